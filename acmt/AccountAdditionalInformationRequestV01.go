@@ -16,6 +16,41 @@ func (d *Document01200101) AddMessage() *AccountAdditionalInformationRequestV01
 	return d.Message
 }
 
+func (d *Document01200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.012.001.01"
+}
+
+func (d *Document01200101) MessageDefinitionIdentifier() string {
+	return "acmt.012.001.01"
+}
+
+func (d *Document01200101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01200101) MessageFunctionality() string {
+	return "012"
+}
+
+func (d *Document01200101) Variant() string {
+	return "001"
+}
+
+func (d *Document01200101) Version() string {
+	return "01"
+}
+
+func (d *Document01200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountAdditionalInformationRequest message is sent from a financial institution to an organisation as part of maintenance process. This message is sent in response to a request message from the organisation, if the business content is valid, but additional information is required.
 // Usage
@@ -38,6 +73,10 @@ type AccountAdditionalInformationRequestV01 struct {
 	DigitalSignature []*model.PartyAndSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *AccountAdditionalInformationRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountAdditionalInformationRequestV01) AddReferences() *model.References3 {
 	a.References = new(model.References3)
 	return a.References
@@ -16,6 +16,41 @@ func (d *Document01200102) AddMessage() *AccountAdditionalInformationRequestV02
 	return d.Message
 }
 
+func (d *Document01200102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.012.001.02"
+}
+
+func (d *Document01200102) MessageDefinitionIdentifier() string {
+	return "acmt.012.001.02"
+}
+
+func (d *Document01200102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01200102) MessageFunctionality() string {
+	return "012"
+}
+
+func (d *Document01200102) Variant() string {
+	return "001"
+}
+
+func (d *Document01200102) Version() string {
+	return "02"
+}
+
+func (d *Document01200102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01200102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AccountAdditionalInformationRequest message is sent from a financial institution to an organisation as part of maintenance process. This message is sent in response to a maintenance request message from the organisation, if the business content is valid, but additional information is required.
 type AccountAdditionalInformationRequestV02 struct {
 
@@ -41,6 +76,10 @@ type AccountAdditionalInformationRequestV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountAdditionalInformationRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountAdditionalInformationRequestV02) AddReferences() *model.References3 {
 	a.References = new(model.References3)
 	return a.References
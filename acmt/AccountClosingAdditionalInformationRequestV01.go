@@ -16,6 +16,41 @@ func (d *Document02100101) AddMessage() *AccountClosingAdditionalInformationRequ
 	return d.Message
 }
 
+func (d *Document02100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.021.001.01"
+}
+
+func (d *Document02100101) MessageDefinitionIdentifier() string {
+	return "acmt.021.001.01"
+}
+
+func (d *Document02100101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document02100101) MessageFunctionality() string {
+	return "021"
+}
+
+func (d *Document02100101) Variant() string {
+	return "001"
+}
+
+func (d *Document02100101) Version() string {
+	return "01"
+}
+
+func (d *Document02100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountClosingAdditionalInformationRequest message is sent from a financial institution to an organisation as part of the account closing process.
 // Usage
@@ -45,6 +80,10 @@ type AccountClosingAdditionalInformationRequestV01 struct {
 	DigitalSignature []*model.PartyAndSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *AccountClosingAdditionalInformationRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountClosingAdditionalInformationRequestV01) AddReferences() *model.References3 {
 	a.References = new(model.References3)
 	return a.References
@@ -16,6 +16,41 @@ func (d *Document02100102) AddMessage() *AccountClosingAdditionalInformationRequ
 	return d.Message
 }
 
+func (d *Document02100102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.021.001.02"
+}
+
+func (d *Document02100102) MessageDefinitionIdentifier() string {
+	return "acmt.021.001.02"
+}
+
+func (d *Document02100102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document02100102) MessageFunctionality() string {
+	return "021"
+}
+
+func (d *Document02100102) Variant() string {
+	return "001"
+}
+
+func (d *Document02100102) Version() string {
+	return "02"
+}
+
+func (d *Document02100102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02100102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AccountClosingAdditionalInformationRequest message is sent from a financial institution to an organisation as part of the account closing process. This message is sent in response to a closing request message from the organisation, if the business content is valid, but additional information is required.
 type AccountClosingAdditionalInformationRequestV02 struct {
 
@@ -48,6 +83,10 @@ type AccountClosingAdditionalInformationRequestV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountClosingAdditionalInformationRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountClosingAdditionalInformationRequestV02) AddReferences() *model.References3 {
 	a.References = new(model.References3)
 	return a.References
@@ -16,6 +16,41 @@ func (d *Document02000101) AddMessage() *AccountClosingAmendmentRequestV01 {
 	return d.Message
 }
 
+func (d *Document02000101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.020.001.01"
+}
+
+func (d *Document02000101) MessageDefinitionIdentifier() string {
+	return "acmt.020.001.01"
+}
+
+func (d *Document02000101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document02000101) MessageFunctionality() string {
+	return "020"
+}
+
+func (d *Document02000101) Variant() string {
+	return "001"
+}
+
+func (d *Document02000101) Version() string {
+	return "01"
+}
+
+func (d *Document02000101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02000101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountClosingAmendmentRequest message is sent from an organisation to a financial institution as part of the account closing process. It is sent in response to a request from the financial institution to send additional information.
 // Usage
@@ -49,6 +84,10 @@ type AccountClosingAmendmentRequestV01 struct {
 	DigitalSignature []*model.PartyAndSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *AccountClosingAmendmentRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountClosingAmendmentRequestV01) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
@@ -16,6 +16,41 @@ func (d *Document02000102) AddMessage() *AccountClosingAmendmentRequestV02 {
 	return d.Message
 }
 
+func (d *Document02000102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.020.001.02"
+}
+
+func (d *Document02000102) MessageDefinitionIdentifier() string {
+	return "acmt.020.001.02"
+}
+
+func (d *Document02000102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document02000102) MessageFunctionality() string {
+	return "020"
+}
+
+func (d *Document02000102) Variant() string {
+	return "001"
+}
+
+func (d *Document02000102) Version() string {
+	return "02"
+}
+
+func (d *Document02000102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02000102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AccountClosingAmendmentRequest message is sent from an organisation to a financial institution as part of the account closing process. It is sent in response to a request from the financial institution to send additional information.
 type AccountClosingAmendmentRequestV02 struct {
 
@@ -51,6 +86,10 @@ type AccountClosingAmendmentRequestV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountClosingAmendmentRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountClosingAmendmentRequestV02) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
@@ -16,6 +16,41 @@ func (d *Document01900101) AddMessage() *AccountClosingRequestV01 {
 	return d.Message
 }
 
+func (d *Document01900101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.019.001.01"
+}
+
+func (d *Document01900101) MessageDefinitionIdentifier() string {
+	return "acmt.019.001.01"
+}
+
+func (d *Document01900101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01900101) MessageFunctionality() string {
+	return "019"
+}
+
+func (d *Document01900101) Variant() string {
+	return "001"
+}
+
+func (d *Document01900101) Version() string {
+	return "01"
+}
+
+func (d *Document01900101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01900101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountClosingRequest message is sent from an organisation to a financial institution as part of the account closing process. It is the initial request message to close an account.
 // Usage
@@ -48,6 +83,10 @@ type AccountClosingRequestV01 struct {
 	DigitalSignature []*model.PartyAndSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *AccountClosingRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountClosingRequestV01) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
@@ -16,6 +16,41 @@ func (d *Document01900102) AddMessage() *AccountClosingRequestV02 {
 	return d.Message
 }
 
+func (d *Document01900102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.019.001.02"
+}
+
+func (d *Document01900102) MessageDefinitionIdentifier() string {
+	return "acmt.019.001.02"
+}
+
+func (d *Document01900102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01900102) MessageFunctionality() string {
+	return "019"
+}
+
+func (d *Document01900102) Variant() string {
+	return "001"
+}
+
+func (d *Document01900102) Version() string {
+	return "02"
+}
+
+func (d *Document01900102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01900102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AccountClosingRequest message is sent from an organisation to a financial institution as part of the account closing process. It is the initial request message to close an account.
 type AccountClosingRequestV02 struct {
 
@@ -50,6 +85,10 @@ type AccountClosingRequestV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountClosingRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountClosingRequestV02) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
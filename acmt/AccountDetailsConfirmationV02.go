@@ -16,6 +16,41 @@ func (d *Document00200102) AddMessage() *AccountDetailsConfirmationV02 {
 	return d.Message
 }
 
+func (d *Document00200102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.002.001.02"
+}
+
+func (d *Document00200102) MessageDefinitionIdentifier() string {
+	return "acmt.002.001.02"
+}
+
+func (d *Document00200102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00200102) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200102) Variant() string {
+	return "001"
+}
+
+func (d *Document00200102) Version() string {
+	return "02"
+}
+
+func (d *Document00200102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account servicer, eg, a registrar, transfer agent or custodian bank sends the AccountDetailsConfirmation message to an account owner, eg, an investor to confirm the opening of an investment fund account, execution of an AccountModificationInstruction or to return information requested in a GetAccountDetails message.
 // Usage
@@ -67,6 +102,10 @@ type AccountDetailsConfirmationV02 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountDetailsConfirmationV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountDetailsConfirmationV02) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
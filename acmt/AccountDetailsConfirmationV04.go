@@ -16,6 +16,41 @@ func (d *Document00200104) AddMessage() *AccountDetailsConfirmationV04 {
 	return d.Message
 }
 
+func (d *Document00200104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.002.001.04"
+}
+
+func (d *Document00200104) MessageDefinitionIdentifier() string {
+	return "acmt.002.001.04"
+}
+
+func (d *Document00200104) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00200104) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200104) Variant() string {
+	return "001"
+}
+
+func (d *Document00200104) Version() string {
+	return "04"
+}
+
+func (d *Document00200104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account servicer, for example, a registrar, transfer agent or custodian bank sends the AccountDetailsConfirmation message to the account owner, for example, an investor to confirm the opening of an investment fund account, execution of an AccountModificationInstruction or to return information requested in a GetAccountDetails message.
 // Usage
@@ -70,6 +105,10 @@ type AccountDetailsConfirmationV04 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountDetailsConfirmationV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountDetailsConfirmationV04) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
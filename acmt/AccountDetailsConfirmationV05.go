@@ -16,6 +16,41 @@ func (d *Document00200105) AddMessage() *AccountDetailsConfirmationV05 {
 	return d.Message
 }
 
+func (d *Document00200105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.002.001.05"
+}
+
+func (d *Document00200105) MessageDefinitionIdentifier() string {
+	return "acmt.002.001.05"
+}
+
+func (d *Document00200105) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00200105) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200105) Variant() string {
+	return "001"
+}
+
+func (d *Document00200105) Version() string {
+	return "05"
+}
+
+func (d *Document00200105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account servicer, for example, a registrar, transfer agent or custodian bank sends the AccountDetailsConfirmation message to the account owner, for example, an investor to confirm the opening of an investment fund account, execution of an AccountModificationInstruction or to return information requested in a GetAccountDetails message.
 // Usage
@@ -70,6 +105,10 @@ type AccountDetailsConfirmationV05 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountDetailsConfirmationV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountDetailsConfirmationV05) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
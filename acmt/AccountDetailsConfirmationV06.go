@@ -16,6 +16,41 @@ func (d *Document00200106) AddMessage() *AccountDetailsConfirmationV06 {
 	return d.Message
 }
 
+func (d *Document00200106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.002.001.06"
+}
+
+func (d *Document00200106) MessageDefinitionIdentifier() string {
+	return "acmt.002.001.06"
+}
+
+func (d *Document00200106) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00200106) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200106) Variant() string {
+	return "001"
+}
+
+func (d *Document00200106) Version() string {
+	return "06"
+}
+
+func (d *Document00200106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200106) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account servicer, for example, a registrar, transfer agent, custodian bank or securities depository  sends the AccountDetailsConfirmation message to the account owner, for example, an investor to confirm the opening of an account, execution of an AccountModificationInstruction or to return information requested in a GetAccountDetails message.
 // Usage
@@ -73,6 +108,10 @@ type AccountDetailsConfirmationV06 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountDetailsConfirmationV06) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountDetailsConfirmationV06) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
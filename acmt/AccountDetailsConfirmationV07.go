@@ -16,6 +16,41 @@ func (d *Document00200107) AddMessage() *AccountDetailsConfirmationV07 {
 	return d.Message
 }
 
+func (d *Document00200107) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.002.001.07"
+}
+
+func (d *Document00200107) MessageDefinitionIdentifier() string {
+	return "acmt.002.001.07"
+}
+
+func (d *Document00200107) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00200107) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200107) Variant() string {
+	return "001"
+}
+
+func (d *Document00200107) Version() string {
+	return "07"
+}
+
+func (d *Document00200107) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200107) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountDetailsConfirmation message is sent by an account servicer, for example, a registrar, transfer agent, custodian bank or securities depository to the account owner, for example, an investor to confirm the opening of an account, execution of an AccountModificationInstruction or to return information requested in a GetAccountDetails message.
 // Usage
@@ -76,6 +111,10 @@ type AccountDetailsConfirmationV07 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountDetailsConfirmationV07) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountDetailsConfirmationV07) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document01600101) AddMessage() *AccountExcludedMandateMaintenanceAmendm
 	return d.Message
 }
 
+func (d *Document01600101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.016.001.01"
+}
+
+func (d *Document01600101) MessageDefinitionIdentifier() string {
+	return "acmt.016.001.01"
+}
+
+func (d *Document01600101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01600101) MessageFunctionality() string {
+	return "016"
+}
+
+func (d *Document01600101) Variant() string {
+	return "001"
+}
+
+func (d *Document01600101) Version() string {
+	return "01"
+}
+
+func (d *Document01600101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01600101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountExcludedMandateMaintenanceAmendmentRequest message is sent from an organisation to a financial institution as part of the account maintenance process. It is sent in response to a request from the financial institution to send additional information.
 // Usage
@@ -44,6 +79,10 @@ type AccountExcludedMandateMaintenanceAmendmentRequestV01 struct {
 	DigitalSignature []*model.PartyAndSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *AccountExcludedMandateMaintenanceAmendmentRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountExcludedMandateMaintenanceAmendmentRequestV01) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
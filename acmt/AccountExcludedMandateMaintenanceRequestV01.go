@@ -16,6 +16,41 @@ func (d *Document01500101) AddMessage() *AccountExcludedMandateMaintenanceReques
 	return d.Message
 }
 
+func (d *Document01500101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.015.001.01"
+}
+
+func (d *Document01500101) MessageDefinitionIdentifier() string {
+	return "acmt.015.001.01"
+}
+
+func (d *Document01500101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01500101) MessageFunctionality() string {
+	return "015"
+}
+
+func (d *Document01500101) Variant() string {
+	return "001"
+}
+
+func (d *Document01500101) Version() string {
+	return "01"
+}
+
+func (d *Document01500101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01500101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // This AccountExcludedMandateMaintenanceRequest message is sent from an organisation to a financial institution as part of the account maintenance process. It is the initial request message to update an account.
 // Usage
@@ -47,6 +82,10 @@ type AccountExcludedMandateMaintenanceRequestV01 struct {
 	DigitalSignature []*model.PartyAndSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *AccountExcludedMandateMaintenanceRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountExcludedMandateMaintenanceRequestV01) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
@@ -16,6 +16,41 @@ func (d *Document01500102) AddMessage() *AccountExcludedMandateMaintenanceReques
 	return d.Message
 }
 
+func (d *Document01500102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.015.001.02"
+}
+
+func (d *Document01500102) MessageDefinitionIdentifier() string {
+	return "acmt.015.001.02"
+}
+
+func (d *Document01500102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01500102) MessageFunctionality() string {
+	return "015"
+}
+
+func (d *Document01500102) Variant() string {
+	return "001"
+}
+
+func (d *Document01500102) Version() string {
+	return "02"
+}
+
+func (d *Document01500102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01500102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AccountExcludedMandateMaintenanceRequest message is sent from an organisation to a financial institution as part of the account maintenance process. It is the initial request message to update an account. Usage: this update is about account details excluding any mandate information.
 // If modification codes are not used: the organisation will specify under the “Account” and “Organisation” tags the complete information as it should be in the financial institution’s records after processing the update request.
 // If modification codes are used (in that case, they must be used everywhere): the organisation will specify under the “Account” and “Organisation” tags which elements must be added, deleted, modified, or if they are unchanged.
@@ -52,6 +87,10 @@ type AccountExcludedMandateMaintenanceRequestV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountExcludedMandateMaintenanceRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountExcludedMandateMaintenanceRequestV02) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
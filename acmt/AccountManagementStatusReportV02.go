@@ -16,6 +16,41 @@ func (d *Document00600102) AddMessage() *AccountManagementStatusReportV02 {
 	return d.Message
 }
 
+func (d *Document00600102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.006.001.02"
+}
+
+func (d *Document00600102) MessageDefinitionIdentifier() string {
+	return "acmt.006.001.02"
+}
+
+func (d *Document00600102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00600102) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600102) Variant() string {
+	return "001"
+}
+
+func (d *Document00600102) Version() string {
+	return "02"
+}
+
+func (d *Document00600102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account servicer, eg, a registrar, transfer agent or custodian bank sends the AccountManagementStatusReport message to an account owner or its designated agent, eg, an investor to report on the receipt or the processing status of a previously received AccountOpeningInstruction or AccountModificationInstruction or GetAccountDetails message.
 // Usage
@@ -34,6 +69,10 @@ type AccountManagementStatusReportV02 struct {
 	StatusReport *model.AccountManagementStatusAndReason1 `xml:"StsRpt"`
 }
 
+func (a *AccountManagementStatusReportV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountManagementStatusReportV02) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
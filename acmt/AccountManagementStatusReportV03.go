@@ -16,6 +16,41 @@ func (d *Document00600103) AddMessage() *AccountManagementStatusReportV03 {
 	return d.Message
 }
 
+func (d *Document00600103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.006.001.03"
+}
+
+func (d *Document00600103) MessageDefinitionIdentifier() string {
+	return "acmt.006.001.03"
+}
+
+func (d *Document00600103) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00600103) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600103) Variant() string {
+	return "001"
+}
+
+func (d *Document00600103) Version() string {
+	return "03"
+}
+
+func (d *Document00600103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account servicer, for example, a registrar, transfer agent or custodian bank sends the AccountManagementStatusReport message to the account owner or its designated agent, for example, an investor to report on the receipt or the processing status of a previously received AccountOpeningInstruction or AccountModificationInstruction or GetAccountDetails message.
 // Usage
@@ -34,6 +69,10 @@ type AccountManagementStatusReportV03 struct {
 	StatusReport *model.AccountManagementStatusAndReason2 `xml:"StsRpt"`
 }
 
+func (a *AccountManagementStatusReportV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountManagementStatusReportV03) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document00600104) AddMessage() *AccountManagementStatusReportV04 {
 	return d.Message
 }
 
+func (d *Document00600104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.006.001.04"
+}
+
+func (d *Document00600104) MessageDefinitionIdentifier() string {
+	return "acmt.006.001.04"
+}
+
+func (d *Document00600104) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00600104) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600104) Variant() string {
+	return "001"
+}
+
+func (d *Document00600104) Version() string {
+	return "04"
+}
+
+func (d *Document00600104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account servicer, for example, a registrar, transfer agent or custodian bank sends the AccountManagementStatusReport message to the account owner or its designated agent, for example, an investor to report on the receipt or the processing status of a previously received AccountOpeningInstruction or AccountModificationInstruction or GetAccountDetails message.
 // Usage
@@ -40,6 +75,10 @@ type AccountManagementStatusReportV04 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountManagementStatusReportV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountManagementStatusReportV04) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document00600105) AddMessage() *AccountManagementStatusReportV05 {
 	return d.Message
 }
 
+func (d *Document00600105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.006.001.05"
+}
+
+func (d *Document00600105) MessageDefinitionIdentifier() string {
+	return "acmt.006.001.05"
+}
+
+func (d *Document00600105) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00600105) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600105) Variant() string {
+	return "001"
+}
+
+func (d *Document00600105) Version() string {
+	return "05"
+}
+
+func (d *Document00600105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account servicer, for example, a registrar, transfer agent, custodian bank or securities depository  sends the AccountManagementStatusReport message to the account owner or its designated agent, for example, an investor to report on the receipt or the processing status of a previously received AccountOpeningInstruction or AccountModificationInstruction or GetAccountDetails message.
 // Usage
@@ -40,6 +75,10 @@ type AccountManagementStatusReportV05 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountManagementStatusReportV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountManagementStatusReportV05) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document00600106) AddMessage() *AccountManagementStatusReportV06 {
 	return d.Message
 }
 
+func (d *Document00600106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.006.001.06"
+}
+
+func (d *Document00600106) MessageDefinitionIdentifier() string {
+	return "acmt.006.001.06"
+}
+
+func (d *Document00600106) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00600106) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600106) Variant() string {
+	return "001"
+}
+
+func (d *Document00600106) Version() string {
+	return "06"
+}
+
+func (d *Document00600106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600106) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountManagementStatusReport message is sent by an account servicer, for example, a registrar, transfer agent, custodian bank or securities depository to the account owner or its designated agent, for example, an investor to report on the receipt or the processing status of a previously received account management message.
 // Usage
@@ -39,6 +74,10 @@ type AccountManagementStatusReportV06 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountManagementStatusReportV06) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountManagementStatusReportV06) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
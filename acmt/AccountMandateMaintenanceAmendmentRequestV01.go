@@ -16,6 +16,41 @@ func (d *Document01800101) AddMessage() *AccountMandateMaintenanceAmendmentReque
 	return d.Message
 }
 
+func (d *Document01800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.018.001.01"
+}
+
+func (d *Document01800101) MessageDefinitionIdentifier() string {
+	return "acmt.018.001.01"
+}
+
+func (d *Document01800101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01800101) MessageFunctionality() string {
+	return "018"
+}
+
+func (d *Document01800101) Variant() string {
+	return "001"
+}
+
+func (d *Document01800101) Version() string {
+	return "01"
+}
+
+func (d *Document01800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountMandateMaintenanceAmendmentRequest message is sent from an organisation to a financial institution as part of the account maintenance process. It is sent in response to a request from the financial institution to send additional information. This update is only about mandate information.
 // Usage
@@ -50,6 +85,10 @@ type AccountMandateMaintenanceAmendmentRequestV01 struct {
 	DigitalSignature []*model.PartyAndSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *AccountMandateMaintenanceAmendmentRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountMandateMaintenanceAmendmentRequestV01) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
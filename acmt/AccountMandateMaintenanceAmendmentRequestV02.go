@@ -16,6 +16,41 @@ func (d *Document01800102) AddMessage() *AccountMandateMaintenanceAmendmentReque
 	return d.Message
 }
 
+func (d *Document01800102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.018.001.02"
+}
+
+func (d *Document01800102) MessageDefinitionIdentifier() string {
+	return "acmt.018.001.02"
+}
+
+func (d *Document01800102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01800102) MessageFunctionality() string {
+	return "018"
+}
+
+func (d *Document01800102) Variant() string {
+	return "001"
+}
+
+func (d *Document01800102) Version() string {
+	return "02"
+}
+
+func (d *Document01800102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01800102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AccountMandateMaintenanceAmendmentRequest message is sent from an organisation to a financial institution as part of the account maintenance process. It is sent in response to a request from the financial institution to send additional information. Usage: this update is only about mandate information.
 // If modification codes are not used: the organisation will specify under the “Mandate” and “Group” tags the complete information as it should be in the financial institution’s records after processing the update request.
 // If modification codes are used (in that case, they must be used everywhere): the organisation will specify under the “Mandate” and “Group” tags which elements must be added, deleted, modified, or if they are unchanged.
@@ -58,6 +93,10 @@ type AccountMandateMaintenanceAmendmentRequestV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountMandateMaintenanceAmendmentRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountMandateMaintenanceAmendmentRequestV02) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
@@ -16,6 +16,41 @@ func (d *Document01700101) AddMessage() *AccountMandateMaintenanceRequestV01 {
 	return d.Message
 }
 
+func (d *Document01700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.017.001.01"
+}
+
+func (d *Document01700101) MessageDefinitionIdentifier() string {
+	return "acmt.017.001.01"
+}
+
+func (d *Document01700101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01700101) MessageFunctionality() string {
+	return "017"
+}
+
+func (d *Document01700101) Variant() string {
+	return "001"
+}
+
+func (d *Document01700101) Version() string {
+	return "01"
+}
+
+func (d *Document01700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountMandateMaintenanceRequest message is sent from an organisation to a financial institution as part of the account maintenance process. It is the initial request message to update an account. This update is only about mandate information.
 // Usage
@@ -52,6 +87,10 @@ type AccountMandateMaintenanceRequestV01 struct {
 	DigitalSignature []*model.PartyAndSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *AccountMandateMaintenanceRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountMandateMaintenanceRequestV01) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
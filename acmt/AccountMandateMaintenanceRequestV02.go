@@ -16,6 +16,41 @@ func (d *Document01700102) AddMessage() *AccountMandateMaintenanceRequestV02 {
 	return d.Message
 }
 
+func (d *Document01700102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.017.001.02"
+}
+
+func (d *Document01700102) MessageDefinitionIdentifier() string {
+	return "acmt.017.001.02"
+}
+
+func (d *Document01700102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01700102) MessageFunctionality() string {
+	return "017"
+}
+
+func (d *Document01700102) Variant() string {
+	return "001"
+}
+
+func (d *Document01700102) Version() string {
+	return "02"
+}
+
+func (d *Document01700102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01700102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AccountMandateMaintenanceRequest message is sent from an organisation to a financial institution as part of the account maintenance process. It is the initial request message to update one or several accounts. Usage: this update is only about mandate information.
 // If modification codes are not used: the organisation will specify under the “Mandate” and “Group” tags the complete information as it should be in the financial institution’s records after processing the update request.
 // If modification codes are used (in that case, they must be used everywhere): the organisation will specify under the “Mandate” and “Group” tags which elements must be added, deleted, modified, or if they are unchanged.
@@ -59,6 +94,10 @@ type AccountMandateMaintenanceRequestV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountMandateMaintenanceRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountMandateMaintenanceRequestV02) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
@@ -16,6 +16,41 @@ func (d *Document00300102) AddMessage() *AccountModificationInstructionV02 {
 	return d.Message
 }
 
+func (d *Document00300102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.003.001.02"
+}
+
+func (d *Document00300102) MessageDefinitionIdentifier() string {
+	return "acmt.003.001.02"
+}
+
+func (d *Document00300102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00300102) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300102) Variant() string {
+	return "001"
+}
+
+func (d *Document00300102) Version() string {
+	return "02"
+}
+
+func (d *Document00300102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account owner, eg, and investor or its designated agent, sends the AccountModificationInstruction message to an account servicer, eg, a registrar, transfer agent or custodian bank to modify, ie, create, update or delete specific details of an existing investment fund account.
 // Usage
@@ -73,6 +108,10 @@ type AccountModificationInstructionV02 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountModificationInstructionV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountModificationInstructionV02) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
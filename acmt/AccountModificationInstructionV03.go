@@ -16,6 +16,41 @@ func (d *Document00300103) AddMessage() *AccountModificationInstructionV03 {
 	return d.Message
 }
 
+func (d *Document00300103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.003.001.03"
+}
+
+func (d *Document00300103) MessageDefinitionIdentifier() string {
+	return "acmt.003.001.03"
+}
+
+func (d *Document00300103) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00300103) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300103) Variant() string {
+	return "001"
+}
+
+func (d *Document00300103) Version() string {
+	return "03"
+}
+
+func (d *Document00300103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account owner, for example, an investor or its designated agent, sends the AccountModificationInstruction message to the account servicer, for example, a registrar, transfer agent or custodian bank to modify, that is, create, update or delete specific details of an existing investment fund account.
 // Usage
@@ -73,6 +108,10 @@ type AccountModificationInstructionV03 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountModificationInstructionV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountModificationInstructionV03) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
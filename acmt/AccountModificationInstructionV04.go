@@ -16,6 +16,41 @@ func (d *Document00300104) AddMessage() *AccountModificationInstructionV04 {
 	return d.Message
 }
 
+func (d *Document00300104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.003.001.04"
+}
+
+func (d *Document00300104) MessageDefinitionIdentifier() string {
+	return "acmt.003.001.04"
+}
+
+func (d *Document00300104) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00300104) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300104) Variant() string {
+	return "001"
+}
+
+func (d *Document00300104) Version() string {
+	return "04"
+}
+
+func (d *Document00300104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account owner, for example, an investor or its designated agent, sends the AccountModificationInstruction message to the account servicer, for example, a registrar, transfer agent or custodian bank to modify, that is, create, update or delete specific details of an existing investment fund account.
 // Usage
@@ -76,6 +111,10 @@ type AccountModificationInstructionV04 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountModificationInstructionV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountModificationInstructionV04) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
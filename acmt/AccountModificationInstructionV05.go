@@ -16,6 +16,41 @@ func (d *Document00300105) AddMessage() *AccountModificationInstructionV05 {
 	return d.Message
 }
 
+func (d *Document00300105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.003.001.05"
+}
+
+func (d *Document00300105) MessageDefinitionIdentifier() string {
+	return "acmt.003.001.05"
+}
+
+func (d *Document00300105) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00300105) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300105) Variant() string {
+	return "001"
+}
+
+func (d *Document00300105) Version() string {
+	return "05"
+}
+
+func (d *Document00300105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account owner, for example, an investor or its designated agent, sends the AccountModificationInstruction message to the account servicer, for example, a registrar, transfer agent or custodian bank to modify, that is, create, update or delete specific details of an existing investment fund account.
 // Usage
@@ -76,6 +111,10 @@ type AccountModificationInstructionV05 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountModificationInstructionV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountModificationInstructionV05) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
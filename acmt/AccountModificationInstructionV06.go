@@ -16,6 +16,41 @@ func (d *Document00300106) AddMessage() *AccountModificationInstructionV06 {
 	return d.Message
 }
 
+func (d *Document00300106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.003.001.06"
+}
+
+func (d *Document00300106) MessageDefinitionIdentifier() string {
+	return "acmt.003.001.06"
+}
+
+func (d *Document00300106) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00300106) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300106) Variant() string {
+	return "001"
+}
+
+func (d *Document00300106) Version() string {
+	return "06"
+}
+
+func (d *Document00300106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300106) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account owner, for example, an investor or its designated agent, sends the AccountModificationInstruction message to the account servicer, for example, a registrar, transfer agent, custodian bank or securities depository to modify, that is, create, update or delete specific details of an existing account.
 // Usage
@@ -79,6 +114,10 @@ type AccountModificationInstructionV06 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountModificationInstructionV06) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountModificationInstructionV06) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document00300107) AddMessage() *AccountModificationInstructionV07 {
 	return d.Message
 }
 
+func (d *Document00300107) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.003.001.07"
+}
+
+func (d *Document00300107) MessageDefinitionIdentifier() string {
+	return "acmt.003.001.07"
+}
+
+func (d *Document00300107) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00300107) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300107) Variant() string {
+	return "001"
+}
+
+func (d *Document00300107) Version() string {
+	return "07"
+}
+
+func (d *Document00300107) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300107) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountModificationInstruction message is sent by an account owner, for example, an investor or its designated agent to the account servicer, for example, a registrar, transfer agent, custodian bank or securities depository to modify, that is, create, update or delete specific details of an existing account.
 // Usage
@@ -79,6 +114,10 @@ type AccountModificationInstructionV07 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountModificationInstructionV07) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountModificationInstructionV07) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document00900101) AddMessage() *AccountOpeningAdditionalInformationRequ
 	return d.Message
 }
 
+func (d *Document00900101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.009.001.01"
+}
+
+func (d *Document00900101) MessageDefinitionIdentifier() string {
+	return "acmt.009.001.01"
+}
+
+func (d *Document00900101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00900101) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900101) Variant() string {
+	return "001"
+}
+
+func (d *Document00900101) Version() string {
+	return "01"
+}
+
+func (d *Document00900101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountOpeningAdditionalInformationRequest message is sent from a financial institution to an organisation as part of the account opening process. This message is sent in response to an opening request message from the organisation, if the business content is valid, but additional information is required.
 // Usage
@@ -41,6 +76,10 @@ type AccountOpeningAdditionalInformationRequestV01 struct {
 	DigitalSignature []*model.PartyAndSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *AccountOpeningAdditionalInformationRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountOpeningAdditionalInformationRequestV01) AddReferences() *model.References3 {
 	a.References = new(model.References3)
 	return a.References
@@ -16,6 +16,41 @@ func (d *Document00900102) AddMessage() *AccountOpeningAdditionalInformationRequ
 	return d.Message
 }
 
+func (d *Document00900102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.009.001.02"
+}
+
+func (d *Document00900102) MessageDefinitionIdentifier() string {
+	return "acmt.009.001.02"
+}
+
+func (d *Document00900102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00900102) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900102) Variant() string {
+	return "001"
+}
+
+func (d *Document00900102) Version() string {
+	return "02"
+}
+
+func (d *Document00900102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AccountOpeningAdditionalInformationRequest message is sent from a financial institution to an organisation as part of the account opening process. This message is sent in response to an opening request message from the organisation, if the business content is valid, but additional information is required.
 type AccountOpeningAdditionalInformationRequestV02 struct {
 
@@ -44,6 +79,10 @@ type AccountOpeningAdditionalInformationRequestV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountOpeningAdditionalInformationRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountOpeningAdditionalInformationRequestV02) AddReferences() *model.References3 {
 	a.References = new(model.References3)
 	return a.References
@@ -16,6 +16,41 @@ func (d *Document00800101) AddMessage() *AccountOpeningAmendmentRequestV01 {
 	return d.Message
 }
 
+func (d *Document00800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.008.001.01"
+}
+
+func (d *Document00800101) MessageDefinitionIdentifier() string {
+	return "acmt.008.001.01"
+}
+
+func (d *Document00800101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00800101) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800101) Variant() string {
+	return "001"
+}
+
+func (d *Document00800101) Version() string {
+	return "01"
+}
+
+func (d *Document00800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountOpeningAmendmentRequest message is sent from an organisation to a financial institution as part of the account opening process. It is sent in response to a request from the financial institution to provide additional information.
 // Usage
@@ -52,6 +87,10 @@ type AccountOpeningAmendmentRequestV01 struct {
 	DigitalSignature []*model.PartyAndSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *AccountOpeningAmendmentRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountOpeningAmendmentRequestV01) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
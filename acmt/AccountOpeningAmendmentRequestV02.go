@@ -16,6 +16,41 @@ func (d *Document00800102) AddMessage() *AccountOpeningAmendmentRequestV02 {
 	return d.Message
 }
 
+func (d *Document00800102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.008.001.02"
+}
+
+func (d *Document00800102) MessageDefinitionIdentifier() string {
+	return "acmt.008.001.02"
+}
+
+func (d *Document00800102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00800102) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800102) Variant() string {
+	return "001"
+}
+
+func (d *Document00800102) Version() string {
+	return "02"
+}
+
+func (d *Document00800102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AccountOpeningAmendmentRequest message is sent from an organisation to a financial institution as part of the account opening process. It is sent in response to a request from the financial institution to send additional information.
 type AccountOpeningAmendmentRequestV02 struct {
 
@@ -57,6 +92,10 @@ type AccountOpeningAmendmentRequestV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountOpeningAmendmentRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountOpeningAmendmentRequestV02) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
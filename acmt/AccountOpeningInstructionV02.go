@@ -16,6 +16,41 @@ func (d *Document00100102) AddMessage() *AccountOpeningInstructionV02 {
 	return d.Message
 }
 
+func (d *Document00100102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.001.001.02"
+}
+
+func (d *Document00100102) MessageDefinitionIdentifier() string {
+	return "acmt.001.001.02"
+}
+
+func (d *Document00100102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00100102) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100102) Variant() string {
+	return "001"
+}
+
+func (d *Document00100102) Version() string {
+	return "02"
+}
+
+func (d *Document00100102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account owner, for example, an investor or its designated agent sends the AccountOpeningInstruction message to an account servicer, for example, a registrar, transfer agent or custodian to instruct the opening of an account or the opening of an account and establishing an investment plan.
 // Usage
@@ -67,6 +102,10 @@ type AccountOpeningInstructionV02 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountOpeningInstructionV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountOpeningInstructionV02) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document00100103) AddMessage() *AccountOpeningInstructionV03 {
 	return d.Message
 }
 
+func (d *Document00100103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.001.001.03"
+}
+
+func (d *Document00100103) MessageDefinitionIdentifier() string {
+	return "acmt.001.001.03"
+}
+
+func (d *Document00100103) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00100103) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100103) Variant() string {
+	return "001"
+}
+
+func (d *Document00100103) Version() string {
+	return "03"
+}
+
+func (d *Document00100103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account owner, for example, an investor or its designated agent sends the AccountOpeningInstruction message to the account servicer, for example, a registrar, transfer agent or custodian to instruct the opening of an account or the opening of an account and establishing an investment plan.
 // Usage
@@ -67,6 +102,10 @@ type AccountOpeningInstructionV03 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountOpeningInstructionV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountOpeningInstructionV03) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
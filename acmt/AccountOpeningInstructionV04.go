@@ -16,6 +16,41 @@ func (d *Document00100104) AddMessage() *AccountOpeningInstructionV04 {
 	return d.Message
 }
 
+func (d *Document00100104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.001.001.04"
+}
+
+func (d *Document00100104) MessageDefinitionIdentifier() string {
+	return "acmt.001.001.04"
+}
+
+func (d *Document00100104) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00100104) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100104) Variant() string {
+	return "001"
+}
+
+func (d *Document00100104) Version() string {
+	return "04"
+}
+
+func (d *Document00100104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account owner, for example, an investor or its designated agent sends the AccountOpeningInstruction message to the account servicer, for example, a registrar, transfer agent or custodian to instruct the opening of an account or the opening of an account and establishing an investment plan.
 // Usage
@@ -70,6 +105,10 @@ type AccountOpeningInstructionV04 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountOpeningInstructionV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountOpeningInstructionV04) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document00100106) AddMessage() *AccountOpeningInstructionV06 {
 	return d.Message
 }
 
+func (d *Document00100106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.001.001.06"
+}
+
+func (d *Document00100106) MessageDefinitionIdentifier() string {
+	return "acmt.001.001.06"
+}
+
+func (d *Document00100106) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00100106) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100106) Variant() string {
+	return "001"
+}
+
+func (d *Document00100106) Version() string {
+	return "06"
+}
+
+func (d *Document00100106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100106) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account owner, for example, an investor or its designated agent sends the AccountOpeningInstruction message to the account servicer, for example, a registrar, transfer agent, custodian or securities depository to instruct the opening of an account or the opening of an account and the establishment of an investment plan.
 // Usage
@@ -73,6 +108,10 @@ type AccountOpeningInstructionV06 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountOpeningInstructionV06) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountOpeningInstructionV06) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document00100107) AddMessage() *AccountOpeningInstructionV07 {
 	return d.Message
 }
 
+func (d *Document00100107) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.001.001.07"
+}
+
+func (d *Document00100107) MessageDefinitionIdentifier() string {
+	return "acmt.001.001.07"
+}
+
+func (d *Document00100107) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00100107) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100107) Variant() string {
+	return "001"
+}
+
+func (d *Document00100107) Version() string {
+	return "07"
+}
+
+func (d *Document00100107) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100107) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountOpeningInstruction message is sent by an account owner, for example, an investor or its designated agent to the account servicer, for example, a registrar, transfer agent, custodian or securities depository, to instruct the opening of an account or the opening of an account and the establishment of an investment plan.
 // Usage
@@ -73,6 +108,10 @@ type AccountOpeningInstructionV07 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountOpeningInstructionV07) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountOpeningInstructionV07) AddMessageIdentification() *model.MessageIdentification1 {
 	a.MessageIdentification = new(model.MessageIdentification1)
 	return a.MessageIdentification
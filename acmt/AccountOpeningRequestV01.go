@@ -16,6 +16,41 @@ func (d *Document00700101) AddMessage() *AccountOpeningRequestV01 {
 	return d.Message
 }
 
+func (d *Document00700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.007.001.01"
+}
+
+func (d *Document00700101) MessageDefinitionIdentifier() string {
+	return "acmt.007.001.01"
+}
+
+func (d *Document00700101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00700101) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700101) Variant() string {
+	return "001"
+}
+
+func (d *Document00700101) Version() string {
+	return "01"
+}
+
+func (d *Document00700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountOpeningRequest message is sent from an organisation to a financial institution as part of the account opening process. It is the initial request to open an account.
 // Usage
@@ -52,6 +87,10 @@ type AccountOpeningRequestV01 struct {
 	DigitalSignature []*model.PartyAndSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *AccountOpeningRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountOpeningRequestV01) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
@@ -16,6 +16,41 @@ func (d *Document00700102) AddMessage() *AccountOpeningRequestV02 {
 	return d.Message
 }
 
+func (d *Document00700102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.007.001.02"
+}
+
+func (d *Document00700102) MessageDefinitionIdentifier() string {
+	return "acmt.007.001.02"
+}
+
+func (d *Document00700102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00700102) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700102) Variant() string {
+	return "001"
+}
+
+func (d *Document00700102) Version() string {
+	return "02"
+}
+
+func (d *Document00700102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AccountOpeningRequest message is sent from an organisation to a financial institution as part of the account opening process. It is the initial request message to open an account.
 type AccountOpeningRequestV02 struct {
 
@@ -57,6 +92,10 @@ type AccountOpeningRequestV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountOpeningRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountOpeningRequestV02) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
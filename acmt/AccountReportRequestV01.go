@@ -16,6 +16,41 @@ func (d *Document01300101) AddMessage() *AccountReportRequestV01 {
 	return d.Message
 }
 
+func (d *Document01300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.013.001.01"
+}
+
+func (d *Document01300101) MessageDefinitionIdentifier() string {
+	return "acmt.013.001.01"
+}
+
+func (d *Document01300101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01300101) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300101) Variant() string {
+	return "001"
+}
+
+func (d *Document01300101) Version() string {
+	return "01"
+}
+
+func (d *Document01300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountReportRequest message is sent from an organisation to a financial institution for reporting purposes. It is a request for an account report.
 // Usage
@@ -42,6 +77,10 @@ type AccountReportRequestV01 struct {
 	DigitalSignature []*model.PartyAndSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *AccountReportRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountReportRequestV01) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
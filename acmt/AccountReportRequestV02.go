@@ -16,6 +16,41 @@ func (d *Document01300102) AddMessage() *AccountReportRequestV02 {
 	return d.Message
 }
 
+func (d *Document01300102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.013.001.02"
+}
+
+func (d *Document01300102) MessageDefinitionIdentifier() string {
+	return "acmt.013.001.02"
+}
+
+func (d *Document01300102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01300102) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300102) Variant() string {
+	return "001"
+}
+
+func (d *Document01300102) Version() string {
+	return "02"
+}
+
+func (d *Document01300102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AccountReportRequest message is sent from an organisation to a financial institution for reporting purposes. It is a request for an account report. This message can be sent at any time outside of account opening, maintenance or closing processes.
 type AccountReportRequestV02 struct {
 
@@ -42,6 +77,10 @@ type AccountReportRequestV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountReportRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountReportRequestV02) AddReferences() *model.References4 {
 	a.References = new(model.References4)
 	return a.References
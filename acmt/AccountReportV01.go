@@ -16,6 +16,41 @@ func (d *Document01400101) AddMessage() *AccountReportV01 {
 	return d.Message
 }
 
+func (d *Document01400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.014.001.01"
+}
+
+func (d *Document01400101) MessageDefinitionIdentifier() string {
+	return "acmt.014.001.01"
+}
+
+func (d *Document01400101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01400101) MessageFunctionality() string {
+	return "014"
+}
+
+func (d *Document01400101) Variant() string {
+	return "001"
+}
+
+func (d *Document01400101) Version() string {
+	return "01"
+}
+
+func (d *Document01400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountReport message is sent from a financial institution to an organisation for reporting purposes.
 // Usage
@@ -39,6 +74,10 @@ type AccountReportV01 struct {
 	DigitalSignature []*model.PartyAndSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *AccountReportV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountReportV01) AddReferences() *model.References5 {
 	a.References = new(model.References5)
 	return a.References
@@ -16,6 +16,41 @@ func (d *Document01400102) AddMessage() *AccountReportV02 {
 	return d.Message
 }
 
+func (d *Document01400102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.014.001.02"
+}
+
+func (d *Document01400102) MessageDefinitionIdentifier() string {
+	return "acmt.014.001.02"
+}
+
+func (d *Document01400102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01400102) MessageFunctionality() string {
+	return "014"
+}
+
+func (d *Document01400102) Variant() string {
+	return "001"
+}
+
+func (d *Document01400102) Version() string {
+	return "02"
+}
+
+func (d *Document01400102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01400102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AccountReport message is sent from a financial institution to an organisation for reporting purposes. It can be sent unsolicited as part of opening, maintenance, or closing process, or it can be sent as response to an AccountReportRequest message.
 type AccountReportV02 struct {
 
@@ -42,6 +77,10 @@ type AccountReportV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountReportV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountReportV02) AddReferences() *model.References5 {
 	a.References = new(model.References5)
 	return a.References
@@ -16,6 +16,41 @@ func (d *Document01000101) AddMessage() *AccountRequestAcknowledgementV01 {
 	return d.Message
 }
 
+func (d *Document01000101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.010.001.01"
+}
+
+func (d *Document01000101) MessageDefinitionIdentifier() string {
+	return "acmt.010.001.01"
+}
+
+func (d *Document01000101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01000101) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000101) Variant() string {
+	return "001"
+}
+
+func (d *Document01000101) Version() string {
+	return "01"
+}
+
+func (d *Document01000101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountRequestAcknowledgement message is sent from a financial institution to an organisation. This message is sent in response to a request message from the organisation. It is sent after the request has been validated from an authentication and authorization point of view. The business content has not yet been validated at this stage.
 // Usage
@@ -39,6 +74,10 @@ type AccountRequestAcknowledgementV01 struct {
 	DigitalSignature []*model.PartyAndSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *AccountRequestAcknowledgementV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountRequestAcknowledgementV01) AddReferences() *model.References5 {
 	a.References = new(model.References5)
 	return a.References
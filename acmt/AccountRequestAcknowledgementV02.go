@@ -16,6 +16,41 @@ func (d *Document01000102) AddMessage() *AccountRequestAcknowledgementV02 {
 	return d.Message
 }
 
+func (d *Document01000102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.010.001.02"
+}
+
+func (d *Document01000102) MessageDefinitionIdentifier() string {
+	return "acmt.010.001.02"
+}
+
+func (d *Document01000102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01000102) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000102) Variant() string {
+	return "001"
+}
+
+func (d *Document01000102) Version() string {
+	return "02"
+}
+
+func (d *Document01000102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AccountRequestAcknowledgement message is sent from a financial institution to an organisation. This message is sent in response to a request message from the organisation. It is sent after the request has been validated from an authentication and authorization point of view. The business content has not yet been validated at this stage.
 type AccountRequestAcknowledgementV02 struct {
 
@@ -42,6 +77,10 @@ type AccountRequestAcknowledgementV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountRequestAcknowledgementV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountRequestAcknowledgementV02) AddReferences() *model.References5 {
 	a.References = new(model.References5)
 	return a.References
@@ -16,6 +16,41 @@ func (d *Document01100101) AddMessage() *AccountRequestRejectionV01 {
 	return d.Message
 }
 
+func (d *Document01100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.011.001.01"
+}
+
+func (d *Document01100101) MessageDefinitionIdentifier() string {
+	return "acmt.011.001.01"
+}
+
+func (d *Document01100101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01100101) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100101) Variant() string {
+	return "001"
+}
+
+func (d *Document01100101) Version() string {
+	return "01"
+}
+
+func (d *Document01100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountRequestRejection message is sent from a financial institution to an organisation. This message is sent in response to a request message from the organisation, if the business content is not valid.
 // Usage
@@ -37,6 +72,10 @@ type AccountRequestRejectionV01 struct {
 	DigitalSignature []*model.PartyAndSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *AccountRequestRejectionV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountRequestRejectionV01) AddReferences() *model.References6 {
 	a.References = new(model.References6)
 	return a.References
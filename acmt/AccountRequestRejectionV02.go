@@ -16,6 +16,41 @@ func (d *Document01100102) AddMessage() *AccountRequestRejectionV02 {
 	return d.Message
 }
 
+func (d *Document01100102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.011.001.02"
+}
+
+func (d *Document01100102) MessageDefinitionIdentifier() string {
+	return "acmt.011.001.02"
+}
+
+func (d *Document01100102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document01100102) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100102) Variant() string {
+	return "001"
+}
+
+func (d *Document01100102) Version() string {
+	return "02"
+}
+
+func (d *Document01100102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AccountRequestRejection message is sent from a financial institution to an organisation. This message is sent in response to a request message from the organisation, if the business content is not valid.
 type AccountRequestRejectionV02 struct {
 
@@ -41,6 +76,10 @@ type AccountRequestRejectionV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountRequestRejectionV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountRequestRejectionV02) AddReferences() *model.References6 {
 	a.References = new(model.References6)
 	return a.References
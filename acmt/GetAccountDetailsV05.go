@@ -16,6 +16,41 @@ func (d *Document00400105) AddMessage() *GetAccountDetailsV05 {
 	return d.Message
 }
 
+func (d *Document00400105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.004.001.05"
+}
+
+func (d *Document00400105) MessageDefinitionIdentifier() string {
+	return "acmt.004.001.05"
+}
+
+func (d *Document00400105) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00400105) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400105) Variant() string {
+	return "001"
+}
+
+func (d *Document00400105) Version() string {
+	return "05"
+}
+
+func (d *Document00400105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The GetAccountDetails message is sent by an account owner, for example, an investor or its designated agent to the account servicer, for example, a registrar, transfer agent, custodian bank or securities depository to query the details of an existing account.
 // Usage
@@ -35,6 +70,10 @@ type GetAccountDetailsV05 struct {
 	SelectedInformationType *model.InvestmentAccountInformationType1 `xml:"SelctdInfTp"`
 }
 
+func (g *GetAccountDetailsV05) Validate() error {
+	return model.ValidateElement(g)
+}
+
 func (g *GetAccountDetailsV05) AddMessageIdentification() *model.MessageIdentification1 {
 	g.MessageIdentification = new(model.MessageIdentification1)
 	return g.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document02200101) AddMessage() *IdentificationModificationAdviceV01 {
 	return d.Message
 }
 
+func (d *Document02200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.022.001.01"
+}
+
+func (d *Document02200101) MessageDefinitionIdentifier() string {
+	return "acmt.022.001.01"
+}
+
+func (d *Document02200101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document02200101) MessageFunctionality() string {
+	return "022"
+}
+
+func (d *Document02200101) Variant() string {
+	return "001"
+}
+
+func (d *Document02200101) Version() string {
+	return "01"
+}
+
+func (d *Document02200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The IdentificationModificationAdvice message is sent by an assigner to an assignee. The message is used to advice on the correct party and/or account identification information.
 // Usage
@@ -35,6 +70,10 @@ type IdentificationModificationAdviceV01 struct {
 	Modification []*model.IdentificationModification1 `xml:"Mod"`
 }
 
+func (i *IdentificationModificationAdviceV01) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *IdentificationModificationAdviceV01) AddAssignment() *model.IdentificationAssignment1 {
 	i.Assignment = new(model.IdentificationAssignment1)
 	return i.Assignment
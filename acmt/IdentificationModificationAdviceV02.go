@@ -16,6 +16,41 @@ func (d *Document02200102) AddMessage() *IdentificationModificationAdviceV02 {
 	return d.Message
 }
 
+func (d *Document02200102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.022.001.02"
+}
+
+func (d *Document02200102) MessageDefinitionIdentifier() string {
+	return "acmt.022.001.02"
+}
+
+func (d *Document02200102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document02200102) MessageFunctionality() string {
+	return "022"
+}
+
+func (d *Document02200102) Variant() string {
+	return "001"
+}
+
+func (d *Document02200102) Version() string {
+	return "02"
+}
+
+func (d *Document02200102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02200102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The IdentificationModificationAdvice message is sent by an assigner to an assignee. The message is used to advice on the correct party and/or account identification information.
 // Usage
@@ -38,6 +73,10 @@ type IdentificationModificationAdviceV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *IdentificationModificationAdviceV02) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *IdentificationModificationAdviceV02) AddAssignment() *model.IdentificationAssignment2 {
 	i.Assignment = new(model.IdentificationAssignment2)
 	return i.Assignment
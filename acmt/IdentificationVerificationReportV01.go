@@ -16,6 +16,41 @@ func (d *Document02400101) AddMessage() *IdentificationVerificationReportV01 {
 	return d.Message
 }
 
+func (d *Document02400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.024.001.01"
+}
+
+func (d *Document02400101) MessageDefinitionIdentifier() string {
+	return "acmt.024.001.01"
+}
+
+func (d *Document02400101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document02400101) MessageFunctionality() string {
+	return "024"
+}
+
+func (d *Document02400101) Variant() string {
+	return "001"
+}
+
+func (d *Document02400101) Version() string {
+	return "01"
+}
+
+func (d *Document02400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The IdentificationVerificationReport message is sent by an assigner to an assignee. It is used to confirm whether or not the presented party and/or account identification information is correct.
 // Usage
@@ -35,6 +70,10 @@ type IdentificationVerificationReportV01 struct {
 	Report []*model.VerificationReport1 `xml:"Rpt"`
 }
 
+func (i *IdentificationVerificationReportV01) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *IdentificationVerificationReportV01) AddAssignment() *model.IdentificationAssignment1 {
 	i.Assignment = new(model.IdentificationAssignment1)
 	return i.Assignment
@@ -16,6 +16,41 @@ func (d *Document02400102) AddMessage() *IdentificationVerificationReportV02 {
 	return d.Message
 }
 
+func (d *Document02400102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.024.001.02"
+}
+
+func (d *Document02400102) MessageDefinitionIdentifier() string {
+	return "acmt.024.001.02"
+}
+
+func (d *Document02400102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document02400102) MessageFunctionality() string {
+	return "024"
+}
+
+func (d *Document02400102) Variant() string {
+	return "001"
+}
+
+func (d *Document02400102) Version() string {
+	return "02"
+}
+
+func (d *Document02400102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02400102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The IdentificationVerificationReport message is sent by an assigner to an assignee. It is used to confirm whether or not the presented party and/or account identification information is correct.
 // Usage
@@ -38,6 +73,10 @@ type IdentificationVerificationReportV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *IdentificationVerificationReportV02) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *IdentificationVerificationReportV02) AddAssignment() *model.IdentificationAssignment2 {
 	i.Assignment = new(model.IdentificationAssignment2)
 	return i.Assignment
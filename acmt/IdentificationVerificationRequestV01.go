@@ -16,6 +16,41 @@ func (d *Document02300101) AddMessage() *IdentificationVerificationRequestV01 {
 	return d.Message
 }
 
+func (d *Document02300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.023.001.01"
+}
+
+func (d *Document02300101) MessageDefinitionIdentifier() string {
+	return "acmt.023.001.01"
+}
+
+func (d *Document02300101) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document02300101) MessageFunctionality() string {
+	return "023"
+}
+
+func (d *Document02300101) Variant() string {
+	return "001"
+}
+
+func (d *Document02300101) Version() string {
+	return "01"
+}
+
+func (d *Document02300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The IdentificationVerificationRequest message is sent by an assigner to an assignee. It is used to request the verification of party and/or account identification information.
 // Usage
@@ -30,6 +65,10 @@ type IdentificationVerificationRequestV01 struct {
 	Verification []*model.IdentificationVerification1 `xml:"Vrfctn"`
 }
 
+func (i *IdentificationVerificationRequestV01) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *IdentificationVerificationRequestV01) AddAssignment() *model.IdentificationAssignment1 {
 	i.Assignment = new(model.IdentificationAssignment1)
 	return i.Assignment
@@ -16,6 +16,41 @@ func (d *Document02300102) AddMessage() *IdentificationVerificationRequestV02 {
 	return d.Message
 }
 
+func (d *Document02300102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.023.001.02"
+}
+
+func (d *Document02300102) MessageDefinitionIdentifier() string {
+	return "acmt.023.001.02"
+}
+
+func (d *Document02300102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document02300102) MessageFunctionality() string {
+	return "023"
+}
+
+func (d *Document02300102) Variant() string {
+	return "001"
+}
+
+func (d *Document02300102) Version() string {
+	return "02"
+}
+
+func (d *Document02300102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02300102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The IdentificationVerificationRequest message is sent by an assigner to an assignee. It is used to request the verification of party and/or account identification information.
 // Usage
@@ -33,6 +68,10 @@ type IdentificationVerificationRequestV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *IdentificationVerificationRequestV02) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *IdentificationVerificationRequestV02) AddAssignment() *model.IdentificationAssignment2 {
 	i.Assignment = new(model.IdentificationAssignment2)
 	return i.Assignment
@@ -16,6 +16,41 @@ func (d *Document00500102) AddMessage() *RequestForAccountManagementStatusReport
 	return d.Message
 }
 
+func (d *Document00500102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.005.001.02"
+}
+
+func (d *Document00500102) MessageDefinitionIdentifier() string {
+	return "acmt.005.001.02"
+}
+
+func (d *Document00500102) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00500102) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500102) Variant() string {
+	return "001"
+}
+
+func (d *Document00500102) Version() string {
+	return "02"
+}
+
+func (d *Document00500102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account owner, for example, an investor or its designated agent, sends the RequestForAccountManagementStatusReport message to the account servicer, for example, a registrar, transfer agent or custodian bank to request the status of an AccountOpeningInstruction or an AccountModificationInstruction.
 // Usage
@@ -29,6 +64,10 @@ type RequestForAccountManagementStatusReportV02 struct {
 	RequestDetails *model.AccountManagementMessageReference1 `xml:"ReqDtls"`
 }
 
+func (r *RequestForAccountManagementStatusReportV02) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RequestForAccountManagementStatusReportV02) AddMessageIdentification() *model.MessageIdentification1 {
 	r.MessageIdentification = new(model.MessageIdentification1)
 	return r.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document00500103) AddMessage() *RequestForAccountManagementStatusReport
 	return d.Message
 }
 
+func (d *Document00500103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.005.001.03"
+}
+
+func (d *Document00500103) MessageDefinitionIdentifier() string {
+	return "acmt.005.001.03"
+}
+
+func (d *Document00500103) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00500103) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500103) Variant() string {
+	return "001"
+}
+
+func (d *Document00500103) Version() string {
+	return "03"
+}
+
+func (d *Document00500103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account owner, for example, an investor or its designated agent, sends the RequestForAccountManagementStatusReport message to the account servicer, for example, a registrar, transfer agent or custodian bank to request the status of an AccountOpeningInstruction or an AccountModificationInstruction.
 // Usage
@@ -29,6 +64,10 @@ type RequestForAccountManagementStatusReportV03 struct {
 	RequestDetails *model.AccountManagementMessageReference2 `xml:"ReqDtls"`
 }
 
+func (r *RequestForAccountManagementStatusReportV03) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RequestForAccountManagementStatusReportV03) AddMessageIdentification() *model.MessageIdentification1 {
 	r.MessageIdentification = new(model.MessageIdentification1)
 	return r.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document00500104) AddMessage() *RequestForAccountManagementStatusReport
 	return d.Message
 }
 
+func (d *Document00500104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.005.001.04"
+}
+
+func (d *Document00500104) MessageDefinitionIdentifier() string {
+	return "acmt.005.001.04"
+}
+
+func (d *Document00500104) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00500104) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500104) Variant() string {
+	return "001"
+}
+
+func (d *Document00500104) Version() string {
+	return "04"
+}
+
+func (d *Document00500104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // An account owner, for example, an investor or its designated agent, sends the RequestForAccountManagementStatusReport message to the account servicer, for example, a registrar, transfer agent, custodian bank or securities depository  to request the status of an AccountOpeningInstruction,  GetAccountDetails or an AccountModificationInstruction.
 // Usage
@@ -29,6 +64,10 @@ type RequestForAccountManagementStatusReportV04 struct {
 	RequestDetails *model.AccountManagementMessageReference3 `xml:"ReqDtls"`
 }
 
+func (r *RequestForAccountManagementStatusReportV04) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RequestForAccountManagementStatusReportV04) AddMessageIdentification() *model.MessageIdentification1 {
 	r.MessageIdentification = new(model.MessageIdentification1)
 	return r.MessageIdentification
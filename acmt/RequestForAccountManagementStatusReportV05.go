@@ -16,6 +16,41 @@ func (d *Document00500105) AddMessage() *RequestForAccountManagementStatusReport
 	return d.Message
 }
 
+func (d *Document00500105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:acmt.005.001.05"
+}
+
+func (d *Document00500105) MessageDefinitionIdentifier() string {
+	return "acmt.005.001.05"
+}
+
+func (d *Document00500105) BusinessArea() string {
+	return "acmt"
+}
+
+func (d *Document00500105) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500105) Variant() string {
+	return "001"
+}
+
+func (d *Document00500105) Version() string {
+	return "05"
+}
+
+func (d *Document00500105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The RequestForAccountManagementStatusReport message is sent by an account owner, for example, an investor or its designated agent, to the account servicer, for example, a registrar, transfer agent, custodian bank or securities depository  to request the status of an AccountOpeningInstruction,  GetAccountDetails or an AccountModificationInstruction.
 // Usage
@@ -29,6 +64,10 @@ type RequestForAccountManagementStatusReportV05 struct {
 	RequestDetails *model.AccountManagementMessageReference4 `xml:"ReqDtls"`
 }
 
+func (r *RequestForAccountManagementStatusReportV05) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RequestForAccountManagementStatusReportV05) AddMessageIdentification() *model.MessageIdentification1 {
 	r.MessageIdentification = new(model.MessageIdentification1)
 	return r.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document00200101) AddMessage() *MessageRejectV01 {
 	return d.Message
 }
 
+func (d *Document00200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:admi.002.001.01"
+}
+
+func (d *Document00200101) MessageDefinitionIdentifier() string {
+	return "admi.002.001.01"
+}
+
+func (d *Document00200101) BusinessArea() string {
+	return "admi"
+}
+
+func (d *Document00200101) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200101) Variant() string {
+	return "001"
+}
+
+func (d *Document00200101) Version() string {
+	return "01"
+}
+
+func (d *Document00200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The MessageReject message is sent by a central system to notify the rejection of a previously received message.
 // Usage
@@ -29,6 +64,10 @@ type MessageRejectV01 struct {
 	Reason *model.RejectionReason2 `xml:"Rsn"`
 }
 
+func (m *MessageRejectV01) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MessageRejectV01) AddRelatedReference() *model.MessageReference {
 	m.RelatedReference = new(model.MessageReference)
 	return m.RelatedReference
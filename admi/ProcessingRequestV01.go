@@ -16,6 +16,41 @@ func (d *Document01700101) AddMessage() *ProcessingRequestV01 {
 	return d.Message
 }
 
+func (d *Document01700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:admi.017.001.01"
+}
+
+func (d *Document01700101) MessageDefinitionIdentifier() string {
+	return "admi.017.001.01"
+}
+
+func (d *Document01700101) BusinessArea() string {
+	return "admi"
+}
+
+func (d *Document01700101) MessageFunctionality() string {
+	return "017"
+}
+
+func (d *Document01700101) Variant() string {
+	return "001"
+}
+
+func (d *Document01700101) Version() string {
+	return "01"
+}
+
+func (d *Document01700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The Processing Request message is sent by a participant to a central system to request the initiation of a system process suported by a central system.
 type ProcessingRequestV01 struct {
 
@@ -29,6 +64,10 @@ type ProcessingRequestV01 struct {
 	Request *model.RequestDetails19 `xml:"Req"`
 }
 
+func (p *ProcessingRequestV01) Validate() error {
+	return model.ValidateElement(p)
+}
+
 func (p *ProcessingRequestV01) SetMessageIdentification(value string) {
 	p.MessageIdentification = (*model.Max35Text)(&value)
 }
@@ -16,6 +16,41 @@ func (d *Document01000102) AddMessage() *StaticDataReportV02 {
 	return d.Message
 }
 
+func (d *Document01000102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:admi.010.001.02"
+}
+
+func (d *Document01000102) MessageDefinitionIdentifier() string {
+	return "admi.010.001.02"
+}
+
+func (d *Document01000102) BusinessArea() string {
+	return "admi"
+}
+
+func (d *Document01000102) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000102) Variant() string {
+	return "001"
+}
+
+func (d *Document01000102) Version() string {
+	return "02"
+}
+
+func (d *Document01000102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The StaticDataReport message is sent by a central system to the participant to provide static data held in the system.
 //
 type StaticDataReportV02 struct {
@@ -33,6 +68,10 @@ type StaticDataReportV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *StaticDataReportV02) Validate() error {
+	return model.ValidateElement(s)
+}
+
 func (s *StaticDataReportV02) SetMessageIdentification(value string) {
 	s.MessageIdentification = (*model.Max35Text)(&value)
 }
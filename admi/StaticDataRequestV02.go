@@ -16,6 +16,41 @@ func (d *Document00900102) AddMessage() *StaticDataRequestV02 {
 	return d.Message
 }
 
+func (d *Document00900102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:admi.009.001.02"
+}
+
+func (d *Document00900102) MessageDefinitionIdentifier() string {
+	return "admi.009.001.02"
+}
+
+func (d *Document00900102) BusinessArea() string {
+	return "admi"
+}
+
+func (d *Document00900102) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900102) Variant() string {
+	return "001"
+}
+
+func (d *Document00900102) Version() string {
+	return "02"
+}
+
+func (d *Document00900102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The StaticDataRequest message is sent by a participant of a central system to the central system to request a static data report.
 //
 type StaticDataRequestV02 struct {
@@ -33,6 +68,10 @@ type StaticDataRequestV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *StaticDataRequestV02) Validate() error {
+	return model.ValidateElement(s)
+}
+
 func (s *StaticDataRequestV02) SetMessageIdentification(value string) {
 	s.MessageIdentification = (*model.Max35Text)(&value)
 }
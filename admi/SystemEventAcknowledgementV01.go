@@ -16,6 +16,41 @@ func (d *Document01100101) AddMessage() *SystemEventAcknowledgementV01 {
 	return d.Message
 }
 
+func (d *Document01100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:admi.011.001.01"
+}
+
+func (d *Document01100101) MessageDefinitionIdentifier() string {
+	return "admi.011.001.01"
+}
+
+func (d *Document01100101) BusinessArea() string {
+	return "admi"
+}
+
+func (d *Document01100101) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100101) Variant() string {
+	return "001"
+}
+
+func (d *Document01100101) Version() string {
+	return "01"
+}
+
+func (d *Document01100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The SystemEventAcknowledgement message is sent by a participant of a central system to the central system to acknowledge the notification of an occurrence of an event in a central system.
 //
 type SystemEventAcknowledgementV01 struct {
@@ -36,6 +71,10 @@ type SystemEventAcknowledgementV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SystemEventAcknowledgementV01) Validate() error {
+	return model.ValidateElement(s)
+}
+
 func (s *SystemEventAcknowledgementV01) SetMessageIdentification(value string) {
 	s.MessageIdentification = (*model.Max35Text)(&value)
 }
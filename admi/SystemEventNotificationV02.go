@@ -16,6 +16,41 @@ func (d *Document00400102) AddMessage() *SystemEventNotificationV02 {
 	return d.Message
 }
 
+func (d *Document00400102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:admi.004.001.02"
+}
+
+func (d *Document00400102) MessageDefinitionIdentifier() string {
+	return "admi.004.001.02"
+}
+
+func (d *Document00400102) BusinessArea() string {
+	return "admi"
+}
+
+func (d *Document00400102) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400102) Variant() string {
+	return "001"
+}
+
+func (d *Document00400102) Version() string {
+	return "02"
+}
+
+func (d *Document00400102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The SystemEventNotification message is sent by a central system to notify the occurrence of an event in a central system.
 // Usage
@@ -26,6 +61,10 @@ type SystemEventNotificationV02 struct {
 	EventInformation *model.Event2 `xml:"EvtInf"`
 }
 
+func (s *SystemEventNotificationV02) Validate() error {
+	return model.ValidateElement(s)
+}
+
 func (s *SystemEventNotificationV02) AddEventInformation() *model.Event2 {
 	s.EventInformation = new(model.Event2)
 	return s.EventInformation
@@ -0,0 +1,173 @@
+// Package amount implements currency-aware monetary amounts on top of exact
+// decimals. Amounts know the minor units of their ISO 4217 currency, so they
+// can be validated against it and rounded to it.
+package amount
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
+// MaxTotalDigits is the maximum number of digits of an ISO 20022 amount.
+const MaxTotalDigits = 18
+
+// ErrCurrencyMismatch is reported when amounts of different currencies are combined.
+var ErrCurrencyMismatch = errors.New("amount: currency mismatch")
+
+// Error reports an amount that is not valid for its currency.
+type Error struct {
+	Value    string
+	Currency string
+	Reason   string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("amount: %s %s %s", e.Currency, e.Value, e.Reason)
+}
+
+// Amount is a decimal value in a given currency.
+type Amount struct {
+	Value    decimal.Decimal
+	Currency string
+}
+
+// New returns the amount value in currency, without validating it.
+func New(value decimal.Decimal, currency string) Amount {
+	return Amount{Value: value, Currency: currency}
+}
+
+// Parse parses an xs:decimal value and validates it against currency.
+func Parse(value, currency string) (Amount, error) {
+	if err := Validate(value, currency); err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: decimal.MustParse(value), Currency: currency}, nil
+}
+
+// Validate checks that value is an xs:decimal of at most MaxTotalDigits digits
+// and that it has no more fraction digits than the minor units of currency.
+// Trailing zeros are not significant, so 10.500 is a valid EUR amount.
+func Validate(value, currency string) error {
+	d, err := decimal.Parse(value)
+	if err != nil {
+		return &Error{Value: value, Currency: currency, Reason: "is not a decimal number"}
+	}
+	units, ok := MinorUnits(currency)
+	if !ok {
+		return &Error{Value: value, Currency: currency, Reason: "has an unknown currency"}
+	}
+	return check(value, currency, d, units)
+}
+
+func check(value, currency string, d decimal.Decimal, units int) error {
+	d = d.Normalize()
+	if units >= 0 && int(d.Scale()) > units {
+		return &Error{Value: value, Currency: currency, Reason: fmt.Sprintf("has %d fraction digits, the currency has %d", d.Scale(), units)}
+	}
+	if n := len(d.Abs().Unscaled().String()); n > MaxTotalDigits {
+		return &Error{Value: value, Currency: currency, Reason: fmt.Sprintf("has %d digits, at most %d allowed", n, MaxTotalDigits)}
+	}
+	return nil
+}
+
+// Validate checks the amount against its currency, as the package-level Validate does.
+func (a Amount) Validate() error {
+	units, ok := MinorUnits(a.Currency)
+	if !ok {
+		return &Error{Value: a.Value.String(), Currency: a.Currency, Reason: "has an unknown currency"}
+	}
+	return check(a.Value.String(), a.Currency, a.Value, units)
+}
+
+// String formats the amount as the currency followed by the value, for example EUR 10.50.
+func (a Amount) String() string {
+	return a.Currency + " " + a.Value.String()
+}
+
+// Text returns the value formatted with the minor units of the currency, as
+// expected in the amount elements of a message.
+func (a Amount) Text() string {
+	return a.Round().Value.String()
+}
+
+// Round returns the amount rounded half away from zero to the minor units of
+// its currency. Amounts in an unknown currency or a currency without minor unit
+// are returned unchanged.
+func (a Amount) Round() Amount {
+	units, ok := MinorUnits(a.Currency)
+	if !ok || units < 0 {
+		return a
+	}
+	return Amount{Value: a.Value.Round(int32(units)), Currency: a.Currency}
+}
+
+func (a Amount) same(other Amount) error {
+	if a.Currency != other.Currency {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, a.Currency, other.Currency)
+	}
+	return nil
+}
+
+// Add returns a + other. Both amounts must have the same currency.
+func (a Amount) Add(other Amount) (Amount, error) {
+	if err := a.same(other); err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: a.Value.Add(other.Value), Currency: a.Currency}, nil
+}
+
+// Sub returns a - other. Both amounts must have the same currency.
+func (a Amount) Sub(other Amount) (Amount, error) {
+	if err := a.same(other); err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: a.Value.Sub(other.Value), Currency: a.Currency}, nil
+}
+
+// Cmp compares a and other and returns -1, 0 or +1. Both amounts must have the same currency.
+func (a Amount) Cmp(other Amount) (int, error) {
+	if err := a.same(other); err != nil {
+		return 0, err
+	}
+	return a.Value.Cmp(other.Value), nil
+}
+
+// Scale returns a multiplied by factor, for example an exchange rate or a
+// percentage, rounded to the minor units of the currency.
+func (a Amount) Scale(factor decimal.Decimal) Amount {
+	return Amount{Value: a.Value.Mul(factor), Currency: a.Currency}.Round()
+}
+
+// Neg returns -a.
+func (a Amount) Neg() Amount {
+	return Amount{Value: a.Value.Neg(), Currency: a.Currency}
+}
+
+// IsZero reports whether the value of a is zero.
+func (a Amount) IsZero() bool {
+	return a.Value.IsZero()
+}
+
+// Sum returns the sum of amounts, which must all have the given currency.
+func Sum(currency string, amounts ...Amount) (Amount, error) {
+	total := Amount{Currency: currency}
+	for _, a := range amounts {
+		var err error
+		if total, err = total.Add(a); err != nil {
+			return Amount{}, err
+		}
+	}
+	return total, nil
+}
+
+// Total returns the sum of the values of amounts whatever their currency, as
+// carried by the ControlSum element of payment messages.
+func Total(amounts ...Amount) decimal.Decimal {
+	var total decimal.Decimal
+	for _, a := range amounts {
+		total = total.Add(a.Value)
+	}
+	return total
+}
@@ -0,0 +1,153 @@
+package amount
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
+func amount(value, currency string) Amount {
+	return New(decimal.MustParse(value), currency)
+}
+
+func TestValidate(t *testing.T) {
+	for _, tt := range []struct {
+		value, currency string
+		reason          string
+	}{
+		{"10.50", "EUR", ""},
+		{"10.500", "EUR", ""},
+		{"10.505", "EUR", "has 3 fraction digits, the currency has 2"},
+		{"1500", "JPY", ""},
+		{"1500.0", "JPY", ""},
+		{"1500.5", "JPY", "has 1 fraction digits, the currency has 0"},
+		{"1.125", "BHD", ""},
+		{"1.1255", "BHD", "has 4 fraction digits, the currency has 3"},
+		{"1.23456", "XAU", ""},
+		{"999999999999999999", "JPY", ""},
+		{"1000000000000000000", "JPY", "has 19 digits, at most 18 allowed"},
+		{"999999999999999.999", "BHD", ""},
+		{"1000000000000000.000", "BHD", ""},
+		{"1000000000000000.001", "BHD", "has 19 digits, at most 18 allowed"},
+		{"1.5", "ABC", "has an unknown currency"},
+		{"1,5", "EUR", "is not a decimal number"},
+	} {
+		err := Validate(tt.value, tt.currency)
+		if tt.reason == "" {
+			if err != nil {
+				t.Errorf("%s %s: %v", tt.currency, tt.value, err)
+			}
+			continue
+		}
+		var e *Error
+		if !errors.As(err, &e) || e.Reason != tt.reason {
+			t.Errorf("%s %s: got error %v, want %q", tt.currency, tt.value, err, tt.reason)
+		}
+	}
+}
+
+func TestSum(t *testing.T) {
+	for _, tt := range []struct {
+		currency string
+		values   []string
+		want     string
+		invalid  bool
+	}{
+		{"JPY", []string{"1500", "250", "1"}, "1751", false},
+		{"BHD", []string{"1.125", "0.005", "10"}, "11.130", false},
+		{"EUR", nil, "0", false},
+		// Sums are exact: past 18 significant digits the sum is no longer a
+		// valid amount.
+		{"JPY", []string{"999999999999999999", "1"}, "1000000000000000000", true},
+		{"BHD", []string{"999999999999999.999", "0.002"}, "1000000000000000.001", true},
+	} {
+		var amounts []Amount
+		for _, v := range tt.values {
+			amounts = append(amounts, amount(v, tt.currency))
+		}
+		got, err := Sum(tt.currency, amounts...)
+		if err != nil {
+			t.Fatalf("%s %v: %v", tt.currency, tt.values, err)
+		}
+		if got.Currency != tt.currency || got.Value.String() != tt.want {
+			t.Errorf("%s %v: sum %s, want %s %s", tt.currency, tt.values, got, tt.currency, tt.want)
+		}
+		if err := got.Validate(); (err != nil) != tt.invalid {
+			t.Errorf("%s %v: Validate() = %v", tt.currency, tt.values, err)
+		}
+	}
+	if _, err := Sum("JPY", amount("1", "JPY"), amount("1", "BHD")); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("sum of JPY and BHD: got error %v", err)
+	}
+}
+
+func TestTotal(t *testing.T) {
+	if got := Total(amount("1500", "JPY"), amount("1.125", "BHD"), amount("0.50", "EUR")); got.String() != "1501.625" {
+		t.Errorf("total %s, want 1501.625", got)
+	}
+}
+
+func TestCmp(t *testing.T) {
+	for _, tt := range []struct {
+		a, b Amount
+		want int
+	}{
+		{amount("1500", "JPY"), amount("1500.0", "JPY"), 0},
+		{amount("1500", "JPY"), amount("1501", "JPY"), -1},
+		{amount("1.125", "BHD"), amount("1.12", "BHD"), 1},
+		{amount("1.120", "BHD"), amount("1.12", "BHD"), 0},
+		{amount("999999999999999999", "JPY"), amount("1000000000000000000", "JPY"), -1},
+	} {
+		if got, err := tt.a.Cmp(tt.b); err != nil || got != tt.want {
+			t.Errorf("%s Cmp %s = %d, %v, want %d", tt.a, tt.b, got, err, tt.want)
+		}
+	}
+	if _, err := amount("1", "JPY").Cmp(amount("1", "BHD")); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("JPY Cmp BHD: got error %v", err)
+	}
+}
+
+func TestScale(t *testing.T) {
+	for _, tt := range []struct {
+		a      Amount
+		factor string
+		want   string
+	}{
+		{amount("1000", "JPY"), "1.0825", "1083"},
+		{amount("1000", "JPY"), "0.0005", "1"},
+		{amount("999", "JPY"), "0.5", "500"},
+		{amount("10.000", "BHD"), "0.33333", "3.333"},
+		{amount("1.001", "BHD"), "0.5", "0.501"},
+		{amount("2.5", "BHD"), "-1", "-2.500"},
+		{amount("10", "EUR"), "0.125", "1.25"},
+		// Scaling may overflow 18 digits, which Validate reports.
+		{amount("999999999999999999", "JPY"), "10", "9999999999999999990"},
+	} {
+		got := tt.a.Scale(decimal.MustParse(tt.factor))
+		if got.Currency != tt.a.Currency || got.Value.String() != tt.want {
+			t.Errorf("%s scaled by %s = %s, want %s", tt.a, tt.factor, got, tt.want)
+		}
+	}
+	if err := amount("999999999999999999", "JPY").Scale(decimal.MustParse("10")).Validate(); err == nil {
+		t.Error("amount of 19 digits is valid")
+	}
+}
+
+func TestRound(t *testing.T) {
+	for _, tt := range []struct {
+		a    Amount
+		want string
+	}{
+		{amount("1500.5", "JPY"), "1501"},
+		{amount("-1500.5", "JPY"), "-1501"},
+		{amount("1.1255", "BHD"), "1.126"},
+		{amount("1.1254", "BHD"), "1.125"},
+		{amount("1.23456", "XAU"), "1.23456"},
+		{amount("1.23456", "ABC"), "1.23456"},
+	} {
+		if got := tt.a.Round().Value.String(); got != tt.want {
+			t.Errorf("%s rounded = %s, want %s", tt.a, got, tt.want)
+		}
+	}
+}
@@ -16,6 +16,41 @@ func (d *Document02100101) AddMessage() *ContractRegistrationAmendmentRequestV01
 	return d.Message
 }
 
+func (d *Document02100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.021.001.01"
+}
+
+func (d *Document02100101) MessageDefinitionIdentifier() string {
+	return "auth.021.001.01"
+}
+
+func (d *Document02100101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document02100101) MessageFunctionality() string {
+	return "021"
+}
+
+func (d *Document02100101) Variant() string {
+	return "001"
+}
+
+func (d *Document02100101) Version() string {
+	return "01"
+}
+
+func (d *Document02100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ContractRegistrationAmendmentRequest message is sent by the reporting party to the registration agent to amend the registered contract subject to currency control.
 type ContractRegistrationAmendmentRequestV01 struct {
 
@@ -29,6 +64,10 @@ type ContractRegistrationAmendmentRequestV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *ContractRegistrationAmendmentRequestV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *ContractRegistrationAmendmentRequestV01) AddGroupHeader() *model.CurrencyControlHeader1 {
 	c.GroupHeader = new(model.CurrencyControlHeader1)
 	return c.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document02000101) AddMessage() *ContractRegistrationClosureRequestV01 {
 	return d.Message
 }
 
+func (d *Document02000101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.020.001.01"
+}
+
+func (d *Document02000101) MessageDefinitionIdentifier() string {
+	return "auth.020.001.01"
+}
+
+func (d *Document02000101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document02000101) MessageFunctionality() string {
+	return "020"
+}
+
+func (d *Document02000101) Variant() string {
+	return "001"
+}
+
+func (d *Document02000101) Version() string {
+	return "01"
+}
+
+func (d *Document02000101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02000101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ContractRegistrationClosureRequest message is sent by the reporting party to the registration agent to close the registered contract subject to currency control.
 type ContractRegistrationClosureRequestV01 struct {
 
@@ -29,6 +64,10 @@ type ContractRegistrationClosureRequestV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *ContractRegistrationClosureRequestV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *ContractRegistrationClosureRequestV01) AddGroupHeader() *model.CurrencyControlHeader1 {
 	c.GroupHeader = new(model.CurrencyControlHeader1)
 	return c.GroupHeader
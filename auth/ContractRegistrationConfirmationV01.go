@@ -16,6 +16,41 @@ func (d *Document01900101) AddMessage() *ContractRegistrationConfirmationV01 {
 	return d.Message
 }
 
+func (d *Document01900101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.019.001.01"
+}
+
+func (d *Document01900101) MessageDefinitionIdentifier() string {
+	return "auth.019.001.01"
+}
+
+func (d *Document01900101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document01900101) MessageFunctionality() string {
+	return "019"
+}
+
+func (d *Document01900101) Variant() string {
+	return "001"
+}
+
+func (d *Document01900101) Version() string {
+	return "01"
+}
+
+func (d *Document01900101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01900101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ContractRegistrationConfirmation message is sent by the registration agent to the reporting party to register the contract subject to currency control.
 type ContractRegistrationConfirmationV01 struct {
 
@@ -29,6 +64,10 @@ type ContractRegistrationConfirmationV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *ContractRegistrationConfirmationV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *ContractRegistrationConfirmationV01) AddGroupHeader() *model.CurrencyControlHeader2 {
 	c.GroupHeader = new(model.CurrencyControlHeader2)
 	return c.GroupHeader
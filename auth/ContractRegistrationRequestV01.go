@@ -16,6 +16,41 @@ func (d *Document01800101) AddMessage() *ContractRegistrationRequestV01 {
 	return d.Message
 }
 
+func (d *Document01800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.018.001.01"
+}
+
+func (d *Document01800101) MessageDefinitionIdentifier() string {
+	return "auth.018.001.01"
+}
+
+func (d *Document01800101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document01800101) MessageFunctionality() string {
+	return "018"
+}
+
+func (d *Document01800101) Variant() string {
+	return "001"
+}
+
+func (d *Document01800101) Version() string {
+	return "01"
+}
+
+func (d *Document01800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ContractRegistrationRequest message is sent by the reporting party to the registration agent to initiate the registration of a new contract subject to currency control.
 type ContractRegistrationRequestV01 struct {
 
@@ -29,6 +64,10 @@ type ContractRegistrationRequestV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *ContractRegistrationRequestV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *ContractRegistrationRequestV01) AddGroupHeader() *model.CurrencyControlHeader1 {
 	c.GroupHeader = new(model.CurrencyControlHeader1)
 	return c.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document02300101) AddMessage() *ContractRegistrationStatementRequestV01
 	return d.Message
 }
 
+func (d *Document02300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.023.001.01"
+}
+
+func (d *Document02300101) MessageDefinitionIdentifier() string {
+	return "auth.023.001.01"
+}
+
+func (d *Document02300101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document02300101) MessageFunctionality() string {
+	return "023"
+}
+
+func (d *Document02300101) Variant() string {
+	return "001"
+}
+
+func (d *Document02300101) Version() string {
+	return "01"
+}
+
+func (d *Document02300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ContractRegistrationStatementRequest message is sent by the reporting party to the registration agent to request for a statement of the operations related to the registered contract subject to currency control.
 type ContractRegistrationStatementRequestV01 struct {
 
@@ -29,6 +64,10 @@ type ContractRegistrationStatementRequestV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *ContractRegistrationStatementRequestV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *ContractRegistrationStatementRequestV01) AddGroupHeader() *model.CurrencyControlHeader1 {
 	c.GroupHeader = new(model.CurrencyControlHeader1)
 	return c.GroupHeader
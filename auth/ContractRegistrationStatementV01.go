@@ -16,6 +16,41 @@ func (d *Document02200101) AddMessage() *ContractRegistrationStatementV01 {
 	return d.Message
 }
 
+func (d *Document02200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.022.001.01"
+}
+
+func (d *Document02200101) MessageDefinitionIdentifier() string {
+	return "auth.022.001.01"
+}
+
+func (d *Document02200101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document02200101) MessageFunctionality() string {
+	return "022"
+}
+
+func (d *Document02200101) Variant() string {
+	return "001"
+}
+
+func (d *Document02200101) Version() string {
+	return "01"
+}
+
+func (d *Document02200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ContractRegistrationStatement message is sent by the registration agent to the reporting party, in response to a request or at a pre-agreed date, to send a statement of the operations related to the registered contract subject to currency control.
 type ContractRegistrationStatementV01 struct {
 
@@ -29,6 +64,10 @@ type ContractRegistrationStatementV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *ContractRegistrationStatementV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *ContractRegistrationStatementV01) AddGroupHeader() *model.CurrencyControlHeader2 {
 	c.GroupHeader = new(model.CurrencyControlHeader2)
 	return c.GroupHeader
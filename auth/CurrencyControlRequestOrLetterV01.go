@@ -16,6 +16,41 @@ func (d *Document02600101) AddMessage() *CurrencyControlRequestOrLetterV01 {
 	return d.Message
 }
 
+func (d *Document02600101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.026.001.01"
+}
+
+func (d *Document02600101) MessageDefinitionIdentifier() string {
+	return "auth.026.001.01"
+}
+
+func (d *Document02600101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document02600101) MessageFunctionality() string {
+	return "026"
+}
+
+func (d *Document02600101) Variant() string {
+	return "001"
+}
+
+func (d *Document02600101) Version() string {
+	return "01"
+}
+
+func (d *Document02600101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02600101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The CurrencyControlRequestOrLetter message is sent by the reporting party (respectively the registration agent) to the registration agent (respectively the reporting party) to send a currency control related letter or to request for supporting documents.
 type CurrencyControlRequestOrLetterV01 struct {
 
@@ -29,6 +64,10 @@ type CurrencyControlRequestOrLetterV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CurrencyControlRequestOrLetterV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CurrencyControlRequestOrLetterV01) AddGroupHeader() *model.CurrencyControlHeader3 {
 	c.GroupHeader = new(model.CurrencyControlHeader3)
 	return c.GroupHeader
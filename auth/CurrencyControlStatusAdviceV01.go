@@ -16,6 +16,41 @@ func (d *Document02700101) AddMessage() *CurrencyControlStatusAdviceV01 {
 	return d.Message
 }
 
+func (d *Document02700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.027.001.01"
+}
+
+func (d *Document02700101) MessageDefinitionIdentifier() string {
+	return "auth.027.001.01"
+}
+
+func (d *Document02700101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document02700101) MessageFunctionality() string {
+	return "027"
+}
+
+func (d *Document02700101) Variant() string {
+	return "001"
+}
+
+func (d *Document02700101) Version() string {
+	return "01"
+}
+
+func (d *Document02700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The CurrencyControlStatusAdvice message is sent by either the reporting party (respectively the registration agent or the registration agent (respectively the reporting party) to provide a status advice on a previously sent currency control message.
 //
 // Usage:
@@ -35,6 +70,10 @@ type CurrencyControlStatusAdviceV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CurrencyControlStatusAdviceV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CurrencyControlStatusAdviceV01) AddGroupHeader() *model.CurrencyControlHeader2 {
 	c.GroupHeader = new(model.CurrencyControlHeader2)
 	return c.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document02500101) AddMessage() *CurrencyControlSupportingDocumentDelive
 	return d.Message
 }
 
+func (d *Document02500101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.025.001.01"
+}
+
+func (d *Document02500101) MessageDefinitionIdentifier() string {
+	return "auth.025.001.01"
+}
+
+func (d *Document02500101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document02500101) MessageFunctionality() string {
+	return "025"
+}
+
+func (d *Document02500101) Variant() string {
+	return "001"
+}
+
+func (d *Document02500101) Version() string {
+	return "01"
+}
+
+func (d *Document02500101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02500101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The CurrencyControlSupportingDocumentDelivery message is sent by either the reporting party (respectively the registration agent or the registration agent (respectively the reporting party) in response to the supporting document request.
 type CurrencyControlSupportingDocumentDeliveryV01 struct {
 
@@ -29,6 +64,10 @@ type CurrencyControlSupportingDocumentDeliveryV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CurrencyControlSupportingDocumentDeliveryV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CurrencyControlSupportingDocumentDeliveryV01) AddGroupHeader() *model.CurrencyControlHeader3 {
 	c.GroupHeader = new(model.CurrencyControlHeader3)
 	return c.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00100101) AddMessage() *InformationRequestOpeningV01 {
 	return d.Message
 }
 
+func (d *Document00100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.001.001.01"
+}
+
+func (d *Document00100101) MessageDefinitionIdentifier() string {
+	return "auth.001.001.01"
+}
+
+func (d *Document00100101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document00100101) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100101) Variant() string {
+	return "001"
+}
+
+func (d *Document00100101) Version() string {
+	return "01"
+}
+
+func (d *Document00100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // This message is sent by the authorities (police, customs, tax authorities, enforcement authorities) to a financial institution to request account and other banking and financial information. Requested information can relate to accounts, their signatories and beneficiaries and co-owners as well as movements plus positions on these accounts.
 //
 // Requests are underpinned by specific legal texts.
@@ -43,6 +78,10 @@ type InformationRequestOpeningV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *InformationRequestOpeningV01) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *InformationRequestOpeningV01) SetInvestigationIdentification(value string) {
 	i.InvestigationIdentification = (*model.Max35Text)(&value)
 }
@@ -56,6 +95,11 @@ func (i *InformationRequestOpeningV01) SetConfidentialityStatus(value string) {
 	i.ConfidentialityStatus = (*model.YesNoIndicator)(&value)
 }
 
+func (i *InformationRequestOpeningV01) SetConfidentialityStatusFromBool(value bool) {
+	i.ConfidentialityStatus = new(model.YesNoIndicator)
+	i.ConfidentialityStatus.FromBool(value)
+}
+
 func (i *InformationRequestOpeningV01) AddDueDate() *model.DueDate1 {
 	i.DueDate = new(model.DueDate1)
 	return i.DueDate
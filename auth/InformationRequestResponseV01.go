@@ -16,6 +16,41 @@ func (d *Document00200101) AddMessage() *InformationRequestResponseV01 {
 	return d.Message
 }
 
+func (d *Document00200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.002.001.01"
+}
+
+func (d *Document00200101) MessageDefinitionIdentifier() string {
+	return "auth.002.001.01"
+}
+
+func (d *Document00200101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document00200101) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200101) Variant() string {
+	return "001"
+}
+
+func (d *Document00200101) Version() string {
+	return "01"
+}
+
+func (d *Document00200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // This message is sent by the financial institution to the authorities (police, customs, tax authorities, enforcement authorities) to provide a part or all of the requested information.
 // The financial institution previously received a request for financial information in the scope of a financial investigation.
 //
@@ -43,6 +78,10 @@ type InformationRequestResponseV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *InformationRequestResponseV01) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *InformationRequestResponseV01) SetResponseIdentification(value string) {
 	i.ResponseIdentification = (*model.Max35Text)(&value)
 }
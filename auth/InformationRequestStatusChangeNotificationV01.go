@@ -16,6 +16,41 @@ func (d *Document00300101) AddMessage() *InformationRequestStatusChangeNotificat
 	return d.Message
 }
 
+func (d *Document00300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.003.001.01"
+}
+
+func (d *Document00300101) MessageDefinitionIdentifier() string {
+	return "auth.003.001.01"
+}
+
+func (d *Document00300101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document00300101) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300101) Variant() string {
+	return "001"
+}
+
+func (d *Document00300101) Version() string {
+	return "01"
+}
+
+func (d *Document00300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // This message is sent by the authorities (police, customs, tax authorities, enforcement authorities) to a financial institution to inform the financial institution that the confidentiality status of the investigation has changed.
 type InformationRequestStatusChangeNotificationV01 struct {
 
@@ -29,6 +64,10 @@ type InformationRequestStatusChangeNotificationV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *InformationRequestStatusChangeNotificationV01) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *InformationRequestStatusChangeNotificationV01) SetOriginalBusinessQuery(value string) {
 	i.OriginalBusinessQuery = (*model.Max35Text)(&value)
 }
@@ -37,6 +76,11 @@ func (i *InformationRequestStatusChangeNotificationV01) SetConfidentialityStatus
 	i.ConfidentialityStatus = (*model.YesNoIndicator)(&value)
 }
 
+func (i *InformationRequestStatusChangeNotificationV01) SetConfidentialityStatusFromBool(value bool) {
+	i.ConfidentialityStatus = new(model.YesNoIndicator)
+	i.ConfidentialityStatus.FromBool(value)
+}
+
 func (i *InformationRequestStatusChangeNotificationV01) AddSupplementaryData() *model.SupplementaryData1 {
 	newValue := new(model.SupplementaryData1)
 	i.SupplementaryData = append(i.SupplementaryData, newValue)
@@ -16,6 +16,41 @@ func (d *Document03800101) AddMessage() *InvoiceTaxReportStatusAdviceV01 {
 	return d.Message
 }
 
+func (d *Document03800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.038.001.01"
+}
+
+func (d *Document03800101) MessageDefinitionIdentifier() string {
+	return "auth.038.001.01"
+}
+
+func (d *Document03800101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document03800101) MessageFunctionality() string {
+	return "038"
+}
+
+func (d *Document03800101) Variant() string {
+	return "001"
+}
+
+func (d *Document03800101) Version() string {
+	return "01"
+}
+
+func (d *Document03800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The InvoiceTaxReportStatusAdvice message is sent by the matching application to the party from which it received a message.
 // This message is used to acknowledge the InvoiceTaxReport message.
 type InvoiceTaxReportStatusAdviceV01 struct {
@@ -31,6 +66,10 @@ type InvoiceTaxReportStatusAdviceV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *InvoiceTaxReportStatusAdviceV01) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *InvoiceTaxReportStatusAdviceV01) AddStatusReportHeader() *model.InvoiceTaxStatusReportHeader1 {
 	i.StatusReportHeader = new(model.InvoiceTaxStatusReportHeader1)
 	return i.StatusReportHeader
@@ -16,6 +16,41 @@ func (d *Document03400101) AddMessage() *InvoiceTaxReportV01 {
 	return d.Message
 }
 
+func (d *Document03400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.034.001.01"
+}
+
+func (d *Document03400101) MessageDefinitionIdentifier() string {
+	return "auth.034.001.01"
+}
+
+func (d *Document03400101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document03400101) MessageFunctionality() string {
+	return "034"
+}
+
+func (d *Document03400101) Variant() string {
+	return "001"
+}
+
+func (d *Document03400101) Version() string {
+	return "01"
+}
+
+func (d *Document03400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The InvoiceTaxReport message is sent by tax responsible to tax authority. Tax authorities require corporates to report their sales based value added tax (VAT). This message is targeted to this reporting based on information in sales invoices and card transactions.
 type InvoiceTaxReportV01 struct {
 
@@ -29,6 +64,10 @@ type InvoiceTaxReportV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *InvoiceTaxReportV01) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *InvoiceTaxReportV01) AddInvoiceTaxReportHeader() *model.TaxReportHeader1 {
 	i.InvoiceTaxReportHeader = new(model.TaxReportHeader1)
 	return i.InvoiceTaxReportHeader
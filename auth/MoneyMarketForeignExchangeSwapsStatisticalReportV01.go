@@ -16,6 +16,41 @@ func (d *Document01400101) AddMessage() *MoneyMarketForeignExchangeSwapsStatisti
 	return d.Message
 }
 
+func (d *Document01400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.014.001.01"
+}
+
+func (d *Document01400101) MessageDefinitionIdentifier() string {
+	return "auth.014.001.01"
+}
+
+func (d *Document01400101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document01400101) MessageFunctionality() string {
+	return "014"
+}
+
+func (d *Document01400101) Variant() string {
+	return "001"
+}
+
+func (d *Document01400101) Version() string {
+	return "01"
+}
+
+func (d *Document01400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The MoneyMarketSecuredMarketStatisticalReport message is sent by the reporting agents  to the relevant competent authority, to report all daily Foreign Exchange Swaps (FX Swaps) transactions.
 type MoneyMarketForeignExchangeSwapsStatisticalReportV01 struct {
 
@@ -29,6 +64,10 @@ type MoneyMarketForeignExchangeSwapsStatisticalReportV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MoneyMarketForeignExchangeSwapsStatisticalReportV01) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MoneyMarketForeignExchangeSwapsStatisticalReportV01) AddReportHeader() *model.MoneyMarketReportHeader1 {
 	m.ReportHeader = new(model.MoneyMarketReportHeader1)
 	return m.ReportHeader
@@ -16,6 +16,41 @@ func (d *Document01500101) AddMessage() *MoneyMarketOvernightIndexSwapsStatistic
 	return d.Message
 }
 
+func (d *Document01500101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.015.001.01"
+}
+
+func (d *Document01500101) MessageDefinitionIdentifier() string {
+	return "auth.015.001.01"
+}
+
+func (d *Document01500101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document01500101) MessageFunctionality() string {
+	return "015"
+}
+
+func (d *Document01500101) Variant() string {
+	return "001"
+}
+
+func (d *Document01500101) Version() string {
+	return "01"
+}
+
+func (d *Document01500101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01500101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The MoneyMarketOvernightIndexSwapsStatisticalReport message is sent by the reporting agents to the relevant competent authority, to report the daily overnight index swaps (OIS) transactions.
 type MoneyMarketOvernightIndexSwapsStatisticalReportV01 struct {
 
@@ -29,6 +64,10 @@ type MoneyMarketOvernightIndexSwapsStatisticalReportV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MoneyMarketOvernightIndexSwapsStatisticalReportV01) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MoneyMarketOvernightIndexSwapsStatisticalReportV01) AddReportHeader() *model.MoneyMarketReportHeader1 {
 	m.ReportHeader = new(model.MoneyMarketReportHeader1)
 	return m.ReportHeader
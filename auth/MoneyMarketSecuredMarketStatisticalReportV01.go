@@ -16,6 +16,41 @@ func (d *Document01200101) AddMessage() *MoneyMarketSecuredMarketStatisticalRepo
 	return d.Message
 }
 
+func (d *Document01200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.012.001.01"
+}
+
+func (d *Document01200101) MessageDefinitionIdentifier() string {
+	return "auth.012.001.01"
+}
+
+func (d *Document01200101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document01200101) MessageFunctionality() string {
+	return "012"
+}
+
+func (d *Document01200101) Variant() string {
+	return "001"
+}
+
+func (d *Document01200101) Version() string {
+	return "01"
+}
+
+func (d *Document01200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The MoneyMarketSecuredMarketStatisticalReport message is sent by the reporting agents to the relevant competent authority, to report all relevant secured money market transactions.
 type MoneyMarketSecuredMarketStatisticalReportV01 struct {
 
@@ -29,6 +64,10 @@ type MoneyMarketSecuredMarketStatisticalReportV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MoneyMarketSecuredMarketStatisticalReportV01) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MoneyMarketSecuredMarketStatisticalReportV01) AddReportHeader() *model.MoneyMarketReportHeader1 {
 	m.ReportHeader = new(model.MoneyMarketReportHeader1)
 	return m.ReportHeader
@@ -16,6 +16,41 @@ func (d *Document02800101) AddMessage() *MoneyMarketStatisticalReportStatusAdvic
 	return d.Message
 }
 
+func (d *Document02800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.028.001.01"
+}
+
+func (d *Document02800101) MessageDefinitionIdentifier() string {
+	return "auth.028.001.01"
+}
+
+func (d *Document02800101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document02800101) MessageFunctionality() string {
+	return "028"
+}
+
+func (d *Document02800101) Variant() string {
+	return "001"
+}
+
+func (d *Document02800101) Version() string {
+	return "01"
+}
+
+func (d *Document02800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The MoneyMarketStatisticalReportStatusAdvice message is sent by the relevant competent authority to the reporting agents to provide the status on the reported transactions.
 type MoneyMarketStatisticalReportStatusAdviceV01 struct {
 
@@ -29,6 +64,10 @@ type MoneyMarketStatisticalReportStatusAdviceV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MoneyMarketStatisticalReportStatusAdviceV01) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MoneyMarketStatisticalReportStatusAdviceV01) AddStatusReportHeader() *model.MoneyMarketStatusReportHeader1 {
 	m.StatusReportHeader = new(model.MoneyMarketStatusReportHeader1)
 	return m.StatusReportHeader
@@ -16,6 +16,41 @@ func (d *Document01300101) AddMessage() *MoneyMarketUnsecuredMarketStatisticalRe
 	return d.Message
 }
 
+func (d *Document01300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.013.001.01"
+}
+
+func (d *Document01300101) MessageDefinitionIdentifier() string {
+	return "auth.013.001.01"
+}
+
+func (d *Document01300101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document01300101) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300101) Variant() string {
+	return "001"
+}
+
+func (d *Document01300101) Version() string {
+	return "01"
+}
+
+func (d *Document01300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The MoneyMarketUnsecuredMarketStatisticalReport message is sent by the reporting agents to the relevant competent authority, to report all relevant unsecured money market transactions.
 type MoneyMarketUnsecuredMarketStatisticalReportV01 struct {
 
@@ -29,6 +64,10 @@ type MoneyMarketUnsecuredMarketStatisticalReportV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MoneyMarketUnsecuredMarketStatisticalReportV01) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MoneyMarketUnsecuredMarketStatisticalReportV01) AddReportHeader() *model.MoneyMarketReportHeader1 {
 	m.ReportHeader = new(model.MoneyMarketReportHeader1)
 	return m.ReportHeader
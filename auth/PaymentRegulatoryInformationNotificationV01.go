@@ -16,6 +16,41 @@ func (d *Document02400101) AddMessage() *PaymentRegulatoryInformationNotificatio
 	return d.Message
 }
 
+func (d *Document02400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.024.001.01"
+}
+
+func (d *Document02400101) MessageDefinitionIdentifier() string {
+	return "auth.024.001.01"
+}
+
+func (d *Document02400101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document02400101) MessageFunctionality() string {
+	return "024"
+}
+
+func (d *Document02400101) Variant() string {
+	return "001"
+}
+
+func (d *Document02400101) Version() string {
+	return "01"
+}
+
+func (d *Document02400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The PaymentRegulatoryInformationNotification message is sent by the reporting party to the registration agent to provide details on the transaction details, when a payment has to be recorded against the registered currency control contract.
 //
 // In some cases, the registration agent may also sent this message to the reporting party.
@@ -31,6 +66,10 @@ type PaymentRegulatoryInformationNotificationV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentRegulatoryInformationNotificationV01) Validate() error {
+	return model.ValidateElement(p)
+}
+
 func (p *PaymentRegulatoryInformationNotificationV01) AddGroupHeader() *model.CurrencyControlHeader3 {
 	p.GroupHeader = new(model.CurrencyControlHeader3)
 	return p.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00900102) AddMessage() *RegulatoryTransactionReportCancellation
 	return d.Message
 }
 
+func (d *Document00900102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.009.001.02"
+}
+
+func (d *Document00900102) MessageDefinitionIdentifier() string {
+	return "auth.009.001.02"
+}
+
+func (d *Document00900102) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document00900102) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900102) Variant() string {
+	return "001"
+}
+
+func (d *Document00900102) Version() string {
+	return "02"
+}
+
+func (d *Document00900102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // A reporting institution, eg, an investment bank, sends the RegulatoryTransactionReportCancellationRequest to a regulator or to an intermediary (eg a reporting agent), to request a cancellation of a previously sent RegulatoryTransactionReport.
 // Usage
@@ -44,6 +79,10 @@ type RegulatoryTransactionReportCancellationRequestV02 struct {
 	CancellationByTradeReference []*model.TransactionDetails2 `xml:"CxlByTradRef"`
 }
 
+func (r *RegulatoryTransactionReportCancellationRequestV02) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RegulatoryTransactionReportCancellationRequestV02) AddIdentification() *model.DocumentIdentification8 {
 	r.Identification = new(model.DocumentIdentification8)
 	return r.Identification
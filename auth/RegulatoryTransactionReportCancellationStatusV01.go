@@ -16,6 +16,41 @@ func (d *Document01100101) AddMessage() *RegulatoryTransactionReportCancellation
 	return d.Message
 }
 
+func (d *Document01100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.011.001.01"
+}
+
+func (d *Document01100101) MessageDefinitionIdentifier() string {
+	return "auth.011.001.01"
+}
+
+func (d *Document01100101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document01100101) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100101) Variant() string {
+	return "001"
+}
+
+func (d *Document01100101) Version() string {
+	return "01"
+}
+
+func (d *Document01100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // A regulator or an intermediary sends the RegulatoryTransactionReportCancellationStatus to a reporting institution to provide the status of a RegulatoryTransactionReportCancellationRequest previously sent by the reporting institution.
 // Usage
@@ -41,6 +76,10 @@ type RegulatoryTransactionReportCancellationStatusV01 struct {
 	IndividualTransactionCancellationStatus []*model.TradeTransactionStatusAndReason2 `xml:"IndvTxCxlSts"`
 }
 
+func (r *RegulatoryTransactionReportCancellationStatusV01) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RegulatoryTransactionReportCancellationStatusV01) AddIdentification() *model.DocumentIdentification8 {
 	r.Identification = new(model.DocumentIdentification8)
 	return r.Identification
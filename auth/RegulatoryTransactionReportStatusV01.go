@@ -16,6 +16,41 @@ func (d *Document01000101) AddMessage() *RegulatoryTransactionReportStatusV01 {
 	return d.Message
 }
 
+func (d *Document01000101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.010.001.01"
+}
+
+func (d *Document01000101) MessageDefinitionIdentifier() string {
+	return "auth.010.001.01"
+}
+
+func (d *Document01000101) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document01000101) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000101) Variant() string {
+	return "001"
+}
+
+func (d *Document01000101) Version() string {
+	return "01"
+}
+
+func (d *Document01000101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // A regulator or an intermediary sends the RegulatoryTransactionReportStatus to a reporting institution to provide the status of a RegulatoryTransactionReport previously sent by the reporting institution.
 // Usage
@@ -39,6 +74,10 @@ type RegulatoryTransactionReportStatusV01 struct {
 	IndividualTransactionStatus []*model.TradeTransactionStatusAndReason1 `xml:"IndvTxSts"`
 }
 
+func (r *RegulatoryTransactionReportStatusV01) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RegulatoryTransactionReportStatusV01) AddIdentification() *model.DocumentIdentification8 {
 	r.Identification = new(model.DocumentIdentification8)
 	return r.Identification
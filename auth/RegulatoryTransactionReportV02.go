@@ -16,6 +16,41 @@ func (d *Document00800102) AddMessage() *RegulatoryTransactionReportV02 {
 	return d.Message
 }
 
+func (d *Document00800102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:auth.008.001.02"
+}
+
+func (d *Document00800102) MessageDefinitionIdentifier() string {
+	return "auth.008.001.02"
+}
+
+func (d *Document00800102) BusinessArea() string {
+	return "auth"
+}
+
+func (d *Document00800102) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800102) Variant() string {
+	return "001"
+}
+
+func (d *Document00800102) Version() string {
+	return "02"
+}
+
+func (d *Document00800102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // A reporting institution, eg, an investment bank, sends the RegulatoryTransactionReport to a regulator or an intermediary (eg a reporting agent), to report the transaction details of a trade that has been executed on or off-exchange.
 // Usage
@@ -39,6 +74,10 @@ type RegulatoryTransactionReportV02 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (r *RegulatoryTransactionReportV02) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RegulatoryTransactionReportV02) AddIdentification() *model.DocumentIdentification8 {
 	r.Identification = new(model.DocumentIdentification8)
 	return r.Identification
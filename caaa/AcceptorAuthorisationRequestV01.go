@@ -16,6 +16,41 @@ func (d *Document00100101) AddMessage() *AcceptorAuthorisationRequestV01 {
 	return d.Message
 }
 
+func (d *Document00100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.001.001.01"
+}
+
+func (d *Document00100101) MessageDefinitionIdentifier() string {
+	return "caaa.001.001.01"
+}
+
+func (d *Document00100101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00100101) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100101) Variant() string {
+	return "001"
+}
+
+func (d *Document00100101) Version() string {
+	return "01"
+}
+
+func (d *Document00100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorAuthorisationRequest message is sent by the card acceptor to the acquirer or its agent when an online authorisation is required for the card payment transaction.
 // Usage
@@ -32,6 +67,10 @@ type AcceptorAuthorisationRequestV01 struct {
 	SecurityTrailer *model.ContentInformationType3 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorAuthorisationRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationRequestV01) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
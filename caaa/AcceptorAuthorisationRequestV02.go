@@ -16,6 +16,41 @@ func (d *Document00100102) AddMessage() *AcceptorAuthorisationRequestV02 {
 	return d.Message
 }
 
+func (d *Document00100102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.001.001.02"
+}
+
+func (d *Document00100102) MessageDefinitionIdentifier() string {
+	return "caaa.001.001.02"
+}
+
+func (d *Document00100102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00100102) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100102) Variant() string {
+	return "001"
+}
+
+func (d *Document00100102) Version() string {
+	return "02"
+}
+
+func (d *Document00100102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorAuthorisationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check with the issuer (or its agent) that the account associated to the card has the resources to fund the payment. This checking will include validation of the card data and any additional transaction data provided.
 type AcceptorAuthorisationRequestV02 struct {
 
@@ -29,6 +64,10 @@ type AcceptorAuthorisationRequestV02 struct {
 	SecurityTrailer *model.ContentInformationType6 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorAuthorisationRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationRequestV02) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00100103) AddMessage() *AcceptorAuthorisationRequestV03 {
 	return d.Message
 }
 
+func (d *Document00100103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.001.001.03"
+}
+
+func (d *Document00100103) MessageDefinitionIdentifier() string {
+	return "caaa.001.001.03"
+}
+
+func (d *Document00100103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00100103) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100103) Variant() string {
+	return "001"
+}
+
+func (d *Document00100103) Version() string {
+	return "03"
+}
+
+func (d *Document00100103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorAuthorisationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check with the issuer (or its agent) that the account associated to the card has the resources to fund the payment. This checking will include validation of the card data and any additional transaction data provided.
 type AcceptorAuthorisationRequestV03 struct {
 
@@ -29,6 +64,10 @@ type AcceptorAuthorisationRequestV03 struct {
 	SecurityTrailer *model.ContentInformationType8 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorAuthorisationRequestV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationRequestV03) AddHeader() *model.Header7 {
 	a.Header = new(model.Header7)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00100104) AddMessage() *AcceptorAuthorisationRequestV04 {
 	return d.Message
 }
 
+func (d *Document00100104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.001.001.04"
+}
+
+func (d *Document00100104) MessageDefinitionIdentifier() string {
+	return "caaa.001.001.04"
+}
+
+func (d *Document00100104) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00100104) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100104) Variant() string {
+	return "001"
+}
+
+func (d *Document00100104) Version() string {
+	return "04"
+}
+
+func (d *Document00100104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorAuthorisationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check with the issuer (or its agent) that the account associated to the card has the resources to fund the payment. This checking will include validation of the card data and any additional transaction data provided.
 type AcceptorAuthorisationRequestV04 struct {
 
@@ -29,6 +64,10 @@ type AcceptorAuthorisationRequestV04 struct {
 	SecurityTrailer *model.ContentInformationType11 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorAuthorisationRequestV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationRequestV04) AddHeader() *model.Header10 {
 	a.Header = new(model.Header10)
 	return a.Header
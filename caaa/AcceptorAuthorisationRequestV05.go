@@ -16,6 +16,41 @@ func (d *Document00100105) AddMessage() *AcceptorAuthorisationRequestV05 {
 	return d.Message
 }
 
+func (d *Document00100105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.001.001.05"
+}
+
+func (d *Document00100105) MessageDefinitionIdentifier() string {
+	return "caaa.001.001.05"
+}
+
+func (d *Document00100105) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00100105) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100105) Variant() string {
+	return "001"
+}
+
+func (d *Document00100105) Version() string {
+	return "05"
+}
+
+func (d *Document00100105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorAuthorisationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check with the issuer (or its agent) that the account associated to the card has the resources to fund the payment. This checking will include validation of the card data and any additional transaction data provided.
 type AcceptorAuthorisationRequestV05 struct {
 
@@ -29,6 +64,10 @@ type AcceptorAuthorisationRequestV05 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorAuthorisationRequestV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationRequestV05) AddHeader() *model.Header30 {
 	a.Header = new(model.Header30)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00200101) AddMessage() *AcceptorAuthorisationResponseV01 {
 	return d.Message
 }
 
+func (d *Document00200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.002.001.01"
+}
+
+func (d *Document00200101) MessageDefinitionIdentifier() string {
+	return "caaa.002.001.01"
+}
+
+func (d *Document00200101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00200101) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200101) Variant() string {
+	return "001"
+}
+
+func (d *Document00200101) Version() string {
+	return "01"
+}
+
+func (d *Document00200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorAuthorisationResponse message is sent by the acquirer to inform the card acceptor of the outcome of the authorisation process. The message can be sent directly to the acceptor or through an agent.
 // Usage
@@ -35,6 +70,10 @@ type AcceptorAuthorisationResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType3 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorAuthorisationResponseV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationResponseV01) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00200102) AddMessage() *AcceptorAuthorisationResponseV02 {
 	return d.Message
 }
 
+func (d *Document00200102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.002.001.02"
+}
+
+func (d *Document00200102) MessageDefinitionIdentifier() string {
+	return "caaa.002.001.02"
+}
+
+func (d *Document00200102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00200102) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200102) Variant() string {
+	return "001"
+}
+
+func (d *Document00200102) Version() string {
+	return "02"
+}
+
+func (d *Document00200102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorAuthorisationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the result of the validation made by issuer about the payment transaction.
 type AcceptorAuthorisationResponseV02 struct {
 
@@ -29,6 +64,10 @@ type AcceptorAuthorisationResponseV02 struct {
 	SecurityTrailer *model.ContentInformationType6 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorAuthorisationResponseV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationResponseV02) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00200103) AddMessage() *AcceptorAuthorisationResponseV03 {
 	return d.Message
 }
 
+func (d *Document00200103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.002.001.03"
+}
+
+func (d *Document00200103) MessageDefinitionIdentifier() string {
+	return "caaa.002.001.03"
+}
+
+func (d *Document00200103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00200103) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200103) Variant() string {
+	return "001"
+}
+
+func (d *Document00200103) Version() string {
+	return "03"
+}
+
+func (d *Document00200103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorAuthorisationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the result of the validation made by issuer about the payment transaction.
 type AcceptorAuthorisationResponseV03 struct {
 
@@ -29,6 +64,10 @@ type AcceptorAuthorisationResponseV03 struct {
 	SecurityTrailer *model.ContentInformationType8 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorAuthorisationResponseV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationResponseV03) AddHeader() *model.Header7 {
 	a.Header = new(model.Header7)
 	return a.Header
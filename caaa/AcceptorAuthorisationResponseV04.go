@@ -16,6 +16,41 @@ func (d *Document00200104) AddMessage() *AcceptorAuthorisationResponseV04 {
 	return d.Message
 }
 
+func (d *Document00200104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.002.001.04"
+}
+
+func (d *Document00200104) MessageDefinitionIdentifier() string {
+	return "caaa.002.001.04"
+}
+
+func (d *Document00200104) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00200104) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200104) Variant() string {
+	return "001"
+}
+
+func (d *Document00200104) Version() string {
+	return "04"
+}
+
+func (d *Document00200104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorAuthorisationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the result of the validation made by issuer about the payment transaction.
 type AcceptorAuthorisationResponseV04 struct {
 
@@ -29,6 +64,10 @@ type AcceptorAuthorisationResponseV04 struct {
 	SecurityTrailer *model.ContentInformationType11 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorAuthorisationResponseV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationResponseV04) AddHeader() *model.Header10 {
 	a.Header = new(model.Header10)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00200105) AddMessage() *AcceptorAuthorisationResponseV05 {
 	return d.Message
 }
 
+func (d *Document00200105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.002.001.05"
+}
+
+func (d *Document00200105) MessageDefinitionIdentifier() string {
+	return "caaa.002.001.05"
+}
+
+func (d *Document00200105) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00200105) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200105) Variant() string {
+	return "001"
+}
+
+func (d *Document00200105) Version() string {
+	return "05"
+}
+
+func (d *Document00200105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorAuthorisationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the result of the validation made by issuer about the payment transaction.
 type AcceptorAuthorisationResponseV05 struct {
 
@@ -29,6 +64,10 @@ type AcceptorAuthorisationResponseV05 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorAuthorisationResponseV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationResponseV05) AddHeader() *model.Header30 {
 	a.Header = new(model.Header30)
 	return a.Header
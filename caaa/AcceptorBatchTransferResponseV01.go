@@ -16,6 +16,41 @@ func (d *Document01200101) AddMessage() *AcceptorBatchTransferResponseV01 {
 	return d.Message
 }
 
+func (d *Document01200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.012.001.01"
+}
+
+func (d *Document01200101) MessageDefinitionIdentifier() string {
+	return "caaa.012.001.01"
+}
+
+func (d *Document01200101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01200101) MessageFunctionality() string {
+	return "012"
+}
+
+func (d *Document01200101) Variant() string {
+	return "001"
+}
+
+func (d *Document01200101) Version() string {
+	return "01"
+}
+
+func (d *Document01200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorBatchTransferResponse message is sent by the acquirer to the card acceptor to acknowledge the proper reception of the AcceptorBatchTransfer.
 // Usage
@@ -32,6 +67,10 @@ type AcceptorBatchTransferResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType1 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorBatchTransferResponseV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorBatchTransferResponseV01) AddHeader() *model.Header3 {
 	a.Header = new(model.Header3)
 	return a.Header
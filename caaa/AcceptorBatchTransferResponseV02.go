@@ -16,6 +16,41 @@ func (d *Document01200102) AddMessage() *AcceptorBatchTransferResponseV02 {
 	return d.Message
 }
 
+func (d *Document01200102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.012.001.02"
+}
+
+func (d *Document01200102) MessageDefinitionIdentifier() string {
+	return "caaa.012.001.02"
+}
+
+func (d *Document01200102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01200102) MessageFunctionality() string {
+	return "012"
+}
+
+func (d *Document01200102) Variant() string {
+	return "001"
+}
+
+func (d *Document01200102) Version() string {
+	return "02"
+}
+
+func (d *Document01200102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01200102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorBatchTransferResponse is sent by the acquirer (or its agent) to inform the acceptor (or its agent) of the transfer in a previous AcceptorBatchTransfer of a collection of transactions.
 type AcceptorBatchTransferResponseV02 struct {
 
@@ -29,6 +64,10 @@ type AcceptorBatchTransferResponseV02 struct {
 	SecurityTrailer *model.ContentInformationType4 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorBatchTransferResponseV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorBatchTransferResponseV02) AddHeader() *model.Header3 {
 	a.Header = new(model.Header3)
 	return a.Header
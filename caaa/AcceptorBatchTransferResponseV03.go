@@ -16,6 +16,41 @@ func (d *Document01200103) AddMessage() *AcceptorBatchTransferResponseV03 {
 	return d.Message
 }
 
+func (d *Document01200103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.012.001.03"
+}
+
+func (d *Document01200103) MessageDefinitionIdentifier() string {
+	return "caaa.012.001.03"
+}
+
+func (d *Document01200103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01200103) MessageFunctionality() string {
+	return "012"
+}
+
+func (d *Document01200103) Variant() string {
+	return "001"
+}
+
+func (d *Document01200103) Version() string {
+	return "03"
+}
+
+func (d *Document01200103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01200103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorBatchTransferResponse is sent by the acquirer (or its agent) to inform the acceptor (or its agent) of the transfer in a previous AcceptorBatchTransfer of a collection of transactions.
 type AcceptorBatchTransferResponseV03 struct {
 
@@ -29,6 +64,10 @@ type AcceptorBatchTransferResponseV03 struct {
 	SecurityTrailer *model.ContentInformationType9 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorBatchTransferResponseV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorBatchTransferResponseV03) AddHeader() *model.Header3 {
 	a.Header = new(model.Header3)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01200104) AddMessage() *AcceptorBatchTransferResponseV04 {
 	return d.Message
 }
 
+func (d *Document01200104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.012.001.04"
+}
+
+func (d *Document01200104) MessageDefinitionIdentifier() string {
+	return "caaa.012.001.04"
+}
+
+func (d *Document01200104) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01200104) MessageFunctionality() string {
+	return "012"
+}
+
+func (d *Document01200104) Variant() string {
+	return "001"
+}
+
+func (d *Document01200104) Version() string {
+	return "04"
+}
+
+func (d *Document01200104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01200104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorBatchTransferResponse is sent by the acquirer (or its agent) to inform the acceptor (or its agent) of the transfer in a previous AcceptorBatchTransfer of a collection of transactions.
 type AcceptorBatchTransferResponseV04 struct {
 
@@ -29,6 +64,10 @@ type AcceptorBatchTransferResponseV04 struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorBatchTransferResponseV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorBatchTransferResponseV04) AddHeader() *model.Header12 {
 	a.Header = new(model.Header12)
 	return a.Header
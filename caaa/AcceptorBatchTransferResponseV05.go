@@ -16,6 +16,41 @@ func (d *Document01200105) AddMessage() *AcceptorBatchTransferResponseV05 {
 	return d.Message
 }
 
+func (d *Document01200105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.012.001.05"
+}
+
+func (d *Document01200105) MessageDefinitionIdentifier() string {
+	return "caaa.012.001.05"
+}
+
+func (d *Document01200105) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01200105) MessageFunctionality() string {
+	return "012"
+}
+
+func (d *Document01200105) Variant() string {
+	return "001"
+}
+
+func (d *Document01200105) Version() string {
+	return "05"
+}
+
+func (d *Document01200105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01200105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorBatchTransferResponse is sent by the acquirer (or its agent) to inform the acceptor (or its agent) of the transfer in a previous AcceptorBatchTransfer of a collection of transactions.
 type AcceptorBatchTransferResponseV05 struct {
 
@@ -29,6 +64,10 @@ type AcceptorBatchTransferResponseV05 struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorBatchTransferResponseV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorBatchTransferResponseV05) AddHeader() *model.Header25 {
 	a.Header = new(model.Header25)
 	return a.Header
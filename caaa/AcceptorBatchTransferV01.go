@@ -16,6 +16,41 @@ func (d *Document01100101) AddMessage() *AcceptorBatchTransferV01 {
 	return d.Message
 }
 
+func (d *Document01100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.011.001.01"
+}
+
+func (d *Document01100101) MessageDefinitionIdentifier() string {
+	return "caaa.011.001.01"
+}
+
+func (d *Document01100101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01100101) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100101) Variant() string {
+	return "001"
+}
+
+func (d *Document01100101) Version() string {
+	return "01"
+}
+
+func (d *Document01100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorBatchTransfer message is sent by the card acceptor to the acquirer to capture a collection of previously completed card payment transactions.
 // Usage
@@ -32,6 +67,10 @@ type AcceptorBatchTransferV01 struct {
 	SecurityTrailer *model.ContentInformationType1 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorBatchTransferV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorBatchTransferV01) AddHeader() *model.Header3 {
 	a.Header = new(model.Header3)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01100102) AddMessage() *AcceptorBatchTransferV02 {
 	return d.Message
 }
 
+func (d *Document01100102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.011.001.02"
+}
+
+func (d *Document01100102) MessageDefinitionIdentifier() string {
+	return "caaa.011.001.02"
+}
+
+func (d *Document01100102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01100102) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100102) Variant() string {
+	return "001"
+}
+
+func (d *Document01100102) Version() string {
+	return "02"
+}
+
+func (d *Document01100102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorBatchTransfer is sent by an acceptor (or its agent) to transfer the  financial data of a collection of transactions to the acquirer (or its agent).
 type AcceptorBatchTransferV02 struct {
 
@@ -29,6 +64,10 @@ type AcceptorBatchTransferV02 struct {
 	SecurityTrailer *model.ContentInformationType4 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorBatchTransferV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorBatchTransferV02) AddHeader() *model.Header3 {
 	a.Header = new(model.Header3)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01100103) AddMessage() *AcceptorBatchTransferV03 {
 	return d.Message
 }
 
+func (d *Document01100103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.011.001.03"
+}
+
+func (d *Document01100103) MessageDefinitionIdentifier() string {
+	return "caaa.011.001.03"
+}
+
+func (d *Document01100103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01100103) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100103) Variant() string {
+	return "001"
+}
+
+func (d *Document01100103) Version() string {
+	return "03"
+}
+
+func (d *Document01100103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorBatchTransfer is sent by an acceptor (or its agent) to transfer the  financial data of a collection of transactions to the acquirer (or its agent).
 type AcceptorBatchTransferV03 struct {
 
@@ -29,6 +64,10 @@ type AcceptorBatchTransferV03 struct {
 	SecurityTrailer *model.ContentInformationType9 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorBatchTransferV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorBatchTransferV03) AddHeader() *model.Header3 {
 	a.Header = new(model.Header3)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01100104) AddMessage() *AcceptorBatchTransferV04 {
 	return d.Message
 }
 
+func (d *Document01100104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.011.001.04"
+}
+
+func (d *Document01100104) MessageDefinitionIdentifier() string {
+	return "caaa.011.001.04"
+}
+
+func (d *Document01100104) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01100104) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100104) Variant() string {
+	return "001"
+}
+
+func (d *Document01100104) Version() string {
+	return "04"
+}
+
+func (d *Document01100104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorBatchTransfer is sent by an acceptor (or its agent) to transfer the  financial data of a collection of transactions to the acquirer (or its agent).
 type AcceptorBatchTransferV04 struct {
 
@@ -29,6 +64,10 @@ type AcceptorBatchTransferV04 struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorBatchTransferV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorBatchTransferV04) AddHeader() *model.Header12 {
 	a.Header = new(model.Header12)
 	return a.Header
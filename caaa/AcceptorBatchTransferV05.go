@@ -16,6 +16,41 @@ func (d *Document01100105) AddMessage() *AcceptorBatchTransferV05 {
 	return d.Message
 }
 
+func (d *Document01100105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.011.001.05"
+}
+
+func (d *Document01100105) MessageDefinitionIdentifier() string {
+	return "caaa.011.001.05"
+}
+
+func (d *Document01100105) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01100105) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100105) Variant() string {
+	return "001"
+}
+
+func (d *Document01100105) Version() string {
+	return "05"
+}
+
+func (d *Document01100105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorBatchTransfer is sent by an acceptor (or its agent) to transfer the  financial data of a collection of transactions to the acquirer (or its agent).
 type AcceptorBatchTransferV05 struct {
 
@@ -29,6 +64,10 @@ type AcceptorBatchTransferV05 struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorBatchTransferV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorBatchTransferV05) AddHeader() *model.Header25 {
 	a.Header = new(model.Header25)
 	return a.Header
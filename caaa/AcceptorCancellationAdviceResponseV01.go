@@ -16,6 +16,41 @@ func (d *Document00800101) AddMessage() *AcceptorCancellationAdviceResponseV01 {
 	return d.Message
 }
 
+func (d *Document00800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.008.001.01"
+}
+
+func (d *Document00800101) MessageDefinitionIdentifier() string {
+	return "caaa.008.001.01"
+}
+
+func (d *Document00800101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00800101) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800101) Variant() string {
+	return "001"
+}
+
+func (d *Document00800101) Version() string {
+	return "01"
+}
+
+func (d *Document00800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorCancellationAdviceResponse message is sent by the acquirer to acknowledge the proper reception of the AcceptorCancellationAdvice. The message can be sent directly to the card acceptor or through an agent.
 // Usage
@@ -32,6 +67,10 @@ type AcceptorCancellationAdviceResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType3 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationAdviceResponseV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdviceResponseV01) AddHeader() *model.Header2 {
 	a.Header = new(model.Header2)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00800102) AddMessage() *AcceptorCancellationAdviceResponseV02 {
 	return d.Message
 }
 
+func (d *Document00800102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.008.001.02"
+}
+
+func (d *Document00800102) MessageDefinitionIdentifier() string {
+	return "caaa.008.001.02"
+}
+
+func (d *Document00800102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00800102) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800102) Variant() string {
+	return "001"
+}
+
+func (d *Document00800102) Version() string {
+	return "02"
+}
+
+func (d *Document00800102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) about the notification of the payment cancellation.
 type AcceptorCancellationAdviceResponseV02 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCancellationAdviceResponseV02 struct {
 	SecurityTrailer *model.ContentInformationType6 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationAdviceResponseV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdviceResponseV02) AddHeader() *model.Header2 {
 	a.Header = new(model.Header2)
 	return a.Header
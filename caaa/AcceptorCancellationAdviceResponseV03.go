@@ -16,6 +16,41 @@ func (d *Document00800103) AddMessage() *AcceptorCancellationAdviceResponseV03 {
 	return d.Message
 }
 
+func (d *Document00800103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.008.001.03"
+}
+
+func (d *Document00800103) MessageDefinitionIdentifier() string {
+	return "caaa.008.001.03"
+}
+
+func (d *Document00800103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00800103) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800103) Variant() string {
+	return "001"
+}
+
+func (d *Document00800103) Version() string {
+	return "03"
+}
+
+func (d *Document00800103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) about the notification of the payment cancellation.
 type AcceptorCancellationAdviceResponseV03 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCancellationAdviceResponseV03 struct {
 	SecurityTrailer *model.ContentInformationType8 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationAdviceResponseV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdviceResponseV03) AddHeader() *model.Header8 {
 	a.Header = new(model.Header8)
 	return a.Header
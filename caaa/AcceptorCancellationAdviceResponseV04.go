@@ -16,6 +16,41 @@ func (d *Document00800104) AddMessage() *AcceptorCancellationAdviceResponseV04 {
 	return d.Message
 }
 
+func (d *Document00800104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.008.001.04"
+}
+
+func (d *Document00800104) MessageDefinitionIdentifier() string {
+	return "caaa.008.001.04"
+}
+
+func (d *Document00800104) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00800104) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800104) Variant() string {
+	return "001"
+}
+
+func (d *Document00800104) Version() string {
+	return "04"
+}
+
+func (d *Document00800104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) about the notification of the payment cancellation.
 type AcceptorCancellationAdviceResponseV04 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCancellationAdviceResponseV04 struct {
 	SecurityTrailer *model.ContentInformationType11 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationAdviceResponseV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdviceResponseV04) AddHeader() *model.Header11 {
 	a.Header = new(model.Header11)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00800105) AddMessage() *AcceptorCancellationAdviceResponseV05 {
 	return d.Message
 }
 
+func (d *Document00800105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.008.001.05"
+}
+
+func (d *Document00800105) MessageDefinitionIdentifier() string {
+	return "caaa.008.001.05"
+}
+
+func (d *Document00800105) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00800105) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800105) Variant() string {
+	return "001"
+}
+
+func (d *Document00800105) Version() string {
+	return "05"
+}
+
+func (d *Document00800105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) about the notification of the payment cancellation.
 type AcceptorCancellationAdviceResponseV05 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCancellationAdviceResponseV05 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorCancellationAdviceResponseV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdviceResponseV05) AddHeader() *model.Header24 {
 	a.Header = new(model.Header24)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00700101) AddMessage() *AcceptorCancellationAdviceV01 {
 	return d.Message
 }
 
+func (d *Document00700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.007.001.01"
+}
+
+func (d *Document00700101) MessageDefinitionIdentifier() string {
+	return "caaa.007.001.01"
+}
+
+func (d *Document00700101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00700101) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700101) Variant() string {
+	return "001"
+}
+
+func (d *Document00700101) Version() string {
+	return "01"
+}
+
+func (d *Document00700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorCancellationAdvice message is sent by a card acceptor to notify the cancellation of a successfully completed card payment transaction. The message can be sent directly to the acquirer or through an agent.
 // Usage
@@ -32,6 +67,10 @@ type AcceptorCancellationAdviceV01 struct {
 	SecurityTrailer *model.ContentInformationType3 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationAdviceV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdviceV01) AddHeader() *model.Header2 {
 	a.Header = new(model.Header2)
 	return a.Header
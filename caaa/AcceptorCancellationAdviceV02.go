@@ -16,6 +16,41 @@ func (d *Document00700102) AddMessage() *AcceptorCancellationAdviceV02 {
 	return d.Message
 }
 
+func (d *Document00700102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.007.001.02"
+}
+
+func (d *Document00700102) MessageDefinitionIdentifier() string {
+	return "caaa.007.001.02"
+}
+
+func (d *Document00700102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00700102) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700102) Variant() string {
+	return "001"
+}
+
+func (d *Document00700102) Version() string {
+	return "02"
+}
+
+func (d *Document00700102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the cancellation of a successfully completed transaction. The transaction has been completed without financial transfer, or the acceptor is aware that the transaction was not cleared by the acquirer.
 type AcceptorCancellationAdviceV02 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCancellationAdviceV02 struct {
 	SecurityTrailer *model.ContentInformationType6 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationAdviceV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdviceV02) AddHeader() *model.Header2 {
 	a.Header = new(model.Header2)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00700103) AddMessage() *AcceptorCancellationAdviceV03 {
 	return d.Message
 }
 
+func (d *Document00700103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.007.001.03"
+}
+
+func (d *Document00700103) MessageDefinitionIdentifier() string {
+	return "caaa.007.001.03"
+}
+
+func (d *Document00700103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00700103) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700103) Variant() string {
+	return "001"
+}
+
+func (d *Document00700103) Version() string {
+	return "03"
+}
+
+func (d *Document00700103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the cancellation of a successfully completed transaction. The transaction has been completed without financial transfer, or the acceptor is aware that the transaction was not cleared by the acquirer.
 type AcceptorCancellationAdviceV03 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCancellationAdviceV03 struct {
 	SecurityTrailer *model.ContentInformationType8 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationAdviceV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdviceV03) AddHeader() *model.Header8 {
 	a.Header = new(model.Header8)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00700104) AddMessage() *AcceptorCancellationAdviceV04 {
 	return d.Message
 }
 
+func (d *Document00700104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.007.001.04"
+}
+
+func (d *Document00700104) MessageDefinitionIdentifier() string {
+	return "caaa.007.001.04"
+}
+
+func (d *Document00700104) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00700104) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700104) Variant() string {
+	return "001"
+}
+
+func (d *Document00700104) Version() string {
+	return "04"
+}
+
+func (d *Document00700104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the cancellation of a successfully completed transaction. The transaction has been completed without financial transfer, or the acceptor is aware that the transaction was not cleared by the acquirer.
 type AcceptorCancellationAdviceV04 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCancellationAdviceV04 struct {
 	SecurityTrailer *model.ContentInformationType11 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationAdviceV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdviceV04) AddHeader() *model.Header11 {
 	a.Header = new(model.Header11)
 	return a.Header
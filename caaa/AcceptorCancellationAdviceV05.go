@@ -16,6 +16,41 @@ func (d *Document00700105) AddMessage() *AcceptorCancellationAdviceV05 {
 	return d.Message
 }
 
+func (d *Document00700105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.007.001.05"
+}
+
+func (d *Document00700105) MessageDefinitionIdentifier() string {
+	return "caaa.007.001.05"
+}
+
+func (d *Document00700105) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00700105) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700105) Variant() string {
+	return "001"
+}
+
+func (d *Document00700105) Version() string {
+	return "05"
+}
+
+func (d *Document00700105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the cancellation of a successfully completed transaction. The transaction has been completed without financial transfer, or the acceptor is aware that the transaction was not cleared by the acquirer.
 type AcceptorCancellationAdviceV05 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCancellationAdviceV05 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorCancellationAdviceV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdviceV05) AddHeader() *model.Header24 {
 	a.Header = new(model.Header24)
 	return a.Header
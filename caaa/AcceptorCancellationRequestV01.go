@@ -16,6 +16,41 @@ func (d *Document00500101) AddMessage() *AcceptorCancellationRequestV01 {
 	return d.Message
 }
 
+func (d *Document00500101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.005.001.01"
+}
+
+func (d *Document00500101) MessageDefinitionIdentifier() string {
+	return "caaa.005.001.01"
+}
+
+func (d *Document00500101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00500101) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500101) Variant() string {
+	return "001"
+}
+
+func (d *Document00500101) Version() string {
+	return "01"
+}
+
+func (d *Document00500101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorCancellationRequest message is sent by a card acceptor to cancel a successfully completed card payment transaction. The message can be sent directly to the acquirer or through an agent.
 // Usage
@@ -32,6 +67,10 @@ type AcceptorCancellationRequestV01 struct {
 	SecurityTrailer *model.ContentInformationType3 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationRequestV01) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
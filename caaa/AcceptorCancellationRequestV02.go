@@ -16,6 +16,41 @@ func (d *Document00500102) AddMessage() *AcceptorCancellationRequestV02 {
 	return d.Message
 }
 
+func (d *Document00500102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.005.001.02"
+}
+
+func (d *Document00500102) MessageDefinitionIdentifier() string {
+	return "caaa.005.001.02"
+}
+
+func (d *Document00500102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00500102) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500102) Variant() string {
+	return "001"
+}
+
+func (d *Document00500102) Version() string {
+	return "02"
+}
+
+func (d *Document00500102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to request the cancellation of a successfully completed transaction. Cancellation should only occur before the transaction has been cleared.
 //
 //
@@ -31,6 +66,10 @@ type AcceptorCancellationRequestV02 struct {
 	SecurityTrailer *model.ContentInformationType6 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationRequestV02) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00500103) AddMessage() *AcceptorCancellationRequestV03 {
 	return d.Message
 }
 
+func (d *Document00500103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.005.001.03"
+}
+
+func (d *Document00500103) MessageDefinitionIdentifier() string {
+	return "caaa.005.001.03"
+}
+
+func (d *Document00500103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00500103) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500103) Variant() string {
+	return "001"
+}
+
+func (d *Document00500103) Version() string {
+	return "03"
+}
+
+func (d *Document00500103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to request the cancellation of a successfully completed transaction. Cancellation should only occur before the transaction has been cleared.
 //
 //
@@ -31,6 +66,10 @@ type AcceptorCancellationRequestV03 struct {
 	SecurityTrailer *model.ContentInformationType8 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationRequestV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationRequestV03) AddHeader() *model.Header7 {
 	a.Header = new(model.Header7)
 	return a.Header
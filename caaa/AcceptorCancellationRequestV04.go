@@ -16,6 +16,41 @@ func (d *Document00500104) AddMessage() *AcceptorCancellationRequestV04 {
 	return d.Message
 }
 
+func (d *Document00500104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.005.001.04"
+}
+
+func (d *Document00500104) MessageDefinitionIdentifier() string {
+	return "caaa.005.001.04"
+}
+
+func (d *Document00500104) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00500104) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500104) Variant() string {
+	return "001"
+}
+
+func (d *Document00500104) Version() string {
+	return "04"
+}
+
+func (d *Document00500104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to request the cancellation of a successfully completed transaction. Cancellation should only occur before the transaction has been cleared.
 //
 //
@@ -31,6 +66,10 @@ type AcceptorCancellationRequestV04 struct {
 	SecurityTrailer *model.ContentInformationType11 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationRequestV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationRequestV04) AddHeader() *model.Header10 {
 	a.Header = new(model.Header10)
 	return a.Header
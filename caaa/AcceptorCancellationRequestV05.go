@@ -16,6 +16,41 @@ func (d *Document00500105) AddMessage() *AcceptorCancellationRequestV05 {
 	return d.Message
 }
 
+func (d *Document00500105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.005.001.05"
+}
+
+func (d *Document00500105) MessageDefinitionIdentifier() string {
+	return "caaa.005.001.05"
+}
+
+func (d *Document00500105) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00500105) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500105) Variant() string {
+	return "001"
+}
+
+func (d *Document00500105) Version() string {
+	return "05"
+}
+
+func (d *Document00500105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to request the cancellation of a successfully completed transaction. Cancellation should only occur before the transaction has been cleared.
 //
 //
@@ -31,6 +66,10 @@ type AcceptorCancellationRequestV05 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorCancellationRequestV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationRequestV05) AddHeader() *model.Header30 {
 	a.Header = new(model.Header30)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00600101) AddMessage() *AcceptorCancellationResponseV01 {
 	return d.Message
 }
 
+func (d *Document00600101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.006.001.01"
+}
+
+func (d *Document00600101) MessageDefinitionIdentifier() string {
+	return "caaa.006.001.01"
+}
+
+func (d *Document00600101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00600101) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600101) Variant() string {
+	return "001"
+}
+
+func (d *Document00600101) Version() string {
+	return "01"
+}
+
+func (d *Document00600101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorCancellationResponse message is sent by the acquirer to inform the card acceptor of the outcome of the cancellation process. The message can be sent directly to the acceptor or through an agent.
 // Usage
@@ -35,6 +70,10 @@ type AcceptorCancellationResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType3 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationResponseV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationResponseV01) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00600102) AddMessage() *AcceptorCancellationResponseV02 {
 	return d.Message
 }
 
+func (d *Document00600102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.006.001.02"
+}
+
+func (d *Document00600102) MessageDefinitionIdentifier() string {
+	return "caaa.006.001.02"
+}
+
+func (d *Document00600102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00600102) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600102) Variant() string {
+	return "001"
+}
+
+func (d *Document00600102) Version() string {
+	return "02"
+}
+
+func (d *Document00600102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the outcome of the cancellation request. If the response is positive, the acquirer has voided the financial data from the captured transaction.
 type AcceptorCancellationResponseV02 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCancellationResponseV02 struct {
 	SecurityTrailer *model.ContentInformationType6 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationResponseV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationResponseV02) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
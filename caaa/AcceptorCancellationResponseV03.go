@@ -16,6 +16,41 @@ func (d *Document00600103) AddMessage() *AcceptorCancellationResponseV03 {
 	return d.Message
 }
 
+func (d *Document00600103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.006.001.03"
+}
+
+func (d *Document00600103) MessageDefinitionIdentifier() string {
+	return "caaa.006.001.03"
+}
+
+func (d *Document00600103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00600103) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600103) Variant() string {
+	return "001"
+}
+
+func (d *Document00600103) Version() string {
+	return "03"
+}
+
+func (d *Document00600103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the outcome of the cancellation request. If the response is positive, the acquirer has voided the financial data from the captured transaction.
 type AcceptorCancellationResponseV03 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCancellationResponseV03 struct {
 	SecurityTrailer *model.ContentInformationType8 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationResponseV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationResponseV03) AddHeader() *model.Header7 {
 	a.Header = new(model.Header7)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00600104) AddMessage() *AcceptorCancellationResponseV04 {
 	return d.Message
 }
 
+func (d *Document00600104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.006.001.04"
+}
+
+func (d *Document00600104) MessageDefinitionIdentifier() string {
+	return "caaa.006.001.04"
+}
+
+func (d *Document00600104) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00600104) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600104) Variant() string {
+	return "001"
+}
+
+func (d *Document00600104) Version() string {
+	return "04"
+}
+
+func (d *Document00600104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the outcome of the cancellation request. If the response is positive, the acquirer has voided the financial data from the captured transaction.
 type AcceptorCancellationResponseV04 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCancellationResponseV04 struct {
 	SecurityTrailer *model.ContentInformationType11 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCancellationResponseV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationResponseV04) AddHeader() *model.Header10 {
 	a.Header = new(model.Header10)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00600105) AddMessage() *AcceptorCancellationResponseV05 {
 	return d.Message
 }
 
+func (d *Document00600105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.006.001.05"
+}
+
+func (d *Document00600105) MessageDefinitionIdentifier() string {
+	return "caaa.006.001.05"
+}
+
+func (d *Document00600105) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00600105) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600105) Variant() string {
+	return "001"
+}
+
+func (d *Document00600105) Version() string {
+	return "05"
+}
+
+func (d *Document00600105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCancellationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the outcome of the cancellation request. If the response is positive, the acquirer has voided the financial data from the captured transaction.
 type AcceptorCancellationResponseV05 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCancellationResponseV05 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorCancellationResponseV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCancellationResponseV05) AddHeader() *model.Header30 {
 	a.Header = new(model.Header30)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00400101) AddMessage() *AcceptorCompletionAdviceResponseV01 {
 	return d.Message
 }
 
+func (d *Document00400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.004.001.01"
+}
+
+func (d *Document00400101) MessageDefinitionIdentifier() string {
+	return "caaa.004.001.01"
+}
+
+func (d *Document00400101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00400101) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400101) Variant() string {
+	return "001"
+}
+
+func (d *Document00400101) Version() string {
+	return "01"
+}
+
+func (d *Document00400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorCompletionAdviceResponse message is sent by the acquirer to acknowledge the proper receipt of an AcceptorCompletionAdvice. The message can be sent directly to the card acceptor or through an agent.
 // Usage
@@ -32,6 +67,10 @@ type AcceptorCompletionAdviceResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType3 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCompletionAdviceResponseV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdviceResponseV01) AddHeader() *model.Header2 {
 	a.Header = new(model.Header2)
 	return a.Header
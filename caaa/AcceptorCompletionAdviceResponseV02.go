@@ -16,6 +16,41 @@ func (d *Document00400102) AddMessage() *AcceptorCompletionAdviceResponseV02 {
 	return d.Message
 }
 
+func (d *Document00400102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.004.001.02"
+}
+
+func (d *Document00400102) MessageDefinitionIdentifier() string {
+	return "caaa.004.001.02"
+}
+
+func (d *Document00400102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00400102) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400102) Variant() string {
+	return "001"
+}
+
+func (d *Document00400102) Version() string {
+	return "02"
+}
+
+func (d *Document00400102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCompletionAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) of the outcome of the payment transaction, and the transfer the  financial data of the transaction contained in the completion advice.
 type AcceptorCompletionAdviceResponseV02 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCompletionAdviceResponseV02 struct {
 	SecurityTrailer *model.ContentInformationType6 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCompletionAdviceResponseV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdviceResponseV02) AddHeader() *model.Header2 {
 	a.Header = new(model.Header2)
 	return a.Header
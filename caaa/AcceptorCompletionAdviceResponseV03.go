@@ -16,6 +16,41 @@ func (d *Document00400103) AddMessage() *AcceptorCompletionAdviceResponseV03 {
 	return d.Message
 }
 
+func (d *Document00400103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.004.001.03"
+}
+
+func (d *Document00400103) MessageDefinitionIdentifier() string {
+	return "caaa.004.001.03"
+}
+
+func (d *Document00400103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00400103) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400103) Variant() string {
+	return "001"
+}
+
+func (d *Document00400103) Version() string {
+	return "03"
+}
+
+func (d *Document00400103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCompletionAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) of the outcome of the payment transaction, and the transfer the  financial data of the transaction contained in the completion advice.
 type AcceptorCompletionAdviceResponseV03 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCompletionAdviceResponseV03 struct {
 	SecurityTrailer *model.ContentInformationType8 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCompletionAdviceResponseV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdviceResponseV03) AddHeader() *model.Header8 {
 	a.Header = new(model.Header8)
 	return a.Header
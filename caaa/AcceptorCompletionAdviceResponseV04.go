@@ -16,6 +16,41 @@ func (d *Document00400104) AddMessage() *AcceptorCompletionAdviceResponseV04 {
 	return d.Message
 }
 
+func (d *Document00400104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.004.001.04"
+}
+
+func (d *Document00400104) MessageDefinitionIdentifier() string {
+	return "caaa.004.001.04"
+}
+
+func (d *Document00400104) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00400104) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400104) Variant() string {
+	return "001"
+}
+
+func (d *Document00400104) Version() string {
+	return "04"
+}
+
+func (d *Document00400104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCompletionAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) of the outcome of the payment transaction, and the transfer the  financial data of the transaction contained in the completion advice.
 type AcceptorCompletionAdviceResponseV04 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCompletionAdviceResponseV04 struct {
 	SecurityTrailer *model.ContentInformationType11 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCompletionAdviceResponseV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdviceResponseV04) AddHeader() *model.Header11 {
 	a.Header = new(model.Header11)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00400105) AddMessage() *AcceptorCompletionAdviceResponseV05 {
 	return d.Message
 }
 
+func (d *Document00400105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.004.001.05"
+}
+
+func (d *Document00400105) MessageDefinitionIdentifier() string {
+	return "caaa.004.001.05"
+}
+
+func (d *Document00400105) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00400105) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400105) Variant() string {
+	return "001"
+}
+
+func (d *Document00400105) Version() string {
+	return "05"
+}
+
+func (d *Document00400105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCompletionAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) of the outcome of the payment transaction, and the transfer the  financial data of the transaction contained in the completion advice.
 type AcceptorCompletionAdviceResponseV05 struct {
 
@@ -29,6 +64,10 @@ type AcceptorCompletionAdviceResponseV05 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorCompletionAdviceResponseV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdviceResponseV05) AddHeader() *model.Header24 {
 	a.Header = new(model.Header24)
 	return a.Header
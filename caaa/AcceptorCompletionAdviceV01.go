@@ -16,6 +16,41 @@ func (d *Document00300101) AddMessage() *AcceptorCompletionAdviceV01 {
 	return d.Message
 }
 
+func (d *Document00300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.003.001.01"
+}
+
+func (d *Document00300101) MessageDefinitionIdentifier() string {
+	return "caaa.003.001.01"
+}
+
+func (d *Document00300101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00300101) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300101) Variant() string {
+	return "001"
+}
+
+func (d *Document00300101) Version() string {
+	return "01"
+}
+
+func (d *Document00300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorCompletionAdvice message is sent by a card acceptor to notify an acquirer about the completion and final outcome of a card payment transaction. The message can be sent directly to the acquirer or through an agent.
 // Usage
@@ -35,6 +70,10 @@ type AcceptorCompletionAdviceV01 struct {
 	SecurityTrailer *model.ContentInformationType3 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCompletionAdviceV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdviceV01) AddHeader() *model.Header2 {
 	a.Header = new(model.Header2)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00300102) AddMessage() *AcceptorCompletionAdviceV02 {
 	return d.Message
 }
 
+func (d *Document00300102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.003.001.02"
+}
+
+func (d *Document00300102) MessageDefinitionIdentifier() string {
+	return "caaa.003.001.02"
+}
+
+func (d *Document00300102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00300102) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300102) Variant() string {
+	return "001"
+}
+
+func (d *Document00300102) Version() string {
+	return "02"
+}
+
+func (d *Document00300102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCompletionAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the outcome of the payment at the acceptor, and to transfer the  financial data of the transaction to the acquirer (capture).
 // A AcceptorCompletionAdvice message is also sent to reverse an approved authorisation and any associated financial transfer (capture), if the card payment transaction could not be completed successfully.
 type AcceptorCompletionAdviceV02 struct {
@@ -30,6 +65,10 @@ type AcceptorCompletionAdviceV02 struct {
 	SecurityTrailer *model.ContentInformationType6 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCompletionAdviceV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdviceV02) AddHeader() *model.Header2 {
 	a.Header = new(model.Header2)
 	return a.Header
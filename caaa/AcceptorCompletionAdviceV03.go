@@ -16,6 +16,41 @@ func (d *Document00300103) AddMessage() *AcceptorCompletionAdviceV03 {
 	return d.Message
 }
 
+func (d *Document00300103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.003.001.03"
+}
+
+func (d *Document00300103) MessageDefinitionIdentifier() string {
+	return "caaa.003.001.03"
+}
+
+func (d *Document00300103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00300103) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300103) Variant() string {
+	return "001"
+}
+
+func (d *Document00300103) Version() string {
+	return "03"
+}
+
+func (d *Document00300103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCompletionAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the outcome of the payment at the acceptor, and to transfer the  financial data of the transaction to the acquirer (capture).
 // A AcceptorCompletionAdvice message is also sent to reverse an approved authorisation and any associated financial transfer (capture), if the card payment transaction could not be completed successfully.
 type AcceptorCompletionAdviceV03 struct {
@@ -30,6 +65,10 @@ type AcceptorCompletionAdviceV03 struct {
 	SecurityTrailer *model.ContentInformationType8 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCompletionAdviceV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdviceV03) AddHeader() *model.Header8 {
 	a.Header = new(model.Header8)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00300104) AddMessage() *AcceptorCompletionAdviceV04 {
 	return d.Message
 }
 
+func (d *Document00300104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.003.001.04"
+}
+
+func (d *Document00300104) MessageDefinitionIdentifier() string {
+	return "caaa.003.001.04"
+}
+
+func (d *Document00300104) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00300104) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300104) Variant() string {
+	return "001"
+}
+
+func (d *Document00300104) Version() string {
+	return "04"
+}
+
+func (d *Document00300104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCompletionAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the outcome of the payment at the acceptor, and to transfer the  financial data of the transaction to the acquirer (capture).
 // A AcceptorCompletionAdvice message is also sent to reverse an approved authorisation and any associated financial transfer (capture), if the card payment transaction could not be completed successfully.
 type AcceptorCompletionAdviceV04 struct {
@@ -30,6 +65,10 @@ type AcceptorCompletionAdviceV04 struct {
 	SecurityTrailer *model.ContentInformationType11 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCompletionAdviceV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdviceV04) AddHeader() *model.Header11 {
 	a.Header = new(model.Header11)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00300105) AddMessage() *AcceptorCompletionAdviceV05 {
 	return d.Message
 }
 
+func (d *Document00300105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.003.001.05"
+}
+
+func (d *Document00300105) MessageDefinitionIdentifier() string {
+	return "caaa.003.001.05"
+}
+
+func (d *Document00300105) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00300105) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300105) Variant() string {
+	return "001"
+}
+
+func (d *Document00300105) Version() string {
+	return "05"
+}
+
+func (d *Document00300105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCompletionAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the outcome of the payment at the acceptor, and to transfer the  financial data of the transaction to the acquirer (capture).
 // A AcceptorCompletionAdvice message is also sent to reverse an approved authorisation and any associated financial transfer (capture), if the card payment transaction could not be completed successfully.
 type AcceptorCompletionAdviceV05 struct {
@@ -30,6 +65,10 @@ type AcceptorCompletionAdviceV05 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorCompletionAdviceV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdviceV05) AddHeader() *model.Header24 {
 	a.Header = new(model.Header24)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01600101) AddMessage() *AcceptorCurrencyConversionRequestV01 {
 	return d.Message
 }
 
+func (d *Document01600101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.016.001.01"
+}
+
+func (d *Document01600101) MessageDefinitionIdentifier() string {
+	return "caaa.016.001.01"
+}
+
+func (d *Document01600101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01600101) MessageFunctionality() string {
+	return "016"
+}
+
+func (d *Document01600101) Variant() string {
+	return "001"
+}
+
+func (d *Document01600101) Version() string {
+	return "01"
+}
+
+func (d *Document01600101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01600101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCurrencyConversionRequest message is sent by the card acceptor to the currency conversion service provider to request if the cardholder is able to pay in the currency of its card.
 //
 type AcceptorCurrencyConversionRequestV01 struct {
@@ -30,6 +65,10 @@ type AcceptorCurrencyConversionRequestV01 struct {
 	SecurityTrailer *model.ContentInformationType8 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCurrencyConversionRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCurrencyConversionRequestV01) AddHeader() *model.Header7 {
 	a.Header = new(model.Header7)
 	return a.Header
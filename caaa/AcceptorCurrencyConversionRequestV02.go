@@ -16,6 +16,41 @@ func (d *Document01600102) AddMessage() *AcceptorCurrencyConversionRequestV02 {
 	return d.Message
 }
 
+func (d *Document01600102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.016.001.02"
+}
+
+func (d *Document01600102) MessageDefinitionIdentifier() string {
+	return "caaa.016.001.02"
+}
+
+func (d *Document01600102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01600102) MessageFunctionality() string {
+	return "016"
+}
+
+func (d *Document01600102) Variant() string {
+	return "001"
+}
+
+func (d *Document01600102) Version() string {
+	return "02"
+}
+
+func (d *Document01600102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01600102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCurrencyConversionRequest message is sent by the card acceptor to the currency conversion service provider to request if the cardholder is able to pay in the currency of its card.
 //
 type AcceptorCurrencyConversionRequestV02 struct {
@@ -30,6 +65,10 @@ type AcceptorCurrencyConversionRequestV02 struct {
 	SecurityTrailer *model.ContentInformationType11 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCurrencyConversionRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCurrencyConversionRequestV02) AddHeader() *model.Header10 {
 	a.Header = new(model.Header10)
 	return a.Header
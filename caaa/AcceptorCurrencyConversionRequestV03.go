@@ -16,6 +16,41 @@ func (d *Document01600103) AddMessage() *AcceptorCurrencyConversionRequestV03 {
 	return d.Message
 }
 
+func (d *Document01600103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.016.001.03"
+}
+
+func (d *Document01600103) MessageDefinitionIdentifier() string {
+	return "caaa.016.001.03"
+}
+
+func (d *Document01600103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01600103) MessageFunctionality() string {
+	return "016"
+}
+
+func (d *Document01600103) Variant() string {
+	return "001"
+}
+
+func (d *Document01600103) Version() string {
+	return "03"
+}
+
+func (d *Document01600103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01600103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCurrencyConversionRequest message is sent by the card acceptor to the currency conversion service provider to request if the cardholder is able to pay in the currency of its card.
 //
 type AcceptorCurrencyConversionRequestV03 struct {
@@ -30,6 +65,10 @@ type AcceptorCurrencyConversionRequestV03 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorCurrencyConversionRequestV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCurrencyConversionRequestV03) AddHeader() *model.Header30 {
 	a.Header = new(model.Header30)
 	return a.Header
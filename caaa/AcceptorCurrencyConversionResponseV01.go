@@ -16,6 +16,41 @@ func (d *Document01700101) AddMessage() *AcceptorCurrencyConversionResponseV01 {
 	return d.Message
 }
 
+func (d *Document01700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.017.001.01"
+}
+
+func (d *Document01700101) MessageDefinitionIdentifier() string {
+	return "caaa.017.001.01"
+}
+
+func (d *Document01700101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01700101) MessageFunctionality() string {
+	return "017"
+}
+
+func (d *Document01700101) Variant() string {
+	return "001"
+}
+
+func (d *Document01700101) Version() string {
+	return "01"
+}
+
+func (d *Document01700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCurrencyConversionResponse message is sent by currency conversion service provider to the card acceptor to return the result of a potential currency conversion for the cardholder.
 //
 type AcceptorCurrencyConversionResponseV01 struct {
@@ -30,6 +65,10 @@ type AcceptorCurrencyConversionResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType8 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCurrencyConversionResponseV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCurrencyConversionResponseV01) AddHeader() *model.Header7 {
 	a.Header = new(model.Header7)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01700102) AddMessage() *AcceptorCurrencyConversionResponseV02 {
 	return d.Message
 }
 
+func (d *Document01700102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.017.001.02"
+}
+
+func (d *Document01700102) MessageDefinitionIdentifier() string {
+	return "caaa.017.001.02"
+}
+
+func (d *Document01700102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01700102) MessageFunctionality() string {
+	return "017"
+}
+
+func (d *Document01700102) Variant() string {
+	return "001"
+}
+
+func (d *Document01700102) Version() string {
+	return "02"
+}
+
+func (d *Document01700102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01700102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCurrencyConversionResponse message is sent by currency conversion service provider to the card acceptor to return the result of a potential currency conversion for the cardholder.
 //
 type AcceptorCurrencyConversionResponseV02 struct {
@@ -30,6 +65,10 @@ type AcceptorCurrencyConversionResponseV02 struct {
 	SecurityTrailer *model.ContentInformationType11 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorCurrencyConversionResponseV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCurrencyConversionResponseV02) AddHeader() *model.Header10 {
 	a.Header = new(model.Header10)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01700103) AddMessage() *AcceptorCurrencyConversionResponseV03 {
 	return d.Message
 }
 
+func (d *Document01700103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.017.001.03"
+}
+
+func (d *Document01700103) MessageDefinitionIdentifier() string {
+	return "caaa.017.001.03"
+}
+
+func (d *Document01700103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01700103) MessageFunctionality() string {
+	return "017"
+}
+
+func (d *Document01700103) Variant() string {
+	return "001"
+}
+
+func (d *Document01700103) Version() string {
+	return "03"
+}
+
+func (d *Document01700103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01700103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorCurrencyConversionResponse message is sent by currency conversion service provider to the card acceptor to return the result of a potential currency conversion for the cardholder.
 //
 type AcceptorCurrencyConversionResponseV03 struct {
@@ -30,6 +65,10 @@ type AcceptorCurrencyConversionResponseV03 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorCurrencyConversionResponseV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorCurrencyConversionResponseV03) AddHeader() *model.Header30 {
 	a.Header = new(model.Header30)
 	return a.Header
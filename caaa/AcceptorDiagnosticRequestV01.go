@@ -16,6 +16,41 @@ func (d *Document01300101) AddMessage() *AcceptorDiagnosticRequestV01 {
 	return d.Message
 }
 
+func (d *Document01300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.013.001.01"
+}
+
+func (d *Document01300101) MessageDefinitionIdentifier() string {
+	return "caaa.013.001.01"
+}
+
+func (d *Document01300101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01300101) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300101) Variant() string {
+	return "001"
+}
+
+func (d *Document01300101) Version() string {
+	return "01"
+}
+
+func (d *Document01300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorDiagnosticRequest message is sent by the card acceptor to the acquirer to ensure the availability of the acquirer. An agent never forwards the message.
 // Usage
@@ -35,6 +70,10 @@ type AcceptorDiagnosticRequestV01 struct {
 	SecurityTrailer *model.ContentInformationType3 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorDiagnosticRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticRequestV01) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01300102) AddMessage() *AcceptorDiagnosticRequestV02 {
 	return d.Message
 }
 
+func (d *Document01300102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.013.001.02"
+}
+
+func (d *Document01300102) MessageDefinitionIdentifier() string {
+	return "caaa.013.001.02"
+}
+
+func (d *Document01300102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01300102) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300102) Variant() string {
+	return "001"
+}
+
+func (d *Document01300102) Version() string {
+	return "02"
+}
+
+func (d *Document01300102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorDiagnosticRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check the end-to-end communication, to test the availability of this acquirer, or to validate the security environment.
 type AcceptorDiagnosticRequestV02 struct {
 
@@ -29,6 +64,10 @@ type AcceptorDiagnosticRequestV02 struct {
 	SecurityTrailer *model.ContentInformationType6 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorDiagnosticRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticRequestV02) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01300103) AddMessage() *AcceptorDiagnosticRequestV03 {
 	return d.Message
 }
 
+func (d *Document01300103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.013.001.03"
+}
+
+func (d *Document01300103) MessageDefinitionIdentifier() string {
+	return "caaa.013.001.03"
+}
+
+func (d *Document01300103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01300103) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300103) Variant() string {
+	return "001"
+}
+
+func (d *Document01300103) Version() string {
+	return "03"
+}
+
+func (d *Document01300103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorDiagnosticRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check the end-to-end communication, to test the availability of this acquirer, or to validate the security environment.
 type AcceptorDiagnosticRequestV03 struct {
 
@@ -29,6 +64,10 @@ type AcceptorDiagnosticRequestV03 struct {
 	SecurityTrailer *model.ContentInformationType8 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorDiagnosticRequestV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticRequestV03) AddHeader() *model.Header7 {
 	a.Header = new(model.Header7)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01300104) AddMessage() *AcceptorDiagnosticRequestV04 {
 	return d.Message
 }
 
+func (d *Document01300104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.013.001.04"
+}
+
+func (d *Document01300104) MessageDefinitionIdentifier() string {
+	return "caaa.013.001.04"
+}
+
+func (d *Document01300104) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01300104) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300104) Variant() string {
+	return "001"
+}
+
+func (d *Document01300104) Version() string {
+	return "04"
+}
+
+func (d *Document01300104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorDiagnosticRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check the end-to-end communication, to test the availability of this acquirer, or to validate the security environment.
 type AcceptorDiagnosticRequestV04 struct {
 
@@ -29,6 +64,10 @@ type AcceptorDiagnosticRequestV04 struct {
 	SecurityTrailer *model.ContentInformationType11 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorDiagnosticRequestV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticRequestV04) AddHeader() *model.Header10 {
 	a.Header = new(model.Header10)
 	return a.Header
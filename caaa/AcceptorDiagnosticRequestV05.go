@@ -16,6 +16,41 @@ func (d *Document01300105) AddMessage() *AcceptorDiagnosticRequestV05 {
 	return d.Message
 }
 
+func (d *Document01300105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.013.001.05"
+}
+
+func (d *Document01300105) MessageDefinitionIdentifier() string {
+	return "caaa.013.001.05"
+}
+
+func (d *Document01300105) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01300105) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300105) Variant() string {
+	return "001"
+}
+
+func (d *Document01300105) Version() string {
+	return "05"
+}
+
+func (d *Document01300105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorDiagnosticRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check the end-to-end communication, to test the availability of this acquirer, or to validate the security environment.
 type AcceptorDiagnosticRequestV05 struct {
 
@@ -29,6 +64,10 @@ type AcceptorDiagnosticRequestV05 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorDiagnosticRequestV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticRequestV05) AddHeader() *model.Header30 {
 	a.Header = new(model.Header30)
 	return a.Header
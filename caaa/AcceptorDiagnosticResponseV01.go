@@ -16,6 +16,41 @@ func (d *Document01400101) AddMessage() *AcceptorDiagnosticResponseV01 {
 	return d.Message
 }
 
+func (d *Document01400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.014.001.01"
+}
+
+func (d *Document01400101) MessageDefinitionIdentifier() string {
+	return "caaa.014.001.01"
+}
+
+func (d *Document01400101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01400101) MessageFunctionality() string {
+	return "014"
+}
+
+func (d *Document01400101) Variant() string {
+	return "001"
+}
+
+func (d *Document01400101) Version() string {
+	return "01"
+}
+
+func (d *Document01400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorDiagnosticResponse message is sent by the acquirer to the card acceptor to confirm the availability of the acquirer. An agent never forwards the message.
 // Usage
@@ -35,6 +70,10 @@ type AcceptorDiagnosticResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType3 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorDiagnosticResponseV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticResponseV01) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01400102) AddMessage() *AcceptorDiagnosticResponseV02 {
 	return d.Message
 }
 
+func (d *Document01400102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.014.001.02"
+}
+
+func (d *Document01400102) MessageDefinitionIdentifier() string {
+	return "caaa.014.001.02"
+}
+
+func (d *Document01400102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01400102) MessageFunctionality() string {
+	return "014"
+}
+
+func (d *Document01400102) Variant() string {
+	return "001"
+}
+
+func (d *Document01400102) Version() string {
+	return "02"
+}
+
+func (d *Document01400102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01400102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorDiagnosticResponse message is sent by the acquirer (or its agent) to provide to the acceptor the result of the diagnostic request.
 type AcceptorDiagnosticResponseV02 struct {
 
@@ -29,6 +64,10 @@ type AcceptorDiagnosticResponseV02 struct {
 	SecurityTrailer *model.ContentInformationType6 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorDiagnosticResponseV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticResponseV02) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01400103) AddMessage() *AcceptorDiagnosticResponseV03 {
 	return d.Message
 }
 
+func (d *Document01400103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.014.001.03"
+}
+
+func (d *Document01400103) MessageDefinitionIdentifier() string {
+	return "caaa.014.001.03"
+}
+
+func (d *Document01400103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01400103) MessageFunctionality() string {
+	return "014"
+}
+
+func (d *Document01400103) Variant() string {
+	return "001"
+}
+
+func (d *Document01400103) Version() string {
+	return "03"
+}
+
+func (d *Document01400103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01400103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorDiagnosticResponse message is sent by the acquirer (or its agent) to provide to the acceptor the result of the diagnostic request.
 type AcceptorDiagnosticResponseV03 struct {
 
@@ -29,6 +64,10 @@ type AcceptorDiagnosticResponseV03 struct {
 	SecurityTrailer *model.ContentInformationType8 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorDiagnosticResponseV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticResponseV03) AddHeader() *model.Header7 {
 	a.Header = new(model.Header7)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01400104) AddMessage() *AcceptorDiagnosticResponseV04 {
 	return d.Message
 }
 
+func (d *Document01400104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.014.001.04"
+}
+
+func (d *Document01400104) MessageDefinitionIdentifier() string {
+	return "caaa.014.001.04"
+}
+
+func (d *Document01400104) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01400104) MessageFunctionality() string {
+	return "014"
+}
+
+func (d *Document01400104) Variant() string {
+	return "001"
+}
+
+func (d *Document01400104) Version() string {
+	return "04"
+}
+
+func (d *Document01400104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01400104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorDiagnosticResponse message is sent by the acquirer (or its agent) to provide to the acceptor the result of the diagnostic request.
 type AcceptorDiagnosticResponseV04 struct {
 
@@ -29,6 +64,10 @@ type AcceptorDiagnosticResponseV04 struct {
 	SecurityTrailer *model.ContentInformationType11 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorDiagnosticResponseV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticResponseV04) AddHeader() *model.Header10 {
 	a.Header = new(model.Header10)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01400105) AddMessage() *AcceptorDiagnosticResponseV05 {
 	return d.Message
 }
 
+func (d *Document01400105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.014.001.05"
+}
+
+func (d *Document01400105) MessageDefinitionIdentifier() string {
+	return "caaa.014.001.05"
+}
+
+func (d *Document01400105) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01400105) MessageFunctionality() string {
+	return "014"
+}
+
+func (d *Document01400105) Variant() string {
+	return "001"
+}
+
+func (d *Document01400105) Version() string {
+	return "05"
+}
+
+func (d *Document01400105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01400105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorDiagnosticResponse message is sent by the acquirer (or its agent) to provide to the acceptor the result of the diagnostic request.
 type AcceptorDiagnosticResponseV05 struct {
 
@@ -29,6 +64,10 @@ type AcceptorDiagnosticResponseV05 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorDiagnosticResponseV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticResponseV05) AddHeader() *model.Header30 {
 	a.Header = new(model.Header30)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00900101) AddMessage() *AcceptorReconciliationRequestV01 {
 	return d.Message
 }
 
+func (d *Document00900101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.009.001.01"
+}
+
+func (d *Document00900101) MessageDefinitionIdentifier() string {
+	return "caaa.009.001.01"
+}
+
+func (d *Document00900101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00900101) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900101) Variant() string {
+	return "001"
+}
+
+func (d *Document00900101) Version() string {
+	return "01"
+}
+
+func (d *Document00900101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorReconciliationRequest message is sent by the card acceptor to the acquirer or an agent to communicate the totals of the card payment transaction for a reconciliation period. An agent never forwards the message.
 // Usage
@@ -33,6 +68,10 @@ type AcceptorReconciliationRequestV01 struct {
 	SecurityTrailer *model.ContentInformationType3 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorReconciliationRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationRequestV01) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
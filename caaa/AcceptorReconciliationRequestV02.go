@@ -16,6 +16,41 @@ func (d *Document00900102) AddMessage() *AcceptorReconciliationRequestV02 {
 	return d.Message
 }
 
+func (d *Document00900102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.009.001.02"
+}
+
+func (d *Document00900102) MessageDefinitionIdentifier() string {
+	return "caaa.009.001.02"
+}
+
+func (d *Document00900102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00900102) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900102) Variant() string {
+	return "001"
+}
+
+func (d *Document00900102) Version() string {
+	return "02"
+}
+
+func (d *Document00900102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorReconciliationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to ensure that the debits and credits performed by the acceptor matches the computed balances of the acquirer for the debits and credits performed during the same reconciliation period.
 // If the acceptor or the acquirer notices a difference in totals, the discrepancy will be resolved by other means, outside the scope of the protocol.
 type AcceptorReconciliationRequestV02 struct {
@@ -30,6 +65,10 @@ type AcceptorReconciliationRequestV02 struct {
 	SecurityTrailer *model.ContentInformationType6 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorReconciliationRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationRequestV02) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00900103) AddMessage() *AcceptorReconciliationRequestV03 {
 	return d.Message
 }
 
+func (d *Document00900103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.009.001.03"
+}
+
+func (d *Document00900103) MessageDefinitionIdentifier() string {
+	return "caaa.009.001.03"
+}
+
+func (d *Document00900103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00900103) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900103) Variant() string {
+	return "001"
+}
+
+func (d *Document00900103) Version() string {
+	return "03"
+}
+
+func (d *Document00900103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorReconciliationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to ensure that the debits and credits performed by the acceptor matches the computed balances of the acquirer for the debits and credits performed during the same reconciliation period.
 // If the acceptor or the acquirer notices a difference in totals, the discrepancy will be resolved by other means, outside the scope of the protocol.
 type AcceptorReconciliationRequestV03 struct {
@@ -30,6 +65,10 @@ type AcceptorReconciliationRequestV03 struct {
 	SecurityTrailer *model.ContentInformationType8 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorReconciliationRequestV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationRequestV03) AddHeader() *model.Header7 {
 	a.Header = new(model.Header7)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00900104) AddMessage() *AcceptorReconciliationRequestV04 {
 	return d.Message
 }
 
+func (d *Document00900104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.009.001.04"
+}
+
+func (d *Document00900104) MessageDefinitionIdentifier() string {
+	return "caaa.009.001.04"
+}
+
+func (d *Document00900104) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00900104) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900104) Variant() string {
+	return "001"
+}
+
+func (d *Document00900104) Version() string {
+	return "04"
+}
+
+func (d *Document00900104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorReconciliationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to ensure that the debits and credits performed by the acceptor matches the computed balances of the acquirer for the debits and credits performed during the same reconciliation period.
 // If the acceptor or the acquirer notices a difference in totals, the discrepancy will be resolved by other means, outside the scope of the protocol.
 type AcceptorReconciliationRequestV04 struct {
@@ -30,6 +65,10 @@ type AcceptorReconciliationRequestV04 struct {
 	SecurityTrailer *model.ContentInformationType11 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorReconciliationRequestV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationRequestV04) AddHeader() *model.Header10 {
 	a.Header = new(model.Header10)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00900105) AddMessage() *AcceptorReconciliationRequestV05 {
 	return d.Message
 }
 
+func (d *Document00900105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.009.001.05"
+}
+
+func (d *Document00900105) MessageDefinitionIdentifier() string {
+	return "caaa.009.001.05"
+}
+
+func (d *Document00900105) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document00900105) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900105) Variant() string {
+	return "001"
+}
+
+func (d *Document00900105) Version() string {
+	return "05"
+}
+
+func (d *Document00900105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorReconciliationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to ensure that the debits and credits performed by the acceptor matches the computed balances of the acquirer for the debits and credits performed during the same reconciliation period.
 // If the acceptor or the acquirer notices a difference in totals, the discrepancy will be resolved by other means, outside the scope of the protocol.
 type AcceptorReconciliationRequestV05 struct {
@@ -30,6 +65,10 @@ type AcceptorReconciliationRequestV05 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorReconciliationRequestV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationRequestV05) AddHeader() *model.Header30 {
 	a.Header = new(model.Header30)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01000101) AddMessage() *AcceptorReconciliationResponseV01 {
 	return d.Message
 }
 
+func (d *Document01000101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.010.001.01"
+}
+
+func (d *Document01000101) MessageDefinitionIdentifier() string {
+	return "caaa.010.001.01"
+}
+
+func (d *Document01000101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01000101) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000101) Variant() string {
+	return "001"
+}
+
+func (d *Document01000101) Version() string {
+	return "01"
+}
+
+func (d *Document01000101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorReconciliationResponse message is sent by the acquirer to communicate to the card acceptor the totals of the card payment transaction performed for the reconciliation period. An agent never forwards the message.
 // Usage
@@ -32,6 +67,10 @@ type AcceptorReconciliationResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType3 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorReconciliationResponseV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationResponseV01) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
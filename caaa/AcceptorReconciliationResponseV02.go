@@ -16,6 +16,41 @@ func (d *Document01000102) AddMessage() *AcceptorReconciliationResponseV02 {
 	return d.Message
 }
 
+func (d *Document01000102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.010.001.02"
+}
+
+func (d *Document01000102) MessageDefinitionIdentifier() string {
+	return "caaa.010.001.02"
+}
+
+func (d *Document01000102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01000102) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000102) Variant() string {
+	return "001"
+}
+
+func (d *Document01000102) Version() string {
+	return "02"
+}
+
+func (d *Document01000102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorReconciliationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to ensure that the debits and credits performed by the acceptor matches the computed balances of the acquirer for the debits and credits performed during the same reconciliation period.
 // If the acceptor or the acquirer notices a difference in totals, the discrepancy will be resolved by other means, outside the scope of the protocol.
 type AcceptorReconciliationResponseV02 struct {
@@ -30,6 +65,10 @@ type AcceptorReconciliationResponseV02 struct {
 	SecurityTrailer *model.ContentInformationType6 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorReconciliationResponseV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationResponseV02) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01000103) AddMessage() *AcceptorReconciliationResponseV03 {
 	return d.Message
 }
 
+func (d *Document01000103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.010.001.03"
+}
+
+func (d *Document01000103) MessageDefinitionIdentifier() string {
+	return "caaa.010.001.03"
+}
+
+func (d *Document01000103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01000103) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000103) Variant() string {
+	return "001"
+}
+
+func (d *Document01000103) Version() string {
+	return "03"
+}
+
+func (d *Document01000103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorReconciliationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to ensure that the debits and credits performed by the acceptor matches the computed balances of the acquirer for the debits and credits performed during the same reconciliation period.
 // If the acceptor or the acquirer notices a difference in totals, the discrepancy will be resolved by other means, outside the scope of the protocol.
 type AcceptorReconciliationResponseV03 struct {
@@ -30,6 +65,10 @@ type AcceptorReconciliationResponseV03 struct {
 	SecurityTrailer *model.ContentInformationType8 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorReconciliationResponseV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationResponseV03) AddHeader() *model.Header7 {
 	a.Header = new(model.Header7)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01000105) AddMessage() *AcceptorReconciliationResponseV05 {
 	return d.Message
 }
 
+func (d *Document01000105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.010.001.05"
+}
+
+func (d *Document01000105) MessageDefinitionIdentifier() string {
+	return "caaa.010.001.05"
+}
+
+func (d *Document01000105) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01000105) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000105) Variant() string {
+	return "001"
+}
+
+func (d *Document01000105) Version() string {
+	return "05"
+}
+
+func (d *Document01000105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorReconciliationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to ensure that the debits and credits performed by the acceptor matches the computed balances of the acquirer for the debits and credits performed during the same reconciliation period.
 // If the acceptor or the acquirer notices a difference in totals, the discrepancy will be resolved by other means, outside the scope of the protocol.
 type AcceptorReconciliationResponseV05 struct {
@@ -30,6 +65,10 @@ type AcceptorReconciliationResponseV05 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorReconciliationResponseV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationResponseV05) AddHeader() *model.Header30 {
 	a.Header = new(model.Header30)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01500101) AddMessage() *AcceptorRejectionV01 {
 	return d.Message
 }
 
+func (d *Document01500101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.015.001.01"
+}
+
+func (d *Document01500101) MessageDefinitionIdentifier() string {
+	return "caaa.015.001.01"
+}
+
+func (d *Document01500101) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01500101) MessageFunctionality() string {
+	return "015"
+}
+
+func (d *Document01500101) Variant() string {
+	return "001"
+}
+
+func (d *Document01500101) Version() string {
+	return "01"
+}
+
+func (d *Document01500101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01500101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorRejection message is used by the acquirer to reject a message received from the card acceptor. The acquirer uses this message as a substitute to a response or an advice response message sent to the card acceptor.
 // Usage
@@ -29,6 +64,10 @@ type AcceptorRejectionV01 struct {
 	Reject *model.AcceptorRejection1 `xml:"Rjct"`
 }
 
+func (a *AcceptorRejectionV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorRejectionV01) AddHeader() *model.Header1 {
 	a.Header = new(model.Header1)
 	return a.Header
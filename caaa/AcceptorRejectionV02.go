@@ -16,6 +16,41 @@ func (d *Document01500102) AddMessage() *AcceptorRejectionV02 {
 	return d.Message
 }
 
+func (d *Document01500102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.015.001.02"
+}
+
+func (d *Document01500102) MessageDefinitionIdentifier() string {
+	return "caaa.015.001.02"
+}
+
+func (d *Document01500102) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01500102) MessageFunctionality() string {
+	return "015"
+}
+
+func (d *Document01500102) Variant() string {
+	return "001"
+}
+
+func (d *Document01500102) Version() string {
+	return "02"
+}
+
+func (d *Document01500102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01500102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorRejection message is sent by the acquirer (or its agent) to reject a message request or advice sent by an acceptor (or its agent), to indicate that the received message could not be processed.
 type AcceptorRejectionV02 struct {
 
@@ -26,6 +61,10 @@ type AcceptorRejectionV02 struct {
 	Reject *model.AcceptorRejection1 `xml:"Rjct"`
 }
 
+func (a *AcceptorRejectionV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorRejectionV02) AddHeader() *model.Header5 {
 	a.Header = new(model.Header5)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01500103) AddMessage() *AcceptorRejectionV03 {
 	return d.Message
 }
 
+func (d *Document01500103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.015.001.03"
+}
+
+func (d *Document01500103) MessageDefinitionIdentifier() string {
+	return "caaa.015.001.03"
+}
+
+func (d *Document01500103) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01500103) MessageFunctionality() string {
+	return "015"
+}
+
+func (d *Document01500103) Variant() string {
+	return "001"
+}
+
+func (d *Document01500103) Version() string {
+	return "03"
+}
+
+func (d *Document01500103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01500103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorRejection message is sent by the acquirer (or its agent) to reject a message request or advice sent by an acceptor (or its agent), to indicate that the received message could not be processed.
 type AcceptorRejectionV03 struct {
 
@@ -26,6 +61,10 @@ type AcceptorRejectionV03 struct {
 	Reject *model.AcceptorRejection2 `xml:"Rjct"`
 }
 
+func (a *AcceptorRejectionV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorRejectionV03) AddHeader() *model.Header9 {
 	a.Header = new(model.Header9)
 	return a.Header
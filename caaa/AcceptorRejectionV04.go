@@ -16,6 +16,41 @@ func (d *Document01500104) AddMessage() *AcceptorRejectionV04 {
 	return d.Message
 }
 
+func (d *Document01500104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.015.001.04"
+}
+
+func (d *Document01500104) MessageDefinitionIdentifier() string {
+	return "caaa.015.001.04"
+}
+
+func (d *Document01500104) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01500104) MessageFunctionality() string {
+	return "015"
+}
+
+func (d *Document01500104) Variant() string {
+	return "001"
+}
+
+func (d *Document01500104) Version() string {
+	return "04"
+}
+
+func (d *Document01500104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01500104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorRejection message is sent by the acquirer (or its agent) to reject a message request or advice sent by an acceptor (or its agent), to indicate that the received message could not be processed.
 type AcceptorRejectionV04 struct {
 
@@ -26,6 +61,10 @@ type AcceptorRejectionV04 struct {
 	Reject *model.AcceptorRejection2 `xml:"Rjct"`
 }
 
+func (a *AcceptorRejectionV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorRejectionV04) AddHeader() *model.Header13 {
 	a.Header = new(model.Header13)
 	return a.Header
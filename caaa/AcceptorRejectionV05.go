@@ -16,6 +16,41 @@ func (d *Document01500105) AddMessage() *AcceptorRejectionV05 {
 	return d.Message
 }
 
+func (d *Document01500105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caaa.015.001.05"
+}
+
+func (d *Document01500105) MessageDefinitionIdentifier() string {
+	return "caaa.015.001.05"
+}
+
+func (d *Document01500105) BusinessArea() string {
+	return "caaa"
+}
+
+func (d *Document01500105) MessageFunctionality() string {
+	return "015"
+}
+
+func (d *Document01500105) Variant() string {
+	return "001"
+}
+
+func (d *Document01500105) Version() string {
+	return "05"
+}
+
+func (d *Document01500105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01500105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcceptorRejection message is sent by the acquirer (or its agent) to reject a message request or advice sent by an acceptor (or its agent), to indicate that the received message could not be processed.
 type AcceptorRejectionV05 struct {
 
@@ -26,6 +61,10 @@ type AcceptorRejectionV05 struct {
 	Reject *model.AcceptorRejection2 `xml:"Rjct"`
 }
 
+func (a *AcceptorRejectionV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorRejectionV05) AddHeader() *model.Header26 {
 	a.Header = new(model.Header26)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00200101) AddMessage() *ATMDeviceControlV01 {
 	return d.Message
 }
 
+func (d *Document00200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.002.001.01"
+}
+
+func (d *Document00200101) MessageDefinitionIdentifier() string {
+	return "caam.002.001.01"
+}
+
+func (d *Document00200101) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00200101) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200101) Variant() string {
+	return "001"
+}
+
+func (d *Document00200101) Version() string {
+	return "01"
+}
+
+func (d *Document00200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMDeviceControl message is sent by a maintenance host to an ATM in response to an ATMDeviceReport message. The message contains a sequence of maintenance commands the ATM must perform.
 type ATMDeviceControlV01 struct {
 
@@ -32,6 +67,10 @@ type ATMDeviceControlV01 struct {
 	SecurityTrailer *model.ContentInformationType13 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMDeviceControlV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMDeviceControlV01) AddHeader() *model.Header20 {
 	a.Header = new(model.Header20)
 	return a.Header
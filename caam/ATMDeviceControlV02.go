@@ -16,6 +16,41 @@ func (d *Document00200102) AddMessage() *ATMDeviceControlV02 {
 	return d.Message
 }
 
+func (d *Document00200102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.002.001.02"
+}
+
+func (d *Document00200102) MessageDefinitionIdentifier() string {
+	return "caam.002.001.02"
+}
+
+func (d *Document00200102) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00200102) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200102) Variant() string {
+	return "001"
+}
+
+func (d *Document00200102) Version() string {
+	return "02"
+}
+
+func (d *Document00200102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMDeviceControl message is sent by a maintenance host to an ATM in response to an ATMDeviceReport message. The message contains a sequence of maintenance commands the ATM must perform.
 type ATMDeviceControlV02 struct {
 
@@ -32,6 +67,10 @@ type ATMDeviceControlV02 struct {
 	SecurityTrailer *model.ContentInformationType13 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMDeviceControlV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMDeviceControlV02) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00100101) AddMessage() *ATMDeviceReportV01 {
 	return d.Message
 }
 
+func (d *Document00100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.001.001.01"
+}
+
+func (d *Document00100101) MessageDefinitionIdentifier() string {
+	return "caam.001.001.01"
+}
+
+func (d *Document00100101) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00100101) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100101) Variant() string {
+	return "001"
+}
+
+func (d *Document00100101) Version() string {
+	return "01"
+}
+
+func (d *Document00100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMDeviceReport message is sent to an acquirer by an ATM, or forwarded by an agent, to report:
 // - The result of maintenance commands performed by the ATM,
 // - The components of the ATM,
@@ -35,6 +70,10 @@ type ATMDeviceReportV01 struct {
 	SecurityTrailer *model.ContentInformationType13 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMDeviceReportV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMDeviceReportV01) AddHeader() *model.Header20 {
 	a.Header = new(model.Header20)
 	return a.Header
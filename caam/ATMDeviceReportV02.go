@@ -16,6 +16,41 @@ func (d *Document00100102) AddMessage() *ATMDeviceReportV02 {
 	return d.Message
 }
 
+func (d *Document00100102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.001.001.02"
+}
+
+func (d *Document00100102) MessageDefinitionIdentifier() string {
+	return "caam.001.001.02"
+}
+
+func (d *Document00100102) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00100102) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100102) Variant() string {
+	return "001"
+}
+
+func (d *Document00100102) Version() string {
+	return "02"
+}
+
+func (d *Document00100102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMDeviceReport message is sent to an acquirer by an ATM, or forwarded by an agent, to report:
 // - The result of maintenance commands performed by the ATM,
 // - The components of the ATM,
@@ -35,6 +70,10 @@ type ATMDeviceReportV02 struct {
 	SecurityTrailer *model.ContentInformationType13 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMDeviceReportV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMDeviceReportV02) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
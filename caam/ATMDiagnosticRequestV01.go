@@ -16,6 +16,41 @@ func (d *Document00500101) AddMessage() *ATMDiagnosticRequestV01 {
 	return d.Message
 }
 
+func (d *Document00500101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.005.001.01"
+}
+
+func (d *Document00500101) MessageDefinitionIdentifier() string {
+	return "caam.005.001.01"
+}
+
+func (d *Document00500101) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00500101) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500101) Variant() string {
+	return "001"
+}
+
+func (d *Document00500101) Version() string {
+	return "01"
+}
+
+func (d *Document00500101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMDiagnosticRequest message is sent from an ATM to an acquirer to verify the availability of the acquirer. The acquirer will also validate that this ATM is a valid ATM for its particular network.
 type ATMDiagnosticRequestV01 struct {
 
@@ -32,6 +67,10 @@ type ATMDiagnosticRequestV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMDiagnosticRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMDiagnosticRequestV01) AddHeader() *model.Header20 {
 	a.Header = new(model.Header20)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00500102) AddMessage() *ATMDiagnosticRequestV02 {
 	return d.Message
 }
 
+func (d *Document00500102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.005.001.02"
+}
+
+func (d *Document00500102) MessageDefinitionIdentifier() string {
+	return "caam.005.001.02"
+}
+
+func (d *Document00500102) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00500102) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500102) Variant() string {
+	return "001"
+}
+
+func (d *Document00500102) Version() string {
+	return "02"
+}
+
+func (d *Document00500102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMDiagnosticRequest message is sent from an ATM to an acquirer to verify the availability of the acquirer. The acquirer will also validate that this ATM is a valid ATM for its particular network.
 type ATMDiagnosticRequestV02 struct {
 
@@ -32,6 +67,10 @@ type ATMDiagnosticRequestV02 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMDiagnosticRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMDiagnosticRequestV02) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00600101) AddMessage() *ATMDiagnosticResponseV01 {
 	return d.Message
 }
 
+func (d *Document00600101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.006.001.01"
+}
+
+func (d *Document00600101) MessageDefinitionIdentifier() string {
+	return "caam.006.001.01"
+}
+
+func (d *Document00600101) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00600101) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600101) Variant() string {
+	return "001"
+}
+
+func (d *Document00600101) Version() string {
+	return "01"
+}
+
+func (d *Document00600101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMDiagnosticResponse message is sent by an acquirer to an ATM in response to an ATMDiagnosticRequest message ensuring the availability and the validity of the parameters.
 type ATMDiagnosticResponseV01 struct {
 
@@ -32,6 +67,10 @@ type ATMDiagnosticResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMDiagnosticResponseV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMDiagnosticResponseV01) AddHeader() *model.Header20 {
 	a.Header = new(model.Header20)
 	return a.Header
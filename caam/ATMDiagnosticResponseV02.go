@@ -16,6 +16,41 @@ func (d *Document00600102) AddMessage() *ATMDiagnosticResponseV02 {
 	return d.Message
 }
 
+func (d *Document00600102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.006.001.02"
+}
+
+func (d *Document00600102) MessageDefinitionIdentifier() string {
+	return "caam.006.001.02"
+}
+
+func (d *Document00600102) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00600102) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600102) Variant() string {
+	return "001"
+}
+
+func (d *Document00600102) Version() string {
+	return "02"
+}
+
+func (d *Document00600102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMDiagnosticResponse message is sent by an acquirer to an ATM in response to an ATMDiagnosticRequest message ensuring the availability and the validity of the parameters.
 type ATMDiagnosticResponseV02 struct {
 
@@ -32,6 +67,10 @@ type ATMDiagnosticResponseV02 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMDiagnosticResponseV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMDiagnosticResponseV02) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01200101) AddMessage() *ATMExceptionAcknowledgementV01 {
 	return d.Message
 }
 
+func (d *Document01200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.012.001.01"
+}
+
+func (d *Document01200101) MessageDefinitionIdentifier() string {
+	return "caam.012.001.01"
+}
+
+func (d *Document01200101) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document01200101) MessageFunctionality() string {
+	return "012"
+}
+
+func (d *Document01200101) Variant() string {
+	return "001"
+}
+
+func (d *Document01200101) Version() string {
+	return "01"
+}
+
+func (d *Document01200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMExceptionAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMExceptionAdvice message.
 type ATMExceptionAcknowledgementV01 struct {
 
@@ -32,6 +67,10 @@ type ATMExceptionAcknowledgementV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMExceptionAcknowledgementV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMExceptionAcknowledgementV01) AddHeader() *model.Header32 {
 	a.Header = new(model.Header32)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01100101) AddMessage() *ATMExceptionAdviceV01 {
 	return d.Message
 }
 
+func (d *Document01100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.011.001.01"
+}
+
+func (d *Document01100101) MessageDefinitionIdentifier() string {
+	return "caam.011.001.01"
+}
+
+func (d *Document01100101) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document01100101) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100101) Variant() string {
+	return "001"
+}
+
+func (d *Document01100101) Version() string {
+	return "01"
+}
+
+func (d *Document01100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMExceptionAdvice message is sent by an ATM to an acquirer or its agent to inform of that an exception occurred outside a service.
 type ATMExceptionAdviceV01 struct {
 
@@ -32,6 +67,10 @@ type ATMExceptionAdviceV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMExceptionAdviceV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMExceptionAdviceV01) AddHeader() *model.Header32 {
 	a.Header = new(model.Header32)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00300101) AddMessage() *ATMKeyDownloadRequestV01 {
 	return d.Message
 }
 
+func (d *Document00300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.003.001.01"
+}
+
+func (d *Document00300101) MessageDefinitionIdentifier() string {
+	return "caam.003.001.01"
+}
+
+func (d *Document00300101) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00300101) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300101) Variant() string {
+	return "001"
+}
+
+func (d *Document00300101) Version() string {
+	return "01"
+}
+
+func (d *Document00300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMKeyDownloadRequest message is sent by an ATM to an ATM manager to initiate the download of one or several cryptographic keys.
 type ATMKeyDownloadRequestV01 struct {
 
@@ -32,6 +67,10 @@ type ATMKeyDownloadRequestV01 struct {
 	SecurityTrailer *model.ContentInformationType13 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMKeyDownloadRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMKeyDownloadRequestV01) AddHeader() *model.Header20 {
 	a.Header = new(model.Header20)
 	return a.Header
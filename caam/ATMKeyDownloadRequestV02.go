@@ -16,6 +16,41 @@ func (d *Document00300102) AddMessage() *ATMKeyDownloadRequestV02 {
 	return d.Message
 }
 
+func (d *Document00300102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.003.001.02"
+}
+
+func (d *Document00300102) MessageDefinitionIdentifier() string {
+	return "caam.003.001.02"
+}
+
+func (d *Document00300102) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00300102) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300102) Variant() string {
+	return "001"
+}
+
+func (d *Document00300102) Version() string {
+	return "02"
+}
+
+func (d *Document00300102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMKeyDownloadRequest message is sent by an ATM to an ATM manager to initiate the download of one or several cryptographic keys.
 type ATMKeyDownloadRequestV02 struct {
 
@@ -32,6 +67,10 @@ type ATMKeyDownloadRequestV02 struct {
 	SecurityTrailer *model.ContentInformationType13 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMKeyDownloadRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMKeyDownloadRequestV02) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
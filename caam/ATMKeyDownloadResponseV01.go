@@ -16,6 +16,41 @@ func (d *Document00400101) AddMessage() *ATMKeyDownloadResponseV01 {
 	return d.Message
 }
 
+func (d *Document00400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.004.001.01"
+}
+
+func (d *Document00400101) MessageDefinitionIdentifier() string {
+	return "caam.004.001.01"
+}
+
+func (d *Document00400101) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00400101) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400101) Variant() string {
+	return "001"
+}
+
+func (d *Document00400101) Version() string {
+	return "01"
+}
+
+func (d *Document00400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMKeyDownloadResponse message is sent from an acquirer to an ATM in response to an ATMKeyDownloadRequest message, to download of one or several cryptographic keys.
 type ATMKeyDownloadResponseV01 struct {
 
@@ -32,6 +67,10 @@ type ATMKeyDownloadResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType13 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMKeyDownloadResponseV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMKeyDownloadResponseV01) AddHeader() *model.Header20 {
 	a.Header = new(model.Header20)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00400102) AddMessage() *ATMKeyDownloadResponseV02 {
 	return d.Message
 }
 
+func (d *Document00400102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.004.001.02"
+}
+
+func (d *Document00400102) MessageDefinitionIdentifier() string {
+	return "caam.004.001.02"
+}
+
+func (d *Document00400102) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00400102) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400102) Variant() string {
+	return "001"
+}
+
+func (d *Document00400102) Version() string {
+	return "02"
+}
+
+func (d *Document00400102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMKeyDownloadResponse message is sent from an acquirer to an ATM in response to an ATMKeyDownloadRequest message, to download of one or several cryptographic keys.
 type ATMKeyDownloadResponseV02 struct {
 
@@ -32,6 +67,10 @@ type ATMKeyDownloadResponseV02 struct {
 	SecurityTrailer *model.ContentInformationType13 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMKeyDownloadResponseV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMKeyDownloadResponseV02) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01000101) AddMessage() *ATMReconciliationAcknowledgementV01 {
 	return d.Message
 }
 
+func (d *Document01000101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.010.001.01"
+}
+
+func (d *Document01000101) MessageDefinitionIdentifier() string {
+	return "caam.010.001.01"
+}
+
+func (d *Document01000101) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document01000101) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000101) Variant() string {
+	return "001"
+}
+
+func (d *Document01000101) Version() string {
+	return "01"
+}
+
+func (d *Document01000101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMReconciliationAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMReconciliationAdvice message.
 type ATMReconciliationAcknowledgementV01 struct {
 
@@ -32,6 +67,10 @@ type ATMReconciliationAcknowledgementV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMReconciliationAcknowledgementV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMReconciliationAcknowledgementV01) AddHeader() *model.Header21 {
 	a.Header = new(model.Header21)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01000102) AddMessage() *ATMReconciliationAcknowledgementV02 {
 	return d.Message
 }
 
+func (d *Document01000102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.010.001.02"
+}
+
+func (d *Document01000102) MessageDefinitionIdentifier() string {
+	return "caam.010.001.02"
+}
+
+func (d *Document01000102) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document01000102) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000102) Variant() string {
+	return "001"
+}
+
+func (d *Document01000102) Version() string {
+	return "02"
+}
+
+func (d *Document01000102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMReconciliationAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMReconciliationAdvice message.
 type ATMReconciliationAcknowledgementV02 struct {
 
@@ -32,6 +67,10 @@ type ATMReconciliationAcknowledgementV02 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMReconciliationAcknowledgementV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMReconciliationAcknowledgementV02) AddHeader() *model.Header32 {
 	a.Header = new(model.Header32)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00900101) AddMessage() *ATMReconciliationAdviceV01 {
 	return d.Message
 }
 
+func (d *Document00900101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.009.001.01"
+}
+
+func (d *Document00900101) MessageDefinitionIdentifier() string {
+	return "caam.009.001.01"
+}
+
+func (d *Document00900101) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00900101) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900101) Variant() string {
+	return "001"
+}
+
+func (d *Document00900101) Version() string {
+	return "01"
+}
+
+func (d *Document00900101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMReconciliationAdvice message is sent by an ATM to an acquirer or its agent to send all the counters of the ATM. It can be sent by an operator function or as a response of a command sent by an agent or a server.
 type ATMReconciliationAdviceV01 struct {
 
@@ -32,6 +67,10 @@ type ATMReconciliationAdviceV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMReconciliationAdviceV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMReconciliationAdviceV01) AddHeader() *model.Header21 {
 	a.Header = new(model.Header21)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00900102) AddMessage() *ATMReconciliationAdviceV02 {
 	return d.Message
 }
 
+func (d *Document00900102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.009.001.02"
+}
+
+func (d *Document00900102) MessageDefinitionIdentifier() string {
+	return "caam.009.001.02"
+}
+
+func (d *Document00900102) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00900102) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900102) Variant() string {
+	return "001"
+}
+
+func (d *Document00900102) Version() string {
+	return "02"
+}
+
+func (d *Document00900102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMReconciliationAdvice message is sent by an ATM to an acquirer or its agent to send all the counters of the ATM. It can be sent by an operator function or as a response of a command sent by an agent or a server.
 type ATMReconciliationAdviceV02 struct {
 
@@ -32,6 +67,10 @@ type ATMReconciliationAdviceV02 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMReconciliationAdviceV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMReconciliationAdviceV02) AddHeader() *model.Header32 {
 	a.Header = new(model.Header32)
 	return a.Header
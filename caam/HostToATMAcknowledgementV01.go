@@ -16,6 +16,41 @@ func (d *Document00800101) AddMessage() *HostToATMAcknowledgementV01 {
 	return d.Message
 }
 
+func (d *Document00800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.008.001.01"
+}
+
+func (d *Document00800101) MessageDefinitionIdentifier() string {
+	return "caam.008.001.01"
+}
+
+func (d *Document00800101) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00800101) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800101) Variant() string {
+	return "001"
+}
+
+func (d *Document00800101) Version() string {
+	return "01"
+}
+
+func (d *Document00800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The HostToATMAcknowledgement message is sent by an ATM to a host to acknowledge the receipt of a HostToATMRequest message.
 type HostToATMAcknowledgementV01 struct {
 
@@ -32,6 +67,10 @@ type HostToATMAcknowledgementV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (h *HostToATMAcknowledgementV01) Validate() error {
+	return model.ValidateElement(h)
+}
+
 func (h *HostToATMAcknowledgementV01) AddHeader() *model.Header20 {
 	h.Header = new(model.Header20)
 	return h.Header
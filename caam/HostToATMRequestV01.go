@@ -16,6 +16,41 @@ func (d *Document00700101) AddMessage() *HostToATMRequestV01 {
 	return d.Message
 }
 
+func (d *Document00700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:caam.007.001.01"
+}
+
+func (d *Document00700101) MessageDefinitionIdentifier() string {
+	return "caam.007.001.01"
+}
+
+func (d *Document00700101) BusinessArea() string {
+	return "caam"
+}
+
+func (d *Document00700101) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700101) Variant() string {
+	return "001"
+}
+
+func (d *Document00700101) Version() string {
+	return "01"
+}
+
+func (d *Document00700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The HostToATMRequest message is sent by a host to an ATM to request the ATM to contact a host by sending of a maintenance messages.
 type HostToATMRequestV01 struct {
 
@@ -32,6 +67,10 @@ type HostToATMRequestV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (h *HostToATMRequestV01) Validate() error {
+	return model.ValidateElement(h)
+}
+
 func (h *HostToATMRequestV01) AddHeader() *model.Header20 {
 	h.Header = new(model.Header20)
 	return h.Header
@@ -16,6 +16,41 @@ func (d *Document00100101) AddMessage() *AcquirerAuthorisationInitiation {
 	return d.Message
 }
 
+func (d *Document00100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:cain.001.001.01"
+}
+
+func (d *Document00100101) MessageDefinitionIdentifier() string {
+	return "cain.001.001.01"
+}
+
+func (d *Document00100101) BusinessArea() string {
+	return "cain"
+}
+
+func (d *Document00100101) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100101) Variant() string {
+	return "001"
+}
+
+func (d *Document00100101) Version() string {
+	return "01"
+}
+
+func (d *Document00100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcquirerAuthorisationInitiation message is sent by an acquirer or an agent to an issuer or an agent, to request, advice or notify the approval of a card transaction.
 type AcquirerAuthorisationInitiation struct {
 
@@ -30,6 +65,10 @@ type AcquirerAuthorisationInitiation struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcquirerAuthorisationInitiation) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcquirerAuthorisationInitiation) AddHeader() *model.Header17 {
 	a.Header = new(model.Header17)
 	return a.Header
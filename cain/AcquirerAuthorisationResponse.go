@@ -16,6 +16,41 @@ func (d *Document00200101) AddMessage() *AcquirerAuthorisationResponse {
 	return d.Message
 }
 
+func (d *Document00200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:cain.002.001.01"
+}
+
+func (d *Document00200101) MessageDefinitionIdentifier() string {
+	return "cain.002.001.01"
+}
+
+func (d *Document00200101) BusinessArea() string {
+	return "cain"
+}
+
+func (d *Document00200101) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200101) Variant() string {
+	return "001"
+}
+
+func (d *Document00200101) Version() string {
+	return "01"
+}
+
+func (d *Document00200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcquirerAuthorisationResponse message is sent by an issuer or an agent to answer to an AcquirerAuthorisationInitiation message.
 type AcquirerAuthorisationResponse struct {
 
@@ -29,6 +64,10 @@ type AcquirerAuthorisationResponse struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcquirerAuthorisationResponse) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcquirerAuthorisationResponse) AddHeader() *model.Header17 {
 	a.Header = new(model.Header17)
 	return a.Header
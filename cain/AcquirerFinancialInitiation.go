@@ -16,6 +16,41 @@ func (d *Document00300101) AddMessage() *AcquirerFinancialInitiation {
 	return d.Message
 }
 
+func (d *Document00300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:cain.003.001.01"
+}
+
+func (d *Document00300101) MessageDefinitionIdentifier() string {
+	return "cain.003.001.01"
+}
+
+func (d *Document00300101) BusinessArea() string {
+	return "cain"
+}
+
+func (d *Document00300101) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300101) Variant() string {
+	return "001"
+}
+
+func (d *Document00300101) Version() string {
+	return "01"
+}
+
+func (d *Document00300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcquirerFinancialInitiation message is sent by an acquirer or  an agent to an issuer or an agent, to request, advice or notify the approval and the clearing of a card transaction.
 type AcquirerFinancialInitiation struct {
 
@@ -29,6 +64,10 @@ type AcquirerFinancialInitiation struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr"`
 }
 
+func (a *AcquirerFinancialInitiation) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcquirerFinancialInitiation) AddHeader() *model.Header17 {
 	a.Header = new(model.Header17)
 	return a.Header
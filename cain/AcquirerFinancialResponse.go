@@ -16,6 +16,41 @@ func (d *Document00400101) AddMessage() *AcquirerFinancialResponse {
 	return d.Message
 }
 
+func (d *Document00400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:cain.004.001.01"
+}
+
+func (d *Document00400101) MessageDefinitionIdentifier() string {
+	return "cain.004.001.01"
+}
+
+func (d *Document00400101) BusinessArea() string {
+	return "cain"
+}
+
+func (d *Document00400101) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400101) Variant() string {
+	return "001"
+}
+
+func (d *Document00400101) Version() string {
+	return "01"
+}
+
+func (d *Document00400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcquirerFinancialResponse message is sent by an issuer or an agent to answer to an AcquirerFinancialInitiation message.
 type AcquirerFinancialResponse struct {
 
@@ -29,6 +64,10 @@ type AcquirerFinancialResponse struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcquirerFinancialResponse) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcquirerFinancialResponse) AddHeader() *model.Header17 {
 	a.Header = new(model.Header17)
 	return a.Header
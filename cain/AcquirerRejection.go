@@ -16,6 +16,41 @@ func (d *Document01300101) AddMessage() *AcquirerRejection {
 	return d.Message
 }
 
+func (d *Document01300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:cain.013.001.01"
+}
+
+func (d *Document01300101) MessageDefinitionIdentifier() string {
+	return "cain.013.001.01"
+}
+
+func (d *Document01300101) BusinessArea() string {
+	return "cain"
+}
+
+func (d *Document01300101) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300101) Variant() string {
+	return "001"
+}
+
+func (d *Document01300101) Version() string {
+	return "01"
+}
+
+func (d *Document01300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcquirerRejection message is sent by any party, to reject an Acquirer to Issuer message.
 type AcquirerRejection struct {
 
@@ -26,6 +61,10 @@ type AcquirerRejection struct {
 	Reject *model.AcceptorRejection4 `xml:"Rjct"`
 }
 
+func (a *AcquirerRejection) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcquirerRejection) AddHeader() *model.Header19 {
 	a.Header = new(model.Header19)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00500101) AddMessage() *AcquirerReversalInitiation {
 	return d.Message
 }
 
+func (d *Document00500101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:cain.005.001.01"
+}
+
+func (d *Document00500101) MessageDefinitionIdentifier() string {
+	return "cain.005.001.01"
+}
+
+func (d *Document00500101) BusinessArea() string {
+	return "cain"
+}
+
+func (d *Document00500101) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500101) Variant() string {
+	return "001"
+}
+
+func (d *Document00500101) Version() string {
+	return "01"
+}
+
+func (d *Document00500101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcquirerReversalInitiation message is sent by an acquirer or an agent to an issuer or an agent, to request, advice or notify the reversal of a card transaction.
 type AcquirerReversalInitiation struct {
 
@@ -29,6 +64,10 @@ type AcquirerReversalInitiation struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcquirerReversalInitiation) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcquirerReversalInitiation) AddHeader() *model.Header18 {
 	a.Header = new(model.Header18)
 	return a.Header
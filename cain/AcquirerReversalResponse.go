@@ -16,6 +16,41 @@ func (d *Document00600101) AddMessage() *AcquirerReversalResponse {
 	return d.Message
 }
 
+func (d *Document00600101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:cain.006.001.01"
+}
+
+func (d *Document00600101) MessageDefinitionIdentifier() string {
+	return "cain.006.001.01"
+}
+
+func (d *Document00600101) BusinessArea() string {
+	return "cain"
+}
+
+func (d *Document00600101) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600101) Variant() string {
+	return "001"
+}
+
+func (d *Document00600101) Version() string {
+	return "01"
+}
+
+func (d *Document00600101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The AcquirerReversalResponse message is sent by an issuer or an agent to answer to an AcquirerReversalInitiation message.
 type AcquirerReversalResponse struct {
 
@@ -29,6 +64,10 @@ type AcquirerReversalResponse struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcquirerReversalResponse) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcquirerReversalResponse) AddHeader() *model.Header18 {
 	a.Header = new(model.Header18)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01100101) AddMessage() *KeyExchangeInitiation {
 	return d.Message
 }
 
+func (d *Document01100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:cain.011.001.01"
+}
+
+func (d *Document01100101) MessageDefinitionIdentifier() string {
+	return "cain.011.001.01"
+}
+
+func (d *Document01100101) BusinessArea() string {
+	return "cain"
+}
+
+func (d *Document01100101) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100101) Variant() string {
+	return "001"
+}
+
+func (d *Document01100101) Version() string {
+	return "01"
+}
+
+func (d *Document01100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The KeyExchangeInitiation message is sent by any party to an acquirer, an issuer or an agent, to initiate a cryptographic key exchange.
 type KeyExchangeInitiation struct {
 
@@ -29,6 +64,10 @@ type KeyExchangeInitiation struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr"`
 }
 
+func (k *KeyExchangeInitiation) Validate() error {
+	return model.ValidateElement(k)
+}
+
 func (k *KeyExchangeInitiation) AddHeader() *model.Header17 {
 	k.Header = new(model.Header17)
 	return k.Header
@@ -16,6 +16,41 @@ func (d *Document01200101) AddMessage() *KeyExchangeResponse {
 	return d.Message
 }
 
+func (d *Document01200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:cain.012.001.01"
+}
+
+func (d *Document01200101) MessageDefinitionIdentifier() string {
+	return "cain.012.001.01"
+}
+
+func (d *Document01200101) BusinessArea() string {
+	return "cain"
+}
+
+func (d *Document01200101) MessageFunctionality() string {
+	return "012"
+}
+
+func (d *Document01200101) Variant() string {
+	return "001"
+}
+
+func (d *Document01200101) Version() string {
+	return "01"
+}
+
+func (d *Document01200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The KeyExchangeResponse message is sent by an acquirer, an issuer or an agent to answer to a KeyExchangeInitiation message and complete a cryptographic key exchange.
 type KeyExchangeResponse struct {
 
@@ -29,6 +64,10 @@ type KeyExchangeResponse struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr"`
 }
 
+func (k *KeyExchangeResponse) Validate() error {
+	return model.ValidateElement(k)
+}
+
 func (k *KeyExchangeResponse) AddHeader() *model.Header17 {
 	k.Header = new(model.Header17)
 	return k.Header
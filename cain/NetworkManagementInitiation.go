@@ -16,6 +16,41 @@ func (d *Document00900101) AddMessage() *NetworkManagementInitiation {
 	return d.Message
 }
 
+func (d *Document00900101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:cain.009.001.01"
+}
+
+func (d *Document00900101) MessageDefinitionIdentifier() string {
+	return "cain.009.001.01"
+}
+
+func (d *Document00900101) BusinessArea() string {
+	return "cain"
+}
+
+func (d *Document00900101) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900101) Variant() string {
+	return "001"
+}
+
+func (d *Document00900101) Version() string {
+	return "01"
+}
+
+func (d *Document00900101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The NetworkManagementInitiation message covers the range of activities to control the operating condition of the network and may be initiated by any party to an acquirer, an issuer or an agent.
 type NetworkManagementInitiation struct {
 
@@ -29,6 +64,10 @@ type NetworkManagementInitiation struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (n *NetworkManagementInitiation) Validate() error {
+	return model.ValidateElement(n)
+}
+
 func (n *NetworkManagementInitiation) AddHeader() *model.Header17 {
 	n.Header = new(model.Header17)
 	return n.Header
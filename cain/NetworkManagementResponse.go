@@ -16,6 +16,41 @@ func (d *Document01000101) AddMessage() *NetworkManagementResponse {
 	return d.Message
 }
 
+func (d *Document01000101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:cain.010.001.01"
+}
+
+func (d *Document01000101) MessageDefinitionIdentifier() string {
+	return "cain.010.001.01"
+}
+
+func (d *Document01000101) BusinessArea() string {
+	return "cain"
+}
+
+func (d *Document01000101) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000101) Variant() string {
+	return "001"
+}
+
+func (d *Document01000101) Version() string {
+	return "01"
+}
+
+func (d *Document01000101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The NetworkManagementResponse message is sent by an acquirer, an issuer or an agent to answer to an NetworkManagementInitiation message.
 type NetworkManagementResponse struct {
 
@@ -29,6 +64,10 @@ type NetworkManagementResponse struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (n *NetworkManagementResponse) Validate() error {
+	return model.ValidateElement(n)
+}
+
 func (n *NetworkManagementResponse) AddHeader() *model.Header17 {
 	n.Header = new(model.Header17)
 	return n.Header
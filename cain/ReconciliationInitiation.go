@@ -16,6 +16,41 @@ func (d *Document00700101) AddMessage() *ReconciliationInitiation {
 	return d.Message
 }
 
+func (d *Document00700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:cain.007.001.01"
+}
+
+func (d *Document00700101) MessageDefinitionIdentifier() string {
+	return "cain.007.001.01"
+}
+
+func (d *Document00700101) BusinessArea() string {
+	return "cain"
+}
+
+func (d *Document00700101) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700101) Variant() string {
+	return "001"
+}
+
+func (d *Document00700101) Version() string {
+	return "01"
+}
+
+func (d *Document00700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ReconciliationInitiation message is sent by an acquirer or an agent to an issuer or an agent, to initiate an exchange of totals to be reconciled for debits, credits, chargebacks and other transactions.
 type ReconciliationInitiation struct {
 
@@ -29,6 +64,10 @@ type ReconciliationInitiation struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (r *ReconciliationInitiation) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *ReconciliationInitiation) AddHeader() *model.Header17 {
 	r.Header = new(model.Header17)
 	return r.Header
@@ -16,6 +16,41 @@ func (d *Document00800101) AddMessage() *ReconciliationResponse {
 	return d.Message
 }
 
+func (d *Document00800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:cain.008.001.01"
+}
+
+func (d *Document00800101) MessageDefinitionIdentifier() string {
+	return "cain.008.001.01"
+}
+
+func (d *Document00800101) BusinessArea() string {
+	return "cain"
+}
+
+func (d *Document00800101) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800101) Variant() string {
+	return "001"
+}
+
+func (d *Document00800101) Version() string {
+	return "01"
+}
+
+func (d *Document00800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ReconciliationResponse message is sent by an issuer or an agent to return the reconciled totals for debits, credits, chargebacks and other transactions.
 type ReconciliationResponse struct {
 
@@ -29,6 +64,10 @@ type ReconciliationResponse struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (r *ReconciliationResponse) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *ReconciliationResponse) AddHeader() *model.Header17 {
 	r.Header = new(model.Header17)
 	return r.Header
@@ -16,6 +16,41 @@ func (d *Document06000102) AddMessage() *AccountReportingRequestV02 {
 	return d.Message
 }
 
+func (d *Document06000102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.060.001.02"
+}
+
+func (d *Document06000102) MessageDefinitionIdentifier() string {
+	return "camt.060.001.02"
+}
+
+func (d *Document06000102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document06000102) MessageFunctionality() string {
+	return "060"
+}
+
+func (d *Document06000102) Variant() string {
+	return "001"
+}
+
+func (d *Document06000102) Version() string {
+	return "02"
+}
+
+func (d *Document06000102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document06000102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountReportingRequest message is sent by the account owner, either directly or through a forwarding agent, to one of its account servicing institutions. It is used to ask the account servicing institution to send a report on the account owner's account in a BankToCustomerAccountReport (camt.052.001.02), a BankToCustomerStatement (camt.053.001.02) or a BankToCustomerDebitCreditNotification (camt.054.001.02).
 // Usage
@@ -29,6 +64,10 @@ type AccountReportingRequestV02 struct {
 	ReportingRequest []*model.ReportingRequest2 `xml:"RptgReq"`
 }
 
+func (a *AccountReportingRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountReportingRequestV02) AddGroupHeader() *model.GroupHeader43 {
 	a.GroupHeader = new(model.GroupHeader43)
 	return a.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document06000103) AddMessage() *AccountReportingRequestV03 {
 	return d.Message
 }
 
+func (d *Document06000103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.060.001.03"
+}
+
+func (d *Document06000103) MessageDefinitionIdentifier() string {
+	return "camt.060.001.03"
+}
+
+func (d *Document06000103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document06000103) MessageFunctionality() string {
+	return "060"
+}
+
+func (d *Document06000103) Variant() string {
+	return "001"
+}
+
+func (d *Document06000103) Version() string {
+	return "03"
+}
+
+func (d *Document06000103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document06000103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AccountReportingRequest message is sent by the account owner, either directly or through a forwarding agent, to one of its account servicing institutions. It is used to ask the account servicing institution to send a report on the account owner's account in a BankToCustomerAccountReport (camt.052.001.03), a BankToCustomerStatement (camt.053.001.03) or a BankToCustomerDebitCreditNotification (camt.054.001.03).
 // Usage
@@ -32,6 +67,10 @@ type AccountReportingRequestV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountReportingRequestV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AccountReportingRequestV03) AddGroupHeader() *model.GroupHeader59 {
 	a.GroupHeader = new(model.GroupHeader59)
 	return a.GroupHeader
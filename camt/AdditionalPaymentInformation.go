@@ -16,6 +16,41 @@ func (d *Document02800101) AddMessage() *AdditionalPaymentInformation {
 	return d.Message
 }
 
+func (d *Document02800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.028.001.01"
+}
+
+func (d *Document02800101) MessageDefinitionIdentifier() string {
+	return "camt.028.001.01"
+}
+
+func (d *Document02800101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02800101) MessageFunctionality() string {
+	return "028"
+}
+
+func (d *Document02800101) Variant() string {
+	return "001"
+}
+
+func (d *Document02800101) Version() string {
+	return "01"
+}
+
+func (d *Document02800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Additional Payment Information message is sent by an account servicing institution to an account owner.
 // This message is used to provide additional or corrected information on a payment instruction or statement entry, in order to allow reconciliation.
@@ -42,6 +77,10 @@ type AdditionalPaymentInformation struct {
 	Information *model.PaymentComplementaryInformation `xml:"Inf"`
 }
 
+func (a *AdditionalPaymentInformation) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AdditionalPaymentInformation) AddAssignment() *model.CaseAssignment {
 	a.Assignment = new(model.CaseAssignment)
 	return a.Assignment
@@ -16,6 +16,41 @@ func (d *Document02800103) AddMessage() *AdditionalPaymentInformationV03 {
 	return d.Message
 }
 
+func (d *Document02800103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.028.001.03"
+}
+
+func (d *Document02800103) MessageDefinitionIdentifier() string {
+	return "camt.028.001.03"
+}
+
+func (d *Document02800103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02800103) MessageFunctionality() string {
+	return "028"
+}
+
+func (d *Document02800103) Variant() string {
+	return "001"
+}
+
+func (d *Document02800103) Version() string {
+	return "03"
+}
+
+func (d *Document02800103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02800103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Additional Payment Information message is sent by an account servicing institution to an account owner.
 // This message is used to provide additional or corrected information on a payment instruction or statement entry, in order to allow reconciliation.
@@ -43,6 +78,10 @@ type AdditionalPaymentInformationV03 struct {
 	Information *model.PaymentComplementaryInformation2 `xml:"Inf"`
 }
 
+func (a *AdditionalPaymentInformationV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AdditionalPaymentInformationV03) AddAssignment() *model.CaseAssignment2 {
 	a.Assignment = new(model.CaseAssignment2)
 	return a.Assignment
@@ -16,6 +16,41 @@ func (d *Document02800104) AddMessage() *AdditionalPaymentInformationV04 {
 	return d.Message
 }
 
+func (d *Document02800104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.028.001.04"
+}
+
+func (d *Document02800104) MessageDefinitionIdentifier() string {
+	return "camt.028.001.04"
+}
+
+func (d *Document02800104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02800104) MessageFunctionality() string {
+	return "028"
+}
+
+func (d *Document02800104) Variant() string {
+	return "001"
+}
+
+func (d *Document02800104) Version() string {
+	return "04"
+}
+
+func (d *Document02800104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02800104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Additional Payment Information message is sent by an account servicing institution to an account owner.
 // This message is used to provide additional or corrected information on a payment instruction or statement entry, in order to allow reconciliation.
@@ -46,6 +81,10 @@ type AdditionalPaymentInformationV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AdditionalPaymentInformationV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AdditionalPaymentInformationV04) AddAssignment() *model.CaseAssignment3 {
 	a.Assignment = new(model.CaseAssignment3)
 	return a.Assignment
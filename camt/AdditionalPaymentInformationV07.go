@@ -16,6 +16,41 @@ func (d *Document02800107) AddMessage() *AdditionalPaymentInformationV07 {
 	return d.Message
 }
 
+func (d *Document02800107) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.028.001.07"
+}
+
+func (d *Document02800107) MessageDefinitionIdentifier() string {
+	return "camt.028.001.07"
+}
+
+func (d *Document02800107) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02800107) MessageFunctionality() string {
+	return "028"
+}
+
+func (d *Document02800107) Variant() string {
+	return "001"
+}
+
+func (d *Document02800107) Version() string {
+	return "07"
+}
+
+func (d *Document02800107) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02800107) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AdditionalPaymentInformation message is sent by an account servicing institution to an account owner.
 // This message is used to provide additional or corrected information on a payment instruction or statement entry, in order to allow reconciliation.
@@ -46,6 +81,10 @@ type AdditionalPaymentInformationV07 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AdditionalPaymentInformationV07) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AdditionalPaymentInformationV07) AddAssignment() *model.CaseAssignment3 {
 	a.Assignment = new(model.CaseAssignment3)
 	return a.Assignment
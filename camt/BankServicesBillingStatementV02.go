@@ -16,6 +16,41 @@ func (d *Document08600102) AddMessage() *BankServicesBillingStatementV02 {
 	return d.Message
 }
 
+func (d *Document08600102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.086.001.02"
+}
+
+func (d *Document08600102) MessageDefinitionIdentifier() string {
+	return "camt.086.001.02"
+}
+
+func (d *Document08600102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document08600102) MessageFunctionality() string {
+	return "086"
+}
+
+func (d *Document08600102) Variant() string {
+	return "001"
+}
+
+func (d *Document08600102) Version() string {
+	return "02"
+}
+
+func (d *Document08600102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document08600102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The BankServicesBillingStatement message is used to send from a Financial Institution (FI) to its wholesale customers (corporations, governments, institutions, etc.), information describing the FI’s billing of services rendered in the form of an electronic statement in a standardised format. The BankServicesBillingStatement is a periodic (usually end of month) recounting of all service chargeable events that occurred during a reporting cycle, typically a calendar month, along with detailed tax and currency translation information. Account balance information, although strongly recommended, is not required.
 // Usage
@@ -34,6 +69,10 @@ type BankServicesBillingStatementV02 struct {
 	BillingStatementGroup []*model.StatementGroup2 `xml:"BllgStmtGrp"`
 }
 
+func (b *BankServicesBillingStatementV02) Validate() error {
+	return model.ValidateElement(b)
+}
+
 func (b *BankServicesBillingStatementV02) AddReportHeader() *model.ReportHeader3 {
 	b.ReportHeader = new(model.ReportHeader3)
 	return b.ReportHeader
@@ -16,6 +16,41 @@ func (d *Document05200101) AddMessage() *BankToCustomerAccountReportV01 {
 	return d.Message
 }
 
+func (d *Document05200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.052.001.01"
+}
+
+func (d *Document05200101) MessageDefinitionIdentifier() string {
+	return "camt.052.001.01"
+}
+
+func (d *Document05200101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05200101) MessageFunctionality() string {
+	return "052"
+}
+
+func (d *Document05200101) Variant() string {
+	return "001"
+}
+
+func (d *Document05200101) Version() string {
+	return "01"
+}
+
+func (d *Document05200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Bank-to-Customer Account Report message is sent by the account servicer to an account owner or to a party authorised by the account owner to receive the message. It can be used to inform the account owner, or authorised party, of the entries reported to the account, and/or to provide the owner with balance information on the account at a given point in time.
 // Usage
@@ -34,6 +69,10 @@ type BankToCustomerAccountReportV01 struct {
 	Report []*model.AccountReport9 `xml:"Rpt"`
 }
 
+func (b *BankToCustomerAccountReportV01) Validate() error {
+	return model.ValidateElement(b)
+}
+
 func (b *BankToCustomerAccountReportV01) AddGroupHeader() *model.GroupHeader23 {
 	b.GroupHeader = new(model.GroupHeader23)
 	return b.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document05200102) AddMessage() *BankToCustomerAccountReportV02 {
 	return d.Message
 }
 
+func (d *Document05200102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.052.001.02"
+}
+
+func (d *Document05200102) MessageDefinitionIdentifier() string {
+	return "camt.052.001.02"
+}
+
+func (d *Document05200102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05200102) MessageFunctionality() string {
+	return "052"
+}
+
+func (d *Document05200102) Variant() string {
+	return "001"
+}
+
+func (d *Document05200102) Version() string {
+	return "02"
+}
+
+func (d *Document05200102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05200102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The BankToCustomerAccountReport message is sent by the account servicer to an account owner or to a party authorised by the account owner to receive the message. It can be used to inform the account owner, or authorised party, of the entries reported to the account, and/or to provide the owner with balance information on the account at a given point in time.
 // Usage
@@ -34,6 +69,10 @@ type BankToCustomerAccountReportV02 struct {
 	Report []*model.AccountReport11 `xml:"Rpt"`
 }
 
+func (b *BankToCustomerAccountReportV02) Validate() error {
+	return model.ValidateElement(b)
+}
+
 func (b *BankToCustomerAccountReportV02) AddGroupHeader() *model.GroupHeader42 {
 	b.GroupHeader = new(model.GroupHeader42)
 	return b.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document05200105) AddMessage() *BankToCustomerAccountReportV05 {
 	return d.Message
 }
 
+func (d *Document05200105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.052.001.05"
+}
+
+func (d *Document05200105) MessageDefinitionIdentifier() string {
+	return "camt.052.001.05"
+}
+
+func (d *Document05200105) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05200105) MessageFunctionality() string {
+	return "052"
+}
+
+func (d *Document05200105) Variant() string {
+	return "001"
+}
+
+func (d *Document05200105) Version() string {
+	return "05"
+}
+
+func (d *Document05200105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05200105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The BankToCustomerAccountReport message is sent by the account servicer to an account owner or to a party authorised by the account owner to receive the message. It can be used to inform the account owner, or authorised party, of the entries reported to the account, and/or to provide the owner with balance information on the account at a given point in time.
 // Usage
@@ -37,6 +72,10 @@ type BankToCustomerAccountReportV05 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (b *BankToCustomerAccountReportV05) Validate() error {
+	return model.ValidateElement(b)
+}
+
 func (b *BankToCustomerAccountReportV05) AddGroupHeader() *model.GroupHeader58 {
 	b.GroupHeader = new(model.GroupHeader58)
 	return b.GroupHeader
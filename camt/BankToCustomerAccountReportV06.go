@@ -3,6 +3,7 @@ package camt
 import (
 	"encoding/xml"
 
+	"github.com/yudaprama/iso20022/fastxml"
 	"github.com/yudaprama/iso20022/model"
 )
 
@@ -16,6 +17,81 @@ func (d *Document05200106) AddMessage() *BankToCustomerAccountReportV06 {
 	return d.Message
 }
 
+func (d *Document05200106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.052.001.06"
+}
+
+func (d *Document05200106) MessageDefinitionIdentifier() string {
+	return "camt.052.001.06"
+}
+
+func (d *Document05200106) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05200106) MessageFunctionality() string {
+	return "052"
+}
+
+func (d *Document05200106) Variant() string {
+	return "001"
+}
+
+func (d *Document05200106) Version() string {
+	return "06"
+}
+
+func (d *Document05200106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05200106) Validate() error {
+	return model.ValidateElement(d)
+}
+
+func (d *Document05200106) MarshalXMLFast() ([]byte, error) {
+	enc := fastxml.NewEncoder(make([]byte, 0, 4096))
+	enc.Root("urn:iso:std:iso:20022:tech:xsd:camt.052.001.06", "Document")
+	if d.Message != nil {
+		d.Message.EncodeXMLFast(enc, "BkToCstmrAcctRpt")
+	}
+	enc.End("Document")
+	return enc.Bytes(), nil
+}
+
+func (d *Document05200106) UnmarshalXMLFast(data []byte) error {
+	dec := fastxml.NewDecoder(data)
+	name, err := dec.Root("urn:iso:std:iso:20022:tech:xsd:camt.052.001.06", "Document")
+	if err != nil {
+		return err
+	}
+	d.XMLName = name
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "BkToCstmrAcctRpt":
+			elem := new(BankToCustomerAccountReportV06)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Message = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // Scope
 // The BankToCustomerAccountReport message is sent by the account servicer to an account owner or to a party authorised by the account owner to receive the message. It can be used to inform the account owner, or authorised party, of the entries reported to the account, and/or to provide the owner with balance information on the account at a given point in time.
 // Usage
@@ -37,6 +113,10 @@ type BankToCustomerAccountReportV06 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (b *BankToCustomerAccountReportV06) Validate() error {
+	return model.ValidateElement(b)
+}
+
 func (b *BankToCustomerAccountReportV06) AddGroupHeader() *model.GroupHeader58 {
 	b.GroupHeader = new(model.GroupHeader58)
 	return b.GroupHeader
@@ -194,6 +274,60 @@ func (b *BankToCustomerAccountReportV06) AddSupplementaryData() *model.Supplemen
 	return newValue
 }
 
+func (b *BankToCustomerAccountReportV06) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.GroupHeader != nil {
+		b.GroupHeader.EncodeXMLFast(enc, "GrpHdr")
+	}
+	for _, elem := range b.Report {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Rpt")
+		}
+	}
+	for _, elem := range b.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (b *BankToCustomerAccountReportV06) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "GrpHdr":
+			elem := new(model.GroupHeader58)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.GroupHeader = elem
+		case "Rpt":
+			elem := new(model.AccountReport19)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.Report = append(b.Report, elem)
+		case "SplmtryData":
+			elem := new(model.SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.SupplementaryData = append(b.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -16,6 +16,41 @@ func (d *Document05400101) AddMessage() *BankToCustomerDebitCreditNotificationV0
 	return d.Message
 }
 
+func (d *Document05400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.054.001.01"
+}
+
+func (d *Document05400101) MessageDefinitionIdentifier() string {
+	return "camt.054.001.01"
+}
+
+func (d *Document05400101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05400101) MessageFunctionality() string {
+	return "054"
+}
+
+func (d *Document05400101) Variant() string {
+	return "001"
+}
+
+func (d *Document05400101) Version() string {
+	return "01"
+}
+
+func (d *Document05400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Bank-to-Customer Debit/Credit Notification message is sent by the account servicer to an account owner or to a party authorised by the account owner to receive the message. It can be used to inform the account owner, or authorised party, of single or multiple debit and/or credit entries reported to the account.
 // Usage
@@ -37,6 +72,10 @@ type BankToCustomerDebitCreditNotificationV01 struct {
 	Notification []*model.AccountNotification1 `xml:"Ntfctn"`
 }
 
+func (b *BankToCustomerDebitCreditNotificationV01) Validate() error {
+	return model.ValidateElement(b)
+}
+
 func (b *BankToCustomerDebitCreditNotificationV01) AddGroupHeader() *model.GroupHeader23 {
 	b.GroupHeader = new(model.GroupHeader23)
 	return b.GroupHeader
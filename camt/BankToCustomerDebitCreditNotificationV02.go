@@ -16,6 +16,41 @@ func (d *Document05400102) AddMessage() *BankToCustomerDebitCreditNotificationV0
 	return d.Message
 }
 
+func (d *Document05400102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.054.001.02"
+}
+
+func (d *Document05400102) MessageDefinitionIdentifier() string {
+	return "camt.054.001.02"
+}
+
+func (d *Document05400102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05400102) MessageFunctionality() string {
+	return "054"
+}
+
+func (d *Document05400102) Variant() string {
+	return "001"
+}
+
+func (d *Document05400102) Version() string {
+	return "02"
+}
+
+func (d *Document05400102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05400102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The BankToCustomerDebitCreditNotification message is sent by the account servicer to an account owner or to a party authorised by the account owner to receive the message. It can be used to inform the account owner, or authorised party, of single or multiple debit and/or credit entries reported to the account.
 // Usage
@@ -37,6 +72,10 @@ type BankToCustomerDebitCreditNotificationV02 struct {
 	Notification []*model.AccountNotification2 `xml:"Ntfctn"`
 }
 
+func (b *BankToCustomerDebitCreditNotificationV02) Validate() error {
+	return model.ValidateElement(b)
+}
+
 func (b *BankToCustomerDebitCreditNotificationV02) AddGroupHeader() *model.GroupHeader42 {
 	b.GroupHeader = new(model.GroupHeader42)
 	return b.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document05400105) AddMessage() *BankToCustomerDebitCreditNotificationV0
 	return d.Message
 }
 
+func (d *Document05400105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.054.001.05"
+}
+
+func (d *Document05400105) MessageDefinitionIdentifier() string {
+	return "camt.054.001.05"
+}
+
+func (d *Document05400105) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05400105) MessageFunctionality() string {
+	return "054"
+}
+
+func (d *Document05400105) Variant() string {
+	return "001"
+}
+
+func (d *Document05400105) Version() string {
+	return "05"
+}
+
+func (d *Document05400105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05400105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The BankToCustomerDebitCreditNotification message is sent by the account servicer to an account owner or to a party authorised by the account owner to receive the message. It can be used to inform the account owner, or authorised party, of single or multiple debit and/or credit entries reported to the account.
 // Usage
@@ -40,6 +75,10 @@ type BankToCustomerDebitCreditNotificationV05 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (b *BankToCustomerDebitCreditNotificationV05) Validate() error {
+	return model.ValidateElement(b)
+}
+
 func (b *BankToCustomerDebitCreditNotificationV05) AddGroupHeader() *model.GroupHeader58 {
 	b.GroupHeader = new(model.GroupHeader58)
 	return b.GroupHeader
@@ -3,6 +3,7 @@ package camt
 import (
 	"encoding/xml"
 
+	"github.com/yudaprama/iso20022/fastxml"
 	"github.com/yudaprama/iso20022/model"
 )
 
@@ -16,6 +17,81 @@ func (d *Document05400106) AddMessage() *BankToCustomerDebitCreditNotificationV0
 	return d.Message
 }
 
+func (d *Document05400106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.054.001.06"
+}
+
+func (d *Document05400106) MessageDefinitionIdentifier() string {
+	return "camt.054.001.06"
+}
+
+func (d *Document05400106) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05400106) MessageFunctionality() string {
+	return "054"
+}
+
+func (d *Document05400106) Variant() string {
+	return "001"
+}
+
+func (d *Document05400106) Version() string {
+	return "06"
+}
+
+func (d *Document05400106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05400106) Validate() error {
+	return model.ValidateElement(d)
+}
+
+func (d *Document05400106) MarshalXMLFast() ([]byte, error) {
+	enc := fastxml.NewEncoder(make([]byte, 0, 4096))
+	enc.Root("urn:iso:std:iso:20022:tech:xsd:camt.054.001.06", "Document")
+	if d.Message != nil {
+		d.Message.EncodeXMLFast(enc, "BkToCstmrDbtCdtNtfctn")
+	}
+	enc.End("Document")
+	return enc.Bytes(), nil
+}
+
+func (d *Document05400106) UnmarshalXMLFast(data []byte) error {
+	dec := fastxml.NewDecoder(data)
+	name, err := dec.Root("urn:iso:std:iso:20022:tech:xsd:camt.054.001.06", "Document")
+	if err != nil {
+		return err
+	}
+	d.XMLName = name
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "BkToCstmrDbtCdtNtfctn":
+			elem := new(BankToCustomerDebitCreditNotificationV06)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Message = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // Scope
 // The BankToCustomerDebitCreditNotification message is sent by the account servicer to an account owner or to a party authorised by the account owner to receive the message. It can be used to inform the account owner, or authorised party, of single or multiple debit and/or credit entries reported to the account.
 // Usage
@@ -40,6 +116,10 @@ type BankToCustomerDebitCreditNotificationV06 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (b *BankToCustomerDebitCreditNotificationV06) Validate() error {
+	return model.ValidateElement(b)
+}
+
 func (b *BankToCustomerDebitCreditNotificationV06) AddGroupHeader() *model.GroupHeader58 {
 	b.GroupHeader = new(model.GroupHeader58)
 	return b.GroupHeader
@@ -197,6 +277,60 @@ func (b *BankToCustomerDebitCreditNotificationV06) AddSupplementaryData() *model
 	return newValue
 }
 
+func (b *BankToCustomerDebitCreditNotificationV06) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.GroupHeader != nil {
+		b.GroupHeader.EncodeXMLFast(enc, "GrpHdr")
+	}
+	for _, elem := range b.Notification {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Ntfctn")
+		}
+	}
+	for _, elem := range b.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (b *BankToCustomerDebitCreditNotificationV06) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "GrpHdr":
+			elem := new(model.GroupHeader58)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.GroupHeader = elem
+		case "Ntfctn":
+			elem := new(model.AccountNotification12)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.Notification = append(b.Notification, elem)
+		case "SplmtryData":
+			elem := new(model.SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.SupplementaryData = append(b.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -16,6 +16,41 @@ func (d *Document05300101) AddMessage() *BankToCustomerStatementV01 {
 	return d.Message
 }
 
+func (d *Document05300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.053.001.01"
+}
+
+func (d *Document05300101) MessageDefinitionIdentifier() string {
+	return "camt.053.001.01"
+}
+
+func (d *Document05300101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05300101) MessageFunctionality() string {
+	return "053"
+}
+
+func (d *Document05300101) Variant() string {
+	return "001"
+}
+
+func (d *Document05300101) Version() string {
+	return "01"
+}
+
+func (d *Document05300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Bank-to-Customer Statement message is sent by the account servicer to an account owner or to a party authorised by the account owner to receive the message. It is used to inform the account owner, or authorised party, of the entries booked to the account, and to provide the owner with balance information on the account at a given point in time.
 // Usage
@@ -33,6 +68,10 @@ type BankToCustomerStatementV01 struct {
 	Statement []*model.AccountStatement1 `xml:"Stmt"`
 }
 
+func (b *BankToCustomerStatementV01) Validate() error {
+	return model.ValidateElement(b)
+}
+
 func (b *BankToCustomerStatementV01) AddGroupHeader() *model.GroupHeader23 {
 	b.GroupHeader = new(model.GroupHeader23)
 	return b.GroupHeader
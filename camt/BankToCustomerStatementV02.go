@@ -16,6 +16,41 @@ func (d *Document05300102) AddMessage() *BankToCustomerStatementV02 {
 	return d.Message
 }
 
+func (d *Document05300102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"
+}
+
+func (d *Document05300102) MessageDefinitionIdentifier() string {
+	return "camt.053.001.02"
+}
+
+func (d *Document05300102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05300102) MessageFunctionality() string {
+	return "053"
+}
+
+func (d *Document05300102) Variant() string {
+	return "001"
+}
+
+func (d *Document05300102) Version() string {
+	return "02"
+}
+
+func (d *Document05300102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05300102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The BankToCustomerStatement message is sent by the account servicer to an account owner or to a party authorised by the account owner to receive the message. It is used to inform the account owner, or authorised party, of the entries booked to the account, and to provide the owner with balance information on the account at a given point in time.
 // Usage
@@ -33,6 +68,10 @@ type BankToCustomerStatementV02 struct {
 	Statement []*model.AccountStatement2 `xml:"Stmt"`
 }
 
+func (b *BankToCustomerStatementV02) Validate() error {
+	return model.ValidateElement(b)
+}
+
 func (b *BankToCustomerStatementV02) AddGroupHeader() *model.GroupHeader42 {
 	b.GroupHeader = new(model.GroupHeader42)
 	return b.GroupHeader
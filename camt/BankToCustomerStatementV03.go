@@ -16,6 +16,41 @@ func (d *Document05300103) AddMessage() *BankToCustomerStatementV03 {
 	return d.Message
 }
 
+func (d *Document05300103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.053.001.03"
+}
+
+func (d *Document05300103) MessageDefinitionIdentifier() string {
+	return "camt.053.001.03"
+}
+
+func (d *Document05300103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05300103) MessageFunctionality() string {
+	return "053"
+}
+
+func (d *Document05300103) Variant() string {
+	return "001"
+}
+
+func (d *Document05300103) Version() string {
+	return "03"
+}
+
+func (d *Document05300103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05300103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The BankToCustomerStatement message is sent by the account servicer to an account owner or to a party authorised by the account owner to receive the message. It is used to inform the account owner, or authorised party, of the entries booked to the account, and to provide the owner with balance information on the account at a given point in time.
 // Usage
@@ -36,6 +71,10 @@ type BankToCustomerStatementV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (b *BankToCustomerStatementV03) Validate() error {
+	return model.ValidateElement(b)
+}
+
 func (b *BankToCustomerStatementV03) AddGroupHeader() *model.GroupHeader58 {
 	b.GroupHeader = new(model.GroupHeader58)
 	return b.GroupHeader
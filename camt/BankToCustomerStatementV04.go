@@ -16,6 +16,41 @@ func (d *Document05300104) AddMessage() *BankToCustomerStatementV04 {
 	return d.Message
 }
 
+func (d *Document05300104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.053.001.04"
+}
+
+func (d *Document05300104) MessageDefinitionIdentifier() string {
+	return "camt.053.001.04"
+}
+
+func (d *Document05300104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05300104) MessageFunctionality() string {
+	return "053"
+}
+
+func (d *Document05300104) Variant() string {
+	return "001"
+}
+
+func (d *Document05300104) Version() string {
+	return "04"
+}
+
+func (d *Document05300104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05300104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The BankToCustomerStatement message is sent by the account servicer to an account owner or to a party authorised by the account owner to receive the message. It is used to inform the account owner, or authorised party, of the entries booked to the account, and to provide the owner with balance information on the account at a given point in time.
 // Usage
@@ -36,6 +71,10 @@ type BankToCustomerStatementV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (b *BankToCustomerStatementV04) Validate() error {
+	return model.ValidateElement(b)
+}
+
 func (b *BankToCustomerStatementV04) AddGroupHeader() *model.GroupHeader58 {
 	b.GroupHeader = new(model.GroupHeader58)
 	return b.GroupHeader
@@ -3,6 +3,7 @@ package camt
 import (
 	"encoding/xml"
 
+	"github.com/yudaprama/iso20022/fastxml"
 	"github.com/yudaprama/iso20022/model"
 )
 
@@ -16,6 +17,81 @@ func (d *Document05300106) AddMessage() *BankToCustomerStatementV06 {
 	return d.Message
 }
 
+func (d *Document05300106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.053.001.06"
+}
+
+func (d *Document05300106) MessageDefinitionIdentifier() string {
+	return "camt.053.001.06"
+}
+
+func (d *Document05300106) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05300106) MessageFunctionality() string {
+	return "053"
+}
+
+func (d *Document05300106) Variant() string {
+	return "001"
+}
+
+func (d *Document05300106) Version() string {
+	return "06"
+}
+
+func (d *Document05300106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05300106) Validate() error {
+	return model.ValidateElement(d)
+}
+
+func (d *Document05300106) MarshalXMLFast() ([]byte, error) {
+	enc := fastxml.NewEncoder(make([]byte, 0, 4096))
+	enc.Root("urn:iso:std:iso:20022:tech:xsd:camt.053.001.06", "Document")
+	if d.Message != nil {
+		d.Message.EncodeXMLFast(enc, "BkToCstmrStmt")
+	}
+	enc.End("Document")
+	return enc.Bytes(), nil
+}
+
+func (d *Document05300106) UnmarshalXMLFast(data []byte) error {
+	dec := fastxml.NewDecoder(data)
+	name, err := dec.Root("urn:iso:std:iso:20022:tech:xsd:camt.053.001.06", "Document")
+	if err != nil {
+		return err
+	}
+	d.XMLName = name
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "BkToCstmrStmt":
+			elem := new(BankToCustomerStatementV06)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Message = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // Scope
 // The BankToCustomerStatement message is sent by the account servicer to an account owner or to a party authorised by the account owner to receive the message. It is used to inform the account owner, or authorised party, of the entries booked to the account, and to provide the owner with balance information on the account at a given point in time.
 // Usage
@@ -36,6 +112,10 @@ type BankToCustomerStatementV06 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (b *BankToCustomerStatementV06) Validate() error {
+	return model.ValidateElement(b)
+}
+
 func (b *BankToCustomerStatementV06) AddGroupHeader() *model.GroupHeader58 {
 	b.GroupHeader = new(model.GroupHeader58)
 	return b.GroupHeader
@@ -193,6 +273,60 @@ func (b *BankToCustomerStatementV06) AddSupplementaryData() *model.Supplementary
 	return newValue
 }
 
+func (b *BankToCustomerStatementV06) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.GroupHeader != nil {
+		b.GroupHeader.EncodeXMLFast(enc, "GrpHdr")
+	}
+	for _, elem := range b.Statement {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Stmt")
+		}
+	}
+	for _, elem := range b.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (b *BankToCustomerStatementV06) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "GrpHdr":
+			elem := new(model.GroupHeader58)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.GroupHeader = elem
+		case "Stmt":
+			elem := new(model.AccountStatement6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.Statement = append(b.Statement, elem)
+		case "SplmtryData":
+			elem := new(model.SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.SupplementaryData = append(b.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
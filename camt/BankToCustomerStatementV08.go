@@ -0,0 +1,188 @@
+package camt
+
+import (
+	"encoding/xml"
+
+	"github.com/yudaprama/iso20022/fastxml"
+	"github.com/yudaprama/iso20022/model"
+)
+
+type Document05300108 struct {
+	XMLName xml.Name                    `xml:"urn:iso:std:iso:20022:tech:xsd:camt.053.001.08 Document"`
+	Message *BankToCustomerStatementV08 `xml:"BkToCstmrStmt"`
+}
+
+func (d *Document05300108) AddMessage() *BankToCustomerStatementV08 {
+	d.Message = new(BankToCustomerStatementV08)
+	return d.Message
+}
+
+func (d *Document05300108) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.053.001.08"
+}
+
+func (d *Document05300108) MessageDefinitionIdentifier() string {
+	return "camt.053.001.08"
+}
+
+func (d *Document05300108) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05300108) MessageFunctionality() string {
+	return "053"
+}
+
+func (d *Document05300108) Variant() string {
+	return "001"
+}
+
+func (d *Document05300108) Version() string {
+	return "08"
+}
+
+func (d *Document05300108) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05300108) Validate() error {
+	return model.ValidateElement(d)
+}
+
+func (d *Document05300108) MarshalXMLFast() ([]byte, error) {
+	enc := fastxml.NewEncoder(make([]byte, 0, 4096))
+	enc.Root("urn:iso:std:iso:20022:tech:xsd:camt.053.001.08", "Document")
+	if d.Message != nil {
+		d.Message.EncodeXMLFast(enc, "BkToCstmrStmt")
+	}
+	enc.End("Document")
+	return enc.Bytes(), nil
+}
+
+func (d *Document05300108) UnmarshalXMLFast(data []byte) error {
+	dec := fastxml.NewDecoder(data)
+	name, err := dec.Root("urn:iso:std:iso:20022:tech:xsd:camt.053.001.08", "Document")
+	if err != nil {
+		return err
+	}
+	d.XMLName = name
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "BkToCstmrStmt":
+			elem := new(BankToCustomerStatementV08)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Message = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Scope
+// The BankToCustomerStatement message is sent by the account servicer to an account owner or to a party authorised by the account owner to receive the message. It is used to inform the account owner, or authorised party, of the entries booked to the account, and to provide the owner with balance information on the account at a given point in time.
+// Usage
+// The BankToCustomerStatement message can contain reports for more than one account. It provides information for cash management and/or reconciliation.
+// It contains information on booked entries only.
+// It can include underlying details of transactions that have been included in the entry.
+// The message is exchanged as defined between the account servicer and the account owner. It provides information on items that have been booked to the account and also balance information. Depending on services and schedule agreed between banks and their customers, statements may be generated and exchanged accordingly, for example for intraday or prior day periods.
+// It is possible that the receiver of the message is not the account owner, but a party entitled through arrangement with the account owner to receive the account information (also known as recipient).
+type BankToCustomerStatementV08 struct {
+
+	// Common information for the message.
+	GroupHeader *model.GroupHeader81 `xml:"GrpHdr"`
+
+	// Reports on booked entries and balances for a cash account.
+	Statement []*model.AccountStatement9 `xml:"Stmt"`
+
+	// Additional information that cannot be captured in the structured elements and/or any other specific block.
+	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
+}
+
+func (b *BankToCustomerStatementV08) Validate() error {
+	return model.ValidateElement(b)
+}
+
+func (b *BankToCustomerStatementV08) AddGroupHeader() *model.GroupHeader81 {
+	b.GroupHeader = new(model.GroupHeader81)
+	return b.GroupHeader
+}
+
+func (b *BankToCustomerStatementV08) AddStatement() *model.AccountStatement9 {
+	newValue := new(model.AccountStatement9)
+	b.Statement = append(b.Statement, newValue)
+	return newValue
+}
+
+func (b *BankToCustomerStatementV08) AddSupplementaryData() *model.SupplementaryData1 {
+	newValue := new(model.SupplementaryData1)
+	b.SupplementaryData = append(b.SupplementaryData, newValue)
+	return newValue
+}
+
+func (b *BankToCustomerStatementV08) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.GroupHeader != nil {
+		b.GroupHeader.EncodeXMLFast(enc, "GrpHdr")
+	}
+	for _, elem := range b.Statement {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Stmt")
+		}
+	}
+	for _, elem := range b.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (b *BankToCustomerStatementV08) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "GrpHdr":
+			elem := new(model.GroupHeader81)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.GroupHeader = elem
+		case "Stmt":
+			elem := new(model.AccountStatement9)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.Statement = append(b.Statement, elem)
+		case "SplmtryData":
+			elem := new(model.SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.SupplementaryData = append(b.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -16,6 +16,41 @@ func (d *Document03200101) AddMessage() *CancelCaseAssignment {
 	return d.Message
 }
 
+func (d *Document03200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.032.001.01"
+}
+
+func (d *Document03200101) MessageDefinitionIdentifier() string {
+	return "camt.032.001.01"
+}
+
+func (d *Document03200101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03200101) MessageFunctionality() string {
+	return "032"
+}
+
+func (d *Document03200101) Variant() string {
+	return "001"
+}
+
+func (d *Document03200101) Version() string {
+	return "01"
+}
+
+func (d *Document03200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Cancel Case Assignment message is sent by a case creator or case assigner to a case assignee. This message is used to request the cancellation of a case.
 // Usage
@@ -39,6 +74,10 @@ type CancelCaseAssignment struct {
 	Case *model.Case `xml:"Case"`
 }
 
+func (c *CancelCaseAssignment) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CancelCaseAssignment) AddAssignment() *model.CaseAssignment {
 	c.Assignment = new(model.CaseAssignment)
 	return c.Assignment
@@ -16,6 +16,41 @@ func (d *Document03200102) AddMessage() *CancelCaseAssignmentV02 {
 	return d.Message
 }
 
+func (d *Document03200102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.032.001.02"
+}
+
+func (d *Document03200102) MessageDefinitionIdentifier() string {
+	return "camt.032.001.02"
+}
+
+func (d *Document03200102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03200102) MessageFunctionality() string {
+	return "032"
+}
+
+func (d *Document03200102) Variant() string {
+	return "001"
+}
+
+func (d *Document03200102) Version() string {
+	return "02"
+}
+
+func (d *Document03200102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03200102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Cancel Case Assignment message is sent by a case creator or case assigner to a case assignee. This message is used to request the cancellation of a case.
 // Usage
@@ -40,6 +75,10 @@ type CancelCaseAssignmentV02 struct {
 	Case *model.Case2 `xml:"Case"`
 }
 
+func (c *CancelCaseAssignmentV02) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CancelCaseAssignmentV02) AddAssignment() *model.CaseAssignment2 {
 	c.Assignment = new(model.CaseAssignment2)
 	return c.Assignment
@@ -16,6 +16,41 @@ func (d *Document03200103) AddMessage() *CancelCaseAssignmentV03 {
 	return d.Message
 }
 
+func (d *Document03200103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.032.001.03"
+}
+
+func (d *Document03200103) MessageDefinitionIdentifier() string {
+	return "camt.032.001.03"
+}
+
+func (d *Document03200103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03200103) MessageFunctionality() string {
+	return "032"
+}
+
+func (d *Document03200103) Variant() string {
+	return "001"
+}
+
+func (d *Document03200103) Version() string {
+	return "03"
+}
+
+func (d *Document03200103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03200103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Cancel Case Assignment message is sent by a case creator or case assigner to a case assignee. This message is used to request the cancellation of a case.
 // Usage
@@ -43,6 +78,10 @@ type CancelCaseAssignmentV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CancelCaseAssignmentV03) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CancelCaseAssignmentV03) AddAssignment() *model.CaseAssignment3 {
 	c.Assignment = new(model.CaseAssignment3)
 	return c.Assignment
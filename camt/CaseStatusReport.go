@@ -16,6 +16,41 @@ func (d *Document03900101) AddMessage() *CaseStatusReport {
 	return d.Message
 }
 
+func (d *Document03900101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.039.001.01"
+}
+
+func (d *Document03900101) MessageDefinitionIdentifier() string {
+	return "camt.039.001.01"
+}
+
+func (d *Document03900101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03900101) MessageFunctionality() string {
+	return "039"
+}
+
+func (d *Document03900101) Variant() string {
+	return "001"
+}
+
+func (d *Document03900101) Version() string {
+	return "01"
+}
+
+func (d *Document03900101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03900101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Case Status Report message is sent by a case assignee to a case creator or case assigner.
 // This message is used to report on the status of a case.
@@ -41,6 +76,10 @@ type CaseStatusReport struct {
 	NewAssignment *model.CaseAssignment `xml:"NewAssgnmt,omitempty"`
 }
 
+func (c *CaseStatusReport) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CaseStatusReport) AddHeader() *model.ReportHeader {
 	c.Header = new(model.ReportHeader)
 	return c.Header
@@ -16,6 +16,41 @@ func (d *Document03800101) AddMessage() *CaseStatusReportRequest {
 	return d.Message
 }
 
+func (d *Document03800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.038.001.01"
+}
+
+func (d *Document03800101) MessageDefinitionIdentifier() string {
+	return "camt.038.001.01"
+}
+
+func (d *Document03800101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03800101) MessageFunctionality() string {
+	return "038"
+}
+
+func (d *Document03800101) Variant() string {
+	return "001"
+}
+
+func (d *Document03800101) Version() string {
+	return "01"
+}
+
+func (d *Document03800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Case Status Report Request message is sent by a case creator or case assigner to a case assignee.
 // This message is used to request the status of a case.
@@ -38,6 +73,10 @@ type CaseStatusReportRequest struct {
 	Case *model.Case `xml:"Case"`
 }
 
+func (c *CaseStatusReportRequest) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CaseStatusReportRequest) AddRequestHeader() *model.ReportHeader {
 	c.RequestHeader = new(model.ReportHeader)
 	return c.RequestHeader
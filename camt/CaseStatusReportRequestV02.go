@@ -16,6 +16,41 @@ func (d *Document03800102) AddMessage() *CaseStatusReportRequestV02 {
 	return d.Message
 }
 
+func (d *Document03800102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.038.001.02"
+}
+
+func (d *Document03800102) MessageDefinitionIdentifier() string {
+	return "camt.038.001.02"
+}
+
+func (d *Document03800102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03800102) MessageFunctionality() string {
+	return "038"
+}
+
+func (d *Document03800102) Variant() string {
+	return "001"
+}
+
+func (d *Document03800102) Version() string {
+	return "02"
+}
+
+func (d *Document03800102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03800102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CaseStatusReportRequest message is sent by a case creator or case assigner to a case assignee.
 // This message is used to request the status of a case.
@@ -38,6 +73,10 @@ type CaseStatusReportRequestV02 struct {
 	Case *model.Case2 `xml:"Case"`
 }
 
+func (c *CaseStatusReportRequestV02) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CaseStatusReportRequestV02) AddRequestHeader() *model.ReportHeader2 {
 	c.RequestHeader = new(model.ReportHeader2)
 	return c.RequestHeader
@@ -16,6 +16,41 @@ func (d *Document03800103) AddMessage() *CaseStatusReportRequestV03 {
 	return d.Message
 }
 
+func (d *Document03800103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.038.001.03"
+}
+
+func (d *Document03800103) MessageDefinitionIdentifier() string {
+	return "camt.038.001.03"
+}
+
+func (d *Document03800103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03800103) MessageFunctionality() string {
+	return "038"
+}
+
+func (d *Document03800103) Variant() string {
+	return "001"
+}
+
+func (d *Document03800103) Version() string {
+	return "03"
+}
+
+func (d *Document03800103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03800103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CaseStatusReportRequest message is sent by a case creator or case assigner to a case assignee.
 // This message is used to request the status of a case.
@@ -41,6 +76,10 @@ type CaseStatusReportRequestV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CaseStatusReportRequestV03) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CaseStatusReportRequestV03) AddRequestHeader() *model.ReportHeader4 {
 	c.RequestHeader = new(model.ReportHeader4)
 	return c.RequestHeader
@@ -16,6 +16,41 @@ func (d *Document03900103) AddMessage() *CaseStatusReportV03 {
 	return d.Message
 }
 
+func (d *Document03900103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.039.001.03"
+}
+
+func (d *Document03900103) MessageDefinitionIdentifier() string {
+	return "camt.039.001.03"
+}
+
+func (d *Document03900103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03900103) MessageFunctionality() string {
+	return "039"
+}
+
+func (d *Document03900103) Variant() string {
+	return "001"
+}
+
+func (d *Document03900103) Version() string {
+	return "03"
+}
+
+func (d *Document03900103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03900103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Case Status Report message is sent by a case assignee to a case creator or case assigner.
 // This message is used to report on the status of a case.
@@ -42,6 +77,10 @@ type CaseStatusReportV03 struct {
 	NewAssignment *model.CaseAssignment2 `xml:"NewAssgnmt,omitempty"`
 }
 
+func (c *CaseStatusReportV03) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CaseStatusReportV03) AddHeader() *model.ReportHeader2 {
 	c.Header = new(model.ReportHeader2)
 	return c.Header
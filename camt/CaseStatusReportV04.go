@@ -16,6 +16,41 @@ func (d *Document03900104) AddMessage() *CaseStatusReportV04 {
 	return d.Message
 }
 
+func (d *Document03900104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.039.001.04"
+}
+
+func (d *Document03900104) MessageDefinitionIdentifier() string {
+	return "camt.039.001.04"
+}
+
+func (d *Document03900104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03900104) MessageFunctionality() string {
+	return "039"
+}
+
+func (d *Document03900104) Variant() string {
+	return "001"
+}
+
+func (d *Document03900104) Version() string {
+	return "04"
+}
+
+func (d *Document03900104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03900104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Case Status Report message is sent by a case assignee to a case creator or case assigner.
 // This message is used to report on the status of a case.
@@ -45,6 +80,10 @@ type CaseStatusReportV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CaseStatusReportV04) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CaseStatusReportV04) AddHeader() *model.ReportHeader4 {
 	c.Header = new(model.ReportHeader4)
 	return c.Header
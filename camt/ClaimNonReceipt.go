@@ -16,6 +16,41 @@ func (d *Document02700101) AddMessage() *ClaimNonReceipt {
 	return d.Message
 }
 
+func (d *Document02700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.027.001.01"
+}
+
+func (d *Document02700101) MessageDefinitionIdentifier() string {
+	return "camt.027.001.01"
+}
+
+func (d *Document02700101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02700101) MessageFunctionality() string {
+	return "027"
+}
+
+func (d *Document02700101) Variant() string {
+	return "001"
+}
+
+func (d *Document02700101) Version() string {
+	return "01"
+}
+
+func (d *Document02700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Claim Non Receipt message is sent by a case creator/case assigner to a case assignee.
 // This message allows to initiate an investigation in case the beneficiary of a payment has not received an expected payment.
@@ -41,6 +76,10 @@ type ClaimNonReceipt struct {
 	MissingCover *model.MissingCover `xml:"MssngCover,omitempty"`
 }
 
+func (c *ClaimNonReceipt) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *ClaimNonReceipt) AddAssignment() *model.CaseAssignment {
 	c.Assignment = new(model.CaseAssignment)
 	return c.Assignment
@@ -16,6 +16,41 @@ func (d *Document02700103) AddMessage() *ClaimNonReceiptV03 {
 	return d.Message
 }
 
+func (d *Document02700103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.027.001.03"
+}
+
+func (d *Document02700103) MessageDefinitionIdentifier() string {
+	return "camt.027.001.03"
+}
+
+func (d *Document02700103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02700103) MessageFunctionality() string {
+	return "027"
+}
+
+func (d *Document02700103) Variant() string {
+	return "001"
+}
+
+func (d *Document02700103) Version() string {
+	return "03"
+}
+
+func (d *Document02700103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02700103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Claim Non Receipt message is sent by a case creator/case assigner to a case assignee.
 // This message is used to initiate an investigation for missing funds at the creditor (missing credit entry to its account) or at an agent along the processing chain (missing cover for a received payment instruction).
@@ -48,6 +83,10 @@ type ClaimNonReceiptV03 struct {
 	CoverDetails *model.MissingCover2 `xml:"CoverDtls,omitempty"`
 }
 
+func (c *ClaimNonReceiptV03) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *ClaimNonReceiptV03) AddAssignment() *model.CaseAssignment2 {
 	c.Assignment = new(model.CaseAssignment2)
 	return c.Assignment
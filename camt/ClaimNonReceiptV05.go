@@ -16,6 +16,41 @@ func (d *Document02700105) AddMessage() *ClaimNonReceiptV05 {
 	return d.Message
 }
 
+func (d *Document02700105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.027.001.05"
+}
+
+func (d *Document02700105) MessageDefinitionIdentifier() string {
+	return "camt.027.001.05"
+}
+
+func (d *Document02700105) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02700105) MessageFunctionality() string {
+	return "027"
+}
+
+func (d *Document02700105) Variant() string {
+	return "001"
+}
+
+func (d *Document02700105) Version() string {
+	return "05"
+}
+
+func (d *Document02700105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02700105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Claim Non Receipt message is sent by a case creator/case assigner to a case assignee.
 // This message is used to initiate an investigation for missing funds at the creditor (missing credit entry to its account) or at an agent along the processing chain (missing cover for a received payment instruction).
@@ -60,6 +95,10 @@ type ClaimNonReceiptV05 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *ClaimNonReceiptV05) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *ClaimNonReceiptV05) AddAssignment() *model.CaseAssignment3 {
 	c.Assignment = new(model.CaseAssignment3)
 	return c.Assignment
@@ -16,6 +16,41 @@ func (d *Document05500101) AddMessage() *CustomerPaymentCancellationRequestV01 {
 	return d.Message
 }
 
+func (d *Document05500101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.055.001.01"
+}
+
+func (d *Document05500101) MessageDefinitionIdentifier() string {
+	return "camt.055.001.01"
+}
+
+func (d *Document05500101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05500101) MessageFunctionality() string {
+	return "055"
+}
+
+func (d *Document05500101) Variant() string {
+	return "001"
+}
+
+func (d *Document05500101) Version() string {
+	return "01"
+}
+
+func (d *Document05500101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05500101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Customer Payment Cancellation Request message is sent by a case creator/case assigner to a case assignee.
 // This message is used to request the cancellation of an original payment instruction. The Customer Payment Cancellation Request message is issued by the initiating party to request the cancellation of an initiation payment message previously sent (such as CustomerCreditTransferInitiation or CustomerDirectDebitInitiation).
@@ -45,6 +80,10 @@ type CustomerPaymentCancellationRequestV01 struct {
 	Underlying []*model.UnderlyingTransaction1 `xml:"Undrlyg"`
 }
 
+func (c *CustomerPaymentCancellationRequestV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerPaymentCancellationRequestV01) AddAssignment() *model.CaseAssignment2 {
 	c.Assignment = new(model.CaseAssignment2)
 	return c.Assignment
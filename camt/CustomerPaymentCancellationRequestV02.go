@@ -16,6 +16,41 @@ func (d *Document05500102) AddMessage() *CustomerPaymentCancellationRequestV02 {
 	return d.Message
 }
 
+func (d *Document05500102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.055.001.02"
+}
+
+func (d *Document05500102) MessageDefinitionIdentifier() string {
+	return "camt.055.001.02"
+}
+
+func (d *Document05500102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05500102) MessageFunctionality() string {
+	return "055"
+}
+
+func (d *Document05500102) Variant() string {
+	return "001"
+}
+
+func (d *Document05500102) Version() string {
+	return "02"
+}
+
+func (d *Document05500102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05500102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Customer Payment Cancellation Request message is sent by a case creator/case assigner to a case assignee.
 // This message is used to request the cancellation of an original payment instruction. The Customer Payment Cancellation Request message is issued by the initiating party to request the cancellation of an initiation payment message previously sent (such as CustomerCreditTransferInitiation or CustomerDirectDebitInitiation).
@@ -55,6 +90,10 @@ type CustomerPaymentCancellationRequestV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CustomerPaymentCancellationRequestV02) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerPaymentCancellationRequestV02) AddAssignment() *model.CaseAssignment3 {
 	c.Assignment = new(model.CaseAssignment3)
 	return c.Assignment
@@ -16,6 +16,41 @@ func (d *Document05500105) AddMessage() *CustomerPaymentCancellationRequestV05 {
 	return d.Message
 }
 
+func (d *Document05500105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.055.001.05"
+}
+
+func (d *Document05500105) MessageDefinitionIdentifier() string {
+	return "camt.055.001.05"
+}
+
+func (d *Document05500105) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05500105) MessageFunctionality() string {
+	return "055"
+}
+
+func (d *Document05500105) Variant() string {
+	return "001"
+}
+
+func (d *Document05500105) Version() string {
+	return "05"
+}
+
+func (d *Document05500105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05500105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CustomerPaymentCancellationRequest message is sent by a case creator/case assigner to a case assignee.
 // This message is used to request the cancellation of an original payment instruction. The CustomerPaymentCancellationRequest message is issued by the initiating party to request the cancellation of an initiation payment message previously sent (such as CustomerCreditTransferInitiation or CustomerDirectDebitInitiation).
@@ -55,6 +90,10 @@ type CustomerPaymentCancellationRequestV05 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CustomerPaymentCancellationRequestV05) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerPaymentCancellationRequestV05) AddAssignment() *model.CaseAssignment3 {
 	c.Assignment = new(model.CaseAssignment3)
 	return c.Assignment
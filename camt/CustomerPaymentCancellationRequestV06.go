@@ -16,6 +16,41 @@ func (d *Document05500106) AddMessage() *CustomerPaymentCancellationRequestV06 {
 	return d.Message
 }
 
+func (d *Document05500106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.055.001.06"
+}
+
+func (d *Document05500106) MessageDefinitionIdentifier() string {
+	return "camt.055.001.06"
+}
+
+func (d *Document05500106) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05500106) MessageFunctionality() string {
+	return "055"
+}
+
+func (d *Document05500106) Variant() string {
+	return "001"
+}
+
+func (d *Document05500106) Version() string {
+	return "06"
+}
+
+func (d *Document05500106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05500106) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CustomerPaymentCancellationRequest message is sent by a case creator/case assigner to a case assignee.
 // This message is used to request the cancellation of an original payment instruction. The CustomerPaymentCancellationRequest message is issued by the initiating party to request the cancellation of an initiation payment message previously sent (such as CustomerCreditTransferInitiation or CustomerDirectDebitInitiation).
@@ -55,6 +90,10 @@ type CustomerPaymentCancellationRequestV06 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CustomerPaymentCancellationRequestV06) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerPaymentCancellationRequestV06) AddAssignment() *model.CaseAssignment3 {
 	c.Assignment = new(model.CaseAssignment3)
 	return c.Assignment
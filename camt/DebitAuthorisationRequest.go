@@ -16,6 +16,41 @@ func (d *Document03700101) AddMessage() *DebitAuthorisationRequest {
 	return d.Message
 }
 
+func (d *Document03700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.037.001.01"
+}
+
+func (d *Document03700101) MessageDefinitionIdentifier() string {
+	return "camt.037.001.01"
+}
+
+func (d *Document03700101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03700101) MessageFunctionality() string {
+	return "037"
+}
+
+func (d *Document03700101) Variant() string {
+	return "001"
+}
+
+func (d *Document03700101) Version() string {
+	return "01"
+}
+
+func (d *Document03700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Debit Authorisation Request message is sent by an account servicing institution to an account owner. This message is used to request authorisation to debit an account.
 // Usage
@@ -42,6 +77,10 @@ type DebitAuthorisationRequest struct {
 	Detail *model.DebitAuthorisationDetails `xml:"Dtl"`
 }
 
+func (d *DebitAuthorisationRequest) Validate() error {
+	return model.ValidateElement(d)
+}
+
 func (d *DebitAuthorisationRequest) AddAssignment() *model.CaseAssignment {
 	d.Assignment = new(model.CaseAssignment)
 	return d.Assignment
@@ -16,6 +16,41 @@ func (d *Document03700103) AddMessage() *DebitAuthorisationRequestV03 {
 	return d.Message
 }
 
+func (d *Document03700103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.037.001.03"
+}
+
+func (d *Document03700103) MessageDefinitionIdentifier() string {
+	return "camt.037.001.03"
+}
+
+func (d *Document03700103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03700103) MessageFunctionality() string {
+	return "037"
+}
+
+func (d *Document03700103) Variant() string {
+	return "001"
+}
+
+func (d *Document03700103) Version() string {
+	return "03"
+}
+
+func (d *Document03700103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03700103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Debit Authorisation Request message is sent by an account servicing institution to an account owner. This message is used to request authorisation to debit an account.
 // Usage
@@ -43,6 +78,10 @@ type DebitAuthorisationRequestV03 struct {
 	Detail *model.DebitAuthorisationDetails3 `xml:"Dtl"`
 }
 
+func (d *DebitAuthorisationRequestV03) Validate() error {
+	return model.ValidateElement(d)
+}
+
 func (d *DebitAuthorisationRequestV03) AddAssignment() *model.CaseAssignment2 {
 	d.Assignment = new(model.CaseAssignment2)
 	return d.Assignment
@@ -16,6 +16,41 @@ func (d *Document03700105) AddMessage() *DebitAuthorisationRequestV05 {
 	return d.Message
 }
 
+func (d *Document03700105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.037.001.05"
+}
+
+func (d *Document03700105) MessageDefinitionIdentifier() string {
+	return "camt.037.001.05"
+}
+
+func (d *Document03700105) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03700105) MessageFunctionality() string {
+	return "037"
+}
+
+func (d *Document03700105) Variant() string {
+	return "001"
+}
+
+func (d *Document03700105) Version() string {
+	return "05"
+}
+
+func (d *Document03700105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03700105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Debit Authorisation Request message is sent by an account servicing institution to an account owner. This message is used to request authorisation to debit an account.
 // Usage
@@ -46,6 +81,10 @@ type DebitAuthorisationRequestV05 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (d *DebitAuthorisationRequestV05) Validate() error {
+	return model.ValidateElement(d)
+}
+
 func (d *DebitAuthorisationRequestV05) AddAssignment() *model.CaseAssignment3 {
 	d.Assignment = new(model.CaseAssignment3)
 	return d.Assignment
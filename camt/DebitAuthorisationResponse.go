@@ -16,6 +16,41 @@ func (d *Document03600101) AddMessage() *DebitAuthorisationResponse {
 	return d.Message
 }
 
+func (d *Document03600101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.036.001.01"
+}
+
+func (d *Document03600101) MessageDefinitionIdentifier() string {
+	return "camt.036.001.01"
+}
+
+func (d *Document03600101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03600101) MessageFunctionality() string {
+	return "036"
+}
+
+func (d *Document03600101) Variant() string {
+	return "001"
+}
+
+func (d *Document03600101) Version() string {
+	return "01"
+}
+
+func (d *Document03600101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03600101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Debit Authorisation Response message is sent by an account owner to its account servicing institution. This message is used to approve or reject a debit authorisation request.
 // Usage
@@ -35,6 +70,10 @@ type DebitAuthorisationResponse struct {
 	Confirmation *model.DebitAuthorisationConfirmation `xml:"Conf"`
 }
 
+func (d *DebitAuthorisationResponse) Validate() error {
+	return model.ValidateElement(d)
+}
+
 func (d *DebitAuthorisationResponse) AddAssignment() *model.CaseAssignment {
 	d.Assignment = new(model.CaseAssignment)
 	return d.Assignment
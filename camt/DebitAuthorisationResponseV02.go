@@ -16,6 +16,41 @@ func (d *Document03600102) AddMessage() *DebitAuthorisationResponseV02 {
 	return d.Message
 }
 
+func (d *Document03600102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.036.001.02"
+}
+
+func (d *Document03600102) MessageDefinitionIdentifier() string {
+	return "camt.036.001.02"
+}
+
+func (d *Document03600102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03600102) MessageFunctionality() string {
+	return "036"
+}
+
+func (d *Document03600102) Variant() string {
+	return "001"
+}
+
+func (d *Document03600102) Version() string {
+	return "02"
+}
+
+func (d *Document03600102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03600102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Debit Authorisation Response message is sent by an account owner to its account servicing institution. This message is used to approve or reject a debit authorisation request.
 // Usage
@@ -36,6 +71,10 @@ type DebitAuthorisationResponseV02 struct {
 	Confirmation *model.DebitAuthorisationConfirmation2 `xml:"Conf"`
 }
 
+func (d *DebitAuthorisationResponseV02) Validate() error {
+	return model.ValidateElement(d)
+}
+
 func (d *DebitAuthorisationResponseV02) AddAssignment() *model.CaseAssignment2 {
 	d.Assignment = new(model.CaseAssignment2)
 	return d.Assignment
@@ -16,6 +16,41 @@ func (d *Document03600103) AddMessage() *DebitAuthorisationResponseV03 {
 	return d.Message
 }
 
+func (d *Document03600103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.036.001.03"
+}
+
+func (d *Document03600103) MessageDefinitionIdentifier() string {
+	return "camt.036.001.03"
+}
+
+func (d *Document03600103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03600103) MessageFunctionality() string {
+	return "036"
+}
+
+func (d *Document03600103) Variant() string {
+	return "001"
+}
+
+func (d *Document03600103) Version() string {
+	return "03"
+}
+
+func (d *Document03600103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03600103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Debit Authorisation Response message is sent by an account owner to its account servicing institution. This message is used to approve or reject a debit authorisation request.
 // Usage
@@ -39,6 +74,10 @@ type DebitAuthorisationResponseV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (d *DebitAuthorisationResponseV03) Validate() error {
+	return model.ValidateElement(d)
+}
+
 func (d *DebitAuthorisationResponseV03) AddAssignment() *model.CaseAssignment3 {
 	d.Assignment = new(model.CaseAssignment3)
 	return d.Assignment
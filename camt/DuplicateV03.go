@@ -16,6 +16,41 @@ func (d *Document03400103) AddMessage() *DuplicateV03 {
 	return d.Message
 }
 
+func (d *Document03400103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.034.001.03"
+}
+
+func (d *Document03400103) MessageDefinitionIdentifier() string {
+	return "camt.034.001.03"
+}
+
+func (d *Document03400103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03400103) MessageFunctionality() string {
+	return "034"
+}
+
+func (d *Document03400103) Variant() string {
+	return "001"
+}
+
+func (d *Document03400103) Version() string {
+	return "03"
+}
+
+func (d *Document03400103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03400103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Duplicate message is used by financial institutions, with their own offices, and/or with other financial institutions with which they have established bilateral agreements. It allows to exchange duplicate payment instructions.
 // Usage
@@ -34,6 +69,10 @@ type DuplicateV03 struct {
 	Duplicate *model.ProprietaryData4 `xml:"Dplct"`
 }
 
+func (d *DuplicateV03) Validate() error {
+	return model.ValidateElement(d)
+}
+
 func (d *DuplicateV03) AddAssignment() *model.CaseAssignment2 {
 	d.Assignment = new(model.CaseAssignment2)
 	return d.Assignment
@@ -16,6 +16,41 @@ func (d *Document03400104) AddMessage() *DuplicateV04 {
 	return d.Message
 }
 
+func (d *Document03400104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.034.001.04"
+}
+
+func (d *Document03400104) MessageDefinitionIdentifier() string {
+	return "camt.034.001.04"
+}
+
+func (d *Document03400104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03400104) MessageFunctionality() string {
+	return "034"
+}
+
+func (d *Document03400104) Variant() string {
+	return "001"
+}
+
+func (d *Document03400104) Version() string {
+	return "04"
+}
+
+func (d *Document03400104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03400104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Duplicate message is used by financial institutions, with their own offices, and/or with other financial institutions with which they have established bilateral agreements. It allows to exchange duplicate payment instructions.
 // Usage
@@ -37,6 +72,10 @@ type DuplicateV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (d *DuplicateV04) Validate() error {
+	return model.ValidateElement(d)
+}
+
 func (d *DuplicateV04) AddAssignment() *model.CaseAssignment3 {
 	d.Assignment = new(model.CaseAssignment3)
 	return d.Assignment
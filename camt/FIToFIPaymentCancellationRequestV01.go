@@ -16,6 +16,41 @@ func (d *Document05600101) AddMessage() *FIToFIPaymentCancellationRequestV01 {
 	return d.Message
 }
 
+func (d *Document05600101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.056.001.01"
+}
+
+func (d *Document05600101) MessageDefinitionIdentifier() string {
+	return "camt.056.001.01"
+}
+
+func (d *Document05600101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05600101) MessageFunctionality() string {
+	return "056"
+}
+
+func (d *Document05600101) Variant() string {
+	return "001"
+}
+
+func (d *Document05600101) Version() string {
+	return "01"
+}
+
+func (d *Document05600101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05600101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FIToFI Payment Cancellation Request message is sent by a case creator/case assigner to a case assignee.
 // This message is used to request the cancellation of an original payment instruction. The FIToFI Payment Cancellation Request message is exchanged between the instructing agent and the instructed agent to request the cancellation of a interbank payment message previously sent (such as FIToFICustomerCreditTransfer, FIToFICustomerDirectDebit or FinancialInstitutionCreditTransfer).
@@ -45,6 +80,10 @@ type FIToFIPaymentCancellationRequestV01 struct {
 	Underlying []*model.UnderlyingTransaction2 `xml:"Undrlyg"`
 }
 
+func (f *FIToFIPaymentCancellationRequestV01) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFIPaymentCancellationRequestV01) AddAssignment() *model.CaseAssignment2 {
 	f.Assignment = new(model.CaseAssignment2)
 	return f.Assignment
@@ -16,6 +16,41 @@ func (d *Document05600103) AddMessage() *FIToFIPaymentCancellationRequestV03 {
 	return d.Message
 }
 
+func (d *Document05600103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.056.001.03"
+}
+
+func (d *Document05600103) MessageDefinitionIdentifier() string {
+	return "camt.056.001.03"
+}
+
+func (d *Document05600103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05600103) MessageFunctionality() string {
+	return "056"
+}
+
+func (d *Document05600103) Variant() string {
+	return "001"
+}
+
+func (d *Document05600103) Version() string {
+	return "03"
+}
+
+func (d *Document05600103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05600103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FIToFI Payment Cancellation Request message is sent by a case creator/case assigner to a case assignee.
 // This message is used to request the cancellation of an original payment instruction. The FIToFI Payment Cancellation Request message is exchanged between the instructing agent and the instructed agent to request the cancellation of a interbank payment message previously sent (such as FIToFICustomerCreditTransfer, FIToFICustomerDirectDebit or FinancialInstitutionCreditTransfer).
@@ -59,6 +94,10 @@ type FIToFIPaymentCancellationRequestV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFIPaymentCancellationRequestV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFIPaymentCancellationRequestV03) AddAssignment() *model.CaseAssignment3 {
 	f.Assignment = new(model.CaseAssignment3)
 	return f.Assignment
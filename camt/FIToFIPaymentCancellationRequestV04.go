@@ -16,6 +16,41 @@ func (d *Document05600104) AddMessage() *FIToFIPaymentCancellationRequestV04 {
 	return d.Message
 }
 
+func (d *Document05600104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.056.001.04"
+}
+
+func (d *Document05600104) MessageDefinitionIdentifier() string {
+	return "camt.056.001.04"
+}
+
+func (d *Document05600104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05600104) MessageFunctionality() string {
+	return "056"
+}
+
+func (d *Document05600104) Variant() string {
+	return "001"
+}
+
+func (d *Document05600104) Version() string {
+	return "04"
+}
+
+func (d *Document05600104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05600104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FIToFI Payment Cancellation Request message is sent by a case creator/case assigner to a case assignee.
 // This message is used to request the cancellation of an original payment instruction. The FIToFI Payment Cancellation Request message is exchanged between the instructing agent and the instructed agent to request the cancellation of a interbank payment message previously sent (such as FIToFICustomerCreditTransfer, FIToFICustomerDirectDebit or FinancialInstitutionCreditTransfer).
@@ -59,6 +94,10 @@ type FIToFIPaymentCancellationRequestV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFIPaymentCancellationRequestV04) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFIPaymentCancellationRequestV04) AddAssignment() *model.CaseAssignment3 {
 	f.Assignment = new(model.CaseAssignment3)
 	return f.Assignment
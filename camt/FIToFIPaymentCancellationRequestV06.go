@@ -16,6 +16,41 @@ func (d *Document05600106) AddMessage() *FIToFIPaymentCancellationRequestV06 {
 	return d.Message
 }
 
+func (d *Document05600106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.056.001.06"
+}
+
+func (d *Document05600106) MessageDefinitionIdentifier() string {
+	return "camt.056.001.06"
+}
+
+func (d *Document05600106) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05600106) MessageFunctionality() string {
+	return "056"
+}
+
+func (d *Document05600106) Variant() string {
+	return "001"
+}
+
+func (d *Document05600106) Version() string {
+	return "06"
+}
+
+func (d *Document05600106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05600106) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FIToFIPaymentCancellationRequest message is sent by a case creator/case assigner to a case assignee.
 // This message is used to request the cancellation of an original payment instruction. The FIToFIPaymentCancellationRequest message is exchanged between the instructing agent and the instructed agent to request the cancellation of a interbank payment message previously sent (such as FIToFICustomerCreditTransfer, FIToFICustomerDirectDebit or FinancialInstitutionCreditTransfer).
@@ -62,6 +97,10 @@ type FIToFIPaymentCancellationRequestV06 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFIPaymentCancellationRequestV06) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFIPaymentCancellationRequestV06) AddAssignment() *model.CaseAssignment3 {
 	f.Assignment = new(model.CaseAssignment3)
 	return f.Assignment
@@ -16,6 +16,41 @@ func (d *Document04400101) AddMessage() *FundConfirmedCashForecastReportCancella
 	return d.Message
 }
 
+func (d *Document04400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.044.001.01"
+}
+
+func (d *Document04400101) MessageDefinitionIdentifier() string {
+	return "camt.044.001.01"
+}
+
+func (d *Document04400101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document04400101) MessageFunctionality() string {
+	return "044"
+}
+
+func (d *Document04400101) Variant() string {
+	return "001"
+}
+
+func (d *Document04400101) Version() string {
+	return "01"
+}
+
+func (d *Document04400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document04400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FundConfirmedCashForecastReportCancellation message is sent by a report provider, such as a transfer agent or a designated agent of the fund, to a report user, such as an investment manager, a fund accountant or any other interested party.
 // This message is used to cancel a previously sent FundConfirmedCashForecastReport message.
@@ -37,6 +72,10 @@ type FundConfirmedCashForecastReportCancellationV01 struct {
 	CashForecastReportToBeCancelled *model.FundConfirmedCashForecastReport1 `xml:"CshFcstRptToBeCanc,omitempty"`
 }
 
+func (f *FundConfirmedCashForecastReportCancellationV01) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FundConfirmedCashForecastReportCancellationV01) AddPoolReference() *model.AdditionalReference3 {
 	f.PoolReference = new(model.AdditionalReference3)
 	return f.PoolReference
@@ -16,6 +16,41 @@ func (d *Document04400102) AddMessage() *FundConfirmedCashForecastReportCancella
 	return d.Message
 }
 
+func (d *Document04400102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.044.001.02"
+}
+
+func (d *Document04400102) MessageDefinitionIdentifier() string {
+	return "camt.044.001.02"
+}
+
+func (d *Document04400102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document04400102) MessageFunctionality() string {
+	return "044"
+}
+
+func (d *Document04400102) Variant() string {
+	return "001"
+}
+
+func (d *Document04400102) Version() string {
+	return "02"
+}
+
+func (d *Document04400102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document04400102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // A report provider, such as a transfer agent, sends the FundConfirmedCashForecastReportCancellation message to the report user, such as an investment manager, to cancel a previously sent FundConfirmedCashForecastReport message.
 // Usage
@@ -42,6 +77,10 @@ type FundConfirmedCashForecastReportCancellationV02 struct {
 	CashForecastReportToBeCancelled *model.FundConfirmedCashForecastReport2 `xml:"CshFcstRptToBeCanc,omitempty"`
 }
 
+func (f *FundConfirmedCashForecastReportCancellationV02) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FundConfirmedCashForecastReportCancellationV02) AddMessageIdentification() *model.MessageIdentification1 {
 	f.MessageIdentification = new(model.MessageIdentification1)
 	return f.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document04400103) AddMessage() *FundConfirmedCashForecastReportCancella
 	return d.Message
 }
 
+func (d *Document04400103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.044.001.03"
+}
+
+func (d *Document04400103) MessageDefinitionIdentifier() string {
+	return "camt.044.001.03"
+}
+
+func (d *Document04400103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document04400103) MessageFunctionality() string {
+	return "044"
+}
+
+func (d *Document04400103) Variant() string {
+	return "001"
+}
+
+func (d *Document04400103) Version() string {
+	return "03"
+}
+
+func (d *Document04400103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document04400103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // A report provider, such as a transfer agent, sends the FundConfirmedCashForecastReportCancellation message to the report user, such as an investment manager or pricing agent, to cancel a previously sent FundConfirmedCashForecastReport message.
 // Usage
@@ -42,6 +77,10 @@ type FundConfirmedCashForecastReportCancellationV03 struct {
 	CashForecastReportToBeCancelled *model.FundConfirmedCashForecastReport3 `xml:"CshFcstRptToBeCanc,omitempty"`
 }
 
+func (f *FundConfirmedCashForecastReportCancellationV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FundConfirmedCashForecastReportCancellationV03) AddMessageIdentification() *model.MessageIdentification1 {
 	f.MessageIdentification = new(model.MessageIdentification1)
 	return f.MessageIdentification
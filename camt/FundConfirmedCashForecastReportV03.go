@@ -16,6 +16,41 @@ func (d *Document04100103) AddMessage() *FundConfirmedCashForecastReportV03 {
 	return d.Message
 }
 
+func (d *Document04100103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.041.001.03"
+}
+
+func (d *Document04100103) MessageDefinitionIdentifier() string {
+	return "camt.041.001.03"
+}
+
+func (d *Document04100103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document04100103) MessageFunctionality() string {
+	return "041"
+}
+
+func (d *Document04100103) Variant() string {
+	return "001"
+}
+
+func (d *Document04100103) Version() string {
+	return "03"
+}
+
+func (d *Document04100103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document04100103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // A report provider, such as a transfer agent, sends the FundConfirmedCashForecastReport message to the report user, such as an investment manager, to report the confirmed cash incomings and outgoings of one or more investment funds on one or more trade dates.
 // The cash movements may result from, for example, redemption, subscription, switch transactions or reinvestment of dividends.
@@ -51,6 +86,10 @@ type FundConfirmedCashForecastReportV03 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundConfirmedCashForecastReportV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FundConfirmedCashForecastReportV03) AddMessageIdentification() *model.MessageIdentification1 {
 	f.MessageIdentification = new(model.MessageIdentification1)
 	return f.MessageIdentification
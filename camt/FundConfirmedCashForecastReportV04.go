@@ -16,6 +16,41 @@ func (d *Document04100104) AddMessage() *FundConfirmedCashForecastReportV04 {
 	return d.Message
 }
 
+func (d *Document04100104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.041.001.04"
+}
+
+func (d *Document04100104) MessageDefinitionIdentifier() string {
+	return "camt.041.001.04"
+}
+
+func (d *Document04100104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document04100104) MessageFunctionality() string {
+	return "041"
+}
+
+func (d *Document04100104) Variant() string {
+	return "001"
+}
+
+func (d *Document04100104) Version() string {
+	return "04"
+}
+
+func (d *Document04100104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document04100104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // A report provider, such as a transfer agent, sends the FundConfirmedCashForecastReport message to the report user, such as an investment manager or pricing agent, to report the confirmed cash incomings and outgoings of one or more share classes of an investment fund on one or more trade dates.
 // The cash movements may result from, for example, redemption, subscription, switch transactions or reinvestment of dividends.
@@ -59,6 +94,10 @@ type FundConfirmedCashForecastReportV04 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundConfirmedCashForecastReportV04) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FundConfirmedCashForecastReportV04) AddMessageIdentification() *model.MessageIdentification1 {
 	f.MessageIdentification = new(model.MessageIdentification1)
 	return f.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document04500102) AddMessage() *FundDetailedConfirmedCashForecastReport
 	return d.Message
 }
 
+func (d *Document04500102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.045.001.02"
+}
+
+func (d *Document04500102) MessageDefinitionIdentifier() string {
+	return "camt.045.001.02"
+}
+
+func (d *Document04500102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document04500102) MessageFunctionality() string {
+	return "045"
+}
+
+func (d *Document04500102) Variant() string {
+	return "001"
+}
+
+func (d *Document04500102) Version() string {
+	return "02"
+}
+
+func (d *Document04500102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document04500102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // A report provider, such as a transfer agent, sends the FundDetailedConfirmedCashForecastReportCancellation messages to the report user, such as an investment manager, fund accountant or any other interested party, to cancel a previously sent FundDetailedConfirmedCashForecastReport.
 // Usage
@@ -42,6 +77,10 @@ type FundDetailedConfirmedCashForecastReportCancellationV02 struct {
 	CashForecastReportToBeCancelled *model.FundDetailedConfirmedCashForecastReport2 `xml:"CshFcstRptToBeCanc,omitempty"`
 }
 
+func (f *FundDetailedConfirmedCashForecastReportCancellationV02) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FundDetailedConfirmedCashForecastReportCancellationV02) AddMessageIdentification() *model.MessageIdentification1 {
 	f.MessageIdentification = new(model.MessageIdentification1)
 	return f.MessageIdentification
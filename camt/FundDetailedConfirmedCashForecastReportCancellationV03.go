@@ -16,6 +16,41 @@ func (d *Document04500103) AddMessage() *FundDetailedConfirmedCashForecastReport
 	return d.Message
 }
 
+func (d *Document04500103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.045.001.03"
+}
+
+func (d *Document04500103) MessageDefinitionIdentifier() string {
+	return "camt.045.001.03"
+}
+
+func (d *Document04500103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document04500103) MessageFunctionality() string {
+	return "045"
+}
+
+func (d *Document04500103) Variant() string {
+	return "001"
+}
+
+func (d *Document04500103) Version() string {
+	return "03"
+}
+
+func (d *Document04500103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document04500103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // A report provider, such as a transfer agent, sends the FundDetailedConfirmedCashForecastReportCancellation messages to the report user, such as an investment manager, fund accountant or any other interested party, to cancel a previously sent FundDetailedConfirmedCashForecastReport.
 // Usage
@@ -42,6 +77,10 @@ type FundDetailedConfirmedCashForecastReportCancellationV03 struct {
 	CashForecastReportToBeCancelled *model.FundDetailedConfirmedCashForecastReport3 `xml:"CshFcstRptToBeCanc,omitempty"`
 }
 
+func (f *FundDetailedConfirmedCashForecastReportCancellationV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FundDetailedConfirmedCashForecastReportCancellationV03) AddMessageIdentification() *model.MessageIdentification1 {
 	f.MessageIdentification = new(model.MessageIdentification1)
 	return f.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document04300104) AddMessage() *FundDetailedConfirmedCashForecastReport
 	return d.Message
 }
 
+func (d *Document04300104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.043.001.04"
+}
+
+func (d *Document04300104) MessageDefinitionIdentifier() string {
+	return "camt.043.001.04"
+}
+
+func (d *Document04300104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document04300104) MessageFunctionality() string {
+	return "043"
+}
+
+func (d *Document04300104) Variant() string {
+	return "001"
+}
+
+func (d *Document04300104) Version() string {
+	return "04"
+}
+
+func (d *Document04300104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document04300104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // A report provider, such as a transfer agent, sends the FundDetailedConfirmedCashForecastReport message to the report user, such as an investment manager or pricing agent, to report the confirmed cash incomings and outgoings, sorted by country, institution name or other criteria defined by the user of one or more share classes of an investment fund on one or more trade dates.
 // The cash movements may result from, for example, redemption, subscription, switch transactions or reinvestment of dividends.
@@ -58,6 +93,10 @@ type FundDetailedConfirmedCashForecastReportV04 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundDetailedConfirmedCashForecastReportV04) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FundDetailedConfirmedCashForecastReportV04) AddMessageIdentification() *model.MessageIdentification1 {
 	f.MessageIdentification = new(model.MessageIdentification1)
 	return f.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document04200102) AddMessage() *FundDetailedEstimatedCashForecastReport
 	return d.Message
 }
 
+func (d *Document04200102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.042.001.02"
+}
+
+func (d *Document04200102) MessageDefinitionIdentifier() string {
+	return "camt.042.001.02"
+}
+
+func (d *Document04200102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document04200102) MessageFunctionality() string {
+	return "042"
+}
+
+func (d *Document04200102) Variant() string {
+	return "001"
+}
+
+func (d *Document04200102) Version() string {
+	return "02"
+}
+
+func (d *Document04200102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document04200102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FundDetailedEstimatedCashForecastReport message is sent by a report provider, such as a transfer agent or a designated agent of the fund, to a report user, such as an investment manager, a fund accountant or any other interested party.
 // This message is used to report estimated cash incomings and outgoings, sorted by country, institution or some other criteria defined by the user. This message can be used to report the estimated cash movements of one or more investment funds, on one or more trade dates. These cash movements may result from, for example, redemption, subscription, switch transactions or dividends.
@@ -41,6 +76,10 @@ type FundDetailedEstimatedCashForecastReportV02 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundDetailedEstimatedCashForecastReportV02) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FundDetailedEstimatedCashForecastReportV02) AddPoolReference() *model.AdditionalReference3 {
 	f.PoolReference = new(model.AdditionalReference3)
 	return f.PoolReference
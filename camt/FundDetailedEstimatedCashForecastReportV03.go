@@ -16,6 +16,41 @@ func (d *Document04200103) AddMessage() *FundDetailedEstimatedCashForecastReport
 	return d.Message
 }
 
+func (d *Document04200103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.042.001.03"
+}
+
+func (d *Document04200103) MessageDefinitionIdentifier() string {
+	return "camt.042.001.03"
+}
+
+func (d *Document04200103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document04200103) MessageFunctionality() string {
+	return "042"
+}
+
+func (d *Document04200103) Variant() string {
+	return "001"
+}
+
+func (d *Document04200103) Version() string {
+	return "03"
+}
+
+func (d *Document04200103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document04200103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // A report provider, such as a transfer agent, sends the FundDetailedEstimatedCashForecastReport message to the report user, such as an investment manager, to report the estimated cash incomings and outgoings, sorted by country, institution name or other criteria defined by the user of one or more investment funds on one or more trade dates.
 // The cash movements may result from, for example, redemption, subscription, switch transactions or reinvestment of dividends.
@@ -50,6 +85,10 @@ type FundDetailedEstimatedCashForecastReportV03 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundDetailedEstimatedCashForecastReportV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FundDetailedEstimatedCashForecastReportV03) AddMessageIdentification() *model.MessageIdentification1 {
 	f.MessageIdentification = new(model.MessageIdentification1)
 	return f.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document04200104) AddMessage() *FundDetailedEstimatedCashForecastReport
 	return d.Message
 }
 
+func (d *Document04200104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.042.001.04"
+}
+
+func (d *Document04200104) MessageDefinitionIdentifier() string {
+	return "camt.042.001.04"
+}
+
+func (d *Document04200104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document04200104) MessageFunctionality() string {
+	return "042"
+}
+
+func (d *Document04200104) Variant() string {
+	return "001"
+}
+
+func (d *Document04200104) Version() string {
+	return "04"
+}
+
+func (d *Document04200104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document04200104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // A report provider, such as a transfer agent, sends the FundDetailedEstimatedCashForecastReport message to the report user, such as an investment manager or pricing agent, to report the estimated cash incomings and outgoings, sorted by country, institution name or other criteria defined by the user of one or more share classes of an investment fund on one or more trade dates.
 // The cash movements may result from, for example, redemption, subscription, switch transactions or reinvestment of dividends.
@@ -57,6 +92,10 @@ type FundDetailedEstimatedCashForecastReportV04 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundDetailedEstimatedCashForecastReportV04) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FundDetailedEstimatedCashForecastReportV04) AddMessageIdentification() *model.MessageIdentification1 {
 	f.MessageIdentification = new(model.MessageIdentification1)
 	return f.MessageIdentification
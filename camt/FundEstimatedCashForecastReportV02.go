@@ -16,6 +16,41 @@ func (d *Document04000102) AddMessage() *FundEstimatedCashForecastReportV02 {
 	return d.Message
 }
 
+func (d *Document04000102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.040.001.02"
+}
+
+func (d *Document04000102) MessageDefinitionIdentifier() string {
+	return "camt.040.001.02"
+}
+
+func (d *Document04000102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document04000102) MessageFunctionality() string {
+	return "040"
+}
+
+func (d *Document04000102) Variant() string {
+	return "001"
+}
+
+func (d *Document04000102) Version() string {
+	return "02"
+}
+
+func (d *Document04000102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document04000102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FundEstimatedCashForecastReport message is sent by a report provider, such as a transfer agent or a designated agent of the fund, to a report user, such as an investment manager, a fund accountant or any other interested party.
 // This message is used to report the estimated cash incomings and outgoings of one or more investment funds, on one or more trade dates. These cash movements may result from, for example, redemption, subscription, switch transactions or dividends.
@@ -39,6 +74,10 @@ type FundEstimatedCashForecastReportV02 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundEstimatedCashForecastReportV02) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FundEstimatedCashForecastReportV02) AddPoolReference() *model.AdditionalReference3 {
 	f.PoolReference = new(model.AdditionalReference3)
 	return f.PoolReference
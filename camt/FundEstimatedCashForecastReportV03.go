@@ -16,6 +16,41 @@ func (d *Document04000103) AddMessage() *FundEstimatedCashForecastReportV03 {
 	return d.Message
 }
 
+func (d *Document04000103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.040.001.03"
+}
+
+func (d *Document04000103) MessageDefinitionIdentifier() string {
+	return "camt.040.001.03"
+}
+
+func (d *Document04000103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document04000103) MessageFunctionality() string {
+	return "040"
+}
+
+func (d *Document04000103) Variant() string {
+	return "001"
+}
+
+func (d *Document04000103) Version() string {
+	return "03"
+}
+
+func (d *Document04000103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document04000103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // A report provider, such as a transfer agent, sends the FundEstimatedCashForecastReport message to the report user, such as an investment manager, to report the estimated cash incomings and outgoings of one or more investment funds on one or more trade dates.
 // The cash movements may result from, for example, redemption, subscription, switch transactions or reinvestment of dividends.
@@ -50,6 +85,10 @@ type FundEstimatedCashForecastReportV03 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundEstimatedCashForecastReportV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FundEstimatedCashForecastReportV03) AddMessageIdentification() *model.MessageIdentification1 {
 	f.MessageIdentification = new(model.MessageIdentification1)
 	return f.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document04000104) AddMessage() *FundEstimatedCashForecastReportV04 {
 	return d.Message
 }
 
+func (d *Document04000104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.040.001.04"
+}
+
+func (d *Document04000104) MessageDefinitionIdentifier() string {
+	return "camt.040.001.04"
+}
+
+func (d *Document04000104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document04000104) MessageFunctionality() string {
+	return "040"
+}
+
+func (d *Document04000104) Variant() string {
+	return "001"
+}
+
+func (d *Document04000104) Version() string {
+	return "04"
+}
+
+func (d *Document04000104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document04000104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // A report provider, such as a transfer agent, sends the FundEstimatedCashForecastReport message to the report user, such as an investment manager or pricing agent, to report the estimated cash incomings and outgoings of one or more share classes of an investment fund on one or more trade dates.
 // The cash movements may result from, for example, redemption, subscription, switch transactions or reinvestment of dividends.
@@ -58,6 +93,10 @@ type FundEstimatedCashForecastReportV04 struct {
 	Extension []*model.Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundEstimatedCashForecastReportV04) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FundEstimatedCashForecastReportV04) AddMessageIdentification() *model.MessageIdentification1 {
 	f.MessageIdentification = new(model.MessageIdentification1)
 	return f.MessageIdentification
@@ -16,6 +16,41 @@ func (d *Document08800101) AddMessage() *NetReportV01 {
 	return d.Message
 }
 
+func (d *Document08800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.088.001.01"
+}
+
+func (d *Document08800101) MessageDefinitionIdentifier() string {
+	return "camt.088.001.01"
+}
+
+func (d *Document08800101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document08800101) MessageFunctionality() string {
+	return "088"
+}
+
+func (d *Document08800101) Variant() string {
+	return "001"
+}
+
+func (d *Document08800101) Version() string {
+	return "01"
+}
+
+func (d *Document08800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document08800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The Net Report message is sent to a participant by a central system to provide details of the of the bi-lateral payment obligations, calculated by the central system per currency.
 type NetReportV01 struct {
 
@@ -35,6 +70,10 @@ type NetReportV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (n *NetReportV01) Validate() error {
+	return model.ValidateElement(n)
+}
+
 func (n *NetReportV01) AddNetReportData() *model.NetReportData1 {
 	n.NetReportData = new(model.NetReportData1)
 	return n.NetReportData
@@ -16,6 +16,41 @@ func (d *Document03000101) AddMessage() *NotificationOfCaseAssignment {
 	return d.Message
 }
 
+func (d *Document03000101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.030.001.01"
+}
+
+func (d *Document03000101) MessageDefinitionIdentifier() string {
+	return "camt.030.001.01"
+}
+
+func (d *Document03000101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03000101) MessageFunctionality() string {
+	return "030"
+}
+
+func (d *Document03000101) Variant() string {
+	return "001"
+}
+
+func (d *Document03000101) Version() string {
+	return "01"
+}
+
+func (d *Document03000101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03000101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Notification Of Case Assignment message is sent by a case assignee to a case creator/case assigner.
 // This message is used to inform the case assigner that:
@@ -50,6 +85,10 @@ type NotificationOfCaseAssignment struct {
 	Notification *model.CaseForwardingNotification `xml:"Ntfctn"`
 }
 
+func (n *NotificationOfCaseAssignment) Validate() error {
+	return model.ValidateElement(n)
+}
+
 func (n *NotificationOfCaseAssignment) AddHeader() *model.ReportHeader {
 	n.Header = new(model.ReportHeader)
 	return n.Header
@@ -16,6 +16,41 @@ func (d *Document03000103) AddMessage() *NotificationOfCaseAssignmentV03 {
 	return d.Message
 }
 
+func (d *Document03000103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.030.001.03"
+}
+
+func (d *Document03000103) MessageDefinitionIdentifier() string {
+	return "camt.030.001.03"
+}
+
+func (d *Document03000103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03000103) MessageFunctionality() string {
+	return "030"
+}
+
+func (d *Document03000103) Variant() string {
+	return "001"
+}
+
+func (d *Document03000103) Version() string {
+	return "03"
+}
+
+func (d *Document03000103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03000103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Notification Of Case Assignment message is sent by a case assignee to a case creator/case assigner.
 // This message is used to inform the case assigner that:
@@ -49,6 +84,10 @@ type NotificationOfCaseAssignmentV03 struct {
 	Notification *model.CaseForwardingNotification3 `xml:"Ntfctn"`
 }
 
+func (n *NotificationOfCaseAssignmentV03) Validate() error {
+	return model.ValidateElement(n)
+}
+
 func (n *NotificationOfCaseAssignmentV03) AddHeader() *model.ReportHeader2 {
 	n.Header = new(model.ReportHeader2)
 	return n.Header
@@ -16,6 +16,41 @@ func (d *Document03000104) AddMessage() *NotificationOfCaseAssignmentV04 {
 	return d.Message
 }
 
+func (d *Document03000104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.030.001.04"
+}
+
+func (d *Document03000104) MessageDefinitionIdentifier() string {
+	return "camt.030.001.04"
+}
+
+func (d *Document03000104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03000104) MessageFunctionality() string {
+	return "030"
+}
+
+func (d *Document03000104) Variant() string {
+	return "001"
+}
+
+func (d *Document03000104) Version() string {
+	return "04"
+}
+
+func (d *Document03000104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03000104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Notification Of Case Assignment message is sent by a case assignee to a case creator/case assigner.
 // This message is used to inform the case assigner that:
@@ -52,6 +87,10 @@ type NotificationOfCaseAssignmentV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (n *NotificationOfCaseAssignmentV04) Validate() error {
+	return model.ValidateElement(n)
+}
+
 func (n *NotificationOfCaseAssignmentV04) AddHeader() *model.ReportHeader4 {
 	n.Header = new(model.ReportHeader4)
 	return n.Header
@@ -16,6 +16,41 @@ func (d *Document05800102) AddMessage() *NotificationToReceiveCancellationAdvice
 	return d.Message
 }
 
+func (d *Document05800102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.058.001.02"
+}
+
+func (d *Document05800102) MessageDefinitionIdentifier() string {
+	return "camt.058.001.02"
+}
+
+func (d *Document05800102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05800102) MessageFunctionality() string {
+	return "058"
+}
+
+func (d *Document05800102) Variant() string {
+	return "001"
+}
+
+func (d *Document05800102) Version() string {
+	return "02"
+}
+
+func (d *Document05800102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05800102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The NotificationToReceiveCancellationAdvice message is sent by an account owner or by a party acting on the account owner's behalf to one of the account owner's account servicing institutions. It is used to advise the account servicing institution about the cancellation of one or more notifications in a previous NotificationToReceive message.
 // Usage
@@ -29,6 +64,10 @@ type NotificationToReceiveCancellationAdviceV02 struct {
 	OriginalNotification *model.OriginalNotification4 `xml:"OrgnlNtfctn"`
 }
 
+func (n *NotificationToReceiveCancellationAdviceV02) Validate() error {
+	return model.ValidateElement(n)
+}
+
 func (n *NotificationToReceiveCancellationAdviceV02) AddGroupHeader() *model.GroupHeader43 {
 	n.GroupHeader = new(model.GroupHeader43)
 	return n.GroupHeader
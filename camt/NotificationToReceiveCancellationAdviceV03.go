@@ -16,6 +16,41 @@ func (d *Document05800103) AddMessage() *NotificationToReceiveCancellationAdvice
 	return d.Message
 }
 
+func (d *Document05800103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.058.001.03"
+}
+
+func (d *Document05800103) MessageDefinitionIdentifier() string {
+	return "camt.058.001.03"
+}
+
+func (d *Document05800103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05800103) MessageFunctionality() string {
+	return "058"
+}
+
+func (d *Document05800103) Variant() string {
+	return "001"
+}
+
+func (d *Document05800103) Version() string {
+	return "03"
+}
+
+func (d *Document05800103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05800103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The NotificationToReceiveCancellationAdvice message is sent by an account owner or by a party acting on the account owner's behalf to one of the account owner's account servicing institutions. It is used to advise the account servicing institution about the cancellation of one or more notifications in a previous NotificationToReceive message.
 // Usage
@@ -32,6 +67,10 @@ type NotificationToReceiveCancellationAdviceV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (n *NotificationToReceiveCancellationAdviceV03) Validate() error {
+	return model.ValidateElement(n)
+}
+
 func (n *NotificationToReceiveCancellationAdviceV03) AddGroupHeader() *model.GroupHeader59 {
 	n.GroupHeader = new(model.GroupHeader59)
 	return n.GroupHeader
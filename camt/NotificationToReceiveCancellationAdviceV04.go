@@ -16,6 +16,41 @@ func (d *Document05800104) AddMessage() *NotificationToReceiveCancellationAdvice
 	return d.Message
 }
 
+func (d *Document05800104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.058.001.04"
+}
+
+func (d *Document05800104) MessageDefinitionIdentifier() string {
+	return "camt.058.001.04"
+}
+
+func (d *Document05800104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05800104) MessageFunctionality() string {
+	return "058"
+}
+
+func (d *Document05800104) Variant() string {
+	return "001"
+}
+
+func (d *Document05800104) Version() string {
+	return "04"
+}
+
+func (d *Document05800104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05800104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The NotificationToReceiveCancellationAdvice message is sent by an account owner or by a party acting on the account owner's behalf to one of the account owner's account servicing institutions. It is used to advise the account servicing institution about the cancellation of one or more notifications in a previous NotificationToReceive message.
 // Usage
@@ -32,6 +67,10 @@ type NotificationToReceiveCancellationAdviceV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (n *NotificationToReceiveCancellationAdviceV04) Validate() error {
+	return model.ValidateElement(n)
+}
+
 func (n *NotificationToReceiveCancellationAdviceV04) AddGroupHeader() *model.GroupHeader59 {
 	n.GroupHeader = new(model.GroupHeader59)
 	return n.GroupHeader
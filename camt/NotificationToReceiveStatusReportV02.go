@@ -16,6 +16,41 @@ func (d *Document05900102) AddMessage() *NotificationToReceiveStatusReportV02 {
 	return d.Message
 }
 
+func (d *Document05900102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.059.001.02"
+}
+
+func (d *Document05900102) MessageDefinitionIdentifier() string {
+	return "camt.059.001.02"
+}
+
+func (d *Document05900102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05900102) MessageFunctionality() string {
+	return "059"
+}
+
+func (d *Document05900102) Variant() string {
+	return "001"
+}
+
+func (d *Document05900102) Version() string {
+	return "02"
+}
+
+func (d *Document05900102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05900102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The NotificationToReceiveStatusReport message is sent by an account servicing institution to an account owner or to a party acting on the account owner's behalf. It is used to notify the account owner about the status of one or more expected payments that were advised in a previous NotificationToReceive message.
 // Usage
@@ -29,6 +64,10 @@ type NotificationToReceiveStatusReportV02 struct {
 	OriginalNotificationAndStatus *model.OriginalNotification3 `xml:"OrgnlNtfctnAndSts"`
 }
 
+func (n *NotificationToReceiveStatusReportV02) Validate() error {
+	return model.ValidateElement(n)
+}
+
 func (n *NotificationToReceiveStatusReportV02) AddGroupHeader() *model.GroupHeader44 {
 	n.GroupHeader = new(model.GroupHeader44)
 	return n.GroupHeader
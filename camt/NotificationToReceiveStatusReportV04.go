@@ -16,6 +16,41 @@ func (d *Document05900104) AddMessage() *NotificationToReceiveStatusReportV04 {
 	return d.Message
 }
 
+func (d *Document05900104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.059.001.04"
+}
+
+func (d *Document05900104) MessageDefinitionIdentifier() string {
+	return "camt.059.001.04"
+}
+
+func (d *Document05900104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05900104) MessageFunctionality() string {
+	return "059"
+}
+
+func (d *Document05900104) Variant() string {
+	return "001"
+}
+
+func (d *Document05900104) Version() string {
+	return "04"
+}
+
+func (d *Document05900104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05900104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The NotificationToReceiveStatusReport message is sent by an account servicing institution to an account owner or to a party acting on the account owner's behalf. It is used to notify the account owner about the status of one or more expected payments that were advised in a previous NotificationToReceive message.
 // Usage
@@ -32,6 +67,10 @@ type NotificationToReceiveStatusReportV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (n *NotificationToReceiveStatusReportV04) Validate() error {
+	return model.ValidateElement(n)
+}
+
 func (n *NotificationToReceiveStatusReportV04) AddGroupHeader() *model.GroupHeader60 {
 	n.GroupHeader = new(model.GroupHeader60)
 	return n.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document05900105) AddMessage() *NotificationToReceiveStatusReportV05 {
 	return d.Message
 }
 
+func (d *Document05900105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.059.001.05"
+}
+
+func (d *Document05900105) MessageDefinitionIdentifier() string {
+	return "camt.059.001.05"
+}
+
+func (d *Document05900105) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05900105) MessageFunctionality() string {
+	return "059"
+}
+
+func (d *Document05900105) Variant() string {
+	return "001"
+}
+
+func (d *Document05900105) Version() string {
+	return "05"
+}
+
+func (d *Document05900105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05900105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The NotificationToReceiveStatusReport message is sent by an account servicing institution to an account owner or to a party acting on the account owner's behalf. It is used to notify the account owner about the status of one or more expected payments that were advised in a previous NotificationToReceive message.
 // Usage
@@ -32,6 +67,10 @@ type NotificationToReceiveStatusReportV05 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (n *NotificationToReceiveStatusReportV05) Validate() error {
+	return model.ValidateElement(n)
+}
+
 func (n *NotificationToReceiveStatusReportV05) AddGroupHeader() *model.GroupHeader60 {
 	n.GroupHeader = new(model.GroupHeader60)
 	return n.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document05700102) AddMessage() *NotificationToReceiveV02 {
 	return d.Message
 }
 
+func (d *Document05700102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.057.001.02"
+}
+
+func (d *Document05700102) MessageDefinitionIdentifier() string {
+	return "camt.057.001.02"
+}
+
+func (d *Document05700102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05700102) MessageFunctionality() string {
+	return "057"
+}
+
+func (d *Document05700102) Variant() string {
+	return "001"
+}
+
+func (d *Document05700102) Version() string {
+	return "02"
+}
+
+func (d *Document05700102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05700102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The NotificationToReceive message is sent by an account owner or by a party acting on the account owner's behalf to one of the account owner's account servicing institutions. It is an advance notice that the account servicing institution will receive funds to be credited to the account of the account owner.
 // Usage
@@ -29,6 +64,10 @@ type NotificationToReceiveV02 struct {
 	Notification *model.AccountNotification4 `xml:"Ntfctn"`
 }
 
+func (n *NotificationToReceiveV02) Validate() error {
+	return model.ValidateElement(n)
+}
+
 func (n *NotificationToReceiveV02) AddGroupHeader() *model.GroupHeader43 {
 	n.GroupHeader = new(model.GroupHeader43)
 	return n.GroupHeader
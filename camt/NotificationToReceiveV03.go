@@ -16,6 +16,41 @@ func (d *Document05700103) AddMessage() *NotificationToReceiveV03 {
 	return d.Message
 }
 
+func (d *Document05700103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.057.001.03"
+}
+
+func (d *Document05700103) MessageDefinitionIdentifier() string {
+	return "camt.057.001.03"
+}
+
+func (d *Document05700103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document05700103) MessageFunctionality() string {
+	return "057"
+}
+
+func (d *Document05700103) Variant() string {
+	return "001"
+}
+
+func (d *Document05700103) Version() string {
+	return "03"
+}
+
+func (d *Document05700103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document05700103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The NotificationToReceive message is sent by an account owner or by a party acting on the account owner's behalf to one of the account owner's account servicing institutions. It is an advance notice that the account servicing institution will receive funds to be credited to the account of the account owner.
 // Usage
@@ -32,6 +67,10 @@ type NotificationToReceiveV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (n *NotificationToReceiveV03) Validate() error {
+	return model.ValidateElement(n)
+}
+
 func (n *NotificationToReceiveV03) AddGroupHeader() *model.GroupHeader59 {
 	n.GroupHeader = new(model.GroupHeader59)
 	return n.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document06100102) AddMessage() *PayInCallV02 {
 	return d.Message
 }
 
+func (d *Document06100102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.061.001.02"
+}
+
+func (d *Document06100102) MessageDefinitionIdentifier() string {
+	return "camt.061.001.02"
+}
+
+func (d *Document06100102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document06100102) MessageFunctionality() string {
+	return "061"
+}
+
+func (d *Document06100102) Variant() string {
+	return "001"
+}
+
+func (d *Document06100102) Version() string {
+	return "02"
+}
+
+func (d *Document06100102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document06100102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The PayInCall message is sent by a central settlement system to request additional funding from a settlement member impacted by a failure situation.
 type PayInCallV02 struct {
 
@@ -32,6 +67,10 @@ type PayInCallV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PayInCallV02) Validate() error {
+	return model.ValidateElement(p)
+}
+
 func (p *PayInCallV02) AddPartyIdentification() *model.PartyIdentification73Choice {
 	p.PartyIdentification = new(model.PartyIdentification73Choice)
 	return p.PartyIdentification
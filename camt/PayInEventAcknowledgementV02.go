@@ -16,6 +16,41 @@ func (d *Document06300102) AddMessage() *PayInEventAcknowledgementV02 {
 	return d.Message
 }
 
+func (d *Document06300102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.063.001.02"
+}
+
+func (d *Document06300102) MessageDefinitionIdentifier() string {
+	return "camt.063.001.02"
+}
+
+func (d *Document06300102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document06300102) MessageFunctionality() string {
+	return "063"
+}
+
+func (d *Document06300102) Variant() string {
+	return "001"
+}
+
+func (d *Document06300102) Version() string {
+	return "02"
+}
+
+func (d *Document06300102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document06300102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The PayInEventAcknowledgement message is sent by a participant of a central system to the central system to confirm a PayInSchedule or a PayInCall has been received.
 //
 type PayInEventAcknowledgementV02 struct {
@@ -33,6 +68,10 @@ type PayInEventAcknowledgementV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PayInEventAcknowledgementV02) Validate() error {
+	return model.ValidateElement(p)
+}
+
 func (p *PayInEventAcknowledgementV02) SetMessageIdentification(value string) {
 	p.MessageIdentification = (*model.Max35Text)(&value)
 }
@@ -16,6 +16,41 @@ func (d *Document06200103) AddMessage() *PayInScheduleV03 {
 	return d.Message
 }
 
+func (d *Document06200103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.062.001.03"
+}
+
+func (d *Document06200103) MessageDefinitionIdentifier() string {
+	return "camt.062.001.03"
+}
+
+func (d *Document06200103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document06200103) MessageFunctionality() string {
+	return "062"
+}
+
+func (d *Document06200103) Variant() string {
+	return "001"
+}
+
+func (d *Document06200103) Version() string {
+	return "03"
+}
+
+func (d *Document06200103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document06200103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The PayInSchedule message is sent by a central settlement system to the participant to provide notification of a series of timed payments scheduled for each currency at the time and date of the schedule generation. The central settlement system may send information about how the timed payments have been calculated.
 type PayInScheduleV03 struct {
 
@@ -38,6 +73,10 @@ type PayInScheduleV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PayInScheduleV03) Validate() error {
+	return model.ValidateElement(p)
+}
+
 func (p *PayInScheduleV03) AddPartyIdentification() *model.PartyIdentification73Choice {
 	p.PartyIdentification = new(model.PartyIdentification73Choice)
 	return p.PartyIdentification
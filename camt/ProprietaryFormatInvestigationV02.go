@@ -16,6 +16,41 @@ func (d *Document03500102) AddMessage() *ProprietaryFormatInvestigationV02 {
 	return d.Message
 }
 
+func (d *Document03500102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.035.001.02"
+}
+
+func (d *Document03500102) MessageDefinitionIdentifier() string {
+	return "camt.035.001.02"
+}
+
+func (d *Document03500102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03500102) MessageFunctionality() string {
+	return "035"
+}
+
+func (d *Document03500102) Variant() string {
+	return "001"
+}
+
+func (d *Document03500102) Version() string {
+	return "02"
+}
+
+func (d *Document03500102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03500102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Proprietary Format Investigation message type is used by financial institutions, with their own offices, and/or with other financial institutions with which they have established bilateral agreements.
 // Usage
@@ -35,6 +70,10 @@ type ProprietaryFormatInvestigationV02 struct {
 	ProprietaryData *model.ProprietaryData4 `xml:"PrtryData"`
 }
 
+func (p *ProprietaryFormatInvestigationV02) Validate() error {
+	return model.ValidateElement(p)
+}
+
 func (p *ProprietaryFormatInvestigationV02) AddAssignment() *model.CaseAssignment2 {
 	p.Assignment = new(model.CaseAssignment2)
 	return p.Assignment
@@ -16,6 +16,41 @@ func (d *Document03500103) AddMessage() *ProprietaryFormatInvestigationV03 {
 	return d.Message
 }
 
+func (d *Document03500103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.035.001.03"
+}
+
+func (d *Document03500103) MessageDefinitionIdentifier() string {
+	return "camt.035.001.03"
+}
+
+func (d *Document03500103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03500103) MessageFunctionality() string {
+	return "035"
+}
+
+func (d *Document03500103) Variant() string {
+	return "001"
+}
+
+func (d *Document03500103) Version() string {
+	return "03"
+}
+
+func (d *Document03500103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03500103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Proprietary Format Investigation message type is used by financial institutions, with their own offices, and/or with other financial institutions with which they have established bilateral agreements.
 // Usage
@@ -39,6 +74,10 @@ type ProprietaryFormatInvestigationV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *ProprietaryFormatInvestigationV03) Validate() error {
+	return model.ValidateElement(p)
+}
+
 func (p *ProprietaryFormatInvestigationV03) AddAssignment() *model.CaseAssignment3 {
 	p.Assignment = new(model.CaseAssignment3)
 	return p.Assignment
@@ -16,6 +16,41 @@ func (d *Document03100101) AddMessage() *RejectCaseAssignment {
 	return d.Message
 }
 
+func (d *Document03100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.031.001.01"
+}
+
+func (d *Document03100101) MessageDefinitionIdentifier() string {
+	return "camt.031.001.01"
+}
+
+func (d *Document03100101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03100101) MessageFunctionality() string {
+	return "031"
+}
+
+func (d *Document03100101) Variant() string {
+	return "001"
+}
+
+func (d *Document03100101) Version() string {
+	return "01"
+}
+
+func (d *Document03100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Reject Case Assignment message is sent by a case assignee to a case creator or case assigner to reject a case given to him.
 // Usage
@@ -40,6 +75,10 @@ type RejectCaseAssignment struct {
 	Justification *model.CaseAssignmentRejectionJustification `xml:"Justfn"`
 }
 
+func (r *RejectCaseAssignment) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RejectCaseAssignment) AddAssignment() *model.CaseAssignment {
 	r.Assignment = new(model.CaseAssignment)
 	return r.Assignment
@@ -16,6 +16,41 @@ func (d *Document03100103) AddMessage() *RejectInvestigationV03 {
 	return d.Message
 }
 
+func (d *Document03100103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.031.001.03"
+}
+
+func (d *Document03100103) MessageDefinitionIdentifier() string {
+	return "camt.031.001.03"
+}
+
+func (d *Document03100103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03100103) MessageFunctionality() string {
+	return "031"
+}
+
+func (d *Document03100103) Variant() string {
+	return "001"
+}
+
+func (d *Document03100103) Version() string {
+	return "03"
+}
+
+func (d *Document03100103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03100103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Reject Investigation message is sent by a case assignee to a case creator or case assigner to reject a case given to him.
 // Usage
@@ -45,6 +80,10 @@ type RejectInvestigationV03 struct {
 	Justification *model.InvestigationRejectionJustification1 `xml:"Justfn"`
 }
 
+func (r *RejectInvestigationV03) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RejectInvestigationV03) AddAssignment() *model.CaseAssignment2 {
 	r.Assignment = new(model.CaseAssignment2)
 	return r.Assignment
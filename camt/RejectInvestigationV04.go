@@ -16,6 +16,41 @@ func (d *Document03100104) AddMessage() *RejectInvestigationV04 {
 	return d.Message
 }
 
+func (d *Document03100104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.031.001.04"
+}
+
+func (d *Document03100104) MessageDefinitionIdentifier() string {
+	return "camt.031.001.04"
+}
+
+func (d *Document03100104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03100104) MessageFunctionality() string {
+	return "031"
+}
+
+func (d *Document03100104) Variant() string {
+	return "001"
+}
+
+func (d *Document03100104) Version() string {
+	return "04"
+}
+
+func (d *Document03100104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03100104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Reject Investigation message is sent by a case assignee to a case creator or case assigner to reject a case given to him.
 // Usage
@@ -48,6 +83,10 @@ type RejectInvestigationV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (r *RejectInvestigationV04) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RejectInvestigationV04) AddAssignment() *model.CaseAssignment3 {
 	r.Assignment = new(model.CaseAssignment3)
 	return r.Assignment
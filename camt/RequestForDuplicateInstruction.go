@@ -16,6 +16,41 @@ func (d *Document03300101) AddMessage() *RequestForDuplicateInstruction {
 	return d.Message
 }
 
+func (d *Document03300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.033.001.01"
+}
+
+func (d *Document03300101) MessageDefinitionIdentifier() string {
+	return "camt.033.001.01"
+}
+
+func (d *Document03300101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03300101) MessageFunctionality() string {
+	return "033"
+}
+
+func (d *Document03300101) Variant() string {
+	return "001"
+}
+
+func (d *Document03300101) Version() string {
+	return "01"
+}
+
+func (d *Document03300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Request For Duplicate message is sent by the case assignee to the case creator or case assigner.
 // This message is used to request a copy of the original payment instruction considered in the case.
@@ -34,6 +69,10 @@ type RequestForDuplicateInstruction struct {
 	Case *model.Case `xml:"Case"`
 }
 
+func (r *RequestForDuplicateInstruction) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RequestForDuplicateInstruction) AddAssignment() *model.CaseAssignment {
 	r.Assignment = new(model.CaseAssignment)
 	return r.Assignment
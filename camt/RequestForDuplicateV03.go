@@ -16,6 +16,41 @@ func (d *Document03300103) AddMessage() *RequestForDuplicateV03 {
 	return d.Message
 }
 
+func (d *Document03300103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.033.001.03"
+}
+
+func (d *Document03300103) MessageDefinitionIdentifier() string {
+	return "camt.033.001.03"
+}
+
+func (d *Document03300103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03300103) MessageFunctionality() string {
+	return "033"
+}
+
+func (d *Document03300103) Variant() string {
+	return "001"
+}
+
+func (d *Document03300103) Version() string {
+	return "03"
+}
+
+func (d *Document03300103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03300103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Request For Duplicate message is sent by the case assignee to the case creator or case assigner.
 // This message is used to request a copy of the original payment instruction considered in the case.
@@ -35,6 +70,10 @@ type RequestForDuplicateV03 struct {
 	Case *model.Case2 `xml:"Case"`
 }
 
+func (r *RequestForDuplicateV03) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RequestForDuplicateV03) AddAssignment() *model.CaseAssignment2 {
 	r.Assignment = new(model.CaseAssignment2)
 	return r.Assignment
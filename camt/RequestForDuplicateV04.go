@@ -16,6 +16,41 @@ func (d *Document03300104) AddMessage() *RequestForDuplicateV04 {
 	return d.Message
 }
 
+func (d *Document03300104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.033.001.04"
+}
+
+func (d *Document03300104) MessageDefinitionIdentifier() string {
+	return "camt.033.001.04"
+}
+
+func (d *Document03300104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document03300104) MessageFunctionality() string {
+	return "033"
+}
+
+func (d *Document03300104) Variant() string {
+	return "001"
+}
+
+func (d *Document03300104) Version() string {
+	return "04"
+}
+
+func (d *Document03300104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03300104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Request For Duplicate message is sent by the case assignee to the case creator or case assigner.
 // This message is used to request a copy of the original payment instruction considered in the case.
@@ -38,6 +73,10 @@ type RequestForDuplicateV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (r *RequestForDuplicateV04) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RequestForDuplicateV04) AddAssignment() *model.CaseAssignment3 {
 	r.Assignment = new(model.CaseAssignment3)
 	return r.Assignment
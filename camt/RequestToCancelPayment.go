@@ -16,6 +16,41 @@ func (d *Document00800201) AddMessage() *RequestToCancelPayment {
 	return d.Message
 }
 
+func (d *Document00800201) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.008.002.01"
+}
+
+func (d *Document00800201) MessageDefinitionIdentifier() string {
+	return "camt.008.002.01"
+}
+
+func (d *Document00800201) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document00800201) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800201) Variant() string {
+	return "002"
+}
+
+func (d *Document00800201) Version() string {
+	return "01"
+}
+
+func (d *Document00800201) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800201) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Request To Cancel Payment message is sent by a case creator/case assigner to a case assignee.
 // This message is used to request the cancellation of an original payment instruction.
@@ -44,6 +79,10 @@ type RequestToCancelPayment struct {
 	Justification *model.DebitAuthorisationDetails `xml:"Justfn"`
 }
 
+func (r *RequestToCancelPayment) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RequestToCancelPayment) AddAssignment() *model.CaseAssignment {
 	r.Assignment = new(model.CaseAssignment)
 	return r.Assignment
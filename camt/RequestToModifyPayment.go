@@ -16,6 +16,41 @@ func (d *Document00700201) AddMessage() *RequestToModifyPayment {
 	return d.Message
 }
 
+func (d *Document00700201) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.007.002.01"
+}
+
+func (d *Document00700201) MessageDefinitionIdentifier() string {
+	return "camt.007.002.01"
+}
+
+func (d *Document00700201) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document00700201) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700201) Variant() string {
+	return "002"
+}
+
+func (d *Document00700201) Version() string {
+	return "01"
+}
+
+func (d *Document00700201) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700201) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Request To Modify Payment message is sent by a case creator/case assigner to a case assignee.
 // This message is used to request the modification of characteristics of an original payment instruction.
@@ -60,6 +95,10 @@ type RequestToModifyPayment struct {
 	Modification *model.RequestedModification `xml:"Mod"`
 }
 
+func (r *RequestToModifyPayment) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RequestToModifyPayment) AddAssignment() *model.CaseAssignment {
 	r.Assignment = new(model.CaseAssignment)
 	return r.Assignment
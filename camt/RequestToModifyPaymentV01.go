@@ -16,6 +16,41 @@ func (d *Document08700101) AddMessage() *RequestToModifyPaymentV01 {
 	return d.Message
 }
 
+func (d *Document08700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.087.001.01"
+}
+
+func (d *Document08700101) MessageDefinitionIdentifier() string {
+	return "camt.087.001.01"
+}
+
+func (d *Document08700101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document08700101) MessageFunctionality() string {
+	return "087"
+}
+
+func (d *Document08700101) Variant() string {
+	return "001"
+}
+
+func (d *Document08700101) Version() string {
+	return "01"
+}
+
+func (d *Document08700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document08700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Request To Modify Payment message is sent by a case creator/case assigner to a case assignee.
 // This message is used to request the modification of characteristics of an original payment instruction.
@@ -64,6 +99,10 @@ type RequestToModifyPaymentV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (r *RequestToModifyPaymentV01) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RequestToModifyPaymentV01) AddAssignment() *model.CaseAssignment3 {
 	r.Assignment = new(model.CaseAssignment3)
 	return r.Assignment
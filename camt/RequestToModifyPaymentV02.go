@@ -16,6 +16,41 @@ func (d *Document08700102) AddMessage() *RequestToModifyPaymentV02 {
 	return d.Message
 }
 
+func (d *Document08700102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.087.001.02"
+}
+
+func (d *Document08700102) MessageDefinitionIdentifier() string {
+	return "camt.087.001.02"
+}
+
+func (d *Document08700102) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document08700102) MessageFunctionality() string {
+	return "087"
+}
+
+func (d *Document08700102) Variant() string {
+	return "001"
+}
+
+func (d *Document08700102) Version() string {
+	return "02"
+}
+
+func (d *Document08700102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document08700102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Request To Modify Payment message is sent by a case creator/case assigner to a case assignee.
 // This message is used to request the modification of characteristics of an original payment instruction.
@@ -64,6 +99,10 @@ type RequestToModifyPaymentV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (r *RequestToModifyPaymentV02) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RequestToModifyPaymentV02) AddAssignment() *model.CaseAssignment3 {
 	r.Assignment = new(model.CaseAssignment3)
 	return r.Assignment
@@ -16,6 +16,41 @@ func (d *Document08700104) AddMessage() *RequestToModifyPaymentV04 {
 	return d.Message
 }
 
+func (d *Document08700104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.087.001.04"
+}
+
+func (d *Document08700104) MessageDefinitionIdentifier() string {
+	return "camt.087.001.04"
+}
+
+func (d *Document08700104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document08700104) MessageFunctionality() string {
+	return "087"
+}
+
+func (d *Document08700104) Variant() string {
+	return "001"
+}
+
+func (d *Document08700104) Version() string {
+	return "04"
+}
+
+func (d *Document08700104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document08700104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The RequestToModifyPayment message is sent by a case creator/case assigner to a case assignee.
 // This message is used to request the modification of characteristics of an original payment instruction.
@@ -64,6 +99,10 @@ type RequestToModifyPaymentV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (r *RequestToModifyPaymentV04) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *RequestToModifyPaymentV04) AddAssignment() *model.CaseAssignment3 {
 	r.Assignment = new(model.CaseAssignment3)
 	return r.Assignment
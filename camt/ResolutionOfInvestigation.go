@@ -16,6 +16,41 @@ func (d *Document02900101) AddMessage() *ResolutionOfInvestigation {
 	return d.Message
 }
 
+func (d *Document02900101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.029.001.01"
+}
+
+func (d *Document02900101) MessageDefinitionIdentifier() string {
+	return "camt.029.001.01"
+}
+
+func (d *Document02900101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02900101) MessageFunctionality() string {
+	return "029"
+}
+
+func (d *Document02900101) Variant() string {
+	return "001"
+}
+
+func (d *Document02900101) Version() string {
+	return "01"
+}
+
+func (d *Document02900101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02900101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Resolution Of Investigation message is sent by a case assignee to a case creator/case assigner.
 // This message is used to inform of the resolution of a case, and optionally provides details about .
@@ -52,6 +87,10 @@ type ResolutionOfInvestigation struct {
 	CorrectionTransaction *model.PaymentInstructionExtract `xml:"CrrctnTx,omitempty"`
 }
 
+func (r *ResolutionOfInvestigation) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *ResolutionOfInvestigation) AddAssignment() *model.CaseAssignment {
 	r.Assignment = new(model.CaseAssignment)
 	return r.Assignment
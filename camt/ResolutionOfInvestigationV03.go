@@ -16,6 +16,41 @@ func (d *Document02900103) AddMessage() *ResolutionOfInvestigationV03 {
 	return d.Message
 }
 
+func (d *Document02900103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.029.001.03"
+}
+
+func (d *Document02900103) MessageDefinitionIdentifier() string {
+	return "camt.029.001.03"
+}
+
+func (d *Document02900103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02900103) MessageFunctionality() string {
+	return "029"
+}
+
+func (d *Document02900103) Variant() string {
+	return "001"
+}
+
+func (d *Document02900103) Version() string {
+	return "03"
+}
+
+func (d *Document02900103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02900103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Resolution Of Investigation message is sent by a case assignee to a case creator/case assigner.
 // This message is used to inform of the resolution of a case, and optionally provides details about .
@@ -63,6 +98,10 @@ type ResolutionOfInvestigationV03 struct {
 	ResolutionRelatedInformation *model.ResolutionInformation1 `xml:"RsltnRltdInf,omitempty"`
 }
 
+func (r *ResolutionOfInvestigationV03) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *ResolutionOfInvestigationV03) AddAssignment() *model.CaseAssignment2 {
 	r.Assignment = new(model.CaseAssignment2)
 	return r.Assignment
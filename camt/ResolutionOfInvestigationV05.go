@@ -16,6 +16,41 @@ func (d *Document02900105) AddMessage() *ResolutionOfInvestigationV05 {
 	return d.Message
 }
 
+func (d *Document02900105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.029.001.05"
+}
+
+func (d *Document02900105) MessageDefinitionIdentifier() string {
+	return "camt.029.001.05"
+}
+
+func (d *Document02900105) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02900105) MessageFunctionality() string {
+	return "029"
+}
+
+func (d *Document02900105) Variant() string {
+	return "001"
+}
+
+func (d *Document02900105) Version() string {
+	return "05"
+}
+
+func (d *Document02900105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02900105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Resolution Of Investigation message is sent by a case assignee to a case creator/case assigner.
 // This message is used to inform of the resolution of a case, and optionally provides details about .
@@ -66,6 +101,10 @@ type ResolutionOfInvestigationV05 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (r *ResolutionOfInvestigationV05) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *ResolutionOfInvestigationV05) AddAssignment() *model.CaseAssignment3 {
 	r.Assignment = new(model.CaseAssignment3)
 	return r.Assignment
@@ -16,6 +16,41 @@ func (d *Document02900106) AddMessage() *ResolutionOfInvestigationV06 {
 	return d.Message
 }
 
+func (d *Document02900106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.029.001.06"
+}
+
+func (d *Document02900106) MessageDefinitionIdentifier() string {
+	return "camt.029.001.06"
+}
+
+func (d *Document02900106) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02900106) MessageFunctionality() string {
+	return "029"
+}
+
+func (d *Document02900106) Variant() string {
+	return "001"
+}
+
+func (d *Document02900106) Version() string {
+	return "06"
+}
+
+func (d *Document02900106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02900106) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ResolutionOfInvestigation message is sent by a case assignee to a case creator/case assigner.
 // This message is used to inform of the resolution of a case, and optionally provides details about .
@@ -66,6 +101,10 @@ type ResolutionOfInvestigationV06 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (r *ResolutionOfInvestigationV06) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *ResolutionOfInvestigationV06) AddAssignment() *model.CaseAssignment3 {
 	r.Assignment = new(model.CaseAssignment3)
 	return r.Assignment
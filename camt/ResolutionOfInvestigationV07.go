@@ -16,6 +16,41 @@ func (d *Document02900107) AddMessage() *ResolutionOfInvestigationV07 {
 	return d.Message
 }
 
+func (d *Document02900107) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.029.001.07"
+}
+
+func (d *Document02900107) MessageDefinitionIdentifier() string {
+	return "camt.029.001.07"
+}
+
+func (d *Document02900107) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02900107) MessageFunctionality() string {
+	return "029"
+}
+
+func (d *Document02900107) Variant() string {
+	return "001"
+}
+
+func (d *Document02900107) Version() string {
+	return "07"
+}
+
+func (d *Document02900107) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02900107) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ResolutionOfInvestigation message is sent by a case assignee to a case creator/case assigner.
 // This message is used to inform of the resolution of a case, and optionally provides details about .
@@ -66,6 +101,10 @@ type ResolutionOfInvestigationV07 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (r *ResolutionOfInvestigationV07) Validate() error {
+	return model.ValidateElement(r)
+}
+
 func (r *ResolutionOfInvestigationV07) AddAssignment() *model.CaseAssignment3 {
 	r.Assignment = new(model.CaseAssignment3)
 	return r.Assignment
@@ -16,6 +16,41 @@ func (d *Document02600101) AddMessage() *UnableToApply {
 	return d.Message
 }
 
+func (d *Document02600101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.026.001.01"
+}
+
+func (d *Document02600101) MessageDefinitionIdentifier() string {
+	return "camt.026.001.01"
+}
+
+func (d *Document02600101) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02600101) MessageFunctionality() string {
+	return "026"
+}
+
+func (d *Document02600101) Variant() string {
+	return "001"
+}
+
+func (d *Document02600101) Version() string {
+	return "01"
+}
+
+func (d *Document02600101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02600101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Unable To Apply message is sent by a case creator or a case assigner to a case assignee. This message is used to initiate an investigation of a payment instruction that cannot be executed or reconciled.
 // Usage
@@ -46,6 +81,10 @@ type UnableToApply struct {
 	Justification *model.UnableToApplyJustificationChoice `xml:"Justfn"`
 }
 
+func (u *UnableToApply) Validate() error {
+	return model.ValidateElement(u)
+}
+
 func (u *UnableToApply) AddAssignment() *model.CaseAssignment {
 	u.Assignment = new(model.CaseAssignment)
 	return u.Assignment
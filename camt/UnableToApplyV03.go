@@ -16,6 +16,41 @@ func (d *Document02600103) AddMessage() *UnableToApplyV03 {
 	return d.Message
 }
 
+func (d *Document02600103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.026.001.03"
+}
+
+func (d *Document02600103) MessageDefinitionIdentifier() string {
+	return "camt.026.001.03"
+}
+
+func (d *Document02600103) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02600103) MessageFunctionality() string {
+	return "026"
+}
+
+func (d *Document02600103) Variant() string {
+	return "001"
+}
+
+func (d *Document02600103) Version() string {
+	return "03"
+}
+
+func (d *Document02600103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02600103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Unable To Apply message is sent by a case creator or a case assigner to a case assignee. This message is used to initiate an investigation of a payment instruction that cannot be executed or reconciled.
 // Usage
@@ -59,6 +94,10 @@ type UnableToApplyV03 struct {
 	Justification *model.UnableToApplyJustification2Choice `xml:"Justfn"`
 }
 
+func (u *UnableToApplyV03) Validate() error {
+	return model.ValidateElement(u)
+}
+
 func (u *UnableToApplyV03) AddAssignment() *model.CaseAssignment2 {
 	u.Assignment = new(model.CaseAssignment2)
 	return u.Assignment
@@ -16,6 +16,41 @@ func (d *Document02600104) AddMessage() *UnableToApplyV04 {
 	return d.Message
 }
 
+func (d *Document02600104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.026.001.04"
+}
+
+func (d *Document02600104) MessageDefinitionIdentifier() string {
+	return "camt.026.001.04"
+}
+
+func (d *Document02600104) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02600104) MessageFunctionality() string {
+	return "026"
+}
+
+func (d *Document02600104) Variant() string {
+	return "001"
+}
+
+func (d *Document02600104) Version() string {
+	return "04"
+}
+
+func (d *Document02600104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02600104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Unable To Apply message is sent by a case creator or a case assigner to a case assignee. This message is used to initiate an investigation of a payment instruction that cannot be executed or reconciled.
 // Usage
@@ -54,6 +89,10 @@ type UnableToApplyV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (u *UnableToApplyV04) Validate() error {
+	return model.ValidateElement(u)
+}
+
 func (u *UnableToApplyV04) AddAssignment() *model.CaseAssignment3 {
 	u.Assignment = new(model.CaseAssignment3)
 	return u.Assignment
@@ -16,6 +16,41 @@ func (d *Document02600105) AddMessage() *UnableToApplyV05 {
 	return d.Message
 }
 
+func (d *Document02600105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:camt.026.001.05"
+}
+
+func (d *Document02600105) MessageDefinitionIdentifier() string {
+	return "camt.026.001.05"
+}
+
+func (d *Document02600105) BusinessArea() string {
+	return "camt"
+}
+
+func (d *Document02600105) MessageFunctionality() string {
+	return "026"
+}
+
+func (d *Document02600105) Variant() string {
+	return "001"
+}
+
+func (d *Document02600105) Version() string {
+	return "05"
+}
+
+func (d *Document02600105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02600105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The Unable To Apply message is sent by a case creator or a case assigner to a case assignee. This message is used to initiate an investigation of a payment instruction that cannot be executed or reconciled.
 // Usage
@@ -54,6 +89,10 @@ type UnableToApplyV05 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (u *UnableToApplyV05) Validate() error {
+	return model.ValidateElement(u)
+}
+
 func (u *UnableToApplyV05) AddAssignment() *model.CaseAssignment3 {
 	u.Assignment = new(model.CaseAssignment3)
 	return u.Assignment
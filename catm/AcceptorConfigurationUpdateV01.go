@@ -16,6 +16,41 @@ func (d *Document00300101) AddMessage() *AcceptorConfigurationUpdateV01 {
 	return d.Message
 }
 
+func (d *Document00300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.003.001.01"
+}
+
+func (d *Document00300101) MessageDefinitionIdentifier() string {
+	return "catm.003.001.01"
+}
+
+func (d *Document00300101) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00300101) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300101) Variant() string {
+	return "001"
+}
+
+func (d *Document00300101) Version() string {
+	return "01"
+}
+
+func (d *Document00300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The AcceptorConfigurationUpdate message is sent by the master terminal manager or delegated terminal manager to the acceptor system for the update of acquirer parameters, merchant parameters, vendor parameters or cryptographic keys of the acquirer.
 // Usage
@@ -36,6 +71,10 @@ type AcceptorConfigurationUpdateV01 struct {
 	SecurityTrailer *model.ContentInformationType1 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorConfigurationUpdateV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorConfigurationUpdateV01) AddHeader() *model.Header4 {
 	a.Header = new(model.Header4)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00300102) AddMessage() *AcceptorConfigurationUpdateV02 {
 	return d.Message
 }
 
+func (d *Document00300102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.003.001.02"
+}
+
+func (d *Document00300102) MessageDefinitionIdentifier() string {
+	return "catm.003.001.02"
+}
+
+func (d *Document00300102) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00300102) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300102) Variant() string {
+	return "001"
+}
+
+func (d *Document00300102) Version() string {
+	return "02"
+}
+
+func (d *Document00300102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Update of the acceptor configuration to be dowloaded by the terminal management system.
 type AcceptorConfigurationUpdateV02 struct {
 
@@ -29,6 +64,10 @@ type AcceptorConfigurationUpdateV02 struct {
 	SecurityTrailer *model.ContentInformationType4 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorConfigurationUpdateV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorConfigurationUpdateV02) AddHeader() *model.Header4 {
 	a.Header = new(model.Header4)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00300103) AddMessage() *AcceptorConfigurationUpdateV03 {
 	return d.Message
 }
 
+func (d *Document00300103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.003.001.03"
+}
+
+func (d *Document00300103) MessageDefinitionIdentifier() string {
+	return "catm.003.001.03"
+}
+
+func (d *Document00300103) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00300103) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300103) Variant() string {
+	return "001"
+}
+
+func (d *Document00300103) Version() string {
+	return "03"
+}
+
+func (d *Document00300103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Update of the acceptor configuration to be dowloaded by the terminal management system.
 type AcceptorConfigurationUpdateV03 struct {
 
@@ -29,6 +64,10 @@ type AcceptorConfigurationUpdateV03 struct {
 	SecurityTrailer *model.ContentInformationType9 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorConfigurationUpdateV03) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorConfigurationUpdateV03) AddHeader() *model.Header4 {
 	a.Header = new(model.Header4)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00300104) AddMessage() *AcceptorConfigurationUpdateV04 {
 	return d.Message
 }
 
+func (d *Document00300104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.003.001.04"
+}
+
+func (d *Document00300104) MessageDefinitionIdentifier() string {
+	return "catm.003.001.04"
+}
+
+func (d *Document00300104) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00300104) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300104) Variant() string {
+	return "001"
+}
+
+func (d *Document00300104) Version() string {
+	return "04"
+}
+
+func (d *Document00300104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Update of the acceptor configuration to be downloaded by the terminal management system.
 type AcceptorConfigurationUpdateV04 struct {
 
@@ -29,6 +64,10 @@ type AcceptorConfigurationUpdateV04 struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr"`
 }
 
+func (a *AcceptorConfigurationUpdateV04) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorConfigurationUpdateV04) AddHeader() *model.Header14 {
 	a.Header = new(model.Header14)
 	return a.Header
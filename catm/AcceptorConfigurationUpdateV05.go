@@ -16,6 +16,41 @@ func (d *Document00300105) AddMessage() *AcceptorConfigurationUpdateV05 {
 	return d.Message
 }
 
+func (d *Document00300105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.003.001.05"
+}
+
+func (d *Document00300105) MessageDefinitionIdentifier() string {
+	return "catm.003.001.05"
+}
+
+func (d *Document00300105) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00300105) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300105) Variant() string {
+	return "001"
+}
+
+func (d *Document00300105) Version() string {
+	return "05"
+}
+
+func (d *Document00300105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Update of the acceptor configuration to be downloaded by the terminal management system.
 type AcceptorConfigurationUpdateV05 struct {
 
@@ -29,6 +64,10 @@ type AcceptorConfigurationUpdateV05 struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *AcceptorConfigurationUpdateV05) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *AcceptorConfigurationUpdateV05) AddHeader() *model.Header27 {
 	a.Header = new(model.Header27)
 	return a.Header
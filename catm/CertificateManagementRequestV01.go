@@ -16,6 +16,41 @@ func (d *Document00700101) AddMessage() *CertificateManagementRequestV01 {
 	return d.Message
 }
 
+func (d *Document00700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.007.001.01"
+}
+
+func (d *Document00700101) MessageDefinitionIdentifier() string {
+	return "catm.007.001.01"
+}
+
+func (d *Document00700101) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00700101) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700101) Variant() string {
+	return "001"
+}
+
+func (d *Document00700101) Version() string {
+	return "01"
+}
+
+func (d *Document00700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The certificate management request message is sent by a POI terminal or any intermediary entity either to a terminal manager acting as a certificate authority for  managing X.509 certificate of a public key owned by the initiating party, or for requesting the inclusion or the removal of the POI to a white list of the terminal manager.
 //
 type CertificateManagementRequestV01 struct {
@@ -30,6 +65,10 @@ type CertificateManagementRequestV01 struct {
 	SecurityTrailer *model.ContentInformationType13 `xml:"SctyTrlr,omitempty"`
 }
 
+func (c *CertificateManagementRequestV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CertificateManagementRequestV01) AddHeader() *model.Header29 {
 	c.Header = new(model.Header29)
 	return c.Header
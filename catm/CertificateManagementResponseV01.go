@@ -16,6 +16,41 @@ func (d *Document00800101) AddMessage() *CertificateManagementResponseV01 {
 	return d.Message
 }
 
+func (d *Document00800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.008.001.01"
+}
+
+func (d *Document00800101) MessageDefinitionIdentifier() string {
+	return "catm.008.001.01"
+}
+
+func (d *Document00800101) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00800101) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800101) Variant() string {
+	return "001"
+}
+
+func (d *Document00800101) Version() string {
+	return "01"
+}
+
+func (d *Document00800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The CertificateManagementResponse is sent by a terminal manager in response to a CertificateManagementRequest to provide the outcome of the requested service.
 type CertificateManagementResponseV01 struct {
 
@@ -29,6 +64,10 @@ type CertificateManagementResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType13 `xml:"SctyTrlr,omitempty"`
 }
 
+func (c *CertificateManagementResponseV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CertificateManagementResponseV01) AddHeader() *model.Header29 {
 	c.Header = new(model.Header29)
 	return c.Header
@@ -16,6 +16,41 @@ func (d *Document00500101) AddMessage() *MaintenanceDelegationRequestV01 {
 	return d.Message
 }
 
+func (d *Document00500101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.005.001.01"
+}
+
+func (d *Document00500101) MessageDefinitionIdentifier() string {
+	return "catm.005.001.01"
+}
+
+func (d *Document00500101) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00500101) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500101) Variant() string {
+	return "001"
+}
+
+func (d *Document00500101) Version() string {
+	return "01"
+}
+
+func (d *Document00500101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // A terminal manager requests to the master terminal manager the delegation of maintenance functions or maintenance operation on the terminal estate managed by the master terminal manager.
 type MaintenanceDelegationRequestV01 struct {
 
@@ -29,6 +64,10 @@ type MaintenanceDelegationRequestV01 struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr"`
 }
 
+func (m *MaintenanceDelegationRequestV01) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MaintenanceDelegationRequestV01) AddHeader() *model.Header16 {
 	m.Header = new(model.Header16)
 	return m.Header
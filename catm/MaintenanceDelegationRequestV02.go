@@ -16,6 +16,41 @@ func (d *Document00500102) AddMessage() *MaintenanceDelegationRequestV02 {
 	return d.Message
 }
 
+func (d *Document00500102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.005.001.02"
+}
+
+func (d *Document00500102) MessageDefinitionIdentifier() string {
+	return "catm.005.001.02"
+}
+
+func (d *Document00500102) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00500102) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500102) Variant() string {
+	return "001"
+}
+
+func (d *Document00500102) Version() string {
+	return "02"
+}
+
+func (d *Document00500102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // A terminal manager requests to the master terminal manager the delegation of maintenance functions or maintenance operation on the terminal estate managed by the master terminal manager.
 type MaintenanceDelegationRequestV02 struct {
 
@@ -29,6 +64,10 @@ type MaintenanceDelegationRequestV02 struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr"`
 }
 
+func (m *MaintenanceDelegationRequestV02) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MaintenanceDelegationRequestV02) AddHeader() *model.Header29 {
 	m.Header = new(model.Header29)
 	return m.Header
@@ -16,6 +16,41 @@ func (d *Document00600101) AddMessage() *MaintenanceDelegationResponseV01 {
 	return d.Message
 }
 
+func (d *Document00600101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.006.001.01"
+}
+
+func (d *Document00600101) MessageDefinitionIdentifier() string {
+	return "catm.006.001.01"
+}
+
+func (d *Document00600101) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00600101) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600101) Variant() string {
+	return "001"
+}
+
+func (d *Document00600101) Version() string {
+	return "01"
+}
+
+func (d *Document00600101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The master terminal manager provides the outcome of a maintenance delegation request to a terminal manager.
 type MaintenanceDelegationResponseV01 struct {
 
@@ -29,6 +64,10 @@ type MaintenanceDelegationResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr"`
 }
 
+func (m *MaintenanceDelegationResponseV01) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MaintenanceDelegationResponseV01) AddHeader() *model.Header16 {
 	m.Header = new(model.Header16)
 	return m.Header
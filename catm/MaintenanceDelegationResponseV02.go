@@ -16,6 +16,41 @@ func (d *Document00600102) AddMessage() *MaintenanceDelegationResponseV02 {
 	return d.Message
 }
 
+func (d *Document00600102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.006.001.02"
+}
+
+func (d *Document00600102) MessageDefinitionIdentifier() string {
+	return "catm.006.001.02"
+}
+
+func (d *Document00600102) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00600102) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600102) Variant() string {
+	return "001"
+}
+
+func (d *Document00600102) Version() string {
+	return "02"
+}
+
+func (d *Document00600102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The master terminal manager provides the outcome of a maintenance delegation request to a terminal manager.
 type MaintenanceDelegationResponseV02 struct {
 
@@ -29,6 +64,10 @@ type MaintenanceDelegationResponseV02 struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr,omitempty"`
 }
 
+func (m *MaintenanceDelegationResponseV02) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MaintenanceDelegationResponseV02) AddHeader() *model.Header29 {
 	m.Header = new(model.Header29)
 	return m.Header
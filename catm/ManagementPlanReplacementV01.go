@@ -16,6 +16,41 @@ func (d *Document00200101) AddMessage() *ManagementPlanReplacementV01 {
 	return d.Message
 }
 
+func (d *Document00200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.002.001.01"
+}
+
+func (d *Document00200101) MessageDefinitionIdentifier() string {
+	return "catm.002.001.01"
+}
+
+func (d *Document00200101) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00200101) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200101) Variant() string {
+	return "001"
+}
+
+func (d *Document00200101) Version() string {
+	return "01"
+}
+
+func (d *Document00200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ManagementPlanReplacement message is sent by the master terminal manager or delegated terminal manager to the acceptor system to replace the TMS action list of the POI system.
 // Usage
@@ -32,6 +67,10 @@ type ManagementPlanReplacementV01 struct {
 	SecurityTrailer *model.ContentInformationType1 `xml:"SctyTrlr"`
 }
 
+func (m *ManagementPlanReplacementV01) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *ManagementPlanReplacementV01) AddHeader() *model.Header4 {
 	m.Header = new(model.Header4)
 	return m.Header
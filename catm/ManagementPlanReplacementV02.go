@@ -16,6 +16,41 @@ func (d *Document00200102) AddMessage() *ManagementPlanReplacementV02 {
 	return d.Message
 }
 
+func (d *Document00200102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.002.001.02"
+}
+
+func (d *Document00200102) MessageDefinitionIdentifier() string {
+	return "catm.002.001.02"
+}
+
+func (d *Document00200102) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00200102) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200102) Variant() string {
+	return "001"
+}
+
+func (d *Document00200102) Version() string {
+	return "02"
+}
+
+func (d *Document00200102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Terminal maintenance actions to be performed by a point of interaction (POI).
 type ManagementPlanReplacementV02 struct {
 
@@ -29,6 +64,10 @@ type ManagementPlanReplacementV02 struct {
 	SecurityTrailer *model.ContentInformationType4 `xml:"SctyTrlr"`
 }
 
+func (m *ManagementPlanReplacementV02) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *ManagementPlanReplacementV02) AddHeader() *model.Header4 {
 	m.Header = new(model.Header4)
 	return m.Header
@@ -16,6 +16,41 @@ func (d *Document00200103) AddMessage() *ManagementPlanReplacementV03 {
 	return d.Message
 }
 
+func (d *Document00200103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.002.001.03"
+}
+
+func (d *Document00200103) MessageDefinitionIdentifier() string {
+	return "catm.002.001.03"
+}
+
+func (d *Document00200103) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00200103) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200103) Variant() string {
+	return "001"
+}
+
+func (d *Document00200103) Version() string {
+	return "03"
+}
+
+func (d *Document00200103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Terminal maintenance actions to be performed by a point of interaction (POI).
 type ManagementPlanReplacementV03 struct {
 
@@ -29,6 +64,10 @@ type ManagementPlanReplacementV03 struct {
 	SecurityTrailer *model.ContentInformationType9 `xml:"SctyTrlr"`
 }
 
+func (m *ManagementPlanReplacementV03) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *ManagementPlanReplacementV03) AddHeader() *model.Header4 {
 	m.Header = new(model.Header4)
 	return m.Header
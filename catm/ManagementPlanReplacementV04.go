@@ -16,6 +16,41 @@ func (d *Document00200104) AddMessage() *ManagementPlanReplacementV04 {
 	return d.Message
 }
 
+func (d *Document00200104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.002.001.04"
+}
+
+func (d *Document00200104) MessageDefinitionIdentifier() string {
+	return "catm.002.001.04"
+}
+
+func (d *Document00200104) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00200104) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200104) Variant() string {
+	return "001"
+}
+
+func (d *Document00200104) Version() string {
+	return "04"
+}
+
+func (d *Document00200104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Terminal maintenance actions to be performed by a point of interaction (POI).
 type ManagementPlanReplacementV04 struct {
 
@@ -29,6 +64,10 @@ type ManagementPlanReplacementV04 struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr"`
 }
 
+func (m *ManagementPlanReplacementV04) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *ManagementPlanReplacementV04) AddHeader() *model.Header14 {
 	m.Header = new(model.Header14)
 	return m.Header
@@ -16,6 +16,41 @@ func (d *Document00200105) AddMessage() *ManagementPlanReplacementV05 {
 	return d.Message
 }
 
+func (d *Document00200105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.002.001.05"
+}
+
+func (d *Document00200105) MessageDefinitionIdentifier() string {
+	return "catm.002.001.05"
+}
+
+func (d *Document00200105) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00200105) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200105) Variant() string {
+	return "001"
+}
+
+func (d *Document00200105) Version() string {
+	return "05"
+}
+
+func (d *Document00200105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Terminal maintenance actions to be performed by a point of interaction (POI).
 type ManagementPlanReplacementV05 struct {
 
@@ -29,6 +64,10 @@ type ManagementPlanReplacementV05 struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr,omitempty"`
 }
 
+func (m *ManagementPlanReplacementV05) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *ManagementPlanReplacementV05) AddHeader() *model.Header27 {
 	m.Header = new(model.Header27)
 	return m.Header
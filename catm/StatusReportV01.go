@@ -16,6 +16,41 @@ func (d *Document00100101) AddMessage() *StatusReportV01 {
 	return d.Message
 }
 
+func (d *Document00100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.001.001.01"
+}
+
+func (d *Document00100101) MessageDefinitionIdentifier() string {
+	return "catm.001.001.01"
+}
+
+func (d *Document00100101) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00100101) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100101) Variant() string {
+	return "001"
+}
+
+func (d *Document00100101) Version() string {
+	return "01"
+}
+
+func (d *Document00100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The StatusReport message is sent by the card acceptor to the master terminal manager or delegated terminal manager to inform these entities about the status of the acceptor system.
 // Usage
@@ -32,6 +67,10 @@ type StatusReportV01 struct {
 	SecurityTrailer *model.ContentInformationType1 `xml:"SctyTrlr"`
 }
 
+func (s *StatusReportV01) Validate() error {
+	return model.ValidateElement(s)
+}
+
 func (s *StatusReportV01) AddHeader() *model.Header4 {
 	s.Header = new(model.Header4)
 	return s.Header
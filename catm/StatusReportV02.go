@@ -16,6 +16,41 @@ func (d *Document00100102) AddMessage() *StatusReportV02 {
 	return d.Message
 }
 
+func (d *Document00100102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.001.001.02"
+}
+
+func (d *Document00100102) MessageDefinitionIdentifier() string {
+	return "catm.001.001.02"
+}
+
+func (d *Document00100102) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00100102) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100102) Variant() string {
+	return "001"
+}
+
+func (d *Document00100102) Version() string {
+	return "02"
+}
+
+func (d *Document00100102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Informs the master terminal manager (MTM) or the terminal manager (TM) about the status of the acceptor system including the identification of the POI, its components and their installed versions.
 type StatusReportV02 struct {
 
@@ -29,6 +64,10 @@ type StatusReportV02 struct {
 	SecurityTrailer *model.ContentInformationType4 `xml:"SctyTrlr"`
 }
 
+func (s *StatusReportV02) Validate() error {
+	return model.ValidateElement(s)
+}
+
 func (s *StatusReportV02) AddHeader() *model.Header4 {
 	s.Header = new(model.Header4)
 	return s.Header
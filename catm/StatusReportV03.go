@@ -16,6 +16,41 @@ func (d *Document00100103) AddMessage() *StatusReportV03 {
 	return d.Message
 }
 
+func (d *Document00100103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.001.001.03"
+}
+
+func (d *Document00100103) MessageDefinitionIdentifier() string {
+	return "catm.001.001.03"
+}
+
+func (d *Document00100103) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00100103) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100103) Variant() string {
+	return "001"
+}
+
+func (d *Document00100103) Version() string {
+	return "03"
+}
+
+func (d *Document00100103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Informs the master terminal manager (MTM) or the terminal manager (TM) about the status of the acceptor system including the identification of the POI, its components and their installed versions.
 type StatusReportV03 struct {
 
@@ -29,6 +64,10 @@ type StatusReportV03 struct {
 	SecurityTrailer *model.ContentInformationType9 `xml:"SctyTrlr"`
 }
 
+func (s *StatusReportV03) Validate() error {
+	return model.ValidateElement(s)
+}
+
 func (s *StatusReportV03) AddHeader() *model.Header4 {
 	s.Header = new(model.Header4)
 	return s.Header
@@ -16,6 +16,41 @@ func (d *Document00100104) AddMessage() *StatusReportV04 {
 	return d.Message
 }
 
+func (d *Document00100104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.001.001.04"
+}
+
+func (d *Document00100104) MessageDefinitionIdentifier() string {
+	return "catm.001.001.04"
+}
+
+func (d *Document00100104) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00100104) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100104) Variant() string {
+	return "001"
+}
+
+func (d *Document00100104) Version() string {
+	return "04"
+}
+
+func (d *Document00100104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Informs the master terminal manager (MTM) or the terminal manager (TM) about the status of the acceptor system including the identification of the POI, its components and their installed versions.
 type StatusReportV04 struct {
 
@@ -29,6 +64,10 @@ type StatusReportV04 struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr"`
 }
 
+func (s *StatusReportV04) Validate() error {
+	return model.ValidateElement(s)
+}
+
 func (s *StatusReportV04) AddHeader() *model.Header14 {
 	s.Header = new(model.Header14)
 	return s.Header
@@ -16,6 +16,41 @@ func (d *Document00100105) AddMessage() *StatusReportV05 {
 	return d.Message
 }
 
+func (d *Document00100105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.001.001.05"
+}
+
+func (d *Document00100105) MessageDefinitionIdentifier() string {
+	return "catm.001.001.05"
+}
+
+func (d *Document00100105) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00100105) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100105) Variant() string {
+	return "001"
+}
+
+func (d *Document00100105) Version() string {
+	return "05"
+}
+
+func (d *Document00100105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Informs the master terminal manager (MTM) or the terminal manager (TM) about the status of the acceptor system including the identification of the POI, its components and their installed versions.
 type StatusReportV05 struct {
 
@@ -29,6 +64,10 @@ type StatusReportV05 struct {
 	SecurityTrailer *model.ContentInformationType12 `xml:"SctyTrlr,omitempty"`
 }
 
+func (s *StatusReportV05) Validate() error {
+	return model.ValidateElement(s)
+}
+
 func (s *StatusReportV05) AddHeader() *model.Header27 {
 	s.Header = new(model.Header27)
 	return s.Header
@@ -16,6 +16,41 @@ func (d *Document00400101) AddMessage() *TerminalManagementRejectionV01 {
 	return d.Message
 }
 
+func (d *Document00400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.004.001.01"
+}
+
+func (d *Document00400101) MessageDefinitionIdentifier() string {
+	return "catm.004.001.01"
+}
+
+func (d *Document00400101) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00400101) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400101) Variant() string {
+	return "001"
+}
+
+func (d *Document00400101) Version() string {
+	return "01"
+}
+
+func (d *Document00400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The TerminalManagementRejection message is sent by the terminal manager to reject a message request sent by an acceptor, to indicate that the received message could not be processed.
 type TerminalManagementRejectionV01 struct {
 
@@ -26,6 +61,10 @@ type TerminalManagementRejectionV01 struct {
 	Reject *model.AcceptorRejection1 `xml:"Rjct"`
 }
 
+func (t *TerminalManagementRejectionV01) Validate() error {
+	return model.ValidateElement(t)
+}
+
 func (t *TerminalManagementRejectionV01) AddHeader() *model.Header6 {
 	t.Header = new(model.Header6)
 	return t.Header
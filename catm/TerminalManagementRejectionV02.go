@@ -16,6 +16,41 @@ func (d *Document00400102) AddMessage() *TerminalManagementRejectionV02 {
 	return d.Message
 }
 
+func (d *Document00400102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.004.001.02"
+}
+
+func (d *Document00400102) MessageDefinitionIdentifier() string {
+	return "catm.004.001.02"
+}
+
+func (d *Document00400102) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00400102) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400102) Variant() string {
+	return "001"
+}
+
+func (d *Document00400102) Version() string {
+	return "02"
+}
+
+func (d *Document00400102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The TerminalManagementRejection message is sent by the terminal manager to reject a message request sent by an acceptor, to indicate that the received message could not be processed.
 type TerminalManagementRejectionV02 struct {
 
@@ -26,6 +61,10 @@ type TerminalManagementRejectionV02 struct {
 	Reject *model.AcceptorRejection2 `xml:"Rjct"`
 }
 
+func (t *TerminalManagementRejectionV02) Validate() error {
+	return model.ValidateElement(t)
+}
+
 func (t *TerminalManagementRejectionV02) AddHeader() *model.Header6 {
 	t.Header = new(model.Header6)
 	return t.Header
@@ -16,6 +16,41 @@ func (d *Document00400103) AddMessage() *TerminalManagementRejectionV03 {
 	return d.Message
 }
 
+func (d *Document00400103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.004.001.03"
+}
+
+func (d *Document00400103) MessageDefinitionIdentifier() string {
+	return "catm.004.001.03"
+}
+
+func (d *Document00400103) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00400103) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400103) Variant() string {
+	return "001"
+}
+
+func (d *Document00400103) Version() string {
+	return "03"
+}
+
+func (d *Document00400103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The TerminalManagementRejection message is sent by the terminal manager to reject a message request sent by an acceptor, to indicate that the received message could not be processed.
 type TerminalManagementRejectionV03 struct {
 
@@ -26,6 +61,10 @@ type TerminalManagementRejectionV03 struct {
 	Reject *model.AcceptorRejection3 `xml:"Rjct"`
 }
 
+func (t *TerminalManagementRejectionV03) Validate() error {
+	return model.ValidateElement(t)
+}
+
 func (t *TerminalManagementRejectionV03) AddHeader() *model.Header15 {
 	t.Header = new(model.Header15)
 	return t.Header
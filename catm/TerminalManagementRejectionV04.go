@@ -16,6 +16,41 @@ func (d *Document00400104) AddMessage() *TerminalManagementRejectionV04 {
 	return d.Message
 }
 
+func (d *Document00400104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catm.004.001.04"
+}
+
+func (d *Document00400104) MessageDefinitionIdentifier() string {
+	return "catm.004.001.04"
+}
+
+func (d *Document00400104) BusinessArea() string {
+	return "catm"
+}
+
+func (d *Document00400104) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400104) Variant() string {
+	return "001"
+}
+
+func (d *Document00400104) Version() string {
+	return "04"
+}
+
+func (d *Document00400104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The TerminalManagementRejection message is sent by the terminal manager to reject a message request sent by an acceptor, to indicate that the received message could not be processed.
 type TerminalManagementRejectionV04 struct {
 
@@ -26,6 +61,10 @@ type TerminalManagementRejectionV04 struct {
 	Reject *model.AcceptorRejection3 `xml:"Rjct"`
 }
 
+func (t *TerminalManagementRejectionV04) Validate() error {
+	return model.ValidateElement(t)
+}
+
 func (t *TerminalManagementRejectionV04) AddHeader() *model.Header28 {
 	t.Header = new(model.Header28)
 	return t.Header
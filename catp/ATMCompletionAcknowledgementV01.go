@@ -16,6 +16,41 @@ func (d *Document00900101) AddMessage() *ATMCompletionAcknowledgementV01 {
 	return d.Message
 }
 
+func (d *Document00900101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.009.001.01"
+}
+
+func (d *Document00900101) MessageDefinitionIdentifier() string {
+	return "catp.009.001.01"
+}
+
+func (d *Document00900101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00900101) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900101) Variant() string {
+	return "001"
+}
+
+func (d *Document00900101) Version() string {
+	return "01"
+}
+
+func (d *Document00900101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMCompletionAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMCompletionAdvice message.
 type ATMCompletionAcknowledgementV01 struct {
 
@@ -32,6 +67,10 @@ type ATMCompletionAcknowledgementV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMCompletionAcknowledgementV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMCompletionAcknowledgementV01) AddHeader() *model.Header21 {
 	a.Header = new(model.Header21)
 	return a.Header
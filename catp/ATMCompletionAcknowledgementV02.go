@@ -16,6 +16,41 @@ func (d *Document00900102) AddMessage() *ATMCompletionAcknowledgementV02 {
 	return d.Message
 }
 
+func (d *Document00900102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.009.001.02"
+}
+
+func (d *Document00900102) MessageDefinitionIdentifier() string {
+	return "catp.009.001.02"
+}
+
+func (d *Document00900102) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00900102) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900102) Variant() string {
+	return "001"
+}
+
+func (d *Document00900102) Version() string {
+	return "02"
+}
+
+func (d *Document00900102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMCompletionAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMCompletionAdvice message.
 type ATMCompletionAcknowledgementV02 struct {
 
@@ -32,6 +67,10 @@ type ATMCompletionAcknowledgementV02 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMCompletionAcknowledgementV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMCompletionAcknowledgementV02) AddHeader() *model.Header32 {
 	a.Header = new(model.Header32)
 	return a.Header
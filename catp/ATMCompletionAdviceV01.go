@@ -16,6 +16,41 @@ func (d *Document00800101) AddMessage() *ATMCompletionAdviceV01 {
 	return d.Message
 }
 
+func (d *Document00800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.008.001.01"
+}
+
+func (d *Document00800101) MessageDefinitionIdentifier() string {
+	return "catp.008.001.01"
+}
+
+func (d *Document00800101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00800101) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800101) Variant() string {
+	return "001"
+}
+
+func (d *Document00800101) Version() string {
+	return "01"
+}
+
+func (d *Document00800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMCompletionAdvice message is sent by an ATM to an acquirer or its agent to inform of the result of a transaction performed on the ATM.
 type ATMCompletionAdviceV01 struct {
 
@@ -32,6 +67,10 @@ type ATMCompletionAdviceV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMCompletionAdviceV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMCompletionAdviceV01) AddHeader() *model.Header21 {
 	a.Header = new(model.Header21)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00800102) AddMessage() *ATMCompletionAdviceV02 {
 	return d.Message
 }
 
+func (d *Document00800102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.008.001.02"
+}
+
+func (d *Document00800102) MessageDefinitionIdentifier() string {
+	return "catp.008.001.02"
+}
+
+func (d *Document00800102) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00800102) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800102) Variant() string {
+	return "001"
+}
+
+func (d *Document00800102) Version() string {
+	return "02"
+}
+
+func (d *Document00800102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMCompletionAdvice message is sent by an ATM to an acquirer or its agent to inform of the result of a transaction performed on the ATM.
 type ATMCompletionAdviceV02 struct {
 
@@ -32,6 +67,10 @@ type ATMCompletionAdviceV02 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMCompletionAdviceV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMCompletionAdviceV02) AddHeader() *model.Header32 {
 	a.Header = new(model.Header32)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01500101) AddMessage() *ATMDepositCompletionAcknowledgementV01
 	return d.Message
 }
 
+func (d *Document01500101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.015.001.01"
+}
+
+func (d *Document01500101) MessageDefinitionIdentifier() string {
+	return "catp.015.001.01"
+}
+
+func (d *Document01500101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document01500101) MessageFunctionality() string {
+	return "015"
+}
+
+func (d *Document01500101) Variant() string {
+	return "001"
+}
+
+func (d *Document01500101) Version() string {
+	return "01"
+}
+
+func (d *Document01500101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01500101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMDepositCompletionAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMDepositCompletionAdvice message.
 type ATMDepositCompletionAcknowledgementV01 struct {
 
@@ -32,6 +67,10 @@ type ATMDepositCompletionAcknowledgementV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMDepositCompletionAcknowledgementV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMDepositCompletionAcknowledgementV01) AddHeader() *model.Header32 {
 	a.Header = new(model.Header32)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01400101) AddMessage() *ATMDepositCompletionAdviceV01 {
 	return d.Message
 }
 
+func (d *Document01400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.014.001.01"
+}
+
+func (d *Document01400101) MessageDefinitionIdentifier() string {
+	return "catp.014.001.01"
+}
+
+func (d *Document01400101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document01400101) MessageFunctionality() string {
+	return "014"
+}
+
+func (d *Document01400101) Variant() string {
+	return "001"
+}
+
+func (d *Document01400101) Version() string {
+	return "01"
+}
+
+func (d *Document01400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMDepositCompletionAdvice message is sent by an ATM to an acquirer or its agent to inform of the result of a deposit transaction at an ATM.
 // If the ATM is configured to only send negative completion, a generic completion message should be used instead of ATMCompletionAdvice.
 // This message can be used each time a bundle is put in the ATM safe and/or at the end of a multi bundle deposit.
@@ -34,6 +69,10 @@ type ATMDepositCompletionAdviceV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMDepositCompletionAdviceV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMDepositCompletionAdviceV01) AddHeader() *model.Header32 {
 	a.Header = new(model.Header32)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01200101) AddMessage() *ATMDepositRequestV01 {
 	return d.Message
 }
 
+func (d *Document01200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.012.001.01"
+}
+
+func (d *Document01200101) MessageDefinitionIdentifier() string {
+	return "catp.012.001.01"
+}
+
+func (d *Document01200101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document01200101) MessageFunctionality() string {
+	return "012"
+}
+
+func (d *Document01200101) Variant() string {
+	return "001"
+}
+
+func (d *Document01200101) Version() string {
+	return "01"
+}
+
+func (d *Document01200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMDepositRequest message is sent by an ATM to an acquirer or its agent to request the approval of a deposit transaction at an ATM, before or after deposit media inside the ATM.
 type ATMDepositRequestV01 struct {
 
@@ -32,6 +67,10 @@ type ATMDepositRequestV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMDepositRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMDepositRequestV01) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
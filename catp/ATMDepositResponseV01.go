@@ -16,6 +16,41 @@ func (d *Document01300101) AddMessage() *ATMDepositResponseV01 {
 	return d.Message
 }
 
+func (d *Document01300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.013.001.01"
+}
+
+func (d *Document01300101) MessageDefinitionIdentifier() string {
+	return "catp.013.001.01"
+}
+
+func (d *Document01300101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document01300101) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300101) Variant() string {
+	return "001"
+}
+
+func (d *Document01300101) Version() string {
+	return "01"
+}
+
+func (d *Document01300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMDepositResponse message is sent by an ATM manager or its agent to inform the ATM of the approval or decline of the deposit transaction.
 type ATMDepositResponseV01 struct {
 
@@ -32,6 +67,10 @@ type ATMDepositResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMDepositResponseV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMDepositResponseV01) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
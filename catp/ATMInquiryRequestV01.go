@@ -16,6 +16,41 @@ func (d *Document00600101) AddMessage() *ATMInquiryRequestV01 {
 	return d.Message
 }
 
+func (d *Document00600101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.006.001.01"
+}
+
+func (d *Document00600101) MessageDefinitionIdentifier() string {
+	return "catp.006.001.01"
+}
+
+func (d *Document00600101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00600101) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600101) Variant() string {
+	return "001"
+}
+
+func (d *Document00600101) Version() string {
+	return "01"
+}
+
+func (d *Document00600101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMInquiryRequest message is sent by an ATM to an ATM manager to request information about a customer (for example card, account).
 type ATMInquiryRequestV01 struct {
 
@@ -32,6 +67,10 @@ type ATMInquiryRequestV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMInquiryRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMInquiryRequestV01) AddHeader() *model.Header20 {
 	a.Header = new(model.Header20)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00600102) AddMessage() *ATMInquiryRequestV02 {
 	return d.Message
 }
 
+func (d *Document00600102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.006.001.02"
+}
+
+func (d *Document00600102) MessageDefinitionIdentifier() string {
+	return "catp.006.001.02"
+}
+
+func (d *Document00600102) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00600102) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600102) Variant() string {
+	return "001"
+}
+
+func (d *Document00600102) Version() string {
+	return "02"
+}
+
+func (d *Document00600102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMInquiryRequest message is sent by an ATM to an ATM manager to request information about a customer (for example card, account).
 type ATMInquiryRequestV02 struct {
 
@@ -32,6 +67,10 @@ type ATMInquiryRequestV02 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMInquiryRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMInquiryRequestV02) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00700101) AddMessage() *ATMInquiryResponseV01 {
 	return d.Message
 }
 
+func (d *Document00700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.007.001.01"
+}
+
+func (d *Document00700101) MessageDefinitionIdentifier() string {
+	return "catp.007.001.01"
+}
+
+func (d *Document00700101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00700101) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700101) Variant() string {
+	return "001"
+}
+
+func (d *Document00700101) Version() string {
+	return "01"
+}
+
+func (d *Document00700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMInquiryResponse message is sent by an ATM manager or its agent to the ATM to provide the information and the outcome of the verifications requested in the ATMInquiryRequest.
 type ATMInquiryResponseV01 struct {
 
@@ -32,6 +67,10 @@ type ATMInquiryResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMInquiryResponseV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMInquiryResponseV01) AddHeader() *model.Header20 {
 	a.Header = new(model.Header20)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00700102) AddMessage() *ATMInquiryResponseV02 {
 	return d.Message
 }
 
+func (d *Document00700102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.007.001.02"
+}
+
+func (d *Document00700102) MessageDefinitionIdentifier() string {
+	return "catp.007.001.02"
+}
+
+func (d *Document00700102) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00700102) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700102) Variant() string {
+	return "001"
+}
+
+func (d *Document00700102) Version() string {
+	return "02"
+}
+
+func (d *Document00700102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMInquiryResponse message is sent by an ATM manager or its agent to the ATM to provide the information and the outcome of the verifications requested in the ATMInquiryRequest.
 type ATMInquiryResponseV02 struct {
 
@@ -32,6 +67,10 @@ type ATMInquiryResponseV02 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMInquiryResponseV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMInquiryResponseV02) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
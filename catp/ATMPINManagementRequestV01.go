@@ -16,6 +16,41 @@ func (d *Document01000101) AddMessage() *ATMPINManagementRequestV01 {
 	return d.Message
 }
 
+func (d *Document01000101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.010.001.01"
+}
+
+func (d *Document01000101) MessageDefinitionIdentifier() string {
+	return "catp.010.001.01"
+}
+
+func (d *Document01000101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document01000101) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000101) Variant() string {
+	return "001"
+}
+
+func (d *Document01000101) Version() string {
+	return "01"
+}
+
+func (d *Document01000101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMPINManagementRequest message is sent by an ATM to an ATM manager to request an operation on the cardholder PIN.
 type ATMPINManagementRequestV01 struct {
 
@@ -32,6 +67,10 @@ type ATMPINManagementRequestV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMPINManagementRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMPINManagementRequestV01) AddHeader() *model.Header20 {
 	a.Header = new(model.Header20)
 	return a.Header
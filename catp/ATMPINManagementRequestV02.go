@@ -16,6 +16,41 @@ func (d *Document01000102) AddMessage() *ATMPINManagementRequestV02 {
 	return d.Message
 }
 
+func (d *Document01000102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.010.001.02"
+}
+
+func (d *Document01000102) MessageDefinitionIdentifier() string {
+	return "catp.010.001.02"
+}
+
+func (d *Document01000102) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document01000102) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000102) Variant() string {
+	return "001"
+}
+
+func (d *Document01000102) Version() string {
+	return "02"
+}
+
+func (d *Document01000102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMPINManagementRequest message is sent by an ATM to an ATM manager to request an operation on the cardholder PIN.
 type ATMPINManagementRequestV02 struct {
 
@@ -32,6 +67,10 @@ type ATMPINManagementRequestV02 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMPINManagementRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMPINManagementRequestV02) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01100101) AddMessage() *ATMPINManagementResponseV01 {
 	return d.Message
 }
 
+func (d *Document01100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.011.001.01"
+}
+
+func (d *Document01100101) MessageDefinitionIdentifier() string {
+	return "catp.011.001.01"
+}
+
+func (d *Document01100101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document01100101) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100101) Variant() string {
+	return "001"
+}
+
+func (d *Document01100101) Version() string {
+	return "01"
+}
+
+func (d *Document01100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMPINManagementResponse message is sent by an ATM manager or its agent to the ATM to provide the information and the outcome of the cardholder PIN operation requested in the ATMPINManagementRequest.
 type ATMPINManagementResponseV01 struct {
 
@@ -32,6 +67,10 @@ type ATMPINManagementResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMPINManagementResponseV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMPINManagementResponseV01) AddHeader() *model.Header20 {
 	a.Header = new(model.Header20)
 	return a.Header
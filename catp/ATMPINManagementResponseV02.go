@@ -16,6 +16,41 @@ func (d *Document01100102) AddMessage() *ATMPINManagementResponseV02 {
 	return d.Message
 }
 
+func (d *Document01100102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.011.001.02"
+}
+
+func (d *Document01100102) MessageDefinitionIdentifier() string {
+	return "catp.011.001.02"
+}
+
+func (d *Document01100102) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document01100102) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100102) Variant() string {
+	return "001"
+}
+
+func (d *Document01100102) Version() string {
+	return "02"
+}
+
+func (d *Document01100102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMPINManagementResponse message is sent by an ATM manager or its agent to the ATM to provide the information and the outcome of the cardholder PIN operation requested in the ATMPINManagementRequest.
 type ATMPINManagementResponseV02 struct {
 
@@ -32,6 +67,10 @@ type ATMPINManagementResponseV02 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMPINManagementResponseV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMPINManagementResponseV02) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00500101) AddMessage() *ATMRejectV01 {
 	return d.Message
 }
 
+func (d *Document00500101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.005.001.01"
+}
+
+func (d *Document00500101) MessageDefinitionIdentifier() string {
+	return "catp.005.001.01"
+}
+
+func (d *Document00500101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00500101) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500101) Variant() string {
+	return "001"
+}
+
+func (d *Document00500101) Version() string {
+	return "01"
+}
+
+func (d *Document00500101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMReject message is sent by any entity to reject a received message.
 type ATMRejectV01 struct {
 
@@ -26,6 +61,10 @@ type ATMRejectV01 struct {
 	ATMReject *model.ATMReject1 `xml:"ATMRjct"`
 }
 
+func (a *ATMRejectV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMRejectV01) AddHeader() *model.Header22 {
 	a.Header = new(model.Header22)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00500102) AddMessage() *ATMRejectV02 {
 	return d.Message
 }
 
+func (d *Document00500102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.005.001.02"
+}
+
+func (d *Document00500102) MessageDefinitionIdentifier() string {
+	return "catp.005.001.02"
+}
+
+func (d *Document00500102) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00500102) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500102) Variant() string {
+	return "001"
+}
+
+func (d *Document00500102) Version() string {
+	return "02"
+}
+
+func (d *Document00500102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMReject message is sent by any entity to reject a received message.
 type ATMRejectV02 struct {
 
@@ -26,6 +61,10 @@ type ATMRejectV02 struct {
 	ATMReject *model.ATMReject2 `xml:"ATMRjct"`
 }
 
+func (a *ATMRejectV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMRejectV02) AddHeader() *model.Header33 {
 	a.Header = new(model.Header33)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01600101) AddMessage() *ATMTransferRequestV01 {
 	return d.Message
 }
 
+func (d *Document01600101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.016.001.01"
+}
+
+func (d *Document01600101) MessageDefinitionIdentifier() string {
+	return "catp.016.001.01"
+}
+
+func (d *Document01600101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document01600101) MessageFunctionality() string {
+	return "016"
+}
+
+func (d *Document01600101) Variant() string {
+	return "001"
+}
+
+func (d *Document01600101) Version() string {
+	return "01"
+}
+
+func (d *Document01600101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01600101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMTransferRequest message is sent by an ATM to an ATM manager to request the approval of a fund transfer at the ATM.
 type ATMTransferRequestV01 struct {
 
@@ -32,6 +67,10 @@ type ATMTransferRequestV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMTransferRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMTransferRequestV01) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document01700101) AddMessage() *ATMTransferResponseV01 {
 	return d.Message
 }
 
+func (d *Document01700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.017.001.01"
+}
+
+func (d *Document01700101) MessageDefinitionIdentifier() string {
+	return "catp.017.001.01"
+}
+
+func (d *Document01700101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document01700101) MessageFunctionality() string {
+	return "017"
+}
+
+func (d *Document01700101) Variant() string {
+	return "001"
+}
+
+func (d *Document01700101) Version() string {
+	return "01"
+}
+
+func (d *Document01700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMTransferResponse message is sent by an acquirer or its agent to inform the ATM of the approval or decline of the transfer transaction.
 type ATMTransferResponseV01 struct {
 
@@ -32,6 +67,10 @@ type ATMTransferResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMTransferResponseV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMTransferResponseV01) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
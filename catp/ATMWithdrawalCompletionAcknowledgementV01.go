@@ -16,6 +16,41 @@ func (d *Document00400101) AddMessage() *ATMWithdrawalCompletionAcknowledgementV
 	return d.Message
 }
 
+func (d *Document00400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.004.001.01"
+}
+
+func (d *Document00400101) MessageDefinitionIdentifier() string {
+	return "catp.004.001.01"
+}
+
+func (d *Document00400101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00400101) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400101) Variant() string {
+	return "001"
+}
+
+func (d *Document00400101) Version() string {
+	return "01"
+}
+
+func (d *Document00400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMWithdrawalCompletionAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMWithdrawalCompletionAdvice message.
 type ATMWithdrawalCompletionAcknowledgementV01 struct {
 
@@ -32,6 +67,10 @@ type ATMWithdrawalCompletionAcknowledgementV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMWithdrawalCompletionAcknowledgementV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMWithdrawalCompletionAcknowledgementV01) AddHeader() *model.Header21 {
 	a.Header = new(model.Header21)
 	return a.Header
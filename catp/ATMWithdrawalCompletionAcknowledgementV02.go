@@ -16,6 +16,41 @@ func (d *Document00400102) AddMessage() *ATMWithdrawalCompletionAcknowledgementV
 	return d.Message
 }
 
+func (d *Document00400102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.004.001.02"
+}
+
+func (d *Document00400102) MessageDefinitionIdentifier() string {
+	return "catp.004.001.02"
+}
+
+func (d *Document00400102) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00400102) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400102) Variant() string {
+	return "001"
+}
+
+func (d *Document00400102) Version() string {
+	return "02"
+}
+
+func (d *Document00400102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMWithdrawalCompletionAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMWithdrawalCompletionAdvice message.
 type ATMWithdrawalCompletionAcknowledgementV02 struct {
 
@@ -32,6 +67,10 @@ type ATMWithdrawalCompletionAcknowledgementV02 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMWithdrawalCompletionAcknowledgementV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMWithdrawalCompletionAcknowledgementV02) AddHeader() *model.Header32 {
 	a.Header = new(model.Header32)
 	return a.Header
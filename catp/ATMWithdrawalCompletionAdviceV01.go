@@ -16,6 +16,41 @@ func (d *Document00300101) AddMessage() *ATMWithdrawalCompletionAdviceV01 {
 	return d.Message
 }
 
+func (d *Document00300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.003.001.01"
+}
+
+func (d *Document00300101) MessageDefinitionIdentifier() string {
+	return "catp.003.001.01"
+}
+
+func (d *Document00300101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00300101) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300101) Variant() string {
+	return "001"
+}
+
+func (d *Document00300101) Version() string {
+	return "01"
+}
+
+func (d *Document00300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMWithdrawalCompletionAdvice message is sent by an ATM to an acquirer or its agent to inform of the result of a withdrawal transaction at an ATM.
 // If the ATM is configured to only send negative completion, a generic completion message should be used instead of ATMCompletionAdvice.
 type ATMWithdrawalCompletionAdviceV01 struct {
@@ -33,6 +68,10 @@ type ATMWithdrawalCompletionAdviceV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMWithdrawalCompletionAdviceV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMWithdrawalCompletionAdviceV01) AddHeader() *model.Header21 {
 	a.Header = new(model.Header21)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00300102) AddMessage() *ATMWithdrawalCompletionAdviceV02 {
 	return d.Message
 }
 
+func (d *Document00300102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.003.001.02"
+}
+
+func (d *Document00300102) MessageDefinitionIdentifier() string {
+	return "catp.003.001.02"
+}
+
+func (d *Document00300102) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00300102) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300102) Variant() string {
+	return "001"
+}
+
+func (d *Document00300102) Version() string {
+	return "02"
+}
+
+func (d *Document00300102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMWithdrawalCompletionAdvice message is sent by an ATM to an acquirer or its agent to inform of the result of a withdrawal transaction at an ATM.
 // If the ATM is configured to only send negative completion, a generic completion message should be used instead of ATMCompletionAdvice.
 type ATMWithdrawalCompletionAdviceV02 struct {
@@ -33,6 +68,10 @@ type ATMWithdrawalCompletionAdviceV02 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMWithdrawalCompletionAdviceV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMWithdrawalCompletionAdviceV02) AddHeader() *model.Header32 {
 	a.Header = new(model.Header32)
 	return a.Header
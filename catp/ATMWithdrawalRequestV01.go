@@ -16,6 +16,41 @@ func (d *Document00100101) AddMessage() *ATMWithdrawalRequestV01 {
 	return d.Message
 }
 
+func (d *Document00100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.001.001.01"
+}
+
+func (d *Document00100101) MessageDefinitionIdentifier() string {
+	return "catp.001.001.01"
+}
+
+func (d *Document00100101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00100101) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100101) Variant() string {
+	return "001"
+}
+
+func (d *Document00100101) Version() string {
+	return "01"
+}
+
+func (d *Document00100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMWithdrawalRequest message is sent by an ATM to an acquirer or its agent to request the approval of a withdrawal transaction at an ATM.
 type ATMWithdrawalRequestV01 struct {
 
@@ -32,6 +67,10 @@ type ATMWithdrawalRequestV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMWithdrawalRequestV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMWithdrawalRequestV01) AddHeader() *model.Header20 {
 	a.Header = new(model.Header20)
 	return a.Header
@@ -16,6 +16,41 @@ func (d *Document00100102) AddMessage() *ATMWithdrawalRequestV02 {
 	return d.Message
 }
 
+func (d *Document00100102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.001.001.02"
+}
+
+func (d *Document00100102) MessageDefinitionIdentifier() string {
+	return "catp.001.001.02"
+}
+
+func (d *Document00100102) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00100102) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100102) Variant() string {
+	return "001"
+}
+
+func (d *Document00100102) Version() string {
+	return "02"
+}
+
+func (d *Document00100102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMWithdrawalRequest message is sent by an ATM to an acquirer or its agent to request the approval of a withdrawal transaction at an ATM.
 type ATMWithdrawalRequestV02 struct {
 
@@ -32,6 +67,10 @@ type ATMWithdrawalRequestV02 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMWithdrawalRequestV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMWithdrawalRequestV02) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
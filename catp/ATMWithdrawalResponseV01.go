@@ -16,6 +16,41 @@ func (d *Document00200101) AddMessage() *ATMWithdrawalResponseV01 {
 	return d.Message
 }
 
+func (d *Document00200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.002.001.01"
+}
+
+func (d *Document00200101) MessageDefinitionIdentifier() string {
+	return "catp.002.001.01"
+}
+
+func (d *Document00200101) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00200101) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200101) Variant() string {
+	return "001"
+}
+
+func (d *Document00200101) Version() string {
+	return "01"
+}
+
+func (d *Document00200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMWithdrawalResponse message is sent by an acquirer or its agent to an ATM in response to the ATMWithdrawalRequest to inform the ATM of the approval or decline of the withdrawal transaction.
 type ATMWithdrawalResponseV01 struct {
 
@@ -32,6 +67,10 @@ type ATMWithdrawalResponseV01 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMWithdrawalResponseV01) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMWithdrawalResponseV01) AddHeader() *model.Header20 {
 	a.Header = new(model.Header20)
 	return a.Header
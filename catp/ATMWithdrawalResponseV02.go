@@ -16,6 +16,41 @@ func (d *Document00200102) AddMessage() *ATMWithdrawalResponseV02 {
 	return d.Message
 }
 
+func (d *Document00200102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:catp.002.001.02"
+}
+
+func (d *Document00200102) MessageDefinitionIdentifier() string {
+	return "catp.002.001.02"
+}
+
+func (d *Document00200102) BusinessArea() string {
+	return "catp"
+}
+
+func (d *Document00200102) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200102) Variant() string {
+	return "001"
+}
+
+func (d *Document00200102) Version() string {
+	return "02"
+}
+
+func (d *Document00200102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The ATMWithdrawalResponse message is sent by an acquirer or its agent to an ATM in response to the ATMWithdrawalRequest to inform the ATM of the approval or decline of the withdrawal transaction.
 type ATMWithdrawalResponseV02 struct {
 
@@ -32,6 +67,10 @@ type ATMWithdrawalResponseV02 struct {
 	SecurityTrailer *model.ContentInformationType15 `xml:"SctyTrlr,omitempty"`
 }
 
+func (a *ATMWithdrawalResponseV02) Validate() error {
+	return model.ValidateElement(a)
+}
+
 func (a *ATMWithdrawalResponseV02) AddHeader() *model.Header31 {
 	a.Header = new(model.Header31)
 	return a.Header
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var catalogueEntry = regexp.MustCompile(`"(urn:[^"]+)": func\(\) Message \{ return new\((\w+)\.(\w+)\) \},`)
+
+// catalogue returns the source of message/Catalogue.go with the root types of
+// schemas added to the entries of the existing file, if any.
+func catalogue(existing string, schemas []*schema) ([]byte, error) {
+	entries := map[string]string{}
+	data, err := os.ReadFile(existing)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, m := range catalogueEntry.FindAllStringSubmatch(string(data), -1) {
+		entries[m[1]] = m[2] + "." + m[3]
+	}
+	for _, s := range schemas {
+		id, err := identifier(s.namespace)
+		if err != nil {
+			return nil, err
+		}
+		parts := strings.Split(id, ".")
+		root := "Document"
+		if s.root == "AppHdr" {
+			root = "AppHdr"
+		}
+		entries[s.namespace] = parts[0] + "." + root + parts[1] + parts[2] + parts[3]
+	}
+
+	pkgs := map[string]bool{}
+	var namespaces []string
+	for namespace, typ := range entries {
+		namespaces = append(namespaces, namespace)
+		pkgs[typ[:strings.IndexByte(typ, '.')]] = true
+	}
+	sort.Strings(namespaces)
+	var sorted []string
+	for pkg := range pkgs {
+		sorted = append(sorted, pkg)
+	}
+	sort.Strings(sorted)
+
+	var b bytes.Buffer
+	b.WriteString("package message\n\nimport (\n")
+	for _, pkg := range sorted {
+		fmt.Fprintf(&b, "\t%q\n", modulePath+"/"+pkg)
+	}
+	b.WriteString(")\n\n")
+	b.WriteString("// catalogue lists every Document type of the business area packages, and the\n")
+	b.WriteString("// AppHdr types of the head package, keyed by their namespace.\n")
+	b.WriteString("var catalogue = map[string]func() Message{\n")
+	for _, namespace := range namespaces {
+		fmt.Fprintf(&b, "\t%q: func() Message { return new(%s) },\n", namespace, entries[namespace])
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
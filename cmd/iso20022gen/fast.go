@@ -0,0 +1,214 @@
+package main
+
+// The fastxml methods encode and decode a type without reflection. They are
+// generated for the message definitions given with -fast, and for the types
+// these use.
+
+// isLeaf reports whether the fields of a kind are encoded as the text of an
+// element.
+func isLeaf(k kind) bool {
+	return k == kindSimple || k == kindEnum
+}
+
+func (g *generator) structFast(f *file, r, name, q string, fields []*goField) {
+	f.imports[modulePath+"/fastxml"] = true
+	f.p("")
+	f.p("func (%s *%s) EncodeXMLFast(enc *fastxml.Encoder, tag string) {", r, name)
+	f.p("enc.Start(tag)")
+	for _, fd := range fields {
+		tag := fd.element.tag
+		if fd.repeated() {
+			f.p("for _, elem := range %s.%s {", r, fd.name)
+			f.p("if elem != nil {")
+			if isLeaf(fd.kind) {
+				f.p("enc.Element(%q, string(*elem))", tag)
+			} else {
+				f.p("elem.EncodeXMLFast(enc, %q)", tag)
+			}
+			f.p("}")
+			f.p("}")
+			continue
+		}
+		f.p("if %s.%s != nil {", r, fd.name)
+		if isLeaf(fd.kind) {
+			f.p("enc.Element(%q, string(*%s.%s))", tag, r, fd.name)
+		} else {
+			f.p("%s.%s.EncodeXMLFast(enc, %q)", r, fd.name, tag)
+		}
+		f.p("}")
+	}
+	f.p("enc.End(tag)")
+	f.p("}")
+	f.p("")
+	f.p("func (%s *%s) DecodeXMLFast(dec *fastxml.Decoder) error {", r, name)
+	f.p("for {")
+	f.p("name, err := dec.Next()")
+	f.p("if err != nil {")
+	f.p("return err")
+	f.p("}")
+	f.p("if name == nil {")
+	f.p("return nil")
+	f.p("}")
+	f.p("switch string(name) {")
+	for _, fd := range fields {
+		f.p("case %q:", fd.element.tag)
+		value := "elem"
+		if isLeaf(fd.kind) {
+			f.p("value, err := dec.Text()")
+			f.p("if err != nil {")
+			f.p("return err")
+			f.p("}")
+			value = "(*" + fd.typ + ")(&value)"
+		} else {
+			f.p("elem := new(%s)", fd.typ)
+			f.p("if err := elem.DecodeXMLFast(dec); err != nil {")
+			f.p("return err")
+			f.p("}")
+		}
+		if fd.repeated() {
+			f.p("%s.%s = append(%s.%s, %s)", r, fd.name, r, fd.name, value)
+		} else {
+			f.p("%s.%s = %s", r, fd.name, value)
+		}
+	}
+	f.p("default:")
+	f.p("if err := dec.Skip(); err != nil {")
+	f.p("return err")
+	f.p("}")
+	f.p("}")
+	f.p("}")
+	f.p("}")
+}
+
+func (g *generator) rawFast(f *file, r, name string) {
+	f.imports[modulePath+"/fastxml"] = true
+	f.p("")
+	f.p("func (%s *%s) EncodeXMLFast(enc *fastxml.Encoder, tag string) {", r, name)
+	f.p("enc.Start(tag)")
+	f.p("enc.Raw(%s.Value)", r)
+	f.p("enc.End(tag)")
+	f.p("}")
+	f.p("")
+	f.p("func (%s *%s) DecodeXMLFast(dec *fastxml.Decoder) error {", r, name)
+	f.p("value, err := innerXMLFast(dec)")
+	f.p("if err != nil {")
+	f.p("return err")
+	f.p("}")
+	f.p("%s.Value = value", r)
+	f.p("return nil")
+	f.p("}")
+}
+
+func (g *generator) amountFast(f *file, r, name string, implied bool) {
+	f.imports[modulePath+"/fastxml"] = true
+	f.p("")
+	f.p("func (%s *%s) EncodeXMLFast(enc *fastxml.Encoder, tag string) {", r, name)
+	f.p("enc.Open(tag)")
+	if implied {
+		f.p("if %s.Currency != \"\" {", r)
+		f.p("enc.Attr(\"Ccy\", %s.Currency)", r)
+		f.p("}")
+	} else {
+		f.p("enc.Attr(\"Ccy\", %s.Currency)", r)
+	}
+	f.p("enc.Close()")
+	f.p("enc.Text(%s.Value)", r)
+	f.p("enc.End(tag)")
+	f.p("}")
+	f.p("")
+	f.p("func (%s *%s) DecodeXMLFast(dec *fastxml.Decoder) error {", r, name)
+	f.p("value, err := dec.Attr(\"Ccy\")")
+	f.p("if err != nil {")
+	f.p("return err")
+	f.p("}")
+	f.p("%s.Currency = value", r)
+	f.p("value, err = dec.Text()")
+	f.p("if err != nil {")
+	f.p("return err")
+	f.p("}")
+	f.p("%s.Value = value", r)
+	f.p("return nil")
+	f.p("}")
+}
+
+func (g *generator) contentFast(f *file, r string, c *complexType) {
+	f.imports[modulePath+"/fastxml"] = true
+	f.p("")
+	f.p("func (%s *%s) EncodeXMLFast(enc *fastxml.Encoder, tag string) {", r, c.name)
+	f.p("enc.Open(tag)")
+	for _, a := range c.attrs {
+		if a.required {
+			f.p("enc.Attr(%q, %s.%s)", a.name, r, exported(a.name))
+			continue
+		}
+		f.p("if %s.%s != \"\" {", r, exported(a.name))
+		f.p("enc.Attr(%q, %s.%s)", a.name, r, exported(a.name))
+		f.p("}")
+	}
+	f.p("enc.Close()")
+	f.p("enc.Text(%s.Value)", r)
+	f.p("enc.End(tag)")
+	f.p("}")
+	f.p("")
+	f.p("func (%s *%s) DecodeXMLFast(dec *fastxml.Decoder) error {", r, c.name)
+	f.p("var value string")
+	f.p("var err error")
+	for _, a := range c.attrs {
+		f.p("value, err = dec.Attr(%q)", a.name)
+		f.p("if err != nil {")
+		f.p("return err")
+		f.p("}")
+		f.p("%s.%s = value", r, exported(a.name))
+	}
+	f.p("value, err = dec.Text()")
+	f.p("if err != nil {")
+	f.p("return err")
+	f.p("}")
+	f.p("%s.Value = value", r)
+	f.p("return nil")
+	f.p("}")
+}
+
+func (g *generator) documentFast(f *file, name string, s *schema) {
+	f.imports[modulePath+"/fastxml"] = true
+	f.p("func (d *%s) MarshalXMLFast() ([]byte, error) {", name)
+	f.p("enc := fastxml.NewEncoder(make([]byte, 0, 4096))")
+	f.p("enc.Root(%q, \"Document\")", s.namespace)
+	f.p("if d.Message != nil {")
+	f.p("d.Message.EncodeXMLFast(enc, %q)", s.tag)
+	f.p("}")
+	f.p("enc.End(\"Document\")")
+	f.p("return enc.Bytes(), nil")
+	f.p("}")
+	f.p("")
+	f.p("func (d *%s) UnmarshalXMLFast(data []byte) error {", name)
+	f.p("dec := fastxml.NewDecoder(data)")
+	f.p("name, err := dec.Root(%q, \"Document\")", s.namespace)
+	f.p("if err != nil {")
+	f.p("return err")
+	f.p("}")
+	f.p("d.XMLName = name")
+	f.p("for {")
+	f.p("name, err := dec.Next()")
+	f.p("if err != nil {")
+	f.p("return err")
+	f.p("}")
+	f.p("if name == nil {")
+	f.p("return nil")
+	f.p("}")
+	f.p("switch string(name) {")
+	f.p("case %q:", s.tag)
+	f.p("elem := new(%s)", s.message)
+	f.p("if err := elem.DecodeXMLFast(dec); err != nil {")
+	f.p("return err")
+	f.p("}")
+	f.p("d.Message = elem")
+	f.p("default:")
+	f.p("if err := dec.Skip(); err != nil {")
+	f.p("return err")
+	f.p("}")
+	f.p("}")
+	f.p("}")
+	f.p("}")
+	f.p("")
+}
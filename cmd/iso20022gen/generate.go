@@ -0,0 +1,1094 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const modulePath = "github.com/yudaprama/iso20022"
+
+// kind is the Go form of a type.
+type kind int
+
+const (
+	// kindSimple is a string type with the facets of a simple type.
+	kindSimple kind = iota
+
+	// kindEnum is a string type with constants for the values of an
+	// enumeration, whose setters check the value.
+	kindEnum
+
+	// kindStruct is a struct with a field for each element of a sequence or a
+	// choice.
+	kindStruct
+
+	// kindAmount is a decimal value with its currency.
+	kindAmount
+
+	// kindContent is a text value with attributes other than an amount.
+	kindContent
+
+	// kindRaw is a wildcard content kept as raw XML.
+	kindRaw
+)
+
+// checks are the validations of the validation package that complement the
+// facets of some simple types.
+var checks = map[string]string{
+	"AnyBICDec2014Identifier": "validation.ValidateBIC(string(%s))",
+	"AnyBICIdentifier":        "validation.ValidateBIC(string(%s))",
+	"BEIIdentifier":           "validation.ValidateBIC(string(%s))",
+	"BICFIDec2014Identifier":  "validation.ValidateBIC(string(%s))",
+	"BICFIIdentifier":         "validation.ValidateBIC(string(%s))",
+	"BICIdentifier":           "validation.ValidateBIC(string(%s))",
+	"BICNonFIIdentifier":      "validation.ValidateBIC(string(%s))",
+	"IBAN2007Identifier":      "validation.ValidateIBAN(string(%s))",
+	"IBANIdentifier":          "validation.ValidateIBAN(strings.ToUpper(string(%s)))",
+	"LEIIdentifier":           "validation.ValidateLEI(string(%s))",
+}
+
+// builtins are the XSD built-in types an element may have, as the facets of
+// the string type of the model package named after them.
+var builtins = map[string]simpleType{
+	"boolean":            {builtin: "boolean"},
+	"date":               {builtin: "date"},
+	"dateTime":           {builtin: "dateTime"},
+	"decimal":            {builtin: "decimal", totalDigits: -1, fractionDigits: -1},
+	"gYear":              {builtin: "gYear"},
+	"gYearMonth":         {builtin: "gYearMonth"},
+	"integer":            {builtin: "integer", totalDigits: -1, fractionDigits: 0},
+	"nonNegativeInteger": {builtin: "nonNegativeInteger", totalDigits: -1, fractionDigits: 0},
+	"positiveInteger":    {builtin: "positiveInteger", totalDigits: -1, fractionDigits: 0},
+	"time":               {builtin: "time"},
+}
+
+// generator writes the Go source of the message definitions of a set of XSDs.
+type generator struct {
+	types *types
+	repo  *repository
+	src   *source
+	warn  func(format string, args ...interface{})
+
+	// fast holds the complex types, and the identifiers of the message
+	// definitions, with the fastxml methods.
+	fast map[string]bool
+
+	// files holds the generated source by path, relative to the module.
+	files map[string][]byte
+}
+
+// generate generates the model types used by the message definitions of
+// schemas, and the Document or AppHdr types and message type of each message
+// definition. The message definitions whose identifier is in fast, and the
+// types they use, have the fastxml methods.
+func (g *generator) generate(schemas []*schema, fast map[string]bool) error {
+	g.fast = map[string]bool{}
+	g.files = map[string][]byte{}
+	used := map[string]bool{}
+	messages := map[string]*schema{}
+	for _, s := range schemas {
+		id, err := identifier(s.namespace)
+		if err != nil {
+			return fmt.Errorf("%s: %v", s.file, err)
+		}
+		c, ok := g.types.complex[s.message]
+		if !ok {
+			return fmt.Errorf("%s: no complex type %s", s.file, s.message)
+		}
+		if s.root != "Document" && s.root != "AppHdr" {
+			return fmt.Errorf("%s: unsupported root element %s", s.file, s.root)
+		}
+		file := path.Join(id[:4], s.message+".go")
+		if prev, ok := messages[file]; ok {
+			return fmt.Errorf("%s: message %s already defined by %s", s.file, s.message, prev.file)
+		}
+		messages[file] = s
+		isFast := fast[id]
+		if isFast && s.root != "Document" {
+			g.warn("%s: no fastxml methods for the %s root element", id, s.root)
+			isFast = false
+		}
+		if isFast {
+			g.fast[id] = true
+			g.fast[s.message] = true
+		}
+		for _, e := range c.elements {
+			if err := g.use(e.typ, used, isFast); err != nil {
+				return fmt.Errorf("%s: %v", s.file, err)
+			}
+		}
+	}
+	for _, s := range schemas {
+		if used[s.message] {
+			return fmt.Errorf("%s: message %s is also used as a model type", s.file, s.message)
+		}
+	}
+	// The existing source of the packages is read before anything is
+	// generated: without it, the names and the documentation would silently
+	// fall back to the XML tags.
+	pkgs := []string{"model"}
+	for file := range messages {
+		pkgs = append(pkgs, path.Dir(file))
+	}
+	sort.Strings(pkgs[1:])
+	for _, p := range pkgs {
+		if _, err := g.src.pkg(p); err != nil {
+			return err
+		}
+	}
+
+	var names []string
+	for name := range used {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		f := newFile("model")
+		if err := g.modelType(f, name); err != nil {
+			return err
+		}
+		data, err := f.source()
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		g.files[path.Join("model", goName(name)+".go")] = data
+	}
+	for file, s := range messages {
+		f := newFile(path.Dir(file))
+		if err := g.messageFile(f, s); err != nil {
+			return fmt.Errorf("%s: %v", s.file, err)
+		}
+		data, err := f.source()
+		if err != nil {
+			return fmt.Errorf("%s: %v", s.file, err)
+		}
+		g.files[file] = data
+	}
+	return nil
+}
+
+// use marks the type name and the types of its elements as used, and as fast
+// when fast is set.
+func (g *generator) use(name string, used map[string]bool, fast bool) error {
+	if used[name] && (!fast || g.fast[name]) {
+		return nil
+	}
+	used[name] = true
+	c, ok := g.types.complex[name]
+	if !ok {
+		// The implied amounts are structs, with the fastxml methods of the
+		// other amounts.
+		st, err := g.simpleType(name)
+		if err == nil && fast && isImpliedAmount(st) {
+			g.fast[name] = true
+		}
+		return err
+	}
+	if fast {
+		g.fast[name] = true
+	}
+	for _, e := range c.elements {
+		if err := g.use(e.typ, used, fast); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// simpleType returns the simple type name, which may be an XSD built-in type.
+func (g *generator) simpleType(name string) (*simpleType, error) {
+	if st, ok := g.types.simple[name]; ok {
+		return st, nil
+	}
+	if isBuiltin(name) {
+		if b, ok := builtins[local(name)]; ok {
+			b.name = local(name)
+			b.minLength, b.maxLength = -1, -1
+			switch b.name {
+			case "positiveInteger":
+				b.minInclusive = "1"
+			case "nonNegativeInteger":
+				b.minInclusive = "0"
+			}
+			return &b, nil
+		}
+		return nil, fmt.Errorf("unsupported built-in type %s", name)
+	}
+	return nil, fmt.Errorf("unknown type %s", name)
+}
+
+// goName returns the Go name of a type.
+func goName(name string) string {
+	return local(name)
+}
+
+// kindOf returns the Go form of the type name.
+func (g *generator) kindOf(name string) kind {
+	if c, ok := g.types.complex[name]; ok {
+		switch {
+		case c.any != nil:
+			return kindRaw
+		case c.value != "" && len(c.attrs) == 1 && c.attrs[0].name == "Ccy":
+			return kindAmount
+		case c.value != "":
+			return kindContent
+		}
+		return kindStruct
+	}
+	if st, err := g.simpleType(name); err == nil {
+		if isImpliedAmount(st) {
+			return kindAmount
+		}
+		if len(st.enumeration) > 0 {
+			return kindEnum
+		}
+	}
+	return kindSimple
+}
+
+// isImpliedAmount reports whether a simple type is an amount whose currency is
+// implied by the context. Such an amount is a struct like the other amounts,
+// with an optional currency.
+func isImpliedAmount(st *simpleType) bool {
+	return st.builtin == "decimal" && strings.HasSuffix(st.name, "ImpliedCurrencyAndAmount")
+}
+
+// conversion returns the method converting a Go value to the simple type
+// name, FromTime, FromBool or FromDecimal, and the Go type of the value, or an
+// empty string when there is none.
+func (g *generator) conversion(name string) (method, typ string) {
+	st, err := g.simpleType(name)
+	if err != nil || len(st.enumeration) > 0 || isImpliedAmount(st) {
+		return "", ""
+	}
+	switch st.builtin {
+	case "date", "dateTime", "time":
+		return "FromTime", "time.Time"
+	case "boolean":
+		return "FromBool", "bool"
+	case "decimal":
+		return "FromDecimal", "decimal.Decimal"
+	}
+	return "", ""
+}
+
+// modelType writes the type name of the model package.
+func (g *generator) modelType(f *file, name string) error {
+	if c, ok := g.types.complex[name]; ok {
+		doc := g.typeDoc(name, c.definition, "model")
+		switch g.kindOf(name) {
+		case kindRaw:
+			g.rawType(f, c, doc)
+		case kindAmount:
+			return g.amountType(f, name, doc, c.value, c.attrs[0].typ, !c.attrs[0].required)
+		case kindContent:
+			g.contentType(f, c, doc)
+		default:
+			return g.structType(f, c, name, doc, "")
+		}
+		return nil
+	}
+	st, err := g.simpleType(name)
+	if err != nil {
+		return err
+	}
+	if isImpliedAmount(st) {
+		return g.amountType(f, name, g.typeDoc(name, st.definition, "model"), name, "", true)
+	}
+	return g.simpleTypeDecl(f, st)
+}
+
+// messageFile writes the root type and the message type of a message
+// definition.
+func (g *generator) messageFile(f *file, s *schema) error {
+	id, _ := identifier(s.namespace)
+	parts := strings.Split(id, ".")
+	c := g.types.complex[s.message]
+	f.imports["encoding/xml"] = true
+	f.imports[modulePath+"/model"] = true
+	if s.root == "AppHdr" {
+		g.appHdr(f, s, id, parts, c)
+	} else {
+		g.document(f, s, id, parts)
+	}
+	doc := g.typeDoc(s.message, c.definition, parts[0])
+	return g.structType(f, c, s.message, doc, "model.")
+}
+
+func (g *generator) document(f *file, s *schema, id string, parts []string) {
+	name := "Document" + parts[1] + parts[2] + parts[3]
+	f.p("type %s struct {", name)
+	f.p("XMLName xml.Name `xml:\"%s Document\"`", s.namespace)
+	f.p("Message *%s `xml:\"%s\"`", s.message, s.tag)
+	f.p("}")
+	f.p("")
+	f.p("func (d *%s) AddMessage() *%s {", name, s.message)
+	f.p("d.Message = new(%s)", s.message)
+	f.p("return d.Message")
+	f.p("}")
+	g.identification(f, "d", name, s.namespace, parts)
+	f.p("func (d *%s) Body() interface{} {", name)
+	f.p("if d.Message == nil {")
+	f.p("return nil")
+	f.p("}")
+	f.p("return d.Message")
+	f.p("}")
+	f.p("")
+	f.p("func (d *%s) Validate() error {", name)
+	f.p("return model.ValidateElement(d)")
+	f.p("}")
+	f.p("")
+	if g.fast[id] {
+		g.documentFast(f, name, s)
+	}
+}
+
+func (g *generator) appHdr(f *file, s *schema, id string, parts []string, c *complexType) {
+	name := "AppHdr" + parts[1] + parts[2] + parts[3]
+	f.imports["fmt"] = true
+	f.p("// %s is the root element of a %s Business Application Header.", name, id)
+	f.p("type %s struct {", name)
+	f.p("XMLName xml.Name `xml:\"%s AppHdr\"`", s.namespace)
+	f.p("// The elements of Message are the children of the AppHdr element.")
+	f.p("Message %s `xml:\",inline\"`", s.message)
+	f.p("}")
+	f.p("")
+	g.identification(f, "a", name, s.namespace, parts)
+	f.p("func (a *%s) Body() interface{} {", name)
+	f.p("return &a.Message")
+	f.p("}")
+	f.p("")
+	for _, e := range c.elements {
+		if e.tag == "MsgDefIdr" && e.max == 1 && g.kindOf(e.typ) == kindSimple {
+			fieldName, _ := g.field(s.message, e, parts[0])
+			f.p("func (a *%s) BusinessMessageDefinitionIdentifier() string {", name)
+			f.p("if a.Message.%s == nil {", fieldName)
+			f.p("return \"\"")
+			f.p("}")
+			f.p("return string(*a.Message.%s)", fieldName)
+			f.p("}")
+			f.p("")
+		}
+	}
+	f.p("func (a *%s) Validate() error {", name)
+	f.p("return model.ValidateElement(a)")
+	f.p("}")
+	f.p("")
+	// The header is a field rather than an embedded struct, so that its
+	// MsgDefIdr field is not hidden by the MessageDefinitionIdentifier method.
+	f.p("func (a *%s) MarshalXML(e *xml.Encoder, start xml.StartElement) error {", name)
+	f.p("start.Name = xml.Name{Space: a.Namespace(), Local: \"AppHdr\"}")
+	f.p("return e.EncodeElement(&a.Message, start)")
+	f.p("}")
+	f.p("")
+	f.p("func (a *%s) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {", name)
+	f.p("if start.Name.Space != a.Namespace() || start.Name.Local != \"AppHdr\" {")
+	f.p("return fmt.Errorf(\"head: expected element AppHdr in namespace %%s but have %%s in namespace %%s\", a.Namespace(), start.Name.Local, start.Name.Space)")
+	f.p("}")
+	f.p("a.XMLName = start.Name")
+	f.p("return d.DecodeElement(&a.Message, &start)")
+	f.p("}")
+	f.p("")
+}
+
+// identification writes the methods identifying the message definition of a
+// root type.
+func (g *generator) identification(f *file, r, name, namespace string, parts []string) {
+	methods := []struct{ name, value string }{
+		{"Namespace", namespace},
+		{"MessageDefinitionIdentifier", strings.Join(parts, ".")},
+		{"BusinessArea", parts[0]},
+		{"MessageFunctionality", parts[1]},
+		{"Variant", parts[2]},
+		{"Version", parts[3]},
+	}
+	for _, m := range methods {
+		f.p("")
+		f.p("func (%s *%s) %s() string {", r, name, m.name)
+		f.p("return %q", m.value)
+		f.p("}")
+	}
+	f.p("")
+}
+
+// goField is a field of a struct type.
+type goField struct {
+	element *element
+	name    string
+	doc     string
+	typ     string
+	kind    kind
+}
+
+func (f *goField) repeated() bool {
+	return f.element.max != 1
+}
+
+// structType writes a struct type with a field for each element, its Validate
+// method and the helpers of its fields. The model types are qualified by q.
+func (g *generator) structType(f *file, c *complexType, name, doc, q string) error {
+	pkg := "model"
+	if q != "" {
+		pkg = f.pkg
+	}
+	var fields []*goField
+	seen := map[string]bool{}
+	for _, e := range c.elements {
+		fieldName, fieldDoc := g.field(name, e, pkg)
+		if seen[fieldName] {
+			return fmt.Errorf("%s: duplicate field %s", name, fieldName)
+		}
+		seen[fieldName] = true
+		if _, err := g.simpleType(e.typ); err != nil && g.types.complex[e.typ] == nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		fields = append(fields, &goField{element: e, name: fieldName, doc: fieldDoc, typ: q + goName(e.typ), kind: g.kindOf(e.typ)})
+	}
+
+	r := receiver(name)
+	f.comment(doc)
+	f.p("type %s struct {", name)
+	for _, fd := range fields {
+		f.p("")
+		f.comment(fd.doc)
+		e := fd.element
+		tag := e.tag
+		if e.min == 0 {
+			tag += ",omitempty"
+		}
+		tags := fmt.Sprintf("xml:%q", tag)
+		if e.max > 1 {
+			tags += fmt.Sprintf(" xsd:\"maxOccurs=%d\"", e.max)
+		}
+		star := "*"
+		if fd.repeated() {
+			star = "[]*"
+		}
+		f.p("%s %s%s `%s`", fd.name, star, fd.typ, tags)
+	}
+	f.p("}")
+	f.p("")
+	f.p("func (%s *%s) Validate() error {", r, name)
+	f.p("return %sValidateElement(%s)", q, r)
+	f.p("}")
+	for _, fd := range fields {
+		g.helpers(f, r, name, q, fd)
+	}
+	if g.fast[name] {
+		g.structFast(f, r, name, q, fields)
+	}
+	return nil
+}
+
+// helpers writes the Add and Set methods of a field.
+func (g *generator) helpers(f *file, r, name, q string, fd *goField) {
+	goType := strings.TrimPrefix(fd.typ, q)
+	switch fd.kind {
+	case kindStruct, kindRaw, kindContent:
+		f.p("")
+		f.p("func (%s *%s) Add%s() *%s {", r, name, fd.name, fd.typ)
+		if fd.repeated() {
+			f.p("newValue := new(%s)", fd.typ)
+			f.p("%s.%s = append(%s.%s, newValue)", r, fd.name, r, fd.name)
+			f.p("return newValue")
+		} else {
+			f.p("%s.%s = new(%s)", r, fd.name, fd.typ)
+			f.p("return %s.%s", r, fd.name)
+		}
+		f.p("}")
+	case kindAmount:
+		f.p("")
+		if fd.repeated() {
+			f.p("func (%s *%s) Add%s(value, currency string) {", r, name, fd.name)
+			f.p("%s.%s = append(%s.%s, %sNew%s(value, currency))", r, fd.name, r, fd.name, q, goType)
+			f.p("}")
+			return
+		}
+		f.imports[modulePath+"/decimal"] = true
+		f.p("func (%s *%s) Set%s(value, currency string) {", r, name, fd.name)
+		f.p("%s.%s = %sNew%s(value, currency)", r, fd.name, q, goType)
+		f.p("}")
+		f.p("")
+		f.p("func (%s *%s) Set%sFromDecimal(value decimal.Decimal, currency string) {", r, name, fd.name)
+		f.p("%s.%s = %sNew%s(value.String(), currency)", r, fd.name, q, goType)
+		f.p("}")
+	case kindEnum:
+		f.p("")
+		verb := "Set"
+		if fd.repeated() {
+			verb = "Add"
+		}
+		f.p("func (%s *%s) %s%s(value string) error {", r, name, verb, fd.name)
+		f.p("if err := %s(value).Validate(); err != nil {", fd.typ)
+		f.p("return err")
+		f.p("}")
+		if fd.repeated() {
+			f.p("%s.%s = append(%s.%s, (*%s)(&value))", r, fd.name, r, fd.name, fd.typ)
+		} else {
+			f.p("%s.%s = (*%s)(&value)", r, fd.name, fd.typ)
+		}
+		f.p("return nil")
+		f.p("}")
+	default:
+		f.p("")
+		if fd.repeated() {
+			f.p("func (%s *%s) Add%s(value string) {", r, name, fd.name)
+			f.p("%s.%s = append(%s.%s, (*%s)(&value))", r, fd.name, r, fd.name, fd.typ)
+			f.p("}")
+			return
+		}
+		f.p("func (%s *%s) Set%s(value string) {", r, name, fd.name)
+		f.p("%s.%s = (*%s)(&value)", r, fd.name, fd.typ)
+		f.p("}")
+		method, typ := g.conversion(fd.element.typ)
+		if method == "" {
+			return
+		}
+		switch typ {
+		case "time.Time":
+			f.imports["time"] = true
+		case "decimal.Decimal":
+			f.imports[modulePath+"/decimal"] = true
+		}
+		f.p("")
+		f.p("func (%s *%s) Set%s%s(value %s) {", r, name, fd.name, method, typ)
+		f.p("%s.%s = new(%s)", r, fd.name, fd.typ)
+		f.p("%s.%s.%s(value)", r, fd.name, method)
+		f.p("}")
+	}
+}
+
+// rawType writes a type whose content is kept as raw XML.
+func (g *generator) rawType(f *file, c *complexType, doc string) {
+	r := receiver(c.name)
+	f.comment(doc)
+	f.p("type %s struct {", c.name)
+	f.p("Value string `xml:\",innerxml\"`")
+	f.p("}")
+	f.p("")
+	f.p("func (%s *%s) Validate() error {", r, c.name)
+	f.p("return ValidateElement(%s)", r)
+	f.p("}")
+	f.p("")
+	f.imports["encoding/xml"] = true
+	f.p("func (%s *%s) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {", r, c.name)
+	f.p("value, err := innerXML(d, start)")
+	f.p("if err != nil {")
+	f.p("return err")
+	f.p("}")
+	f.p("%s.Value = value", r)
+	f.p("return nil")
+	f.p("}")
+	if *c.any != "##other" {
+		f.p("")
+		f.p("// Embed replaces the content with the XML encoding of v.")
+		f.p("func (%s *%s) Embed(v interface{}) error {", r, c.name)
+		f.p("value, err := embedXML(v)")
+		f.p("if err != nil {")
+		f.p("return err")
+		f.p("}")
+		f.p("%s.Value = value", r)
+		f.p("return nil")
+		f.p("}")
+		f.p("")
+		f.p("// Extract decodes the content into v.")
+		f.p("func (%s *%s) Extract(v interface{}) error {", r, c.name)
+		f.p("return extractXML(%s.Value, v)", r)
+		f.p("}")
+		f.p("")
+		f.p("// Namespace returns the namespace of the first element of the content.")
+		f.p("func (%s *%s) Namespace() string {", r, c.name)
+		f.p("return rootNamespace(%s.Value)", r)
+		f.p("}")
+	}
+	if g.fast[c.name] {
+		g.rawFast(f, r, c.name)
+	}
+}
+
+// amountType writes an amount, whose value has the facets of the simple type
+// value and whose currency has the type currency.
+func (g *generator) amountType(f *file, name, doc, value, currency string, implied bool) error {
+	st, err := g.simpleType(value)
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	r := receiver(name)
+	f.imports[modulePath+"/amount"] = true
+	f.imports[modulePath+"/decimal"] = true
+	attr := "Ccy,attr"
+	if implied {
+		attr += ",omitempty"
+	}
+	f.comment(doc)
+	f.p("type %s struct {", name)
+	f.p("Value string `xml:\",chardata\"`")
+	f.p("Currency string `xml:%q`", attr)
+	f.p("}")
+	f.p("")
+	f.p("func (%s *%s) Validate() error {", r, name)
+	f.p("if err := ValidateElement(%s); err != nil {", r)
+	f.p("return err")
+	f.p("}")
+	active := currency == "ActiveCurrencyCode"
+	minorUnits := st.fractionDigits <= 5 && !implied
+	f.p("return validateAmount(%q, %s.Value, %s.Currency, %d, %d, %t, %t)", name, r, r, st.totalDigits, st.fractionDigits, active, minorUnits)
+	f.p("}")
+	f.p("")
+	f.p("func New%s(value, currency string) *%s {", name, name)
+	f.p("return &%s{Value: value, Currency: currency}", name)
+	f.p("}")
+	f.p("")
+	f.p("func (%s *%s) Decimal() (decimal.Decimal, error) {", r, name)
+	f.p("return decimal.Parse(%s.Value)", r)
+	f.p("}")
+	f.p("")
+	f.p("func New%sFromDecimal(value decimal.Decimal, currency string) *%s {", name, name)
+	f.p("return New%s(value.String(), currency)", name)
+	f.p("}")
+	f.p("")
+	f.p("func (%s *%s) Amount() (amount.Amount, error) {", r, name)
+	f.p("value, err := %s.Decimal()", r)
+	f.p("if err != nil {")
+	f.p("return amount.Amount{}, err")
+	f.p("}")
+	f.p("return amount.New(value, %s.Currency), nil", r)
+	f.p("}")
+	f.p("")
+	f.p("func New%sFromAmount(value amount.Amount) *%s {", name, name)
+	f.p("return New%s(value.Text(), value.Currency)", name)
+	f.p("}")
+	if g.fast[name] {
+		g.amountFast(f, r, name, implied)
+	}
+	return nil
+}
+
+// contentType writes a text value with attributes.
+func (g *generator) contentType(f *file, c *complexType, doc string) {
+	r := receiver(c.name)
+	f.comment(doc)
+	f.p("type %s struct {", c.name)
+	f.p("Value string `xml:\",chardata\"`")
+	for _, a := range c.attrs {
+		tag := a.name + ",attr"
+		if !a.required {
+			tag += ",omitempty"
+		}
+		f.p("%s string `xml:%q`", exported(a.name), tag)
+	}
+	f.p("}")
+	f.p("")
+	f.p("func (%s *%s) Validate() error {", r, c.name)
+	f.p("return ValidateElement(%s)", r)
+	f.p("}")
+	if g.fast[c.name] {
+		g.contentFast(f, r, c)
+	}
+}
+
+// simpleTypeDecl writes a string type with the Validate method checking the
+// facets of a simple type, and its conversions.
+func (g *generator) simpleTypeDecl(f *file, st *simpleType) error {
+	name := st.name
+	r := receiver(name)
+	f.p("type %s string", name)
+	if len(st.enumeration) > 0 {
+		g.enumeration(f, st)
+		return nil
+	}
+
+	var calls []string
+	value := fmt.Sprintf("string(%s)", r)
+	external := strings.HasPrefix(name, "External") && st.builtin == "string" && len(st.patterns) == 0 && (st.minLength >= 0 || st.maxLength >= 0)
+	if len(st.patterns) > 0 {
+		v := lowerCamel(name) + "Pattern"
+		f.p("")
+		f.p("var %s = newPattern(%s)", v, quote(strings.Join(st.patterns, "|")))
+		calls = append(calls, fmt.Sprintf("validatePattern(%q, %s, %s)", name, value, v))
+	}
+	min := st.minLength
+	if min < 0 {
+		min = 0
+	}
+	switch st.builtin {
+	case "string", "normalizedString", "token":
+		if st.minLength >= 0 || st.maxLength >= 0 {
+			call := fmt.Sprintf("validateLength(%q, %s, %d, %d)", name, value, min, st.maxLength)
+			if external {
+				calls = []string{call, fmt.Sprintf("validateExternalCode(%q, %s)", name, value)}
+			} else {
+				calls = append(calls, call)
+			}
+		}
+	case "base64Binary":
+		calls = append(calls, fmt.Sprintf("validateBinary(%q, %s, %d, %d)", name, value, min, st.maxLength))
+	case "boolean":
+		calls = append([]string{fmt.Sprintf("validateBoolean(%q, %s)", name, value)}, calls...)
+	case "decimal", "integer", "positiveInteger", "nonNegativeInteger":
+		var c []string
+		c = append(c, fmt.Sprintf("validateDecimal(%q, %s, %d, %d)", name, value, st.totalDigits, st.fractionDigits))
+		if st.minInclusive != "" {
+			c = append(c, fmt.Sprintf("validateInclusive(%q, %s, \"minInclusive\", %q)", name, value, st.minInclusive))
+		}
+		if st.maxInclusive != "" {
+			c = append(c, fmt.Sprintf("validateInclusive(%q, %s, \"maxInclusive\", %q)", name, value, st.maxInclusive))
+		}
+		calls = append(c, calls...)
+	case "date", "dateTime", "time", "gYear", "gYearMonth":
+		calls = append([]string{fmt.Sprintf("validateBuiltin(%q, %s, %q)", name, value, st.builtin)}, calls...)
+	default:
+		return fmt.Errorf("%s: unsupported built-in type %s", name, st.builtin)
+	}
+	if check, ok := checks[name]; ok {
+		f.imports[modulePath+"/validation"] = true
+		if strings.Contains(check, "strings.") {
+			f.imports["strings"] = true
+		}
+		calls = append(calls, fmt.Sprintf(check, r))
+	}
+	if len(calls) > 0 {
+		f.p("")
+		f.p("func (%s %s) Validate() error {", r, name)
+		for _, call := range calls[:len(calls)-1] {
+			f.p("if err := %s; err != nil {", call)
+			f.p("return err")
+			f.p("}")
+		}
+		f.p("return %s", calls[len(calls)-1])
+		f.p("}")
+	}
+	if external {
+		f.p("")
+		f.p("func (%s %s) Description() string {", r, name)
+		f.p("return describeExternalCode(%q, %s)", name, value)
+		f.p("}")
+	}
+	g.conversions(f, st, r)
+	return nil
+}
+
+// conversions writes the methods converting a simple type from and to a Go
+// value.
+func (g *generator) conversions(f *file, st *simpleType, r string) {
+	name := st.name
+	switch st.builtin {
+	case "boolean":
+		f.p("")
+		f.p("func (%s %s) Bool() bool {", r, name)
+		f.p("return parseBoolean(string(%s))", r)
+		f.p("}")
+		f.p("")
+		f.p("func (%s *%s) FromBool(value bool) {", r, name)
+		f.p("*%s = %s(formatBoolean(value))", r, name)
+		f.p("}")
+	case "decimal":
+		f.imports[modulePath+"/decimal"] = true
+		f.p("")
+		f.p("func (%s %s) Decimal() (decimal.Decimal, error) {", r, name)
+		f.p("return decimal.Parse(string(%s))", r)
+		f.p("}")
+		f.p("")
+		f.p("func (%s *%s) FromDecimal(value decimal.Decimal) {", r, name)
+		f.p("*%s = %s(value.String())", r, name)
+		f.p("}")
+	case "date", "dateTime", "time":
+		f.imports["time"] = true
+		layout, doc, format := "2006-01-02", "FromTime sets the value to the date of t in the location of t.", `value.Format("2006-01-02")`
+		switch {
+		case st.builtin == "time":
+			layout, doc, format = "15:04:05", "FromTime sets the value to the time of day of t, keeping its time zone offset.", `value.Format("15:04:05.999999999Z07:00")`
+		case len(st.patterns) == 1 && st.patterns[0] == ".*Z":
+			layout, doc, format = "2006-01-02T15:04:05", "FromTime sets the value to t converted to UTC.", "value.UTC().Format(time.RFC3339Nano)"
+		case st.builtin == "dateTime":
+			layout, doc, format = "2006-01-02T15:04:05", "FromTime sets the value to t, keeping the time zone offset and fractional seconds of t.", "value.Format(time.RFC3339Nano)"
+		}
+		f.p("")
+		f.p("// Time returns the value as a time.Time. A value without a time zone is returned in UTC.")
+		f.p("func (%s %s) Time() (time.Time, error) {", r, name)
+		f.p("return parseTime(%q, string(%s), %q, %q)", name, r, st.builtin, layout)
+		f.p("}")
+		f.p("")
+		f.p("// %s", doc)
+		f.p("func (%s *%s) FromTime(value time.Time) {", r, name)
+		f.p("*%s = %s(%s)", r, name, format)
+		f.p("}")
+	}
+}
+
+// enumeration writes the constants of the values of a Code type, with their
+// descriptions.
+func (g *generator) enumeration(f *file, st *simpleType) {
+	name := st.name
+	r := receiver(name)
+	src := g.src.loaded("model")
+	var values []string
+	f.p("")
+	f.p("const (")
+	for _, e := range st.enumeration {
+		f.p("%s%s %s = %q", name, identifierPart(e.code), name, e.code)
+		values = append(values, e.code)
+	}
+	f.p(")")
+	f.p("")
+	v := lowerCamel(name) + "Descriptions"
+	f.p("var %s = map[%s]string{", v, name)
+	for _, e := range st.enumeration {
+		description := describe(e.name, e.definition)
+		if description == "" {
+			if d, ok := g.repo.codes[name][e.code]; ok {
+				description = describe(d.name, d.definition)
+			}
+		}
+		if description == "" {
+			description = src.codes[name][e.code]
+		}
+		f.p("%s%s: %q,", name, identifierPart(e.code), description)
+	}
+	f.p("}")
+	f.p("")
+	f.p("func (%s %s) IsValid() bool {", r, name)
+	f.p("_, ok := %s[%s]", v, r)
+	f.p("return ok")
+	f.p("}")
+	f.p("")
+	f.p("func (%s %s) Description() string {", r, name)
+	f.p("return %s[%s]", v, r)
+	f.p("}")
+	f.p("")
+	f.p("func (%s %s) Validate() error {", r, name)
+	f.p("return validateEnumeration(%q, string(%s), %s.IsValid(), %q)", name, r, r, strings.Join(values, ", "))
+	f.p("}")
+}
+
+// typeDoc returns the documentation of a type of the package pkg.
+func (g *generator) typeDoc(name, definition, pkg string) string {
+	if definition != "" {
+		return definition
+	}
+	if d, ok := g.repo.docs[name]; ok {
+		return d
+	}
+	return g.src.loaded(pkg).docs[name]
+}
+
+// field returns the Go name and the documentation of the field of an element
+// of the type owner of the package pkg. The names are taken from the XSD, the
+// e-Repository, and the existing source in that order, and default to the XML
+// tag.
+func (g *generator) field(owner string, e *element, pkg string) (name, doc string) {
+	name, doc = e.name, e.definition
+	if d, ok := g.repo.elements[owner][e.tag]; ok {
+		if name == "" {
+			name = d.name
+		}
+		if doc == "" {
+			doc = d.definition
+		}
+	}
+	if fd, ok := g.src.loaded(pkg).fields[owner][e.tag]; ok {
+		if name == "" {
+			name = fd.name
+		}
+		if doc == "" {
+			doc = fd.doc
+		}
+	}
+	for _, p := range []string{pkg, "model"} {
+		if name == "" {
+			name = g.src.loaded(p).tags[e.tag].name
+		}
+	}
+	if name == "" {
+		name = e.tag
+	}
+	return exported(name), doc
+}
+
+// describe returns the description of a code from its name and definition,
+// for example "High: priority level is high.". The first letter of the
+// definition is lowered, unless it starts an acronym.
+func describe(name, definition string) string {
+	name = words(name)
+	if i := strings.IndexFunc(definition, unicode.IsLetter); i >= 0 && i+1 < len(definition) && !unicode.IsUpper(rune(definition[i+1])) {
+		definition = definition[:i] + strings.ToLower(definition[i:i+1]) + definition[i+1:]
+	}
+	switch {
+	case name == "":
+		return definition
+	case definition == "":
+		return name
+	}
+	return name + ": " + definition
+}
+
+// words splits a name in upper camel case into words, keeping the acronyms,
+// for example "POBox" into "PO box". A name with spaces is kept.
+func words(name string) string {
+	if strings.Contains(name, " ") {
+		return name
+	}
+	rs := []rune(name)
+	var ws []string
+	start := 0
+	for i := 1; i < len(rs); i++ {
+		if unicode.IsUpper(rs[i]) && (unicode.IsLower(rs[i-1]) || i+1 < len(rs) && unicode.IsLower(rs[i+1])) {
+			ws = append(ws, string(rs[start:i]))
+			start = i
+		}
+	}
+	if start < len(rs) {
+		ws = append(ws, string(rs[start:]))
+	}
+	for i := 1; i < len(ws); i++ {
+		if len(ws[i]) == 1 || strings.ToUpper(ws[i]) != ws[i] {
+			ws[i] = strings.ToLower(ws[i])
+		}
+	}
+	return strings.Join(ws, " ")
+}
+
+// receiver returns the receiver name of the methods of a type.
+func receiver(name string) string {
+	return strings.ToLower(name[:1])
+}
+
+// lowerCamel returns a type name in lower camel case, as the name of a package
+// variable: the leading upper case letters are lowered, except the last one
+// when it starts a word, as in bicfiDec2014Identifier.
+func lowerCamel(name string) string {
+	rs := []rune(name)
+	n := 0
+	for n < len(rs) && unicode.IsUpper(rs[n]) {
+		n++
+	}
+	if n > 1 && n+1 < len(rs) && unicode.IsLower(rs[n]) && unicode.IsLower(rs[n+1]) {
+		n--
+	}
+	return strings.ToLower(string(rs[:n])) + string(rs[n:])
+}
+
+// exported returns name as an exported Go identifier.
+func exported(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			b.WriteRune(r)
+		}
+	}
+	s := b.String()
+	if s == "" || !unicode.IsLetter(rune(s[0])) {
+		s = "X" + s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// identifierPart returns a code as the suffix of a constant name.
+func identifierPart(code string) string {
+	var b strings.Builder
+	for _, r := range code {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
+
+// quote returns a pattern as a raw string literal, or as an interpreted one when
+// it contains a back quote.
+func quote(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
+// identifier returns the message definition identifier of a namespace, for
+// example pacs.008.001.08.
+func identifier(namespace string) (string, error) {
+	id := namespace[strings.LastIndexByte(namespace, ':')+1:]
+	parts := strings.Split(id, ".")
+	if len(parts) != 4 || len(parts[0]) != 4 {
+		return "", fmt.Errorf("namespace %s is not the one of an ISO 20022 message definition", namespace)
+	}
+	return id, nil
+}
+
+// file is the source of a Go file being generated.
+type file struct {
+	pkg     string
+	imports map[string]bool
+	body    bytes.Buffer
+}
+
+func newFile(pkg string) *file {
+	return &file{pkg: pkg, imports: map[string]bool{}}
+}
+
+// p writes a line of source. The indentation is left to go/format.
+func (f *file) p(format string, args ...interface{}) {
+	fmt.Fprintf(&f.body, format, args...)
+	f.body.WriteByte('\n')
+}
+
+// comment writes a documentation comment, keeping the line breaks of doc.
+func (f *file) comment(doc string) {
+	if doc == "" {
+		return
+	}
+	for _, line := range strings.Split(doc, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" {
+			f.p("//")
+		} else {
+			f.p("// %s", line)
+		}
+	}
+}
+
+// source returns the formatted source of the file. The standard library
+// imports come first, then the ones of the module.
+func (f *file) source() ([]byte, error) {
+	var std, mod []string
+	for imp, ok := range f.imports {
+		if !ok {
+			continue
+		}
+		if strings.HasPrefix(imp, modulePath) {
+			mod = append(mod, imp)
+		} else {
+			std = append(std, imp)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(mod)
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "package %s\n\n", f.pkg)
+	switch {
+	case len(std) == 0 && len(mod) == 1 && mod[0] == modulePath+"/fastxml":
+		fmt.Fprintf(&b, "import %q\n\n", mod[0])
+	case len(std)+len(mod) > 0:
+		b.WriteString("import (\n")
+		for _, imp := range std {
+			fmt.Fprintf(&b, "\t%q\n", imp)
+		}
+		if len(std) > 0 && len(mod) > 0 {
+			b.WriteString("\n")
+		}
+		for _, imp := range mod {
+			fmt.Fprintf(&b, "\t%q\n", imp)
+		}
+		b.WriteString(")\n\n")
+	}
+	b.Write(bytes.TrimRight(f.body.Bytes(), "\n"))
+	b.WriteString("\n")
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// generateTestdata generates the testdata XSDs, with the fastxml methods for
+// the message definitions fast.
+func generateTestdata(t *testing.T, fast ...string) (*generator, []*schema) {
+	t.Helper()
+	warn := func(format string, args ...interface{}) {
+		t.Errorf(format, args...)
+	}
+	schemas, types, err := readXSDs("testdata", warn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := readRepository(filepath.Join("testdata", "repository.iso20022"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := &generator{types: types, repo: r, src: newSource(filepath.Join("..", "..")), warn: warn}
+	ids := map[string]bool{}
+	for _, id := range fast {
+		ids[id] = true
+	}
+	if err := g.generate(schemas, ids); err != nil {
+		t.Fatal(err)
+	}
+	return g, schemas
+}
+
+// TestGenerateModel checks that the model types generated from the testdata
+// XSDs, one whose documentation comes from the XSD and one whose documentation
+// comes from the e-Repository export and the existing source, are the ones of
+// the model package. Both have the fastxml methods, as their types have in
+// the model package.
+func TestGenerateModel(t *testing.T) {
+	g, _ := generateTestdata(t, "test.001.001.01", "test.002.001.01")
+	n := 0
+	for file, data := range g.files {
+		if !strings.HasPrefix(file, "model/") {
+			continue
+		}
+		n++
+		want, err := os.ReadFile(filepath.Join("..", "..", filepath.FromSlash(file)))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if !bytes.Equal(data, want) {
+			t.Errorf("%s differs from the model package:\n%s", file, data)
+		}
+	}
+	if n != 17 {
+		t.Errorf("%d model files, want 17", n)
+	}
+}
+
+func TestGenerateMessage(t *testing.T) {
+	g, _ := generateTestdata(t, "test.001.001.01")
+	for file, want := range map[string][]string{
+		"test/TestPaymentV01.go": {
+			"type Document00100101 struct {",
+			"`xml:\"urn:iso:std:iso:20022:tech:xsd:test.001.001.01 Document\"`",
+			"func (d *Document00100101) MarshalXMLFast() ([]byte, error) {",
+			"// Scope\n// The TestPayment message is made up for the tests of the generator.\ntype TestPaymentV01 struct {",
+			"Identification []*model.GenericIdentification30 `xml:\"Id,omitempty\" xsd:\"maxOccurs=3\"`",
+			"func (t *TestPaymentV01) SetInstructionPriority(value string) error {",
+			"func (t *TestPaymentV01) SetRequestedDateFromTime(value time.Time) {",
+			"func (t *TestPaymentV01) EncodeXMLFast(enc *fastxml.Encoder, tag string) {",
+			"t.ChargesAmount.EncodeXMLFast(enc, \"ChrgsAmt\")",
+		},
+		"test/TestAddressV01.go": {
+			"// Scope\n// The TestAddress message is made up for the tests of the generator.\n",
+			"\t// Address of the party.\n\tPostalAddress *model.PostalAddress24 `xml:\"PstlAdr,omitempty\"`",
+			"func (t *TestAddressV01) AddNumber(value string) {",
+		},
+	} {
+		data := string(g.files[file])
+		for _, s := range want {
+			if !strings.Contains(data, s) {
+				t.Errorf("%s does not contain %q", file, s)
+			}
+		}
+	}
+	if strings.Contains(string(g.files["test/TestAddressV01.go"]), "XMLFast") {
+		t.Error("test/TestAddressV01.go has fastxml methods")
+	}
+}
+
+func TestGenerateDeterministic(t *testing.T) {
+	g1, _ := generateTestdata(t, "test.001.001.01")
+	g2, _ := generateTestdata(t, "test.001.001.01")
+	if len(g1.files) != len(g2.files) {
+		t.Fatalf("%d and %d files", len(g1.files), len(g2.files))
+	}
+	for file, data := range g1.files {
+		if !bytes.Equal(data, g2.files[file]) {
+			t.Errorf("%s differs between two runs", file)
+		}
+	}
+}
+
+// TestSourceError checks that a package of the existing source that cannot be
+// parsed stops the generation, every time it is read.
+func TestSourceError(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "model"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "model", "Max35Text.go"), []byte("package model\n\ntype Max35Text string\n\nfunc ("), 0644); err != nil {
+		t.Fatal(err)
+	}
+	src := newSource(dir)
+	for i := 0; i < 2; i++ {
+		if _, err := src.pkg("model"); err == nil {
+			t.Errorf("read %d of the source succeeded", i+1)
+		}
+	}
+	schemas, types, err := readXSDs("testdata", t.Errorf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := &generator{types: types, repo: newRepository(), src: src, warn: t.Errorf}
+	if err := g.generate(schemas, nil); err == nil || !strings.Contains(err.Error(), "Max35Text.go") {
+		t.Errorf("generation: %v", err)
+	}
+}
+
+func TestCatalogue(t *testing.T) {
+	_, schemas := generateTestdata(t, "test.001.001.01")
+	existing := filepath.Join("..", "..", "message", "Catalogue.go")
+	data, err := catalogue(existing, schemas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old, err := os.ReadFile(existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(catalogueEntry.FindAll(data, -1)), len(catalogueEntry.FindAll(old, -1))+2; got != want {
+		t.Errorf("%d entries, want %d", got, want)
+	}
+	for _, s := range []string{
+		"\t\"github.com/yudaprama/iso20022/test\"\n",
+		"\"urn:iso:std:iso:20022:tech:xsd:test.002.001.01\": func() Message { return new(test.Document00200101) },",
+	} {
+		if !bytes.Contains(data, []byte(s)) {
+			t.Errorf("catalogue does not contain %q", s)
+		}
+	}
+}
+
+// TestLowerCamel checks the names of the pattern variables of the model
+// package.
+func TestLowerCamel(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "..", "model", "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pattern := regexp.MustCompile(`(?m)^var (\w+)Pattern = newPattern`)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		name := strings.TrimSuffix(filepath.Base(file), ".go")
+		m := pattern.FindSubmatch(data)
+		if m == nil || !bytes.Contains(data, []byte("\ntype "+name+" string\n")) {
+			continue
+		}
+		if got := lowerCamel(name); got != string(m[1]) {
+			t.Errorf("lowerCamel(%s) = %s, want %s", name, got, m[1])
+		}
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	for _, tt := range []struct{ name, definition, want string }{
+		{"POBox", "Address is a postal office (PO) box.", "PO box: address is a postal office (PO) box."},
+		{"MailTo", "Address is the address to which mail is sent.", "Mail to: address is the address to which mail is sent."},
+		{"High", "", "High"},
+		{"", "BIC of the agent.", "BIC of the agent."},
+		{"Pay creditor by cheque", "(Ultimate) creditor must be paid by cheque.", "Pay creditor by cheque: (ultimate) creditor must be paid by cheque."},
+	} {
+		if got := describe(tt.name, tt.definition); got != tt.want {
+			t.Errorf("describe(%q, %q) = %q, want %q", tt.name, tt.definition, got, tt.want)
+		}
+	}
+}
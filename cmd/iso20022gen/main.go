@@ -0,0 +1,121 @@
+// Command iso20022gen generates the Go source of ISO 20022 message definitions
+// from their XSDs: the types of the model package, a file per message
+// definition in its business area package with the Document type, or the
+// AppHdr type of a Business Application Header, and the message type, and the
+// catalogue of the message package.
+//
+// Each complex type is a struct with a field for each element, and with the
+// Add and Set helpers of its fields. Each simple type is a string type whose
+// Validate method checks its facets, the Code types with an enumeration having
+// a constant for each code. The amounts are structs with the value and the
+// currency.
+//
+// Usage:
+//
+//	iso20022gen -xsd dir [-repository file] [-src dir] [-out dir] [-fast ids]
+//
+// The XSDs are read from dir and its subdirectories. The names and the
+// documentation of the types, the fields and the codes are taken from the XSD
+// annotations when present, then from the e-Repository export given with
+// -repository, then from the existing source of the module in -src, and the
+// field names default to the XML tags. The message definitions whose
+// identifiers are listed in -fast, separated by commas, and the types they
+// use, have the methods of the fastxml package.
+//
+// The output is deterministic, so that regenerating the module gives a diff
+// that can be reviewed: run iso20022gen from the root of the module with the
+// XSDs of the message definitions to add or to fix. The code written by hand
+// in a generated file, such as the deprecated Document00100101 type of the
+// head package, is not kept and must be restored from the diff.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// fastDefinitions are the message definitions with the fastxml methods by
+// default.
+var fastDefinitions = []string{
+	"camt.052.001.06",
+	"camt.053.001.06",
+	"camt.053.001.08",
+	"camt.054.001.06",
+	"pacs.002.001.08",
+	"pacs.004.001.07",
+	"pacs.008.001.06",
+	"pacs.008.001.08",
+	"pacs.009.001.08",
+	"pain.001.001.08",
+	"pain.001.001.09",
+	"pain.002.001.08",
+}
+
+func main() {
+	xsd := flag.String("xsd", "", "directory of the XSDs of the message definitions")
+	repo := flag.String("repository", "", "e-Repository export with the names and definitions")
+	src := flag.String("src", ".", "root directory of the existing iso20022 module, or empty for none")
+	out := flag.String("out", ".", "root directory of the generated module")
+	fast := flag.String("fast", strings.Join(fastDefinitions, ","), "message definitions with the fastxml methods")
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("iso20022gen: ")
+	if *xsd == "" || flag.NArg() > 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	warn := func(format string, args ...interface{}) {
+		log.Printf("warning: "+format, args...)
+	}
+	schemas, types, err := readXSDs(*xsd, warn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(schemas) == 0 {
+		log.Fatalf("no XSD in %s", *xsd)
+	}
+	r := newRepository()
+	if *repo != "" {
+		if r, err = readRepository(*repo); err != nil {
+			log.Fatal(err)
+		}
+	}
+	ids := map[string]bool{}
+	for _, id := range strings.Split(*fast, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids[id] = true
+		}
+	}
+
+	g := &generator{types: types, repo: r, src: newSource(*src), warn: warn}
+	if err := g.generate(schemas, ids); err != nil {
+		log.Fatal(err)
+	}
+	catalogueFile := filepath.Join("message", "Catalogue.go")
+	data, err := catalogue(filepath.Join(*out, catalogueFile), schemas)
+	if err != nil {
+		log.Fatal(err)
+	}
+	g.files[filepath.ToSlash(catalogueFile)] = data
+
+	var files []string
+	for file := range g.files {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		path := filepath.Join(*out, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(path, g.files[file], 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	log.Printf("%d message definitions, %d files", len(schemas), len(files))
+}
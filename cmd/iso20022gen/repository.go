@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/xml"
+	"io"
+	"os"
+)
+
+// doc is the name and the definition of a repository item.
+type doc struct {
+	name       string
+	definition string
+}
+
+// repository holds the names and definitions of an ISO 20022 e-Repository
+// export, the XMI file published at iso20022.org: the definitions of the
+// message components, code sets and message definitions, the names and
+// definitions of their elements by XML tag, and those of the codes.
+type repository struct {
+	docs     map[string]string
+	elements map[string]map[string]doc
+	codes    map[string]map[string]doc
+}
+
+func newRepository() *repository {
+	return &repository{docs: map[string]string{}, elements: map[string]map[string]doc{}, codes: map[string]map[string]doc{}}
+}
+
+// readRepository reads an e-Repository export. The items are recognised by
+// their XMI element names and attributes, so the reader does not depend on
+// the version of the metamodel.
+func readRepository(file string) (*repository, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r := newRepository()
+	d := xml.NewDecoder(f)
+	var owners []string
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return r, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			owner := ""
+			if len(owners) > 0 {
+				owner = owners[len(owners)-1]
+			}
+			name, definition := attr(t, "name"), attr(t, "definition")
+			switch {
+			case t.Name.Local == "topLevelDictionaryEntry" || t.Name.Local == "messageDefinition":
+				owner = name
+				if name != "" && definition != "" {
+					r.docs[name] = definition
+				}
+			case t.Name.Local == "code" && attr(t, "codeName") != "" && owner != "":
+				if r.codes[owner] == nil {
+					r.codes[owner] = map[string]doc{}
+				}
+				r.codes[owner][attr(t, "codeName")] = doc{name: name, definition: definition}
+			case attr(t, "xmlTag") != "" && owner != "":
+				if r.elements[owner] == nil {
+					r.elements[owner] = map[string]doc{}
+				}
+				r.elements[owner][attr(t, "xmlTag")] = doc{name: name, definition: definition}
+			}
+			owners = append(owners, owner)
+		case xml.EndElement:
+			owners = owners[:len(owners)-1]
+		}
+	}
+}
+
+func attr(t xml.StartElement, name string) string {
+	for _, a := range t.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// field is the name and the documentation of a struct field of the existing
+// source, found by its XML tag.
+type field struct {
+	name string
+	doc  string
+}
+
+// pkgSource holds what the Go source of an existing package tells about the
+// types the XSDs do not document: the documentation comments of the types, the
+// names and documentation of the struct fields, and the descriptions of the
+// codes.
+type pkgSource struct {
+	docs   map[string]string
+	fields map[string]map[string]field
+	tags   map[string]field
+	codes  map[string]map[string]string
+}
+
+// source loads the Go source of the packages of an existing module on demand.
+// Without a directory, it holds nothing.
+type source struct {
+	dir  string
+	pkgs map[string]*pkgSource
+}
+
+func newSource(dir string) *source {
+	return &source{dir: dir, pkgs: map[string]*pkgSource{}}
+}
+
+// pkg returns the source of the package in the directory name of the module,
+// which is read the first time. A package that cannot be read is not kept, so
+// that every call reports the error.
+func (s *source) pkg(name string) (*pkgSource, error) {
+	if p, ok := s.pkgs[name]; ok {
+		return p, nil
+	}
+	p := &pkgSource{
+		docs:   map[string]string{},
+		fields: map[string]map[string]field{},
+		tags:   map[string]field{},
+		codes:  map[string]map[string]string{},
+	}
+	if s.dir == "" {
+		s.pkgs[name] = p
+		return p, nil
+	}
+	files, err := filepath.Glob(filepath.Join(s.dir, name, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	values := map[string]string{}
+	var descriptions []*ast.CompositeLit
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			d, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					doc := s.Doc
+					if doc == nil && len(d.Specs) == 1 {
+						doc = d.Doc
+					}
+					p.docs[s.Name.Name] = text(doc)
+					if st, ok := s.Type.(*ast.StructType); ok {
+						p.structType(s.Name.Name, st)
+					}
+				case *ast.ValueSpec:
+					for i, name := range s.Names {
+						if i >= len(s.Values) {
+							continue
+						}
+						switch v := s.Values[i].(type) {
+						case *ast.BasicLit:
+							if value, err := strconv.Unquote(v.Value); err == nil && v.Kind == token.STRING {
+								values[name.Name] = value
+							}
+						case *ast.CompositeLit:
+							if strings.HasSuffix(name.Name, "Descriptions") {
+								descriptions = append(descriptions, v)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	for _, lit := range descriptions {
+		m, ok := lit.Type.(*ast.MapType)
+		if !ok {
+			continue
+		}
+		typ, ok := m.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		codes := map[string]string{}
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			key, ok := kv.Key.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			value, ok := kv.Value.(*ast.BasicLit)
+			if !ok {
+				continue
+			}
+			description, err := strconv.Unquote(value.Value)
+			if err != nil {
+				continue
+			}
+			if code, ok := values[key.Name]; ok {
+				codes[code] = description
+			}
+		}
+		p.codes[typ.Name] = codes
+	}
+	s.pkgs[name] = p
+	return p, nil
+}
+
+// loaded returns the source of a package that pkg has read, or nothing.
+func (s *source) loaded(name string) *pkgSource {
+	if p, ok := s.pkgs[name]; ok {
+		return p
+	}
+	return &pkgSource{}
+}
+
+func (p *pkgSource) structType(name string, st *ast.StructType) {
+	fields := map[string]field{}
+	for _, f := range st.Fields.List {
+		if f.Tag == nil || len(f.Names) != 1 {
+			continue
+		}
+		tag, err := strconv.Unquote(f.Tag.Value)
+		if err != nil {
+			continue
+		}
+		xmlTag := strings.Split(reflect.StructTag(tag).Get("xml"), ",")[0]
+		if xmlTag == "" || strings.Contains(xmlTag, " ") {
+			continue
+		}
+		fd := field{name: f.Names[0].Name, doc: text(f.Doc)}
+		fields[xmlTag] = fd
+		if _, ok := p.tags[xmlTag]; !ok {
+			p.tags[xmlTag] = fd
+		}
+	}
+	p.fields[name] = fields
+}
+
+// text returns the text of a comment, keeping its line breaks and empty lines.
+func text(c *ast.CommentGroup) string {
+	if c == nil {
+		return ""
+	}
+	lines := make([]string, len(c.List))
+	for i, line := range c.List {
+		s := strings.TrimPrefix(line.Text, "//")
+		lines[i] = strings.TrimPrefix(s, " ")
+	}
+	return strings.Join(lines, "\n")
+}
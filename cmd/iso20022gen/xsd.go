@@ -0,0 +1,489 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The XSD of an ISO 20022 message definition declares the root element, which
+// is Document or, for the Business Application Header, AppHdr, and a flat list
+// of named simple and complex types: the complex types are sequences or choices
+// of elements, an xs:any wildcard or, for the amounts, a decimal with a
+// currency attribute. The reader supports that subset of XML Schema.
+
+type xsdSchema struct {
+	TargetNamespace string           `xml:"targetNamespace,attr"`
+	Elements        []xsdParticle    `xml:"element"`
+	SimpleTypes     []xsdSimpleType  `xml:"simpleType"`
+	ComplexTypes    []xsdComplexType `xml:"complexType"`
+}
+
+type xsdAnnotation struct {
+	Documentation []struct {
+		Source string `xml:"source,attr"`
+		Text   string `xml:",chardata"`
+	} `xml:"documentation"`
+}
+
+// docs returns the name and the definition given by the annotation. A
+// documentation without source is taken as the definition.
+func (a *xsdAnnotation) docs() (name, definition string) {
+	if a == nil {
+		return "", ""
+	}
+	for _, d := range a.Documentation {
+		switch d.Source {
+		case "Name":
+			name = strings.TrimSpace(d.Text)
+		case "Definition", "":
+			definition = strings.TrimSpace(d.Text)
+		}
+	}
+	return name, definition
+}
+
+// xsdParticle is an element, an xs:any wildcard, or a nested sequence or
+// choice.
+type xsdParticle struct {
+	XMLName    xml.Name
+	Name       string         `xml:"name,attr"`
+	Type       string         `xml:"type,attr"`
+	MinOccurs  string         `xml:"minOccurs,attr"`
+	MaxOccurs  string         `xml:"maxOccurs,attr"`
+	Namespace  string         `xml:"namespace,attr"`
+	Annotation *xsdAnnotation `xml:"annotation"`
+	Items      []xsdParticle  `xml:",any"`
+}
+
+type xsdFacet struct {
+	XMLName    xml.Name
+	Value      string         `xml:"value,attr"`
+	Annotation *xsdAnnotation `xml:"annotation"`
+}
+
+type xsdSimpleType struct {
+	Name        string         `xml:"name,attr"`
+	Annotation  *xsdAnnotation `xml:"annotation"`
+	Restriction struct {
+		Base   string     `xml:"base,attr"`
+		Facets []xsdFacet `xml:",any"`
+	} `xml:"restriction"`
+}
+
+type xsdComplexType struct {
+	Name          string         `xml:"name,attr"`
+	Annotation    *xsdAnnotation `xml:"annotation"`
+	Sequence      *xsdParticle   `xml:"sequence"`
+	Choice        *xsdParticle   `xml:"choice"`
+	SimpleContent *struct {
+		Extension struct {
+			Base       string `xml:"base,attr"`
+			Attributes []struct {
+				Name string `xml:"name,attr"`
+				Type string `xml:"type,attr"`
+				Use  string `xml:"use,attr"`
+			} `xml:"attribute"`
+		} `xml:"extension"`
+	} `xml:"simpleContent"`
+}
+
+// simpleType is a simple type with its facets, after its named base types were
+// followed to the XSD built-in type.
+type simpleType struct {
+	name           string
+	definition     string
+	builtin        string
+	minLength      int
+	maxLength      int
+	patterns       []string
+	enumeration    []enumValue
+	totalDigits    int
+	fractionDigits int
+	minInclusive   string
+	maxInclusive   string
+}
+
+type enumValue struct {
+	code       string
+	name       string
+	definition string
+}
+
+// complexType is a struct type.
+type complexType struct {
+	name       string
+	definition string
+	choice     bool
+	elements   []*element
+
+	// any is set for a wildcard content, to the namespace constraint.
+	any *string
+
+	// value is the type of the text of a simple content, with the attributes.
+	value string
+	attrs []attribute
+}
+
+type element struct {
+	tag        string
+	name       string
+	definition string
+	typ        string
+	min        int
+
+	// max is the maximum number of occurrences, or -1 for unbounded.
+	max int
+}
+
+type attribute struct {
+	name     string
+	typ      string
+	required bool
+}
+
+// schema is a message definition read from an XSD.
+type schema struct {
+	file      string
+	namespace string
+
+	// root is the name of the root element, Document or AppHdr.
+	root string
+
+	// message is the type of the message, and tag its element in a Document.
+	message string
+	tag     string
+}
+
+// types holds the types of all the XSDs read, by name.
+type types struct {
+	simple  map[string]*simpleType
+	complex map[string]*complexType
+	from    map[string]string
+}
+
+// readXSDs reads the XSD files of dir and of its subdirectories, in the order
+// of their names. A type defined by several XSDs is taken from the first.
+func readXSDs(dir string, warn func(format string, args ...interface{})) ([]*schema, *types, error) {
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.EqualFold(filepath.Ext(path), ".xsd") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Strings(files)
+	t := &types{simple: map[string]*simpleType{}, complex: map[string]*complexType{}, from: map[string]string{}}
+	var schemas []*schema
+	for _, file := range files {
+		s, err := t.read(file, warn)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", file, err)
+		}
+		schemas = append(schemas, s)
+	}
+	return schemas, t, nil
+}
+
+func (t *types) read(file string, warn func(format string, args ...interface{})) (*schema, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var x xsdSchema
+	if err := xml.Unmarshal(data, &x); err != nil {
+		return nil, err
+	}
+	if len(x.Elements) != 1 {
+		return nil, fmt.Errorf("%d root elements, want 1", len(x.Elements))
+	}
+	s := &schema{file: file, namespace: x.TargetNamespace, root: x.Elements[0].Name}
+
+	raw := map[string]xsdSimpleType{}
+	for _, st := range x.SimpleTypes {
+		raw[st.Name] = st
+	}
+	simple := map[string]*simpleType{}
+	for _, st := range x.SimpleTypes {
+		v, err := resolve(st.Name, raw, nil)
+		if err != nil {
+			return nil, err
+		}
+		simple[st.Name] = v
+	}
+	complex := map[string]*complexType{}
+	for _, ct := range x.ComplexTypes {
+		c, err := newComplexType(ct)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", ct.Name, err)
+		}
+		complex[ct.Name] = c
+	}
+
+	document, ok := complex[local(x.Elements[0].Type)]
+	if !ok {
+		return nil, fmt.Errorf("root element %s has no complex type", s.root)
+	}
+	if s.root == "Document" {
+		if len(document.elements) != 1 {
+			return nil, fmt.Errorf("Document has %d elements, want 1", len(document.elements))
+		}
+		s.message = local(document.elements[0].typ)
+		s.tag = document.elements[0].tag
+		delete(complex, document.name)
+	} else {
+		s.message = document.name
+	}
+
+	var names []string
+	for name := range simple {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		v := simple[name]
+		if c, ok := complex[name]; ok {
+			return nil, fmt.Errorf("%s is both a simple and a complex type", c.name)
+		}
+		if prev, ok := t.simple[name]; ok {
+			if !sameSimple(prev, v) {
+				warn("simple type %s of %s differs from %s, which is kept", name, file, t.from[name])
+			}
+			continue
+		}
+		t.simple[name] = v
+		t.from[name] = file
+	}
+	names = names[:0]
+	for name := range complex {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		c := complex[name]
+		if prev, ok := t.complex[name]; ok {
+			if !sameComplex(prev, c) {
+				warn("complex type %s of %s differs from %s, which is kept", name, file, t.from[name])
+			}
+			continue
+		}
+		t.complex[name] = c
+		t.from[name] = file
+	}
+	return s, nil
+}
+
+// resolve returns the simple type name with the facets of its named base
+// types.
+func resolve(name string, raw map[string]xsdSimpleType, seen []string) (*simpleType, error) {
+	for _, s := range seen {
+		if s == name {
+			return nil, fmt.Errorf("simple type %s derives from itself", name)
+		}
+	}
+	st, ok := raw[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown simple type %s", name)
+	}
+	var v *simpleType
+	if base := st.Restriction.Base; isBuiltin(base) {
+		v = &simpleType{builtin: local(base), minLength: -1, maxLength: -1, totalDigits: -1, fractionDigits: -1}
+	} else {
+		b, err := resolve(local(base), raw, append(seen, name))
+		if err != nil {
+			return nil, err
+		}
+		c := *b
+		c.patterns = append([]string(nil), b.patterns...)
+		c.enumeration = nil
+		v = &c
+	}
+	v.name = name
+	_, v.definition = st.Annotation.docs()
+	for _, f := range st.Restriction.Facets {
+		var err error
+		switch f.XMLName.Local {
+		case "length":
+			v.minLength, err = strconv.Atoi(f.Value)
+			v.maxLength = v.minLength
+		case "minLength":
+			v.minLength, err = strconv.Atoi(f.Value)
+		case "maxLength":
+			v.maxLength, err = strconv.Atoi(f.Value)
+		case "pattern":
+			v.patterns = append(v.patterns, f.Value)
+		case "enumeration":
+			n, d := f.Annotation.docs()
+			v.enumeration = append(v.enumeration, enumValue{code: f.Value, name: n, definition: d})
+		case "totalDigits":
+			v.totalDigits, err = strconv.Atoi(f.Value)
+		case "fractionDigits":
+			v.fractionDigits, err = strconv.Atoi(f.Value)
+		case "minInclusive":
+			v.minInclusive = f.Value
+		case "maxInclusive":
+			v.maxInclusive = f.Value
+		case "annotation":
+		default:
+			return nil, fmt.Errorf("simple type %s: unsupported facet %s", name, f.XMLName.Local)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("simple type %s: %s: %v", name, f.XMLName.Local, err)
+		}
+	}
+	return v, nil
+}
+
+func newComplexType(ct xsdComplexType) (*complexType, error) {
+	c := &complexType{name: ct.Name}
+	_, c.definition = ct.Annotation.docs()
+	switch {
+	case ct.SimpleContent != nil:
+		ext := ct.SimpleContent.Extension
+		c.value = local(ext.Base)
+		for _, a := range ext.Attributes {
+			c.attrs = append(c.attrs, attribute{name: a.Name, typ: local(a.Type), required: a.Use == "required"})
+		}
+	case ct.Choice != nil:
+		c.choice = true
+		if err := c.add(*ct.Choice, false, false); err != nil {
+			return nil, err
+		}
+	case ct.Sequence != nil:
+		if err := c.add(*ct.Sequence, false, false); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// add adds the elements of a sequence or a choice. The elements of a nested
+// choice are optional, and those of a repeated group repeated.
+func (c *complexType) add(group xsdParticle, optional, repeated bool) error {
+	min, max, err := occurs(group)
+	if err != nil {
+		return err
+	}
+	optional = optional || min == 0
+	repeated = repeated || max != 1
+	for _, p := range group.Items {
+		switch p.XMLName.Local {
+		case "element":
+			min, max, err := occurs(p)
+			if err != nil {
+				return err
+			}
+			if optional {
+				min = 0
+			}
+			if repeated {
+				max = -1
+			}
+			e := &element{tag: p.Name, typ: p.Type, min: min, max: max}
+			if !isBuiltin(e.typ) {
+				e.typ = local(e.typ)
+			}
+			e.name, e.definition = p.Annotation.docs()
+			c.elements = append(c.elements, e)
+		case "any":
+			ns := p.Namespace
+			c.any = &ns
+		case "sequence":
+			if err := c.add(p, optional, repeated); err != nil {
+				return err
+			}
+		case "choice":
+			if err := c.add(p, optional || len(c.elements) > 0 || !c.choice, repeated); err != nil {
+				return err
+			}
+		case "annotation":
+		default:
+			return fmt.Errorf("unsupported particle %s", p.XMLName.Local)
+		}
+	}
+	if c.any != nil && len(c.elements) > 0 {
+		return fmt.Errorf("wildcard with elements")
+	}
+	return nil
+}
+
+func occurs(p xsdParticle) (min, max int, err error) {
+	min, max = 1, 1
+	if p.MinOccurs != "" {
+		if min, err = strconv.Atoi(p.MinOccurs); err != nil {
+			return 0, 0, err
+		}
+	}
+	switch p.MaxOccurs {
+	case "":
+	case "unbounded":
+		max = -1
+	default:
+		if max, err = strconv.Atoi(p.MaxOccurs); err != nil {
+			return 0, 0, err
+		}
+	}
+	return min, max, nil
+}
+
+// isBuiltin reports whether a type reference names an XSD built-in type.
+func isBuiltin(name string) bool {
+	return strings.HasPrefix(name, "xs:") || strings.HasPrefix(name, "xsd:")
+}
+
+// local returns a type reference without its namespace prefix.
+func local(name string) string {
+	if i := strings.IndexByte(name, ':'); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+func sameSimple(a, b *simpleType) bool {
+	if a.builtin != b.builtin || a.minLength != b.minLength || a.maxLength != b.maxLength ||
+		a.totalDigits != b.totalDigits || a.fractionDigits != b.fractionDigits ||
+		a.minInclusive != b.minInclusive || a.maxInclusive != b.maxInclusive ||
+		len(a.patterns) != len(b.patterns) || len(a.enumeration) != len(b.enumeration) {
+		return false
+	}
+	for i := range a.patterns {
+		if a.patterns[i] != b.patterns[i] {
+			return false
+		}
+	}
+	for i := range a.enumeration {
+		if a.enumeration[i].code != b.enumeration[i].code {
+			return false
+		}
+	}
+	return true
+}
+
+func sameComplex(a, b *complexType) bool {
+	if a.choice != b.choice || a.value != b.value || len(a.elements) != len(b.elements) ||
+		len(a.attrs) != len(b.attrs) || (a.any == nil) != (b.any == nil) {
+		return false
+	}
+	for i := range a.elements {
+		x, y := a.elements[i], b.elements[i]
+		if x.tag != y.tag || x.typ != y.typ || x.min != y.min || x.max != y.max {
+			return false
+		}
+	}
+	for i := range a.attrs {
+		if a.attrs[i] != b.attrs[i] {
+			return false
+		}
+	}
+	return true
+}
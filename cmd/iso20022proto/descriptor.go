@@ -0,0 +1,213 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// goPackagePrefix is the import path of the directory of the Go packages of
+// the definitions, each named after the package of the definitions with the
+// suffix pb, for example pacspb.
+const goPackagePrefix = "github.com/yudaprama/iso20022/proto/" + protoPackage + "/"
+
+// goPackage returns the import path of the Go package of the definitions of
+// package name.
+func goPackage(name string) string {
+	return goPackagePrefix + name + "pb"
+}
+
+// descriptor returns the descriptor of the definitions of package p, the one
+// protoc builds from the file protoFile writes, with the documentation of the
+// messages, the enums and their values.
+func (s *source) descriptor(p *pkg) (*descriptorpb.FileDescriptorProto, error) {
+	fd := &descriptorpb.FileDescriptorProto{
+		Name:           proto.String(protoPath(p.name)),
+		Package:        proto.String(protoPackage + "." + p.name),
+		Syntax:         proto.String("proto3"),
+		Options:        &descriptorpb.FileOptions{GoPackage: proto.String(goPackage(p.name))},
+		SourceCodeInfo: &descriptorpb.SourceCodeInfo{},
+	}
+	imports := map[string]bool{}
+	for _, name := range p.names {
+		t := p.types[name]
+		switch {
+		case t.st != nil:
+			m, err := s.messageDescriptor(t, imports)
+			if err != nil {
+				return nil, err
+			}
+			document(fd, t.doc, 4, int32(len(fd.MessageType)))
+			fd.MessageType = append(fd.MessageType, m)
+		case t.isEnum():
+			i := int32(len(fd.EnumType))
+			document(fd, t.doc, 5, i)
+			fd.EnumType = append(fd.EnumType, enumDescriptor(t))
+			for j, v := range t.values {
+				document(fd, v.description, 5, i, 2, int32(j+1))
+			}
+		}
+	}
+	for name := range imports {
+		fd.Dependency = append(fd.Dependency, protoPath(name))
+	}
+	sort.Strings(fd.Dependency)
+	return fd, nil
+}
+
+// document adds the leading comments text to the element of fd at path.
+func document(fd *descriptorpb.FileDescriptorProto, text string, path ...int32) {
+	if text == "" {
+		return
+	}
+	var b strings.Builder
+	for _, line := range strings.Split(text, "\n") {
+		b.WriteString(strings.TrimRight(" "+line, " "))
+		b.WriteByte('\n')
+	}
+	fd.SourceCodeInfo.Location = append(fd.SourceCodeInfo.Location, &descriptorpb.SourceCodeInfo_Location{
+		Path:            path,
+		Span:            []int32{0, 0, 0},
+		LeadingComments: proto.String(b.String()),
+	})
+}
+
+// messageDescriptor returns the descriptor of the message of the struct type
+// t, as message writes it.
+func (s *source) messageDescriptor(t *typeDecl, imports map[string]bool) (*descriptorpb.DescriptorProto, error) {
+	fields, err := s.fields(t)
+	if err != nil {
+		return nil, err
+	}
+	choice := strings.HasSuffix(t.name, "Choice")
+	m := &descriptorpb.DescriptorProto{Name: proto.String(t.name)}
+	if choice {
+		m.OneofDecl = append(m.OneofDecl, &descriptorpb.OneofDescriptorProto{Name: proto.String("choice")})
+	}
+	var optional []*descriptorpb.FieldDescriptorProto
+	for i, f := range fields {
+		fd := fieldDescriptor(t, f.typ, f.name, int32(i+1), imports)
+		switch {
+		case choice && f.repeated:
+			list := &descriptorpb.DescriptorProto{Name: proto.String(f.goName + "List")}
+			value := fieldDescriptor(t, f.typ, "value", 1, imports)
+			value.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+			list.Field = append(list.Field, value)
+			m.NestedType = append(m.NestedType, list)
+			fd.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+			fd.TypeName = proto.String("." + protoPackage + "." + t.pkg.name + "." + t.name + "." + f.goName + "List")
+			fd.OneofIndex = proto.Int32(0)
+		case choice:
+			fd.OneofIndex = proto.Int32(0)
+		case f.repeated:
+			fd.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		case f.pointer && (f.typ == nil || f.typ.st == nil):
+			fd.Proto3Optional = proto.Bool(true)
+			optional = append(optional, fd)
+		}
+		m.Field = append(m.Field, fd)
+	}
+	// The optional fields of proto3 are members of synthetic oneofs, which
+	// follow the other oneofs.
+	for _, fd := range optional {
+		fd.OneofIndex = proto.Int32(int32(len(m.OneofDecl)))
+		m.OneofDecl = append(m.OneofDecl, &descriptorpb.OneofDescriptorProto{Name: proto.String("_" + fd.GetName())})
+	}
+	return m, nil
+}
+
+// fieldDescriptor returns the descriptor of a field of type t, nil for a
+// string, of a message of type owner.
+func fieldDescriptor(owner, t *typeDecl, name string, number int32, imports map[string]bool) *descriptorpb.FieldDescriptorProto {
+	fd := &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		Number:   proto.Int32(number),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+		JsonName: proto.String(jsonName(name)),
+	}
+	if t == nil {
+		return fd
+	}
+	if t.pkg != owner.pkg {
+		imports[t.pkg.name] = true
+	}
+	fd.TypeName = proto.String("." + protoPackage + "." + t.pkg.name + "." + t.name)
+	if t.st != nil {
+		fd.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+	} else {
+		fd.Type = descriptorpb.FieldDescriptorProto_TYPE_ENUM.Enum()
+	}
+	return fd
+}
+
+// enumDescriptor returns the descriptor of the enum of the Code type t, as
+// enum writes it.
+func enumDescriptor(t *typeDecl) *descriptorpb.EnumDescriptorProto {
+	prefix := strings.ToUpper(snake(t.name)) + "_"
+	e := &descriptorpb.EnumDescriptorProto{Name: proto.String(t.name)}
+	e.Value = append(e.Value, &descriptorpb.EnumValueDescriptorProto{Name: proto.String(prefix + "UNSPECIFIED"), Number: proto.Int32(0)})
+	for i, v := range t.values {
+		e.Value = append(e.Value, &descriptorpb.EnumValueDescriptorProto{Name: proto.String(prefix + enumName(v.value)), Number: proto.Int32(int32(i + 1))})
+	}
+	return e
+}
+
+// jsonName returns the lower camel case of a field name, as protoc sets it.
+func jsonName(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		switch {
+		case r == '_':
+			upper = true
+		case upper && 'a' <= r && r <= 'z':
+			b.WriteRune(r - 'a' + 'A')
+			upper = false
+		default:
+			b.WriteRune(r)
+			upper = false
+		}
+	}
+	return b.String()
+}
+
+// goFiles returns the Go sources that protoc-gen-go generates from the
+// descriptors of the packages, by path relative to the directory of the Go
+// packages.
+func goFiles(files []*descriptorpb.FileDescriptorProto) (map[string][]byte, error) {
+	req := &pluginpb.CodeGeneratorRequest{ProtoFile: files}
+	for _, fd := range files {
+		req.FileToGenerate = append(req.FileToGenerate, fd.GetName())
+	}
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		return nil, err
+	}
+	internal_gengo.GenerateVersionMarkers = false
+	for _, f := range gen.Files {
+		if f.Generate {
+			internal_gengo.GenerateFile(gen, f)
+		}
+	}
+	resp := gen.Response()
+	if resp.Error != nil {
+		return nil, fmt.Errorf("%s", resp.GetError())
+	}
+	out := map[string][]byte{}
+	for _, f := range resp.File {
+		name := strings.TrimPrefix(f.GetName(), goPackagePrefix)
+		if name == f.GetName() || path.IsAbs(name) {
+			return nil, fmt.Errorf("unexpected file %s", f.GetName())
+		}
+		out[name] = []byte(f.GetContent())
+	}
+	return out, nil
+}
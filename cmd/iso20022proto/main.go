@@ -0,0 +1,135 @@
+// Command iso20022proto generates the Protocol Buffers definitions of the types
+// of the model package and of the business area packages, and the table of the
+// enums of the isoproto package, which converts between the Go types and their
+// Protocol Buffers encoding.
+//
+// Each struct type is a message whose fields are numbered in the order of the
+// fields of the struct, each choice a message with a oneof, and each Code type
+// with constants an enum. The other simple types are strings. The definitions
+// of a package are written to <out>/iso20022/<package>.proto, in the proto
+// package iso20022.<package>, and the Go types that protoc-gen-go generates
+// from them to <out>/iso20022/<package>pb, the Go package
+// github.com/yudaprama/iso20022/proto/iso20022/<package>pb. The isoproto
+// package converts between them and the Go types of the module.
+//
+// Usage:
+//
+//	iso20022proto [-src dir] [-out dir] [-go file]
+//
+// The output is deterministic, and committed to the repository: run
+// iso20022proto from the root of the module after changing the Go types.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+
+	"github.com/yudaprama/iso20022/message"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func main() {
+	src := flag.String("src", ".", "root directory of the iso20022 module")
+	out := flag.String("out", "proto", "output directory of the definitions")
+	goFile := flag.String("go", filepath.Join("isoproto", "Enums.go"), "output file of the table of the enums")
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("iso20022proto: ")
+
+	names := map[string]bool{"model": true}
+	for _, namespace := range message.Namespaces() {
+		m, err := message.New(namespace)
+		if err != nil {
+			log.Fatal(err)
+		}
+		names[filepath.Base(reflect.TypeOf(m).Elem().PkgPath())] = true
+	}
+	var sorted []string
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	s := newSource(*src)
+	dir := filepath.Join(*out, protoPackage)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		log.Fatal(err)
+	}
+	var pkgs []*pkg
+	var files []*descriptorpb.FileDescriptorProto
+	for _, name := range sorted {
+		p, err := s.pkg(name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		data, err := s.protoFile(p)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name+".proto"), data, 0644); err != nil {
+			log.Fatal(err)
+		}
+		fd, err := s.descriptor(p)
+		if err != nil {
+			log.Fatal(err)
+		}
+		pkgs, files = append(pkgs, p), append(files, fd)
+	}
+	for name := range s.pkgs {
+		if !names[name] {
+			log.Fatalf("package %s is referred to but has no definitions", name)
+		}
+	}
+	data, err := s.goFile(pkgs)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*goFile, data, 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	sources, err := goFiles(dependencyOrder(files))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, data := range sources {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// dependencyOrder returns the descriptors of files with each one after the
+// files it imports.
+func dependencyOrder(files []*descriptorpb.FileDescriptorProto) []*descriptorpb.FileDescriptorProto {
+	done := map[string]bool{}
+	var ordered []*descriptorpb.FileDescriptorProto
+	for len(ordered) < len(files) {
+		n := len(ordered)
+		for _, fd := range files {
+			if done[fd.GetName()] {
+				continue
+			}
+			ready := true
+			for _, dep := range fd.Dependency {
+				ready = ready && done[dep]
+			}
+			if ready {
+				done[fd.GetName()] = true
+				ordered = append(ordered, fd)
+			}
+		}
+		if len(ordered) == n {
+			log.Fatal("cyclic imports between the definitions")
+		}
+	}
+	return ordered
+}
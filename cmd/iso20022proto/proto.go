@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// protoPackage is the prefix of the packages of the definitions, followed by
+// the name of the Go package.
+const protoPackage = "iso20022"
+
+const header = "// Code generated by iso20022proto. DO NOT EDIT.\n\n"
+
+// protoFile returns the definitions of the messages and enums of package p.
+func (s *source) protoFile(p *pkg) ([]byte, error) {
+	var body bytes.Buffer
+	imports := map[string]bool{}
+	for _, name := range p.names {
+		t := p.types[name]
+		switch {
+		case t.st != nil:
+			if err := s.message(&body, t, imports); err != nil {
+				return nil, err
+			}
+		case t.isEnum():
+			enum(&body, t)
+		}
+	}
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("syntax = \"proto3\";\n\n")
+	fmt.Fprintf(&b, "package %s.%s;\n\n", protoPackage, p.name)
+	fmt.Fprintf(&b, "option go_package = %q;\n", goPackage(p.name))
+	if len(imports) > 0 {
+		b.WriteByte('\n')
+		var names []string
+		for name := range imports {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(&b, "import %q;\n", protoPath(name))
+		}
+	}
+	b.Write(body.Bytes())
+	return b.Bytes(), nil
+}
+
+// protoPath returns the path of the definitions of package name, relative to
+// the root of the definitions.
+func protoPath(name string) string {
+	return protoPackage + "/" + name + ".proto"
+}
+
+// message writes the message of the struct type t. The fields of a choice are
+// the members of a oneof, and its repeated fields are wrapped in messages.
+func (s *source) message(b *bytes.Buffer, t *typeDecl, imports map[string]bool) error {
+	fields, err := s.fields(t)
+	if err != nil {
+		return err
+	}
+	choice := strings.HasSuffix(t.name, "Choice")
+	b.WriteByte('\n')
+	comment(b, "", t.doc)
+	fmt.Fprintf(b, "message %s {\n", t.name)
+	indent := "  "
+	if choice {
+		for _, f := range fields {
+			if f.repeated {
+				fmt.Fprintf(b, "  message %sList {\n", f.goName)
+				fmt.Fprintf(b, "    repeated %s value = 1;\n", typeName(t, f.typ, imports))
+				b.WriteString("  }\n")
+			}
+		}
+		b.WriteString("  oneof choice {\n")
+		indent = "    "
+	}
+	for i, f := range fields {
+		typ := typeName(t, f.typ, imports)
+		switch {
+		case choice && f.repeated:
+			typ = f.goName + "List"
+		case f.repeated:
+			typ = "repeated " + typ
+		case !choice && f.pointer && (f.typ == nil || f.typ.st == nil):
+			typ = "optional " + typ
+		}
+		fmt.Fprintf(b, "%s%s %s = %d;\n", indent, typ, f.name, i+1)
+	}
+	if choice {
+		b.WriteString("  }\n")
+	}
+	b.WriteString("}\n")
+	return nil
+}
+
+// typeName returns the name of the type of a field of a message of type owner.
+func typeName(owner, t *typeDecl, imports map[string]bool) string {
+	if t == nil {
+		return "string"
+	}
+	if t.pkg == owner.pkg {
+		return t.name
+	}
+	imports[t.pkg.name] = true
+	return "." + protoPackage + "." + t.pkg.name + "." + t.name
+}
+
+// enum writes the enum of the Code type t. The values are numbered from 1 in
+// the order of their constants, 0 being unspecified.
+func enum(b *bytes.Buffer, t *typeDecl) {
+	prefix := strings.ToUpper(snake(t.name)) + "_"
+	b.WriteByte('\n')
+	comment(b, "", t.doc)
+	fmt.Fprintf(b, "enum %s {\n", t.name)
+	fmt.Fprintf(b, "  %sUNSPECIFIED = 0;\n", prefix)
+	for i, v := range t.values {
+		comment(b, "  ", v.description)
+		fmt.Fprintf(b, "  %s%s = %d;\n", prefix, enumName(v.value), i+1)
+	}
+	b.WriteString("}\n")
+}
+
+func comment(b *bytes.Buffer, indent, text string) {
+	if text == "" {
+		return
+	}
+	for _, line := range strings.Split(text, "\n") {
+		b.WriteString(indent)
+		b.WriteString(strings.TrimRight("// "+line, " "))
+		b.WriteByte('\n')
+	}
+}
+
+// goFile returns the Go source of the table of the values of the enums, used
+// by the isoproto package to number them.
+func (s *source) goFile(pkgs []*pkg) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package isoproto\n\n")
+	b.WriteString("import (\n\t\"reflect\"\n\n")
+	var entries bytes.Buffer
+	for _, p := range pkgs {
+		n := 0
+		for _, name := range p.names {
+			t := p.types[name]
+			if !t.isEnum() {
+				continue
+			}
+			n++
+			fmt.Fprintf(&entries, "reflect.TypeOf(%s.%s(\"\")): {", p.name, t.name)
+			for i, v := range t.values {
+				if i > 0 {
+					entries.WriteString(", ")
+				}
+				entries.WriteString(strconv.Quote(v.value))
+			}
+			entries.WriteString("},\n")
+		}
+		if n > 0 {
+			fmt.Fprintf(&b, "\t\"github.com/yudaprama/iso20022/%s\"\n", p.name)
+		}
+	}
+	b.WriteString(")\n\n")
+	b.WriteString("// enums holds the values of the Code types with constants, in the order of\n")
+	b.WriteString("// their numbers in the enums of the definitions, starting at 1.\n")
+	b.WriteString("var enums = map[reflect.Type][]string{\n")
+	b.Write(entries.Bytes())
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
+
+// snake returns the lower snake case of a Go name, for example group_header for
+// GroupHeader and bicfi for BICFI.
+func snake(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// enumName returns the name of the value of an enum, in upper case with the
+// characters other than letters and digits replaced by underscores.
+func enumName(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, value)
+}
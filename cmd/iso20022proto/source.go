@@ -0,0 +1,281 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// pkg is a package of the module: its struct types, and its simple types with
+// the values of their enumerations.
+type pkg struct {
+	name  string
+	types map[string]*typeDecl
+	names []string
+}
+
+// typeDecl is the declaration of a type of a package.
+type typeDecl struct {
+	pkg    *pkg
+	name   string
+	doc    string
+	st     *ast.StructType
+	values []value
+}
+
+// isEnum reports whether t is a Code type with constants, whose values are
+// those of its enum, whether or not its Validate method checks them.
+func (t *typeDecl) isEnum() bool {
+	return t.st == nil && strings.HasSuffix(t.name, "Code") && len(t.values) > 0
+}
+
+// value is a value of a Code type and its description.
+type value struct {
+	name        string
+	value       string
+	description string
+}
+
+// source loads the packages of a module on demand.
+type source struct {
+	dir  string
+	pkgs map[string]*pkg
+}
+
+func newSource(dir string) *source {
+	return &source{dir: dir, pkgs: map[string]*pkg{}}
+}
+
+// pkg returns the package in the directory name of the module.
+func (s *source) pkg(name string) (*pkg, error) {
+	if p, ok := s.pkgs[name]; ok {
+		return p, nil
+	}
+	files, err := filepath.Glob(filepath.Join(s.dir, name, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	p := &pkg{name: name, types: map[string]*typeDecl{}}
+	fset := token.NewFileSet()
+	consts := map[string][]value{}
+	descriptions := map[string]string{}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			if d, ok := decl.(*ast.GenDecl); ok {
+				p.genDecl(d, consts, descriptions)
+			}
+		}
+	}
+	for name, t := range p.types {
+		for _, v := range consts[name] {
+			v.description = descriptions[v.name]
+			t.values = append(t.values, v)
+		}
+		p.names = append(p.names, name)
+	}
+	sort.Strings(p.names)
+	s.pkgs[name] = p
+	return p, nil
+}
+
+func (p *pkg) decl(name string) *typeDecl {
+	t, ok := p.types[name]
+	if !ok {
+		t = &typeDecl{pkg: p, name: name}
+		p.types[name] = t
+	}
+	return t
+}
+
+func (p *pkg) genDecl(d *ast.GenDecl, consts map[string][]value, descriptions map[string]string) {
+	for _, spec := range d.Specs {
+		switch s := spec.(type) {
+		case *ast.TypeSpec:
+			switch typ := s.Type.(type) {
+			case *ast.StructType:
+				if !isElement(typ) {
+					continue
+				}
+				t := p.decl(s.Name.Name)
+				t.st = typ
+				t.doc = docOf(s.Doc, d)
+			case *ast.Ident:
+				if typ.Name == "string" {
+					p.decl(s.Name.Name).doc = docOf(s.Doc, d)
+				}
+			}
+		case *ast.ValueSpec:
+			typ, ok := s.Type.(*ast.Ident)
+			if d.Tok == token.CONST && ok {
+				for i, name := range s.Names {
+					if i >= len(s.Values) {
+						continue
+					}
+					lit, ok := s.Values[i].(*ast.BasicLit)
+					if !ok || lit.Kind != token.STRING {
+						continue
+					}
+					v, _ := strconv.Unquote(lit.Value)
+					consts[typ.Name] = append(consts[typ.Name], value{name: name.Name, value: v})
+				}
+			}
+			// The descriptions of the values of a Code type are held in a map
+			// from the constants to the descriptions.
+			if len(s.Values) != 1 {
+				continue
+			}
+			lit, ok := s.Values[0].(*ast.CompositeLit)
+			if !ok {
+				continue
+			}
+			if _, ok := lit.Type.(*ast.MapType); !ok {
+				continue
+			}
+			for _, elt := range lit.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				key, ok := kv.Key.(*ast.Ident)
+				v, ok2 := kv.Value.(*ast.BasicLit)
+				if ok && ok2 && v.Kind == token.STRING {
+					descriptions[key.Name], _ = strconv.Unquote(v.Value)
+				}
+			}
+		}
+	}
+}
+
+// isElement reports whether a struct type is the type of an element, with
+// fields of XML elements, attributes or text, rather than a Go type such as
+// an error.
+func isElement(st *ast.StructType) bool {
+	for _, f := range st.Fields.List {
+		if len(f.Names) == 0 || f.Tag != nil && strings.Contains(f.Tag.Value, `xml:"`) {
+			return true
+		}
+	}
+	return false
+}
+
+func docOf(doc *ast.CommentGroup, d *ast.GenDecl) string {
+	if doc == nil && len(d.Specs) == 1 {
+		doc = d.Doc
+	}
+	if doc == nil {
+		return ""
+	}
+	return strings.TrimSpace(doc.Text())
+}
+
+// field is a field of the message of a struct type.
+type field struct {
+	name     string
+	goName   string
+	pointer  bool
+	repeated bool
+	typ      *typeDecl // nil for a string
+}
+
+// fields returns the fields of the message of the struct type t, in the order
+// of their numbers: its fields of XML elements, attributes and text, and
+// those of the structs it embeds.
+func (s *source) fields(t *typeDecl) ([]field, error) {
+	var fields []field
+	for _, f := range t.st.Fields.List {
+		var tag string
+		if f.Tag != nil {
+			raw, err := strconv.Unquote(f.Tag.Value)
+			if err != nil {
+				return nil, err
+			}
+			tag = reflect.StructTag(raw).Get("xml")
+		}
+		expr := f.Type
+		var repeated, pointer bool
+		if a, ok := expr.(*ast.ArrayType); ok {
+			repeated, expr = true, a.Elt
+		}
+		if star, ok := expr.(*ast.StarExpr); ok {
+			pointer, expr = true, star.X
+		}
+		typ, err := s.resolve(t.pkg, expr)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %v", t.pkg.name, t.name, err)
+		}
+		// The fields of an embedded struct, or of a struct field tagged inline,
+		// take its place.
+		if len(f.Names) == 0 || tag == ",inline" {
+			if tag != "" && tag != ",inline" || typ == nil || typ.st == nil {
+				return nil, fmt.Errorf("%s.%s: unsupported embedded field", t.pkg.name, t.name)
+			}
+			embedded, err := s.fields(typ)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, embedded...)
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "-" || name == "" && !strings.Contains(options, "innerxml") && !strings.Contains(options, "chardata") && !strings.Contains(options, "attr") {
+			continue
+		}
+		for _, n := range f.Names {
+			if !n.IsExported() || n.Name == "XMLName" {
+				continue
+			}
+			fields = append(fields, field{name: snake(n.Name), goName: n.Name, pointer: pointer, repeated: repeated, typ: typ})
+		}
+	}
+	return fields, nil
+}
+
+// resolve returns the declaration of the type of expr in package p, or nil for
+// a string or a simple type without constants.
+func (s *source) resolve(p *pkg, expr ast.Expr) (*typeDecl, error) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if e.Name == "string" {
+			return nil, nil
+		}
+		return lookup(p, e.Name)
+	case *ast.SelectorExpr:
+		x, ok := e.X.(*ast.Ident)
+		if !ok {
+			break
+		}
+		if x.Name == "xml" && e.Sel.Name == "Name" {
+			return nil, nil
+		}
+		other, err := s.pkg(x.Name)
+		if err != nil {
+			return nil, err
+		}
+		return lookup(other, e.Sel.Name)
+	}
+	return nil, fmt.Errorf("unsupported type %T", expr)
+}
+
+func lookup(p *pkg, name string) (*typeDecl, error) {
+	t, ok := p.types[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %s.%s", p.name, name)
+	}
+	if t.st == nil && !t.isEnum() {
+		return nil, nil
+	}
+	return t, nil
+}
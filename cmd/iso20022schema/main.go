@@ -0,0 +1,147 @@
+// Command iso20022schema generates the JSON Schemas (draft 2020-12) of the JSON
+// encoding of the isojson package for the registered message definitions, and
+// an OpenAPI 3.1 document whose components hold the schemas of all of them.
+//
+// The schemas are built from the Go types of the message packages and of the
+// model package. Their source provides the documentation comments and the
+// facets of the simple types: length, pattern and digits facets, and the
+// values of the Code types with their descriptions. Facets without a JSON
+// Schema keyword are given as x- annotations, for example x-totalDigits. A
+// Code type without constants has no enum, since its values are not known
+// until it is generated from its XSD by iso20022gen.
+//
+// Usage:
+//
+//	iso20022schema [-src dir] [-out dir] [-openapi file] [identifier ...]
+//
+// The identifiers select the message definitions, by full identifier such as
+// pacs.008.001.06 or by prefix such as pacs.008; all registered message
+// definitions are generated by default. Each schema is written to
+// <out>/<identifier>.schema.json.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yudaprama/iso20022/message"
+)
+
+func main() {
+	src := flag.String("src", ".", "root directory of the iso20022 module")
+	out := flag.String("out", "schema", "output directory")
+	openapi := flag.String("openapi", "openapi.json", "name of the OpenAPI document in the output directory, or empty for none")
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("iso20022schema: ")
+
+	var messages []message.Message
+	for _, namespace := range message.Namespaces() {
+		m, err := message.New(namespace)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if selected(m.MessageDefinitionIdentifier(), flag.Args()) {
+			messages = append(messages, m)
+		}
+	}
+	if len(messages) == 0 {
+		log.Fatal("no message definition selected")
+	}
+	if err := os.MkdirAll(*out, 0755); err != nil {
+		log.Fatal(err)
+	}
+
+	s := newSource(*src)
+	components := newGenerator(s, "#/components/schemas/")
+	var schemas object
+	for _, m := range messages {
+		doc, err := messageSchema(s, m)
+		if err != nil {
+			log.Fatalf("%s: %v", m.MessageDefinitionIdentifier(), err)
+		}
+		if err := write(filepath.Join(*out, m.MessageDefinitionIdentifier()+".schema.json"), doc); err != nil {
+			log.Fatal(err)
+		}
+		if *openapi != "" {
+			schema, err := components.message(m)
+			if err != nil {
+				log.Fatalf("%s: %v", m.MessageDefinitionIdentifier(), err)
+			}
+			schemas.set(m.MessageDefinitionIdentifier(), schema)
+		}
+	}
+	if *openapi == "" {
+		return
+	}
+	schemas = append(schemas, components.definitions()...)
+	doc := object{
+		{"openapi", "3.1.0"},
+		{"info", object{
+			{"title", "ISO 20022 messages"},
+			{"description", "Schemas of the JSON encoding of ISO 20022 messages, following the naming conventions of the ISO 20022 JSON schemas."},
+			{"version", "1.0.0"},
+		}},
+		{"jsonSchemaDialect", "https://json-schema.org/draft/2020-12/schema"},
+		{"components", object{{"schemas", schemas}}},
+	}
+	if err := write(filepath.Join(*out, *openapi), doc); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// messageSchema returns the schema of the message definition of m, with the
+// definitions of its types.
+func messageSchema(s *source, m message.Message) (object, error) {
+	g := newGenerator(s, "#/$defs/")
+	schema, err := g.message(m)
+	if err != nil {
+		return nil, err
+	}
+	doc := object{{"$schema", "https://json-schema.org/draft/2020-12/schema"}}
+	doc = append(doc, schema...)
+	doc.set("$defs", g.definitions())
+	return doc, nil
+}
+
+// selected reports whether the message definition id is selected by the
+// identifiers or prefixes of the command line.
+func selected(id string, args []string) bool {
+	if len(args) == 0 {
+		return true
+	}
+	for _, arg := range args {
+		if id == arg || strings.HasPrefix(id, arg+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func write(path string, v interface{}) error {
+	data, err := indent(v)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// indent returns the indented JSON of v, ending with a newline.
+func indent(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	if err := json.Indent(&b, data, "", "  "); err != nil {
+		return nil, err
+	}
+	b.WriteByte('\n')
+	return b.Bytes(), nil
+}
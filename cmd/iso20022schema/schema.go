@@ -0,0 +1,380 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yudaprama/iso20022/message"
+)
+
+// decimalPattern is the lexical space of xs:decimal.
+const decimalPattern = `^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`
+
+// object is a JSON object whose members keep their order, so that the
+// properties of a schema follow the sequence of the elements and the output
+// is deterministic.
+type object []member
+
+type member struct {
+	key   string
+	value interface{}
+}
+
+func (o *object) set(key string, value interface{}) {
+	*o = append(*o, member{key, value})
+}
+
+func (o object) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, m := range o {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if err := encodeJSON(&b, m.key); err != nil {
+			return nil, err
+		}
+		b.WriteByte(':')
+		if err := encodeJSON(&b, m.value); err != nil {
+			return nil, err
+		}
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// encodeJSON writes v without escaping the characters of HTML, which are
+// frequent in patterns and documentation.
+func encodeJSON(b *bytes.Buffer, v interface{}) error {
+	e := json.NewEncoder(b)
+	e.SetEscapeHTML(false)
+	if err := e.Encode(v); err != nil {
+		return err
+	}
+	b.Truncate(b.Len() - 1)
+	return nil
+}
+
+// generator builds the schemas of the types of message definitions. The
+// schemas of the types are definitions referred to with the ref prefix.
+type generator struct {
+	src   *source
+	ref   string
+	names map[reflect.Type]string
+	types map[string]reflect.Type
+	order []string
+	defs  map[string]object
+}
+
+func newGenerator(src *source, ref string) *generator {
+	return &generator{
+		src:   src,
+		ref:   ref,
+		names: map[reflect.Type]string{},
+		types: map[string]reflect.Type{},
+		defs:  map[string]object{},
+	}
+}
+
+// definitions returns the definitions in the order of their first use.
+func (g *generator) definitions() object {
+	var o object
+	for _, name := range g.order {
+		o.set(name, g.defs[name])
+	}
+	return o
+}
+
+// message returns the schema of the JSON encoding of the Document m.
+func (g *generator) message(m message.Message) (object, error) {
+	t := reflect.TypeOf(m).Elem()
+	s, err := g.structSchema(t)
+	if err != nil {
+		return nil, err
+	}
+	var o object
+	o.set("title", m.MessageDefinitionIdentifier())
+	// The documentation of the message definition is that of the body of the
+	// Document, or of the header of an AppHdr.
+	for i := 0; i < t.NumField(); i++ {
+		body := t.Field(i).Type
+		if t.Field(i).Name != "Message" {
+			continue
+		}
+		for body.Kind() == reflect.Ptr {
+			body = body.Elem()
+		}
+		if p, err := g.src.pkg(pkgName(body)); err == nil {
+			if doc := p.docs[body.Name()]; doc != "" {
+				o.set("description", doc)
+			}
+		}
+		break
+	}
+	o.set("x-namespace", m.Namespace())
+	for _, m := range s {
+		if m.key != "description" {
+			o = append(o, m)
+		}
+	}
+	return o, nil
+}
+
+// refTo returns a reference to the definition of the type of the elements of
+// type t, adding it when it is first used.
+func (g *generator) refTo(t reflect.Type) (object, error) {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.PkgPath() == "" {
+		return object{{"type", "string"}}, nil
+	}
+	name, ok := g.names[t]
+	if !ok {
+		name = t.Name()
+		if other, taken := g.types[name]; taken && other != t {
+			name = pkgName(t) + "." + name
+		}
+		g.names[t], g.types[name] = name, t
+		g.order = append(g.order, name)
+		var s object
+		var err error
+		switch t.Kind() {
+		case reflect.String:
+			s, err = g.simple(t)
+		case reflect.Struct:
+			s, err = g.structSchema(t)
+		default:
+			err = fmt.Errorf("unsupported type %v", t)
+		}
+		if err != nil {
+			return nil, err
+		}
+		g.defs[name] = s
+	}
+	return object{{"$ref", g.ref + name}}, nil
+}
+
+// simple returns the schema of a simple type, with the facets of its Validate
+// method and the values of its constants.
+func (g *generator) simple(t reflect.Type) (object, error) {
+	p, err := g.src.pkg(pkgName(t))
+	if err != nil {
+		return nil, err
+	}
+	var s object
+	if doc := p.docs[t.Name()]; doc != "" {
+		s.set("description", doc)
+	}
+	s.set("type", "string")
+	for _, f := range p.facets[t.Name()] {
+		switch f.name {
+		case "validateLength":
+			if min, _ := strconv.Atoi(f.arg(2)); min > 0 {
+				s.set("minLength", min)
+			}
+			if max, err := strconv.Atoi(f.arg(3)); err == nil && max >= 0 {
+				s.set("maxLength", max)
+			}
+		case "validatePattern":
+			s.set("pattern", "^(?:"+f.arg(2)+")$")
+		case "validateExternalCode":
+			s.set("x-externalCodeSet", t.Name())
+		case "validateBoolean":
+			s.set("enum", []string{"true", "false", "1", "0"})
+		case "validateDecimal":
+			s.set("pattern", decimalPattern)
+			digits(&s, f.arg(2), f.arg(3))
+		case "validateInclusive":
+			s.set("x-"+f.arg(2), f.arg(3))
+		case "validateBinary":
+			s.set("contentEncoding", "base64")
+			if min, _ := strconv.Atoi(f.arg(2)); min > 0 {
+				s.set("x-minOctets", min)
+			}
+			if max, err := strconv.Atoi(f.arg(3)); err == nil && max >= 0 {
+				s.set("x-maxOctets", max)
+			}
+		case "validateBuiltin":
+			if pattern := p.arg(ast.NewIdent(f.arg(2) + "Pattern")); pattern != f.arg(2)+"Pattern" {
+				s.set("pattern", "^(?:"+pattern+")$")
+			}
+			s.set("x-xsdType", f.arg(2))
+		}
+	}
+	// The values of a Code type are its constants, whether or not its Validate
+	// method checks them.
+	if codes := p.codes[t.Name()]; len(codes) > 0 {
+		var values []string
+		var descriptions object
+		for _, c := range codes {
+			values = append(values, c.value)
+			if c.description != "" {
+				descriptions.set(c.value, c.description)
+			}
+		}
+		s.set("enum", values)
+		if len(descriptions) > 0 {
+			s.set("x-enumDescriptions", descriptions)
+		}
+	}
+	return s, nil
+}
+
+func digits(s *object, total, fraction string) {
+	if n, err := strconv.Atoi(total); err == nil {
+		s.set("x-totalDigits", n)
+	}
+	if n, err := strconv.Atoi(fraction); err == nil {
+		s.set("x-fractionDigits", n)
+	}
+}
+
+// structSchema returns the schema of the JSON object of a struct type, with
+// the members named as the isojson package names them.
+func (g *generator) structSchema(t reflect.Type) (object, error) {
+	p, err := g.src.pkg(pkgName(t))
+	if err != nil {
+		return nil, err
+	}
+	var s object
+	if doc := p.docs[t.Name()]; doc != "" {
+		s.set("description", doc)
+	}
+	fields := fieldsOf(t)
+	if len(fields) == 1 && fields[0].any {
+		s.set("type", "string")
+		s.set("contentMediaType", "application/xml")
+		return s, nil
+	}
+	var amount *facet
+	for _, f := range p.facets[t.Name()] {
+		if f.name == "validateAmount" {
+			f := f
+			amount = &f
+		}
+	}
+	choice := strings.HasSuffix(t.Name(), "Choice")
+	var properties object
+	var required []string
+	for _, f := range fields {
+		var prop object
+		switch {
+		case f.text:
+			prop = object{{"type", "string"}, {"pattern", decimalPattern}}
+			if amount != nil {
+				digits(&prop, amount.arg(3), amount.arg(4))
+				prop.set("x-minInclusive", "0")
+			}
+		case f.attr && amount != nil && f.name == "Ccy":
+			prop = object{{"type", "string"}, {"pattern", "^[A-Z]{3}$"}}
+		default:
+			ref, err := g.refTo(f.typ)
+			if err != nil {
+				return nil, err
+			}
+			prop = ref
+		}
+		if f.typ.Kind() == reflect.Slice {
+			prop = object{{"type", "array"}, {"items", prop}}
+			if !f.optional && !choice {
+				prop.set("minItems", 1)
+			}
+			if f.maxOccurs > 0 {
+				prop.set("maxItems", f.maxOccurs)
+			}
+		}
+		owner, err := g.src.pkg(pkgName(f.owner))
+		if err != nil {
+			return nil, err
+		}
+		if doc := owner.fieldDocs[f.owner.Name()+"."+f.field]; doc != "" {
+			prop = append(object{{"description", doc}}, prop...)
+		}
+		properties.set(f.name, prop)
+		if !f.optional && !choice {
+			required = append(required, f.name)
+		}
+	}
+	s.set("type", "object")
+	s.set("properties", properties)
+	if len(required) > 0 {
+		s.set("required", required)
+	}
+	if choice {
+		s.set("minProperties", 1)
+		s.set("maxProperties", 1)
+	}
+	s.set("additionalProperties", false)
+	return s, nil
+}
+
+// field is a member of the JSON object of a struct type.
+type field struct {
+	name      string
+	field     string
+	owner     reflect.Type
+	typ       reflect.Type
+	attr      bool
+	text      bool
+	any       bool
+	optional  bool
+	maxOccurs int
+}
+
+// fieldsOf returns the members of the JSON object of the struct type t: its
+// attributes, then its elements and those of the structs it embeds.
+func fieldsOf(t reflect.Type) []field {
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.Name == "XMLName" || sf.PkgPath != "" && !sf.Anonymous {
+			continue
+		}
+		tag := sf.Tag.Get("xml")
+		if (sf.Anonymous && tag == "" || tag == ",inline") && sf.Type.Kind() == reflect.Struct {
+			fields = append(fields, fieldsOf(sf.Type)...)
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		f := field{name: name, field: sf.Name, owner: t, typ: sf.Type, optional: strings.Contains(options, "omitempty")}
+		if s, ok := sf.Tag.Lookup("xsd"); ok && strings.HasPrefix(s, "maxOccurs=") {
+			f.maxOccurs, _ = strconv.Atoi(strings.TrimPrefix(s, "maxOccurs="))
+		}
+		switch {
+		case name == "-":
+			continue
+		case strings.Contains(options, "innerxml"):
+			f.any = true
+		case strings.Contains(options, "chardata"):
+			f.text, f.name = true, "amount"
+		case strings.Contains(options, "attr"):
+			f.attr = true
+		case name == "":
+			continue
+		}
+		fields = append(fields, f)
+	}
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].attr && !fields[j].attr
+	})
+	return fields
+}
+
+func (f facet) arg(i int) string {
+	if i < len(f.args) {
+		return f.args[i]
+	}
+	return ""
+}
+
+func pkgName(t reflect.Type) string {
+	return filepath.Base(t.PkgPath())
+}
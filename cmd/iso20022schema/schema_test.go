@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yudaprama/iso20022/message"
+)
+
+var update = flag.Bool("update", false, "update the schemas of testdata")
+
+// TestMessageSchema compares the schema of pacs.008.001.06 with the one of
+// testdata, which -update rewrites.
+func TestMessageSchema(t *testing.T) {
+	m, err := message.NewFromIdentifier("pacs.008.001.06")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := messageSchema(newSource(filepath.Join("..", "..")), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := indent(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join("testdata", "pacs.008.001.06.schema.json")
+	if *update {
+		if err := os.WriteFile(file, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("schema of pacs.008.001.06 differs from %s, run go test -update", file)
+	}
+
+	// Every Code type has the values of its constants.
+	var schema struct {
+		Defs map[string]struct {
+			Enum []string `json:"enum"`
+		} `json:"$defs"`
+	}
+	if err := json.Unmarshal(got, &schema); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"ChargeBearerType1Code", "Priority2Code", "SettlementMethod1Code", "ClearingChannel2Code"} {
+		if len(schema.Defs[name].Enum) == 0 {
+			t.Errorf("%s has no enum", name)
+		}
+	}
+}
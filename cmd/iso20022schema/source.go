@@ -0,0 +1,239 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// pkgSource holds what the Go source of a package tells beyond the reflection
+// of its types: the documentation comments and the facets of the simple types.
+type pkgSource struct {
+	docs      map[string]string
+	fieldDocs map[string]string
+	facets    map[string][]facet
+	codes     map[string][]code
+	vars      map[string]ast.Expr
+}
+
+// facet is a call to one of the validate functions of the model package in
+// the Validate method of a simple type, for example validateLength with the
+// arguments 1 and 35.
+type facet struct {
+	name string
+	args []string
+}
+
+// code is a value of a Code type and its description.
+type code struct {
+	value       string
+	description string
+}
+
+// source loads the Go source of the packages of a module on demand.
+type source struct {
+	dir  string
+	pkgs map[string]*pkgSource
+}
+
+func newSource(dir string) *source {
+	return &source{dir: dir, pkgs: map[string]*pkgSource{}}
+}
+
+// pkg returns the source of the package in the directory name of the module.
+func (s *source) pkg(name string) (*pkgSource, error) {
+	if p, ok := s.pkgs[name]; ok {
+		return p, nil
+	}
+	files, err := filepath.Glob(filepath.Join(s.dir, name, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	p := &pkgSource{
+		docs:      map[string]string{},
+		fieldDocs: map[string]string{},
+		facets:    map[string][]facet{},
+		codes:     map[string][]code{},
+		vars:      map[string]ast.Expr{},
+	}
+	fset := token.NewFileSet()
+	var parsed []*ast.File
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, f)
+	}
+	descriptions := map[string]string{}
+	constants := map[string][]string{}
+	for _, f := range parsed {
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				p.genDecl(d, descriptions, constants)
+			case *ast.FuncDecl:
+				p.funcDecl(d)
+			}
+		}
+	}
+	for typ, names := range constants {
+		for _, name := range names {
+			value, err := p.eval(p.vars[name])
+			if err != nil {
+				continue
+			}
+			p.codes[typ] = append(p.codes[typ], code{value: value, description: descriptions[name]})
+		}
+	}
+	s.pkgs[name] = p
+	return p, nil
+}
+
+func (p *pkgSource) genDecl(d *ast.GenDecl, descriptions map[string]string, constants map[string][]string) {
+	for _, spec := range d.Specs {
+		switch s := spec.(type) {
+		case *ast.TypeSpec:
+			doc := s.Doc
+			if doc == nil && len(d.Specs) == 1 {
+				doc = d.Doc
+			}
+			p.docs[s.Name.Name] = text(doc)
+			if st, ok := s.Type.(*ast.StructType); ok {
+				for _, field := range st.Fields.List {
+					for _, name := range field.Names {
+						p.fieldDocs[s.Name.Name+"."+name.Name] = text(field.Doc)
+					}
+				}
+			}
+		case *ast.ValueSpec:
+			for i, name := range s.Names {
+				if i < len(s.Values) {
+					p.vars[name.Name] = s.Values[i]
+				}
+				if typ, ok := s.Type.(*ast.Ident); ok && d.Tok == token.CONST {
+					constants[typ.Name] = append(constants[typ.Name], name.Name)
+				}
+			}
+			// The descriptions of the values of a Code type are held in a map
+			// from the constants to the descriptions.
+			if len(s.Values) == 1 {
+				if lit, ok := s.Values[0].(*ast.CompositeLit); ok {
+					if _, ok := lit.Type.(*ast.MapType); ok {
+						for _, elt := range lit.Elts {
+							kv, ok := elt.(*ast.KeyValueExpr)
+							if !ok {
+								continue
+							}
+							key, ok := kv.Key.(*ast.Ident)
+							value, ok2 := kv.Value.(*ast.BasicLit)
+							if ok && ok2 && value.Kind == token.STRING {
+								descriptions[key.Name], _ = strconv.Unquote(value.Value)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// funcDecl records the validate calls of the Validate method of a simple type
+// or of an amount.
+func (p *pkgSource) funcDecl(d *ast.FuncDecl) {
+	if d.Name.Name != "Validate" || d.Recv == nil || len(d.Recv.List) != 1 || d.Body == nil {
+		return
+	}
+	typ := d.Recv.List[0].Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	recv, ok := typ.(*ast.Ident)
+	if !ok {
+		return
+	}
+	ast.Inspect(d.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		fn, ok := call.Fun.(*ast.Ident)
+		if !ok || !strings.HasPrefix(fn.Name, "validate") || fn.Name == "validateFields" {
+			return true
+		}
+		f := facet{name: fn.Name}
+		for _, arg := range call.Args {
+			f.args = append(f.args, p.arg(arg))
+		}
+		p.facets[recv.Name] = append(p.facets[recv.Name], f)
+		return false
+	})
+}
+
+// arg returns the value of a literal argument of a validate call, the pattern
+// of a pattern variable, or the source of any other expression.
+func (p *pkgSource) arg(e ast.Expr) string {
+	switch a := e.(type) {
+	case *ast.BasicLit:
+		if a.Kind == token.STRING {
+			s, _ := strconv.Unquote(a.Value)
+			return s
+		}
+		return a.Value
+	case *ast.UnaryExpr:
+		if lit, ok := a.X.(*ast.BasicLit); ok && a.Op == token.SUB {
+			return "-" + lit.Value
+		}
+	case *ast.Ident:
+		if call, ok := p.vars[a.Name].(*ast.CallExpr); ok && len(call.Args) == 1 {
+			if fn, ok := call.Fun.(*ast.Ident); ok && fn.Name == "newPattern" {
+				if s, err := p.eval(call.Args[0]); err == nil {
+					return s
+				}
+			}
+		}
+		return a.Name
+	}
+	return ""
+}
+
+// eval returns the value of a constant string expression of the package.
+func (p *pkgSource) eval(e ast.Expr) (string, error) {
+	switch x := e.(type) {
+	case *ast.BasicLit:
+		if x.Kind == token.STRING {
+			return strconv.Unquote(x.Value)
+		}
+	case *ast.BinaryExpr:
+		if x.Op == token.ADD {
+			l, err := p.eval(x.X)
+			if err != nil {
+				return "", err
+			}
+			r, err := p.eval(x.Y)
+			return l + r, err
+		}
+	case *ast.Ident:
+		if v, ok := p.vars[x.Name]; ok {
+			return p.eval(v)
+		}
+	case *ast.ParenExpr:
+		return p.eval(x.X)
+	}
+	return "", fmt.Errorf("unsupported constant expression %T", e)
+}
+
+// text returns the text of a comment.
+func text(doc *ast.CommentGroup) string {
+	if doc == nil {
+		return ""
+	}
+	return strings.TrimSpace(doc.Text())
+}
@@ -0,0 +1,44 @@
+package codeset
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// document is the JSON layout of the code sets. Besides the codeSets object
+// used by the embedded snapshot, the definitions object of the JSON schema
+// published by ISO is understood, in which case only the code values are known.
+// Partial is set when the code sets hold only part of their codes, as in the
+// snapshot.
+type document struct {
+	Version     string            `json:"version"`
+	Partial     bool              `json:"partial"`
+	CodeSets    map[string][]Code `json:"codeSets"`
+	Definitions map[string]struct {
+		Enum []string `json:"enum"`
+	} `json:"definitions"`
+}
+
+// LoadJSON reads code sets in JSON format and merges them into the registry.
+func (r *Registry) LoadJSON(reader io.Reader) error {
+	var doc document
+	if err := json.NewDecoder(reader).Decode(&doc); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if doc.Version != "" {
+		r.version = doc.Version
+	}
+	for set, codes := range doc.CodeSets {
+		r.add(set, codes, doc.Partial)
+	}
+	for set, def := range doc.Definitions {
+		codes := make([]Code, len(def.Enum))
+		for i, value := range def.Enum {
+			codes[i] = Code{Code: value}
+		}
+		r.add(set, codes, doc.Partial)
+	}
+	return nil
+}
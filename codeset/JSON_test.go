@@ -0,0 +1,42 @@
+package codeset
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadJSON(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		data     string
+		version  string
+		complete bool
+	}{
+		{"code sets", `{"version": "1Q2024", "codeSets": {"ExternalPurpose1Code": [{"code": "SALA", "name": "SalaryPayment", "definition": "Transaction is the payment of salaries."}]}}`, "1Q2024", true},
+		{"partial", `{"version": "snapshot", "partial": true, "codeSets": {"ExternalPurpose1Code": [{"code": "SALA", "name": "SalaryPayment", "definition": "Transaction is the payment of salaries."}]}}`, "snapshot", false},
+		{"definitions", `{"definitions": {"ExternalPurpose1Code": {"enum": ["SALA", "IVPT"]}}}`, "", true},
+	} {
+		r := NewRegistry()
+		if err := r.LoadJSON(strings.NewReader(tt.data)); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got := r.Version(); got != tt.version {
+			t.Errorf("%s: version %q, want %q", tt.name, got, tt.version)
+		}
+		if got := r.Complete("ExternalPurpose1Code"); got != tt.complete {
+			t.Errorf("%s: complete %v, want %v", tt.name, got, tt.complete)
+		}
+		if _, ok := r.Lookup("ExternalPurpose1Code", "SALA"); !ok {
+			t.Errorf("%s: no code SALA", tt.name)
+		}
+	}
+}
+
+func TestLoadJSONError(t *testing.T) {
+	for _, data := range []string{"", "{", `{"codeSets": []}`} {
+		if err := NewRegistry().LoadJSON(strings.NewReader(data)); err == nil {
+			t.Errorf("LoadJSON(%q) succeeded", data)
+		}
+	}
+}
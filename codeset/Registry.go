@@ -0,0 +1,208 @@
+// Package codeset holds the ISO 20022 external code sets, such as
+// ExternalPurpose1Code or ExternalCategoryPurpose1Code, which are published
+// separately from the message schemas and updated every quarter.
+//
+// The Default registry starts with the snapshot embedded in this package and
+// can be refreshed at run time from a newer ISO ExternalCodeSets publication:
+//
+//	if err := codeset.Load("ExternalCodeSets_2Q2024.xlsx"); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// The snapshot holds only part of the codes of its sets. Its codes have a
+// description, but a code missing from it may be valid: only the code sets of
+// a complete publication are used to reject codes.
+package codeset
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Code is a single entry of an external code set.
+type Code struct {
+	Code       string `json:"code"`
+	Name       string `json:"name,omitempty"`
+	Definition string `json:"definition,omitempty"`
+}
+
+// Registry is a collection of external code sets keyed by code set name.
+// A Registry is safe for concurrent use.
+type Registry struct {
+	mu      sync.RWMutex
+	version string
+	sets    map[string]map[string]Code
+
+	// partial holds the code sets of which the registry holds only part of
+	// the codes.
+	partial map[string]bool
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{sets: make(map[string]map[string]Code), partial: make(map[string]bool)}
+}
+
+// Version returns the release of the code sets, for example 2Q2024.
+func (r *Registry) Version() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.version
+}
+
+// Add adds codes to a code set, replacing existing entries with the same code.
+// The code set is then complete.
+func (r *Registry) Add(set string, codes ...Code) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.add(set, codes, false)
+}
+
+// add adds codes to a code set, which is complete unless partial is set and
+// the registry does not already hold it complete.
+func (r *Registry) add(set string, codes []Code, partial bool) {
+	if _, ok := r.sets[set]; !ok || !partial {
+		r.partial[set] = partial
+	}
+	m := r.sets[set]
+	if m == nil {
+		m = make(map[string]Code, len(codes))
+		r.sets[set] = m
+	}
+	for _, c := range codes {
+		m[c.Code] = c
+	}
+}
+
+// Known reports whether the registry holds the given code set.
+func (r *Registry) Known(set string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.sets[set]
+	return ok
+}
+
+// Complete reports whether the registry holds all the codes of the given code
+// set, so that a code it does not hold is not valid.
+func (r *Registry) Complete(set string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.sets[set]
+	return ok && !r.partial[set]
+}
+
+// Lookup returns the entry of code in the given code set.
+func (r *Registry) Lookup(set, code string) (Code, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.sets[set][code]
+	return c, ok
+}
+
+// Sets returns the sorted names of the code sets held by the registry.
+func (r *Registry) Sets() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	list := make([]string, 0, len(r.sets))
+	for set := range r.sets {
+		list = append(list, set)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// Codes returns the entries of a code set sorted by code.
+func (r *Registry) Codes(set string) []Code {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	list := make([]Code, 0, len(r.sets[set]))
+	for _, c := range r.sets[set] {
+		list = append(list, c)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+	return list
+}
+
+// replace swaps the content of r with the content of other.
+func (r *Registry) replace(other *Registry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.version, r.sets, r.partial = other.version, other.sets, other.partial
+}
+
+// LoadFile reads an ISO ExternalCodeSets publication in JSON or XLSX format,
+// depending on the file extension, and merges it into the registry. When the
+// file does not state its release, the file name is used as the version.
+func (r *Registry) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	ext := filepath.Ext(path)
+	switch strings.ToLower(ext) {
+	case ".json":
+		err = r.LoadJSON(f)
+	case ".xlsx":
+		var info os.FileInfo
+		if info, err = f.Stat(); err == nil {
+			err = r.LoadXLSX(f, info.Size())
+		}
+	default:
+		return fmt.Errorf("codeset: unsupported file format %q", ext)
+	}
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.version == "" {
+		r.version = strings.TrimSuffix(filepath.Base(path), ext)
+	}
+	return nil
+}
+
+// Default is the registry used by the External*Code types of the model package.
+var Default = NewRegistry()
+
+func init() {
+	if err := Default.LoadJSON(strings.NewReader(snapshot)); err != nil {
+		panic("codeset: invalid embedded snapshot: " + err.Error())
+	}
+}
+
+// Load replaces the content of the Default registry with the code sets read from path.
+// The Default registry is left untouched if the file cannot be read.
+func Load(path string) error {
+	r := NewRegistry()
+	if err := r.LoadFile(path); err != nil {
+		return err
+	}
+	Default.replace(r)
+	return nil
+}
+
+// Lookup returns the entry of code in the given code set of the Default registry.
+func Lookup(set, code string) (Code, bool) {
+	return Default.Lookup(set, code)
+}
+
+// Known reports whether the Default registry holds the given code set.
+func Known(set string) bool {
+	return Default.Known(set)
+}
+
+// Complete reports whether the Default registry holds all the codes of the
+// given code set.
+func Complete(set string) bool {
+	return Default.Complete(set)
+}
+
+// Warn, when set, is called with the codes that are accepted although the
+// Default registry does not hold them, because it holds only part of their
+// code set. It must be set before the registry is used, at start-up.
+var Warn func(set, code string)
@@ -0,0 +1,100 @@
+package codeset
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	r.Add("ExternalPurpose1Code", Code{Code: "SALA", Name: "SalaryPayment"}, Code{Code: "CASH"})
+	r.Add("ExternalPurpose1Code", Code{Code: "SALA", Name: "Salary"})
+	r.Add("ExternalCategoryPurpose1Code", Code{Code: "SUPP"})
+	if got, want := r.Sets(), []string{"ExternalCategoryPurpose1Code", "ExternalPurpose1Code"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sets %v, want %v", got, want)
+	}
+	if got, want := r.Codes("ExternalPurpose1Code"), []Code{{Code: "CASH"}, {Code: "SALA", Name: "Salary"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("codes %v, want %v", got, want)
+	}
+	for _, tt := range []struct {
+		set, code string
+		known     bool
+		found     bool
+	}{
+		{"ExternalPurpose1Code", "SALA", true, true},
+		{"ExternalPurpose1Code", "IVPT", true, false},
+		{"ExternalReturnReason1Code", "NARR", false, false},
+	} {
+		if got := r.Known(tt.set); got != tt.known {
+			t.Errorf("Known(%s) = %v", tt.set, got)
+		}
+		if got := r.Complete(tt.set); got != tt.known {
+			t.Errorf("Complete(%s) = %v", tt.set, got)
+		}
+		if _, got := r.Lookup(tt.set, tt.code); got != tt.found {
+			t.Errorf("Lookup(%s, %s) found %v", tt.set, tt.code, got)
+		}
+	}
+}
+
+func TestDefault(t *testing.T) {
+	for _, tt := range []struct{ set, code string }{
+		{"ExternalReturnReason1Code", "NARR"},
+		{"ExternalStatusReason1Code", "TM01"},
+		{"ExternalPurpose1Code", "IVPT"},
+	} {
+		if !Known(tt.set) {
+			t.Errorf("no code set %s in the snapshot", tt.set)
+		}
+		if Complete(tt.set) {
+			t.Errorf("code set %s of the snapshot complete", tt.set)
+		}
+	}
+	if c, ok := Lookup("ExternalPurpose1Code", "SALA"); !ok || c.Name != "SalaryPayment" {
+		t.Errorf("SALA: %+v", c)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "ExternalCodeSets_2Q2024.json")
+	data := `{"definitions": {"ExternalPurpose1Code": {"enum": ["IVPT", "SALA"]}}}`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	r := NewRegistry()
+	if err := r.LoadJSON(strings.NewReader(snapshot)); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.LoadFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Version(); got != "snapshot" {
+		t.Errorf("version %s", got)
+	}
+	if !r.Complete("ExternalPurpose1Code") || r.Complete("ExternalReturnReason1Code") {
+		t.Errorf("complete code sets: purpose %v, return reason %v", r.Complete("ExternalPurpose1Code"), r.Complete("ExternalReturnReason1Code"))
+	}
+	if c, ok := r.Lookup("ExternalPurpose1Code", "SALA"); !ok || c.Name != "" {
+		t.Errorf("SALA: %+v", c)
+	}
+
+	r = NewRegistry()
+	if err := r.LoadFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Version(); got != "ExternalCodeSets_2Q2024" {
+		t.Errorf("version %s", got)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "codes.csv"), []byte("SALA"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"missing.json", "codes.csv"} {
+		if err := r.LoadFile(filepath.Join(dir, name)); err == nil {
+			t.Errorf("LoadFile(%s) succeeded", name)
+		}
+	}
+}
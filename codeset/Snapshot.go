@@ -0,0 +1,11 @@
+package codeset
+
+import _ "embed"
+
+// snapshot is the subset of the ISO external code sets shipped with the
+// package. It covers the code sets most used in payments and cash management,
+// in part: load the complete publication with Load to reject the codes that
+// are not in their code set.
+//
+//go:embed ExternalCodeSets.json
+var snapshot string
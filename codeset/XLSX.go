@@ -0,0 +1,221 @@
+package codeset
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// LoadXLSX reads code sets from an ISO ExternalCodeSets workbook and merges them
+// into the registry.
+//
+// Every sheet whose header row holds a code column ("Code" or "Code Value") is
+// read. The code set of each row is taken from a "Code Set" column when present
+// and from the sheet name otherwise. Optional "Name" and "Definition" columns
+// give the name and definition of the codes.
+func (r *Registry) LoadXLSX(reader io.ReaderAt, size int64) error {
+	z, err := zip.NewReader(reader, size)
+	if err != nil {
+		return err
+	}
+	files := make(map[string]*zip.File, len(z.File))
+	for _, f := range z.File {
+		files[f.Name] = f
+	}
+	var strs []string
+	if f, ok := files["xl/sharedStrings.xml"]; ok {
+		if strs, err = readSharedStrings(f); err != nil {
+			return err
+		}
+	}
+	sheets, err := readSheets(files)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range sheets {
+		f, ok := files[s.target]
+		if !ok {
+			return fmt.Errorf("codeset: sheet %q not found in workbook", s.name)
+		}
+		rows, err := readRows(f, strs)
+		if err != nil {
+			return err
+		}
+		r.addRows(s.name, rows)
+	}
+	return nil
+}
+
+func (r *Registry) addRows(sheet string, rows [][]string) {
+	set, code, name, definition := -1, -1, -1, -1
+	for _, row := range rows {
+		if code < 0 {
+			for j, cell := range row {
+				switch strings.ToLower(strings.TrimSpace(cell)) {
+				case "code set", "codeset", "code set name":
+					set = j
+				case "code", "code value", "codevalue":
+					code = j
+				case "name", "code name":
+					name = j
+				case "definition", "code definition":
+					definition = j
+				}
+			}
+			continue
+		}
+		c := Code{Code: strings.TrimSpace(cell(row, code))}
+		if c.Code == "" {
+			continue
+		}
+		c.Name = strings.TrimSpace(cell(row, name))
+		c.Definition = strings.TrimSpace(cell(row, definition))
+		s := strings.TrimSpace(cell(row, set))
+		if s == "" {
+			s = sheet
+		}
+		r.add(s, []Code{c}, false)
+	}
+}
+
+func cell(row []string, i int) string {
+	if i < 0 || i >= len(row) {
+		return ""
+	}
+	return row[i]
+}
+
+type sheet struct {
+	name   string
+	target string
+}
+
+func readSheets(files map[string]*zip.File) ([]sheet, error) {
+	var workbook struct {
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+			ID   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	var rels struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	if err := decodeFile(files["xl/workbook.xml"], &workbook); err != nil {
+		return nil, err
+	}
+	if err := decodeFile(files["xl/_rels/workbook.xml.rels"], &rels); err != nil {
+		return nil, err
+	}
+	targets := make(map[string]string, len(rels.Relationships))
+	for _, rel := range rels.Relationships {
+		target := rel.Target
+		if strings.HasPrefix(target, "/") {
+			target = strings.TrimPrefix(target, "/")
+		} else {
+			target = path.Join("xl", target)
+		}
+		targets[rel.ID] = target
+	}
+	list := make([]sheet, len(workbook.Sheets))
+	for i, s := range workbook.Sheets {
+		list[i] = sheet{name: s.Name, target: targets[s.ID]}
+	}
+	return list, nil
+}
+
+func readSharedStrings(f *zip.File) ([]string, error) {
+	var sst struct {
+		Items []struct {
+			Text string `xml:"t"`
+			Runs []struct {
+				Text string `xml:"t"`
+			} `xml:"r"`
+		} `xml:"si"`
+	}
+	if err := decodeFile(f, &sst); err != nil {
+		return nil, err
+	}
+	list := make([]string, len(sst.Items))
+	for i, si := range sst.Items {
+		text := si.Text
+		for _, run := range si.Runs {
+			text += run.Text
+		}
+		list[i] = text
+	}
+	return list, nil
+}
+
+func readRows(f *zip.File, strs []string) ([][]string, error) {
+	var ws struct {
+		Rows []struct {
+			Cells []struct {
+				Ref    string `xml:"r,attr"`
+				Type   string `xml:"t,attr"`
+				Value  string `xml:"v"`
+				Inline string `xml:"is>t"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	if err := decodeFile(f, &ws); err != nil {
+		return nil, err
+	}
+	rows := make([][]string, len(ws.Rows))
+	for i, row := range ws.Rows {
+		for j, c := range row.Cells {
+			col := j
+			if c.Ref != "" {
+				col = column(c.Ref)
+			}
+			for len(rows[i]) <= col {
+				rows[i] = append(rows[i], "")
+			}
+			switch c.Type {
+			case "s":
+				n, err := strconv.Atoi(c.Value)
+				if err != nil || n < 0 || n >= len(strs) {
+					return nil, fmt.Errorf("codeset: invalid shared string %q in cell %s", c.Value, c.Ref)
+				}
+				rows[i][col] = strs[n]
+			case "inlineStr":
+				rows[i][col] = c.Inline
+			default:
+				rows[i][col] = c.Value
+			}
+		}
+	}
+	return rows, nil
+}
+
+// column returns the zero-based column index of a cell reference such as AB12.
+func column(ref string) int {
+	n := 0
+	for _, c := range ref {
+		if c < 'A' || c > 'Z' {
+			break
+		}
+		n = n*26 + int(c-'A'+1)
+	}
+	return n - 1
+}
+
+func decodeFile(f *zip.File, v interface{}) error {
+	if f == nil {
+		return fmt.Errorf("codeset: not an xlsx workbook")
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return xml.NewDecoder(rc).Decode(v)
+}
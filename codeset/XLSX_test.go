@@ -0,0 +1,120 @@
+package codeset
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// workbook returns an xlsx workbook of the given files, besides the workbook
+// and its relationships, which list sheets sheet1.xml, sheet2.xml, and so on,
+// with the given names.
+func workbook(t *testing.T, files map[string]string, names ...string) []byte {
+	t.Helper()
+	var sheets, rels strings.Builder
+	for i, name := range names {
+		id := string(rune('1' + i))
+		sheets.WriteString(`<sheet name="` + name + `" sheetId="` + id + `" r:id="rId` + id + `"/>`)
+		rels.WriteString(`<Relationship Id="rId` + id + `" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet` + id + `.xml"/>`)
+	}
+	all := map[string]string{
+		"xl/workbook.xml":            `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>` + sheets.String() + `</sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` + rels.String() + `</Relationships>`,
+	}
+	for name, data := range files {
+		all[name] = data
+	}
+	var b bytes.Buffer
+	z := zip.NewWriter(&b)
+	for name, data := range all {
+		w, err := z.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := z.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+// release is a workbook laid out as the ISO ExternalCodeSets publication: a
+// sheet of all the code sets with a code set column, its strings shared, and a
+// sheet named after its code set, with inline strings and an empty column.
+var release = map[string]string{
+	"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<si><t>Code Set</t></si><si><t>Code Value</t></si><si><t>Code Name</t></si><si><t>Code Definition</t></si>` +
+		`<si><t>ExternalReturnReason1Code</t></si><si><t>NARR</t></si><si><t>Narrative</t></si><si><r><t>Reason is provided </t></r><r><t>as narrative information.</t></r></si>` +
+		`<si><t>ExternalStatusReason1Code</t></si><si><t>TM01</t></si><si><t>InvalidCutOffTime</t></si>` +
+		`</sst>`,
+	"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+		`<row r="1"><c r="A1"><v>2Q2024</v></c></row>` +
+		`<row r="2"><c r="A2" t="s"><v>0</v></c><c r="B2" t="s"><v>1</v></c><c r="C2" t="s"><v>2</v></c><c r="D2" t="s"><v>3</v></c></row>` +
+		`<row r="3"><c r="A3" t="s"><v>4</v></c><c r="B3" t="s"><v>5</v></c><c r="C3" t="s"><v>6</v></c><c r="D3" t="s"><v>7</v></c></row>` +
+		`<row r="4"><c r="A4" t="s"><v>8</v></c><c r="B4" t="s"><v>9</v></c><c r="C4" t="s"><v>10</v></c></row>` +
+		`<row r="5"><c r="A5" t="s"><v>8</v></c></row>` +
+		`</sheetData></worksheet>`,
+	"xl/worksheets/sheet2.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+		`<row r="1"><c r="A1" t="inlineStr"><is><t>Code</t></is></c><c r="C1" t="inlineStr"><is><t>Name</t></is></c></row>` +
+		`<row r="2"><c r="A2" t="inlineStr"><is><t>IVPT</t></is></c><c r="C2" t="inlineStr"><is><t>InvoicePayment</t></is></c></row>` +
+		`</sheetData></worksheet>`,
+}
+
+func TestLoadXLSX(t *testing.T) {
+	data := workbook(t, release, "AllCodeSets", "ExternalPurpose1Code")
+	path := filepath.Join(t.TempDir(), "ExternalCodeSets_2Q2024.xlsx")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	r := NewRegistry()
+	if err := r.LoadFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Version(); got != "ExternalCodeSets_2Q2024" {
+		t.Errorf("version %s", got)
+	}
+	for _, tt := range []struct {
+		set  string
+		code Code
+	}{
+		{"ExternalReturnReason1Code", Code{"NARR", "Narrative", "Reason is provided as narrative information."}},
+		{"ExternalStatusReason1Code", Code{"TM01", "InvalidCutOffTime", ""}},
+		{"ExternalPurpose1Code", Code{"IVPT", "InvoicePayment", ""}},
+	} {
+		if got, _ := r.Lookup(tt.set, tt.code.Code); got != tt.code {
+			t.Errorf("%s %s: %+v, want %+v", tt.set, tt.code.Code, got, tt.code)
+		}
+		if !r.Complete(tt.set) {
+			t.Errorf("code set %s not complete", tt.set)
+		}
+	}
+	if got := len(r.Sets()); got != 3 {
+		t.Errorf("%d code sets, want 3", got)
+	}
+}
+
+func TestLoadXLSXError(t *testing.T) {
+	bad := map[string]string{
+		"xl/sharedStrings.xml":     `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><si><t>Code</t></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData><row r="1"><c r="A1" t="s"><v>1</v></c></row></sheetData></worksheet>`,
+	}
+	for _, tt := range []struct {
+		name string
+		data []byte
+	}{
+		{"not a zip", []byte("Code,Name\nSALA,SalaryPayment\n")},
+		{"empty", nil},
+		{"missing sheet", workbook(t, nil, "ExternalPurpose1Code")},
+		{"shared string", workbook(t, bad, "ExternalPurpose1Code")},
+	} {
+		if err := NewRegistry().LoadXLSX(bytes.NewReader(tt.data), int64(len(tt.data))); err == nil {
+			t.Errorf("%s: LoadXLSX succeeded", tt.name)
+		}
+	}
+}
@@ -16,6 +16,41 @@ func (d *Document01600102) AddMessage() *CollateralAndExposureReportV02 {
 	return d.Message
 }
 
+func (d *Document01600102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.016.001.02"
+}
+
+func (d *Document01600102) MessageDefinitionIdentifier() string {
+	return "colr.016.001.02"
+}
+
+func (d *Document01600102) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document01600102) MessageFunctionality() string {
+	return "016"
+}
+
+func (d *Document01600102) Variant() string {
+	return "001"
+}
+
+func (d *Document01600102) Version() string {
+	return "02"
+}
+
+func (d *Document01600102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01600102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // This message is sent:
 // - either by the collateral giver, or its collateral manager, to the collateral taker, or its collateral manager, or
@@ -47,6 +82,10 @@ type CollateralAndExposureReportV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CollateralAndExposureReportV02) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CollateralAndExposureReportV02) AddReportParameters() *model.ReportParameters2 {
 	c.ReportParameters = new(model.ReportParameters2)
 	return c.ReportParameters
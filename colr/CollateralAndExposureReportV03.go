@@ -16,6 +16,41 @@ func (d *Document01600103) AddMessage() *CollateralAndExposureReportV03 {
 	return d.Message
 }
 
+func (d *Document01600103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.016.001.03"
+}
+
+func (d *Document01600103) MessageDefinitionIdentifier() string {
+	return "colr.016.001.03"
+}
+
+func (d *Document01600103) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document01600103) MessageFunctionality() string {
+	return "016"
+}
+
+func (d *Document01600103) Variant() string {
+	return "001"
+}
+
+func (d *Document01600103) Version() string {
+	return "03"
+}
+
+func (d *Document01600103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01600103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CollateralAndExposureReport message is sent:
 // - either by the collateral giver, or its collateral manager, to the collateral taker, or its collateral manager, or
@@ -47,6 +82,10 @@ type CollateralAndExposureReportV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CollateralAndExposureReportV03) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CollateralAndExposureReportV03) AddReportParameters() *model.ReportParameters5 {
 	c.ReportParameters = new(model.ReportParameters5)
 	return c.ReportParameters
@@ -16,6 +16,41 @@ func (d *Document00500103) AddMessage() *CollateralManagementCancellationRequest
 	return d.Message
 }
 
+func (d *Document00500103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.005.001.03"
+}
+
+func (d *Document00500103) MessageDefinitionIdentifier() string {
+	return "colr.005.001.03"
+}
+
+func (d *Document00500103) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document00500103) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500103) Variant() string {
+	return "001"
+}
+
+func (d *Document00500103) Version() string {
+	return "03"
+}
+
+func (d *Document00500103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // This CollateralManagementCancellationRequest message is sent by:
 // - the collateral taker or its collateral manager to the collateral giver or its collateral manager,
@@ -45,6 +80,10 @@ type CollateralManagementCancellationRequestV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CollateralManagementCancellationRequestV03) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CollateralManagementCancellationRequestV03) SetTransactionIdentification(value string) {
 	c.TransactionIdentification = (*model.Max35Text)(&value)
 }
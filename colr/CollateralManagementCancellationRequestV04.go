@@ -16,6 +16,41 @@ func (d *Document00500104) AddMessage() *CollateralManagementCancellationRequest
 	return d.Message
 }
 
+func (d *Document00500104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.005.001.04"
+}
+
+func (d *Document00500104) MessageDefinitionIdentifier() string {
+	return "colr.005.001.04"
+}
+
+func (d *Document00500104) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document00500104) MessageFunctionality() string {
+	return "005"
+}
+
+func (d *Document00500104) Variant() string {
+	return "001"
+}
+
+func (d *Document00500104) Version() string {
+	return "04"
+}
+
+func (d *Document00500104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00500104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CollateralManagementCancellationRequest message is sent by:
 // - the collateral taker or its collateral manager to the collateral giver or its collateral manager,
@@ -45,6 +80,10 @@ type CollateralManagementCancellationRequestV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CollateralManagementCancellationRequestV04) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CollateralManagementCancellationRequestV04) SetTransactionIdentification(value string) {
 	c.TransactionIdentification = (*model.Max35Text)(&value)
 }
@@ -16,6 +16,41 @@ func (d *Document00600103) AddMessage() *CollateralManagementCancellationStatusV
 	return d.Message
 }
 
+func (d *Document00600103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.006.001.03"
+}
+
+func (d *Document00600103) MessageDefinitionIdentifier() string {
+	return "colr.006.001.03"
+}
+
+func (d *Document00600103) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document00600103) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600103) Variant() string {
+	return "001"
+}
+
+func (d *Document00600103) Version() string {
+	return "03"
+}
+
+func (d *Document00600103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // This CollateralManagementCancellationStatus message is sent by:
 // - the collateral taker or its collateral manager to the collateral giver or its collateral manager, or
@@ -44,6 +79,10 @@ type CollateralManagementCancellationStatusV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CollateralManagementCancellationStatusV03) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CollateralManagementCancellationStatusV03) SetTransactionIdentification(value string) {
 	c.TransactionIdentification = (*model.Max35Text)(&value)
 }
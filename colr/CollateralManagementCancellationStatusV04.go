@@ -16,6 +16,41 @@ func (d *Document00600104) AddMessage() *CollateralManagementCancellationStatusV
 	return d.Message
 }
 
+func (d *Document00600104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.006.001.04"
+}
+
+func (d *Document00600104) MessageDefinitionIdentifier() string {
+	return "colr.006.001.04"
+}
+
+func (d *Document00600104) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document00600104) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600104) Variant() string {
+	return "001"
+}
+
+func (d *Document00600104) Version() string {
+	return "04"
+}
+
+func (d *Document00600104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CollateralManagementCancellationStatus message is sent by:
 // - the collateral taker or its collateral manager to the collateral giver or its collateral manager, or
@@ -44,6 +79,10 @@ type CollateralManagementCancellationStatusV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CollateralManagementCancellationStatusV04) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CollateralManagementCancellationStatusV04) SetTransactionIdentification(value string) {
 	c.TransactionIdentification = (*model.Max35Text)(&value)
 }
@@ -16,6 +16,41 @@ func (d *Document00800104) AddMessage() *CollateralProposalResponseV04 {
 	return d.Message
 }
 
+func (d *Document00800104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.008.001.04"
+}
+
+func (d *Document00800104) MessageDefinitionIdentifier() string {
+	return "colr.008.001.04"
+}
+
+func (d *Document00800104) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document00800104) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800104) Variant() string {
+	return "001"
+}
+
+func (d *Document00800104) Version() string {
+	return "04"
+}
+
+func (d *Document00800104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CollateralProposalResponse message is sent by the collateral taker or its collateral manager to the collateral giver or its collateral manager to either accept or reject the collateral which has been proposed for the margin call. This message applies to both initial and counter proposals. If the collateral proposal is rejected then a new collateral proposal must be made.
 //
@@ -39,6 +74,10 @@ type CollateralProposalResponseV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CollateralProposalResponseV04) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CollateralProposalResponseV04) SetTransactionIdentification(value string) {
 	c.TransactionIdentification = (*model.Max35Text)(&value)
 }
@@ -16,6 +16,41 @@ func (d *Document00800105) AddMessage() *CollateralProposalResponseV05 {
 	return d.Message
 }
 
+func (d *Document00800105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.008.001.05"
+}
+
+func (d *Document00800105) MessageDefinitionIdentifier() string {
+	return "colr.008.001.05"
+}
+
+func (d *Document00800105) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document00800105) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800105) Variant() string {
+	return "001"
+}
+
+func (d *Document00800105) Version() string {
+	return "05"
+}
+
+func (d *Document00800105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CollateralProposalResponse message is sent by the collateral taker or its collateral manager to the collateral giver or its collateral manager to either accept or reject the collateral which has been proposed for the margin call. This message applies to both initial and counter proposals. If the collateral proposal is rejected then a new collateral proposal must be made.
 //
@@ -39,6 +74,10 @@ type CollateralProposalResponseV05 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CollateralProposalResponseV05) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CollateralProposalResponseV05) SetTransactionIdentification(value string) {
 	c.TransactionIdentification = (*model.Max35Text)(&value)
 }
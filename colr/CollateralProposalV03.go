@@ -16,6 +16,41 @@ func (d *Document00700103) AddMessage() *CollateralProposalV03 {
 	return d.Message
 }
 
+func (d *Document00700103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.007.001.03"
+}
+
+func (d *Document00700103) MessageDefinitionIdentifier() string {
+	return "colr.007.001.03"
+}
+
+func (d *Document00700103) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document00700103) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700103) Variant() string {
+	return "001"
+}
+
+func (d *Document00700103) Version() string {
+	return "03"
+}
+
+func (d *Document00700103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // This message is sent by the collateral giver or its collateral manager to the collateral taker or its collateral manager, to propose the collateral to be delivered. This message is sent once the Margin Call is agreed or partially agreed and can be used for new collateral at the initiation of an exposure or for additional collateral for variation of an existing exposure. This message is used for both initial collateral proposal and subsequent counter proposals.
 //
@@ -41,6 +76,10 @@ type CollateralProposalV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CollateralProposalV03) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CollateralProposalV03) SetTransactionIdentification(value string) {
 	c.TransactionIdentification = (*model.Max35Text)(&value)
 }
@@ -16,6 +16,41 @@ func (d *Document01200103) AddMessage() *CollateralSubstitutionConfirmationV03 {
 	return d.Message
 }
 
+func (d *Document01200103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.012.001.03"
+}
+
+func (d *Document01200103) MessageDefinitionIdentifier() string {
+	return "colr.012.001.03"
+}
+
+func (d *Document01200103) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document01200103) MessageFunctionality() string {
+	return "012"
+}
+
+func (d *Document01200103) Variant() string {
+	return "001"
+}
+
+func (d *Document01200103) Version() string {
+	return "03"
+}
+
+func (d *Document01200103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01200103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // This CollateralSubstitutionConfirmation message is sent by:
 // - the collateral taker or its collateral manager to the collateral giver or its collateral manager, or
@@ -44,6 +79,10 @@ type CollateralSubstitutionConfirmationV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CollateralSubstitutionConfirmationV03) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CollateralSubstitutionConfirmationV03) SetTransactionIdentification(value string) {
 	c.TransactionIdentification = (*model.Max35Text)(&value)
 }
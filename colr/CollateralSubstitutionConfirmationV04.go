@@ -16,6 +16,41 @@ func (d *Document01200104) AddMessage() *CollateralSubstitutionConfirmationV04 {
 	return d.Message
 }
 
+func (d *Document01200104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.012.001.04"
+}
+
+func (d *Document01200104) MessageDefinitionIdentifier() string {
+	return "colr.012.001.04"
+}
+
+func (d *Document01200104) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document01200104) MessageFunctionality() string {
+	return "012"
+}
+
+func (d *Document01200104) Variant() string {
+	return "001"
+}
+
+func (d *Document01200104) Version() string {
+	return "04"
+}
+
+func (d *Document01200104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01200104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CollateralSubstitutionConfirmation message is sent by:
 // - the collateral taker or its collateral manager to the collateral giver or its collateral manager, or
@@ -44,6 +79,10 @@ type CollateralSubstitutionConfirmationV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CollateralSubstitutionConfirmationV04) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CollateralSubstitutionConfirmationV04) SetTransactionIdentification(value string) {
 	c.TransactionIdentification = (*model.Max35Text)(&value)
 }
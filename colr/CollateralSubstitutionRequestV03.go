@@ -16,6 +16,41 @@ func (d *Document01000103) AddMessage() *CollateralSubstitutionRequestV03 {
 	return d.Message
 }
 
+func (d *Document01000103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.010.001.03"
+}
+
+func (d *Document01000103) MessageDefinitionIdentifier() string {
+	return "colr.010.001.03"
+}
+
+func (d *Document01000103) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document01000103) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000103) Variant() string {
+	return "001"
+}
+
+func (d *Document01000103) Version() string {
+	return "03"
+}
+
+func (d *Document01000103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // This CollateralSubstitutionRequest message is sent by either the collateral giver or its collateral manager to the collateral taker or its collateral manager. It is used to request a substitution of collateral by specifying the collateral to be returned and proposing the new type(s) of collateral to be delivered. Note: There are cases where the collateral taker can initiate the CollateralSubstitutionRequest message, for example in case of breach in the concentration limit.
 //
@@ -44,6 +79,10 @@ type CollateralSubstitutionRequestV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CollateralSubstitutionRequestV03) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CollateralSubstitutionRequestV03) SetTransactionIdentification(value string) {
 	c.TransactionIdentification = (*model.Max35Text)(&value)
 }
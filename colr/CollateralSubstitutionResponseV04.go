@@ -16,6 +16,41 @@ func (d *Document01100104) AddMessage() *CollateralSubstitutionResponseV04 {
 	return d.Message
 }
 
+func (d *Document01100104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.011.001.04"
+}
+
+func (d *Document01100104) MessageDefinitionIdentifier() string {
+	return "colr.011.001.04"
+}
+
+func (d *Document01100104) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document01100104) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100104) Variant() string {
+	return "001"
+}
+
+func (d *Document01100104) Version() string {
+	return "04"
+}
+
+func (d *Document01100104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CollateralSubstitutionResponse message is sent by either the collateral taker or its collateral manager to the collateral giver or its collateral manager. This is a response to the CollateralSubstitutionRequest message and the collateral proposed in the substitution request can be accepted or rejected. If the collateral proposed is rejected then a new CollateralSubstitutionRequest should be sent. Note: There are cases where the collateral giver will send this message when the collateral taker has initiated the CollateralSubstitutionRequest message, for example in case of breach in the concentration limit.
 //
@@ -41,6 +76,10 @@ type CollateralSubstitutionResponseV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CollateralSubstitutionResponseV04) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CollateralSubstitutionResponseV04) SetTransactionIdentification(value string) {
 	c.TransactionIdentification = (*model.Max35Text)(&value)
 }
@@ -16,6 +16,41 @@ func (d *Document01300103) AddMessage() *InterestPaymentRequestV03 {
 	return d.Message
 }
 
+func (d *Document01300103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.013.001.03"
+}
+
+func (d *Document01300103) MessageDefinitionIdentifier() string {
+	return "colr.013.001.03"
+}
+
+func (d *Document01300103) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document01300103) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300103) Variant() string {
+	return "001"
+}
+
+func (d *Document01300103) Version() string {
+	return "03"
+}
+
+func (d *Document01300103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // This InterestPaymentRequest message is sent by either;
 // - the collateral taker or its collateral manager to the collateral giver or its collateral manager, or
@@ -50,6 +85,10 @@ type InterestPaymentRequestV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *InterestPaymentRequestV03) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *InterestPaymentRequestV03) SetTransactionIdentification(value string) {
 	i.TransactionIdentification = (*model.Max35Text)(&value)
 }
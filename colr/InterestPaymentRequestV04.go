@@ -16,6 +16,41 @@ func (d *Document01300104) AddMessage() *InterestPaymentRequestV04 {
 	return d.Message
 }
 
+func (d *Document01300104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.013.001.04"
+}
+
+func (d *Document01300104) MessageDefinitionIdentifier() string {
+	return "colr.013.001.04"
+}
+
+func (d *Document01300104) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document01300104) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300104) Variant() string {
+	return "001"
+}
+
+func (d *Document01300104) Version() string {
+	return "04"
+}
+
+func (d *Document01300104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The InterestPaymentRequest message is sent by either;
 // - the collateral taker or its collateral manager to the collateral giver or its collateral manager, or
@@ -50,6 +85,10 @@ type InterestPaymentRequestV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *InterestPaymentRequestV04) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *InterestPaymentRequestV04) SetTransactionIdentification(value string) {
 	i.TransactionIdentification = (*model.Max35Text)(&value)
 }
@@ -16,6 +16,41 @@ func (d *Document01400104) AddMessage() *InterestPaymentResponseV04 {
 	return d.Message
 }
 
+func (d *Document01400104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.014.001.04"
+}
+
+func (d *Document01400104) MessageDefinitionIdentifier() string {
+	return "colr.014.001.04"
+}
+
+func (d *Document01400104) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document01400104) MessageFunctionality() string {
+	return "014"
+}
+
+func (d *Document01400104) Variant() string {
+	return "001"
+}
+
+func (d *Document01400104) Version() string {
+	return "04"
+}
+
+func (d *Document01400104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01400104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The InterestPaymentResponse message is sent by either;
 // - the collateral taker or its collateral manager to the collateral giver or its collateral manager, or
@@ -50,6 +85,10 @@ type InterestPaymentResponseV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *InterestPaymentResponseV04) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *InterestPaymentResponseV04) SetTransactionIdentification(value string) {
 	i.TransactionIdentification = (*model.Max35Text)(&value)
 }
@@ -16,6 +16,41 @@ func (d *Document01500103) AddMessage() *InterestPaymentStatementV03 {
 	return d.Message
 }
 
+func (d *Document01500103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.015.001.03"
+}
+
+func (d *Document01500103) MessageDefinitionIdentifier() string {
+	return "colr.015.001.03"
+}
+
+func (d *Document01500103) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document01500103) MessageFunctionality() string {
+	return "015"
+}
+
+func (d *Document01500103) Variant() string {
+	return "001"
+}
+
+func (d *Document01500103) Version() string {
+	return "03"
+}
+
+func (d *Document01500103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01500103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // This message is sent by either the collateral giver or its collateral manager to the collateral taker or its collateral manager. It is used to report the interest amounts calculated based on the effective posted collateral amount, over a specific period of time agreed by both parties.
 //
@@ -47,6 +82,10 @@ type InterestPaymentStatementV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *InterestPaymentStatementV03) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *InterestPaymentStatementV03) SetTransactionIdentification(value string) {
 	i.TransactionIdentification = (*model.Max35Text)(&value)
 }
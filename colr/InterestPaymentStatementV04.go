@@ -16,6 +16,41 @@ func (d *Document01500104) AddMessage() *InterestPaymentStatementV04 {
 	return d.Message
 }
 
+func (d *Document01500104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.015.001.04"
+}
+
+func (d *Document01500104) MessageDefinitionIdentifier() string {
+	return "colr.015.001.04"
+}
+
+func (d *Document01500104) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document01500104) MessageFunctionality() string {
+	return "015"
+}
+
+func (d *Document01500104) Variant() string {
+	return "001"
+}
+
+func (d *Document01500104) Version() string {
+	return "04"
+}
+
+func (d *Document01500104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01500104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The InterestPaymentStatement message is sent by either the collateral giver or its collateral manager to the collateral taker or its collateral manager. It is used to report the interest amounts calculated based on the effective posted collateral amount, over a specific period of time agreed by both parties.
 //
@@ -47,6 +82,10 @@ type InterestPaymentStatementV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *InterestPaymentStatementV04) Validate() error {
+	return model.ValidateElement(i)
+}
+
 func (i *InterestPaymentStatementV04) SetTransactionIdentification(value string) {
 	i.TransactionIdentification = (*model.Max35Text)(&value)
 }
@@ -16,6 +16,41 @@ func (d *Document00900103) AddMessage() *MarginCallDisputeNotificationV03 {
 	return d.Message
 }
 
+func (d *Document00900103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.009.001.03"
+}
+
+func (d *Document00900103) MessageDefinitionIdentifier() string {
+	return "colr.009.001.03"
+}
+
+func (d *Document00900103) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document00900103) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900103) Variant() string {
+	return "001"
+}
+
+func (d *Document00900103) Version() string {
+	return "03"
+}
+
+func (d *Document00900103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The MarginCallDisputeNotification message is sent by the collateral taker or its collateral manager to the collateral giver or its collateral manager to acknowledge the notification of the dispute (either full or partial dispute) of the MarginCallRequest. The message will detail the amount of the dispute and the reason.
 //
@@ -38,6 +73,10 @@ type MarginCallDisputeNotificationV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MarginCallDisputeNotificationV03) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MarginCallDisputeNotificationV03) SetTransactionIdentification(value string) {
 	m.TransactionIdentification = (*model.Max35Text)(&value)
 }
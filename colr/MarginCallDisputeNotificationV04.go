@@ -16,6 +16,41 @@ func (d *Document00900104) AddMessage() *MarginCallDisputeNotificationV04 {
 	return d.Message
 }
 
+func (d *Document00900104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.009.001.04"
+}
+
+func (d *Document00900104) MessageDefinitionIdentifier() string {
+	return "colr.009.001.04"
+}
+
+func (d *Document00900104) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document00900104) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900104) Variant() string {
+	return "001"
+}
+
+func (d *Document00900104) Version() string {
+	return "04"
+}
+
+func (d *Document00900104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The MarginCallDisputeNotification message is sent by the collateral taker or its collateral manager to the collateral giver or its collateral manager to acknowledge the notification of the dispute (either full or partial dispute) of the MarginCallRequest. The message will detail the amount of the dispute and the reason.
 //
@@ -38,6 +73,10 @@ type MarginCallDisputeNotificationV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MarginCallDisputeNotificationV04) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MarginCallDisputeNotificationV04) SetTransactionIdentification(value string) {
 	m.TransactionIdentification = (*model.Max35Text)(&value)
 }
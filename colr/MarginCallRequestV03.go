@@ -16,6 +16,41 @@ func (d *Document00300103) AddMessage() *MarginCallRequestV03 {
 	return d.Message
 }
 
+func (d *Document00300103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.003.001.03"
+}
+
+func (d *Document00300103) MessageDefinitionIdentifier() string {
+	return "colr.003.001.03"
+}
+
+func (d *Document00300103) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document00300103) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300103) Variant() string {
+	return "001"
+}
+
+func (d *Document00300103) Version() string {
+	return "03"
+}
+
+func (d *Document00300103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The MarginCallRequest message is sent by the collateral taker or its collateral manager to the collateral giver or its collateral manager
 // This message is used to request new collateral at the initiation of an exposure or request additional collateral for an existing exposure. It can also be used to recall collateral upon the collateral giver or its collateral manager's request.
@@ -64,6 +99,10 @@ type MarginCallRequestV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MarginCallRequestV03) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MarginCallRequestV03) SetTransactionIdentification(value string) {
 	m.TransactionIdentification = (*model.Max35Text)(&value)
 }
@@ -16,6 +16,41 @@ func (d *Document00300104) AddMessage() *MarginCallRequestV04 {
 	return d.Message
 }
 
+func (d *Document00300104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.003.001.04"
+}
+
+func (d *Document00300104) MessageDefinitionIdentifier() string {
+	return "colr.003.001.04"
+}
+
+func (d *Document00300104) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document00300104) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300104) Variant() string {
+	return "001"
+}
+
+func (d *Document00300104) Version() string {
+	return "04"
+}
+
+func (d *Document00300104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The MarginCallRequest message is sent by the collateral taker or its collateral manager to the collateral giver or its collateral manager
 // This message is used to request new collateral at the initiation of an exposure or request additional collateral for an existing exposure. It can also be used to recall collateral upon the collateral giver or its collateral manager's request.
@@ -67,6 +102,10 @@ type MarginCallRequestV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MarginCallRequestV04) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MarginCallRequestV04) SetTransactionIdentification(value string) {
 	m.TransactionIdentification = (*model.Max35Text)(&value)
 }
@@ -16,6 +16,41 @@ func (d *Document00400103) AddMessage() *MarginCallResponseV03 {
 	return d.Message
 }
 
+func (d *Document00400103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:colr.004.001.03"
+}
+
+func (d *Document00400103) MessageDefinitionIdentifier() string {
+	return "colr.004.001.03"
+}
+
+func (d *Document00400103) BusinessArea() string {
+	return "colr"
+}
+
+func (d *Document00400103) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400103) Variant() string {
+	return "001"
+}
+
+func (d *Document00400103) Version() string {
+	return "03"
+}
+
+func (d *Document00400103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // This message is sent by the collateral giver or its collateral manager to the collateral taker or its collateral manager or vice versa. This is a response to the MarginCallRequest message. The margin call can be accepted, fully disputed or partially disputed.
 //
@@ -59,6 +94,10 @@ type MarginCallResponseV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MarginCallResponseV03) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MarginCallResponseV03) SetTransactionIdentification(value string) {
 	m.TransactionIdentification = (*model.Max35Text)(&value)
 }
@@ -0,0 +1,221 @@
+// Package decimal implements the exact decimal numbers used for the amounts,
+// rates and quantities of ISO 20022 messages, which are xs:decimal values and
+// must not go through float64.
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Decimal is an exact decimal number, the unscaled integer multiplied by ten to
+// the power of minus the scale. The scale is kept as written, so 1.50 and 1.5
+// are equal but are formatted differently. The zero value is 0.
+//
+// Decimal values are immutable; operations return a new value.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+var ten = big.NewInt(10)
+
+// New returns unscaled * 10^-scale.
+func New(unscaled int64, scale int32) Decimal {
+	return Decimal{unscaled: big.NewInt(unscaled), scale: scale}
+}
+
+// Parse parses a value in the lexical space of xs:decimal, for example
+// "1234.50", "-0.5" or "+.25". Exponents are not allowed.
+func Parse(s string) (Decimal, error) {
+	digits := s
+	negative := false
+	if len(digits) > 0 && (digits[0] == '+' || digits[0] == '-') {
+		negative = digits[0] == '-'
+		digits = digits[1:]
+	}
+	integer, fraction := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		integer, fraction = digits[:i], digits[i+1:]
+	}
+	if integer == "" && fraction == "" || !isDigits(integer) || !isDigits(fraction) {
+		return Decimal{}, fmt.Errorf("decimal: invalid value %q", s)
+	}
+	unscaled, _ := new(big.Int).SetString(integer+fraction, 10)
+	if negative {
+		unscaled.Neg(unscaled)
+	}
+	return Decimal{unscaled: unscaled, scale: int32(len(fraction))}, nil
+}
+
+// MustParse is like Parse but panics if the value cannot be parsed.
+func MustParse(s string) Decimal {
+	d, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// Unscaled returns the unscaled integer of d.
+func (d Decimal) Unscaled() *big.Int {
+	return new(big.Int).Set(d.int())
+}
+
+// Scale returns the number of fraction digits of d.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero reports whether d is zero.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// String formats d in plain notation with exactly Scale fraction digits.
+func (d Decimal) String() string {
+	if d.scale <= 0 {
+		return new(big.Int).Mul(d.int(), pow10(-d.scale)).String()
+	}
+	s := new(big.Int).Abs(d.int()).String()
+	if n := int(d.scale) + 1 - len(s); n > 0 {
+		s = strings.Repeat("0", n) + s
+	}
+	s = s[:len(s)-int(d.scale)] + "." + s[len(s)-int(d.scale):]
+	if d.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// Rat returns d as a big.Rat.
+func (d Decimal) Rat() *big.Rat {
+	r := new(big.Rat).SetInt(d.int())
+	if d.scale > 0 {
+		r.Quo(r, new(big.Rat).SetInt(pow10(d.scale)))
+	} else if d.scale < 0 {
+		r.Mul(r, new(big.Rat).SetInt(pow10(-d.scale)))
+	}
+	return r
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(ten, big.NewInt(int64(n)), nil)
+}
+
+// rescale returns the unscaled integer of d at a scale that is not lower than its own.
+func (d Decimal) rescale(scale int32) *big.Int {
+	return new(big.Int).Mul(d.int(), pow10(scale-d.scale))
+}
+
+func align(x, y Decimal) (*big.Int, *big.Int, int32) {
+	scale := x.scale
+	if y.scale > scale {
+		scale = y.scale
+	}
+	return x.rescale(scale), y.rescale(scale), scale
+}
+
+// Cmp compares d and other and returns -1, 0 or +1.
+func (d Decimal) Cmp(other Decimal) int {
+	x, y, _ := align(d, other)
+	return x.Cmp(y)
+}
+
+// Equal reports whether d and other have the same value, whatever their scale.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// Add returns d + other, at the larger scale of both.
+func (d Decimal) Add(other Decimal) Decimal {
+	x, y, scale := align(d, other)
+	return Decimal{unscaled: x.Add(x, y), scale: scale}
+}
+
+// Sub returns d - other, at the larger scale of both.
+func (d Decimal) Sub(other Decimal) Decimal {
+	x, y, scale := align(d, other)
+	return Decimal{unscaled: x.Sub(x, y), scale: scale}
+}
+
+// Mul returns d * other, at the sum of both scales.
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), other.int()), scale: d.scale + other.scale}
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Abs returns the absolute value of d.
+func (d Decimal) Abs() Decimal {
+	return Decimal{unscaled: new(big.Int).Abs(d.int()), scale: d.scale}
+}
+
+// Round returns d rounded to the given number of fraction digits, rounding
+// half away from zero. Values with fewer fraction digits are padded with zeros.
+func (d Decimal) Round(scale int32) Decimal {
+	if scale >= d.scale {
+		return Decimal{unscaled: d.rescale(scale), scale: scale}
+	}
+	divisor := pow10(d.scale - scale)
+	q, r := new(big.Int).QuoRem(d.int(), divisor, new(big.Int))
+	if r.Abs(r).Lsh(r, 1).Cmp(divisor) >= 0 {
+		q.Add(q, big.NewInt(int64(d.Sign())))
+	}
+	return Decimal{unscaled: q, scale: scale}
+}
+
+// Normalize returns d without trailing fraction zeros.
+func (d Decimal) Normalize() Decimal {
+	unscaled, scale := new(big.Int).Set(d.int()), d.scale
+	r := new(big.Int)
+	for scale > 0 && unscaled.Sign() != 0 {
+		q, _ := new(big.Int).QuoRem(unscaled, ten, r)
+		if r.Sign() != 0 {
+			break
+		}
+		unscaled, scale = q, scale-1
+	}
+	if unscaled.Sign() == 0 {
+		scale = 0
+	}
+	return Decimal{unscaled: unscaled, scale: scale}
+}
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalText(text []byte) error {
+	v, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
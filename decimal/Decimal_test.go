@@ -0,0 +1,218 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	for _, tt := range []struct {
+		value    string
+		unscaled string
+		scale    int32
+		want     string
+	}{
+		{"0", "0", 0, "0"},
+		{"1234.50", "123450", 2, "1234.50"},
+		{"-0.5", "-5", 1, "-0.5"},
+		{"+.25", "25", 2, "0.25"},
+		{"7.", "7", 0, "7"},
+		{"-0", "0", 0, "0"},
+		{"000123.4500", "1234500", 4, "123.4500"},
+		{"12345678901234567890.123456789", "12345678901234567890123456789", 9, "12345678901234567890.123456789"},
+	} {
+		d, err := Parse(tt.value)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.value, err)
+			continue
+		}
+		if got := d.Unscaled().String(); got != tt.unscaled || d.Scale() != tt.scale {
+			t.Errorf("Parse(%q) = %s scale %d, want %s scale %d", tt.value, got, d.Scale(), tt.unscaled, tt.scale)
+		}
+		if got := d.String(); got != tt.want {
+			t.Errorf("Parse(%q).String() = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, value := range []string{
+		"", "+", "-", ".", "+.", "1,5", "1.2.3", "1e3", "1E-2", " 1", "1 ", "--1", "+-1", "0x10", "∞", "NaN", "1_000",
+	} {
+		if d, err := Parse(value); err == nil {
+			t.Errorf("Parse(%q) = %s", value, d)
+		}
+	}
+}
+
+func TestZero(t *testing.T) {
+	var d Decimal
+	if d.String() != "0" || !d.IsZero() || d.Sign() != 0 || d.Scale() != 0 {
+		t.Errorf("zero value %s, scale %d", d, d.Scale())
+	}
+	if got := d.Add(MustParse("1.5")).String(); got != "1.5" {
+		t.Errorf("0 + 1.5 = %s", got)
+	}
+}
+
+func TestString(t *testing.T) {
+	for _, tt := range []struct {
+		d    Decimal
+		want string
+	}{
+		{New(5, 3), "0.005"},
+		{New(-5, 3), "-0.005"},
+		{New(12345, 2), "123.45"},
+		{New(12, -3), "12000"},
+		{New(0, 2), "0.00"},
+	} {
+		if got := tt.d.String(); got != tt.want {
+			t.Errorf("%d scale %d = %s, want %s", tt.d.Unscaled(), tt.d.Scale(), got, tt.want)
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	for _, tt := range []struct {
+		x, y      string
+		sum, diff string
+		product   string
+		cmp       int
+	}{
+		{"1.50", "1.5", "3.00", "0.00", "2.250", 0},
+		{"0.1", "0.2", "0.3", "-0.1", "0.02", -1},
+		{"-10", "2.005", "-7.995", "-12.005", "-20.050", -1},
+		{"999999999999999999.99999", "0.00001", "1000000000000000000.00000", "999999999999999999.99998", "9999999999999.9999999999", 1},
+	} {
+		x, y := MustParse(tt.x), MustParse(tt.y)
+		if got := x.Add(y).String(); got != tt.sum {
+			t.Errorf("%s + %s = %s, want %s", tt.x, tt.y, got, tt.sum)
+		}
+		if got := x.Sub(y).String(); got != tt.diff {
+			t.Errorf("%s - %s = %s, want %s", tt.x, tt.y, got, tt.diff)
+		}
+		if got := x.Mul(y).String(); got != tt.product {
+			t.Errorf("%s * %s = %s, want %s", tt.x, tt.y, got, tt.product)
+		}
+		if got := x.Cmp(y); got != tt.cmp {
+			t.Errorf("Cmp(%s, %s) = %d, want %d", tt.x, tt.y, got, tt.cmp)
+		}
+		if got := x.Equal(y); got != (tt.cmp == 0) {
+			t.Errorf("Equal(%s, %s) = %v", tt.x, tt.y, got)
+		}
+	}
+	if got := MustParse("-1.25").Neg().String(); got != "1.25" {
+		t.Errorf("-(-1.25) = %s", got)
+	}
+	if got := MustParse("-1.25").Abs().String(); got != "1.25" {
+		t.Errorf("|-1.25| = %s", got)
+	}
+	if got := MustParse("0.125").Rat().String(); got != "1/8" {
+		t.Errorf("0.125 as a rational = %s", got)
+	}
+}
+
+// TestRound checks the rounding, half away from zero, to the fraction digits
+// of an amount: 2 for EUR, 0 for JPY, 3 for BHD, and 5 for the fractionDigits
+// of ActiveCurrencyAndAmount.
+func TestRound(t *testing.T) {
+	for _, tt := range []struct {
+		value string
+		scale int32
+		want  string
+	}{
+		{"1.005", 2, "1.01"},
+		{"1.004", 2, "1.00"},
+		{"-1.005", 2, "-1.01"},
+		{"-1.004", 2, "-1.00"},
+		{"2.5", 0, "3"},
+		{"-2.5", 0, "-3"},
+		{"1499.49", 0, "1499"},
+		{"0.0005", 3, "0.001"},
+		{"0.0004", 3, "0.000"},
+		{"1.5", 3, "1.500"},
+		{"123.4567891", 5, "123.45679"},
+		{"9.999995", 5, "10.00000"},
+	} {
+		if got := MustParse(tt.value).Round(tt.scale).String(); got != tt.want {
+			t.Errorf("%s rounded to %d digits = %s, want %s", tt.value, tt.scale, got, tt.want)
+		}
+	}
+}
+
+// TestDigits checks the digits that the fractionDigits and totalDigits facets
+// of xs:decimal count, those of the normalized value, against the limits of
+// ActiveCurrencyAndAmount, 18 total digits and 5 fraction digits.
+func TestDigits(t *testing.T) {
+	const totalDigits, fractionDigits = 18, 5
+	for _, tt := range []struct {
+		value    string
+		fraction int32
+		total    int
+		valid    bool
+	}{
+		{"0", 0, 1, true},
+		{"1.50000", 1, 2, true},
+		{"0.00001", 5, 1, true},
+		{"0.000001", 6, 1, false},
+		{"0.0000010", 6, 1, false},
+		{"1000.000000", 0, 4, true},
+		{"0001234.50", 1, 5, true},
+		{"999999999999999999", 0, 18, true},
+		{"1000000000000000000", 0, 19, false},
+		{"9999999999999.99999", 5, 18, true},
+		{"99999999999999.99999", 5, 19, false},
+	} {
+		d := MustParse(tt.value).Normalize()
+		total := len(d.Abs().Unscaled().String())
+		if d.Scale() != tt.fraction || total != tt.total {
+			t.Errorf("%s: %d fraction digits, %d total digits, want %d and %d", tt.value, d.Scale(), total, tt.fraction, tt.total)
+		}
+		// Trailing integer zeros are counted: Normalize keeps a scale of 0.
+		if valid := d.Scale() <= fractionDigits && total <= totalDigits; valid != tt.valid {
+			t.Errorf("%s within the limits: %v, want %v", tt.value, valid, tt.valid)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	for _, tt := range []struct{ value, want string }{
+		{"1.500", "1.5"},
+		{"-0.000", "0"},
+		{"100", "100"},
+		{"100.00", "100"},
+		{"0.0100", "0.01"},
+	} {
+		if got := MustParse(tt.value).Normalize().String(); got != tt.want {
+			t.Errorf("%s normalized = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	var v struct {
+		Value Decimal `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(`{"value": "1234.50"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(data); got != `{"value":"1234.50"}` {
+		t.Errorf("JSON %s", got)
+	}
+	if err := json.Unmarshal([]byte(`{"value": "1.2e3"}`), &v); err == nil {
+		t.Error("unmarshal of 1.2e3 succeeded")
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParse of an invalid value did not panic")
+		}
+	}()
+	MustParse("1,5")
+}
@@ -0,0 +1,486 @@
+package fastxml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// SyntaxError reports malformed XML.
+type SyntaxError struct {
+	Msg    string
+	Offset int
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("fastxml: %s at offset %d", e.Msg, e.Offset)
+}
+
+// Decoder reads XML from a byte slice, one element at a time. After the start
+// element of an element has been read by Root or Next, its content is read by
+// calling Next until it returns no name, or by one of Text, InnerXML and Skip.
+type Decoder struct {
+	data  []byte
+	pos   int
+	empty bool
+	attrs []attr
+	open  []span
+	end   int
+
+	// ns holds the prefixed namespace declarations of the open elements, those
+	// of the open element i starting at scope[i].
+	ns    []attr
+	scope []int
+}
+
+type span struct {
+	start, end int
+}
+
+type attr struct {
+	name, value span
+}
+
+// NewDecoder returns a decoder reading data.
+func NewDecoder(data []byte) *Decoder {
+	return &Decoder{data: data, open: make([]span, 0, 16), scope: make([]int, 0, 16)}
+}
+
+// Root reads the start element of the root element and checks its name.
+func (d *Decoder) Root(namespace, local string) (xml.Name, error) {
+	for {
+		i := bytes.IndexByte(d.data[d.pos:], '<')
+		if i < 0 || d.pos+i+1 >= len(d.data) {
+			return xml.Name{}, d.syntax("unexpected EOF", len(d.data))
+		}
+		d.pos += i
+		switch d.data[d.pos+1] {
+		case '?':
+			if err := d.skipTo("?>"); err != nil {
+				return xml.Name{}, err
+			}
+			continue
+		case '!':
+			if err := d.skipMarkup(); err != nil {
+				return xml.Name{}, err
+			}
+			continue
+		}
+		if err := d.start(); err != nil {
+			return xml.Name{}, err
+		}
+		raw := d.span(d.open[len(d.open)-1])
+		var prefix []byte
+		name := raw
+		if i := bytes.IndexByte(raw, ':'); i >= 0 {
+			prefix, name = raw[:i], raw[i+1:]
+		}
+		space := ""
+		for _, a := range d.attrs {
+			n := d.span(a.name)
+			if len(prefix) == 0 && string(n) == "xmlns" || len(prefix) > 0 && len(n) == len(prefix)+6 && string(n[:6]) == "xmlns:" && bytes.Equal(n[6:], prefix) {
+				value, err := d.decode(a.value)
+				if err != nil {
+					return xml.Name{}, err
+				}
+				space = value
+			}
+		}
+		if string(name) != local {
+			return xml.Name{}, fmt.Errorf("expected element type <%s> but have <%s>", local, name)
+		}
+		if space != namespace {
+			if space == "" {
+				return xml.Name{}, fmt.Errorf("expected element <%s> in name space %s but have no name space", local, namespace)
+			}
+			return xml.Name{}, fmt.Errorf("expected element <%s> in name space %s but have %s", local, namespace, space)
+		}
+		return xml.Name{Space: space, Local: local}, nil
+	}
+}
+
+// Next reads up to the next child element of the current element and
+// returns its local name. At the end of the current element it returns nil.
+// Character data, comments and processing instructions between the child
+// elements are skipped.
+func (d *Decoder) Next() ([]byte, error) {
+	if d.empty {
+		d.empty = false
+		d.pop()
+		return nil, nil
+	}
+	for {
+		i := bytes.IndexByte(d.data[d.pos:], '<')
+		if i < 0 || d.pos+i+1 >= len(d.data) {
+			return nil, d.syntax("unexpected EOF", len(d.data))
+		}
+		d.pos += i
+		switch d.data[d.pos+1] {
+		case '/':
+			return nil, d.endElement()
+		case '?':
+			if err := d.skipTo("?>"); err != nil {
+				return nil, err
+			}
+		case '!':
+			if err := d.skipMarkup(); err != nil {
+				return nil, err
+			}
+		default:
+			if err := d.start(); err != nil {
+				return nil, err
+			}
+			name := d.span(d.open[len(d.open)-1])
+			if i := bytes.IndexByte(name, ':'); i >= 0 {
+				name = name[i+1:]
+			}
+			return name, nil
+		}
+	}
+}
+
+// Attr returns the value of the attribute of the current element with the
+// given local name, or an empty string if the element has no such attribute.
+func (d *Decoder) Attr(local string) (string, error) {
+	for _, a := range d.attrs {
+		name := d.span(a.name)
+		if i := bytes.IndexByte(name, ':'); i >= 0 {
+			if string(name[:i]) == "xmlns" {
+				continue
+			}
+			name = name[i+1:]
+		}
+		if string(name) == local {
+			return d.decode(a.value)
+		}
+	}
+	return "", nil
+}
+
+// Text reads the rest of the current element and returns its character data.
+// The content of its child elements is skipped.
+func (d *Decoder) Text() (string, error) {
+	if d.empty {
+		d.empty = false
+		d.pop()
+		return "", nil
+	}
+	// Most elements hold plain text followed by their end element.
+	if i := bytes.IndexByte(d.data[d.pos:], '<'); i >= 0 && d.pos+i+1 < len(d.data) && d.data[d.pos+i+1] == '/' {
+		text := d.data[d.pos : d.pos+i]
+		if bytes.IndexByte(text, '&') < 0 && bytes.IndexByte(text, '\r') < 0 {
+			d.pos += i
+			return string(text), d.endElement()
+		}
+	}
+	var b []byte
+	for {
+		i := bytes.IndexByte(d.data[d.pos:], '<')
+		if i < 0 || d.pos+i+1 >= len(d.data) {
+			return "", d.syntax("unexpected EOF", len(d.data))
+		}
+		var err error
+		if b, err = d.appendText(b, span{d.pos, d.pos + i}); err != nil {
+			return "", err
+		}
+		d.pos += i
+		switch {
+		case d.data[d.pos+1] == '/':
+			return string(b), d.endElement()
+		case d.data[d.pos+1] == '?':
+			err = d.skipTo("?>")
+		case bytes.HasPrefix(d.data[d.pos:], []byte("<![CDATA[")):
+			end := bytes.Index(d.data[d.pos+9:], []byte("]]>"))
+			if end < 0 {
+				return "", d.syntax("unexpected EOF in CDATA section", len(d.data))
+			}
+			b = append(b, d.data[d.pos+9:d.pos+9+end]...)
+			d.pos += 9 + end + 3
+		case d.data[d.pos+1] == '!':
+			err = d.skipMarkup()
+		default:
+			if err = d.start(); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+// Namespaces returns the prefixed namespace declarations in scope at the
+// current element, made by the element or its ancestors, as attributes in the
+// xmlns space. The default namespace is not included.
+func (d *Decoder) Namespaces() ([]xml.Attr, error) {
+	var ns []xml.Attr
+	seen := map[string]bool{}
+	for i := len(d.ns) - 1; i >= 0; i-- {
+		prefix := string(d.span(d.ns[i].name)[6:])
+		if seen[prefix] {
+			continue
+		}
+		seen[prefix] = true
+		value, err := d.decode(d.ns[i].value)
+		if err != nil {
+			return nil, err
+		}
+		ns = append(ns, xml.Attr{Name: xml.Name{Space: "xmlns", Local: prefix}, Value: value})
+	}
+	for i, j := 0, len(ns)-1; i < j; i, j = i+1, j-1 {
+		ns[i], ns[j] = ns[j], ns[i]
+	}
+	return ns, nil
+}
+
+// InnerXML reads the rest of the current element and returns its content as is.
+func (d *Decoder) InnerXML() (string, error) {
+	if d.empty {
+		d.empty = false
+		d.pop()
+		return "", nil
+	}
+	start := d.pos
+	if err := d.Skip(); err != nil {
+		return "", err
+	}
+	return string(d.data[start:d.end]), nil
+}
+
+// Skip reads the rest of the current element.
+func (d *Decoder) Skip() error {
+	for {
+		name, err := d.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		if err := d.Skip(); err != nil {
+			return err
+		}
+	}
+}
+
+// start reads the start element at the current position.
+func (d *Decoder) start() error {
+	d.pos++
+	name, err := d.name()
+	if err != nil {
+		return err
+	}
+	d.attrs = d.attrs[:0]
+	for {
+		d.space()
+		if d.pos >= len(d.data) {
+			return d.syntax("unexpected EOF", d.pos)
+		}
+		switch d.data[d.pos] {
+		case '>':
+			d.pos++
+			d.push(name)
+			return nil
+		case '/':
+			if d.pos+1 >= len(d.data) || d.data[d.pos+1] != '>' {
+				return d.syntax("expected /> in element", d.pos)
+			}
+			d.pos += 2
+			d.push(name)
+			d.empty = true
+			return nil
+		}
+		var a attr
+		if a.name, err = d.name(); err != nil {
+			return err
+		}
+		d.space()
+		if d.pos >= len(d.data) || d.data[d.pos] != '=' {
+			return d.syntax("attribute name without = in element", d.pos)
+		}
+		d.pos++
+		d.space()
+		if d.pos >= len(d.data) || d.data[d.pos] != '"' && d.data[d.pos] != '\'' {
+			return d.syntax("unquoted or missing attribute value in element", d.pos)
+		}
+		end := bytes.IndexByte(d.data[d.pos+1:], d.data[d.pos])
+		if end < 0 {
+			return d.syntax("unexpected EOF", len(d.data))
+		}
+		a.value = span{d.pos + 1, d.pos + 1 + end}
+		d.pos += end + 2
+		d.attrs = append(d.attrs, a)
+	}
+}
+
+// endElement reads the end element at the current position, which must close the current element.
+func (d *Decoder) endElement() error {
+	d.end = d.pos
+	d.pos += 2
+	name, err := d.name()
+	if err != nil {
+		return err
+	}
+	d.space()
+	if d.pos >= len(d.data) || d.data[d.pos] != '>' {
+		return d.syntax("invalid characters between </"+string(d.span(name))+" and >", d.pos)
+	}
+	d.pos++
+	if len(d.open) == 0 {
+		return d.syntax("unexpected end element </"+string(d.span(name))+">", d.end)
+	}
+	if open := d.open[len(d.open)-1]; !bytes.Equal(d.span(open), d.span(name)) {
+		return d.syntax("element <"+string(d.span(open))+"> closed by </"+string(d.span(name))+">", d.end)
+	}
+	d.pop()
+	return nil
+}
+
+// push opens the element name, whose attributes have just been read.
+func (d *Decoder) push(name span) {
+	d.scope = append(d.scope, len(d.ns))
+	for _, a := range d.attrs {
+		if n := d.span(a.name); len(n) > 6 && string(n[:6]) == "xmlns:" {
+			d.ns = append(d.ns, a)
+		}
+	}
+	d.open = append(d.open, name)
+}
+
+// pop closes the current element.
+func (d *Decoder) pop() {
+	d.ns = d.ns[:d.scope[len(d.scope)-1]]
+	d.scope = d.scope[:len(d.scope)-1]
+	d.open = d.open[:len(d.open)-1]
+}
+
+func (d *Decoder) name() (span, error) {
+	start := d.pos
+	for d.pos < len(d.data) {
+		switch d.data[d.pos] {
+		case ' ', '\t', '\n', '\r', '>', '/', '=':
+			if d.pos == start {
+				return span{}, d.syntax("expected element name after <", d.pos)
+			}
+			return span{start, d.pos}, nil
+		}
+		d.pos++
+	}
+	return span{}, d.syntax("unexpected EOF", d.pos)
+}
+
+func (d *Decoder) space() {
+	for d.pos < len(d.data) {
+		switch d.data[d.pos] {
+		case ' ', '\t', '\n', '\r':
+			d.pos++
+		default:
+			return
+		}
+	}
+}
+
+// skipMarkup skips a comment, a CDATA section or a document type declaration.
+func (d *Decoder) skipMarkup() error {
+	switch {
+	case bytes.HasPrefix(d.data[d.pos:], []byte("<!--")):
+		return d.skipTo("-->")
+	case bytes.HasPrefix(d.data[d.pos:], []byte("<![CDATA[")):
+		return d.skipTo("]]>")
+	}
+	depth := 0
+	for i := d.pos + 2; i < len(d.data); i++ {
+		switch d.data[i] {
+		case '<':
+			depth++
+		case '>':
+			if depth == 0 {
+				d.pos = i + 1
+				return nil
+			}
+			depth--
+		}
+	}
+	return d.syntax("unexpected EOF", len(d.data))
+}
+
+func (d *Decoder) skipTo(end string) error {
+	i := bytes.Index(d.data[d.pos:], []byte(end))
+	if i < 0 {
+		return d.syntax("unexpected EOF", len(d.data))
+	}
+	d.pos += i + len(end)
+	return nil
+}
+
+func (d *Decoder) span(s span) []byte {
+	return d.data[s.start:s.end]
+}
+
+// decode returns the text of s with its references replaced.
+func (d *Decoder) decode(s span) (string, error) {
+	text := d.span(s)
+	if bytes.IndexByte(text, '&') < 0 && bytes.IndexByte(text, '\r') < 0 {
+		return string(text), nil
+	}
+	b, err := d.appendText(nil, s)
+	return string(b), err
+}
+
+// appendText appends the text of s to b with its references replaced and its
+// line endings normalized, as encoding/xml does.
+func (d *Decoder) appendText(b []byte, s span) ([]byte, error) {
+	text := d.span(s)
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; c {
+		case '\r':
+			if i+1 < len(text) && text[i+1] == '\n' {
+				continue
+			}
+			b = append(b, '\n')
+		case '&':
+			end := bytes.IndexByte(text[i:], ';')
+			if end < 0 {
+				return nil, d.syntax("invalid character entity &"+string(text[i+1:]), s.start+i)
+			}
+			entity := string(text[i+1 : i+end])
+			switch entity {
+			case "lt":
+				b = append(b, '<')
+			case "gt":
+				b = append(b, '>')
+			case "amp":
+				b = append(b, '&')
+			case "apos":
+				b = append(b, '\'')
+			case "quot":
+				b = append(b, '"')
+			default:
+				var n uint64
+				var err error
+				switch {
+				case len(entity) > 2 && entity[:2] == "#x":
+					n, err = strconv.ParseUint(entity[2:], 16, 32)
+				case len(entity) > 1 && entity[0] == '#':
+					n, err = strconv.ParseUint(entity[1:], 10, 32)
+				default:
+					err = strconv.ErrSyntax
+				}
+				if err != nil || !inCharacterRange(rune(n)) {
+					return nil, d.syntax("invalid character entity &"+entity+";", s.start+i)
+				}
+				b = utf8.AppendRune(b, rune(n))
+			}
+			i += end
+		default:
+			b = append(b, c)
+		}
+	}
+	return b, nil
+}
+
+func (d *Decoder) syntax(msg string, offset int) error {
+	return &SyntaxError{Msg: msg, Offset: offset}
+}
@@ -0,0 +1,140 @@
+// Package fastxml holds the encoder and decoder used by the generated
+// MarshalXMLFast and UnmarshalXMLFast methods of the Documents of the most
+// used message definitions. The generated code writes and reads the elements
+// of every message building block directly, without the reflection of
+// encoding/xml, and produces the same XML as xml.Marshal.
+package fastxml
+
+import (
+	"unicode/utf8"
+)
+
+// Encoder appends XML to a byte slice.
+type Encoder struct {
+	b []byte
+}
+
+// NewEncoder returns an encoder appending to b.
+func NewEncoder(b []byte) *Encoder {
+	return &Encoder{b: b}
+}
+
+// Bytes returns the XML written so far.
+func (e *Encoder) Bytes() []byte {
+	return e.b
+}
+
+// Root writes the start element of a root element in the given namespace.
+func (e *Encoder) Root(namespace, tag string) {
+	e.b = append(e.b, '<')
+	e.b = append(e.b, tag...)
+	e.b = append(e.b, ` xmlns="`...)
+	e.escape(namespace)
+	e.b = append(e.b, '"', '>')
+}
+
+// Start writes the start element tag.
+func (e *Encoder) Start(tag string) {
+	e.b = append(e.b, '<')
+	e.b = append(e.b, tag...)
+	e.b = append(e.b, '>')
+}
+
+// Open writes the start element tag without closing it, so that its attributes can follow.
+func (e *Encoder) Open(tag string) {
+	e.b = append(e.b, '<')
+	e.b = append(e.b, tag...)
+}
+
+// Attr writes an attribute of the element opened by Open.
+func (e *Encoder) Attr(name, value string) {
+	e.b = append(e.b, ' ')
+	e.b = append(e.b, name...)
+	e.b = append(e.b, '=', '"')
+	e.escape(value)
+	e.b = append(e.b, '"')
+}
+
+// Close closes the start element opened by Open.
+func (e *Encoder) Close() {
+	e.b = append(e.b, '>')
+}
+
+// End writes the end element tag.
+func (e *Encoder) End(tag string) {
+	e.b = append(e.b, '<', '/')
+	e.b = append(e.b, tag...)
+	e.b = append(e.b, '>')
+}
+
+// Element writes an element holding the text value.
+func (e *Encoder) Element(tag, value string) {
+	e.Start(tag)
+	e.escape(value)
+	e.End(tag)
+}
+
+// Text writes character data.
+func (e *Encoder) Text(value string) {
+	e.escape(value)
+}
+
+// Raw writes XML as is.
+func (e *Encoder) Raw(xml string) {
+	e.b = append(e.b, xml...)
+}
+
+// escape writes s escaped as xml.EscapeText does.
+func (e *Encoder) escape(s string) {
+	last := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c >= 0x20 && c < utf8.RuneSelf && c != '"' && c != '\'' && c != '&' && c != '<' && c != '>' {
+			i++
+			continue
+		}
+		r, width := rune(c), 1
+		if c >= utf8.RuneSelf {
+			r, width = utf8.DecodeRuneInString(s[i:])
+		}
+		var esc string
+		switch r {
+		case '"':
+			esc = "&#34;"
+		case '\'':
+			esc = "&#39;"
+		case '&':
+			esc = "&amp;"
+		case '<':
+			esc = "&lt;"
+		case '>':
+			esc = "&gt;"
+		case '\t':
+			esc = "&#x9;"
+		case '\n':
+			esc = "&#xA;"
+		case '\r':
+			esc = "&#xD;"
+		default:
+			if inCharacterRange(r) && (r != utf8.RuneError || width != 1) {
+				i += width
+				continue
+			}
+			esc = "\uFFFD"
+		}
+		e.b = append(e.b, s[last:i]...)
+		e.b = append(e.b, esc...)
+		i += width
+		last = i
+	}
+	e.b = append(e.b, s[last:]...)
+}
+
+func inCharacterRange(r rune) bool {
+	return r == 0x09 ||
+		r == 0x0A ||
+		r == 0x0D ||
+		r >= 0x20 && r <= 0xD7FF ||
+		r >= 0xE000 && r <= 0xFFFD ||
+		r >= 0x10000 && r <= 0x10FFFF
+}
@@ -16,6 +16,41 @@ func (d *Document03000103) AddMessage() *ForeignExchangeTradeBulkStatusNotificat
 	return d.Message
 }
 
+func (d *Document03000103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:fxtr.030.001.03"
+}
+
+func (d *Document03000103) MessageDefinitionIdentifier() string {
+	return "fxtr.030.001.03"
+}
+
+func (d *Document03000103) BusinessArea() string {
+	return "fxtr"
+}
+
+func (d *Document03000103) MessageFunctionality() string {
+	return "030"
+}
+
+func (d *Document03000103) Variant() string {
+	return "001"
+}
+
+func (d *Document03000103) Version() string {
+	return "03"
+}
+
+func (d *Document03000103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03000103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ForeignExchangeTradeBulkStatusNotification message is sent by a central system to the participant to provide notification of the current status of one or more foreign exchange trades.
 type ForeignExchangeTradeBulkStatusNotificationV03 struct {
@@ -35,6 +70,10 @@ type ForeignExchangeTradeBulkStatusNotificationV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *ForeignExchangeTradeBulkStatusNotificationV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *ForeignExchangeTradeBulkStatusNotificationV03) AddStatusDetails() *model.TradeData10 {
 	f.StatusDetails = new(model.TradeData10)
 	return f.StatusDetails
@@ -16,6 +16,41 @@ func (d *Document03000104) AddMessage() *ForeignExchangeTradeBulkStatusNotificat
 	return d.Message
 }
 
+func (d *Document03000104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:fxtr.030.001.04"
+}
+
+func (d *Document03000104) MessageDefinitionIdentifier() string {
+	return "fxtr.030.001.04"
+}
+
+func (d *Document03000104) BusinessArea() string {
+	return "fxtr"
+}
+
+func (d *Document03000104) MessageFunctionality() string {
+	return "030"
+}
+
+func (d *Document03000104) Variant() string {
+	return "001"
+}
+
+func (d *Document03000104) Version() string {
+	return "04"
+}
+
+func (d *Document03000104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document03000104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ForeignExchangeTradeBulkStatusNotification message is sent by a central system to the participant to provide notification of the current status of one or more foreign exchange trades.
 type ForeignExchangeTradeBulkStatusNotificationV04 struct {
@@ -35,6 +70,10 @@ type ForeignExchangeTradeBulkStatusNotificationV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *ForeignExchangeTradeBulkStatusNotificationV04) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *ForeignExchangeTradeBulkStatusNotificationV04) AddStatusDetails() *model.TradeData12 {
 	f.StatusDetails = new(model.TradeData12)
 	return f.StatusDetails
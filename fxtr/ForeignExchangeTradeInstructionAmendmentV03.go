@@ -16,6 +16,41 @@ func (d *Document01500103) AddMessage() *ForeignExchangeTradeInstructionAmendmen
 	return d.Message
 }
 
+func (d *Document01500103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:fxtr.015.001.03"
+}
+
+func (d *Document01500103) MessageDefinitionIdentifier() string {
+	return "fxtr.015.001.03"
+}
+
+func (d *Document01500103) BusinessArea() string {
+	return "fxtr"
+}
+
+func (d *Document01500103) MessageFunctionality() string {
+	return "015"
+}
+
+func (d *Document01500103) Variant() string {
+	return "001"
+}
+
+func (d *Document01500103) Version() string {
+	return "03"
+}
+
+func (d *Document01500103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01500103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ForeignExchangeTradeInstructionAmendment message is sent by a participant to a central settlement system to notify the amendment of the foreign exchange trade previously confirmed by the sender.
 // Usage
@@ -53,6 +88,10 @@ type ForeignExchangeTradeInstructionAmendmentV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *ForeignExchangeTradeInstructionAmendmentV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *ForeignExchangeTradeInstructionAmendmentV03) AddTradeInformation() *model.TradeAgreement11 {
 	f.TradeInformation = new(model.TradeAgreement11)
 	return f.TradeInformation
@@ -16,6 +16,41 @@ func (d *Document01500104) AddMessage() *ForeignExchangeTradeInstructionAmendmen
 	return d.Message
 }
 
+func (d *Document01500104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:fxtr.015.001.04"
+}
+
+func (d *Document01500104) MessageDefinitionIdentifier() string {
+	return "fxtr.015.001.04"
+}
+
+func (d *Document01500104) BusinessArea() string {
+	return "fxtr"
+}
+
+func (d *Document01500104) MessageFunctionality() string {
+	return "015"
+}
+
+func (d *Document01500104) Variant() string {
+	return "001"
+}
+
+func (d *Document01500104) Version() string {
+	return "04"
+}
+
+func (d *Document01500104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01500104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ForeignExchangeTradeInstructionAmendment message is sent by a participant to a central settlement system to notify the amendment of the foreign exchange trade previously confirmed by the sender.
 // Usage
@@ -56,6 +91,10 @@ type ForeignExchangeTradeInstructionAmendmentV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *ForeignExchangeTradeInstructionAmendmentV04) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *ForeignExchangeTradeInstructionAmendmentV04) AddTradeInformation() *model.TradeAgreement15 {
 	f.TradeInformation = new(model.TradeAgreement15)
 	return f.TradeInformation
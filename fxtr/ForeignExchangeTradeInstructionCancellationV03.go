@@ -16,6 +16,41 @@ func (d *Document01600103) AddMessage() *ForeignExchangeTradeInstructionCancella
 	return d.Message
 }
 
+func (d *Document01600103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:fxtr.016.001.03"
+}
+
+func (d *Document01600103) MessageDefinitionIdentifier() string {
+	return "fxtr.016.001.03"
+}
+
+func (d *Document01600103) BusinessArea() string {
+	return "fxtr"
+}
+
+func (d *Document01600103) MessageFunctionality() string {
+	return "016"
+}
+
+func (d *Document01600103) Variant() string {
+	return "001"
+}
+
+func (d *Document01600103) Version() string {
+	return "03"
+}
+
+func (d *Document01600103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01600103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ForeignExchangeTradeInstructionCancellation message is sent by a participant to a central settlement system to notify the cancellation of the foreign exchange trade previously confirmed by the sender.
 // Usage
@@ -53,6 +88,10 @@ type ForeignExchangeTradeInstructionCancellationV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *ForeignExchangeTradeInstructionCancellationV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *ForeignExchangeTradeInstructionCancellationV03) AddTradeInformation() *model.TradeAgreement11 {
 	f.TradeInformation = new(model.TradeAgreement11)
 	return f.TradeInformation
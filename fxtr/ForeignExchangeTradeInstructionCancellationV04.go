@@ -16,6 +16,41 @@ func (d *Document01600104) AddMessage() *ForeignExchangeTradeInstructionCancella
 	return d.Message
 }
 
+func (d *Document01600104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:fxtr.016.001.04"
+}
+
+func (d *Document01600104) MessageDefinitionIdentifier() string {
+	return "fxtr.016.001.04"
+}
+
+func (d *Document01600104) BusinessArea() string {
+	return "fxtr"
+}
+
+func (d *Document01600104) MessageFunctionality() string {
+	return "016"
+}
+
+func (d *Document01600104) Variant() string {
+	return "001"
+}
+
+func (d *Document01600104) Version() string {
+	return "04"
+}
+
+func (d *Document01600104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01600104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ForeignExchangeTradeInstructionCancellation message is sent by a participant to a central settlement system to notify the cancellation of the foreign exchange trade previously confirmed by the sender.
 // Usage
@@ -56,6 +91,10 @@ type ForeignExchangeTradeInstructionCancellationV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *ForeignExchangeTradeInstructionCancellationV04) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *ForeignExchangeTradeInstructionCancellationV04) AddTradeInformation() *model.TradeAgreement15 {
 	f.TradeInformation = new(model.TradeAgreement15)
 	return f.TradeInformation
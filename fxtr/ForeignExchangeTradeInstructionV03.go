@@ -16,6 +16,41 @@ func (d *Document01400103) AddMessage() *ForeignExchangeTradeInstructionV03 {
 	return d.Message
 }
 
+func (d *Document01400103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:fxtr.014.001.03"
+}
+
+func (d *Document01400103) MessageDefinitionIdentifier() string {
+	return "fxtr.014.001.03"
+}
+
+func (d *Document01400103) BusinessArea() string {
+	return "fxtr"
+}
+
+func (d *Document01400103) MessageFunctionality() string {
+	return "014"
+}
+
+func (d *Document01400103) Variant() string {
+	return "001"
+}
+
+func (d *Document01400103) Version() string {
+	return "03"
+}
+
+func (d *Document01400103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01400103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ForeignExchangeTradeInstruction message is sent by a participant to a central settlement system to notify the creation of the foreign exchange trade agreed by both trading parties.
 // Usage
@@ -53,6 +88,10 @@ type ForeignExchangeTradeInstructionV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *ForeignExchangeTradeInstructionV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *ForeignExchangeTradeInstructionV03) AddTradeInformation() *model.TradeAgreement10 {
 	f.TradeInformation = new(model.TradeAgreement10)
 	return f.TradeInformation
@@ -16,6 +16,41 @@ func (d *Document01400104) AddMessage() *ForeignExchangeTradeInstructionV04 {
 	return d.Message
 }
 
+func (d *Document01400104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:fxtr.014.001.04"
+}
+
+func (d *Document01400104) MessageDefinitionIdentifier() string {
+	return "fxtr.014.001.04"
+}
+
+func (d *Document01400104) BusinessArea() string {
+	return "fxtr"
+}
+
+func (d *Document01400104) MessageFunctionality() string {
+	return "014"
+}
+
+func (d *Document01400104) Variant() string {
+	return "001"
+}
+
+func (d *Document01400104) Version() string {
+	return "04"
+}
+
+func (d *Document01400104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01400104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ForeignExchangeTradeInstruction message is sent by a participant to a central settlement system to notify the creation of the foreign exchange trade agreed by both trading parties.
 // Usage
@@ -56,6 +91,10 @@ type ForeignExchangeTradeInstructionV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *ForeignExchangeTradeInstructionV04) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *ForeignExchangeTradeInstructionV04) AddTradeInformation() *model.TradeAgreement14 {
 	f.TradeInformation = new(model.TradeAgreement14)
 	return f.TradeInformation
@@ -16,6 +16,41 @@ func (d *Document01700103) AddMessage() *ForeignExchangeTradeStatusAndDetailsNot
 	return d.Message
 }
 
+func (d *Document01700103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:fxtr.017.001.03"
+}
+
+func (d *Document01700103) MessageDefinitionIdentifier() string {
+	return "fxtr.017.001.03"
+}
+
+func (d *Document01700103) BusinessArea() string {
+	return "fxtr"
+}
+
+func (d *Document01700103) MessageFunctionality() string {
+	return "017"
+}
+
+func (d *Document01700103) Variant() string {
+	return "001"
+}
+
+func (d *Document01700103) Version() string {
+	return "03"
+}
+
+func (d *Document01700103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01700103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ForeignExchangeTradeStatusAndDetails message is sent by a central system to the participant to provide notification of the status and details of a foreign exchange trade.
 // Usage
@@ -59,6 +94,10 @@ type ForeignExchangeTradeStatusAndDetailsNotificationV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *ForeignExchangeTradeStatusAndDetailsNotificationV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *ForeignExchangeTradeStatusAndDetailsNotificationV03) AddStatusDetails() *model.TradeData9 {
 	f.StatusDetails = new(model.TradeData9)
 	return f.StatusDetails
@@ -16,6 +16,41 @@ func (d *Document01700104) AddMessage() *ForeignExchangeTradeStatusAndDetailsNot
 	return d.Message
 }
 
+func (d *Document01700104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:fxtr.017.001.04"
+}
+
+func (d *Document01700104) MessageDefinitionIdentifier() string {
+	return "fxtr.017.001.04"
+}
+
+func (d *Document01700104) BusinessArea() string {
+	return "fxtr"
+}
+
+func (d *Document01700104) MessageFunctionality() string {
+	return "017"
+}
+
+func (d *Document01700104) Variant() string {
+	return "001"
+}
+
+func (d *Document01700104) Version() string {
+	return "04"
+}
+
+func (d *Document01700104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01700104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ForeignExchangeTradeStatusAndDetails message is sent by a central system to the participant to provide notification of the status and details of a foreign exchange trade.
 // Usage
@@ -62,6 +97,10 @@ type ForeignExchangeTradeStatusAndDetailsNotificationV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *ForeignExchangeTradeStatusAndDetailsNotificationV04) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *ForeignExchangeTradeStatusAndDetailsNotificationV04) AddStatusDetails() *model.TradeData14 {
 	f.StatusDetails = new(model.TradeData14)
 	return f.StatusDetails
@@ -16,6 +16,41 @@ func (d *Document00800105) AddMessage() *ForeignExchangeTradeStatusNotificationV
 	return d.Message
 }
 
+func (d *Document00800105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:fxtr.008.001.05"
+}
+
+func (d *Document00800105) MessageDefinitionIdentifier() string {
+	return "fxtr.008.001.05"
+}
+
+func (d *Document00800105) BusinessArea() string {
+	return "fxtr"
+}
+
+func (d *Document00800105) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800105) Variant() string {
+	return "001"
+}
+
+func (d *Document00800105) Version() string {
+	return "05"
+}
+
+func (d *Document00800105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ForeignExchangeTradeStatusNotification message is sent by a central system to the participant to notify the current status of a foreign exchange trade in the system.
 // Usage
@@ -32,6 +67,10 @@ type ForeignExchangeTradeStatusNotificationV05 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *ForeignExchangeTradeStatusNotificationV05) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *ForeignExchangeTradeStatusNotificationV05) AddTradeData() *model.TradeData7 {
 	f.TradeData = new(model.TradeData7)
 	return f.TradeData
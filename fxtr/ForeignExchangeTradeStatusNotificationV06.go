@@ -16,6 +16,41 @@ func (d *Document00800106) AddMessage() *ForeignExchangeTradeStatusNotificationV
 	return d.Message
 }
 
+func (d *Document00800106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:fxtr.008.001.06"
+}
+
+func (d *Document00800106) MessageDefinitionIdentifier() string {
+	return "fxtr.008.001.06"
+}
+
+func (d *Document00800106) BusinessArea() string {
+	return "fxtr"
+}
+
+func (d *Document00800106) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800106) Variant() string {
+	return "001"
+}
+
+func (d *Document00800106) Version() string {
+	return "06"
+}
+
+func (d *Document00800106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800106) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ForeignExchangeTradeStatusNotification message is sent by a central system to the participant to notify the current status of a foreign exchange trade in the system.
 // Usage
@@ -32,6 +67,10 @@ type ForeignExchangeTradeStatusNotificationV06 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *ForeignExchangeTradeStatusNotificationV06) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *ForeignExchangeTradeStatusNotificationV06) AddTradeData() *model.TradeData15 {
 	f.TradeData = new(model.TradeData15)
 	return f.TradeData
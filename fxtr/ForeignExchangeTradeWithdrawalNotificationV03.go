@@ -16,6 +16,41 @@ func (d *Document01300103) AddMessage() *ForeignExchangeTradeWithdrawalNotificat
 	return d.Message
 }
 
+func (d *Document01300103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:fxtr.013.001.03"
+}
+
+func (d *Document01300103) MessageDefinitionIdentifier() string {
+	return "fxtr.013.001.03"
+}
+
+func (d *Document01300103) BusinessArea() string {
+	return "fxtr"
+}
+
+func (d *Document01300103) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300103) Variant() string {
+	return "001"
+}
+
+func (d *Document01300103) Version() string {
+	return "03"
+}
+
+func (d *Document01300103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The ForeignExchangeTradeWithdrawalNotification message is sent by a central system to notify the withdrawal of a foreign exchange trade which was previously notified to the receiver as an alleged trade.
 // Usage
@@ -38,6 +73,10 @@ type ForeignExchangeTradeWithdrawalNotificationV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *ForeignExchangeTradeWithdrawalNotificationV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *ForeignExchangeTradeWithdrawalNotificationV03) SetMessageIdentification(value string) {
 	f.MessageIdentification = (*model.Max35Text)(&value)
 }
@@ -2,10 +2,16 @@ package head
 
 import (
 	"encoding/xml"
+	"fmt"
+	"time"
 
 	"github.com/yudaprama/iso20022/model"
 )
 
+// Document00100101 wraps the header in a Document element, which is not the
+// layout defined by the head.001.001.01 schema.
+//
+// Deprecated: Use AppHdr00100101, whose root element is AppHdr.
 type Document00100101 struct {
 	XMLName xml.Name                      `xml:"urn:iso:std:iso:20022:tech:xsd:head.001.001.01 Document"`
 	Message *BusinessApplicationHeaderV01 `xml:"AppHdr"`
@@ -16,6 +22,101 @@ func (d *Document00100101) AddMessage() *BusinessApplicationHeaderV01 {
 	return d.Message
 }
 
+func (d *Document00100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:head.001.001.01"
+}
+
+func (d *Document00100101) MessageDefinitionIdentifier() string {
+	return "head.001.001.01"
+}
+
+func (d *Document00100101) BusinessArea() string {
+	return "head"
+}
+
+func (d *Document00100101) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100101) Variant() string {
+	return "001"
+}
+
+func (d *Document00100101) Version() string {
+	return "01"
+}
+
+func (d *Document00100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
+// AppHdr00100101 is the root element of a head.001.001.01 Business Application Header.
+// Unlike Document00100101, the header is not wrapped in a Document element.
+type AppHdr00100101 struct {
+	XMLName xml.Name `xml:"urn:iso:std:iso:20022:tech:xsd:head.001.001.01 AppHdr"`
+	// The elements of Message are the children of the AppHdr element.
+	Message BusinessApplicationHeaderV01 `xml:",inline"`
+}
+
+func (a *AppHdr00100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:head.001.001.01"
+}
+
+func (a *AppHdr00100101) MessageDefinitionIdentifier() string {
+	return "head.001.001.01"
+}
+
+func (a *AppHdr00100101) BusinessArea() string {
+	return "head"
+}
+
+func (a *AppHdr00100101) MessageFunctionality() string {
+	return "001"
+}
+
+func (a *AppHdr00100101) Variant() string {
+	return "001"
+}
+
+func (a *AppHdr00100101) Version() string {
+	return "01"
+}
+
+func (a *AppHdr00100101) Body() interface{} {
+	return &a.Message
+}
+
+func (a *AppHdr00100101) BusinessMessageDefinitionIdentifier() string {
+	if a.Message.MessageDefinitionIdentifier == nil {
+		return ""
+	}
+	return string(*a.Message.MessageDefinitionIdentifier)
+}
+
+func (a *AppHdr00100101) Validate() error {
+	return model.ValidateElement(a)
+}
+
+func (a *AppHdr00100101) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Space: a.Namespace(), Local: "AppHdr"}
+	return e.EncodeElement(&a.Message, start)
+}
+
+func (a *AppHdr00100101) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if start.Name.Space != a.Namespace() || start.Name.Local != "AppHdr" {
+		return fmt.Errorf("head: expected element AppHdr in namespace %s but have %s in namespace %s", a.Namespace(), start.Name.Local, start.Name.Space)
+	}
+	a.XMLName = start.Name
+	return d.DecodeElement(&a.Message, &start)
+}
+
 // The Business Layer deals with Business Messages. The behaviour of the Business Messages is fully described by the Business Transaction and the structure of the Business Messages is fully described by the Message Definitions and related Message Rules, Rules and Market Practices. All of which are registered in the ISO 20022 Repository.
 // A single new Business Message (with its accompagnying business application header) is created - by the sending MessagingEndpoint - for each business event; that is each interaction in a Business Transaction. A Business Message adheres to the following principles:
 // " A Business Message (and its business application header) must not contain information about the Message Transport System or the mechanics or mechanism of message sending, transportation, or receipt.
@@ -80,6 +181,10 @@ type BusinessApplicationHeaderV01 struct {
 	Related *model.BusinessApplicationHeader1 `xml:"Rltd,omitempty"`
 }
 
+func (b *BusinessApplicationHeaderV01) Validate() error {
+	return model.ValidateElement(b)
+}
+
 func (b *BusinessApplicationHeaderV01) SetCharacterSet(value string) {
 	b.CharacterSet = (*model.UnicodeChartsCode)(&value)
 }
@@ -110,14 +215,28 @@ func (b *BusinessApplicationHeaderV01) SetCreationDate(value string) {
 	b.CreationDate = (*model.ISONormalisedDateTime)(&value)
 }
 
-func (b *BusinessApplicationHeaderV01) SetCopyDuplicate(value string) {
+func (b *BusinessApplicationHeaderV01) SetCreationDateFromTime(value time.Time) {
+	b.CreationDate = new(model.ISONormalisedDateTime)
+	b.CreationDate.FromTime(value)
+}
+
+func (b *BusinessApplicationHeaderV01) SetCopyDuplicate(value string) error {
+	if err := model.CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	b.CopyDuplicate = (*model.CopyDuplicate1Code)(&value)
+	return nil
 }
 
 func (b *BusinessApplicationHeaderV01) SetPossibleDuplicate(value string) {
 	b.PossibleDuplicate = (*model.YesNoIndicator)(&value)
 }
 
+func (b *BusinessApplicationHeaderV01) SetPossibleDuplicateFromBool(value bool) {
+	b.PossibleDuplicate = new(model.YesNoIndicator)
+	b.PossibleDuplicate.FromBool(value)
+}
+
 func (b *BusinessApplicationHeaderV01) SetPriority(value string) {
 	b.Priority = (*model.BusinessMessagePriorityCode)(&value)
 }
@@ -0,0 +1,226 @@
+package head
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/yudaprama/iso20022/model"
+)
+
+// AppHdr00100103 is the root element of a head.001.001.03 Business Application Header.
+type AppHdr00100103 struct {
+	XMLName xml.Name `xml:"urn:iso:std:iso:20022:tech:xsd:head.001.001.03 AppHdr"`
+	// The elements of Message are the children of the AppHdr element.
+	Message BusinessApplicationHeaderV03 `xml:",inline"`
+}
+
+func (a *AppHdr00100103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:head.001.001.03"
+}
+
+func (a *AppHdr00100103) MessageDefinitionIdentifier() string {
+	return "head.001.001.03"
+}
+
+func (a *AppHdr00100103) BusinessArea() string {
+	return "head"
+}
+
+func (a *AppHdr00100103) MessageFunctionality() string {
+	return "001"
+}
+
+func (a *AppHdr00100103) Variant() string {
+	return "001"
+}
+
+func (a *AppHdr00100103) Version() string {
+	return "03"
+}
+
+func (a *AppHdr00100103) Body() interface{} {
+	return &a.Message
+}
+
+func (a *AppHdr00100103) BusinessMessageDefinitionIdentifier() string {
+	if a.Message.MessageDefinitionIdentifier == nil {
+		return ""
+	}
+	return string(*a.Message.MessageDefinitionIdentifier)
+}
+
+func (a *AppHdr00100103) Validate() error {
+	return model.ValidateElement(a)
+}
+
+func (a *AppHdr00100103) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Space: a.Namespace(), Local: "AppHdr"}
+	return e.EncodeElement(&a.Message, start)
+}
+
+func (a *AppHdr00100103) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if start.Name.Space != a.Namespace() || start.Name.Local != "AppHdr" {
+		return fmt.Errorf("head: expected element AppHdr in namespace %s but have %s in namespace %s", a.Namespace(), start.Name.Local, start.Name.Space)
+	}
+	a.XMLName = start.Name
+	return d.DecodeElement(&a.Message, &start)
+}
+
+// The Business Layer deals with Business Messages. The behaviour of the Business Messages is fully described by the Business Transaction and the structure of the Business Messages is fully described by the Message Definitions and related Message Rules, Rules and Market Practices. All of which are registered in the ISO 20022 Repository.
+// A single new Business Message (with its accompagnying business application header) is created - by the sending MessagingEndpoint - for each business event; that is each interaction in a Business Transaction. A Business Message adheres to the following principles:
+// " A Business Message (and its business application header) must not contain information about the Message Transport System or the mechanics or mechanism of message sending, transportation, or receipt.
+// " A Business Message must be comprehensible outside of the context of the Transport Message. That is the Business Message must not require knowledge of the Transport Message to be understood.
+// " A Business Message may contain headers, footers, and envelopes that are meaningful for the business. When present, they are treated as any other message content, which means that they are considered part of the Message Definition of the Business Message and as such will be part of the ISO 20022 Repository.
+// " A Business Message refers to Business Actors by their Name. Each instance of a Business Actor has one Name. The Business Actor must not be referred to in the Transport Layer.
+// Specific usage of this BusinessMessageHeader may be defined by the relevant SEG.
+type BusinessApplicationHeaderV03 struct {
+
+	// Contains the character set of the text-based elements used in the Business Message.
+	CharacterSet *model.UnicodeChartsCode `xml:"CharSet,omitempty"`
+
+	// The sending MessagingEndpoint that has created this Business Message for the receiving MessagingEndpoint that will process this Business Message.
+	//
+	// Note	the sending MessagingEndpoint might be different from the sending address potentially contained in the transport header (as defined in the transport layer).
+	From *model.Party51Choice `xml:"Fr"`
+
+	// The MessagingEndpoint designated by the sending MessagingEndpoint to be the recipient who will ultimately process this Business Message.
+	//
+	// Note the receiving MessagingEndpoint might be different from the receiving address potentially contained in the transport header (as defined in the transport layer).
+	To *model.Party51Choice `xml:"To"`
+
+	// Unambiguously identifies the Business Message to the MessagingEndpoint that has created the Business Message.
+	BusinessMessageIdentifier *model.Max35Text `xml:"BizMsgIdr"`
+
+	// Contains the MessageIdentifier that defines the BusinessMessage.
+	// It must contain a MessageIdentifier published on the ISO 20022 website.
+	//
+	// example	camt.001.001.03
+	MessageDefinitionIdentifier *model.Max35Text `xml:"MsgDefIdr"`
+
+	// Specifies the business service agreed between the two MessagingEndpoints under which rules this Business Message is exchanged.
+	//  To be used when there is a choice of processing services or processing service levels.
+	// Example: E&I
+	BusinessService *model.Max35Text `xml:"BizSvc,omitempty"`
+
+	// Specifies the market practice to which the message conforms. The market practices are a set of rules agreed between the parties that restricts the usage of the messages in order to achieve better STP (Straight Through Processing) rates.
+	// A market practice specification may also extend the underlying message specification by using extensions or supplementary data of this underlying message.
+	// Usage: The MarketPractice component is used to identify the Market Practice to which this message conforms. The specification and usage of the Market Practice are defined by an external implementation specification registry.
+	MarketPractice *model.ImplementationSpecification1 `xml:"MktPrctc,omitempty"`
+
+	// Date and time when this Business Message (header) was created.
+	// Note    Times must be normalized, using the "Z" annotation.
+	CreationDate *model.ISONormalisedDateTime `xml:"CreDt"`
+
+	// Processing date and time indicated by the sender for the receiver of the business message. This date may be different from the date and time provided in the CreationDate.
+	// Usage: Market practice or bilateral agreement should specify how this element should be used.
+	BusinessProcessingDate *model.ISONormalisedDateTime `xml:"BizPrcgDt,omitempty"`
+
+	// Indicates whether the message is a Copy, a Duplicate or a copy of a duplicate of a previously sent ISO 20022 Message.
+	CopyDuplicate *model.CopyDuplicate1Code `xml:"CpyDplct,omitempty"`
+
+	// Flag indicating if the Business Message exchanged between the MessagingEndpoints is possibly a duplicate.
+	// If the receiving MessagingEndpoint  did not receive the original, then this Business Message should be processed as if it were the original.
+	//
+	// If the receiving MessagingEndpoint did receive the original, then it should perform necessary actions to avoid processing this Business Message again.
+	//
+	// This will guarantee business idempotent behaviour.
+	//
+	// NOTE: this is named "PossResend" in FIX - this is an application level resend not a network level retransmission
+	PossibleDuplicate *model.YesNoIndicator `xml:"PssblDplct,omitempty"`
+
+	// Relative indication of the processing precedence of the message over a (set of) Business Messages with assigned priorities.
+	Priority *model.BusinessMessagePriorityCode `xml:"Prty,omitempty"`
+
+	// Contains the digital signature of the Business Entity authorised to sign this Business Message.
+	Signature *model.SignatureEnvelope `xml:"Sgntr,omitempty"`
+
+	// Specifies the Business Application Header(s) of the Business Message(s) to which this Business Message relates.
+	// Can be used when replying to a query; can also be used when canceling or amending.
+	Related []*model.BusinessApplicationHeader8 `xml:"Rltd,omitempty"`
+}
+
+func (b *BusinessApplicationHeaderV03) Validate() error {
+	return model.ValidateElement(b)
+}
+
+func (b *BusinessApplicationHeaderV03) SetCharacterSet(value string) {
+	b.CharacterSet = (*model.UnicodeChartsCode)(&value)
+}
+
+func (b *BusinessApplicationHeaderV03) AddFrom() *model.Party51Choice {
+	b.From = new(model.Party51Choice)
+	return b.From
+}
+
+func (b *BusinessApplicationHeaderV03) AddTo() *model.Party51Choice {
+	b.To = new(model.Party51Choice)
+	return b.To
+}
+
+func (b *BusinessApplicationHeaderV03) SetBusinessMessageIdentifier(value string) {
+	b.BusinessMessageIdentifier = (*model.Max35Text)(&value)
+}
+
+func (b *BusinessApplicationHeaderV03) SetMessageDefinitionIdentifier(value string) {
+	b.MessageDefinitionIdentifier = (*model.Max35Text)(&value)
+}
+
+func (b *BusinessApplicationHeaderV03) SetBusinessService(value string) {
+	b.BusinessService = (*model.Max35Text)(&value)
+}
+
+func (b *BusinessApplicationHeaderV03) AddMarketPractice() *model.ImplementationSpecification1 {
+	b.MarketPractice = new(model.ImplementationSpecification1)
+	return b.MarketPractice
+}
+
+func (b *BusinessApplicationHeaderV03) SetCreationDate(value string) {
+	b.CreationDate = (*model.ISONormalisedDateTime)(&value)
+}
+
+func (b *BusinessApplicationHeaderV03) SetCreationDateFromTime(value time.Time) {
+	b.CreationDate = new(model.ISONormalisedDateTime)
+	b.CreationDate.FromTime(value)
+}
+
+func (b *BusinessApplicationHeaderV03) SetBusinessProcessingDate(value string) {
+	b.BusinessProcessingDate = (*model.ISONormalisedDateTime)(&value)
+}
+
+func (b *BusinessApplicationHeaderV03) SetBusinessProcessingDateFromTime(value time.Time) {
+	b.BusinessProcessingDate = new(model.ISONormalisedDateTime)
+	b.BusinessProcessingDate.FromTime(value)
+}
+
+func (b *BusinessApplicationHeaderV03) SetCopyDuplicate(value string) error {
+	if err := model.CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
+	b.CopyDuplicate = (*model.CopyDuplicate1Code)(&value)
+	return nil
+}
+
+func (b *BusinessApplicationHeaderV03) SetPossibleDuplicate(value string) {
+	b.PossibleDuplicate = (*model.YesNoIndicator)(&value)
+}
+
+func (b *BusinessApplicationHeaderV03) SetPossibleDuplicateFromBool(value bool) {
+	b.PossibleDuplicate = new(model.YesNoIndicator)
+	b.PossibleDuplicate.FromBool(value)
+}
+
+func (b *BusinessApplicationHeaderV03) SetPriority(value string) {
+	b.Priority = (*model.BusinessMessagePriorityCode)(&value)
+}
+
+func (b *BusinessApplicationHeaderV03) AddSignature() *model.SignatureEnvelope {
+	b.Signature = new(model.SignatureEnvelope)
+	return b.Signature
+}
+
+func (b *BusinessApplicationHeaderV03) AddRelated() *model.BusinessApplicationHeader8 {
+	newValue := new(model.BusinessApplicationHeader8)
+	b.Related = append(b.Related, newValue)
+	return newValue
+}
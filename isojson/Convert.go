@@ -0,0 +1,30 @@
+package isojson
+
+import (
+	"encoding/xml"
+
+	"github.com/yudaprama/iso20022/message"
+)
+
+// XMLToJSON converts an XML message, whose message definition is detected from
+// the namespace of its root element, to JSON.
+func XMLToJSON(data []byte) ([]byte, error) {
+	m, err := message.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return Marshal(m)
+}
+
+// JSONToXML converts the JSON encoding of a message of the message definition
+// with the given identifier, for example pacs.008.001.06, to XML.
+func JSONToXML(data []byte, identifier string) ([]byte, error) {
+	m, err := message.NewFromIdentifier(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if err := Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	return xml.Marshal(m)
+}
@@ -0,0 +1,74 @@
+package isojson
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yudaprama/iso20022/message"
+)
+
+// TestCorpus converts the sample messages of the testdata of the message
+// package to JSON and back to XML, and checks that the Documents and headers
+// decoded from both XML encodings are equal.
+func TestCorpus(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "message", "testdata", "*.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no sample messages in testdata")
+	}
+	for _, file := range files {
+		file := file
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(data), "<Envelope") {
+				roundTrip(t, data)
+				return
+			}
+			e, err := message.UnmarshalEnvelope(data)
+			if err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			for _, m := range []message.Message{e.Header, e.Document} {
+				data, err := xml.Marshal(m)
+				if err != nil {
+					t.Fatal(err)
+				}
+				roundTrip(t, data)
+			}
+		})
+	}
+}
+
+// roundTrip converts the XML message data to JSON and back, and compares the
+// Documents decoded from both XML encodings.
+func roundTrip(t *testing.T, data []byte) {
+	t.Helper()
+	want, err := message.Unmarshal(data)
+	if err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	j, err := XMLToJSON(data)
+	if err != nil {
+		t.Fatalf("XMLToJSON: %v", err)
+	}
+	x, err := JSONToXML(j, want.MessageDefinitionIdentifier())
+	if err != nil {
+		t.Fatalf("JSONToXML: %v\n%s", err, j)
+	}
+	got, err := message.Unmarshal(x)
+	if err != nil {
+		t.Fatalf("unmarshal converted XML: %v\n%s", err, x)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s differs after conversion to JSON and back:\n%s", want.MessageDefinitionIdentifier(), j)
+	}
+}
@@ -0,0 +1,253 @@
+// Package isojson encodes the Documents of the business area packages in JSON,
+// following the naming conventions of the ISO 20022 JSON schemas:
+//   - the members of an object are named by the XML tags of the elements, in
+//     the order of the message definition,
+//   - optional elements that are absent are left out, rather than set to null,
+//     and repeated elements are arrays,
+//   - a choice is an object with the single member of the chosen element,
+//   - an amount is an object with the currency in Ccy and the value in amount,
+//     for example {"Ccy":"EUR","amount":"1500.00"},
+//   - the content of supplementary data and of the other elements whose content
+//     is not defined by the message definition is a string holding its XML.
+//
+// Values are kept as strings, as they appear in XML, so that the conversion
+// between XML and JSON is lossless. A Document is encoded as an object with
+// the message element, for example {"FIToFICstmrCdtTrf":{"GrpHdr":{...}}}, and an
+// AppHdr as an object with its elements.
+package isojson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/yudaprama/iso20022/message"
+)
+
+// amountMember is the name of the member holding the value of an amount.
+const amountMember = "amount"
+
+// Marshal returns the JSON encoding of the Document m.
+func Marshal(m message.Message) ([]byte, error) {
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("isojson: cannot marshal %T", m)
+	}
+	var b bytes.Buffer
+	if err := encode(&b, v.Elem()); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// MarshalIndent is like Marshal but indents the output as json.Indent does.
+func MarshalIndent(m message.Message, prefix, indent string) ([]byte, error) {
+	data, err := Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	if err := json.Indent(&b, data, prefix, indent); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// encode writes the JSON of v, a string or a struct.
+func encode(b *bytes.Buffer, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.String:
+		quote(b, v.String())
+		return nil
+	case reflect.Struct:
+	default:
+		return fmt.Errorf("isojson: unsupported type %v", v.Type())
+	}
+	fields := fieldsOf(v.Type())
+	if len(fields) == 1 && fields[0].kind == anyField {
+		quote(b, v.FieldByIndex(fields[0].index).String())
+		return nil
+	}
+	b.WriteByte('{')
+	first := true
+	member := func(name string) {
+		if !first {
+			b.WriteByte(',')
+		}
+		first = false
+		quote(b, name)
+		b.WriteByte(':')
+	}
+	for _, f := range fields {
+		fv := v.FieldByIndex(f.index)
+		switch f.kind {
+		case attrField, textField, anyField:
+			if f.omitempty && fv.String() == "" {
+				continue
+			}
+			member(f.name)
+			quote(b, fv.String())
+			continue
+		}
+		switch fv.Kind() {
+		case reflect.Ptr:
+			if fv.IsNil() {
+				continue
+			}
+			member(f.name)
+			if err := encode(b, fv.Elem()); err != nil {
+				return err
+			}
+		case reflect.Slice:
+			n := 0
+			for i := 0; i < fv.Len(); i++ {
+				elem := fv.Index(i)
+				if elem.Kind() == reflect.Ptr {
+					if elem.IsNil() {
+						continue
+					}
+					elem = elem.Elem()
+				}
+				if n == 0 {
+					member(f.name)
+					b.WriteByte('[')
+				} else {
+					b.WriteByte(',')
+				}
+				n++
+				if err := encode(b, elem); err != nil {
+					return err
+				}
+			}
+			if n > 0 {
+				b.WriteByte(']')
+			}
+		default:
+			if f.omitempty && fv.IsZero() {
+				continue
+			}
+			member(f.name)
+			if err := encode(b, fv); err != nil {
+				return err
+			}
+		}
+	}
+	b.WriteByte('}')
+	return nil
+}
+
+// quote writes s as a JSON string. Unlike encoding/json, it does not escape
+// the characters of HTML, which are frequent in supplementary data.
+func quote(b *bytes.Buffer, s string) {
+	const hex = "0123456789abcdef"
+	b.WriteByte('"')
+	last := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c >= 0x20 && c != '"' && c != '\\' && c < utf8.RuneSelf {
+			i++
+			continue
+		}
+		if c >= utf8.RuneSelf {
+			r, size := utf8.DecodeRuneInString(s[i:])
+			if r != utf8.RuneError || size != 1 {
+				i += size
+				continue
+			}
+		}
+		b.WriteString(s[last:i])
+		switch c {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if c >= utf8.RuneSelf {
+				b.WriteString("\uFFFD")
+			} else {
+				b.WriteString(`\u00`)
+				b.WriteByte(hex[c>>4])
+				b.WriteByte(hex[c&0xF])
+			}
+		}
+		i++
+		last = i
+	}
+	b.WriteString(s[last:])
+	b.WriteByte('"')
+}
+
+type fieldKind int
+
+const (
+	elementField fieldKind = iota
+	attrField
+	textField
+	anyField
+)
+
+// field is a member of the JSON object of a struct type.
+type field struct {
+	name      string
+	index     []int
+	kind      fieldKind
+	omitempty bool
+}
+
+var fieldCache sync.Map
+
+// fieldsOf returns the members of the JSON object of the struct type t: its
+// attributes, then its elements and those of the structs it embeds.
+func fieldsOf(t reflect.Type) []field {
+	if f, ok := fieldCache.Load(t); ok {
+		return f.([]field)
+	}
+	var fields []field
+	var add func(t reflect.Type, index []int)
+	add = func(t reflect.Type, index []int) {
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			if sf.Name == "XMLName" || sf.PkgPath != "" && !sf.Anonymous {
+				continue
+			}
+			idx := append(append([]int{}, index...), i)
+			tag := sf.Tag.Get("xml")
+			if (sf.Anonymous && tag == "" || tag == ",inline") && sf.Type.Kind() == reflect.Struct {
+				add(sf.Type, idx)
+				continue
+			}
+			name, options, _ := strings.Cut(tag, ",")
+			f := field{name: name, index: idx, omitempty: strings.Contains(options, "omitempty")}
+			switch {
+			case name == "-":
+				continue
+			case strings.Contains(options, "innerxml"):
+				f.kind = anyField
+			case strings.Contains(options, "chardata"):
+				f.kind, f.name = textField, amountMember
+			case strings.Contains(options, "attr"):
+				f.kind = attrField
+			case name == "":
+				continue
+			}
+			fields = append(fields, f)
+		}
+	}
+	add(t, nil)
+	// Attributes come first, as in XML, so that an amount reads {"Ccy":"EUR","amount":"1.00"}.
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].kind == attrField && fields[j].kind != attrField
+	})
+	v, _ := fieldCache.LoadOrStore(t, fields)
+	return v.([]field)
+}
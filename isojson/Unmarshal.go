@@ -0,0 +1,134 @@
+package isojson
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/yudaprama/iso20022/message"
+)
+
+// Unmarshal decodes the JSON encoding of a Document into m. Members that are
+// not elements of the message definition and choices with more than one
+// member are rejected. Numbers and booleans are accepted for values, and kept
+// as they are written.
+func Unmarshal(data []byte, m message.Message) error {
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("isojson: cannot unmarshal into %T", m)
+	}
+	if !json.Valid(data) {
+		return fmt.Errorf("isojson: invalid JSON")
+	}
+	v = v.Elem()
+	if err := decode(json.RawMessage(data), v, ""); err != nil {
+		return err
+	}
+	if name := v.FieldByName("XMLName"); name.IsValid() {
+		field, _ := v.Type().FieldByName("XMLName")
+		tag := field.Tag.Get("xml")
+		name.Set(reflect.ValueOf(xml.Name{Space: m.Namespace(), Local: tag[strings.LastIndex(tag, " ")+1:]}))
+	}
+	return nil
+}
+
+// decode sets v, a string, a struct or a pointer to one, to the JSON value data.
+func decode(data json.RawMessage, v reflect.Value, path string) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return nil
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		s, err := text(data, path)
+		if err != nil {
+			return err
+		}
+		v.SetString(s)
+		return nil
+	case reflect.Struct:
+	default:
+		return fmt.Errorf("isojson: unsupported type %v", v.Type())
+	}
+	fields := fieldsOf(v.Type())
+	if len(fields) == 1 && fields[0].kind == anyField {
+		s, err := text(data, path)
+		if err != nil {
+			return err
+		}
+		v.FieldByIndex(fields[0].index).SetString(s)
+		return nil
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return fmt.Errorf("isojson: %s: expected an object", where(path))
+	}
+	if len(members) > 1 && strings.HasSuffix(v.Type().Name(), "Choice") {
+		return fmt.Errorf("isojson: %s: choice with %d elements", where(path), len(members))
+	}
+	for name, value := range members {
+		f, ok := lookup(fields, name)
+		if !ok {
+			return fmt.Errorf("isojson: %s: unknown element %s", where(path), name)
+		}
+		fv := v.FieldByIndex(f.index)
+		if fv.Kind() != reflect.Slice {
+			if err := decode(value, fv, path+"/"+name); err != nil {
+				return err
+			}
+			continue
+		}
+		var elems []json.RawMessage
+		if err := json.Unmarshal(value, &elems); err != nil {
+			return fmt.Errorf("isojson: %s/%s: expected an array", where(path), name)
+		}
+		s := reflect.MakeSlice(fv.Type(), len(elems), len(elems))
+		for i, elem := range elems {
+			if err := decode(elem, s.Index(i), fmt.Sprintf("%s/%s[%d]", path, name, i)); err != nil {
+				return err
+			}
+		}
+		fv.Set(s)
+	}
+	return nil
+}
+
+// text returns the string value of a JSON string, number or boolean.
+func text(data json.RawMessage, path string) (string, error) {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+	if len(data) > 0 && (data[0] == '-' || data[0] >= '0' && data[0] <= '9') || string(data) == "true" || string(data) == "false" {
+		return string(data), nil
+	}
+	return "", fmt.Errorf("isojson: %s: expected a string", where(path))
+}
+
+func lookup(fields []field, name string) (field, bool) {
+	for _, f := range fields {
+		if f.name == name {
+			return f, true
+		}
+	}
+	return field{}, false
+}
+
+func where(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return path
+}
@@ -0,0 +1,59 @@
+package isojson
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yudaprama/iso20022/message"
+	"github.com/yudaprama/iso20022/pacs"
+)
+
+func TestUnmarshal(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		json string
+		err  string
+	}{
+		{"choice", `{"FIToFICstmrCdtTrf":{"GrpHdr":{"PmtTpInf":{"SvcLvl":{"Cd":"SEPA"}}}}}`, ""},
+		{"choice of two elements", `{"FIToFICstmrCdtTrf":{"GrpHdr":{"PmtTpInf":{"SvcLvl":{"Cd":"SEPA","Prtry":"X"}}}}}`,
+			"isojson: /FIToFICstmrCdtTrf/GrpHdr/PmtTpInf/SvcLvl: choice with 2 elements"},
+		{"unknown element", `{"FIToFICstmrCdtTrf":{"GrpHdr":{"MsgId":"1","Msgid":"2"}}}`,
+			"isojson: /FIToFICstmrCdtTrf/GrpHdr: unknown element Msgid"},
+		{"unknown root", `{"FIToFICstmrCdtTrfV06":{}}`, "isojson: /: unknown element FIToFICstmrCdtTrfV06"},
+		{"array expected", `{"FIToFICstmrCdtTrf":{"CdtTrfTxInf":{}}}`, "isojson: /FIToFICstmrCdtTrf/CdtTrfTxInf: expected an array"},
+		{"object expected", `{"FIToFICstmrCdtTrf":{"GrpHdr":"1"}}`, "isojson: /FIToFICstmrCdtTrf/GrpHdr: expected an object"},
+		{"string expected", `{"FIToFICstmrCdtTrf":{"GrpHdr":{"MsgId":{}}}}`, "isojson: /FIToFICstmrCdtTrf/GrpHdr/MsgId: expected a string"},
+		{"invalid", `{"FIToFICstmrCdtTrf":`, "isojson: invalid JSON"},
+	} {
+		m, err := message.NewFromIdentifier("pacs.008.001.06")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = Unmarshal([]byte(tt.json), m)
+		if tt.err == "" && err != nil || tt.err != "" && (err == nil || err.Error() != tt.err) {
+			t.Errorf("%s: got error %v, want %q", tt.name, err, tt.err)
+		}
+	}
+}
+
+func TestAmount(t *testing.T) {
+	const data = `{"FIToFICstmrCdtTrf":{"CdtTrfTxInf":[{"IntrBkSttlmAmt":{"Ccy":"JPY","amount":10000000}}]}}`
+	m, err := message.NewFromIdentifier("pacs.008.001.06")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Unmarshal([]byte(data), m); err != nil {
+		t.Fatal(err)
+	}
+	a := m.(*pacs.Document00800106).Message.CreditTransferTransactionInformation[0].InterbankSettlementAmount
+	if a.Currency != "JPY" || a.Value != "10000000" {
+		t.Errorf("amount decoded as %+v", a)
+	}
+	out, err := Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `"IntrBkSttlmAmt":{"Ccy":"JPY","amount":"10000000"}`; !strings.Contains(string(out), want) {
+		t.Errorf("amount encoded as %s, want %s", out, want)
+	}
+}
@@ -0,0 +1,55 @@
+package isoproto
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// NewMessage returns a new message of the Go type that protoc-gen-go generates
+// for the type of v, a Document, an AppHdr or a struct of the model package,
+// for example a *pacspb.Document00800106 for a *pacs.Document00800106. The
+// package of the generated type, such as
+// github.com/yudaprama/iso20022/proto/iso20022/pacspb, must be imported.
+func NewMessage(v interface{}) (proto.Message, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("isoproto: no message for %T", v)
+	}
+	name := protoreflect.FullName("iso20022." + path.Base(t.PkgPath()) + "." + t.Name())
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("isoproto: no message %s for %T: %v", name, v, err)
+	}
+	return mt.New().Interface(), nil
+}
+
+// ToProto sets m, a generated message, to the value of v, a pointer to a
+// Document, an AppHdr or a struct of the model package.
+func ToProto(v interface{}, m proto.Message) error {
+	data, err := Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := proto.Unmarshal(data, m); err != nil {
+		return fmt.Errorf("isoproto: %v", err)
+	}
+	return nil
+}
+
+// FromProto sets v, a pointer to a Document, an AppHdr or a struct of the model
+// package, to the value of m, a generated message.
+func FromProto(m proto.Message, v interface{}) error {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("isoproto: %v", err)
+	}
+	return Unmarshal(data, v)
+}
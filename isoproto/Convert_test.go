@@ -0,0 +1,149 @@
+package isoproto
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yudaprama/iso20022/message"
+	"github.com/yudaprama/iso20022/pacs"
+	"github.com/yudaprama/iso20022/proto/iso20022/modelpb"
+	"github.com/yudaprama/iso20022/proto/iso20022/pacspb"
+
+	_ "github.com/yudaprama/iso20022/proto/iso20022/acmtpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/admipb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/authpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/caaapb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/caampb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/cainpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/camtpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/catmpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/catppb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/colrpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/fxtrpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/headpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/painpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/redapb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/remtpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/seclpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/seevpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/semtpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/sesepb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/setrpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/suplpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/treapb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/tsinpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/tsmtpb"
+	_ "github.com/yudaprama/iso20022/proto/iso20022/tsrvpb"
+)
+
+// TestConvert converts a maximal instance of every Document to its generated
+// message and back, and checks that the generated message has the encoding of
+// the Document.
+func TestConvert(t *testing.T) {
+	for _, namespace := range message.Namespaces() {
+		namespace := namespace
+		t.Run(strings.TrimPrefix(namespace, message.NamespacePrefix), func(t *testing.T) {
+			original, _ := message.New(namespace)
+			(&filler{path: map[reflect.Type]int{}, oneof: true}).fill(reflect.ValueOf(original).Elem())
+			convert(t, original)
+		})
+	}
+}
+
+// TestConvertCorpus converts the sample messages of the message package to
+// their generated messages and back.
+func TestConvertCorpus(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "message", "testdata", "*.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.HasPrefix(filepath.Base(file), "envelope.") {
+			env, err := message.ParseEnvelope(f)
+			if err != nil {
+				t.Fatalf("%s: %v", file, err)
+			}
+			convert(t, env.Header)
+			convert(t, env.Document)
+		} else {
+			m, err := message.Parse(f)
+			if err != nil {
+				t.Fatalf("%s: %v", file, err)
+			}
+			convert(t, m)
+		}
+		f.Close()
+	}
+}
+
+func convert(t *testing.T, original message.Message) {
+	t.Helper()
+	id := original.MessageDefinitionIdentifier()
+	m, err := NewMessage(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ToProto(original, m); err != nil {
+		t.Fatalf("%s: ToProto: %v", id, err)
+	}
+	want, err := Marshal(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s: generated message encoded differently", id)
+	}
+	decoded, _ := message.New(original.Namespace())
+	if err := FromProto(m, decoded); err != nil {
+		t.Fatalf("%s: FromProto: %v", id, err)
+	}
+	reflect.ValueOf(original).Elem().FieldByName("XMLName").Set(reflect.ValueOf(decoded).Elem().FieldByName("XMLName"))
+	if !reflect.DeepEqual(original, decoded) {
+		t.Fatalf("%s: message differs after conversion", id)
+	}
+}
+
+func TestToProto(t *testing.T) {
+	f, err := os.Open(filepath.Join("..", "message", "testdata", "pacs.008.001.06.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	m, err := message.Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := m.(*pacs.Document00800106)
+	var pb pacspb.Document00800106
+	if err := ToProto(doc, &pb); err != nil {
+		t.Fatal(err)
+	}
+	tx := pb.GetMessage().GetCreditTransferTransactionInformation()[0]
+	if got := tx.GetInterbankSettlementAmount(); got.GetValue() != "10000000" || got.GetCurrency() != "JPY" {
+		t.Errorf("interbank settlement amount %v", got)
+	}
+	if got := tx.GetChargeBearer(); got != modelpb.ChargeBearerType1Code_CHARGE_BEARER_TYPE1_CODE_SHAR {
+		t.Errorf("charge bearer %v", got)
+	}
+
+	if _, err := NewMessage(doc.Message.GroupHeader); err != nil {
+		t.Error(err)
+	}
+	if _, err := NewMessage("pacs"); err == nil {
+		t.Error("NewMessage accepted a string")
+	}
+}
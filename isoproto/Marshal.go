@@ -0,0 +1,230 @@
+// Package isoproto encodes the Documents of the business area packages and the
+// types of the model package in the Protocol Buffers wire format, as described
+// by the definitions of the proto directory:
+//   - a struct is a message whose fields are numbered from 1 in the order of
+//     the fields of the struct, the fields of an embedded struct, or of a
+//     struct field tagged inline, taking the place of that field,
+//   - a choice is a message whose fields are the members of a oneof, a
+//     repeated element of a choice being wrapped in a message with the
+//     repeated field 1,
+//   - a Code type with constants is an enum, whose values are numbered from 1
+//     in the order of the constants, 0 being unspecified; a Code type without
+//     constants, whose values are not known until it is generated from its
+//     XSD, is a string, and becomes an enum of another wire type once it is,
+//     so that both ends must then be updated together,
+//   - the other simple types are strings holding the values as they appear in
+//     XML, so that the conversion between XML and Protocol Buffers is lossless.
+//
+// The definitions are generated by cmd/iso20022proto, with the Go types that
+// protoc-gen-go generates from them, which ToProto and FromProto convert to and
+// from. Messages written by Marshal can be read by the code that protoc
+// generates from them, in any language, and the other way round.
+package isoproto
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// The wire types of the Protocol Buffers encoding.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// Marshal returns the Protocol Buffers encoding of v, a pointer to a Document,
+// an AppHdr or a struct of the model package.
+func Marshal(v interface{}) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("isoproto: cannot marshal %T", v)
+	}
+	return encode(nil, rv.Elem())
+}
+
+// encode appends the fields of v, a struct, to b.
+func encode(b []byte, v reflect.Value) ([]byte, error) {
+	var err error
+	for _, f := range fieldsOf(v.Type()) {
+		fv := v.FieldByIndex(f.index)
+		if !f.repeated {
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					continue
+				}
+				fv = fv.Elem()
+			} else if fv.IsZero() {
+				continue
+			}
+			if b, err = f.encode(b, f.number, fv); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if fv.Len() == 0 {
+			continue
+		}
+		if f.wrapped {
+			var list []byte
+			if list, err = f.encodeList(nil, 1, fv); err != nil {
+				return nil, err
+			}
+			b = appendBytes(appendTag(b, f.number, wireBytes), list)
+			continue
+		}
+		if b, err = f.encodeList(b, f.number, fv); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// encodeList appends the elements of the slice v as the repeated field number.
+// The values of an enum are packed, as proto3 does by default.
+func (f *field) encodeList(b []byte, number int, v reflect.Value) ([]byte, error) {
+	if f.enum != nil {
+		var packed []byte
+		for i := 0; i < v.Len(); i++ {
+			n, err := f.enumNumber(indirect(v.Index(i)))
+			if err != nil {
+				return nil, err
+			}
+			packed = binary.AppendUvarint(packed, n)
+		}
+		return appendBytes(appendTag(b, number, wireBytes), packed), nil
+	}
+	var err error
+	for i := 0; i < v.Len(); i++ {
+		if b, err = f.encode(b, number, indirect(v.Index(i))); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// encode appends v, a string or a struct, as the field number.
+func (f *field) encode(b []byte, number int, v reflect.Value) ([]byte, error) {
+	switch {
+	case f.enum != nil:
+		n, err := f.enumNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		return binary.AppendUvarint(appendTag(b, number, wireVarint), n), nil
+	case v.Kind() == reflect.String:
+		return appendString(appendTag(b, number, wireBytes), v.String()), nil
+	}
+	m, err := encode(nil, v)
+	if err != nil {
+		return nil, err
+	}
+	return appendBytes(appendTag(b, number, wireBytes), m), nil
+}
+
+func (f *field) enumNumber(v reflect.Value) (uint64, error) {
+	if v.String() == "" {
+		return 0, nil
+	}
+	n, ok := f.numbers[v.String()]
+	if !ok {
+		return 0, fmt.Errorf("isoproto: %s is not a value of %v", v.String(), f.typ)
+	}
+	return n, nil
+}
+
+// indirect returns the value v points to. A nil element of a slice has no
+// representation, and is written as the zero value.
+func indirect(v reflect.Value) reflect.Value {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Zero(v.Type().Elem())
+		}
+		return v.Elem()
+	}
+	return v
+}
+
+func appendTag(b []byte, number, wire int) []byte {
+	return binary.AppendUvarint(b, uint64(number)<<3|uint64(wire))
+}
+
+func appendBytes(b, data []byte) []byte {
+	return append(binary.AppendUvarint(b, uint64(len(data))), data...)
+}
+
+func appendString(b []byte, s string) []byte {
+	return append(binary.AppendUvarint(b, uint64(len(s))), s...)
+}
+
+// field is a field of the message of a struct type.
+type field struct {
+	number int
+	index  []int
+	typ    reflect.Type
+
+	// repeated is set for slices; wrapped for the slices of a choice, which
+	// are written in a message of their own since a oneof cannot hold a
+	// repeated field.
+	repeated bool
+	wrapped  bool
+
+	// enum holds the values of a Code type with an enumeration, numbered from
+	// 1 in numbers.
+	enum    []string
+	numbers map[string]uint64
+}
+
+var fieldCache sync.Map
+
+// fieldsOf returns the fields of the message of the struct type t: its
+// elements, attributes and text, and those of the structs it embeds.
+func fieldsOf(t reflect.Type) []*field {
+	if f, ok := fieldCache.Load(t); ok {
+		return f.([]*field)
+	}
+	choice := strings.HasSuffix(t.Name(), "Choice")
+	var fields []*field
+	var add func(t reflect.Type, index []int)
+	add = func(t reflect.Type, index []int) {
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			if sf.Name == "XMLName" || sf.PkgPath != "" && !sf.Anonymous {
+				continue
+			}
+			idx := append(append([]int{}, index...), i)
+			tag := sf.Tag.Get("xml")
+			if (sf.Anonymous && tag == "" || tag == ",inline") && sf.Type.Kind() == reflect.Struct {
+				add(sf.Type, idx)
+				continue
+			}
+			name, options, _ := strings.Cut(tag, ",")
+			if name == "-" || name == "" && !strings.Contains(options, "innerxml") && !strings.Contains(options, "chardata") && !strings.Contains(options, "attr") {
+				continue
+			}
+			f := &field{number: len(fields) + 1, index: idx, typ: sf.Type}
+			if f.typ.Kind() == reflect.Slice {
+				f.typ = f.typ.Elem()
+				f.repeated, f.wrapped = true, choice
+			}
+			if f.typ.Kind() == reflect.Ptr {
+				f.typ = f.typ.Elem()
+			}
+			if values, ok := enums[f.typ]; ok {
+				f.enum = values
+				f.numbers = make(map[string]uint64, len(values))
+				for n, value := range values {
+					f.numbers[value] = uint64(n + 1)
+				}
+			}
+			fields = append(fields, f)
+		}
+	}
+	add(t, nil)
+	v, _ := fieldCache.LoadOrStore(t, fields)
+	return v.([]*field)
+}
@@ -0,0 +1,303 @@
+package isoproto
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/yudaprama/iso20022/message"
+	"github.com/yudaprama/iso20022/model"
+)
+
+// TestRoundTrip converts a maximal instance of every Document to Protocol
+// Buffers and back.
+func TestRoundTrip(t *testing.T) {
+	for _, namespace := range message.Namespaces() {
+		namespace := namespace
+		t.Run(strings.TrimPrefix(namespace, message.NamespacePrefix), func(t *testing.T) {
+			original, _ := message.New(namespace)
+			(&filler{path: map[reflect.Type]int{}}).fill(reflect.ValueOf(original).Elem())
+			roundTrip(t, original)
+		})
+	}
+}
+
+// TestRoundTripCorpus converts the sample messages of the message package to
+// Protocol Buffers and back.
+func TestRoundTripCorpus(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "message", "testdata", "*.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no sample messages")
+	}
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.HasPrefix(filepath.Base(file), "envelope.") {
+			env, err := message.ParseEnvelope(f)
+			if err != nil {
+				t.Fatalf("%s: %v", file, err)
+			}
+			roundTrip(t, env.Header)
+			roundTrip(t, env.Document)
+		} else {
+			m, err := message.Parse(f)
+			if err != nil {
+				t.Fatalf("%s: %v", file, err)
+			}
+			roundTrip(t, m)
+		}
+		f.Close()
+	}
+}
+
+func roundTrip(t *testing.T, original message.Message) {
+	t.Helper()
+	data, err := Marshal(original)
+	if err != nil {
+		t.Fatalf("%s: marshal: %v", original.MessageDefinitionIdentifier(), err)
+	}
+	decoded, _ := message.New(original.Namespace())
+	if err := Unmarshal(data, decoded); err != nil {
+		t.Fatalf("%s: unmarshal: %v", original.MessageDefinitionIdentifier(), err)
+	}
+	reflect.ValueOf(original).Elem().FieldByName("XMLName").Set(reflect.ValueOf(decoded).Elem().FieldByName("XMLName"))
+	if !reflect.DeepEqual(original, decoded) {
+		t.Fatalf("%s: message differs after round trip", original.MessageDefinitionIdentifier())
+	}
+}
+
+func TestWireFormat(t *testing.T) {
+	amount := &model.ActiveCurrencyAndAmount{Value: "1.5", Currency: "EUR"}
+	data, err := Marshal(amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte("\x0a\x031.5\x12\x03EUR"); !bytes.Equal(data, want) {
+		t.Errorf("Marshal(%v) = %q, want %q", amount, data, want)
+	}
+
+	code := model.ChargeBearerType1CodeSHAR
+	tx := &model.CreditTransferTransaction25{ChargeBearer: &code}
+	data, err = Marshal(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	number := fieldNumber(t, tx, "ChargeBearer")
+	if want := []byte{byte(number<<3 | wireVarint), 3}; !bytes.Equal(data, want) {
+		t.Errorf("Marshal(ChargeBearer SHAR) = %x, want %x", data, want)
+	}
+
+	// Unknown fields are skipped.
+	data = append(data, 0xf8, 0x07, 0x2a, 0xfa, 0x07, 0x01, 0x00)
+	var decoded model.CreditTransferTransaction25
+	if err := Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&decoded, tx) {
+		t.Errorf("Unmarshal = %+v, want %+v", decoded, *tx)
+	}
+
+	invalid := model.ChargeBearerType1Code("OUR")
+	if _, err := Marshal(&model.CreditTransferTransaction25{ChargeBearer: &invalid}); err == nil {
+		t.Error("Marshal accepted a value that is not in the enumeration")
+	}
+	if err := Unmarshal([]byte{byte(number<<3 | wireVarint), 9}, &decoded); err == nil {
+		t.Error("Unmarshal accepted a number that is not in the enum")
+	}
+	if err := Unmarshal([]byte{0x0a, 0x05, 'a'}, amount); err == nil {
+		t.Error("Unmarshal accepted truncated data")
+	}
+}
+
+func fieldNumber(t *testing.T, v interface{}, name string) int {
+	t.Helper()
+	typ := reflect.TypeOf(v).Elem()
+	sf, _ := typ.FieldByName(name)
+	for _, f := range fieldsOf(typ) {
+		if reflect.DeepEqual(f.index, sf.Index) {
+			return f.number
+		}
+	}
+	t.Fatalf("%v has no field %s", typ, name)
+	return 0
+}
+
+// protoField is a field of a message of the definitions.
+type protoField struct {
+	label string
+	typ   string
+}
+
+var fieldPattern = regexp.MustCompile(`^\s*(optional |repeated )?([\w.]+) \w+ = (\d+);$`)
+
+// TestDefinitions checks that the committed definitions describe the
+// encoding of every type of every Document.
+func TestDefinitions(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "proto", "iso20022", "*.proto"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	messages := map[string]map[int]protoField{}
+	for _, file := range files {
+		pkg := strings.TrimSuffix(filepath.Base(file), ".proto")
+		f, err := os.Open(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var scope []string
+		s := bufio.NewScanner(f)
+		s.Buffer(nil, 1<<20)
+		for s.Scan() {
+			line := strings.TrimSpace(s.Text())
+			switch {
+			case strings.HasPrefix(line, "message "), strings.HasPrefix(line, "enum "), strings.HasPrefix(line, "oneof "):
+				name := strings.Fields(line)[1]
+				if strings.HasPrefix(line, "oneof ") {
+					name = ""
+				}
+				scope = append(scope, name)
+				if strings.HasPrefix(line, "message ") {
+					messages[qualified(pkg, scope)] = map[int]protoField{}
+				}
+			case line == "}":
+				scope = scope[:len(scope)-1]
+			default:
+				m := fieldPattern.FindStringSubmatch(line)
+				if m == nil || len(scope) == 0 || messages[qualified(pkg, scope)] == nil {
+					continue
+				}
+				number, _ := strconv.Atoi(m[3])
+				typ := m[2]
+				if strings.HasPrefix(typ, ".iso20022.") {
+					typ = strings.TrimPrefix(typ, ".iso20022.")
+				} else if typ != "string" && !strings.HasSuffix(typ, "List") {
+					typ = pkg + "." + typ
+				}
+				messages[qualified(pkg, scope)][number] = protoField{strings.TrimSpace(m[1]), typ}
+			}
+		}
+		f.Close()
+		if err := s.Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	seen := map[reflect.Type]bool{}
+	var check func(typ reflect.Type)
+	check = func(typ reflect.Type) {
+		if seen[typ] {
+			return
+		}
+		seen[typ] = true
+		name := typeName(typ)
+		fields, ok := messages[name]
+		if !ok {
+			t.Fatalf("no message %s", name)
+		}
+		for _, f := range fieldsOf(typ) {
+			pf, ok := fields[f.number]
+			if !ok {
+				t.Fatalf("%s has no field %d", name, f.number)
+			}
+			want := "string"
+			if f.enum != nil || f.typ.Kind() == reflect.Struct {
+				want = typeName(f.typ)
+			}
+			if f.wrapped {
+				pf = messages[name+"."+pf.typ][1]
+			}
+			if pf.typ != want || (pf.label == "repeated") != f.repeated {
+				t.Fatalf("field %d of %s is %s %s, want %v", f.number, name, pf.label, pf.typ, f.typ)
+			}
+			if f.typ.Kind() == reflect.Struct {
+				check(f.typ)
+			}
+		}
+		if len(fields) != len(fieldsOf(typ)) {
+			t.Fatalf("%s has %d fields, want %d", name, len(fields), len(fieldsOf(typ)))
+		}
+	}
+	for _, namespace := range message.Namespaces() {
+		m, _ := message.New(namespace)
+		check(reflect.TypeOf(m).Elem())
+	}
+}
+
+func qualified(pkg string, scope []string) string {
+	name := pkg
+	for _, s := range scope {
+		if s != "" {
+			name += "." + s
+		}
+	}
+	return name
+}
+
+func typeName(t reflect.Type) string {
+	return filepath.Base(t.PkgPath()) + "." + t.Name()
+}
+
+// filler sets every field of a value to a distinct non-zero value, or to a
+// value of the enumeration of a Code type. Repeated elements get two
+// occurrences and recursive types are expanded twice along a path. When oneof
+// is set, a single element of a choice is set, as a oneof holds one member.
+type filler struct {
+	path  map[reflect.Type]int
+	n     int
+	oneof bool
+}
+
+func (f *filler) fill(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		f.n++
+		if values, ok := enums[v.Type()]; ok {
+			v.SetString(values[f.n%len(values)])
+			return
+		}
+		v.SetString(strconv.Itoa(f.n))
+	case reflect.Ptr:
+		if f.path[v.Type().Elem()] >= 2 {
+			return
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		f.fill(v.Elem())
+	case reflect.Slice:
+		if elem := v.Type().Elem(); elem.Kind() == reflect.Ptr && f.path[elem.Elem()] >= 2 {
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		for i := 0; i < v.Len(); i++ {
+			f.fill(v.Index(i))
+		}
+	case reflect.Struct:
+		f.path[v.Type()]++
+		defer func() { f.path[v.Type()]-- }()
+		var fields []int
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.Name == "XMLName" || field.PkgPath != "" || field.Tag.Get("xml") == "-" {
+				continue
+			}
+			fields = append(fields, i)
+		}
+		if f.oneof && strings.HasSuffix(v.Type().Name(), "Choice") && len(fields) > 0 {
+			// The chosen element varies from one choice to the next.
+			fields = fields[f.n%len(fields) : f.n%len(fields)+1]
+		}
+		for _, i := range fields {
+			f.fill(v.Field(i))
+		}
+	}
+}
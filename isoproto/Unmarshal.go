@@ -0,0 +1,233 @@
+package isoproto
+
+import (
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var errTruncated = errors.New("isoproto: unexpected end of data")
+
+// Unmarshal decodes the Protocol Buffers encoding of a Document, an AppHdr or
+// a struct of the model package into v, a pointer to it. Fields with unknown
+// numbers are skipped, as Protocol Buffers requires.
+func Unmarshal(data []byte, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("isoproto: cannot unmarshal into %T", v)
+	}
+	rv = rv.Elem()
+	if err := decode(data, rv); err != nil {
+		return err
+	}
+	if field, ok := rv.Type().FieldByName("XMLName"); ok && field.Type == reflect.TypeOf(xml.Name{}) {
+		tag, _, _ := strings.Cut(field.Tag.Get("xml"), ",")
+		if i := strings.LastIndex(tag, " "); i >= 0 {
+			rv.FieldByIndex(field.Index).Set(reflect.ValueOf(xml.Name{Space: tag[:i], Local: tag[i+1:]}))
+		}
+	}
+	return nil
+}
+
+// decode merges the fields of data into v, a struct.
+func decode(data []byte, v reflect.Value) error {
+	fields := fieldsOf(v.Type())
+	for len(data) > 0 {
+		number, wire, n, err := readTag(data)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		var f *field
+		if number >= 1 && number <= uint64(len(fields)) {
+			f = fields[number-1]
+		}
+		if f == nil {
+			if data, err = skip(data, wire); err != nil {
+				return err
+			}
+			continue
+		}
+		var value []byte
+		var x uint64
+		switch wire {
+		case wireVarint:
+			if f.enum == nil {
+				return fmt.Errorf("isoproto: field %d of %v has wire type %d", number, v.Type(), wire)
+			}
+			if x, n = binary.Uvarint(data); n <= 0 {
+				return errTruncated
+			}
+		case wireBytes:
+			if value, n, err = readBytes(data); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("isoproto: field %d of %v has wire type %d", number, v.Type(), wire)
+		}
+		data = data[n:]
+		fv := v.FieldByIndex(f.index)
+		switch {
+		case f.wrapped:
+			err = f.decodeList(value, fv)
+		case f.repeated && f.enum != nil && wire == wireBytes:
+			err = f.decodePacked(value, fv)
+		case f.repeated:
+			err = f.decodeValue(value, x, appendElem(fv))
+		default:
+			err = f.decodeValue(value, x, settable(fv))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeList decodes the message wrapping the elements of a repeated member of
+// a choice.
+func (f *field) decodeList(data []byte, v reflect.Value) error {
+	for len(data) > 0 {
+		number, wire, n, err := readTag(data)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		if number != 1 {
+			if data, err = skip(data, wire); err != nil {
+				return err
+			}
+			continue
+		}
+		var value []byte
+		var x uint64
+		switch {
+		case wire == wireBytes && f.enum != nil:
+			if value, n, err = readBytes(data); err != nil {
+				return err
+			}
+			if err := f.decodePacked(value, v); err != nil {
+				return err
+			}
+			data = data[n:]
+			continue
+		case wire == wireBytes:
+			value, n, err = readBytes(data)
+		case wire == wireVarint && f.enum != nil:
+			if x, n = binary.Uvarint(data); n <= 0 {
+				err = errTruncated
+			}
+		default:
+			err = fmt.Errorf("isoproto: element of %v has wire type %d", f.typ, wire)
+		}
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		if err := f.decodeValue(value, x, appendElem(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodePacked decodes the packed values of a repeated enum.
+func (f *field) decodePacked(data []byte, v reflect.Value) error {
+	for len(data) > 0 {
+		x, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errTruncated
+		}
+		data = data[n:]
+		if err := f.decodeValue(nil, x, appendElem(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeValue sets v, of the type of the field, to the value of an enum x, or
+// to the string or message data.
+func (f *field) decodeValue(data []byte, x uint64, v reflect.Value) error {
+	switch {
+	case f.enum != nil:
+		if x > uint64(len(f.enum)) {
+			return fmt.Errorf("isoproto: %d is not a value of %v", x, f.typ)
+		}
+		if x > 0 {
+			v.SetString(f.enum[x-1])
+		}
+		return nil
+	case v.Kind() == reflect.String:
+		v.SetString(string(data))
+		return nil
+	}
+	return decode(data, v)
+}
+
+// appendElem appends an element to the slice v and returns it, allocated if
+// the elements are pointers.
+func appendElem(v reflect.Value) reflect.Value {
+	v.Set(reflect.Append(v, reflect.Zero(v.Type().Elem())))
+	return settable(v.Index(v.Len() - 1))
+}
+
+// settable returns the value v points to, allocating it if v is a nil
+// pointer, or v itself.
+func settable(v reflect.Value) reflect.Value {
+	if v.Kind() != reflect.Ptr {
+		return v
+	}
+	if v.IsNil() {
+		v.Set(reflect.New(v.Type().Elem()))
+	}
+	return v.Elem()
+}
+
+func readTag(data []byte) (number uint64, wire int, n int, err error) {
+	x, n := binary.Uvarint(data)
+	if n <= 0 {
+		return 0, 0, 0, errTruncated
+	}
+	if x>>3 == 0 {
+		return 0, 0, 0, errors.New("isoproto: invalid field number 0")
+	}
+	return x >> 3, int(x & 7), n, nil
+}
+
+func readBytes(data []byte) ([]byte, int, error) {
+	l, n := binary.Uvarint(data)
+	if n <= 0 || l > uint64(len(data)-n) {
+		return nil, 0, errTruncated
+	}
+	return data[n : n+int(l)], n + int(l), nil
+}
+
+// skip returns the data that follows the value of a field of wire type wire.
+func skip(data []byte, wire int) ([]byte, error) {
+	var n int
+	switch wire {
+	case wireVarint:
+		if _, n = binary.Uvarint(data); n <= 0 {
+			return nil, errTruncated
+		}
+	case wireBytes:
+		var err error
+		if _, n, err = readBytes(data); err != nil {
+			return nil, err
+		}
+	case wireFixed64:
+		n = 8
+	case wireFixed32:
+		n = 4
+	default:
+		return nil, fmt.Errorf("isoproto: unsupported wire type %d", wire)
+	}
+	if n > len(data) {
+		return nil, errTruncated
+	}
+	return data[n:], nil
+}
@@ -0,0 +1,1328 @@
+package message
+
+import (
+	"github.com/yudaprama/iso20022/acmt"
+	"github.com/yudaprama/iso20022/admi"
+	"github.com/yudaprama/iso20022/auth"
+	"github.com/yudaprama/iso20022/caaa"
+	"github.com/yudaprama/iso20022/caam"
+	"github.com/yudaprama/iso20022/cain"
+	"github.com/yudaprama/iso20022/camt"
+	"github.com/yudaprama/iso20022/catm"
+	"github.com/yudaprama/iso20022/catp"
+	"github.com/yudaprama/iso20022/colr"
+	"github.com/yudaprama/iso20022/fxtr"
+	"github.com/yudaprama/iso20022/head"
+	"github.com/yudaprama/iso20022/pacs"
+	"github.com/yudaprama/iso20022/pain"
+	"github.com/yudaprama/iso20022/reda"
+	"github.com/yudaprama/iso20022/remt"
+	"github.com/yudaprama/iso20022/secl"
+	"github.com/yudaprama/iso20022/seev"
+	"github.com/yudaprama/iso20022/semt"
+	"github.com/yudaprama/iso20022/sese"
+	"github.com/yudaprama/iso20022/setr"
+	"github.com/yudaprama/iso20022/supl"
+	"github.com/yudaprama/iso20022/trea"
+	"github.com/yudaprama/iso20022/tsin"
+	"github.com/yudaprama/iso20022/tsmt"
+	"github.com/yudaprama/iso20022/tsrv"
+)
+
+// catalogue lists every Document type of the business area packages, and the
+// AppHdr types of the head package, keyed by their namespace.
+var catalogue = map[string]func() Message{
+	"urn:iso:std:iso:20022:tech:xsd:acmt.001.001.02": func() Message { return new(acmt.Document00100102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.001.001.03": func() Message { return new(acmt.Document00100103) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.001.001.04": func() Message { return new(acmt.Document00100104) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.001.001.05": func() Message { return new(acmt.Document00100105) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.001.001.06": func() Message { return new(acmt.Document00100106) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.001.001.07": func() Message { return new(acmt.Document00100107) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.002.001.02": func() Message { return new(acmt.Document00200102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.002.001.03": func() Message { return new(acmt.Document00200103) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.002.001.04": func() Message { return new(acmt.Document00200104) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.002.001.05": func() Message { return new(acmt.Document00200105) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.002.001.06": func() Message { return new(acmt.Document00200106) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.002.001.07": func() Message { return new(acmt.Document00200107) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.003.001.02": func() Message { return new(acmt.Document00300102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.003.001.03": func() Message { return new(acmt.Document00300103) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.003.001.04": func() Message { return new(acmt.Document00300104) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.003.001.05": func() Message { return new(acmt.Document00300105) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.003.001.06": func() Message { return new(acmt.Document00300106) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.003.001.07": func() Message { return new(acmt.Document00300107) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.004.001.05": func() Message { return new(acmt.Document00400105) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.005.001.02": func() Message { return new(acmt.Document00500102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.005.001.03": func() Message { return new(acmt.Document00500103) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.005.001.04": func() Message { return new(acmt.Document00500104) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.005.001.05": func() Message { return new(acmt.Document00500105) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.006.001.02": func() Message { return new(acmt.Document00600102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.006.001.03": func() Message { return new(acmt.Document00600103) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.006.001.04": func() Message { return new(acmt.Document00600104) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.006.001.05": func() Message { return new(acmt.Document00600105) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.006.001.06": func() Message { return new(acmt.Document00600106) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.007.001.01": func() Message { return new(acmt.Document00700101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.007.001.02": func() Message { return new(acmt.Document00700102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.008.001.01": func() Message { return new(acmt.Document00800101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.008.001.02": func() Message { return new(acmt.Document00800102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.009.001.01": func() Message { return new(acmt.Document00900101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.009.001.02": func() Message { return new(acmt.Document00900102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.010.001.01": func() Message { return new(acmt.Document01000101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.010.001.02": func() Message { return new(acmt.Document01000102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.011.001.01": func() Message { return new(acmt.Document01100101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.011.001.02": func() Message { return new(acmt.Document01100102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.012.001.01": func() Message { return new(acmt.Document01200101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.012.001.02": func() Message { return new(acmt.Document01200102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.013.001.01": func() Message { return new(acmt.Document01300101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.013.001.02": func() Message { return new(acmt.Document01300102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.014.001.01": func() Message { return new(acmt.Document01400101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.014.001.02": func() Message { return new(acmt.Document01400102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.015.001.01": func() Message { return new(acmt.Document01500101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.015.001.02": func() Message { return new(acmt.Document01500102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.016.001.01": func() Message { return new(acmt.Document01600101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.016.001.02": func() Message { return new(acmt.Document01600102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.017.001.01": func() Message { return new(acmt.Document01700101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.017.001.02": func() Message { return new(acmt.Document01700102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.018.001.01": func() Message { return new(acmt.Document01800101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.018.001.02": func() Message { return new(acmt.Document01800102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.019.001.01": func() Message { return new(acmt.Document01900101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.019.001.02": func() Message { return new(acmt.Document01900102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.020.001.01": func() Message { return new(acmt.Document02000101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.020.001.02": func() Message { return new(acmt.Document02000102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.021.001.01": func() Message { return new(acmt.Document02100101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.021.001.02": func() Message { return new(acmt.Document02100102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.022.001.01": func() Message { return new(acmt.Document02200101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.022.001.02": func() Message { return new(acmt.Document02200102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.023.001.01": func() Message { return new(acmt.Document02300101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.023.001.02": func() Message { return new(acmt.Document02300102) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.024.001.01": func() Message { return new(acmt.Document02400101) },
+	"urn:iso:std:iso:20022:tech:xsd:acmt.024.001.02": func() Message { return new(acmt.Document02400102) },
+	"urn:iso:std:iso:20022:tech:xsd:admi.002.001.01": func() Message { return new(admi.Document00200101) },
+	"urn:iso:std:iso:20022:tech:xsd:admi.004.001.02": func() Message { return new(admi.Document00400102) },
+	"urn:iso:std:iso:20022:tech:xsd:admi.009.001.02": func() Message { return new(admi.Document00900102) },
+	"urn:iso:std:iso:20022:tech:xsd:admi.010.001.02": func() Message { return new(admi.Document01000102) },
+	"urn:iso:std:iso:20022:tech:xsd:admi.011.001.01": func() Message { return new(admi.Document01100101) },
+	"urn:iso:std:iso:20022:tech:xsd:admi.017.001.01": func() Message { return new(admi.Document01700101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.001.001.01": func() Message { return new(auth.Document00100101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.002.001.01": func() Message { return new(auth.Document00200101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.003.001.01": func() Message { return new(auth.Document00300101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.008.001.02": func() Message { return new(auth.Document00800102) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.009.001.02": func() Message { return new(auth.Document00900102) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.010.001.01": func() Message { return new(auth.Document01000101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.011.001.01": func() Message { return new(auth.Document01100101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.012.001.01": func() Message { return new(auth.Document01200101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.013.001.01": func() Message { return new(auth.Document01300101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.014.001.01": func() Message { return new(auth.Document01400101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.015.001.01": func() Message { return new(auth.Document01500101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.018.001.01": func() Message { return new(auth.Document01800101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.019.001.01": func() Message { return new(auth.Document01900101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.020.001.01": func() Message { return new(auth.Document02000101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.021.001.01": func() Message { return new(auth.Document02100101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.022.001.01": func() Message { return new(auth.Document02200101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.023.001.01": func() Message { return new(auth.Document02300101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.024.001.01": func() Message { return new(auth.Document02400101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.025.001.01": func() Message { return new(auth.Document02500101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.026.001.01": func() Message { return new(auth.Document02600101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.027.001.01": func() Message { return new(auth.Document02700101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.028.001.01": func() Message { return new(auth.Document02800101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.034.001.01": func() Message { return new(auth.Document03400101) },
+	"urn:iso:std:iso:20022:tech:xsd:auth.038.001.01": func() Message { return new(auth.Document03800101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.001.001.01": func() Message { return new(caaa.Document00100101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.001.001.02": func() Message { return new(caaa.Document00100102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.001.001.03": func() Message { return new(caaa.Document00100103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.001.001.04": func() Message { return new(caaa.Document00100104) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.001.001.05": func() Message { return new(caaa.Document00100105) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.002.001.01": func() Message { return new(caaa.Document00200101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.002.001.02": func() Message { return new(caaa.Document00200102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.002.001.03": func() Message { return new(caaa.Document00200103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.002.001.04": func() Message { return new(caaa.Document00200104) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.002.001.05": func() Message { return new(caaa.Document00200105) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.003.001.01": func() Message { return new(caaa.Document00300101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.003.001.02": func() Message { return new(caaa.Document00300102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.003.001.03": func() Message { return new(caaa.Document00300103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.003.001.04": func() Message { return new(caaa.Document00300104) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.003.001.05": func() Message { return new(caaa.Document00300105) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.004.001.01": func() Message { return new(caaa.Document00400101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.004.001.02": func() Message { return new(caaa.Document00400102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.004.001.03": func() Message { return new(caaa.Document00400103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.004.001.04": func() Message { return new(caaa.Document00400104) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.004.001.05": func() Message { return new(caaa.Document00400105) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.005.001.01": func() Message { return new(caaa.Document00500101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.005.001.02": func() Message { return new(caaa.Document00500102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.005.001.03": func() Message { return new(caaa.Document00500103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.005.001.04": func() Message { return new(caaa.Document00500104) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.005.001.05": func() Message { return new(caaa.Document00500105) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.006.001.01": func() Message { return new(caaa.Document00600101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.006.001.02": func() Message { return new(caaa.Document00600102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.006.001.03": func() Message { return new(caaa.Document00600103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.006.001.04": func() Message { return new(caaa.Document00600104) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.006.001.05": func() Message { return new(caaa.Document00600105) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.007.001.01": func() Message { return new(caaa.Document00700101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.007.001.02": func() Message { return new(caaa.Document00700102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.007.001.03": func() Message { return new(caaa.Document00700103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.007.001.04": func() Message { return new(caaa.Document00700104) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.007.001.05": func() Message { return new(caaa.Document00700105) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.008.001.01": func() Message { return new(caaa.Document00800101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.008.001.02": func() Message { return new(caaa.Document00800102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.008.001.03": func() Message { return new(caaa.Document00800103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.008.001.04": func() Message { return new(caaa.Document00800104) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.008.001.05": func() Message { return new(caaa.Document00800105) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.009.001.01": func() Message { return new(caaa.Document00900101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.009.001.02": func() Message { return new(caaa.Document00900102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.009.001.03": func() Message { return new(caaa.Document00900103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.009.001.04": func() Message { return new(caaa.Document00900104) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.009.001.05": func() Message { return new(caaa.Document00900105) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.010.001.01": func() Message { return new(caaa.Document01000101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.010.001.02": func() Message { return new(caaa.Document01000102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.010.001.03": func() Message { return new(caaa.Document01000103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.010.001.04": func() Message { return new(caaa.Document01000104) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.010.001.05": func() Message { return new(caaa.Document01000105) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.011.001.01": func() Message { return new(caaa.Document01100101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.011.001.02": func() Message { return new(caaa.Document01100102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.011.001.03": func() Message { return new(caaa.Document01100103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.011.001.04": func() Message { return new(caaa.Document01100104) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.011.001.05": func() Message { return new(caaa.Document01100105) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.012.001.01": func() Message { return new(caaa.Document01200101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.012.001.02": func() Message { return new(caaa.Document01200102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.012.001.03": func() Message { return new(caaa.Document01200103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.012.001.04": func() Message { return new(caaa.Document01200104) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.012.001.05": func() Message { return new(caaa.Document01200105) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.013.001.01": func() Message { return new(caaa.Document01300101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.013.001.02": func() Message { return new(caaa.Document01300102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.013.001.03": func() Message { return new(caaa.Document01300103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.013.001.04": func() Message { return new(caaa.Document01300104) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.013.001.05": func() Message { return new(caaa.Document01300105) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.014.001.01": func() Message { return new(caaa.Document01400101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.014.001.02": func() Message { return new(caaa.Document01400102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.014.001.03": func() Message { return new(caaa.Document01400103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.014.001.04": func() Message { return new(caaa.Document01400104) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.014.001.05": func() Message { return new(caaa.Document01400105) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.015.001.01": func() Message { return new(caaa.Document01500101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.015.001.02": func() Message { return new(caaa.Document01500102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.015.001.03": func() Message { return new(caaa.Document01500103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.015.001.04": func() Message { return new(caaa.Document01500104) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.015.001.05": func() Message { return new(caaa.Document01500105) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.016.001.01": func() Message { return new(caaa.Document01600101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.016.001.02": func() Message { return new(caaa.Document01600102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.016.001.03": func() Message { return new(caaa.Document01600103) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.017.001.01": func() Message { return new(caaa.Document01700101) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.017.001.02": func() Message { return new(caaa.Document01700102) },
+	"urn:iso:std:iso:20022:tech:xsd:caaa.017.001.03": func() Message { return new(caaa.Document01700103) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.001.001.01": func() Message { return new(caam.Document00100101) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.001.001.02": func() Message { return new(caam.Document00100102) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.002.001.01": func() Message { return new(caam.Document00200101) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.002.001.02": func() Message { return new(caam.Document00200102) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.003.001.01": func() Message { return new(caam.Document00300101) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.003.001.02": func() Message { return new(caam.Document00300102) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.004.001.01": func() Message { return new(caam.Document00400101) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.004.001.02": func() Message { return new(caam.Document00400102) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.005.001.01": func() Message { return new(caam.Document00500101) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.005.001.02": func() Message { return new(caam.Document00500102) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.006.001.01": func() Message { return new(caam.Document00600101) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.006.001.02": func() Message { return new(caam.Document00600102) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.007.001.01": func() Message { return new(caam.Document00700101) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.008.001.01": func() Message { return new(caam.Document00800101) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.009.001.01": func() Message { return new(caam.Document00900101) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.009.001.02": func() Message { return new(caam.Document00900102) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.010.001.01": func() Message { return new(caam.Document01000101) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.010.001.02": func() Message { return new(caam.Document01000102) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.011.001.01": func() Message { return new(caam.Document01100101) },
+	"urn:iso:std:iso:20022:tech:xsd:caam.012.001.01": func() Message { return new(caam.Document01200101) },
+	"urn:iso:std:iso:20022:tech:xsd:cain.001.001.01": func() Message { return new(cain.Document00100101) },
+	"urn:iso:std:iso:20022:tech:xsd:cain.002.001.01": func() Message { return new(cain.Document00200101) },
+	"urn:iso:std:iso:20022:tech:xsd:cain.003.001.01": func() Message { return new(cain.Document00300101) },
+	"urn:iso:std:iso:20022:tech:xsd:cain.004.001.01": func() Message { return new(cain.Document00400101) },
+	"urn:iso:std:iso:20022:tech:xsd:cain.005.001.01": func() Message { return new(cain.Document00500101) },
+	"urn:iso:std:iso:20022:tech:xsd:cain.006.001.01": func() Message { return new(cain.Document00600101) },
+	"urn:iso:std:iso:20022:tech:xsd:cain.007.001.01": func() Message { return new(cain.Document00700101) },
+	"urn:iso:std:iso:20022:tech:xsd:cain.008.001.01": func() Message { return new(cain.Document00800101) },
+	"urn:iso:std:iso:20022:tech:xsd:cain.009.001.01": func() Message { return new(cain.Document00900101) },
+	"urn:iso:std:iso:20022:tech:xsd:cain.010.001.01": func() Message { return new(cain.Document01000101) },
+	"urn:iso:std:iso:20022:tech:xsd:cain.011.001.01": func() Message { return new(cain.Document01100101) },
+	"urn:iso:std:iso:20022:tech:xsd:cain.012.001.01": func() Message { return new(cain.Document01200101) },
+	"urn:iso:std:iso:20022:tech:xsd:cain.013.001.01": func() Message { return new(cain.Document01300101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.007.002.01": func() Message { return new(camt.Document00700201) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.008.002.01": func() Message { return new(camt.Document00800201) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.026.001.01": func() Message { return new(camt.Document02600101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.026.001.03": func() Message { return new(camt.Document02600103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.026.001.04": func() Message { return new(camt.Document02600104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.026.001.05": func() Message { return new(camt.Document02600105) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.027.001.01": func() Message { return new(camt.Document02700101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.027.001.03": func() Message { return new(camt.Document02700103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.027.001.04": func() Message { return new(camt.Document02700104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.027.001.05": func() Message { return new(camt.Document02700105) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.028.001.01": func() Message { return new(camt.Document02800101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.028.001.03": func() Message { return new(camt.Document02800103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.028.001.04": func() Message { return new(camt.Document02800104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.028.001.05": func() Message { return new(camt.Document02800105) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.028.001.06": func() Message { return new(camt.Document02800106) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.028.001.07": func() Message { return new(camt.Document02800107) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.029.001.01": func() Message { return new(camt.Document02900101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.029.001.03": func() Message { return new(camt.Document02900103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.029.001.04": func() Message { return new(camt.Document02900104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.029.001.05": func() Message { return new(camt.Document02900105) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.029.001.06": func() Message { return new(camt.Document02900106) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.029.001.07": func() Message { return new(camt.Document02900107) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.030.001.01": func() Message { return new(camt.Document03000101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.030.001.03": func() Message { return new(camt.Document03000103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.030.001.04": func() Message { return new(camt.Document03000104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.031.001.01": func() Message { return new(camt.Document03100101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.031.001.03": func() Message { return new(camt.Document03100103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.031.001.04": func() Message { return new(camt.Document03100104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.032.001.01": func() Message { return new(camt.Document03200101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.032.001.02": func() Message { return new(camt.Document03200102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.032.001.03": func() Message { return new(camt.Document03200103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.033.001.01": func() Message { return new(camt.Document03300101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.033.001.03": func() Message { return new(camt.Document03300103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.033.001.04": func() Message { return new(camt.Document03300104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.034.001.03": func() Message { return new(camt.Document03400103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.034.001.04": func() Message { return new(camt.Document03400104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.035.001.02": func() Message { return new(camt.Document03500102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.035.001.03": func() Message { return new(camt.Document03500103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.036.001.01": func() Message { return new(camt.Document03600101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.036.001.02": func() Message { return new(camt.Document03600102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.036.001.03": func() Message { return new(camt.Document03600103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.037.001.01": func() Message { return new(camt.Document03700101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.037.001.03": func() Message { return new(camt.Document03700103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.037.001.04": func() Message { return new(camt.Document03700104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.037.001.05": func() Message { return new(camt.Document03700105) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.038.001.01": func() Message { return new(camt.Document03800101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.038.001.02": func() Message { return new(camt.Document03800102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.038.001.03": func() Message { return new(camt.Document03800103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.039.001.01": func() Message { return new(camt.Document03900101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.039.001.03": func() Message { return new(camt.Document03900103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.039.001.04": func() Message { return new(camt.Document03900104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.040.001.02": func() Message { return new(camt.Document04000102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.040.001.03": func() Message { return new(camt.Document04000103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.040.001.04": func() Message { return new(camt.Document04000104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.041.001.02": func() Message { return new(camt.Document04100102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.041.001.03": func() Message { return new(camt.Document04100103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.041.001.04": func() Message { return new(camt.Document04100104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.042.001.02": func() Message { return new(camt.Document04200102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.042.001.03": func() Message { return new(camt.Document04200103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.042.001.04": func() Message { return new(camt.Document04200104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.043.001.02": func() Message { return new(camt.Document04300102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.043.001.03": func() Message { return new(camt.Document04300103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.043.001.04": func() Message { return new(camt.Document04300104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.044.001.01": func() Message { return new(camt.Document04400101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.044.001.02": func() Message { return new(camt.Document04400102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.044.001.03": func() Message { return new(camt.Document04400103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.045.001.01": func() Message { return new(camt.Document04500101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.045.001.02": func() Message { return new(camt.Document04500102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.045.001.03": func() Message { return new(camt.Document04500103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.052.001.01": func() Message { return new(camt.Document05200101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.052.001.02": func() Message { return new(camt.Document05200102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.052.001.03": func() Message { return new(camt.Document05200103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.052.001.04": func() Message { return new(camt.Document05200104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.052.001.05": func() Message { return new(camt.Document05200105) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.052.001.06": func() Message { return new(camt.Document05200106) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.053.001.01": func() Message { return new(camt.Document05300101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.053.001.02": func() Message { return new(camt.Document05300102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.053.001.03": func() Message { return new(camt.Document05300103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.053.001.04": func() Message { return new(camt.Document05300104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.053.001.05": func() Message { return new(camt.Document05300105) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.053.001.06": func() Message { return new(camt.Document05300106) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.053.001.08": func() Message { return new(camt.Document05300108) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.054.001.01": func() Message { return new(camt.Document05400101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.054.001.02": func() Message { return new(camt.Document05400102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.054.001.03": func() Message { return new(camt.Document05400103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.054.001.04": func() Message { return new(camt.Document05400104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.054.001.05": func() Message { return new(camt.Document05400105) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.054.001.06": func() Message { return new(camt.Document05400106) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.055.001.01": func() Message { return new(camt.Document05500101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.055.001.02": func() Message { return new(camt.Document05500102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.055.001.03": func() Message { return new(camt.Document05500103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.055.001.04": func() Message { return new(camt.Document05500104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.055.001.05": func() Message { return new(camt.Document05500105) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.055.001.06": func() Message { return new(camt.Document05500106) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.056.001.01": func() Message { return new(camt.Document05600101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.056.001.02": func() Message { return new(camt.Document05600102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.056.001.03": func() Message { return new(camt.Document05600103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.056.001.04": func() Message { return new(camt.Document05600104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.056.001.05": func() Message { return new(camt.Document05600105) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.056.001.06": func() Message { return new(camt.Document05600106) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.057.001.02": func() Message { return new(camt.Document05700102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.057.001.03": func() Message { return new(camt.Document05700103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.057.001.04": func() Message { return new(camt.Document05700104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.057.001.05": func() Message { return new(camt.Document05700105) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.058.001.02": func() Message { return new(camt.Document05800102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.058.001.03": func() Message { return new(camt.Document05800103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.058.001.04": func() Message { return new(camt.Document05800104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.058.001.05": func() Message { return new(camt.Document05800105) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.059.001.02": func() Message { return new(camt.Document05900102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.059.001.03": func() Message { return new(camt.Document05900103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.059.001.04": func() Message { return new(camt.Document05900104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.059.001.05": func() Message { return new(camt.Document05900105) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.060.001.02": func() Message { return new(camt.Document06000102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.060.001.03": func() Message { return new(camt.Document06000103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.061.001.02": func() Message { return new(camt.Document06100102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.062.001.03": func() Message { return new(camt.Document06200103) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.063.001.02": func() Message { return new(camt.Document06300102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.086.001.01": func() Message { return new(camt.Document08600101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.086.001.02": func() Message { return new(camt.Document08600102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.087.001.01": func() Message { return new(camt.Document08700101) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.087.001.02": func() Message { return new(camt.Document08700102) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.087.001.04": func() Message { return new(camt.Document08700104) },
+	"urn:iso:std:iso:20022:tech:xsd:camt.088.001.01": func() Message { return new(camt.Document08800101) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.001.001.01": func() Message { return new(catm.Document00100101) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.001.001.02": func() Message { return new(catm.Document00100102) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.001.001.03": func() Message { return new(catm.Document00100103) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.001.001.04": func() Message { return new(catm.Document00100104) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.001.001.05": func() Message { return new(catm.Document00100105) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.002.001.01": func() Message { return new(catm.Document00200101) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.002.001.02": func() Message { return new(catm.Document00200102) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.002.001.03": func() Message { return new(catm.Document00200103) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.002.001.04": func() Message { return new(catm.Document00200104) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.002.001.05": func() Message { return new(catm.Document00200105) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.003.001.01": func() Message { return new(catm.Document00300101) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.003.001.02": func() Message { return new(catm.Document00300102) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.003.001.03": func() Message { return new(catm.Document00300103) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.003.001.04": func() Message { return new(catm.Document00300104) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.003.001.05": func() Message { return new(catm.Document00300105) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.004.001.01": func() Message { return new(catm.Document00400101) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.004.001.02": func() Message { return new(catm.Document00400102) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.004.001.03": func() Message { return new(catm.Document00400103) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.004.001.04": func() Message { return new(catm.Document00400104) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.005.001.01": func() Message { return new(catm.Document00500101) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.005.001.02": func() Message { return new(catm.Document00500102) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.006.001.01": func() Message { return new(catm.Document00600101) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.006.001.02": func() Message { return new(catm.Document00600102) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.007.001.01": func() Message { return new(catm.Document00700101) },
+	"urn:iso:std:iso:20022:tech:xsd:catm.008.001.01": func() Message { return new(catm.Document00800101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.001.001.01": func() Message { return new(catp.Document00100101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.001.001.02": func() Message { return new(catp.Document00100102) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.002.001.01": func() Message { return new(catp.Document00200101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.002.001.02": func() Message { return new(catp.Document00200102) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.003.001.01": func() Message { return new(catp.Document00300101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.003.001.02": func() Message { return new(catp.Document00300102) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.004.001.01": func() Message { return new(catp.Document00400101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.004.001.02": func() Message { return new(catp.Document00400102) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.005.001.01": func() Message { return new(catp.Document00500101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.005.001.02": func() Message { return new(catp.Document00500102) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.006.001.01": func() Message { return new(catp.Document00600101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.006.001.02": func() Message { return new(catp.Document00600102) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.007.001.01": func() Message { return new(catp.Document00700101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.007.001.02": func() Message { return new(catp.Document00700102) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.008.001.01": func() Message { return new(catp.Document00800101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.008.001.02": func() Message { return new(catp.Document00800102) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.009.001.01": func() Message { return new(catp.Document00900101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.009.001.02": func() Message { return new(catp.Document00900102) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.010.001.01": func() Message { return new(catp.Document01000101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.010.001.02": func() Message { return new(catp.Document01000102) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.011.001.01": func() Message { return new(catp.Document01100101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.011.001.02": func() Message { return new(catp.Document01100102) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.012.001.01": func() Message { return new(catp.Document01200101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.013.001.01": func() Message { return new(catp.Document01300101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.014.001.01": func() Message { return new(catp.Document01400101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.015.001.01": func() Message { return new(catp.Document01500101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.016.001.01": func() Message { return new(catp.Document01600101) },
+	"urn:iso:std:iso:20022:tech:xsd:catp.017.001.01": func() Message { return new(catp.Document01700101) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.003.001.03": func() Message { return new(colr.Document00300103) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.003.001.04": func() Message { return new(colr.Document00300104) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.004.001.03": func() Message { return new(colr.Document00400103) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.004.001.04": func() Message { return new(colr.Document00400104) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.005.001.03": func() Message { return new(colr.Document00500103) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.005.001.04": func() Message { return new(colr.Document00500104) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.006.001.03": func() Message { return new(colr.Document00600103) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.006.001.04": func() Message { return new(colr.Document00600104) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.007.001.03": func() Message { return new(colr.Document00700103) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.007.001.04": func() Message { return new(colr.Document00700104) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.007.001.05": func() Message { return new(colr.Document00700105) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.008.001.03": func() Message { return new(colr.Document00800103) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.008.001.04": func() Message { return new(colr.Document00800104) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.008.001.05": func() Message { return new(colr.Document00800105) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.009.001.03": func() Message { return new(colr.Document00900103) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.009.001.04": func() Message { return new(colr.Document00900104) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.010.001.03": func() Message { return new(colr.Document01000103) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.010.001.04": func() Message { return new(colr.Document01000104) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.011.001.03": func() Message { return new(colr.Document01100103) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.011.001.04": func() Message { return new(colr.Document01100104) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.012.001.03": func() Message { return new(colr.Document01200103) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.012.001.04": func() Message { return new(colr.Document01200104) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.013.001.03": func() Message { return new(colr.Document01300103) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.013.001.04": func() Message { return new(colr.Document01300104) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.014.001.03": func() Message { return new(colr.Document01400103) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.014.001.04": func() Message { return new(colr.Document01400104) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.015.001.03": func() Message { return new(colr.Document01500103) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.015.001.04": func() Message { return new(colr.Document01500104) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.016.001.02": func() Message { return new(colr.Document01600102) },
+	"urn:iso:std:iso:20022:tech:xsd:colr.016.001.03": func() Message { return new(colr.Document01600103) },
+	"urn:iso:std:iso:20022:tech:xsd:fxtr.008.001.05": func() Message { return new(fxtr.Document00800105) },
+	"urn:iso:std:iso:20022:tech:xsd:fxtr.008.001.06": func() Message { return new(fxtr.Document00800106) },
+	"urn:iso:std:iso:20022:tech:xsd:fxtr.013.001.03": func() Message { return new(fxtr.Document01300103) },
+	"urn:iso:std:iso:20022:tech:xsd:fxtr.014.001.03": func() Message { return new(fxtr.Document01400103) },
+	"urn:iso:std:iso:20022:tech:xsd:fxtr.014.001.04": func() Message { return new(fxtr.Document01400104) },
+	"urn:iso:std:iso:20022:tech:xsd:fxtr.015.001.03": func() Message { return new(fxtr.Document01500103) },
+	"urn:iso:std:iso:20022:tech:xsd:fxtr.015.001.04": func() Message { return new(fxtr.Document01500104) },
+	"urn:iso:std:iso:20022:tech:xsd:fxtr.016.001.03": func() Message { return new(fxtr.Document01600103) },
+	"urn:iso:std:iso:20022:tech:xsd:fxtr.016.001.04": func() Message { return new(fxtr.Document01600104) },
+	"urn:iso:std:iso:20022:tech:xsd:fxtr.017.001.03": func() Message { return new(fxtr.Document01700103) },
+	"urn:iso:std:iso:20022:tech:xsd:fxtr.017.001.04": func() Message { return new(fxtr.Document01700104) },
+	"urn:iso:std:iso:20022:tech:xsd:fxtr.030.001.03": func() Message { return new(fxtr.Document03000103) },
+	"urn:iso:std:iso:20022:tech:xsd:fxtr.030.001.04": func() Message { return new(fxtr.Document03000104) },
+	"urn:iso:std:iso:20022:tech:xsd:head.001.001.01": func() Message { return new(head.AppHdr00100101) },
+	"urn:iso:std:iso:20022:tech:xsd:head.001.001.02": func() Message { return new(head.AppHdr00100102) },
+	"urn:iso:std:iso:20022:tech:xsd:head.001.001.03": func() Message { return new(head.AppHdr00100103) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.002.001.02": func() Message { return new(pacs.Document00200102) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.002.001.03": func() Message { return new(pacs.Document00200103) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.002.001.04": func() Message { return new(pacs.Document00200104) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.002.001.05": func() Message { return new(pacs.Document00200105) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.002.001.06": func() Message { return new(pacs.Document00200106) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.002.001.07": func() Message { return new(pacs.Document00200107) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.002.001.08": func() Message { return new(pacs.Document00200108) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.003.001.01": func() Message { return new(pacs.Document00300101) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.003.001.02": func() Message { return new(pacs.Document00300102) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.003.001.03": func() Message { return new(pacs.Document00300103) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.003.001.04": func() Message { return new(pacs.Document00300104) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.003.001.05": func() Message { return new(pacs.Document00300105) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.003.001.06": func() Message { return new(pacs.Document00300106) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.003.001.07": func() Message { return new(pacs.Document00300107) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.004.001.01": func() Message { return new(pacs.Document00400101) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.004.001.02": func() Message { return new(pacs.Document00400102) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.004.001.03": func() Message { return new(pacs.Document00400103) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.004.001.04": func() Message { return new(pacs.Document00400104) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.004.001.05": func() Message { return new(pacs.Document00400105) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.004.001.06": func() Message { return new(pacs.Document00400106) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.004.001.07": func() Message { return new(pacs.Document00400107) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.006.001.01": func() Message { return new(pacs.Document00600101) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.007.001.01": func() Message { return new(pacs.Document00700101) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.007.001.02": func() Message { return new(pacs.Document00700102) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.007.001.03": func() Message { return new(pacs.Document00700103) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.007.001.04": func() Message { return new(pacs.Document00700104) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.007.001.05": func() Message { return new(pacs.Document00700105) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.007.001.06": func() Message { return new(pacs.Document00700106) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.007.001.07": func() Message { return new(pacs.Document00700107) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.008.001.01": func() Message { return new(pacs.Document00800101) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.008.001.02": func() Message { return new(pacs.Document00800102) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.008.001.03": func() Message { return new(pacs.Document00800103) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.008.001.04": func() Message { return new(pacs.Document00800104) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.008.001.05": func() Message { return new(pacs.Document00800105) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.008.001.06": func() Message { return new(pacs.Document00800106) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08": func() Message { return new(pacs.Document00800108) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.009.001.01": func() Message { return new(pacs.Document00900101) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.009.001.02": func() Message { return new(pacs.Document00900102) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.009.001.03": func() Message { return new(pacs.Document00900103) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.009.001.04": func() Message { return new(pacs.Document00900104) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.009.001.05": func() Message { return new(pacs.Document00900105) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.009.001.06": func() Message { return new(pacs.Document00900106) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08": func() Message { return new(pacs.Document00900108) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.010.001.02": func() Message { return new(pacs.Document01000102) },
+	"urn:iso:std:iso:20022:tech:xsd:pacs.028.001.01": func() Message { return new(pacs.Document02800101) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.001.001.02": func() Message { return new(pain.Document00100102) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.001.001.03": func() Message { return new(pain.Document00100103) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.001.001.04": func() Message { return new(pain.Document00100104) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.001.001.05": func() Message { return new(pain.Document00100105) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.001.001.06": func() Message { return new(pain.Document00100106) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.001.001.07": func() Message { return new(pain.Document00100107) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.001.001.08": func() Message { return new(pain.Document00100108) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.001.001.09": func() Message { return new(pain.Document00100109) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.002.001.02": func() Message { return new(pain.Document00200102) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.002.001.03": func() Message { return new(pain.Document00200103) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.002.001.04": func() Message { return new(pain.Document00200104) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.002.001.05": func() Message { return new(pain.Document00200105) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.002.001.06": func() Message { return new(pain.Document00200106) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.002.001.07": func() Message { return new(pain.Document00200107) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.002.001.08": func() Message { return new(pain.Document00200108) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.006.001.01": func() Message { return new(pain.Document00600101) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.007.001.01": func() Message { return new(pain.Document00700101) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.007.001.02": func() Message { return new(pain.Document00700102) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.007.001.03": func() Message { return new(pain.Document00700103) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.007.001.04": func() Message { return new(pain.Document00700104) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.007.001.05": func() Message { return new(pain.Document00700105) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.007.001.06": func() Message { return new(pain.Document00700106) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.007.001.07": func() Message { return new(pain.Document00700107) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.008.001.01": func() Message { return new(pain.Document00800101) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.008.001.02": func() Message { return new(pain.Document00800102) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.008.001.03": func() Message { return new(pain.Document00800103) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.008.001.04": func() Message { return new(pain.Document00800104) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.008.001.05": func() Message { return new(pain.Document00800105) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.008.001.06": func() Message { return new(pain.Document00800106) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.008.001.07": func() Message { return new(pain.Document00800107) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.009.001.01": func() Message { return new(pain.Document00900101) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.009.001.02": func() Message { return new(pain.Document00900102) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.009.001.03": func() Message { return new(pain.Document00900103) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.009.001.04": func() Message { return new(pain.Document00900104) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.009.001.05": func() Message { return new(pain.Document00900105) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.010.001.01": func() Message { return new(pain.Document01000101) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.010.001.02": func() Message { return new(pain.Document01000102) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.010.001.03": func() Message { return new(pain.Document01000103) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.010.001.04": func() Message { return new(pain.Document01000104) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.010.001.05": func() Message { return new(pain.Document01000105) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.011.001.01": func() Message { return new(pain.Document01100101) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.011.001.02": func() Message { return new(pain.Document01100102) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.011.001.03": func() Message { return new(pain.Document01100103) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.011.001.04": func() Message { return new(pain.Document01100104) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.011.001.05": func() Message { return new(pain.Document01100105) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.012.001.01": func() Message { return new(pain.Document01200101) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.012.001.02": func() Message { return new(pain.Document01200102) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.012.001.03": func() Message { return new(pain.Document01200103) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.012.001.04": func() Message { return new(pain.Document01200104) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.012.001.05": func() Message { return new(pain.Document01200105) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.013.001.01": func() Message { return new(pain.Document01300101) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.013.001.02": func() Message { return new(pain.Document01300102) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.013.001.03": func() Message { return new(pain.Document01300103) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.013.001.04": func() Message { return new(pain.Document01300104) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.013.001.05": func() Message { return new(pain.Document01300105) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.013.001.06": func() Message { return new(pain.Document01300106) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.014.001.01": func() Message { return new(pain.Document01400101) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.014.001.02": func() Message { return new(pain.Document01400102) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.014.001.03": func() Message { return new(pain.Document01400103) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.014.001.04": func() Message { return new(pain.Document01400104) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.014.001.05": func() Message { return new(pain.Document01400105) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.014.001.06": func() Message { return new(pain.Document01400106) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.017.001.01": func() Message { return new(pain.Document01700101) },
+	"urn:iso:std:iso:20022:tech:xsd:pain.018.001.01": func() Message { return new(pain.Document01800101) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.001.001.02": func() Message { return new(reda.Document00100102) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.001.001.03": func() Message { return new(reda.Document00100103) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.001.001.04": func() Message { return new(reda.Document00100104) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.002.001.02": func() Message { return new(reda.Document00200102) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.002.001.03": func() Message { return new(reda.Document00200103) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.002.001.04": func() Message { return new(reda.Document00200104) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.003.001.02": func() Message { return new(reda.Document00300102) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.003.001.03": func() Message { return new(reda.Document00300103) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.004.001.02": func() Message { return new(reda.Document00400102) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.005.001.02": func() Message { return new(reda.Document00500102) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.056.001.01": func() Message { return new(reda.Document05600101) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.057.001.01": func() Message { return new(reda.Document05700101) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.058.001.01": func() Message { return new(reda.Document05800101) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.059.001.01": func() Message { return new(reda.Document05900101) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.060.001.01": func() Message { return new(reda.Document06000101) },
+	"urn:iso:std:iso:20022:tech:xsd:reda.061.001.01": func() Message { return new(reda.Document06100101) },
+	"urn:iso:std:iso:20022:tech:xsd:remt.001.001.01": func() Message { return new(remt.Document00100101) },
+	"urn:iso:std:iso:20022:tech:xsd:remt.001.001.02": func() Message { return new(remt.Document00100102) },
+	"urn:iso:std:iso:20022:tech:xsd:remt.001.001.03": func() Message { return new(remt.Document00100103) },
+	"urn:iso:std:iso:20022:tech:xsd:remt.002.001.01": func() Message { return new(remt.Document00200101) },
+	"urn:iso:std:iso:20022:tech:xsd:secl.001.001.03": func() Message { return new(secl.Document00100103) },
+	"urn:iso:std:iso:20022:tech:xsd:secl.002.001.03": func() Message { return new(secl.Document00200103) },
+	"urn:iso:std:iso:20022:tech:xsd:secl.003.001.03": func() Message { return new(secl.Document00300103) },
+	"urn:iso:std:iso:20022:tech:xsd:secl.004.001.03": func() Message { return new(secl.Document00400103) },
+	"urn:iso:std:iso:20022:tech:xsd:secl.005.001.02": func() Message { return new(secl.Document00500102) },
+	"urn:iso:std:iso:20022:tech:xsd:secl.006.001.02": func() Message { return new(secl.Document00600102) },
+	"urn:iso:std:iso:20022:tech:xsd:secl.007.001.03": func() Message { return new(secl.Document00700103) },
+	"urn:iso:std:iso:20022:tech:xsd:secl.008.001.03": func() Message { return new(secl.Document00800103) },
+	"urn:iso:std:iso:20022:tech:xsd:secl.009.001.03": func() Message { return new(secl.Document00900103) },
+	"urn:iso:std:iso:20022:tech:xsd:secl.010.001.03": func() Message { return new(secl.Document01000103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.001.001.02": func() Message { return new(seev.Document00100102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.001.001.03": func() Message { return new(seev.Document00100103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.001.001.04": func() Message { return new(seev.Document00100104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.001.001.05": func() Message { return new(seev.Document00100105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.002.001.02": func() Message { return new(seev.Document00200102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.002.001.03": func() Message { return new(seev.Document00200103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.002.001.04": func() Message { return new(seev.Document00200104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.002.001.05": func() Message { return new(seev.Document00200105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.003.001.02": func() Message { return new(seev.Document00300102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.003.001.03": func() Message { return new(seev.Document00300103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.003.001.04": func() Message { return new(seev.Document00300104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.003.001.05": func() Message { return new(seev.Document00300105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.004.001.02": func() Message { return new(seev.Document00400102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.004.001.03": func() Message { return new(seev.Document00400103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.004.001.04": func() Message { return new(seev.Document00400104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.004.001.05": func() Message { return new(seev.Document00400105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.005.001.02": func() Message { return new(seev.Document00500102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.005.001.03": func() Message { return new(seev.Document00500103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.005.001.04": func() Message { return new(seev.Document00500104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.005.001.05": func() Message { return new(seev.Document00500105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.006.001.02": func() Message { return new(seev.Document00600102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.006.001.03": func() Message { return new(seev.Document00600103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.006.001.04": func() Message { return new(seev.Document00600104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.006.001.05": func() Message { return new(seev.Document00600105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.007.001.02": func() Message { return new(seev.Document00700102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.007.001.03": func() Message { return new(seev.Document00700103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.007.001.04": func() Message { return new(seev.Document00700104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.007.001.05": func() Message { return new(seev.Document00700105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.008.001.02": func() Message { return new(seev.Document00800102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.008.001.03": func() Message { return new(seev.Document00800103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.008.001.04": func() Message { return new(seev.Document00800104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.008.001.05": func() Message { return new(seev.Document00800105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.009.001.01": func() Message { return new(seev.Document00900101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.010.001.01": func() Message { return new(seev.Document01000101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.011.001.01": func() Message { return new(seev.Document01100101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.012.001.01": func() Message { return new(seev.Document01200101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.013.001.01": func() Message { return new(seev.Document01300101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.014.001.01": func() Message { return new(seev.Document01400101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.015.001.01": func() Message { return new(seev.Document01500101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.016.001.01": func() Message { return new(seev.Document01600101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.017.001.01": func() Message { return new(seev.Document01700101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.018.001.01": func() Message { return new(seev.Document01800101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.019.001.01": func() Message { return new(seev.Document01900101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.020.001.01": func() Message { return new(seev.Document02000101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.021.001.01": func() Message { return new(seev.Document02100101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.022.001.01": func() Message { return new(seev.Document02200101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.023.001.01": func() Message { return new(seev.Document02300101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.024.001.01": func() Message { return new(seev.Document02400101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.025.001.01": func() Message { return new(seev.Document02500101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.026.001.01": func() Message { return new(seev.Document02600101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.027.001.01": func() Message { return new(seev.Document02700101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.028.001.01": func() Message { return new(seev.Document02800101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.029.001.01": func() Message { return new(seev.Document02900101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.030.001.01": func() Message { return new(seev.Document03000101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.031.001.01": func() Message { return new(seev.Document03100101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.031.001.02": func() Message { return new(seev.Document03100102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.031.001.03": func() Message { return new(seev.Document03100103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.031.001.04": func() Message { return new(seev.Document03100104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.031.001.05": func() Message { return new(seev.Document03100105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.031.001.06": func() Message { return new(seev.Document03100106) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.031.001.07": func() Message { return new(seev.Document03100107) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.031.002.06": func() Message { return new(seev.Document03100206) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.031.002.07": func() Message { return new(seev.Document03100207) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.032.001.01": func() Message { return new(seev.Document03200101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.032.001.02": func() Message { return new(seev.Document03200102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.032.001.03": func() Message { return new(seev.Document03200103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.032.001.04": func() Message { return new(seev.Document03200104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.032.001.05": func() Message { return new(seev.Document03200105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.032.001.06": func() Message { return new(seev.Document03200106) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.032.002.05": func() Message { return new(seev.Document03200205) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.032.002.06": func() Message { return new(seev.Document03200206) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.033.001.01": func() Message { return new(seev.Document03300101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.033.001.02": func() Message { return new(seev.Document03300102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.033.001.03": func() Message { return new(seev.Document03300103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.033.001.04": func() Message { return new(seev.Document03300104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.033.001.05": func() Message { return new(seev.Document03300105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.033.001.06": func() Message { return new(seev.Document03300106) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.033.001.07": func() Message { return new(seev.Document03300107) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.033.002.06": func() Message { return new(seev.Document03300206) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.033.002.07": func() Message { return new(seev.Document03300207) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.034.001.01": func() Message { return new(seev.Document03400101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.034.001.02": func() Message { return new(seev.Document03400102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.034.001.03": func() Message { return new(seev.Document03400103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.034.001.04": func() Message { return new(seev.Document03400104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.034.001.05": func() Message { return new(seev.Document03400105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.034.001.06": func() Message { return new(seev.Document03400106) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.034.001.07": func() Message { return new(seev.Document03400107) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.034.001.08": func() Message { return new(seev.Document03400108) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.034.002.07": func() Message { return new(seev.Document03400207) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.034.002.08": func() Message { return new(seev.Document03400208) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.035.001.01": func() Message { return new(seev.Document03500101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.035.001.02": func() Message { return new(seev.Document03500102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.035.001.03": func() Message { return new(seev.Document03500103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.035.001.04": func() Message { return new(seev.Document03500104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.035.001.05": func() Message { return new(seev.Document03500105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.035.001.06": func() Message { return new(seev.Document03500106) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.035.001.07": func() Message { return new(seev.Document03500107) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.035.001.08": func() Message { return new(seev.Document03500108) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.035.002.07": func() Message { return new(seev.Document03500207) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.035.002.08": func() Message { return new(seev.Document03500208) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.036.001.01": func() Message { return new(seev.Document03600101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.036.001.02": func() Message { return new(seev.Document03600102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.036.001.03": func() Message { return new(seev.Document03600103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.036.001.04": func() Message { return new(seev.Document03600104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.036.001.05": func() Message { return new(seev.Document03600105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.036.001.06": func() Message { return new(seev.Document03600106) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.036.001.07": func() Message { return new(seev.Document03600107) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.036.001.08": func() Message { return new(seev.Document03600108) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.036.002.07": func() Message { return new(seev.Document03600207) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.036.002.08": func() Message { return new(seev.Document03600208) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.037.001.01": func() Message { return new(seev.Document03700101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.037.001.02": func() Message { return new(seev.Document03700102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.037.001.03": func() Message { return new(seev.Document03700103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.037.001.04": func() Message { return new(seev.Document03700104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.037.001.05": func() Message { return new(seev.Document03700105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.037.001.06": func() Message { return new(seev.Document03700106) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.037.001.07": func() Message { return new(seev.Document03700107) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.037.001.08": func() Message { return new(seev.Document03700108) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.037.002.07": func() Message { return new(seev.Document03700207) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.037.002.08": func() Message { return new(seev.Document03700208) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.038.001.01": func() Message { return new(seev.Document03800101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.038.001.02": func() Message { return new(seev.Document03800102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.038.001.03": func() Message { return new(seev.Document03800103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.038.001.04": func() Message { return new(seev.Document03800104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.038.002.04": func() Message { return new(seev.Document03800204) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.039.001.01": func() Message { return new(seev.Document03900101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.039.001.02": func() Message { return new(seev.Document03900102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.039.001.03": func() Message { return new(seev.Document03900103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.039.001.04": func() Message { return new(seev.Document03900104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.039.001.05": func() Message { return new(seev.Document03900105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.039.001.06": func() Message { return new(seev.Document03900106) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.039.001.07": func() Message { return new(seev.Document03900107) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.039.002.06": func() Message { return new(seev.Document03900206) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.039.002.07": func() Message { return new(seev.Document03900207) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.040.001.01": func() Message { return new(seev.Document04000101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.040.001.02": func() Message { return new(seev.Document04000102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.040.001.03": func() Message { return new(seev.Document04000103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.040.001.04": func() Message { return new(seev.Document04000104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.040.001.05": func() Message { return new(seev.Document04000105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.040.001.06": func() Message { return new(seev.Document04000106) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.040.001.07": func() Message { return new(seev.Document04000107) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.040.002.06": func() Message { return new(seev.Document04000206) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.040.002.07": func() Message { return new(seev.Document04000207) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.041.001.01": func() Message { return new(seev.Document04100101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.041.001.02": func() Message { return new(seev.Document04100102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.041.001.03": func() Message { return new(seev.Document04100103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.041.001.04": func() Message { return new(seev.Document04100104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.041.001.05": func() Message { return new(seev.Document04100105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.041.001.06": func() Message { return new(seev.Document04100106) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.041.001.07": func() Message { return new(seev.Document04100107) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.041.002.06": func() Message { return new(seev.Document04100206) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.041.002.07": func() Message { return new(seev.Document04100207) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.042.001.01": func() Message { return new(seev.Document04200101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.042.001.02": func() Message { return new(seev.Document04200102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.042.001.03": func() Message { return new(seev.Document04200103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.042.001.04": func() Message { return new(seev.Document04200104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.042.001.05": func() Message { return new(seev.Document04200105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.042.001.06": func() Message { return new(seev.Document04200106) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.042.002.05": func() Message { return new(seev.Document04200205) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.042.002.06": func() Message { return new(seev.Document04200206) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.044.001.01": func() Message { return new(seev.Document04400101) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.044.001.02": func() Message { return new(seev.Document04400102) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.044.001.03": func() Message { return new(seev.Document04400103) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.044.001.04": func() Message { return new(seev.Document04400104) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.044.001.05": func() Message { return new(seev.Document04400105) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.044.001.06": func() Message { return new(seev.Document04400106) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.044.001.07": func() Message { return new(seev.Document04400107) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.044.002.06": func() Message { return new(seev.Document04400206) },
+	"urn:iso:std:iso:20022:tech:xsd:seev.044.002.07": func() Message { return new(seev.Document04400207) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.001.001.01": func() Message { return new(semt.Document00100101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.001.001.02": func() Message { return new(semt.Document00100102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.001.001.03": func() Message { return new(semt.Document00100103) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.002.001.01": func() Message { return new(semt.Document00200101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.002.001.02": func() Message { return new(semt.Document00200102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.002.001.03": func() Message { return new(semt.Document00200103) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.002.001.04": func() Message { return new(semt.Document00200104) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.002.001.05": func() Message { return new(semt.Document00200105) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.002.001.06": func() Message { return new(semt.Document00200106) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.002.001.07": func() Message { return new(semt.Document00200107) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.002.001.08": func() Message { return new(semt.Document00200108) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.002.001.09": func() Message { return new(semt.Document00200109) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.002.002.09": func() Message { return new(semt.Document00200209) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.003.001.01": func() Message { return new(semt.Document00300101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.003.001.02": func() Message { return new(semt.Document00300102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.003.001.03": func() Message { return new(semt.Document00300103) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.003.001.04": func() Message { return new(semt.Document00300104) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.003.001.05": func() Message { return new(semt.Document00300105) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.003.001.06": func() Message { return new(semt.Document00300106) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.003.001.07": func() Message { return new(semt.Document00300107) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.003.001.08": func() Message { return new(semt.Document00300108) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.003.001.09": func() Message { return new(semt.Document00300109) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.003.002.09": func() Message { return new(semt.Document00300209) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.004.001.01": func() Message { return new(semt.Document00400101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.004.001.02": func() Message { return new(semt.Document00400102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.005.001.01": func() Message { return new(semt.Document00500101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.005.001.02": func() Message { return new(semt.Document00500102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.006.001.01": func() Message { return new(semt.Document00600101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.006.001.02": func() Message { return new(semt.Document00600102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.006.001.03": func() Message { return new(semt.Document00600103) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.007.001.01": func() Message { return new(semt.Document00700101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.007.001.02": func() Message { return new(semt.Document00700102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.007.001.03": func() Message { return new(semt.Document00700103) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.013.001.01": func() Message { return new(semt.Document01300101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.013.001.02": func() Message { return new(semt.Document01300102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.013.001.03": func() Message { return new(semt.Document01300103) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.013.001.04": func() Message { return new(semt.Document01300104) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.013.002.04": func() Message { return new(semt.Document01300204) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.014.001.01": func() Message { return new(semt.Document01400101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.014.001.02": func() Message { return new(semt.Document01400102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.014.001.03": func() Message { return new(semt.Document01400103) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.014.001.04": func() Message { return new(semt.Document01400104) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.014.001.05": func() Message { return new(semt.Document01400105) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.014.002.04": func() Message { return new(semt.Document01400204) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.014.002.05": func() Message { return new(semt.Document01400205) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.015.001.01": func() Message { return new(semt.Document01500101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.015.001.02": func() Message { return new(semt.Document01500102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.015.001.03": func() Message { return new(semt.Document01500103) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.015.001.04": func() Message { return new(semt.Document01500104) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.015.001.05": func() Message { return new(semt.Document01500105) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.015.001.06": func() Message { return new(semt.Document01500106) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.015.002.05": func() Message { return new(semt.Document01500205) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.015.002.06": func() Message { return new(semt.Document01500206) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.016.001.01": func() Message { return new(semt.Document01600101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.016.001.02": func() Message { return new(semt.Document01600102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.016.001.03": func() Message { return new(semt.Document01600103) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.016.001.04": func() Message { return new(semt.Document01600104) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.016.001.05": func() Message { return new(semt.Document01600105) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.016.001.06": func() Message { return new(semt.Document01600106) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.016.002.05": func() Message { return new(semt.Document01600205) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.016.002.06": func() Message { return new(semt.Document01600206) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.017.001.01": func() Message { return new(semt.Document01700101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.017.001.02": func() Message { return new(semt.Document01700102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.017.001.03": func() Message { return new(semt.Document01700103) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.017.001.04": func() Message { return new(semt.Document01700104) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.017.001.05": func() Message { return new(semt.Document01700105) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.017.001.06": func() Message { return new(semt.Document01700106) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.017.001.07": func() Message { return new(semt.Document01700107) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.017.002.06": func() Message { return new(semt.Document01700206) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.017.002.07": func() Message { return new(semt.Document01700207) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.018.001.01": func() Message { return new(semt.Document01800101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.018.001.02": func() Message { return new(semt.Document01800102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.018.001.03": func() Message { return new(semt.Document01800103) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.018.001.04": func() Message { return new(semt.Document01800104) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.018.001.05": func() Message { return new(semt.Document01800105) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.018.001.06": func() Message { return new(semt.Document01800106) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.018.001.07": func() Message { return new(semt.Document01800107) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.018.001.08": func() Message { return new(semt.Document01800108) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.018.002.07": func() Message { return new(semt.Document01800207) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.018.002.08": func() Message { return new(semt.Document01800208) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.019.001.01": func() Message { return new(semt.Document01900101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.019.001.02": func() Message { return new(semt.Document01900102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.019.001.03": func() Message { return new(semt.Document01900103) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.019.001.04": func() Message { return new(semt.Document01900104) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.019.001.05": func() Message { return new(semt.Document01900105) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.019.001.06": func() Message { return new(semt.Document01900106) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.019.002.05": func() Message { return new(semt.Document01900205) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.019.002.06": func() Message { return new(semt.Document01900206) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.020.001.01": func() Message { return new(semt.Document02000101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.020.001.02": func() Message { return new(semt.Document02000102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.020.001.03": func() Message { return new(semt.Document02000103) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.020.001.04": func() Message { return new(semt.Document02000104) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.020.001.05": func() Message { return new(semt.Document02000105) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.020.002.05": func() Message { return new(semt.Document02000205) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.021.001.01": func() Message { return new(semt.Document02100101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.021.001.02": func() Message { return new(semt.Document02100102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.021.001.03": func() Message { return new(semt.Document02100103) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.021.001.04": func() Message { return new(semt.Document02100104) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.021.001.05": func() Message { return new(semt.Document02100105) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.021.001.06": func() Message { return new(semt.Document02100106) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.021.002.06": func() Message { return new(semt.Document02100206) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.022.001.01": func() Message { return new(semt.Document02200101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.022.001.02": func() Message { return new(semt.Document02200102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.022.001.03": func() Message { return new(semt.Document02200103) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.022.002.03": func() Message { return new(semt.Document02200203) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.023.001.01": func() Message { return new(semt.Document02300101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.024.001.01": func() Message { return new(semt.Document02400101) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.041.001.02": func() Message { return new(semt.Document04100102) },
+	"urn:iso:std:iso:20022:tech:xsd:semt.042.001.01": func() Message { return new(semt.Document04200101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.001.001.01": func() Message { return new(sese.Document00100101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.001.001.02": func() Message { return new(sese.Document00100102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.001.001.03": func() Message { return new(sese.Document00100103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.001.001.04": func() Message { return new(sese.Document00100104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.001.001.05": func() Message { return new(sese.Document00100105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.001.001.06": func() Message { return new(sese.Document00100106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.001.001.07": func() Message { return new(sese.Document00100107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.002.001.01": func() Message { return new(sese.Document00200101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.002.001.02": func() Message { return new(sese.Document00200102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.002.001.03": func() Message { return new(sese.Document00200103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.002.001.04": func() Message { return new(sese.Document00200104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.002.001.05": func() Message { return new(sese.Document00200105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.002.001.06": func() Message { return new(sese.Document00200106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.002.001.07": func() Message { return new(sese.Document00200107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.003.001.01": func() Message { return new(sese.Document00300101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.003.001.02": func() Message { return new(sese.Document00300102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.003.001.03": func() Message { return new(sese.Document00300103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.003.001.04": func() Message { return new(sese.Document00300104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.003.001.05": func() Message { return new(sese.Document00300105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.003.001.06": func() Message { return new(sese.Document00300106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.003.001.07": func() Message { return new(sese.Document00300107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.004.001.01": func() Message { return new(sese.Document00400101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.004.001.02": func() Message { return new(sese.Document00400102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.004.001.03": func() Message { return new(sese.Document00400103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.004.001.04": func() Message { return new(sese.Document00400104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.004.001.05": func() Message { return new(sese.Document00400105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.004.001.06": func() Message { return new(sese.Document00400106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.004.001.07": func() Message { return new(sese.Document00400107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.005.001.01": func() Message { return new(sese.Document00500101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.005.001.02": func() Message { return new(sese.Document00500102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.005.001.03": func() Message { return new(sese.Document00500103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.005.001.04": func() Message { return new(sese.Document00500104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.005.001.05": func() Message { return new(sese.Document00500105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.005.001.06": func() Message { return new(sese.Document00500106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.005.001.07": func() Message { return new(sese.Document00500107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.006.001.01": func() Message { return new(sese.Document00600101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.006.001.02": func() Message { return new(sese.Document00600102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.006.001.03": func() Message { return new(sese.Document00600103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.006.001.04": func() Message { return new(sese.Document00600104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.006.001.05": func() Message { return new(sese.Document00600105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.006.001.06": func() Message { return new(sese.Document00600106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.006.001.07": func() Message { return new(sese.Document00600107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.007.001.01": func() Message { return new(sese.Document00700101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.007.001.02": func() Message { return new(sese.Document00700102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.007.001.03": func() Message { return new(sese.Document00700103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.007.001.04": func() Message { return new(sese.Document00700104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.007.001.05": func() Message { return new(sese.Document00700105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.007.001.06": func() Message { return new(sese.Document00700106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.007.001.07": func() Message { return new(sese.Document00700107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.008.001.01": func() Message { return new(sese.Document00800101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.008.001.02": func() Message { return new(sese.Document00800102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.008.001.03": func() Message { return new(sese.Document00800103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.008.001.04": func() Message { return new(sese.Document00800104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.008.001.05": func() Message { return new(sese.Document00800105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.008.001.06": func() Message { return new(sese.Document00800106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.008.001.07": func() Message { return new(sese.Document00800107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.009.001.01": func() Message { return new(sese.Document00900101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.009.001.02": func() Message { return new(sese.Document00900102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.009.001.03": func() Message { return new(sese.Document00900103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.009.001.04": func() Message { return new(sese.Document00900104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.009.001.05": func() Message { return new(sese.Document00900105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.010.001.01": func() Message { return new(sese.Document01000101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.010.001.02": func() Message { return new(sese.Document01000102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.010.001.03": func() Message { return new(sese.Document01000103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.010.001.04": func() Message { return new(sese.Document01000104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.010.001.05": func() Message { return new(sese.Document01000105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.011.001.01": func() Message { return new(sese.Document01100101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.011.001.02": func() Message { return new(sese.Document01100102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.011.001.03": func() Message { return new(sese.Document01100103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.011.001.04": func() Message { return new(sese.Document01100104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.011.001.05": func() Message { return new(sese.Document01100105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.012.001.02": func() Message { return new(sese.Document01200102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.012.001.03": func() Message { return new(sese.Document01200103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.012.001.04": func() Message { return new(sese.Document01200104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.012.001.05": func() Message { return new(sese.Document01200105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.012.001.06": func() Message { return new(sese.Document01200106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.012.001.07": func() Message { return new(sese.Document01200107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.013.001.02": func() Message { return new(sese.Document01300102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.013.001.03": func() Message { return new(sese.Document01300103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.013.001.04": func() Message { return new(sese.Document01300104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.013.001.05": func() Message { return new(sese.Document01300105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.013.001.06": func() Message { return new(sese.Document01300106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.013.001.07": func() Message { return new(sese.Document01300107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.014.001.02": func() Message { return new(sese.Document01400102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.014.001.03": func() Message { return new(sese.Document01400103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.014.001.04": func() Message { return new(sese.Document01400104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.014.001.05": func() Message { return new(sese.Document01400105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.014.001.06": func() Message { return new(sese.Document01400106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.014.001.07": func() Message { return new(sese.Document01400107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.018.001.01": func() Message { return new(sese.Document01800101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.018.001.02": func() Message { return new(sese.Document01800102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.018.001.03": func() Message { return new(sese.Document01800103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.018.001.04": func() Message { return new(sese.Document01800104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.018.001.05": func() Message { return new(sese.Document01800105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.019.001.01": func() Message { return new(sese.Document01900101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.019.001.02": func() Message { return new(sese.Document01900102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.019.001.03": func() Message { return new(sese.Document01900103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.019.001.04": func() Message { return new(sese.Document01900104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.020.001.01": func() Message { return new(sese.Document02000101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.020.001.02": func() Message { return new(sese.Document02000102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.020.001.03": func() Message { return new(sese.Document02000103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.020.001.04": func() Message { return new(sese.Document02000104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.020.001.05": func() Message { return new(sese.Document02000105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.020.002.05": func() Message { return new(sese.Document02000205) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.021.001.01": func() Message { return new(sese.Document02100101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.021.001.02": func() Message { return new(sese.Document02100102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.021.001.03": func() Message { return new(sese.Document02100103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.021.001.04": func() Message { return new(sese.Document02100104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.021.002.04": func() Message { return new(sese.Document02100204) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.022.001.01": func() Message { return new(sese.Document02200101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.022.001.02": func() Message { return new(sese.Document02200102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.022.001.03": func() Message { return new(sese.Document02200103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.022.001.04": func() Message { return new(sese.Document02200104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.022.002.04": func() Message { return new(sese.Document02200204) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.023.001.01": func() Message { return new(sese.Document02300101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.023.001.02": func() Message { return new(sese.Document02300102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.023.001.03": func() Message { return new(sese.Document02300103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.023.001.04": func() Message { return new(sese.Document02300104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.023.001.05": func() Message { return new(sese.Document02300105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.023.001.06": func() Message { return new(sese.Document02300106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.023.001.07": func() Message { return new(sese.Document02300107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.023.002.06": func() Message { return new(sese.Document02300206) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.023.002.07": func() Message { return new(sese.Document02300207) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.024.001.01": func() Message { return new(sese.Document02400101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.024.001.02": func() Message { return new(sese.Document02400102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.024.001.03": func() Message { return new(sese.Document02400103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.024.001.04": func() Message { return new(sese.Document02400104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.024.001.05": func() Message { return new(sese.Document02400105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.024.001.06": func() Message { return new(sese.Document02400106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.024.001.07": func() Message { return new(sese.Document02400107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.024.001.08": func() Message { return new(sese.Document02400108) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.024.002.07": func() Message { return new(sese.Document02400207) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.024.002.08": func() Message { return new(sese.Document02400208) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.025.001.01": func() Message { return new(sese.Document02500101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.025.001.02": func() Message { return new(sese.Document02500102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.025.001.03": func() Message { return new(sese.Document02500103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.025.001.04": func() Message { return new(sese.Document02500104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.025.001.05": func() Message { return new(sese.Document02500105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.025.001.06": func() Message { return new(sese.Document02500106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.025.001.07": func() Message { return new(sese.Document02500107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.025.002.06": func() Message { return new(sese.Document02500206) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.025.002.07": func() Message { return new(sese.Document02500207) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.026.001.01": func() Message { return new(sese.Document02600101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.026.001.02": func() Message { return new(sese.Document02600102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.026.001.03": func() Message { return new(sese.Document02600103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.026.001.04": func() Message { return new(sese.Document02600104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.026.001.05": func() Message { return new(sese.Document02600105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.026.001.06": func() Message { return new(sese.Document02600106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.026.002.05": func() Message { return new(sese.Document02600205) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.026.002.06": func() Message { return new(sese.Document02600206) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.027.001.01": func() Message { return new(sese.Document02700101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.027.001.02": func() Message { return new(sese.Document02700102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.027.001.03": func() Message { return new(sese.Document02700103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.027.001.04": func() Message { return new(sese.Document02700104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.027.001.05": func() Message { return new(sese.Document02700105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.027.002.05": func() Message { return new(sese.Document02700205) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.028.001.01": func() Message { return new(sese.Document02800101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.028.001.02": func() Message { return new(sese.Document02800102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.028.001.03": func() Message { return new(sese.Document02800103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.028.001.04": func() Message { return new(sese.Document02800104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.028.001.05": func() Message { return new(sese.Document02800105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.028.001.06": func() Message { return new(sese.Document02800106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.028.002.05": func() Message { return new(sese.Document02800205) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.028.002.06": func() Message { return new(sese.Document02800206) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.029.001.01": func() Message { return new(sese.Document02900101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.029.001.02": func() Message { return new(sese.Document02900102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.029.001.03": func() Message { return new(sese.Document02900103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.029.001.04": func() Message { return new(sese.Document02900104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.029.002.04": func() Message { return new(sese.Document02900204) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.030.001.01": func() Message { return new(sese.Document03000101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.030.001.02": func() Message { return new(sese.Document03000102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.030.001.03": func() Message { return new(sese.Document03000103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.030.001.04": func() Message { return new(sese.Document03000104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.030.001.05": func() Message { return new(sese.Document03000105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.030.001.06": func() Message { return new(sese.Document03000106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.030.001.07": func() Message { return new(sese.Document03000107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.030.002.06": func() Message { return new(sese.Document03000206) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.030.002.07": func() Message { return new(sese.Document03000207) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.031.001.01": func() Message { return new(sese.Document03100101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.031.001.02": func() Message { return new(sese.Document03100102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.031.001.03": func() Message { return new(sese.Document03100103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.031.001.04": func() Message { return new(sese.Document03100104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.031.001.05": func() Message { return new(sese.Document03100105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.031.001.06": func() Message { return new(sese.Document03100106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.031.001.07": func() Message { return new(sese.Document03100107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.031.002.07": func() Message { return new(sese.Document03100207) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.032.001.01": func() Message { return new(sese.Document03200101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.032.001.02": func() Message { return new(sese.Document03200102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.032.001.03": func() Message { return new(sese.Document03200103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.032.001.04": func() Message { return new(sese.Document03200104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.032.001.05": func() Message { return new(sese.Document03200105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.032.001.06": func() Message { return new(sese.Document03200106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.032.001.07": func() Message { return new(sese.Document03200107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.032.002.06": func() Message { return new(sese.Document03200206) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.032.002.07": func() Message { return new(sese.Document03200207) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.033.001.01": func() Message { return new(sese.Document03300101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.033.001.02": func() Message { return new(sese.Document03300102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.033.001.03": func() Message { return new(sese.Document03300103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.033.001.04": func() Message { return new(sese.Document03300104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.033.001.05": func() Message { return new(sese.Document03300105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.033.001.06": func() Message { return new(sese.Document03300106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.033.001.07": func() Message { return new(sese.Document03300107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.033.002.06": func() Message { return new(sese.Document03300206) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.033.002.07": func() Message { return new(sese.Document03300207) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.034.001.01": func() Message { return new(sese.Document03400101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.034.001.02": func() Message { return new(sese.Document03400102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.034.001.03": func() Message { return new(sese.Document03400103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.034.001.04": func() Message { return new(sese.Document03400104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.034.001.05": func() Message { return new(sese.Document03400105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.034.001.06": func() Message { return new(sese.Document03400106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.034.001.07": func() Message { return new(sese.Document03400107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.034.002.06": func() Message { return new(sese.Document03400206) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.034.002.07": func() Message { return new(sese.Document03400207) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.035.001.01": func() Message { return new(sese.Document03500101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.035.001.02": func() Message { return new(sese.Document03500102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.035.001.03": func() Message { return new(sese.Document03500103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.035.001.04": func() Message { return new(sese.Document03500104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.035.001.05": func() Message { return new(sese.Document03500105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.035.001.06": func() Message { return new(sese.Document03500106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.035.001.07": func() Message { return new(sese.Document03500107) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.035.002.06": func() Message { return new(sese.Document03500206) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.035.002.07": func() Message { return new(sese.Document03500207) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.036.001.01": func() Message { return new(sese.Document03600101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.036.001.02": func() Message { return new(sese.Document03600102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.036.001.03": func() Message { return new(sese.Document03600103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.036.001.04": func() Message { return new(sese.Document03600104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.036.001.05": func() Message { return new(sese.Document03600105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.036.001.06": func() Message { return new(sese.Document03600106) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.036.002.05": func() Message { return new(sese.Document03600205) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.036.002.06": func() Message { return new(sese.Document03600206) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.037.001.01": func() Message { return new(sese.Document03700101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.037.001.02": func() Message { return new(sese.Document03700102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.037.001.03": func() Message { return new(sese.Document03700103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.037.001.04": func() Message { return new(sese.Document03700104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.037.002.04": func() Message { return new(sese.Document03700204) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.038.001.01": func() Message { return new(sese.Document03800101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.038.001.02": func() Message { return new(sese.Document03800102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.038.001.03": func() Message { return new(sese.Document03800103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.038.001.04": func() Message { return new(sese.Document03800104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.038.001.05": func() Message { return new(sese.Document03800105) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.038.002.04": func() Message { return new(sese.Document03800204) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.038.002.05": func() Message { return new(sese.Document03800205) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.039.001.01": func() Message { return new(sese.Document03900101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.039.001.02": func() Message { return new(sese.Document03900102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.039.001.03": func() Message { return new(sese.Document03900103) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.039.001.04": func() Message { return new(sese.Document03900104) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.039.002.04": func() Message { return new(sese.Document03900204) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.040.001.01": func() Message { return new(sese.Document04000101) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.040.001.02": func() Message { return new(sese.Document04000102) },
+	"urn:iso:std:iso:20022:tech:xsd:sese.040.002.02": func() Message { return new(sese.Document04000202) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.001.001.02": func() Message { return new(setr.Document00100102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.001.001.03": func() Message { return new(setr.Document00100103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.001.001.04": func() Message { return new(setr.Document00100104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.002.001.02": func() Message { return new(setr.Document00200102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.002.001.03": func() Message { return new(setr.Document00200103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.002.001.04": func() Message { return new(setr.Document00200104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.003.001.02": func() Message { return new(setr.Document00300102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.003.001.03": func() Message { return new(setr.Document00300103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.003.001.04": func() Message { return new(setr.Document00300104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.004.001.02": func() Message { return new(setr.Document00400102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.004.001.03": func() Message { return new(setr.Document00400103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.004.001.04": func() Message { return new(setr.Document00400104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.005.001.02": func() Message { return new(setr.Document00500102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.005.001.03": func() Message { return new(setr.Document00500103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.005.001.04": func() Message { return new(setr.Document00500104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.006.001.02": func() Message { return new(setr.Document00600102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.006.001.03": func() Message { return new(setr.Document00600103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.006.001.04": func() Message { return new(setr.Document00600104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.007.001.02": func() Message { return new(setr.Document00700102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.007.001.03": func() Message { return new(setr.Document00700103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.007.001.04": func() Message { return new(setr.Document00700104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.008.001.02": func() Message { return new(setr.Document00800102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.008.001.03": func() Message { return new(setr.Document00800103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.008.001.04": func() Message { return new(setr.Document00800104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.009.001.02": func() Message { return new(setr.Document00900102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.009.001.03": func() Message { return new(setr.Document00900103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.009.001.04": func() Message { return new(setr.Document00900104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.010.001.02": func() Message { return new(setr.Document01000102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.010.001.03": func() Message { return new(setr.Document01000103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.010.001.04": func() Message { return new(setr.Document01000104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.011.001.02": func() Message { return new(setr.Document01100102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.011.001.03": func() Message { return new(setr.Document01100103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.011.001.04": func() Message { return new(setr.Document01100104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.012.001.02": func() Message { return new(setr.Document01200102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.012.001.03": func() Message { return new(setr.Document01200103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.012.001.04": func() Message { return new(setr.Document01200104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.013.001.02": func() Message { return new(setr.Document01300102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.013.001.03": func() Message { return new(setr.Document01300103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.013.001.04": func() Message { return new(setr.Document01300104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.014.001.02": func() Message { return new(setr.Document01400102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.014.001.03": func() Message { return new(setr.Document01400103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.014.001.04": func() Message { return new(setr.Document01400104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.015.001.02": func() Message { return new(setr.Document01500102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.015.001.03": func() Message { return new(setr.Document01500103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.015.001.04": func() Message { return new(setr.Document01500104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.016.001.02": func() Message { return new(setr.Document01600102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.016.001.03": func() Message { return new(setr.Document01600103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.016.001.04": func() Message { return new(setr.Document01600104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.017.001.02": func() Message { return new(setr.Document01700102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.017.001.03": func() Message { return new(setr.Document01700103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.017.001.04": func() Message { return new(setr.Document01700104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.018.001.02": func() Message { return new(setr.Document01800102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.018.001.03": func() Message { return new(setr.Document01800103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.018.001.04": func() Message { return new(setr.Document01800104) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.027.001.01": func() Message { return new(setr.Document02700101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.027.001.02": func() Message { return new(setr.Document02700102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.027.001.03": func() Message { return new(setr.Document02700103) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.029.001.01": func() Message { return new(setr.Document02900101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.030.001.01": func() Message { return new(setr.Document03000101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.044.001.01": func() Message { return new(setr.Document04400101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.044.001.02": func() Message { return new(setr.Document04400102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.047.001.01": func() Message { return new(setr.Document04700101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.047.001.02": func() Message { return new(setr.Document04700102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.048.001.01": func() Message { return new(setr.Document04800101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.049.001.01": func() Message { return new(setr.Document04900101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.049.001.02": func() Message { return new(setr.Document04900102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.050.001.01": func() Message { return new(setr.Document05000101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.051.001.01": func() Message { return new(setr.Document05100101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.051.001.02": func() Message { return new(setr.Document05100102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.052.001.01": func() Message { return new(setr.Document05200101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.053.001.01": func() Message { return new(setr.Document05300101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.053.001.02": func() Message { return new(setr.Document05300102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.054.001.01": func() Message { return new(setr.Document05400101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.055.001.01": func() Message { return new(setr.Document05500101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.055.001.02": func() Message { return new(setr.Document05500102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.056.001.01": func() Message { return new(setr.Document05600101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.057.001.01": func() Message { return new(setr.Document05700101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.057.001.02": func() Message { return new(setr.Document05700102) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.058.001.01": func() Message { return new(setr.Document05800101) },
+	"urn:iso:std:iso:20022:tech:xsd:setr.058.001.02": func() Message { return new(setr.Document05800102) },
+	"urn:iso:std:iso:20022:tech:xsd:supl.017.001.01": func() Message { return new(supl.Document01700101) },
+	"urn:iso:std:iso:20022:tech:xsd:supl.027.001.01": func() Message { return new(supl.Document02700101) },
+	"urn:iso:std:iso:20022:tech:xsd:trea.001.001.02": func() Message { return new(trea.Document00100102) },
+	"urn:iso:std:iso:20022:tech:xsd:trea.002.001.02": func() Message { return new(trea.Document00200102) },
+	"urn:iso:std:iso:20022:tech:xsd:trea.003.001.02": func() Message { return new(trea.Document00300102) },
+	"urn:iso:std:iso:20022:tech:xsd:trea.004.001.02": func() Message { return new(trea.Document00400102) },
+	"urn:iso:std:iso:20022:tech:xsd:trea.005.001.02": func() Message { return new(trea.Document00500102) },
+	"urn:iso:std:iso:20022:tech:xsd:trea.006.001.02": func() Message { return new(trea.Document00600102) },
+	"urn:iso:std:iso:20022:tech:xsd:trea.007.001.02": func() Message { return new(trea.Document00700102) },
+	"urn:iso:std:iso:20022:tech:xsd:trea.008.001.02": func() Message { return new(trea.Document00800102) },
+	"urn:iso:std:iso:20022:tech:xsd:trea.009.001.02": func() Message { return new(trea.Document00900102) },
+	"urn:iso:std:iso:20022:tech:xsd:trea.010.001.02": func() Message { return new(trea.Document01000102) },
+	"urn:iso:std:iso:20022:tech:xsd:trea.011.001.02": func() Message { return new(trea.Document01100102) },
+	"urn:iso:std:iso:20022:tech:xsd:trea.012.001.02": func() Message { return new(trea.Document01200102) },
+	"urn:iso:std:iso:20022:tech:xsd:trea.013.001.01": func() Message { return new(trea.Document01300101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsin.001.001.01": func() Message { return new(tsin.Document00100101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsin.002.001.01": func() Message { return new(tsin.Document00200101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsin.003.001.01": func() Message { return new(tsin.Document00300101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsin.004.001.01": func() Message { return new(tsin.Document00400101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsin.005.001.01": func() Message { return new(tsin.Document00500101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsin.006.001.01": func() Message { return new(tsin.Document00600101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsin.007.001.01": func() Message { return new(tsin.Document00700101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsin.008.001.01": func() Message { return new(tsin.Document00800101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsin.009.001.01": func() Message { return new(tsin.Document00900101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsin.010.001.01": func() Message { return new(tsin.Document01000101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsin.011.001.01": func() Message { return new(tsin.Document01100101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsin.012.001.01": func() Message { return new(tsin.Document01200101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsin.013.001.01": func() Message { return new(tsin.Document01300101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.001.001.03": func() Message { return new(tsmt.Document00100103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.002.001.03": func() Message { return new(tsmt.Document00200103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.002.001.04": func() Message { return new(tsmt.Document00200104) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.003.001.03": func() Message { return new(tsmt.Document00300103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.004.001.02": func() Message { return new(tsmt.Document00400102) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.005.001.02": func() Message { return new(tsmt.Document00500102) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.006.001.03": func() Message { return new(tsmt.Document00600103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.007.001.02": func() Message { return new(tsmt.Document00700102) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.008.001.03": func() Message { return new(tsmt.Document00800103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.009.001.03": func() Message { return new(tsmt.Document00900103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.009.001.04": func() Message { return new(tsmt.Document00900104) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.009.001.05": func() Message { return new(tsmt.Document00900105) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.010.001.03": func() Message { return new(tsmt.Document01000103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.011.001.03": func() Message { return new(tsmt.Document01100103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.011.001.04": func() Message { return new(tsmt.Document01100104) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.012.001.03": func() Message { return new(tsmt.Document01200103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.012.001.04": func() Message { return new(tsmt.Document01200104) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.012.001.05": func() Message { return new(tsmt.Document01200105) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.013.001.03": func() Message { return new(tsmt.Document01300103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.014.001.03": func() Message { return new(tsmt.Document01400103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.014.001.04": func() Message { return new(tsmt.Document01400104) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.014.001.05": func() Message { return new(tsmt.Document01400105) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.015.001.03": func() Message { return new(tsmt.Document01500103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.016.001.03": func() Message { return new(tsmt.Document01600103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.017.001.03": func() Message { return new(tsmt.Document01700103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.017.001.04": func() Message { return new(tsmt.Document01700104) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.017.001.05": func() Message { return new(tsmt.Document01700105) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.018.001.03": func() Message { return new(tsmt.Document01800103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.018.001.04": func() Message { return new(tsmt.Document01800104) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.018.001.05": func() Message { return new(tsmt.Document01800105) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.019.001.03": func() Message { return new(tsmt.Document01900103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.019.001.04": func() Message { return new(tsmt.Document01900104) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.019.001.05": func() Message { return new(tsmt.Document01900105) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.020.001.02": func() Message { return new(tsmt.Document02000102) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.021.001.03": func() Message { return new(tsmt.Document02100103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.022.001.02": func() Message { return new(tsmt.Document02200102) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.023.001.03": func() Message { return new(tsmt.Document02300103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.024.001.03": func() Message { return new(tsmt.Document02400103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.025.001.03": func() Message { return new(tsmt.Document02500103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.026.001.02": func() Message { return new(tsmt.Document02600102) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.027.001.02": func() Message { return new(tsmt.Document02700102) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.028.001.03": func() Message { return new(tsmt.Document02800103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.029.001.02": func() Message { return new(tsmt.Document02900102) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.030.001.03": func() Message { return new(tsmt.Document03000103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.031.001.03": func() Message { return new(tsmt.Document03100103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.032.001.03": func() Message { return new(tsmt.Document03200103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.033.001.03": func() Message { return new(tsmt.Document03300103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.034.001.03": func() Message { return new(tsmt.Document03400103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.035.001.03": func() Message { return new(tsmt.Document03500103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.036.001.03": func() Message { return new(tsmt.Document03600103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.037.001.03": func() Message { return new(tsmt.Document03700103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.038.001.03": func() Message { return new(tsmt.Document03800103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.040.001.03": func() Message { return new(tsmt.Document04000103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.041.001.03": func() Message { return new(tsmt.Document04100103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.042.001.03": func() Message { return new(tsmt.Document04200103) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.044.001.01": func() Message { return new(tsmt.Document04400101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.044.001.02": func() Message { return new(tsmt.Document04400102) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.045.001.01": func() Message { return new(tsmt.Document04500101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.045.001.02": func() Message { return new(tsmt.Document04500102) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.046.001.01": func() Message { return new(tsmt.Document04600101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.047.001.01": func() Message { return new(tsmt.Document04700101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.048.001.01": func() Message { return new(tsmt.Document04800101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.049.001.01": func() Message { return new(tsmt.Document04900101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.050.001.01": func() Message { return new(tsmt.Document05000101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.051.001.01": func() Message { return new(tsmt.Document05100101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.052.001.01": func() Message { return new(tsmt.Document05200101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.053.001.01": func() Message { return new(tsmt.Document05300101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.054.001.01": func() Message { return new(tsmt.Document05400101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsmt.055.001.01": func() Message { return new(tsmt.Document05500101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.001.001.01": func() Message { return new(tsrv.Document00100101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.002.001.01": func() Message { return new(tsrv.Document00200101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.003.001.01": func() Message { return new(tsrv.Document00300101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.004.001.01": func() Message { return new(tsrv.Document00400101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.005.001.01": func() Message { return new(tsrv.Document00500101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.006.001.01": func() Message { return new(tsrv.Document00600101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.007.001.01": func() Message { return new(tsrv.Document00700101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.008.001.01": func() Message { return new(tsrv.Document00800101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.009.001.01": func() Message { return new(tsrv.Document00900101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.010.001.01": func() Message { return new(tsrv.Document01000101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.011.001.01": func() Message { return new(tsrv.Document01100101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.012.001.01": func() Message { return new(tsrv.Document01200101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.013.001.01": func() Message { return new(tsrv.Document01300101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.014.001.01": func() Message { return new(tsrv.Document01400101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.015.001.01": func() Message { return new(tsrv.Document01500101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.016.001.01": func() Message { return new(tsrv.Document01600101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.017.001.01": func() Message { return new(tsrv.Document01700101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.018.001.01": func() Message { return new(tsrv.Document01800101) },
+	"urn:iso:std:iso:20022:tech:xsd:tsrv.019.001.01": func() Message { return new(tsrv.Document01900101) },
+}
@@ -0,0 +1,35 @@
+package message
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestNewestVersions checks the newest versions of the payments messages that
+// the README lists as included.
+func TestNewestVersions(t *testing.T) {
+	newest := map[string]string{}
+	for _, namespace := range Namespaces() {
+		identifier := strings.TrimPrefix(namespace, NamespacePrefix)
+		family := identifier[:strings.LastIndexByte(identifier, '.')]
+		if identifier > newest[family] {
+			newest[family] = identifier
+		}
+	}
+	for family, want := range map[string]string{
+		"head.001.001": "head.001.001.03",
+		"pacs.008.001": "pacs.008.001.08",
+		"pacs.009.001": "pacs.009.001.08",
+		"pacs.002.001": "pacs.002.001.08",
+		"pacs.004.001": "pacs.004.001.07",
+		"pain.001.001": "pain.001.001.09",
+		"pain.002.001": "pain.002.001.08",
+		"camt.052.001": "camt.052.001.06",
+		"camt.053.001": "camt.053.001.08",
+		"camt.054.001": "camt.054.001.06",
+	} {
+		if newest[family] != want {
+			t.Errorf("newest version of %s is %s, the README lists %s", family, newest[family], want)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package message
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// TestCorpus parses the sample messages of testdata, encodes them again and
+// compares the canonical forms of both, so that no element or attribute of a
+// real-world message is lost. Files whose root element is not a Document are
+// read as an envelope of an AppHdr and a Document.
+func TestCorpus(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no sample messages in testdata")
+	}
+	for _, file := range files {
+		file := file
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var v interface{ Validate() error }
+			if strings.Contains(string(data), "<Envelope") {
+				v, err = UnmarshalEnvelope(data)
+			} else {
+				v, err = Unmarshal(data)
+			}
+			if err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			out, err := xml.Marshal(v)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			want, err := canonical(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := canonical(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want != got {
+				i := mismatch([]byte(want), []byte(got))
+				t.Fatalf("message differs after round trip at offset %d:\nwant %s\ngot  %s", i, excerpt([]byte(want), i), excerpt([]byte(got), i))
+			}
+		})
+	}
+}
+
+// canonical returns a canonical form of an XML document for comparison:
+// element and attribute names are qualified by their namespace, attributes are
+// sorted, namespace declarations, comments, processing instructions and the
+// whitespace between elements are left out, and every element has an end tag.
+func canonical(data []byte) (string, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	var b strings.Builder
+	var text strings.Builder
+	flush := func() {
+		if s := text.String(); strings.TrimSpace(s) != "" {
+			xml.EscapeText(&b, []byte(s))
+		}
+		text.Reset()
+	}
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			flush()
+			var attrs []string
+			for _, a := range t.Attr {
+				if a.Name.Space == "xmlns" || a.Name.Space == "" && a.Name.Local == "xmlns" {
+					continue
+				}
+				var v strings.Builder
+				xml.EscapeText(&v, []byte(a.Value))
+				attrs = append(attrs, " {"+a.Name.Space+"}"+a.Name.Local+`="`+v.String()+`"`)
+			}
+			sort.Strings(attrs)
+			b.WriteString("<{" + t.Name.Space + "}" + t.Name.Local + strings.Join(attrs, "") + ">")
+		case xml.EndElement:
+			flush()
+			b.WriteString("</{" + t.Name.Space + "}" + t.Name.Local + ">")
+		case xml.CharData:
+			text.Write(t)
+		}
+	}
+	return b.String(), nil
+}
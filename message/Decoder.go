@@ -0,0 +1,368 @@
+package message
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// xsiNamespace is the namespace of the XML Schema instance attributes, such as xsi:schemaLocation.
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// StrictError reports a part of the input rejected by a strict Decoder, at the
+// position of the offending element.
+type StrictError struct {
+	Line   int
+	Column int
+
+	// Path is the XML path of the offending element, for example
+	// /Document/FIToFICstmrCdtTrf/GrpHdr/MsgId.
+	Path string
+	Msg  string
+}
+
+func (e *StrictError) Error() string {
+	return fmt.Sprintf("message: line %d, column %d: %s: %s", e.Line, e.Column, e.Path, e.Msg)
+}
+
+// Decoder reads ISO 20022 messages from an input stream. By default it is as
+// lenient as encoding/xml, which skips the elements and attributes that the
+// Document type does not define; Strict makes it check the input against the
+// message definition.
+type Decoder struct {
+	r      io.Reader
+	d      *xml.Decoder
+	strict bool
+	data   []byte
+}
+
+// NewDecoder returns a lenient decoder reading from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r}
+}
+
+// Strict makes the decoder reject, with a *StrictError:
+//   - elements that the message definition does not define,
+//   - attributes that it does not define, other than namespace declarations and
+//     the XML Schema instance attributes,
+//   - elements out of the order of their sequence, or repeated while they are
+//     not repeatable,
+//   - more than one branch of a choice,
+//   - an envelope with other child elements than one AppHdr followed by one
+//     Document,
+//   - elements outside the namespace of their Document or AppHdr, and a root
+//     element that is not the Document of the expected message definition.
+//
+// The content of supplementary data and other elements whose content is not
+// defined by the message definition is not checked. A strict decoder reads
+// the whole input into memory, since each message is checked before it is
+// decoded. Strict must be called before the first message is read.
+func (d *Decoder) Strict() {
+	d.strict = true
+}
+
+// Decode reads the next message and decodes it into the Document type
+// registered for the namespace of its root element.
+func (d *Decoder) Decode() (Message, error) {
+	var m Message
+	err := d.decode(nil, func(x *xml.Decoder, start xml.StartElement) error {
+		var err error
+		if m, err = New(start.Name.Space); err != nil {
+			return err
+		}
+		return x.DecodeElement(m, &start)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DecodeMessage reads the next message into the Document m. In strict mode
+// its root element must be the Document of the message definition of m.
+func (d *Decoder) DecodeMessage(m Message) error {
+	return d.decode(m, func(x *xml.Decoder, start xml.StartElement) error {
+		return x.DecodeElement(m, &start)
+	})
+}
+
+// DecodeEnvelope reads the next envelope of an AppHdr and a Document, as
+// ParseEnvelope does. In strict mode the root element may only hold one AppHdr
+// followed by one Document.
+func (d *Decoder) DecodeEnvelope() (*Envelope, error) {
+	e := new(Envelope)
+	err := d.decode(e, func(x *xml.Decoder, start xml.StartElement) error {
+		return x.DecodeElement(e, &start)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// decode finds the next root element and decodes it with fn. In strict mode
+// the message is first checked against v, which is a Message, an *Envelope,
+// or nil for a Document of any registered namespace.
+func (d *Decoder) decode(v interface{}, fn func(x *xml.Decoder, start xml.StartElement) error) error {
+	x := d.d
+	switch {
+	case d.strict:
+		if d.data == nil {
+			data, err := io.ReadAll(d.r)
+			if err != nil {
+				return err
+			}
+			d.data = data
+		}
+		c := &checker{d: xml.NewDecoder(bytes.NewReader(d.data)), root: v}
+		if err := c.check(); err != nil {
+			return err
+		}
+		end := c.d.InputOffset()
+		x = xml.NewDecoder(bytes.NewReader(d.data[:end]))
+		d.data = d.data[end:]
+	case x == nil:
+		x = xml.NewDecoder(d.r)
+		d.d = x
+	}
+	for {
+		token, err := x.Token()
+		if err == io.EOF {
+			return ErrNoRootElement
+		}
+		if err != nil {
+			return err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return fn(x, start)
+		}
+	}
+}
+
+// checker checks the elements of a message against the layout of the Go types
+// of its message definition, which follows the XSD sequences of the elements.
+type checker struct {
+	d     *xml.Decoder
+	root  interface{}
+	stack []frame
+}
+
+// frame is an open element of the input.
+type frame struct {
+	path      string
+	layout    *layout
+	namespace string
+	envelope  bool
+	last      int
+}
+
+// check reads the next message and reports the first violation found.
+func (c *checker) check() error {
+	for {
+		line, column := c.d.InputPos()
+		token, err := c.d.Token()
+		if err == io.EOF {
+			return ErrNoRootElement
+		}
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			f, msg := c.start(t)
+			if msg != "" {
+				return &StrictError{Line: line, Column: column, Path: f.path, Msg: msg}
+			}
+			if f.layout != nil {
+				for _, a := range t.Attr {
+					if !f.layout.allows(a.Name) {
+						return &StrictError{Line: line, Column: column, Path: f.path, Msg: "unexpected attribute " + qualified(a.Name)}
+					}
+				}
+			}
+			c.stack = append(c.stack, f)
+		case xml.EndElement:
+			c.stack = c.stack[:len(c.stack)-1]
+			if len(c.stack) == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+// start returns the frame of an element and, if it is rejected, the reason.
+func (c *checker) start(t xml.StartElement) (frame, string) {
+	if len(c.stack) == 0 {
+		return c.rootFrame(t)
+	}
+	parent := &c.stack[len(c.stack)-1]
+	path := parent.path + "/" + t.Name.Local
+	if parent.envelope {
+		if msg := parent.next(t.Name.Local); msg != "" {
+			return frame{path: path}, msg
+		}
+		m, err := New(t.Name.Space)
+		if err != nil {
+			return frame{path: path}, ""
+		}
+		return c.document(path, t.Name, m)
+	}
+	if parent.layout == nil || parent.layout.any {
+		return frame{path: path}, ""
+	}
+	if t.Name.Space != parent.namespace {
+		return frame{path: path}, fmt.Sprintf("element %s is not in namespace %s", qualified(t.Name), parent.namespace)
+	}
+	if msg := parent.next(t.Name.Local); msg != "" {
+		return frame{path: path}, msg
+	}
+	e := parent.layout.elements[t.Name.Local]
+	return frame{path: path, layout: layoutOf(e.typ), namespace: parent.namespace, last: -1}, ""
+}
+
+// next records the child element local of the frame and, if it is rejected,
+// returns the reason.
+func (f *frame) next(local string) string {
+	e, ok := f.layout.elements[local]
+	switch {
+	case !ok:
+		return "unknown element " + local
+	case f.layout.choice && f.last >= 0 && e.index != f.last:
+		return fmt.Sprintf("element %s is a second branch of the choice, after %s", local, f.layout.tags[f.last])
+	case e.index < f.last:
+		return fmt.Sprintf("element %s is out of order, it comes before %s", local, f.layout.tags[f.last])
+	case e.index == f.last && !e.repeated:
+		return fmt.Sprintf("element %s is not repeatable", local)
+	}
+	f.last = e.index
+	return ""
+}
+
+func (c *checker) rootFrame(t xml.StartElement) (frame, string) {
+	path := "/" + t.Name.Local
+	switch root := c.root.(type) {
+	case *Envelope:
+		return frame{path: path, layout: envelopeLayout, envelope: true, last: -1}, ""
+	case Message:
+		return c.document(path, t.Name, root)
+	default:
+		// Namespaces that are not registered are reported when decoding, as in lenient mode.
+		m, err := New(t.Name.Space)
+		if err != nil {
+			return frame{path: path}, ""
+		}
+		return c.document(path, t.Name, m)
+	}
+}
+
+// document returns the frame of the root element of the Document or AppHdr m.
+func (c *checker) document(path string, name xml.Name, m Message) (frame, string) {
+	t := reflect.TypeOf(m).Elem()
+	field, _ := t.FieldByName("XMLName")
+	local := field.Tag.Get("xml")
+	local = local[strings.LastIndex(local, " ")+1:]
+	switch {
+	case name.Local != local:
+		return frame{path: path}, fmt.Sprintf("root element %s is not %s", qualified(name), local)
+	case name.Space != m.Namespace():
+		return frame{path: path}, fmt.Sprintf("element %s is not in namespace %s of %s", qualified(name), m.Namespace(), m.MessageDefinitionIdentifier())
+	}
+	return frame{path: path, layout: layoutOf(t), namespace: name.Space, last: -1}, ""
+}
+
+func qualified(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return "{" + name.Space + "}" + name.Local
+}
+
+// layout is the content of an element as defined by its Go type.
+type layout struct {
+	elements map[string]element
+	tags     []string
+	attrs    map[string]bool
+	any      bool
+
+	// choice is set for the types of XSD choices, whose names end in Choice.
+	choice bool
+}
+
+// envelopeLayout is the layout of the root element of an envelope.
+var envelopeLayout = &layout{
+	elements: map[string]element{"AppHdr": {index: 0}, "Document": {index: 1}},
+	tags:     []string{"AppHdr", "Document"},
+	attrs:    map[string]bool{},
+}
+
+// element is a child element of a layout.
+type element struct {
+	index    int
+	repeated bool
+	typ      reflect.Type
+}
+
+var layouts sync.Map
+
+// layoutOf returns the layout of the elements of type t.
+func layoutOf(t reflect.Type) *layout {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if l, ok := layouts.Load(t); ok {
+		return l.(*layout)
+	}
+	l := &layout{elements: map[string]element{}, attrs: map[string]bool{}, choice: strings.HasSuffix(t.Name(), "Choice")}
+	if t.Kind() == reflect.Struct {
+		l.add(t)
+	}
+	v, _ := layouts.LoadOrStore(t, l)
+	return v.(*layout)
+}
+
+// add adds the fields of the struct type t, and of the structs it embeds, to the layout.
+func (l *layout) add(t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Name == "XMLName" || field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+		tag := field.Tag.Get("xml")
+		if field.Anonymous && tag == "" || tag == ",inline" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				l.add(embedded)
+			}
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		switch {
+		case name == "-":
+		case strings.Contains(options, "innerxml"):
+			l.any = true
+		case strings.Contains(options, "attr"):
+			l.attrs[name] = true
+		case name != "":
+			l.elements[name] = element{index: len(l.tags), repeated: field.Type.Kind() == reflect.Slice, typ: field.Type}
+			l.tags = append(l.tags, name)
+		}
+	}
+}
+
+// allows reports whether an element of the layout may carry the attribute.
+func (l *layout) allows(name xml.Name) bool {
+	switch {
+	case name.Space == "xmlns", name.Space == "" && name.Local == "xmlns", name.Space == xsiNamespace:
+		return true
+	case name.Space == "":
+		return l.attrs[name.Local]
+	}
+	return false
+}
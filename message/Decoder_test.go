@@ -0,0 +1,91 @@
+package message
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestStrict checks the violations a strict Decoder reports, with the position
+// and path of the offending element.
+func TestStrict(t *testing.T) {
+	read := func(name string) string {
+		data, err := os.ReadFile(filepath.Join("testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	pacs008 := read("pacs.008.001.06.xml")
+	envelope := read("envelope.pacs.002.001.08.xml")
+	header := envelope[strings.Index(envelope, "<h:AppHdr") : strings.Index(envelope, "</h:AppHdr>")+len("</h:AppHdr>")]
+	document := envelope[strings.Index(envelope, "<Document") : strings.Index(envelope, "</Document>")+len("</Document>")]
+	tests := []struct {
+		name     string
+		data     string
+		envelope bool
+		want     StrictError
+	}{
+		{
+			name: "choice",
+			data: strings.Replace(pacs008, "</Othr>", "</Othr><IBAN>DE89370400440532013000</IBAN>", 1),
+			want: StrictError{Line: 58, Column: 28, Path: "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf/DbtrAcct/Id/IBAN"},
+		},
+		{
+			name: "order",
+			data: strings.Replace(pacs008, "<NbOfTxs>1</NbOfTxs>", "<NbOfTxs>1</NbOfTxs><CreDtTm>2015-09-28T16:00:00</CreDtTm>", 1),
+			want: StrictError{Line: 7, Column: 33, Path: "/Document/FIToFICstmrCdtTrf/GrpHdr/CreDtTm"},
+		},
+		{
+			name:     "header",
+			data:     strings.Replace(envelope, header, header+header, 1),
+			envelope: true,
+			want:     StrictError{Line: 43, Column: 14, Path: "/Envelope/AppHdr"},
+		},
+		{
+			name:     "document",
+			data:     strings.Replace(envelope, document, document+document, 1),
+			envelope: true,
+			want:     StrictError{Line: 76, Column: 14, Path: "/Envelope/Document"},
+		},
+		{
+			name:     "swapped",
+			data:     strings.Replace(strings.Replace(envelope, header, "", 1), document, document+header, 1),
+			envelope: true,
+			want:     StrictError{Line: 37, Column: 14, Path: "/Envelope/AppHdr"},
+		},
+	}
+	for _, test := range tests {
+		d := NewDecoder(strings.NewReader(test.data))
+		d.Strict()
+		var err error
+		if test.envelope {
+			_, err = d.DecodeEnvelope()
+		} else {
+			_, err = d.Decode()
+		}
+		var got *StrictError
+		if !errors.As(err, &got) {
+			t.Errorf("%s: got error %v, want a StrictError", test.name, err)
+			continue
+		}
+		if got.Line != test.want.Line || got.Column != test.want.Column || got.Path != test.want.Path {
+			t.Errorf("%s: got %v, want line %d, column %d at %s", test.name, got, test.want.Line, test.want.Column, test.want.Path)
+		}
+	}
+	for _, data := range []string{pacs008, envelope} {
+		d := NewDecoder(strings.NewReader(data))
+		d.Strict()
+		var err error
+		if strings.Contains(data, "<Envelope") {
+			_, err = d.DecodeEnvelope()
+		} else {
+			_, err = d.Decode()
+		}
+		if err != nil {
+			t.Errorf("sample message: %v", err)
+		}
+	}
+}
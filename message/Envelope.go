@@ -0,0 +1,180 @@
+package message
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/yudaprama/iso20022/model"
+)
+
+// Header is implemented by the AppHdr types of the head package.
+type Header interface {
+	Message
+
+	// BusinessMessageDefinitionIdentifier returns the MsgDefIdr element of the
+	// header, which identifies the message definition of the accompanying Document.
+	BusinessMessageDefinitionIdentifier() string
+}
+
+var (
+	// ErrNoHeader is reported for an envelope without AppHdr element.
+	ErrNoHeader = errors.New("message: envelope has no AppHdr")
+
+	// ErrNoDocument is reported for an envelope without Document element.
+	ErrNoDocument = errors.New("message: envelope has no Document")
+)
+
+// MismatchError reports a header whose MsgDefIdr does not identify the
+// message definition of the Document of the envelope.
+type MismatchError struct {
+	Header   string
+	Document string
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf("message: AppHdr MsgDefIdr %q does not match Document %s", e.Header, e.Document)
+}
+
+// Envelope pairs a Business Application Header with the Document it
+// accompanies, in the <Envelope><AppHdr/><Document/></Envelope> layout used by
+// CBPR+ and most RTGS systems. The name of the root element is kept when
+// decoding and defaults to Envelope when encoding. The root element must hold
+// at most one AppHdr and one Document element and no other child elements, so
+// that a header or Document cannot be smuggled in next to the one a signature
+// or a check covers.
+type Envelope struct {
+	XMLName  xml.Name
+	Header   Header
+	Document Message
+}
+
+// NewEnvelope returns an envelope for header and document. The MsgDefIdr of
+// the header is not set; use Check to verify it.
+func NewEnvelope(header Header, document Message) *Envelope {
+	return &Envelope{Header: header, Document: document}
+}
+
+// ParseEnvelope reads an envelope and decodes its AppHdr and Document elements
+// into the types registered for their namespaces.
+func ParseEnvelope(r io.Reader) (*Envelope, error) {
+	return NewDecoder(r).DecodeEnvelope()
+}
+
+// UnmarshalEnvelope is like ParseEnvelope but reads the envelope from a byte slice.
+func UnmarshalEnvelope(data []byte) (*Envelope, error) {
+	return ParseEnvelope(bytes.NewReader(data))
+}
+
+func (e *Envelope) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*e = Envelope{XMLName: start.Name}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch {
+			case t.Name.Local != "AppHdr" && t.Name.Local != "Document":
+				return fmt.Errorf("message: unexpected element %s in %s", t.Name.Local, start.Name.Local)
+			case t.Name.Local == "AppHdr" && e.Header != nil, t.Name.Local == "Document" && e.Document != nil:
+				return fmt.Errorf("message: duplicate %s element in %s", t.Name.Local, start.Name.Local)
+			}
+			m, err := New(t.Name.Space)
+			if err != nil {
+				return err
+			}
+			if err := d.DecodeElement(m, &t); err != nil {
+				return err
+			}
+			if t.Name.Local == "Document" {
+				e.Document = m
+				continue
+			}
+			h, ok := m.(Header)
+			if !ok {
+				return fmt.Errorf("message: %s is not a Business Application Header", m.MessageDefinitionIdentifier())
+			}
+			e.Header = h
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+func (e *Envelope) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	start.Name = e.XMLName
+	if start.Name.Local == "" {
+		start.Name.Local = "Envelope"
+	}
+	if err := enc.EncodeToken(start); err != nil {
+		return err
+	}
+	if e.Header != nil {
+		if err := enc.Encode(e.Header); err != nil {
+			return err
+		}
+	}
+	if e.Document != nil {
+		if err := enc.Encode(e.Document); err != nil {
+			return err
+		}
+	}
+	return enc.EncodeToken(start.End())
+}
+
+// Check verifies that the envelope carries a header and a Document and that
+// the MsgDefIdr of the header identifies the message definition of the Document.
+func (e *Envelope) Check() error {
+	if e.Header == nil {
+		return ErrNoHeader
+	}
+	if e.Document == nil {
+		return ErrNoDocument
+	}
+	if id := e.Header.BusinessMessageDefinitionIdentifier(); id != e.Document.MessageDefinitionIdentifier() {
+		return &MismatchError{Header: id, Document: e.Document.MessageDefinitionIdentifier()}
+	}
+	return nil
+}
+
+// Validate checks the envelope with Check and the structure of its header and
+// Document with their Validate methods. All problems are reported together in
+// model.ValidationErrors, with paths starting at the root element.
+func (e *Envelope) Validate() error {
+	root := "/" + e.XMLName.Local
+	if e.XMLName.Local == "" {
+		root = "/Envelope"
+	}
+	var errs model.ValidationErrors
+	if e.Header == nil {
+		errs = append(errs, &model.ValidationError{Path: root + "/AppHdr", Err: model.ErrMissing})
+	}
+	if e.Document == nil {
+		errs = append(errs, &model.ValidationError{Path: root + "/Document", Err: model.ErrMissing})
+	}
+	if err, ok := e.Check().(*MismatchError); ok {
+		errs = append(errs, &model.ValidationError{Path: root + "/AppHdr/MsgDefIdr", Err: err})
+	}
+	for _, m := range []Message{e.Header, e.Document} {
+		if m == nil {
+			continue
+		}
+		switch err := m.Validate().(type) {
+		case nil:
+		case model.ValidationErrors:
+			for _, v := range err {
+				errs = append(errs, &model.ValidationError{Path: root + v.Path, Err: v.Err})
+			}
+		default:
+			return err
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
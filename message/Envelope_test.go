@@ -0,0 +1,132 @@
+package message
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yudaprama/iso20022/codeset"
+	"github.com/yudaprama/iso20022/head"
+	"github.com/yudaprama/iso20022/model"
+)
+
+// TestEnvelopeChildren checks that an envelope with a repeated AppHdr or
+// Document, or with another child element, is rejected.
+func TestEnvelopeChildren(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "envelope.pacs.002.001.08.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := string(data)
+	header := s[strings.Index(s, "<h:AppHdr") : strings.Index(s, "</h:AppHdr>")+len("</h:AppHdr>")]
+	document := s[strings.Index(s, "<Document") : strings.Index(s, "</Document>")+len("</Document>")]
+	if _, err := UnmarshalEnvelope(data); err != nil {
+		t.Fatalf("sample envelope: %v", err)
+	}
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{"header", strings.Replace(s, header, header+header, 1), "duplicate AppHdr element in Envelope"},
+		{"document", strings.Replace(s, document, document+document, 1), "duplicate Document element in Envelope"},
+		{"other", strings.Replace(s, document, "<Signature/>"+document, 1), "unexpected element Signature in Envelope"},
+	}
+	for _, test := range tests {
+		_, err := UnmarshalEnvelope([]byte(test.data))
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: got error %v, want %q", test.name, err, test.err)
+		}
+	}
+}
+
+// TestExternalCode checks that a status reason missing from the snapshot of
+// the code sets is accepted and reported to codeset.Warn.
+func TestExternalCode(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "envelope.pacs.002.001.08.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var warned []string
+	codeset.Warn = func(set, code string) {
+		warned = append(warned, set+" "+code)
+	}
+	defer func() { codeset.Warn = nil }()
+	e, err := UnmarshalEnvelope([]byte(strings.Replace(string(data), "<Cd>AC04</Cd>", "<Cd>TM01</Cd>", 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Document.Validate(); err != nil {
+		t.Error(err)
+	}
+	if want := []string{"ExternalStatusReason1Code TM01"}; !reflect.DeepEqual(warned, want) {
+		t.Errorf("warned %v, want %v", warned, want)
+	}
+}
+
+// TestUnchecked checks that the value of a Code type without values is
+// accepted and reported to model.Unchecked.
+func TestUnchecked(t *testing.T) {
+	var unchecked []string
+	model.Unchecked = func(typ, value string) {
+		unchecked = append(unchecked, typ+" "+value)
+	}
+	defer func() { model.Unchecked = nil }()
+	var choice model.ChargeType2Choice
+	code := model.ChargeType1Code("BRKF")
+	choice.Code = &code
+	if err := choice.Validate(); err != nil {
+		t.Error(err)
+	}
+	var priority model.PaymentTypeInformation21
+	if err := priority.SetInstructionPriority("HIGH"); err != nil {
+		t.Fatal(err)
+	}
+	if err := priority.Validate(); err != nil {
+		t.Error(err)
+	}
+	if want := []string{"ChargeType1Code BRKF"}; !reflect.DeepEqual(unchecked, want) {
+		t.Errorf("unchecked %v, want %v", unchecked, want)
+	}
+}
+
+// TestHeaderFields checks that the MsgDefIdr of a header is read from its
+// Message field, apart from the MessageDefinitionIdentifier method, and that
+// the header is written back unchanged.
+func TestHeaderFields(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "envelope.pacs.002.001.08.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := UnmarshalEnvelope(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hdr, ok := e.Header.(*head.AppHdr00100102)
+	if !ok {
+		t.Fatalf("header of type %T", e.Header)
+	}
+	if hdr.Message.MessageDefinitionIdentifier == nil || *hdr.Message.MessageDefinitionIdentifier != "pacs.002.001.08" {
+		t.Errorf("MsgDefIdr = %v, want pacs.002.001.08", hdr.Message.MessageDefinitionIdentifier)
+	}
+	if id := hdr.MessageDefinitionIdentifier(); id != "head.001.001.02" {
+		t.Errorf("MessageDefinitionIdentifier() = %s, want head.001.001.02", id)
+	}
+	out, err := xml.Marshal(hdr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var again head.AppHdr00100102
+	if err := xml.Unmarshal(out, &again); err != nil {
+		t.Fatalf("%v\n%s", err, out)
+	}
+	if !reflect.DeepEqual(again.Message, hdr.Message) {
+		t.Errorf("header changed by a round trip:\n%s", out)
+	}
+	if err := xml.Unmarshal([]byte(`<AppHdr xmlns="urn:iso:std:iso:20022:tech:xsd:head.001.001.03"/>`), &again); err == nil {
+		t.Error("AppHdr of head.001.001.03 read as head.001.001.02")
+	}
+}
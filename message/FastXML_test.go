@@ -0,0 +1,246 @@
+package message
+
+import (
+	"bytes"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fastDocument is a Document with the generated MarshalXMLFast and
+// UnmarshalXMLFast methods.
+type fastDocument interface {
+	Message
+	MarshalXMLFast() ([]byte, error)
+	UnmarshalXMLFast(data []byte) error
+}
+
+// TestFastXML checks that the generated methods of a maximal instance of
+// every Document that has them produce the same XML as xml.Marshal and the
+// same Document as xml.Unmarshal.
+func TestFastXML(t *testing.T) {
+	count := 0
+	for _, namespace := range Namespaces() {
+		m, err := New(namespace)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := m.(fastDocument); !ok {
+			continue
+		}
+		count++
+		namespace := namespace
+		t.Run(strings.TrimPrefix(namespace, NamespacePrefix), func(t *testing.T) {
+			m, _ := New(namespace)
+			(&filler{path: map[reflect.Type]int{}}).fill(reflect.ValueOf(m).Elem())
+			compareFast(t, m.(fastDocument))
+		})
+	}
+	if count < 12 {
+		t.Fatalf("%d Documents have generated methods, want at least 12", count)
+	}
+}
+
+// TestFastXMLCorpus runs the sample messages of testdata through the generated
+// methods of their Document.
+func TestFastXMLCorpus(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m, err := Unmarshal(data)
+		if err != nil {
+			continue
+		}
+		doc, ok := m.(fastDocument)
+		if !ok {
+			continue
+		}
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			fast, _ := New(m.Namespace())
+			if err := fast.(fastDocument).UnmarshalXMLFast(data); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if !reflect.DeepEqual(m, fast) {
+				t.Fatalf("Document differs from xml.Unmarshal: %s", difference(reflect.ValueOf(m), reflect.ValueOf(fast), ""))
+			}
+			compareFast(t, doc)
+		})
+	}
+}
+
+// TestFastXMLInput checks that the decoder reads the lexical forms of XML
+// that encoding/xml accepts, and rejects what it rejects.
+func TestFastXMLInput(t *testing.T) {
+	const ns = "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.06"
+	tests := []struct {
+		name, data string
+		fails      bool
+	}{
+		{name: "prolog", data: `<?xml version="1.0" encoding="UTF-8"?>
+<!-- sample -->
+<Document xmlns="` + ns + `">
+	<FIToFICstmrCdtTrf>
+		<GrpHdr><MsgId>M1</MsgId><NbOfTxs>1</NbOfTxs></GrpHdr>
+	</FIToFICstmrCdtTrf>
+</Document>`},
+		{name: "prefix", data: `<p:Document xmlns:p="` + ns + `"><p:FIToFICstmrCdtTrf><p:GrpHdr><p:MsgId>M1</p:MsgId></p:GrpHdr></p:FIToFICstmrCdtTrf></p:Document>`},
+		{name: "references", data: `<Document xmlns="` + ns + `"><FIToFICstmrCdtTrf><GrpHdr><MsgId>A&amp;B&#65;&#x42;&lt;&quot;</MsgId></GrpHdr></FIToFICstmrCdtTrf></Document>`},
+		{name: "cdata", data: `<Document xmlns="` + ns + `"><FIToFICstmrCdtTrf><GrpHdr><MsgId><![CDATA[<M&1>]]><!-- c -->2</MsgId></GrpHdr></FIToFICstmrCdtTrf></Document>`},
+		{name: "line ends", data: "<Document xmlns=\"" + ns + "\"><FIToFICstmrCdtTrf><GrpHdr><MsgId>M\r\n1\r2</MsgId></GrpHdr></FIToFICstmrCdtTrf></Document>"},
+		{name: "attribute", data: `<Document xmlns="` + ns + `"><FIToFICstmrCdtTrf><GrpHdr><TtlIntrBkSttlmAmt Ccy='E&amp;R'>1.5</TtlIntrBkSttlmAmt></GrpHdr></FIToFICstmrCdtTrf></Document>`},
+		{name: "unknown", data: `<Document xmlns="` + ns + `"><FIToFICstmrCdtTrf><Unknown a="1"><MsgId>X</MsgId></Unknown><GrpHdr><MsgId>M1</MsgId></GrpHdr></FIToFICstmrCdtTrf></Document>`},
+		{name: "empty", data: `<Document xmlns="` + ns + `"><FIToFICstmrCdtTrf><GrpHdr><MsgId/></GrpHdr></FIToFICstmrCdtTrf></Document>`},
+		{name: "namespace", data: `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.07"></Document>`, fails: true},
+		{name: "root", data: `<Doc xmlns="` + ns + `"></Doc>`, fails: true},
+		{name: "mismatch", data: `<Document xmlns="` + ns + `"><FIToFICstmrCdtTrf></GrpHdr></Document>`, fails: true},
+		{name: "truncated", data: `<Document xmlns="` + ns + `"><FIToFICstmrCdtTrf><GrpHdr><MsgId>M1`, fails: true},
+		{name: "entity", data: `<Document xmlns="` + ns + `"><FIToFICstmrCdtTrf><GrpHdr><MsgId>&nbsp;</MsgId></GrpHdr></FIToFICstmrCdtTrf></Document>`, fails: true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			want, _ := New(ns)
+			wantErr := xml.Unmarshal([]byte(test.data), want)
+			got, _ := New(ns)
+			err := got.(fastDocument).UnmarshalXMLFast([]byte(test.data))
+			if test.fails {
+				if wantErr == nil || err == nil {
+					t.Fatalf("got errors %v and %v from xml.Unmarshal, want both to fail", wantErr, err)
+				}
+				return
+			}
+			if wantErr != nil {
+				t.Fatalf("xml.Unmarshal: %v", wantErr)
+			}
+			if err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if !reflect.DeepEqual(want, got) {
+				t.Fatalf("Document differs from xml.Unmarshal: %s", difference(reflect.ValueOf(want), reflect.ValueOf(got), ""))
+			}
+		})
+	}
+}
+
+// TestFastXMLEscape checks that text and attributes are escaped as
+// encoding/xml escapes them.
+func TestFastXMLEscape(t *testing.T) {
+	m, err := NewFromIdentifier("pacs.008.001.06")
+	if err != nil {
+		t.Fatal(err)
+	}
+	(&filler{path: map[reflect.Type]int{}}).fill(reflect.ValueOf(m).Elem())
+	const text = "A&B <C> \"D\" 'E'\t\n\r\x01\xffé\U0001F600\uFFFE"
+	body := reflect.ValueOf(m.Body()).Elem()
+	header := body.FieldByName("GroupHeader").Elem()
+	header.FieldByName("MessageIdentification").Elem().SetString(text)
+	header.FieldByName("TotalInterbankSettlementAmount").Elem().FieldByName("Currency").SetString(text)
+	compareFast(t, m.(fastDocument))
+}
+
+// compareFast checks the generated methods of doc against encoding/xml.
+func compareFast(t *testing.T, doc fastDocument) {
+	t.Helper()
+	want, err := xml.Marshal(doc)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	got, err := doc.MarshalXMLFast()
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if !bytes.Equal(want, got) {
+		i := mismatch(want, got)
+		t.Fatalf("XML differs from xml.Marshal at offset %d:\nwant %s\ngot  %s", i, excerpt(want, i), excerpt(got, i))
+	}
+	decoded, _ := New(doc.Namespace())
+	if err := xml.Unmarshal(want, decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	fast, _ := New(doc.Namespace())
+	if err := fast.(fastDocument).UnmarshalXMLFast(want); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, fast) {
+		t.Fatalf("Document differs from xml.Unmarshal: %s", difference(reflect.ValueOf(decoded), reflect.ValueOf(fast), ""))
+	}
+}
+
+var benchmarkSamples = []string{"pacs.008.001.06", "pain.001.001.08", "camt.053.001.06"}
+
+// BenchmarkMarshal compares xml.Marshal with MarshalXMLFast on the sample
+// messages of testdata.
+func BenchmarkMarshal(b *testing.B) {
+	for _, sample := range benchmarkSamples {
+		doc := loadSample(b, sample)
+		b.Run(sample+"/xml", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := xml.Marshal(doc); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(sample+"/fast", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := doc.MarshalXMLFast(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkUnmarshal compares xml.Unmarshal with UnmarshalXMLFast on the
+// sample messages of testdata.
+func BenchmarkUnmarshal(b *testing.B) {
+	for _, sample := range benchmarkSamples {
+		doc := loadSample(b, sample)
+		data, err := os.ReadFile(filepath.Join("testdata", sample+".xml"))
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(sample+"/xml", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				m, _ := New(doc.Namespace())
+				if err := xml.Unmarshal(data, m); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(sample+"/fast", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				m, _ := New(doc.Namespace())
+				if err := m.(fastDocument).UnmarshalXMLFast(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func loadSample(b *testing.B, sample string) fastDocument {
+	data, err := os.ReadFile(filepath.Join("testdata", sample+".xml"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	m, err := Unmarshal(data)
+	if err != nil {
+		b.Fatal(err)
+	}
+	return m.(fastDocument)
+}
@@ -0,0 +1,39 @@
+// Package message gives access to the Document types of all business area
+// packages through a single registry, so that an inbound ISO 20022 message can
+// be parsed without knowing its message definition in advance.
+package message
+
+// NamespacePrefix is the common prefix of the namespaces of all ISO 20022 message definitions.
+const NamespacePrefix = "urn:iso:std:iso:20022:tech:xsd:"
+
+// Message is implemented by every Document type of the business area packages.
+type Message interface {
+
+	// Namespace returns the XML namespace of the message definition,
+	// for example urn:iso:std:iso:20022:tech:xsd:pacs.008.001.06.
+	Namespace() string
+
+	// MessageDefinitionIdentifier returns the identifier of the message definition,
+	// for example pacs.008.001.06.
+	MessageDefinitionIdentifier() string
+
+	// BusinessArea returns the business area code of the message definition, for example pacs.
+	BusinessArea() string
+
+	// MessageFunctionality returns the message functionality number, for example 008.
+	MessageFunctionality() string
+
+	// Variant returns the variant number of the message definition, for example 001.
+	Variant() string
+
+	// Version returns the version number of the message definition, for example 06.
+	Version() string
+
+	// Body returns the message building block wrapped by the Document,
+	// for example *pacs.FIToFICustomerCreditTransferV06, or nil if it has not been added yet.
+	Body() interface{}
+
+	// Validate checks the structure of the whole message and reports every
+	// problem found as model.ValidationErrors addressed by XML path.
+	Validate() error
+}
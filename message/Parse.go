@@ -0,0 +1,29 @@
+package message
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// ErrNoRootElement is returned by Parse when the input does not contain any XML element.
+var ErrNoRootElement = errors.New("message: no root element")
+
+// Parse reads an ISO 20022 message, detects its message definition from the
+// namespace of the root element and decodes it into the matching Document type.
+func Parse(r io.Reader) (Message, error) {
+	return NewDecoder(r).Decode()
+}
+
+// Unmarshal is like Parse but reads the message from a byte slice.
+func Unmarshal(data []byte) (Message, error) {
+	return Parse(bytes.NewReader(data))
+}
+
+// UnmarshalStrict is like Unmarshal but checks the message against its message
+// definition, as a strict Decoder does.
+func UnmarshalStrict(data []byte) (Message, error) {
+	d := NewDecoder(bytes.NewReader(data))
+	d.Strict()
+	return d.Decode()
+}
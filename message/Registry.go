@@ -0,0 +1,62 @@
+package message
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]func() Message, len(catalogue))
+)
+
+func init() {
+	for namespace, fn := range catalogue {
+		registry[namespace] = fn
+	}
+}
+
+// Register makes a Document constructor available under the given namespace.
+// It can be used to add message definitions that are not part of this repository.
+// If Register is called twice with the same namespace it panics.
+func Register(namespace string, fn func() Message) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if fn == nil {
+		panic("message: Register constructor is nil")
+	}
+	if _, dup := registry[namespace]; dup {
+		panic("message: Register called twice for namespace " + namespace)
+	}
+	registry[namespace] = fn
+}
+
+// New returns a new, empty Document for the given namespace.
+func New(namespace string) (Message, error) {
+	registryMu.RLock()
+	fn, ok := registry[namespace]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("message: unknown namespace %q", namespace)
+	}
+	return fn(), nil
+}
+
+// NewFromIdentifier returns a new, empty Document for the given message
+// definition identifier, for example pacs.008.001.06.
+func NewFromIdentifier(identifier string) (Message, error) {
+	return New(NamespacePrefix + identifier)
+}
+
+// Namespaces returns the sorted list of registered namespaces.
+func Namespaces() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	list := make([]string, 0, len(registry))
+	for namespace := range registry {
+		list = append(list, namespace)
+	}
+	sort.Strings(list)
+	return list
+}
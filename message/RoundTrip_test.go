@@ -0,0 +1,140 @@
+package message
+
+import (
+	"bytes"
+	"encoding/xml"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestRoundTrip marshals a maximal instance of every registered Document,
+// with every element and attribute present, unmarshals it into a new Document
+// and checks that nothing was lost on the way.
+func TestRoundTrip(t *testing.T) {
+	for _, namespace := range Namespaces() {
+		namespace := namespace
+		t.Run(strings.TrimPrefix(namespace, NamespacePrefix), func(t *testing.T) {
+			t.Parallel()
+			original, err := New(namespace)
+			if err != nil {
+				t.Fatal(err)
+			}
+			(&filler{path: map[reflect.Type]int{}}).fill(reflect.ValueOf(original).Elem())
+			data, err := xml.Marshal(original)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			decoded, err := Unmarshal(data)
+			if err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			again, err := xml.Marshal(decoded)
+			if err != nil {
+				t.Fatalf("marshal again: %v", err)
+			}
+			if !bytes.Equal(data, again) {
+				t.Fatalf("XML differs after round trip at offset %d:\n%s", mismatch(data, again), excerpt(again, mismatch(data, again)))
+			}
+			reflect.ValueOf(original).Elem().FieldByName("XMLName").Set(reflect.ValueOf(decoded).Elem().FieldByName("XMLName"))
+			if !reflect.DeepEqual(original, decoded) {
+				t.Fatalf("Document differs after round trip: %s", difference(reflect.ValueOf(original), reflect.ValueOf(decoded), ""))
+			}
+		})
+	}
+}
+
+// filler sets every field of a value to a distinct non-zero value. Repeated
+// elements get two occurrences and recursive types are expanded twice along a path.
+type filler struct {
+	path map[reflect.Type]int
+	n    int
+}
+
+func (f *filler) fill(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		f.n++
+		v.SetString(strconv.Itoa(f.n))
+	case reflect.Ptr:
+		if f.path[v.Type().Elem()] >= 2 {
+			return
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		f.fill(v.Elem())
+	case reflect.Slice:
+		if elem := v.Type().Elem(); elem.Kind() == reflect.Ptr && f.path[elem.Elem()] >= 2 {
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		for i := 0; i < v.Len(); i++ {
+			f.fill(v.Index(i))
+		}
+	case reflect.Struct:
+		f.path[v.Type()]++
+		defer func() { f.path[v.Type()]-- }()
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.Name == "XMLName" || field.PkgPath != "" || field.Tag.Get("xml") == "-" {
+				continue
+			}
+			if strings.Contains(field.Tag.Get("xml"), ",innerxml") {
+				f.n++
+				v.Field(i).SetString("<Any>" + strconv.Itoa(f.n) + "</Any>")
+				continue
+			}
+			f.fill(v.Field(i))
+		}
+	}
+}
+
+// difference returns the path of the first difference between a and b.
+func difference(a, b reflect.Value, path string) string {
+	if a.Kind() != b.Kind() {
+		return path
+	}
+	switch a.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return path + " (nil)"
+		}
+		return difference(a.Elem(), b.Elem(), path)
+	case reflect.Slice:
+		if a.Len() != b.Len() {
+			return path + " (length " + strconv.Itoa(a.Len()) + " != " + strconv.Itoa(b.Len()) + ")"
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !reflect.DeepEqual(a.Index(i).Interface(), b.Index(i).Interface()) {
+				return difference(a.Index(i), b.Index(i), path+"["+strconv.Itoa(i)+"]")
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if !reflect.DeepEqual(a.Field(i).Interface(), b.Field(i).Interface()) {
+				return difference(a.Field(i), b.Field(i), path+"."+a.Type().Field(i).Name)
+			}
+		}
+	}
+	return path
+}
+
+func mismatch(a, b []byte) int {
+	for i := range a {
+		if i >= len(b) || a[i] != b[i] {
+			return i
+		}
+	}
+	return len(a)
+}
+
+func excerpt(data []byte, offset int) string {
+	start, end := offset-80, offset+80
+	if start < 0 {
+		start = 0
+	}
+	if end > len(data) {
+		end = len(data)
+	}
+	return string(data[start:end])
+}
@@ -0,0 +1,15 @@
+package message
+
+import (
+	"github.com/yudaprama/iso20022/model"
+)
+
+// ParseSupplementaryData decodes the Document held by a supplementary data
+// envelope into the Document type registered for its namespace, for example
+// a supl.Document01700101.
+func ParseSupplementaryData(e *model.SupplementaryDataEnvelope1) (Message, error) {
+	if e == nil {
+		return nil, ErrNoRootElement
+	}
+	return Unmarshal([]byte(e.Value))
+}
@@ -0,0 +1,81 @@
+package message
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yudaprama/iso20022/model"
+)
+
+// rtgsStatus is the supplementary data of the sample pacs.002 envelope.
+type rtgsStatus struct {
+	XMLName xml.Name `xml:"urn:example:rtgs:status:1 RtgsStsInf"`
+	Cycle   string   `xml:"urn:example:rtgs:status:1 Cycle"`
+	Node    string   `xml:"urn:example:rtgs:status:1 Node"`
+}
+
+// TestSupplementaryDataNamespaces checks that the supplementary data of a
+// Document that declares the prefix of its elements on the Document element
+// is captured with the declaration, by xml.Unmarshal and UnmarshalXMLFast, and
+// can be read on its own.
+func TestSupplementaryDataNamespaces(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "envelope.pacs.002.001.08.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := string(data)
+	s = s[strings.Index(s, "<Document") : strings.Index(s, "</Document>")+len("</Document>")]
+	s = strings.NewReplacer(
+		`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.002.001.08">`, `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.002.001.08" xmlns:rtgs="urn:example:rtgs:status:1" xmlns:other="urn:example:other">`,
+		`<RtgsStsInf xmlns="urn:example:rtgs:status:1">`, `<rtgs:RtgsStsInf>`,
+		`</RtgsStsInf>`, `</rtgs:RtgsStsInf>`,
+		`<Cycle>3</Cycle>`, `<rtgs:Cycle>3</rtgs:Cycle>`,
+		`<Node name="primary">A</Node>`, `<rtgs:Node rtgs:name="primary">A</rtgs:Node>`,
+	).Replace(s)
+	want := s[strings.Index(s, "<Envlp>")+len("<Envlp>") : strings.Index(s, "</Envlp>")]
+	want = strings.Replace(want, "<rtgs:RtgsStsInf>", `<rtgs:RtgsStsInf xmlns:rtgs="urn:example:rtgs:status:1">`, 1)
+	m, err := Unmarshal([]byte(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fast, _ := New(m.Namespace())
+	if err := fast.(fastDocument).UnmarshalXMLFast([]byte(s)); err != nil {
+		t.Fatal(err)
+	}
+	for name, m := range map[string]Message{"xml.Unmarshal": m, "UnmarshalXMLFast": fast} {
+		e := envelopeOf(t, m)
+		if e.Value != want {
+			t.Errorf("%s: content %q, want %q", name, e.Value, want)
+		}
+		var status rtgsStatus
+		if err := e.Extract(&status); err != nil || status.Cycle != "3" || status.Node != "A" {
+			t.Errorf("%s: extracted %+v, %v", name, status, err)
+		}
+		// The content keeps its declaration when it is written and read again.
+		out, err := xml.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		again, err := Unmarshal(out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := envelopeOf(t, again).Value; got != want {
+			t.Errorf("%s: content %q after a round trip, want %q", name, got, want)
+		}
+	}
+}
+
+// envelopeOf returns the supplementary data envelope of a pacs.002 Document.
+func envelopeOf(t *testing.T, m Message) *model.SupplementaryDataEnvelope1 {
+	t.Helper()
+	v := reflect.ValueOf(m).Elem().FieldByName("Message").Elem().FieldByName("SupplementaryData")
+	if v.Len() != 1 {
+		t.Fatalf("%d supplementary data", v.Len())
+	}
+	return v.Index(0).Interface().(*model.SupplementaryData1).Envelope
+}
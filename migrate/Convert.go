@@ -0,0 +1,363 @@
+package migrate
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// node is an element of the message being converted, independent of the types
+// of the version it comes from.
+type node struct {
+	name string
+
+	// path is the path of the element in the original message, or the path of
+	// the element it defaults in the converted message.
+	path string
+
+	// leaf reports whether the element is a simple type, whose value is text
+	// and whose type is typ.
+	leaf bool
+	typ  reflect.Type
+	text string
+
+	attrs    []attr
+	hasText  bool
+	raw      string
+	children []*node
+
+	// defaulted reports whether the value is a default set by a rule.
+	defaulted bool
+}
+
+type attr struct {
+	name  string
+	value string
+}
+
+// value returns the text of a leaf, or the attributes and text of an amount,
+// as reported for a dropped element.
+func (n *node) value() string {
+	switch {
+	case n.leaf:
+		return n.text
+	case n.hasText && len(n.children) == 0:
+		s := n.text
+		for _, a := range n.attrs {
+			s += " " + a.name + "=" + a.value
+		}
+		return s
+	}
+	return ""
+}
+
+// converter converts the nodes of a message to the types of another version
+// and collects the changes.
+type converter struct {
+	changes []Change
+}
+
+func (c *converter) report(kind Kind, path, value, reason string) {
+	c.changes = append(c.changes, Change{Kind: kind, Path: path, Value: value, Reason: reason})
+}
+
+// build returns the node of the element name of struct v at path.
+func (c *converter) build(v reflect.Value, name, path string) *node {
+	n := &node{name: name, path: path}
+	for _, f := range layoutOf(v.Type()).fields {
+		fv := v.FieldByIndex(f.index)
+		switch f.kind {
+		case attrField:
+			if s := fv.String(); s != "" {
+				n.attrs = append(n.attrs, attr{f.name, s})
+			}
+		case textField:
+			n.text, n.hasText = fv.String(), true
+		case anyField:
+			n.raw = fv.String()
+		case elementField:
+			if f.repeated {
+				for j := 0; j < fv.Len(); j++ {
+					if child := c.child(fv.Index(j), f.name, path+"/"+f.name+"["+strconv.Itoa(j+1)+"]"); child != nil {
+						n.children = append(n.children, child)
+					}
+				}
+			} else if child := c.child(fv, f.name, path+"/"+f.name); child != nil {
+				n.children = append(n.children, child)
+			}
+		}
+	}
+	return n
+}
+
+func (c *converter) child(v reflect.Value, name, path string) *node {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		return c.build(v, name, path)
+	}
+	return &node{name: name, path: path, leaf: true, typ: v.Type(), text: v.String()}
+}
+
+// drop reports n and its content as dropped.
+func (c *converter) drop(n *node, reason string) {
+	c.report(Dropped, n.path, n.value(), reason)
+}
+
+// fill sets the fields of struct v, the element at path of the converted
+// message, to the content of n.
+func (c *converter) fill(n *node, v reflect.Value, path string) {
+	l := layoutOf(v.Type())
+	c.apply(n, l)
+	for _, a := range n.attrs {
+		f, ok := l.attrs[a.name]
+		if !ok {
+			c.report(Dropped, n.path+"/@"+a.name, a.value, "")
+			continue
+		}
+		v.FieldByIndex(f.index).SetString(a.value)
+	}
+	switch {
+	case n.hasText && l.text != nil:
+		v.FieldByIndex(l.text.index).SetString(n.text)
+	case n.text != "":
+		c.report(Dropped, n.path, n.text, "")
+	}
+	switch {
+	case n.raw != "" && l.any != nil:
+		v.FieldByIndex(l.any.index).SetString(n.raw)
+	case n.raw != "":
+		c.report(Dropped, n.path, "", "")
+	}
+
+	count := map[string]int{}
+	for _, child := range n.children {
+		f, ok := l.elements[child.name]
+		if !ok {
+			c.drop(child, "")
+			continue
+		}
+		fv := v.FieldByIndex(f.index)
+		target := path + "/" + f.name
+		if f.repeated {
+			if f.max >= 0 && fv.Len() >= f.max {
+				c.drop(child, "more than "+strconv.Itoa(f.max)+" occurrences")
+				continue
+			}
+			target += "[" + strconv.Itoa(fv.Len()+1) + "]"
+		} else if count[f.name] > 0 {
+			c.drop(child, "more than one occurrence")
+			continue
+		}
+		elem, ok := c.convert(child, f, target)
+		if !ok {
+			continue
+		}
+		count[f.name]++
+		switch {
+		case f.repeated:
+			fv.Set(reflect.Append(fv, elem))
+		default:
+			fv.Set(elem)
+		}
+	}
+
+	if l.choice {
+		return
+	}
+	for _, f := range l.fields {
+		if f.optional {
+			continue
+		}
+		fv := v.FieldByIndex(f.index)
+		switch {
+		case f.kind == attrField && fv.Len() == 0:
+			c.report(Missing, path+"/@"+f.name, "", "")
+		case f.kind == textField && fv.Len() == 0:
+			c.report(Missing, path, "", "")
+		case f.kind == elementField && (f.repeated && fv.Len() == 0 || f.pointer && fv.IsNil()):
+			c.report(Missing, path+"/"+f.name, "", "")
+		}
+	}
+}
+
+// convert returns the value of field f, at path of the converted message, for
+// n. It reports false if n cannot be converted, after reporting it as dropped.
+func (c *converter) convert(n *node, f *field, path string) (reflect.Value, bool) {
+	if n.leaf != (f.typ.Kind() != reflect.Struct) {
+		c.drop(n, "not a "+f.typ.Name())
+		return reflect.Value{}, false
+	}
+	v := reflect.New(f.typ)
+	if n.leaf {
+		v.Elem().SetString(n.text)
+		if f.typ != n.typ {
+			val, ok := validatorOf(v.Elem())
+			if !ok {
+				c.report(Unverified, path, n.text, f.typ.Name()+" has no values to check against")
+			} else if err := val.Validate(); err != nil {
+				s, ok := substitute(f.typ, n.text)
+				if !ok {
+					c.drop(n, err.Error())
+					return reflect.Value{}, false
+				}
+				v.Elem().SetString(s)
+				c.report(Defaulted, path, s, "replaces "+n.text)
+			}
+		}
+		if n.defaulted {
+			c.report(Defaulted, path, n.text, "")
+		}
+	} else {
+		mark := len(c.changes)
+		c.fill(n, v.Elem(), path)
+		if v.Elem().IsZero() {
+			// An element left empty is not set: what was missing in it is
+			// missing only if the element is mandatory, as reported by the
+			// enclosing element.
+			kept := c.changes[:mark]
+			for _, change := range c.changes[mark:] {
+				if change.Kind == Dropped {
+					kept = append(kept, change)
+				}
+			}
+			c.changes = kept
+			return reflect.Value{}, false
+		}
+	}
+	if !f.pointer {
+		return v.Elem(), true
+	}
+	return v, true
+}
+
+type validator interface {
+	Validate() error
+}
+
+// validatorOf returns the Validate method of v. A simple type without one has
+// no facets or values known to the module.
+func validatorOf(v reflect.Value) (validator, bool) {
+	if val, ok := v.Interface().(validator); ok {
+		return val, true
+	}
+	val, ok := v.Addr().Interface().(validator)
+	return val, ok
+}
+
+const (
+	elementField = iota
+	attrField
+	textField
+	anyField
+)
+
+// field is an element, attribute, text or raw content of a struct type.
+type field struct {
+	name  string
+	index []int
+	kind  int
+
+	// typ is the type of the element, without pointer or slice.
+	typ      reflect.Type
+	repeated bool
+	pointer  bool
+	optional bool
+	max      int
+}
+
+// layout is the fields of a struct type.
+type layout struct {
+	fields   []*field
+	elements map[string]*field
+	attrs    map[string]*field
+	text     *field
+	any      *field
+	choice   bool
+}
+
+var layoutCache sync.Map // map[reflect.Type]*layout
+
+func layoutOf(t reflect.Type) *layout {
+	if l, ok := layoutCache.Load(t); ok {
+		return l.(*layout)
+	}
+	l := &layout{
+		elements: map[string]*field{},
+		attrs:    map[string]*field{},
+		choice:   strings.HasSuffix(t.Name(), "Choice"),
+	}
+	var add func(t reflect.Type, index []int)
+	add = func(t reflect.Type, index []int) {
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			if sf.Name == "XMLName" || sf.PkgPath != "" && !sf.Anonymous {
+				continue
+			}
+			idx := append(append([]int{}, index...), i)
+			tag := sf.Tag.Get("xml")
+			if (sf.Anonymous && tag == "" || tag == ",inline") && sf.Type.Kind() == reflect.Struct {
+				add(sf.Type, idx)
+				continue
+			}
+			name, options, _ := strings.Cut(tag, ",")
+			if i := strings.LastIndexByte(name, ' '); i >= 0 {
+				name = name[i+1:]
+			}
+			f := &field{name: name, index: idx, typ: sf.Type, max: -1}
+			f.optional = l.choice || strings.Contains(options, "omitempty")
+			switch {
+			case name == "-":
+				continue
+			case strings.Contains(options, "innerxml"), strings.Contains(options, "any"):
+				f.kind = anyField
+				l.any = f
+			case strings.Contains(options, "chardata"):
+				f.kind = textField
+				l.text = f
+			case strings.Contains(options, "attr"):
+				f.kind = attrField
+				l.attrs[name] = f
+			case name == "":
+				continue
+			default:
+				if f.typ.Kind() == reflect.Slice {
+					f.typ, f.repeated = f.typ.Elem(), true
+				}
+				if f.typ.Kind() == reflect.Ptr {
+					f.typ, f.pointer = f.typ.Elem(), true
+				}
+				if s, ok := sf.Tag.Lookup("xsd"); ok && strings.HasPrefix(s, "maxOccurs=") {
+					f.max, _ = strconv.Atoi(strings.TrimPrefix(s, "maxOccurs="))
+				}
+				l.elements[name] = f
+			}
+			l.fields = append(l.fields, f)
+		}
+	}
+	add(t, nil)
+	v, _ := layoutCache.LoadOrStore(t, l)
+	return v.(*layout)
+}
+
+// rootName returns the name of the root element of the message type t.
+func rootName(t reflect.Type) string {
+	f, _ := t.FieldByName("XMLName")
+	return xmlName(f.Tag.Get("xml"), "").Local
+}
+
+// xmlName returns the name of the root element given by the XMLName tag, in
+// namespace.
+func xmlName(tag, namespace string) xml.Name {
+	name, _, _ := strings.Cut(tag, ",")
+	if i := strings.LastIndexByte(name, ' '); i >= 0 {
+		name = name[i+1:]
+	}
+	return xml.Name{Space: namespace, Local: name}
+}
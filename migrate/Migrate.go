@@ -0,0 +1,264 @@
+// Package migrate converts a message to the previous or the next version of
+// its message definition, for example pacs.008.001.06 to pacs.008.001.05, so
+// that counterparties on different versions can exchange messages.
+//
+// The content of an element is carried to the element with the same tag in the
+// other version, whatever the types of the two versions. The restructurings
+// between versions, such as BIC renamed BICFI or an amount wrapped in a
+// structure with its type, are described by the rules of Rules.go, which apply
+// to every message definition where the structure of the other version calls
+// for them. Every message definition can therefore be migrated; a rule is only
+// needed for a restructuring that the tags do not follow.
+//
+// What cannot be carried is reported: the elements the other version has no
+// place for, or whose value is not valid in it, are dropped; the mandatory
+// elements of the other version that have no value in the message are
+// defaulted when a rule gives a default, and missing otherwise. A value carried
+// to a type of the other version that has no Validate method is reported as
+// unverified, since the module cannot tell whether the other version allows it.
+package migrate
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/yudaprama/iso20022/message"
+)
+
+// Kind is the kind of a change made by a migration.
+type Kind int
+
+const (
+	// Dropped is an element, attribute or value of the message that the other
+	// version cannot hold.
+	Dropped Kind = iota
+
+	// Defaulted is a mandatory element of the other version that was set to a
+	// default value, or a value replaced by its equivalent in the other version.
+	Defaulted
+
+	// Missing is a mandatory element of the other version that has no value,
+	// so that the migrated message is not valid.
+	Missing
+
+	// Unverified is a value carried to a type of the other version whose
+	// values cannot be checked, so that the migrated message may not be valid.
+	Unverified
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Dropped:
+		return "dropped"
+	case Defaulted:
+		return "defaulted"
+	case Missing:
+		return "missing"
+	case Unverified:
+		return "unverified"
+	}
+	return "Kind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Change is a change made by a migration. The path of a dropped element is its
+// path in the original message, the path of a defaulted, missing or unverified
+// element its path in the migrated message, for example
+// /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/Tax.
+type Change struct {
+	Kind Kind
+	Path string
+
+	// Value is the value that was dropped or not verified, or the default value.
+	Value string
+
+	// Reason tells why a value was dropped or replaced, if not because the
+	// other version has no place for it.
+	Reason string
+}
+
+func (c Change) String() string {
+	s := c.Kind.String() + " " + c.Path
+	if c.Value != "" {
+		s += " (" + c.Value + ")"
+	}
+	if c.Reason != "" {
+		s += ": " + c.Reason
+	}
+	return s
+}
+
+// Report lists the changes made by the migration of a message from a message
+// definition to another.
+type Report struct {
+	From    string
+	To      string
+	Changes []Change
+}
+
+// Lossless reports whether the migration carried the whole message, the
+// migrated message has all its mandatory elements and its values were checked
+// against the types of the other version.
+func (r *Report) Lossless() bool {
+	return len(r.Changes) == 0
+}
+
+// Filter returns the changes of the given kind.
+func (r *Report) Filter(kind Kind) []Change {
+	var list []Change
+	for _, c := range r.Changes {
+		if c.Kind == kind {
+			list = append(list, c)
+		}
+	}
+	return list
+}
+
+func (r *Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s to %s", r.From, r.To)
+	for _, c := range r.Changes {
+		b.WriteString("\n")
+		b.WriteString(c.String())
+	}
+	return b.String()
+}
+
+// Upgrade converts m to the next version of its message definition.
+func Upgrade(m message.Message) (message.Message, *Report, error) {
+	next, err := adjacent(m.MessageDefinitionIdentifier(), 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	return convert(m, next)
+}
+
+// Downgrade converts m to the previous version of its message definition.
+func Downgrade(m message.Message) (message.Message, *Report, error) {
+	previous, err := adjacent(m.MessageDefinitionIdentifier(), -1)
+	if err != nil {
+		return nil, nil, err
+	}
+	return convert(m, previous)
+}
+
+// To converts m to the version of its message definition with the given
+// identifier, for example pacs.008.001.02, one version at a time. It returns
+// the report of each step.
+func To(m message.Message, identifier string) (message.Message, []*Report, error) {
+	family, _ := split(m.MessageDefinitionIdentifier())
+	if target, _ := split(identifier); target != family {
+		return nil, nil, fmt.Errorf("migrate: %s is not a version of %s", identifier, family)
+	}
+	if _, err := message.NewFromIdentifier(identifier); err != nil {
+		return nil, nil, fmt.Errorf("migrate: unknown message definition %s", identifier)
+	}
+	var reports []*Report
+	for m.MessageDefinitionIdentifier() != identifier {
+		step := 1
+		if identifier < m.MessageDefinitionIdentifier() {
+			step = -1
+		}
+		next, err := adjacent(m.MessageDefinitionIdentifier(), step)
+		if err != nil {
+			return nil, nil, err
+		}
+		converted, report, err := convert(m, next)
+		if err != nil {
+			return nil, nil, err
+		}
+		m = converted
+		reports = append(reports, report)
+	}
+	return m, reports, nil
+}
+
+// Versions returns the identifiers of the registered versions of the message
+// definition of identifier, from the oldest to the newest.
+func Versions(identifier string) []string {
+	family, _ := split(identifier)
+	return versions()[family]
+}
+
+var (
+	versionsOnce sync.Once
+	families     map[string][]string
+)
+
+// versions returns the registered identifiers by message definition, the
+// identifier without its version.
+func versions() map[string][]string {
+	versionsOnce.Do(func() {
+		families = map[string][]string{}
+		for _, namespace := range message.Namespaces() {
+			identifier := strings.TrimPrefix(namespace, message.NamespacePrefix)
+			family, _ := split(identifier)
+			families[family] = append(families[family], identifier)
+		}
+		for _, list := range families {
+			sort.Slice(list, func(i, j int) bool {
+				_, a := split(list[i])
+				_, b := split(list[j])
+				return a < b
+			})
+		}
+	})
+	return families
+}
+
+// split splits a message definition identifier into the identifier of the
+// message definition and the version number.
+func split(identifier string) (string, int) {
+	i := strings.LastIndexByte(identifier, '.')
+	if i < 0 {
+		return identifier, 0
+	}
+	version, _ := strconv.Atoi(identifier[i+1:])
+	return identifier[:i], version
+}
+
+func adjacent(identifier string, step int) (string, error) {
+	list := Versions(identifier)
+	for i, id := range list {
+		if id != identifier {
+			continue
+		}
+		if i+step < 0 || i+step >= len(list) {
+			break
+		}
+		return list[i+step], nil
+	}
+	if step > 0 {
+		return "", fmt.Errorf("migrate: no version after %s", identifier)
+	}
+	return "", fmt.Errorf("migrate: no version before %s", identifier)
+}
+
+// convert converts m to the message definition identifier.
+func convert(m message.Message, identifier string) (message.Message, *Report, error) {
+	target, err := message.NewFromIdentifier(identifier)
+	if err != nil {
+		return nil, nil, err
+	}
+	src := reflect.ValueOf(m)
+	if src.Kind() != reflect.Ptr || src.IsNil() {
+		return nil, nil, fmt.Errorf("migrate: cannot convert %T", m)
+	}
+	c := &converter{}
+	root := c.build(src.Elem(), rootName(src.Elem().Type()), "/"+rootName(src.Elem().Type()))
+	dst := reflect.ValueOf(target).Elem()
+	l := layoutOf(dst.Type())
+	// The message element of a Document may be named after the message
+	// definition, as in pacs.008.001.01; it is carried whatever its tag.
+	if len(root.children) == 1 && len(l.fields) == 1 && l.fields[0].kind == elementField {
+		root.children[0].name = l.fields[0].name
+	}
+	c.fill(root, dst, "/"+rootName(dst.Type()))
+	if f, ok := dst.Type().FieldByName("XMLName"); ok {
+		dst.FieldByIndex(f.Index).Set(reflect.ValueOf(xmlName(f.Tag.Get("xml"), target.Namespace())))
+	}
+	return target, &Report{From: m.MessageDefinitionIdentifier(), To: identifier, Changes: c.changes}, nil
+}
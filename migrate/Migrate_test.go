@@ -0,0 +1,393 @@
+package migrate
+
+import (
+	"encoding/xml"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/yudaprama/iso20022/message"
+	"github.com/yudaprama/iso20022/model"
+	"github.com/yudaprama/iso20022/pacs"
+)
+
+func TestVersions(t *testing.T) {
+	want := []string{"pacs.008.001.01", "pacs.008.001.02", "pacs.008.001.03", "pacs.008.001.04", "pacs.008.001.05", "pacs.008.001.06", "pacs.008.001.08"}
+	if got := Versions("pacs.008.001.03"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Versions(pacs.008.001.03) = %v, want %v", got, want)
+	}
+	first, _ := message.NewFromIdentifier("pacs.008.001.01")
+	if _, _, err := Downgrade(first); err == nil {
+		t.Error("Downgrade of the first version succeeded")
+	}
+	last, _ := message.NewFromIdentifier("pacs.008.001.08")
+	if _, _, err := Upgrade(last); err == nil {
+		t.Error("Upgrade of the last version succeeded")
+	}
+	if _, _, err := To(last, "pacs.009.001.02"); err == nil {
+		t.Error("To converted to another message definition")
+	}
+}
+
+// TestIdentity converts a maximal instance of every message to its own
+// version, which must carry it whole.
+func TestIdentity(t *testing.T) {
+	for _, namespace := range message.Namespaces() {
+		original, _ := message.New(namespace)
+		(&filler{path: map[reflect.Type]int{}}).fill(reflect.ValueOf(original).Elem())
+		converted, report, err := convert(original, original.MessageDefinitionIdentifier())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !report.Lossless() {
+			t.Fatalf("%s: %v", original.MessageDefinitionIdentifier(), report)
+		}
+		setXMLName(original, converted)
+		if !reflect.DeepEqual(original, converted) {
+			t.Fatalf("%s: message differs after conversion", original.MessageDefinitionIdentifier())
+		}
+	}
+}
+
+// TestAdjacent converts a maximal instance of every message to the adjacent
+// versions.
+func TestAdjacent(t *testing.T) {
+	for _, namespace := range message.Namespaces() {
+		original, _ := message.New(namespace)
+		(&filler{path: map[reflect.Type]int{}}).fill(reflect.ValueOf(original).Elem())
+		for _, step := range []func(message.Message) (message.Message, *Report, error){Upgrade, Downgrade} {
+			converted, report, err := step(original)
+			if err != nil {
+				continue
+			}
+			if converted.MessageDefinitionIdentifier() != report.To || report.From != original.MessageDefinitionIdentifier() {
+				t.Fatalf("%s converted to %s, reported as %s to %s", original.MessageDefinitionIdentifier(), converted.MessageDefinitionIdentifier(), report.From, report.To)
+			}
+			for _, c := range report.Changes {
+				if !strings.HasPrefix(c.Path, "/") {
+					t.Fatalf("%s: change %v has no path", report.From, c)
+				}
+			}
+		}
+	}
+}
+
+// TestCorpus converts the sample messages of the message package to the
+// previous version and back: what was not dropped or defaulted is unchanged.
+func TestCorpus(t *testing.T) {
+	for _, m := range corpus(t) {
+		down, report, err := Downgrade(m)
+		if err != nil {
+			continue
+		}
+		up, back, err := Upgrade(down)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(report.Filter(Dropped)) > 0 || len(back.Filter(Dropped)) > 0 || len(back.Filter(Defaulted)) > 0 {
+			continue
+		}
+		setXMLName(m, up)
+		if !reflect.DeepEqual(m, up) {
+			t.Errorf("%s: message differs after a lossless downgrade and upgrade\n%v\n%v", m.MessageDefinitionIdentifier(), report, back)
+		}
+	}
+}
+
+func TestPacs008(t *testing.T) {
+	var original *pacs.Document00800106
+	for _, m := range corpus(t) {
+		if d, ok := m.(*pacs.Document00800106); ok {
+			original = d
+		}
+	}
+	reference := model.Max140Text("TAX-2015-09")
+	original.Message.CreditTransferTransactionInformation[0].Tax = &model.TaxInformation3{ReferenceNumber: &reference}
+
+	older, reports, err := To(original, "pacs.008.001.02")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 4 || reports[0].From != "pacs.008.001.06" || reports[3].To != "pacs.008.001.02" {
+		t.Fatalf("To(pacs.008.001.02) reported %d steps", len(reports))
+	}
+	var dropped []Change
+	for _, r := range reports {
+		dropped = append(dropped, r.Filter(Dropped)...)
+	}
+	if len(dropped) != 1 || dropped[0].Path != "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/Tax" || reports[1].Filter(Dropped) == nil {
+		t.Errorf("downgrade dropped %v, want the Tax from pacs.008.001.05", dropped)
+	}
+	d := older.(*pacs.Document00800102)
+	if bic := d.Message.CreditTransferTransactionInformation[0].CreditorAgent.FinancialInstitutionIdentification.BIC; bic == nil || *bic != "AAAAGB2L" {
+		t.Errorf("BICFI of the creditor agent not carried to BIC")
+	}
+
+	// The message element of pacs.008.001.01 is named after the message
+	// definition.
+	oldest, _, err := Downgrade(older)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oldest.(*pacs.Document00800101).Message == nil {
+		t.Error("message element not carried to pacs.008.001.01")
+	}
+
+	newer, reports, err := To(older, "pacs.008.001.06")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range reports {
+		if !r.Lossless() {
+			t.Errorf("upgrade is not lossless: %v", r)
+		}
+	}
+	original.Message.CreditTransferTransactionInformation[0].Tax = nil
+	setXMLName(original, newer)
+	if !reflect.DeepEqual(original, newer) {
+		t.Error("message differs after a downgrade to pacs.008.001.02 and an upgrade")
+	}
+
+	// The previous instructing agent of pacs.008.001.06 is the first of the
+	// three of pacs.008.001.08.
+	original.Message.CreditTransferTransactionInformation[0].AddPreviousInstructingAgent().AddFinancialInstitutionIdentification().SetBICFI("BBBBUS33")
+	latest, report, err := Upgrade(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Lossless() {
+		t.Errorf("upgrade is not lossless: %v", report)
+	}
+	agent := latest.(*pacs.Document00800108).Message.CreditTransferTransactionInformation[0].PreviousInstructingAgent1
+	if agent == nil || *agent.FinancialInstitutionIdentification.BICFI != "BBBBUS33" {
+		t.Error("previous instructing agent not carried to PrvsInstgAgt1")
+	}
+}
+
+func TestSubstitute(t *testing.T) {
+	var original *pacs.Document00200108
+	for _, m := range corpus(t) {
+		if d, ok := m.(*pacs.Document00200108); ok {
+			original = d
+		}
+	}
+	status := model.ExternalPaymentTransactionStatus1Code("ACCC")
+	original.Message.TransactionInformationAndStatus[0].TransactionStatus = &status
+	converted, report, err := Downgrade(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := converted.(*pacs.Document00200107)
+	if s := d.Message.TransactionInformationAndStatus[0].TransactionStatus; s == nil || *s != model.TransactionIndividualStatus3CodeACSC {
+		t.Errorf("TxSts = %v, want ACSC", s)
+	}
+	want := Change{Kind: Defaulted, Path: "/Document/FIToFIPmtStsRpt/TxInfAndSts[1]/TxSts", Value: "ACSC", Reason: "replaces ACCC"}
+	if !contains(report.Changes, want) {
+		t.Errorf("report %v has no %v", report, want)
+	}
+}
+
+// checkedCode and uncheckedCode are simple types with and without a Validate
+// method, held by a codes element.
+type checkedCode string
+
+func (c checkedCode) Validate() error {
+	if c != "HIGH" && c != "NORM" {
+		return errors.New("not a priority")
+	}
+	return nil
+}
+
+type uncheckedCode string
+
+type codes struct {
+	Checked   *checkedCode   `xml:"Chckd,omitempty"`
+	Unchecked *uncheckedCode `xml:"Unchckd,omitempty"`
+}
+
+// TestUnverified checks that a value carried to a type without a Validate
+// method is reported, so that the migration is not lossless.
+func TestUnverified(t *testing.T) {
+	leaf := func(name, value string) *node {
+		return &node{name: name, path: "/Cds/" + name, leaf: true, typ: reflect.TypeOf(model.Priority2Code("")), text: value}
+	}
+	for _, tt := range []struct {
+		leaf *node
+		want []Change
+	}{
+		{leaf("Chckd", "HIGH"), nil},
+		{leaf("Chckd", "URGT"), []Change{{Kind: Dropped, Path: "/Cds/Chckd", Value: "URGT", Reason: "not a priority"}}},
+		{leaf("Unchckd", "HIGH"), []Change{{Kind: Unverified, Path: "/Cds/Unchckd", Value: "HIGH", Reason: "uncheckedCode has no values to check against"}}},
+	} {
+		c := &converter{}
+		var v codes
+		c.fill(&node{name: "Cds", path: "/Cds", children: []*node{tt.leaf}}, reflect.ValueOf(&v).Elem(), "/Cds")
+		if !reflect.DeepEqual(c.changes, tt.want) {
+			t.Errorf("%s %s: changes %v, want %v", tt.leaf.name, tt.leaf.text, c.changes, tt.want)
+		}
+		if r := (&Report{Changes: c.changes}); r.Lossless() != (tt.want == nil) {
+			t.Errorf("%s %s: Lossless() = %t", tt.leaf.name, tt.leaf.text, r.Lossless())
+		}
+	}
+}
+
+// TestAddressLines checks that the address lines of the postal addresses of
+// every message are bounded, as in the XSDs, so that a migration drops the
+// lines past the bound of the other version.
+func TestAddressLines(t *testing.T) {
+	seen := map[reflect.Type]bool{}
+	var walk func(t reflect.Type)
+	walk = func(typ reflect.Type) {
+		if seen[typ] || typ.Kind() != reflect.Struct {
+			return
+		}
+		seen[typ] = true
+		for _, f := range layoutOf(typ).fields {
+			if f.name == "AdrLine" && f.max != 5 && f.max != 7 {
+				t.Errorf("%s.AdrLine has maxOccurs %d", typ.Name(), f.max)
+			}
+			walk(f.typ)
+		}
+	}
+	for _, namespace := range message.Namespaces() {
+		m, _ := message.New(namespace)
+		walk(reflect.TypeOf(m).Elem())
+	}
+	if !seen[reflect.TypeOf(model.PostalAddress6{})] {
+		t.Error("PostalAddress6 not reached")
+	}
+}
+
+const statement = `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"><BkToCstmrStmt><Stmt>
+<Ntry><Chrgs><Amt Ccy="EUR">1.00</Amt><CdtDbtInd>DBIT</CdtDbtInd><Pty><FinInstnId><BIC>AAAAGB2L</BIC></FinInstnId></Pty></Chrgs>
+<NtryDtls><TxDtls><RltdPric><DealPric Ccy="EUR">10.5</DealPric></RltdPric></TxDtls></NtryDtls></Ntry>
+<TxsSummry><TtlNtries><TtlNetNtryAmt>12.5</TtlNetNtryAmt><CdtDbtInd>CRDT</CdtDbtInd></TtlNtries></TxsSummry>
+</Stmt></BkToCstmrStmt></Document>`
+
+func TestRules(t *testing.T) {
+	original, err := message.Parse(strings.NewReader(statement))
+	if err != nil {
+		t.Fatal(err)
+	}
+	upgraded, reports, err := To(original, "camt.053.001.04")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := xml.Marshal(upgraded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`<Chrgs><Rcrd><Amt Ccy="EUR">1.00</Amt><CdtDbtInd>DBIT</CdtDbtInd><Agt><FinInstnId><BICFI>AAAAGB2L</BICFI></FinInstnId></Agt></Rcrd></Chrgs>`,
+		`<DealPric><Tp><Yldd>false</Yldd></Tp><Val><Amt Ccy="EUR">10.5</Amt></Val></DealPric>`,
+		`<TtlNtries><TtlNetNtry><Amt>12.5</Amt><CdtDbtInd>CRDT</CdtDbtInd></TtlNetNtry></TtlNtries>`,
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("upgraded statement has no %s", want)
+		}
+	}
+	want := Change{Kind: Defaulted, Path: "/Document/BkToCstmrStmt/Stmt[1]/Ntry[1]/NtryDtls[1]/TxDtls[1]/RltdPric/DealPric/Tp/Yldd", Value: "false"}
+	if !contains(reports[0].Changes, want) {
+		t.Errorf("report %v has no %v", reports[0], want)
+	}
+
+	downgraded, reports, err := To(upgraded, "camt.053.001.02")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dropped := Change{Kind: Dropped, Path: "/Document/BkToCstmrStmt/Stmt[1]/Ntry[1]/NtryDtls[1]/TxDtls[1]/RltdPric/DealPric/Tp"}
+	if !contains(reports[1].Changes, dropped) {
+		t.Errorf("report %v has no %v", reports[1], dropped)
+	}
+	setXMLName(original, downgraded)
+	if !reflect.DeepEqual(original, downgraded) {
+		t.Error("statement differs after an upgrade and a downgrade")
+	}
+}
+
+func contains(changes []Change, c Change) bool {
+	for _, change := range changes {
+		if change == c {
+			return true
+		}
+	}
+	return false
+}
+
+func setXMLName(from, to message.Message) {
+	reflect.ValueOf(from).Elem().FieldByName("XMLName").Set(reflect.ValueOf(to).Elem().FieldByName("XMLName"))
+}
+
+// corpus returns the sample messages of the message package.
+func corpus(t *testing.T) []message.Message {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join("..", "message", "testdata", "*.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var list []message.Message
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.HasPrefix(filepath.Base(file), "envelope.") {
+			env, err := message.ParseEnvelope(f)
+			if err != nil {
+				t.Fatalf("%s: %v", file, err)
+			}
+			list = append(list, env.Header, env.Document)
+		} else {
+			m, err := message.Parse(f)
+			if err != nil {
+				t.Fatalf("%s: %v", file, err)
+			}
+			list = append(list, m)
+		}
+		f.Close()
+	}
+	return list
+}
+
+// filler sets every field of a value to a non-zero value. Repeated elements
+// get two occurrences and recursive types are expanded twice along a path.
+type filler struct {
+	path map[reflect.Type]int
+	n    int
+}
+
+func (f *filler) fill(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		f.n++
+		v.SetString(strconv.Itoa(f.n))
+	case reflect.Ptr:
+		if f.path[v.Type().Elem()] >= 2 {
+			return
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		f.fill(v.Elem())
+	case reflect.Slice:
+		if elem := v.Type().Elem(); elem.Kind() == reflect.Ptr && f.path[elem.Elem()] >= 2 {
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		for i := 0; i < v.Len(); i++ {
+			f.fill(v.Index(i))
+		}
+	case reflect.Struct:
+		f.path[v.Type()]++
+		defer func() { f.path[v.Type()]-- }()
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.Name == "XMLName" || field.PkgPath != "" || field.Tag.Get("xml") == "-" {
+				continue
+			}
+			f.fill(v.Field(i))
+		}
+	}
+}
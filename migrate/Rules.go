@@ -0,0 +1,286 @@
+package migrate
+
+import (
+	"reflect"
+	"strings"
+)
+
+// The rules describe the restructurings between versions that the tags of the
+// elements do not follow. A rule is not tied to a message definition: it
+// applies wherever the element it names is found and the type of the other
+// version has the structure it converts to, in either direction, so that the
+// components shared by the message definitions are migrated alike.
+
+// rename renames the child Old of the element Parent to New, or New to Old.
+type rename struct {
+	parent, old, new string
+}
+
+var renames = []rename{
+	{"FinInstnId", "BIC", "BICFI"},
+	{"OrgId", "BICOrBEI", "AnyBIC"},
+	{"ChrgsInf", "Pty", "Agt"},
+	{"CdtTrfTxInf", "PrvsInstgAgt", "PrvsInstgAgt1"},
+	{"CdtTrfTxInf", "PrvsInstgAgtAcct", "PrvsInstgAgt1Acct"},
+}
+
+// wrap wraps the value of the child Elem of the element Parent, or of any
+// element if Parent is empty, in the elements of path, or unwraps it; the other
+// content of the wrapping elements is dropped.
+type wrap struct {
+	parent, elem, path string
+}
+
+var wraps = []wrap{
+	{"RfrdDocAmt", "DscntApldAmt", "Amt"},
+	{"RfrdDocAmt", "TaxAmt", "Amt"},
+	{"MndtRltdInf", "Frqcy", "Tp"},
+	{"AmdmntInfDtls", "OrgnlFrqcy", "Tp"},
+	{"", "ReqdExctnDt", "Dt"},
+	{"RltdPric", "DealPric", "Val/Amt"},
+}
+
+// group groups the children of the element Parent named after the members in a
+// child Elem, renamed to the second name of the member, or ungroups them. Only
+// the first occurrence of Elem is ungrouped, the others are dropped.
+type group struct {
+	parent, elem string
+	members      [][2]string
+}
+
+var groups = []group{
+	{"RltdRmtInf", "RmtLctnDtls", [][2]string{{"RmtLctnMtd", "Mtd"}, {"RmtLctnElctrncAdr", "ElctrncAdr"}, {"RmtLctnPstlAdr", "PstlAdr"}}},
+	{"TtlNtries", "TtlNetNtry", [][2]string{{"TtlNetNtryAmt", "Amt"}, {"CdtDbtInd", "CdtDbtInd"}}},
+	{"TtlNtriesPerBkTxCd", "TtlNetNtry", [][2]string{{"TtlNetNtryAmt", "Amt"}, {"CdtDbtInd", "CdtDbtInd"}}},
+	{"Chrgs", "Rcrd", [][2]string{{"Amt", "Amt"}, {"CdtDbtInd", "CdtDbtInd"}, {"Tp", "Tp"}, {"Rate", "Rate"}, {"Br", "Br"}, {"Pty", "Agt"}, {"Tax", "Tax"}}},
+	{"Intrst", "Rcrd", [][2]string{{"Amt", "Amt"}, {"CdtDbtInd", "CdtDbtInd"}, {"Tp", "Tp"}, {"Rate", "Rate"}, {"FrToDt", "FrToDt"}, {"Rsn", "Rsn"}}},
+}
+
+// merge merges the occurrences of the child Elem of the element Parent, or of
+// any element if Parent is empty, when the other version has only one.
+type merge struct {
+	parent, elem string
+}
+
+var merges = []merge{
+	{"Ntry", "Chrgs"},
+	{"Ntry", "Intrst"},
+	{"TxDtls", "Chrgs"},
+	{"TxDtls", "Intrst"},
+}
+
+// defaultValue sets the descendant path of the element Parent to Value when its
+// first element is mandatory in the other version and missing.
+type defaultValue struct {
+	parent, path, value string
+}
+
+var defaults = []defaultValue{
+	{"DealPric", "Tp/Yldd", "false"},
+}
+
+// substitutes holds by Code type the values of other versions that are not in
+// its enumeration, and the values that replace them.
+var substitutes = map[string]map[string]string{
+	"TransactionIndividualStatus3Code": {"ACCC": "ACSC"},
+	"TransactionGroupStatus3Code":      {"ACCC": "ACSC"},
+}
+
+func substitute(t reflect.Type, value string) (string, bool) {
+	s, ok := substitutes[t.Name()][value]
+	return s, ok
+}
+
+// apply applies the rules to the children of n, converted to the struct type
+// of layout l.
+func (c *converter) apply(n *node, l *layout) {
+	for _, r := range renames {
+		r.apply(n, l)
+	}
+	for _, m := range merges {
+		m.apply(n, l)
+	}
+	for _, w := range wraps {
+		c.wrap(w, n, l)
+	}
+	for _, g := range groups {
+		c.group(g, n, l)
+	}
+	for _, d := range defaults {
+		d.apply(n, l)
+	}
+}
+
+func (r rename) apply(n *node, l *layout) {
+	if n.name != r.parent {
+		return
+	}
+	from, to := r.old, r.new
+	if l.elements[to] == nil {
+		from, to = to, from
+	}
+	if l.elements[to] == nil || l.elements[from] != nil {
+		return
+	}
+	for _, child := range n.children {
+		if child.name == from {
+			child.name = to
+		}
+	}
+}
+
+func (m merge) apply(n *node, l *layout) {
+	if m.parent != "" && n.name != m.parent {
+		return
+	}
+	if f := l.elements[m.elem]; f == nil || f.repeated {
+		return
+	}
+	var first *node
+	children := n.children[:0]
+	for _, child := range n.children {
+		if child.name == m.elem && !child.leaf {
+			if first != nil {
+				first.children = append(first.children, child.children...)
+				continue
+			}
+			first = child
+		}
+		children = append(children, child)
+	}
+	n.children = children
+}
+
+func (c *converter) wrap(w wrap, n *node, l *layout) {
+	if w.parent != "" && n.name != w.parent {
+		return
+	}
+	f := l.elements[w.elem]
+	if f == nil {
+		return
+	}
+	path := strings.Split(w.path, "/")
+	wrapped := f.typ.Kind() == reflect.Struct && layoutOf(f.typ).elements[path[0]] != nil
+	for i, child := range n.children {
+		if child.name != w.elem {
+			continue
+		}
+		switch {
+		case wrapped && (child.leaf || child.hasText):
+			outer := &node{name: w.elem, path: child.path}
+			inner := outer
+			for _, name := range path[:len(path)-1] {
+				next := &node{name: name, path: child.path}
+				inner.children = []*node{next}
+				inner = next
+			}
+			child.name = path[len(path)-1]
+			inner.children = []*node{child}
+			n.children[i] = outer
+		case !wrapped && !child.leaf && !child.hasText:
+			// The content is unwrapped only if it has the element of path,
+			// otherwise it is dropped as not convertible.
+			value := child
+			for _, name := range path {
+				if value = find(value, name); value == nil {
+					break
+				}
+			}
+			if value == nil {
+				continue
+			}
+			for parent, name := child, path; len(name) > 0; name = name[1:] {
+				next := find(parent, name[0])
+				for _, other := range parent.children {
+					if other != next {
+						c.drop(other, "")
+					}
+				}
+				parent = next
+			}
+			value.name = w.elem
+			n.children[i] = value
+		}
+	}
+}
+
+// find returns the first child of n named name.
+func find(n *node, name string) *node {
+	for _, child := range n.children {
+		if child.name == name {
+			return child
+		}
+	}
+	return nil
+}
+
+func (c *converter) group(g group, n *node, l *layout) {
+	if n.name != g.parent {
+		return
+	}
+	grouped, flat := l.elements[g.elem] != nil, l.elements[g.members[0][0]] != nil
+	switch {
+	case grouped && !flat:
+		var elem *node
+		children := n.children[:0]
+		for _, child := range n.children {
+			if name, ok := g.member(child.name, 0); ok {
+				if elem == nil {
+					elem = &node{name: g.elem, path: n.path + "/" + g.elem}
+					children = append(children, elem)
+				}
+				child.name = name
+				elem.children = append(elem.children, child)
+				continue
+			}
+			children = append(children, child)
+		}
+		n.children = children
+	case flat && !grouped:
+		var children []*node
+		first := true
+		for _, child := range n.children {
+			if child.name != g.elem || child.leaf {
+				children = append(children, child)
+				continue
+			}
+			if !first {
+				c.drop(child, "more than one occurrence")
+				continue
+			}
+			first = false
+			for _, member := range child.children {
+				if name, ok := g.member(member.name, 1); ok {
+					member.name = name
+				}
+				children = append(children, member)
+			}
+		}
+		n.children = children
+	}
+}
+
+// member returns the other name of the member whose name at index i is name.
+func (g group) member(name string, i int) (string, bool) {
+	for _, m := range g.members {
+		if m[i] == name {
+			return m[1-i], true
+		}
+	}
+	return "", false
+}
+
+func (d defaultValue) apply(n *node, l *layout) {
+	if n.name != d.parent {
+		return
+	}
+	path := strings.Split(d.path, "/")
+	if f := l.elements[path[0]]; f == nil || f.optional || find(n, path[0]) != nil {
+		return
+	}
+	value := &node{name: path[len(path)-1], path: n.path + "/" + d.path, leaf: true, text: d.value, defaulted: true}
+	for i := len(path) - 2; i >= 0; i-- {
+		value = &node{name: path[i], path: n.path + "/" + strings.Join(path[:i+1], "/"), children: []*node{value}}
+	}
+	n.children = append(n.children, value)
+}
@@ -1,3 +1,15 @@
 package model
 
 type AMLIndicator string
+
+func (a AMLIndicator) Validate() error {
+	return validateBoolean("AMLIndicator", string(a))
+}
+
+func (a AMLIndicator) Bool() bool {
+	return parseBoolean(string(a))
+}
+
+func (a *AMLIndicator) FromBool(value bool) {
+	*a = AMLIndicator(formatBoolean(value))
+}
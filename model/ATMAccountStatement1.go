@@ -14,6 +14,10 @@ type ATMAccountStatement1 struct {
 	AccountStatement []*ATMAccountStatement2 `xml:"AcctStmt,omitempty"`
 }
 
+func (a *ATMAccountStatement1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMAccountStatement1) AddAccountIdentifier() *AccountIdentification31Choice {
 	a.AccountIdentifier = new(AccountIdentification31Choice)
 	return a.AccountIdentifier
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Statement information of an account.
 type ATMAccountStatement2 struct {
 
@@ -25,14 +31,28 @@ type ATMAccountStatement2 struct {
 	LongText *Max256Text `xml:"LngTxt,omitempty"`
 }
 
+func (a *ATMAccountStatement2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMAccountStatement2) SetTransactionDate(value string) {
 	a.TransactionDate = (*ISODate)(&value)
 }
 
+func (a *ATMAccountStatement2) SetTransactionDateFromTime(value time.Time) {
+	a.TransactionDate = new(ISODate)
+	a.TransactionDate.FromTime(value)
+}
+
 func (a *ATMAccountStatement2) SetValueDate(value string) {
 	a.ValueDate = (*ISODate)(&value)
 }
 
+func (a *ATMAccountStatement2) SetValueDateFromTime(value time.Time) {
+	a.ValueDate = new(ISODate)
+	a.ValueDate.FromTime(value)
+}
+
 func (a *ATMAccountStatement2) SetShortText(value string) {
 	a.ShortText = (*Max70Text)(&value)
 }
@@ -41,10 +61,19 @@ func (a *ATMAccountStatement2) SetCreditTransaction(value string) {
 	a.CreditTransaction = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMAccountStatement2) SetCreditTransactionFromBool(value bool) {
+	a.CreditTransaction = new(TrueFalseIndicator)
+	a.CreditTransaction.FromBool(value)
+}
+
 func (a *ATMAccountStatement2) SetAmount(value, currency string) {
 	a.Amount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMAccountStatement2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMAccountStatement2) SetCurrency(value string) {
 	a.Currency = (*ActiveCurrencyCode)(&value)
 }
@@ -22,6 +22,10 @@ type ATMCassette1 struct {
 	MediaCounters []*ATMCassetteCounters1 `xml:"MdiaCntrs,omitempty"`
 }
 
+func (a *ATMCassette1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCassette1) SetPhysicalIdentification(value string) {
 	a.PhysicalIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type ATMCassette2 struct {
 	MediaCounters []*ATMCassetteCounters3 `xml:"MdiaCntrs,omitempty"`
 }
 
+func (a *ATMCassette2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCassette2) SetPhysicalIdentification(value string) {
 	a.PhysicalIdentification = (*Max35Text)(&value)
 }
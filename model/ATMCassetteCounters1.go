@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // ATM cassette counter per unit value or globally.
 type ATMCassetteCounters1 struct {
 
@@ -22,10 +26,18 @@ type ATMCassetteCounters1 struct {
 	CurrentAmount *ImpliedCurrencyAndAmount `xml:"CurAmt,omitempty"`
 }
 
+func (a *ATMCassetteCounters1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCassetteCounters1) SetUnitValue(value, currency string) {
 	a.UnitValue = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMCassetteCounters1) SetUnitValueFromDecimal(value decimal.Decimal, currency string) {
+	a.UnitValue = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMCassetteCounters1) SetCurrency(value string) {
 	a.Currency = (*ActiveCurrencyCode)(&value)
 }
@@ -184,6 +196,15 @@ func (a *ATMCassetteCounters1) SetCurrentNumber(value string) {
 	a.CurrentNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters1) SetCurrentNumberFromDecimal(value decimal.Decimal) {
+	a.CurrentNumber = new(Number)
+	a.CurrentNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters1) SetCurrentAmount(value, currency string) {
 	a.CurrentAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (a *ATMCassetteCounters1) SetCurrentAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.CurrentAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Counters of media inside an ATM cassette.
 type ATMCassetteCounters2 struct {
 
@@ -31,6 +35,10 @@ type ATMCassetteCounters2 struct {
 	PresentedNumber *Number `xml:"PresntdNb,omitempty"`
 }
 
+func (a *ATMCassetteCounters2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCassetteCounters2) SetType(value string) {
 	a.Type = (*ATMCounterType1Code)(&value)
 }
@@ -39,30 +47,70 @@ func (a *ATMCassetteCounters2) SetAddedNumber(value string) {
 	a.AddedNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters2) SetAddedNumberFromDecimal(value decimal.Decimal) {
+	a.AddedNumber = new(Number)
+	a.AddedNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters2) SetRemovedNumber(value string) {
 	a.RemovedNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters2) SetRemovedNumberFromDecimal(value decimal.Decimal) {
+	a.RemovedNumber = new(Number)
+	a.RemovedNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters2) SetDispensedNumber(value string) {
 	a.DispensedNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters2) SetDispensedNumberFromDecimal(value decimal.Decimal) {
+	a.DispensedNumber = new(Number)
+	a.DispensedNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters2) SetDepositNumber(value string) {
 	a.DepositNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters2) SetDepositNumberFromDecimal(value decimal.Decimal) {
+	a.DepositNumber = new(Number)
+	a.DepositNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters2) SetRecycledNumber(value string) {
 	a.RecycledNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters2) SetRecycledNumberFromDecimal(value decimal.Decimal) {
+	a.RecycledNumber = new(Number)
+	a.RecycledNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters2) SetRetractedNumber(value string) {
 	a.RetractedNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters2) SetRetractedNumberFromDecimal(value decimal.Decimal) {
+	a.RetractedNumber = new(Number)
+	a.RetractedNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters2) SetRejectedNumber(value string) {
 	a.RejectedNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters2) SetRejectedNumberFromDecimal(value decimal.Decimal) {
+	a.RejectedNumber = new(Number)
+	a.RejectedNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters2) SetPresentedNumber(value string) {
 	a.PresentedNumber = (*Number)(&value)
 }
+
+func (a *ATMCassetteCounters2) SetPresentedNumberFromDecimal(value decimal.Decimal) {
+	a.PresentedNumber = new(Number)
+	a.PresentedNumber.FromDecimal(value)
+}
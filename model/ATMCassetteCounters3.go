@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // ATM cassette counter per unit value or globally.
 type ATMCassetteCounters3 struct {
 
@@ -22,10 +26,18 @@ type ATMCassetteCounters3 struct {
 	FlowTotals []*ATMCassetteCounters4 `xml:"FlowTtls,omitempty"`
 }
 
+func (a *ATMCassetteCounters3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCassetteCounters3) SetUnitValue(value, currency string) {
 	a.UnitValue = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMCassetteCounters3) SetUnitValueFromDecimal(value decimal.Decimal, currency string) {
+	a.UnitValue = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMCassetteCounters3) SetCurrency(value string) {
 	a.Currency = (*ActiveCurrencyCode)(&value)
 }
@@ -38,10 +50,19 @@ func (a *ATMCassetteCounters3) SetCurrentNumber(value string) {
 	a.CurrentNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters3) SetCurrentNumberFromDecimal(value decimal.Decimal) {
+	a.CurrentNumber = new(Number)
+	a.CurrentNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters3) SetCurrentAmount(value, currency string) {
 	a.CurrentAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMCassetteCounters3) SetCurrentAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.CurrentAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMCassetteCounters3) AddFlowTotals() *ATMCassetteCounters4 {
 	newValue := new(ATMCassetteCounters4)
 	a.FlowTotals = append(a.FlowTotals, newValue)
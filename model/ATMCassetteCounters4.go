@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Counters of media inside an ATM cassette.
 type ATMCassetteCounters4 struct {
 
@@ -31,6 +35,10 @@ type ATMCassetteCounters4 struct {
 	PresentedNumber *Number `xml:"PresntdNb,omitempty"`
 }
 
+func (a *ATMCassetteCounters4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCassetteCounters4) SetType(value string) {
 	a.Type = (*ATMCounterType1Code)(&value)
 }
@@ -39,30 +47,70 @@ func (a *ATMCassetteCounters4) SetAddedNumber(value string) {
 	a.AddedNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters4) SetAddedNumberFromDecimal(value decimal.Decimal) {
+	a.AddedNumber = new(Number)
+	a.AddedNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters4) SetRemovedNumber(value string) {
 	a.RemovedNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters4) SetRemovedNumberFromDecimal(value decimal.Decimal) {
+	a.RemovedNumber = new(Number)
+	a.RemovedNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters4) SetDispensedNumber(value string) {
 	a.DispensedNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters4) SetDispensedNumberFromDecimal(value decimal.Decimal) {
+	a.DispensedNumber = new(Number)
+	a.DispensedNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters4) SetDepositedNumber(value string) {
 	a.DepositedNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters4) SetDepositedNumberFromDecimal(value decimal.Decimal) {
+	a.DepositedNumber = new(Number)
+	a.DepositedNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters4) SetRecycledNumber(value string) {
 	a.RecycledNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters4) SetRecycledNumberFromDecimal(value decimal.Decimal) {
+	a.RecycledNumber = new(Number)
+	a.RecycledNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters4) SetRetractedNumber(value string) {
 	a.RetractedNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters4) SetRetractedNumberFromDecimal(value decimal.Decimal) {
+	a.RetractedNumber = new(Number)
+	a.RetractedNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters4) SetRejectedNumber(value string) {
 	a.RejectedNumber = (*Number)(&value)
 }
 
+func (a *ATMCassetteCounters4) SetRejectedNumberFromDecimal(value decimal.Decimal) {
+	a.RejectedNumber = new(Number)
+	a.RejectedNumber.FromDecimal(value)
+}
+
 func (a *ATMCassetteCounters4) SetPresentedNumber(value string) {
 	a.PresentedNumber = (*Number)(&value)
 }
+
+func (a *ATMCassetteCounters4) SetPresentedNumberFromDecimal(value decimal.Decimal) {
+	a.PresentedNumber = new(Number)
+	a.PresentedNumber.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Maintenance command to perform on an ATM.
 type ATMCommand1 struct {
 
@@ -19,6 +23,10 @@ type ATMCommand1 struct {
 	CommandParameters *ATMCommandParameters1Choice `xml:"CmdParams,omitempty"`
 }
 
+func (a *ATMCommand1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCommand1) SetType(value string) {
 	a.Type = (*ATMCommand1Code)(&value)
 }
@@ -31,6 +39,11 @@ func (a *ATMCommand1) SetDateTime(value string) {
 	a.DateTime = (*ISODateTime)(&value)
 }
 
+func (a *ATMCommand1) SetDateTimeFromTime(value time.Time) {
+	a.DateTime = new(ISODateTime)
+	a.DateTime.FromTime(value)
+}
+
 func (a *ATMCommand1) AddCommandIdentification() *ATMCommandIdentification1 {
 	a.CommandIdentification = new(ATMCommandIdentification1)
 	return a.CommandIdentification
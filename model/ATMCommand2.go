@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Result of a maintenance command performed by the ATM.
 type ATMCommand2 struct {
 
@@ -22,6 +26,10 @@ type ATMCommand2 struct {
 	AdditionalErrorInformation *Max140Text `xml:"AddtlErrInf,omitempty"`
 }
 
+func (a *ATMCommand2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCommand2) SetType(value string) {
 	a.Type = (*ATMCommand2Code)(&value)
 }
@@ -30,10 +38,20 @@ func (a *ATMCommand2) SetRequiredDateTime(value string) {
 	a.RequiredDateTime = (*ISODateTime)(&value)
 }
 
+func (a *ATMCommand2) SetRequiredDateTimeFromTime(value time.Time) {
+	a.RequiredDateTime = new(ISODateTime)
+	a.RequiredDateTime.FromTime(value)
+}
+
 func (a *ATMCommand2) SetProcessedDateTime(value string) {
 	a.ProcessedDateTime = (*ISODateTime)(&value)
 }
 
+func (a *ATMCommand2) SetProcessedDateTimeFromTime(value time.Time) {
+	a.ProcessedDateTime = new(ISODateTime)
+	a.ProcessedDateTime.FromTime(value)
+}
+
 func (a *ATMCommand2) AddCommandIdentification() *ATMCommandIdentification1 {
 	a.CommandIdentification = new(ATMCommandIdentification1)
 	return a.CommandIdentification
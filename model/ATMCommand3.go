@@ -10,6 +10,10 @@ type ATMCommand3 struct {
 	CommandIdentification *ATMCommandIdentification1 `xml:"CmdId,omitempty"`
 }
 
+func (a *ATMCommand3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCommand3) SetType(value string) {
 	a.Type = (*ATMCommand2Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Maintenance command the ATM must perform.
 type ATMCommand4 struct {
 
@@ -28,6 +32,10 @@ type ATMCommand4 struct {
 	CommandParameters *ATMCommandParameters2Choice `xml:"CmdParams,omitempty"`
 }
 
+func (a *ATMCommand4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCommand4) SetType(value string) {
 	a.Type = (*ATMCommand2Code)(&value)
 }
@@ -40,6 +48,11 @@ func (a *ATMCommand4) SetDateTime(value string) {
 	a.DateTime = (*ISODateTime)(&value)
 }
 
+func (a *ATMCommand4) SetDateTimeFromTime(value time.Time) {
+	a.DateTime = new(ISODateTime)
+	a.DateTime.FromTime(value)
+}
+
 func (a *ATMCommand4) AddCommandIdentification() *ATMCommandIdentification1 {
 	a.CommandIdentification = new(ATMCommandIdentification1)
 	return a.CommandIdentification
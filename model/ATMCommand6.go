@@ -10,6 +10,10 @@ type ATMCommand6 struct {
 	CommandIdentification *ATMCommandIdentification1 `xml:"CmdId,omitempty"`
 }
 
+func (a *ATMCommand6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCommand6) SetType(value string) {
 	a.Type = (*ATMCommand3Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Command result for reinitialization of the transaction counters.
 type ATMCommand8 struct {
 
@@ -22,6 +26,10 @@ type ATMCommand8 struct {
 	AdditionalErrorInformation *Max140Text `xml:"AddtlErrInf,omitempty"`
 }
 
+func (a *ATMCommand8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCommand8) SetType(value string) {
 	a.Type = (*ATMCommand5Code)(&value)
 }
@@ -30,10 +38,20 @@ func (a *ATMCommand8) SetRequiredDateTime(value string) {
 	a.RequiredDateTime = (*ISODateTime)(&value)
 }
 
+func (a *ATMCommand8) SetRequiredDateTimeFromTime(value time.Time) {
+	a.RequiredDateTime = new(ISODateTime)
+	a.RequiredDateTime.FromTime(value)
+}
+
 func (a *ATMCommand8) SetProcessedDateTime(value string) {
 	a.ProcessedDateTime = (*ISODateTime)(&value)
 }
 
+func (a *ATMCommand8) SetProcessedDateTimeFromTime(value time.Time) {
+	a.ProcessedDateTime = new(ISODateTime)
+	a.ProcessedDateTime.FromTime(value)
+}
+
 func (a *ATMCommand8) AddCommandIdentification() *ATMCommandIdentification1 {
 	a.CommandIdentification = new(ATMCommandIdentification1)
 	return a.CommandIdentification
@@ -10,6 +10,10 @@ type ATMCommand9 struct {
 	CommandIdentification *ATMCommandIdentification1 `xml:"CmdId,omitempty"`
 }
 
+func (a *ATMCommand9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCommand9) SetType(value string) {
 	a.Type = (*ATMCommand5Code)(&value)
 }
@@ -13,6 +13,10 @@ type ATMCommandIdentification1 struct {
 	Processor *Max140Text `xml:"Prcr,omitempty"`
 }
 
+func (a *ATMCommandIdentification1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCommandIdentification1) SetOrigin(value string) {
 	a.Origin = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type ATMCommandParameters1 struct {
 	RequiredStatus *ATMStatus2Code `xml:"ReqrdSts,omitempty"`
 }
 
+func (a *ATMCommandParameters1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCommandParameters1) SetSerialNumber(value string) {
 	a.SerialNumber = (*Max35Text)(&value)
 }
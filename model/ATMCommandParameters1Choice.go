@@ -13,6 +13,10 @@ type ATMCommandParameters1Choice struct {
 	RequiredConfigurationParameter *ATMConfigurationParameter1 `xml:"ReqrdCfgtnParam"`
 }
 
+func (a *ATMCommandParameters1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCommandParameters1Choice) SetATMRequiredGlobalStatus(value string) {
 	a.ATMRequiredGlobalStatus = (*ATMStatus1Code)(&value)
 }
@@ -22,6 +22,10 @@ type ATMCommandParameters2Choice struct {
 	Key *ATMConfigurationParameter2 `xml:"Key"`
 }
 
+func (a *ATMCommandParameters2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCommandParameters2Choice) SetATMRequiredGlobalStatus(value string) {
 	a.ATMRequiredGlobalStatus = (*ATMStatus1Code)(&value)
 }
@@ -13,6 +13,10 @@ type ATMCompletionAcknowledgement1 struct {
 	Transaction *ATMTransaction4 `xml:"Tx"`
 }
 
+func (a *ATMCompletionAcknowledgement1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCompletionAcknowledgement1) AddATM() *AutomatedTellerMachine3 {
 	a.ATM = new(AutomatedTellerMachine3)
 	return a.ATM
@@ -13,6 +13,10 @@ type ATMCompletionAcknowledgement2 struct {
 	Transaction *ATMTransaction18 `xml:"Tx"`
 }
 
+func (a *ATMCompletionAcknowledgement2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCompletionAcknowledgement2) AddATM() *AutomatedTellerMachine3 {
 	a.ATM = new(AutomatedTellerMachine3)
 	return a.ATM
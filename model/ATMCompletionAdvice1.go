@@ -13,6 +13,10 @@ type ATMCompletionAdvice1 struct {
 	Transaction *ATMTransaction5 `xml:"Tx"`
 }
 
+func (a *ATMCompletionAdvice1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCompletionAdvice1) AddEnvironment() *ATMEnvironment3 {
 	a.Environment = new(ATMEnvironment3)
 	return a.Environment
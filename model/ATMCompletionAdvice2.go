@@ -13,6 +13,10 @@ type ATMCompletionAdvice2 struct {
 	Transaction *ATMTransaction20 `xml:"Tx"`
 }
 
+func (a *ATMCompletionAdvice2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCompletionAdvice2) AddEnvironment() *ATMEnvironment13 {
 	a.Environment = new(ATMEnvironment13)
 	return a.Environment
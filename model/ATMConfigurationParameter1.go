@@ -10,6 +10,10 @@ type ATMConfigurationParameter1 struct {
 	Version *Max35Text `xml:"Vrsn"`
 }
 
+func (a *ATMConfigurationParameter1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMConfigurationParameter1) SetType(value string) {
 	a.Type = (*DataSetCategory7Code)(&value)
 }
@@ -16,6 +16,10 @@ type ATMConfigurationParameter2 struct {
 	KeyProperties []*KEKIdentifier4 `xml:"KeyProps,omitempty"`
 }
 
+func (a *ATMConfigurationParameter2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMConfigurationParameter2) SetKeyCategory(value string) {
 	a.KeyCategory = (*CryptographicKeyType4Code)(&value)
 }
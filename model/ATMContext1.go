@@ -10,6 +10,10 @@ type ATMContext1 struct {
 	Service *ATMService1 `xml:"Svc,omitempty"`
 }
 
+func (a *ATMContext1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext1) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type ATMContext10 struct {
 	Service *ATMService11 `xml:"Svc,omitempty"`
 }
 
+func (a *ATMContext10) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext10) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
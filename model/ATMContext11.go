@@ -10,6 +10,10 @@ type ATMContext11 struct {
 	Service *ATMService12 `xml:"Svc,omitempty"`
 }
 
+func (a *ATMContext11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext11) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type ATMContext12 struct {
 	Service *ATMService13 `xml:"Svc,omitempty"`
 }
 
+func (a *ATMContext12) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext12) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type ATMContext13 struct {
 	Service *ATMService14 `xml:"Svc"`
 }
 
+func (a *ATMContext13) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext13) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
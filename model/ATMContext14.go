@@ -10,6 +10,10 @@ type ATMContext14 struct {
 	Service *ATMService15 `xml:"Svc"`
 }
 
+func (a *ATMContext14) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext14) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
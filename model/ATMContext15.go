@@ -10,6 +10,10 @@ type ATMContext15 struct {
 	Service *ATMService16 `xml:"Svc"`
 }
 
+func (a *ATMContext15) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext15) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type ATMContext16 struct {
 	Service *ATMService20 `xml:"Svc"`
 }
 
+func (a *ATMContext16) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext16) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type ATMContext17 struct {
 	Service *ATMService21 `xml:"Svc"`
 }
 
+func (a *ATMContext17) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext17) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
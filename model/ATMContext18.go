@@ -10,6 +10,10 @@ type ATMContext18 struct {
 	Service *ATMService22 `xml:"Svc"`
 }
 
+func (a *ATMContext18) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext18) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type ATMContext19 struct {
 	Service *ATMService23 `xml:"Svc"`
 }
 
+func (a *ATMContext19) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext19) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type ATMContext2 struct {
 	Service *ATMService2 `xml:"Svc,omitempty"`
 }
 
+func (a *ATMContext2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext2) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type ATMContext20 struct {
 	Service *ATMService24 `xml:"Svc"`
 }
 
+func (a *ATMContext20) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext20) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type ATMContext3 struct {
 	Service *ATMService3 `xml:"Svc"`
 }
 
+func (a *ATMContext3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext3) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
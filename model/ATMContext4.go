@@ -10,6 +10,10 @@ type ATMContext4 struct {
 	Service *ATMService4 `xml:"Svc"`
 }
 
+func (a *ATMContext4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext4) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
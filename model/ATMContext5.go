@@ -10,6 +10,10 @@ type ATMContext5 struct {
 	Service *ATMService5 `xml:"Svc"`
 }
 
+func (a *ATMContext5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext5) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type ATMContext6 struct {
 	Service *ATMService6 `xml:"Svc"`
 }
 
+func (a *ATMContext6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext6) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type ATMContext7 struct {
 	Service *ATMService8 `xml:"Svc"`
 }
 
+func (a *ATMContext7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext7) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type ATMContext8 struct {
 	Service *ATMService9 `xml:"Svc,omitempty"`
 }
 
+func (a *ATMContext8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext8) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type ATMContext9 struct {
 	Service *ATMService10 `xml:"Svc,omitempty"`
 }
 
+func (a *ATMContext9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMContext9) SetSessionReference(value string) {
 	a.SessionReference = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type ATMCustomer1 struct {
 	AuthenticationResult []*TransactionVerificationResult5 `xml:"AuthntcnRslt,omitempty"`
 }
 
+func (a *ATMCustomer1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCustomer1) AddProfile() *ATMCustomerProfile1 {
 	a.Profile = new(ATMCustomerProfile1)
 	return a.Profile
@@ -10,6 +10,10 @@ type ATMCustomer2 struct {
 	AuthenticationResult []*TransactionVerificationResult5 `xml:"AuthntcnRslt,omitempty"`
 }
 
+func (a *ATMCustomer2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCustomer2) AddProfile() *ATMCustomerProfile2 {
 	a.Profile = new(ATMCustomerProfile2)
 	return a.Profile
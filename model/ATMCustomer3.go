@@ -13,6 +13,10 @@ type ATMCustomer3 struct {
 	AuthenticationResult []*TransactionVerificationResult5 `xml:"AuthntcnRslt"`
 }
 
+func (a *ATMCustomer3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCustomer3) AddProfile() *ATMCustomerProfile1 {
 	a.Profile = new(ATMCustomerProfile1)
 	return a.Profile
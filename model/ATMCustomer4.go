@@ -16,6 +16,10 @@ type ATMCustomer4 struct {
 	AuthenticationResult []*TransactionVerificationResult5 `xml:"AuthntcnRslt,omitempty"`
 }
 
+func (a *ATMCustomer4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCustomer4) AddProfile() *ATMCustomerProfile4 {
 	a.Profile = new(ATMCustomerProfile4)
 	return a.Profile
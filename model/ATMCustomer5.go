@@ -10,6 +10,10 @@ type ATMCustomer5 struct {
 	AuthenticationResult []*TransactionVerificationResult5 `xml:"AuthntcnRslt,omitempty"`
 }
 
+func (a *ATMCustomer5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCustomer5) AddProfile() *ATMCustomerProfile2 {
 	a.Profile = new(ATMCustomerProfile2)
 	return a.Profile
@@ -13,6 +13,10 @@ type ATMCustomer6 struct {
 	AuthenticationResult []*TransactionVerificationResult5 `xml:"AuthntcnRslt"`
 }
 
+func (a *ATMCustomer6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCustomer6) AddProfile() *ATMCustomerProfile4 {
 	a.Profile = new(ATMCustomerProfile4)
 	return a.Profile
@@ -13,6 +13,10 @@ type ATMCustomerProfile1 struct {
 	CustomerIdentification *Max35Text `xml:"CstmrId,omitempty"`
 }
 
+func (a *ATMCustomerProfile1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCustomerProfile1) SetRetrievalMode(value string) {
 	a.RetrievalMode = (*ATMCustomerProfile1Code)(&value)
 }
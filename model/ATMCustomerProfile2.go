@@ -10,6 +10,10 @@ type ATMCustomerProfile2 struct {
 	CustomerIdentification *Max35Text `xml:"CstmrId,omitempty"`
 }
 
+func (a *ATMCustomerProfile2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCustomerProfile2) SetProfileReference(value string) {
 	a.ProfileReference = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type ATMCustomerProfile3 struct {
 	AllowedServices []*ATMService7 `xml:"AllwdSvcs,omitempty"`
 }
 
+func (a *ATMCustomerProfile3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCustomerProfile3) SetProfileReference(value string) {
 	a.ProfileReference = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type ATMCustomerProfile4 struct {
 	CustomerIdentification *Max35Text `xml:"CstmrId,omitempty"`
 }
 
+func (a *ATMCustomerProfile4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCustomerProfile4) SetRetrievalMode(value string) {
 	a.RetrievalMode = (*ATMCustomerProfile1Code)(&value)
 }
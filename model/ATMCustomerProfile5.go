@@ -16,6 +16,10 @@ type ATMCustomerProfile5 struct {
 	AllowedServices []*ATMService17 `xml:"AllwdSvcs,omitempty"`
 }
 
+func (a *ATMCustomerProfile5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMCustomerProfile5) SetProfileReference(value string) {
 	a.ProfileReference = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type ATMDepositCompletionAcknowledgement1 struct {
 	Transaction *ATMTransaction18 `xml:"Tx"`
 }
 
+func (a *ATMDepositCompletionAcknowledgement1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMDepositCompletionAcknowledgement1) AddATM() *AutomatedTellerMachine3 {
 	a.ATM = new(AutomatedTellerMachine3)
 	return a.ATM
@@ -13,6 +13,10 @@ type ATMDepositCompletionAdvice1 struct {
 	Transaction *ATMTransaction19 `xml:"Tx"`
 }
 
+func (a *ATMDepositCompletionAdvice1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMDepositCompletionAdvice1) AddEnvironment() *ATMEnvironment13 {
 	a.Environment = new(ATMEnvironment13)
 	return a.Environment
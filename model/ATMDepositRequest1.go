@@ -13,6 +13,10 @@ type ATMDepositRequest1 struct {
 	Transaction *ATMTransaction15 `xml:"Tx"`
 }
 
+func (a *ATMDepositRequest1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMDepositRequest1) AddEnvironment() *ATMEnvironment11 {
 	a.Environment = new(ATMEnvironment11)
 	return a.Environment
@@ -13,6 +13,10 @@ type ATMDepositResponse1 struct {
 	Transaction *ATMTransaction16 `xml:"Tx"`
 }
 
+func (a *ATMDepositResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMDepositResponse1) AddEnvironment() *ATMEnvironment12 {
 	a.Environment = new(ATMEnvironment12)
 	return a.Environment
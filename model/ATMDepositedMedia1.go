@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Deposited media put in the safe.
 type ATMDepositedMedia1 struct {
 
@@ -16,10 +20,19 @@ type ATMDepositedMedia1 struct {
 	MediaItems []*ATMDepositedMedia2 `xml:"MdiaItms"`
 }
 
+func (a *ATMDepositedMedia1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMDepositedMedia1) SetAccountSequenceNumber(value string) {
 	a.AccountSequenceNumber = (*Number)(&value)
 }
 
+func (a *ATMDepositedMedia1) SetAccountSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.AccountSequenceNumber = new(Number)
+	a.AccountSequenceNumber.FromDecimal(value)
+}
+
 func (a *ATMDepositedMedia1) SetMediaType(value string) {
 	a.MediaType = (*ATMMediaType2Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Media item that are deposited.
 type ATMDepositedMedia2 struct {
 
@@ -25,14 +29,27 @@ type ATMDepositedMedia2 struct {
 	ConfidenceLevel *Percentage `xml:"CnfdncLvl,omitempty"`
 }
 
+func (a *ATMDepositedMedia2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMDepositedMedia2) SetCount(value string) {
 	a.Count = (*Number)(&value)
 }
 
+func (a *ATMDepositedMedia2) SetCountFromDecimal(value decimal.Decimal) {
+	a.Count = new(Number)
+	a.Count.FromDecimal(value)
+}
+
 func (a *ATMDepositedMedia2) SetUnitValue(value, currency string) {
 	a.UnitValue = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMDepositedMedia2) SetUnitValueFromDecimal(value decimal.Decimal, currency string) {
+	a.UnitValue = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMDepositedMedia2) SetCurrency(value string) {
 	a.Currency = (*ActiveCurrencyCode)(&value)
 }
@@ -49,6 +66,15 @@ func (a *ATMDepositedMedia2) SetScannedValue(value, currency string) {
 	a.ScannedValue = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMDepositedMedia2) SetScannedValueFromDecimal(value decimal.Decimal, currency string) {
+	a.ScannedValue = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMDepositedMedia2) SetConfidenceLevel(value string) {
 	a.ConfidenceLevel = (*Percentage)(&value)
 }
+
+func (a *ATMDepositedMedia2) SetConfidenceLevelFromDecimal(value decimal.Decimal) {
+	a.ConfidenceLevel = new(Percentage)
+	a.ConfidenceLevel.FromDecimal(value)
+}
@@ -13,6 +13,10 @@ type ATMDepositedMedia3 struct {
 	MediaItems []*ATMDepositedMedia2 `xml:"MdiaItms"`
 }
 
+func (a *ATMDepositedMedia3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMDepositedMedia3) SetMediaType(value string) {
 	a.MediaType = (*ATMMediaType2Code)(&value)
 }
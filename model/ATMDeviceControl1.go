@@ -10,6 +10,10 @@ type ATMDeviceControl1 struct {
 	Command []*ATMCommand4 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMDeviceControl1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMDeviceControl1) AddEnvironment() *ATMEnvironment7 {
 	a.Environment = new(ATMEnvironment7)
 	return a.Environment
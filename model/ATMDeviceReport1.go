@@ -19,6 +19,10 @@ type ATMDeviceReport1 struct {
 	ATMSecurityContext *ATMSecurityContext1 `xml:"ATMSctyCntxt,omitempty"`
 }
 
+func (a *ATMDeviceReport1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMDeviceReport1) AddEnvironment() *ATMEnvironment6 {
 	a.Environment = new(ATMEnvironment6)
 	return a.Environment
@@ -7,6 +7,10 @@ type ATMDiagnosticRequest1 struct {
 	Environment *ATMEnvironment9 `xml:"Envt"`
 }
 
+func (a *ATMDiagnosticRequest1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMDiagnosticRequest1) AddEnvironment() *ATMEnvironment9 {
 	a.Environment = new(ATMEnvironment9)
 	return a.Environment
@@ -10,6 +10,10 @@ type ATMDiagnosticRequest2 struct {
 	ATMGlobalStatus *ATMStatus1 `xml:"ATMGblSts"`
 }
 
+func (a *ATMDiagnosticRequest2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMDiagnosticRequest2) AddEnvironment() *ATMEnvironment9 {
 	a.Environment = new(ATMEnvironment9)
 	return a.Environment
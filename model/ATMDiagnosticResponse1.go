@@ -10,6 +10,10 @@ type ATMDiagnosticResponse1 struct {
 	Command []*ATMCommand1 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMDiagnosticResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMDiagnosticResponse1) AddEnvironment() *ATMEnvironment6 {
 	a.Environment = new(ATMEnvironment6)
 	return a.Environment
@@ -10,6 +10,10 @@ type ATMDiagnosticResponse2 struct {
 	Command []*ATMCommand7 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMDiagnosticResponse2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMDiagnosticResponse2) AddEnvironment() *ATMEnvironment6 {
 	a.Environment = new(ATMEnvironment6)
 	return a.Environment
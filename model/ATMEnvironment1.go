@@ -22,6 +22,10 @@ type ATMEnvironment1 struct {
 	Card *PaymentCard16 `xml:"Card,omitempty"`
 }
 
+func (a *ATMEnvironment1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment1) AddAcquirer() *Acquirer7 {
 	a.Acquirer = new(Acquirer7)
 	return a.Acquirer
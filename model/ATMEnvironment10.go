@@ -16,6 +16,10 @@ type ATMEnvironment10 struct {
 	ATM *AutomatedTellerMachine8 `xml:"ATM"`
 }
 
+func (a *ATMEnvironment10) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment10) AddAcquirer() *Acquirer7 {
 	a.Acquirer = new(Acquirer7)
 	return a.Acquirer
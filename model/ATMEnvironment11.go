@@ -22,6 +22,10 @@ type ATMEnvironment11 struct {
 	Card *PaymentCard22 `xml:"Card,omitempty"`
 }
 
+func (a *ATMEnvironment11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment11) AddAcquirer() *Acquirer7 {
 	a.Acquirer = new(Acquirer7)
 	return a.Acquirer
@@ -25,6 +25,10 @@ type ATMEnvironment12 struct {
 	PlainCardData *PlainCardData19 `xml:"PlainCardData,omitempty"`
 }
 
+func (a *ATMEnvironment12) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment12) AddAcquirer() *Acquirer7 {
 	a.Acquirer = new(Acquirer7)
 	return a.Acquirer
@@ -22,6 +22,10 @@ type ATMEnvironment13 struct {
 	Card *PaymentCard23 `xml:"Card,omitempty"`
 }
 
+func (a *ATMEnvironment13) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment13) AddAcquirer() *Acquirer7 {
 	a.Acquirer = new(Acquirer7)
 	return a.Acquirer
@@ -22,6 +22,10 @@ type ATMEnvironment14 struct {
 	Card *PaymentCard22 `xml:"Card,omitempty"`
 }
 
+func (a *ATMEnvironment14) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment14) AddAcquirer() *Acquirer7 {
 	a.Acquirer = new(Acquirer7)
 	return a.Acquirer
@@ -16,6 +16,10 @@ type ATMEnvironment15 struct {
 	ATM *AutomatedTellerMachine6 `xml:"ATM"`
 }
 
+func (a *ATMEnvironment15) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment15) AddAcquirer() *Acquirer7 {
 	a.Acquirer = new(Acquirer7)
 	return a.Acquirer
@@ -22,6 +22,10 @@ type ATMEnvironment16 struct {
 	Card *PaymentCard23 `xml:"Card,omitempty"`
 }
 
+func (a *ATMEnvironment16) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment16) AddAcquirer() *Acquirer7 {
 	a.Acquirer = new(Acquirer7)
 	return a.Acquirer
@@ -25,6 +25,10 @@ type ATMEnvironment2 struct {
 	PlainCardData *PlainCardData14 `xml:"PlainCardData,omitempty"`
 }
 
+func (a *ATMEnvironment2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment2) AddAcquirer() *Acquirer7 {
 	a.Acquirer = new(Acquirer7)
 	return a.Acquirer
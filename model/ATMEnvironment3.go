@@ -22,6 +22,10 @@ type ATMEnvironment3 struct {
 	Card *PaymentCard17 `xml:"Card,omitempty"`
 }
 
+func (a *ATMEnvironment3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment3) AddAcquirer() *Acquirer7 {
 	a.Acquirer = new(Acquirer7)
 	return a.Acquirer
@@ -22,6 +22,10 @@ type ATMEnvironment4 struct {
 	Card *PaymentCard16 `xml:"Card,omitempty"`
 }
 
+func (a *ATMEnvironment4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment4) AddAcquirer() *Acquirer7 {
 	a.Acquirer = new(Acquirer7)
 	return a.Acquirer
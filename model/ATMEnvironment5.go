@@ -16,6 +16,10 @@ type ATMEnvironment5 struct {
 	PlainCardData *PlainCardData14 `xml:"PlainCardData,omitempty"`
 }
 
+func (a *ATMEnvironment5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment5) AddATM() *AutomatedTellerMachine2 {
 	a.ATM = new(AutomatedTellerMachine2)
 	return a.ATM
@@ -16,6 +16,10 @@ type ATMEnvironment6 struct {
 	ATM *AutomatedTellerMachine5 `xml:"ATM"`
 }
 
+func (a *ATMEnvironment6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment6) AddAcquirer() *Acquirer7 {
 	a.Acquirer = new(Acquirer7)
 	return a.Acquirer
@@ -16,6 +16,10 @@ type ATMEnvironment7 struct {
 	ATM *AutomatedTellerMachine3 `xml:"ATM"`
 }
 
+func (a *ATMEnvironment7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment7) AddAcquirer() *Acquirer7 {
 	a.Acquirer = new(Acquirer7)
 	return a.Acquirer
@@ -16,6 +16,10 @@ type ATMEnvironment8 struct {
 	ATM *AutomatedTellerMachine6 `xml:"ATM"`
 }
 
+func (a *ATMEnvironment8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment8) AddAcquirer() *Acquirer7 {
 	a.Acquirer = new(Acquirer7)
 	return a.Acquirer
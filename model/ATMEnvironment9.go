@@ -13,6 +13,10 @@ type ATMEnvironment9 struct {
 	ATM *AutomatedTellerMachine7 `xml:"ATM"`
 }
 
+func (a *ATMEnvironment9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEnvironment9) AddAcquirer() *Acquirer7 {
 	a.Acquirer = new(Acquirer7)
 	return a.Acquirer
@@ -28,6 +28,10 @@ type ATMEquipment1 struct {
 	ConfigurationParameter []*ATMConfigurationParameter1 `xml:"CfgtnParam,omitempty"`
 }
 
+func (a *ATMEquipment1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEquipment1) SetManufacturer(value string) {
 	a.Manufacturer = (*Max35Text)(&value)
 }
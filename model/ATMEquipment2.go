@@ -25,6 +25,10 @@ type ATMEquipment2 struct {
 	FirmwareVersion *Max35Text `xml:"FrmwrVrsn,omitempty"`
 }
 
+func (a *ATMEquipment2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEquipment2) SetManufacturer(value string) {
 	a.Manufacturer = (*Max35Text)(&value)
 }
@@ -28,6 +28,10 @@ type ATMEquipment3 struct {
 	FirmwareVersion *Max35Text `xml:"FrmwrVrsn,omitempty"`
 }
 
+func (a *ATMEquipment3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMEquipment3) SetManufacturer(value string) {
 	a.Manufacturer = (*Max35Text)(&value)
 }
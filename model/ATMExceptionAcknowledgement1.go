@@ -13,6 +13,10 @@ type ATMExceptionAcknowledgement1 struct {
 	Transaction *ATMTransaction28 `xml:"Tx"`
 }
 
+func (a *ATMExceptionAcknowledgement1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMExceptionAcknowledgement1) AddATM() *AutomatedTellerMachine3 {
 	a.ATM = new(AutomatedTellerMachine3)
 	return a.ATM
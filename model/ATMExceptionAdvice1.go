@@ -13,6 +13,10 @@ type ATMExceptionAdvice1 struct {
 	Transaction *ATMTransaction27 `xml:"Tx"`
 }
 
+func (a *ATMExceptionAdvice1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMExceptionAdvice1) AddEnvironment() *ATMEnvironment16 {
 	a.Environment = new(ATMEnvironment16)
 	return a.Environment
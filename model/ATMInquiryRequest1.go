@@ -13,6 +13,10 @@ type ATMInquiryRequest1 struct {
 	Transaction *ATMTransaction6 `xml:"Tx"`
 }
 
+func (a *ATMInquiryRequest1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMInquiryRequest1) AddEnvironment() *ATMEnvironment4 {
 	a.Environment = new(ATMEnvironment4)
 	return a.Environment
@@ -13,6 +13,10 @@ type ATMInquiryRequest2 struct {
 	Transaction *ATMTransaction29 `xml:"Tx"`
 }
 
+func (a *ATMInquiryRequest2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMInquiryRequest2) AddEnvironment() *ATMEnvironment14 {
 	a.Environment = new(ATMEnvironment14)
 	return a.Environment
@@ -13,6 +13,10 @@ type ATMInquiryResponse1 struct {
 	Transaction *ATMTransaction7 `xml:"Tx"`
 }
 
+func (a *ATMInquiryResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMInquiryResponse1) AddEnvironment() *ATMEnvironment5 {
 	a.Environment = new(ATMEnvironment5)
 	return a.Environment
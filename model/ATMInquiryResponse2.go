@@ -13,6 +13,10 @@ type ATMInquiryResponse2 struct {
 	Transaction *ATMTransaction21 `xml:"Tx"`
 }
 
+func (a *ATMInquiryResponse2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMInquiryResponse2) AddEnvironment() *ATMEnvironment12 {
 	a.Environment = new(ATMEnvironment12)
 	return a.Environment
@@ -22,6 +22,10 @@ type ATMKeyDownloadRequest1 struct {
 	HostChallenge *Max140Binary `xml:"HstChllng,omitempty"`
 }
 
+func (a *ATMKeyDownloadRequest1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMKeyDownloadRequest1) AddEnvironment() *ATMEnvironment8 {
 	a.Environment = new(ATMEnvironment8)
 	return a.Environment
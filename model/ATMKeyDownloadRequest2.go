@@ -22,6 +22,10 @@ type ATMKeyDownloadRequest2 struct {
 	HostChallenge *Max140Binary `xml:"HstChllng,omitempty"`
 }
 
+func (a *ATMKeyDownloadRequest2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMKeyDownloadRequest2) AddEnvironment() *ATMEnvironment15 {
 	a.Environment = new(ATMEnvironment15)
 	return a.Environment
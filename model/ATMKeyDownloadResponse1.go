@@ -19,6 +19,10 @@ type ATMKeyDownloadResponse1 struct {
 	Command []*ATMCommand1 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMKeyDownloadResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMKeyDownloadResponse1) AddEnvironment() *ATMEnvironment7 {
 	a.Environment = new(ATMEnvironment7)
 	return a.Environment
@@ -19,6 +19,10 @@ type ATMKeyDownloadResponse2 struct {
 	Command []*ATMCommand7 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMKeyDownloadResponse2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMKeyDownloadResponse2) AddEnvironment() *ATMEnvironment7 {
 	a.Environment = new(ATMEnvironment7)
 	return a.Environment
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Media mix selected.
 type ATMMediaMix1 struct {
 
@@ -13,14 +17,32 @@ type ATMMediaMix1 struct {
 	UnitValue *ImpliedCurrencyAndAmount `xml:"UnitVal"`
 }
 
+func (a *ATMMediaMix1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMMediaMix1) SetCashUnitNumber(value string) {
 	a.CashUnitNumber = (*Number)(&value)
 }
 
+func (a *ATMMediaMix1) SetCashUnitNumberFromDecimal(value decimal.Decimal) {
+	a.CashUnitNumber = new(Number)
+	a.CashUnitNumber.FromDecimal(value)
+}
+
 func (a *ATMMediaMix1) SetNumber(value string) {
 	a.Number = (*Number)(&value)
 }
 
+func (a *ATMMediaMix1) SetNumberFromDecimal(value decimal.Decimal) {
+	a.Number = new(Number)
+	a.Number.FromDecimal(value)
+}
+
 func (a *ATMMediaMix1) SetUnitValue(value, currency string) {
 	a.UnitValue = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (a *ATMMediaMix1) SetUnitValueFromDecimal(value decimal.Decimal, currency string) {
+	a.UnitValue = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Media mix selected.
 type ATMMediaMix2 struct {
 
@@ -10,10 +14,23 @@ type ATMMediaMix2 struct {
 	UnitValue *ImpliedCurrencyAndAmount `xml:"UnitVal"`
 }
 
+func (a *ATMMediaMix2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMMediaMix2) SetNumber(value string) {
 	a.Number = (*Number)(&value)
 }
 
+func (a *ATMMediaMix2) SetNumberFromDecimal(value decimal.Decimal) {
+	a.Number = new(Number)
+	a.Number.FromDecimal(value)
+}
+
 func (a *ATMMediaMix2) SetUnitValue(value, currency string) {
 	a.UnitValue = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (a *ATMMediaMix2) SetUnitValueFromDecimal(value decimal.Decimal, currency string) {
+	a.UnitValue = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
@@ -13,6 +13,10 @@ type ATMMessageFunction1 struct {
 	HostServiceCode *Max35Text `xml:"HstSvcCd,omitempty"`
 }
 
+func (a *ATMMessageFunction1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMMessageFunction1) SetFunction(value string) {
 	a.Function = (*MessageFunction7Code)(&value)
 }
@@ -13,6 +13,10 @@ type ATMMessageFunction2 struct {
 	HostServiceCode *Max35Text `xml:"HstSvcCd,omitempty"`
 }
 
+func (a *ATMMessageFunction2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMMessageFunction2) SetFunction(value string) {
 	a.Function = (*MessageFunction11Code)(&value)
 }
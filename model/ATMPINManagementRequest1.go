@@ -13,6 +13,10 @@ type ATMPINManagementRequest1 struct {
 	Transaction *ATMTransaction9 `xml:"Tx"`
 }
 
+func (a *ATMPINManagementRequest1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMPINManagementRequest1) AddEnvironment() *ATMEnvironment1 {
 	a.Environment = new(ATMEnvironment1)
 	return a.Environment
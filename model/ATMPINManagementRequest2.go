@@ -13,6 +13,10 @@ type ATMPINManagementRequest2 struct {
 	Transaction *ATMTransaction9 `xml:"Tx"`
 }
 
+func (a *ATMPINManagementRequest2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMPINManagementRequest2) AddEnvironment() *ATMEnvironment11 {
 	a.Environment = new(ATMEnvironment11)
 	return a.Environment
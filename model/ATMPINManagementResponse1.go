@@ -13,6 +13,10 @@ type ATMPINManagementResponse1 struct {
 	Transaction *ATMTransaction10 `xml:"Tx"`
 }
 
+func (a *ATMPINManagementResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMPINManagementResponse1) AddEnvironment() *ATMEnvironment2 {
 	a.Environment = new(ATMEnvironment2)
 	return a.Environment
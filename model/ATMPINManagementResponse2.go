@@ -13,6 +13,10 @@ type ATMPINManagementResponse2 struct {
 	Transaction *ATMTransaction22 `xml:"Tx"`
 }
 
+func (a *ATMPINManagementResponse2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMPINManagementResponse2) AddEnvironment() *ATMEnvironment12 {
 	a.Environment = new(ATMEnvironment12)
 	return a.Environment
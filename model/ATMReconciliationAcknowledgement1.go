@@ -10,6 +10,10 @@ type ATMReconciliationAcknowledgement1 struct {
 	Transaction *ATMTransaction12 `xml:"Tx"`
 }
 
+func (a *ATMReconciliationAcknowledgement1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMReconciliationAcknowledgement1) AddATM() *AutomatedTellerMachine3 {
 	a.ATM = new(AutomatedTellerMachine3)
 	return a.ATM
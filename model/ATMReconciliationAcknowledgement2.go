@@ -10,6 +10,10 @@ type ATMReconciliationAcknowledgement2 struct {
 	Transaction *ATMTransaction26 `xml:"Tx"`
 }
 
+func (a *ATMReconciliationAcknowledgement2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMReconciliationAcknowledgement2) AddATM() *AutomatedTellerMachine3 {
 	a.ATM = new(AutomatedTellerMachine3)
 	return a.ATM
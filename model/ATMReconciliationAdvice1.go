@@ -16,6 +16,10 @@ type ATMReconciliationAdvice1 struct {
 	Transaction *ATMTransaction11 `xml:"Tx"`
 }
 
+func (a *ATMReconciliationAdvice1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMReconciliationAdvice1) AddEnvironment() *ATMEnvironment10 {
 	a.Environment = new(ATMEnvironment10)
 	return a.Environment
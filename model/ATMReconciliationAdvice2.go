@@ -16,6 +16,10 @@ type ATMReconciliationAdvice2 struct {
 	Transaction *ATMTransaction25 `xml:"Tx"`
 }
 
+func (a *ATMReconciliationAdvice2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMReconciliationAdvice2) AddEnvironment() *ATMEnvironment10 {
 	a.Environment = new(ATMEnvironment10)
 	return a.Environment
@@ -20,6 +20,10 @@ type ATMReject1 struct {
 	MessageInError *Max100KBinary `xml:"MsgInErr,omitempty"`
 }
 
+func (a *ATMReject1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMReject1) SetRejectInitiatorIdentification(value string) {
 	a.RejectInitiatorIdentification = (*Max35Text)(&value)
 }
@@ -20,6 +20,10 @@ type ATMReject2 struct {
 	MessageInError *Max100KBinary `xml:"MsgInErr,omitempty"`
 }
 
+func (a *ATMReject2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMReject2) SetRejectInitiatorIdentification(value string) {
 	a.RejectInitiatorIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type ATMSecurityConfiguration1 struct {
 	MessageProtection []*MessageProtection1Code `xml:"MsgPrtcn,omitempty"`
 }
 
+func (a *ATMSecurityConfiguration1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMSecurityConfiguration1) AddKeys() *ATMSecurityConfiguration2 {
 	a.Keys = new(ATMSecurityConfiguration2)
 	return a.Keys
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Configuration of the cryptographic keys.
 type ATMSecurityConfiguration2 struct {
 
@@ -16,18 +20,42 @@ type ATMSecurityConfiguration2 struct {
 	MaximumRootKeyLength *Number `xml:"MaxRootKeyLngth,omitempty"`
 }
 
+func (a *ATMSecurityConfiguration2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMSecurityConfiguration2) SetMaximumSymmetricKey(value string) {
 	a.MaximumSymmetricKey = (*Number)(&value)
 }
 
+func (a *ATMSecurityConfiguration2) SetMaximumSymmetricKeyFromDecimal(value decimal.Decimal) {
+	a.MaximumSymmetricKey = new(Number)
+	a.MaximumSymmetricKey.FromDecimal(value)
+}
+
 func (a *ATMSecurityConfiguration2) SetMaximumAsymmetricKey(value string) {
 	a.MaximumAsymmetricKey = (*Number)(&value)
 }
 
+func (a *ATMSecurityConfiguration2) SetMaximumAsymmetricKeyFromDecimal(value decimal.Decimal) {
+	a.MaximumAsymmetricKey = new(Number)
+	a.MaximumAsymmetricKey.FromDecimal(value)
+}
+
 func (a *ATMSecurityConfiguration2) SetMaximumRSAKeyLength(value string) {
 	a.MaximumRSAKeyLength = (*Number)(&value)
 }
 
+func (a *ATMSecurityConfiguration2) SetMaximumRSAKeyLengthFromDecimal(value decimal.Decimal) {
+	a.MaximumRSAKeyLength = new(Number)
+	a.MaximumRSAKeyLength.FromDecimal(value)
+}
+
 func (a *ATMSecurityConfiguration2) SetMaximumRootKeyLength(value string) {
 	a.MaximumRootKeyLength = (*Number)(&value)
 }
+
+func (a *ATMSecurityConfiguration2) SetMaximumRootKeyLengthFromDecimal(value decimal.Decimal) {
+	a.MaximumRootKeyLength = new(Number)
+	a.MaximumRootKeyLength.FromDecimal(value)
+}
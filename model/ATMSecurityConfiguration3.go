@@ -25,14 +25,28 @@ type ATMSecurityConfiguration3 struct {
 	EncryptionFormat []*EncryptionFormat1Code `xml:"NcrptnFrmt,omitempty"`
 }
 
+func (a *ATMSecurityConfiguration3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMSecurityConfiguration3) SetAsymmetricEncryption(value string) {
 	a.AsymmetricEncryption = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMSecurityConfiguration3) SetAsymmetricEncryptionFromBool(value bool) {
+	a.AsymmetricEncryption = new(TrueFalseIndicator)
+	a.AsymmetricEncryption.FromBool(value)
+}
+
 func (a *ATMSecurityConfiguration3) SetAsymmetricKeyStandardIdentification(value string) {
 	a.AsymmetricKeyStandardIdentification = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMSecurityConfiguration3) SetAsymmetricKeyStandardIdentificationFromBool(value bool) {
+	a.AsymmetricKeyStandardIdentification = new(TrueFalseIndicator)
+	a.AsymmetricKeyStandardIdentification.FromBool(value)
+}
+
 func (a *ATMSecurityConfiguration3) AddAsymmetricEncryptionAlgorithm(value string) {
 	a.AsymmetricEncryptionAlgorithm = append(a.AsymmetricEncryptionAlgorithm, (*Algorithm7Code)(&value))
 }
@@ -41,6 +55,11 @@ func (a *ATMSecurityConfiguration3) SetSymmetricTransportKey(value string) {
 	a.SymmetricTransportKey = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMSecurityConfiguration3) SetSymmetricTransportKeyFromBool(value bool) {
+	a.SymmetricTransportKey = new(TrueFalseIndicator)
+	a.SymmetricTransportKey.FromBool(value)
+}
+
 func (a *ATMSecurityConfiguration3) AddSymmetricTransportKeyAlgorithm(value string) {
 	a.SymmetricTransportKeyAlgorithm = append(a.SymmetricTransportKeyAlgorithm, (*Algorithm13Code)(&value))
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Configuration of the digital signatures if the security module is able to perform digital signatures with an asymmetric key.
 type ATMSecurityConfiguration4 struct {
 
@@ -13,14 +17,28 @@ type ATMSecurityConfiguration4 struct {
 	DigitalSignatureAlgorithm []*Algorithm14Code `xml:"DgtlSgntrAlgo,omitempty"`
 }
 
+func (a *ATMSecurityConfiguration4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMSecurityConfiguration4) SetMaximumCertificates(value string) {
 	a.MaximumCertificates = (*Number)(&value)
 }
 
+func (a *ATMSecurityConfiguration4) SetMaximumCertificatesFromDecimal(value decimal.Decimal) {
+	a.MaximumCertificates = new(Number)
+	a.MaximumCertificates.FromDecimal(value)
+}
+
 func (a *ATMSecurityConfiguration4) SetMaximumSignatures(value string) {
 	a.MaximumSignatures = (*Number)(&value)
 }
 
+func (a *ATMSecurityConfiguration4) SetMaximumSignaturesFromDecimal(value decimal.Decimal) {
+	a.MaximumSignatures = new(Number)
+	a.MaximumSignatures.FromDecimal(value)
+}
+
 func (a *ATMSecurityConfiguration4) AddDigitalSignatureAlgorithm(value string) {
 	a.DigitalSignatureAlgorithm = append(a.DigitalSignatureAlgorithm, (*Algorithm14Code)(&value))
 }
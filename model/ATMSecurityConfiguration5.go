@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Configuration of the PIN online verification.
 type ATMSecurityConfiguration5 struct {
 
@@ -10,6 +14,10 @@ type ATMSecurityConfiguration5 struct {
 	PINLengthCapabilities *Number `xml:"PINLngthCpblties,omitempty"`
 }
 
+func (a *ATMSecurityConfiguration5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMSecurityConfiguration5) AddPINFormat(value string) {
 	a.PINFormat = append(a.PINFormat, (*PINFormat4Code)(&value))
 }
@@ -17,3 +25,8 @@ func (a *ATMSecurityConfiguration5) AddPINFormat(value string) {
 func (a *ATMSecurityConfiguration5) SetPINLengthCapabilities(value string) {
 	a.PINLengthCapabilities = (*Number)(&value)
 }
+
+func (a *ATMSecurityConfiguration5) SetPINLengthCapabilitiesFromDecimal(value decimal.Decimal) {
+	a.PINLengthCapabilities = new(Number)
+	a.PINLengthCapabilities.FromDecimal(value)
+}
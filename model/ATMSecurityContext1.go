@@ -19,6 +19,10 @@ type ATMSecurityContext1 struct {
 	HostChallenge *Max140Binary `xml:"HstChllng,omitempty"`
 }
 
+func (a *ATMSecurityContext1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMSecurityContext1) SetCurrentSecurityScheme(value string) {
 	a.CurrentSecurityScheme = (*ATMSecurityScheme1Code)(&value)
 }
@@ -13,6 +13,10 @@ type ATMSecurityContext2 struct {
 	CurrentConfiguration *ATMSecurityConfiguration1 `xml:"CurCfgtn,omitempty"`
 }
 
+func (a *ATMSecurityContext2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMSecurityContext2) SetCurrentSecurityScheme(value string) {
 	a.CurrentSecurityScheme = (*ATMSecurityScheme1Code)(&value)
 }
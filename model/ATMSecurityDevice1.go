@@ -19,6 +19,10 @@ type ATMSecurityDevice1 struct {
 	Incident *FailureReason5Code `xml:"Incdnt,omitempty"`
 }
 
+func (a *ATMSecurityDevice1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMSecurityDevice1) AddDeviceProperty() *ATMEquipment2 {
 	a.DeviceProperty = new(ATMEquipment2)
 	return a.DeviceProperty
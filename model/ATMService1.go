@@ -13,6 +13,10 @@ type ATMService1 struct {
 	ServiceType *ATMServiceType1Code `xml:"SvcTp"`
 }
 
+func (a *ATMService1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService1) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
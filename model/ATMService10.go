@@ -19,6 +19,10 @@ type ATMService10 struct {
 	ServiceVariantIdentification []*Max35Text `xml:"SvcVarntId,omitempty"`
 }
 
+func (a *ATMService10) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService10) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type ATMService11 struct {
 	MultiAccount *TrueFalseIndicator `xml:"MultiAcct,omitempty"`
 }
 
+func (a *ATMService11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService11) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
@@ -42,6 +46,16 @@ func (a *ATMService11) SetCashBack(value string) {
 	a.CashBack = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMService11) SetCashBackFromBool(value bool) {
+	a.CashBack = new(TrueFalseIndicator)
+	a.CashBack.FromBool(value)
+}
+
 func (a *ATMService11) SetMultiAccount(value string) {
 	a.MultiAccount = (*TrueFalseIndicator)(&value)
 }
+
+func (a *ATMService11) SetMultiAccountFromBool(value bool) {
+	a.MultiAccount = new(TrueFalseIndicator)
+	a.MultiAccount.FromBool(value)
+}
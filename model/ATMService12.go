@@ -25,6 +25,10 @@ type ATMService12 struct {
 	MultiAccount *TrueFalseIndicator `xml:"MultiAcct,omitempty"`
 }
 
+func (a *ATMService12) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService12) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
@@ -49,6 +53,16 @@ func (a *ATMService12) SetCashBack(value string) {
 	a.CashBack = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMService12) SetCashBackFromBool(value bool) {
+	a.CashBack = new(TrueFalseIndicator)
+	a.CashBack.FromBool(value)
+}
+
 func (a *ATMService12) SetMultiAccount(value string) {
 	a.MultiAccount = (*TrueFalseIndicator)(&value)
 }
+
+func (a *ATMService12) SetMultiAccountFromBool(value bool) {
+	a.MultiAccount = new(TrueFalseIndicator)
+	a.MultiAccount.FromBool(value)
+}
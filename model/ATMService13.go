@@ -28,6 +28,10 @@ type ATMService13 struct {
 	PartialDeposit *TrueFalseIndicator `xml:"PrtlDpst,omitempty"`
 }
 
+func (a *ATMService13) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService13) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
@@ -52,10 +56,25 @@ func (a *ATMService13) SetCashBack(value string) {
 	a.CashBack = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMService13) SetCashBackFromBool(value bool) {
+	a.CashBack = new(TrueFalseIndicator)
+	a.CashBack.FromBool(value)
+}
+
 func (a *ATMService13) SetMultiAccount(value string) {
 	a.MultiAccount = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMService13) SetMultiAccountFromBool(value bool) {
+	a.MultiAccount = new(TrueFalseIndicator)
+	a.MultiAccount.FromBool(value)
+}
+
 func (a *ATMService13) SetPartialDeposit(value string) {
 	a.PartialDeposit = (*TrueFalseIndicator)(&value)
 }
+
+func (a *ATMService13) SetPartialDepositFromBool(value bool) {
+	a.PartialDeposit = new(TrueFalseIndicator)
+	a.PartialDeposit.FromBool(value)
+}
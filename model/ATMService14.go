@@ -19,6 +19,10 @@ type ATMService14 struct {
 	ServiceVariantIdentification []*Max35Text `xml:"SvcVarntId,omitempty"`
 }
 
+func (a *ATMService14) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService14) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type ATMService15 struct {
 	ServiceVariantIdentification []*Max35Text `xml:"SvcVarntId,omitempty"`
 }
 
+func (a *ATMService15) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService15) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type ATMService16 struct {
 	ServiceVariantIdentification []*Max35Text `xml:"SvcVarntId,omitempty"`
 }
 
+func (a *ATMService16) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService16) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type ATMService17 struct {
 	PreferredWithdrawal *ATMTransaction8 `xml:"PrefrdWdrwl,omitempty"`
 }
 
+func (a *ATMService17) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService17) SetServiceType(value string) {
 	a.ServiceType = (*ATMServiceType8Code)(&value)
 }
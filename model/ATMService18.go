@@ -10,6 +10,10 @@ type ATMService18 struct {
 	Label *Max35Text `xml:"Labl,omitempty"`
 }
 
+func (a *ATMService18) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService18) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type ATMService19 struct {
 	Limits []*ATMTransactionAmounts6 `xml:"Lmts,omitempty"`
 }
 
+func (a *ATMService19) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService19) SetServiceType(value string) {
 	a.ServiceType = (*ATMServiceType8Code)(&value)
 }
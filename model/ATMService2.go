@@ -16,6 +16,10 @@ type ATMService2 struct {
 	ServiceType *ATMServiceType1Code `xml:"SvcTp"`
 }
 
+func (a *ATMService2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService2) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type ATMService20 struct {
 	ServiceVariantIdentification []*Max35Text `xml:"SvcVarntId,omitempty"`
 }
 
+func (a *ATMService20) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService20) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type ATMService21 struct {
 	ServiceVariantIdentification []*Max35Text `xml:"SvcVarntId,omitempty"`
 }
 
+func (a *ATMService21) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService21) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
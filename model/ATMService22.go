@@ -16,6 +16,10 @@ type ATMService22 struct {
 	ServiceVariantIdentification []*Max35Text `xml:"SvcVarntId,omitempty"`
 }
 
+func (a *ATMService22) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService22) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type ATMService23 struct {
 	ServiceVariantIdentification []*Max35Text `xml:"SvcVarntId,omitempty"`
 }
 
+func (a *ATMService23) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService23) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type ATMService24 struct {
 	ServiceVariantIdentification []*Max35Text `xml:"SvcVarntId,omitempty"`
 }
 
+func (a *ATMService24) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService24) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type ATMService3 struct {
 	ServiceType *ATMServiceType2Code `xml:"SvcTp"`
 }
 
+func (a *ATMService3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService3) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
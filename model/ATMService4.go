@@ -16,6 +16,10 @@ type ATMService4 struct {
 	ServiceType *ATMServiceType5Code `xml:"SvcTp"`
 }
 
+func (a *ATMService4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService4) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type ATMService5 struct {
 	ServiceType *ATMServiceType3Code `xml:"SvcTp"`
 }
 
+func (a *ATMService5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService5) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
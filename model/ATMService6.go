@@ -16,6 +16,10 @@ type ATMService6 struct {
 	ServiceType *ATMServiceType3Code `xml:"SvcTp"`
 }
 
+func (a *ATMService6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService6) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
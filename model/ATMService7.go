@@ -13,6 +13,10 @@ type ATMService7 struct {
 	PreferredWithdrawal *ATMTransaction8 `xml:"PrefrdWdrwl,omitempty"`
 }
 
+func (a *ATMService7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService7) SetServiceType(value string) {
 	a.ServiceType = (*ATMServiceType4Code)(&value)
 }
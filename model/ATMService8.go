@@ -13,6 +13,10 @@ type ATMService8 struct {
 	ServiceType *ATMServiceType5Code `xml:"SvcTp"`
 }
 
+func (a *ATMService8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService8) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type ATMService9 struct {
 	ServiceVariantIdentification []*Max35Text `xml:"SvcVarntId,omitempty"`
 }
 
+func (a *ATMService9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMService9) SetServiceReference(value string) {
 	a.ServiceReference = (*Max35Text)(&value)
 }
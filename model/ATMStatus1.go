@@ -10,6 +10,10 @@ type ATMStatus1 struct {
 	DemandedStatus *ATMStatus1Code `xml:"DmnddSts,omitempty"`
 }
 
+func (a *ATMStatus1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMStatus1) SetCurrentStatus(value string) {
 	a.CurrentStatus = (*ATMStatus1Code)(&value)
 }
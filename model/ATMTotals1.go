@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Current totals of the ATM.
 type ATMTotals1 struct {
 
@@ -22,6 +26,10 @@ type ATMTotals1 struct {
 	ATMCurrentNumber *Number `xml:"ATMCurNb,omitempty"`
 }
 
+func (a *ATMTotals1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTotals1) SetMediaType(value string) {
 	a.MediaType = (*ATMMediaType1Code)(&value)
 }
@@ -34,14 +42,32 @@ func (a *ATMTotals1) SetATMBalance(value, currency string) {
 	a.ATMBalance = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTotals1) SetATMBalanceFromDecimal(value decimal.Decimal, currency string) {
+	a.ATMBalance = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTotals1) SetATMCurrent(value, currency string) {
 	a.ATMCurrent = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTotals1) SetATMCurrentFromDecimal(value decimal.Decimal, currency string) {
+	a.ATMCurrent = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTotals1) SetATMBalanceNumber(value string) {
 	a.ATMBalanceNumber = (*Number)(&value)
 }
 
+func (a *ATMTotals1) SetATMBalanceNumberFromDecimal(value decimal.Decimal) {
+	a.ATMBalanceNumber = new(Number)
+	a.ATMBalanceNumber.FromDecimal(value)
+}
+
 func (a *ATMTotals1) SetATMCurrentNumber(value string) {
 	a.ATMCurrentNumber = (*Number)(&value)
 }
+
+func (a *ATMTotals1) SetATMCurrentNumberFromDecimal(value decimal.Decimal) {
+	a.ATMCurrentNumber = new(Number)
+	a.ATMCurrentNumber.FromDecimal(value)
+}
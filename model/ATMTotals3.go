@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Totals of the ATM.
 type ATMTotals3 struct {
 
@@ -22,6 +26,10 @@ type ATMTotals3 struct {
 	Amount *ImpliedCurrencyAndAmount `xml:"Amt,omitempty"`
 }
 
+func (a *ATMTotals3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTotals3) SetIdentification(value string) {
 	a.Identification = (*Max70Text)(&value)
 }
@@ -42,6 +50,15 @@ func (a *ATMTotals3) SetCount(value string) {
 	a.Count = (*Number)(&value)
 }
 
+func (a *ATMTotals3) SetCountFromDecimal(value decimal.Decimal) {
+	a.Count = new(Number)
+	a.Count.FromDecimal(value)
+}
+
 func (a *ATMTotals3) SetAmount(value, currency string) {
 	a.Amount = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (a *ATMTotals3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
@@ -40,10 +40,19 @@ type ATMTransaction1 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (a *ATMTransaction1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction1) SetCashDispensed(value string) {
 	a.CashDispensed = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction1) SetCashDispensedFromBool(value bool) {
+	a.CashDispensed = new(TrueFalseIndicator)
+	a.CashDispensed.FromBool(value)
+}
+
 func (a *ATMTransaction1) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -232,6 +241,11 @@ func (a *ATMTransaction1) SetRequestedReceipt(value string) {
 	a.RequestedReceipt = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction1) SetRequestedReceiptFromBool(value bool) {
+	a.RequestedReceipt = new(TrueFalseIndicator)
+	a.RequestedReceipt.FromBool(value)
+}
+
 func (a *ATMTransaction1) SetICCRelatedData(value string) {
 	a.ICCRelatedData = (*Max10000Binary)(&value)
 }
@@ -25,6 +25,10 @@ type ATMTransaction10 struct {
 	Command []*ATMCommand1 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMTransaction10) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction10) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -38,6 +42,11 @@ func (a *ATMTransaction10) SetCompletionRequired(value string) {
 	a.CompletionRequired = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction10) SetCompletionRequiredFromBool(value bool) {
+	a.CompletionRequired = new(TrueFalseIndicator)
+	a.CompletionRequired.FromBool(value)
+}
+
 func (a *ATMTransaction10) AddTransactionResponse() *ResponseType3 {
 	a.TransactionResponse = new(ResponseType3)
 	return a.TransactionResponse
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about the reconciliation request.
 type ATMTransaction11 struct {
 
@@ -28,6 +32,10 @@ type ATMTransaction11 struct {
 	AdditionalTransactionInformation *Max140Text `xml:"AddtlTxInf,omitempty"`
 }
 
+func (a *ATMTransaction11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction11) SetTypeOfOperation(value string) {
 	a.TypeOfOperation = (*ATMOperation1Code)(&value)
 }
@@ -483,6 +491,11 @@ func (a *ATMTransaction11) SetRetainedCard(value string) {
 	a.RetainedCard = (*Number)(&value)
 }
 
+func (a *ATMTransaction11) SetRetainedCardFromDecimal(value decimal.Decimal) {
+	a.RetainedCard = new(Number)
+	a.RetainedCard.FromDecimal(value)
+}
+
 func (a *ATMTransaction11) SetAdditionalTransactionInformation(value string) {
 	a.AdditionalTransactionInformation = (*Max140Text)(&value)
 }
@@ -19,6 +19,10 @@ type ATMTransaction12 struct {
 	Command []*ATMCommand1 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMTransaction12) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction12) SetTypeOfOperation(value string) {
 	a.TypeOfOperation = (*ATMOperation1Code)(&value)
 }
@@ -31,6 +31,10 @@ type ATMTransaction15 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (a *ATMTransaction15) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction15) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -351,6 +355,11 @@ func (a *ATMTransaction15) SetRequestedReceipt(value string) {
 	a.RequestedReceipt = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction15) SetRequestedReceiptFromBool(value bool) {
+	a.RequestedReceipt = new(TrueFalseIndicator)
+	a.RequestedReceipt.FromBool(value)
+}
+
 func (a *ATMTransaction15) SetICCRelatedData(value string) {
 	a.ICCRelatedData = (*Max10000Binary)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Response to the deposit request.
 type ATMTransaction16 struct {
 
@@ -40,6 +44,10 @@ type ATMTransaction16 struct {
 	Command []*ATMCommand7 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMTransaction16) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction16) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -53,6 +61,11 @@ func (a *ATMTransaction16) SetCompletionRequired(value string) {
 	a.CompletionRequired = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction16) SetCompletionRequiredFromBool(value bool) {
+	a.CompletionRequired = new(TrueFalseIndicator)
+	a.CompletionRequired.FromBool(value)
+}
+
 func (a *ATMTransaction16) AddAccountData() *CardAccount10 {
 	a.AccountData = new(CardAccount10)
 	return a.AccountData
@@ -72,6 +85,10 @@ func (a *ATMTransaction16) SetTotalRequestedAmount(value, currency string) {
 	a.TotalRequestedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransaction16) SetTotalRequestedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.TotalRequestedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransaction16) AddDetailedRequestedAmount() *DetailedAmount16 {
 	newValue := new(DetailedAmount16)
 	a.DetailedRequestedAmount = append(a.DetailedRequestedAmount, newValue)
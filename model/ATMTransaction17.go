@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Withdrawal transaction for which the completion is sent.
 type ATMTransaction17 struct {
 
@@ -76,6 +80,10 @@ type ATMTransaction17 struct {
 	Cassette []*ATMCassette2 `xml:"Csstt,omitempty"`
 }
 
+func (a *ATMTransaction17) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction17) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -89,6 +97,11 @@ func (a *ATMTransaction17) SetMultiBundle(value string) {
 	a.MultiBundle = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction17) SetMultiBundleFromBool(value bool) {
+	a.MultiBundle = new(TrueFalseIndicator)
+	a.MultiBundle.FromBool(value)
+}
+
 func (a *ATMTransaction17) AddBundlePresentedAmount(value, currency string) {
 	a.BundlePresentedAmount = append(a.BundlePresentedAmount, NewImpliedCurrencyAndAmount(value, currency))
 }
@@ -128,10 +141,18 @@ func (a *ATMTransaction17) SetTotalAuthorisedAmount(value, currency string) {
 	a.TotalAuthorisedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransaction17) SetTotalAuthorisedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.TotalAuthorisedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransaction17) SetTotalRequestedAmount(value, currency string) {
 	a.TotalRequestedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransaction17) SetTotalRequestedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.TotalRequestedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransaction17) AddDetailedRequestedAmount() *DetailedAmount12 {
 	a.DetailedRequestedAmount = new(DetailedAmount12)
 	return a.DetailedRequestedAmount
@@ -292,14 +313,29 @@ func (a *ATMTransaction17) SetRequestedReceipt(value string) {
 	a.RequestedReceipt = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction17) SetRequestedReceiptFromBool(value bool) {
+	a.RequestedReceipt = new(TrueFalseIndicator)
+	a.RequestedReceipt.FromBool(value)
+}
+
 func (a *ATMTransaction17) SetReceiptPrinted(value string) {
 	a.ReceiptPrinted = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction17) SetReceiptPrintedFromBool(value bool) {
+	a.ReceiptPrinted = new(TrueFalseIndicator)
+	a.ReceiptPrinted.FromBool(value)
+}
+
 func (a *ATMTransaction17) SetCustomerConsent(value string) {
 	a.CustomerConsent = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction17) SetCustomerConsentFromBool(value bool) {
+	a.CustomerConsent = new(TrueFalseIndicator)
+	a.CustomerConsent.FromBool(value)
+}
+
 func (a *ATMTransaction17) AddLimits() *ATMTransactionAmounts6 {
 	a.Limits = new(ATMTransactionAmounts6)
 	return a.Limits
@@ -19,6 +19,10 @@ type ATMTransaction18 struct {
 	Command []*ATMCommand7 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMTransaction18) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction18) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Response to the deposit request.
 type ATMTransaction19 struct {
 
@@ -64,6 +68,10 @@ type ATMTransaction19 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (a *ATMTransaction19) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction19) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -104,10 +112,18 @@ func (a *ATMTransaction19) SetTotalAuthorisedAmount(value, currency string) {
 	a.TotalAuthorisedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransaction19) SetTotalAuthorisedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.TotalAuthorisedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransaction19) SetTotalRequestedAmount(value, currency string) {
 	a.TotalRequestedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransaction19) SetTotalRequestedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.TotalRequestedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransaction19) AddDetailedRequestedAmount() *DetailedAmount16 {
 	a.DetailedRequestedAmount = new(DetailedAmount16)
 	return a.DetailedRequestedAmount
@@ -263,10 +279,20 @@ func (a *ATMTransaction19) SetRequestedReceipt(value string) {
 	a.RequestedReceipt = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction19) SetRequestedReceiptFromBool(value bool) {
+	a.RequestedReceipt = new(TrueFalseIndicator)
+	a.RequestedReceipt.FromBool(value)
+}
+
 func (a *ATMTransaction19) SetReceiptPrinted(value string) {
 	a.ReceiptPrinted = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction19) SetReceiptPrintedFromBool(value bool) {
+	a.ReceiptPrinted = new(TrueFalseIndicator)
+	a.ReceiptPrinted.FromBool(value)
+}
+
 func (a *ATMTransaction19) AddAuthorisationResult() *AuthorisationResult13 {
 	a.AuthorisationResult = new(AuthorisationResult13)
 	return a.AuthorisationResult
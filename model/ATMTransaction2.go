@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Response to the withdrawal transaction request.
 type ATMTransaction2 struct {
 
@@ -52,6 +56,10 @@ type ATMTransaction2 struct {
 	Command []*ATMCommand1 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMTransaction2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction2) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -65,6 +73,11 @@ func (a *ATMTransaction2) SetCompletionRequired(value string) {
 	a.CompletionRequired = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction2) SetCompletionRequiredFromBool(value bool) {
+	a.CompletionRequired = new(TrueFalseIndicator)
+	a.CompletionRequired.FromBool(value)
+}
+
 func (a *ATMTransaction2) AddAccountData() *CardAccount4 {
 	a.AccountData = new(CardAccount4)
 	return a.AccountData
@@ -84,6 +97,10 @@ func (a *ATMTransaction2) SetTotalRequestedAmount(value, currency string) {
 	a.TotalRequestedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransaction2) SetTotalRequestedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.TotalRequestedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransaction2) AddDetailedRequestedAmount() *DetailedAmount12 {
 	a.DetailedRequestedAmount = new(DetailedAmount12)
 	return a.DetailedRequestedAmount
@@ -34,6 +34,10 @@ type ATMTransaction20 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (a *ATMTransaction20) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction20) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -59,14 +63,29 @@ func (a *ATMTransaction20) SetRequestedReceipt(value string) {
 	a.RequestedReceipt = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction20) SetRequestedReceiptFromBool(value bool) {
+	a.RequestedReceipt = new(TrueFalseIndicator)
+	a.RequestedReceipt.FromBool(value)
+}
+
 func (a *ATMTransaction20) SetReceiptPrinted(value string) {
 	a.ReceiptPrinted = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction20) SetReceiptPrintedFromBool(value bool) {
+	a.ReceiptPrinted = new(TrueFalseIndicator)
+	a.ReceiptPrinted.FromBool(value)
+}
+
 func (a *ATMTransaction20) SetCustomerConsent(value string) {
 	a.CustomerConsent = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction20) SetCustomerConsentFromBool(value bool) {
+	a.CustomerConsent = new(TrueFalseIndicator)
+	a.CustomerConsent.FromBool(value)
+}
+
 func (a *ATMTransaction20) AddAuthorisationResult() *AuthorisationResult13 {
 	a.AuthorisationResult = new(AuthorisationResult13)
 	return a.AuthorisationResult
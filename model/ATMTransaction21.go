@@ -34,6 +34,10 @@ type ATMTransaction21 struct {
 	Command []*ATMCommand7 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMTransaction21) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction21) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
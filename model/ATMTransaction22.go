@@ -25,6 +25,10 @@ type ATMTransaction22 struct {
 	Command []*ATMCommand7 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMTransaction22) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction22) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -38,6 +42,11 @@ func (a *ATMTransaction22) SetCompletionRequired(value string) {
 	a.CompletionRequired = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction22) SetCompletionRequiredFromBool(value bool) {
+	a.CompletionRequired = new(TrueFalseIndicator)
+	a.CompletionRequired.FromBool(value)
+}
+
 func (a *ATMTransaction22) AddTransactionResponse() *ResponseType7 {
 	a.TransactionResponse = new(ResponseType7)
 	return a.TransactionResponse
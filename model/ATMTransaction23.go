@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Transfer information for the transaction.
 type ATMTransaction23 struct {
 
@@ -52,6 +58,10 @@ type ATMTransaction23 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (a *ATMTransaction23) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction23) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -238,6 +248,10 @@ func (a *ATMTransaction23) SetTotalRequestedAmount(value, currency string) {
 	a.TotalRequestedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransaction23) SetTotalRequestedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.TotalRequestedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransaction23) AddDetailedRequestedAmount() *DetailedAmount17 {
 	a.DetailedRequestedAmount = new(DetailedAmount17)
 	return a.DetailedRequestedAmount
@@ -247,6 +261,11 @@ func (a *ATMTransaction23) SetRequestedExecutionDate(value string) {
 	a.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (a *ATMTransaction23) SetRequestedExecutionDateFromTime(value time.Time) {
+	a.RequestedExecutionDate = new(ISODate)
+	a.RequestedExecutionDate.FromTime(value)
+}
+
 func (a *ATMTransaction23) SetInstantTransferProgram(value string) {
 	a.InstantTransferProgram = (*Max35Text)(&value)
 }
@@ -260,6 +279,11 @@ func (a *ATMTransaction23) SetRequestedReceipt(value string) {
 	a.RequestedReceipt = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction23) SetRequestedReceiptFromBool(value bool) {
+	a.RequestedReceipt = new(TrueFalseIndicator)
+	a.RequestedReceipt.FromBool(value)
+}
+
 func (a *ATMTransaction23) SetICCRelatedData(value string) {
 	a.ICCRelatedData = (*Max10000Binary)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Transfer information for the transaction.
 type ATMTransaction24 struct {
 
@@ -76,6 +82,10 @@ type ATMTransaction24 struct {
 	Command []*ATMCommand7 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMTransaction24) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction24) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -422,6 +432,10 @@ func (a *ATMTransaction24) SetTotalRequestedAmount(value, currency string) {
 	a.TotalRequestedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransaction24) SetTotalRequestedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.TotalRequestedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransaction24) AddDetailedRequestedAmount() *DetailedAmount17 {
 	a.DetailedRequestedAmount = new(DetailedAmount17)
 	return a.DetailedRequestedAmount
@@ -582,10 +596,20 @@ func (a *ATMTransaction24) SetRequestedExecutionDate(value string) {
 	a.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (a *ATMTransaction24) SetRequestedExecutionDateFromTime(value time.Time) {
+	a.RequestedExecutionDate = new(ISODate)
+	a.RequestedExecutionDate.FromTime(value)
+}
+
 func (a *ATMTransaction24) SetProposedExecutionDate(value string) {
 	a.ProposedExecutionDate = (*ISODate)(&value)
 }
 
+func (a *ATMTransaction24) SetProposedExecutionDateFromTime(value time.Time) {
+	a.ProposedExecutionDate = new(ISODate)
+	a.ProposedExecutionDate.FromTime(value)
+}
+
 func (a *ATMTransaction24) SetInstantTransferProgram(value string) {
 	a.InstantTransferProgram = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type ATMTransaction26 struct {
 	Command []*ATMCommand7 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMTransaction26) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction26) SetTypeOfOperation(value string) {
 	a.TypeOfOperation = (*ATMOperation1Code)(&value)
 }
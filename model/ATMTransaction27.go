@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Transaction for which the exception is sent.
 type ATMTransaction27 struct {
 
@@ -19,6 +23,10 @@ type ATMTransaction27 struct {
 	ElectronicPurseBalance *CurrencyAndAmount `xml:"ElctrncPrsBal,omitempty"`
 }
 
+func (a *ATMTransaction27) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction27) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -39,3 +47,7 @@ func (a *ATMTransaction27) AddExceptionDetail(value string) {
 func (a *ATMTransaction27) SetElectronicPurseBalance(value, currency string) {
 	a.ElectronicPurseBalance = NewCurrencyAndAmount(value, currency)
 }
+
+func (a *ATMTransaction27) SetElectronicPurseBalanceFromDecimal(value decimal.Decimal, currency string) {
+	a.ElectronicPurseBalance = NewCurrencyAndAmount(value.String(), currency)
+}
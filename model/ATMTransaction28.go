@@ -13,6 +13,10 @@ type ATMTransaction28 struct {
 	Command []*ATMCommand7 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMTransaction28) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction28) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -22,6 +22,10 @@ type ATMTransaction29 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (a *ATMTransaction29) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction29) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
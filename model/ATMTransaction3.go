@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Withdrawal transaction for which the completion is sent.
 type ATMTransaction3 struct {
 
@@ -76,6 +80,10 @@ type ATMTransaction3 struct {
 	Cassette []*ATMCassette1 `xml:"Csstt,omitempty"`
 }
 
+func (a *ATMTransaction3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction3) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -89,6 +97,11 @@ func (a *ATMTransaction3) SetMultiBundle(value string) {
 	a.MultiBundle = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction3) SetMultiBundleFromBool(value bool) {
+	a.MultiBundle = new(TrueFalseIndicator)
+	a.MultiBundle.FromBool(value)
+}
+
 func (a *ATMTransaction3) AddBundlePresentedAmount(value, currency string) {
 	a.BundlePresentedAmount = append(a.BundlePresentedAmount, NewImpliedCurrencyAndAmount(value, currency))
 }
@@ -128,10 +141,18 @@ func (a *ATMTransaction3) SetTotalAuthorisedAmount(value, currency string) {
 	a.TotalAuthorisedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransaction3) SetTotalAuthorisedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.TotalAuthorisedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransaction3) SetTotalRequestedAmount(value, currency string) {
 	a.TotalRequestedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransaction3) SetTotalRequestedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.TotalRequestedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransaction3) AddDetailedRequestedAmount() *DetailedAmount12 {
 	a.DetailedRequestedAmount = new(DetailedAmount12)
 	return a.DetailedRequestedAmount
@@ -292,14 +313,29 @@ func (a *ATMTransaction3) SetRequestedReceipt(value string) {
 	a.RequestedReceipt = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction3) SetRequestedReceiptFromBool(value bool) {
+	a.RequestedReceipt = new(TrueFalseIndicator)
+	a.RequestedReceipt.FromBool(value)
+}
+
 func (a *ATMTransaction3) SetReceiptPrinted(value string) {
 	a.ReceiptPrinted = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction3) SetReceiptPrintedFromBool(value bool) {
+	a.ReceiptPrinted = new(TrueFalseIndicator)
+	a.ReceiptPrinted.FromBool(value)
+}
+
 func (a *ATMTransaction3) SetCapturedCard(value string) {
 	a.CapturedCard = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction3) SetCapturedCardFromBool(value bool) {
+	a.CapturedCard = new(TrueFalseIndicator)
+	a.CapturedCard.FromBool(value)
+}
+
 func (a *ATMTransaction3) AddLimits() *ATMTransactionAmounts2 {
 	a.Limits = new(ATMTransactionAmounts2)
 	return a.Limits
@@ -19,6 +19,10 @@ type ATMTransaction4 struct {
 	Command []*ATMCommand1 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMTransaction4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction4) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -34,6 +34,10 @@ type ATMTransaction5 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (a *ATMTransaction5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction5) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -59,14 +63,29 @@ func (a *ATMTransaction5) SetRequestedReceipt(value string) {
 	a.RequestedReceipt = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction5) SetRequestedReceiptFromBool(value bool) {
+	a.RequestedReceipt = new(TrueFalseIndicator)
+	a.RequestedReceipt.FromBool(value)
+}
+
 func (a *ATMTransaction5) SetReceiptPrinted(value string) {
 	a.ReceiptPrinted = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction5) SetReceiptPrintedFromBool(value bool) {
+	a.ReceiptPrinted = new(TrueFalseIndicator)
+	a.ReceiptPrinted.FromBool(value)
+}
+
 func (a *ATMTransaction5) SetCapturedCard(value string) {
 	a.CapturedCard = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction5) SetCapturedCardFromBool(value bool) {
+	a.CapturedCard = new(TrueFalseIndicator)
+	a.CapturedCard.FromBool(value)
+}
+
 func (a *ATMTransaction5) AddAuthorisationResult() *AuthorisationResult9 {
 	a.AuthorisationResult = new(AuthorisationResult9)
 	return a.AuthorisationResult
@@ -22,6 +22,10 @@ type ATMTransaction6 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (a *ATMTransaction6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction6) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
@@ -34,6 +34,10 @@ type ATMTransaction7 struct {
 	Command []*ATMCommand1 `xml:"Cmd,omitempty"`
 }
 
+func (a *ATMTransaction7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction7) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
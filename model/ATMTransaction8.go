@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Preferred withdrawal transaction chosen by the the customer.
 type ATMTransaction8 struct {
 
@@ -22,10 +26,18 @@ type ATMTransaction8 struct {
 	Mix []*ATMMediaMix2 `xml:"Mix,omitempty"`
 }
 
+func (a *ATMTransaction8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction8) SetAmount(value, currency string) {
 	a.Amount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransaction8) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransaction8) SetCurrency(value string) {
 	a.Currency = (*ActiveCurrencyCode)(&value)
 }
@@ -34,10 +46,20 @@ func (a *ATMTransaction8) SetReceiptFlag(value string) {
 	a.ReceiptFlag = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction8) SetReceiptFlagFromBool(value bool) {
+	a.ReceiptFlag = new(TrueFalseIndicator)
+	a.ReceiptFlag.FromBool(value)
+}
+
 func (a *ATMTransaction8) SetBalancePrintFlag(value string) {
 	a.BalancePrintFlag = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransaction8) SetBalancePrintFlagFromBool(value bool) {
+	a.BalancePrintFlag = new(TrueFalseIndicator)
+	a.BalancePrintFlag.FromBool(value)
+}
+
 func (a *ATMTransaction8) SetMixType(value string) {
 	a.MixType = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type ATMTransaction9 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (a *ATMTransaction9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransaction9) AddTransactionIdentification() *TransactionIdentifier1 {
 	a.TransactionIdentification = new(TransactionIdentifier1)
 	return a.TransactionIdentification
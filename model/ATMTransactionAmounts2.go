@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Limit of amounts for the customer.
 type ATMTransactionAmounts2 struct {
 
@@ -25,6 +29,10 @@ type ATMTransactionAmounts2 struct {
 	MonthlyBalance *DetailedAmount4 `xml:"MnthlyBal,omitempty"`
 }
 
+func (a *ATMTransactionAmounts2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransactionAmounts2) SetCurrency(value string) {
 	a.Currency = (*ActiveCurrencyCode)(&value)
 }
@@ -33,14 +41,26 @@ func (a *ATMTransactionAmounts2) SetMaximumAuthorisableAmount(value, currency st
 	a.MaximumAuthorisableAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransactionAmounts2) SetMaximumAuthorisableAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.MaximumAuthorisableAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransactionAmounts2) SetMinimumAllowedAmount(value, currency string) {
 	a.MinimumAllowedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransactionAmounts2) SetMinimumAllowedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.MinimumAllowedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransactionAmounts2) SetMaximumAllowedAmount(value, currency string) {
 	a.MaximumAllowedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransactionAmounts2) SetMaximumAllowedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.MaximumAllowedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransactionAmounts2) AddDailyBalance() *DetailedAmount4 {
 	a.DailyBalance = new(DetailedAmount4)
 	return a.DailyBalance
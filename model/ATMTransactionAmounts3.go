@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Limit of amounts for the customer.
 type ATMTransactionAmounts3 struct {
 
@@ -19,6 +23,10 @@ type ATMTransactionAmounts3 struct {
 	MaximumAmount *ImpliedCurrencyAndAmount `xml:"MaxAmt,omitempty"`
 }
 
+func (a *ATMTransactionAmounts3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransactionAmounts3) SetType(value string) {
 	a.Type = (*Max35Text)(&value)
 }
@@ -35,6 +43,14 @@ func (a *ATMTransactionAmounts3) SetMinimumAmount(value, currency string) {
 	a.MinimumAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransactionAmounts3) SetMinimumAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.MinimumAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransactionAmounts3) SetMaximumAmount(value, currency string) {
 	a.MaximumAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (a *ATMTransactionAmounts3) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.MaximumAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Withdrawal limits for the account.
 type ATMTransactionAmounts4 struct {
 
@@ -19,14 +23,27 @@ type ATMTransactionAmounts4 struct {
 	MonthlyBalance *DetailedAmount4 `xml:"MnthlyBal,omitempty"`
 }
 
+func (a *ATMTransactionAmounts4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransactionAmounts4) SetDisplayFlag(value string) {
 	a.DisplayFlag = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransactionAmounts4) SetDisplayFlagFromBool(value bool) {
+	a.DisplayFlag = new(TrueFalseIndicator)
+	a.DisplayFlag.FromBool(value)
+}
+
 func (a *ATMTransactionAmounts4) SetAvailableAmount(value, currency string) {
 	a.AvailableAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransactionAmounts4) SetAvailableAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.AvailableAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransactionAmounts4) AddDailyBalance() *DetailedAmount4 {
 	a.DailyBalance = new(DetailedAmount4)
 	return a.DailyBalance
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Deposit limits for the account.
 type ATMTransactionAmounts5 struct {
 
@@ -10,10 +14,23 @@ type ATMTransactionAmounts5 struct {
 	MaximumAmount *ImpliedCurrencyAndAmount `xml:"MaxAmt,omitempty"`
 }
 
+func (a *ATMTransactionAmounts5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransactionAmounts5) SetDisplayFlag(value string) {
 	a.DisplayFlag = (*TrueFalseIndicator)(&value)
 }
 
+func (a *ATMTransactionAmounts5) SetDisplayFlagFromBool(value bool) {
+	a.DisplayFlag = new(TrueFalseIndicator)
+	a.DisplayFlag.FromBool(value)
+}
+
 func (a *ATMTransactionAmounts5) SetMaximumAmount(value, currency string) {
 	a.MaximumAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (a *ATMTransactionAmounts5) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.MaximumAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
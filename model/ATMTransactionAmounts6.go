@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Limit of amounts for the customer.
 type ATMTransactionAmounts6 struct {
 
@@ -16,6 +20,10 @@ type ATMTransactionAmounts6 struct {
 	AdditionalAmount []*ATMTransactionAmounts7 `xml:"AddtlAmt,omitempty"`
 }
 
+func (a *ATMTransactionAmounts6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransactionAmounts6) SetCurrency(value string) {
 	a.Currency = (*ActiveCurrencyCode)(&value)
 }
@@ -24,10 +32,18 @@ func (a *ATMTransactionAmounts6) SetMaximumPossibleAmount(value, currency string
 	a.MaximumPossibleAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransactionAmounts6) SetMaximumPossibleAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.MaximumPossibleAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransactionAmounts6) SetMinimumPossibleAmount(value, currency string) {
 	a.MinimumPossibleAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransactionAmounts6) SetMinimumPossibleAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.MinimumPossibleAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransactionAmounts6) AddAdditionalAmount() *ATMTransactionAmounts7 {
 	newValue := new(ATMTransactionAmounts7)
 	a.AdditionalAmount = append(a.AdditionalAmount, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Additional amount that may be displayed to the customer, for instance the daily limit or the daily balance for the service.
 type ATMTransactionAmounts7 struct {
 
@@ -16,6 +20,10 @@ type ATMTransactionAmounts7 struct {
 	Label *Max70Text `xml:"Labl,omitempty"`
 }
 
+func (a *ATMTransactionAmounts7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransactionAmounts7) SetType(value string) {
 	a.Type = (*Max35Text)(&value)
 }
@@ -24,6 +32,10 @@ func (a *ATMTransactionAmounts7) SetAmount(value, currency string) {
 	a.Amount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransactionAmounts7) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransactionAmounts7) SetCurrency(value string) {
 	a.Currency = (*ActiveCurrencyCode)(&value)
 }
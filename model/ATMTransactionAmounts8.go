@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Limit of amounts for the customer.
 type ATMTransactionAmounts8 struct {
 
@@ -19,6 +23,10 @@ type ATMTransactionAmounts8 struct {
 	DepositLimits []*ATMTransactionAmounts9 `xml:"DpstLmts,omitempty"`
 }
 
+func (a *ATMTransactionAmounts8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransactionAmounts8) SetCurrency(value string) {
 	a.Currency = (*ActiveCurrencyCode)(&value)
 }
@@ -27,10 +35,18 @@ func (a *ATMTransactionAmounts8) SetMaximumPossibleAmount(value, currency string
 	a.MaximumPossibleAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransactionAmounts8) SetMaximumPossibleAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.MaximumPossibleAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransactionAmounts8) SetMinimumPossibleAmount(value, currency string) {
 	a.MinimumPossibleAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *ATMTransactionAmounts8) SetMinimumPossibleAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.MinimumPossibleAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *ATMTransactionAmounts8) AddAdditionalAmount() *ATMTransactionAmounts7 {
 	newValue := new(ATMTransactionAmounts7)
 	a.AdditionalAmount = append(a.AdditionalAmount, newValue)
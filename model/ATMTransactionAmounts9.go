@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Limit of deposited media for the customer.
 type ATMTransactionAmounts9 struct {
 
@@ -19,6 +23,10 @@ type ATMTransactionAmounts9 struct {
 	DisplayFlag *TrueFalseIndicator `xml:"DispFlg,omitempty"`
 }
 
+func (a *ATMTransactionAmounts9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransactionAmounts9) SetMediaType(value string) {
 	a.MediaType = (*ATMMediaType2Code)(&value)
 }
@@ -31,10 +39,25 @@ func (a *ATMTransactionAmounts9) SetMinimumNumber(value string) {
 	a.MinimumNumber = (*Number)(&value)
 }
 
+func (a *ATMTransactionAmounts9) SetMinimumNumberFromDecimal(value decimal.Decimal) {
+	a.MinimumNumber = new(Number)
+	a.MinimumNumber.FromDecimal(value)
+}
+
 func (a *ATMTransactionAmounts9) SetMaximumNumber(value string) {
 	a.MaximumNumber = (*Number)(&value)
 }
 
+func (a *ATMTransactionAmounts9) SetMaximumNumberFromDecimal(value decimal.Decimal) {
+	a.MaximumNumber = new(Number)
+	a.MaximumNumber.FromDecimal(value)
+}
+
 func (a *ATMTransactionAmounts9) SetDisplayFlag(value string) {
 	a.DisplayFlag = (*TrueFalseIndicator)(&value)
 }
+
+func (a *ATMTransactionAmounts9) SetDisplayFlagFromBool(value bool) {
+	a.DisplayFlag = new(TrueFalseIndicator)
+	a.DisplayFlag.FromBool(value)
+}
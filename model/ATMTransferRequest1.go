@@ -13,6 +13,10 @@ type ATMTransferRequest1 struct {
 	Transaction *ATMTransaction23 `xml:"Tx"`
 }
 
+func (a *ATMTransferRequest1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransferRequest1) AddEnvironment() *ATMEnvironment11 {
 	a.Environment = new(ATMEnvironment11)
 	return a.Environment
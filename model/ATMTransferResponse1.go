@@ -13,6 +13,10 @@ type ATMTransferResponse1 struct {
 	Transaction *ATMTransaction24 `xml:"Tx"`
 }
 
+func (a *ATMTransferResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMTransferResponse1) AddEnvironment() *ATMEnvironment12 {
 	a.Environment = new(ATMEnvironment12)
 	return a.Environment
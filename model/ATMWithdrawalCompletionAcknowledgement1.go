@@ -13,6 +13,10 @@ type ATMWithdrawalCompletionAcknowledgement1 struct {
 	Transaction *ATMTransaction4 `xml:"Tx"`
 }
 
+func (a *ATMWithdrawalCompletionAcknowledgement1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMWithdrawalCompletionAcknowledgement1) AddATM() *AutomatedTellerMachine3 {
 	a.ATM = new(AutomatedTellerMachine3)
 	return a.ATM
@@ -13,6 +13,10 @@ type ATMWithdrawalCompletionAcknowledgement2 struct {
 	Transaction *ATMTransaction18 `xml:"Tx"`
 }
 
+func (a *ATMWithdrawalCompletionAcknowledgement2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMWithdrawalCompletionAcknowledgement2) AddATM() *AutomatedTellerMachine3 {
 	a.ATM = new(AutomatedTellerMachine3)
 	return a.ATM
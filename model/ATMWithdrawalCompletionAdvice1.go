@@ -13,6 +13,10 @@ type ATMWithdrawalCompletionAdvice1 struct {
 	Transaction *ATMTransaction3 `xml:"Tx"`
 }
 
+func (a *ATMWithdrawalCompletionAdvice1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMWithdrawalCompletionAdvice1) AddEnvironment() *ATMEnvironment3 {
 	a.Environment = new(ATMEnvironment3)
 	return a.Environment
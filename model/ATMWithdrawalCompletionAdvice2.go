@@ -13,6 +13,10 @@ type ATMWithdrawalCompletionAdvice2 struct {
 	Transaction *ATMTransaction17 `xml:"Tx"`
 }
 
+func (a *ATMWithdrawalCompletionAdvice2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMWithdrawalCompletionAdvice2) AddEnvironment() *ATMEnvironment13 {
 	a.Environment = new(ATMEnvironment13)
 	return a.Environment
@@ -13,6 +13,10 @@ type ATMWithdrawalRequest1 struct {
 	Transaction *ATMTransaction1 `xml:"Tx"`
 }
 
+func (a *ATMWithdrawalRequest1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMWithdrawalRequest1) AddEnvironment() *ATMEnvironment1 {
 	a.Environment = new(ATMEnvironment1)
 	return a.Environment
@@ -13,6 +13,10 @@ type ATMWithdrawalRequest2 struct {
 	Transaction *ATMTransaction13 `xml:"Tx"`
 }
 
+func (a *ATMWithdrawalRequest2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMWithdrawalRequest2) AddEnvironment() *ATMEnvironment11 {
 	a.Environment = new(ATMEnvironment11)
 	return a.Environment
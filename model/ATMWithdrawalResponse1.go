@@ -13,6 +13,10 @@ type ATMWithdrawalResponse1 struct {
 	Transaction *ATMTransaction2 `xml:"Tx"`
 }
 
+func (a *ATMWithdrawalResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMWithdrawalResponse1) AddEnvironment() *ATMEnvironment2 {
 	a.Environment = new(ATMEnvironment2)
 	return a.Environment
@@ -13,6 +13,10 @@ type ATMWithdrawalResponse2 struct {
 	Transaction *ATMTransaction14 `xml:"Tx"`
 }
 
+func (a *ATMWithdrawalResponse2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ATMWithdrawalResponse2) AddEnvironment() *ATMEnvironment12 {
 	a.Environment = new(ATMEnvironment12)
 	return a.Environment
@@ -13,10 +13,19 @@ type AcceptanceResult6 struct {
 	AdditionalRejectReasonInformation []*Max105Text `xml:"AddtlRjctRsnInf,omitempty"`
 }
 
+func (a *AcceptanceResult6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptanceResult6) SetAccepted(value string) {
 	a.Accepted = (*YesNoIndicator)(&value)
 }
 
+func (a *AcceptanceResult6) SetAcceptedFromBool(value bool) {
+	a.Accepted = new(YesNoIndicator)
+	a.Accepted.FromBool(value)
+}
+
 func (a *AcceptanceResult6) AddRejectReason() *MandateReason1Choice {
 	a.RejectReason = new(MandateReason1Choice)
 	return a.RejectReason
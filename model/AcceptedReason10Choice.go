@@ -10,6 +10,10 @@ type AcceptedReason10Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (a *AcceptedReason10Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedReason10Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason7Code)(&value)
 }
@@ -10,6 +10,10 @@ type AcceptedReason11Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AcceptedReason11Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedReason11Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason7Code)(&value)
 }
@@ -10,6 +10,10 @@ type AcceptedReason12Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AcceptedReason12Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedReason12Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason8Code)(&value)
 }
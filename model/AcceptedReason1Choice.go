@@ -10,6 +10,10 @@ type AcceptedReason1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AcceptedReason1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedReason1Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason4Code)(&value)
 }
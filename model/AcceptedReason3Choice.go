@@ -10,6 +10,10 @@ type AcceptedReason3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AcceptedReason3Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedReason3Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason7Code)(&value)
 }
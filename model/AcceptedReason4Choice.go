@@ -10,6 +10,10 @@ type AcceptedReason4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AcceptedReason4Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedReason4Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason8Code)(&value)
 }
@@ -10,6 +10,10 @@ type AcceptedReason7Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (a *AcceptedReason7Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedReason7Choice) SetCode(value string) {
 	a.Code = (*ExternalAcceptedReason1Code)(&value)
 }
@@ -10,8 +10,16 @@ type AcceptedReason8Choice struct {
 	Reason *AcceptedReason7Choice `xml:"Rsn"`
 }
 
-func (a *AcceptedReason8Choice) SetNoSpecifiedReason(value string) {
+func (a *AcceptedReason8Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcceptedReason8Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcceptedReason8Choice) AddReason() *AcceptedReason7Choice {
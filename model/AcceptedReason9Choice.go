@@ -10,6 +10,10 @@ type AcceptedReason9Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (a *AcceptedReason9Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedReason9Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason8Code)(&value)
 }
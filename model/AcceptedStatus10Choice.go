@@ -10,8 +10,16 @@ type AcceptedStatus10Choice struct {
 	Reason []*AcceptedStatusReason11 `xml:"Rsn"`
 }
 
-func (a *AcceptedStatus10Choice) SetNoSpecifiedReason(value string) {
+func (a *AcceptedStatus10Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcceptedStatus10Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcceptedStatus10Choice) AddReason() *AcceptedStatusReason11 {
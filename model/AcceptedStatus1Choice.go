@@ -10,8 +10,16 @@ type AcceptedStatus1Choice struct {
 	Reason []*AcceptedStatusReason1 `xml:"Rsn"`
 }
 
-func (a *AcceptedStatus1Choice) SetNoSpecifiedReason(value string) {
+func (a *AcceptedStatus1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcceptedStatus1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcceptedStatus1Choice) AddReason() *AcceptedStatusReason1 {
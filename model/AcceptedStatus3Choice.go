@@ -10,8 +10,16 @@ type AcceptedStatus3Choice struct {
 	Reason []*AcceptedStatusReason3 `xml:"Rsn"`
 }
 
-func (a *AcceptedStatus3Choice) SetNoSpecifiedReason(value string) {
+func (a *AcceptedStatus3Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcceptedStatus3Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcceptedStatus3Choice) AddReason() *AcceptedStatusReason3 {
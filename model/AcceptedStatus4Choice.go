@@ -10,8 +10,16 @@ type AcceptedStatus4Choice struct {
 	Reason []*AcceptedStatusReason4 `xml:"Rsn"`
 }
 
-func (a *AcceptedStatus4Choice) SetNoSpecifiedReason(value string) {
+func (a *AcceptedStatus4Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcceptedStatus4Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcceptedStatus4Choice) AddReason() *AcceptedStatusReason4 {
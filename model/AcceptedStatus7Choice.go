@@ -10,8 +10,16 @@ type AcceptedStatus7Choice struct {
 	Reason []*AcceptedStatusReason8 `xml:"Rsn"`
 }
 
-func (a *AcceptedStatus7Choice) SetNoSpecifiedReason(value string) {
+func (a *AcceptedStatus7Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcceptedStatus7Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcceptedStatus7Choice) AddReason() *AcceptedStatusReason8 {
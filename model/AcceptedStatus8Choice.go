@@ -10,8 +10,16 @@ type AcceptedStatus8Choice struct {
 	Reason []*AcceptedStatusReason9 `xml:"Rsn"`
 }
 
-func (a *AcceptedStatus8Choice) SetNoSpecifiedReason(value string) {
+func (a *AcceptedStatus8Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcceptedStatus8Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcceptedStatus8Choice) AddReason() *AcceptedStatusReason9 {
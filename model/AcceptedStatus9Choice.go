@@ -10,8 +10,16 @@ type AcceptedStatus9Choice struct {
 	Reason []*AcceptedStatusReason10 `xml:"Rsn"`
 }
 
-func (a *AcceptedStatus9Choice) SetNoSpecifiedReason(value string) {
+func (a *AcceptedStatus9Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcceptedStatus9Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcceptedStatus9Choice) AddReason() *AcceptedStatusReason10 {
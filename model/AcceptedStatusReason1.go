@@ -10,6 +10,10 @@ type AcceptedStatusReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcceptedStatusReason1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedStatusReason1) AddReasonCode() *AcceptedReason1Choice {
 	a.ReasonCode = new(AcceptedReason1Choice)
 	return a.ReasonCode
@@ -10,6 +10,10 @@ type AcceptedStatusReason10 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcceptedStatusReason10) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedStatusReason10) AddReasonCode() *AcceptedReason11Choice {
 	a.ReasonCode = new(AcceptedReason11Choice)
 	return a.ReasonCode
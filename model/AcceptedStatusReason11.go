@@ -10,6 +10,10 @@ type AcceptedStatusReason11 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcceptedStatusReason11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedStatusReason11) AddReasonCode() *AcceptedReason12Choice {
 	a.ReasonCode = new(AcceptedReason12Choice)
 	return a.ReasonCode
@@ -10,6 +10,10 @@ type AcceptedStatusReason1Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (a *AcceptedStatusReason1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedStatusReason1Choice) SetCode(value string) {
 	a.Code = (*AcceptedStatusReason1Code)(&value)
 }
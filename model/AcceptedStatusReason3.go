@@ -10,6 +10,10 @@ type AcceptedStatusReason3 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcceptedStatusReason3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedStatusReason3) AddReasonCode() *AcceptedReason3Choice {
 	a.ReasonCode = new(AcceptedReason3Choice)
 	return a.ReasonCode
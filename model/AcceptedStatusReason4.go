@@ -10,6 +10,10 @@ type AcceptedStatusReason4 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcceptedStatusReason4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedStatusReason4) AddReasonCode() *AcceptedReason4Choice {
 	a.ReasonCode = new(AcceptedReason4Choice)
 	return a.ReasonCode
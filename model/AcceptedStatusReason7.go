@@ -10,6 +10,10 @@ type AcceptedStatusReason7 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcceptedStatusReason7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedStatusReason7) AddReason() *AcceptedReason8Choice {
 	a.Reason = new(AcceptedReason8Choice)
 	return a.Reason
@@ -10,6 +10,10 @@ type AcceptedStatusReason8 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcceptedStatusReason8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedStatusReason8) AddReasonCode() *AcceptedReason9Choice {
 	a.ReasonCode = new(AcceptedReason9Choice)
 	return a.ReasonCode
@@ -10,6 +10,10 @@ type AcceptedStatusReason9 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcceptedStatusReason9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptedStatusReason9) AddReasonCode() *AcceptedReason10Choice {
 	a.ReasonCode = new(AcceptedReason10Choice)
 	return a.ReasonCode
@@ -13,6 +13,10 @@ type AcceptorAuthorisationRequest1 struct {
 	Transaction *CardPaymentTransaction1 `xml:"Tx"`
 }
 
+func (a *AcceptorAuthorisationRequest1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationRequest1) AddEnvironment() *CardPaymentEnvironment1 {
 	a.Environment = new(CardPaymentEnvironment1)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorAuthorisationRequest2 struct {
 	Transaction *CardPaymentTransaction11 `xml:"Tx"`
 }
 
+func (a *AcceptorAuthorisationRequest2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationRequest2) AddEnvironment() *CardPaymentEnvironment9 {
 	a.Environment = new(CardPaymentEnvironment9)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorAuthorisationRequest3 struct {
 	Transaction *CardPaymentTransaction22 `xml:"Tx"`
 }
 
+func (a *AcceptorAuthorisationRequest3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationRequest3) AddEnvironment() *CardPaymentEnvironment20 {
 	a.Environment = new(CardPaymentEnvironment20)
 	return a.Environment
@@ -16,6 +16,10 @@ type AcceptorAuthorisationRequest4 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AcceptorAuthorisationRequest4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationRequest4) AddEnvironment() *CardPaymentEnvironment32 {
 	a.Environment = new(CardPaymentEnvironment32)
 	return a.Environment
@@ -16,6 +16,10 @@ type AcceptorAuthorisationRequest5 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AcceptorAuthorisationRequest5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationRequest5) AddEnvironment() *CardPaymentEnvironment45 {
 	a.Environment = new(CardPaymentEnvironment45)
 	return a.Environment
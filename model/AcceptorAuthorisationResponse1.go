@@ -14,6 +14,10 @@ type AcceptorAuthorisationResponse1 struct {
 	TransactionResponse *CardPaymentTransaction9 `xml:"TxRspn"`
 }
 
+func (a *AcceptorAuthorisationResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationResponse1) AddEnvironment() *CardPaymentEnvironment3 {
 	a.Environment = new(CardPaymentEnvironment3)
 	return a.Environment
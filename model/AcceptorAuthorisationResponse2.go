@@ -14,6 +14,10 @@ type AcceptorAuthorisationResponse2 struct {
 	TransactionResponse *CardPaymentTransaction18 `xml:"TxRspn"`
 }
 
+func (a *AcceptorAuthorisationResponse2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationResponse2) AddEnvironment() *CardPaymentEnvironment11 {
 	a.Environment = new(CardPaymentEnvironment11)
 	return a.Environment
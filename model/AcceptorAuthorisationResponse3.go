@@ -14,6 +14,10 @@ type AcceptorAuthorisationResponse3 struct {
 	TransactionResponse *CardPaymentTransaction24 `xml:"TxRspn"`
 }
 
+func (a *AcceptorAuthorisationResponse3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationResponse3) AddEnvironment() *CardPaymentEnvironment21 {
 	a.Environment = new(CardPaymentEnvironment21)
 	return a.Environment
@@ -17,6 +17,10 @@ type AcceptorAuthorisationResponse4 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AcceptorAuthorisationResponse4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationResponse4) AddEnvironment() *CardPaymentEnvironment33 {
 	a.Environment = new(CardPaymentEnvironment33)
 	return a.Environment
@@ -17,6 +17,10 @@ type AcceptorAuthorisationResponse5 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AcceptorAuthorisationResponse5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorAuthorisationResponse5) AddEnvironment() *CardPaymentEnvironment46 {
 	a.Environment = new(CardPaymentEnvironment46)
 	return a.Environment
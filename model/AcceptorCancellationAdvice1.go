@@ -13,6 +13,10 @@ type AcceptorCancellationAdvice1 struct {
 	Transaction *CardPaymentTransaction7 `xml:"Tx"`
 }
 
+func (a *AcceptorCancellationAdvice1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdvice1) AddEnvironment() *CardPaymentEnvironment2 {
 	a.Environment = new(CardPaymentEnvironment2)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCancellationAdvice2 struct {
 	Transaction *CardPaymentTransaction16 `xml:"Tx"`
 }
 
+func (a *AcceptorCancellationAdvice2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdvice2) AddEnvironment() *CardPaymentEnvironment18 {
 	a.Environment = new(CardPaymentEnvironment18)
 	return a.Environment
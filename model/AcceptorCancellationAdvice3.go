@@ -13,6 +13,10 @@ type AcceptorCancellationAdvice3 struct {
 	Transaction *CardPaymentTransaction28 `xml:"Tx"`
 }
 
+func (a *AcceptorCancellationAdvice3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdvice3) AddEnvironment() *CardPaymentEnvironment24 {
 	a.Environment = new(CardPaymentEnvironment24)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCancellationAdvice4 struct {
 	Transaction *CardPaymentTransaction44 `xml:"Tx"`
 }
 
+func (a *AcceptorCancellationAdvice4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdvice4) AddEnvironment() *CardPaymentEnvironment36 {
 	a.Environment = new(CardPaymentEnvironment36)
 	return a.Environment
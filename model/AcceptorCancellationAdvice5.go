@@ -13,6 +13,10 @@ type AcceptorCancellationAdvice5 struct {
 	Transaction *CardPaymentTransaction59 `xml:"Tx"`
 }
 
+func (a *AcceptorCancellationAdvice5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdvice5) AddEnvironment() *CardPaymentEnvironment49 {
 	a.Environment = new(CardPaymentEnvironment49)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCancellationAdviceResponse1 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AcceptorCancellationAdviceResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdviceResponse1) AddEnvironment() *CardPaymentEnvironment3 {
 	a.Environment = new(CardPaymentEnvironment3)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCancellationAdviceResponse2 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AcceptorCancellationAdviceResponse2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdviceResponse2) AddEnvironment() *CardPaymentEnvironment11 {
 	a.Environment = new(CardPaymentEnvironment11)
 	return a.Environment
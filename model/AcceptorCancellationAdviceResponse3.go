@@ -13,6 +13,10 @@ type AcceptorCancellationAdviceResponse3 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AcceptorCancellationAdviceResponse3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdviceResponse3) AddEnvironment() *CardPaymentEnvironment21 {
 	a.Environment = new(CardPaymentEnvironment21)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCancellationAdviceResponse4 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AcceptorCancellationAdviceResponse4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdviceResponse4) AddEnvironment() *CardPaymentEnvironment33 {
 	a.Environment = new(CardPaymentEnvironment33)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCancellationAdviceResponse5 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AcceptorCancellationAdviceResponse5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationAdviceResponse5) AddEnvironment() *CardPaymentEnvironment46 {
 	a.Environment = new(CardPaymentEnvironment46)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCancellationRequest1 struct {
 	Transaction *CardPaymentTransaction5 `xml:"Tx"`
 }
 
+func (a *AcceptorCancellationRequest1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationRequest1) AddEnvironment() *CardPaymentEnvironment4 {
 	a.Environment = new(CardPaymentEnvironment4)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCancellationRequest2 struct {
 	Transaction *CardPaymentTransaction15 `xml:"Tx"`
 }
 
+func (a *AcceptorCancellationRequest2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationRequest2) AddEnvironment() *CardPaymentEnvironment12 {
 	a.Environment = new(CardPaymentEnvironment12)
 	return a.Environment
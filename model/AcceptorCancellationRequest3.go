@@ -13,6 +13,10 @@ type AcceptorCancellationRequest3 struct {
 	Transaction *CardPaymentTransaction26 `xml:"Tx"`
 }
 
+func (a *AcceptorCancellationRequest3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationRequest3) AddEnvironment() *CardPaymentEnvironment23 {
 	a.Environment = new(CardPaymentEnvironment23)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCancellationRequest4 struct {
 	Transaction *CardPaymentTransaction41 `xml:"Tx"`
 }
 
+func (a *AcceptorCancellationRequest4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationRequest4) AddEnvironment() *CardPaymentEnvironment35 {
 	a.Environment = new(CardPaymentEnvironment35)
 	return a.Environment
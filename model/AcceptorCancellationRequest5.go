@@ -13,6 +13,10 @@ type AcceptorCancellationRequest5 struct {
 	Transaction *CardPaymentTransaction56 `xml:"Tx"`
 }
 
+func (a *AcceptorCancellationRequest5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationRequest5) AddEnvironment() *CardPaymentEnvironment48 {
 	a.Environment = new(CardPaymentEnvironment48)
 	return a.Environment
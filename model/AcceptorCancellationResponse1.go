@@ -13,6 +13,10 @@ type AcceptorCancellationResponse1 struct {
 	TransactionResponse *CardPaymentTransaction10 `xml:"TxRspn"`
 }
 
+func (a *AcceptorCancellationResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationResponse1) AddEnvironment() *CardPaymentEnvironment3 {
 	a.Environment = new(CardPaymentEnvironment3)
 	return a.Environment
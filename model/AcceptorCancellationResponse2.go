@@ -13,6 +13,10 @@ type AcceptorCancellationResponse2 struct {
 	TransactionResponse *CardPaymentTransaction10 `xml:"TxRspn"`
 }
 
+func (a *AcceptorCancellationResponse2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationResponse2) AddEnvironment() *CardPaymentEnvironment11 {
 	a.Environment = new(CardPaymentEnvironment11)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCancellationResponse3 struct {
 	TransactionResponse *CardPaymentTransaction27 `xml:"TxRspn"`
 }
 
+func (a *AcceptorCancellationResponse3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationResponse3) AddEnvironment() *CardPaymentEnvironment21 {
 	a.Environment = new(CardPaymentEnvironment21)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCancellationResponse4 struct {
 	TransactionResponse *CardPaymentTransaction43 `xml:"TxRspn"`
 }
 
+func (a *AcceptorCancellationResponse4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationResponse4) AddEnvironment() *CardPaymentEnvironment33 {
 	a.Environment = new(CardPaymentEnvironment33)
 	return a.Environment
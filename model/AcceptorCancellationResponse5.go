@@ -13,6 +13,10 @@ type AcceptorCancellationResponse5 struct {
 	TransactionResponse *CardPaymentTransaction58 `xml:"TxRspn"`
 }
 
+func (a *AcceptorCancellationResponse5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCancellationResponse5) AddEnvironment() *CardPaymentEnvironment46 {
 	a.Environment = new(CardPaymentEnvironment46)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCompletionAdvice1 struct {
 	Transaction *CardPaymentTransaction3 `xml:"Tx"`
 }
 
+func (a *AcceptorCompletionAdvice1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdvice1) AddEnvironment() *CardPaymentEnvironment2 {
 	a.Environment = new(CardPaymentEnvironment2)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCompletionAdvice2 struct {
 	Transaction *CardPaymentTransaction13 `xml:"Tx"`
 }
 
+func (a *AcceptorCompletionAdvice2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdvice2) AddEnvironment() *CardPaymentEnvironment10 {
 	a.Environment = new(CardPaymentEnvironment10)
 	return a.Environment
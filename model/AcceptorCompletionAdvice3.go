@@ -13,6 +13,10 @@ type AcceptorCompletionAdvice3 struct {
 	Transaction *CardPaymentTransaction25 `xml:"Tx"`
 }
 
+func (a *AcceptorCompletionAdvice3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdvice3) AddEnvironment() *CardPaymentEnvironment22 {
 	a.Environment = new(CardPaymentEnvironment22)
 	return a.Environment
@@ -16,6 +16,10 @@ type AcceptorCompletionAdvice4 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AcceptorCompletionAdvice4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdvice4) AddEnvironment() *CardPaymentEnvironment34 {
 	a.Environment = new(CardPaymentEnvironment34)
 	return a.Environment
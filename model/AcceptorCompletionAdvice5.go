@@ -16,6 +16,10 @@ type AcceptorCompletionAdvice5 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AcceptorCompletionAdvice5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdvice5) AddEnvironment() *CardPaymentEnvironment47 {
 	a.Environment = new(CardPaymentEnvironment47)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCompletionAdviceResponse1 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AcceptorCompletionAdviceResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdviceResponse1) AddEnvironment() *CardPaymentEnvironment3 {
 	a.Environment = new(CardPaymentEnvironment3)
 	return a.Environment
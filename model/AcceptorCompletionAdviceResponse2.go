@@ -13,6 +13,10 @@ type AcceptorCompletionAdviceResponse2 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AcceptorCompletionAdviceResponse2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdviceResponse2) AddEnvironment() *CardPaymentEnvironment11 {
 	a.Environment = new(CardPaymentEnvironment11)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCompletionAdviceResponse3 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AcceptorCompletionAdviceResponse3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdviceResponse3) AddEnvironment() *CardPaymentEnvironment21 {
 	a.Environment = new(CardPaymentEnvironment21)
 	return a.Environment
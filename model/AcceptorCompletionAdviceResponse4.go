@@ -16,6 +16,10 @@ type AcceptorCompletionAdviceResponse4 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AcceptorCompletionAdviceResponse4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdviceResponse4) AddEnvironment() *CardPaymentEnvironment33 {
 	a.Environment = new(CardPaymentEnvironment33)
 	return a.Environment
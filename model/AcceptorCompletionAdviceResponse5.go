@@ -16,6 +16,10 @@ type AcceptorCompletionAdviceResponse5 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AcceptorCompletionAdviceResponse5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCompletionAdviceResponse5) AddEnvironment() *CardPaymentEnvironment46 {
 	a.Environment = new(CardPaymentEnvironment46)
 	return a.Environment
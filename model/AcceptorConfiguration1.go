@@ -13,6 +13,10 @@ type AcceptorConfiguration1 struct {
 	DataSet []*TerminalManagementDataSet3 `xml:"DataSet"`
 }
 
+func (a *AcceptorConfiguration1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorConfiguration1) AddPOIIdentification() *GenericIdentification35 {
 	a.POIIdentification = new(GenericIdentification35)
 	return a.POIIdentification
@@ -13,6 +13,10 @@ type AcceptorConfiguration2 struct {
 	DataSet []*TerminalManagementDataSet6 `xml:"DataSet"`
 }
 
+func (a *AcceptorConfiguration2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorConfiguration2) AddPOIIdentification() *GenericIdentification35 {
 	a.POIIdentification = new(GenericIdentification35)
 	return a.POIIdentification
@@ -13,6 +13,10 @@ type AcceptorConfiguration3 struct {
 	DataSet []*TerminalManagementDataSet11 `xml:"DataSet"`
 }
 
+func (a *AcceptorConfiguration3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorConfiguration3) AddPOIIdentification() *GenericIdentification35 {
 	a.POIIdentification = new(GenericIdentification35)
 	return a.POIIdentification
@@ -13,6 +13,10 @@ type AcceptorConfiguration4 struct {
 	DataSet []*TerminalManagementDataSet14 `xml:"DataSet"`
 }
 
+func (a *AcceptorConfiguration4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorConfiguration4) AddPOIIdentification() *GenericIdentification71 {
 	a.POIIdentification = new(GenericIdentification71)
 	return a.POIIdentification
@@ -10,6 +10,10 @@ type AcceptorConfiguration5 struct {
 	DataSet []*TerminalManagementDataSet19 `xml:"DataSet"`
 }
 
+func (a *AcceptorConfiguration5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorConfiguration5) AddTerminalManagerIdentification() *GenericIdentification71 {
 	a.TerminalManagerIdentification = new(GenericIdentification71)
 	return a.TerminalManagerIdentification
@@ -16,6 +16,10 @@ type AcceptorConfigurationContent1 struct {
 	HostCommunicationParameters []*HostCommunicationParameter1 `xml:"HstComParams,omitempty"`
 }
 
+func (a *AcceptorConfigurationContent1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorConfigurationContent1) AddAcquirerProtocolParameters() *AcquirerProtocolParameters1 {
 	newValue := new(AcquirerProtocolParameters1)
 	a.AcquirerProtocolParameters = append(a.AcquirerProtocolParameters, newValue)
@@ -22,6 +22,10 @@ type AcceptorConfigurationContent2 struct {
 	SecurityParameters []*SecurityParameters1 `xml:"SctyParams,omitempty"`
 }
 
+func (a *AcceptorConfigurationContent2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorConfigurationContent2) AddAcquirerProtocolParameters() *AcquirerProtocolParameters3 {
 	newValue := new(AcquirerProtocolParameters3)
 	a.AcquirerProtocolParameters = append(a.AcquirerProtocolParameters, newValue)
@@ -22,6 +22,10 @@ type AcceptorConfigurationContent3 struct {
 	SecurityParameters []*SecurityParameters2 `xml:"SctyParams,omitempty"`
 }
 
+func (a *AcceptorConfigurationContent3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorConfigurationContent3) AddAcquirerProtocolParameters() *AcquirerProtocolParameters6 {
 	newValue := new(AcquirerProtocolParameters6)
 	a.AcquirerProtocolParameters = append(a.AcquirerProtocolParameters, newValue)
@@ -25,6 +25,10 @@ type AcceptorConfigurationContent4 struct {
 	SecurityParameters []*SecurityParameters3 `xml:"SctyParams,omitempty"`
 }
 
+func (a *AcceptorConfigurationContent4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorConfigurationContent4) AddTMSProtocolParameters() *TMSProtocolParameters1 {
 	newValue := new(TMSProtocolParameters1)
 	a.TMSProtocolParameters = append(a.TMSProtocolParameters, newValue)
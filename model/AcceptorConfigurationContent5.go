@@ -28,10 +28,19 @@ type AcceptorConfigurationContent5 struct {
 	SecurityParameters []*SecurityParameters6 `xml:"SctyParams,omitempty"`
 }
 
+func (a *AcceptorConfigurationContent5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorConfigurationContent5) SetReplaceConfiguration(value string) {
 	a.ReplaceConfiguration = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcceptorConfigurationContent5) SetReplaceConfigurationFromBool(value bool) {
+	a.ReplaceConfiguration = new(TrueFalseIndicator)
+	a.ReplaceConfiguration.FromBool(value)
+}
+
 func (a *AcceptorConfigurationContent5) AddTMSProtocolParameters() *TMSProtocolParameters2 {
 	newValue := new(TMSProtocolParameters2)
 	a.TMSProtocolParameters = append(a.TMSProtocolParameters, newValue)
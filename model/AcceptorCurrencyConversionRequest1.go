@@ -10,6 +10,10 @@ type AcceptorCurrencyConversionRequest1 struct {
 	Transaction *CardPaymentTransaction34 `xml:"Tx"`
 }
 
+func (a *AcceptorCurrencyConversionRequest1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCurrencyConversionRequest1) AddEnvironment() *CardPaymentEnvironment30 {
 	a.Environment = new(CardPaymentEnvironment30)
 	return a.Environment
@@ -10,6 +10,10 @@ type AcceptorCurrencyConversionRequest2 struct {
 	Transaction *CardPaymentTransaction49 `xml:"Tx"`
 }
 
+func (a *AcceptorCurrencyConversionRequest2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCurrencyConversionRequest2) AddEnvironment() *CardPaymentEnvironment44 {
 	a.Environment = new(CardPaymentEnvironment44)
 	return a.Environment
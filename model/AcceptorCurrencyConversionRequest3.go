@@ -10,6 +10,10 @@ type AcceptorCurrencyConversionRequest3 struct {
 	Transaction *CardPaymentTransaction64 `xml:"Tx"`
 }
 
+func (a *AcceptorCurrencyConversionRequest3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCurrencyConversionRequest3) AddEnvironment() *CardPaymentEnvironment56 {
 	a.Environment = new(CardPaymentEnvironment56)
 	return a.Environment
@@ -16,6 +16,10 @@ type AcceptorCurrencyConversionResponse1 struct {
 	CurrencyConversion *CurrencyConversion1 `xml:"CcyConvs"`
 }
 
+func (a *AcceptorCurrencyConversionResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCurrencyConversionResponse1) AddEnvironment() *CardPaymentEnvironment21 {
 	a.Environment = new(CardPaymentEnvironment21)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorCurrencyConversionResponse2 struct {
 	CurrencyConversion *CurrencyConversion3 `xml:"CcyConvs"`
 }
 
+func (a *AcceptorCurrencyConversionResponse2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCurrencyConversionResponse2) AddEnvironment() *CardPaymentEnvironment33 {
 	a.Environment = new(CardPaymentEnvironment33)
 	return a.Environment
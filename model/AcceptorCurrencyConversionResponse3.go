@@ -13,6 +13,10 @@ type AcceptorCurrencyConversionResponse3 struct {
 	CurrencyConversionResult *CurrencyConversion7 `xml:"CcyConvsRslt"`
 }
 
+func (a *AcceptorCurrencyConversionResponse3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorCurrencyConversionResponse3) AddEnvironment() *CardPaymentEnvironment46 {
 	a.Environment = new(CardPaymentEnvironment46)
 	return a.Environment
@@ -7,6 +7,10 @@ type AcceptorDiagnosticRequest1 struct {
 	Environment *CardPaymentEnvironment8 `xml:"Envt"`
 }
 
+func (a *AcceptorDiagnosticRequest1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticRequest1) AddEnvironment() *CardPaymentEnvironment8 {
 	a.Environment = new(CardPaymentEnvironment8)
 	return a.Environment
@@ -7,6 +7,10 @@ type AcceptorDiagnosticRequest2 struct {
 	Environment *CardPaymentEnvironment17 `xml:"Envt"`
 }
 
+func (a *AcceptorDiagnosticRequest2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticRequest2) AddEnvironment() *CardPaymentEnvironment17 {
 	a.Environment = new(CardPaymentEnvironment17)
 	return a.Environment
@@ -7,6 +7,10 @@ type AcceptorDiagnosticRequest3 struct {
 	Environment *CardPaymentEnvironment29 `xml:"Envt"`
 }
 
+func (a *AcceptorDiagnosticRequest3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticRequest3) AddEnvironment() *CardPaymentEnvironment29 {
 	a.Environment = new(CardPaymentEnvironment29)
 	return a.Environment
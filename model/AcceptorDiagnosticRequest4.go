@@ -7,6 +7,10 @@ type AcceptorDiagnosticRequest4 struct {
 	Environment *CardPaymentEnvironment42 `xml:"Envt"`
 }
 
+func (a *AcceptorDiagnosticRequest4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticRequest4) AddEnvironment() *CardPaymentEnvironment42 {
 	a.Environment = new(CardPaymentEnvironment42)
 	return a.Environment
@@ -7,6 +7,10 @@ type AcceptorDiagnosticRequest5 struct {
 	Environment *CardPaymentEnvironment55 `xml:"Envt"`
 }
 
+func (a *AcceptorDiagnosticRequest5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticRequest5) AddEnvironment() *CardPaymentEnvironment55 {
 	a.Environment = new(CardPaymentEnvironment55)
 	return a.Environment
@@ -10,6 +10,10 @@ type AcceptorDiagnosticResponse1 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AcceptorDiagnosticResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticResponse1) AddEnvironment() *CardPaymentEnvironment8 {
 	a.Environment = new(CardPaymentEnvironment8)
 	return a.Environment
@@ -10,6 +10,10 @@ type AcceptorDiagnosticResponse2 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AcceptorDiagnosticResponse2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticResponse2) AddEnvironment() *CardPaymentEnvironment17 {
 	a.Environment = new(CardPaymentEnvironment17)
 	return a.Environment
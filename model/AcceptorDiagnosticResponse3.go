@@ -10,6 +10,10 @@ type AcceptorDiagnosticResponse3 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AcceptorDiagnosticResponse3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticResponse3) AddEnvironment() *CardPaymentEnvironment31 {
 	a.Environment = new(CardPaymentEnvironment31)
 	return a.Environment
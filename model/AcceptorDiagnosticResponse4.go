@@ -10,6 +10,10 @@ type AcceptorDiagnosticResponse4 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AcceptorDiagnosticResponse4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorDiagnosticResponse4) AddEnvironment() *CardPaymentEnvironment43 {
 	a.Environment = new(CardPaymentEnvironment43)
 	return a.Environment
@@ -10,6 +10,10 @@ type AcceptorReconciliationRequest1 struct {
 	Transaction *TransactionReconciliation1 `xml:"Tx"`
 }
 
+func (a *AcceptorReconciliationRequest1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationRequest1) AddEnvironment() *CardPaymentEnvironment7 {
 	a.Environment = new(CardPaymentEnvironment7)
 	return a.Environment
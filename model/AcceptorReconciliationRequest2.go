@@ -10,6 +10,10 @@ type AcceptorReconciliationRequest2 struct {
 	Transaction *TransactionReconciliation2 `xml:"Tx"`
 }
 
+func (a *AcceptorReconciliationRequest2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationRequest2) AddEnvironment() *CardPaymentEnvironment15 {
 	a.Environment = new(CardPaymentEnvironment15)
 	return a.Environment
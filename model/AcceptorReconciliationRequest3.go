@@ -10,6 +10,10 @@ type AcceptorReconciliationRequest3 struct {
 	Transaction *TransactionReconciliation2 `xml:"Tx"`
 }
 
+func (a *AcceptorReconciliationRequest3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationRequest3) AddEnvironment() *CardPaymentEnvironment25 {
 	a.Environment = new(CardPaymentEnvironment25)
 	return a.Environment
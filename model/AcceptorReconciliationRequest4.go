@@ -10,6 +10,10 @@ type AcceptorReconciliationRequest4 struct {
 	Transaction *TransactionReconciliation3 `xml:"Tx"`
 }
 
+func (a *AcceptorReconciliationRequest4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationRequest4) AddEnvironment() *CardPaymentEnvironment37 {
 	a.Environment = new(CardPaymentEnvironment37)
 	return a.Environment
@@ -10,6 +10,10 @@ type AcceptorReconciliationRequest5 struct {
 	Transaction *TransactionReconciliation4 `xml:"Tx"`
 }
 
+func (a *AcceptorReconciliationRequest5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationRequest5) AddEnvironment() *CardPaymentEnvironment50 {
 	a.Environment = new(CardPaymentEnvironment50)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorReconciliationResponse1 struct {
 	Transaction *TransactionReconciliation1 `xml:"Tx"`
 }
 
+func (a *AcceptorReconciliationResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationResponse1) AddEnvironment() *CardPaymentEnvironment7 {
 	a.Environment = new(CardPaymentEnvironment7)
 	return a.Environment
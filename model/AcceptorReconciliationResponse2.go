@@ -13,6 +13,10 @@ type AcceptorReconciliationResponse2 struct {
 	Transaction *TransactionReconciliation2 `xml:"Tx"`
 }
 
+func (a *AcceptorReconciliationResponse2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationResponse2) AddEnvironment() *CardPaymentEnvironment19 {
 	a.Environment = new(CardPaymentEnvironment19)
 	return a.Environment
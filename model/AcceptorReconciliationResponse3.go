@@ -13,6 +13,10 @@ type AcceptorReconciliationResponse3 struct {
 	Transaction *TransactionReconciliation3 `xml:"Tx"`
 }
 
+func (a *AcceptorReconciliationResponse3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationResponse3) AddEnvironment() *CardPaymentEnvironment38 {
 	a.Environment = new(CardPaymentEnvironment38)
 	return a.Environment
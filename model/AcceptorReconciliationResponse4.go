@@ -13,6 +13,10 @@ type AcceptorReconciliationResponse4 struct {
 	Transaction *TransactionReconciliation4 `xml:"Tx"`
 }
 
+func (a *AcceptorReconciliationResponse4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorReconciliationResponse4) AddEnvironment() *CardPaymentEnvironment38 {
 	a.Environment = new(CardPaymentEnvironment38)
 	return a.Environment
@@ -13,6 +13,10 @@ type AcceptorRejection1 struct {
 	MessageInError *Max5000Binary `xml:"MsgInErr,omitempty"`
 }
 
+func (a *AcceptorRejection1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorRejection1) SetRejectReason(value string) {
 	a.RejectReason = (*RejectReason1Code)(&value)
 }
@@ -13,6 +13,10 @@ type AcceptorRejection2 struct {
 	MessageInError *Max100KBinary `xml:"MsgInErr,omitempty"`
 }
 
+func (a *AcceptorRejection2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorRejection2) SetRejectReason(value string) {
 	a.RejectReason = (*RejectReason1Code)(&value)
 }
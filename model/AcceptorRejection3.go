@@ -13,6 +13,10 @@ type AcceptorRejection3 struct {
 	MessageInError *Max100KBinary `xml:"MsgInErr,omitempty"`
 }
 
+func (a *AcceptorRejection3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorRejection3) SetRejectReason(value string) {
 	a.RejectReason = (*RejectReason2Code)(&value)
 }
@@ -13,6 +13,10 @@ type AcceptorRejection4 struct {
 	MessageInError *Max100KBinary `xml:"MsgInErr,omitempty"`
 }
 
+func (a *AcceptorRejection4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcceptorRejection4) SetRejectReason(value string) {
 	a.RejectReason = (*RejectReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Account1 struct {
 	AccountServicer *PartyIdentification1Choice `xml:"AcctSvcr"`
 }
 
+func (a *Account1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account1) AddIdentification() *AccountIdentification1 {
 	a.Identification = new(AccountIdentification1)
 	return a.Identification
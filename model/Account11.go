@@ -10,6 +10,10 @@ type Account11 struct {
 	AccountServicer *PartyIdentification49Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (a *Account11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account11) AddIdentification() *AccountIdentification1 {
 	a.Identification = new(AccountIdentification1)
 	return a.Identification
@@ -10,6 +10,10 @@ type Account13 struct {
 	AccountServicer *PartyIdentification49Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (a *Account13) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account13) AddIdentification() *AccountIdentification26 {
 	a.Identification = new(AccountIdentification26)
 	return a.Identification
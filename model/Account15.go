@@ -13,6 +13,10 @@ type Account15 struct {
 	Servicer *PartyIdentification2Choice `xml:"Svcr"`
 }
 
+func (a *Account15) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account15) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
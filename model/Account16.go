@@ -13,6 +13,10 @@ type Account16 struct {
 	Servicer *PartyIdentification2Choice `xml:"Svcr,omitempty"`
 }
 
+func (a *Account16) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account16) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type Account18 struct {
 	AccountServicer *PartyIdentification71Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (a *Account18) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account18) AddIdentification() *AccountIdentification26 {
 	a.Identification = new(AccountIdentification26)
 	return a.Identification
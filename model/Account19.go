@@ -13,6 +13,10 @@ type Account19 struct {
 	Servicer *PartyIdentification70Choice `xml:"Svcr,omitempty"`
 }
 
+func (a *Account19) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account19) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
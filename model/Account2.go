@@ -10,6 +10,10 @@ type Account2 struct {
 	AccountServicer *PartyIdentification2Choice `xml:"AcctSvcr"`
 }
 
+func (a *Account2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account2) AddIdentification() *AccountIdentification1 {
 	a.Identification = new(AccountIdentification1)
 	return a.Identification
@@ -10,6 +10,10 @@ type Account20 struct {
 	AccountServicer *PartyIdentification70Choice `xml:"AcctSvcr"`
 }
 
+func (a *Account20) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account20) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
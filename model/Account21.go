@@ -10,6 +10,10 @@ type Account21 struct {
 	AccountServicer *PartyIdentification104Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (a *Account21) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account21) AddIdentification() *AccountIdentification4 {
 	a.Identification = new(AccountIdentification4)
 	return a.Identification
@@ -10,6 +10,10 @@ type Account22 struct {
 	AccountServicer *PartyIdentification113 `xml:"AcctSvcr,omitempty"`
 }
 
+func (a *Account22) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account22) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
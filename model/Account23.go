@@ -10,6 +10,10 @@ type Account23 struct {
 	RelatedAccountDetails *GenericIdentification1 `xml:"RltdAcctDtls,omitempty"`
 }
 
+func (a *Account23) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account23) SetAccountIdentification(value string) {
 	a.AccountIdentification = (*Max35Text)(&value)
 }
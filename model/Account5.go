@@ -13,6 +13,10 @@ type Account5 struct {
 	Servicer *PartyIdentification2Choice `xml:"Svcr"`
 }
 
+func (a *Account5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account5) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type Account6 struct {
 	Servicer *PartyIdentification2Choice `xml:"Svcr,omitempty"`
 }
 
+func (a *Account6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account6) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type Account7 struct {
 	AccountServicer *PartyIdentification2Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (a *Account7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account7) AddIdentification() *AccountIdentification1 {
 	a.Identification = new(AccountIdentification1)
 	return a.Identification
@@ -13,6 +13,10 @@ type Account8Choice struct {
 	TaxAccount *CashAccountIdentification5Choice `xml:"TaxAcct"`
 }
 
+func (a *Account8Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account8Choice) AddCashAccount() *CashAccountIdentification5Choice {
 	a.CashAccount = new(CashAccountIdentification5Choice)
 	return a.CashAccount
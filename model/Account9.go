@@ -10,6 +10,10 @@ type Account9 struct {
 	AccountServicer *PartyIdentification10Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (a *Account9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account9) AddIdentification() *AccountIdentification1 {
 	a.Identification = new(AccountIdentification1)
 	return a.Identification
@@ -13,6 +13,10 @@ type Account9Choice struct {
 	TaxAccount *CashAccountIdentification6Choice `xml:"TaxAcct"`
 }
 
+func (a *Account9Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Account9Choice) AddCashAccount() *CashAccountIdentification6Choice {
 	a.CashAccount = new(CashAccountIdentification6Choice)
 	return a.CashAccount
@@ -16,6 +16,10 @@ type AccountAndBalance1 struct {
 	Balance *CorporateActionBalanceDetails1 `xml:"Bal,omitempty"`
 }
 
+func (a *AccountAndBalance1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance1) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
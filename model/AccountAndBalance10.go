@@ -16,6 +16,10 @@ type AccountAndBalance10 struct {
 	Balance *CorporateActionBalanceDetails3 `xml:"Bal,omitempty"`
 }
 
+func (a *AccountAndBalance10) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance10) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type AccountAndBalance11 struct {
 	Balance *CorporateActionBalanceDetails2 `xml:"Bal"`
 }
 
+func (a *AccountAndBalance11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance11) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type AccountAndBalance15 struct {
 	Balance *CorporateActionBalanceDetails10 `xml:"Bal,omitempty"`
 }
 
+func (a *AccountAndBalance15) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance15) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type AccountAndBalance16 struct {
 	Balance *CorporateActionBalanceDetails11 `xml:"Bal"`
 }
 
+func (a *AccountAndBalance16) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance16) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
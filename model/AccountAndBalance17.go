@@ -16,6 +16,10 @@ type AccountAndBalance17 struct {
 	Balance *CorporateActionBalanceDetails12 `xml:"Bal,omitempty"`
 }
 
+func (a *AccountAndBalance17) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance17) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
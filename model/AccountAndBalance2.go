@@ -16,6 +16,10 @@ type AccountAndBalance2 struct {
 	Balance *CorporateActionBalanceDetails3 `xml:"Bal,omitempty"`
 }
 
+func (a *AccountAndBalance2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance2) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type AccountAndBalance21 struct {
 	Balance *CorporateActionBalanceDetails17 `xml:"Bal,omitempty"`
 }
 
+func (a *AccountAndBalance21) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance21) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
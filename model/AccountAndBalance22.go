@@ -16,6 +16,10 @@ type AccountAndBalance22 struct {
 	Balance *CorporateActionBalanceDetails18 `xml:"Bal"`
 }
 
+func (a *AccountAndBalance22) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance22) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type AccountAndBalance25 struct {
 	Balance *CorporateActionBalanceDetails21 `xml:"Bal,omitempty"`
 }
 
+func (a *AccountAndBalance25) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance25) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type AccountAndBalance26 struct {
 	Balance *CorporateActionBalanceDetails22 `xml:"Bal"`
 }
 
+func (a *AccountAndBalance26) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance26) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
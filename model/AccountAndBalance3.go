@@ -16,6 +16,10 @@ type AccountAndBalance3 struct {
 	Balance *CorporateActionBalanceDetails2 `xml:"Bal"`
 }
 
+func (a *AccountAndBalance3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance3) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type AccountAndBalance33 struct {
 	Balance *CorporateActionBalanceDetails29 `xml:"Bal,omitempty"`
 }
 
+func (a *AccountAndBalance33) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance33) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type AccountAndBalance34 struct {
 	Balance *CorporateActionBalanceDetails31 `xml:"Bal"`
 }
 
+func (a *AccountAndBalance34) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance34) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type AccountAndBalance35 struct {
 	Balance *CorporateActionBalanceDetails32 `xml:"Bal,omitempty"`
 }
 
+func (a *AccountAndBalance35) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance35) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type AccountAndBalance36 struct {
 	ConfirmedBalance *BalanceFormat5Choice `xml:"ConfdBal"`
 }
 
+func (a *AccountAndBalance36) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance36) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
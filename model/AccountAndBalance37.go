@@ -16,6 +16,10 @@ type AccountAndBalance37 struct {
 	Balance *CorporateActionBalanceDetails34 `xml:"Bal,omitempty"`
 }
 
+func (a *AccountAndBalance37) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance37) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*RestrictedFINXMax35Text)(&value)
 }
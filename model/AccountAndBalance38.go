@@ -16,6 +16,10 @@ type AccountAndBalance38 struct {
 	Balance *CorporateActionBalanceDetails35 `xml:"Bal"`
 }
 
+func (a *AccountAndBalance38) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance38) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*RestrictedFINXMax35Text)(&value)
 }
@@ -16,6 +16,10 @@ type AccountAndBalance39 struct {
 	Balance *CorporateActionBalanceDetails36 `xml:"Bal,omitempty"`
 }
 
+func (a *AccountAndBalance39) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance39) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*RestrictedFINXMax35Text)(&value)
 }
@@ -10,6 +10,10 @@ type AccountAndBalance4 struct {
 	ConfirmedBalance *BalanceFormat1Choice `xml:"ConfdBal"`
 }
 
+func (a *AccountAndBalance4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance4) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type AccountAndBalance40 struct {
 	ConfirmedBalance *BalanceFormat7Choice `xml:"ConfdBal"`
 }
 
+func (a *AccountAndBalance40) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance40) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*RestrictedFINXMax35Text)(&value)
 }
@@ -16,6 +16,10 @@ type AccountAndBalance9 struct {
 	Balance *CorporateActionBalanceDetails1 `xml:"Bal,omitempty"`
 }
 
+func (a *AccountAndBalance9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndBalance9) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type AccountAndParties1 struct {
 	AuthorityRequestType []*AuthorityRequestType1 `xml:"AuthrtyReqTp"`
 }
 
+func (a *AccountAndParties1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndParties1) AddIdentification() *CashAccount25 {
 	a.Identification = new(CashAccount25)
 	return a.Identification
@@ -13,6 +13,10 @@ type AccountAndParties2 struct {
 	AdditionalInformation []*Max256Text `xml:"AddtlInf,omitempty"`
 }
 
+func (a *AccountAndParties2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountAndParties2) AddAccount() *CustomerAccount1 {
 	a.Account = new(CustomerAccount1)
 	return a.Account
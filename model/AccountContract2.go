@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies target dates dates related to account opening and closing.
 type AccountContract2 struct {
 
@@ -13,14 +17,33 @@ type AccountContract2 struct {
 	UrgencyFlag *YesNoIndicator `xml:"UrgcyFlg,omitempty"`
 }
 
+func (a *AccountContract2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountContract2) SetTargetGoLiveDate(value string) {
 	a.TargetGoLiveDate = (*ISODate)(&value)
 }
 
+func (a *AccountContract2) SetTargetGoLiveDateFromTime(value time.Time) {
+	a.TargetGoLiveDate = new(ISODate)
+	a.TargetGoLiveDate.FromTime(value)
+}
+
 func (a *AccountContract2) SetTargetClosingDate(value string) {
 	a.TargetClosingDate = (*ISODate)(&value)
 }
 
+func (a *AccountContract2) SetTargetClosingDateFromTime(value time.Time) {
+	a.TargetClosingDate = new(ISODate)
+	a.TargetClosingDate.FromTime(value)
+}
+
 func (a *AccountContract2) SetUrgencyFlag(value string) {
 	a.UrgencyFlag = (*YesNoIndicator)(&value)
 }
+
+func (a *AccountContract2) SetUrgencyFlagFromBool(value bool) {
+	a.UrgencyFlag = new(YesNoIndicator)
+	a.UrgencyFlag.FromBool(value)
+}
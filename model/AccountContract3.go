@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies target and actual dates dates related to account opening and closing.
 type AccountContract3 struct {
 
@@ -22,26 +26,60 @@ type AccountContract3 struct {
 	RemovalIndicator *YesNoIndicator `xml:"RmvlInd,omitempty"`
 }
 
+func (a *AccountContract3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountContract3) SetTargetGoLiveDate(value string) {
 	a.TargetGoLiveDate = (*ISODate)(&value)
 }
 
+func (a *AccountContract3) SetTargetGoLiveDateFromTime(value time.Time) {
+	a.TargetGoLiveDate = new(ISODate)
+	a.TargetGoLiveDate.FromTime(value)
+}
+
 func (a *AccountContract3) SetTargetClosingDate(value string) {
 	a.TargetClosingDate = (*ISODate)(&value)
 }
 
+func (a *AccountContract3) SetTargetClosingDateFromTime(value time.Time) {
+	a.TargetClosingDate = new(ISODate)
+	a.TargetClosingDate.FromTime(value)
+}
+
 func (a *AccountContract3) SetGoLiveDate(value string) {
 	a.GoLiveDate = (*ISODate)(&value)
 }
 
+func (a *AccountContract3) SetGoLiveDateFromTime(value time.Time) {
+	a.GoLiveDate = new(ISODate)
+	a.GoLiveDate.FromTime(value)
+}
+
 func (a *AccountContract3) SetClosingDate(value string) {
 	a.ClosingDate = (*ISODate)(&value)
 }
 
+func (a *AccountContract3) SetClosingDateFromTime(value time.Time) {
+	a.ClosingDate = new(ISODate)
+	a.ClosingDate.FromTime(value)
+}
+
 func (a *AccountContract3) SetUrgencyFlag(value string) {
 	a.UrgencyFlag = (*YesNoIndicator)(&value)
 }
 
+func (a *AccountContract3) SetUrgencyFlagFromBool(value bool) {
+	a.UrgencyFlag = new(YesNoIndicator)
+	a.UrgencyFlag.FromBool(value)
+}
+
 func (a *AccountContract3) SetRemovalIndicator(value string) {
 	a.RemovalIndicator = (*YesNoIndicator)(&value)
 }
+
+func (a *AccountContract3) SetRemovalIndicatorFromBool(value bool) {
+	a.RemovalIndicator = new(YesNoIndicator)
+	a.RemovalIndicator.FromBool(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies target dates dates related to account opening and closing.
 type AccountContract4 struct {
 
@@ -13,14 +17,33 @@ type AccountContract4 struct {
 	RemovalIndicator *YesNoIndicator `xml:"RmvlInd,omitempty"`
 }
 
+func (a *AccountContract4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountContract4) SetTargetClosingDate(value string) {
 	a.TargetClosingDate = (*ISODate)(&value)
 }
 
+func (a *AccountContract4) SetTargetClosingDateFromTime(value time.Time) {
+	a.TargetClosingDate = new(ISODate)
+	a.TargetClosingDate.FromTime(value)
+}
+
 func (a *AccountContract4) SetUrgencyFlag(value string) {
 	a.UrgencyFlag = (*YesNoIndicator)(&value)
 }
 
+func (a *AccountContract4) SetUrgencyFlagFromBool(value bool) {
+	a.UrgencyFlag = new(YesNoIndicator)
+	a.UrgencyFlag.FromBool(value)
+}
+
 func (a *AccountContract4) SetRemovalIndicator(value string) {
 	a.RemovalIndicator = (*YesNoIndicator)(&value)
 }
+
+func (a *AccountContract4) SetRemovalIndicatorFromBool(value bool) {
+	a.RemovalIndicator = new(YesNoIndicator)
+	a.RemovalIndicator.FromBool(value)
+}
@@ -10,6 +10,10 @@ type AccountDesignation1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AccountDesignation1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountDesignation1Choice) SetCode(value string) {
 	a.Code = (*Rank1Code)(&value)
 }
@@ -10,6 +10,10 @@ type AccountForAction1 struct {
 	Currency *ActiveCurrencyCode `xml:"Ccy"`
 }
 
+func (a *AccountForAction1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountForAction1) AddIdentification() *AccountIdentification4Choice {
 	a.Identification = new(AccountIdentification4Choice)
 	return a.Identification
@@ -15,6 +15,10 @@ type AccountForAction2 struct {
 	Currency *ActiveCurrencyCode `xml:"Ccy"`
 }
 
+func (a *AccountForAction2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountForAction2) AddIdentification() *AccountIdentification4Choice {
 	a.Identification = new(AccountIdentification4Choice)
 	return a.Identification
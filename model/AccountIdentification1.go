@@ -7,6 +7,10 @@ type AccountIdentification1 struct {
 	Proprietary *SimpleIdentificationInformation `xml:"Prtry"`
 }
 
+func (a *AccountIdentification1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification1) AddProprietary() *SimpleIdentificationInformation {
 	a.Proprietary = new(SimpleIdentificationInformation)
 	return a.Proprietary
@@ -7,6 +7,10 @@ type AccountIdentification10 struct {
 	IdentificationCode *SafekeepingAccountIdentification1Code `xml:"IdCd"`
 }
 
+func (a *AccountIdentification10) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification10) SetIdentificationCode(value string) {
 	a.IdentificationCode = (*SafekeepingAccountIdentification1Code)(&value)
 }
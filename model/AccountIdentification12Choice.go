@@ -10,6 +10,10 @@ type AccountIdentification12Choice struct {
 	AccountsListAndBalanceDetails []*AccountAndBalance9 `xml:"AcctsListAndBalDtls"`
 }
 
+func (a *AccountIdentification12Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification12Choice) AddForAllAccounts() *AccountIdentification10 {
 	a.ForAllAccounts = new(AccountIdentification10)
 	return a.ForAllAccounts
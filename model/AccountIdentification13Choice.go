@@ -10,6 +10,10 @@ type AccountIdentification13Choice struct {
 	AccountsList []*AccountIdentification15 `xml:"AcctsList"`
 }
 
+func (a *AccountIdentification13Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification13Choice) AddForAllAccounts() *AccountIdentification10 {
 	a.ForAllAccounts = new(AccountIdentification10)
 	return a.ForAllAccounts
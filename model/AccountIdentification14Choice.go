@@ -10,6 +10,10 @@ type AccountIdentification14Choice struct {
 	AccountsListAndBalanceDetails []*AccountIdentification18 `xml:"AcctsListAndBalDtls"`
 }
 
+func (a *AccountIdentification14Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification14Choice) AddForAllAccounts() *AccountIdentification10 {
 	a.ForAllAccounts = new(AccountIdentification10)
 	return a.ForAllAccounts
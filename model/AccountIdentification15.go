@@ -13,6 +13,10 @@ type AccountIdentification15 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat2Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (a *AccountIdentification15) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification15) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
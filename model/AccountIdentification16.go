@@ -16,6 +16,10 @@ type AccountIdentification16 struct {
 	CorporateActionEventAndBalance []*CorporateActionEventAndBalance3 `xml:"CorpActnEvtAndBal,omitempty"`
 }
 
+func (a *AccountIdentification16) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification16) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type AccountIdentification17 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat2Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (a *AccountIdentification17) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification17) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
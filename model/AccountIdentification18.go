@@ -16,6 +16,10 @@ type AccountIdentification18 struct {
 	ConfirmedBalance *BalanceFormat1Choice `xml:"ConfdBal"`
 }
 
+func (a *AccountIdentification18) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification18) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type AccountIdentification18Choice struct {
 	AccountsListAndBalanceDetails []*AccountAndBalance15 `xml:"AcctsListAndBalDtls"`
 }
 
+func (a *AccountIdentification18Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification18Choice) AddForAllAccounts() *AccountIdentification10 {
 	a.ForAllAccounts = new(AccountIdentification10)
 	return a.ForAllAccounts
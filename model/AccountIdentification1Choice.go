@@ -16,6 +16,10 @@ type AccountIdentification1Choice struct {
 	DomesticAccount *SimpleIdentificationInformation `xml:"DmstAcct"`
 }
 
+func (a *AccountIdentification1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification1Choice) SetIBAN(value string) {
 	a.IBAN = (*IBANIdentifier)(&value)
 }
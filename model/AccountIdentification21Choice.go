@@ -10,6 +10,10 @@ type AccountIdentification21Choice struct {
 	AccountsListAndBalanceDetails []*AccountAndBalance21 `xml:"AcctsListAndBalDtls"`
 }
 
+func (a *AccountIdentification21Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification21Choice) AddForAllAccounts() *AccountIdentification10 {
 	a.ForAllAccounts = new(AccountIdentification10)
 	return a.ForAllAccounts
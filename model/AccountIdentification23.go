@@ -16,6 +16,10 @@ type AccountIdentification23 struct {
 	CorporateActionEventAndBalance []*CorporateActionEventAndBalance5 `xml:"CorpActnEvtAndBal,omitempty"`
 }
 
+func (a *AccountIdentification23) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification23) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
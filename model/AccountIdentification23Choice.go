@@ -10,6 +10,10 @@ type AccountIdentification23Choice struct {
 	AccountsListAndBalanceDetails []*AccountAndBalance25 `xml:"AcctsListAndBalDtls"`
 }
 
+func (a *AccountIdentification23Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification23Choice) AddForAllAccounts() *AccountIdentification10 {
 	a.ForAllAccounts = new(AccountIdentification10)
 	return a.ForAllAccounts
@@ -16,6 +16,10 @@ type AccountIdentification25 struct {
 	CorporateActionEventAndBalance []*CorporateActionEventAndBalance7 `xml:"CorpActnEvtAndBal,omitempty"`
 }
 
+func (a *AccountIdentification25) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification25) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -7,6 +7,10 @@ type AccountIdentification26 struct {
 	Proprietary *SimpleIdentificationInformation4 `xml:"Prtry"`
 }
 
+func (a *AccountIdentification26) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification26) AddProprietary() *SimpleIdentificationInformation4 {
 	a.Proprietary = new(SimpleIdentificationInformation4)
 	return a.Proprietary
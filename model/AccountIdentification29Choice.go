@@ -10,6 +10,10 @@ type AccountIdentification29Choice struct {
 	AccountsList []*AccountIdentification31 `xml:"AcctsList"`
 }
 
+func (a *AccountIdentification29Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification29Choice) AddForAllAccounts() *AccountIdentification10 {
 	a.ForAllAccounts = new(AccountIdentification10)
 	return a.ForAllAccounts
@@ -10,6 +10,10 @@ type AccountIdentification2Choice struct {
 	SecuritiesAccountIdentification *Max35Text `xml:"SctiesAcctId"`
 }
 
+func (a *AccountIdentification2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification2Choice) SetCashAccountIdentification(value string) {
 	a.CashAccountIdentification = (*Max35Text)(&value)
 }
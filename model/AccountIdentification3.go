@@ -13,6 +13,10 @@ type AccountIdentification3 struct {
 	Information *Exact4AlphaNumericText `xml:"Inf"`
 }
 
+func (a *AccountIdentification3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification3) AddIdentification() *AccountIdentification1 {
 	a.Identification = new(AccountIdentification1)
 	return a.Identification
@@ -28,6 +28,10 @@ type AccountIdentification30Choice struct {
 	Other *Max35Text `xml:"Othr"`
 }
 
+func (a *AccountIdentification30Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification30Choice) SetCard(value string) {
 	a.Card = (*Min8Max28NumericText)(&value)
 }
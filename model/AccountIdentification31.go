@@ -13,6 +13,10 @@ type AccountIdentification31 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat8Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (a *AccountIdentification31) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification31) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type AccountIdentification31Choice struct {
 	DomesticAccount *SimpleIdentificationInformation4 `xml:"DmstAcct"`
 }
 
+func (a *AccountIdentification31Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification31Choice) SetIBAN(value string) {
 	a.IBAN = (*IBANIdentifier)(&value)
 }
@@ -16,6 +16,10 @@ type AccountIdentification32 struct {
 	ConfirmedBalance *BalanceFormat5Choice `xml:"ConfdBal"`
 }
 
+func (a *AccountIdentification32) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification32) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type AccountIdentification32Choice struct {
 	AccountsListAndBalanceDetails []*AccountAndBalance33 `xml:"AcctsListAndBalDtls"`
 }
 
+func (a *AccountIdentification32Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification32Choice) AddForAllAccounts() *AccountIdentification10 {
 	a.ForAllAccounts = new(AccountIdentification10)
 	return a.ForAllAccounts
@@ -16,6 +16,10 @@ type AccountIdentification33 struct {
 	CorporateActionEventAndBalance []*CorporateActionEventAndBalance9 `xml:"CorpActnEvtAndBal,omitempty"`
 }
 
+func (a *AccountIdentification33) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification33) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type AccountIdentification33Choice struct {
 	AccountsListAndBalanceDetails []*AccountIdentification32 `xml:"AcctsListAndBalDtls"`
 }
 
+func (a *AccountIdentification33Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification33Choice) AddForAllAccounts() *AccountIdentification10 {
 	a.ForAllAccounts = new(AccountIdentification10)
 	return a.ForAllAccounts
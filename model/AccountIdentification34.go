@@ -13,6 +13,10 @@ type AccountIdentification34 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat11Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (a *AccountIdentification34) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification34) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*RestrictedFINXMax35Text)(&value)
 }
@@ -10,6 +10,10 @@ type AccountIdentification34Choice struct {
 	AccountsList []*AccountIdentification34 `xml:"AcctsList"`
 }
 
+func (a *AccountIdentification34Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification34Choice) AddForAllAccounts() *AccountIdentification10 {
 	a.ForAllAccounts = new(AccountIdentification10)
 	return a.ForAllAccounts
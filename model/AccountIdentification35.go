@@ -16,6 +16,10 @@ type AccountIdentification35 struct {
 	CorporateActionEventAndBalance []*CorporateActionEventAndBalance10 `xml:"CorpActnEvtAndBal,omitempty"`
 }
 
+func (a *AccountIdentification35) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification35) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*RestrictedFINXMax35Text)(&value)
 }
@@ -10,6 +10,10 @@ type AccountIdentification36Choice struct {
 	AccountsListAndBalanceDetails []*AccountAndBalance39 `xml:"AcctsListAndBalDtls"`
 }
 
+func (a *AccountIdentification36Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification36Choice) AddForAllAccounts() *AccountIdentification10 {
 	a.ForAllAccounts = new(AccountIdentification10)
 	return a.ForAllAccounts
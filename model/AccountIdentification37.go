@@ -16,6 +16,10 @@ type AccountIdentification37 struct {
 	ConfirmedBalance *BalanceFormat7Choice `xml:"ConfdBal"`
 }
 
+func (a *AccountIdentification37) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification37) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*RestrictedFINXMax35Text)(&value)
 }
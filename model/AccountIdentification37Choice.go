@@ -10,6 +10,10 @@ type AccountIdentification37Choice struct {
 	AccountsListAndBalanceDetails []*AccountIdentification37 `xml:"AcctsListAndBalDtls"`
 }
 
+func (a *AccountIdentification37Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification37Choice) AddForAllAccounts() *AccountIdentification10 {
 	a.ForAllAccounts = new(AccountIdentification10)
 	return a.ForAllAccounts
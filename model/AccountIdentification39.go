@@ -13,6 +13,10 @@ type AccountIdentification39 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat26Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (a *AccountIdentification39) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification39) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*RestrictedFINXMax35Text)(&value)
 }
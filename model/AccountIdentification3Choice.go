@@ -16,6 +16,10 @@ type AccountIdentification3Choice struct {
 	ProprietaryAccount *SimpleIdentificationInformation2 `xml:"PrtryAcct"`
 }
 
+func (a *AccountIdentification3Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification3Choice) SetIBAN(value string) {
 	a.IBAN = (*IBANIdentifier)(&value)
 }
@@ -7,6 +7,10 @@ type AccountIdentification4 struct {
 	Proprietary *SimpleIdentificationInformation1 `xml:"Prtry"`
 }
 
+func (a *AccountIdentification4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification4) AddProprietary() *SimpleIdentificationInformation1 {
 	a.Proprietary = new(SimpleIdentificationInformation1)
 	return a.Proprietary
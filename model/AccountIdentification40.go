@@ -16,6 +16,10 @@ type AccountIdentification40 struct {
 	CorporateActionEventAndBalance []*CorporateActionEventAndBalance11 `xml:"CorpActnEvtAndBal,omitempty"`
 }
 
+func (a *AccountIdentification40) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification40) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type AccountIdentification41 struct {
 	CorporateActionEventAndBalance []*CorporateActionEventAndBalance12 `xml:"CorpActnEvtAndBal,omitempty"`
 }
 
+func (a *AccountIdentification41) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification41) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*RestrictedFINXMax35Text)(&value)
 }
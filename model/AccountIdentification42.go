@@ -13,6 +13,10 @@ type AccountIdentification42 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat27Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (a *AccountIdentification42) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification42) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*RestrictedFINXMax35Text)(&value)
 }
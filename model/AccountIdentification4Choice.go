@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the unique identification of an account as assigned by the account servicer.
 type AccountIdentification4Choice struct {
 
@@ -10,6 +12,10 @@ type AccountIdentification4Choice struct {
 	Other *GenericAccountIdentification1 `xml:"Othr"`
 }
 
+func (a *AccountIdentification4Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification4Choice) SetIBAN(value string) {
 	a.IBAN = (*IBAN2007Identifier)(&value)
 }
@@ -18,3 +24,44 @@ func (a *AccountIdentification4Choice) AddOther() *GenericAccountIdentification1
 	a.Other = new(GenericAccountIdentification1)
 	return a.Other
 }
+
+func (a *AccountIdentification4Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.IBAN != nil {
+		enc.Element("IBAN", string(*a.IBAN))
+	}
+	if a.Other != nil {
+		a.Other.EncodeXMLFast(enc, "Othr")
+	}
+	enc.End(tag)
+}
+
+func (a *AccountIdentification4Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "IBAN":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.IBAN = (*IBAN2007Identifier)(&value)
+		case "Othr":
+			elem := new(GenericAccountIdentification1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Other = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
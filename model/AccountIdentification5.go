@@ -13,6 +13,10 @@ type AccountIdentification5 struct {
 	Type *GenericIdentification30 `xml:"Tp,omitempty"`
 }
 
+func (a *AccountIdentification5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification5) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
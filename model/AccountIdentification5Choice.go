@@ -16,6 +16,10 @@ type AccountIdentification5Choice struct {
 	DepositoryAccount *Max35Text `xml:"DpstryAcct"`
 }
 
+func (a *AccountIdentification5Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification5Choice) SetIBAN(value string) {
 	a.IBAN = (*IBANIdentifier)(&value)
 }
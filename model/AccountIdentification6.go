@@ -16,6 +16,10 @@ type AccountIdentification6 struct {
 	CorporateActionEventAndBalance []*CorporateActionEventAndBalance1 `xml:"CorpActnEvtAndBal,omitempty"`
 }
 
+func (a *AccountIdentification6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification6) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type AccountIdentification6Choice struct {
 	AccountsList []*AccountIdentification9 `xml:"AcctsList"`
 }
 
+func (a *AccountIdentification6Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification6Choice) AddForAllAccounts() *AccountIdentification10 {
 	a.ForAllAccounts = new(AccountIdentification10)
 	return a.ForAllAccounts
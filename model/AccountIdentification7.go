@@ -16,6 +16,10 @@ type AccountIdentification7 struct {
 	ConfirmedBalance *BalanceFormat1Choice `xml:"ConfdBal"`
 }
 
+func (a *AccountIdentification7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification7) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type AccountIdentification7Choice struct {
 	AccountsListAndBalanceDetails []*AccountAndBalance1 `xml:"AcctsListAndBalDtls"`
 }
 
+func (a *AccountIdentification7Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification7Choice) AddForAllAccounts() *AccountIdentification10 {
 	a.ForAllAccounts = new(AccountIdentification10)
 	return a.ForAllAccounts
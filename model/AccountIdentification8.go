@@ -13,6 +13,10 @@ type AccountIdentification8 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat2Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (a *AccountIdentification8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification8) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
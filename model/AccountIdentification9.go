@@ -13,6 +13,10 @@ type AccountIdentification9 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat2Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (a *AccountIdentification9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification9) SetSafekeepingAccount(value string) {
 	a.SafekeepingAccount = (*Max35Text)(&value)
 }
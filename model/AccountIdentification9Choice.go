@@ -10,6 +10,10 @@ type AccountIdentification9Choice struct {
 	AccountsListAndBalanceDetails []*AccountIdentification7 `xml:"AcctsListAndBalDtls"`
 }
 
+func (a *AccountIdentification9Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentification9Choice) AddForAllAccounts() *AccountIdentification10 {
 	a.ForAllAccounts = new(AccountIdentification10)
 	return a.ForAllAccounts
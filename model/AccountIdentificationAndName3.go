@@ -10,6 +10,10 @@ type AccountIdentificationAndName3 struct {
 	Name *Max35Text `xml:"Nm,omitempty"`
 }
 
+func (a *AccountIdentificationAndName3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentificationAndName3) AddIdentification() *CashAccountIdentification1Choice {
 	a.Identification = new(CashAccountIdentification1Choice)
 	return a.Identification
@@ -10,6 +10,10 @@ type AccountIdentificationAndName5 struct {
 	Name *Max35Text `xml:"Nm,omitempty"`
 }
 
+func (a *AccountIdentificationAndName5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentificationAndName5) AddIdentification() *AccountIdentification4Choice {
 	a.Identification = new(AccountIdentification4Choice)
 	return a.Identification
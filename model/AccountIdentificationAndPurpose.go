@@ -10,6 +10,10 @@ type AccountIdentificationAndPurpose struct {
 	Purpose *SecuritiesAccountPurposeType1Code `xml:"Purp"`
 }
 
+func (a *AccountIdentificationAndPurpose) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentificationAndPurpose) AddIdentification() *AccountIdentification1 {
 	a.Identification = new(AccountIdentification1)
 	return a.Identification
@@ -13,6 +13,10 @@ type AccountIdentificationFormatChoice struct {
 	IdentificationAsDSS *AccountIdentification3 `xml:"IdAsDSS"`
 }
 
+func (a *AccountIdentificationFormatChoice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountIdentificationFormatChoice) AddSimpleIdentification() *AccountIdentification1 {
 	a.SimpleIdentification = new(AccountIdentification1)
 	return a.SimpleIdentification
@@ -16,6 +16,10 @@ type AccountInterest1 struct {
 	Reason *Max35Text `xml:"Rsn,omitempty"`
 }
 
+func (a *AccountInterest1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountInterest1) AddType() *InterestType1Choice {
 	a.Type = new(InterestType1Choice)
 	return a.Type
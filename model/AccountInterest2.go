@@ -16,6 +16,10 @@ type AccountInterest2 struct {
 	Reason *Max35Text `xml:"Rsn,omitempty"`
 }
 
+func (a *AccountInterest2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountInterest2) AddType() *InterestType1Choice {
 	a.Type = new(InterestType1Choice)
 	return a.Type
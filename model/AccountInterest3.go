@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Provides further details on the interest that applies to the account at a particular moment in time.
 type AccountInterest3 struct {
 
@@ -19,6 +21,10 @@ type AccountInterest3 struct {
 	Tax *TaxCharges2 `xml:"Tax,omitempty"`
 }
 
+func (a *AccountInterest3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountInterest3) AddType() *InterestType1Choice {
 	a.Type = new(InterestType1Choice)
 	return a.Type
@@ -183,3 +189,73 @@ func (a *AccountInterest3) AddTax() *TaxCharges2 {
 	a.Tax = new(TaxCharges2)
 	return a.Tax
 }
+
+func (a *AccountInterest3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.Type != nil {
+		a.Type.EncodeXMLFast(enc, "Tp")
+	}
+	for _, elem := range a.Rate {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Rate")
+		}
+	}
+	if a.FromToDate != nil {
+		a.FromToDate.EncodeXMLFast(enc, "FrToDt")
+	}
+	if a.Reason != nil {
+		enc.Element("Rsn", string(*a.Reason))
+	}
+	if a.Tax != nil {
+		a.Tax.EncodeXMLFast(enc, "Tax")
+	}
+	enc.End(tag)
+}
+
+func (a *AccountInterest3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(InterestType1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Type = elem
+		case "Rate":
+			elem := new(Rate3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Rate = append(a.Rate, elem)
+		case "FrToDt":
+			elem := new(DateTimePeriodDetails)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.FromToDate = elem
+		case "Rsn":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.Reason = (*Max35Text)(&value)
+		case "Tax":
+			elem := new(TaxCharges2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Tax = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
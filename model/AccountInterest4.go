@@ -0,0 +1,121 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Provides further details on the interest that applies to the account at a particular moment in time.
+type AccountInterest4 struct {
+
+	// Specifies the type of interest.
+	Type *InterestType1Choice `xml:"Tp,omitempty"`
+
+	// Set of elements used to qualify the interest rate.
+	Rate []*Rate4 `xml:"Rate,omitempty"`
+
+	// Range of time between a start date and an end date for the calculation of the interest.
+	FromToDate *DateTimePeriod1 `xml:"FrToDt,omitempty"`
+
+	// Specifies the reason for the interest.
+	Reason *Max35Text `xml:"Rsn,omitempty"`
+
+	// Provides details on the tax applied to charges.
+	Tax *TaxCharges2 `xml:"Tax,omitempty"`
+}
+
+func (a *AccountInterest4) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AccountInterest4) AddType() *InterestType1Choice {
+	a.Type = new(InterestType1Choice)
+	return a.Type
+}
+
+func (a *AccountInterest4) AddRate() *Rate4 {
+	newValue := new(Rate4)
+	a.Rate = append(a.Rate, newValue)
+	return newValue
+}
+
+func (a *AccountInterest4) AddFromToDate() *DateTimePeriod1 {
+	a.FromToDate = new(DateTimePeriod1)
+	return a.FromToDate
+}
+
+func (a *AccountInterest4) SetReason(value string) {
+	a.Reason = (*Max35Text)(&value)
+}
+
+func (a *AccountInterest4) AddTax() *TaxCharges2 {
+	a.Tax = new(TaxCharges2)
+	return a.Tax
+}
+
+func (a *AccountInterest4) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.Type != nil {
+		a.Type.EncodeXMLFast(enc, "Tp")
+	}
+	for _, elem := range a.Rate {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Rate")
+		}
+	}
+	if a.FromToDate != nil {
+		a.FromToDate.EncodeXMLFast(enc, "FrToDt")
+	}
+	if a.Reason != nil {
+		enc.Element("Rsn", string(*a.Reason))
+	}
+	if a.Tax != nil {
+		a.Tax.EncodeXMLFast(enc, "Tax")
+	}
+	enc.End(tag)
+}
+
+func (a *AccountInterest4) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(InterestType1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Type = elem
+		case "Rate":
+			elem := new(Rate4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Rate = append(a.Rate, elem)
+		case "FrToDt":
+			elem := new(DateTimePeriod1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.FromToDate = elem
+		case "Rsn":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.Reason = (*Max35Text)(&value)
+		case "Tax":
+			elem := new(TaxCharges2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Tax = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
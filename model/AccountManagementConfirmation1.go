@@ -10,6 +10,10 @@ type AccountManagementConfirmation1 struct {
 	AccountApplicationIdentification *Max35Text `xml:"AcctApplId,omitempty"`
 }
 
+func (a *AccountManagementConfirmation1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountManagementConfirmation1) SetConfirmationType(value string) {
 	a.ConfirmationType = (*AccountManagementType2Code)(&value)
 }
@@ -16,6 +16,10 @@ type AccountManagementConfirmation2 struct {
 	CounterpartyReference *AdditionalReference2 `xml:"CtrPtyRef,omitempty"`
 }
 
+func (a *AccountManagementConfirmation2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountManagementConfirmation2) SetConfirmationType(value string) {
 	a.ConfirmationType = (*AccountManagementType2Code)(&value)
 }
@@ -19,6 +19,10 @@ type AccountManagementConfirmation3 struct {
 	ExistingAccountIdentification *Max35Text `xml:"ExstgAcctId,omitempty"`
 }
 
+func (a *AccountManagementConfirmation3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountManagementConfirmation3) SetConfirmationType(value string) {
 	a.ConfirmationType = (*AccountManagementType2Code)(&value)
 }
@@ -19,6 +19,10 @@ type AccountManagementConfirmation4 struct {
 	ExistingAccountIdentification []*Account23 `xml:"ExstgAcctId,omitempty"`
 }
 
+func (a *AccountManagementConfirmation4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountManagementConfirmation4) AddConfirmationType() *ConfirmationType1Choice {
 	a.ConfirmationType = new(ConfirmationType1Choice)
 	return a.ConfirmationType
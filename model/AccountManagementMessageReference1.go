@@ -19,6 +19,10 @@ type AccountManagementMessageReference1 struct {
 	InvestmentAccount *InvestmentAccount14 `xml:"InvstmtAcct,omitempty"`
 }
 
+func (a *AccountManagementMessageReference1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountManagementMessageReference1) AddOtherReference() *AdditionalReference3 {
 	a.OtherReference = new(AdditionalReference3)
 	return a.OtherReference
@@ -16,6 +16,10 @@ type AccountManagementMessageReference2 struct {
 	InvestmentAccount *InvestmentAccount45 `xml:"InvstmtAcct,omitempty"`
 }
 
+func (a *AccountManagementMessageReference2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountManagementMessageReference2) AddLinkedReference() *LinkedMessage2Choice {
 	a.LinkedReference = new(LinkedMessage2Choice)
 	return a.LinkedReference
@@ -19,6 +19,10 @@ type AccountManagementMessageReference3 struct {
 	InvestmentAccount *InvestmentAccount53 `xml:"InvstmtAcct,omitempty"`
 }
 
+func (a *AccountManagementMessageReference3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountManagementMessageReference3) AddLinkedReference() *LinkedMessage3Choice {
 	a.LinkedReference = new(LinkedMessage3Choice)
 	return a.LinkedReference
@@ -19,6 +19,10 @@ type AccountManagementMessageReference4 struct {
 	InvestmentAccount *InvestmentAccount53 `xml:"InvstmtAcct,omitempty"`
 }
 
+func (a *AccountManagementMessageReference4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountManagementMessageReference4) AddLinkedReference() *LinkedMessage4Choice {
 	a.LinkedReference = new(LinkedMessage4Choice)
 	return a.LinkedReference
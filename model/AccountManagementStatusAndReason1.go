@@ -13,6 +13,10 @@ type AccountManagementStatusAndReason1 struct {
 	AccountApplicationIdentification *Max35Text `xml:"AcctApplId,omitempty"`
 }
 
+func (a *AccountManagementStatusAndReason1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountManagementStatusAndReason1) SetStatus(value string) {
 	a.Status = (*AccountManagementStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type AccountManagementStatusAndReason2 struct {
 	AccountApplicationIdentification *Max35Text `xml:"AcctApplId,omitempty"`
 }
 
+func (a *AccountManagementStatusAndReason2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountManagementStatusAndReason2) AddStatus() *Status12Choice {
 	a.Status = new(Status12Choice)
 	return a.Status
@@ -10,6 +10,10 @@ type AccountManagementStatusAndReason3 struct {
 	AccountApplicationIdentification *Max35Text `xml:"AcctApplId,omitempty"`
 }
 
+func (a *AccountManagementStatusAndReason3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountManagementStatusAndReason3) AddStatus() *Status14Choice {
 	a.Status = new(Status14Choice)
 	return a.Status
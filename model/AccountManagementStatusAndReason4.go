@@ -13,6 +13,10 @@ type AccountManagementStatusAndReason4 struct {
 	ExistingAccountIdentification *Max35Text `xml:"ExstgAcctId,omitempty"`
 }
 
+func (a *AccountManagementStatusAndReason4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountManagementStatusAndReason4) AddStatus() *Status20Choice {
 	a.Status = new(Status20Choice)
 	return a.Status
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Status information.
 type AccountManagementStatusAndReason5 struct {
 
@@ -31,6 +35,10 @@ type AccountManagementStatusAndReason5 struct {
 	CRSReportingDate *ISODate `xml:"CRSRptgDt,omitempty"`
 }
 
+func (a *AccountManagementStatusAndReason5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountManagementStatusAndReason5) AddStatus() *Status25Choice {
 	a.Status = new(Status25Choice)
 	return a.Status
@@ -350,6 +358,16 @@ func (a *AccountManagementStatusAndReason5) SetFATCAReportingDate(value string)
 	a.FATCAReportingDate = (*ISODate)(&value)
 }
 
+func (a *AccountManagementStatusAndReason5) SetFATCAReportingDateFromTime(value time.Time) {
+	a.FATCAReportingDate = new(ISODate)
+	a.FATCAReportingDate.FromTime(value)
+}
+
 func (a *AccountManagementStatusAndReason5) SetCRSReportingDate(value string) {
 	a.CRSReportingDate = (*ISODate)(&value)
 }
+
+func (a *AccountManagementStatusAndReason5) SetCRSReportingDateFromTime(value time.Time) {
+	a.CRSReportingDate = new(ISODate)
+	a.CRSReportingDate.FromTime(value)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements providing further details on the account notification.
 type AccountNotification1 struct {
 
@@ -41,6 +47,10 @@ type AccountNotification1 struct {
 	AdditionalNotificationInformation *Max500Text `xml:"AddtlNtfctnInf,omitempty"`
 }
 
+func (a *AccountNotification1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountNotification1) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -49,21 +59,40 @@ func (a *AccountNotification1) SetElectronicSequenceNumber(value string) {
 	a.ElectronicSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountNotification1) SetElectronicSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.ElectronicSequenceNumber = new(Number)
+	a.ElectronicSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountNotification1) SetLegalSequenceNumber(value string) {
 	a.LegalSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountNotification1) SetLegalSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.LegalSequenceNumber = new(Number)
+	a.LegalSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountNotification1) SetCreationDateTime(value string) {
 	a.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (a *AccountNotification1) SetCreationDateTimeFromTime(value time.Time) {
+	a.CreationDateTime = new(ISODateTime)
+	a.CreationDateTime.FromTime(value)
+}
+
 func (a *AccountNotification1) AddFromToDate() *DateTimePeriodDetails {
 	a.FromToDate = new(DateTimePeriodDetails)
 	return a.FromToDate
 }
 
-func (a *AccountNotification1) SetCopyDuplicateIndicator(value string) {
+func (a *AccountNotification1) SetCopyDuplicateIndicator(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	a.CopyDuplicateIndicator = (*CopyDuplicate1Code)(&value)
+	return nil
 }
 
 func (a *AccountNotification1) AddAccount() *CashAccount13 {
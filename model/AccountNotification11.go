@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details of the account notification.
 type AccountNotification11 struct {
 
@@ -55,6 +61,10 @@ type AccountNotification11 struct {
 	AdditionalNotificationInformation *Max500Text `xml:"AddtlNtfctnInf,omitempty"`
 }
 
+func (a *AccountNotification11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountNotification11) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -68,21 +78,40 @@ func (a *AccountNotification11) SetElectronicSequenceNumber(value string) {
 	a.ElectronicSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountNotification11) SetElectronicSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.ElectronicSequenceNumber = new(Number)
+	a.ElectronicSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountNotification11) SetLegalSequenceNumber(value string) {
 	a.LegalSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountNotification11) SetLegalSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.LegalSequenceNumber = new(Number)
+	a.LegalSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountNotification11) SetCreationDateTime(value string) {
 	a.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (a *AccountNotification11) SetCreationDateTimeFromTime(value time.Time) {
+	a.CreationDateTime = new(ISODateTime)
+	a.CreationDateTime.FromTime(value)
+}
+
 func (a *AccountNotification11) AddFromToDate() *DateTimePeriodDetails {
 	a.FromToDate = new(DateTimePeriodDetails)
 	return a.FromToDate
 }
 
-func (a *AccountNotification11) SetCopyDuplicateIndicator(value string) {
+func (a *AccountNotification11) SetCopyDuplicateIndicator(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	a.CopyDuplicateIndicator = (*CopyDuplicate1Code)(&value)
+	return nil
 }
 
 func (a *AccountNotification11) AddReportingSource() *ReportingSource1Choice {
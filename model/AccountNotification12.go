@@ -1,5 +1,12 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides further details of the account notification.
 type AccountNotification12 struct {
 
@@ -55,6 +62,10 @@ type AccountNotification12 struct {
 	AdditionalNotificationInformation *Max500Text `xml:"AddtlNtfctnInf,omitempty"`
 }
 
+func (a *AccountNotification12) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountNotification12) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -68,21 +79,40 @@ func (a *AccountNotification12) SetElectronicSequenceNumber(value string) {
 	a.ElectronicSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountNotification12) SetElectronicSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.ElectronicSequenceNumber = new(Number)
+	a.ElectronicSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountNotification12) SetLegalSequenceNumber(value string) {
 	a.LegalSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountNotification12) SetLegalSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.LegalSequenceNumber = new(Number)
+	a.LegalSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountNotification12) SetCreationDateTime(value string) {
 	a.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (a *AccountNotification12) SetCreationDateTimeFromTime(value time.Time) {
+	a.CreationDateTime = new(ISODateTime)
+	a.CreationDateTime.FromTime(value)
+}
+
 func (a *AccountNotification12) AddFromToDate() *DateTimePeriodDetails {
 	a.FromToDate = new(DateTimePeriodDetails)
 	return a.FromToDate
 }
 
-func (a *AccountNotification12) SetCopyDuplicateIndicator(value string) {
+func (a *AccountNotification12) SetCopyDuplicateIndicator(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	a.CopyDuplicateIndicator = (*CopyDuplicate1Code)(&value)
+	return nil
 }
 
 func (a *AccountNotification12) AddReportingSource() *ReportingSource1Choice {
@@ -400,3 +430,156 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (a *AccountNotification12) SetAdditionalNotificationInformation(value string) {
 	a.AdditionalNotificationInformation = (*Max500Text)(&value)
 }
+
+func (a *AccountNotification12) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.Identification != nil {
+		enc.Element("Id", string(*a.Identification))
+	}
+	if a.NotificationPagination != nil {
+		a.NotificationPagination.EncodeXMLFast(enc, "NtfctnPgntn")
+	}
+	if a.ElectronicSequenceNumber != nil {
+		enc.Element("ElctrncSeqNb", string(*a.ElectronicSequenceNumber))
+	}
+	if a.LegalSequenceNumber != nil {
+		enc.Element("LglSeqNb", string(*a.LegalSequenceNumber))
+	}
+	if a.CreationDateTime != nil {
+		enc.Element("CreDtTm", string(*a.CreationDateTime))
+	}
+	if a.FromToDate != nil {
+		a.FromToDate.EncodeXMLFast(enc, "FrToDt")
+	}
+	if a.CopyDuplicateIndicator != nil {
+		enc.Element("CpyDplctInd", string(*a.CopyDuplicateIndicator))
+	}
+	if a.ReportingSource != nil {
+		a.ReportingSource.EncodeXMLFast(enc, "RptgSrc")
+	}
+	if a.Account != nil {
+		a.Account.EncodeXMLFast(enc, "Acct")
+	}
+	if a.RelatedAccount != nil {
+		a.RelatedAccount.EncodeXMLFast(enc, "RltdAcct")
+	}
+	for _, elem := range a.Interest {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Intrst")
+		}
+	}
+	if a.TransactionsSummary != nil {
+		a.TransactionsSummary.EncodeXMLFast(enc, "TxsSummry")
+	}
+	for _, elem := range a.Entry {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Ntry")
+		}
+	}
+	if a.AdditionalNotificationInformation != nil {
+		enc.Element("AddtlNtfctnInf", string(*a.AdditionalNotificationInformation))
+	}
+	enc.End(tag)
+}
+
+func (a *AccountNotification12) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.Identification = (*Max35Text)(&value)
+		case "NtfctnPgntn":
+			elem := new(Pagination)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.NotificationPagination = elem
+		case "ElctrncSeqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.ElectronicSequenceNumber = (*Number)(&value)
+		case "LglSeqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.LegalSequenceNumber = (*Number)(&value)
+		case "CreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.CreationDateTime = (*ISODateTime)(&value)
+		case "FrToDt":
+			elem := new(DateTimePeriodDetails)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.FromToDate = elem
+		case "CpyDplctInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.CopyDuplicateIndicator = (*CopyDuplicate1Code)(&value)
+		case "RptgSrc":
+			elem := new(ReportingSource1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.ReportingSource = elem
+		case "Acct":
+			elem := new(CashAccount25)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Account = elem
+		case "RltdAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.RelatedAccount = elem
+		case "Intrst":
+			elem := new(AccountInterest3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Interest = append(a.Interest, elem)
+		case "TxsSummry":
+			elem := new(TotalTransactions5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.TransactionsSummary = elem
+		case "Ntry":
+			elem := new(ReportEntry8)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Entry = append(a.Entry, elem)
+		case "AddtlNtfctnInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.AdditionalNotificationInformation = (*Max500Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
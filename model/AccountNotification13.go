@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the account notification.
 type AccountNotification13 struct {
 
@@ -37,6 +43,10 @@ type AccountNotification13 struct {
 	Item []*NotificationItem6 `xml:"Itm"`
 }
 
+func (a *AccountNotification13) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountNotification13) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -65,10 +75,19 @@ func (a *AccountNotification13) SetTotalAmount(value, currency string) {
 	a.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AccountNotification13) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AccountNotification13) SetExpectedValueDate(value string) {
 	a.ExpectedValueDate = (*ISODate)(&value)
 }
 
+func (a *AccountNotification13) SetExpectedValueDateFromTime(value time.Time) {
+	a.ExpectedValueDate = new(ISODate)
+	a.ExpectedValueDate.FromTime(value)
+}
+
 func (a *AccountNotification13) AddDebtor() *Party12Choice {
 	a.Debtor = new(Party12Choice)
 	return a.Debtor
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide details of the account notification.
 type AccountNotification2 struct {
 
@@ -45,6 +51,10 @@ type AccountNotification2 struct {
 	AdditionalNotificationInformation *Max500Text `xml:"AddtlNtfctnInf,omitempty"`
 }
 
+func (a *AccountNotification2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountNotification2) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -53,21 +63,40 @@ func (a *AccountNotification2) SetElectronicSequenceNumber(value string) {
 	a.ElectronicSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountNotification2) SetElectronicSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.ElectronicSequenceNumber = new(Number)
+	a.ElectronicSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountNotification2) SetLegalSequenceNumber(value string) {
 	a.LegalSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountNotification2) SetLegalSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.LegalSequenceNumber = new(Number)
+	a.LegalSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountNotification2) SetCreationDateTime(value string) {
 	a.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (a *AccountNotification2) SetCreationDateTimeFromTime(value time.Time) {
+	a.CreationDateTime = new(ISODateTime)
+	a.CreationDateTime.FromTime(value)
+}
+
 func (a *AccountNotification2) AddFromToDate() *DateTimePeriodDetails {
 	a.FromToDate = new(DateTimePeriodDetails)
 	return a.FromToDate
 }
 
-func (a *AccountNotification2) SetCopyDuplicateIndicator(value string) {
+func (a *AccountNotification2) SetCopyDuplicateIndicator(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	a.CopyDuplicateIndicator = (*CopyDuplicate1Code)(&value)
+	return nil
 }
 
 func (a *AccountNotification2) AddReportingSource() *ReportingSource1Choice {
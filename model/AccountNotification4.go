@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the account notification.
 type AccountNotification4 struct {
 
@@ -37,6 +43,10 @@ type AccountNotification4 struct {
 	Item []*NotificationItem3 `xml:"Itm"`
 }
 
+func (a *AccountNotification4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountNotification4) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -65,10 +75,19 @@ func (a *AccountNotification4) SetTotalAmount(value, currency string) {
 	a.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AccountNotification4) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AccountNotification4) SetExpectedValueDate(value string) {
 	a.ExpectedValueDate = (*ISODate)(&value)
 }
 
+func (a *AccountNotification4) SetExpectedValueDateFromTime(value time.Time) {
+	a.ExpectedValueDate = new(ISODate)
+	a.ExpectedValueDate.FromTime(value)
+}
+
 func (a *AccountNotification4) AddDebtor() *Party12Choice {
 	a.Debtor = new(Party12Choice)
 	return a.Debtor
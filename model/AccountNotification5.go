@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details of the account notification.
 type AccountNotification5 struct {
 
@@ -50,6 +56,10 @@ type AccountNotification5 struct {
 	AdditionalNotificationInformation *Max500Text `xml:"AddtlNtfctnInf,omitempty"`
 }
 
+func (a *AccountNotification5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountNotification5) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -63,21 +73,40 @@ func (a *AccountNotification5) SetElectronicSequenceNumber(value string) {
 	a.ElectronicSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountNotification5) SetElectronicSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.ElectronicSequenceNumber = new(Number)
+	a.ElectronicSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountNotification5) SetLegalSequenceNumber(value string) {
 	a.LegalSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountNotification5) SetLegalSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.LegalSequenceNumber = new(Number)
+	a.LegalSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountNotification5) SetCreationDateTime(value string) {
 	a.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (a *AccountNotification5) SetCreationDateTimeFromTime(value time.Time) {
+	a.CreationDateTime = new(ISODateTime)
+	a.CreationDateTime.FromTime(value)
+}
+
 func (a *AccountNotification5) AddFromToDate() *DateTimePeriodDetails {
 	a.FromToDate = new(DateTimePeriodDetails)
 	return a.FromToDate
 }
 
-func (a *AccountNotification5) SetCopyDuplicateIndicator(value string) {
+func (a *AccountNotification5) SetCopyDuplicateIndicator(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	a.CopyDuplicateIndicator = (*CopyDuplicate1Code)(&value)
+	return nil
 }
 
 func (a *AccountNotification5) AddReportingSource() *ReportingSource1Choice {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the account notification.
 type AccountNotification6 struct {
 
@@ -37,6 +43,10 @@ type AccountNotification6 struct {
 	Item []*NotificationItem4 `xml:"Itm"`
 }
 
+func (a *AccountNotification6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountNotification6) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -65,10 +75,19 @@ func (a *AccountNotification6) SetTotalAmount(value, currency string) {
 	a.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AccountNotification6) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AccountNotification6) SetExpectedValueDate(value string) {
 	a.ExpectedValueDate = (*ISODate)(&value)
 }
 
+func (a *AccountNotification6) SetExpectedValueDateFromTime(value time.Time) {
+	a.ExpectedValueDate = new(ISODate)
+	a.ExpectedValueDate.FromTime(value)
+}
+
 func (a *AccountNotification6) AddDebtor() *Party12Choice {
 	a.Debtor = new(Party12Choice)
 	return a.Debtor
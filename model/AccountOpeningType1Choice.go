@@ -10,6 +10,10 @@ type AccountOpeningType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AccountOpeningType1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountOpeningType1Choice) SetCode(value string) {
 	a.Code = (*AccountOpeningType1Code)(&value)
 }
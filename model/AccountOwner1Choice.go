@@ -10,6 +10,10 @@ type AccountOwner1Choice struct {
 	OrganisationOwnerIdentification *PartyIdentification96 `xml:"OrgOwnrId"`
 }
 
+func (a *AccountOwner1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountOwner1Choice) AddIndividualOwnerIdentification() *IndividualPersonIdentification1Choice {
 	a.IndividualOwnerIdentification = new(IndividualPersonIdentification1Choice)
 	return a.IndividualOwnerIdentification
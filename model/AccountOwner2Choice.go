@@ -10,6 +10,10 @@ type AccountOwner2Choice struct {
 	OrganisationOwnerIdentification *PartyIdentification96 `xml:"OrgOwnrId"`
 }
 
+func (a *AccountOwner2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountOwner2Choice) AddIndividualOwnerIdentification() *IndividualPersonIdentification3Choice {
 	a.IndividualOwnerIdentification = new(IndividualPersonIdentification3Choice)
 	return a.IndividualOwnerIdentification
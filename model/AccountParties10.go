@@ -34,6 +34,10 @@ type AccountParties10 struct {
 	Settlor []*InvestmentAccountOwnershipInformation10 `xml:"Sttlr,omitempty"`
 }
 
+func (a *AccountParties10) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties10) AddPrincipalAccountParty() *AccountParties5Choice {
 	a.PrincipalAccountParty = new(AccountParties5Choice)
 	return a.PrincipalAccountParty
@@ -16,6 +16,10 @@ type AccountParties10Choice struct {
 	JointOwner []*InvestmentAccountOwnershipInformation14 `xml:"JntOwnr"`
 }
 
+func (a *AccountParties10Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties10Choice) AddPrimaryOwner() *InvestmentAccountOwnershipInformation14 {
 	a.PrimaryOwner = new(InvestmentAccountOwnershipInformation14)
 	return a.PrimaryOwner
@@ -37,6 +37,10 @@ type AccountParties11 struct {
 	Settlor []*InvestmentAccountOwnershipInformation11 `xml:"Sttlr,omitempty"`
 }
 
+func (a *AccountParties11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties11) SetModificationScopeIndication(value string) {
 	a.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
@@ -16,6 +16,10 @@ type AccountParties11Choice struct {
 	JointOwner []*InvestmentAccountOwnershipInformation15 `xml:"JntOwnr"`
 }
 
+func (a *AccountParties11Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties11Choice) AddPrimaryOwner() *InvestmentAccountOwnershipInformation15 {
 	a.PrimaryOwner = new(InvestmentAccountOwnershipInformation15)
 	return a.PrimaryOwner
@@ -34,6 +34,10 @@ type AccountParties12 struct {
 	Settlor []*InvestmentAccountOwnershipInformation10 `xml:"Sttlr,omitempty"`
 }
 
+func (a *AccountParties12) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties12) AddPrincipalAccountParty() *AccountParties7Choice {
 	a.PrincipalAccountParty = new(AccountParties7Choice)
 	return a.PrincipalAccountParty
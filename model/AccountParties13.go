@@ -40,6 +40,10 @@ type AccountParties13 struct {
 	RegisteredShareholderName *RegisteredShareholderName1Choice `xml:"RegdShrhldrNm,omitempty"`
 }
 
+func (a *AccountParties13) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties13) AddPrincipalAccountParty() *AccountParties8Choice {
 	a.PrincipalAccountParty = new(AccountParties8Choice)
 	return a.PrincipalAccountParty
@@ -43,6 +43,10 @@ type AccountParties14 struct {
 	RegisteredShareholderName *RegisteredShareholderName1Choice `xml:"RegdShrhldrNm,omitempty"`
 }
 
+func (a *AccountParties14) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties14) SetModificationScopeIndication(value string) {
 	a.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
@@ -46,6 +46,10 @@ type AccountParties15 struct {
 	RegisteredShareholderName *RegisteredShareholderName1Choice `xml:"RegdShrhldrNm,omitempty"`
 }
 
+func (a *AccountParties15) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties15) AddPrincipalAccountParty() *AccountParties10Choice {
 	a.PrincipalAccountParty = new(AccountParties10Choice)
 	return a.PrincipalAccountParty
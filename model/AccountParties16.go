@@ -49,6 +49,10 @@ type AccountParties16 struct {
 	RegisteredShareholderName *RegisteredShareholderName1Choice `xml:"RegdShrhldrNm,omitempty"`
 }
 
+func (a *AccountParties16) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties16) SetModificationScopeIndication(value string) {
 	a.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
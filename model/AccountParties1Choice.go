@@ -19,6 +19,10 @@ type AccountParties1Choice struct {
 	JointOwner []*InvestmentAccountOwnershipInformation6 `xml:"JntOwnr"`
 }
 
+func (a *AccountParties1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties1Choice) AddPrimaryOwner() *InvestmentAccountOwnershipInformation6 {
 	a.PrimaryOwner = new(InvestmentAccountOwnershipInformation6)
 	return a.PrimaryOwner
@@ -19,6 +19,10 @@ type AccountParties2Choice struct {
 	JointOwner []*InvestmentAccountOwnershipInformation7 `xml:"JntOwnr"`
 }
 
+func (a *AccountParties2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties2Choice) AddPrimaryOwner() *InvestmentAccountOwnershipInformation7 {
 	a.PrimaryOwner = new(InvestmentAccountOwnershipInformation7)
 	return a.PrimaryOwner
@@ -19,6 +19,10 @@ type AccountParties3Choice struct {
 	JointOwner []*InvestmentAccountOwnershipInformation8 `xml:"JntOwnr"`
 }
 
+func (a *AccountParties3Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties3Choice) AddPrimaryOwner() *InvestmentAccountOwnershipInformation8 {
 	a.PrimaryOwner = new(InvestmentAccountOwnershipInformation8)
 	return a.PrimaryOwner
@@ -49,6 +49,10 @@ type AccountParties4 struct {
 	OtherParty []*ExtendedParty1 `xml:"OthrPty,omitempty"`
 }
 
+func (a *AccountParties4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties4) SetModificationScopeIndication(value string) {
 	a.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
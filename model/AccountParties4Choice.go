@@ -19,6 +19,10 @@ type AccountParties4Choice struct {
 	JointOwner []*InvestmentAccountOwnershipInformation9 `xml:"JntOwnr"`
 }
 
+func (a *AccountParties4Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties4Choice) AddPrimaryOwner() *InvestmentAccountOwnershipInformation9 {
 	a.PrimaryOwner = new(InvestmentAccountOwnershipInformation9)
 	return a.PrimaryOwner
@@ -46,6 +46,10 @@ type AccountParties5 struct {
 	Settler []*InvestmentAccountOwnershipInformation5 `xml:"Sttlr,omitempty"`
 }
 
+func (a *AccountParties5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties5) AddPrimaryOwner() *InvestmentAccountOwnershipInformation5 {
 	a.PrimaryOwner = new(InvestmentAccountOwnershipInformation5)
 	return a.PrimaryOwner
@@ -19,6 +19,10 @@ type AccountParties5Choice struct {
 	JointOwner []*InvestmentAccountOwnershipInformation10 `xml:"JntOwnr"`
 }
 
+func (a *AccountParties5Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties5Choice) AddPrimaryOwner() *InvestmentAccountOwnershipInformation10 {
 	a.PrimaryOwner = new(InvestmentAccountOwnershipInformation10)
 	return a.PrimaryOwner
@@ -34,6 +34,10 @@ type AccountParties6 struct {
 	Settler []*InvestmentAccountOwnershipInformation6 `xml:"Sttlr,omitempty"`
 }
 
+func (a *AccountParties6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties6) AddPrincipalAccountParty() *AccountParties1Choice {
 	a.PrincipalAccountParty = new(AccountParties1Choice)
 	return a.PrincipalAccountParty
@@ -19,6 +19,10 @@ type AccountParties6Choice struct {
 	JointOwner []*InvestmentAccountOwnershipInformation11 `xml:"JntOwnr"`
 }
 
+func (a *AccountParties6Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties6Choice) AddPrimaryOwner() *InvestmentAccountOwnershipInformation11 {
 	a.PrimaryOwner = new(InvestmentAccountOwnershipInformation11)
 	return a.PrimaryOwner
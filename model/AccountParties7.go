@@ -37,6 +37,10 @@ type AccountParties7 struct {
 	OtherParty []*ExtendedParty4 `xml:"OthrPty,omitempty"`
 }
 
+func (a *AccountParties7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties7) SetModificationScopeIndication(value string) {
 	a.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
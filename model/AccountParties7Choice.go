@@ -19,6 +19,10 @@ type AccountParties7Choice struct {
 	JointOwner []*InvestmentAccountOwnershipInformation9 `xml:"JntOwnr"`
 }
 
+func (a *AccountParties7Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties7Choice) AddPrimaryOwner() *InvestmentAccountOwnershipInformation10 {
 	a.PrimaryOwner = new(InvestmentAccountOwnershipInformation10)
 	return a.PrimaryOwner
@@ -37,6 +37,10 @@ type AccountParties8 struct {
 	Settler []*InvestmentAccountOwnershipInformation8 `xml:"Sttlr,omitempty"`
 }
 
+func (a *AccountParties8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties8) SetModificationScopeIndication(value string) {
 	a.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
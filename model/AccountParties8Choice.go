@@ -16,6 +16,10 @@ type AccountParties8Choice struct {
 	JointOwner []*InvestmentAccountOwnershipInformation12 `xml:"JntOwnr"`
 }
 
+func (a *AccountParties8Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties8Choice) AddPrimaryOwner() *InvestmentAccountOwnershipInformation12 {
 	a.PrimaryOwner = new(InvestmentAccountOwnershipInformation12)
 	return a.PrimaryOwner
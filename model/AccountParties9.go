@@ -34,6 +34,10 @@ type AccountParties9 struct {
 	Settler []*InvestmentAccountOwnershipInformation9 `xml:"Sttlr,omitempty"`
 }
 
+func (a *AccountParties9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties9) AddPrincipalAccountParty() *AccountParties4Choice {
 	a.PrincipalAccountParty = new(AccountParties4Choice)
 	return a.PrincipalAccountParty
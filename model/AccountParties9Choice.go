@@ -16,6 +16,10 @@ type AccountParties9Choice struct {
 	JointOwner []*InvestmentAccountOwnershipInformation13 `xml:"JntOwnr"`
 }
 
+func (a *AccountParties9Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountParties9Choice) AddPrimaryOwner() *InvestmentAccountOwnershipInformation13 {
 	a.PrimaryOwner = new(InvestmentAccountOwnershipInformation13)
 	return a.PrimaryOwner
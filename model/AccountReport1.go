@@ -25,6 +25,10 @@ type AccountReport1 struct {
 	TransferAccountServicerIdentification *BranchAndFinancialInstitutionIdentification4 `xml:"TrfAcctSvcrId,omitempty"`
 }
 
+func (a *AccountReport1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountReport1) AddAccount() *CustomerAccount1 {
 	a.Account = new(CustomerAccount1)
 	return a.Account
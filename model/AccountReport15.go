@@ -28,6 +28,10 @@ type AccountReport15 struct {
 	TransferAccountServicerIdentification *BranchAndFinancialInstitutionIdentification5 `xml:"TrfAcctSvcrId,omitempty"`
 }
 
+func (a *AccountReport15) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountReport15) AddAccount() *CustomerAccount5 {
 	a.Account = new(CustomerAccount5)
 	return a.Account
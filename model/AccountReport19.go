@@ -1,5 +1,12 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides further details of the account report.
 type AccountReport19 struct {
 
@@ -58,6 +65,10 @@ type AccountReport19 struct {
 	AdditionalReportInformation *Max500Text `xml:"AddtlRptInf,omitempty"`
 }
 
+func (a *AccountReport19) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountReport19) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -71,21 +82,40 @@ func (a *AccountReport19) SetElectronicSequenceNumber(value string) {
 	a.ElectronicSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountReport19) SetElectronicSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.ElectronicSequenceNumber = new(Number)
+	a.ElectronicSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountReport19) SetLegalSequenceNumber(value string) {
 	a.LegalSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountReport19) SetLegalSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.LegalSequenceNumber = new(Number)
+	a.LegalSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountReport19) SetCreationDateTime(value string) {
 	a.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (a *AccountReport19) SetCreationDateTimeFromTime(value time.Time) {
+	a.CreationDateTime = new(ISODateTime)
+	a.CreationDateTime.FromTime(value)
+}
+
 func (a *AccountReport19) AddFromToDate() *DateTimePeriodDetails {
 	a.FromToDate = new(DateTimePeriodDetails)
 	return a.FromToDate
 }
 
-func (a *AccountReport19) SetCopyDuplicateIndicator(value string) {
+func (a *AccountReport19) SetCopyDuplicateIndicator(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	a.CopyDuplicateIndicator = (*CopyDuplicate1Code)(&value)
+	return nil
 }
 
 func (a *AccountReport19) AddReportingSource() *ReportingSource1Choice {
@@ -549,3 +579,167 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (a *AccountReport19) SetAdditionalReportInformation(value string) {
 	a.AdditionalReportInformation = (*Max500Text)(&value)
 }
+
+func (a *AccountReport19) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.Identification != nil {
+		enc.Element("Id", string(*a.Identification))
+	}
+	if a.ReportPagination != nil {
+		a.ReportPagination.EncodeXMLFast(enc, "RptPgntn")
+	}
+	if a.ElectronicSequenceNumber != nil {
+		enc.Element("ElctrncSeqNb", string(*a.ElectronicSequenceNumber))
+	}
+	if a.LegalSequenceNumber != nil {
+		enc.Element("LglSeqNb", string(*a.LegalSequenceNumber))
+	}
+	if a.CreationDateTime != nil {
+		enc.Element("CreDtTm", string(*a.CreationDateTime))
+	}
+	if a.FromToDate != nil {
+		a.FromToDate.EncodeXMLFast(enc, "FrToDt")
+	}
+	if a.CopyDuplicateIndicator != nil {
+		enc.Element("CpyDplctInd", string(*a.CopyDuplicateIndicator))
+	}
+	if a.ReportingSource != nil {
+		a.ReportingSource.EncodeXMLFast(enc, "RptgSrc")
+	}
+	if a.Account != nil {
+		a.Account.EncodeXMLFast(enc, "Acct")
+	}
+	if a.RelatedAccount != nil {
+		a.RelatedAccount.EncodeXMLFast(enc, "RltdAcct")
+	}
+	for _, elem := range a.Interest {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Intrst")
+		}
+	}
+	for _, elem := range a.Balance {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Bal")
+		}
+	}
+	if a.TransactionsSummary != nil {
+		a.TransactionsSummary.EncodeXMLFast(enc, "TxsSummry")
+	}
+	for _, elem := range a.Entry {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Ntry")
+		}
+	}
+	if a.AdditionalReportInformation != nil {
+		enc.Element("AddtlRptInf", string(*a.AdditionalReportInformation))
+	}
+	enc.End(tag)
+}
+
+func (a *AccountReport19) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.Identification = (*Max35Text)(&value)
+		case "RptPgntn":
+			elem := new(Pagination)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.ReportPagination = elem
+		case "ElctrncSeqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.ElectronicSequenceNumber = (*Number)(&value)
+		case "LglSeqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.LegalSequenceNumber = (*Number)(&value)
+		case "CreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.CreationDateTime = (*ISODateTime)(&value)
+		case "FrToDt":
+			elem := new(DateTimePeriodDetails)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.FromToDate = elem
+		case "CpyDplctInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.CopyDuplicateIndicator = (*CopyDuplicate1Code)(&value)
+		case "RptgSrc":
+			elem := new(ReportingSource1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.ReportingSource = elem
+		case "Acct":
+			elem := new(CashAccount25)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Account = elem
+		case "RltdAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.RelatedAccount = elem
+		case "Intrst":
+			elem := new(AccountInterest3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Interest = append(a.Interest, elem)
+		case "Bal":
+			elem := new(CashBalance7)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Balance = append(a.Balance, elem)
+		case "TxsSummry":
+			elem := new(TotalTransactions5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.TransactionsSummary = elem
+		case "Ntry":
+			elem := new(ReportEntry8)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Entry = append(a.Entry, elem)
+		case "AddtlRptInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.AdditionalReportInformation = (*Max500Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
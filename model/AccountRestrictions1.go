@@ -25,6 +25,10 @@ type AccountRestrictions1 struct {
 	Period *DateTimePeriodDetails1 `xml:"Prd,omitempty"`
 }
 
+func (a *AccountRestrictions1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountRestrictions1) SetLimitation(value string) {
 	a.Limitation = (*Max350Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the party and owner type.
 type AccountRole1 struct {
 
@@ -16,6 +20,10 @@ type AccountRole1 struct {
 	EndDate *ISODate `xml:"EndDt,omitempty"`
 }
 
+func (a *AccountRole1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountRole1) AddParty() *PartyIdentification41 {
 	a.Party = new(PartyIdentification41)
 	return a.Party
@@ -30,6 +38,16 @@ func (a *AccountRole1) SetStartDate(value string) {
 	a.StartDate = (*ISODate)(&value)
 }
 
+func (a *AccountRole1) SetStartDateFromTime(value time.Time) {
+	a.StartDate = new(ISODate)
+	a.StartDate.FromTime(value)
+}
+
 func (a *AccountRole1) SetEndDate(value string) {
 	a.EndDate = (*ISODate)(&value)
 }
+
+func (a *AccountRole1) SetEndDateFromTime(value time.Time) {
+	a.EndDate = new(ISODate)
+	a.EndDate.FromTime(value)
+}
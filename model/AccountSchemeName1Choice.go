@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Sets of elements to identify a name of the identification scheme
 type AccountSchemeName1Choice struct {
 
@@ -10,6 +12,10 @@ type AccountSchemeName1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (a *AccountSchemeName1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountSchemeName1Choice) SetCode(value string) {
 	a.Code = (*ExternalAccountIdentification1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (a *AccountSchemeName1Choice) SetCode(value string) {
 func (a *AccountSchemeName1Choice) SetProprietary(value string) {
 	a.Proprietary = (*Max35Text)(&value)
 }
+
+func (a *AccountSchemeName1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.Code != nil {
+		enc.Element("Cd", string(*a.Code))
+	}
+	if a.Proprietary != nil {
+		enc.Element("Prtry", string(*a.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (a *AccountSchemeName1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.Code = (*ExternalAccountIdentification1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
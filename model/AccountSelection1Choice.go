@@ -10,6 +10,10 @@ type AccountSelection1Choice struct {
 	OtherAccountSelectionData *InvestmentAccount52 `xml:"OthrAcctSelctnData"`
 }
 
+func (a *AccountSelection1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountSelection1Choice) SetAccountIdentification(value string) {
 	a.AccountIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type AccountSelection2Choice struct {
 	OtherAccountSelectionData *InvestmentAccount64 `xml:"OthrAcctSelctnData"`
 }
 
+func (a *AccountSelection2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountSelection2Choice) SetAccountIdentification(value string) {
 	a.AccountIdentification = (*Max35Text)(&value)
 }
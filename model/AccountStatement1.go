@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements providing further details on the account statement.
 type AccountStatement1 struct {
 
@@ -47,6 +53,10 @@ type AccountStatement1 struct {
 	AdditionalStatementInformation *Max500Text `xml:"AddtlStmtInf,omitempty"`
 }
 
+func (a *AccountStatement1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountStatement1) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -55,21 +65,40 @@ func (a *AccountStatement1) SetElectronicSequenceNumber(value string) {
 	a.ElectronicSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountStatement1) SetElectronicSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.ElectronicSequenceNumber = new(Number)
+	a.ElectronicSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountStatement1) SetLegalSequenceNumber(value string) {
 	a.LegalSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountStatement1) SetLegalSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.LegalSequenceNumber = new(Number)
+	a.LegalSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountStatement1) SetCreationDateTime(value string) {
 	a.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (a *AccountStatement1) SetCreationDateTimeFromTime(value time.Time) {
+	a.CreationDateTime = new(ISODateTime)
+	a.CreationDateTime.FromTime(value)
+}
+
 func (a *AccountStatement1) AddFromToDate() *DateTimePeriodDetails {
 	a.FromToDate = new(DateTimePeriodDetails)
 	return a.FromToDate
 }
 
-func (a *AccountStatement1) SetCopyDuplicateIndicator(value string) {
+func (a *AccountStatement1) SetCopyDuplicateIndicator(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	a.CopyDuplicateIndicator = (*CopyDuplicate1Code)(&value)
+	return nil
 }
 
 func (a *AccountStatement1) AddAccount() *CashAccount13 {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide details of the account statement.
 type AccountStatement2 struct {
 
@@ -50,6 +56,10 @@ type AccountStatement2 struct {
 	AdditionalStatementInformation *Max500Text `xml:"AddtlStmtInf,omitempty"`
 }
 
+func (a *AccountStatement2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountStatement2) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -58,21 +68,40 @@ func (a *AccountStatement2) SetElectronicSequenceNumber(value string) {
 	a.ElectronicSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountStatement2) SetElectronicSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.ElectronicSequenceNumber = new(Number)
+	a.ElectronicSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountStatement2) SetLegalSequenceNumber(value string) {
 	a.LegalSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountStatement2) SetLegalSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.LegalSequenceNumber = new(Number)
+	a.LegalSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountStatement2) SetCreationDateTime(value string) {
 	a.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (a *AccountStatement2) SetCreationDateTimeFromTime(value time.Time) {
+	a.CreationDateTime = new(ISODateTime)
+	a.CreationDateTime.FromTime(value)
+}
+
 func (a *AccountStatement2) AddFromToDate() *DateTimePeriodDetails {
 	a.FromToDate = new(DateTimePeriodDetails)
 	return a.FromToDate
 }
 
-func (a *AccountStatement2) SetCopyDuplicateIndicator(value string) {
+func (a *AccountStatement2) SetCopyDuplicateIndicator(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	a.CopyDuplicateIndicator = (*CopyDuplicate1Code)(&value)
+	return nil
 }
 
 func (a *AccountStatement2) AddReportingSource() *ReportingSource1Choice {
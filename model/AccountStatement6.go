@@ -1,5 +1,12 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides further details of the account statement.
 type AccountStatement6 struct {
 
@@ -61,6 +68,10 @@ type AccountStatement6 struct {
 	AdditionalStatementInformation *Max500Text `xml:"AddtlStmtInf,omitempty"`
 }
 
+func (a *AccountStatement6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountStatement6) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -74,21 +85,40 @@ func (a *AccountStatement6) SetElectronicSequenceNumber(value string) {
 	a.ElectronicSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountStatement6) SetElectronicSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.ElectronicSequenceNumber = new(Number)
+	a.ElectronicSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountStatement6) SetLegalSequenceNumber(value string) {
 	a.LegalSequenceNumber = (*Number)(&value)
 }
 
+func (a *AccountStatement6) SetLegalSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.LegalSequenceNumber = new(Number)
+	a.LegalSequenceNumber.FromDecimal(value)
+}
+
 func (a *AccountStatement6) SetCreationDateTime(value string) {
 	a.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (a *AccountStatement6) SetCreationDateTimeFromTime(value time.Time) {
+	a.CreationDateTime = new(ISODateTime)
+	a.CreationDateTime.FromTime(value)
+}
+
 func (a *AccountStatement6) AddFromToDate() *DateTimePeriodDetails {
 	a.FromToDate = new(DateTimePeriodDetails)
 	return a.FromToDate
 }
 
-func (a *AccountStatement6) SetCopyDuplicateIndicator(value string) {
+func (a *AccountStatement6) SetCopyDuplicateIndicator(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	a.CopyDuplicateIndicator = (*CopyDuplicate1Code)(&value)
+	return nil
 }
 
 func (a *AccountStatement6) AddReportingSource() *ReportingSource1Choice {
@@ -552,3 +582,167 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (a *AccountStatement6) SetAdditionalStatementInformation(value string) {
 	a.AdditionalStatementInformation = (*Max500Text)(&value)
 }
+
+func (a *AccountStatement6) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.Identification != nil {
+		enc.Element("Id", string(*a.Identification))
+	}
+	if a.StatementPagination != nil {
+		a.StatementPagination.EncodeXMLFast(enc, "StmtPgntn")
+	}
+	if a.ElectronicSequenceNumber != nil {
+		enc.Element("ElctrncSeqNb", string(*a.ElectronicSequenceNumber))
+	}
+	if a.LegalSequenceNumber != nil {
+		enc.Element("LglSeqNb", string(*a.LegalSequenceNumber))
+	}
+	if a.CreationDateTime != nil {
+		enc.Element("CreDtTm", string(*a.CreationDateTime))
+	}
+	if a.FromToDate != nil {
+		a.FromToDate.EncodeXMLFast(enc, "FrToDt")
+	}
+	if a.CopyDuplicateIndicator != nil {
+		enc.Element("CpyDplctInd", string(*a.CopyDuplicateIndicator))
+	}
+	if a.ReportingSource != nil {
+		a.ReportingSource.EncodeXMLFast(enc, "RptgSrc")
+	}
+	if a.Account != nil {
+		a.Account.EncodeXMLFast(enc, "Acct")
+	}
+	if a.RelatedAccount != nil {
+		a.RelatedAccount.EncodeXMLFast(enc, "RltdAcct")
+	}
+	for _, elem := range a.Interest {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Intrst")
+		}
+	}
+	for _, elem := range a.Balance {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Bal")
+		}
+	}
+	if a.TransactionsSummary != nil {
+		a.TransactionsSummary.EncodeXMLFast(enc, "TxsSummry")
+	}
+	for _, elem := range a.Entry {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Ntry")
+		}
+	}
+	if a.AdditionalStatementInformation != nil {
+		enc.Element("AddtlStmtInf", string(*a.AdditionalStatementInformation))
+	}
+	enc.End(tag)
+}
+
+func (a *AccountStatement6) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.Identification = (*Max35Text)(&value)
+		case "StmtPgntn":
+			elem := new(Pagination)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.StatementPagination = elem
+		case "ElctrncSeqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.ElectronicSequenceNumber = (*Number)(&value)
+		case "LglSeqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.LegalSequenceNumber = (*Number)(&value)
+		case "CreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.CreationDateTime = (*ISODateTime)(&value)
+		case "FrToDt":
+			elem := new(DateTimePeriodDetails)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.FromToDate = elem
+		case "CpyDplctInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.CopyDuplicateIndicator = (*CopyDuplicate1Code)(&value)
+		case "RptgSrc":
+			elem := new(ReportingSource1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.ReportingSource = elem
+		case "Acct":
+			elem := new(CashAccount25)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Account = elem
+		case "RltdAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.RelatedAccount = elem
+		case "Intrst":
+			elem := new(AccountInterest3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Interest = append(a.Interest, elem)
+		case "Bal":
+			elem := new(CashBalance7)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Balance = append(a.Balance, elem)
+		case "TxsSummry":
+			elem := new(TotalTransactions5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.TransactionsSummary = elem
+		case "Ntry":
+			elem := new(ReportEntry8)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Entry = append(a.Entry, elem)
+		case "AddtlStmtInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.AdditionalStatementInformation = (*Max500Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
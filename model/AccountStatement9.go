@@ -0,0 +1,345 @@
+package model
+
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Provides further details of the account statement.
+type AccountStatement9 struct {
+
+	// Unique identification, as assigned by the account servicer, to unambiguously identify the account statement.
+	Identification *Max35Text `xml:"Id"`
+
+	// Provides details on the page number of the statement.
+	//
+	// Usage: The pagination of the statement is only allowed when agreed between the parties.
+	StatementPagination *Pagination1 `xml:"StmtPgntn,omitempty"`
+
+	// Sequential number of the statement, as assigned by the account servicer.
+	// Usage: The sequential number is increased incrementally for each statement sent electronically.
+	ElectronicSequenceNumber *Number `xml:"ElctrncSeqNb,omitempty"`
+
+	// Specifies the range of identification sequence numbers, as provided in the request.
+	ReportingSequence *SequenceRange1Choice `xml:"RptgSeq,omitempty"`
+
+	// Legal sequential number of the statement, as assigned by the account servicer. It is increased incrementally for each statement sent.
+	//
+	// Usage: Where a paper statement is a legal requirement, it may have a number different from the electronic sequential number. Paper statements could for instance only be sent if movement on the account has taken place, whereas electronic statements could be sent at the end of each reporting period, regardless of whether movements have taken place or not.
+	LegalSequenceNumber *Number `xml:"LglSeqNb,omitempty"`
+
+	// Date and time at which the message was created.
+	CreationDateTime *ISODateTime `xml:"CreDtTm,omitempty"`
+
+	// Range of time between a start date and an end date for which the account statement is issued.
+	FromToDate *DateTimePeriod1 `xml:"FrToDt,omitempty"`
+
+	// Indicates whether the document is a copy, a duplicate, or a duplicate of a copy.
+	CopyDuplicateIndicator *CopyDuplicate1Code `xml:"CpyDplctInd,omitempty"`
+
+	// Specifies the application used to generate the reporting.
+	ReportingSource *ReportingSource1Choice `xml:"RptgSrc,omitempty"`
+
+	// Unambiguous identification of the account to which credit and debit entries are made.
+	Account *CashAccount39 `xml:"Acct"`
+
+	// Identifies the parent account of the account for which the statement has been issued.
+	RelatedAccount *CashAccount38 `xml:"RltdAcct,omitempty"`
+
+	// Provides general interest information that applies to the account at a particular moment in time.
+	Interest []*AccountInterest4 `xml:"Intrst,omitempty"`
+
+	// Set of elements used to define the balance as a numerical representation of the net increases and decreases in an account at a specific point in time.
+	Balance []*CashBalance8 `xml:"Bal"`
+
+	// Provides summary information on entries.
+	TransactionsSummary *TotalTransactions6 `xml:"TxsSummry,omitempty"`
+
+	// Specify an entry in the statement.
+	// Usage: At least one reference must be provided to identify the entry and its underlying transaction(s).
+	//
+	//
+	// Usage Rule:  In case of a Payments R-transaction the creditor / debtor referenced of the original payment initiation messages is also used for reporting of the R-transaction. The original debtor/creditor in the reporting of R-Transactions is not inverted.
+	// Following elements all defined in the TransactionDetails in RelatedParties or RelatedAgents are impacted by this usage rule:
+	// Creditor, UltimateCreditor, CreditorAccount, CreditorAgent, Debtor, UltimateDebtor, DebtorAccount and DebtorAgent.
+	//
+	Entry []*ReportEntry10 `xml:"Ntry,omitempty"`
+
+	// Further details of the account statement.
+	AdditionalStatementInformation *Max500Text `xml:"AddtlStmtInf,omitempty"`
+}
+
+func (a *AccountStatement9) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AccountStatement9) SetIdentification(value string) {
+	a.Identification = (*Max35Text)(&value)
+}
+
+func (a *AccountStatement9) AddStatementPagination() *Pagination1 {
+	a.StatementPagination = new(Pagination1)
+	return a.StatementPagination
+}
+
+func (a *AccountStatement9) SetElectronicSequenceNumber(value string) {
+	a.ElectronicSequenceNumber = (*Number)(&value)
+}
+
+func (a *AccountStatement9) SetElectronicSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.ElectronicSequenceNumber = new(Number)
+	a.ElectronicSequenceNumber.FromDecimal(value)
+}
+
+func (a *AccountStatement9) AddReportingSequence() *SequenceRange1Choice {
+	a.ReportingSequence = new(SequenceRange1Choice)
+	return a.ReportingSequence
+}
+
+func (a *AccountStatement9) SetLegalSequenceNumber(value string) {
+	a.LegalSequenceNumber = (*Number)(&value)
+}
+
+func (a *AccountStatement9) SetLegalSequenceNumberFromDecimal(value decimal.Decimal) {
+	a.LegalSequenceNumber = new(Number)
+	a.LegalSequenceNumber.FromDecimal(value)
+}
+
+func (a *AccountStatement9) SetCreationDateTime(value string) {
+	a.CreationDateTime = (*ISODateTime)(&value)
+}
+
+func (a *AccountStatement9) SetCreationDateTimeFromTime(value time.Time) {
+	a.CreationDateTime = new(ISODateTime)
+	a.CreationDateTime.FromTime(value)
+}
+
+func (a *AccountStatement9) AddFromToDate() *DateTimePeriod1 {
+	a.FromToDate = new(DateTimePeriod1)
+	return a.FromToDate
+}
+
+func (a *AccountStatement9) SetCopyDuplicateIndicator(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
+	a.CopyDuplicateIndicator = (*CopyDuplicate1Code)(&value)
+	return nil
+}
+
+func (a *AccountStatement9) AddReportingSource() *ReportingSource1Choice {
+	a.ReportingSource = new(ReportingSource1Choice)
+	return a.ReportingSource
+}
+
+func (a *AccountStatement9) AddAccount() *CashAccount39 {
+	a.Account = new(CashAccount39)
+	return a.Account
+}
+
+func (a *AccountStatement9) AddRelatedAccount() *CashAccount38 {
+	a.RelatedAccount = new(CashAccount38)
+	return a.RelatedAccount
+}
+
+func (a *AccountStatement9) AddInterest() *AccountInterest4 {
+	newValue := new(AccountInterest4)
+	a.Interest = append(a.Interest, newValue)
+	return newValue
+}
+
+func (a *AccountStatement9) AddBalance() *CashBalance8 {
+	newValue := new(CashBalance8)
+	a.Balance = append(a.Balance, newValue)
+	return newValue
+}
+
+func (a *AccountStatement9) AddTransactionsSummary() *TotalTransactions6 {
+	a.TransactionsSummary = new(TotalTransactions6)
+	return a.TransactionsSummary
+}
+
+func (a *AccountStatement9) AddEntry() *ReportEntry10 {
+	newValue := new(ReportEntry10)
+	a.Entry = append(a.Entry, newValue)
+	return newValue
+}
+
+func (a *AccountStatement9) SetAdditionalStatementInformation(value string) {
+	a.AdditionalStatementInformation = (*Max500Text)(&value)
+}
+
+func (a *AccountStatement9) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.Identification != nil {
+		enc.Element("Id", string(*a.Identification))
+	}
+	if a.StatementPagination != nil {
+		a.StatementPagination.EncodeXMLFast(enc, "StmtPgntn")
+	}
+	if a.ElectronicSequenceNumber != nil {
+		enc.Element("ElctrncSeqNb", string(*a.ElectronicSequenceNumber))
+	}
+	if a.ReportingSequence != nil {
+		a.ReportingSequence.EncodeXMLFast(enc, "RptgSeq")
+	}
+	if a.LegalSequenceNumber != nil {
+		enc.Element("LglSeqNb", string(*a.LegalSequenceNumber))
+	}
+	if a.CreationDateTime != nil {
+		enc.Element("CreDtTm", string(*a.CreationDateTime))
+	}
+	if a.FromToDate != nil {
+		a.FromToDate.EncodeXMLFast(enc, "FrToDt")
+	}
+	if a.CopyDuplicateIndicator != nil {
+		enc.Element("CpyDplctInd", string(*a.CopyDuplicateIndicator))
+	}
+	if a.ReportingSource != nil {
+		a.ReportingSource.EncodeXMLFast(enc, "RptgSrc")
+	}
+	if a.Account != nil {
+		a.Account.EncodeXMLFast(enc, "Acct")
+	}
+	if a.RelatedAccount != nil {
+		a.RelatedAccount.EncodeXMLFast(enc, "RltdAcct")
+	}
+	for _, elem := range a.Interest {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Intrst")
+		}
+	}
+	for _, elem := range a.Balance {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Bal")
+		}
+	}
+	if a.TransactionsSummary != nil {
+		a.TransactionsSummary.EncodeXMLFast(enc, "TxsSummry")
+	}
+	for _, elem := range a.Entry {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Ntry")
+		}
+	}
+	if a.AdditionalStatementInformation != nil {
+		enc.Element("AddtlStmtInf", string(*a.AdditionalStatementInformation))
+	}
+	enc.End(tag)
+}
+
+func (a *AccountStatement9) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.Identification = (*Max35Text)(&value)
+		case "StmtPgntn":
+			elem := new(Pagination1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.StatementPagination = elem
+		case "ElctrncSeqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.ElectronicSequenceNumber = (*Number)(&value)
+		case "RptgSeq":
+			elem := new(SequenceRange1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.ReportingSequence = elem
+		case "LglSeqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.LegalSequenceNumber = (*Number)(&value)
+		case "CreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.CreationDateTime = (*ISODateTime)(&value)
+		case "FrToDt":
+			elem := new(DateTimePeriod1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.FromToDate = elem
+		case "CpyDplctInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.CopyDuplicateIndicator = (*CopyDuplicate1Code)(&value)
+		case "RptgSrc":
+			elem := new(ReportingSource1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.ReportingSource = elem
+		case "Acct":
+			elem := new(CashAccount39)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Account = elem
+		case "RltdAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.RelatedAccount = elem
+		case "Intrst":
+			elem := new(AccountInterest4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Interest = append(a.Interest, elem)
+		case "Bal":
+			elem := new(CashBalance8)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Balance = append(a.Balance, elem)
+		case "TxsSummry":
+			elem := new(TotalTransactions6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.TransactionsSummary = elem
+		case "Ntry":
+			elem := new(ReportEntry10)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Entry = append(a.Entry, elem)
+		case "AddtlStmtInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.AdditionalStatementInformation = (*Max500Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ type AccountStatus1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AccountStatus1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountStatus1Choice) SetCode(value string) {
 	a.Code = (*AccountStatus4Code)(&value)
 }
@@ -28,6 +28,10 @@ type AccountStatus2 struct {
 	Other []*OtherAccountStatus1 `xml:"Othr,omitempty"`
 }
 
+func (a *AccountStatus2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountStatus2) AddEnabled() *EnabledStatusReason1Choice {
 	a.Enabled = new(EnabledStatusReason1Choice)
 	return a.Enabled
@@ -10,6 +10,10 @@ type AccountStatusModification1 struct {
 	Status *AccountStatus3Code `xml:"Sts"`
 }
 
+func (a *AccountStatusModification1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountStatusModification1) SetModificationCode(value string) {
 	a.ModificationCode = (*Modification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type AccountStatusUpdateInstruction1 struct {
 	UpdateInstructionReason *AccountStatusUpdateInstructionReason1Choice `xml:"UpdInstrRsn,omitempty"`
 }
 
+func (a *AccountStatusUpdateInstruction1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountStatusUpdateInstruction1) AddUpdateInstruction() *AccountStatusUpdateInstruction1Choice {
 	a.UpdateInstruction = new(AccountStatusUpdateInstruction1Choice)
 	return a.UpdateInstruction
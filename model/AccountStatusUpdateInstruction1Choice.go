@@ -11,6 +11,10 @@ type AccountStatusUpdateInstruction1Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (a *AccountStatusUpdateInstruction1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountStatusUpdateInstruction1Choice) SetCode(value string) {
 	a.Code = (*AccountStatusUpdateInstruction1Code)(&value)
 }
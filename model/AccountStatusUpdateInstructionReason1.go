@@ -10,6 +10,10 @@ type AccountStatusUpdateInstructionReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (a *AccountStatusUpdateInstructionReason1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountStatusUpdateInstructionReason1) AddCode() *AccountStatusUpdateInstructionReason2Choice {
 	a.Code = new(AccountStatusUpdateInstructionReason2Choice)
 	return a.Code
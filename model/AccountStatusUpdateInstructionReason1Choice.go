@@ -10,8 +10,16 @@ type AccountStatusUpdateInstructionReason1Choice struct {
 	Reason []*AccountStatusUpdateInstructionReason1 `xml:"Rsn"`
 }
 
-func (a *AccountStatusUpdateInstructionReason1Choice) SetNoSpecifiedReason(value string) {
+func (a *AccountStatusUpdateInstructionReason1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AccountStatusUpdateInstructionReason1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AccountStatusUpdateInstructionReason1Choice) AddReason() *AccountStatusUpdateInstructionReason1 {
@@ -10,6 +10,10 @@ type AccountStatusUpdateInstructionReason2Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (a *AccountStatusUpdateInstructionReason2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountStatusUpdateInstructionReason2Choice) SetCode(value string) {
 	a.Code = (*AccountStatusUpdateRequestReason1Code)(&value)
 }
@@ -28,6 +28,10 @@ type AccountSubLevel12 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountSubLevel12) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountSubLevel12) AddAccountIdentification() *SecuritiesAccount19 {
 	a.AccountIdentification = new(SecuritiesAccount19)
 	return a.AccountIdentification
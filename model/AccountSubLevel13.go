@@ -28,6 +28,10 @@ type AccountSubLevel13 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountSubLevel13) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountSubLevel13) AddAccountIdentification() *SecuritiesAccount19 {
 	a.AccountIdentification = new(SecuritiesAccount19)
 	return a.AccountIdentification
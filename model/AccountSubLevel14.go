@@ -28,6 +28,10 @@ type AccountSubLevel14 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountSubLevel14) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountSubLevel14) AddAccountIdentification() *SecuritiesAccount19 {
 	a.AccountIdentification = new(SecuritiesAccount19)
 	return a.AccountIdentification
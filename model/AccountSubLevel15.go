@@ -28,6 +28,10 @@ type AccountSubLevel15 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountSubLevel15) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountSubLevel15) AddAccountIdentification() *SecuritiesAccount19 {
 	a.AccountIdentification = new(SecuritiesAccount19)
 	return a.AccountIdentification
@@ -28,6 +28,10 @@ type AccountSubLevel16 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountSubLevel16) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountSubLevel16) AddAccountIdentification() *SecuritiesAccount19 {
 	a.AccountIdentification = new(SecuritiesAccount19)
 	return a.AccountIdentification
@@ -28,6 +28,10 @@ type AccountSubLevel17 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountSubLevel17) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountSubLevel17) AddAccountIdentification() *SecuritiesAccount19 {
 	a.AccountIdentification = new(SecuritiesAccount19)
 	return a.AccountIdentification
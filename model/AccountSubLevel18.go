@@ -28,6 +28,10 @@ type AccountSubLevel18 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountSubLevel18) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountSubLevel18) AddAccountIdentification() *SecuritiesAccount19 {
 	a.AccountIdentification = new(SecuritiesAccount19)
 	return a.AccountIdentification
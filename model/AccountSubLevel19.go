@@ -22,6 +22,10 @@ type AccountSubLevel19 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AccountSubLevel19) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountSubLevel19) AddAccountIdentification() *SecuritiesAccount19 {
 	a.AccountIdentification = new(SecuritiesAccount19)
 	return a.AccountIdentification
@@ -15,6 +15,10 @@ type AccountTax1 struct {
 	NonResidenceCountry *ResidenceLocation1Choice `xml:"NonResCtry,omitempty"`
 }
 
+func (a *AccountTax1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountTax1) SetCalculationMethod(value string) {
 	a.CalculationMethod = (*BillingTaxCalculationMethod1Code)(&value)
 }
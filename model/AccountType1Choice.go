@@ -10,6 +10,10 @@ type AccountType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AccountType1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountType1Choice) SetCode(value string) {
 	a.Code = (*FundCashAccount3Code)(&value)
 }
@@ -10,6 +10,10 @@ type AccountType2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AccountType2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountType2Choice) SetCode(value string) {
 	a.Code = (*FundCashAccount4Code)(&value)
 }
@@ -10,6 +10,10 @@ type AccountUsageType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AccountUsageType1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountUsageType1Choice) SetCode(value string) {
 	a.Code = (*AccountUsageType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type AccountUsageType2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AccountUsageType2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountUsageType2Choice) SetCode(value string) {
 	a.Code = (*AccountUsageType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type AccountingAccount1 struct {
 	CostReferencePattern *Max35Text `xml:"CostRefPttrn,omitempty"`
 }
 
+func (a *AccountingAccount1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountingAccount1) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type AccountingStatementOfHoldings1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountingStatementOfHoldings1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountingStatementOfHoldings1) AddMessagePagination() *Pagination {
 	a.MessagePagination = new(Pagination)
 	return a.MessagePagination
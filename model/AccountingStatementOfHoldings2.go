@@ -22,6 +22,10 @@ type AccountingStatementOfHoldings2 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AccountingStatementOfHoldings2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountingStatementOfHoldings2) AddStatementGeneralDetails() *Statement6 {
 	a.StatementGeneralDetails = new(Statement6)
 	return a.StatementGeneralDetails
@@ -10,6 +10,10 @@ type AccountingStatus1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AccountingStatus1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AccountingStatus1Choice) SetCode(value string) {
 	a.Code = (*AccountingStatus1Code)(&value)
 }
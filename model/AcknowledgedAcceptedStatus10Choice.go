@@ -10,8 +10,16 @@ type AcknowledgedAcceptedStatus10Choice struct {
 	Reason []*AcknowledgementReason2 `xml:"Rsn"`
 }
 
-func (a *AcknowledgedAcceptedStatus10Choice) SetNoSpecifiedReason(value string) {
+func (a *AcknowledgedAcceptedStatus10Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcknowledgedAcceptedStatus10Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcknowledgedAcceptedStatus10Choice) AddReason() *AcknowledgementReason2 {
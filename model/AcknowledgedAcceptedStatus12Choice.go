@@ -10,8 +10,16 @@ type AcknowledgedAcceptedStatus12Choice struct {
 	Reason []*AcknowledgementReason1 `xml:"Rsn"`
 }
 
-func (a *AcknowledgedAcceptedStatus12Choice) SetNoSpecifiedReason(value string) {
+func (a *AcknowledgedAcceptedStatus12Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcknowledgedAcceptedStatus12Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcknowledgedAcceptedStatus12Choice) AddReason() *AcknowledgementReason1 {
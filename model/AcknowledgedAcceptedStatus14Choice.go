@@ -10,8 +10,16 @@ type AcknowledgedAcceptedStatus14Choice struct {
 	Reason []*AcknowledgementReason7 `xml:"Rsn"`
 }
 
-func (a *AcknowledgedAcceptedStatus14Choice) SetNoSpecifiedReason(value string) {
+func (a *AcknowledgedAcceptedStatus14Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcknowledgedAcceptedStatus14Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcknowledgedAcceptedStatus14Choice) AddReason() *AcknowledgementReason7 {
@@ -10,8 +10,16 @@ type AcknowledgedAcceptedStatus1Choice struct {
 	Reason []*AcknowledgementReason1 `xml:"Rsn,omitempty"`
 }
 
-func (a *AcknowledgedAcceptedStatus1Choice) SetNoSpecifiedReason(value string) {
+func (a *AcknowledgedAcceptedStatus1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcknowledgedAcceptedStatus1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcknowledgedAcceptedStatus1Choice) AddReason() *AcknowledgementReason1 {
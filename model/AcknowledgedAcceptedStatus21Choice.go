@@ -10,8 +10,16 @@ type AcknowledgedAcceptedStatus21Choice struct {
 	Reason []*AcknowledgementReason9 `xml:"Rsn"`
 }
 
-func (a *AcknowledgedAcceptedStatus21Choice) SetNoSpecifiedReason(value string) {
+func (a *AcknowledgedAcceptedStatus21Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcknowledgedAcceptedStatus21Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcknowledgedAcceptedStatus21Choice) AddReason() *AcknowledgementReason9 {
@@ -10,8 +10,16 @@ type AcknowledgedAcceptedStatus22Choice struct {
 	Reason []*AcknowledgementReason10 `xml:"Rsn"`
 }
 
-func (a *AcknowledgedAcceptedStatus22Choice) SetNoSpecifiedReason(value string) {
+func (a *AcknowledgedAcceptedStatus22Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcknowledgedAcceptedStatus22Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcknowledgedAcceptedStatus22Choice) AddReason() *AcknowledgementReason10 {
@@ -10,8 +10,16 @@ type AcknowledgedAcceptedStatus23Choice struct {
 	Reason []*AcknowledgementReason11 `xml:"Rsn"`
 }
 
-func (a *AcknowledgedAcceptedStatus23Choice) SetNoSpecifiedReason(value string) {
+func (a *AcknowledgedAcceptedStatus23Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcknowledgedAcceptedStatus23Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcknowledgedAcceptedStatus23Choice) AddReason() *AcknowledgementReason11 {
@@ -10,8 +10,16 @@ type AcknowledgedAcceptedStatus24Choice struct {
 	Reason []*AcknowledgementReason12 `xml:"Rsn"`
 }
 
-func (a *AcknowledgedAcceptedStatus24Choice) SetNoSpecifiedReason(value string) {
+func (a *AcknowledgedAcceptedStatus24Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcknowledgedAcceptedStatus24Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcknowledgedAcceptedStatus24Choice) AddReason() *AcknowledgementReason12 {
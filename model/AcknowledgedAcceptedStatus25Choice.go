@@ -10,8 +10,16 @@ type AcknowledgedAcceptedStatus25Choice struct {
 	Reason []*AcknowledgementReason13 `xml:"Rsn"`
 }
 
-func (a *AcknowledgedAcceptedStatus25Choice) SetNoSpecifiedReason(value string) {
+func (a *AcknowledgedAcceptedStatus25Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcknowledgedAcceptedStatus25Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcknowledgedAcceptedStatus25Choice) AddReason() *AcknowledgementReason13 {
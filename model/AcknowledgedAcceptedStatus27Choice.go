@@ -10,8 +10,16 @@ type AcknowledgedAcceptedStatus27Choice struct {
 	Reason []*AcknowledgementReason15 `xml:"Rsn"`
 }
 
-func (a *AcknowledgedAcceptedStatus27Choice) SetNoSpecifiedReason(value string) {
+func (a *AcknowledgedAcceptedStatus27Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcknowledgedAcceptedStatus27Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcknowledgedAcceptedStatus27Choice) AddReason() *AcknowledgementReason15 {
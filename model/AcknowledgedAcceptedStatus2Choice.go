@@ -10,8 +10,16 @@ type AcknowledgedAcceptedStatus2Choice struct {
 	Reason []*AcknowledgementReason2 `xml:"Rsn,omitempty"`
 }
 
-func (a *AcknowledgedAcceptedStatus2Choice) SetNoSpecifiedReason(value string) {
+func (a *AcknowledgedAcceptedStatus2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcknowledgedAcceptedStatus2Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcknowledgedAcceptedStatus2Choice) AddReason() *AcknowledgementReason2 {
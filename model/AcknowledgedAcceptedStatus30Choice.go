@@ -10,8 +10,16 @@ type AcknowledgedAcceptedStatus30Choice struct {
 	Reason []*AcknowledgementReason18 `xml:"Rsn"`
 }
 
-func (a *AcknowledgedAcceptedStatus30Choice) SetNoSpecifiedReason(value string) {
+func (a *AcknowledgedAcceptedStatus30Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcknowledgedAcceptedStatus30Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcknowledgedAcceptedStatus30Choice) AddReason() *AcknowledgementReason18 {
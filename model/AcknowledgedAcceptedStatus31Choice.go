@@ -10,8 +10,16 @@ type AcknowledgedAcceptedStatus31Choice struct {
 	Reason []*AcknowledgementReason19 `xml:"Rsn"`
 }
 
-func (a *AcknowledgedAcceptedStatus31Choice) SetNoSpecifiedReason(value string) {
+func (a *AcknowledgedAcceptedStatus31Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcknowledgedAcceptedStatus31Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcknowledgedAcceptedStatus31Choice) AddReason() *AcknowledgementReason19 {
@@ -10,8 +10,16 @@ type AcknowledgedAcceptedStatus3Choice struct {
 	Reason []*AcknowledgementReason3 `xml:"Rsn,omitempty"`
 }
 
-func (a *AcknowledgedAcceptedStatus3Choice) SetNoSpecifiedReason(value string) {
+func (a *AcknowledgedAcceptedStatus3Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcknowledgedAcceptedStatus3Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcknowledgedAcceptedStatus3Choice) AddReason() *AcknowledgementReason3 {
@@ -10,8 +10,16 @@ type AcknowledgedAcceptedStatus7Choice struct {
 	Reason []*AcknowledgementReason3 `xml:"Rsn"`
 }
 
-func (a *AcknowledgedAcceptedStatus7Choice) SetNoSpecifiedReason(value string) {
+func (a *AcknowledgedAcceptedStatus7Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AcknowledgedAcceptedStatus7Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (a *AcknowledgedAcceptedStatus7Choice) AddReason() *AcknowledgementReason3 {
@@ -11,6 +11,10 @@ type AcknowledgementDetails1Choice struct {
 	PayInCallReference *Max35Text `xml:"PayInCallRef"`
 }
 
+func (a *AcknowledgementDetails1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementDetails1Choice) SetPayInScheduleReference(value string) {
 	a.PayInScheduleReference = (*Max35Text)(&value)
 }
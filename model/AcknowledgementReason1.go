@@ -10,6 +10,10 @@ type AcknowledgementReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcknowledgementReason1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason1) AddCode() *AcknowledgementReason1Choice {
 	a.Code = new(AcknowledgementReason1Choice)
 	return a.Code
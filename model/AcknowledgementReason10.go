@@ -10,6 +10,10 @@ type AcknowledgementReason10 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcknowledgementReason10) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason10) AddCode() *AcknowledgementReason13Choice {
 	a.Code = new(AcknowledgementReason13Choice)
 	return a.Code
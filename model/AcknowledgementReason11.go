@@ -10,6 +10,10 @@ type AcknowledgementReason11 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcknowledgementReason11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason11) AddCode() *AcknowledgementReason14Choice {
 	a.Code = new(AcknowledgementReason14Choice)
 	return a.Code
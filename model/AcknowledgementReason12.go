@@ -10,6 +10,10 @@ type AcknowledgementReason12 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcknowledgementReason12) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason12) AddCode() *AcknowledgementReason15Choice {
 	a.Code = new(AcknowledgementReason15Choice)
 	return a.Code
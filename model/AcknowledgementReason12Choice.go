@@ -10,6 +10,10 @@ type AcknowledgementReason12Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (a *AcknowledgementReason12Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason12Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason5Code)(&value)
 }
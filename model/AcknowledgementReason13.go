@@ -10,6 +10,10 @@ type AcknowledgementReason13 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcknowledgementReason13) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason13) AddCode() *AcknowledgementReason16Choice {
 	a.Code = new(AcknowledgementReason16Choice)
 	return a.Code
@@ -10,6 +10,10 @@ type AcknowledgementReason13Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (a *AcknowledgementReason13Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason13Choice) SetCode(value string) {
 	a.Code = (*RepoCallAcknowledgementReason2Code)(&value)
 }
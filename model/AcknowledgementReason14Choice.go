@@ -10,6 +10,10 @@ type AcknowledgementReason14Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (a *AcknowledgementReason14Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason14Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason6Code)(&value)
 }
@@ -10,6 +10,10 @@ type AcknowledgementReason15 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcknowledgementReason15) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason15) AddCode() *AcknowledgementReason18Choice {
 	a.Code = new(AcknowledgementReason18Choice)
 	return a.Code
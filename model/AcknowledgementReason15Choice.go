@@ -10,6 +10,10 @@ type AcknowledgementReason15Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (a *AcknowledgementReason15Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason15Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason3Code)(&value)
 }
@@ -10,6 +10,10 @@ type AcknowledgementReason16Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AcknowledgementReason16Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason16Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason5Code)(&value)
 }
@@ -10,6 +10,10 @@ type AcknowledgementReason18 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcknowledgementReason18) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason18) AddCode() *AcknowledgementReason21Choice {
 	a.Code = new(AcknowledgementReason21Choice)
 	return a.Code
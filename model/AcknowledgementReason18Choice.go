@@ -10,6 +10,10 @@ type AcknowledgementReason18Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AcknowledgementReason18Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason18Choice) SetCode(value string) {
 	a.Code = (*RepoCallAcknowledgementReason2Code)(&value)
 }
@@ -10,6 +10,10 @@ type AcknowledgementReason19 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcknowledgementReason19) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason19) AddCode() *AcknowledgementReason22Choice {
 	a.Code = new(AcknowledgementReason22Choice)
 	return a.Code
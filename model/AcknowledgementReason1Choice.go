@@ -10,6 +10,10 @@ type AcknowledgementReason1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AcknowledgementReason1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason1Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason3Code)(&value)
 }
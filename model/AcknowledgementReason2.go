@@ -10,6 +10,10 @@ type AcknowledgementReason2 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcknowledgementReason2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason2) AddCode() *AcknowledgementReason3Choice {
 	a.Code = new(AcknowledgementReason3Choice)
 	return a.Code
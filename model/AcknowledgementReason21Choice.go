@@ -10,6 +10,10 @@ type AcknowledgementReason21Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AcknowledgementReason21Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason21Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason6Code)(&value)
 }
@@ -10,6 +10,10 @@ type AcknowledgementReason22Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AcknowledgementReason22Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason22Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason3Code)(&value)
 }
@@ -10,6 +10,10 @@ type AcknowledgementReason2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AcknowledgementReason2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason2Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason5Code)(&value)
 }
@@ -10,6 +10,10 @@ type AcknowledgementReason3 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcknowledgementReason3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason3) AddCode() *AcknowledgementReason4Choice {
 	a.Code = new(AcknowledgementReason4Choice)
 	return a.Code
@@ -10,6 +10,10 @@ type AcknowledgementReason3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AcknowledgementReason3Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason3Choice) SetCode(value string) {
 	a.Code = (*RepoCallAcknowledgementReason2Code)(&value)
 }
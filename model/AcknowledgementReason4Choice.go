@@ -10,6 +10,10 @@ type AcknowledgementReason4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AcknowledgementReason4Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason4Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason5Code)(&value)
 }
@@ -10,6 +10,10 @@ type AcknowledgementReason7 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcknowledgementReason7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason7) AddCode() *AcknowledgementReason9Choice {
 	a.Code = new(AcknowledgementReason9Choice)
 	return a.Code
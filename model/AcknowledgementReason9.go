@@ -10,6 +10,10 @@ type AcknowledgementReason9 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AcknowledgementReason9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason9) AddCode() *AcknowledgementReason12Choice {
 	a.Code = new(AcknowledgementReason12Choice)
 	return a.Code
@@ -10,6 +10,10 @@ type AcknowledgementReason9Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AcknowledgementReason9Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcknowledgementReason9Choice) SetCode(value string) {
 	a.Code = (*AcknowledgementReason6Code)(&value)
 }
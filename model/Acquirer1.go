@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Acquirer involved in the card payment.
 type Acquirer1 struct {
 
@@ -10,6 +14,10 @@ type Acquirer1 struct {
 	ParametersVersion *ISODateTime `xml:"ParamsVrsn"`
 }
 
+func (a *Acquirer1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Acquirer1) AddIdentification() *GenericIdentification32 {
 	a.Identification = new(GenericIdentification32)
 	return a.Identification
@@ -18,3 +26,8 @@ func (a *Acquirer1) AddIdentification() *GenericIdentification32 {
 func (a *Acquirer1) SetParametersVersion(value string) {
 	a.ParametersVersion = (*ISODateTime)(&value)
 }
+
+func (a *Acquirer1) SetParametersVersionFromTime(value time.Time) {
+	a.ParametersVersion = new(ISODateTime)
+	a.ParametersVersion.FromTime(value)
+}
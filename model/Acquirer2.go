@@ -10,6 +10,10 @@ type Acquirer2 struct {
 	ParametersVersion *Max35Text `xml:"ParamsVrsn"`
 }
 
+func (a *Acquirer2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Acquirer2) AddIdentification() *GenericIdentification32 {
 	a.Identification = new(GenericIdentification32)
 	return a.Identification
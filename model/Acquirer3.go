@@ -10,6 +10,10 @@ type Acquirer3 struct {
 	ParametersVersion *Max35Text `xml:"ParamsVrsn,omitempty"`
 }
 
+func (a *Acquirer3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Acquirer3) AddIdentification() *GenericIdentification32 {
 	a.Identification = new(GenericIdentification32)
 	return a.Identification
@@ -10,6 +10,10 @@ type Acquirer4 struct {
 	ParametersVersion *Max256Text `xml:"ParamsVrsn"`
 }
 
+func (a *Acquirer4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Acquirer4) AddIdentification() *GenericIdentification53 {
 	a.Identification = new(GenericIdentification53)
 	return a.Identification
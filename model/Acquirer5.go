@@ -10,6 +10,10 @@ type Acquirer5 struct {
 	ParametersVersion *Max256Text `xml:"ParamsVrsn,omitempty"`
 }
 
+func (a *Acquirer5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Acquirer5) AddIdentification() *GenericIdentification53 {
 	a.Identification = new(GenericIdentification53)
 	return a.Identification
@@ -15,6 +15,10 @@ type Acquirer6 struct {
 	CountryCode *ISO3NumericCountryCode `xml:"CtryCd,omitempty"`
 }
 
+func (a *Acquirer6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Acquirer6) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type Acquirer7 struct {
 	Branch *Max35Text `xml:"Brnch,omitempty"`
 }
 
+func (a *Acquirer7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Acquirer7) SetAcquiringInstitution(value string) {
 	a.AcquiringInstitution = (*Max35Text)(&value)
 }
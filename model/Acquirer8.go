@@ -10,6 +10,10 @@ type Acquirer8 struct {
 	ApplicationVersion *Max35Text `xml:"ApplVrsn,omitempty"`
 }
 
+func (a *Acquirer8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Acquirer8) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type AcquirerAuthorisationInitiation1 struct {
 	Transaction *CardTransaction15 `xml:"Tx"`
 }
 
+func (a *AcquirerAuthorisationInitiation1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerAuthorisationInitiation1) AddEnvironment() *CardTransactionEnvironment1 {
 	a.Environment = new(CardTransactionEnvironment1)
 	return a.Environment
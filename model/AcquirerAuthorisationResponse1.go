@@ -13,6 +13,10 @@ type AcquirerAuthorisationResponse1 struct {
 	Transaction *CardTransaction4 `xml:"Tx,omitempty"`
 }
 
+func (a *AcquirerAuthorisationResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerAuthorisationResponse1) AddEnvironment() *CardTransactionEnvironment2 {
 	a.Environment = new(CardTransactionEnvironment2)
 	return a.Environment
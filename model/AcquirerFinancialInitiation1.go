@@ -13,6 +13,10 @@ type AcquirerFinancialInitiation1 struct {
 	Transaction *CardTransaction5 `xml:"Tx"`
 }
 
+func (a *AcquirerFinancialInitiation1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerFinancialInitiation1) AddEnvironment() *CardTransactionEnvironment1 {
 	a.Environment = new(CardTransactionEnvironment1)
 	return a.Environment
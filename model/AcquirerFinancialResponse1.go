@@ -13,6 +13,10 @@ type AcquirerFinancialResponse1 struct {
 	Transaction *CardTransaction6 `xml:"Tx"`
 }
 
+func (a *AcquirerFinancialResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerFinancialResponse1) AddEnvironment() *CardTransactionEnvironment2 {
 	a.Environment = new(CardTransactionEnvironment2)
 	return a.Environment
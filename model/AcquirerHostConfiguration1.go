@@ -10,6 +10,10 @@ type AcquirerHostConfiguration1 struct {
 	MessageToSend []*MessageFunction3Code `xml:"MsgToSnd"`
 }
 
+func (a *AcquirerHostConfiguration1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerHostConfiguration1) SetHostIdentification(value string) {
 	a.HostIdentification = (*Max35Text)(&value)
 }
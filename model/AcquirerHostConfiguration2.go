@@ -10,6 +10,10 @@ type AcquirerHostConfiguration2 struct {
 	MessageToSend []*MessageFunction3Code `xml:"MsgToSnd,omitempty"`
 }
 
+func (a *AcquirerHostConfiguration2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerHostConfiguration2) SetHostIdentification(value string) {
 	a.HostIdentification = (*Max35Text)(&value)
 }
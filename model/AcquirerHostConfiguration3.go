@@ -10,6 +10,10 @@ type AcquirerHostConfiguration3 struct {
 	MessageToSend []*MessageFunction5Code `xml:"MsgToSnd,omitempty"`
 }
 
+func (a *AcquirerHostConfiguration3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerHostConfiguration3) SetHostIdentification(value string) {
 	a.HostIdentification = (*Max35Text)(&value)
 }
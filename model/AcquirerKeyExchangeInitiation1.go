@@ -10,6 +10,10 @@ type AcquirerKeyExchangeInitiation1 struct {
 	Transaction *CardTransaction13 `xml:"Tx"`
 }
 
+func (a *AcquirerKeyExchangeInitiation1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerKeyExchangeInitiation1) AddEnvironment() *CardTransactionEnvironment6 {
 	a.Environment = new(CardTransactionEnvironment6)
 	return a.Environment
@@ -10,6 +10,10 @@ type AcquirerKeyExchangeResponse1 struct {
 	Transaction *CardTransaction14 `xml:"Tx"`
 }
 
+func (a *AcquirerKeyExchangeResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerKeyExchangeResponse1) AddEnvironment() *CardTransactionEnvironment6 {
 	a.Environment = new(CardTransactionEnvironment6)
 	return a.Environment
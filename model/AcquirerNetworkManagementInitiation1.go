@@ -10,6 +10,10 @@ type AcquirerNetworkManagementInitiation1 struct {
 	Transaction *CardTransaction11 `xml:"Tx"`
 }
 
+func (a *AcquirerNetworkManagementInitiation1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerNetworkManagementInitiation1) AddEnvironment() *CardTransactionEnvironment6 {
 	a.Environment = new(CardTransactionEnvironment6)
 	return a.Environment
@@ -10,6 +10,10 @@ type AcquirerNetworkManagementResponse1 struct {
 	Transaction *CardTransaction12 `xml:"Tx"`
 }
 
+func (a *AcquirerNetworkManagementResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerNetworkManagementResponse1) AddEnvironment() *CardTransactionEnvironment6 {
 	a.Environment = new(CardTransactionEnvironment6)
 	return a.Environment
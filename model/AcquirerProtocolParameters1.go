@@ -37,6 +37,10 @@ type AcquirerProtocolParameters1 struct {
 	ProtectCardData *TrueFalseIndicator `xml:"PrtctCardData"`
 }
 
+func (a *AcquirerProtocolParameters1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerProtocolParameters1) AddAcquirerIdentification() *GenericIdentification32 {
 	newValue := new(GenericIdentification32)
 	a.AcquirerIdentification = append(a.AcquirerIdentification, newValue)
@@ -352,10 +356,20 @@ func (a *AcquirerProtocolParameters1) SetReconciliationByAcquirer(value string)
 	a.ReconciliationByAcquirer = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters1) SetReconciliationByAcquirerFromBool(value bool) {
+	a.ReconciliationByAcquirer = new(TrueFalseIndicator)
+	a.ReconciliationByAcquirer.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters1) SetTotalsPerCurrency(value string) {
 	a.TotalsPerCurrency = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters1) SetTotalsPerCurrencyFromBool(value bool) {
+	a.TotalsPerCurrency = new(TrueFalseIndicator)
+	a.TotalsPerCurrency.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters1) AddBatchTransferContent(value string) {
 	a.BatchTransferContent = append(a.BatchTransferContent, (*BatchTransactionType1Code)(&value))
 }
@@ -509,3 +523,8 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (a *AcquirerProtocolParameters1) SetProtectCardData(value string) {
 	a.ProtectCardData = (*TrueFalseIndicator)(&value)
 }
+
+func (a *AcquirerProtocolParameters1) SetProtectCardDataFromBool(value bool) {
+	a.ProtectCardData = new(TrueFalseIndicator)
+	a.ProtectCardData.FromBool(value)
+}
@@ -13,6 +13,10 @@ type AcquirerProtocolParameters2 struct {
 	CompletionExchange *ExchangeConfiguration1 `xml:"CmpltnXchg,omitempty"`
 }
 
+func (a *AcquirerProtocolParameters2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerProtocolParameters2) SetFinancialCapture(value string) {
 	a.FinancialCapture = (*FinancialCapture1Code)(&value)
 }
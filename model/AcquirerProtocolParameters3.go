@@ -43,6 +43,10 @@ type AcquirerProtocolParameters3 struct {
 	ProtectCardData *TrueFalseIndicator `xml:"PrtctCardData"`
 }
 
+func (a *AcquirerProtocolParameters3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerProtocolParameters3) AddAcquirerIdentification() *GenericIdentification32 {
 	newValue := new(GenericIdentification32)
 	a.AcquirerIdentification = append(a.AcquirerIdentification, newValue)
@@ -358,18 +362,38 @@ func (a *AcquirerProtocolParameters3) SetReconciliationByAcquirer(value string)
 	a.ReconciliationByAcquirer = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters3) SetReconciliationByAcquirerFromBool(value bool) {
+	a.ReconciliationByAcquirer = new(TrueFalseIndicator)
+	a.ReconciliationByAcquirer.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters3) SetTotalsPerCurrency(value string) {
 	a.TotalsPerCurrency = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters3) SetTotalsPerCurrencyFromBool(value bool) {
+	a.TotalsPerCurrency = new(TrueFalseIndicator)
+	a.TotalsPerCurrency.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters3) SetSplitTotals(value string) {
 	a.SplitTotals = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters3) SetSplitTotalsFromBool(value bool) {
+	a.SplitTotals = new(TrueFalseIndicator)
+	a.SplitTotals.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters3) SetCardDataVerification(value string) {
 	a.CardDataVerification = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters3) SetCardDataVerificationFromBool(value bool) {
+	a.CardDataVerification = new(TrueFalseIndicator)
+	a.CardDataVerification.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters3) AddBatchTransferContent(value string) {
 	a.BatchTransferContent = append(a.BatchTransferContent, (*BatchTransactionType1Code)(&value))
 }
@@ -523,3 +547,8 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (a *AcquirerProtocolParameters3) SetProtectCardData(value string) {
 	a.ProtectCardData = (*TrueFalseIndicator)(&value)
 }
+
+func (a *AcquirerProtocolParameters3) SetProtectCardDataFromBool(value bool) {
+	a.ProtectCardData = new(TrueFalseIndicator)
+	a.ProtectCardData.FromBool(value)
+}
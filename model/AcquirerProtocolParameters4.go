@@ -16,6 +16,10 @@ type AcquirerProtocolParameters4 struct {
 	CancellationExchange *CancellationProcess1Code `xml:"CxlXchg,omitempty"`
 }
 
+func (a *AcquirerProtocolParameters4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerProtocolParameters4) SetFinancialCapture(value string) {
 	a.FinancialCapture = (*FinancialCapture1Code)(&value)
 }
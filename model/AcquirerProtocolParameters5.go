@@ -16,6 +16,10 @@ type AcquirerProtocolParameters5 struct {
 	CancellationExchange *CancellationProcess1Code `xml:"CxlXchg,omitempty"`
 }
 
+func (a *AcquirerProtocolParameters5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerProtocolParameters5) SetFinancialCapture(value string) {
 	a.FinancialCapture = (*FinancialCapture1Code)(&value)
 }
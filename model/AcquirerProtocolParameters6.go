@@ -46,6 +46,10 @@ type AcquirerProtocolParameters6 struct {
 	ProtectCardData *TrueFalseIndicator `xml:"PrtctCardData"`
 }
 
+func (a *AcquirerProtocolParameters6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerProtocolParameters6) AddAcquirerIdentification() *GenericIdentification32 {
 	newValue := new(GenericIdentification32)
 	a.AcquirerIdentification = append(a.AcquirerIdentification, newValue)
@@ -361,22 +365,47 @@ func (a *AcquirerProtocolParameters6) SetReconciliationByAcquirer(value string)
 	a.ReconciliationByAcquirer = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters6) SetReconciliationByAcquirerFromBool(value bool) {
+	a.ReconciliationByAcquirer = new(TrueFalseIndicator)
+	a.ReconciliationByAcquirer.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters6) SetTotalsPerCurrency(value string) {
 	a.TotalsPerCurrency = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters6) SetTotalsPerCurrencyFromBool(value bool) {
+	a.TotalsPerCurrency = new(TrueFalseIndicator)
+	a.TotalsPerCurrency.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters6) SetSplitTotals(value string) {
 	a.SplitTotals = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters6) SetSplitTotalsFromBool(value bool) {
+	a.SplitTotals = new(TrueFalseIndicator)
+	a.SplitTotals.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters6) SetCardDataVerification(value string) {
 	a.CardDataVerification = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters6) SetCardDataVerificationFromBool(value bool) {
+	a.CardDataVerification = new(TrueFalseIndicator)
+	a.CardDataVerification.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters6) SetNotifyOffLineCancellation(value string) {
 	a.NotifyOffLineCancellation = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters6) SetNotifyOffLineCancellationFromBool(value bool) {
+	a.NotifyOffLineCancellation = new(TrueFalseIndicator)
+	a.NotifyOffLineCancellation.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters6) AddBatchTransferContent(value string) {
 	a.BatchTransferContent = append(a.BatchTransferContent, (*BatchTransactionType1Code)(&value))
 }
@@ -530,3 +559,8 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (a *AcquirerProtocolParameters6) SetProtectCardData(value string) {
 	a.ProtectCardData = (*TrueFalseIndicator)(&value)
 }
+
+func (a *AcquirerProtocolParameters6) SetProtectCardDataFromBool(value bool) {
+	a.ProtectCardData = new(TrueFalseIndicator)
+	a.ProtectCardData.FromBool(value)
+}
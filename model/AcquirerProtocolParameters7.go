@@ -55,6 +55,10 @@ type AcquirerProtocolParameters7 struct {
 	ProtectCardData *TrueFalseIndicator `xml:"PrtctCardData"`
 }
 
+func (a *AcquirerProtocolParameters7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerProtocolParameters7) AddAcquirerIdentification() *GenericIdentification53 {
 	newValue := new(GenericIdentification53)
 	a.AcquirerIdentification = append(a.AcquirerIdentification, newValue)
@@ -374,22 +378,47 @@ func (a *AcquirerProtocolParameters7) SetReconciliationByAcquirer(value string)
 	a.ReconciliationByAcquirer = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters7) SetReconciliationByAcquirerFromBool(value bool) {
+	a.ReconciliationByAcquirer = new(TrueFalseIndicator)
+	a.ReconciliationByAcquirer.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters7) SetTotalsPerCurrency(value string) {
 	a.TotalsPerCurrency = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters7) SetTotalsPerCurrencyFromBool(value bool) {
+	a.TotalsPerCurrency = new(TrueFalseIndicator)
+	a.TotalsPerCurrency.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters7) SetSplitTotals(value string) {
 	a.SplitTotals = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters7) SetSplitTotalsFromBool(value bool) {
+	a.SplitTotals = new(TrueFalseIndicator)
+	a.SplitTotals.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters7) SetCardDataVerification(value string) {
 	a.CardDataVerification = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters7) SetCardDataVerificationFromBool(value bool) {
+	a.CardDataVerification = new(TrueFalseIndicator)
+	a.CardDataVerification.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters7) SetNotifyOffLineCancellation(value string) {
 	a.NotifyOffLineCancellation = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters7) SetNotifyOffLineCancellationFromBool(value bool) {
+	a.NotifyOffLineCancellation = new(TrueFalseIndicator)
+	a.NotifyOffLineCancellation.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters7) AddBatchTransferContent(value string) {
 	a.BatchTransferContent = append(a.BatchTransferContent, (*BatchTransactionType1Code)(&value))
 }
@@ -398,10 +427,20 @@ func (a *AcquirerProtocolParameters7) SetFileTransferBatch(value string) {
 	a.FileTransferBatch = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters7) SetFileTransferBatchFromBool(value bool) {
+	a.FileTransferBatch = new(TrueFalseIndicator)
+	a.FileTransferBatch.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters7) SetBatchDigitalSignature(value string) {
 	a.BatchDigitalSignature = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters7) SetBatchDigitalSignatureFromBool(value bool) {
+	a.BatchDigitalSignature = new(TrueFalseIndicator)
+	a.BatchDigitalSignature.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters7) AddMessageItem() *MessageItemCondition1 {
 	newValue := new(MessageItemCondition1)
 	a.MessageItem = append(a.MessageItem, newValue)
@@ -551,3 +590,8 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (a *AcquirerProtocolParameters7) SetProtectCardData(value string) {
 	a.ProtectCardData = (*TrueFalseIndicator)(&value)
 }
+
+func (a *AcquirerProtocolParameters7) SetProtectCardDataFromBool(value bool) {
+	a.ProtectCardData = new(TrueFalseIndicator)
+	a.ProtectCardData.FromBool(value)
+}
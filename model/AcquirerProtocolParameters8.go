@@ -16,6 +16,10 @@ type AcquirerProtocolParameters8 struct {
 	CancellationExchange *CancellationProcess1Code `xml:"CxlXchg,omitempty"`
 }
 
+func (a *AcquirerProtocolParameters8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerProtocolParameters8) SetFinancialCapture(value string) {
 	a.FinancialCapture = (*FinancialCapture1Code)(&value)
 }
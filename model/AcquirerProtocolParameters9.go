@@ -64,6 +64,10 @@ type AcquirerProtocolParameters9 struct {
 	MandatorySecurityTrailer *TrueFalseIndicator `xml:"MndtrySctyTrlr,omitempty"`
 }
 
+func (a *AcquirerProtocolParameters9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerProtocolParameters9) SetActionType(value string) {
 	a.ActionType = (*TerminalManagementAction3Code)(&value)
 }
@@ -387,26 +391,56 @@ func (a *AcquirerProtocolParameters9) SetReconciliationByAcquirer(value string)
 	a.ReconciliationByAcquirer = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters9) SetReconciliationByAcquirerFromBool(value bool) {
+	a.ReconciliationByAcquirer = new(TrueFalseIndicator)
+	a.ReconciliationByAcquirer.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters9) SetTotalsPerCurrency(value string) {
 	a.TotalsPerCurrency = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters9) SetTotalsPerCurrencyFromBool(value bool) {
+	a.TotalsPerCurrency = new(TrueFalseIndicator)
+	a.TotalsPerCurrency.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters9) SetSplitTotals(value string) {
 	a.SplitTotals = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters9) SetSplitTotalsFromBool(value bool) {
+	a.SplitTotals = new(TrueFalseIndicator)
+	a.SplitTotals.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters9) SetReconciliationError(value string) {
 	a.ReconciliationError = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters9) SetReconciliationErrorFromBool(value bool) {
+	a.ReconciliationError = new(TrueFalseIndicator)
+	a.ReconciliationError.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters9) SetCardDataVerification(value string) {
 	a.CardDataVerification = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters9) SetCardDataVerificationFromBool(value bool) {
+	a.CardDataVerification = new(TrueFalseIndicator)
+	a.CardDataVerification.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters9) SetNotifyOffLineCancellation(value string) {
 	a.NotifyOffLineCancellation = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters9) SetNotifyOffLineCancellationFromBool(value bool) {
+	a.NotifyOffLineCancellation = new(TrueFalseIndicator)
+	a.NotifyOffLineCancellation.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters9) AddBatchTransferContent(value string) {
 	a.BatchTransferContent = append(a.BatchTransferContent, (*BatchTransactionType1Code)(&value))
 }
@@ -415,10 +449,20 @@ func (a *AcquirerProtocolParameters9) SetFileTransferBatch(value string) {
 	a.FileTransferBatch = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters9) SetFileTransferBatchFromBool(value bool) {
+	a.FileTransferBatch = new(TrueFalseIndicator)
+	a.FileTransferBatch.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters9) SetBatchDigitalSignature(value string) {
 	a.BatchDigitalSignature = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters9) SetBatchDigitalSignatureFromBool(value bool) {
+	a.BatchDigitalSignature = new(TrueFalseIndicator)
+	a.BatchDigitalSignature.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters9) AddMessageItem() *MessageItemCondition1 {
 	newValue := new(MessageItemCondition1)
 	a.MessageItem = append(a.MessageItem, newValue)
@@ -569,6 +613,16 @@ func (a *AcquirerProtocolParameters9) SetProtectCardData(value string) {
 	a.ProtectCardData = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AcquirerProtocolParameters9) SetProtectCardDataFromBool(value bool) {
+	a.ProtectCardData = new(TrueFalseIndicator)
+	a.ProtectCardData.FromBool(value)
+}
+
 func (a *AcquirerProtocolParameters9) SetMandatorySecurityTrailer(value string) {
 	a.MandatorySecurityTrailer = (*TrueFalseIndicator)(&value)
 }
+
+func (a *AcquirerProtocolParameters9) SetMandatorySecurityTrailerFromBool(value bool) {
+	a.MandatorySecurityTrailer = new(TrueFalseIndicator)
+	a.MandatorySecurityTrailer.FromBool(value)
+}
@@ -10,6 +10,10 @@ type AcquirerReconciliationInitiation1 struct {
 	Transaction *CardTransaction9 `xml:"Tx"`
 }
 
+func (a *AcquirerReconciliationInitiation1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerReconciliationInitiation1) AddEnvironment() *CardTransactionEnvironment5 {
 	a.Environment = new(CardTransactionEnvironment5)
 	return a.Environment
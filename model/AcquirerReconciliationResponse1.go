@@ -10,6 +10,10 @@ type AcquirerReconciliationResponse1 struct {
 	Transaction *CardTransaction10 `xml:"Tx"`
 }
 
+func (a *AcquirerReconciliationResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerReconciliationResponse1) AddEnvironment() *CardTransactionEnvironment5 {
 	a.Environment = new(CardTransactionEnvironment5)
 	return a.Environment
@@ -10,6 +10,10 @@ type AcquirerReversalInitiation1 struct {
 	Transaction *CardTransaction7 `xml:"Tx"`
 }
 
+func (a *AcquirerReversalInitiation1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerReversalInitiation1) AddEnvironment() *CardTransactionEnvironment3 {
 	a.Environment = new(CardTransactionEnvironment3)
 	return a.Environment
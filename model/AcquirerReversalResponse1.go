@@ -10,6 +10,10 @@ type AcquirerReversalResponse1 struct {
 	Transaction *CardTransaction8 `xml:"Tx"`
 }
 
+func (a *AcquirerReversalResponse1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AcquirerReversalResponse1) AddEnvironment() *CardTransactionEnvironment4 {
 	a.Environment = new(CardTransactionEnvironment4)
 	return a.Environment
@@ -10,6 +10,10 @@ type Action1 struct {
 	MessageToPresent *ActionMessage1 `xml:"MsgToPres,omitempty"`
 }
 
+func (a *Action1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Action1) SetActionType(value string) {
 	a.ActionType = (*ActionType1Code)(&value)
 }
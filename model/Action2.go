@@ -10,6 +10,10 @@ type Action2 struct {
 	MessageToPresent *ActionMessage1 `xml:"MsgToPres,omitempty"`
 }
 
+func (a *Action2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Action2) SetActionType(value string) {
 	a.ActionType = (*ActionType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type Action3 struct {
 	MessageToPresent *ActionMessage1 `xml:"MsgToPres,omitempty"`
 }
 
+func (a *Action3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Action3) SetActionType(value string) {
 	a.ActionType = (*ActionType3Code)(&value)
 }
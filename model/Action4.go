@@ -10,6 +10,10 @@ type Action4 struct {
 	MessageToPresent *ActionMessage2 `xml:"MsgToPres,omitempty"`
 }
 
+func (a *Action4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Action4) SetActionType(value string) {
 	a.ActionType = (*ActionType5Code)(&value)
 }
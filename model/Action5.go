@@ -13,6 +13,10 @@ type Action5 struct {
 	RequestToPerform *MessageFunction7Code `xml:"ReqToPrfrm,omitempty"`
 }
 
+func (a *Action5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Action5) SetActionType(value string) {
 	a.ActionType = (*ActionType6Code)(&value)
 }
@@ -10,6 +10,10 @@ type Action6 struct {
 	MessageToPresent *ActionMessage2 `xml:"MsgToPres,omitempty"`
 }
 
+func (a *Action6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Action6) SetActionType(value string) {
 	a.ActionType = (*ActionType3Code)(&value)
 }
@@ -13,6 +13,10 @@ type Action7 struct {
 	RequestToPerform *MessageFunction11Code `xml:"ReqToPrfrm,omitempty"`
 }
 
+func (a *Action7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Action7) SetActionType(value string) {
 	a.ActionType = (*ActionType6Code)(&value)
 }
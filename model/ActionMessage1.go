@@ -13,6 +13,10 @@ type ActionMessage1 struct {
 	MessageContentSignature *Max70Text `xml:"MsgCnttSgntr,omitempty"`
 }
 
+func (a *ActionMessage1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ActionMessage1) SetMessageDestination(value string) {
 	a.MessageDestination = (*UserInterface1Code)(&value)
 }
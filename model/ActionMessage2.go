@@ -16,6 +16,10 @@ type ActionMessage2 struct {
 	MessageContentSignature *Max140Binary `xml:"MsgCnttSgntr,omitempty"`
 }
 
+func (a *ActionMessage2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ActionMessage2) SetMessageDestination(value string) {
 	a.MessageDestination = (*UserInterface4Code)(&value)
 }
@@ -13,6 +13,10 @@ type ActionMessage3 struct {
 	Content *Max20000Text `xml:"Cntt"`
 }
 
+func (a *ActionMessage3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ActionMessage3) SetDestination(value string) {
 	a.Destination = (*UserInterface3Code)(&value)
 }
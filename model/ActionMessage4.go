@@ -19,6 +19,10 @@ type ActionMessage4 struct {
 	MessageContentSignature *Max35Binary `xml:"MsgCnttSgntr,omitempty"`
 }
 
+func (a *ActionMessage4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ActionMessage4) SetFormat(value string) {
 	a.Format = (*OutputFormat2Code)(&value)
 }
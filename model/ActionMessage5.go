@@ -10,6 +10,10 @@ type ActionMessage5 struct {
 	MessageContent *Max20000Text `xml:"MsgCntt"`
 }
 
+func (a *ActionMessage5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ActionMessage5) SetFormat(value string) {
 	a.Format = (*OutputFormat1Code)(&value)
 }
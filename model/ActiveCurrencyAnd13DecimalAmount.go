@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/amount"
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // A number of monetary units specified in an active currency where the unit of currency is explicit and compliant with ISO 4217. The number of fractional digits (or minor unit of currency) is not checked as per ISO 4217: It must be lesser than or equal to 13.
 // Note: The decimal separator is a dot.
 type ActiveCurrencyAnd13DecimalAmount struct {
@@ -7,6 +12,33 @@ type ActiveCurrencyAnd13DecimalAmount struct {
 	Currency string `xml:"Ccy,attr"`
 }
 
+func (a *ActiveCurrencyAnd13DecimalAmount) Validate() error {
+	if err := ValidateElement(a); err != nil {
+		return err
+	}
+	return validateAmount("ActiveCurrencyAnd13DecimalAmount", a.Value, a.Currency, 18, 13, true, false)
+}
+
 func NewActiveCurrencyAnd13DecimalAmount(value, currency string) *ActiveCurrencyAnd13DecimalAmount {
 	return &ActiveCurrencyAnd13DecimalAmount{Value: value, Currency: currency}
 }
+
+func (a *ActiveCurrencyAnd13DecimalAmount) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(a.Value)
+}
+
+func NewActiveCurrencyAnd13DecimalAmountFromDecimal(value decimal.Decimal, currency string) *ActiveCurrencyAnd13DecimalAmount {
+	return NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
+func (a *ActiveCurrencyAnd13DecimalAmount) Amount() (amount.Amount, error) {
+	value, err := a.Decimal()
+	if err != nil {
+		return amount.Amount{}, err
+	}
+	return amount.New(value, a.Currency), nil
+}
+
+func NewActiveCurrencyAnd13DecimalAmountFromAmount(value amount.Amount) *ActiveCurrencyAnd13DecimalAmount {
+	return NewActiveCurrencyAnd13DecimalAmount(value.Text(), value.Currency)
+}
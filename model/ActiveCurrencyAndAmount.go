@@ -1,11 +1,66 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/amount"
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // A number of monetary units specified in an active currency where the unit of currency is explicit and compliant with ISO 4217.
 type ActiveCurrencyAndAmount struct {
 	Value    string `xml:",chardata"`
 	Currency string `xml:"Ccy,attr"`
 }
 
+func (a *ActiveCurrencyAndAmount) Validate() error {
+	if err := ValidateElement(a); err != nil {
+		return err
+	}
+	return validateAmount("ActiveCurrencyAndAmount", a.Value, a.Currency, 18, 5, true, true)
+}
+
 func NewActiveCurrencyAndAmount(value, currency string) *ActiveCurrencyAndAmount {
 	return &ActiveCurrencyAndAmount{Value: value, Currency: currency}
 }
+
+func (a *ActiveCurrencyAndAmount) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(a.Value)
+}
+
+func NewActiveCurrencyAndAmountFromDecimal(value decimal.Decimal, currency string) *ActiveCurrencyAndAmount {
+	return NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *ActiveCurrencyAndAmount) Amount() (amount.Amount, error) {
+	value, err := a.Decimal()
+	if err != nil {
+		return amount.Amount{}, err
+	}
+	return amount.New(value, a.Currency), nil
+}
+
+func NewActiveCurrencyAndAmountFromAmount(value amount.Amount) *ActiveCurrencyAndAmount {
+	return NewActiveCurrencyAndAmount(value.Text(), value.Currency)
+}
+
+func (a *ActiveCurrencyAndAmount) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Open(tag)
+	enc.Attr("Ccy", a.Currency)
+	enc.Close()
+	enc.Text(a.Value)
+	enc.End(tag)
+}
+
+func (a *ActiveCurrencyAndAmount) DecodeXMLFast(dec *fastxml.Decoder) error {
+	value, err := dec.Attr("Ccy")
+	if err != nil {
+		return err
+	}
+	a.Currency = value
+	value, err = dec.Text()
+	if err != nil {
+		return err
+	}
+	a.Value = value
+	return nil
+}
@@ -1,3 +1,9 @@
 package model
 
 type ActiveCurrencyCode string
+
+var activeCurrencyCodePattern = newPattern(`[A-Z]{3,3}`)
+
+func (a ActiveCurrencyCode) Validate() error {
+	return validatePattern("ActiveCurrencyCode", string(a), activeCurrencyCodePattern)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/amount"
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // A number of monetary units specified in an active or a historic currency where the unit of currency is explicit and compliant with ISO 4217. The number of fractional digits (or minor unit of currency) is not checked as per ISO 4217: It must be lesser than or equal to 13.
 // Note: The decimal separator is a dot.
 type ActiveOrHistoricCurrencyAnd13DecimalAmount struct {
@@ -7,6 +13,55 @@ type ActiveOrHistoricCurrencyAnd13DecimalAmount struct {
 	Currency string `xml:"Ccy,attr"`
 }
 
+func (a *ActiveOrHistoricCurrencyAnd13DecimalAmount) Validate() error {
+	if err := ValidateElement(a); err != nil {
+		return err
+	}
+	return validateAmount("ActiveOrHistoricCurrencyAnd13DecimalAmount", a.Value, a.Currency, 18, 13, false, false)
+}
+
 func NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency string) *ActiveOrHistoricCurrencyAnd13DecimalAmount {
 	return &ActiveOrHistoricCurrencyAnd13DecimalAmount{Value: value, Currency: currency}
 }
+
+func (a *ActiveOrHistoricCurrencyAnd13DecimalAmount) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(a.Value)
+}
+
+func NewActiveOrHistoricCurrencyAnd13DecimalAmountFromDecimal(value decimal.Decimal, currency string) *ActiveOrHistoricCurrencyAnd13DecimalAmount {
+	return NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
+func (a *ActiveOrHistoricCurrencyAnd13DecimalAmount) Amount() (amount.Amount, error) {
+	value, err := a.Decimal()
+	if err != nil {
+		return amount.Amount{}, err
+	}
+	return amount.New(value, a.Currency), nil
+}
+
+func NewActiveOrHistoricCurrencyAnd13DecimalAmountFromAmount(value amount.Amount) *ActiveOrHistoricCurrencyAnd13DecimalAmount {
+	return NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.Text(), value.Currency)
+}
+
+func (a *ActiveOrHistoricCurrencyAnd13DecimalAmount) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Open(tag)
+	enc.Attr("Ccy", a.Currency)
+	enc.Close()
+	enc.Text(a.Value)
+	enc.End(tag)
+}
+
+func (a *ActiveOrHistoricCurrencyAnd13DecimalAmount) DecodeXMLFast(dec *fastxml.Decoder) error {
+	value, err := dec.Attr("Ccy")
+	if err != nil {
+		return err
+	}
+	a.Currency = value
+	value, err = dec.Text()
+	if err != nil {
+		return err
+	}
+	a.Value = value
+	return nil
+}
@@ -1,11 +1,66 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/amount"
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // A number of monetary units specified in an active or a historic currency where the unit of currency is explicit and compliant with ISO 4217.
 type ActiveOrHistoricCurrencyAndAmount struct {
 	Value    string `xml:",chardata"`
 	Currency string `xml:"Ccy,attr"`
 }
 
+func (a *ActiveOrHistoricCurrencyAndAmount) Validate() error {
+	if err := ValidateElement(a); err != nil {
+		return err
+	}
+	return validateAmount("ActiveOrHistoricCurrencyAndAmount", a.Value, a.Currency, 18, 5, false, true)
+}
+
 func NewActiveOrHistoricCurrencyAndAmount(value, currency string) *ActiveOrHistoricCurrencyAndAmount {
 	return &ActiveOrHistoricCurrencyAndAmount{Value: value, Currency: currency}
 }
+
+func (a *ActiveOrHistoricCurrencyAndAmount) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(a.Value)
+}
+
+func NewActiveOrHistoricCurrencyAndAmountFromDecimal(value decimal.Decimal, currency string) *ActiveOrHistoricCurrencyAndAmount {
+	return NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *ActiveOrHistoricCurrencyAndAmount) Amount() (amount.Amount, error) {
+	value, err := a.Decimal()
+	if err != nil {
+		return amount.Amount{}, err
+	}
+	return amount.New(value, a.Currency), nil
+}
+
+func NewActiveOrHistoricCurrencyAndAmountFromAmount(value amount.Amount) *ActiveOrHistoricCurrencyAndAmount {
+	return NewActiveOrHistoricCurrencyAndAmount(value.Text(), value.Currency)
+}
+
+func (a *ActiveOrHistoricCurrencyAndAmount) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Open(tag)
+	enc.Attr("Ccy", a.Currency)
+	enc.Close()
+	enc.Text(a.Value)
+	enc.End(tag)
+}
+
+func (a *ActiveOrHistoricCurrencyAndAmount) DecodeXMLFast(dec *fastxml.Decoder) error {
+	value, err := dec.Attr("Ccy")
+	if err != nil {
+		return err
+	}
+	a.Currency = value
+	value, err = dec.Text()
+	if err != nil {
+		return err
+	}
+	a.Value = value
+	return nil
+}
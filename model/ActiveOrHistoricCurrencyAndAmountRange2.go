@@ -0,0 +1,87 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Range of amount values.
+type ActiveOrHistoricCurrencyAndAmountRange2 struct {
+
+	// Specified amount or amount range.
+	Amount *ImpliedCurrencyAmountRange1Choice `xml:"Amt"`
+
+	// Indicates whether the amount is a credited or debited amount.
+	CreditDebitIndicator *CreditDebitCode `xml:"CdtDbtInd,omitempty"`
+
+	// Medium of exchange of value, used to qualify an amount.
+	Currency *ActiveOrHistoricCurrencyCode `xml:"Ccy"`
+}
+
+func (a *ActiveOrHistoricCurrencyAndAmountRange2) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *ActiveOrHistoricCurrencyAndAmountRange2) AddAmount() *ImpliedCurrencyAmountRange1Choice {
+	a.Amount = new(ImpliedCurrencyAmountRange1Choice)
+	return a.Amount
+}
+
+func (a *ActiveOrHistoricCurrencyAndAmountRange2) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
+	a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
+}
+
+func (a *ActiveOrHistoricCurrencyAndAmountRange2) SetCurrency(value string) {
+	a.Currency = (*ActiveOrHistoricCurrencyCode)(&value)
+}
+
+func (a *ActiveOrHistoricCurrencyAndAmountRange2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.Amount != nil {
+		a.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if a.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*a.CreditDebitIndicator))
+	}
+	if a.Currency != nil {
+		enc.Element("Ccy", string(*a.Currency))
+	}
+	enc.End(tag)
+}
+
+func (a *ActiveOrHistoricCurrencyAndAmountRange2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Amt":
+			elem := new(ImpliedCurrencyAmountRange1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Amount = elem
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		case "Ccy":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.Currency = (*ActiveOrHistoricCurrencyCode)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,3 +1,9 @@
 package model
 
 type ActiveOrHistoricCurrencyCode string
+
+var activeOrHistoricCurrencyCodePattern = newPattern(`[A-Z]{3,3}`)
+
+func (a ActiveOrHistoricCurrencyCode) Validate() error {
+	return validatePattern("ActiveOrHistoricCurrencyCode", string(a), activeOrHistoricCurrencyCodePattern)
+}
@@ -10,6 +10,10 @@ type Activity1 struct {
 	Description *Max140Text `xml:"Desc,omitempty"`
 }
 
+func (a *Activity1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Activity1) SetMessageName(value string) {
 	a.MessageName = (*Max70Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Describes the activities that took place during a certain period for one trade transaction.
 type ActivityDetails1 struct {
 
@@ -13,10 +17,19 @@ type ActivityDetails1 struct {
 	Initiator *BICIdentification1 `xml:"Initr"`
 }
 
+func (a *ActivityDetails1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ActivityDetails1) SetDateTime(value string) {
 	a.DateTime = (*ISODateTime)(&value)
 }
 
+func (a *ActivityDetails1) SetDateTimeFromTime(value time.Time) {
+	a.DateTime = new(ISODateTime)
+	a.DateTime.FromTime(value)
+}
+
 func (a *ActivityDetails1) AddActivity() *Activity1 {
 	a.Activity = new(Activity1)
 	return a.Activity
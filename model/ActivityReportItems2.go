@@ -20,6 +20,10 @@ type ActivityReportItems2 struct {
 	PendingRequestForAction []*PendingActivity2 `xml:"PdgReqForActn,omitempty"`
 }
 
+func (a *ActivityReportItems2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ActivityReportItems2) SetTransactionIdentification(value string) {
 	a.TransactionIdentification = (*Max35Text)(&value)
 }
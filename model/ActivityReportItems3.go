@@ -20,6 +20,10 @@ type ActivityReportItems3 struct {
 	PendingRequestForAction []*PendingActivity2 `xml:"PdgReqForActn,omitempty"`
 }
 
+func (a *ActivityReportItems3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ActivityReportItems3) SetTransactionIdentification(value string) {
 	a.TransactionIdentification = (*Max35Text)(&value)
 }
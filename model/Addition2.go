@@ -7,6 +7,10 @@ type Addition2 struct {
 	ProposedValue *Max350Text `xml:"PropsdVal,omitempty"`
 }
 
+func (a *Addition2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Addition2) SetProposedValue(value string) {
 	a.ProposedValue = (*Max350Text)(&value)
 }
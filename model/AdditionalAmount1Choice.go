@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between additional cash in or resulting cash out.
 type AdditionalAmount1Choice struct {
 
@@ -10,10 +14,22 @@ type AdditionalAmount1Choice struct {
 	ResultingCashOut *ActiveOrHistoricCurrencyAndAmount `xml:"RsltgCshOut"`
 }
 
+func (a *AdditionalAmount1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalAmount1Choice) SetAdditionalCashIn(value, currency string) {
 	a.AdditionalCashIn = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AdditionalAmount1Choice) SetAdditionalCashInFromDecimal(value decimal.Decimal, currency string) {
+	a.AdditionalCashIn = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AdditionalAmount1Choice) SetResultingCashOut(value, currency string) {
 	a.ResultingCashOut = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (a *AdditionalAmount1Choice) SetResultingCashOutFromDecimal(value decimal.Decimal, currency string) {
+	a.ResultingCashOut = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
@@ -10,6 +10,10 @@ type AdditionalBalanceInformation struct {
 	SubBalanceType *SecuritiesBalanceType2Code `xml:"SubBalTp"`
 }
 
+func (a *AdditionalBalanceInformation) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBalanceInformation) AddQuantity() *SubBalanceQuantity1Choice {
 	a.Quantity = new(SubBalanceQuantity1Choice)
 	return a.Quantity
@@ -16,6 +16,10 @@ type AdditionalBalanceInformation11 struct {
 	QuantityBreakdown []*QuantityBreakdown23 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (a *AdditionalBalanceInformation11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBalanceInformation11) AddSubBalanceType() *SubBalanceType6Choice {
 	a.SubBalanceType = new(SubBalanceType6Choice)
 	return a.SubBalanceType
@@ -13,6 +13,10 @@ type AdditionalBalanceInformation14 struct {
 	SubBalanceAdditionalDetails *Max140Text `xml:"SubBalAddtlDtls,omitempty"`
 }
 
+func (a *AdditionalBalanceInformation14) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBalanceInformation14) AddSubBalanceType() *SubBalanceType12Choice {
 	a.SubBalanceType = new(SubBalanceType12Choice)
 	return a.SubBalanceType
@@ -16,6 +16,10 @@ type AdditionalBalanceInformation15 struct {
 	QuantityBreakdown []*QuantityBreakdown27 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (a *AdditionalBalanceInformation15) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBalanceInformation15) AddSubBalanceType() *SubBalanceType12Choice {
 	a.SubBalanceType = new(SubBalanceType12Choice)
 	return a.SubBalanceType
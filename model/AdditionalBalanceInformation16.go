@@ -13,6 +13,10 @@ type AdditionalBalanceInformation16 struct {
 	SubBalanceAdditionalDetails *RestrictedFINXMax140Text `xml:"SubBalAddtlDtls,omitempty"`
 }
 
+func (a *AdditionalBalanceInformation16) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBalanceInformation16) AddSubBalanceType() *SubBalanceType14Choice {
 	a.SubBalanceType = new(SubBalanceType14Choice)
 	return a.SubBalanceType
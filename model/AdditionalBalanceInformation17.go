@@ -16,6 +16,10 @@ type AdditionalBalanceInformation17 struct {
 	QuantityBreakdown []*QuantityBreakdown40 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (a *AdditionalBalanceInformation17) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBalanceInformation17) AddSubBalanceType() *SubBalanceType14Choice {
 	a.SubBalanceType = new(SubBalanceType14Choice)
 	return a.SubBalanceType
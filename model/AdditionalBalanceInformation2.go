@@ -13,6 +13,10 @@ type AdditionalBalanceInformation2 struct {
 	ExtendedSubBalanceType *Extended350Code `xml:"XtndedSubBalTp"`
 }
 
+func (a *AdditionalBalanceInformation2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBalanceInformation2) AddQuantity() *SubBalanceQuantity1Choice {
 	a.Quantity = new(SubBalanceQuantity1Choice)
 	return a.Quantity
@@ -16,6 +16,10 @@ type AdditionalBalanceInformation5 struct {
 	QuantityBreakdown []*QuantityBreakdown3 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (a *AdditionalBalanceInformation5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBalanceInformation5) AddSubBalanceType() *SubBalanceType6Choice {
 	a.SubBalanceType = new(SubBalanceType6Choice)
 	return a.SubBalanceType
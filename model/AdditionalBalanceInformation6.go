@@ -13,6 +13,10 @@ type AdditionalBalanceInformation6 struct {
 	SubBalanceAdditionalDetails *Max140Text `xml:"SubBalAddtlDtls,omitempty"`
 }
 
+func (a *AdditionalBalanceInformation6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBalanceInformation6) AddSubBalanceType() *SubBalanceType6Choice {
 	a.SubBalanceType = new(SubBalanceType6Choice)
 	return a.SubBalanceType
@@ -16,6 +16,10 @@ type AdditionalBalanceInformation9 struct {
 	QuantityBreakdown []*QuantityBreakdown13 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (a *AdditionalBalanceInformation9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBalanceInformation9) AddSubBalanceType() *SubBalanceType6Choice {
 	a.SubBalanceType = new(SubBalanceType6Choice)
 	return a.SubBalanceType
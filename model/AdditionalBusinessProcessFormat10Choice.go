@@ -10,6 +10,10 @@ type AdditionalBusinessProcessFormat10Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (a *AdditionalBusinessProcessFormat10Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBusinessProcessFormat10Choice) SetCode(value string) {
 	a.Code = (*AdditionalBusinessProcess6Code)(&value)
 }
@@ -10,6 +10,10 @@ type AdditionalBusinessProcessFormat11Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (a *AdditionalBusinessProcessFormat11Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBusinessProcessFormat11Choice) SetCode(value string) {
 	a.Code = (*AdditionalBusinessProcess7Code)(&value)
 }
@@ -10,6 +10,10 @@ type AdditionalBusinessProcessFormat12Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AdditionalBusinessProcessFormat12Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBusinessProcessFormat12Choice) SetCode(value string) {
 	a.Code = (*AdditionalBusinessProcess5Code)(&value)
 }
@@ -10,6 +10,10 @@ type AdditionalBusinessProcessFormat13Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AdditionalBusinessProcessFormat13Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBusinessProcessFormat13Choice) SetCode(value string) {
 	a.Code = (*AdditionalBusinessProcess6Code)(&value)
 }
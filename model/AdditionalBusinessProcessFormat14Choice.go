@@ -10,6 +10,10 @@ type AdditionalBusinessProcessFormat14Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AdditionalBusinessProcessFormat14Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBusinessProcessFormat14Choice) SetCode(value string) {
 	a.Code = (*AdditionalBusinessProcess7Code)(&value)
 }
@@ -10,6 +10,10 @@ type AdditionalBusinessProcessFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AdditionalBusinessProcessFormat1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBusinessProcessFormat1Choice) SetCode(value string) {
 	a.Code = (*AdditionalBusinessProcess1Code)(&value)
 }
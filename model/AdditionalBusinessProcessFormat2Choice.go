@@ -10,6 +10,10 @@ type AdditionalBusinessProcessFormat2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AdditionalBusinessProcessFormat2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBusinessProcessFormat2Choice) SetCode(value string) {
 	a.Code = (*AdditionalBusinessProcess2Code)(&value)
 }
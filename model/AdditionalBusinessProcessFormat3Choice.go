@@ -10,6 +10,10 @@ type AdditionalBusinessProcessFormat3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AdditionalBusinessProcessFormat3Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBusinessProcessFormat3Choice) SetCode(value string) {
 	a.Code = (*AdditionalBusinessProcess3Code)(&value)
 }
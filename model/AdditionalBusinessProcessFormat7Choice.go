@@ -10,6 +10,10 @@ type AdditionalBusinessProcessFormat7Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AdditionalBusinessProcessFormat7Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBusinessProcessFormat7Choice) SetCode(value string) {
 	a.Code = (*AdditionalBusinessProcess4Code)(&value)
 }
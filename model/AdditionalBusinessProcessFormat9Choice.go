@@ -10,6 +10,10 @@ type AdditionalBusinessProcessFormat9Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (a *AdditionalBusinessProcessFormat9Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalBusinessProcessFormat9Choice) SetCode(value string) {
 	a.Code = (*AdditionalBusinessProcess5Code)(&value)
 }
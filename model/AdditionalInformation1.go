@@ -10,6 +10,10 @@ type AdditionalInformation1 struct {
 	InformationValue *Max350Text `xml:"InfVal"`
 }
 
+func (a *AdditionalInformation1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalInformation1) AddInformationType() *InformationType1Choice {
 	a.InformationType = new(InformationType1Choice)
 	return a.InformationType
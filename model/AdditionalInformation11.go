@@ -40,6 +40,10 @@ type AdditionalInformation11 struct {
 	ProcessingStatus *ProcessingStatus56Choice `xml:"PrcgSts,omitempty"`
 }
 
+func (a *AdditionalInformation11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalInformation11) SetAccountOwnerTransactionIdentification(value string) {
 	a.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
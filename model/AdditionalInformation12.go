@@ -40,6 +40,10 @@ type AdditionalInformation12 struct {
 	ProcessingStatus *ProcessingStatus59Choice `xml:"PrcgSts,omitempty"`
 }
 
+func (a *AdditionalInformation12) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalInformation12) SetAccountOwnerTransactionIdentification(value string) {
 	a.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -37,6 +37,10 @@ type AdditionalInformation13 struct {
 	ReceivingParty1 *PartyIdentificationAndAccount117 `xml:"RcvgPty1,omitempty"`
 }
 
+func (a *AdditionalInformation13) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalInformation13) SetAccountOwnerTransactionIdentification(value string) {
 	a.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
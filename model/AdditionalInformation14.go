@@ -37,6 +37,10 @@ type AdditionalInformation14 struct {
 	ReceivingParty1 *PartyIdentificationAndAccount146 `xml:"RcvgPty1,omitempty"`
 }
 
+func (a *AdditionalInformation14) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalInformation14) SetAccountOwnerTransactionIdentification(value string) {
 	a.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -37,6 +37,10 @@ type AdditionalInformation3 struct {
 	ReceivingParty1 *PartyIdentificationAndAccount16 `xml:"RcvgPty1,omitempty"`
 }
 
+func (a *AdditionalInformation3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalInformation3) SetAccountOwnerTransactionIdentification(value string) {
 	a.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
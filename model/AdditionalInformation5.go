@@ -7,6 +7,10 @@ type AdditionalInformation5 struct {
 	Information []*Max256Text `xml:"Inf"`
 }
 
+func (a *AdditionalInformation5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalInformation5) AddInformation(value string) {
 	a.Information = append(a.Information, (*Max256Text)(&value))
 }
@@ -10,6 +10,10 @@ type AdditionalInformation6 struct {
 	InformationValue *Max350Text `xml:"InfVal"`
 }
 
+func (a *AdditionalInformation6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalInformation6) SetInformationType(value string) {
 	a.InformationType = (*ExternalInformationType1Code)(&value)
 }
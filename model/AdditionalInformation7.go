@@ -37,6 +37,10 @@ type AdditionalInformation7 struct {
 	ReceivingParty1 *PartyIdentificationAndAccount43 `xml:"RcvgPty1,omitempty"`
 }
 
+func (a *AdditionalInformation7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalInformation7) SetAccountOwnerTransactionIdentification(value string) {
 	a.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -40,6 +40,10 @@ type AdditionalInformation9 struct {
 	ProcessingStatus *ProcessingStatus39Choice `xml:"PrcgSts,omitempty"`
 }
 
+func (a *AdditionalInformation9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalInformation9) SetAccountOwnerTransactionIdentification(value string) {
 	a.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type AdditionalParameters1 struct {
 	GeographicalArea *Max35Text `xml:"GeoArea,omitempty"`
 }
 
+func (a *AdditionalParameters1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters1) SetCountry(value string) {
 	a.Country = (*CountryCode)(&value)
 }
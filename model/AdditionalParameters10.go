@@ -28,6 +28,10 @@ type AdditionalParameters10 struct {
 	ProcessorTransactionIdentification *Max35Text `xml:"PrcrTxId,omitempty"`
 }
 
+func (a *AdditionalParameters10) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters10) SetPartialSettlement(value string) {
 	a.PartialSettlement = (*PartialSettlement2Code)(&value)
 }
@@ -19,6 +19,10 @@ type AdditionalParameters12 struct {
 	ClientTripartyCollateralTransactionIdentification *Max35Text `xml:"ClntTrptyCollTxId,omitempty"`
 }
 
+func (a *AdditionalParameters12) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters12) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
@@ -16,6 +16,10 @@ type AdditionalParameters14 struct {
 	ClientTripartyCollateralTransactionIdentification *Max35Text `xml:"ClntTrptyCollTxId,omitempty"`
 }
 
+func (a *AdditionalParameters14) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters14) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
@@ -25,6 +25,10 @@ type AdditionalParameters17 struct {
 	TripartyCollateralInstructionIdentification *Max35Text `xml:"TrptyCollInstrId,omitempty"`
 }
 
+func (a *AdditionalParameters17) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters17) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
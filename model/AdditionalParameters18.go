@@ -13,6 +13,10 @@ type AdditionalParameters18 struct {
 	PreviousPartialConfirmationIdentification *Max35Text `xml:"PrvsPrtlConfId,omitempty"`
 }
 
+func (a *AdditionalParameters18) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters18) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
@@ -19,6 +19,10 @@ type AdditionalParameters2 struct {
 	ClientTripartyCollateralTransactionIdentification *Max35Text `xml:"ClntTrptyCollTxId,omitempty"`
 }
 
+func (a *AdditionalParameters2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters2) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
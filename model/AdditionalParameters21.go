@@ -28,6 +28,10 @@ type AdditionalParameters21 struct {
 	ProcessorTransactionIdentification *Max35Text `xml:"PrcrTxId,omitempty"`
 }
 
+func (a *AdditionalParameters21) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters21) SetPartialSettlement(value string) {
 	a.PartialSettlement = (*PartialSettlement2Code)(&value)
 }
@@ -25,6 +25,10 @@ type AdditionalParameters22 struct {
 	TripartyCollateralInstructionIdentification *Max35Text `xml:"TrptyCollInstrId,omitempty"`
 }
 
+func (a *AdditionalParameters22) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters22) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
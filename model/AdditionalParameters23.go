@@ -16,6 +16,10 @@ type AdditionalParameters23 struct {
 	ClientTripartyCollateralTransactionIdentification *Max35Text `xml:"ClntTrptyCollTxId,omitempty"`
 }
 
+func (a *AdditionalParameters23) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters23) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
@@ -13,6 +13,10 @@ type AdditionalParameters24 struct {
 	PreviousPartialConfirmationIdentification *Max35Text `xml:"PrvsPrtlConfId,omitempty"`
 }
 
+func (a *AdditionalParameters24) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters24) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
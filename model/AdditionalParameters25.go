@@ -28,6 +28,10 @@ type AdditionalParameters25 struct {
 	ProcessorTransactionIdentification *RestrictedFINXMax16Text `xml:"PrcrTxId,omitempty"`
 }
 
+func (a *AdditionalParameters25) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters25) SetPartialSettlement(value string) {
 	a.PartialSettlement = (*PartialSettlement2Code)(&value)
 }
@@ -13,6 +13,10 @@ type AdditionalParameters26 struct {
 	PreviousPartialConfirmationIdentification *RestrictedFINXMax16Text `xml:"PrvsPrtlConfId,omitempty"`
 }
 
+func (a *AdditionalParameters26) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters26) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
@@ -25,6 +25,10 @@ type AdditionalParameters27 struct {
 	TripartyCollateralInstructionIdentification *RestrictedFINXMax16Text `xml:"TrptyCollInstrId,omitempty"`
 }
 
+func (a *AdditionalParameters27) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters27) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
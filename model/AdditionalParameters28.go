@@ -16,6 +16,10 @@ type AdditionalParameters28 struct {
 	ClientTripartyCollateralTransactionIdentification *RestrictedFINXMax16Text `xml:"ClntTrptyCollTxId,omitempty"`
 }
 
+func (a *AdditionalParameters28) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters28) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
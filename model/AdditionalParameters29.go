@@ -25,6 +25,10 @@ type AdditionalParameters29 struct {
 	TripartyAgentServiceProviderCollateralInstructionIdentification *Max35Text `xml:"TrptyAgtSvcPrvdrCollInstrId,omitempty"`
 }
 
+func (a *AdditionalParameters29) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters29) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
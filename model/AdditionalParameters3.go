@@ -25,6 +25,10 @@ type AdditionalParameters3 struct {
 	MarketInfrastructureTransactionIdentification *Max35Text `xml:"MktInfrstrctrTxId,omitempty"`
 }
 
+func (a *AdditionalParameters3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters3) SetPartialSettlement(value string) {
 	a.PartialSettlement = (*PartialSettlement1Code)(&value)
 }
@@ -16,6 +16,10 @@ type AdditionalParameters30 struct {
 	ClientTripartyCollateralTransactionIdentification *Max35Text `xml:"ClntTrptyCollTxId,omitempty"`
 }
 
+func (a *AdditionalParameters30) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters30) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
@@ -25,6 +25,10 @@ type AdditionalParameters31 struct {
 	TripartyAgentServiceProviderCollateralInstructionIdentification *RestrictedFINXMax16Text `xml:"TrptyAgtSvcPrvdrCollInstrId,omitempty"`
 }
 
+func (a *AdditionalParameters31) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters31) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
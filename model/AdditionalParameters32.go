@@ -16,6 +16,10 @@ type AdditionalParameters32 struct {
 	ClientTripartyCollateralTransactionIdentification *RestrictedFINXMax16Text `xml:"ClntTrptyCollTxId,omitempty"`
 }
 
+func (a *AdditionalParameters32) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters32) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
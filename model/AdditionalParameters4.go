@@ -16,6 +16,10 @@ type AdditionalParameters4 struct {
 	ClientTripartyCollateralTransactionIdentification *Max35Text `xml:"ClntTrptyCollTxId,omitempty"`
 }
 
+func (a *AdditionalParameters4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters4) SetPreConfirmation(value string) {
 	a.PreConfirmation = (*PreConfirmation1Code)(&value)
 }
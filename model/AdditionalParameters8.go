@@ -28,6 +28,10 @@ type AdditionalParameters8 struct {
 	ProcessorTransactionIdentification *Max35Text `xml:"PrcrTxId,omitempty"`
 }
 
+func (a *AdditionalParameters8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalParameters8) SetPartialSettlement(value string) {
 	a.PartialSettlement = (*PartialSettlement1Code)(&value)
 }
@@ -13,6 +13,10 @@ type AdditionalQueryParameters1 struct {
 	FinancialInstrumentIdentification []*SecurityIdentification11 `xml:"FinInstrmId,omitempty"`
 }
 
+func (a *AdditionalQueryParameters1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalQueryParameters1) AddStatus() *Status1Choice {
 	a.Status = new(Status1Choice)
 	return a.Status
@@ -13,6 +13,10 @@ type AdditionalQueryParameters11 struct {
 	FinancialInstrumentIdentification []*SecurityIdentification19 `xml:"FinInstrmId,omitempty"`
 }
 
+func (a *AdditionalQueryParameters11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalQueryParameters11) AddStatus() *Status19Choice {
 	a.Status = new(Status19Choice)
 	return a.Status
@@ -13,6 +13,10 @@ type AdditionalQueryParameters12 struct {
 	FinancialInstrumentIdentification []*SecurityIdentification20 `xml:"FinInstrmId,omitempty"`
 }
 
+func (a *AdditionalQueryParameters12) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalQueryParameters12) AddStatus() *Status22Choice {
 	a.Status = new(Status22Choice)
 	return a.Status
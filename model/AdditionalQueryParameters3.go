@@ -13,6 +13,10 @@ type AdditionalQueryParameters3 struct {
 	FinancialInstrumentIdentification []*SecurityIdentification14 `xml:"FinInstrmId,omitempty"`
 }
 
+func (a *AdditionalQueryParameters3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalQueryParameters3) AddStatus() *Status8Choice {
 	a.Status = new(Status8Choice)
 	return a.Status
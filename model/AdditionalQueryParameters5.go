@@ -13,6 +13,10 @@ type AdditionalQueryParameters5 struct {
 	FinancialInstrumentIdentification []*SecurityIdentification14 `xml:"FinInstrmId,omitempty"`
 }
 
+func (a *AdditionalQueryParameters5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalQueryParameters5) AddStatus() *Status8Choice {
 	a.Status = new(Status8Choice)
 	return a.Status
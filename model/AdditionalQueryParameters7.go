@@ -13,6 +13,10 @@ type AdditionalQueryParameters7 struct {
 	FinancialInstrumentIdentification []*SecurityIdentification14 `xml:"FinInstrmId,omitempty"`
 }
 
+func (a *AdditionalQueryParameters7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalQueryParameters7) AddStatus() *Status8Choice {
 	a.Status = new(Status8Choice)
 	return a.Status
@@ -13,6 +13,10 @@ type AdditionalQueryParameters9 struct {
 	FinancialInstrumentIdentification []*SecurityIdentification14 `xml:"FinInstrmId,omitempty"`
 }
 
+func (a *AdditionalQueryParameters9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalQueryParameters9) AddStatus() *Status8Choice {
 	a.Status = new(Status8Choice)
 	return a.Status
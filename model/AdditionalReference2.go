@@ -13,6 +13,10 @@ type AdditionalReference2 struct {
 	MessageName *Max35Text `xml:"MsgNm,omitempty"`
 }
 
+func (a *AdditionalReference2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalReference2) SetReference(value string) {
 	a.Reference = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type AdditionalReference3 struct {
 	MessageName *Max35Text `xml:"MsgNm,omitempty"`
 }
 
+func (a *AdditionalReference3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalReference3) SetReference(value string) {
 	a.Reference = (*Max35Text)(&value)
 }
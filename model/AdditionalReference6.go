@@ -13,6 +13,10 @@ type AdditionalReference6 struct {
 	MessageName *Max35Text `xml:"MsgNm,omitempty"`
 }
 
+func (a *AdditionalReference6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalReference6) SetReference(value string) {
 	a.Reference = (*Max35Text)(&value)
 }
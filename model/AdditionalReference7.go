@@ -13,6 +13,10 @@ type AdditionalReference7 struct {
 	MessageName *Max35Text `xml:"MsgNm,omitempty"`
 }
 
+func (a *AdditionalReference7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalReference7) SetReference(value string) {
 	a.Reference = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type AdditionalReference8 struct {
 	MessageName *Max35Text `xml:"MsgNm,omitempty"`
 }
 
+func (a *AdditionalReference8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalReference8) SetReference(value string) {
 	a.Reference = (*Max35Text)(&value)
 }
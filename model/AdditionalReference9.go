@@ -13,6 +13,10 @@ type AdditionalReference9 struct {
 	MessageName *Max35Text `xml:"MsgNm,omitempty"`
 }
 
+func (a *AdditionalReference9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalReference9) SetReference(value string) {
 	a.Reference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type AdditionalRightCode1Choice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (a *AdditionalRightCode1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalRightCode1Choice) SetCode(value string) {
 	a.Code = (*AdditionalRight1Code)(&value)
 }
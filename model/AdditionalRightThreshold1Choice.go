@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of additional right threshold.
 type AdditionalRightThreshold1Choice struct {
 
@@ -10,6 +14,10 @@ type AdditionalRightThreshold1Choice struct {
 	AdditionalRightThresholdPercentage *PercentageRate `xml:"AddtlRghtThrshldPctg"`
 }
 
+func (a *AdditionalRightThreshold1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalRightThreshold1Choice) SetAdditionalRightThreshold(value string) {
 	a.AdditionalRightThreshold = (*Max35Text)(&value)
 }
@@ -17,3 +25,8 @@ func (a *AdditionalRightThreshold1Choice) SetAdditionalRightThreshold(value stri
 func (a *AdditionalRightThreshold1Choice) SetAdditionalRightThresholdPercentage(value string) {
 	a.AdditionalRightThresholdPercentage = (*PercentageRate)(&value)
 }
+
+func (a *AdditionalRightThreshold1Choice) SetAdditionalRightThresholdPercentageFromDecimal(value decimal.Decimal) {
+	a.AdditionalRightThresholdPercentage = new(PercentageRate)
+	a.AdditionalRightThresholdPercentage.FromDecimal(value)
+}
@@ -19,6 +19,10 @@ type AdditionalRights1 struct {
 	AdditionalRightThreshold *AdditionalRightThreshold1Choice `xml:"AddtlRghtThrshld,omitempty"`
 }
 
+func (a *AdditionalRights1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalRights1) AddAdditionalRight() *AdditionalRightCode1Choice {
 	a.AdditionalRight = new(AdditionalRightCode1Choice)
 	return a.AdditionalRight
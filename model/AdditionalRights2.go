@@ -19,6 +19,10 @@ type AdditionalRights2 struct {
 	AdditionalRightThreshold *AdditionalRightThreshold1Choice `xml:"AddtlRghtThrshld,omitempty"`
 }
 
+func (a *AdditionalRights2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalRights2) AddAdditionalRight() *AdditionalRightCode1Choice {
 	a.AdditionalRight = new(AdditionalRightCode1Choice)
 	return a.AdditionalRight
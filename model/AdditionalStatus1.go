@@ -10,6 +10,10 @@ type AdditionalStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (a *AdditionalStatus1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalStatus1) AddReason() *InstructionRejectionStatus1Choice {
 	a.Reason = new(InstructionRejectionStatus1Choice)
 	return a.Reason
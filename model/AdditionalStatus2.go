@@ -10,6 +10,10 @@ type AdditionalStatus2 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (a *AdditionalStatus2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditionalStatus2) AddReason() *CancellationRejectionStatus1Choice {
 	a.Reason = new(CancellationRejectionStatus1Choice)
 	return a.Reason
@@ -25,6 +25,10 @@ type AdditiononalInformation12 struct {
 	Period *DateTimePeriodDetails1 `xml:"Prd,omitempty"`
 }
 
+func (a *AdditiononalInformation12) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdditiononalInformation12) SetLimitation(value string) {
 	a.Limitation = (*Max350Text)(&value)
 }
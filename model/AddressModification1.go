@@ -10,6 +10,10 @@ type AddressModification1 struct {
 	Address *PostalAddress6 `xml:"Adr"`
 }
 
+func (a *AddressModification1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AddressModification1) SetModificationCode(value string) {
 	a.ModificationCode = (*Modification1Code)(&value)
 }
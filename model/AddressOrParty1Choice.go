@@ -10,6 +10,10 @@ type AddressOrParty1Choice struct {
 	NewBeneficiary *NameAndAddress10 `xml:"NewBnfcry"`
 }
 
+func (a *AddressOrParty1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AddressOrParty1Choice) AddNewAddress() *PostalAddress6 {
 	a.NewAddress = new(PostalAddress6)
 	return a.NewAddress
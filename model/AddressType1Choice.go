@@ -10,6 +10,10 @@ type AddressType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AddressType1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AddressType1Choice) SetCode(value string) {
 	a.Code = (*AddressType1Code)(&value)
 }
@@ -10,8 +10,16 @@ type AddressType2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
-func (a *AddressType2Choice) SetCode(value string) {
+func (a *AddressType2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AddressType2Choice) SetCode(value string) error {
+	if err := AddressType2Code(value).Validate(); err != nil {
+		return err
+	}
 	a.Code = (*AddressType2Code)(&value)
+	return nil
 }
 
 func (a *AddressType2Choice) AddProprietary() *GenericIdentification47 {
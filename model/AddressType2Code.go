@@ -1,3 +1,34 @@
 package model
 
 type AddressType2Code string
+
+const (
+	AddressType2CodeADDR AddressType2Code = "ADDR"
+	AddressType2CodePBOX AddressType2Code = "PBOX"
+	AddressType2CodeHOME AddressType2Code = "HOME"
+	AddressType2CodeBIZZ AddressType2Code = "BIZZ"
+	AddressType2CodeMLTO AddressType2Code = "MLTO"
+	AddressType2CodeDLVY AddressType2Code = "DLVY"
+)
+
+var addressType2CodeDescriptions = map[AddressType2Code]string{
+	AddressType2CodeADDR: "Postal: address is the complete postal address.",
+	AddressType2CodePBOX: "PO box: address is a postal office (PO) box.",
+	AddressType2CodeHOME: "Residential: address is the home address.",
+	AddressType2CodeBIZZ: "Business: address is the business address.",
+	AddressType2CodeMLTO: "Mail to: address is the address to which mail is sent.",
+	AddressType2CodeDLVY: "Delivery to: address is the address to which delivery is to take place.",
+}
+
+func (a AddressType2Code) IsValid() bool {
+	_, ok := addressType2CodeDescriptions[a]
+	return ok
+}
+
+func (a AddressType2Code) Description() string {
+	return addressType2CodeDescriptions[a]
+}
+
+func (a AddressType2Code) Validate() error {
+	return validateEnumeration("AddressType2Code", string(a), a.IsValid(), "ADDR, PBOX, HOME, BIZZ, MLTO, DLVY")
+}
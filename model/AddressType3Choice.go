@@ -0,0 +1,71 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Choice between an address type code or a proprietary address type.
+type AddressType3Choice struct {
+
+	// Type of address expressed as a code.
+	Code *AddressType2Code `xml:"Cd,omitempty"`
+
+	// Type of address expressed as a proprietary code.
+	Proprietary *GenericIdentification30 `xml:"Prtry,omitempty"`
+}
+
+func (a *AddressType3Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *AddressType3Choice) SetCode(value string) error {
+	if err := AddressType2Code(value).Validate(); err != nil {
+		return err
+	}
+	a.Code = (*AddressType2Code)(&value)
+	return nil
+}
+
+func (a *AddressType3Choice) AddProprietary() *GenericIdentification30 {
+	a.Proprietary = new(GenericIdentification30)
+	return a.Proprietary
+}
+
+func (a *AddressType3Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.Code != nil {
+		enc.Element("Cd", string(*a.Code))
+	}
+	if a.Proprietary != nil {
+		a.Proprietary.EncodeXMLFast(enc, "Prtry")
+	}
+	enc.End(tag)
+}
+
+func (a *AddressType3Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.Code = (*AddressType2Code)(&value)
+		case "Prtry":
+			elem := new(GenericIdentification30)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Proprietary = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
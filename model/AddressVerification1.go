@@ -10,6 +10,10 @@ type AddressVerification1 struct {
 	PostalCodeDigits *Max5NumericText `xml:"PstlCdDgts,omitempty"`
 }
 
+func (a *AddressVerification1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AddressVerification1) SetAddressDigits(value string) {
 	a.AddressDigits = (*Max5NumericText)(&value)
 }
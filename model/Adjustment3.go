@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Modification on the value of goods and / or services. For example: rebate, discount, surcharge
 type Adjustment3 struct {
 
@@ -19,6 +23,10 @@ type Adjustment3 struct {
 	Direction *AdjustmentDirection1Code `xml:"Drctn"`
 }
 
+func (a *Adjustment3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Adjustment3) SetType(value string) {
 	a.Type = (*AdjustmentType2Code)(&value)
 }
@@ -31,10 +39,19 @@ func (a *Adjustment3) SetAmount(value, currency string) {
 	a.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (a *Adjustment3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *Adjustment3) SetRate(value string) {
 	a.Rate = (*PercentageRate)(&value)
 }
 
+func (a *Adjustment3) SetRateFromDecimal(value decimal.Decimal) {
+	a.Rate = new(PercentageRate)
+	a.Rate.FromDecimal(value)
+}
+
 func (a *Adjustment3) SetDirection(value string) {
 	a.Direction = (*AdjustmentDirection1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Modification on the value of goods and / or services. For example: rebate, discount, surcharge
 type Adjustment4 struct {
 
@@ -16,6 +20,10 @@ type Adjustment4 struct {
 	Amount *CurrencyAndAmount `xml:"Amt"`
 }
 
+func (a *Adjustment4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Adjustment4) SetType(value string) {
 	a.Type = (*AdjustmentType2Code)(&value)
 }
@@ -31,3 +39,7 @@ func (a *Adjustment4) SetDirection(value string) {
 func (a *Adjustment4) SetAmount(value, currency string) {
 	a.Amount = NewCurrencyAndAmount(value, currency)
 }
+
+func (a *Adjustment4) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Modification on the value of goods and / or services. For example: rebate, discount, surcharge
 type Adjustment5 struct {
 
@@ -10,6 +14,10 @@ type Adjustment5 struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (a *Adjustment5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Adjustment5) SetDirection(value string) {
 	a.Direction = (*AdjustmentDirection1Code)(&value)
 }
@@ -17,3 +25,7 @@ func (a *Adjustment5) SetDirection(value string) {
 func (a *Adjustment5) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (a *Adjustment5) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
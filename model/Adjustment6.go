@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Modification on the value of goods and / or services. For example: rebate, discount, surcharge
 type Adjustment6 struct {
 
@@ -13,6 +17,10 @@ type Adjustment6 struct {
 	Amount *CurrencyAndAmount `xml:"Amt"`
 }
 
+func (a *Adjustment6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Adjustment6) AddType() *AdjustmentType1Choice {
 	a.Type = new(AdjustmentType1Choice)
 	return a.Type
@@ -25,3 +33,7 @@ func (a *Adjustment6) SetDirection(value string) {
 func (a *Adjustment6) SetAmount(value, currency string) {
 	a.Amount = NewCurrencyAndAmount(value, currency)
 }
+
+func (a *Adjustment6) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
@@ -13,6 +13,10 @@ type Adjustment7 struct {
 	Direction *AdjustmentDirection1Code `xml:"Drctn"`
 }
 
+func (a *Adjustment7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Adjustment7) AddType() *AdjustmentType1Choice {
 	a.Type = new(AdjustmentType1Choice)
 	return a.Type
@@ -10,6 +10,10 @@ type AdjustmentType1Choice struct {
 	OtherAdjustmentType *Max35Text `xml:"OthrAdjstmntTp"`
 }
 
+func (a *AdjustmentType1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdjustmentType1Choice) SetType(value string) {
 	a.Type = (*AdjustmentType2Code)(&value)
 }
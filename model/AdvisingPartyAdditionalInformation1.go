@@ -10,6 +10,10 @@ type AdvisingPartyAdditionalInformation1 struct {
 	BankToBeneficiaryInformation []*Max2000Text `xml:"BkToBnfcryInf,omitempty"`
 }
 
+func (a *AdvisingPartyAdditionalInformation1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AdvisingPartyAdditionalInformation1) SetReferenceNumber(value string) {
 	a.ReferenceNumber = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type AffirmationReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AffirmationReason1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AffirmationReason1) AddCode() *UnaffirmedReason2Choice {
 	a.Code = new(UnaffirmedReason2Choice)
 	return a.Code
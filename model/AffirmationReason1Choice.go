@@ -10,6 +10,10 @@ type AffirmationReason1Choice struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (a *AffirmationReason1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AffirmationReason1Choice) AddReason() *AffirmationReason1 {
 	newValue := new(AffirmationReason1)
 	a.Reason = append(a.Reason, newValue)
@@ -156,6 +160,10 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 		XchgRate:               doc.XchgRate.BaseOneRate,                                  // "<XchgRate>"
 	}
 
-func (a *AffirmationReason1Choice) SetNoSpecifiedReason(value string) {
+func (a *AffirmationReason1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	a.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
@@ -10,6 +10,10 @@ type AffirmationStatus1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AffirmationStatus1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AffirmationStatus1Choice) SetCode(value string) {
 	a.Code = (*AffirmationStatus1Code)(&value)
 }
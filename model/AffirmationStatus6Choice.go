@@ -13,6 +13,10 @@ type AffirmationStatus6Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts,omitempty"`
 }
 
+func (a *AffirmationStatus6Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AffirmationStatus6Choice) AddAffirmed() *ProprietaryReason1 {
 	a.Affirmed = new(ProprietaryReason1)
 	return a.Affirmed
@@ -10,6 +10,10 @@ type AffirmationStatus7Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (a *AffirmationStatus7Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AffirmationStatus7Choice) SetCode(value string) {
 	a.Code = (*AffirmationStatus1Code)(&value)
 }
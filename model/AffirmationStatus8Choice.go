@@ -10,6 +10,10 @@ type AffirmationStatus8Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (a *AffirmationStatus8Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AffirmationStatus8Choice) SetCode(value string) {
 	a.Code = (*AffirmationStatus1Code)(&value)
 }
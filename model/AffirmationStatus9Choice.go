@@ -10,6 +10,10 @@ type AffirmationStatus9Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AffirmationStatus9Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AffirmationStatus9Choice) SetCode(value string) {
 	a.Code = (*AffirmationStatus1Code)(&value)
 }
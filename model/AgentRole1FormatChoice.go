@@ -10,6 +10,10 @@ type AgentRole1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (a *AgentRole1FormatChoice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AgentRole1FormatChoice) SetCode(value string) {
 	a.Code = (*AgentRole2Code)(&value)
 }
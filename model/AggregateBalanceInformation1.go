@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net position of a segregated holding, in a single security, within the overall position held in a securities account. A securities balance is calculated from the sum of securities' receipts minus the sum of securities' deliveries.
 type AggregateBalanceInformation1 struct {
 
@@ -46,6 +50,10 @@ type AggregateBalanceInformation1 struct {
 	BalanceAtSafekeepingPlace []*AggregateBalancePerSafekeepingPlace1 `xml:"BalAtSfkpgPlc,omitempty"`
 }
 
+func (a *AggregateBalanceInformation1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalanceInformation1) AddAggregateQuantity() *BalanceQuantity1Choice {
 	a.AggregateQuantity = new(BalanceQuantity1Choice)
 	return a.AggregateQuantity
@@ -65,6 +73,11 @@ func (a *AggregateBalanceInformation1) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalanceInformation1) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalanceInformation1) AddHoldingValue(value, currency string) {
 	a.HoldingValue = append(a.HoldingValue, NewActiveOrHistoricCurrencyAndAmount(value, currency))
 }
@@ -73,10 +86,18 @@ func (a *AggregateBalanceInformation1) SetAccruedInterestAmount(value, currency
 	a.AccruedInterestAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalanceInformation1) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.AccruedInterestAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalanceInformation1) SetBookValue(value, currency string) {
 	a.BookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalanceInformation1) SetBookValueFromDecimal(value decimal.Decimal, currency string) {
+	a.BookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalanceInformation1) AddSafekeepingPlace() *SafekeepingPlaceFormatChoice {
 	a.SafekeepingPlace = new(SafekeepingPlaceFormatChoice)
 	return a.SafekeepingPlace
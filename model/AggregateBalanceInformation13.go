@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Overall position, in a single security, held in a securities account at a specified place of safekeeping.
 type AggregateBalanceInformation13 struct {
 
@@ -61,6 +65,10 @@ type AggregateBalanceInformation13 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AggregateBalanceInformation13) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalanceInformation13) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	a.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return a.FinancialInstrumentIdentification
@@ -391,6 +399,11 @@ func (a *AggregateBalanceInformation13) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalanceInformation13) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalanceInformation13) AddAccountBaseCurrencyAmounts() *BalanceAmounts1 {
 	a.AccountBaseCurrencyAmounts = new(BalanceAmounts1)
 	return a.AccountBaseCurrencyAmounts
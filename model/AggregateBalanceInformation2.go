@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net position of a segregated holding, in a single security, within the overall position held in a securities account. A securities balance is calculated from the sum of securities' receipts minus the sum of securities' deliveries.
 type AggregateBalanceInformation2 struct {
 
@@ -40,6 +44,10 @@ type AggregateBalanceInformation2 struct {
 	BalanceAtSafekeepingPlace []*AggregateBalancePerSafekeepingPlace2 `xml:"BalAtSfkpgPlc,omitempty"`
 }
 
+func (a *AggregateBalanceInformation2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalanceInformation2) AddAggregateQuantity() *BalanceQuantity1Choice {
 	a.AggregateQuantity = new(BalanceQuantity1Choice)
 	return a.AggregateQuantity
@@ -49,6 +57,11 @@ func (a *AggregateBalanceInformation2) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalanceInformation2) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalanceInformation2) AddHoldingValue(value, currency string) {
 	a.HoldingValue = append(a.HoldingValue, NewActiveOrHistoricCurrencyAndAmount(value, currency))
 }
@@ -57,10 +70,18 @@ func (a *AggregateBalanceInformation2) SetAccruedInterestAmount(value, currency
 	a.AccruedInterestAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalanceInformation2) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.AccruedInterestAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalanceInformation2) SetBookValue(value, currency string) {
 	a.BookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalanceInformation2) SetBookValueFromDecimal(value decimal.Decimal, currency string) {
+	a.BookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalanceInformation2) AddSafekeepingPlace() *SafekeepingPlaceFormatChoice {
 	a.SafekeepingPlace = new(SafekeepingPlaceFormatChoice)
 	return a.SafekeepingPlace
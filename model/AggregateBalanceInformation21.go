@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Overall position, in a single security, held in a securities account at a specified place of safekeeping.
 type AggregateBalanceInformation21 struct {
 
@@ -64,6 +68,10 @@ type AggregateBalanceInformation21 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AggregateBalanceInformation21) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalanceInformation21) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	a.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return a.FinancialInstrumentIdentification
@@ -404,6 +412,11 @@ func (a *AggregateBalanceInformation21) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalanceInformation21) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalanceInformation21) AddAccountBaseCurrencyAmounts() *BalanceAmounts3 {
 	a.AccountBaseCurrencyAmounts = new(BalanceAmounts3)
 	return a.AccountBaseCurrencyAmounts
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net position of a segregated holding, in a single security, within the overall position held in a securities account. A securities balance is calculated from the sum of securities' receipts minus the sum of securities' deliveries.
 type AggregateBalanceInformation3 struct {
 
@@ -46,6 +50,10 @@ type AggregateBalanceInformation3 struct {
 	BalanceAtSafekeepingPlace []*AggregateBalancePerSafekeepingPlace4 `xml:"BalAtSfkpgPlc,omitempty"`
 }
 
+func (a *AggregateBalanceInformation3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalanceInformation3) AddAggregateQuantity() *BalanceQuantity1Choice {
 	a.AggregateQuantity = new(BalanceQuantity1Choice)
 	return a.AggregateQuantity
@@ -55,6 +63,11 @@ func (a *AggregateBalanceInformation3) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalanceInformation3) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalanceInformation3) AddHoldingValue(value, currency string) {
 	a.HoldingValue = append(a.HoldingValue, NewActiveOrHistoricCurrencyAndAmount(value, currency))
 }
@@ -63,18 +76,35 @@ func (a *AggregateBalanceInformation3) SetPreviousHoldingValue(value, currency s
 	a.PreviousHoldingValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalanceInformation3) SetPreviousHoldingValueFromDecimal(value decimal.Decimal, currency string) {
+	a.PreviousHoldingValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalanceInformation3) SetAccruedInterestAmount(value, currency string) {
 	a.AccruedInterestAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalanceInformation3) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.AccruedInterestAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalanceInformation3) SetAccruedInterestAmountSign(value string) {
 	a.AccruedInterestAmountSign = (*PlusOrMinusIndicator)(&value)
 }
 
+func (a *AggregateBalanceInformation3) SetAccruedInterestAmountSignFromBool(value bool) {
+	a.AccruedInterestAmountSign = new(PlusOrMinusIndicator)
+	a.AccruedInterestAmountSign.FromBool(value)
+}
+
 func (a *AggregateBalanceInformation3) SetBookValue(value, currency string) {
 	a.BookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalanceInformation3) SetBookValueFromDecimal(value decimal.Decimal, currency string) {
+	a.BookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalanceInformation3) AddSafekeepingPlace() *SafekeepingPlaceFormatChoice {
 	a.SafekeepingPlace = new(SafekeepingPlaceFormatChoice)
 	return a.SafekeepingPlace
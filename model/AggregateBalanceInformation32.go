@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Overall position, in a single security, held in a securities account at a specified place of safekeeping.
 type AggregateBalanceInformation32 struct {
 
@@ -61,6 +65,10 @@ type AggregateBalanceInformation32 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AggregateBalanceInformation32) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalanceInformation32) AddFinancialInstrumentIdentification() *SecurityIdentification20 {
 	a.FinancialInstrumentIdentification = new(SecurityIdentification20)
 	return a.FinancialInstrumentIdentification
@@ -391,6 +399,11 @@ func (a *AggregateBalanceInformation32) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalanceInformation32) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalanceInformation32) AddAccountBaseCurrencyAmounts() *BalanceAmounts5 {
 	a.AccountBaseCurrencyAmounts = new(BalanceAmounts5)
 	return a.AccountBaseCurrencyAmounts
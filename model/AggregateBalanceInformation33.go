@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Overall position, in a single security, held in a securities account at a specified place of safekeeping.
 type AggregateBalanceInformation33 struct {
 
@@ -64,6 +68,10 @@ type AggregateBalanceInformation33 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AggregateBalanceInformation33) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalanceInformation33) AddFinancialInstrumentIdentification() *SecurityIdentification20 {
 	a.FinancialInstrumentIdentification = new(SecurityIdentification20)
 	return a.FinancialInstrumentIdentification
@@ -404,6 +412,11 @@ func (a *AggregateBalanceInformation33) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalanceInformation33) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalanceInformation33) AddAccountBaseCurrencyAmounts() *BalanceAmounts4 {
 	a.AccountBaseCurrencyAmounts = new(BalanceAmounts4)
 	return a.AccountBaseCurrencyAmounts
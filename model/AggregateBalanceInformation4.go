@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net position of a segregated holding, in a single security, within the overall position held in a securities account. A securities balance is calculated from the sum of securities' receipts minus the sum of securities' deliveries.
 type AggregateBalanceInformation4 struct {
 
@@ -52,6 +56,10 @@ type AggregateBalanceInformation4 struct {
 	BalanceAtSafekeepingPlace []*AggregateBalancePerSafekeepingPlace3 `xml:"BalAtSfkpgPlc,omitempty"`
 }
 
+func (a *AggregateBalanceInformation4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalanceInformation4) AddAggregateQuantity() *BalanceQuantity1Choice {
 	a.AggregateQuantity = new(BalanceQuantity1Choice)
 	return a.AggregateQuantity
@@ -71,6 +79,11 @@ func (a *AggregateBalanceInformation4) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalanceInformation4) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalanceInformation4) AddHoldingValue(value, currency string) {
 	a.HoldingValue = append(a.HoldingValue, NewActiveOrHistoricCurrencyAndAmount(value, currency))
 }
@@ -79,18 +92,35 @@ func (a *AggregateBalanceInformation4) SetPreviousHoldingValue(value, currency s
 	a.PreviousHoldingValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalanceInformation4) SetPreviousHoldingValueFromDecimal(value decimal.Decimal, currency string) {
+	a.PreviousHoldingValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalanceInformation4) SetAccruedInterestAmount(value, currency string) {
 	a.AccruedInterestAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalanceInformation4) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.AccruedInterestAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalanceInformation4) SetAccruedInterestAmountSign(value string) {
 	a.AccruedInterestAmountSign = (*PlusOrMinusIndicator)(&value)
 }
 
+func (a *AggregateBalanceInformation4) SetAccruedInterestAmountSignFromBool(value bool) {
+	a.AccruedInterestAmountSign = new(PlusOrMinusIndicator)
+	a.AccruedInterestAmountSign.FromBool(value)
+}
+
 func (a *AggregateBalanceInformation4) SetBookValue(value, currency string) {
 	a.BookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalanceInformation4) SetBookValueFromDecimal(value decimal.Decimal, currency string) {
+	a.BookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalanceInformation4) AddSafekeepingPlace() *SafekeepingPlaceFormatChoice {
 	a.SafekeepingPlace = new(SafekeepingPlaceFormatChoice)
 	return a.SafekeepingPlace
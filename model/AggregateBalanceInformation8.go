@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Overall position, in a single security, held in a securities account at a specified place of safekeeping.
 type AggregateBalanceInformation8 struct {
 
@@ -61,6 +65,10 @@ type AggregateBalanceInformation8 struct {
 	Extension []*Extension2 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AggregateBalanceInformation8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalanceInformation8) AddFinancialInstrumentIdentification() *SecurityIdentification11 {
 	a.FinancialInstrumentIdentification = new(SecurityIdentification11)
 	return a.FinancialInstrumentIdentification
@@ -391,6 +399,11 @@ func (a *AggregateBalanceInformation8) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalanceInformation8) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalanceInformation8) AddAccountBaseCurrencyAmounts() *BalanceAmounts1 {
 	a.AccountBaseCurrencyAmounts = new(BalanceAmounts1)
 	return a.AccountBaseCurrencyAmounts
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Overall position, in a single security, held in a securities account at a specified place of safekeeping.
 type AggregateBalanceInformation9 struct {
 
@@ -64,6 +68,10 @@ type AggregateBalanceInformation9 struct {
 	Extension []*Extension2 `xml:"Xtnsn,omitempty"`
 }
 
+func (a *AggregateBalanceInformation9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalanceInformation9) AddFinancialInstrumentIdentification() *SecurityIdentification11 {
 	a.FinancialInstrumentIdentification = new(SecurityIdentification11)
 	return a.FinancialInstrumentIdentification
@@ -404,6 +412,11 @@ func (a *AggregateBalanceInformation9) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalanceInformation9) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalanceInformation9) AddAccountBaseCurrencyAmounts() *BalanceAmounts3 {
 	a.AccountBaseCurrencyAmounts = new(BalanceAmounts3)
 	return a.AccountBaseCurrencyAmounts
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net position of a segregated holding, in a single security, within the overall position held in a securities account at a specified place of safekeeping.
 type AggregateBalancePerSafekeepingPlace1 struct {
 
@@ -40,6 +44,10 @@ type AggregateBalancePerSafekeepingPlace1 struct {
 	AdditionalBalanceBreakdownDetails []*AdditionalBalanceInformation `xml:"AddtlBalBrkdwnDtls,omitempty"`
 }
 
+func (a *AggregateBalancePerSafekeepingPlace1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace1) AddAggregateQuantity() *BalanceQuantity1Choice {
 	a.AggregateQuantity = new(BalanceQuantity1Choice)
 	return a.AggregateQuantity
@@ -59,6 +67,11 @@ func (a *AggregateBalancePerSafekeepingPlace1) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace1) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace1) AddHoldingValue(value, currency string) {
 	a.HoldingValue = append(a.HoldingValue, NewActiveOrHistoricCurrencyAndAmount(value, currency))
 }
@@ -67,10 +80,18 @@ func (a *AggregateBalancePerSafekeepingPlace1) SetAccruedInterestAmount(value, c
 	a.AccruedInterestAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace1) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.AccruedInterestAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace1) SetBookValue(value, currency string) {
 	a.BookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace1) SetBookValueFromDecimal(value decimal.Decimal, currency string) {
+	a.BookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace1) AddSafekeepingPlace() *SafekeepingPlaceFormatChoice {
 	a.SafekeepingPlace = new(SafekeepingPlaceFormatChoice)
 	return a.SafekeepingPlace
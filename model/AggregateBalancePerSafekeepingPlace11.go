@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net position of a segregated holding, in a single security, within the overall position held in a securities account at a specified place of safekeeping.
 type AggregateBalancePerSafekeepingPlace11 struct {
 
@@ -46,6 +50,10 @@ type AggregateBalancePerSafekeepingPlace11 struct {
 	HoldingAdditionalDetails *Max350Text `xml:"HldgAddtlDtls,omitempty"`
 }
 
+func (a *AggregateBalancePerSafekeepingPlace11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace11) AddSafekeepingPlace() *SafekeepingPlaceFormat3Choice {
 	a.SafekeepingPlace = new(SafekeepingPlaceFormat3Choice)
 	return a.SafekeepingPlace
@@ -367,6 +375,11 @@ func (a *AggregateBalancePerSafekeepingPlace11) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace11) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace11) AddAccountBaseCurrencyAmounts() *BalanceAmounts3 {
 	a.AccountBaseCurrencyAmounts = new(BalanceAmounts3)
 	return a.AccountBaseCurrencyAmounts
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net position of a segregated holding, in a single security, within the overall position held in a securities account at a specified place of safekeeping.
 type AggregateBalancePerSafekeepingPlace16 struct {
 
@@ -43,6 +47,10 @@ type AggregateBalancePerSafekeepingPlace16 struct {
 	HoldingAdditionalDetails *Max350Text `xml:"HldgAddtlDtls,omitempty"`
 }
 
+func (a *AggregateBalancePerSafekeepingPlace16) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace16) AddSafekeepingPlace() *SafekeepingPlaceFormat3Choice {
 	a.SafekeepingPlace = new(SafekeepingPlaceFormat3Choice)
 	return a.SafekeepingPlace
@@ -354,6 +362,11 @@ func (a *AggregateBalancePerSafekeepingPlace16) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace16) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace16) AddAccountBaseCurrencyAmounts() *BalanceAmounts1 {
 	a.AccountBaseCurrencyAmounts = new(BalanceAmounts1)
 	return a.AccountBaseCurrencyAmounts
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net position of a segregated holding, in a single security, within the overall position held in a securities account at a specified place of safekeeping.
 type AggregateBalancePerSafekeepingPlace3 struct {
 
@@ -46,6 +50,10 @@ type AggregateBalancePerSafekeepingPlace3 struct {
 	AdditionalBalanceBreakdownDetails []*AdditionalBalanceInformation2 `xml:"AddtlBalBrkdwnDtls,omitempty"`
 }
 
+func (a *AggregateBalancePerSafekeepingPlace3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace3) AddAggregateQuantity() *BalanceQuantity1Choice {
 	a.AggregateQuantity = new(BalanceQuantity1Choice)
 	return a.AggregateQuantity
@@ -65,6 +73,11 @@ func (a *AggregateBalancePerSafekeepingPlace3) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace3) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace3) AddHoldingValue(value, currency string) {
 	a.HoldingValue = append(a.HoldingValue, NewActiveOrHistoricCurrencyAndAmount(value, currency))
 }
@@ -73,18 +86,35 @@ func (a *AggregateBalancePerSafekeepingPlace3) SetPreviousHoldingValue(value, cu
 	a.PreviousHoldingValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace3) SetPreviousHoldingValueFromDecimal(value decimal.Decimal, currency string) {
+	a.PreviousHoldingValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace3) SetAccruedInterestAmount(value, currency string) {
 	a.AccruedInterestAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace3) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.AccruedInterestAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace3) SetAccruedInterestAmountSign(value string) {
 	a.AccruedInterestAmountSign = (*PlusOrMinusIndicator)(&value)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace3) SetAccruedInterestAmountSignFromBool(value bool) {
+	a.AccruedInterestAmountSign = new(PlusOrMinusIndicator)
+	a.AccruedInterestAmountSign.FromBool(value)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace3) SetBookValue(value, currency string) {
 	a.BookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace3) SetBookValueFromDecimal(value decimal.Decimal, currency string) {
+	a.BookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace3) AddSafekeepingPlace() *SafekeepingPlaceFormatChoice {
 	a.SafekeepingPlace = new(SafekeepingPlaceFormatChoice)
 	return a.SafekeepingPlace
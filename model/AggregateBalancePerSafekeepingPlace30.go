@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net position of a segregated holding, in a single security, within the overall position held in a securities account at a specified place of safekeeping.
 type AggregateBalancePerSafekeepingPlace30 struct {
 
@@ -49,6 +53,10 @@ type AggregateBalancePerSafekeepingPlace30 struct {
 	HoldingAdditionalDetails *RestrictedFINXMax350Text `xml:"HldgAddtlDtls,omitempty"`
 }
 
+func (a *AggregateBalancePerSafekeepingPlace30) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace30) AddSafekeepingPlace() *SafeKeepingPlace2 {
 	a.SafekeepingPlace = new(SafeKeepingPlace2)
 	return a.SafekeepingPlace
@@ -365,6 +373,11 @@ func (a *AggregateBalancePerSafekeepingPlace30) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace30) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace30) AddAccountBaseCurrencyAmounts() *BalanceAmounts5 {
 	a.AccountBaseCurrencyAmounts = new(BalanceAmounts5)
 	return a.AccountBaseCurrencyAmounts
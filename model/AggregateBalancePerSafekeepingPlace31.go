@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net position of a segregated holding, in a single security, within the overall position held in a securities account at a specified place of safekeeping.
 type AggregateBalancePerSafekeepingPlace31 struct {
 
@@ -52,6 +56,10 @@ type AggregateBalancePerSafekeepingPlace31 struct {
 	HoldingAdditionalDetails *RestrictedFINXMax350Text `xml:"HldgAddtlDtls,omitempty"`
 }
 
+func (a *AggregateBalancePerSafekeepingPlace31) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace31) AddSafekeepingPlace() *SafeKeepingPlace2 {
 	a.SafekeepingPlace = new(SafeKeepingPlace2)
 	return a.SafekeepingPlace
@@ -378,6 +386,11 @@ func (a *AggregateBalancePerSafekeepingPlace31) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace31) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace31) AddAccountBaseCurrencyAmounts() *BalanceAmounts4 {
 	a.AccountBaseCurrencyAmounts = new(BalanceAmounts4)
 	return a.AccountBaseCurrencyAmounts
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net position of a segregated holding, in a single security, within the overall position held in a securities account at a specified place of safekeeping.
 type AggregateBalancePerSafekeepingPlace4 struct {
 
@@ -40,6 +44,10 @@ type AggregateBalancePerSafekeepingPlace4 struct {
 	AdditionalBalanceBreakdownDetails []*AdditionalBalanceInformation2 `xml:"AddtlBalBrkdwnDtls,omitempty"`
 }
 
+func (a *AggregateBalancePerSafekeepingPlace4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace4) AddAggregateBalance() *BalanceQuantity1Choice {
 	a.AggregateBalance = new(BalanceQuantity1Choice)
 	return a.AggregateBalance
@@ -49,6 +57,11 @@ func (a *AggregateBalancePerSafekeepingPlace4) SetDaysAccrued(value string) {
 	a.DaysAccrued = (*Number)(&value)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace4) SetDaysAccruedFromDecimal(value decimal.Decimal) {
+	a.DaysAccrued = new(Number)
+	a.DaysAccrued.FromDecimal(value)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace4) AddHoldingValue(value, currency string) {
 	a.HoldingValue = append(a.HoldingValue, NewActiveOrHistoricCurrencyAndAmount(value, currency))
 }
@@ -57,18 +70,35 @@ func (a *AggregateBalancePerSafekeepingPlace4) SetPreviousHoldingValue(value, cu
 	a.PreviousHoldingValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace4) SetPreviousHoldingValueFromDecimal(value decimal.Decimal, currency string) {
+	a.PreviousHoldingValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace4) SetAccruedInterestAmount(value, currency string) {
 	a.AccruedInterestAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace4) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.AccruedInterestAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace4) SetAccruedInterestAmountSign(value string) {
 	a.AccruedInterestAmountSign = (*PlusOrMinusIndicator)(&value)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace4) SetAccruedInterestAmountSignFromBool(value bool) {
+	a.AccruedInterestAmountSign = new(PlusOrMinusIndicator)
+	a.AccruedInterestAmountSign.FromBool(value)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace4) SetBookValue(value, currency string) {
 	a.BookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AggregateBalancePerSafekeepingPlace4) SetBookValueFromDecimal(value decimal.Decimal, currency string) {
+	a.BookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AggregateBalancePerSafekeepingPlace4) AddSafekeepingPlace() *SafekeepingPlaceFormatChoice {
 	a.SafekeepingPlace = new(SafekeepingPlaceFormatChoice)
 	return a.SafekeepingPlace
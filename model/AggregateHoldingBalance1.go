@@ -19,6 +19,10 @@ type AggregateHoldingBalance1 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AggregateHoldingBalance1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateHoldingBalance1) AddFinancialInstrumentIdentification() *SecurityIdentification19 {
 	a.FinancialInstrumentIdentification = new(SecurityIdentification19)
 	return a.FinancialInstrumentIdentification
@@ -13,6 +13,10 @@ type AggregateHoldingBalance2 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (a *AggregateHoldingBalance2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateHoldingBalance2) AddFinancialInstrumentIdentification() *SecurityIdentification19 {
 	a.FinancialInstrumentIdentification = new(SecurityIdentification19)
 	return a.FinancialInstrumentIdentification
@@ -10,6 +10,10 @@ type AggregateHoldingBalance3 struct {
 	Agent []*Intermediary29 `xml:"Agt,omitempty"`
 }
 
+func (a *AggregateHoldingBalance3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregateHoldingBalance3) AddBalanceForAccount() *AggregateHoldingBalance1 {
 	newValue := new(AggregateHoldingBalance1)
 	a.BalanceForAccount = append(a.BalanceForAccount, newValue)
@@ -16,6 +16,10 @@ type AggregatedIndependentAmount1 struct {
 	OtherAmount []*IndependentAmount2 `xml:"OthrAmt,omitempty"`
 }
 
+func (a *AggregatedIndependentAmount1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregatedIndependentAmount1) AddTrade() *IndependentAmount1 {
 	a.Trade = new(IndependentAmount1)
 	return a.Trade
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Payment transaction with an aggregated amount.
 type AggregationTransaction1 struct {
 
@@ -16,18 +22,37 @@ type AggregationTransaction1 struct {
 	IndividualPayment []*DetailedAmount6 `xml:"IndvPmt,omitempty"`
 }
 
+func (a *AggregationTransaction1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregationTransaction1) SetFirstPaymentDateTime(value string) {
 	a.FirstPaymentDateTime = (*ISODateTime)(&value)
 }
 
+func (a *AggregationTransaction1) SetFirstPaymentDateTimeFromTime(value time.Time) {
+	a.FirstPaymentDateTime = new(ISODateTime)
+	a.FirstPaymentDateTime.FromTime(value)
+}
+
 func (a *AggregationTransaction1) SetLastPaymentDateTime(value string) {
 	a.LastPaymentDateTime = (*ISODateTime)(&value)
 }
 
+func (a *AggregationTransaction1) SetLastPaymentDateTimeFromTime(value time.Time) {
+	a.LastPaymentDateTime = new(ISODateTime)
+	a.LastPaymentDateTime.FromTime(value)
+}
+
 func (a *AggregationTransaction1) SetNumberOfPayments(value string) {
 	a.NumberOfPayments = (*Number)(&value)
 }
 
+func (a *AggregationTransaction1) SetNumberOfPaymentsFromDecimal(value decimal.Decimal) {
+	a.NumberOfPayments = new(Number)
+	a.NumberOfPayments.FromDecimal(value)
+}
+
 func (a *AggregationTransaction1) AddIndividualPayment() *DetailedAmount6 {
 	newValue := new(DetailedAmount6)
 	a.IndividualPayment = append(a.IndividualPayment, newValue)
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Payment transaction with an aggregated amount.
 type AggregationTransaction2 struct {
 
@@ -16,18 +22,37 @@ type AggregationTransaction2 struct {
 	IndividualPayment []*DetailedAmount14 `xml:"IndvPmt,omitempty"`
 }
 
+func (a *AggregationTransaction2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AggregationTransaction2) SetFirstPaymentDateTime(value string) {
 	a.FirstPaymentDateTime = (*ISODateTime)(&value)
 }
 
+func (a *AggregationTransaction2) SetFirstPaymentDateTimeFromTime(value time.Time) {
+	a.FirstPaymentDateTime = new(ISODateTime)
+	a.FirstPaymentDateTime.FromTime(value)
+}
+
 func (a *AggregationTransaction2) SetLastPaymentDateTime(value string) {
 	a.LastPaymentDateTime = (*ISODateTime)(&value)
 }
 
+func (a *AggregationTransaction2) SetLastPaymentDateTimeFromTime(value time.Time) {
+	a.LastPaymentDateTime = new(ISODateTime)
+	a.LastPaymentDateTime.FromTime(value)
+}
+
 func (a *AggregationTransaction2) SetNumberOfPayments(value string) {
 	a.NumberOfPayments = (*Number)(&value)
 }
 
+func (a *AggregationTransaction2) SetNumberOfPaymentsFromDecimal(value decimal.Decimal) {
+	a.NumberOfPayments = new(Number)
+	a.NumberOfPayments.FromDecimal(value)
+}
+
 func (a *AggregationTransaction2) AddIndividualPayment() *DetailedAmount14 {
 	newValue := new(DetailedAmount14)
 	a.IndividualPayment = append(a.IndividualPayment, newValue)
@@ -10,6 +10,10 @@ type AgreedAmount1 struct {
 	SegregatedIndependentAmount *Amount1 `xml:"SgrtdIndpdntAmt,omitempty"`
 }
 
+func (a *AgreedAmount1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AgreedAmount1) AddVariationMarginAmount() *Amount1 {
 	a.VariationMarginAmount = new(Amount1)
 	return a.VariationMarginAmount
@@ -10,6 +10,10 @@ type AgreedAmount1Choice struct {
 	SegregatedIndependentAmount *Amount1 `xml:"SgrtdIndpdntAmt"`
 }
 
+func (a *AgreedAmount1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AgreedAmount1Choice) AddAgreedAmountDetails() *AgreedAmount1 {
 	a.AgreedAmountDetails = new(AgreedAmount1)
 	return a.AgreedAmountDetails
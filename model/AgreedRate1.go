@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information needed to process a currency exchange or conversion.
 type AgreedRate1 struct {
 
@@ -13,10 +17,19 @@ type AgreedRate1 struct {
 	QuotedCurrency *CurrencyCode `xml:"QtdCcy,omitempty"`
 }
 
+func (a *AgreedRate1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AgreedRate1) SetExchangeRate(value string) {
 	a.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (a *AgreedRate1) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	a.ExchangeRate = new(BaseOneRate)
+	a.ExchangeRate.FromDecimal(value)
+}
+
 func (a *AgreedRate1) SetUnitCurrency(value string) {
 	a.UnitCurrency = (*CurrencyCode)(&value)
 }
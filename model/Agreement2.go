@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Agreement details for the over the counter market.
 type Agreement2 struct {
 
@@ -19,6 +23,10 @@ type Agreement2 struct {
 	AgreementFramework *AgreementFramework1Choice `xml:"AgrmtFrmwk,omitempty"`
 }
 
+func (a *Agreement2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Agreement2) SetAgreementDetails(value string) {
 	a.AgreementDetails = (*Max140Text)(&value)
 }
@@ -31,6 +39,11 @@ func (a *Agreement2) SetAgreementDate(value string) {
 	a.AgreementDate = (*ISODate)(&value)
 }
 
+func (a *Agreement2) SetAgreementDateFromTime(value time.Time) {
+	a.AgreementDate = new(ISODate)
+	a.AgreementDate.FromTime(value)
+}
+
 func (a *Agreement2) SetBaseCurrency(value string) {
 	a.BaseCurrency = (*CurrencyCode)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Contractual details related to the agreement between parties.
 type Agreement3 struct {
 
@@ -25,6 +31,10 @@ type Agreement3 struct {
 	MarginRatio *PercentageRate `xml:"MrgnRatio,omitempty"`
 }
 
+func (a *Agreement3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Agreement3) SetDescription(value string) {
 	a.Description = (*Max350Text)(&value)
 }
@@ -33,6 +43,11 @@ func (a *Agreement3) SetDate(value string) {
 	a.Date = (*ISODateTime)(&value)
 }
 
+func (a *Agreement3) SetDateFromTime(value time.Time) {
+	a.Date = new(ISODateTime)
+	a.Date.FromTime(value)
+}
+
 func (a *Agreement3) SetCurrency(value string) {
 	a.Currency = (*CurrencyCode)(&value)
 }
@@ -45,6 +60,11 @@ func (a *Agreement3) SetStartDate(value string) {
 	a.StartDate = (*ISODateTime)(&value)
 }
 
+func (a *Agreement3) SetStartDateFromTime(value time.Time) {
+	a.StartDate = new(ISODateTime)
+	a.StartDate.FromTime(value)
+}
+
 func (a *Agreement3) SetDeliveryType(value string) {
 	a.DeliveryType = (*DeliveryType2Code)(&value)
 }
@@ -52,3 +72,8 @@ func (a *Agreement3) SetDeliveryType(value string) {
 func (a *Agreement3) SetMarginRatio(value string) {
 	a.MarginRatio = (*PercentageRate)(&value)
 }
+
+func (a *Agreement3) SetMarginRatioFromDecimal(value decimal.Decimal) {
+	a.MarginRatio = new(PercentageRate)
+	a.MarginRatio.FromDecimal(value)
+}
@@ -10,6 +10,10 @@ type AgreementClauses1 struct {
 	DocumentURL *Max350Text `xml:"DocURL,omitempty"`
 }
 
+func (a *AgreementClauses1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AgreementClauses1) SetDescription(value string) {
 	a.Description = (*Max256Text)(&value)
 }
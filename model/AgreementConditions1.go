@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the type, date and version of the agreement.
 type AgreementConditions1 struct {
 
@@ -13,6 +17,10 @@ type AgreementConditions1 struct {
 	Version *Exact4NumericText `xml:"Vrsn,omitempty"`
 }
 
+func (a *AgreementConditions1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AgreementConditions1) SetAgreementCode(value string) {
 	a.AgreementCode = (*Max6AlphaText)(&value)
 }
@@ -21,6 +29,11 @@ func (a *AgreementConditions1) SetDate(value string) {
 	a.Date = (*ISODate)(&value)
 }
 
+func (a *AgreementConditions1) SetDateFromTime(value time.Time) {
+	a.Date = new(ISODate)
+	a.Date.FromTime(value)
+}
+
 func (a *AgreementConditions1) SetVersion(value string) {
 	a.Version = (*Exact4NumericText)(&value)
 }
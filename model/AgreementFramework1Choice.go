@@ -10,6 +10,10 @@ type AgreementFramework1Choice struct {
 	ProprietaryIdentification *GenericIdentification30 `xml:"PrtryId"`
 }
 
+func (a *AgreementFramework1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AgreementFramework1Choice) SetAgreementFramework(value string) {
 	a.AgreementFramework = (*AgreementFramework1Code)(&value)
 }
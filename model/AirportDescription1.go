@@ -10,6 +10,10 @@ type AirportDescription1 struct {
 	AirportName *Max35Text `xml:"AirprtNm,omitempty"`
 }
 
+func (a *AirportDescription1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AirportDescription1) SetTown(value string) {
 	a.Town = (*Max35Text)(&value)
 }
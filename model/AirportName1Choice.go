@@ -10,6 +10,10 @@ type AirportName1Choice struct {
 	OtherAirportDescription *AirportDescription1 `xml:"OthrAirprtDesc"`
 }
 
+func (a *AirportName1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AirportName1Choice) SetAirportCode(value string) {
 	a.AirportCode = (*Max6Text)(&value)
 }
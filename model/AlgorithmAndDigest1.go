@@ -10,6 +10,10 @@ type AlgorithmAndDigest1 struct {
 	Digest *Max140Text `xml:"Dgst"`
 }
 
+func (a *AlgorithmAndDigest1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmAndDigest1) SetDigestAlgorithm(value string) {
 	a.DigestAlgorithm = (*Algorithm5Code)(&value)
 }
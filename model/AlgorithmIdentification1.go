@@ -10,6 +10,10 @@ type AlgorithmIdentification1 struct {
 	Parameter *Parameter1 `xml:"Param,omitempty"`
 }
 
+func (a *AlgorithmIdentification1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification1) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm1Code)(&value)
 }
@@ -10,6 +10,10 @@ type AlgorithmIdentification10 struct {
 	Parameter *Parameter1 `xml:"Param,omitempty"`
 }
 
+func (a *AlgorithmIdentification10) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification10) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm10Code)(&value)
 }
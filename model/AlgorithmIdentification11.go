@@ -10,6 +10,10 @@ type AlgorithmIdentification11 struct {
 	Parameter *Parameter4 `xml:"Param,omitempty"`
 }
 
+func (a *AlgorithmIdentification11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification11) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm7Code)(&value)
 }
@@ -10,6 +10,10 @@ type AlgorithmIdentification12 struct {
 	Parameter *Parameter5 `xml:"Param,omitempty"`
 }
 
+func (a *AlgorithmIdentification12) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification12) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm8Code)(&value)
 }
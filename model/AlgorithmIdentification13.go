@@ -10,6 +10,10 @@ type AlgorithmIdentification13 struct {
 	Parameter *Parameter6 `xml:"Param,omitempty"`
 }
 
+func (a *AlgorithmIdentification13) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification13) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm13Code)(&value)
 }
@@ -10,6 +10,10 @@ type AlgorithmIdentification14 struct {
 	Parameter *Parameter6 `xml:"Param,omitempty"`
 }
 
+func (a *AlgorithmIdentification14) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification14) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm15Code)(&value)
 }
@@ -10,6 +10,10 @@ type AlgorithmIdentification15 struct {
 	Parameter *Parameter7 `xml:"Param,omitempty"`
 }
 
+func (a *AlgorithmIdentification15) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification15) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm12Code)(&value)
 }
@@ -7,6 +7,10 @@ type AlgorithmIdentification16 struct {
 	Algorithm *Algorithm11Code `xml:"Algo"`
 }
 
+func (a *AlgorithmIdentification16) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification16) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm11Code)(&value)
 }
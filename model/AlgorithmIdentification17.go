@@ -10,6 +10,10 @@ type AlgorithmIdentification17 struct {
 	Parameter *Parameter8 `xml:"Param,omitempty"`
 }
 
+func (a *AlgorithmIdentification17) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification17) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm14Code)(&value)
 }
@@ -10,6 +10,10 @@ type AlgorithmIdentification2 struct {
 	Parameter *Parameter1 `xml:"Param,omitempty"`
 }
 
+func (a *AlgorithmIdentification2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification2) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm2Code)(&value)
 }
@@ -10,6 +10,10 @@ type AlgorithmIdentification3 struct {
 	Parameter *Parameter1 `xml:"Param,omitempty"`
 }
 
+func (a *AlgorithmIdentification3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification3) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm3Code)(&value)
 }
@@ -7,6 +7,10 @@ type AlgorithmIdentification4 struct {
 	Algorithm *Algorithm4Code `xml:"Algo"`
 }
 
+func (a *AlgorithmIdentification4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification4) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm4Code)(&value)
 }
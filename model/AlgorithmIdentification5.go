@@ -7,6 +7,10 @@ type AlgorithmIdentification5 struct {
 	Algorithm *Algorithm5Code `xml:"Algo"`
 }
 
+func (a *AlgorithmIdentification5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification5) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm5Code)(&value)
 }
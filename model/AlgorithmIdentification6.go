@@ -10,6 +10,10 @@ type AlgorithmIdentification6 struct {
 	Parameter *Parameter1 `xml:"Param,omitempty"`
 }
 
+func (a *AlgorithmIdentification6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification6) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm6Code)(&value)
 }
@@ -10,6 +10,10 @@ type AlgorithmIdentification7 struct {
 	Parameter *Parameter2 `xml:"Param,omitempty"`
 }
 
+func (a *AlgorithmIdentification7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification7) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm7Code)(&value)
 }
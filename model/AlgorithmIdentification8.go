@@ -10,6 +10,10 @@ type AlgorithmIdentification8 struct {
 	Parameter *Parameter3 `xml:"Param,omitempty"`
 }
 
+func (a *AlgorithmIdentification8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification8) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm8Code)(&value)
 }
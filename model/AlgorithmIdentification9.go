@@ -10,6 +10,10 @@ type AlgorithmIdentification9 struct {
 	Parameter *Parameter1 `xml:"Param,omitempty"`
 }
 
+func (a *AlgorithmIdentification9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlgorithmIdentification9) SetAlgorithm(value string) {
 	a.Algorithm = (*Algorithm9Code)(&value)
 }
@@ -10,6 +10,10 @@ type AllegementMatchingReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AllegementMatchingReason1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AllegementMatchingReason1) AddCode() *AllegementReason1Choice {
 	a.Code = new(AllegementReason1Choice)
 	return a.Code
@@ -10,6 +10,10 @@ type AllegementReason1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (a *AllegementReason1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AllegementReason1Choice) SetCode(value string) {
 	a.Code = (*AllegementReason1Code)(&value)
 }
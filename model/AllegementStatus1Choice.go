@@ -10,6 +10,10 @@ type AllegementStatus1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AllegementStatus1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AllegementStatus1Choice) SetCode(value string) {
 	a.Code = (*AllegementStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type AllegementStatus3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (a *AllegementStatus3Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AllegementStatus3Choice) SetCode(value string) {
 	a.Code = (*AllegementStatus1Code)(&value)
 }
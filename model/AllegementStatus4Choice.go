@@ -10,6 +10,10 @@ type AllegementStatus4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AllegementStatus4Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AllegementStatus4Choice) SetCode(value string) {
 	a.Code = (*AllegementStatus1Code)(&value)
 }
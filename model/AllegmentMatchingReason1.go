@@ -10,6 +10,10 @@ type AllegmentMatchingReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AllegmentMatchingReason1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AllegmentMatchingReason1) AddCode() *AllegmentReason1Choice {
 	a.Code = new(AllegmentReason1Choice)
 	return a.Code
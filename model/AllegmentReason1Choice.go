@@ -10,6 +10,10 @@ type AllegmentReason1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (a *AllegmentReason1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AllegmentReason1Choice) SetCode(value string) {
 	a.Code = (*AllegementReason1Code)(&value)
 }
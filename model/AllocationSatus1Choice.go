@@ -10,6 +10,10 @@ type AllocationSatus1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AllocationSatus1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AllocationSatus1Choice) SetCode(value string) {
 	a.Code = (*AllocationStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type AllocationSatus3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (a *AllocationSatus3Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AllocationSatus3Choice) SetCode(value string) {
 	a.Code = (*AllocationStatus1Code)(&value)
 }
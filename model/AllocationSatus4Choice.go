@@ -10,6 +10,10 @@ type AllocationSatus4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AllocationSatus4Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AllocationSatus4Choice) SetCode(value string) {
 	a.Code = (*AllocationStatus1Code)(&value)
 }
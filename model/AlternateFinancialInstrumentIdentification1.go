@@ -13,6 +13,10 @@ type AlternateFinancialInstrumentIdentification1 struct {
 	Identification *Max35Text `xml:"Id"`
 }
 
+func (a *AlternateFinancialInstrumentIdentification1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlternateFinancialInstrumentIdentification1) SetDomesticIdentificationSource(value string) {
 	a.DomesticIdentificationSource = (*CountryCode)(&value)
 }
@@ -10,6 +10,10 @@ type AlternateIdentification1 struct {
 	IdentificationSource *IdentificationSource1Choice `xml:"IdSrc"`
 }
 
+func (a *AlternateIdentification1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlternateIdentification1) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
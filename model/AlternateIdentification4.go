@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information related to the identification of an individual person.
 type AlternateIdentification4 struct {
 
@@ -22,6 +26,10 @@ type AlternateIdentification4 struct {
 	IssuerCountry *CountryCode `xml:"IssrCtry,omitempty"`
 }
 
+func (a *AlternateIdentification4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlternateIdentification4) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -39,10 +47,20 @@ func (a *AlternateIdentification4) SetIssueDate(value string) {
 	a.IssueDate = (*ISODate)(&value)
 }
 
+func (a *AlternateIdentification4) SetIssueDateFromTime(value time.Time) {
+	a.IssueDate = new(ISODate)
+	a.IssueDate.FromTime(value)
+}
+
 func (a *AlternateIdentification4) SetExpiryDate(value string) {
 	a.ExpiryDate = (*ISODate)(&value)
 }
 
+func (a *AlternateIdentification4) SetExpiryDateFromTime(value time.Time) {
+	a.ExpiryDate = new(ISODate)
+	a.ExpiryDate.FromTime(value)
+}
+
 func (a *AlternateIdentification4) SetIssuerCountry(value string) {
 	a.IssuerCountry = (*CountryCode)(&value)
 }
@@ -13,6 +13,10 @@ type AlternatePartyIdentification2 struct {
 	AlternateIdentification *Max35Text `xml:"AltrnId"`
 }
 
+func (a *AlternatePartyIdentification2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlternatePartyIdentification2) AddIdentificationType() *IdentificationType4Choice {
 	a.IdentificationType = new(IdentificationType4Choice)
 	return a.IdentificationType
@@ -13,6 +13,10 @@ type AlternatePartyIdentification4 struct {
 	AlternateIdentification *Max35Text `xml:"AltrnId"`
 }
 
+func (a *AlternatePartyIdentification4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlternatePartyIdentification4) AddIdentificationType() *IdentificationType6Choice {
 	a.IdentificationType = new(IdentificationType6Choice)
 	return a.IdentificationType
@@ -13,6 +13,10 @@ type AlternatePartyIdentification5 struct {
 	AlternateIdentification *Max35Text `xml:"AltrnId"`
 }
 
+func (a *AlternatePartyIdentification5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlternatePartyIdentification5) AddIdentificationType() *IdentificationType40Choice {
 	a.IdentificationType = new(IdentificationType40Choice)
 	return a.IdentificationType
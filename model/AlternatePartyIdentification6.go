@@ -13,6 +13,10 @@ type AlternatePartyIdentification6 struct {
 	AlternateIdentification *Max35Text `xml:"AltrnId"`
 }
 
+func (a *AlternatePartyIdentification6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlternatePartyIdentification6) AddTypeOfIdentification() *IdentificationType41Choice {
 	a.TypeOfIdentification = new(IdentificationType41Choice)
 	return a.TypeOfIdentification
@@ -13,6 +13,10 @@ type AlternatePartyIdentification7 struct {
 	AlternateIdentification *Max35Text `xml:"AltrnId"`
 }
 
+func (a *AlternatePartyIdentification7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlternatePartyIdentification7) AddIdentificationType() *IdentificationType42Choice {
 	a.IdentificationType = new(IdentificationType42Choice)
 	return a.IdentificationType
@@ -13,6 +13,10 @@ type AlternatePartyIdentification8 struct {
 	AlternateIdentification *Max35Text `xml:"AltrnId"`
 }
 
+func (a *AlternatePartyIdentification8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlternatePartyIdentification8) AddIdentificationType() *IdentificationType43Choice {
 	a.IdentificationType = new(IdentificationType43Choice)
 	return a.IdentificationType
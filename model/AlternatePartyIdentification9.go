@@ -13,6 +13,10 @@ type AlternatePartyIdentification9 struct {
 	AlternateIdentification *RestrictedFINXMax30Text `xml:"AltrnId"`
 }
 
+func (a *AlternatePartyIdentification9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlternatePartyIdentification9) AddIdentificationType() *IdentificationType44Choice {
 	a.IdentificationType = new(IdentificationType44Choice)
 	return a.IdentificationType
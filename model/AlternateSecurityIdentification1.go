@@ -13,6 +13,10 @@ type AlternateSecurityIdentification1 struct {
 	ProprietaryIdentificationSource *Max35Text `xml:"PrtryIdSrc"`
 }
 
+func (a *AlternateSecurityIdentification1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlternateSecurityIdentification1) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type AlternateSecurityIdentification2 struct {
 	Identification *Max35Text `xml:"Id"`
 }
 
+func (a *AlternateSecurityIdentification2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlternateSecurityIdentification2) SetType(value string) {
 	a.Type = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type AlternateSecurityIdentification3 struct {
 	ProprietaryIdentificationSource *Max35Text `xml:"PrtryIdSrc"`
 }
 
+func (a *AlternateSecurityIdentification3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlternateSecurityIdentification3) SetIdentification(value string) {
 	a.Identification = (*Max70Text)(&value)
 }
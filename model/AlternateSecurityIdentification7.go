@@ -10,6 +10,10 @@ type AlternateSecurityIdentification7 struct {
 	IdentificationSource *IdentificationSource1Choice `xml:"IdSrc"`
 }
 
+func (a *AlternateSecurityIdentification7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AlternateSecurityIdentification7) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
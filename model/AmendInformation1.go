@@ -10,6 +10,10 @@ type AmendInformation1 struct {
 	ReconfirmInstructions *YesNoIndicator `xml:"RcnfrmInstrs"`
 }
 
+func (a *AmendInformation1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmendInformation1) AddPreviousReference() *MessageIdentification {
 	a.PreviousReference = new(MessageIdentification)
 	return a.PreviousReference
@@ -18,3 +22,8 @@ func (a *AmendInformation1) AddPreviousReference() *MessageIdentification {
 func (a *AmendInformation1) SetReconfirmInstructions(value string) {
 	a.ReconfirmInstructions = (*YesNoIndicator)(&value)
 }
+
+func (a *AmendInformation1) SetReconfirmInstructionsFromBool(value bool) {
+	a.ReconfirmInstructions = new(YesNoIndicator)
+	a.ReconfirmInstructions.FromBool(value)
+}
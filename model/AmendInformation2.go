@@ -7,6 +7,10 @@ type AmendInformation2 struct {
 	PreviousReference *MessageIdentification `xml:"PrvsRef"`
 }
 
+func (a *AmendInformation2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmendInformation2) AddPreviousReference() *MessageIdentification {
 	a.PreviousReference = new(MessageIdentification)
 	return a.PreviousReference
@@ -7,6 +7,10 @@ type AmendInformation3 struct {
 	PreviousReference *MessageIdentification `xml:"PrvsRef"`
 }
 
+func (a *AmendInformation3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmendInformation3) AddPreviousReference() *MessageIdentification {
 	a.PreviousReference = new(MessageIdentification)
 	return a.PreviousReference
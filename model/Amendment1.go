@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Details of the amendment.
 type Amendment1 struct {
 
@@ -49,6 +53,10 @@ type Amendment1 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (a *Amendment1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Amendment1) SetSequenceNumber(value string) {
 	a.SequenceNumber = (*Max4AlphaNumericText)(&value)
 }
@@ -57,6 +65,11 @@ func (a *Amendment1) SetDateOfIssuance(value string) {
 	a.DateOfIssuance = (*ISODate)(&value)
 }
 
+func (a *Amendment1) SetDateOfIssuanceFromTime(value time.Time) {
+	a.DateOfIssuance = new(ISODate)
+	a.DateOfIssuance.FromTime(value)
+}
+
 func (a *Amendment1) AddUndertakingIdentification() *Undertaking7 {
 	a.UndertakingIdentification = new(Undertaking7)
 	return a.UndertakingIdentification
@@ -247,6 +260,11 @@ func (a *Amendment1) SetBeneficiaryConsentRequestIndicator(value string) {
 	a.BeneficiaryConsentRequestIndicator = (*YesNoIndicator)(&value)
 }
 
+func (a *Amendment1) SetBeneficiaryConsentRequestIndicatorFromBool(value bool) {
+	a.BeneficiaryConsentRequestIndicator = new(YesNoIndicator)
+	a.BeneficiaryConsentRequestIndicator.FromBool(value)
+}
+
 func (a *Amendment1) AddDeliveryChannel() *CommunicationChannel1 {
 	a.DeliveryChannel = new(CommunicationChannel1)
 	return a.DeliveryChannel
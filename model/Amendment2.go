@@ -19,6 +19,10 @@ type Amendment2 struct {
 	DigitalSignature []*PartyAndSignature2 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (a *Amendment2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Amendment2) AddUndertakingAmendmentMessage() *UndertakingAmendmentMessage1 {
 	a.UndertakingAmendmentMessage = new(UndertakingAmendmentMessage1)
 	return a.UndertakingAmendmentMessage
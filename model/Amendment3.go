@@ -40,6 +40,10 @@ type Amendment3 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (a *Amendment3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Amendment3) SetApplicantRequestNumber(value string) {
 	a.ApplicantRequestNumber = (*Max35Text)(&value)
 }
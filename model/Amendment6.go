@@ -13,6 +13,10 @@ type Amendment6 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (a *Amendment6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Amendment6) AddUndertakingAmendmentMessage() *UndertakingAmendmentMessage1 {
 	a.UndertakingAmendmentMessage = new(UndertakingAmendmentMessage1)
 	return a.UndertakingAmendmentMessage
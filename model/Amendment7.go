@@ -10,6 +10,10 @@ type Amendment7 struct {
 	AmendmentStatus *UndertakingStatus2Code `xml:"AmdmntSts"`
 }
 
+func (a *Amendment7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Amendment7) AddAmendmentIdentification() *Amendment8 {
 	a.AmendmentIdentification = new(Amendment8)
 	return a.AmendmentIdentification
@@ -16,6 +16,10 @@ type Amendment8 struct {
 	Issuer *PartyIdentification43 `xml:"Issr"`
 }
 
+func (a *Amendment8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Amendment8) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -7,6 +7,10 @@ type Amendment9 struct {
 	UndertakingAmendmentResponseMessage *UndertakingAmendmentResponseMessage1 `xml:"UdrtkgAmdmntRspnMsg"`
 }
 
+func (a *Amendment9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Amendment9) AddUndertakingAmendmentResponseMessage() *UndertakingAmendmentResponseMessage1 {
 	a.UndertakingAmendmentResponseMessage = new(UndertakingAmendmentResponseMessage1)
 	return a.UndertakingAmendmentResponseMessage
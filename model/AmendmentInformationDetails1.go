@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Amendment information details providing the list of direct debit mandate elements that have been modified when the amendment indicator has been set.
 type AmendmentInformationDetails1 struct {
 
@@ -34,6 +38,10 @@ type AmendmentInformationDetails1 struct {
 	OriginalFrequency *Frequency1Code `xml:"OrgnlFrqcy,omitempty"`
 }
 
+func (a *AmendmentInformationDetails1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmendmentInformationDetails1) SetOriginalMandateIdentification(value string) {
 	a.OriginalMandateIdentification = (*Max35Text)(&value)
 }
@@ -77,6 +85,11 @@ func (a *AmendmentInformationDetails1) SetOriginalFinalCollectionDate(value stri
 	a.OriginalFinalCollectionDate = (*ISODate)(&value)
 }
 
+func (a *AmendmentInformationDetails1) SetOriginalFinalCollectionDateFromTime(value time.Time) {
+	a.OriginalFinalCollectionDate = new(ISODate)
+	a.OriginalFinalCollectionDate.FromTime(value)
+}
+
 func (a *AmendmentInformationDetails1) SetOriginalFrequency(value string) {
 	a.OriginalFrequency = (*Frequency1Code)(&value)
 }
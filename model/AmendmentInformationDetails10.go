@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details on the list of direct debit mandate elements that have been modified when the amendment indicator has been set.
 type AmendmentInformationDetails10 struct {
 
@@ -37,6 +41,10 @@ type AmendmentInformationDetails10 struct {
 	OriginalReason *MandateSetupReason1Choice `xml:"OrgnlRsn,omitempty"`
 }
 
+func (a *AmendmentInformationDetails10) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmendmentInformationDetails10) SetOriginalMandateIdentification(value string) {
 	a.OriginalMandateIdentification = (*Max35Text)(&value)
 }
@@ -80,6 +88,11 @@ func (a *AmendmentInformationDetails10) SetOriginalFinalCollectionDate(value str
 	a.OriginalFinalCollectionDate = (*ISODate)(&value)
 }
 
+func (a *AmendmentInformationDetails10) SetOriginalFinalCollectionDateFromTime(value time.Time) {
+	a.OriginalFinalCollectionDate = new(ISODate)
+	a.OriginalFinalCollectionDate.FromTime(value)
+}
+
 func (a *AmendmentInformationDetails10) AddOriginalFrequency() *Frequency21Choice {
 	a.OriginalFrequency = new(Frequency21Choice)
 	return a.OriginalFrequency
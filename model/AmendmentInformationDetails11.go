@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides further details on the list of direct debit mandate elements that have been modified when the amendment indicator has been set.
 type AmendmentInformationDetails11 struct {
 
@@ -40,6 +46,10 @@ type AmendmentInformationDetails11 struct {
 	OriginalTrackingDays *Exact2NumericText `xml:"OrgnlTrckgDays,omitempty"`
 }
 
+func (a *AmendmentInformationDetails11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmendmentInformationDetails11) SetOriginalMandateIdentification(value string) {
 	a.OriginalMandateIdentification = (*Max35Text)(&value)
 }
@@ -83,6 +93,11 @@ func (a *AmendmentInformationDetails11) SetOriginalFinalCollectionDate(value str
 	a.OriginalFinalCollectionDate = (*ISODate)(&value)
 }
 
+func (a *AmendmentInformationDetails11) SetOriginalFinalCollectionDateFromTime(value time.Time) {
+	a.OriginalFinalCollectionDate = new(ISODate)
+	a.OriginalFinalCollectionDate.FromTime(value)
+}
+
 func (a *AmendmentInformationDetails11) AddOriginalFrequency() *Frequency36Choice {
 	a.OriginalFrequency = new(Frequency36Choice)
 	return a.OriginalFrequency
@@ -96,3 +111,134 @@ func (a *AmendmentInformationDetails11) AddOriginalReason() *MandateSetupReason1
 func (a *AmendmentInformationDetails11) SetOriginalTrackingDays(value string) {
 	a.OriginalTrackingDays = (*Exact2NumericText)(&value)
 }
+
+func (a *AmendmentInformationDetails11) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.OriginalMandateIdentification != nil {
+		enc.Element("OrgnlMndtId", string(*a.OriginalMandateIdentification))
+	}
+	if a.OriginalCreditorSchemeIdentification != nil {
+		a.OriginalCreditorSchemeIdentification.EncodeXMLFast(enc, "OrgnlCdtrSchmeId")
+	}
+	if a.OriginalCreditorAgent != nil {
+		a.OriginalCreditorAgent.EncodeXMLFast(enc, "OrgnlCdtrAgt")
+	}
+	if a.OriginalCreditorAgentAccount != nil {
+		a.OriginalCreditorAgentAccount.EncodeXMLFast(enc, "OrgnlCdtrAgtAcct")
+	}
+	if a.OriginalDebtor != nil {
+		a.OriginalDebtor.EncodeXMLFast(enc, "OrgnlDbtr")
+	}
+	if a.OriginalDebtorAccount != nil {
+		a.OriginalDebtorAccount.EncodeXMLFast(enc, "OrgnlDbtrAcct")
+	}
+	if a.OriginalDebtorAgent != nil {
+		a.OriginalDebtorAgent.EncodeXMLFast(enc, "OrgnlDbtrAgt")
+	}
+	if a.OriginalDebtorAgentAccount != nil {
+		a.OriginalDebtorAgentAccount.EncodeXMLFast(enc, "OrgnlDbtrAgtAcct")
+	}
+	if a.OriginalFinalCollectionDate != nil {
+		enc.Element("OrgnlFnlColltnDt", string(*a.OriginalFinalCollectionDate))
+	}
+	if a.OriginalFrequency != nil {
+		a.OriginalFrequency.EncodeXMLFast(enc, "OrgnlFrqcy")
+	}
+	if a.OriginalReason != nil {
+		a.OriginalReason.EncodeXMLFast(enc, "OrgnlRsn")
+	}
+	if a.OriginalTrackingDays != nil {
+		enc.Element("OrgnlTrckgDays", string(*a.OriginalTrackingDays))
+	}
+	enc.End(tag)
+}
+
+func (a *AmendmentInformationDetails11) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "OrgnlMndtId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.OriginalMandateIdentification = (*Max35Text)(&value)
+		case "OrgnlCdtrSchmeId":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.OriginalCreditorSchemeIdentification = elem
+		case "OrgnlCdtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.OriginalCreditorAgent = elem
+		case "OrgnlCdtrAgtAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.OriginalCreditorAgentAccount = elem
+		case "OrgnlDbtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.OriginalDebtor = elem
+		case "OrgnlDbtrAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.OriginalDebtorAccount = elem
+		case "OrgnlDbtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.OriginalDebtorAgent = elem
+		case "OrgnlDbtrAgtAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.OriginalDebtorAgentAccount = elem
+		case "OrgnlFnlColltnDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.OriginalFinalCollectionDate = (*ISODate)(&value)
+		case "OrgnlFrqcy":
+			elem := new(Frequency36Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.OriginalFrequency = elem
+		case "OrgnlRsn":
+			elem := new(MandateSetupReason1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.OriginalReason = elem
+		case "OrgnlTrckgDays":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.OriginalTrackingDays = (*Exact2NumericText)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
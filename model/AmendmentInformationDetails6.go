@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to provide the list of direct debit mandate elements that have been modified when the amendment indicator has been set.
 type AmendmentInformationDetails6 struct {
 
@@ -34,6 +38,10 @@ type AmendmentInformationDetails6 struct {
 	OriginalFrequency *Frequency1Code `xml:"OrgnlFrqcy,omitempty"`
 }
 
+func (a *AmendmentInformationDetails6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmendmentInformationDetails6) SetOriginalMandateIdentification(value string) {
 	a.OriginalMandateIdentification = (*Max35Text)(&value)
 }
@@ -77,6 +85,11 @@ func (a *AmendmentInformationDetails6) SetOriginalFinalCollectionDate(value stri
 	a.OriginalFinalCollectionDate = (*ISODate)(&value)
 }
 
+func (a *AmendmentInformationDetails6) SetOriginalFinalCollectionDateFromTime(value time.Time) {
+	a.OriginalFinalCollectionDate = new(ISODate)
+	a.OriginalFinalCollectionDate.FromTime(value)
+}
+
 func (a *AmendmentInformationDetails6) SetOriginalFrequency(value string) {
 	a.OriginalFrequency = (*Frequency1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to provide the list of direct debit mandate elements that have been modified when the amendment indicator has been set.
 type AmendmentInformationDetails7 struct {
 
@@ -28,6 +32,10 @@ type AmendmentInformationDetails7 struct {
 	OriginalFrequency *Frequency1Code `xml:"OrgnlFrqcy,omitempty"`
 }
 
+func (a *AmendmentInformationDetails7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmendmentInformationDetails7) SetOriginalMandateIdentification(value string) {
 	a.OriginalMandateIdentification = (*Max35Text)(&value)
 }
@@ -61,6 +69,11 @@ func (a *AmendmentInformationDetails7) SetOriginalFinalCollectionDate(value stri
 	a.OriginalFinalCollectionDate = (*ISODate)(&value)
 }
 
+func (a *AmendmentInformationDetails7) SetOriginalFinalCollectionDateFromTime(value time.Time) {
+	a.OriginalFinalCollectionDate = new(ISODate)
+	a.OriginalFinalCollectionDate.FromTime(value)
+}
+
 func (a *AmendmentInformationDetails7) SetOriginalFrequency(value string) {
 	a.OriginalFrequency = (*Frequency1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details on the list of direct debit mandate elements that have been modified when the amendment indicator has been set.
 type AmendmentInformationDetails9 struct {
 
@@ -28,6 +32,10 @@ type AmendmentInformationDetails9 struct {
 	OriginalFrequency *Frequency6Code `xml:"OrgnlFrqcy,omitempty"`
 }
 
+func (a *AmendmentInformationDetails9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmendmentInformationDetails9) SetOriginalMandateIdentification(value string) {
 	a.OriginalMandateIdentification = (*Max35Text)(&value)
 }
@@ -61,6 +69,15 @@ func (a *AmendmentInformationDetails9) SetOriginalFinalCollectionDate(value stri
 	a.OriginalFinalCollectionDate = (*ISODate)(&value)
 }
 
-func (a *AmendmentInformationDetails9) SetOriginalFrequency(value string) {
+func (a *AmendmentInformationDetails9) SetOriginalFinalCollectionDateFromTime(value time.Time) {
+	a.OriginalFinalCollectionDate = new(ISODate)
+	a.OriginalFinalCollectionDate.FromTime(value)
+}
+
+func (a *AmendmentInformationDetails9) SetOriginalFrequency(value string) error {
+	if err := Frequency6Code(value).Validate(); err != nil {
+		return err
+	}
 	a.OriginalFrequency = (*Frequency6Code)(&value)
+	return nil
 }
@@ -13,6 +13,10 @@ type AmendmentReasonInformation1 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (a *AmendmentReasonInformation1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmendmentReasonInformation1) AddOriginator() *PartyIdentification32 {
 	a.Originator = new(PartyIdentification32)
 	return a.Originator
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Margin amount payable by one party to the other party.
 type Amount1 struct {
 
@@ -13,10 +17,18 @@ type Amount1 struct {
 	AdditionalInformation *Max210Text `xml:"AddtlInf,omitempty"`
 }
 
+func (a *Amount1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Amount1) SetAgreedAmount(value, currency string) {
 	a.AgreedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (a *Amount1) SetAgreedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.AgreedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *Amount1) SetMarginCallRequestIdentification(value string) {
 	a.MarginCallRequestIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of amounts.
 type Amount1Choice struct {
 
@@ -10,10 +14,22 @@ type Amount1Choice struct {
 	DecreaseAmount *ActiveCurrencyAndAmount `xml:"DcrAmt"`
 }
 
+func (a *Amount1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Amount1Choice) SetIncreaseAmount(value, currency string) {
 	a.IncreaseAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (a *Amount1Choice) SetIncreaseAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.IncreaseAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *Amount1Choice) SetDecreaseAmount(value, currency string) {
 	a.DecreaseAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (a *Amount1Choice) SetDecreaseAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.DecreaseAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
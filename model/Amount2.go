@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the amount in the reporting currency and optionally in the original currency.
 type Amount2 struct {
 
@@ -10,10 +14,22 @@ type Amount2 struct {
 	ReportingAmount *ImpliedCurrencyAndAmount `xml:"RptgAmt"`
 }
 
+func (a *Amount2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Amount2) SetOriginalCurrencyAmount(value, currency string) {
 	a.OriginalCurrencyAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (a *Amount2) SetOriginalCurrencyAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.OriginalCurrencyAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *Amount2) SetReportingAmount(value, currency string) {
 	a.ReportingAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (a *Amount2) SetReportingAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.ReportingAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount to be authorised by the issuer.
 type AmountAndCurrency1 struct {
 
@@ -10,10 +14,18 @@ type AmountAndCurrency1 struct {
 	Currency *ActiveCurrencyCode `xml:"Ccy,omitempty"`
 }
 
+func (a *AmountAndCurrency1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndCurrency1) SetAmount(value, currency string) {
 	a.Amount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndCurrency1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndCurrency1) SetCurrency(value string) {
 	a.Currency = (*ActiveCurrencyCode)(&value)
 }
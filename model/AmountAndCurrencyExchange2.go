@@ -22,6 +22,10 @@ type AmountAndCurrencyExchange2 struct {
 	ProprietaryAmount []*AmountAndCurrencyExchangeDetails2 `xml:"PrtryAmt,omitempty"`
 }
 
+func (a *AmountAndCurrencyExchange2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndCurrencyExchange2) AddInstructedAmount() *AmountAndCurrencyExchangeDetails1 {
 	a.InstructedAmount = new(AmountAndCurrencyExchangeDetails1)
 	return a.InstructedAmount
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to provide information on the original amount.
 type AmountAndCurrencyExchange3 struct {
 
@@ -21,6 +23,10 @@ type AmountAndCurrencyExchange3 struct {
 	ProprietaryAmount []*AmountAndCurrencyExchangeDetails4 `xml:"PrtryAmt,omitempty"`
 }
 
+func (a *AmountAndCurrencyExchange3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndCurrencyExchange3) AddInstructedAmount() *AmountAndCurrencyExchangeDetails3 {
 	a.InstructedAmount = new(AmountAndCurrencyExchangeDetails3)
 	return a.InstructedAmount
@@ -47,6 +53,76 @@ func (a *AmountAndCurrencyExchange3) AddProprietaryAmount() *AmountAndCurrencyEx
 	return newValue
 }
 
+func (a *AmountAndCurrencyExchange3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.InstructedAmount != nil {
+		a.InstructedAmount.EncodeXMLFast(enc, "InstdAmt")
+	}
+	if a.TransactionAmount != nil {
+		a.TransactionAmount.EncodeXMLFast(enc, "TxAmt")
+	}
+	if a.CounterValueAmount != nil {
+		a.CounterValueAmount.EncodeXMLFast(enc, "CntrValAmt")
+	}
+	if a.AnnouncedPostingAmount != nil {
+		a.AnnouncedPostingAmount.EncodeXMLFast(enc, "AnncdPstngAmt")
+	}
+	for _, elem := range a.ProprietaryAmount {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "PrtryAmt")
+		}
+	}
+	enc.End(tag)
+}
+
+func (a *AmountAndCurrencyExchange3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "InstdAmt":
+			elem := new(AmountAndCurrencyExchangeDetails3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.InstructedAmount = elem
+		case "TxAmt":
+			elem := new(AmountAndCurrencyExchangeDetails3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.TransactionAmount = elem
+		case "CntrValAmt":
+			elem := new(AmountAndCurrencyExchangeDetails3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.CounterValueAmount = elem
+		case "AnncdPstngAmt":
+			elem := new(AmountAndCurrencyExchangeDetails3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.AnnouncedPostingAmount = elem
+		case "PrtryAmt":
+			elem := new(AmountAndCurrencyExchangeDetails4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.ProprietaryAmount = append(a.ProprietaryAmount, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
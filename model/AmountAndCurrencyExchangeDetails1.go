@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements providing information on the original amount and currency information.
 type AmountAndCurrencyExchangeDetails1 struct {
 
@@ -10,10 +14,18 @@ type AmountAndCurrencyExchangeDetails1 struct {
 	CurrencyExchange *CurrencyExchange3 `xml:"CcyXchg,omitempty"`
 }
 
+func (a *AmountAndCurrencyExchangeDetails1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndCurrencyExchangeDetails1) SetAmount(value, currency string) {
 	a.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndCurrencyExchangeDetails1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndCurrencyExchangeDetails1) AddCurrencyExchange() *CurrencyExchange3 {
 	a.CurrencyExchange = new(CurrencyExchange3)
 	return a.CurrencyExchange
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements providing information on the original amount and currency information.
 type AmountAndCurrencyExchangeDetails2 struct {
 
@@ -13,6 +17,10 @@ type AmountAndCurrencyExchangeDetails2 struct {
 	CurrencyExchange *CurrencyExchange3 `xml:"CcyXchg,omitempty"`
 }
 
+func (a *AmountAndCurrencyExchangeDetails2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndCurrencyExchangeDetails2) SetType(value string) {
 	a.Type = (*Max35Text)(&value)
 }
@@ -21,6 +29,10 @@ func (a *AmountAndCurrencyExchangeDetails2) SetAmount(value, currency string) {
 	a.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndCurrencyExchangeDetails2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndCurrencyExchangeDetails2) AddCurrencyExchange() *CurrencyExchange3 {
 	a.CurrencyExchange = new(CurrencyExchange3)
 	return a.CurrencyExchange
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of elements used to provide information on the original amount and currency exchange.
 type AmountAndCurrencyExchangeDetails4 struct {
 
@@ -13,6 +18,10 @@ type AmountAndCurrencyExchangeDetails4 struct {
 	CurrencyExchange *CurrencyExchange5 `xml:"CcyXchg,omitempty"`
 }
 
+func (a *AmountAndCurrencyExchangeDetails4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndCurrencyExchangeDetails4) SetType(value string) {
 	a.Type = (*Max35Text)(&value)
 }
@@ -21,7 +30,61 @@ func (a *AmountAndCurrencyExchangeDetails4) SetAmount(value, currency string) {
 	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndCurrencyExchangeDetails4) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndCurrencyExchangeDetails4) AddCurrencyExchange() *CurrencyExchange5 {
 	a.CurrencyExchange = new(CurrencyExchange5)
 	return a.CurrencyExchange
 }
+
+func (a *AmountAndCurrencyExchangeDetails4) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.Type != nil {
+		enc.Element("Tp", string(*a.Type))
+	}
+	if a.Amount != nil {
+		a.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if a.CurrencyExchange != nil {
+		a.CurrencyExchange.EncodeXMLFast(enc, "CcyXchg")
+	}
+	enc.End(tag)
+}
+
+func (a *AmountAndCurrencyExchangeDetails4) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.Type = (*Max35Text)(&value)
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.Amount = elem
+		case "CcyXchg":
+			elem := new(CurrencyExchange5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.CurrencyExchange = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Posting of an item to a cash account, in the context of a cash transaction, that results in an increase or decrease to the balance of the account.
 type AmountAndDirection18 struct {
 
@@ -10,10 +14,22 @@ type AmountAndDirection18 struct {
 	CreditDebit *CreditDebitCode `xml:"CdtDbt"`
 }
 
+func (a *AmountAndDirection18) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection18) SetAmount(value, currency string) {
 	a.Amount = NewRestrictedFINActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection18) SetCreditDebit(value string) {
+func (a *AmountAndDirection18) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewRestrictedFINActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection18) SetCreditDebit(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebit = (*CreditDebitCode)(&value)
+	return nil
 }
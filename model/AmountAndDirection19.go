@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money debited or credited on the books of an account servicer.
 type AmountAndDirection19 struct {
 
@@ -10,10 +14,22 @@ type AmountAndDirection19 struct {
 	CreditDebit *CreditDebitCode `xml:"CdtDbt,omitempty"`
 }
 
+func (a *AmountAndDirection19) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection19) SetAmount(value, currency string) {
 	a.Amount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection19) SetCreditDebit(value string) {
+func (a *AmountAndDirection19) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection19) SetCreditDebit(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebit = (*CreditDebitCode)(&value)
+	return nil
 }
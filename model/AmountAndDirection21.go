@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money debited or credited on the books of an account servicer.
 type AmountAndDirection21 struct {
 
@@ -10,10 +14,22 @@ type AmountAndDirection21 struct {
 	CreditDebitIndicator *CreditDebitCode `xml:"CdtDbtInd,omitempty"`
 }
 
+func (a *AmountAndDirection21) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection21) SetAmount(value, currency string) {
 	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection21) SetCreditDebitIndicator(value string) {
+func (a *AmountAndDirection21) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection21) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
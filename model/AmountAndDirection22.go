@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Posting of an item to a cash account, in the context of a cash transaction, that results in an increase or decrease to the balance of the account.
 type AmountAndDirection22 struct {
 
@@ -19,18 +23,34 @@ type AmountAndDirection22 struct {
 	ValueDate *DateAndDateTimeChoice `xml:"ValDt,omitempty"`
 }
 
+func (a *AmountAndDirection22) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection22) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection22) SetCreditDebitIndicator(value string) {
+func (a *AmountAndDirection22) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection22) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (a *AmountAndDirection22) SetOriginalCurrencyAndOrderedAmount(value, currency string) {
 	a.OriginalCurrencyAndOrderedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndDirection22) SetOriginalCurrencyAndOrderedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.OriginalCurrencyAndOrderedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndDirection22) AddForeignExchangeDetails() *ForeignExchangeTerms11 {
 	a.ForeignExchangeDetails = new(ForeignExchangeTerms11)
 	return a.ForeignExchangeDetails
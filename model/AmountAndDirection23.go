@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money debited or credited on the books of an account servicer.
 type AmountAndDirection23 struct {
 
@@ -13,12 +17,24 @@ type AmountAndDirection23 struct {
 	ForeignExchangeDetails *ForeignExchangeTerms11 `xml:"FXDtls,omitempty"`
 }
 
+func (a *AmountAndDirection23) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection23) SetAmount(value, currency string) {
 	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection23) SetCreditDebitIndicator(value string) {
+func (a *AmountAndDirection23) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection23) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (a *AmountAndDirection23) AddForeignExchangeDetails() *ForeignExchangeTerms11 {
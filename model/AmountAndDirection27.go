@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money debited or credited on the books of an account servicer.
 type AmountAndDirection27 struct {
 
@@ -16,18 +20,34 @@ type AmountAndDirection27 struct {
 	ForeignExchangeDetails *ForeignExchangeTerms17 `xml:"FXDtls,omitempty"`
 }
 
+func (a *AmountAndDirection27) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection27) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection27) SetCreditDebitIndicator(value string) {
+func (a *AmountAndDirection27) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection27) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (a *AmountAndDirection27) SetOriginalCurrencyAndOrderedAmount(value, currency string) {
 	a.OriginalCurrencyAndOrderedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndDirection27) SetOriginalCurrencyAndOrderedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.OriginalCurrencyAndOrderedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndDirection27) AddForeignExchangeDetails() *ForeignExchangeTerms17 {
 	a.ForeignExchangeDetails = new(ForeignExchangeTerms17)
 	return a.ForeignExchangeDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money debited or credited on the books of an account servicer.
 type AmountAndDirection29 struct {
 
@@ -16,18 +20,34 @@ type AmountAndDirection29 struct {
 	ForeignExchangeDetails *ForeignExchangeTerms18 `xml:"FXDtls,omitempty"`
 }
 
+func (a *AmountAndDirection29) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection29) SetAmount(value, currency string) {
 	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection29) SetCreditDebitIndicator(value string) {
+func (a *AmountAndDirection29) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection29) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (a *AmountAndDirection29) SetOriginalCurrencyAndOrderedAmount(value, currency string) {
 	a.OriginalCurrencyAndOrderedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndDirection29) SetOriginalCurrencyAndOrderedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.OriginalCurrencyAndOrderedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndDirection29) AddForeignExchangeDetails() *ForeignExchangeTerms18 {
 	a.ForeignExchangeDetails = new(ForeignExchangeTerms18)
 	return a.ForeignExchangeDetails
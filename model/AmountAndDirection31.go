@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Posting of an item to a cash account, in the context of a cash transaction, that results in an increase or decrease to the balance of the account.
 type AmountAndDirection31 struct {
 
@@ -10,10 +14,18 @@ type AmountAndDirection31 struct {
 	ShortLongIndicator *ShortLong1Code `xml:"ShrtLngInd,omitempty"`
 }
 
+func (a *AmountAndDirection31) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection31) SetAmount(value, currency string) {
 	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndDirection31) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndDirection31) SetShortLongIndicator(value string) {
 	a.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Posting of an item to a cash account, in the context of a cash transaction, that results in an increase or decrease to the balance of the account.
 type AmountAndDirection32 struct {
 
@@ -25,26 +29,52 @@ type AmountAndDirection32 struct {
 	ValueDate *DateAndDateTimeChoice `xml:"ValDt,omitempty"`
 }
 
+func (a *AmountAndDirection32) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection32) SetAccruedInterestIndicator(value string) {
 	a.AccruedInterestIndicator = (*YesNoIndicator)(&value)
 }
 
+func (a *AmountAndDirection32) SetAccruedInterestIndicatorFromBool(value bool) {
+	a.AccruedInterestIndicator = new(YesNoIndicator)
+	a.AccruedInterestIndicator.FromBool(value)
+}
+
 func (a *AmountAndDirection32) SetStampDutyIndicator(value string) {
 	a.StampDutyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (a *AmountAndDirection32) SetStampDutyIndicatorFromBool(value bool) {
+	a.StampDutyIndicator = new(YesNoIndicator)
+	a.StampDutyIndicator.FromBool(value)
+}
+
 func (a *AmountAndDirection32) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection32) SetCreditDebitIndicator(value string) {
+func (a *AmountAndDirection32) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection32) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (a *AmountAndDirection32) SetOriginalCurrencyAndOrderedAmount(value, currency string) {
 	a.OriginalCurrencyAndOrderedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndDirection32) SetOriginalCurrencyAndOrderedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.OriginalCurrencyAndOrderedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndDirection32) AddForeignExchangeDetails() *ForeignExchangeTerms11 {
 	a.ForeignExchangeDetails = new(ForeignExchangeTerms11)
 	return a.ForeignExchangeDetails
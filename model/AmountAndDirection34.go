@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money expressed with an optional currency code and debit/credit indicator.
 type AmountAndDirection34 struct {
 
@@ -10,10 +14,23 @@ type AmountAndDirection34 struct {
 	Sign *PlusOrMinusIndicator `xml:"Sgn"`
 }
 
+func (a *AmountAndDirection34) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection34) SetAmount(value, currency string) {
 	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndDirection34) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndDirection34) SetSign(value string) {
 	a.Sign = (*PlusOrMinusIndicator)(&value)
 }
+
+func (a *AmountAndDirection34) SetSignFromBool(value bool) {
+	a.Sign = new(PlusOrMinusIndicator)
+	a.Sign.FromBool(value)
+}
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Resulting debit or credit amount of the netted amounts for all debit and credit entries.
 type AmountAndDirection35 struct {
 
@@ -10,10 +15,64 @@ type AmountAndDirection35 struct {
 	CreditDebitIndicator *CreditDebitCode `xml:"CdtDbtInd"`
 }
 
+func (a *AmountAndDirection35) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection35) SetAmount(value string) {
 	a.Amount = (*NonNegativeDecimalNumber)(&value)
 }
 
-func (a *AmountAndDirection35) SetCreditDebitIndicator(value string) {
+func (a *AmountAndDirection35) SetAmountFromDecimal(value decimal.Decimal) {
+	a.Amount = new(NonNegativeDecimalNumber)
+	a.Amount.FromDecimal(value)
+}
+
+func (a *AmountAndDirection35) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
+}
+
+func (a *AmountAndDirection35) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.Amount != nil {
+		enc.Element("Amt", string(*a.Amount))
+	}
+	if a.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*a.CreditDebitIndicator))
+	}
+	enc.End(tag)
+}
+
+func (a *AmountAndDirection35) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Amt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.Amount = (*NonNegativeDecimalNumber)(&value)
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Posting of an item to a cash account, in the context of a cash transaction, that results in an increase or decrease to the balance of the account.
 type AmountAndDirection37 struct {
 
@@ -28,30 +32,61 @@ type AmountAndDirection37 struct {
 	ValueDate *DateAndDateTimeChoice `xml:"ValDt,omitempty"`
 }
 
+func (a *AmountAndDirection37) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection37) SetAccruedInterestIndicator(value string) {
 	a.AccruedInterestIndicator = (*YesNoIndicator)(&value)
 }
 
+func (a *AmountAndDirection37) SetAccruedInterestIndicatorFromBool(value bool) {
+	a.AccruedInterestIndicator = new(YesNoIndicator)
+	a.AccruedInterestIndicator.FromBool(value)
+}
+
 func (a *AmountAndDirection37) SetStampDutyIndicator(value string) {
 	a.StampDutyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (a *AmountAndDirection37) SetStampDutyIndicatorFromBool(value bool) {
+	a.StampDutyIndicator = new(YesNoIndicator)
+	a.StampDutyIndicator.FromBool(value)
+}
+
 func (a *AmountAndDirection37) SetBrokerageAmountIndicator(value string) {
 	a.BrokerageAmountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (a *AmountAndDirection37) SetBrokerageAmountIndicatorFromBool(value bool) {
+	a.BrokerageAmountIndicator = new(YesNoIndicator)
+	a.BrokerageAmountIndicator.FromBool(value)
+}
+
 func (a *AmountAndDirection37) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection37) SetCreditDebitIndicator(value string) {
+func (a *AmountAndDirection37) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection37) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (a *AmountAndDirection37) SetOriginalCurrencyAndOrderedAmount(value, currency string) {
 	a.OriginalCurrencyAndOrderedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndDirection37) SetOriginalCurrencyAndOrderedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.OriginalCurrencyAndOrderedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndDirection37) AddForeignExchangeDetails() *ForeignExchangeTerms11 {
 	a.ForeignExchangeDetails = new(ForeignExchangeTerms11)
 	return a.ForeignExchangeDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Signed amount.
 type AmountAndDirection41 struct {
 
@@ -10,10 +14,23 @@ type AmountAndDirection41 struct {
 	Sign *PlusOrMinusIndicator `xml:"Sgn,omitempty"`
 }
 
+func (a *AmountAndDirection41) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection41) SetAmount(value, currency string) {
 	a.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndDirection41) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndDirection41) SetSign(value string) {
 	a.Sign = (*PlusOrMinusIndicator)(&value)
 }
+
+func (a *AmountAndDirection41) SetSignFromBool(value bool) {
+	a.Sign = new(PlusOrMinusIndicator)
+	a.Sign.FromBool(value)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Signed amount.
 type AmountAndDirection43 struct {
 
@@ -13,14 +19,32 @@ type AmountAndDirection43 struct {
 	Date *ISODate `xml:"Dt,omitempty"`
 }
 
+func (a *AmountAndDirection43) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection43) SetAmount(value, currency string) {
 	a.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndDirection43) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndDirection43) SetSign(value string) {
 	a.Sign = (*PlusOrMinusIndicator)(&value)
 }
 
+func (a *AmountAndDirection43) SetSignFromBool(value bool) {
+	a.Sign = new(PlusOrMinusIndicator)
+	a.Sign.FromBool(value)
+}
+
 func (a *AmountAndDirection43) SetDate(value string) {
 	a.Date = (*ISODate)(&value)
 }
+
+func (a *AmountAndDirection43) SetDateFromTime(value time.Time) {
+	a.Date = new(ISODate)
+	a.Date.FromTime(value)
+}
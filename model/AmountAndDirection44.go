@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money debited or credited on the books of an account servicer.
 type AmountAndDirection44 struct {
 
@@ -16,18 +20,34 @@ type AmountAndDirection44 struct {
 	ForeignExchangeDetails *ForeignExchangeTerms23 `xml:"FXDtls,omitempty"`
 }
 
+func (a *AmountAndDirection44) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection44) SetAmount(value, currency string) {
 	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection44) SetCreditDebitIndicator(value string) {
+func (a *AmountAndDirection44) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection44) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (a *AmountAndDirection44) SetOriginalCurrencyAndOrderedAmount(value, currency string) {
 	a.OriginalCurrencyAndOrderedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndDirection44) SetOriginalCurrencyAndOrderedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.OriginalCurrencyAndOrderedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndDirection44) AddForeignExchangeDetails() *ForeignExchangeTerms23 {
 	a.ForeignExchangeDetails = new(ForeignExchangeTerms23)
 	return a.ForeignExchangeDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money debited or credited on the books of an account servicer.
 type AmountAndDirection47 struct {
 
@@ -13,12 +17,24 @@ type AmountAndDirection47 struct {
 	ForeignExchangeDetails *ForeignExchangeTerms23 `xml:"FXDtls,omitempty"`
 }
 
+func (a *AmountAndDirection47) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection47) SetAmount(value, currency string) {
 	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection47) SetCreditDebitIndicator(value string) {
+func (a *AmountAndDirection47) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection47) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (a *AmountAndDirection47) AddForeignExchangeDetails() *ForeignExchangeTerms23 {
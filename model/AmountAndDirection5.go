@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money debited or credited on the books of an account servicer.
 type AmountAndDirection5 struct {
 
@@ -10,10 +14,22 @@ type AmountAndDirection5 struct {
 	CreditDebit *CreditDebitCode `xml:"CdtDbt,omitempty"`
 }
 
+func (a *AmountAndDirection5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection5) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection5) SetCreditDebit(value string) {
+func (a *AmountAndDirection5) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection5) SetCreditDebit(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebit = (*CreditDebitCode)(&value)
+	return nil
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Posting of an item to a cash account, in the context of a cash transaction, that results in an increase or decrease to the balance of the account.
 type AmountAndDirection57 struct {
 
@@ -10,10 +14,22 @@ type AmountAndDirection57 struct {
 	CreditDebitIndicator *CreditDebitCode `xml:"CdtDbtInd"`
 }
 
+func (a *AmountAndDirection57) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection57) SetAmount(value, currency string) {
 	a.Amount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection57) SetCreditDebitIndicator(value string) {
+func (a *AmountAndDirection57) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection57) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
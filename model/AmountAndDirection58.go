@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money debited or credited on the books of an account servicer.
 type AmountAndDirection58 struct {
 
@@ -16,18 +20,34 @@ type AmountAndDirection58 struct {
 	ForeignExchangeDetails *ForeignExchangeTerms27 `xml:"FXDtls,omitempty"`
 }
 
+func (a *AmountAndDirection58) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection58) SetAmount(value, currency string) {
 	a.Amount = NewRestrictedFINActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection58) SetCreditDebitIndicator(value string) {
+func (a *AmountAndDirection58) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewRestrictedFINActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection58) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (a *AmountAndDirection58) SetOriginalCurrencyAndOrderedAmount(value, currency string) {
 	a.OriginalCurrencyAndOrderedAmount = NewRestrictedFINActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndDirection58) SetOriginalCurrencyAndOrderedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.OriginalCurrencyAndOrderedAmount = NewRestrictedFINActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndDirection58) AddForeignExchangeDetails() *ForeignExchangeTerms27 {
 	a.ForeignExchangeDetails = new(ForeignExchangeTerms27)
 	return a.ForeignExchangeDetails
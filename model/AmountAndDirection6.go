@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Posting of an item to a cash account, in the context of a cash transaction, that results in an increase or decrease to the balance of the account.
 type AmountAndDirection6 struct {
 
@@ -10,10 +14,23 @@ type AmountAndDirection6 struct {
 	Sign *PlusOrMinusIndicator `xml:"Sgn"`
 }
 
+func (a *AmountAndDirection6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection6) SetAmount(value, currency string) {
 	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndDirection6) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndDirection6) SetSign(value string) {
 	a.Sign = (*PlusOrMinusIndicator)(&value)
 }
+
+func (a *AmountAndDirection6) SetSignFromBool(value bool) {
+	a.Sign = new(PlusOrMinusIndicator)
+	a.Sign.FromBool(value)
+}
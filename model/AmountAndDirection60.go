@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Posting of an item to a cash account, in the context of a cash transaction, that results in an increase or decrease to the balance of the account.
 type AmountAndDirection60 struct {
 
@@ -28,30 +32,61 @@ type AmountAndDirection60 struct {
 	ValueDate *DateAndDateTimeChoice `xml:"ValDt,omitempty"`
 }
 
+func (a *AmountAndDirection60) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection60) SetAccruedInterestIndicator(value string) {
 	a.AccruedInterestIndicator = (*YesNoIndicator)(&value)
 }
 
+func (a *AmountAndDirection60) SetAccruedInterestIndicatorFromBool(value bool) {
+	a.AccruedInterestIndicator = new(YesNoIndicator)
+	a.AccruedInterestIndicator.FromBool(value)
+}
+
 func (a *AmountAndDirection60) SetStampDutyIndicator(value string) {
 	a.StampDutyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (a *AmountAndDirection60) SetStampDutyIndicatorFromBool(value bool) {
+	a.StampDutyIndicator = new(YesNoIndicator)
+	a.StampDutyIndicator.FromBool(value)
+}
+
 func (a *AmountAndDirection60) SetBrokerageAmountIndicator(value string) {
 	a.BrokerageAmountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (a *AmountAndDirection60) SetBrokerageAmountIndicatorFromBool(value bool) {
+	a.BrokerageAmountIndicator = new(YesNoIndicator)
+	a.BrokerageAmountIndicator.FromBool(value)
+}
+
 func (a *AmountAndDirection60) SetAmount(value, currency string) {
 	a.Amount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection60) SetCreditDebitIndicator(value string) {
+func (a *AmountAndDirection60) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection60) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (a *AmountAndDirection60) SetOriginalCurrencyAndOrderedAmount(value, currency string) {
 	a.OriginalCurrencyAndOrderedAmount = NewRestrictedFINActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndDirection60) SetOriginalCurrencyAndOrderedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.OriginalCurrencyAndOrderedAmount = NewRestrictedFINActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndDirection60) AddForeignExchangeDetails() *ForeignExchangeTerms27 {
 	a.ForeignExchangeDetails = new(ForeignExchangeTerms27)
 	return a.ForeignExchangeDetails
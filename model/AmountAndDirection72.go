@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money debited or credited on the books of an account servicer.
 type AmountAndDirection72 struct {
 
@@ -13,12 +17,24 @@ type AmountAndDirection72 struct {
 	ForeignExchangeDetails *ForeignExchangeTerms27 `xml:"FXDtls,omitempty"`
 }
 
+func (a *AmountAndDirection72) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndDirection72) SetAmount(value, currency string) {
 	a.Amount = NewRestrictedFINActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (a *AmountAndDirection72) SetCreditDebitIndicator(value string) {
+func (a *AmountAndDirection72) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewRestrictedFINActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (a *AmountAndDirection72) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	a.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (a *AmountAndDirection72) AddForeignExchangeDetails() *ForeignExchangeTerms27 {
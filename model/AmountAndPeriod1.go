@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Relates an amount to a period of time.
 type AmountAndPeriod1 struct {
 
@@ -13,14 +19,32 @@ type AmountAndPeriod1 struct {
 	EndDate *ISODate `xml:"EndDt,omitempty"`
 }
 
+func (a *AmountAndPeriod1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndPeriod1) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndPeriod1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndPeriod1) SetStartDate(value string) {
 	a.StartDate = (*ISODate)(&value)
 }
 
+func (a *AmountAndPeriod1) SetStartDateFromTime(value time.Time) {
+	a.StartDate = new(ISODate)
+	a.StartDate.FromTime(value)
+}
+
 func (a *AmountAndPeriod1) SetEndDate(value string) {
 	a.EndDate = (*ISODate)(&value)
 }
+
+func (a *AmountAndPeriod1) SetEndDateFromTime(value time.Time) {
+	a.EndDate = new(ISODate)
+	a.EndDate.FromTime(value)
+}
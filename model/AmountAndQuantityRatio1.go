@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Ratio expressed as amount per quantity.
 type AmountAndQuantityRatio1 struct {
 
@@ -10,10 +14,23 @@ type AmountAndQuantityRatio1 struct {
 	Quantity *DecimalNumber `xml:"Qty"`
 }
 
+func (a *AmountAndQuantityRatio1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndQuantityRatio1) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndQuantityRatio1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndQuantityRatio1) SetQuantity(value string) {
 	a.Quantity = (*DecimalNumber)(&value)
 }
+
+func (a *AmountAndQuantityRatio1) SetQuantityFromDecimal(value decimal.Decimal) {
+	a.Quantity = new(DecimalNumber)
+	a.Quantity.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Ratio expressed as amount per quantity.
 type AmountAndQuantityRatio2 struct {
 
@@ -10,10 +14,23 @@ type AmountAndQuantityRatio2 struct {
 	Quantity *DecimalNumber `xml:"Qty"`
 }
 
+func (a *AmountAndQuantityRatio2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndQuantityRatio2) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (a *AmountAndQuantityRatio2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (a *AmountAndQuantityRatio2) SetQuantity(value string) {
 	a.Quantity = (*DecimalNumber)(&value)
 }
+
+func (a *AmountAndQuantityRatio2) SetQuantityFromDecimal(value decimal.Decimal) {
+	a.Quantity = new(DecimalNumber)
+	a.Quantity.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Ratio expressed as amount per quantity.
 type AmountAndQuantityRatio4 struct {
 
@@ -10,10 +14,23 @@ type AmountAndQuantityRatio4 struct {
 	Quantity *DecimalNumber `xml:"Qty"`
 }
 
+func (a *AmountAndQuantityRatio4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndQuantityRatio4) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (a *AmountAndQuantityRatio4) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (a *AmountAndQuantityRatio4) SetQuantity(value string) {
 	a.Quantity = (*DecimalNumber)(&value)
 }
+
+func (a *AmountAndQuantityRatio4) SetQuantityFromDecimal(value decimal.Decimal) {
+	a.Quantity = new(DecimalNumber)
+	a.Quantity.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Ratio expressed as amount per quantity.
 type AmountAndQuantityRatio5 struct {
 
@@ -10,10 +14,23 @@ type AmountAndQuantityRatio5 struct {
 	Quantity *RestrictedFINDecimalNumber `xml:"Qty"`
 }
 
+func (a *AmountAndQuantityRatio5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndQuantityRatio5) SetAmount(value, currency string) {
 	a.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (a *AmountAndQuantityRatio5) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (a *AmountAndQuantityRatio5) SetQuantity(value string) {
 	a.Quantity = (*RestrictedFINDecimalNumber)(&value)
 }
+
+func (a *AmountAndQuantityRatio5) SetQuantityFromDecimal(value decimal.Decimal) {
+	a.Quantity = new(RestrictedFINDecimalNumber)
+	a.Quantity.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Change amount and rate.
 type AmountAndRate2 struct {
 
@@ -10,6 +14,10 @@ type AmountAndRate2 struct {
 	Rate *PercentageRate `xml:"Rate,omitempty"`
 }
 
+func (a *AmountAndRate2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndRate2) AddAmount() *AmountAndDirection30 {
 	a.Amount = new(AmountAndDirection30)
 	return a.Amount
@@ -18,3 +26,8 @@ func (a *AmountAndRate2) AddAmount() *AmountAndDirection30 {
 func (a *AmountAndRate2) SetRate(value string) {
 	a.Rate = (*PercentageRate)(&value)
 }
+
+func (a *AmountAndRate2) SetRateFromDecimal(value decimal.Decimal) {
+	a.Rate = new(PercentageRate)
+	a.Rate.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of rate formats.
 type AmountAndRateFormat2Choice struct {
 
@@ -10,10 +14,18 @@ type AmountAndRateFormat2Choice struct {
 	NotSpecifiedRate *RateType12FormatChoice `xml:"NotSpcfdRate"`
 }
 
+func (a *AmountAndRateFormat2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndRateFormat2Choice) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndRateFormat2Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndRateFormat2Choice) AddNotSpecifiedRate() *RateType12FormatChoice {
 	a.NotSpecifiedRate = new(RateType12FormatChoice)
 	return a.NotSpecifiedRate
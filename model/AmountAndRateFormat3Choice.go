@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of rate formats.
 type AmountAndRateFormat3Choice struct {
 
@@ -10,10 +14,18 @@ type AmountAndRateFormat3Choice struct {
 	NotSpecifiedRate *RateValueType6FormatChoice `xml:"NotSpcfdRate"`
 }
 
+func (a *AmountAndRateFormat3Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndRateFormat3Choice) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountAndRateFormat3Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountAndRateFormat3Choice) AddNotSpecifiedRate() *RateValueType6FormatChoice {
 	a.NotSpecifiedRate = new(RateValueType6FormatChoice)
 	return a.NotSpecifiedRate
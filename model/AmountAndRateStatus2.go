@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies an amount and a rate status.
 type AmountAndRateStatus2 struct {
 
@@ -10,10 +14,18 @@ type AmountAndRateStatus2 struct {
 	RateStatus *RateStatus1Code `xml:"RateSts"`
 }
 
+func (a *AmountAndRateStatus2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndRateStatus2) SetAmount(value, currency string) {
 	a.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (a *AmountAndRateStatus2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (a *AmountAndRateStatus2) SetRateStatus(value string) {
 	a.RateStatus = (*RateStatus1Code)(&value)
 }
@@ -13,6 +13,10 @@ type AmountAndTrigger1 struct {
 	Trigger []*Trigger1 `xml:"Trggr"`
 }
 
+func (a *AmountAndTrigger1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountAndTrigger1) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the type of change to an amount.
 type AmountModification1 struct {
 
@@ -10,6 +14,10 @@ type AmountModification1 struct {
 	Amount *ImpliedCurrencyAndAmount `xml:"Amt"`
 }
 
+func (a *AmountModification1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountModification1) SetModificationCode(value string) {
 	a.ModificationCode = (*Modification1Code)(&value)
 }
@@ -17,3 +25,7 @@ func (a *AmountModification1) SetModificationCode(value string) {
 func (a *AmountModification1) SetAmount(value, currency string) {
 	a.Amount = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (a *AmountModification1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
@@ -10,6 +10,10 @@ type AmountOrPercentage1Choice struct {
 	PercentageAmount *Percentage1 `xml:"PctgAmt"`
 }
 
+func (a *AmountOrPercentage1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountOrPercentage1Choice) AddDefinedAmount() *UndertakingAmount4 {
 	a.DefinedAmount = new(UndertakingAmount4)
 	return a.DefinedAmount
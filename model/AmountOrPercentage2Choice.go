@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a set amount or a percentage.
 type AmountOrPercentage2Choice struct {
 
@@ -10,10 +14,23 @@ type AmountOrPercentage2Choice struct {
 	Percentage *PercentageRate `xml:"Pctg"`
 }
 
+func (a *AmountOrPercentage2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountOrPercentage2Choice) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountOrPercentage2Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountOrPercentage2Choice) SetPercentage(value string) {
 	a.Percentage = (*PercentageRate)(&value)
 }
+
+func (a *AmountOrPercentage2Choice) SetPercentageFromDecimal(value decimal.Decimal) {
+	a.Percentage = new(PercentageRate)
+	a.Percentage.FromDecimal(value)
+}
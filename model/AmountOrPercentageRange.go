@@ -10,6 +10,10 @@ type AmountOrPercentageRange struct {
 	Term []*Term1 `xml:"Term,omitempty"`
 }
 
+func (a *AmountOrPercentageRange) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountOrPercentageRange) SetOperation(value string) {
 	a.Operation = (*Operation1Code)(&value)
 }
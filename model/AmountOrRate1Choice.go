@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or a rate.
 type AmountOrRate1Choice struct {
 
@@ -10,10 +14,23 @@ type AmountOrRate1Choice struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (a *AmountOrRate1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountOrRate1Choice) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountOrRate1Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountOrRate1Choice) SetRate(value string) {
 	a.Rate = (*PercentageRate)(&value)
 }
+
+func (a *AmountOrRate1Choice) SetRateFromDecimal(value decimal.Decimal) {
+	a.Rate = new(PercentageRate)
+	a.Rate.FromDecimal(value)
+}
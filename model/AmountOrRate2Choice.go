@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or a rate.
 type AmountOrRate2Choice struct {
 
@@ -10,10 +14,23 @@ type AmountOrRate2Choice struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (a *AmountOrRate2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountOrRate2Choice) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountOrRate2Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountOrRate2Choice) SetRate(value string) {
 	a.Rate = (*PercentageRate)(&value)
 }
+
+func (a *AmountOrRate2Choice) SetRateFromDecimal(value decimal.Decimal) {
+	a.Rate = new(PercentageRate)
+	a.Rate.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or rate.
 type AmountOrRate3Choice struct {
 
@@ -10,10 +14,23 @@ type AmountOrRate3Choice struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (a *AmountOrRate3Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountOrRate3Choice) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (a *AmountOrRate3Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (a *AmountOrRate3Choice) SetRate(value string) {
 	a.Rate = (*PercentageRate)(&value)
 }
+
+func (a *AmountOrRate3Choice) SetRateFromDecimal(value decimal.Decimal) {
+	a.Rate = new(PercentageRate)
+	a.Rate.FromDecimal(value)
+}
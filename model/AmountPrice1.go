@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Price expressed as an amount.
 type AmountPrice1 struct {
 
@@ -10,6 +14,10 @@ type AmountPrice1 struct {
 	PriceValue *ActiveCurrencyAnd13DecimalAmount `xml:"PricVal"`
 }
 
+func (a *AmountPrice1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountPrice1) AddAmountPriceType() *AmountPriceType1FormatChoice {
 	a.AmountPriceType = new(AmountPriceType1FormatChoice)
 	return a.AmountPriceType
@@ -18,3 +26,7 @@ func (a *AmountPrice1) AddAmountPriceType() *AmountPriceType1FormatChoice {
 func (a *AmountPrice1) SetPriceValue(value, currency string) {
 	a.PriceValue = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (a *AmountPrice1) SetPriceValueFromDecimal(value decimal.Decimal, currency string) {
+	a.PriceValue = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
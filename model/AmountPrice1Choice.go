@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between ways to express an amount.
 type AmountPrice1Choice struct {
 
@@ -10,10 +14,18 @@ type AmountPrice1Choice struct {
 	UnitPrice *UnitPrice4 `xml:"UnitPric"`
 }
 
+func (a *AmountPrice1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountPrice1Choice) SetAmount(value, currency string) {
 	a.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountPrice1Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountPrice1Choice) AddUnitPrice() *UnitPrice4 {
 	a.UnitPrice = new(UnitPrice4)
 	return a.UnitPrice
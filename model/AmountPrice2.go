@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Price expressed as an actual amount.
 type AmountPrice2 struct {
 
@@ -10,6 +14,10 @@ type AmountPrice2 struct {
 	PriceValue *ActiveCurrencyAnd13DecimalAmount `xml:"PricVal"`
 }
 
+func (a *AmountPrice2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountPrice2) SetAmountPriceType(value string) {
 	a.AmountPriceType = (*AmountPriceType2Code)(&value)
 }
@@ -17,3 +25,7 @@ func (a *AmountPrice2) SetAmountPriceType(value string) {
 func (a *AmountPrice2) SetPriceValue(value, currency string) {
 	a.PriceValue = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (a *AmountPrice2) SetPriceValueFromDecimal(value decimal.Decimal, currency string) {
+	a.PriceValue = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Price expressed as an amount.
 type AmountPrice3 struct {
 
@@ -10,6 +14,10 @@ type AmountPrice3 struct {
 	PriceValue *ActiveCurrencyAnd13DecimalAmount `xml:"PricVal"`
 }
 
+func (a *AmountPrice3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountPrice3) SetAmountPriceType(value string) {
 	a.AmountPriceType = (*AmountPriceType1Code)(&value)
 }
@@ -17,3 +25,7 @@ func (a *AmountPrice3) SetAmountPriceType(value string) {
 func (a *AmountPrice3) SetPriceValue(value, currency string) {
 	a.PriceValue = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (a *AmountPrice3) SetPriceValueFromDecimal(value decimal.Decimal, currency string) {
+	a.PriceValue = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Price expressed as an amount.
 type AmountPrice5 struct {
 
@@ -10,6 +14,10 @@ type AmountPrice5 struct {
 	PriceValue *RestrictedFINActiveCurrencyAnd13DecimalAmount `xml:"PricVal"`
 }
 
+func (a *AmountPrice5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountPrice5) SetAmountPriceType(value string) {
 	a.AmountPriceType = (*AmountPriceType1Code)(&value)
 }
@@ -17,3 +25,7 @@ func (a *AmountPrice5) SetAmountPriceType(value string) {
 func (a *AmountPrice5) SetPriceValue(value, currency string) {
 	a.PriceValue = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (a *AmountPrice5) SetPriceValueFromDecimal(value decimal.Decimal, currency string) {
+	a.PriceValue = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies a ratio: Amount price per amount.
 //
 // Example:
@@ -16,6 +20,10 @@ type AmountPricePerAmount1 struct {
 	Amount *ActiveCurrencyAnd13DecimalAmount `xml:"Amt"`
 }
 
+func (a *AmountPricePerAmount1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountPricePerAmount1) AddAmountPriceType() *AmountPriceType1FormatChoice {
 	a.AmountPriceType = new(AmountPriceType1FormatChoice)
 	return a.AmountPriceType
@@ -25,6 +33,14 @@ func (a *AmountPricePerAmount1) SetPriceValue(value, currency string) {
 	a.PriceValue = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (a *AmountPricePerAmount1) SetPriceValueFromDecimal(value decimal.Decimal, currency string) {
+	a.PriceValue = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (a *AmountPricePerAmount1) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (a *AmountPricePerAmount1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies a ratio: Amount price per amount.
 // Example:
 // ISIN US629377AS17. Repurchase USD1087.17 cash for every USD1000 stock (NRG Energy Inc 8% Senior Notes 15/12/13).
@@ -15,6 +19,10 @@ type AmountPricePerAmount2 struct {
 	Amount *ActiveCurrencyAnd13DecimalAmount `xml:"Amt"`
 }
 
+func (a *AmountPricePerAmount2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountPricePerAmount2) SetAmountPriceType(value string) {
 	a.AmountPriceType = (*AmountPriceType1Code)(&value)
 }
@@ -23,6 +31,14 @@ func (a *AmountPricePerAmount2) SetPriceValue(value, currency string) {
 	a.PriceValue = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (a *AmountPricePerAmount2) SetPriceValueFromDecimal(value decimal.Decimal, currency string) {
+	a.PriceValue = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (a *AmountPricePerAmount2) SetAmount(value, currency string) {
 	a.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (a *AmountPricePerAmount2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies a ratio: Amount price per amount.
 // Example:
 // ISIN US629377AS17. Repurchase USD1087.17 cash for every USD1000 stock (NRG Energy Inc 8% Senior Notes 15/12/13).
@@ -15,6 +19,10 @@ type AmountPricePerAmount3 struct {
 	Amount *RestrictedFINActiveCurrencyAnd13DecimalAmount `xml:"Amt"`
 }
 
+func (a *AmountPricePerAmount3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountPricePerAmount3) SetAmountPriceType(value string) {
 	a.AmountPriceType = (*AmountPriceType1Code)(&value)
 }
@@ -23,6 +31,14 @@ func (a *AmountPricePerAmount3) SetPriceValue(value, currency string) {
 	a.PriceValue = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (a *AmountPricePerAmount3) SetPriceValueFromDecimal(value decimal.Decimal, currency string) {
+	a.PriceValue = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (a *AmountPricePerAmount3) SetAmount(value, currency string) {
 	a.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (a *AmountPricePerAmount3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
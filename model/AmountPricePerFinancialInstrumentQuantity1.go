@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies a ratio: amount price per financial instrument quantity.
 type AmountPricePerFinancialInstrumentQuantity1 struct {
 
@@ -13,6 +17,10 @@ type AmountPricePerFinancialInstrumentQuantity1 struct {
 	FinancialInstrumentQuantity *UnitOrFaceAmount1Choice `xml:"FinInstrmQty"`
 }
 
+func (a *AmountPricePerFinancialInstrumentQuantity1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountPricePerFinancialInstrumentQuantity1) AddAmountPriceType() *AmountPriceType1FormatChoice {
 	a.AmountPriceType = new(AmountPriceType1FormatChoice)
 	return a.AmountPriceType
@@ -22,6 +30,10 @@ func (a *AmountPricePerFinancialInstrumentQuantity1) SetPriceValue(value, curren
 	a.PriceValue = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (a *AmountPricePerFinancialInstrumentQuantity1) SetPriceValueFromDecimal(value decimal.Decimal, currency string) {
+	a.PriceValue = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (a *AmountPricePerFinancialInstrumentQuantity1) AddFinancialInstrumentQuantity() *UnitOrFaceAmount1Choice {
 	a.FinancialInstrumentQuantity = new(UnitOrFaceAmount1Choice)
 	return a.FinancialInstrumentQuantity
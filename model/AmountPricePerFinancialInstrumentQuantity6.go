@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies a ratio: amount price per financial instrument quantity.
 type AmountPricePerFinancialInstrumentQuantity6 struct {
 
@@ -13,6 +17,10 @@ type AmountPricePerFinancialInstrumentQuantity6 struct {
 	FinancialInstrumentQuantity *FinancialInstrumentQuantity1Choice `xml:"FinInstrmQty"`
 }
 
+func (a *AmountPricePerFinancialInstrumentQuantity6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountPricePerFinancialInstrumentQuantity6) SetAmountPriceType(value string) {
 	a.AmountPriceType = (*AmountPriceType1Code)(&value)
 }
@@ -21,6 +29,10 @@ func (a *AmountPricePerFinancialInstrumentQuantity6) SetPriceValue(value, curren
 	a.PriceValue = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (a *AmountPricePerFinancialInstrumentQuantity6) SetPriceValueFromDecimal(value decimal.Decimal, currency string) {
+	a.PriceValue = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (a *AmountPricePerFinancialInstrumentQuantity6) AddFinancialInstrumentQuantity() *FinancialInstrumentQuantity1Choice {
 	a.FinancialInstrumentQuantity = new(FinancialInstrumentQuantity1Choice)
 	return a.FinancialInstrumentQuantity
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies a ratio: amount price per financial instrument quantity.
 type AmountPricePerFinancialInstrumentQuantity7 struct {
 
@@ -13,6 +17,10 @@ type AmountPricePerFinancialInstrumentQuantity7 struct {
 	FinancialInstrumentQuantity *FinancialInstrumentQuantity15Choice `xml:"FinInstrmQty"`
 }
 
+func (a *AmountPricePerFinancialInstrumentQuantity7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountPricePerFinancialInstrumentQuantity7) SetAmountPriceType(value string) {
 	a.AmountPriceType = (*AmountPriceType1Code)(&value)
 }
@@ -21,6 +29,10 @@ func (a *AmountPricePerFinancialInstrumentQuantity7) SetPriceValue(value, curren
 	a.PriceValue = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (a *AmountPricePerFinancialInstrumentQuantity7) SetPriceValueFromDecimal(value decimal.Decimal, currency string) {
+	a.PriceValue = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (a *AmountPricePerFinancialInstrumentQuantity7) AddFinancialInstrumentQuantity() *FinancialInstrumentQuantity15Choice {
 	a.FinancialInstrumentQuantity = new(FinancialInstrumentQuantity15Choice)
 	return a.FinancialInstrumentQuantity
@@ -10,6 +10,10 @@ type AmountPriceType1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (a *AmountPriceType1FormatChoice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountPriceType1FormatChoice) SetCode(value string) {
 	a.Code = (*AmountPriceType1Code)(&value)
 }
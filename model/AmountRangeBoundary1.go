@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Limit for an amount range.
 type AmountRangeBoundary1 struct {
 
@@ -10,10 +15,64 @@ type AmountRangeBoundary1 struct {
 	Included *YesNoIndicator `xml:"Incl"`
 }
 
+func (a *AmountRangeBoundary1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountRangeBoundary1) SetBoundaryAmount(value, currency string) {
 	a.BoundaryAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountRangeBoundary1) SetBoundaryAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.BoundaryAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountRangeBoundary1) SetIncluded(value string) {
 	a.Included = (*YesNoIndicator)(&value)
 }
+
+func (a *AmountRangeBoundary1) SetIncludedFromBool(value bool) {
+	a.Included = new(YesNoIndicator)
+	a.Included.FromBool(value)
+}
+
+func (a *AmountRangeBoundary1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.BoundaryAmount != nil {
+		a.BoundaryAmount.EncodeXMLFast(enc, "BdryAmt")
+	}
+	if a.Included != nil {
+		enc.Element("Incl", string(*a.Included))
+	}
+	enc.End(tag)
+}
+
+func (a *AmountRangeBoundary1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "BdryAmt":
+			elem := new(ImpliedCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.BoundaryAmount = elem
+		case "Incl":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.Included = (*YesNoIndicator)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Ratio expressed as a quotient of amounts.
 type AmountToAmountRatio1 struct {
 
@@ -10,10 +14,22 @@ type AmountToAmountRatio1 struct {
 	Amount2 *ActiveCurrencyAndAmount `xml:"Amt2"`
 }
 
+func (a *AmountToAmountRatio1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountToAmountRatio1) SetAmount1(value, currency string) {
 	a.Amount1 = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountToAmountRatio1) SetAmount1FromDecimal(value decimal.Decimal, currency string) {
+	a.Amount1 = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountToAmountRatio1) SetAmount2(value, currency string) {
 	a.Amount2 = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (a *AmountToAmountRatio1) SetAmount2FromDecimal(value decimal.Decimal, currency string) {
+	a.Amount2 = NewActiveCurrencyAndAmount(value.String(), currency)
+}
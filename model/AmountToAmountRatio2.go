@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Ratio expressed as a quotient of amounts.
 type AmountToAmountRatio2 struct {
 
@@ -10,10 +14,22 @@ type AmountToAmountRatio2 struct {
 	Amount2 *ActiveCurrencyAnd13DecimalAmount `xml:"Amt2"`
 }
 
+func (a *AmountToAmountRatio2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountToAmountRatio2) SetAmount1(value, currency string) {
 	a.Amount1 = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (a *AmountToAmountRatio2) SetAmount1FromDecimal(value decimal.Decimal, currency string) {
+	a.Amount1 = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (a *AmountToAmountRatio2) SetAmount2(value, currency string) {
 	a.Amount2 = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (a *AmountToAmountRatio2) SetAmount2FromDecimal(value decimal.Decimal, currency string) {
+	a.Amount2 = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Ratio expressed as a quotient of amounts.
 type AmountToAmountRatio3 struct {
 
@@ -10,10 +14,22 @@ type AmountToAmountRatio3 struct {
 	Amount2 *RestrictedFINActiveCurrencyAnd13DecimalAmount `xml:"Amt2"`
 }
 
+func (a *AmountToAmountRatio3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountToAmountRatio3) SetAmount1(value, currency string) {
 	a.Amount1 = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (a *AmountToAmountRatio3) SetAmount1FromDecimal(value decimal.Decimal, currency string) {
+	a.Amount1 = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (a *AmountToAmountRatio3) SetAmount2(value, currency string) {
 	a.Amount2 = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (a *AmountToAmountRatio3) SetAmount2FromDecimal(value decimal.Decimal, currency string) {
+	a.Amount2 = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money to be transferred between the debtor and creditor, expressed in debtor's account currency or converted in another currency.
 type AmountType1Choice struct {
 
@@ -14,10 +18,18 @@ type AmountType1Choice struct {
 	EquivalentAmount *EquivalentAmount `xml:"EqvtAmt"`
 }
 
+func (a *AmountType1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountType1Choice) SetInstructedAmount(value, currency string) {
 	a.InstructedAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountType1Choice) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.InstructedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountType1Choice) AddEquivalentAmount() *EquivalentAmount {
 	a.EquivalentAmount = new(EquivalentAmount)
 	return a.EquivalentAmount
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money to be moved between the debtor and creditor, expressed in debtor's account currency or converted in another currency.
 type AmountType2Choice struct {
 
@@ -12,10 +16,18 @@ type AmountType2Choice struct {
 	EquivalentAmount *EquivalentAmount `xml:"EqvtAmt"`
 }
 
+func (a *AmountType2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountType2Choice) SetInstructedAmount(value, currency string) {
 	a.InstructedAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountType2Choice) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.InstructedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountType2Choice) AddEquivalentAmount() *EquivalentAmount {
 	a.EquivalentAmount = new(EquivalentAmount)
 	return a.EquivalentAmount
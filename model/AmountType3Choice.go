@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the amount of money to be moved between the debtor and creditor, before deduction of charges, expressed in the currency as ordered by the initiating party.
 type AmountType3Choice struct {
 
@@ -10,10 +14,18 @@ type AmountType3Choice struct {
 	EquivalentAmount *EquivalentAmount2 `xml:"EqvtAmt"`
 }
 
+func (a *AmountType3Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountType3Choice) SetInstructedAmount(value, currency string) {
 	a.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountType3Choice) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountType3Choice) AddEquivalentAmount() *EquivalentAmount2 {
 	a.EquivalentAmount = new(EquivalentAmount2)
 	return a.EquivalentAmount
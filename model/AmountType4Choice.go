@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Specifies the amount of money to be moved between the debtor and creditor, before deduction of charges, expressed in the currency as ordered by the initiating party.
 type AmountType4Choice struct {
 
@@ -12,11 +17,60 @@ type AmountType4Choice struct {
 	EquivalentAmount *EquivalentAmount2 `xml:"EqvtAmt"`
 }
 
+func (a *AmountType4Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountType4Choice) SetInstructedAmount(value, currency string) {
 	a.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountType4Choice) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountType4Choice) AddEquivalentAmount() *EquivalentAmount2 {
 	a.EquivalentAmount = new(EquivalentAmount2)
 	return a.EquivalentAmount
 }
+
+func (a *AmountType4Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.InstructedAmount != nil {
+		a.InstructedAmount.EncodeXMLFast(enc, "InstdAmt")
+	}
+	if a.EquivalentAmount != nil {
+		a.EquivalentAmount.EncodeXMLFast(enc, "EqvtAmt")
+	}
+	enc.End(tag)
+}
+
+func (a *AmountType4Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "InstdAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.InstructedAmount = elem
+		case "EqvtAmt":
+			elem := new(EquivalentAmount2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			a.EquivalentAmount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the value date and the amounts traded in a foreign exchange transaction.
 type AmountsAndValueDate1 struct {
 
@@ -13,14 +19,31 @@ type AmountsAndValueDate1 struct {
 	SettlementDate *ISODate `xml:"SttlmDt"`
 }
 
+func (a *AmountsAndValueDate1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountsAndValueDate1) SetTradingSideBuyAmount(value, currency string) {
 	a.TradingSideBuyAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountsAndValueDate1) SetTradingSideBuyAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.TradingSideBuyAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountsAndValueDate1) SetTradingSideSellAmount(value, currency string) {
 	a.TradingSideSellAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountsAndValueDate1) SetTradingSideSellAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.TradingSideSellAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountsAndValueDate1) SetSettlementDate(value string) {
 	a.SettlementDate = (*ISODate)(&value)
 }
+
+func (a *AmountsAndValueDate1) SetSettlementDateFromTime(value time.Time) {
+	a.SettlementDate = new(ISODate)
+	a.SettlementDate.FromTime(value)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the value date and the amounts traded in a foreign exchange option trade.
 type AmountsAndValueDate2 struct {
 
@@ -13,14 +19,31 @@ type AmountsAndValueDate2 struct {
 	FinalSettlementDate *ISODate `xml:"FnlSttlmDt"`
 }
 
+func (a *AmountsAndValueDate2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AmountsAndValueDate2) SetCallAmount(value, currency string) {
 	a.CallAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountsAndValueDate2) SetCallAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.CallAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountsAndValueDate2) SetPutAmount(value, currency string) {
 	a.PutAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (a *AmountsAndValueDate2) SetPutAmountFromDecimal(value decimal.Decimal, currency string) {
+	a.PutAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *AmountsAndValueDate2) SetFinalSettlementDate(value string) {
 	a.FinalSettlementDate = (*ISODate)(&value)
 }
+
+func (a *AmountsAndValueDate2) SetFinalSettlementDateFromTime(value time.Time) {
+	a.FinalSettlementDate = new(ISODate)
+	a.FinalSettlementDate.FromTime(value)
+}
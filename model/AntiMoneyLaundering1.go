@@ -40,6 +40,10 @@ type AntiMoneyLaundering1 struct {
 	TransactionReference *Max35Text `xml:"TxRef,omitempty"`
 }
 
+func (a *AntiMoneyLaundering1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AntiMoneyLaundering1) SetSenderName(value string) {
 	a.SenderName = (*Max70Text)(&value)
 }
@@ -0,0 +1,16 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/validation"
+)
+
+type AnyBICDec2014Identifier string
+
+var anyBICDec2014IdentifierPattern = newPattern(`[A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1}`)
+
+func (a AnyBICDec2014Identifier) Validate() error {
+	if err := validatePattern("AnyBICDec2014Identifier", string(a), anyBICDec2014IdentifierPattern); err != nil {
+		return err
+	}
+	return validation.ValidateBIC(string(a))
+}
@@ -1,3 +1,16 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/validation"
+)
+
 type AnyBICIdentifier string
+
+var anyBICIdentifierPattern = newPattern(`[A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1}`)
+
+func (a AnyBICIdentifier) Validate() error {
+	if err := validatePattern("AnyBICIdentifier", string(a), anyBICIdentifierPattern); err != nil {
+		return err
+	}
+	return validation.ValidateBIC(string(a))
+}
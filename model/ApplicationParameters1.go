@@ -16,6 +16,10 @@ type ApplicationParameters1 struct {
 	EncryptedParameters *ContentInformationType2 `xml:"NcrptdParams,omitempty"`
 }
 
+func (a *ApplicationParameters1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ApplicationParameters1) SetApplicationIdentification(value string) {
 	a.ApplicationIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type ApplicationParameters2 struct {
 	EncryptedParameters *ContentInformationType5 `xml:"NcrptdParams,omitempty"`
 }
 
+func (a *ApplicationParameters2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ApplicationParameters2) SetApplicationIdentification(value string) {
 	a.ApplicationIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type ApplicationParameters3 struct {
 	EncryptedParameters *ContentInformationType7 `xml:"NcrptdParams,omitempty"`
 }
 
+func (a *ApplicationParameters3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ApplicationParameters3) SetApplicationIdentification(value string) {
 	a.ApplicationIdentification = (*Max35Text)(&value)
 }
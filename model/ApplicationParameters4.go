@@ -16,6 +16,10 @@ type ApplicationParameters4 struct {
 	EncryptedParameters *ContentInformationType10 `xml:"NcrptdParams,omitempty"`
 }
 
+func (a *ApplicationParameters4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ApplicationParameters4) SetApplicationIdentification(value string) {
 	a.ApplicationIdentification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type ApplicationParameters5 struct {
 	EncryptedParameters *ContentInformationType10 `xml:"NcrptdParams,omitempty"`
 }
 
+func (a *ApplicationParameters5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *ApplicationParameters5) SetActionType(value string) {
 	a.ActionType = (*TerminalManagementAction3Code)(&value)
 }
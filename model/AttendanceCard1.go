@@ -13,6 +13,10 @@ type AttendanceCard1 struct {
 	OtherAddress *NameAndAddress9 `xml:"OthrAdr,omitempty"`
 }
 
+func (a *AttendanceCard1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AttendanceCard1) SetAttendanceCardLabelling(value string) {
 	a.AttendanceCardLabelling = (*Max105Text)(&value)
 }
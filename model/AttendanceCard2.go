@@ -13,6 +13,10 @@ type AttendanceCard2 struct {
 	OtherAddress *NameAndAddress9 `xml:"OthrAdr,omitempty"`
 }
 
+func (a *AttendanceCard2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AttendanceCard2) SetAttendanceCardLabelling(value string) {
 	a.AttendanceCardLabelling = (*Max105Text)(&value)
 }
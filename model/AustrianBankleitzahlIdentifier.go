@@ -1,3 +1,9 @@
 package model
 
 type AustrianBankleitzahlIdentifier string
+
+var austrianBankleitzahlIdentifierPattern = newPattern(`AT[0-9]{5,5}`)
+
+func (a AustrianBankleitzahlIdentifier) Validate() error {
+	return validatePattern("AustrianBankleitzahlIdentifier", string(a), austrianBankleitzahlIdentifierPattern)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Message authentication code (MAC), computed on the data to protect with an encryption key.
 type AuthenticatedData1 struct {
 
@@ -19,10 +23,19 @@ type AuthenticatedData1 struct {
 	MAC *Max35Binary `xml:"MAC"`
 }
 
+func (a *AuthenticatedData1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthenticatedData1) SetVersion(value string) {
 	a.Version = (*Number)(&value)
 }
 
+func (a *AuthenticatedData1) SetVersionFromDecimal(value decimal.Decimal) {
+	a.Version = new(Number)
+	a.Version.FromDecimal(value)
+}
+
 func (a *AuthenticatedData1) AddRecipient() *Recipient1Choice {
 	newValue := new(Recipient1Choice)
 	a.Recipient = append(a.Recipient, newValue)
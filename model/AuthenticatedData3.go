@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Message authentication code (MAC), computed on the data to protect with an encryption key.
 type AuthenticatedData3 struct {
 
@@ -19,10 +23,19 @@ type AuthenticatedData3 struct {
 	MAC *Max35Binary `xml:"MAC"`
 }
 
+func (a *AuthenticatedData3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthenticatedData3) SetVersion(value string) {
 	a.Version = (*Number)(&value)
 }
 
+func (a *AuthenticatedData3) SetVersionFromDecimal(value decimal.Decimal) {
+	a.Version = new(Number)
+	a.Version.FromDecimal(value)
+}
+
 func (a *AuthenticatedData3) AddRecipient() *Recipient3Choice {
 	newValue := new(Recipient3Choice)
 	a.Recipient = append(a.Recipient, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Message authentication code (MAC), computed on the data to protect with an encryption key.
 type AuthenticatedData4 struct {
 
@@ -19,10 +23,19 @@ type AuthenticatedData4 struct {
 	MAC *Max140Binary `xml:"MAC"`
 }
 
+func (a *AuthenticatedData4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthenticatedData4) SetVersion(value string) {
 	a.Version = (*Number)(&value)
 }
 
+func (a *AuthenticatedData4) SetVersionFromDecimal(value decimal.Decimal) {
+	a.Version = new(Number)
+	a.Version.FromDecimal(value)
+}
+
 func (a *AuthenticatedData4) AddRecipient() *Recipient4Choice {
 	newValue := new(Recipient4Choice)
 	a.Recipient = append(a.Recipient, newValue)
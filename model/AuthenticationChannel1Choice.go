@@ -10,6 +10,10 @@ type AuthenticationChannel1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (a *AuthenticationChannel1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthenticationChannel1Choice) SetCode(value string) {
 	a.Code = (*ExternalAuthenticationChannel1Code)(&value)
 }
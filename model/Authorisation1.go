@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Autorisation of the mandate holder.
 type Authorisation1 struct {
 
@@ -13,14 +17,26 @@ type Authorisation1 struct {
 	MaximumAmountByPeriod []*MaximumAmountByPeriod1 `xml:"MaxAmtByPrd,omitempty"`
 }
 
+func (a *Authorisation1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Authorisation1) SetMinimumAmountPerTransaction(value, currency string) {
 	a.MinimumAmountPerTransaction = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (a *Authorisation1) SetMinimumAmountPerTransactionFromDecimal(value decimal.Decimal, currency string) {
+	a.MinimumAmountPerTransaction = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *Authorisation1) SetMaximumAmountPerTransaction(value, currency string) {
 	a.MaximumAmountPerTransaction = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (a *Authorisation1) SetMaximumAmountPerTransactionFromDecimal(value decimal.Decimal, currency string) {
+	a.MaximumAmountPerTransaction = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (a *Authorisation1) AddMaximumAmountByPeriod() *MaximumAmountByPeriod1 {
 	newValue := new(MaximumAmountByPeriod1)
 	a.MaximumAmountByPeriod = append(a.MaximumAmountByPeriod, newValue)
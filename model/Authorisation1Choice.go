@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Provides the details on the user identification or any user key that allows to check if the initiating party is allowed to issue the transaction.
 type Authorisation1Choice struct {
 
@@ -10,10 +12,59 @@ type Authorisation1Choice struct {
 	Proprietary *Max128Text `xml:"Prtry"`
 }
 
-func (a *Authorisation1Choice) SetCode(value string) {
+func (a *Authorisation1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
+func (a *Authorisation1Choice) SetCode(value string) error {
+	if err := Authorisation1Code(value).Validate(); err != nil {
+		return err
+	}
 	a.Code = (*Authorisation1Code)(&value)
+	return nil
 }
 
 func (a *Authorisation1Choice) SetProprietary(value string) {
 	a.Proprietary = (*Max128Text)(&value)
 }
+
+func (a *Authorisation1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if a.Code != nil {
+		enc.Element("Cd", string(*a.Code))
+	}
+	if a.Proprietary != nil {
+		enc.Element("Prtry", string(*a.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (a *Authorisation1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.Code = (*Authorisation1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			a.Proprietary = (*Max128Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,3 +1,30 @@
 package model
 
 type Authorisation1Code string
+
+const (
+	Authorisation1CodeAUTH Authorisation1Code = "AUTH"
+	Authorisation1CodeFDET Authorisation1Code = "FDET"
+	Authorisation1CodeFSUM Authorisation1Code = "FSUM"
+	Authorisation1CodeILEV Authorisation1Code = "ILEV"
+)
+
+var authorisation1CodeDescriptions = map[Authorisation1Code]string{
+	Authorisation1CodeAUTH: "Pre-authorised file: indicates a file has been pre authorised or approved within the originating customer environment and no further approval is required.",
+	Authorisation1CodeFDET: "File level authorisation details: indicates that a file requires additional file level approval, with the ability to view both the payment information block and supporting customer credit transaction detail.",
+	Authorisation1CodeFSUM: "File level authorisation summary: indicates that a file requires additional file level approval, with the ability to view only the payment information block level information.",
+	Authorisation1CodeILEV: "Instruction level authorisation: indicates that a file requires all customer transactions to be authorised or approved.",
+}
+
+func (a Authorisation1Code) IsValid() bool {
+	_, ok := authorisation1CodeDescriptions[a]
+	return ok
+}
+
+func (a Authorisation1Code) Description() string {
+	return authorisation1CodeDescriptions[a]
+}
+
+func (a Authorisation1Code) Validate() error {
+	return validateEnumeration("Authorisation1Code", string(a), a.IsValid(), "AUTH, FDET, FSUM, ILEV")
+}
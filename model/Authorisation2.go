@@ -13,6 +13,10 @@ type Authorisation2 struct {
 	MaximumAmountByBulkSubmission *FixedAmountOrUnlimited1Choice `xml:"MaxAmtByBlkSubmissn,omitempty"`
 }
 
+func (a *Authorisation2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *Authorisation2) AddMaximumAmountByTransaction() *FixedAmountOrUnlimited1Choice {
 	a.MaximumAmountByTransaction = new(FixedAmountOrUnlimited1Choice)
 	return a.MaximumAmountByTransaction
@@ -19,6 +19,10 @@ type AuthorisationResult1 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AuthorisationResult1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthorisationResult1) AddAuthorisationEntity() *GenericIdentification33 {
 	a.AuthorisationEntity = new(GenericIdentification33)
 	return a.AuthorisationEntity
@@ -37,6 +41,11 @@ func (a *AuthorisationResult1) SetCompletionRequired(value string) {
 	a.CompletionRequired = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AuthorisationResult1) SetCompletionRequiredFromBool(value bool) {
+	a.CompletionRequired = new(TrueFalseIndicator)
+	a.CompletionRequired.FromBool(value)
+}
+
 func (a *AuthorisationResult1) AddTMSTrigger() *TMSTrigger1 {
 	a.TMSTrigger = new(TMSTrigger1)
 	return a.TMSTrigger
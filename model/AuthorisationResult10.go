@@ -19,6 +19,10 @@ type AuthorisationResult10 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AuthorisationResult10) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthorisationResult10) AddAuthorisationEntity() *GenericIdentification90 {
 	a.AuthorisationEntity = new(GenericIdentification90)
 	return a.AuthorisationEntity
@@ -37,6 +41,11 @@ func (a *AuthorisationResult10) SetCompletionRequired(value string) {
 	a.CompletionRequired = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AuthorisationResult10) SetCompletionRequiredFromBool(value bool) {
+	a.CompletionRequired = new(TrueFalseIndicator)
+	a.CompletionRequired.FromBool(value)
+}
+
 func (a *AuthorisationResult10) AddTMSTrigger() *TMSTrigger1 {
 	a.TMSTrigger = new(TMSTrigger1)
 	return a.TMSTrigger
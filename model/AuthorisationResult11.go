@@ -13,6 +13,10 @@ type AuthorisationResult11 struct {
 	AuthorisationCode *Min6Max8Text `xml:"AuthstnCd,omitempty"`
 }
 
+func (a *AuthorisationResult11) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthorisationResult11) AddAuthorisationEntity() *GenericIdentification90 {
 	a.AuthorisationEntity = new(GenericIdentification90)
 	return a.AuthorisationEntity
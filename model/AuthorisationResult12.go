@@ -16,6 +16,10 @@ type AuthorisationResult12 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AuthorisationResult12) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthorisationResult12) AddAuthorisationEntity() *GenericIdentification90 {
 	a.AuthorisationEntity = new(GenericIdentification90)
 	return a.AuthorisationEntity
@@ -19,6 +19,10 @@ type AuthorisationResult13 struct {
 	Action []*Action7 `xml:"Actn,omitempty"`
 }
 
+func (a *AuthorisationResult13) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthorisationResult13) SetAuthorisationEntity(value string) {
 	a.AuthorisationEntity = (*PartyType16Code)(&value)
 }
@@ -13,6 +13,10 @@ type AuthorisationResult2 struct {
 	AuthorisationCode *Min6Max8Text `xml:"AuthstnCd,omitempty"`
 }
 
+func (a *AuthorisationResult2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthorisationResult2) AddAuthorisationEntity() *GenericIdentification33 {
 	a.AuthorisationEntity = new(GenericIdentification33)
 	return a.AuthorisationEntity
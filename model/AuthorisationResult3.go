@@ -16,6 +16,10 @@ type AuthorisationResult3 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AuthorisationResult3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthorisationResult3) AddAuthorisationEntity() *GenericIdentification33 {
 	a.AuthorisationEntity = new(GenericIdentification33)
 	return a.AuthorisationEntity
@@ -19,6 +19,10 @@ type AuthorisationResult4 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AuthorisationResult4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthorisationResult4) AddAuthorisationEntity() *GenericIdentification70 {
 	a.AuthorisationEntity = new(GenericIdentification70)
 	return a.AuthorisationEntity
@@ -37,6 +41,11 @@ func (a *AuthorisationResult4) SetCompletionRequired(value string) {
 	a.CompletionRequired = (*TrueFalseIndicator)(&value)
 }
 
+func (a *AuthorisationResult4) SetCompletionRequiredFromBool(value bool) {
+	a.CompletionRequired = new(TrueFalseIndicator)
+	a.CompletionRequired.FromBool(value)
+}
+
 func (a *AuthorisationResult4) AddTMSTrigger() *TMSTrigger1 {
 	a.TMSTrigger = new(TMSTrigger1)
 	return a.TMSTrigger
@@ -13,6 +13,10 @@ type AuthorisationResult5 struct {
 	AuthorisationCode *Min6Max8Text `xml:"AuthstnCd,omitempty"`
 }
 
+func (a *AuthorisationResult5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthorisationResult5) AddAuthorisationEntity() *GenericIdentification70 {
 	a.AuthorisationEntity = new(GenericIdentification70)
 	return a.AuthorisationEntity
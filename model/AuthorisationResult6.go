@@ -16,6 +16,10 @@ type AuthorisationResult6 struct {
 	TMSTrigger *TMSTrigger1 `xml:"TMSTrggr,omitempty"`
 }
 
+func (a *AuthorisationResult6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthorisationResult6) AddAuthorisationEntity() *GenericIdentification70 {
 	a.AuthorisationEntity = new(GenericIdentification70)
 	return a.AuthorisationEntity
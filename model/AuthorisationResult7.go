@@ -16,6 +16,10 @@ type AuthorisationResult7 struct {
 	AdditionalInformation []*ActionMessage3 `xml:"AddtlInf,omitempty"`
 }
 
+func (a *AuthorisationResult7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthorisationResult7) AddAuthorisationEntity() *GenericIdentification75 {
 	a.AuthorisationEntity = new(GenericIdentification75)
 	return a.AuthorisationEntity
@@ -19,6 +19,10 @@ type AuthorisationResult8 struct {
 	AdditionalInformation []*ActionMessage3 `xml:"AddtlInf,omitempty"`
 }
 
+func (a *AuthorisationResult8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthorisationResult8) AddAuthorisationEntity() *GenericIdentification75 {
 	a.AuthorisationEntity = new(GenericIdentification75)
 	return a.AuthorisationEntity
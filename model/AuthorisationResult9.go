@@ -19,6 +19,10 @@ type AuthorisationResult9 struct {
 	Action []*Action5 `xml:"Actn,omitempty"`
 }
 
+func (a *AuthorisationResult9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthorisationResult9) SetAuthorisationEntity(value string) {
 	a.AuthorisationEntity = (*PartyType13Code)(&value)
 }
@@ -16,6 +16,10 @@ type AuthorityInvestigation2 struct {
 	AdditionalInformation *Max500Text `xml:"AddtlInf,omitempty"`
 }
 
+func (a *AuthorityInvestigation2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthorityInvestigation2) AddType() *AuthorityRequestType1 {
 	a.Type = new(AuthorityRequestType1)
 	return a.Type
@@ -10,6 +10,10 @@ type AuthorityRequestType1 struct {
 	MessageName *Max140Text `xml:"MsgNm,omitempty"`
 }
 
+func (a *AuthorityRequestType1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AuthorityRequestType1) SetMessageNameIdentification(value string) {
 	a.MessageNameIdentification = (*Max35Text)(&value)
 }
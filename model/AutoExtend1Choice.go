@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format for the auto extend period.
 type AutoExtend1Choice struct {
 
@@ -16,18 +22,42 @@ type AutoExtend1Choice struct {
 	Date *ISODate `xml:"Dt"`
 }
 
+func (a *AutoExtend1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutoExtend1Choice) SetDays(value string) {
 	a.Days = (*Number)(&value)
 }
 
+func (a *AutoExtend1Choice) SetDaysFromDecimal(value decimal.Decimal) {
+	a.Days = new(Number)
+	a.Days.FromDecimal(value)
+}
+
 func (a *AutoExtend1Choice) SetMonths(value string) {
 	a.Months = (*Number)(&value)
 }
 
+func (a *AutoExtend1Choice) SetMonthsFromDecimal(value decimal.Decimal) {
+	a.Months = new(Number)
+	a.Months.FromDecimal(value)
+}
+
 func (a *AutoExtend1Choice) SetYears(value string) {
 	a.Years = (*Number)(&value)
 }
 
+func (a *AutoExtend1Choice) SetYearsFromDecimal(value decimal.Decimal) {
+	a.Years = new(Number)
+	a.Years.FromDecimal(value)
+}
+
 func (a *AutoExtend1Choice) SetDate(value string) {
 	a.Date = (*ISODate)(&value)
 }
+
+func (a *AutoExtend1Choice) SetDateFromTime(value time.Time) {
+	a.Date = new(ISODate)
+	a.Date.FromTime(value)
+}
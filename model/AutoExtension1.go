@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Automatic extension information.
 type AutoExtension1 struct {
 
@@ -13,6 +17,10 @@ type AutoExtension1 struct {
 	NonExtensionNotification []*NonExtension1 `xml:"NonXtnsnNtfctn,omitempty"`
 }
 
+func (a *AutoExtension1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutoExtension1) AddPeriod() *AutoExtend1Choice {
 	a.Period = new(AutoExtend1Choice)
 	return a.Period
@@ -22,6 +30,11 @@ func (a *AutoExtension1) SetFinalExpiryDate(value string) {
 	a.FinalExpiryDate = (*ISODate)(&value)
 }
 
+func (a *AutoExtension1) SetFinalExpiryDateFromTime(value time.Time) {
+	a.FinalExpiryDate = new(ISODate)
+	a.FinalExpiryDate.FromTime(value)
+}
+
 func (a *AutoExtension1) AddNonExtensionNotification() *NonExtension1 {
 	newValue := new(NonExtension1)
 	a.NonExtensionNotification = append(a.NonExtensionNotification, newValue)
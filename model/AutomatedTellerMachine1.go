@@ -28,6 +28,10 @@ type AutomatedTellerMachine1 struct {
 	Equipment *ATMEquipment1 `xml:"Eqpmnt,omitempty"`
 }
 
+func (a *AutomatedTellerMachine1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomatedTellerMachine1) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
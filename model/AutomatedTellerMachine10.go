@@ -31,6 +31,10 @@ type AutomatedTellerMachine10 struct {
 	AvailableDevice []*ATMDevice2Code `xml:"AvlblDvc,omitempty"`
 }
 
+func (a *AutomatedTellerMachine10) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomatedTellerMachine10) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type AutomatedTellerMachine2 struct {
 	Location *PostalAddress17 `xml:"Lctn,omitempty"`
 }
 
+func (a *AutomatedTellerMachine2) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomatedTellerMachine2) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
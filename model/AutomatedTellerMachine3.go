@@ -16,6 +16,10 @@ type AutomatedTellerMachine3 struct {
 	Location *PostalAddress17 `xml:"Lctn,omitempty"`
 }
 
+func (a *AutomatedTellerMachine3) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomatedTellerMachine3) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -31,6 +31,10 @@ type AutomatedTellerMachine4 struct {
 	AvailableDevice []*ATMDevice2Code `xml:"AvlblDvc,omitempty"`
 }
 
+func (a *AutomatedTellerMachine4) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomatedTellerMachine4) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -31,6 +31,10 @@ type AutomatedTellerMachine5 struct {
 	MessageProtection *MessageProtection1Code `xml:"MsgPrtcn,omitempty"`
 }
 
+func (a *AutomatedTellerMachine5) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomatedTellerMachine5) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type AutomatedTellerMachine6 struct {
 	Equipment *ATMEquipment1 `xml:"Eqpmnt,omitempty"`
 }
 
+func (a *AutomatedTellerMachine6) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomatedTellerMachine6) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type AutomatedTellerMachine7 struct {
 	SequenceNumber *Max35Text `xml:"SeqNb,omitempty"`
 }
 
+func (a *AutomatedTellerMachine7) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomatedTellerMachine7) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type AutomatedTellerMachine8 struct {
 	Equipment *ATMEquipment1 `xml:"Eqpmnt,omitempty"`
 }
 
+func (a *AutomatedTellerMachine8) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomatedTellerMachine8) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
@@ -28,6 +28,10 @@ type AutomatedTellerMachine9 struct {
 	Equipment *ATMEquipment1 `xml:"Eqpmnt,omitempty"`
 }
 
+func (a *AutomatedTellerMachine9) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomatedTellerMachine9) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
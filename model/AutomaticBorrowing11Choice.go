@@ -10,6 +10,10 @@ type AutomaticBorrowing11Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AutomaticBorrowing11Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomaticBorrowing11Choice) SetCode(value string) {
 	a.Code = (*AutoBorrowing2Code)(&value)
 }
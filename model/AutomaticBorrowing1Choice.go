@@ -10,6 +10,10 @@ type AutomaticBorrowing1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AutomaticBorrowing1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomaticBorrowing1Choice) SetCode(value string) {
 	a.Code = (*AutoBorrowing1Code)(&value)
 }
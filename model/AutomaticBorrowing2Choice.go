@@ -10,6 +10,10 @@ type AutomaticBorrowing2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (a *AutomaticBorrowing2Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomaticBorrowing2Choice) SetCode(value string) {
 	a.Code = (*AutoBorrowing2Code)(&value)
 }
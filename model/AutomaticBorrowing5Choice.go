@@ -10,6 +10,10 @@ type AutomaticBorrowing5Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (a *AutomaticBorrowing5Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomaticBorrowing5Choice) SetCode(value string) {
 	a.Code = (*AutoBorrowing1Code)(&value)
 }
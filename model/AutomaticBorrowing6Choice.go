@@ -10,6 +10,10 @@ type AutomaticBorrowing6Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (a *AutomaticBorrowing6Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomaticBorrowing6Choice) SetCode(value string) {
 	a.Code = (*AutoBorrowing1Code)(&value)
 }
@@ -10,6 +10,10 @@ type AutomaticBorrowing7Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (a *AutomaticBorrowing7Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomaticBorrowing7Choice) SetCode(value string) {
 	a.Code = (*AutoBorrowing2Code)(&value)
 }
@@ -10,6 +10,10 @@ type AutomaticBorrowing8Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (a *AutomaticBorrowing8Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomaticBorrowing8Choice) SetCode(value string) {
 	a.Code = (*AutoBorrowing1Code)(&value)
 }
@@ -16,6 +16,10 @@ type AutomaticVariation1 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (a *AutomaticVariation1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AutomaticVariation1) SetIdentification(value string) {
 	a.Identification = (*Max35Text)(&value)
 }
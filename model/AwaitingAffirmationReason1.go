@@ -10,6 +10,10 @@ type AwaitingAffirmationReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AwaitingAffirmationReason1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AwaitingAffirmationReason1) AddCode() *AwaitingAffirmationReason1Choice {
 	a.Code = new(AwaitingAffirmationReason1Choice)
 	return a.Code
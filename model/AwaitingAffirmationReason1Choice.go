@@ -10,6 +10,10 @@ type AwaitingAffirmationReason1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (a *AwaitingAffirmationReason1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AwaitingAffirmationReason1Choice) SetCode(value string) {
 	a.Code = (*AwaitingAffirmationReason1Code)(&value)
 }
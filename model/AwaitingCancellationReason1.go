@@ -10,6 +10,10 @@ type AwaitingCancellationReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (a *AwaitingCancellationReason1) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AwaitingCancellationReason1) AddCode() *AwaitingCancellationReason1Choice {
 	a.Code = new(AwaitingCancellationReason1Choice)
 	return a.Code
@@ -10,6 +10,10 @@ type AwaitingCancellationReason1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (a *AwaitingCancellationReason1Choice) Validate() error {
+	return ValidateElement(a)
+}
+
 func (a *AwaitingCancellationReason1Choice) SetCode(value string) {
 	a.Code = (*AwaitingCancellationReason1Code)(&value)
 }
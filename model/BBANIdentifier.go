@@ -1,3 +1,9 @@
 package model
 
 type BBANIdentifier string
+
+var bbanIdentifierPattern = newPattern(`[a-zA-Z0-9]{1,30}`)
+
+func (b BBANIdentifier) Validate() error {
+	return validatePattern("BBANIdentifier", string(b), bbanIdentifierPattern)
+}
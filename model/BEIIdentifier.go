@@ -1,3 +1,16 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/validation"
+)
+
 type BEIIdentifier string
+
+var beiIdentifierPattern = newPattern(`[A-Z]{6,6}[A-Z2-9][A-NP-Z0-9]([A-Z0-9]{3,3}){0,1}`)
+
+func (b BEIIdentifier) Validate() error {
+	if err := validatePattern("BEIIdentifier", string(b), beiIdentifierPattern); err != nil {
+		return err
+	}
+	return validation.ValidateBIC(string(b))
+}
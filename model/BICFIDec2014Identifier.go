@@ -0,0 +1,16 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/validation"
+)
+
+type BICFIDec2014Identifier string
+
+var bicfiDec2014IdentifierPattern = newPattern(`[A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1}`)
+
+func (b BICFIDec2014Identifier) Validate() error {
+	if err := validatePattern("BICFIDec2014Identifier", string(b), bicfiDec2014IdentifierPattern); err != nil {
+		return err
+	}
+	return validation.ValidateBIC(string(b))
+}
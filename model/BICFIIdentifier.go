@@ -1,3 +1,16 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/validation"
+)
+
 type BICFIIdentifier string
+
+var bicfiIdentifierPattern = newPattern(`[A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1}`)
+
+func (b BICFIIdentifier) Validate() error {
+	if err := validatePattern("BICFIIdentifier", string(b), bicfiIdentifierPattern); err != nil {
+		return err
+	}
+	return validation.ValidateBIC(string(b))
+}
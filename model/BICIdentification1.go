@@ -7,6 +7,10 @@ type BICIdentification1 struct {
 	BIC *BICIdentifier `xml:"BIC"`
 }
 
+func (b *BICIdentification1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BICIdentification1) SetBIC(value string) {
 	b.BIC = (*BICIdentifier)(&value)
 }
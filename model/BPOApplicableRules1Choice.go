@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Rules which apply to the BPO (Bank Payment Obligation).
 type BPOApplicableRules1Choice struct {
 
@@ -10,10 +14,19 @@ type BPOApplicableRules1Choice struct {
 	OtherRulesAndVersion *Max35Text `xml:"OthrRulesAndVrsn"`
 }
 
+func (b *BPOApplicableRules1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BPOApplicableRules1Choice) SetURBPOVersion(value string) {
 	b.URBPOVersion = (*DecimalNumber)(&value)
 }
 
+func (b *BPOApplicableRules1Choice) SetURBPOVersionFromDecimal(value decimal.Decimal) {
+	b.URBPOVersion = new(DecimalNumber)
+	b.URBPOVersion.FromDecimal(value)
+}
+
 func (b *BPOApplicableRules1Choice) SetOtherRulesAndVersion(value string) {
 	b.OtherRulesAndVersion = (*Max35Text)(&value)
 }
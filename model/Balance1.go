@@ -10,6 +10,10 @@ type Balance1 struct {
 	Quantity *BalanceQuantity4Choice `xml:"Qty"`
 }
 
+func (b *Balance1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *Balance1) SetShortLongIndicator(value string) {
 	b.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Balance10 struct {
 	Quantity *BalanceQuantity10Choice `xml:"Qty"`
 }
 
+func (b *Balance10) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *Balance10) SetShortLongIndicator(value string) {
 	b.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
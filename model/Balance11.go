@@ -10,6 +10,10 @@ type Balance11 struct {
 	Quantity *BalanceQuantity10Choice `xml:"Qty"`
 }
 
+func (b *Balance11) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *Balance11) SetShortLongIndicator(value string) {
 	b.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Balance12 struct {
 	Quantity *BalanceQuantity12Choice `xml:"Qty"`
 }
 
+func (b *Balance12) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *Balance12) SetShortLongIndicator(value string) {
 	b.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
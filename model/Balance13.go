@@ -10,6 +10,10 @@ type Balance13 struct {
 	Quantity *SubBalanceQuantity7Choice `xml:"Qty"`
 }
 
+func (b *Balance13) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *Balance13) SetShortLongIndicator(value string) {
 	b.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
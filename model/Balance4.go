@@ -10,6 +10,10 @@ type Balance4 struct {
 	Quantity *BalanceQuantity4Choice `xml:"Qty"`
 }
 
+func (b *Balance4) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *Balance4) SetShortLongIndicator(value string) {
 	b.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Balance6 struct {
 	Quantity *BalanceQuantity9Choice `xml:"Qty"`
 }
 
+func (b *Balance6) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *Balance6) SetShortLongIndicator(value string) {
 	b.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
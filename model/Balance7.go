@@ -10,6 +10,10 @@ type Balance7 struct {
 	Quantity *BalanceQuantity9Choice `xml:"Qty"`
 }
 
+func (b *Balance7) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *Balance7) SetShortLongIndicator(value string) {
 	b.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Balance8 struct {
 	Quantity *BalanceQuantity8Choice `xml:"Qty"`
 }
 
+func (b *Balance8) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *Balance8) SetShortLongIndicator(value string) {
 	b.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Balance9 struct {
 	Quantity *SubBalanceQuantity6Choice `xml:"Qty"`
 }
 
+func (b *Balance9) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *Balance9) SetShortLongIndicator(value string) {
 	b.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the balance adjustments for a specific service.
 type BalanceAdjustment1 struct {
 
@@ -28,6 +34,10 @@ type BalanceAdjustment1 struct {
 	EarningsAdjustmentAmount *AmountAndDirection34 `xml:"EarngsAdjstmntAmt,omitempty"`
 }
 
+func (b *BalanceAdjustment1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceAdjustment1) SetType(value string) {
 	b.Type = (*BalanceAdjustmentType1Code)(&value)
 }
@@ -50,14 +60,29 @@ func (b *BalanceAdjustment1) SetErrorDate(value string) {
 	b.ErrorDate = (*ISODate)(&value)
 }
 
+func (b *BalanceAdjustment1) SetErrorDateFromTime(value time.Time) {
+	b.ErrorDate = new(ISODate)
+	b.ErrorDate.FromTime(value)
+}
+
 func (b *BalanceAdjustment1) SetPostingDate(value string) {
 	b.PostingDate = (*ISODate)(&value)
 }
 
+func (b *BalanceAdjustment1) SetPostingDateFromTime(value time.Time) {
+	b.PostingDate = new(ISODate)
+	b.PostingDate.FromTime(value)
+}
+
 func (b *BalanceAdjustment1) SetDays(value string) {
 	b.Days = (*DecimalNumber)(&value)
 }
 
+func (b *BalanceAdjustment1) SetDaysFromDecimal(value decimal.Decimal) {
+	b.Days = new(DecimalNumber)
+	b.Days.FromDecimal(value)
+}
+
 func (b *BalanceAdjustment1) AddEarningsAdjustmentAmount() *AmountAndDirection34 {
 	b.EarningsAdjustmentAmount = new(AmountAndDirection34)
 	return b.EarningsAdjustmentAmount
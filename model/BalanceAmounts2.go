@@ -13,6 +13,10 @@ type BalanceAmounts2 struct {
 	UnrealisedGainLoss *AmountAndDirection6 `xml:"UrlsdGnLoss,omitempty"`
 }
 
+func (b *BalanceAmounts2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceAmounts2) AddHoldingValue() *AmountAndDirection6 {
 	b.HoldingValue = new(AmountAndDirection6)
 	return b.HoldingValue
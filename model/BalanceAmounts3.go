@@ -19,6 +19,10 @@ type BalanceAmounts3 struct {
 	AccruedInterestAmount *AmountAndDirection6 `xml:"AcrdIntrstAmt,omitempty"`
 }
 
+func (b *BalanceAmounts3) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceAmounts3) AddHoldingValue() *AmountAndDirection6 {
 	b.HoldingValue = new(AmountAndDirection6)
 	return b.HoldingValue
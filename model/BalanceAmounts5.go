@@ -19,6 +19,10 @@ type BalanceAmounts5 struct {
 	AccruedInterestAmount *AmountAndDirection14 `xml:"AcrdIntrstAmt,omitempty"`
 }
 
+func (b *BalanceAmounts5) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceAmounts5) AddHoldingValue() *AmountAndDirection14 {
 	b.HoldingValue = new(AmountAndDirection14)
 	return b.HoldingValue
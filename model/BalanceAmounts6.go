@@ -13,6 +13,10 @@ type BalanceAmounts6 struct {
 	UnrealisedGainLoss *AmountAndDirection14 `xml:"UrlsdGnLoss,omitempty"`
 }
 
+func (b *BalanceAmounts6) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceAmounts6) AddHoldingValue() *AmountAndDirection14 {
 	b.HoldingValue = new(AmountAndDirection14)
 	return b.HoldingValue
@@ -16,6 +16,10 @@ type BalanceDetails5 struct {
 	DetailedBalance []*BalanceDetails6 `xml:"DtldBal,omitempty"`
 }
 
+func (b *BalanceDetails5) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceDetails5) AddType() *BalanceType6Choice {
 	b.Type = new(BalanceType6Choice)
 	return b.Type
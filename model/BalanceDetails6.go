@@ -16,6 +16,10 @@ type BalanceDetails6 struct {
 	Amount *AmountAndDirection31 `xml:"Amt"`
 }
 
+func (b *BalanceDetails6) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceDetails6) SetCategory(value string) {
 	b.Category = (*FinancialAssetTypeCategory1Code)(&value)
 }
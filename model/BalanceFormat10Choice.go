@@ -19,6 +19,10 @@ type BalanceFormat10Choice struct {
 	PartWayPeriodUnits *SignedQuantityFormat9 `xml:"PartWayPrdUnits"`
 }
 
+func (b *BalanceFormat10Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceFormat10Choice) AddBalance() *SignedQuantityFormat8 {
 	b.Balance = new(SignedQuantityFormat8)
 	return b.Balance
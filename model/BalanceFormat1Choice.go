@@ -13,6 +13,10 @@ type BalanceFormat1Choice struct {
 	NotEligibleBalance *SignedQuantityFormat2 `xml:"NotElgblBal"`
 }
 
+func (b *BalanceFormat1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceFormat1Choice) AddBalance() *SignedQuantityFormat1 {
 	b.Balance = new(SignedQuantityFormat1)
 	return b.Balance
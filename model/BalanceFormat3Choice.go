@@ -19,6 +19,10 @@ type BalanceFormat3Choice struct {
 	PartWayPeriodUnits *SignedQuantityFormat2 `xml:"PartWayPrdUnits"`
 }
 
+func (b *BalanceFormat3Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceFormat3Choice) AddBalance() *SignedQuantityFormat1 {
 	b.Balance = new(SignedQuantityFormat1)
 	return b.Balance
@@ -13,6 +13,10 @@ type BalanceFormat5Choice struct {
 	NotEligibleBalance *SignedQuantityFormat6 `xml:"NotElgblBal"`
 }
 
+func (b *BalanceFormat5Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceFormat5Choice) AddBalance() *SignedQuantityFormat7 {
 	b.Balance = new(SignedQuantityFormat7)
 	return b.Balance
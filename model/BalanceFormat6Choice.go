@@ -19,6 +19,10 @@ type BalanceFormat6Choice struct {
 	PartWayPeriodUnits *SignedQuantityFormat6 `xml:"PartWayPrdUnits"`
 }
 
+func (b *BalanceFormat6Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceFormat6Choice) AddBalance() *SignedQuantityFormat7 {
 	b.Balance = new(SignedQuantityFormat7)
 	return b.Balance
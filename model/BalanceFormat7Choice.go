@@ -13,6 +13,10 @@ type BalanceFormat7Choice struct {
 	NotEligibleBalance *SignedQuantityFormat9 `xml:"NotElgblBal"`
 }
 
+func (b *BalanceFormat7Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceFormat7Choice) AddBalance() *SignedQuantityFormat8 {
 	b.Balance = new(SignedQuantityFormat8)
 	return b.Balance
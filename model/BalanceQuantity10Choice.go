@@ -10,6 +10,10 @@ type BalanceQuantity10Choice struct {
 	Proprietary *GenericIdentification144 `xml:"Prtry"`
 }
 
+func (b *BalanceQuantity10Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceQuantity10Choice) AddQuantity() *Quantity10Choice {
 	b.Quantity = new(Quantity10Choice)
 	return b.Quantity
@@ -10,6 +10,10 @@ type BalanceQuantity12Choice struct {
 	Proprietary *GenericIdentification144 `xml:"Prtry"`
 }
 
+func (b *BalanceQuantity12Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceQuantity12Choice) AddQuantity() *FinancialInstrumentQuantity15Choice {
 	b.Quantity = new(FinancialInstrumentQuantity15Choice)
 	return b.Quantity
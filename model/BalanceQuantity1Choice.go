@@ -10,6 +10,10 @@ type BalanceQuantity1Choice struct {
 	QuantityAsDSS *GenericIdentification6 `xml:"QtyAsDSS"`
 }
 
+func (b *BalanceQuantity1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceQuantity1Choice) AddQuantity() *FinancialInstrumentQuantityChoice {
 	b.Quantity = new(FinancialInstrumentQuantityChoice)
 	return b.Quantity
@@ -10,6 +10,10 @@ type BalanceQuantity4Choice struct {
 	Proprietary *GenericIdentification22 `xml:"Prtry"`
 }
 
+func (b *BalanceQuantity4Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceQuantity4Choice) AddQuantity() *Quantity6Choice {
 	b.Quantity = new(Quantity6Choice)
 	return b.Quantity
@@ -10,6 +10,10 @@ type BalanceQuantity5Choice struct {
 	Proprietary *GenericIdentification22 `xml:"Prtry"`
 }
 
+func (b *BalanceQuantity5Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceQuantity5Choice) AddQuantity() *FinancialInstrumentQuantity1Choice {
 	b.Quantity = new(FinancialInstrumentQuantity1Choice)
 	return b.Quantity
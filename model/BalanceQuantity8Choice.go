@@ -10,6 +10,10 @@ type BalanceQuantity8Choice struct {
 	Proprietary *GenericIdentification56 `xml:"Prtry"`
 }
 
+func (b *BalanceQuantity8Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceQuantity8Choice) AddQuantity() *FinancialInstrumentQuantity1Choice {
 	b.Quantity = new(FinancialInstrumentQuantity1Choice)
 	return b.Quantity
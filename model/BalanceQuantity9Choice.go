@@ -10,6 +10,10 @@ type BalanceQuantity9Choice struct {
 	Proprietary *GenericIdentification56 `xml:"Prtry"`
 }
 
+func (b *BalanceQuantity9Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceQuantity9Choice) AddQuantity() *Quantity6Choice {
 	b.Quantity = new(Quantity6Choice)
 	return b.Quantity
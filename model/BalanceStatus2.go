@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Numerical representation of the net increases and decreases in an account at a specific point in time. A cash balance is calculated from a sum of cash credits minus a sum of cash debits.
 type BalanceStatus2 struct {
 
@@ -7,6 +11,14 @@ type BalanceStatus2 struct {
 	Balance *ActiveCurrencyAndAmount `xml:"Bal"`
 }
 
+func (b *BalanceStatus2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceStatus2) SetBalance(value, currency string) {
 	b.Balance = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (b *BalanceStatus2) SetBalanceFromDecimal(value decimal.Decimal, currency string) {
+	b.Balance = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the balance subtype.
 type BalanceSubType1Choice struct {
 
@@ -10,6 +12,10 @@ type BalanceSubType1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (b *BalanceSubType1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceSubType1Choice) SetCode(value string) {
 	b.Code = (*ExternalBalanceSubType1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (b *BalanceSubType1Choice) SetCode(value string) {
 func (b *BalanceSubType1Choice) SetProprietary(value string) {
 	b.Proprietary = (*Max35Text)(&value)
 }
+
+func (b *BalanceSubType1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.Code != nil {
+		enc.Element("Cd", string(*b.Code))
+	}
+	if b.Proprietary != nil {
+		enc.Element("Prtry", string(*b.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (b *BalanceSubType1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.Code = (*ExternalBalanceSubType1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Specifies the balance type.
+type BalanceType10Choice struct {
+
+	// Balance type, in a coded form.
+	Code *ExternalBalanceType1Code `xml:"Cd"`
+
+	// Balance type, in a proprietary form.
+	Proprietary *Max35Text `xml:"Prtry"`
+}
+
+func (b *BalanceType10Choice) Validate() error {
+	return ValidateElement(b)
+}
+
+func (b *BalanceType10Choice) SetCode(value string) {
+	b.Code = (*ExternalBalanceType1Code)(&value)
+}
+
+func (b *BalanceType10Choice) SetProprietary(value string) {
+	b.Proprietary = (*Max35Text)(&value)
+}
+
+func (b *BalanceType10Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.Code != nil {
+		enc.Element("Cd", string(*b.Code))
+	}
+	if b.Proprietary != nil {
+		enc.Element("Prtry", string(*b.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (b *BalanceType10Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.Code = (*ExternalBalanceType1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to define the balance type and sub-type.
 type BalanceType12 struct {
 
@@ -10,6 +12,10 @@ type BalanceType12 struct {
 	SubType *BalanceSubType1Choice `xml:"SubTp,omitempty"`
 }
 
+func (b *BalanceType12) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceType12) AddCodeOrProprietary() *BalanceType5Choice {
 	b.CodeOrProprietary = new(BalanceType5Choice)
 	return b.CodeOrProprietary
@@ -19,3 +25,44 @@ func (b *BalanceType12) AddSubType() *BalanceSubType1Choice {
 	b.SubType = new(BalanceSubType1Choice)
 	return b.SubType
 }
+
+func (b *BalanceType12) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.CodeOrProprietary != nil {
+		b.CodeOrProprietary.EncodeXMLFast(enc, "CdOrPrtry")
+	}
+	if b.SubType != nil {
+		b.SubType.EncodeXMLFast(enc, "SubTp")
+	}
+	enc.End(tag)
+}
+
+func (b *BalanceType12) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "CdOrPrtry":
+			elem := new(BalanceType5Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.CodeOrProprietary = elem
+		case "SubTp":
+			elem := new(BalanceSubType1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.SubType = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,3 +1,42 @@
 package model
 
 type BalanceType12Code string
+
+const (
+	BalanceType12CodeXPCD BalanceType12Code = "XPCD"
+	BalanceType12CodeOPAV BalanceType12Code = "OPAV"
+	BalanceType12CodeITAV BalanceType12Code = "ITAV"
+	BalanceType12CodeCLAV BalanceType12Code = "CLAV"
+	BalanceType12CodeFWAV BalanceType12Code = "FWAV"
+	BalanceType12CodeCLBD BalanceType12Code = "CLBD"
+	BalanceType12CodeITBD BalanceType12Code = "ITBD"
+	BalanceType12CodeOPBD BalanceType12Code = "OPBD"
+	BalanceType12CodePRCD BalanceType12Code = "PRCD"
+	BalanceType12CodeINFO BalanceType12Code = "INFO"
+)
+
+var balanceType12CodeDescriptions = map[BalanceType12Code]string{
+	BalanceType12CodeXPCD: "Expected: balance, composed of booked entries and pending items known at the time of calculation, which projects the end of day balance if everything is booked on the account and no other entry is posted.",
+	BalanceType12CodeOPAV: "Opening available: book balance of the account at the beginning of the account reporting period, always equal to the closing available balance of the previous account reporting period.",
+	BalanceType12CodeITAV: "Interim available: available balance calculated in the course of the account servicer's business day, at the time specified, and subject to further changes during the business day.",
+	BalanceType12CodeCLAV: "Closing available: closing balance of amount of money that is at the disposal of the account owner on the date specified.",
+	BalanceType12CodeFWAV: "Forward available: forward available balance of money that is at the disposal of the account owner on the date specified.",
+	BalanceType12CodeCLBD: "Closing booked: balance of the account at the end of the pre-agreed account reporting period.",
+	BalanceType12CodeITBD: "Interim booked: balance calculated in the course of the account servicer's business day, at the time specified, and subject to further changes during the business day.",
+	BalanceType12CodeOPBD: "Opening booked: book balance of the account at the beginning of the account reporting period.",
+	BalanceType12CodePRCD: "Previously closed booked: balance of the account at the previously closed account reporting period.",
+	BalanceType12CodeINFO: "Information: balance for informational purposes.",
+}
+
+func (b BalanceType12Code) IsValid() bool {
+	_, ok := balanceType12CodeDescriptions[b]
+	return ok
+}
+
+func (b BalanceType12Code) Description() string {
+	return balanceType12CodeDescriptions[b]
+}
+
+func (b BalanceType12Code) Validate() error {
+	return validateEnumeration("BalanceType12Code", string(b), b.IsValid(), "XPCD, OPAV, ITAV, CLAV, FWAV, CLBD, ITBD, OPBD, PRCD, INFO")
+}
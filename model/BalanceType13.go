@@ -0,0 +1,68 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Set of elements used to define the balance type and sub-type.
+type BalanceType13 struct {
+
+	// Coded or proprietary format balance type.
+	CodeOrProprietary *BalanceType10Choice `xml:"CdOrPrtry"`
+
+	// Specifies the balance sub-type.
+	SubType *BalanceSubType1Choice `xml:"SubTp,omitempty"`
+}
+
+func (b *BalanceType13) Validate() error {
+	return ValidateElement(b)
+}
+
+func (b *BalanceType13) AddCodeOrProprietary() *BalanceType10Choice {
+	b.CodeOrProprietary = new(BalanceType10Choice)
+	return b.CodeOrProprietary
+}
+
+func (b *BalanceType13) AddSubType() *BalanceSubType1Choice {
+	b.SubType = new(BalanceSubType1Choice)
+	return b.SubType
+}
+
+func (b *BalanceType13) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.CodeOrProprietary != nil {
+		b.CodeOrProprietary.EncodeXMLFast(enc, "CdOrPrtry")
+	}
+	if b.SubType != nil {
+		b.SubType.EncodeXMLFast(enc, "SubTp")
+	}
+	enc.End(tag)
+}
+
+func (b *BalanceType13) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "CdOrPrtry":
+			elem := new(BalanceType10Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.CodeOrProprietary = elem
+		case "SubTp":
+			elem := new(BalanceSubType1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.SubType = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ type BalanceType1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (b *BalanceType1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceType1Choice) SetCode(value string) {
 	b.Code = (*BalanceType8Code)(&value)
 }
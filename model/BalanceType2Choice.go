@@ -10,6 +10,10 @@ type BalanceType2Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (b *BalanceType2Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceType2Choice) SetCode(value string) {
 	b.Code = (*BalanceType9Code)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the balance type.
 type BalanceType5Choice struct {
 
@@ -10,10 +12,59 @@ type BalanceType5Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
-func (b *BalanceType5Choice) SetCode(value string) {
+func (b *BalanceType5Choice) Validate() error {
+	return ValidateElement(b)
+}
+
+func (b *BalanceType5Choice) SetCode(value string) error {
+	if err := BalanceType12Code(value).Validate(); err != nil {
+		return err
+	}
 	b.Code = (*BalanceType12Code)(&value)
+	return nil
 }
 
 func (b *BalanceType5Choice) SetProprietary(value string) {
 	b.Proprietary = (*Max35Text)(&value)
 }
+
+func (b *BalanceType5Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.Code != nil {
+		enc.Element("Cd", string(*b.Code))
+	}
+	if b.Proprietary != nil {
+		enc.Element("Prtry", string(*b.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (b *BalanceType5Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.Code = (*BalanceType12Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
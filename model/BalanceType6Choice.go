@@ -10,6 +10,10 @@ type BalanceType6Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (b *BalanceType6Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceType6Choice) SetCode(value string) {
 	b.Code = (*BalanceType13Code)(&value)
 }
@@ -13,6 +13,10 @@ type BalanceType7Choice struct {
 	Account *AccountIdentification5 `xml:"Acct"`
 }
 
+func (b *BalanceType7Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BalanceType7Choice) SetCode(value string) {
 	b.Code = (*FinancialAssetBalanceType1Code)(&value)
 }
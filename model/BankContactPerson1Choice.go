@@ -10,6 +10,10 @@ type BankContactPerson1Choice struct {
 	SellerBankContactPerson []*ContactIdentification1 `xml:"SellrBkCtctPrsn"`
 }
 
+func (b *BankContactPerson1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BankContactPerson1Choice) AddBuyerBankContactPerson() *ContactIdentification1 {
 	newValue := new(ContactIdentification1)
 	b.BuyerBankContactPerson = append(b.BuyerBankContactPerson, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Details of the instructions from the bank.
 type BankInstructions1 struct {
 
@@ -10,6 +14,10 @@ type BankInstructions1 struct {
 	LastDateForResponse *ISODate `xml:"LastDtForRspn,omitempty"`
 }
 
+func (b *BankInstructions1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BankInstructions1) AddText(value string) {
 	b.Text = append(b.Text, (*Max2000Text)(&value))
 }
@@ -17,3 +25,8 @@ func (b *BankInstructions1) AddText(value string) {
 func (b *BankInstructions1) SetLastDateForResponse(value string) {
 	b.LastDateForResponse = (*ISODate)(&value)
 }
+
+func (b *BankInstructions1) SetLastDateForResponseFromTime(value time.Time) {
+	b.LastDateForResponse = new(ISODate)
+	b.LastDateForResponse.FromTime(value)
+}
@@ -12,6 +12,10 @@ type BankTransactionCodeStructure1 struct {
 	Proprietary *ProprietaryBankTransactionCodeStructure1 `xml:"Prtry,omitempty"`
 }
 
+func (b *BankTransactionCodeStructure1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BankTransactionCodeStructure1) AddDomain() *BankTransactionCodeStructure2 {
 	b.Domain = new(BankTransactionCodeStructure2)
 	return b.Domain
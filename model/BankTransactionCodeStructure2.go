@@ -10,6 +10,10 @@ type BankTransactionCodeStructure2 struct {
 	Family *BankTransactionCodeStructure3 `xml:"Fmly"`
 }
 
+func (b *BankTransactionCodeStructure2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BankTransactionCodeStructure2) SetCode(value string) {
 	b.Code = (*ExternalBankTransactionDomainCode)(&value)
 }
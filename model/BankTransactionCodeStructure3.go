@@ -10,6 +10,10 @@ type BankTransactionCodeStructure3 struct {
 	SubFamilyCode *ExternalBankTransactionSubFamilyCode `xml:"SubFmlyCd"`
 }
 
+func (b *BankTransactionCodeStructure3) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BankTransactionCodeStructure3) SetCode(value string) {
 	b.Code = (*ExternalBankTransactionFamilyCode)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to identify the type or operations code of a transaction entry.
 type BankTransactionCodeStructure4 struct {
 
@@ -12,6 +14,10 @@ type BankTransactionCodeStructure4 struct {
 	Proprietary *ProprietaryBankTransactionCodeStructure1 `xml:"Prtry,omitempty"`
 }
 
+func (b *BankTransactionCodeStructure4) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BankTransactionCodeStructure4) AddDomain() *BankTransactionCodeStructure5 {
 	b.Domain = new(BankTransactionCodeStructure5)
 	return b.Domain
@@ -21,3 +27,44 @@ func (b *BankTransactionCodeStructure4) AddProprietary() *ProprietaryBankTransac
 	b.Proprietary = new(ProprietaryBankTransactionCodeStructure1)
 	return b.Proprietary
 }
+
+func (b *BankTransactionCodeStructure4) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.Domain != nil {
+		b.Domain.EncodeXMLFast(enc, "Domn")
+	}
+	if b.Proprietary != nil {
+		b.Proprietary.EncodeXMLFast(enc, "Prtry")
+	}
+	enc.End(tag)
+}
+
+func (b *BankTransactionCodeStructure4) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Domn":
+			elem := new(BankTransactionCodeStructure5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.Domain = elem
+		case "Prtry":
+			elem := new(ProprietaryBankTransactionCodeStructure1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.Proprietary = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to identify the type or operations code of a transaction entry.
 type BankTransactionCodeStructure5 struct {
 
@@ -10,6 +12,10 @@ type BankTransactionCodeStructure5 struct {
 	Family *BankTransactionCodeStructure6 `xml:"Fmly"`
 }
 
+func (b *BankTransactionCodeStructure5) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BankTransactionCodeStructure5) SetCode(value string) {
 	b.Code = (*ExternalBankTransactionDomain1Code)(&value)
 }
@@ -18,3 +24,44 @@ func (b *BankTransactionCodeStructure5) AddFamily() *BankTransactionCodeStructur
 	b.Family = new(BankTransactionCodeStructure6)
 	return b.Family
 }
+
+func (b *BankTransactionCodeStructure5) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.Code != nil {
+		enc.Element("Cd", string(*b.Code))
+	}
+	if b.Family != nil {
+		b.Family.EncodeXMLFast(enc, "Fmly")
+	}
+	enc.End(tag)
+}
+
+func (b *BankTransactionCodeStructure5) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.Code = (*ExternalBankTransactionDomain1Code)(&value)
+		case "Fmly":
+			elem := new(BankTransactionCodeStructure6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.Family = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to identify the type or operations code of a transaction entry.
 type BankTransactionCodeStructure6 struct {
 
@@ -10,6 +12,10 @@ type BankTransactionCodeStructure6 struct {
 	SubFamilyCode *ExternalBankTransactionSubFamily1Code `xml:"SubFmlyCd"`
 }
 
+func (b *BankTransactionCodeStructure6) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BankTransactionCodeStructure6) SetCode(value string) {
 	b.Code = (*ExternalBankTransactionFamily1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (b *BankTransactionCodeStructure6) SetCode(value string) {
 func (b *BankTransactionCodeStructure6) SetSubFamilyCode(value string) {
 	b.SubFamilyCode = (*ExternalBankTransactionSubFamily1Code)(&value)
 }
+
+func (b *BankTransactionCodeStructure6) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.Code != nil {
+		enc.Element("Cd", string(*b.Code))
+	}
+	if b.SubFamilyCode != nil {
+		enc.Element("SubFmlyCd", string(*b.SubFamilyCode))
+	}
+	enc.End(tag)
+}
+
+func (b *BankTransactionCodeStructure6) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.Code = (*ExternalBankTransactionFamily1Code)(&value)
+		case "SubFmlyCd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.SubFamilyCode = (*ExternalBankTransactionSubFamily1Code)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,3 +1,19 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 type BaseOne14Rate string
+
+func (b BaseOne14Rate) Validate() error {
+	return validateDecimal("BaseOne14Rate", string(b), 15, 14)
+}
+
+func (b BaseOne14Rate) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(string(b))
+}
+
+func (b *BaseOne14Rate) FromDecimal(value decimal.Decimal) {
+	*b = BaseOne14Rate(value.String())
+}
@@ -1,3 +1,19 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 type BaseOneRate string
+
+func (b BaseOneRate) Validate() error {
+	return validateDecimal("BaseOneRate", string(b), 11, 10)
+}
+
+func (b BaseOneRate) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(string(b))
+}
+
+func (b *BaseOneRate) FromDecimal(value decimal.Decimal) {
+	*b = BaseOneRate(value.String())
+}
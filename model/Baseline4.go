@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the commercial details of the underlying transaction.
 type Baseline4 struct {
 
@@ -73,6 +77,10 @@ type Baseline4 struct {
 	IntentToPayExpected *YesNoIndicator `xml:"InttToPayXpctd"`
 }
 
+func (b *Baseline4) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *Baseline4) AddSubmitterBaselineIdentification() *DocumentIdentification1 {
 	b.SubmitterBaselineIdentification = new(DocumentIdentification1)
 	return b.SubmitterBaselineIdentification
@@ -720,6 +728,11 @@ func (b *Baseline4) SetLatestMatchDate(value string) {
 	b.LatestMatchDate = (*ISODate)(&value)
 }
 
+func (b *Baseline4) SetLatestMatchDateFromTime(value time.Time) {
+	b.LatestMatchDate = new(ISODate)
+	b.LatestMatchDate.FromTime(value)
+}
+
 func (b *Baseline4) AddCommercialDataSetRequired() *RequiredSubmission2 {
 	b.CommercialDataSetRequired = new(RequiredSubmission2)
 	return b.CommercialDataSetRequired
@@ -1030,3 +1043,8 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (b *Baseline4) SetIntentToPayExpected(value string) {
 	b.IntentToPayExpected = (*YesNoIndicator)(&value)
 }
+
+func (b *Baseline4) SetIntentToPayExpectedFromBool(value bool) {
+	b.IntentToPayExpected = new(YesNoIndicator)
+	b.IntentToPayExpected.FromBool(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Basic valuation details of a collateral position.
 type BasicCollateralValuation1Details struct {
 
@@ -10,10 +14,19 @@ type BasicCollateralValuation1Details struct {
 	HaircutSource *PartyIdentification15 `xml:"HrcutSrc,omitempty"`
 }
 
+func (b *BasicCollateralValuation1Details) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BasicCollateralValuation1Details) SetValuationHaircut(value string) {
 	b.ValuationHaircut = (*PercentageRate)(&value)
 }
 
+func (b *BasicCollateralValuation1Details) SetValuationHaircutFromDecimal(value decimal.Decimal) {
+	b.ValuationHaircut = new(PercentageRate)
+	b.ValuationHaircut.FromDecimal(value)
+}
+
 func (b *BasicCollateralValuation1Details) AddHaircutSource() *PartyIdentification15 {
 	b.HaircutSource = new(PartyIdentification15)
 	return b.HaircutSource
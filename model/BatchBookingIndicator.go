@@ -1,3 +1,15 @@
 package model
 
 type BatchBookingIndicator string
+
+func (b BatchBookingIndicator) Validate() error {
+	return validateBoolean("BatchBookingIndicator", string(b))
+}
+
+func (b BatchBookingIndicator) Bool() bool {
+	return parseBoolean(string(b))
+}
+
+func (b *BatchBookingIndicator) FromBool(value bool) {
+	*b = BatchBookingIndicator(formatBoolean(value))
+}
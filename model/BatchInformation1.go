@@ -13,6 +13,10 @@ type BatchInformation1 struct {
 	NumberOfTransactions *Max15NumericText `xml:"NbOfTxs,omitempty"`
 }
 
+func (b *BatchInformation1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BatchInformation1) SetMessageIdentification(value string) {
 	b.MessageIdentification = (*Max35Text)(&value)
 }
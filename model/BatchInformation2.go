@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of elements used to identify the underlying batches.
 type BatchInformation2 struct {
 
@@ -19,6 +24,10 @@ type BatchInformation2 struct {
 	CreditDebitIndicator *CreditDebitCode `xml:"CdtDbtInd,omitempty"`
 }
 
+func (b *BatchInformation2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BatchInformation2) SetMessageIdentification(value string) {
 	b.MessageIdentification = (*Max35Text)(&value)
 }
@@ -35,6 +44,82 @@ func (b *BatchInformation2) SetTotalAmount(value, currency string) {
 	b.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (b *BatchInformation2) SetCreditDebitIndicator(value string) {
+func (b *BatchInformation2) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	b.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (b *BatchInformation2) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	b.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
+}
+
+func (b *BatchInformation2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.MessageIdentification != nil {
+		enc.Element("MsgId", string(*b.MessageIdentification))
+	}
+	if b.PaymentInformationIdentification != nil {
+		enc.Element("PmtInfId", string(*b.PaymentInformationIdentification))
+	}
+	if b.NumberOfTransactions != nil {
+		enc.Element("NbOfTxs", string(*b.NumberOfTransactions))
+	}
+	if b.TotalAmount != nil {
+		b.TotalAmount.EncodeXMLFast(enc, "TtlAmt")
+	}
+	if b.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*b.CreditDebitIndicator))
+	}
+	enc.End(tag)
+}
+
+func (b *BatchInformation2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "MsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.MessageIdentification = (*Max35Text)(&value)
+		case "PmtInfId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.PaymentInformationIdentification = (*Max35Text)(&value)
+		case "NbOfTxs":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.NumberOfTransactions = (*Max15NumericText)(&value)
+		case "TtlAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.TotalAmount = elem
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
 }
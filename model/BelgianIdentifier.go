@@ -1,3 +1,7 @@
 package model
 
 type BelgianIdentifier string
+
+func (b BelgianIdentifier) Validate() error {
+	return validateLength("BelgianIdentifier", string(b), 1, 35)
+}
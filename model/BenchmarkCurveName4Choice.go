@@ -13,6 +13,10 @@ type BenchmarkCurveName4Choice struct {
 	Name *Max25Text `xml:"Nm"`
 }
 
+func (b *BenchmarkCurveName4Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BenchmarkCurveName4Choice) SetISIN(value string) {
 	b.ISIN = (*ISINOct2015Identifier)(&value)
 }
@@ -36,6 +36,10 @@ type BeneficialOwner1 struct {
 	ElectedSecuritiesQuantity *UnitOrFaceAmount1Choice `xml:"ElctdSctiesQty"`
 }
 
+func (b *BeneficialOwner1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BeneficialOwner1) AddBeneficialOwnerIdentification() *PartyIdentification2Choice {
 	b.BeneficialOwnerIdentification = new(PartyIdentification2Choice)
 	return b.BeneficialOwnerIdentification
@@ -62,6 +66,11 @@ func (b *BeneficialOwner1) SetCertificationIndicator(value string) {
 	b.CertificationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (b *BeneficialOwner1) SetCertificationIndicatorFromBool(value bool) {
+	b.CertificationIndicator = new(YesNoIndicator)
+	b.CertificationIndicator.FromBool(value)
+}
+
 func (b *BeneficialOwner1) AddCertificationType() *BeneficiaryCertificationType1FormatChoice {
 	b.CertificationType = new(BeneficiaryCertificationType1FormatChoice)
 	return b.CertificationType
@@ -28,6 +28,10 @@ type BeneficialOwner2 struct {
 	DeclarationDetails *Max350Text `xml:"DclrtnDtls,omitempty"`
 }
 
+func (b *BeneficialOwner2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BeneficialOwner2) AddBeneficialOwnerIdentification() *PartyIdentification100 {
 	b.BeneficialOwnerIdentification = new(PartyIdentification100)
 	return b.BeneficialOwnerIdentification
@@ -54,6 +58,11 @@ func (b *BeneficialOwner2) SetCertificationIndicator(value string) {
 	b.CertificationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (b *BeneficialOwner2) SetCertificationIndicatorFromBool(value bool) {
+	b.CertificationIndicator = new(YesNoIndicator)
+	b.CertificationIndicator.FromBool(value)
+}
+
 func (b *BeneficialOwner2) AddCertificationType() *BeneficiaryCertificationType9Choice {
 	b.CertificationType = new(BeneficiaryCertificationType9Choice)
 	return b.CertificationType
@@ -10,10 +10,19 @@ type BeneficialOwnership1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (b *BeneficialOwnership1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BeneficialOwnership1Choice) SetIndicator(value string) {
 	b.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (b *BeneficialOwnership1Choice) SetIndicatorFromBool(value bool) {
+	b.Indicator = new(YesNoIndicator)
+	b.Indicator.FromBool(value)
+}
+
 func (b *BeneficialOwnership1Choice) AddProprietary() *GenericIdentification20 {
 	b.Proprietary = new(GenericIdentification20)
 	return b.Proprietary
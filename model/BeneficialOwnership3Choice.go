@@ -10,10 +10,19 @@ type BeneficialOwnership3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (b *BeneficialOwnership3Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BeneficialOwnership3Choice) SetIndicator(value string) {
 	b.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (b *BeneficialOwnership3Choice) SetIndicatorFromBool(value bool) {
+	b.Indicator = new(YesNoIndicator)
+	b.Indicator.FromBool(value)
+}
+
 func (b *BeneficialOwnership3Choice) AddProprietary() *GenericIdentification38 {
 	b.Proprietary = new(GenericIdentification38)
 	return b.Proprietary
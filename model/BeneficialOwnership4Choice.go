@@ -10,10 +10,19 @@ type BeneficialOwnership4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (b *BeneficialOwnership4Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BeneficialOwnership4Choice) SetIndicator(value string) {
 	b.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (b *BeneficialOwnership4Choice) SetIndicatorFromBool(value bool) {
+	b.Indicator = new(YesNoIndicator)
+	b.Indicator.FromBool(value)
+}
+
 func (b *BeneficialOwnership4Choice) AddProprietary() *GenericIdentification30 {
 	b.Proprietary = new(GenericIdentification30)
 	return b.Proprietary
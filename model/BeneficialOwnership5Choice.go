@@ -10,10 +10,19 @@ type BeneficialOwnership5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (b *BeneficialOwnership5Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BeneficialOwnership5Choice) SetIndicator(value string) {
 	b.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (b *BeneficialOwnership5Choice) SetIndicatorFromBool(value bool) {
+	b.Indicator = new(YesNoIndicator)
+	b.Indicator.FromBool(value)
+}
+
 func (b *BeneficialOwnership5Choice) AddProprietary() *GenericIdentification47 {
 	b.Proprietary = new(GenericIdentification47)
 	return b.Proprietary
@@ -10,6 +10,10 @@ type Beneficiary1 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (b *Beneficiary1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *Beneficiary1) AddNewAddressOrNewBeneficiary() *AddressOrParty1Choice {
 	b.NewAddressOrNewBeneficiary = new(AddressOrParty1Choice)
 	return b.NewAddressOrNewBeneficiary
@@ -10,6 +10,10 @@ type BeneficiaryCertificationType10Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (b *BeneficiaryCertificationType10Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BeneficiaryCertificationType10Choice) SetCode(value string) {
 	b.Code = (*BeneficiaryCertificationType5Code)(&value)
 }
@@ -10,6 +10,10 @@ type BeneficiaryCertificationType11Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (b *BeneficiaryCertificationType11Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BeneficiaryCertificationType11Choice) SetCode(value string) {
 	b.Code = (*BeneficiaryCertificationType5Code)(&value)
 }
@@ -10,6 +10,10 @@ type BeneficiaryCertificationType12Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (b *BeneficiaryCertificationType12Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BeneficiaryCertificationType12Choice) SetCode(value string) {
 	b.Code = (*BeneficiaryCertificationType4Code)(&value)
 }
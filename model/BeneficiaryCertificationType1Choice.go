@@ -10,6 +10,10 @@ type BeneficiaryCertificationType1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (b *BeneficiaryCertificationType1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BeneficiaryCertificationType1Choice) SetCode(value string) {
 	b.Code = (*BeneficiaryCertificationType2Code)(&value)
 }
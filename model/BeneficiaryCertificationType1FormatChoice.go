@@ -10,6 +10,10 @@ type BeneficiaryCertificationType1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (b *BeneficiaryCertificationType1FormatChoice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BeneficiaryCertificationType1FormatChoice) SetCode(value string) {
 	b.Code = (*BeneficiaryCertificationType1Code)(&value)
 }
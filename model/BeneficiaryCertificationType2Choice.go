@@ -10,6 +10,10 @@ type BeneficiaryCertificationType2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (b *BeneficiaryCertificationType2Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BeneficiaryCertificationType2Choice) SetCode(value string) {
 	b.Code = (*BeneficiaryCertificationType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type BeneficiaryCertificationType5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (b *BeneficiaryCertificationType5Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BeneficiaryCertificationType5Choice) SetCode(value string) {
 	b.Code = (*BeneficiaryCertificationType4Code)(&value)
 }
@@ -10,6 +10,10 @@ type BeneficiaryCertificationType6Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (b *BeneficiaryCertificationType6Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BeneficiaryCertificationType6Choice) SetCode(value string) {
 	b.Code = (*BeneficiaryCertificationType5Code)(&value)
 }
@@ -10,6 +10,10 @@ type BeneficiaryCertificationType9Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (b *BeneficiaryCertificationType9Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BeneficiaryCertificationType9Choice) SetCode(value string) {
 	b.Code = (*BeneficiaryCertificationType4Code)(&value)
 }
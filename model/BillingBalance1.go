@@ -13,6 +13,10 @@ type BillingBalance1 struct {
 	CurrencyType *BillingCurrencyType1Code `xml:"CcyTp,omitempty"`
 }
 
+func (b *BillingBalance1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingBalance1) AddType() *BillingBalanceType1Choice {
 	b.Type = new(BillingBalanceType1Choice)
 	return b.Type
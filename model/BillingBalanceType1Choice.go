@@ -10,6 +10,10 @@ type BillingBalanceType1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (b *BillingBalanceType1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingBalanceType1Choice) SetCode(value string) {
 	b.Code = (*ExternalBillingBalanceType1Code)(&value)
 }
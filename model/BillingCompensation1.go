@@ -13,6 +13,10 @@ type BillingCompensation1 struct {
 	CurrencyType *BillingCurrencyType2Code `xml:"CcyTp,omitempty"`
 }
 
+func (b *BillingCompensation1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingCompensation1) AddType() *BillingCompensationType1Choice {
 	b.Type = new(BillingCompensationType1Choice)
 	return b.Type
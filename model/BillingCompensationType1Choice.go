@@ -10,6 +10,10 @@ type BillingCompensationType1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (b *BillingCompensationType1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingCompensationType1Choice) SetCode(value string) {
 	b.Code = (*ExternalBillingCompensationType1Code)(&value)
 }
@@ -18,6 +18,10 @@ type BillingMethod1 struct {
 	TaxIdentification []*BillingServicesTax1 `xml:"TaxId"`
 }
 
+func (b *BillingMethod1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingMethod1) AddServiceChargeHostAmount() *AmountAndDirection34 {
 	b.ServiceChargeHostAmount = new(AmountAndDirection34)
 	return b.ServiceChargeHostAmount
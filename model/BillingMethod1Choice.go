@@ -13,6 +13,10 @@ type BillingMethod1Choice struct {
 	MethodD *BillingMethod3 `xml:"MtdD"`
 }
 
+func (b *BillingMethod1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingMethod1Choice) AddMethodA() *BillingMethod1 {
 	b.MethodA = new(BillingMethod1)
 	return b.MethodA
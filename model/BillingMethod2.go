@@ -15,6 +15,10 @@ type BillingMethod2 struct {
 	TaxIdentification []*BillingServicesTax1 `xml:"TaxId"`
 }
 
+func (b *BillingMethod2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingMethod2) AddServiceChargeHostAmount() *AmountAndDirection34 {
 	b.ServiceChargeHostAmount = new(AmountAndDirection34)
 	return b.ServiceChargeHostAmount
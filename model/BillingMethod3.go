@@ -12,6 +12,10 @@ type BillingMethod3 struct {
 	TaxIdentification []*BillingServicesTax2 `xml:"TaxId"`
 }
 
+func (b *BillingMethod3) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingMethod3) AddServiceTaxPriceAmount() *AmountAndDirection34 {
 	b.ServiceTaxPriceAmount = new(AmountAndDirection34)
 	return b.ServiceTaxPriceAmount
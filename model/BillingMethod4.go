@@ -12,6 +12,10 @@ type BillingMethod4 struct {
 	TaxCalculation *TaxCalculation1 `xml:"TaxClctn"`
 }
 
+func (b *BillingMethod4) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingMethod4) AddServiceDetail() *BillingServiceParameters2 {
 	newValue := new(BillingServiceParameters2)
 	b.ServiceDetail = append(b.ServiceDetail, newValue)
@@ -18,6 +18,10 @@ type BillingPrice1 struct {
 	Rule *Max20Text `xml:"Rule,omitempty"`
 }
 
+func (b *BillingPrice1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingPrice1) SetCurrency(value string) {
 	b.Currency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the billing rate.
 type BillingRate1 struct {
 
@@ -20,6 +24,10 @@ type BillingRate1 struct {
 	DaysInYear *Number `xml:"DaysInYr,omitempty"`
 }
 
+func (b *BillingRate1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingRate1) AddIdentification() *BillingRateIdentification1Choice {
 	b.Identification = new(BillingRateIdentification1Choice)
 	return b.Identification
@@ -29,10 +37,25 @@ func (b *BillingRate1) SetValue(value string) {
 	b.Value = (*PercentageRate)(&value)
 }
 
+func (b *BillingRate1) SetValueFromDecimal(value decimal.Decimal) {
+	b.Value = new(PercentageRate)
+	b.Value.FromDecimal(value)
+}
+
 func (b *BillingRate1) SetDaysInPeriod(value string) {
 	b.DaysInPeriod = (*Number)(&value)
 }
 
+func (b *BillingRate1) SetDaysInPeriodFromDecimal(value decimal.Decimal) {
+	b.DaysInPeriod = new(Number)
+	b.DaysInPeriod.FromDecimal(value)
+}
+
 func (b *BillingRate1) SetDaysInYear(value string) {
 	b.DaysInYear = (*Number)(&value)
 }
+
+func (b *BillingRate1) SetDaysInYearFromDecimal(value decimal.Decimal) {
+	b.DaysInYear = new(Number)
+	b.DaysInYear.FromDecimal(value)
+}
@@ -10,6 +10,10 @@ type BillingRateIdentification1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (b *BillingRateIdentification1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingRateIdentification1Choice) SetCode(value string) {
 	b.Code = (*ExternalBillingRateIdentification1Code)(&value)
 }
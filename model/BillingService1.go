@@ -28,6 +28,10 @@ type BillingService1 struct {
 	TaxCalculation *BillingMethod1Choice `xml:"TaxClctn,omitempty"`
 }
 
+func (b *BillingService1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingService1) AddServiceDetail() *BillingServiceParameters1 {
 	b.ServiceDetail = new(BillingServiceParameters1)
 	return b.ServiceDetail
@@ -28,6 +28,10 @@ type BillingService2 struct {
 	TaxCalculation *BillingMethod1Choice `xml:"TaxClctn,omitempty"`
 }
 
+func (b *BillingService2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingService2) AddServiceDetail() *BillingServiceParameters3 {
 	b.ServiceDetail = new(BillingServiceParameters3)
 	return b.ServiceDetail
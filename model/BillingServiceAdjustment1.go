@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the billing adjustments for a specific service.
 type BillingServiceAdjustment1 struct {
 
@@ -51,6 +57,10 @@ type BillingServiceAdjustment1 struct {
 	NewChargeAmount *AmountAndDirection34 `xml:"NewChrgAmt,omitempty"`
 }
 
+func (b *BillingServiceAdjustment1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingServiceAdjustment1) SetType(value string) {
 	b.Type = (*ServiceAdjustmentType1Code)(&value)
 }
@@ -73,6 +83,11 @@ func (b *BillingServiceAdjustment1) SetErrorDate(value string) {
 	b.ErrorDate = (*ISODate)(&value)
 }
 
+func (b *BillingServiceAdjustment1) SetErrorDateFromTime(value time.Time) {
+	b.ErrorDate = new(ISODate)
+	b.ErrorDate.FromTime(value)
+}
+
 func (b *BillingServiceAdjustment1) SetAdjustmentIdentification(value string) {
 	b.AdjustmentIdentification = (*Max35Text)(&value)
 }
@@ -101,14 +116,29 @@ func (b *BillingServiceAdjustment1) SetVolumeChange(value string) {
 	b.VolumeChange = (*DecimalNumber)(&value)
 }
 
+func (b *BillingServiceAdjustment1) SetVolumeChangeFromDecimal(value decimal.Decimal) {
+	b.VolumeChange = new(DecimalNumber)
+	b.VolumeChange.FromDecimal(value)
+}
+
 func (b *BillingServiceAdjustment1) SetOriginalVolume(value string) {
 	b.OriginalVolume = (*DecimalNumber)(&value)
 }
 
+func (b *BillingServiceAdjustment1) SetOriginalVolumeFromDecimal(value decimal.Decimal) {
+	b.OriginalVolume = new(DecimalNumber)
+	b.OriginalVolume.FromDecimal(value)
+}
+
 func (b *BillingServiceAdjustment1) SetNewVolume(value string) {
 	b.NewVolume = (*DecimalNumber)(&value)
 }
 
+func (b *BillingServiceAdjustment1) SetNewVolumeFromDecimal(value decimal.Decimal) {
+	b.NewVolume = new(DecimalNumber)
+	b.NewVolume.FromDecimal(value)
+}
+
 func (b *BillingServiceAdjustment1) AddOriginalChargeAmount() *AmountAndDirection34 {
 	b.OriginalChargeAmount = new(AmountAndDirection34)
 	return b.OriginalChargeAmount
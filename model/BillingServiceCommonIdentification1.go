@@ -10,6 +10,10 @@ type BillingServiceCommonIdentification1 struct {
 	Identification *Max8Text `xml:"Id"`
 }
 
+func (b *BillingServiceCommonIdentification1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingServiceCommonIdentification1) SetIssuer(value string) {
 	b.Issuer = (*Max6Text)(&value)
 }
@@ -20,6 +20,10 @@ type BillingServiceIdentification1 struct {
 	ServiceType *Max12Text `xml:"SvcTp,omitempty"`
 }
 
+func (b *BillingServiceIdentification1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingServiceIdentification1) SetIdentification(value string) {
 	b.Identification = (*Max35Text)(&value)
 }
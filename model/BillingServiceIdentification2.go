@@ -14,6 +14,10 @@ type BillingServiceIdentification2 struct {
 	Description *Max70Text `xml:"Desc"`
 }
 
+func (b *BillingServiceIdentification2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingServiceIdentification2) SetIdentification(value string) {
 	b.Identification = (*Max35Text)(&value)
 }
@@ -23,6 +23,10 @@ type BillingServiceIdentification3 struct {
 	ServiceType *Max12Text `xml:"SvcTp,omitempty"`
 }
 
+func (b *BillingServiceIdentification3) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingServiceIdentification3) SetIdentification(value string) {
 	b.Identification = (*Max35Text)(&value)
 }
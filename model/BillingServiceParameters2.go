@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the detailed parameters a service to be billed.
 type BillingServiceParameters2 struct {
 
@@ -16,6 +20,10 @@ type BillingServiceParameters2 struct {
 	ServiceChargeAmount *AmountAndDirection34 `xml:"SvcChrgAmt"`
 }
 
+func (b *BillingServiceParameters2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingServiceParameters2) AddBankService() *BillingServiceIdentification2 {
 	b.BankService = new(BillingServiceIdentification2)
 	return b.BankService
@@ -25,6 +33,11 @@ func (b *BillingServiceParameters2) SetVolume(value string) {
 	b.Volume = (*DecimalNumber)(&value)
 }
 
+func (b *BillingServiceParameters2) SetVolumeFromDecimal(value decimal.Decimal) {
+	b.Volume = new(DecimalNumber)
+	b.Volume.FromDecimal(value)
+}
+
 func (b *BillingServiceParameters2) AddUnitPrice() *AmountAndDirection34 {
 	b.UnitPrice = new(AmountAndDirection34)
 	return b.UnitPrice
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the detailed parameters a service to be billed.
 type BillingServiceParameters3 struct {
 
@@ -10,6 +14,10 @@ type BillingServiceParameters3 struct {
 	Volume *DecimalNumber `xml:"Vol,omitempty"`
 }
 
+func (b *BillingServiceParameters3) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingServiceParameters3) AddBankService() *BillingServiceIdentification3 {
 	b.BankService = new(BillingServiceIdentification3)
 	return b.BankService
@@ -18,3 +26,8 @@ func (b *BillingServiceParameters3) AddBankService() *BillingServiceIdentificati
 func (b *BillingServiceParameters3) SetVolume(value string) {
 	b.Volume = (*DecimalNumber)(&value)
 }
+
+func (b *BillingServiceParameters3) SetVolumeFromDecimal(value decimal.Decimal) {
+	b.Volume = new(DecimalNumber)
+	b.Volume.FromDecimal(value)
+}
@@ -11,6 +11,10 @@ type BillingServicesAmount1 struct {
 	PricingAmount *AmountAndDirection34 `xml:"PricgAmt,omitempty"`
 }
 
+func (b *BillingServicesAmount1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingServicesAmount1) AddHostAmount() *AmountAndDirection34 {
 	b.HostAmount = new(AmountAndDirection34)
 	return b.HostAmount
@@ -13,6 +13,10 @@ type BillingServicesAmount2 struct {
 	PricingAmount *AmountAndDirection34 `xml:"PricgAmt,omitempty"`
 }
 
+func (b *BillingServicesAmount2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingServicesAmount2) AddHostAmount() *AmountAndDirection34 {
 	b.HostAmount = new(AmountAndDirection34)
 	return b.HostAmount
@@ -10,6 +10,10 @@ type BillingServicesAmount3 struct {
 	HostAmount *AmountAndDirection34 `xml:"HstAmt"`
 }
 
+func (b *BillingServicesAmount3) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingServicesAmount3) AddSourceAmount() *AmountAndDirection34 {
 	b.SourceAmount = new(AmountAndDirection34)
 	return b.SourceAmount
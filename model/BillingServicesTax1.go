@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides for regional taxes on the service.
 type BillingServicesTax1 struct {
 
@@ -19,6 +23,10 @@ type BillingServicesTax1 struct {
 	PricingAmount *AmountAndDirection34 `xml:"PricgAmt,omitempty"`
 }
 
+func (b *BillingServicesTax1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingServicesTax1) SetNumber(value string) {
 	b.Number = (*Max35Text)(&value)
 }
@@ -31,6 +39,11 @@ func (b *BillingServicesTax1) SetRate(value string) {
 	b.Rate = (*DecimalNumber)(&value)
 }
 
+func (b *BillingServicesTax1) SetRateFromDecimal(value decimal.Decimal) {
+	b.Rate = new(DecimalNumber)
+	b.Rate.FromDecimal(value)
+}
+
 func (b *BillingServicesTax1) AddHostAmount() *AmountAndDirection34 {
 	b.HostAmount = new(AmountAndDirection34)
 	return b.HostAmount
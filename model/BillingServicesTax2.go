@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides for regional taxes on the service.
 type BillingServicesTax2 struct {
 
@@ -16,6 +20,10 @@ type BillingServicesTax2 struct {
 	PricingAmount *AmountAndDirection34 `xml:"PricgAmt"`
 }
 
+func (b *BillingServicesTax2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingServicesTax2) SetNumber(value string) {
 	b.Number = (*Max35Text)(&value)
 }
@@ -28,6 +36,11 @@ func (b *BillingServicesTax2) SetRate(value string) {
 	b.Rate = (*DecimalNumber)(&value)
 }
 
+func (b *BillingServicesTax2) SetRateFromDecimal(value decimal.Decimal) {
+	b.Rate = new(DecimalNumber)
+	b.Rate.FromDecimal(value)
+}
+
 func (b *BillingServicesTax2) AddPricingAmount() *AmountAndDirection34 {
 	b.PricingAmount = new(AmountAndDirection34)
 	return b.PricingAmount
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides for regional taxes on the service.
 type BillingServicesTax3 struct {
 
@@ -16,6 +20,10 @@ type BillingServicesTax3 struct {
 	TotalTaxAmount *AmountAndDirection34 `xml:"TtlTaxAmt"`
 }
 
+func (b *BillingServicesTax3) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingServicesTax3) SetNumber(value string) {
 	b.Number = (*Max35Text)(&value)
 }
@@ -28,6 +36,11 @@ func (b *BillingServicesTax3) SetRate(value string) {
 	b.Rate = (*DecimalNumber)(&value)
 }
 
+func (b *BillingServicesTax3) SetRateFromDecimal(value decimal.Decimal) {
+	b.Rate = new(DecimalNumber)
+	b.Rate.FromDecimal(value)
+}
+
 func (b *BillingServicesTax3) AddTotalTaxAmount() *AmountAndDirection34 {
 	b.TotalTaxAmount = new(AmountAndDirection34)
 	return b.TotalTaxAmount
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Details of the statement reporting the bank services billing.
 type BillingStatement2 struct {
 
@@ -43,6 +47,10 @@ type BillingStatement2 struct {
 	ServiceAdjustment []*BillingServiceAdjustment1 `xml:"SvcAdjstmnt,omitempty"`
 }
 
+func (b *BillingStatement2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingStatement2) SetStatementIdentification(value string) {
 	b.StatementIdentification = (*Max35Text)(&value)
 }
@@ -56,6 +64,11 @@ func (b *BillingStatement2) SetCreationDateTime(value string) {
 	b.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (b *BillingStatement2) SetCreationDateTimeFromTime(value time.Time) {
+	b.CreationDateTime = new(ISODateTime)
+	b.CreationDateTime.FromTime(value)
+}
+
 func (b *BillingStatement2) SetStatus(value string) {
 	b.Status = (*BillingStatementStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type BillingSubServiceIdentification1 struct {
 	Identification *Max35Text `xml:"Id"`
 }
 
+func (b *BillingSubServiceIdentification1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingSubServiceIdentification1) AddIssuer() *BillingSubServiceQualifier1Choice {
 	b.Issuer = new(BillingSubServiceQualifier1Choice)
 	return b.Issuer
@@ -10,6 +10,10 @@ type BillingSubServiceQualifier1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (b *BillingSubServiceQualifier1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingSubServiceQualifier1Choice) SetCode(value string) {
 	b.Code = (*BillingSubServiceQualifier1Code)(&value)
 }
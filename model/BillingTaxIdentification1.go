@@ -13,6 +13,10 @@ type BillingTaxIdentification1 struct {
 	TaxContact *ContactDetails3 `xml:"TaxCtct,omitempty"`
 }
 
+func (b *BillingTaxIdentification1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingTaxIdentification1) SetVATRegistrationNumber(value string) {
 	b.VATRegistrationNumber = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Tax region that levies a tax on the services in a statement.
 type BillingTaxRegion1 struct {
 
@@ -41,6 +45,10 @@ type BillingTaxRegion1 struct {
 	TaxDueToRegion *AmountAndDirection34 `xml:"TaxDueToRgn"`
 }
 
+func (b *BillingTaxRegion1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BillingTaxRegion1) SetRegionNumber(value string) {
 	b.RegionNumber = (*Max40Text)(&value)
 }
@@ -57,6 +65,11 @@ func (b *BillingTaxRegion1) SetPointDate(value string) {
 	b.PointDate = (*ISODate)(&value)
 }
 
+func (b *BillingTaxRegion1) SetPointDateFromTime(value time.Time) {
+	b.PointDate = new(ISODate)
+	b.PointDate.FromTime(value)
+}
+
 func (b *BillingTaxRegion1) AddSendingFinancialInstitution() *BillingTaxIdentification1 {
 	b.SendingFinancialInstitution = new(BillingTaxIdentification1)
 	return b.SendingFinancialInstitution
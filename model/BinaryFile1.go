@@ -16,6 +16,10 @@ type BinaryFile1 struct {
 	IncludedBinaryObject *Max100KBinary `xml:"InclBinryObjct,omitempty"`
 }
 
+func (b *BinaryFile1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BinaryFile1) SetMIMEType(value string) {
 	b.MIMEType = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type BlockTrade1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (b *BlockTrade1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BlockTrade1Choice) SetCode(value string) {
 	b.Code = (*BlockTrade1Code)(&value)
 }
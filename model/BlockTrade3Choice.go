@@ -10,6 +10,10 @@ type BlockTrade3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (b *BlockTrade3Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BlockTrade3Choice) SetCode(value string) {
 	b.Code = (*BlockTrade1Code)(&value)
 }
@@ -10,6 +10,10 @@ type BlockTrade4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (b *BlockTrade4Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BlockTrade4Choice) SetCode(value string) {
 	b.Code = (*BlockTrade1Code)(&value)
 }
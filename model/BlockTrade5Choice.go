@@ -10,6 +10,10 @@ type BlockTrade5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (b *BlockTrade5Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BlockTrade5Choice) SetCode(value string) {
 	b.Code = (*BlockTrade1Code)(&value)
 }
@@ -13,6 +13,10 @@ type Blocked1 struct {
 	Reason *BlockedReason1Choice `xml:"Rsn,omitempty"`
 }
 
+func (b *Blocked1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *Blocked1) AddOrderType() *FundOrderType1Choice {
 	newValue := new(FundOrderType1Choice)
 	b.OrderType = append(b.OrderType, newValue)
@@ -163,6 +167,11 @@ func (b *Blocked1) SetBlocked(value string) {
 	b.Blocked = (*YesNoIndicator)(&value)
 }
 
+func (b *Blocked1) SetBlockedFromBool(value bool) {
+	b.Blocked = new(YesNoIndicator)
+	b.Blocked.FromBool(value)
+}
+
 func (b *Blocked1) AddReason() *BlockedReason1Choice {
 	b.Reason = new(BlockedReason1Choice)
 	return b.Reason
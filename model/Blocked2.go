@@ -13,6 +13,10 @@ type Blocked2 struct {
 	Reason *BlockedReason1Choice `xml:"Rsn,omitempty"`
 }
 
+func (b *Blocked2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *Blocked2) AddOrderType() *OrderType2Choice {
 	newValue := new(OrderType2Choice)
 	b.OrderType = append(b.OrderType, newValue)
@@ -163,6 +167,11 @@ func (b *Blocked2) SetBlocked(value string) {
 	b.Blocked = (*YesNoIndicator)(&value)
 }
 
+func (b *Blocked2) SetBlockedFromBool(value bool) {
+	b.Blocked = new(YesNoIndicator)
+	b.Blocked.FromBool(value)
+}
+
 func (b *Blocked2) AddReason() *BlockedReason1Choice {
 	b.Reason = new(BlockedReason1Choice)
 	return b.Reason
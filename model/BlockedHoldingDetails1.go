@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies information about a blocked holding.
 type BlockedHoldingDetails1 struct {
 
@@ -13,6 +17,10 @@ type BlockedHoldingDetails1 struct {
 	HoldingCertificateNumber *Max35Text `xml:"HldgCertNb,omitempty"`
 }
 
+func (b *BlockedHoldingDetails1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BlockedHoldingDetails1) SetBlockedHolding(value string) {
 	b.BlockedHolding = (*Holding1Code)(&value)
 }
@@ -21,6 +29,11 @@ func (b *BlockedHoldingDetails1) SetPartialHoldingUnits(value string) {
 	b.PartialHoldingUnits = (*DecimalNumber)(&value)
 }
 
+func (b *BlockedHoldingDetails1) SetPartialHoldingUnitsFromDecimal(value decimal.Decimal) {
+	b.PartialHoldingUnits = new(DecimalNumber)
+	b.PartialHoldingUnits.FromDecimal(value)
+}
+
 func (b *BlockedHoldingDetails1) SetHoldingCertificateNumber(value string) {
 	b.HoldingCertificateNumber = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about a blocked holding.
 type BlockedHoldingDetails2 struct {
 
@@ -13,6 +17,10 @@ type BlockedHoldingDetails2 struct {
 	HoldingCertificateNumber *Max35Text `xml:"HldgCertNb,omitempty"`
 }
 
+func (b *BlockedHoldingDetails2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BlockedHoldingDetails2) SetBlockedHolding(value string) {
 	b.BlockedHolding = (*Holding1Code)(&value)
 }
@@ -21,6 +29,11 @@ func (b *BlockedHoldingDetails2) SetPartialHoldingUnits(value string) {
 	b.PartialHoldingUnits = (*DecimalNumber)(&value)
 }
 
+func (b *BlockedHoldingDetails2) SetPartialHoldingUnitsFromDecimal(value decimal.Decimal) {
+	b.PartialHoldingUnits = new(DecimalNumber)
+	b.PartialHoldingUnits.FromDecimal(value)
+}
+
 func (b *BlockedHoldingDetails2) SetHoldingCertificateNumber(value string) {
 	b.HoldingCertificateNumber = (*Max35Text)(&value)
 }
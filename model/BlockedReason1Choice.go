@@ -10,6 +10,10 @@ type BlockedReason1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (b *BlockedReason1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BlockedReason1Choice) SetReason(value string) {
 	b.Reason = (*BlockedReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type BlockedReason2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (b *BlockedReason2Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BlockedReason2Choice) SetCode(value string) {
 	b.Code = (*BlockedReason2Code)(&value)
 }
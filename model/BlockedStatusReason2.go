@@ -16,6 +16,10 @@ type BlockedStatusReason2 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf"`
 }
 
+func (b *BlockedStatusReason2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BlockedStatusReason2) AddTransactionType() *TransactionType5Choice {
 	b.TransactionType = new(TransactionType5Choice)
 	return b.TransactionType
@@ -25,6 +29,11 @@ func (b *BlockedStatusReason2) SetBlocked(value string) {
 	b.Blocked = (*YesNoIndicator)(&value)
 }
 
+func (b *BlockedStatusReason2) SetBlockedFromBool(value bool) {
+	b.Blocked = new(YesNoIndicator)
+	b.Blocked.FromBool(value)
+}
+
 func (b *BlockedStatusReason2) AddReason() *BlockedReason2Choice {
 	newValue := new(BlockedReason2Choice)
 	b.Reason = append(b.Reason, newValue)
@@ -10,8 +10,16 @@ type BlockedStatusReason2Choice struct {
 	Reason []*BlockedStatusReason2 `xml:"Rsn"`
 }
 
-func (b *BlockedStatusReason2Choice) SetNoSpecifiedReason(value string) {
+func (b *BlockedStatusReason2Choice) Validate() error {
+	return ValidateElement(b)
+}
+
+func (b *BlockedStatusReason2Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	b.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (b *BlockedStatusReason2Choice) AddReason() *BlockedStatusReason2 {
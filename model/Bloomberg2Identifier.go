@@ -1,3 +1,7 @@
 package model
 
 type Bloomberg2Identifier string
+
+func (b Bloomberg2Identifier) Validate() error {
+	return validateLength("Bloomberg2Identifier", string(b), 1, 35)
+}
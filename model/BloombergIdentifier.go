@@ -1,3 +1,7 @@
 package model
 
 type BloombergIdentifier string
+
+func (b BloombergIdentifier) Validate() error {
+	return validateLength("BloombergIdentifier", string(b), 1, 35)
+}
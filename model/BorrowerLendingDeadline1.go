@@ -10,6 +10,10 @@ type BorrowerLendingDeadline1 struct {
 	Borrower *PartyIdentification92Choice `xml:"Brrwr"`
 }
 
+func (b *BorrowerLendingDeadline1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BorrowerLendingDeadline1) AddStockLendingDeadline() *DateFormat31Choice {
 	b.StockLendingDeadline = new(DateFormat31Choice)
 	return b.StockLendingDeadline
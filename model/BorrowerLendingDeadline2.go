@@ -10,6 +10,10 @@ type BorrowerLendingDeadline2 struct {
 	Borrower *PartyIdentification103Choice `xml:"Brrwr"`
 }
 
+func (b *BorrowerLendingDeadline2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BorrowerLendingDeadline2) AddStockLendingDeadline() *DateFormat34Choice {
 	b.StockLendingDeadline = new(DateFormat34Choice)
 	return b.StockLendingDeadline
@@ -10,6 +10,10 @@ type BorrowingReason1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (b *BorrowingReason1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BorrowingReason1Choice) SetCode(value string) {
 	b.Code = (*BorrowingReason1Code)(&value)
 }
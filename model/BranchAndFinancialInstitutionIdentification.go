@@ -12,6 +12,10 @@ type BranchAndFinancialInstitutionIdentification struct {
 	BranchIdentification *BranchData `xml:"BrnchId,omitempty"`
 }
 
+func (b *BranchAndFinancialInstitutionIdentification) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BranchAndFinancialInstitutionIdentification) AddFinancialInstitutionIdentification() *FinancialInstitutionIdentification1 {
 	b.FinancialInstitutionIdentification = new(FinancialInstitutionIdentification1)
 	return b.FinancialInstitutionIdentification
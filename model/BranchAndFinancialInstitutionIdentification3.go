@@ -12,6 +12,10 @@ type BranchAndFinancialInstitutionIdentification3 struct {
 	BranchIdentification *BranchData `xml:"BrnchId,omitempty"`
 }
 
+func (b *BranchAndFinancialInstitutionIdentification3) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BranchAndFinancialInstitutionIdentification3) AddFinancialInstitutionIdentification() *FinancialInstitutionIdentification5Choice {
 	b.FinancialInstitutionIdentification = new(FinancialInstitutionIdentification5Choice)
 	return b.FinancialInstitutionIdentification
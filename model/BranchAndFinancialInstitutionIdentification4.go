@@ -12,6 +12,10 @@ type BranchAndFinancialInstitutionIdentification4 struct {
 	BranchIdentification *BranchData2 `xml:"BrnchId,omitempty"`
 }
 
+func (b *BranchAndFinancialInstitutionIdentification4) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BranchAndFinancialInstitutionIdentification4) AddFinancialInstitutionIdentification() *FinancialInstitutionIdentification7 {
 	b.FinancialInstitutionIdentification = new(FinancialInstitutionIdentification7)
 	return b.FinancialInstitutionIdentification
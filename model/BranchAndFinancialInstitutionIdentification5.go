@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to uniquely and unambiguously identify a financial institution or a branch of a financial institution.
 type BranchAndFinancialInstitutionIdentification5 struct {
 
@@ -12,6 +14,10 @@ type BranchAndFinancialInstitutionIdentification5 struct {
 	BranchIdentification *BranchData2 `xml:"BrnchId,omitempty"`
 }
 
+func (b *BranchAndFinancialInstitutionIdentification5) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BranchAndFinancialInstitutionIdentification5) AddFinancialInstitutionIdentification() *FinancialInstitutionIdentification8 {
 	b.FinancialInstitutionIdentification = new(FinancialInstitutionIdentification8)
 	return b.FinancialInstitutionIdentification
@@ -21,3 +27,44 @@ func (b *BranchAndFinancialInstitutionIdentification5) AddBranchIdentification()
 	b.BranchIdentification = new(BranchData2)
 	return b.BranchIdentification
 }
+
+func (b *BranchAndFinancialInstitutionIdentification5) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.FinancialInstitutionIdentification != nil {
+		b.FinancialInstitutionIdentification.EncodeXMLFast(enc, "FinInstnId")
+	}
+	if b.BranchIdentification != nil {
+		b.BranchIdentification.EncodeXMLFast(enc, "BrnchId")
+	}
+	enc.End(tag)
+}
+
+func (b *BranchAndFinancialInstitutionIdentification5) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FinInstnId":
+			elem := new(FinancialInstitutionIdentification8)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.FinancialInstitutionIdentification = elem
+		case "BrnchId":
+			elem := new(BranchData2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.BranchIdentification = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
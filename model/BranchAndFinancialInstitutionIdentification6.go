@@ -0,0 +1,70 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Unique and unambiguous identification of a financial institution or a branch of a financial institution.
+type BranchAndFinancialInstitutionIdentification6 struct {
+
+	// Unique and unambiguous identification of a financial institution, as assigned under an internationally recognised or proprietary identification scheme.
+	FinancialInstitutionIdentification *FinancialInstitutionIdentification18 `xml:"FinInstnId"`
+
+	// Identifies a specific branch of a financial institution.
+	//
+	// Usage: This component should be used in case the identification information in the financial institution component does not provide identification up to branch level.
+	BranchIdentification *BranchData3 `xml:"BrnchId,omitempty"`
+}
+
+func (b *BranchAndFinancialInstitutionIdentification6) Validate() error {
+	return ValidateElement(b)
+}
+
+func (b *BranchAndFinancialInstitutionIdentification6) AddFinancialInstitutionIdentification() *FinancialInstitutionIdentification18 {
+	b.FinancialInstitutionIdentification = new(FinancialInstitutionIdentification18)
+	return b.FinancialInstitutionIdentification
+}
+
+func (b *BranchAndFinancialInstitutionIdentification6) AddBranchIdentification() *BranchData3 {
+	b.BranchIdentification = new(BranchData3)
+	return b.BranchIdentification
+}
+
+func (b *BranchAndFinancialInstitutionIdentification6) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.FinancialInstitutionIdentification != nil {
+		b.FinancialInstitutionIdentification.EncodeXMLFast(enc, "FinInstnId")
+	}
+	if b.BranchIdentification != nil {
+		b.BranchIdentification.EncodeXMLFast(enc, "BrnchId")
+	}
+	enc.End(tag)
+}
+
+func (b *BranchAndFinancialInstitutionIdentification6) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FinInstnId":
+			elem := new(FinancialInstitutionIdentification18)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.FinancialInstitutionIdentification = elem
+		case "BrnchId":
+			elem := new(BranchData3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.BranchIdentification = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package model
+
+// Unique and unambiguous identification of a financial institution or a branch of a financial institution.
+type BranchAndFinancialInstitutionIdentification8 struct {
+
+	// Unique and unambiguous identification of a financial institution, as assigned under an internationally recognised or proprietary identification scheme.
+	FinancialInstitutionIdentification *FinancialInstitutionIdentification23 `xml:"FinInstnId"`
+
+	// Identifies a specific branch of a financial institution.
+	//
+	// Usage: This component should be used in case the identification information in the financial institution component does not provide identification up to branch level.
+	BranchIdentification *BranchData5 `xml:"BrnchId,omitempty"`
+}
+
+func (b *BranchAndFinancialInstitutionIdentification8) Validate() error {
+	return ValidateElement(b)
+}
+
+func (b *BranchAndFinancialInstitutionIdentification8) AddFinancialInstitutionIdentification() *FinancialInstitutionIdentification23 {
+	b.FinancialInstitutionIdentification = new(FinancialInstitutionIdentification23)
+	return b.FinancialInstitutionIdentification
+}
+
+func (b *BranchAndFinancialInstitutionIdentification8) AddBranchIdentification() *BranchData5 {
+	b.BranchIdentification = new(BranchData5)
+	return b.BranchIdentification
+}
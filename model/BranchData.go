@@ -13,6 +13,10 @@ type BranchData struct {
 	PostalAddress *PostalAddress1 `xml:"PstlAdr,omitempty"`
 }
 
+func (b *BranchData) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BranchData) SetIdentification(value string) {
 	b.Identification = (*Max35Text)(&value)
 }
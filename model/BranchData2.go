@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Information that locates and identifies a specific branch of a financial institution.
 type BranchData2 struct {
 
@@ -13,6 +15,10 @@ type BranchData2 struct {
 	PostalAddress *PostalAddress6 `xml:"PstlAdr,omitempty"`
 }
 
+func (b *BranchData2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BranchData2) SetIdentification(value string) {
 	b.Identification = (*Max35Text)(&value)
 }
@@ -25,3 +31,53 @@ func (b *BranchData2) AddPostalAddress() *PostalAddress6 {
 	b.PostalAddress = new(PostalAddress6)
 	return b.PostalAddress
 }
+
+func (b *BranchData2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.Identification != nil {
+		enc.Element("Id", string(*b.Identification))
+	}
+	if b.Name != nil {
+		enc.Element("Nm", string(*b.Name))
+	}
+	if b.PostalAddress != nil {
+		b.PostalAddress.EncodeXMLFast(enc, "PstlAdr")
+	}
+	enc.End(tag)
+}
+
+func (b *BranchData2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.Identification = (*Max35Text)(&value)
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.Name = (*Max140Text)(&value)
+		case "PstlAdr":
+			elem := new(PostalAddress6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.PostalAddress = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
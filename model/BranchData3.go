@@ -0,0 +1,99 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Information that locates and identifies a specific branch of a financial institution.
+type BranchData3 struct {
+
+	// Unique and unambiguous identification of a branch of a financial institution.
+	Identification *Max35Text `xml:"Id,omitempty"`
+
+	// Legal entity identification as an alternate identification for a party.
+	LEI *LEIIdentifier `xml:"LEI,omitempty"`
+
+	// Name by which an agent is known and which is usually used to identify that agent.
+	Name *Max140Text `xml:"Nm,omitempty"`
+
+	// Information that locates and identifies a specific address, as defined by postal services.
+	PostalAddress *PostalAddress24 `xml:"PstlAdr,omitempty"`
+}
+
+func (b *BranchData3) Validate() error {
+	return ValidateElement(b)
+}
+
+func (b *BranchData3) SetIdentification(value string) {
+	b.Identification = (*Max35Text)(&value)
+}
+
+func (b *BranchData3) SetLEI(value string) {
+	b.LEI = (*LEIIdentifier)(&value)
+}
+
+func (b *BranchData3) SetName(value string) {
+	b.Name = (*Max140Text)(&value)
+}
+
+func (b *BranchData3) AddPostalAddress() *PostalAddress24 {
+	b.PostalAddress = new(PostalAddress24)
+	return b.PostalAddress
+}
+
+func (b *BranchData3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if b.Identification != nil {
+		enc.Element("Id", string(*b.Identification))
+	}
+	if b.LEI != nil {
+		enc.Element("LEI", string(*b.LEI))
+	}
+	if b.Name != nil {
+		enc.Element("Nm", string(*b.Name))
+	}
+	if b.PostalAddress != nil {
+		b.PostalAddress.EncodeXMLFast(enc, "PstlAdr")
+	}
+	enc.End(tag)
+}
+
+func (b *BranchData3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.Identification = (*Max35Text)(&value)
+		case "LEI":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.LEI = (*LEIIdentifier)(&value)
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			b.Name = (*Max140Text)(&value)
+		case "PstlAdr":
+			elem := new(PostalAddress24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			b.PostalAddress = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package model
+
+// Information that locates and identifies a specific branch of a financial institution.
+type BranchData5 struct {
+
+	// Unique and unambiguous identification of a branch of a financial institution.
+	Identification *Max35Text `xml:"Id,omitempty"`
+
+	// Legal entity identification as an alternate identification for a party.
+	LEI *LEIIdentifier `xml:"LEI,omitempty"`
+
+	// Name by which an agent is known and which is usually used to identify that agent.
+	Name *Max140Text `xml:"Nm,omitempty"`
+
+	// Information that locates and identifies a specific address, as defined by postal services.
+	PostalAddress *PostalAddress27 `xml:"PstlAdr,omitempty"`
+}
+
+func (b *BranchData5) Validate() error {
+	return ValidateElement(b)
+}
+
+func (b *BranchData5) SetIdentification(value string) {
+	b.Identification = (*Max35Text)(&value)
+}
+
+func (b *BranchData5) SetLEI(value string) {
+	b.LEI = (*LEIIdentifier)(&value)
+}
+
+func (b *BranchData5) SetName(value string) {
+	b.Name = (*Max140Text)(&value)
+}
+
+func (b *BranchData5) AddPostalAddress() *PostalAddress27 {
+	b.PostalAddress = new(PostalAddress27)
+	return b.PostalAddress
+}
@@ -10,6 +10,10 @@ type BreakDown1Choice struct {
 	ByCommercialInvoice *ReportLine6 `xml:"ByComrclInvc"`
 }
 
+func (b *BreakDown1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BreakDown1Choice) AddByPurchaseOrder() *ReportLine5 {
 	b.ByPurchaseOrder = new(ReportLine5)
 	return b.ByPurchaseOrder
@@ -16,6 +16,10 @@ type BreakdownByCountry1 struct {
 	NetCashForecast []*NetCashForecast2 `xml:"NetCshFcst,omitempty"`
 }
 
+func (b *BreakdownByCountry1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BreakdownByCountry1) SetCountry(value string) {
 	b.Country = (*CountryCode)(&value)
 }
@@ -16,6 +16,10 @@ type BreakdownByCountry2 struct {
 	NetCashForecast []*NetCashForecast4 `xml:"NetCshFcst,omitempty"`
 }
 
+func (b *BreakdownByCountry2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BreakdownByCountry2) SetCountry(value string) {
 	b.Country = (*CountryCode)(&value)
 }
@@ -16,6 +16,10 @@ type BreakdownByCurrency1 struct {
 	NetCashForecast []*NetCashForecast2 `xml:"NetCshFcst,omitempty"`
 }
 
+func (b *BreakdownByCurrency1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BreakdownByCurrency1) SetCurrency(value string) {
 	b.Currency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -16,6 +16,10 @@ type BreakdownByCurrency2 struct {
 	NetCashForecast []*NetCashForecast4 `xml:"NetCshFcst,omitempty"`
 }
 
+func (b *BreakdownByCurrency2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BreakdownByCurrency2) SetCurrency(value string) {
 	b.Currency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
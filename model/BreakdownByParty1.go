@@ -19,6 +19,10 @@ type BreakdownByParty1 struct {
 	NetCashForecast []*NetCashForecast2 `xml:"NetCshFcst,omitempty"`
 }
 
+func (b *BreakdownByParty1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BreakdownByParty1) AddParty() *PartyIdentification2Choice {
 	b.Party = new(PartyIdentification2Choice)
 	return b.Party
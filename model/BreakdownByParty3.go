@@ -19,6 +19,10 @@ type BreakdownByParty3 struct {
 	NetCashForecast []*NetCashForecast4 `xml:"NetCshFcst,omitempty"`
 }
 
+func (b *BreakdownByParty3) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BreakdownByParty3) AddParty() *InvestmentAccount42 {
 	b.Party = new(InvestmentAccount42)
 	return b.Party
@@ -25,6 +25,10 @@ type BreakdownByUserDefinedParameter1 struct {
 	NetCashForecast []*NetCashForecast2 `xml:"NetCshFcst,omitempty"`
 }
 
+func (b *BreakdownByUserDefinedParameter1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BreakdownByUserDefinedParameter1) AddParty() *PartyIdentification2Choice {
 	b.Party = new(PartyIdentification2Choice)
 	return b.Party
@@ -25,6 +25,10 @@ type BreakdownByUserDefinedParameter3 struct {
 	NetCashForecast []*NetCashForecast4 `xml:"NetCshFcst,omitempty"`
 }
 
+func (b *BreakdownByUserDefinedParameter3) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BreakdownByUserDefinedParameter3) AddParty() *InvestmentAccount42 {
 	b.Party = new(InvestmentAccount42)
 	return b.Party
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the Business Application Header of the Business Message.
 // Can be used when replying to a query;  can also be used when canceling or amending.
 type BusinessApplicationHeader1 struct {
@@ -55,6 +59,10 @@ type BusinessApplicationHeader1 struct {
 	Signature *SignatureEnvelope `xml:"Sgntr,omitempty"`
 }
 
+func (b *BusinessApplicationHeader1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BusinessApplicationHeader1) SetCharacterSet(value string) {
 	b.CharacterSet = (*UnicodeChartsCode)(&value)
 }
@@ -85,14 +93,28 @@ func (b *BusinessApplicationHeader1) SetCreationDate(value string) {
 	b.CreationDate = (*ISONormalisedDateTime)(&value)
 }
 
-func (b *BusinessApplicationHeader1) SetCopyDuplicate(value string) {
+func (b *BusinessApplicationHeader1) SetCreationDateFromTime(value time.Time) {
+	b.CreationDate = new(ISONormalisedDateTime)
+	b.CreationDate.FromTime(value)
+}
+
+func (b *BusinessApplicationHeader1) SetCopyDuplicate(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	b.CopyDuplicate = (*CopyDuplicate1Code)(&value)
+	return nil
 }
 
 func (b *BusinessApplicationHeader1) SetPossibleDuplicate(value string) {
 	b.PossibleDuplicate = (*YesNoIndicator)(&value)
 }
 
+func (b *BusinessApplicationHeader1) SetPossibleDuplicateFromBool(value bool) {
+	b.PossibleDuplicate = new(YesNoIndicator)
+	b.PossibleDuplicate.FromBool(value)
+}
+
 func (b *BusinessApplicationHeader1) SetPriority(value string) {
 	b.Priority = (*BusinessMessagePriorityCode)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Defines a business letter containing identifications of involved entities and their roles, references to documents, free form text and signatures.
 // The semantics of this information are defined by usual business practices for the exchange and tracing of business letters. The described references and party identifiers permit to establish a linked informal trace of sequences of letters.
 // This message component contains three types of elements that can be referenced using IDREF:
@@ -72,6 +76,10 @@ type BusinessLetter1 struct {
 	DigitalSignature []*QualifiedPartyAndXMLSignature1 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (b *BusinessLetter1) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BusinessLetter1) SetApplicationContext(value string) {
 	b.ApplicationContext = (*Max35Text)(&value)
 }
@@ -85,6 +93,11 @@ func (b *BusinessLetter1) SetDate(value string) {
 	b.Date = (*ISODate)(&value)
 }
 
+func (b *BusinessLetter1) SetDateFromTime(value time.Time) {
+	b.Date = new(ISODate)
+	b.Date.FromTime(value)
+}
+
 func (b *BusinessLetter1) AddRelatedLetter() *QualifiedDocumentInformation1 {
 	newValue := new(QualifiedDocumentInformation1)
 	b.RelatedLetter = append(b.RelatedLetter, newValue)
@@ -381,8 +394,12 @@ func (b *BusinessLetter1) AddContentIdentifier(value string) {
 	b.ContentIdentifier = append(b.ContentIdentifier, (*Max35Text)(&value))
 }
 
-func (b *BusinessLetter1) SetInstructionPriority(value string) {
+func (b *BusinessLetter1) SetInstructionPriority(value string) error {
+	if err := Priority3Code(value).Validate(); err != nil {
+		return err
+	}
 	b.InstructionPriority = (*Priority3Code)(&value)
+	return nil
 }
 
 func (b *BusinessLetter1) AddOriginator() *QualifiedPartyIdentification1 {
@@ -1,3 +1,7 @@
 package model
 
 type BusinessMessagePriorityCode string
+
+func (b BusinessMessagePriorityCode) Validate() error {
+	return validateLength("BusinessMessagePriorityCode", string(b), 1, 4)
+}
@@ -10,6 +10,10 @@ type BusinessProcessType1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (b *BusinessProcessType1Choice) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BusinessProcessType1Choice) SetCode(value string) {
 	b.Code = (*BusinessProcessType1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies elements related to the confirmation sent by the central counterparty to the clearing member in the context of the buy in process.
 type BuyIn2 struct {
 
@@ -22,6 +26,10 @@ type BuyIn2 struct {
 	RequiredCashCompensation *CashCompensation1 `xml:"ReqrdCshCompstn,omitempty"`
 }
 
+func (b *BuyIn2) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BuyIn2) SetBuyInNotificationIdentification(value string) {
 	b.BuyInNotificationIdentification = (*Max35Text)(&value)
 }
@@ -34,6 +42,11 @@ func (b *BuyIn2) SetDate(value string) {
 	b.Date = (*ISODate)(&value)
 }
 
+func (b *BuyIn2) SetDateFromTime(value time.Time) {
+	b.Date = new(ISODate)
+	b.Date.FromTime(value)
+}
+
 func (b *BuyIn2) AddPrice() *Price4 {
 	b.Price = new(Price4)
 	return b.Price
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies elements related to the response sent by the clearing member to the central counterparty in the context of the buy in process.
 type BuyIn3 struct {
 
@@ -22,6 +26,10 @@ type BuyIn3 struct {
 	UncoveredQuantity *FinancialInstrumentQuantity1Choice `xml:"UcvrdQty"`
 }
 
+func (b *BuyIn3) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BuyIn3) SetBuyInNotificationIdentification(value string) {
 	b.BuyInNotificationIdentification = (*Max35Text)(&value)
 }
@@ -30,10 +38,20 @@ func (b *BuyIn3) SetRequestForDelayIndicator(value string) {
 	b.RequestForDelayIndicator = (*YesNoIndicator)(&value)
 }
 
+func (b *BuyIn3) SetRequestForDelayIndicatorFromBool(value bool) {
+	b.RequestForDelayIndicator = new(YesNoIndicator)
+	b.RequestForDelayIndicator.FromBool(value)
+}
+
 func (b *BuyIn3) SetNumberOfDays(value string) {
 	b.NumberOfDays = (*Number)(&value)
 }
 
+func (b *BuyIn3) SetNumberOfDaysFromDecimal(value decimal.Decimal) {
+	b.NumberOfDays = new(Number)
+	b.NumberOfDays.FromDecimal(value)
+}
+
 func (b *BuyIn3) AddInitialQuantity() *FinancialInstrumentQuantity1Choice {
 	b.InitialQuantity = new(FinancialInstrumentQuantity1Choice)
 	return b.InitialQuantity
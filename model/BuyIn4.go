@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies elements related to the notification (or warn) sent by the central counterparty to the clearing member in the context of the buy in process.
 type BuyIn4 struct {
 
@@ -16,10 +20,19 @@ type BuyIn4 struct {
 	BuyInReversionDate *ISODate `xml:"BuyInRvrsnDt,omitempty"`
 }
 
+func (b *BuyIn4) Validate() error {
+	return ValidateElement(b)
+}
+
 func (b *BuyIn4) SetWarningIndicator(value string) {
 	b.WarningIndicator = (*YesNoIndicator)(&value)
 }
 
+func (b *BuyIn4) SetWarningIndicatorFromBool(value bool) {
+	b.WarningIndicator = new(YesNoIndicator)
+	b.WarningIndicator.FromBool(value)
+}
+
 func (b *BuyIn4) AddExpectedBuyInDate() *DateFormat15Choice {
 	b.ExpectedBuyInDate = new(DateFormat15Choice)
 	return b.ExpectedBuyInDate
@@ -29,6 +42,16 @@ func (b *BuyIn4) SetCancellationLimitDate(value string) {
 	b.CancellationLimitDate = (*ISODate)(&value)
 }
 
+func (b *BuyIn4) SetCancellationLimitDateFromTime(value time.Time) {
+	b.CancellationLimitDate = new(ISODate)
+	b.CancellationLimitDate.FromTime(value)
+}
+
 func (b *BuyIn4) SetBuyInReversionDate(value string) {
 	b.BuyInReversionDate = (*ISODate)(&value)
 }
+
+func (b *BuyIn4) SetBuyInReversionDateFromTime(value time.Time) {
+	b.BuyInReversionDate = new(ISODate)
+	b.BuyInReversionDate.FromTime(value)
+}
@@ -1,3 +1,9 @@
 package model
 
 type CFIIdentifier string
+
+var cfiIdentifierPattern = newPattern(`[A-Z]{6,6}`)
+
+func (c CFIIdentifier) Validate() error {
+	return validatePattern("CFIIdentifier", string(c), cfiIdentifierPattern)
+}
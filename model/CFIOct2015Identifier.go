@@ -1,3 +1,9 @@
 package model
 
 type CFIOct2015Identifier string
+
+var cfiOct2015IdentifierPattern = newPattern(`[A-Z]{6,6}`)
+
+func (c CFIOct2015Identifier) Validate() error {
+	return validatePattern("CFIOct2015Identifier", string(c), cfiOct2015IdentifierPattern)
+}
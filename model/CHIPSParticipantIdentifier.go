@@ -1,3 +1,9 @@
 package model
 
 type CHIPSParticipantIdentifier string
+
+var chipsParticipantIdentifierPattern = newPattern(`CP[0-9]{4,4}`)
+
+func (c CHIPSParticipantIdentifier) Validate() error {
+	return validatePattern("CHIPSParticipantIdentifier", string(c), chipsParticipantIdentifierPattern)
+}
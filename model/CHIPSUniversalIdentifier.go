@@ -1,3 +1,9 @@
 package model
 
 type CHIPSUniversalIdentifier string
+
+var chipsUniversalIdentifierPattern = newPattern(`CH[0-9]{6,6}`)
+
+func (c CHIPSUniversalIdentifier) Validate() error {
+	return validatePattern("CHIPSUniversalIdentifier", string(c), chipsUniversalIdentifierPattern)
+}
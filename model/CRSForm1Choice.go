@@ -10,6 +10,10 @@ type CRSForm1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CRSForm1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CRSForm1Choice) SetCode(value string) {
 	c.Code = (*CRSFormType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CRSSource1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CRSSource1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CRSSource1Choice) SetCode(value string) {
 	c.Code = (*CRSSourceStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CRSStatus3Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CRSStatus3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CRSStatus3Choice) SetCode(value string) {
 	c.Code = (*CRSStatus1Code)(&value)
 }
@@ -13,6 +13,10 @@ type CRSStatus4 struct {
 	ExceptionalReportingCountry *CountryCode `xml:"XcptnlRptgCtry,omitempty"`
 }
 
+func (c *CRSStatus4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CRSStatus4) AddType() *CRSStatus3Choice {
 	c.Type = new(CRSStatus3Choice)
 	return c.Type
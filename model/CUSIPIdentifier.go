@@ -1,3 +1,7 @@
 package model
 
 type CUSIPIdentifier string
+
+func (c CUSIPIdentifier) Validate() error {
+	return validateLength("CUSIPIdentifier", string(c), 1, 35)
+}
@@ -10,6 +10,10 @@ type CalculationBasis1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CalculationBasis1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CalculationBasis1) SetStructured(value string) {
 	c.Structured = (*CalculationBasis1Code)(&value)
 }
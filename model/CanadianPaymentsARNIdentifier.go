@@ -1,3 +1,9 @@
 package model
 
 type CanadianPaymentsARNIdentifier string
+
+var canadianPaymentsARNIdentifierPattern = newPattern(`CA[0-9]{9,9}`)
+
+func (c CanadianPaymentsARNIdentifier) Validate() error {
+	return validatePattern("CanadianPaymentsARNIdentifier", string(c), canadianPaymentsARNIdentifierPattern)
+}
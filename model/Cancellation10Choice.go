@@ -10,6 +10,10 @@ type Cancellation10Choice struct {
 	TransferInDetails *TransferIn15 `xml:"TrfInDtls"`
 }
 
+func (c *Cancellation10Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cancellation10Choice) AddReferences() *TransferReference9 {
 	newValue := new(TransferReference9)
 	c.References = append(c.References, newValue)
@@ -10,6 +10,10 @@ type Cancellation11Choice struct {
 	CancellationByReference *TransferReference7 `xml:"CxlByRef"`
 }
 
+func (c *Cancellation11Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cancellation11Choice) AddCancellationByTransferInstructionDetails() *ISATransfer24 {
 	c.CancellationByTransferInstructionDetails = new(ISATransfer24)
 	return c.CancellationByTransferInstructionDetails
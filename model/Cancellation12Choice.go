@@ -10,6 +10,10 @@ type Cancellation12Choice struct {
 	TransferOutDetails *TransferOut17 `xml:"TrfOutDtls"`
 }
 
+func (c *Cancellation12Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cancellation12Choice) AddReferences() *TransferReference9 {
 	newValue := new(TransferReference9)
 	c.References = append(c.References, newValue)
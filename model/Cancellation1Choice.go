@@ -10,6 +10,10 @@ type Cancellation1Choice struct {
 	TransferOutDetails *TransferOut9 `xml:"TrfOutDtls"`
 }
 
+func (c *Cancellation1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cancellation1Choice) AddReference() *TransferReference1 {
 	c.Reference = new(TransferReference1)
 	return c.Reference
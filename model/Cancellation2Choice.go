@@ -10,6 +10,10 @@ type Cancellation2Choice struct {
 	TransferInDetails *TransferIn7 `xml:"TrfInDtls"`
 }
 
+func (c *Cancellation2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cancellation2Choice) AddReference() *TransferReference1 {
 	c.Reference = new(TransferReference1)
 	return c.Reference
@@ -10,6 +10,10 @@ type Cancellation3Choice struct {
 	CancellationByReference *TransferReference3 `xml:"CxlByRef"`
 }
 
+func (c *Cancellation3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cancellation3Choice) AddCancellationByTransferInstructionDetails() *ISATransfer9 {
 	c.CancellationByTransferInstructionDetails = new(ISATransfer9)
 	return c.CancellationByTransferInstructionDetails
@@ -10,6 +10,10 @@ type Cancellation4Choice struct {
 	TransferOutDetails *TransferOut11 `xml:"TrfOutDtls"`
 }
 
+func (c *Cancellation4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cancellation4Choice) AddReferences() *TransferReference5 {
 	newValue := new(TransferReference5)
 	c.References = append(c.References, newValue)
@@ -10,6 +10,10 @@ type Cancellation5Choice struct {
 	TransferInDetails *TransferIn10 `xml:"TrfInDtls"`
 }
 
+func (c *Cancellation5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cancellation5Choice) AddReferences() *TransferReference5 {
 	newValue := new(TransferReference5)
 	c.References = append(c.References, newValue)
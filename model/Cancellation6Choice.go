@@ -10,6 +10,10 @@ type Cancellation6Choice struct {
 	CancellationByReference *TransferReference7 `xml:"CxlByRef"`
 }
 
+func (c *Cancellation6Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cancellation6Choice) AddCancellationByTransferInstructionDetails() *ISATransfer12 {
 	c.CancellationByTransferInstructionDetails = new(ISATransfer12)
 	return c.CancellationByTransferInstructionDetails
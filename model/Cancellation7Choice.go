@@ -10,6 +10,10 @@ type Cancellation7Choice struct {
 	CancellationByReference *TransferReference7 `xml:"CxlByRef"`
 }
 
+func (c *Cancellation7Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cancellation7Choice) AddCancellationByTransferInstructionDetails() *ISATransfer19 {
 	c.CancellationByTransferInstructionDetails = new(ISATransfer19)
 	return c.CancellationByTransferInstructionDetails
@@ -10,6 +10,10 @@ type Cancellation8Choice struct {
 	TransferOutDetails *TransferOut15 `xml:"TrfOutDtls"`
 }
 
+func (c *Cancellation8Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cancellation8Choice) AddReferences() *TransferReference5 {
 	newValue := new(TransferReference5)
 	c.References = append(c.References, newValue)
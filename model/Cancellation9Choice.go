@@ -10,6 +10,10 @@ type Cancellation9Choice struct {
 	TransferInDetails *TransferIn13 `xml:"TrfInDtls"`
 }
 
+func (c *Cancellation9Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cancellation9Choice) AddReferences() *TransferReference5 {
 	newValue := new(TransferReference5)
 	c.References = append(c.References, newValue)
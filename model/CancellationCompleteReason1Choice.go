@@ -10,6 +10,10 @@ type CancellationCompleteReason1Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (c *CancellationCompleteReason1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationCompleteReason1Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason1Code)(&value)
 }
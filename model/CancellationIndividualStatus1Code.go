@@ -1,3 +1,28 @@
 package model
 
 type CancellationIndividualStatus1Code string
+
+const (
+	CancellationIndividualStatus1CodeRJCR CancellationIndividualStatus1Code = "RJCR"
+	CancellationIndividualStatus1CodeACCR CancellationIndividualStatus1Code = "ACCR"
+	CancellationIndividualStatus1CodePDCR CancellationIndividualStatus1Code = "PDCR"
+)
+
+var cancellationIndividualStatus1CodeDescriptions = map[CancellationIndividualStatus1Code]string{
+	CancellationIndividualStatus1CodeRJCR: "Rejected cancellation request: cancellation request is rejected.",
+	CancellationIndividualStatus1CodeACCR: "Accepted cancellation request: cancellation is accepted.",
+	CancellationIndividualStatus1CodePDCR: "Pending cancellation request: cancellation request is pending.",
+}
+
+func (c CancellationIndividualStatus1Code) IsValid() bool {
+	_, ok := cancellationIndividualStatus1CodeDescriptions[c]
+	return ok
+}
+
+func (c CancellationIndividualStatus1Code) Description() string {
+	return cancellationIndividualStatus1CodeDescriptions[c]
+}
+
+func (c CancellationIndividualStatus1Code) Validate() error {
+	return validateEnumeration("CancellationIndividualStatus1Code", string(c), c.IsValid(), "RJCR, ACCR, PDCR")
+}
@@ -13,6 +13,10 @@ type CancellationPendingStatus1 struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (c *CancellationPendingStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationPendingStatus1) SetReason(value string) {
 	c.Reason = (*Max350Text)(&value)
 }
@@ -22,6 +26,10 @@ func (c *CancellationPendingStatus1) AddDataSourceScheme() *GenericIdentificatio
 	return c.DataSourceScheme
 }
 
-func (c *CancellationPendingStatus1) SetNoSpecifiedReason(value string) {
+func (c *CancellationPendingStatus1) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
@@ -13,6 +13,10 @@ type CancellationPendingStatus7Choice struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (c *CancellationPendingStatus7Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationPendingStatus7Choice) SetReason(value string) {
 	c.Reason = (*Max350Text)(&value)
 }
@@ -22,6 +26,10 @@ func (c *CancellationPendingStatus7Choice) AddDataSourceScheme() *GenericIdentif
 	return c.DataSourceScheme
 }
 
-func (c *CancellationPendingStatus7Choice) SetNoSpecifiedReason(value string) {
+func (c *CancellationPendingStatus7Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
@@ -10,6 +10,10 @@ type CancellationProcessingStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CancellationProcessingStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationProcessingStatus1) SetStatus(value string) {
 	c.Status = (*CancellationStatus3Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationProcessingStatus1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CancellationProcessingStatus1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationProcessingStatus1Choice) SetCode(value string) {
 	c.Code = (*CancellationProcessingStatus1Code)(&value)
 }
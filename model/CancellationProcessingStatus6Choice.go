@@ -16,6 +16,10 @@ type CancellationProcessingStatus6Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts,omitempty"`
 }
 
+func (c *CancellationProcessingStatus6Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationProcessingStatus6Choice) AddCancellationPending() *CancellationReason11Choice {
 	c.CancellationPending = new(CancellationReason11Choice)
 	return c.CancellationPending
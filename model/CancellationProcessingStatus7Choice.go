@@ -10,6 +10,10 @@ type CancellationProcessingStatus7Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CancellationProcessingStatus7Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationProcessingStatus7Choice) SetCode(value string) {
 	c.Code = (*CancellationProcessingStatus1Code)(&value)
 }
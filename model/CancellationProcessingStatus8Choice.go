@@ -10,6 +10,10 @@ type CancellationProcessingStatus8Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CancellationProcessingStatus8Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationProcessingStatus8Choice) SetCode(value string) {
 	c.Code = (*CancellationProcessingStatus1Code)(&value)
 }
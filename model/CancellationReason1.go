@@ -10,6 +10,10 @@ type CancellationReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancellationReason1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason1) AddCode() *CancellationReason5Choice {
 	c.Code = new(CancellationReason5Choice)
 	return c.Code
@@ -10,6 +10,10 @@ type CancellationReason10 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancellationReason10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason10) AddCode() *CancellationReason21Choice {
 	c.Code = new(CancellationReason21Choice)
 	return c.Code
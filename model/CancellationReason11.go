@@ -10,6 +10,10 @@ type CancellationReason11 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancellationReason11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason11) AddCode() *CancellationReason22Choice {
 	c.Code = new(CancellationReason22Choice)
 	return c.Code
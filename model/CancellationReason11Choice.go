@@ -10,8 +10,16 @@ type CancellationReason11Choice struct {
 	Reason []*AwaitingCancellationReason1 `xml:"Rsn"`
 }
 
-func (c *CancellationReason11Choice) SetNoSpecifiedReason(value string) {
+func (c *CancellationReason11Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancellationReason11Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancellationReason11Choice) AddReason() *AwaitingCancellationReason1 {
@@ -10,6 +10,10 @@ type CancellationReason12 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancellationReason12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason12) AddCode() *CancellationReason23Choice {
 	c.Code = new(CancellationReason23Choice)
 	return c.Code
@@ -10,6 +10,10 @@ type CancellationReason12Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CancellationReason12Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason12Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason13Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationReason13 struct {
 	CorporateActionEventIdentification *Max35Text `xml:"CorpActnEvtId,omitempty"`
 }
 
+func (c *CancellationReason13) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason13) AddCode() *CancellationReason19Choice {
 	c.Code = new(CancellationReason19Choice)
 	return c.Code
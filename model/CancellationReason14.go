@@ -10,6 +10,10 @@ type CancellationReason14 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancellationReason14) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason14) AddCode() *CancellationReason24Choice {
 	c.Code = new(CancellationReason24Choice)
 	return c.Code
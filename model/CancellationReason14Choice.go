@@ -10,6 +10,10 @@ type CancellationReason14Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *CancellationReason14Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason14Choice) SetCode(value string) {
 	c.Code = (*CancellationReason5Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationReason15 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancellationReason15) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason15) AddCode() *CancellationReason25Choice {
 	c.Code = new(CancellationReason25Choice)
 	return c.Code
@@ -10,6 +10,10 @@ type CancellationReason16 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancellationReason16) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason16) AddCode() *CancellationReason26Choice {
 	c.Code = new(CancellationReason26Choice)
 	return c.Code
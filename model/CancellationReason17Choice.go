@@ -10,6 +10,10 @@ type CancellationReason17Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CancellationReason17Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason17Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason14Code)(&value)
 }
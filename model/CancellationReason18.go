@@ -10,6 +10,10 @@ type CancellationReason18 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancellationReason18) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason18) AddCode() *CancellationReason28Choice {
 	c.Code = new(CancellationReason28Choice)
 	return c.Code
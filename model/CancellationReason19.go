@@ -10,6 +10,10 @@ type CancellationReason19 struct {
 	CorporateActionEventIdentification *RestrictedFINMax16Text `xml:"CorpActnEvtId,omitempty"`
 }
 
+func (c *CancellationReason19) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason19) AddCode() *CancellationReason24Choice {
 	c.Code = new(CancellationReason24Choice)
 	return c.Code
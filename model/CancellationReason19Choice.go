@@ -10,6 +10,10 @@ type CancellationReason19Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CancellationReason19Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason19Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason13Code)(&value)
 }
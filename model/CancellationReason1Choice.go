@@ -10,6 +10,10 @@ type CancellationReason1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *CancellationReason1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason1Choice) SetCode(value string) {
 	c.Code = (*CancellationReason2Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationReason2 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancellationReason2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason2) AddCode() *CancellationReason3Choice {
 	c.Code = new(CancellationReason3Choice)
 	return c.Code
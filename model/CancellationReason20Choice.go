@@ -10,6 +10,10 @@ type CancellationReason20Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CancellationReason20Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason20Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason12Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationReason21Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CancellationReason21Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason21Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason5Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationReason22Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CancellationReason22Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason22Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason14Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationReason23Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CancellationReason23Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason23Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason9Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationReason24Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CancellationReason24Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason24Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason13Code)(&value)
 }
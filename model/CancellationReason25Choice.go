@@ -10,6 +10,10 @@ type CancellationReason25Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CancellationReason25Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason25Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason9Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationReason26Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CancellationReason26Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason26Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason14Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationReason28Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CancellationReason28Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason28Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason5Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationReason2Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *CancellationReason2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason2Choice) SetCode(value string) {
 	c.Code = (*CancellationReason4Code)(&value)
 }
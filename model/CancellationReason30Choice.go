@@ -10,6 +10,10 @@ type CancellationReason30Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CancellationReason30Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason30Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason12Code)(&value)
 }
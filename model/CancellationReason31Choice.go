@@ -10,6 +10,10 @@ type CancellationReason31Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CancellationReason31Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason31Choice) SetCode(value string) {
 	c.Code = (*ConfirmationCancellationReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationReason32Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CancellationReason32Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason32Choice) SetCode(value string) {
 	c.Code = (*Max35Text)(&value)
 }
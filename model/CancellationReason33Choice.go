@@ -10,6 +10,10 @@ type CancellationReason33Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *CancellationReason33Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason33Choice) SetCode(value string) {
 	c.Code = (*ExternalCancellationReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationReason3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CancellationReason3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason3Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason5Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationReason4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CancellationReason4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason4Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason10Code)(&value)
 }
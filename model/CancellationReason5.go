@@ -10,6 +10,10 @@ type CancellationReason5 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancellationReason5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason5) AddCode() *CancellationReason12Choice {
 	c.Code = new(CancellationReason12Choice)
 	return c.Code
@@ -10,6 +10,10 @@ type CancellationReason5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CancellationReason5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason5Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason9Code)(&value)
 }
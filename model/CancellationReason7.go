@@ -10,6 +10,10 @@ type CancellationReason7 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancellationReason7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason7) AddCode() *CancellationReason17Choice {
 	c.Code = new(CancellationReason17Choice)
 	return c.Code
@@ -10,6 +10,10 @@ type CancellationReason9 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancellationReason9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason9) AddCode() *CancellationReason19Choice {
 	c.Code = new(CancellationReason19Choice)
 	return c.Code
@@ -10,6 +10,10 @@ type CancellationReason9Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CancellationReason9Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReason9Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason12Code)(&value)
 }
@@ -15,6 +15,10 @@ type CancellationReasonInformation1 struct {
 	AdditionalCancellationReasonInformation []*Max105Text `xml:"AddtlCxlRsnInf,omitempty"`
 }
 
+func (c *CancellationReasonInformation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReasonInformation1) AddCancellationOriginator() *PartyIdentification8 {
 	c.CancellationOriginator = new(PartyIdentification8)
 	return c.CancellationOriginator
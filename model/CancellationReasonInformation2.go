@@ -13,6 +13,10 @@ type CancellationReasonInformation2 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CancellationReasonInformation2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationReasonInformation2) AddOriginator() *PartyIdentification32 {
 	c.Originator = new(PartyIdentification32)
 	return c.Originator
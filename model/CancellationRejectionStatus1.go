@@ -13,6 +13,10 @@ type CancellationRejectionStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CancellationRejectionStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationRejectionStatus1) SetReason(value string) {
 	c.Reason = (*RejectionReason2Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationRejectionStatus1Choice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (c *CancellationRejectionStatus1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationRejectionStatus1Choice) SetCode(value string) {
 	c.Code = (*RejectionReason2Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of information related to the cancellation request, such as actors involved and identification of the original multiple invoice financing request to which the cancellation request is referring.
 type CancellationRequestInformation1 struct {
 
@@ -28,6 +34,10 @@ type CancellationRequestInformation1 struct {
 	FirstAgent *FinancialInstitutionIdentification6 `xml:"FrstAgt,omitempty"`
 }
 
+func (c *CancellationRequestInformation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationRequestInformation1) SetOriginalGroupIdentification(value string) {
 	c.OriginalGroupIdentification = (*Max35Text)(&value)
 }
@@ -36,6 +46,11 @@ func (c *CancellationRequestInformation1) SetOriginalCreationDateTime(value stri
 	c.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CancellationRequestInformation1) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	c.OriginalCreationDateTime = new(ISODateTime)
+	c.OriginalCreationDateTime.FromTime(value)
+}
+
 func (c *CancellationRequestInformation1) SetNumberOfInvoiceRequests(value string) {
 	c.NumberOfInvoiceRequests = (*Max15NumericText)(&value)
 }
@@ -44,6 +59,10 @@ func (c *CancellationRequestInformation1) SetTotalBulkInvoiceAmount(value, curre
 	c.TotalBulkInvoiceAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CancellationRequestInformation1) SetTotalBulkInvoiceAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalBulkInvoiceAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CancellationRequestInformation1) SetCancellationReason(value string) {
 	c.CancellationReason = (*Max105Text)(&value)
 }
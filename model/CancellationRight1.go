@@ -10,6 +10,10 @@ type CancellationRight1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CancellationRight1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationRight1) SetStructured(value string) {
 	c.Structured = (*CancellationRight2Code)(&value)
 }
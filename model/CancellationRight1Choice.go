@@ -10,6 +10,10 @@ type CancellationRight1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CancellationRight1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationRight1Choice) SetCode(value string) {
 	c.Code = (*CancellationRight1Code)(&value)
 }
@@ -10,8 +10,16 @@ type CancellationStatus14Choice struct {
 	Reason []*CancellationReason9 `xml:"Rsn"`
 }
 
-func (c *CancellationStatus14Choice) SetNoSpecifiedReason(value string) {
+func (c *CancellationStatus14Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancellationStatus14Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancellationStatus14Choice) AddReason() *CancellationReason9 {
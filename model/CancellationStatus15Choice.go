@@ -10,8 +10,16 @@ type CancellationStatus15Choice struct {
 	Reason []*CancellationReason10 `xml:"Rsn"`
 }
 
-func (c *CancellationStatus15Choice) SetNoSpecifiedReason(value string) {
+func (c *CancellationStatus15Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancellationStatus15Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancellationStatus15Choice) AddReason() *CancellationReason10 {
@@ -10,8 +10,16 @@ type CancellationStatus16Choice struct {
 	Reason []*CancellationReason12 `xml:"Rsn"`
 }
 
-func (c *CancellationStatus16Choice) SetNoSpecifiedReason(value string) {
+func (c *CancellationStatus16Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancellationStatus16Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancellationStatus16Choice) AddReason() *CancellationReason12 {
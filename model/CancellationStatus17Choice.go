@@ -10,8 +10,16 @@ type CancellationStatus17Choice struct {
 	Reason []*CancellationReason14 `xml:"Rsn"`
 }
 
-func (c *CancellationStatus17Choice) SetNoSpecifiedReason(value string) {
+func (c *CancellationStatus17Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancellationStatus17Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancellationStatus17Choice) AddReason() *CancellationReason14 {
@@ -10,8 +10,16 @@ type CancellationStatus18Choice struct {
 	Reason []*CancellationReason15 `xml:"Rsn"`
 }
 
-func (c *CancellationStatus18Choice) SetNoSpecifiedReason(value string) {
+func (c *CancellationStatus18Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancellationStatus18Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancellationStatus18Choice) AddReason() *CancellationReason15 {
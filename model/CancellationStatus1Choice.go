@@ -10,6 +10,10 @@ type CancellationStatus1Choice struct {
 	RejectionStatus *CancellationRejectionStatus1 `xml:"RjctnSts"`
 }
 
+func (c *CancellationStatus1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationStatus1Choice) AddProcessingStatus() *CancellationProcessingStatus1 {
 	c.ProcessingStatus = new(CancellationProcessingStatus1)
 	return c.ProcessingStatus
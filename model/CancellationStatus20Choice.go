@@ -10,8 +10,16 @@ type CancellationStatus20Choice struct {
 	Reason []*CancellationReason18 `xml:"Rsn"`
 }
 
-func (c *CancellationStatus20Choice) SetNoSpecifiedReason(value string) {
+func (c *CancellationStatus20Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancellationStatus20Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancellationStatus20Choice) AddReason() *CancellationReason18 {
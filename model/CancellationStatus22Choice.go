@@ -10,6 +10,10 @@ type CancellationStatus22Choice struct {
 	Rejected *RejectedStatus10 `xml:"Rjctd"`
 }
 
+func (c *CancellationStatus22Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationStatus22Choice) SetStatus(value string) {
 	c.Status = (*OrderCancellationStatus2Code)(&value)
 }
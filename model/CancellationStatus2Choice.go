@@ -10,6 +10,10 @@ type CancellationStatus2Choice struct {
 	RejectionStatus *AdditionalStatus2 `xml:"RjctnSts"`
 }
 
+func (c *CancellationStatus2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationStatus2Choice) AddProcessingStatus() *CancellationProcessingStatus1 {
 	c.ProcessingStatus = new(CancellationProcessingStatus1)
 	return c.ProcessingStatus
@@ -10,8 +10,16 @@ type CancellationStatus3Choice struct {
 	Reason []*CancellationReason2 `xml:"Rsn,omitempty"`
 }
 
-func (c *CancellationStatus3Choice) SetNoSpecifiedReason(value string) {
+func (c *CancellationStatus3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancellationStatus3Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancellationStatus3Choice) AddReason() *CancellationReason2 {
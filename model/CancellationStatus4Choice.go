@@ -10,8 +10,16 @@ type CancellationStatus4Choice struct {
 	Reason []*CancellationReason1 `xml:"Rsn,omitempty"`
 }
 
-func (c *CancellationStatus4Choice) SetNoSpecifiedReason(value string) {
+func (c *CancellationStatus4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancellationStatus4Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancellationStatus4Choice) AddReason() *CancellationReason1 {
@@ -10,8 +10,16 @@ type CancellationStatus7Choice struct {
 	Reason []*CancellationReason5 `xml:"Rsn"`
 }
 
-func (c *CancellationStatus7Choice) SetNoSpecifiedReason(value string) {
+func (c *CancellationStatus7Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancellationStatus7Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancellationStatus7Choice) AddReason() *CancellationReason5 {
@@ -10,8 +10,16 @@ type CancellationStatus8Choice struct {
 	Reason []*CancellationReason1 `xml:"Rsn"`
 }
 
-func (c *CancellationStatus8Choice) SetNoSpecifiedReason(value string) {
+func (c *CancellationStatus8Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancellationStatus8Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancellationStatus8Choice) AddReason() *CancellationReason1 {
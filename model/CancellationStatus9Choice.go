@@ -10,8 +10,16 @@ type CancellationStatus9Choice struct {
 	Reason []*CancellationReason2 `xml:"Rsn"`
 }
 
-func (c *CancellationStatus9Choice) SetNoSpecifiedReason(value string) {
+func (c *CancellationStatus9Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancellationStatus9Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancellationStatus9Choice) AddReason() *CancellationReason2 {
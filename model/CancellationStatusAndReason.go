@@ -16,6 +16,10 @@ type CancellationStatusAndReason struct {
 	StatusInitiator *PartyIdentification1Choice `xml:"StsInitr,omitempty"`
 }
 
+func (c *CancellationStatusAndReason) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationStatusAndReason) AddStatus() *TransferCancellationStatus {
 	c.Status = new(TransferCancellationStatus)
 	return c.Status
@@ -31,6 +31,10 @@ type CancellationStatusAndReason2 struct {
 	StatusInitiator *PartyIdentification2Choice `xml:"StsInitr,omitempty"`
 }
 
+func (c *CancellationStatusAndReason2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationStatusAndReason2) SetMasterReference(value string) {
 	c.MasterReference = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type CancellationStatusAndReason3 struct {
 	StatusInitiator *PartyIdentification70Choice `xml:"StsInitr,omitempty"`
 }
 
+func (c *CancellationStatusAndReason3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationStatusAndReason3) SetMasterReference(value string) {
 	c.MasterReference = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type CancellationStatusInformation1 struct {
 	AdditionalStatusReasonInformation []*Max105Text `xml:"AddtlStsRsnInf,omitempty"`
 }
 
+func (c *CancellationStatusInformation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationStatusInformation1) SetStatus(value string) {
 	c.Status = (*CancellationStatus4Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancellationStatusReason1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *CancellationStatusReason1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationStatusReason1Choice) SetCode(value string) {
 	c.Code = (*PaymentCancellationRejection1Code)(&value)
 }
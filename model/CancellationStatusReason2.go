@@ -13,6 +13,10 @@ type CancellationStatusReason2 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CancellationStatusReason2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationStatusReason2) AddOriginator() *PartyIdentification43 {
 	c.Originator = new(PartyIdentification43)
 	return c.Originator
@@ -10,6 +10,10 @@ type CancellationStatusReason2Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *CancellationStatusReason2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationStatusReason2Choice) SetCode(value string) {
 	c.Code = (*PaymentCancellationRejection2Code)(&value)
 }
@@ -13,6 +13,10 @@ type CancellationStatusReasonInformation1 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CancellationStatusReasonInformation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancellationStatusReasonInformation1) AddOriginator() *PartyIdentification32 {
 	c.Originator = new(PartyIdentification32)
 	return c.Originator
@@ -10,6 +10,10 @@ type CancelledCompleteReason1 struct {
 	AdditionalReasonInformation *Max350Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancelledCompleteReason1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledCompleteReason1) AddReason() *CancellationCompleteReason1Choice {
 	c.Reason = new(CancellationCompleteReason1Choice)
 	return c.Reason
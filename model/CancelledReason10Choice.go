@@ -10,6 +10,10 @@ type CancelledReason10Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CancelledReason10Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledReason10Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason5Code)(&value)
 }
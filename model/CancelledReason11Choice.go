@@ -10,6 +10,10 @@ type CancelledReason11Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CancelledReason11Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledReason11Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason6Code)(&value)
 }
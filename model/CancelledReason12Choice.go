@@ -13,6 +13,10 @@ type CancelledReason12Choice struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (c *CancelledReason12Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledReason12Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason2Code)(&value)
 }
@@ -22,6 +26,10 @@ func (c *CancelledReason12Choice) AddProprietary() *GenericIdentification1 {
 	return c.Proprietary
 }
 
-func (c *CancelledReason12Choice) SetNoSpecifiedReason(value string) {
+func (c *CancelledReason12Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
@@ -10,6 +10,10 @@ type CancelledReason1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CancelledReason1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledReason1Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason6Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancelledReason3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CancelledReason3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledReason3Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason11Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancelledReason5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CancelledReason5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledReason5Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason5Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancelledReason8Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CancelledReason8Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledReason8Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason6Code)(&value)
 }
@@ -10,6 +10,10 @@ type CancelledReason9Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CancelledReason9Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledReason9Choice) SetCode(value string) {
 	c.Code = (*CancelledStatusReason5Code)(&value)
 }
@@ -13,8 +13,16 @@ type CancelledStatus1 struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
-func (c *CancelledStatus1) SetNoReason(value string) {
+func (c *CancelledStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancelledStatus1) SetNoReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancelledStatus1) AddReason() *CancelledStatusReason1 {
@@ -10,8 +10,16 @@ type CancelledStatus10Choice struct {
 	Reason *CancellationReason11 `xml:"Rsn"`
 }
 
-func (c *CancelledStatus10Choice) SetNoSpecifiedReason(value string) {
+func (c *CancelledStatus10Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancelledStatus10Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancelledStatus10Choice) AddReason() *CancellationReason11 {
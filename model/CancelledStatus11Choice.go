@@ -10,8 +10,16 @@ type CancelledStatus11Choice struct {
 	Reason []*CancelledStatusReason12 `xml:"Rsn"`
 }
 
-func (c *CancelledStatus11Choice) SetNoSpecifiedReason(value string) {
+func (c *CancelledStatus11Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancelledStatus11Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancelledStatus11Choice) AddReason() *CancelledStatusReason12 {
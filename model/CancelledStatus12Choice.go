@@ -10,8 +10,16 @@ type CancelledStatus12Choice struct {
 	Reason []*CancelledStatusReason11 `xml:"Rsn"`
 }
 
-func (c *CancelledStatus12Choice) SetNoSpecifiedReason(value string) {
+func (c *CancelledStatus12Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancelledStatus12Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancelledStatus12Choice) AddReason() *CancelledStatusReason11 {
@@ -16,8 +16,16 @@ type CancelledStatus13Choice struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
-func (c *CancelledStatus13Choice) SetNoSpecifiedReason(value string) {
+func (c *CancelledStatus13Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancelledStatus13Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancelledStatus13Choice) SetReason(value string) {
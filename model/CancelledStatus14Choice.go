@@ -10,8 +10,16 @@ type CancelledStatus14Choice struct {
 	Reason []*CancelledStatusReason13 `xml:"Rsn"`
 }
 
-func (c *CancelledStatus14Choice) SetNoSpecifiedReason(value string) {
+func (c *CancelledStatus14Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancelledStatus14Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancelledStatus14Choice) AddReason() *CancelledStatusReason13 {
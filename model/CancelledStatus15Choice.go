@@ -10,8 +10,16 @@ type CancelledStatus15Choice struct {
 	Reason []*CancelledStatusReason14 `xml:"Rsn"`
 }
 
-func (c *CancelledStatus15Choice) SetNoSpecifiedReason(value string) {
+func (c *CancelledStatus15Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancelledStatus15Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancelledStatus15Choice) AddReason() *CancelledStatusReason14 {
@@ -10,8 +10,16 @@ type CancelledStatus16Choice struct {
 	Reason *CancellationReason16 `xml:"Rsn"`
 }
 
-func (c *CancelledStatus16Choice) SetNoSpecifiedReason(value string) {
+func (c *CancelledStatus16Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancelledStatus16Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancelledStatus16Choice) AddReason() *CancellationReason16 {
@@ -10,8 +10,16 @@ type CancelledStatus1Choice struct {
 	Reason []*CancelledStatusReason4 `xml:"Rsn"`
 }
 
-func (c *CancelledStatus1Choice) SetNoSpecifiedReason(value string) {
+func (c *CancelledStatus1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancelledStatus1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancelledStatus1Choice) AddReason() *CancelledStatusReason4 {
@@ -16,6 +16,10 @@ type CancelledStatus2 struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (c *CancelledStatus2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledStatus2) SetReason(value string) {
 	c.Reason = (*CancelledStatusReason2Code)(&value)
 }
@@ -29,6 +33,10 @@ func (c *CancelledStatus2) AddDataSourceScheme() *GenericIdentification1 {
 	return c.DataSourceScheme
 }
 
-func (c *CancelledStatus2) SetNoSpecifiedReason(value string) {
+func (c *CancelledStatus2) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
@@ -16,8 +16,16 @@ type CancelledStatus3 struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
-func (c *CancelledStatus3) SetNoSpecifiedReason(value string) {
+func (c *CancelledStatus3) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancelledStatus3) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancelledStatus3) SetReason(value string) {
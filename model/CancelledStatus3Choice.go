@@ -10,8 +10,16 @@ type CancelledStatus3Choice struct {
 	Reason []*CancelledStatusReason6 `xml:"Rsn"`
 }
 
-func (c *CancelledStatus3Choice) SetNoSpecifiedReason(value string) {
+func (c *CancelledStatus3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancelledStatus3Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancelledStatus3Choice) AddReason() *CancelledStatusReason6 {
@@ -10,8 +10,16 @@ type CancelledStatus5Choice struct {
 	Reason *CancellationReason7 `xml:"Rsn"`
 }
 
-func (c *CancelledStatus5Choice) SetNoSpecifiedReason(value string) {
+func (c *CancelledStatus5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancelledStatus5Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancelledStatus5Choice) AddReason() *CancellationReason7 {
@@ -10,8 +10,16 @@ type CancelledStatus7Choice struct {
 	Reason []*CancelledStatusReason8 `xml:"Rsn"`
 }
 
-func (c *CancelledStatus7Choice) SetNoSpecifiedReason(value string) {
+func (c *CancelledStatus7Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CancelledStatus7Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *CancelledStatus7Choice) AddReason() *CancelledStatusReason8 {
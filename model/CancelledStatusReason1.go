@@ -7,6 +7,10 @@ type CancelledStatusReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf"`
 }
 
+func (c *CancelledStatusReason1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledStatusReason1) SetAdditionalInformation(value string) {
 	c.AdditionalInformation = (*Max350Text)(&value)
 }
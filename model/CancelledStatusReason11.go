@@ -10,6 +10,10 @@ type CancelledStatusReason11 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancelledStatusReason11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledStatusReason11) AddReasonCode() *CancelledReason8Choice {
 	c.ReasonCode = new(CancelledReason8Choice)
 	return c.ReasonCode
@@ -10,6 +10,10 @@ type CancelledStatusReason12 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancelledStatusReason12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledStatusReason12) AddReasonCode() *CancelledReason9Choice {
 	c.ReasonCode = new(CancelledReason9Choice)
 	return c.ReasonCode
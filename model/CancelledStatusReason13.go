@@ -10,6 +10,10 @@ type CancelledStatusReason13 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancelledStatusReason13) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledStatusReason13) AddReasonCode() *CancelledReason10Choice {
 	c.ReasonCode = new(CancelledReason10Choice)
 	return c.ReasonCode
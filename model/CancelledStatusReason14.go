@@ -10,6 +10,10 @@ type CancelledStatusReason14 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancelledStatusReason14) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledStatusReason14) AddReasonCode() *CancelledReason11Choice {
 	c.ReasonCode = new(CancelledReason11Choice)
 	return c.ReasonCode
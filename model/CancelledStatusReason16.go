@@ -10,6 +10,10 @@ type CancelledStatusReason16 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CancelledStatusReason16) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledStatusReason16) AddReason() *CancelledReason12Choice {
 	c.Reason = new(CancelledReason12Choice)
 	return c.Reason
@@ -10,6 +10,10 @@ type CancelledStatusReason4 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancelledStatusReason4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledStatusReason4) AddReasonCode() *CancelledReason1Choice {
 	c.ReasonCode = new(CancelledReason1Choice)
 	return c.ReasonCode
@@ -10,6 +10,10 @@ type CancelledStatusReason6 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancelledStatusReason6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledStatusReason6) AddReasonCode() *CancelledReason3Choice {
 	c.ReasonCode = new(CancelledReason3Choice)
 	return c.ReasonCode
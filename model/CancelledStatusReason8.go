@@ -10,6 +10,10 @@ type CancelledStatusReason8 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CancelledStatusReason8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CancelledStatusReason8) AddReasonCode() *CancelledReason5Choice {
 	c.ReasonCode = new(CancelledReason5Choice)
 	return c.ReasonCode
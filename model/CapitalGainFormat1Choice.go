@@ -10,6 +10,10 @@ type CapitalGainFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CapitalGainFormat1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CapitalGainFormat1Choice) SetCode(value string) {
 	c.Code = (*EUCapitalGain2Code)(&value)
 }
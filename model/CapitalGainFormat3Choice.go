@@ -10,6 +10,10 @@ type CapitalGainFormat3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CapitalGainFormat3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CapitalGainFormat3Choice) SetCode(value string) {
 	c.Code = (*EUCapitalGain2Code)(&value)
 }
@@ -10,6 +10,10 @@ type CapitalGainFormat4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CapitalGainFormat4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CapitalGainFormat4Choice) SetCode(value string) {
 	c.Code = (*EUCapitalGain2Code)(&value)
 }
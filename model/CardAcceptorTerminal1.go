@@ -14,6 +14,10 @@ type CardAcceptorTerminal1 struct {
 	Capabilities *PointOfInteractionCapabilities4 `xml:"Cpblties"`
 }
 
+func (c *CardAcceptorTerminal1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAcceptorTerminal1) AddIdentification() *GenericIdentification32 {
 	c.Identification = new(GenericIdentification32)
 	return c.Identification
@@ -23,6 +23,10 @@ type CardAccount1 struct {
 	Servicer *PartyIdentification72Choice `xml:"Svcr,omitempty"`
 }
 
+func (c *CardAccount1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAccount1) SetSelectionMethod(value string) {
 	c.SelectionMethod = (*AccountChoiceMethod1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Customer account information.
 type CardAccount10 struct {
 
@@ -44,10 +48,19 @@ type CardAccount10 struct {
 	Limits *ATMTransactionAmounts8 `xml:"Lmts,omitempty"`
 }
 
+func (c *CardAccount10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAccount10) SetAccountSequenceNumber(value string) {
 	c.AccountSequenceNumber = (*Number)(&value)
 }
 
+func (c *CardAccount10) SetAccountSequenceNumberFromDecimal(value decimal.Decimal) {
+	c.AccountSequenceNumber = new(Number)
+	c.AccountSequenceNumber.FromDecimal(value)
+}
+
 func (c *CardAccount10) SetSelectionMethod(value string) {
 	c.SelectionMethod = (*AccountChoiceMethod1Code)(&value)
 }
@@ -92,10 +105,20 @@ func (c *CardAccount10) SetBalanceDisplayFlag(value string) {
 	c.BalanceDisplayFlag = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardAccount10) SetBalanceDisplayFlagFromBool(value bool) {
+	c.BalanceDisplayFlag = new(TrueFalseIndicator)
+	c.BalanceDisplayFlag.FromBool(value)
+}
+
 func (c *CardAccount10) SetDefaultAccountIndicator(value string) {
 	c.DefaultAccountIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardAccount10) SetDefaultAccountIndicatorFromBool(value bool) {
+	c.DefaultAccountIndicator = new(TrueFalseIndicator)
+	c.DefaultAccountIndicator.FromBool(value)
+}
+
 func (c *CardAccount10) AddLimits() *ATMTransactionAmounts8 {
 	c.Limits = new(ATMTransactionAmounts8)
 	return c.Limits
@@ -26,6 +26,10 @@ type CardAccount11 struct {
 	Servicer *PartyIdentification72Choice `xml:"Svcr,omitempty"`
 }
 
+func (c *CardAccount11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAccount11) SetSelectionMethod(value string) {
 	c.SelectionMethod = (*AccountChoiceMethod1Code)(&value)
 }
@@ -35,6 +35,10 @@ type CardAccount12 struct {
 	AllowedService []*ATMService19 `xml:"AllwdSvc,omitempty"`
 }
 
+func (c *CardAccount12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAccount12) SetAccountType(value string) {
 	c.AccountType = (*CardAccountType3Code)(&value)
 }
@@ -70,10 +74,20 @@ func (c *CardAccount12) SetBalanceDisplayFlag(value string) {
 	c.BalanceDisplayFlag = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardAccount12) SetBalanceDisplayFlagFromBool(value bool) {
+	c.BalanceDisplayFlag = new(TrueFalseIndicator)
+	c.BalanceDisplayFlag.FromBool(value)
+}
+
 func (c *CardAccount12) SetDefaultAccountIndicator(value string) {
 	c.DefaultAccountIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardAccount12) SetDefaultAccountIndicatorFromBool(value bool) {
+	c.DefaultAccountIndicator = new(TrueFalseIndicator)
+	c.DefaultAccountIndicator.FromBool(value)
+}
+
 func (c *CardAccount12) AddAllowedService() *ATMService19 {
 	newValue := new(ATMService19)
 	c.AllowedService = append(c.AllowedService, newValue)
@@ -29,6 +29,10 @@ type CardAccount13 struct {
 	BalanceAfter *AmountAndDirection43 `xml:"BalAftr,omitempty"`
 }
 
+func (c *CardAccount13) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAccount13) SetAccountType(value string) {
 	c.AccountType = (*CardAccountType3Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Account involved in the card transaction.
 type CardAccount2 struct {
 
@@ -29,6 +33,10 @@ type CardAccount2 struct {
 	BalanceDate *ISODate `xml:"BalDt,omitempty"`
 }
 
+func (c *CardAccount2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAccount2) SetSelectedAccountType(value string) {
 	c.SelectedAccountType = (*CardAccountType2Code)(&value)
 }
@@ -64,3 +72,8 @@ func (c *CardAccount2) AddBalance() *AmountAndDirection41 {
 func (c *CardAccount2) SetBalanceDate(value string) {
 	c.BalanceDate = (*ISODate)(&value)
 }
+
+func (c *CardAccount2) SetBalanceDateFromTime(value time.Time) {
+	c.BalanceDate = new(ISODate)
+	c.BalanceDate.FromTime(value)
+}
@@ -26,6 +26,10 @@ type CardAccount3 struct {
 	Servicer *PartyIdentification72Choice `xml:"Svcr,omitempty"`
 }
 
+func (c *CardAccount3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAccount3) SetSelectionMethod(value string) {
 	c.SelectionMethod = (*AccountChoiceMethod1Code)(&value)
 }
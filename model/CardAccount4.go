@@ -38,6 +38,10 @@ type CardAccount4 struct {
 	DefaultAccountIndicator *TrueFalseIndicator `xml:"DfltAcctInd,omitempty"`
 }
 
+func (c *CardAccount4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAccount4) SetSelectionMethod(value string) {
 	c.SelectionMethod = (*AccountChoiceMethod1Code)(&value)
 }
@@ -82,6 +86,16 @@ func (c *CardAccount4) SetBalanceDisplayFlag(value string) {
 	c.BalanceDisplayFlag = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardAccount4) SetBalanceDisplayFlagFromBool(value bool) {
+	c.BalanceDisplayFlag = new(TrueFalseIndicator)
+	c.BalanceDisplayFlag.FromBool(value)
+}
+
 func (c *CardAccount4) SetDefaultAccountIndicator(value string) {
 	c.DefaultAccountIndicator = (*TrueFalseIndicator)(&value)
 }
+
+func (c *CardAccount4) SetDefaultAccountIndicatorFromBool(value bool) {
+	c.DefaultAccountIndicator = new(TrueFalseIndicator)
+	c.DefaultAccountIndicator.FromBool(value)
+}
@@ -26,6 +26,10 @@ type CardAccount5 struct {
 	Servicer *PartyIdentification72Choice `xml:"Svcr,omitempty"`
 }
 
+func (c *CardAccount5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAccount5) SetSelectionMethod(value string) {
 	c.SelectionMethod = (*AccountChoiceMethod1Code)(&value)
 }
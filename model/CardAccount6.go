@@ -41,6 +41,10 @@ type CardAccount6 struct {
 	DepositLimits *ATMTransactionAmounts5 `xml:"DpstLmts,omitempty"`
 }
 
+func (c *CardAccount6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAccount6) SetAccountType(value string) {
 	c.AccountType = (*CardAccountType2Code)(&value)
 }
@@ -76,10 +80,20 @@ func (c *CardAccount6) SetBalanceDisplayFlag(value string) {
 	c.BalanceDisplayFlag = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardAccount6) SetBalanceDisplayFlagFromBool(value bool) {
+	c.BalanceDisplayFlag = new(TrueFalseIndicator)
+	c.BalanceDisplayFlag.FromBool(value)
+}
+
 func (c *CardAccount6) SetDefaultAccountIndicator(value string) {
 	c.DefaultAccountIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardAccount6) SetDefaultAccountIndicatorFromBool(value bool) {
+	c.DefaultAccountIndicator = new(TrueFalseIndicator)
+	c.DefaultAccountIndicator.FromBool(value)
+}
+
 func (c *CardAccount6) AddAllowedServices(value string) {
 	c.AllowedServices = append(c.AllowedServices, (*ATMServiceType4Code)(&value))
 }
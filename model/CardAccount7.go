@@ -26,6 +26,10 @@ type CardAccount7 struct {
 	Servicer *PartyIdentification72Choice `xml:"Svcr,omitempty"`
 }
 
+func (c *CardAccount7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAccount7) SetSelectionMethod(value string) {
 	c.SelectionMethod = (*AccountChoiceMethod1Code)(&value)
 }
@@ -38,6 +38,10 @@ type CardAccount8 struct {
 	DefaultAccountIndicator *TrueFalseIndicator `xml:"DfltAcctInd,omitempty"`
 }
 
+func (c *CardAccount8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAccount8) SetSelectionMethod(value string) {
 	c.SelectionMethod = (*AccountChoiceMethod1Code)(&value)
 }
@@ -82,6 +86,16 @@ func (c *CardAccount8) SetBalanceDisplayFlag(value string) {
 	c.BalanceDisplayFlag = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardAccount8) SetBalanceDisplayFlagFromBool(value bool) {
+	c.BalanceDisplayFlag = new(TrueFalseIndicator)
+	c.BalanceDisplayFlag.FromBool(value)
+}
+
 func (c *CardAccount8) SetDefaultAccountIndicator(value string) {
 	c.DefaultAccountIndicator = (*TrueFalseIndicator)(&value)
 }
+
+func (c *CardAccount8) SetDefaultAccountIndicatorFromBool(value bool) {
+	c.DefaultAccountIndicator = new(TrueFalseIndicator)
+	c.DefaultAccountIndicator.FromBool(value)
+}
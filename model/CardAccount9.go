@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Customer account information.
 type CardAccount9 struct {
 
@@ -32,10 +36,19 @@ type CardAccount9 struct {
 	Servicer *PartyIdentification72Choice `xml:"Svcr,omitempty"`
 }
 
+func (c *CardAccount9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAccount9) SetAccountSequenceNumber(value string) {
 	c.AccountSequenceNumber = (*Number)(&value)
 }
 
+func (c *CardAccount9) SetAccountSequenceNumberFromDecimal(value decimal.Decimal) {
+	c.AccountSequenceNumber = new(Number)
+	c.AccountSequenceNumber.FromDecimal(value)
+}
+
 func (c *CardAccount9) SetSelectionMethod(value string) {
 	c.SelectionMethod = (*AccountChoiceMethod1Code)(&value)
 }
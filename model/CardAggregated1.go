@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Globalised card transaction entry details.
 type CardAggregated1 struct {
 
@@ -19,6 +21,10 @@ type CardAggregated1 struct {
 	TransactionDateRange *DateOrDateTimePeriodChoice `xml:"TxDtRg,omitempty"`
 }
 
+func (c *CardAggregated1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAggregated1) SetAdditionalService(value string) {
 	c.AdditionalService = (*CardPaymentServiceType2Code)(&value)
 }
@@ -40,3 +46,71 @@ func (c *CardAggregated1) AddTransactionDateRange() *DateOrDateTimePeriodChoice
 	c.TransactionDateRange = new(DateOrDateTimePeriodChoice)
 	return c.TransactionDateRange
 }
+
+func (c *CardAggregated1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.AdditionalService != nil {
+		enc.Element("AddtlSvc", string(*c.AdditionalService))
+	}
+	if c.TransactionCategory != nil {
+		enc.Element("TxCtgy", string(*c.TransactionCategory))
+	}
+	if c.SaleReconciliationIdentification != nil {
+		enc.Element("SaleRcncltnId", string(*c.SaleReconciliationIdentification))
+	}
+	if c.SequenceNumberRange != nil {
+		c.SequenceNumberRange.EncodeXMLFast(enc, "SeqNbRg")
+	}
+	if c.TransactionDateRange != nil {
+		c.TransactionDateRange.EncodeXMLFast(enc, "TxDtRg")
+	}
+	enc.End(tag)
+}
+
+func (c *CardAggregated1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "AddtlSvc":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.AdditionalService = (*CardPaymentServiceType2Code)(&value)
+		case "TxCtgy":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.TransactionCategory = (*ExternalCardTransactionCategory1Code)(&value)
+		case "SaleRcncltnId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.SaleReconciliationIdentification = (*Max35Text)(&value)
+		case "SeqNbRg":
+			elem := new(CardSequenceNumberRange1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.SequenceNumberRange = elem
+		case "TxDtRg":
+			elem := new(DateOrDateTimePeriodChoice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.TransactionDateRange = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Globalised card transaction entry details.
+type CardAggregated2 struct {
+
+	// Service in addition to the main service.
+	AdditionalService *CardPaymentServiceType2Code `xml:"AddtlSvc,omitempty"`
+
+	// Category code conform to ISO 18245, related to the type of services or goods the merchant provides for the transaction.
+	TransactionCategory *ExternalCardTransactionCategory1Code `xml:"TxCtgy,omitempty"`
+
+	// Unique identification of the sales reconciliation period between the acceptor and the acquirer. This identification might be linked to the identification of the settlement for further verification by the merchant.
+	SaleReconciliationIdentification *Max35Text `xml:"SaleRcncltnId,omitempty"`
+
+	// Range of sequence numbers on which the globalisation applies.
+	SequenceNumberRange *CardSequenceNumberRange1 `xml:"SeqNbRg,omitempty"`
+
+	// Date range on which the globalisation applies.
+	TransactionDateRange *DateOrDateTimePeriod1Choice `xml:"TxDtRg,omitempty"`
+}
+
+func (c *CardAggregated2) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CardAggregated2) SetAdditionalService(value string) {
+	c.AdditionalService = (*CardPaymentServiceType2Code)(&value)
+}
+
+func (c *CardAggregated2) SetTransactionCategory(value string) {
+	c.TransactionCategory = (*ExternalCardTransactionCategory1Code)(&value)
+}
+
+func (c *CardAggregated2) SetSaleReconciliationIdentification(value string) {
+	c.SaleReconciliationIdentification = (*Max35Text)(&value)
+}
+
+func (c *CardAggregated2) AddSequenceNumberRange() *CardSequenceNumberRange1 {
+	c.SequenceNumberRange = new(CardSequenceNumberRange1)
+	return c.SequenceNumberRange
+}
+
+func (c *CardAggregated2) AddTransactionDateRange() *DateOrDateTimePeriod1Choice {
+	c.TransactionDateRange = new(DateOrDateTimePeriod1Choice)
+	return c.TransactionDateRange
+}
+
+func (c *CardAggregated2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.AdditionalService != nil {
+		enc.Element("AddtlSvc", string(*c.AdditionalService))
+	}
+	if c.TransactionCategory != nil {
+		enc.Element("TxCtgy", string(*c.TransactionCategory))
+	}
+	if c.SaleReconciliationIdentification != nil {
+		enc.Element("SaleRcncltnId", string(*c.SaleReconciliationIdentification))
+	}
+	if c.SequenceNumberRange != nil {
+		c.SequenceNumberRange.EncodeXMLFast(enc, "SeqNbRg")
+	}
+	if c.TransactionDateRange != nil {
+		c.TransactionDateRange.EncodeXMLFast(enc, "TxDtRg")
+	}
+	enc.End(tag)
+}
+
+func (c *CardAggregated2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "AddtlSvc":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.AdditionalService = (*CardPaymentServiceType2Code)(&value)
+		case "TxCtgy":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.TransactionCategory = (*ExternalCardTransactionCategory1Code)(&value)
+		case "SaleRcncltnId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.SaleReconciliationIdentification = (*Max35Text)(&value)
+		case "SeqNbRg":
+			elem := new(CardSequenceNumberRange1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.SequenceNumberRange = elem
+		case "TxDtRg":
+			elem := new(DateOrDateTimePeriod1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.TransactionDateRange = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the detailed or original amount and currency.
 type CardAmountAndCurrencyExchange1 struct {
 
@@ -13,10 +17,18 @@ type CardAmountAndCurrencyExchange1 struct {
 	Type *TypeOfAmount3Code `xml:"Tp,omitempty"`
 }
 
+func (c *CardAmountAndCurrencyExchange1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardAmountAndCurrencyExchange1) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CardAmountAndCurrencyExchange1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardAmountAndCurrencyExchange1) AddCurrencyExchange() *CurrencyExchange3 {
 	c.CurrencyExchange = new(CurrencyExchange3)
 	return c.CurrencyExchange
@@ -13,6 +13,10 @@ type CardEntry1 struct {
 	AggregatedEntry *CardAggregated1 `xml:"AggtdNtry,omitempty"`
 }
 
+func (c *CardEntry1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardEntry1) AddCard() *PaymentCard4 {
 	c.Card = new(PaymentCard4)
 	return c.Card
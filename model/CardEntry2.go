@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Card transaction entry.
 type CardEntry2 struct {
 
@@ -16,6 +18,10 @@ type CardEntry2 struct {
 	PrePaidAccount *CashAccount24 `xml:"PrePdAcct,omitempty"`
 }
 
+func (c *CardEntry2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardEntry2) AddCard() *PaymentCard4 {
 	c.Card = new(PaymentCard4)
 	return c.Card
@@ -35,3 +41,62 @@ func (c *CardEntry2) AddPrePaidAccount() *CashAccount24 {
 	c.PrePaidAccount = new(CashAccount24)
 	return c.PrePaidAccount
 }
+
+func (c *CardEntry2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Card != nil {
+		c.Card.EncodeXMLFast(enc, "Card")
+	}
+	if c.POI != nil {
+		c.POI.EncodeXMLFast(enc, "POI")
+	}
+	if c.AggregatedEntry != nil {
+		c.AggregatedEntry.EncodeXMLFast(enc, "AggtdNtry")
+	}
+	if c.PrePaidAccount != nil {
+		c.PrePaidAccount.EncodeXMLFast(enc, "PrePdAcct")
+	}
+	enc.End(tag)
+}
+
+func (c *CardEntry2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Card":
+			elem := new(PaymentCard4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Card = elem
+		case "POI":
+			elem := new(PointOfInteraction1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.POI = elem
+		case "AggtdNtry":
+			elem := new(CardAggregated1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.AggregatedEntry = elem
+		case "PrePdAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PrePaidAccount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Card transaction entry.
+type CardEntry4 struct {
+
+	// Electronic money product that provides the cardholder with a portable and specialised computer device, which typically contains a microprocessor.
+	Card *PaymentCard4 `xml:"Card,omitempty"`
+
+	// Physical or logical card payment terminal containing software and hardware components.
+	POI *PointOfInteraction1 `xml:"POI,omitempty"`
+
+	// Card entry details, based on card transaction aggregated data performed by the account servicer.
+	AggregatedEntry *CardAggregated2 `xml:"AggtdNtry,omitempty"`
+
+	// Prepaid account for the transfer or loading of an amount of money.
+	PrePaidAccount *CashAccount38 `xml:"PrePdAcct,omitempty"`
+}
+
+func (c *CardEntry4) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CardEntry4) AddCard() *PaymentCard4 {
+	c.Card = new(PaymentCard4)
+	return c.Card
+}
+
+func (c *CardEntry4) AddPOI() *PointOfInteraction1 {
+	c.POI = new(PointOfInteraction1)
+	return c.POI
+}
+
+func (c *CardEntry4) AddAggregatedEntry() *CardAggregated2 {
+	c.AggregatedEntry = new(CardAggregated2)
+	return c.AggregatedEntry
+}
+
+func (c *CardEntry4) AddPrePaidAccount() *CashAccount38 {
+	c.PrePaidAccount = new(CashAccount38)
+	return c.PrePaidAccount
+}
+
+func (c *CardEntry4) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Card != nil {
+		c.Card.EncodeXMLFast(enc, "Card")
+	}
+	if c.POI != nil {
+		c.POI.EncodeXMLFast(enc, "POI")
+	}
+	if c.AggregatedEntry != nil {
+		c.AggregatedEntry.EncodeXMLFast(enc, "AggtdNtry")
+	}
+	if c.PrePaidAccount != nil {
+		c.PrePaidAccount.EncodeXMLFast(enc, "PrePdAcct")
+	}
+	enc.End(tag)
+}
+
+func (c *CardEntry4) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Card":
+			elem := new(PaymentCard4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Card = elem
+		case "POI":
+			elem := new(PointOfInteraction1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.POI = elem
+		case "AggtdNtry":
+			elem := new(CardAggregated2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.AggregatedEntry = elem
+		case "PrePdAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PrePaidAccount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Individual card transaction entry details.
 type CardIndividualTransaction1 struct {
 
@@ -33,6 +37,10 @@ type CardIndividualTransaction1 struct {
 	ValidationSequenceNumber *Max35Text `xml:"VldtnSeqNb,omitempty"`
 }
 
+func (c *CardIndividualTransaction1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardIndividualTransaction1) SetAdditionalService(value string) {
 	c.AdditionalService = (*CardPaymentServiceType2Code)(&value)
 }
@@ -67,6 +75,11 @@ func (c *CardIndividualTransaction1) SetValidationDate(value string) {
 	c.ValidationDate = (*ISODate)(&value)
 }
 
+func (c *CardIndividualTransaction1) SetValidationDateFromTime(value time.Time) {
+	c.ValidationDate = new(ISODate)
+	c.ValidationDate.FromTime(value)
+}
+
 func (c *CardIndividualTransaction1) SetValidationSequenceNumber(value string) {
 	c.ValidationSequenceNumber = (*Max35Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Individual card transaction entry details.
 type CardIndividualTransaction2 struct {
 
@@ -43,6 +49,10 @@ type CardIndividualTransaction2 struct {
 	ValidationSequenceNumber *Max35Text `xml:"VldtnSeqNb,omitempty"`
 }
 
+func (c *CardIndividualTransaction2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardIndividualTransaction2) SetICCRelatedData(value string) {
 	c.ICCRelatedData = (*Max1025Text)(&value)
 }
@@ -90,6 +100,142 @@ func (c *CardIndividualTransaction2) SetValidationDate(value string) {
 	c.ValidationDate = (*ISODate)(&value)
 }
 
+func (c *CardIndividualTransaction2) SetValidationDateFromTime(value time.Time) {
+	c.ValidationDate = new(ISODate)
+	c.ValidationDate.FromTime(value)
+}
+
 func (c *CardIndividualTransaction2) SetValidationSequenceNumber(value string) {
 	c.ValidationSequenceNumber = (*Max35Text)(&value)
 }
+
+func (c *CardIndividualTransaction2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.ICCRelatedData != nil {
+		enc.Element("ICCRltdData", string(*c.ICCRelatedData))
+	}
+	if c.PaymentContext != nil {
+		c.PaymentContext.EncodeXMLFast(enc, "PmtCntxt")
+	}
+	if c.AdditionalService != nil {
+		enc.Element("AddtlSvc", string(*c.AdditionalService))
+	}
+	if c.TransactionCategory != nil {
+		enc.Element("TxCtgy", string(*c.TransactionCategory))
+	}
+	if c.SaleReconciliationIdentification != nil {
+		enc.Element("SaleRcncltnId", string(*c.SaleReconciliationIdentification))
+	}
+	if c.SaleReferenceNumber != nil {
+		enc.Element("SaleRefNb", string(*c.SaleReferenceNumber))
+	}
+	if c.RePresentmentReason != nil {
+		enc.Element("RePresntmntRsn", string(*c.RePresentmentReason))
+	}
+	if c.SequenceNumber != nil {
+		enc.Element("SeqNb", string(*c.SequenceNumber))
+	}
+	if c.TransactionIdentification != nil {
+		c.TransactionIdentification.EncodeXMLFast(enc, "TxId")
+	}
+	if c.Product != nil {
+		c.Product.EncodeXMLFast(enc, "Pdct")
+	}
+	if c.ValidationDate != nil {
+		enc.Element("VldtnDt", string(*c.ValidationDate))
+	}
+	if c.ValidationSequenceNumber != nil {
+		enc.Element("VldtnSeqNb", string(*c.ValidationSequenceNumber))
+	}
+	enc.End(tag)
+}
+
+func (c *CardIndividualTransaction2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "ICCRltdData":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ICCRelatedData = (*Max1025Text)(&value)
+		case "PmtCntxt":
+			elem := new(PaymentContext3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PaymentContext = elem
+		case "AddtlSvc":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.AdditionalService = (*CardPaymentServiceType2Code)(&value)
+		case "TxCtgy":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.TransactionCategory = (*ExternalCardTransactionCategory1Code)(&value)
+		case "SaleRcncltnId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.SaleReconciliationIdentification = (*Max35Text)(&value)
+		case "SaleRefNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.SaleReferenceNumber = (*Max35Text)(&value)
+		case "RePresntmntRsn":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.RePresentmentReason = (*ExternalRePresentmentReason1Code)(&value)
+		case "SeqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.SequenceNumber = (*Max35Text)(&value)
+		case "TxId":
+			elem := new(TransactionIdentifier1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.TransactionIdentification = elem
+		case "Pdct":
+			elem := new(Product2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Product = elem
+		case "VldtnDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ValidationDate = (*ISODate)(&value)
+		case "VldtnSeqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ValidationSequenceNumber = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
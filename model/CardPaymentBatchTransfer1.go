@@ -10,6 +10,10 @@ type CardPaymentBatchTransfer1 struct {
 	DataSet []*CardPaymentDataSet4 `xml:"DataSet,omitempty"`
 }
 
+func (c *CardPaymentBatchTransfer1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentBatchTransfer1) AddTransactionTotals() *TransactionTotals2 {
 	newValue := new(TransactionTotals2)
 	c.TransactionTotals = append(c.TransactionTotals, newValue)
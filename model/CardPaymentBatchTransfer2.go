@@ -10,6 +10,10 @@ type CardPaymentBatchTransfer2 struct {
 	DataSet []*CardPaymentDataSet7 `xml:"DataSet,omitempty"`
 }
 
+func (c *CardPaymentBatchTransfer2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentBatchTransfer2) AddTransactionTotals() *TransactionTotals2 {
 	newValue := new(TransactionTotals2)
 	c.TransactionTotals = append(c.TransactionTotals, newValue)
@@ -10,6 +10,10 @@ type CardPaymentBatchTransfer3 struct {
 	DataSet []*CardPaymentDataSet10 `xml:"DataSet,omitempty"`
 }
 
+func (c *CardPaymentBatchTransfer3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentBatchTransfer3) AddTransactionTotals() *TransactionTotals3 {
 	newValue := new(TransactionTotals3)
 	c.TransactionTotals = append(c.TransactionTotals, newValue)
@@ -10,6 +10,10 @@ type CardPaymentBatchTransfer4 struct {
 	DataSet []*CardPaymentDataSet13 `xml:"DataSet,omitempty"`
 }
 
+func (c *CardPaymentBatchTransfer4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentBatchTransfer4) AddTransactionTotals() *TransactionTotals7 {
 	newValue := new(TransactionTotals7)
 	c.TransactionTotals = append(c.TransactionTotals, newValue)
@@ -10,6 +10,10 @@ type CardPaymentBatchTransferResponse1 struct {
 	DataSet []*CardPaymentDataSet5 `xml:"DataSet,omitempty"`
 }
 
+func (c *CardPaymentBatchTransferResponse1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentBatchTransferResponse1) AddTransactionTotals() *TransactionTotals2 {
 	c.TransactionTotals = new(TransactionTotals2)
 	return c.TransactionTotals
@@ -10,6 +10,10 @@ type CardPaymentBatchTransferResponse2 struct {
 	DataSet []*CardPaymentDataSet9 `xml:"DataSet,omitempty"`
 }
 
+func (c *CardPaymentBatchTransferResponse2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentBatchTransferResponse2) AddTransactionTotals() *TransactionTotals2 {
 	newValue := new(TransactionTotals2)
 	c.TransactionTotals = append(c.TransactionTotals, newValue)
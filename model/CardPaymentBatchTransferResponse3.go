@@ -10,6 +10,10 @@ type CardPaymentBatchTransferResponse3 struct {
 	DataSet []*CardPaymentDataSet12 `xml:"DataSet,omitempty"`
 }
 
+func (c *CardPaymentBatchTransferResponse3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentBatchTransferResponse3) AddTransactionTotals() *TransactionTotals3 {
 	newValue := new(TransactionTotals3)
 	c.TransactionTotals = append(c.TransactionTotals, newValue)
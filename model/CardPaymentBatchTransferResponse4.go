@@ -10,6 +10,10 @@ type CardPaymentBatchTransferResponse4 struct {
 	DataSet []*CardPaymentDataSet14 `xml:"DataSet,omitempty"`
 }
 
+func (c *CardPaymentBatchTransferResponse4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentBatchTransferResponse4) AddTransactionTotals() *TransactionTotals7 {
 	newValue := new(TransactionTotals7)
 	c.TransactionTotals = append(c.TransactionTotals, newValue)
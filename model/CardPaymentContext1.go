@@ -10,6 +10,10 @@ type CardPaymentContext1 struct {
 	SaleContext *SaleContext1 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext1) AddPaymentContext() *PaymentContext1 {
 	c.PaymentContext = new(PaymentContext1)
 	return c.PaymentContext
@@ -10,6 +10,10 @@ type CardPaymentContext10 struct {
 	SaleContext *SaleContext1 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext10) AddPaymentContext() *PaymentContext10 {
 	c.PaymentContext = new(PaymentContext10)
 	return c.PaymentContext
@@ -10,6 +10,10 @@ type CardPaymentContext11 struct {
 	SaleContext *SaleContext1 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext11) AddPaymentContext() *PaymentContext11 {
 	c.PaymentContext = new(PaymentContext11)
 	return c.PaymentContext
@@ -10,6 +10,10 @@ type CardPaymentContext12 struct {
 	SaleContext *SaleContext1 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext12) AddPaymentContext() *PaymentContext12 {
 	c.PaymentContext = new(PaymentContext12)
 	return c.PaymentContext
@@ -10,6 +10,10 @@ type CardPaymentContext13 struct {
 	SaleContext *SaleContext1 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext13) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext13) AddPaymentContext() *PaymentContext13 {
 	c.PaymentContext = new(PaymentContext13)
 	return c.PaymentContext
@@ -10,6 +10,10 @@ type CardPaymentContext14 struct {
 	SaleContext *SaleContext2 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext14) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext14) AddPaymentContext() *PaymentContext14 {
 	c.PaymentContext = new(PaymentContext14)
 	return c.PaymentContext
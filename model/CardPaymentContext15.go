@@ -10,6 +10,10 @@ type CardPaymentContext15 struct {
 	SaleContext *SaleContext2 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext15) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext15) AddPaymentContext() *PaymentContext15 {
 	c.PaymentContext = new(PaymentContext15)
 	return c.PaymentContext
@@ -10,6 +10,10 @@ type CardPaymentContext16 struct {
 	SaleContext *SaleContext2 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext16) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext16) AddPaymentContext() *PaymentContext16 {
 	c.PaymentContext = new(PaymentContext16)
 	return c.PaymentContext
@@ -10,6 +10,10 @@ type CardPaymentContext17 struct {
 	SaleContext *SaleContext2 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext17) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext17) AddPaymentContext() *PaymentContext17 {
 	c.PaymentContext = new(PaymentContext17)
 	return c.PaymentContext
@@ -10,6 +10,10 @@ type CardPaymentContext18 struct {
 	SaleContext *SaleContext2 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext18) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext18) AddPaymentContext() *PaymentContext18 {
 	c.PaymentContext = new(PaymentContext18)
 	return c.PaymentContext
@@ -10,6 +10,10 @@ type CardPaymentContext19 struct {
 	SaleContext *SaleContext2 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext19) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext19) AddPaymentContext() *PaymentContext19 {
 	c.PaymentContext = new(PaymentContext19)
 	return c.PaymentContext
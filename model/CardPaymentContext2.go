@@ -10,6 +10,10 @@ type CardPaymentContext2 struct {
 	SaleContext *SaleContext1 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext2) AddPaymentContext() *PaymentContext2 {
 	c.PaymentContext = new(PaymentContext2)
 	return c.PaymentContext
@@ -10,6 +10,10 @@ type CardPaymentContext3 struct {
 	SaleContext *SaleContext1 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext3) AddPaymentContext() *PaymentContext1 {
 	c.PaymentContext = new(PaymentContext1)
 	return c.PaymentContext
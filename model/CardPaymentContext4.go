@@ -10,6 +10,10 @@ type CardPaymentContext4 struct {
 	SaleContext *SaleContext1 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext4) AddPaymentContext() *PaymentContext4 {
 	c.PaymentContext = new(PaymentContext4)
 	return c.PaymentContext
@@ -10,6 +10,10 @@ type CardPaymentContext5 struct {
 	SaleContext *SaleContext1 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext5) AddPaymentContext() *PaymentContext5 {
 	c.PaymentContext = new(PaymentContext5)
 	return c.PaymentContext
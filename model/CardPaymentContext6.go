@@ -10,6 +10,10 @@ type CardPaymentContext6 struct {
 	SaleContext *SaleContext1 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext6) AddPaymentContext() *PaymentContext6 {
 	c.PaymentContext = new(PaymentContext6)
 	return c.PaymentContext
@@ -10,6 +10,10 @@ type CardPaymentContext7 struct {
 	SaleContext *SaleContext1 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext7) AddPaymentContext() *PaymentContext7 {
 	c.PaymentContext = new(PaymentContext7)
 	return c.PaymentContext
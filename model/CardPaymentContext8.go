@@ -10,6 +10,10 @@ type CardPaymentContext8 struct {
 	SaleContext *SaleContext1 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext8) AddPaymentContext() *PaymentContext8 {
 	c.PaymentContext = new(PaymentContext8)
 	return c.PaymentContext
@@ -10,6 +10,10 @@ type CardPaymentContext9 struct {
 	SaleContext *SaleContext1 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardPaymentContext9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentContext9) AddPaymentContext() *PaymentContext9 {
 	c.PaymentContext = new(PaymentContext9)
 	return c.PaymentContext
@@ -22,6 +22,10 @@ type CardPaymentDataSet1 struct {
 	TransactionToCapture []*CardPaymentDataSetTransaction1 `xml:"TxToCaptr,omitempty"`
 }
 
+func (c *CardPaymentDataSet1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSet1) AddDataSetIdentification() *DataSetIdentification1 {
 	c.DataSetIdentification = new(DataSetIdentification1)
 	return c.DataSetIdentification
@@ -22,6 +22,10 @@ type CardPaymentDataSet10 struct {
 	Transaction []*CardPaymentDataSetTransaction3Choice `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSet10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSet10) AddDataSetIdentification() *DataSetIdentification1 {
 	c.DataSetIdentification = new(DataSetIdentification1)
 	return c.DataSetIdentification
@@ -16,6 +16,10 @@ type CardPaymentDataSet11 struct {
 	Transaction *CardPaymentTransactionAdviceResponse5 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSet11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSet11) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
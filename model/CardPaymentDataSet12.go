@@ -22,6 +22,10 @@ type CardPaymentDataSet12 struct {
 	RejectedTransaction []*CardPaymentDataSet11 `xml:"RjctdTx,omitempty"`
 }
 
+func (c *CardPaymentDataSet12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSet12) AddDataSetIdentification() *DataSetIdentification1 {
 	c.DataSetIdentification = new(DataSetIdentification1)
 	return c.DataSetIdentification
@@ -36,6 +40,11 @@ func (c *CardPaymentDataSet12) SetRemoveDataSet(value string) {
 	c.RemoveDataSet = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentDataSet12) SetRemoveDataSetFromBool(value bool) {
+	c.RemoveDataSet = new(TrueFalseIndicator)
+	c.RemoveDataSet.FromBool(value)
+}
+
 func (c *CardPaymentDataSet12) AddDataSetInitiator() *GenericIdentification53 {
 	c.DataSetInitiator = new(GenericIdentification53)
 	return c.DataSetInitiator
@@ -22,6 +22,10 @@ type CardPaymentDataSet13 struct {
 	Transaction []*CardPaymentDataSetTransaction4Choice `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSet13) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSet13) AddDataSetIdentification() *DataSetIdentification5 {
 	c.DataSetIdentification = new(DataSetIdentification5)
 	return c.DataSetIdentification
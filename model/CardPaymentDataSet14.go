@@ -22,6 +22,10 @@ type CardPaymentDataSet14 struct {
 	RejectedTransaction []*CardPaymentDataSet15 `xml:"RjctdTx,omitempty"`
 }
 
+func (c *CardPaymentDataSet14) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSet14) AddDataSetIdentification() *DataSetIdentification5 {
 	c.DataSetIdentification = new(DataSetIdentification5)
 	return c.DataSetIdentification
@@ -36,6 +40,11 @@ func (c *CardPaymentDataSet14) SetRemoveDataSet(value string) {
 	c.RemoveDataSet = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentDataSet14) SetRemoveDataSetFromBool(value bool) {
+	c.RemoveDataSet = new(TrueFalseIndicator)
+	c.RemoveDataSet.FromBool(value)
+}
+
 func (c *CardPaymentDataSet14) AddDataSetInitiator() *GenericIdentification53 {
 	c.DataSetInitiator = new(GenericIdentification53)
 	return c.DataSetInitiator
@@ -16,6 +16,10 @@ type CardPaymentDataSet15 struct {
 	Transaction *CardPaymentTransactionAdviceResponse5 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSet15) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSet15) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
@@ -22,6 +22,10 @@ type CardPaymentDataSet2 struct {
 	RejectedTransaction []*CardPaymentDataSet3 `xml:"RjctdTx,omitempty"`
 }
 
+func (c *CardPaymentDataSet2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSet2) AddDataSetIdentification() *DataSetIdentification1 {
 	c.DataSetIdentification = new(DataSetIdentification1)
 	return c.DataSetIdentification
@@ -36,6 +40,11 @@ func (c *CardPaymentDataSet2) SetRemoveDataSet(value string) {
 	c.RemoveDataSet = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentDataSet2) SetRemoveDataSetFromBool(value bool) {
+	c.RemoveDataSet = new(TrueFalseIndicator)
+	c.RemoveDataSet.FromBool(value)
+}
+
 func (c *CardPaymentDataSet2) AddDataSetInitiator() *GenericIdentification32 {
 	c.DataSetInitiator = new(GenericIdentification32)
 	return c.DataSetInitiator
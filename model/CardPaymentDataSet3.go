@@ -16,6 +16,10 @@ type CardPaymentDataSet3 struct {
 	Transaction *CardPaymentTransactionAdviceResponse1 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSet3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSet3) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
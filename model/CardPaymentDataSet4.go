@@ -22,6 +22,10 @@ type CardPaymentDataSet4 struct {
 	Transaction []*CardPaymentDataSetTransaction1Choice `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSet4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSet4) AddDataSetIdentification() *DataSetIdentification1 {
 	c.DataSetIdentification = new(DataSetIdentification1)
 	return c.DataSetIdentification
@@ -22,6 +22,10 @@ type CardPaymentDataSet5 struct {
 	RejectedTransaction []*CardPaymentDataSet6 `xml:"RjctdTx,omitempty"`
 }
 
+func (c *CardPaymentDataSet5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSet5) AddDataSetIdentification() *DataSetIdentification1 {
 	c.DataSetIdentification = new(DataSetIdentification1)
 	return c.DataSetIdentification
@@ -36,6 +40,11 @@ func (c *CardPaymentDataSet5) SetRemoveDataSet(value string) {
 	c.RemoveDataSet = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentDataSet5) SetRemoveDataSetFromBool(value bool) {
+	c.RemoveDataSet = new(TrueFalseIndicator)
+	c.RemoveDataSet.FromBool(value)
+}
+
 func (c *CardPaymentDataSet5) AddDataSetInitiator() *GenericIdentification32 {
 	c.DataSetInitiator = new(GenericIdentification32)
 	return c.DataSetInitiator
@@ -16,6 +16,10 @@ type CardPaymentDataSet6 struct {
 	Transaction *CardPaymentTransactionAdviceResponse1 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSet6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSet6) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
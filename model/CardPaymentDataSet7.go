@@ -22,6 +22,10 @@ type CardPaymentDataSet7 struct {
 	Transaction []*CardPaymentDataSetTransaction2Choice `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSet7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSet7) AddDataSetIdentification() *DataSetIdentification1 {
 	c.DataSetIdentification = new(DataSetIdentification1)
 	return c.DataSetIdentification
@@ -16,6 +16,10 @@ type CardPaymentDataSet8 struct {
 	Transaction *CardPaymentTransactionAdviceResponse5 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSet8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSet8) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
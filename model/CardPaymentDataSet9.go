@@ -22,6 +22,10 @@ type CardPaymentDataSet9 struct {
 	RejectedTransaction []*CardPaymentDataSet8 `xml:"RjctdTx,omitempty"`
 }
 
+func (c *CardPaymentDataSet9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSet9) AddDataSetIdentification() *DataSetIdentification1 {
 	c.DataSetIdentification = new(DataSetIdentification1)
 	return c.DataSetIdentification
@@ -36,6 +40,11 @@ func (c *CardPaymentDataSet9) SetRemoveDataSet(value string) {
 	c.RemoveDataSet = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentDataSet9) SetRemoveDataSetFromBool(value bool) {
+	c.RemoveDataSet = new(TrueFalseIndicator)
+	c.RemoveDataSet.FromBool(value)
+}
+
 func (c *CardPaymentDataSet9) AddDataSetInitiator() *GenericIdentification32 {
 	c.DataSetInitiator = new(GenericIdentification32)
 	return c.DataSetInitiator
@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction1 struct {
 	Transaction *CardPaymentTransaction4 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSetTransaction1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction1) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction10 struct {
 	Transaction *CardPaymentTransaction45 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSetTransaction10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction10) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
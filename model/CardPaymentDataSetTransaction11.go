@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction11 struct {
 	Transaction *CardPaymentTransaction46 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSetTransaction11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction11) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
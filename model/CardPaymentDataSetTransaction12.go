@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction12 struct {
 	Transaction *CardPaymentTransaction47 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSetTransaction12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction12) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction13 struct {
 	TransactionResponse *CardPaymentTransaction39 `xml:"TxRspn"`
 }
 
+func (c *CardPaymentDataSetTransaction13) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction13) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction14 struct {
 	Transaction *CardPaymentTransaction60 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSetTransaction14) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction14) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction15 struct {
 	Transaction *CardPaymentTransaction61 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSetTransaction15) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction15) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
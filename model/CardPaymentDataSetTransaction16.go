@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction16 struct {
 	Transaction *CardPaymentTransaction62 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSetTransaction16) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction16) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
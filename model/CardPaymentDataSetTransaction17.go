@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction17 struct {
 	TransactionResponse *CardPaymentTransaction54 `xml:"TxRspn"`
 }
 
+func (c *CardPaymentDataSetTransaction17) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction17) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
@@ -16,6 +16,10 @@ type CardPaymentDataSetTransaction1Choice struct {
 	AuthorisationResponse *CardPaymentDataSetTransaction5 `xml:"AuthstnRspn,omitempty"`
 }
 
+func (c *CardPaymentDataSetTransaction1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction1Choice) AddCompletion() *CardPaymentDataSetTransaction2 {
 	c.Completion = new(CardPaymentDataSetTransaction2)
 	return c.Completion
@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction2 struct {
 	Transaction *CardPaymentTransaction14 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSetTransaction2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction2) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
@@ -16,6 +16,10 @@ type CardPaymentDataSetTransaction2Choice struct {
 	AuthorisationResponse *CardPaymentDataSetTransaction9 `xml:"AuthstnRspn"`
 }
 
+func (c *CardPaymentDataSetTransaction2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction2Choice) AddCompletion() *CardPaymentDataSetTransaction6 {
 	c.Completion = new(CardPaymentDataSetTransaction6)
 	return c.Completion
@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction3 struct {
 	Transaction *CardPaymentTransaction20 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSetTransaction3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction3) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
@@ -16,6 +16,10 @@ type CardPaymentDataSetTransaction3Choice struct {
 	AuthorisationResponse *CardPaymentDataSetTransaction13 `xml:"AuthstnRspn"`
 }
 
+func (c *CardPaymentDataSetTransaction3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction3Choice) AddCompletion() *CardPaymentDataSetTransaction10 {
 	c.Completion = new(CardPaymentDataSetTransaction10)
 	return c.Completion
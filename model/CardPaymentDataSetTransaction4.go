@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction4 struct {
 	Transaction *CardPaymentTransaction19 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSetTransaction4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction4) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
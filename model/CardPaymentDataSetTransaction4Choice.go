@@ -16,6 +16,10 @@ type CardPaymentDataSetTransaction4Choice struct {
 	AuthorisationResponse *CardPaymentDataSetTransaction17 `xml:"AuthstnRspn"`
 }
 
+func (c *CardPaymentDataSetTransaction4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction4Choice) AddCompletion() *CardPaymentDataSetTransaction14 {
 	c.Completion = new(CardPaymentDataSetTransaction14)
 	return c.Completion
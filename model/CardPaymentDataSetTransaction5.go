@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction5 struct {
 	TransactionResponse *CardPaymentTransaction18 `xml:"TxRspn"`
 }
 
+func (c *CardPaymentDataSetTransaction5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction5) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction6 struct {
 	Transaction *CardPaymentTransaction29 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSetTransaction6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction6) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
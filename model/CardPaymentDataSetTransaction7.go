@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction7 struct {
 	Transaction *CardPaymentTransaction30 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSetTransaction7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction7) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
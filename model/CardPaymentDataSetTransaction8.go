@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction8 struct {
 	Transaction *CardPaymentTransaction31 `xml:"Tx"`
 }
 
+func (c *CardPaymentDataSetTransaction8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction8) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
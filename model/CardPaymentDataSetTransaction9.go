@@ -19,6 +19,10 @@ type CardPaymentDataSetTransaction9 struct {
 	TransactionResponse *CardPaymentTransaction24 `xml:"TxRspn"`
 }
 
+func (c *CardPaymentDataSetTransaction9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentDataSetTransaction9) SetTransactionSequenceCounter(value string) {
 	c.TransactionSequenceCounter = (*Max9NumericText)(&value)
 }
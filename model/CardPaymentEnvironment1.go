@@ -20,6 +20,10 @@ type CardPaymentEnvironment1 struct {
 	Cardholder *Cardholder1 `xml:"Crdhldr,omitempty"`
 }
 
+func (c *CardPaymentEnvironment1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment1) AddAcquirer() *Acquirer1 {
 	c.Acquirer = new(Acquirer1)
 	return c.Acquirer
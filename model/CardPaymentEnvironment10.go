@@ -20,6 +20,10 @@ type CardPaymentEnvironment10 struct {
 	Cardholder *Cardholder4 `xml:"Crdhldr,omitempty"`
 }
 
+func (c *CardPaymentEnvironment10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment10) AddAcquirer() *Acquirer2 {
 	c.Acquirer = new(Acquirer2)
 	return c.Acquirer
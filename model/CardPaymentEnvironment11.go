@@ -19,6 +19,10 @@ type CardPaymentEnvironment11 struct {
 	PlainCardData *PlainCardData3 `xml:"PlainCardData,omitempty"`
 }
 
+func (c *CardPaymentEnvironment11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment11) AddAcquirerIdentification() *GenericIdentification32 {
 	c.AcquirerIdentification = new(GenericIdentification32)
 	return c.AcquirerIdentification
@@ -17,6 +17,10 @@ type CardPaymentEnvironment12 struct {
 	Card *PaymentCard6 `xml:"Card"`
 }
 
+func (c *CardPaymentEnvironment12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment12) AddAcquirer() *Acquirer2 {
 	c.Acquirer = new(Acquirer2)
 	return c.Acquirer
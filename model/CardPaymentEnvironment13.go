@@ -14,6 +14,10 @@ type CardPaymentEnvironment13 struct {
 	POI *PointOfInteraction2 `xml:"POI,omitempty"`
 }
 
+func (c *CardPaymentEnvironment13) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment13) AddAcquirer() *Acquirer3 {
 	c.Acquirer = new(Acquirer3)
 	return c.Acquirer
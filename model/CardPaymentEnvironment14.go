@@ -20,6 +20,10 @@ type CardPaymentEnvironment14 struct {
 	Cardholder *Cardholder4 `xml:"Crdhldr,omitempty"`
 }
 
+func (c *CardPaymentEnvironment14) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment14) AddAcquirer() *Acquirer2 {
 	c.Acquirer = new(Acquirer2)
 	return c.Acquirer
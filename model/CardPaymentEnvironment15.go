@@ -13,6 +13,10 @@ type CardPaymentEnvironment15 struct {
 	POIIdentification *GenericIdentification32 `xml:"POIId,omitempty"`
 }
 
+func (c *CardPaymentEnvironment15) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment15) AddAcquirer() *Acquirer2 {
 	c.Acquirer = new(Acquirer2)
 	return c.Acquirer
@@ -19,6 +19,10 @@ type CardPaymentEnvironment16 struct {
 	PlainCardData *PlainCardData3 `xml:"PlainCardData,omitempty"`
 }
 
+func (c *CardPaymentEnvironment16) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment16) AddAcquirerIdentification() *GenericIdentification32 {
 	c.AcquirerIdentification = new(GenericIdentification32)
 	return c.AcquirerIdentification
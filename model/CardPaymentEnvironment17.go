@@ -13,6 +13,10 @@ type CardPaymentEnvironment17 struct {
 	POIIdentification *GenericIdentification32 `xml:"POIId"`
 }
 
+func (c *CardPaymentEnvironment17) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment17) SetAcquirerParametersVersion(value string) {
 	c.AcquirerParametersVersion = (*Max35Text)(&value)
 }
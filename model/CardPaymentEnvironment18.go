@@ -17,6 +17,10 @@ type CardPaymentEnvironment18 struct {
 	Card *PaymentCard6 `xml:"Card"`
 }
 
+func (c *CardPaymentEnvironment18) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment18) AddAcquirer() *Acquirer2 {
 	c.Acquirer = new(Acquirer2)
 	return c.Acquirer
@@ -13,6 +13,10 @@ type CardPaymentEnvironment19 struct {
 	POIIdentification *GenericIdentification32 `xml:"POIId,omitempty"`
 }
 
+func (c *CardPaymentEnvironment19) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment19) AddAcquirerIdentification() *GenericIdentification32 {
 	c.AcquirerIdentification = new(GenericIdentification32)
 	return c.AcquirerIdentification
@@ -20,6 +20,10 @@ type CardPaymentEnvironment2 struct {
 	Cardholder *Cardholder2 `xml:"Crdhldr,omitempty"`
 }
 
+func (c *CardPaymentEnvironment2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment2) AddAcquirer() *Acquirer1 {
 	c.Acquirer = new(Acquirer1)
 	return c.Acquirer
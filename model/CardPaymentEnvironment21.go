@@ -19,6 +19,10 @@ type CardPaymentEnvironment21 struct {
 	PlainCardData *PlainCardData5 `xml:"PlainCardData,omitempty"`
 }
 
+func (c *CardPaymentEnvironment21) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment21) AddAcquirerIdentification() *GenericIdentification32 {
 	c.AcquirerIdentification = new(GenericIdentification32)
 	return c.AcquirerIdentification
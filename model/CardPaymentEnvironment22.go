@@ -23,6 +23,10 @@ type CardPaymentEnvironment22 struct {
 	ProtectedCardholderData *ContentInformationType7 `xml:"PrtctdCrdhldrData,omitempty"`
 }
 
+func (c *CardPaymentEnvironment22) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment22) AddAcquirer() *Acquirer2 {
 	c.Acquirer = new(Acquirer2)
 	return c.Acquirer
@@ -17,6 +17,10 @@ type CardPaymentEnvironment23 struct {
 	Card *PaymentCard8 `xml:"Card"`
 }
 
+func (c *CardPaymentEnvironment23) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment23) AddAcquirer() *Acquirer2 {
 	c.Acquirer = new(Acquirer2)
 	return c.Acquirer
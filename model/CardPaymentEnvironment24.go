@@ -17,6 +17,10 @@ type CardPaymentEnvironment24 struct {
 	Card *PaymentCard8 `xml:"Card"`
 }
 
+func (c *CardPaymentEnvironment24) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment24) AddAcquirer() *Acquirer2 {
 	c.Acquirer = new(Acquirer2)
 	return c.Acquirer
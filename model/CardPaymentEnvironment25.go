@@ -16,6 +16,10 @@ type CardPaymentEnvironment25 struct {
 	POIComponent []*PointOfInteractionComponent4 `xml:"POICmpnt,omitempty"`
 }
 
+func (c *CardPaymentEnvironment25) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment25) AddAcquirer() *Acquirer2 {
 	c.Acquirer = new(Acquirer2)
 	return c.Acquirer
@@ -14,6 +14,10 @@ type CardPaymentEnvironment26 struct {
 	POI *PointOfInteraction3 `xml:"POI,omitempty"`
 }
 
+func (c *CardPaymentEnvironment26) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment26) AddAcquirer() *Acquirer3 {
 	c.Acquirer = new(Acquirer3)
 	return c.Acquirer
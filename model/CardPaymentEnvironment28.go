@@ -19,6 +19,10 @@ type CardPaymentEnvironment28 struct {
 	PlainCardData *PlainCardData3 `xml:"PlainCardData,omitempty"`
 }
 
+func (c *CardPaymentEnvironment28) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment28) AddAcquirerIdentification() *GenericIdentification32 {
 	c.AcquirerIdentification = new(GenericIdentification32)
 	return c.AcquirerIdentification
@@ -19,6 +19,10 @@ type CardPaymentEnvironment29 struct {
 	POIComponent []*PointOfInteractionComponent4 `xml:"POICmpnt,omitempty"`
 }
 
+func (c *CardPaymentEnvironment29) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment29) AddAcquirer() *Acquirer2 {
 	c.Acquirer = new(Acquirer2)
 	return c.Acquirer
@@ -28,6 +32,11 @@ func (c *CardPaymentEnvironment29) SetAcquirerAvailabilityRequested(value string
 	c.AcquirerAvailabilityRequested = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentEnvironment29) SetAcquirerAvailabilityRequestedFromBool(value bool) {
+	c.AcquirerAvailabilityRequested = new(TrueFalseIndicator)
+	c.AcquirerAvailabilityRequested.FromBool(value)
+}
+
 func (c *CardPaymentEnvironment29) AddMerchantIdentification() *GenericIdentification32 {
 	c.MerchantIdentification = new(GenericIdentification32)
 	return c.MerchantIdentification
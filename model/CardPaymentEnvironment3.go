@@ -19,6 +19,10 @@ type CardPaymentEnvironment3 struct {
 	PlainCardData *PlainCardData3 `xml:"PlainCardData,omitempty"`
 }
 
+func (c *CardPaymentEnvironment3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment3) AddAcquirer() *Acquirer1 {
 	c.Acquirer = new(Acquirer1)
 	return c.Acquirer
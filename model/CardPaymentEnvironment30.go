@@ -19,6 +19,10 @@ type CardPaymentEnvironment30 struct {
 	CardholderLanguage *ISO2ALanguageCode `xml:"CrdhldrLang,omitempty"`
 }
 
+func (c *CardPaymentEnvironment30) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment30) AddAcquirerIdentification() *GenericIdentification32 {
 	c.AcquirerIdentification = new(GenericIdentification32)
 	return c.AcquirerIdentification
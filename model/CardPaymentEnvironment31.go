@@ -16,6 +16,10 @@ type CardPaymentEnvironment31 struct {
 	POIIdentification *GenericIdentification32 `xml:"POIId,omitempty"`
 }
 
+func (c *CardPaymentEnvironment31) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment31) AddAcquirer() *Acquirer2 {
 	c.Acquirer = new(Acquirer2)
 	return c.Acquirer
@@ -25,6 +29,11 @@ func (c *CardPaymentEnvironment31) SetAcquirerAvailable(value string) {
 	c.AcquirerAvailable = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentEnvironment31) SetAcquirerAvailableFromBool(value bool) {
+	c.AcquirerAvailable = new(TrueFalseIndicator)
+	c.AcquirerAvailable.FromBool(value)
+}
+
 func (c *CardPaymentEnvironment31) AddMerchantIdentification() *GenericIdentification32 {
 	c.MerchantIdentification = new(GenericIdentification32)
 	return c.MerchantIdentification
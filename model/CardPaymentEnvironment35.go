@@ -26,6 +26,10 @@ type CardPaymentEnvironment35 struct {
 	PaymentToken *CardPaymentToken3 `xml:"PmtTkn,omitempty"`
 }
 
+func (c *CardPaymentEnvironment35) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment35) AddAcquirer() *Acquirer4 {
 	c.Acquirer = new(Acquirer4)
 	return c.Acquirer
@@ -26,6 +26,10 @@ type CardPaymentEnvironment36 struct {
 	PaymentToken *CardPaymentToken3 `xml:"PmtTkn,omitempty"`
 }
 
+func (c *CardPaymentEnvironment36) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment36) AddAcquirer() *Acquirer4 {
 	c.Acquirer = new(Acquirer4)
 	return c.Acquirer
@@ -16,6 +16,10 @@ type CardPaymentEnvironment37 struct {
 	POIComponent []*PointOfInteractionComponent5 `xml:"POICmpnt,omitempty"`
 }
 
+func (c *CardPaymentEnvironment37) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment37) AddAcquirer() *Acquirer4 {
 	c.Acquirer = new(Acquirer4)
 	return c.Acquirer
@@ -13,6 +13,10 @@ type CardPaymentEnvironment38 struct {
 	POIIdentification *GenericIdentification32 `xml:"POIId,omitempty"`
 }
 
+func (c *CardPaymentEnvironment38) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment38) AddAcquirerIdentification() *GenericIdentification53 {
 	c.AcquirerIdentification = new(GenericIdentification53)
 	return c.AcquirerIdentification
@@ -14,6 +14,10 @@ type CardPaymentEnvironment39 struct {
 	POI *PointOfInteraction4 `xml:"POI,omitempty"`
 }
 
+func (c *CardPaymentEnvironment39) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment39) AddAcquirer() *Acquirer5 {
 	c.Acquirer = new(Acquirer5)
 	return c.Acquirer
@@ -17,6 +17,10 @@ type CardPaymentEnvironment4 struct {
 	Card *PaymentCard3 `xml:"Card"`
 }
 
+func (c *CardPaymentEnvironment4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment4) AddAcquirer() *Acquirer1 {
 	c.Acquirer = new(Acquirer1)
 	return c.Acquirer
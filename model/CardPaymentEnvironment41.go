@@ -19,6 +19,10 @@ type CardPaymentEnvironment41 struct {
 	PaymentToken *CardPaymentToken2 `xml:"PmtTkn,omitempty"`
 }
 
+func (c *CardPaymentEnvironment41) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment41) AddAcquirerIdentification() *GenericIdentification53 {
 	c.AcquirerIdentification = new(GenericIdentification53)
 	return c.AcquirerIdentification
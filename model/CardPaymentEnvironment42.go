@@ -19,6 +19,10 @@ type CardPaymentEnvironment42 struct {
 	POIComponent []*PointOfInteractionComponent5 `xml:"POICmpnt,omitempty"`
 }
 
+func (c *CardPaymentEnvironment42) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment42) AddAcquirer() *Acquirer4 {
 	c.Acquirer = new(Acquirer4)
 	return c.Acquirer
@@ -28,6 +32,11 @@ func (c *CardPaymentEnvironment42) SetAcquirerAvailabilityRequested(value string
 	c.AcquirerAvailabilityRequested = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentEnvironment42) SetAcquirerAvailabilityRequestedFromBool(value bool) {
+	c.AcquirerAvailabilityRequested = new(TrueFalseIndicator)
+	c.AcquirerAvailabilityRequested.FromBool(value)
+}
+
 func (c *CardPaymentEnvironment42) AddMerchantIdentification() *GenericIdentification53 {
 	c.MerchantIdentification = new(GenericIdentification53)
 	return c.MerchantIdentification
@@ -16,6 +16,10 @@ type CardPaymentEnvironment43 struct {
 	POIIdentification *GenericIdentification32 `xml:"POIId,omitempty"`
 }
 
+func (c *CardPaymentEnvironment43) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment43) AddAcquirer() *Acquirer4 {
 	c.Acquirer = new(Acquirer4)
 	return c.Acquirer
@@ -25,6 +29,11 @@ func (c *CardPaymentEnvironment43) SetAcquirerAvailable(value string) {
 	c.AcquirerAvailable = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentEnvironment43) SetAcquirerAvailableFromBool(value bool) {
+	c.AcquirerAvailable = new(TrueFalseIndicator)
+	c.AcquirerAvailable.FromBool(value)
+}
+
 func (c *CardPaymentEnvironment43) AddMerchantIdentification() *GenericIdentification53 {
 	c.MerchantIdentification = new(GenericIdentification53)
 	return c.MerchantIdentification
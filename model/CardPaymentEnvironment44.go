@@ -20,6 +20,10 @@ type CardPaymentEnvironment44 struct {
 	CardholderLanguage *LanguageCode `xml:"CrdhldrLang,omitempty"`
 }
 
+func (c *CardPaymentEnvironment44) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment44) AddAcquirerIdentification() *GenericIdentification53 {
 	c.AcquirerIdentification = new(GenericIdentification53)
 	return c.AcquirerIdentification
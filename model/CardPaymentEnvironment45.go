@@ -32,6 +32,10 @@ type CardPaymentEnvironment45 struct {
 	ProtectedCardholderData *ContentInformationType10 `xml:"PrtctdCrdhldrData,omitempty"`
 }
 
+func (c *CardPaymentEnvironment45) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment45) AddAcquirer() *Acquirer4 {
 	c.Acquirer = new(Acquirer4)
 	return c.Acquirer
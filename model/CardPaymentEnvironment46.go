@@ -19,6 +19,10 @@ type CardPaymentEnvironment46 struct {
 	PaymentToken *CardPaymentToken2 `xml:"PmtTkn,omitempty"`
 }
 
+func (c *CardPaymentEnvironment46) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment46) AddAcquirerIdentification() *GenericIdentification53 {
 	c.AcquirerIdentification = new(GenericIdentification53)
 	return c.AcquirerIdentification
@@ -26,6 +26,10 @@ type CardPaymentEnvironment49 struct {
 	PaymentToken *CardPaymentToken3 `xml:"PmtTkn,omitempty"`
 }
 
+func (c *CardPaymentEnvironment49) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment49) AddAcquirer() *Acquirer4 {
 	c.Acquirer = new(Acquirer4)
 	return c.Acquirer
@@ -14,6 +14,10 @@ type CardPaymentEnvironment5 struct {
 	POI *PointOfInteraction1 `xml:"POI,omitempty"`
 }
 
+func (c *CardPaymentEnvironment5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment5) AddAcquirer() *Acquirer1 {
 	c.Acquirer = new(Acquirer1)
 	return c.Acquirer
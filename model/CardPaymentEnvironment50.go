@@ -16,6 +16,10 @@ type CardPaymentEnvironment50 struct {
 	POIComponent []*PointOfInteractionComponent6 `xml:"POICmpnt,omitempty"`
 }
 
+func (c *CardPaymentEnvironment50) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment50) AddAcquirer() *Acquirer4 {
 	c.Acquirer = new(Acquirer4)
 	return c.Acquirer
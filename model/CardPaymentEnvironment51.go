@@ -14,6 +14,10 @@ type CardPaymentEnvironment51 struct {
 	POI *PointOfInteraction5 `xml:"POI,omitempty"`
 }
 
+func (c *CardPaymentEnvironment51) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment51) AddAcquirer() *Acquirer5 {
 	c.Acquirer = new(Acquirer5)
 	return c.Acquirer
@@ -32,6 +32,10 @@ type CardPaymentEnvironment53 struct {
 	ProtectedCardholderData *ContentInformationType10 `xml:"PrtctdCrdhldrData,omitempty"`
 }
 
+func (c *CardPaymentEnvironment53) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment53) AddAcquirer() *Acquirer4 {
 	c.Acquirer = new(Acquirer4)
 	return c.Acquirer
@@ -20,6 +20,10 @@ type CardPaymentEnvironment54 struct {
 	PaymentToken *CardPaymentToken2 `xml:"PmtTkn,omitempty"`
 }
 
+func (c *CardPaymentEnvironment54) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment54) AddAcquirerIdentification() *GenericIdentification53 {
 	c.AcquirerIdentification = new(GenericIdentification53)
 	return c.AcquirerIdentification
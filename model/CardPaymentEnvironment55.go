@@ -19,6 +19,10 @@ type CardPaymentEnvironment55 struct {
 	POIComponent []*PointOfInteractionComponent6 `xml:"POICmpnt,omitempty"`
 }
 
+func (c *CardPaymentEnvironment55) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment55) AddAcquirer() *Acquirer4 {
 	c.Acquirer = new(Acquirer4)
 	return c.Acquirer
@@ -28,6 +32,11 @@ func (c *CardPaymentEnvironment55) SetAcquirerAvailabilityRequested(value string
 	c.AcquirerAvailabilityRequested = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentEnvironment55) SetAcquirerAvailabilityRequestedFromBool(value bool) {
+	c.AcquirerAvailabilityRequested = new(TrueFalseIndicator)
+	c.AcquirerAvailabilityRequested.FromBool(value)
+}
+
 func (c *CardPaymentEnvironment55) AddMerchantIdentification() *GenericIdentification53 {
 	c.MerchantIdentification = new(GenericIdentification53)
 	return c.MerchantIdentification
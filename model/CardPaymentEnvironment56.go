@@ -20,6 +20,10 @@ type CardPaymentEnvironment56 struct {
 	CardholderLanguage *LanguageCode `xml:"CrdhldrLang,omitempty"`
 }
 
+func (c *CardPaymentEnvironment56) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment56) AddAcquirerIdentification() *GenericIdentification53 {
 	c.AcquirerIdentification = new(GenericIdentification53)
 	return c.AcquirerIdentification
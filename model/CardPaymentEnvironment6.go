@@ -20,6 +20,10 @@ type CardPaymentEnvironment6 struct {
 	Cardholder *Cardholder2 `xml:"Crdhldr,omitempty"`
 }
 
+func (c *CardPaymentEnvironment6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment6) AddAcquirer() *Acquirer1 {
 	c.Acquirer = new(Acquirer1)
 	return c.Acquirer
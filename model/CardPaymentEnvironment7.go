@@ -13,6 +13,10 @@ type CardPaymentEnvironment7 struct {
 	POIIdentification *GenericIdentification32 `xml:"POIId,omitempty"`
 }
 
+func (c *CardPaymentEnvironment7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment7) AddAcquirer() *Acquirer1 {
 	c.Acquirer = new(Acquirer1)
 	return c.Acquirer
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Environment of the diagnostic exchange.
 type CardPaymentEnvironment8 struct {
 
@@ -13,10 +17,19 @@ type CardPaymentEnvironment8 struct {
 	POIIdentification *GenericIdentification32 `xml:"POIId"`
 }
 
+func (c *CardPaymentEnvironment8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment8) SetAcquirerParametersVersion(value string) {
 	c.AcquirerParametersVersion = (*ISODateTime)(&value)
 }
 
+func (c *CardPaymentEnvironment8) SetAcquirerParametersVersionFromTime(value time.Time) {
+	c.AcquirerParametersVersion = new(ISODateTime)
+	c.AcquirerParametersVersion.FromTime(value)
+}
+
 func (c *CardPaymentEnvironment8) AddMerchantIdentification() *GenericIdentification32 {
 	c.MerchantIdentification = new(GenericIdentification32)
 	return c.MerchantIdentification
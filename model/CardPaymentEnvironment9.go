@@ -20,6 +20,10 @@ type CardPaymentEnvironment9 struct {
 	Cardholder *Cardholder3 `xml:"Crdhldr,omitempty"`
 }
 
+func (c *CardPaymentEnvironment9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentEnvironment9) AddAcquirer() *Acquirer2 {
 	c.Acquirer = new(Acquirer2)
 	return c.Acquirer
@@ -16,6 +16,10 @@ type CardPaymentInvoice1 struct {
 	LineItem []*LineItem10 `xml:"LineItm,omitempty"`
 }
 
+func (c *CardPaymentInvoice1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentInvoice1) AddInvoiceHeader() *InvoiceHeader1 {
 	c.InvoiceHeader = new(InvoiceHeader1)
 	return c.InvoiceHeader
@@ -16,6 +16,10 @@ type CardPaymentInvoice2 struct {
 	LineItem []*LineItem16 `xml:"LineItm,omitempty"`
 }
 
+func (c *CardPaymentInvoice2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentInvoice2) AddInvoiceHeader() *InvoiceHeader2 {
 	c.InvoiceHeader = new(InvoiceHeader2)
 	return c.InvoiceHeader
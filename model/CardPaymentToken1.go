@@ -10,6 +10,10 @@ type CardPaymentToken1 struct {
 	TokenRequestor *PaymentTokenIdentifiers1 `xml:"TknRqstr,omitempty"`
 }
 
+func (c *CardPaymentToken1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentToken1) AddTokenCharacteristic(value string) {
 	c.TokenCharacteristic = append(c.TokenCharacteristic, (*Max35Text)(&value))
 }
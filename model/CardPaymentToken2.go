@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Payment token information.
 type CardPaymentToken2 struct {
 
@@ -10,6 +14,10 @@ type CardPaymentToken2 struct {
 	TokenAssuranceLevel *Number `xml:"TknAssrncLvl,omitempty"`
 }
 
+func (c *CardPaymentToken2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentToken2) AddTokenCharacteristic(value string) {
 	c.TokenCharacteristic = append(c.TokenCharacteristic, (*Max35Text)(&value))
 }
@@ -17,3 +25,8 @@ func (c *CardPaymentToken2) AddTokenCharacteristic(value string) {
 func (c *CardPaymentToken2) SetTokenAssuranceLevel(value string) {
 	c.TokenAssuranceLevel = (*Number)(&value)
 }
+
+func (c *CardPaymentToken2) SetTokenAssuranceLevelFromDecimal(value decimal.Decimal) {
+	c.TokenAssuranceLevel = new(Number)
+	c.TokenAssuranceLevel.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Payment token information.
 type CardPaymentToken3 struct {
 
@@ -13,6 +17,10 @@ type CardPaymentToken3 struct {
 	TokenAssuranceLevel *Number `xml:"TknAssrncLvl,omitempty"`
 }
 
+func (c *CardPaymentToken3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentToken3) AddTokenCharacteristic(value string) {
 	c.TokenCharacteristic = append(c.TokenCharacteristic, (*Max35Text)(&value))
 }
@@ -25,3 +33,8 @@ func (c *CardPaymentToken3) AddTokenRequestor() *PaymentTokenIdentifiers1 {
 func (c *CardPaymentToken3) SetTokenAssuranceLevel(value string) {
 	c.TokenAssuranceLevel = (*Number)(&value)
 }
+
+func (c *CardPaymentToken3) SetTokenAssuranceLevelFromDecimal(value decimal.Decimal) {
+	c.TokenAssuranceLevel = new(Number)
+	c.TokenAssuranceLevel.FromDecimal(value)
+}
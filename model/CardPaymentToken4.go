@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Payment token information.
 type CardPaymentToken4 struct {
 
@@ -25,6 +29,10 @@ type CardPaymentToken4 struct {
 	TokenAssuranceData *Max500Binary `xml:"TknAssrncData,omitempty"`
 }
 
+func (c *CardPaymentToken4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentToken4) SetToken(value string) {
 	c.Token = (*Min8Max28NumericText)(&value)
 }
@@ -50,6 +58,11 @@ func (c *CardPaymentToken4) SetTokenAssuranceLevel(value string) {
 	c.TokenAssuranceLevel = (*Number)(&value)
 }
 
+func (c *CardPaymentToken4) SetTokenAssuranceLevelFromDecimal(value decimal.Decimal) {
+	c.TokenAssuranceLevel = new(Number)
+	c.TokenAssuranceLevel.FromDecimal(value)
+}
+
 func (c *CardPaymentToken4) SetTokenAssuranceData(value string) {
 	c.TokenAssuranceData = (*Max500Binary)(&value)
 }
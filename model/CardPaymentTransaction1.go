@@ -37,10 +37,19 @@ type CardPaymentTransaction1 struct {
 	AdditionalTransactionData *Max70Text `xml:"AddtlTxData,omitempty"`
 }
 
+func (c *CardPaymentTransaction1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction1) SetTransactionCapture(value string) {
 	c.TransactionCapture = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction1) SetTransactionCaptureFromBool(value bool) {
+	c.TransactionCapture = new(TrueFalseIndicator)
+	c.TransactionCapture.FromBool(value)
+}
+
 func (c *CardPaymentTransaction1) SetTransactionType(value string) {
 	c.TransactionType = (*CardPaymentServiceType1Code)(&value)
 }
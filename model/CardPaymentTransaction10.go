@@ -10,6 +10,10 @@ type CardPaymentTransaction10 struct {
 	Action []*Action1 `xml:"Actn,omitempty"`
 }
 
+func (c *CardPaymentTransaction10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction10) AddAuthorisationResult() *AuthorisationResult1 {
 	c.AuthorisationResult = new(AuthorisationResult1)
 	return c.AuthorisationResult
@@ -37,10 +37,19 @@ type CardPaymentTransaction11 struct {
 	AdditionalTransactionData []*Max70Text `xml:"AddtlTxData,omitempty"`
 }
 
+func (c *CardPaymentTransaction11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction11) SetTransactionCapture(value string) {
 	c.TransactionCapture = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction11) SetTransactionCaptureFromBool(value bool) {
+	c.TransactionCapture = new(TrueFalseIndicator)
+	c.TransactionCapture.FromBool(value)
+}
+
 func (c *CardPaymentTransaction11) SetTransactionType(value string) {
 	c.TransactionType = (*CardPaymentServiceType4Code)(&value)
 }
@@ -19,6 +19,10 @@ type CardPaymentTransaction12 struct {
 	TransactionDetails *CardPaymentTransactionDetails11 `xml:"TxDtls"`
 }
 
+func (c *CardPaymentTransaction12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction12) AddTransactionIdentification() *TransactionIdentifier1 {
 	c.TransactionIdentification = new(TransactionIdentifier1)
 	return c.TransactionIdentification
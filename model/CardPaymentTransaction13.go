@@ -61,10 +61,19 @@ type CardPaymentTransaction13 struct {
 	AdditionalTransactionData []*Max70Text `xml:"AddtlTxData,omitempty"`
 }
 
+func (c *CardPaymentTransaction13) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction13) SetTransactionCapture(value string) {
 	c.TransactionCapture = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction13) SetTransactionCaptureFromBool(value bool) {
+	c.TransactionCapture = new(TrueFalseIndicator)
+	c.TransactionCapture.FromBool(value)
+}
+
 func (c *CardPaymentTransaction13) SetTransactionType(value string) {
 	c.TransactionType = (*CardPaymentServiceType4Code)(&value)
 }
@@ -95,14 +104,29 @@ func (c *CardPaymentTransaction13) SetTransactionSuccess(value string) {
 	c.TransactionSuccess = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction13) SetTransactionSuccessFromBool(value bool) {
+	c.TransactionSuccess = new(TrueFalseIndicator)
+	c.TransactionSuccess.FromBool(value)
+}
+
 func (c *CardPaymentTransaction13) SetReversal(value string) {
 	c.Reversal = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction13) SetReversalFromBool(value bool) {
+	c.Reversal = new(TrueFalseIndicator)
+	c.Reversal.FromBool(value)
+}
+
 func (c *CardPaymentTransaction13) SetMerchantOverride(value string) {
 	c.MerchantOverride = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction13) SetMerchantOverrideFromBool(value bool) {
+	c.MerchantOverride = new(TrueFalseIndicator)
+	c.MerchantOverride.FromBool(value)
+}
+
 func (c *CardPaymentTransaction13) AddFailureReason(value string) {
 	c.FailureReason = append(c.FailureReason, (*FailureReason2Code)(&value))
 }
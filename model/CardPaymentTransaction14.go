@@ -58,6 +58,10 @@ type CardPaymentTransaction14 struct {
 	AdditionalTransactionData []*Max70Text `xml:"AddtlTxData,omitempty"`
 }
 
+func (c *CardPaymentTransaction14) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction14) SetTransactionType(value string) {
 	c.TransactionType = (*CardPaymentServiceType4Code)(&value)
 }
@@ -88,14 +92,29 @@ func (c *CardPaymentTransaction14) SetTransactionSuccess(value string) {
 	c.TransactionSuccess = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction14) SetTransactionSuccessFromBool(value bool) {
+	c.TransactionSuccess = new(TrueFalseIndicator)
+	c.TransactionSuccess.FromBool(value)
+}
+
 func (c *CardPaymentTransaction14) SetReversal(value string) {
 	c.Reversal = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction14) SetReversalFromBool(value bool) {
+	c.Reversal = new(TrueFalseIndicator)
+	c.Reversal.FromBool(value)
+}
+
 func (c *CardPaymentTransaction14) SetMerchantOverride(value string) {
 	c.MerchantOverride = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction14) SetMerchantOverrideFromBool(value bool) {
+	c.MerchantOverride = new(TrueFalseIndicator)
+	c.MerchantOverride.FromBool(value)
+}
+
 func (c *CardPaymentTransaction14) AddFailureReason(value string) {
 	c.FailureReason = append(c.FailureReason, (*FailureReason2Code)(&value))
 }
@@ -25,10 +25,19 @@ type CardPaymentTransaction15 struct {
 	AdditionalTransactionData []*Max70Text `xml:"AddtlTxData,omitempty"`
 }
 
+func (c *CardPaymentTransaction15) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction15) SetTransactionCapture(value string) {
 	c.TransactionCapture = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction15) SetTransactionCaptureFromBool(value bool) {
+	c.TransactionCapture = new(TrueFalseIndicator)
+	c.TransactionCapture.FromBool(value)
+}
+
 func (c *CardPaymentTransaction15) SetMerchantCategoryCode(value string) {
 	c.MerchantCategoryCode = (*Min3Max4Text)(&value)
 }
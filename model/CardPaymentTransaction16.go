@@ -40,6 +40,10 @@ type CardPaymentTransaction16 struct {
 	AdditionalTransactionData []*Max70Text `xml:"AddtlTxData,omitempty"`
 }
 
+func (c *CardPaymentTransaction16) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction16) SetMerchantCategoryCode(value string) {
 	c.MerchantCategoryCode = (*Min3Max4Text)(&value)
 }
@@ -58,10 +62,20 @@ func (c *CardPaymentTransaction16) SetTransactionSuccess(value string) {
 	c.TransactionSuccess = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction16) SetTransactionSuccessFromBool(value bool) {
+	c.TransactionSuccess = new(TrueFalseIndicator)
+	c.TransactionSuccess.FromBool(value)
+}
+
 func (c *CardPaymentTransaction16) SetReversal(value string) {
 	c.Reversal = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction16) SetReversalFromBool(value bool) {
+	c.Reversal = new(TrueFalseIndicator)
+	c.Reversal.FromBool(value)
+}
+
 func (c *CardPaymentTransaction16) AddFailureReason(value string) {
 	c.FailureReason = append(c.FailureReason, (*FailureReason2Code)(&value))
 }
@@ -28,6 +28,10 @@ type CardPaymentTransaction17 struct {
 	TransactionResult *CardPaymentTransactionResult1 `xml:"TxRslt,omitempty"`
 }
 
+func (c *CardPaymentTransaction17) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction17) AddTransactionIdentification() *TransactionIdentifier1 {
 	c.TransactionIdentification = new(TransactionIdentifier1)
 	return c.TransactionIdentification
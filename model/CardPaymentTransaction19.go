@@ -37,10 +37,19 @@ type CardPaymentTransaction19 struct {
 	AdditionalTransactionData []*Max70Text `xml:"AddtlTxData,omitempty"`
 }
 
+func (c *CardPaymentTransaction19) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction19) SetTransactionCapture(value string) {
 	c.TransactionCapture = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction19) SetTransactionCaptureFromBool(value bool) {
+	c.TransactionCapture = new(TrueFalseIndicator)
+	c.TransactionCapture.FromBool(value)
+}
+
 func (c *CardPaymentTransaction19) SetTransactionType(value string) {
 	c.TransactionType = (*CardPaymentServiceType4Code)(&value)
 }
@@ -19,6 +19,10 @@ type CardPaymentTransaction2 struct {
 	TransactionDetails *CardPaymentTransactionDetails2 `xml:"TxDtls"`
 }
 
+func (c *CardPaymentTransaction2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction2) AddTransactionIdentification() *TransactionIdentifier1 {
 	c.TransactionIdentification = new(TransactionIdentifier1)
 	return c.TransactionIdentification
@@ -40,6 +40,10 @@ type CardPaymentTransaction20 struct {
 	AdditionalTransactionData []*Max70Text `xml:"AddtlTxData,omitempty"`
 }
 
+func (c *CardPaymentTransaction20) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction20) SetMerchantCategoryCode(value string) {
 	c.MerchantCategoryCode = (*Min3Max4Text)(&value)
 }
@@ -58,10 +62,20 @@ func (c *CardPaymentTransaction20) SetTransactionSuccess(value string) {
 	c.TransactionSuccess = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction20) SetTransactionSuccessFromBool(value bool) {
+	c.TransactionSuccess = new(TrueFalseIndicator)
+	c.TransactionSuccess.FromBool(value)
+}
+
 func (c *CardPaymentTransaction20) SetReversal(value string) {
 	c.Reversal = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction20) SetReversalFromBool(value bool) {
+	c.Reversal = new(TrueFalseIndicator)
+	c.Reversal.FromBool(value)
+}
+
 func (c *CardPaymentTransaction20) AddFailureReason(value string) {
 	c.FailureReason = append(c.FailureReason, (*FailureReason2Code)(&value))
 }
@@ -31,6 +31,10 @@ type CardPaymentTransaction21 struct {
 	TransactionResult *CardPaymentTransactionResult1 `xml:"TxRslt,omitempty"`
 }
 
+func (c *CardPaymentTransaction21) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction21) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
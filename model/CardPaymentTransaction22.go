@@ -40,10 +40,19 @@ type CardPaymentTransaction22 struct {
 	AdditionalTransactionData []*Max70Text `xml:"AddtlTxData,omitempty"`
 }
 
+func (c *CardPaymentTransaction22) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction22) SetTransactionCapture(value string) {
 	c.TransactionCapture = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction22) SetTransactionCaptureFromBool(value bool) {
+	c.TransactionCapture = new(TrueFalseIndicator)
+	c.TransactionCapture.FromBool(value)
+}
+
 func (c *CardPaymentTransaction22) SetTransactionType(value string) {
 	c.TransactionType = (*CardPaymentServiceType5Code)(&value)
 }
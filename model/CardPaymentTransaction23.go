@@ -22,6 +22,10 @@ type CardPaymentTransaction23 struct {
 	TransactionDetails *CardPaymentTransactionDetails13 `xml:"TxDtls"`
 }
 
+func (c *CardPaymentTransaction23) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction23) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
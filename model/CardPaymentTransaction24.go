@@ -19,6 +19,10 @@ type CardPaymentTransaction24 struct {
 	CurrencyConversion *CurrencyConversion1 `xml:"CcyConvs,omitempty"`
 }
 
+func (c *CardPaymentTransaction24) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction24) AddAuthorisationResult() *AuthorisationResult1 {
 	c.AuthorisationResult = new(AuthorisationResult1)
 	return c.AuthorisationResult
@@ -64,10 +64,19 @@ type CardPaymentTransaction25 struct {
 	AdditionalTransactionData []*Max70Text `xml:"AddtlTxData,omitempty"`
 }
 
+func (c *CardPaymentTransaction25) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction25) SetTransactionCapture(value string) {
 	c.TransactionCapture = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction25) SetTransactionCaptureFromBool(value bool) {
+	c.TransactionCapture = new(TrueFalseIndicator)
+	c.TransactionCapture.FromBool(value)
+}
+
 func (c *CardPaymentTransaction25) SetTransactionType(value string) {
 	c.TransactionType = (*CardPaymentServiceType5Code)(&value)
 }
@@ -102,14 +111,29 @@ func (c *CardPaymentTransaction25) SetTransactionSuccess(value string) {
 	c.TransactionSuccess = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction25) SetTransactionSuccessFromBool(value bool) {
+	c.TransactionSuccess = new(TrueFalseIndicator)
+	c.TransactionSuccess.FromBool(value)
+}
+
 func (c *CardPaymentTransaction25) SetReversal(value string) {
 	c.Reversal = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction25) SetReversalFromBool(value bool) {
+	c.Reversal = new(TrueFalseIndicator)
+	c.Reversal.FromBool(value)
+}
+
 func (c *CardPaymentTransaction25) SetMerchantOverride(value string) {
 	c.MerchantOverride = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction25) SetMerchantOverrideFromBool(value bool) {
+	c.MerchantOverride = new(TrueFalseIndicator)
+	c.MerchantOverride.FromBool(value)
+}
+
 func (c *CardPaymentTransaction25) AddFailureReason(value string) {
 	c.FailureReason = append(c.FailureReason, (*FailureReason3Code)(&value))
 }
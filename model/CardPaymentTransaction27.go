@@ -10,6 +10,10 @@ type CardPaymentTransaction27 struct {
 	Action []*Action1 `xml:"Actn,omitempty"`
 }
 
+func (c *CardPaymentTransaction27) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction27) AddAuthorisationResult() *AuthorisationResult3 {
 	c.AuthorisationResult = new(AuthorisationResult3)
 	return c.AuthorisationResult
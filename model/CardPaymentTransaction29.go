@@ -61,6 +61,10 @@ type CardPaymentTransaction29 struct {
 	AdditionalTransactionData []*Max70Text `xml:"AddtlTxData,omitempty"`
 }
 
+func (c *CardPaymentTransaction29) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction29) SetTransactionType(value string) {
 	c.TransactionType = (*CardPaymentServiceType5Code)(&value)
 }
@@ -95,14 +99,29 @@ func (c *CardPaymentTransaction29) SetTransactionSuccess(value string) {
 	c.TransactionSuccess = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction29) SetTransactionSuccessFromBool(value bool) {
+	c.TransactionSuccess = new(TrueFalseIndicator)
+	c.TransactionSuccess.FromBool(value)
+}
+
 func (c *CardPaymentTransaction29) SetReversal(value string) {
 	c.Reversal = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction29) SetReversalFromBool(value bool) {
+	c.Reversal = new(TrueFalseIndicator)
+	c.Reversal.FromBool(value)
+}
+
 func (c *CardPaymentTransaction29) SetMerchantOverride(value string) {
 	c.MerchantOverride = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction29) SetMerchantOverrideFromBool(value bool) {
+	c.MerchantOverride = new(TrueFalseIndicator)
+	c.MerchantOverride.FromBool(value)
+}
+
 func (c *CardPaymentTransaction29) AddFailureReason(value string) {
 	c.FailureReason = append(c.FailureReason, (*FailureReason3Code)(&value))
 }
@@ -22,6 +22,10 @@ type CardPaymentTransaction32 struct {
 	TransactionDetails *CardPaymentTransactionDetails17 `xml:"TxDtls"`
 }
 
+func (c *CardPaymentTransaction32) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction32) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
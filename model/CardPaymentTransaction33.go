@@ -16,6 +16,10 @@ type CardPaymentTransaction33 struct {
 	CurrencyConversion *CurrencyConversion1 `xml:"CcyConvs"`
 }
 
+func (c *CardPaymentTransaction33) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction33) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type CardPaymentTransaction35 struct {
 	TransactionDetails *CardPaymentTransactionDetails6 `xml:"TxDtls"`
 }
 
+func (c *CardPaymentTransaction35) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction35) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
@@ -31,6 +31,10 @@ type CardPaymentTransaction37 struct {
 	TransactionResult *CardPaymentTransactionResult2 `xml:"TxRslt,omitempty"`
 }
 
+func (c *CardPaymentTransaction37) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction37) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type CardPaymentTransaction38 struct {
 	TransactionDetails *CardPaymentTransactionDetails20 `xml:"TxDtls"`
 }
 
+func (c *CardPaymentTransaction38) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction38) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type CardPaymentTransaction39 struct {
 	CurrencyConversion *CurrencyConversion3 `xml:"CcyConvs,omitempty"`
 }
 
+func (c *CardPaymentTransaction39) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction39) AddAuthorisationResult() *AuthorisationResult4 {
 	c.AuthorisationResult = new(AuthorisationResult4)
 	return c.AuthorisationResult
@@ -58,6 +58,10 @@ type CardPaymentTransaction4 struct {
 	AdditionalTransactionData *Max70Text `xml:"AddtlTxData,omitempty"`
 }
 
+func (c *CardPaymentTransaction4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction4) SetTransactionType(value string) {
 	c.TransactionType = (*CardPaymentServiceType1Code)(&value)
 }
@@ -88,14 +92,29 @@ func (c *CardPaymentTransaction4) SetTransactionSuccess(value string) {
 	c.TransactionSuccess = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction4) SetTransactionSuccessFromBool(value bool) {
+	c.TransactionSuccess = new(TrueFalseIndicator)
+	c.TransactionSuccess.FromBool(value)
+}
+
 func (c *CardPaymentTransaction4) SetReversal(value string) {
 	c.Reversal = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction4) SetReversalFromBool(value bool) {
+	c.Reversal = new(TrueFalseIndicator)
+	c.Reversal.FromBool(value)
+}
+
 func (c *CardPaymentTransaction4) SetMerchantOverride(value string) {
 	c.MerchantOverride = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransaction4) SetMerchantOverrideFromBool(value bool) {
+	c.MerchantOverride = new(TrueFalseIndicator)
+	c.MerchantOverride.FromBool(value)
+}
+
 func (c *CardPaymentTransaction4) AddFailureReason(value string) {
 	c.FailureReason = append(c.FailureReason, (*FailureReason1Code)(&value))
 }
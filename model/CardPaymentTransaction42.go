@@ -22,6 +22,10 @@ type CardPaymentTransaction42 struct {
 	TransactionDetails *CardPaymentTransactionDetails22 `xml:"TxDtls"`
 }
 
+func (c *CardPaymentTransaction42) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction42) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type CardPaymentTransaction43 struct {
 	Action []*Action3 `xml:"Actn,omitempty"`
 }
 
+func (c *CardPaymentTransaction43) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction43) AddAuthorisationResult() *AuthorisationResult6 {
 	c.AuthorisationResult = new(AuthorisationResult6)
 	return c.AuthorisationResult
@@ -22,6 +22,10 @@ type CardPaymentTransaction48 struct {
 	TransactionDetails *CardPaymentTransactionDetails25 `xml:"TxDtls"`
 }
 
+func (c *CardPaymentTransaction48) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction48) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type CardPaymentTransaction50 struct {
 	CurrencyConversion *CurrencyConversion3 `xml:"CcyConvs"`
 }
 
+func (c *CardPaymentTransaction50) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction50) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
@@ -34,6 +34,10 @@ type CardPaymentTransaction52 struct {
 	TransactionResult *CardPaymentTransactionResult3 `xml:"TxRslt,omitempty"`
 }
 
+func (c *CardPaymentTransaction52) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction52) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
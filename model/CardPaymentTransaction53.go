@@ -28,6 +28,10 @@ type CardPaymentTransaction53 struct {
 	MerchantReferenceData *Max70Text `xml:"MrchntRefData,omitempty"`
 }
 
+func (c *CardPaymentTransaction53) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction53) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
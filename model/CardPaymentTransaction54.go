@@ -31,6 +31,10 @@ type CardPaymentTransaction54 struct {
 	CurrencyConversionEligibility *CurrencyConversion6 `xml:"CcyConvsElgblty,omitempty"`
 }
 
+func (c *CardPaymentTransaction54) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction54) AddAuthorisationResult() *AuthorisationResult10 {
 	c.AuthorisationResult = new(AuthorisationResult10)
 	return c.AuthorisationResult
@@ -25,6 +25,10 @@ type CardPaymentTransaction57 struct {
 	TransactionDetails *CardPaymentTransactionDetails35 `xml:"TxDtls"`
 }
 
+func (c *CardPaymentTransaction57) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction57) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type CardPaymentTransaction58 struct {
 	Action []*Action6 `xml:"Actn,omitempty"`
 }
 
+func (c *CardPaymentTransaction58) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction58) AddAuthorisationResult() *AuthorisationResult12 {
 	c.AuthorisationResult = new(AuthorisationResult12)
 	return c.AuthorisationResult
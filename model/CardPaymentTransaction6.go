@@ -19,6 +19,10 @@ type CardPaymentTransaction6 struct {
 	TransactionDetails *CardPaymentTransactionDetails6 `xml:"TxDtls"`
 }
 
+func (c *CardPaymentTransaction6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction6) AddTransactionIdentification() *TransactionIdentifier1 {
 	c.TransactionIdentification = new(TransactionIdentifier1)
 	return c.TransactionIdentification
@@ -25,6 +25,10 @@ type CardPaymentTransaction63 struct {
 	TransactionDetails *CardPaymentTransactionDetails32 `xml:"TxDtls"`
 }
 
+func (c *CardPaymentTransaction63) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction63) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type CardPaymentTransaction65 struct {
 	CurrencyConversion *CurrencyConversion7 `xml:"CcyConvs"`
 }
 
+func (c *CardPaymentTransaction65) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction65) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
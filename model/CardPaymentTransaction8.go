@@ -28,6 +28,10 @@ type CardPaymentTransaction8 struct {
 	TransactionResult *CardPaymentTransactionResult1 `xml:"TxRslt,omitempty"`
 }
 
+func (c *CardPaymentTransaction8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction8) AddTransactionIdentification() *TransactionIdentifier1 {
 	c.TransactionIdentification = new(TransactionIdentifier1)
 	return c.TransactionIdentification
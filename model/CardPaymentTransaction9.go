@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Authorisation response from the acquirer.
 type CardPaymentTransaction9 struct {
 
@@ -19,6 +23,10 @@ type CardPaymentTransaction9 struct {
 	Action []*Action1 `xml:"Actn,omitempty"`
 }
 
+func (c *CardPaymentTransaction9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransaction9) AddAuthorisationResult() *AuthorisationResult1 {
 	c.AuthorisationResult = new(AuthorisationResult1)
 	return c.AuthorisationResult
@@ -33,6 +41,10 @@ func (c *CardPaymentTransaction9) SetBalance(value, currency string) {
 	c.Balance = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransaction9) SetBalanceFromDecimal(value decimal.Decimal, currency string) {
+	c.Balance = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransaction9) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -10,6 +10,10 @@ type CardPaymentTransactionAdviceResponse1 struct {
 	Response *Response1Code `xml:"Rspn"`
 }
 
+func (c *CardPaymentTransactionAdviceResponse1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionAdviceResponse1) AddTransactionIdentification() *TransactionIdentifier1 {
 	c.TransactionIdentification = new(TransactionIdentifier1)
 	return c.TransactionIdentification
@@ -7,6 +7,10 @@ type CardPaymentTransactionAdviceResponse2 struct {
 	TransactionIdentification *TransactionIdentifier1 `xml:"TxId"`
 }
 
+func (c *CardPaymentTransactionAdviceResponse2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionAdviceResponse2) AddTransactionIdentification() *TransactionIdentifier1 {
 	c.TransactionIdentification = new(TransactionIdentifier1)
 	return c.TransactionIdentification
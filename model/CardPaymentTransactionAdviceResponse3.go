@@ -13,6 +13,10 @@ type CardPaymentTransactionAdviceResponse3 struct {
 	Response *Response1Code `xml:"Rspn"`
 }
 
+func (c *CardPaymentTransactionAdviceResponse3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionAdviceResponse3) AddTransactionIdentification() *TransactionIdentifier1 {
 	c.TransactionIdentification = new(TransactionIdentifier1)
 	return c.TransactionIdentification
@@ -16,6 +16,10 @@ type CardPaymentTransactionAdviceResponse4 struct {
 	Response *Response1Code `xml:"Rspn"`
 }
 
+func (c *CardPaymentTransactionAdviceResponse4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionAdviceResponse4) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
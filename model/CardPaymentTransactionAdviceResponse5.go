@@ -13,6 +13,10 @@ type CardPaymentTransactionAdviceResponse5 struct {
 	Response *Response1Code `xml:"Rspn"`
 }
 
+func (c *CardPaymentTransactionAdviceResponse5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionAdviceResponse5) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
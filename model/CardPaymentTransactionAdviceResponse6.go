@@ -22,6 +22,10 @@ type CardPaymentTransactionAdviceResponse6 struct {
 	Response *Response4Code `xml:"Rspn"`
 }
 
+func (c *CardPaymentTransactionAdviceResponse6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionAdviceResponse6) SetSaleReferenceIdentification(value string) {
 	c.SaleReferenceIdentification = (*Max35Text)(&value)
 }
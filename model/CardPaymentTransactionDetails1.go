@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction in the authorisation request.
 type CardPaymentTransactionDetails1 struct {
 
@@ -37,6 +43,10 @@ type CardPaymentTransactionDetails1 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails1) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -45,6 +55,10 @@ func (c *CardPaymentTransactionDetails1) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails1) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails1) SetAmountQualifier(value string) {
 	c.AmountQualifier = (*TypeOfAmount1Code)(&value)
 }
@@ -199,6 +213,11 @@ func (c *CardPaymentTransactionDetails1) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardPaymentTransactionDetails1) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardPaymentTransactionDetails1) SetOnLineReason(value string) {
 	c.OnLineReason = (*OnLineReason1Code)(&value)
 }
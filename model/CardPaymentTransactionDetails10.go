@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction in the authorisation request in a batch.
 type CardPaymentTransactionDetails10 struct {
 
@@ -37,6 +43,10 @@ type CardPaymentTransactionDetails10 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails10) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -45,6 +55,10 @@ func (c *CardPaymentTransactionDetails10) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails10) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails10) SetAmountQualifier(value string) {
 	c.AmountQualifier = (*TypeOfAmount1Code)(&value)
 }
@@ -199,6 +213,11 @@ func (c *CardPaymentTransactionDetails10) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardPaymentTransactionDetails10) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardPaymentTransactionDetails10) SetOnLineReason(value string) {
 	c.OnLineReason = (*OnLineReason1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction in the authorisation response contained in a batch.
 type CardPaymentTransactionDetails11 struct {
 
@@ -22,6 +28,10 @@ type CardPaymentTransactionDetails11 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails11) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -30,6 +40,10 @@ func (c *CardPaymentTransactionDetails11) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails11) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails11) AddDetailedAmount() *DetailedAmount2 {
 	newValue := new(DetailedAmount2)
 	c.DetailedAmount = append(c.DetailedAmount, newValue)
@@ -180,6 +194,11 @@ func (c *CardPaymentTransactionDetails11) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardPaymentTransactionDetails11) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardPaymentTransactionDetails11) SetAccountType(value string) {
 	c.AccountType = (*CardAccountType1Code)(&value)
 }
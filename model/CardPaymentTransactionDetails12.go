@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction in the authorisation request.
 type CardPaymentTransactionDetails12 struct {
 
@@ -49,6 +55,10 @@ type CardPaymentTransactionDetails12 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails12) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -57,6 +67,10 @@ func (c *CardPaymentTransactionDetails12) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails12) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails12) SetAmountQualifier(value string) {
 	c.AmountQualifier = (*TypeOfAmount1Code)(&value)
 }
@@ -70,6 +84,11 @@ func (c *CardPaymentTransactionDetails12) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardPaymentTransactionDetails12) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardPaymentTransactionDetails12) SetOnLineReason(value string) {
 	c.OnLineReason = (*OnLineReason1Code)(&value)
 }
@@ -86,6 +105,11 @@ func (c *CardPaymentTransactionDetails12) SetConversionAccepted(value string) {
 	c.ConversionAccepted = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransactionDetails12) SetConversionAcceptedFromBool(value bool) {
+	c.ConversionAccepted = new(TrueFalseIndicator)
+	c.ConversionAccepted.FromBool(value)
+}
+
 func (c *CardPaymentTransactionDetails12) AddCurrencyConversion() *CurrencyConversion1 {
 	c.CurrencyConversion = new(CurrencyConversion1)
 	return c.CurrencyConversion
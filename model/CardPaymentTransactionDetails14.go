@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction in the completion advice.
 type CardPaymentTransactionDetails14 struct {
 
@@ -49,6 +55,10 @@ type CardPaymentTransactionDetails14 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails14) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails14) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -57,6 +67,10 @@ func (c *CardPaymentTransactionDetails14) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails14) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails14) SetAmountQualifier(value string) {
 	c.AmountQualifier = (*TypeOfAmount1Code)(&value)
 }
@@ -70,14 +84,27 @@ func (c *CardPaymentTransactionDetails14) SetRequestedAmount(value, currency str
 	c.RequestedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails14) SetRequestedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RequestedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails14) SetAuthorisedAmount(value, currency string) {
 	c.AuthorisedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails14) SetAuthorisedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AuthorisedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails14) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardPaymentTransactionDetails14) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardPaymentTransactionDetails14) SetUnattendedLevelCategory(value string) {
 	c.UnattendedLevelCategory = (*Max35NumericText)(&value)
 }
@@ -90,6 +117,11 @@ func (c *CardPaymentTransactionDetails14) SetConversionAccepted(value string) {
 	c.ConversionAccepted = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransactionDetails14) SetConversionAcceptedFromBool(value bool) {
+	c.ConversionAccepted = new(TrueFalseIndicator)
+	c.ConversionAccepted.FromBool(value)
+}
+
 func (c *CardPaymentTransactionDetails14) AddCurrencyConversion() *CurrencyConversion1 {
 	c.CurrencyConversion = new(CurrencyConversion1)
 	return c.CurrencyConversion
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction to capture.
 type CardPaymentTransactionDetails15 struct {
 
@@ -52,6 +58,10 @@ type CardPaymentTransactionDetails15 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails15) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails15) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -60,6 +70,10 @@ func (c *CardPaymentTransactionDetails15) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails15) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails15) SetAmountQualifier(value string) {
 	c.AmountQualifier = (*TypeOfAmount1Code)(&value)
 }
@@ -73,14 +87,27 @@ func (c *CardPaymentTransactionDetails15) SetRequestedAmount(value, currency str
 	c.RequestedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails15) SetRequestedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RequestedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails15) SetAuthorisedAmount(value, currency string) {
 	c.AuthorisedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails15) SetAuthorisedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AuthorisedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails15) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardPaymentTransactionDetails15) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardPaymentTransactionDetails15) SetUnattendedLevelCategory(value string) {
 	c.UnattendedLevelCategory = (*Max35NumericText)(&value)
 }
@@ -93,6 +120,11 @@ func (c *CardPaymentTransactionDetails15) SetConversionAccepted(value string) {
 	c.ConversionAccepted = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardPaymentTransactionDetails15) SetConversionAcceptedFromBool(value bool) {
+	c.ConversionAccepted = new(TrueFalseIndicator)
+	c.ConversionAccepted.FromBool(value)
+}
+
 func (c *CardPaymentTransactionDetails15) AddCurrencyConversion() *CurrencyConversion1 {
 	c.CurrencyConversion = new(CurrencyConversion1)
 	return c.CurrencyConversion
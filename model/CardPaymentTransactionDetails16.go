@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction in the authorisation request in a batch.
 type CardPaymentTransactionDetails16 struct {
 
@@ -43,6 +49,10 @@ type CardPaymentTransactionDetails16 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails16) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails16) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -51,6 +61,10 @@ func (c *CardPaymentTransactionDetails16) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails16) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails16) SetAmountQualifier(value string) {
 	c.AmountQualifier = (*TypeOfAmount1Code)(&value)
 }
@@ -64,6 +78,11 @@ func (c *CardPaymentTransactionDetails16) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardPaymentTransactionDetails16) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardPaymentTransactionDetails16) SetOnLineReason(value string) {
 	c.OnLineReason = (*OnLineReason1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction in the authorisation request.
 type CardPaymentTransactionDetails19 struct {
 
@@ -46,6 +52,10 @@ type CardPaymentTransactionDetails19 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails19) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails19) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -54,6 +64,10 @@ func (c *CardPaymentTransactionDetails19) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails19) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails19) SetAmountQualifier(value string) {
 	c.AmountQualifier = (*TypeOfAmount1Code)(&value)
 }
@@ -67,6 +81,11 @@ func (c *CardPaymentTransactionDetails19) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardPaymentTransactionDetails19) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardPaymentTransactionDetails19) SetOnLineReason(value string) {
 	c.OnLineReason = (*OnLineReason1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction in the authorisation response.
 type CardPaymentTransactionDetails20 struct {
 
@@ -22,6 +28,10 @@ type CardPaymentTransactionDetails20 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails20) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails20) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -30,6 +40,10 @@ func (c *CardPaymentTransactionDetails20) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails20) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails20) AddDetailedAmount() *DetailedAmount7 {
 	c.DetailedAmount = new(DetailedAmount7)
 	return c.DetailedAmount
@@ -39,6 +53,11 @@ func (c *CardPaymentTransactionDetails20) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardPaymentTransactionDetails20) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardPaymentTransactionDetails20) SetAccountType(value string) {
 	c.AccountType = (*CardAccountType2Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction in the completion advice.
 type CardPaymentTransactionDetails21 struct {
 
@@ -49,6 +55,10 @@ type CardPaymentTransactionDetails21 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails21) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails21) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -57,6 +67,10 @@ func (c *CardPaymentTransactionDetails21) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails21) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails21) SetAmountQualifier(value string) {
 	c.AmountQualifier = (*TypeOfAmount1Code)(&value)
 }
@@ -70,14 +84,27 @@ func (c *CardPaymentTransactionDetails21) SetRequestedAmount(value, currency str
 	c.RequestedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails21) SetRequestedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RequestedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails21) SetAuthorisedAmount(value, currency string) {
 	c.AuthorisedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails21) SetAuthorisedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AuthorisedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails21) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardPaymentTransactionDetails21) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardPaymentTransactionDetails21) SetUnattendedLevelCategory(value string) {
 	c.UnattendedLevelCategory = (*Max35NumericText)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction in the cancellation response.
 type CardPaymentTransactionDetails22 struct {
 
@@ -13,6 +17,10 @@ type CardPaymentTransactionDetails22 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails22) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails22) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -21,6 +29,10 @@ func (c *CardPaymentTransactionDetails22) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails22) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails22) SetICCRelatedData(value string) {
 	c.ICCRelatedData = (*Max10000Binary)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction in the authorisation response contained in a batch.
 type CardPaymentTransactionDetails25 struct {
 
@@ -22,6 +28,10 @@ type CardPaymentTransactionDetails25 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails25) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails25) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -30,6 +40,10 @@ func (c *CardPaymentTransactionDetails25) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails25) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails25) AddDetailedAmount() *DetailedAmount7 {
 	c.DetailedAmount = new(DetailedAmount7)
 	return c.DetailedAmount
@@ -39,6 +53,11 @@ func (c *CardPaymentTransactionDetails25) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardPaymentTransactionDetails25) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardPaymentTransactionDetails25) SetAccountType(value string) {
 	c.AccountType = (*CardAccountType2Code)(&value)
 }
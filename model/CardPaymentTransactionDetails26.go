@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the payment transaction.
 type CardPaymentTransactionDetails26 struct {
 
@@ -19,6 +23,10 @@ type CardPaymentTransactionDetails26 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails26) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails26) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -27,6 +35,10 @@ func (c *CardPaymentTransactionDetails26) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails26) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails26) SetAmountQualifier(value string) {
 	c.AmountQualifier = (*TypeOfAmount1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction in the authorisation response.
 type CardPaymentTransactionDetails28 struct {
 
@@ -25,6 +31,10 @@ type CardPaymentTransactionDetails28 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails28) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails28) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -33,6 +43,10 @@ func (c *CardPaymentTransactionDetails28) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails28) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails28) AddDetailedAmount() *DetailedAmount15 {
 	c.DetailedAmount = new(DetailedAmount15)
 	return c.DetailedAmount
@@ -47,6 +61,11 @@ func (c *CardPaymentTransactionDetails28) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardPaymentTransactionDetails28) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardPaymentTransactionDetails28) SetAccountType(value string) {
 	c.AccountType = (*CardAccountType3Code)(&value)
 }
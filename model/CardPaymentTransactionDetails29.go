@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction in the completion advice.
 type CardPaymentTransactionDetails29 struct {
 
@@ -58,6 +64,10 @@ type CardPaymentTransactionDetails29 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails29) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails29) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -66,6 +76,10 @@ func (c *CardPaymentTransactionDetails29) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails29) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails29) SetAmountQualifier(value string) {
 	c.AmountQualifier = (*TypeOfAmount1Code)(&value)
 }
@@ -79,18 +93,35 @@ func (c *CardPaymentTransactionDetails29) SetRequestedAmount(value, currency str
 	c.RequestedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails29) SetRequestedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RequestedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails29) SetAuthorisedAmount(value, currency string) {
 	c.AuthorisedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails29) SetAuthorisedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AuthorisedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails29) SetInvoiceAmount(value, currency string) {
 	c.InvoiceAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails29) SetInvoiceAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InvoiceAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails29) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardPaymentTransactionDetails29) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardPaymentTransactionDetails29) SetUnattendedLevelCategory(value string) {
 	c.UnattendedLevelCategory = (*Max35NumericText)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction in the authorisation response contained in a batch.
 type CardPaymentTransactionDetails32 struct {
 
@@ -25,6 +31,10 @@ type CardPaymentTransactionDetails32 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails32) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails32) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -33,6 +43,10 @@ func (c *CardPaymentTransactionDetails32) SetTotalAmount(value, currency string)
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails32) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails32) AddDetailedAmount() *DetailedAmount15 {
 	c.DetailedAmount = new(DetailedAmount15)
 	return c.DetailedAmount
@@ -42,10 +56,19 @@ func (c *CardPaymentTransactionDetails32) SetInvoiceAmount(value, currency strin
 	c.InvoiceAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CardPaymentTransactionDetails32) SetInvoiceAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InvoiceAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardPaymentTransactionDetails32) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardPaymentTransactionDetails32) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardPaymentTransactionDetails32) SetAccountType(value string) {
 	c.AccountType = (*CardAccountType3Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction in the cancellation response.
 type CardPaymentTransactionDetails6 struct {
 
@@ -10,6 +14,10 @@ type CardPaymentTransactionDetails6 struct {
 	TotalAmount *ImpliedCurrencyAndAmount `xml:"TtlAmt"`
 }
 
+func (c *CardPaymentTransactionDetails6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails6) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -17,3 +25,7 @@ func (c *CardPaymentTransactionDetails6) SetCurrency(value string) {
 func (c *CardPaymentTransactionDetails6) SetTotalAmount(value, currency string) {
 	c.TotalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (c *CardPaymentTransactionDetails6) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
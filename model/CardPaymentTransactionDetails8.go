@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Details of the transaction in the authorisation request.
 type CardPaymentTransactionDetails8 struct {
 
@@ -31,6 +35,10 @@ type CardPaymentTransactionDetails8 struct {
 	TransactionReference *Max35Text `xml:"TxRef,omitempty"`
 }
 
+func (c *CardPaymentTransactionDetails8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionDetails8) AddAmount() *CardAmountAndCurrencyExchange1 {
 	newValue := new(CardAmountAndCurrencyExchange1)
 	c.Amount = append(c.Amount, newValue)
@@ -194,6 +202,11 @@ func (c *CardPaymentTransactionDetails8) SetTransactionDateTime(value string) {
 	c.TransactionDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CardPaymentTransactionDetails8) SetTransactionDateTimeFromTime(value time.Time) {
+	c.TransactionDateTime = new(ISODateTime)
+	c.TransactionDateTime.FromTime(value)
+}
+
 func (c *CardPaymentTransactionDetails8) SetSaleReferenceNumber(value string) {
 	c.SaleReferenceNumber = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type CardPaymentTransactionResult1 struct {
 	AuthorisationCode *Min6Max8Text `xml:"AuthstnCd,omitempty"`
 }
 
+func (c *CardPaymentTransactionResult1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionResult1) AddAuthorisationEntity() *GenericIdentification33 {
 	c.AuthorisationEntity = new(GenericIdentification33)
 	return c.AuthorisationEntity
@@ -13,6 +13,10 @@ type CardPaymentTransactionResult2 struct {
 	AuthorisationCode *Min6Max8Text `xml:"AuthstnCd,omitempty"`
 }
 
+func (c *CardPaymentTransactionResult2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionResult2) AddAuthorisationEntity() *GenericIdentification70 {
 	c.AuthorisationEntity = new(GenericIdentification70)
 	return c.AuthorisationEntity
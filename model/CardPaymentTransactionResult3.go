@@ -13,6 +13,10 @@ type CardPaymentTransactionResult3 struct {
 	AuthorisationCode *Min6Max8Text `xml:"AuthstnCd,omitempty"`
 }
 
+func (c *CardPaymentTransactionResult3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardPaymentTransactionResult3) AddAuthorisationEntity() *GenericIdentification90 {
 	c.AuthorisationEntity = new(GenericIdentification90)
 	return c.AuthorisationEntity
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Card security code (CSC) associated with the card performing the transaction.
 type CardSecurityInformation1 struct {
 
@@ -10,6 +12,10 @@ type CardSecurityInformation1 struct {
 	CSCValue *Min3Max4NumericText `xml:"CSCVal,omitempty"`
 }
 
+func (c *CardSecurityInformation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardSecurityInformation1) SetCSCManagement(value string) {
 	c.CSCManagement = (*CSCManagement1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (c *CardSecurityInformation1) SetCSCManagement(value string) {
 func (c *CardSecurityInformation1) SetCSCValue(value string) {
 	c.CSCValue = (*Min3Max4NumericText)(&value)
 }
+
+func (c *CardSecurityInformation1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.CSCManagement != nil {
+		enc.Element("CSCMgmt", string(*c.CSCManagement))
+	}
+	if c.CSCValue != nil {
+		enc.Element("CSCVal", string(*c.CSCValue))
+	}
+	enc.End(tag)
+}
+
+func (c *CardSecurityInformation1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "CSCMgmt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.CSCManagement = (*CSCManagement1Code)(&value)
+		case "CSCVal":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.CSCValue = (*Min3Max4NumericText)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
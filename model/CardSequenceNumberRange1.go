@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Range of sequence numbers related to card transactions.
 type CardSequenceNumberRange1 struct {
 
@@ -10,6 +12,10 @@ type CardSequenceNumberRange1 struct {
 	LastTransaction *Max35Text `xml:"LastTx,omitempty"`
 }
 
+func (c *CardSequenceNumberRange1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardSequenceNumberRange1) SetFirstTransaction(value string) {
 	c.FirstTransaction = (*Max35Text)(&value)
 }
@@ -17,3 +23,44 @@ func (c *CardSequenceNumberRange1) SetFirstTransaction(value string) {
 func (c *CardSequenceNumberRange1) SetLastTransaction(value string) {
 	c.LastTransaction = (*Max35Text)(&value)
 }
+
+func (c *CardSequenceNumberRange1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.FirstTransaction != nil {
+		enc.Element("FrstTx", string(*c.FirstTransaction))
+	}
+	if c.LastTransaction != nil {
+		enc.Element("LastTx", string(*c.LastTransaction))
+	}
+	enc.End(tag)
+}
+
+func (c *CardSequenceNumberRange1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FrstTx":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.FirstTransaction = (*Max35Text)(&value)
+		case "LastTx":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.LastTransaction = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -13,6 +13,10 @@ type CardTransaction1 struct {
 	Transaction *CardTransaction1Choice `xml:"Tx,omitempty"`
 }
 
+func (c *CardTransaction1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransaction1) AddCard() *PaymentCard4 {
 	c.Card = new(PaymentCard4)
 	return c.Card
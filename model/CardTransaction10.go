@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Card transaction for which the authorisation has been requested.
 type CardTransaction10 struct {
 
@@ -28,6 +32,10 @@ type CardTransaction10 struct {
 	TransactionResponse *ResponseType2 `xml:"TxRspn"`
 }
 
+func (c *CardTransaction10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransaction10) AddReconciliation() *TransactionIdentifier2 {
 	c.Reconciliation = new(TransactionIdentifier2)
 	return c.Reconciliation
@@ -37,10 +45,20 @@ func (c *CardTransaction10) SetInterBankSettlementDate(value string) {
 	c.InterBankSettlementDate = (*ISODate)(&value)
 }
 
+func (c *CardTransaction10) SetInterBankSettlementDateFromTime(value time.Time) {
+	c.InterBankSettlementDate = new(ISODate)
+	c.InterBankSettlementDate.FromTime(value)
+}
+
 func (c *CardTransaction10) SetInitiatorTransactionDateTime(value string) {
 	c.InitiatorTransactionDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CardTransaction10) SetInitiatorTransactionDateTimeFromTime(value time.Time) {
+	c.InitiatorTransactionDateTime = new(ISODateTime)
+	c.InitiatorTransactionDateTime.FromTime(value)
+}
+
 func (c *CardTransaction10) SetInitiatorTransactionIdentification(value string) {
 	c.InitiatorTransactionIdentification = (*Max35Text)(&value)
 }
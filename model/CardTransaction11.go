@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Network management transaction.
 type CardTransaction11 struct {
 
@@ -19,6 +25,10 @@ type CardTransaction11 struct {
 	TransactionResponse *ResponseType2 `xml:"TxRspn,omitempty"`
 }
 
+func (c *CardTransaction11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransaction11) SetNetworkManagementType(value string) {
 	c.NetworkManagementType = (*CardServiceType2Code)(&value)
 }
@@ -27,14 +37,29 @@ func (c *CardTransaction11) SetNumberOfMessages(value string) {
 	c.NumberOfMessages = (*Number)(&value)
 }
 
+func (c *CardTransaction11) SetNumberOfMessagesFromDecimal(value decimal.Decimal) {
+	c.NumberOfMessages = new(Number)
+	c.NumberOfMessages.FromDecimal(value)
+}
+
 func (c *CardTransaction11) SetMaximumNumberOfMessages(value string) {
 	c.MaximumNumberOfMessages = (*Number)(&value)
 }
 
+func (c *CardTransaction11) SetMaximumNumberOfMessagesFromDecimal(value decimal.Decimal) {
+	c.MaximumNumberOfMessages = new(Number)
+	c.MaximumNumberOfMessages.FromDecimal(value)
+}
+
 func (c *CardTransaction11) SetInitiatorDateTime(value string) {
 	c.InitiatorDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CardTransaction11) SetInitiatorDateTimeFromTime(value time.Time) {
+	c.InitiatorDateTime = new(ISODateTime)
+	c.InitiatorDateTime.FromTime(value)
+}
+
 func (c *CardTransaction11) AddTransactionResponse() *ResponseType2 {
 	c.TransactionResponse = new(ResponseType2)
 	return c.TransactionResponse
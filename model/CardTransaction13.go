@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Key exchange transaction.
 type CardTransaction13 struct {
 
@@ -19,6 +23,10 @@ type CardTransaction13 struct {
 	TransactionResponse *ResponseType2 `xml:"TxRspn,omitempty"`
 }
 
+func (c *CardTransaction13) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransaction13) SetKeyExchangeType(value string) {
 	c.KeyExchangeType = (*CardServiceType3Code)(&value)
 }
@@ -27,6 +35,11 @@ func (c *CardTransaction13) SetInitiatorDateTime(value string) {
 	c.InitiatorDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CardTransaction13) SetInitiatorDateTimeFromTime(value time.Time) {
+	c.InitiatorDateTime = new(ISODateTime)
+	c.InitiatorDateTime.FromTime(value)
+}
+
 func (c *CardTransaction13) AddRequestedKey() *KEKIdentifier3 {
 	newValue := new(KEKIdentifier3)
 	c.RequestedKey = append(c.RequestedKey, newValue)
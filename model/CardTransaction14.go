@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Key exchange transaction.
 type CardTransaction14 struct {
 
@@ -19,6 +23,10 @@ type CardTransaction14 struct {
 	TransactionResponse *ResponseType2 `xml:"TxRspn"`
 }
 
+func (c *CardTransaction14) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransaction14) SetKeyExchangeType(value string) {
 	c.KeyExchangeType = (*CardServiceType3Code)(&value)
 }
@@ -27,6 +35,11 @@ func (c *CardTransaction14) SetInitiatorDateTime(value string) {
 	c.InitiatorDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CardTransaction14) SetInitiatorDateTimeFromTime(value time.Time) {
+	c.InitiatorDateTime = new(ISODateTime)
+	c.InitiatorDateTime.FromTime(value)
+}
+
 func (c *CardTransaction14) AddKeyVerification() *KEKIdentifier3 {
 	newValue := new(KEKIdentifier3)
 	c.KeyVerification = append(c.KeyVerification, newValue)
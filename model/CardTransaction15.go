@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Card transaction for which the authorisation is requested.
 type CardTransaction15 struct {
 
@@ -53,6 +59,10 @@ type CardTransaction15 struct {
 	AuthorisationResult *AuthorisationResult7 `xml:"AuthstnRslt,omitempty"`
 }
 
+func (c *CardTransaction15) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransaction15) SetTransactionType(value string) {
 	c.TransactionType = (*CardPaymentServiceType7Code)(&value)
 }
@@ -78,6 +88,11 @@ func (c *CardTransaction15) SetAcceptorTransactionDateTime(value string) {
 	c.AcceptorTransactionDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CardTransaction15) SetAcceptorTransactionDateTimeFromTime(value time.Time) {
+	c.AcceptorTransactionDateTime = new(ISODateTime)
+	c.AcceptorTransactionDateTime.FromTime(value)
+}
+
 func (c *CardTransaction15) SetAcceptorTransactionIdentification(value string) {
 	c.AcceptorTransactionIdentification = (*Max35Text)(&value)
 }
@@ -94,10 +109,20 @@ func (c *CardTransaction15) SetTransactionLifeCycleSequenceNumber(value string)
 	c.TransactionLifeCycleSequenceNumber = (*Number)(&value)
 }
 
+func (c *CardTransaction15) SetTransactionLifeCycleSequenceNumberFromDecimal(value decimal.Decimal) {
+	c.TransactionLifeCycleSequenceNumber = new(Number)
+	c.TransactionLifeCycleSequenceNumber.FromDecimal(value)
+}
+
 func (c *CardTransaction15) SetTransactionLifeCycleSequenceCounter(value string) {
 	c.TransactionLifeCycleSequenceCounter = (*Number)(&value)
 }
 
+func (c *CardTransaction15) SetTransactionLifeCycleSequenceCounterFromDecimal(value decimal.Decimal) {
+	c.TransactionLifeCycleSequenceCounter = new(Number)
+	c.TransactionLifeCycleSequenceCounter.FromDecimal(value)
+}
+
 func (c *CardTransaction15) AddOriginalTransaction() *CardTransaction3 {
 	c.OriginalTransaction = new(CardTransaction3)
 	return c.OriginalTransaction
@@ -10,6 +10,10 @@ type CardTransaction1Choice struct {
 	Individual *CardIndividualTransaction1 `xml:"Indv"`
 }
 
+func (c *CardTransaction1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransaction1Choice) AddAggregated() *CardAggregated1 {
 	c.Aggregated = new(CardAggregated1)
 	return c.Aggregated
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Choice between a acquirer globalised card transaction or an individual card transaction.
 type CardTransaction2Choice struct {
 
@@ -10,6 +12,10 @@ type CardTransaction2Choice struct {
 	Individual *CardIndividualTransaction2 `xml:"Indv"`
 }
 
+func (c *CardTransaction2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransaction2Choice) AddAggregated() *CardAggregated1 {
 	c.Aggregated = new(CardAggregated1)
 	return c.Aggregated
@@ -19,3 +25,44 @@ func (c *CardTransaction2Choice) AddIndividual() *CardIndividualTransaction2 {
 	c.Individual = new(CardIndividualTransaction2)
 	return c.Individual
 }
+
+func (c *CardTransaction2Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Aggregated != nil {
+		c.Aggregated.EncodeXMLFast(enc, "Aggtd")
+	}
+	if c.Individual != nil {
+		c.Individual.EncodeXMLFast(enc, "Indv")
+	}
+	enc.End(tag)
+}
+
+func (c *CardTransaction2Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Aggtd":
+			elem := new(CardAggregated1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Aggregated = elem
+		case "Indv":
+			elem := new(CardIndividualTransaction2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Individual = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
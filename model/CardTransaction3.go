@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identification of the original transaction.
 // It corresponds to ISO 8583, field number 90 for the version 87, and 56 for the other versions.
 type CardTransaction3 struct {
@@ -15,10 +19,19 @@ type CardTransaction3 struct {
 	InitiatorIdentification *Max35Text `xml:"InitrId"`
 }
 
+func (c *CardTransaction3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransaction3) SetAcceptorTransactionDateTime(value string) {
 	c.AcceptorTransactionDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CardTransaction3) SetAcceptorTransactionDateTimeFromTime(value time.Time) {
+	c.AcceptorTransactionDateTime = new(ISODateTime)
+	c.AcceptorTransactionDateTime.FromTime(value)
+}
+
 func (c *CardTransaction3) SetInitiatorTransactionIdentification(value string) {
 	c.InitiatorTransactionIdentification = (*Max35Text)(&value)
 }
@@ -0,0 +1,68 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Choice between a acquirer globalised card transaction or an individual card transaction.
+type CardTransaction3Choice struct {
+
+	// Card transaction details, based on card transaction aggregated data performed by the card acquirer.
+	Aggregated *CardAggregated2 `xml:"Aggtd"`
+
+	// Card transaction details for the individual transaction, as recorded at the POI (point of interaction).
+	Individual *CardIndividualTransaction2 `xml:"Indv"`
+}
+
+func (c *CardTransaction3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CardTransaction3Choice) AddAggregated() *CardAggregated2 {
+	c.Aggregated = new(CardAggregated2)
+	return c.Aggregated
+}
+
+func (c *CardTransaction3Choice) AddIndividual() *CardIndividualTransaction2 {
+	c.Individual = new(CardIndividualTransaction2)
+	return c.Individual
+}
+
+func (c *CardTransaction3Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Aggregated != nil {
+		c.Aggregated.EncodeXMLFast(enc, "Aggtd")
+	}
+	if c.Individual != nil {
+		c.Individual.EncodeXMLFast(enc, "Indv")
+	}
+	enc.End(tag)
+}
+
+func (c *CardTransaction3Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Aggtd":
+			elem := new(CardAggregated2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Aggregated = elem
+		case "Indv":
+			elem := new(CardIndividualTransaction2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Individual = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Card transaction for which the authorisation has been requested.
 type CardTransaction4 struct {
 
@@ -37,6 +43,10 @@ type CardTransaction4 struct {
 	AuthorisationResult *AuthorisationResult8 `xml:"AuthstnRslt,omitempty"`
 }
 
+func (c *CardTransaction4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransaction4) SetTransactionType(value string) {
 	c.TransactionType = (*CardPaymentServiceType7Code)(&value)
 }
@@ -50,6 +60,11 @@ func (c *CardTransaction4) SetAcceptorTransactionDateTime(value string) {
 	c.AcceptorTransactionDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CardTransaction4) SetAcceptorTransactionDateTimeFromTime(value time.Time) {
+	c.AcceptorTransactionDateTime = new(ISODateTime)
+	c.AcceptorTransactionDateTime.FromTime(value)
+}
+
 func (c *CardTransaction4) SetInitiatorTransactionIdentification(value string) {
 	c.InitiatorTransactionIdentification = (*Max35Text)(&value)
 }
@@ -62,10 +77,20 @@ func (c *CardTransaction4) SetTransactionLifeCycleSequenceNumber(value string) {
 	c.TransactionLifeCycleSequenceNumber = (*Number)(&value)
 }
 
+func (c *CardTransaction4) SetTransactionLifeCycleSequenceNumberFromDecimal(value decimal.Decimal) {
+	c.TransactionLifeCycleSequenceNumber = new(Number)
+	c.TransactionLifeCycleSequenceNumber.FromDecimal(value)
+}
+
 func (c *CardTransaction4) SetTransactionLifeCycleSequenceCounter(value string) {
 	c.TransactionLifeCycleSequenceCounter = (*Number)(&value)
 }
 
+func (c *CardTransaction4) SetTransactionLifeCycleSequenceCounterFromDecimal(value decimal.Decimal) {
+	c.TransactionLifeCycleSequenceCounter = new(Number)
+	c.TransactionLifeCycleSequenceCounter.FromDecimal(value)
+}
+
 func (c *CardTransaction4) SetCardIssuerReferenceData(value string) {
 	c.CardIssuerReferenceData = (*Max140Text)(&value)
 }
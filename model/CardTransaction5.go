@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Card transaction for which the financial authorisation is requested.
 type CardTransaction5 struct {
 
@@ -59,6 +65,10 @@ type CardTransaction5 struct {
 	AuthorisationResult *AuthorisationResult7 `xml:"AuthstnRslt,omitempty"`
 }
 
+func (c *CardTransaction5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransaction5) SetTransactionType(value string) {
 	c.TransactionType = (*CardPaymentServiceType7Code)(&value)
 }
@@ -84,6 +94,11 @@ func (c *CardTransaction5) SetAcceptorTransactionDateTime(value string) {
 	c.AcceptorTransactionDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CardTransaction5) SetAcceptorTransactionDateTimeFromTime(value time.Time) {
+	c.AcceptorTransactionDateTime = new(ISODateTime)
+	c.AcceptorTransactionDateTime.FromTime(value)
+}
+
 func (c *CardTransaction5) SetAcceptorTransactionIdentification(value string) {
 	c.AcceptorTransactionIdentification = (*Max35Text)(&value)
 }
@@ -100,10 +115,20 @@ func (c *CardTransaction5) SetTransactionLifeCycleSequenceNumber(value string) {
 	c.TransactionLifeCycleSequenceNumber = (*Number)(&value)
 }
 
+func (c *CardTransaction5) SetTransactionLifeCycleSequenceNumberFromDecimal(value decimal.Decimal) {
+	c.TransactionLifeCycleSequenceNumber = new(Number)
+	c.TransactionLifeCycleSequenceNumber.FromDecimal(value)
+}
+
 func (c *CardTransaction5) SetTransactionLifeCycleSequenceCounter(value string) {
 	c.TransactionLifeCycleSequenceCounter = (*Number)(&value)
 }
 
+func (c *CardTransaction5) SetTransactionLifeCycleSequenceCounterFromDecimal(value decimal.Decimal) {
+	c.TransactionLifeCycleSequenceCounter = new(Number)
+	c.TransactionLifeCycleSequenceCounter.FromDecimal(value)
+}
+
 func (c *CardTransaction5) SetAcquirerTransactionReference(value string) {
 	c.AcquirerTransactionReference = (*Max35NumericText)(&value)
 }
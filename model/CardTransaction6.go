@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Card transaction for which the financial authorisation has been requested.
 type CardTransaction6 struct {
 
@@ -37,6 +43,10 @@ type CardTransaction6 struct {
 	AuthorisationResult *AuthorisationResult8 `xml:"AuthstnRslt,omitempty"`
 }
 
+func (c *CardTransaction6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransaction6) SetTransactionType(value string) {
 	c.TransactionType = (*CardPaymentServiceType7Code)(&value)
 }
@@ -50,6 +60,11 @@ func (c *CardTransaction6) SetAcceptorTransactionDateTime(value string) {
 	c.AcceptorTransactionDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CardTransaction6) SetAcceptorTransactionDateTimeFromTime(value time.Time) {
+	c.AcceptorTransactionDateTime = new(ISODateTime)
+	c.AcceptorTransactionDateTime.FromTime(value)
+}
+
 func (c *CardTransaction6) SetInitiatorTransactionIdentification(value string) {
 	c.InitiatorTransactionIdentification = (*Max35Text)(&value)
 }
@@ -62,10 +77,20 @@ func (c *CardTransaction6) SetTransactionLifeCycleSequenceNumber(value string) {
 	c.TransactionLifeCycleSequenceNumber = (*Number)(&value)
 }
 
+func (c *CardTransaction6) SetTransactionLifeCycleSequenceNumberFromDecimal(value decimal.Decimal) {
+	c.TransactionLifeCycleSequenceNumber = new(Number)
+	c.TransactionLifeCycleSequenceNumber.FromDecimal(value)
+}
+
 func (c *CardTransaction6) SetTransactionLifeCycleSequenceCounter(value string) {
 	c.TransactionLifeCycleSequenceCounter = (*Number)(&value)
 }
 
+func (c *CardTransaction6) SetTransactionLifeCycleSequenceCounterFromDecimal(value decimal.Decimal) {
+	c.TransactionLifeCycleSequenceCounter = new(Number)
+	c.TransactionLifeCycleSequenceCounter.FromDecimal(value)
+}
+
 func (c *CardTransaction6) SetCardIssuerReferenceData(value string) {
 	c.CardIssuerReferenceData = (*Max140Text)(&value)
 }
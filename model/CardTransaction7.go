@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Reversal card transaction.
 type CardTransaction7 struct {
 
@@ -47,6 +53,10 @@ type CardTransaction7 struct {
 	TransactionResponse *ResponseType2 `xml:"TxRspn,omitempty"`
 }
 
+func (c *CardTransaction7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransaction7) SetTransactionType(value string) {
 	c.TransactionType = (*CardPaymentServiceType7Code)(&value)
 }
@@ -60,6 +70,11 @@ func (c *CardTransaction7) SetAcceptorTransactionDateTime(value string) {
 	c.AcceptorTransactionDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CardTransaction7) SetAcceptorTransactionDateTimeFromTime(value time.Time) {
+	c.AcceptorTransactionDateTime = new(ISODateTime)
+	c.AcceptorTransactionDateTime.FromTime(value)
+}
+
 func (c *CardTransaction7) SetAcceptorTransactionIdentification(value string) {
 	c.AcceptorTransactionIdentification = (*Max35Text)(&value)
 }
@@ -76,10 +91,20 @@ func (c *CardTransaction7) SetTransactionLifeCycleSequenceNumber(value string) {
 	c.TransactionLifeCycleSequenceNumber = (*Number)(&value)
 }
 
+func (c *CardTransaction7) SetTransactionLifeCycleSequenceNumberFromDecimal(value decimal.Decimal) {
+	c.TransactionLifeCycleSequenceNumber = new(Number)
+	c.TransactionLifeCycleSequenceNumber.FromDecimal(value)
+}
+
 func (c *CardTransaction7) SetTransactionLifeCycleSequenceCounter(value string) {
 	c.TransactionLifeCycleSequenceCounter = (*Number)(&value)
 }
 
+func (c *CardTransaction7) SetTransactionLifeCycleSequenceCounterFromDecimal(value decimal.Decimal) {
+	c.TransactionLifeCycleSequenceCounter = new(Number)
+	c.TransactionLifeCycleSequenceCounter.FromDecimal(value)
+}
+
 func (c *CardTransaction7) SetAcquirerTransactionReference(value string) {
 	c.AcquirerTransactionReference = (*Max35NumericText)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Card transaction for which the authorisation has been requested.
 type CardTransaction8 struct {
 
@@ -34,6 +40,10 @@ type CardTransaction8 struct {
 	TransactionResponse *ResponseType2 `xml:"TxRspn"`
 }
 
+func (c *CardTransaction8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransaction8) SetTransactionType(value string) {
 	c.TransactionType = (*CardPaymentServiceType7Code)(&value)
 }
@@ -47,6 +57,11 @@ func (c *CardTransaction8) SetAcceptorTransactionDateTime(value string) {
 	c.AcceptorTransactionDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CardTransaction8) SetAcceptorTransactionDateTimeFromTime(value time.Time) {
+	c.AcceptorTransactionDateTime = new(ISODateTime)
+	c.AcceptorTransactionDateTime.FromTime(value)
+}
+
 func (c *CardTransaction8) SetInitiatorTransactionIdentification(value string) {
 	c.InitiatorTransactionIdentification = (*Max35Text)(&value)
 }
@@ -59,10 +74,20 @@ func (c *CardTransaction8) SetTransactionLifeCycleSequenceNumber(value string) {
 	c.TransactionLifeCycleSequenceNumber = (*Number)(&value)
 }
 
+func (c *CardTransaction8) SetTransactionLifeCycleSequenceNumberFromDecimal(value decimal.Decimal) {
+	c.TransactionLifeCycleSequenceNumber = new(Number)
+	c.TransactionLifeCycleSequenceNumber.FromDecimal(value)
+}
+
 func (c *CardTransaction8) SetTransactionLifeCycleSequenceCounter(value string) {
 	c.TransactionLifeCycleSequenceCounter = (*Number)(&value)
 }
 
+func (c *CardTransaction8) SetTransactionLifeCycleSequenceCounterFromDecimal(value decimal.Decimal) {
+	c.TransactionLifeCycleSequenceCounter = new(Number)
+	c.TransactionLifeCycleSequenceCounter.FromDecimal(value)
+}
+
 func (c *CardTransaction8) AddTransactionDetails() *CardTransactionDetail6 {
 	c.TransactionDetails = new(CardTransactionDetail6)
 	return c.TransactionDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Card transaction for which the authorisation has been requested.
 type CardTransaction9 struct {
 
@@ -31,6 +35,10 @@ type CardTransaction9 struct {
 	TransactionResponse *ResponseType2 `xml:"TxRspn,omitempty"`
 }
 
+func (c *CardTransaction9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransaction9) SetReconciliationType(value string) {
 	c.ReconciliationType = (*CardServiceType1Code)(&value)
 }
@@ -44,10 +52,20 @@ func (c *CardTransaction9) SetInterBankSettlementDate(value string) {
 	c.InterBankSettlementDate = (*ISODate)(&value)
 }
 
+func (c *CardTransaction9) SetInterBankSettlementDateFromTime(value time.Time) {
+	c.InterBankSettlementDate = new(ISODate)
+	c.InterBankSettlementDate.FromTime(value)
+}
+
 func (c *CardTransaction9) SetInitiatorTransactionDateTime(value string) {
 	c.InitiatorTransactionDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CardTransaction9) SetInitiatorTransactionDateTimeFromTime(value time.Time) {
+	c.InitiatorTransactionDateTime = new(ISODateTime)
+	c.InitiatorTransactionDateTime.FromTime(value)
+}
+
 func (c *CardTransaction9) SetInitiatorTransactionIdentification(value string) {
 	c.InitiatorTransactionIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amounts of the transaction expressed within the terminal currency.
 type CardTransactionAmount1 struct {
 
@@ -18,10 +22,18 @@ type CardTransactionAmount1 struct {
 	DetailedAmount []*DetailedAmount9 `xml:"DtldAmt,omitempty"`
 }
 
+func (c *CardTransactionAmount1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionAmount1) SetTotalAmount(value, currency string) {
 	c.TotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (c *CardTransactionAmount1) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardTransactionAmount1) SetAmountQualifier(value string) {
 	c.AmountQualifier = (*TypeOfAmount1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amounts of the transaction expressed within the terminal currency.
 type CardTransactionAmount2 struct {
 
@@ -14,10 +18,18 @@ type CardTransactionAmount2 struct {
 	DetailedAmount []*DetailedAmount9 `xml:"DtldAmt,omitempty"`
 }
 
+func (c *CardTransactionAmount2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionAmount2) SetTotalAmount(value, currency string) {
 	c.TotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (c *CardTransactionAmount2) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardTransactionAmount2) AddCardholderBillingTransactionAmount() *DetailedAmount8 {
 	c.CardholderBillingTransactionAmount = new(DetailedAmount8)
 	return c.CardholderBillingTransactionAmount
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amounts of the transaction expressed within the terminal currency.
 type CardTransactionAmount3 struct {
 
@@ -21,10 +25,18 @@ type CardTransactionAmount3 struct {
 	DetailedAmount []*DetailedAmount9 `xml:"DtldAmt,omitempty"`
 }
 
+func (c *CardTransactionAmount3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionAmount3) SetTotalAmount(value, currency string) {
 	c.TotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (c *CardTransactionAmount3) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardTransactionAmount3) SetAmountQualifier(value string) {
 	c.AmountQualifier = (*TypeOfAmount1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amounts of the transaction expressed within the terminal currency.
 type CardTransactionAmount4 struct {
 
@@ -17,10 +21,18 @@ type CardTransactionAmount4 struct {
 	DetailedAmount []*DetailedAmount9 `xml:"DtldAmt,omitempty"`
 }
 
+func (c *CardTransactionAmount4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionAmount4) SetTotalAmount(value, currency string) {
 	c.TotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (c *CardTransactionAmount4) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardTransactionAmount4) AddCardholderBillingTransactionAmount() *DetailedAmount8 {
 	c.CardholderBillingTransactionAmount = new(DetailedAmount8)
 	return c.CardholderBillingTransactionAmount
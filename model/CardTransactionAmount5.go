@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amounts of the transaction expressed within the terminal currency.
 type CardTransactionAmount5 struct {
 
@@ -14,10 +18,18 @@ type CardTransactionAmount5 struct {
 	ReconciliationTransactionAmount *DetailedAmount8 `xml:"RcncltnTxAmt,omitempty"`
 }
 
+func (c *CardTransactionAmount5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionAmount5) SetTotalAmount(value, currency string) {
 	c.TotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (c *CardTransactionAmount5) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CardTransactionAmount5) AddCardholderBillingTransactionAmount() *DetailedAmount8 {
 	c.CardholderBillingTransactionAmount = new(DetailedAmount8)
 	return c.CardholderBillingTransactionAmount
@@ -10,6 +10,10 @@ type CardTransactionCondition1 struct {
 	Value *Max35Text `xml:"Val,omitempty"`
 }
 
+func (c *CardTransactionCondition1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionCondition1) SetProgram(value string) {
 	c.Program = (*Max35Text)(&value)
 }
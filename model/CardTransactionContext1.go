@@ -10,6 +10,10 @@ type CardTransactionContext1 struct {
 	SaleContext *SaleContext1 `xml:"SaleCntxt,omitempty"`
 }
 
+func (c *CardTransactionContext1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionContext1) AddTransactionContext() *CardTransactionContext2 {
 	c.TransactionContext = new(CardTransactionContext2)
 	return c.TransactionContext
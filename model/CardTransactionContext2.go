@@ -47,14 +47,28 @@ type CardTransactionContext2 struct {
 	RiskIndicator []*CardTransactionRiskIndicator1 `xml:"RskInd,omitempty"`
 }
 
+func (c *CardTransactionContext2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionContext2) SetCardPresent(value string) {
 	c.CardPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardTransactionContext2) SetCardPresentFromBool(value bool) {
+	c.CardPresent = new(TrueFalseIndicator)
+	c.CardPresent.FromBool(value)
+}
+
 func (c *CardTransactionContext2) SetCardholderPresent(value string) {
 	c.CardholderPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardTransactionContext2) SetCardholderPresentFromBool(value bool) {
+	c.CardholderPresent = new(TrueFalseIndicator)
+	c.CardholderPresent.FromBool(value)
+}
+
 func (c *CardTransactionContext2) SetLocationCategory(value string) {
 	c.LocationCategory = (*LocationCategory2Code)(&value)
 }
@@ -7,6 +7,10 @@ type CardTransactionContext3 struct {
 	TransactionContext *CardTransactionContext4 `xml:"TxCntxt"`
 }
 
+func (c *CardTransactionContext3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionContext3) AddTransactionContext() *CardTransactionContext4 {
 	c.TransactionContext = new(CardTransactionContext4)
 	return c.TransactionContext
@@ -7,6 +7,10 @@ type CardTransactionContext4 struct {
 	SpecialConditions []*CardTransactionCondition1 `xml:"SpclConds,omitempty"`
 }
 
+func (c *CardTransactionContext4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionContext4) AddSpecialConditions() *CardTransactionCondition1 {
 	newValue := new(CardTransactionCondition1)
 	c.SpecialConditions = append(c.SpecialConditions, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Details of the card transaction.
 type CardTransactionDetail1 struct {
 
@@ -37,6 +41,10 @@ type CardTransactionDetail1 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardTransactionDetail1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionDetail1) AddTransactionAmounts() *CardTransactionAmount1 {
 	c.TransactionAmounts = new(CardTransactionAmount1)
 	return c.TransactionAmounts
@@ -196,6 +204,11 @@ func (c *CardTransactionDetail1) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardTransactionDetail1) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardTransactionDetail1) SetUnattendedLevelCategory(value string) {
 	c.UnattendedLevelCategory = (*Max35NumericText)(&value)
 }
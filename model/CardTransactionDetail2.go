@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Details of the card transaction.
 type CardTransactionDetail2 struct {
 
@@ -24,6 +28,10 @@ type CardTransactionDetail2 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardTransactionDetail2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionDetail2) AddTransactionAmounts() *CardTransactionAmount2 {
 	c.TransactionAmounts = new(CardTransactionAmount2)
 	return c.TransactionAmounts
@@ -471,6 +479,11 @@ func (c *CardTransactionDetail2) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardTransactionDetail2) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardTransactionDetail2) SetICCRelatedData(value string) {
 	c.ICCRelatedData = (*Max10000Binary)(&value)
 }
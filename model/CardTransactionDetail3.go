@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Details of the card transaction.
 type CardTransactionDetail3 struct {
 
@@ -40,6 +44,10 @@ type CardTransactionDetail3 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardTransactionDetail3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionDetail3) AddTransactionAmounts() *CardTransactionAmount3 {
 	c.TransactionAmounts = new(CardTransactionAmount3)
 	return c.TransactionAmounts
@@ -345,6 +353,11 @@ func (c *CardTransactionDetail3) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardTransactionDetail3) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardTransactionDetail3) SetUnattendedLevelCategory(value string) {
 	c.UnattendedLevelCategory = (*Max35NumericText)(&value)
 }
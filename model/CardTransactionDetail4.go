@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Details of the card transaction.
 type CardTransactionDetail4 struct {
 
@@ -27,6 +31,10 @@ type CardTransactionDetail4 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardTransactionDetail4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionDetail4) AddTransactionAmounts() *CardTransactionAmount4 {
 	c.TransactionAmounts = new(CardTransactionAmount4)
 	return c.TransactionAmounts
@@ -620,6 +628,11 @@ func (c *CardTransactionDetail4) SetValidityDate(value string) {
 	c.ValidityDate = (*ISODate)(&value)
 }
 
+func (c *CardTransactionDetail4) SetValidityDateFromTime(value time.Time) {
+	c.ValidityDate = new(ISODate)
+	c.ValidityDate.FromTime(value)
+}
+
 func (c *CardTransactionDetail4) SetICCRelatedData(value string) {
 	c.ICCRelatedData = (*Max10000Binary)(&value)
 }
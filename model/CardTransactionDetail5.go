@@ -21,6 +21,10 @@ type CardTransactionDetail5 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardTransactionDetail5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionDetail5) AddTransactionAmounts() *CardTransactionAmount5 {
 	c.TransactionAmounts = new(CardTransactionAmount5)
 	return c.TransactionAmounts
@@ -17,6 +17,10 @@ type CardTransactionDetail6 struct {
 	ICCRelatedData *Max10000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CardTransactionDetail6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionDetail6) AddTransactionAmounts() *CardTransactionAmount5 {
 	c.TransactionAmounts = new(CardTransactionAmount5)
 	return c.TransactionAmounts
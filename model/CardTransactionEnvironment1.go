@@ -35,6 +35,10 @@ type CardTransactionEnvironment1 struct {
 	ProtectedCardholderData *ContentInformationType10 `xml:"PrtctdCrdhldrData,omitempty"`
 }
 
+func (c *CardTransactionEnvironment1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionEnvironment1) AddAcquirer() *Acquirer6 {
 	c.Acquirer = new(Acquirer6)
 	return c.Acquirer
@@ -25,6 +25,10 @@ type CardTransactionEnvironment2 struct {
 	ShippingAddress *PostalAddress18 `xml:"ShppgAdr,omitempty"`
 }
 
+func (c *CardTransactionEnvironment2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionEnvironment2) SetAcquirerIdentification(value string) {
 	c.AcquirerIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +28,10 @@ type CardTransactionEnvironment3 struct {
 	PaymentToken *CardPaymentToken4 `xml:"PmtTkn,omitempty"`
 }
 
+func (c *CardTransactionEnvironment3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionEnvironment3) AddAcquirer() *Acquirer6 {
 	c.Acquirer = new(Acquirer6)
 	return c.Acquirer
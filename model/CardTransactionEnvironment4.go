@@ -22,6 +22,10 @@ type CardTransactionEnvironment4 struct {
 	PaymentToken *CardPaymentToken2 `xml:"PmtTkn,omitempty"`
 }
 
+func (c *CardTransactionEnvironment4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionEnvironment4) SetAcquirerIdentification(value string) {
 	c.AcquirerIdentification = (*Max35Text)(&value)
 }
@@ -15,6 +15,10 @@ type CardTransactionEnvironment5 struct {
 	SettlementInstitution *GenericIdentification73 `xml:"SttlmInstn"`
 }
 
+func (c *CardTransactionEnvironment5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionEnvironment5) AddSendingInstitution() *GenericIdentification73 {
 	c.SendingInstitution = new(GenericIdentification73)
 	return c.SendingInstitution
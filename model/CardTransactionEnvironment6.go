@@ -10,6 +10,10 @@ type CardTransactionEnvironment6 struct {
 	ReceivingInstitution *GenericIdentification73 `xml:"RcvgInstn"`
 }
 
+func (c *CardTransactionEnvironment6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionEnvironment6) AddSendingInstitution() *GenericIdentification73 {
 	c.SendingInstitution = new(GenericIdentification73)
 	return c.SendingInstitution
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Indicates to the issuer the level of risk of the transaction.
 type CardTransactionRiskIndicator1 struct {
 
@@ -13,6 +17,10 @@ type CardTransactionRiskIndicator1 struct {
 	RecommendedAction []*ActionType4Code `xml:"RcmmnddActn,omitempty"`
 }
 
+func (c *CardTransactionRiskIndicator1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardTransactionRiskIndicator1) AddReason(value string) {
 	c.Reason = append(c.Reason, (*CardTransactionRiskReason1Code)(&value))
 }
@@ -21,6 +29,11 @@ func (c *CardTransactionRiskIndicator1) SetLevel(value string) {
 	c.Level = (*Number)(&value)
 }
 
+func (c *CardTransactionRiskIndicator1) SetLevelFromDecimal(value decimal.Decimal) {
+	c.Level = new(Number)
+	c.Level.FromDecimal(value)
+}
+
 func (c *CardTransactionRiskIndicator1) AddRecommendedAction(value string) {
 	c.RecommendedAction = append(c.RecommendedAction, (*ActionType4Code)(&value))
 }
@@ -22,6 +22,10 @@ type Cardholder1 struct {
 	PersonalData *Max70Text `xml:"PrsnlData,omitempty"`
 }
 
+func (c *Cardholder1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cardholder1) AddIdentification() *CardholderIdentification1 {
 	newValue := new(CardholderIdentification1)
 	c.Identification = append(c.Identification, newValue)
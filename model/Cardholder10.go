@@ -35,6 +35,10 @@ type Cardholder10 struct {
 	PersonalData *Max70Text `xml:"PrsnlData,omitempty"`
 }
 
+func (c *Cardholder10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cardholder10) AddIdentification() *PersonIdentification11 {
 	c.Identification = new(PersonIdentification11)
 	return c.Identification
@@ -29,6 +29,10 @@ type Cardholder11 struct {
 	PersonalData *Max70Text `xml:"PrsnlData,omitempty"`
 }
 
+func (c *Cardholder11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cardholder11) AddIdentification() *PersonIdentification11 {
 	c.Identification = new(PersonIdentification11)
 	return c.Identification
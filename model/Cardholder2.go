@@ -19,6 +19,10 @@ type Cardholder2 struct {
 	PersonalData *Max70Text `xml:"PrsnlData,omitempty"`
 }
 
+func (c *Cardholder2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cardholder2) AddIdentification() *CardholderIdentification1 {
 	newValue := new(CardholderIdentification1)
 	c.Identification = append(c.Identification, newValue)
@@ -22,6 +22,10 @@ type Cardholder3 struct {
 	PersonalData *Max70Text `xml:"PrsnlData,omitempty"`
 }
 
+func (c *Cardholder3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cardholder3) AddIdentification() *CardholderIdentification1 {
 	newValue := new(CardholderIdentification1)
 	c.Identification = append(c.Identification, newValue)
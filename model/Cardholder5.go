@@ -28,6 +28,10 @@ type Cardholder5 struct {
 	PersonalData *Max70Text `xml:"PrsnlData,omitempty"`
 }
 
+func (c *Cardholder5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cardholder5) AddIdentification() *PersonIdentification7 {
 	c.Identification = new(PersonIdentification7)
 	return c.Identification
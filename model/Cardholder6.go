@@ -19,6 +19,10 @@ type Cardholder6 struct {
 	PersonalData *Max70Text `xml:"PrsnlData,omitempty"`
 }
 
+func (c *Cardholder6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cardholder6) AddIdentification() *PersonIdentification7 {
 	newValue := new(PersonIdentification7)
 	c.Identification = append(c.Identification, newValue)
@@ -29,6 +29,10 @@ type Cardholder7 struct {
 	PersonalData *Max70Text `xml:"PrsnlData,omitempty"`
 }
 
+func (c *Cardholder7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cardholder7) AddIdentification() *PersonIdentification7 {
 	c.Identification = new(PersonIdentification7)
 	return c.Identification
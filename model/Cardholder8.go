@@ -13,6 +13,10 @@ type Cardholder8 struct {
 	PersonalData *Max70Text `xml:"PrsnlData,omitempty"`
 }
 
+func (c *Cardholder8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cardholder8) AddIdentification() *PersonIdentification7 {
 	c.Identification = new(PersonIdentification7)
 	return c.Identification
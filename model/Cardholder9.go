@@ -29,6 +29,10 @@ type Cardholder9 struct {
 	PersonalData *Max70Text `xml:"PrsnlData,omitempty"`
 }
 
+func (c *Cardholder9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cardholder9) AddIdentification() *PersonIdentification7 {
 	c.Identification = new(PersonIdentification7)
 	return c.Identification
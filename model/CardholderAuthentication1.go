@@ -19,6 +19,10 @@ type CardholderAuthentication1 struct {
 	AuthenticationCollectionIndicator *Max35Text `xml:"AuthntcnColltnInd,omitempty"`
 }
 
+func (c *CardholderAuthentication1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardholderAuthentication1) SetAuthenticationMethod(value string) {
 	c.AuthenticationMethod = (*AuthenticationMethod1Code)(&value)
 }
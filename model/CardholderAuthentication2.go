@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Data related to the authentication of the cardholder.
 type CardholderAuthentication2 struct {
 
@@ -10,6 +12,10 @@ type CardholderAuthentication2 struct {
 	AuthenticationEntity *AuthenticationEntity1Code `xml:"AuthntcnNtty"`
 }
 
+func (c *CardholderAuthentication2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardholderAuthentication2) SetAuthenticationMethod(value string) {
 	c.AuthenticationMethod = (*AuthenticationMethod1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (c *CardholderAuthentication2) SetAuthenticationMethod(value string) {
 func (c *CardholderAuthentication2) SetAuthenticationEntity(value string) {
 	c.AuthenticationEntity = (*AuthenticationEntity1Code)(&value)
 }
+
+func (c *CardholderAuthentication2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.AuthenticationMethod != nil {
+		enc.Element("AuthntcnMtd", string(*c.AuthenticationMethod))
+	}
+	if c.AuthenticationEntity != nil {
+		enc.Element("AuthntcnNtty", string(*c.AuthenticationEntity))
+	}
+	enc.End(tag)
+}
+
+func (c *CardholderAuthentication2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "AuthntcnMtd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.AuthenticationMethod = (*AuthenticationMethod1Code)(&value)
+		case "AuthntcnNtty":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.AuthenticationEntity = (*AuthenticationEntity1Code)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -19,6 +19,10 @@ type CardholderAuthentication3 struct {
 	AuthenticationCollectionIndicator *Max35Text `xml:"AuthntcnColltnInd,omitempty"`
 }
 
+func (c *CardholderAuthentication3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardholderAuthentication3) SetAuthenticationMethod(value string) {
 	c.AuthenticationMethod = (*AuthenticationMethod2Code)(&value)
 }
@@ -10,6 +10,10 @@ type CardholderAuthentication4 struct {
 	AuthenticationEntity *AuthenticationEntity1Code `xml:"AuthntcnNtty"`
 }
 
+func (c *CardholderAuthentication4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardholderAuthentication4) SetAuthenticationMethod(value string) {
 	c.AuthenticationMethod = (*AuthenticationMethod2Code)(&value)
 }
@@ -19,6 +19,10 @@ type CardholderAuthentication5 struct {
 	AuthenticationCollectionIndicator *Max35Text `xml:"AuthntcnColltnInd,omitempty"`
 }
 
+func (c *CardholderAuthentication5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardholderAuthentication5) SetAuthenticationMethod(value string) {
 	c.AuthenticationMethod = (*AuthenticationMethod2Code)(&value)
 }
@@ -19,6 +19,10 @@ type CardholderAuthentication6 struct {
 	AddressVerification *AddressVerification1 `xml:"AdrVrfctn,omitempty"`
 }
 
+func (c *CardholderAuthentication6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardholderAuthentication6) SetAuthenticationMethod(value string) {
 	c.AuthenticationMethod = (*AuthenticationMethod3Code)(&value)
 }
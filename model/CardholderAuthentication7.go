@@ -22,6 +22,10 @@ type CardholderAuthentication7 struct {
 	AddressVerification *AddressVerification1 `xml:"AdrVrfctn,omitempty"`
 }
 
+func (c *CardholderAuthentication7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardholderAuthentication7) SetAuthenticationMethod(value string) {
 	c.AuthenticationMethod = (*AuthenticationMethod5Code)(&value)
 }
@@ -19,6 +19,10 @@ type CardholderAuthentication8 struct {
 	CardholderOnLinePIN *OnLinePIN5 `xml:"CrdhldrOnLinePIN,omitempty"`
 }
 
+func (c *CardholderAuthentication8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardholderAuthentication8) SetAuthenticationMethod(value string) {
 	c.AuthenticationMethod = (*AuthenticationMethod7Code)(&value)
 }
@@ -27,6 +31,11 @@ func (c *CardholderAuthentication8) SetTokenRequested(value string) {
 	c.TokenRequested = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CardholderAuthentication8) SetTokenRequestedFromBool(value bool) {
+	c.TokenRequested = new(TrueFalseIndicator)
+	c.TokenRequested.FromBool(value)
+}
+
 func (c *CardholderAuthentication8) SetAuthenticationValue(value string) {
 	c.AuthenticationValue = (*Max5000Binary)(&value)
 }
@@ -22,6 +22,10 @@ type CardholderAuthentication9 struct {
 	AddressVerification *AddressVerification1 `xml:"AdrVrfctn,omitempty"`
 }
 
+func (c *CardholderAuthentication9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardholderAuthentication9) SetAuthenticationMethod(value string) {
 	c.AuthenticationMethod = (*AuthenticationMethod5Code)(&value)
 }
@@ -10,6 +10,10 @@ type CardholderIdentification1 struct {
 	CardholderIdentificationType *PersonIdentificationType4Code `xml:"CrdhldrIdTp"`
 }
 
+func (c *CardholderIdentification1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CardholderIdentification1) SetCardholderIdentificationValue(value string) {
 	c.CardholderIdentificationValue = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type Case struct {
 	ReopenCaseIndication *YesNoIndicator `xml:"ReopCaseIndctn,omitempty"`
 }
 
+func (c *Case) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Case) SetIdentification(value string) {
 	c.Identification = (*Max35Text)(&value)
 }
@@ -24,3 +28,8 @@ func (c *Case) SetCreator(value string) {
 func (c *Case) SetReopenCaseIndication(value string) {
 	c.ReopenCaseIndication = (*YesNoIndicator)(&value)
 }
+
+func (c *Case) SetReopenCaseIndicationFromBool(value bool) {
+	c.ReopenCaseIndication = new(YesNoIndicator)
+	c.ReopenCaseIndication.FromBool(value)
+}
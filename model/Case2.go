@@ -13,6 +13,10 @@ type Case2 struct {
 	ReopenCaseIndication *YesNoIndicator `xml:"ReopCaseIndctn,omitempty"`
 }
 
+func (c *Case2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Case2) SetIdentification(value string) {
 	c.Identification = (*Max35Text)(&value)
 }
@@ -25,3 +29,8 @@ func (c *Case2) AddCreator() *Party7Choice {
 func (c *Case2) SetReopenCaseIndication(value string) {
 	c.ReopenCaseIndication = (*YesNoIndicator)(&value)
 }
+
+func (c *Case2) SetReopenCaseIndicationFromBool(value bool) {
+	c.ReopenCaseIndication = new(YesNoIndicator)
+	c.ReopenCaseIndication.FromBool(value)
+}
@@ -13,6 +13,10 @@ type Case3 struct {
 	ReopenCaseIndication *YesNoIndicator `xml:"ReopCaseIndctn,omitempty"`
 }
 
+func (c *Case3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Case3) SetIdentification(value string) {
 	c.Identification = (*Max35Text)(&value)
 }
@@ -25,3 +29,8 @@ func (c *Case3) AddCreator() *Party12Choice {
 func (c *Case3) SetReopenCaseIndication(value string) {
 	c.ReopenCaseIndication = (*YesNoIndicator)(&value)
 }
+
+func (c *Case3) SetReopenCaseIndicationFromBool(value bool) {
+	c.ReopenCaseIndication = new(YesNoIndicator)
+	c.ReopenCaseIndication.FromBool(value)
+}
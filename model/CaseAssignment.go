@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Represents the assignment of a case to a party. Assignment is a step in the overall process of managing a case.
 type CaseAssignment struct {
 
@@ -16,6 +20,10 @@ type CaseAssignment struct {
 	CreationDateTime *ISODateTime `xml:"CreDtTm"`
 }
 
+func (c *CaseAssignment) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CaseAssignment) SetIdentification(value string) {
 	c.Identification = (*Max35Text)(&value)
 }
@@ -31,3 +39,8 @@ func (c *CaseAssignment) SetAssignee(value string) {
 func (c *CaseAssignment) SetCreationDateTime(value string) {
 	c.CreationDateTime = (*ISODateTime)(&value)
 }
+
+func (c *CaseAssignment) SetCreationDateTimeFromTime(value time.Time) {
+	c.CreationDateTime = new(ISODateTime)
+	c.CreationDateTime.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to represent the assignment of a case to a party.
 type CaseAssignment2 struct {
 
@@ -18,6 +22,10 @@ type CaseAssignment2 struct {
 	CreationDateTime *ISODateTime `xml:"CreDtTm"`
 }
 
+func (c *CaseAssignment2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CaseAssignment2) SetIdentification(value string) {
 	c.Identification = (*Max35Text)(&value)
 }
@@ -35,3 +43,8 @@ func (c *CaseAssignment2) AddAssignee() *Party7Choice {
 func (c *CaseAssignment2) SetCreationDateTime(value string) {
 	c.CreationDateTime = (*ISODateTime)(&value)
 }
+
+func (c *CaseAssignment2) SetCreationDateTimeFromTime(value time.Time) {
+	c.CreationDateTime = new(ISODateTime)
+	c.CreationDateTime.FromTime(value)
+}
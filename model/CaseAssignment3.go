@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Represents the assignment of a case to a party.
 type CaseAssignment3 struct {
 
@@ -18,6 +22,10 @@ type CaseAssignment3 struct {
 	CreationDateTime *ISODateTime `xml:"CreDtTm"`
 }
 
+func (c *CaseAssignment3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CaseAssignment3) SetIdentification(value string) {
 	c.Identification = (*Max35Text)(&value)
 }
@@ -35,3 +43,8 @@ func (c *CaseAssignment3) AddAssignee() *Party12Choice {
 func (c *CaseAssignment3) SetCreationDateTime(value string) {
 	c.CreationDateTime = (*ISODateTime)(&value)
 }
+
+func (c *CaseAssignment3) SetCreationDateTimeFromTime(value time.Time) {
+	c.CreationDateTime = new(ISODateTime)
+	c.CreationDateTime.FromTime(value)
+}
@@ -7,6 +7,10 @@ type CaseAssignmentRejectionJustification struct {
 	RejectionReason *CaseAssignmentRejection1Code `xml:"RjctnRsn"`
 }
 
+func (c *CaseAssignmentRejectionJustification) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CaseAssignmentRejectionJustification) SetRejectionReason(value string) {
 	c.RejectionReason = (*CaseAssignmentRejection1Code)(&value)
 }
@@ -7,6 +7,10 @@ type CaseForwardingNotification struct {
 	Justification *CaseForwardingNotification1Code `xml:"Justfn"`
 }
 
+func (c *CaseForwardingNotification) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CaseForwardingNotification) SetJustification(value string) {
 	c.Justification = (*CaseForwardingNotification1Code)(&value)
 }
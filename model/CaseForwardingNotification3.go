@@ -7,6 +7,10 @@ type CaseForwardingNotification3 struct {
 	Justification *CaseForwardingNotification3Code `xml:"Justfn"`
 }
 
+func (c *CaseForwardingNotification3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CaseForwardingNotification3) SetJustification(value string) {
 	c.Justification = (*CaseForwardingNotification3Code)(&value)
 }
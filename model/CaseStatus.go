@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Defines the status of an investigation case.
 type CaseStatus struct {
 
@@ -16,10 +20,19 @@ type CaseStatus struct {
 	Reason *Max140Text `xml:"Rsn,omitempty"`
 }
 
+func (c *CaseStatus) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CaseStatus) SetDateTime(value string) {
 	c.DateTime = (*ISODateTime)(&value)
 }
 
+func (c *CaseStatus) SetDateTimeFromTime(value time.Time) {
+	c.DateTime = new(ISODateTime)
+	c.DateTime.FromTime(value)
+}
+
 func (c *CaseStatus) SetCaseStatus(value string) {
 	c.CaseStatus = (*CaseStatus1Code)(&value)
 }
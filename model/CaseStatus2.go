@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Defines the status of an investigation case.
 type CaseStatus2 struct {
 
@@ -13,12 +17,25 @@ type CaseStatus2 struct {
 	Reason *Max140Text `xml:"Rsn,omitempty"`
 }
 
+func (c *CaseStatus2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CaseStatus2) SetDateTime(value string) {
 	c.DateTime = (*ISODateTime)(&value)
 }
 
-func (c *CaseStatus2) SetCaseStatus(value string) {
+func (c *CaseStatus2) SetDateTimeFromTime(value time.Time) {
+	c.DateTime = new(ISODateTime)
+	c.DateTime.FromTime(value)
+}
+
+func (c *CaseStatus2) SetCaseStatus(value string) error {
+	if err := CaseStatus2Code(value).Validate(); err != nil {
+		return err
+	}
 	c.CaseStatus = (*CaseStatus2Code)(&value)
+	return nil
 }
 
 func (c *CaseStatus2) SetReason(value string) {
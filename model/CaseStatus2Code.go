@@ -1,3 +1,32 @@
 package model
 
 type CaseStatus2Code string
+
+const (
+	CaseStatus2CodeCLSD CaseStatus2Code = "CLSD"
+	CaseStatus2CodeASGN CaseStatus2Code = "ASGN"
+	CaseStatus2CodeINVE CaseStatus2Code = "INVE"
+	CaseStatus2CodeUKNW CaseStatus2Code = "UKNW"
+	CaseStatus2CodeODUE CaseStatus2Code = "ODUE"
+)
+
+var caseStatus2CodeDescriptions = map[CaseStatus2Code]string{
+	CaseStatus2CodeCLSD: "Closed: case has been closed.",
+	CaseStatus2CodeASGN: "Assigned: case has been assigned to another party.",
+	CaseStatus2CodeINVE: "Under investigation: investigation is taking place.",
+	CaseStatus2CodeUKNW: "Unknown: case is unknown by the assigner or assignee.",
+	CaseStatus2CodeODUE: "Overdue: investigation is taking too long.",
+}
+
+func (c CaseStatus2Code) IsValid() bool {
+	_, ok := caseStatus2CodeDescriptions[c]
+	return ok
+}
+
+func (c CaseStatus2Code) Description() string {
+	return caseStatus2CodeDescriptions[c]
+}
+
+func (c CaseStatus2Code) Validate() error {
+	return validateEnumeration("CaseStatus2Code", string(c), c.IsValid(), "CLSD, ASGN, INVE, UKNW, ODUE")
+}
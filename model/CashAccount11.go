@@ -10,6 +10,10 @@ type CashAccount11 struct {
 	AccountServicer *FinancialInstitutionIdentification3Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (c *CashAccount11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount11) AddIdentification() *CashAccountIdentification1Choice {
 	c.Identification = new(CashAccountIdentification1Choice)
 	return c.Identification
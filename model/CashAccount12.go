@@ -22,6 +22,10 @@ type CashAccount12 struct {
 	Status *AccountStatus1Code `xml:"Sts"`
 }
 
+func (c *CashAccount12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount12) AddIdentification() *CashAccountIdentification1Choice {
 	c.Identification = new(CashAccountIdentification1Choice)
 	return c.Identification
@@ -24,6 +24,10 @@ type CashAccount13 struct {
 	Servicer *BranchAndFinancialInstitutionIdentification3 `xml:"Svcr,omitempty"`
 }
 
+func (c *CashAccount13) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount13) AddIdentification() *AccountIdentification3Choice {
 	c.Identification = new(AccountIdentification3Choice)
 	return c.Identification
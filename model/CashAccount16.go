@@ -21,6 +21,10 @@ type CashAccount16 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (c *CashAccount16) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount16) AddIdentification() *AccountIdentification4Choice {
 	c.Identification = new(AccountIdentification4Choice)
 	return c.Identification
@@ -16,6 +16,10 @@ type CashAccount17 struct {
 	CorrespondentBankIdentification *BICIdentifier `xml:"CrspdtBkId"`
 }
 
+func (c *CashAccount17) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount17) AddAccountIdentification() *CashAccountIdentification1Choice {
 	c.AccountIdentification = new(CashAccountIdentification1Choice)
 	return c.AccountIdentification
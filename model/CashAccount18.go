@@ -16,8 +16,16 @@ type CashAccount18 struct {
 	BalanceType *CashBalanceType1FormatType `xml:"BalTp,omitempty"`
 }
 
-func (c *CashAccount18) SetCreditDebitIndicator(value string) {
+func (c *CashAccount18) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashAccount18) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashAccount18) AddAccountOwnerIdentification() *PartyIdentification2Choice {
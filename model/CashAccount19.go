@@ -13,8 +13,16 @@ type CashAccount19 struct {
 	AccountIdentification *AccountIdentification2Choice `xml:"AcctId"`
 }
 
-func (c *CashAccount19) SetCreditDebitIndicator(value string) {
+func (c *CashAccount19) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashAccount19) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashAccount19) AddAccountOwnerIdentification() *PartyIdentification2Choice {
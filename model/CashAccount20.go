@@ -24,6 +24,10 @@ type CashAccount20 struct {
 	Servicer *BranchAndFinancialInstitutionIdentification4 `xml:"Svcr,omitempty"`
 }
 
+func (c *CashAccount20) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount20) AddIdentification() *AccountIdentification4Choice {
 	c.Identification = new(AccountIdentification4Choice)
 	return c.Identification
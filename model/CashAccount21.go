@@ -10,6 +10,10 @@ type CashAccount21 struct {
 	Identification *AccountIdentification5Choice `xml:"Id"`
 }
 
+func (c *CashAccount21) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount21) SetServicer(value string) {
 	c.Servicer = (*BICIdentifier)(&value)
 }
@@ -19,6 +19,10 @@ type CashAccount22 struct {
 	AccountTypeDescription *Max35Text `xml:"AcctTpDesc"`
 }
 
+func (c *CashAccount22) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount22) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
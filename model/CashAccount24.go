@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Provides the details to identify an account.
 type CashAccount24 struct {
 
@@ -21,6 +23,10 @@ type CashAccount24 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (c *CashAccount24) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount24) AddIdentification() *AccountIdentification4Choice {
 	c.Identification = new(AccountIdentification4Choice)
 	return c.Identification
@@ -38,3 +44,62 @@ func (c *CashAccount24) SetCurrency(value string) {
 func (c *CashAccount24) SetName(value string) {
 	c.Name = (*Max70Text)(&value)
 }
+
+func (c *CashAccount24) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Identification != nil {
+		c.Identification.EncodeXMLFast(enc, "Id")
+	}
+	if c.Type != nil {
+		c.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if c.Currency != nil {
+		enc.Element("Ccy", string(*c.Currency))
+	}
+	if c.Name != nil {
+		enc.Element("Nm", string(*c.Name))
+	}
+	enc.End(tag)
+}
+
+func (c *CashAccount24) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			elem := new(AccountIdentification4Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Identification = elem
+		case "Tp":
+			elem := new(CashAccountType2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Type = elem
+		case "Ccy":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Currency = (*ActiveOrHistoricCurrencyCode)(&value)
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Name = (*Max70Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Provides the details to identify an account.
 type CashAccount25 struct {
 
@@ -24,6 +26,10 @@ type CashAccount25 struct {
 	Servicer *BranchAndFinancialInstitutionIdentification5 `xml:"Svcr,omitempty"`
 }
 
+func (c *CashAccount25) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount25) AddIdentification() *AccountIdentification4Choice {
 	c.Identification = new(AccountIdentification4Choice)
 	return c.Identification
@@ -51,3 +57,80 @@ func (c *CashAccount25) AddServicer() *BranchAndFinancialInstitutionIdentificati
 	c.Servicer = new(BranchAndFinancialInstitutionIdentification5)
 	return c.Servicer
 }
+
+func (c *CashAccount25) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Identification != nil {
+		c.Identification.EncodeXMLFast(enc, "Id")
+	}
+	if c.Type != nil {
+		c.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if c.Currency != nil {
+		enc.Element("Ccy", string(*c.Currency))
+	}
+	if c.Name != nil {
+		enc.Element("Nm", string(*c.Name))
+	}
+	if c.Owner != nil {
+		c.Owner.EncodeXMLFast(enc, "Ownr")
+	}
+	if c.Servicer != nil {
+		c.Servicer.EncodeXMLFast(enc, "Svcr")
+	}
+	enc.End(tag)
+}
+
+func (c *CashAccount25) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			elem := new(AccountIdentification4Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Identification = elem
+		case "Tp":
+			elem := new(CashAccountType2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Type = elem
+		case "Ccy":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Currency = (*ActiveOrHistoricCurrencyCode)(&value)
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Name = (*Max70Text)(&value)
+		case "Ownr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Owner = elem
+		case "Svcr":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Servicer = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
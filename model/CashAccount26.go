@@ -24,6 +24,10 @@ type CashAccount26 struct {
 	AccountOwnerOtherIdentification []*GenericIdentification46 `xml:"AcctOwnrOthrId,omitempty"`
 }
 
+func (c *CashAccount26) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount26) AddIdentification() *AccountIdentificationAndName3 {
 	c.Identification = new(AccountIdentificationAndName3)
 	return c.Identification
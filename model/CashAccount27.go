@@ -25,6 +25,10 @@ type CashAccount27 struct {
 	Servicer *BranchAndFinancialInstitutionIdentification5 `xml:"Svcr,omitempty"`
 }
 
+func (c *CashAccount27) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount27) AddIdentification() *AccountIdentification4Choice {
 	c.Identification = new(AccountIdentification4Choice)
 	return c.Identification
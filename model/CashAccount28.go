@@ -18,6 +18,10 @@ type CashAccount28 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (c *CashAccount28) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount28) AddIdentification() *AccountIdentification4Choice {
 	c.Identification = new(AccountIdentification4Choice)
 	return c.Identification
@@ -10,6 +10,10 @@ type CashAccount29 struct {
 	AccountServicer *FinancialInstitutionIdentification3Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (c *CashAccount29) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount29) AddIdentification() *CashAccountIdentification1Choice {
 	c.Identification = new(CashAccountIdentification1Choice)
 	return c.Identification
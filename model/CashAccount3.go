@@ -20,6 +20,10 @@ type CashAccount3 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (c *CashAccount3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount3) AddIdentification() *AccountIdentification1Choice {
 	c.Identification = new(AccountIdentification1Choice)
 	return c.Identification
@@ -18,6 +18,10 @@ type CashAccount32 struct {
 	AccountServicerBranch *BranchData `xml:"AcctSvcrBrnch,omitempty"`
 }
 
+func (c *CashAccount32) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount32) AddIdentification() *AccountIdentificationAndName5 {
 	c.Identification = new(AccountIdentificationAndName5)
 	return c.Identification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Account to or from which a cash entry is made.
 type CashAccount33 struct {
 
@@ -42,6 +46,10 @@ type CashAccount33 struct {
 	DividendPercentage *PercentageBoundedRate `xml:"DvddPctg,omitempty"`
 }
 
+func (c *CashAccount33) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount33) SetSettlementCurrency(value string) {
 	c.SettlementCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -217,8 +225,12 @@ func (c *CashAccount33) AddInvestmentAccountType() *AccountType2Choice {
 	return c.InvestmentAccountType
 }
 
-func (c *CashAccount33) SetCreditDebit(value string) {
+func (c *CashAccount33) SetCreditDebit(value string) error {
+	if err := CreditDebit3Code(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebit = (*CreditDebit3Code)(&value)
+	return nil
 }
 
 func (c *CashAccount33) AddSettlementInstructionReason() *SettlementInstructionReason1Choice {
@@ -239,3 +251,8 @@ func (c *CashAccount33) AddCashAccountDesignation() *AccountDesignation1Choice {
 func (c *CashAccount33) SetDividendPercentage(value string) {
 	c.DividendPercentage = (*PercentageBoundedRate)(&value)
 }
+
+func (c *CashAccount33) SetDividendPercentageFromDecimal(value decimal.Decimal) {
+	c.DividendPercentage = new(PercentageBoundedRate)
+	c.DividendPercentage.FromDecimal(value)
+}
@@ -10,6 +10,10 @@ type CashAccount34 struct {
 	AccountServicer *FinancialInstitutionIdentification7Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (c *CashAccount34) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount34) AddIdentification() *AccountIdentificationAndName5 {
 	c.Identification = new(AccountIdentificationAndName5)
 	return c.Identification
@@ -0,0 +1,153 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Provides the details to identify an account.
+type CashAccount39 struct {
+
+	// Unique and unambiguous identification for the account between the account owner and the account servicer.
+	Identification *AccountIdentification4Choice `xml:"Id"`
+
+	// Specifies the nature, or use of the account.
+	Type *CashAccountType2Choice `xml:"Tp,omitempty"`
+
+	// Identification of the currency in which the account is held.
+	Currency *ActiveOrHistoricCurrencyCode `xml:"Ccy,omitempty"`
+
+	// Name of the account, as assigned by the account servicing institution, in agreement with the account owner in order to provide an additional means of identification of the account.
+	//
+	// Usage: The account name is different from the account owner name. The account name is used in certain user communities to provide a means of identifying the account, in addition to the account owner's identity and the account number.
+	Name *Max70Text `xml:"Nm,omitempty"`
+
+	// Specifies an alternate assumed name for the identification of the account.
+	Proxy *ProxyAccountIdentification1 `xml:"Prxy,omitempty"`
+
+	// Party that legally owns the account.
+	Owner *PartyIdentification135 `xml:"Ownr,omitempty"`
+
+	// Party that manages the account on behalf of the account owner, that is manages the registration and booking of entries on the account, calculates balances on the account and provides information about the account.
+	Servicer *BranchAndFinancialInstitutionIdentification6 `xml:"Svcr,omitempty"`
+}
+
+func (c *CashAccount39) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashAccount39) AddIdentification() *AccountIdentification4Choice {
+	c.Identification = new(AccountIdentification4Choice)
+	return c.Identification
+}
+
+func (c *CashAccount39) AddType() *CashAccountType2Choice {
+	c.Type = new(CashAccountType2Choice)
+	return c.Type
+}
+
+func (c *CashAccount39) SetCurrency(value string) {
+	c.Currency = (*ActiveOrHistoricCurrencyCode)(&value)
+}
+
+func (c *CashAccount39) SetName(value string) {
+	c.Name = (*Max70Text)(&value)
+}
+
+func (c *CashAccount39) AddProxy() *ProxyAccountIdentification1 {
+	c.Proxy = new(ProxyAccountIdentification1)
+	return c.Proxy
+}
+
+func (c *CashAccount39) AddOwner() *PartyIdentification135 {
+	c.Owner = new(PartyIdentification135)
+	return c.Owner
+}
+
+func (c *CashAccount39) AddServicer() *BranchAndFinancialInstitutionIdentification6 {
+	c.Servicer = new(BranchAndFinancialInstitutionIdentification6)
+	return c.Servicer
+}
+
+func (c *CashAccount39) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Identification != nil {
+		c.Identification.EncodeXMLFast(enc, "Id")
+	}
+	if c.Type != nil {
+		c.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if c.Currency != nil {
+		enc.Element("Ccy", string(*c.Currency))
+	}
+	if c.Name != nil {
+		enc.Element("Nm", string(*c.Name))
+	}
+	if c.Proxy != nil {
+		c.Proxy.EncodeXMLFast(enc, "Prxy")
+	}
+	if c.Owner != nil {
+		c.Owner.EncodeXMLFast(enc, "Ownr")
+	}
+	if c.Servicer != nil {
+		c.Servicer.EncodeXMLFast(enc, "Svcr")
+	}
+	enc.End(tag)
+}
+
+func (c *CashAccount39) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			elem := new(AccountIdentification4Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Identification = elem
+		case "Tp":
+			elem := new(CashAccountType2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Type = elem
+		case "Ccy":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Currency = (*ActiveOrHistoricCurrencyCode)(&value)
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Name = (*Max70Text)(&value)
+		case "Prxy":
+			elem := new(ProxyAccountIdentification1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Proxy = elem
+		case "Ownr":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Owner = elem
+		case "Svcr":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Servicer = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
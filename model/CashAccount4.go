@@ -24,6 +24,10 @@ type CashAccount4 struct {
 	ExtendedInvestmentAccountType *Extended350Code `xml:"XtndedInvstmtAcctTp,omitempty"`
 }
 
+func (c *CashAccount4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount4) AddIdentification() *AccountIdentificationAndName3 {
 	c.Identification = new(AccountIdentificationAndName3)
 	return c.Identification
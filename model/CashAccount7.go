@@ -20,6 +20,10 @@ type CashAccount7 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (c *CashAccount7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccount7) AddIdentification() *AccountIdentification3Choice {
 	c.Identification = new(AccountIdentification3Choice)
 	return c.Identification
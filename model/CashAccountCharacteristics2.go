@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the characteristics of the cash account.
 type CashAccountCharacteristics2 struct {
 
@@ -43,6 +47,10 @@ type CashAccountCharacteristics2 struct {
 	AccountServicerContact *ContactDetails3 `xml:"AcctSvcrCtct"`
 }
 
+func (c *CashAccountCharacteristics2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccountCharacteristics2) SetAccountLevel(value string) {
 	c.AccountLevel = (*AccountLevel2Code)(&value)
 }
@@ -75,6 +83,11 @@ func (c *CashAccountCharacteristics2) SetDelayedDebitDate(value string) {
 	c.DelayedDebitDate = (*ISODate)(&value)
 }
 
+func (c *CashAccountCharacteristics2) SetDelayedDebitDateFromTime(value time.Time) {
+	c.DelayedDebitDate = new(ISODate)
+	c.DelayedDebitDate.FromTime(value)
+}
+
 func (c *CashAccountCharacteristics2) SetSettlementAdvice(value string) {
 	c.SettlementAdvice = (*Max105Text)(&value)
 }
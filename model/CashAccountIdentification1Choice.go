@@ -16,6 +16,10 @@ type CashAccountIdentification1Choice struct {
 	DomesticAccount *SimpleIdentificationInformation `xml:"DmstAcct"`
 }
 
+func (c *CashAccountIdentification1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccountIdentification1Choice) SetIBAN(value string) {
 	c.IBAN = (*IBANIdentifier)(&value)
 }
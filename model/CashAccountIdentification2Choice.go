@@ -10,6 +10,10 @@ type CashAccountIdentification2Choice struct {
 	Proprietary *Max34Text `xml:"Prtry"`
 }
 
+func (c *CashAccountIdentification2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccountIdentification2Choice) SetIBAN(value string) {
 	c.IBAN = (*IBANIdentifier)(&value)
 }
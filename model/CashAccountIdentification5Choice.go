@@ -10,6 +10,10 @@ type CashAccountIdentification5Choice struct {
 	Proprietary *Max34Text `xml:"Prtry"`
 }
 
+func (c *CashAccountIdentification5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccountIdentification5Choice) SetIBAN(value string) {
 	c.IBAN = (*IBAN2007Identifier)(&value)
 }
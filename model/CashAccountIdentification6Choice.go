@@ -10,6 +10,10 @@ type CashAccountIdentification6Choice struct {
 	Proprietary *RestrictedFINX2Max34Text `xml:"Prtry"`
 }
 
+func (c *CashAccountIdentification6Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccountIdentification6Choice) SetIBAN(value string) {
 	c.IBAN = (*IBAN2007Identifier)(&value)
 }
@@ -10,6 +10,10 @@ type CashAccountType1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CashAccountType1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccountType1) SetStructured(value string) {
 	c.Structured = (*FundCashAccount1Code)(&value)
 }
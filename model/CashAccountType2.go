@@ -10,8 +10,16 @@ type CashAccountType2 struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
-func (c *CashAccountType2) SetCode(value string) {
+func (c *CashAccountType2) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashAccountType2) SetCode(value string) error {
+	if err := CashAccountType4Code(value).Validate(); err != nil {
+		return err
+	}
 	c.Code = (*CashAccountType4Code)(&value)
+	return nil
 }
 
 func (c *CashAccountType2) SetProprietary(value string) {
@@ -10,6 +10,10 @@ type CashAccountType3Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CashAccountType3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAccountType3Choice) SetCode(value string) {
 	c.Code = (*CashAccountType5Code)(&value)
 }
@@ -1,3 +1,54 @@
 package model
 
 type CashAccountType4Code string
+
+const (
+	CashAccountType4CodeCASH CashAccountType4Code = "CASH"
+	CashAccountType4CodeCHAR CashAccountType4Code = "CHAR"
+	CashAccountType4CodeCOMM CashAccountType4Code = "COMM"
+	CashAccountType4CodeTAXE CashAccountType4Code = "TAXE"
+	CashAccountType4CodeCISH CashAccountType4Code = "CISH"
+	CashAccountType4CodeTRAS CashAccountType4Code = "TRAS"
+	CashAccountType4CodeSACC CashAccountType4Code = "SACC"
+	CashAccountType4CodeCACC CashAccountType4Code = "CACC"
+	CashAccountType4CodeSVGS CashAccountType4Code = "SVGS"
+	CashAccountType4CodeONDP CashAccountType4Code = "ONDP"
+	CashAccountType4CodeMGLD CashAccountType4Code = "MGLD"
+	CashAccountType4CodeNREX CashAccountType4Code = "NREX"
+	CashAccountType4CodeMOMA CashAccountType4Code = "MOMA"
+	CashAccountType4CodeLOAN CashAccountType4Code = "LOAN"
+	CashAccountType4CodeSLRY CashAccountType4Code = "SLRY"
+	CashAccountType4CodeODFT CashAccountType4Code = "ODFT"
+)
+
+var cashAccountType4CodeDescriptions = map[CashAccountType4Code]string{
+	CashAccountType4CodeCASH: "Cash payment: account used to post debits and credits when no specific account has been nominated.",
+	CashAccountType4CodeCHAR: "Charges: account used for the payment of charges.",
+	CashAccountType4CodeCOMM: "Commission: account used for the payment of commission.",
+	CashAccountType4CodeTAXE: "Tax: account used for the payment of taxes.",
+	CashAccountType4CodeCISH: "Cash income: account used for payment of income if different from the current cash account.",
+	CashAccountType4CodeTRAS: "Cash trading: account used for trading if different from the current cash account.",
+	CashAccountType4CodeSACC: "Settlement: account used to post settlement debit and credit entries.",
+	CashAccountType4CodeCACC: "Current: account used to post debits and credits when no specific account has been nominated.",
+	CashAccountType4CodeSVGS: "Savings: account used for savings.",
+	CashAccountType4CodeONDP: "Overnight deposit: account used for overnight deposits.",
+	CashAccountType4CodeMGLD: "Marginal lending: account used for a marginal lending facility.",
+	CashAccountType4CodeNREX: "Non resident external: account used for non-resident external transactions.",
+	CashAccountType4CodeMOMA: "Money market: account used for money markets if different from the cash account.",
+	CashAccountType4CodeLOAN: "Loan: account used for loans.",
+	CashAccountType4CodeSLRY: "Salary: account used for the payment of salaries.",
+	CashAccountType4CodeODFT: "Overdraft: account is used for overdrafts.",
+}
+
+func (c CashAccountType4Code) IsValid() bool {
+	_, ok := cashAccountType4CodeDescriptions[c]
+	return ok
+}
+
+func (c CashAccountType4Code) Description() string {
+	return cashAccountType4CodeDescriptions[c]
+}
+
+func (c CashAccountType4Code) Validate() error {
+	return validateEnumeration("CashAccountType4Code", string(c), c.IsValid(), "CASH, CHAR, COMM, TAXE, CISH, TRAS, SACC, CACC, SVGS, ONDP, MGLD, NREX, MOMA, LOAN, SLRY, ODFT")
+}
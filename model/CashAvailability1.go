@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Indicates when the amount of money will become available, that is can be accessed and start generating interest.
 type CashAvailability1 struct {
 
@@ -14,6 +19,10 @@ type CashAvailability1 struct {
 	CreditDebitIndicator *CreditDebitCode `xml:"CdtDbtInd"`
 }
 
+func (c *CashAvailability1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAvailability1) AddDate() *CashAvailabilityDate1Choice {
 	c.Date = new(CashAvailabilityDate1Choice)
 	return c.Date
@@ -23,6 +32,64 @@ func (c *CashAvailability1) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (c *CashAvailability1) SetCreditDebitIndicator(value string) {
+func (c *CashAvailability1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *CashAvailability1) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
+}
+
+func (c *CashAvailability1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Date != nil {
+		c.Date.EncodeXMLFast(enc, "Dt")
+	}
+	if c.Amount != nil {
+		c.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if c.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*c.CreditDebitIndicator))
+	}
+	enc.End(tag)
+}
+
+func (c *CashAvailability1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Dt":
+			elem := new(CashAvailabilityDate1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Date = elem
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Amount = elem
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Indicates when the amount of money will become available.
 type CashAvailabilityDate1Choice struct {
 
@@ -10,6 +16,10 @@ type CashAvailabilityDate1Choice struct {
 	ActualDate *ISODate `xml:"ActlDt"`
 }
 
+func (c *CashAvailabilityDate1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashAvailabilityDate1Choice) SetNumberOfDays(value string) {
 	c.NumberOfDays = (*Max15PlusSignedNumericText)(&value)
 }
@@ -17,3 +27,49 @@ func (c *CashAvailabilityDate1Choice) SetNumberOfDays(value string) {
 func (c *CashAvailabilityDate1Choice) SetActualDate(value string) {
 	c.ActualDate = (*ISODate)(&value)
 }
+
+func (c *CashAvailabilityDate1Choice) SetActualDateFromTime(value time.Time) {
+	c.ActualDate = new(ISODate)
+	c.ActualDate.FromTime(value)
+}
+
+func (c *CashAvailabilityDate1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.NumberOfDays != nil {
+		enc.Element("NbOfDays", string(*c.NumberOfDays))
+	}
+	if c.ActualDate != nil {
+		enc.Element("ActlDt", string(*c.ActualDate))
+	}
+	enc.End(tag)
+}
+
+func (c *CashAvailabilityDate1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "NbOfDays":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.NumberOfDays = (*Max15PlusSignedNumericText)(&value)
+		case "ActlDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ActualDate = (*ISODate)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements defining the balance details.
 type CashBalance2 struct {
 
@@ -25,6 +29,10 @@ type CashBalance2 struct {
 	Availability []*CashBalanceAvailability1 `xml:"Avlbty,omitempty"`
 }
 
+func (c *CashBalance2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashBalance2) AddType() *BalanceType2Choice {
 	c.Type = new(BalanceType2Choice)
 	return c.Type
@@ -39,8 +47,16 @@ func (c *CashBalance2) SetAmount(value, currency string) {
 	c.Amount = NewCurrencyAndAmount(value, currency)
 }
 
-func (c *CashBalance2) SetCreditDebitIndicator(value string) {
+func (c *CashBalance2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *CashBalance2) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashBalance2) AddDate() *DateAndDateTimeChoice {
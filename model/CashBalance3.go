@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to define the balance details.
 type CashBalance3 struct {
 
@@ -26,6 +30,10 @@ type CashBalance3 struct {
 	Availability []*CashBalanceAvailability2 `xml:"Avlbty,omitempty"`
 }
 
+func (c *CashBalance3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashBalance3) AddType() *BalanceType12 {
 	c.Type = new(BalanceType12)
 	return c.Type
@@ -40,8 +48,16 @@ func (c *CashBalance3) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (c *CashBalance3) SetCreditDebitIndicator(value string) {
+func (c *CashBalance3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *CashBalance3) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashBalance3) AddDate() *DateAndDateTimeChoice {
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of elements used to define the balance details.
 type CashBalance7 struct {
 
@@ -26,6 +31,10 @@ type CashBalance7 struct {
 	Availability []*CashAvailability1 `xml:"Avlbty,omitempty"`
 }
 
+func (c *CashBalance7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashBalance7) AddType() *BalanceType12 {
 	c.Type = new(BalanceType12)
 	return c.Type
@@ -40,8 +49,16 @@ func (c *CashBalance7) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (c *CashBalance7) SetCreditDebitIndicator(value string) {
+func (c *CashBalance7) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *CashBalance7) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashBalance7) AddDate() *DateAndDateTimeChoice {
@@ -55,6 +72,85 @@ func (c *CashBalance7) AddAvailability() *CashAvailability1 {
 	return newValue
 }
 
+func (c *CashBalance7) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Type != nil {
+		c.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if c.CreditLine != nil {
+		c.CreditLine.EncodeXMLFast(enc, "CdtLine")
+	}
+	if c.Amount != nil {
+		c.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if c.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*c.CreditDebitIndicator))
+	}
+	if c.Date != nil {
+		c.Date.EncodeXMLFast(enc, "Dt")
+	}
+	for _, elem := range c.Availability {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Avlbty")
+		}
+	}
+	enc.End(tag)
+}
+
+func (c *CashBalance7) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(BalanceType12)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Type = elem
+		case "CdtLine":
+			elem := new(CreditLine2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditLine = elem
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Amount = elem
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		case "Dt":
+			elem := new(DateAndDateTimeChoice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Date = elem
+		case "Avlbty":
+			elem := new(CashAvailability1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Availability = append(c.Availability, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
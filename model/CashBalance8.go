@@ -0,0 +1,155 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Set of elements used to define the balance details.
+type CashBalance8 struct {
+
+	// Specifies the nature of a balance.
+	Type *BalanceType13 `xml:"Tp"`
+
+	// Set of elements used to provide details on the credit line.
+	CreditLine []*CreditLine3 `xml:"CdtLine,omitempty"`
+
+	// Amount of money of the cash balance.
+	Amount *ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+
+	// Indicates whether the balance is a credit or a debit balance.
+	// Usage: A zero balance is considered to be a credit balance.
+	CreditDebitIndicator *CreditDebitCode `xml:"CdtDbtInd"`
+
+	// Indicates the date (and time) of the balance.
+	Date *DateAndDateTime2Choice `xml:"Dt"`
+
+	// Set of elements used to indicate when the booked amount of money will become available, that is can be accessed and starts generating interest.
+	//
+	// Usage: This type of information is used in the US and is linked to particular instruments such as cheques.
+	// Example: When a cheque is deposited, it will be booked on the deposit day, but the amount of money will only be accessible as of the indicated availability day (according to national banking regulations).
+	Availability []*CashAvailability1 `xml:"Avlbty,omitempty"`
+}
+
+func (c *CashBalance8) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashBalance8) AddType() *BalanceType13 {
+	c.Type = new(BalanceType13)
+	return c.Type
+}
+
+func (c *CashBalance8) AddCreditLine() *CreditLine3 {
+	newValue := new(CreditLine3)
+	c.CreditLine = append(c.CreditLine, newValue)
+	return newValue
+}
+
+func (c *CashBalance8) SetAmount(value, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (c *CashBalance8) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *CashBalance8) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
+	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
+}
+
+func (c *CashBalance8) AddDate() *DateAndDateTime2Choice {
+	c.Date = new(DateAndDateTime2Choice)
+	return c.Date
+}
+
+func (c *CashBalance8) AddAvailability() *CashAvailability1 {
+	newValue := new(CashAvailability1)
+	c.Availability = append(c.Availability, newValue)
+	return newValue
+}
+
+func (c *CashBalance8) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Type != nil {
+		c.Type.EncodeXMLFast(enc, "Tp")
+	}
+	for _, elem := range c.CreditLine {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "CdtLine")
+		}
+	}
+	if c.Amount != nil {
+		c.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if c.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*c.CreditDebitIndicator))
+	}
+	if c.Date != nil {
+		c.Date.EncodeXMLFast(enc, "Dt")
+	}
+	for _, elem := range c.Availability {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Avlbty")
+		}
+	}
+	enc.End(tag)
+}
+
+func (c *CashBalance8) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(BalanceType13)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Type = elem
+		case "CdtLine":
+			elem := new(CreditLine3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditLine = append(c.CreditLine, elem)
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Amount = elem
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		case "Dt":
+			elem := new(DateAndDateTime2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Date = elem
+		case "Avlbty":
+			elem := new(CashAvailability1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Availability = append(c.Availability, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to indicate when the booked amount of money will become available, ie can be accessed and start generating interest.
 type CashBalanceAvailability1 struct {
 
@@ -13,6 +17,10 @@ type CashBalanceAvailability1 struct {
 	CreditDebitIndicator *CreditDebitCode `xml:"CdtDbtInd"`
 }
 
+func (c *CashBalanceAvailability1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashBalanceAvailability1) AddDate() *CashBalanceAvailabilityDate1 {
 	c.Date = new(CashBalanceAvailabilityDate1)
 	return c.Date
@@ -22,6 +30,14 @@ func (c *CashBalanceAvailability1) SetAmount(value, currency string) {
 	c.Amount = NewCurrencyAndAmount(value, currency)
 }
 
-func (c *CashBalanceAvailability1) SetCreditDebitIndicator(value string) {
+func (c *CashBalanceAvailability1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *CashBalanceAvailability1) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
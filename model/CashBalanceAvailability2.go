@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Indicates when the amount of money will become available, ie can be accessed and start generating interest.
 type CashBalanceAvailability2 struct {
 
@@ -14,6 +18,10 @@ type CashBalanceAvailability2 struct {
 	CreditDebitIndicator *CreditDebitCode `xml:"CdtDbtInd"`
 }
 
+func (c *CashBalanceAvailability2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashBalanceAvailability2) AddDate() *CashBalanceAvailabilityDate1 {
 	c.Date = new(CashBalanceAvailabilityDate1)
 	return c.Date
@@ -23,6 +31,14 @@ func (c *CashBalanceAvailability2) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (c *CashBalanceAvailability2) SetCreditDebitIndicator(value string) {
+func (c *CashBalanceAvailability2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *CashBalanceAvailability2) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
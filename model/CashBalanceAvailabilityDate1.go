@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Indicates when the amount of money will become available.
 type CashBalanceAvailabilityDate1 struct {
 
@@ -10,6 +14,10 @@ type CashBalanceAvailabilityDate1 struct {
 	ActualDate *ISODate `xml:"ActlDt"`
 }
 
+func (c *CashBalanceAvailabilityDate1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashBalanceAvailabilityDate1) SetNumberOfDays(value string) {
 	c.NumberOfDays = (*Max15PlusSignedNumericText)(&value)
 }
@@ -17,3 +25,8 @@ func (c *CashBalanceAvailabilityDate1) SetNumberOfDays(value string) {
 func (c *CashBalanceAvailabilityDate1) SetActualDate(value string) {
 	c.ActualDate = (*ISODate)(&value)
 }
+
+func (c *CashBalanceAvailabilityDate1) SetActualDateFromTime(value time.Time) {
+	c.ActualDate = new(ISODate)
+	c.ActualDate.FromTime(value)
+}
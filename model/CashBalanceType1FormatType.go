@@ -10,6 +10,10 @@ type CashBalanceType1FormatType struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (c *CashBalanceType1FormatType) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashBalanceType1FormatType) SetCode(value string) {
 	c.Code = (*CashBalanceType1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the cash posted as collateral.
 type CashCollateral2 struct {
 
@@ -34,6 +40,10 @@ type CashCollateral2 struct {
 	Haircut *PercentageRate `xml:"Hrcut,omitempty"`
 }
 
+func (c *CashCollateral2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashCollateral2) SetCollateralIdentification(value string) {
 	c.CollateralIdentification = (*Max35Text)(&value)
 }
@@ -47,10 +57,19 @@ func (c *CashCollateral2) SetReturnExcess(value string) {
 	c.ReturnExcess = (*YesNoIndicator)(&value)
 }
 
+func (c *CashCollateral2) SetReturnExcessFromBool(value bool) {
+	c.ReturnExcess = new(YesNoIndicator)
+	c.ReturnExcess.FromBool(value)
+}
+
 func (c *CashCollateral2) SetDepositAmount(value, currency string) {
 	c.DepositAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CashCollateral2) SetDepositAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.DepositAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashCollateral2) SetDepositType(value string) {
 	c.DepositType = (*DepositType1Code)(&value)
 }
@@ -59,18 +78,42 @@ func (c *CashCollateral2) SetMaturityDate(value string) {
 	c.MaturityDate = (*ISODate)(&value)
 }
 
+func (c *CashCollateral2) SetMaturityDateFromTime(value time.Time) {
+	c.MaturityDate = new(ISODate)
+	c.MaturityDate.FromTime(value)
+}
+
 func (c *CashCollateral2) SetValueDate(value string) {
 	c.ValueDate = (*ISODate)(&value)
 }
 
+func (c *CashCollateral2) SetValueDateFromTime(value time.Time) {
+	c.ValueDate = new(ISODate)
+	c.ValueDate.FromTime(value)
+}
+
 func (c *CashCollateral2) SetExchangeRate(value string) {
 	c.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (c *CashCollateral2) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(BaseOneRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
 func (c *CashCollateral2) SetCollateralValue(value, currency string) {
 	c.CollateralValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CashCollateral2) SetCollateralValueFromDecimal(value decimal.Decimal, currency string) {
+	c.CollateralValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashCollateral2) SetHaircut(value string) {
 	c.Haircut = (*PercentageRate)(&value)
 }
+
+func (c *CashCollateral2) SetHaircutFromDecimal(value decimal.Decimal) {
+	c.Haircut = new(PercentageRate)
+	c.Haircut.FromDecimal(value)
+}
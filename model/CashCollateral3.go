@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the cash posted as collateral.
 type CashCollateral3 struct {
 
@@ -31,6 +37,10 @@ type CashCollateral3 struct {
 	Haircut *PercentageRate `xml:"Hrcut,omitempty"`
 }
 
+func (c *CashCollateral3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashCollateral3) SetCollateralIdentification(value string) {
 	c.CollateralIdentification = (*Max35Text)(&value)
 }
@@ -44,6 +54,10 @@ func (c *CashCollateral3) SetDepositAmount(value, currency string) {
 	c.DepositAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CashCollateral3) SetDepositAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.DepositAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashCollateral3) SetDepositType(value string) {
 	c.DepositType = (*DepositType1Code)(&value)
 }
@@ -52,18 +66,42 @@ func (c *CashCollateral3) SetMaturityDate(value string) {
 	c.MaturityDate = (*ISODate)(&value)
 }
 
+func (c *CashCollateral3) SetMaturityDateFromTime(value time.Time) {
+	c.MaturityDate = new(ISODate)
+	c.MaturityDate.FromTime(value)
+}
+
 func (c *CashCollateral3) SetValueDate(value string) {
 	c.ValueDate = (*ISODate)(&value)
 }
 
+func (c *CashCollateral3) SetValueDateFromTime(value time.Time) {
+	c.ValueDate = new(ISODate)
+	c.ValueDate.FromTime(value)
+}
+
 func (c *CashCollateral3) SetExchangeRate(value string) {
 	c.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (c *CashCollateral3) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(BaseOneRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
 func (c *CashCollateral3) SetCollateralValue(value, currency string) {
 	c.CollateralValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CashCollateral3) SetCollateralValueFromDecimal(value decimal.Decimal, currency string) {
+	c.CollateralValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashCollateral3) SetHaircut(value string) {
 	c.Haircut = (*PercentageRate)(&value)
 }
+
+func (c *CashCollateral3) SetHaircutFromDecimal(value decimal.Decimal) {
+	c.Haircut = new(PercentageRate)
+	c.Haircut.FromDecimal(value)
+}
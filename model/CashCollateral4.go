@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the cash posted as collateral.
 type CashCollateral4 struct {
 
@@ -31,6 +37,10 @@ type CashCollateral4 struct {
 	Haircut *PercentageRate `xml:"Hrcut,omitempty"`
 }
 
+func (c *CashCollateral4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashCollateral4) SetAssetNumber(value string) {
 	c.AssetNumber = (*Max35Text)(&value)
 }
@@ -39,6 +49,10 @@ func (c *CashCollateral4) SetDepositAmount(value, currency string) {
 	c.DepositAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CashCollateral4) SetDepositAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.DepositAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashCollateral4) SetDepositType(value string) {
 	c.DepositType = (*DepositType1Code)(&value)
 }
@@ -47,22 +61,50 @@ func (c *CashCollateral4) SetBlockedAmount(value, currency string) {
 	c.BlockedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CashCollateral4) SetBlockedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.BlockedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashCollateral4) SetMaturityDate(value string) {
 	c.MaturityDate = (*ISODate)(&value)
 }
 
+func (c *CashCollateral4) SetMaturityDateFromTime(value time.Time) {
+	c.MaturityDate = new(ISODate)
+	c.MaturityDate.FromTime(value)
+}
+
 func (c *CashCollateral4) SetValueDate(value string) {
 	c.ValueDate = (*ISODate)(&value)
 }
 
+func (c *CashCollateral4) SetValueDateFromTime(value time.Time) {
+	c.ValueDate = new(ISODate)
+	c.ValueDate.FromTime(value)
+}
+
 func (c *CashCollateral4) SetExchangeRate(value string) {
 	c.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (c *CashCollateral4) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(BaseOneRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
 func (c *CashCollateral4) SetCollateralValue(value, currency string) {
 	c.CollateralValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CashCollateral4) SetCollateralValueFromDecimal(value decimal.Decimal, currency string) {
+	c.CollateralValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashCollateral4) SetHaircut(value string) {
 	c.Haircut = (*PercentageRate)(&value)
 }
+
+func (c *CashCollateral4) SetHaircutFromDecimal(value decimal.Decimal) {
+	c.Haircut = new(PercentageRate)
+	c.Haircut.FromDecimal(value)
+}
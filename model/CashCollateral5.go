@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the cash posted as collateral.
 type CashCollateral5 struct {
 
@@ -34,6 +40,10 @@ type CashCollateral5 struct {
 	Haircut *PercentageRate `xml:"Hrcut,omitempty"`
 }
 
+func (c *CashCollateral5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashCollateral5) SetCollateralIdentification(value string) {
 	c.CollateralIdentification = (*Max35Text)(&value)
 }
@@ -51,6 +61,10 @@ func (c *CashCollateral5) SetDepositAmount(value, currency string) {
 	c.DepositAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CashCollateral5) SetDepositAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.DepositAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashCollateral5) SetDepositType(value string) {
 	c.DepositType = (*DepositType1Code)(&value)
 }
@@ -59,18 +73,42 @@ func (c *CashCollateral5) SetMaturityDate(value string) {
 	c.MaturityDate = (*ISODate)(&value)
 }
 
+func (c *CashCollateral5) SetMaturityDateFromTime(value time.Time) {
+	c.MaturityDate = new(ISODate)
+	c.MaturityDate.FromTime(value)
+}
+
 func (c *CashCollateral5) SetValueDate(value string) {
 	c.ValueDate = (*ISODate)(&value)
 }
 
+func (c *CashCollateral5) SetValueDateFromTime(value time.Time) {
+	c.ValueDate = new(ISODate)
+	c.ValueDate.FromTime(value)
+}
+
 func (c *CashCollateral5) SetExchangeRate(value string) {
 	c.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (c *CashCollateral5) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(BaseOneRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
 func (c *CashCollateral5) SetCollateralValue(value, currency string) {
 	c.CollateralValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CashCollateral5) SetCollateralValueFromDecimal(value decimal.Decimal, currency string) {
+	c.CollateralValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashCollateral5) SetHaircut(value string) {
 	c.Haircut = (*PercentageRate)(&value)
 }
+
+func (c *CashCollateral5) SetHaircutFromDecimal(value decimal.Decimal) {
+	c.Haircut = new(PercentageRate)
+	c.Haircut.FromDecimal(value)
+}
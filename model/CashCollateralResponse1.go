@@ -22,6 +22,10 @@ type CashCollateralResponse1 struct {
 	RejectionInformation *Max35Text `xml:"RjctnInf,omitempty"`
 }
 
+func (c *CashCollateralResponse1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashCollateralResponse1) SetResponseType(value string) {
 	c.ResponseType = (*Status4Code)(&value)
 }
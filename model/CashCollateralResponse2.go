@@ -22,6 +22,10 @@ type CashCollateralResponse2 struct {
 	RejectionInformation *Max35Text `xml:"RjctnInf,omitempty"`
 }
 
+func (c *CashCollateralResponse2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashCollateralResponse2) SetResponseType(value string) {
 	c.ResponseType = (*Status4Code)(&value)
 }
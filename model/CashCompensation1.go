@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides details about the cash compensation such as the fees and the total settlement amount.
 type CashCompensation1 struct {
 
@@ -13,6 +17,10 @@ type CashCompensation1 struct {
 	ValueDate *ISODate `xml:"ValDt,omitempty"`
 }
 
+func (c *CashCompensation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashCompensation1) AddSettlementAmount() *AmountAndDirection20 {
 	c.SettlementAmount = new(AmountAndDirection20)
 	return c.SettlementAmount
@@ -26,3 +34,8 @@ func (c *CashCompensation1) AddFees() *AmountAndDirection20 {
 func (c *CashCompensation1) SetValueDate(value string) {
 	c.ValueDate = (*ISODate)(&value)
 }
+
+func (c *CashCompensation1) SetValueDateFromTime(value time.Time) {
+	c.ValueDate = new(ISODate)
+	c.ValueDate.FromTime(value)
+}
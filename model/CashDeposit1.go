@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Deposit of an amount of money defined in cash notes and/or coins.
 type CashDeposit1 struct {
 
@@ -13,10 +18,18 @@ type CashDeposit1 struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (c *CashDeposit1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashDeposit1) SetNoteDenomination(value, currency string) {
 	c.NoteDenomination = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CashDeposit1) SetNoteDenominationFromDecimal(value decimal.Decimal, currency string) {
+	c.NoteDenomination = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashDeposit1) SetNumberOfNotes(value string) {
 	c.NumberOfNotes = (*Max15NumericText)(&value)
 }
@@ -24,3 +37,57 @@ func (c *CashDeposit1) SetNumberOfNotes(value string) {
 func (c *CashDeposit1) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CashDeposit1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *CashDeposit1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.NoteDenomination != nil {
+		c.NoteDenomination.EncodeXMLFast(enc, "NoteDnmtn")
+	}
+	if c.NumberOfNotes != nil {
+		enc.Element("NbOfNotes", string(*c.NumberOfNotes))
+	}
+	if c.Amount != nil {
+		c.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	enc.End(tag)
+}
+
+func (c *CashDeposit1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "NoteDnmtn":
+			elem := new(ActiveCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.NoteDenomination = elem
+		case "NbOfNotes":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.NumberOfNotes = (*Max15NumericText)(&value)
+		case "Amt":
+			elem := new(ActiveCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Amount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about cash entitlements.
 type CashEntitlement1 struct {
 
@@ -7,6 +11,14 @@ type CashEntitlement1 struct {
 	CashAmount *ActiveCurrencyAndAmount `xml:"CshAmt"`
 }
 
+func (c *CashEntitlement1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashEntitlement1) SetCashAmount(value, currency string) {
 	c.CashAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CashEntitlement1) SetCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.CashAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements into a fund as a result of investment funds transactions, eg, subscriptions or switch-in.
 type CashInForecast1 struct {
 
@@ -19,14 +25,27 @@ type CashInForecast1 struct {
 	CashInBreakdownDetails []*FundCashInBreakdown1 `xml:"CshInBrkdwnDtls,omitempty"`
 }
 
+func (c *CashInForecast1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashInForecast1) SetSettlementDate(value string) {
 	c.SettlementDate = (*ISODate)(&value)
 }
 
+func (c *CashInForecast1) SetSettlementDateFromTime(value time.Time) {
+	c.SettlementDate = new(ISODate)
+	c.SettlementDate.FromTime(value)
+}
+
 func (c *CashInForecast1) SetSubTotalAmount(value, currency string) {
 	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CashInForecast1) SetSubTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashInForecast1) AddSubTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	c.SubTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return c.SubTotalUnitsNumber
@@ -36,6 +55,11 @@ func (c *CashInForecast1) SetExceptionalCashFlowIndicator(value string) {
 	c.ExceptionalCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CashInForecast1) SetExceptionalCashFlowIndicatorFromBool(value bool) {
+	c.ExceptionalCashFlowIndicator = new(YesNoIndicator)
+	c.ExceptionalCashFlowIndicator.FromBool(value)
+}
+
 func (c *CashInForecast1) AddCashInBreakdownDetails() *FundCashInBreakdown1 {
 	newValue := new(FundCashInBreakdown1)
 	c.CashInBreakdownDetails = append(c.CashInBreakdownDetails, newValue)
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements into a fund as a result of investment funds transactions, eg, subscriptions or switch-in.
 type CashInForecast2 struct {
 
@@ -16,14 +22,27 @@ type CashInForecast2 struct {
 	ExceptionalCashFlowIndicator *YesNoIndicator `xml:"XcptnlCshFlowInd,omitempty"`
 }
 
+func (c *CashInForecast2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashInForecast2) SetSettlementDate(value string) {
 	c.SettlementDate = (*ISODate)(&value)
 }
 
+func (c *CashInForecast2) SetSettlementDateFromTime(value time.Time) {
+	c.SettlementDate = new(ISODate)
+	c.SettlementDate.FromTime(value)
+}
+
 func (c *CashInForecast2) SetSubTotalAmount(value, currency string) {
 	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CashInForecast2) SetSubTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashInForecast2) AddSubTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	c.SubTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return c.SubTotalUnitsNumber
@@ -32,3 +51,8 @@ func (c *CashInForecast2) AddSubTotalUnitsNumber() *FinancialInstrumentQuantity1
 func (c *CashInForecast2) SetExceptionalCashFlowIndicator(value string) {
 	c.ExceptionalCashFlowIndicator = (*YesNoIndicator)(&value)
 }
+
+func (c *CashInForecast2) SetExceptionalCashFlowIndicatorFromBool(value bool) {
+	c.ExceptionalCashFlowIndicator = new(YesNoIndicator)
+	c.ExceptionalCashFlowIndicator.FromBool(value)
+}
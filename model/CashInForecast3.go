@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements into a fund as a result of investment funds transactions, eg, subscriptions or switch-in.
 type CashInForecast3 struct {
 
@@ -19,14 +25,27 @@ type CashInForecast3 struct {
 	CashInBreakdownDetails []*FundCashInBreakdown2 `xml:"CshInBrkdwnDtls,omitempty"`
 }
 
+func (c *CashInForecast3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashInForecast3) SetCashSettlementDate(value string) {
 	c.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (c *CashInForecast3) SetCashSettlementDateFromTime(value time.Time) {
+	c.CashSettlementDate = new(ISODate)
+	c.CashSettlementDate.FromTime(value)
+}
+
 func (c *CashInForecast3) SetSubTotalAmount(value, currency string) {
 	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CashInForecast3) SetSubTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashInForecast3) AddSubTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	c.SubTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return c.SubTotalUnitsNumber
@@ -36,6 +55,11 @@ func (c *CashInForecast3) SetExceptionalCashFlowIndicator(value string) {
 	c.ExceptionalCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CashInForecast3) SetExceptionalCashFlowIndicatorFromBool(value bool) {
+	c.ExceptionalCashFlowIndicator = new(YesNoIndicator)
+	c.ExceptionalCashFlowIndicator.FromBool(value)
+}
+
 func (c *CashInForecast3) AddCashInBreakdownDetails() *FundCashInBreakdown2 {
 	newValue := new(FundCashInBreakdown2)
 	c.CashInBreakdownDetails = append(c.CashInBreakdownDetails, newValue)
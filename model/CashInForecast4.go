@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements into a fund as a result of investment funds transactions, eg, subscriptions or switch-in.
 type CashInForecast4 struct {
 
@@ -16,14 +22,27 @@ type CashInForecast4 struct {
 	ExceptionalCashFlowIndicator *YesNoIndicator `xml:"XcptnlCshFlowInd,omitempty"`
 }
 
+func (c *CashInForecast4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashInForecast4) SetCashSettlementDate(value string) {
 	c.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (c *CashInForecast4) SetCashSettlementDateFromTime(value time.Time) {
+	c.CashSettlementDate = new(ISODate)
+	c.CashSettlementDate.FromTime(value)
+}
+
 func (c *CashInForecast4) SetSubTotalAmount(value, currency string) {
 	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CashInForecast4) SetSubTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashInForecast4) AddSubTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	c.SubTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return c.SubTotalUnitsNumber
@@ -32,3 +51,8 @@ func (c *CashInForecast4) AddSubTotalUnitsNumber() *FinancialInstrumentQuantity1
 func (c *CashInForecast4) SetExceptionalCashFlowIndicator(value string) {
 	c.ExceptionalCashFlowIndicator = (*YesNoIndicator)(&value)
 }
+
+func (c *CashInForecast4) SetExceptionalCashFlowIndicatorFromBool(value bool) {
+	c.ExceptionalCashFlowIndicator = new(YesNoIndicator)
+	c.ExceptionalCashFlowIndicator.FromBool(value)
+}
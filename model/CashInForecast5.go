@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements into a fund as a result of investment funds transactions, eg, subscriptions or switch-in.
 type CashInForecast5 struct {
 
@@ -23,14 +29,27 @@ type CashInForecast5 struct {
 	AdditionalBalance *FundBalance1 `xml:"AddtlBal,omitempty"`
 }
 
+func (c *CashInForecast5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashInForecast5) SetCashSettlementDate(value string) {
 	c.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (c *CashInForecast5) SetCashSettlementDateFromTime(value time.Time) {
+	c.CashSettlementDate = new(ISODate)
+	c.CashSettlementDate.FromTime(value)
+}
+
 func (c *CashInForecast5) SetSubTotalAmount(value, currency string) {
 	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CashInForecast5) SetSubTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashInForecast5) AddSubTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	c.SubTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return c.SubTotalUnitsNumber
@@ -40,6 +59,11 @@ func (c *CashInForecast5) SetExceptionalCashFlowIndicator(value string) {
 	c.ExceptionalCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CashInForecast5) SetExceptionalCashFlowIndicatorFromBool(value bool) {
+	c.ExceptionalCashFlowIndicator = new(YesNoIndicator)
+	c.ExceptionalCashFlowIndicator.FromBool(value)
+}
+
 func (c *CashInForecast5) AddCashInBreakdownDetails() *FundCashInBreakdown3 {
 	newValue := new(FundCashInBreakdown3)
 	c.CashInBreakdownDetails = append(c.CashInBreakdownDetails, newValue)
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements into a fund as a result of investment funds transactions, eg, subscriptions or switch-in.
 type CashInForecast6 struct {
 
@@ -20,14 +26,27 @@ type CashInForecast6 struct {
 	AdditionalBalance *FundBalance1 `xml:"AddtlBal,omitempty"`
 }
 
+func (c *CashInForecast6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashInForecast6) SetCashSettlementDate(value string) {
 	c.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (c *CashInForecast6) SetCashSettlementDateFromTime(value time.Time) {
+	c.CashSettlementDate = new(ISODate)
+	c.CashSettlementDate.FromTime(value)
+}
+
 func (c *CashInForecast6) SetSubTotalAmount(value, currency string) {
 	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CashInForecast6) SetSubTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashInForecast6) AddSubTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	c.SubTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return c.SubTotalUnitsNumber
@@ -37,6 +56,11 @@ func (c *CashInForecast6) SetExceptionalCashFlowIndicator(value string) {
 	c.ExceptionalCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CashInForecast6) SetExceptionalCashFlowIndicatorFromBool(value bool) {
+	c.ExceptionalCashFlowIndicator = new(YesNoIndicator)
+	c.ExceptionalCashFlowIndicator.FromBool(value)
+}
+
 func (c *CashInForecast6) AddAdditionalBalance() *FundBalance1 {
 	c.AdditionalBalance = new(FundBalance1)
 	return c.AdditionalBalance
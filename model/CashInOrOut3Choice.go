@@ -10,6 +10,10 @@ type CashInOrOut3Choice struct {
 	CashOutPaymentInstrument *PaymentInstrument7Choice `xml:"CshOutPmtInstrm"`
 }
 
+func (c *CashInOrOut3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashInOrOut3Choice) AddCashInPaymentInstrument() *PaymentInstrument6Choice {
 	c.CashInPaymentInstrument = new(PaymentInstrument6Choice)
 	return c.CashInPaymentInstrument
@@ -10,6 +10,10 @@ type CashInOrOut4Choice struct {
 	CashOutPaymentInstrument *PaymentInstrument7Choice `xml:"CshOutPmtInstrm"`
 }
 
+func (c *CashInOrOut4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashInOrOut4Choice) AddCashInPaymentInstrument() *PaymentInstrument10Choice {
 	c.CashInPaymentInstrument = new(PaymentInstrument10Choice)
 	return c.CashInPaymentInstrument
@@ -10,6 +10,10 @@ type CashInOrOut5Choice struct {
 	CashOutPaymentInstrument *PaymentInstrument11Choice `xml:"CshOutPmtInstrm"`
 }
 
+func (c *CashInOrOut5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashInOrOut5Choice) AddCashInPaymentInstrument() *PaymentInstrument12Choice {
 	c.CashInPaymentInstrument = new(PaymentInstrument12Choice)
 	return c.CashInPaymentInstrument
@@ -10,6 +10,10 @@ type CashInOrOut6Choice struct {
 	CashOutPaymentInstrument *PaymentInstrument11Choice `xml:"CshOutPmtInstrm"`
 }
 
+func (c *CashInOrOut6Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashInOrOut6Choice) AddCashInPaymentInstrument() *PaymentInstrument12Choice {
 	c.CashInPaymentInstrument = new(PaymentInstrument12Choice)
 	return c.CashInPaymentInstrument
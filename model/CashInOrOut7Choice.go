@@ -10,6 +10,10 @@ type CashInOrOut7Choice struct {
 	CashOutPaymentInstrument *PaymentInstrument21Choice `xml:"CshOutPmtInstrm"`
 }
 
+func (c *CashInOrOut7Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashInOrOut7Choice) AddCashInPaymentInstrument() *PaymentInstrument20Choice {
 	c.CashInPaymentInstrument = new(PaymentInstrument20Choice)
 	return c.CashInPaymentInstrument
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements into or out of a fund as a result of investment funds transactions, for example, subscriptions, redemptions or switches.
 type CashInOutForecast7 struct {
 
@@ -10,10 +16,23 @@ type CashInOutForecast7 struct {
 	Amount *ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
 }
 
+func (c *CashInOutForecast7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashInOutForecast7) SetCashSettlementDate(value string) {
 	c.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (c *CashInOutForecast7) SetCashSettlementDateFromTime(value time.Time) {
+	c.CashSettlementDate = new(ISODate)
+	c.CashSettlementDate.FromTime(value)
+}
+
 func (c *CashInOutForecast7) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (c *CashInOutForecast7) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the cash movement.
 type CashMovement1 struct {
 
@@ -19,6 +23,10 @@ type CashMovement1 struct {
 	AccountDetails []*CashAccount18 `xml:"AcctDtls"`
 }
 
+func (c *CashMovement1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashMovement1) SetMovementIdentification(value string) {
 	c.MovementIdentification = (*Max35Text)(&value)
 }
@@ -27,10 +35,18 @@ func (c *CashMovement1) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CashMovement1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashMovement1) SetTaxAmount(value, currency string) {
 	c.TaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CashMovement1) SetTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashMovement1) AddCharges() *Charges1 {
 	newValue := new(Charges1)
 	c.Charges = append(c.Charges, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the cash movement.
 type CashMovement2 struct {
 
@@ -10,10 +14,18 @@ type CashMovement2 struct {
 	AccountDetails []*CashAccount19 `xml:"AcctDtls"`
 }
 
+func (c *CashMovement2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashMovement2) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CashMovement2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashMovement2) AddAccountDetails() *CashAccount19 {
 	newValue := new(CashAccount19)
 	c.AccountDetails = append(c.AccountDetails, newValue)
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the cash movement.
 type CashMovement3 struct {
 
@@ -16,6 +22,10 @@ type CashMovement3 struct {
 	AccountDetails []*CashAccount18 `xml:"AcctDtls"`
 }
 
+func (c *CashMovement3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashMovement3) AddPostingDateTime() *DateAndDateTimeChoice {
 	c.PostingDateTime = new(DateAndDateTimeChoice)
 	return c.PostingDateTime
@@ -25,10 +35,19 @@ func (c *CashMovement3) SetValueDate(value string) {
 	c.ValueDate = (*ISODate)(&value)
 }
 
+func (c *CashMovement3) SetValueDateFromTime(value time.Time) {
+	c.ValueDate = new(ISODate)
+	c.ValueDate.FromTime(value)
+}
+
 func (c *CashMovement3) SetPostingAmount(value, currency string) {
 	c.PostingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CashMovement3) SetPostingAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PostingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashMovement3) AddAccountDetails() *CashAccount18 {
 	newValue := new(CashAccount18)
 	c.AccountDetails = append(c.AccountDetails, newValue)
@@ -19,8 +19,16 @@ type CashOption1 struct {
 	ExchangeRate *ForeignExchangeTerms8 `xml:"XchgRate,omitempty"`
 }
 
-func (c *CashOption1) SetCreditDebitIndicator(value string) {
+func (c *CashOption1) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption1) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption1) SetCurrency(value string) {
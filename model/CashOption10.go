@@ -32,8 +32,16 @@ type CashOption10 struct {
 	PriceDetails *PriceDetails3 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption10) SetCreditDebitIndicator(value string) {
+func (c *CashOption10) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption10) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption10) AddNonEligibleProceedsIndicator() *NonEligibleProceedsIndicator1Choice {
@@ -35,8 +35,16 @@ type CashOption11 struct {
 	PriceDetails *PriceDetails3 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption11) SetCreditDebitIndicator(value string) {
+func (c *CashOption11) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption11) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption11) SetContractualPaymentIndicator(value string) {
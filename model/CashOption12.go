@@ -38,8 +38,16 @@ type CashOption12 struct {
 	PriceDetails *PriceDetails2 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption12) SetCreditDebitIndicator(value string) {
+func (c *CashOption12) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption12) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption12) SetContractualPaymentIndicator(value string) {
@@ -38,8 +38,16 @@ type CashOption16 struct {
 	PriceDetails *PriceDetails6 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption16) SetCreditDebitIndicator(value string) {
+func (c *CashOption16) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption16) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption16) SetContractualPaymentIndicator(value string) {
@@ -35,8 +35,16 @@ type CashOption17 struct {
 	PriceDetails *PriceDetails6 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption17) SetCreditDebitIndicator(value string) {
+func (c *CashOption17) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption17) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption17) AddNonEligibleProceedsIndicator() *NonEligibleProceedsIndicator1Choice {
@@ -38,8 +38,16 @@ type CashOption18 struct {
 	PriceDetails *PriceDetails7 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption18) SetCreditDebitIndicator(value string) {
+func (c *CashOption18) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption18) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption18) SetContractualPaymentIndicator(value string) {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the cash option.
 type CashOption19 struct {
 
@@ -22,8 +26,16 @@ type CashOption19 struct {
 	PostingAmount *ActiveCurrencyAndAmount `xml:"PstngAmt"`
 }
 
-func (c *CashOption19) SetCreditDebitIndicator(value string) {
+func (c *CashOption19) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption19) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption19) AddAccount() *Account8Choice {
@@ -49,3 +61,7 @@ func (c *CashOption19) AddValueDate() *DateAndDateTimeChoice {
 func (c *CashOption19) SetPostingAmount(value, currency string) {
 	c.PostingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CashOption19) SetPostingAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PostingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -35,8 +35,16 @@ type CashOption2 struct {
 	TaxVoucherDetails *TaxVoucher2 `xml:"TaxVchrDtls,omitempty"`
 }
 
-func (c *CashOption2) SetCreditDebitIndicator(value string) {
+func (c *CashOption2) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption2) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption2) SetContractualPaymentIndicator(value string) {
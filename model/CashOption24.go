@@ -35,8 +35,16 @@ type CashOption24 struct {
 	PriceDetails *PriceDetails10 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption24) SetCreditDebitIndicator(value string) {
+func (c *CashOption24) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption24) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption24) AddNonEligibleProceedsIndicator() *NonEligibleProceedsIndicator1Choice {
@@ -38,8 +38,16 @@ type CashOption25 struct {
 	PriceDetails *PriceDetails10 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption25) SetCreditDebitIndicator(value string) {
+func (c *CashOption25) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption25) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption25) SetContractualPaymentIndicator(value string) {
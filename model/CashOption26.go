@@ -38,8 +38,16 @@ type CashOption26 struct {
 	PriceDetails *PriceDetails11 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption26) SetCreditDebitIndicator(value string) {
+func (c *CashOption26) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption26) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption26) SetContractualPaymentIndicator(value string) {
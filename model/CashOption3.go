@@ -32,8 +32,16 @@ type CashOption3 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat10Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
-func (c *CashOption3) SetCreditDebitIndicator(value string) {
+func (c *CashOption3) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption3) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption3) SetContractualPaymentIndicator(value string) {
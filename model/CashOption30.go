@@ -41,8 +41,16 @@ type CashOption30 struct {
 	PriceDetails *PriceDetails14 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption30) SetCreditDebitIndicator(value string) {
+func (c *CashOption30) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption30) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption30) SetContractualPaymentIndicator(value string) {
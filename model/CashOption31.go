@@ -41,8 +41,16 @@ type CashOption31 struct {
 	PriceDetails *PriceDetails15 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption31) SetCreditDebitIndicator(value string) {
+func (c *CashOption31) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption31) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption31) SetContractualPaymentIndicator(value string) {
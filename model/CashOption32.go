@@ -38,8 +38,16 @@ type CashOption32 struct {
 	PriceDetails *PriceDetails15 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption32) SetCreditDebitIndicator(value string) {
+func (c *CashOption32) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption32) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption32) AddNonEligibleProceedsIndicator() *NonEligibleProceedsIndicator1Choice {
@@ -41,8 +41,16 @@ type CashOption39 struct {
 	PriceDetails *PriceDetails14 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption39) SetCreditDebitIndicator(value string) {
+func (c *CashOption39) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption39) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption39) SetContractualPaymentIndicator(value string) {
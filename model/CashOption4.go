@@ -29,8 +29,16 @@ type CashOption4 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat10Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
-func (c *CashOption4) SetCreditDebitIndicator(value string) {
+func (c *CashOption4) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption4) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption4) AddNonEligibleProceedsIndicator() *NonEligibleProceedsIndicator1Choice {
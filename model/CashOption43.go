@@ -41,8 +41,16 @@ type CashOption43 struct {
 	PriceDetails *PriceDetails22 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption43) SetCreditDebitIndicator(value string) {
+func (c *CashOption43) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption43) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption43) AddNonEligibleProceedsIndicator() *NonEligibleProceedsIndicator3Choice {
@@ -47,8 +47,16 @@ type CashOption44 struct {
 	PriceDetails *PriceDetails23 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption44) SetCreditDebitIndicator(value string) {
+func (c *CashOption44) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption44) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption44) SetContractualPaymentIndicator(value string) {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the cash option.
 type CashOption45 struct {
 
@@ -22,8 +26,16 @@ type CashOption45 struct {
 	PostingAmount *ActiveCurrencyAndAmount `xml:"PstngAmt"`
 }
 
-func (c *CashOption45) SetCreditDebitIndicator(value string) {
+func (c *CashOption45) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption45) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption45) AddAccount() *Account8Choice {
@@ -49,3 +61,7 @@ func (c *CashOption45) AddValueDate() *DateAndDateTimeChoice {
 func (c *CashOption45) SetPostingAmount(value, currency string) {
 	c.PostingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CashOption45) SetPostingAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PostingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -47,8 +47,16 @@ type CashOption46 struct {
 	PriceDetails *PriceDetails24 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption46) SetCreditDebitIndicator(value string) {
+func (c *CashOption46) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption46) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption46) SetContractualPaymentIndicator(value string) {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the cash option.
 type CashOption48 struct {
 
@@ -22,8 +26,16 @@ type CashOption48 struct {
 	PostingAmount *RestrictedFINActiveCurrencyAndAmount `xml:"PstngAmt"`
 }
 
-func (c *CashOption48) SetCreditDebitIndicator(value string) {
+func (c *CashOption48) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption48) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption48) AddAccount() *Account9Choice {
@@ -49,3 +61,7 @@ func (c *CashOption48) AddValueDate() *DateAndDateTimeChoice {
 func (c *CashOption48) SetPostingAmount(value, currency string) {
 	c.PostingAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CashOption48) SetPostingAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PostingAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -41,8 +41,16 @@ type CashOption49 struct {
 	PriceDetails *PriceDetails25 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption49) SetCreditDebitIndicator(value string) {
+func (c *CashOption49) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption49) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption49) AddNonEligibleProceedsIndicator() *NonEligibleProceedsIndicator4Choice {
@@ -41,8 +41,16 @@ type CashOption50 struct {
 	PriceDetails *PriceDetails22 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption50) SetCreditDebitIndicator(value string) {
+func (c *CashOption50) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption50) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption50) AddNonEligibleProceedsIndicator() *NonEligibleProceedsIndicator3Choice {
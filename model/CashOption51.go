@@ -44,8 +44,16 @@ type CashOption51 struct {
 	PriceDetails *PriceDetails22 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption51) SetCreditDebitIndicator(value string) {
+func (c *CashOption51) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption51) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption51) SetContractualPaymentIndicator(value string) {
@@ -41,8 +41,16 @@ type CashOption53 struct {
 	PriceDetails *PriceDetails25 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption53) SetCreditDebitIndicator(value string) {
+func (c *CashOption53) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption53) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption53) AddNonEligibleProceedsIndicator() *NonEligibleProceedsIndicator4Choice {
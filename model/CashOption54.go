@@ -44,8 +44,16 @@ type CashOption54 struct {
 	PriceDetails *PriceDetails25 `xml:"PricDtls,omitempty"`
 }
 
-func (c *CashOption54) SetCreditDebitIndicator(value string) {
+func (c *CashOption54) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CashOption54) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CashOption54) SetContractualPaymentIndicator(value string) {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements out of a fund as a result of investment funds transactions, eg, redemptions or switch-out.
 type CashOutForecast1 struct {
 
@@ -19,14 +25,27 @@ type CashOutForecast1 struct {
 	CashOutBreakdownDetails []*FundCashOutBreakdown1 `xml:"CshOutBrkdwnDtls,omitempty"`
 }
 
+func (c *CashOutForecast1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashOutForecast1) SetSettlementDate(value string) {
 	c.SettlementDate = (*ISODate)(&value)
 }
 
+func (c *CashOutForecast1) SetSettlementDateFromTime(value time.Time) {
+	c.SettlementDate = new(ISODate)
+	c.SettlementDate.FromTime(value)
+}
+
 func (c *CashOutForecast1) SetSubTotalAmount(value, currency string) {
 	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CashOutForecast1) SetSubTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashOutForecast1) AddSubTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	c.SubTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return c.SubTotalUnitsNumber
@@ -36,6 +55,11 @@ func (c *CashOutForecast1) SetExceptionalCashFlowIndicator(value string) {
 	c.ExceptionalCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CashOutForecast1) SetExceptionalCashFlowIndicatorFromBool(value bool) {
+	c.ExceptionalCashFlowIndicator = new(YesNoIndicator)
+	c.ExceptionalCashFlowIndicator.FromBool(value)
+}
+
 func (c *CashOutForecast1) AddCashOutBreakdownDetails() *FundCashOutBreakdown1 {
 	newValue := new(FundCashOutBreakdown1)
 	c.CashOutBreakdownDetails = append(c.CashOutBreakdownDetails, newValue)
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements out of a fund as a result of investment funds transactions, eg, redemptions or switch-out.
 type CashOutForecast2 struct {
 
@@ -16,14 +22,27 @@ type CashOutForecast2 struct {
 	ExceptionalCashFlowIndicator *YesNoIndicator `xml:"XcptnlCshFlowInd,omitempty"`
 }
 
+func (c *CashOutForecast2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashOutForecast2) SetSettlementDate(value string) {
 	c.SettlementDate = (*ISODate)(&value)
 }
 
+func (c *CashOutForecast2) SetSettlementDateFromTime(value time.Time) {
+	c.SettlementDate = new(ISODate)
+	c.SettlementDate.FromTime(value)
+}
+
 func (c *CashOutForecast2) SetSubTotalAmount(value, currency string) {
 	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CashOutForecast2) SetSubTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashOutForecast2) AddSubTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	c.SubTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return c.SubTotalUnitsNumber
@@ -32,3 +51,8 @@ func (c *CashOutForecast2) AddSubTotalUnitsNumber() *FinancialInstrumentQuantity
 func (c *CashOutForecast2) SetExceptionalCashFlowIndicator(value string) {
 	c.ExceptionalCashFlowIndicator = (*YesNoIndicator)(&value)
 }
+
+func (c *CashOutForecast2) SetExceptionalCashFlowIndicatorFromBool(value bool) {
+	c.ExceptionalCashFlowIndicator = new(YesNoIndicator)
+	c.ExceptionalCashFlowIndicator.FromBool(value)
+}
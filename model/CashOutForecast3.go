@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements out of a fund as a result of investment funds transactions, eg, redemptions or switch-out.
 type CashOutForecast3 struct {
 
@@ -19,14 +25,27 @@ type CashOutForecast3 struct {
 	CashOutBreakdownDetails []*FundCashOutBreakdown2 `xml:"CshOutBrkdwnDtls,omitempty"`
 }
 
+func (c *CashOutForecast3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashOutForecast3) SetCashSettlementDate(value string) {
 	c.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (c *CashOutForecast3) SetCashSettlementDateFromTime(value time.Time) {
+	c.CashSettlementDate = new(ISODate)
+	c.CashSettlementDate.FromTime(value)
+}
+
 func (c *CashOutForecast3) SetSubTotalAmount(value, currency string) {
 	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CashOutForecast3) SetSubTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashOutForecast3) AddSubTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	c.SubTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return c.SubTotalUnitsNumber
@@ -36,6 +55,11 @@ func (c *CashOutForecast3) SetExceptionalCashFlowIndicator(value string) {
 	c.ExceptionalCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CashOutForecast3) SetExceptionalCashFlowIndicatorFromBool(value bool) {
+	c.ExceptionalCashFlowIndicator = new(YesNoIndicator)
+	c.ExceptionalCashFlowIndicator.FromBool(value)
+}
+
 func (c *CashOutForecast3) AddCashOutBreakdownDetails() *FundCashOutBreakdown2 {
 	newValue := new(FundCashOutBreakdown2)
 	c.CashOutBreakdownDetails = append(c.CashOutBreakdownDetails, newValue)
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements out of a fund as a result of investment funds transactions, eg, redemptions or switch-out.
 type CashOutForecast5 struct {
 
@@ -23,14 +29,27 @@ type CashOutForecast5 struct {
 	AdditionalBalance *FundBalance1 `xml:"AddtlBal,omitempty"`
 }
 
+func (c *CashOutForecast5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashOutForecast5) SetCashSettlementDate(value string) {
 	c.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (c *CashOutForecast5) SetCashSettlementDateFromTime(value time.Time) {
+	c.CashSettlementDate = new(ISODate)
+	c.CashSettlementDate.FromTime(value)
+}
+
 func (c *CashOutForecast5) SetSubTotalAmount(value, currency string) {
 	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CashOutForecast5) SetSubTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SubTotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashOutForecast5) AddSubTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	c.SubTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return c.SubTotalUnitsNumber
@@ -40,6 +59,11 @@ func (c *CashOutForecast5) SetExceptionalCashFlowIndicator(value string) {
 	c.ExceptionalCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CashOutForecast5) SetExceptionalCashFlowIndicatorFromBool(value bool) {
+	c.ExceptionalCashFlowIndicator = new(YesNoIndicator)
+	c.ExceptionalCashFlowIndicator.FromBool(value)
+}
+
 func (c *CashOutForecast5) AddCashOutBreakdownDetails() *FundCashOutBreakdown3 {
 	newValue := new(FundCashOutBreakdown3)
 	c.CashOutBreakdownDetails = append(c.CashOutBreakdownDetails, newValue)
@@ -13,6 +13,10 @@ type CashParties10 struct {
 	MarketClaimCounterparty *PartyIdentificationAndAccount52 `xml:"MktClmCtrPty,omitempty"`
 }
 
+func (c *CashParties10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties10) AddCreditor() *PartyIdentificationAndAccount52 {
 	c.Creditor = new(PartyIdentificationAndAccount52)
 	return c.Creditor
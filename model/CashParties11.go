@@ -16,6 +16,10 @@ type CashParties11 struct {
 	CreditorAgent *PartyIdentificationAndAccount55 `xml:"CdtrAgt,omitempty"`
 }
 
+func (c *CashParties11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties11) AddDebtor() *PartyIdentificationAndAccount53 {
 	c.Debtor = new(PartyIdentificationAndAccount53)
 	return c.Debtor
@@ -19,6 +19,10 @@ type CashParties17 struct {
 	Intermediary *PartyIdentificationAndAccount50 `xml:"Intrmy,omitempty"`
 }
 
+func (c *CashParties17) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties17) AddDebtor() *PartyIdentificationAndAccount39 {
 	c.Debtor = new(PartyIdentificationAndAccount39)
 	return c.Debtor
@@ -19,6 +19,10 @@ type CashParties18 struct {
 	Intermediary *PartyIdentificationAndAccount80 `xml:"Intrmy,omitempty"`
 }
 
+func (c *CashParties18) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties18) AddDebtor() *PartyIdentificationAndAccount80 {
 	c.Debtor = new(PartyIdentificationAndAccount80)
 	return c.Debtor
@@ -13,6 +13,10 @@ type CashParties2 struct {
 	MarketClaimCounterparty *PartyIdentificationAndAccount17 `xml:"MktClmCtrPty,omitempty"`
 }
 
+func (c *CashParties2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties2) AddCreditor() *PartyIdentificationAndAccount17 {
 	c.Creditor = new(PartyIdentificationAndAccount17)
 	return c.Creditor
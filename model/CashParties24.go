@@ -17,6 +17,10 @@ type CashParties24 struct {
 	Intermediary2 *PartyIdentificationAndAccount97 `xml:"Intrmy2,omitempty"`
 }
 
+func (c *CashParties24) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties24) AddCreditor() *PartyIdentificationAndAccount96 {
 	c.Creditor = new(PartyIdentificationAndAccount96)
 	return c.Creditor
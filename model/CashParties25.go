@@ -16,6 +16,10 @@ type CashParties25 struct {
 	CreditorAgent *PartyIdentificationAndAccount112 `xml:"CdtrAgt,omitempty"`
 }
 
+func (c *CashParties25) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties25) AddDebtor() *PartyIdentificationAndAccount111 {
 	c.Debtor = new(PartyIdentificationAndAccount111)
 	return c.Debtor
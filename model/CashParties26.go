@@ -19,6 +19,10 @@ type CashParties26 struct {
 	Intermediary *PartyIdentificationAndAccount112 `xml:"Intrmy,omitempty"`
 }
 
+func (c *CashParties26) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties26) AddDebtor() *PartyIdentificationAndAccount111 {
 	c.Debtor = new(PartyIdentificationAndAccount111)
 	return c.Debtor
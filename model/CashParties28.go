@@ -13,6 +13,10 @@ type CashParties28 struct {
 	MarketClaimCounterparty *PartyIdentificationAndAccount120 `xml:"MktClmCtrPty,omitempty"`
 }
 
+func (c *CashParties28) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties28) AddCreditor() *PartyIdentificationAndAccount120 {
 	c.Creditor = new(PartyIdentificationAndAccount120)
 	return c.Creditor
@@ -13,6 +13,10 @@ type CashParties29 struct {
 	MarketClaimCounterparty *PartyIdentificationAndAccount129 `xml:"MktClmCtrPty,omitempty"`
 }
 
+func (c *CashParties29) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties29) AddCreditor() *PartyIdentificationAndAccount129 {
 	c.Creditor = new(PartyIdentificationAndAccount129)
 	return c.Creditor
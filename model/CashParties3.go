@@ -16,6 +16,10 @@ type CashParties3 struct {
 	CreditorAgent *PartyIdentificationAndAccount15 `xml:"CdtrAgt,omitempty"`
 }
 
+func (c *CashParties3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties3) AddDebtor() *PartyIdentificationAndAccount20 {
 	c.Debtor = new(PartyIdentificationAndAccount20)
 	return c.Debtor
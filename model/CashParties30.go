@@ -19,6 +19,10 @@ type CashParties30 struct {
 	Intermediary *PartyIdentificationAndAccount134 `xml:"Intrmy,omitempty"`
 }
 
+func (c *CashParties30) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties30) AddDebtor() *PartyIdentificationAndAccount133 {
 	c.Debtor = new(PartyIdentificationAndAccount133)
 	return c.Debtor
@@ -16,6 +16,10 @@ type CashParties32 struct {
 	CreditorAgent *PartyIdentificationAndAccount134 `xml:"CdtrAgt,omitempty"`
 }
 
+func (c *CashParties32) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties32) AddDebtor() *PartyIdentificationAndAccount133 {
 	c.Debtor = new(PartyIdentificationAndAccount133)
 	return c.Debtor
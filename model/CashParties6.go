@@ -16,6 +16,10 @@ type CashParties6 struct {
 	CreditorAgent *PartyIdentificationAndAccount80 `xml:"CdtrAgt,omitempty"`
 }
 
+func (c *CashParties6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties6) AddDebtor() *PartyIdentificationAndAccount80 {
 	c.Debtor = new(PartyIdentificationAndAccount80)
 	return c.Debtor
@@ -16,6 +16,10 @@ type CashParties7 struct {
 	CreditorAgent *PartyIdentificationAndAccount50 `xml:"CdtrAgt,omitempty"`
 }
 
+func (c *CashParties7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties7) AddDebtor() *PartyIdentificationAndAccount38 {
 	c.Debtor = new(PartyIdentificationAndAccount38)
 	return c.Debtor
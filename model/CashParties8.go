@@ -16,6 +16,10 @@ type CashParties8 struct {
 	CreditorAgent *PartyIdentificationAndAccount50 `xml:"CdtrAgt,omitempty"`
 }
 
+func (c *CashParties8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties8) AddDebtor() *PartyIdentificationAndAccount39 {
 	c.Debtor = new(PartyIdentificationAndAccount39)
 	return c.Debtor
@@ -16,6 +16,10 @@ type CashParties9 struct {
 	CreditorAgent *PartyIdentificationAndAccount50 `xml:"CdtrAgt,omitempty"`
 }
 
+func (c *CashParties9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashParties9) AddDebtor() *PartyIdentificationAndAccount48 {
 	c.Debtor = new(PartyIdentificationAndAccount48)
 	return c.Debtor
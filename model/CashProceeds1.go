@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the cash proceeds.
 type CashProceeds1 struct {
 
@@ -13,10 +17,18 @@ type CashProceeds1 struct {
 	AccountDetails []*CashAccount19 `xml:"AcctDtls"`
 }
 
+func (c *CashProceeds1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashProceeds1) SetPostingAmount(value, currency string) {
 	c.PostingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CashProceeds1) SetPostingAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PostingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CashProceeds1) SetReconciliationDetails(value string) {
 	c.ReconciliationDetails = (*Max350Text)(&value)
 }
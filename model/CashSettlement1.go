@@ -10,6 +10,10 @@ type CashSettlement1 struct {
 	OtherCashSettlementDetails []*PaymentInstrument13 `xml:"OthrCshSttlmDtls,omitempty"`
 }
 
+func (c *CashSettlement1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashSettlement1) AddCashAccountDetails() *CashAccount33 {
 	newValue := new(CashAccount33)
 	c.CashAccountDetails = append(c.CashAccountDetails, newValue)
@@ -13,6 +13,10 @@ type CashSettlement2 struct {
 	OtherCashSettlementDetails []*PaymentInstrument13 `xml:"OthrCshSttlmDtls,omitempty"`
 }
 
+func (c *CashSettlement2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashSettlement2) SetModificationScopeIndication(value string) {
 	c.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
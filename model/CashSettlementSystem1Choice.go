@@ -10,6 +10,10 @@ type CashSettlementSystem1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CashSettlementSystem1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashSettlementSystem1Choice) SetCode(value string) {
 	c.Code = (*CashSettlementSystem2Code)(&value)
 }
@@ -10,6 +10,10 @@ type CashSettlementSystem3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (c *CashSettlementSystem3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashSettlementSystem3Choice) SetCode(value string) {
 	c.Code = (*CashSettlementSystem2Code)(&value)
 }
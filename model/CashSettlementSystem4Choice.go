@@ -10,6 +10,10 @@ type CashSettlementSystem4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CashSettlementSystem4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashSettlementSystem4Choice) SetCode(value string) {
 	c.Code = (*CashSettlementSystem2Code)(&value)
 }
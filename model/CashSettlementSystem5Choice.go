@@ -10,6 +10,10 @@ type CashSettlementSystem5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CashSettlementSystem5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashSettlementSystem5Choice) SetCode(value string) {
 	c.Code = (*CashSettlementSystem2Code)(&value)
 }
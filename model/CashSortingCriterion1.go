@@ -10,6 +10,10 @@ type CashSortingCriterion1 struct {
 	ForecastBreakdownDetails []*ForecastParameter1 `xml:"FcstBrkdwnDtls"`
 }
 
+func (c *CashSortingCriterion1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashSortingCriterion1) AddSortingCriterionType() *SortCriteria1Choice {
 	c.SortingCriterionType = new(SortCriteria1Choice)
 	return c.SortingCriterionType
@@ -10,6 +10,10 @@ type CashSortingCriterion2 struct {
 	ForecastBreakdownDetails []*ForecastParameter2 `xml:"FcstBrkdwnDtls"`
 }
 
+func (c *CashSortingCriterion2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CashSortingCriterion2) AddSortingCriterionType() *SortCriteria1Choice {
 	c.SortingCriterionType = new(SortCriteria1Choice)
 	return c.SortingCriterionType
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the high level purpose of the instruction based on a set of pre-defined categories.
 // Usage: This is used by the initiating party to provide information concerning the processing of the payment. It is likely to trigger special processing by any of the agents involved in the payment chain.
 type CategoryPurpose1Choice struct {
@@ -11,6 +13,10 @@ type CategoryPurpose1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *CategoryPurpose1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CategoryPurpose1Choice) SetCode(value string) {
 	c.Code = (*ExternalCategoryPurpose1Code)(&value)
 }
@@ -18,3 +24,44 @@ func (c *CategoryPurpose1Choice) SetCode(value string) {
 func (c *CategoryPurpose1Choice) SetProprietary(value string) {
 	c.Proprietary = (*Max35Text)(&value)
 }
+
+func (c *CategoryPurpose1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Code != nil {
+		enc.Element("Cd", string(*c.Code))
+	}
+	if c.Proprietary != nil {
+		enc.Element("Prtry", string(*c.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (c *CategoryPurpose1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Code = (*ExternalCategoryPurpose1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
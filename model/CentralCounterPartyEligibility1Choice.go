@@ -10,10 +10,19 @@ type CentralCounterPartyEligibility1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CentralCounterPartyEligibility1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CentralCounterPartyEligibility1Choice) SetIndicator(value string) {
 	c.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CentralCounterPartyEligibility1Choice) SetIndicatorFromBool(value bool) {
+	c.Indicator = new(YesNoIndicator)
+	c.Indicator.FromBool(value)
+}
+
 func (c *CentralCounterPartyEligibility1Choice) AddProprietary() *GenericIdentification20 {
 	c.Proprietary = new(GenericIdentification20)
 	return c.Proprietary
@@ -10,10 +10,19 @@ type CentralCounterPartyEligibility3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (c *CentralCounterPartyEligibility3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CentralCounterPartyEligibility3Choice) SetIndicator(value string) {
 	c.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CentralCounterPartyEligibility3Choice) SetIndicatorFromBool(value bool) {
+	c.Indicator = new(YesNoIndicator)
+	c.Indicator.FromBool(value)
+}
+
 func (c *CentralCounterPartyEligibility3Choice) AddProprietary() *GenericIdentification38 {
 	c.Proprietary = new(GenericIdentification38)
 	return c.Proprietary
@@ -10,10 +10,19 @@ type CentralCounterPartyEligibility4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CentralCounterPartyEligibility4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CentralCounterPartyEligibility4Choice) SetIndicator(value string) {
 	c.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CentralCounterPartyEligibility4Choice) SetIndicatorFromBool(value bool) {
+	c.Indicator = new(YesNoIndicator)
+	c.Indicator.FromBool(value)
+}
+
 func (c *CentralCounterPartyEligibility4Choice) AddProprietary() *GenericIdentification30 {
 	c.Proprietary = new(GenericIdentification30)
 	return c.Proprietary
@@ -10,10 +10,19 @@ type CentralCounterPartyEligibility5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CentralCounterPartyEligibility5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CentralCounterPartyEligibility5Choice) SetIndicator(value string) {
 	c.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CentralCounterPartyEligibility5Choice) SetIndicatorFromBool(value bool) {
+	c.Indicator = new(YesNoIndicator)
+	c.Indicator.FromBool(value)
+}
+
 func (c *CentralCounterPartyEligibility5Choice) AddProprietary() *GenericIdentification47 {
 	c.Proprietary = new(GenericIdentification47)
 	return c.Proprietary
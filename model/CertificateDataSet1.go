@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Formal document used to record a fact and used as proof of the fact, in the context of a commercial trade transaction.
 type CertificateDataSet1 struct {
 
@@ -52,6 +56,10 @@ type CertificateDataSet1 struct {
 	AdditionalInformation []*Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CertificateDataSet1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CertificateDataSet1) AddDataSetIdentification() *DocumentIdentification1 {
 	c.DataSetIdentification = new(DocumentIdentification1)
 	return c.DataSetIdentification
@@ -216,6 +224,11 @@ func (c *CertificateDataSet1) SetIssueDate(value string) {
 	c.IssueDate = (*ISODate)(&value)
 }
 
+func (c *CertificateDataSet1) SetIssueDateFromTime(value time.Time) {
+	c.IssueDate = new(ISODate)
+	c.IssueDate.FromTime(value)
+}
+
 func (c *CertificateDataSet1) AddPlaceOfIssue() *PostalAddress5 {
 	c.PlaceOfIssue = new(PostalAddress5)
 	return c.PlaceOfIssue
@@ -235,6 +248,11 @@ func (c *CertificateDataSet1) SetAuthorisedInspectorIndicator(value string) {
 	c.AuthorisedInspectorIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CertificateDataSet1) SetAuthorisedInspectorIndicatorFromBool(value bool) {
+	c.AuthorisedInspectorIndicator = new(YesNoIndicator)
+	c.AuthorisedInspectorIndicator.FromBool(value)
+}
+
 func (c *CertificateDataSet1) SetCertificateIdentification(value string) {
 	c.CertificateIdentification = (*Max35Text)(&value)
 }
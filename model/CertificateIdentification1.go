@@ -28,6 +28,10 @@ type CertificateIdentification1 struct {
 	Proprietary *ProprietaryReference1 `xml:"Prtry,omitempty"`
 }
 
+func (c *CertificateIdentification1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CertificateIdentification1) SetMessageIdentification(value string) {
 	c.MessageIdentification = (*Max35Text)(&value)
 }
@@ -7,6 +7,10 @@ type CertificateIdentifier1 struct {
 	IssuerAndSerialNumber *IssuerAndSerialNumber1 `xml:"IssrAndSrlNb"`
 }
 
+func (c *CertificateIdentifier1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CertificateIdentifier1) AddIssuerAndSerialNumber() *IssuerAndSerialNumber1 {
 	c.IssuerAndSerialNumber = new(IssuerAndSerialNumber1)
 	return c.IssuerAndSerialNumber
@@ -7,6 +7,10 @@ type CertificateIssuer1 struct {
 	RelativeDistinguishedName []*RelativeDistinguishedName1 `xml:"RltvDstngshdNm"`
 }
 
+func (c *CertificateIssuer1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CertificateIssuer1) AddRelativeDistinguishedName() *RelativeDistinguishedName1 {
 	newValue := new(RelativeDistinguishedName1)
 	c.RelativeDistinguishedName = append(c.RelativeDistinguishedName, newValue)
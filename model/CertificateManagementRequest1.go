@@ -28,6 +28,10 @@ type CertificateManagementRequest1 struct {
 	WhiteListIdentification *PointOfInteraction6 `xml:"WhtListId,omitempty"`
 }
 
+func (c *CertificateManagementRequest1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CertificateManagementRequest1) AddPOIIdentification() *GenericIdentification72 {
 	c.POIIdentification = new(GenericIdentification72)
 	return c.POIIdentification
@@ -28,6 +28,10 @@ type CertificateManagementResponse1 struct {
 	ServerCertificatePath []*Max10KBinary `xml:"SvrCertPth,omitempty"`
 }
 
+func (c *CertificateManagementResponse1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CertificateManagementResponse1) AddPOIIdentification() *GenericIdentification72 {
 	c.POIIdentification = new(GenericIdentification72)
 	return c.POIIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Reference of the transaction, that is the underlying payment instruction or statement entry.
 type CertificateReference1 struct {
 
@@ -10,6 +14,10 @@ type CertificateReference1 struct {
 	Date *ISODate `xml:"Dt"`
 }
 
+func (c *CertificateReference1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CertificateReference1) AddIdentification() *CertificateIdentification1 {
 	c.Identification = new(CertificateIdentification1)
 	return c.Identification
@@ -18,3 +26,8 @@ func (c *CertificateReference1) AddIdentification() *CertificateIdentification1
 func (c *CertificateReference1) SetDate(value string) {
 	c.Date = (*ISODate)(&value)
 }
+
+func (c *CertificateReference1) SetDateFromTime(value time.Time) {
+	c.Date = new(ISODate)
+	c.Date.FromTime(value)
+}
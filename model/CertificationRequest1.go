@@ -13,6 +13,10 @@ type CertificationRequest1 struct {
 	KeyVersion *Max140Text `xml:"KeyVrsn,omitempty"`
 }
 
+func (c *CertificationRequest1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CertificationRequest1) AddCertificateRequestInformation() *CertificationRequest2 {
 	c.CertificateRequestInformation = new(CertificationRequest2)
 	return c.CertificateRequestInformation
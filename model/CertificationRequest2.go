@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information of the certificate to create.
 type CertificationRequest2 struct {
 
@@ -16,10 +20,19 @@ type CertificationRequest2 struct {
 	Attribute []*RelativeDistinguishedName2 `xml:"Attr"`
 }
 
+func (c *CertificationRequest2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CertificationRequest2) SetVersion(value string) {
 	c.Version = (*Number)(&value)
 }
 
+func (c *CertificationRequest2) SetVersionFromDecimal(value decimal.Decimal) {
+	c.Version = new(Number)
+	c.Version.FromDecimal(value)
+}
+
 func (c *CertificationRequest2) AddSubjectName() *CertificateIssuer1 {
 	c.SubjectName = new(CertificateIssuer1)
 	return c.SubjectName
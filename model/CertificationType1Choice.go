@@ -10,6 +10,10 @@ type CertificationType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CertificationType1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CertificationType1Choice) SetCode(value string) {
 	c.Code = (*CertificateType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type CertificationTypeFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CertificationTypeFormat1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CertificationTypeFormat1Choice) SetCode(value string) {
 	c.Code = (*CertificationFormatType1Code)(&value)
 }
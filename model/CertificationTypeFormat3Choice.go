@@ -10,6 +10,10 @@ type CertificationTypeFormat3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CertificationTypeFormat3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CertificationTypeFormat3Choice) SetCode(value string) {
 	c.Code = (*CertificationFormatType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CertificationTypeFormat4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CertificationTypeFormat4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CertificationTypeFormat4Choice) SetCode(value string) {
 	c.Code = (*CertificationFormatType1Code)(&value)
 }
@@ -25,6 +25,10 @@ type CertifiedCharacteristics1Choice struct {
 	PhytosanitaryIndication *YesNoIndicator `xml:"PhytosntryIndctn"`
 }
 
+func (c *CertifiedCharacteristics1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CertifiedCharacteristics1Choice) SetOrigin(value string) {
 	c.Origin = (*CountryCode)(&value)
 }
@@ -51,6 +55,16 @@ func (c *CertifiedCharacteristics1Choice) SetHealthIndication(value string) {
 	c.HealthIndication = (*YesNoIndicator)(&value)
 }
 
+func (c *CertifiedCharacteristics1Choice) SetHealthIndicationFromBool(value bool) {
+	c.HealthIndication = new(YesNoIndicator)
+	c.HealthIndication.FromBool(value)
+}
+
 func (c *CertifiedCharacteristics1Choice) SetPhytosanitaryIndication(value string) {
 	c.PhytosanitaryIndication = (*YesNoIndicator)(&value)
 }
+
+func (c *CertifiedCharacteristics1Choice) SetPhytosanitaryIndicationFromBool(value bool) {
+	c.PhytosanitaryIndication = new(YesNoIndicator)
+	c.PhytosanitaryIndication.FromBool(value)
+}
@@ -25,6 +25,10 @@ type CertifiedCharacteristics2Choice struct {
 	PhytosanitaryIndication *YesNoIndicator `xml:"PhytosntryIndctn"`
 }
 
+func (c *CertifiedCharacteristics2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CertifiedCharacteristics2Choice) SetOrigin(value string) {
 	c.Origin = (*CountryCode)(&value)
 }
@@ -51,6 +55,16 @@ func (c *CertifiedCharacteristics2Choice) SetHealthIndication(value string) {
 	c.HealthIndication = (*YesNoIndicator)(&value)
 }
 
+func (c *CertifiedCharacteristics2Choice) SetHealthIndicationFromBool(value bool) {
+	c.HealthIndication = new(YesNoIndicator)
+	c.HealthIndication.FromBool(value)
+}
+
 func (c *CertifiedCharacteristics2Choice) SetPhytosanitaryIndication(value string) {
 	c.PhytosanitaryIndication = (*YesNoIndicator)(&value)
 }
+
+func (c *CertifiedCharacteristics2Choice) SetPhytosanitaryIndicationFromBool(value bool) {
+	c.PhytosanitaryIndication = new(YesNoIndicator)
+	c.PhytosanitaryIndication.FromBool(value)
+}
@@ -11,6 +11,10 @@ type Channel1Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (c *Channel1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Channel1Choice) SetCode(value string) {
 	c.Code = (*ExternalChannel1Code)(&value)
 }
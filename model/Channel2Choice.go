@@ -10,6 +10,10 @@ type Channel2Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *Channel2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Channel2Choice) SetCode(value string) {
 	c.Code = (*CommunicationMethod3Code)(&value)
 }
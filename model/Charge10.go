@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type Charge10 struct {
 
@@ -19,6 +23,10 @@ type Charge10 struct {
 	RecipientIdentification *PartyIdentification2Choice `xml:"RcptId,omitempty"`
 }
 
+func (c *Charge10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charge10) AddType() *ChargeType1 {
 	c.Type = new(ChargeType1)
 	return c.Type
@@ -33,10 +41,19 @@ func (c *Charge10) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *Charge10) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *Charge10) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
 
+func (c *Charge10) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
+
 func (c *Charge10) AddRecipientIdentification() *PartyIdentification2Choice {
 	c.RecipientIdentification = new(PartyIdentification2Choice)
 	return c.RecipientIdentification
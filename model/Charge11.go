@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type Charge11 struct {
 
@@ -13,14 +17,27 @@ type Charge11 struct {
 	Type *ChargeType1 `xml:"Tp"`
 }
 
+func (c *Charge11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charge11) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *Charge11) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *Charge11) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
 
+func (c *Charge11) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
+
 func (c *Charge11) AddType() *ChargeType1 {
 	c.Type = new(ChargeType1)
 	return c.Type
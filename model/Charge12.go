@@ -10,6 +10,10 @@ type Charge12 struct {
 	Charges []*ChargesDetails1 `xml:"Chrgs,omitempty"`
 }
 
+func (c *Charge12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charge12) SetType(value string) {
 	c.Type = (*FreightCharges1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Charge13 struct {
 	Charges []*ChargesDetails2 `xml:"Chrgs,omitempty"`
 }
 
+func (c *Charge13) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charge13) SetType(value string) {
 	c.Type = (*FreightCharges1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type Charge15 struct {
 
@@ -22,6 +26,10 @@ type Charge15 struct {
 	ExtendedCalculationBasis *Extended350Code `xml:"XtndedClctnBsis,omitempty"`
 }
 
+func (c *Charge15) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charge15) SetType(value string) {
 	c.Type = (*ChargeType9Code)(&value)
 }
@@ -34,10 +42,19 @@ func (c *Charge15) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *Charge15) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *Charge15) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
 
+func (c *Charge15) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
+
 func (c *Charge15) SetCalculationBasis(value string) {
 	c.CalculationBasis = (*CalculationBasis2Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type Charge16 struct {
 
@@ -16,6 +20,10 @@ type Charge16 struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (c *Charge16) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charge16) SetType(value string) {
 	c.Type = (*ChargeType10Code)(&value)
 }
@@ -28,6 +36,15 @@ func (c *Charge16) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *Charge16) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *Charge16) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
+
+func (c *Charge16) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
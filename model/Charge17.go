@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type Charge17 struct {
 
@@ -25,6 +29,10 @@ type Charge17 struct {
 	RecipientIdentification *PartyIdentification2Choice `xml:"RcptId,omitempty"`
 }
 
+func (c *Charge17) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charge17) SetType(value string) {
 	c.Type = (*ChargeType11Code)(&value)
 }
@@ -45,10 +53,19 @@ func (c *Charge17) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *Charge17) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *Charge17) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
 
+func (c *Charge17) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
+
 func (c *Charge17) AddRecipientIdentification() *PartyIdentification2Choice {
 	c.RecipientIdentification = new(PartyIdentification2Choice)
 	return c.RecipientIdentification
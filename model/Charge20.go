@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type Charge20 struct {
 
@@ -25,6 +29,10 @@ type Charge20 struct {
 	RecipientIdentification *PartyIdentification2Choice `xml:"RcptId,omitempty"`
 }
 
+func (c *Charge20) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charge20) SetType(value string) {
 	c.Type = (*ChargeType12Code)(&value)
 }
@@ -37,6 +45,10 @@ func (c *Charge20) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *Charge20) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Charge20) SetChargeBasis(value string) {
 	c.ChargeBasis = (*TaxationBasis2Code)(&value)
 }
@@ -45,8 +57,12 @@ func (c *Charge20) SetExtendedChargeBasis(value string) {
 	c.ExtendedChargeBasis = (*Extended350Code)(&value)
 }
 
-func (c *Charge20) SetChargeBearer(value string) {
+func (c *Charge20) SetChargeBearer(value string) error {
+	if err := ChargeBearer1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChargeBearer = (*ChargeBearer1Code)(&value)
+	return nil
 }
 
 func (c *Charge20) AddRecipientIdentification() *PartyIdentification2Choice {
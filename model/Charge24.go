@@ -10,6 +10,10 @@ type Charge24 struct {
 	Charges []*ChargesDetails3 `xml:"Chrgs,omitempty"`
 }
 
+func (c *Charge24) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charge24) SetType(value string) {
 	c.Type = (*FreightCharges1Code)(&value)
 }
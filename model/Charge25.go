@@ -10,6 +10,10 @@ type Charge25 struct {
 	Charges []*ChargesDetails4 `xml:"Chrgs,omitempty"`
 }
 
+func (c *Charge25) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charge25) SetType(value string) {
 	c.Type = (*FreightCharges1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Charge26 struct {
 	ChargeApplied *AmountOrRate3Choice `xml:"ChrgApld"`
 }
 
+func (c *Charge26) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charge26) AddType() *ChargeType4Choice {
 	c.Type = new(ChargeType4Choice)
 	return c.Type
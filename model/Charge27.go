@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type Charge27 struct {
 
@@ -19,6 +23,10 @@ type Charge27 struct {
 	RecipientIdentification *PartyIdentification2Choice `xml:"RcptId,omitempty"`
 }
 
+func (c *Charge27) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charge27) AddType() *ChargeType4Choice {
 	c.Type = new(ChargeType4Choice)
 	return c.Type
@@ -28,13 +36,21 @@ func (c *Charge27) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *Charge27) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Charge27) AddChargeBasis() *ChargeBasisType1Choice {
 	c.ChargeBasis = new(ChargeBasisType1Choice)
 	return c.ChargeBasis
 }
 
-func (c *Charge27) SetChargeBearer(value string) {
+func (c *Charge27) SetChargeBearer(value string) error {
+	if err := ChargeBearer1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChargeBearer = (*ChargeBearer1Code)(&value)
+	return nil
 }
 
 func (c *Charge27) AddRecipientIdentification() *PartyIdentification2Choice {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type Charge29 struct {
 
@@ -19,6 +23,10 @@ type Charge29 struct {
 	RecipientIdentification *PartyIdentification70Choice `xml:"RcptId,omitempty"`
 }
 
+func (c *Charge29) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charge29) AddType() *ChargeType4Choice {
 	c.Type = new(ChargeType4Choice)
 	return c.Type
@@ -28,13 +36,21 @@ func (c *Charge29) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *Charge29) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Charge29) AddChargeBasis() *ChargeBasisType1Choice {
 	c.ChargeBasis = new(ChargeBasisType1Choice)
 	return c.ChargeBasis
 }
 
-func (c *Charge29) SetChargeBearer(value string) {
+func (c *Charge29) SetChargeBearer(value string) error {
+	if err := ChargeBearer1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChargeBearer = (*ChargeBearer1Code)(&value)
+	return nil
 }
 
 func (c *Charge29) AddRecipientIdentification() *PartyIdentification70Choice {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type Charge4 struct {
 
@@ -19,6 +23,10 @@ type Charge4 struct {
 	RecipientIdentification *PartyIdentification1Choice `xml:"RcptId,omitempty"`
 }
 
+func (c *Charge4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charge4) AddType() *ChargeTypeFormat2Choice {
 	c.Type = new(ChargeTypeFormat2Choice)
 	return c.Type
@@ -28,12 +36,20 @@ func (c *Charge4) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *Charge4) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Charge4) SetChargeBasis(value string) {
 	c.ChargeBasis = (*TaxationBasis2Code)(&value)
 }
 
-func (c *Charge4) SetChargeBearer(value string) {
+func (c *Charge4) SetChargeBearer(value string) error {
+	if err := ChargeBearer1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChargeBearer = (*ChargeBearer1Code)(&value)
+	return nil
 }
 
 func (c *Charge4) AddRecipientIdentification() *PartyIdentification1Choice {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type Charge9 struct {
 
@@ -13,6 +17,10 @@ type Charge9 struct {
 	CalculationBasis *CalculationBasis1 `xml:"ClctnBsis,omitempty"`
 }
 
+func (c *Charge9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charge9) AddType() *ChargeType2 {
 	c.Type = new(ChargeType2)
 	return c.Type
@@ -22,6 +30,10 @@ func (c *Charge9) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *Charge9) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *Charge9) AddCalculationBasis() *CalculationBasis1 {
 	c.CalculationBasis = new(CalculationBasis1)
 	return c.CalculationBasis
@@ -10,6 +10,10 @@ type ChargeBasis2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *ChargeBasis2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargeBasis2Choice) SetCode(value string) {
 	c.Code = (*TaxationBasis5Code)(&value)
 }
@@ -10,6 +10,10 @@ type ChargeBasisType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *ChargeBasisType1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargeBasisType1Choice) SetCode(value string) {
 	c.Code = (*TaxationBasis2Code)(&value)
 }
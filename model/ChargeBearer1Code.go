@@ -1,3 +1,28 @@
 package model
 
 type ChargeBearer1Code string
+
+const (
+	ChargeBearer1CodeOUR ChargeBearer1Code = "OUR"
+	ChargeBearer1CodeBEN ChargeBearer1Code = "BEN"
+	ChargeBearer1CodeSHA ChargeBearer1Code = "SHA"
+)
+
+var chargeBearer1CodeDescriptions = map[ChargeBearer1Code]string{
+	ChargeBearer1CodeOUR: "Borne by debtor: all transaction charges are to be borne by the debtor.",
+	ChargeBearer1CodeBEN: "Borne by creditor: all transaction charges are to be borne by the creditor.",
+	ChargeBearer1CodeSHA: "Shared: transaction charges on the sender side are to be borne by the debtor, transaction charges on the receiver side are to be borne by the creditor.",
+}
+
+func (c ChargeBearer1Code) IsValid() bool {
+	_, ok := chargeBearer1CodeDescriptions[c]
+	return ok
+}
+
+func (c ChargeBearer1Code) Description() string {
+	return chargeBearer1CodeDescriptions[c]
+}
+
+func (c ChargeBearer1Code) Validate() error {
+	return validateEnumeration("ChargeBearer1Code", string(c), c.IsValid(), "OUR, BEN, SHA")
+}
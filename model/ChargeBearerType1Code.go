@@ -1,3 +1,30 @@
 package model
 
 type ChargeBearerType1Code string
+
+const (
+	ChargeBearerType1CodeDEBT ChargeBearerType1Code = "DEBT"
+	ChargeBearerType1CodeCRED ChargeBearerType1Code = "CRED"
+	ChargeBearerType1CodeSHAR ChargeBearerType1Code = "SHAR"
+	ChargeBearerType1CodeSLEV ChargeBearerType1Code = "SLEV"
+)
+
+var chargeBearerType1CodeDescriptions = map[ChargeBearerType1Code]string{
+	ChargeBearerType1CodeDEBT: "Borne by debtor: all transaction charges are to be borne by the debtor.",
+	ChargeBearerType1CodeCRED: "Borne by creditor: all transaction charges are to be borne by the creditor.",
+	ChargeBearerType1CodeSHAR: "Shared: in a credit transfer context, means that transaction charges on the sender side are to be borne by the debtor, transaction charges on the receiver side are to be borne by the creditor.",
+	ChargeBearerType1CodeSLEV: "Following service level: charges are to be applied following the rules agreed in the service level and/or scheme.",
+}
+
+func (c ChargeBearerType1Code) IsValid() bool {
+	_, ok := chargeBearerType1CodeDescriptions[c]
+	return ok
+}
+
+func (c ChargeBearerType1Code) Description() string {
+	return chargeBearerType1CodeDescriptions[c]
+}
+
+func (c ChargeBearerType1Code) Validate() error {
+	return validateEnumeration("ChargeBearerType1Code", string(c), c.IsValid(), "DEBT, CRED, SHAR, SLEV")
+}
@@ -1,3 +1,15 @@
 package model
 
 type ChargeIncludedIndicator string
+
+func (c ChargeIncludedIndicator) Validate() error {
+	return validateBoolean("ChargeIncludedIndicator", string(c))
+}
+
+func (c ChargeIncludedIndicator) Bool() bool {
+	return parseBoolean(string(c))
+}
+
+func (c *ChargeIncludedIndicator) FromBool(value bool) {
+	*c = ChargeIncludedIndicator(formatBoolean(value))
+}
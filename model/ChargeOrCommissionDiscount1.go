@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about discounts or waivers to charges and commissions.
 type ChargeOrCommissionDiscount1 struct {
 
@@ -21,14 +25,27 @@ type ChargeOrCommissionDiscount1 struct {
 	Basis *WaivingInstruction2Choice `xml:"Bsis,omitempty"`
 }
 
+func (c *ChargeOrCommissionDiscount1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargeOrCommissionDiscount1) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *ChargeOrCommissionDiscount1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *ChargeOrCommissionDiscount1) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
 
+func (c *ChargeOrCommissionDiscount1) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
+
 func (c *ChargeOrCommissionDiscount1) AddBasis() *WaivingInstruction2Choice {
 	c.Basis = new(WaivingInstruction2Choice)
 	return c.Basis
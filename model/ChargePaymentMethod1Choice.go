@@ -10,6 +10,10 @@ type ChargePaymentMethod1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *ChargePaymentMethod1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargePaymentMethod1Choice) SetCode(value string) {
 	c.Code = (*ChargePaymentMethod1Code)(&value)
 }
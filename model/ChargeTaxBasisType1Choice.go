@@ -10,6 +10,10 @@ type ChargeTaxBasisType1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (c *ChargeTaxBasisType1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargeTaxBasisType1Choice) SetCode(value string) {
 	c.Code = (*ChargeTaxBasis1Code)(&value)
 }
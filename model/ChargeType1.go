@@ -10,6 +10,10 @@ type ChargeType1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *ChargeType1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargeType1) SetStructured(value string) {
 	c.Structured = (*ChargeType6Code)(&value)
 }
@@ -10,6 +10,10 @@ type ChargeType2 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *ChargeType2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargeType2) SetStructured(value string) {
 	c.Structured = (*ChargeType7Code)(&value)
 }
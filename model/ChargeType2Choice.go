@@ -10,6 +10,10 @@ type ChargeType2Choice struct {
 	Proprietary *GenericIdentification3 `xml:"Prtry"`
 }
 
+func (c *ChargeType2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargeType2Choice) SetCode(value string) {
 	c.Code = (*ChargeType1Code)(&value)
 }
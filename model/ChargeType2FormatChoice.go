@@ -10,6 +10,10 @@ type ChargeType2FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (c *ChargeType2FormatChoice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargeType2FormatChoice) SetCode(value string) {
 	c.Code = (*ChargeType14Code)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the charge type.
 type ChargeType3Choice struct {
 
@@ -10,6 +12,10 @@ type ChargeType3Choice struct {
 	Proprietary *GenericIdentification3 `xml:"Prtry"`
 }
 
+func (c *ChargeType3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargeType3Choice) SetCode(value string) {
 	c.Code = (*ExternalChargeType1Code)(&value)
 }
@@ -18,3 +24,44 @@ func (c *ChargeType3Choice) AddProprietary() *GenericIdentification3 {
 	c.Proprietary = new(GenericIdentification3)
 	return c.Proprietary
 }
+
+func (c *ChargeType3Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Code != nil {
+		enc.Element("Cd", string(*c.Code))
+	}
+	if c.Proprietary != nil {
+		c.Proprietary.EncodeXMLFast(enc, "Prtry")
+	}
+	enc.End(tag)
+}
+
+func (c *ChargeType3Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Code = (*ExternalChargeType1Code)(&value)
+		case "Prtry":
+			elem := new(GenericIdentification3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Proprietary = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ type ChargeType4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *ChargeType4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargeType4Choice) SetCode(value string) {
 	c.Code = (*ChargeType12Code)(&value)
 }
@@ -10,6 +10,10 @@ type ChargeType5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *ChargeType5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargeType5Choice) SetCode(value string) {
 	c.Code = (*InvestmentFundFee1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ChargeTypeChoice struct {
 	ProprietaryCode *Max4AlphaNumericText `xml:"PrtryCd"`
 }
 
+func (c *ChargeTypeChoice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargeTypeChoice) SetCode(value string) {
 	c.Code = (*ChargeType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ChargeTypeFormat2Choice struct {
 	Structured *ChargeType4Code `xml:"Strd"`
 }
 
+func (c *ChargeTypeFormat2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargeTypeFormat2Choice) SetUnstructured(value string) {
 	c.Unstructured = (*Max35Text)(&value)
 }
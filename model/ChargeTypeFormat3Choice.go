@@ -10,6 +10,10 @@ type ChargeTypeFormat3Choice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (c *ChargeTypeFormat3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargeTypeFormat3Choice) SetCode(value string) {
 	c.Code = (*ChargeType17Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the type of charge and the amont.
 type Charges1 struct {
 
@@ -10,6 +14,10 @@ type Charges1 struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (c *Charges1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charges1) AddType() *ChargeType2FormatChoice {
 	c.Type = new(ChargeType2FormatChoice)
 	return c.Type
@@ -18,3 +26,7 @@ func (c *Charges1) AddType() *ChargeType2FormatChoice {
 func (c *Charges1) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *Charges1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
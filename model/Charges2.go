@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of elements used to provide information on the charges related to the payment transaction.
 type Charges2 struct {
 
@@ -10,11 +15,60 @@ type Charges2 struct {
 	Agent *BranchAndFinancialInstitutionIdentification5 `xml:"Agt"`
 }
 
+func (c *Charges2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charges2) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *Charges2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Charges2) AddAgent() *BranchAndFinancialInstitutionIdentification5 {
 	c.Agent = new(BranchAndFinancialInstitutionIdentification5)
 	return c.Agent
 }
+
+func (c *Charges2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Amount != nil {
+		c.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if c.Agent != nil {
+		c.Agent.EncodeXMLFast(enc, "Agt")
+	}
+	enc.End(tag)
+}
+
+func (c *Charges2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Amount = elem
+		case "Agt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Agent = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
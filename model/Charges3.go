@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details on the charges related to the payment transaction.
 type Charges3 struct {
 
@@ -10,10 +14,18 @@ type Charges3 struct {
 	Record []*ChargesRecord1 `xml:"Rcrd,omitempty"`
 }
 
+func (c *Charges3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charges3) SetTotalChargesAndTaxAmount(value, currency string) {
 	c.TotalChargesAndTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *Charges3) SetTotalChargesAndTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalChargesAndTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Charges3) AddRecord() *ChargesRecord1 {
 	newValue := new(ChargesRecord1)
 	c.Record = append(c.Record, newValue)
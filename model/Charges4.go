@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides further details on the charges related to the payment transaction.
 type Charges4 struct {
 
@@ -10,16 +15,67 @@ type Charges4 struct {
 	Record []*ChargesRecord2 `xml:"Rcrd,omitempty"`
 }
 
+func (c *Charges4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charges4) SetTotalChargesAndTaxAmount(value, currency string) {
 	c.TotalChargesAndTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *Charges4) SetTotalChargesAndTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalChargesAndTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Charges4) AddRecord() *ChargesRecord2 {
 	newValue := new(ChargesRecord2)
 	c.Record = append(c.Record, newValue)
 	return newValue
 }
 
+func (c *Charges4) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.TotalChargesAndTaxAmount != nil {
+		c.TotalChargesAndTaxAmount.EncodeXMLFast(enc, "TtlChrgsAndTaxAmt")
+	}
+	for _, elem := range c.Record {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Rcrd")
+		}
+	}
+	enc.End(tag)
+}
+
+func (c *Charges4) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "TtlChrgsAndTaxAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.TotalChargesAndTaxAmount = elem
+		case "Rcrd":
+			elem := new(ChargesRecord2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Record = append(c.Record, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
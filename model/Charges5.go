@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Charges related to a payment obligation contracted between two financial institutions related to the financing of a commercial transaction.
 type Charges5 struct {
 
@@ -19,6 +23,10 @@ type Charges5 struct {
 	Type *Max35Text `xml:"Tp,omitempty"`
 }
 
+func (c *Charges5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Charges5) SetChargesPayer(value string) {
 	c.ChargesPayer = (*BankRole1Code)(&value)
 }
@@ -31,10 +39,19 @@ func (c *Charges5) SetAmount(value, currency string) {
 	c.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (c *Charges5) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Charges5) SetPercentage(value string) {
 	c.Percentage = (*PercentageRate)(&value)
 }
 
+func (c *Charges5) SetPercentageFromDecimal(value decimal.Decimal) {
+	c.Percentage = new(PercentageRate)
+	c.Percentage.FromDecimal(value)
+}
+
 func (c *Charges5) SetType(value string) {
 	c.Type = (*Max35Text)(&value)
 }
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Provides further details on the charges related to the payment transaction.
+type Charges6 struct {
+
+	// Total of all charges and taxes applied to the entry.
+	TotalChargesAndTaxAmount *ActiveOrHistoricCurrencyAndAmount `xml:"TtlChrgsAndTaxAmt,omitempty"`
+
+	// Provides details of the individual charges record.
+	Record []*ChargesRecord3 `xml:"Rcrd,omitempty"`
+}
+
+func (c *Charges6) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *Charges6) SetTotalChargesAndTaxAmount(value, currency string) {
+	c.TotalChargesAndTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (c *Charges6) SetTotalChargesAndTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalChargesAndTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *Charges6) AddRecord() *ChargesRecord3 {
+	newValue := new(ChargesRecord3)
+	c.Record = append(c.Record, newValue)
+	return newValue
+}
+
+func (c *Charges6) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.TotalChargesAndTaxAmount != nil {
+		c.TotalChargesAndTaxAmount.EncodeXMLFast(enc, "TtlChrgsAndTaxAmt")
+	}
+	for _, elem := range c.Record {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Rcrd")
+		}
+	}
+	enc.End(tag)
+}
+
+func (c *Charges6) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "TtlChrgsAndTaxAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.TotalChargesAndTaxAmount = elem
+		case "Rcrd":
+			elem := new(ChargesRecord3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Record = append(c.Record, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
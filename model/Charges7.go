@@ -0,0 +1,74 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Set of elements used to provide information on the charges related to the payment transaction.
+type Charges7 struct {
+
+	// Transaction charges to be paid by the charge bearer.
+	Amount *ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+
+	// Agent that takes the transaction charges or to which the transaction charges are due.
+	Agent *BranchAndFinancialInstitutionIdentification6 `xml:"Agt"`
+}
+
+func (c *Charges7) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *Charges7) SetAmount(value, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (c *Charges7) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *Charges7) AddAgent() *BranchAndFinancialInstitutionIdentification6 {
+	c.Agent = new(BranchAndFinancialInstitutionIdentification6)
+	return c.Agent
+}
+
+func (c *Charges7) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Amount != nil {
+		c.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if c.Agent != nil {
+		c.Agent.EncodeXMLFast(enc, "Agt")
+	}
+	enc.End(tag)
+}
+
+func (c *Charges7) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Amount = elem
+		case "Agt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Agent = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type ChargesDetails1 struct {
 
@@ -16,6 +20,10 @@ type ChargesDetails1 struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (c *ChargesDetails1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargesDetails1) SetType(value string) {
 	c.Type = (*ChargeType8Code)(&value)
 }
@@ -28,6 +36,15 @@ func (c *ChargesDetails1) SetAmount(value, currency string) {
 	c.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (c *ChargesDetails1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *ChargesDetails1) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
+
+func (c *ChargesDetails1) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type ChargesDetails2 struct {
 
@@ -13,6 +17,10 @@ type ChargesDetails2 struct {
 	Amount *CurrencyAndAmount `xml:"Amt"`
 }
 
+func (c *ChargesDetails2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargesDetails2) SetType(value string) {
 	c.Type = (*ChargeType8Code)(&value)
 }
@@ -24,3 +32,7 @@ func (c *ChargesDetails2) SetOtherChargesType(value string) {
 func (c *ChargesDetails2) SetAmount(value, currency string) {
 	c.Amount = NewCurrencyAndAmount(value, currency)
 }
+
+func (c *ChargesDetails2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
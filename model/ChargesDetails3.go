@@ -10,6 +10,10 @@ type ChargesDetails3 struct {
 	AmountOrPercentage *AmountOrPercentage2Choice `xml:"AmtOrPctg"`
 }
 
+func (c *ChargesDetails3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargesDetails3) AddType() *ChargesType1Choice {
 	c.Type = new(ChargesType1Choice)
 	return c.Type
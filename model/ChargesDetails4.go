@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type ChargesDetails4 struct {
 
@@ -10,6 +14,10 @@ type ChargesDetails4 struct {
 	Amount *CurrencyAndAmount `xml:"Amt"`
 }
 
+func (c *ChargesDetails4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargesDetails4) AddChargesType() *ChargesType1Choice {
 	c.ChargesType = new(ChargesType1Choice)
 	return c.ChargesType
@@ -18,3 +26,7 @@ func (c *ChargesDetails4) AddChargesType() *ChargesType1Choice {
 func (c *ChargesDetails4) SetAmount(value, currency string) {
 	c.Amount = NewCurrencyAndAmount(value, currency)
 }
+
+func (c *ChargesDetails4) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information on the charges related to the payment transaction.
 type ChargesInformation1 struct {
 
@@ -10,10 +14,18 @@ type ChargesInformation1 struct {
 	ChargesParty *BranchAndFinancialInstitutionIdentification3 `xml:"ChrgsPty"`
 }
 
+func (c *ChargesInformation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargesInformation1) SetChargesAmount(value, currency string) {
 	c.ChargesAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (c *ChargesInformation1) SetChargesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ChargesAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *ChargesInformation1) AddChargesParty() *BranchAndFinancialInstitutionIdentification3 {
 	c.ChargesParty = new(BranchAndFinancialInstitutionIdentification3)
 	return c.ChargesParty
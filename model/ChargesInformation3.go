@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information on the charges related to the payment transaction.
 type ChargesInformation3 struct {
 
@@ -25,14 +29,26 @@ type ChargesInformation3 struct {
 	Tax *TaxCharges1 `xml:"Tax,omitempty"`
 }
 
+func (c *ChargesInformation3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargesInformation3) SetTotalChargesAndTaxAmount(value, currency string) {
 	c.TotalChargesAndTaxAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (c *ChargesInformation3) SetTotalChargesAndTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalChargesAndTaxAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *ChargesInformation3) SetAmount(value, currency string) {
 	c.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (c *ChargesInformation3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *ChargesInformation3) AddType() *ChargeTypeChoice {
 	c.Type = new(ChargeTypeChoice)
 	return c.Type
@@ -42,8 +58,17 @@ func (c *ChargesInformation3) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
 
-func (c *ChargesInformation3) SetBearer(value string) {
+func (c *ChargesInformation3) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
+
+func (c *ChargesInformation3) SetBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.Bearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (c *ChargesInformation3) AddParty() *BranchAndFinancialInstitutionIdentification3 {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the charges related to the payment transaction.
 type ChargesInformation5 struct {
 
@@ -10,10 +14,18 @@ type ChargesInformation5 struct {
 	Party *BranchAndFinancialInstitutionIdentification4 `xml:"Pty"`
 }
 
+func (c *ChargesInformation5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargesInformation5) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *ChargesInformation5) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *ChargesInformation5) AddParty() *BranchAndFinancialInstitutionIdentification4 {
 	c.Party = new(BranchAndFinancialInstitutionIdentification4)
 	return c.Party
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the charges related to the payment transaction.
 type ChargesInformation6 struct {
 
@@ -29,16 +33,32 @@ type ChargesInformation6 struct {
 	Tax *TaxCharges2 `xml:"Tax,omitempty"`
 }
 
+func (c *ChargesInformation6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargesInformation6) SetTotalChargesAndTaxAmount(value, currency string) {
 	c.TotalChargesAndTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *ChargesInformation6) SetTotalChargesAndTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalChargesAndTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *ChargesInformation6) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (c *ChargesInformation6) SetCreditDebitIndicator(value string) {
+func (c *ChargesInformation6) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *ChargesInformation6) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *ChargesInformation6) AddType() *ChargeType2Choice {
@@ -50,8 +70,17 @@ func (c *ChargesInformation6) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
 
-func (c *ChargesInformation6) SetBearer(value string) {
+func (c *ChargesInformation6) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
+
+func (c *ChargesInformation6) SetBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.Bearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (c *ChargesInformation6) AddParty() *BranchAndFinancialInstitutionIdentification4 {
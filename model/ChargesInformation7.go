@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the charges related to the payment transaction.
 type ChargesInformation7 struct {
 
@@ -10,10 +14,18 @@ type ChargesInformation7 struct {
 	Party *BranchAndFinancialInstitutionIdentification5 `xml:"Pty"`
 }
 
+func (c *ChargesInformation7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargesInformation7) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *ChargesInformation7) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *ChargesInformation7) AddParty() *BranchAndFinancialInstitutionIdentification5 {
 	c.Party = new(BranchAndFinancialInstitutionIdentification5)
 	return c.Party
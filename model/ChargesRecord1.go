@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further individual record details on the charges related to the payment transaction.
 type ChargesRecord1 struct {
 
@@ -26,12 +30,24 @@ type ChargesRecord1 struct {
 	Tax *TaxCharges2 `xml:"Tax,omitempty"`
 }
 
+func (c *ChargesRecord1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargesRecord1) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (c *ChargesRecord1) SetCreditDebitIndicator(value string) {
+func (c *ChargesRecord1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *ChargesRecord1) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *ChargesRecord1) AddType() *ChargeType3Choice {
@@ -43,8 +59,17 @@ func (c *ChargesRecord1) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
 
-func (c *ChargesRecord1) SetBearer(value string) {
+func (c *ChargesRecord1) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
+
+func (c *ChargesRecord1) SetBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.Bearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (c *ChargesRecord1) AddAgent() *BranchAndFinancialInstitutionIdentification5 {
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides further individual record details on the charges related to the payment transaction.
 type ChargesRecord2 struct {
 
@@ -29,18 +34,35 @@ type ChargesRecord2 struct {
 	Tax *TaxCharges2 `xml:"Tax,omitempty"`
 }
 
+func (c *ChargesRecord2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargesRecord2) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (c *ChargesRecord2) SetCreditDebitIndicator(value string) {
+func (c *ChargesRecord2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *ChargesRecord2) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *ChargesRecord2) SetChargeIncludedIndicator(value string) {
 	c.ChargeIncludedIndicator = (*ChargeIncludedIndicator)(&value)
 }
 
+func (c *ChargesRecord2) SetChargeIncludedIndicatorFromBool(value bool) {
+	c.ChargeIncludedIndicator = new(ChargeIncludedIndicator)
+	c.ChargeIncludedIndicator.FromBool(value)
+}
+
 func (c *ChargesRecord2) AddType() *ChargeType3Choice {
 	c.Type = new(ChargeType3Choice)
 	return c.Type
@@ -50,8 +72,17 @@ func (c *ChargesRecord2) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
 
-func (c *ChargesRecord2) SetBearer(value string) {
+func (c *ChargesRecord2) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
+
+func (c *ChargesRecord2) SetBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.Bearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (c *ChargesRecord2) AddAgent() *BranchAndFinancialInstitutionIdentification5 {
@@ -63,3 +94,98 @@ func (c *ChargesRecord2) AddTax() *TaxCharges2 {
 	c.Tax = new(TaxCharges2)
 	return c.Tax
 }
+
+func (c *ChargesRecord2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Amount != nil {
+		c.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if c.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*c.CreditDebitIndicator))
+	}
+	if c.ChargeIncludedIndicator != nil {
+		enc.Element("ChrgInclInd", string(*c.ChargeIncludedIndicator))
+	}
+	if c.Type != nil {
+		c.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if c.Rate != nil {
+		enc.Element("Rate", string(*c.Rate))
+	}
+	if c.Bearer != nil {
+		enc.Element("Br", string(*c.Bearer))
+	}
+	if c.Agent != nil {
+		c.Agent.EncodeXMLFast(enc, "Agt")
+	}
+	if c.Tax != nil {
+		c.Tax.EncodeXMLFast(enc, "Tax")
+	}
+	enc.End(tag)
+}
+
+func (c *ChargesRecord2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Amount = elem
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		case "ChrgInclInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ChargeIncludedIndicator = (*ChargeIncludedIndicator)(&value)
+		case "Tp":
+			elem := new(ChargeType3Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Type = elem
+		case "Rate":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Rate = (*PercentageRate)(&value)
+		case "Br":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Bearer = (*ChargeBearerType1Code)(&value)
+		case "Agt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Agent = elem
+		case "Tax":
+			elem := new(TaxCharges2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Tax = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
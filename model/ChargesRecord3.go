@@ -0,0 +1,191 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Provides further individual record details on the charges related to the payment transaction.
+type ChargesRecord3 struct {
+
+	// Transaction charges to be paid by the charge bearer.
+	Amount *ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+
+	// Indicates whether the charges amount is a credit or a debit amount.
+	// Usage: A zero amount is considered to be a credit.
+	CreditDebitIndicator *CreditDebitCode `xml:"CdtDbtInd,omitempty"`
+
+	// Indicates whether the charge should be included in the amount or is added as pre-advice.
+	ChargeIncludedIndicator *ChargeIncludedIndicator `xml:"ChrgInclInd,omitempty"`
+
+	// Specifies the type of charge.
+	Type *ChargeType3Choice `xml:"Tp,omitempty"`
+
+	// Rate used to calculate the amount of the charge or fee.
+	Rate *PercentageRate `xml:"Rate,omitempty"`
+
+	// Specifies which party/parties will bear the charges associated with the processing of the payment transaction.
+	Bearer *ChargeBearerType1Code `xml:"Br,omitempty"`
+
+	// Agent that takes the transaction charges or to which the transaction charges are due.
+	Agent *BranchAndFinancialInstitutionIdentification6 `xml:"Agt,omitempty"`
+
+	// Provides details on the tax applied to charges.
+	Tax *TaxCharges2 `xml:"Tax,omitempty"`
+}
+
+func (c *ChargesRecord3) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *ChargesRecord3) SetAmount(value, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (c *ChargesRecord3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *ChargesRecord3) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
+	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
+}
+
+func (c *ChargesRecord3) SetChargeIncludedIndicator(value string) {
+	c.ChargeIncludedIndicator = (*ChargeIncludedIndicator)(&value)
+}
+
+func (c *ChargesRecord3) SetChargeIncludedIndicatorFromBool(value bool) {
+	c.ChargeIncludedIndicator = new(ChargeIncludedIndicator)
+	c.ChargeIncludedIndicator.FromBool(value)
+}
+
+func (c *ChargesRecord3) AddType() *ChargeType3Choice {
+	c.Type = new(ChargeType3Choice)
+	return c.Type
+}
+
+func (c *ChargesRecord3) SetRate(value string) {
+	c.Rate = (*PercentageRate)(&value)
+}
+
+func (c *ChargesRecord3) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
+
+func (c *ChargesRecord3) SetBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
+	c.Bearer = (*ChargeBearerType1Code)(&value)
+	return nil
+}
+
+func (c *ChargesRecord3) AddAgent() *BranchAndFinancialInstitutionIdentification6 {
+	c.Agent = new(BranchAndFinancialInstitutionIdentification6)
+	return c.Agent
+}
+
+func (c *ChargesRecord3) AddTax() *TaxCharges2 {
+	c.Tax = new(TaxCharges2)
+	return c.Tax
+}
+
+func (c *ChargesRecord3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Amount != nil {
+		c.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if c.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*c.CreditDebitIndicator))
+	}
+	if c.ChargeIncludedIndicator != nil {
+		enc.Element("ChrgInclInd", string(*c.ChargeIncludedIndicator))
+	}
+	if c.Type != nil {
+		c.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if c.Rate != nil {
+		enc.Element("Rate", string(*c.Rate))
+	}
+	if c.Bearer != nil {
+		enc.Element("Br", string(*c.Bearer))
+	}
+	if c.Agent != nil {
+		c.Agent.EncodeXMLFast(enc, "Agt")
+	}
+	if c.Tax != nil {
+		c.Tax.EncodeXMLFast(enc, "Tax")
+	}
+	enc.End(tag)
+}
+
+func (c *ChargesRecord3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Amount = elem
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		case "ChrgInclInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ChargeIncludedIndicator = (*ChargeIncludedIndicator)(&value)
+		case "Tp":
+			elem := new(ChargeType3Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Type = elem
+		case "Rate":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Rate = (*PercentageRate)(&value)
+		case "Br":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Bearer = (*ChargeBearerType1Code)(&value)
+		case "Agt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Agent = elem
+		case "Tax":
+			elem := new(TaxCharges2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Tax = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
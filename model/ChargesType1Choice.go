@@ -10,6 +10,10 @@ type ChargesType1Choice struct {
 	OtherChargesType *Max35Text `xml:"OthrChrgsTp"`
 }
 
+func (c *ChargesType1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ChargesType1Choice) SetType(value string) {
 	c.Type = (*ChargeType8Code)(&value)
 }
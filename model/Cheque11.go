@@ -0,0 +1,250 @@
+package model
+
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Set of characteristics related to a cheque instruction, such as cheque type or cheque number.
+type Cheque11 struct {
+
+	// Specifies the type of cheque to be issued.
+	ChequeType *ChequeType2Code `xml:"ChqTp,omitempty"`
+
+	// Unique and unambiguous identifier for a cheque as assigned by the agent.
+	ChequeNumber *Max35Text `xml:"ChqNb,omitempty"`
+
+	// Identifies the party that ordered the issuance of the cheque.
+	ChequeFrom *NameAndAddress16 `xml:"ChqFr,omitempty"`
+
+	// Specifies the delivery method of the cheque by the debtor's agent.
+	DeliveryMethod *ChequeDeliveryMethod1Choice `xml:"DlvryMtd,omitempty"`
+
+	// Party to whom the debtor's agent needs to send the cheque.
+	DeliverTo *NameAndAddress16 `xml:"DlvrTo,omitempty"`
+
+	// Urgency or order of importance that the originator would like the recipient of the payment instruction to apply to the processing of the payment instruction.
+	InstructionPriority *Priority2Code `xml:"InstrPrty,omitempty"`
+
+	// Date when the draft becomes payable and the debtor's account is debited.
+	ChequeMaturityDate *ISODate `xml:"ChqMtrtyDt,omitempty"`
+
+	// Identifies, in a coded form, the cheque layout, company logo and digitised signature to be used to print the cheque, as agreed between the initiating party and the debtor's agent.
+	FormsCode *Max35Text `xml:"FrmsCd,omitempty"`
+
+	// Information that needs to be printed on a cheque, used by the payer to add miscellaneous information.
+	MemoField []*Max35Text `xml:"MemoFld,omitempty"`
+
+	// Regional area in which the cheque can be cleared, when a country has no nation-wide cheque clearing organisation.
+	RegionalClearingZone *Max35Text `xml:"RgnlClrZone,omitempty"`
+
+	// Specifies the print location of the cheque.
+	PrintLocation *Max35Text `xml:"PrtLctn,omitempty"`
+
+	// Signature to be used by the cheque servicer on a specific cheque to be printed.
+	Signature []*Max70Text `xml:"Sgntr,omitempty"`
+}
+
+func (c *Cheque11) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *Cheque11) SetChequeType(value string) error {
+	if err := ChequeType2Code(value).Validate(); err != nil {
+		return err
+	}
+	c.ChequeType = (*ChequeType2Code)(&value)
+	return nil
+}
+
+func (c *Cheque11) SetChequeNumber(value string) {
+	c.ChequeNumber = (*Max35Text)(&value)
+}
+
+func (c *Cheque11) AddChequeFrom() *NameAndAddress16 {
+	c.ChequeFrom = new(NameAndAddress16)
+	return c.ChequeFrom
+}
+
+func (c *Cheque11) AddDeliveryMethod() *ChequeDeliveryMethod1Choice {
+	c.DeliveryMethod = new(ChequeDeliveryMethod1Choice)
+	return c.DeliveryMethod
+}
+
+func (c *Cheque11) AddDeliverTo() *NameAndAddress16 {
+	c.DeliverTo = new(NameAndAddress16)
+	return c.DeliverTo
+}
+
+func (c *Cheque11) SetInstructionPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
+	c.InstructionPriority = (*Priority2Code)(&value)
+	return nil
+}
+
+func (c *Cheque11) SetChequeMaturityDate(value string) {
+	c.ChequeMaturityDate = (*ISODate)(&value)
+}
+
+func (c *Cheque11) SetChequeMaturityDateFromTime(value time.Time) {
+	c.ChequeMaturityDate = new(ISODate)
+	c.ChequeMaturityDate.FromTime(value)
+}
+
+func (c *Cheque11) SetFormsCode(value string) {
+	c.FormsCode = (*Max35Text)(&value)
+}
+
+func (c *Cheque11) AddMemoField(value string) {
+	c.MemoField = append(c.MemoField, (*Max35Text)(&value))
+}
+
+func (c *Cheque11) SetRegionalClearingZone(value string) {
+	c.RegionalClearingZone = (*Max35Text)(&value)
+}
+
+func (c *Cheque11) SetPrintLocation(value string) {
+	c.PrintLocation = (*Max35Text)(&value)
+}
+
+func (c *Cheque11) AddSignature(value string) {
+	c.Signature = append(c.Signature, (*Max70Text)(&value))
+}
+
+func (c *Cheque11) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.ChequeType != nil {
+		enc.Element("ChqTp", string(*c.ChequeType))
+	}
+	if c.ChequeNumber != nil {
+		enc.Element("ChqNb", string(*c.ChequeNumber))
+	}
+	if c.ChequeFrom != nil {
+		c.ChequeFrom.EncodeXMLFast(enc, "ChqFr")
+	}
+	if c.DeliveryMethod != nil {
+		c.DeliveryMethod.EncodeXMLFast(enc, "DlvryMtd")
+	}
+	if c.DeliverTo != nil {
+		c.DeliverTo.EncodeXMLFast(enc, "DlvrTo")
+	}
+	if c.InstructionPriority != nil {
+		enc.Element("InstrPrty", string(*c.InstructionPriority))
+	}
+	if c.ChequeMaturityDate != nil {
+		enc.Element("ChqMtrtyDt", string(*c.ChequeMaturityDate))
+	}
+	if c.FormsCode != nil {
+		enc.Element("FrmsCd", string(*c.FormsCode))
+	}
+	for _, elem := range c.MemoField {
+		if elem != nil {
+			enc.Element("MemoFld", string(*elem))
+		}
+	}
+	if c.RegionalClearingZone != nil {
+		enc.Element("RgnlClrZone", string(*c.RegionalClearingZone))
+	}
+	if c.PrintLocation != nil {
+		enc.Element("PrtLctn", string(*c.PrintLocation))
+	}
+	for _, elem := range c.Signature {
+		if elem != nil {
+			enc.Element("Sgntr", string(*elem))
+		}
+	}
+	enc.End(tag)
+}
+
+func (c *Cheque11) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "ChqTp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ChequeType = (*ChequeType2Code)(&value)
+		case "ChqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ChequeNumber = (*Max35Text)(&value)
+		case "ChqFr":
+			elem := new(NameAndAddress16)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.ChequeFrom = elem
+		case "DlvryMtd":
+			elem := new(ChequeDeliveryMethod1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.DeliveryMethod = elem
+		case "DlvrTo":
+			elem := new(NameAndAddress16)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.DeliverTo = elem
+		case "InstrPrty":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.InstructionPriority = (*Priority2Code)(&value)
+		case "ChqMtrtyDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ChequeMaturityDate = (*ISODate)(&value)
+		case "FrmsCd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.FormsCode = (*Max35Text)(&value)
+		case "MemoFld":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.MemoField = append(c.MemoField, (*Max35Text)(&value))
+		case "RgnlClrZone":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.RegionalClearingZone = (*Max35Text)(&value)
+		case "PrtLctn":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.PrintLocation = (*Max35Text)(&value)
+		case "Sgntr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Signature = append(c.Signature, (*Max70Text)(&value))
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -16,6 +16,10 @@ type Cheque3 struct {
 	DrawerIdentification *PartyIdentification2Choice `xml:"DrwrId,omitempty"`
 }
 
+func (c *Cheque3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cheque3) SetNumber(value string) {
 	c.Number = (*Max35Text)(&value)
 }
@@ -7,6 +7,10 @@ type Cheque4 struct {
 	PayeeIdentification *NameAndAddress5 `xml:"PyeeId"`
 }
 
+func (c *Cheque4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cheque4) AddPayeeIdentification() *NameAndAddress5 {
 	c.PayeeIdentification = new(NameAndAddress5)
 	return c.PayeeIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics related to a cheque instruction, such as cheque type or cheque number.
 type Cheque5 struct {
 
@@ -37,8 +41,16 @@ type Cheque5 struct {
 	PrintLocation *Max35Text `xml:"PrtLctn,omitempty"`
 }
 
-func (c *Cheque5) SetChequeType(value string) {
+func (c *Cheque5) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *Cheque5) SetChequeType(value string) error {
+	if err := ChequeType2Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChequeType = (*ChequeType2Code)(&value)
+	return nil
 }
 
 func (c *Cheque5) SetChequeNumber(value string) {
@@ -60,14 +72,23 @@ func (c *Cheque5) AddDeliverTo() *NameAndAddress3 {
 	return c.DeliverTo
 }
 
-func (c *Cheque5) SetInstructionPriority(value string) {
+func (c *Cheque5) SetInstructionPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	c.InstructionPriority = (*Priority2Code)(&value)
+	return nil
 }
 
 func (c *Cheque5) SetChequeMaturityDate(value string) {
 	c.ChequeMaturityDate = (*ISODate)(&value)
 }
 
+func (c *Cheque5) SetChequeMaturityDateFromTime(value time.Time) {
+	c.ChequeMaturityDate = new(ISODate)
+	c.ChequeMaturityDate.FromTime(value)
+}
+
 func (c *Cheque5) SetFormsCode(value string) {
 	c.FormsCode = (*Max35Text)(&value)
 }
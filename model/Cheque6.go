@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics related to a cheque instruction, such as cheque type or cheque number.
 type Cheque6 struct {
 
@@ -37,8 +41,16 @@ type Cheque6 struct {
 	PrintLocation *Max35Text `xml:"PrtLctn,omitempty"`
 }
 
-func (c *Cheque6) SetChequeType(value string) {
+func (c *Cheque6) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *Cheque6) SetChequeType(value string) error {
+	if err := ChequeType2Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChequeType = (*ChequeType2Code)(&value)
+	return nil
 }
 
 func (c *Cheque6) SetChequeNumber(value string) {
@@ -60,14 +72,23 @@ func (c *Cheque6) AddDeliverTo() *NameAndAddress10 {
 	return c.DeliverTo
 }
 
-func (c *Cheque6) SetInstructionPriority(value string) {
+func (c *Cheque6) SetInstructionPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	c.InstructionPriority = (*Priority2Code)(&value)
+	return nil
 }
 
 func (c *Cheque6) SetChequeMaturityDate(value string) {
 	c.ChequeMaturityDate = (*ISODate)(&value)
 }
 
+func (c *Cheque6) SetChequeMaturityDateFromTime(value time.Time) {
+	c.ChequeMaturityDate = new(ISODate)
+	c.ChequeMaturityDate.FromTime(value)
+}
+
 func (c *Cheque6) SetFormsCode(value string) {
 	c.FormsCode = (*Max35Text)(&value)
 }
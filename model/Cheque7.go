@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of characteristics related to a cheque instruction, such as cheque type or cheque number.
 type Cheque7 struct {
 
@@ -40,8 +46,16 @@ type Cheque7 struct {
 	Signature []*Max70Text `xml:"Sgntr,omitempty"`
 }
 
-func (c *Cheque7) SetChequeType(value string) {
+func (c *Cheque7) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *Cheque7) SetChequeType(value string) error {
+	if err := ChequeType2Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChequeType = (*ChequeType2Code)(&value)
+	return nil
 }
 
 func (c *Cheque7) SetChequeNumber(value string) {
@@ -63,14 +77,23 @@ func (c *Cheque7) AddDeliverTo() *NameAndAddress10 {
 	return c.DeliverTo
 }
 
-func (c *Cheque7) SetInstructionPriority(value string) {
+func (c *Cheque7) SetInstructionPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	c.InstructionPriority = (*Priority2Code)(&value)
+	return nil
 }
 
 func (c *Cheque7) SetChequeMaturityDate(value string) {
 	c.ChequeMaturityDate = (*ISODate)(&value)
 }
 
+func (c *Cheque7) SetChequeMaturityDateFromTime(value time.Time) {
+	c.ChequeMaturityDate = new(ISODate)
+	c.ChequeMaturityDate.FromTime(value)
+}
+
 func (c *Cheque7) SetFormsCode(value string) {
 	c.FormsCode = (*Max35Text)(&value)
 }
@@ -90,3 +113,138 @@ func (c *Cheque7) SetPrintLocation(value string) {
 func (c *Cheque7) AddSignature(value string) {
 	c.Signature = append(c.Signature, (*Max70Text)(&value))
 }
+
+func (c *Cheque7) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.ChequeType != nil {
+		enc.Element("ChqTp", string(*c.ChequeType))
+	}
+	if c.ChequeNumber != nil {
+		enc.Element("ChqNb", string(*c.ChequeNumber))
+	}
+	if c.ChequeFrom != nil {
+		c.ChequeFrom.EncodeXMLFast(enc, "ChqFr")
+	}
+	if c.DeliveryMethod != nil {
+		c.DeliveryMethod.EncodeXMLFast(enc, "DlvryMtd")
+	}
+	if c.DeliverTo != nil {
+		c.DeliverTo.EncodeXMLFast(enc, "DlvrTo")
+	}
+	if c.InstructionPriority != nil {
+		enc.Element("InstrPrty", string(*c.InstructionPriority))
+	}
+	if c.ChequeMaturityDate != nil {
+		enc.Element("ChqMtrtyDt", string(*c.ChequeMaturityDate))
+	}
+	if c.FormsCode != nil {
+		enc.Element("FrmsCd", string(*c.FormsCode))
+	}
+	for _, elem := range c.MemoField {
+		if elem != nil {
+			enc.Element("MemoFld", string(*elem))
+		}
+	}
+	if c.RegionalClearingZone != nil {
+		enc.Element("RgnlClrZone", string(*c.RegionalClearingZone))
+	}
+	if c.PrintLocation != nil {
+		enc.Element("PrtLctn", string(*c.PrintLocation))
+	}
+	for _, elem := range c.Signature {
+		if elem != nil {
+			enc.Element("Sgntr", string(*elem))
+		}
+	}
+	enc.End(tag)
+}
+
+func (c *Cheque7) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "ChqTp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ChequeType = (*ChequeType2Code)(&value)
+		case "ChqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ChequeNumber = (*Max35Text)(&value)
+		case "ChqFr":
+			elem := new(NameAndAddress10)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.ChequeFrom = elem
+		case "DlvryMtd":
+			elem := new(ChequeDeliveryMethod1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.DeliveryMethod = elem
+		case "DlvrTo":
+			elem := new(NameAndAddress10)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.DeliverTo = elem
+		case "InstrPrty":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.InstructionPriority = (*Priority2Code)(&value)
+		case "ChqMtrtyDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ChequeMaturityDate = (*ISODate)(&value)
+		case "FrmsCd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.FormsCode = (*Max35Text)(&value)
+		case "MemoFld":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.MemoField = append(c.MemoField, (*Max35Text)(&value))
+		case "RgnlClrZone":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.RegionalClearingZone = (*Max35Text)(&value)
+		case "PrtLctn":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.PrintLocation = (*Max35Text)(&value)
+		case "Sgntr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Signature = append(c.Signature, (*Max70Text)(&value))
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -16,6 +16,10 @@ type Cheque9 struct {
 	DrawerIdentification *PartyIdentification113 `xml:"DrwrId,omitempty"`
 }
 
+func (c *Cheque9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Cheque9) SetNumber(value string) {
 	c.Number = (*Max35Text)(&value)
 }
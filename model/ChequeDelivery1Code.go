@@ -1,3 +1,46 @@
 package model
 
 type ChequeDelivery1Code string
+
+const (
+	ChequeDelivery1CodeMLDB ChequeDelivery1Code = "MLDB"
+	ChequeDelivery1CodeMLCD ChequeDelivery1Code = "MLCD"
+	ChequeDelivery1CodeMLFA ChequeDelivery1Code = "MLFA"
+	ChequeDelivery1CodeCRDB ChequeDelivery1Code = "CRDB"
+	ChequeDelivery1CodeCRCD ChequeDelivery1Code = "CRCD"
+	ChequeDelivery1CodeCRFA ChequeDelivery1Code = "CRFA"
+	ChequeDelivery1CodePUDB ChequeDelivery1Code = "PUDB"
+	ChequeDelivery1CodePUCD ChequeDelivery1Code = "PUCD"
+	ChequeDelivery1CodePUFA ChequeDelivery1Code = "PUFA"
+	ChequeDelivery1CodeRGDB ChequeDelivery1Code = "RGDB"
+	ChequeDelivery1CodeRGCD ChequeDelivery1Code = "RGCD"
+	ChequeDelivery1CodeRGFA ChequeDelivery1Code = "RGFA"
+)
+
+var chequeDelivery1CodeDescriptions = map[ChequeDelivery1Code]string{
+	ChequeDelivery1CodeMLDB: "Mail to debtor: cheque is to be sent through mail services to debtor.",
+	ChequeDelivery1CodeMLCD: "Mail to creditor: cheque is to be sent through mail services to creditor.",
+	ChequeDelivery1CodeMLFA: "Mail to final agent: cheque is to be sent through mail services to creditor agent.",
+	ChequeDelivery1CodeCRDB: "Courier to debtor: cheque is to be sent through courier services to debtor.",
+	ChequeDelivery1CodeCRCD: "Courier to creditor: cheque is to be sent through courier services to creditor.",
+	ChequeDelivery1CodeCRFA: "Courier to final agent: cheque is to be sent through courier services to creditor agent.",
+	ChequeDelivery1CodePUDB: "Pick up by debtor: cheque will be picked up by the debtor.",
+	ChequeDelivery1CodePUCD: "Pick up by creditor: cheque will be picked up by the creditor.",
+	ChequeDelivery1CodePUFA: "Pick up by final agent: cheque will be picked up by the creditor agent.",
+	ChequeDelivery1CodeRGDB: "Registered mail to debtor: cheque is to be sent through registered mail services to debtor.",
+	ChequeDelivery1CodeRGCD: "Registered mail to creditor: cheque is to be sent through registered mail services to creditor.",
+	ChequeDelivery1CodeRGFA: "Registered mail to final agent: cheque is to be sent through registered mail services to creditor agent.",
+}
+
+func (c ChequeDelivery1Code) IsValid() bool {
+	_, ok := chequeDelivery1CodeDescriptions[c]
+	return ok
+}
+
+func (c ChequeDelivery1Code) Description() string {
+	return chequeDelivery1CodeDescriptions[c]
+}
+
+func (c ChequeDelivery1Code) Validate() error {
+	return validateEnumeration("ChequeDelivery1Code", string(c), c.IsValid(), "MLDB, MLCD, MLFA, CRDB, CRCD, CRFA, PUDB, PUCD, PUFA, RGDB, RGCD, RGFA")
+}
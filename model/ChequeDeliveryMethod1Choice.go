@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of characteristics related to a cheque instruction, such as cheque type or cheque number.
 type ChequeDeliveryMethod1Choice struct {
 
@@ -10,10 +12,59 @@ type ChequeDeliveryMethod1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
-func (c *ChequeDeliveryMethod1Choice) SetCode(value string) {
+func (c *ChequeDeliveryMethod1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *ChequeDeliveryMethod1Choice) SetCode(value string) error {
+	if err := ChequeDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.Code = (*ChequeDelivery1Code)(&value)
+	return nil
 }
 
 func (c *ChequeDeliveryMethod1Choice) SetProprietary(value string) {
 	c.Proprietary = (*Max35Text)(&value)
 }
+
+func (c *ChequeDeliveryMethod1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Code != nil {
+		enc.Element("Cd", string(*c.Code))
+	}
+	if c.Proprietary != nil {
+		enc.Element("Prtry", string(*c.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (c *ChequeDeliveryMethod1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Code = (*ChequeDelivery1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,3 +1,32 @@
 package model
 
 type ChequeType2Code string
+
+const (
+	ChequeType2CodeCCHQ ChequeType2Code = "CCHQ"
+	ChequeType2CodeCCCH ChequeType2Code = "CCCH"
+	ChequeType2CodeBCHQ ChequeType2Code = "BCHQ"
+	ChequeType2CodeDRFT ChequeType2Code = "DRFT"
+	ChequeType2CodeELDR ChequeType2Code = "ELDR"
+)
+
+var chequeType2CodeDescriptions = map[ChequeType2Code]string{
+	ChequeType2CodeCCHQ: "Customer cheque: written order to a bank to pay a certain amount of money from one person to another person.",
+	ChequeType2CodeCCCH: "Certified customer cheque: cheque drawn on the account of the debtor, and debited on the debtor's account when the cheque is cashed.",
+	ChequeType2CodeBCHQ: "Bank cheque: cheque drawn on the account of the debtor's financial institution, which is debited on the debtor's account when the cheque is issued.",
+	ChequeType2CodeDRFT: "Draft: a guaranteed bank cheque with a future value date.",
+	ChequeType2CodeELDR: "Electronic draft: an instrument with a future value date.",
+}
+
+func (c ChequeType2Code) IsValid() bool {
+	_, ok := chequeType2CodeDescriptions[c]
+	return ok
+}
+
+func (c ChequeType2Code) Description() string {
+	return chequeType2CodeDescriptions[c]
+}
+
+func (c ChequeType2Code) Validate() error {
+	return validateEnumeration("ChequeType2Code", string(c), c.IsValid(), "CCHQ, CCCH, BCHQ, DRFT, ELDR")
+}
@@ -10,6 +10,10 @@ type CitizenshipInformation struct {
 	MinorIndicator *YesNoIndicator `xml:"MnrInd"`
 }
 
+func (c *CitizenshipInformation) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CitizenshipInformation) SetNationality(value string) {
 	c.Nationality = (*NationalityCode)(&value)
 }
@@ -17,3 +21,8 @@ func (c *CitizenshipInformation) SetNationality(value string) {
 func (c *CitizenshipInformation) SetMinorIndicator(value string) {
 	c.MinorIndicator = (*YesNoIndicator)(&value)
 }
+
+func (c *CitizenshipInformation) SetMinorIndicatorFromBool(value bool) {
+	c.MinorIndicator = new(YesNoIndicator)
+	c.MinorIndicator.FromBool(value)
+}
@@ -10,6 +10,10 @@ type CitizenshipInformation2 struct {
 	MinorIndicator *YesNoIndicator `xml:"MnrInd"`
 }
 
+func (c *CitizenshipInformation2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CitizenshipInformation2) SetNationality(value string) {
 	c.Nationality = (*NationalityCode)(&value)
 }
@@ -17,3 +21,8 @@ func (c *CitizenshipInformation2) SetNationality(value string) {
 func (c *CitizenshipInformation2) SetMinorIndicator(value string) {
 	c.MinorIndicator = (*YesNoIndicator)(&value)
 }
+
+func (c *CitizenshipInformation2) SetMinorIndicatorFromBool(value bool) {
+	c.MinorIndicator = new(YesNoIndicator)
+	c.MinorIndicator.FromBool(value)
+}
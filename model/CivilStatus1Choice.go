@@ -10,6 +10,10 @@ type CivilStatus1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CivilStatus1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CivilStatus1Choice) SetCode(value string) {
 	c.Code = (*CivilStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ClassificationType1Choice struct {
 	AlternateClassification *GenericIdentification1 `xml:"AltrnClssfctn"`
 }
 
+func (c *ClassificationType1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClassificationType1Choice) SetClassificationFinancialInstrument(value string) {
 	c.ClassificationFinancialInstrument = (*CFIOct2015Identifier)(&value)
 }
@@ -10,6 +10,10 @@ type ClassificationType2Choice struct {
 	AlternateClassification *GenericIdentification19 `xml:"AltrnClssfctn"`
 }
 
+func (c *ClassificationType2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClassificationType2Choice) SetClassificationFinancialInstrument(value string) {
 	c.ClassificationFinancialInstrument = (*CFIIdentifier)(&value)
 }
@@ -10,6 +10,10 @@ type ClassificationType30Choice struct {
 	AlternateClassification *GenericIdentification36 `xml:"AltrnClssfctn"`
 }
 
+func (c *ClassificationType30Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClassificationType30Choice) SetClassificationFinancialInstrument(value string) {
 	c.ClassificationFinancialInstrument = (*CFIIdentifier)(&value)
 }
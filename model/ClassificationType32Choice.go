@@ -10,6 +10,10 @@ type ClassificationType32Choice struct {
 	AlternateClassification *GenericIdentification36 `xml:"AltrnClssfctn"`
 }
 
+func (c *ClassificationType32Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClassificationType32Choice) SetClassificationFinancialInstrument(value string) {
 	c.ClassificationFinancialInstrument = (*CFIOct2015Identifier)(&value)
 }
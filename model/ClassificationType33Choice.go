@@ -10,6 +10,10 @@ type ClassificationType33Choice struct {
 	AlternateClassification *GenericIdentification86 `xml:"AltrnClssfctn"`
 }
 
+func (c *ClassificationType33Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClassificationType33Choice) SetClassificationFinancialInstrument(value string) {
 	c.ClassificationFinancialInstrument = (*CFIOct2015Identifier)(&value)
 }
@@ -11,6 +11,10 @@ type Clearing3 struct {
 	ClearingSegment *PartyIdentification35Choice `xml:"ClrSgmt,omitempty"`
 }
 
+func (c *Clearing3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Clearing3) AddClearingMember() *PartyIdentificationAndAccount78 {
 	newValue := new(PartyIdentificationAndAccount78)
 	c.ClearingMember = append(c.ClearingMember, newValue)
@@ -17,6 +17,10 @@ type Clearing4 struct {
 	NonGuaranteedTrade *NonGuaranteedTrade3 `xml:"NonGrntedTrad,omitempty"`
 }
 
+func (c *Clearing4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Clearing4) SetSettlementNettingEligibleCode(value string) {
 	c.SettlementNettingEligibleCode = (*NettingEligible1Code)(&value)
 }
@@ -30,6 +34,11 @@ func (c *Clearing4) SetGuaranteedTrade(value string) {
 	c.GuaranteedTrade = (*YesNoIndicator)(&value)
 }
 
+func (c *Clearing4) SetGuaranteedTradeFromBool(value bool) {
+	c.GuaranteedTrade = new(YesNoIndicator)
+	c.GuaranteedTrade.FromBool(value)
+}
+
 func (c *Clearing4) AddNonGuaranteedTrade() *NonGuaranteedTrade3 {
 	c.NonGuaranteedTrade = new(NonGuaranteedTrade3)
 	return c.NonGuaranteedTrade
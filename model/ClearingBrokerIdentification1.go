@@ -10,6 +10,10 @@ type ClearingBrokerIdentification1 struct {
 	ClearingBrokerIdentification *Max35Text `xml:"ClrBrkrId"`
 }
 
+func (c *ClearingBrokerIdentification1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClearingBrokerIdentification1) SetSideIndicator(value string) {
 	c.SideIndicator = (*SideIndicator1Code)(&value)
 }
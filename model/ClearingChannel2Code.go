@@ -1,3 +1,30 @@
 package model
 
 type ClearingChannel2Code string
+
+const (
+	ClearingChannel2CodeRTGS ClearingChannel2Code = "RTGS"
+	ClearingChannel2CodeRTNS ClearingChannel2Code = "RTNS"
+	ClearingChannel2CodeMPNS ClearingChannel2Code = "MPNS"
+	ClearingChannel2CodeBOOK ClearingChannel2Code = "BOOK"
+)
+
+var clearingChannel2CodeDescriptions = map[ClearingChannel2Code]string{
+	ClearingChannel2CodeRTGS: "Real time gross settlement system: clearing channel is a real-time gross settlement system.",
+	ClearingChannel2CodeRTNS: "Real time net settlement system: clearing channel is a real-time net settlement system.",
+	ClearingChannel2CodeMPNS: "Mass payment net system: clearing channel is a mass payment net settlement system.",
+	ClearingChannel2CodeBOOK: "Book transfer: payment through internal book transfer.",
+}
+
+func (c ClearingChannel2Code) IsValid() bool {
+	_, ok := clearingChannel2CodeDescriptions[c]
+	return ok
+}
+
+func (c ClearingChannel2Code) Description() string {
+	return clearingChannel2CodeDescriptions[c]
+}
+
+func (c ClearingChannel2Code) Validate() error {
+	return validateEnumeration("ClearingChannel2Code", string(c), c.IsValid(), "RTGS, RTNS, MPNS, BOOK")
+}
@@ -10,6 +10,10 @@ type ClearingSystemIdentification1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *ClearingSystemIdentification1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClearingSystemIdentification1Choice) SetClearingSystemIdentification(value string) {
 	c.ClearingSystemIdentification = (*CashClearingSystem3Code)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Choice of a clearing system identifier.
 type ClearingSystemIdentification2Choice struct {
 
@@ -10,6 +12,10 @@ type ClearingSystemIdentification2Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *ClearingSystemIdentification2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClearingSystemIdentification2Choice) SetCode(value string) {
 	c.Code = (*ExternalClearingSystemIdentification1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (c *ClearingSystemIdentification2Choice) SetCode(value string) {
 func (c *ClearingSystemIdentification2Choice) SetProprietary(value string) {
 	c.Proprietary = (*Max35Text)(&value)
 }
+
+func (c *ClearingSystemIdentification2Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Code != nil {
+		enc.Element("Cd", string(*c.Code))
+	}
+	if c.Proprietary != nil {
+		enc.Element("Prtry", string(*c.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (c *ClearingSystemIdentification2Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Code = (*ExternalClearingSystemIdentification1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the clearing system identification.
 type ClearingSystemIdentification3Choice struct {
 
@@ -10,6 +12,10 @@ type ClearingSystemIdentification3Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *ClearingSystemIdentification3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClearingSystemIdentification3Choice) SetCode(value string) {
 	c.Code = (*ExternalCashClearingSystem1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (c *ClearingSystemIdentification3Choice) SetCode(value string) {
 func (c *ClearingSystemIdentification3Choice) SetProprietary(value string) {
 	c.Proprietary = (*Max35Text)(&value)
 }
+
+func (c *ClearingSystemIdentification3Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Code != nil {
+		enc.Element("Cd", string(*c.Code))
+	}
+	if c.Proprietary != nil {
+		enc.Element("Prtry", string(*c.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (c *ClearingSystemIdentification3Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Code = (*ExternalCashClearingSystem1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
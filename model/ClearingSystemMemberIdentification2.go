@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Unique identification, as assigned by a clearing system, to unambiguously identify a member of the clearing system.
 type ClearingSystemMemberIdentification2 struct {
 
@@ -10,6 +12,10 @@ type ClearingSystemMemberIdentification2 struct {
 	MemberIdentification *Max35Text `xml:"MmbId"`
 }
 
+func (c *ClearingSystemMemberIdentification2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClearingSystemMemberIdentification2) AddClearingSystemIdentification() *ClearingSystemIdentification2Choice {
 	c.ClearingSystemIdentification = new(ClearingSystemIdentification2Choice)
 	return c.ClearingSystemIdentification
@@ -18,3 +24,44 @@ func (c *ClearingSystemMemberIdentification2) AddClearingSystemIdentification()
 func (c *ClearingSystemMemberIdentification2) SetMemberIdentification(value string) {
 	c.MemberIdentification = (*Max35Text)(&value)
 }
+
+func (c *ClearingSystemMemberIdentification2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.ClearingSystemIdentification != nil {
+		c.ClearingSystemIdentification.EncodeXMLFast(enc, "ClrSysId")
+	}
+	if c.MemberIdentification != nil {
+		enc.Element("MmbId", string(*c.MemberIdentification))
+	}
+	enc.End(tag)
+}
+
+func (c *ClearingSystemMemberIdentification2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "ClrSysId":
+			elem := new(ClearingSystemIdentification2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.ClearingSystemIdentification = elem
+		case "MmbId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.MemberIdentification = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -73,6 +73,10 @@ type ClearingSystemMemberIdentification2Choice struct {
 	OtherClearingCodeIdentification *Max35Text `xml:"OthrClrCdId"`
 }
 
+func (c *ClearingSystemMemberIdentification2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClearingSystemMemberIdentification2Choice) SetCHIPSUniversalIdentification(value string) {
 	c.CHIPSUniversalIdentification = (*CHIPSUniversalIdentifier)(&value)
 }
@@ -10,6 +10,10 @@ type ClearingSystemMemberIdentification3Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *ClearingSystemMemberIdentification3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClearingSystemMemberIdentification3Choice) SetIdentification(value string) {
 	c.Identification = (*ExternalClearingSystemMemberCode)(&value)
 }
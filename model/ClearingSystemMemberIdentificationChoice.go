@@ -61,6 +61,10 @@ type ClearingSystemMemberIdentificationChoice struct {
 	AustralianSmallNetworkIdentification *SmallNetworkIdentifier `xml:"AUBSBs"`
 }
 
+func (c *ClearingSystemMemberIdentificationChoice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClearingSystemMemberIdentificationChoice) SetCHIPSUniversalIdentification(value string) {
 	c.CHIPSUniversalIdentification = (*CHIPSUniversalIdentifier)(&value)
 }
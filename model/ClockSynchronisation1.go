@@ -10,6 +10,10 @@ type ClockSynchronisation1 struct {
 	SynchronisationServer []*NetworkParameters2 `xml:"SynctnSvr,omitempty"`
 }
 
+func (c *ClockSynchronisation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClockSynchronisation1) SetPOITimeZone(value string) {
 	c.POITimeZone = (*Max70Text)(&value)
 }
@@ -10,6 +10,10 @@ type ClosedStatusReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *ClosedStatusReason1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClosedStatusReason1) AddCode() *ClosedStatusReason2Choice {
 	c.Code = new(ClosedStatusReason2Choice)
 	return c.Code
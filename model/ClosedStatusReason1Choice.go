@@ -10,8 +10,16 @@ type ClosedStatusReason1Choice struct {
 	Reason []*ClosedStatusReason1 `xml:"Rsn"`
 }
 
-func (c *ClosedStatusReason1Choice) SetNoSpecifiedReason(value string) {
+func (c *ClosedStatusReason1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *ClosedStatusReason1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *ClosedStatusReason1Choice) AddReason() *ClosedStatusReason1 {
@@ -10,6 +10,10 @@ type ClosedStatusReason2Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (c *ClosedStatusReason2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClosedStatusReason2Choice) SetCode(value string) {
 	c.Code = (*ClosedStatusReason1Code)(&value)
 }
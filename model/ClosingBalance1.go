@@ -10,6 +10,10 @@ type ClosingBalance1 struct {
 	ClosingBalance *ClosingBalance1Choice `xml:"ClsgBal"`
 }
 
+func (c *ClosingBalance1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClosingBalance1) SetShortLongIndicator(value string) {
 	c.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ClosingBalance1Choice struct {
 	Intermediary *BalanceQuantity5Choice `xml:"Intrmy"`
 }
 
+func (c *ClosingBalance1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClosingBalance1Choice) AddFinal() *BalanceQuantity5Choice {
 	c.Final = new(BalanceQuantity5Choice)
 	return c.Final
@@ -10,6 +10,10 @@ type ClosingBalance3 struct {
 	ClosingBalance *ClosingBalance4Choice `xml:"ClsgBal"`
 }
 
+func (c *ClosingBalance3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClosingBalance3) SetShortLongIndicator(value string) {
 	c.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
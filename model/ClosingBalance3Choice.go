@@ -10,6 +10,10 @@ type ClosingBalance3Choice struct {
 	IntermediaryClosingBalance *FinancialInstrumentQuantity1 `xml:"IntrmyClsgBal,omitempty"`
 }
 
+func (c *ClosingBalance3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClosingBalance3Choice) AddFinalClosingBalance() *FinancialInstrumentQuantity1 {
 	c.FinalClosingBalance = new(FinancialInstrumentQuantity1)
 	return c.FinalClosingBalance
@@ -10,6 +10,10 @@ type ClosingBalance4 struct {
 	ClosingBalance *ClosingBalance5Choice `xml:"ClsgBal"`
 }
 
+func (c *ClosingBalance4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClosingBalance4) SetShortLongIndicator(value string) {
 	c.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
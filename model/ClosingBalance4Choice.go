@@ -10,6 +10,10 @@ type ClosingBalance4Choice struct {
 	Intermediary *BalanceQuantity8Choice `xml:"Intrmy"`
 }
 
+func (c *ClosingBalance4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClosingBalance4Choice) AddFinal() *BalanceQuantity8Choice {
 	c.Final = new(BalanceQuantity8Choice)
 	return c.Final
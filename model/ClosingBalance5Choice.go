@@ -10,6 +10,10 @@ type ClosingBalance5Choice struct {
 	Intermediary *BalanceQuantity12Choice `xml:"Intrmy"`
 }
 
+func (c *ClosingBalance5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClosingBalance5Choice) AddFinal() *BalanceQuantity12Choice {
 	c.Final = new(BalanceQuantity12Choice)
 	return c.Final
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of data specified for the fixing of a non deliverable trade.
 type ClosingData2 struct {
 
@@ -28,10 +32,19 @@ type ClosingData2 struct {
 	ValuationInformation *ValuationData2 `xml:"ValtnInf"`
 }
 
+func (c *ClosingData2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClosingData2) SetTradeDate(value string) {
 	c.TradeDate = (*ISODate)(&value)
 }
 
+func (c *ClosingData2) SetTradeDateFromTime(value time.Time) {
+	c.TradeDate = new(ISODate)
+	c.TradeDate.FromTime(value)
+}
+
 func (c *ClosingData2) SetNotificationIdentification(value string) {
 	c.NotificationIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type ClosingDate1Choice struct {
 	Code *Date2Choice `xml:"Cd"`
 }
 
+func (c *ClosingDate1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClosingDate1Choice) AddDate() *DateAndDateTimeChoice {
 	c.Date = new(DateAndDateTimeChoice)
 	return c.Date
@@ -10,6 +10,10 @@ type ClosurePendingStatusReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *ClosurePendingStatusReason1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClosurePendingStatusReason1) AddCode() *ClosurePendingStatusReason2Choice {
 	c.Code = new(ClosurePendingStatusReason2Choice)
 	return c.Code
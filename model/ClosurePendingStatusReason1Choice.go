@@ -10,8 +10,16 @@ type ClosurePendingStatusReason1Choice struct {
 	Reason []*ClosurePendingStatusReason1 `xml:"Rsn"`
 }
 
-func (c *ClosurePendingStatusReason1Choice) SetNoSpecifiedReason(value string) {
+func (c *ClosurePendingStatusReason1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *ClosurePendingStatusReason1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *ClosurePendingStatusReason1Choice) AddReason() *ClosurePendingStatusReason1 {
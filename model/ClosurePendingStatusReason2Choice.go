@@ -10,6 +10,10 @@ type ClosurePendingStatusReason2Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (c *ClosurePendingStatusReason2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ClosurePendingStatusReason2Choice) SetCode(value string) {
 	c.Code = (*ClosurePendingStatusReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CodeOrProprietary1Choice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (c *CodeOrProprietary1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CodeOrProprietary1Choice) SetCode(value string) {
 	c.Code = (*Max4Text)(&value)
 }
@@ -10,6 +10,10 @@ type Collateral1 struct {
 	SegregatedIndependentAmount *MarginCollateral1 `xml:"SgrtdIndpdntAmt,omitempty"`
 }
 
+func (c *Collateral1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Collateral1) AddVariationMargin() *MarginCollateral1 {
 	c.VariationMargin = new(MarginCollateral1)
 	return c.VariationMargin
@@ -25,6 +25,10 @@ type Collateral11 struct {
 	OtherCollateral []*OtherCollateral5 `xml:"OthrColl,omitempty"`
 }
 
+func (c *Collateral11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Collateral11) SetMarginCallRequestIdentification(value string) {
 	c.MarginCallRequestIdentification = (*Max35Text)(&value)
 }
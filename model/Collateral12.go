@@ -25,6 +25,10 @@ type Collateral12 struct {
 	OtherCollateral []*OtherCollateral5 `xml:"OthrColl,omitempty"`
 }
 
+func (c *Collateral12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Collateral12) SetMarginCallRequestIdentification(value string) {
 	c.MarginCallRequestIdentification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type Collateral13 struct {
 	CollateralValuation []*CollateralValuation5 `xml:"CollValtn,omitempty"`
 }
 
+func (c *Collateral13) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Collateral13) AddAccountIdentification() *CollateralAccount2 {
 	c.AccountIdentification = new(CollateralAccount2)
 	return c.AccountIdentification
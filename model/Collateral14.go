@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the details of the security pledge as collateral.
 type Collateral14 struct {
 
@@ -25,6 +29,10 @@ type Collateral14 struct {
 	SpecialCollateralIndicator *SpecialCollateral1Code `xml:"SpclCollInd,omitempty"`
 }
 
+func (c *Collateral14) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Collateral14) AddValuation() *SecuredCollateral2Choice {
 	c.Valuation = new(SecuredCollateral2Choice)
 	return c.Valuation
@@ -34,6 +42,11 @@ func (c *Collateral14) SetHaircut(value string) {
 	c.Haircut = (*PercentageRate)(&value)
 }
 
+func (c *Collateral14) SetHaircutFromDecimal(value decimal.Decimal) {
+	c.Haircut = new(PercentageRate)
+	c.Haircut.FromDecimal(value)
+}
+
 func (c *Collateral14) SetSpecialCollateralIndicator(value string) {
 	c.SpecialCollateralIndicator = (*SpecialCollateral1Code)(&value)
 }
@@ -25,6 +25,10 @@ type Collateral16 struct {
 	OtherCollateral []*OtherCollateral5 `xml:"OthrColl,omitempty"`
 }
 
+func (c *Collateral16) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Collateral16) SetMarginCallRequestIdentification(value string) {
 	c.MarginCallRequestIdentification = (*Max35Text)(&value)
 }
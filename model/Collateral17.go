@@ -25,6 +25,10 @@ type Collateral17 struct {
 	OtherCollateral []*OtherCollateral5 `xml:"OthrColl,omitempty"`
 }
 
+func (c *Collateral17) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Collateral17) SetMarginCallRequestIdentification(value string) {
 	c.MarginCallRequestIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the current and market value of the collateral held.
 type Collateral3 struct {
 
@@ -13,14 +17,26 @@ type Collateral3 struct {
 	CollateralType *CollateralType2Code `xml:"CollTp"`
 }
 
+func (c *Collateral3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Collateral3) SetPostHaircutValue(value, currency string) {
 	c.PostHaircutValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *Collateral3) SetPostHaircutValueFromDecimal(value decimal.Decimal, currency string) {
+	c.PostHaircutValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Collateral3) SetMarketValue(value, currency string) {
 	c.MarketValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *Collateral3) SetMarketValueFromDecimal(value decimal.Decimal, currency string) {
+	c.MarketValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Collateral3) SetCollateralType(value string) {
 	c.CollateralType = (*CollateralType2Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the current and market value of the collateral held.
 type Collateral6 struct {
 
@@ -13,14 +17,26 @@ type Collateral6 struct {
 	CollateralType *CollateralType1Code `xml:"CollTp"`
 }
 
+func (c *Collateral6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Collateral6) SetPostHaircutValue(value, currency string) {
 	c.PostHaircutValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *Collateral6) SetPostHaircutValueFromDecimal(value decimal.Decimal, currency string) {
+	c.PostHaircutValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Collateral6) SetMarketValue(value, currency string) {
 	c.MarketValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *Collateral6) SetMarketValueFromDecimal(value decimal.Decimal, currency string) {
+	c.MarketValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Collateral6) SetCollateralType(value string) {
 	c.CollateralType = (*CollateralType1Code)(&value)
 }
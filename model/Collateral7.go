@@ -25,6 +25,10 @@ type Collateral7 struct {
 	OtherCollateral []*OtherCollateral2 `xml:"OthrColl,omitempty"`
 }
 
+func (c *Collateral7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Collateral7) SetMarginCallRequestIdentification(value string) {
 	c.MarginCallRequestIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type Collateral8 struct {
 	OtherCollateral []*OtherCollateral2 `xml:"OthrColl,omitempty"`
 }
 
+func (c *Collateral8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Collateral8) SetMarginCallRequestIdentification(value string) {
 	c.MarginCallRequestIdentification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type Collateral9 struct {
 	CollateralValuation []*CollateralValuation2 `xml:"CollValtn,omitempty"`
 }
 
+func (c *Collateral9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Collateral9) AddAccountIdentification() *CollateralAccount1 {
 	c.AccountIdentification = new(CollateralAccount1)
 	return c.AccountIdentification
@@ -13,6 +13,10 @@ type CollateralAccount1 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (c *CollateralAccount1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralAccount1) SetIdentification(value string) {
 	c.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type CollateralAccount2 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (c *CollateralAccount2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralAccount2) SetIdentification(value string) {
 	c.Identification = (*Max35Text)(&value)
 }
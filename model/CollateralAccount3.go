@@ -13,6 +13,10 @@ type CollateralAccount3 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (c *CollateralAccount3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralAccount3) SetIdentification(value string) {
 	c.Identification = (*Max35Text)(&value)
 }
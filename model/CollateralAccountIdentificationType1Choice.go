@@ -10,6 +10,10 @@ type CollateralAccountIdentificationType1Choice struct {
 	Proprietary *GenericIdentification29 `xml:"Prtry"`
 }
 
+func (c *CollateralAccountIdentificationType1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralAccountIdentificationType1Choice) SetType(value string) {
 	c.Type = (*CollateralAccountType1Code)(&value)
 }
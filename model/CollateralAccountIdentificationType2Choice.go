@@ -10,6 +10,10 @@ type CollateralAccountIdentificationType2Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (c *CollateralAccountIdentificationType2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralAccountIdentificationType2Choice) SetType(value string) {
 	c.Type = (*CollateralAccountType1Code)(&value)
 }
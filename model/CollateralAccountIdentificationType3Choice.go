@@ -10,6 +10,10 @@ type CollateralAccountIdentificationType3Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (c *CollateralAccountIdentificationType3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralAccountIdentificationType3Choice) SetType(value string) {
 	c.Type = (*CollateralAccountType1Code)(&value)
 }
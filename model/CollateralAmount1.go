@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the collateral valuation such as the collateral amount, the market value.
 type CollateralAmount1 struct {
 
@@ -19,22 +23,46 @@ type CollateralAmount1 struct {
 	FeesAndCommissions *ActiveCurrencyAndAmount `xml:"FeesAndComssns,omitempty"`
 }
 
+func (c *CollateralAmount1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralAmount1) SetCollateralAmount(value, currency string) {
 	c.CollateralAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CollateralAmount1) SetCollateralAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.CollateralAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CollateralAmount1) SetReportedCurrencyAndAmount(value, currency string) {
 	c.ReportedCurrencyAndAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CollateralAmount1) SetReportedCurrencyAndAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ReportedCurrencyAndAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CollateralAmount1) SetMarketValueAmount(value, currency string) {
 	c.MarketValueAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CollateralAmount1) SetMarketValueAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.MarketValueAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CollateralAmount1) SetAccruedInterestAmount(value, currency string) {
 	c.AccruedInterestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CollateralAmount1) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AccruedInterestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CollateralAmount1) SetFeesAndCommissions(value, currency string) {
 	c.FeesAndCommissions = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CollateralAmount1) SetFeesAndCommissionsFromDecimal(value decimal.Decimal, currency string) {
+	c.FeesAndCommissions = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the collateral held by party A and/or B.
 type CollateralBalance1 struct {
 
@@ -10,10 +14,22 @@ type CollateralBalance1 struct {
 	HeldByPartyB *ActiveCurrencyAndAmount `xml:"HeldByPtyB"`
 }
 
+func (c *CollateralBalance1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralBalance1) SetHeldByPartyA(value, currency string) {
 	c.HeldByPartyA = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CollateralBalance1) SetHeldByPartyAFromDecimal(value decimal.Decimal, currency string) {
+	c.HeldByPartyA = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CollateralBalance1) SetHeldByPartyB(value, currency string) {
 	c.HeldByPartyB = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CollateralBalance1) SetHeldByPartyBFromDecimal(value decimal.Decimal, currency string) {
+	c.HeldByPartyB = NewActiveCurrencyAndAmount(value.String(), currency)
+}
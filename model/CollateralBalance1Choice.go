@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice to provide the collateral balance for the variation margin and the segregated independent amount, or the segregated independent amount only.
 type CollateralBalance1Choice struct {
 
@@ -13,10 +17,18 @@ type CollateralBalance1Choice struct {
 	SegregatedIndependentAmount *MarginCollateral1 `xml:"SgrtdIndpdntAmt"`
 }
 
+func (c *CollateralBalance1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralBalance1Choice) SetTotalCollateral(value, currency string) {
 	c.TotalCollateral = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CollateralBalance1Choice) SetTotalCollateralFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalCollateral = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CollateralBalance1Choice) AddCollateralDetails() *Collateral1 {
 	c.CollateralDetails = new(Collateral1)
 	return c.CollateralDetails
@@ -10,6 +10,10 @@ type CollateralCancellationReason1 struct {
 	CancellationReasonCode *CollateralCancellationType1Choice `xml:"CxlRsnCd"`
 }
 
+func (c *CollateralCancellationReason1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralCancellationReason1) SetAdditionalInformation(value string) {
 	c.AdditionalInformation = (*Max35Text)(&value)
 }
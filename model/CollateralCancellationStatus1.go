@@ -13,6 +13,10 @@ type CollateralCancellationStatus1 struct {
 	RejectionDetails *RejectionStatus2 `xml:"RjctnDtls,omitempty"`
 }
 
+func (c *CollateralCancellationStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralCancellationStatus1) SetCollateralStatusCode(value string) {
 	c.CollateralStatusCode = (*Status4Code)(&value)
 }
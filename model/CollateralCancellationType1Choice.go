@@ -10,6 +10,10 @@ type CollateralCancellationType1Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CollateralCancellationType1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralCancellationType1Choice) SetCode(value string) {
 	c.Code = (*CollateralManagementCancellationReason1Code)(&value)
 }
@@ -16,6 +16,10 @@ type CollateralConfirmation1 struct {
 	Comment *Max140Text `xml:"Cmnt,omitempty"`
 }
 
+func (c *CollateralConfirmation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralConfirmation1) SetCollateralSubstitutionRequestIdentification(value string) {
 	c.CollateralSubstitutionRequestIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the agreed amount and the collateral movement direction.
 type CollateralMovement10 struct {
 
@@ -10,10 +14,18 @@ type CollateralMovement10 struct {
 	MovementDirection []*CollateralMovement5Choice `xml:"MvmntDrctn,omitempty"`
 }
 
+func (c *CollateralMovement10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralMovement10) SetAgreedAmount(value, currency string) {
 	c.AgreedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CollateralMovement10) SetAgreedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AgreedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CollateralMovement10) AddMovementDirection() *CollateralMovement5Choice {
 	newValue := new(CollateralMovement5Choice)
 	c.MovementDirection = append(c.MovementDirection, newValue)
@@ -10,6 +10,10 @@ type CollateralMovement11 struct {
 	Return *Collateral17 `xml:"Rtr,omitempty"`
 }
 
+func (c *CollateralMovement11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralMovement11) AddDeliver() *Collateral16 {
 	c.Deliver = new(Collateral16)
 	return c.Deliver
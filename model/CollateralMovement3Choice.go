@@ -10,6 +10,10 @@ type CollateralMovement3Choice struct {
 	Return *Collateral7 `xml:"Rtr"`
 }
 
+func (c *CollateralMovement3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralMovement3Choice) AddCollateralMovementDirection() *CollateralMovement6 {
 	c.CollateralMovementDirection = new(CollateralMovement6)
 	return c.CollateralMovementDirection
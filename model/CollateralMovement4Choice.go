@@ -10,6 +10,10 @@ type CollateralMovement4Choice struct {
 	Return *Collateral11 `xml:"Rtr"`
 }
 
+func (c *CollateralMovement4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralMovement4Choice) AddCollateralMovementDirection() *CollateralMovement8 {
 	c.CollateralMovementDirection = new(CollateralMovement8)
 	return c.CollateralMovementDirection
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the agreed amount and the collateral movement direction.
 type CollateralMovement5 struct {
 
@@ -10,10 +14,18 @@ type CollateralMovement5 struct {
 	MovementDirection []*CollateralMovement3Choice `xml:"MvmntDrctn,omitempty"`
 }
 
+func (c *CollateralMovement5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralMovement5) SetAgreedAmount(value, currency string) {
 	c.AgreedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CollateralMovement5) SetAgreedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AgreedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CollateralMovement5) AddMovementDirection() *CollateralMovement3Choice {
 	newValue := new(CollateralMovement3Choice)
 	c.MovementDirection = append(c.MovementDirection, newValue)
@@ -10,6 +10,10 @@ type CollateralMovement5Choice struct {
 	Return *Collateral17 `xml:"Rtr"`
 }
 
+func (c *CollateralMovement5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralMovement5Choice) AddCollateralMovementDirection() *CollateralMovement11 {
 	c.CollateralMovementDirection = new(CollateralMovement11)
 	return c.CollateralMovementDirection
@@ -10,6 +10,10 @@ type CollateralMovement6 struct {
 	Return *Collateral7 `xml:"Rtr,omitempty"`
 }
 
+func (c *CollateralMovement6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralMovement6) AddDeliver() *Collateral8 {
 	c.Deliver = new(Collateral8)
 	return c.Deliver
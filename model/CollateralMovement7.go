@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the agreed amount and the collateral movement direction.
 type CollateralMovement7 struct {
 
@@ -10,10 +14,18 @@ type CollateralMovement7 struct {
 	MovementDirection []*CollateralMovement4Choice `xml:"MvmntDrctn,omitempty"`
 }
 
+func (c *CollateralMovement7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralMovement7) SetAgreedAmount(value, currency string) {
 	c.AgreedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CollateralMovement7) SetAgreedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AgreedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CollateralMovement7) AddMovementDirection() *CollateralMovement4Choice {
 	newValue := new(CollateralMovement4Choice)
 	c.MovementDirection = append(c.MovementDirection, newValue)
@@ -10,6 +10,10 @@ type CollateralMovement8 struct {
 	Return *Collateral11 `xml:"Rtr,omitempty"`
 }
 
+func (c *CollateralMovement8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralMovement8) AddDeliver() *Collateral12 {
 	c.Deliver = new(Collateral12)
 	return c.Deliver
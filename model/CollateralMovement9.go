@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the type of collateral that will be delivered and the date to be reported.
 type CollateralMovement9 struct {
 
@@ -10,6 +14,10 @@ type CollateralMovement9 struct {
 	Date *ISODate `xml:"Dt,omitempty"`
 }
 
+func (c *CollateralMovement9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralMovement9) SetCollateralType(value string) {
 	c.CollateralType = (*CollateralType1Code)(&value)
 }
@@ -17,3 +25,8 @@ func (c *CollateralMovement9) SetCollateralType(value string) {
 func (c *CollateralMovement9) SetDate(value string) {
 	c.Date = (*ISODate)(&value)
 }
+
+func (c *CollateralMovement9) SetDateFromTime(value time.Time) {
+	c.Date = new(ISODate)
+	c.Date.FromTime(value)
+}
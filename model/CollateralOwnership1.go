@@ -10,10 +10,19 @@ type CollateralOwnership1 struct {
 	ClientName *PartyIdentification33Choice `xml:"ClntNm,omitempty"`
 }
 
+func (c *CollateralOwnership1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralOwnership1) SetProprietary(value string) {
 	c.Proprietary = (*YesNoIndicator)(&value)
 }
 
+func (c *CollateralOwnership1) SetProprietaryFromBool(value bool) {
+	c.Proprietary = new(YesNoIndicator)
+	c.Proprietary.FromBool(value)
+}
+
 func (c *CollateralOwnership1) AddClientName() *PartyIdentification33Choice {
 	c.ClientName = new(PartyIdentification33Choice)
 	return c.ClientName
@@ -10,10 +10,19 @@ type CollateralOwnership2 struct {
 	ClientName *PartyIdentification100Choice `xml:"ClntNm,omitempty"`
 }
 
+func (c *CollateralOwnership2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralOwnership2) SetProprietary(value string) {
 	c.Proprietary = (*YesNoIndicator)(&value)
 }
 
+func (c *CollateralOwnership2) SetProprietaryFromBool(value bool) {
+	c.Proprietary = new(YesNoIndicator)
+	c.Proprietary.FromBool(value)
+}
+
 func (c *CollateralOwnership2) AddClientName() *PartyIdentification100Choice {
 	c.ClientName = new(PartyIdentification100Choice)
 	return c.ClientName
@@ -10,6 +10,10 @@ type CollateralProposal3Choice struct {
 	SegregatedIndependentAmount *CollateralMovement5 `xml:"SgrtdIndpdntAmt"`
 }
 
+func (c *CollateralProposal3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralProposal3Choice) AddCollateralProposalDetails() *CollateralProposal4 {
 	c.CollateralProposalDetails = new(CollateralProposal4)
 	return c.CollateralProposalDetails
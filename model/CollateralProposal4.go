@@ -10,6 +10,10 @@ type CollateralProposal4 struct {
 	SegregatedIndependentAmount *CollateralMovement5 `xml:"SgrtdIndpdntAmt,omitempty"`
 }
 
+func (c *CollateralProposal4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralProposal4) AddVariationMargin() *CollateralMovement5 {
 	c.VariationMargin = new(CollateralMovement5)
 	return c.VariationMargin
@@ -10,6 +10,10 @@ type CollateralProposal4Choice struct {
 	SegregatedIndependentAmount *CollateralMovement7 `xml:"SgrtdIndpdntAmt"`
 }
 
+func (c *CollateralProposal4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralProposal4Choice) AddCollateralProposalDetails() *CollateralProposal5 {
 	c.CollateralProposalDetails = new(CollateralProposal5)
 	return c.CollateralProposalDetails
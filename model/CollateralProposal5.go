@@ -10,6 +10,10 @@ type CollateralProposal5 struct {
 	SegregatedIndependentAmount *CollateralMovement7 `xml:"SgrtdIndpdntAmt,omitempty"`
 }
 
+func (c *CollateralProposal5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralProposal5) AddVariationMargin() *CollateralMovement7 {
 	c.VariationMargin = new(CollateralMovement7)
 	return c.VariationMargin
@@ -10,6 +10,10 @@ type CollateralProposal5Choice struct {
 	SegregatedIndependentAmount *CollateralMovement10 `xml:"SgrtdIndpdntAmt"`
 }
 
+func (c *CollateralProposal5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralProposal5Choice) AddCollateralProposalDetails() *CollateralProposal6 {
 	c.CollateralProposalDetails = new(CollateralProposal6)
 	return c.CollateralProposalDetails
@@ -10,6 +10,10 @@ type CollateralProposal6 struct {
 	SegregatedIndependentAmount *CollateralMovement10 `xml:"SgrtdIndpdntAmt,omitempty"`
 }
 
+func (c *CollateralProposal6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralProposal6) AddVariationMargin() *CollateralMovement10 {
 	c.VariationMargin = new(CollateralMovement10)
 	return c.VariationMargin
@@ -10,6 +10,10 @@ type CollateralProposalResponse2 struct {
 	SegregatedIndependentAmount *CollateralProposalResponseType2 `xml:"SgrtdIndpdntAmt,omitempty"`
 }
 
+func (c *CollateralProposalResponse2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralProposalResponse2) AddVariationMargin() *CollateralProposalResponseType2 {
 	c.VariationMargin = new(CollateralProposalResponseType2)
 	return c.VariationMargin
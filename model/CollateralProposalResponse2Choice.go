@@ -10,6 +10,10 @@ type CollateralProposalResponse2Choice struct {
 	SegregatedIndependentAmount *CollateralProposalResponseType2 `xml:"SgrtdIndpdntAmt"`
 }
 
+func (c *CollateralProposalResponse2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralProposalResponse2Choice) AddCollateralProposal() *CollateralProposalResponse2 {
 	c.CollateralProposal = new(CollateralProposalResponse2)
 	return c.CollateralProposal
@@ -10,6 +10,10 @@ type CollateralProposalResponse3 struct {
 	SegregatedIndependentAmount *CollateralProposalResponseType3 `xml:"SgrtdIndpdntAmt,omitempty"`
 }
 
+func (c *CollateralProposalResponse3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralProposalResponse3) AddVariationMargin() *CollateralProposalResponseType3 {
 	c.VariationMargin = new(CollateralProposalResponseType3)
 	return c.VariationMargin
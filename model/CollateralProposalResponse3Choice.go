@@ -10,6 +10,10 @@ type CollateralProposalResponse3Choice struct {
 	SegregatedIndependentAmount *CollateralProposalResponseType3 `xml:"SgrtdIndpdntAmt"`
 }
 
+func (c *CollateralProposalResponse3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralProposalResponse3Choice) AddCollateralProposal() *CollateralProposalResponse3 {
 	c.CollateralProposal = new(CollateralProposalResponse3)
 	return c.CollateralProposal
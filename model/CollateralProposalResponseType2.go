@@ -13,6 +13,10 @@ type CollateralProposalResponseType2 struct {
 	Response *CollateralResponse1 `xml:"Rspn"`
 }
 
+func (c *CollateralProposalResponseType2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralProposalResponseType2) SetCollateralProposalIdentification(value string) {
 	c.CollateralProposalIdentification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type CollateralProposalResponseType3 struct {
 	Response *CollateralResponse2 `xml:"Rspn"`
 }
 
+func (c *CollateralProposalResponseType3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralProposalResponseType3) SetCollateralProposalIdentification(value string) {
 	c.CollateralProposalIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type CollateralPurpose1Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CollateralPurpose1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralPurpose1Choice) SetCode(value string) {
 	c.Code = (*CollateralPurpose1Code)(&value)
 }
@@ -13,6 +13,10 @@ type CollateralResponse1 struct {
 	OtherCollateralResponse []*OtherCollateralResponse1 `xml:"OthrCollRspn,omitempty"`
 }
 
+func (c *CollateralResponse1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralResponse1) AddSecuritiesCollateralResponse() *SecuritiesCollateralResponse1 {
 	newValue := new(SecuritiesCollateralResponse1)
 	c.SecuritiesCollateralResponse = append(c.SecuritiesCollateralResponse, newValue)
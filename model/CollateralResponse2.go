@@ -13,6 +13,10 @@ type CollateralResponse2 struct {
 	OtherCollateralResponse []*OtherCollateralResponse2 `xml:"OthrCollRspn,omitempty"`
 }
 
+func (c *CollateralResponse2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralResponse2) AddSecuritiesCollateralResponse() *SecuritiesCollateralResponse1 {
 	newValue := new(SecuritiesCollateralResponse1)
 	c.SecuritiesCollateralResponse = append(c.SecuritiesCollateralResponse, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the collateral that will be substituted.
 type CollateralSubstitution2 struct {
 
@@ -28,6 +32,10 @@ type CollateralSubstitution2 struct {
 	LinkedReferences *Reference17 `xml:"LkdRefs,omitempty"`
 }
 
+func (c *CollateralSubstitution2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralSubstitution2) SetCollateralSubstitutionSequence(value string) {
 	c.CollateralSubstitutionSequence = (*CollateralSubstitutionSequence1Code)(&value)
 }
@@ -36,6 +44,10 @@ func (c *CollateralSubstitution2) SetSubstitutionRequirement(value, currency str
 	c.SubstitutionRequirement = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CollateralSubstitution2) SetSubstitutionRequirementFromDecimal(value decimal.Decimal, currency string) {
+	c.SubstitutionRequirement = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CollateralSubstitution2) SetCollateralSubstitutionType(value string) {
 	c.CollateralSubstitutionType = (*CollateralSubstitutionType1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the accepted collateral substitution.
 type CollateralSubstitutionResponse1 struct {
 
@@ -10,6 +14,10 @@ type CollateralSubstitutionResponse1 struct {
 	AcceptedAmount *ActiveCurrencyAndAmount `xml:"AccptdAmt,omitempty"`
 }
 
+func (c *CollateralSubstitutionResponse1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralSubstitutionResponse1) SetCollateralSubstitutionRequestIdentification(value string) {
 	c.CollateralSubstitutionRequestIdentification = (*Max35Text)(&value)
 }
@@ -17,3 +25,7 @@ func (c *CollateralSubstitutionResponse1) SetCollateralSubstitutionRequestIdenti
 func (c *CollateralSubstitutionResponse1) SetAcceptedAmount(value, currency string) {
 	c.AcceptedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CollateralSubstitutionResponse1) SetAcceptedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AcceptedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the rejected collateral substitution.
 type CollateralSubstitutionResponse2 struct {
 
@@ -16,6 +20,10 @@ type CollateralSubstitutionResponse2 struct {
 	RejectionReasonInformation *Max140Text `xml:"RjctnRsnInf,omitempty"`
 }
 
+func (c *CollateralSubstitutionResponse2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralSubstitutionResponse2) SetCollateralSubstitutionRequestIdentification(value string) {
 	c.CollateralSubstitutionRequestIdentification = (*Max35Text)(&value)
 }
@@ -24,6 +32,10 @@ func (c *CollateralSubstitutionResponse2) SetRejectedAmount(value, currency stri
 	c.RejectedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CollateralSubstitutionResponse2) SetRejectedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RejectedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CollateralSubstitutionResponse2) SetRejectionReason(value string) {
 	c.RejectionReason = (*RejectionReasonV021Code)(&value)
 }
@@ -10,6 +10,10 @@ type CollateralType1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (c *CollateralType1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralType1Choice) SetCode(value string) {
 	c.Code = (*CollateralType3Code)(&value)
 }
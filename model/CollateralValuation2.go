@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the valuation of each piece of collateral that is posted.
 type CollateralValuation2 struct {
 
@@ -40,6 +44,10 @@ type CollateralValuation2 struct {
 	OtherCollateral *OtherCollateral3 `xml:"OthrColl,omitempty"`
 }
 
+func (c *CollateralValuation2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralValuation2) SetCollateralIdentification(value string) {
 	c.CollateralIdentification = (*Max35Text)(&value)
 }
@@ -56,6 +64,11 @@ func (c *CollateralValuation2) SetNumberOfDaysAccrued(value string) {
 	c.NumberOfDaysAccrued = (*Number)(&value)
 }
 
+func (c *CollateralValuation2) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	c.NumberOfDaysAccrued = new(Number)
+	c.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (c *CollateralValuation2) AddValuationAmounts() *CollateralAmount1 {
 	c.ValuationAmounts = new(CollateralAmount1)
 	return c.ValuationAmounts
@@ -69,14 +82,29 @@ func (c *CollateralValuation2) SetExchangeRate(value string) {
 	c.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (c *CollateralValuation2) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(BaseOneRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
 func (c *CollateralValuation2) SetCurrencyHaircut(value string) {
 	c.CurrencyHaircut = (*BaseOneRate)(&value)
 }
 
+func (c *CollateralValuation2) SetCurrencyHaircutFromDecimal(value decimal.Decimal) {
+	c.CurrencyHaircut = new(BaseOneRate)
+	c.CurrencyHaircut.FromDecimal(value)
+}
+
 func (c *CollateralValuation2) SetAdjustedRate(value string) {
 	c.AdjustedRate = (*BaseOneRate)(&value)
 }
 
+func (c *CollateralValuation2) SetAdjustedRateFromDecimal(value decimal.Decimal) {
+	c.AdjustedRate = new(BaseOneRate)
+	c.AdjustedRate.FromDecimal(value)
+}
+
 func (c *CollateralValuation2) AddSecuritiesCollateral() *SecuritiesCollateral2 {
 	c.SecuritiesCollateral = new(SecuritiesCollateral2)
 	return c.SecuritiesCollateral
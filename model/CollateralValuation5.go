@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the valuation of each piece of collateral that is posted.
 type CollateralValuation5 struct {
 
@@ -40,6 +44,10 @@ type CollateralValuation5 struct {
 	OtherCollateral *OtherCollateral6 `xml:"OthrColl,omitempty"`
 }
 
+func (c *CollateralValuation5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralValuation5) SetCollateralIdentification(value string) {
 	c.CollateralIdentification = (*Max35Text)(&value)
 }
@@ -56,6 +64,11 @@ func (c *CollateralValuation5) SetNumberOfDaysAccrued(value string) {
 	c.NumberOfDaysAccrued = (*Number)(&value)
 }
 
+func (c *CollateralValuation5) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	c.NumberOfDaysAccrued = new(Number)
+	c.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (c *CollateralValuation5) AddValuationAmounts() *CollateralAmount1 {
 	c.ValuationAmounts = new(CollateralAmount1)
 	return c.ValuationAmounts
@@ -69,14 +82,29 @@ func (c *CollateralValuation5) SetExchangeRate(value string) {
 	c.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (c *CollateralValuation5) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(BaseOneRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
 func (c *CollateralValuation5) SetCurrencyHaircut(value string) {
 	c.CurrencyHaircut = (*BaseOneRate)(&value)
 }
 
+func (c *CollateralValuation5) SetCurrencyHaircutFromDecimal(value decimal.Decimal) {
+	c.CurrencyHaircut = new(BaseOneRate)
+	c.CurrencyHaircut.FromDecimal(value)
+}
+
 func (c *CollateralValuation5) SetAdjustedRate(value string) {
 	c.AdjustedRate = (*BaseOneRate)(&value)
 }
 
+func (c *CollateralValuation5) SetAdjustedRateFromDecimal(value decimal.Decimal) {
+	c.AdjustedRate = new(BaseOneRate)
+	c.AdjustedRate.FromDecimal(value)
+}
+
 func (c *CollateralValuation5) AddSecuritiesCollateral() *SecuritiesCollateral6 {
 	c.SecuritiesCollateral = new(SecuritiesCollateral6)
 	return c.SecuritiesCollateral
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the valuation of a collateral, identified through an ISIN.
 type CollateralValuation6 struct {
 
@@ -10,10 +14,18 @@ type CollateralValuation6 struct {
 	ISIN *ISINOct2015Identifier `xml:"ISIN"`
 }
 
+func (c *CollateralValuation6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralValuation6) SetNominalAmount(value, currency string) {
 	c.NominalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CollateralValuation6) SetNominalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NominalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CollateralValuation6) SetISIN(value string) {
 	c.ISIN = (*ISINOct2015Identifier)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the specification of the valuation of a collateral, based on the sector and the asset classification.
 type CollateralValuation7 struct {
 
@@ -16,6 +20,10 @@ type CollateralValuation7 struct {
 	NominalAmount *ActiveCurrencyAndAmount `xml:"NmnlAmt,omitempty"`
 }
 
+func (c *CollateralValuation7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CollateralValuation7) SetPoolStatus(value string) {
 	c.PoolStatus = (*CollateralPool1Code)(&value)
 }
@@ -31,3 +39,7 @@ func (c *CollateralValuation7) SetSector(value string) {
 func (c *CollateralValuation7) SetNominalAmount(value, currency string) {
 	c.NominalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CollateralValuation7) SetNominalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NominalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -28,6 +28,10 @@ type CommercialDataSet3 struct {
 	SettlementTerms *SettlementTerms2 `xml:"SttlmTerms"`
 }
 
+func (c *CommercialDataSet3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommercialDataSet3) AddDataSetIdentification() *DocumentIdentification1 {
 	c.DataSetIdentification = new(DocumentIdentification1)
 	return c.DataSetIdentification
@@ -28,6 +28,10 @@ type CommercialDataSet4 struct {
 	SettlementTerms *SettlementTerms3 `xml:"SttlmTerms"`
 }
 
+func (c *CommercialDataSet4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommercialDataSet4) AddDataSetIdentification() *DocumentIdentification1 {
 	c.DataSetIdentification = new(DocumentIdentification1)
 	return c.DataSetIdentification
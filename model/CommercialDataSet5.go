@@ -28,6 +28,10 @@ type CommercialDataSet5 struct {
 	SettlementTerms *SettlementTerms3 `xml:"SttlmTerms"`
 }
 
+func (c *CommercialDataSet5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommercialDataSet5) AddDataSetIdentification() *DocumentIdentification1 {
 	c.DataSetIdentification = new(DocumentIdentification1)
 	return c.DataSetIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to a party as compensation for a service.
 type Commission10 struct {
 
@@ -31,6 +35,10 @@ type Commission10 struct {
 	WaivingDetails *CommissionWaiver3 `xml:"WvgDtls,omitempty"`
 }
 
+func (c *Commission10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Commission10) SetType(value string) {
 	c.Type = (*CommissionType6Code)(&value)
 }
@@ -51,10 +59,19 @@ func (c *Commission10) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *Commission10) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *Commission10) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
 
+func (c *Commission10) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
+
 func (c *Commission10) AddRecipientIdentification() *PartyIdentification2Choice {
 	c.RecipientIdentification = new(PartyIdentification2Choice)
 	return c.RecipientIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to a party as compensation for a service.
 type Commission11 struct {
 
@@ -22,14 +26,27 @@ type Commission11 struct {
 	NewCommercialAgreementReferenceIndicator *YesNoIndicator `xml:"NewComrclAgrmtRefInd,omitempty"`
 }
 
+func (c *Commission11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Commission11) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *Commission11) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *Commission11) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
 
+func (c *Commission11) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
+
 func (c *Commission11) SetType(value string) {
 	c.Type = (*CommissionType6Code)(&value)
 }
@@ -45,3 +62,8 @@ func (c *Commission11) SetCommercialAgreementReference(value string) {
 func (c *Commission11) SetNewCommercialAgreementReferenceIndicator(value string) {
 	c.NewCommercialAgreementReferenceIndicator = (*YesNoIndicator)(&value)
 }
+
+func (c *Commission11) SetNewCommercialAgreementReferenceIndicatorFromBool(value bool) {
+	c.NewCommercialAgreementReferenceIndicator = new(YesNoIndicator)
+	c.NewCommercialAgreementReferenceIndicator.FromBool(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to a party as compensation for a service.
 type Commission12 struct {
 
@@ -25,6 +29,10 @@ type Commission12 struct {
 	CommercialAgreementReference *Max35Text `xml:"ComrclAgrmtRef,omitempty"`
 }
 
+func (c *Commission12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Commission12) SetType(value string) {
 	c.Type = (*CommissionType7Code)(&value)
 }
@@ -45,6 +53,10 @@ func (c *Commission12) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *Commission12) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *Commission12) AddRecipientIdentification() *PartyIdentification2Choice {
 	c.RecipientIdentification = new(PartyIdentification2Choice)
 	return c.RecipientIdentification
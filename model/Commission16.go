@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to a party as compensation for a service.
 type Commission16 struct {
 
@@ -25,6 +31,10 @@ type Commission16 struct {
 	VATRate *BaseOneRate `xml:"VATRate,omitempty"`
 }
 
+func (c *Commission16) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Commission16) AddType() *CommissionType2Choice {
 	c.Type = new(CommissionType2Choice)
 	return c.Type
@@ -44,6 +54,11 @@ func (c *Commission16) SetCalculationDate(value string) {
 	c.CalculationDate = (*ISODate)(&value)
 }
 
+func (c *Commission16) SetCalculationDateFromTime(value time.Time) {
+	c.CalculationDate = new(ISODate)
+	c.CalculationDate.FromTime(value)
+}
+
 func (c *Commission16) AddTotalCommission() *AmountAndDirection29 {
 	c.TotalCommission = new(AmountAndDirection29)
 	return c.TotalCommission
@@ -53,6 +68,15 @@ func (c *Commission16) SetTotalVATAmount(value, currency string) {
 	c.TotalVATAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *Commission16) SetTotalVATAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalVATAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Commission16) SetVATRate(value string) {
 	c.VATRate = (*BaseOneRate)(&value)
 }
+
+func (c *Commission16) SetVATRateFromDecimal(value decimal.Decimal) {
+	c.VATRate = new(BaseOneRate)
+	c.VATRate.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to a party as compensation for a service.
 type Commission17 struct {
 
@@ -22,6 +26,10 @@ type Commission17 struct {
 	WaivingDetails *CommissionWaiver4 `xml:"WvgDtls,omitempty"`
 }
 
+func (c *Commission17) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Commission17) AddType() *CommissionType3Choice {
 	c.Type = new(CommissionType3Choice)
 	return c.Type
@@ -36,6 +44,10 @@ func (c *Commission17) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *Commission17) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *Commission17) AddRecipientIdentification() *PartyIdentification2Choice {
 	c.RecipientIdentification = new(PartyIdentification2Choice)
 	return c.RecipientIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to a party as compensation for a service.
 type Commission18 struct {
 
@@ -10,10 +14,19 @@ type Commission18 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *Commission18) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Commission18) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
 
+func (c *Commission18) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
+
 func (c *Commission18) SetAdditionalInformation(value string) {
 	c.AdditionalInformation = (*Max350Text)(&value)
 }
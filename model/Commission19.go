@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to a party as compensation for a service.
 type Commission19 struct {
 
@@ -10,10 +14,18 @@ type Commission19 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *Commission19) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Commission19) SetAmount(value, currency string) {
 	c.Amount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *Commission19) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Commission19) SetAdditionalInformation(value string) {
 	c.AdditionalInformation = (*Max350Text)(&value)
 }
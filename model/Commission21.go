@@ -10,6 +10,10 @@ type Commission21 struct {
 	CommissionApplied *AmountOrRate3Choice `xml:"ComssnApld"`
 }
 
+func (c *Commission21) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Commission21) AddCommissionType() *CommissionType5Choice {
 	c.CommissionType = new(CommissionType5Choice)
 	return c.CommissionType
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to a party as compensation for a service.
 type Commission4 struct {
 
@@ -13,6 +17,10 @@ type Commission4 struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (c *Commission4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Commission4) AddType() *CommissionType1 {
 	c.Type = new(CommissionType1)
 	return c.Type
@@ -22,6 +30,15 @@ func (c *Commission4) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *Commission4) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *Commission4) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
+
+func (c *Commission4) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to a party as compensation for a service.
 type Commission6 struct {
 
@@ -25,6 +29,10 @@ type Commission6 struct {
 	WaivingDetails *CommissionWaiver2 `xml:"WvgDtls,omitempty"`
 }
 
+func (c *Commission6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Commission6) AddType() *CommissionType1 {
 	c.Type = new(CommissionType1)
 	return c.Type
@@ -39,10 +47,19 @@ func (c *Commission6) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *Commission6) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *Commission6) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
 
+func (c *Commission6) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
+
 func (c *Commission6) AddRecipientIdentification() *PartyIdentification2Choice {
 	c.RecipientIdentification = new(PartyIdentification2Choice)
 	return c.RecipientIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to a party as compensation for a service.
 type Commission7 struct {
 
@@ -13,14 +17,27 @@ type Commission7 struct {
 	Type *CommissionType1 `xml:"Tp"`
 }
 
+func (c *Commission7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Commission7) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *Commission7) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *Commission7) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
 
+func (c *Commission7) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
+
 func (c *Commission7) AddType() *CommissionType1 {
 	c.Type = new(CommissionType1)
 	return c.Type
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to a party as compensation for a service.
 type Commission9 struct {
 
@@ -16,6 +20,10 @@ type Commission9 struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (c *Commission9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Commission9) SetType(value string) {
 	c.Type = (*CommissionType6Code)(&value)
 }
@@ -28,6 +36,15 @@ func (c *Commission9) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *Commission9) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *Commission9) SetRate(value string) {
 	c.Rate = (*PercentageRate)(&value)
 }
+
+func (c *Commission9) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(PercentageRate)
+	c.Rate.FromDecimal(value)
+}
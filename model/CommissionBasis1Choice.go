@@ -10,6 +10,10 @@ type CommissionBasis1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CommissionBasis1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommissionBasis1Choice) SetCode(value string) {
 	c.Code = (*TaxationBasis4Code)(&value)
 }
@@ -10,6 +10,10 @@ type CommissionType1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CommissionType1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommissionType1) SetStructured(value string) {
 	c.Structured = (*CommissionType5Code)(&value)
 }
@@ -10,6 +10,10 @@ type CommissionType2Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (c *CommissionType2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommissionType2Choice) SetCode(value string) {
 	c.Code = (*CommissionType9Code)(&value)
 }
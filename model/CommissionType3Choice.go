@@ -10,6 +10,10 @@ type CommissionType3Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CommissionType3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommissionType3Choice) SetCode(value string) {
 	c.Code = (*CommissionType7Code)(&value)
 }
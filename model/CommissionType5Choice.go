@@ -10,6 +10,10 @@ type CommissionType5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CommissionType5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommissionType5Choice) SetCode(value string) {
 	c.Code = (*CommissionType6Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Non-enforcement of the right to all or part of a commission by the party entitled to the commission.
 type CommissionWaiver2 struct {
 
@@ -10,6 +14,10 @@ type CommissionWaiver2 struct {
 	WaivedRate *PercentageRate `xml:"WvdRate"`
 }
 
+func (c *CommissionWaiver2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommissionWaiver2) AddInstructionBasis() *WaivingType1 {
 	c.InstructionBasis = new(WaivingType1)
 	return c.InstructionBasis
@@ -18,3 +26,8 @@ func (c *CommissionWaiver2) AddInstructionBasis() *WaivingType1 {
 func (c *CommissionWaiver2) SetWaivedRate(value string) {
 	c.WaivedRate = (*PercentageRate)(&value)
 }
+
+func (c *CommissionWaiver2) SetWaivedRateFromDecimal(value decimal.Decimal) {
+	c.WaivedRate = new(PercentageRate)
+	c.WaivedRate.FromDecimal(value)
+}
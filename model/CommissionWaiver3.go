@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Non-enforcement of the right to all or part of a commission by the party entitled to the commission.
 type CommissionWaiver3 struct {
 
@@ -13,6 +17,10 @@ type CommissionWaiver3 struct {
 	WaivedRate *PercentageRate `xml:"WvdRate"`
 }
 
+func (c *CommissionWaiver3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommissionWaiver3) SetInstructionBasis(value string) {
 	c.InstructionBasis = (*WaivingInstruction1Code)(&value)
 }
@@ -24,3 +32,8 @@ func (c *CommissionWaiver3) SetExtendedInstructionBasis(value string) {
 func (c *CommissionWaiver3) SetWaivedRate(value string) {
 	c.WaivedRate = (*PercentageRate)(&value)
 }
+
+func (c *CommissionWaiver3) SetWaivedRateFromDecimal(value decimal.Decimal) {
+	c.WaivedRate = new(PercentageRate)
+	c.WaivedRate.FromDecimal(value)
+}
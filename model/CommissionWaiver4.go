@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Non-enforcement of the right to all or part of a commission by the party entitled to the commission.
 type CommissionWaiver4 struct {
 
@@ -10,6 +14,10 @@ type CommissionWaiver4 struct {
 	WaivedRate *PercentageRate `xml:"WvdRate"`
 }
 
+func (c *CommissionWaiver4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommissionWaiver4) AddInstructionBasis() *WaivingInstruction1Choice {
 	c.InstructionBasis = new(WaivingInstruction1Choice)
 	return c.InstructionBasis
@@ -18,3 +26,8 @@ func (c *CommissionWaiver4) AddInstructionBasis() *WaivingInstruction1Choice {
 func (c *CommissionWaiver4) SetWaivedRate(value string) {
 	c.WaivedRate = (*PercentageRate)(&value)
 }
+
+func (c *CommissionWaiver4) SetWaivedRateFromDecimal(value decimal.Decimal) {
+	c.WaivedRate = new(PercentageRate)
+	c.WaivedRate.FromDecimal(value)
+}
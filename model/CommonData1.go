@@ -28,6 +28,10 @@ type CommonData1 struct {
 	Currency *CurrencyCode `xml:"Ccy,omitempty"`
 }
 
+func (c *CommonData1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommonData1) AddEnvironment() *CardPaymentEnvironment5 {
 	c.Environment = new(CardPaymentEnvironment5)
 	return c.Environment
@@ -28,6 +28,10 @@ type CommonData2 struct {
 	Currency *CurrencyCode `xml:"Ccy,omitempty"`
 }
 
+func (c *CommonData2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommonData2) AddEnvironment() *CardPaymentEnvironment13 {
 	c.Environment = new(CardPaymentEnvironment13)
 	return c.Environment
@@ -28,6 +28,10 @@ type CommonData3 struct {
 	Currency *CurrencyCode `xml:"Ccy,omitempty"`
 }
 
+func (c *CommonData3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommonData3) AddEnvironment() *CardPaymentEnvironment26 {
 	c.Environment = new(CardPaymentEnvironment26)
 	return c.Environment
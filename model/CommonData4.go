@@ -28,6 +28,10 @@ type CommonData4 struct {
 	Currency *CurrencyCode `xml:"Ccy,omitempty"`
 }
 
+func (c *CommonData4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommonData4) AddEnvironment() *CardPaymentEnvironment39 {
 	c.Environment = new(CardPaymentEnvironment39)
 	return c.Environment
@@ -28,6 +28,10 @@ type CommonData5 struct {
 	Currency *CurrencyCode `xml:"Ccy,omitempty"`
 }
 
+func (c *CommonData5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommonData5) AddEnvironment() *CardPaymentEnvironment51 {
 	c.Environment = new(CardPaymentEnvironment51)
 	return c.Environment
@@ -22,6 +22,10 @@ type CommunicationAddress3 struct {
 	URLAddress *Max256Text `xml:"URLAdr,omitempty"`
 }
 
+func (c *CommunicationAddress3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommunicationAddress3) SetEmail(value string) {
 	c.Email = (*Max256Text)(&value)
 }
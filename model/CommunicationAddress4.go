@@ -10,6 +10,10 @@ type CommunicationAddress4 struct {
 	URLAddress *Max256Text `xml:"URLAdr,omitempty"`
 }
 
+func (c *CommunicationAddress4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommunicationAddress4) SetEmailAddress(value string) {
 	c.EmailAddress = (*Max256Text)(&value)
 }
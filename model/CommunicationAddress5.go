@@ -22,6 +22,10 @@ type CommunicationAddress5 struct {
 	AdditionalContactInformation *Max256Text `xml:"AddtlCtctInf,omitempty"`
 }
 
+func (c *CommunicationAddress5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommunicationAddress5) AddPostalAddress() *PostalAddress18 {
 	c.PostalAddress = new(PostalAddress18)
 	return c.PostalAddress
@@ -25,6 +25,10 @@ type CommunicationAddress6 struct {
 	URLAddress *Max256Text `xml:"URLAdr,omitempty"`
 }
 
+func (c *CommunicationAddress6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommunicationAddress6) AddAddressType() *AddressType1Choice {
 	c.AddressType = new(AddressType1Choice)
 	return c.AddressType
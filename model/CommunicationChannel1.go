@@ -17,6 +17,10 @@ type CommunicationChannel1 struct {
 	DeliverToAddress *PostalAddress6 `xml:"DlvrToAdr,omitempty"`
 }
 
+func (c *CommunicationChannel1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommunicationChannel1) SetMethod(value string) {
 	c.Method = (*ExternalChannel1Code)(&value)
 }
@@ -13,6 +13,10 @@ type CommunicationCharacteristics1 struct {
 	Active *TrueFalseIndicator `xml:"Actv"`
 }
 
+func (c *CommunicationCharacteristics1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommunicationCharacteristics1) SetCommunicationType(value string) {
 	c.CommunicationType = (*POICommunicationType1Code)(&value)
 }
@@ -24,3 +28,8 @@ func (c *CommunicationCharacteristics1) SetRemoteParty(value string) {
 func (c *CommunicationCharacteristics1) SetActive(value string) {
 	c.Active = (*TrueFalseIndicator)(&value)
 }
+
+func (c *CommunicationCharacteristics1) SetActiveFromBool(value bool) {
+	c.Active = new(TrueFalseIndicator)
+	c.Active.FromBool(value)
+}
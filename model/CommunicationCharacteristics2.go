@@ -13,6 +13,10 @@ type CommunicationCharacteristics2 struct {
 	Active *TrueFalseIndicator `xml:"Actv"`
 }
 
+func (c *CommunicationCharacteristics2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommunicationCharacteristics2) SetCommunicationType(value string) {
 	c.CommunicationType = (*POICommunicationType1Code)(&value)
 }
@@ -24,3 +28,8 @@ func (c *CommunicationCharacteristics2) AddRemoteParty(value string) {
 func (c *CommunicationCharacteristics2) SetActive(value string) {
 	c.Active = (*TrueFalseIndicator)(&value)
 }
+
+func (c *CommunicationCharacteristics2) SetActiveFromBool(value bool) {
+	c.Active = new(TrueFalseIndicator)
+	c.Active.FromBool(value)
+}
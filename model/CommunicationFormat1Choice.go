@@ -10,6 +10,10 @@ type CommunicationFormat1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *CommunicationFormat1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommunicationFormat1Choice) SetCode(value string) {
 	c.Code = (*ExternalCommunicationFormat1Code)(&value)
 }
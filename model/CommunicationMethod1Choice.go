@@ -11,6 +11,10 @@ type CommunicationMethod1Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (c *CommunicationMethod1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommunicationMethod1Choice) SetCode(value string) {
 	c.Code = (*ExternalChannel1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CommunicationMethod2Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *CommunicationMethod2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommunicationMethod2Choice) SetCode(value string) {
 	c.Code = (*CommunicationMethod2Code)(&value)
 }
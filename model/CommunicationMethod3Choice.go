@@ -10,6 +10,10 @@ type CommunicationMethod3Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CommunicationMethod3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CommunicationMethod3Choice) SetCode(value string) {
 	c.Code = (*CommunicationMethod1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CompanyLink1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CompanyLink1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CompanyLink1Choice) SetCode(value string) {
 	c.Code = (*CompanyLink1Code)(&value)
 }
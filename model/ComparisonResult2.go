@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Describes the comparison between the currently established baseline elements and the proposed ones.
 type ComparisonResult2 struct {
 
@@ -22,10 +26,19 @@ type ComparisonResult2 struct {
 	Addition *Addition2 `xml:"Addtn"`
 }
 
+func (c *ComparisonResult2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ComparisonResult2) SetElementSequenceNumber(value string) {
 	c.ElementSequenceNumber = (*Number)(&value)
 }
 
+func (c *ComparisonResult2) SetElementSequenceNumberFromDecimal(value decimal.Decimal) {
+	c.ElementSequenceNumber = new(Number)
+	c.ElementSequenceNumber.FromDecimal(value)
+}
+
 func (c *ComparisonResult2) SetElementPath(value string) {
 	c.ElementPath = (*Max350Text)(&value)
 }
@@ -13,8 +13,16 @@ type ConditionallyAcceptedStatus1 struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
-func (c *ConditionallyAcceptedStatus1) SetNoReason(value string) {
+func (c *ConditionallyAcceptedStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *ConditionallyAcceptedStatus1) SetNoReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *ConditionallyAcceptedStatus1) AddReason() *ConditionallyAcceptedStatusReason1 {
@@ -10,8 +10,16 @@ type ConditionallyAcceptedStatus2 struct {
 	ReasonDetails []*ConditionallyAcceptedStatusReason2 `xml:"RsnDtls"`
 }
 
-func (c *ConditionallyAcceptedStatus2) SetNoSpecifiedReason(value string) {
+func (c *ConditionallyAcceptedStatus2) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *ConditionallyAcceptedStatus2) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *ConditionallyAcceptedStatus2) AddReasonDetails() *ConditionallyAcceptedStatusReason2 {
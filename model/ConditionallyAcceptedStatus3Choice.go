@@ -10,8 +10,16 @@ type ConditionallyAcceptedStatus3Choice struct {
 	ReasonDetails []*ConditionallyAcceptedStatusReason3 `xml:"RsnDtls"`
 }
 
-func (c *ConditionallyAcceptedStatus3Choice) SetNoSpecifiedReason(value string) {
+func (c *ConditionallyAcceptedStatus3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *ConditionallyAcceptedStatus3Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *ConditionallyAcceptedStatus3Choice) AddReasonDetails() *ConditionallyAcceptedStatusReason3 {
@@ -10,6 +10,10 @@ type ConditionallyAcceptedStatusReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *ConditionallyAcceptedStatusReason1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConditionallyAcceptedStatusReason1) AddStructured(value string) {
 	c.Structured = append(c.Structured, (*ConditionallyAcceptedStatusReason1Code)(&value))
 }
@@ -16,6 +16,10 @@ type ConditionallyAcceptedStatusReason2 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *ConditionallyAcceptedStatusReason2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConditionallyAcceptedStatusReason2) SetReason(value string) {
 	c.Reason = (*ConditionallyAcceptedStatusReason2Code)(&value)
 }
@@ -10,6 +10,10 @@ type ConditionallyAcceptedStatusReason3 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *ConditionallyAcceptedStatusReason3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConditionallyAcceptedStatusReason3) AddReason() *ConditionallyAcceptedStatusReason3Choice {
 	c.Reason = new(ConditionallyAcceptedStatusReason3Choice)
 	return c.Reason
@@ -10,6 +10,10 @@ type ConditionallyAcceptedStatusReason3Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (c *ConditionallyAcceptedStatusReason3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConditionallyAcceptedStatusReason3Choice) SetCode(value string) {
 	c.Code = (*ConditionallyAcceptedStatusReason2Code)(&value)
 }
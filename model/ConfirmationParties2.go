@@ -46,6 +46,10 @@ type ConfirmationParties2 struct {
 	TradeBeneficiaryParty *ConfirmationPartyDetails3 `xml:"TradBnfcryPty,omitempty"`
 }
 
+func (c *ConfirmationParties2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConfirmationParties2) AddBuyer() *ConfirmationPartyDetails2 {
 	c.Buyer = new(ConfirmationPartyDetails2)
 	return c.Buyer
@@ -22,6 +22,10 @@ type ConfirmationParties3 struct {
 	TradeBeneficiaryParty *ConfirmationPartyDetails3 `xml:"TradBnfcryPty,omitempty"`
 }
 
+func (c *ConfirmationParties3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConfirmationParties3) AddAffirmingParty() *ConfirmationPartyDetails4 {
 	c.AffirmingParty = new(ConfirmationPartyDetails4)
 	return c.AffirmingParty
@@ -43,6 +43,10 @@ type ConfirmationParties4 struct {
 	TradeBeneficiaryParty *ConfirmationPartyDetails3 `xml:"TradBnfcryPty,omitempty"`
 }
 
+func (c *ConfirmationParties4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConfirmationParties4) AddInvestor() *PartyIdentificationAndAccount79 {
 	newValue := new(PartyIdentificationAndAccount79)
 	c.Investor = append(c.Investor, newValue)
@@ -16,6 +16,10 @@ type ConfirmationPartyDetails1 struct {
 	AdditionalInformation *PartyTextInformation5 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *ConfirmationPartyDetails1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConfirmationPartyDetails1) AddIdentification() *PartyIdentification32Choice {
 	c.Identification = new(PartyIdentification32Choice)
 	return c.Identification
@@ -22,6 +22,10 @@ type ConfirmationPartyDetails2 struct {
 	TradingPartyCapacity *TradingPartyCapacity1Choice `xml:"TradgPtyCpcty,omitempty"`
 }
 
+func (c *ConfirmationPartyDetails2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConfirmationPartyDetails2) AddIdentification() *PartyIdentification32Choice {
 	c.Identification = new(PartyIdentification32Choice)
 	return c.Identification
@@ -25,6 +25,10 @@ type ConfirmationPartyDetails3 struct {
 	PartyCapacity *TradingPartyCapacity2Choice `xml:"PtyCpcty,omitempty"`
 }
 
+func (c *ConfirmationPartyDetails3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConfirmationPartyDetails3) AddIdentification() *PartyIdentification32Choice {
 	c.Identification = new(PartyIdentification32Choice)
 	return c.Identification
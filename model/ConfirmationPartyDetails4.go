@@ -22,6 +22,10 @@ type ConfirmationPartyDetails4 struct {
 	AdditionalInformation *PartyTextInformation5 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *ConfirmationPartyDetails4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConfirmationPartyDetails4) AddIdentification() *PartyIdentification32Choice {
 	c.Identification = new(PartyIdentification32Choice)
 	return c.Identification
@@ -19,6 +19,10 @@ type ConfirmationPartyDetails5 struct {
 	InvestorProtectionAssociationMembership *YesNoIndicator `xml:"InvstrPrtcnAssoctnMmbsh,omitempty"`
 }
 
+func (c *ConfirmationPartyDetails5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConfirmationPartyDetails5) AddIdentification() *PartyIdentification32Choice {
 	c.Identification = new(PartyIdentification32Choice)
 	return c.Identification
@@ -41,3 +45,8 @@ func (c *ConfirmationPartyDetails5) AddAdditionalInformation() *PartyTextInforma
 func (c *ConfirmationPartyDetails5) SetInvestorProtectionAssociationMembership(value string) {
 	c.InvestorProtectionAssociationMembership = (*YesNoIndicator)(&value)
 }
+
+func (c *ConfirmationPartyDetails5) SetInvestorProtectionAssociationMembershipFromBool(value bool) {
+	c.InvestorProtectionAssociationMembership = new(YesNoIndicator)
+	c.InvestorProtectionAssociationMembership.FromBool(value)
+}
@@ -28,6 +28,10 @@ type ConfirmationPartyDetails6 struct {
 	InvestorProtectionAssociationMembership *YesNoIndicator `xml:"InvstrPrtcnAssoctnMmbsh,omitempty"`
 }
 
+func (c *ConfirmationPartyDetails6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConfirmationPartyDetails6) AddIdentification() *PartyIdentification32Choice {
 	c.Identification = new(PartyIdentification32Choice)
 	return c.Identification
@@ -65,3 +69,8 @@ func (c *ConfirmationPartyDetails6) AddPartyCapacity() *TradingPartyCapacity2Cho
 func (c *ConfirmationPartyDetails6) SetInvestorProtectionAssociationMembership(value string) {
 	c.InvestorProtectionAssociationMembership = (*YesNoIndicator)(&value)
 }
+
+func (c *ConfirmationPartyDetails6) SetInvestorProtectionAssociationMembershipFromBool(value bool) {
+	c.InvestorProtectionAssociationMembership = new(YesNoIndicator)
+	c.InvestorProtectionAssociationMembership.FromBool(value)
+}
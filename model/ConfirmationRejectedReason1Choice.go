@@ -10,6 +10,10 @@ type ConfirmationRejectedReason1Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (c *ConfirmationRejectedReason1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConfirmationRejectedReason1Choice) SetCode(value string) {
 	c.Code = (*RejectedConfirmationStatusReason1Code)(&value)
 }
@@ -13,6 +13,10 @@ type ConfirmationRejectedStatus1 struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
+func (c *ConfirmationRejectedStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConfirmationRejectedStatus1) SetReason(value string) {
 	c.Reason = (*RejectedConfirmationStatusReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ConfirmationRejectedStatus2 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *ConfirmationRejectedStatus2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConfirmationRejectedStatus2) AddReason() *ConfirmationRejectedReason1Choice {
 	c.Reason = new(ConfirmationRejectedReason1Choice)
 	return c.Reason
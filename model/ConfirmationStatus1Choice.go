@@ -13,6 +13,10 @@ type ConfirmationStatus1Choice struct {
 	Status *OrderConfirmationStatus1Code `xml:"Sts"`
 }
 
+func (c *ConfirmationStatus1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConfirmationStatus1Choice) AddConfirmationRejected() *ConfirmationRejectedStatus2 {
 	newValue := new(ConfirmationRejectedStatus2)
 	c.ConfirmationRejected = append(c.ConfirmationRejected, newValue)
@@ -10,6 +10,10 @@ type ConfirmationType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *ConfirmationType1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConfirmationType1Choice) SetCode(value string) {
 	c.Code = (*AccountManagementType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type ConsentOrRejectionReason2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *ConsentOrRejectionReason2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConsentOrRejectionReason2Choice) SetCode(value string) {
 	c.Code = (*CounterpartyResponseStatusReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ConsentOrRejectionReason4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *ConsentOrRejectionReason4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConsentOrRejectionReason4Choice) SetCode(value string) {
 	c.Code = (*CounterpartyResponseStatusReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ConsentOrRejectionReason5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *ConsentOrRejectionReason5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConsentOrRejectionReason5Choice) SetCode(value string) {
 	c.Code = (*CounterpartyResponseStatusReason1Code)(&value)
 }
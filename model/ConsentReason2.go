@@ -10,6 +10,10 @@ type ConsentReason2 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *ConsentReason2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConsentReason2) AddCode() *ConsentOrRejectionReason2Choice {
 	c.Code = new(ConsentOrRejectionReason2Choice)
 	return c.Code
@@ -10,6 +10,10 @@ type ConsentReason4 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *ConsentReason4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConsentReason4) AddCode() *ConsentOrRejectionReason4Choice {
 	c.Code = new(ConsentOrRejectionReason4Choice)
 	return c.Code
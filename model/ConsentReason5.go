@@ -10,6 +10,10 @@ type ConsentReason5 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *ConsentReason5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConsentReason5) AddCode() *ConsentOrRejectionReason5Choice {
 	c.Code = new(ConsentOrRejectionReason5Choice)
 	return c.Code
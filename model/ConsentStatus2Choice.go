@@ -10,8 +10,16 @@ type ConsentStatus2Choice struct {
 	Reason []*ConsentReason2 `xml:"Rsn"`
 }
 
-func (c *ConsentStatus2Choice) SetNoSpecifiedReason(value string) {
+func (c *ConsentStatus2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *ConsentStatus2Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *ConsentStatus2Choice) AddReason() *ConsentReason2 {
@@ -10,8 +10,16 @@ type ConsentStatus4Choice struct {
 	Reason []*ConsentReason4 `xml:"Rsn"`
 }
 
-func (c *ConsentStatus4Choice) SetNoSpecifiedReason(value string) {
+func (c *ConsentStatus4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *ConsentStatus4Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *ConsentStatus4Choice) AddReason() *ConsentReason4 {
@@ -10,8 +10,16 @@ type ConsentStatus5Choice struct {
 	Reason []*ConsentReason5 `xml:"Rsn"`
 }
 
-func (c *ConsentStatus5Choice) SetNoSpecifiedReason(value string) {
+func (c *ConsentStatus5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *ConsentStatus5Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	c.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (c *ConsentStatus5Choice) AddReason() *ConsentReason5 {
@@ -10,6 +10,10 @@ type ConsentTypeFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *ConsentTypeFormat1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConsentTypeFormat1Choice) SetCode(value string) {
 	c.Code = (*ConsentType1Code)(&value)
 }
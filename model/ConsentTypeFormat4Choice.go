@@ -10,6 +10,10 @@ type ConsentTypeFormat4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *ConsentTypeFormat4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConsentTypeFormat4Choice) SetCode(value string) {
 	c.Code = (*ConsentType1Code)(&value)
 }
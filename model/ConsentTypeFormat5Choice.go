@@ -10,6 +10,10 @@ type ConsentTypeFormat5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *ConsentTypeFormat5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConsentTypeFormat5Choice) SetCode(value string) {
 	c.Code = (*ConsentType1Code)(&value)
 }
@@ -13,6 +13,10 @@ type Consignment1 struct {
 	TotalWeight *Quantity3 `xml:"TtlWght,omitempty"`
 }
 
+func (c *Consignment1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Consignment1) AddTotalQuantity() *Quantity3 {
 	c.TotalQuantity = new(Quantity3)
 	return c.TotalQuantity
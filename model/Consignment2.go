@@ -13,6 +13,10 @@ type Consignment2 struct {
 	TransportMeans []*TransportMeans3 `xml:"TrnsprtMeans,omitempty"`
 }
 
+func (c *Consignment2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Consignment2) AddConsignor() *TradeParty1 {
 	c.Consignor = new(TradeParty1)
 	return c.Consignor
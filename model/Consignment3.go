@@ -13,6 +13,10 @@ type Consignment3 struct {
 	TotalWeight *Quantity10 `xml:"TtlWght,omitempty"`
 }
 
+func (c *Consignment3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Consignment3) AddTotalQuantity() *Quantity10 {
 	c.TotalQuantity = new(Quantity10)
 	return c.TotalQuantity
@@ -13,6 +13,10 @@ type Consignment4 struct {
 	TransportMeans []*TransportMeans3 `xml:"TrnsprtMeans,omitempty"`
 }
 
+func (c *Consignment4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Consignment4) AddConsignor() *TradeParty3 {
 	c.Consignor = new(TradeParty3)
 	return c.Consignor
@@ -1,3 +1,7 @@
 package model
 
 type ConsolidatedTapeAssociationIdentifier string
+
+func (c ConsolidatedTapeAssociationIdentifier) Validate() error {
+	return validateLength("ConsolidatedTapeAssociationIdentifier", string(c), 1, 35)
+}
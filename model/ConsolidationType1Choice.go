@@ -10,6 +10,10 @@ type ConsolidationType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *ConsolidationType1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConsolidationType1Choice) SetCode(value string) {
 	c.Code = (*ConsolidationType1Code)(&value)
 }
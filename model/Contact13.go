@@ -0,0 +1,110 @@
+package model
+
+// Specifies the details of the contact person.
+type Contact13 struct {
+
+	// Specifies the terms used to formally address a person.
+	NamePrefix *NamePrefix2Code `xml:"NmPrfx,omitempty"`
+
+	// Name by which a party is known and which is usually used to identify that party.
+	Name *Max140Text `xml:"Nm,omitempty"`
+
+	// Collection of information that identifies a phone number, as defined by telecom services.
+	PhoneNumber *PhoneNumber `xml:"PhneNb,omitempty"`
+
+	// Collection of information that identifies a mobile phone number, as defined by telecom services.
+	MobileNumber *PhoneNumber `xml:"MobNb,omitempty"`
+
+	// Collection of information that identifies a FAX number, as defined by telecom services.
+	FaxNumber *PhoneNumber `xml:"FaxNb,omitempty"`
+
+	// Address for the Universal Resource Locator (URL), for example an address used over the www (HTTP) service.
+	URLAddress *Max2048Text `xml:"URLAdr,omitempty"`
+
+	// Address for electronic mail (e-mail).
+	EmailAddress *Max256Text `xml:"EmailAdr,omitempty"`
+
+	// Purpose for which an email address may be used.
+	EmailPurpose *Max35Text `xml:"EmailPurp,omitempty"`
+
+	// Title of the function.
+	JobTitle *Max35Text `xml:"JobTitl,omitempty"`
+
+	// Role of a person in an organisation.
+	Responsibility *Max35Text `xml:"Rspnsblty,omitempty"`
+
+	// Identification of a division of a large organisation or building.
+	Department *Max70Text `xml:"Dept,omitempty"`
+
+	// Contact details in another form.
+	Other []*OtherContact1 `xml:"Othr,omitempty"`
+
+	// Preferred method used to reach the contact.
+	PreferredMethod *PreferredContactMethod2Code `xml:"PrefrdMtd,omitempty"`
+}
+
+func (c *Contact13) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *Contact13) SetNamePrefix(value string) error {
+	if err := NamePrefix2Code(value).Validate(); err != nil {
+		return err
+	}
+	c.NamePrefix = (*NamePrefix2Code)(&value)
+	return nil
+}
+
+func (c *Contact13) SetName(value string) {
+	c.Name = (*Max140Text)(&value)
+}
+
+func (c *Contact13) SetPhoneNumber(value string) {
+	c.PhoneNumber = (*PhoneNumber)(&value)
+}
+
+func (c *Contact13) SetMobileNumber(value string) {
+	c.MobileNumber = (*PhoneNumber)(&value)
+}
+
+func (c *Contact13) SetFaxNumber(value string) {
+	c.FaxNumber = (*PhoneNumber)(&value)
+}
+
+func (c *Contact13) SetURLAddress(value string) {
+	c.URLAddress = (*Max2048Text)(&value)
+}
+
+func (c *Contact13) SetEmailAddress(value string) {
+	c.EmailAddress = (*Max256Text)(&value)
+}
+
+func (c *Contact13) SetEmailPurpose(value string) {
+	c.EmailPurpose = (*Max35Text)(&value)
+}
+
+func (c *Contact13) SetJobTitle(value string) {
+	c.JobTitle = (*Max35Text)(&value)
+}
+
+func (c *Contact13) SetResponsibility(value string) {
+	c.Responsibility = (*Max35Text)(&value)
+}
+
+func (c *Contact13) SetDepartment(value string) {
+	c.Department = (*Max70Text)(&value)
+}
+
+func (c *Contact13) AddOther() *OtherContact1 {
+	newValue := new(OtherContact1)
+	c.Other = append(c.Other, newValue)
+	return newValue
+}
+
+func (c *Contact13) SetPreferredMethod(value string) error {
+	if err := PreferredContactMethod2Code(value).Validate(); err != nil {
+		return err
+	}
+	c.PreferredMethod = (*PreferredContactMethod2Code)(&value)
+	return nil
+}
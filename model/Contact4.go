@@ -0,0 +1,238 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Specifies the details of the contact person.
+type Contact4 struct {
+
+	// Specifies the terms used to formally address a person.
+	NamePrefix *NamePrefix2Code `xml:"NmPrfx,omitempty"`
+
+	// Name by which a party is known and which is usually used to identify that party.
+	Name *Max140Text `xml:"Nm,omitempty"`
+
+	// Collection of information that identifies a phone number, as defined by telecom services.
+	PhoneNumber *PhoneNumber `xml:"PhneNb,omitempty"`
+
+	// Collection of information that identifies a mobile phone number, as defined by telecom services.
+	MobileNumber *PhoneNumber `xml:"MobNb,omitempty"`
+
+	// Collection of information that identifies a FAX number, as defined by telecom services.
+	FaxNumber *PhoneNumber `xml:"FaxNb,omitempty"`
+
+	// Address for electronic mail (e-mail).
+	EmailAddress *Max2048Text `xml:"EmailAdr,omitempty"`
+
+	// Purpose for which an email address may be used.
+	EmailPurpose *Max35Text `xml:"EmailPurp,omitempty"`
+
+	// Title of the function.
+	JobTitle *Max35Text `xml:"JobTitl,omitempty"`
+
+	// Role of a person in an organisation.
+	Responsibility *Max35Text `xml:"Rspnsblty,omitempty"`
+
+	// Identification of a division of a large organisation or building.
+	Department *Max70Text `xml:"Dept,omitempty"`
+
+	// Contact details in another form.
+	Other []*OtherContact1 `xml:"Othr,omitempty"`
+
+	// Preferred method used to reach the contact.
+	PreferredMethod *PreferredContactMethod1Code `xml:"PrefrdMtd,omitempty"`
+}
+
+func (c *Contact4) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *Contact4) SetNamePrefix(value string) error {
+	if err := NamePrefix2Code(value).Validate(); err != nil {
+		return err
+	}
+	c.NamePrefix = (*NamePrefix2Code)(&value)
+	return nil
+}
+
+func (c *Contact4) SetName(value string) {
+	c.Name = (*Max140Text)(&value)
+}
+
+func (c *Contact4) SetPhoneNumber(value string) {
+	c.PhoneNumber = (*PhoneNumber)(&value)
+}
+
+func (c *Contact4) SetMobileNumber(value string) {
+	c.MobileNumber = (*PhoneNumber)(&value)
+}
+
+func (c *Contact4) SetFaxNumber(value string) {
+	c.FaxNumber = (*PhoneNumber)(&value)
+}
+
+func (c *Contact4) SetEmailAddress(value string) {
+	c.EmailAddress = (*Max2048Text)(&value)
+}
+
+func (c *Contact4) SetEmailPurpose(value string) {
+	c.EmailPurpose = (*Max35Text)(&value)
+}
+
+func (c *Contact4) SetJobTitle(value string) {
+	c.JobTitle = (*Max35Text)(&value)
+}
+
+func (c *Contact4) SetResponsibility(value string) {
+	c.Responsibility = (*Max35Text)(&value)
+}
+
+func (c *Contact4) SetDepartment(value string) {
+	c.Department = (*Max70Text)(&value)
+}
+
+func (c *Contact4) AddOther() *OtherContact1 {
+	newValue := new(OtherContact1)
+	c.Other = append(c.Other, newValue)
+	return newValue
+}
+
+func (c *Contact4) SetPreferredMethod(value string) error {
+	if err := PreferredContactMethod1Code(value).Validate(); err != nil {
+		return err
+	}
+	c.PreferredMethod = (*PreferredContactMethod1Code)(&value)
+	return nil
+}
+
+func (c *Contact4) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.NamePrefix != nil {
+		enc.Element("NmPrfx", string(*c.NamePrefix))
+	}
+	if c.Name != nil {
+		enc.Element("Nm", string(*c.Name))
+	}
+	if c.PhoneNumber != nil {
+		enc.Element("PhneNb", string(*c.PhoneNumber))
+	}
+	if c.MobileNumber != nil {
+		enc.Element("MobNb", string(*c.MobileNumber))
+	}
+	if c.FaxNumber != nil {
+		enc.Element("FaxNb", string(*c.FaxNumber))
+	}
+	if c.EmailAddress != nil {
+		enc.Element("EmailAdr", string(*c.EmailAddress))
+	}
+	if c.EmailPurpose != nil {
+		enc.Element("EmailPurp", string(*c.EmailPurpose))
+	}
+	if c.JobTitle != nil {
+		enc.Element("JobTitl", string(*c.JobTitle))
+	}
+	if c.Responsibility != nil {
+		enc.Element("Rspnsblty", string(*c.Responsibility))
+	}
+	if c.Department != nil {
+		enc.Element("Dept", string(*c.Department))
+	}
+	for _, elem := range c.Other {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Othr")
+		}
+	}
+	if c.PreferredMethod != nil {
+		enc.Element("PrefrdMtd", string(*c.PreferredMethod))
+	}
+	enc.End(tag)
+}
+
+func (c *Contact4) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "NmPrfx":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.NamePrefix = (*NamePrefix2Code)(&value)
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Name = (*Max140Text)(&value)
+		case "PhneNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.PhoneNumber = (*PhoneNumber)(&value)
+		case "MobNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.MobileNumber = (*PhoneNumber)(&value)
+		case "FaxNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.FaxNumber = (*PhoneNumber)(&value)
+		case "EmailAdr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.EmailAddress = (*Max2048Text)(&value)
+		case "EmailPurp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.EmailPurpose = (*Max35Text)(&value)
+		case "JobTitl":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.JobTitle = (*Max35Text)(&value)
+		case "Rspnsblty":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Responsibility = (*Max35Text)(&value)
+		case "Dept":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Department = (*Max70Text)(&value)
+		case "Othr":
+			elem := new(OtherContact1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Other = append(c.Other, elem)
+		case "PrefrdMtd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.PreferredMethod = (*PreferredContactMethod1Code)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
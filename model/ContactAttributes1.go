@@ -25,6 +25,10 @@ type ContactAttributes1 struct {
 	Identification *BICIdentifier `xml:"Id,omitempty"`
 }
 
+func (c *ContactAttributes1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContactAttributes1) SetName(value string) {
 	c.Name = (*Max350Text)(&value)
 }
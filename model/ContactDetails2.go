@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Communication device number or electronic address used for communication.
 type ContactDetails2 struct {
 
@@ -25,8 +27,16 @@ type ContactDetails2 struct {
 	Other *Max35Text `xml:"Othr,omitempty"`
 }
 
-func (c *ContactDetails2) SetNamePrefix(value string) {
+func (c *ContactDetails2) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *ContactDetails2) SetNamePrefix(value string) error {
+	if err := NamePrefix1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.NamePrefix = (*NamePrefix1Code)(&value)
+	return nil
 }
 
 func (c *ContactDetails2) SetName(value string) {
@@ -52,3 +62,89 @@ func (c *ContactDetails2) SetEmailAddress(value string) {
 func (c *ContactDetails2) SetOther(value string) {
 	c.Other = (*Max35Text)(&value)
 }
+
+func (c *ContactDetails2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.NamePrefix != nil {
+		enc.Element("NmPrfx", string(*c.NamePrefix))
+	}
+	if c.Name != nil {
+		enc.Element("Nm", string(*c.Name))
+	}
+	if c.PhoneNumber != nil {
+		enc.Element("PhneNb", string(*c.PhoneNumber))
+	}
+	if c.MobileNumber != nil {
+		enc.Element("MobNb", string(*c.MobileNumber))
+	}
+	if c.FaxNumber != nil {
+		enc.Element("FaxNb", string(*c.FaxNumber))
+	}
+	if c.EmailAddress != nil {
+		enc.Element("EmailAdr", string(*c.EmailAddress))
+	}
+	if c.Other != nil {
+		enc.Element("Othr", string(*c.Other))
+	}
+	enc.End(tag)
+}
+
+func (c *ContactDetails2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "NmPrfx":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.NamePrefix = (*NamePrefix1Code)(&value)
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Name = (*Max140Text)(&value)
+		case "PhneNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.PhoneNumber = (*PhoneNumber)(&value)
+		case "MobNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.MobileNumber = (*PhoneNumber)(&value)
+		case "FaxNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.FaxNumber = (*PhoneNumber)(&value)
+		case "EmailAdr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.EmailAddress = (*Max2048Text)(&value)
+		case "Othr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Other = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
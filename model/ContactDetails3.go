@@ -28,8 +28,16 @@ type ContactDetails3 struct {
 	PreferredMethod *PreferredContactMethod1Code `xml:"PrefrdMtd,omitempty"`
 }
 
-func (c *ContactDetails3) SetNamePrefix(value string) {
+func (c *ContactDetails3) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *ContactDetails3) SetNamePrefix(value string) error {
+	if err := NamePrefix1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.NamePrefix = (*NamePrefix1Code)(&value)
+	return nil
 }
 
 func (c *ContactDetails3) SetName(value string) {
@@ -198,6 +206,10 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 		XchgRate:               doc.XchgRate.BaseOneRate,                                  // "<XchgRate>"
 	}
 
-func (c *ContactDetails3) SetPreferredMethod(value string) {
+func (c *ContactDetails3) SetPreferredMethod(value string) error {
+	if err := PreferredContactMethod1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.PreferredMethod = (*PreferredContactMethod1Code)(&value)
+	return nil
 }
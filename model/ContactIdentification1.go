@@ -25,12 +25,20 @@ type ContactIdentification1 struct {
 	EmailAddress *Max256Text `xml:"EmailAdr,omitempty"`
 }
 
+func (c *ContactIdentification1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContactIdentification1) SetName(value string) {
 	c.Name = (*Max35Text)(&value)
 }
 
-func (c *ContactIdentification1) SetNamePrefix(value string) {
+func (c *ContactIdentification1) SetNamePrefix(value string) error {
+	if err := NamePrefix1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.NamePrefix = (*NamePrefix1Code)(&value)
+	return nil
 }
 
 func (c *ContactIdentification1) SetGivenName(value string) {
@@ -25,8 +25,16 @@ type ContactIdentification2 struct {
 	EmailAddress *Max256Text `xml:"EmailAdr,omitempty"`
 }
 
-func (c *ContactIdentification2) SetNamePrefix(value string) {
+func (c *ContactIdentification2) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *ContactIdentification2) SetNamePrefix(value string) error {
+	if err := NamePrefix1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.NamePrefix = (*NamePrefix1Code)(&value)
+	return nil
 }
 
 func (c *ContactIdentification2) SetGivenName(value string) {
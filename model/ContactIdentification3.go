@@ -28,6 +28,10 @@ type ContactIdentification3 struct {
 	EmailAddress *Max256Text `xml:"EmailAdr,omitempty"`
 }
 
+func (c *ContactIdentification3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContactIdentification3) SetBIC(value string) {
 	c.BIC = (*BICIdentifier)(&value)
 }
@@ -36,8 +40,12 @@ func (c *ContactIdentification3) SetName(value string) {
 	c.Name = (*Max35Text)(&value)
 }
 
-func (c *ContactIdentification3) SetNamePrefix(value string) {
+func (c *ContactIdentification3) SetNamePrefix(value string) error {
+	if err := NamePrefix1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.NamePrefix = (*NamePrefix1Code)(&value)
+	return nil
 }
 
 func (c *ContactIdentification3) SetGivenName(value string) {
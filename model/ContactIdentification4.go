@@ -25,12 +25,20 @@ type ContactIdentification4 struct {
 	EmailAddress *Max256Text `xml:"EmailAdr,omitempty"`
 }
 
+func (c *ContactIdentification4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContactIdentification4) SetName(value string) {
 	c.Name = (*Max350Text)(&value)
 }
 
-func (c *ContactIdentification4) SetNamePrefix(value string) {
+func (c *ContactIdentification4) SetNamePrefix(value string) error {
+	if err := NamePrefix1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.NamePrefix = (*NamePrefix1Code)(&value)
+	return nil
 }
 
 func (c *ContactIdentification4) SetGivenName(value string) {
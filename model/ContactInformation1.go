@@ -16,6 +16,10 @@ type ContactInformation1 struct {
 	EmailAddress *Max256Text `xml:"EmailAdr,omitempty"`
 }
 
+func (c *ContactInformation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContactInformation1) SetName(value string) {
 	c.Name = (*Max350Text)(&value)
 }
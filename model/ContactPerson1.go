@@ -10,6 +10,10 @@ type ContactPerson1 struct {
 	InstitutionIdentification *PartyIdentification2Choice `xml:"InstnId,omitempty"`
 }
 
+func (c *ContactPerson1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContactPerson1) AddContactPerson() *ContactIdentification4 {
 	c.ContactPerson = new(ContactIdentification4)
 	return c.ContactPerson
@@ -34,8 +34,16 @@ type Contacts3 struct {
 	Department *Max70Text `xml:"Dept,omitempty"`
 }
 
-func (c *Contacts3) SetNamePrefix(value string) {
+func (c *Contacts3) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *Contacts3) SetNamePrefix(value string) error {
+	if err := NamePrefix1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.NamePrefix = (*NamePrefix1Code)(&value)
+	return nil
 }
 
 func (c *Contacts3) SetName(value string) {
@@ -22,6 +22,10 @@ type ContentInformationType1 struct {
 	NamedKeyEncryptedData *NamedKeyEncryptedData1 `xml:"NmdKeyNcrptdData,omitempty"`
 }
 
+func (c *ContentInformationType1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContentInformationType1) SetContentType(value string) {
 	c.ContentType = (*ContentType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ContentInformationType10 struct {
 	EnvelopedData *EnvelopedData4 `xml:"EnvlpdData"`
 }
 
+func (c *ContentInformationType10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContentInformationType10) SetContentType(value string) {
 	c.ContentType = (*ContentType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type ContentInformationType11 struct {
 	AuthenticatedData []*AuthenticatedData4 `xml:"AuthntcdData,omitempty"`
 }
 
+func (c *ContentInformationType11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContentInformationType11) SetContentType(value string) {
 	c.ContentType = (*ContentType2Code)(&value)
 }
@@ -19,6 +19,10 @@ type ContentInformationType12 struct {
 	DigestedData *DigestedData4 `xml:"DgstdData,omitempty"`
 }
 
+func (c *ContentInformationType12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContentInformationType12) SetContentType(value string) {
 	c.ContentType = (*ContentType2Code)(&value)
 }
@@ -13,6 +13,10 @@ type ContentInformationType13 struct {
 	SignedData *SignedData4 `xml:"SgndData,omitempty"`
 }
 
+func (c *ContentInformationType13) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContentInformationType13) SetContentType(value string) {
 	c.ContentType = (*ContentType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type ContentInformationType14 struct {
 	SignedData *SignedData4 `xml:"SgndData"`
 }
 
+func (c *ContentInformationType14) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContentInformationType14) SetContentType(value string) {
 	c.ContentType = (*ContentType2Code)(&value)
 }
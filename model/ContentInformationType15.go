@@ -10,6 +10,10 @@ type ContentInformationType15 struct {
 	AuthenticatedData *AuthenticatedData4 `xml:"AuthntcdData"`
 }
 
+func (c *ContentInformationType15) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContentInformationType15) SetContentType(value string) {
 	c.ContentType = (*ContentType2Code)(&value)
 }
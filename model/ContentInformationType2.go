@@ -10,6 +10,10 @@ type ContentInformationType2 struct {
 	EnvelopedData *EnvelopedData1 `xml:"EnvlpdData"`
 }
 
+func (c *ContentInformationType2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContentInformationType2) SetContentType(value string) {
 	c.ContentType = (*ContentType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ContentInformationType3 struct {
 	AuthenticatedData []*AuthenticatedData1 `xml:"AuthntcdData,omitempty"`
 }
 
+func (c *ContentInformationType3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContentInformationType3) SetContentType(value string) {
 	c.ContentType = (*ContentType1Code)(&value)
 }
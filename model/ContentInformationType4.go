@@ -22,6 +22,10 @@ type ContentInformationType4 struct {
 	NamedKeyEncryptedData *NamedKeyEncryptedData2 `xml:"NmdKeyNcrptdData,omitempty"`
 }
 
+func (c *ContentInformationType4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContentInformationType4) SetContentType(value string) {
 	c.ContentType = (*ContentType1Code)(&value)
 }
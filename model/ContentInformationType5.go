@@ -10,6 +10,10 @@ type ContentInformationType5 struct {
 	EnvelopedData *EnvelopedData2 `xml:"EnvlpdData"`
 }
 
+func (c *ContentInformationType5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContentInformationType5) SetContentType(value string) {
 	c.ContentType = (*ContentType1Code)(&value)
 }
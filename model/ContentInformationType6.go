@@ -10,6 +10,10 @@ type ContentInformationType6 struct {
 	AuthenticatedData []*AuthenticatedData2 `xml:"AuthntcdData,omitempty"`
 }
 
+func (c *ContentInformationType6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContentInformationType6) SetContentType(value string) {
 	c.ContentType = (*ContentType1Code)(&value)
 }
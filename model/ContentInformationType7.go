@@ -10,6 +10,10 @@ type ContentInformationType7 struct {
 	EnvelopedData *EnvelopedData3 `xml:"EnvlpdData"`
 }
 
+func (c *ContentInformationType7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContentInformationType7) SetContentType(value string) {
 	c.ContentType = (*ContentType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ContentInformationType8 struct {
 	AuthenticatedData []*AuthenticatedData3 `xml:"AuthntcdData,omitempty"`
 }
 
+func (c *ContentInformationType8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContentInformationType8) SetContentType(value string) {
 	c.ContentType = (*ContentType1Code)(&value)
 }
@@ -19,6 +19,10 @@ type ContentInformationType9 struct {
 	DigestedData *DigestedData3 `xml:"DgstdData,omitempty"`
 }
 
+func (c *ContentInformationType9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContentInformationType9) SetContentType(value string) {
 	c.ContentType = (*ContentType1Code)(&value)
 }
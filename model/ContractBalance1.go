@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Balance details of a registered contract.
 type ContractBalance1 struct {
 
@@ -13,6 +17,10 @@ type ContractBalance1 struct {
 	CreditDebitIndicator *CreditDebit3Code `xml:"CdtDbtInd"`
 }
 
+func (c *ContractBalance1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContractBalance1) AddType() *ContractBalanceType1Choice {
 	c.Type = new(ContractBalanceType1Choice)
 	return c.Type
@@ -22,6 +30,14 @@ func (c *ContractBalance1) SetAmount(value, currency string) {
 	c.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
-func (c *ContractBalance1) SetCreditDebitIndicator(value string) {
+func (c *ContractBalance1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *ContractBalance1) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebit3Code(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebit3Code)(&value)
+	return nil
 }
@@ -10,6 +10,10 @@ type ContractBalanceType1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *ContractBalanceType1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContractBalanceType1Choice) SetCode(value string) {
 	c.Code = (*ExternalContractBalanceType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ContractClosureReason1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (c *ContractClosureReason1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContractClosureReason1Choice) SetCode(value string) {
 	c.Code = (*ExternalContractClosureReason1Code)(&value)
 }
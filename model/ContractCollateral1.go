@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Further details on the contract collateral.
 type ContractCollateral1 struct {
 
@@ -13,10 +17,18 @@ type ContractCollateral1 struct {
 	AdditionalInformation *Max1025Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *ContractCollateral1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContractCollateral1) SetTotalAmount(value, currency string) {
 	c.TotalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *ContractCollateral1) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *ContractCollateral1) AddCollateralDescription() *CashCollateral5 {
 	newValue := new(CashCollateral5)
 	c.CollateralDescription = append(c.CollateralDescription, newValue)
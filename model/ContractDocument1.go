@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Document that contains the information of the contract agreed between both parties.
 type ContractDocument1 struct {
 
@@ -13,6 +17,10 @@ type ContractDocument1 struct {
 	Version *Max6Text `xml:"Vrsn,omitempty"`
 }
 
+func (c *ContractDocument1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContractDocument1) SetReference(value string) {
 	c.Reference = (*Max35Text)(&value)
 }
@@ -21,6 +29,11 @@ func (c *ContractDocument1) SetSignOffDate(value string) {
 	c.SignOffDate = (*ISODate)(&value)
 }
 
+func (c *ContractDocument1) SetSignOffDateFromTime(value time.Time) {
+	c.SignOffDate = new(ISODate)
+	c.SignOffDate.FromTime(value)
+}
+
 func (c *ContractDocument1) SetVersion(value string) {
 	c.Version = (*Max6Text)(&value)
 }
@@ -19,6 +19,10 @@ type ContractRegistration1 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *ContractRegistration1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContractRegistration1) SetContractRegistrationIdentification(value string) {
 	c.ContractRegistrationIdentification = (*Max35Text)(&value)
 }
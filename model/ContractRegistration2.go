@@ -31,12 +31,20 @@ type ContractRegistration2 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *ContractRegistration2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContractRegistration2) SetContractRegistrationOpeningIdentification(value string) {
 	c.ContractRegistrationOpeningIdentification = (*Max35Text)(&value)
 }
 
-func (c *ContractRegistration2) SetPriority(value string) {
+func (c *ContractRegistration2) SetPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	c.Priority = (*Priority2Code)(&value)
+	return nil
 }
 
 func (c *ContractRegistration2) AddContract() *UnderlyingContract1Choice {
@@ -10,6 +10,10 @@ type ContractRegistrationReference1Choice struct {
 	Contract *DocumentIdentification28 `xml:"Ctrct"`
 }
 
+func (c *ContractRegistrationReference1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContractRegistrationReference1Choice) SetRegisteredContractIdentification(value string) {
 	c.RegisteredContractIdentification = (*Max35Text)(&value)
 }
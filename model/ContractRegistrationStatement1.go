@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Statement of the journal entries for all activities related to the registered currency control contract.
 type ContractRegistrationStatement1 struct {
 
@@ -37,6 +41,10 @@ type ContractRegistrationStatement1 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *ContractRegistrationStatement1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContractRegistrationStatement1) SetStatementIdentification(value string) {
 	c.StatementIdentification = (*Max35Text)(&value)
 }
@@ -649,6 +657,10 @@ func (c *ContractRegistrationStatement1) SetTotalContractTurnoverSum(value, curr
 	c.TotalContractTurnoverSum = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *ContractRegistrationStatement1) SetTotalContractTurnoverSumFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalContractTurnoverSum = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *ContractRegistrationStatement1) AddSupplementaryData() *SupplementaryData1 {
 	newValue := new(SupplementaryData1)
 	c.SupplementaryData = append(c.SupplementaryData, newValue)
@@ -16,18 +16,42 @@ type ContractRegistrationStatementCriteria1 struct {
 	RegulatoryRuleValidation *TrueFalseIndicator `xml:"RgltryRuleVldtn,omitempty"`
 }
 
+func (c *ContractRegistrationStatementCriteria1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContractRegistrationStatementCriteria1) SetTransactionJournal(value string) {
 	c.TransactionJournal = (*TrueFalseIndicator)(&value)
 }
 
+func (c *ContractRegistrationStatementCriteria1) SetTransactionJournalFromBool(value bool) {
+	c.TransactionJournal = new(TrueFalseIndicator)
+	c.TransactionJournal.FromBool(value)
+}
+
 func (c *ContractRegistrationStatementCriteria1) SetSupportingDocumentJournal(value string) {
 	c.SupportingDocumentJournal = (*TrueFalseIndicator)(&value)
 }
 
+func (c *ContractRegistrationStatementCriteria1) SetSupportingDocumentJournalFromBool(value bool) {
+	c.SupportingDocumentJournal = new(TrueFalseIndicator)
+	c.SupportingDocumentJournal.FromBool(value)
+}
+
 func (c *ContractRegistrationStatementCriteria1) SetAdditionalSupportingDocumentJournal(value string) {
 	c.AdditionalSupportingDocumentJournal = (*TrueFalseIndicator)(&value)
 }
 
+func (c *ContractRegistrationStatementCriteria1) SetAdditionalSupportingDocumentJournalFromBool(value bool) {
+	c.AdditionalSupportingDocumentJournal = new(TrueFalseIndicator)
+	c.AdditionalSupportingDocumentJournal.FromBool(value)
+}
+
 func (c *ContractRegistrationStatementCriteria1) SetRegulatoryRuleValidation(value string) {
 	c.RegulatoryRuleValidation = (*TrueFalseIndicator)(&value)
 }
+
+func (c *ContractRegistrationStatementCriteria1) SetRegulatoryRuleValidationFromBool(value bool) {
+	c.RegulatoryRuleValidation = new(TrueFalseIndicator)
+	c.RegulatoryRuleValidation.FromBool(value)
+}
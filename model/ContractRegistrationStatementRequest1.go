@@ -25,6 +25,10 @@ type ContractRegistrationStatementRequest1 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *ContractRegistrationStatementRequest1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ContractRegistrationStatementRequest1) SetStatementRequestIdentification(value string) {
 	c.StatementRequestIdentification = (*Max35Text)(&value)
 }
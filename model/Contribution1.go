@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides additional information such as the contribution account identification or the requirement amount.
 type Contribution1 struct {
 
@@ -16,6 +20,10 @@ type Contribution1 struct {
 	NonClearingMember *PartyIdentificationAndAccount31 `xml:"NonClrMmb,omitempty"`
 }
 
+func (c *Contribution1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Contribution1) AddAccount() *AccountIdentification4Choice {
 	c.Account = new(AccountIdentification4Choice)
 	return c.Account
@@ -25,10 +33,18 @@ func (c *Contribution1) SetRequiredAmount(value, currency string) {
 	c.RequiredAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *Contribution1) SetRequiredAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RequiredAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Contribution1) SetIncreaseCoverageAmount(value, currency string) {
 	c.IncreaseCoverageAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *Contribution1) SetIncreaseCoverageAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.IncreaseCoverageAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *Contribution1) AddNonClearingMember() *PartyIdentificationAndAccount31 {
 	c.NonClearingMember = new(PartyIdentificationAndAccount31)
 	return c.NonClearingMember
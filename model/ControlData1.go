@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details of the number of transactions and the control sum of the message.
 type ControlData1 struct {
 
@@ -10,6 +14,10 @@ type ControlData1 struct {
 	ControlSum *DecimalNumber `xml:"CtrlSum,omitempty"`
 }
 
+func (c *ControlData1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ControlData1) SetNumberOfTransactions(value string) {
 	c.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -17,3 +25,8 @@ func (c *ControlData1) SetNumberOfTransactions(value string) {
 func (c *ControlData1) SetControlSum(value string) {
 	c.ControlSum = (*DecimalNumber)(&value)
 }
+
+func (c *ControlData1) SetControlSumFromDecimal(value decimal.Decimal) {
+	c.ControlSum = new(DecimalNumber)
+	c.ControlSum.FromDecimal(value)
+}
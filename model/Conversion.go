@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+)
+
+// parseTime parses a date or time value in the given layout, with or without
+// a time zone. Values without a time zone are returned in UTC. Fractional
+// seconds are accepted after the seconds of the layout.
+func parseTime(typ, value, builtin, layout string) (time.Time, error) {
+	for _, l := range []string{layout + "Z07:00", layout} {
+		if t, err := time.Parse(l, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, &FacetError{Type: typ, Value: value, Facet: "lexical space of", Limit: "xs:" + builtin}
+}
+
+func parseBoolean(value string) bool {
+	return value == "true" || value == "1"
+}
+
+func formatBoolean(value bool) string {
+	if value {
+		return "true"
+	}
+	return "false"
+}
@@ -10,6 +10,10 @@ type ConversionType1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (c *ConversionType1FormatChoice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConversionType1FormatChoice) SetCode(value string) {
 	c.Code = (*ConversionType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ConversionTypeFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *ConversionTypeFormat1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConversionTypeFormat1Choice) SetCode(value string) {
 	c.Code = (*ConversionType1Code)(&value)
 }
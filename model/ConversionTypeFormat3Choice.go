@@ -10,6 +10,10 @@ type ConversionTypeFormat3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *ConversionTypeFormat3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConversionTypeFormat3Choice) SetCode(value string) {
 	c.Code = (*ConversionType1Code)(&value)
 }
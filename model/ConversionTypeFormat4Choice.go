@@ -10,6 +10,10 @@ type ConversionTypeFormat4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *ConversionTypeFormat4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *ConversionTypeFormat4Choice) SetCode(value string) {
 	c.Code = (*ConversionType1Code)(&value)
 }
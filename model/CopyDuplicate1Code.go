@@ -1,3 +1,28 @@
 package model
 
 type CopyDuplicate1Code string
+
+const (
+	CopyDuplicate1CodeCODU CopyDuplicate1Code = "CODU"
+	CopyDuplicate1CodeCOPY CopyDuplicate1Code = "COPY"
+	CopyDuplicate1CodeDUPL CopyDuplicate1Code = "DUPL"
+)
+
+var copyDuplicate1CodeDescriptions = map[CopyDuplicate1Code]string{
+	CopyDuplicate1CodeCODU: "Copy duplicate: message is being sent as a copy to a party other than the expected recipient and is a duplicate of a message already sent.",
+	CopyDuplicate1CodeCOPY: "Copy: message is being sent as a copy to a party other than the expected recipient.",
+	CopyDuplicate1CodeDUPL: "Duplicate: message is for information/confirmation purposes. It is a duplicate of a message previously sent.",
+}
+
+func (c CopyDuplicate1Code) IsValid() bool {
+	_, ok := copyDuplicate1CodeDescriptions[c]
+	return ok
+}
+
+func (c CopyDuplicate1Code) Description() string {
+	return copyDuplicate1CodeDescriptions[c]
+}
+
+func (c CopyDuplicate1Code) Validate() error {
+	return validateEnumeration("CopyDuplicate1Code", string(c), c.IsValid(), "CODU, COPY, DUPL")
+}
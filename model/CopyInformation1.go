@@ -10,10 +10,19 @@ type CopyInformation1 struct {
 	OriginalReceiver *BICIdentification1 `xml:"OrgnlRcvr"`
 }
 
+func (c *CopyInformation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CopyInformation1) SetCopyIndicator(value string) {
 	c.CopyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CopyInformation1) SetCopyIndicatorFromBool(value bool) {
+	c.CopyIndicator = new(YesNoIndicator)
+	c.CopyIndicator.FromBool(value)
+}
+
 func (c *CopyInformation1) AddOriginalReceiver() *BICIdentification1 {
 	c.OriginalReceiver = new(BICIdentification1)
 	return c.OriginalReceiver
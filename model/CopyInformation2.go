@@ -10,10 +10,19 @@ type CopyInformation2 struct {
 	OriginalReceiver *BICIdentification1 `xml:"OrgnlRcvr,omitempty"`
 }
 
+func (c *CopyInformation2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CopyInformation2) SetCopyIndicator(value string) {
 	c.CopyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CopyInformation2) SetCopyIndicatorFromBool(value bool) {
+	c.CopyIndicator = new(YesNoIndicator)
+	c.CopyIndicator.FromBool(value)
+}
+
 func (c *CopyInformation2) AddOriginalReceiver() *BICIdentification1 {
 	c.OriginalReceiver = new(BICIdentification1)
 	return c.OriginalReceiver
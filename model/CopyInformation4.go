@@ -10,10 +10,19 @@ type CopyInformation4 struct {
 	OriginalReceiver *AnyBICIdentifier `xml:"OrgnlRcvr,omitempty"`
 }
 
+func (c *CopyInformation4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CopyInformation4) SetCopyIndicator(value string) {
 	c.CopyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CopyInformation4) SetCopyIndicatorFromBool(value bool) {
+	c.CopyIndicator = new(YesNoIndicator)
+	c.CopyIndicator.FromBool(value)
+}
+
 func (c *CopyInformation4) SetOriginalReceiver(value string) {
 	c.OriginalReceiver = (*AnyBICIdentifier)(&value)
 }
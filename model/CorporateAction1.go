@@ -13,6 +13,10 @@ type CorporateAction1 struct {
 	Proprietary *Max35Text `xml:"Prtry,omitempty"`
 }
 
+func (c *CorporateAction1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction1) SetCode(value string) {
 	c.Code = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the corporate action event.
 type CorporateAction10 struct {
 
@@ -92,6 +96,10 @@ type CorporateAction10 struct {
 	AdditionalInformation *CorporateActionNarrative24 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateAction10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction10) AddDateDetails() *CorporateActionDate27 {
 	c.DateDetails = new(CorporateActionDate27)
 	return c.DateDetails
@@ -121,6 +129,11 @@ func (c *CorporateAction10) SetInterestAccruedNumberOfDays(value string) {
 	c.InterestAccruedNumberOfDays = (*Max3Number)(&value)
 }
 
+func (c *CorporateAction10) SetInterestAccruedNumberOfDaysFromDecimal(value decimal.Decimal) {
+	c.InterestAccruedNumberOfDays = new(Max3Number)
+	c.InterestAccruedNumberOfDays.FromDecimal(value)
+}
+
 func (c *CorporateAction10) AddCouponNumber() *IdentificationFormat1Choice {
 	newValue := new(IdentificationFormat1Choice)
 	c.CouponNumber = append(c.CouponNumber, newValue)
@@ -271,22 +284,47 @@ func (c *CorporateAction10) SetCertificationBreakdownIndicator(value string) {
 	c.CertificationBreakdownIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction10) SetCertificationBreakdownIndicatorFromBool(value bool) {
+	c.CertificationBreakdownIndicator = new(YesNoIndicator)
+	c.CertificationBreakdownIndicator.FromBool(value)
+}
+
 func (c *CorporateAction10) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction10) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateAction10) SetRestrictionIndicator(value string) {
 	c.RestrictionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction10) SetRestrictionIndicatorFromBool(value bool) {
+	c.RestrictionIndicator = new(YesNoIndicator)
+	c.RestrictionIndicator.FromBool(value)
+}
+
 func (c *CorporateAction10) SetAccruedInterestIndicator(value string) {
 	c.AccruedInterestIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction10) SetAccruedInterestIndicatorFromBool(value bool) {
+	c.AccruedInterestIndicator = new(YesNoIndicator)
+	c.AccruedInterestIndicator.FromBool(value)
+}
+
 func (c *CorporateAction10) SetLetterOfGuaranteedDeliveryIndicator(value string) {
 	c.LetterOfGuaranteedDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction10) SetLetterOfGuaranteedDeliveryIndicatorFromBool(value bool) {
+	c.LetterOfGuaranteedDeliveryIndicator = new(YesNoIndicator)
+	c.LetterOfGuaranteedDeliveryIndicator.FromBool(value)
+}
+
 func (c *CorporateAction10) AddDividendType() *DividendTypeFormat3Choice {
 	c.DividendType = new(DividendTypeFormat3Choice)
 	return c.DividendType
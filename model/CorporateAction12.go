@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the corporate action event.
 type CorporateAction12 struct {
 
@@ -98,6 +102,10 @@ type CorporateAction12 struct {
 	AdditionalInformation *CorporateActionNarrative24 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateAction12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction12) AddDateDetails() *CorporateActionDate27 {
 	c.DateDetails = new(CorporateActionDate27)
 	return c.DateDetails
@@ -127,6 +135,11 @@ func (c *CorporateAction12) SetInterestAccruedNumberOfDays(value string) {
 	c.InterestAccruedNumberOfDays = (*Max3Number)(&value)
 }
 
+func (c *CorporateAction12) SetInterestAccruedNumberOfDaysFromDecimal(value decimal.Decimal) {
+	c.InterestAccruedNumberOfDays = new(Max3Number)
+	c.InterestAccruedNumberOfDays.FromDecimal(value)
+}
+
 func (c *CorporateAction12) AddCouponNumber() *IdentificationFormat1Choice {
 	newValue := new(IdentificationFormat1Choice)
 	c.CouponNumber = append(c.CouponNumber, newValue)
@@ -277,22 +290,47 @@ func (c *CorporateAction12) SetCertificationBreakdownIndicator(value string) {
 	c.CertificationBreakdownIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction12) SetCertificationBreakdownIndicatorFromBool(value bool) {
+	c.CertificationBreakdownIndicator = new(YesNoIndicator)
+	c.CertificationBreakdownIndicator.FromBool(value)
+}
+
 func (c *CorporateAction12) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction12) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateAction12) SetRestrictionIndicator(value string) {
 	c.RestrictionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction12) SetRestrictionIndicatorFromBool(value bool) {
+	c.RestrictionIndicator = new(YesNoIndicator)
+	c.RestrictionIndicator.FromBool(value)
+}
+
 func (c *CorporateAction12) SetAccruedInterestIndicator(value string) {
 	c.AccruedInterestIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction12) SetAccruedInterestIndicatorFromBool(value bool) {
+	c.AccruedInterestIndicator = new(YesNoIndicator)
+	c.AccruedInterestIndicator.FromBool(value)
+}
+
 func (c *CorporateAction12) SetLetterOfGuaranteedDeliveryIndicator(value string) {
 	c.LetterOfGuaranteedDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction12) SetLetterOfGuaranteedDeliveryIndicatorFromBool(value bool) {
+	c.LetterOfGuaranteedDeliveryIndicator = new(YesNoIndicator)
+	c.LetterOfGuaranteedDeliveryIndicator.FromBool(value)
+}
+
 func (c *CorporateAction12) AddDividendType() *DividendTypeFormat5Choice {
 	c.DividendType = new(DividendTypeFormat5Choice)
 	return c.DividendType
@@ -13,6 +13,10 @@ type CorporateAction13 struct {
 	LotteryType *LotteryTypeFormat1Choice `xml:"LtryTp,omitempty"`
 }
 
+func (c *CorporateAction13) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction13) AddDateDetails() *CorporateActionDate30 {
 	c.DateDetails = new(CorporateActionDate30)
 	return c.DateDetails
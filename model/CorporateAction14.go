@@ -10,6 +10,10 @@ type CorporateAction14 struct {
 	LotteryType *LotteryTypeFormat1Choice `xml:"LtryTp,omitempty"`
 }
 
+func (c *CorporateAction14) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction14) AddDateDetails() *CorporateActionDate30 {
 	c.DateDetails = new(CorporateActionDate30)
 	return c.DateDetails
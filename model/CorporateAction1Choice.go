@@ -10,6 +10,10 @@ type CorporateAction1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateAction1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction1Choice) SetType(value string) {
 	c.Type = (*CorporateActionEventType1Code)(&value)
 }
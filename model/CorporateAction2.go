@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the CA event.
 type CorporateAction2 struct {
 
@@ -125,6 +129,10 @@ type CorporateAction2 struct {
 	DistributionType *DistributionType1FormatChoice `xml:"DstrbtnTp,omitempty"`
 }
 
+func (c *CorporateAction2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction2) AddEventStage() *CorporateActionEventStage1FormatChoice {
 	newValue := new(CorporateActionEventStage1FormatChoice)
 	c.EventStage = append(c.EventStage, newValue)
@@ -626,10 +634,20 @@ func (c *CorporateAction2) SetRestrictionIndicator(value string) {
 	c.RestrictionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction2) SetRestrictionIndicatorFromBool(value bool) {
+	c.RestrictionIndicator = new(YesNoIndicator)
+	c.RestrictionIndicator.FromBool(value)
+}
+
 func (c *CorporateAction2) SetPartialElectionIndicator(value string) {
 	c.PartialElectionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction2) SetPartialElectionIndicatorFromBool(value bool) {
+	c.PartialElectionIndicator = new(YesNoIndicator)
+	c.PartialElectionIndicator.FromBool(value)
+}
+
 func (c *CorporateAction2) AddElectionType() *ElectionMovementType1FormatChoice {
 	c.ElectionType = new(ElectionMovementType1FormatChoice)
 	return c.ElectionType
@@ -663,6 +681,11 @@ func (c *CorporateAction2) SetInterestAccruedNumberOfDays(value string) {
 	c.InterestAccruedNumberOfDays = (*Number)(&value)
 }
 
+func (c *CorporateAction2) SetInterestAccruedNumberOfDaysFromDecimal(value decimal.Decimal) {
+	c.InterestAccruedNumberOfDays = new(Number)
+	c.InterestAccruedNumberOfDays.FromDecimal(value)
+}
+
 func (c *CorporateAction2) SetNewDenominationCurrency(value string) {
 	c.NewDenominationCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -837,6 +860,11 @@ func (c *CorporateAction2) SetCertificationRequiredIndicator(value string) {
 	c.CertificationRequiredIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction2) SetCertificationRequiredIndicatorFromBool(value bool) {
+	c.CertificationRequiredIndicator = new(YesNoIndicator)
+	c.CertificationRequiredIndicator.FromBool(value)
+}
+
 func (c *CorporateAction2) AddCertificationType() *BeneficiaryCertificationType1FormatChoice {
 	c.CertificationType = new(BeneficiaryCertificationType1FormatChoice)
 	return c.CertificationType
@@ -868,6 +896,11 @@ func (c *CorporateAction2) SetRedemptionChargesAppliedIndicator(value string) {
 	c.RedemptionChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction2) SetRedemptionChargesAppliedIndicatorFromBool(value bool) {
+	c.RedemptionChargesAppliedIndicator = new(YesNoIndicator)
+	c.RedemptionChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateAction2) AddDistributionType() *DistributionType1FormatChoice {
 	c.DistributionType = new(DistributionType1FormatChoice)
 	return c.DistributionType
@@ -13,6 +13,10 @@ type CorporateAction24 struct {
 	LotteryType *LotteryTypeFormat1Choice `xml:"LtryTp,omitempty"`
 }
 
+func (c *CorporateAction24) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction24) AddDateDetails() *CorporateActionDate41 {
 	c.DateDetails = new(CorporateActionDate41)
 	return c.DateDetails
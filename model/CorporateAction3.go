@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the corporate action event.
 type CorporateAction3 struct {
 
@@ -89,6 +93,10 @@ type CorporateAction3 struct {
 	AdditionalInformation *CorporateActionNarrative3 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateAction3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction3) AddDateDetails() *CorporateActionDate1 {
 	c.DateDetails = new(CorporateActionDate1)
 	return c.DateDetails
@@ -118,6 +126,11 @@ func (c *CorporateAction3) SetInterestAccruedNumberOfDays(value string) {
 	c.InterestAccruedNumberOfDays = (*Max3Number)(&value)
 }
 
+func (c *CorporateAction3) SetInterestAccruedNumberOfDaysFromDecimal(value decimal.Decimal) {
+	c.InterestAccruedNumberOfDays = new(Max3Number)
+	c.InterestAccruedNumberOfDays.FromDecimal(value)
+}
+
 func (c *CorporateAction3) AddCouponNumber() *IdentificationFormat1Choice {
 	newValue := new(IdentificationFormat1Choice)
 	c.CouponNumber = append(c.CouponNumber, newValue)
@@ -268,18 +281,38 @@ func (c *CorporateAction3) SetCertificationRequiredIndicator(value string) {
 	c.CertificationRequiredIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction3) SetCertificationRequiredIndicatorFromBool(value bool) {
+	c.CertificationRequiredIndicator = new(YesNoIndicator)
+	c.CertificationRequiredIndicator.FromBool(value)
+}
+
 func (c *CorporateAction3) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction3) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateAction3) SetRestrictionIndicator(value string) {
 	c.RestrictionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction3) SetRestrictionIndicatorFromBool(value bool) {
+	c.RestrictionIndicator = new(YesNoIndicator)
+	c.RestrictionIndicator.FromBool(value)
+}
+
 func (c *CorporateAction3) SetAccruedInterestIndicator(value string) {
 	c.AccruedInterestIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction3) SetAccruedInterestIndicatorFromBool(value bool) {
+	c.AccruedInterestIndicator = new(YesNoIndicator)
+	c.AccruedInterestIndicator.FromBool(value)
+}
+
 func (c *CorporateAction3) AddDividendType() *DividendTypeFormat1Choice {
 	c.DividendType = new(DividendTypeFormat1Choice)
 	return c.DividendType
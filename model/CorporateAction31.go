@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the corporate action event.
 type CorporateAction31 struct {
 
@@ -98,6 +102,10 @@ type CorporateAction31 struct {
 	AdditionalInformation *CorporateActionNarrative26 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateAction31) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction31) AddDateDetails() *CorporateActionDate44 {
 	c.DateDetails = new(CorporateActionDate44)
 	return c.DateDetails
@@ -127,6 +135,11 @@ func (c *CorporateAction31) SetInterestAccruedNumberOfDays(value string) {
 	c.InterestAccruedNumberOfDays = (*Max3Number)(&value)
 }
 
+func (c *CorporateAction31) SetInterestAccruedNumberOfDaysFromDecimal(value decimal.Decimal) {
+	c.InterestAccruedNumberOfDays = new(Max3Number)
+	c.InterestAccruedNumberOfDays.FromDecimal(value)
+}
+
 func (c *CorporateAction31) AddCouponNumber() *IdentificationFormat3Choice {
 	newValue := new(IdentificationFormat3Choice)
 	c.CouponNumber = append(c.CouponNumber, newValue)
@@ -277,22 +290,47 @@ func (c *CorporateAction31) SetCertificationBreakdownIndicator(value string) {
 	c.CertificationBreakdownIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction31) SetCertificationBreakdownIndicatorFromBool(value bool) {
+	c.CertificationBreakdownIndicator = new(YesNoIndicator)
+	c.CertificationBreakdownIndicator.FromBool(value)
+}
+
 func (c *CorporateAction31) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction31) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateAction31) SetRestrictionIndicator(value string) {
 	c.RestrictionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction31) SetRestrictionIndicatorFromBool(value bool) {
+	c.RestrictionIndicator = new(YesNoIndicator)
+	c.RestrictionIndicator.FromBool(value)
+}
+
 func (c *CorporateAction31) SetAccruedInterestIndicator(value string) {
 	c.AccruedInterestIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction31) SetAccruedInterestIndicatorFromBool(value bool) {
+	c.AccruedInterestIndicator = new(YesNoIndicator)
+	c.AccruedInterestIndicator.FromBool(value)
+}
+
 func (c *CorporateAction31) SetLetterOfGuaranteedDeliveryIndicator(value string) {
 	c.LetterOfGuaranteedDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction31) SetLetterOfGuaranteedDeliveryIndicatorFromBool(value bool) {
+	c.LetterOfGuaranteedDeliveryIndicator = new(YesNoIndicator)
+	c.LetterOfGuaranteedDeliveryIndicator.FromBool(value)
+}
+
 func (c *CorporateAction31) AddDividendType() *DividendTypeFormat9Choice {
 	c.DividendType = new(DividendTypeFormat9Choice)
 	return c.DividendType
@@ -16,6 +16,10 @@ type CorporateAction32 struct {
 	LotteryType *LotteryTypeFormat4Choice `xml:"LtryTp,omitempty"`
 }
 
+func (c *CorporateAction32) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction32) AddDateDetails() *CorporateActionDate45 {
 	c.DateDetails = new(CorporateActionDate45)
 	return c.DateDetails
@@ -16,6 +16,10 @@ type CorporateAction33 struct {
 	LotteryType *LotteryTypeFormat4Choice `xml:"LtryTp,omitempty"`
 }
 
+func (c *CorporateAction33) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction33) AddDateDetails() *CorporateActionDate49 {
 	c.DateDetails = new(CorporateActionDate49)
 	return c.DateDetails
@@ -13,6 +13,10 @@ type CorporateAction34 struct {
 	LotteryType *LotteryTypeFormat4Choice `xml:"LtryTp,omitempty"`
 }
 
+func (c *CorporateAction34) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction34) AddDateDetails() *CorporateActionDate49 {
 	c.DateDetails = new(CorporateActionDate49)
 	return c.DateDetails
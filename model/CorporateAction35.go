@@ -13,6 +13,10 @@ type CorporateAction35 struct {
 	LotteryType *LotteryTypeFormat5Choice `xml:"LtryTp,omitempty"`
 }
 
+func (c *CorporateAction35) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction35) AddDateDetails() *CorporateActionDate50 {
 	c.DateDetails = new(CorporateActionDate50)
 	return c.DateDetails
@@ -16,6 +16,10 @@ type CorporateAction36 struct {
 	LotteryType *LotteryTypeFormat5Choice `xml:"LtryTp,omitempty"`
 }
 
+func (c *CorporateAction36) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction36) AddDateDetails() *CorporateActionDate50 {
 	c.DateDetails = new(CorporateActionDate50)
 	return c.DateDetails
@@ -16,6 +16,10 @@ type CorporateAction38 struct {
 	LotteryType *LotteryTypeFormat5Choice `xml:"LtryTp,omitempty"`
 }
 
+func (c *CorporateAction38) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction38) AddDateDetails() *CorporateActionDate54 {
 	c.DateDetails = new(CorporateActionDate54)
 	return c.DateDetails
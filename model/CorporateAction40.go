@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the corporate action event.
 type CorporateAction40 struct {
 
@@ -98,6 +102,10 @@ type CorporateAction40 struct {
 	AdditionalInformation *CorporateActionNarrative39 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateAction40) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction40) AddDateDetails() *CorporateActionDate58 {
 	c.DateDetails = new(CorporateActionDate58)
 	return c.DateDetails
@@ -127,6 +135,11 @@ func (c *CorporateAction40) SetInterestAccruedNumberOfDays(value string) {
 	c.InterestAccruedNumberOfDays = (*Max3Number)(&value)
 }
 
+func (c *CorporateAction40) SetInterestAccruedNumberOfDaysFromDecimal(value decimal.Decimal) {
+	c.InterestAccruedNumberOfDays = new(Max3Number)
+	c.InterestAccruedNumberOfDays.FromDecimal(value)
+}
+
 func (c *CorporateAction40) AddCouponNumber() *IdentificationFormat4Choice {
 	newValue := new(IdentificationFormat4Choice)
 	c.CouponNumber = append(c.CouponNumber, newValue)
@@ -277,22 +290,47 @@ func (c *CorporateAction40) SetCertificationBreakdownIndicator(value string) {
 	c.CertificationBreakdownIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction40) SetCertificationBreakdownIndicatorFromBool(value bool) {
+	c.CertificationBreakdownIndicator = new(YesNoIndicator)
+	c.CertificationBreakdownIndicator.FromBool(value)
+}
+
 func (c *CorporateAction40) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction40) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateAction40) SetRestrictionIndicator(value string) {
 	c.RestrictionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction40) SetRestrictionIndicatorFromBool(value bool) {
+	c.RestrictionIndicator = new(YesNoIndicator)
+	c.RestrictionIndicator.FromBool(value)
+}
+
 func (c *CorporateAction40) SetAccruedInterestIndicator(value string) {
 	c.AccruedInterestIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction40) SetAccruedInterestIndicatorFromBool(value bool) {
+	c.AccruedInterestIndicator = new(YesNoIndicator)
+	c.AccruedInterestIndicator.FromBool(value)
+}
+
 func (c *CorporateAction40) SetLetterOfGuaranteedDeliveryIndicator(value string) {
 	c.LetterOfGuaranteedDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction40) SetLetterOfGuaranteedDeliveryIndicatorFromBool(value bool) {
+	c.LetterOfGuaranteedDeliveryIndicator = new(YesNoIndicator)
+	c.LetterOfGuaranteedDeliveryIndicator.FromBool(value)
+}
+
 func (c *CorporateAction40) AddDividendType() *DividendTypeFormat10Choice {
 	c.DividendType = new(DividendTypeFormat10Choice)
 	return c.DividendType
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the corporate action event.
 type CorporateAction5 struct {
 
@@ -89,6 +93,10 @@ type CorporateAction5 struct {
 	AdditionalInformation *CorporateActionNarrative3 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateAction5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction5) AddDateDetails() *CorporateActionDate14 {
 	c.DateDetails = new(CorporateActionDate14)
 	return c.DateDetails
@@ -118,6 +126,11 @@ func (c *CorporateAction5) SetInterestAccruedNumberOfDays(value string) {
 	c.InterestAccruedNumberOfDays = (*Max3Number)(&value)
 }
 
+func (c *CorporateAction5) SetInterestAccruedNumberOfDaysFromDecimal(value decimal.Decimal) {
+	c.InterestAccruedNumberOfDays = new(Max3Number)
+	c.InterestAccruedNumberOfDays.FromDecimal(value)
+}
+
 func (c *CorporateAction5) AddCouponNumber() *IdentificationFormat1Choice {
 	newValue := new(IdentificationFormat1Choice)
 	c.CouponNumber = append(c.CouponNumber, newValue)
@@ -268,18 +281,38 @@ func (c *CorporateAction5) SetCertificationRequiredIndicator(value string) {
 	c.CertificationRequiredIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction5) SetCertificationRequiredIndicatorFromBool(value bool) {
+	c.CertificationRequiredIndicator = new(YesNoIndicator)
+	c.CertificationRequiredIndicator.FromBool(value)
+}
+
 func (c *CorporateAction5) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction5) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateAction5) SetRestrictionIndicator(value string) {
 	c.RestrictionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction5) SetRestrictionIndicatorFromBool(value bool) {
+	c.RestrictionIndicator = new(YesNoIndicator)
+	c.RestrictionIndicator.FromBool(value)
+}
+
 func (c *CorporateAction5) SetAccruedInterestIndicator(value string) {
 	c.AccruedInterestIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction5) SetAccruedInterestIndicatorFromBool(value bool) {
+	c.AccruedInterestIndicator = new(YesNoIndicator)
+	c.AccruedInterestIndicator.FromBool(value)
+}
+
 func (c *CorporateAction5) AddDividendType() *DividendTypeFormat3Choice {
 	c.DividendType = new(DividendTypeFormat3Choice)
 	return c.DividendType
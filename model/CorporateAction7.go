@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the corporate action event.
 type CorporateAction7 struct {
 
@@ -89,6 +93,10 @@ type CorporateAction7 struct {
 	AdditionalInformation *CorporateActionNarrative3 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateAction7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction7) AddDateDetails() *CorporateActionDate22 {
 	c.DateDetails = new(CorporateActionDate22)
 	return c.DateDetails
@@ -118,6 +126,11 @@ func (c *CorporateAction7) SetInterestAccruedNumberOfDays(value string) {
 	c.InterestAccruedNumberOfDays = (*Max3Number)(&value)
 }
 
+func (c *CorporateAction7) SetInterestAccruedNumberOfDaysFromDecimal(value decimal.Decimal) {
+	c.InterestAccruedNumberOfDays = new(Max3Number)
+	c.InterestAccruedNumberOfDays.FromDecimal(value)
+}
+
 func (c *CorporateAction7) AddCouponNumber() *IdentificationFormat1Choice {
 	newValue := new(IdentificationFormat1Choice)
 	c.CouponNumber = append(c.CouponNumber, newValue)
@@ -268,18 +281,38 @@ func (c *CorporateAction7) SetCertificationBreakdownIndicator(value string) {
 	c.CertificationBreakdownIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction7) SetCertificationBreakdownIndicatorFromBool(value bool) {
+	c.CertificationBreakdownIndicator = new(YesNoIndicator)
+	c.CertificationBreakdownIndicator.FromBool(value)
+}
+
 func (c *CorporateAction7) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction7) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateAction7) SetRestrictionIndicator(value string) {
 	c.RestrictionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction7) SetRestrictionIndicatorFromBool(value bool) {
+	c.RestrictionIndicator = new(YesNoIndicator)
+	c.RestrictionIndicator.FromBool(value)
+}
+
 func (c *CorporateAction7) SetAccruedInterestIndicator(value string) {
 	c.AccruedInterestIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateAction7) SetAccruedInterestIndicatorFromBool(value bool) {
+	c.AccruedInterestIndicator = new(YesNoIndicator)
+	c.AccruedInterestIndicator.FromBool(value)
+}
+
 func (c *CorporateAction7) AddDividendType() *DividendTypeFormat3Choice {
 	c.DividendType = new(DividendTypeFormat3Choice)
 	return c.DividendType
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // An event determined by a corporation's board of directors, that changes the existing corporate capital structure or financial condition.
 type CorporateAction9 struct {
 
@@ -10,6 +12,10 @@ type CorporateAction9 struct {
 	EventIdentification *Max35Text `xml:"EvtId"`
 }
 
+func (c *CorporateAction9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateAction9) SetEventType(value string) {
 	c.EventType = (*Max35Text)(&value)
 }
@@ -17,3 +23,44 @@ func (c *CorporateAction9) SetEventType(value string) {
 func (c *CorporateAction9) SetEventIdentification(value string) {
 	c.EventIdentification = (*Max35Text)(&value)
 }
+
+func (c *CorporateAction9) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.EventType != nil {
+		enc.Element("EvtTp", string(*c.EventType))
+	}
+	if c.EventIdentification != nil {
+		enc.Element("EvtId", string(*c.EventIdentification))
+	}
+	enc.End(tag)
+}
+
+func (c *CorporateAction9) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "EvtTp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.EventType = (*Max35Text)(&value)
+		case "EvtId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.EventIdentification = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
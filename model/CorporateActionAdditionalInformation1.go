@@ -27,6 +27,10 @@ type CorporateActionAdditionalInformation1 struct {
 	AdditionalInstruction *Max350Text `xml:"AddtlInstr,omitempty"`
 }
 
+func (c *CorporateActionAdditionalInformation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionAdditionalInformation1) AddBeneficialOwnerDetails() *BeneficialOwner1 {
 	newValue := new(BeneficialOwner1)
 	c.BeneficialOwnerDetails = append(c.BeneficialOwnerDetails, newValue)
@@ -186,6 +190,11 @@ func (c *CorporateActionAdditionalInformation1) SetCertificationIndicator(value
 	c.CertificationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionAdditionalInformation1) SetCertificationIndicatorFromBool(value bool) {
+	c.CertificationIndicator = new(YesNoIndicator)
+	c.CertificationIndicator.FromBool(value)
+}
+
 func (c *CorporateActionAdditionalInformation1) AddCertificationType() *BeneficiaryCertificationType1FormatChoice {
 	c.CertificationType = new(BeneficiaryCertificationType1FormatChoice)
 	return c.CertificationType
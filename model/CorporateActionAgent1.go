@@ -13,6 +13,10 @@ type CorporateActionAgent1 struct {
 	ContactPerson *NameAndAddress5 `xml:"CtctPrsn,omitempty"`
 }
 
+func (c *CorporateActionAgent1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionAgent1) AddAgentIdentification() *PartyIdentification2Choice {
 	c.AgentIdentification = new(PartyIdentification2Choice)
 	return c.AgentIdentification
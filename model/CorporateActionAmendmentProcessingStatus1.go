@@ -10,6 +10,10 @@ type CorporateActionAmendmentProcessingStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionAmendmentProcessingStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionAmendmentProcessingStatus1) AddStatus() *ProcessedStatus5FormatChoice {
 	c.Status = new(ProcessedStatus5FormatChoice)
 	return c.Status
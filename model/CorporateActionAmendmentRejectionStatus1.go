@@ -10,6 +10,10 @@ type CorporateActionAmendmentRejectionStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionAmendmentRejectionStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionAmendmentRejectionStatus1) AddReason() *RejectionReason8FormatChoice {
 	newValue := new(RejectionReason8FormatChoice)
 	c.Reason = append(c.Reason, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies amounts in the framework of a corporate action event..
 type CorporateActionAmounts1 struct {
 
@@ -154,202 +158,406 @@ type CorporateActionAmounts1 struct {
 	ChargesAmount *ActiveCurrencyAndAmount `xml:"ChrgsAmt,omitempty"`
 }
 
+func (c *CorporateActionAmounts1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionAmounts1) SetGrossCashAmount(value, currency string) {
 	c.GrossCashAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetGrossCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.GrossCashAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetNetCashAmount(value, currency string) {
 	c.NetCashAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetNetCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NetCashAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetIssueDiscountAmount(value, currency string) {
 	c.IssueDiscountAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetIssueDiscountAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.IssueDiscountAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetSolicitationFees(value, currency string) {
 	c.SolicitationFees = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetSolicitationFeesFromDecimal(value decimal.Decimal, currency string) {
+	c.SolicitationFees = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetCashInLieuOfShare(value, currency string) {
 	c.CashInLieuOfShare = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetCashInLieuOfShareFromDecimal(value decimal.Decimal, currency string) {
+	c.CashInLieuOfShare = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetOriginalAmount(value, currency string) {
 	c.OriginalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetOriginalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.OriginalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetCapitalGain(value, currency string) {
 	c.CapitalGain = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetCapitalGainFromDecimal(value decimal.Decimal, currency string) {
+	c.CapitalGain = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetInterestAmount(value, currency string) {
 	c.InterestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetIndemnityAmount(value, currency string) {
 	c.IndemnityAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetIndemnityAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.IndemnityAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetRedemptionPremiumAmount(value, currency string) {
 	c.RedemptionPremiumAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetRedemptionPremiumAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RedemptionPremiumAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetManufacturedDividendAmount(value, currency string) {
 	c.ManufacturedDividendAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetManufacturedDividendAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ManufacturedDividendAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetPrincipalOrCorpus(value, currency string) {
 	c.PrincipalOrCorpus = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetPrincipalOrCorpusFromDecimal(value decimal.Decimal, currency string) {
+	c.PrincipalOrCorpus = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetReinvestmentAmount(value, currency string) {
 	c.ReinvestmentAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetReinvestmentAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ReinvestmentAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetMarketClaimAmount(value, currency string) {
 	c.MarketClaimAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetMarketClaimAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.MarketClaimAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetFullyFrankedAmount(value, currency string) {
 	c.FullyFrankedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetFullyFrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FullyFrankedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetUnfrankedAmount(value, currency string) {
 	c.UnfrankedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetUnfrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.UnfrankedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetSundryOrOtherAmount(value, currency string) {
 	c.SundryOrOtherAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetSundryOrOtherAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SundryOrOtherAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetSpecialConcessionAmount(value, currency string) {
 	c.SpecialConcessionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetSpecialConcessionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SpecialConcessionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetEntitledAmount(value, currency string) {
 	c.EntitledAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetEntitledAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.EntitledAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetCashIncentive(value, currency string) {
 	c.CashIncentive = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetCashIncentiveFromDecimal(value decimal.Decimal, currency string) {
+	c.CashIncentive = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetAdditionalSubscriptionCost(value, currency string) {
 	c.AdditionalSubscriptionCost = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetAdditionalSubscriptionCostFromDecimal(value decimal.Decimal, currency string) {
+	c.AdditionalSubscriptionCost = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetTaxFreeAmount(value, currency string) {
 	c.TaxFreeAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetTaxFreeAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxFreeAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetTaxDeferredAmount(value, currency string) {
 	c.TaxDeferredAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetTaxDeferredAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxDeferredAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetGermanLocalTax1Amount(value, currency string) {
 	c.GermanLocalTax1Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetGermanLocalTax1AmountFromDecimal(value decimal.Decimal, currency string) {
+	c.GermanLocalTax1Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetGermanLocalTax2Amount(value, currency string) {
 	c.GermanLocalTax2Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetGermanLocalTax2AmountFromDecimal(value decimal.Decimal, currency string) {
+	c.GermanLocalTax2Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetGermanLocalTax3Amount(value, currency string) {
 	c.GermanLocalTax3Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetGermanLocalTax3AmountFromDecimal(value decimal.Decimal, currency string) {
+	c.GermanLocalTax3Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetGermanLocalTax4Amount(value, currency string) {
 	c.GermanLocalTax4Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetGermanLocalTax4AmountFromDecimal(value decimal.Decimal, currency string) {
+	c.GermanLocalTax4Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetStockExchangeTaxAmount(value, currency string) {
 	c.StockExchangeTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetStockExchangeTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.StockExchangeTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetTransferTaxAmount(value, currency string) {
 	c.TransferTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetTransferTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TransferTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetTransactionTaxAmount(value, currency string) {
 	c.TransactionTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetTransactionTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TransactionTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetValueAddedTaxAmount(value, currency string) {
 	c.ValueAddedTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetValueAddedTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ValueAddedTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetEURetentionTaxAmount(value, currency string) {
 	c.EURetentionTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetEURetentionTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.EURetentionTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetLocalTaxAmount(value, currency string) {
 	c.LocalTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetLocalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.LocalTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetPaymentLevyTaxAmount(value, currency string) {
 	c.PaymentLevyTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetPaymentLevyTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PaymentLevyTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetCountryNationalFederalTaxAmount(value, currency string) {
 	c.CountryNationalFederalTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetCountryNationalFederalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.CountryNationalFederalTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetStampDutyAmount(value, currency string) {
 	c.StampDutyAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetStampDutyAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.StampDutyAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetTaxReclaimAmount(value, currency string) {
 	c.TaxReclaimAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetTaxReclaimAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxReclaimAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetTaxCreditAmount(value, currency string) {
 	c.TaxCreditAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetTaxCreditAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxCreditAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetWithholdingOfForeignTaxAmount(value, currency string) {
 	c.WithholdingOfForeignTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetWithholdingOfForeignTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingOfForeignTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetWithholdingOfLocalTaxAmount(value, currency string) {
 	c.WithholdingOfLocalTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetWithholdingOfLocalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingOfLocalTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetAdditionalTaxAmount(value, currency string) {
 	c.AdditionalTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetAdditionalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AdditionalTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetWithholdingTaxAmount(value, currency string) {
 	c.WithholdingTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetWithholdingTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetFiscalStampAmount(value, currency string) {
 	c.FiscalStampAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetFiscalStampAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FiscalStampAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetExecutingBrokerAmount(value, currency string) {
 	c.ExecutingBrokerAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetExecutingBrokerAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ExecutingBrokerAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetPayingAgentCommissionAmount(value, currency string) {
 	c.PayingAgentCommissionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetPayingAgentCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PayingAgentCommissionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetLocalBrokerCommissionAmount(value, currency string) {
 	c.LocalBrokerCommissionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetLocalBrokerCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.LocalBrokerCommissionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetPostageFeeAmount(value, currency string) {
 	c.PostageFeeAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetPostageFeeAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PostageFeeAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetRegulatoryFeesAmount(value, currency string) {
 	c.RegulatoryFeesAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetRegulatoryFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RegulatoryFeesAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetShippingFeesAmount(value, currency string) {
 	c.ShippingFeesAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts1) SetShippingFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ShippingFeesAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts1) SetChargesAmount(value, currency string) {
 	c.ChargesAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CorporateActionAmounts1) SetChargesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ChargesAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
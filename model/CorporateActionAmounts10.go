@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies amounts in the framework of a corporate action event.
 type CorporateActionAmounts10 struct {
 
@@ -121,158 +125,318 @@ type CorporateActionAmounts10 struct {
 	AccruedInterestAmount *ActiveCurrencyAndAmount `xml:"AcrdIntrstAmt,omitempty"`
 }
 
+func (c *CorporateActionAmounts10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionAmounts10) SetGrossCashAmount(value, currency string) {
 	c.GrossCashAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetGrossCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.GrossCashAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetNetCashAmount(value, currency string) {
 	c.NetCashAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetNetCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NetCashAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetSolicitationFees(value, currency string) {
 	c.SolicitationFees = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetSolicitationFeesFromDecimal(value decimal.Decimal, currency string) {
+	c.SolicitationFees = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetCashInLieuOfShare(value, currency string) {
 	c.CashInLieuOfShare = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetCashInLieuOfShareFromDecimal(value decimal.Decimal, currency string) {
+	c.CashInLieuOfShare = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetCapitalGain(value, currency string) {
 	c.CapitalGain = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetCapitalGainFromDecimal(value decimal.Decimal, currency string) {
+	c.CapitalGain = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetInterestAmount(value, currency string) {
 	c.InterestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetIndemnityAmount(value, currency string) {
 	c.IndemnityAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetIndemnityAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.IndemnityAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetManufacturedDividendPaymentAmount(value, currency string) {
 	c.ManufacturedDividendPaymentAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetManufacturedDividendPaymentAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ManufacturedDividendPaymentAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetReinvestmentAmount(value, currency string) {
 	c.ReinvestmentAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetReinvestmentAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ReinvestmentAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetFullyFrankedAmount(value, currency string) {
 	c.FullyFrankedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetFullyFrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FullyFrankedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetUnfrankedAmount(value, currency string) {
 	c.UnfrankedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetUnfrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.UnfrankedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetSundryOrOtherAmount(value, currency string) {
 	c.SundryOrOtherAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetSundryOrOtherAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SundryOrOtherAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetCashIncentive(value, currency string) {
 	c.CashIncentive = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetCashIncentiveFromDecimal(value decimal.Decimal, currency string) {
+	c.CashIncentive = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetTaxFreeAmount(value, currency string) {
 	c.TaxFreeAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetTaxFreeAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxFreeAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetTaxDeferredAmount(value, currency string) {
 	c.TaxDeferredAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetTaxDeferredAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxDeferredAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetValueAddedTaxAmount(value, currency string) {
 	c.ValueAddedTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetValueAddedTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ValueAddedTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetStampDutyAmount(value, currency string) {
 	c.StampDutyAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetStampDutyAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.StampDutyAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetTaxReclaimAmount(value, currency string) {
 	c.TaxReclaimAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetTaxReclaimAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxReclaimAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetTaxCreditAmount(value, currency string) {
 	c.TaxCreditAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetTaxCreditAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxCreditAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetWithholdingOfForeignTaxAmount(value, currency string) {
 	c.WithholdingOfForeignTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetWithholdingOfForeignTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingOfForeignTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetWithholdingOfLocalTaxAmount(value, currency string) {
 	c.WithholdingOfLocalTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetWithholdingOfLocalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingOfLocalTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetAdditionalTaxAmount(value, currency string) {
 	c.AdditionalTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetAdditionalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AdditionalTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetWithholdingTaxAmount(value, currency string) {
 	c.WithholdingTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetWithholdingTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetFiscalStampAmount(value, currency string) {
 	c.FiscalStampAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetFiscalStampAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FiscalStampAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetExecutingBrokerAmount(value, currency string) {
 	c.ExecutingBrokerAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetExecutingBrokerAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ExecutingBrokerAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetPayingAgentCommissionAmount(value, currency string) {
 	c.PayingAgentCommissionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetPayingAgentCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PayingAgentCommissionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetLocalBrokerCommissionAmount(value, currency string) {
 	c.LocalBrokerCommissionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetLocalBrokerCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.LocalBrokerCommissionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetPostageFeeAmount(value, currency string) {
 	c.PostageFeeAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetPostageFeeAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PostageFeeAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetRegulatoryFeesAmount(value, currency string) {
 	c.RegulatoryFeesAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetRegulatoryFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RegulatoryFeesAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetShippingFeesAmount(value, currency string) {
 	c.ShippingFeesAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetShippingFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ShippingFeesAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetChargesAmount(value, currency string) {
 	c.ChargesAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetChargesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ChargesAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetEntitledAmount(value, currency string) {
 	c.EntitledAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetEntitledAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.EntitledAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetOriginalAmount(value, currency string) {
 	c.OriginalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetOriginalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.OriginalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetPrincipalOrCorpus(value, currency string) {
 	c.PrincipalOrCorpus = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetPrincipalOrCorpusFromDecimal(value decimal.Decimal, currency string) {
+	c.PrincipalOrCorpus = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetRedemptionPremiumAmount(value, currency string) {
 	c.RedemptionPremiumAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetRedemptionPremiumAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RedemptionPremiumAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetIncomePortion(value, currency string) {
 	c.IncomePortion = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetIncomePortionFromDecimal(value decimal.Decimal, currency string) {
+	c.IncomePortion = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetStockExchangeTax(value, currency string) {
 	c.StockExchangeTax = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetStockExchangeTaxFromDecimal(value decimal.Decimal, currency string) {
+	c.StockExchangeTax = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetEUTaxRetentionAmount(value, currency string) {
 	c.EUTaxRetentionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts10) SetEUTaxRetentionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.EUTaxRetentionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts10) SetAccruedInterestAmount(value, currency string) {
 	c.AccruedInterestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CorporateActionAmounts10) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AccruedInterestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies amounts in the framework of a corporate action event.
 type CorporateActionAmounts11 struct {
 
@@ -139,182 +143,366 @@ type CorporateActionAmounts11 struct {
 	AccruedInterestAmount *ActiveCurrencyAndAmount `xml:"AcrdIntrstAmt,omitempty"`
 }
 
+func (c *CorporateActionAmounts11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionAmounts11) SetPostingAmount(value, currency string) {
 	c.PostingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetPostingAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PostingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetGrossCashAmount(value, currency string) {
 	c.GrossCashAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetGrossCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.GrossCashAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetNetCashAmount(value, currency string) {
 	c.NetCashAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetNetCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NetCashAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetSolicitationFees(value, currency string) {
 	c.SolicitationFees = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetSolicitationFeesFromDecimal(value decimal.Decimal, currency string) {
+	c.SolicitationFees = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetCashInLieuOfShare(value, currency string) {
 	c.CashInLieuOfShare = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetCashInLieuOfShareFromDecimal(value decimal.Decimal, currency string) {
+	c.CashInLieuOfShare = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetCapitalGain(value, currency string) {
 	c.CapitalGain = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetCapitalGainFromDecimal(value decimal.Decimal, currency string) {
+	c.CapitalGain = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetInterestAmount(value, currency string) {
 	c.InterestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetMarketClaimAmount(value, currency string) {
 	c.MarketClaimAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetMarketClaimAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.MarketClaimAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetIndemnityAmount(value, currency string) {
 	c.IndemnityAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetIndemnityAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.IndemnityAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetManufacturedDividendPaymentAmount(value, currency string) {
 	c.ManufacturedDividendPaymentAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetManufacturedDividendPaymentAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ManufacturedDividendPaymentAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetReinvestmentAmount(value, currency string) {
 	c.ReinvestmentAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetReinvestmentAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ReinvestmentAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetFullyFrankedAmount(value, currency string) {
 	c.FullyFrankedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetFullyFrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FullyFrankedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetUnfrankedAmount(value, currency string) {
 	c.UnfrankedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetUnfrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.UnfrankedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetSundryOrOtherAmount(value, currency string) {
 	c.SundryOrOtherAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetSundryOrOtherAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SundryOrOtherAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetCashIncentive(value, currency string) {
 	c.CashIncentive = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetCashIncentiveFromDecimal(value decimal.Decimal, currency string) {
+	c.CashIncentive = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetTaxFreeAmount(value, currency string) {
 	c.TaxFreeAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetTaxFreeAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxFreeAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetTaxDeferredAmount(value, currency string) {
 	c.TaxDeferredAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetTaxDeferredAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxDeferredAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetValueAddedTaxAmount(value, currency string) {
 	c.ValueAddedTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetValueAddedTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ValueAddedTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetStampDutyAmount(value, currency string) {
 	c.StampDutyAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetStampDutyAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.StampDutyAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetTaxReclaimAmount(value, currency string) {
 	c.TaxReclaimAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetTaxReclaimAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxReclaimAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetTaxCreditAmount(value, currency string) {
 	c.TaxCreditAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetTaxCreditAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxCreditAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetWithholdingOfForeignTaxAmount(value, currency string) {
 	c.WithholdingOfForeignTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetWithholdingOfForeignTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingOfForeignTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetWithholdingOfLocalTaxAmount(value, currency string) {
 	c.WithholdingOfLocalTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetWithholdingOfLocalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingOfLocalTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetAdditionalTaxAmount(value, currency string) {
 	c.AdditionalTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetAdditionalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AdditionalTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetWithholdingTaxAmount(value, currency string) {
 	c.WithholdingTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetWithholdingTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetFiscalStampAmount(value, currency string) {
 	c.FiscalStampAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetFiscalStampAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FiscalStampAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetExecutingBrokerAmount(value, currency string) {
 	c.ExecutingBrokerAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetExecutingBrokerAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ExecutingBrokerAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetPayingAgentCommissionAmount(value, currency string) {
 	c.PayingAgentCommissionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetPayingAgentCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PayingAgentCommissionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetLocalBrokerCommissionAmount(value, currency string) {
 	c.LocalBrokerCommissionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetLocalBrokerCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.LocalBrokerCommissionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetPostageFeeAmount(value, currency string) {
 	c.PostageFeeAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetPostageFeeAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PostageFeeAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetRegulatoryFeesAmount(value, currency string) {
 	c.RegulatoryFeesAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetRegulatoryFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RegulatoryFeesAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetShippingFeesAmount(value, currency string) {
 	c.ShippingFeesAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetShippingFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ShippingFeesAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetChargesAmount(value, currency string) {
 	c.ChargesAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetChargesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ChargesAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetCashAmountBroughtForward(value, currency string) {
 	c.CashAmountBroughtForward = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetCashAmountBroughtForwardFromDecimal(value decimal.Decimal, currency string) {
+	c.CashAmountBroughtForward = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetCashAmountCarriedForward(value, currency string) {
 	c.CashAmountCarriedForward = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetCashAmountCarriedForwardFromDecimal(value decimal.Decimal, currency string) {
+	c.CashAmountCarriedForward = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetNotionalDividendPayableAmount(value, currency string) {
 	c.NotionalDividendPayableAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetNotionalDividendPayableAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NotionalDividendPayableAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetNotionalTaxAmount(value, currency string) {
 	c.NotionalTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetNotionalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NotionalTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetTaxArrearsAmount(value, currency string) {
 	c.TaxArrearsAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetTaxArrearsAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxArrearsAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetOriginalAmount(value, currency string) {
 	c.OriginalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetOriginalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.OriginalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetPrincipalOrCorpus(value, currency string) {
 	c.PrincipalOrCorpus = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetPrincipalOrCorpusFromDecimal(value decimal.Decimal, currency string) {
+	c.PrincipalOrCorpus = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetRedemptionPremiumAmount(value, currency string) {
 	c.RedemptionPremiumAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetRedemptionPremiumAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RedemptionPremiumAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetIncomePortion(value, currency string) {
 	c.IncomePortion = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetIncomePortionFromDecimal(value decimal.Decimal, currency string) {
+	c.IncomePortion = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetStockExchangeTax(value, currency string) {
 	c.StockExchangeTax = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetStockExchangeTaxFromDecimal(value decimal.Decimal, currency string) {
+	c.StockExchangeTax = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetEUTaxRetentionAmount(value, currency string) {
 	c.EUTaxRetentionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts11) SetEUTaxRetentionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.EUTaxRetentionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts11) SetAccruedInterestAmount(value, currency string) {
 	c.AccruedInterestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CorporateActionAmounts11) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AccruedInterestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
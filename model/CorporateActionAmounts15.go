@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies amounts in the framework of a corporate action event.
 type CorporateActionAmounts15 struct {
 
@@ -118,154 +122,310 @@ type CorporateActionAmounts15 struct {
 	AccruedInterestAmount *ActiveCurrencyAndAmount `xml:"AcrdIntrstAmt,omitempty"`
 }
 
+func (c *CorporateActionAmounts15) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionAmounts15) SetGrossCashAmount(value, currency string) {
 	c.GrossCashAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetGrossCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.GrossCashAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetNetCashAmount(value, currency string) {
 	c.NetCashAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetNetCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NetCashAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetSolicitationFees(value, currency string) {
 	c.SolicitationFees = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetSolicitationFeesFromDecimal(value decimal.Decimal, currency string) {
+	c.SolicitationFees = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetCashInLieuOfShare(value, currency string) {
 	c.CashInLieuOfShare = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetCashInLieuOfShareFromDecimal(value decimal.Decimal, currency string) {
+	c.CashInLieuOfShare = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetCapitalGain(value, currency string) {
 	c.CapitalGain = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetCapitalGainFromDecimal(value decimal.Decimal, currency string) {
+	c.CapitalGain = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetInterestAmount(value, currency string) {
 	c.InterestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetIndemnityAmount(value, currency string) {
 	c.IndemnityAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetIndemnityAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.IndemnityAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetManufacturedDividendPaymentAmount(value, currency string) {
 	c.ManufacturedDividendPaymentAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetManufacturedDividendPaymentAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ManufacturedDividendPaymentAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetReinvestmentAmount(value, currency string) {
 	c.ReinvestmentAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetReinvestmentAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ReinvestmentAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetFullyFrankedAmount(value, currency string) {
 	c.FullyFrankedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetFullyFrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FullyFrankedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetUnfrankedAmount(value, currency string) {
 	c.UnfrankedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetUnfrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.UnfrankedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetSundryOrOtherAmount(value, currency string) {
 	c.SundryOrOtherAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetSundryOrOtherAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SundryOrOtherAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetCashIncentive(value, currency string) {
 	c.CashIncentive = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetCashIncentiveFromDecimal(value decimal.Decimal, currency string) {
+	c.CashIncentive = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetTaxFreeAmount(value, currency string) {
 	c.TaxFreeAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetTaxFreeAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxFreeAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetTaxDeferredAmount(value, currency string) {
 	c.TaxDeferredAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetTaxDeferredAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxDeferredAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetValueAddedTaxAmount(value, currency string) {
 	c.ValueAddedTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetValueAddedTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ValueAddedTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetStampDutyAmount(value, currency string) {
 	c.StampDutyAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetStampDutyAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.StampDutyAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetTaxReclaimAmount(value, currency string) {
 	c.TaxReclaimAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetTaxReclaimAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxReclaimAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetTaxCreditAmount(value, currency string) {
 	c.TaxCreditAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetTaxCreditAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxCreditAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetWithholdingOfForeignTaxAmount(value, currency string) {
 	c.WithholdingOfForeignTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetWithholdingOfForeignTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingOfForeignTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetWithholdingOfLocalTaxAmount(value, currency string) {
 	c.WithholdingOfLocalTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetWithholdingOfLocalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingOfLocalTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetAdditionalTaxAmount(value, currency string) {
 	c.AdditionalTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetAdditionalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AdditionalTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetWithholdingTaxAmount(value, currency string) {
 	c.WithholdingTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetWithholdingTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetFiscalStampAmount(value, currency string) {
 	c.FiscalStampAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetFiscalStampAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FiscalStampAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetExecutingBrokerAmount(value, currency string) {
 	c.ExecutingBrokerAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetExecutingBrokerAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ExecutingBrokerAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetPayingAgentCommissionAmount(value, currency string) {
 	c.PayingAgentCommissionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetPayingAgentCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PayingAgentCommissionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetLocalBrokerCommissionAmount(value, currency string) {
 	c.LocalBrokerCommissionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetLocalBrokerCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.LocalBrokerCommissionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetRegulatoryFeesAmount(value, currency string) {
 	c.RegulatoryFeesAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetRegulatoryFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RegulatoryFeesAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetShippingFeesAmount(value, currency string) {
 	c.ShippingFeesAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetShippingFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ShippingFeesAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetChargesAmount(value, currency string) {
 	c.ChargesAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetChargesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ChargesAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetEntitledAmount(value, currency string) {
 	c.EntitledAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetEntitledAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.EntitledAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetOriginalAmount(value, currency string) {
 	c.OriginalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetOriginalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.OriginalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetPrincipalOrCorpus(value, currency string) {
 	c.PrincipalOrCorpus = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetPrincipalOrCorpusFromDecimal(value decimal.Decimal, currency string) {
+	c.PrincipalOrCorpus = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetRedemptionPremiumAmount(value, currency string) {
 	c.RedemptionPremiumAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetRedemptionPremiumAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RedemptionPremiumAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetIncomePortion(value, currency string) {
 	c.IncomePortion = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetIncomePortionFromDecimal(value decimal.Decimal, currency string) {
+	c.IncomePortion = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetStockExchangeTax(value, currency string) {
 	c.StockExchangeTax = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetStockExchangeTaxFromDecimal(value decimal.Decimal, currency string) {
+	c.StockExchangeTax = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetEUTaxRetentionAmount(value, currency string) {
 	c.EUTaxRetentionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts15) SetEUTaxRetentionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.EUTaxRetentionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts15) SetAccruedInterestAmount(value, currency string) {
 	c.AccruedInterestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CorporateActionAmounts15) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AccruedInterestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies amounts in the framework of a corporate action event.
 type CorporateActionAmounts16 struct {
 
@@ -109,142 +113,286 @@ type CorporateActionAmounts16 struct {
 	IncomePortion *ActiveCurrencyAndAmount `xml:"IncmPrtn,omitempty"`
 }
 
+func (c *CorporateActionAmounts16) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionAmounts16) SetGrossCashAmount(value, currency string) {
 	c.GrossCashAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetGrossCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.GrossCashAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetNetCashAmount(value, currency string) {
 	c.NetCashAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetNetCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NetCashAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetSolicitationFees(value, currency string) {
 	c.SolicitationFees = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetSolicitationFeesFromDecimal(value decimal.Decimal, currency string) {
+	c.SolicitationFees = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetCashInLieuOfShare(value, currency string) {
 	c.CashInLieuOfShare = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetCashInLieuOfShareFromDecimal(value decimal.Decimal, currency string) {
+	c.CashInLieuOfShare = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetCapitalGain(value, currency string) {
 	c.CapitalGain = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetCapitalGainFromDecimal(value decimal.Decimal, currency string) {
+	c.CapitalGain = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetInterestAmount(value, currency string) {
 	c.InterestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetMarketClaimAmount(value, currency string) {
 	c.MarketClaimAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetMarketClaimAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.MarketClaimAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetIndemnityAmount(value, currency string) {
 	c.IndemnityAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetIndemnityAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.IndemnityAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetManufacturedDividendPaymentAmount(value, currency string) {
 	c.ManufacturedDividendPaymentAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetManufacturedDividendPaymentAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ManufacturedDividendPaymentAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetReinvestmentAmount(value, currency string) {
 	c.ReinvestmentAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetReinvestmentAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ReinvestmentAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetFullyFrankedAmount(value, currency string) {
 	c.FullyFrankedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetFullyFrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FullyFrankedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetUnfrankedAmount(value, currency string) {
 	c.UnfrankedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetUnfrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.UnfrankedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetSundryOrOtherAmount(value, currency string) {
 	c.SundryOrOtherAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetSundryOrOtherAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SundryOrOtherAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetCashIncentive(value, currency string) {
 	c.CashIncentive = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetCashIncentiveFromDecimal(value decimal.Decimal, currency string) {
+	c.CashIncentive = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetTaxFreeAmount(value, currency string) {
 	c.TaxFreeAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetTaxFreeAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxFreeAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetTaxDeferredAmount(value, currency string) {
 	c.TaxDeferredAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetTaxDeferredAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxDeferredAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetValueAddedTaxAmount(value, currency string) {
 	c.ValueAddedTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetValueAddedTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ValueAddedTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetStampDutyAmount(value, currency string) {
 	c.StampDutyAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetStampDutyAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.StampDutyAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetTaxReclaimAmount(value, currency string) {
 	c.TaxReclaimAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetTaxReclaimAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxReclaimAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetTaxCreditAmount(value, currency string) {
 	c.TaxCreditAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetTaxCreditAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxCreditAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetWithholdingOfForeignTaxAmount(value, currency string) {
 	c.WithholdingOfForeignTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetWithholdingOfForeignTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingOfForeignTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetWithholdingOfLocalTaxAmount(value, currency string) {
 	c.WithholdingOfLocalTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetWithholdingOfLocalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingOfLocalTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetAdditionalTaxAmount(value, currency string) {
 	c.AdditionalTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetAdditionalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AdditionalTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetWithholdingTaxAmount(value, currency string) {
 	c.WithholdingTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetWithholdingTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetFiscalStampAmount(value, currency string) {
 	c.FiscalStampAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetFiscalStampAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FiscalStampAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetExecutingBrokerAmount(value, currency string) {
 	c.ExecutingBrokerAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetExecutingBrokerAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ExecutingBrokerAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetPayingAgentCommissionAmount(value, currency string) {
 	c.PayingAgentCommissionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetPayingAgentCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PayingAgentCommissionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetLocalBrokerCommissionAmount(value, currency string) {
 	c.LocalBrokerCommissionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetLocalBrokerCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.LocalBrokerCommissionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetRegulatoryFeesAmount(value, currency string) {
 	c.RegulatoryFeesAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetRegulatoryFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RegulatoryFeesAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetShippingFeesAmount(value, currency string) {
 	c.ShippingFeesAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetShippingFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ShippingFeesAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetChargesAmount(value, currency string) {
 	c.ChargesAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetChargesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ChargesAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetEntitledAmount(value, currency string) {
 	c.EntitledAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetEntitledAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.EntitledAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetOriginalAmount(value, currency string) {
 	c.OriginalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetOriginalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.OriginalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetAccruedInterestAmount(value, currency string) {
 	c.AccruedInterestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts16) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AccruedInterestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts16) SetIncomePortion(value, currency string) {
 	c.IncomePortion = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CorporateActionAmounts16) SetIncomePortionFromDecimal(value decimal.Decimal, currency string) {
+	c.IncomePortion = NewActiveCurrencyAndAmount(value.String(), currency)
+}
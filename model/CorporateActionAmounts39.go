@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies amounts in the framework of a corporate action event.
 type CorporateActionAmounts39 struct {
 
@@ -148,194 +152,390 @@ type CorporateActionAmounts39 struct {
 	TransactionTax *RestrictedFINActiveCurrencyAndAmount `xml:"TxTax,omitempty"`
 }
 
+func (c *CorporateActionAmounts39) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionAmounts39) SetPostingAmount(value, currency string) {
 	c.PostingAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetPostingAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PostingAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetGrossCashAmount(value, currency string) {
 	c.GrossCashAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetGrossCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.GrossCashAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetNetCashAmount(value, currency string) {
 	c.NetCashAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetNetCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NetCashAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetSolicitationFees(value, currency string) {
 	c.SolicitationFees = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetSolicitationFeesFromDecimal(value decimal.Decimal, currency string) {
+	c.SolicitationFees = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetCashInLieuOfShare(value, currency string) {
 	c.CashInLieuOfShare = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetCashInLieuOfShareFromDecimal(value decimal.Decimal, currency string) {
+	c.CashInLieuOfShare = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetCapitalGain(value, currency string) {
 	c.CapitalGain = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetCapitalGainFromDecimal(value decimal.Decimal, currency string) {
+	c.CapitalGain = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetInterestAmount(value, currency string) {
 	c.InterestAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterestAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetMarketClaimAmount(value, currency string) {
 	c.MarketClaimAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetMarketClaimAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.MarketClaimAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetIndemnityAmount(value, currency string) {
 	c.IndemnityAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetIndemnityAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.IndemnityAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetManufacturedDividendPaymentAmount(value, currency string) {
 	c.ManufacturedDividendPaymentAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetManufacturedDividendPaymentAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ManufacturedDividendPaymentAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetReinvestmentAmount(value, currency string) {
 	c.ReinvestmentAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetReinvestmentAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ReinvestmentAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetFullyFrankedAmount(value, currency string) {
 	c.FullyFrankedAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetFullyFrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FullyFrankedAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetUnfrankedAmount(value, currency string) {
 	c.UnfrankedAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetUnfrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.UnfrankedAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetSundryOrOtherAmount(value, currency string) {
 	c.SundryOrOtherAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetSundryOrOtherAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SundryOrOtherAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetTaxFreeAmount(value, currency string) {
 	c.TaxFreeAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetTaxFreeAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxFreeAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetTaxDeferredAmount(value, currency string) {
 	c.TaxDeferredAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetTaxDeferredAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxDeferredAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetValueAddedTaxAmount(value, currency string) {
 	c.ValueAddedTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetValueAddedTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ValueAddedTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetStampDutyAmount(value, currency string) {
 	c.StampDutyAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetStampDutyAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.StampDutyAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetTaxReclaimAmount(value, currency string) {
 	c.TaxReclaimAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetTaxReclaimAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxReclaimAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetTaxCreditAmount(value, currency string) {
 	c.TaxCreditAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetTaxCreditAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxCreditAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetAdditionalTaxAmount(value, currency string) {
 	c.AdditionalTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetAdditionalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AdditionalTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetWithholdingTaxAmount(value, currency string) {
 	c.WithholdingTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetWithholdingTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetSecondLevelTaxAmount(value, currency string) {
 	c.SecondLevelTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetSecondLevelTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SecondLevelTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetFiscalStampAmount(value, currency string) {
 	c.FiscalStampAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetFiscalStampAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FiscalStampAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetExecutingBrokerAmount(value, currency string) {
 	c.ExecutingBrokerAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetExecutingBrokerAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ExecutingBrokerAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetPayingAgentCommissionAmount(value, currency string) {
 	c.PayingAgentCommissionAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetPayingAgentCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PayingAgentCommissionAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetLocalBrokerCommissionAmount(value, currency string) {
 	c.LocalBrokerCommissionAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetLocalBrokerCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.LocalBrokerCommissionAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetRegulatoryFeesAmount(value, currency string) {
 	c.RegulatoryFeesAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetRegulatoryFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RegulatoryFeesAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetShippingFeesAmount(value, currency string) {
 	c.ShippingFeesAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetShippingFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ShippingFeesAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetChargesAmount(value, currency string) {
 	c.ChargesAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetChargesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ChargesAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetCashAmountBroughtForward(value, currency string) {
 	c.CashAmountBroughtForward = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetCashAmountBroughtForwardFromDecimal(value decimal.Decimal, currency string) {
+	c.CashAmountBroughtForward = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetCashAmountCarriedForward(value, currency string) {
 	c.CashAmountCarriedForward = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetCashAmountCarriedForwardFromDecimal(value decimal.Decimal, currency string) {
+	c.CashAmountCarriedForward = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetNotionalDividendPayableAmount(value, currency string) {
 	c.NotionalDividendPayableAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetNotionalDividendPayableAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NotionalDividendPayableAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetNotionalTaxAmount(value, currency string) {
 	c.NotionalTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetNotionalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NotionalTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetTaxArrearsAmount(value, currency string) {
 	c.TaxArrearsAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetTaxArrearsAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxArrearsAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetOriginalAmount(value, currency string) {
 	c.OriginalAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetOriginalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.OriginalAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetPrincipalOrCorpus(value, currency string) {
 	c.PrincipalOrCorpus = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetPrincipalOrCorpusFromDecimal(value decimal.Decimal, currency string) {
+	c.PrincipalOrCorpus = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetRedemptionPremiumAmount(value, currency string) {
 	c.RedemptionPremiumAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetRedemptionPremiumAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RedemptionPremiumAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetIncomePortion(value, currency string) {
 	c.IncomePortion = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetIncomePortionFromDecimal(value decimal.Decimal, currency string) {
+	c.IncomePortion = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetStockExchangeTax(value, currency string) {
 	c.StockExchangeTax = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetStockExchangeTaxFromDecimal(value decimal.Decimal, currency string) {
+	c.StockExchangeTax = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetEUTaxRetentionAmount(value, currency string) {
 	c.EUTaxRetentionAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetEUTaxRetentionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.EUTaxRetentionAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetAccruedInterestAmount(value, currency string) {
 	c.AccruedInterestAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AccruedInterestAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetEqualisationAmount(value, currency string) {
 	c.EqualisationAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetEqualisationAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.EqualisationAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetFATCATaxAmount(value, currency string) {
 	c.FATCATaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetFATCATaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FATCATaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetNRATaxAmount(value, currency string) {
 	c.NRATaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetNRATaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NRATaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetBackUpWithholdingTaxAmount(value, currency string) {
 	c.BackUpWithholdingTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetBackUpWithholdingTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.BackUpWithholdingTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetTaxOnIncomeAmount(value, currency string) {
 	c.TaxOnIncomeAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts39) SetTaxOnIncomeAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxOnIncomeAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts39) SetTransactionTax(value, currency string) {
 	c.TransactionTax = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CorporateActionAmounts39) SetTransactionTaxFromDecimal(value decimal.Decimal, currency string) {
+	c.TransactionTax = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies amounts in the framework of a corporate action event.
 type CorporateActionAmounts40 struct {
 
@@ -121,158 +125,318 @@ type CorporateActionAmounts40 struct {
 	TransactionTax *RestrictedFINActiveCurrencyAndAmount `xml:"TxTax,omitempty"`
 }
 
+func (c *CorporateActionAmounts40) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionAmounts40) SetGrossCashAmount(value, currency string) {
 	c.GrossCashAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetGrossCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.GrossCashAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetNetCashAmount(value, currency string) {
 	c.NetCashAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetNetCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NetCashAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetSolicitationFees(value, currency string) {
 	c.SolicitationFees = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetSolicitationFeesFromDecimal(value decimal.Decimal, currency string) {
+	c.SolicitationFees = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetCashInLieuOfShare(value, currency string) {
 	c.CashInLieuOfShare = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetCashInLieuOfShareFromDecimal(value decimal.Decimal, currency string) {
+	c.CashInLieuOfShare = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetCapitalGain(value, currency string) {
 	c.CapitalGain = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetCapitalGainFromDecimal(value decimal.Decimal, currency string) {
+	c.CapitalGain = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetInterestAmount(value, currency string) {
 	c.InterestAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterestAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetMarketClaimAmount(value, currency string) {
 	c.MarketClaimAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetMarketClaimAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.MarketClaimAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetIndemnityAmount(value, currency string) {
 	c.IndemnityAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetIndemnityAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.IndemnityAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetManufacturedDividendPaymentAmount(value, currency string) {
 	c.ManufacturedDividendPaymentAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetManufacturedDividendPaymentAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ManufacturedDividendPaymentAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetReinvestmentAmount(value, currency string) {
 	c.ReinvestmentAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetReinvestmentAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ReinvestmentAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetFullyFrankedAmount(value, currency string) {
 	c.FullyFrankedAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetFullyFrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FullyFrankedAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetUnfrankedAmount(value, currency string) {
 	c.UnfrankedAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetUnfrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.UnfrankedAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetSundryOrOtherAmount(value, currency string) {
 	c.SundryOrOtherAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetSundryOrOtherAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SundryOrOtherAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetTaxFreeAmount(value, currency string) {
 	c.TaxFreeAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetTaxFreeAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxFreeAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetTaxDeferredAmount(value, currency string) {
 	c.TaxDeferredAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetTaxDeferredAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxDeferredAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetValueAddedTaxAmount(value, currency string) {
 	c.ValueAddedTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetValueAddedTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ValueAddedTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetStampDutyAmount(value, currency string) {
 	c.StampDutyAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetStampDutyAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.StampDutyAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetTaxReclaimAmount(value, currency string) {
 	c.TaxReclaimAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetTaxReclaimAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxReclaimAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetTaxCreditAmount(value, currency string) {
 	c.TaxCreditAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetTaxCreditAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxCreditAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetAdditionalTaxAmount(value, currency string) {
 	c.AdditionalTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetAdditionalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AdditionalTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetWithholdingTaxAmount(value, currency string) {
 	c.WithholdingTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetWithholdingTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetSecondLevelTaxAmount(value, currency string) {
 	c.SecondLevelTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetSecondLevelTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SecondLevelTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetFiscalStampAmount(value, currency string) {
 	c.FiscalStampAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetFiscalStampAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FiscalStampAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetExecutingBrokerAmount(value, currency string) {
 	c.ExecutingBrokerAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetExecutingBrokerAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ExecutingBrokerAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetPayingAgentCommissionAmount(value, currency string) {
 	c.PayingAgentCommissionAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetPayingAgentCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PayingAgentCommissionAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetLocalBrokerCommissionAmount(value, currency string) {
 	c.LocalBrokerCommissionAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetLocalBrokerCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.LocalBrokerCommissionAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetRegulatoryFeesAmount(value, currency string) {
 	c.RegulatoryFeesAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetRegulatoryFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RegulatoryFeesAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetShippingFeesAmount(value, currency string) {
 	c.ShippingFeesAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetShippingFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ShippingFeesAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetChargesAmount(value, currency string) {
 	c.ChargesAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetChargesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ChargesAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetEntitledAmount(value, currency string) {
 	c.EntitledAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetEntitledAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.EntitledAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetOriginalAmount(value, currency string) {
 	c.OriginalAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetOriginalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.OriginalAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetAccruedInterestAmount(value, currency string) {
 	c.AccruedInterestAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AccruedInterestAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetIncomePortion(value, currency string) {
 	c.IncomePortion = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetIncomePortionFromDecimal(value decimal.Decimal, currency string) {
+	c.IncomePortion = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetEqualisationAmount(value, currency string) {
 	c.EqualisationAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetEqualisationAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.EqualisationAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetFATCATaxAmount(value, currency string) {
 	c.FATCATaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetFATCATaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FATCATaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetNRATaxAmount(value, currency string) {
 	c.NRATaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetNRATaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NRATaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetBackUpWithholdingTaxAmount(value, currency string) {
 	c.BackUpWithholdingTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetBackUpWithholdingTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.BackUpWithholdingTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetTaxOnIncomeAmount(value, currency string) {
 	c.TaxOnIncomeAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts40) SetTaxOnIncomeAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxOnIncomeAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts40) SetTransactionTax(value, currency string) {
 	c.TransactionTax = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CorporateActionAmounts40) SetTransactionTaxFromDecimal(value decimal.Decimal, currency string) {
+	c.TransactionTax = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
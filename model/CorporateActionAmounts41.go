@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies amounts in the framework of a corporate action event.
 type CorporateActionAmounts41 struct {
 
@@ -130,170 +134,342 @@ type CorporateActionAmounts41 struct {
 	TransactionTax *RestrictedFINActiveCurrencyAndAmount `xml:"TxTax,omitempty"`
 }
 
+func (c *CorporateActionAmounts41) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionAmounts41) SetGrossCashAmount(value, currency string) {
 	c.GrossCashAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetGrossCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.GrossCashAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetNetCashAmount(value, currency string) {
 	c.NetCashAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetNetCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NetCashAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetSolicitationFees(value, currency string) {
 	c.SolicitationFees = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetSolicitationFeesFromDecimal(value decimal.Decimal, currency string) {
+	c.SolicitationFees = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetCashInLieuOfShare(value, currency string) {
 	c.CashInLieuOfShare = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetCashInLieuOfShareFromDecimal(value decimal.Decimal, currency string) {
+	c.CashInLieuOfShare = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetCapitalGain(value, currency string) {
 	c.CapitalGain = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetCapitalGainFromDecimal(value decimal.Decimal, currency string) {
+	c.CapitalGain = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetInterestAmount(value, currency string) {
 	c.InterestAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterestAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetIndemnityAmount(value, currency string) {
 	c.IndemnityAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetIndemnityAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.IndemnityAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetManufacturedDividendPaymentAmount(value, currency string) {
 	c.ManufacturedDividendPaymentAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetManufacturedDividendPaymentAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ManufacturedDividendPaymentAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetReinvestmentAmount(value, currency string) {
 	c.ReinvestmentAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetReinvestmentAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ReinvestmentAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetFullyFrankedAmount(value, currency string) {
 	c.FullyFrankedAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetFullyFrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FullyFrankedAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetUnfrankedAmount(value, currency string) {
 	c.UnfrankedAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetUnfrankedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.UnfrankedAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetSundryOrOtherAmount(value, currency string) {
 	c.SundryOrOtherAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetSundryOrOtherAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SundryOrOtherAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetTaxFreeAmount(value, currency string) {
 	c.TaxFreeAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetTaxFreeAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxFreeAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetTaxDeferredAmount(value, currency string) {
 	c.TaxDeferredAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetTaxDeferredAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxDeferredAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetValueAddedTaxAmount(value, currency string) {
 	c.ValueAddedTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetValueAddedTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ValueAddedTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetStampDutyAmount(value, currency string) {
 	c.StampDutyAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetStampDutyAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.StampDutyAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetTaxReclaimAmount(value, currency string) {
 	c.TaxReclaimAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetTaxReclaimAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxReclaimAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetTaxCreditAmount(value, currency string) {
 	c.TaxCreditAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetTaxCreditAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxCreditAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetAdditionalTaxAmount(value, currency string) {
 	c.AdditionalTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetAdditionalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AdditionalTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetWithholdingTaxAmount(value, currency string) {
 	c.WithholdingTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetWithholdingTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.WithholdingTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetSecondLevelTaxAmount(value, currency string) {
 	c.SecondLevelTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetSecondLevelTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.SecondLevelTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetFiscalStampAmount(value, currency string) {
 	c.FiscalStampAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetFiscalStampAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FiscalStampAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetExecutingBrokerAmount(value, currency string) {
 	c.ExecutingBrokerAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetExecutingBrokerAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ExecutingBrokerAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetPayingAgentCommissionAmount(value, currency string) {
 	c.PayingAgentCommissionAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetPayingAgentCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PayingAgentCommissionAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetLocalBrokerCommissionAmount(value, currency string) {
 	c.LocalBrokerCommissionAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetLocalBrokerCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.LocalBrokerCommissionAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetRegulatoryFeesAmount(value, currency string) {
 	c.RegulatoryFeesAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetRegulatoryFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RegulatoryFeesAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetShippingFeesAmount(value, currency string) {
 	c.ShippingFeesAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetShippingFeesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ShippingFeesAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetChargesAmount(value, currency string) {
 	c.ChargesAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetChargesAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ChargesAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetEntitledAmount(value, currency string) {
 	c.EntitledAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetEntitledAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.EntitledAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetOriginalAmount(value, currency string) {
 	c.OriginalAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetOriginalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.OriginalAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetPrincipalOrCorpus(value, currency string) {
 	c.PrincipalOrCorpus = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetPrincipalOrCorpusFromDecimal(value decimal.Decimal, currency string) {
+	c.PrincipalOrCorpus = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetRedemptionPremiumAmount(value, currency string) {
 	c.RedemptionPremiumAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetRedemptionPremiumAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.RedemptionPremiumAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetIncomePortion(value, currency string) {
 	c.IncomePortion = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetIncomePortionFromDecimal(value decimal.Decimal, currency string) {
+	c.IncomePortion = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetStockExchangeTax(value, currency string) {
 	c.StockExchangeTax = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetStockExchangeTaxFromDecimal(value decimal.Decimal, currency string) {
+	c.StockExchangeTax = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetEUTaxRetentionAmount(value, currency string) {
 	c.EUTaxRetentionAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetEUTaxRetentionAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.EUTaxRetentionAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetAccruedInterestAmount(value, currency string) {
 	c.AccruedInterestAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.AccruedInterestAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetEqualisationAmount(value, currency string) {
 	c.EqualisationAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetEqualisationAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.EqualisationAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetFATCATaxAmount(value, currency string) {
 	c.FATCATaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetFATCATaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FATCATaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetNRATaxAmount(value, currency string) {
 	c.NRATaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetNRATaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.NRATaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetBackUpWithholdingTaxAmount(value, currency string) {
 	c.BackUpWithholdingTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetBackUpWithholdingTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.BackUpWithholdingTaxAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetTaxOnIncomeAmount(value, currency string) {
 	c.TaxOnIncomeAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionAmounts41) SetTaxOnIncomeAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TaxOnIncomeAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionAmounts41) SetTransactionTax(value, currency string) {
 	c.TransactionTax = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
+
+func (c *CorporateActionAmounts41) SetTransactionTaxFromDecimal(value decimal.Decimal, currency string) {
+	c.TransactionTax = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
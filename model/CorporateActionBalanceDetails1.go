@@ -61,6 +61,10 @@ type CorporateActionBalanceDetails1 struct {
 	UnaffectedBalance *BalanceFormat1Choice `xml:"UafctdBal,omitempty"`
 }
 
+func (c *CorporateActionBalanceDetails1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionBalanceDetails1) AddTotalEligibleBalance() *Quantity3Choice {
 	c.TotalEligibleBalance = new(Quantity3Choice)
 	return c.TotalEligibleBalance
@@ -55,6 +55,10 @@ type CorporateActionBalanceDetails11 struct {
 	UnaffectedBalance *BalanceFormat1Choice `xml:"UafctdBal,omitempty"`
 }
 
+func (c *CorporateActionBalanceDetails11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionBalanceDetails11) AddConfirmedBalance() *BalanceFormat1Choice {
 	c.ConfirmedBalance = new(BalanceFormat1Choice)
 	return c.ConfirmedBalance
@@ -46,6 +46,10 @@ type CorporateActionBalanceDetails12 struct {
 	RegisteredBalance *BalanceFormat1Choice `xml:"RegdBal,omitempty"`
 }
 
+func (c *CorporateActionBalanceDetails12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionBalanceDetails12) AddTotalEligibleBalance() *Quantity3Choice {
 	c.TotalEligibleBalance = new(Quantity3Choice)
 	return c.TotalEligibleBalance
@@ -61,6 +61,10 @@ type CorporateActionBalanceDetails17 struct {
 	UnaffectedBalance *BalanceFormat1Choice `xml:"UafctdBal,omitempty"`
 }
 
+func (c *CorporateActionBalanceDetails17) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionBalanceDetails17) AddTotalEligibleBalance() *Quantity3Choice {
 	c.TotalEligibleBalance = new(Quantity3Choice)
 	return c.TotalEligibleBalance
@@ -61,6 +61,10 @@ type CorporateActionBalanceDetails21 struct {
 	UnaffectedBalance *BalanceFormat1Choice `xml:"UafctdBal,omitempty"`
 }
 
+func (c *CorporateActionBalanceDetails21) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionBalanceDetails21) AddTotalEligibleBalance() *TotalEligibleBalanceFormat1 {
 	c.TotalEligibleBalance = new(TotalEligibleBalanceFormat1)
 	return c.TotalEligibleBalance
@@ -61,6 +61,10 @@ type CorporateActionBalanceDetails29 struct {
 	UnaffectedBalance *BalanceFormat5Choice `xml:"UafctdBal,omitempty"`
 }
 
+func (c *CorporateActionBalanceDetails29) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionBalanceDetails29) AddTotalEligibleBalance() *TotalEligibleBalanceFormat8 {
 	c.TotalEligibleBalance = new(TotalEligibleBalanceFormat8)
 	return c.TotalEligibleBalance
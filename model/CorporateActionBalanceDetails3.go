@@ -46,6 +46,10 @@ type CorporateActionBalanceDetails3 struct {
 	RegisteredBalance *BalanceFormat1Choice `xml:"RegdBal,omitempty"`
 }
 
+func (c *CorporateActionBalanceDetails3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionBalanceDetails3) AddTotalEligibleBalance() *Quantity3Choice {
 	c.TotalEligibleBalance = new(Quantity3Choice)
 	return c.TotalEligibleBalance
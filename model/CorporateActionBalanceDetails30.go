@@ -55,6 +55,10 @@ type CorporateActionBalanceDetails30 struct {
 	PendingReceiptBalance []*PendingBalance3 `xml:"PdgRctBal,omitempty"`
 }
 
+func (c *CorporateActionBalanceDetails30) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionBalanceDetails30) AddTotalEligibleBalance() *Quantity17Choice {
 	c.TotalEligibleBalance = new(Quantity17Choice)
 	return c.TotalEligibleBalance
@@ -55,6 +55,10 @@ type CorporateActionBalanceDetails31 struct {
 	UnaffectedBalance *BalanceFormat5Choice `xml:"UafctdBal,omitempty"`
 }
 
+func (c *CorporateActionBalanceDetails31) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionBalanceDetails31) AddConfirmedBalance() *BalanceFormat5Choice {
 	c.ConfirmedBalance = new(BalanceFormat5Choice)
 	return c.ConfirmedBalance
@@ -46,6 +46,10 @@ type CorporateActionBalanceDetails32 struct {
 	RegisteredBalance *BalanceFormat5Choice `xml:"RegdBal,omitempty"`
 }
 
+func (c *CorporateActionBalanceDetails32) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionBalanceDetails32) AddTotalEligibleBalance() *Quantity17Choice {
 	c.TotalEligibleBalance = new(Quantity17Choice)
 	return c.TotalEligibleBalance
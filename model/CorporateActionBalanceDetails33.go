@@ -55,6 +55,10 @@ type CorporateActionBalanceDetails33 struct {
 	PendingReceiptBalance []*PendingBalance4 `xml:"PdgRctBal,omitempty"`
 }
 
+func (c *CorporateActionBalanceDetails33) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionBalanceDetails33) AddTotalEligibleBalance() *Quantity22Choice {
 	c.TotalEligibleBalance = new(Quantity22Choice)
 	return c.TotalEligibleBalance
@@ -46,6 +46,10 @@ type CorporateActionBalanceDetails34 struct {
 	RegisteredBalance *BalanceFormat7Choice `xml:"RegdBal,omitempty"`
 }
 
+func (c *CorporateActionBalanceDetails34) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionBalanceDetails34) AddTotalEligibleBalance() *Quantity22Choice {
 	c.TotalEligibleBalance = new(Quantity22Choice)
 	return c.TotalEligibleBalance
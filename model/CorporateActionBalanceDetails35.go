@@ -55,6 +55,10 @@ type CorporateActionBalanceDetails35 struct {
 	UnaffectedBalance *BalanceFormat7Choice `xml:"UafctdBal,omitempty"`
 }
 
+func (c *CorporateActionBalanceDetails35) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionBalanceDetails35) AddConfirmedBalance() *BalanceFormat7Choice {
 	c.ConfirmedBalance = new(BalanceFormat7Choice)
 	return c.ConfirmedBalance
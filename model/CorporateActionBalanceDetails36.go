@@ -61,6 +61,10 @@ type CorporateActionBalanceDetails36 struct {
 	UnaffectedBalance *BalanceFormat7Choice `xml:"UafctdBal,omitempty"`
 }
 
+func (c *CorporateActionBalanceDetails36) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionBalanceDetails36) AddTotalEligibleBalance() *TotalEligibleBalanceFormat9 {
 	c.TotalEligibleBalance = new(TotalEligibleBalanceFormat9)
 	return c.TotalEligibleBalance
@@ -55,6 +55,10 @@ type CorporateActionBalanceDetails4 struct {
 	PendingReceiptBalance []*PendingBalance1 `xml:"PdgRctBal,omitempty"`
 }
 
+func (c *CorporateActionBalanceDetails4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionBalanceDetails4) AddTotalEligibleBalance() *Quantity3Choice {
 	c.TotalEligibleBalance = new(Quantity3Choice)
 	return c.TotalEligibleBalance
@@ -10,6 +10,10 @@ type CorporateActionCalculationMethod1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (c *CorporateActionCalculationMethod1FormatChoice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionCalculationMethod1FormatChoice) SetCode(value string) {
 	c.Code = (*CorporateActionCalculationMethod1Code)(&value)
 }
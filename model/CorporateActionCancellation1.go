@@ -13,6 +13,10 @@ type CorporateActionCancellation1 struct {
 	ProcessingStatus *CorporateActionProcessingStatus1Choice `xml:"PrcgSts"`
 }
 
+func (c *CorporateActionCancellation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionCancellation1) SetCancellationReasonCode(value string) {
 	c.CancellationReasonCode = (*CorporateActionCancellationReason1Code)(&value)
 }
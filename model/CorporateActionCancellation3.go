@@ -13,6 +13,10 @@ type CorporateActionCancellation3 struct {
 	ProcessingStatus *CorporateActionEventStatus1 `xml:"PrcgSts"`
 }
 
+func (c *CorporateActionCancellation3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionCancellation3) SetCancellationReasonCode(value string) {
 	c.CancellationReasonCode = (*CorporateActionCancellationReason1Code)(&value)
 }
@@ -13,6 +13,10 @@ type CorporateActionCancellation4 struct {
 	ProcessingStatus *CorporateActionEventStatus1 `xml:"PrcgSts"`
 }
 
+func (c *CorporateActionCancellation4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionCancellation4) SetCancellationReasonCode(value string) {
 	c.CancellationReasonCode = (*CorporateActionCancellationReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionCancellationProcessingStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionCancellationProcessingStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionCancellationProcessingStatus1) AddStatus() *ProcessedStatus5FormatChoice {
 	c.Status = new(ProcessedStatus5FormatChoice)
 	return c.Status
@@ -10,6 +10,10 @@ type CorporateActionCancellationRejectionStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionCancellationRejectionStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionCancellationRejectionStatus1) AddReason() *RejectionReason9FormatChoice {
 	newValue := new(RejectionReason9FormatChoice)
 	c.Reason = append(c.Reason, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the cash movement resulting from the election instruction.
 type CorporateActionCashMovements2 struct {
 
@@ -16,6 +20,10 @@ type CorporateActionCashMovements2 struct {
 	AccountDetails []*CashAccount19 `xml:"AcctDtls"`
 }
 
+func (c *CorporateActionCashMovements2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionCashMovements2) SetPostingIdentification(value string) {
 	c.PostingIdentification = (*Max35Text)(&value)
 }
@@ -29,6 +37,10 @@ func (c *CorporateActionCashMovements2) SetPostingAmount(value, currency string)
 	c.PostingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionCashMovements2) SetPostingAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.PostingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionCashMovements2) AddAccountDetails() *CashAccount19 {
 	newValue := new(CashAccount19)
 	c.AccountDetails = append(c.AccountDetails, newValue)
@@ -10,6 +10,10 @@ type CorporateActionChangeType1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (c *CorporateActionChangeType1FormatChoice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionChangeType1FormatChoice) SetCode(value string) {
 	c.Code = (*CorporateActionChangeType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionChangeTypeFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionChangeTypeFormat1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionChangeTypeFormat1Choice) SetCode(value string) {
 	c.Code = (*CorporateActionChangeType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionChangeTypeFormat2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionChangeTypeFormat2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionChangeTypeFormat2Choice) SetCode(value string) {
 	c.Code = (*CorporateActionChangeType2Code)(&value)
 }
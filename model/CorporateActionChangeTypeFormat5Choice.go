@@ -10,6 +10,10 @@ type CorporateActionChangeTypeFormat5Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionChangeTypeFormat5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionChangeTypeFormat5Choice) SetCode(value string) {
 	c.Code = (*CorporateActionChangeType1Code)(&value)
 }
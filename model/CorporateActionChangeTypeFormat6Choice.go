@@ -10,6 +10,10 @@ type CorporateActionChangeTypeFormat6Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionChangeTypeFormat6Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionChangeTypeFormat6Choice) SetCode(value string) {
 	c.Code = (*CorporateActionChangeType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionChangeTypeFormat7Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionChangeTypeFormat7Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionChangeTypeFormat7Choice) SetCode(value string) {
 	c.Code = (*CorporateActionChangeType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionChangeTypeFormat8Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionChangeTypeFormat8Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionChangeTypeFormat8Choice) SetCode(value string) {
 	c.Code = (*CorporateActionChangeType1Code)(&value)
 }
@@ -97,6 +97,10 @@ type CorporateActionDate1 struct {
 	MarketClaimTrackingEndDate *DateFormat6Choice `xml:"MktClmTrckgEndDt,omitempty"`
 }
 
+func (c *CorporateActionDate1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate1) AddAnnouncementDate() *DateFormat6Choice {
 	c.AnnouncementDate = new(DateFormat6Choice)
 	return c.AnnouncementDate
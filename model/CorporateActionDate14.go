@@ -100,6 +100,10 @@ type CorporateActionDate14 struct {
 	LeadPlaintiffDeadline *DateFormat19Choice `xml:"LeadPlntffDdln,omitempty"`
 }
 
+func (c *CorporateActionDate14) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate14) AddAnnouncementDate() *DateFormat19Choice {
 	c.AnnouncementDate = new(DateFormat19Choice)
 	return c.AnnouncementDate
@@ -28,6 +28,10 @@ type CorporateActionDate15 struct {
 	DepositoryCoverExpirationDate *DateFormat19Choice `xml:"DpstryCoverXprtnDt,omitempty"`
 }
 
+func (c *CorporateActionDate15) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate15) AddEarlyResponseDeadline() *DateFormat19Choice {
 	c.EarlyResponseDeadline = new(DateFormat19Choice)
 	return c.EarlyResponseDeadline
@@ -16,6 +16,10 @@ type CorporateActionDate17 struct {
 	EarliestPaymentDate *DateFormat19Choice `xml:"EarlstPmtDt,omitempty"`
 }
 
+func (c *CorporateActionDate17) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate17) AddPaymentDate() *DateFormat19Choice {
 	c.PaymentDate = new(DateFormat19Choice)
 	return c.PaymentDate
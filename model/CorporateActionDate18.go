@@ -25,6 +25,10 @@ type CorporateActionDate18 struct {
 	TradingDate *DateFormat19Choice `xml:"TradgDt,omitempty"`
 }
 
+func (c *CorporateActionDate18) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate18) AddResponseDeadline() *DateFormat19Choice {
 	c.ResponseDeadline = new(DateFormat19Choice)
 	return c.ResponseDeadline
@@ -79,6 +79,10 @@ type CorporateActionDate2 struct {
 	SpecialExDate *DateFormat4Choice `xml:"SpclExDt,omitempty"`
 }
 
+func (c *CorporateActionDate2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate2) AddRecordDate() *DateFormat4Choice {
 	c.RecordDate = new(DateFormat4Choice)
 	return c.RecordDate
@@ -106,6 +106,10 @@ type CorporateActionDate22 struct {
 	HearingDate *DateFormat16Choice `xml:"HrgDt,omitempty"`
 }
 
+func (c *CorporateActionDate22) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate22) AddAnnouncementDate() *DateFormat19Choice {
 	c.AnnouncementDate = new(DateFormat19Choice)
 	return c.AnnouncementDate
@@ -19,6 +19,10 @@ type CorporateActionDate24 struct {
 	PaymentDate *DateAndDateTimeChoice `xml:"PmtDt,omitempty"`
 }
 
+func (c *CorporateActionDate24) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate24) AddPostingDate() *DateAndDateTimeChoice {
 	c.PostingDate = new(DateAndDateTimeChoice)
 	return c.PostingDate
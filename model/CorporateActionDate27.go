@@ -106,6 +106,10 @@ type CorporateActionDate27 struct {
 	HearingDate *DateFormat16Choice `xml:"HrgDt,omitempty"`
 }
 
+func (c *CorporateActionDate27) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate27) AddAnnouncementDate() *DateFormat19Choice {
 	c.AnnouncementDate = new(DateFormat19Choice)
 	return c.AnnouncementDate
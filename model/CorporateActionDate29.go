@@ -31,6 +31,10 @@ type CorporateActionDate29 struct {
 	StockLendingDeadline *DateFormat19Choice `xml:"StockLndgDdln,omitempty"`
 }
 
+func (c *CorporateActionDate29) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate29) AddEarlyResponseDeadline() *DateFormat19Choice {
 	c.EarlyResponseDeadline = new(DateFormat19Choice)
 	return c.EarlyResponseDeadline
@@ -22,6 +22,10 @@ type CorporateActionDate3 struct {
 	EarliestPaymentDate *DateFormat4Choice `xml:"EarlstPmtDt,omitempty"`
 }
 
+func (c *CorporateActionDate3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate3) AddPaymentDate() *DateFormat4Choice {
 	c.PaymentDate = new(DateFormat4Choice)
 	return c.PaymentDate
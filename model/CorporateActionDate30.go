@@ -10,6 +10,10 @@ type CorporateActionDate30 struct {
 	ExDividendDate *DateFormat19Choice `xml:"ExDvddDt,omitempty"`
 }
 
+func (c *CorporateActionDate30) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate30) AddRecordDate() *DateFormat19Choice {
 	c.RecordDate = new(DateFormat19Choice)
 	return c.RecordDate
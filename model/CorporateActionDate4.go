@@ -37,6 +37,10 @@ type CorporateActionDate4 struct {
 	SubscriptionCostDebitDate *DateFormat4Choice `xml:"SbcptCostDbtDt,omitempty"`
 }
 
+func (c *CorporateActionDate4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate4) AddCouponClippingDate() *DateFormat4Choice {
 	c.CouponClippingDate = new(DateFormat4Choice)
 	return c.CouponClippingDate
@@ -13,6 +13,10 @@ type CorporateActionDate41 struct {
 	LotteryDate *DateFormat19Choice `xml:"LtryDt,omitempty"`
 }
 
+func (c *CorporateActionDate41) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate41) AddRecordDate() *DateFormat19Choice {
 	c.RecordDate = new(DateFormat19Choice)
 	return c.RecordDate
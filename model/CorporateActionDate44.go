@@ -109,6 +109,10 @@ type CorporateActionDate44 struct {
 	HearingDate *DateFormat30Choice `xml:"HrgDt,omitempty"`
 }
 
+func (c *CorporateActionDate44) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate44) AddAnnouncementDate() *DateFormat31Choice {
 	c.AnnouncementDate = new(DateFormat31Choice)
 	return c.AnnouncementDate
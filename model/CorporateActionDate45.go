@@ -13,6 +13,10 @@ type CorporateActionDate45 struct {
 	LotteryDate *DateFormat31Choice `xml:"LtryDt,omitempty"`
 }
 
+func (c *CorporateActionDate45) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate45) AddRecordDate() *DateFormat31Choice {
 	c.RecordDate = new(DateFormat31Choice)
 	return c.RecordDate
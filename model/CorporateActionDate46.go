@@ -25,6 +25,10 @@ type CorporateActionDate46 struct {
 	TradingDate *DateFormat31Choice `xml:"TradgDt,omitempty"`
 }
 
+func (c *CorporateActionDate46) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate46) AddResponseDeadline() *DateFormat31Choice {
 	c.ResponseDeadline = new(DateFormat31Choice)
 	return c.ResponseDeadline
@@ -16,6 +16,10 @@ type CorporateActionDate47 struct {
 	EarliestPaymentDate *DateFormat31Choice `xml:"EarlstPmtDt,omitempty"`
 }
 
+func (c *CorporateActionDate47) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate47) AddPaymentDate() *DateFormat31Choice {
 	c.PaymentDate = new(DateFormat31Choice)
 	return c.PaymentDate
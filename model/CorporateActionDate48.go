@@ -34,6 +34,10 @@ type CorporateActionDate48 struct {
 	BorrowerStockLendingDeadline []*BorrowerLendingDeadline1 `xml:"BrrwrStockLndgDdln,omitempty"`
 }
 
+func (c *CorporateActionDate48) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate48) AddEarlyResponseDeadline() *DateFormat31Choice {
 	c.EarlyResponseDeadline = new(DateFormat31Choice)
 	return c.EarlyResponseDeadline
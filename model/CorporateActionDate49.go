@@ -10,6 +10,10 @@ type CorporateActionDate49 struct {
 	ExDividendDate *DateFormat31Choice `xml:"ExDvddDt,omitempty"`
 }
 
+func (c *CorporateActionDate49) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate49) AddRecordDate() *DateFormat31Choice {
 	c.RecordDate = new(DateFormat31Choice)
 	return c.RecordDate
@@ -16,6 +16,10 @@ type CorporateActionDate5 struct {
 	EarliestPaymentDate *DateFormat4Choice `xml:"EarlstPmtDt,omitempty"`
 }
 
+func (c *CorporateActionDate5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate5) AddForeignExchangeRateFixingDate() *DateFormat4Choice {
 	c.ForeignExchangeRateFixingDate = new(DateFormat4Choice)
 	return c.ForeignExchangeRateFixingDate
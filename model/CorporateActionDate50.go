@@ -10,6 +10,10 @@ type CorporateActionDate50 struct {
 	ExDividendDate *DateFormat34Choice `xml:"ExDvddDt,omitempty"`
 }
 
+func (c *CorporateActionDate50) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate50) AddRecordDate() *DateFormat34Choice {
 	c.RecordDate = new(DateFormat34Choice)
 	return c.RecordDate
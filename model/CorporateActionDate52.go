@@ -25,6 +25,10 @@ type CorporateActionDate52 struct {
 	TradingDate *DateFormat34Choice `xml:"TradgDt,omitempty"`
 }
 
+func (c *CorporateActionDate52) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate52) AddResponseDeadline() *DateFormat34Choice {
 	c.ResponseDeadline = new(DateFormat34Choice)
 	return c.ResponseDeadline
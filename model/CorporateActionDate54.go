@@ -13,6 +13,10 @@ type CorporateActionDate54 struct {
 	LotteryDate *DateFormat34Choice `xml:"LtryDt,omitempty"`
 }
 
+func (c *CorporateActionDate54) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate54) AddRecordDate() *DateFormat34Choice {
 	c.RecordDate = new(DateFormat34Choice)
 	return c.RecordDate
@@ -34,6 +34,10 @@ type CorporateActionDate55 struct {
 	BorrowerStockLendingDeadline []*BorrowerLendingDeadline2 `xml:"BrrwrStockLndgDdln,omitempty"`
 }
 
+func (c *CorporateActionDate55) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate55) AddEarlyResponseDeadline() *DateFormat34Choice {
 	c.EarlyResponseDeadline = new(DateFormat34Choice)
 	return c.EarlyResponseDeadline
@@ -16,6 +16,10 @@ type CorporateActionDate56 struct {
 	EarliestPaymentDate *DateFormat34Choice `xml:"EarlstPmtDt,omitempty"`
 }
 
+func (c *CorporateActionDate56) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate56) AddPaymentDate() *DateFormat34Choice {
 	c.PaymentDate = new(DateFormat34Choice)
 	return c.PaymentDate
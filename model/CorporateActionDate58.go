@@ -109,6 +109,10 @@ type CorporateActionDate58 struct {
 	HearingDate *DateFormat41Choice `xml:"HrgDt,omitempty"`
 }
 
+func (c *CorporateActionDate58) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate58) AddAnnouncementDate() *DateFormat34Choice {
 	c.AnnouncementDate = new(DateFormat34Choice)
 	return c.AnnouncementDate
@@ -25,6 +25,10 @@ type CorporateActionDate6 struct {
 	TradingDate *DateFormat6Choice `xml:"TradgDt,omitempty"`
 }
 
+func (c *CorporateActionDate6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate6) AddResponseDeadline() *DateFormat6Choice {
 	c.ResponseDeadline = new(DateFormat6Choice)
 	return c.ResponseDeadline
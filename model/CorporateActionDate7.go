@@ -19,6 +19,10 @@ type CorporateActionDate7 struct {
 	PaymentDate *DateAndDateTimeChoice `xml:"PmtDt,omitempty"`
 }
 
+func (c *CorporateActionDate7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate7) AddPostingDate() *DateAndDateTimeChoice {
 	c.PostingDate = new(DateAndDateTimeChoice)
 	return c.PostingDate
@@ -31,6 +31,10 @@ type CorporateActionDate8 struct {
 	LeadPlaintiffDeadline *DateFormat6Choice `xml:"LeadPlntffDdln,omitempty"`
 }
 
+func (c *CorporateActionDate8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate8) AddEarlyResponseDeadline() *DateFormat6Choice {
 	c.EarlyResponseDeadline = new(DateFormat6Choice)
 	return c.EarlyResponseDeadline
@@ -16,6 +16,10 @@ type CorporateActionDate9 struct {
 	EarliestPaymentDate *DateFormat6Choice `xml:"EarlstPmtDt,omitempty"`
 }
 
+func (c *CorporateActionDate9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDate9) AddPaymentDate() *DateFormat6Choice {
 	c.PaymentDate = new(DateFormat6Choice)
 	return c.PaymentDate
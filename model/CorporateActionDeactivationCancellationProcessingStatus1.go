@@ -10,6 +10,10 @@ type CorporateActionDeactivationCancellationProcessingStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionDeactivationCancellationProcessingStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDeactivationCancellationProcessingStatus1) AddStatus() *ProcessedStatus2FormatChoice {
 	c.Status = new(ProcessedStatus2FormatChoice)
 	return c.Status
@@ -10,6 +10,10 @@ type CorporateActionDeactivationCancellationRejectionStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionDeactivationCancellationRejectionStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDeactivationCancellationRejectionStatus1) AddReason() *RejectionReason7FormatChoice {
 	newValue := new(RejectionReason7FormatChoice)
 	c.Reason = append(c.Reason, newValue)
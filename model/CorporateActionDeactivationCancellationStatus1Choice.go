@@ -10,6 +10,10 @@ type CorporateActionDeactivationCancellationStatus1Choice struct {
 	RejectedStatus *CorporateActionDeactivationCancellationRejectionStatus1 `xml:"RjctdSts"`
 }
 
+func (c *CorporateActionDeactivationCancellationStatus1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDeactivationCancellationStatus1Choice) AddProcessedStatus() *CorporateActionDeactivationCancellationProcessingStatus1 {
 	c.ProcessedStatus = new(CorporateActionDeactivationCancellationProcessingStatus1)
 	return c.ProcessedStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information about the deactivation.
 type CorporateActionDeactivationInstruction1 struct {
 
@@ -10,10 +14,19 @@ type CorporateActionDeactivationInstruction1 struct {
 	OptionDetails []*CorporateActionOption2 `xml:"OptnDtls,omitempty"`
 }
 
+func (c *CorporateActionDeactivationInstruction1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDeactivationInstruction1) SetDeactivationDateAndTime(value string) {
 	c.DeactivationDateAndTime = (*ISODateTime)(&value)
 }
 
+func (c *CorporateActionDeactivationInstruction1) SetDeactivationDateAndTimeFromTime(value time.Time) {
+	c.DeactivationDateAndTime = new(ISODateTime)
+	c.DeactivationDateAndTime.FromTime(value)
+}
+
 func (c *CorporateActionDeactivationInstruction1) AddOptionDetails() *CorporateActionOption2 {
 	newValue := new(CorporateActionOption2)
 	c.OptionDetails = append(c.OptionDetails, newValue)
@@ -10,6 +10,10 @@ type CorporateActionDeactivationInstructionProcessingStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionDeactivationInstructionProcessingStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDeactivationInstructionProcessingStatus1) AddStatus() *ProcessedStatus6FormatChoice {
 	c.Status = new(ProcessedStatus6FormatChoice)
 	return c.Status
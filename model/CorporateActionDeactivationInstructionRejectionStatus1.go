@@ -10,6 +10,10 @@ type CorporateActionDeactivationInstructionRejectionStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionDeactivationInstructionRejectionStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDeactivationInstructionRejectionStatus1) AddReason() *RejectionReason12FormatChoice {
 	newValue := new(RejectionReason12FormatChoice)
 	c.Reason = append(c.Reason, newValue)
@@ -16,6 +16,10 @@ type CorporateActionDeactivationInstructionStatus1 struct {
 	RejectedStatus *CorporateActionDeactivationInstructionRejectionStatus1 `xml:"RjctdSts"`
 }
 
+func (c *CorporateActionDeactivationInstructionStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionDeactivationInstructionStatus1) AddOptionType() *CorporateActionOption1FormatChoice {
 	c.OptionType = new(CorporateActionOption1FormatChoice)
 	return c.OptionType
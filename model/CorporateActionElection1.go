@@ -16,6 +16,10 @@ type CorporateActionElection1 struct {
 	RemainingQuantity *UnitOrFaceAmount1Choice `xml:"RmngQty"`
 }
 
+func (c *CorporateActionElection1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionElection1) AddOptionType() *CorporateActionOption1FormatChoice {
 	c.OptionType = new(CorporateActionOption1FormatChoice)
 	return c.OptionType
@@ -16,6 +16,10 @@ type CorporateActionElection2 struct {
 	Reason *Max350Text `xml:"Rsn,omitempty"`
 }
 
+func (c *CorporateActionElection2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionElection2) AddOptionType() *CorporateActionOption1FormatChoice {
 	c.OptionType = new(CorporateActionOption1FormatChoice)
 	return c.OptionType
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about a corporate action election.
 type CorporateActionElection3 struct {
 
@@ -28,6 +32,10 @@ type CorporateActionElection3 struct {
 	SecuritiesMovementDetails []*CorporateActionSecuritiesMovement2 `xml:"SctiesMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionElection3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionElection3) AddAccountDetails() *SecuritiesAccount7 {
 	c.AccountDetails = new(SecuritiesAccount7)
 	return c.AccountDetails
@@ -56,6 +64,11 @@ func (c *CorporateActionElection3) SetProposedRate(value string) {
 	c.ProposedRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionElection3) SetProposedRateFromDecimal(value decimal.Decimal) {
+	c.ProposedRate = new(PercentageRate)
+	c.ProposedRate.FromDecimal(value)
+}
+
 func (c *CorporateActionElection3) AddCashMovementDetails() *CorporateActionCashMovements2 {
 	newValue := new(CorporateActionCashMovements2)
 	c.CashMovementDetails = append(c.CashMovementDetails, newValue)
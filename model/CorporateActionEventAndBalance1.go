@@ -16,6 +16,10 @@ type CorporateActionEventAndBalance1 struct {
 	Extension []*Extension2 `xml:"Xtnsn,omitempty"`
 }
 
+func (c *CorporateActionEventAndBalance1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventAndBalance1) AddGeneralInformation() *EventInformation1 {
 	c.GeneralInformation = new(EventInformation1)
 	return c.GeneralInformation
@@ -16,6 +16,10 @@ type CorporateActionEventAndBalance10 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CorporateActionEventAndBalance10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventAndBalance10) AddGeneralInformation() *EventInformation8 {
 	c.GeneralInformation = new(EventInformation8)
 	return c.GeneralInformation
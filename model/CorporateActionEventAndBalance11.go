@@ -16,6 +16,10 @@ type CorporateActionEventAndBalance11 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CorporateActionEventAndBalance11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventAndBalance11) AddGeneralInformation() *EventInformation9 {
 	c.GeneralInformation = new(EventInformation9)
 	return c.GeneralInformation
@@ -16,6 +16,10 @@ type CorporateActionEventAndBalance12 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CorporateActionEventAndBalance12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventAndBalance12) AddGeneralInformation() *EventInformation10 {
 	c.GeneralInformation = new(EventInformation10)
 	return c.GeneralInformation
@@ -16,6 +16,10 @@ type CorporateActionEventAndBalance3 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CorporateActionEventAndBalance3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventAndBalance3) AddGeneralInformation() *EventInformation1 {
 	c.GeneralInformation = new(EventInformation1)
 	return c.GeneralInformation
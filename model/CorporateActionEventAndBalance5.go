@@ -16,6 +16,10 @@ type CorporateActionEventAndBalance5 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CorporateActionEventAndBalance5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventAndBalance5) AddGeneralInformation() *EventInformation3 {
 	c.GeneralInformation = new(EventInformation3)
 	return c.GeneralInformation
@@ -16,6 +16,10 @@ type CorporateActionEventAndBalance7 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CorporateActionEventAndBalance7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventAndBalance7) AddGeneralInformation() *EventInformation5 {
 	c.GeneralInformation = new(EventInformation5)
 	return c.GeneralInformation
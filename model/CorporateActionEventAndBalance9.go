@@ -16,6 +16,10 @@ type CorporateActionEventAndBalance9 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CorporateActionEventAndBalance9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventAndBalance9) AddGeneralInformation() *EventInformation7 {
 	c.GeneralInformation = new(EventInformation7)
 	return c.GeneralInformation
@@ -10,6 +10,10 @@ type CorporateActionEventProcessingStatus1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventProcessingStatus1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventProcessingStatus1Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventProcessingStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventProcessingStatus3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventProcessingStatus3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventProcessingStatus3Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventProcessingStatus1Code)(&value)
 }
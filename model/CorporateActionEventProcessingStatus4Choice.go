@@ -10,6 +10,10 @@ type CorporateActionEventProcessingStatus4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventProcessingStatus4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventProcessingStatus4Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventProcessingStatus1Code)(&value)
 }
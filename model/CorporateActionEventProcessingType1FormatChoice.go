@@ -10,6 +10,10 @@ type CorporateActionEventProcessingType1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventProcessingType1FormatChoice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventProcessingType1FormatChoice) SetCode(value string) {
 	c.Code = (*CorporateActionEventProcessingType1Code)(&value)
 }
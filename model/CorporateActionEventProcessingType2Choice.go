@@ -10,6 +10,10 @@ type CorporateActionEventProcessingType2Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventProcessingType2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventProcessingType2Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventProcessingType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventProcessingType3Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventProcessingType3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventProcessingType3Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventProcessingType1Code)(&value)
 }
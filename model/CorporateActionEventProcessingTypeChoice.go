@@ -10,6 +10,10 @@ type CorporateActionEventProcessingTypeChoice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventProcessingTypeChoice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventProcessingTypeChoice) SetCode(value string) {
 	c.Code = (*CorporateActionEventProcessingType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventReference1 struct {
 	LinkageType *ProcessingPosition1Choice `xml:"LkgTp,omitempty"`
 }
 
+func (c *CorporateActionEventReference1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventReference1) AddEventIdentification() *CorporateActionEventReference1Choice {
 	c.EventIdentification = new(CorporateActionEventReference1Choice)
 	return c.EventIdentification
@@ -10,6 +10,10 @@ type CorporateActionEventReference1Choice struct {
 	LinkedCorporateActionIdentification *Max35Text `xml:"LkdCorpActnId"`
 }
 
+func (c *CorporateActionEventReference1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventReference1Choice) SetLinkedOfficialCorporateActionEventIdentification(value string) {
 	c.LinkedOfficialCorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventReference3 struct {
 	LinkageType *ProcessingPosition7Choice `xml:"LkgTp,omitempty"`
 }
 
+func (c *CorporateActionEventReference3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventReference3) AddEventIdentification() *CorporateActionEventReference3Choice {
 	c.EventIdentification = new(CorporateActionEventReference3Choice)
 	return c.EventIdentification
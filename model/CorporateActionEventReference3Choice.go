@@ -10,6 +10,10 @@ type CorporateActionEventReference3Choice struct {
 	LinkedCorporateActionIdentification *Max35Text `xml:"LkdCorpActnId"`
 }
 
+func (c *CorporateActionEventReference3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventReference3Choice) SetLinkedOfficialCorporateActionEventIdentification(value string) {
 	c.LinkedOfficialCorporateActionEventIdentification = (*Max35Text)(&value)
 }
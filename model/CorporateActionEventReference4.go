@@ -10,6 +10,10 @@ type CorporateActionEventReference4 struct {
 	LinkageType *ProcessingPosition10Choice `xml:"LkgTp,omitempty"`
 }
 
+func (c *CorporateActionEventReference4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventReference4) AddEventIdentification() *CorporateActionEventReference4Choice {
 	c.EventIdentification = new(CorporateActionEventReference4Choice)
 	return c.EventIdentification
@@ -10,6 +10,10 @@ type CorporateActionEventReference4Choice struct {
 	LinkedCorporateActionIdentification *RestrictedFINXMax16Text `xml:"LkdCorpActnId"`
 }
 
+func (c *CorporateActionEventReference4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventReference4Choice) SetLinkedOfficialCorporateActionEventIdentification(value string) {
 	c.LinkedOfficialCorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
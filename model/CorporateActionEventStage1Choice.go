@@ -10,6 +10,10 @@ type CorporateActionEventStage1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventStage1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventStage1Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventStage2Code)(&value)
 }
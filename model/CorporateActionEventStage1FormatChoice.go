@@ -10,6 +10,10 @@ type CorporateActionEventStage1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventStage1FormatChoice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventStage1FormatChoice) SetCode(value string) {
 	c.Code = (*CorporateActionEventStage1Code)(&value)
 }
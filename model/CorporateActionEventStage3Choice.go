@@ -10,6 +10,10 @@ type CorporateActionEventStage3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventStage3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventStage3Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventStage2Code)(&value)
 }
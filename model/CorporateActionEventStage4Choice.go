@@ -10,6 +10,10 @@ type CorporateActionEventStage4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventStage4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventStage4Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventStage2Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventStageFormat13Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventStageFormat13Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventStageFormat13Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventStage3Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventStageFormat14Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventStageFormat14Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventStageFormat14Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventStage4Code)(&value)
 }
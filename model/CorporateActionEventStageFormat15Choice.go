@@ -10,6 +10,10 @@ type CorporateActionEventStageFormat15Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventStageFormat15Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventStageFormat15Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventStage4Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventStageFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventStageFormat1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventStageFormat1Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventStage1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventStageFormat20Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventStageFormat20Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventStageFormat20Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventStage3Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventStageFormat3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventStageFormat3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventStageFormat3Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventStage1Code)(&value)
 }
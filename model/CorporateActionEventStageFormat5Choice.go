@@ -10,6 +10,10 @@ type CorporateActionEventStageFormat5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventStageFormat5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventStageFormat5Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventStage3Code)(&value)
 }
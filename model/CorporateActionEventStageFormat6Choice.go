@@ -10,6 +10,10 @@ type CorporateActionEventStageFormat6Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventStageFormat6Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventStageFormat6Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventStage4Code)(&value)
 }
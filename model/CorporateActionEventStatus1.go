@@ -10,6 +10,10 @@ type CorporateActionEventStatus1 struct {
 	EventConfirmationStatus *EventConfirmationStatus1Code `xml:"EvtConfSts"`
 }
 
+func (c *CorporateActionEventStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventStatus1) SetEventCompletenessStatus(value string) {
 	c.EventCompletenessStatus = (*EventCompletenessStatus1Code)(&value)
 }
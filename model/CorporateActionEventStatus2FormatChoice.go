@@ -10,6 +10,10 @@ type CorporateActionEventStatus2FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventStatus2FormatChoice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventStatus2FormatChoice) SetCode(value string) {
 	c.Code = (*CorporateActionEventStatus2Code)(&value)
 }
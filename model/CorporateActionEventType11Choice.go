@@ -10,6 +10,10 @@ type CorporateActionEventType11Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType11Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType11Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType10Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType12Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType12Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType12Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType11Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType13Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType13Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType13Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType12Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType14Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType14Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType14Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType13Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType15Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType15Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType15Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType14Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType16Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType16Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType16Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType14Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType1Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType1CodeChoice struct {
 	Unstructured *Max35Text `xml:"Ustrd"`
 }
 
+func (c *CorporateActionEventType1CodeChoice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType1CodeChoice) SetStructured(value string) {
 	c.Structured = (*CorporateActionEventType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType29Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType29Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType29Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType19Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType2FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType2FormatChoice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType2FormatChoice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType2Code)(&value)
 }
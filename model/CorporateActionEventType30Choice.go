@@ -10,6 +10,10 @@ type CorporateActionEventType30Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType30Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType30Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType19Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType31Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType31Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType31Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType16Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType32Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType32Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType32Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType15Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType33Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType33Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType33Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType17Code)(&value)
 }
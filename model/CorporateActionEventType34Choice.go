@@ -10,6 +10,10 @@ type CorporateActionEventType34Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType34Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType34Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType18Code)(&value)
 }
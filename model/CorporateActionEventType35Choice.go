@@ -10,6 +10,10 @@ type CorporateActionEventType35Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType35Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType35Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType17Code)(&value)
 }
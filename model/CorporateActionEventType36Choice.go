@@ -10,6 +10,10 @@ type CorporateActionEventType36Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType36Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType36Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType15Code)(&value)
 }
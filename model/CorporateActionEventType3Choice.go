@@ -10,6 +10,10 @@ type CorporateActionEventType3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType3Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType6Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType41Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType41Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType41Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType16Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType42Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType42Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType42Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType18Code)(&value)
 }
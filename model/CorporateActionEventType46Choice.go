@@ -10,6 +10,10 @@ type CorporateActionEventType46Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType46Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType46Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType19Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType48Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType48Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType48Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType19Code)(&value)
 }
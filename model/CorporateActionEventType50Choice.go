@@ -10,6 +10,10 @@ type CorporateActionEventType50Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType50Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType50Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType15Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType51Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType51Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType51Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType22Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType52Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType52Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType52Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType20Code)(&value)
 }
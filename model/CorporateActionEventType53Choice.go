@@ -10,6 +10,10 @@ type CorporateActionEventType53Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType53Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType53Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType21Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType54Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType54Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType54Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType23Code)(&value)
 }
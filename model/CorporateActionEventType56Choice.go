@@ -10,6 +10,10 @@ type CorporateActionEventType56Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType56Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType56Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType24Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType57Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType57Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType57Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType22Code)(&value)
 }
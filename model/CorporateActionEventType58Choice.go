@@ -10,6 +10,10 @@ type CorporateActionEventType58Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType58Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType58Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType20Code)(&value)
 }
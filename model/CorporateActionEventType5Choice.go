@@ -10,6 +10,10 @@ type CorporateActionEventType5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType5Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType7Code)(&value)
 }
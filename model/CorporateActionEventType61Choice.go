@@ -10,6 +10,10 @@ type CorporateActionEventType61Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType61Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType61Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType23Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType62Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType62Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType62Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType21Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType69Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType69Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType69Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType24Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType71Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType71Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType71Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType24Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionEventType7Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType7Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType7Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType8Code)(&value)
 }
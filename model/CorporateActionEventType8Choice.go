@@ -10,6 +10,10 @@ type CorporateActionEventType8Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionEventType8Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionEventType8Choice) SetCode(value string) {
 	c.Code = (*CorporateActionEventType9Code)(&value)
 }
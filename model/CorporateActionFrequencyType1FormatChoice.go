@@ -10,6 +10,10 @@ type CorporateActionFrequencyType1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (c *CorporateActionFrequencyType1FormatChoice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionFrequencyType1FormatChoice) SetCode(value string) {
 	c.Code = (*CorporateActionFrequencyType1Code)(&value)
 }
@@ -13,6 +13,10 @@ type CorporateActionGeneralInformation10 struct {
 	NarrativeType *CorporateActionNarrative1Choice `xml:"NrrtvTp,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation10) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
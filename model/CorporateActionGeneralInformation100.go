@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation100 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes70 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation100) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation100) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -13,6 +13,10 @@ type CorporateActionGeneralInformation102 struct {
 	NarrativeType *CorporateActionNarrative4Choice `xml:"NrrtvTp,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation102) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation102) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation103 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes70 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation103) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation103) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
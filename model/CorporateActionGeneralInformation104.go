@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation104 struct {
 	FinancialInstrumentIdentification *SecurityIdentification21 `xml:"FinInstrmId,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation104) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation104) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation105 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes79 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation105) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation105) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
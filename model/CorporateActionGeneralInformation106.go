@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation106 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes79 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation106) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation106) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
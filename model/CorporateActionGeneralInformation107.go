@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation107 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes81 `xml:"UndrlygScty,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation107) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation107) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
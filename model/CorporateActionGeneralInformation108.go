@@ -22,6 +22,10 @@ type CorporateActionGeneralInformation108 struct {
 	FinancialInstrumentIdentification *SecurityIdentification19 `xml:"FinInstrmId"`
 }
 
+func (c *CorporateActionGeneralInformation108) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation108) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation109 struct {
 	EventType *CorporateActionEventType52Choice `xml:"EvtTp"`
 }
 
+func (c *CorporateActionGeneralInformation109) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation109) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation11 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes7 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation11) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
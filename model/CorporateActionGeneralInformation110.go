@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation110 struct {
 	FinancialInstrumentIdentification *SecurityIdentification19 `xml:"FinInstrmId,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation110) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation110) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation111 struct {
 	FractionalQuantity *FinancialInstrumentQuantity1Choice `xml:"FrctnlQty,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation111) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation111) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type CorporateActionGeneralInformation112 struct {
 	FinancialInstrumentIdentification *SecurityIdentification19 `xml:"FinInstrmId"`
 }
 
+func (c *CorporateActionGeneralInformation112) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation112) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation113 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes85 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation113) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation113) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation114 struct {
 	EventType *CorporateActionEventType58Choice `xml:"EvtTp"`
 }
 
+func (c *CorporateActionGeneralInformation114) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation114) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
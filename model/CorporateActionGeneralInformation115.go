@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation115 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes84 `xml:"UndrlygScty,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation115) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation115) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
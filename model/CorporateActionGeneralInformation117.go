@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation117 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes85 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation117) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation117) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation118 struct {
 	FractionalQuantity *FinancialInstrumentQuantity15Choice `xml:"FrctnlQty,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation118) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation118) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -22,6 +22,10 @@ type CorporateActionGeneralInformation120 struct {
 	FinancialInstrumentIdentification *SecurityIdentification20 `xml:"FinInstrmId"`
 }
 
+func (c *CorporateActionGeneralInformation120) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation120) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
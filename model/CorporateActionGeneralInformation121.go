@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation121 struct {
 	FinancialInstrumentIdentification *SecurityIdentification21 `xml:"FinInstrmId,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation121) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation121) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
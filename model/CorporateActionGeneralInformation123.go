@@ -22,6 +22,10 @@ type CorporateActionGeneralInformation123 struct {
 	FinancialInstrumentIdentification *SecurityIdentification20 `xml:"FinInstrmId"`
 }
 
+func (c *CorporateActionGeneralInformation123) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation123) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
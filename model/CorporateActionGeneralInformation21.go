@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation21 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes18 `xml:"UndrlygScty,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation21) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation21) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
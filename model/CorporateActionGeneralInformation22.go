@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation22 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes19 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation22) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation22) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +28,10 @@ type CorporateActionGeneralInformation23 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes19 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation23) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation23) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
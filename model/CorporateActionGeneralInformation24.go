@@ -22,6 +22,10 @@ type CorporateActionGeneralInformation24 struct {
 	AdditionalBusinessProcessIndicator *AdditionalBusinessProcessFormat3Choice `xml:"AddtlBizPrcInd,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation24) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation24) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
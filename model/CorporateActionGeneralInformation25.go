@@ -22,6 +22,10 @@ type CorporateActionGeneralInformation25 struct {
 	UnderlyingSecurityIdentification *SecurityIdentification14 `xml:"UndrlygSctyId"`
 }
 
+func (c *CorporateActionGeneralInformation25) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation25) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation26 struct {
 	UnderlyingSecurityIdentification *SecurityIdentification14 `xml:"UndrlygSctyId,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation26) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation26) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +28,10 @@ type CorporateActionGeneralInformation3 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes7 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation3) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
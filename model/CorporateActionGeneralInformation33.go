@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation33 struct {
 	FinancialInstrumentIdentification *SecurityIdentification14 `xml:"FinInstrmId,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation33) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation33) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
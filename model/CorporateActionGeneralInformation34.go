@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation34 struct {
 	EventType *CorporateActionEventType7Choice `xml:"EvtTp"`
 }
 
+func (c *CorporateActionGeneralInformation34) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation34) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type CorporateActionGeneralInformation35 struct {
 	FinancialInstrumentIdentification *SecurityIdentification14 `xml:"FinInstrmId"`
 }
 
+func (c *CorporateActionGeneralInformation35) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation35) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
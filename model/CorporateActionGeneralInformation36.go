@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation36 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes32 `xml:"UndrlygScty,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation36) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation36) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
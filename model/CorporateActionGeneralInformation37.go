@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation37 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes33 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation37) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation37) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
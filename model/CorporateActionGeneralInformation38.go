@@ -28,6 +28,10 @@ type CorporateActionGeneralInformation38 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes33 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation38) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation38) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation39 struct {
 	IntermediateSecuritiesDistributionType *IntermediateSecuritiesDistributionTypeFormat6Choice `xml:"IntrmdtSctiesDstrbtnTp,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation39) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation39) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type CorporateActionGeneralInformation4 struct {
 	AdditionalBusinessProcessIndicator *AdditionalBusinessProcessFormat3Choice `xml:"AddtlBizPrcInd,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation4) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
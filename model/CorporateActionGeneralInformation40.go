@@ -13,6 +13,10 @@ type CorporateActionGeneralInformation40 struct {
 	NarrativeType *CorporateActionNarrative1Choice `xml:"NrrtvTp,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation40) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation40) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
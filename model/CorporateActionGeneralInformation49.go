@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation49 struct {
 	FinancialInstrumentIdentification *SecurityIdentification14 `xml:"FinInstrmId,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation49) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation49) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
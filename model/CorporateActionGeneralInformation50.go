@@ -28,6 +28,10 @@ type CorporateActionGeneralInformation50 struct {
 	FractionalQuantity *FinancialInstrumentQuantity1Choice `xml:"FrctnlQty,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation50) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation50) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
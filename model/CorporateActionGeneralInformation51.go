@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation51 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes43 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation51) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation51) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
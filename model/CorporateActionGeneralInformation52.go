@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation52 struct {
 	EventType *CorporateActionEventType11Choice `xml:"EvtTp"`
 }
 
+func (c *CorporateActionGeneralInformation52) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation52) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
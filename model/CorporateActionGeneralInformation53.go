@@ -22,6 +22,10 @@ type CorporateActionGeneralInformation53 struct {
 	FinancialInstrumentIdentification *SecurityIdentification14 `xml:"FinInstrmId"`
 }
 
+func (c *CorporateActionGeneralInformation53) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation53) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
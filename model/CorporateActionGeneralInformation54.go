@@ -28,6 +28,10 @@ type CorporateActionGeneralInformation54 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes43 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation54) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation54) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation55 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes32 `xml:"UndrlygScty,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation55) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation55) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type CorporateActionGeneralInformation56 struct {
 	FinancialInstrumentIdentification *SecurityIdentification14 `xml:"FinInstrmId"`
 }
 
+func (c *CorporateActionGeneralInformation56) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation56) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
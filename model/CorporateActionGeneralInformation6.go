@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation6 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes6 `xml:"UndrlygScty,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation6) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +28,10 @@ type CorporateActionGeneralInformation69 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes48 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation69) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation69) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
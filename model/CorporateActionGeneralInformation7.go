@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation7 struct {
 	UnderlyingSecurityIdentification *SecurityIdentification11 `xml:"UndrlygSctyId,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation7) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
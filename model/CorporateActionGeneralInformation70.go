@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation70 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes48 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation70) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation70) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
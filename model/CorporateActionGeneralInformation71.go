@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation71 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes50 `xml:"UndrlygScty,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation71) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation71) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
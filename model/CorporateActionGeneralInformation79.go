@@ -28,6 +28,10 @@ type CorporateActionGeneralInformation79 struct {
 	FractionalQuantity *FinancialInstrumentQuantity1Choice `xml:"FrctnlQty,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation79) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation79) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type CorporateActionGeneralInformation8 struct {
 	UnderlyingSecurityIdentification *SecurityIdentification11 `xml:"UndrlygSctyId"`
 }
 
+func (c *CorporateActionGeneralInformation8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation8) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation84 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes66 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation84) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation84) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
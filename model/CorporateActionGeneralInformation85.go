@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation85 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes66 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionGeneralInformation85) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation85) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
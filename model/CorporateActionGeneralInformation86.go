@@ -22,6 +22,10 @@ type CorporateActionGeneralInformation86 struct {
 	FinancialInstrumentIdentification *SecurityIdentification19 `xml:"FinInstrmId"`
 }
 
+func (c *CorporateActionGeneralInformation86) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation86) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
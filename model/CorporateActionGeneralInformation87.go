@@ -22,6 +22,10 @@ type CorporateActionGeneralInformation87 struct {
 	FinancialInstrumentIdentification *SecurityIdentification19 `xml:"FinInstrmId"`
 }
 
+func (c *CorporateActionGeneralInformation87) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation87) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
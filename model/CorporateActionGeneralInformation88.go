@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation88 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes65 `xml:"UndrlygScty,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation88) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation88) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation89 struct {
 	FractionalQuantity *FinancialInstrumentQuantity1Choice `xml:"FrctnlQty,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation89) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation89) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
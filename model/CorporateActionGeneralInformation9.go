@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation9 struct {
 	EventType *CorporateActionEventType3Choice `xml:"EvtTp"`
 }
 
+func (c *CorporateActionGeneralInformation9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation9) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation90 struct {
 	FinancialInstrumentIdentification *SecurityIdentification19 `xml:"FinInstrmId,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation90) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation90) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
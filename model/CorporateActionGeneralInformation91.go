@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation91 struct {
 	EventType *CorporateActionEventType32Choice `xml:"EvtTp"`
 }
 
+func (c *CorporateActionGeneralInformation91) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation91) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type CorporateActionGeneralInformation92 struct {
 	NarrativeType *CorporateActionNarrative3Choice `xml:"NrrtvTp,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation92) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation92) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type CorporateActionGeneralInformation93 struct {
 	FinancialInstrumentIdentification *SecurityIdentification20 `xml:"FinInstrmId"`
 }
 
+func (c *CorporateActionGeneralInformation93) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation93) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
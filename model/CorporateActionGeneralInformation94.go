@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation94 struct {
 	EventType *CorporateActionEventType36Choice `xml:"EvtTp"`
 }
 
+func (c *CorporateActionGeneralInformation94) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation94) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -16,6 +16,10 @@ type CorporateActionGeneralInformation97 struct {
 	UnderlyingSecurity *FinancialInstrumentAttributes69 `xml:"UndrlygScty,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation97) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation97) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -25,6 +25,10 @@ type CorporateActionGeneralInformation98 struct {
 	FractionalQuantity *FinancialInstrumentQuantity15Choice `xml:"FrctnlQty,omitempty"`
 }
 
+func (c *CorporateActionGeneralInformation98) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation98) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
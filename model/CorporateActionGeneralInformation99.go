@@ -22,6 +22,10 @@ type CorporateActionGeneralInformation99 struct {
 	FinancialInstrumentIdentification *SecurityIdentification20 `xml:"FinInstrmId"`
 }
 
+func (c *CorporateActionGeneralInformation99) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionGeneralInformation99) SetCorporateActionEventIdentification(value string) {
 	c.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
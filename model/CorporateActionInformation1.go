@@ -25,6 +25,10 @@ type CorporateActionInformation1 struct {
 	UnderlyingSecurity *FinancialInstrumentDescription3 `xml:"UndrlygScty"`
 }
 
+func (c *CorporateActionInformation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionInformation1) AddAgentIdentification() *PartyIdentification2Choice {
 	c.AgentIdentification = new(PartyIdentification2Choice)
 	return c.AgentIdentification
@@ -28,6 +28,10 @@ type CorporateActionInformation2 struct {
 	OtherUnderlyingSecurity []*FinancialInstrumentDescription3 `xml:"OthrUndrlygScty,omitempty"`
 }
 
+func (c *CorporateActionInformation2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionInformation2) AddAgentIdentification() *PartyIdentification2Choice {
 	c.AgentIdentification = new(PartyIdentification2Choice)
 	return c.AgentIdentification
@@ -10,6 +10,10 @@ type CorporateActionInformationProcessingStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionInformationProcessingStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionInformationProcessingStatus1) AddStatus() *ProcessedStatus5FormatChoice {
 	c.Status = new(ProcessedStatus5FormatChoice)
 	return c.Status
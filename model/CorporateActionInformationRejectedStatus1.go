@@ -10,6 +10,10 @@ type CorporateActionInformationRejectedStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionInformationRejectedStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionInformationRejectedStatus1) AddReason() *RejectionReason15FormatChoice {
 	newValue := new(RejectionReason15FormatChoice)
 	c.Reason = append(c.Reason, newValue)
@@ -10,6 +10,10 @@ type CorporateActionInformationStatus1Choice struct {
 	RejectedStatus *CorporateActionInformationRejectedStatus1 `xml:"RjctdSts"`
 }
 
+func (c *CorporateActionInformationStatus1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionInformationStatus1Choice) AddProcessedStatus() *CorporateActionInformationProcessingStatus1 {
 	c.ProcessedStatus = new(CorporateActionInformationProcessingStatus1)
 	return c.ProcessedStatus
@@ -10,6 +10,10 @@ type CorporateActionInstructionProcessingStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionInstructionProcessingStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionInstructionProcessingStatus1) AddStatus() *ProcessedStatus3FormatChoice {
 	c.Status = new(ProcessedStatus3FormatChoice)
 	return c.Status
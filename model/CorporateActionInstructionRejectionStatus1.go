@@ -10,6 +10,10 @@ type CorporateActionInstructionRejectionStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionInstructionRejectionStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionInstructionRejectionStatus1) AddReason() *RejectionReason18FormatChoice {
 	newValue := new(RejectionReason18FormatChoice)
 	c.Reason = append(c.Reason, newValue)
@@ -10,6 +10,10 @@ type CorporateActionMandatoryVoluntary1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionMandatoryVoluntary1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionMandatoryVoluntary1Choice) SetCode(value string) {
 	c.Code = (*CorporateActionMandatoryVoluntary1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionMandatoryVoluntary1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (c *CorporateActionMandatoryVoluntary1FormatChoice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionMandatoryVoluntary1FormatChoice) SetCode(value string) {
 	c.Code = (*CorporateActionMandatoryVoluntary1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionMandatoryVoluntary3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionMandatoryVoluntary3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionMandatoryVoluntary3Choice) SetCode(value string) {
 	c.Code = (*CorporateActionMandatoryVoluntary1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionMandatoryVoluntary4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionMandatoryVoluntary4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionMandatoryVoluntary4Choice) SetCode(value string) {
 	c.Code = (*CorporateActionMandatoryVoluntary1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information about the movement instruction.
 type CorporateActionMovement1 struct {
 
@@ -30,6 +34,10 @@ type CorporateActionMovement1 struct {
 	ConfirmedBalanceSecuritiesQuantity *UnitOrFaceAmount1Choice `xml:"ConfdBalSctiesQty,omitempty"`
 }
 
+func (c *CorporateActionMovement1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionMovement1) SetOrderType(value string) {
 	c.OrderType = (*DistributionInstructionType1Code)(&value)
 }
@@ -38,6 +46,11 @@ func (c *CorporateActionMovement1) SetHighPriorityIndicator(value string) {
 	c.HighPriorityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionMovement1) SetHighPriorityIndicatorFromBool(value bool) {
+	c.HighPriorityIndicator = new(YesNoIndicator)
+	c.HighPriorityIndicator.FromBool(value)
+}
+
 func (c *CorporateActionMovement1) SetOptionNumber(value string) {
 	c.OptionNumber = (*Exact3NumericText)(&value)
 }
@@ -51,6 +64,11 @@ func (c *CorporateActionMovement1) SetRequestedExecutionDate(value string) {
 	c.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (c *CorporateActionMovement1) SetRequestedExecutionDateFromTime(value time.Time) {
+	c.RequestedExecutionDate = new(ISODate)
+	c.RequestedExecutionDate.FromTime(value)
+}
+
 func (c *CorporateActionMovement1) AddAccountOwnerIdentification() *PartyIdentification2Choice {
 	c.AccountOwnerIdentification = new(PartyIdentification2Choice)
 	return c.AccountOwnerIdentification
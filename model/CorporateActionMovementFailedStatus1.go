@@ -16,6 +16,10 @@ type CorporateActionMovementFailedStatus1 struct {
 	ResourceDetails []*FailedMovement1 `xml:"RsrcDtls"`
 }
 
+func (c *CorporateActionMovementFailedStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionMovementFailedStatus1) SetAgentAccountIdentification(value string) {
 	c.AgentAccountIdentification = (*Max35Text)(&value)
 }
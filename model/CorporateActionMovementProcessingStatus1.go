@@ -10,6 +10,10 @@ type CorporateActionMovementProcessingStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionMovementProcessingStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionMovementProcessingStatus1) AddStatus() *ProcessedStatus3FormatChoice {
 	c.Status = new(ProcessedStatus3FormatChoice)
 	return c.Status
@@ -10,6 +10,10 @@ type CorporateActionMovementRejectionStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionMovementRejectionStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionMovementRejectionStatus1) AddReason() *RejectionReason13FormatChoice {
 	newValue := new(RejectionReason13FormatChoice)
 	c.Reason = append(c.Reason, newValue)
@@ -10,6 +10,10 @@ type CorporateActionMovementRejectionStatus2 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionMovementRejectionStatus2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionMovementRejectionStatus2) AddReason() *RejectionReason14FormatChoice {
 	newValue := new(RejectionReason14FormatChoice)
 	c.Reason = append(c.Reason, newValue)
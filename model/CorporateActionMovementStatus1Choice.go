@@ -13,6 +13,10 @@ type CorporateActionMovementStatus1Choice struct {
 	RejectedStatus *CorporateActionMovementRejectionStatus1 `xml:"RjctdSts"`
 }
 
+func (c *CorporateActionMovementStatus1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionMovementStatus1Choice) AddProcessedStatus() *CorporateActionMovementProcessingStatus1 {
 	c.ProcessedStatus = new(CorporateActionMovementProcessingStatus1)
 	return c.ProcessedStatus
@@ -26,6 +26,10 @@ type CorporateActionNarrative1 struct {
 	AdditionalText *Max350Text `xml:"AddtlTxt,omitempty"`
 }
 
+func (c *CorporateActionNarrative1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative1) SetInformationConditions(value string) {
 	c.InformationConditions = (*Max350Text)(&value)
 }
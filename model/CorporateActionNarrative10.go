@@ -10,6 +10,10 @@ type CorporateActionNarrative10 struct {
 	PartyContactNarrative []*Max350Text `xml:"PtyCtctNrrtv,omitempty"`
 }
 
+func (c *CorporateActionNarrative10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative10) AddAdditionalText(value string) {
 	c.AdditionalText = append(c.AdditionalText, (*Max350Text)(&value))
 }
@@ -34,6 +34,10 @@ type CorporateActionNarrative11 struct {
 	BasketOrIndexInformation *UpdatedAdditionalInformation2 `xml:"BsktOrIndxInf,omitempty"`
 }
 
+func (c *CorporateActionNarrative11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative11) AddAdditionalText() *UpdatedAdditionalInformation2 {
 	c.AdditionalText = new(UpdatedAdditionalInformation2)
 	return c.AdditionalText
@@ -10,6 +10,10 @@ type CorporateActionNarrative19 struct {
 	PartyContactNarrative []*RestrictedFINXMax350Text `xml:"PtyCtctNrrtv,omitempty"`
 }
 
+func (c *CorporateActionNarrative19) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative19) AddAdditionalText(value string) {
 	c.AdditionalText = append(c.AdditionalText, (*RestrictedFINXMax350Text)(&value))
 }
@@ -10,6 +10,10 @@ type CorporateActionNarrative1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionNarrative1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative1Choice) SetCode(value string) {
 	c.Code = (*CorporateActionNarrative1Code)(&value)
 }
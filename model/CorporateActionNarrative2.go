@@ -22,6 +22,10 @@ type CorporateActionNarrative2 struct {
 	AdditionalText *Max350Text `xml:"AddtlTxt,omitempty"`
 }
 
+func (c *CorporateActionNarrative2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative2) SetInformationConditions(value string) {
 	c.InformationConditions = (*Max350Text)(&value)
 }
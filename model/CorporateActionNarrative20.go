@@ -28,6 +28,10 @@ type CorporateActionNarrative20 struct {
 	CertificationBreakdown *UpdatedAdditionalInformation1 `xml:"CertfctnBrkdwn,omitempty"`
 }
 
+func (c *CorporateActionNarrative20) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative20) AddAdditionalText() *UpdatedAdditionalInformation3 {
 	c.AdditionalText = new(UpdatedAdditionalInformation3)
 	return c.AdditionalText
@@ -25,6 +25,10 @@ type CorporateActionNarrative21 struct {
 	CertificationBreakdown []*Max350Text `xml:"CertfctnBrkdwn,omitempty"`
 }
 
+func (c *CorporateActionNarrative21) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative21) AddAdditionalText(value string) {
 	c.AdditionalText = append(c.AdditionalText, (*Max350Text)(&value))
 }
@@ -13,6 +13,10 @@ type CorporateActionNarrative24 struct {
 	URLAddress *UpdatedURLlnformation `xml:"URLAdr,omitempty"`
 }
 
+func (c *CorporateActionNarrative24) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative24) AddOfferor() *UpdatedAdditionalInformation3 {
 	newValue := new(UpdatedAdditionalInformation3)
 	c.Offeror = append(c.Offeror, newValue)
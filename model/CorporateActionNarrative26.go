@@ -13,6 +13,10 @@ type CorporateActionNarrative26 struct {
 	URLAddress *UpdatedURLlnformation2 `xml:"URLAdr,omitempty"`
 }
 
+func (c *CorporateActionNarrative26) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative26) AddOfferor() *UpdatedAdditionalInformation3 {
 	newValue := new(UpdatedAdditionalInformation3)
 	c.Offeror = append(c.Offeror, newValue)
@@ -34,6 +34,10 @@ type CorporateActionNarrative27 struct {
 	CertificationBreakdown *UpdatedAdditionalInformation8 `xml:"CertfctnBrkdwn,omitempty"`
 }
 
+func (c *CorporateActionNarrative27) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative27) AddAdditionalText() *UpdatedAdditionalInformation8 {
 	c.AdditionalText = new(UpdatedAdditionalInformation8)
 	return c.AdditionalText
@@ -34,6 +34,10 @@ type CorporateActionNarrative28 struct {
 	CertificationBreakdown *UpdatedAdditionalInformation1 `xml:"CertfctnBrkdwn,omitempty"`
 }
 
+func (c *CorporateActionNarrative28) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative28) AddAdditionalText() *UpdatedAdditionalInformation1 {
 	c.AdditionalText = new(UpdatedAdditionalInformation1)
 	return c.AdditionalText
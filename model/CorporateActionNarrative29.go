@@ -28,6 +28,10 @@ type CorporateActionNarrative29 struct {
 	CertificationBreakdown *UpdatedAdditionalInformation1 `xml:"CertfctnBrkdwn,omitempty"`
 }
 
+func (c *CorporateActionNarrative29) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative29) AddAdditionalText() *UpdatedAdditionalInformation3 {
 	c.AdditionalText = new(UpdatedAdditionalInformation3)
 	return c.AdditionalText
@@ -13,6 +13,10 @@ type CorporateActionNarrative3 struct {
 	URLAddress *UpdatedURLlnformation `xml:"URLAdr,omitempty"`
 }
 
+func (c *CorporateActionNarrative3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative3) AddOfferor() *UpdatedAdditionalInformation3 {
 	c.Offeror = new(UpdatedAdditionalInformation3)
 	return c.Offeror
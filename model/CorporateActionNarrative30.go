@@ -13,6 +13,10 @@ type CorporateActionNarrative30 struct {
 	CertificationBreakdown []*Max350Text `xml:"CertfctnBrkdwn,omitempty"`
 }
 
+func (c *CorporateActionNarrative30) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative30) AddRegistrationDetails(value string) {
 	c.RegistrationDetails = append(c.RegistrationDetails, (*Max350Text)(&value))
 }
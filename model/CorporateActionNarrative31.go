@@ -16,6 +16,10 @@ type CorporateActionNarrative31 struct {
 	TaxationConditions []*Max350Text `xml:"TaxtnConds,omitempty"`
 }
 
+func (c *CorporateActionNarrative31) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative31) AddAdditionalText(value string) {
 	c.AdditionalText = append(c.AdditionalText, (*Max350Text)(&value))
 }
@@ -16,6 +16,10 @@ type CorporateActionNarrative32 struct {
 	InstructionAdditionalInformation []*Max350Text `xml:"InstrAddtlInf,omitempty"`
 }
 
+func (c *CorporateActionNarrative32) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative32) AddInformationToComplyWith(value string) {
 	c.InformationToComplyWith = append(c.InformationToComplyWith, (*Max350Text)(&value))
 }
@@ -16,6 +16,10 @@ type CorporateActionNarrative33 struct {
 	InstructionAdditionalInformation []*RestrictedFINXMax350Text `xml:"InstrAddtlInf,omitempty"`
 }
 
+func (c *CorporateActionNarrative33) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative33) AddInformationToComplyWith(value string) {
 	c.InformationToComplyWith = append(c.InformationToComplyWith, (*RestrictedFINXMax350Text)(&value))
 }
@@ -13,6 +13,10 @@ type CorporateActionNarrative34 struct {
 	CertificationBreakdown []*RestrictedFINXMax350Text `xml:"CertfctnBrkdwn,omitempty"`
 }
 
+func (c *CorporateActionNarrative34) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative34) AddRegistrationDetails(value string) {
 	c.RegistrationDetails = append(c.RegistrationDetails, (*RestrictedFINXMax350Text)(&value))
 }
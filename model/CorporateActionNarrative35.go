@@ -16,6 +16,10 @@ type CorporateActionNarrative35 struct {
 	TaxationConditions []*RestrictedFINXMax350Text `xml:"TaxtnConds,omitempty"`
 }
 
+func (c *CorporateActionNarrative35) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative35) AddAdditionalText(value string) {
 	c.AdditionalText = append(c.AdditionalText, (*RestrictedFINXMax350Text)(&value))
 }
@@ -28,6 +28,10 @@ type CorporateActionNarrative36 struct {
 	CertificationBreakdown *UpdatedAdditionalInformation5 `xml:"CertfctnBrkdwn,omitempty"`
 }
 
+func (c *CorporateActionNarrative36) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative36) AddAdditionalText() *UpdatedAdditionalInformation6 {
 	c.AdditionalText = new(UpdatedAdditionalInformation6)
 	return c.AdditionalText
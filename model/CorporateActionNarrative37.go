@@ -34,6 +34,10 @@ type CorporateActionNarrative37 struct {
 	CertificationBreakdown *UpdatedAdditionalInformation5 `xml:"CertfctnBrkdwn,omitempty"`
 }
 
+func (c *CorporateActionNarrative37) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative37) AddAdditionalText() *UpdatedAdditionalInformation5 {
 	c.AdditionalText = new(UpdatedAdditionalInformation5)
 	return c.AdditionalText
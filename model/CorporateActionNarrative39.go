@@ -13,6 +13,10 @@ type CorporateActionNarrative39 struct {
 	URLAddress *UpdatedURLlnformation3 `xml:"URLAdr,omitempty"`
 }
 
+func (c *CorporateActionNarrative39) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative39) AddOfferor() *UpdatedAdditionalInformation6 {
 	newValue := new(UpdatedAdditionalInformation6)
 	c.Offeror = append(c.Offeror, newValue)
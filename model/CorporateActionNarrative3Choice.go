@@ -10,6 +10,10 @@ type CorporateActionNarrative3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionNarrative3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative3Choice) SetCode(value string) {
 	c.Code = (*CorporateActionNarrative1Code)(&value)
 }
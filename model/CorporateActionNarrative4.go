@@ -31,6 +31,10 @@ type CorporateActionNarrative4 struct {
 	BasketOrIndexInformation []*Max350Text `xml:"BsktOrIndxInf,omitempty"`
 }
 
+func (c *CorporateActionNarrative4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative4) AddDeclarationDetails(value string) {
 	c.DeclarationDetails = append(c.DeclarationDetails, (*Max350Text)(&value))
 }
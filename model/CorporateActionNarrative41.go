@@ -34,6 +34,10 @@ type CorporateActionNarrative41 struct {
 	CertificationBreakdown *UpdatedAdditionalInformation10 `xml:"CertfctnBrkdwn,omitempty"`
 }
 
+func (c *CorporateActionNarrative41) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative41) AddAdditionalText() *UpdatedAdditionalInformation10 {
 	c.AdditionalText = new(UpdatedAdditionalInformation10)
 	return c.AdditionalText
@@ -10,6 +10,10 @@ type CorporateActionNarrative4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionNarrative4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative4Choice) SetCode(value string) {
 	c.Code = (*CorporateActionNarrative1Code)(&value)
 }
@@ -25,6 +25,10 @@ type CorporateActionNarrative5 struct {
 	Disclaimer *UpdatedAdditionalInformation1 `xml:"Dsclmr,omitempty"`
 }
 
+func (c *CorporateActionNarrative5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative5) AddAdditionalText() *UpdatedAdditionalInformation3 {
 	c.AdditionalText = new(UpdatedAdditionalInformation3)
 	return c.AdditionalText
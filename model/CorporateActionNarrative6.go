@@ -34,6 +34,10 @@ type CorporateActionNarrative6 struct {
 	BasketOrIndexInformation *UpdatedAdditionalInformation1 `xml:"BsktOrIndxInf,omitempty"`
 }
 
+func (c *CorporateActionNarrative6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative6) AddAdditionalText() *UpdatedAdditionalInformation1 {
 	c.AdditionalText = new(UpdatedAdditionalInformation1)
 	return c.AdditionalText
@@ -22,6 +22,10 @@ type CorporateActionNarrative7 struct {
 	BasketOrIndexInformation []*Max350Text `xml:"BsktOrIndxInf,omitempty"`
 }
 
+func (c *CorporateActionNarrative7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative7) AddAdditionalText(value string) {
 	c.AdditionalText = append(c.AdditionalText, (*Max350Text)(&value))
 }
@@ -19,6 +19,10 @@ type CorporateActionNarrative8 struct {
 	InstructionAdditionalInformation []*Max350Text `xml:"InstrAddtlInf,omitempty"`
 }
 
+func (c *CorporateActionNarrative8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNarrative8) AddInformationToComplyWith(value string) {
 	c.InformationToComplyWith = append(c.InformationToComplyWith, (*Max350Text)(&value))
 }
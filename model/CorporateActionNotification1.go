@@ -16,6 +16,10 @@ type CorporateActionNotification1 struct {
 	ProcessingStatus *ProcessingStatus1FormatChoice `xml:"PrcgSts"`
 }
 
+func (c *CorporateActionNotification1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNotification1) AddAnnouncementDate() *DateFormat4Choice {
 	c.AnnouncementDate = new(DateFormat4Choice)
 	return c.AnnouncementDate
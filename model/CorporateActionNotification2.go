@@ -13,6 +13,10 @@ type CorporateActionNotification2 struct {
 	EligibleBalanceIndicator *YesNoIndicator `xml:"ElgblBalInd,omitempty"`
 }
 
+func (c *CorporateActionNotification2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNotification2) SetNotificationType(value string) {
 	c.NotificationType = (*CorporateActionNotificationType1Code)(&value)
 }
@@ -25,3 +29,8 @@ func (c *CorporateActionNotification2) AddProcessingStatus() *CorporateActionPro
 func (c *CorporateActionNotification2) SetEligibleBalanceIndicator(value string) {
 	c.EligibleBalanceIndicator = (*YesNoIndicator)(&value)
 }
+
+func (c *CorporateActionNotification2) SetEligibleBalanceIndicatorFromBool(value bool) {
+	c.EligibleBalanceIndicator = new(YesNoIndicator)
+	c.EligibleBalanceIndicator.FromBool(value)
+}
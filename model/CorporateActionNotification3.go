@@ -13,6 +13,10 @@ type CorporateActionNotification3 struct {
 	EligibleBalanceIndicator *YesNoIndicator `xml:"ElgblBalInd,omitempty"`
 }
 
+func (c *CorporateActionNotification3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNotification3) SetNotificationType(value string) {
 	c.NotificationType = (*CorporateActionNotificationType1Code)(&value)
 }
@@ -25,3 +29,8 @@ func (c *CorporateActionNotification3) AddProcessingStatus() *CorporateActionPro
 func (c *CorporateActionNotification3) SetEligibleBalanceIndicator(value string) {
 	c.EligibleBalanceIndicator = (*YesNoIndicator)(&value)
 }
+
+func (c *CorporateActionNotification3) SetEligibleBalanceIndicatorFromBool(value bool) {
+	c.EligibleBalanceIndicator = new(YesNoIndicator)
+	c.EligibleBalanceIndicator.FromBool(value)
+}
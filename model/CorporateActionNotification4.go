@@ -13,6 +13,10 @@ type CorporateActionNotification4 struct {
 	EligibleBalanceIndicator *YesNoIndicator `xml:"ElgblBalInd,omitempty"`
 }
 
+func (c *CorporateActionNotification4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNotification4) SetNotificationType(value string) {
 	c.NotificationType = (*CorporateActionNotificationType1Code)(&value)
 }
@@ -25,3 +29,8 @@ func (c *CorporateActionNotification4) AddProcessingStatus() *CorporateActionPro
 func (c *CorporateActionNotification4) SetEligibleBalanceIndicator(value string) {
 	c.EligibleBalanceIndicator = (*YesNoIndicator)(&value)
 }
+
+func (c *CorporateActionNotification4) SetEligibleBalanceIndicatorFromBool(value bool) {
+	c.EligibleBalanceIndicator = new(YesNoIndicator)
+	c.EligibleBalanceIndicator.FromBool(value)
+}
@@ -13,6 +13,10 @@ type CorporateActionNotification5 struct {
 	EligibleBalanceIndicator *YesNoIndicator `xml:"ElgblBalInd,omitempty"`
 }
 
+func (c *CorporateActionNotification5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNotification5) SetNotificationType(value string) {
 	c.NotificationType = (*CorporateActionNotificationType1Code)(&value)
 }
@@ -25,3 +29,8 @@ func (c *CorporateActionNotification5) AddProcessingStatus() *CorporateActionPro
 func (c *CorporateActionNotification5) SetEligibleBalanceIndicator(value string) {
 	c.EligibleBalanceIndicator = (*YesNoIndicator)(&value)
 }
+
+func (c *CorporateActionNotification5) SetEligibleBalanceIndicatorFromBool(value bool) {
+	c.EligibleBalanceIndicator = new(YesNoIndicator)
+	c.EligibleBalanceIndicator.FromBool(value)
+}
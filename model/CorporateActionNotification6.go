@@ -13,6 +13,10 @@ type CorporateActionNotification6 struct {
 	EligibleBalanceIndicator *YesNoIndicator `xml:"ElgblBalInd,omitempty"`
 }
 
+func (c *CorporateActionNotification6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNotification6) SetNotificationType(value string) {
 	c.NotificationType = (*CorporateActionNotificationType1Code)(&value)
 }
@@ -25,3 +29,8 @@ func (c *CorporateActionNotification6) AddProcessingStatus() *CorporateActionPro
 func (c *CorporateActionNotification6) SetEligibleBalanceIndicator(value string) {
 	c.EligibleBalanceIndicator = (*YesNoIndicator)(&value)
 }
+
+func (c *CorporateActionNotification6) SetEligibleBalanceIndicatorFromBool(value bool) {
+	c.EligibleBalanceIndicator = new(YesNoIndicator)
+	c.EligibleBalanceIndicator.FromBool(value)
+}
@@ -10,6 +10,10 @@ type CorporateActionNotificationAdvice1 struct {
 	CorporateActionOptionDetails []*CorporateActionOption1 `xml:"CorpActnOptnDtls,omitempty"`
 }
 
+func (c *CorporateActionNotificationAdvice1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionNotificationAdvice1) AddCorporateActionDetails() *CorporateAction2 {
 	c.CorporateActionDetails = new(CorporateAction2)
 	return c.CorporateActionDetails
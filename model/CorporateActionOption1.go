@@ -75,6 +75,10 @@ type CorporateActionOption1 struct {
 	CorporateActionAdditionalInformation *CorporateActionNarrative1 `xml:"CorpActnAddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption1) SetOptionNumber(value string) {
 	c.OptionNumber = (*Exact3NumericText)(&value)
 }
@@ -93,6 +97,11 @@ func (c *CorporateActionOption1) SetCertificationIndicator(value string) {
 	c.CertificationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption1) SetCertificationIndicatorFromBool(value bool) {
+	c.CertificationIndicator = new(YesNoIndicator)
+	c.CertificationIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption1) AddCertificationType() *BeneficiaryCertificationType1FormatChoice {
 	c.CertificationType = new(BeneficiaryCertificationType1FormatChoice)
 	return c.CertificationType
@@ -267,10 +276,20 @@ func (c *CorporateActionOption1) SetWithdrawalAllowedIndicator(value string) {
 	c.WithdrawalAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption1) SetWithdrawalAllowedIndicatorFromBool(value bool) {
+	c.WithdrawalAllowedIndicator = new(YesNoIndicator)
+	c.WithdrawalAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption1) SetChangeAllowedIndicator(value string) {
 	c.ChangeAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption1) SetChangeAllowedIndicatorFromBool(value bool) {
+	c.ChangeAllowedIndicator = new(YesNoIndicator)
+	c.ChangeAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption1) AddDateDetails() *CorporateActionDate4 {
 	c.DateDetails = new(CorporateActionDate4)
 	return c.DateDetails
@@ -738,6 +757,11 @@ func (c *CorporateActionOption1) SetRedemptionChargesAppliedIndicator(value stri
 	c.RedemptionChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption1) SetRedemptionChargesAppliedIndicatorFromBool(value bool) {
+	c.RedemptionChargesAppliedIndicator = new(YesNoIndicator)
+	c.RedemptionChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption1) AddOptionFeatures() *OptionFeatures1FormatChoice {
 	newValue := new(OptionFeatures1FormatChoice)
 	c.OptionFeatures = append(c.OptionFeatures, newValue)
@@ -81,6 +81,10 @@ type CorporateActionOption10 struct {
 	AdditionalInformation *CorporateActionNarrative5 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption10) SetOptionNumber(value string) {
 	c.OptionNumber = (*Exact3NumericText)(&value)
 }
@@ -564,18 +568,38 @@ func (c *CorporateActionOption10) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption10) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption10) SetCertificationIndicator(value string) {
 	c.CertificationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption10) SetCertificationIndicatorFromBool(value bool) {
+	c.CertificationIndicator = new(YesNoIndicator)
+	c.CertificationIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption10) SetWithdrawalAllowedIndicator(value string) {
 	c.WithdrawalAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption10) SetWithdrawalAllowedIndicatorFromBool(value bool) {
+	c.WithdrawalAllowedIndicator = new(YesNoIndicator)
+	c.WithdrawalAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption10) SetChangeAllowedIndicator(value string) {
 	c.ChangeAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption10) SetChangeAllowedIndicatorFromBool(value bool) {
+	c.ChangeAllowedIndicator = new(YesNoIndicator)
+	c.ChangeAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption10) AddSecurityIdentification() *SecurityIdentification11 {
 	c.SecurityIdentification = new(SecurityIdentification11)
 	return c.SecurityIdentification
@@ -81,6 +81,10 @@ type CorporateActionOption100 struct {
 	AdditionalInformation *CorporateActionNarrative20 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption100) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption100) SetOptionNumber(value string) {
 	c.OptionNumber = (*Exact3NumericText)(&value)
 }
@@ -564,18 +568,38 @@ func (c *CorporateActionOption100) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption100) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption100) SetCertificationBreakdownIndicator(value string) {
 	c.CertificationBreakdownIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption100) SetCertificationBreakdownIndicatorFromBool(value bool) {
+	c.CertificationBreakdownIndicator = new(YesNoIndicator)
+	c.CertificationBreakdownIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption100) SetWithdrawalAllowedIndicator(value string) {
 	c.WithdrawalAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption100) SetWithdrawalAllowedIndicatorFromBool(value bool) {
+	c.WithdrawalAllowedIndicator = new(YesNoIndicator)
+	c.WithdrawalAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption100) SetChangeAllowedIndicator(value string) {
 	c.ChangeAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption100) SetChangeAllowedIndicatorFromBool(value bool) {
+	c.ChangeAllowedIndicator = new(YesNoIndicator)
+	c.ChangeAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption100) AddSecurityIdentification() *SecurityIdentification14 {
 	c.SecurityIdentification = new(SecurityIdentification14)
 	return c.SecurityIdentification
@@ -78,6 +78,10 @@ type CorporateActionOption101 struct {
 	AdditionalInformation *CorporateActionNarrative20 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption101) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption101) SetOptionNumber(value string) {
 	c.OptionNumber = (*Exact3NumericText)(&value)
 }
@@ -556,18 +560,38 @@ func (c *CorporateActionOption101) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption101) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption101) SetCertificationBreakdownIndicator(value string) {
 	c.CertificationBreakdownIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption101) SetCertificationBreakdownIndicatorFromBool(value bool) {
+	c.CertificationBreakdownIndicator = new(YesNoIndicator)
+	c.CertificationBreakdownIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption101) SetWithdrawalAllowedIndicator(value string) {
 	c.WithdrawalAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption101) SetWithdrawalAllowedIndicatorFromBool(value bool) {
+	c.WithdrawalAllowedIndicator = new(YesNoIndicator)
+	c.WithdrawalAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption101) SetChangeAllowedIndicator(value string) {
 	c.ChangeAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption101) SetChangeAllowedIndicatorFromBool(value bool) {
+	c.ChangeAllowedIndicator = new(YesNoIndicator)
+	c.ChangeAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption101) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	c.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return c.FinancialInstrumentIdentification
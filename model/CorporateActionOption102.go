@@ -40,6 +40,10 @@ type CorporateActionOption102 struct {
 	CashMovementDetails []*CashOption30 `xml:"CshMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionOption102) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption102) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
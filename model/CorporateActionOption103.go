@@ -46,6 +46,10 @@ type CorporateActionOption103 struct {
 	AdditionalInformation *CorporateActionNarrative8 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption103) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption103) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -211,6 +215,11 @@ func (c *CorporateActionOption103) SetEligibleForCollateralIndicator(value strin
 	c.EligibleForCollateralIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption103) SetEligibleForCollateralIndicatorFromBool(value bool) {
+	c.EligibleForCollateralIndicator = new(YesNoIndicator)
+	c.EligibleForCollateralIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption103) SetCurrencyToBuy(value string) {
 	c.CurrencyToBuy = (*ActiveCurrencyCode)(&value)
 }
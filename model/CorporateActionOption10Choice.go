@@ -10,6 +10,10 @@ type CorporateActionOption10Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption10Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption10Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption7Code)(&value)
 }
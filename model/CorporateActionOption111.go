@@ -40,6 +40,10 @@ type CorporateActionOption111 struct {
 	CashMovementDetails []*CashOption39 `xml:"CshMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionOption111) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption111) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
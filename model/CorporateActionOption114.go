@@ -81,6 +81,10 @@ type CorporateActionOption114 struct {
 	AdditionalInformation *CorporateActionNarrative29 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption114) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption114) SetOptionNumber(value string) {
 	c.OptionNumber = (*Exact3NumericText)(&value)
 }
@@ -559,22 +563,47 @@ func (c *CorporateActionOption114) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption114) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption114) SetCertificationBreakdownIndicator(value string) {
 	c.CertificationBreakdownIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption114) SetCertificationBreakdownIndicatorFromBool(value bool) {
+	c.CertificationBreakdownIndicator = new(YesNoIndicator)
+	c.CertificationBreakdownIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption114) SetWithdrawalAllowedIndicator(value string) {
 	c.WithdrawalAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption114) SetWithdrawalAllowedIndicatorFromBool(value bool) {
+	c.WithdrawalAllowedIndicator = new(YesNoIndicator)
+	c.WithdrawalAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption114) SetChangeAllowedIndicator(value string) {
 	c.ChangeAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption114) SetChangeAllowedIndicatorFromBool(value bool) {
+	c.ChangeAllowedIndicator = new(YesNoIndicator)
+	c.ChangeAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption114) SetAppliedOptionIndicator(value string) {
 	c.AppliedOptionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption114) SetAppliedOptionIndicatorFromBool(value bool) {
+	c.AppliedOptionIndicator = new(YesNoIndicator)
+	c.AppliedOptionIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption114) AddFinancialInstrumentIdentification() *SecurityIdentification19 {
 	c.FinancialInstrumentIdentification = new(SecurityIdentification19)
 	return c.FinancialInstrumentIdentification
@@ -37,6 +37,10 @@ type CorporateActionOption116 struct {
 	StatusQuantity *Quantity6Choice `xml:"StsQty"`
 }
 
+func (c *CorporateActionOption116) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption116) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -40,6 +40,10 @@ type CorporateActionOption117 struct {
 	CashMovementDetails []*CashOption44 `xml:"CshMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionOption117) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption117) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
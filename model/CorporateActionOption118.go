@@ -46,6 +46,10 @@ type CorporateActionOption118 struct {
 	AdditionalInformation *CorporateActionNarrative32 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption118) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption118) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -211,6 +215,11 @@ func (c *CorporateActionOption118) SetEligibleForCollateralIndicator(value strin
 	c.EligibleForCollateralIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption118) SetEligibleForCollateralIndicatorFromBool(value bool) {
+	c.EligibleForCollateralIndicator = new(YesNoIndicator)
+	c.EligibleForCollateralIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption118) SetCurrencyToBuy(value string) {
 	c.CurrencyToBuy = (*ActiveCurrencyCode)(&value)
 }
@@ -16,6 +16,10 @@ type CorporateActionOption119 struct {
 	CashMovementDetails []*CashOption45 `xml:"CshMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionOption119) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption119) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
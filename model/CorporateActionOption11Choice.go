@@ -10,6 +10,10 @@ type CorporateActionOption11Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption11Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption11Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption8Code)(&value)
 }
@@ -16,6 +16,10 @@ type CorporateActionOption12 struct {
 	CashMovementDetails []*CashOption5 `xml:"CshMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionOption12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption12) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -13,6 +13,10 @@ type CorporateActionOption120 struct {
 	InstructedQuantity *Quantity20Choice `xml:"InstdQty"`
 }
 
+func (c *CorporateActionOption120) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption120) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -37,6 +37,10 @@ type CorporateActionOption121 struct {
 	StatusQuantity *Quantity10Choice `xml:"StsQty"`
 }
 
+func (c *CorporateActionOption121) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption121) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
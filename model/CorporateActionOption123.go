@@ -46,6 +46,10 @@ type CorporateActionOption123 struct {
 	AdditionalInformation *CorporateActionNarrative33 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption123) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption123) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -211,6 +215,11 @@ func (c *CorporateActionOption123) SetEligibleForCollateralIndicator(value strin
 	c.EligibleForCollateralIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption123) SetEligibleForCollateralIndicatorFromBool(value bool) {
+	c.EligibleForCollateralIndicator = new(YesNoIndicator)
+	c.EligibleForCollateralIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption123) SetCurrencyToBuy(value string) {
 	c.CurrencyToBuy = (*ActiveCurrencyCode)(&value)
 }
@@ -40,6 +40,10 @@ type CorporateActionOption124 struct {
 	CashMovementDetails []*CashOption46 `xml:"CshMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionOption124) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption124) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
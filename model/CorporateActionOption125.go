@@ -84,6 +84,10 @@ type CorporateActionOption125 struct {
 	AdditionalInformation *CorporateActionNarrative36 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption125) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption125) SetOptionNumber(value string) {
 	c.OptionNumber = (*Exact3NumericText)(&value)
 }
@@ -567,22 +571,47 @@ func (c *CorporateActionOption125) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption125) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption125) SetCertificationBreakdownIndicator(value string) {
 	c.CertificationBreakdownIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption125) SetCertificationBreakdownIndicatorFromBool(value bool) {
+	c.CertificationBreakdownIndicator = new(YesNoIndicator)
+	c.CertificationBreakdownIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption125) SetWithdrawalAllowedIndicator(value string) {
 	c.WithdrawalAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption125) SetWithdrawalAllowedIndicatorFromBool(value bool) {
+	c.WithdrawalAllowedIndicator = new(YesNoIndicator)
+	c.WithdrawalAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption125) SetChangeAllowedIndicator(value string) {
 	c.ChangeAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption125) SetChangeAllowedIndicatorFromBool(value bool) {
+	c.ChangeAllowedIndicator = new(YesNoIndicator)
+	c.ChangeAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption125) SetAppliedOptionIndicator(value string) {
 	c.AppliedOptionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption125) SetAppliedOptionIndicatorFromBool(value bool) {
+	c.AppliedOptionIndicator = new(YesNoIndicator)
+	c.AppliedOptionIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption125) AddSecurityIdentification() *SecurityIdentification20 {
 	c.SecurityIdentification = new(SecurityIdentification20)
 	return c.SecurityIdentification
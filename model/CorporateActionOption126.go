@@ -16,6 +16,10 @@ type CorporateActionOption126 struct {
 	CashMovementDetails []*CashOption48 `xml:"CshMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionOption126) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption126) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -81,6 +81,10 @@ type CorporateActionOption127 struct {
 	AdditionalInformation *CorporateActionNarrative36 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption127) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption127) SetOptionNumber(value string) {
 	c.OptionNumber = (*Exact3NumericText)(&value)
 }
@@ -559,22 +563,47 @@ func (c *CorporateActionOption127) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption127) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption127) SetCertificationBreakdownIndicator(value string) {
 	c.CertificationBreakdownIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption127) SetCertificationBreakdownIndicatorFromBool(value bool) {
+	c.CertificationBreakdownIndicator = new(YesNoIndicator)
+	c.CertificationBreakdownIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption127) SetWithdrawalAllowedIndicator(value string) {
 	c.WithdrawalAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption127) SetWithdrawalAllowedIndicatorFromBool(value bool) {
+	c.WithdrawalAllowedIndicator = new(YesNoIndicator)
+	c.WithdrawalAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption127) SetChangeAllowedIndicator(value string) {
 	c.ChangeAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption127) SetChangeAllowedIndicatorFromBool(value bool) {
+	c.ChangeAllowedIndicator = new(YesNoIndicator)
+	c.ChangeAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption127) SetAppliedOptionIndicator(value string) {
 	c.AppliedOptionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption127) SetAppliedOptionIndicatorFromBool(value bool) {
+	c.AppliedOptionIndicator = new(YesNoIndicator)
+	c.AppliedOptionIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption127) AddFinancialInstrumentIdentification() *SecurityIdentification20 {
 	c.FinancialInstrumentIdentification = new(SecurityIdentification20)
 	return c.FinancialInstrumentIdentification
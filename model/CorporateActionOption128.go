@@ -13,6 +13,10 @@ type CorporateActionOption128 struct {
 	InstructedQuantity *Quantity40Choice `xml:"InstdQty"`
 }
 
+func (c *CorporateActionOption128) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption128) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -84,6 +84,10 @@ type CorporateActionOption129 struct {
 	AdditionalInformation *CorporateActionNarrative29 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption129) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption129) SetOptionNumber(value string) {
 	c.OptionNumber = (*Exact3NumericText)(&value)
 }
@@ -567,22 +571,47 @@ func (c *CorporateActionOption129) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption129) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption129) SetCertificationBreakdownIndicator(value string) {
 	c.CertificationBreakdownIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption129) SetCertificationBreakdownIndicatorFromBool(value bool) {
+	c.CertificationBreakdownIndicator = new(YesNoIndicator)
+	c.CertificationBreakdownIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption129) SetWithdrawalAllowedIndicator(value string) {
 	c.WithdrawalAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption129) SetWithdrawalAllowedIndicatorFromBool(value bool) {
+	c.WithdrawalAllowedIndicator = new(YesNoIndicator)
+	c.WithdrawalAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption129) SetChangeAllowedIndicator(value string) {
 	c.ChangeAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption129) SetChangeAllowedIndicatorFromBool(value bool) {
+	c.ChangeAllowedIndicator = new(YesNoIndicator)
+	c.ChangeAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption129) SetAppliedOptionIndicator(value string) {
 	c.AppliedOptionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption129) SetAppliedOptionIndicatorFromBool(value bool) {
+	c.AppliedOptionIndicator = new(YesNoIndicator)
+	c.AppliedOptionIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption129) AddSecurityIdentification() *SecurityIdentification19 {
 	c.SecurityIdentification = new(SecurityIdentification19)
 	return c.SecurityIdentification
@@ -10,6 +10,10 @@ type CorporateActionOption12Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption12Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption12Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption9Code)(&value)
 }
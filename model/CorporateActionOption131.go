@@ -46,6 +46,10 @@ type CorporateActionOption131 struct {
 	AdditionalInformation *CorporateActionNarrative32 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption131) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption131) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -211,6 +215,11 @@ func (c *CorporateActionOption131) SetEligibleForCollateralIndicator(value strin
 	c.EligibleForCollateralIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption131) SetEligibleForCollateralIndicatorFromBool(value bool) {
+	c.EligibleForCollateralIndicator = new(YesNoIndicator)
+	c.EligibleForCollateralIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption131) SetCurrencyToBuy(value string) {
 	c.CurrencyToBuy = (*ActiveCurrencyCode)(&value)
 }
@@ -40,6 +40,10 @@ type CorporateActionOption132 struct {
 	CashMovementDetails []*CashOption52 `xml:"CshMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionOption132) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption132) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -46,6 +46,10 @@ type CorporateActionOption134 struct {
 	AdditionalInformation *CorporateActionNarrative33 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption134) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption134) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -211,6 +215,11 @@ func (c *CorporateActionOption134) SetEligibleForCollateralIndicator(value strin
 	c.EligibleForCollateralIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption134) SetEligibleForCollateralIndicatorFromBool(value bool) {
+	c.EligibleForCollateralIndicator = new(YesNoIndicator)
+	c.EligibleForCollateralIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption134) SetCurrencyToBuy(value string) {
 	c.CurrencyToBuy = (*ActiveCurrencyCode)(&value)
 }
@@ -40,6 +40,10 @@ type CorporateActionOption136 struct {
 	CashMovementDetails []*CashOption55 `xml:"CshMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionOption136) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption136) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
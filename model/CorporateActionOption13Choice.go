@@ -10,6 +10,10 @@ type CorporateActionOption13Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption13Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption13Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption10Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionOption18Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption18Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption18Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption7Code)(&value)
 }
@@ -78,6 +78,10 @@ type CorporateActionOption19 struct {
 	AdditionalInformation *CorporateActionNarrative5 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption19) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption19) SetOptionNumber(value string) {
 	c.OptionNumber = (*Exact3NumericText)(&value)
 }
@@ -556,18 +560,38 @@ func (c *CorporateActionOption19) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption19) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption19) SetCertificationIndicator(value string) {
 	c.CertificationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption19) SetCertificationIndicatorFromBool(value bool) {
+	c.CertificationIndicator = new(YesNoIndicator)
+	c.CertificationIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption19) SetWithdrawalAllowedIndicator(value string) {
 	c.WithdrawalAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption19) SetWithdrawalAllowedIndicatorFromBool(value bool) {
+	c.WithdrawalAllowedIndicator = new(YesNoIndicator)
+	c.WithdrawalAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption19) SetChangeAllowedIndicator(value string) {
 	c.ChangeAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption19) SetChangeAllowedIndicatorFromBool(value bool) {
+	c.ChangeAllowedIndicator = new(YesNoIndicator)
+	c.ChangeAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption19) AddSecurityIdentification() *SecurityIdentification14 {
 	c.SecurityIdentification = new(SecurityIdentification14)
 	return c.SecurityIdentification
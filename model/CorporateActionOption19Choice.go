@@ -10,6 +10,10 @@ type CorporateActionOption19Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption19Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption19Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption8Code)(&value)
 }
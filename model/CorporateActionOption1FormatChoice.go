@@ -10,6 +10,10 @@ type CorporateActionOption1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption1FormatChoice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption1FormatChoice) SetCode(value string) {
 	c.Code = (*CorporateActionOptionType1Code)(&value)
 }
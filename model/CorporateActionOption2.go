@@ -10,6 +10,10 @@ type CorporateActionOption2 struct {
 	OptionNumber *Exact3NumericText `xml:"OptnNb"`
 }
 
+func (c *CorporateActionOption2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption2) AddOptionType() *CorporateActionOption1FormatChoice {
 	c.OptionType = new(CorporateActionOption1FormatChoice)
 	return c.OptionType
@@ -81,6 +81,10 @@ type CorporateActionOption20 struct {
 	AdditionalInformation *CorporateActionNarrative5 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption20) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption20) SetOptionNumber(value string) {
 	c.OptionNumber = (*Exact3NumericText)(&value)
 }
@@ -564,18 +568,38 @@ func (c *CorporateActionOption20) SetChargesAppliedIndicator(value string) {
 	c.ChargesAppliedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption20) SetChargesAppliedIndicatorFromBool(value bool) {
+	c.ChargesAppliedIndicator = new(YesNoIndicator)
+	c.ChargesAppliedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption20) SetCertificationIndicator(value string) {
 	c.CertificationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption20) SetCertificationIndicatorFromBool(value bool) {
+	c.CertificationIndicator = new(YesNoIndicator)
+	c.CertificationIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption20) SetWithdrawalAllowedIndicator(value string) {
 	c.WithdrawalAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption20) SetWithdrawalAllowedIndicatorFromBool(value bool) {
+	c.WithdrawalAllowedIndicator = new(YesNoIndicator)
+	c.WithdrawalAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption20) SetChangeAllowedIndicator(value string) {
 	c.ChangeAllowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption20) SetChangeAllowedIndicatorFromBool(value bool) {
+	c.ChangeAllowedIndicator = new(YesNoIndicator)
+	c.ChangeAllowedIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption20) AddSecurityIdentification() *SecurityIdentification14 {
 	c.SecurityIdentification = new(SecurityIdentification14)
 	return c.SecurityIdentification
@@ -10,6 +10,10 @@ type CorporateActionOption20Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption20Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption20Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption9Code)(&value)
 }
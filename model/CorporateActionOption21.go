@@ -13,6 +13,10 @@ type CorporateActionOption21 struct {
 	InstructedOrQuantityToReceive *InstructedOrQuantityToReceive1Choice `xml:"InstdOrQtyToRcv"`
 }
 
+func (c *CorporateActionOption21) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption21) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
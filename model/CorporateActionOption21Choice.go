@@ -10,6 +10,10 @@ type CorporateActionOption21Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption21Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption21Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption10Code)(&value)
 }
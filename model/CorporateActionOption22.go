@@ -37,6 +37,10 @@ type CorporateActionOption22 struct {
 	StatusQuantityOrQuantityToReceive *StatusOrQuantityToReceive1Choice `xml:"StsQtyOrQtyToRcv,omitempty"`
 }
 
+func (c *CorporateActionOption22) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption22) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
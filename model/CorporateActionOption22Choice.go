@@ -10,6 +10,10 @@ type CorporateActionOption22Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption22Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption22Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption10Code)(&value)
 }
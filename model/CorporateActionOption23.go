@@ -40,6 +40,10 @@ type CorporateActionOption23 struct {
 	CashMovementDetails []*CashOption12 `xml:"CshMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionOption23) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption23) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -10,6 +10,10 @@ type CorporateActionOption23Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption23Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption23Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption7Code)(&value)
 }
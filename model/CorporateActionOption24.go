@@ -16,6 +16,10 @@ type CorporateActionOption24 struct {
 	CashMovementDetails []*CashOption5 `xml:"CshMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionOption24) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption24) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
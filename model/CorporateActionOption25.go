@@ -46,6 +46,10 @@ type CorporateActionOption25 struct {
 	AdditionalInformation *CorporateActionNarrative8 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption25) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption25) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -211,6 +215,11 @@ func (c *CorporateActionOption25) SetEligibleForCollateralIndicator(value string
 	c.EligibleForCollateralIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption25) SetEligibleForCollateralIndicatorFromBool(value bool) {
+	c.EligibleForCollateralIndicator = new(YesNoIndicator)
+	c.EligibleForCollateralIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption25) SetCurrencyToBuy(value string) {
 	c.CurrencyToBuy = (*ActiveCurrencyCode)(&value)
 }
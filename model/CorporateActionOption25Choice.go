@@ -10,6 +10,10 @@ type CorporateActionOption25Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption25Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption25Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption9Code)(&value)
 }
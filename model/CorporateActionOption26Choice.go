@@ -10,6 +10,10 @@ type CorporateActionOption26Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption26Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption26Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption8Code)(&value)
 }
@@ -10,6 +10,10 @@ type CorporateActionOption29Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption29Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption29Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption9Code)(&value)
 }
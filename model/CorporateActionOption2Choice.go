@@ -10,6 +10,10 @@ type CorporateActionOption2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption2Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption2Code)(&value)
 }
@@ -40,6 +40,10 @@ type CorporateActionOption37 struct {
 	CashMovementDetails []*CashOption18 `xml:"CshMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionOption37) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption37) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -46,6 +46,10 @@ type CorporateActionOption38 struct {
 	AdditionalInformation *CorporateActionNarrative8 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption38) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption38) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -211,6 +215,11 @@ func (c *CorporateActionOption38) SetEligibleForCollateralIndicator(value string
 	c.EligibleForCollateralIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption38) SetEligibleForCollateralIndicatorFromBool(value bool) {
+	c.EligibleForCollateralIndicator = new(YesNoIndicator)
+	c.EligibleForCollateralIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption38) SetCurrencyToBuy(value string) {
 	c.CurrencyToBuy = (*ActiveCurrencyCode)(&value)
 }
@@ -16,6 +16,10 @@ type CorporateActionOption39 struct {
 	CashMovementDetails []*CashOption19 `xml:"CshMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionOption39) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption39) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -10,6 +10,10 @@ type CorporateActionOption3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption3Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption3Code)(&value)
 }
@@ -40,6 +40,10 @@ type CorporateActionOption4 struct {
 	CashMovementDetails []*CashOption2 `xml:"CshMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionOption4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption4) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
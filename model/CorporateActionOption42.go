@@ -13,6 +13,10 @@ type CorporateActionOption42 struct {
 	InstructedOrQuantityToReceive *InstructedOrQuantityToReceive1Choice `xml:"InstdOrQtyToRcv"`
 }
 
+func (c *CorporateActionOption42) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption42) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -10,6 +10,10 @@ type CorporateActionOption4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption4Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption4Code)(&value)
 }
@@ -40,6 +40,10 @@ type CorporateActionOption53 struct {
 	CashMovementDetails []*CashOption26 `xml:"CshMvmntDtls,omitempty"`
 }
 
+func (c *CorporateActionOption53) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption53) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
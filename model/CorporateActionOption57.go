@@ -46,6 +46,10 @@ type CorporateActionOption57 struct {
 	AdditionalInformation *CorporateActionNarrative8 `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionOption57) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption57) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
@@ -211,6 +215,11 @@ func (c *CorporateActionOption57) SetEligibleForCollateralIndicator(value string
 	c.EligibleForCollateralIndicator = (*YesNoIndicator)(&value)
 }
 
+func (c *CorporateActionOption57) SetEligibleForCollateralIndicatorFromBool(value bool) {
+	c.EligibleForCollateralIndicator = new(YesNoIndicator)
+	c.EligibleForCollateralIndicator.FromBool(value)
+}
+
 func (c *CorporateActionOption57) SetCurrencyToBuy(value string) {
 	c.CurrencyToBuy = (*ActiveCurrencyCode)(&value)
 }
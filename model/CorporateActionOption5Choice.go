@@ -10,6 +10,10 @@ type CorporateActionOption5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionOption5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption5Choice) SetCode(value string) {
 	c.Code = (*CorporateActionOption6Code)(&value)
 }
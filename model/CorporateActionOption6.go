@@ -13,6 +13,10 @@ type CorporateActionOption6 struct {
 	InstructedOrQuantityToReceive *InstructedOrQuantityToReceive1Choice `xml:"InstdOrQtyToRcv"`
 }
 
+func (c *CorporateActionOption6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption6) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
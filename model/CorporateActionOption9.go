@@ -37,6 +37,10 @@ type CorporateActionOption9 struct {
 	StatusQuantityOrQuantityToReceive *StatusOrQuantityToReceive1Choice `xml:"StsQtyOrQtyToRcv,omitempty"`
 }
 
+func (c *CorporateActionOption9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionOption9) AddOptionNumber() *OptionNumber1Choice {
 	c.OptionNumber = new(OptionNumber1Choice)
 	return c.OptionNumber
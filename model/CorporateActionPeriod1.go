@@ -19,6 +19,10 @@ type CorporateActionPeriod1 struct {
 	PriceCalculationPeriod *Period1 `xml:"PricClctnPrd,omitempty"`
 }
 
+func (c *CorporateActionPeriod1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPeriod1) AddActionPeriod() *Period1 {
 	c.ActionPeriod = new(Period1)
 	return c.ActionPeriod
@@ -52,6 +52,10 @@ type CorporateActionPeriod10 struct {
 	SplitPeriod *Period3Choice `xml:"SpltPrd,omitempty"`
 }
 
+func (c *CorporateActionPeriod10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPeriod10) AddPriceCalculationPeriod() *Period3Choice {
 	c.PriceCalculationPeriod = new(Period3Choice)
 	return c.PriceCalculationPeriod
@@ -13,6 +13,10 @@ type CorporateActionPeriod11 struct {
 	ParallelTradingPeriod *Period4 `xml:"ParllTradgPrd,omitempty"`
 }
 
+func (c *CorporateActionPeriod11) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPeriod11) AddPriceCalculationPeriod() *Period4 {
 	c.PriceCalculationPeriod = new(Period4)
 	return c.PriceCalculationPeriod
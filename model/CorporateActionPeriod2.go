@@ -25,6 +25,10 @@ type CorporateActionPeriod2 struct {
 	PriceCalculationPeriod *Period1 `xml:"PricClctnPrd,omitempty"`
 }
 
+func (c *CorporateActionPeriod2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPeriod2) AddAssentedLinePeriod() *Period1 {
 	c.AssentedLinePeriod = new(Period1)
 	return c.AssentedLinePeriod
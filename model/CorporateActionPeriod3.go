@@ -46,6 +46,10 @@ type CorporateActionPeriod3 struct {
 	BookClosurePeriod *Period1Choice `xml:"BookClsrPrd,omitempty"`
 }
 
+func (c *CorporateActionPeriod3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPeriod3) AddPriceCalculationPeriod() *Period1Choice {
 	c.PriceCalculationPeriod = new(Period1Choice)
 	return c.PriceCalculationPeriod
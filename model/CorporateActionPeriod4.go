@@ -13,6 +13,10 @@ type CorporateActionPeriod4 struct {
 	ParallelTradingPeriod *Period3 `xml:"ParllTradgPrd,omitempty"`
 }
 
+func (c *CorporateActionPeriod4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPeriod4) AddPriceCalculationPeriod() *Period3 {
 	c.PriceCalculationPeriod = new(Period3)
 	return c.PriceCalculationPeriod
@@ -25,6 +25,10 @@ type CorporateActionPeriod5 struct {
 	DepositorySuspensionPeriodForWithdrawal *Period1Choice `xml:"DpstrySspnsnPrdForWdrwl,omitempty"`
 }
 
+func (c *CorporateActionPeriod5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPeriod5) AddPriceCalculationPeriod() *Period1Choice {
 	c.PriceCalculationPeriod = new(Period1Choice)
 	return c.PriceCalculationPeriod
@@ -46,6 +46,10 @@ type CorporateActionPeriod6 struct {
 	BookClosurePeriod *Period3Choice `xml:"BookClsrPrd,omitempty"`
 }
 
+func (c *CorporateActionPeriod6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPeriod6) AddPriceCalculationPeriod() *Period3Choice {
 	c.PriceCalculationPeriod = new(Period3Choice)
 	return c.PriceCalculationPeriod
@@ -25,6 +25,10 @@ type CorporateActionPeriod7 struct {
 	DepositorySuspensionPeriodForWithdrawal *Period3Choice `xml:"DpstrySspnsnPrdForWdrwl,omitempty"`
 }
 
+func (c *CorporateActionPeriod7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPeriod7) AddPriceCalculationPeriod() *Period3Choice {
 	c.PriceCalculationPeriod = new(Period3Choice)
 	return c.PriceCalculationPeriod
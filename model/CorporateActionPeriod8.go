@@ -49,6 +49,10 @@ type CorporateActionPeriod8 struct {
 	CoDepositoriesSuspensionPeriod *Period3Choice `xml:"CoDpstriesSspnsnPrd,omitempty"`
 }
 
+func (c *CorporateActionPeriod8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPeriod8) AddPriceCalculationPeriod() *Period3Choice {
 	c.PriceCalculationPeriod = new(Period3Choice)
 	return c.PriceCalculationPeriod
@@ -13,6 +13,10 @@ type CorporateActionPeriod9 struct {
 	ParallelTradingPeriod *Period5 `xml:"ParllTradgPrd,omitempty"`
 }
 
+func (c *CorporateActionPeriod9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPeriod9) AddPriceCalculationPeriod() *Period5 {
 	c.PriceCalculationPeriod = new(Period5)
 	return c.PriceCalculationPeriod
@@ -14,6 +14,10 @@ type CorporateActionPreliminaryAdviceType1 struct {
 	EligibilityIndicator *YesNoIndicator `xml:"ElgbltyInd,omitempty"`
 }
 
+func (c *CorporateActionPreliminaryAdviceType1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPreliminaryAdviceType1) SetType(value string) {
 	c.Type = (*CorporateActionPreliminaryAdviceType1Code)(&value)
 }
@@ -26,3 +30,8 @@ func (c *CorporateActionPreliminaryAdviceType1) AddProcessingStatus() *Corporate
 func (c *CorporateActionPreliminaryAdviceType1) SetEligibilityIndicator(value string) {
 	c.EligibilityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (c *CorporateActionPreliminaryAdviceType1) SetEligibilityIndicatorFromBool(value bool) {
+	c.EligibilityIndicator = new(YesNoIndicator)
+	c.EligibilityIndicator.FromBool(value)
+}
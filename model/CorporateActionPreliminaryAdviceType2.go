@@ -11,6 +11,10 @@ type CorporateActionPreliminaryAdviceType2 struct {
 	EligibilityIndicator *YesNoIndicator `xml:"ElgbltyInd,omitempty"`
 }
 
+func (c *CorporateActionPreliminaryAdviceType2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPreliminaryAdviceType2) SetType(value string) {
 	c.Type = (*CorporateActionPreliminaryAdviceType1Code)(&value)
 }
@@ -18,3 +22,8 @@ func (c *CorporateActionPreliminaryAdviceType2) SetType(value string) {
 func (c *CorporateActionPreliminaryAdviceType2) SetEligibilityIndicator(value string) {
 	c.EligibilityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (c *CorporateActionPreliminaryAdviceType2) SetEligibilityIndicatorFromBool(value bool) {
+	c.EligibilityIndicator = new(YesNoIndicator)
+	c.EligibilityIndicator.FromBool(value)
+}
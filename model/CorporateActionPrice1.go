@@ -27,6 +27,10 @@ type CorporateActionPrice1 struct {
 	OverSubscriptionDepositPrice *PriceFormat2Choice `xml:"OverSbcptDpstPric,omitempty"`
 }
 
+func (c *CorporateActionPrice1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice1) AddExercisePrice() *PriceFormat4Choice {
 	c.ExercisePrice = new(PriceFormat4Choice)
 	return c.ExercisePrice
@@ -10,6 +10,10 @@ type CorporateActionPrice10 struct {
 	CashInLieuOfSharePrice *PriceFormat11Choice `xml:"CshInLieuOfShrPric,omitempty"`
 }
 
+func (c *CorporateActionPrice10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice10) AddIndicativeOrMarketPrice() *IndicativeOrMarketPrice1Choice {
 	c.IndicativeOrMarketPrice = new(IndicativeOrMarketPrice1Choice)
 	return c.IndicativeOrMarketPrice
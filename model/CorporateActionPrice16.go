@@ -13,6 +13,10 @@ type CorporateActionPrice16 struct {
 	OverSubscriptionDepositPrice *PriceFormat19Choice `xml:"OverSbcptDpstPric,omitempty"`
 }
 
+func (c *CorporateActionPrice16) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice16) AddCashInLieuOfSharePrice() *PriceFormat19Choice {
 	c.CashInLieuOfSharePrice = new(PriceFormat19Choice)
 	return c.CashInLieuOfSharePrice
@@ -10,6 +10,10 @@ type CorporateActionPrice17 struct {
 	MinimumPrice *PriceFormat19Choice `xml:"MinPric,omitempty"`
 }
 
+func (c *CorporateActionPrice17) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice17) AddMaximumPrice() *PriceFormat19Choice {
 	c.MaximumPrice = new(PriceFormat19Choice)
 	return c.MaximumPrice
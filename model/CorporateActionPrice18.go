@@ -10,6 +10,10 @@ type CorporateActionPrice18 struct {
 	CashInLieuOfSharePrice *PriceFormat19Choice `xml:"CshInLieuOfShrPric,omitempty"`
 }
 
+func (c *CorporateActionPrice18) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice18) AddIndicativeOrMarketPrice() *IndicativeOrMarketPrice5Choice {
 	c.IndicativeOrMarketPrice = new(IndicativeOrMarketPrice5Choice)
 	return c.IndicativeOrMarketPrice
@@ -21,6 +21,10 @@ type CorporateActionPrice19 struct {
 	GenericCashPricePaidPerProduct *PriceFormat5Choice `xml:"GncCshPricPdPerPdct,omitempty"`
 }
 
+func (c *CorporateActionPrice19) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice19) AddIndicativeOrMarketPrice() *IndicativeOrMarketPrice2Choice {
 	c.IndicativeOrMarketPrice = new(IndicativeOrMarketPrice2Choice)
 	return c.IndicativeOrMarketPrice
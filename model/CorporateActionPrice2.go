@@ -10,6 +10,10 @@ type CorporateActionPrice2 struct {
 	MinimumPrice *PriceFormat3Choice `xml:"MinPric,omitempty"`
 }
 
+func (c *CorporateActionPrice2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice2) AddMaximumPrice() *PriceFormat3Choice {
 	c.MaximumPrice = new(PriceFormat3Choice)
 	return c.MaximumPrice
@@ -13,6 +13,10 @@ type CorporateActionPrice21 struct {
 	OverSubscriptionDepositPrice *PriceFormat5Choice `xml:"OverSbcptDpstPric,omitempty"`
 }
 
+func (c *CorporateActionPrice21) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice21) AddGenericCashPriceReceivedPerProduct() *PriceFormat7Choice {
 	newValue := new(PriceFormat7Choice)
 	c.GenericCashPriceReceivedPerProduct = append(c.GenericCashPriceReceivedPerProduct, newValue)
@@ -19,6 +19,10 @@ type CorporateActionPrice26 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat20Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (c *CorporateActionPrice26) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice26) AddIndicativeOrMarketPrice() *IndicativeOrMarketPrice5Choice {
 	c.IndicativeOrMarketPrice = new(IndicativeOrMarketPrice5Choice)
 	return c.IndicativeOrMarketPrice
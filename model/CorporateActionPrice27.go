@@ -19,6 +19,10 @@ type CorporateActionPrice27 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat7Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (c *CorporateActionPrice27) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice27) AddCashInLieuOfSharePrice() *PriceFormat5Choice {
 	c.CashInLieuOfSharePrice = new(PriceFormat5Choice)
 	return c.CashInLieuOfSharePrice
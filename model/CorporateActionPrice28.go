@@ -10,6 +10,10 @@ type CorporateActionPrice28 struct {
 	OverSubscriptionDepositPrice *PriceFormat19Choice `xml:"OverSbcptDpstPric,omitempty"`
 }
 
+func (c *CorporateActionPrice28) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice28) AddCashInLieuOfSharePrice() *PriceFormat19Choice {
 	c.CashInLieuOfSharePrice = new(PriceFormat19Choice)
 	return c.CashInLieuOfSharePrice
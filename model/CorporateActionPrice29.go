@@ -16,6 +16,10 @@ type CorporateActionPrice29 struct {
 	GenericCashPricePaidPerProduct *PriceFormat5Choice `xml:"GncCshPricPdPerPdct,omitempty"`
 }
 
+func (c *CorporateActionPrice29) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice29) AddIndicativeOrMarketPrice() *IndicativeOrMarketPrice2Choice {
 	c.IndicativeOrMarketPrice = new(IndicativeOrMarketPrice2Choice)
 	return c.IndicativeOrMarketPrice
@@ -10,6 +10,10 @@ type CorporateActionPrice3 struct {
 	MinimumPrice *PriceFormat11Choice `xml:"MinPric,omitempty"`
 }
 
+func (c *CorporateActionPrice3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice3) AddMaximumPrice() *PriceFormat11Choice {
 	c.MaximumPrice = new(PriceFormat11Choice)
 	return c.MaximumPrice
@@ -10,6 +10,10 @@ type CorporateActionPrice30 struct {
 	OverSubscriptionDepositPrice *PriceFormat5Choice `xml:"OverSbcptDpstPric,omitempty"`
 }
 
+func (c *CorporateActionPrice30) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice30) AddCashInLieuOfSharePrice() *PriceFormat5Choice {
 	c.CashInLieuOfSharePrice = new(PriceFormat5Choice)
 	return c.CashInLieuOfSharePrice
@@ -19,6 +19,10 @@ type CorporateActionPrice39 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat7Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (c *CorporateActionPrice39) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice39) AddCashInLieuOfSharePrice() *PriceFormat5Choice {
 	c.CashInLieuOfSharePrice = new(PriceFormat5Choice)
 	return c.CashInLieuOfSharePrice
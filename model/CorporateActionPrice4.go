@@ -10,6 +10,10 @@ type CorporateActionPrice4 struct {
 	MarketPrice *PriceFormat2Choice `xml:"MktPric,omitempty"`
 }
 
+func (c *CorporateActionPrice4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice4) AddIndicativePrice() *PriceFormat2Choice {
 	c.IndicativePrice = new(PriceFormat2Choice)
 	return c.IndicativePrice
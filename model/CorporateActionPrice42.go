@@ -10,6 +10,10 @@ type CorporateActionPrice42 struct {
 	MinimumPrice *PriceFormat23Choice `xml:"MinPric,omitempty"`
 }
 
+func (c *CorporateActionPrice42) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice42) AddMaximumPrice() *PriceFormat23Choice {
 	c.MaximumPrice = new(PriceFormat23Choice)
 	return c.MaximumPrice
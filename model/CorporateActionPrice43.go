@@ -19,6 +19,10 @@ type CorporateActionPrice43 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat32Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (c *CorporateActionPrice43) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice43) AddIndicativeOrMarketPrice() *IndicativeOrMarketPrice5Choice {
 	c.IndicativeOrMarketPrice = new(IndicativeOrMarketPrice5Choice)
 	return c.IndicativeOrMarketPrice
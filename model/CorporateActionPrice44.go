@@ -16,6 +16,10 @@ type CorporateActionPrice44 struct {
 	GenericCashPricePaidPerProduct *PriceFormat5Choice `xml:"GncCshPricPdPerPdct,omitempty"`
 }
 
+func (c *CorporateActionPrice44) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice44) AddIndicativeOrMarketPrice() *IndicativeOrMarketPrice2Choice {
 	c.IndicativeOrMarketPrice = new(IndicativeOrMarketPrice2Choice)
 	return c.IndicativeOrMarketPrice
@@ -19,6 +19,10 @@ type CorporateActionPrice45 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat34Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (c *CorporateActionPrice45) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice45) AddCashInLieuOfSharePrice() *PriceFormat5Choice {
 	c.CashInLieuOfSharePrice = new(PriceFormat5Choice)
 	return c.CashInLieuOfSharePrice
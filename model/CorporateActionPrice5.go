@@ -24,6 +24,10 @@ type CorporateActionPrice5 struct {
 	OverSubscriptionDepositPrice *PriceFormat5Choice `xml:"OverSbcptDpstPric,omitempty"`
 }
 
+func (c *CorporateActionPrice5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice5) AddExercisePrice() *PriceFormat6Choice {
 	c.ExercisePrice = new(PriceFormat6Choice)
 	return c.ExercisePrice
@@ -19,6 +19,10 @@ type CorporateActionPrice56 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat47Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (c *CorporateActionPrice56) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice56) AddIndicativeOrMarketPrice() *IndicativeOrMarketPrice7Choice {
 	c.IndicativeOrMarketPrice = new(IndicativeOrMarketPrice7Choice)
 	return c.IndicativeOrMarketPrice
@@ -10,6 +10,10 @@ type CorporateActionPrice57 struct {
 	MinimumPrice *PriceFormat44Choice `xml:"MinPric,omitempty"`
 }
 
+func (c *CorporateActionPrice57) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice57) AddMaximumPrice() *PriceFormat44Choice {
 	c.MaximumPrice = new(PriceFormat44Choice)
 	return c.MaximumPrice
@@ -10,6 +10,10 @@ type CorporateActionPrice58 struct {
 	OverSubscriptionDepositPrice *PriceFormat45Choice `xml:"OverSbcptDpstPric,omitempty"`
 }
 
+func (c *CorporateActionPrice58) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice58) AddCashInLieuOfSharePrice() *PriceFormat45Choice {
 	c.CashInLieuOfSharePrice = new(PriceFormat45Choice)
 	return c.CashInLieuOfSharePrice
@@ -19,6 +19,10 @@ type CorporateActionPrice59 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat48Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (c *CorporateActionPrice59) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice59) AddCashInLieuOfSharePrice() *PriceFormat50Choice {
 	c.CashInLieuOfSharePrice = new(PriceFormat50Choice)
 	return c.CashInLieuOfSharePrice
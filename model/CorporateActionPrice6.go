@@ -24,6 +24,10 @@ type CorporateActionPrice6 struct {
 	TaxableIncomePerDividendShare *AmountPrice3 `xml:"TaxblIncmPerDvddShr,omitempty"`
 }
 
+func (c *CorporateActionPrice6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice6) AddCashInLieuOfSharePrice() *PriceFormat11Choice {
 	c.CashInLieuOfSharePrice = new(PriceFormat11Choice)
 	return c.CashInLieuOfSharePrice
@@ -16,6 +16,10 @@ type CorporateActionPrice60 struct {
 	GenericCashPricePaidPerProduct *PriceFormat50Choice `xml:"GncCshPricPdPerPdct,omitempty"`
 }
 
+func (c *CorporateActionPrice60) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice60) AddIndicativeOrMarketPrice() *IndicativeOrMarketPrice8Choice {
 	c.IndicativeOrMarketPrice = new(IndicativeOrMarketPrice8Choice)
 	return c.IndicativeOrMarketPrice
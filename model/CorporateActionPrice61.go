@@ -10,6 +10,10 @@ type CorporateActionPrice61 struct {
 	OverSubscriptionDepositPrice *PriceFormat50Choice `xml:"OverSbcptDpstPric,omitempty"`
 }
 
+func (c *CorporateActionPrice61) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice61) AddCashInLieuOfSharePrice() *PriceFormat50Choice {
 	c.CashInLieuOfSharePrice = new(PriceFormat50Choice)
 	return c.CashInLieuOfSharePrice
@@ -16,6 +16,10 @@ type CorporateActionPrice62 struct {
 	GenericCashPricePaidPerProduct *PriceFormat52Choice `xml:"GncCshPricPdPerPdct,omitempty"`
 }
 
+func (c *CorporateActionPrice62) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice62) AddIndicativeOrMarketPrice() *IndicativeOrMarketPrice9Choice {
 	c.IndicativeOrMarketPrice = new(IndicativeOrMarketPrice9Choice)
 	return c.IndicativeOrMarketPrice
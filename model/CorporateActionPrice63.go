@@ -10,6 +10,10 @@ type CorporateActionPrice63 struct {
 	OverSubscriptionDepositPrice *PriceFormat52Choice `xml:"OverSbcptDpstPric,omitempty"`
 }
 
+func (c *CorporateActionPrice63) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice63) AddCashInLieuOfSharePrice() *PriceFormat52Choice {
 	c.CashInLieuOfSharePrice = new(PriceFormat52Choice)
 	return c.CashInLieuOfSharePrice
@@ -19,6 +19,10 @@ type CorporateActionPrice64 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat56Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (c *CorporateActionPrice64) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice64) AddCashInLieuOfSharePrice() *PriceFormat52Choice {
 	c.CashInLieuOfSharePrice = new(PriceFormat52Choice)
 	return c.CashInLieuOfSharePrice
@@ -10,6 +10,10 @@ type CorporateActionPrice65 struct {
 	OverSubscriptionDepositPrice *PriceFormat57Choice `xml:"OverSbcptDpstPric,omitempty"`
 }
 
+func (c *CorporateActionPrice65) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice65) AddCashInLieuOfSharePrice() *PriceFormat57Choice {
 	c.CashInLieuOfSharePrice = new(PriceFormat57Choice)
 	return c.CashInLieuOfSharePrice
@@ -19,6 +19,10 @@ type CorporateActionPrice66 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat60Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (c *CorporateActionPrice66) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice66) AddIndicativeOrMarketPrice() *IndicativeOrMarketPrice11Choice {
 	c.IndicativeOrMarketPrice = new(IndicativeOrMarketPrice11Choice)
 	return c.IndicativeOrMarketPrice
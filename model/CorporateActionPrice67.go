@@ -10,6 +10,10 @@ type CorporateActionPrice67 struct {
 	MinimumPrice *PriceFormat59Choice `xml:"MinPric,omitempty"`
 }
 
+func (c *CorporateActionPrice67) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice67) AddMaximumPrice() *PriceFormat59Choice {
 	c.MaximumPrice = new(PriceFormat59Choice)
 	return c.MaximumPrice
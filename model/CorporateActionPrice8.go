@@ -21,6 +21,10 @@ type CorporateActionPrice8 struct {
 	GenericCashPricePaidPerProduct *PriceFormat5Choice `xml:"GncCshPricPdPerPdct,omitempty"`
 }
 
+func (c *CorporateActionPrice8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice8) AddIndicativeOrMarketPrice() *IndicativeOrMarketPrice2Choice {
 	c.IndicativeOrMarketPrice = new(IndicativeOrMarketPrice2Choice)
 	return c.IndicativeOrMarketPrice
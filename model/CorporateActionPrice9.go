@@ -10,6 +10,10 @@ type CorporateActionPrice9 struct {
 	IndicativeOrMarketPrice *IndicativeOrMarketPrice2Choice `xml:"IndctvOrMktPric,omitempty"`
 }
 
+func (c *CorporateActionPrice9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionPrice9) AddCashInLieuOfSharePrice() *PriceFormat5Choice {
 	c.CashInLieuOfSharePrice = new(PriceFormat5Choice)
 	return c.CashInLieuOfSharePrice
@@ -10,6 +10,10 @@ type CorporateActionProcessingStatus1Choice struct {
 	ForInformationOnlyIndicator *YesNoIndicator `xml:"ForInfOnlyInd"`
 }
 
+func (c *CorporateActionProcessingStatus1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionProcessingStatus1Choice) AddEventStatus() *CorporateActionEventStatus1 {
 	c.EventStatus = new(CorporateActionEventStatus1)
 	return c.EventStatus
@@ -18,3 +22,8 @@ func (c *CorporateActionProcessingStatus1Choice) AddEventStatus() *CorporateActi
 func (c *CorporateActionProcessingStatus1Choice) SetForInformationOnlyIndicator(value string) {
 	c.ForInformationOnlyIndicator = (*YesNoIndicator)(&value)
 }
+
+func (c *CorporateActionProcessingStatus1Choice) SetForInformationOnlyIndicatorFromBool(value bool) {
+	c.ForInformationOnlyIndicator = new(YesNoIndicator)
+	c.ForInformationOnlyIndicator.FromBool(value)
+}
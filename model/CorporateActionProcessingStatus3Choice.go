@@ -10,6 +10,10 @@ type CorporateActionProcessingStatus3Choice struct {
 	Proprietary *GenericIdentification25 `xml:"Prtry"`
 }
 
+func (c *CorporateActionProcessingStatus3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionProcessingStatus3Choice) AddCode() *CorporateActionProcessingStatus1Choice {
 	c.Code = new(CorporateActionProcessingStatus1Choice)
 	return c.Code
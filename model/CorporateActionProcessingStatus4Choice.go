@@ -10,6 +10,10 @@ type CorporateActionProcessingStatus4Choice struct {
 	Proprietary *GenericIdentification25 `xml:"Prtry"`
 }
 
+func (c *CorporateActionProcessingStatus4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionProcessingStatus4Choice) AddCode() *CorporateActionEventStatus1 {
 	c.Code = new(CorporateActionEventStatus1)
 	return c.Code
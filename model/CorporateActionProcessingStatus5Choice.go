@@ -10,6 +10,10 @@ type CorporateActionProcessingStatus5Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionProcessingStatus5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionProcessingStatus5Choice) AddCode() *CorporateActionEventStatus1 {
 	c.Code = new(CorporateActionEventStatus1)
 	return c.Code
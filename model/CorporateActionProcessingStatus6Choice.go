@@ -10,6 +10,10 @@ type CorporateActionProcessingStatus6Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionProcessingStatus6Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionProcessingStatus6Choice) AddCode() *CorporateActionEventStatus1 {
 	c.Code = new(CorporateActionEventStatus1)
 	return c.Code
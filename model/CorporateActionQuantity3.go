@@ -28,6 +28,10 @@ type CorporateActionQuantity3 struct {
 	IncrementalDenomination *FinancialInstrumentQuantity1Choice `xml:"IncrmtlDnmtn,omitempty"`
 }
 
+func (c *CorporateActionQuantity3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionQuantity3) AddMinimumExercisableQuantity() *FinancialInstrumentQuantity1Choice {
 	c.MinimumExercisableQuantity = new(FinancialInstrumentQuantity1Choice)
 	return c.MinimumExercisableQuantity
@@ -28,6 +28,10 @@ type CorporateActionQuantity5 struct {
 	IncrementalDenomination *FinancialInstrumentQuantity20Choice `xml:"IncrmtlDnmtn,omitempty"`
 }
 
+func (c *CorporateActionQuantity5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionQuantity5) AddMinimumExercisableQuantity() *FinancialInstrumentQuantity19Choice {
 	c.MinimumExercisableQuantity = new(FinancialInstrumentQuantity19Choice)
 	return c.MinimumExercisableQuantity
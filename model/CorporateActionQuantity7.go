@@ -22,6 +22,10 @@ type CorporateActionQuantity7 struct {
 	IncrementalDenomination *FinancialInstrumentQuantity20Choice `xml:"IncrmtlDnmtn,omitempty"`
 }
 
+func (c *CorporateActionQuantity7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionQuantity7) AddMaximumQuantity() *FinancialInstrumentQuantity19Choice {
 	c.MaximumQuantity = new(FinancialInstrumentQuantity19Choice)
 	return c.MaximumQuantity
@@ -22,6 +22,10 @@ type CorporateActionQuantity8 struct {
 	IncrementalDenomination *FinancialInstrumentQuantity22Choice `xml:"IncrmtlDnmtn,omitempty"`
 }
 
+func (c *CorporateActionQuantity8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionQuantity8) AddMaximumQuantity() *FinancialInstrumentQuantity21Choice {
 	c.MaximumQuantity = new(FinancialInstrumentQuantity21Choice)
 	return c.MaximumQuantity
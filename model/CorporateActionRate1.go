@@ -25,6 +25,10 @@ type CorporateActionRate1 struct {
 	Charges *RateAndAmountFormat1Choice `xml:"Chrgs,omitempty"`
 }
 
+func (c *CorporateActionRate1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate1) AddInterest() *RateAndAmountFormat1Choice {
 	c.Interest = new(RateAndAmountFormat1Choice)
 	return c.Interest
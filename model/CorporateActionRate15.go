@@ -32,6 +32,10 @@ type CorporateActionRate15 struct {
 	TaxableIncomePerDividendShare []*RateTypeAndAmountAndStatus11 `xml:"TaxblIncmPerDvddShr,omitempty"`
 }
 
+func (c *CorporateActionRate15) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate15) AddAdditionalTax() *RateAndAmountFormat14Choice {
 	c.AdditionalTax = new(RateAndAmountFormat14Choice)
 	return c.AdditionalTax
@@ -28,6 +28,10 @@ type CorporateActionRate16 struct {
 	ReinvestmentDiscountRateToMarket *RateFormat6Choice `xml:"RinvstmtDscntRateToMkt,omitempty"`
 }
 
+func (c *CorporateActionRate16) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate16) AddInterest() *RateAndAmountFormat14Choice {
 	c.Interest = new(RateAndAmountFormat14Choice)
 	return c.Interest
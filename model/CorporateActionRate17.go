@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies security rate details.
 type CorporateActionRate17 struct {
 
@@ -16,6 +20,10 @@ type CorporateActionRate17 struct {
 	TransformationRate *PercentageRate `xml:"TrfrmatnRate,omitempty"`
 }
 
+func (c *CorporateActionRate17) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate17) AddAdditionalQuantityForSubscribedResultantSecurities() *RatioFormat11Choice {
 	c.AdditionalQuantityForSubscribedResultantSecurities = new(RatioFormat11Choice)
 	return c.AdditionalQuantityForSubscribedResultantSecurities
@@ -34,3 +42,8 @@ func (c *CorporateActionRate17) AddNewToOld() *RatioFormat12Choice {
 func (c *CorporateActionRate17) SetTransformationRate(value string) {
 	c.TransformationRate = (*PercentageRate)(&value)
 }
+
+func (c *CorporateActionRate17) SetTransformationRateFromDecimal(value decimal.Decimal) {
+	c.TransformationRate = new(PercentageRate)
+	c.TransformationRate.FromDecimal(value)
+}
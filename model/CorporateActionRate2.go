@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rates.
 type CorporateActionRate2 struct {
 
@@ -104,6 +108,10 @@ type CorporateActionRate2 struct {
 	ApplicableRate *RateFormat1Choice `xml:"AplblRate,omitempty"`
 }
 
+func (c *CorporateActionRate2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate2) AddWithholdingTax() *RateFormat1Choice {
 	c.WithholdingTax = new(RateFormat1Choice)
 	return c.WithholdingTax
@@ -258,6 +266,10 @@ func (c *CorporateActionRate2) SetOriginalAmount(value, currency string) {
 	c.OriginalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CorporateActionRate2) SetOriginalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.OriginalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorporateActionRate2) AddExchangeRate() *ForeignExchangeTerms8 {
 	c.ExchangeRate = new(ForeignExchangeTerms8)
 	return c.ExchangeRate
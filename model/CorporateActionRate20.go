@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rates.
 type CorporateActionRate20 struct {
 
@@ -31,6 +35,10 @@ type CorporateActionRate20 struct {
 	TaxableIncomePerDividendShare []*RateTypeAndAmountAndStatus11 `xml:"TaxblIncmPerDvddShr,omitempty"`
 }
 
+func (c *CorporateActionRate20) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate20) AddGrossDividendRate() *GrossDividendRateFormat2Choice {
 	newValue := new(GrossDividendRateFormat2Choice)
 	c.GrossDividendRate = append(c.GrossDividendRate, newValue)
@@ -332,10 +340,20 @@ func (c *CorporateActionRate20) SetMaximumAllowedOversubscriptionRate(value stri
 	c.MaximumAllowedOversubscriptionRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate20) SetMaximumAllowedOversubscriptionRateFromDecimal(value decimal.Decimal) {
+	c.MaximumAllowedOversubscriptionRate = new(PercentageRate)
+	c.MaximumAllowedOversubscriptionRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate20) SetProrationRate(value string) {
 	c.ProrationRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate20) SetProrationRateFromDecimal(value decimal.Decimal) {
+	c.ProrationRate = new(PercentageRate)
+	c.ProrationRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate20) AddTaxRelatedRate() *RateTypeAndAmountAndStatus6 {
 	newValue := new(RateTypeAndAmountAndStatus6)
 	c.TaxRelatedRate = append(c.TaxRelatedRate, newValue)
@@ -486,6 +504,11 @@ func (c *CorporateActionRate20) SetWithholdingTaxRate(value string) {
 	c.WithholdingTaxRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate20) SetWithholdingTaxRateFromDecimal(value decimal.Decimal) {
+	c.WithholdingTaxRate = new(PercentageRate)
+	c.WithholdingTaxRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate20) AddAdditionalTax() *RateAndAmountFormat5Choice {
 	c.AdditionalTax = new(RateAndAmountFormat5Choice)
 	return c.AdditionalTax
@@ -13,6 +13,10 @@ type CorporateActionRate21 struct {
 	NewToOld *RatioFormat4Choice `xml:"NewToOd,omitempty"`
 }
 
+func (c *CorporateActionRate21) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate21) AddAdditionalQuantityForSubscribedResultantSecurities() *RatioFormat3Choice {
 	c.AdditionalQuantityForSubscribedResultantSecurities = new(RatioFormat3Choice)
 	return c.AdditionalQuantityForSubscribedResultantSecurities
@@ -38,6 +38,10 @@ type CorporateActionRate25 struct {
 	TaxableIncomePerDividendShare []*RateTypeAndAmountAndStatus11 `xml:"TaxblIncmPerDvddShr,omitempty"`
 }
 
+func (c *CorporateActionRate25) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate25) AddAdditionalTax() *RateAndAmountFormat14Choice {
 	c.AdditionalTax = new(RateAndAmountFormat14Choice)
 	return c.AdditionalTax
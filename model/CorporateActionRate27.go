@@ -34,6 +34,10 @@ type CorporateActionRate27 struct {
 	RealisedLoss *RateAndAmountFormat12Choice `xml:"RealsdLoss,omitempty"`
 }
 
+func (c *CorporateActionRate27) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate27) AddInterest() *RateAndAmountFormat14Choice {
 	c.Interest = new(RateAndAmountFormat14Choice)
 	return c.Interest
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies security rate details.
 type CorporateActionRate28 struct {
 
@@ -28,6 +32,10 @@ type CorporateActionRate28 struct {
 	TaxCreditRate []*TaxCreditRateFormat5Choice `xml:"TaxCdtRate,omitempty"`
 }
 
+func (c *CorporateActionRate28) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate28) AddAdditionalQuantityForSubscribedResultantSecurities() *RatioFormat11Choice {
 	c.AdditionalQuantityForSubscribedResultantSecurities = new(RatioFormat11Choice)
 	return c.AdditionalQuantityForSubscribedResultantSecurities
@@ -47,6 +55,11 @@ func (c *CorporateActionRate28) SetTransformationRate(value string) {
 	c.TransformationRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate28) SetTransformationRateFromDecimal(value decimal.Decimal) {
+	c.TransformationRate = new(PercentageRate)
+	c.TransformationRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate28) AddChargesFees() *RateAndAmountFormat14Choice {
 	c.ChargesFees = new(RateAndAmountFormat14Choice)
 	return c.ChargesFees
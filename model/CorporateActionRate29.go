@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rate details.
 type CorporateActionRate29 struct {
 
@@ -25,6 +29,10 @@ type CorporateActionRate29 struct {
 	TaxCreditRate []*TaxCreditRateFormat2Choice `xml:"TaxCdtRate,omitempty"`
 }
 
+func (c *CorporateActionRate29) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate29) AddAdditionalQuantityForSubscribedResultantSecurities() *RatioFormat3Choice {
 	c.AdditionalQuantityForSubscribedResultantSecurities = new(RatioFormat3Choice)
 	return c.AdditionalQuantityForSubscribedResultantSecurities
@@ -49,10 +57,20 @@ func (c *CorporateActionRate29) SetFiscalStamp(value string) {
 	c.FiscalStamp = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate29) SetFiscalStampFromDecimal(value decimal.Decimal) {
+	c.FiscalStamp = new(PercentageRate)
+	c.FiscalStamp.FromDecimal(value)
+}
+
 func (c *CorporateActionRate29) SetApplicableRate(value string) {
 	c.ApplicableRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate29) SetApplicableRateFromDecimal(value decimal.Decimal) {
+	c.ApplicableRate = new(PercentageRate)
+	c.ApplicableRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate29) AddTaxCreditRate() *TaxCreditRateFormat2Choice {
 	newValue := new(TaxCreditRateFormat2Choice)
 	c.TaxCreditRate = append(c.TaxCreditRate, newValue)
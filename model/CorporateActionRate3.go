@@ -28,6 +28,10 @@ type CorporateActionRate3 struct {
 	ReinvestmentDiscountRateToMarket *RateFormat2Choice `xml:"RinvstmtDscntRateToMkt,omitempty"`
 }
 
+func (c *CorporateActionRate3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate3) AddInterest() *RateAndAmountFormat3Choice {
 	c.Interest = new(RateAndAmountFormat3Choice)
 	return c.Interest
@@ -37,6 +37,10 @@ type CorporateActionRate35 struct {
 	DeclaredRate *RateAndAmountFormat12Choice `xml:"DclrdRate,omitempty"`
 }
 
+func (c *CorporateActionRate35) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate35) AddInterest() *RateAndAmountFormat14Choice {
 	c.Interest = new(RateAndAmountFormat14Choice)
 	return c.Interest
@@ -41,6 +41,10 @@ type CorporateActionRate36 struct {
 	IssuerDeclaredExchangeRate *ForeignExchangeTerms19 `xml:"IssrDclrdXchgRate,omitempty"`
 }
 
+func (c *CorporateActionRate36) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate36) AddAdditionalTax() *RateAndAmountFormat14Choice {
 	c.AdditionalTax = new(RateAndAmountFormat14Choice)
 	return c.AdditionalTax
@@ -38,6 +38,10 @@ type CorporateActionRate37 struct {
 	TaxableIncomePerDividendShare []*RateTypeAndAmountAndStatus11 `xml:"TaxblIncmPerDvddShr,omitempty"`
 }
 
+func (c *CorporateActionRate37) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate37) AddAdditionalTax() *RateAndAmountFormat14Choice {
 	c.AdditionalTax = new(RateAndAmountFormat14Choice)
 	return c.AdditionalTax
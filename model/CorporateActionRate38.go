@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rates.
 type CorporateActionRate38 struct {
 
@@ -37,6 +41,10 @@ type CorporateActionRate38 struct {
 	TaxableIncomePerDividendShare []*RateTypeAndAmountAndStatus11 `xml:"TaxblIncmPerDvddShr,omitempty"`
 }
 
+func (c *CorporateActionRate38) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate38) AddGrossDividendRate() *GrossDividendRateFormat10Choice {
 	newValue := new(GrossDividendRateFormat10Choice)
 	c.GrossDividendRate = append(c.GrossDividendRate, newValue)
@@ -484,10 +492,20 @@ func (c *CorporateActionRate38) SetMaximumAllowedOversubscriptionRate(value stri
 	c.MaximumAllowedOversubscriptionRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate38) SetMaximumAllowedOversubscriptionRateFromDecimal(value decimal.Decimal) {
+	c.MaximumAllowedOversubscriptionRate = new(PercentageRate)
+	c.MaximumAllowedOversubscriptionRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate38) SetProrationRate(value string) {
 	c.ProrationRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate38) SetProrationRateFromDecimal(value decimal.Decimal) {
+	c.ProrationRate = new(PercentageRate)
+	c.ProrationRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate38) AddTaxRelatedRate() *RateTypeAndAmountAndStatus6 {
 	newValue := new(RateTypeAndAmountAndStatus6)
 	c.TaxRelatedRate = append(c.TaxRelatedRate, newValue)
@@ -638,6 +656,11 @@ func (c *CorporateActionRate38) SetWithholdingTaxRate(value string) {
 	c.WithholdingTaxRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate38) SetWithholdingTaxRateFromDecimal(value decimal.Decimal) {
+	c.WithholdingTaxRate = new(PercentageRate)
+	c.WithholdingTaxRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate38) AddAdditionalTax() *RateAndAmountFormat5Choice {
 	c.AdditionalTax = new(RateAndAmountFormat5Choice)
 	return c.AdditionalTax
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rates.
 type CorporateActionRate4 struct {
 
@@ -76,6 +80,10 @@ type CorporateActionRate4 struct {
 	ApplicableRate *PercentageRate `xml:"AplblRate,omitempty"`
 }
 
+func (c *CorporateActionRate4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate4) AddChargesFees() *RateAndAmountFormat5Choice {
 	c.ChargesFees = new(RateAndAmountFormat5Choice)
 	return c.ChargesFees
@@ -85,10 +93,19 @@ func (c *CorporateActionRate4) SetFinalDividendRate(value, currency string) {
 	c.FinalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *CorporateActionRate4) SetFinalDividendRateFromDecimal(value decimal.Decimal, currency string) {
+	c.FinalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *CorporateActionRate4) SetFiscalStamp(value string) {
 	c.FiscalStamp = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate4) SetFiscalStampFromDecimal(value decimal.Decimal) {
+	c.FiscalStamp = new(PercentageRate)
+	c.FiscalStamp.FromDecimal(value)
+}
+
 func (c *CorporateActionRate4) AddFullyFrankedRate() *RateAndAmountFormat5Choice {
 	c.FullyFrankedRate = new(RateAndAmountFormat5Choice)
 	return c.FullyFrankedRate
@@ -244,6 +261,11 @@ func (c *CorporateActionRate4) SetCashIncentiveRate(value string) {
 	c.CashIncentiveRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate4) SetCashIncentiveRateFromDecimal(value decimal.Decimal) {
+	c.CashIncentiveRate = new(PercentageRate)
+	c.CashIncentiveRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate4) AddIndexFactor() *RateAndAmountFormat5Choice {
 	c.IndexFactor = new(RateAndAmountFormat5Choice)
 	return c.IndexFactor
@@ -550,18 +572,37 @@ func (c *CorporateActionRate4) SetMaximumAllowedOversubscriptionRate(value strin
 	c.MaximumAllowedOversubscriptionRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate4) SetMaximumAllowedOversubscriptionRateFromDecimal(value decimal.Decimal) {
+	c.MaximumAllowedOversubscriptionRate = new(PercentageRate)
+	c.MaximumAllowedOversubscriptionRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate4) SetProvisionalDividendRate(value, currency string) {
 	c.ProvisionalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (c *CorporateActionRate4) SetProvisionalDividendRateFromDecimal(value decimal.Decimal, currency string) {
+	c.ProvisionalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (c *CorporateActionRate4) SetProrationRate(value string) {
 	c.ProrationRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate4) SetProrationRateFromDecimal(value decimal.Decimal) {
+	c.ProrationRate = new(PercentageRate)
+	c.ProrationRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate4) SetSolicitationFeeRate(value string) {
 	c.SolicitationFeeRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate4) SetSolicitationFeeRateFromDecimal(value decimal.Decimal) {
+	c.SolicitationFeeRate = new(PercentageRate)
+	c.SolicitationFeeRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate4) AddTaxCreditRate() *TaxCreditRateFormat2Choice {
 	newValue := new(TaxCreditRateFormat2Choice)
 	c.TaxCreditRate = append(c.TaxCreditRate, newValue)
@@ -858,18 +899,38 @@ func (c *CorporateActionRate4) SetWithholdingTaxRate(value string) {
 	c.WithholdingTaxRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate4) SetWithholdingTaxRateFromDecimal(value decimal.Decimal) {
+	c.WithholdingTaxRate = new(PercentageRate)
+	c.WithholdingTaxRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate4) SetTaxOnIncome(value string) {
 	c.TaxOnIncome = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate4) SetTaxOnIncomeFromDecimal(value decimal.Decimal) {
+	c.TaxOnIncome = new(PercentageRate)
+	c.TaxOnIncome.FromDecimal(value)
+}
+
 func (c *CorporateActionRate4) SetTaxOnProfits(value string) {
 	c.TaxOnProfits = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate4) SetTaxOnProfitsFromDecimal(value decimal.Decimal) {
+	c.TaxOnProfits = new(PercentageRate)
+	c.TaxOnProfits.FromDecimal(value)
+}
+
 func (c *CorporateActionRate4) SetTaxReclaimRate(value string) {
 	c.TaxReclaimRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate4) SetTaxReclaimRateFromDecimal(value decimal.Decimal) {
+	c.TaxReclaimRate = new(PercentageRate)
+	c.TaxReclaimRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate4) AddWithholdingOfForeignTax() *RateAndAmountFormat5Choice {
 	c.WithholdingOfForeignTax = new(RateAndAmountFormat5Choice)
 	return c.WithholdingOfForeignTax
@@ -888,3 +949,8 @@ func (c *CorporateActionRate4) AddAdditionalTax() *RateAndAmountFormat5Choice {
 func (c *CorporateActionRate4) SetApplicableRate(value string) {
 	c.ApplicableRate = (*PercentageRate)(&value)
 }
+
+func (c *CorporateActionRate4) SetApplicableRateFromDecimal(value decimal.Decimal) {
+	c.ApplicableRate = new(PercentageRate)
+	c.ApplicableRate.FromDecimal(value)
+}
@@ -37,6 +37,10 @@ type CorporateActionRate43 struct {
 	DeclaredRate *RateAndAmountFormat5Choice `xml:"DclrdRate,omitempty"`
 }
 
+func (c *CorporateActionRate43) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate43) AddInterest() *RateAndAmountFormat14Choice {
 	c.Interest = new(RateAndAmountFormat14Choice)
 	return c.Interest
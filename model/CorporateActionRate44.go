@@ -44,6 +44,10 @@ type CorporateActionRate44 struct {
 	TaxOnIncome *RateAndAmountFormat14Choice `xml:"TaxOnIncm,omitempty"`
 }
 
+func (c *CorporateActionRate44) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate44) AddAdditionalTax() *RateAndAmountFormat14Choice {
 	c.AdditionalTax = new(RateAndAmountFormat14Choice)
 	return c.AdditionalTax
@@ -41,6 +41,10 @@ type CorporateActionRate45 struct {
 	TaxOnIncome *RateAndAmountFormat14Choice `xml:"TaxOnIncm,omitempty"`
 }
 
+func (c *CorporateActionRate45) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate45) AddAdditionalTax() *RateAndAmountFormat14Choice {
 	c.AdditionalTax = new(RateAndAmountFormat14Choice)
 	return c.AdditionalTax
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rates.
 type CorporateActionRate46 struct {
 
@@ -37,6 +41,10 @@ type CorporateActionRate46 struct {
 	TaxableIncomePerDividendShare []*RateTypeAndAmountAndStatus11 `xml:"TaxblIncmPerDvddShr,omitempty"`
 }
 
+func (c *CorporateActionRate46) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate46) AddGrossDividendRate() *GrossDividendRateFormat10Choice {
 	newValue := new(GrossDividendRateFormat10Choice)
 	c.GrossDividendRate = append(c.GrossDividendRate, newValue)
@@ -484,10 +492,20 @@ func (c *CorporateActionRate46) SetMaximumAllowedOversubscriptionRate(value stri
 	c.MaximumAllowedOversubscriptionRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate46) SetMaximumAllowedOversubscriptionRateFromDecimal(value decimal.Decimal) {
+	c.MaximumAllowedOversubscriptionRate = new(PercentageRate)
+	c.MaximumAllowedOversubscriptionRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate46) SetProrationRate(value string) {
 	c.ProrationRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate46) SetProrationRateFromDecimal(value decimal.Decimal) {
+	c.ProrationRate = new(PercentageRate)
+	c.ProrationRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate46) AddTaxRelatedRate() *RateTypeAndAmountAndStatus6 {
 	newValue := new(RateTypeAndAmountAndStatus6)
 	c.TaxRelatedRate = append(c.TaxRelatedRate, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rates related to a corporate action option.
 type CorporateActionRate47 struct {
 
@@ -19,10 +23,19 @@ type CorporateActionRate47 struct {
 	RequestedWithholdingOfLocalTax []*RateAndAmountFormat21Choice `xml:"ReqdWhldgOfLclTax,omitempty"`
 }
 
+func (c *CorporateActionRate47) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate47) SetProposedRate(value string) {
 	c.ProposedRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate47) SetProposedRateFromDecimal(value decimal.Decimal) {
+	c.ProposedRate = new(PercentageRate)
+	c.ProposedRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate47) AddOversubscriptionRate() *RateAndAmountFormat5Choice {
 	c.OversubscriptionRate = new(RateAndAmountFormat5Choice)
 	return c.OversubscriptionRate
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies security rate details.
 type CorporateActionRate48 struct {
 
@@ -31,6 +35,10 @@ type CorporateActionRate48 struct {
 	FinancialTransactionTaxRate *RateFormat3Choice `xml:"FinTxTaxRate,omitempty"`
 }
 
+func (c *CorporateActionRate48) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate48) AddAdditionalQuantityForSubscribedResultantSecurities() *RatioFormat11Choice {
 	c.AdditionalQuantityForSubscribedResultantSecurities = new(RatioFormat11Choice)
 	return c.AdditionalQuantityForSubscribedResultantSecurities
@@ -50,6 +58,11 @@ func (c *CorporateActionRate48) SetTransformationRate(value string) {
 	c.TransformationRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate48) SetTransformationRateFromDecimal(value decimal.Decimal) {
+	c.TransformationRate = new(PercentageRate)
+	c.TransformationRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate48) AddChargesFees() *RateAndAmountFormat14Choice {
 	c.ChargesFees = new(RateAndAmountFormat14Choice)
 	return c.ChargesFees
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rate details.
 type CorporateActionRate49 struct {
 
@@ -28,6 +32,10 @@ type CorporateActionRate49 struct {
 	FinancialTransactionTaxRate *PercentageRate `xml:"FinTxTaxRate,omitempty"`
 }
 
+func (c *CorporateActionRate49) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate49) AddAdditionalQuantityForSubscribedResultantSecurities() *RatioFormat3Choice {
 	c.AdditionalQuantityForSubscribedResultantSecurities = new(RatioFormat3Choice)
 	return c.AdditionalQuantityForSubscribedResultantSecurities
@@ -52,10 +60,20 @@ func (c *CorporateActionRate49) SetFiscalStamp(value string) {
 	c.FiscalStamp = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate49) SetFiscalStampFromDecimal(value decimal.Decimal) {
+	c.FiscalStamp = new(PercentageRate)
+	c.FiscalStamp.FromDecimal(value)
+}
+
 func (c *CorporateActionRate49) SetApplicableRate(value string) {
 	c.ApplicableRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate49) SetApplicableRateFromDecimal(value decimal.Decimal) {
+	c.ApplicableRate = new(PercentageRate)
+	c.ApplicableRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate49) AddTaxCreditRate() *TaxCreditRateFormat2Choice {
 	newValue := new(TaxCreditRateFormat2Choice)
 	c.TaxCreditRate = append(c.TaxCreditRate, newValue)
@@ -205,3 +223,8 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (c *CorporateActionRate49) SetFinancialTransactionTaxRate(value string) {
 	c.FinancialTransactionTaxRate = (*PercentageRate)(&value)
 }
+
+func (c *CorporateActionRate49) SetFinancialTransactionTaxRateFromDecimal(value decimal.Decimal) {
+	c.FinancialTransactionTaxRate = new(PercentageRate)
+	c.FinancialTransactionTaxRate.FromDecimal(value)
+}
@@ -80,6 +80,10 @@ type CorporateActionRate5 struct {
 	ApplicableRate *RateFormat2Choice `xml:"AplblRate,omitempty"`
 }
 
+func (c *CorporateActionRate5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate5) AddAdditionalTax() *RateAndAmountFormat3Choice {
 	c.AdditionalTax = new(RateAndAmountFormat3Choice)
 	return c.AdditionalTax
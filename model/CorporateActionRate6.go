@@ -16,6 +16,10 @@ type CorporateActionRate6 struct {
 	NewSecuritiesToUnderlyingSecurities *RatioFormat4Choice `xml:"NewSctiesToUndrlygScties,omitempty"`
 }
 
+func (c *CorporateActionRate6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate6) AddAdditionalQuantityForSubscribedResultantSecurities() *RatioFormat3Choice {
 	c.AdditionalQuantityForSubscribedResultantSecurities = new(RatioFormat3Choice)
 	return c.AdditionalQuantityForSubscribedResultantSecurities
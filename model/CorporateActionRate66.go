@@ -37,6 +37,10 @@ type CorporateActionRate66 struct {
 	DeclaredRate *RateAndAmountFormat39Choice `xml:"DclrdRate,omitempty"`
 }
 
+func (c *CorporateActionRate66) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate66) AddInterest() *RateAndAmountFormat37Choice {
 	c.Interest = new(RateAndAmountFormat37Choice)
 	return c.Interest
@@ -38,6 +38,10 @@ type CorporateActionRate67 struct {
 	TaxOnIncome *RateAndAmountFormat37Choice `xml:"TaxOnIncm,omitempty"`
 }
 
+func (c *CorporateActionRate67) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate67) AddAdditionalTax() *RateAndAmountFormat37Choice {
 	c.AdditionalTax = new(RateAndAmountFormat37Choice)
 	return c.AdditionalTax
@@ -41,6 +41,10 @@ type CorporateActionRate68 struct {
 	TaxOnIncome *RateAndAmountFormat37Choice `xml:"TaxOnIncm,omitempty"`
 }
 
+func (c *CorporateActionRate68) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate68) AddAdditionalTax() *RateAndAmountFormat37Choice {
 	c.AdditionalTax = new(RateAndAmountFormat37Choice)
 	return c.AdditionalTax
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies security rate details.
 type CorporateActionRate69 struct {
 
@@ -31,6 +35,10 @@ type CorporateActionRate69 struct {
 	FinancialTransactionTaxRate *RateFormat3Choice `xml:"FinTxTaxRate,omitempty"`
 }
 
+func (c *CorporateActionRate69) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate69) AddAdditionalQuantityForSubscribedResultantSecurities() *RatioFormat17Choice {
 	c.AdditionalQuantityForSubscribedResultantSecurities = new(RatioFormat17Choice)
 	return c.AdditionalQuantityForSubscribedResultantSecurities
@@ -50,6 +58,11 @@ func (c *CorporateActionRate69) SetTransformationRate(value string) {
 	c.TransformationRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate69) SetTransformationRateFromDecimal(value decimal.Decimal) {
+	c.TransformationRate = new(PercentageRate)
+	c.TransformationRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate69) AddChargesFees() *RateAndAmountFormat37Choice {
 	c.ChargesFees = new(RateAndAmountFormat37Choice)
 	return c.ChargesFees
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies security rate details.
 type CorporateActionRate7 struct {
 
@@ -19,6 +23,10 @@ type CorporateActionRate7 struct {
 	TransformationRate *PercentageRate `xml:"TrfrmatnRate,omitempty"`
 }
 
+func (c *CorporateActionRate7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate7) AddAdditionalQuantityForSubscribedResultantSecurities() *RatioFormat5Choice {
 	c.AdditionalQuantityForSubscribedResultantSecurities = new(RatioFormat5Choice)
 	return c.AdditionalQuantityForSubscribedResultantSecurities
@@ -42,3 +50,8 @@ func (c *CorporateActionRate7) AddNewSecuritiesToUnderlyingSecurities() *RatioFo
 func (c *CorporateActionRate7) SetTransformationRate(value string) {
 	c.TransformationRate = (*PercentageRate)(&value)
 }
+
+func (c *CorporateActionRate7) SetTransformationRateFromDecimal(value decimal.Decimal) {
+	c.TransformationRate = new(PercentageRate)
+	c.TransformationRate.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rates.
 type CorporateActionRate70 struct {
 
@@ -34,6 +38,10 @@ type CorporateActionRate70 struct {
 	TaxableIncomePerDividendShare []*RateTypeAndAmountAndStatus26 `xml:"TaxblIncmPerDvddShr,omitempty"`
 }
 
+func (c *CorporateActionRate70) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate70) AddGrossDividendRate() *GrossDividendRateFormat21Choice {
 	newValue := new(GrossDividendRateFormat21Choice)
 	c.GrossDividendRate = append(c.GrossDividendRate, newValue)
@@ -481,10 +489,20 @@ func (c *CorporateActionRate70) SetMaximumAllowedOversubscriptionRate(value stri
 	c.MaximumAllowedOversubscriptionRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate70) SetMaximumAllowedOversubscriptionRateFromDecimal(value decimal.Decimal) {
+	c.MaximumAllowedOversubscriptionRate = new(PercentageRate)
+	c.MaximumAllowedOversubscriptionRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate70) SetProrationRate(value string) {
 	c.ProrationRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate70) SetProrationRateFromDecimal(value decimal.Decimal) {
+	c.ProrationRate = new(PercentageRate)
+	c.ProrationRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate70) AddWithholdingTaxRate() *RateAndAmountFormat40Choice {
 	newValue := new(RateAndAmountFormat40Choice)
 	c.WithholdingTaxRate = append(c.WithholdingTaxRate, newValue)
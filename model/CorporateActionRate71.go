@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rates related to a corporate action option.
 type CorporateActionRate71 struct {
 
@@ -16,10 +20,19 @@ type CorporateActionRate71 struct {
 	RequestedSecondLevelTaxRate []*RateAndAmountFormat40Choice `xml:"ReqdScndLvlTaxRate,omitempty"`
 }
 
+func (c *CorporateActionRate71) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate71) SetProposedRate(value string) {
 	c.ProposedRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate71) SetProposedRateFromDecimal(value decimal.Decimal) {
+	c.ProposedRate = new(PercentageRate)
+	c.ProposedRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate71) AddOversubscriptionRate() *RateAndAmountFormat39Choice {
 	c.OversubscriptionRate = new(RateAndAmountFormat39Choice)
 	return c.OversubscriptionRate
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rate details.
 type CorporateActionRate72 struct {
 
@@ -28,6 +32,10 @@ type CorporateActionRate72 struct {
 	FinancialTransactionTaxRate *PercentageRate `xml:"FinTxTaxRate,omitempty"`
 }
 
+func (c *CorporateActionRate72) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate72) AddAdditionalQuantityForSubscribedResultantSecurities() *RatioFormat20Choice {
 	c.AdditionalQuantityForSubscribedResultantSecurities = new(RatioFormat20Choice)
 	return c.AdditionalQuantityForSubscribedResultantSecurities
@@ -52,10 +60,20 @@ func (c *CorporateActionRate72) SetFiscalStamp(value string) {
 	c.FiscalStamp = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate72) SetFiscalStampFromDecimal(value decimal.Decimal) {
+	c.FiscalStamp = new(PercentageRate)
+	c.FiscalStamp.FromDecimal(value)
+}
+
 func (c *CorporateActionRate72) SetApplicableRate(value string) {
 	c.ApplicableRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate72) SetApplicableRateFromDecimal(value decimal.Decimal) {
+	c.ApplicableRate = new(PercentageRate)
+	c.ApplicableRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate72) AddTaxCreditRate() *TaxCreditRateFormat7Choice {
 	newValue := new(TaxCreditRateFormat7Choice)
 	c.TaxCreditRate = append(c.TaxCreditRate, newValue)
@@ -205,3 +223,8 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (c *CorporateActionRate72) SetFinancialTransactionTaxRate(value string) {
 	c.FinancialTransactionTaxRate = (*PercentageRate)(&value)
 }
+
+func (c *CorporateActionRate72) SetFinancialTransactionTaxRateFromDecimal(value decimal.Decimal) {
+	c.FinancialTransactionTaxRate = new(PercentageRate)
+	c.FinancialTransactionTaxRate.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rates related to a corporate action option.
 type CorporateActionRate73 struct {
 
@@ -16,10 +20,19 @@ type CorporateActionRate73 struct {
 	RequestedSecondLevelTaxRate []*RateAndAmountFormat45Choice `xml:"ReqdScndLvlTaxRate,omitempty"`
 }
 
+func (c *CorporateActionRate73) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate73) SetProposedRate(value string) {
 	c.ProposedRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate73) SetProposedRateFromDecimal(value decimal.Decimal) {
+	c.ProposedRate = new(PercentageRate)
+	c.ProposedRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate73) AddOversubscriptionRate() *RateAndAmountFormat43Choice {
 	c.OversubscriptionRate = new(RateAndAmountFormat43Choice)
 	return c.OversubscriptionRate
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rates.
 type CorporateActionRate74 struct {
 
@@ -34,6 +38,10 @@ type CorporateActionRate74 struct {
 	TaxableIncomePerDividendShare []*RateTypeAndAmountAndStatus33 `xml:"TaxblIncmPerDvddShr,omitempty"`
 }
 
+func (c *CorporateActionRate74) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate74) AddGrossDividendRate() *GrossDividendRateFormat23Choice {
 	newValue := new(GrossDividendRateFormat23Choice)
 	c.GrossDividendRate = append(c.GrossDividendRate, newValue)
@@ -481,10 +489,20 @@ func (c *CorporateActionRate74) SetMaximumAllowedOversubscriptionRate(value stri
 	c.MaximumAllowedOversubscriptionRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate74) SetMaximumAllowedOversubscriptionRateFromDecimal(value decimal.Decimal) {
+	c.MaximumAllowedOversubscriptionRate = new(PercentageRate)
+	c.MaximumAllowedOversubscriptionRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate74) SetProrationRate(value string) {
 	c.ProrationRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate74) SetProrationRateFromDecimal(value decimal.Decimal) {
+	c.ProrationRate = new(PercentageRate)
+	c.ProrationRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate74) AddWithholdingTaxRate() *RateAndAmountFormat45Choice {
 	newValue := new(RateAndAmountFormat45Choice)
 	c.WithholdingTaxRate = append(c.WithholdingTaxRate, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rate details.
 type CorporateActionRate75 struct {
 
@@ -28,6 +32,10 @@ type CorporateActionRate75 struct {
 	FinancialTransactionTaxRate *PercentageRate `xml:"FinTxTaxRate,omitempty"`
 }
 
+func (c *CorporateActionRate75) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate75) AddAdditionalQuantityForSubscribedResultantSecurities() *RatioFormat21Choice {
 	c.AdditionalQuantityForSubscribedResultantSecurities = new(RatioFormat21Choice)
 	return c.AdditionalQuantityForSubscribedResultantSecurities
@@ -52,10 +60,20 @@ func (c *CorporateActionRate75) SetFiscalStamp(value string) {
 	c.FiscalStamp = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate75) SetFiscalStampFromDecimal(value decimal.Decimal) {
+	c.FiscalStamp = new(PercentageRate)
+	c.FiscalStamp.FromDecimal(value)
+}
+
 func (c *CorporateActionRate75) SetApplicableRate(value string) {
 	c.ApplicableRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate75) SetApplicableRateFromDecimal(value decimal.Decimal) {
+	c.ApplicableRate = new(PercentageRate)
+	c.ApplicableRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate75) AddTaxCreditRate() *TaxCreditRateFormat9Choice {
 	newValue := new(TaxCreditRateFormat9Choice)
 	c.TaxCreditRate = append(c.TaxCreditRate, newValue)
@@ -205,3 +223,8 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (c *CorporateActionRate75) SetFinancialTransactionTaxRate(value string) {
 	c.FinancialTransactionTaxRate = (*PercentageRate)(&value)
 }
+
+func (c *CorporateActionRate75) SetFinancialTransactionTaxRateFromDecimal(value decimal.Decimal) {
+	c.FinancialTransactionTaxRate = new(PercentageRate)
+	c.FinancialTransactionTaxRate.FromDecimal(value)
+}
@@ -38,6 +38,10 @@ type CorporateActionRate76 struct {
 	TaxOnIncome *RateAndAmountFormat46Choice `xml:"TaxOnIncm,omitempty"`
 }
 
+func (c *CorporateActionRate76) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate76) AddAdditionalTax() *RateAndAmountFormat46Choice {
 	c.AdditionalTax = new(RateAndAmountFormat46Choice)
 	return c.AdditionalTax
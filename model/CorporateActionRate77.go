@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies security rate details.
 type CorporateActionRate77 struct {
 
@@ -31,6 +35,10 @@ type CorporateActionRate77 struct {
 	FinancialTransactionTaxRate *RateFormat3Choice `xml:"FinTxTaxRate,omitempty"`
 }
 
+func (c *CorporateActionRate77) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate77) AddAdditionalQuantityForSubscribedResultantSecurities() *RatioFormat23Choice {
 	c.AdditionalQuantityForSubscribedResultantSecurities = new(RatioFormat23Choice)
 	return c.AdditionalQuantityForSubscribedResultantSecurities
@@ -50,6 +58,11 @@ func (c *CorporateActionRate77) SetTransformationRate(value string) {
 	c.TransformationRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate77) SetTransformationRateFromDecimal(value decimal.Decimal) {
+	c.TransformationRate = new(PercentageRate)
+	c.TransformationRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate77) AddChargesFees() *RateAndAmountFormat46Choice {
 	c.ChargesFees = new(RateAndAmountFormat46Choice)
 	return c.ChargesFees
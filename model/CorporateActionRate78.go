@@ -37,6 +37,10 @@ type CorporateActionRate78 struct {
 	DeclaredRate *RateAndAmountFormat43Choice `xml:"DclrdRate,omitempty"`
 }
 
+func (c *CorporateActionRate78) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate78) AddInterest() *RateAndAmountFormat46Choice {
 	c.Interest = new(RateAndAmountFormat46Choice)
 	return c.Interest
@@ -41,6 +41,10 @@ type CorporateActionRate79 struct {
 	TaxOnIncome *RateAndAmountFormat46Choice `xml:"TaxOnIncm,omitempty"`
 }
 
+func (c *CorporateActionRate79) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate79) AddAdditionalTax() *RateAndAmountFormat46Choice {
 	c.AdditionalTax = new(RateAndAmountFormat46Choice)
 	return c.AdditionalTax
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rates related to a corporate action option.
 type CorporateActionRate8 struct {
 
@@ -13,10 +17,19 @@ type CorporateActionRate8 struct {
 	RequestedTaxationRate *PercentageRate `xml:"ReqdTaxtnRate,omitempty"`
 }
 
+func (c *CorporateActionRate8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate8) SetProposedRate(value string) {
 	c.ProposedRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate8) SetProposedRateFromDecimal(value decimal.Decimal) {
+	c.ProposedRate = new(PercentageRate)
+	c.ProposedRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate8) AddOversubscriptionRate() *RateAndAmountFormat12Choice {
 	c.OversubscriptionRate = new(RateAndAmountFormat12Choice)
 	return c.OversubscriptionRate
@@ -25,3 +38,8 @@ func (c *CorporateActionRate8) AddOversubscriptionRate() *RateAndAmountFormat12C
 func (c *CorporateActionRate8) SetRequestedTaxationRate(value string) {
 	c.RequestedTaxationRate = (*PercentageRate)(&value)
 }
+
+func (c *CorporateActionRate8) SetRequestedTaxationRateFromDecimal(value decimal.Decimal) {
+	c.RequestedTaxationRate = new(PercentageRate)
+	c.RequestedTaxationRate.FromDecimal(value)
+}
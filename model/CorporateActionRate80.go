@@ -41,6 +41,10 @@ type CorporateActionRate80 struct {
 	TaxOnIncome *RateAndAmountFormat37Choice `xml:"TaxOnIncm,omitempty"`
 }
 
+func (c *CorporateActionRate80) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate80) AddAdditionalTax() *RateAndAmountFormat37Choice {
 	c.AdditionalTax = new(RateAndAmountFormat37Choice)
 	return c.AdditionalTax
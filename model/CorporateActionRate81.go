@@ -38,6 +38,10 @@ type CorporateActionRate81 struct {
 	TaxOnIncome *RateAndAmountFormat37Choice `xml:"TaxOnIncm,omitempty"`
 }
 
+func (c *CorporateActionRate81) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate81) AddAdditionalTax() *RateAndAmountFormat37Choice {
 	c.AdditionalTax = new(RateAndAmountFormat37Choice)
 	return c.AdditionalTax
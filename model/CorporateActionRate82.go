@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies rates.
 type CorporateActionRate82 struct {
 
@@ -34,6 +38,10 @@ type CorporateActionRate82 struct {
 	TaxableIncomePerDividendShare []*RateTypeAndAmountAndStatus26 `xml:"TaxblIncmPerDvddShr,omitempty"`
 }
 
+func (c *CorporateActionRate82) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate82) AddGrossDividendRate() *GrossDividendRateFormat21Choice {
 	newValue := new(GrossDividendRateFormat21Choice)
 	c.GrossDividendRate = append(c.GrossDividendRate, newValue)
@@ -481,10 +489,20 @@ func (c *CorporateActionRate82) SetMaximumAllowedOversubscriptionRate(value stri
 	c.MaximumAllowedOversubscriptionRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate82) SetMaximumAllowedOversubscriptionRateFromDecimal(value decimal.Decimal) {
+	c.MaximumAllowedOversubscriptionRate = new(PercentageRate)
+	c.MaximumAllowedOversubscriptionRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate82) SetProrationRate(value string) {
 	c.ProrationRate = (*PercentageRate)(&value)
 }
 
+func (c *CorporateActionRate82) SetProrationRateFromDecimal(value decimal.Decimal) {
+	c.ProrationRate = new(PercentageRate)
+	c.ProrationRate.FromDecimal(value)
+}
+
 func (c *CorporateActionRate82) AddWithholdingTaxRate() *RateAndAmountFormat40Choice {
 	newValue := new(RateAndAmountFormat40Choice)
 	c.WithholdingTaxRate = append(c.WithholdingTaxRate, newValue)
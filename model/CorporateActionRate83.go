@@ -41,6 +41,10 @@ type CorporateActionRate83 struct {
 	TaxOnIncome *RateAndAmountFormat46Choice `xml:"TaxOnIncm,omitempty"`
 }
 
+func (c *CorporateActionRate83) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionRate83) AddAdditionalTax() *RateAndAmountFormat46Choice {
 	c.AdditionalTax = new(RateAndAmountFormat46Choice)
 	return c.AdditionalTax
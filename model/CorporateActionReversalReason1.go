@@ -10,6 +10,10 @@ type CorporateActionReversalReason1 struct {
 	AdditionalReasonInformation *Max256Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CorporateActionReversalReason1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionReversalReason1) AddReason() *CorporateActionReversalReason1Choice {
 	c.Reason = new(CorporateActionReversalReason1Choice)
 	return c.Reason
@@ -10,6 +10,10 @@ type CorporateActionReversalReason1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (c *CorporateActionReversalReason1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionReversalReason1Choice) SetCode(value string) {
 	c.Code = (*CorporateActionReversalReason1Code)(&value)
 }
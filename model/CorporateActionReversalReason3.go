@@ -10,6 +10,10 @@ type CorporateActionReversalReason3 struct {
 	AdditionalReasonInformation *Max256Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CorporateActionReversalReason3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionReversalReason3) AddReason() *CorporateActionReversalReason3Choice {
 	c.Reason = new(CorporateActionReversalReason3Choice)
 	return c.Reason
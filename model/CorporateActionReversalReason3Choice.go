@@ -10,6 +10,10 @@ type CorporateActionReversalReason3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (c *CorporateActionReversalReason3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionReversalReason3Choice) SetCode(value string) {
 	c.Code = (*CorporateActionReversalReason1Code)(&value)
 }
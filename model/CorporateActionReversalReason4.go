@@ -10,6 +10,10 @@ type CorporateActionReversalReason4 struct {
 	AdditionalReasonInformation *RestrictedFINXMax256Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (c *CorporateActionReversalReason4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionReversalReason4) AddReason() *CorporateActionReversalReason4Choice {
 	c.Reason = new(CorporateActionReversalReason4Choice)
 	return c.Reason
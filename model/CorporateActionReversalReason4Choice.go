@@ -10,6 +10,10 @@ type CorporateActionReversalReason4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CorporateActionReversalReason4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionReversalReason4Choice) SetCode(value string) {
 	c.Code = (*CorporateActionReversalReason1Code)(&value)
 }
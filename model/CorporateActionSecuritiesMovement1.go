@@ -16,6 +16,10 @@ type CorporateActionSecuritiesMovement1 struct {
 	AccountDetails []*SecuritiesAccount8 `xml:"AcctDtls"`
 }
 
+func (c *CorporateActionSecuritiesMovement1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionSecuritiesMovement1) AddPostingDateTime() *DateAndDateTimeChoice {
 	c.PostingDateTime = new(DateAndDateTimeChoice)
 	return c.PostingDateTime
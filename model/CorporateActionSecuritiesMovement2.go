@@ -19,6 +19,10 @@ type CorporateActionSecuritiesMovement2 struct {
 	AccountDetails []*SecuritiesAccount9 `xml:"AcctDtls"`
 }
 
+func (c *CorporateActionSecuritiesMovement2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionSecuritiesMovement2) AddSecurityIdentification() *SecurityIdentification7 {
 	c.SecurityIdentification = new(SecurityIdentification7)
 	return c.SecurityIdentification
@@ -16,6 +16,10 @@ type CorporateActionStandingInstruction1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionStandingInstruction1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionStandingInstruction1) SetNetOrGross(value string) {
 	c.NetOrGross = (*StandingInstructionGrossNet1Code)(&value)
 }
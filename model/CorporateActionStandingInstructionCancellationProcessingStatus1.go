@@ -10,6 +10,10 @@ type CorporateActionStandingInstructionCancellationProcessingStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionStandingInstructionCancellationProcessingStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionStandingInstructionCancellationProcessingStatus1) AddStatus() *ProcessedStatus4FormatChoice {
 	c.Status = new(ProcessedStatus4FormatChoice)
 	return c.Status
@@ -10,6 +10,10 @@ type CorporateActionStandingInstructionCancellationRejectionStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionStandingInstructionCancellationRejectionStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionStandingInstructionCancellationRejectionStatus1) AddReason() *RejectionReason10FormatChoice {
 	newValue := new(RejectionReason10FormatChoice)
 	c.Reason = append(c.Reason, newValue)
@@ -22,6 +22,10 @@ type CorporateActionStandingInstructionGeneralInformation1 struct {
 	UnderlyingSecurity *FinancialInstrumentDescription3 `xml:"UndrlygScty,omitempty"`
 }
 
+func (c *CorporateActionStandingInstructionGeneralInformation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionStandingInstructionGeneralInformation1) SetStandingInstructionType(value string) {
 	c.StandingInstructionType = (*StandingInstructionType1Code)(&value)
 }
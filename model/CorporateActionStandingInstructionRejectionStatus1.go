@@ -10,6 +10,10 @@ type CorporateActionStandingInstructionRejectionStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporateActionStandingInstructionRejectionStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateActionStandingInstructionRejectionStatus1) AddReason() *RejectionReason20FormatChoice {
 	newValue := new(RejectionReason20FormatChoice)
 	c.Reason = append(c.Reason, newValue)
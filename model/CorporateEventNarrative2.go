@@ -7,6 +7,10 @@ type CorporateEventNarrative2 struct {
 	Disclaimer []*Max350Text `xml:"Dsclmr,omitempty"`
 }
 
+func (c *CorporateEventNarrative2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateEventNarrative2) AddDisclaimer(value string) {
 	c.Disclaimer = append(c.Disclaimer, (*Max350Text)(&value))
 }
@@ -10,6 +10,10 @@ type CorporateMovementStatus2 struct {
 	RejectedStatus *CorporateActionMovementRejectionStatus2 `xml:"RjctdSts"`
 }
 
+func (c *CorporateMovementStatus2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporateMovementStatus2) AddProcessedStatus() *CorporationActionMovementProcessingStatus2 {
 	c.ProcessedStatus = new(CorporationActionMovementProcessingStatus2)
 	return c.ProcessedStatus
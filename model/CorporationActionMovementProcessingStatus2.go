@@ -10,6 +10,10 @@ type CorporationActionMovementProcessingStatus2 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (c *CorporationActionMovementProcessingStatus2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorporationActionMovementProcessingStatus2) AddStatus() *ProcessedStatus2FormatChoice {
 	c.Status = new(ProcessedStatus2FormatChoice)
 	return c.Status
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to provide information on the group of the corrective transaction, to which the resolution message refers.
 type CorrectiveGroupInformation1 struct {
 
@@ -14,6 +18,10 @@ type CorrectiveGroupInformation1 struct {
 	CreationDateTime *ISODateTime `xml:"CreDtTm,omitempty"`
 }
 
+func (c *CorrectiveGroupInformation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorrectiveGroupInformation1) SetMessageIdentification(value string) {
 	c.MessageIdentification = (*Max35Text)(&value)
 }
@@ -25,3 +33,8 @@ func (c *CorrectiveGroupInformation1) SetMessageNameIdentification(value string)
 func (c *CorrectiveGroupInformation1) SetCreationDateTime(value string) {
 	c.CreationDateTime = (*ISODateTime)(&value)
 }
+
+func (c *CorrectiveGroupInformation1) SetCreationDateTimeFromTime(value time.Time) {
+	c.CreationDateTime = new(ISODateTime)
+	c.CreationDateTime.FromTime(value)
+}
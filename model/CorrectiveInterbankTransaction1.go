@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the corrective interbank transaction, to which the resolution message refers.
 type CorrectiveInterbankTransaction1 struct {
 
@@ -30,6 +36,10 @@ type CorrectiveInterbankTransaction1 struct {
 	InterbankSettlementDate *ISODate `xml:"IntrBkSttlmDt"`
 }
 
+func (c *CorrectiveInterbankTransaction1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorrectiveInterbankTransaction1) AddGroupHeader() *CorrectiveGroupInformation1 {
 	c.GroupHeader = new(CorrectiveGroupInformation1)
 	return c.GroupHeader
@@ -51,6 +61,15 @@ func (c *CorrectiveInterbankTransaction1) SetInterbankSettlementAmount(value, cu
 	c.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CorrectiveInterbankTransaction1) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorrectiveInterbankTransaction1) SetInterbankSettlementDate(value string) {
 	c.InterbankSettlementDate = (*ISODate)(&value)
 }
+
+func (c *CorrectiveInterbankTransaction1) SetInterbankSettlementDateFromTime(value time.Time) {
+	c.InterbankSettlementDate = new(ISODate)
+	c.InterbankSettlementDate.FromTime(value)
+}
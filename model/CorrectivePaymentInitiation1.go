@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the corrective payment initiation transaction, to which the resolution message refers.
 type CorrectivePaymentInitiation1 struct {
 
@@ -32,6 +38,10 @@ type CorrectivePaymentInitiation1 struct {
 	RequestedCollectionDate *ISODate `xml:"ReqdColltnDt,omitempty"`
 }
 
+func (c *CorrectivePaymentInitiation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorrectivePaymentInitiation1) AddGroupHeader() *CorrectiveGroupInformation1 {
 	c.GroupHeader = new(CorrectiveGroupInformation1)
 	return c.GroupHeader
@@ -53,10 +63,24 @@ func (c *CorrectivePaymentInitiation1) SetInstructedAmount(value, currency strin
 	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CorrectivePaymentInitiation1) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorrectivePaymentInitiation1) SetRequestedExecutionDate(value string) {
 	c.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (c *CorrectivePaymentInitiation1) SetRequestedExecutionDateFromTime(value time.Time) {
+	c.RequestedExecutionDate = new(ISODate)
+	c.RequestedExecutionDate.FromTime(value)
+}
+
 func (c *CorrectivePaymentInitiation1) SetRequestedCollectionDate(value string) {
 	c.RequestedCollectionDate = (*ISODate)(&value)
 }
+
+func (c *CorrectivePaymentInitiation1) SetRequestedCollectionDateFromTime(value time.Time) {
+	c.RequestedCollectionDate = new(ISODate)
+	c.RequestedCollectionDate.FromTime(value)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the corrective payment initiation transaction, to which the resolution message refers.
 type CorrectivePaymentInitiation2 struct {
 
@@ -32,6 +38,10 @@ type CorrectivePaymentInitiation2 struct {
 	RequestedCollectionDate *ISODate `xml:"ReqdColltnDt,omitempty"`
 }
 
+func (c *CorrectivePaymentInitiation2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorrectivePaymentInitiation2) AddGroupHeader() *CorrectiveGroupInformation1 {
 	c.GroupHeader = new(CorrectiveGroupInformation1)
 	return c.GroupHeader
@@ -53,6 +63,10 @@ func (c *CorrectivePaymentInitiation2) SetInstructedAmount(value, currency strin
 	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CorrectivePaymentInitiation2) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CorrectivePaymentInitiation2) AddRequestedExecutionDate() *DateAndDateTimeChoice {
 	c.RequestedExecutionDate = new(DateAndDateTimeChoice)
 	return c.RequestedExecutionDate
@@ -61,3 +75,8 @@ func (c *CorrectivePaymentInitiation2) AddRequestedExecutionDate() *DateAndDateT
 func (c *CorrectivePaymentInitiation2) SetRequestedCollectionDate(value string) {
 	c.RequestedCollectionDate = (*ISODate)(&value)
 }
+
+func (c *CorrectivePaymentInitiation2) SetRequestedCollectionDateFromTime(value time.Time) {
+	c.RequestedCollectionDate = new(ISODate)
+	c.RequestedCollectionDate.FromTime(value)
+}
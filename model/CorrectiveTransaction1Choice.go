@@ -10,6 +10,10 @@ type CorrectiveTransaction1Choice struct {
 	Interbank *CorrectiveInterbankTransaction1 `xml:"IntrBk"`
 }
 
+func (c *CorrectiveTransaction1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorrectiveTransaction1Choice) AddInitiation() *CorrectivePaymentInitiation1 {
 	c.Initiation = new(CorrectivePaymentInitiation1)
 	return c.Initiation
@@ -10,6 +10,10 @@ type CorrectiveTransaction2Choice struct {
 	Interbank *CorrectiveInterbankTransaction1 `xml:"IntrBk"`
 }
 
+func (c *CorrectiveTransaction2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CorrectiveTransaction2Choice) AddInitiation() *CorrectivePaymentInitiation2 {
 	c.Initiation = new(CorrectivePaymentInitiation2)
 	return c.Initiation
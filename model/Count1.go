@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies a sequence number or a total.
 type Count1 struct {
 
@@ -7,6 +11,15 @@ type Count1 struct {
 	Number *Number `xml:"Nb"`
 }
 
+func (c *Count1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Count1) SetNumber(value string) {
 	c.Number = (*Number)(&value)
 }
+
+func (c *Count1) SetNumberFromDecimal(value decimal.Decimal) {
+	c.Number = new(Number)
+	c.Number.FromDecimal(value)
+}
@@ -10,6 +10,10 @@ type Counterparty10Choice struct {
 	Buyer *PartyIdentificationAndAccount131 `xml:"Buyr"`
 }
 
+func (c *Counterparty10Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Counterparty10Choice) AddSeller() *PartyIdentificationAndAccount131 {
 	c.Seller = new(PartyIdentificationAndAccount131)
 	return c.Seller
@@ -10,6 +10,10 @@ type Counterparty1Choice struct {
 	Buyer *PartyIdentificationAndAccount1 `xml:"Buyr"`
 }
 
+func (c *Counterparty1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Counterparty1Choice) AddSeller() *PartyIdentificationAndAccount1 {
 	c.Seller = new(PartyIdentificationAndAccount1)
 	return c.Seller
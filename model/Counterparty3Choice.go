@@ -10,6 +10,10 @@ type Counterparty3Choice struct {
 	Buyer *PartyIdentificationAndAccount35 `xml:"Buyr"`
 }
 
+func (c *Counterparty3Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Counterparty3Choice) AddSeller() *PartyIdentificationAndAccount35 {
 	c.Seller = new(PartyIdentificationAndAccount35)
 	return c.Seller
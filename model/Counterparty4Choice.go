@@ -10,6 +10,10 @@ type Counterparty4Choice struct {
 	Buyer *PartyIdentificationAndAccount42 `xml:"Buyr"`
 }
 
+func (c *Counterparty4Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Counterparty4Choice) AddSeller() *PartyIdentificationAndAccount42 {
 	c.Seller = new(PartyIdentificationAndAccount42)
 	return c.Seller
@@ -10,6 +10,10 @@ type Counterparty5Choice struct {
 	Buyer *PartyIdentificationAndAccount45 `xml:"Buyr"`
 }
 
+func (c *Counterparty5Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Counterparty5Choice) AddSeller() *PartyIdentificationAndAccount45 {
 	c.Seller = new(PartyIdentificationAndAccount45)
 	return c.Seller
@@ -10,6 +10,10 @@ type Counterparty9Choice struct {
 	Buyer *PartyIdentificationAndAccount106 `xml:"Buyr"`
 }
 
+func (c *Counterparty9Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Counterparty9Choice) AddSeller() *PartyIdentificationAndAccount106 {
 	c.Seller = new(PartyIdentificationAndAccount106)
 	return c.Seller
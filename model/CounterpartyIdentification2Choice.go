@@ -10,6 +10,10 @@ type CounterpartyIdentification2Choice struct {
 	Other *ReportedPartyIdentification1 `xml:"Othr"`
 }
 
+func (c *CounterpartyIdentification2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CounterpartyIdentification2Choice) SetLEI(value string) {
 	c.LEI = (*LEIIdentifier)(&value)
 }
@@ -13,6 +13,10 @@ type CounterpartySideTransactionReporting1 struct {
 	CounterpartySideUniqueTransactionIdentifier []*UniqueTransactionIdentifier2 `xml:"CtrPtySdUnqTxIdr,omitempty"`
 }
 
+func (c *CounterpartySideTransactionReporting1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CounterpartySideTransactionReporting1) SetReportingJurisdiction(value string) {
 	c.ReportingJurisdiction = (*Max35Text)(&value)
 }
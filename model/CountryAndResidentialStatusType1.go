@@ -10,6 +10,10 @@ type CountryAndResidentialStatusType1 struct {
 	ResidentialStatus *ResidentialStatus1Code `xml:"ResdtlSts"`
 }
 
+func (c *CountryAndResidentialStatusType1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CountryAndResidentialStatusType1) SetCountry(value string) {
 	c.Country = (*CountryCode)(&value)
 }
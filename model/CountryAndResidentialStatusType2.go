@@ -10,6 +10,10 @@ type CountryAndResidentialStatusType2 struct {
 	ResidentialStatus *ResidentialStatus1Code `xml:"ResdtlSts"`
 }
 
+func (c *CountryAndResidentialStatusType2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CountryAndResidentialStatusType2) SetCountry(value string) {
 	c.Country = (*CountryCode)(&value)
 }
@@ -1,3 +1,9 @@
 package model
 
 type CountryCode string
+
+var countryCodePattern = newPattern(`[A-Z]{2,2}`)
+
+func (c CountryCode) Validate() error {
+	return validatePattern("CountryCode", string(c), countryCodePattern)
+}
@@ -10,6 +10,10 @@ type CountryCodeAndName1 struct {
 	Name *Max35Text `xml:"Nm,omitempty"`
 }
 
+func (c *CountryCodeAndName1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CountryCodeAndName1) SetCode(value string) {
 	c.Code = (*CountryCode)(&value)
 }
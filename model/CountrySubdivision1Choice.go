@@ -11,6 +11,10 @@ type CountrySubdivision1Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (c *CountrySubdivision1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CountrySubdivision1Choice) SetCode(value string) {
 	c.Code = (*Max35Text)(&value)
 }
@@ -1,3 +1,26 @@
 package model
 
 type CreditDebit3Code string
+
+const (
+	CreditDebit3CodeCRDT CreditDebit3Code = "CRDT"
+	CreditDebit3CodeDBIT CreditDebit3Code = "DBIT"
+)
+
+var creditDebit3CodeDescriptions = map[CreditDebit3Code]string{
+	CreditDebit3CodeCRDT: "Credit: operation is an increase.",
+	CreditDebit3CodeDBIT: "Debit: operation is a decrease.",
+}
+
+func (c CreditDebit3Code) IsValid() bool {
+	_, ok := creditDebit3CodeDescriptions[c]
+	return ok
+}
+
+func (c CreditDebit3Code) Description() string {
+	return creditDebit3CodeDescriptions[c]
+}
+
+func (c CreditDebit3Code) Validate() error {
+	return validateEnumeration("CreditDebit3Code", string(c), c.IsValid(), "CRDT, DBIT")
+}
@@ -1,3 +1,26 @@
 package model
 
 type CreditDebitCode string
+
+const (
+	CreditDebitCodeCRDT CreditDebitCode = "CRDT"
+	CreditDebitCodeDBIT CreditDebitCode = "DBIT"
+)
+
+var creditDebitCodeDescriptions = map[CreditDebitCode]string{
+	CreditDebitCodeCRDT: "Credit: operation is an increase.",
+	CreditDebitCodeDBIT: "Debit: operation is a decrease.",
+}
+
+func (c CreditDebitCode) IsValid() bool {
+	_, ok := creditDebitCodeDescriptions[c]
+	return ok
+}
+
+func (c CreditDebitCode) Description() string {
+	return creditDebitCodeDescriptions[c]
+}
+
+func (c CreditDebitCode) Validate() error {
+	return validateEnumeration("CreditDebitCode", string(c), c.IsValid(), "CRDT, DBIT")
+}
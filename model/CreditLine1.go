@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Transaction has an origin and a destination in the same country and is made in the currency of that country.
 type CreditLine1 struct {
 
@@ -12,10 +16,23 @@ type CreditLine1 struct {
 	Amount *CurrencyAndAmount `xml:"Amt,omitempty"`
 }
 
+func (c *CreditLine1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditLine1) SetIncluded(value string) {
 	c.Included = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CreditLine1) SetIncludedFromBool(value bool) {
+	c.Included = new(TrueFalseIndicator)
+	c.Included.FromBool(value)
+}
+
 func (c *CreditLine1) SetAmount(value, currency string) {
 	c.Amount = NewCurrencyAndAmount(value, currency)
 }
+
+func (c *CreditLine1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
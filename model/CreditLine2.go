@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of elements used to provide details of the credit line.
 type CreditLine2 struct {
 
@@ -12,10 +17,64 @@ type CreditLine2 struct {
 	Amount *ActiveOrHistoricCurrencyAndAmount `xml:"Amt,omitempty"`
 }
 
+func (c *CreditLine2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditLine2) SetIncluded(value string) {
 	c.Included = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CreditLine2) SetIncludedFromBool(value bool) {
+	c.Included = new(TrueFalseIndicator)
+	c.Included.FromBool(value)
+}
+
 func (c *CreditLine2) SetAmount(value, currency string) {
 	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (c *CreditLine2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *CreditLine2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Included != nil {
+		enc.Element("Incl", string(*c.Included))
+	}
+	if c.Amount != nil {
+		c.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	enc.End(tag)
+}
+
+func (c *CreditLine2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Incl":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Included = (*TrueFalseIndicator)(&value)
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Amount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Set of elements used to provide details of the credit line.
+type CreditLine3 struct {
+
+	// Indicates whether or not the credit line is included in the balance.
+	//
+	// Usage: If not present, credit line is not included in the balance amount.
+	Included *TrueFalseIndicator `xml:"Incl"`
+
+	// Type of the credit line provided when multiple credit lines may be provided.
+	Type *CreditLineType1Choice `xml:"Tp,omitempty"`
+
+	// Amount of money of the credit line.
+	Amount *ActiveOrHistoricCurrencyAndAmount `xml:"Amt,omitempty"`
+
+	// Date of the credit line provided when multiple credit lines may be provided.
+	Date *DateAndDateTime2Choice `xml:"Dt,omitempty"`
+}
+
+func (c *CreditLine3) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CreditLine3) SetIncluded(value string) {
+	c.Included = (*TrueFalseIndicator)(&value)
+}
+
+func (c *CreditLine3) SetIncludedFromBool(value bool) {
+	c.Included = new(TrueFalseIndicator)
+	c.Included.FromBool(value)
+}
+
+func (c *CreditLine3) AddType() *CreditLineType1Choice {
+	c.Type = new(CreditLineType1Choice)
+	return c.Type
+}
+
+func (c *CreditLine3) SetAmount(value, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (c *CreditLine3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *CreditLine3) AddDate() *DateAndDateTime2Choice {
+	c.Date = new(DateAndDateTime2Choice)
+	return c.Date
+}
+
+func (c *CreditLine3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Included != nil {
+		enc.Element("Incl", string(*c.Included))
+	}
+	if c.Type != nil {
+		c.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if c.Amount != nil {
+		c.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if c.Date != nil {
+		c.Date.EncodeXMLFast(enc, "Dt")
+	}
+	enc.End(tag)
+}
+
+func (c *CreditLine3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Incl":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Included = (*TrueFalseIndicator)(&value)
+		case "Tp":
+			elem := new(CreditLineType1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Type = elem
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Amount = elem
+		case "Dt":
+			elem := new(DateAndDateTime2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Date = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
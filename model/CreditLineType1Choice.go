@@ -0,0 +1,66 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Specifies the type of the credit line.
+type CreditLineType1Choice struct {
+
+	// Specifies the type of the credit line, as published in an external code set.
+	Code *ExternalCreditLineType1Code `xml:"Cd"`
+
+	// Specifies the type of the credit line, in a proprietary form.
+	Proprietary *Max35Text `xml:"Prtry"`
+}
+
+func (c *CreditLineType1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CreditLineType1Choice) SetCode(value string) {
+	c.Code = (*ExternalCreditLineType1Code)(&value)
+}
+
+func (c *CreditLineType1Choice) SetProprietary(value string) {
+	c.Proprietary = (*Max35Text)(&value)
+}
+
+func (c *CreditLineType1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Code != nil {
+		enc.Element("Cd", string(*c.Code))
+	}
+	if c.Proprietary != nil {
+		enc.Element("Prtry", string(*c.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (c *CreditLineType1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Code = (*ExternalCreditLineType1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
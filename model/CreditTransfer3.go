@@ -13,6 +13,10 @@ type CreditTransfer3 struct {
 	DebtorDetails *Debtor2 `xml:"DbtrDtls,omitempty"`
 }
 
+func (c *CreditTransfer3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransfer3) SetReference(value string) {
 	c.Reference = (*Max35Text)(&value)
 }
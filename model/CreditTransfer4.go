@@ -13,6 +13,10 @@ type CreditTransfer4 struct {
 	DebtorDetails *Debtor2 `xml:"DbtrDtls"`
 }
 
+func (c *CreditTransfer4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransfer4) SetReference(value string) {
 	c.Reference = (*Max35Text)(&value)
 }
@@ -43,6 +43,10 @@ type CreditTransfer6 struct {
 	CreditorAccount *AccountIdentificationAndName3 `xml:"CdtrAcct"`
 }
 
+func (c *CreditTransfer6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransfer6) SetReference(value string) {
 	c.Reference = (*Max35Text)(&value)
 }
@@ -43,6 +43,10 @@ type CreditTransfer8 struct {
 	CreditorAccount *AccountIdentificationAndName5 `xml:"CdtrAcct"`
 }
 
+func (c *CreditTransfer8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransfer8) SetReference(value string) {
 	c.Reference = (*Max35Text)(&value)
 }
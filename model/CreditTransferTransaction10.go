@@ -56,13 +56,13 @@ type CreditTransferTransaction10 struct {
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 
 	// Information needed due to regulatory and statutory requirements.
-	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty"`
+	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
 
 	// Provides details on the tax.
 	Tax *TaxInformation3 `xml:"Tax,omitempty"`
 
 	// Provides information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation7 `xml:"RmtInf,omitempty"`
@@ -71,6 +71,10 @@ type CreditTransferTransaction10 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CreditTransferTransaction10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransaction10) AddPaymentIdentification() *PaymentIdentification1 {
 	c.PaymentIdentification = new(PaymentIdentification1)
 	return c.PaymentIdentification
@@ -86,8 +90,12 @@ func (c *CreditTransferTransaction10) AddAmount() *AmountType3Choice {
 	return c.Amount
 }
 
-func (c *CreditTransferTransaction10) SetChargeBearer(value string) {
+func (c *CreditTransferTransaction10) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (c *CreditTransferTransaction10) AddChequeInstruction() *Cheque7 {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details specific to the individual transaction(s) included in the message.
 type CreditTransferTransaction19 struct {
 
@@ -120,20 +126,20 @@ type CreditTransferTransaction19 struct {
 	//
 	// Usage: The next agent may not be the creditor agent.
 	// The instruction can relate to a level of service, can be an instruction that has to be executed by the agent, or can be information required by the next agent.
-	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty"`
+	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty" xsd:"maxOccurs=6"`
 
 	// Underlying reason for the payment transaction.
 	// Usage: Purpose is used by the end-customers, that is initiating party, (ultimate) debtor, (ultimate) creditor to provide information concerning the nature of the payment. Purpose is a content element, which is not used for processing by any of the agents involved in the payment chain.
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 
 	// Information needed due to regulatory and statutory requirements.
-	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty"`
+	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
 
 	// Provides details on the tax.
 	Tax *TaxInformation3 `xml:"Tax,omitempty"`
 
 	// Provides information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation4 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation4 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation10 `xml:"RmtInf,omitempty"`
@@ -142,6 +148,10 @@ type CreditTransferTransaction19 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CreditTransferTransaction19) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransaction19) AddPaymentIdentification() *PaymentIdentification3 {
 	c.PaymentIdentification = new(PaymentIdentification3)
 	return c.PaymentIdentification
@@ -156,12 +166,25 @@ func (c *CreditTransferTransaction19) SetInterbankSettlementAmount(value, curren
 	c.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CreditTransferTransaction19) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CreditTransferTransaction19) SetInterbankSettlementDate(value string) {
 	c.InterbankSettlementDate = (*ISODate)(&value)
 }
 
-func (c *CreditTransferTransaction19) SetSettlementPriority(value string) {
+func (c *CreditTransferTransaction19) SetInterbankSettlementDateFromTime(value time.Time) {
+	c.InterbankSettlementDate = new(ISODate)
+	c.InterbankSettlementDate.FromTime(value)
+}
+
+func (c *CreditTransferTransaction19) SetSettlementPriority(value string) error {
+	if err := Priority3Code(value).Validate(); err != nil {
+		return err
+	}
 	c.SettlementPriority = (*Priority3Code)(&value)
+	return nil
 }
 
 func (c *CreditTransferTransaction19) AddSettlementTimeIndication() *SettlementDateTimeIndication1 {
@@ -178,20 +201,43 @@ func (c *CreditTransferTransaction19) SetAcceptanceDateTime(value string) {
 	c.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CreditTransferTransaction19) SetAcceptanceDateTimeFromTime(value time.Time) {
+	c.AcceptanceDateTime = new(ISODateTime)
+	c.AcceptanceDateTime.FromTime(value)
+}
+
 func (c *CreditTransferTransaction19) SetPoolingAdjustmentDate(value string) {
 	c.PoolingAdjustmentDate = (*ISODate)(&value)
 }
 
+func (c *CreditTransferTransaction19) SetPoolingAdjustmentDateFromTime(value time.Time) {
+	c.PoolingAdjustmentDate = new(ISODate)
+	c.PoolingAdjustmentDate.FromTime(value)
+}
+
 func (c *CreditTransferTransaction19) SetInstructedAmount(value, currency string) {
 	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CreditTransferTransaction19) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CreditTransferTransaction19) SetExchangeRate(value string) {
 	c.ExchangeRate = (*BaseOneRate)(&value)
 }
 
-func (c *CreditTransferTransaction19) SetChargeBearer(value string) {
+func (c *CreditTransferTransaction19) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(BaseOneRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
+func (c *CreditTransferTransaction19) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (c *CreditTransferTransaction19) AddChargesInformation() *Charges2 {
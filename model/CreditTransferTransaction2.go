@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details specific to the individual transaction(s) included in the message.
 type CreditTransferTransaction2 struct {
 
@@ -119,22 +125,26 @@ type CreditTransferTransaction2 struct {
 	//
 	// Usage: The next agent may not be the creditor agent.
 	// The instruction can relate to a level of service, can be an instruction that has to be executed by the agent, or can be information required by the next agent.
-	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty"`
+	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty" xsd:"maxOccurs=6"`
 
 	// Underlying reason for the payment transaction.
 	// Usage: Purpose is used by the end-customers, that is initiating party, (ultimate) debtor, (ultimate) creditor to provide information concerning the nature of the payment. Purpose is a content element, which is not used for processing by any of the agents involved in the payment chain.
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 
 	// Information needed due to regulatory and statutory requirements.
-	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty"`
+	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
 
 	// Provides information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation7 `xml:"RmtInf,omitempty"`
 }
 
+func (c *CreditTransferTransaction2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransaction2) AddPaymentIdentification() *PaymentIdentification3 {
 	c.PaymentIdentification = new(PaymentIdentification3)
 	return c.PaymentIdentification
@@ -149,12 +159,25 @@ func (c *CreditTransferTransaction2) SetInterbankSettlementAmount(value, currenc
 	c.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CreditTransferTransaction2) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CreditTransferTransaction2) SetInterbankSettlementDate(value string) {
 	c.InterbankSettlementDate = (*ISODate)(&value)
 }
 
-func (c *CreditTransferTransaction2) SetSettlementPriority(value string) {
+func (c *CreditTransferTransaction2) SetInterbankSettlementDateFromTime(value time.Time) {
+	c.InterbankSettlementDate = new(ISODate)
+	c.InterbankSettlementDate.FromTime(value)
+}
+
+func (c *CreditTransferTransaction2) SetSettlementPriority(value string) error {
+	if err := Priority3Code(value).Validate(); err != nil {
+		return err
+	}
 	c.SettlementPriority = (*Priority3Code)(&value)
+	return nil
 }
 
 func (c *CreditTransferTransaction2) AddSettlementTimeIndication() *SettlementDateTimeIndication1 {
@@ -171,20 +194,43 @@ func (c *CreditTransferTransaction2) SetAcceptanceDateTime(value string) {
 	c.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CreditTransferTransaction2) SetAcceptanceDateTimeFromTime(value time.Time) {
+	c.AcceptanceDateTime = new(ISODateTime)
+	c.AcceptanceDateTime.FromTime(value)
+}
+
 func (c *CreditTransferTransaction2) SetPoolingAdjustmentDate(value string) {
 	c.PoolingAdjustmentDate = (*ISODate)(&value)
 }
 
+func (c *CreditTransferTransaction2) SetPoolingAdjustmentDateFromTime(value time.Time) {
+	c.PoolingAdjustmentDate = new(ISODate)
+	c.PoolingAdjustmentDate.FromTime(value)
+}
+
 func (c *CreditTransferTransaction2) SetInstructedAmount(value, currency string) {
 	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CreditTransferTransaction2) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CreditTransferTransaction2) SetExchangeRate(value string) {
 	c.ExchangeRate = (*BaseOneRate)(&value)
 }
 
-func (c *CreditTransferTransaction2) SetChargeBearer(value string) {
+func (c *CreditTransferTransaction2) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(BaseOneRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
+func (c *CreditTransferTransaction2) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (c *CreditTransferTransaction2) AddChargesInformation() *Charges2 {
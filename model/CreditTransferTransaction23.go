@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provide further details specific to the individual transaction(s) included in the message.
 type CreditTransferTransaction23 struct {
 
@@ -97,7 +103,7 @@ type CreditTransferTransaction23 struct {
 	//
 	// Usage: The next agent may not be the creditor agent.
 	// The instruction can relate to a level of service, can be an instruction that has to be executed by the agent, or can be information required by the next agent.
-	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty"`
+	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty" xsd:"maxOccurs=6"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation2 `xml:"RmtInf,omitempty"`
@@ -109,6 +115,10 @@ type CreditTransferTransaction23 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CreditTransferTransaction23) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransaction23) AddPaymentIdentification() *PaymentIdentification3 {
 	c.PaymentIdentification = new(PaymentIdentification3)
 	return c.PaymentIdentification
@@ -123,12 +133,25 @@ func (c *CreditTransferTransaction23) SetInterbankSettlementAmount(value, curren
 	c.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CreditTransferTransaction23) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CreditTransferTransaction23) SetInterbankSettlementDate(value string) {
 	c.InterbankSettlementDate = (*ISODate)(&value)
 }
 
-func (c *CreditTransferTransaction23) SetSettlementPriority(value string) {
+func (c *CreditTransferTransaction23) SetInterbankSettlementDateFromTime(value time.Time) {
+	c.InterbankSettlementDate = new(ISODate)
+	c.InterbankSettlementDate.FromTime(value)
+}
+
+func (c *CreditTransferTransaction23) SetSettlementPriority(value string) error {
+	if err := Priority3Code(value).Validate(); err != nil {
+		return err
+	}
 	c.SettlementPriority = (*Priority3Code)(&value)
+	return nil
 }
 
 func (c *CreditTransferTransaction23) AddSettlementTimeIndication() *SettlementDateTimeIndication1 {
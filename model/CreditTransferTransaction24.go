@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details specific to the individual transaction(s) included in the message.
 type CreditTransferTransaction24 struct {
 
@@ -71,7 +75,7 @@ type CreditTransferTransaction24 struct {
 	InstructionForCreditorAgent []*InstructionForCreditorAgent1 `xml:"InstrForCdtrAgt,omitempty"`
 
 	// Further information related to the processing of the payment instruction that may need to be acted upon by the next agent.
-	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty"`
+	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty" xsd:"maxOccurs=6"`
 
 	// Provides details on the tax.
 	Tax *TaxInformation3 `xml:"Tax,omitempty"`
@@ -84,6 +88,10 @@ type CreditTransferTransaction24 struct {
 	InstructedAmount *ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt,omitempty"`
 }
 
+func (c *CreditTransferTransaction24) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransaction24) AddUltimateDebtor() *PartyIdentification43 {
 	c.UltimateDebtor = new(PartyIdentification43)
 	return c.UltimateDebtor
@@ -484,3 +492,7 @@ func (c *CreditTransferTransaction24) AddRemittanceInformation() *RemittanceInfo
 func (c *CreditTransferTransaction24) SetInstructedAmount(value, currency string) {
 	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (c *CreditTransferTransaction24) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
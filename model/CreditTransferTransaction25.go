@@ -1,5 +1,12 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides further details specific to the individual transaction(s) included in the message.
 type CreditTransferTransaction25 struct {
 
@@ -120,20 +127,20 @@ type CreditTransferTransaction25 struct {
 	//
 	// Usage: The next agent may not be the creditor agent.
 	// The instruction can relate to a level of service, can be an instruction that has to be executed by the agent, or can be information required by the next agent.
-	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty"`
+	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty" xsd:"maxOccurs=6"`
 
 	// Underlying reason for the payment transaction.
 	// Usage: Purpose is used by the end-customers, that is initiating party, (ultimate) debtor, (ultimate) creditor to provide information concerning the nature of the payment. Purpose is a content element, which is not used for processing by any of the agents involved in the payment chain.
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 
 	// Information needed due to regulatory and statutory requirements.
-	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty"`
+	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
 
 	// Provides details on the tax.
 	Tax *TaxInformation3 `xml:"Tax,omitempty"`
 
 	// Provides information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation4 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation4 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation11 `xml:"RmtInf,omitempty"`
@@ -142,6 +149,10 @@ type CreditTransferTransaction25 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CreditTransferTransaction25) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransaction25) AddPaymentIdentification() *PaymentIdentification3 {
 	c.PaymentIdentification = new(PaymentIdentification3)
 	return c.PaymentIdentification
@@ -156,12 +167,25 @@ func (c *CreditTransferTransaction25) SetInterbankSettlementAmount(value, curren
 	c.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CreditTransferTransaction25) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CreditTransferTransaction25) SetInterbankSettlementDate(value string) {
 	c.InterbankSettlementDate = (*ISODate)(&value)
 }
 
-func (c *CreditTransferTransaction25) SetSettlementPriority(value string) {
+func (c *CreditTransferTransaction25) SetInterbankSettlementDateFromTime(value time.Time) {
+	c.InterbankSettlementDate = new(ISODate)
+	c.InterbankSettlementDate.FromTime(value)
+}
+
+func (c *CreditTransferTransaction25) SetSettlementPriority(value string) error {
+	if err := Priority3Code(value).Validate(); err != nil {
+		return err
+	}
 	c.SettlementPriority = (*Priority3Code)(&value)
+	return nil
 }
 
 func (c *CreditTransferTransaction25) AddSettlementTimeIndication() *SettlementDateTimeIndication1 {
@@ -178,20 +202,43 @@ func (c *CreditTransferTransaction25) SetAcceptanceDateTime(value string) {
 	c.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CreditTransferTransaction25) SetAcceptanceDateTimeFromTime(value time.Time) {
+	c.AcceptanceDateTime = new(ISODateTime)
+	c.AcceptanceDateTime.FromTime(value)
+}
+
 func (c *CreditTransferTransaction25) SetPoolingAdjustmentDate(value string) {
 	c.PoolingAdjustmentDate = (*ISODate)(&value)
 }
 
+func (c *CreditTransferTransaction25) SetPoolingAdjustmentDateFromTime(value time.Time) {
+	c.PoolingAdjustmentDate = new(ISODate)
+	c.PoolingAdjustmentDate.FromTime(value)
+}
+
 func (c *CreditTransferTransaction25) SetInstructedAmount(value, currency string) {
 	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (c *CreditTransferTransaction25) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CreditTransferTransaction25) SetExchangeRate(value string) {
 	c.ExchangeRate = (*BaseOneRate)(&value)
 }
 
-func (c *CreditTransferTransaction25) SetChargeBearer(value string) {
+func (c *CreditTransferTransaction25) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(BaseOneRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
+func (c *CreditTransferTransaction25) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (c *CreditTransferTransaction25) AddChargesInformation() *Charges2 {
@@ -1050,6 +1097,419 @@ func (c *CreditTransferTransaction25) AddSupplementaryData() *SupplementaryData1
 	return newValue
 }
 
+func (c *CreditTransferTransaction25) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.PaymentIdentification != nil {
+		c.PaymentIdentification.EncodeXMLFast(enc, "PmtId")
+	}
+	if c.PaymentTypeInformation != nil {
+		c.PaymentTypeInformation.EncodeXMLFast(enc, "PmtTpInf")
+	}
+	if c.InterbankSettlementAmount != nil {
+		c.InterbankSettlementAmount.EncodeXMLFast(enc, "IntrBkSttlmAmt")
+	}
+	if c.InterbankSettlementDate != nil {
+		enc.Element("IntrBkSttlmDt", string(*c.InterbankSettlementDate))
+	}
+	if c.SettlementPriority != nil {
+		enc.Element("SttlmPrty", string(*c.SettlementPriority))
+	}
+	if c.SettlementTimeIndication != nil {
+		c.SettlementTimeIndication.EncodeXMLFast(enc, "SttlmTmIndctn")
+	}
+	if c.SettlementTimeRequest != nil {
+		c.SettlementTimeRequest.EncodeXMLFast(enc, "SttlmTmReq")
+	}
+	if c.AcceptanceDateTime != nil {
+		enc.Element("AccptncDtTm", string(*c.AcceptanceDateTime))
+	}
+	if c.PoolingAdjustmentDate != nil {
+		enc.Element("PoolgAdjstmntDt", string(*c.PoolingAdjustmentDate))
+	}
+	if c.InstructedAmount != nil {
+		c.InstructedAmount.EncodeXMLFast(enc, "InstdAmt")
+	}
+	if c.ExchangeRate != nil {
+		enc.Element("XchgRate", string(*c.ExchangeRate))
+	}
+	if c.ChargeBearer != nil {
+		enc.Element("ChrgBr", string(*c.ChargeBearer))
+	}
+	for _, elem := range c.ChargesInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "ChrgsInf")
+		}
+	}
+	if c.PreviousInstructingAgent != nil {
+		c.PreviousInstructingAgent.EncodeXMLFast(enc, "PrvsInstgAgt")
+	}
+	if c.PreviousInstructingAgentAccount != nil {
+		c.PreviousInstructingAgentAccount.EncodeXMLFast(enc, "PrvsInstgAgtAcct")
+	}
+	if c.InstructingAgent != nil {
+		c.InstructingAgent.EncodeXMLFast(enc, "InstgAgt")
+	}
+	if c.InstructedAgent != nil {
+		c.InstructedAgent.EncodeXMLFast(enc, "InstdAgt")
+	}
+	if c.IntermediaryAgent1 != nil {
+		c.IntermediaryAgent1.EncodeXMLFast(enc, "IntrmyAgt1")
+	}
+	if c.IntermediaryAgent1Account != nil {
+		c.IntermediaryAgent1Account.EncodeXMLFast(enc, "IntrmyAgt1Acct")
+	}
+	if c.IntermediaryAgent2 != nil {
+		c.IntermediaryAgent2.EncodeXMLFast(enc, "IntrmyAgt2")
+	}
+	if c.IntermediaryAgent2Account != nil {
+		c.IntermediaryAgent2Account.EncodeXMLFast(enc, "IntrmyAgt2Acct")
+	}
+	if c.IntermediaryAgent3 != nil {
+		c.IntermediaryAgent3.EncodeXMLFast(enc, "IntrmyAgt3")
+	}
+	if c.IntermediaryAgent3Account != nil {
+		c.IntermediaryAgent3Account.EncodeXMLFast(enc, "IntrmyAgt3Acct")
+	}
+	if c.UltimateDebtor != nil {
+		c.UltimateDebtor.EncodeXMLFast(enc, "UltmtDbtr")
+	}
+	if c.InitiatingParty != nil {
+		c.InitiatingParty.EncodeXMLFast(enc, "InitgPty")
+	}
+	if c.Debtor != nil {
+		c.Debtor.EncodeXMLFast(enc, "Dbtr")
+	}
+	if c.DebtorAccount != nil {
+		c.DebtorAccount.EncodeXMLFast(enc, "DbtrAcct")
+	}
+	if c.DebtorAgent != nil {
+		c.DebtorAgent.EncodeXMLFast(enc, "DbtrAgt")
+	}
+	if c.DebtorAgentAccount != nil {
+		c.DebtorAgentAccount.EncodeXMLFast(enc, "DbtrAgtAcct")
+	}
+	if c.CreditorAgent != nil {
+		c.CreditorAgent.EncodeXMLFast(enc, "CdtrAgt")
+	}
+	if c.CreditorAgentAccount != nil {
+		c.CreditorAgentAccount.EncodeXMLFast(enc, "CdtrAgtAcct")
+	}
+	if c.Creditor != nil {
+		c.Creditor.EncodeXMLFast(enc, "Cdtr")
+	}
+	if c.CreditorAccount != nil {
+		c.CreditorAccount.EncodeXMLFast(enc, "CdtrAcct")
+	}
+	if c.UltimateCreditor != nil {
+		c.UltimateCreditor.EncodeXMLFast(enc, "UltmtCdtr")
+	}
+	for _, elem := range c.InstructionForCreditorAgent {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "InstrForCdtrAgt")
+		}
+	}
+	for _, elem := range c.InstructionForNextAgent {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "InstrForNxtAgt")
+		}
+	}
+	if c.Purpose != nil {
+		c.Purpose.EncodeXMLFast(enc, "Purp")
+	}
+	for _, elem := range c.RegulatoryReporting {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RgltryRptg")
+		}
+	}
+	if c.Tax != nil {
+		c.Tax.EncodeXMLFast(enc, "Tax")
+	}
+	for _, elem := range c.RelatedRemittanceInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RltdRmtInf")
+		}
+	}
+	if c.RemittanceInformation != nil {
+		c.RemittanceInformation.EncodeXMLFast(enc, "RmtInf")
+	}
+	for _, elem := range c.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (c *CreditTransferTransaction25) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "PmtId":
+			elem := new(PaymentIdentification3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PaymentIdentification = elem
+		case "PmtTpInf":
+			elem := new(PaymentTypeInformation21)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PaymentTypeInformation = elem
+		case "IntrBkSttlmAmt":
+			elem := new(ActiveCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InterbankSettlementAmount = elem
+		case "IntrBkSttlmDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.InterbankSettlementDate = (*ISODate)(&value)
+		case "SttlmPrty":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.SettlementPriority = (*Priority3Code)(&value)
+		case "SttlmTmIndctn":
+			elem := new(SettlementDateTimeIndication1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.SettlementTimeIndication = elem
+		case "SttlmTmReq":
+			elem := new(SettlementTimeRequest2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.SettlementTimeRequest = elem
+		case "AccptncDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.AcceptanceDateTime = (*ISODateTime)(&value)
+		case "PoolgAdjstmntDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.PoolingAdjustmentDate = (*ISODate)(&value)
+		case "InstdAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InstructedAmount = elem
+		case "XchgRate":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ExchangeRate = (*BaseOneRate)(&value)
+		case "ChrgBr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ChargeBearer = (*ChargeBearerType1Code)(&value)
+		case "ChrgsInf":
+			elem := new(Charges2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.ChargesInformation = append(c.ChargesInformation, elem)
+		case "PrvsInstgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PreviousInstructingAgent = elem
+		case "PrvsInstgAgtAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PreviousInstructingAgentAccount = elem
+		case "InstgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InstructingAgent = elem
+		case "InstdAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InstructedAgent = elem
+		case "IntrmyAgt1":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent1 = elem
+		case "IntrmyAgt1Acct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent1Account = elem
+		case "IntrmyAgt2":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent2 = elem
+		case "IntrmyAgt2Acct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent2Account = elem
+		case "IntrmyAgt3":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent3 = elem
+		case "IntrmyAgt3Acct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent3Account = elem
+		case "UltmtDbtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.UltimateDebtor = elem
+		case "InitgPty":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InitiatingParty = elem
+		case "Dbtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Debtor = elem
+		case "DbtrAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.DebtorAccount = elem
+		case "DbtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.DebtorAgent = elem
+		case "DbtrAgtAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.DebtorAgentAccount = elem
+		case "CdtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditorAgent = elem
+		case "CdtrAgtAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditorAgentAccount = elem
+		case "Cdtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Creditor = elem
+		case "CdtrAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditorAccount = elem
+		case "UltmtCdtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.UltimateCreditor = elem
+		case "InstrForCdtrAgt":
+			elem := new(InstructionForCreditorAgent1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InstructionForCreditorAgent = append(c.InstructionForCreditorAgent, elem)
+		case "InstrForNxtAgt":
+			elem := new(InstructionForNextAgent1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InstructionForNextAgent = append(c.InstructionForNextAgent, elem)
+		case "Purp":
+			elem := new(Purpose2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Purpose = elem
+		case "RgltryRptg":
+			elem := new(RegulatoryReporting3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.RegulatoryReporting = append(c.RegulatoryReporting, elem)
+		case "Tax":
+			elem := new(TaxInformation3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Tax = elem
+		case "RltdRmtInf":
+			elem := new(RemittanceLocation4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.RelatedRemittanceInformation = append(c.RelatedRemittanceInformation, elem)
+		case "RmtInf":
+			elem := new(RemittanceInformation11)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.RemittanceInformation = elem
+		case "SplmtryData":
+			elem := new(SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.SupplementaryData = append(c.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
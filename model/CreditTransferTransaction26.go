@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Provides information specific to the individual transaction(s) included in the message.
 type CreditTransferTransaction26 struct {
 
@@ -74,13 +76,13 @@ type CreditTransferTransaction26 struct {
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 
 	// Information needed due to regulatory and statutory requirements.
-	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty"`
+	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
 
 	// Provides details on the tax.
 	Tax *TaxInformation3 `xml:"Tax,omitempty"`
 
 	// Provides information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation4 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation4 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation11 `xml:"RmtInf,omitempty"`
@@ -89,6 +91,10 @@ type CreditTransferTransaction26 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CreditTransferTransaction26) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransaction26) AddPaymentIdentification() *PaymentIdentification1 {
 	c.PaymentIdentification = new(PaymentIdentification1)
 	return c.PaymentIdentification
@@ -109,8 +115,12 @@ func (c *CreditTransferTransaction26) AddExchangeRateInformation() *ExchangeRate
 	return c.ExchangeRateInformation
 }
 
-func (c *CreditTransferTransaction26) SetChargeBearer(value string) {
+func (c *CreditTransferTransaction26) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (c *CreditTransferTransaction26) AddChequeInstruction() *Cheque7 {
@@ -641,6 +651,271 @@ func (c *CreditTransferTransaction26) AddSupplementaryData() *SupplementaryData1
 	return newValue
 }
 
+func (c *CreditTransferTransaction26) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.PaymentIdentification != nil {
+		c.PaymentIdentification.EncodeXMLFast(enc, "PmtId")
+	}
+	if c.PaymentTypeInformation != nil {
+		c.PaymentTypeInformation.EncodeXMLFast(enc, "PmtTpInf")
+	}
+	if c.Amount != nil {
+		c.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if c.ExchangeRateInformation != nil {
+		c.ExchangeRateInformation.EncodeXMLFast(enc, "XchgRateInf")
+	}
+	if c.ChargeBearer != nil {
+		enc.Element("ChrgBr", string(*c.ChargeBearer))
+	}
+	if c.ChequeInstruction != nil {
+		c.ChequeInstruction.EncodeXMLFast(enc, "ChqInstr")
+	}
+	if c.UltimateDebtor != nil {
+		c.UltimateDebtor.EncodeXMLFast(enc, "UltmtDbtr")
+	}
+	if c.IntermediaryAgent1 != nil {
+		c.IntermediaryAgent1.EncodeXMLFast(enc, "IntrmyAgt1")
+	}
+	if c.IntermediaryAgent1Account != nil {
+		c.IntermediaryAgent1Account.EncodeXMLFast(enc, "IntrmyAgt1Acct")
+	}
+	if c.IntermediaryAgent2 != nil {
+		c.IntermediaryAgent2.EncodeXMLFast(enc, "IntrmyAgt2")
+	}
+	if c.IntermediaryAgent2Account != nil {
+		c.IntermediaryAgent2Account.EncodeXMLFast(enc, "IntrmyAgt2Acct")
+	}
+	if c.IntermediaryAgent3 != nil {
+		c.IntermediaryAgent3.EncodeXMLFast(enc, "IntrmyAgt3")
+	}
+	if c.IntermediaryAgent3Account != nil {
+		c.IntermediaryAgent3Account.EncodeXMLFast(enc, "IntrmyAgt3Acct")
+	}
+	if c.CreditorAgent != nil {
+		c.CreditorAgent.EncodeXMLFast(enc, "CdtrAgt")
+	}
+	if c.CreditorAgentAccount != nil {
+		c.CreditorAgentAccount.EncodeXMLFast(enc, "CdtrAgtAcct")
+	}
+	if c.Creditor != nil {
+		c.Creditor.EncodeXMLFast(enc, "Cdtr")
+	}
+	if c.CreditorAccount != nil {
+		c.CreditorAccount.EncodeXMLFast(enc, "CdtrAcct")
+	}
+	if c.UltimateCreditor != nil {
+		c.UltimateCreditor.EncodeXMLFast(enc, "UltmtCdtr")
+	}
+	for _, elem := range c.InstructionForCreditorAgent {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "InstrForCdtrAgt")
+		}
+	}
+	if c.InstructionForDebtorAgent != nil {
+		enc.Element("InstrForDbtrAgt", string(*c.InstructionForDebtorAgent))
+	}
+	if c.Purpose != nil {
+		c.Purpose.EncodeXMLFast(enc, "Purp")
+	}
+	for _, elem := range c.RegulatoryReporting {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RgltryRptg")
+		}
+	}
+	if c.Tax != nil {
+		c.Tax.EncodeXMLFast(enc, "Tax")
+	}
+	for _, elem := range c.RelatedRemittanceInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RltdRmtInf")
+		}
+	}
+	if c.RemittanceInformation != nil {
+		c.RemittanceInformation.EncodeXMLFast(enc, "RmtInf")
+	}
+	for _, elem := range c.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (c *CreditTransferTransaction26) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "PmtId":
+			elem := new(PaymentIdentification1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PaymentIdentification = elem
+		case "PmtTpInf":
+			elem := new(PaymentTypeInformation19)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PaymentTypeInformation = elem
+		case "Amt":
+			elem := new(AmountType4Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Amount = elem
+		case "XchgRateInf":
+			elem := new(ExchangeRate1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.ExchangeRateInformation = elem
+		case "ChrgBr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ChargeBearer = (*ChargeBearerType1Code)(&value)
+		case "ChqInstr":
+			elem := new(Cheque7)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.ChequeInstruction = elem
+		case "UltmtDbtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.UltimateDebtor = elem
+		case "IntrmyAgt1":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent1 = elem
+		case "IntrmyAgt1Acct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent1Account = elem
+		case "IntrmyAgt2":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent2 = elem
+		case "IntrmyAgt2Acct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent2Account = elem
+		case "IntrmyAgt3":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent3 = elem
+		case "IntrmyAgt3Acct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent3Account = elem
+		case "CdtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditorAgent = elem
+		case "CdtrAgtAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditorAgentAccount = elem
+		case "Cdtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Creditor = elem
+		case "CdtrAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditorAccount = elem
+		case "UltmtCdtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.UltimateCreditor = elem
+		case "InstrForCdtrAgt":
+			elem := new(InstructionForCreditorAgent1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InstructionForCreditorAgent = append(c.InstructionForCreditorAgent, elem)
+		case "InstrForDbtrAgt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.InstructionForDebtorAgent = (*Max140Text)(&value)
+		case "Purp":
+			elem := new(Purpose2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Purpose = elem
+		case "RgltryRptg":
+			elem := new(RegulatoryReporting3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.RegulatoryReporting = append(c.RegulatoryReporting, elem)
+		case "Tax":
+			elem := new(TaxInformation3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Tax = elem
+		case "RltdRmtInf":
+			elem := new(RemittanceLocation4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.RelatedRemittanceInformation = append(c.RelatedRemittanceInformation, elem)
+		case "RmtInf":
+			elem := new(RemittanceInformation11)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.RemittanceInformation = elem
+		case "SplmtryData":
+			elem := new(SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.SupplementaryData = append(c.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details specific to the individual transaction(s) included in the message.
 type CreditTransferTransaction3 struct {
 
@@ -74,6 +78,10 @@ type CreditTransferTransaction3 struct {
 	InstructedAmount *ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt,omitempty"`
 }
 
+func (c *CreditTransferTransaction3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransaction3) AddUltimateDebtor() *PartyIdentification43 {
 	c.UltimateDebtor = new(PartyIdentification43)
 	return c.UltimateDebtor
@@ -177,3 +185,7 @@ func (c *CreditTransferTransaction3) AddRemittanceInformation() *RemittanceInfor
 func (c *CreditTransferTransaction3) SetInstructedAmount(value, currency string) {
 	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (c *CreditTransferTransaction3) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
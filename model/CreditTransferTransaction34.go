@@ -0,0 +1,497 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Provides information specific to the individual transaction(s) included in the message.
+type CreditTransferTransaction34 struct {
+
+	// Set of elements used to reference a payment instruction.
+	PaymentIdentification *PaymentIdentification6 `xml:"PmtId"`
+
+	// Set of elements used to further specify the type of transaction.
+	PaymentTypeInformation *PaymentTypeInformation26 `xml:"PmtTpInf,omitempty"`
+
+	// Amount of money to be moved between the debtor and creditor, before deduction of charges, expressed in the currency as ordered by the initiating party.
+	Amount *AmountType4Choice `xml:"Amt"`
+
+	// Provides details on the currency exchange rate and contract.
+	ExchangeRateInformation *ExchangeRate1 `xml:"XchgRateInf,omitempty"`
+
+	// Specifies which party/parties will bear the charges associated with the processing of the payment transaction.
+	ChargeBearer *ChargeBearerType1Code `xml:"ChrgBr,omitempty"`
+
+	// Set of elements needed to issue a cheque.
+	ChequeInstruction *Cheque11 `xml:"ChqInstr,omitempty"`
+
+	// Ultimate party that owes an amount of money to the (ultimate) creditor.
+	UltimateDebtor *PartyIdentification135 `xml:"UltmtDbtr,omitempty"`
+
+	// Agent between the debtor's agent and the creditor's agent.
+	//
+	// Usage: If more than one intermediary agent is present, then IntermediaryAgent1 identifies the agent between the DebtorAgent and the IntermediaryAgent2.
+	IntermediaryAgent1 *BranchAndFinancialInstitutionIdentification6 `xml:"IntrmyAgt1,omitempty"`
+
+	// Unambiguous identification of the account of the intermediary agent 1 at its servicing agent in the payment chain.
+	IntermediaryAgent1Account *CashAccount38 `xml:"IntrmyAgt1Acct,omitempty"`
+
+	// Agent between the debtor's agent and the creditor's agent.
+	//
+	// Usage: If more than two intermediary agents are present, then IntermediaryAgent2 identifies the agent between the IntermediaryAgent1 and the IntermediaryAgent3.
+	IntermediaryAgent2 *BranchAndFinancialInstitutionIdentification6 `xml:"IntrmyAgt2,omitempty"`
+
+	// Unambiguous identification of the account of the intermediary agent 2 at its servicing agent in the payment chain.
+	IntermediaryAgent2Account *CashAccount38 `xml:"IntrmyAgt2Acct,omitempty"`
+
+	// Agent between the debtor's agent and the creditor's agent.
+	//
+	// Usage: If IntermediaryAgent3 is present, then it identifies the agent between the IntermediaryAgent 2 and the CreditorAgent.
+	IntermediaryAgent3 *BranchAndFinancialInstitutionIdentification6 `xml:"IntrmyAgt3,omitempty"`
+
+	// Unambiguous identification of the account of the intermediary agent 3 at its servicing agent in the payment chain.
+	IntermediaryAgent3Account *CashAccount38 `xml:"IntrmyAgt3Acct,omitempty"`
+
+	// Financial institution servicing an account for the creditor.
+	CreditorAgent *BranchAndFinancialInstitutionIdentification6 `xml:"CdtrAgt,omitempty"`
+
+	// Unambiguous identification of the account of the creditor agent at its servicing agent to which a credit entry will be made as a result of the payment transaction.
+	CreditorAgentAccount *CashAccount38 `xml:"CdtrAgtAcct,omitempty"`
+
+	// Party to which an amount of money is due.
+	Creditor *PartyIdentification135 `xml:"Cdtr,omitempty"`
+
+	// Unambiguous identification of the account of the creditor to which a credit entry will be posted as a result of the payment transaction.
+	CreditorAccount *CashAccount38 `xml:"CdtrAcct,omitempty"`
+
+	// Ultimate party to which an amount of money is due.
+	UltimateCreditor *PartyIdentification135 `xml:"UltmtCdtr,omitempty"`
+
+	// Further information related to the processing of the payment instruction, provided by the initiating party, and intended for the creditor agent.
+	InstructionForCreditorAgent []*InstructionForCreditorAgent1 `xml:"InstrForCdtrAgt,omitempty"`
+
+	// Further information related to the processing of the payment instruction, that may need to be acted upon by the debtor agent, depending on agreement between debtor and the debtor agent.
+	InstructionForDebtorAgent *Max140Text `xml:"InstrForDbtrAgt,omitempty"`
+
+	// Underlying reason for the payment transaction.
+	// Usage: Purpose is used by the end-customers, that is initiating party, (ultimate) debtor, (ultimate) creditor to provide information concerning the nature of the payment. Purpose is a content element, which is not used for processing by any of the agents involved in the payment chain.
+	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
+
+	// Information needed due to regulatory and statutory requirements.
+	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
+
+	// Provides details on the tax.
+	Tax *TaxInformation8 `xml:"Tax,omitempty"`
+
+	// Provides information related to the handling of the remittance information by any of the agents in the transaction processing chain.
+	RelatedRemittanceInformation []*RemittanceLocation7 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
+
+	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
+	RemittanceInformation *RemittanceInformation16 `xml:"RmtInf,omitempty"`
+
+	// Additional information that cannot be captured in the structured elements and/or any other specific block.
+	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
+}
+
+func (c *CreditTransferTransaction34) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CreditTransferTransaction34) AddPaymentIdentification() *PaymentIdentification6 {
+	c.PaymentIdentification = new(PaymentIdentification6)
+	return c.PaymentIdentification
+}
+
+func (c *CreditTransferTransaction34) AddPaymentTypeInformation() *PaymentTypeInformation26 {
+	c.PaymentTypeInformation = new(PaymentTypeInformation26)
+	return c.PaymentTypeInformation
+}
+
+func (c *CreditTransferTransaction34) AddAmount() *AmountType4Choice {
+	c.Amount = new(AmountType4Choice)
+	return c.Amount
+}
+
+func (c *CreditTransferTransaction34) AddExchangeRateInformation() *ExchangeRate1 {
+	c.ExchangeRateInformation = new(ExchangeRate1)
+	return c.ExchangeRateInformation
+}
+
+func (c *CreditTransferTransaction34) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
+	c.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
+}
+
+func (c *CreditTransferTransaction34) AddChequeInstruction() *Cheque11 {
+	c.ChequeInstruction = new(Cheque11)
+	return c.ChequeInstruction
+}
+
+func (c *CreditTransferTransaction34) AddUltimateDebtor() *PartyIdentification135 {
+	c.UltimateDebtor = new(PartyIdentification135)
+	return c.UltimateDebtor
+}
+
+func (c *CreditTransferTransaction34) AddIntermediaryAgent1() *BranchAndFinancialInstitutionIdentification6 {
+	c.IntermediaryAgent1 = new(BranchAndFinancialInstitutionIdentification6)
+	return c.IntermediaryAgent1
+}
+
+func (c *CreditTransferTransaction34) AddIntermediaryAgent1Account() *CashAccount38 {
+	c.IntermediaryAgent1Account = new(CashAccount38)
+	return c.IntermediaryAgent1Account
+}
+
+func (c *CreditTransferTransaction34) AddIntermediaryAgent2() *BranchAndFinancialInstitutionIdentification6 {
+	c.IntermediaryAgent2 = new(BranchAndFinancialInstitutionIdentification6)
+	return c.IntermediaryAgent2
+}
+
+func (c *CreditTransferTransaction34) AddIntermediaryAgent2Account() *CashAccount38 {
+	c.IntermediaryAgent2Account = new(CashAccount38)
+	return c.IntermediaryAgent2Account
+}
+
+func (c *CreditTransferTransaction34) AddIntermediaryAgent3() *BranchAndFinancialInstitutionIdentification6 {
+	c.IntermediaryAgent3 = new(BranchAndFinancialInstitutionIdentification6)
+	return c.IntermediaryAgent3
+}
+
+func (c *CreditTransferTransaction34) AddIntermediaryAgent3Account() *CashAccount38 {
+	c.IntermediaryAgent3Account = new(CashAccount38)
+	return c.IntermediaryAgent3Account
+}
+
+func (c *CreditTransferTransaction34) AddCreditorAgent() *BranchAndFinancialInstitutionIdentification6 {
+	c.CreditorAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return c.CreditorAgent
+}
+
+func (c *CreditTransferTransaction34) AddCreditorAgentAccount() *CashAccount38 {
+	c.CreditorAgentAccount = new(CashAccount38)
+	return c.CreditorAgentAccount
+}
+
+func (c *CreditTransferTransaction34) AddCreditor() *PartyIdentification135 {
+	c.Creditor = new(PartyIdentification135)
+	return c.Creditor
+}
+
+func (c *CreditTransferTransaction34) AddCreditorAccount() *CashAccount38 {
+	c.CreditorAccount = new(CashAccount38)
+	return c.CreditorAccount
+}
+
+func (c *CreditTransferTransaction34) AddUltimateCreditor() *PartyIdentification135 {
+	c.UltimateCreditor = new(PartyIdentification135)
+	return c.UltimateCreditor
+}
+
+func (c *CreditTransferTransaction34) AddInstructionForCreditorAgent() *InstructionForCreditorAgent1 {
+	newValue := new(InstructionForCreditorAgent1)
+	c.InstructionForCreditorAgent = append(c.InstructionForCreditorAgent, newValue)
+	return newValue
+}
+
+func (c *CreditTransferTransaction34) SetInstructionForDebtorAgent(value string) {
+	c.InstructionForDebtorAgent = (*Max140Text)(&value)
+}
+
+func (c *CreditTransferTransaction34) AddPurpose() *Purpose2Choice {
+	c.Purpose = new(Purpose2Choice)
+	return c.Purpose
+}
+
+func (c *CreditTransferTransaction34) AddRegulatoryReporting() *RegulatoryReporting3 {
+	newValue := new(RegulatoryReporting3)
+	c.RegulatoryReporting = append(c.RegulatoryReporting, newValue)
+	return newValue
+}
+
+func (c *CreditTransferTransaction34) AddTax() *TaxInformation8 {
+	c.Tax = new(TaxInformation8)
+	return c.Tax
+}
+
+func (c *CreditTransferTransaction34) AddRelatedRemittanceInformation() *RemittanceLocation7 {
+	newValue := new(RemittanceLocation7)
+	c.RelatedRemittanceInformation = append(c.RelatedRemittanceInformation, newValue)
+	return newValue
+}
+
+func (c *CreditTransferTransaction34) AddRemittanceInformation() *RemittanceInformation16 {
+	c.RemittanceInformation = new(RemittanceInformation16)
+	return c.RemittanceInformation
+}
+
+func (c *CreditTransferTransaction34) AddSupplementaryData() *SupplementaryData1 {
+	newValue := new(SupplementaryData1)
+	c.SupplementaryData = append(c.SupplementaryData, newValue)
+	return newValue
+}
+
+func (c *CreditTransferTransaction34) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.PaymentIdentification != nil {
+		c.PaymentIdentification.EncodeXMLFast(enc, "PmtId")
+	}
+	if c.PaymentTypeInformation != nil {
+		c.PaymentTypeInformation.EncodeXMLFast(enc, "PmtTpInf")
+	}
+	if c.Amount != nil {
+		c.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if c.ExchangeRateInformation != nil {
+		c.ExchangeRateInformation.EncodeXMLFast(enc, "XchgRateInf")
+	}
+	if c.ChargeBearer != nil {
+		enc.Element("ChrgBr", string(*c.ChargeBearer))
+	}
+	if c.ChequeInstruction != nil {
+		c.ChequeInstruction.EncodeXMLFast(enc, "ChqInstr")
+	}
+	if c.UltimateDebtor != nil {
+		c.UltimateDebtor.EncodeXMLFast(enc, "UltmtDbtr")
+	}
+	if c.IntermediaryAgent1 != nil {
+		c.IntermediaryAgent1.EncodeXMLFast(enc, "IntrmyAgt1")
+	}
+	if c.IntermediaryAgent1Account != nil {
+		c.IntermediaryAgent1Account.EncodeXMLFast(enc, "IntrmyAgt1Acct")
+	}
+	if c.IntermediaryAgent2 != nil {
+		c.IntermediaryAgent2.EncodeXMLFast(enc, "IntrmyAgt2")
+	}
+	if c.IntermediaryAgent2Account != nil {
+		c.IntermediaryAgent2Account.EncodeXMLFast(enc, "IntrmyAgt2Acct")
+	}
+	if c.IntermediaryAgent3 != nil {
+		c.IntermediaryAgent3.EncodeXMLFast(enc, "IntrmyAgt3")
+	}
+	if c.IntermediaryAgent3Account != nil {
+		c.IntermediaryAgent3Account.EncodeXMLFast(enc, "IntrmyAgt3Acct")
+	}
+	if c.CreditorAgent != nil {
+		c.CreditorAgent.EncodeXMLFast(enc, "CdtrAgt")
+	}
+	if c.CreditorAgentAccount != nil {
+		c.CreditorAgentAccount.EncodeXMLFast(enc, "CdtrAgtAcct")
+	}
+	if c.Creditor != nil {
+		c.Creditor.EncodeXMLFast(enc, "Cdtr")
+	}
+	if c.CreditorAccount != nil {
+		c.CreditorAccount.EncodeXMLFast(enc, "CdtrAcct")
+	}
+	if c.UltimateCreditor != nil {
+		c.UltimateCreditor.EncodeXMLFast(enc, "UltmtCdtr")
+	}
+	for _, elem := range c.InstructionForCreditorAgent {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "InstrForCdtrAgt")
+		}
+	}
+	if c.InstructionForDebtorAgent != nil {
+		enc.Element("InstrForDbtrAgt", string(*c.InstructionForDebtorAgent))
+	}
+	if c.Purpose != nil {
+		c.Purpose.EncodeXMLFast(enc, "Purp")
+	}
+	for _, elem := range c.RegulatoryReporting {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RgltryRptg")
+		}
+	}
+	if c.Tax != nil {
+		c.Tax.EncodeXMLFast(enc, "Tax")
+	}
+	for _, elem := range c.RelatedRemittanceInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RltdRmtInf")
+		}
+	}
+	if c.RemittanceInformation != nil {
+		c.RemittanceInformation.EncodeXMLFast(enc, "RmtInf")
+	}
+	for _, elem := range c.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (c *CreditTransferTransaction34) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "PmtId":
+			elem := new(PaymentIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PaymentIdentification = elem
+		case "PmtTpInf":
+			elem := new(PaymentTypeInformation26)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PaymentTypeInformation = elem
+		case "Amt":
+			elem := new(AmountType4Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Amount = elem
+		case "XchgRateInf":
+			elem := new(ExchangeRate1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.ExchangeRateInformation = elem
+		case "ChrgBr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ChargeBearer = (*ChargeBearerType1Code)(&value)
+		case "ChqInstr":
+			elem := new(Cheque11)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.ChequeInstruction = elem
+		case "UltmtDbtr":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.UltimateDebtor = elem
+		case "IntrmyAgt1":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent1 = elem
+		case "IntrmyAgt1Acct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent1Account = elem
+		case "IntrmyAgt2":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent2 = elem
+		case "IntrmyAgt2Acct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent2Account = elem
+		case "IntrmyAgt3":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent3 = elem
+		case "IntrmyAgt3Acct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent3Account = elem
+		case "CdtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditorAgent = elem
+		case "CdtrAgtAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditorAgentAccount = elem
+		case "Cdtr":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Creditor = elem
+		case "CdtrAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditorAccount = elem
+		case "UltmtCdtr":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.UltimateCreditor = elem
+		case "InstrForCdtrAgt":
+			elem := new(InstructionForCreditorAgent1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InstructionForCreditorAgent = append(c.InstructionForCreditorAgent, elem)
+		case "InstrForDbtrAgt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.InstructionForDebtorAgent = (*Max140Text)(&value)
+		case "Purp":
+			elem := new(Purpose2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Purpose = elem
+		case "RgltryRptg":
+			elem := new(RegulatoryReporting3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.RegulatoryReporting = append(c.RegulatoryReporting, elem)
+		case "Tax":
+			elem := new(TaxInformation8)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Tax = elem
+		case "RltdRmtInf":
+			elem := new(RemittanceLocation7)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.RelatedRemittanceInformation = append(c.RelatedRemittanceInformation, elem)
+		case "RmtInf":
+			elem := new(RemittanceInformation16)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.RemittanceInformation = elem
+		case "SplmtryData":
+			elem := new(SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.SupplementaryData = append(c.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
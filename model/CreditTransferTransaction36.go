@@ -0,0 +1,697 @@
+package model
+
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Provide further details specific to the individual transaction(s) included in the message.
+type CreditTransferTransaction36 struct {
+
+	// Set of elements used to reference a payment instruction.
+	PaymentIdentification *PaymentIdentification7 `xml:"PmtId"`
+
+	// Set of elements used to further specify the type of transaction.
+	PaymentTypeInformation *PaymentTypeInformation28 `xml:"PmtTpInf,omitempty"`
+
+	// Amount of money moved between the instructing agent and the instructed agent.
+	InterbankSettlementAmount *ActiveCurrencyAndAmount `xml:"IntrBkSttlmAmt"`
+
+	// Date on which the amount of money ceases to be available to the agent that owes it and when the amount of money becomes available to the agent to which it is due.
+	InterbankSettlementDate *ISODate `xml:"IntrBkSttlmDt,omitempty"`
+
+	// Indicator of the urgency or order of importance that the instructing party would like the instructed party to apply to the processing of the settlement instruction.
+	SettlementPriority *Priority3Code `xml:"SttlmPrty,omitempty"`
+
+	// Provides information on the occurred settlement time(s) of the payment transaction.
+	SettlementTimeIndication *SettlementDateTimeIndication1 `xml:"SttlmTmIndctn,omitempty"`
+
+	// Provides information on the requested settlement time(s) of the payment instruction.
+	SettlementTimeRequest *SettlementTimeRequest2 `xml:"SttlmTmReq,omitempty"`
+
+	// Agent immediately prior to the instructing agent.
+	PreviousInstructingAgent1 *BranchAndFinancialInstitutionIdentification6 `xml:"PrvsInstgAgt1,omitempty"`
+
+	// Unambiguous identification of the account of the previous instructing agent at its servicing agent in the payment chain.
+	PreviousInstructingAgent1Account *CashAccount38 `xml:"PrvsInstgAgt1Acct,omitempty"`
+
+	// Agent immediately prior to the previous instructing agent 1.
+	PreviousInstructingAgent2 *BranchAndFinancialInstitutionIdentification6 `xml:"PrvsInstgAgt2,omitempty"`
+
+	// Unambiguous identification of the account of the previous instructing agent at its servicing agent in the payment chain.
+	PreviousInstructingAgent2Account *CashAccount38 `xml:"PrvsInstgAgt2Acct,omitempty"`
+
+	// Agent immediately prior to the previous instructing agent 2.
+	PreviousInstructingAgent3 *BranchAndFinancialInstitutionIdentification6 `xml:"PrvsInstgAgt3,omitempty"`
+
+	// Unambiguous identification of the account of the previous instructing agent at its servicing agent in the payment chain.
+	PreviousInstructingAgent3Account *CashAccount38 `xml:"PrvsInstgAgt3Acct,omitempty"`
+
+	// Agent that instructs the next party in the chain to carry out the (set of) instruction(s).
+	InstructingAgent *BranchAndFinancialInstitutionIdentification6 `xml:"InstgAgt,omitempty"`
+
+	// Agent that is instructed by the previous party in the chain to carry out the (set of) instruction(s).
+	InstructedAgent *BranchAndFinancialInstitutionIdentification6 `xml:"InstdAgt,omitempty"`
+
+	// Agent between the debtor's agent and the creditor's agent.
+	//
+	// Usage: If more than one intermediary agent is present, then IntermediaryAgent1 identifies the agent between the DebtorAgent and the IntermediaryAgent2.
+	IntermediaryAgent1 *BranchAndFinancialInstitutionIdentification6 `xml:"IntrmyAgt1,omitempty"`
+
+	// Unambiguous identification of the account of the intermediary agent 1 at its servicing agent in the payment chain.
+	IntermediaryAgent1Account *CashAccount38 `xml:"IntrmyAgt1Acct,omitempty"`
+
+	// Agent between the debtor's agent and the creditor's agent.
+	//
+	// Usage: If more than two intermediary agents are present, then IntermediaryAgent2 identifies the agent between the IntermediaryAgent1 and the IntermediaryAgent3.
+	IntermediaryAgent2 *BranchAndFinancialInstitutionIdentification6 `xml:"IntrmyAgt2,omitempty"`
+
+	// Unambiguous identification of the account of the intermediary agent 2 at its servicing agent in the payment chain.
+	IntermediaryAgent2Account *CashAccount38 `xml:"IntrmyAgt2Acct,omitempty"`
+
+	// Agent between the debtor's agent and the creditor's agent.
+	//
+	// Usage: If IntermediaryAgent3 is present, then it identifies the agent between the IntermediaryAgent 2 and the CreditorAgent.
+	IntermediaryAgent3 *BranchAndFinancialInstitutionIdentification6 `xml:"IntrmyAgt3,omitempty"`
+
+	// Unambiguous identification of the account of the intermediary agent 3 at its servicing agent in the payment chain.
+	IntermediaryAgent3Account *CashAccount38 `xml:"IntrmyAgt3Acct,omitempty"`
+
+	// Ultimate financial institution that owes an amount of money to the (ultimate) institutional creditor.
+	UltimateDebtor *BranchAndFinancialInstitutionIdentification6 `xml:"UltmtDbtr,omitempty"`
+
+	// Financial institution that owes an amount of money to the (ultimate) financial institutional creditor.
+	Debtor *BranchAndFinancialInstitutionIdentification6 `xml:"Dbtr"`
+
+	// Unambiguous identification of the account of the debtor to which a debit entry will be made as a result of the transaction.
+	DebtorAccount *CashAccount38 `xml:"DbtrAcct,omitempty"`
+
+	// Financial institution servicing an account for the debtor.
+	DebtorAgent *BranchAndFinancialInstitutionIdentification6 `xml:"DbtrAgt,omitempty"`
+
+	// Unambiguous identification of the account of the debtor agent at its servicing agent in the payment chain.
+	DebtorAgentAccount *CashAccount38 `xml:"DbtrAgtAcct,omitempty"`
+
+	// Financial institution servicing an account for the creditor.
+	CreditorAgent *BranchAndFinancialInstitutionIdentification6 `xml:"CdtrAgt,omitempty"`
+
+	// Unambiguous identification of the account of the creditor agent at its servicing agent to which a credit entry will be made as a result of the payment transaction.
+	CreditorAgentAccount *CashAccount38 `xml:"CdtrAgtAcct,omitempty"`
+
+	// Financial institution that receives an amount of money from the financial institutional debtor.
+	Creditor *BranchAndFinancialInstitutionIdentification6 `xml:"Cdtr"`
+
+	// Unambiguous identification of the account of the creditor to which a credit entry will be posted as a result of the payment transaction.
+	CreditorAccount *CashAccount38 `xml:"CdtrAcct,omitempty"`
+
+	// Ultimate financial institution to which an amount of money is due.
+	UltimateCreditor *BranchAndFinancialInstitutionIdentification6 `xml:"UltmtCdtr,omitempty"`
+
+	// Further information related to the processing of the payment instruction, provided by the initiating party, and intended for the creditor agent.
+	InstructionForCreditorAgent []*InstructionForCreditorAgent2 `xml:"InstrForCdtrAgt,omitempty"`
+
+	// Further information related to the processing of the payment instruction that may need to be acted upon by the next agent.
+	//
+	// Usage: The next agent may not be the creditor agent.
+	// The instruction can relate to a level of service, can be an instruction that has to be executed by the agent, or can be information required by the next agent.
+	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty" xsd:"maxOccurs=6"`
+
+	// Underlying reason for the payment transaction.
+	// Usage: Purpose is used by the end-customers, that is initiating party, (ultimate) debtor, (ultimate) creditor to provide information concerning the nature of the payment. Purpose is a content element, which is not used for processing by any of the agents involved in the payment chain.
+	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
+
+	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
+	RemittanceInformation *RemittanceInformation2 `xml:"RmtInf,omitempty"`
+
+	// Provides information on the underlying customer credit transfer for which cover is provided.
+	UnderlyingCustomerCreditTransfer *CreditTransferTransaction37 `xml:"UndrlygCstmrCdtTrf,omitempty"`
+
+	// Additional information that cannot be captured in the structured elements and/or any other specific block.
+	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
+}
+
+func (c *CreditTransferTransaction36) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CreditTransferTransaction36) AddPaymentIdentification() *PaymentIdentification7 {
+	c.PaymentIdentification = new(PaymentIdentification7)
+	return c.PaymentIdentification
+}
+
+func (c *CreditTransferTransaction36) AddPaymentTypeInformation() *PaymentTypeInformation28 {
+	c.PaymentTypeInformation = new(PaymentTypeInformation28)
+	return c.PaymentTypeInformation
+}
+
+func (c *CreditTransferTransaction36) SetInterbankSettlementAmount(value, currency string) {
+	c.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
+}
+
+func (c *CreditTransferTransaction36) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *CreditTransferTransaction36) SetInterbankSettlementDate(value string) {
+	c.InterbankSettlementDate = (*ISODate)(&value)
+}
+
+func (c *CreditTransferTransaction36) SetInterbankSettlementDateFromTime(value time.Time) {
+	c.InterbankSettlementDate = new(ISODate)
+	c.InterbankSettlementDate.FromTime(value)
+}
+
+func (c *CreditTransferTransaction36) SetSettlementPriority(value string) error {
+	if err := Priority3Code(value).Validate(); err != nil {
+		return err
+	}
+	c.SettlementPriority = (*Priority3Code)(&value)
+	return nil
+}
+
+func (c *CreditTransferTransaction36) AddSettlementTimeIndication() *SettlementDateTimeIndication1 {
+	c.SettlementTimeIndication = new(SettlementDateTimeIndication1)
+	return c.SettlementTimeIndication
+}
+
+func (c *CreditTransferTransaction36) AddSettlementTimeRequest() *SettlementTimeRequest2 {
+	c.SettlementTimeRequest = new(SettlementTimeRequest2)
+	return c.SettlementTimeRequest
+}
+
+func (c *CreditTransferTransaction36) AddPreviousInstructingAgent1() *BranchAndFinancialInstitutionIdentification6 {
+	c.PreviousInstructingAgent1 = new(BranchAndFinancialInstitutionIdentification6)
+	return c.PreviousInstructingAgent1
+}
+
+func (c *CreditTransferTransaction36) AddPreviousInstructingAgent1Account() *CashAccount38 {
+	c.PreviousInstructingAgent1Account = new(CashAccount38)
+	return c.PreviousInstructingAgent1Account
+}
+
+func (c *CreditTransferTransaction36) AddPreviousInstructingAgent2() *BranchAndFinancialInstitutionIdentification6 {
+	c.PreviousInstructingAgent2 = new(BranchAndFinancialInstitutionIdentification6)
+	return c.PreviousInstructingAgent2
+}
+
+func (c *CreditTransferTransaction36) AddPreviousInstructingAgent2Account() *CashAccount38 {
+	c.PreviousInstructingAgent2Account = new(CashAccount38)
+	return c.PreviousInstructingAgent2Account
+}
+
+func (c *CreditTransferTransaction36) AddPreviousInstructingAgent3() *BranchAndFinancialInstitutionIdentification6 {
+	c.PreviousInstructingAgent3 = new(BranchAndFinancialInstitutionIdentification6)
+	return c.PreviousInstructingAgent3
+}
+
+func (c *CreditTransferTransaction36) AddPreviousInstructingAgent3Account() *CashAccount38 {
+	c.PreviousInstructingAgent3Account = new(CashAccount38)
+	return c.PreviousInstructingAgent3Account
+}
+
+func (c *CreditTransferTransaction36) AddInstructingAgent() *BranchAndFinancialInstitutionIdentification6 {
+	c.InstructingAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return c.InstructingAgent
+}
+
+func (c *CreditTransferTransaction36) AddInstructedAgent() *BranchAndFinancialInstitutionIdentification6 {
+	c.InstructedAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return c.InstructedAgent
+}
+
+func (c *CreditTransferTransaction36) AddIntermediaryAgent1() *BranchAndFinancialInstitutionIdentification6 {
+	c.IntermediaryAgent1 = new(BranchAndFinancialInstitutionIdentification6)
+	return c.IntermediaryAgent1
+}
+
+func (c *CreditTransferTransaction36) AddIntermediaryAgent1Account() *CashAccount38 {
+	c.IntermediaryAgent1Account = new(CashAccount38)
+	return c.IntermediaryAgent1Account
+}
+
+func (c *CreditTransferTransaction36) AddIntermediaryAgent2() *BranchAndFinancialInstitutionIdentification6 {
+	c.IntermediaryAgent2 = new(BranchAndFinancialInstitutionIdentification6)
+	return c.IntermediaryAgent2
+}
+
+func (c *CreditTransferTransaction36) AddIntermediaryAgent2Account() *CashAccount38 {
+	c.IntermediaryAgent2Account = new(CashAccount38)
+	return c.IntermediaryAgent2Account
+}
+
+func (c *CreditTransferTransaction36) AddIntermediaryAgent3() *BranchAndFinancialInstitutionIdentification6 {
+	c.IntermediaryAgent3 = new(BranchAndFinancialInstitutionIdentification6)
+	return c.IntermediaryAgent3
+}
+
+func (c *CreditTransferTransaction36) AddIntermediaryAgent3Account() *CashAccount38 {
+	c.IntermediaryAgent3Account = new(CashAccount38)
+	return c.IntermediaryAgent3Account
+}
+
+func (c *CreditTransferTransaction36) AddUltimateDebtor() *BranchAndFinancialInstitutionIdentification6 {
+	c.UltimateDebtor = new(BranchAndFinancialInstitutionIdentification6)
+	return c.UltimateDebtor
+}
+
+func (c *CreditTransferTransaction36) AddDebtor() *BranchAndFinancialInstitutionIdentification6 {
+	c.Debtor = new(BranchAndFinancialInstitutionIdentification6)
+	return c.Debtor
+}
+
+func (c *CreditTransferTransaction36) AddDebtorAccount() *CashAccount38 {
+	c.DebtorAccount = new(CashAccount38)
+	return c.DebtorAccount
+}
+
+func (c *CreditTransferTransaction36) AddDebtorAgent() *BranchAndFinancialInstitutionIdentification6 {
+	c.DebtorAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return c.DebtorAgent
+}
+
+func (c *CreditTransferTransaction36) AddDebtorAgentAccount() *CashAccount38 {
+	c.DebtorAgentAccount = new(CashAccount38)
+	return c.DebtorAgentAccount
+}
+
+func (c *CreditTransferTransaction36) AddCreditorAgent() *BranchAndFinancialInstitutionIdentification6 {
+	c.CreditorAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return c.CreditorAgent
+}
+
+func (c *CreditTransferTransaction36) AddCreditorAgentAccount() *CashAccount38 {
+	c.CreditorAgentAccount = new(CashAccount38)
+	return c.CreditorAgentAccount
+}
+
+func (c *CreditTransferTransaction36) AddCreditor() *BranchAndFinancialInstitutionIdentification6 {
+	c.Creditor = new(BranchAndFinancialInstitutionIdentification6)
+	return c.Creditor
+}
+
+func (c *CreditTransferTransaction36) AddCreditorAccount() *CashAccount38 {
+	c.CreditorAccount = new(CashAccount38)
+	return c.CreditorAccount
+}
+
+func (c *CreditTransferTransaction36) AddUltimateCreditor() *BranchAndFinancialInstitutionIdentification6 {
+	c.UltimateCreditor = new(BranchAndFinancialInstitutionIdentification6)
+	return c.UltimateCreditor
+}
+
+func (c *CreditTransferTransaction36) AddInstructionForCreditorAgent() *InstructionForCreditorAgent2 {
+	newValue := new(InstructionForCreditorAgent2)
+	c.InstructionForCreditorAgent = append(c.InstructionForCreditorAgent, newValue)
+	return newValue
+}
+
+func (c *CreditTransferTransaction36) AddInstructionForNextAgent() *InstructionForNextAgent1 {
+	newValue := new(InstructionForNextAgent1)
+	c.InstructionForNextAgent = append(c.InstructionForNextAgent, newValue)
+	return newValue
+}
+
+func (c *CreditTransferTransaction36) AddPurpose() *Purpose2Choice {
+	c.Purpose = new(Purpose2Choice)
+	return c.Purpose
+}
+
+func (c *CreditTransferTransaction36) AddRemittanceInformation() *RemittanceInformation2 {
+	c.RemittanceInformation = new(RemittanceInformation2)
+	return c.RemittanceInformation
+}
+
+func (c *CreditTransferTransaction36) AddUnderlyingCustomerCreditTransfer() *CreditTransferTransaction37 {
+	c.UnderlyingCustomerCreditTransfer = new(CreditTransferTransaction37)
+	return c.UnderlyingCustomerCreditTransfer
+}
+
+func (c *CreditTransferTransaction36) AddSupplementaryData() *SupplementaryData1 {
+	newValue := new(SupplementaryData1)
+	c.SupplementaryData = append(c.SupplementaryData, newValue)
+	return newValue
+}
+
+func (c *CreditTransferTransaction36) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.PaymentIdentification != nil {
+		c.PaymentIdentification.EncodeXMLFast(enc, "PmtId")
+	}
+	if c.PaymentTypeInformation != nil {
+		c.PaymentTypeInformation.EncodeXMLFast(enc, "PmtTpInf")
+	}
+	if c.InterbankSettlementAmount != nil {
+		c.InterbankSettlementAmount.EncodeXMLFast(enc, "IntrBkSttlmAmt")
+	}
+	if c.InterbankSettlementDate != nil {
+		enc.Element("IntrBkSttlmDt", string(*c.InterbankSettlementDate))
+	}
+	if c.SettlementPriority != nil {
+		enc.Element("SttlmPrty", string(*c.SettlementPriority))
+	}
+	if c.SettlementTimeIndication != nil {
+		c.SettlementTimeIndication.EncodeXMLFast(enc, "SttlmTmIndctn")
+	}
+	if c.SettlementTimeRequest != nil {
+		c.SettlementTimeRequest.EncodeXMLFast(enc, "SttlmTmReq")
+	}
+	if c.PreviousInstructingAgent1 != nil {
+		c.PreviousInstructingAgent1.EncodeXMLFast(enc, "PrvsInstgAgt1")
+	}
+	if c.PreviousInstructingAgent1Account != nil {
+		c.PreviousInstructingAgent1Account.EncodeXMLFast(enc, "PrvsInstgAgt1Acct")
+	}
+	if c.PreviousInstructingAgent2 != nil {
+		c.PreviousInstructingAgent2.EncodeXMLFast(enc, "PrvsInstgAgt2")
+	}
+	if c.PreviousInstructingAgent2Account != nil {
+		c.PreviousInstructingAgent2Account.EncodeXMLFast(enc, "PrvsInstgAgt2Acct")
+	}
+	if c.PreviousInstructingAgent3 != nil {
+		c.PreviousInstructingAgent3.EncodeXMLFast(enc, "PrvsInstgAgt3")
+	}
+	if c.PreviousInstructingAgent3Account != nil {
+		c.PreviousInstructingAgent3Account.EncodeXMLFast(enc, "PrvsInstgAgt3Acct")
+	}
+	if c.InstructingAgent != nil {
+		c.InstructingAgent.EncodeXMLFast(enc, "InstgAgt")
+	}
+	if c.InstructedAgent != nil {
+		c.InstructedAgent.EncodeXMLFast(enc, "InstdAgt")
+	}
+	if c.IntermediaryAgent1 != nil {
+		c.IntermediaryAgent1.EncodeXMLFast(enc, "IntrmyAgt1")
+	}
+	if c.IntermediaryAgent1Account != nil {
+		c.IntermediaryAgent1Account.EncodeXMLFast(enc, "IntrmyAgt1Acct")
+	}
+	if c.IntermediaryAgent2 != nil {
+		c.IntermediaryAgent2.EncodeXMLFast(enc, "IntrmyAgt2")
+	}
+	if c.IntermediaryAgent2Account != nil {
+		c.IntermediaryAgent2Account.EncodeXMLFast(enc, "IntrmyAgt2Acct")
+	}
+	if c.IntermediaryAgent3 != nil {
+		c.IntermediaryAgent3.EncodeXMLFast(enc, "IntrmyAgt3")
+	}
+	if c.IntermediaryAgent3Account != nil {
+		c.IntermediaryAgent3Account.EncodeXMLFast(enc, "IntrmyAgt3Acct")
+	}
+	if c.UltimateDebtor != nil {
+		c.UltimateDebtor.EncodeXMLFast(enc, "UltmtDbtr")
+	}
+	if c.Debtor != nil {
+		c.Debtor.EncodeXMLFast(enc, "Dbtr")
+	}
+	if c.DebtorAccount != nil {
+		c.DebtorAccount.EncodeXMLFast(enc, "DbtrAcct")
+	}
+	if c.DebtorAgent != nil {
+		c.DebtorAgent.EncodeXMLFast(enc, "DbtrAgt")
+	}
+	if c.DebtorAgentAccount != nil {
+		c.DebtorAgentAccount.EncodeXMLFast(enc, "DbtrAgtAcct")
+	}
+	if c.CreditorAgent != nil {
+		c.CreditorAgent.EncodeXMLFast(enc, "CdtrAgt")
+	}
+	if c.CreditorAgentAccount != nil {
+		c.CreditorAgentAccount.EncodeXMLFast(enc, "CdtrAgtAcct")
+	}
+	if c.Creditor != nil {
+		c.Creditor.EncodeXMLFast(enc, "Cdtr")
+	}
+	if c.CreditorAccount != nil {
+		c.CreditorAccount.EncodeXMLFast(enc, "CdtrAcct")
+	}
+	if c.UltimateCreditor != nil {
+		c.UltimateCreditor.EncodeXMLFast(enc, "UltmtCdtr")
+	}
+	for _, elem := range c.InstructionForCreditorAgent {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "InstrForCdtrAgt")
+		}
+	}
+	for _, elem := range c.InstructionForNextAgent {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "InstrForNxtAgt")
+		}
+	}
+	if c.Purpose != nil {
+		c.Purpose.EncodeXMLFast(enc, "Purp")
+	}
+	if c.RemittanceInformation != nil {
+		c.RemittanceInformation.EncodeXMLFast(enc, "RmtInf")
+	}
+	if c.UnderlyingCustomerCreditTransfer != nil {
+		c.UnderlyingCustomerCreditTransfer.EncodeXMLFast(enc, "UndrlygCstmrCdtTrf")
+	}
+	for _, elem := range c.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (c *CreditTransferTransaction36) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "PmtId":
+			elem := new(PaymentIdentification7)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PaymentIdentification = elem
+		case "PmtTpInf":
+			elem := new(PaymentTypeInformation28)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PaymentTypeInformation = elem
+		case "IntrBkSttlmAmt":
+			elem := new(ActiveCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InterbankSettlementAmount = elem
+		case "IntrBkSttlmDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.InterbankSettlementDate = (*ISODate)(&value)
+		case "SttlmPrty":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.SettlementPriority = (*Priority3Code)(&value)
+		case "SttlmTmIndctn":
+			elem := new(SettlementDateTimeIndication1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.SettlementTimeIndication = elem
+		case "SttlmTmReq":
+			elem := new(SettlementTimeRequest2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.SettlementTimeRequest = elem
+		case "PrvsInstgAgt1":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PreviousInstructingAgent1 = elem
+		case "PrvsInstgAgt1Acct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PreviousInstructingAgent1Account = elem
+		case "PrvsInstgAgt2":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PreviousInstructingAgent2 = elem
+		case "PrvsInstgAgt2Acct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PreviousInstructingAgent2Account = elem
+		case "PrvsInstgAgt3":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PreviousInstructingAgent3 = elem
+		case "PrvsInstgAgt3Acct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PreviousInstructingAgent3Account = elem
+		case "InstgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InstructingAgent = elem
+		case "InstdAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InstructedAgent = elem
+		case "IntrmyAgt1":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent1 = elem
+		case "IntrmyAgt1Acct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent1Account = elem
+		case "IntrmyAgt2":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent2 = elem
+		case "IntrmyAgt2Acct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent2Account = elem
+		case "IntrmyAgt3":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent3 = elem
+		case "IntrmyAgt3Acct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent3Account = elem
+		case "UltmtDbtr":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.UltimateDebtor = elem
+		case "Dbtr":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Debtor = elem
+		case "DbtrAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.DebtorAccount = elem
+		case "DbtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.DebtorAgent = elem
+		case "DbtrAgtAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.DebtorAgentAccount = elem
+		case "CdtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditorAgent = elem
+		case "CdtrAgtAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditorAgentAccount = elem
+		case "Cdtr":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Creditor = elem
+		case "CdtrAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditorAccount = elem
+		case "UltmtCdtr":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.UltimateCreditor = elem
+		case "InstrForCdtrAgt":
+			elem := new(InstructionForCreditorAgent2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InstructionForCreditorAgent = append(c.InstructionForCreditorAgent, elem)
+		case "InstrForNxtAgt":
+			elem := new(InstructionForNextAgent1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InstructionForNextAgent = append(c.InstructionForNextAgent, elem)
+		case "Purp":
+			elem := new(Purpose2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Purpose = elem
+		case "RmtInf":
+			elem := new(RemittanceInformation2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.RemittanceInformation = elem
+		case "UndrlygCstmrCdtTrf":
+			elem := new(CreditTransferTransaction37)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.UnderlyingCustomerCreditTransfer = elem
+		case "SplmtryData":
+			elem := new(SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.SupplementaryData = append(c.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,530 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Provides further details specific to the individual transaction(s) included in the message.
+type CreditTransferTransaction37 struct {
+
+	// Ultimate party that owes an amount of money to the (ultimate) creditor.
+	UltimateDebtor *PartyIdentification135 `xml:"UltmtDbtr,omitempty"`
+
+	// Party that initiates the payment.
+	// Usage: This can be either the debtor or a party that initiates the credit transfer on behalf of the debtor.
+	InitiatingParty *PartyIdentification135 `xml:"InitgPty,omitempty"`
+
+	// Party that owes an amount of money to the (ultimate) creditor.
+	Debtor *PartyIdentification135 `xml:"Dbtr"`
+
+	// Unambiguous identification of the account of the debtor to which a debit entry will be made as a result of the transaction.
+	DebtorAccount *CashAccount38 `xml:"DbtrAcct,omitempty"`
+
+	// Financial institution servicing an account for the debtor.
+	DebtorAgent *BranchAndFinancialInstitutionIdentification6 `xml:"DbtrAgt"`
+
+	// Unambiguous identification of the account of the debtor agent at its servicing agent in the payment chain.
+	DebtorAgentAccount *CashAccount38 `xml:"DbtrAgtAcct,omitempty"`
+
+	// Agent immediately prior to the instructing agent.
+	PreviousInstructingAgent1 *BranchAndFinancialInstitutionIdentification6 `xml:"PrvsInstgAgt1,omitempty"`
+
+	// Unambiguous identification of the account of the previous instructing agent at its servicing agent in the payment chain.
+	PreviousInstructingAgent1Account *CashAccount38 `xml:"PrvsInstgAgt1Acct,omitempty"`
+
+	// Agent immediately prior to the previous instructing agent 1.
+	PreviousInstructingAgent2 *BranchAndFinancialInstitutionIdentification6 `xml:"PrvsInstgAgt2,omitempty"`
+
+	// Unambiguous identification of the account of the previous instructing agent at its servicing agent in the payment chain.
+	PreviousInstructingAgent2Account *CashAccount38 `xml:"PrvsInstgAgt2Acct,omitempty"`
+
+	// Agent immediately prior to the previous instructing agent 2.
+	PreviousInstructingAgent3 *BranchAndFinancialInstitutionIdentification6 `xml:"PrvsInstgAgt3,omitempty"`
+
+	// Unambiguous identification of the account of the previous instructing agent at its servicing agent in the payment chain.
+	PreviousInstructingAgent3Account *CashAccount38 `xml:"PrvsInstgAgt3Acct,omitempty"`
+
+	// Agent between the debtor's agent and the creditor's agent.
+	//
+	// Usage: If more than one intermediary agent is present, then IntermediaryAgent1 identifies the agent between the DebtorAgent and the IntermediaryAgent2.
+	IntermediaryAgent1 *BranchAndFinancialInstitutionIdentification6 `xml:"IntrmyAgt1,omitempty"`
+
+	// Unambiguous identification of the account of the intermediary agent 1 at its servicing agent in the payment chain.
+	IntermediaryAgent1Account *CashAccount38 `xml:"IntrmyAgt1Acct,omitempty"`
+
+	// Agent between the debtor's agent and the creditor's agent.
+	//
+	// Usage: If more than two intermediary agents are present, then IntermediaryAgent2 identifies the agent between the IntermediaryAgent1 and the IntermediaryAgent3.
+	IntermediaryAgent2 *BranchAndFinancialInstitutionIdentification6 `xml:"IntrmyAgt2,omitempty"`
+
+	// Unambiguous identification of the account of the intermediary agent 2 at its servicing agent in the payment chain.
+	IntermediaryAgent2Account *CashAccount38 `xml:"IntrmyAgt2Acct,omitempty"`
+
+	// Agent between the debtor's agent and the creditor's agent.
+	//
+	// Usage: If IntermediaryAgent3 is present, then it identifies the agent between the IntermediaryAgent 2 and the CreditorAgent.
+	IntermediaryAgent3 *BranchAndFinancialInstitutionIdentification6 `xml:"IntrmyAgt3,omitempty"`
+
+	// Unambiguous identification of the account of the intermediary agent 3 at its servicing agent in the payment chain.
+	IntermediaryAgent3Account *CashAccount38 `xml:"IntrmyAgt3Acct,omitempty"`
+
+	// Financial institution servicing an account for the creditor.
+	CreditorAgent *BranchAndFinancialInstitutionIdentification6 `xml:"CdtrAgt"`
+
+	// Unambiguous identification of the account of the creditor agent at its servicing agent to which a credit entry will be made as a result of the payment transaction.
+	CreditorAgentAccount *CashAccount38 `xml:"CdtrAgtAcct,omitempty"`
+
+	// Party to which an amount of money is due.
+	Creditor *PartyIdentification135 `xml:"Cdtr"`
+
+	// Unambiguous identification of the account of the creditor to which a credit entry will be posted as a result of the payment transaction.
+	CreditorAccount *CashAccount38 `xml:"CdtrAcct,omitempty"`
+
+	// Ultimate party to which an amount of money is due.
+	UltimateCreditor *PartyIdentification135 `xml:"UltmtCdtr,omitempty"`
+
+	// Further information related to the processing of the payment instruction, provided by the initiating party, and intended for the creditor agent.
+	InstructionForCreditorAgent []*InstructionForCreditorAgent1 `xml:"InstrForCdtrAgt,omitempty"`
+
+	// Further information related to the processing of the payment instruction that may need to be acted upon by the next agent.
+	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty" xsd:"maxOccurs=6"`
+
+	// Provides details on the tax.
+	Tax *TaxInformation8 `xml:"Tax,omitempty"`
+
+	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
+	RemittanceInformation *RemittanceInformation16 `xml:"RmtInf,omitempty"`
+
+	// Amount of money to be moved between the debtor and creditor, before deduction of charges, expressed in the currency as ordered by the initiating party.
+	// Usage: This amount has to be transported unchanged through the transaction chain.
+	InstructedAmount *ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt,omitempty"`
+}
+
+func (c *CreditTransferTransaction37) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CreditTransferTransaction37) AddUltimateDebtor() *PartyIdentification135 {
+	c.UltimateDebtor = new(PartyIdentification135)
+	return c.UltimateDebtor
+}
+
+func (c *CreditTransferTransaction37) AddInitiatingParty() *PartyIdentification135 {
+	c.InitiatingParty = new(PartyIdentification135)
+	return c.InitiatingParty
+}
+
+func (c *CreditTransferTransaction37) AddDebtor() *PartyIdentification135 {
+	c.Debtor = new(PartyIdentification135)
+	return c.Debtor
+}
+
+func (c *CreditTransferTransaction37) AddDebtorAccount() *CashAccount38 {
+	c.DebtorAccount = new(CashAccount38)
+	return c.DebtorAccount
+}
+
+func (c *CreditTransferTransaction37) AddDebtorAgent() *BranchAndFinancialInstitutionIdentification6 {
+	c.DebtorAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return c.DebtorAgent
+}
+
+func (c *CreditTransferTransaction37) AddDebtorAgentAccount() *CashAccount38 {
+	c.DebtorAgentAccount = new(CashAccount38)
+	return c.DebtorAgentAccount
+}
+
+func (c *CreditTransferTransaction37) AddPreviousInstructingAgent1() *BranchAndFinancialInstitutionIdentification6 {
+	c.PreviousInstructingAgent1 = new(BranchAndFinancialInstitutionIdentification6)
+	return c.PreviousInstructingAgent1
+}
+
+func (c *CreditTransferTransaction37) AddPreviousInstructingAgent1Account() *CashAccount38 {
+	c.PreviousInstructingAgent1Account = new(CashAccount38)
+	return c.PreviousInstructingAgent1Account
+}
+
+func (c *CreditTransferTransaction37) AddPreviousInstructingAgent2() *BranchAndFinancialInstitutionIdentification6 {
+	c.PreviousInstructingAgent2 = new(BranchAndFinancialInstitutionIdentification6)
+	return c.PreviousInstructingAgent2
+}
+
+func (c *CreditTransferTransaction37) AddPreviousInstructingAgent2Account() *CashAccount38 {
+	c.PreviousInstructingAgent2Account = new(CashAccount38)
+	return c.PreviousInstructingAgent2Account
+}
+
+func (c *CreditTransferTransaction37) AddPreviousInstructingAgent3() *BranchAndFinancialInstitutionIdentification6 {
+	c.PreviousInstructingAgent3 = new(BranchAndFinancialInstitutionIdentification6)
+	return c.PreviousInstructingAgent3
+}
+
+func (c *CreditTransferTransaction37) AddPreviousInstructingAgent3Account() *CashAccount38 {
+	c.PreviousInstructingAgent3Account = new(CashAccount38)
+	return c.PreviousInstructingAgent3Account
+}
+
+func (c *CreditTransferTransaction37) AddIntermediaryAgent1() *BranchAndFinancialInstitutionIdentification6 {
+	c.IntermediaryAgent1 = new(BranchAndFinancialInstitutionIdentification6)
+	return c.IntermediaryAgent1
+}
+
+func (c *CreditTransferTransaction37) AddIntermediaryAgent1Account() *CashAccount38 {
+	c.IntermediaryAgent1Account = new(CashAccount38)
+	return c.IntermediaryAgent1Account
+}
+
+func (c *CreditTransferTransaction37) AddIntermediaryAgent2() *BranchAndFinancialInstitutionIdentification6 {
+	c.IntermediaryAgent2 = new(BranchAndFinancialInstitutionIdentification6)
+	return c.IntermediaryAgent2
+}
+
+func (c *CreditTransferTransaction37) AddIntermediaryAgent2Account() *CashAccount38 {
+	c.IntermediaryAgent2Account = new(CashAccount38)
+	return c.IntermediaryAgent2Account
+}
+
+func (c *CreditTransferTransaction37) AddIntermediaryAgent3() *BranchAndFinancialInstitutionIdentification6 {
+	c.IntermediaryAgent3 = new(BranchAndFinancialInstitutionIdentification6)
+	return c.IntermediaryAgent3
+}
+
+func (c *CreditTransferTransaction37) AddIntermediaryAgent3Account() *CashAccount38 {
+	c.IntermediaryAgent3Account = new(CashAccount38)
+	return c.IntermediaryAgent3Account
+}
+
+func (c *CreditTransferTransaction37) AddCreditorAgent() *BranchAndFinancialInstitutionIdentification6 {
+	c.CreditorAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return c.CreditorAgent
+}
+
+func (c *CreditTransferTransaction37) AddCreditorAgentAccount() *CashAccount38 {
+	c.CreditorAgentAccount = new(CashAccount38)
+	return c.CreditorAgentAccount
+}
+
+func (c *CreditTransferTransaction37) AddCreditor() *PartyIdentification135 {
+	c.Creditor = new(PartyIdentification135)
+	return c.Creditor
+}
+
+func (c *CreditTransferTransaction37) AddCreditorAccount() *CashAccount38 {
+	c.CreditorAccount = new(CashAccount38)
+	return c.CreditorAccount
+}
+
+func (c *CreditTransferTransaction37) AddUltimateCreditor() *PartyIdentification135 {
+	c.UltimateCreditor = new(PartyIdentification135)
+	return c.UltimateCreditor
+}
+
+func (c *CreditTransferTransaction37) AddInstructionForCreditorAgent() *InstructionForCreditorAgent1 {
+	newValue := new(InstructionForCreditorAgent1)
+	c.InstructionForCreditorAgent = append(c.InstructionForCreditorAgent, newValue)
+	return newValue
+}
+
+func (c *CreditTransferTransaction37) AddInstructionForNextAgent() *InstructionForNextAgent1 {
+	newValue := new(InstructionForNextAgent1)
+	c.InstructionForNextAgent = append(c.InstructionForNextAgent, newValue)
+	return newValue
+}
+
+func (c *CreditTransferTransaction37) AddTax() *TaxInformation8 {
+	c.Tax = new(TaxInformation8)
+	return c.Tax
+}
+
+func (c *CreditTransferTransaction37) AddRemittanceInformation() *RemittanceInformation16 {
+	c.RemittanceInformation = new(RemittanceInformation16)
+	return c.RemittanceInformation
+}
+
+func (c *CreditTransferTransaction37) SetInstructedAmount(value, currency string) {
+	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (c *CreditTransferTransaction37) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *CreditTransferTransaction37) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.UltimateDebtor != nil {
+		c.UltimateDebtor.EncodeXMLFast(enc, "UltmtDbtr")
+	}
+	if c.InitiatingParty != nil {
+		c.InitiatingParty.EncodeXMLFast(enc, "InitgPty")
+	}
+	if c.Debtor != nil {
+		c.Debtor.EncodeXMLFast(enc, "Dbtr")
+	}
+	if c.DebtorAccount != nil {
+		c.DebtorAccount.EncodeXMLFast(enc, "DbtrAcct")
+	}
+	if c.DebtorAgent != nil {
+		c.DebtorAgent.EncodeXMLFast(enc, "DbtrAgt")
+	}
+	if c.DebtorAgentAccount != nil {
+		c.DebtorAgentAccount.EncodeXMLFast(enc, "DbtrAgtAcct")
+	}
+	if c.PreviousInstructingAgent1 != nil {
+		c.PreviousInstructingAgent1.EncodeXMLFast(enc, "PrvsInstgAgt1")
+	}
+	if c.PreviousInstructingAgent1Account != nil {
+		c.PreviousInstructingAgent1Account.EncodeXMLFast(enc, "PrvsInstgAgt1Acct")
+	}
+	if c.PreviousInstructingAgent2 != nil {
+		c.PreviousInstructingAgent2.EncodeXMLFast(enc, "PrvsInstgAgt2")
+	}
+	if c.PreviousInstructingAgent2Account != nil {
+		c.PreviousInstructingAgent2Account.EncodeXMLFast(enc, "PrvsInstgAgt2Acct")
+	}
+	if c.PreviousInstructingAgent3 != nil {
+		c.PreviousInstructingAgent3.EncodeXMLFast(enc, "PrvsInstgAgt3")
+	}
+	if c.PreviousInstructingAgent3Account != nil {
+		c.PreviousInstructingAgent3Account.EncodeXMLFast(enc, "PrvsInstgAgt3Acct")
+	}
+	if c.IntermediaryAgent1 != nil {
+		c.IntermediaryAgent1.EncodeXMLFast(enc, "IntrmyAgt1")
+	}
+	if c.IntermediaryAgent1Account != nil {
+		c.IntermediaryAgent1Account.EncodeXMLFast(enc, "IntrmyAgt1Acct")
+	}
+	if c.IntermediaryAgent2 != nil {
+		c.IntermediaryAgent2.EncodeXMLFast(enc, "IntrmyAgt2")
+	}
+	if c.IntermediaryAgent2Account != nil {
+		c.IntermediaryAgent2Account.EncodeXMLFast(enc, "IntrmyAgt2Acct")
+	}
+	if c.IntermediaryAgent3 != nil {
+		c.IntermediaryAgent3.EncodeXMLFast(enc, "IntrmyAgt3")
+	}
+	if c.IntermediaryAgent3Account != nil {
+		c.IntermediaryAgent3Account.EncodeXMLFast(enc, "IntrmyAgt3Acct")
+	}
+	if c.CreditorAgent != nil {
+		c.CreditorAgent.EncodeXMLFast(enc, "CdtrAgt")
+	}
+	if c.CreditorAgentAccount != nil {
+		c.CreditorAgentAccount.EncodeXMLFast(enc, "CdtrAgtAcct")
+	}
+	if c.Creditor != nil {
+		c.Creditor.EncodeXMLFast(enc, "Cdtr")
+	}
+	if c.CreditorAccount != nil {
+		c.CreditorAccount.EncodeXMLFast(enc, "CdtrAcct")
+	}
+	if c.UltimateCreditor != nil {
+		c.UltimateCreditor.EncodeXMLFast(enc, "UltmtCdtr")
+	}
+	for _, elem := range c.InstructionForCreditorAgent {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "InstrForCdtrAgt")
+		}
+	}
+	for _, elem := range c.InstructionForNextAgent {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "InstrForNxtAgt")
+		}
+	}
+	if c.Tax != nil {
+		c.Tax.EncodeXMLFast(enc, "Tax")
+	}
+	if c.RemittanceInformation != nil {
+		c.RemittanceInformation.EncodeXMLFast(enc, "RmtInf")
+	}
+	if c.InstructedAmount != nil {
+		c.InstructedAmount.EncodeXMLFast(enc, "InstdAmt")
+	}
+	enc.End(tag)
+}
+
+func (c *CreditTransferTransaction37) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "UltmtDbtr":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.UltimateDebtor = elem
+		case "InitgPty":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InitiatingParty = elem
+		case "Dbtr":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Debtor = elem
+		case "DbtrAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.DebtorAccount = elem
+		case "DbtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.DebtorAgent = elem
+		case "DbtrAgtAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.DebtorAgentAccount = elem
+		case "PrvsInstgAgt1":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PreviousInstructingAgent1 = elem
+		case "PrvsInstgAgt1Acct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PreviousInstructingAgent1Account = elem
+		case "PrvsInstgAgt2":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PreviousInstructingAgent2 = elem
+		case "PrvsInstgAgt2Acct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PreviousInstructingAgent2Account = elem
+		case "PrvsInstgAgt3":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PreviousInstructingAgent3 = elem
+		case "PrvsInstgAgt3Acct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PreviousInstructingAgent3Account = elem
+		case "IntrmyAgt1":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent1 = elem
+		case "IntrmyAgt1Acct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent1Account = elem
+		case "IntrmyAgt2":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent2 = elem
+		case "IntrmyAgt2Acct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent2Account = elem
+		case "IntrmyAgt3":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent3 = elem
+		case "IntrmyAgt3Acct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.IntermediaryAgent3Account = elem
+		case "CdtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditorAgent = elem
+		case "CdtrAgtAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditorAgentAccount = elem
+		case "Cdtr":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Creditor = elem
+		case "CdtrAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CreditorAccount = elem
+		case "UltmtCdtr":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.UltimateCreditor = elem
+		case "InstrForCdtrAgt":
+			elem := new(InstructionForCreditorAgent1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InstructionForCreditorAgent = append(c.InstructionForCreditorAgent, elem)
+		case "InstrForNxtAgt":
+			elem := new(InstructionForNextAgent1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InstructionForNextAgent = append(c.InstructionForNextAgent, elem)
+		case "Tax":
+			elem := new(TaxInformation8)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Tax = elem
+		case "RmtInf":
+			elem := new(RemittanceInformation16)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.RemittanceInformation = elem
+		case "InstdAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.InstructedAmount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provide further details specific to the individual transaction(s) included in the message.
 type CreditTransferTransaction4 struct {
 
@@ -97,7 +103,7 @@ type CreditTransferTransaction4 struct {
 	//
 	// Usage: The next agent may not be the creditor agent.
 	// The instruction can relate to a level of service, can be an instruction that has to be executed by the agent, or can be information required by the next agent.
-	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty"`
+	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty" xsd:"maxOccurs=6"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation2 `xml:"RmtInf,omitempty"`
@@ -106,6 +112,10 @@ type CreditTransferTransaction4 struct {
 	UnderlyingCustomerCreditTransfer *CreditTransferTransaction3 `xml:"UndrlygCstmrCdtTrf,omitempty"`
 }
 
+func (c *CreditTransferTransaction4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransaction4) AddPaymentIdentification() *PaymentIdentification3 {
 	c.PaymentIdentification = new(PaymentIdentification3)
 	return c.PaymentIdentification
@@ -120,12 +130,25 @@ func (c *CreditTransferTransaction4) SetInterbankSettlementAmount(value, currenc
 	c.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CreditTransferTransaction4) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CreditTransferTransaction4) SetInterbankSettlementDate(value string) {
 	c.InterbankSettlementDate = (*ISODate)(&value)
 }
 
-func (c *CreditTransferTransaction4) SetSettlementPriority(value string) {
+func (c *CreditTransferTransaction4) SetInterbankSettlementDateFromTime(value time.Time) {
+	c.InterbankSettlementDate = new(ISODate)
+	c.InterbankSettlementDate.FromTime(value)
+}
+
+func (c *CreditTransferTransaction4) SetSettlementPriority(value string) error {
+	if err := Priority3Code(value).Validate(); err != nil {
+		return err
+	}
 	c.SettlementPriority = (*Priority3Code)(&value)
+	return nil
 }
 
 func (c *CreditTransferTransaction4) AddSettlementTimeIndication() *SettlementDateTimeIndication1 {
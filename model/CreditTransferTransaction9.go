@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details specific to the individual transaction(s) included in the message.
 type CreditTransferTransaction9 struct {
 
@@ -74,6 +80,10 @@ type CreditTransferTransaction9 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CreditTransferTransaction9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransaction9) SetCreditIdentification(value string) {
 	c.CreditIdentification = (*Max35Text)(&value)
 }
@@ -82,6 +92,11 @@ func (c *CreditTransferTransaction9) SetBatchBooking(value string) {
 	c.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (c *CreditTransferTransaction9) SetBatchBookingFromBool(value bool) {
+	c.BatchBooking = new(BatchBookingIndicator)
+	c.BatchBooking.FromBool(value)
+}
+
 func (c *CreditTransferTransaction9) AddPaymentTypeInformation() *PaymentTypeInformation21 {
 	c.PaymentTypeInformation = new(PaymentTypeInformation21)
 	return c.PaymentTypeInformation
@@ -91,10 +106,19 @@ func (c *CreditTransferTransaction9) SetTotalInterbankSettlementAmount(value, cu
 	c.TotalInterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CreditTransferTransaction9) SetTotalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.TotalInterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CreditTransferTransaction9) SetInterbankSettlementDate(value string) {
 	c.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (c *CreditTransferTransaction9) SetInterbankSettlementDateFromTime(value time.Time) {
+	c.InterbankSettlementDate = new(ISODate)
+	c.InterbankSettlementDate.FromTime(value)
+}
+
 func (c *CreditTransferTransaction9) AddInstructingAgent() *BranchAndFinancialInstitutionIdentification5 {
 	c.InstructingAgent = new(BranchAndFinancialInstitutionIdentification5)
 	return c.InstructingAgent
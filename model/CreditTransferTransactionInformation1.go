@@ -77,18 +77,22 @@ type CreditTransferTransactionInformation1 struct {
 	Purpose *Purpose1Choice `xml:"Purp,omitempty"`
 
 	// Information needed due to regulatory and statutory requirements.
-	RegulatoryReporting []*RegulatoryReporting2 `xml:"RgltryRptg,omitempty"`
+	RegulatoryReporting []*RegulatoryReporting2 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
 
 	// Amount of money due to the government or tax authority, according to various pre-defined parameters such as thresholds or income.
 	Tax *TaxInformation2 `xml:"Tax,omitempty"`
 
 	// Information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation1 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation1 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information that enables the matching, ie, reconciliation, of a payment with the items that the payment is intended to settle, eg, commercial invoices in an account receivable system.
 	RemittanceInformation *RemittanceInformation1 `xml:"RmtInf,omitempty"`
 }
 
+func (c *CreditTransferTransactionInformation1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransactionInformation1) AddPaymentIdentification() *PaymentIdentification1 {
 	c.PaymentIdentification = new(PaymentIdentification1)
 	return c.PaymentIdentification
@@ -109,8 +113,12 @@ func (c *CreditTransferTransactionInformation1) AddExchangeRateInformation() *Ex
 	return c.ExchangeRateInformation
 }
 
-func (c *CreditTransferTransactionInformation1) SetChargeBearer(value string) {
+func (c *CreditTransferTransactionInformation1) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (c *CreditTransferTransactionInformation1) AddChequeInstruction() *Cheque5 {
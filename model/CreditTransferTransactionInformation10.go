@@ -74,18 +74,22 @@ type CreditTransferTransactionInformation10 struct {
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 
 	// Information needed due to regulatory and statutory requirements.
-	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty"`
+	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
 
 	// Set of elements used to provide details on the tax.
 	Tax *TaxInformation3 `xml:"Tax,omitempty"`
 
 	// Set of elements used to provide information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation5 `xml:"RmtInf,omitempty"`
 }
 
+func (c *CreditTransferTransactionInformation10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransactionInformation10) AddPaymentIdentification() *PaymentIdentification1 {
 	c.PaymentIdentification = new(PaymentIdentification1)
 	return c.PaymentIdentification
@@ -106,8 +110,12 @@ func (c *CreditTransferTransactionInformation10) AddExchangeRateInformation() *E
 	return c.ExchangeRateInformation
 }
 
-func (c *CreditTransferTransactionInformation10) SetChargeBearer(value string) {
+func (c *CreditTransferTransactionInformation10) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (c *CreditTransferTransactionInformation10) AddChequeInstruction() *Cheque6 {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information specific to the individual transaction(s) included in the message.
 type CreditTransferTransactionInformation12 struct {
 
@@ -74,6 +78,10 @@ type CreditTransferTransactionInformation12 struct {
 	InstructedAmount *ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt,omitempty"`
 }
 
+func (c *CreditTransferTransactionInformation12) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransactionInformation12) AddUltimateDebtor() *PartyIdentification32 {
 	c.UltimateDebtor = new(PartyIdentification32)
 	return c.UltimateDebtor
@@ -177,3 +185,7 @@ func (c *CreditTransferTransactionInformation12) AddRemittanceInformation() *Rem
 func (c *CreditTransferTransactionInformation12) SetInstructedAmount(value, currency string) {
 	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (c *CreditTransferTransactionInformation12) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
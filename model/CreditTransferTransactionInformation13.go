@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information specific to the individual transaction(s) included in the message.
 type CreditTransferTransactionInformation13 struct {
 
@@ -97,7 +103,7 @@ type CreditTransferTransactionInformation13 struct {
 	//
 	// Usage: The next agent may not be the creditor agent.
 	// The instruction can relate to a level of service, can be an instruction that has to be executed by the agent, or can be information required by the next agent.
-	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty"`
+	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty" xsd:"maxOccurs=6"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation2 `xml:"RmtInf,omitempty"`
@@ -106,6 +112,10 @@ type CreditTransferTransactionInformation13 struct {
 	UnderlyingCustomerCreditTransfer *CreditTransferTransactionInformation12 `xml:"UndrlygCstmrCdtTrf,omitempty"`
 }
 
+func (c *CreditTransferTransactionInformation13) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransactionInformation13) AddPaymentIdentification() *PaymentIdentification3 {
 	c.PaymentIdentification = new(PaymentIdentification3)
 	return c.PaymentIdentification
@@ -120,12 +130,25 @@ func (c *CreditTransferTransactionInformation13) SetInterbankSettlementAmount(va
 	c.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (c *CreditTransferTransactionInformation13) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CreditTransferTransactionInformation13) SetInterbankSettlementDate(value string) {
 	c.InterbankSettlementDate = (*ISODate)(&value)
 }
 
-func (c *CreditTransferTransactionInformation13) SetSettlementPriority(value string) {
+func (c *CreditTransferTransactionInformation13) SetInterbankSettlementDateFromTime(value time.Time) {
+	c.InterbankSettlementDate = new(ISODate)
+	c.InterbankSettlementDate.FromTime(value)
+}
+
+func (c *CreditTransferTransactionInformation13) SetSettlementPriority(value string) error {
+	if err := Priority3Code(value).Validate(); err != nil {
+		return err
+	}
 	c.SettlementPriority = (*Priority3Code)(&value)
+	return nil
 }
 
 func (c *CreditTransferTransactionInformation13) AddSettlementTimeIndication() *SettlementDateTimeIndication1 {
@@ -56,18 +56,22 @@ type CreditTransferTransactionInformation14 struct {
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 
 	// Information needed due to regulatory and statutory requirements.
-	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty"`
+	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
 
 	// Set of elements used to provide details on the tax.
 	Tax *TaxInformation3 `xml:"Tax,omitempty"`
 
 	// Set of elements used to provide information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation6 `xml:"RmtInf,omitempty"`
 }
 
+func (c *CreditTransferTransactionInformation14) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransactionInformation14) AddPaymentIdentification() *PaymentIdentification1 {
 	c.PaymentIdentification = new(PaymentIdentification1)
 	return c.PaymentIdentification
@@ -83,8 +87,12 @@ func (c *CreditTransferTransactionInformation14) AddAmount() *AmountType3Choice
 	return c.Amount
 }
 
-func (c *CreditTransferTransactionInformation14) SetChargeBearer(value string) {
+func (c *CreditTransferTransactionInformation14) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (c *CreditTransferTransactionInformation14) AddChequeInstruction() *Cheque6 {
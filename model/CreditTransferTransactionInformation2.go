@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements providing information specific to the individual transaction(s) included in the message.
 type CreditTransferTransactionInformation2 struct {
 
@@ -117,7 +123,7 @@ type CreditTransferTransactionInformation2 struct {
 	//
 	// Usage: The next agent may not be the creditor agent.
 	// The instruction can relate to a level of service, can be an instruction that has to be executed by the agent, or can be information required by the next agent.
-	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty"`
+	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty" xsd:"maxOccurs=6"`
 
 	// Underlying reason for the payment transaction.
 	//
@@ -125,15 +131,19 @@ type CreditTransferTransactionInformation2 struct {
 	Purpose *Purpose1Choice `xml:"Purp,omitempty"`
 
 	// Information needed due to regulatory and statutory requirements.
-	RegulatoryReporting []*RegulatoryReporting2 `xml:"RgltryRptg,omitempty"`
+	RegulatoryReporting []*RegulatoryReporting2 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
 
 	// Information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation1 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation1 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation1 `xml:"RmtInf,omitempty"`
 }
 
+func (c *CreditTransferTransactionInformation2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransactionInformation2) AddPaymentIdentification() *PaymentIdentification2 {
 	c.PaymentIdentification = new(PaymentIdentification2)
 	return c.PaymentIdentification
@@ -148,10 +158,19 @@ func (c *CreditTransferTransactionInformation2) SetInterbankSettlementAmount(val
 	c.InterbankSettlementAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (c *CreditTransferTransactionInformation2) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterbankSettlementAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CreditTransferTransactionInformation2) SetInterbankSettlementDate(value string) {
 	c.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (c *CreditTransferTransactionInformation2) SetInterbankSettlementDateFromTime(value time.Time) {
+	c.InterbankSettlementDate = new(ISODate)
+	c.InterbankSettlementDate.FromTime(value)
+}
+
 func (c *CreditTransferTransactionInformation2) AddSettlementTimeIndication() *SettlementDateTimeIndication1 {
 	c.SettlementTimeIndication = new(SettlementDateTimeIndication1)
 	return c.SettlementTimeIndication
@@ -166,20 +185,43 @@ func (c *CreditTransferTransactionInformation2) SetAcceptanceDateTime(value stri
 	c.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CreditTransferTransactionInformation2) SetAcceptanceDateTimeFromTime(value time.Time) {
+	c.AcceptanceDateTime = new(ISODateTime)
+	c.AcceptanceDateTime.FromTime(value)
+}
+
 func (c *CreditTransferTransactionInformation2) SetPoolingAdjustmentDate(value string) {
 	c.PoolingAdjustmentDate = (*ISODate)(&value)
 }
 
+func (c *CreditTransferTransactionInformation2) SetPoolingAdjustmentDateFromTime(value time.Time) {
+	c.PoolingAdjustmentDate = new(ISODate)
+	c.PoolingAdjustmentDate.FromTime(value)
+}
+
 func (c *CreditTransferTransactionInformation2) SetInstructedAmount(value, currency string) {
 	c.InstructedAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (c *CreditTransferTransactionInformation2) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InstructedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CreditTransferTransactionInformation2) SetExchangeRate(value string) {
 	c.ExchangeRate = (*BaseOneRate)(&value)
 }
 
-func (c *CreditTransferTransactionInformation2) SetChargeBearer(value string) {
+func (c *CreditTransferTransactionInformation2) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(BaseOneRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
+func (c *CreditTransferTransactionInformation2) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (c *CreditTransferTransactionInformation2) AddChargesInformation() *ChargesInformation1 {
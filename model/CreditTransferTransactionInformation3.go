@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements providing information specific to the individual transaction(s) included in the message.
 type CreditTransferTransactionInformation3 struct {
 
@@ -96,12 +102,16 @@ type CreditTransferTransactionInformation3 struct {
 	//
 	// Usage: The next agent may not be the creditor agent.
 	// The instruction can relate to a level of service, can be an instruction that has to be executed by the agent, or can be information required by the next agent.
-	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty"`
+	InstructionForNextAgent []*InstructionForNextAgent1 `xml:"InstrForNxtAgt,omitempty" xsd:"maxOccurs=6"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle.
 	RemittanceInformation *RemittanceInformation2 `xml:"RmtInf,omitempty"`
 }
 
+func (c *CreditTransferTransactionInformation3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditTransferTransactionInformation3) AddPaymentIdentification() *PaymentIdentification2 {
 	c.PaymentIdentification = new(PaymentIdentification2)
 	return c.PaymentIdentification
@@ -116,10 +126,19 @@ func (c *CreditTransferTransactionInformation3) SetInterbankSettlementAmount(val
 	c.InterbankSettlementAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (c *CreditTransferTransactionInformation3) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.InterbankSettlementAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CreditTransferTransactionInformation3) SetInterbankSettlementDate(value string) {
 	c.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (c *CreditTransferTransactionInformation3) SetInterbankSettlementDateFromTime(value time.Time) {
+	c.InterbankSettlementDate = new(ISODate)
+	c.InterbankSettlementDate.FromTime(value)
+}
+
 func (c *CreditTransferTransactionInformation3) AddSettlementTimeIndication() *SettlementDateTimeIndication1 {
 	c.SettlementTimeIndication = new(SettlementDateTimeIndication1)
 	return c.SettlementTimeIndication
@@ -13,6 +13,10 @@ type Creditor2 struct {
 	FinalAgent *FinancialInstitutionIdentification3Choice `xml:"FnlAgt"`
 }
 
+func (c *Creditor2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *Creditor2) AddCreditor() *PartyIdentification2Choice {
 	c.Creditor = new(PartyIdentification2Choice)
 	return c.Creditor
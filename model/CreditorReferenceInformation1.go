@@ -14,6 +14,18 @@ type CreditorReferenceInformation1 struct {
 	CreditorReference *Max35Text `xml:"CdtrRef,omitempty"`
 }
 
+func (c *CreditorReferenceInformation1) Validate() error {
+	var errs ValidationErrors
+	appendErrors("", ValidateElement(c), &errs)
+	if c.CreditorReferenceType != nil {
+		appendErrors("", validateCreditorReference(c.CreditorReferenceType.Code, c.CreditorReferenceType.Issuer, c.CreditorReference, "/CdtrRef"), &errs)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
 func (c *CreditorReferenceInformation1) AddCreditorReferenceType() *CreditorReferenceType1 {
 	c.CreditorReferenceType = new(CreditorReferenceType1)
 	return c.CreditorReferenceType
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Reference information provided by the creditor to allow the identification of the underlying documents.
 type CreditorReferenceInformation2 struct {
 
@@ -14,6 +16,18 @@ type CreditorReferenceInformation2 struct {
 	Reference *Max35Text `xml:"Ref,omitempty"`
 }
 
+func (c *CreditorReferenceInformation2) Validate() error {
+	var errs ValidationErrors
+	appendErrors("", ValidateElement(c), &errs)
+	if c.Type != nil && c.Type.CodeOrProprietary != nil {
+		appendErrors("", validateCreditorReference(c.Type.CodeOrProprietary.Code, c.Type.Issuer, c.Reference, "/Ref"), &errs)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
 func (c *CreditorReferenceInformation2) AddType() *CreditorReferenceType2 {
 	c.Type = new(CreditorReferenceType2)
 	return c.Type
@@ -22,3 +36,44 @@ func (c *CreditorReferenceInformation2) AddType() *CreditorReferenceType2 {
 func (c *CreditorReferenceInformation2) SetReference(value string) {
 	c.Reference = (*Max35Text)(&value)
 }
+
+func (c *CreditorReferenceInformation2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Type != nil {
+		c.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if c.Reference != nil {
+		enc.Element("Ref", string(*c.Reference))
+	}
+	enc.End(tag)
+}
+
+func (c *CreditorReferenceInformation2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(CreditorReferenceType2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Type = elem
+		case "Ref":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Reference = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -13,8 +13,16 @@ type CreditorReferenceType1 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
-func (c *CreditorReferenceType1) SetCode(value string) {
+func (c *CreditorReferenceType1) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CreditorReferenceType1) SetCode(value string) error {
+	if err := DocumentType3Code(value).Validate(); err != nil {
+		return err
+	}
 	c.Code = (*DocumentType3Code)(&value)
+	return nil
 }
 
 func (c *CreditorReferenceType1) SetProprietary(value string) {
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the type of creditor reference.
 type CreditorReferenceType2 struct {
 
@@ -10,6 +12,10 @@ type CreditorReferenceType2 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (c *CreditorReferenceType2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CreditorReferenceType2) AddCodeOrProprietary() *CreditorReferenceType1Choice {
 	c.CodeOrProprietary = new(CreditorReferenceType1Choice)
 	return c.CodeOrProprietary
@@ -18,3 +24,44 @@ func (c *CreditorReferenceType2) AddCodeOrProprietary() *CreditorReferenceType1C
 func (c *CreditorReferenceType2) SetIssuer(value string) {
 	c.Issuer = (*Max35Text)(&value)
 }
+
+func (c *CreditorReferenceType2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.CodeOrProprietary != nil {
+		c.CodeOrProprietary.EncodeXMLFast(enc, "CdOrPrtry")
+	}
+	if c.Issuer != nil {
+		enc.Element("Issr", string(*c.Issuer))
+	}
+	enc.End(tag)
+}
+
+func (c *CreditorReferenceType2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "CdOrPrtry":
+			elem := new(CreditorReferenceType1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.CodeOrProprietary = elem
+		case "Issr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Issuer = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
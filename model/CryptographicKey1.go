@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Cryptographic Key to exchange.
 type CryptographicKey1 struct {
 
@@ -30,6 +34,10 @@ type CryptographicKey1 struct {
 	KeyValue *ContentInformationType2 `xml:"KeyVal"`
 }
 
+func (c *CryptographicKey1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CryptographicKey1) SetIdentification(value string) {
 	c.Identification = (*Max140Text)(&value)
 }
@@ -54,10 +62,20 @@ func (c *CryptographicKey1) SetActivationDate(value string) {
 	c.ActivationDate = (*ISODateTime)(&value)
 }
 
+func (c *CryptographicKey1) SetActivationDateFromTime(value time.Time) {
+	c.ActivationDate = new(ISODateTime)
+	c.ActivationDate.FromTime(value)
+}
+
 func (c *CryptographicKey1) SetDeactivationDate(value string) {
 	c.DeactivationDate = (*ISODateTime)(&value)
 }
 
+func (c *CryptographicKey1) SetDeactivationDateFromTime(value time.Time) {
+	c.DeactivationDate = new(ISODateTime)
+	c.DeactivationDate.FromTime(value)
+}
+
 func (c *CryptographicKey1) AddKeyValue() *ContentInformationType2 {
 	c.KeyValue = new(ContentInformationType2)
 	return c.KeyValue
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Cryptographic Key to exchange.
 type CryptographicKey2 struct {
 
@@ -30,6 +34,10 @@ type CryptographicKey2 struct {
 	KeyValue *ContentInformationType5 `xml:"KeyVal"`
 }
 
+func (c *CryptographicKey2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CryptographicKey2) SetIdentification(value string) {
 	c.Identification = (*Max140Text)(&value)
 }
@@ -54,10 +62,20 @@ func (c *CryptographicKey2) SetActivationDate(value string) {
 	c.ActivationDate = (*ISODateTime)(&value)
 }
 
+func (c *CryptographicKey2) SetActivationDateFromTime(value time.Time) {
+	c.ActivationDate = new(ISODateTime)
+	c.ActivationDate.FromTime(value)
+}
+
 func (c *CryptographicKey2) SetDeactivationDate(value string) {
 	c.DeactivationDate = (*ISODateTime)(&value)
 }
 
+func (c *CryptographicKey2) SetDeactivationDateFromTime(value time.Time) {
+	c.DeactivationDate = new(ISODateTime)
+	c.DeactivationDate.FromTime(value)
+}
+
 func (c *CryptographicKey2) AddKeyValue() *ContentInformationType5 {
 	c.KeyValue = new(ContentInformationType5)
 	return c.KeyValue
@@ -15,6 +15,10 @@ type CryptographicKey3 struct {
 	Version *Exact10Text `xml:"Vrsn"`
 }
 
+func (c *CryptographicKey3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CryptographicKey3) SetIdentification(value string) {
 	c.Identification = (*Max140Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Cryptographic Key to exchange.
 type CryptographicKey4 struct {
 
@@ -30,6 +34,10 @@ type CryptographicKey4 struct {
 	KeyValue *ContentInformationType7 `xml:"KeyVal"`
 }
 
+func (c *CryptographicKey4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CryptographicKey4) SetIdentification(value string) {
 	c.Identification = (*Max140Text)(&value)
 }
@@ -54,10 +62,20 @@ func (c *CryptographicKey4) SetActivationDate(value string) {
 	c.ActivationDate = (*ISODateTime)(&value)
 }
 
+func (c *CryptographicKey4) SetActivationDateFromTime(value time.Time) {
+	c.ActivationDate = new(ISODateTime)
+	c.ActivationDate.FromTime(value)
+}
+
 func (c *CryptographicKey4) SetDeactivationDate(value string) {
 	c.DeactivationDate = (*ISODateTime)(&value)
 }
 
+func (c *CryptographicKey4) SetDeactivationDateFromTime(value time.Time) {
+	c.DeactivationDate = new(ISODateTime)
+	c.DeactivationDate.FromTime(value)
+}
+
 func (c *CryptographicKey4) AddKeyValue() *ContentInformationType7 {
 	c.KeyValue = new(ContentInformationType7)
 	return c.KeyValue
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Cryptographic Key.
 type CryptographicKey5 struct {
 
@@ -30,6 +34,10 @@ type CryptographicKey5 struct {
 	KeyValue *ContentInformationType10 `xml:"KeyVal"`
 }
 
+func (c *CryptographicKey5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CryptographicKey5) SetIdentification(value string) {
 	c.Identification = (*Max140Text)(&value)
 }
@@ -54,10 +62,20 @@ func (c *CryptographicKey5) SetActivationDate(value string) {
 	c.ActivationDate = (*ISODateTime)(&value)
 }
 
+func (c *CryptographicKey5) SetActivationDateFromTime(value time.Time) {
+	c.ActivationDate = new(ISODateTime)
+	c.ActivationDate.FromTime(value)
+}
+
 func (c *CryptographicKey5) SetDeactivationDate(value string) {
 	c.DeactivationDate = (*ISODateTime)(&value)
 }
 
+func (c *CryptographicKey5) SetDeactivationDateFromTime(value time.Time) {
+	c.DeactivationDate = new(ISODateTime)
+	c.DeactivationDate.FromTime(value)
+}
+
 func (c *CryptographicKey5) AddKeyValue() *ContentInformationType10 {
 	c.KeyValue = new(ContentInformationType10)
 	return c.KeyValue
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Cryptographic Key.
 type CryptographicKey6 struct {
 
@@ -34,6 +38,10 @@ type CryptographicKey6 struct {
 	ICCRelatedData *Max5000Binary `xml:"ICCRltdData,omitempty"`
 }
 
+func (c *CryptographicKey6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CryptographicKey6) SetName(value string) {
 	c.Name = (*Max140Text)(&value)
 }
@@ -58,10 +66,20 @@ func (c *CryptographicKey6) SetActivationDate(value string) {
 	c.ActivationDate = (*ISODateTime)(&value)
 }
 
+func (c *CryptographicKey6) SetActivationDateFromTime(value time.Time) {
+	c.ActivationDate = new(ISODateTime)
+	c.ActivationDate.FromTime(value)
+}
+
 func (c *CryptographicKey6) SetDeactivationDate(value string) {
 	c.DeactivationDate = (*ISODateTime)(&value)
 }
 
+func (c *CryptographicKey6) SetDeactivationDateFromTime(value time.Time) {
+	c.DeactivationDate = new(ISODateTime)
+	c.DeactivationDate.FromTime(value)
+}
+
 func (c *CryptographicKey6) AddEncryptedKeyValue() *ContentInformationType10 {
 	c.EncryptedKeyValue = new(ContentInformationType10)
 	return c.EncryptedKeyValue
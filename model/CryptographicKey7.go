@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cryptographic Key component.
 type CryptographicKey7 struct {
 
@@ -43,6 +49,10 @@ type CryptographicKey7 struct {
 	FailureReason *FailureReason6Code `xml:"FailrRsn,omitempty"`
 }
 
+func (c *CryptographicKey7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CryptographicKey7) SetName(value string) {
 	c.Name = (*Max140Text)(&value)
 }
@@ -67,6 +77,11 @@ func (c *CryptographicKey7) SetSequenceCounter(value string) {
 	c.SequenceCounter = (*Number)(&value)
 }
 
+func (c *CryptographicKey7) SetSequenceCounterFromDecimal(value decimal.Decimal) {
+	c.SequenceCounter = new(Number)
+	c.SequenceCounter.FromDecimal(value)
+}
+
 func (c *CryptographicKey7) SetType(value string) {
 	c.Type = (*CryptographicKeyType3Code)(&value)
 }
@@ -79,10 +94,20 @@ func (c *CryptographicKey7) SetActivationDate(value string) {
 	c.ActivationDate = (*ISODateTime)(&value)
 }
 
+func (c *CryptographicKey7) SetActivationDateFromTime(value time.Time) {
+	c.ActivationDate = new(ISODateTime)
+	c.ActivationDate.FromTime(value)
+}
+
 func (c *CryptographicKey7) SetDeactivationDate(value string) {
 	c.DeactivationDate = (*ISODateTime)(&value)
 }
 
+func (c *CryptographicKey7) SetDeactivationDateFromTime(value time.Time) {
+	c.DeactivationDate = new(ISODateTime)
+	c.DeactivationDate.FromTime(value)
+}
+
 func (c *CryptographicKey7) SetKeyCheckValue(value string) {
 	c.KeyCheckValue = (*Max35Binary)(&value)
 }
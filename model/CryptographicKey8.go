@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cryptographic key.
 type CryptographicKey8 struct {
 
@@ -43,6 +49,10 @@ type CryptographicKey8 struct {
 	PublicKeyValue *PublicRSAKey1 `xml:"PblcKeyVal,omitempty"`
 }
 
+func (c *CryptographicKey8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CryptographicKey8) SetName(value string) {
 	c.Name = (*Max140Text)(&value)
 }
@@ -67,6 +77,11 @@ func (c *CryptographicKey8) SetSequenceCounter(value string) {
 	c.SequenceCounter = (*Number)(&value)
 }
 
+func (c *CryptographicKey8) SetSequenceCounterFromDecimal(value decimal.Decimal) {
+	c.SequenceCounter = new(Number)
+	c.SequenceCounter.FromDecimal(value)
+}
+
 func (c *CryptographicKey8) SetType(value string) {
 	c.Type = (*CryptographicKeyType3Code)(&value)
 }
@@ -79,10 +94,20 @@ func (c *CryptographicKey8) SetActivationDate(value string) {
 	c.ActivationDate = (*ISODateTime)(&value)
 }
 
+func (c *CryptographicKey8) SetActivationDateFromTime(value time.Time) {
+	c.ActivationDate = new(ISODateTime)
+	c.ActivationDate.FromTime(value)
+}
+
 func (c *CryptographicKey8) SetDeactivationDate(value string) {
 	c.DeactivationDate = (*ISODateTime)(&value)
 }
 
+func (c *CryptographicKey8) SetDeactivationDateFromTime(value time.Time) {
+	c.DeactivationDate = new(ISODateTime)
+	c.DeactivationDate.FromTime(value)
+}
+
 func (c *CryptographicKey8) SetKeyCheckValue(value string) {
 	c.KeyCheckValue = (*Max35Binary)(&value)
 }
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/amount"
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Number of monetary units specified in a currency, where the unit of currency is explicit and compliant with ISO 4217.  The decimal separator is a dot.
 // Note: A zero amount is considered a positive amount.
 type CurrencyAndAmount struct {
@@ -7,6 +12,33 @@ type CurrencyAndAmount struct {
 	Currency string `xml:"Ccy,attr"`
 }
 
+func (c *CurrencyAndAmount) Validate() error {
+	if err := ValidateElement(c); err != nil {
+		return err
+	}
+	return validateAmount("CurrencyAndAmount", c.Value, c.Currency, 18, 5, false, true)
+}
+
 func NewCurrencyAndAmount(value, currency string) *CurrencyAndAmount {
 	return &CurrencyAndAmount{Value: value, Currency: currency}
 }
+
+func (c *CurrencyAndAmount) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(c.Value)
+}
+
+func NewCurrencyAndAmountFromDecimal(value decimal.Decimal, currency string) *CurrencyAndAmount {
+	return NewCurrencyAndAmount(value.String(), currency)
+}
+
+func (c *CurrencyAndAmount) Amount() (amount.Amount, error) {
+	value, err := c.Decimal()
+	if err != nil {
+		return amount.Amount{}, err
+	}
+	return amount.New(value, c.Currency), nil
+}
+
+func NewCurrencyAndAmountFromAmount(value amount.Amount) *CurrencyAndAmount {
+	return NewCurrencyAndAmount(value.Text(), value.Currency)
+}
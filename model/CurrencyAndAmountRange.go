@@ -13,13 +13,21 @@ type CurrencyAndAmountRange struct {
 	Currency *CurrencyCode `xml:"Ccy"`
 }
 
+func (c *CurrencyAndAmountRange) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyAndAmountRange) AddAmount() *ImpliedCurrencyAmountRangeChoice {
 	c.Amount = new(ImpliedCurrencyAmountRangeChoice)
 	return c.Amount
 }
 
-func (c *CurrencyAndAmountRange) SetCreditDebitIndicator(value string) {
+func (c *CurrencyAndAmountRange) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CurrencyAndAmountRange) SetCurrency(value string) {
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Range of amount values.
 type CurrencyAndAmountRange2 struct {
 
@@ -13,15 +15,73 @@ type CurrencyAndAmountRange2 struct {
 	Currency *ActiveOrHistoricCurrencyCode `xml:"Ccy"`
 }
 
+func (c *CurrencyAndAmountRange2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyAndAmountRange2) AddAmount() *ImpliedCurrencyAmountRangeChoice {
 	c.Amount = new(ImpliedCurrencyAmountRangeChoice)
 	return c.Amount
 }
 
-func (c *CurrencyAndAmountRange2) SetCreditDebitIndicator(value string) {
+func (c *CurrencyAndAmountRange2) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (c *CurrencyAndAmountRange2) SetCurrency(value string) {
 	c.Currency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
+
+func (c *CurrencyAndAmountRange2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.Amount != nil {
+		c.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if c.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*c.CreditDebitIndicator))
+	}
+	if c.Currency != nil {
+		enc.Element("Ccy", string(*c.Currency))
+	}
+	enc.End(tag)
+}
+
+func (c *CurrencyAndAmountRange2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Amt":
+			elem := new(ImpliedCurrencyAmountRangeChoice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.Amount = elem
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		case "Ccy":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.Currency = (*ActiveOrHistoricCurrencyCode)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
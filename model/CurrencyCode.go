@@ -1,3 +1,9 @@
 package model
 
 type CurrencyCode string
+
+var currencyCodePattern = newPattern(`[A-Z]{3,3}`)
+
+func (c CurrencyCode) Validate() error {
+	return validatePattern("CurrencyCode", string(c), currencyCodePattern)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides the currency control group status details.
 type CurrencyControlGroupStatus1 struct {
 
@@ -26,6 +30,10 @@ type CurrencyControlGroupStatus1 struct {
 	StatusDateTime *ISODateTime `xml:"StsDtTm,omitempty"`
 }
 
+func (c *CurrencyControlGroupStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyControlGroupStatus1) AddOriginalReferences() *OriginalMessage3 {
 	c.OriginalReferences = new(OriginalMessage3)
 	return c.OriginalReferences
@@ -199,3 +207,8 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (c *CurrencyControlGroupStatus1) SetStatusDateTime(value string) {
 	c.StatusDateTime = (*ISODateTime)(&value)
 }
+
+func (c *CurrencyControlGroupStatus1) SetStatusDateTimeFromTime(value time.Time) {
+	c.StatusDateTime = new(ISODateTime)
+	c.StatusDateTime.FromTime(value)
+}
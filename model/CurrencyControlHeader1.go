@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Characteristics shared by all individual items included in the currency control message.
 type CurrencyControlHeader1 struct {
 
@@ -20,6 +24,10 @@ type CurrencyControlHeader1 struct {
 	ForwardingAgent *BranchAndFinancialInstitutionIdentification5 `xml:"FwdgAgt,omitempty"`
 }
 
+func (c *CurrencyControlHeader1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyControlHeader1) SetMessageIdentification(value string) {
 	c.MessageIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +36,11 @@ func (c *CurrencyControlHeader1) SetCreationDateTime(value string) {
 	c.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CurrencyControlHeader1) SetCreationDateTimeFromTime(value time.Time) {
+	c.CreationDateTime = new(ISODateTime)
+	c.CreationDateTime.FromTime(value)
+}
+
 func (c *CurrencyControlHeader1) SetNumberOfItems(value string) {
 	c.NumberOfItems = (*Max15NumericText)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides the details of each package of currency control records.
 type CurrencyControlPackageStatus1 struct {
 
@@ -19,6 +23,10 @@ type CurrencyControlPackageStatus1 struct {
 	RecordStatus []*CurrencyControlRecordStatus1 `xml:"RcrdSts,omitempty"`
 }
 
+func (c *CurrencyControlPackageStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyControlPackageStatus1) SetPackageIdentification(value string) {
 	c.PackageIdentification = (*Max35Text)(&value)
 }
@@ -177,6 +185,11 @@ func (c *CurrencyControlPackageStatus1) SetStatusDateTime(value string) {
 	c.StatusDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CurrencyControlPackageStatus1) SetStatusDateTimeFromTime(value time.Time) {
+	c.StatusDateTime = new(ISODateTime)
+	c.StatusDateTime.FromTime(value)
+}
+
 func (c *CurrencyControlPackageStatus1) AddRecordStatus() *CurrencyControlRecordStatus1 {
 	newValue := new(CurrencyControlRecordStatus1)
 	c.RecordStatus = append(c.RecordStatus, newValue)
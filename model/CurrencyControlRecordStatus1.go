@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides the details of each individual currency control record.
 type CurrencyControlRecordStatus1 struct {
 
@@ -19,6 +23,10 @@ type CurrencyControlRecordStatus1 struct {
 	DocumentIdentification *DocumentIdentification28 `xml:"DocId,omitempty"`
 }
 
+func (c *CurrencyControlRecordStatus1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyControlRecordStatus1) SetRecordIdentification(value string) {
 	c.RecordIdentification = (*Max35Text)(&value)
 }
@@ -177,6 +185,11 @@ func (c *CurrencyControlRecordStatus1) SetStatusDateTime(value string) {
 	c.StatusDateTime = (*ISODateTime)(&value)
 }
 
+func (c *CurrencyControlRecordStatus1) SetStatusDateTimeFromTime(value time.Time) {
+	c.StatusDateTime = new(ISODateTime)
+	c.StatusDateTime.FromTime(value)
+}
+
 func (c *CurrencyControlRecordStatus1) AddDocumentIdentification() *DocumentIdentification28 {
 	c.DocumentIdentification = new(DocumentIdentification28)
 	return c.DocumentIdentification
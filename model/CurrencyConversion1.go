@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Conversion between the currency of a card acceptor and the currency of a card issuer, provided by a dedicated service provider. The currency conversion has to be accepted by the cardholder.
 type CurrencyConversion1 struct {
 
@@ -64,6 +70,10 @@ type CurrencyConversion1 struct {
 	DeclarationDetails *Max2048Text `xml:"DclrtnDtls,omitempty"`
 }
 
+func (c *CurrencyConversion1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyConversion1) SetCurrencyConversionIdentification(value string) {
 	c.CurrencyConversionIdentification = (*Max35Text)(&value)
 }
@@ -88,6 +98,11 @@ func (c *CurrencyConversion1) SetTargetCurrencyDecimal(value string) {
 	c.TargetCurrencyDecimal = (*Number)(&value)
 }
 
+func (c *CurrencyConversion1) SetTargetCurrencyDecimalFromDecimal(value decimal.Decimal) {
+	c.TargetCurrencyDecimal = new(Number)
+	c.TargetCurrencyDecimal.FromDecimal(value)
+}
+
 func (c *CurrencyConversion1) SetTargetCurrencyName(value string) {
 	c.TargetCurrencyName = (*Max35Text)(&value)
 }
@@ -96,22 +111,46 @@ func (c *CurrencyConversion1) SetResultingAmount(value, currency string) {
 	c.ResultingAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CurrencyConversion1) SetResultingAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ResultingAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CurrencyConversion1) SetExchangeRate(value string) {
 	c.ExchangeRate = (*PercentageRate)(&value)
 }
 
+func (c *CurrencyConversion1) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(PercentageRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
 func (c *CurrencyConversion1) SetInvertedExchangeRate(value string) {
 	c.InvertedExchangeRate = (*PercentageRate)(&value)
 }
 
+func (c *CurrencyConversion1) SetInvertedExchangeRateFromDecimal(value decimal.Decimal) {
+	c.InvertedExchangeRate = new(PercentageRate)
+	c.InvertedExchangeRate.FromDecimal(value)
+}
+
 func (c *CurrencyConversion1) SetQuotationDate(value string) {
 	c.QuotationDate = (*ISODateTime)(&value)
 }
 
+func (c *CurrencyConversion1) SetQuotationDateFromTime(value time.Time) {
+	c.QuotationDate = new(ISODateTime)
+	c.QuotationDate.FromTime(value)
+}
+
 func (c *CurrencyConversion1) SetValidUntil(value string) {
 	c.ValidUntil = (*ISODateTime)(&value)
 }
 
+func (c *CurrencyConversion1) SetValidUntilFromTime(value time.Time) {
+	c.ValidUntil = new(ISODateTime)
+	c.ValidUntil.FromTime(value)
+}
+
 func (c *CurrencyConversion1) SetSourceCurrency(value string) {
 	c.SourceCurrency = (*CurrencyCode)(&value)
 }
@@ -124,6 +163,11 @@ func (c *CurrencyConversion1) SetSourceCurrencyDecimal(value string) {
 	c.SourceCurrencyDecimal = (*Number)(&value)
 }
 
+func (c *CurrencyConversion1) SetSourceCurrencyDecimalFromDecimal(value decimal.Decimal) {
+	c.SourceCurrencyDecimal = new(Number)
+	c.SourceCurrencyDecimal.FromDecimal(value)
+}
+
 func (c *CurrencyConversion1) SetSourceCurrencyName(value string) {
 	c.SourceCurrencyName = (*Max35Text)(&value)
 }
@@ -132,6 +176,10 @@ func (c *CurrencyConversion1) SetOriginalAmount(value, currency string) {
 	c.OriginalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CurrencyConversion1) SetOriginalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.OriginalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CurrencyConversion1) AddCommissionDetails() *Commission19 {
 	newValue := new(Commission19)
 	c.CommissionDetails = append(c.CommissionDetails, newValue)
@@ -13,6 +13,10 @@ type CurrencyConversion10 struct {
 	Conversion *CurrencyConversion9 `xml:"Convs,omitempty"`
 }
 
+func (c *CurrencyConversion10) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyConversion10) SetResult(value string) {
 	c.Result = (*CurrencyConversionResponse2Code)(&value)
 }
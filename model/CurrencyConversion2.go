@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Conversion between the currency of a card acceptor and the currency of a card issuer, provided by a dedicated service provider. The currency conversion has to be accepted by the cardholder.
 type CurrencyConversion2 struct {
 
@@ -43,6 +49,10 @@ type CurrencyConversion2 struct {
 	DeclarationDetails *Max2048Text `xml:"DclrtnDtls,omitempty"`
 }
 
+func (c *CurrencyConversion2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyConversion2) SetCurrencyConversionIdentification(value string) {
 	c.CurrencyConversionIdentification = (*Max35Text)(&value)
 }
@@ -56,26 +66,55 @@ func (c *CurrencyConversion2) SetResultingAmount(value, currency string) {
 	c.ResultingAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CurrencyConversion2) SetResultingAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ResultingAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CurrencyConversion2) SetExchangeRate(value string) {
 	c.ExchangeRate = (*PercentageRate)(&value)
 }
 
+func (c *CurrencyConversion2) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(PercentageRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
 func (c *CurrencyConversion2) SetExchangeRateDecimal(value string) {
 	c.ExchangeRateDecimal = (*BaseOneRate)(&value)
 }
 
+func (c *CurrencyConversion2) SetExchangeRateDecimalFromDecimal(value decimal.Decimal) {
+	c.ExchangeRateDecimal = new(BaseOneRate)
+	c.ExchangeRateDecimal.FromDecimal(value)
+}
+
 func (c *CurrencyConversion2) SetInvertedExchangeRate(value string) {
 	c.InvertedExchangeRate = (*PercentageRate)(&value)
 }
 
+func (c *CurrencyConversion2) SetInvertedExchangeRateFromDecimal(value decimal.Decimal) {
+	c.InvertedExchangeRate = new(PercentageRate)
+	c.InvertedExchangeRate.FromDecimal(value)
+}
+
 func (c *CurrencyConversion2) SetQuotationDate(value string) {
 	c.QuotationDate = (*ISODateTime)(&value)
 }
 
+func (c *CurrencyConversion2) SetQuotationDateFromTime(value time.Time) {
+	c.QuotationDate = new(ISODateTime)
+	c.QuotationDate.FromTime(value)
+}
+
 func (c *CurrencyConversion2) SetValidUntil(value string) {
 	c.ValidUntil = (*ISODateTime)(&value)
 }
 
+func (c *CurrencyConversion2) SetValidUntilFromTime(value time.Time) {
+	c.ValidUntil = new(ISODateTime)
+	c.ValidUntil.FromTime(value)
+}
+
 func (c *CurrencyConversion2) AddSourceCurrency() *CurrencyDetails1 {
 	c.SourceCurrency = new(CurrencyDetails1)
 	return c.SourceCurrency
@@ -85,6 +124,10 @@ func (c *CurrencyConversion2) SetOriginalAmount(value, currency string) {
 	c.OriginalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CurrencyConversion2) SetOriginalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.OriginalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CurrencyConversion2) AddCommissionDetails() *Commission19 {
 	newValue := new(Commission19)
 	c.CommissionDetails = append(c.CommissionDetails, newValue)
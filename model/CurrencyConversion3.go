@@ -13,6 +13,10 @@ type CurrencyConversion3 struct {
 	Conversion *CurrencyConversion2 `xml:"Convs,omitempty"`
 }
 
+func (c *CurrencyConversion3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyConversion3) SetResult(value string) {
 	c.Result = (*CurrencyConversionResponse1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Currency conversion accepted by the customer, either to convert the amount to dispense in the base currency of the ATM, or to convert the total requested amount in the currency of the customer (so called dynamic currency conversion).
 type CurrencyConversion4 struct {
 
@@ -43,6 +49,10 @@ type CurrencyConversion4 struct {
 	DeclarationDetails *Max2048Text `xml:"DclrtnDtls,omitempty"`
 }
 
+func (c *CurrencyConversion4) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyConversion4) SetCurrencyConversionIdentification(value string) {
 	c.CurrencyConversionIdentification = (*Max35Text)(&value)
 }
@@ -56,26 +66,55 @@ func (c *CurrencyConversion4) SetResultingAmount(value, currency string) {
 	c.ResultingAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CurrencyConversion4) SetResultingAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ResultingAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CurrencyConversion4) SetExchangeRate(value string) {
 	c.ExchangeRate = (*PercentageRate)(&value)
 }
 
+func (c *CurrencyConversion4) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(PercentageRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
 func (c *CurrencyConversion4) SetExchangeRateDecimal(value string) {
 	c.ExchangeRateDecimal = (*BaseOneRate)(&value)
 }
 
+func (c *CurrencyConversion4) SetExchangeRateDecimalFromDecimal(value decimal.Decimal) {
+	c.ExchangeRateDecimal = new(BaseOneRate)
+	c.ExchangeRateDecimal.FromDecimal(value)
+}
+
 func (c *CurrencyConversion4) SetInvertedExchangeRate(value string) {
 	c.InvertedExchangeRate = (*PercentageRate)(&value)
 }
 
+func (c *CurrencyConversion4) SetInvertedExchangeRateFromDecimal(value decimal.Decimal) {
+	c.InvertedExchangeRate = new(PercentageRate)
+	c.InvertedExchangeRate.FromDecimal(value)
+}
+
 func (c *CurrencyConversion4) SetQuotationDate(value string) {
 	c.QuotationDate = (*ISODateTime)(&value)
 }
 
+func (c *CurrencyConversion4) SetQuotationDateFromTime(value time.Time) {
+	c.QuotationDate = new(ISODateTime)
+	c.QuotationDate.FromTime(value)
+}
+
 func (c *CurrencyConversion4) SetValidUntil(value string) {
 	c.ValidUntil = (*ISODateTime)(&value)
 }
 
+func (c *CurrencyConversion4) SetValidUntilFromTime(value time.Time) {
+	c.ValidUntil = new(ISODateTime)
+	c.ValidUntil.FromTime(value)
+}
+
 func (c *CurrencyConversion4) AddSourceCurrency() *CurrencyDetails2 {
 	c.SourceCurrency = new(CurrencyDetails2)
 	return c.SourceCurrency
@@ -85,6 +124,10 @@ func (c *CurrencyConversion4) SetOriginalAmount(value, currency string) {
 	c.OriginalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CurrencyConversion4) SetOriginalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.OriginalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CurrencyConversion4) AddCommissionDetails() *Commission19 {
 	newValue := new(Commission19)
 	c.CommissionDetails = append(c.CommissionDetails, newValue)
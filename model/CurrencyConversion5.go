@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Exchange rate and calculated amount to be presented to the customer when the dispense currency or the deposit currency (target currency) is different to account currency (source currency).
 type CurrencyConversion5 struct {
 
@@ -22,6 +26,10 @@ type CurrencyConversion5 struct {
 	CalculatedAmount *ImpliedCurrencyAndAmount `xml:"ClctdAmt"`
 }
 
+func (c *CurrencyConversion5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyConversion5) SetSourceCurrency(value string) {
 	c.SourceCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -42,6 +50,15 @@ func (c *CurrencyConversion5) SetRate(value string) {
 	c.Rate = (*BaseOneRate)(&value)
 }
 
+func (c *CurrencyConversion5) SetRateFromDecimal(value decimal.Decimal) {
+	c.Rate = new(BaseOneRate)
+	c.Rate.FromDecimal(value)
+}
+
 func (c *CurrencyConversion5) SetCalculatedAmount(value, currency string) {
 	c.CalculatedAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (c *CurrencyConversion5) SetCalculatedAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.CalculatedAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
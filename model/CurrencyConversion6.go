@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Conversion between the currency of a card acceptor and the currency of a card issuer, provided by a dedicated service provider. The currency conversion has to be accepted by the cardholder.
 type CurrencyConversion6 struct {
 
@@ -40,6 +46,10 @@ type CurrencyConversion6 struct {
 	DeclarationDetails *ActionMessage5 `xml:"DclrtnDtls,omitempty"`
 }
 
+func (c *CurrencyConversion6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyConversion6) SetCurrencyConversionIdentification(value string) {
 	c.CurrencyConversionIdentification = (*Max35Text)(&value)
 }
@@ -53,22 +63,46 @@ func (c *CurrencyConversion6) SetResultingAmount(value, currency string) {
 	c.ResultingAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CurrencyConversion6) SetResultingAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ResultingAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CurrencyConversion6) SetExchangeRate(value string) {
 	c.ExchangeRate = (*PercentageRate)(&value)
 }
 
+func (c *CurrencyConversion6) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(PercentageRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
 func (c *CurrencyConversion6) SetInvertedExchangeRate(value string) {
 	c.InvertedExchangeRate = (*PercentageRate)(&value)
 }
 
+func (c *CurrencyConversion6) SetInvertedExchangeRateFromDecimal(value decimal.Decimal) {
+	c.InvertedExchangeRate = new(PercentageRate)
+	c.InvertedExchangeRate.FromDecimal(value)
+}
+
 func (c *CurrencyConversion6) SetQuotationDate(value string) {
 	c.QuotationDate = (*ISODateTime)(&value)
 }
 
+func (c *CurrencyConversion6) SetQuotationDateFromTime(value time.Time) {
+	c.QuotationDate = new(ISODateTime)
+	c.QuotationDate.FromTime(value)
+}
+
 func (c *CurrencyConversion6) SetValidUntil(value string) {
 	c.ValidUntil = (*ISODateTime)(&value)
 }
 
+func (c *CurrencyConversion6) SetValidUntilFromTime(value time.Time) {
+	c.ValidUntil = new(ISODateTime)
+	c.ValidUntil.FromTime(value)
+}
+
 func (c *CurrencyConversion6) AddSourceCurrency() *CurrencyDetails1 {
 	c.SourceCurrency = new(CurrencyDetails1)
 	return c.SourceCurrency
@@ -78,6 +112,10 @@ func (c *CurrencyConversion6) SetOriginalAmount(value, currency string) {
 	c.OriginalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CurrencyConversion6) SetOriginalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.OriginalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CurrencyConversion6) AddCommissionDetails() *Commission19 {
 	newValue := new(Commission19)
 	c.CommissionDetails = append(c.CommissionDetails, newValue)
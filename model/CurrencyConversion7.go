@@ -13,6 +13,10 @@ type CurrencyConversion7 struct {
 	ConversionDetails *CurrencyConversion6 `xml:"ConvsDtls,omitempty"`
 }
 
+func (c *CurrencyConversion7) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyConversion7) SetResult(value string) {
 	c.Result = (*CurrencyConversionResponse1Code)(&value)
 }
@@ -10,10 +10,19 @@ type CurrencyConversion8 struct {
 	Conversion *CurrencyConversion6 `xml:"Convs,omitempty"`
 }
 
+func (c *CurrencyConversion8) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyConversion8) SetAcceptedByCardholder(value string) {
 	c.AcceptedByCardholder = (*TrueFalseIndicator)(&value)
 }
 
+func (c *CurrencyConversion8) SetAcceptedByCardholderFromBool(value bool) {
+	c.AcceptedByCardholder = new(TrueFalseIndicator)
+	c.AcceptedByCardholder.FromBool(value)
+}
+
 func (c *CurrencyConversion8) AddConversion() *CurrencyConversion6 {
 	c.Conversion = new(CurrencyConversion6)
 	return c.Conversion
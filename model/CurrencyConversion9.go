@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Currency conversion accepted by the customer, either to convert the amount to dispense in the base currency of the ATM, or to convert the total requested amount in the currency of the customer (so called dynamic currency conversion).
 type CurrencyConversion9 struct {
 
@@ -40,6 +46,10 @@ type CurrencyConversion9 struct {
 	DeclarationDetails *ActionMessage5 `xml:"DclrtnDtls,omitempty"`
 }
 
+func (c *CurrencyConversion9) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyConversion9) SetCurrencyConversionIdentification(value string) {
 	c.CurrencyConversionIdentification = (*Max35Text)(&value)
 }
@@ -53,22 +63,46 @@ func (c *CurrencyConversion9) SetResultingAmount(value, currency string) {
 	c.ResultingAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CurrencyConversion9) SetResultingAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.ResultingAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CurrencyConversion9) SetExchangeRate(value string) {
 	c.ExchangeRate = (*PercentageRate)(&value)
 }
 
+func (c *CurrencyConversion9) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(PercentageRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
 func (c *CurrencyConversion9) SetInvertedExchangeRate(value string) {
 	c.InvertedExchangeRate = (*PercentageRate)(&value)
 }
 
+func (c *CurrencyConversion9) SetInvertedExchangeRateFromDecimal(value decimal.Decimal) {
+	c.InvertedExchangeRate = new(PercentageRate)
+	c.InvertedExchangeRate.FromDecimal(value)
+}
+
 func (c *CurrencyConversion9) SetQuotationDate(value string) {
 	c.QuotationDate = (*ISODateTime)(&value)
 }
 
+func (c *CurrencyConversion9) SetQuotationDateFromTime(value time.Time) {
+	c.QuotationDate = new(ISODateTime)
+	c.QuotationDate.FromTime(value)
+}
+
 func (c *CurrencyConversion9) SetValidUntil(value string) {
 	c.ValidUntil = (*ISODateTime)(&value)
 }
 
+func (c *CurrencyConversion9) SetValidUntilFromTime(value time.Time) {
+	c.ValidUntil = new(ISODateTime)
+	c.ValidUntil.FromTime(value)
+}
+
 func (c *CurrencyConversion9) AddSourceCurrency() *CurrencyDetails2 {
 	c.SourceCurrency = new(CurrencyDetails2)
 	return c.SourceCurrency
@@ -78,6 +112,10 @@ func (c *CurrencyConversion9) SetOriginalAmount(value, currency string) {
 	c.OriginalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CurrencyConversion9) SetOriginalAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.OriginalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CurrencyConversion9) AddCommissionDetails() *Commission19 {
 	newValue := new(Commission19)
 	c.CommissionDetails = append(c.CommissionDetails, newValue)
@@ -13,8 +13,16 @@ type CurrencyDesignation1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
-func (c *CurrencyDesignation1) SetCurrencyDesignation(value string) {
+func (c *CurrencyDesignation1) Validate() error {
+	return ValidateElement(c)
+}
+
+func (c *CurrencyDesignation1) SetCurrencyDesignation(value string) error {
+	if err := CurrencyDesignation1Code(value).Validate(); err != nil {
+		return err
+	}
 	c.CurrencyDesignation = (*CurrencyDesignation1Code)(&value)
+	return nil
 }
 
 func (c *CurrencyDesignation1) SetLocation(value string) {
@@ -1,3 +1,26 @@
 package model
 
 type CurrencyDesignation1Code string
+
+const (
+	CurrencyDesignation1CodeONSH CurrencyDesignation1Code = "ONSH"
+	CurrencyDesignation1CodeOFFS CurrencyDesignation1Code = "OFFS"
+)
+
+var currencyDesignation1CodeDescriptions = map[CurrencyDesignation1Code]string{
+	CurrencyDesignation1CodeONSH: "Onshore: onshore currency.",
+	CurrencyDesignation1CodeOFFS: "Offshore: offshore currency.",
+}
+
+func (c CurrencyDesignation1Code) IsValid() bool {
+	_, ok := currencyDesignation1CodeDescriptions[c]
+	return ok
+}
+
+func (c CurrencyDesignation1Code) Description() string {
+	return currencyDesignation1CodeDescriptions[c]
+}
+
+func (c CurrencyDesignation1Code) Validate() error {
+	return validateEnumeration("CurrencyDesignation1Code", string(c), c.IsValid(), "ONSH, OFFS")
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of a currency.
 type CurrencyDetails1 struct {
 
@@ -16,6 +20,10 @@ type CurrencyDetails1 struct {
 	Name *Max35Text `xml:"Nm,omitempty"`
 }
 
+func (c *CurrencyDetails1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyDetails1) SetAlphaCode(value string) {
 	c.AlphaCode = (*CurrencyCode)(&value)
 }
@@ -28,6 +36,11 @@ func (c *CurrencyDetails1) SetDecimal(value string) {
 	c.Decimal = (*Number)(&value)
 }
 
+func (c *CurrencyDetails1) SetDecimalFromDecimal(value decimal.Decimal) {
+	c.Decimal = new(Number)
+	c.Decimal.FromDecimal(value)
+}
+
 func (c *CurrencyDetails1) SetName(value string) {
 	c.Name = (*Max35Text)(&value)
 }
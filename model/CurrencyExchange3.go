@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information needed to process a currency exchange or conversion.
 type CurrencyExchange3 struct {
 
@@ -24,6 +30,10 @@ type CurrencyExchange3 struct {
 	QuotationDate *ISODateTime `xml:"QtnDt,omitempty"`
 }
 
+func (c *CurrencyExchange3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyExchange3) SetSourceCurrency(value string) {
 	c.SourceCurrency = (*CurrencyCode)(&value)
 }
@@ -40,6 +50,11 @@ func (c *CurrencyExchange3) SetExchangeRate(value string) {
 	c.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (c *CurrencyExchange3) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(BaseOneRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
 func (c *CurrencyExchange3) SetContractIdentification(value string) {
 	c.ContractIdentification = (*Max35Text)(&value)
 }
@@ -47,3 +62,8 @@ func (c *CurrencyExchange3) SetContractIdentification(value string) {
 func (c *CurrencyExchange3) SetQuotationDate(value string) {
 	c.QuotationDate = (*ISODateTime)(&value)
 }
+
+func (c *CurrencyExchange3) SetQuotationDateFromTime(value time.Time) {
+	c.QuotationDate = new(ISODateTime)
+	c.QuotationDate.FromTime(value)
+}
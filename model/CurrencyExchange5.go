@@ -1,5 +1,12 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of elements used to provide details of the currency exchange.
 type CurrencyExchange5 struct {
 
@@ -24,6 +31,10 @@ type CurrencyExchange5 struct {
 	QuotationDate *ISODateTime `xml:"QtnDt,omitempty"`
 }
 
+func (c *CurrencyExchange5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyExchange5) SetSourceCurrency(value string) {
 	c.SourceCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -40,6 +51,11 @@ func (c *CurrencyExchange5) SetExchangeRate(value string) {
 	c.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (c *CurrencyExchange5) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(BaseOneRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
 func (c *CurrencyExchange5) SetContractIdentification(value string) {
 	c.ContractIdentification = (*Max35Text)(&value)
 }
@@ -47,3 +63,85 @@ func (c *CurrencyExchange5) SetContractIdentification(value string) {
 func (c *CurrencyExchange5) SetQuotationDate(value string) {
 	c.QuotationDate = (*ISODateTime)(&value)
 }
+
+func (c *CurrencyExchange5) SetQuotationDateFromTime(value time.Time) {
+	c.QuotationDate = new(ISODateTime)
+	c.QuotationDate.FromTime(value)
+}
+
+func (c *CurrencyExchange5) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.SourceCurrency != nil {
+		enc.Element("SrcCcy", string(*c.SourceCurrency))
+	}
+	if c.TargetCurrency != nil {
+		enc.Element("TrgtCcy", string(*c.TargetCurrency))
+	}
+	if c.UnitCurrency != nil {
+		enc.Element("UnitCcy", string(*c.UnitCurrency))
+	}
+	if c.ExchangeRate != nil {
+		enc.Element("XchgRate", string(*c.ExchangeRate))
+	}
+	if c.ContractIdentification != nil {
+		enc.Element("CtrctId", string(*c.ContractIdentification))
+	}
+	if c.QuotationDate != nil {
+		enc.Element("QtnDt", string(*c.QuotationDate))
+	}
+	enc.End(tag)
+}
+
+func (c *CurrencyExchange5) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "SrcCcy":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.SourceCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
+		case "TrgtCcy":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.TargetCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
+		case "UnitCcy":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.UnitCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
+		case "XchgRate":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ExchangeRate = (*BaseOneRate)(&value)
+		case "CtrctId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.ContractIdentification = (*Max35Text)(&value)
+		case "QtnDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			c.QuotationDate = (*ISODateTime)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Describes the details of the currency exchange.
 type CurrencyExchange6 struct {
 
@@ -25,6 +31,10 @@ type CurrencyExchange6 struct {
 	QuotationDate *ISODateTime `xml:"QtnDt,omitempty"`
 }
 
+func (c *CurrencyExchange6) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyExchange6) SetSourceCurrency(value string) {
 	c.SourceCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -37,6 +47,11 @@ func (c *CurrencyExchange6) SetExchangeRate(value string) {
 	c.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (c *CurrencyExchange6) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	c.ExchangeRate = new(BaseOneRate)
+	c.ExchangeRate.FromDecimal(value)
+}
+
 func (c *CurrencyExchange6) SetDescription(value string) {
 	c.Description = (*Max40Text)(&value)
 }
@@ -52,3 +67,8 @@ func (c *CurrencyExchange6) SetComments(value string) {
 func (c *CurrencyExchange6) SetQuotationDate(value string) {
 	c.QuotationDate = (*ISODateTime)(&value)
 }
+
+func (c *CurrencyExchange6) SetQuotationDateFromTime(value time.Time) {
+	c.QuotationDate = new(ISODateTime)
+	c.QuotationDate.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Currency specific Factors.
 type CurrencyFactors1 struct {
 
@@ -19,6 +23,10 @@ type CurrencyFactors1 struct {
 	Rate *AgreedRate2 `xml:"Rate,omitempty"`
 }
 
+func (c *CurrencyFactors1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyFactors1) SetCurrency(value string) {
 	c.Currency = (*CurrencyCode)(&value)
 }
@@ -27,14 +35,27 @@ func (c *CurrencyFactors1) SetShortPositionLimit(value, currency string) {
 	c.ShortPositionLimit = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CurrencyFactors1) SetShortPositionLimitFromDecimal(value decimal.Decimal, currency string) {
+	c.ShortPositionLimit = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CurrencyFactors1) SetMinimumPayInAmount(value, currency string) {
 	c.MinimumPayInAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CurrencyFactors1) SetMinimumPayInAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.MinimumPayInAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CurrencyFactors1) SetVolatilityMargin(value string) {
 	c.VolatilityMargin = (*PercentageRate)(&value)
 }
 
+func (c *CurrencyFactors1) SetVolatilityMarginFromDecimal(value decimal.Decimal) {
+	c.VolatilityMargin = new(PercentageRate)
+	c.VolatilityMargin.FromDecimal(value)
+}
+
 func (c *CurrencyFactors1) AddRate() *AgreedRate2 {
 	c.Rate = new(AgreedRate2)
 	return c.Rate
@@ -13,6 +13,10 @@ type CurrencyReference2 struct {
 	ExchangeRateInformation []*ExchangeRateInformation1 `xml:"XchgRateInf,omitempty"`
 }
 
+func (c *CurrencyReference2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyReference2) SetTargetCurrency(value string) {
 	c.TargetCurrency = (*CurrencyCode)(&value)
 }
@@ -13,6 +13,10 @@ type CurrencyReference3 struct {
 	ExchangeRateInformation []*ExchangeRateInformation1 `xml:"XchgRateInf,omitempty"`
 }
 
+func (c *CurrencyReference3) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyReference3) SetTargetCurrency(value string) {
 	c.TargetCurrency = (*ActiveCurrencyCode)(&value)
 }
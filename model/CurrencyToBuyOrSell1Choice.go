@@ -10,6 +10,10 @@ type CurrencyToBuyOrSell1Choice struct {
 	CurrencyToSell *ActiveCurrencyCode `xml:"CcyToSell"`
 }
 
+func (c *CurrencyToBuyOrSell1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrencyToBuyOrSell1Choice) SetCurrencyToBuy(value string) {
 	c.CurrencyToBuy = (*ActiveCurrencyCode)(&value)
 }
@@ -10,6 +10,10 @@ type CurrentYearType1Choice struct {
 	ExtendedCurrentYearType *Extended350Code `xml:"XtndedCurYrTp"`
 }
 
+func (c *CurrentYearType1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrentYearType1Choice) SetCurrentYearType(value string) {
 	c.CurrentYearType = (*ISAType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type CurrentYearType2Choice struct {
 	ExtendedCurrentYearType *Extended350Code `xml:"XtndedCurYrTp"`
 }
 
+func (c *CurrentYearType2Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CurrentYearType2Choice) SetCurrentYearType(value string) {
 	c.CurrentYearType = (*ISAType2Code)(&value)
 }
@@ -25,6 +25,10 @@ type CustodyStatementOfHoldings1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (c *CustodyStatementOfHoldings1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CustodyStatementOfHoldings1) AddMessagePagination() *Pagination {
 	c.MessagePagination = new(Pagination)
 	return c.MessagePagination
@@ -22,6 +22,10 @@ type CustodyStatementOfHoldings2 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (c *CustodyStatementOfHoldings2) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CustodyStatementOfHoldings2) AddStatementGeneralDetails() *Statement7 {
 	c.StatementGeneralDetails = new(Statement7)
 	return c.StatementGeneralDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Account owned by a customer.
 type CustomerAccount1 struct {
 
@@ -49,6 +55,10 @@ type CustomerAccount1 struct {
 	Restriction []*Restriction1 `xml:"Rstrctn,omitempty"`
 }
 
+func (c *CustomerAccount1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CustomerAccount1) AddIdentification() *AccountIdentification4Choice {
 	c.Identification = new(AccountIdentification4Choice)
 	return c.Identification
@@ -75,10 +85,18 @@ func (c *CustomerAccount1) SetMonthlyPaymentValue(value, currency string) {
 	c.MonthlyPaymentValue = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CustomerAccount1) SetMonthlyPaymentValueFromDecimal(value decimal.Decimal, currency string) {
+	c.MonthlyPaymentValue = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CustomerAccount1) SetMonthlyReceivedValue(value, currency string) {
 	c.MonthlyReceivedValue = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CustomerAccount1) SetMonthlyReceivedValueFromDecimal(value decimal.Decimal, currency string) {
+	c.MonthlyReceivedValue = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CustomerAccount1) SetMonthlyTransactionNumber(value string) {
 	c.MonthlyTransactionNumber = (*Max5NumericText)(&value)
 }
@@ -87,6 +105,10 @@ func (c *CustomerAccount1) SetAverageBalance(value, currency string) {
 	c.AverageBalance = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CustomerAccount1) SetAverageBalanceFromDecimal(value decimal.Decimal, currency string) {
+	c.AverageBalance = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CustomerAccount1) SetAccountPurpose(value string) {
 	c.AccountPurpose = (*Max140Text)(&value)
 }
@@ -95,10 +117,18 @@ func (c *CustomerAccount1) SetFloorNotificationAmount(value, currency string) {
 	c.FloorNotificationAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CustomerAccount1) SetFloorNotificationAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FloorNotificationAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CustomerAccount1) SetCeilingNotificationAmount(value, currency string) {
 	c.CeilingNotificationAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CustomerAccount1) SetCeilingNotificationAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.CeilingNotificationAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CustomerAccount1) SetStatementCycle(value string) {
 	c.StatementCycle = (*Frequency3Code)(&value)
 }
@@ -107,6 +137,11 @@ func (c *CustomerAccount1) SetClosingDate(value string) {
 	c.ClosingDate = (*ISODate)(&value)
 }
 
+func (c *CustomerAccount1) SetClosingDateFromTime(value time.Time) {
+	c.ClosingDate = new(ISODate)
+	c.ClosingDate.FromTime(value)
+}
+
 func (c *CustomerAccount1) AddRestriction() *Restriction1 {
 	newValue := new(Restriction1)
 	c.Restriction = append(c.Restriction, newValue)
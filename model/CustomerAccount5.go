@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Account owned by a customer.
 type CustomerAccount5 struct {
 
@@ -49,6 +55,10 @@ type CustomerAccount5 struct {
 	Restriction []*Restriction1 `xml:"Rstrctn,omitempty"`
 }
 
+func (c *CustomerAccount5) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CustomerAccount5) AddIdentification() *AccountIdentification4Choice {
 	newValue := new(AccountIdentification4Choice)
 	c.Identification = append(c.Identification, newValue)
@@ -216,10 +226,18 @@ func (c *CustomerAccount5) SetMonthlyPaymentValue(value, currency string) {
 	c.MonthlyPaymentValue = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CustomerAccount5) SetMonthlyPaymentValueFromDecimal(value decimal.Decimal, currency string) {
+	c.MonthlyPaymentValue = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CustomerAccount5) SetMonthlyReceivedValue(value, currency string) {
 	c.MonthlyReceivedValue = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CustomerAccount5) SetMonthlyReceivedValueFromDecimal(value decimal.Decimal, currency string) {
+	c.MonthlyReceivedValue = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CustomerAccount5) SetMonthlyTransactionNumber(value string) {
 	c.MonthlyTransactionNumber = (*Max5NumericText)(&value)
 }
@@ -228,6 +246,10 @@ func (c *CustomerAccount5) SetAverageBalance(value, currency string) {
 	c.AverageBalance = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CustomerAccount5) SetAverageBalanceFromDecimal(value decimal.Decimal, currency string) {
+	c.AverageBalance = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CustomerAccount5) SetAccountPurpose(value string) {
 	c.AccountPurpose = (*Max140Text)(&value)
 }
@@ -236,10 +258,18 @@ func (c *CustomerAccount5) SetFloorNotificationAmount(value, currency string) {
 	c.FloorNotificationAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CustomerAccount5) SetFloorNotificationAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.FloorNotificationAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CustomerAccount5) SetCeilingNotificationAmount(value, currency string) {
 	c.CeilingNotificationAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (c *CustomerAccount5) SetCeilingNotificationAmountFromDecimal(value decimal.Decimal, currency string) {
+	c.CeilingNotificationAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (c *CustomerAccount5) AddStatementFrequencyAndFormat() *StatementFrequencyAndForm1 {
 	newValue := new(StatementFrequencyAndForm1)
 	c.StatementFrequencyAndFormat = append(c.StatementFrequencyAndFormat, newValue)
@@ -390,6 +420,11 @@ func (c *CustomerAccount5) SetClosingDate(value string) {
 	c.ClosingDate = (*ISODate)(&value)
 }
 
+func (c *CustomerAccount5) SetClosingDateFromTime(value time.Time) {
+	c.ClosingDate = new(ISODate)
+	c.ClosingDate.FromTime(value)
+}
+
 func (c *CustomerAccount5) AddRestriction() *Restriction1 {
 	newValue := new(Restriction1)
 	c.Restriction = append(c.Restriction, newValue)
@@ -49,6 +49,10 @@ type CustomerAccountModification1 struct {
 	Restriction []*RestrictionModification1 `xml:"Rstrctn,omitempty"`
 }
 
+func (c *CustomerAccountModification1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CustomerAccountModification1) AddIdentification() *AccountIdentification4Choice {
 	newValue := new(AccountIdentification4Choice)
 	c.Identification = append(c.Identification, newValue)
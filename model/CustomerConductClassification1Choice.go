@@ -10,6 +10,10 @@ type CustomerConductClassification1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (c *CustomerConductClassification1Choice) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CustomerConductClassification1Choice) SetCode(value string) {
 	c.Code = (*ConductClassification1Code)(&value)
 }
@@ -13,6 +13,10 @@ type CustomerDevice1 struct {
 	Provider *Max35Text `xml:"Prvdr,omitempty"`
 }
 
+func (c *CustomerDevice1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CustomerDevice1) SetIdentification(value string) {
 	c.Identification = (*Max35Text)(&value)
 }
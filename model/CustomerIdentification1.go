@@ -10,6 +10,10 @@ type CustomerIdentification1 struct {
 	AuthorityRequest []*AuthorityInvestigation2 `xml:"AuthrtyReq"`
 }
 
+func (c *CustomerIdentification1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CustomerIdentification1) AddParty() *PartyIdentification43 {
 	c.Party = new(PartyIdentification43)
 	return c.Party
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information that describes a netting cut off held at a central system.
 type CutOff1 struct {
 
@@ -16,6 +20,10 @@ type CutOff1 struct {
 	ValueDateOffset *DateOffsetText `xml:"ValDtOffset"`
 }
 
+func (c *CutOff1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CutOff1) SetCutOffUpdateIdentification(value string) {
 	c.CutOffUpdateIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +36,11 @@ func (c *CutOff1) SetCutOffTime(value string) {
 	c.CutOffTime = (*ISOTime)(&value)
 }
 
+func (c *CutOff1) SetCutOffTimeFromTime(value time.Time) {
+	c.CutOffTime = new(ISOTime)
+	c.CutOffTime.FromTime(value)
+}
+
 func (c *CutOff1) SetValueDateOffset(value string) {
 	c.ValueDateOffset = (*DateOffsetText)(&value)
 }
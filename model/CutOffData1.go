@@ -10,6 +10,10 @@ type CutOffData1 struct {
 	NettingCutOffDetails []*NettingCutOff1 `xml:"NetgCutOffDtls"`
 }
 
+func (c *CutOffData1) Validate() error {
+	return ValidateElement(c)
+}
+
 func (c *CutOffData1) AddParticipantIdentification() *PartyIdentification73Choice {
 	c.ParticipantIdentification = new(PartyIdentification73Choice)
 	return c.ParticipantIdentification
@@ -10,10 +10,19 @@ type DataBaseCheck1 struct {
 	Identification *Max35Text `xml:"Id"`
 }
 
+func (d *DataBaseCheck1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DataBaseCheck1) SetDatabaseCheck(value string) {
 	d.DatabaseCheck = (*YesNoIndicator)(&value)
 }
 
+func (d *DataBaseCheck1) SetDatabaseCheckFromBool(value bool) {
+	d.DatabaseCheck = new(YesNoIndicator)
+	d.DatabaseCheck.FromBool(value)
+}
+
 func (d *DataBaseCheck1) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type DataFormat1Choice struct {
 	Unstructured *Max140Text `xml:"Ustrd"`
 }
 
+func (d *DataFormat1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DataFormat1Choice) AddStructured() *GenericIdentification2 {
 	d.Structured = new(GenericIdentification2)
 	return d.Structured
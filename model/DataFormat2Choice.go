@@ -10,6 +10,10 @@ type DataFormat2Choice struct {
 	Unstructured *Max140Text `xml:"Ustrd"`
 }
 
+func (d *DataFormat2Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DataFormat2Choice) AddStructured() *GenericIdentification1 {
 	d.Structured = new(GenericIdentification1)
 	return d.Structured
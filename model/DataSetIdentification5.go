@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identification of a data set.
 type DataSetIdentification5 struct {
 
@@ -16,6 +20,10 @@ type DataSetIdentification5 struct {
 	CreationDateTime *ISODateTime `xml:"CreDtTm"`
 }
 
+func (d *DataSetIdentification5) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DataSetIdentification5) SetName(value string) {
 	d.Name = (*Max256Text)(&value)
 }
@@ -31,3 +39,8 @@ func (d *DataSetIdentification5) SetVersion(value string) {
 func (d *DataSetIdentification5) SetCreationDateTime(value string) {
 	d.CreationDateTime = (*ISODateTime)(&value)
 }
+
+func (d *DataSetIdentification5) SetCreationDateTimeFromTime(value time.Time) {
+	d.CreationDateTime = new(ISODateTime)
+	d.CreationDateTime.FromTime(value)
+}
@@ -17,6 +17,10 @@ type DataSetSubmissionReferences3 struct {
 	ForcedMatch *YesNoIndicator `xml:"ForcdMtch"`
 }
 
+func (d *DataSetSubmissionReferences3) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DataSetSubmissionReferences3) SetTransactionIdentification(value string) {
 	d.TransactionIdentification = (*Max35Text)(&value)
 }
@@ -33,3 +37,8 @@ func (d *DataSetSubmissionReferences3) SetSubmitterTransactionReference(value st
 func (d *DataSetSubmissionReferences3) SetForcedMatch(value string) {
 	d.ForcedMatch = (*YesNoIndicator)(&value)
 }
+
+func (d *DataSetSubmissionReferences3) SetForcedMatchFromBool(value bool) {
+	d.ForcedMatch = new(YesNoIndicator)
+	d.ForcedMatch.FromBool(value)
+}
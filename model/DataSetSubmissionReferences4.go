@@ -23,6 +23,10 @@ type DataSetSubmissionReferences4 struct {
 	TransactionStatus *BaselineStatus3Code `xml:"TxSts"`
 }
 
+func (d *DataSetSubmissionReferences4) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DataSetSubmissionReferences4) SetTransactionIdentification(value string) {
 	d.TransactionIdentification = (*Max35Text)(&value)
 }
@@ -182,6 +186,11 @@ func (d *DataSetSubmissionReferences4) SetForcedMatch(value string) {
 	d.ForcedMatch = (*YesNoIndicator)(&value)
 }
 
+func (d *DataSetSubmissionReferences4) SetForcedMatchFromBool(value bool) {
+	d.ForcedMatch = new(YesNoIndicator)
+	d.ForcedMatch.FromBool(value)
+}
+
 func (d *DataSetSubmissionReferences4) AddEstablishedBaselineIdentification() *DocumentIdentification3 {
 	d.EstablishedBaselineIdentification = new(DocumentIdentification3)
 	return d.EstablishedBaselineIdentification
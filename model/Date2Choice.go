@@ -10,6 +10,10 @@ type Date2Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (d *Date2Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *Date2Choice) SetCode(value string) {
 	d.Code = (*DateType2Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Date and amount.
 type DateAndAmount1 struct {
 
@@ -10,10 +16,23 @@ type DateAndAmount1 struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (d *DateAndAmount1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateAndAmount1) SetDate(value string) {
 	d.Date = (*ISODate)(&value)
 }
 
+func (d *DateAndAmount1) SetDateFromTime(value time.Time) {
+	d.Date = new(ISODate)
+	d.Date.FromTime(value)
+}
+
 func (d *DateAndAmount1) SetAmount(value, currency string) {
 	d.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (d *DateAndAmount1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
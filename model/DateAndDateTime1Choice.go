@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice between a date or a date and time format.
 type DateAndDateTime1Choice struct {
 
@@ -10,10 +14,24 @@ type DateAndDateTime1Choice struct {
 	DateTime *ISODateTime `xml:"DtTm"`
 }
 
+func (d *DateAndDateTime1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateAndDateTime1Choice) SetDate(value string) {
 	d.Date = (*ISODate)(&value)
 }
 
+func (d *DateAndDateTime1Choice) SetDateFromTime(value time.Time) {
+	d.Date = new(ISODate)
+	d.Date.FromTime(value)
+}
+
 func (d *DateAndDateTime1Choice) SetDateTime(value string) {
 	d.DateTime = (*ISODateTime)(&value)
 }
+
+func (d *DateAndDateTime1Choice) SetDateTimeFromTime(value time.Time) {
+	d.DateTime = new(ISODateTime)
+	d.DateTime.FromTime(value)
+}
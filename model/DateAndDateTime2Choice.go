@@ -0,0 +1,80 @@
+package model
+
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Choice between a date or a date and time format.
+type DateAndDateTime2Choice struct {
+
+	// Specified date.
+	Date *ISODate `xml:"Dt"`
+
+	// Specified date and time.
+	DateTime *ISODateTime `xml:"DtTm"`
+}
+
+func (d *DateAndDateTime2Choice) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DateAndDateTime2Choice) SetDate(value string) {
+	d.Date = (*ISODate)(&value)
+}
+
+func (d *DateAndDateTime2Choice) SetDateFromTime(value time.Time) {
+	d.Date = new(ISODate)
+	d.Date.FromTime(value)
+}
+
+func (d *DateAndDateTime2Choice) SetDateTime(value string) {
+	d.DateTime = (*ISODateTime)(&value)
+}
+
+func (d *DateAndDateTime2Choice) SetDateTimeFromTime(value time.Time) {
+	d.DateTime = new(ISODateTime)
+	d.DateTime.FromTime(value)
+}
+
+func (d *DateAndDateTime2Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.Date != nil {
+		enc.Element("Dt", string(*d.Date))
+	}
+	if d.DateTime != nil {
+		enc.Element("DtTm", string(*d.DateTime))
+	}
+	enc.End(tag)
+}
+
+func (d *DateAndDateTime2Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Dt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.Date = (*ISODate)(&value)
+		case "DtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.DateTime = (*ISODateTime)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
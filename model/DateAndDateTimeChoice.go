@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Choice between a date or a date and time format.
 type DateAndDateTimeChoice struct {
 
@@ -10,10 +16,65 @@ type DateAndDateTimeChoice struct {
 	DateTime *ISODateTime `xml:"DtTm"`
 }
 
+func (d *DateAndDateTimeChoice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateAndDateTimeChoice) SetDate(value string) {
 	d.Date = (*ISODate)(&value)
 }
 
+func (d *DateAndDateTimeChoice) SetDateFromTime(value time.Time) {
+	d.Date = new(ISODate)
+	d.Date.FromTime(value)
+}
+
 func (d *DateAndDateTimeChoice) SetDateTime(value string) {
 	d.DateTime = (*ISODateTime)(&value)
 }
+
+func (d *DateAndDateTimeChoice) SetDateTimeFromTime(value time.Time) {
+	d.DateTime = new(ISODateTime)
+	d.DateTime.FromTime(value)
+}
+
+func (d *DateAndDateTimeChoice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.Date != nil {
+		enc.Element("Dt", string(*d.Date))
+	}
+	if d.DateTime != nil {
+		enc.Element("DtTm", string(*d.DateTime))
+	}
+	enc.End(tag)
+}
+
+func (d *DateAndDateTimeChoice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Dt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.Date = (*ISODate)(&value)
+		case "DtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.DateTime = (*ISODateTime)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
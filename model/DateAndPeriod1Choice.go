@@ -10,6 +10,10 @@ type DateAndPeriod1Choice struct {
 	StatementPeriod *Period2Choice `xml:"StmtPrd"`
 }
 
+func (d *DateAndPeriod1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateAndPeriod1Choice) AddStatementDate() *DateAndDateTimeChoice {
 	d.StatementDate = new(DateAndDateTimeChoice)
 	return d.StatementDate
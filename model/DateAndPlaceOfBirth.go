@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Date and place of birth of a person.
 type DateAndPlaceOfBirth struct {
 
@@ -16,10 +22,19 @@ type DateAndPlaceOfBirth struct {
 	CountryOfBirth *CountryCode `xml:"CtryOfBirth"`
 }
 
+func (d *DateAndPlaceOfBirth) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateAndPlaceOfBirth) SetBirthDate(value string) {
 	d.BirthDate = (*ISODate)(&value)
 }
 
+func (d *DateAndPlaceOfBirth) SetBirthDateFromTime(value time.Time) {
+	d.BirthDate = new(ISODate)
+	d.BirthDate.FromTime(value)
+}
+
 func (d *DateAndPlaceOfBirth) SetProvinceOfBirth(value string) {
 	d.ProvinceOfBirth = (*Max35Text)(&value)
 }
@@ -31,3 +46,62 @@ func (d *DateAndPlaceOfBirth) SetCityOfBirth(value string) {
 func (d *DateAndPlaceOfBirth) SetCountryOfBirth(value string) {
 	d.CountryOfBirth = (*CountryCode)(&value)
 }
+
+func (d *DateAndPlaceOfBirth) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.BirthDate != nil {
+		enc.Element("BirthDt", string(*d.BirthDate))
+	}
+	if d.ProvinceOfBirth != nil {
+		enc.Element("PrvcOfBirth", string(*d.ProvinceOfBirth))
+	}
+	if d.CityOfBirth != nil {
+		enc.Element("CityOfBirth", string(*d.CityOfBirth))
+	}
+	if d.CountryOfBirth != nil {
+		enc.Element("CtryOfBirth", string(*d.CountryOfBirth))
+	}
+	enc.End(tag)
+}
+
+func (d *DateAndPlaceOfBirth) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "BirthDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.BirthDate = (*ISODate)(&value)
+		case "PrvcOfBirth":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.ProvinceOfBirth = (*Max35Text)(&value)
+		case "CityOfBirth":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.CityOfBirth = (*Max35Text)(&value)
+		case "CtryOfBirth":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.CountryOfBirth = (*CountryCode)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Date and place of birth of a person.
+type DateAndPlaceOfBirth1 struct {
+
+	// Date on which a person is born.
+	BirthDate *ISODate `xml:"BirthDt"`
+
+	// Province where a person was born.
+	ProvinceOfBirth *Max35Text `xml:"PrvcOfBirth,omitempty"`
+
+	// City where a person was born.
+	CityOfBirth *Max35Text `xml:"CityOfBirth"`
+
+	// Country where a person was born.
+	CountryOfBirth *CountryCode `xml:"CtryOfBirth"`
+}
+
+func (d *DateAndPlaceOfBirth1) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DateAndPlaceOfBirth1) SetBirthDate(value string) {
+	d.BirthDate = (*ISODate)(&value)
+}
+
+func (d *DateAndPlaceOfBirth1) SetBirthDateFromTime(value time.Time) {
+	d.BirthDate = new(ISODate)
+	d.BirthDate.FromTime(value)
+}
+
+func (d *DateAndPlaceOfBirth1) SetProvinceOfBirth(value string) {
+	d.ProvinceOfBirth = (*Max35Text)(&value)
+}
+
+func (d *DateAndPlaceOfBirth1) SetCityOfBirth(value string) {
+	d.CityOfBirth = (*Max35Text)(&value)
+}
+
+func (d *DateAndPlaceOfBirth1) SetCountryOfBirth(value string) {
+	d.CountryOfBirth = (*CountryCode)(&value)
+}
+
+func (d *DateAndPlaceOfBirth1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.BirthDate != nil {
+		enc.Element("BirthDt", string(*d.BirthDate))
+	}
+	if d.ProvinceOfBirth != nil {
+		enc.Element("PrvcOfBirth", string(*d.ProvinceOfBirth))
+	}
+	if d.CityOfBirth != nil {
+		enc.Element("CityOfBirth", string(*d.CityOfBirth))
+	}
+	if d.CountryOfBirth != nil {
+		enc.Element("CtryOfBirth", string(*d.CountryOfBirth))
+	}
+	enc.End(tag)
+}
+
+func (d *DateAndPlaceOfBirth1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "BirthDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.BirthDate = (*ISODate)(&value)
+		case "PrvcOfBirth":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.ProvinceOfBirth = (*Max35Text)(&value)
+		case "CityOfBirth":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.CityOfBirth = (*Max35Text)(&value)
+		case "CtryOfBirth":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.CountryOfBirth = (*CountryCode)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
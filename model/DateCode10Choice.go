@@ -10,6 +10,10 @@ type DateCode10Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DateCode10Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCode10Choice) SetCode(value string) {
 	d.Code = (*DateType8Code)(&value)
 }
@@ -10,6 +10,10 @@ type DateCode11Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DateCode11Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCode11Choice) SetCode(value string) {
 	d.Code = (*DateType8Code)(&value)
 }
@@ -10,6 +10,10 @@ type DateCode18Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (d *DateCode18Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCode18Choice) SetCode(value string) {
 	d.Code = (*DateType5Code)(&value)
 }
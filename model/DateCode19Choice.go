@@ -10,6 +10,10 @@ type DateCode19Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (d *DateCode19Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCode19Choice) SetCode(value string) {
 	d.Code = (*DateType8Code)(&value)
 }
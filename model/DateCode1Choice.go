@@ -10,6 +10,10 @@ type DateCode1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DateCode1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCode1Choice) SetCode(value string) {
 	d.Code = (*DateType5Code)(&value)
 }
@@ -10,6 +10,10 @@ type DateCode20Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (d *DateCode20Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCode20Choice) SetCode(value string) {
 	d.Code = (*DateType1Code)(&value)
 }
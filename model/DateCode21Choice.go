@@ -10,6 +10,10 @@ type DateCode21Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (d *DateCode21Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCode21Choice) SetCode(value string) {
 	d.Code = (*DateType7Code)(&value)
 }
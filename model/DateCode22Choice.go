@@ -10,6 +10,10 @@ type DateCode22Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (d *DateCode22Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCode22Choice) SetCode(value string) {
 	d.Code = (*DateType8Code)(&value)
 }
@@ -10,6 +10,10 @@ type DateCode26Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (d *DateCode26Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCode26Choice) SetCode(value string) {
 	d.Code = (*DateType7Code)(&value)
 }
@@ -10,6 +10,10 @@ type DateCode27Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (d *DateCode27Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCode27Choice) SetCode(value string) {
 	d.Code = (*DateType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type DateCode2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DateCode2Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCode2Choice) SetCode(value string) {
 	d.Code = (*DateType6Code)(&value)
 }
@@ -10,6 +10,10 @@ type DateCode32Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (d *DateCode32Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCode32Choice) SetCode(value string) {
 	d.Code = (*DateType5Code)(&value)
 }
@@ -10,6 +10,10 @@ type DateCode3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DateCode3Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCode3Choice) SetCode(value string) {
 	d.Code = (*DateType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type DateCode4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DateCode4Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCode4Choice) SetCode(value string) {
 	d.Code = (*DateType7Code)(&value)
 }
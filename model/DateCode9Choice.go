@@ -10,6 +10,10 @@ type DateCode9Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (d *DateCode9Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCode9Choice) SetCode(value string) {
 	d.Code = (*DateType2Code)(&value)
 }
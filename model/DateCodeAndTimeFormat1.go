@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies  a date code and a time.
 type DateCodeAndTimeFormat1 struct {
 
@@ -10,6 +14,10 @@ type DateCodeAndTimeFormat1 struct {
 	Time *ISOTime `xml:"Tm"`
 }
 
+func (d *DateCodeAndTimeFormat1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCodeAndTimeFormat1) AddDateCode() *DateCode4Choice {
 	d.DateCode = new(DateCode4Choice)
 	return d.DateCode
@@ -18,3 +26,8 @@ func (d *DateCodeAndTimeFormat1) AddDateCode() *DateCode4Choice {
 func (d *DateCodeAndTimeFormat1) SetTime(value string) {
 	d.Time = (*ISOTime)(&value)
 }
+
+func (d *DateCodeAndTimeFormat1) SetTimeFromTime(value time.Time) {
+	d.Time = new(ISOTime)
+	d.Time.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies  a date code and a time.
 type DateCodeAndTimeFormat3 struct {
 
@@ -10,6 +14,10 @@ type DateCodeAndTimeFormat3 struct {
 	Time *ISOTime `xml:"Tm"`
 }
 
+func (d *DateCodeAndTimeFormat3) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCodeAndTimeFormat3) AddDateCode() *DateCode21Choice {
 	d.DateCode = new(DateCode21Choice)
 	return d.DateCode
@@ -18,3 +26,8 @@ func (d *DateCodeAndTimeFormat3) AddDateCode() *DateCode21Choice {
 func (d *DateCodeAndTimeFormat3) SetTime(value string) {
 	d.Time = (*ISOTime)(&value)
 }
+
+func (d *DateCodeAndTimeFormat3) SetTimeFromTime(value time.Time) {
+	d.Time = new(ISOTime)
+	d.Time.FromTime(value)
+}
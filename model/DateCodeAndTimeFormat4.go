@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies  a date code and a time.
 type DateCodeAndTimeFormat4 struct {
 
@@ -10,6 +14,10 @@ type DateCodeAndTimeFormat4 struct {
 	Time *ISOTime `xml:"Tm"`
 }
 
+func (d *DateCodeAndTimeFormat4) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateCodeAndTimeFormat4) AddDateCode() *DateCode26Choice {
 	d.DateCode = new(DateCode26Choice)
 	return d.DateCode
@@ -18,3 +26,8 @@ func (d *DateCodeAndTimeFormat4) AddDateCode() *DateCode26Choice {
 func (d *DateCodeAndTimeFormat4) SetTime(value string) {
 	d.Time = (*ISOTime)(&value)
 }
+
+func (d *DateCodeAndTimeFormat4) SetTimeFromTime(value time.Time) {
+	d.Time = new(ISOTime)
+	d.Time.FromTime(value)
+}
@@ -10,6 +10,10 @@ type DateFormat1 struct {
 	DateMode *DateMode1Code `xml:"DtMd,omitempty"`
 }
 
+func (d *DateFormat1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat1) AddDate() *DateFormat3Choice {
 	d.Date = new(DateFormat3Choice)
 	return d.Date
@@ -10,6 +10,10 @@ type DateFormat11Choice struct {
 	DateCode *DateCode3Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat11Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat11Choice) AddDate() *DateAndDateTimeChoice {
 	d.Date = new(DateAndDateTimeChoice)
 	return d.Date
@@ -10,6 +10,10 @@ type DateFormat12Choice struct {
 	NotSpecifiedDate *DateType6Code `xml:"NotSpcfdDt"`
 }
 
+func (d *DateFormat12Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat12Choice) AddDate() *DateAndDateTimeChoice {
 	d.Date = new(DateAndDateTimeChoice)
 	return d.Date
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice between an ISODate format or a date code.
 type DateFormat14Choice struct {
 
@@ -10,10 +14,19 @@ type DateFormat14Choice struct {
 	DateCode *DateCode9Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat14Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat14Choice) SetDate(value string) {
 	d.Date = (*ISODate)(&value)
 }
 
+func (d *DateFormat14Choice) SetDateFromTime(value time.Time) {
+	d.Date = new(ISODate)
+	d.Date.FromTime(value)
+}
+
 func (d *DateFormat14Choice) AddDateCode() *DateCode9Choice {
 	d.DateCode = new(DateCode9Choice)
 	return d.DateCode
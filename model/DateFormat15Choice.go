@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides the date.
 type DateFormat15Choice struct {
 
@@ -10,10 +14,19 @@ type DateFormat15Choice struct {
 	DateCode *DateCode3Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat15Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat15Choice) SetDate(value string) {
 	d.Date = (*ISODate)(&value)
 }
 
+func (d *DateFormat15Choice) SetDateFromTime(value time.Time) {
+	d.Date = new(ISODate)
+	d.Date.FromTime(value)
+}
+
 func (d *DateFormat15Choice) AddDateCode() *DateCode3Choice {
 	d.DateCode = new(DateCode3Choice)
 	return d.DateCode
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice between an ISODate format or a date code.
 type DateFormat16Choice struct {
 
@@ -10,10 +14,19 @@ type DateFormat16Choice struct {
 	DateCode *DateCode10Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat16Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat16Choice) SetDate(value string) {
 	d.Date = (*ISODate)(&value)
 }
 
+func (d *DateFormat16Choice) SetDateFromTime(value time.Time) {
+	d.Date = new(ISODate)
+	d.Date.FromTime(value)
+}
+
 func (d *DateFormat16Choice) AddDateCode() *DateCode10Choice {
 	d.DateCode = new(DateCode10Choice)
 	return d.DateCode
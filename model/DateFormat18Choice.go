@@ -10,6 +10,10 @@ type DateFormat18Choice struct {
 	NotSpecifiedDate *DateType8Code `xml:"NotSpcfdDt"`
 }
 
+func (d *DateFormat18Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat18Choice) AddDate() *DateAndDateTimeChoice {
 	d.Date = new(DateAndDateTimeChoice)
 	return d.Date
@@ -10,6 +10,10 @@ type DateFormat19Choice struct {
 	DateCode *DateCode11Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat19Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat19Choice) AddDate() *DateAndDateTimeChoice {
 	d.Date = new(DateAndDateTimeChoice)
 	return d.Date
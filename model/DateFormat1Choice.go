@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice between the formats to express a date.
 type DateFormat1Choice struct {
 
@@ -13,10 +17,19 @@ type DateFormat1Choice struct {
 	DateTime *ISODateTime `xml:"DtTm"`
 }
 
+func (d *DateFormat1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat1Choice) SetDate(value string) {
 	d.Date = (*ISODate)(&value)
 }
 
+func (d *DateFormat1Choice) SetDateFromTime(value time.Time) {
+	d.Date = new(ISODate)
+	d.Date.FromTime(value)
+}
+
 func (d *DateFormat1Choice) SetCode(value string) {
 	d.Code = (*SettlementDate1Code)(&value)
 }
@@ -24,3 +37,8 @@ func (d *DateFormat1Choice) SetCode(value string) {
 func (d *DateFormat1Choice) SetDateTime(value string) {
 	d.DateTime = (*ISODateTime)(&value)
 }
+
+func (d *DateFormat1Choice) SetDateTimeFromTime(value time.Time) {
+	d.DateTime = new(ISODateTime)
+	d.DateTime.FromTime(value)
+}
@@ -13,6 +13,10 @@ type DateFormat20Choice struct {
 	DateCode *DateCode11Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat20Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat20Choice) AddDate() *DateAndDateTimeChoice {
 	d.Date = new(DateAndDateTimeChoice)
 	return d.Date
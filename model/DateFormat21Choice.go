@@ -10,6 +10,10 @@ type DateFormat21Choice struct {
 	NotSpecifiedDate *DateType8Code `xml:"NotSpcfdDt"`
 }
 
+func (d *DateFormat21Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat21Choice) AddDate() *DateAndDateTimeChoice {
 	d.Date = new(DateAndDateTimeChoice)
 	return d.Date
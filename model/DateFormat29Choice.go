@@ -10,6 +10,10 @@ type DateFormat29Choice struct {
 	DateCode *DateType1Code `xml:"DtCd"`
 }
 
+func (d *DateFormat29Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat29Choice) AddDateOrDateTime() *DateAndDateTimeChoice {
 	d.DateOrDateTime = new(DateAndDateTimeChoice)
 	return d.DateOrDateTime
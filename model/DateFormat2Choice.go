@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice between the formats to express a date and time.
 type DateFormat2Choice struct {
 
@@ -10,10 +14,19 @@ type DateFormat2Choice struct {
 	DateCode *DateType1Code `xml:"DtCd"`
 }
 
+func (d *DateFormat2Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat2Choice) SetDate(value string) {
 	d.Date = (*ISODateTime)(&value)
 }
 
+func (d *DateFormat2Choice) SetDateFromTime(value time.Time) {
+	d.Date = new(ISODateTime)
+	d.Date.FromTime(value)
+}
+
 func (d *DateFormat2Choice) SetDateCode(value string) {
 	d.DateCode = (*DateType1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice between an ISODate format or a date code.
 type DateFormat30Choice struct {
 
@@ -10,10 +14,19 @@ type DateFormat30Choice struct {
 	DateCode *DateCode19Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat30Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat30Choice) SetDate(value string) {
 	d.Date = (*ISODate)(&value)
 }
 
+func (d *DateFormat30Choice) SetDateFromTime(value time.Time) {
+	d.Date = new(ISODate)
+	d.Date.FromTime(value)
+}
+
 func (d *DateFormat30Choice) AddDateCode() *DateCode19Choice {
 	d.DateCode = new(DateCode19Choice)
 	return d.DateCode
@@ -10,6 +10,10 @@ type DateFormat31Choice struct {
 	DateCode *DateCode19Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat31Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat31Choice) AddDate() *DateAndDateTimeChoice {
 	d.Date = new(DateAndDateTimeChoice)
 	return d.Date
@@ -13,6 +13,10 @@ type DateFormat32Choice struct {
 	DateCode *DateCode19Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat32Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat32Choice) AddDate() *DateAndDateTimeChoice {
 	d.Date = new(DateAndDateTimeChoice)
 	return d.Date
@@ -10,6 +10,10 @@ type DateFormat33Choice struct {
 	DateCode *DateCode20Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat33Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat33Choice) AddDate() *DateAndDateTimeChoice {
 	d.Date = new(DateAndDateTimeChoice)
 	return d.Date
@@ -10,6 +10,10 @@ type DateFormat34Choice struct {
 	DateCode *DateCode22Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat34Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat34Choice) AddDate() *DateAndDateTimeChoice {
 	d.Date = new(DateAndDateTimeChoice)
 	return d.Date
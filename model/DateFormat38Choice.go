@@ -13,6 +13,10 @@ type DateFormat38Choice struct {
 	DateCode *DateCode22Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat38Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat38Choice) AddDate() *DateAndDateTimeChoice {
 	d.Date = new(DateAndDateTimeChoice)
 	return d.Date
@@ -10,6 +10,10 @@ type DateFormat39Choice struct {
 	DateCode *DateCode27Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat39Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat39Choice) AddDate() *DateAndDateTimeChoice {
 	d.Date = new(DateAndDateTimeChoice)
 	return d.Date
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice between the formats to express a date.
 type DateFormat3Choice struct {
 
@@ -10,10 +14,19 @@ type DateFormat3Choice struct {
 	DateCode *DateType1Code `xml:"DtCd"`
 }
 
+func (d *DateFormat3Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat3Choice) SetDate(value string) {
 	d.Date = (*ISODate)(&value)
 }
 
+func (d *DateFormat3Choice) SetDateFromTime(value time.Time) {
+	d.Date = new(ISODate)
+	d.Date.FromTime(value)
+}
+
 func (d *DateFormat3Choice) SetDateCode(value string) {
 	d.DateCode = (*DateType1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice between an ISODate format or a date code.
 type DateFormat41Choice struct {
 
@@ -10,10 +14,19 @@ type DateFormat41Choice struct {
 	DateCode *DateCode22Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat41Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat41Choice) SetDate(value string) {
 	d.Date = (*ISODate)(&value)
 }
 
+func (d *DateFormat41Choice) SetDateFromTime(value time.Time) {
+	d.Date = new(ISODate)
+	d.Date.FromTime(value)
+}
+
 func (d *DateFormat41Choice) AddDateCode() *DateCode22Choice {
 	d.DateCode = new(DateCode22Choice)
 	return d.DateCode
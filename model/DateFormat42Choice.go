@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice of formats for a date.
 type DateFormat42Choice struct {
 
@@ -10,6 +14,10 @@ type DateFormat42Choice struct {
 	YearMonthDay *ISODate `xml:"YrMnthDay"`
 }
 
+func (d *DateFormat42Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat42Choice) SetYearMonth(value string) {
 	d.YearMonth = (*ISOYearMonth)(&value)
 }
@@ -17,3 +25,8 @@ func (d *DateFormat42Choice) SetYearMonth(value string) {
 func (d *DateFormat42Choice) SetYearMonthDay(value string) {
 	d.YearMonthDay = (*ISODate)(&value)
 }
+
+func (d *DateFormat42Choice) SetYearMonthDayFromTime(value time.Time) {
+	d.YearMonthDay = new(ISODate)
+	d.YearMonthDay.FromTime(value)
+}
@@ -13,6 +13,10 @@ type DateFormat4Choice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (d *DateFormat4Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat4Choice) AddDate() *DateAndDateTimeChoice {
 	d.Date = new(DateAndDateTimeChoice)
 	return d.Date
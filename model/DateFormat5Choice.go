@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice between an ISODate format or a date code.
 type DateFormat5Choice struct {
 
@@ -10,10 +14,19 @@ type DateFormat5Choice struct {
 	DateCode *DateCode2Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat5Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat5Choice) SetDate(value string) {
 	d.Date = (*ISODate)(&value)
 }
 
+func (d *DateFormat5Choice) SetDateFromTime(value time.Time) {
+	d.Date = new(ISODate)
+	d.Date.FromTime(value)
+}
+
 func (d *DateFormat5Choice) AddDateCode() *DateCode2Choice {
 	d.DateCode = new(DateCode2Choice)
 	return d.DateCode
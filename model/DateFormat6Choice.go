@@ -10,6 +10,10 @@ type DateFormat6Choice struct {
 	DateCode *DateCode2Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat6Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat6Choice) AddDate() *DateAndDateTimeChoice {
 	d.Date = new(DateAndDateTimeChoice)
 	return d.Date
@@ -13,6 +13,10 @@ type DateFormat7Choice struct {
 	DateCode *DateCode2Choice `xml:"DtCd"`
 }
 
+func (d *DateFormat7Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateFormat7Choice) AddDate() *DateAndDateTimeChoice {
 	d.Date = new(DateAndDateTimeChoice)
 	return d.Date
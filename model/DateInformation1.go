@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Date parameters.
 type DateInformation1 struct {
 
@@ -13,10 +19,19 @@ type DateInformation1 struct {
 	Number *Number `xml:"Nb"`
 }
 
+func (d *DateInformation1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateInformation1) SetStartDate(value string) {
 	d.StartDate = (*ISODate)(&value)
 }
 
+func (d *DateInformation1) SetStartDateFromTime(value time.Time) {
+	d.StartDate = new(ISODate)
+	d.StartDate.FromTime(value)
+}
+
 func (d *DateInformation1) SetFrequency(value string) {
 	d.Frequency = (*ExternalDateFrequency1Code)(&value)
 }
@@ -24,3 +39,8 @@ func (d *DateInformation1) SetFrequency(value string) {
 func (d *DateInformation1) SetNumber(value string) {
 	d.Number = (*Number)(&value)
 }
+
+func (d *DateInformation1) SetNumberFromDecimal(value decimal.Decimal) {
+	d.Number = new(Number)
+	d.Number.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the type of change to a date.
 type DateModification1 struct {
 
@@ -10,6 +14,10 @@ type DateModification1 struct {
 	Date *ISODate `xml:"Dt"`
 }
 
+func (d *DateModification1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateModification1) SetModificationCode(value string) {
 	d.ModificationCode = (*Modification1Code)(&value)
 }
@@ -17,3 +25,8 @@ func (d *DateModification1) SetModificationCode(value string) {
 func (d *DateModification1) SetDate(value string) {
 	d.Date = (*ISODate)(&value)
 }
+
+func (d *DateModification1) SetDateFromTime(value time.Time) {
+	d.Date = new(ISODate)
+	d.Date.FromTime(value)
+}
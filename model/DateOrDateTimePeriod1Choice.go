@@ -0,0 +1,68 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Choice between a date or a date and time format for a period.
+type DateOrDateTimePeriod1Choice struct {
+
+	// Period expressed with dates.
+	Date *DatePeriod2 `xml:"Dt"`
+
+	// Period expressed a dates and times.
+	DateTime *DateTimePeriod1 `xml:"DtTm"`
+}
+
+func (d *DateOrDateTimePeriod1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DateOrDateTimePeriod1Choice) AddDate() *DatePeriod2 {
+	d.Date = new(DatePeriod2)
+	return d.Date
+}
+
+func (d *DateOrDateTimePeriod1Choice) AddDateTime() *DateTimePeriod1 {
+	d.DateTime = new(DateTimePeriod1)
+	return d.DateTime
+}
+
+func (d *DateOrDateTimePeriod1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.Date != nil {
+		d.Date.EncodeXMLFast(enc, "Dt")
+	}
+	if d.DateTime != nil {
+		d.DateTime.EncodeXMLFast(enc, "DtTm")
+	}
+	enc.End(tag)
+}
+
+func (d *DateOrDateTimePeriod1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Dt":
+			elem := new(DatePeriod2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Date = elem
+		case "DtTm":
+			elem := new(DateTimePeriod1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.DateTime = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Choice between a date or a date and time format for a period.
 type DateOrDateTimePeriodChoice struct {
 
@@ -10,6 +12,10 @@ type DateOrDateTimePeriodChoice struct {
 	DateTime *DateTimePeriodDetails `xml:"DtTm"`
 }
 
+func (d *DateOrDateTimePeriodChoice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateOrDateTimePeriodChoice) AddDate() *DatePeriodDetails {
 	d.Date = new(DatePeriodDetails)
 	return d.Date
@@ -19,3 +25,44 @@ func (d *DateOrDateTimePeriodChoice) AddDateTime() *DateTimePeriodDetails {
 	d.DateTime = new(DateTimePeriodDetails)
 	return d.DateTime
 }
+
+func (d *DateOrDateTimePeriodChoice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.Date != nil {
+		d.Date.EncodeXMLFast(enc, "Dt")
+	}
+	if d.DateTime != nil {
+		d.DateTime.EncodeXMLFast(enc, "DtTm")
+	}
+	enc.End(tag)
+}
+
+func (d *DateOrDateTimePeriodChoice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Dt":
+			elem := new(DatePeriodDetails)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Date = elem
+		case "DtTm":
+			elem := new(DateTimePeriodDetails)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.DateTime = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Range of time defined by a start date and an end date.
 type DatePeriod1 struct {
 
@@ -10,10 +14,24 @@ type DatePeriod1 struct {
 	ToDate *ISODate `xml:"ToDt"`
 }
 
+func (d *DatePeriod1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DatePeriod1) SetFromDate(value string) {
 	d.FromDate = (*ISODate)(&value)
 }
 
+func (d *DatePeriod1) SetFromDateFromTime(value time.Time) {
+	d.FromDate = new(ISODate)
+	d.FromDate.FromTime(value)
+}
+
 func (d *DatePeriod1) SetToDate(value string) {
 	d.ToDate = (*ISODate)(&value)
 }
+
+func (d *DatePeriod1) SetToDateFromTime(value time.Time) {
+	d.ToDate = new(ISODate)
+	d.ToDate.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Period as a date, a month or a date time span for which the statement is provided.
 type DatePeriod1Choice struct {
 
@@ -13,10 +17,19 @@ type DatePeriod1Choice struct {
 	FromDateToDate *Period2 `xml:"FrDtToDt"`
 }
 
+func (d *DatePeriod1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DatePeriod1Choice) SetDate(value string) {
 	d.Date = (*ISODate)(&value)
 }
 
+func (d *DatePeriod1Choice) SetDateFromTime(value time.Time) {
+	d.Date = new(ISODate)
+	d.Date.FromTime(value)
+}
+
 func (d *DatePeriod1Choice) SetDateMonth(value string) {
 	d.DateMonth = (*ISOYearMonth)(&value)
 }
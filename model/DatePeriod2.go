@@ -0,0 +1,80 @@
+package model
+
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Range of time defined by a start date and an end date.
+type DatePeriod2 struct {
+
+	// Start date of the range.
+	FromDate *ISODate `xml:"FrDt"`
+
+	// End date of the range.
+	ToDate *ISODate `xml:"ToDt"`
+}
+
+func (d *DatePeriod2) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DatePeriod2) SetFromDate(value string) {
+	d.FromDate = (*ISODate)(&value)
+}
+
+func (d *DatePeriod2) SetFromDateFromTime(value time.Time) {
+	d.FromDate = new(ISODate)
+	d.FromDate.FromTime(value)
+}
+
+func (d *DatePeriod2) SetToDate(value string) {
+	d.ToDate = (*ISODate)(&value)
+}
+
+func (d *DatePeriod2) SetToDateFromTime(value time.Time) {
+	d.ToDate = new(ISODate)
+	d.ToDate.FromTime(value)
+}
+
+func (d *DatePeriod2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.FromDate != nil {
+		enc.Element("FrDt", string(*d.FromDate))
+	}
+	if d.ToDate != nil {
+		enc.Element("ToDt", string(*d.ToDate))
+	}
+	enc.End(tag)
+}
+
+func (d *DatePeriod2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FrDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.FromDate = (*ISODate)(&value)
+		case "ToDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.ToDate = (*ISODate)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
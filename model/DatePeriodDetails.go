@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Range of time defined by a start date and an end date.
 type DatePeriodDetails struct {
 
@@ -10,10 +16,65 @@ type DatePeriodDetails struct {
 	ToDate *ISODate `xml:"ToDt"`
 }
 
+func (d *DatePeriodDetails) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DatePeriodDetails) SetFromDate(value string) {
 	d.FromDate = (*ISODate)(&value)
 }
 
+func (d *DatePeriodDetails) SetFromDateFromTime(value time.Time) {
+	d.FromDate = new(ISODate)
+	d.FromDate.FromTime(value)
+}
+
 func (d *DatePeriodDetails) SetToDate(value string) {
 	d.ToDate = (*ISODate)(&value)
 }
+
+func (d *DatePeriodDetails) SetToDateFromTime(value time.Time) {
+	d.ToDate = new(ISODate)
+	d.ToDate.FromTime(value)
+}
+
+func (d *DatePeriodDetails) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.FromDate != nil {
+		enc.Element("FrDt", string(*d.FromDate))
+	}
+	if d.ToDate != nil {
+		enc.Element("ToDt", string(*d.ToDate))
+	}
+	enc.End(tag)
+}
+
+func (d *DatePeriodDetails) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FrDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.FromDate = (*ISODate)(&value)
+		case "ToDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.ToDate = (*ISODate)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
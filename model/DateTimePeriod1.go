@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Time span defined by a start date and time, and an end date and time.
 type DateTimePeriod1 struct {
 
@@ -10,10 +16,65 @@ type DateTimePeriod1 struct {
 	ToDateTime *ISODateTime `xml:"ToDtTm"`
 }
 
+func (d *DateTimePeriod1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateTimePeriod1) SetFromDateTime(value string) {
 	d.FromDateTime = (*ISODateTime)(&value)
 }
 
+func (d *DateTimePeriod1) SetFromDateTimeFromTime(value time.Time) {
+	d.FromDateTime = new(ISODateTime)
+	d.FromDateTime.FromTime(value)
+}
+
 func (d *DateTimePeriod1) SetToDateTime(value string) {
 	d.ToDateTime = (*ISODateTime)(&value)
 }
+
+func (d *DateTimePeriod1) SetToDateTimeFromTime(value time.Time) {
+	d.ToDateTime = new(ISODateTime)
+	d.ToDateTime.FromTime(value)
+}
+
+func (d *DateTimePeriod1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.FromDateTime != nil {
+		enc.Element("FrDtTm", string(*d.FromDateTime))
+	}
+	if d.ToDateTime != nil {
+		enc.Element("ToDtTm", string(*d.ToDateTime))
+	}
+	enc.End(tag)
+}
+
+func (d *DateTimePeriod1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FrDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.FromDateTime = (*ISODateTime)(&value)
+		case "ToDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.ToDateTime = (*ISODateTime)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice between various date time patterns.
 type DateTimePeriodChoice struct {
 
@@ -13,14 +17,28 @@ type DateTimePeriodChoice struct {
 	DateTimeRange *DateTimePeriodDetails `xml:"DtTmRg"`
 }
 
+func (d *DateTimePeriodChoice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateTimePeriodChoice) SetFromDateTime(value string) {
 	d.FromDateTime = (*ISODateTime)(&value)
 }
 
+func (d *DateTimePeriodChoice) SetFromDateTimeFromTime(value time.Time) {
+	d.FromDateTime = new(ISODateTime)
+	d.FromDateTime.FromTime(value)
+}
+
 func (d *DateTimePeriodChoice) SetToDateTime(value string) {
 	d.ToDateTime = (*ISODateTime)(&value)
 }
 
+func (d *DateTimePeriodChoice) SetToDateTimeFromTime(value time.Time) {
+	d.ToDateTime = new(ISODateTime)
+	d.ToDateTime.FromTime(value)
+}
+
 func (d *DateTimePeriodChoice) AddDateTimeRange() *DateTimePeriodDetails {
 	d.DateTimeRange = new(DateTimePeriodDetails)
 	return d.DateTimeRange
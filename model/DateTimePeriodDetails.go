@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Time span defined by a start date and time, and an end date and time.
 type DateTimePeriodDetails struct {
 
@@ -10,10 +16,65 @@ type DateTimePeriodDetails struct {
 	ToDateTime *ISODateTime `xml:"ToDtTm"`
 }
 
+func (d *DateTimePeriodDetails) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateTimePeriodDetails) SetFromDateTime(value string) {
 	d.FromDateTime = (*ISODateTime)(&value)
 }
 
+func (d *DateTimePeriodDetails) SetFromDateTimeFromTime(value time.Time) {
+	d.FromDateTime = new(ISODateTime)
+	d.FromDateTime.FromTime(value)
+}
+
 func (d *DateTimePeriodDetails) SetToDateTime(value string) {
 	d.ToDateTime = (*ISODateTime)(&value)
 }
+
+func (d *DateTimePeriodDetails) SetToDateTimeFromTime(value time.Time) {
+	d.ToDateTime = new(ISODateTime)
+	d.ToDateTime.FromTime(value)
+}
+
+func (d *DateTimePeriodDetails) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.FromDateTime != nil {
+		enc.Element("FrDtTm", string(*d.FromDateTime))
+	}
+	if d.ToDateTime != nil {
+		enc.Element("ToDtTm", string(*d.ToDateTime))
+	}
+	enc.End(tag)
+}
+
+func (d *DateTimePeriodDetails) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FrDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.FromDateTime = (*ISODateTime)(&value)
+		case "ToDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.ToDateTime = (*ISODateTime)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Time span defined by a start date and time, and an end date and time.
 type DateTimePeriodDetails1 struct {
 
@@ -10,10 +14,24 @@ type DateTimePeriodDetails1 struct {
 	ToDateTime *ISODateTime `xml:"ToDtTm,omitempty"`
 }
 
+func (d *DateTimePeriodDetails1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DateTimePeriodDetails1) SetFromDateTime(value string) {
 	d.FromDateTime = (*ISODateTime)(&value)
 }
 
+func (d *DateTimePeriodDetails1) SetFromDateTimeFromTime(value time.Time) {
+	d.FromDateTime = new(ISODateTime)
+	d.FromDateTime.FromTime(value)
+}
+
 func (d *DateTimePeriodDetails1) SetToDateTime(value string) {
 	d.ToDateTime = (*ISODateTime)(&value)
 }
+
+func (d *DateTimePeriodDetails1) SetToDateTimeFromTime(value time.Time) {
+	d.ToDateTime = new(ISODateTime)
+	d.ToDateTime.FromTime(value)
+}
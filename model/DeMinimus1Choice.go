@@ -10,6 +10,10 @@ type DeMinimus1Choice struct {
 	DeMinimusNotApplicable *DeMinimusNotApplicable1 `xml:"DeMnmsNotAplbl"`
 }
 
+func (d *DeMinimus1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeMinimus1Choice) AddDeMinimusApplicable() *DeMinimusApplicable1 {
 	d.DeMinimusApplicable = new(DeMinimusApplicable1)
 	return d.DeMinimusApplicable
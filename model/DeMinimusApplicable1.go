@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Conditions applicable when the investor is covered by the "de minimis" exemption.
 type DeMinimusApplicable1 struct {
 
@@ -10,10 +14,24 @@ type DeMinimusApplicable1 struct {
 	Percentage *PercentageRate `xml:"Pctg,omitempty"`
 }
 
+func (d *DeMinimusApplicable1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeMinimusApplicable1) SetNewIssuePermission(value string) {
 	d.NewIssuePermission = (*YesNoIndicator)(&value)
 }
 
+func (d *DeMinimusApplicable1) SetNewIssuePermissionFromBool(value bool) {
+	d.NewIssuePermission = new(YesNoIndicator)
+	d.NewIssuePermission.FromBool(value)
+}
+
 func (d *DeMinimusApplicable1) SetPercentage(value string) {
 	d.Percentage = (*PercentageRate)(&value)
 }
+
+func (d *DeMinimusApplicable1) SetPercentageFromDecimal(value decimal.Decimal) {
+	d.Percentage = new(PercentageRate)
+	d.Percentage.FromDecimal(value)
+}
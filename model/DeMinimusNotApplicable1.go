@@ -7,6 +7,10 @@ type DeMinimusNotApplicable1 struct {
 	RestrictedPersonReason *Max350Text `xml:"RstrctdPrsnRsn"`
 }
 
+func (d *DeMinimusNotApplicable1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeMinimusNotApplicable1) SetRestrictedPersonReason(value string) {
 	d.RestrictedPersonReason = (*Max350Text)(&value)
 }
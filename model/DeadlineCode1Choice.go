@@ -10,6 +10,10 @@ type DeadlineCode1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DeadlineCode1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeadlineCode1Choice) SetCode(value string) {
 	d.Code = (*CorporateActionDeadline1Code)(&value)
 }
@@ -10,6 +10,10 @@ type DeadlineCode3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (d *DeadlineCode3Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeadlineCode3Choice) SetCode(value string) {
 	d.Code = (*CorporateActionDeadline1Code)(&value)
 }
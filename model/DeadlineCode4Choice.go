@@ -10,6 +10,10 @@ type DeadlineCode4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (d *DeadlineCode4Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeadlineCode4Choice) SetCode(value string) {
 	d.Code = (*CorporateActionDeadline1Code)(&value)
 }
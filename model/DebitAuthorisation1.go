@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the reason for requesting a debit authorisation as well as the amount of the requested debit.
 type DebitAuthorisation1 struct {
 
@@ -16,6 +22,10 @@ type DebitAuthorisation1 struct {
 	AdditionalCancellationReasonInformation []*Max105Text `xml:"AddtlCxlRsnInf,omitempty"`
 }
 
+func (d *DebitAuthorisation1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DebitAuthorisation1) AddCancellationReason() *CancellationReason14Choice {
 	d.CancellationReason = new(CancellationReason14Choice)
 	return d.CancellationReason
@@ -25,10 +35,19 @@ func (d *DebitAuthorisation1) SetAmountToDebit(value, currency string) {
 	d.AmountToDebit = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (d *DebitAuthorisation1) SetAmountToDebitFromDecimal(value decimal.Decimal, currency string) {
+	d.AmountToDebit = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DebitAuthorisation1) SetValueDateToDebit(value string) {
 	d.ValueDateToDebit = (*ISODate)(&value)
 }
 
+func (d *DebitAuthorisation1) SetValueDateToDebitFromTime(value time.Time) {
+	d.ValueDateToDebit = new(ISODate)
+	d.ValueDateToDebit.FromTime(value)
+}
+
 func (d *DebitAuthorisation1) AddAdditionalCancellationReasonInformation(value string) {
 	d.AdditionalCancellationReasonInformation = append(d.AdditionalCancellationReasonInformation, (*Max105Text)(&value))
 }
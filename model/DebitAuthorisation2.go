@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the reason for requesting a debit authorisation as well as the amount of the requested debit.
 type DebitAuthorisation2 struct {
 
@@ -16,6 +22,10 @@ type DebitAuthorisation2 struct {
 	AdditionalCancellationReasonInformation []*Max105Text `xml:"AddtlCxlRsnInf,omitempty"`
 }
 
+func (d *DebitAuthorisation2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DebitAuthorisation2) AddCancellationReason() *CancellationReason33Choice {
 	d.CancellationReason = new(CancellationReason33Choice)
 	return d.CancellationReason
@@ -25,10 +35,19 @@ func (d *DebitAuthorisation2) SetAmountToDebit(value, currency string) {
 	d.AmountToDebit = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (d *DebitAuthorisation2) SetAmountToDebitFromDecimal(value decimal.Decimal, currency string) {
+	d.AmountToDebit = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DebitAuthorisation2) SetValueDateToDebit(value string) {
 	d.ValueDateToDebit = (*ISODate)(&value)
 }
 
+func (d *DebitAuthorisation2) SetValueDateToDebitFromTime(value time.Time) {
+	d.ValueDateToDebit = new(ISODate)
+	d.ValueDateToDebit.FromTime(value)
+}
+
 func (d *DebitAuthorisation2) AddAdditionalCancellationReasonInformation(value string) {
 	d.AdditionalCancellationReasonInformation = append(d.AdditionalCancellationReasonInformation, (*Max105Text)(&value))
 }
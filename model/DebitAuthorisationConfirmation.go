@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Indicates if the debit authorisation is granted or not.
 type DebitAuthorisationConfirmation struct {
 
@@ -16,18 +22,36 @@ type DebitAuthorisationConfirmation struct {
 	Reason *Max140Text `xml:"Rsn,omitempty"`
 }
 
+func (d *DebitAuthorisationConfirmation) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DebitAuthorisationConfirmation) SetDebitAuthorisation(value string) {
 	d.DebitAuthorisation = (*YesNoIndicator)(&value)
 }
 
+func (d *DebitAuthorisationConfirmation) SetDebitAuthorisationFromBool(value bool) {
+	d.DebitAuthorisation = new(YesNoIndicator)
+	d.DebitAuthorisation.FromBool(value)
+}
+
 func (d *DebitAuthorisationConfirmation) SetAmountToDebit(value, currency string) {
 	d.AmountToDebit = NewCurrencyAndAmount(value, currency)
 }
 
+func (d *DebitAuthorisationConfirmation) SetAmountToDebitFromDecimal(value decimal.Decimal, currency string) {
+	d.AmountToDebit = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DebitAuthorisationConfirmation) SetValueDateToDebit(value string) {
 	d.ValueDateToDebit = (*ISODate)(&value)
 }
 
+func (d *DebitAuthorisationConfirmation) SetValueDateToDebitFromTime(value time.Time) {
+	d.ValueDateToDebit = new(ISODate)
+	d.ValueDateToDebit.FromTime(value)
+}
+
 func (d *DebitAuthorisationConfirmation) SetReason(value string) {
 	d.Reason = (*Max140Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies whether or not the debit authorisation is granted.
 type DebitAuthorisationConfirmation2 struct {
 
@@ -17,18 +23,36 @@ type DebitAuthorisationConfirmation2 struct {
 	Reason *Max140Text `xml:"Rsn,omitempty"`
 }
 
+func (d *DebitAuthorisationConfirmation2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DebitAuthorisationConfirmation2) SetDebitAuthorisation(value string) {
 	d.DebitAuthorisation = (*YesNoIndicator)(&value)
 }
 
+func (d *DebitAuthorisationConfirmation2) SetDebitAuthorisationFromBool(value bool) {
+	d.DebitAuthorisation = new(YesNoIndicator)
+	d.DebitAuthorisation.FromBool(value)
+}
+
 func (d *DebitAuthorisationConfirmation2) SetAmountToDebit(value, currency string) {
 	d.AmountToDebit = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DebitAuthorisationConfirmation2) SetAmountToDebitFromDecimal(value decimal.Decimal, currency string) {
+	d.AmountToDebit = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DebitAuthorisationConfirmation2) SetValueDateToDebit(value string) {
 	d.ValueDateToDebit = (*ISODate)(&value)
 }
 
+func (d *DebitAuthorisationConfirmation2) SetValueDateToDebitFromTime(value time.Time) {
+	d.ValueDateToDebit = new(ISODate)
+	d.ValueDateToDebit.FromTime(value)
+}
+
 func (d *DebitAuthorisationConfirmation2) SetReason(value string) {
 	d.Reason = (*Max140Text)(&value)
 }
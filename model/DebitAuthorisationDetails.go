@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the reason for requesting a debit authorisation as well as the amount of the requested debit.
 type DebitAuthorisationDetails struct {
 
@@ -13,6 +19,10 @@ type DebitAuthorisationDetails struct {
 	ValueDateToDebit *ISODate `xml:"ValDtToDbt,omitempty"`
 }
 
+func (d *DebitAuthorisationDetails) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DebitAuthorisationDetails) SetCancellationReason(value string) {
 	d.CancellationReason = (*CancellationReason1Code)(&value)
 }
@@ -21,6 +31,15 @@ func (d *DebitAuthorisationDetails) SetAmountToDebit(value, currency string) {
 	d.AmountToDebit = NewCurrencyAndAmount(value, currency)
 }
 
+func (d *DebitAuthorisationDetails) SetAmountToDebitFromDecimal(value decimal.Decimal, currency string) {
+	d.AmountToDebit = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DebitAuthorisationDetails) SetValueDateToDebit(value string) {
 	d.ValueDateToDebit = (*ISODate)(&value)
 }
+
+func (d *DebitAuthorisationDetails) SetValueDateToDebitFromTime(value time.Time) {
+	d.ValueDateToDebit = new(ISODate)
+	d.ValueDateToDebit.FromTime(value)
+}
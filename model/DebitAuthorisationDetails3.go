@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the reason for requesting a debit authorisation as well as the amount of the requested debit.
 type DebitAuthorisationDetails3 struct {
 
@@ -16,6 +22,10 @@ type DebitAuthorisationDetails3 struct {
 	AdditionalCancellationReasonInformation []*Max105Text `xml:"AddtlCxlRsnInf,omitempty"`
 }
 
+func (d *DebitAuthorisationDetails3) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DebitAuthorisationDetails3) AddCancellationReason() *CancellationReason2Choice {
 	d.CancellationReason = new(CancellationReason2Choice)
 	return d.CancellationReason
@@ -25,10 +35,19 @@ func (d *DebitAuthorisationDetails3) SetAmountToDebit(value, currency string) {
 	d.AmountToDebit = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (d *DebitAuthorisationDetails3) SetAmountToDebitFromDecimal(value decimal.Decimal, currency string) {
+	d.AmountToDebit = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DebitAuthorisationDetails3) SetValueDateToDebit(value string) {
 	d.ValueDateToDebit = (*ISODate)(&value)
 }
 
+func (d *DebitAuthorisationDetails3) SetValueDateToDebitFromTime(value time.Time) {
+	d.ValueDateToDebit = new(ISODate)
+	d.ValueDateToDebit.FromTime(value)
+}
+
 func (d *DebitAuthorisationDetails3) AddAdditionalCancellationReasonInformation(value string) {
 	d.AdditionalCancellationReasonInformation = append(d.AdditionalCancellationReasonInformation, (*Max105Text)(&value))
 }
@@ -13,6 +13,10 @@ type Debtor2 struct {
 	FirstAgent *FinancialInstitutionIdentification3Choice `xml:"FrstAgt"`
 }
 
+func (d *Debtor2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *Debtor2) AddDebtor() *PartyIdentification2Choice {
 	d.Debtor = new(PartyIdentification2Choice)
 	return d.Debtor
@@ -1,3 +1,19 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 type DecimalNumber string
+
+func (d DecimalNumber) Validate() error {
+	return validateDecimal("DecimalNumber", string(d), 18, 17)
+}
+
+func (d DecimalNumber) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(string(d))
+}
+
+func (d *DecimalNumber) FromDecimal(value decimal.Decimal) {
+	*d = DecimalNumber(value.String())
+}
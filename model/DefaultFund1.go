@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information such as the default fund account identification or the default fund amount.
 type DefaultFund1 struct {
 
@@ -16,6 +20,10 @@ type DefaultFund1 struct {
 	IncreaseCoverageAmount *ActiveCurrencyAndAmount `xml:"IncrCvrgAmt,omitempty"`
 }
 
+func (d *DefaultFund1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DefaultFund1) AddDefaultFundAccount() *AccountIdentification4Choice {
 	d.DefaultFundAccount = new(AccountIdentification4Choice)
 	return d.DefaultFundAccount
@@ -25,6 +33,10 @@ func (d *DefaultFund1) SetTotalDefaultFundAmount(value, currency string) {
 	d.TotalDefaultFundAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DefaultFund1) SetTotalDefaultFundAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.TotalDefaultFundAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DefaultFund1) AddContribution() *Contribution1 {
 	newValue := new(Contribution1)
 	d.Contribution = append(d.Contribution, newValue)
@@ -174,3 +186,7 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (d *DefaultFund1) SetIncreaseCoverageAmount(value, currency string) {
 	d.IncreaseCoverageAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (d *DefaultFund1) SetIncreaseCoverageAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.IncreaseCoverageAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -13,6 +13,10 @@ type DefaultFundReport1 struct {
 	NetExcessOrDeficit *AmountAndDirection21 `xml:"NetXcssOrDfcit"`
 }
 
+func (d *DefaultFundReport1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DefaultFundReport1) AddDefaultFundCalculation() *DefaultFund1 {
 	newValue := new(DefaultFund1)
 	d.DefaultFundCalculation = append(d.DefaultFundCalculation, newValue)
@@ -10,10 +10,24 @@ type DefaultProcessingOrStandingInstruction1Choice struct {
 	StandingInstructionIndicator *YesNoIndicator `xml:"StgInstrInd"`
 }
 
+func (d *DefaultProcessingOrStandingInstruction1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DefaultProcessingOrStandingInstruction1Choice) SetDefaultOptionIndicator(value string) {
 	d.DefaultOptionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (d *DefaultProcessingOrStandingInstruction1Choice) SetDefaultOptionIndicatorFromBool(value bool) {
+	d.DefaultOptionIndicator = new(YesNoIndicator)
+	d.DefaultOptionIndicator.FromBool(value)
+}
+
 func (d *DefaultProcessingOrStandingInstruction1Choice) SetStandingInstructionIndicator(value string) {
 	d.StandingInstructionIndicator = (*YesNoIndicator)(&value)
 }
+
+func (d *DefaultProcessingOrStandingInstruction1Choice) SetStandingInstructionIndicatorFromBool(value bool) {
+	d.StandingInstructionIndicator = new(YesNoIndicator)
+	d.StandingInstructionIndicator.FromBool(value)
+}
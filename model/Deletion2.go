@@ -7,6 +7,10 @@ type Deletion2 struct {
 	DeletedValue *Max350Text `xml:"DeltdVal,omitempty"`
 }
 
+func (d *Deletion2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *Deletion2) SetDeletedValue(value string) {
 	d.DeletedValue = (*Max350Text)(&value)
 }
@@ -13,6 +13,10 @@ type DeliverInformation1 struct {
 	PhysicalTransferDetails *DeliveryParameters2 `xml:"PhysTrfDtls,omitempty"`
 }
 
+func (d *DeliverInformation1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliverInformation1) AddSettlementPartiesDetails() *DeliveringPartiesAndAccount1 {
 	d.SettlementPartiesDetails = new(DeliveringPartiesAndAccount1)
 	return d.SettlementPartiesDetails
@@ -22,6 +26,11 @@ func (d *DeliverInformation1) SetPhysicalTransferIndicator(value string) {
 	d.PhysicalTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (d *DeliverInformation1) SetPhysicalTransferIndicatorFromBool(value bool) {
+	d.PhysicalTransferIndicator = new(YesNoIndicator)
+	d.PhysicalTransferIndicator.FromBool(value)
+}
+
 func (d *DeliverInformation1) AddPhysicalTransferDetails() *DeliveryParameters2 {
 	d.PhysicalTransferDetails = new(DeliveryParameters2)
 	return d.PhysicalTransferDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Completion of a securities settlement instruction, wherein securities are delivered/debited from a securities account and received/credited to the designated securities account.
 type DeliverInformation11 struct {
 
@@ -37,10 +43,19 @@ type DeliverInformation11 struct {
 	PhysicalTransferDetails *DeliveryParameters4 `xml:"PhysTrfDtls,omitempty"`
 }
 
+func (d *DeliverInformation11) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliverInformation11) SetRequestedSettlementDate(value string) {
 	d.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (d *DeliverInformation11) SetRequestedSettlementDateFromTime(value time.Time) {
+	d.RequestedSettlementDate = new(ISODate)
+	d.RequestedSettlementDate.FromTime(value)
+}
+
 func (d *DeliverInformation11) AddEffectiveSettlementDate() *DateAndDateTimeChoice {
 	d.EffectiveSettlementDate = new(DateAndDateTimeChoice)
 	return d.EffectiveSettlementDate
@@ -50,6 +65,10 @@ func (d *DeliverInformation11) SetSettlementAmount(value, currency string) {
 	d.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation11) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation11) SetStampDuty(value string) {
 	d.StampDuty = (*StampDutyType2Code)(&value)
 }
@@ -58,6 +77,10 @@ func (d *DeliverInformation11) SetNetAmount(value, currency string) {
 	d.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation11) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation11) AddChargeDetails() *Charge20 {
 	newValue := new(Charge20)
 	d.ChargeDetails = append(d.ChargeDetails, newValue)
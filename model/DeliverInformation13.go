@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type DeliverInformation13 struct {
 
@@ -40,14 +46,27 @@ type DeliverInformation13 struct {
 	ClientReference *Max35Text `xml:"ClntRef,omitempty"`
 }
 
+func (d *DeliverInformation13) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliverInformation13) SetRequestedSettlementDate(value string) {
 	d.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (d *DeliverInformation13) SetRequestedSettlementDateFromTime(value time.Time) {
+	d.RequestedSettlementDate = new(ISODate)
+	d.RequestedSettlementDate.FromTime(value)
+}
+
 func (d *DeliverInformation13) SetSettlementAmount(value, currency string) {
 	d.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation13) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation13) SetStampDuty(value string) {
 	d.StampDuty = (*StampDutyType2Code)(&value)
 }
@@ -56,6 +75,10 @@ func (d *DeliverInformation13) SetNetAmount(value, currency string) {
 	d.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation13) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation13) AddSettlementPartiesDetails() *DeliveringPartiesAndAccount9 {
 	d.SettlementPartiesDetails = new(DeliveringPartiesAndAccount9)
 	return d.SettlementPartiesDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type DeliverInformation14 struct {
 
@@ -43,10 +49,19 @@ type DeliverInformation14 struct {
 	ClientReference *Max35Text `xml:"ClntRef,omitempty"`
 }
 
+func (d *DeliverInformation14) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliverInformation14) SetRequestedSettlementDate(value string) {
 	d.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (d *DeliverInformation14) SetRequestedSettlementDateFromTime(value time.Time) {
+	d.RequestedSettlementDate = new(ISODate)
+	d.RequestedSettlementDate.FromTime(value)
+}
+
 func (d *DeliverInformation14) AddEffectiveSettlementDate() *DateAndDateTimeChoice {
 	d.EffectiveSettlementDate = new(DateAndDateTimeChoice)
 	return d.EffectiveSettlementDate
@@ -56,6 +71,10 @@ func (d *DeliverInformation14) SetSettlementAmount(value, currency string) {
 	d.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation14) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation14) SetStampDuty(value string) {
 	d.StampDuty = (*StampDutyType2Code)(&value)
 }
@@ -64,6 +83,10 @@ func (d *DeliverInformation14) SetNetAmount(value, currency string) {
 	d.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation14) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation14) AddChargeDetails() *Charge27 {
 	newValue := new(Charge27)
 	d.ChargeDetails = append(d.ChargeDetails, newValue)
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type DeliverInformation15 struct {
 
@@ -40,14 +46,27 @@ type DeliverInformation15 struct {
 	ClientReference *Max35Text `xml:"ClntRef,omitempty"`
 }
 
+func (d *DeliverInformation15) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliverInformation15) SetRequestedSettlementDate(value string) {
 	d.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (d *DeliverInformation15) SetRequestedSettlementDateFromTime(value time.Time) {
+	d.RequestedSettlementDate = new(ISODate)
+	d.RequestedSettlementDate.FromTime(value)
+}
+
 func (d *DeliverInformation15) SetSettlementAmount(value, currency string) {
 	d.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation15) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation15) SetStampDuty(value string) {
 	d.StampDuty = (*StampDutyType2Code)(&value)
 }
@@ -56,6 +75,10 @@ func (d *DeliverInformation15) SetNetAmount(value, currency string) {
 	d.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation15) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation15) AddSettlementPartiesDetails() *DeliveringPartiesAndAccount9 {
 	d.SettlementPartiesDetails = new(DeliveringPartiesAndAccount9)
 	return d.SettlementPartiesDetails
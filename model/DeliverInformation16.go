@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type DeliverInformation16 struct {
 
@@ -49,6 +55,10 @@ type DeliverInformation16 struct {
 	ClientReference *AdditionalReference7 `xml:"ClntRef,omitempty"`
 }
 
+func (d *DeliverInformation16) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliverInformation16) AddTransferor() *PartyIdentification70Choice {
 	d.Transferor = new(PartyIdentification70Choice)
 	return d.Transferor
@@ -209,10 +219,19 @@ func (d *DeliverInformation16) SetRequestedSettlementDate(value string) {
 	d.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (d *DeliverInformation16) SetRequestedSettlementDateFromTime(value time.Time) {
+	d.RequestedSettlementDate = new(ISODate)
+	d.RequestedSettlementDate.FromTime(value)
+}
+
 func (d *DeliverInformation16) SetSettlementAmount(value, currency string) {
 	d.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation16) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation16) SetStampDuty(value string) {
 	d.StampDuty = (*StampDutyType2Code)(&value)
 }
@@ -221,6 +240,10 @@ func (d *DeliverInformation16) SetNetAmount(value, currency string) {
 	d.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation16) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation16) AddSettlementPartiesDetails() *DeliveringPartiesAndAccount13 {
 	d.SettlementPartiesDetails = new(DeliveringPartiesAndAccount13)
 	return d.SettlementPartiesDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type DeliverInformation17 struct {
 
@@ -52,6 +58,10 @@ type DeliverInformation17 struct {
 	ClientReference *AdditionalReference7 `xml:"ClntRef,omitempty"`
 }
 
+func (d *DeliverInformation17) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliverInformation17) AddTransferor() *PartyIdentification70Choice {
 	d.Transferor = new(PartyIdentification70Choice)
 	return d.Transferor
@@ -212,6 +222,11 @@ func (d *DeliverInformation17) SetRequestedSettlementDate(value string) {
 	d.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (d *DeliverInformation17) SetRequestedSettlementDateFromTime(value time.Time) {
+	d.RequestedSettlementDate = new(ISODate)
+	d.RequestedSettlementDate.FromTime(value)
+}
+
 func (d *DeliverInformation17) AddEffectiveSettlementDate() *DateAndDateTimeChoice {
 	d.EffectiveSettlementDate = new(DateAndDateTimeChoice)
 	return d.EffectiveSettlementDate
@@ -221,6 +236,10 @@ func (d *DeliverInformation17) SetSettlementAmount(value, currency string) {
 	d.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation17) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation17) SetStampDuty(value string) {
 	d.StampDuty = (*StampDutyType2Code)(&value)
 }
@@ -229,6 +248,10 @@ func (d *DeliverInformation17) SetNetAmount(value, currency string) {
 	d.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation17) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation17) AddChargeDetails() *Charge29 {
 	newValue := new(Charge29)
 	d.ChargeDetails = append(d.ChargeDetails, newValue)
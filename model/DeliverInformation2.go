@@ -19,6 +19,10 @@ type DeliverInformation2 struct {
 	PhysicalTransferDetails *DeliveryParameters2 `xml:"PhysTrfDtls,omitempty"`
 }
 
+func (d *DeliverInformation2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliverInformation2) AddChargeDetails() *Charge4 {
 	newValue := new(Charge4)
 	d.ChargeDetails = append(d.ChargeDetails, newValue)
@@ -320,6 +324,11 @@ func (d *DeliverInformation2) SetPhysicalTransferIndicator(value string) {
 	d.PhysicalTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (d *DeliverInformation2) SetPhysicalTransferIndicatorFromBool(value bool) {
+	d.PhysicalTransferIndicator = new(YesNoIndicator)
+	d.PhysicalTransferIndicator.FromBool(value)
+}
+
 func (d *DeliverInformation2) AddPhysicalTransferDetails() *DeliveryParameters2 {
 	d.PhysicalTransferDetails = new(DeliveryParameters2)
 	return d.PhysicalTransferDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type DeliverInformation3 struct {
 
@@ -31,18 +35,35 @@ type DeliverInformation3 struct {
 	PhysicalTransferDetails *DeliveryParameters4 `xml:"PhysTrfDtls,omitempty"`
 }
 
+func (d *DeliverInformation3) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliverInformation3) SetSettlementAmount(value, currency string) {
 	d.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation3) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation3) SetStampDutyIndicator(value string) {
 	d.StampDutyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (d *DeliverInformation3) SetStampDutyIndicatorFromBool(value bool) {
+	d.StampDutyIndicator = new(YesNoIndicator)
+	d.StampDutyIndicator.FromBool(value)
+}
+
 func (d *DeliverInformation3) SetNetAmount(value, currency string) {
 	d.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation3) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation3) AddSettlementPartiesDetails() *DeliveringPartiesAndAccount4 {
 	d.SettlementPartiesDetails = new(DeliveringPartiesAndAccount4)
 	return d.SettlementPartiesDetails
@@ -490,6 +511,11 @@ func (d *DeliverInformation3) SetPhysicalTransferIndicator(value string) {
 	d.PhysicalTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (d *DeliverInformation3) SetPhysicalTransferIndicatorFromBool(value bool) {
+	d.PhysicalTransferIndicator = new(YesNoIndicator)
+	d.PhysicalTransferIndicator.FromBool(value)
+}
+
 func (d *DeliverInformation3) AddPhysicalTransferDetails() *DeliveryParameters4 {
 	d.PhysicalTransferDetails = new(DeliveryParameters4)
 	return d.PhysicalTransferDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Completion of a securities settlement instruction, wherein securities are delivered/debited from a securities account and received/credited to the designated securities account.
 type DeliverInformation4 struct {
 
@@ -34,6 +38,10 @@ type DeliverInformation4 struct {
 	PhysicalTransferDetails *DeliveryParameters4 `xml:"PhysTrfDtls,omitempty"`
 }
 
+func (d *DeliverInformation4) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliverInformation4) AddEffectiveSettlementDate() *DateAndDateTimeChoice {
 	d.EffectiveSettlementDate = new(DateAndDateTimeChoice)
 	return d.EffectiveSettlementDate
@@ -43,14 +51,27 @@ func (d *DeliverInformation4) SetSettlementAmount(value, currency string) {
 	d.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation4) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation4) SetStampDutyIndicator(value string) {
 	d.StampDutyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (d *DeliverInformation4) SetStampDutyIndicatorFromBool(value bool) {
+	d.StampDutyIndicator = new(YesNoIndicator)
+	d.StampDutyIndicator.FromBool(value)
+}
+
 func (d *DeliverInformation4) SetNetAmount(value, currency string) {
 	d.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DeliverInformation4) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DeliverInformation4) AddChargeDetails() *Charge20 {
 	newValue := new(Charge20)
 	d.ChargeDetails = append(d.ChargeDetails, newValue)
@@ -498,6 +519,11 @@ func (d *DeliverInformation4) SetPhysicalTransferIndicator(value string) {
 	d.PhysicalTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (d *DeliverInformation4) SetPhysicalTransferIndicatorFromBool(value bool) {
+	d.PhysicalTransferIndicator = new(YesNoIndicator)
+	d.PhysicalTransferIndicator.FromBool(value)
+}
+
 func (d *DeliverInformation4) AddPhysicalTransferDetails() *DeliveryParameters4 {
 	d.PhysicalTransferDetails = new(DeliveryParameters4)
 	return d.PhysicalTransferDetails
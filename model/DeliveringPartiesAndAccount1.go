@@ -22,6 +22,10 @@ type DeliveringPartiesAndAccount1 struct {
 	PlaceOfSettlementDetails *PartyIdentificationAndAccount2 `xml:"PlcOfSttlmDtls"`
 }
 
+func (d *DeliveringPartiesAndAccount1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveringPartiesAndAccount1) AddDelivererDetails() *InvestmentAccount11 {
 	d.DelivererDetails = new(InvestmentAccount11)
 	return d.DelivererDetails
@@ -13,6 +13,10 @@ type DeliveringPartiesAndAccount10 struct {
 	Party2 *PartyIdentificationAndAccount77 `xml:"Pty2,omitempty"`
 }
 
+func (d *DeliveringPartiesAndAccount10) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveringPartiesAndAccount10) AddDepository() *PartyIdentification34Choice {
 	d.Depository = new(PartyIdentification34Choice)
 	return d.Depository
@@ -16,6 +16,10 @@ type DeliveringPartiesAndAccount11 struct {
 	SecuritiesSettlementSystem *Max35Text `xml:"SctiesSttlmSys,omitempty"`
 }
 
+func (d *DeliveringPartiesAndAccount11) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveringPartiesAndAccount11) AddDepository() *PartyIdentification34Choice {
 	d.Depository = new(PartyIdentification34Choice)
 	return d.Depository
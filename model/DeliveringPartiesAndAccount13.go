@@ -25,6 +25,10 @@ type DeliveringPartiesAndAccount13 struct {
 	PlaceOfSettlementDetails *PartyIdentification97 `xml:"PlcOfSttlmDtls,omitempty"`
 }
 
+func (d *DeliveringPartiesAndAccount13) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveringPartiesAndAccount13) AddDelivererDetails() *InvestmentAccount55 {
 	d.DelivererDetails = new(InvestmentAccount55)
 	return d.DelivererDetails
@@ -22,6 +22,10 @@ type DeliveringPartiesAndAccount14 struct {
 	PlaceOfSettlementDetails *PartyIdentification97 `xml:"PlcOfSttlmDtls,omitempty"`
 }
 
+func (d *DeliveringPartiesAndAccount14) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveringPartiesAndAccount14) AddDeliverersCustodianDetails() *PartyIdentificationAndAccount124 {
 	d.DeliverersCustodianDetails = new(PartyIdentificationAndAccount124)
 	return d.DeliverersCustodianDetails
@@ -13,6 +13,10 @@ type DeliveringPartiesAndAccount15 struct {
 	Party2 *PartyIdentificationAndAccount127 `xml:"Pty2,omitempty"`
 }
 
+func (d *DeliveringPartiesAndAccount15) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveringPartiesAndAccount15) AddDepository() *PartyIdentification102Choice {
 	d.Depository = new(PartyIdentification102Choice)
 	return d.Depository
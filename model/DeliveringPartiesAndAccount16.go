@@ -16,6 +16,10 @@ type DeliveringPartiesAndAccount16 struct {
 	DeliveringAgentDetails *PartyIdentificationAndAccount147 `xml:"DlvrgAgtDtls"`
 }
 
+func (d *DeliveringPartiesAndAccount16) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveringPartiesAndAccount16) AddDeliverersCustodianDetails() *PartyIdentificationAndAccount147 {
 	d.DeliverersCustodianDetails = new(PartyIdentificationAndAccount147)
 	return d.DeliverersCustodianDetails
@@ -13,6 +13,10 @@ type DeliveringPartiesAndAccount3 struct {
 	DeliveringAgentDetails *PartyIdentificationAndAccount3 `xml:"DlvrgAgtDtls"`
 }
 
+func (d *DeliveringPartiesAndAccount3) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveringPartiesAndAccount3) AddDeliverersCustodianDetails() *PartyIdentificationAndAccount3 {
 	d.DeliverersCustodianDetails = new(PartyIdentificationAndAccount3)
 	return d.DeliverersCustodianDetails
@@ -22,6 +22,10 @@ type DeliveringPartiesAndAccount4 struct {
 	PlaceOfSettlementDetails *PartyIdentification21 `xml:"PlcOfSttlmDtls"`
 }
 
+func (d *DeliveringPartiesAndAccount4) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveringPartiesAndAccount4) AddDelivererDetails() *InvestmentAccount24 {
 	d.DelivererDetails = new(InvestmentAccount24)
 	return d.DelivererDetails
@@ -16,6 +16,10 @@ type DeliveringPartiesAndAccount7 struct {
 	SecuritiesSettlementSystem *Max35Text `xml:"SctiesSttlmSys,omitempty"`
 }
 
+func (d *DeliveringPartiesAndAccount7) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveringPartiesAndAccount7) AddDepository() *PartyIdentification34Choice {
 	d.Depository = new(PartyIdentification34Choice)
 	return d.Depository
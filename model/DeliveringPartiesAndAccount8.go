@@ -22,6 +22,10 @@ type DeliveringPartiesAndAccount8 struct {
 	PlaceOfSettlementDetails *PartyIdentification21 `xml:"PlcOfSttlmDtls,omitempty"`
 }
 
+func (d *DeliveringPartiesAndAccount8) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveringPartiesAndAccount8) AddDelivererDetails() *InvestmentAccount24 {
 	d.DelivererDetails = new(InvestmentAccount24)
 	return d.DelivererDetails
@@ -22,6 +22,10 @@ type DeliveringPartiesAndAccount9 struct {
 	PlaceOfSettlementDetails *PartyIdentification21 `xml:"PlcOfSttlmDtls,omitempty"`
 }
 
+func (d *DeliveringPartiesAndAccount9) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveringPartiesAndAccount9) AddDelivererDetails() *InvestmentAccount41 {
 	d.DelivererDetails = new(InvestmentAccount41)
 	return d.DelivererDetails
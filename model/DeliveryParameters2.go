@@ -10,10 +10,19 @@ type DeliveryParameters2 struct {
 	NameAndAddress *NameAndAddress1 `xml:"NmAndAdr,omitempty"`
 }
 
+func (d *DeliveryParameters2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveryParameters2) SetRegisteredAddressIndicator(value string) {
 	d.RegisteredAddressIndicator = (*YesNoIndicator)(&value)
 }
 
+func (d *DeliveryParameters2) SetRegisteredAddressIndicatorFromBool(value bool) {
+	d.RegisteredAddressIndicator = new(YesNoIndicator)
+	d.RegisteredAddressIndicator.FromBool(value)
+}
+
 func (d *DeliveryParameters2) AddNameAndAddress() *NameAndAddress1 {
 	d.NameAndAddress = new(NameAndAddress1)
 	return d.NameAndAddress
@@ -10,6 +10,10 @@ type DeliveryParameters3 struct {
 	IssuedCertificateNumber *Max35Text `xml:"IssdCertNb,omitempty"`
 }
 
+func (d *DeliveryParameters3) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveryParameters3) AddAddress() *NameAndAddress4 {
 	d.Address = new(NameAndAddress4)
 	return d.Address
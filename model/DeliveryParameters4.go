@@ -13,10 +13,19 @@ type DeliveryParameters4 struct {
 	ContactPerson *ContactIdentification2 `xml:"CtctPrsn,omitempty"`
 }
 
+func (d *DeliveryParameters4) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveryParameters4) SetRegisteredAddressIndicator(value string) {
 	d.RegisteredAddressIndicator = (*YesNoIndicator)(&value)
 }
 
+func (d *DeliveryParameters4) SetRegisteredAddressIndicatorFromBool(value bool) {
+	d.RegisteredAddressIndicator = new(YesNoIndicator)
+	d.RegisteredAddressIndicator.FromBool(value)
+}
+
 func (d *DeliveryParameters4) AddNameAndAddress() *NameAndAddress4 {
 	d.NameAndAddress = new(NameAndAddress4)
 	return d.NameAndAddress
@@ -1,3 +1,26 @@
 package model
 
 type DeliveryReceiptType2Code string
+
+const (
+	DeliveryReceiptType2CodeFREE DeliveryReceiptType2Code = "FREE"
+	DeliveryReceiptType2CodeAPMT DeliveryReceiptType2Code = "APMT"
+)
+
+var deliveryReceiptType2CodeDescriptions = map[DeliveryReceiptType2Code]string{
+	DeliveryReceiptType2CodeFREE: "Free of payment: delivery/receipt without payment.",
+	DeliveryReceiptType2CodeAPMT: "Against payment: delivery/receipt against payment.",
+}
+
+func (d DeliveryReceiptType2Code) IsValid() bool {
+	_, ok := deliveryReceiptType2CodeDescriptions[d]
+	return ok
+}
+
+func (d DeliveryReceiptType2Code) Description() string {
+	return deliveryReceiptType2CodeDescriptions[d]
+}
+
+func (d DeliveryReceiptType2Code) Validate() error {
+	return validateEnumeration("DeliveryReceiptType2Code", string(d), d.IsValid(), "FREE, APMT")
+}
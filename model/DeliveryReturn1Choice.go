@@ -10,6 +10,10 @@ type DeliveryReturn1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DeliveryReturn1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveryReturn1Choice) SetCode(value string) {
 	d.Code = (*DeliveryReturn1Code)(&value)
 }
@@ -10,6 +10,10 @@ type DeliveryReturn3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (d *DeliveryReturn3Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveryReturn3Choice) SetCode(value string) {
 	d.Code = (*DeliveryReturn1Code)(&value)
 }
@@ -10,6 +10,10 @@ type DeliveryReturn4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (d *DeliveryReturn4Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeliveryReturn4Choice) SetCode(value string) {
 	d.Code = (*DeliveryReturn1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Details of the demand.
 type Demand1 struct {
 
@@ -40,6 +44,10 @@ type Demand1 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (d *Demand1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *Demand1) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -225,6 +233,11 @@ func (d *Demand1) SetRequestedExpiryDate(value string) {
 	d.RequestedExpiryDate = (*ISODate)(&value)
 }
 
+func (d *Demand1) SetRequestedExpiryDateFromTime(value time.Time) {
+	d.RequestedExpiryDate = new(ISODate)
+	d.RequestedExpiryDate.FromTime(value)
+}
+
 func (d *Demand1) AddDemandDocumentation() *DemandDocumentation1 {
 	d.DemandDocumentation = new(DemandDocumentation1)
 	return d.DemandDocumentation
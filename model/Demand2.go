@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about the demand.
 type Demand2 struct {
 
@@ -16,6 +22,10 @@ type Demand2 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (d *Demand2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *Demand2) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -24,10 +34,19 @@ func (d *Demand2) SetSubmissionDateTime(value string) {
 	d.SubmissionDateTime = (*ISODateTime)(&value)
 }
 
+func (d *Demand2) SetSubmissionDateTimeFromTime(value time.Time) {
+	d.SubmissionDateTime = new(ISODateTime)
+	d.SubmissionDateTime.FromTime(value)
+}
+
 func (d *Demand2) SetAmount(value, currency string) {
 	d.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *Demand2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *Demand2) AddAdditionalInformation(value string) {
 	d.AdditionalInformation = append(d.AdditionalInformation, (*Max2000Text)(&value))
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details related to the demand.
 type Demand3 struct {
 
@@ -13,6 +19,10 @@ type Demand3 struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (d *Demand3) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *Demand3) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -21,6 +31,15 @@ func (d *Demand3) SetSubmissionDateTime(value string) {
 	d.SubmissionDateTime = (*ISODateTime)(&value)
 }
 
+func (d *Demand3) SetSubmissionDateTimeFromTime(value time.Time) {
+	d.SubmissionDateTime = new(ISODateTime)
+	d.SubmissionDateTime.FromTime(value)
+}
+
 func (d *Demand3) SetAmount(value, currency string) {
 	d.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (d *Demand3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about a demand.
 type Demand4 struct {
 
@@ -10,6 +14,10 @@ type Demand4 struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (d *Demand4) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *Demand4) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -17,3 +25,7 @@ func (d *Demand4) SetIdentification(value string) {
 func (d *Demand4) SetAmount(value, currency string) {
 	d.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (d *Demand4) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -16,10 +16,19 @@ type DemandDocumentation1 struct {
 	DemandNarrative *Max20000Text `xml:"DmndNrrtv,omitempty"`
 }
 
+func (d *DemandDocumentation1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DemandDocumentation1) SetCompleteIndicator(value string) {
 	d.CompleteIndicator = (*YesNoIndicator)(&value)
 }
 
+func (d *DemandDocumentation1) SetCompleteIndicatorFromBool(value bool) {
+	d.CompleteIndicator = new(YesNoIndicator)
+	d.CompleteIndicator.FromBool(value)
+}
+
 func (d *DemandDocumentation1) SetCompletionInformation(value string) {
 	d.CompletionInformation = (*Max2000Text)(&value)
 }
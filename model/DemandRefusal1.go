@@ -31,6 +31,10 @@ type DemandRefusal1 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (d *DemandRefusal1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DemandRefusal1) AddUndertakingIdentification() *Undertaking9 {
 	d.UndertakingIdentification = new(Undertaking9)
 	return d.UndertakingIdentification
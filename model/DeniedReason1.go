@@ -10,6 +10,10 @@ type DeniedReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (d *DeniedReason1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason1) AddCode() *DeniedReason3Choice {
 	d.Code = new(DeniedReason3Choice)
 	return d.Code
@@ -10,6 +10,10 @@ type DeniedReason10 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (d *DeniedReason10) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason10) AddCode() *DeniedReason15Choice {
 	d.Code = new(DeniedReason15Choice)
 	return d.Code
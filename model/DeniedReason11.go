@@ -10,6 +10,10 @@ type DeniedReason11 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (d *DeniedReason11) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason11) AddCode() *DeniedReason16Choice {
 	d.Code = new(DeniedReason16Choice)
 	return d.Code
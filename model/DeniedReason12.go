@@ -10,6 +10,10 @@ type DeniedReason12 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (d *DeniedReason12) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason12) AddCode() *DeniedReason17Choice {
 	d.Code = new(DeniedReason17Choice)
 	return d.Code
@@ -10,6 +10,10 @@ type DeniedReason13 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (d *DeniedReason13) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason13) AddCode() *DeniedReason18Choice {
 	d.Code = new(DeniedReason18Choice)
 	return d.Code
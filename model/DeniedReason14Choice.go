@@ -10,6 +10,10 @@ type DeniedReason14Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (d *DeniedReason14Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason14Choice) SetCode(value string) {
 	d.Code = (*DeniedReason7Code)(&value)
 }
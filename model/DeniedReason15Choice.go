@@ -10,6 +10,10 @@ type DeniedReason15Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (d *DeniedReason15Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason15Choice) SetCode(value string) {
 	d.Code = (*DeniedReason6Code)(&value)
 }
@@ -10,6 +10,10 @@ type DeniedReason16 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (d *DeniedReason16) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason16) AddCode() *DeniedReason21Choice {
 	d.Code = new(DeniedReason21Choice)
 	return d.Code
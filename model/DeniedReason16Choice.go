@@ -10,6 +10,10 @@ type DeniedReason16Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (d *DeniedReason16Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason16Choice) SetCode(value string) {
 	d.Code = (*DeniedReason4Code)(&value)
 }
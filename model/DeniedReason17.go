@@ -10,6 +10,10 @@ type DeniedReason17 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (d *DeniedReason17) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason17) AddCode() *DeniedReason24Choice {
 	d.Code = new(DeniedReason24Choice)
 	return d.Code
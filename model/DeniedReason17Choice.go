@@ -10,6 +10,10 @@ type DeniedReason17Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (d *DeniedReason17Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason17Choice) SetCode(value string) {
 	d.Code = (*DeniedReason3Code)(&value)
 }
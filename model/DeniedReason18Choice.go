@@ -10,6 +10,10 @@ type DeniedReason18Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (d *DeniedReason18Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason18Choice) SetCode(value string) {
 	d.Code = (*DeniedReason3Code)(&value)
 }
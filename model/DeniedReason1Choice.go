@@ -10,6 +10,10 @@ type DeniedReason1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DeniedReason1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason1Choice) SetCode(value string) {
 	d.Code = (*DeniedReason5Code)(&value)
 }
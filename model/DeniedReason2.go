@@ -10,6 +10,10 @@ type DeniedReason2 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (d *DeniedReason2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason2) AddCode() *DeniedReason2Choice {
 	d.Code = new(DeniedReason2Choice)
 	return d.Code
@@ -10,6 +10,10 @@ type DeniedReason21Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (d *DeniedReason21Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason21Choice) SetCode(value string) {
 	d.Code = (*DeniedReason4Code)(&value)
 }
@@ -10,6 +10,10 @@ type DeniedReason23Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (d *DeniedReason23Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason23Choice) SetCode(value string) {
 	d.Code = (*DeniedReason7Code)(&value)
 }
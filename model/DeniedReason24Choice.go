@@ -10,6 +10,10 @@ type DeniedReason24Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (d *DeniedReason24Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason24Choice) SetCode(value string) {
 	d.Code = (*DeniedReason6Code)(&value)
 }
@@ -10,6 +10,10 @@ type DeniedReason2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DeniedReason2Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason2Choice) SetCode(value string) {
 	d.Code = (*DeniedReason4Code)(&value)
 }
@@ -10,6 +10,10 @@ type DeniedReason3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DeniedReason3Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason3Choice) SetCode(value string) {
 	d.Code = (*DeniedReason3Code)(&value)
 }
@@ -10,6 +10,10 @@ type DeniedReason5 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (d *DeniedReason5) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason5) AddCode() *DeniedReason7Choice {
 	d.Code = new(DeniedReason7Choice)
 	return d.Code
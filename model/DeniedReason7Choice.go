@@ -10,6 +10,10 @@ type DeniedReason7Choice struct {
 	Proprietary *GenericIdentification40 `xml:"Prtry"`
 }
 
+func (d *DeniedReason7Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason7Choice) SetCode(value string) {
 	d.Code = (*DeniedReason6Code)(&value)
 }
@@ -10,6 +10,10 @@ type DeniedReason8Choice struct {
 	Proprietary *GenericIdentification40 `xml:"Prtry"`
 }
 
+func (d *DeniedReason8Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DeniedReason8Choice) SetCode(value string) {
 	d.Code = (*DeniedReason7Code)(&value)
 }
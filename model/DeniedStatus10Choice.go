@@ -10,8 +10,16 @@ type DeniedStatus10Choice struct {
 	Reason []*DeniedReason5 `xml:"Rsn"`
 }
 
-func (d *DeniedStatus10Choice) SetNoSpecifiedReason(value string) {
+func (d *DeniedStatus10Choice) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DeniedStatus10Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	d.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (d *DeniedStatus10Choice) AddReason() *DeniedReason5 {
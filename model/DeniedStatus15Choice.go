@@ -10,8 +10,16 @@ type DeniedStatus15Choice struct {
 	Reason []*DeniedReason10 `xml:"Rsn"`
 }
 
-func (d *DeniedStatus15Choice) SetNoSpecifiedReason(value string) {
+func (d *DeniedStatus15Choice) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DeniedStatus15Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	d.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (d *DeniedStatus15Choice) AddReason() *DeniedReason10 {
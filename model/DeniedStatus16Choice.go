@@ -10,8 +10,16 @@ type DeniedStatus16Choice struct {
 	Reason []*DeniedReason11 `xml:"Rsn"`
 }
 
-func (d *DeniedStatus16Choice) SetNoSpecifiedReason(value string) {
+func (d *DeniedStatus16Choice) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DeniedStatus16Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	d.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (d *DeniedStatus16Choice) AddReason() *DeniedReason11 {
@@ -10,8 +10,16 @@ type DeniedStatus17Choice struct {
 	Reason []*DeniedReason12 `xml:"Rsn"`
 }
 
-func (d *DeniedStatus17Choice) SetNoSpecifiedReason(value string) {
+func (d *DeniedStatus17Choice) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DeniedStatus17Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	d.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (d *DeniedStatus17Choice) AddReason() *DeniedReason12 {
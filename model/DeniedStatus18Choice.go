@@ -10,8 +10,16 @@ type DeniedStatus18Choice struct {
 	Reason []*DeniedReason13 `xml:"Rsn"`
 }
 
-func (d *DeniedStatus18Choice) SetNoSpecifiedReason(value string) {
+func (d *DeniedStatus18Choice) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DeniedStatus18Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	d.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (d *DeniedStatus18Choice) AddReason() *DeniedReason13 {
@@ -10,8 +10,16 @@ type DeniedStatus19Choice struct {
 	Reason []*DeniedReason17 `xml:"Rsn"`
 }
 
-func (d *DeniedStatus19Choice) SetNoSpecifiedReason(value string) {
+func (d *DeniedStatus19Choice) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DeniedStatus19Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	d.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (d *DeniedStatus19Choice) AddReason() *DeniedReason17 {
@@ -10,8 +10,16 @@ type DeniedStatus1Choice struct {
 	Reason []*DeniedReason1 `xml:"Rsn,omitempty"`
 }
 
-func (d *DeniedStatus1Choice) SetNoSpecifiedReason(value string) {
+func (d *DeniedStatus1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DeniedStatus1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	d.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (d *DeniedStatus1Choice) AddReason() *DeniedReason1 {
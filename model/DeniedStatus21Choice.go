@@ -10,8 +10,16 @@ type DeniedStatus21Choice struct {
 	Reason []*DeniedReason16 `xml:"Rsn"`
 }
 
-func (d *DeniedStatus21Choice) SetNoSpecifiedReason(value string) {
+func (d *DeniedStatus21Choice) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DeniedStatus21Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	d.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (d *DeniedStatus21Choice) AddReason() *DeniedReason16 {
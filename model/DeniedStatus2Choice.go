@@ -10,8 +10,16 @@ type DeniedStatus2Choice struct {
 	Reason []*DeniedReason2 `xml:"Rsn,omitempty"`
 }
 
-func (d *DeniedStatus2Choice) SetNoSpecifiedReason(value string) {
+func (d *DeniedStatus2Choice) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DeniedStatus2Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	d.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (d *DeniedStatus2Choice) AddReason() *DeniedReason2 {
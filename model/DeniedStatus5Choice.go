@@ -10,8 +10,16 @@ type DeniedStatus5Choice struct {
 	Reason []*DeniedReason1 `xml:"Rsn"`
 }
 
-func (d *DeniedStatus5Choice) SetNoSpecifiedReason(value string) {
+func (d *DeniedStatus5Choice) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DeniedStatus5Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	d.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (d *DeniedStatus5Choice) AddReason() *DeniedReason1 {
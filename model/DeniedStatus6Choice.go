@@ -10,8 +10,16 @@ type DeniedStatus6Choice struct {
 	Reason []*DeniedReason2 `xml:"Rsn"`
 }
 
-func (d *DeniedStatus6Choice) SetNoSpecifiedReason(value string) {
+func (d *DeniedStatus6Choice) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DeniedStatus6Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	d.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (d *DeniedStatus6Choice) AddReason() *DeniedReason2 {
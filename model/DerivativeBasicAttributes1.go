@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the derivative contract not included in the general financial instrument attributes component.
 type DerivativeBasicAttributes1 struct {
 
@@ -10,10 +14,23 @@ type DerivativeBasicAttributes1 struct {
 	InterestIncludedInPrice *YesNoIndicator `xml:"IntrstInclInPric,omitempty"`
 }
 
+func (d *DerivativeBasicAttributes1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DerivativeBasicAttributes1) SetNotionalCurrencyAndAmount(value, currency string) {
 	d.NotionalCurrencyAndAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (d *DerivativeBasicAttributes1) SetNotionalCurrencyAndAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.NotionalCurrencyAndAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DerivativeBasicAttributes1) SetInterestIncludedInPrice(value string) {
 	d.InterestIncludedInPrice = (*YesNoIndicator)(&value)
 }
+
+func (d *DerivativeBasicAttributes1) SetInterestIncludedInPriceFromBool(value bool) {
+	d.InterestIncludedInPrice = new(YesNoIndicator)
+	d.InterestIncludedInPrice.FromBool(value)
+}
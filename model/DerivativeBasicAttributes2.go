@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the derivative contract not included in the general financial instrument attributes component.
 type DerivativeBasicAttributes2 struct {
 
@@ -10,10 +14,23 @@ type DerivativeBasicAttributes2 struct {
 	InterestIncludedInPrice *YesNoIndicator `xml:"IntrstInclInPric,omitempty"`
 }
 
+func (d *DerivativeBasicAttributes2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DerivativeBasicAttributes2) SetNotionalCurrencyAndAmount(value, currency string) {
 	d.NotionalCurrencyAndAmount = NewRestrictedFINActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (d *DerivativeBasicAttributes2) SetNotionalCurrencyAndAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.NotionalCurrencyAndAmount = NewRestrictedFINActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DerivativeBasicAttributes2) SetInterestIncludedInPrice(value string) {
 	d.InterestIncludedInPrice = (*YesNoIndicator)(&value)
 }
+
+func (d *DerivativeBasicAttributes2) SetInterestIncludedInPriceFromBool(value bool) {
+	d.InterestIncludedInPrice = new(YesNoIndicator)
+	d.InterestIncludedInPrice.FromBool(value)
+}
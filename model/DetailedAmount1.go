@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Detailed amounts associated with the total amount of transaction.
 type DetailedAmount1 struct {
 
@@ -10,6 +14,10 @@ type DetailedAmount1 struct {
 	Value *ImpliedCurrencyAndAmount `xml:"Val"`
 }
 
+func (d *DetailedAmount1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount1) SetType(value string) {
 	d.Type = (*TypeOfAmount2Code)(&value)
 }
@@ -17,3 +25,7 @@ func (d *DetailedAmount1) SetType(value string) {
 func (d *DetailedAmount1) SetValue(value, currency string) {
 	d.Value = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (d *DetailedAmount1) SetValueFromDecimal(value decimal.Decimal, currency string) {
+	d.Value = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Additional amounts from the processor or the issuer without financial impacts on the transaction amount.
 type DetailedAmount10 struct {
 
@@ -16,6 +20,10 @@ type DetailedAmount10 struct {
 	Label *Max35Text `xml:"Labl,omitempty"`
 }
 
+func (d *DetailedAmount10) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount10) SetType(value string) {
 	d.Type = (*TypeOfAmount6Code)(&value)
 }
@@ -28,6 +36,10 @@ func (d *DetailedAmount10) SetAmount(value, currency string) {
 	d.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount10) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount10) SetLabel(value string) {
 	d.Label = (*Max35Text)(&value)
 }
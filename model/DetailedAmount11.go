@@ -16,6 +16,10 @@ type DetailedAmount11 struct {
 	OriginalAmount *AmountAndDirection41 `xml:"OrgnlAmt,omitempty"`
 }
 
+func (d *DetailedAmount11) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount11) SetType(value string) {
 	d.Type = (*TypeOfAmount7Code)(&value)
 }
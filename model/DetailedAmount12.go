@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amounts of the withdrawal transaction.
 type DetailedAmount12 struct {
 
@@ -16,10 +20,18 @@ type DetailedAmount12 struct {
 	Donation []*DetailedAmount13 `xml:"Dontn,omitempty"`
 }
 
+func (d *DetailedAmount12) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount12) SetAmountToDispense(value, currency string) {
 	d.AmountToDispense = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount12) SetAmountToDispenseFromDecimal(value decimal.Decimal, currency string) {
+	d.AmountToDispense = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount12) SetCurrency(value string) {
 	d.Currency = (*ActiveCurrencyCode)(&value)
 }
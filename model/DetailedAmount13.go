@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Withdrawal fees, accepted by the customer.
 type DetailedAmount13 struct {
 
@@ -13,10 +17,18 @@ type DetailedAmount13 struct {
 	Label *Max70Text `xml:"Labl,omitempty"`
 }
 
+func (d *DetailedAmount13) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount13) SetAmount(value, currency string) {
 	d.Amount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount13) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.Amount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount13) SetCurrency(value string) {
 	d.Currency = (*ActiveCurrencyCode)(&value)
 }
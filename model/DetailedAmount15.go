@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Detailed amounts associated with the total amount of transaction.
 type DetailedAmount15 struct {
 
@@ -25,18 +29,34 @@ type DetailedAmount15 struct {
 	Surcharge []*DetailedAmount4 `xml:"Srchrg,omitempty"`
 }
 
+func (d *DetailedAmount15) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount15) SetAmountGoodsAndServices(value, currency string) {
 	d.AmountGoodsAndServices = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount15) SetAmountGoodsAndServicesFromDecimal(value decimal.Decimal, currency string) {
+	d.AmountGoodsAndServices = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount15) SetCashBack(value, currency string) {
 	d.CashBack = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount15) SetCashBackFromDecimal(value decimal.Decimal, currency string) {
+	d.CashBack = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount15) SetGratuity(value, currency string) {
 	d.Gratuity = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount15) SetGratuityFromDecimal(value decimal.Decimal, currency string) {
+	d.Gratuity = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount15) AddFees() *DetailedAmount4 {
 	newValue := new(DetailedAmount4)
 	d.Fees = append(d.Fees, newValue)
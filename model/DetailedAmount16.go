@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amounts of the deposit transaction.
 type DetailedAmount16 struct {
 
@@ -22,14 +26,27 @@ type DetailedAmount16 struct {
 	Donation []*DetailedAmount13 `xml:"Dontn,omitempty"`
 }
 
+func (d *DetailedAmount16) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount16) SetAccountSequenceNumber(value string) {
 	d.AccountSequenceNumber = (*Number)(&value)
 }
 
+func (d *DetailedAmount16) SetAccountSequenceNumberFromDecimal(value decimal.Decimal) {
+	d.AccountSequenceNumber = new(Number)
+	d.AccountSequenceNumber.FromDecimal(value)
+}
+
 func (d *DetailedAmount16) SetAmountToDeposit(value, currency string) {
 	d.AmountToDeposit = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount16) SetAmountToDepositFromDecimal(value decimal.Decimal, currency string) {
+	d.AmountToDeposit = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount16) SetCurrency(value string) {
 	d.Currency = (*ActiveCurrencyCode)(&value)
 }
@@ -38,6 +55,10 @@ func (d *DetailedAmount16) SetCashBackAmount(value, currency string) {
 	d.CashBackAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount16) SetCashBackAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.CashBackAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount16) AddFees() *DetailedAmount13 {
 	newValue := new(DetailedAmount13)
 	d.Fees = append(d.Fees, newValue)
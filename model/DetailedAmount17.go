@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transfer transaction amounts.
 type DetailedAmount17 struct {
 
@@ -16,10 +20,18 @@ type DetailedAmount17 struct {
 	Donation []*DetailedAmount18 `xml:"Dontn,omitempty"`
 }
 
+func (d *DetailedAmount17) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount17) SetAmountToTransfer(value, currency string) {
 	d.AmountToTransfer = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount17) SetAmountToTransferFromDecimal(value decimal.Decimal, currency string) {
+	d.AmountToTransfer = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount17) SetCurrency(value string) {
 	d.Currency = (*ActiveCurrencyCode)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Withdrawal fees, accepted by the customer.
 type DetailedAmount18 struct {
 
@@ -16,10 +20,18 @@ type DetailedAmount18 struct {
 	Label *Max70Text `xml:"Labl,omitempty"`
 }
 
+func (d *DetailedAmount18) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount18) SetAmount(value, currency string) {
 	d.Amount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount18) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.Amount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount18) SetCurrency(value string) {
 	d.Currency = (*ActiveCurrencyCode)(&value)
 }
@@ -28,6 +40,11 @@ func (d *DetailedAmount18) SetChargeAccountTo(value string) {
 	d.ChargeAccountTo = (*TrueFalseIndicator)(&value)
 }
 
+func (d *DetailedAmount18) SetChargeAccountToFromBool(value bool) {
+	d.ChargeAccountTo = new(TrueFalseIndicator)
+	d.ChargeAccountTo.FromBool(value)
+}
+
 func (d *DetailedAmount18) SetLabel(value string) {
 	d.Label = (*Max70Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Detailed amounts associated with the total amount of transaction.
 type DetailedAmount2 struct {
 
@@ -13,6 +17,10 @@ type DetailedAmount2 struct {
 	Label *Max35Text `xml:"Labl,omitempty"`
 }
 
+func (d *DetailedAmount2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount2) SetType(value string) {
 	d.Type = (*TypeOfAmount2Code)(&value)
 }
@@ -21,6 +29,10 @@ func (d *DetailedAmount2) SetValue(value, currency string) {
 	d.Value = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount2) SetValueFromDecimal(value decimal.Decimal, currency string) {
+	d.Value = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount2) SetLabel(value string) {
 	d.Label = (*Max35Text)(&value)
 }
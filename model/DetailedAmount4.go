@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Detailed amounts associated with the total amount of transaction.
 type DetailedAmount4 struct {
 
@@ -10,10 +14,18 @@ type DetailedAmount4 struct {
 	Label *Max140Text `xml:"Labl,omitempty"`
 }
 
+func (d *DetailedAmount4) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount4) SetAmount(value, currency string) {
 	d.Amount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount4) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.Amount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount4) SetLabel(value string) {
 	d.Label = (*Max140Text)(&value)
 }
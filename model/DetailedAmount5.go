@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Detailed amounts associated with the total amount of transaction.
 type DetailedAmount5 struct {
 
@@ -19,14 +23,26 @@ type DetailedAmount5 struct {
 	ValueAddedTax []*DetailedAmount4 `xml:"ValAddedTax,omitempty"`
 }
 
+func (d *DetailedAmount5) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount5) SetCashBack(value, currency string) {
 	d.CashBack = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount5) SetCashBackFromDecimal(value decimal.Decimal, currency string) {
+	d.CashBack = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount5) SetGratuity(value, currency string) {
 	d.Gratuity = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount5) SetGratuityFromDecimal(value decimal.Decimal, currency string) {
+	d.Gratuity = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount5) AddFees() *DetailedAmount4 {
 	newValue := new(DetailedAmount4)
 	d.Fees = append(d.Fees, newValue)
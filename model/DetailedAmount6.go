@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Detailed amounts associated with the total amount of transaction.
 type DetailedAmount6 struct {
 
@@ -19,14 +25,27 @@ type DetailedAmount6 struct {
 	Label *Max140Text `xml:"Labl,omitempty"`
 }
 
+func (d *DetailedAmount6) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount6) SetAmount(value, currency string) {
 	d.Amount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount6) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.Amount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount6) SetDateTime(value string) {
 	d.DateTime = (*ISODateTime)(&value)
 }
 
+func (d *DetailedAmount6) SetDateTimeFromTime(value time.Time) {
+	d.DateTime = new(ISODateTime)
+	d.DateTime.FromTime(value)
+}
+
 func (d *DetailedAmount6) SetCardDataEntryMode(value string) {
 	d.CardDataEntryMode = (*CardDataReading1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Detailed amounts associated with the total amount of transaction.
 type DetailedAmount7 struct {
 
@@ -22,14 +26,26 @@ type DetailedAmount7 struct {
 	Surcharge []*DetailedAmount4 `xml:"Srchrg,omitempty"`
 }
 
+func (d *DetailedAmount7) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount7) SetCashBack(value, currency string) {
 	d.CashBack = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount7) SetCashBackFromDecimal(value decimal.Decimal, currency string) {
+	d.CashBack = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount7) SetGratuity(value, currency string) {
 	d.Gratuity = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount7) SetGratuityFromDecimal(value decimal.Decimal, currency string) {
+	d.Gratuity = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount7) AddFees() *DetailedAmount4 {
 	newValue := new(DetailedAmount4)
 	d.Fees = append(d.Fees, newValue)
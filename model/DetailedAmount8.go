@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Detailed amounts associated with the total amount of transaction.
 type DetailedAmount8 struct {
 
@@ -18,18 +24,36 @@ type DetailedAmount8 struct {
 	Label *Max140Text `xml:"Labl,omitempty"`
 }
 
+func (d *DetailedAmount8) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount8) SetAmount(value, currency string) {
 	d.Amount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount8) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.Amount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount8) SetExchangeRate(value string) {
 	d.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (d *DetailedAmount8) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	d.ExchangeRate = new(BaseOneRate)
+	d.ExchangeRate.FromDecimal(value)
+}
+
 func (d *DetailedAmount8) SetQuotationDate(value string) {
 	d.QuotationDate = (*ISODateTime)(&value)
 }
 
+func (d *DetailedAmount8) SetQuotationDateFromTime(value time.Time) {
+	d.QuotationDate = new(ISODateTime)
+	d.QuotationDate.FromTime(value)
+}
+
 func (d *DetailedAmount8) SetLabel(value string) {
 	d.Label = (*Max140Text)(&value)
 }
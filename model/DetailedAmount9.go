@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Detailed amounts associated with the total amount of transaction.
 type DetailedAmount9 struct {
 
@@ -16,6 +20,10 @@ type DetailedAmount9 struct {
 	Label *Max140Text `xml:"Labl,omitempty"`
 }
 
+func (d *DetailedAmount9) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedAmount9) SetType(value string) {
 	d.Type = (*TypeOfAmount5Code)(&value)
 }
@@ -28,6 +36,10 @@ func (d *DetailedAmount9) SetAmount(value, currency string) {
 	d.Amount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (d *DetailedAmount9) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.Amount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DetailedAmount9) SetLabel(value string) {
 	d.Label = (*Max140Text)(&value)
 }
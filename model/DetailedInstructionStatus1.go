@@ -16,6 +16,10 @@ type DetailedInstructionStatus1 struct {
 	InstructionStatus *InstructionStatus2Choice `xml:"InstrSts"`
 }
 
+func (d *DetailedInstructionStatus1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedInstructionStatus1) SetInstructionIdentification(value string) {
 	d.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type DetailedInstructionStatus10 struct {
 	VotePerResolution []*Vote6 `xml:"VotePerRsltn,omitempty"`
 }
 
+func (d *DetailedInstructionStatus10) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedInstructionStatus10) SetInstructionIdentification(value string) {
 	d.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -192,6 +196,11 @@ func (d *DetailedInstructionStatus10) SetStandingInstruction(value string) {
 	d.StandingInstruction = (*YesNoIndicator)(&value)
 }
 
+func (d *DetailedInstructionStatus10) SetStandingInstructionFromBool(value bool) {
+	d.StandingInstruction = new(YesNoIndicator)
+	d.StandingInstruction.FromBool(value)
+}
+
 func (d *DetailedInstructionStatus10) AddVotePerResolution() *Vote6 {
 	newValue := new(Vote6)
 	d.VotePerResolution = append(d.VotePerResolution, newValue)
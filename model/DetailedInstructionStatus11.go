@@ -16,6 +16,10 @@ type DetailedInstructionStatus11 struct {
 	InstructionStatus *InstructionStatus6Choice `xml:"InstrSts"`
 }
 
+func (d *DetailedInstructionStatus11) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedInstructionStatus11) SetInstructionIdentification(value string) {
 	d.InstructionIdentification = (*Max35Text)(&value)
 }
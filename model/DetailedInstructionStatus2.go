@@ -25,6 +25,10 @@ type DetailedInstructionStatus2 struct {
 	VotePerResolution []*Vote4 `xml:"VotePerRsltn"`
 }
 
+func (d *DetailedInstructionStatus2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedInstructionStatus2) SetInstructionIdentification(value string) {
 	d.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -192,6 +196,11 @@ func (d *DetailedInstructionStatus2) SetStandingInstruction(value string) {
 	d.StandingInstruction = (*YesNoIndicator)(&value)
 }
 
+func (d *DetailedInstructionStatus2) SetStandingInstructionFromBool(value bool) {
+	d.StandingInstruction = new(YesNoIndicator)
+	d.StandingInstruction.FromBool(value)
+}
+
 func (d *DetailedInstructionStatus2) AddVotePerResolution() *Vote4 {
 	newValue := new(Vote4)
 	d.VotePerResolution = append(d.VotePerResolution, newValue)
@@ -16,6 +16,10 @@ type DetailedInstructionStatus8 struct {
 	InstructionStatus *InstructionStatus4Choice `xml:"InstrSts"`
 }
 
+func (d *DetailedInstructionStatus8) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DetailedInstructionStatus8) SetInstructionIdentification(value string) {
 	d.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Digest computed on the identified data.
 type DigestedData1 struct {
 
@@ -16,10 +20,19 @@ type DigestedData1 struct {
 	Digest *Max140Text `xml:"Dgst"`
 }
 
+func (d *DigestedData1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DigestedData1) SetVersion(value string) {
 	d.Version = (*Number)(&value)
 }
 
+func (d *DigestedData1) SetVersionFromDecimal(value decimal.Decimal) {
+	d.Version = new(Number)
+	d.Version.FromDecimal(value)
+}
+
 func (d *DigestedData1) AddDigestAlgorithm() *AlgorithmIdentification1 {
 	newValue := new(AlgorithmIdentification1)
 	d.DigestAlgorithm = append(d.DigestAlgorithm, newValue)
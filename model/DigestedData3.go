@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Digest computed on the identified data.
 type DigestedData3 struct {
 
@@ -16,10 +20,19 @@ type DigestedData3 struct {
 	Digest *Max140Text `xml:"Dgst"`
 }
 
+func (d *DigestedData3) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DigestedData3) SetVersion(value string) {
 	d.Version = (*Number)(&value)
 }
 
+func (d *DigestedData3) SetVersionFromDecimal(value decimal.Decimal) {
+	d.Version = new(Number)
+	d.Version.FromDecimal(value)
+}
+
 func (d *DigestedData3) AddDigestAlgorithm() *AlgorithmIdentification5 {
 	newValue := new(AlgorithmIdentification5)
 	d.DigestAlgorithm = append(d.DigestAlgorithm, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Digest computed on the identified data.
 type DigestedData4 struct {
 
@@ -16,10 +20,19 @@ type DigestedData4 struct {
 	Digest *Max140Binary `xml:"Dgst"`
 }
 
+func (d *DigestedData4) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DigestedData4) SetVersion(value string) {
 	d.Version = (*Number)(&value)
 }
 
+func (d *DigestedData4) SetVersionFromDecimal(value decimal.Decimal) {
+	d.Version = new(Number)
+	d.Version.FromDecimal(value)
+}
+
 func (d *DigestedData4) AddDigestAlgorithm() *AlgorithmIdentification16 {
 	d.DigestAlgorithm = new(AlgorithmIdentification16)
 	return d.DigestAlgorithm
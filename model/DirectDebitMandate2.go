@@ -25,6 +25,10 @@ type DirectDebitMandate2 struct {
 	MandateIdentification *Max35Text `xml:"MndtId,omitempty"`
 }
 
+func (d *DirectDebitMandate2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitMandate2) AddDebtorAccountIdentification() *CashAccountIdentification1Choice {
 	d.DebtorAccountIdentification = new(CashAccountIdentification1Choice)
 	return d.DebtorAccountIdentification
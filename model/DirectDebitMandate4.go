@@ -41,6 +41,10 @@ type DirectDebitMandate4 struct {
 	MandateIdentification *Max35Text `xml:"MndtId,omitempty"`
 }
 
+func (d *DirectDebitMandate4) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitMandate4) AddDebtorAccount() *AccountIdentificationAndName3 {
 	d.DebtorAccount = new(AccountIdentificationAndName3)
 	return d.DebtorAccount
@@ -41,6 +41,10 @@ type DirectDebitMandate5 struct {
 	MandateIdentification *Max35Text `xml:"MndtId,omitempty"`
 }
 
+func (d *DirectDebitMandate5) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitMandate5) AddDebtorAccount() *AccountIdentificationAndName5 {
 	d.DebtorAccount = new(AccountIdentificationAndName5)
 	return d.DebtorAccount
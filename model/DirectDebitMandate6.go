@@ -41,6 +41,10 @@ type DirectDebitMandate6 struct {
 	MandateIdentification *Max35Text `xml:"MndtId,omitempty"`
 }
 
+func (d *DirectDebitMandate6) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitMandate6) AddDebtorAccount() *AccountIdentificationAndName5 {
 	d.DebtorAccount = new(AccountIdentificationAndName5)
 	return d.DebtorAccount
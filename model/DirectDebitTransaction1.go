@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements providing specific information on the direct debit transaction and the related mandate.
 type DirectDebitTransaction1 struct {
 
@@ -20,6 +24,10 @@ type DirectDebitTransaction1 struct {
 	PreNotificationDate *ISODate `xml:"PreNtfctnDt,omitempty"`
 }
 
+func (d *DirectDebitTransaction1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitTransaction1) AddMandateRelatedInformation() *MandateRelatedInformation1 {
 	d.MandateRelatedInformation = new(MandateRelatedInformation1)
 	return d.MandateRelatedInformation
@@ -37,3 +45,8 @@ func (d *DirectDebitTransaction1) SetPreNotificationIdentification(value string)
 func (d *DirectDebitTransaction1) SetPreNotificationDate(value string) {
 	d.PreNotificationDate = (*ISODate)(&value)
 }
+
+func (d *DirectDebitTransaction1) SetPreNotificationDateFromTime(value time.Time) {
+	d.PreNotificationDate = new(ISODate)
+	d.PreNotificationDate.FromTime(value)
+}
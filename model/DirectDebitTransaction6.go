@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to provide specific information on the direct debit transaction and the related mandate.
 type DirectDebitTransaction6 struct {
 
@@ -18,6 +22,10 @@ type DirectDebitTransaction6 struct {
 	PreNotificationDate *ISODate `xml:"PreNtfctnDt,omitempty"`
 }
 
+func (d *DirectDebitTransaction6) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitTransaction6) AddMandateRelatedInformation() *MandateRelatedInformation6 {
 	d.MandateRelatedInformation = new(MandateRelatedInformation6)
 	return d.MandateRelatedInformation
@@ -35,3 +43,8 @@ func (d *DirectDebitTransaction6) SetPreNotificationIdentification(value string)
 func (d *DirectDebitTransaction6) SetPreNotificationDate(value string) {
 	d.PreNotificationDate = (*ISODate)(&value)
 }
+
+func (d *DirectDebitTransaction6) SetPreNotificationDateFromTime(value time.Time) {
+	d.PreNotificationDate = new(ISODate)
+	d.PreNotificationDate.FromTime(value)
+}
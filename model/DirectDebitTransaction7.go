@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further specific details on the direct debit transaction and the related mandate.
 type DirectDebitTransaction7 struct {
 
@@ -18,6 +22,10 @@ type DirectDebitTransaction7 struct {
 	PreNotificationDate *ISODate `xml:"PreNtfctnDt,omitempty"`
 }
 
+func (d *DirectDebitTransaction7) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitTransaction7) AddMandateRelatedInformation() *MandateRelatedInformation8 {
 	d.MandateRelatedInformation = new(MandateRelatedInformation8)
 	return d.MandateRelatedInformation
@@ -35,3 +43,8 @@ func (d *DirectDebitTransaction7) SetPreNotificationIdentification(value string)
 func (d *DirectDebitTransaction7) SetPreNotificationDate(value string) {
 	d.PreNotificationDate = (*ISODate)(&value)
 }
+
+func (d *DirectDebitTransaction7) SetPreNotificationDateFromTime(value time.Time) {
+	d.PreNotificationDate = new(ISODate)
+	d.PreNotificationDate.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further specific details on the direct debit transaction and the related mandate.
 type DirectDebitTransaction8 struct {
 
@@ -18,6 +22,10 @@ type DirectDebitTransaction8 struct {
 	PreNotificationDate *ISODate `xml:"PreNtfctnDt,omitempty"`
 }
 
+func (d *DirectDebitTransaction8) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitTransaction8) AddMandateRelatedInformation() *MandateRelatedInformation10 {
 	d.MandateRelatedInformation = new(MandateRelatedInformation10)
 	return d.MandateRelatedInformation
@@ -35,3 +43,8 @@ func (d *DirectDebitTransaction8) SetPreNotificationIdentification(value string)
 func (d *DirectDebitTransaction8) SetPreNotificationDate(value string) {
 	d.PreNotificationDate = (*ISODate)(&value)
 }
+
+func (d *DirectDebitTransaction8) SetPreNotificationDateFromTime(value time.Time) {
+	d.PreNotificationDate = new(ISODate)
+	d.PreNotificationDate.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further specific details on the direct debit transaction and the related mandate.
 type DirectDebitTransaction9 struct {
 
@@ -18,6 +22,10 @@ type DirectDebitTransaction9 struct {
 	PreNotificationDate *ISODate `xml:"PreNtfctnDt,omitempty"`
 }
 
+func (d *DirectDebitTransaction9) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitTransaction9) AddMandateRelatedInformation() *MandateRelatedInformation11 {
 	d.MandateRelatedInformation = new(MandateRelatedInformation11)
 	return d.MandateRelatedInformation
@@ -35,3 +43,8 @@ func (d *DirectDebitTransaction9) SetPreNotificationIdentification(value string)
 func (d *DirectDebitTransaction9) SetPreNotificationDate(value string) {
 	d.PreNotificationDate = (*ISODate)(&value)
 }
+
+func (d *DirectDebitTransaction9) SetPreNotificationDateFromTime(value time.Time) {
+	d.PreNotificationDate = new(ISODate)
+	d.PreNotificationDate.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics that apply to the the direct debit transaction(s).
 type DirectDebitTransactionInformation1 struct {
 
@@ -45,18 +49,22 @@ type DirectDebitTransactionInformation1 struct {
 	Purpose *Purpose1Choice `xml:"Purp,omitempty"`
 
 	// Information needed due to regulatory and statutory requirements.
-	RegulatoryReporting []*RegulatoryReporting2 `xml:"RgltryRptg,omitempty"`
+	RegulatoryReporting []*RegulatoryReporting2 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
 
 	// Amount of money due to the government or tax authority, according to various pre-defined parameters such as thresholds or income.
 	Tax *TaxInformation2 `xml:"Tax,omitempty"`
 
 	// Information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation1 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation1 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information that enables the matching, ie, reconciliation, of a payment with the items that the payment is intended to settle, eg, commercial invoices in an account receivable system.
 	RemittanceInformation *RemittanceInformation1 `xml:"RmtInf,omitempty"`
 }
 
+func (d *DirectDebitTransactionInformation1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitTransactionInformation1) AddPaymentIdentification() *PaymentIdentification1 {
 	d.PaymentIdentification = new(PaymentIdentification1)
 	return d.PaymentIdentification
@@ -71,8 +79,16 @@ func (d *DirectDebitTransactionInformation1) SetInstructedAmount(value, currency
 	d.InstructedAmount = NewCurrencyAndAmount(value, currency)
 }
 
-func (d *DirectDebitTransactionInformation1) SetChargeBearer(value string) {
+func (d *DirectDebitTransactionInformation1) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.InstructedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
+func (d *DirectDebitTransactionInformation1) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	d.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (d *DirectDebitTransactionInformation1) AddDirectDebitTransaction() *DirectDebitTransaction1 {
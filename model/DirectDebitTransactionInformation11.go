@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details specific to the individual direct debit transaction(s) included in the message.
 type DirectDebitTransactionInformation11 struct {
 
@@ -44,18 +48,22 @@ type DirectDebitTransactionInformation11 struct {
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 
 	// Information needed due to regulatory and statutory requirements.
-	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty"`
+	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
 
 	// Provides details on the tax.
 	Tax *TaxInformation3 `xml:"Tax,omitempty"`
 
 	// Provides information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation7 `xml:"RmtInf,omitempty"`
 }
 
+func (d *DirectDebitTransactionInformation11) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitTransactionInformation11) AddPaymentIdentification() *PaymentIdentification1 {
 	d.PaymentIdentification = new(PaymentIdentification1)
 	return d.PaymentIdentification
@@ -70,8 +78,16 @@ func (d *DirectDebitTransactionInformation11) SetInstructedAmount(value, currenc
 	d.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (d *DirectDebitTransactionInformation11) SetChargeBearer(value string) {
+func (d *DirectDebitTransactionInformation11) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (d *DirectDebitTransactionInformation11) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	d.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (d *DirectDebitTransactionInformation11) AddDirectDebitTransaction() *DirectDebitTransaction7 {
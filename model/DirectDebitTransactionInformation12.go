@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details specific to the individual direct debit transaction(s) included in the message.
 type DirectDebitTransactionInformation12 struct {
 
@@ -102,15 +108,19 @@ type DirectDebitTransactionInformation12 struct {
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 
 	// Information needed due to regulatory and statutory requirements.
-	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty"`
+	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
 
 	// Provides information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation7 `xml:"RmtInf,omitempty"`
 }
 
+func (d *DirectDebitTransactionInformation12) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitTransactionInformation12) AddPaymentIdentification() *PaymentIdentification3 {
 	d.PaymentIdentification = new(PaymentIdentification3)
 	return d.PaymentIdentification
@@ -125,20 +135,42 @@ func (d *DirectDebitTransactionInformation12) SetInterbankSettlementAmount(value
 	d.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DirectDebitTransactionInformation12) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DirectDebitTransactionInformation12) SetInterbankSettlementDate(value string) {
 	d.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (d *DirectDebitTransactionInformation12) SetInterbankSettlementDateFromTime(value time.Time) {
+	d.InterbankSettlementDate = new(ISODate)
+	d.InterbankSettlementDate.FromTime(value)
+}
+
 func (d *DirectDebitTransactionInformation12) SetInstructedAmount(value, currency string) {
 	d.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (d *DirectDebitTransactionInformation12) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DirectDebitTransactionInformation12) SetExchangeRate(value string) {
 	d.ExchangeRate = (*BaseOneRate)(&value)
 }
 
-func (d *DirectDebitTransactionInformation12) SetChargeBearer(value string) {
+func (d *DirectDebitTransactionInformation12) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	d.ExchangeRate = new(BaseOneRate)
+	d.ExchangeRate.FromDecimal(value)
+}
+
+func (d *DirectDebitTransactionInformation12) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	d.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (d *DirectDebitTransactionInformation12) AddChargesInformation() *Charges2 {
@@ -291,6 +323,11 @@ func (d *DirectDebitTransactionInformation12) SetRequestedCollectionDate(value s
 	d.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (d *DirectDebitTransactionInformation12) SetRequestedCollectionDateFromTime(value time.Time) {
+	d.RequestedCollectionDate = new(ISODate)
+	d.RequestedCollectionDate.FromTime(value)
+}
+
 func (d *DirectDebitTransactionInformation12) AddDirectDebitTransaction() *DirectDebitTransaction7 {
 	d.DirectDebitTransaction = new(DirectDebitTransaction7)
 	return d.DirectDebitTransaction
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details specific to the individual direct debit transaction(s) included in the message.
 type DirectDebitTransactionInformation14 struct {
 
@@ -102,10 +108,10 @@ type DirectDebitTransactionInformation14 struct {
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 
 	// Information needed due to regulatory and statutory requirements.
-	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty"`
+	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
 
 	// Provides information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation7 `xml:"RmtInf,omitempty"`
@@ -114,6 +120,10 @@ type DirectDebitTransactionInformation14 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (d *DirectDebitTransactionInformation14) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitTransactionInformation14) AddPaymentIdentification() *PaymentIdentification3 {
 	d.PaymentIdentification = new(PaymentIdentification3)
 	return d.PaymentIdentification
@@ -128,20 +138,42 @@ func (d *DirectDebitTransactionInformation14) SetInterbankSettlementAmount(value
 	d.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DirectDebitTransactionInformation14) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DirectDebitTransactionInformation14) SetInterbankSettlementDate(value string) {
 	d.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (d *DirectDebitTransactionInformation14) SetInterbankSettlementDateFromTime(value time.Time) {
+	d.InterbankSettlementDate = new(ISODate)
+	d.InterbankSettlementDate.FromTime(value)
+}
+
 func (d *DirectDebitTransactionInformation14) SetInstructedAmount(value, currency string) {
 	d.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (d *DirectDebitTransactionInformation14) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DirectDebitTransactionInformation14) SetExchangeRate(value string) {
 	d.ExchangeRate = (*BaseOneRate)(&value)
 }
 
-func (d *DirectDebitTransactionInformation14) SetChargeBearer(value string) {
+func (d *DirectDebitTransactionInformation14) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	d.ExchangeRate = new(BaseOneRate)
+	d.ExchangeRate.FromDecimal(value)
+}
+
+func (d *DirectDebitTransactionInformation14) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	d.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (d *DirectDebitTransactionInformation14) AddChargesInformation() *Charges2 {
@@ -294,6 +326,11 @@ func (d *DirectDebitTransactionInformation14) SetRequestedCollectionDate(value s
 	d.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (d *DirectDebitTransactionInformation14) SetRequestedCollectionDateFromTime(value time.Time) {
+	d.RequestedCollectionDate = new(ISODate)
+	d.RequestedCollectionDate.FromTime(value)
+}
+
 func (d *DirectDebitTransactionInformation14) AddDirectDebitTransaction() *DirectDebitTransaction7 {
 	d.DirectDebitTransaction = new(DirectDebitTransaction7)
 	return d.DirectDebitTransaction
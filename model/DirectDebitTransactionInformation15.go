@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details specific to the individual direct debit transaction(s) included in the message.
 type DirectDebitTransactionInformation15 struct {
 
@@ -44,6 +50,10 @@ type DirectDebitTransactionInformation15 struct {
 	RemittanceInformation *RemittanceInformation2 `xml:"RmtInf,omitempty"`
 }
 
+func (d *DirectDebitTransactionInformation15) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitTransactionInformation15) AddPaymentIdentification() *PaymentIdentification3 {
 	d.PaymentIdentification = new(PaymentIdentification3)
 	return d.PaymentIdentification
@@ -58,12 +68,25 @@ func (d *DirectDebitTransactionInformation15) SetInterbankSettlementAmount(value
 	d.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DirectDebitTransactionInformation15) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DirectDebitTransactionInformation15) SetInterbankSettlementDate(value string) {
 	d.InterbankSettlementDate = (*ISODate)(&value)
 }
 
-func (d *DirectDebitTransactionInformation15) SetSettlementPriority(value string) {
+func (d *DirectDebitTransactionInformation15) SetInterbankSettlementDateFromTime(value time.Time) {
+	d.InterbankSettlementDate = new(ISODate)
+	d.InterbankSettlementDate.FromTime(value)
+}
+
+func (d *DirectDebitTransactionInformation15) SetSettlementPriority(value string) error {
+	if err := Priority3Code(value).Validate(); err != nil {
+		return err
+	}
 	d.SettlementPriority = (*Priority3Code)(&value)
+	return nil
 }
 
 func (d *DirectDebitTransactionInformation15) AddSettlementTimeRequest() *SettlementTimeRequest2 {
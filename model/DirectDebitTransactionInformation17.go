@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details specific to the individual direct debit transaction(s) included in the message.
 type DirectDebitTransactionInformation17 struct {
 
@@ -106,10 +112,10 @@ type DirectDebitTransactionInformation17 struct {
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 
 	// Information needed due to regulatory and statutory requirements.
-	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty"`
+	RegulatoryReporting []*RegulatoryReporting3 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
 
 	// Provides information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation4 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation4 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation10 `xml:"RmtInf,omitempty"`
@@ -118,6 +124,10 @@ type DirectDebitTransactionInformation17 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (d *DirectDebitTransactionInformation17) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitTransactionInformation17) AddPaymentIdentification() *PaymentIdentification3 {
 	d.PaymentIdentification = new(PaymentIdentification3)
 	return d.PaymentIdentification
@@ -132,24 +142,50 @@ func (d *DirectDebitTransactionInformation17) SetInterbankSettlementAmount(value
 	d.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *DirectDebitTransactionInformation17) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.InterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DirectDebitTransactionInformation17) SetInterbankSettlementDate(value string) {
 	d.InterbankSettlementDate = (*ISODate)(&value)
 }
 
-func (d *DirectDebitTransactionInformation17) SetSettlementPriority(value string) {
+func (d *DirectDebitTransactionInformation17) SetInterbankSettlementDateFromTime(value time.Time) {
+	d.InterbankSettlementDate = new(ISODate)
+	d.InterbankSettlementDate.FromTime(value)
+}
+
+func (d *DirectDebitTransactionInformation17) SetSettlementPriority(value string) error {
+	if err := Priority3Code(value).Validate(); err != nil {
+		return err
+	}
 	d.SettlementPriority = (*Priority3Code)(&value)
+	return nil
 }
 
 func (d *DirectDebitTransactionInformation17) SetInstructedAmount(value, currency string) {
 	d.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (d *DirectDebitTransactionInformation17) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.InstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DirectDebitTransactionInformation17) SetExchangeRate(value string) {
 	d.ExchangeRate = (*BaseOneRate)(&value)
 }
 
-func (d *DirectDebitTransactionInformation17) SetChargeBearer(value string) {
+func (d *DirectDebitTransactionInformation17) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	d.ExchangeRate = new(BaseOneRate)
+	d.ExchangeRate.FromDecimal(value)
+}
+
+func (d *DirectDebitTransactionInformation17) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	d.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (d *DirectDebitTransactionInformation17) AddChargesInformation() *Charges2 {
@@ -302,6 +338,11 @@ func (d *DirectDebitTransactionInformation17) SetRequestedCollectionDate(value s
 	d.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (d *DirectDebitTransactionInformation17) SetRequestedCollectionDateFromTime(value time.Time) {
+	d.RequestedCollectionDate = new(ISODate)
+	d.RequestedCollectionDate.FromTime(value)
+}
+
 func (d *DirectDebitTransactionInformation17) AddDirectDebitTransaction() *DirectDebitTransaction8 {
 	d.DirectDebitTransaction = new(DirectDebitTransaction8)
 	return d.DirectDebitTransaction
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics that apply to the the direct debit transaction(s).
 type DirectDebitTransactionInformation2 struct {
 
@@ -102,15 +108,19 @@ type DirectDebitTransactionInformation2 struct {
 	Purpose *Purpose1Choice `xml:"Purp,omitempty"`
 
 	// Information needed due to regulatory and statutory requirements.
-	RegulatoryReporting []*RegulatoryReporting2 `xml:"RgltryRptg,omitempty"`
+	RegulatoryReporting []*RegulatoryReporting2 `xml:"RgltryRptg,omitempty" xsd:"maxOccurs=10"`
 
 	// Information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation1 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation1 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information supplied to enable the matching of an entry with the items that the transfer is intended to settle, such as commercial invoices in an accounts' receivable system.
 	RemittanceInformation *RemittanceInformation1 `xml:"RmtInf,omitempty"`
 }
 
+func (d *DirectDebitTransactionInformation2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DirectDebitTransactionInformation2) AddPaymentIdentification() *PaymentIdentification2 {
 	d.PaymentIdentification = new(PaymentIdentification2)
 	return d.PaymentIdentification
@@ -125,20 +135,42 @@ func (d *DirectDebitTransactionInformation2) SetInterbankSettlementAmount(value,
 	d.InterbankSettlementAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (d *DirectDebitTransactionInformation2) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.InterbankSettlementAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DirectDebitTransactionInformation2) SetInterbankSettlementDate(value string) {
 	d.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (d *DirectDebitTransactionInformation2) SetInterbankSettlementDateFromTime(value time.Time) {
+	d.InterbankSettlementDate = new(ISODate)
+	d.InterbankSettlementDate.FromTime(value)
+}
+
 func (d *DirectDebitTransactionInformation2) SetInstructedAmount(value, currency string) {
 	d.InstructedAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (d *DirectDebitTransactionInformation2) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.InstructedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *DirectDebitTransactionInformation2) SetExchangeRate(value string) {
 	d.ExchangeRate = (*BaseOneRate)(&value)
 }
 
-func (d *DirectDebitTransactionInformation2) SetChargeBearer(value string) {
+func (d *DirectDebitTransactionInformation2) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	d.ExchangeRate = new(BaseOneRate)
+	d.ExchangeRate.FromDecimal(value)
+}
+
+func (d *DirectDebitTransactionInformation2) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	d.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (d *DirectDebitTransactionInformation2) AddChargesInformation() *ChargesInformation1 {
@@ -291,6 +323,11 @@ func (d *DirectDebitTransactionInformation2) SetRequestedCollectionDate(value st
 	d.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (d *DirectDebitTransactionInformation2) SetRequestedCollectionDateFromTime(value time.Time) {
+	d.RequestedCollectionDate = new(ISODate)
+	d.RequestedCollectionDate.FromTime(value)
+}
+
 func (d *DirectDebitTransactionInformation2) AddDirectDebitTransaction() *DirectDebitTransaction1 {
 	d.DirectDebitTransaction = new(DirectDebitTransaction1)
 	return d.DirectDebitTransaction
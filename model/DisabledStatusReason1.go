@@ -10,6 +10,10 @@ type DisabledStatusReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (d *DisabledStatusReason1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DisabledStatusReason1) AddCode() *DisabledStatusReason2Choice {
 	d.Code = new(DisabledStatusReason2Choice)
 	return d.Code
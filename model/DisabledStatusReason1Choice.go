@@ -10,8 +10,16 @@ type DisabledStatusReason1Choice struct {
 	Reason []*DisabledStatusReason1 `xml:"Rsn"`
 }
 
-func (d *DisabledStatusReason1Choice) SetNoSpecifiedReason(value string) {
+func (d *DisabledStatusReason1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
+func (d *DisabledStatusReason1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	d.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (d *DisabledStatusReason1Choice) AddReason() *DisabledStatusReason1 {
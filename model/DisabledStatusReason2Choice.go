@@ -10,6 +10,10 @@ type DisabledStatusReason2Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (d *DisabledStatusReason2Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DisabledStatusReason2Choice) SetCode(value string) {
 	d.Code = (*DisabledReason2Code)(&value)
 }
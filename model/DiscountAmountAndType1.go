@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Specifies the amount with a specific type.
 type DiscountAmountAndType1 struct {
 
@@ -10,6 +15,10 @@ type DiscountAmountAndType1 struct {
 	Amount *ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
 }
 
+func (d *DiscountAmountAndType1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DiscountAmountAndType1) AddType() *DiscountAmountType1Choice {
 	d.Type = new(DiscountAmountType1Choice)
 	return d.Type
@@ -18,3 +27,48 @@ func (d *DiscountAmountAndType1) AddType() *DiscountAmountType1Choice {
 func (d *DiscountAmountAndType1) SetAmount(value, currency string) {
 	d.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (d *DiscountAmountAndType1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (d *DiscountAmountAndType1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.Type != nil {
+		d.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if d.Amount != nil {
+		d.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	enc.End(tag)
+}
+
+func (d *DiscountAmountAndType1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(DiscountAmountType1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Type = elem
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Amount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the amount type.
 type DiscountAmountType1Choice struct {
 
@@ -10,6 +12,10 @@ type DiscountAmountType1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (d *DiscountAmountType1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DiscountAmountType1Choice) SetCode(value string) {
 	d.Code = (*ExternalDiscountAmountType1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (d *DiscountAmountType1Choice) SetCode(value string) {
 func (d *DiscountAmountType1Choice) SetProprietary(value string) {
 	d.Proprietary = (*Max35Text)(&value)
 }
+
+func (d *DiscountAmountType1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.Code != nil {
+		enc.Element("Cd", string(*d.Code))
+	}
+	if d.Proprietary != nil {
+		enc.Element("Prtry", string(*d.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (d *DiscountAmountType1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.Code = (*ExternalDiscountAmountType1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ type DiscountOrChargeType1Choice struct {
 	DiscountType *DiscountTypeFormat1Choice `xml:"DscntTp"`
 }
 
+func (d *DiscountOrChargeType1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DiscountOrChargeType1Choice) AddChargeType() *ChargeTypeFormat3Choice {
 	d.ChargeType = new(ChargeTypeFormat3Choice)
 	return d.ChargeType
@@ -10,6 +10,10 @@ type DiscountTypeFormat1Choice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (d *DiscountTypeFormat1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DiscountTypeFormat1Choice) SetCode(value string) {
 	d.Code = (*DiscountType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Discrepancy1 struct {
 	Narrative *Max20000Text `xml:"Nrrtv"`
 }
 
+func (d *Discrepancy1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *Discrepancy1) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
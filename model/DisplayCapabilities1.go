@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // The capabilities of the display components performing the transaction.
 type DisplayCapabilities1 struct {
 
@@ -13,6 +15,10 @@ type DisplayCapabilities1 struct {
 	LineWidth *Max3NumericText `xml:"LineWidth"`
 }
 
+func (d *DisplayCapabilities1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DisplayCapabilities1) SetDisplayType(value string) {
 	d.DisplayType = (*UserInterface2Code)(&value)
 }
@@ -24,3 +30,53 @@ func (d *DisplayCapabilities1) SetNumberOfLines(value string) {
 func (d *DisplayCapabilities1) SetLineWidth(value string) {
 	d.LineWidth = (*Max3NumericText)(&value)
 }
+
+func (d *DisplayCapabilities1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.DisplayType != nil {
+		enc.Element("DispTp", string(*d.DisplayType))
+	}
+	if d.NumberOfLines != nil {
+		enc.Element("NbOfLines", string(*d.NumberOfLines))
+	}
+	if d.LineWidth != nil {
+		enc.Element("LineWidth", string(*d.LineWidth))
+	}
+	enc.End(tag)
+}
+
+func (d *DisplayCapabilities1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "DispTp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.DisplayType = (*UserInterface2Code)(&value)
+		case "NbOfLines":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.NumberOfLines = (*Max3NumericText)(&value)
+		case "LineWidth":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.LineWidth = (*Max3NumericText)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // The capabilities of the display components performing the transaction.
 type DisplayCapabilities2 struct {
 
@@ -13,6 +17,10 @@ type DisplayCapabilities2 struct {
 	LineWidth *Number `xml:"LineWidth"`
 }
 
+func (d *DisplayCapabilities2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DisplayCapabilities2) SetDisplayType(value string) {
 	d.DisplayType = (*UserInterface2Code)(&value)
 }
@@ -21,6 +29,16 @@ func (d *DisplayCapabilities2) SetNumberOfLines(value string) {
 	d.NumberOfLines = (*Number)(&value)
 }
 
+func (d *DisplayCapabilities2) SetNumberOfLinesFromDecimal(value decimal.Decimal) {
+	d.NumberOfLines = new(Number)
+	d.NumberOfLines.FromDecimal(value)
+}
+
 func (d *DisplayCapabilities2) SetLineWidth(value string) {
 	d.LineWidth = (*Number)(&value)
 }
+
+func (d *DisplayCapabilities2) SetLineWidthFromDecimal(value decimal.Decimal) {
+	d.LineWidth = new(Number)
+	d.LineWidth.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Capabilities of the display components performing the transaction.
 type DisplayCapabilities3 struct {
 
@@ -19,6 +23,10 @@ type DisplayCapabilities3 struct {
 	AvailableLanguage []*LanguageCode `xml:"AvlblLang,omitempty"`
 }
 
+func (d *DisplayCapabilities3) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DisplayCapabilities3) SetDestination(value string) {
 	d.Destination = (*UserInterface1Code)(&value)
 }
@@ -31,10 +39,20 @@ func (d *DisplayCapabilities3) SetNumberOfLines(value string) {
 	d.NumberOfLines = (*Number)(&value)
 }
 
+func (d *DisplayCapabilities3) SetNumberOfLinesFromDecimal(value decimal.Decimal) {
+	d.NumberOfLines = new(Number)
+	d.NumberOfLines.FromDecimal(value)
+}
+
 func (d *DisplayCapabilities3) SetLineWidth(value string) {
 	d.LineWidth = (*Number)(&value)
 }
 
+func (d *DisplayCapabilities3) SetLineWidthFromDecimal(value decimal.Decimal) {
+	d.LineWidth = new(Number)
+	d.LineWidth.FromDecimal(value)
+}
+
 func (d *DisplayCapabilities3) AddAvailableLanguage(value string) {
 	d.AvailableLanguage = append(d.AvailableLanguage, (*LanguageCode)(&value))
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Capabilities of the display components performing the transaction.
 type DisplayCapabilities4 struct {
 
@@ -19,6 +23,10 @@ type DisplayCapabilities4 struct {
 	AvailableLanguage []*LanguageCode `xml:"AvlblLang,omitempty"`
 }
 
+func (d *DisplayCapabilities4) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DisplayCapabilities4) AddDestination(value string) {
 	d.Destination = append(d.Destination, (*UserInterface4Code)(&value))
 }
@@ -31,10 +39,20 @@ func (d *DisplayCapabilities4) SetNumberOfLines(value string) {
 	d.NumberOfLines = (*Number)(&value)
 }
 
+func (d *DisplayCapabilities4) SetNumberOfLinesFromDecimal(value decimal.Decimal) {
+	d.NumberOfLines = new(Number)
+	d.NumberOfLines.FromDecimal(value)
+}
+
 func (d *DisplayCapabilities4) SetLineWidth(value string) {
 	d.LineWidth = (*Number)(&value)
 }
 
+func (d *DisplayCapabilities4) SetLineWidthFromDecimal(value decimal.Decimal) {
+	d.LineWidth = new(Number)
+	d.LineWidth.FromDecimal(value)
+}
+
 func (d *DisplayCapabilities4) AddAvailableLanguage(value string) {
 	d.AvailableLanguage = append(d.AvailableLanguage, (*LanguageCode)(&value))
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the dispute details.
 type Dispute1 struct {
 
@@ -13,6 +19,10 @@ type Dispute1 struct {
 	DisputeDate *ISODate `xml:"DsptDt"`
 }
 
+func (d *Dispute1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *Dispute1) SetMarginCallRequestIdentification(value string) {
 	d.MarginCallRequestIdentification = (*Max35Text)(&value)
 }
@@ -21,6 +31,15 @@ func (d *Dispute1) SetDisputedAmount(value, currency string) {
 	d.DisputedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (d *Dispute1) SetDisputedAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.DisputedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (d *Dispute1) SetDisputeDate(value string) {
 	d.DisputeDate = (*ISODate)(&value)
 }
+
+func (d *Dispute1) SetDisputeDateFromTime(value time.Time) {
+	d.DisputeDate = new(ISODate)
+	d.DisputeDate.FromTime(value)
+}
@@ -10,6 +10,10 @@ type DisputeNotification1 struct {
 	SegregatedIndependentAmountDispute *SegregatedIndependentAmountDispute1 `xml:"SgrtdIndpdntAmtDspt,omitempty"`
 }
 
+func (d *DisputeNotification1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DisputeNotification1) AddVariationMarginDispute() *VariationMarginDispute1 {
 	d.VariationMarginDispute = new(VariationMarginDispute1)
 	return d.VariationMarginDispute
@@ -10,6 +10,10 @@ type DisputeNotification1Choice struct {
 	SegregatedIndependentAmountDisputeDetails *SegregatedIndependentAmountDispute1 `xml:"SgrtdIndpdntAmtDsptDtls"`
 }
 
+func (d *DisputeNotification1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DisputeNotification1Choice) AddDisputeNotificationDetails() *DisputeNotification1 {
 	d.DisputeNotificationDetails = new(DisputeNotification1)
 	return d.DisputeNotificationDetails
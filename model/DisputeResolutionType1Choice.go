@@ -10,6 +10,10 @@ type DisputeResolutionType1Choice struct {
 	ProprietaryIdentification *GenericIdentification30 `xml:"PrtryId"`
 }
 
+func (d *DisputeResolutionType1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DisputeResolutionType1Choice) SetCode(value string) {
 	d.Code = (*DisputeResolutionType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type DisputeResolutionType2Choice struct {
 	ProprietaryIdentification *GenericIdentification30 `xml:"PrtryId"`
 }
 
+func (d *DisputeResolutionType2Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DisputeResolutionType2Choice) SetCode(value string) {
 	d.Code = (*DisputeResolutionType2Code)(&value)
 }
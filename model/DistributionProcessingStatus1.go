@@ -10,6 +10,10 @@ type DistributionProcessingStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (d *DistributionProcessingStatus1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DistributionProcessingStatus1) AddStatus() *ProcessedStatus3FormatChoice {
 	d.Status = new(ProcessedStatus3FormatChoice)
 	return d.Status
@@ -10,6 +10,10 @@ type DistributionRejectionStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (d *DistributionRejectionStatus1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DistributionRejectionStatus1) AddReason() *RejectionReason19FormatChoice {
 	newValue := new(RejectionReason19FormatChoice)
 	d.Reason = append(d.Reason, newValue)
@@ -10,6 +10,10 @@ type DistributionType1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (d *DistributionType1FormatChoice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DistributionType1FormatChoice) SetCode(value string) {
 	d.Code = (*DistributionType1Code)(&value)
 }
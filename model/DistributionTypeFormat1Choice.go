@@ -10,6 +10,10 @@ type DistributionTypeFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DistributionTypeFormat1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DistributionTypeFormat1Choice) SetCode(value string) {
 	d.Code = (*DistributionType1Code)(&value)
 }
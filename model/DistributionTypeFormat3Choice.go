@@ -10,6 +10,10 @@ type DistributionTypeFormat3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DistributionTypeFormat3Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DistributionTypeFormat3Choice) SetCode(value string) {
 	d.Code = (*DistributionType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type DistributionTypeFormat4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DistributionTypeFormat4Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DistributionTypeFormat4Choice) SetCode(value string) {
 	d.Code = (*DistributionType3Code)(&value)
 }
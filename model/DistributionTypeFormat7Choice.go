@@ -10,6 +10,10 @@ type DistributionTypeFormat7Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (d *DistributionTypeFormat7Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DistributionTypeFormat7Choice) SetCode(value string) {
 	d.Code = (*DistributionType3Code)(&value)
 }
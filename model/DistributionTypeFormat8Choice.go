@@ -10,6 +10,10 @@ type DistributionTypeFormat8Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (d *DistributionTypeFormat8Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DistributionTypeFormat8Choice) SetCode(value string) {
 	d.Code = (*DistributionType3Code)(&value)
 }
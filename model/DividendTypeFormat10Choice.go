@@ -10,6 +10,10 @@ type DividendTypeFormat10Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (d *DividendTypeFormat10Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DividendTypeFormat10Choice) SetCode(value string) {
 	d.Code = (*CorporateActionFrequencyType5Code)(&value)
 }
@@ -10,6 +10,10 @@ type DividendTypeFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DividendTypeFormat1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DividendTypeFormat1Choice) SetCode(value string) {
 	d.Code = (*CorporateActionFrequencyType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type DividendTypeFormat3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DividendTypeFormat3Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DividendTypeFormat3Choice) SetCode(value string) {
 	d.Code = (*CorporateActionFrequencyType2Code)(&value)
 }
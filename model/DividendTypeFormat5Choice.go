@@ -10,6 +10,10 @@ type DividendTypeFormat5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (d *DividendTypeFormat5Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DividendTypeFormat5Choice) SetCode(value string) {
 	d.Code = (*CorporateActionFrequencyType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type DividendTypeFormat9Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (d *DividendTypeFormat9Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DividendTypeFormat9Choice) SetCode(value string) {
 	d.Code = (*CorporateActionFrequencyType5Code)(&value)
 }
@@ -19,6 +19,10 @@ type Document10 struct {
 	SignedIndicator *YesNoIndicator `xml:"SgndInd,omitempty"`
 }
 
+func (d *Document10) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *Document10) AddDocumentType() *UndertakingDocumentType2Choice {
 	d.DocumentType = new(UndertakingDocumentType2Choice)
 	return d.DocumentType
@@ -38,6 +42,16 @@ func (d *Document10) SetCopyIndicator(value string) {
 	d.CopyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (d *Document10) SetCopyIndicatorFromBool(value bool) {
+	d.CopyIndicator = new(YesNoIndicator)
+	d.CopyIndicator.FromBool(value)
+}
+
 func (d *Document10) SetSignedIndicator(value string) {
 	d.SignedIndicator = (*YesNoIndicator)(&value)
 }
+
+func (d *Document10) SetSignedIndicatorFromBool(value bool) {
+	d.SignedIndicator = new(YesNoIndicator)
+	d.SignedIndicator.FromBool(value)
+}
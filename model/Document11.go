@@ -13,6 +13,10 @@ type Document11 struct {
 	ElectronicDetails []*Presentation3 `xml:"ElctrncDtls,omitempty"`
 }
 
+func (d *Document11) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *Document11) AddType() *PresentationDocumentFormat1Choice {
 	d.Type = new(PresentationDocumentFormat1Choice)
 	return d.Type
@@ -19,6 +19,10 @@ type Document9 struct {
 	DigitalSignature *PartyAndSignature2 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (d *Document9) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *Document9) AddType() *UndertakingDocumentType1Choice {
 	d.Type = new(UndertakingDocumentType1Choice)
 	return d.Type
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of elements used to provide information on the amount and reason of the document adjustment.
 type DocumentAdjustment1 struct {
 
@@ -16,12 +21,24 @@ type DocumentAdjustment1 struct {
 	AdditionalInformation *Max140Text `xml:"AddtlInf,omitempty"`
 }
 
+func (d *DocumentAdjustment1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentAdjustment1) SetAmount(value, currency string) {
 	d.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (d *DocumentAdjustment1) SetCreditDebitIndicator(value string) {
+func (d *DocumentAdjustment1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (d *DocumentAdjustment1) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	d.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (d *DocumentAdjustment1) SetReason(value string) {
@@ -31,3 +48,62 @@ func (d *DocumentAdjustment1) SetReason(value string) {
 func (d *DocumentAdjustment1) SetAdditionalInformation(value string) {
 	d.AdditionalInformation = (*Max140Text)(&value)
 }
+
+func (d *DocumentAdjustment1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.Amount != nil {
+		d.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if d.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*d.CreditDebitIndicator))
+	}
+	if d.Reason != nil {
+		enc.Element("Rsn", string(*d.Reason))
+	}
+	if d.AdditionalInformation != nil {
+		enc.Element("AddtlInf", string(*d.AdditionalInformation))
+	}
+	enc.End(tag)
+}
+
+func (d *DocumentAdjustment1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Amount = elem
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		case "Rsn":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.Reason = (*Max4Text)(&value)
+		case "AddtlInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.AdditionalInformation = (*Max140Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the amount and reason of the document adjustment.
 type DocumentAdjustment2 struct {
 
@@ -16,12 +20,24 @@ type DocumentAdjustment2 struct {
 	AdditionalInformation *Max140Text `xml:"AddtlInf,omitempty"`
 }
 
+func (d *DocumentAdjustment2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentAdjustment2) SetAmount(value, currency string) {
 	d.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (d *DocumentAdjustment2) SetCreditDebitIndicator(value string) {
+func (d *DocumentAdjustment2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	d.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (d *DocumentAdjustment2) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	d.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (d *DocumentAdjustment2) SetReason(value string) {
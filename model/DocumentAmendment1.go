@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Indicates whether the supporting document is amending an original document or not, and the reference of the original supporting document, when applicable.
 type DocumentAmendment1 struct {
 
@@ -10,10 +14,19 @@ type DocumentAmendment1 struct {
 	OriginalDocumentIdentification *Max35Text `xml:"OrgnlDocId,omitempty"`
 }
 
+func (d *DocumentAmendment1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentAmendment1) SetCorrectionIdentification(value string) {
 	d.CorrectionIdentification = (*Number)(&value)
 }
 
+func (d *DocumentAmendment1) SetCorrectionIdentificationFromDecimal(value decimal.Decimal) {
+	d.CorrectionIdentification = new(Number)
+	d.CorrectionIdentification.FromDecimal(value)
+}
+
 func (d *DocumentAmendment1) SetOriginalDocumentIdentification(value string) {
 	d.OriginalDocumentIdentification = (*Max35Text)(&value)
 }
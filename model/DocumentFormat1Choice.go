@@ -11,6 +11,10 @@ type DocumentFormat1Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (d *DocumentFormat1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentFormat1Choice) SetCode(value string) {
 	d.Code = (*ExternalDocumentFormat1Code)(&value)
 }
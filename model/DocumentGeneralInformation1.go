@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // General information that unambiguously identifies a document, such as identification number and issue date time.
 type DocumentGeneralInformation1 struct {
 
@@ -19,6 +23,10 @@ type DocumentGeneralInformation1 struct {
 	URL *Max256Text `xml:"URL,omitempty"`
 }
 
+func (d *DocumentGeneralInformation1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentGeneralInformation1) SetDocumentType(value string) {
 	d.DocumentType = (*DocumentType4Code)(&value)
 }
@@ -35,6 +43,11 @@ func (d *DocumentGeneralInformation1) SetIssueDate(value string) {
 	d.IssueDate = (*ISODate)(&value)
 }
 
+func (d *DocumentGeneralInformation1) SetIssueDateFromTime(value time.Time) {
+	d.IssueDate = new(ISODate)
+	d.IssueDate.FromTime(value)
+}
+
 func (d *DocumentGeneralInformation1) SetURL(value string) {
 	d.URL = (*Max256Text)(&value)
 }
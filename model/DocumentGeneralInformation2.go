@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // General information that unambiguously identifies a document, such as identification number and issue date time.
 type DocumentGeneralInformation2 struct {
 
@@ -22,6 +26,10 @@ type DocumentGeneralInformation2 struct {
 	AttachedBinaryFile []*BinaryFile1 `xml:"AttchdBinryFile,omitempty"`
 }
 
+func (d *DocumentGeneralInformation2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentGeneralInformation2) SetDocumentType(value string) {
 	d.DocumentType = (*ExternalDocumentType1Code)(&value)
 }
@@ -38,6 +46,11 @@ func (d *DocumentGeneralInformation2) SetIssueDate(value string) {
 	d.IssueDate = (*ISODate)(&value)
 }
 
+func (d *DocumentGeneralInformation2) SetIssueDateFromTime(value time.Time) {
+	d.IssueDate = new(ISODate)
+	d.IssueDate.FromTime(value)
+}
+
 func (d *DocumentGeneralInformation2) SetURL(value string) {
 	d.URL = (*Max256Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // General information that unambiguously identifies a document, such as identification number and issue date time.
 type DocumentGeneralInformation3 struct {
 
@@ -26,6 +30,10 @@ type DocumentGeneralInformation3 struct {
 	AttachedBinaryFile *BinaryFile1 `xml:"AttchdBinryFile"`
 }
 
+func (d *DocumentGeneralInformation3) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentGeneralInformation3) SetDocumentType(value string) {
 	d.DocumentType = (*ExternalDocumentType1Code)(&value)
 }
@@ -42,6 +50,11 @@ func (d *DocumentGeneralInformation3) SetIssueDate(value string) {
 	d.IssueDate = (*ISODate)(&value)
 }
 
+func (d *DocumentGeneralInformation3) SetIssueDateFromTime(value time.Time) {
+	d.IssueDate = new(ISODate)
+	d.IssueDate.FromTime(value)
+}
+
 func (d *DocumentGeneralInformation3) SetURL(value string) {
 	d.URL = (*Max256Text)(&value)
 }
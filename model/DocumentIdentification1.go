@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identifies a document by a unique identification and a version together with the identification of the submitter of the document.
 type DocumentIdentification1 struct {
 
@@ -13,6 +17,10 @@ type DocumentIdentification1 struct {
 	Submitter *BICIdentification1 `xml:"Submitr"`
 }
 
+func (d *DocumentIdentification1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification1) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -21,6 +29,11 @@ func (d *DocumentIdentification1) SetVersion(value string) {
 	d.Version = (*Number)(&value)
 }
 
+func (d *DocumentIdentification1) SetVersionFromDecimal(value decimal.Decimal) {
+	d.Version = new(Number)
+	d.Version.FromDecimal(value)
+}
+
 func (d *DocumentIdentification1) AddSubmitter() *BICIdentification1 {
 	d.Submitter = new(BICIdentification1)
 	return d.Submitter
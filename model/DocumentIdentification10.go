@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identifies a document by a unique identification and a version together with the submitter of the document.
 // Also specifies the type of document and an index for easy referencing.
 type DocumentIdentification10 struct {
@@ -20,6 +24,10 @@ type DocumentIdentification10 struct {
 	DocumentIndex *Max3NumericText `xml:"DocIndx"`
 }
 
+func (d *DocumentIdentification10) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification10) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -28,6 +36,11 @@ func (d *DocumentIdentification10) SetVersion(value string) {
 	d.Version = (*Number)(&value)
 }
 
+func (d *DocumentIdentification10) SetVersionFromDecimal(value decimal.Decimal) {
+	d.Version = new(Number)
+	d.Version.FromDecimal(value)
+}
+
 func (d *DocumentIdentification10) SetType(value string) {
 	d.Type = (*DataSetType2Code)(&value)
 }
@@ -13,6 +13,10 @@ type DocumentIdentification11 struct {
 	CopyDuplicate *CopyDuplicate1Code `xml:"CpyDplct,omitempty"`
 }
 
+func (d *DocumentIdentification11) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification11) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -22,6 +26,10 @@ func (d *DocumentIdentification11) AddCreationDateTime() *DateAndDateTimeChoice
 	return d.CreationDateTime
 }
 
-func (d *DocumentIdentification11) SetCopyDuplicate(value string) {
+func (d *DocumentIdentification11) SetCopyDuplicate(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	d.CopyDuplicate = (*CopyDuplicate1Code)(&value)
+	return nil
 }
@@ -16,6 +16,10 @@ type DocumentIdentification12 struct {
 	ChangeInstructionIndicator *YesNoIndicator `xml:"ChngInstrInd,omitempty"`
 }
 
+func (d *DocumentIdentification12) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification12) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -25,10 +29,19 @@ func (d *DocumentIdentification12) AddCreationDateTime() *DateAndDateTimeChoice
 	return d.CreationDateTime
 }
 
-func (d *DocumentIdentification12) SetCopyDuplicate(value string) {
+func (d *DocumentIdentification12) SetCopyDuplicate(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	d.CopyDuplicate = (*CopyDuplicate1Code)(&value)
+	return nil
 }
 
 func (d *DocumentIdentification12) SetChangeInstructionIndicator(value string) {
 	d.ChangeInstructionIndicator = (*YesNoIndicator)(&value)
 }
+
+func (d *DocumentIdentification12) SetChangeInstructionIndicatorFromBool(value bool) {
+	d.ChangeInstructionIndicator = new(YesNoIndicator)
+	d.ChangeInstructionIndicator.FromBool(value)
+}
@@ -13,6 +13,10 @@ type DocumentIdentification13 struct {
 	LinkageType *ProcessingPosition1Choice `xml:"LkgTp,omitempty"`
 }
 
+func (d *DocumentIdentification13) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification13) AddIdentification() *DocumentIdentification1Choice {
 	d.Identification = new(DocumentIdentification1Choice)
 	return d.Identification
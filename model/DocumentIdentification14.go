@@ -10,6 +10,10 @@ type DocumentIdentification14 struct {
 	DocumentNumber *DocumentNumber1Choice `xml:"DocNb,omitempty"`
 }
 
+func (d *DocumentIdentification14) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification14) AddIdentification() *DocumentIdentification1Choice {
 	d.Identification = new(DocumentIdentification1Choice)
 	return d.Identification
@@ -10,6 +10,10 @@ type DocumentIdentification15 struct {
 	LinkageType *ProcessingPosition1Choice `xml:"LkgTp,omitempty"`
 }
 
+func (d *DocumentIdentification15) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification15) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -7,6 +7,10 @@ type DocumentIdentification17 struct {
 	Identification *RestrictedFINXMax16Text `xml:"Id"`
 }
 
+func (d *DocumentIdentification17) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification17) SetIdentification(value string) {
 	d.Identification = (*RestrictedFINXMax16Text)(&value)
 }
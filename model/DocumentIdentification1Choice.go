@@ -10,6 +10,10 @@ type DocumentIdentification1Choice struct {
 	AccountOwnerDocumentIdentification *Max35Text `xml:"AcctOwnrDocId"`
 }
 
+func (d *DocumentIdentification1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification1Choice) SetAccountServicerDocumentIdentification(value string) {
 	d.AccountServicerDocumentIdentification = (*Max35Text)(&value)
 }
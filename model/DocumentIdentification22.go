@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies a document by a unique identification and a date of issue.
 type DocumentIdentification22 struct {
 
@@ -10,6 +14,10 @@ type DocumentIdentification22 struct {
 	DateOfIssue *ISODate `xml:"DtOfIsse,omitempty"`
 }
 
+func (d *DocumentIdentification22) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification22) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -17,3 +25,8 @@ func (d *DocumentIdentification22) SetIdentification(value string) {
 func (d *DocumentIdentification22) SetDateOfIssue(value string) {
 	d.DateOfIssue = (*ISODate)(&value)
 }
+
+func (d *DocumentIdentification22) SetDateOfIssueFromTime(value time.Time) {
+	d.DateOfIssue = new(ISODate)
+	d.DateOfIssue.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies a document by a unique identification and a date of issue.
 type DocumentIdentification23 struct {
 
@@ -13,6 +17,10 @@ type DocumentIdentification23 struct {
 	OrderLineIdentification *Max35Text `xml:"OrdrLineId,omitempty"`
 }
 
+func (d *DocumentIdentification23) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification23) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -21,6 +29,11 @@ func (d *DocumentIdentification23) SetDateOfIssue(value string) {
 	d.DateOfIssue = (*ISODate)(&value)
 }
 
+func (d *DocumentIdentification23) SetDateOfIssueFromTime(value time.Time) {
+	d.DateOfIssue = new(ISODate)
+	d.DateOfIssue.FromTime(value)
+}
+
 func (d *DocumentIdentification23) SetOrderLineIdentification(value string) {
 	d.OrderLineIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type DocumentIdentification24 struct {
 	Reference *Identification1 `xml:"Ref"`
 }
 
+func (d *DocumentIdentification24) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification24) AddMessageNumber() *DocumentNumber1Choice {
 	d.MessageNumber = new(DocumentNumber1Choice)
 	return d.MessageNumber
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies a document by a unique identification and a date of issue.
 type DocumentIdentification29 struct {
 
@@ -10,6 +14,10 @@ type DocumentIdentification29 struct {
 	DateOfIssue *ISODate `xml:"DtOfIsse"`
 }
 
+func (d *DocumentIdentification29) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification29) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -17,3 +25,8 @@ func (d *DocumentIdentification29) SetIdentification(value string) {
 func (d *DocumentIdentification29) SetDateOfIssue(value string) {
 	d.DateOfIssue = (*ISODate)(&value)
 }
+
+func (d *DocumentIdentification29) SetDateOfIssueFromTime(value time.Time) {
+	d.DateOfIssue = new(ISODate)
+	d.DateOfIssue.FromTime(value)
+}
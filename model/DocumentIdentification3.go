@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identifies a document by a unique identification and a version.
 type DocumentIdentification3 struct {
 
@@ -10,6 +14,10 @@ type DocumentIdentification3 struct {
 	Version *Number `xml:"Vrsn"`
 }
 
+func (d *DocumentIdentification3) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification3) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -17,3 +25,8 @@ func (d *DocumentIdentification3) SetIdentification(value string) {
 func (d *DocumentIdentification3) SetVersion(value string) {
 	d.Version = (*Number)(&value)
 }
+
+func (d *DocumentIdentification3) SetVersionFromDecimal(value decimal.Decimal) {
+	d.Version = new(Number)
+	d.Version.FromDecimal(value)
+}
@@ -10,6 +10,10 @@ type DocumentIdentification30 struct {
 	Reference *Identification14 `xml:"Ref"`
 }
 
+func (d *DocumentIdentification30) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification30) AddMessageNumber() *DocumentNumber5Choice {
 	d.MessageNumber = new(DocumentNumber5Choice)
 	return d.MessageNumber
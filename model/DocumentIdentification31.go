@@ -10,6 +10,10 @@ type DocumentIdentification31 struct {
 	LinkageType *ProcessingPosition7Choice `xml:"LkgTp,omitempty"`
 }
 
+func (d *DocumentIdentification31) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification31) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type DocumentIdentification32 struct {
 	LinkageType *ProcessingPosition7Choice `xml:"LkgTp,omitempty"`
 }
 
+func (d *DocumentIdentification32) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification32) AddIdentification() *DocumentIdentification3Choice {
 	d.Identification = new(DocumentIdentification3Choice)
 	return d.Identification
@@ -10,6 +10,10 @@ type DocumentIdentification33 struct {
 	DocumentNumber *DocumentNumber5Choice `xml:"DocNb,omitempty"`
 }
 
+func (d *DocumentIdentification33) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification33) AddIdentification() *DocumentIdentification3Choice {
 	d.Identification = new(DocumentIdentification3Choice)
 	return d.Identification
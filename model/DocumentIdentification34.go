@@ -10,6 +10,10 @@ type DocumentIdentification34 struct {
 	DocumentNumber *DocumentNumber6Choice `xml:"DocNb,omitempty"`
 }
 
+func (d *DocumentIdentification34) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification34) AddIdentification() *DocumentIdentification4Choice {
 	d.Identification = new(DocumentIdentification4Choice)
 	return d.Identification
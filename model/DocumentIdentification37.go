@@ -10,6 +10,10 @@ type DocumentIdentification37 struct {
 	LinkageType *ProcessingPosition10Choice `xml:"LkgTp,omitempty"`
 }
 
+func (d *DocumentIdentification37) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification37) SetIdentification(value string) {
 	d.Identification = (*RestrictedFINXMax16Text)(&value)
 }
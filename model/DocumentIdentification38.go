@@ -13,6 +13,10 @@ type DocumentIdentification38 struct {
 	LinkageType *ProcessingPosition10Choice `xml:"LkgTp,omitempty"`
 }
 
+func (d *DocumentIdentification38) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification38) AddIdentification() *DocumentIdentification4Choice {
 	d.Identification = new(DocumentIdentification4Choice)
 	return d.Identification
@@ -10,6 +10,10 @@ type DocumentIdentification3Choice struct {
 	AccountOwnerDocumentIdentification *Max35Text `xml:"AcctOwnrDocId"`
 }
 
+func (d *DocumentIdentification3Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification3Choice) SetAccountServicerDocumentIdentification(value string) {
 	d.AccountServicerDocumentIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identifies a document by a unique identification and a version together with the submitter of the document.
 // Also specifies an index for easy referencing.
 type DocumentIdentification4 struct {
@@ -17,6 +21,10 @@ type DocumentIdentification4 struct {
 	DocumentIndex *Max3NumericText `xml:"DocIndx"`
 }
 
+func (d *DocumentIdentification4) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification4) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -25,6 +33,11 @@ func (d *DocumentIdentification4) SetVersion(value string) {
 	d.Version = (*Number)(&value)
 }
 
+func (d *DocumentIdentification4) SetVersionFromDecimal(value decimal.Decimal) {
+	d.Version = new(Number)
+	d.Version.FromDecimal(value)
+}
+
 func (d *DocumentIdentification4) AddSubmitter() *BICIdentification1 {
 	d.Submitter = new(BICIdentification1)
 	return d.Submitter
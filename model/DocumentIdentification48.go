@@ -10,6 +10,10 @@ type DocumentIdentification48 struct {
 	Reference *Identification16 `xml:"Ref"`
 }
 
+func (d *DocumentIdentification48) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification48) AddMessageNumber() *DocumentNumber6Choice {
 	d.MessageNumber = new(DocumentNumber6Choice)
 	return d.MessageNumber
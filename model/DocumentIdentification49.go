@@ -10,6 +10,10 @@ type DocumentIdentification49 struct {
 	LinkageType *ProcessingPosition22Choice `xml:"LkgTp,omitempty"`
 }
 
+func (d *DocumentIdentification49) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification49) SetIdentification(value string) {
 	d.Identification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -10,6 +10,10 @@ type DocumentIdentification4Choice struct {
 	AccountOwnerDocumentIdentification *RestrictedFINXMax16Text `xml:"AcctOwnrDocId"`
 }
 
+func (d *DocumentIdentification4Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification4Choice) SetAccountServicerDocumentIdentification(value string) {
 	d.AccountServicerDocumentIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -10,6 +10,10 @@ type DocumentIdentification5 struct {
 	IdentificationIssuer *BICIdentification1 `xml:"IdIssr"`
 }
 
+func (d *DocumentIdentification5) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification5) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identifies a document by a unique identification and a version.
 // Also provides reference to a baseline amendment number.
 type DocumentIdentification6 struct {
@@ -14,6 +18,10 @@ type DocumentIdentification6 struct {
 	AmendmentSequenceNumber *Max3NumericText `xml:"AmdmntSeqNb,omitempty"`
 }
 
+func (d *DocumentIdentification6) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification6) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -22,6 +30,11 @@ func (d *DocumentIdentification6) SetVersion(value string) {
 	d.Version = (*Number)(&value)
 }
 
+func (d *DocumentIdentification6) SetVersionFromDecimal(value decimal.Decimal) {
+	d.Version = new(Number)
+	d.Version.FromDecimal(value)
+}
+
 func (d *DocumentIdentification6) SetAmendmentSequenceNumber(value string) {
 	d.AmendmentSequenceNumber = (*Max3NumericText)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies a document by a unique identification and a date of issue.
 type DocumentIdentification7 struct {
 
@@ -10,6 +14,10 @@ type DocumentIdentification7 struct {
 	DateOfIssue *ISODate `xml:"DtOfIsse"`
 }
 
+func (d *DocumentIdentification7) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification7) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -17,3 +25,8 @@ func (d *DocumentIdentification7) SetIdentification(value string) {
 func (d *DocumentIdentification7) SetDateOfIssue(value string) {
 	d.DateOfIssue = (*ISODate)(&value)
 }
+
+func (d *DocumentIdentification7) SetDateOfIssueFromTime(value time.Time) {
+	d.DateOfIssue = new(ISODate)
+	d.DateOfIssue.FromTime(value)
+}
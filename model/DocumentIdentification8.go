@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies the document by providing a unique identification and optionally the date/time of the creation of the document.
 type DocumentIdentification8 struct {
 
@@ -10,6 +14,10 @@ type DocumentIdentification8 struct {
 	CreationDateTime *ISODateTime `xml:"CreDtTm,omitempty"`
 }
 
+func (d *DocumentIdentification8) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification8) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -17,3 +25,8 @@ func (d *DocumentIdentification8) SetIdentification(value string) {
 func (d *DocumentIdentification8) SetCreationDateTime(value string) {
 	d.CreationDateTime = (*ISODateTime)(&value)
 }
+
+func (d *DocumentIdentification8) SetCreationDateTimeFromTime(value time.Time) {
+	d.CreationDateTime = new(ISODateTime)
+	d.CreationDateTime.FromTime(value)
+}
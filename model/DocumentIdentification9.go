@@ -7,6 +7,10 @@ type DocumentIdentification9 struct {
 	Identification *Max35Text `xml:"Id"`
 }
 
+func (d *DocumentIdentification9) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentIdentification9) SetIdentification(value string) {
 	d.Identification = (*Max35Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Identifies the documents referred to in the remittance information.
 type DocumentLineIdentification1 struct {
 
@@ -13,6 +19,10 @@ type DocumentLineIdentification1 struct {
 	RelatedDate *ISODate `xml:"RltdDt,omitempty"`
 }
 
+func (d *DocumentLineIdentification1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentLineIdentification1) AddType() *DocumentLineType1 {
 	d.Type = new(DocumentLineType1)
 	return d.Type
@@ -25,3 +35,58 @@ func (d *DocumentLineIdentification1) SetNumber(value string) {
 func (d *DocumentLineIdentification1) SetRelatedDate(value string) {
 	d.RelatedDate = (*ISODate)(&value)
 }
+
+func (d *DocumentLineIdentification1) SetRelatedDateFromTime(value time.Time) {
+	d.RelatedDate = new(ISODate)
+	d.RelatedDate.FromTime(value)
+}
+
+func (d *DocumentLineIdentification1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.Type != nil {
+		d.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if d.Number != nil {
+		enc.Element("Nb", string(*d.Number))
+	}
+	if d.RelatedDate != nil {
+		enc.Element("RltdDt", string(*d.RelatedDate))
+	}
+	enc.End(tag)
+}
+
+func (d *DocumentLineIdentification1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(DocumentLineType1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Type = elem
+		case "Nb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.Number = (*Max35Text)(&value)
+		case "RltdDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.RelatedDate = (*ISODate)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Provides document line information.
 //
 type DocumentLineInformation1 struct {
@@ -14,6 +16,10 @@ type DocumentLineInformation1 struct {
 	Amount *RemittanceAmount3 `xml:"Amt,omitempty"`
 }
 
+func (d *DocumentLineInformation1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentLineInformation1) AddIdentification() *DocumentLineIdentification1 {
 	newValue := new(DocumentLineIdentification1)
 	d.Identification = append(d.Identification, newValue)
@@ -168,3 +174,55 @@ func (d *DocumentLineInformation1) AddAmount() *RemittanceAmount3 {
 	d.Amount = new(RemittanceAmount3)
 	return d.Amount
 }
+
+func (d *DocumentLineInformation1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	for _, elem := range d.Identification {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Id")
+		}
+	}
+	if d.Description != nil {
+		enc.Element("Desc", string(*d.Description))
+	}
+	if d.Amount != nil {
+		d.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	enc.End(tag)
+}
+
+func (d *DocumentLineInformation1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			elem := new(DocumentLineIdentification1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Identification = append(d.Identification, elem)
+		case "Desc":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.Description = (*Max2048Text)(&value)
+		case "Amt":
+			elem := new(RemittanceAmount3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Amount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the type of the document line identification.
 type DocumentLineType1 struct {
 
@@ -10,6 +12,10 @@ type DocumentLineType1 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (d *DocumentLineType1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentLineType1) AddCodeOrProprietary() *DocumentLineType1Choice {
 	d.CodeOrProprietary = new(DocumentLineType1Choice)
 	return d.CodeOrProprietary
@@ -18,3 +24,44 @@ func (d *DocumentLineType1) AddCodeOrProprietary() *DocumentLineType1Choice {
 func (d *DocumentLineType1) SetIssuer(value string) {
 	d.Issuer = (*Max35Text)(&value)
 }
+
+func (d *DocumentLineType1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.CodeOrProprietary != nil {
+		d.CodeOrProprietary.EncodeXMLFast(enc, "CdOrPrtry")
+	}
+	if d.Issuer != nil {
+		enc.Element("Issr", string(*d.Issuer))
+	}
+	enc.End(tag)
+}
+
+func (d *DocumentLineType1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "CdOrPrtry":
+			elem := new(DocumentLineType1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.CodeOrProprietary = elem
+		case "Issr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.Issuer = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
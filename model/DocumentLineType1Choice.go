@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the type of the document line identification.
 type DocumentLineType1Choice struct {
 
@@ -10,6 +12,10 @@ type DocumentLineType1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (d *DocumentLineType1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentLineType1Choice) SetCode(value string) {
 	d.Code = (*ExternalDocumentLineType1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (d *DocumentLineType1Choice) SetCode(value string) {
 func (d *DocumentLineType1Choice) SetProprietary(value string) {
 	d.Proprietary = (*Max35Text)(&value)
 }
+
+func (d *DocumentLineType1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if d.Code != nil {
+		enc.Element("Cd", string(*d.Code))
+	}
+	if d.Proprietary != nil {
+		enc.Element("Prtry", string(*d.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (d *DocumentLineType1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.Code = (*ExternalDocumentLineType1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			d.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -7,6 +7,10 @@ type DocumentNumber1 struct {
 	Number *DocumentNumber1Choice `xml:"Nb"`
 }
 
+func (d *DocumentNumber1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentNumber1) AddNumber() *DocumentNumber1Choice {
 	d.Number = new(DocumentNumber1Choice)
 	return d.Number
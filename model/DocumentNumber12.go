@@ -10,6 +10,10 @@ type DocumentNumber12 struct {
 	References []*Identification15 `xml:"Refs"`
 }
 
+func (d *DocumentNumber12) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentNumber12) AddNumber() *DocumentNumber5Choice {
 	d.Number = new(DocumentNumber5Choice)
 	return d.Number
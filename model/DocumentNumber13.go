@@ -7,6 +7,10 @@ type DocumentNumber13 struct {
 	Number *DocumentNumber5Choice `xml:"Nb"`
 }
 
+func (d *DocumentNumber13) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentNumber13) AddNumber() *DocumentNumber5Choice {
 	d.Number = new(DocumentNumber5Choice)
 	return d.Number
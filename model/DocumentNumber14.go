@@ -7,6 +7,10 @@ type DocumentNumber14 struct {
 	Number *DocumentNumber6Choice `xml:"Nb"`
 }
 
+func (d *DocumentNumber14) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentNumber14) AddNumber() *DocumentNumber6Choice {
 	d.Number = new(DocumentNumber6Choice)
 	return d.Number
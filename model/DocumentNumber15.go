@@ -10,6 +10,10 @@ type DocumentNumber15 struct {
 	References []*Identification24 `xml:"Refs"`
 }
 
+func (d *DocumentNumber15) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentNumber15) AddNumber() *DocumentNumber6Choice {
 	d.Number = new(DocumentNumber6Choice)
 	return d.Number
@@ -13,6 +13,10 @@ type DocumentNumber16Choice struct {
 	ProprietaryNumber *GenericIdentification163 `xml:"PrtryNb"`
 }
 
+func (d *DocumentNumber16Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentNumber16Choice) SetShortNumber(value string) {
 	d.ShortNumber = (*Exact3NumericText)(&value)
 }
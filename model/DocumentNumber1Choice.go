@@ -13,6 +13,10 @@ type DocumentNumber1Choice struct {
 	ProprietaryNumber *GenericIdentification19 `xml:"PrtryNb"`
 }
 
+func (d *DocumentNumber1Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentNumber1Choice) SetShortNumber(value string) {
 	d.ShortNumber = (*Exact3NumericText)(&value)
 }
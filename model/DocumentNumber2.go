@@ -10,6 +10,10 @@ type DocumentNumber2 struct {
 	References []*Identification2 `xml:"Refs"`
 }
 
+func (d *DocumentNumber2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentNumber2) AddNumber() *DocumentNumber1Choice {
 	d.Number = new(DocumentNumber1Choice)
 	return d.Number
@@ -13,6 +13,10 @@ type DocumentNumber4Choice struct {
 	ProprietaryNumber *GenericIdentification38 `xml:"PrtryNb"`
 }
 
+func (d *DocumentNumber4Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentNumber4Choice) SetShortNumber(value string) {
 	d.ShortNumber = (*Exact3NumericText)(&value)
 }
@@ -10,6 +10,10 @@ type DocumentNumber5 struct {
 	References []*Identification6 `xml:"Refs"`
 }
 
+func (d *DocumentNumber5) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentNumber5) AddNumber() *DocumentNumber1Choice {
 	d.Number = new(DocumentNumber1Choice)
 	return d.Number
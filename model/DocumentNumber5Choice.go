@@ -13,6 +13,10 @@ type DocumentNumber5Choice struct {
 	ProprietaryNumber *GenericIdentification36 `xml:"PrtryNb"`
 }
 
+func (d *DocumentNumber5Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentNumber5Choice) SetShortNumber(value string) {
 	d.ShortNumber = (*Exact3NumericText)(&value)
 }
@@ -10,6 +10,10 @@ type DocumentNumber6 struct {
 	References []*Identification7 `xml:"Refs"`
 }
 
+func (d *DocumentNumber6) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentNumber6) AddNumber() *DocumentNumber1Choice {
 	d.Number = new(DocumentNumber1Choice)
 	return d.Number
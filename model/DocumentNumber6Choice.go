@@ -13,6 +13,10 @@ type DocumentNumber6Choice struct {
 	ProprietaryNumber *GenericIdentification86 `xml:"PrtryNb"`
 }
 
+func (d *DocumentNumber6Choice) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentNumber6Choice) SetShortNumber(value string) {
 	d.ShortNumber = (*Exact3NumericText)(&value)
 }
@@ -10,6 +10,10 @@ type DocumentNumber9 struct {
 	References []*Identification11 `xml:"Refs"`
 }
 
+func (d *DocumentNumber9) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentNumber9) AddNumber() *DocumentNumber1Choice {
 	d.Number = new(DocumentNumber1Choice)
 	return d.Number
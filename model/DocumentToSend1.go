@@ -16,6 +16,10 @@ type DocumentToSend1 struct {
 	ExtendedMethodOfTransmission *Extended350Code `xml:"XtndedMtdOfTrnsmssn"`
 }
 
+func (d *DocumentToSend1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentToSend1) SetType(value string) {
 	d.Type = (*Max140Text)(&value)
 }
@@ -13,6 +13,10 @@ type DocumentToSend2 struct {
 	MethodOfTransmission *CommunicationMethod3Choice `xml:"MtdOfTrnsmssn"`
 }
 
+func (d *DocumentToSend2) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentToSend2) SetType(value string) {
 	d.Type = (*Max140Text)(&value)
 }
@@ -13,6 +13,10 @@ type DocumentToSend3 struct {
 	MethodOfTransmission *CommunicationMethod3Choice `xml:"MtdOfTrnsmssn"`
 }
 
+func (d *DocumentToSend3) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DocumentToSend3) SetType(value string) {
 	d.Type = (*Max140Text)(&value)
 }
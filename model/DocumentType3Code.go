@@ -1,3 +1,34 @@
 package model
 
 type DocumentType3Code string
+
+const (
+	DocumentType3CodeRADM DocumentType3Code = "RADM"
+	DocumentType3CodeRPIN DocumentType3Code = "RPIN"
+	DocumentType3CodeFXDR DocumentType3Code = "FXDR"
+	DocumentType3CodeDISP DocumentType3Code = "DISP"
+	DocumentType3CodePUOR DocumentType3Code = "PUOR"
+	DocumentType3CodeSCOR DocumentType3Code = "SCOR"
+)
+
+var documentType3CodeDescriptions = map[DocumentType3Code]string{
+	DocumentType3CodeRADM: "Remittance advice message: document is a remittance advice sent separately from the current transaction.",
+	DocumentType3CodeRPIN: "Related payment instruction: document is a linked payment instruction to which the current payment instruction is related.",
+	DocumentType3CodeFXDR: "Foreign exchange deal reference: document is a pre-agreed or pre-arranged foreign exchange transaction to which the payment transaction refers.",
+	DocumentType3CodeDISP: "Dispatch advice: document is a dispatch advice.",
+	DocumentType3CodePUOR: "Purchase order: document is a purchase order.",
+	DocumentType3CodeSCOR: "Structured communication reference: document is a structured communication reference provided by the creditor to identify the referred transaction.",
+}
+
+func (d DocumentType3Code) IsValid() bool {
+	_, ok := documentType3CodeDescriptions[d]
+	return ok
+}
+
+func (d DocumentType3Code) Description() string {
+	return documentType3CodeDescriptions[d]
+}
+
+func (d DocumentType3Code) Validate() error {
+	return validateEnumeration("DocumentType3Code", string(d), d.IsValid(), "RADM, RPIN, FXDR, DISP, PUOR, SCOR")
+}
@@ -1,3 +1,54 @@
 package model
 
 type DocumentType6Code string
+
+const (
+	DocumentType6CodeMSIN DocumentType6Code = "MSIN"
+	DocumentType6CodeCNFA DocumentType6Code = "CNFA"
+	DocumentType6CodeDNFA DocumentType6Code = "DNFA"
+	DocumentType6CodeCINV DocumentType6Code = "CINV"
+	DocumentType6CodeCREN DocumentType6Code = "CREN"
+	DocumentType6CodeDEBN DocumentType6Code = "DEBN"
+	DocumentType6CodeHIRI DocumentType6Code = "HIRI"
+	DocumentType6CodeSBIN DocumentType6Code = "SBIN"
+	DocumentType6CodeCMCN DocumentType6Code = "CMCN"
+	DocumentType6CodeSOAC DocumentType6Code = "SOAC"
+	DocumentType6CodeDISP DocumentType6Code = "DISP"
+	DocumentType6CodeBOLD DocumentType6Code = "BOLD"
+	DocumentType6CodeVCHR DocumentType6Code = "VCHR"
+	DocumentType6CodeAROI DocumentType6Code = "AROI"
+	DocumentType6CodeTSUT DocumentType6Code = "TSUT"
+	DocumentType6CodePUOR DocumentType6Code = "PUOR"
+)
+
+var documentType6CodeDescriptions = map[DocumentType6Code]string{
+	DocumentType6CodeMSIN: "Metered service invoice: document is an invoice claiming payment for the supply of metered services.",
+	DocumentType6CodeCNFA: "Credit note related to financial adjustment: document is a credit note for the final amount settled for a commercial transaction.",
+	DocumentType6CodeDNFA: "Debit note related to financial adjustment: document is a debit note for the final amount settled for a commercial transaction.",
+	DocumentType6CodeCINV: "Commercial invoice: document is an invoice.",
+	DocumentType6CodeCREN: "Credit note: document is a credit note.",
+	DocumentType6CodeDEBN: "Debit note: document is a debit note.",
+	DocumentType6CodeHIRI: "Hire invoice: document is an invoice for the hiring of human resources or renting goods or equipment.",
+	DocumentType6CodeSBIN: "Self billed invoice: document is an invoice issued by the debtor.",
+	DocumentType6CodeCMCN: "Commercial contract: document is an agreement between the parties, stipulating the terms and conditions of the delivery of goods or services.",
+	DocumentType6CodeSOAC: "Statement of account: document is a statement of the transactions posted to the debtor's account at the supplier.",
+	DocumentType6CodeDISP: "Dispatch advice: document is a dispatch advice.",
+	DocumentType6CodeBOLD: "Bill of lading: document is a shipping notice.",
+	DocumentType6CodeVCHR: "Voucher: document is an electronic payment document.",
+	DocumentType6CodeAROI: "Accounts receivable open item: document is a payment that applies to a specific source document.",
+	DocumentType6CodeTSUT: "Trade services utility transaction: document is a transaction identifier as assigned by the Trade Services Utility.",
+	DocumentType6CodePUOR: "Purchase order: document is a purchase order.",
+}
+
+func (d DocumentType6Code) IsValid() bool {
+	_, ok := documentType6CodeDescriptions[d]
+	return ok
+}
+
+func (d DocumentType6Code) Description() string {
+	return documentType6CodeDescriptions[d]
+}
+
+func (d DocumentType6Code) Validate() error {
+	return validateEnumeration("DocumentType6Code", string(d), d.IsValid(), "MSIN, CNFA, DNFA, CINV, CREN, DEBN, HIRI, SBIN, CMCN, SOAC, DISP, BOLD, VCHR, AROI, TSUT, PUOR")
+}
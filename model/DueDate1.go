@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the date by when the financial institutiion needs to provide a response.
 type DueDate1 struct {
 
@@ -10,10 +14,19 @@ type DueDate1 struct {
 	AdditionalInformation *Max140Text `xml:"AddtlInf,omitempty"`
 }
 
+func (d *DueDate1) Validate() error {
+	return ValidateElement(d)
+}
+
 func (d *DueDate1) SetDueDate(value string) {
 	d.DueDate = (*ISODate)(&value)
 }
 
+func (d *DueDate1) SetDueDateFromTime(value time.Time) {
+	d.DueDate = new(ISODate)
+	d.DueDate.FromTime(value)
+}
+
 func (d *DueDate1) SetAdditionalInformation(value string) {
 	d.AdditionalInformation = (*Max140Text)(&value)
 }
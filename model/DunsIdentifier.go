@@ -1,3 +1,9 @@
 package model
 
 type DunsIdentifier string
+
+var dunsIdentifierPattern = newPattern(`[0-9]{9,9}`)
+
+func (d DunsIdentifier) Validate() error {
+	return validatePattern("DunsIdentifier", string(d), dunsIdentifierPattern)
+}
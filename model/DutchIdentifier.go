@@ -1,3 +1,7 @@
 package model
 
 type DutchIdentifier string
+
+func (d DutchIdentifier) Validate() error {
+	return validateLength("DutchIdentifier", string(d), 1, 35)
+}
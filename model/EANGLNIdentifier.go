@@ -1,3 +1,9 @@
 package model
 
 type EANGLNIdentifier string
+
+var eanglnIdentifierPattern = newPattern(`[0-9]{13,13}`)
+
+func (e EANGLNIdentifier) Validate() error {
+	return validatePattern("EANGLNIdentifier", string(e), eanglnIdentifierPattern)
+}
@@ -10,6 +10,10 @@ type EUCapitalGain1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (e *EUCapitalGain1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EUCapitalGain1) SetStructured(value string) {
 	e.Structured = (*EUCapitalGain1Code)(&value)
 }
@@ -10,6 +10,10 @@ type EUCapitalGain3Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (e *EUCapitalGain3Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EUCapitalGain3Choice) SetCode(value string) {
 	e.Code = (*EUCapitalGain2Code)(&value)
 }
@@ -10,6 +10,10 @@ type EUCapitalGainType2Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (e *EUCapitalGainType2Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EUCapitalGainType2Choice) SetEUCapitalGain(value string) {
 	e.EUCapitalGain = (*EUCapitalGain2Code)(&value)
 }
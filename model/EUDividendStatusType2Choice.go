@@ -10,6 +10,10 @@ type EUDividendStatusType2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (e *EUDividendStatusType2Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EUDividendStatusType2Choice) SetCode(value string) {
 	e.Code = (*EUDividendStatus1Code)(&value)
 }
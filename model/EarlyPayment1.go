@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the payment terms of the underlying transaction.
 type EarlyPayment1 struct {
 
@@ -22,18 +28,36 @@ type EarlyPayment1 struct {
 	DuePayableAmountWithEarlyPayment *CurrencyAndAmount `xml:"DuePyblAmtWthEarlyPmt,omitempty"`
 }
 
+func (e *EarlyPayment1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EarlyPayment1) SetEarlyPaymentDate(value string) {
 	e.EarlyPaymentDate = (*ISODate)(&value)
 }
 
+func (e *EarlyPayment1) SetEarlyPaymentDateFromTime(value time.Time) {
+	e.EarlyPaymentDate = new(ISODate)
+	e.EarlyPaymentDate.FromTime(value)
+}
+
 func (e *EarlyPayment1) SetDiscountPercent(value string) {
 	e.DiscountPercent = (*PercentageRate)(&value)
 }
 
+func (e *EarlyPayment1) SetDiscountPercentFromDecimal(value decimal.Decimal) {
+	e.DiscountPercent = new(PercentageRate)
+	e.DiscountPercent.FromDecimal(value)
+}
+
 func (e *EarlyPayment1) SetDiscountAmount(value, currency string) {
 	e.DiscountAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (e *EarlyPayment1) SetDiscountAmountFromDecimal(value decimal.Decimal, currency string) {
+	e.DiscountAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *EarlyPayment1) AddEarlyPaymentTaxSpecification() *EarlyPaymentsVAT1 {
 	newValue := new(EarlyPaymentsVAT1)
 	e.EarlyPaymentTaxSpecification = append(e.EarlyPaymentTaxSpecification, newValue)
@@ -184,6 +208,14 @@ func (e *EarlyPayment1) SetEarlyPaymentTaxTotal(value, currency string) {
 	e.EarlyPaymentTaxTotal = NewCurrencyAndAmount(value, currency)
 }
 
+func (e *EarlyPayment1) SetEarlyPaymentTaxTotalFromDecimal(value decimal.Decimal, currency string) {
+	e.EarlyPaymentTaxTotal = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *EarlyPayment1) SetDuePayableAmountWithEarlyPayment(value, currency string) {
 	e.DuePayableAmountWithEarlyPayment = NewCurrencyAndAmount(value, currency)
 }
+
+func (e *EarlyPayment1) SetDuePayableAmountWithEarlyPaymentFromDecimal(value decimal.Decimal, currency string) {
+	e.DuePayableAmountWithEarlyPayment = NewCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the payment terms of the underlying transaction.
 type EarlyPaymentsVAT1 struct {
 
@@ -13,10 +17,19 @@ type EarlyPaymentsVAT1 struct {
 	DiscountTaxAmount *CurrencyAndAmount `xml:"DscntTaxAmt"`
 }
 
+func (e *EarlyPaymentsVAT1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EarlyPaymentsVAT1) SetTaxRate(value string) {
 	e.TaxRate = (*PercentageRate)(&value)
 }
 
+func (e *EarlyPaymentsVAT1) SetTaxRateFromDecimal(value decimal.Decimal) {
+	e.TaxRate = new(PercentageRate)
+	e.TaxRate.FromDecimal(value)
+}
+
 func (e *EarlyPaymentsVAT1) SetDiscountTaxType(value string) {
 	e.DiscountTaxType = (*Max4Text)(&value)
 }
@@ -24,3 +37,7 @@ func (e *EarlyPaymentsVAT1) SetDiscountTaxType(value string) {
 func (e *EarlyPaymentsVAT1) SetDiscountTaxAmount(value, currency string) {
 	e.DiscountTaxAmount = NewCurrencyAndAmount(value, currency)
 }
+
+func (e *EarlyPaymentsVAT1) SetDiscountTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	e.DiscountTaxAmount = NewCurrencyAndAmount(value.String(), currency)
+}
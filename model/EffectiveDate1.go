@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Date and date parameters.
 type EffectiveDate1 struct {
 
@@ -10,10 +14,19 @@ type EffectiveDate1 struct {
 	EffectiveDateParameter *ExternalEffectiveDateParameter1Code `xml:"FctvDtParam,omitempty"`
 }
 
+func (e *EffectiveDate1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EffectiveDate1) SetEffectiveDate(value string) {
 	e.EffectiveDate = (*ISODate)(&value)
 }
 
+func (e *EffectiveDate1) SetEffectiveDateFromTime(value time.Time) {
+	e.EffectiveDate = new(ISODate)
+	e.EffectiveDate.FromTime(value)
+}
+
 func (e *EffectiveDate1) SetEffectiveDateParameter(value string) {
 	e.EffectiveDateParameter = (*ExternalEffectiveDateParameter1Code)(&value)
 }
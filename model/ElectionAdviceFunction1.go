@@ -16,6 +16,10 @@ type ElectionAdviceFunction1 struct {
 	AgentCAElectionAmendmentRequestIdentification *DocumentIdentification8 `xml:"AgtCAElctnAmdmntReqId,omitempty"`
 }
 
+func (e *ElectionAdviceFunction1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ElectionAdviceFunction1) SetElectionType(value string) {
 	e.ElectionType = (*ElectionType1Code)(&value)
 }
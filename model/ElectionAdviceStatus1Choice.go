@@ -10,6 +10,10 @@ type ElectionAdviceStatus1Choice struct {
 	RejectedStatus *CorporateActionInstructionRejectionStatus1 `xml:"RjctdSts"`
 }
 
+func (e *ElectionAdviceStatus1Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ElectionAdviceStatus1Choice) AddProcessedStatus() *CorporateActionInstructionProcessingStatus1 {
 	e.ProcessedStatus = new(CorporateActionInstructionProcessingStatus1)
 	return e.ProcessedStatus
@@ -10,6 +10,10 @@ type ElectionAmendmentStatus1Choice struct {
 	RejectedStatus *CorporateActionAmendmentRejectionStatus1 `xml:"RjctdSts"`
 }
 
+func (e *ElectionAmendmentStatus1Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ElectionAmendmentStatus1Choice) AddProcessedStatus() *CorporateActionAmendmentProcessingStatus1 {
 	e.ProcessedStatus = new(CorporateActionAmendmentProcessingStatus1)
 	return e.ProcessedStatus
@@ -10,6 +10,10 @@ type ElectionCancellationStatus1Choice struct {
 	RejectedStatus *CorporateActionCancellationRejectionStatus1 `xml:"RjctdSts"`
 }
 
+func (e *ElectionCancellationStatus1Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ElectionCancellationStatus1Choice) AddProcessedStatus() *CorporateActionCancellationProcessingStatus1 {
 	e.ProcessedStatus = new(CorporateActionCancellationProcessingStatus1)
 	return e.ProcessedStatus
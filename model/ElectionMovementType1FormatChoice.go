@@ -10,6 +10,10 @@ type ElectionMovementType1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (e *ElectionMovementType1FormatChoice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ElectionMovementType1FormatChoice) SetCode(value string) {
 	e.Code = (*ElectionMovementType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ElectionTypeFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (e *ElectionTypeFormat1Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ElectionTypeFormat1Choice) SetCode(value string) {
 	e.Code = (*ElectionMovementType2Code)(&value)
 }
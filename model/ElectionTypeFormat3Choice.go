@@ -10,6 +10,10 @@ type ElectionTypeFormat3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (e *ElectionTypeFormat3Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ElectionTypeFormat3Choice) SetCode(value string) {
 	e.Code = (*ElectionMovementType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type ElectionTypeFormat4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (e *ElectionTypeFormat4Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ElectionTypeFormat4Choice) SetCode(value string) {
 	e.Code = (*ElectionMovementType2Code)(&value)
 }
@@ -16,6 +16,10 @@ type ElementIdentification1 struct {
 	ElementValue *Max140Text `xml:"ElmtVal,omitempty"`
 }
 
+func (e *ElementIdentification1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ElementIdentification1) SetDocumentIndex(value string) {
 	e.DocumentIndex = (*Max3NumericText)(&value)
 }
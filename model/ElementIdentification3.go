@@ -13,6 +13,10 @@ type ElementIdentification3 struct {
 	ElementValue *Max140Text `xml:"ElmtVal,omitempty"`
 }
 
+func (e *ElementIdentification3) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ElementIdentification3) SetElementPath(value string) {
 	e.ElementPath = (*Max350Text)(&value)
 }
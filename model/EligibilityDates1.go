@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Dates determining the entitlement.
 type EligibilityDates1 struct {
 
@@ -7,6 +11,15 @@ type EligibilityDates1 struct {
 	EntitlementFixingDate *ISODate `xml:"EntitlmntFxgDt"`
 }
 
+func (e *EligibilityDates1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EligibilityDates1) SetEntitlementFixingDate(value string) {
 	e.EntitlementFixingDate = (*ISODate)(&value)
 }
+
+func (e *EligibilityDates1) SetEntitlementFixingDateFromTime(value time.Time) {
+	e.EntitlementFixingDate = new(ISODate)
+	e.EntitlementFixingDate.FromTime(value)
+}
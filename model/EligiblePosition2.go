@@ -16,6 +16,10 @@ type EligiblePosition2 struct {
 	RightsHolder []*PartyIdentification9Choice `xml:"RghtsHldr,omitempty"`
 }
 
+func (e *EligiblePosition2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EligiblePosition2) SetAccountIdentification(value string) {
 	e.AccountIdentification = (*Max35Text)(&value)
 }
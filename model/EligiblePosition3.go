@@ -16,6 +16,10 @@ type EligiblePosition3 struct {
 	RightsHolder []*PartyIdentification9Choice `xml:"RghtsHldr,omitempty"`
 }
 
+func (e *EligiblePosition3) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EligiblePosition3) SetAccountIdentification(value string) {
 	e.AccountIdentification = (*Max35Text)(&value)
 }
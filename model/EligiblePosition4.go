@@ -16,6 +16,10 @@ type EligiblePosition4 struct {
 	RightsHolder []*PartyIdentification39 `xml:"RghtsHldr,omitempty"`
 }
 
+func (e *EligiblePosition4) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EligiblePosition4) SetAccountIdentification(value string) {
 	e.AccountIdentification = (*Max35Text)(&value)
 }
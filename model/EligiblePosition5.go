@@ -16,6 +16,10 @@ type EligiblePosition5 struct {
 	RightsHolder []*PartyIdentification40Choice `xml:"RghtsHldr,omitempty"`
 }
 
+func (e *EligiblePosition5) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EligiblePosition5) SetAccountIdentification(value string) {
 	e.AccountIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type EligiblePosition6 struct {
 	RightsHolder []*PartyIdentification71 `xml:"RghtsHldr,omitempty"`
 }
 
+func (e *EligiblePosition6) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EligiblePosition6) SetAccountIdentification(value string) {
 	e.AccountIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type EnabledStatusReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (e *EnabledStatusReason1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EnabledStatusReason1) AddCode() *EnabledStatusReason2Choice {
 	e.Code = new(EnabledStatusReason2Choice)
 	return e.Code
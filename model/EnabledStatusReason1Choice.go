@@ -10,8 +10,16 @@ type EnabledStatusReason1Choice struct {
 	Reason []*EnabledStatusReason1 `xml:"Rsn"`
 }
 
-func (e *EnabledStatusReason1Choice) SetNoSpecifiedReason(value string) {
+func (e *EnabledStatusReason1Choice) Validate() error {
+	return ValidateElement(e)
+}
+
+func (e *EnabledStatusReason1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	e.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (e *EnabledStatusReason1Choice) AddReason() *EnabledStatusReason1 {
@@ -10,6 +10,10 @@ type EnabledStatusReason2Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (e *EnabledStatusReason2Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EnabledStatusReason2Choice) SetCode(value string) {
 	e.Code = (*EnabledStatusReason1Code)(&value)
 }
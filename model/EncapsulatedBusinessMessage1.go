@@ -16,6 +16,10 @@ type EncapsulatedBusinessMessage1 struct {
 	Message *StrictPayload `xml:"Msg"`
 }
 
+func (e *EncapsulatedBusinessMessage1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EncapsulatedBusinessMessage1) AddHeader() *BusinessApplicationHeader1 {
 	e.Header = new(BusinessApplicationHeader1)
 	return e.Header
@@ -29,6 +33,11 @@ func (e *EncapsulatedBusinessMessage1) SetPartial(value string) {
 	e.Partial = (*YesNoIndicator)(&value)
 }
 
+func (e *EncapsulatedBusinessMessage1) SetPartialFromBool(value bool) {
+	e.Partial = new(YesNoIndicator)
+	e.Partial.FromBool(value)
+}
+
 func (e *EncapsulatedBusinessMessage1) AddMessage() *StrictPayload {
 	e.Message = new(StrictPayload)
 	return e.Message
@@ -10,6 +10,10 @@ type EncapsulatedContent1 struct {
 	Content *Max10000Binary `xml:"Cntt,omitempty"`
 }
 
+func (e *EncapsulatedContent1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EncapsulatedContent1) SetContentType(value string) {
 	e.ContentType = (*ContentType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type EncapsulatedContent2 struct {
 	Content *Max100KBinary `xml:"Cntt,omitempty"`
 }
 
+func (e *EncapsulatedContent2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EncapsulatedContent2) SetContentType(value string) {
 	e.ContentType = (*ContentType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type EncapsulatedContent3 struct {
 	Content *Max100KBinary `xml:"Cntt,omitempty"`
 }
 
+func (e *EncapsulatedContent3) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EncapsulatedContent3) SetContentType(value string) {
 	e.ContentType = (*ContentType2Code)(&value)
 }
@@ -13,6 +13,10 @@ type EncryptedContent1 struct {
 	EncryptedData *Max10000Binary `xml:"NcrptdData"`
 }
 
+func (e *EncryptedContent1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EncryptedContent1) SetContentType(value string) {
 	e.ContentType = (*ContentType1Code)(&value)
 }
@@ -13,6 +13,10 @@ type EncryptedContent2 struct {
 	EncryptedData *Max10000Binary `xml:"NcrptdData"`
 }
 
+func (e *EncryptedContent2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EncryptedContent2) SetContentType(value string) {
 	e.ContentType = (*ContentType1Code)(&value)
 }
@@ -13,6 +13,10 @@ type EncryptedContent3 struct {
 	EncryptedData *Max100KBinary `xml:"NcrptdData"`
 }
 
+func (e *EncryptedContent3) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EncryptedContent3) SetContentType(value string) {
 	e.ContentType = (*ContentType2Code)(&value)
 }
@@ -16,6 +16,10 @@ type Entitlement1 struct {
 	CashDistributionDetails []*CashEntitlement1 `xml:"CshDstrbtnDtls,omitempty"`
 }
 
+func (e *Entitlement1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *Entitlement1) AddAccountOwnerIdentification() *PartyIdentification2Choice {
 	e.AccountOwnerIdentification = new(PartyIdentification2Choice)
 	return e.AccountOwnerIdentification
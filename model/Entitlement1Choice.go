@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of entitlement calculation method.
 type Entitlement1Choice struct {
 
@@ -10,10 +14,19 @@ type Entitlement1Choice struct {
 	EntitlementDescription *Max35Text `xml:"EntitlmntDesc"`
 }
 
+func (e *Entitlement1Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *Entitlement1Choice) SetEntitlementRatio(value string) {
 	e.EntitlementRatio = (*DecimalNumber)(&value)
 }
 
+func (e *Entitlement1Choice) SetEntitlementRatioFromDecimal(value decimal.Decimal) {
+	e.EntitlementRatio = new(DecimalNumber)
+	e.EntitlementRatio.FromDecimal(value)
+}
+
 func (e *Entitlement1Choice) SetEntitlementDescription(value string) {
 	e.EntitlementDescription = (*Max35Text)(&value)
 }
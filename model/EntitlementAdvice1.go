@@ -19,6 +19,10 @@ type EntitlementAdvice1 struct {
 	AccountAndDistributionDetails []*Entitlement1 `xml:"AcctAndDstrbtnDtls"`
 }
 
+func (e *EntitlementAdvice1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EntitlementAdvice1) AddOptionType() *CorporateActionOption1FormatChoice {
 	e.OptionType = new(CorporateActionOption1FormatChoice)
 	return e.OptionType
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the parameters, such as dates, used to calculate the entitlement to vote at a general meeting.
 type EntitlementAssessment1 struct {
 
@@ -43,6 +49,10 @@ type EntitlementAssessment1 struct {
 	EntitlementRatio *DecimalNumber `xml:"EntitlmntRatio,omitempty"`
 }
 
+func (e *EntitlementAssessment1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EntitlementAssessment1) AddSecuritiesBlockingDeadline() *DateFormat2Choice {
 	e.SecuritiesBlockingDeadline = new(DateFormat2Choice)
 	return e.SecuritiesBlockingDeadline
@@ -62,6 +72,11 @@ func (e *EntitlementAssessment1) SetSecuritiesBlockingPeriodEndDate(value string
 	e.SecuritiesBlockingPeriodEndDate = (*ISODateTime)(&value)
 }
 
+func (e *EntitlementAssessment1) SetSecuritiesBlockingPeriodEndDateFromTime(value time.Time) {
+	e.SecuritiesBlockingPeriodEndDate = new(ISODateTime)
+	e.SecuritiesBlockingPeriodEndDate.FromTime(value)
+}
+
 func (e *EntitlementAssessment1) AddEntitlementFixingDate() *DateFormat3Choice {
 	e.EntitlementFixingDate = new(DateFormat3Choice)
 	return e.EntitlementFixingDate
@@ -104,3 +119,8 @@ func (e *EntitlementAssessment1) SetEntitlementDescription(value string) {
 func (e *EntitlementAssessment1) SetEntitlementRatio(value string) {
 	e.EntitlementRatio = (*DecimalNumber)(&value)
 }
+
+func (e *EntitlementAssessment1) SetEntitlementRatioFromDecimal(value decimal.Decimal) {
+	e.EntitlementRatio = new(DecimalNumber)
+	e.EntitlementRatio.FromDecimal(value)
+}
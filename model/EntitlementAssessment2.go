@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the parameters, such as dates, used to calculate the entitlement to vote at a general meeting.
 type EntitlementAssessment2 struct {
 
@@ -40,6 +44,10 @@ type EntitlementAssessment2 struct {
 	Entitlement *Entitlement1Choice `xml:"Entitlmnt,omitempty"`
 }
 
+func (e *EntitlementAssessment2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EntitlementAssessment2) AddSecuritiesBlockingDeadline() *DateFormat2Choice {
 	e.SecuritiesBlockingDeadline = new(DateFormat2Choice)
 	return e.SecuritiesBlockingDeadline
@@ -59,6 +67,11 @@ func (e *EntitlementAssessment2) SetSecuritiesBlockingPeriodEndDate(value string
 	e.SecuritiesBlockingPeriodEndDate = (*ISODateTime)(&value)
 }
 
+func (e *EntitlementAssessment2) SetSecuritiesBlockingPeriodEndDateFromTime(value time.Time) {
+	e.SecuritiesBlockingPeriodEndDate = new(ISODateTime)
+	e.SecuritiesBlockingPeriodEndDate.FromTime(value)
+}
+
 func (e *EntitlementAssessment2) AddEntitlementFixingDate() *DateFormat3Choice {
 	e.EntitlementFixingDate = new(DateFormat3Choice)
 	return e.EntitlementFixingDate
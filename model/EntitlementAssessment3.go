@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the parameters, such as dates, used to calculate the entitlement to vote at a general meeting.
 type EntitlementAssessment3 struct {
 
@@ -40,6 +44,10 @@ type EntitlementAssessment3 struct {
 	Entitlement *Entitlement1Choice `xml:"Entitlmnt,omitempty"`
 }
 
+func (e *EntitlementAssessment3) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EntitlementAssessment3) AddSecuritiesBlockingDeadline() *DateFormat29Choice {
 	e.SecuritiesBlockingDeadline = new(DateFormat29Choice)
 	return e.SecuritiesBlockingDeadline
@@ -59,6 +67,11 @@ func (e *EntitlementAssessment3) SetSecuritiesBlockingPeriodEndDate(value string
 	e.SecuritiesBlockingPeriodEndDate = (*ISODateTime)(&value)
 }
 
+func (e *EntitlementAssessment3) SetSecuritiesBlockingPeriodEndDateFromTime(value time.Time) {
+	e.SecuritiesBlockingPeriodEndDate = new(ISODateTime)
+	e.SecuritiesBlockingPeriodEndDate.FromTime(value)
+}
+
 func (e *EntitlementAssessment3) AddEntitlementFixingDate() *DateFormat1 {
 	e.EntitlementFixingDate = new(DateFormat1)
 	return e.EntitlementFixingDate
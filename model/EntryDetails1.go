@@ -10,6 +10,10 @@ type EntryDetails1 struct {
 	TransactionDetails []*EntryTransaction2 `xml:"TxDtls,omitempty"`
 }
 
+func (e *EntryDetails1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EntryDetails1) AddBatch() *BatchInformation2 {
 	e.Batch = new(BatchInformation2)
 	return e.Batch
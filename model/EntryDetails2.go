@@ -10,6 +10,10 @@ type EntryDetails2 struct {
 	TransactionDetails []*EntryTransaction3 `xml:"TxDtls,omitempty"`
 }
 
+func (e *EntryDetails2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EntryDetails2) AddBatch() *BatchInformation2 {
 	e.Batch = new(BatchInformation2)
 	return e.Batch
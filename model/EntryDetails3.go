@@ -10,6 +10,10 @@ type EntryDetails3 struct {
 	TransactionDetails []*EntryTransaction4 `xml:"TxDtls,omitempty"`
 }
 
+func (e *EntryDetails3) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EntryDetails3) AddBatch() *BatchInformation2 {
 	e.Batch = new(BatchInformation2)
 	return e.Batch
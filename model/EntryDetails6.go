@@ -10,6 +10,10 @@ type EntryDetails6 struct {
 	TransactionDetails []*EntryTransaction7 `xml:"TxDtls,omitempty"`
 }
 
+func (e *EntryDetails6) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EntryDetails6) AddBatch() *BatchInformation2 {
 	e.Batch = new(BatchInformation2)
 	return e.Batch
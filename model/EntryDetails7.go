@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Identifies the underlying transaction(s) and/or batched entries.
 type EntryDetails7 struct {
 
@@ -10,6 +12,10 @@ type EntryDetails7 struct {
 	TransactionDetails []*EntryTransaction8 `xml:"TxDtls,omitempty"`
 }
 
+func (e *EntryDetails7) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EntryDetails7) AddBatch() *BatchInformation2 {
 	e.Batch = new(BatchInformation2)
 	return e.Batch
@@ -21,6 +27,49 @@ func (e *EntryDetails7) AddTransactionDetails() *EntryTransaction8 {
 	return newValue
 }
 
+func (e *EntryDetails7) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if e.Batch != nil {
+		e.Batch.EncodeXMLFast(enc, "Btch")
+	}
+	for _, elem := range e.TransactionDetails {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "TxDtls")
+		}
+	}
+	enc.End(tag)
+}
+
+func (e *EntryDetails7) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Btch":
+			elem := new(BatchInformation2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.Batch = elem
+		case "TxDtls":
+			elem := new(EntryTransaction8)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.TransactionDetails = append(e.TransactionDetails, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -0,0 +1,71 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Identifies the underlying transaction(s) and/or batched entries.
+type EntryDetails9 struct {
+
+	// Provides details on batched transactions.
+	Batch *BatchInformation2 `xml:"Btch,omitempty"`
+
+	// Provides information on the underlying transaction(s).
+	TransactionDetails []*EntryTransaction10 `xml:"TxDtls,omitempty"`
+}
+
+func (e *EntryDetails9) Validate() error {
+	return ValidateElement(e)
+}
+
+func (e *EntryDetails9) AddBatch() *BatchInformation2 {
+	e.Batch = new(BatchInformation2)
+	return e.Batch
+}
+
+func (e *EntryDetails9) AddTransactionDetails() *EntryTransaction10 {
+	newValue := new(EntryTransaction10)
+	e.TransactionDetails = append(e.TransactionDetails, newValue)
+	return newValue
+}
+
+func (e *EntryDetails9) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if e.Batch != nil {
+		e.Batch.EncodeXMLFast(enc, "Btch")
+	}
+	for _, elem := range e.TransactionDetails {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "TxDtls")
+		}
+	}
+	enc.End(tag)
+}
+
+func (e *EntryDetails9) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Btch":
+			elem := new(BatchInformation2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.Batch = elem
+		case "TxDtls":
+			elem := new(EntryTransaction10)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.TransactionDetails = append(e.TransactionDetails, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Specifies the status of an entry on the books of the account servicer.
+type EntryStatus1Choice struct {
+
+	// Status of an entry, as published in an external code set.
+	Code *ExternalEntryStatus1Code `xml:"Cd"`
+
+	// Status of an entry, in a proprietary form.
+	Proprietary *Max35Text `xml:"Prtry"`
+}
+
+func (e *EntryStatus1Choice) Validate() error {
+	return ValidateElement(e)
+}
+
+func (e *EntryStatus1Choice) SetCode(value string) {
+	e.Code = (*ExternalEntryStatus1Code)(&value)
+}
+
+func (e *EntryStatus1Choice) SetProprietary(value string) {
+	e.Proprietary = (*Max35Text)(&value)
+}
+
+func (e *EntryStatus1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if e.Code != nil {
+		enc.Element("Cd", string(*e.Code))
+	}
+	if e.Proprietary != nil {
+		enc.Element("Prtry", string(*e.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (e *EntryStatus1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			e.Code = (*ExternalEntryStatus1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			e.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
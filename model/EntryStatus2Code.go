@@ -1,3 +1,28 @@
 package model
 
 type EntryStatus2Code string
+
+const (
+	EntryStatus2CodeBOOK EntryStatus2Code = "BOOK"
+	EntryStatus2CodePDNG EntryStatus2Code = "PDNG"
+	EntryStatus2CodeINFO EntryStatus2Code = "INFO"
+)
+
+var entryStatus2CodeDescriptions = map[EntryStatus2Code]string{
+	EntryStatus2CodeBOOK: "Booked: booked means that the transfer of money has been completed between account servicer and account owner.",
+	EntryStatus2CodePDNG: "Pending: booking on the account owner's account in the account servicer's ledger has not been completed.",
+	EntryStatus2CodeINFO: "Information: entry is only provided for information, and no booking on the account owner's account in the account servicer's ledger has been performed.",
+}
+
+func (e EntryStatus2Code) IsValid() bool {
+	_, ok := entryStatus2CodeDescriptions[e]
+	return ok
+}
+
+func (e EntryStatus2Code) Description() string {
+	return entryStatus2CodeDescriptions[e]
+}
+
+func (e EntryStatus2Code) Validate() error {
+	return validateEnumeration("EntryStatus2Code", string(e), e.IsValid(), "BOOK, PDNG, INFO")
+}
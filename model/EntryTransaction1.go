@@ -42,7 +42,7 @@ type EntryTransaction1 struct {
 	Purpose *Purpose1Choice `xml:"Purp,omitempty"`
 
 	// Information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation1 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation1 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Information that enables the matching, ie, reconciliation, of a payment with the items that the payment is intended to settle, eg, commercial invoices in an account receivable system.
 	RemittanceInformation *RemittanceInformation1 `xml:"RmtInf,omitempty"`
@@ -75,6 +75,10 @@ type EntryTransaction1 struct {
 	AdditionalTransactionInformation *Max500Text `xml:"AddtlTxInf,omitempty"`
 }
 
+func (e *EntryTransaction1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EntryTransaction1) AddReferences() *TransactionReferences1 {
 	e.References = new(TransactionReferences1)
 	return e.References
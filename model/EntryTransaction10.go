@@ -0,0 +1,511 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Identifies the underlying transaction.
+type EntryTransaction10 struct {
+
+	// Provides the identification of the underlying transaction.
+	References *TransactionReferences6 `xml:"Refs,omitempty"`
+
+	// Amount of money in the cash transaction.
+	Amount *ActiveOrHistoricCurrencyAndAmount `xml:"Amt,omitempty"`
+
+	// Indicates whether the transaction is a credit or a debit transaction.
+	CreditDebitIndicator *CreditDebitCode `xml:"CdtDbtInd,omitempty"`
+
+	// Provides detailed information on the original amount.
+	//
+	// Usage: This component (on transaction level) should be used in case booking is for a single transaction and the original amount is different from the entry amount. It can also be used in case individual original amounts are provided in case of a batch or aggregate booking.
+	AmountDetails *AmountAndCurrencyExchange3 `xml:"AmtDtls,omitempty"`
+
+	// Indicates when the booked amount of money will become available, that is can be accessed and starts generating interest.
+	//
+	// Usage: This type of information is used in the US and is linked to particular instruments such as cheques.
+	// Example: When a cheque is deposited, it will be booked on the deposit day, but the amount of money will only be accessible as of the indicated availability day (according to national banking regulations).
+	Availability []*CashAvailability1 `xml:"Avlbty,omitempty"`
+
+	// Set of elements used to fully identify the type of underlying transaction resulting in an entry.
+	BankTransactionCode *BankTransactionCodeStructure4 `xml:"BkTxCd,omitempty"`
+
+	// Provides information on the charges, pre-advised or included in the entry amount.
+	//
+	// Usage: This component (on transaction level) can be used in case the booking is for a single transaction, and charges are included in the entry amount. It can also be used in case individual charge amounts are applied to individual transactions in case of a batch or aggregate amount booking.
+	Charges *Charges6 `xml:"Chrgs,omitempty"`
+
+	// Provides details of the interest amount included in the entry amount.
+	//
+	// Usage: This component (on transaction level) can be used if the booking is for a single transaction, and interest amount is included in the entry amount.  It can also be used if individual interest amounts are applied to individual transactions in the case of a batch or aggregate amount booking.
+	Interest *TransactionInterest4 `xml:"Intrst,omitempty"`
+
+	// Set of elements used to identify the parties related to the underlying transaction.
+	RelatedParties *TransactionParties6 `xml:"RltdPties,omitempty"`
+
+	// Set of elements used to identify the agents related to the underlying transaction.
+	RelatedAgents *TransactionAgents5 `xml:"RltdAgts,omitempty"`
+
+	// User community specific instrument.
+	//
+	// Usage: This element is used to specify a local instrument, local clearing option and/or further qualify the service or service level.
+	LocalInstrument *LocalInstrument2Choice `xml:"LclInstrm,omitempty"`
+
+	// Underlying reason for the payment transaction.
+	// Usage: Purpose is used by the end-customers, that is initiating party, (ultimate) debtor, (ultimate) creditor to provide information concerning the nature of the payment. Purpose is a content element, which is not used for processing by any of the agents involved in the payment chain.
+	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
+
+	// Provides information related to the handling of the remittance information by any of the agents in the transaction processing chain.
+	RelatedRemittanceInformation []*RemittanceLocation7 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
+
+	// Structured information that enables the matching, that is reconciliation, of a payment with the items that the payment is intended to settle, such as commercial invoices in an account receivable system.
+	RemittanceInformation *RemittanceInformation16 `xml:"RmtInf,omitempty"`
+
+	// Set of elements used to identify the dates related to the underlying transactions.
+	RelatedDates *TransactionDates3 `xml:"RltdDts,omitempty"`
+
+	// Set of elements used to identify the price information related to the underlying transaction.
+	RelatedPrice *TransactionPrice4Choice `xml:"RltdPric,omitempty"`
+
+	// Set of elements used to identify the related quantities, such as securities, in the underlying transaction.
+	RelatedQuantities []*TransactionQuantities3Choice `xml:"RltdQties,omitempty"`
+
+	// Identification of a security, as assigned under a formal or proprietary identification scheme.
+	FinancialInstrumentIdentification *SecurityIdentification19 `xml:"FinInstrmId,omitempty"`
+
+	// Provides details on the tax.
+	Tax *TaxInformation8 `xml:"Tax,omitempty"`
+
+	// Provides the return information.
+	ReturnInformation *PaymentReturnReason5 `xml:"RtrInf,omitempty"`
+
+	// Set of elements used to identify the underlying corporate action.
+	CorporateAction *CorporateAction9 `xml:"CorpActn,omitempty"`
+
+	// Safekeeping or investment account. A safekeeping account is an account on which a securities entry is made. An investment account is an account between an investor(s) and a fund manager or a fund. The account can contain holdings in any investment fund or investment fund class managed (or distributed) by the fund manager, within the same fund family.
+	SafekeepingAccount *SecuritiesAccount19 `xml:"SfkpgAcct,omitempty"`
+
+	// Provides the details of a cash deposit for an amount of money in cash notes and/or coins.
+	CashDeposit []*CashDeposit1 `xml:"CshDpst,omitempty"`
+
+	// Provides the data related to the card (number, scheme), terminal (number, identification) and transactional data used to uniquely identify a card transaction.
+	CardTransaction *CardTransaction17 `xml:"CardTx,omitempty"`
+
+	// Further details of the transaction.
+	AdditionalTransactionInformation *Max500Text `xml:"AddtlTxInf,omitempty"`
+
+	// Additional information that cannot be captured in the structured elements and/or any other specific block.
+	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
+}
+
+func (e *EntryTransaction10) Validate() error {
+	return ValidateElement(e)
+}
+
+func (e *EntryTransaction10) AddReferences() *TransactionReferences6 {
+	e.References = new(TransactionReferences6)
+	return e.References
+}
+
+func (e *EntryTransaction10) SetAmount(value, currency string) {
+	e.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (e *EntryTransaction10) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	e.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (e *EntryTransaction10) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
+	e.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
+}
+
+func (e *EntryTransaction10) AddAmountDetails() *AmountAndCurrencyExchange3 {
+	e.AmountDetails = new(AmountAndCurrencyExchange3)
+	return e.AmountDetails
+}
+
+func (e *EntryTransaction10) AddAvailability() *CashAvailability1 {
+	newValue := new(CashAvailability1)
+	e.Availability = append(e.Availability, newValue)
+	return newValue
+}
+
+func (e *EntryTransaction10) AddBankTransactionCode() *BankTransactionCodeStructure4 {
+	e.BankTransactionCode = new(BankTransactionCodeStructure4)
+	return e.BankTransactionCode
+}
+
+func (e *EntryTransaction10) AddCharges() *Charges6 {
+	e.Charges = new(Charges6)
+	return e.Charges
+}
+
+func (e *EntryTransaction10) AddInterest() *TransactionInterest4 {
+	e.Interest = new(TransactionInterest4)
+	return e.Interest
+}
+
+func (e *EntryTransaction10) AddRelatedParties() *TransactionParties6 {
+	e.RelatedParties = new(TransactionParties6)
+	return e.RelatedParties
+}
+
+func (e *EntryTransaction10) AddRelatedAgents() *TransactionAgents5 {
+	e.RelatedAgents = new(TransactionAgents5)
+	return e.RelatedAgents
+}
+
+func (e *EntryTransaction10) AddLocalInstrument() *LocalInstrument2Choice {
+	e.LocalInstrument = new(LocalInstrument2Choice)
+	return e.LocalInstrument
+}
+
+func (e *EntryTransaction10) AddPurpose() *Purpose2Choice {
+	e.Purpose = new(Purpose2Choice)
+	return e.Purpose
+}
+
+func (e *EntryTransaction10) AddRelatedRemittanceInformation() *RemittanceLocation7 {
+	newValue := new(RemittanceLocation7)
+	e.RelatedRemittanceInformation = append(e.RelatedRemittanceInformation, newValue)
+	return newValue
+}
+
+func (e *EntryTransaction10) AddRemittanceInformation() *RemittanceInformation16 {
+	e.RemittanceInformation = new(RemittanceInformation16)
+	return e.RemittanceInformation
+}
+
+func (e *EntryTransaction10) AddRelatedDates() *TransactionDates3 {
+	e.RelatedDates = new(TransactionDates3)
+	return e.RelatedDates
+}
+
+func (e *EntryTransaction10) AddRelatedPrice() *TransactionPrice4Choice {
+	e.RelatedPrice = new(TransactionPrice4Choice)
+	return e.RelatedPrice
+}
+
+func (e *EntryTransaction10) AddRelatedQuantities() *TransactionQuantities3Choice {
+	newValue := new(TransactionQuantities3Choice)
+	e.RelatedQuantities = append(e.RelatedQuantities, newValue)
+	return newValue
+}
+
+func (e *EntryTransaction10) AddFinancialInstrumentIdentification() *SecurityIdentification19 {
+	e.FinancialInstrumentIdentification = new(SecurityIdentification19)
+	return e.FinancialInstrumentIdentification
+}
+
+func (e *EntryTransaction10) AddTax() *TaxInformation8 {
+	e.Tax = new(TaxInformation8)
+	return e.Tax
+}
+
+func (e *EntryTransaction10) AddReturnInformation() *PaymentReturnReason5 {
+	e.ReturnInformation = new(PaymentReturnReason5)
+	return e.ReturnInformation
+}
+
+func (e *EntryTransaction10) AddCorporateAction() *CorporateAction9 {
+	e.CorporateAction = new(CorporateAction9)
+	return e.CorporateAction
+}
+
+func (e *EntryTransaction10) AddSafekeepingAccount() *SecuritiesAccount19 {
+	e.SafekeepingAccount = new(SecuritiesAccount19)
+	return e.SafekeepingAccount
+}
+
+func (e *EntryTransaction10) AddCashDeposit() *CashDeposit1 {
+	newValue := new(CashDeposit1)
+	e.CashDeposit = append(e.CashDeposit, newValue)
+	return newValue
+}
+
+func (e *EntryTransaction10) AddCardTransaction() *CardTransaction17 {
+	e.CardTransaction = new(CardTransaction17)
+	return e.CardTransaction
+}
+
+func (e *EntryTransaction10) SetAdditionalTransactionInformation(value string) {
+	e.AdditionalTransactionInformation = (*Max500Text)(&value)
+}
+
+func (e *EntryTransaction10) AddSupplementaryData() *SupplementaryData1 {
+	newValue := new(SupplementaryData1)
+	e.SupplementaryData = append(e.SupplementaryData, newValue)
+	return newValue
+}
+
+func (e *EntryTransaction10) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if e.References != nil {
+		e.References.EncodeXMLFast(enc, "Refs")
+	}
+	if e.Amount != nil {
+		e.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if e.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*e.CreditDebitIndicator))
+	}
+	if e.AmountDetails != nil {
+		e.AmountDetails.EncodeXMLFast(enc, "AmtDtls")
+	}
+	for _, elem := range e.Availability {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Avlbty")
+		}
+	}
+	if e.BankTransactionCode != nil {
+		e.BankTransactionCode.EncodeXMLFast(enc, "BkTxCd")
+	}
+	if e.Charges != nil {
+		e.Charges.EncodeXMLFast(enc, "Chrgs")
+	}
+	if e.Interest != nil {
+		e.Interest.EncodeXMLFast(enc, "Intrst")
+	}
+	if e.RelatedParties != nil {
+		e.RelatedParties.EncodeXMLFast(enc, "RltdPties")
+	}
+	if e.RelatedAgents != nil {
+		e.RelatedAgents.EncodeXMLFast(enc, "RltdAgts")
+	}
+	if e.LocalInstrument != nil {
+		e.LocalInstrument.EncodeXMLFast(enc, "LclInstrm")
+	}
+	if e.Purpose != nil {
+		e.Purpose.EncodeXMLFast(enc, "Purp")
+	}
+	for _, elem := range e.RelatedRemittanceInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RltdRmtInf")
+		}
+	}
+	if e.RemittanceInformation != nil {
+		e.RemittanceInformation.EncodeXMLFast(enc, "RmtInf")
+	}
+	if e.RelatedDates != nil {
+		e.RelatedDates.EncodeXMLFast(enc, "RltdDts")
+	}
+	if e.RelatedPrice != nil {
+		e.RelatedPrice.EncodeXMLFast(enc, "RltdPric")
+	}
+	for _, elem := range e.RelatedQuantities {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RltdQties")
+		}
+	}
+	if e.FinancialInstrumentIdentification != nil {
+		e.FinancialInstrumentIdentification.EncodeXMLFast(enc, "FinInstrmId")
+	}
+	if e.Tax != nil {
+		e.Tax.EncodeXMLFast(enc, "Tax")
+	}
+	if e.ReturnInformation != nil {
+		e.ReturnInformation.EncodeXMLFast(enc, "RtrInf")
+	}
+	if e.CorporateAction != nil {
+		e.CorporateAction.EncodeXMLFast(enc, "CorpActn")
+	}
+	if e.SafekeepingAccount != nil {
+		e.SafekeepingAccount.EncodeXMLFast(enc, "SfkpgAcct")
+	}
+	for _, elem := range e.CashDeposit {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "CshDpst")
+		}
+	}
+	if e.CardTransaction != nil {
+		e.CardTransaction.EncodeXMLFast(enc, "CardTx")
+	}
+	if e.AdditionalTransactionInformation != nil {
+		enc.Element("AddtlTxInf", string(*e.AdditionalTransactionInformation))
+	}
+	for _, elem := range e.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (e *EntryTransaction10) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Refs":
+			elem := new(TransactionReferences6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.References = elem
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.Amount = elem
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			e.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		case "AmtDtls":
+			elem := new(AmountAndCurrencyExchange3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.AmountDetails = elem
+		case "Avlbty":
+			elem := new(CashAvailability1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.Availability = append(e.Availability, elem)
+		case "BkTxCd":
+			elem := new(BankTransactionCodeStructure4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.BankTransactionCode = elem
+		case "Chrgs":
+			elem := new(Charges6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.Charges = elem
+		case "Intrst":
+			elem := new(TransactionInterest4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.Interest = elem
+		case "RltdPties":
+			elem := new(TransactionParties6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.RelatedParties = elem
+		case "RltdAgts":
+			elem := new(TransactionAgents5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.RelatedAgents = elem
+		case "LclInstrm":
+			elem := new(LocalInstrument2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.LocalInstrument = elem
+		case "Purp":
+			elem := new(Purpose2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.Purpose = elem
+		case "RltdRmtInf":
+			elem := new(RemittanceLocation7)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.RelatedRemittanceInformation = append(e.RelatedRemittanceInformation, elem)
+		case "RmtInf":
+			elem := new(RemittanceInformation16)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.RemittanceInformation = elem
+		case "RltdDts":
+			elem := new(TransactionDates3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.RelatedDates = elem
+		case "RltdPric":
+			elem := new(TransactionPrice4Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.RelatedPrice = elem
+		case "RltdQties":
+			elem := new(TransactionQuantities3Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.RelatedQuantities = append(e.RelatedQuantities, elem)
+		case "FinInstrmId":
+			elem := new(SecurityIdentification19)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.FinancialInstrumentIdentification = elem
+		case "Tax":
+			elem := new(TaxInformation8)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.Tax = elem
+		case "RtrInf":
+			elem := new(PaymentReturnReason5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.ReturnInformation = elem
+		case "CorpActn":
+			elem := new(CorporateAction9)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.CorporateAction = elem
+		case "SfkpgAcct":
+			elem := new(SecuritiesAccount19)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.SafekeepingAccount = elem
+		case "CshDpst":
+			elem := new(CashDeposit1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.CashDeposit = append(e.CashDeposit, elem)
+		case "CardTx":
+			elem := new(CardTransaction17)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.CardTransaction = elem
+		case "AddtlTxInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			e.AdditionalTransactionInformation = (*Max500Text)(&value)
+		case "SplmtryData":
+			elem := new(SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.SupplementaryData = append(e.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -41,7 +41,7 @@ type EntryTransaction2 struct {
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 
 	// Set of elements used to provide information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation2 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Structured information that enables the matching, ie, reconciliation, of a payment with the items that the payment is intended to settle, such as commercial invoices in an account receivable system.
 	RemittanceInformation *RemittanceInformation5 `xml:"RmtInf,omitempty"`
@@ -74,6 +74,10 @@ type EntryTransaction2 struct {
 	AdditionalTransactionInformation *Max500Text `xml:"AddtlTxInf,omitempty"`
 }
 
+func (e *EntryTransaction2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EntryTransaction2) AddReferences() *TransactionReferences2 {
 	e.References = new(TransactionReferences2)
 	return e.References
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identifies the underlying transaction.
 type EntryTransaction7 struct {
 
@@ -47,7 +51,7 @@ type EntryTransaction7 struct {
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 
 	// Provides information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation4 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation4 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Structured information that enables the matching, that is reconciliation, of a payment with the items that the payment is intended to settle, such as commercial invoices in an account receivable system.
 	RemittanceInformation *RemittanceInformation10 `xml:"RmtInf,omitempty"`
@@ -89,6 +93,10 @@ type EntryTransaction7 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (e *EntryTransaction7) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EntryTransaction7) AddReferences() *TransactionReferences3 {
 	e.References = new(TransactionReferences3)
 	return e.References
@@ -98,8 +106,16 @@ func (e *EntryTransaction7) SetAmount(value, currency string) {
 	e.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (e *EntryTransaction7) SetCreditDebitIndicator(value string) {
+func (e *EntryTransaction7) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	e.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (e *EntryTransaction7) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	e.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (e *EntryTransaction7) AddAmountDetails() *AmountAndCurrencyExchange3 {
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Identifies the underlying transaction.
 type EntryTransaction8 struct {
 
@@ -47,7 +52,7 @@ type EntryTransaction8 struct {
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 
 	// Provides information related to the handling of the remittance information by any of the agents in the transaction processing chain.
-	RelatedRemittanceInformation []*RemittanceLocation4 `xml:"RltdRmtInf,omitempty"`
+	RelatedRemittanceInformation []*RemittanceLocation4 `xml:"RltdRmtInf,omitempty" xsd:"maxOccurs=10"`
 
 	// Structured information that enables the matching, that is reconciliation, of a payment with the items that the payment is intended to settle, such as commercial invoices in an account receivable system.
 	RemittanceInformation *RemittanceInformation11 `xml:"RmtInf,omitempty"`
@@ -89,6 +94,10 @@ type EntryTransaction8 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (e *EntryTransaction8) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EntryTransaction8) AddReferences() *TransactionReferences3 {
 	e.References = new(TransactionReferences3)
 	return e.References
@@ -98,8 +107,16 @@ func (e *EntryTransaction8) SetAmount(value, currency string) {
 	e.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (e *EntryTransaction8) SetCreditDebitIndicator(value string) {
+func (e *EntryTransaction8) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	e.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (e *EntryTransaction8) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	e.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (e *EntryTransaction8) AddAmountDetails() *AmountAndCurrencyExchange3 {
@@ -776,6 +793,264 @@ func (e *EntryTransaction8) AddSupplementaryData() *SupplementaryData1 {
 	return newValue
 }
 
+func (e *EntryTransaction8) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if e.References != nil {
+		e.References.EncodeXMLFast(enc, "Refs")
+	}
+	if e.Amount != nil {
+		e.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if e.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*e.CreditDebitIndicator))
+	}
+	if e.AmountDetails != nil {
+		e.AmountDetails.EncodeXMLFast(enc, "AmtDtls")
+	}
+	for _, elem := range e.Availability {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Avlbty")
+		}
+	}
+	if e.BankTransactionCode != nil {
+		e.BankTransactionCode.EncodeXMLFast(enc, "BkTxCd")
+	}
+	if e.Charges != nil {
+		e.Charges.EncodeXMLFast(enc, "Chrgs")
+	}
+	if e.Interest != nil {
+		e.Interest.EncodeXMLFast(enc, "Intrst")
+	}
+	if e.RelatedParties != nil {
+		e.RelatedParties.EncodeXMLFast(enc, "RltdPties")
+	}
+	if e.RelatedAgents != nil {
+		e.RelatedAgents.EncodeXMLFast(enc, "RltdAgts")
+	}
+	if e.Purpose != nil {
+		e.Purpose.EncodeXMLFast(enc, "Purp")
+	}
+	for _, elem := range e.RelatedRemittanceInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RltdRmtInf")
+		}
+	}
+	if e.RemittanceInformation != nil {
+		e.RemittanceInformation.EncodeXMLFast(enc, "RmtInf")
+	}
+	if e.RelatedDates != nil {
+		e.RelatedDates.EncodeXMLFast(enc, "RltdDts")
+	}
+	if e.RelatedPrice != nil {
+		e.RelatedPrice.EncodeXMLFast(enc, "RltdPric")
+	}
+	for _, elem := range e.RelatedQuantities {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RltdQties")
+		}
+	}
+	if e.FinancialInstrumentIdentification != nil {
+		e.FinancialInstrumentIdentification.EncodeXMLFast(enc, "FinInstrmId")
+	}
+	if e.Tax != nil {
+		e.Tax.EncodeXMLFast(enc, "Tax")
+	}
+	if e.ReturnInformation != nil {
+		e.ReturnInformation.EncodeXMLFast(enc, "RtrInf")
+	}
+	if e.CorporateAction != nil {
+		e.CorporateAction.EncodeXMLFast(enc, "CorpActn")
+	}
+	if e.SafekeepingAccount != nil {
+		e.SafekeepingAccount.EncodeXMLFast(enc, "SfkpgAcct")
+	}
+	for _, elem := range e.CashDeposit {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "CshDpst")
+		}
+	}
+	if e.CardTransaction != nil {
+		e.CardTransaction.EncodeXMLFast(enc, "CardTx")
+	}
+	if e.AdditionalTransactionInformation != nil {
+		enc.Element("AddtlTxInf", string(*e.AdditionalTransactionInformation))
+	}
+	for _, elem := range e.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (e *EntryTransaction8) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Refs":
+			elem := new(TransactionReferences3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.References = elem
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.Amount = elem
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			e.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		case "AmtDtls":
+			elem := new(AmountAndCurrencyExchange3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.AmountDetails = elem
+		case "Avlbty":
+			elem := new(CashAvailability1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.Availability = append(e.Availability, elem)
+		case "BkTxCd":
+			elem := new(BankTransactionCodeStructure4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.BankTransactionCode = elem
+		case "Chrgs":
+			elem := new(Charges4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.Charges = elem
+		case "Intrst":
+			elem := new(TransactionInterest3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.Interest = elem
+		case "RltdPties":
+			elem := new(TransactionParties3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.RelatedParties = elem
+		case "RltdAgts":
+			elem := new(TransactionAgents3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.RelatedAgents = elem
+		case "Purp":
+			elem := new(Purpose2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.Purpose = elem
+		case "RltdRmtInf":
+			elem := new(RemittanceLocation4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.RelatedRemittanceInformation = append(e.RelatedRemittanceInformation, elem)
+		case "RmtInf":
+			elem := new(RemittanceInformation11)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.RemittanceInformation = elem
+		case "RltdDts":
+			elem := new(TransactionDates2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.RelatedDates = elem
+		case "RltdPric":
+			elem := new(TransactionPrice3Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.RelatedPrice = elem
+		case "RltdQties":
+			elem := new(TransactionQuantities2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.RelatedQuantities = append(e.RelatedQuantities, elem)
+		case "FinInstrmId":
+			elem := new(SecurityIdentification19)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.FinancialInstrumentIdentification = elem
+		case "Tax":
+			elem := new(TaxInformation3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.Tax = elem
+		case "RtrInf":
+			elem := new(PaymentReturnReason2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.ReturnInformation = elem
+		case "CorpActn":
+			elem := new(CorporateAction9)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.CorporateAction = elem
+		case "SfkpgAcct":
+			elem := new(SecuritiesAccount19)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.SafekeepingAccount = elem
+		case "CshDpst":
+			elem := new(CashDeposit1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.CashDeposit = append(e.CashDeposit, elem)
+		case "CardTx":
+			elem := new(CardTransaction2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.CardTransaction = elem
+		case "AddtlTxInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			e.AdditionalTransactionInformation = (*Max500Text)(&value)
+		case "SplmtryData":
+			elem := new(SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.SupplementaryData = append(e.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
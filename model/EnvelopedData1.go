@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Encrypted data with encryption key.
 type EnvelopedData1 struct {
 
@@ -13,10 +17,19 @@ type EnvelopedData1 struct {
 	EncryptedContent *EncryptedContent1 `xml:"NcrptdCntt"`
 }
 
+func (e *EnvelopedData1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EnvelopedData1) SetVersion(value string) {
 	e.Version = (*Number)(&value)
 }
 
+func (e *EnvelopedData1) SetVersionFromDecimal(value decimal.Decimal) {
+	e.Version = new(Number)
+	e.Version.FromDecimal(value)
+}
+
 func (e *EnvelopedData1) AddRecipient() *Recipient1Choice {
 	newValue := new(Recipient1Choice)
 	e.Recipient = append(e.Recipient, newValue)
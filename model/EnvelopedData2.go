@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Encrypted data with encryption key.
 type EnvelopedData2 struct {
 
@@ -13,10 +17,19 @@ type EnvelopedData2 struct {
 	EncryptedContent *EncryptedContent2 `xml:"NcrptdCntt"`
 }
 
+func (e *EnvelopedData2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EnvelopedData2) SetVersion(value string) {
 	e.Version = (*Number)(&value)
 }
 
+func (e *EnvelopedData2) SetVersionFromDecimal(value decimal.Decimal) {
+	e.Version = new(Number)
+	e.Version.FromDecimal(value)
+}
+
 func (e *EnvelopedData2) AddRecipient() *Recipient2Choice {
 	newValue := new(Recipient2Choice)
 	e.Recipient = append(e.Recipient, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Encrypted data with encryption key.
 type EnvelopedData3 struct {
 
@@ -13,10 +17,19 @@ type EnvelopedData3 struct {
 	EncryptedContent *EncryptedContent2 `xml:"NcrptdCntt"`
 }
 
+func (e *EnvelopedData3) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EnvelopedData3) SetVersion(value string) {
 	e.Version = (*Number)(&value)
 }
 
+func (e *EnvelopedData3) SetVersionFromDecimal(value decimal.Decimal) {
+	e.Version = new(Number)
+	e.Version.FromDecimal(value)
+}
+
 func (e *EnvelopedData3) AddRecipient() *Recipient3Choice {
 	newValue := new(Recipient3Choice)
 	e.Recipient = append(e.Recipient, newValue)
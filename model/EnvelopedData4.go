@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Encrypted data with encryption key.
 type EnvelopedData4 struct {
 
@@ -13,10 +17,19 @@ type EnvelopedData4 struct {
 	EncryptedContent *EncryptedContent3 `xml:"NcrptdCntt,omitempty"`
 }
 
+func (e *EnvelopedData4) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EnvelopedData4) SetVersion(value string) {
 	e.Version = (*Number)(&value)
 }
 
+func (e *EnvelopedData4) SetVersionFromDecimal(value decimal.Decimal) {
+	e.Version = new(Number)
+	e.Version.FromDecimal(value)
+}
+
 func (e *EnvelopedData4) AddRecipient() *Recipient4Choice {
 	newValue := new(Recipient4Choice)
 	e.Recipient = append(e.Recipient, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Part of an investor's subscription amount that is held by the fund in order to pay incentive / performance fees at the end of the fiscal year.
 type Equalisation1 struct {
 
@@ -10,10 +14,23 @@ type Equalisation1 struct {
 	Rate *PercentageRate `xml:"Rate,omitempty"`
 }
 
+func (e *Equalisation1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *Equalisation1) SetAmount(value, currency string) {
 	e.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (e *Equalisation1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	e.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *Equalisation1) SetRate(value string) {
 	e.Rate = (*PercentageRate)(&value)
 }
+
+func (e *Equalisation1) SetRateFromDecimal(value decimal.Decimal) {
+	e.Rate = new(PercentageRate)
+	e.Rate.FromDecimal(value)
+}
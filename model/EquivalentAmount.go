@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money to be transferred between the debtor and creditor, before deduction of charges, expressed in the currency of the debtor's account, and to be transferred into a different currency.
 type EquivalentAmount struct {
 
@@ -12,10 +16,18 @@ type EquivalentAmount struct {
 	CurrencyOfTransfer *CurrencyCode `xml:"CcyOfTrf"`
 }
 
+func (e *EquivalentAmount) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EquivalentAmount) SetAmount(value, currency string) {
 	e.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (e *EquivalentAmount) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	e.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *EquivalentAmount) SetCurrencyOfTransfer(value string) {
 	e.CurrencyOfTransfer = (*CurrencyCode)(&value)
 }
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Amount of money to be moved between the debtor and creditor, expressed in the currency of the debtor's account, and the currency in which the amount is to be moved.
 type EquivalentAmount2 struct {
 
@@ -11,10 +16,59 @@ type EquivalentAmount2 struct {
 	CurrencyOfTransfer *ActiveOrHistoricCurrencyCode `xml:"CcyOfTrf"`
 }
 
+func (e *EquivalentAmount2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EquivalentAmount2) SetAmount(value, currency string) {
 	e.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (e *EquivalentAmount2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	e.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *EquivalentAmount2) SetCurrencyOfTransfer(value string) {
 	e.CurrencyOfTransfer = (*ActiveOrHistoricCurrencyCode)(&value)
 }
+
+func (e *EquivalentAmount2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if e.Amount != nil {
+		e.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if e.CurrencyOfTransfer != nil {
+		enc.Element("CcyOfTrf", string(*e.CurrencyOfTransfer))
+	}
+	enc.End(tag)
+}
+
+func (e *EquivalentAmount2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			e.Amount = elem
+		case "CcyOfTrf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			e.CurrencyOfTransfer = (*ActiveOrHistoricCurrencyCode)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
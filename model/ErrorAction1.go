@@ -10,6 +10,10 @@ type ErrorAction1 struct {
 	ActionToProcess *TerminalManagementErrorAction1Code `xml:"ActnToPrc"`
 }
 
+func (e *ErrorAction1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ErrorAction1) AddActionResult(value string) {
 	e.ActionResult = append(e.ActionResult, (*TerminalManagementActionResult1Code)(&value))
 }
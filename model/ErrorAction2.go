@@ -10,6 +10,10 @@ type ErrorAction2 struct {
 	ActionToProcess *TerminalManagementErrorAction2Code `xml:"ActnToPrc"`
 }
 
+func (e *ErrorAction2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ErrorAction2) AddActionResult(value string) {
 	e.ActionResult = append(e.ActionResult, (*TerminalManagementActionResult1Code)(&value))
 }
@@ -10,6 +10,10 @@ type ErrorReporting1 struct {
 	Description *Max500Text `xml:"Desc"`
 }
 
+func (e *ErrorReporting1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ErrorReporting1) SetType(value string) {
 	e.Type = (*Max35Text)(&value)
 }
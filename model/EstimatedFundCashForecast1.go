@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements from or to a fund as a result of investment funds transactions, eg, subscriptions or redemptions.
 type EstimatedFundCashForecast1 struct {
 
@@ -43,6 +47,10 @@ type EstimatedFundCashForecast1 struct {
 	EstimatedNetCashForecastDetails []*NetCashForecast1 `xml:"EstmtdNetCshFcstDtls,omitempty"`
 }
 
+func (e *EstimatedFundCashForecast1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EstimatedFundCashForecast1) AddTradeDateTime() *DateAndDateTimeChoice {
 	e.TradeDateTime = new(DateAndDateTimeChoice)
 	return e.TradeDateTime
@@ -62,10 +70,18 @@ func (e *EstimatedFundCashForecast1) SetEstimatedTotalNAV(value, currency string
 	e.EstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (e *EstimatedFundCashForecast1) SetEstimatedTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	e.EstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *EstimatedFundCashForecast1) SetPreviousEstimatedTotalNAV(value, currency string) {
 	e.PreviousEstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (e *EstimatedFundCashForecast1) SetPreviousEstimatedTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	e.PreviousEstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *EstimatedFundCashForecast1) AddEstimatedTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	e.EstimatedTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return e.EstimatedTotalUnitsNumber
@@ -80,6 +96,11 @@ func (e *EstimatedFundCashForecast1) SetEstimatedTotalNAVChangeRate(value string
 	e.EstimatedTotalNAVChangeRate = (*PercentageRate)(&value)
 }
 
+func (e *EstimatedFundCashForecast1) SetEstimatedTotalNAVChangeRateFromDecimal(value decimal.Decimal) {
+	e.EstimatedTotalNAVChangeRate = new(PercentageRate)
+	e.EstimatedTotalNAVChangeRate.FromDecimal(value)
+}
+
 func (e *EstimatedFundCashForecast1) AddInvestmentCurrency(value string) {
 	e.InvestmentCurrency = append(e.InvestmentCurrency, (*ActiveOrHistoricCurrencyCode)(&value))
 }
@@ -88,6 +109,11 @@ func (e *EstimatedFundCashForecast1) SetExceptionalNetCashFlowIndicator(value st
 	e.ExceptionalNetCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (e *EstimatedFundCashForecast1) SetExceptionalNetCashFlowIndicatorFromBool(value bool) {
+	e.ExceptionalNetCashFlowIndicator = new(YesNoIndicator)
+	e.ExceptionalNetCashFlowIndicator.FromBool(value)
+}
+
 func (e *EstimatedFundCashForecast1) AddEstimatedCashInForecastDetails() *CashInForecast2 {
 	newValue := new(CashInForecast2)
 	e.EstimatedCashInForecastDetails = append(e.EstimatedCashInForecastDetails, newValue)
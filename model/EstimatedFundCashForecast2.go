@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements from or to a fund as a result of investment funds transactions, eg, subscriptions or redemptions.
 type EstimatedFundCashForecast2 struct {
 
@@ -40,6 +44,10 @@ type EstimatedFundCashForecast2 struct {
 	EstimatedNetCashForecastDetails []*NetCashForecast1 `xml:"EstmtdNetCshFcstDtls,omitempty"`
 }
 
+func (e *EstimatedFundCashForecast2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EstimatedFundCashForecast2) AddTradeDateTime() *DateAndDateTimeChoice {
 	e.TradeDateTime = new(DateAndDateTimeChoice)
 	return e.TradeDateTime
@@ -59,10 +67,18 @@ func (e *EstimatedFundCashForecast2) SetEstimatedTotalNAV(value, currency string
 	e.EstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (e *EstimatedFundCashForecast2) SetEstimatedTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	e.EstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *EstimatedFundCashForecast2) SetPreviousEstimatedTotalNAV(value, currency string) {
 	e.PreviousEstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (e *EstimatedFundCashForecast2) SetPreviousEstimatedTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	e.PreviousEstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *EstimatedFundCashForecast2) AddEstimatedTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	e.EstimatedTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return e.EstimatedTotalUnitsNumber
@@ -77,6 +93,11 @@ func (e *EstimatedFundCashForecast2) SetEstimatedTotalNAVChangeRate(value string
 	e.EstimatedTotalNAVChangeRate = (*PercentageRate)(&value)
 }
 
+func (e *EstimatedFundCashForecast2) SetEstimatedTotalNAVChangeRateFromDecimal(value decimal.Decimal) {
+	e.EstimatedTotalNAVChangeRate = new(PercentageRate)
+	e.EstimatedTotalNAVChangeRate.FromDecimal(value)
+}
+
 func (e *EstimatedFundCashForecast2) AddInvestmentCurrency(value string) {
 	e.InvestmentCurrency = append(e.InvestmentCurrency, (*ActiveOrHistoricCurrencyCode)(&value))
 }
@@ -85,6 +106,11 @@ func (e *EstimatedFundCashForecast2) SetExceptionalNetCashFlowIndicator(value st
 	e.ExceptionalNetCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (e *EstimatedFundCashForecast2) SetExceptionalNetCashFlowIndicatorFromBool(value bool) {
+	e.ExceptionalNetCashFlowIndicator = new(YesNoIndicator)
+	e.ExceptionalNetCashFlowIndicator.FromBool(value)
+}
+
 func (e *EstimatedFundCashForecast2) AddSortingCriteriaDetails() *CashSortingCriterion1 {
 	newValue := new(CashSortingCriterion1)
 	e.SortingCriteriaDetails = append(e.SortingCriteriaDetails, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements from or to a fund as a result of investment funds transactions, eg, subscriptions or redemptions.
 type EstimatedFundCashForecast3 struct {
 
@@ -46,6 +50,10 @@ type EstimatedFundCashForecast3 struct {
 	EstimatedNetCashForecastDetails []*NetCashForecast2 `xml:"EstmtdNetCshFcstDtls,omitempty"`
 }
 
+func (e *EstimatedFundCashForecast3) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EstimatedFundCashForecast3) SetIdentification(value string) {
 	e.Identification = (*Max35Text)(&value)
 }
@@ -69,10 +77,18 @@ func (e *EstimatedFundCashForecast3) SetEstimatedTotalNAV(value, currency string
 	e.EstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (e *EstimatedFundCashForecast3) SetEstimatedTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	e.EstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *EstimatedFundCashForecast3) SetPreviousTotalNAV(value, currency string) {
 	e.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (e *EstimatedFundCashForecast3) SetPreviousTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	e.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *EstimatedFundCashForecast3) AddEstimatedTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	e.EstimatedTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return e.EstimatedTotalUnitsNumber
@@ -87,6 +103,11 @@ func (e *EstimatedFundCashForecast3) SetEstimatedTotalNAVChangeRate(value string
 	e.EstimatedTotalNAVChangeRate = (*PercentageRate)(&value)
 }
 
+func (e *EstimatedFundCashForecast3) SetEstimatedTotalNAVChangeRateFromDecimal(value decimal.Decimal) {
+	e.EstimatedTotalNAVChangeRate = new(PercentageRate)
+	e.EstimatedTotalNAVChangeRate.FromDecimal(value)
+}
+
 func (e *EstimatedFundCashForecast3) AddInvestmentCurrency(value string) {
 	e.InvestmentCurrency = append(e.InvestmentCurrency, (*ActiveOrHistoricCurrencyCode)(&value))
 }
@@ -95,6 +116,11 @@ func (e *EstimatedFundCashForecast3) SetExceptionalNetCashFlowIndicator(value st
 	e.ExceptionalNetCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (e *EstimatedFundCashForecast3) SetExceptionalNetCashFlowIndicatorFromBool(value bool) {
+	e.ExceptionalNetCashFlowIndicator = new(YesNoIndicator)
+	e.ExceptionalNetCashFlowIndicator.FromBool(value)
+}
+
 func (e *EstimatedFundCashForecast3) AddEstimatedCashInForecastDetails() *CashInForecast4 {
 	newValue := new(CashInForecast4)
 	e.EstimatedCashInForecastDetails = append(e.EstimatedCashInForecastDetails, newValue)
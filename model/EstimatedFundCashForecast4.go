@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements from or to a fund as a result of investment funds transactions, eg, subscriptions or redemptions.
 type EstimatedFundCashForecast4 struct {
 
@@ -52,6 +56,10 @@ type EstimatedFundCashForecast4 struct {
 	EstimatedNetCashForecastDetails []*NetCashForecast2 `xml:"EstmtdNetCshFcstDtls,omitempty"`
 }
 
+func (e *EstimatedFundCashForecast4) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EstimatedFundCashForecast4) SetIdentification(value string) {
 	e.Identification = (*Max35Text)(&value)
 }
@@ -75,10 +83,18 @@ func (e *EstimatedFundCashForecast4) SetEstimatedTotalNAV(value, currency string
 	e.EstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (e *EstimatedFundCashForecast4) SetEstimatedTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	e.EstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *EstimatedFundCashForecast4) SetPreviousTotalNAV(value, currency string) {
 	e.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (e *EstimatedFundCashForecast4) SetPreviousTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	e.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *EstimatedFundCashForecast4) AddEstimatedTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	e.EstimatedTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return e.EstimatedTotalUnitsNumber
@@ -677,6 +693,11 @@ func (e *EstimatedFundCashForecast4) SetEstimatedTotalNAVChangeRate(value string
 	e.EstimatedTotalNAVChangeRate = (*PercentageRate)(&value)
 }
 
+func (e *EstimatedFundCashForecast4) SetEstimatedTotalNAVChangeRateFromDecimal(value decimal.Decimal) {
+	e.EstimatedTotalNAVChangeRate = new(PercentageRate)
+	e.EstimatedTotalNAVChangeRate.FromDecimal(value)
+}
+
 func (e *EstimatedFundCashForecast4) AddInvestmentCurrency(value string) {
 	e.InvestmentCurrency = append(e.InvestmentCurrency, (*ActiveOrHistoricCurrencyCode)(&value))
 }
@@ -685,6 +706,11 @@ func (e *EstimatedFundCashForecast4) SetExceptionalNetCashFlowIndicator(value st
 	e.ExceptionalNetCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (e *EstimatedFundCashForecast4) SetExceptionalNetCashFlowIndicatorFromBool(value bool) {
+	e.ExceptionalNetCashFlowIndicator = new(YesNoIndicator)
+	e.ExceptionalNetCashFlowIndicator.FromBool(value)
+}
+
 func (e *EstimatedFundCashForecast4) AddEstimatedNetCashForecastDetails() *NetCashForecast2 {
 	newValue := new(NetCashForecast2)
 	e.EstimatedNetCashForecastDetails = append(e.EstimatedNetCashForecastDetails, newValue)
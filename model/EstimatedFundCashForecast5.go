@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements from or to a fund as a result of investment funds transactions, eg, subscriptions or redemptions.
 type EstimatedFundCashForecast5 struct {
 
@@ -64,6 +68,10 @@ type EstimatedFundCashForecast5 struct {
 	EstimatedNetCashForecastDetails []*NetCashForecast4 `xml:"EstmtdNetCshFcstDtls,omitempty"`
 }
 
+func (e *EstimatedFundCashForecast5) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EstimatedFundCashForecast5) SetIdentification(value string) {
 	e.Identification = (*Max35Text)(&value)
 }
@@ -105,6 +113,11 @@ func (e *EstimatedFundCashForecast5) SetEstimatedTotalNAVChangeRate(value string
 	e.EstimatedTotalNAVChangeRate = (*PercentageRate)(&value)
 }
 
+func (e *EstimatedFundCashForecast5) SetEstimatedTotalNAVChangeRateFromDecimal(value decimal.Decimal) {
+	e.EstimatedTotalNAVChangeRate = new(PercentageRate)
+	e.EstimatedTotalNAVChangeRate.FromDecimal(value)
+}
+
 func (e *EstimatedFundCashForecast5) AddInvestmentCurrency(value string) {
 	e.InvestmentCurrency = append(e.InvestmentCurrency, (*ActiveOrHistoricCurrencyCode)(&value))
 }
@@ -118,6 +131,11 @@ func (e *EstimatedFundCashForecast5) SetExceptionalNetCashFlowIndicator(value st
 	e.ExceptionalNetCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (e *EstimatedFundCashForecast5) SetExceptionalNetCashFlowIndicatorFromBool(value bool) {
+	e.ExceptionalNetCashFlowIndicator = new(YesNoIndicator)
+	e.ExceptionalNetCashFlowIndicator.FromBool(value)
+}
+
 func (e *EstimatedFundCashForecast5) AddPrice() *UnitPrice19 {
 	e.Price = new(UnitPrice19)
 	return e.Price
@@ -132,6 +150,11 @@ func (e *EstimatedFundCashForecast5) SetEstimatedPercentageOfShareClassTotalNAV(
 	e.EstimatedPercentageOfShareClassTotalNAV = (*PercentageRate)(&value)
 }
 
+func (e *EstimatedFundCashForecast5) SetEstimatedPercentageOfShareClassTotalNAVFromDecimal(value decimal.Decimal) {
+	e.EstimatedPercentageOfShareClassTotalNAV = new(PercentageRate)
+	e.EstimatedPercentageOfShareClassTotalNAV.FromDecimal(value)
+}
+
 func (e *EstimatedFundCashForecast5) AddBreakdownByParty() *BreakdownByParty3 {
 	newValue := new(BreakdownByParty3)
 	e.BreakdownByParty = append(e.BreakdownByParty, newValue)
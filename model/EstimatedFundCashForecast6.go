@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements from or to a fund as a result of investment funds transactions, eg, subscriptions or redemptions.
 type EstimatedFundCashForecast6 struct {
 
@@ -58,6 +62,10 @@ type EstimatedFundCashForecast6 struct {
 	EstimatedNetCashForecastDetails []*NetCashForecast4 `xml:"EstmtdNetCshFcstDtls,omitempty"`
 }
 
+func (e *EstimatedFundCashForecast6) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EstimatedFundCashForecast6) SetIdentification(value string) {
 	e.Identification = (*Max35Text)(&value)
 }
@@ -99,6 +107,11 @@ func (e *EstimatedFundCashForecast6) SetEstimatedTotalNAVChangeRate(value string
 	e.EstimatedTotalNAVChangeRate = (*PercentageRate)(&value)
 }
 
+func (e *EstimatedFundCashForecast6) SetEstimatedTotalNAVChangeRateFromDecimal(value decimal.Decimal) {
+	e.EstimatedTotalNAVChangeRate = new(PercentageRate)
+	e.EstimatedTotalNAVChangeRate.FromDecimal(value)
+}
+
 func (e *EstimatedFundCashForecast6) AddInvestmentCurrency(value string) {
 	e.InvestmentCurrency = append(e.InvestmentCurrency, (*ActiveOrHistoricCurrencyCode)(&value))
 }
@@ -112,6 +125,11 @@ func (e *EstimatedFundCashForecast6) SetExceptionalNetCashFlowIndicator(value st
 	e.ExceptionalNetCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (e *EstimatedFundCashForecast6) SetExceptionalNetCashFlowIndicatorFromBool(value bool) {
+	e.ExceptionalNetCashFlowIndicator = new(YesNoIndicator)
+	e.ExceptionalNetCashFlowIndicator.FromBool(value)
+}
+
 func (e *EstimatedFundCashForecast6) AddPrice() *UnitPrice19 {
 	e.Price = new(UnitPrice19)
 	return e.Price
@@ -126,6 +144,11 @@ func (e *EstimatedFundCashForecast6) SetEstimatedPercentageOfShareClassTotalNAV(
 	e.EstimatedPercentageOfShareClassTotalNAV = (*PercentageRate)(&value)
 }
 
+func (e *EstimatedFundCashForecast6) SetEstimatedPercentageOfShareClassTotalNAVFromDecimal(value decimal.Decimal) {
+	e.EstimatedPercentageOfShareClassTotalNAV = new(PercentageRate)
+	e.EstimatedPercentageOfShareClassTotalNAV.FromDecimal(value)
+}
+
 func (e *EstimatedFundCashForecast6) AddEstimatedCashInForecastDetails() *CashInForecast6 {
 	newValue := new(CashInForecast6)
 	e.EstimatedCashInForecastDetails = append(e.EstimatedCashInForecastDetails, newValue)
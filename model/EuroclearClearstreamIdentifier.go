@@ -1,3 +1,7 @@
 package model
 
 type EuroclearClearstreamIdentifier string
+
+func (e EuroclearClearstreamIdentifier) Validate() error {
+	return validateLength("EuroclearClearstreamIdentifier", string(e), 1, 35)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information on an event that happened in a system.
 type Event1 struct {
 
@@ -16,6 +20,10 @@ type Event1 struct {
 	EventTime *ISODateTime `xml:"EvtTm,omitempty"`
 }
 
+func (e *Event1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *Event1) SetEventCode(value string) {
 	e.EventCode = (*Max4AlphaNumericText)(&value)
 }
@@ -31,3 +39,8 @@ func (e *Event1) SetEventDescription(value string) {
 func (e *Event1) SetEventTime(value string) {
 	e.EventTime = (*ISODateTime)(&value)
 }
+
+func (e *Event1) SetEventTimeFromTime(value time.Time) {
+	e.EventTime = new(ISODateTime)
+	e.EventTime.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Describes an event not covered by other formal messages, for example a trace after a telephone call.
 type EventDescription1 struct {
 
@@ -43,6 +47,10 @@ type EventDescription1 struct {
 	LegalContext *GovernanceRules2 `xml:"LglCntxt,omitempty"`
 }
 
+func (e *EventDescription1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EventDescription1) SetIdentifier(value string) {
 	e.Identifier = (*Max35Text)(&value)
 }
@@ -51,6 +59,11 @@ func (e *EventDescription1) SetDate(value string) {
 	e.Date = (*ISODateTime)(&value)
 }
 
+func (e *EventDescription1) SetDateFromTime(value time.Time) {
+	e.Date = new(ISODateTime)
+	e.Date.FromTime(value)
+}
+
 func (e *EventDescription1) AddRecipient() *QualifiedPartyIdentification1 {
 	e.Recipient = new(QualifiedPartyIdentification1)
 	return e.Recipient
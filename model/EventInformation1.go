@@ -19,6 +19,10 @@ type EventInformation1 struct {
 	LastNotificationIdentification *NotificationIdentification1 `xml:"LastNtfctnId,omitempty"`
 }
 
+func (e *EventInformation1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EventInformation1) SetCorporateActionEventIdentification(value string) {
 	e.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type EventInformation10 struct {
 	LastNotificationIdentification *NotificationIdentification4 `xml:"LastNtfctnId,omitempty"`
 }
 
+func (e *EventInformation10) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EventInformation10) SetCorporateActionEventIdentification(value string) {
 	e.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
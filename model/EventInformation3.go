@@ -19,6 +19,10 @@ type EventInformation3 struct {
 	LastNotificationIdentification *NotificationIdentification1 `xml:"LastNtfctnId,omitempty"`
 }
 
+func (e *EventInformation3) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EventInformation3) SetCorporateActionEventIdentification(value string) {
 	e.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
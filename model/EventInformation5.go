@@ -19,6 +19,10 @@ type EventInformation5 struct {
 	LastNotificationIdentification *NotificationIdentification1 `xml:"LastNtfctnId,omitempty"`
 }
 
+func (e *EventInformation5) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EventInformation5) SetCorporateActionEventIdentification(value string) {
 	e.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type EventInformation7 struct {
 	LastNotificationIdentification *NotificationIdentification3 `xml:"LastNtfctnId,omitempty"`
 }
 
+func (e *EventInformation7) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EventInformation7) SetCorporateActionEventIdentification(value string) {
 	e.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
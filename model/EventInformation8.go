@@ -19,6 +19,10 @@ type EventInformation8 struct {
 	LastNotificationIdentification *NotificationIdentification4 `xml:"LastNtfctnId,omitempty"`
 }
 
+func (e *EventInformation8) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EventInformation8) SetCorporateActionEventIdentification(value string) {
 	e.CorporateActionEventIdentification = (*RestrictedFINXMax16Text)(&value)
 }
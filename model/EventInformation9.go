@@ -19,6 +19,10 @@ type EventInformation9 struct {
 	LastNotificationIdentification *NotificationIdentification3 `xml:"LastNtfctnId,omitempty"`
 }
 
+func (e *EventInformation9) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EventInformation9) SetCorporateActionEventIdentification(value string) {
 	e.CorporateActionEventIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type EventProcessingStatus1Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts"`
 }
 
+func (e *EventProcessingStatus1Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EventProcessingStatus1Choice) AddComplete() *NoSpecifiedReason1 {
 	e.Complete = new(NoSpecifiedReason1)
 	return e.Complete
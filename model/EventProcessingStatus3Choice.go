@@ -16,6 +16,10 @@ type EventProcessingStatus3Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason6 `xml:"PrtrySts"`
 }
 
+func (e *EventProcessingStatus3Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EventProcessingStatus3Choice) AddComplete() *NoSpecifiedReason1 {
 	e.Complete = new(NoSpecifiedReason1)
 	return e.Complete
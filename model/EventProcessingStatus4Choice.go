@@ -16,6 +16,10 @@ type EventProcessingStatus4Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason7 `xml:"PrtrySts"`
 }
 
+func (e *EventProcessingStatus4Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *EventProcessingStatus4Choice) AddComplete() *NoSpecifiedReason1 {
 	e.Complete = new(NoSpecifiedReason1)
 	return e.Complete
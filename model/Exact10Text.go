@@ -1,3 +1,7 @@
 package model
 
 type Exact10Text string
+
+func (e Exact10Text) Validate() error {
+	return validateLength("Exact10Text", string(e), 10, 10)
+}
@@ -1,3 +1,9 @@
 package model
 
 type Exact1NumericText string
+
+var exact1NumericTextPattern = newPattern(`[0-9]{1}`)
+
+func (e Exact1NumericText) Validate() error {
+	return validatePattern("Exact1NumericText", string(e), exact1NumericTextPattern)
+}
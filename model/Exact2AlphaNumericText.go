@@ -1,3 +1,9 @@
 package model
 
 type Exact2AlphaNumericText string
+
+var exact2AlphaNumericTextPattern = newPattern(`[a-zA-Z0-9]{2}`)
+
+func (e Exact2AlphaNumericText) Validate() error {
+	return validatePattern("Exact2AlphaNumericText", string(e), exact2AlphaNumericTextPattern)
+}
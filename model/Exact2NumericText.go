@@ -1,3 +1,9 @@
 package model
 
 type Exact2NumericText string
+
+var exact2NumericTextPattern = newPattern(`[0-9]{2}`)
+
+func (e Exact2NumericText) Validate() error {
+	return validatePattern("Exact2NumericText", string(e), exact2NumericTextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type Exact3AlphaNumericText string
+
+var exact3AlphaNumericTextPattern = newPattern(`[a-zA-Z0-9]{3}`)
+
+func (e Exact3AlphaNumericText) Validate() error {
+	return validatePattern("Exact3AlphaNumericText", string(e), exact3AlphaNumericTextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type Exact3NumericText string
+
+var exact3NumericTextPattern = newPattern(`[0-9]{3}`)
+
+func (e Exact3NumericText) Validate() error {
+	return validatePattern("Exact3NumericText", string(e), exact3NumericTextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type Exact3UpperCaseAlphaNumericText string
+
+var exact3UpperCaseAlphaNumericTextPattern = newPattern(`[A-Z0-9]{3,3}`)
+
+func (e Exact3UpperCaseAlphaNumericText) Validate() error {
+	return validatePattern("Exact3UpperCaseAlphaNumericText", string(e), exact3UpperCaseAlphaNumericTextPattern)
+}
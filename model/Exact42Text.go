@@ -1,3 +1,7 @@
 package model
 
 type Exact42Text string
+
+func (e Exact42Text) Validate() error {
+	return validateLength("Exact42Text", string(e), 42, 42)
+}
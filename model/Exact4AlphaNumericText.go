@@ -1,3 +1,9 @@
 package model
 
 type Exact4AlphaNumericText string
+
+var exact4AlphaNumericTextPattern = newPattern(`[a-zA-Z0-9]{4}`)
+
+func (e Exact4AlphaNumericText) Validate() error {
+	return validatePattern("Exact4AlphaNumericText", string(e), exact4AlphaNumericTextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type Exact4NumericText string
+
+var exact4NumericTextPattern = newPattern(`[0-9]{4}`)
+
+func (e Exact4NumericText) Validate() error {
+	return validatePattern("Exact4NumericText", string(e), exact4NumericTextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type Exact5NumericText string
+
+var exact5NumericTextPattern = newPattern(`[0-9]{5}`)
+
+func (e Exact5NumericText) Validate() error {
+	return validatePattern("Exact5NumericText", string(e), exact5NumericTextPattern)
+}
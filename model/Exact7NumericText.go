@@ -1,3 +1,9 @@
 package model
 
 type Exact7NumericText string
+
+var exact7NumericTextPattern = newPattern(`[0-9]{7}`)
+
+func (e Exact7NumericText) Validate() error {
+	return validatePattern("Exact7NumericText", string(e), exact7NumericTextPattern)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Configuration parameters of data exchanges.
 type ExchangeConfiguration1 struct {
 
@@ -16,6 +20,10 @@ type ExchangeConfiguration1 struct {
 	TimeCondition *ProcessTiming1 `xml:"TmCond,omitempty"`
 }
 
+func (e *ExchangeConfiguration1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExchangeConfiguration1) AddExchangePolicy(value string) {
 	e.ExchangePolicy = append(e.ExchangePolicy, (*ExchangePolicy1Code)(&value))
 }
@@ -24,10 +32,19 @@ func (e *ExchangeConfiguration1) SetMaximumNumber(value string) {
 	e.MaximumNumber = (*Number)(&value)
 }
 
+func (e *ExchangeConfiguration1) SetMaximumNumberFromDecimal(value decimal.Decimal) {
+	e.MaximumNumber = new(Number)
+	e.MaximumNumber.FromDecimal(value)
+}
+
 func (e *ExchangeConfiguration1) SetMaximumAmount(value, currency string) {
 	e.MaximumAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (e *ExchangeConfiguration1) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	e.MaximumAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *ExchangeConfiguration1) AddTimeCondition() *ProcessTiming1 {
 	e.TimeCondition = new(ProcessTiming1)
 	return e.TimeCondition
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Configuration parameters of data exchanges.
 type ExchangeConfiguration2 struct {
 
@@ -16,6 +20,10 @@ type ExchangeConfiguration2 struct {
 	TimeCondition *ProcessTiming2 `xml:"TmCond,omitempty"`
 }
 
+func (e *ExchangeConfiguration2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExchangeConfiguration2) AddExchangePolicy(value string) {
 	e.ExchangePolicy = append(e.ExchangePolicy, (*ExchangePolicy1Code)(&value))
 }
@@ -24,10 +32,19 @@ func (e *ExchangeConfiguration2) SetMaximumNumber(value string) {
 	e.MaximumNumber = (*Number)(&value)
 }
 
+func (e *ExchangeConfiguration2) SetMaximumNumberFromDecimal(value decimal.Decimal) {
+	e.MaximumNumber = new(Number)
+	e.MaximumNumber.FromDecimal(value)
+}
+
 func (e *ExchangeConfiguration2) SetMaximumAmount(value, currency string) {
 	e.MaximumAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (e *ExchangeConfiguration2) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	e.MaximumAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *ExchangeConfiguration2) AddTimeCondition() *ProcessTiming2 {
 	e.TimeCondition = new(ProcessTiming2)
 	return e.TimeCondition
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Configuration parameters of data exchanges.
 type ExchangeConfiguration3 struct {
 
@@ -23,6 +27,10 @@ type ExchangeConfiguration3 struct {
 	ExchangeDeclined *TrueFalseIndicator `xml:"XchgDclnd,omitempty"`
 }
 
+func (e *ExchangeConfiguration3) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExchangeConfiguration3) AddExchangePolicy(value string) {
 	e.ExchangePolicy = append(e.ExchangePolicy, (*ExchangePolicy1Code)(&value))
 }
@@ -31,10 +39,19 @@ func (e *ExchangeConfiguration3) SetMaximumNumber(value string) {
 	e.MaximumNumber = (*Number)(&value)
 }
 
+func (e *ExchangeConfiguration3) SetMaximumNumberFromDecimal(value decimal.Decimal) {
+	e.MaximumNumber = new(Number)
+	e.MaximumNumber.FromDecimal(value)
+}
+
 func (e *ExchangeConfiguration3) SetMaximumAmount(value, currency string) {
 	e.MaximumAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (e *ExchangeConfiguration3) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	e.MaximumAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *ExchangeConfiguration3) AddTimeCondition() *ProcessTiming2 {
 	e.TimeCondition = new(ProcessTiming2)
 	return e.TimeCondition
@@ -44,6 +61,16 @@ func (e *ExchangeConfiguration3) SetExchangeFailed(value string) {
 	e.ExchangeFailed = (*TrueFalseIndicator)(&value)
 }
 
+func (e *ExchangeConfiguration3) SetExchangeFailedFromBool(value bool) {
+	e.ExchangeFailed = new(TrueFalseIndicator)
+	e.ExchangeFailed.FromBool(value)
+}
+
 func (e *ExchangeConfiguration3) SetExchangeDeclined(value string) {
 	e.ExchangeDeclined = (*TrueFalseIndicator)(&value)
 }
+
+func (e *ExchangeConfiguration3) SetExchangeDeclinedFromBool(value bool) {
+	e.ExchangeDeclined = new(TrueFalseIndicator)
+	e.ExchangeDeclined.FromBool(value)
+}
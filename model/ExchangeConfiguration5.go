@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Configuration parameters of data exchanges.
 type ExchangeConfiguration5 struct {
 
@@ -26,6 +30,10 @@ type ExchangeConfiguration5 struct {
 	ExchangeDeclined *TrueFalseIndicator `xml:"XchgDclnd,omitempty"`
 }
 
+func (e *ExchangeConfiguration5) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExchangeConfiguration5) AddExchangePolicy(value string) {
 	e.ExchangePolicy = append(e.ExchangePolicy, (*ExchangePolicy1Code)(&value))
 }
@@ -34,10 +42,19 @@ func (e *ExchangeConfiguration5) SetMaximumNumber(value string) {
 	e.MaximumNumber = (*Number)(&value)
 }
 
+func (e *ExchangeConfiguration5) SetMaximumNumberFromDecimal(value decimal.Decimal) {
+	e.MaximumNumber = new(Number)
+	e.MaximumNumber.FromDecimal(value)
+}
+
 func (e *ExchangeConfiguration5) SetMaximumAmount(value, currency string) {
 	e.MaximumAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (e *ExchangeConfiguration5) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	e.MaximumAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *ExchangeConfiguration5) AddReTry() *ProcessRetry2 {
 	e.ReTry = new(ProcessRetry2)
 	return e.ReTry
@@ -52,6 +69,16 @@ func (e *ExchangeConfiguration5) SetExchangeFailed(value string) {
 	e.ExchangeFailed = (*TrueFalseIndicator)(&value)
 }
 
+func (e *ExchangeConfiguration5) SetExchangeFailedFromBool(value bool) {
+	e.ExchangeFailed = new(TrueFalseIndicator)
+	e.ExchangeFailed.FromBool(value)
+}
+
 func (e *ExchangeConfiguration5) SetExchangeDeclined(value string) {
 	e.ExchangeDeclined = (*TrueFalseIndicator)(&value)
 }
+
+func (e *ExchangeConfiguration5) SetExchangeDeclinedFromBool(value bool) {
+	e.ExchangeDeclined = new(TrueFalseIndicator)
+	e.ExchangeDeclined.FromBool(value)
+}
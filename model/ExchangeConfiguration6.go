@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Configuration parameters of data exchanges.
 type ExchangeConfiguration6 struct {
 
@@ -19,6 +23,10 @@ type ExchangeConfiguration6 struct {
 	TimeCondition *ProcessTiming4 `xml:"TmCond,omitempty"`
 }
 
+func (e *ExchangeConfiguration6) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExchangeConfiguration6) AddExchangePolicy(value string) {
 	e.ExchangePolicy = append(e.ExchangePolicy, (*ExchangePolicy1Code)(&value))
 }
@@ -27,10 +35,19 @@ func (e *ExchangeConfiguration6) SetMaximumNumber(value string) {
 	e.MaximumNumber = (*Number)(&value)
 }
 
+func (e *ExchangeConfiguration6) SetMaximumNumberFromDecimal(value decimal.Decimal) {
+	e.MaximumNumber = new(Number)
+	e.MaximumNumber.FromDecimal(value)
+}
+
 func (e *ExchangeConfiguration6) SetMaximumAmount(value, currency string) {
 	e.MaximumAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (e *ExchangeConfiguration6) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	e.MaximumAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *ExchangeConfiguration6) AddReTry() *ProcessRetry2 {
 	e.ReTry = new(ProcessRetry2)
 	return e.ReTry
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Configuration parameters of data exchanges.
 type ExchangeConfiguration7 struct {
 
@@ -26,6 +30,10 @@ type ExchangeConfiguration7 struct {
 	ExchangeDeclined *TrueFalseIndicator `xml:"XchgDclnd,omitempty"`
 }
 
+func (e *ExchangeConfiguration7) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExchangeConfiguration7) AddExchangePolicy(value string) {
 	e.ExchangePolicy = append(e.ExchangePolicy, (*ExchangePolicy1Code)(&value))
 }
@@ -34,10 +42,19 @@ func (e *ExchangeConfiguration7) SetMaximumNumber(value string) {
 	e.MaximumNumber = (*Number)(&value)
 }
 
+func (e *ExchangeConfiguration7) SetMaximumNumberFromDecimal(value decimal.Decimal) {
+	e.MaximumNumber = new(Number)
+	e.MaximumNumber.FromDecimal(value)
+}
+
 func (e *ExchangeConfiguration7) SetMaximumAmount(value, currency string) {
 	e.MaximumAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (e *ExchangeConfiguration7) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	e.MaximumAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (e *ExchangeConfiguration7) AddReTry() *ProcessRetry2 {
 	e.ReTry = new(ProcessRetry2)
 	return e.ReTry
@@ -52,6 +69,16 @@ func (e *ExchangeConfiguration7) SetExchangeFailed(value string) {
 	e.ExchangeFailed = (*TrueFalseIndicator)(&value)
 }
 
+func (e *ExchangeConfiguration7) SetExchangeFailedFromBool(value bool) {
+	e.ExchangeFailed = new(TrueFalseIndicator)
+	e.ExchangeFailed.FromBool(value)
+}
+
 func (e *ExchangeConfiguration7) SetExchangeDeclined(value string) {
 	e.ExchangeDeclined = (*TrueFalseIndicator)(&value)
 }
+
+func (e *ExchangeConfiguration7) SetExchangeDeclinedFromBool(value bool) {
+	e.ExchangeDeclined = new(TrueFalseIndicator)
+	e.ExchangeDeclined.FromBool(value)
+}
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Further detailed information on the exchange rate that has been used in the payment transaction.
 type ExchangeRate1 struct {
 
@@ -16,6 +21,10 @@ type ExchangeRate1 struct {
 	ContractIdentification *Max35Text `xml:"CtrctId,omitempty"`
 }
 
+func (e *ExchangeRate1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExchangeRate1) SetUnitCurrency(value string) {
 	e.UnitCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -24,10 +33,78 @@ func (e *ExchangeRate1) SetExchangeRate(value string) {
 	e.ExchangeRate = (*BaseOneRate)(&value)
 }
 
-func (e *ExchangeRate1) SetRateType(value string) {
+func (e *ExchangeRate1) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	e.ExchangeRate = new(BaseOneRate)
+	e.ExchangeRate.FromDecimal(value)
+}
+
+func (e *ExchangeRate1) SetRateType(value string) error {
+	if err := ExchangeRateType1Code(value).Validate(); err != nil {
+		return err
+	}
 	e.RateType = (*ExchangeRateType1Code)(&value)
+	return nil
 }
 
 func (e *ExchangeRate1) SetContractIdentification(value string) {
 	e.ContractIdentification = (*Max35Text)(&value)
 }
+
+func (e *ExchangeRate1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if e.UnitCurrency != nil {
+		enc.Element("UnitCcy", string(*e.UnitCurrency))
+	}
+	if e.ExchangeRate != nil {
+		enc.Element("XchgRate", string(*e.ExchangeRate))
+	}
+	if e.RateType != nil {
+		enc.Element("RateTp", string(*e.RateType))
+	}
+	if e.ContractIdentification != nil {
+		enc.Element("CtrctId", string(*e.ContractIdentification))
+	}
+	enc.End(tag)
+}
+
+func (e *ExchangeRate1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "UnitCcy":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			e.UnitCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
+		case "XchgRate":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			e.ExchangeRate = (*BaseOneRate)(&value)
+		case "RateTp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			e.RateType = (*ExchangeRateType1Code)(&value)
+		case "CtrctId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			e.ContractIdentification = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Further detailed information on the exchange rate that has been used in the payment transaction.
 type ExchangeRateInformation1 struct {
 
@@ -13,12 +17,25 @@ type ExchangeRateInformation1 struct {
 	ContractIdentification *Max35Text `xml:"CtrctId,omitempty"`
 }
 
+func (e *ExchangeRateInformation1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExchangeRateInformation1) SetExchangeRate(value string) {
 	e.ExchangeRate = (*BaseOneRate)(&value)
 }
 
-func (e *ExchangeRateInformation1) SetRateType(value string) {
+func (e *ExchangeRateInformation1) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	e.ExchangeRate = new(BaseOneRate)
+	e.ExchangeRate.FromDecimal(value)
+}
+
+func (e *ExchangeRateInformation1) SetRateType(value string) error {
+	if err := ExchangeRateType1Code(value).Validate(); err != nil {
+		return err
+	}
 	e.RateType = (*ExchangeRateType1Code)(&value)
+	return nil
 }
 
 func (e *ExchangeRateInformation1) SetContractIdentification(value string) {
@@ -1,3 +1,28 @@
 package model
 
 type ExchangeRateType1Code string
+
+const (
+	ExchangeRateType1CodeSPOT ExchangeRateType1Code = "SPOT"
+	ExchangeRateType1CodeSALE ExchangeRateType1Code = "SALE"
+	ExchangeRateType1CodeAGRD ExchangeRateType1Code = "AGRD"
+)
+
+var exchangeRateType1CodeDescriptions = map[ExchangeRateType1Code]string{
+	ExchangeRateType1CodeSPOT: "Spot: exchange rate applied is the spot rate.",
+	ExchangeRateType1CodeSALE: "Sale: exchange rate applied is the market rate at the time of the sale.",
+	ExchangeRateType1CodeAGRD: "Agreed: exchange rate applied is the rate agreed between the parties.",
+}
+
+func (e ExchangeRateType1Code) IsValid() bool {
+	_, ok := exchangeRateType1CodeDescriptions[e]
+	return ok
+}
+
+func (e ExchangeRateType1Code) Description() string {
+	return exchangeRateType1CodeDescriptions[e]
+}
+
+func (e ExchangeRateType1Code) Validate() error {
+	return validateEnumeration("ExchangeRateType1Code", string(e), e.IsValid(), "SPOT, SALE, AGRD")
+}
@@ -10,6 +10,10 @@ type ExemptionReason1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (e *ExemptionReason1Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExemptionReason1Choice) SetCode(value string) {
 	e.Code = (*TaxExemptReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ExpectedCollateral1 struct {
 	SegregatedIndependentAmount *ExpectedCollateralMovement1 `xml:"SgrtdIndpdntAmt,omitempty"`
 }
 
+func (e *ExpectedCollateral1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExpectedCollateral1) AddVariationMargin() *ExpectedCollateralMovement1 {
 	e.VariationMargin = new(ExpectedCollateralMovement1)
 	return e.VariationMargin
@@ -10,6 +10,10 @@ type ExpectedCollateral1Choice struct {
 	SegregatedIndependentAmount *ExpectedCollateralMovement1 `xml:"SgrtdIndpdntAmt"`
 }
 
+func (e *ExpectedCollateral1Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExpectedCollateral1Choice) AddExpectedCollateralDetails() *ExpectedCollateral1 {
 	e.ExpectedCollateralDetails = new(ExpectedCollateral1)
 	return e.ExpectedCollateralDetails
@@ -10,6 +10,10 @@ type ExpectedCollateral2 struct {
 	SegregatedIndependentAmount *ExpectedCollateralMovement2 `xml:"SgrtdIndpdntAmt,omitempty"`
 }
 
+func (e *ExpectedCollateral2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExpectedCollateral2) AddVariationMargin() *ExpectedCollateralMovement2 {
 	e.VariationMargin = new(ExpectedCollateralMovement2)
 	return e.VariationMargin
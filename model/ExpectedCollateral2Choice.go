@@ -10,6 +10,10 @@ type ExpectedCollateral2Choice struct {
 	SegregatedIndependentAmount *ExpectedCollateralMovement2 `xml:"SgrtdIndpdntAmt"`
 }
 
+func (e *ExpectedCollateral2Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExpectedCollateral2Choice) AddExpectedCollateralDetails() *ExpectedCollateral2 {
 	e.ExpectedCollateralDetails = new(ExpectedCollateral2)
 	return e.ExpectedCollateralDetails
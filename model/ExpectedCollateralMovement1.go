@@ -10,6 +10,10 @@ type ExpectedCollateralMovement1 struct {
 	Return []*CollateralType1Code `xml:"Rtr,omitempty"`
 }
 
+func (e *ExpectedCollateralMovement1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExpectedCollateralMovement1) AddDelivery(value string) {
 	e.Delivery = append(e.Delivery, (*CollateralType1Code)(&value))
 }
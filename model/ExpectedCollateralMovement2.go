@@ -10,6 +10,10 @@ type ExpectedCollateralMovement2 struct {
 	Return []*CollateralMovement9 `xml:"Rtr,omitempty"`
 }
 
+func (e *ExpectedCollateralMovement2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExpectedCollateralMovement2) AddDelivery() *CollateralMovement9 {
 	newValue := new(CollateralMovement9)
 	e.Delivery = append(e.Delivery, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Expected trade date and expected settlement date of the order execution.
 type ExpectedExecutionDetails1 struct {
 
@@ -10,6 +14,10 @@ type ExpectedExecutionDetails1 struct {
 	ExpectedSettlementDate *ISODate `xml:"XpctdSttlmDt,omitempty"`
 }
 
+func (e *ExpectedExecutionDetails1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExpectedExecutionDetails1) AddExpectedTradeDateTime() *DateAndDateTimeChoice {
 	e.ExpectedTradeDateTime = new(DateAndDateTimeChoice)
 	return e.ExpectedTradeDateTime
@@ -18,3 +26,8 @@ func (e *ExpectedExecutionDetails1) AddExpectedTradeDateTime() *DateAndDateTimeC
 func (e *ExpectedExecutionDetails1) SetExpectedSettlementDate(value string) {
 	e.ExpectedSettlementDate = (*ISODate)(&value)
 }
+
+func (e *ExpectedExecutionDetails1) SetExpectedSettlementDateFromTime(value time.Time) {
+	e.ExpectedSettlementDate = new(ISODate)
+	e.ExpectedSettlementDate.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Expected trade date and expected settlement date of the order execution.
 type ExpectedExecutionDetails2 struct {
 
@@ -10,6 +14,10 @@ type ExpectedExecutionDetails2 struct {
 	ExpectedCashSettlementDate *ISODate `xml:"XpctdCshSttlmDt,omitempty"`
 }
 
+func (e *ExpectedExecutionDetails2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExpectedExecutionDetails2) AddExpectedTradeDateTime() *DateAndDateTimeChoice {
 	e.ExpectedTradeDateTime = new(DateAndDateTimeChoice)
 	return e.ExpectedTradeDateTime
@@ -18,3 +26,8 @@ func (e *ExpectedExecutionDetails2) AddExpectedTradeDateTime() *DateAndDateTimeC
 func (e *ExpectedExecutionDetails2) SetExpectedCashSettlementDate(value string) {
 	e.ExpectedCashSettlementDate = (*ISODate)(&value)
 }
+
+func (e *ExpectedExecutionDetails2) SetExpectedCashSettlementDateFromTime(value time.Time) {
+	e.ExpectedCashSettlementDate = new(ISODate)
+	e.ExpectedCashSettlementDate.FromTime(value)
+}
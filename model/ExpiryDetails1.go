@@ -10,6 +10,10 @@ type ExpiryDetails1 struct {
 	AdditionalExpiryInformation []*Max2000Text `xml:"AddtlXpryInf,omitempty"`
 }
 
+func (e *ExpiryDetails1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExpiryDetails1) AddExpiryTerms() *ExpiryTerms1 {
 	e.ExpiryTerms = new(ExpiryTerms1)
 	return e.ExpiryTerms
@@ -10,6 +10,10 @@ type ExpiryDetails2 struct {
 	AdditionalExpiryInformation []*Max2000Text `xml:"AddtlXpryInf,omitempty"`
 }
 
+func (e *ExpiryDetails2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExpiryDetails2) AddExpiryTerms() *ExpiryTerms2 {
 	e.ExpiryTerms = new(ExpiryTerms2)
 	return e.ExpiryTerms
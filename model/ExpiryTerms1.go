@@ -16,6 +16,10 @@ type ExpiryTerms1 struct {
 	OpenEndedIndicator *YesNoIndicator `xml:"OpnEnddInd,omitempty"`
 }
 
+func (e *ExpiryTerms1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExpiryTerms1) AddDateTime() *DateAndDateTimeChoice {
 	e.DateTime = new(DateAndDateTimeChoice)
 	return e.DateTime
@@ -33,3 +37,8 @@ func (e *ExpiryTerms1) SetCondition(value string) {
 func (e *ExpiryTerms1) SetOpenEndedIndicator(value string) {
 	e.OpenEndedIndicator = (*YesNoIndicator)(&value)
 }
+
+func (e *ExpiryTerms1) SetOpenEndedIndicatorFromBool(value bool) {
+	e.OpenEndedIndicator = new(YesNoIndicator)
+	e.OpenEndedIndicator.FromBool(value)
+}
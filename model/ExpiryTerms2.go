@@ -16,6 +16,10 @@ type ExpiryTerms2 struct {
 	OpenEndedIndicator *YesNoIndicator `xml:"OpnEnddInd,omitempty"`
 }
 
+func (e *ExpiryTerms2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExpiryTerms2) AddDateTime() *DateAndDateTimeChoice {
 	e.DateTime = new(DateAndDateTimeChoice)
 	return e.DateTime
@@ -33,3 +37,8 @@ func (e *ExpiryTerms2) SetCondition(value string) {
 func (e *ExpiryTerms2) SetOpenEndedIndicator(value string) {
 	e.OpenEndedIndicator = (*YesNoIndicator)(&value)
 }
+
+func (e *ExpiryTerms2) SetOpenEndedIndicatorFromBool(value bool) {
+	e.OpenEndedIndicator = new(YesNoIndicator)
+	e.OpenEndedIndicator.FromBool(value)
+}
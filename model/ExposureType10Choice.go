@@ -10,6 +10,10 @@ type ExposureType10Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (e *ExposureType10Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExposureType10Choice) SetCode(value string) {
 	e.Code = (*ExposureType4Code)(&value)
 }
@@ -10,6 +10,10 @@ type ExposureType12Choice struct {
 	Proprietary *GenericIdentification40 `xml:"Prtry"`
 }
 
+func (e *ExposureType12Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExposureType12Choice) SetCode(value string) {
 	e.Code = (*ExposureType4Code)(&value)
 }
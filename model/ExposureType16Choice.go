@@ -10,6 +10,10 @@ type ExposureType16Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (e *ExposureType16Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExposureType16Choice) SetCode(value string) {
 	e.Code = (*ExposureType4Code)(&value)
 }
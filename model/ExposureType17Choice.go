@@ -10,6 +10,10 @@ type ExposureType17Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (e *ExposureType17Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExposureType17Choice) SetCode(value string) {
 	e.Code = (*ExposureType4Code)(&value)
 }
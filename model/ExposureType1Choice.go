@@ -10,6 +10,10 @@ type ExposureType1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (e *ExposureType1Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExposureType1Choice) SetCode(value string) {
 	e.Code = (*ExposureType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ExposureType3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (e *ExposureType3Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExposureType3Choice) SetCode(value string) {
 	e.Code = (*ExposureType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type ExposureType4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (e *ExposureType4Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExposureType4Choice) SetCode(value string) {
 	e.Code = (*ExposureType2Code)(&value)
 }
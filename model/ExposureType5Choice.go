@@ -10,6 +10,10 @@ type ExposureType5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (e *ExposureType5Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExposureType5Choice) SetCode(value string) {
 	e.Code = (*ExposureType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type ExposureType9Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (e *ExposureType9Choice) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExposureType9Choice) SetCode(value string) {
 	e.Code = (*ExposureType3Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Undertaking extend or pay query details.
 type ExtendOrPayQuery1 struct {
 
@@ -25,6 +29,10 @@ type ExtendOrPayQuery1 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (e *ExtendOrPayQuery1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExtendOrPayQuery1) AddUndertakingIdentification() *Undertaking9 {
 	e.UndertakingIdentification = new(Undertaking9)
 	return e.UndertakingIdentification
@@ -39,6 +47,11 @@ func (e *ExtendOrPayQuery1) SetRequestedExpiryDate(value string) {
 	e.RequestedExpiryDate = (*ISODate)(&value)
 }
 
+func (e *ExtendOrPayQuery1) SetRequestedExpiryDateFromTime(value time.Time) {
+	e.RequestedExpiryDate = new(ISODate)
+	e.RequestedExpiryDate.FromTime(value)
+}
+
 func (e *ExtendOrPayQuery1) AddBankInstructions() *BankInstructions1 {
 	e.BankInstructions = new(BankInstructions1)
 	return e.BankInstructions
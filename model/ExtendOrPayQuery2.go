@@ -13,6 +13,10 @@ type ExtendOrPayQuery2 struct {
 	Status *DemandStatus1Code `xml:"Sts"`
 }
 
+func (e *ExtendOrPayQuery2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExtendOrPayQuery2) AddUndertakingIdentification() *Undertaking9 {
 	e.UndertakingIdentification = new(Undertaking9)
 	return e.UndertakingIdentification
@@ -10,6 +10,10 @@ type ExtendedParty1 struct {
 	OtherPartyDetails *InvestmentAccountOwnershipInformation4 `xml:"OthrPtyDtls"`
 }
 
+func (e *ExtendedParty1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExtendedParty1) SetExtendedPartyRole(value string) {
 	e.ExtendedPartyRole = (*Extended350Code)(&value)
 }
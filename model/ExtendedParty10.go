@@ -10,6 +10,10 @@ type ExtendedParty10 struct {
 	OtherPartyDetails *InvestmentAccountOwnershipInformation13 `xml:"OthrPtyDtls"`
 }
 
+func (e *ExtendedParty10) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExtendedParty10) SetExtendedPartyRole(value string) {
 	e.ExtendedPartyRole = (*Extended350Code)(&value)
 }
@@ -10,6 +10,10 @@ type ExtendedParty11 struct {
 	OtherPartyDetails *InvestmentAccountOwnershipInformation14 `xml:"OthrPtyDtls"`
 }
 
+func (e *ExtendedParty11) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExtendedParty11) SetExtendedPartyRole(value string) {
 	e.ExtendedPartyRole = (*Extended350Code)(&value)
 }
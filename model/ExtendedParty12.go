@@ -10,6 +10,10 @@ type ExtendedParty12 struct {
 	OtherPartyDetails *InvestmentAccountOwnershipInformation15 `xml:"OthrPtyDtls"`
 }
 
+func (e *ExtendedParty12) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExtendedParty12) SetExtendedPartyRole(value string) {
 	e.ExtendedPartyRole = (*Extended350Code)(&value)
 }
@@ -10,6 +10,10 @@ type ExtendedParty2 struct {
 	OtherPartyDetails *InvestmentAccountOwnershipInformation5 `xml:"OthrPtyDtls"`
 }
 
+func (e *ExtendedParty2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExtendedParty2) SetExtendedPartyRole(value string) {
 	e.ExtendedPartyRole = (*Extended350Code)(&value)
 }
@@ -10,6 +10,10 @@ type ExtendedParty3 struct {
 	OtherPartyDetails *InvestmentAccountOwnershipInformation6 `xml:"OthrPtyDtls"`
 }
 
+func (e *ExtendedParty3) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExtendedParty3) SetExtendedPartyRole(value string) {
 	e.ExtendedPartyRole = (*Extended350Code)(&value)
 }
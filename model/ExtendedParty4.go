@@ -10,6 +10,10 @@ type ExtendedParty4 struct {
 	OtherPartyDetails *InvestmentAccountOwnershipInformation7 `xml:"OthrPtyDtls"`
 }
 
+func (e *ExtendedParty4) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExtendedParty4) SetExtendedPartyRole(value string) {
 	e.ExtendedPartyRole = (*Extended350Code)(&value)
 }
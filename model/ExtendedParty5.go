@@ -10,6 +10,10 @@ type ExtendedParty5 struct {
 	OtherPartyDetails *InvestmentAccountOwnershipInformation8 `xml:"OthrPtyDtls"`
 }
 
+func (e *ExtendedParty5) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExtendedParty5) SetExtendedPartyRole(value string) {
 	e.ExtendedPartyRole = (*Extended350Code)(&value)
 }
@@ -10,6 +10,10 @@ type ExtendedParty6 struct {
 	OtherPartyDetails *InvestmentAccountOwnershipInformation9 `xml:"OthrPtyDtls"`
 }
 
+func (e *ExtendedParty6) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExtendedParty6) SetExtendedPartyRole(value string) {
 	e.ExtendedPartyRole = (*Extended350Code)(&value)
 }
@@ -10,6 +10,10 @@ type ExtendedParty7 struct {
 	OtherPartyDetails *InvestmentAccountOwnershipInformation10 `xml:"OthrPtyDtls"`
 }
 
+func (e *ExtendedParty7) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExtendedParty7) SetExtendedPartyRole(value string) {
 	e.ExtendedPartyRole = (*Extended350Code)(&value)
 }
@@ -10,6 +10,10 @@ type ExtendedParty8 struct {
 	OtherPartyDetails *InvestmentAccountOwnershipInformation11 `xml:"OthrPtyDtls"`
 }
 
+func (e *ExtendedParty8) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExtendedParty8) SetExtendedPartyRole(value string) {
 	e.ExtendedPartyRole = (*Extended350Code)(&value)
 }
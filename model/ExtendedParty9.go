@@ -10,6 +10,10 @@ type ExtendedParty9 struct {
 	OtherPartyDetails *InvestmentAccountOwnershipInformation12 `xml:"OthrPtyDtls"`
 }
 
+func (e *ExtendedParty9) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExtendedParty9) SetExtendedPartyRole(value string) {
 	e.ExtendedPartyRole = (*Extended350Code)(&value)
 }
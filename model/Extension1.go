@@ -10,6 +10,10 @@ type Extension1 struct {
 	Text *Max350Text `xml:"Txt"`
 }
 
+func (e *Extension1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *Extension1) SetPlaceAndName(value string) {
 	e.PlaceAndName = (*Max350Text)(&value)
 }
@@ -10,6 +10,10 @@ type Extension2 struct {
 	ExtensionEnvelope *ExtensionEnvelope1 `xml:"XtnsnEnvlp"`
 }
 
+func (e *Extension2) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *Extension2) SetPlaceAndName(value string) {
 	e.PlaceAndName = (*Max350Text)(&value)
 }
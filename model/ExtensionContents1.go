@@ -1,3 +1,41 @@
 package model
 
-type ExtensionContents1 string
+import "encoding/xml"
+
+// Technical element that contains the extension, kept as raw XML.
+type ExtensionContents1 struct {
+	Value string `xml:",innerxml"`
+}
+
+func (e *ExtensionContents1) Validate() error {
+	return ValidateElement(e)
+}
+
+func (e *ExtensionContents1) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	value, err := innerXML(d, start)
+	if err != nil {
+		return err
+	}
+	e.Value = value
+	return nil
+}
+
+// Embed replaces the content with the XML encoding of v.
+func (e *ExtensionContents1) Embed(v interface{}) error {
+	value, err := embedXML(v)
+	if err != nil {
+		return err
+	}
+	e.Value = value
+	return nil
+}
+
+// Extract decodes the content into v.
+func (e *ExtensionContents1) Extract(v interface{}) error {
+	return extractXML(e.Value, v)
+}
+
+// Namespace returns the namespace of the first element of the content.
+func (e *ExtensionContents1) Namespace() string {
+	return rootNamespace(e.Value)
+}
@@ -7,6 +7,10 @@ type ExtensionEnvelope1 struct {
 	ExtensionContents *ExtensionContents1 `xml:"XtnsnCnts"`
 }
 
+func (e *ExtensionEnvelope1) Validate() error {
+	return ValidateElement(e)
+}
+
 func (e *ExtensionEnvelope1) AddExtensionContents() *ExtensionContents1 {
 	e.ExtensionContents = new(ExtensionContents1)
 	return e.ExtensionContents
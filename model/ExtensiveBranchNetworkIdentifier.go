@@ -1,3 +1,9 @@
 package model
 
 type ExtensiveBranchNetworkIdentifier string
+
+var extensiveBranchNetworkIdentifierPattern = newPattern(`AU[0-9]{6,6}`)
+
+func (e ExtensiveBranchNetworkIdentifier) Validate() error {
+	return validatePattern("ExtensiveBranchNetworkIdentifier", string(e), extensiveBranchNetworkIdentifierPattern)
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalAcceptedReason1Code string
+
+func (e ExternalAcceptedReason1Code) Validate() error {
+	if err := validateLength("ExternalAcceptedReason1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalAcceptedReason1Code", string(e))
+}
+
+func (e ExternalAcceptedReason1Code) Description() string {
+	return describeExternalCode("ExternalAcceptedReason1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalAccountIdentification1Code string
+
+func (e ExternalAccountIdentification1Code) Validate() error {
+	if err := validateLength("ExternalAccountIdentification1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalAccountIdentification1Code", string(e))
+}
+
+func (e ExternalAccountIdentification1Code) Description() string {
+	return describeExternalCode("ExternalAccountIdentification1Code", string(e))
+}
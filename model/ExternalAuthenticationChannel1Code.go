@@ -1,3 +1,14 @@
 package model
 
 type ExternalAuthenticationChannel1Code string
+
+func (e ExternalAuthenticationChannel1Code) Validate() error {
+	if err := validateLength("ExternalAuthenticationChannel1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalAuthenticationChannel1Code", string(e))
+}
+
+func (e ExternalAuthenticationChannel1Code) Description() string {
+	return describeExternalCode("ExternalAuthenticationChannel1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalBalanceSubType1Code string
+
+func (e ExternalBalanceSubType1Code) Validate() error {
+	if err := validateLength("ExternalBalanceSubType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalBalanceSubType1Code", string(e))
+}
+
+func (e ExternalBalanceSubType1Code) Description() string {
+	return describeExternalCode("ExternalBalanceSubType1Code", string(e))
+}
@@ -0,0 +1,14 @@
+package model
+
+type ExternalBalanceType1Code string
+
+func (e ExternalBalanceType1Code) Validate() error {
+	if err := validateLength("ExternalBalanceType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalBalanceType1Code", string(e))
+}
+
+func (e ExternalBalanceType1Code) Description() string {
+	return describeExternalCode("ExternalBalanceType1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalBankTransactionDomain1Code string
+
+func (e ExternalBankTransactionDomain1Code) Validate() error {
+	if err := validateLength("ExternalBankTransactionDomain1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalBankTransactionDomain1Code", string(e))
+}
+
+func (e ExternalBankTransactionDomain1Code) Description() string {
+	return describeExternalCode("ExternalBankTransactionDomain1Code", string(e))
+}
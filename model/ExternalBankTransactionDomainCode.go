@@ -1,3 +1,14 @@
 package model
 
 type ExternalBankTransactionDomainCode string
+
+func (e ExternalBankTransactionDomainCode) Validate() error {
+	if err := validateLength("ExternalBankTransactionDomainCode", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalBankTransactionDomainCode", string(e))
+}
+
+func (e ExternalBankTransactionDomainCode) Description() string {
+	return describeExternalCode("ExternalBankTransactionDomainCode", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalBankTransactionFamily1Code string
+
+func (e ExternalBankTransactionFamily1Code) Validate() error {
+	if err := validateLength("ExternalBankTransactionFamily1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalBankTransactionFamily1Code", string(e))
+}
+
+func (e ExternalBankTransactionFamily1Code) Description() string {
+	return describeExternalCode("ExternalBankTransactionFamily1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalBankTransactionFamilyCode string
+
+func (e ExternalBankTransactionFamilyCode) Validate() error {
+	if err := validateLength("ExternalBankTransactionFamilyCode", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalBankTransactionFamilyCode", string(e))
+}
+
+func (e ExternalBankTransactionFamilyCode) Description() string {
+	return describeExternalCode("ExternalBankTransactionFamilyCode", string(e))
+}
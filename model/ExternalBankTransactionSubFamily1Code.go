@@ -1,3 +1,14 @@
 package model
 
 type ExternalBankTransactionSubFamily1Code string
+
+func (e ExternalBankTransactionSubFamily1Code) Validate() error {
+	if err := validateLength("ExternalBankTransactionSubFamily1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalBankTransactionSubFamily1Code", string(e))
+}
+
+func (e ExternalBankTransactionSubFamily1Code) Description() string {
+	return describeExternalCode("ExternalBankTransactionSubFamily1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalBankTransactionSubFamilyCode string
+
+func (e ExternalBankTransactionSubFamilyCode) Validate() error {
+	if err := validateLength("ExternalBankTransactionSubFamilyCode", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalBankTransactionSubFamilyCode", string(e))
+}
+
+func (e ExternalBankTransactionSubFamilyCode) Description() string {
+	return describeExternalCode("ExternalBankTransactionSubFamilyCode", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalBillingBalanceType1Code string
+
+func (e ExternalBillingBalanceType1Code) Validate() error {
+	if err := validateLength("ExternalBillingBalanceType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalBillingBalanceType1Code", string(e))
+}
+
+func (e ExternalBillingBalanceType1Code) Description() string {
+	return describeExternalCode("ExternalBillingBalanceType1Code", string(e))
+}
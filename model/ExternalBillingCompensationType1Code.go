@@ -1,3 +1,14 @@
 package model
 
 type ExternalBillingCompensationType1Code string
+
+func (e ExternalBillingCompensationType1Code) Validate() error {
+	if err := validateLength("ExternalBillingCompensationType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalBillingCompensationType1Code", string(e))
+}
+
+func (e ExternalBillingCompensationType1Code) Description() string {
+	return describeExternalCode("ExternalBillingCompensationType1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalBillingRateIdentification1Code string
+
+func (e ExternalBillingRateIdentification1Code) Validate() error {
+	if err := validateLength("ExternalBillingRateIdentification1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalBillingRateIdentification1Code", string(e))
+}
+
+func (e ExternalBillingRateIdentification1Code) Description() string {
+	return describeExternalCode("ExternalBillingRateIdentification1Code", string(e))
+}
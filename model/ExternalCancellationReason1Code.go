@@ -1,3 +1,14 @@
 package model
 
 type ExternalCancellationReason1Code string
+
+func (e ExternalCancellationReason1Code) Validate() error {
+	if err := validateLength("ExternalCancellationReason1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalCancellationReason1Code", string(e))
+}
+
+func (e ExternalCancellationReason1Code) Description() string {
+	return describeExternalCode("ExternalCancellationReason1Code", string(e))
+}
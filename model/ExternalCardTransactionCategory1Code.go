@@ -1,3 +1,14 @@
 package model
 
 type ExternalCardTransactionCategory1Code string
+
+func (e ExternalCardTransactionCategory1Code) Validate() error {
+	if err := validateLength("ExternalCardTransactionCategory1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalCardTransactionCategory1Code", string(e))
+}
+
+func (e ExternalCardTransactionCategory1Code) Description() string {
+	return describeExternalCode("ExternalCardTransactionCategory1Code", string(e))
+}
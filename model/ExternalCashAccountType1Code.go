@@ -1,3 +1,14 @@
 package model
 
 type ExternalCashAccountType1Code string
+
+func (e ExternalCashAccountType1Code) Validate() error {
+	if err := validateLength("ExternalCashAccountType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalCashAccountType1Code", string(e))
+}
+
+func (e ExternalCashAccountType1Code) Description() string {
+	return describeExternalCode("ExternalCashAccountType1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalCashClearingSystem1Code string
+
+func (e ExternalCashClearingSystem1Code) Validate() error {
+	if err := validateLength("ExternalCashClearingSystem1Code", string(e), 1, 3); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalCashClearingSystem1Code", string(e))
+}
+
+func (e ExternalCashClearingSystem1Code) Description() string {
+	return describeExternalCode("ExternalCashClearingSystem1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalCategoryPurpose1Code string
+
+func (e ExternalCategoryPurpose1Code) Validate() error {
+	if err := validateLength("ExternalCategoryPurpose1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalCategoryPurpose1Code", string(e))
+}
+
+func (e ExternalCategoryPurpose1Code) Description() string {
+	return describeExternalCode("ExternalCategoryPurpose1Code", string(e))
+}
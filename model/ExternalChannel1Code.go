@@ -1,3 +1,14 @@
 package model
 
 type ExternalChannel1Code string
+
+func (e ExternalChannel1Code) Validate() error {
+	if err := validateLength("ExternalChannel1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalChannel1Code", string(e))
+}
+
+func (e ExternalChannel1Code) Description() string {
+	return describeExternalCode("ExternalChannel1Code", string(e))
+}
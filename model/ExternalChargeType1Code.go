@@ -1,3 +1,14 @@
 package model
 
 type ExternalChargeType1Code string
+
+func (e ExternalChargeType1Code) Validate() error {
+	if err := validateLength("ExternalChargeType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalChargeType1Code", string(e))
+}
+
+func (e ExternalChargeType1Code) Description() string {
+	return describeExternalCode("ExternalChargeType1Code", string(e))
+}
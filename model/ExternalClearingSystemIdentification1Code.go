@@ -1,3 +1,14 @@
 package model
 
 type ExternalClearingSystemIdentification1Code string
+
+func (e ExternalClearingSystemIdentification1Code) Validate() error {
+	if err := validateLength("ExternalClearingSystemIdentification1Code", string(e), 1, 5); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalClearingSystemIdentification1Code", string(e))
+}
+
+func (e ExternalClearingSystemIdentification1Code) Description() string {
+	return describeExternalCode("ExternalClearingSystemIdentification1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalClearingSystemMemberCode string
+
+func (e ExternalClearingSystemMemberCode) Validate() error {
+	if err := validateLength("ExternalClearingSystemMemberCode", string(e), 1, 5); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalClearingSystemMemberCode", string(e))
+}
+
+func (e ExternalClearingSystemMemberCode) Description() string {
+	return describeExternalCode("ExternalClearingSystemMemberCode", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalCommunicationFormat1Code string
+
+func (e ExternalCommunicationFormat1Code) Validate() error {
+	if err := validateLength("ExternalCommunicationFormat1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalCommunicationFormat1Code", string(e))
+}
+
+func (e ExternalCommunicationFormat1Code) Description() string {
+	return describeExternalCode("ExternalCommunicationFormat1Code", string(e))
+}
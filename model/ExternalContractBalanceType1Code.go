@@ -1,3 +1,14 @@
 package model
 
 type ExternalContractBalanceType1Code string
+
+func (e ExternalContractBalanceType1Code) Validate() error {
+	if err := validateLength("ExternalContractBalanceType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalContractBalanceType1Code", string(e))
+}
+
+func (e ExternalContractBalanceType1Code) Description() string {
+	return describeExternalCode("ExternalContractBalanceType1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalContractClosureReason1Code string
+
+func (e ExternalContractClosureReason1Code) Validate() error {
+	if err := validateLength("ExternalContractClosureReason1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalContractClosureReason1Code", string(e))
+}
+
+func (e ExternalContractClosureReason1Code) Description() string {
+	return describeExternalCode("ExternalContractClosureReason1Code", string(e))
+}
@@ -0,0 +1,14 @@
+package model
+
+type ExternalCreditLineType1Code string
+
+func (e ExternalCreditLineType1Code) Validate() error {
+	if err := validateLength("ExternalCreditLineType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalCreditLineType1Code", string(e))
+}
+
+func (e ExternalCreditLineType1Code) Description() string {
+	return describeExternalCode("ExternalCreditLineType1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalDateFrequency1Code string
+
+func (e ExternalDateFrequency1Code) Validate() error {
+	if err := validateLength("ExternalDateFrequency1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalDateFrequency1Code", string(e))
+}
+
+func (e ExternalDateFrequency1Code) Description() string {
+	return describeExternalCode("ExternalDateFrequency1Code", string(e))
+}
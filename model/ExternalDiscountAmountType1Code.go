@@ -1,3 +1,14 @@
 package model
 
 type ExternalDiscountAmountType1Code string
+
+func (e ExternalDiscountAmountType1Code) Validate() error {
+	if err := validateLength("ExternalDiscountAmountType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalDiscountAmountType1Code", string(e))
+}
+
+func (e ExternalDiscountAmountType1Code) Description() string {
+	return describeExternalCode("ExternalDiscountAmountType1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalDocumentFormat1Code string
+
+func (e ExternalDocumentFormat1Code) Validate() error {
+	if err := validateLength("ExternalDocumentFormat1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalDocumentFormat1Code", string(e))
+}
+
+func (e ExternalDocumentFormat1Code) Description() string {
+	return describeExternalCode("ExternalDocumentFormat1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalDocumentLineType1Code string
+
+func (e ExternalDocumentLineType1Code) Validate() error {
+	if err := validateLength("ExternalDocumentLineType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalDocumentLineType1Code", string(e))
+}
+
+func (e ExternalDocumentLineType1Code) Description() string {
+	return describeExternalCode("ExternalDocumentLineType1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalDocumentPurpose1Code string
+
+func (e ExternalDocumentPurpose1Code) Validate() error {
+	if err := validateLength("ExternalDocumentPurpose1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalDocumentPurpose1Code", string(e))
+}
+
+func (e ExternalDocumentPurpose1Code) Description() string {
+	return describeExternalCode("ExternalDocumentPurpose1Code", string(e))
+}
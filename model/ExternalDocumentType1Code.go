@@ -1,3 +1,14 @@
 package model
 
 type ExternalDocumentType1Code string
+
+func (e ExternalDocumentType1Code) Validate() error {
+	if err := validateLength("ExternalDocumentType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalDocumentType1Code", string(e))
+}
+
+func (e ExternalDocumentType1Code) Description() string {
+	return describeExternalCode("ExternalDocumentType1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalEffectiveDateParameter1Code string
+
+func (e ExternalEffectiveDateParameter1Code) Validate() error {
+	if err := validateLength("ExternalEffectiveDateParameter1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalEffectiveDateParameter1Code", string(e))
+}
+
+func (e ExternalEffectiveDateParameter1Code) Description() string {
+	return describeExternalCode("ExternalEffectiveDateParameter1Code", string(e))
+}
@@ -0,0 +1,14 @@
+package model
+
+type ExternalEntryStatus1Code string
+
+func (e ExternalEntryStatus1Code) Validate() error {
+	if err := validateLength("ExternalEntryStatus1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalEntryStatus1Code", string(e))
+}
+
+func (e ExternalEntryStatus1Code) Description() string {
+	return describeExternalCode("ExternalEntryStatus1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalFinancialInstitutionIdentification1Code string
+
+func (e ExternalFinancialInstitutionIdentification1Code) Validate() error {
+	if err := validateLength("ExternalFinancialInstitutionIdentification1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalFinancialInstitutionIdentification1Code", string(e))
+}
+
+func (e ExternalFinancialInstitutionIdentification1Code) Description() string {
+	return describeExternalCode("ExternalFinancialInstitutionIdentification1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalFinancialInstrumentIdentificationType1Code string
+
+func (e ExternalFinancialInstrumentIdentificationType1Code) Validate() error {
+	if err := validateLength("ExternalFinancialInstrumentIdentificationType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalFinancialInstrumentIdentificationType1Code", string(e))
+}
+
+func (e ExternalFinancialInstrumentIdentificationType1Code) Description() string {
+	return describeExternalCode("ExternalFinancialInstrumentIdentificationType1Code", string(e))
+}
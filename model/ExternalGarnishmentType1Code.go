@@ -1,3 +1,14 @@
 package model
 
 type ExternalGarnishmentType1Code string
+
+func (e ExternalGarnishmentType1Code) Validate() error {
+	if err := validateLength("ExternalGarnishmentType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalGarnishmentType1Code", string(e))
+}
+
+func (e ExternalGarnishmentType1Code) Description() string {
+	return describeExternalCode("ExternalGarnishmentType1Code", string(e))
+}
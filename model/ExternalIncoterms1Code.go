@@ -1,3 +1,14 @@
 package model
 
 type ExternalIncoterms1Code string
+
+func (e ExternalIncoterms1Code) Validate() error {
+	if err := validateLength("ExternalIncoterms1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalIncoterms1Code", string(e))
+}
+
+func (e ExternalIncoterms1Code) Description() string {
+	return describeExternalCode("ExternalIncoterms1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalInformationType1Code string
+
+func (e ExternalInformationType1Code) Validate() error {
+	if err := validateLength("ExternalInformationType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalInformationType1Code", string(e))
+}
+
+func (e ExternalInformationType1Code) Description() string {
+	return describeExternalCode("ExternalInformationType1Code", string(e))
+}
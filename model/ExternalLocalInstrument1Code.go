@@ -1,3 +1,14 @@
 package model
 
 type ExternalLocalInstrument1Code string
+
+func (e ExternalLocalInstrument1Code) Validate() error {
+	if err := validateLength("ExternalLocalInstrument1Code", string(e), 1, 35); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalLocalInstrument1Code", string(e))
+}
+
+func (e ExternalLocalInstrument1Code) Description() string {
+	return describeExternalCode("ExternalLocalInstrument1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalLocalInstrumentCode string
+
+func (e ExternalLocalInstrumentCode) Validate() error {
+	if err := validateLength("ExternalLocalInstrumentCode", string(e), 1, 35); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalLocalInstrumentCode", string(e))
+}
+
+func (e ExternalLocalInstrumentCode) Description() string {
+	return describeExternalCode("ExternalLocalInstrumentCode", string(e))
+}
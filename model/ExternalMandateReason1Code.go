@@ -1,3 +1,14 @@
 package model
 
 type ExternalMandateReason1Code string
+
+func (e ExternalMandateReason1Code) Validate() error {
+	if err := validateLength("ExternalMandateReason1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalMandateReason1Code", string(e))
+}
+
+func (e ExternalMandateReason1Code) Description() string {
+	return describeExternalCode("ExternalMandateReason1Code", string(e))
+}
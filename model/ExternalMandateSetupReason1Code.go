@@ -1,3 +1,14 @@
 package model
 
 type ExternalMandateSetupReason1Code string
+
+func (e ExternalMandateSetupReason1Code) Validate() error {
+	if err := validateLength("ExternalMandateSetupReason1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalMandateSetupReason1Code", string(e))
+}
+
+func (e ExternalMandateSetupReason1Code) Description() string {
+	return describeExternalCode("ExternalMandateSetupReason1Code", string(e))
+}
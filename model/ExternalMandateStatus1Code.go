@@ -1,3 +1,14 @@
 package model
 
 type ExternalMandateStatus1Code string
+
+func (e ExternalMandateStatus1Code) Validate() error {
+	if err := validateLength("ExternalMandateStatus1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalMandateStatus1Code", string(e))
+}
+
+func (e ExternalMandateStatus1Code) Description() string {
+	return describeExternalCode("ExternalMandateStatus1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalMandateSuspensionReason1Code string
+
+func (e ExternalMandateSuspensionReason1Code) Validate() error {
+	if err := validateLength("ExternalMandateSuspensionReason1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalMandateSuspensionReason1Code", string(e))
+}
+
+func (e ExternalMandateSuspensionReason1Code) Description() string {
+	return describeExternalCode("ExternalMandateSuspensionReason1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalMarketArea1Code string
+
+func (e ExternalMarketArea1Code) Validate() error {
+	if err := validateLength("ExternalMarketArea1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalMarketArea1Code", string(e))
+}
+
+func (e ExternalMarketArea1Code) Description() string {
+	return describeExternalCode("ExternalMarketArea1Code", string(e))
+}
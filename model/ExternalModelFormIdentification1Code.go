@@ -1,3 +1,14 @@
 package model
 
 type ExternalModelFormIdentification1Code string
+
+func (e ExternalModelFormIdentification1Code) Validate() error {
+	if err := validateLength("ExternalModelFormIdentification1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalModelFormIdentification1Code", string(e))
+}
+
+func (e ExternalModelFormIdentification1Code) Description() string {
+	return describeExternalCode("ExternalModelFormIdentification1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalNarrativeType1Code string
+
+func (e ExternalNarrativeType1Code) Validate() error {
+	if err := validateLength("ExternalNarrativeType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalNarrativeType1Code", string(e))
+}
+
+func (e ExternalNarrativeType1Code) Description() string {
+	return describeExternalCode("ExternalNarrativeType1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalOrganisationIdentification1Code string
+
+func (e ExternalOrganisationIdentification1Code) Validate() error {
+	if err := validateLength("ExternalOrganisationIdentification1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalOrganisationIdentification1Code", string(e))
+}
+
+func (e ExternalOrganisationIdentification1Code) Description() string {
+	return describeExternalCode("ExternalOrganisationIdentification1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalPackagingType1Code string
+
+func (e ExternalPackagingType1Code) Validate() error {
+	if err := validateLength("ExternalPackagingType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalPackagingType1Code", string(e))
+}
+
+func (e ExternalPackagingType1Code) Description() string {
+	return describeExternalCode("ExternalPackagingType1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalPaymentGroupStatus1Code string
+
+func (e ExternalPaymentGroupStatus1Code) Validate() error {
+	if err := validateLength("ExternalPaymentGroupStatus1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalPaymentGroupStatus1Code", string(e))
+}
+
+func (e ExternalPaymentGroupStatus1Code) Description() string {
+	return describeExternalCode("ExternalPaymentGroupStatus1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalPaymentTransactionStatus1Code string
+
+func (e ExternalPaymentTransactionStatus1Code) Validate() error {
+	if err := validateLength("ExternalPaymentTransactionStatus1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalPaymentTransactionStatus1Code", string(e))
+}
+
+func (e ExternalPaymentTransactionStatus1Code) Description() string {
+	return describeExternalCode("ExternalPaymentTransactionStatus1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalPendingProcessingReason1Code string
+
+func (e ExternalPendingProcessingReason1Code) Validate() error {
+	if err := validateLength("ExternalPendingProcessingReason1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalPendingProcessingReason1Code", string(e))
+}
+
+func (e ExternalPendingProcessingReason1Code) Description() string {
+	return describeExternalCode("ExternalPendingProcessingReason1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalPersonIdentification1Code string
+
+func (e ExternalPersonIdentification1Code) Validate() error {
+	if err := validateLength("ExternalPersonIdentification1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalPersonIdentification1Code", string(e))
+}
+
+func (e ExternalPersonIdentification1Code) Description() string {
+	return describeExternalCode("ExternalPersonIdentification1Code", string(e))
+}
@@ -0,0 +1,14 @@
+package model
+
+type ExternalProxyAccountType1Code string
+
+func (e ExternalProxyAccountType1Code) Validate() error {
+	if err := validateLength("ExternalProxyAccountType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalProxyAccountType1Code", string(e))
+}
+
+func (e ExternalProxyAccountType1Code) Description() string {
+	return describeExternalCode("ExternalProxyAccountType1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalPurpose1Code string
+
+func (e ExternalPurpose1Code) Validate() error {
+	if err := validateLength("ExternalPurpose1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalPurpose1Code", string(e))
+}
+
+func (e ExternalPurpose1Code) Description() string {
+	return describeExternalCode("ExternalPurpose1Code", string(e))
+}
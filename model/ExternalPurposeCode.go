@@ -1,3 +1,14 @@
 package model
 
 type ExternalPurposeCode string
+
+func (e ExternalPurposeCode) Validate() error {
+	if err := validateLength("ExternalPurposeCode", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalPurposeCode", string(e))
+}
+
+func (e ExternalPurposeCode) Description() string {
+	return describeExternalCode("ExternalPurposeCode", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalRePresentmentReason1Code string
+
+func (e ExternalRePresentmentReason1Code) Validate() error {
+	if err := validateLength("ExternalRePresentmentReason1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalRePresentmentReason1Code", string(e))
+}
+
+func (e ExternalRePresentmentReason1Code) Description() string {
+	return describeExternalCode("ExternalRePresentmentReason1Code", string(e))
+}
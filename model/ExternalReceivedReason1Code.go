@@ -1,3 +1,14 @@
 package model
 
 type ExternalReceivedReason1Code string
+
+func (e ExternalReceivedReason1Code) Validate() error {
+	if err := validateLength("ExternalReceivedReason1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalReceivedReason1Code", string(e))
+}
+
+func (e ExternalReceivedReason1Code) Description() string {
+	return describeExternalCode("ExternalReceivedReason1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalRejectedReason1Code string
+
+func (e ExternalRejectedReason1Code) Validate() error {
+	if err := validateLength("ExternalRejectedReason1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalRejectedReason1Code", string(e))
+}
+
+func (e ExternalRejectedReason1Code) Description() string {
+	return describeExternalCode("ExternalRejectedReason1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalRelativeTo1Code string
+
+func (e ExternalRelativeTo1Code) Validate() error {
+	if err := validateLength("ExternalRelativeTo1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalRelativeTo1Code", string(e))
+}
+
+func (e ExternalRelativeTo1Code) Description() string {
+	return describeExternalCode("ExternalRelativeTo1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalReportingSource1Code string
+
+func (e ExternalReportingSource1Code) Validate() error {
+	if err := validateLength("ExternalReportingSource1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalReportingSource1Code", string(e))
+}
+
+func (e ExternalReportingSource1Code) Description() string {
+	return describeExternalCode("ExternalReportingSource1Code", string(e))
+}
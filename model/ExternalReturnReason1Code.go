@@ -1,3 +1,14 @@
 package model
 
 type ExternalReturnReason1Code string
+
+func (e ExternalReturnReason1Code) Validate() error {
+	if err := validateLength("ExternalReturnReason1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalReturnReason1Code", string(e))
+}
+
+func (e ExternalReturnReason1Code) Description() string {
+	return describeExternalCode("ExternalReturnReason1Code", string(e))
+}
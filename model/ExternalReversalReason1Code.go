@@ -1,3 +1,14 @@
 package model
 
 type ExternalReversalReason1Code string
+
+func (e ExternalReversalReason1Code) Validate() error {
+	if err := validateLength("ExternalReversalReason1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalReversalReason1Code", string(e))
+}
+
+func (e ExternalReversalReason1Code) Description() string {
+	return describeExternalCode("ExternalReversalReason1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalSecuritiesPurpose1Code string
+
+func (e ExternalSecuritiesPurpose1Code) Validate() error {
+	if err := validateLength("ExternalSecuritiesPurpose1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalSecuritiesPurpose1Code", string(e))
+}
+
+func (e ExternalSecuritiesPurpose1Code) Description() string {
+	return describeExternalCode("ExternalSecuritiesPurpose1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalServiceLevel1Code string
+
+func (e ExternalServiceLevel1Code) Validate() error {
+	if err := validateLength("ExternalServiceLevel1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalServiceLevel1Code", string(e))
+}
+
+func (e ExternalServiceLevel1Code) Description() string {
+	return describeExternalCode("ExternalServiceLevel1Code", string(e))
+}
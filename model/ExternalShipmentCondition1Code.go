@@ -1,3 +1,14 @@
 package model
 
 type ExternalShipmentCondition1Code string
+
+func (e ExternalShipmentCondition1Code) Validate() error {
+	if err := validateLength("ExternalShipmentCondition1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalShipmentCondition1Code", string(e))
+}
+
+func (e ExternalShipmentCondition1Code) Description() string {
+	return describeExternalCode("ExternalShipmentCondition1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalStatusReason1Code string
+
+func (e ExternalStatusReason1Code) Validate() error {
+	if err := validateLength("ExternalStatusReason1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalStatusReason1Code", string(e))
+}
+
+func (e ExternalStatusReason1Code) Description() string {
+	return describeExternalCode("ExternalStatusReason1Code", string(e))
+}
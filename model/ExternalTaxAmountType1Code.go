@@ -1,3 +1,14 @@
 package model
 
 type ExternalTaxAmountType1Code string
+
+func (e ExternalTaxAmountType1Code) Validate() error {
+	if err := validateLength("ExternalTaxAmountType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalTaxAmountType1Code", string(e))
+}
+
+func (e ExternalTaxAmountType1Code) Description() string {
+	return describeExternalCode("ExternalTaxAmountType1Code", string(e))
+}
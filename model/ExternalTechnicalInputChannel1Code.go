@@ -1,3 +1,14 @@
 package model
 
 type ExternalTechnicalInputChannel1Code string
+
+func (e ExternalTechnicalInputChannel1Code) Validate() error {
+	if err := validateLength("ExternalTechnicalInputChannel1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalTechnicalInputChannel1Code", string(e))
+}
+
+func (e ExternalTechnicalInputChannel1Code) Description() string {
+	return describeExternalCode("ExternalTechnicalInputChannel1Code", string(e))
+}
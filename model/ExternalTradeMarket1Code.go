@@ -1,3 +1,14 @@
 package model
 
 type ExternalTradeMarket1Code string
+
+func (e ExternalTradeMarket1Code) Validate() error {
+	if err := validateLength("ExternalTradeMarket1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalTradeMarket1Code", string(e))
+}
+
+func (e ExternalTradeMarket1Code) Description() string {
+	return describeExternalCode("ExternalTradeMarket1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalTradeTransactionCondition1Code string
+
+func (e ExternalTradeTransactionCondition1Code) Validate() error {
+	if err := validateLength("ExternalTradeTransactionCondition1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalTradeTransactionCondition1Code", string(e))
+}
+
+func (e ExternalTradeTransactionCondition1Code) Description() string {
+	return describeExternalCode("ExternalTradeTransactionCondition1Code", string(e))
+}
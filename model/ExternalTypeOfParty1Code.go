@@ -1,3 +1,14 @@
 package model
 
 type ExternalTypeOfParty1Code string
+
+func (e ExternalTypeOfParty1Code) Validate() error {
+	if err := validateLength("ExternalTypeOfParty1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalTypeOfParty1Code", string(e))
+}
+
+func (e ExternalTypeOfParty1Code) Description() string {
+	return describeExternalCode("ExternalTypeOfParty1Code", string(e))
+}
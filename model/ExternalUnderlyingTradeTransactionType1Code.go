@@ -1,3 +1,14 @@
 package model
 
 type ExternalUnderlyingTradeTransactionType1Code string
+
+func (e ExternalUnderlyingTradeTransactionType1Code) Validate() error {
+	if err := validateLength("ExternalUnderlyingTradeTransactionType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalUnderlyingTradeTransactionType1Code", string(e))
+}
+
+func (e ExternalUnderlyingTradeTransactionType1Code) Description() string {
+	return describeExternalCode("ExternalUnderlyingTradeTransactionType1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalUndertakingAmountType1Code string
+
+func (e ExternalUndertakingAmountType1Code) Validate() error {
+	if err := validateLength("ExternalUndertakingAmountType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalUndertakingAmountType1Code", string(e))
+}
+
+func (e ExternalUndertakingAmountType1Code) Description() string {
+	return describeExternalCode("ExternalUndertakingAmountType1Code", string(e))
+}
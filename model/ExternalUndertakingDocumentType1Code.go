@@ -1,3 +1,14 @@
 package model
 
 type ExternalUndertakingDocumentType1Code string
+
+func (e ExternalUndertakingDocumentType1Code) Validate() error {
+	if err := validateLength("ExternalUndertakingDocumentType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalUndertakingDocumentType1Code", string(e))
+}
+
+func (e ExternalUndertakingDocumentType1Code) Description() string {
+	return describeExternalCode("ExternalUndertakingDocumentType1Code", string(e))
+}
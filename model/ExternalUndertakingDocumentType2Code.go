@@ -1,3 +1,14 @@
 package model
 
 type ExternalUndertakingDocumentType2Code string
+
+func (e ExternalUndertakingDocumentType2Code) Validate() error {
+	if err := validateLength("ExternalUndertakingDocumentType2Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalUndertakingDocumentType2Code", string(e))
+}
+
+func (e ExternalUndertakingDocumentType2Code) Description() string {
+	return describeExternalCode("ExternalUndertakingDocumentType2Code", string(e))
+}
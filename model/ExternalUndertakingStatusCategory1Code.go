@@ -1,3 +1,14 @@
 package model
 
 type ExternalUndertakingStatusCategory1Code string
+
+func (e ExternalUndertakingStatusCategory1Code) Validate() error {
+	if err := validateLength("ExternalUndertakingStatusCategory1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalUndertakingStatusCategory1Code", string(e))
+}
+
+func (e ExternalUndertakingStatusCategory1Code) Description() string {
+	return describeExternalCode("ExternalUndertakingStatusCategory1Code", string(e))
+}
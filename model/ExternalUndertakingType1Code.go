@@ -1,3 +1,14 @@
 package model
 
 type ExternalUndertakingType1Code string
+
+func (e ExternalUndertakingType1Code) Validate() error {
+	if err := validateLength("ExternalUndertakingType1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalUndertakingType1Code", string(e))
+}
+
+func (e ExternalUndertakingType1Code) Description() string {
+	return describeExternalCode("ExternalUndertakingType1Code", string(e))
+}
@@ -1,3 +1,14 @@
 package model
 
 type ExternalValidationRuleIdentification1Code string
+
+func (e ExternalValidationRuleIdentification1Code) Validate() error {
+	if err := validateLength("ExternalValidationRuleIdentification1Code", string(e), 1, 35); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalValidationRuleIdentification1Code", string(e))
+}
+
+func (e ExternalValidationRuleIdentification1Code) Description() string {
+	return describeExternalCode("ExternalValidationRuleIdentification1Code", string(e))
+}
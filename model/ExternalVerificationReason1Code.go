@@ -1,3 +1,14 @@
 package model
 
 type ExternalVerificationReason1Code string
+
+func (e ExternalVerificationReason1Code) Validate() error {
+	if err := validateLength("ExternalVerificationReason1Code", string(e), 1, 4); err != nil {
+		return err
+	}
+	return validateExternalCode("ExternalVerificationReason1Code", string(e))
+}
+
+func (e ExternalVerificationReason1Code) Description() string {
+	return describeExternalCode("ExternalVerificationReason1Code", string(e))
+}
@@ -10,6 +10,10 @@ type FATCAForm1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *FATCAForm1Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FATCAForm1Choice) SetCode(value string) {
 	f.Code = (*FATCAFormType1Code)(&value)
 }
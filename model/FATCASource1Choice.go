@@ -10,6 +10,10 @@ type FATCASource1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *FATCASource1Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FATCASource1Choice) SetCode(value string) {
 	f.Code = (*FATCASourceStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type FATCAStatus1 struct {
 	Source *FATCASource1Choice `xml:"Src,omitempty"`
 }
 
+func (f *FATCAStatus1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FATCAStatus1) AddType() *FATCAStatus1Choice {
 	f.Type = new(FATCAStatus1Choice)
 	return f.Type
@@ -10,6 +10,10 @@ type FATCAStatus1Choice struct {
 	Proprietary *GenericIdentification29 `xml:"Prtry"`
 }
 
+func (f *FATCAStatus1Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FATCAStatus1Choice) SetCode(value string) {
 	f.Code = (*FATCAStatus1Code)(&value)
 }
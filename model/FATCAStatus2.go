@@ -10,6 +10,10 @@ type FATCAStatus2 struct {
 	Source *FATCASource1Choice `xml:"Src,omitempty"`
 }
 
+func (f *FATCAStatus2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FATCAStatus2) AddType() *FATCAStatus2Choice {
 	f.Type = new(FATCAStatus2Choice)
 	return f.Type
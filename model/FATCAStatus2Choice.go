@@ -10,6 +10,10 @@ type FATCAStatus2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *FATCAStatus2Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FATCAStatus2Choice) SetCode(value string) {
 	f.Code = (*FATCAStatus1Code)(&value)
 }
@@ -10,10 +10,19 @@ type FXCancellation3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (f *FXCancellation3Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FXCancellation3Choice) SetIndicator(value string) {
 	f.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FXCancellation3Choice) SetIndicatorFromBool(value bool) {
+	f.Indicator = new(YesNoIndicator)
+	f.Indicator.FromBool(value)
+}
+
 func (f *FXCancellation3Choice) AddProprietary() *GenericIdentification30 {
 	f.Proprietary = new(GenericIdentification30)
 	return f.Proprietary
@@ -10,10 +10,19 @@ type FXCancellation4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *FXCancellation4Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FXCancellation4Choice) SetIndicator(value string) {
 	f.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FXCancellation4Choice) SetIndicatorFromBool(value bool) {
+	f.Indicator = new(YesNoIndicator)
+	f.Indicator.FromBool(value)
+}
+
 func (f *FXCancellation4Choice) AddProprietary() *GenericIdentification47 {
 	f.Proprietary = new(GenericIdentification47)
 	return f.Proprietary
@@ -10,10 +10,19 @@ type FXStandingInstruction1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FXStandingInstruction1Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FXStandingInstruction1Choice) SetIndicator(value string) {
 	f.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FXStandingInstruction1Choice) SetIndicatorFromBool(value bool) {
+	f.Indicator = new(YesNoIndicator)
+	f.Indicator.FromBool(value)
+}
+
 func (f *FXStandingInstruction1Choice) AddProprietary() *GenericIdentification20 {
 	f.Proprietary = new(GenericIdentification20)
 	return f.Proprietary
@@ -10,10 +10,19 @@ type FXStandingInstruction3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (f *FXStandingInstruction3Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FXStandingInstruction3Choice) SetIndicator(value string) {
 	f.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FXStandingInstruction3Choice) SetIndicatorFromBool(value bool) {
+	f.Indicator = new(YesNoIndicator)
+	f.Indicator.FromBool(value)
+}
+
 func (f *FXStandingInstruction3Choice) AddProprietary() *GenericIdentification38 {
 	f.Proprietary = new(GenericIdentification38)
 	return f.Proprietary
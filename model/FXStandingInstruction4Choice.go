@@ -10,10 +10,19 @@ type FXStandingInstruction4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (f *FXStandingInstruction4Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FXStandingInstruction4Choice) SetIndicator(value string) {
 	f.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FXStandingInstruction4Choice) SetIndicatorFromBool(value bool) {
+	f.Indicator = new(YesNoIndicator)
+	f.Indicator.FromBool(value)
+}
+
 func (f *FXStandingInstruction4Choice) AddProprietary() *GenericIdentification30 {
 	f.Proprietary = new(GenericIdentification30)
 	return f.Proprietary
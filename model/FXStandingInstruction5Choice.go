@@ -10,10 +10,19 @@ type FXStandingInstruction5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *FXStandingInstruction5Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FXStandingInstruction5Choice) SetIndicator(value string) {
 	f.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FXStandingInstruction5Choice) SetIndicatorFromBool(value bool) {
+	f.Indicator = new(YesNoIndicator)
+	f.Indicator.FromBool(value)
+}
+
 func (f *FXStandingInstruction5Choice) AddProprietary() *GenericIdentification47 {
 	f.Proprietary = new(GenericIdentification47)
 	return f.Proprietary
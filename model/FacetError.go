@@ -0,0 +1,214 @@
+package model
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yudaprama/iso20022/amount"
+	"github.com/yudaprama/iso20022/codeset"
+)
+
+// FacetError reports a simple type value that violates one of the XSD facets of its type.
+type FacetError struct {
+
+	// Name of the simple type, for example Max35Text.
+	Type string
+
+	// Offending value.
+	Value string
+
+	// Name of the violated facet, for example maxLength or pattern.
+	Facet string
+
+	// Value of the violated facet.
+	Limit string
+}
+
+func (e *FacetError) Error() string {
+	return fmt.Sprintf("model: %s value %q violates %s %s", e.Type, e.Value, e.Facet, e.Limit)
+}
+
+// newPattern compiles an XSD pattern. XSD patterns are implicitly anchored at both ends.
+func newPattern(pattern string) *regexp.Regexp {
+	return regexp.MustCompile(`^(?:` + pattern + `)$`)
+}
+
+func validateLength(typ, value string, min, max int) error {
+	n := utf8.RuneCountInString(value)
+	if min == max && n != min {
+		return &FacetError{Type: typ, Value: value, Facet: "length", Limit: fmt.Sprint(min)}
+	}
+	if n < min {
+		return &FacetError{Type: typ, Value: value, Facet: "minLength", Limit: fmt.Sprint(min)}
+	}
+	if max >= 0 && n > max {
+		return &FacetError{Type: typ, Value: value, Facet: "maxLength", Limit: fmt.Sprint(max)}
+	}
+	return nil
+}
+
+func validatePattern(typ, value string, pattern *regexp.Regexp) error {
+	if !pattern.MatchString(value) {
+		limit := strings.TrimSuffix(strings.TrimPrefix(pattern.String(), "^(?:"), ")$")
+		return &FacetError{Type: typ, Value: value, Facet: "pattern", Limit: limit}
+	}
+	return nil
+}
+
+var decimalPattern = newPattern(`[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)`)
+
+// validateDecimal checks the lexical space of xs:decimal and the totalDigits and
+// fractionDigits facets. A negative totalDigits or fractionDigits means the
+// facet is not set.
+func validateDecimal(typ, value string, totalDigits, fractionDigits int) error {
+	if !decimalPattern.MatchString(value) {
+		return &FacetError{Type: typ, Value: value, Facet: "lexical space of", Limit: "xs:decimal"}
+	}
+	digits := strings.TrimLeft(value, "+-")
+	integer, fraction := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		integer, fraction = digits[:i], digits[i+1:]
+	}
+	integer = strings.TrimLeft(integer, "0")
+	fraction = strings.TrimRight(fraction, "0")
+	if fractionDigits >= 0 && len(fraction) > fractionDigits {
+		return &FacetError{Type: typ, Value: value, Facet: "fractionDigits", Limit: fmt.Sprint(fractionDigits)}
+	}
+	if totalDigits >= 0 && len(integer)+len(fraction) > totalDigits {
+		return &FacetError{Type: typ, Value: value, Facet: "totalDigits", Limit: fmt.Sprint(totalDigits)}
+	}
+	return nil
+}
+
+// validateInclusive checks the minInclusive or maxInclusive facet of a value
+// that is already known to be a valid xs:decimal.
+func validateInclusive(typ, value, facet, limit string) error {
+	v, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return &FacetError{Type: typ, Value: value, Facet: "lexical space of", Limit: "xs:decimal"}
+	}
+	l, _ := new(big.Rat).SetString(limit)
+	c := v.Cmp(l)
+	if (facet == "minInclusive" && c < 0) || (facet == "maxInclusive" && c > 0) {
+		return &FacetError{Type: typ, Value: value, Facet: facet, Limit: limit}
+	}
+	return nil
+}
+
+// validateAmount checks the facets of a currency amount: its digits, that it is
+// not negative and, when active is set, that its currency is an active ISO 4217
+// code. When minorUnits is set, an amount in a known currency may not have more
+// fraction digits than the minor units of the currency.
+func validateAmount(typ, value, currency string, totalDigits, fractionDigits int, active, minorUnits bool) error {
+	if err := validateDecimal(typ, value, totalDigits, fractionDigits); err != nil {
+		return err
+	}
+	if err := validateInclusive(typ, value, "minInclusive", "0"); err != nil {
+		return err
+	}
+	if active && !amount.IsActive(currency) {
+		return &FacetError{Type: typ, Value: currency, Facet: "enumeration of", Limit: "ISO 4217 active currency codes"}
+	}
+	if _, ok := amount.MinorUnits(currency); ok && minorUnits {
+		return amount.Validate(value, currency)
+	}
+	return nil
+}
+
+func validateEnumeration(typ, value string, valid bool, values string) error {
+	if !valid {
+		return &FacetError{Type: typ, Value: value, Facet: "enumeration", Limit: values}
+	}
+	return nil
+}
+
+// validateExternalCode checks a value against its external code set in the
+// codeset.Default registry. Only the code sets the registry holds complete are
+// checked; a value missing from a partial one is passed to codeset.Warn.
+func validateExternalCode(typ, value string) error {
+	if _, ok := codeset.Lookup(typ, value); ok || !codeset.Known(typ) {
+		return nil
+	}
+	if !codeset.Complete(typ) {
+		if codeset.Warn != nil {
+			codeset.Warn(typ, value)
+		}
+		return nil
+	}
+	return &FacetError{Type: typ, Value: value, Facet: "external code set", Limit: codeset.Default.Version()}
+}
+
+// describeExternalCode returns the name and definition of an external code, or
+// an empty string when the code is not in the codeset.Default registry.
+func describeExternalCode(typ, value string) string {
+	c, ok := codeset.Lookup(typ, value)
+	if !ok {
+		return ""
+	}
+	if c.Definition == "" {
+		return c.Name
+	}
+	if c.Name == "" {
+		return c.Definition
+	}
+	return c.Name + ": " + c.Definition
+}
+
+func validateBoolean(typ, value string) error {
+	switch value {
+	case "true", "false", "1", "0":
+		return nil
+	}
+	return &FacetError{Type: typ, Value: value, Facet: "lexical space of", Limit: "xs:boolean"}
+}
+
+// validateBinary checks the length facets of an xs:base64Binary value, which
+// are expressed in octets of the decoded data. A negative max means the
+// maxLength facet is not set.
+func validateBinary(typ, value string, min, max int) error {
+	data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(value), ""))
+	if err != nil {
+		return &FacetError{Type: typ, Value: value, Facet: "lexical space of", Limit: "xs:base64Binary"}
+	}
+	if len(data) < min {
+		return &FacetError{Type: typ, Value: value, Facet: "minLength", Limit: fmt.Sprint(min)}
+	}
+	if max >= 0 && len(data) > max {
+		return &FacetError{Type: typ, Value: value, Facet: "maxLength", Limit: fmt.Sprint(max)}
+	}
+	return nil
+}
+
+var (
+	timeZone          = `(Z|[+-]((0[0-9]|1[0-3]):[0-5][0-9]|14:00))?`
+	datePattern       = newPattern(`-?[0-9]{4,}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])` + timeZone)
+	timePattern       = newPattern(`(([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9](\.[0-9]+)?|24:00:00(\.0+)?)` + timeZone)
+	dateTimePattern   = newPattern(`-?[0-9]{4,}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])T(([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9](\.[0-9]+)?|24:00:00(\.0+)?)` + timeZone)
+	gYearPattern      = newPattern(`-?[0-9]{4,}` + timeZone)
+	gYearMonthPattern = newPattern(`-?[0-9]{4,}-(0[1-9]|1[0-2])` + timeZone)
+)
+
+// validateBuiltin checks the lexical space of the date and time XSD built-in types.
+func validateBuiltin(typ, value, builtin string) error {
+	var pattern *regexp.Regexp
+	switch builtin {
+	case "date":
+		pattern = datePattern
+	case "time":
+		pattern = timePattern
+	case "dateTime":
+		pattern = dateTimePattern
+	case "gYear":
+		pattern = gYearPattern
+	case "gYearMonth":
+		pattern = gYearMonthPattern
+	}
+	if !pattern.MatchString(value) {
+		return &FacetError{Type: typ, Value: value, Facet: "lexical space of", Limit: "xs:" + builtin}
+	}
+	return nil
+}
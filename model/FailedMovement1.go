@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about  a movement that failed the settlement.
 type FailedMovement1 struct {
 
@@ -16,10 +20,18 @@ type FailedMovement1 struct {
 	Reason *FailedSettlementReason1FormatChoice `xml:"Rsn"`
 }
 
+func (f *FailedMovement1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailedMovement1) SetCashAmount(value, currency string) {
 	f.CashAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FailedMovement1) SetCashAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.CashAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FailedMovement1) AddSecuritiesQuantity() *UnitOrFaceAmount1Choice {
 	f.SecuritiesQuantity = new(UnitOrFaceAmount1Choice)
 	return f.SecuritiesQuantity
@@ -10,6 +10,10 @@ type FailedSettlementReason1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (f *FailedSettlementReason1FormatChoice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailedSettlementReason1FormatChoice) SetCode(value string) {
 	f.Code = (*FailedSettlementReason1Code)(&value)
 }
@@ -13,6 +13,10 @@ type FailedSettlementStatus1 struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (f *FailedSettlementStatus1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailedSettlementStatus1) SetReason(value string) {
 	f.Reason = (*Max350Text)(&value)
 }
@@ -22,6 +26,10 @@ func (f *FailedSettlementStatus1) AddDataSourceScheme() *GenericIdentification1
 	return f.DataSourceScheme
 }
 
-func (f *FailedSettlementStatus1) SetNoSpecifiedReason(value string) {
+func (f *FailedSettlementStatus1) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	f.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
@@ -13,6 +13,10 @@ type FailedSettlementStatus2Choice struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (f *FailedSettlementStatus2Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailedSettlementStatus2Choice) SetReason(value string) {
 	f.Reason = (*Max350Text)(&value)
 }
@@ -22,6 +26,10 @@ func (f *FailedSettlementStatus2Choice) AddDataSourceScheme() *GenericIdentifica
 	return f.DataSourceScheme
 }
 
-func (f *FailedSettlementStatus2Choice) SetNoSpecifiedReason(value string) {
+func (f *FailedSettlementStatus2Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	f.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
@@ -10,6 +10,10 @@ type FailingReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (f *FailingReason1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason1) AddCode() *FailingReason1Choice {
 	f.Code = new(FailingReason1Choice)
 	return f.Code
@@ -10,6 +10,10 @@ type FailingReason10 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (f *FailingReason10) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason10) AddCode() *FailingReason11Choice {
 	f.Code = new(FailingReason11Choice)
 	return f.Code
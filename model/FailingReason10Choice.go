@@ -10,6 +10,10 @@ type FailingReason10Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *FailingReason10Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason10Choice) SetCode(value string) {
 	f.Code = (*FailingReason3Code)(&value)
 }
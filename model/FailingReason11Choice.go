@@ -10,6 +10,10 @@ type FailingReason11Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *FailingReason11Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason11Choice) SetCode(value string) {
 	f.Code = (*FailingReason2Code)(&value)
 }
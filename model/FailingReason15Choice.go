@@ -10,6 +10,10 @@ type FailingReason15Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *FailingReason15Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason15Choice) SetCode(value string) {
 	f.Code = (*FailingReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type FailingReason1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FailingReason1Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason1Choice) SetCode(value string) {
 	f.Code = (*FailingReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type FailingReason3 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (f *FailingReason3) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason3) AddCode() *FailingReason3Choice {
 	f.Code = new(FailingReason3Choice)
 	return f.Code
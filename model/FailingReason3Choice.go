@@ -10,6 +10,10 @@ type FailingReason3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FailingReason3Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason3Choice) SetCode(value string) {
 	f.Code = (*FailingReason2Code)(&value)
 }
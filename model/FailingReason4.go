@@ -10,6 +10,10 @@ type FailingReason4 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (f *FailingReason4) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason4) AddCode() *FailingReason4Choice {
 	f.Code = new(FailingReason4Choice)
 	return f.Code
@@ -10,6 +10,10 @@ type FailingReason4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FailingReason4Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason4Choice) SetCode(value string) {
 	f.Code = (*FailingReason3Code)(&value)
 }
@@ -10,6 +10,10 @@ type FailingReason7 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (f *FailingReason7) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason7) AddCode() *FailingReason7Choice {
 	f.Code = new(FailingReason7Choice)
 	return f.Code
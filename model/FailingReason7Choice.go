@@ -10,6 +10,10 @@ type FailingReason7Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (f *FailingReason7Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason7Choice) SetCode(value string) {
 	f.Code = (*FailingReason3Code)(&value)
 }
@@ -10,6 +10,10 @@ type FailingReason8 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (f *FailingReason8) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason8) AddCode() *FailingReason8Choice {
 	f.Code = new(FailingReason8Choice)
 	return f.Code
@@ -10,6 +10,10 @@ type FailingReason8Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (f *FailingReason8Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason8Choice) SetCode(value string) {
 	f.Code = (*FailingReason2Code)(&value)
 }
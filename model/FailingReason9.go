@@ -10,6 +10,10 @@ type FailingReason9 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (f *FailingReason9) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason9) AddCode() *FailingReason10Choice {
 	f.Code = new(FailingReason10Choice)
 	return f.Code
@@ -10,6 +10,10 @@ type FailingReason9Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (f *FailingReason9Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FailingReason9Choice) SetCode(value string) {
 	f.Code = (*FailingReason1Code)(&value)
 }
@@ -10,8 +10,16 @@ type FailingStatus10Choice struct {
 	Reason []*FailingReason8 `xml:"Rsn"`
 }
 
-func (f *FailingStatus10Choice) SetNoSpecifiedReason(value string) {
+func (f *FailingStatus10Choice) Validate() error {
+	return ValidateElement(f)
+}
+
+func (f *FailingStatus10Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	f.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (f *FailingStatus10Choice) AddReason() *FailingReason8 {
@@ -10,8 +10,16 @@ type FailingStatus11Choice struct {
 	Reason []*FailingReason9 `xml:"Rsn"`
 }
 
-func (f *FailingStatus11Choice) SetNoSpecifiedReason(value string) {
+func (f *FailingStatus11Choice) Validate() error {
+	return ValidateElement(f)
+}
+
+func (f *FailingStatus11Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	f.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (f *FailingStatus11Choice) AddReason() *FailingReason9 {
@@ -10,8 +10,16 @@ type FailingStatus12Choice struct {
 	Reason []*FailingReason10 `xml:"Rsn"`
 }
 
-func (f *FailingStatus12Choice) SetNoSpecifiedReason(value string) {
+func (f *FailingStatus12Choice) Validate() error {
+	return ValidateElement(f)
+}
+
+func (f *FailingStatus12Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	f.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (f *FailingStatus12Choice) AddReason() *FailingReason10 {
@@ -10,8 +10,16 @@ type FailingStatus1Choice struct {
 	Reason []*FailingReason1 `xml:"Rsn,omitempty"`
 }
 
-func (f *FailingStatus1Choice) SetNoSpecifiedReason(value string) {
+func (f *FailingStatus1Choice) Validate() error {
+	return ValidateElement(f)
+}
+
+func (f *FailingStatus1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	f.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (f *FailingStatus1Choice) AddReason() *FailingReason1 {
@@ -10,8 +10,16 @@ type FailingStatus3Choice struct {
 	Reason []*FailingReason3 `xml:"Rsn"`
 }
 
-func (f *FailingStatus3Choice) SetNoSpecifiedReason(value string) {
+func (f *FailingStatus3Choice) Validate() error {
+	return ValidateElement(f)
+}
+
+func (f *FailingStatus3Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	f.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (f *FailingStatus3Choice) AddReason() *FailingReason3 {
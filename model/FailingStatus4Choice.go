@@ -10,8 +10,16 @@ type FailingStatus4Choice struct {
 	Reason []*FailingReason4 `xml:"Rsn"`
 }
 
-func (f *FailingStatus4Choice) SetNoSpecifiedReason(value string) {
+func (f *FailingStatus4Choice) Validate() error {
+	return ValidateElement(f)
+}
+
+func (f *FailingStatus4Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	f.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (f *FailingStatus4Choice) AddReason() *FailingReason4 {
@@ -10,8 +10,16 @@ type FailingStatus9Choice struct {
 	Reason []*FailingReason7 `xml:"Rsn"`
 }
 
-func (f *FailingStatus9Choice) SetNoSpecifiedReason(value string) {
+func (f *FailingStatus9Choice) Validate() error {
+	return ValidateElement(f)
+}
+
+func (f *FailingStatus9Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	f.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (f *FailingStatus9Choice) AddReason() *FailingReason7 {
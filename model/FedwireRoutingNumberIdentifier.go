@@ -1,3 +1,9 @@
 package model
 
 type FedwireRoutingNumberIdentifier string
+
+var fedwireRoutingNumberIdentifierPattern = newPattern(`FW[0-9]{9,9}`)
+
+func (f FedwireRoutingNumberIdentifier) Validate() error {
+	return validatePattern("FedwireRoutingNumberIdentifier", string(f), fedwireRoutingNumberIdentifierPattern)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type Fee1 struct {
 
@@ -31,6 +35,10 @@ type Fee1 struct {
 	RecipientIdentification *PartyIdentification113 `xml:"RcptId,omitempty"`
 }
 
+func (f *Fee1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Fee1) AddType() *ChargeType5Choice {
 	f.Type = new(ChargeType5Choice)
 	return f.Type
@@ -45,10 +53,19 @@ func (f *Fee1) SetStandardAmount(value, currency string) {
 	f.StandardAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *Fee1) SetStandardAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.StandardAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *Fee1) SetStandardRate(value string) {
 	f.StandardRate = (*PercentageRate)(&value)
 }
 
+func (f *Fee1) SetStandardRateFromDecimal(value decimal.Decimal) {
+	f.StandardRate = new(PercentageRate)
+	f.StandardRate.FromDecimal(value)
+}
+
 func (f *Fee1) AddDiscountDetails() *ChargeOrCommissionDiscount1 {
 	f.DiscountDetails = new(ChargeOrCommissionDiscount1)
 	return f.DiscountDetails
@@ -58,10 +75,19 @@ func (f *Fee1) SetRequestedAmount(value, currency string) {
 	f.RequestedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *Fee1) SetRequestedAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.RequestedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *Fee1) SetRequestedRate(value string) {
 	f.RequestedRate = (*PercentageRate)(&value)
 }
 
+func (f *Fee1) SetRequestedRateFromDecimal(value decimal.Decimal) {
+	f.RequestedRate = new(PercentageRate)
+	f.RequestedRate.FromDecimal(value)
+}
+
 func (f *Fee1) SetNonStandardSLAReference(value string) {
 	f.NonStandardSLAReference = (*Max35Text)(&value)
 }
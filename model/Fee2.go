@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type Fee2 struct {
 
@@ -34,6 +38,10 @@ type Fee2 struct {
 	InformativeIndicator *YesNoIndicator `xml:"InftvInd"`
 }
 
+func (f *Fee2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Fee2) AddType() *ChargeType5Choice {
 	f.Type = new(ChargeType5Choice)
 	return f.Type
@@ -48,10 +56,19 @@ func (f *Fee2) SetStandardAmount(value, currency string) {
 	f.StandardAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *Fee2) SetStandardAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.StandardAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *Fee2) SetStandardRate(value string) {
 	f.StandardRate = (*PercentageRate)(&value)
 }
 
+func (f *Fee2) SetStandardRateFromDecimal(value decimal.Decimal) {
+	f.StandardRate = new(PercentageRate)
+	f.StandardRate.FromDecimal(value)
+}
+
 func (f *Fee2) AddDiscountDetails() *ChargeOrCommissionDiscount1 {
 	f.DiscountDetails = new(ChargeOrCommissionDiscount1)
 	return f.DiscountDetails
@@ -61,10 +78,19 @@ func (f *Fee2) SetAppliedAmount(value, currency string) {
 	f.AppliedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *Fee2) SetAppliedAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.AppliedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *Fee2) SetAppliedRate(value string) {
 	f.AppliedRate = (*PercentageRate)(&value)
 }
 
+func (f *Fee2) SetAppliedRateFromDecimal(value decimal.Decimal) {
+	f.AppliedRate = new(PercentageRate)
+	f.AppliedRate.FromDecimal(value)
+}
+
 func (f *Fee2) SetNonStandardSLAReference(value string) {
 	f.NonStandardSLAReference = (*Max35Text)(&value)
 }
@@ -77,3 +103,8 @@ func (f *Fee2) AddRecipientIdentification() *PartyIdentification113 {
 func (f *Fee2) SetInformativeIndicator(value string) {
 	f.InformativeIndicator = (*YesNoIndicator)(&value)
 }
+
+func (f *Fee2) SetInformativeIndicatorFromBool(value bool) {
+	f.InformativeIndicator = new(YesNoIndicator)
+	f.InformativeIndicator.FromBool(value)
+}
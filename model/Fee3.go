@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type Fee3 struct {
 
@@ -31,6 +35,10 @@ type Fee3 struct {
 	NewCommercialAgreementReferenceIndicator *YesNoIndicator `xml:"NewComrclAgrmtRefInd,omitempty"`
 }
 
+func (f *Fee3) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Fee3) AddType() *ChargeType5Choice {
 	f.Type = new(ChargeType5Choice)
 	return f.Type
@@ -40,26 +48,53 @@ func (f *Fee3) SetRepairedStandardAmount(value, currency string) {
 	f.RepairedStandardAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *Fee3) SetRepairedStandardAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.RepairedStandardAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *Fee3) SetRepairedStandardRate(value string) {
 	f.RepairedStandardRate = (*PercentageRate)(&value)
 }
 
+func (f *Fee3) SetRepairedStandardRateFromDecimal(value decimal.Decimal) {
+	f.RepairedStandardRate = new(PercentageRate)
+	f.RepairedStandardRate.FromDecimal(value)
+}
+
 func (f *Fee3) SetRepairedDiscountAmount(value, currency string) {
 	f.RepairedDiscountAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *Fee3) SetRepairedDiscountAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.RepairedDiscountAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *Fee3) SetRepairedDiscountRate(value string) {
 	f.RepairedDiscountRate = (*PercentageRate)(&value)
 }
 
+func (f *Fee3) SetRepairedDiscountRateFromDecimal(value decimal.Decimal) {
+	f.RepairedDiscountRate = new(PercentageRate)
+	f.RepairedDiscountRate.FromDecimal(value)
+}
+
 func (f *Fee3) SetRepairedRequestedAmount(value, currency string) {
 	f.RepairedRequestedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *Fee3) SetRepairedRequestedAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.RepairedRequestedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *Fee3) SetRepairedRequestedRate(value string) {
 	f.RepairedRequestedRate = (*PercentageRate)(&value)
 }
 
+func (f *Fee3) SetRepairedRequestedRateFromDecimal(value decimal.Decimal) {
+	f.RepairedRequestedRate = new(PercentageRate)
+	f.RepairedRequestedRate.FromDecimal(value)
+}
+
 func (f *Fee3) SetCommercialAgreementReference(value string) {
 	f.CommercialAgreementReference = (*Max35Text)(&value)
 }
@@ -67,3 +102,8 @@ func (f *Fee3) SetCommercialAgreementReference(value string) {
 func (f *Fee3) SetNewCommercialAgreementReferenceIndicator(value string) {
 	f.NewCommercialAgreementReferenceIndicator = (*YesNoIndicator)(&value)
 }
+
+func (f *Fee3) SetNewCommercialAgreementReferenceIndicatorFromBool(value bool) {
+	f.NewCommercialAgreementReferenceIndicator = new(YesNoIndicator)
+	f.NewCommercialAgreementReferenceIndicator.FromBool(value)
+}
@@ -13,6 +13,10 @@ type FeeAndTax1 struct {
 	IndividualTax []*Tax30 `xml:"IndvTax,omitempty"`
 }
 
+func (f *FeeAndTax1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FeeAndTax1) SetCommercialAgreementReference(value string) {
 	f.CommercialAgreementReference = (*Max35Text)(&value)
 }
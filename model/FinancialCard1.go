@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Card used to represent a financial account for the purpose of payment settlement.
 type FinancialCard1 struct {
 
@@ -13,6 +17,10 @@ type FinancialCard1 struct {
 	InterestRatePercent *PercentageRate `xml:"IntrstRatePct,omitempty"`
 }
 
+func (f *FinancialCard1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialCard1) AddCreditLimitAmount(value, currency string) {
 	f.CreditLimitAmount = append(f.CreditLimitAmount, NewCurrencyAndAmount(value, currency))
 }
@@ -24,3 +32,8 @@ func (f *FinancialCard1) AddCreditAvailableAmount(value, currency string) {
 func (f *FinancialCard1) SetInterestRatePercent(value string) {
 	f.InterestRatePercent = (*PercentageRate)(&value)
 }
+
+func (f *FinancialCard1) SetInterestRatePercentFromDecimal(value decimal.Decimal) {
+	f.InterestRatePercent = new(PercentageRate)
+	f.InterestRatePercent.FromDecimal(value)
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Sets of elements to identify a name of the organisation identification scheme.
 type FinancialIdentificationSchemeName1Choice struct {
 
@@ -10,6 +12,10 @@ type FinancialIdentificationSchemeName1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (f *FinancialIdentificationSchemeName1Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialIdentificationSchemeName1Choice) SetCode(value string) {
 	f.Code = (*ExternalFinancialInstitutionIdentification1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (f *FinancialIdentificationSchemeName1Choice) SetCode(value string) {
 func (f *FinancialIdentificationSchemeName1Choice) SetProprietary(value string) {
 	f.Proprietary = (*Max35Text)(&value)
 }
+
+func (f *FinancialIdentificationSchemeName1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if f.Code != nil {
+		enc.Element("Cd", string(*f.Code))
+	}
+	if f.Proprietary != nil {
+		enc.Element("Prtry", string(*f.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (f *FinancialIdentificationSchemeName1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			f.Code = (*ExternalFinancialInstitutionIdentification1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			f.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
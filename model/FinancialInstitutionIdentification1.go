@@ -19,6 +19,10 @@ type FinancialInstitutionIdentification1 struct {
 	ProprietaryIdentification *GenericIdentification3 `xml:"PrtryId,omitempty"`
 }
 
+func (f *FinancialInstitutionIdentification1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstitutionIdentification1) SetBIC(value string) {
 	f.BIC = (*BICIdentifier)(&value)
 }
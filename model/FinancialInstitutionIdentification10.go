@@ -10,6 +10,10 @@ type FinancialInstitutionIdentification10 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (f *FinancialInstitutionIdentification10) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstitutionIdentification10) AddParty() *FinancialInstitutionIdentification8Choice {
 	f.Party = new(FinancialInstitutionIdentification8Choice)
 	return f.Party
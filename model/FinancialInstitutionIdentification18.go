@@ -0,0 +1,133 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Specifies the details to identify a financial institution.
+type FinancialInstitutionIdentification18 struct {
+
+	// Code allocated to a financial institution by the ISO 9362 Registration Authority as described in ISO 9362 "Banking - Banking telecommunication messages - Business identifier code (BIC)".
+	BICFI *BICFIDec2014Identifier `xml:"BICFI,omitempty"`
+
+	// Information used to identify a member within a clearing system.
+	ClearingSystemMemberIdentification *ClearingSystemMemberIdentification2 `xml:"ClrSysMmbId,omitempty"`
+
+	// Legal entity identification as an alternate identification for a party.
+	LEI *LEIIdentifier `xml:"LEI,omitempty"`
+
+	// Name by which an agent is known and which is usually used to identify that agent.
+	Name *Max140Text `xml:"Nm,omitempty"`
+
+	// Information that locates and identifies a specific address, as defined by postal services.
+	PostalAddress *PostalAddress24 `xml:"PstlAdr,omitempty"`
+
+	// Unique identification of an agent, as assigned by an institution, using an identification scheme.
+	Other *GenericFinancialIdentification1 `xml:"Othr,omitempty"`
+}
+
+func (f *FinancialInstitutionIdentification18) Validate() error {
+	return ValidateElement(f)
+}
+
+func (f *FinancialInstitutionIdentification18) SetBICFI(value string) {
+	f.BICFI = (*BICFIDec2014Identifier)(&value)
+}
+
+func (f *FinancialInstitutionIdentification18) AddClearingSystemMemberIdentification() *ClearingSystemMemberIdentification2 {
+	f.ClearingSystemMemberIdentification = new(ClearingSystemMemberIdentification2)
+	return f.ClearingSystemMemberIdentification
+}
+
+func (f *FinancialInstitutionIdentification18) SetLEI(value string) {
+	f.LEI = (*LEIIdentifier)(&value)
+}
+
+func (f *FinancialInstitutionIdentification18) SetName(value string) {
+	f.Name = (*Max140Text)(&value)
+}
+
+func (f *FinancialInstitutionIdentification18) AddPostalAddress() *PostalAddress24 {
+	f.PostalAddress = new(PostalAddress24)
+	return f.PostalAddress
+}
+
+func (f *FinancialInstitutionIdentification18) AddOther() *GenericFinancialIdentification1 {
+	f.Other = new(GenericFinancialIdentification1)
+	return f.Other
+}
+
+func (f *FinancialInstitutionIdentification18) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if f.BICFI != nil {
+		enc.Element("BICFI", string(*f.BICFI))
+	}
+	if f.ClearingSystemMemberIdentification != nil {
+		f.ClearingSystemMemberIdentification.EncodeXMLFast(enc, "ClrSysMmbId")
+	}
+	if f.LEI != nil {
+		enc.Element("LEI", string(*f.LEI))
+	}
+	if f.Name != nil {
+		enc.Element("Nm", string(*f.Name))
+	}
+	if f.PostalAddress != nil {
+		f.PostalAddress.EncodeXMLFast(enc, "PstlAdr")
+	}
+	if f.Other != nil {
+		f.Other.EncodeXMLFast(enc, "Othr")
+	}
+	enc.End(tag)
+}
+
+func (f *FinancialInstitutionIdentification18) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "BICFI":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			f.BICFI = (*BICFIDec2014Identifier)(&value)
+		case "ClrSysMmbId":
+			elem := new(ClearingSystemMemberIdentification2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.ClearingSystemMemberIdentification = elem
+		case "LEI":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			f.LEI = (*LEIIdentifier)(&value)
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			f.Name = (*Max140Text)(&value)
+		case "PstlAdr":
+			elem := new(PostalAddress24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.PostalAddress = elem
+		case "Othr":
+			elem := new(GenericFinancialIdentification1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.Other = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package model
+
+// Specifies the details to identify a financial institution.
+type FinancialInstitutionIdentification23 struct {
+
+	// Code allocated to a financial institution by the ISO 9362 Registration Authority as described in ISO 9362 "Banking - Banking telecommunication messages - Business identifier code (BIC)".
+	BICFI *BICFIDec2014Identifier `xml:"BICFI,omitempty"`
+
+	// Information used to identify a member within a clearing system.
+	ClearingSystemMemberIdentification *ClearingSystemMemberIdentification2 `xml:"ClrSysMmbId,omitempty"`
+
+	// Legal entity identification as an alternate identification for a party.
+	LEI *LEIIdentifier `xml:"LEI,omitempty"`
+
+	// Name by which an agent is known and which is usually used to identify that agent.
+	Name *Max140Text `xml:"Nm,omitempty"`
+
+	// Information that locates and identifies a specific address, as defined by postal services.
+	PostalAddress *PostalAddress27 `xml:"PstlAdr,omitempty"`
+
+	// Unique identification of an agent, as assigned by an institution, using an identification scheme.
+	Other *GenericFinancialIdentification1 `xml:"Othr,omitempty"`
+}
+
+func (f *FinancialInstitutionIdentification23) Validate() error {
+	return ValidateElement(f)
+}
+
+func (f *FinancialInstitutionIdentification23) SetBICFI(value string) {
+	f.BICFI = (*BICFIDec2014Identifier)(&value)
+}
+
+func (f *FinancialInstitutionIdentification23) AddClearingSystemMemberIdentification() *ClearingSystemMemberIdentification2 {
+	f.ClearingSystemMemberIdentification = new(ClearingSystemMemberIdentification2)
+	return f.ClearingSystemMemberIdentification
+}
+
+func (f *FinancialInstitutionIdentification23) SetLEI(value string) {
+	f.LEI = (*LEIIdentifier)(&value)
+}
+
+func (f *FinancialInstitutionIdentification23) SetName(value string) {
+	f.Name = (*Max140Text)(&value)
+}
+
+func (f *FinancialInstitutionIdentification23) AddPostalAddress() *PostalAddress27 {
+	f.PostalAddress = new(PostalAddress27)
+	return f.PostalAddress
+}
+
+func (f *FinancialInstitutionIdentification23) AddOther() *GenericFinancialIdentification1 {
+	f.Other = new(GenericFinancialIdentification1)
+	return f.Other
+}
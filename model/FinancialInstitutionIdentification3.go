@@ -19,6 +19,10 @@ type FinancialInstitutionIdentification3 struct {
 	ProprietaryIdentification *GenericIdentification3 `xml:"PrtryId,omitempty"`
 }
 
+func (f *FinancialInstitutionIdentification3) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstitutionIdentification3) SetBIC(value string) {
 	f.BIC = (*BICIdentifier)(&value)
 }
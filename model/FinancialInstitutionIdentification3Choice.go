@@ -16,6 +16,10 @@ type FinancialInstitutionIdentification3Choice struct {
 	ProprietaryIdentification *SimpleIdentificationInformation `xml:"PrtryId"`
 }
 
+func (f *FinancialInstitutionIdentification3Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstitutionIdentification3Choice) AddNameAndAddress() *NameAndAddress5 {
 	f.NameAndAddress = new(NameAndAddress5)
 	return f.NameAndAddress
@@ -10,6 +10,10 @@ type FinancialInstitutionIdentification4Choice struct {
 	NameAndAddress *NameAndAddress6 `xml:"NmAndAdr"`
 }
 
+func (f *FinancialInstitutionIdentification4Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstitutionIdentification4Choice) SetBIC(value string) {
 	f.BIC = (*BICIdentifier)(&value)
 }
@@ -19,6 +19,10 @@ type FinancialInstitutionIdentification5Choice struct {
 	CombinedIdentification *FinancialInstitutionIdentification3 `xml:"CmbndId"`
 }
 
+func (f *FinancialInstitutionIdentification5Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstitutionIdentification5Choice) SetBIC(value string) {
 	f.BIC = (*BICIdentifier)(&value)
 }
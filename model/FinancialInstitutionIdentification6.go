@@ -13,6 +13,10 @@ type FinancialInstitutionIdentification6 struct {
 	BIC *BICIdentifier `xml:"BIC,omitempty"`
 }
 
+func (f *FinancialInstitutionIdentification6) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstitutionIdentification6) AddClearingSystemMemberIdentification() *ClearingSystemMemberIdentification2Choice {
 	f.ClearingSystemMemberIdentification = new(ClearingSystemMemberIdentification2Choice)
 	return f.ClearingSystemMemberIdentification
@@ -19,6 +19,10 @@ type FinancialInstitutionIdentification7 struct {
 	Other *GenericFinancialIdentification1 `xml:"Othr,omitempty"`
 }
 
+func (f *FinancialInstitutionIdentification7) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstitutionIdentification7) SetBIC(value string) {
 	f.BIC = (*BICIdentifier)(&value)
 }
@@ -16,6 +16,10 @@ type FinancialInstitutionIdentification7Choice struct {
 	ProprietaryIdentification *SimpleIdentificationInformation4 `xml:"PrtryId"`
 }
 
+func (f *FinancialInstitutionIdentification7Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstitutionIdentification7Choice) AddNameAndAddress() *NameAndAddress5 {
 	f.NameAndAddress = new(NameAndAddress5)
 	return f.NameAndAddress
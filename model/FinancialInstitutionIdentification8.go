@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to identify a financial institution.
 type FinancialInstitutionIdentification8 struct {
 
@@ -19,6 +21,10 @@ type FinancialInstitutionIdentification8 struct {
 	Other *GenericFinancialIdentification1 `xml:"Othr,omitempty"`
 }
 
+func (f *FinancialInstitutionIdentification8) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstitutionIdentification8) SetBICFI(value string) {
 	f.BICFI = (*BICFIIdentifier)(&value)
 }
@@ -41,3 +47,71 @@ func (f *FinancialInstitutionIdentification8) AddOther() *GenericFinancialIdenti
 	f.Other = new(GenericFinancialIdentification1)
 	return f.Other
 }
+
+func (f *FinancialInstitutionIdentification8) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if f.BICFI != nil {
+		enc.Element("BICFI", string(*f.BICFI))
+	}
+	if f.ClearingSystemMemberIdentification != nil {
+		f.ClearingSystemMemberIdentification.EncodeXMLFast(enc, "ClrSysMmbId")
+	}
+	if f.Name != nil {
+		enc.Element("Nm", string(*f.Name))
+	}
+	if f.PostalAddress != nil {
+		f.PostalAddress.EncodeXMLFast(enc, "PstlAdr")
+	}
+	if f.Other != nil {
+		f.Other.EncodeXMLFast(enc, "Othr")
+	}
+	enc.End(tag)
+}
+
+func (f *FinancialInstitutionIdentification8) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "BICFI":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			f.BICFI = (*BICFIIdentifier)(&value)
+		case "ClrSysMmbId":
+			elem := new(ClearingSystemMemberIdentification2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.ClearingSystemMemberIdentification = elem
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			f.Name = (*Max140Text)(&value)
+		case "PstlAdr":
+			elem := new(PostalAddress6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.PostalAddress = elem
+		case "Othr":
+			elem := new(GenericFinancialIdentification1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.Other = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
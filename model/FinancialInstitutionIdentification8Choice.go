@@ -16,6 +16,10 @@ type FinancialInstitutionIdentification8Choice struct {
 	ProprietaryIdentification *Max35Text `xml:"PrtryId"`
 }
 
+func (f *FinancialInstitutionIdentification8Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstitutionIdentification8Choice) AddNameAndAddress() *NameAndAddress5 {
 	f.NameAndAddress = new(NameAndAddress5)
 	return f.NameAndAddress
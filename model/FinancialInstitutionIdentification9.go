@@ -13,6 +13,10 @@ type FinancialInstitutionIdentification9 struct {
 	Other *GenericFinancialIdentification1 `xml:"Othr,omitempty"`
 }
 
+func (f *FinancialInstitutionIdentification9) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstitutionIdentification9) SetBICFI(value string) {
 	f.BICFI = (*BICFIIdentifier)(&value)
 }
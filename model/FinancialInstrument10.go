@@ -25,6 +25,10 @@ type FinancialInstrument10 struct {
 	ProductGroup *Max140Text `xml:"PdctGrp,omitempty"`
 }
 
+func (f *FinancialInstrument10) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument10) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
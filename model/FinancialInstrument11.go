@@ -13,6 +13,10 @@ type FinancialInstrument11 struct {
 	TransferType *TransferType1Code `xml:"TrfTp"`
 }
 
+func (f *FinancialInstrument11) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument11) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
@@ -10,6 +10,10 @@ type FinancialInstrument12 struct {
 	Name *Max350Text `xml:"Nm,omitempty"`
 }
 
+func (f *FinancialInstrument12) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument12) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
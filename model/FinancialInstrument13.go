@@ -22,6 +22,10 @@ type FinancialInstrument13 struct {
 	DistributionPolicy *DistributionPolicy1Code `xml:"DstrbtnPlcy,omitempty"`
 }
 
+func (f *FinancialInstrument13) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument13) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
@@ -13,6 +13,10 @@ type FinancialInstrument15 struct {
 	UnderlyingInstrumentIdentification *SecurityIdentification6Choice `xml:"UndrlygInstrmId,omitempty"`
 }
 
+func (f *FinancialInstrument15) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument15) AddIdentification() *SecurityIdentification6Choice {
 	f.Identification = new(SecurityIdentification6Choice)
 	return f.Identification
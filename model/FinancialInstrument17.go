@@ -25,6 +25,10 @@ type FinancialInstrument17 struct {
 	ProductGroup *Max140Text `xml:"PdctGrp,omitempty"`
 }
 
+func (f *FinancialInstrument17) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument17) AddIdentification() *SecurityIdentification7 {
 	f.Identification = new(SecurityIdentification7)
 	return f.Identification
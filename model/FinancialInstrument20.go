@@ -40,22 +40,46 @@ type FinancialInstrument20 struct {
 	EUSavingsDirective *EUSavingsDirective1Code `xml:"EUSvgsDrctv"`
 }
 
+func (f *FinancialInstrument20) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument20) SetPhysicalBearerSecurities(value string) {
 	f.PhysicalBearerSecurities = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrument20) SetPhysicalBearerSecuritiesFromBool(value bool) {
+	f.PhysicalBearerSecurities = new(YesNoIndicator)
+	f.PhysicalBearerSecurities.FromBool(value)
+}
+
 func (f *FinancialInstrument20) SetDematerialisedBearerSecurities(value string) {
 	f.DematerialisedBearerSecurities = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrument20) SetDematerialisedBearerSecuritiesFromBool(value bool) {
+	f.DematerialisedBearerSecurities = new(YesNoIndicator)
+	f.DematerialisedBearerSecurities.FromBool(value)
+}
+
 func (f *FinancialInstrument20) SetPhysicalRegisteredSecurities(value string) {
 	f.PhysicalRegisteredSecurities = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrument20) SetPhysicalRegisteredSecuritiesFromBool(value bool) {
+	f.PhysicalRegisteredSecurities = new(YesNoIndicator)
+	f.PhysicalRegisteredSecurities.FromBool(value)
+}
+
 func (f *FinancialInstrument20) SetDematerialisedRegisteredSecurities(value string) {
 	f.DematerialisedRegisteredSecurities = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrument20) SetDematerialisedRegisteredSecuritiesFromBool(value bool) {
+	f.DematerialisedRegisteredSecurities = new(YesNoIndicator)
+	f.DematerialisedRegisteredSecurities.FromBool(value)
+}
+
 func (f *FinancialInstrument20) SetDistributionPolicy(value string) {
 	f.DistributionPolicy = (*DistributionPolicy1Code)(&value)
 }
@@ -76,14 +100,29 @@ func (f *FinancialInstrument20) SetFrontEndLoad(value string) {
 	f.FrontEndLoad = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrument20) SetFrontEndLoadFromBool(value bool) {
+	f.FrontEndLoad = new(YesNoIndicator)
+	f.FrontEndLoad.FromBool(value)
+}
+
 func (f *FinancialInstrument20) SetBackEndLoad(value string) {
 	f.BackEndLoad = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrument20) SetBackEndLoadFromBool(value bool) {
+	f.BackEndLoad = new(YesNoIndicator)
+	f.BackEndLoad.FromBool(value)
+}
+
 func (f *FinancialInstrument20) SetSwitchFee(value string) {
 	f.SwitchFee = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrument20) SetSwitchFeeFromBool(value bool) {
+	f.SwitchFee = new(YesNoIndicator)
+	f.SwitchFee.FromBool(value)
+}
+
 func (f *FinancialInstrument20) SetEUSavingsDirective(value string) {
 	f.EUSavingsDirective = (*EUSavingsDirective1Code)(&value)
 }
@@ -37,6 +37,10 @@ type FinancialInstrument21 struct {
 	RegisteredDistributionCountry []*CountryCode `xml:"RegdDstrbtnCtry,omitempty"`
 }
 
+func (f *FinancialInstrument21) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument21) SetClassType(value string) {
 	f.ClassType = (*Max35Text)(&value)
 }
@@ -73,6 +77,11 @@ func (f *FinancialInstrument21) SetDualFundIndicator(value string) {
 	f.DualFundIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrument21) SetDualFundIndicatorFromBool(value bool) {
+	f.DualFundIndicator = new(YesNoIndicator)
+	f.DualFundIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrument21) SetCountryOfDomicile(value string) {
 	f.CountryOfDomicile = (*CountryCode)(&value)
 }
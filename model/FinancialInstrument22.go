@@ -37,6 +37,10 @@ type FinancialInstrument22 struct {
 	RegisteredDistributionCountry []*CountryCode `xml:"RegdDstrbtnCtry,omitempty"`
 }
 
+func (f *FinancialInstrument22) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument22) SetClassType(value string) {
 	f.ClassType = (*Max35Text)(&value)
 }
@@ -73,6 +77,11 @@ func (f *FinancialInstrument22) SetDualFundIndicator(value string) {
 	f.DualFundIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrument22) SetDualFundIndicatorFromBool(value bool) {
+	f.DualFundIndicator = new(YesNoIndicator)
+	f.DualFundIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrument22) SetCountryOfDomicile(value string) {
 	f.CountryOfDomicile = (*CountryCode)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Security that is a sub-set of an investment fund, and is governed by the same investment fund policy, eg, dividend option or valuation currency.
 type FinancialInstrument24 struct {
 
@@ -25,6 +29,10 @@ type FinancialInstrument24 struct {
 	TransfereeAccount *Account6 `xml:"TrfeeAcct,omitempty"`
 }
 
+func (f *FinancialInstrument24) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument24) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
@@ -47,10 +55,18 @@ func (f *FinancialInstrument24) SetAverageAcquisitionPrice(value, currency strin
 	f.AverageAcquisitionPrice = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument24) SetAverageAcquisitionPriceFromDecimal(value decimal.Decimal, currency string) {
+	f.AverageAcquisitionPrice = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument24) SetTotalBookValue(value, currency string) {
 	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument24) SetTotalBookValueFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument24) AddTransfereeAccount() *Account6 {
 	f.TransfereeAccount = new(Account6)
 	return f.TransfereeAccount
@@ -16,6 +16,10 @@ type FinancialInstrument25 struct {
 	TransfereeAccount *Account6 `xml:"TrfeeAcct,omitempty"`
 }
 
+func (f *FinancialInstrument25) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument25) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
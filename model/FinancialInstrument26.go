@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Security that is a sub-set of an investment fund, and is governed by the same investment fund policy, eg, dividend option or valuation currency.
 type FinancialInstrument26 struct {
 
@@ -22,6 +26,10 @@ type FinancialInstrument26 struct {
 	TransfereeAccount *Account6 `xml:"TrfeeAcct,omitempty"`
 }
 
+func (f *FinancialInstrument26) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument26) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
@@ -40,10 +48,18 @@ func (f *FinancialInstrument26) SetAverageAcquisitionPrice(value, currency strin
 	f.AverageAcquisitionPrice = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument26) SetAverageAcquisitionPriceFromDecimal(value decimal.Decimal, currency string) {
+	f.AverageAcquisitionPrice = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument26) SetTotalBookValue(value, currency string) {
 	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument26) SetTotalBookValueFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument26) AddTransfereeAccount() *Account6 {
 	f.TransfereeAccount = new(Account6)
 	return f.TransfereeAccount
@@ -13,6 +13,10 @@ type FinancialInstrument27 struct {
 	TransfereeAccount *Account6 `xml:"TrfeeAcct,omitempty"`
 }
 
+func (f *FinancialInstrument27) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument27) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
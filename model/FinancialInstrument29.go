@@ -28,6 +28,10 @@ type FinancialInstrument29 struct {
 	BlockedHoldingDetails *BlockedHoldingDetails1 `xml:"BlckdHldgDtls,omitempty"`
 }
 
+func (f *FinancialInstrument29) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument29) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
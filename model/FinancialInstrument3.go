@@ -25,6 +25,10 @@ type FinancialInstrument3 struct {
 	DistributionPolicy *DistributionPolicy1Code `xml:"DstrbtnPlcy,omitempty"`
 }
 
+func (f *FinancialInstrument3) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument3) AddIdentification() *SecurityIdentification1Choice {
 	f.Identification = new(SecurityIdentification1Choice)
 	return f.Identification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Security that is a sub-set of an investment fund, and is governed by the same investment fund policy, eg, dividend option or valuation currency.
 type FinancialInstrument30 struct {
 
@@ -25,6 +29,10 @@ type FinancialInstrument30 struct {
 	DeliveringAgentDetails *PartyIdentificationAndAccount93 `xml:"DlvrgAgtDtls,omitempty"`
 }
 
+func (f *FinancialInstrument30) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument30) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
@@ -43,10 +51,18 @@ func (f *FinancialInstrument30) SetAverageAcquisitionPrice(value, currency strin
 	f.AverageAcquisitionPrice = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument30) SetAverageAcquisitionPriceFromDecimal(value decimal.Decimal, currency string) {
+	f.AverageAcquisitionPrice = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument30) SetTotalBookValue(value, currency string) {
 	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument30) SetTotalBookValueFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument30) AddTransfereeAccount() *Account6 {
 	f.TransfereeAccount = new(Account6)
 	return f.TransfereeAccount
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Security that is a sub-set of an investment fund, and is governed by the same investment fund policy, eg, dividend option or valuation currency.
 type FinancialInstrument31 struct {
 
@@ -31,6 +35,10 @@ type FinancialInstrument31 struct {
 	DeliveringAgentDetails *PartyIdentificationAndAccount93 `xml:"DlvrgAgtDtls,omitempty"`
 }
 
+func (f *FinancialInstrument31) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument31) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
@@ -53,10 +61,18 @@ func (f *FinancialInstrument31) SetAverageAcquisitionPrice(value, currency strin
 	f.AverageAcquisitionPrice = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument31) SetAverageAcquisitionPriceFromDecimal(value decimal.Decimal, currency string) {
+	f.AverageAcquisitionPrice = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument31) SetTotalBookValue(value, currency string) {
 	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument31) SetTotalBookValueFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument31) AddTransfereeAccount() *Account6 {
 	f.TransfereeAccount = new(Account6)
 	return f.TransfereeAccount
@@ -22,6 +22,10 @@ type FinancialInstrument32 struct {
 	DeliveringAgentDetails *PartyIdentificationAndAccount93 `xml:"DlvrgAgtDtls,omitempty"`
 }
 
+func (f *FinancialInstrument32) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument32) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
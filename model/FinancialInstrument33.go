@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Security that is a sub-set of an investment fund, and is governed by the same investment fund policy, eg, dividend option or valuation currency.
 type FinancialInstrument33 struct {
 
@@ -34,6 +40,10 @@ type FinancialInstrument33 struct {
 	RequestedSettlementDate *ISODate `xml:"ReqdSttlmDt,omitempty"`
 }
 
+func (f *FinancialInstrument33) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument33) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
@@ -56,10 +66,18 @@ func (f *FinancialInstrument33) SetAverageAcquisitionPrice(value, currency strin
 	f.AverageAcquisitionPrice = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument33) SetAverageAcquisitionPriceFromDecimal(value decimal.Decimal, currency string) {
+	f.AverageAcquisitionPrice = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument33) SetTotalBookValue(value, currency string) {
 	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument33) SetTotalBookValueFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument33) AddTransfereeAccount() *Account6 {
 	f.TransfereeAccount = new(Account6)
 	return f.TransfereeAccount
@@ -78,3 +96,8 @@ func (f *FinancialInstrument33) AddDeliveringAgentDetails() *PartyIdentification
 func (f *FinancialInstrument33) SetRequestedSettlementDate(value string) {
 	f.RequestedSettlementDate = (*ISODate)(&value)
 }
+
+func (f *FinancialInstrument33) SetRequestedSettlementDateFromTime(value time.Time) {
+	f.RequestedSettlementDate = new(ISODate)
+	f.RequestedSettlementDate.FromTime(value)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Security that is a sub-set of an investment fund, and is governed by the same investment fund policy, eg, dividend option or valuation currency.
 type FinancialInstrument35 struct {
 
@@ -37,6 +43,10 @@ type FinancialInstrument35 struct {
 	RequestedSettlementDate *ISODate `xml:"ReqdSttlmDt,omitempty"`
 }
 
+func (f *FinancialInstrument35) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument35) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
@@ -59,10 +69,18 @@ func (f *FinancialInstrument35) SetAverageAcquisitionPrice(value, currency strin
 	f.AverageAcquisitionPrice = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument35) SetAverageAcquisitionPriceFromDecimal(value decimal.Decimal, currency string) {
+	f.AverageAcquisitionPrice = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument35) SetTotalBookValue(value, currency string) {
 	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument35) SetTotalBookValueFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument35) AddTransfereeAccount() *Account16 {
 	f.TransfereeAccount = new(Account16)
 	return f.TransfereeAccount
@@ -86,3 +104,8 @@ func (f *FinancialInstrument35) AddDeliveringAgentDetails() *PartyIdentification
 func (f *FinancialInstrument35) SetRequestedSettlementDate(value string) {
 	f.RequestedSettlementDate = (*ISODate)(&value)
 }
+
+func (f *FinancialInstrument35) SetRequestedSettlementDateFromTime(value time.Time) {
+	f.RequestedSettlementDate = new(ISODate)
+	f.RequestedSettlementDate.FromTime(value)
+}
@@ -16,6 +16,10 @@ type FinancialInstrument36 struct {
 	SubAccountDetails *SubAccount1 `xml:"SubAcctDtls,omitempty"`
 }
 
+func (f *FinancialInstrument36) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument36) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
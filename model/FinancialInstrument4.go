@@ -22,6 +22,10 @@ type FinancialInstrument4 struct {
 	DistributionPolicy *DistributionPolicy1Code `xml:"DstrbtnPlcy,omitempty"`
 }
 
+func (f *FinancialInstrument4) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument4) AddIdentification() *SecurityIdentification1Choice {
 	f.Identification = new(SecurityIdentification1Choice)
 	return f.Identification
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Security that is a sub-set of an investment fund, and is governed by the same investment fund policy, eg, dividend option or valuation currency.
 type FinancialInstrument40 struct {
 
@@ -40,6 +46,10 @@ type FinancialInstrument40 struct {
 	RequestedSettlementDate *ISODate `xml:"ReqdSttlmDt,omitempty"`
 }
 
+func (f *FinancialInstrument40) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument40) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
@@ -62,6 +72,10 @@ func (f *FinancialInstrument40) SetAverageAcquisitionPrice(value, currency strin
 	f.AverageAcquisitionPrice = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument40) SetAverageAcquisitionPriceFromDecimal(value decimal.Decimal, currency string) {
+	f.AverageAcquisitionPrice = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument40) SetTransferCurrency(value string) {
 	f.TransferCurrency = (*CurrencyCode)(&value)
 }
@@ -70,6 +84,10 @@ func (f *FinancialInstrument40) SetTotalBookValue(value, currency string) {
 	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument40) SetTotalBookValueFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument40) AddTransfereeAccount() *Account16 {
 	f.TransfereeAccount = new(Account16)
 	return f.TransfereeAccount
@@ -93,3 +111,8 @@ func (f *FinancialInstrument40) AddDeliveringAgentDetails() *PartyIdentification
 func (f *FinancialInstrument40) SetRequestedSettlementDate(value string) {
 	f.RequestedSettlementDate = (*ISODate)(&value)
 }
+
+func (f *FinancialInstrument40) SetRequestedSettlementDateFromTime(value time.Time) {
+	f.RequestedSettlementDate = new(ISODate)
+	f.RequestedSettlementDate.FromTime(value)
+}
@@ -28,6 +28,10 @@ type FinancialInstrument45 struct {
 	ProductGroup *Max140Text `xml:"PdctGrp,omitempty"`
 }
 
+func (f *FinancialInstrument45) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument45) AddIdentification() *SecurityIdentification23Choice {
 	f.Identification = new(SecurityIdentification23Choice)
 	return f.Identification
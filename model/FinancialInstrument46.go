@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Security that is a sub-set of an investment fund, and is governed by the same investment fund policy, for example, dividend option or valuation currency.
 type FinancialInstrument46 struct {
 
@@ -40,6 +44,10 @@ type FinancialInstrument46 struct {
 	DeliveringAgentDetails *PartyIdentificationAndAccount125 `xml:"DlvrgAgtDtls,omitempty"`
 }
 
+func (f *FinancialInstrument46) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument46) AddIdentification() *SecurityIdentification23Choice {
 	f.Identification = new(SecurityIdentification23Choice)
 	return f.Identification
@@ -66,6 +74,10 @@ func (f *FinancialInstrument46) SetAverageAcquisitionPrice(value, currency strin
 	f.AverageAcquisitionPrice = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument46) SetAverageAcquisitionPriceFromDecimal(value decimal.Decimal, currency string) {
+	f.AverageAcquisitionPrice = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument46) SetTransferCurrency(value string) {
 	f.TransferCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -74,6 +86,10 @@ func (f *FinancialInstrument46) SetTotalBookValue(value, currency string) {
 	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument46) SetTotalBookValueFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument46) AddTransfereeAccount() *Account19 {
 	f.TransfereeAccount = new(Account19)
 	return f.TransfereeAccount
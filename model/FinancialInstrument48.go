@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Security that is a sub-set of an investment fund, and is governed by the same investment fund policy, for example, dividend option or valuation currency.
 type FinancialInstrument48 struct {
 
@@ -43,6 +49,10 @@ type FinancialInstrument48 struct {
 	RequestedSettlementDate *ISODate `xml:"ReqdSttlmDt,omitempty"`
 }
 
+func (f *FinancialInstrument48) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument48) AddIdentification() *SecurityIdentification23Choice {
 	f.Identification = new(SecurityIdentification23Choice)
 	return f.Identification
@@ -69,6 +79,10 @@ func (f *FinancialInstrument48) SetAverageAcquisitionPrice(value, currency strin
 	f.AverageAcquisitionPrice = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument48) SetAverageAcquisitionPriceFromDecimal(value decimal.Decimal, currency string) {
+	f.AverageAcquisitionPrice = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument48) SetTransferCurrency(value string) {
 	f.TransferCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -77,6 +91,10 @@ func (f *FinancialInstrument48) SetTotalBookValue(value, currency string) {
 	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrument48) SetTotalBookValueFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalBookValue = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrument48) AddTransfereeAccount() *Account19 {
 	f.TransfereeAccount = new(Account19)
 	return f.TransfereeAccount
@@ -100,3 +118,8 @@ func (f *FinancialInstrument48) AddDeliveringAgentDetails() *PartyIdentification
 func (f *FinancialInstrument48) SetRequestedSettlementDate(value string) {
 	f.RequestedSettlementDate = (*ISODate)(&value)
 }
+
+func (f *FinancialInstrument48) SetRequestedSettlementDateFromTime(value time.Time) {
+	f.RequestedSettlementDate = new(ISODate)
+	f.RequestedSettlementDate.FromTime(value)
+}
@@ -25,6 +25,10 @@ type FinancialInstrument49 struct {
 	DistributionPolicy *DistributionPolicy1Code `xml:"DstrbtnPlcy,omitempty"`
 }
 
+func (f *FinancialInstrument49) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument49) AddIdentification() *SecurityIdentification23Choice {
 	f.Identification = new(SecurityIdentification23Choice)
 	return f.Identification
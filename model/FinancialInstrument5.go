@@ -28,6 +28,10 @@ type FinancialInstrument5 struct {
 	DualFundIndicator *YesNoIndicator `xml:"DualFndInd"`
 }
 
+func (f *FinancialInstrument5) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument5) AddIdentification() *SecurityIdentification1Choice {
 	f.Identification = new(SecurityIdentification1Choice)
 	return f.Identification
@@ -60,3 +64,8 @@ func (f *FinancialInstrument5) SetDistributionPolicy(value string) {
 func (f *FinancialInstrument5) SetDualFundIndicator(value string) {
 	f.DualFundIndicator = (*YesNoIndicator)(&value)
 }
+
+func (f *FinancialInstrument5) SetDualFundIndicatorFromBool(value bool) {
+	f.DualFundIndicator = new(YesNoIndicator)
+	f.DualFundIndicator.FromBool(value)
+}
@@ -19,6 +19,10 @@ type FinancialInstrument50 struct {
 	SubAccountDetails *SubAccount5 `xml:"SubAcctDtls,omitempty"`
 }
 
+func (f *FinancialInstrument50) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument50) AddIdentification() *SecurityIdentification23Choice {
 	f.Identification = new(SecurityIdentification23Choice)
 	return f.Identification
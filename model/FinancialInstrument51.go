@@ -49,6 +49,10 @@ type FinancialInstrument51 struct {
 	OperationalStatus *OperationalStatus1Code `xml:"OprlSts,omitempty"`
 }
 
+func (f *FinancialInstrument51) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument51) AddIdentification() *SecurityIdentification23Choice {
 	f.Identification = new(SecurityIdentification23Choice)
 	return f.Identification
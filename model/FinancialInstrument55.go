@@ -28,6 +28,10 @@ type FinancialInstrument55 struct {
 	ProductGroup *Max140Text `xml:"PdctGrp,omitempty"`
 }
 
+func (f *FinancialInstrument55) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument55) AddIdentification() *SecurityIdentification25Choice {
 	f.Identification = new(SecurityIdentification25Choice)
 	return f.Identification
@@ -49,6 +49,10 @@ type FinancialInstrument56 struct {
 	OperationalStatus *OperationalStatus1Code `xml:"OprlSts,omitempty"`
 }
 
+func (f *FinancialInstrument56) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument56) AddIdentification() *SecurityIdentification25Choice {
 	f.Identification = new(SecurityIdentification25Choice)
 	return f.Identification
@@ -31,6 +31,10 @@ type FinancialInstrument57 struct {
 	SeriesIdentification *Series1 `xml:"SrsId,omitempty"`
 }
 
+func (f *FinancialInstrument57) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument57) AddIdentification() *SecurityIdentification25Choice {
 	f.Identification = new(SecurityIdentification25Choice)
 	return f.Identification
@@ -25,6 +25,10 @@ type FinancialInstrument6 struct {
 	ProductGroup *Max140Text `xml:"PdctGrp,omitempty"`
 }
 
+func (f *FinancialInstrument6) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument6) AddIdentification() *SecurityIdentification1Choice {
 	f.Identification = new(SecurityIdentification1Choice)
 	return f.Identification
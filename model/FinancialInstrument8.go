@@ -28,6 +28,10 @@ type FinancialInstrument8 struct {
 	DualFundIndicator *YesNoIndicator `xml:"DualFndInd"`
 }
 
+func (f *FinancialInstrument8) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument8) AddIdentification() *SecurityIdentification3Choice {
 	newValue := new(SecurityIdentification3Choice)
 	f.Identification = append(f.Identification, newValue)
@@ -201,3 +205,8 @@ func (f *FinancialInstrument8) SetDistributionPolicy(value string) {
 func (f *FinancialInstrument8) SetDualFundIndicator(value string) {
 	f.DualFundIndicator = (*YesNoIndicator)(&value)
 }
+
+func (f *FinancialInstrument8) SetDualFundIndicatorFromBool(value bool) {
+	f.DualFundIndicator = new(YesNoIndicator)
+	f.DualFundIndicator.FromBool(value)
+}
@@ -28,6 +28,10 @@ type FinancialInstrument9 struct {
 	DualFundIndicator *YesNoIndicator `xml:"DualFndInd"`
 }
 
+func (f *FinancialInstrument9) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrument9) AddIdentification() *SecurityIdentification3Choice {
 	f.Identification = new(SecurityIdentification3Choice)
 	return f.Identification
@@ -60,3 +64,8 @@ func (f *FinancialInstrument9) SetDistributionPolicy(value string) {
 func (f *FinancialInstrument9) SetDualFundIndicator(value string) {
 	f.DualFundIndicator = (*YesNoIndicator)(&value)
 }
+
+func (f *FinancialInstrument9) SetDualFundIndicatorFromBool(value bool) {
+	f.DualFundIndicator = new(YesNoIndicator)
+	f.DualFundIndicator.FromBool(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Aggregated position of holdings held in a securities account for a specified financial instrument.
 type FinancialInstrumentAggregateBalance1 struct {
 
@@ -13,10 +17,19 @@ type FinancialInstrumentAggregateBalance1 struct {
 	Price []*Price6 `xml:"Pric,omitempty"`
 }
 
+func (f *FinancialInstrumentAggregateBalance1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentAggregateBalance1) SetItemDate(value string) {
 	f.ItemDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAggregateBalance1) SetItemDateFromTime(value time.Time) {
+	f.ItemDate = new(ISODate)
+	f.ItemDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAggregateBalance1) AddHoldings() *FinancialInstrumentAggregateBalance1Choice {
 	f.Holdings = new(FinancialInstrumentAggregateBalance1Choice)
 	return f.Holdings
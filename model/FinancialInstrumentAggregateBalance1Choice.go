@@ -10,10 +10,19 @@ type FinancialInstrumentAggregateBalance1Choice struct {
 	HoldingBalance *FinancialInstrumentAggregateBalance2 `xml:"HldgBal"`
 }
 
+func (f *FinancialInstrumentAggregateBalance1Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentAggregateBalance1Choice) SetHoldingsIndicator(value string) {
 	f.HoldingsIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentAggregateBalance1Choice) SetHoldingsIndicatorFromBool(value bool) {
+	f.HoldingsIndicator = new(YesNoIndicator)
+	f.HoldingsIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentAggregateBalance1Choice) AddHoldingBalance() *FinancialInstrumentAggregateBalance2 {
 	f.HoldingBalance = new(FinancialInstrumentAggregateBalance2)
 	return f.HoldingBalance
@@ -13,6 +13,10 @@ type FinancialInstrumentAggregateBalance2 struct {
 	BalanceBreakdown []*SubBalanceBreakdown1 `xml:"BalBrkdwn,omitempty"`
 }
 
+func (f *FinancialInstrumentAggregateBalance2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentAggregateBalance2) AddSettledBalance() *FinancialInstrumentQuantity1Choice {
 	f.SettledBalance = new(FinancialInstrumentQuantity1Choice)
 	return f.SettledBalance
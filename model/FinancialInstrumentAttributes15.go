@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Elements characterising a financial instrument.
 type FinancialInstrumentAttributes15 struct {
 
@@ -128,6 +134,10 @@ type FinancialInstrumentAttributes15 struct {
 	FinancialInstrumentAttributeAdditionalDetails *Max350Text `xml:"FinInstrmAttrAddtlDtls,omitempty"`
 }
 
+func (f *FinancialInstrumentAttributes15) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentAttributes15) AddPlaceOfListing() *MarketIdentification5 {
 	f.PlaceOfListing = new(MarketIdentification5)
 	return f.PlaceOfListing
@@ -191,62 +201,137 @@ func (f *FinancialInstrumentAttributes15) SetCouponDate(value string) {
 	f.CouponDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetCouponDateFromTime(value time.Time) {
+	f.CouponDate = new(ISODate)
+	f.CouponDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetExpiryDate(value string) {
 	f.ExpiryDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetExpiryDateFromTime(value time.Time) {
+	f.ExpiryDate = new(ISODate)
+	f.ExpiryDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetFloatingRateFixingDate(value string) {
 	f.FloatingRateFixingDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetFloatingRateFixingDateFromTime(value time.Time) {
+	f.FloatingRateFixingDate = new(ISODate)
+	f.FloatingRateFixingDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetMaturityDate(value string) {
 	f.MaturityDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetMaturityDateFromTime(value time.Time) {
+	f.MaturityDate = new(ISODate)
+	f.MaturityDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetIssueDate(value string) {
 	f.IssueDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetIssueDateFromTime(value time.Time) {
+	f.IssueDate = new(ISODate)
+	f.IssueDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetNextCallableDate(value string) {
 	f.NextCallableDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetNextCallableDateFromTime(value time.Time) {
+	f.NextCallableDate = new(ISODate)
+	f.NextCallableDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetPutableDate(value string) {
 	f.PutableDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetPutableDateFromTime(value time.Time) {
+	f.PutableDate = new(ISODate)
+	f.PutableDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetDatedDate(value string) {
 	f.DatedDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetDatedDateFromTime(value time.Time) {
+	f.DatedDate = new(ISODate)
+	f.DatedDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetFirstPaymentDate(value string) {
 	f.FirstPaymentDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetFirstPaymentDateFromTime(value time.Time) {
+	f.FirstPaymentDate = new(ISODate)
+	f.FirstPaymentDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetPreviousFactor(value string) {
 	f.PreviousFactor = (*BaseOneRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetPreviousFactorFromDecimal(value decimal.Decimal) {
+	f.PreviousFactor = new(BaseOneRate)
+	f.PreviousFactor.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetCurrentFactor(value string) {
 	f.CurrentFactor = (*BaseOneRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetCurrentFactorFromDecimal(value decimal.Decimal) {
+	f.CurrentFactor = new(BaseOneRate)
+	f.CurrentFactor.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetNextFactor(value string) {
 	f.NextFactor = (*BaseOneRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetNextFactorFromDecimal(value decimal.Decimal) {
+	f.NextFactor = new(BaseOneRate)
+	f.NextFactor.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetInterestRate(value string) {
 	f.InterestRate = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetInterestRateFromDecimal(value decimal.Decimal) {
+	f.InterestRate = new(PercentageRate)
+	f.InterestRate.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetNextInterestRate(value string) {
 	f.NextInterestRate = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetNextInterestRateFromDecimal(value decimal.Decimal) {
+	f.NextInterestRate = new(PercentageRate)
+	f.NextInterestRate.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetIndexRateBasis(value string) {
 	f.IndexRateBasis = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetIndexRateBasisFromDecimal(value decimal.Decimal) {
+	f.IndexRateBasis = new(PercentageRate)
+	f.IndexRateBasis.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes15) AddCouponAttachedNumber() *Number2Choice {
 	f.CouponAttachedNumber = new(Number2Choice)
 	return f.CouponAttachedNumber
@@ -261,14 +346,29 @@ func (f *FinancialInstrumentAttributes15) SetVariableRateIndicator(value string)
 	f.VariableRateIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetVariableRateIndicatorFromBool(value bool) {
+	f.VariableRateIndicator = new(YesNoIndicator)
+	f.VariableRateIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetCallableIndicator(value string) {
 	f.CallableIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetCallableIndicatorFromBool(value bool) {
+	f.CallableIndicator = new(YesNoIndicator)
+	f.CallableIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentAttributes15) SetPutableIndicator(value string) {
 	f.PutableIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentAttributes15) SetPutableIndicatorFromBool(value bool) {
+	f.PutableIndicator = new(YesNoIndicator)
+	f.PutableIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentAttributes15) AddMarketOrIndicativePrice() *PriceType1Choice {
 	f.MarketOrIndicativePrice = new(PriceType1Choice)
 	return f.MarketOrIndicativePrice
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Description of the financial instrument.
 type FinancialInstrumentAttributes16 struct {
 
@@ -73,6 +77,10 @@ type FinancialInstrumentAttributes16 struct {
 	IssuePrice *PriceFormat19Choice `xml:"IssePric,omitempty"`
 }
 
+func (f *FinancialInstrumentAttributes16) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentAttributes16) AddSecurityIdentification() *SecurityIdentification14 {
 	f.SecurityIdentification = new(SecurityIdentification14)
 	return f.SecurityIdentification
@@ -106,34 +114,74 @@ func (f *FinancialInstrumentAttributes16) SetNextCouponDate(value string) {
 	f.NextCouponDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes16) SetNextCouponDateFromTime(value time.Time) {
+	f.NextCouponDate = new(ISODate)
+	f.NextCouponDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes16) SetFloatingRateFixingDate(value string) {
 	f.FloatingRateFixingDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes16) SetFloatingRateFixingDateFromTime(value time.Time) {
+	f.FloatingRateFixingDate = new(ISODate)
+	f.FloatingRateFixingDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes16) SetMaturityDate(value string) {
 	f.MaturityDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes16) SetMaturityDateFromTime(value time.Time) {
+	f.MaturityDate = new(ISODate)
+	f.MaturityDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes16) SetIssueDate(value string) {
 	f.IssueDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes16) SetIssueDateFromTime(value time.Time) {
+	f.IssueDate = new(ISODate)
+	f.IssueDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes16) SetNextCallableDate(value string) {
 	f.NextCallableDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes16) SetNextCallableDateFromTime(value time.Time) {
+	f.NextCallableDate = new(ISODate)
+	f.NextCallableDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes16) SetPutableDate(value string) {
 	f.PutableDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes16) SetPutableDateFromTime(value time.Time) {
+	f.PutableDate = new(ISODate)
+	f.PutableDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes16) SetDatedDate(value string) {
 	f.DatedDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes16) SetDatedDateFromTime(value time.Time) {
+	f.DatedDate = new(ISODate)
+	f.DatedDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes16) SetConversionDate(value string) {
 	f.ConversionDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes16) SetConversionDateFromTime(value time.Time) {
+	f.ConversionDate = new(ISODate)
+	f.ConversionDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes16) AddPreviousFactor() *RateFormat3Choice {
 	f.PreviousFactor = new(RateFormat3Choice)
 	return f.PreviousFactor
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Description of the financial instrument.
 type FinancialInstrumentAttributes17 struct {
 
@@ -37,6 +41,10 @@ type FinancialInstrumentAttributes17 struct {
 	InstructedBalance *BalanceFormat1Choice `xml:"InstdBal,omitempty"`
 }
 
+func (f *FinancialInstrumentAttributes17) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentAttributes17) AddSecurityIdentification() *SecurityIdentification14 {
 	f.SecurityIdentification = new(SecurityIdentification14)
 	return f.SecurityIdentification
@@ -46,6 +54,11 @@ func (f *FinancialInstrumentAttributes17) SetQuantity(value string) {
 	f.Quantity = (*DecimalNumber)(&value)
 }
 
+func (f *FinancialInstrumentAttributes17) SetQuantityFromDecimal(value decimal.Decimal) {
+	f.Quantity = new(DecimalNumber)
+	f.Quantity.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes17) AddRenounceableEntitlementStatusType() *RenounceableEntitlementStatusTypeFormat1Choice {
 	f.RenounceableEntitlementStatusType = new(RenounceableEntitlementStatusTypeFormat1Choice)
 	return f.RenounceableEntitlementStatusType
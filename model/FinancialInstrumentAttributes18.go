@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Description of the financial instrument.
 type FinancialInstrumentAttributes18 struct {
 
@@ -70,6 +76,10 @@ type FinancialInstrumentAttributes18 struct {
 	ContractSize *FinancialInstrumentQuantity1Choice `xml:"CtrctSz,omitempty"`
 }
 
+func (f *FinancialInstrumentAttributes18) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentAttributes18) AddSecurityIdentification() *SecurityIdentification14 {
 	f.SecurityIdentification = new(SecurityIdentification14)
 	return f.SecurityIdentification
@@ -98,54 +108,119 @@ func (f *FinancialInstrumentAttributes18) SetNextCouponDate(value string) {
 	f.NextCouponDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes18) SetNextCouponDateFromTime(value time.Time) {
+	f.NextCouponDate = new(ISODate)
+	f.NextCouponDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes18) SetExpiryDate(value string) {
 	f.ExpiryDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes18) SetExpiryDateFromTime(value time.Time) {
+	f.ExpiryDate = new(ISODate)
+	f.ExpiryDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes18) SetFloatingRateFixingDate(value string) {
 	f.FloatingRateFixingDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes18) SetFloatingRateFixingDateFromTime(value time.Time) {
+	f.FloatingRateFixingDate = new(ISODate)
+	f.FloatingRateFixingDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes18) SetMaturityDate(value string) {
 	f.MaturityDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes18) SetMaturityDateFromTime(value time.Time) {
+	f.MaturityDate = new(ISODate)
+	f.MaturityDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes18) SetIssueDate(value string) {
 	f.IssueDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes18) SetIssueDateFromTime(value time.Time) {
+	f.IssueDate = new(ISODate)
+	f.IssueDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes18) SetNextCallableDate(value string) {
 	f.NextCallableDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes18) SetNextCallableDateFromTime(value time.Time) {
+	f.NextCallableDate = new(ISODate)
+	f.NextCallableDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes18) SetPutableDate(value string) {
 	f.PutableDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes18) SetPutableDateFromTime(value time.Time) {
+	f.PutableDate = new(ISODate)
+	f.PutableDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes18) SetDatedDate(value string) {
 	f.DatedDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes18) SetDatedDateFromTime(value time.Time) {
+	f.DatedDate = new(ISODate)
+	f.DatedDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes18) SetConversionDate(value string) {
 	f.ConversionDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes18) SetConversionDateFromTime(value time.Time) {
+	f.ConversionDate = new(ISODate)
+	f.ConversionDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes18) SetPreviousFactor(value string) {
 	f.PreviousFactor = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes18) SetPreviousFactorFromDecimal(value decimal.Decimal) {
+	f.PreviousFactor = new(PercentageRate)
+	f.PreviousFactor.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes18) SetNextFactor(value string) {
 	f.NextFactor = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes18) SetNextFactorFromDecimal(value decimal.Decimal) {
+	f.NextFactor = new(PercentageRate)
+	f.NextFactor.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes18) SetInterestRate(value string) {
 	f.InterestRate = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes18) SetInterestRateFromDecimal(value decimal.Decimal) {
+	f.InterestRate = new(PercentageRate)
+	f.InterestRate.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes18) SetNextInterestRate(value string) {
 	f.NextInterestRate = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes18) SetNextInterestRateFromDecimal(value decimal.Decimal) {
+	f.NextInterestRate = new(PercentageRate)
+	f.NextInterestRate.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes18) AddMinimumNominalQuantity() *FinancialInstrumentQuantity1Choice {
 	f.MinimumNominalQuantity = new(FinancialInstrumentQuantity1Choice)
 	return f.MinimumNominalQuantity
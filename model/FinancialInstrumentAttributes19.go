@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Description of the financial instrument.
 type FinancialInstrumentAttributes19 struct {
 
@@ -76,6 +80,10 @@ type FinancialInstrumentAttributes19 struct {
 	ContractSize *FinancialInstrumentQuantity1Choice `xml:"CtrctSz,omitempty"`
 }
 
+func (f *FinancialInstrumentAttributes19) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentAttributes19) AddSecurityIdentification() *SecurityIdentification14 {
 	f.SecurityIdentification = new(SecurityIdentification14)
 	return f.SecurityIdentification
@@ -109,38 +117,83 @@ func (f *FinancialInstrumentAttributes19) SetNextCouponDate(value string) {
 	f.NextCouponDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes19) SetNextCouponDateFromTime(value time.Time) {
+	f.NextCouponDate = new(ISODate)
+	f.NextCouponDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes19) SetExpiryDate(value string) {
 	f.ExpiryDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes19) SetExpiryDateFromTime(value time.Time) {
+	f.ExpiryDate = new(ISODate)
+	f.ExpiryDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes19) SetFloatingRateFixingDate(value string) {
 	f.FloatingRateFixingDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes19) SetFloatingRateFixingDateFromTime(value time.Time) {
+	f.FloatingRateFixingDate = new(ISODate)
+	f.FloatingRateFixingDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes19) SetMaturityDate(value string) {
 	f.MaturityDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes19) SetMaturityDateFromTime(value time.Time) {
+	f.MaturityDate = new(ISODate)
+	f.MaturityDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes19) SetIssueDate(value string) {
 	f.IssueDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes19) SetIssueDateFromTime(value time.Time) {
+	f.IssueDate = new(ISODate)
+	f.IssueDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes19) SetNextCallableDate(value string) {
 	f.NextCallableDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes19) SetNextCallableDateFromTime(value time.Time) {
+	f.NextCallableDate = new(ISODate)
+	f.NextCallableDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes19) SetPutableDate(value string) {
 	f.PutableDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes19) SetPutableDateFromTime(value time.Time) {
+	f.PutableDate = new(ISODate)
+	f.PutableDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes19) SetDatedDate(value string) {
 	f.DatedDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes19) SetDatedDateFromTime(value time.Time) {
+	f.DatedDate = new(ISODate)
+	f.DatedDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes19) SetConversionDate(value string) {
 	f.ConversionDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes19) SetConversionDateFromTime(value time.Time) {
+	f.ConversionDate = new(ISODate)
+	f.ConversionDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes19) AddInterestRate() *RateFormat3Choice {
 	f.InterestRate = new(RateFormat3Choice)
 	return f.InterestRate
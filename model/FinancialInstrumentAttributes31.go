@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Elements characterising a financial instrument.
 type FinancialInstrumentAttributes31 struct {
 
@@ -161,6 +167,10 @@ type FinancialInstrumentAttributes31 struct {
 	FinancialInstrumentAttributeAdditionalDetails *Max350Text `xml:"FinInstrmAttrAddtlDtls,omitempty"`
 }
 
+func (f *FinancialInstrumentAttributes31) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentAttributes31) AddPlaceOfListing() *MarketIdentification3Choice {
 	f.PlaceOfListing = new(MarketIdentification3Choice)
 	return f.PlaceOfListing
@@ -218,78 +228,173 @@ func (f *FinancialInstrumentAttributes31) SetCouponDate(value string) {
 	f.CouponDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetCouponDateFromTime(value time.Time) {
+	f.CouponDate = new(ISODate)
+	f.CouponDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetExpiryDate(value string) {
 	f.ExpiryDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetExpiryDateFromTime(value time.Time) {
+	f.ExpiryDate = new(ISODate)
+	f.ExpiryDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetFloatingRateFixingDate(value string) {
 	f.FloatingRateFixingDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetFloatingRateFixingDateFromTime(value time.Time) {
+	f.FloatingRateFixingDate = new(ISODate)
+	f.FloatingRateFixingDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetMaturityDate(value string) {
 	f.MaturityDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetMaturityDateFromTime(value time.Time) {
+	f.MaturityDate = new(ISODate)
+	f.MaturityDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetIssueDate(value string) {
 	f.IssueDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetIssueDateFromTime(value time.Time) {
+	f.IssueDate = new(ISODate)
+	f.IssueDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetNextCallableDate(value string) {
 	f.NextCallableDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetNextCallableDateFromTime(value time.Time) {
+	f.NextCallableDate = new(ISODate)
+	f.NextCallableDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetConversionDate(value string) {
 	f.ConversionDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetConversionDateFromTime(value time.Time) {
+	f.ConversionDate = new(ISODate)
+	f.ConversionDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetPutableDate(value string) {
 	f.PutableDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetPutableDateFromTime(value time.Time) {
+	f.PutableDate = new(ISODate)
+	f.PutableDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetDatedDate(value string) {
 	f.DatedDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetDatedDateFromTime(value time.Time) {
+	f.DatedDate = new(ISODate)
+	f.DatedDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetFirstPaymentDate(value string) {
 	f.FirstPaymentDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetFirstPaymentDateFromTime(value time.Time) {
+	f.FirstPaymentDate = new(ISODate)
+	f.FirstPaymentDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetNextFactorDate(value string) {
 	f.NextFactorDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetNextFactorDateFromTime(value time.Time) {
+	f.NextFactorDate = new(ISODate)
+	f.NextFactorDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetPreviousFactor(value string) {
 	f.PreviousFactor = (*BaseOneRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetPreviousFactorFromDecimal(value decimal.Decimal) {
+	f.PreviousFactor = new(BaseOneRate)
+	f.PreviousFactor.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetCurrentFactor(value string) {
 	f.CurrentFactor = (*BaseOneRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetCurrentFactorFromDecimal(value decimal.Decimal) {
+	f.CurrentFactor = new(BaseOneRate)
+	f.CurrentFactor.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetNextFactor(value string) {
 	f.NextFactor = (*BaseOneRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetNextFactorFromDecimal(value decimal.Decimal) {
+	f.NextFactor = new(BaseOneRate)
+	f.NextFactor.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetEndFactor(value string) {
 	f.EndFactor = (*BaseOneRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetEndFactorFromDecimal(value decimal.Decimal) {
+	f.EndFactor = new(BaseOneRate)
+	f.EndFactor.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetInterestRate(value string) {
 	f.InterestRate = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetInterestRateFromDecimal(value decimal.Decimal) {
+	f.InterestRate = new(PercentageRate)
+	f.InterestRate.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetNextInterestRate(value string) {
 	f.NextInterestRate = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetNextInterestRateFromDecimal(value decimal.Decimal) {
+	f.NextInterestRate = new(PercentageRate)
+	f.NextInterestRate.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetIndexRateBasis(value string) {
 	f.IndexRateBasis = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetIndexRateBasisFromDecimal(value decimal.Decimal) {
+	f.IndexRateBasis = new(PercentageRate)
+	f.IndexRateBasis.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetPercentageOfDebtClaims(value string) {
 	f.PercentageOfDebtClaims = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetPercentageOfDebtClaimsFromDecimal(value decimal.Decimal) {
+	f.PercentageOfDebtClaims = new(PercentageRate)
+	f.PercentageOfDebtClaims.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes31) AddCouponAttachedNumber() *Number1Choice {
 	f.CouponAttachedNumber = new(Number1Choice)
 	return f.CouponAttachedNumber
@@ -309,38 +414,83 @@ func (f *FinancialInstrumentAttributes31) SetConvertibleIndicator(value string)
 	f.ConvertibleIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetConvertibleIndicatorFromBool(value bool) {
+	f.ConvertibleIndicator = new(YesNoIndicator)
+	f.ConvertibleIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetVariableRateIndicator(value string) {
 	f.VariableRateIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetVariableRateIndicatorFromBool(value bool) {
+	f.VariableRateIndicator = new(YesNoIndicator)
+	f.VariableRateIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetCoveredIndicator(value string) {
 	f.CoveredIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetCoveredIndicatorFromBool(value bool) {
+	f.CoveredIndicator = new(YesNoIndicator)
+	f.CoveredIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetCallableIndicator(value string) {
 	f.CallableIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetCallableIndicatorFromBool(value bool) {
+	f.CallableIndicator = new(YesNoIndicator)
+	f.CallableIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetPutableIndicator(value string) {
 	f.PutableIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetPutableIndicatorFromBool(value bool) {
+	f.PutableIndicator = new(YesNoIndicator)
+	f.PutableIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetWarrantAttachedOnDelivery(value string) {
 	f.WarrantAttachedOnDelivery = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetWarrantAttachedOnDeliveryFromBool(value bool) {
+	f.WarrantAttachedOnDelivery = new(YesNoIndicator)
+	f.WarrantAttachedOnDelivery.FromBool(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetOddCouponIndicator(value string) {
 	f.OddCouponIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetOddCouponIndicatorFromBool(value bool) {
+	f.OddCouponIndicator = new(YesNoIndicator)
+	f.OddCouponIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetRedemptionYieldImpact(value string) {
 	f.RedemptionYieldImpact = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetRedemptionYieldImpactFromBool(value bool) {
+	f.RedemptionYieldImpact = new(YesNoIndicator)
+	f.RedemptionYieldImpact.FromBool(value)
+}
+
 func (f *FinancialInstrumentAttributes31) SetYieldVariance(value string) {
 	f.YieldVariance = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentAttributes31) SetYieldVarianceFromBool(value bool) {
+	f.YieldVariance = new(YesNoIndicator)
+	f.YieldVariance.FromBool(value)
+}
+
 func (f *FinancialInstrumentAttributes31) AddExercisePrice() *Price4 {
 	f.ExercisePrice = new(Price4)
 	return f.ExercisePrice
@@ -380,6 +530,10 @@ func (f *FinancialInstrumentAttributes31) SetFaceAmount(value, currency string)
 	f.FaceAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentAttributes31) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.FaceAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentAttributes31) AddContractSize() *FinancialInstrumentQuantity18Choice {
 	f.ContractSize = new(FinancialInstrumentQuantity18Choice)
 	return f.ContractSize
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Description of the financial instrument.
 type FinancialInstrumentAttributes32 struct {
 
@@ -70,6 +76,10 @@ type FinancialInstrumentAttributes32 struct {
 	ContractSize *FinancialInstrumentQuantity1Choice `xml:"CtrctSz,omitempty"`
 }
 
+func (f *FinancialInstrumentAttributes32) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentAttributes32) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return f.FinancialInstrumentIdentification
@@ -98,54 +108,119 @@ func (f *FinancialInstrumentAttributes32) SetNextCouponDate(value string) {
 	f.NextCouponDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes32) SetNextCouponDateFromTime(value time.Time) {
+	f.NextCouponDate = new(ISODate)
+	f.NextCouponDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes32) SetExpiryDate(value string) {
 	f.ExpiryDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes32) SetExpiryDateFromTime(value time.Time) {
+	f.ExpiryDate = new(ISODate)
+	f.ExpiryDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes32) SetFloatingRateFixingDate(value string) {
 	f.FloatingRateFixingDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes32) SetFloatingRateFixingDateFromTime(value time.Time) {
+	f.FloatingRateFixingDate = new(ISODate)
+	f.FloatingRateFixingDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes32) SetMaturityDate(value string) {
 	f.MaturityDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes32) SetMaturityDateFromTime(value time.Time) {
+	f.MaturityDate = new(ISODate)
+	f.MaturityDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes32) SetIssueDate(value string) {
 	f.IssueDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes32) SetIssueDateFromTime(value time.Time) {
+	f.IssueDate = new(ISODate)
+	f.IssueDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes32) SetNextCallableDate(value string) {
 	f.NextCallableDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes32) SetNextCallableDateFromTime(value time.Time) {
+	f.NextCallableDate = new(ISODate)
+	f.NextCallableDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes32) SetPutableDate(value string) {
 	f.PutableDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes32) SetPutableDateFromTime(value time.Time) {
+	f.PutableDate = new(ISODate)
+	f.PutableDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes32) SetDatedDate(value string) {
 	f.DatedDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes32) SetDatedDateFromTime(value time.Time) {
+	f.DatedDate = new(ISODate)
+	f.DatedDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes32) SetConversionDate(value string) {
 	f.ConversionDate = (*ISODate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes32) SetConversionDateFromTime(value time.Time) {
+	f.ConversionDate = new(ISODate)
+	f.ConversionDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentAttributes32) SetPreviousFactor(value string) {
 	f.PreviousFactor = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes32) SetPreviousFactorFromDecimal(value decimal.Decimal) {
+	f.PreviousFactor = new(PercentageRate)
+	f.PreviousFactor.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes32) SetNextFactor(value string) {
 	f.NextFactor = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes32) SetNextFactorFromDecimal(value decimal.Decimal) {
+	f.NextFactor = new(PercentageRate)
+	f.NextFactor.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes32) SetInterestRate(value string) {
 	f.InterestRate = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes32) SetInterestRateFromDecimal(value decimal.Decimal) {
+	f.InterestRate = new(PercentageRate)
+	f.InterestRate.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes32) SetNextInterestRate(value string) {
 	f.NextInterestRate = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentAttributes32) SetNextInterestRateFromDecimal(value decimal.Decimal) {
+	f.NextInterestRate = new(PercentageRate)
+	f.NextInterestRate.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes32) AddMinimumNominalQuantity() *FinancialInstrumentQuantity1Choice {
 	f.MinimumNominalQuantity = new(FinancialInstrumentQuantity1Choice)
 	return f.MinimumNominalQuantity
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Description of the financial instrument.
 type FinancialInstrumentAttributes68 struct {
 
@@ -37,6 +41,10 @@ type FinancialInstrumentAttributes68 struct {
 	InstructedBalance *BalanceFormat5Choice `xml:"InstdBal,omitempty"`
 }
 
+func (f *FinancialInstrumentAttributes68) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentAttributes68) AddSecurityIdentification() *SecurityIdentification19 {
 	f.SecurityIdentification = new(SecurityIdentification19)
 	return f.SecurityIdentification
@@ -46,6 +54,11 @@ func (f *FinancialInstrumentAttributes68) SetQuantity(value string) {
 	f.Quantity = (*DecimalNumber)(&value)
 }
 
+func (f *FinancialInstrumentAttributes68) SetQuantityFromDecimal(value decimal.Decimal) {
+	f.Quantity = new(DecimalNumber)
+	f.Quantity.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes68) AddRenounceableEntitlementStatusType() *RenounceableEntitlementStatusTypeFormat3Choice {
 	f.RenounceableEntitlementStatusType = new(RenounceableEntitlementStatusTypeFormat3Choice)
 	return f.RenounceableEntitlementStatusType
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Description of the financial instrument.
 type FinancialInstrumentAttributes73 struct {
 
@@ -37,6 +41,10 @@ type FinancialInstrumentAttributes73 struct {
 	InstructedBalance *BalanceFormat7Choice `xml:"InstdBal,omitempty"`
 }
 
+func (f *FinancialInstrumentAttributes73) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentAttributes73) AddSecurityIdentification() *SecurityIdentification20 {
 	f.SecurityIdentification = new(SecurityIdentification20)
 	return f.SecurityIdentification
@@ -46,6 +54,11 @@ func (f *FinancialInstrumentAttributes73) SetQuantity(value string) {
 	f.Quantity = (*RestrictedFINDecimalNumber)(&value)
 }
 
+func (f *FinancialInstrumentAttributes73) SetQuantityFromDecimal(value decimal.Decimal) {
+	f.Quantity = new(RestrictedFINDecimalNumber)
+	f.Quantity.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentAttributes73) AddRenounceableEntitlementStatusType() *RenounceableEntitlementStatusTypeFormat4Choice {
 	f.RenounceableEntitlementStatusType = new(RenounceableEntitlementStatusTypeFormat4Choice)
 	return f.RenounceableEntitlementStatusType
@@ -13,6 +13,10 @@ type FinancialInstrumentDescription3 struct {
 	SafekeepingPlace *PartyIdentification2Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (f *FinancialInstrumentDescription3) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDescription3) AddSecurityIdentification() *SecurityIdentification7 {
 	f.SecurityIdentification = new(SecurityIdentification7)
 	return f.SecurityIdentification
@@ -13,6 +13,10 @@ type FinancialInstrumentDetails1 struct {
 	SubBalance []*IntraPositionDetails3 `xml:"SubBal"`
 }
 
+func (f *FinancialInstrumentDetails1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails1) AddFinancialInstrumentIdentification() *SecurityIdentification11 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification11)
 	return f.FinancialInstrumentIdentification
@@ -13,6 +13,10 @@ type FinancialInstrumentDetails10 struct {
 	SubBalance []*IntraPositionDetails20 `xml:"SubBal"`
 }
 
+func (f *FinancialInstrumentDetails10) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails10) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return f.FinancialInstrumentIdentification
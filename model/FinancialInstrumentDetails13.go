@@ -22,6 +22,10 @@ type FinancialInstrumentDetails13 struct {
 	Transaction []*Transaction29 `xml:"Tx"`
 }
 
+func (f *FinancialInstrumentDetails13) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails13) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return f.FinancialInstrumentIdentification
@@ -13,6 +13,10 @@ type FinancialInstrumentDetails14 struct {
 	SubBalance []*IntraPositionDetails28 `xml:"SubBal"`
 }
 
+func (f *FinancialInstrumentDetails14) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails14) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return f.FinancialInstrumentIdentification
@@ -22,6 +22,10 @@ type FinancialInstrumentDetails17 struct {
 	Transaction []*Transaction36 `xml:"Tx"`
 }
 
+func (f *FinancialInstrumentDetails17) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails17) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return f.FinancialInstrumentIdentification
@@ -22,6 +22,10 @@ type FinancialInstrumentDetails2 struct {
 	Transaction []*Transaction6 `xml:"Tx"`
 }
 
+func (f *FinancialInstrumentDetails2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails2) AddFinancialInstrumentIdentification() *SecurityIdentification11 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification11)
 	return f.FinancialInstrumentIdentification
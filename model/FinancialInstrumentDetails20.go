@@ -22,6 +22,10 @@ type FinancialInstrumentDetails20 struct {
 	Transaction []*Transaction46 `xml:"Tx"`
 }
 
+func (f *FinancialInstrumentDetails20) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails20) AddFinancialInstrumentIdentification() *SecurityIdentification19 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification19)
 	return f.FinancialInstrumentIdentification
@@ -13,6 +13,10 @@ type FinancialInstrumentDetails21 struct {
 	SubBalance []*IntraPositionDetails32 `xml:"SubBal"`
 }
 
+func (f *FinancialInstrumentDetails21) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails21) AddFinancialInstrumentIdentification() *SecurityIdentification19 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification19)
 	return f.FinancialInstrumentIdentification
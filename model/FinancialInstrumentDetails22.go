@@ -13,6 +13,10 @@ type FinancialInstrumentDetails22 struct {
 	SubBalance []*IntraPositionDetails37 `xml:"SubBal"`
 }
 
+func (f *FinancialInstrumentDetails22) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails22) AddFinancialInstrumentIdentification() *SecurityIdentification20 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification20)
 	return f.FinancialInstrumentIdentification
@@ -22,6 +22,10 @@ type FinancialInstrumentDetails23 struct {
 	Transaction []*Transaction50 `xml:"Tx"`
 }
 
+func (f *FinancialInstrumentDetails23) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails23) AddFinancialInstrumentIdentification() *SecurityIdentification20 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification20)
 	return f.FinancialInstrumentIdentification
@@ -13,6 +13,10 @@ type FinancialInstrumentDetails24 struct {
 	SubBalance []*IntraPositionDetails40 `xml:"SubBal"`
 }
 
+func (f *FinancialInstrumentDetails24) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails24) AddFinancialInstrumentIdentification() *SecurityIdentification19 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification19)
 	return f.FinancialInstrumentIdentification
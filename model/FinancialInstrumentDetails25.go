@@ -22,6 +22,10 @@ type FinancialInstrumentDetails25 struct {
 	Transaction []*Transaction52 `xml:"Tx"`
 }
 
+func (f *FinancialInstrumentDetails25) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails25) AddFinancialInstrumentIdentification() *SecurityIdentification19 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification19)
 	return f.FinancialInstrumentIdentification
@@ -13,6 +13,10 @@ type FinancialInstrumentDetails26 struct {
 	SubBalance []*IntraPositionDetails44 `xml:"SubBal"`
 }
 
+func (f *FinancialInstrumentDetails26) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails26) AddFinancialInstrumentIdentification() *SecurityIdentification20 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification20)
 	return f.FinancialInstrumentIdentification
@@ -22,6 +22,10 @@ type FinancialInstrumentDetails27 struct {
 	Transaction []*Transaction55 `xml:"Tx"`
 }
 
+func (f *FinancialInstrumentDetails27) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails27) AddFinancialInstrumentIdentification() *SecurityIdentification20 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification20)
 	return f.FinancialInstrumentIdentification
@@ -22,6 +22,10 @@ type FinancialInstrumentDetails5 struct {
 	Transaction []*Transaction13 `xml:"Tx"`
 }
 
+func (f *FinancialInstrumentDetails5) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails5) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return f.FinancialInstrumentIdentification
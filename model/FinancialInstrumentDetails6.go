@@ -13,6 +13,10 @@ type FinancialInstrumentDetails6 struct {
 	SubBalance []*IntraPositionDetails17 `xml:"SubBal"`
 }
 
+func (f *FinancialInstrumentDetails6) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails6) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return f.FinancialInstrumentIdentification
@@ -22,6 +22,10 @@ type FinancialInstrumentDetails9 struct {
 	Transaction []*Transaction18 `xml:"Tx"`
 }
 
+func (f *FinancialInstrumentDetails9) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentDetails9) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	f.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return f.FinancialInstrumentIdentification
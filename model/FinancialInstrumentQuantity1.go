@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Quantity of a security.
 type FinancialInstrumentQuantity1 struct {
 
@@ -7,6 +11,15 @@ type FinancialInstrumentQuantity1 struct {
 	Unit *DecimalNumber `xml:"Unit"`
 }
 
+func (f *FinancialInstrumentQuantity1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity1) SetUnit(value string) {
 	f.Unit = (*DecimalNumber)(&value)
 }
+
+func (f *FinancialInstrumentQuantity1) SetUnitFromDecimal(value decimal.Decimal) {
+	f.Unit = new(DecimalNumber)
+	f.Unit.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between formats for the quantity of security.
 type FinancialInstrumentQuantity15Choice struct {
 
@@ -13,14 +17,31 @@ type FinancialInstrumentQuantity15Choice struct {
 	AmortisedValue *RestrictedFINImpliedCurrencyAndAmount `xml:"AmtsdVal"`
 }
 
+func (f *FinancialInstrumentQuantity15Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity15Choice) SetUnit(value string) {
 	f.Unit = (*RestrictedFINDecimalNumber)(&value)
 }
 
+func (f *FinancialInstrumentQuantity15Choice) SetUnitFromDecimal(value decimal.Decimal) {
+	f.Unit = new(RestrictedFINDecimalNumber)
+	f.Unit.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity15Choice) SetFaceAmount(value, currency string) {
 	f.FaceAmount = NewRestrictedFINImpliedCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity15Choice) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.FaceAmount = NewRestrictedFINImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity15Choice) SetAmortisedValue(value, currency string) {
 	f.AmortisedValue = NewRestrictedFINImpliedCurrencyAndAmount(value, currency)
 }
+
+func (f *FinancialInstrumentQuantity15Choice) SetAmortisedValueFromDecimal(value decimal.Decimal, currency string) {
+	f.AmortisedValue = NewRestrictedFINImpliedCurrencyAndAmount(value.String(), currency)
+}
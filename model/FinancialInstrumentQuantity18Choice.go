@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between formats for the quantity of security.
 type FinancialInstrumentQuantity18Choice struct {
 
@@ -10,10 +14,23 @@ type FinancialInstrumentQuantity18Choice struct {
 	FaceAmount *ImpliedCurrencyAndAmount `xml:"FaceAmt"`
 }
 
+func (f *FinancialInstrumentQuantity18Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity18Choice) SetUnit(value string) {
 	f.Unit = (*DecimalNumber)(&value)
 }
 
+func (f *FinancialInstrumentQuantity18Choice) SetUnitFromDecimal(value decimal.Decimal) {
+	f.Unit = new(DecimalNumber)
+	f.Unit.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity18Choice) SetFaceAmount(value, currency string) {
 	f.FaceAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (f *FinancialInstrumentQuantity18Choice) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.FaceAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
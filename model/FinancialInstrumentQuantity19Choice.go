@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between formats for the quantity of security.
 type FinancialInstrumentQuantity19Choice struct {
 
@@ -16,18 +20,35 @@ type FinancialInstrumentQuantity19Choice struct {
 	Code *Quantity4Code `xml:"Cd"`
 }
 
+func (f *FinancialInstrumentQuantity19Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity19Choice) SetUnit(value string) {
 	f.Unit = (*DecimalNumber)(&value)
 }
 
+func (f *FinancialInstrumentQuantity19Choice) SetUnitFromDecimal(value decimal.Decimal) {
+	f.Unit = new(DecimalNumber)
+	f.Unit.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity19Choice) SetFaceAmount(value, currency string) {
 	f.FaceAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity19Choice) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.FaceAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity19Choice) SetAmortisedValue(value, currency string) {
 	f.AmortisedValue = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity19Choice) SetAmortisedValueFromDecimal(value decimal.Decimal, currency string) {
+	f.AmortisedValue = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity19Choice) SetCode(value string) {
 	f.Code = (*Quantity4Code)(&value)
 }
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Choice between formats for the quantity of security.
 type FinancialInstrumentQuantity1Choice struct {
 
@@ -13,14 +18,81 @@ type FinancialInstrumentQuantity1Choice struct {
 	AmortisedValue *ImpliedCurrencyAndAmount `xml:"AmtsdVal"`
 }
 
+func (f *FinancialInstrumentQuantity1Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity1Choice) SetUnit(value string) {
 	f.Unit = (*DecimalNumber)(&value)
 }
 
+func (f *FinancialInstrumentQuantity1Choice) SetUnitFromDecimal(value decimal.Decimal) {
+	f.Unit = new(DecimalNumber)
+	f.Unit.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity1Choice) SetFaceAmount(value, currency string) {
 	f.FaceAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity1Choice) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.FaceAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity1Choice) SetAmortisedValue(value, currency string) {
 	f.AmortisedValue = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (f *FinancialInstrumentQuantity1Choice) SetAmortisedValueFromDecimal(value decimal.Decimal, currency string) {
+	f.AmortisedValue = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
+func (f *FinancialInstrumentQuantity1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if f.Unit != nil {
+		enc.Element("Unit", string(*f.Unit))
+	}
+	if f.FaceAmount != nil {
+		f.FaceAmount.EncodeXMLFast(enc, "FaceAmt")
+	}
+	if f.AmortisedValue != nil {
+		f.AmortisedValue.EncodeXMLFast(enc, "AmtsdVal")
+	}
+	enc.End(tag)
+}
+
+func (f *FinancialInstrumentQuantity1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Unit":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			f.Unit = (*DecimalNumber)(&value)
+		case "FaceAmt":
+			elem := new(ImpliedCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.FaceAmount = elem
+		case "AmtsdVal":
+			elem := new(ImpliedCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.AmortisedValue = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
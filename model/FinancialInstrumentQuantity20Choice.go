@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between formats for the quantity of security.
 type FinancialInstrumentQuantity20Choice struct {
 
@@ -16,18 +20,35 @@ type FinancialInstrumentQuantity20Choice struct {
 	Code *Quantity5Code `xml:"Cd"`
 }
 
+func (f *FinancialInstrumentQuantity20Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity20Choice) SetUnit(value string) {
 	f.Unit = (*DecimalNumber)(&value)
 }
 
+func (f *FinancialInstrumentQuantity20Choice) SetUnitFromDecimal(value decimal.Decimal) {
+	f.Unit = new(DecimalNumber)
+	f.Unit.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity20Choice) SetFaceAmount(value, currency string) {
 	f.FaceAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity20Choice) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.FaceAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity20Choice) SetAmortisedValue(value, currency string) {
 	f.AmortisedValue = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity20Choice) SetAmortisedValueFromDecimal(value decimal.Decimal, currency string) {
+	f.AmortisedValue = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity20Choice) SetCode(value string) {
 	f.Code = (*Quantity5Code)(&value)
 }
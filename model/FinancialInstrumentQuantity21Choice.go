@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between formats for the quantity of security.
 type FinancialInstrumentQuantity21Choice struct {
 
@@ -16,18 +20,35 @@ type FinancialInstrumentQuantity21Choice struct {
 	Code *Quantity4Code `xml:"Cd"`
 }
 
+func (f *FinancialInstrumentQuantity21Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity21Choice) SetUnit(value string) {
 	f.Unit = (*RestrictedFINDecimalNumber)(&value)
 }
 
+func (f *FinancialInstrumentQuantity21Choice) SetUnitFromDecimal(value decimal.Decimal) {
+	f.Unit = new(RestrictedFINDecimalNumber)
+	f.Unit.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity21Choice) SetFaceAmount(value, currency string) {
 	f.FaceAmount = NewRestrictedFINImpliedCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity21Choice) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.FaceAmount = NewRestrictedFINImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity21Choice) SetAmortisedValue(value, currency string) {
 	f.AmortisedValue = NewRestrictedFINImpliedCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity21Choice) SetAmortisedValueFromDecimal(value decimal.Decimal, currency string) {
+	f.AmortisedValue = NewRestrictedFINImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity21Choice) SetCode(value string) {
 	f.Code = (*Quantity4Code)(&value)
 }
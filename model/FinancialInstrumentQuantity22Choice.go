@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between formats for the quantity of security.
 type FinancialInstrumentQuantity22Choice struct {
 
@@ -16,18 +20,35 @@ type FinancialInstrumentQuantity22Choice struct {
 	Code *Quantity5Code `xml:"Cd"`
 }
 
+func (f *FinancialInstrumentQuantity22Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity22Choice) SetUnit(value string) {
 	f.Unit = (*RestrictedFINDecimalNumber)(&value)
 }
 
+func (f *FinancialInstrumentQuantity22Choice) SetUnitFromDecimal(value decimal.Decimal) {
+	f.Unit = new(RestrictedFINDecimalNumber)
+	f.Unit.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity22Choice) SetFaceAmount(value, currency string) {
 	f.FaceAmount = NewRestrictedFINImpliedCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity22Choice) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.FaceAmount = NewRestrictedFINImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity22Choice) SetAmortisedValue(value, currency string) {
 	f.AmortisedValue = NewRestrictedFINImpliedCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity22Choice) SetAmortisedValueFromDecimal(value decimal.Decimal, currency string) {
+	f.AmortisedValue = NewRestrictedFINImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity22Choice) SetCode(value string) {
 	f.Code = (*Quantity5Code)(&value)
 }
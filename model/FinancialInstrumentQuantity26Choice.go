@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between formats for the specification of the number of units, amount of money or percentage.
 type FinancialInstrumentQuantity26Choice struct {
 
@@ -18,18 +22,40 @@ type FinancialInstrumentQuantity26Choice struct {
 	GrossAmount *ActiveOrHistoricCurrencyAndAmount `xml:"GrssAmt"`
 }
 
+func (f *FinancialInstrumentQuantity26Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity26Choice) SetUnitsNumber(value string) {
 	f.UnitsNumber = (*DecimalNumber)(&value)
 }
 
+func (f *FinancialInstrumentQuantity26Choice) SetUnitsNumberFromDecimal(value decimal.Decimal) {
+	f.UnitsNumber = new(DecimalNumber)
+	f.UnitsNumber.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity26Choice) SetPercentageOfTotalRedemptionAmount(value string) {
 	f.PercentageOfTotalRedemptionAmount = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentQuantity26Choice) SetPercentageOfTotalRedemptionAmountFromDecimal(value decimal.Decimal) {
+	f.PercentageOfTotalRedemptionAmount = new(PercentageRate)
+	f.PercentageOfTotalRedemptionAmount.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity26Choice) SetNetAmount(value, currency string) {
 	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity26Choice) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity26Choice) SetGrossAmount(value, currency string) {
 	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (f *FinancialInstrumentQuantity26Choice) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
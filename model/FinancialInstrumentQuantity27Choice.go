@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between formats for the specification of the number of units or amount of money.
 type FinancialInstrumentQuantity27Choice struct {
 
@@ -15,14 +19,31 @@ type FinancialInstrumentQuantity27Choice struct {
 	NetAmount *ActiveOrHistoricCurrencyAndAmount `xml:"NetAmt"`
 }
 
+func (f *FinancialInstrumentQuantity27Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity27Choice) SetUnitsNumber(value string) {
 	f.UnitsNumber = (*DecimalNumber)(&value)
 }
 
+func (f *FinancialInstrumentQuantity27Choice) SetUnitsNumberFromDecimal(value decimal.Decimal) {
+	f.UnitsNumber = new(DecimalNumber)
+	f.UnitsNumber.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity27Choice) SetGrossAmount(value, currency string) {
 	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity27Choice) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity27Choice) SetNetAmount(value, currency string) {
 	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (f *FinancialInstrumentQuantity27Choice) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between formats for the specification of the number of units, amount of money or percentage..
 type FinancialInstrumentQuantity28Choice struct {
 
@@ -18,18 +22,40 @@ type FinancialInstrumentQuantity28Choice struct {
 	HoldingsRedemptionRate *PercentageRate `xml:"HldgsRedRate"`
 }
 
+func (f *FinancialInstrumentQuantity28Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity28Choice) SetUnitsNumber(value string) {
 	f.UnitsNumber = (*DecimalNumber)(&value)
 }
 
+func (f *FinancialInstrumentQuantity28Choice) SetUnitsNumberFromDecimal(value decimal.Decimal) {
+	f.UnitsNumber = new(DecimalNumber)
+	f.UnitsNumber.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity28Choice) SetGrossAmount(value, currency string) {
 	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity28Choice) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity28Choice) SetNetAmount(value, currency string) {
 	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity28Choice) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity28Choice) SetHoldingsRedemptionRate(value string) {
 	f.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
+
+func (f *FinancialInstrumentQuantity28Choice) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	f.HoldingsRedemptionRate = new(PercentageRate)
+	f.HoldingsRedemptionRate.FromDecimal(value)
+}
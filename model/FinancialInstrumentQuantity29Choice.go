@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between formats for the specification of the number of units, amount of money or percentage.
 type FinancialInstrumentQuantity29Choice struct {
 
@@ -21,22 +25,49 @@ type FinancialInstrumentQuantity29Choice struct {
 	HoldingsRedemptionRate *PercentageRate `xml:"HldgsRedRate"`
 }
 
+func (f *FinancialInstrumentQuantity29Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity29Choice) SetUnitsNumber(value string) {
 	f.UnitsNumber = (*DecimalNumber)(&value)
 }
 
+func (f *FinancialInstrumentQuantity29Choice) SetUnitsNumberFromDecimal(value decimal.Decimal) {
+	f.UnitsNumber = new(DecimalNumber)
+	f.UnitsNumber.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity29Choice) SetPercentageOfTotalSubscriptionAmount(value string) {
 	f.PercentageOfTotalSubscriptionAmount = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentQuantity29Choice) SetPercentageOfTotalSubscriptionAmountFromDecimal(value decimal.Decimal) {
+	f.PercentageOfTotalSubscriptionAmount = new(PercentageRate)
+	f.PercentageOfTotalSubscriptionAmount.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity29Choice) SetNetAmount(value, currency string) {
 	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity29Choice) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity29Choice) SetGrossAmount(value, currency string) {
 	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity29Choice) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity29Choice) SetHoldingsRedemptionRate(value string) {
 	f.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
+
+func (f *FinancialInstrumentQuantity29Choice) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	f.HoldingsRedemptionRate = new(PercentageRate)
+	f.HoldingsRedemptionRate.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between ways to express the quantity of the financial instrument to be redeemed.
 type FinancialInstrumentQuantity3Choice struct {
 
@@ -19,6 +23,10 @@ type FinancialInstrumentQuantity3Choice struct {
 	HoldingsRedemptionRate *PercentageRate `xml:"HldgsRedRate"`
 }
 
+func (f *FinancialInstrumentQuantity3Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity3Choice) AddUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.UnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.UnitsNumber
@@ -28,14 +36,32 @@ func (f *FinancialInstrumentQuantity3Choice) SetPercentageOfTotalSubscriptionAmo
 	f.PercentageOfTotalSubscriptionAmount = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentQuantity3Choice) SetPercentageOfTotalSubscriptionAmountFromDecimal(value decimal.Decimal) {
+	f.PercentageOfTotalSubscriptionAmount = new(PercentageRate)
+	f.PercentageOfTotalSubscriptionAmount.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity3Choice) SetNetAmount(value, currency string) {
 	f.NetAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity3Choice) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.NetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity3Choice) SetGrossAmount(value, currency string) {
 	f.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity3Choice) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity3Choice) SetHoldingsRedemptionRate(value string) {
 	f.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
+
+func (f *FinancialInstrumentQuantity3Choice) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	f.HoldingsRedemptionRate = new(PercentageRate)
+	f.HoldingsRedemptionRate.FromDecimal(value)
+}
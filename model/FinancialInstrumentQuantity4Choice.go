@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between ways to express the quantity of the financial instrument to be subscribed.
 type FinancialInstrumentQuantity4Choice struct {
 
@@ -16,6 +20,10 @@ type FinancialInstrumentQuantity4Choice struct {
 	GrossAmount *ActiveCurrencyAndAmount `xml:"GrssAmt"`
 }
 
+func (f *FinancialInstrumentQuantity4Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity4Choice) AddUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.UnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.UnitsNumber
@@ -25,10 +33,23 @@ func (f *FinancialInstrumentQuantity4Choice) SetPercentageOfTotalRedemptionAmoun
 	f.PercentageOfTotalRedemptionAmount = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentQuantity4Choice) SetPercentageOfTotalRedemptionAmountFromDecimal(value decimal.Decimal) {
+	f.PercentageOfTotalRedemptionAmount = new(PercentageRate)
+	f.PercentageOfTotalRedemptionAmount.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity4Choice) SetNetAmount(value, currency string) {
 	f.NetAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity4Choice) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.NetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity4Choice) SetGrossAmount(value, currency string) {
 	f.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (f *FinancialInstrumentQuantity4Choice) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between ways to express the quantity of the financial instrument to be redeemed.
 type FinancialInstrumentQuantity5Choice struct {
 
@@ -19,6 +23,10 @@ type FinancialInstrumentQuantity5Choice struct {
 	HoldingsRedemptionRate *PercentageRate `xml:"HldgsRedRate"`
 }
 
+func (f *FinancialInstrumentQuantity5Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity5Choice) AddUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.UnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.UnitsNumber
@@ -28,14 +36,32 @@ func (f *FinancialInstrumentQuantity5Choice) SetPercentageOfTotalSubscriptionAmo
 	f.PercentageOfTotalSubscriptionAmount = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentQuantity5Choice) SetPercentageOfTotalSubscriptionAmountFromDecimal(value decimal.Decimal) {
+	f.PercentageOfTotalSubscriptionAmount = new(PercentageRate)
+	f.PercentageOfTotalSubscriptionAmount.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity5Choice) SetNetAmount(value, currency string) {
 	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity5Choice) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity5Choice) SetGrossAmount(value, currency string) {
 	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity5Choice) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity5Choice) SetHoldingsRedemptionRate(value string) {
 	f.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
+
+func (f *FinancialInstrumentQuantity5Choice) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	f.HoldingsRedemptionRate = new(PercentageRate)
+	f.HoldingsRedemptionRate.FromDecimal(value)
+}
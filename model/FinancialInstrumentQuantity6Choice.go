@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between ways to express the quantity of the financial instrument to be subscribed.
 type FinancialInstrumentQuantity6Choice struct {
 
@@ -16,6 +20,10 @@ type FinancialInstrumentQuantity6Choice struct {
 	GrossAmount *ActiveOrHistoricCurrencyAndAmount `xml:"GrssAmt"`
 }
 
+func (f *FinancialInstrumentQuantity6Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentQuantity6Choice) AddUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.UnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.UnitsNumber
@@ -25,10 +33,23 @@ func (f *FinancialInstrumentQuantity6Choice) SetPercentageOfTotalRedemptionAmoun
 	f.PercentageOfTotalRedemptionAmount = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentQuantity6Choice) SetPercentageOfTotalRedemptionAmountFromDecimal(value decimal.Decimal) {
+	f.PercentageOfTotalRedemptionAmount = new(PercentageRate)
+	f.PercentageOfTotalRedemptionAmount.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentQuantity6Choice) SetNetAmount(value, currency string) {
 	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentQuantity6Choice) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentQuantity6Choice) SetGrossAmount(value, currency string) {
 	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (f *FinancialInstrumentQuantity6Choice) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Additional restrictions on the financial instrument, related to the stipulation.
 type FinancialInstrumentStipulations2 struct {
 
@@ -127,6 +133,10 @@ type FinancialInstrumentStipulations2 struct {
 	PerpetualIndicator *YesNoIndicator `xml:"PerptlInd,omitempty"`
 }
 
+func (f *FinancialInstrumentStipulations2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialInstrumentStipulations2) SetGeographics(value string) {
 	f.Geographics = (*Max35Text)(&value)
 }
@@ -150,6 +160,11 @@ func (f *FinancialInstrumentStipulations2) SetAmortisableIndicator(value string)
 	f.AmortisableIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetAmortisableIndicatorFromBool(value bool) {
+	f.AmortisableIndicator = new(YesNoIndicator)
+	f.AmortisableIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentStipulations2) SetPurpose(value string) {
 	f.Purpose = (*Max256Text)(&value)
 }
@@ -158,10 +173,20 @@ func (f *FinancialInstrumentStipulations2) SetAlternativeMinimumTaxIndicator(val
 	f.AlternativeMinimumTaxIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetAlternativeMinimumTaxIndicatorFromBool(value bool) {
+	f.AlternativeMinimumTaxIndicator = new(YesNoIndicator)
+	f.AlternativeMinimumTaxIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentStipulations2) SetAutoReinvestment(value string) {
 	f.AutoReinvestment = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetAutoReinvestmentFromDecimal(value decimal.Decimal) {
+	f.AutoReinvestment = new(PercentageRate)
+	f.AutoReinvestment.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentStipulations2) SetTransactionConditions(value string) {
 	f.TransactionConditions = (*TradeTransactionCondition2Code)(&value)
 }
@@ -179,14 +204,29 @@ func (f *FinancialInstrumentStipulations2) SetHaircut(value string) {
 	f.Haircut = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetHaircutFromDecimal(value decimal.Decimal) {
+	f.Haircut = new(PercentageRate)
+	f.Haircut.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentStipulations2) SetInsuredIndicator(value string) {
 	f.InsuredIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetInsuredIndicatorFromBool(value bool) {
+	f.InsuredIndicator = new(YesNoIndicator)
+	f.InsuredIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentStipulations2) SetLookBack(value string) {
 	f.LookBack = (*Number)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetLookBackFromDecimal(value decimal.Decimal) {
+	f.LookBack = new(Number)
+	f.LookBack.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentStipulations2) SetMaturityDate(value string) {
 	f.MaturityDate = (*ISOYearMonth)(&value)
 }
@@ -203,6 +243,11 @@ func (f *FinancialInstrumentStipulations2) SetIssueSize(value string) {
 	f.IssueSize = (*Number)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetIssueSizeFromDecimal(value decimal.Decimal) {
+	f.IssueSize = new(Number)
+	f.IssueSize.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentStipulations2) AddMinimumDenomination() *FinancialInstrumentQuantityChoice {
 	f.MinimumDenomination = new(FinancialInstrumentQuantityChoice)
 	return f.MinimumDenomination
@@ -212,6 +257,11 @@ func (f *FinancialInstrumentStipulations2) SetMaximumSubstitution(value string)
 	f.MaximumSubstitution = (*Number)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetMaximumSubstitutionFromDecimal(value decimal.Decimal) {
+	f.MaximumSubstitution = new(Number)
+	f.MaximumSubstitution.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentStipulations2) AddMinimumIncrement() *FinancialInstrumentQuantityChoice {
 	f.MinimumIncrement = new(FinancialInstrumentQuantityChoice)
 	return f.MinimumIncrement
@@ -234,6 +284,11 @@ func (f *FinancialInstrumentStipulations2) SetRestrictedIndicator(value string)
 	f.RestrictedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetRestrictedIndicatorFromBool(value bool) {
+	f.RestrictedIndicator = new(YesNoIndicator)
+	f.RestrictedIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentStipulations2) SetPriceFrequency(value string) {
 	f.PriceFrequency = (*Frequency1Code)(&value)
 }
@@ -250,10 +305,20 @@ func (f *FinancialInstrumentStipulations2) SetSubstitutionLeft(value string) {
 	f.SubstitutionLeft = (*Number)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetSubstitutionLeftFromDecimal(value decimal.Decimal) {
+	f.SubstitutionLeft = new(Number)
+	f.SubstitutionLeft.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentStipulations2) SetWholePoolIndicator(value string) {
 	f.WholePoolIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetWholePoolIndicatorFromBool(value bool) {
+	f.WholePoolIndicator = new(YesNoIndicator)
+	f.WholePoolIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentStipulations2) SetPriceSource(value string) {
 	f.PriceSource = (*Max35Text)(&value)
 }
@@ -262,14 +327,28 @@ func (f *FinancialInstrumentStipulations2) SetExpirationDate(value string) {
 	f.ExpirationDate = (*ISODateTime)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetExpirationDateFromTime(value time.Time) {
+	f.ExpirationDate = new(ISODateTime)
+	f.ExpirationDate.FromTime(value)
+}
+
 func (f *FinancialInstrumentStipulations2) SetOverAllotmentAmount(value, currency string) {
 	f.OverAllotmentAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialInstrumentStipulations2) SetOverAllotmentAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.OverAllotmentAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialInstrumentStipulations2) SetOverAllotmentRate(value string) {
 	f.OverAllotmentRate = (*PercentageRate)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetOverAllotmentRateFromDecimal(value decimal.Decimal) {
+	f.OverAllotmentRate = new(PercentageRate)
+	f.OverAllotmentRate.FromDecimal(value)
+}
+
 func (f *FinancialInstrumentStipulations2) AddPriceRange() *AmountOrPercentageRange {
 	f.PriceRange = new(AmountOrPercentageRange)
 	return f.PriceRange
@@ -279,22 +358,52 @@ func (f *FinancialInstrumentStipulations2) SetCallableIndicator(value string) {
 	f.CallableIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetCallableIndicatorFromBool(value bool) {
+	f.CallableIndicator = new(YesNoIndicator)
+	f.CallableIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentStipulations2) SetConvertibleIndicator(value string) {
 	f.ConvertibleIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetConvertibleIndicatorFromBool(value bool) {
+	f.ConvertibleIndicator = new(YesNoIndicator)
+	f.ConvertibleIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentStipulations2) SetPutableIndicator(value string) {
 	f.PutableIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetPutableIndicatorFromBool(value bool) {
+	f.PutableIndicator = new(YesNoIndicator)
+	f.PutableIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentStipulations2) SetPreFundedIndicator(value string) {
 	f.PreFundedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetPreFundedIndicatorFromBool(value bool) {
+	f.PreFundedIndicator = new(YesNoIndicator)
+	f.PreFundedIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentStipulations2) SetEscrowedIndicator(value string) {
 	f.EscrowedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancialInstrumentStipulations2) SetEscrowedIndicatorFromBool(value bool) {
+	f.EscrowedIndicator = new(YesNoIndicator)
+	f.EscrowedIndicator.FromBool(value)
+}
+
 func (f *FinancialInstrumentStipulations2) SetPerpetualIndicator(value string) {
 	f.PerpetualIndicator = (*YesNoIndicator)(&value)
 }
+
+func (f *FinancialInstrumentStipulations2) SetPerpetualIndicatorFromBool(value bool) {
+	f.PerpetualIndicator = new(YesNoIndicator)
+	f.PerpetualIndicator.FromBool(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies information about a financing relation between two parties represented by a document, for example invoice, credit.
 // The component may include an external document describing details of the underlying trade object using an external schema.
 type FinancialItem1 struct {
@@ -38,6 +42,10 @@ type FinancialItem1 struct {
 	ProprietaryDetails *SupplementaryData1 `xml:"PrtryDtls,omitempty"`
 }
 
+func (f *FinancialItem1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialItem1) AddItemContext() *FinancialItemParameters1 {
 	f.ItemContext = new(FinancialItemParameters1)
 	return f.ItemContext
@@ -189,8 +197,12 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 		XchgRate:               doc.XchgRate.BaseOneRate,                                  // "<XchgRate>"
 	}
 
-func (f *FinancialItem1) SetCreditDebitIndicator(value string) {
+func (f *FinancialItem1) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	f.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (f *FinancialItem1) AddTotalAmount() *InvoiceTotals1 {
@@ -202,6 +214,10 @@ func (f *FinancialItem1) SetDueAmount(value, currency string) {
 	f.DueAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancialItem1) SetDueAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.DueAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancialItem1) AddInstalmentInformation() *Instalment2 {
 	newValue := new(Instalment2)
 	f.InstalmentInformation = append(f.InstalmentInformation, newValue)
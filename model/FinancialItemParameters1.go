@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Regroups identification parameters for trade items.
 type FinancialItemParameters1 struct {
 
@@ -55,6 +59,10 @@ type FinancialItemParameters1 struct {
 	TradeMarket *TradeMarket1Choice `xml:"TradMkt,omitempty"`
 }
 
+func (f *FinancialItemParameters1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancialItemParameters1) SetIdentifier(value string) {
 	f.Identifier = (*Max35Text)(&value)
 }
@@ -63,6 +71,11 @@ func (f *FinancialItemParameters1) SetIssueDate(value string) {
 	f.IssueDate = (*ISODate)(&value)
 }
 
+func (f *FinancialItemParameters1) SetIssueDateFromTime(value time.Time) {
+	f.IssueDate = new(ISODate)
+	f.IssueDate.FromTime(value)
+}
+
 func (f *FinancialItemParameters1) AddRelatedItem() *QualifiedDocumentInformation1 {
 	newValue := new(QualifiedDocumentInformation1)
 	f.RelatedItem = append(f.RelatedItem, newValue)
@@ -37,6 +37,10 @@ type FinancingAgreementItem1 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (f *FinancingAgreementItem1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancingAgreementItem1) AddItemContext() *FinancialItemParameters1 {
 	f.ItemContext = new(FinancialItemParameters1)
 	return f.ItemContext
@@ -59,10 +63,20 @@ func (f *FinancingAgreementItem1) SetRating(value string) {
 	f.Rating = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancingAgreementItem1) SetRatingFromBool(value bool) {
+	f.Rating = new(YesNoIndicator)
+	f.Rating.FromBool(value)
+}
+
 func (f *FinancingAgreementItem1) SetReopenIndication(value string) {
 	f.ReopenIndication = (*YesNoIndicator)(&value)
 }
 
+func (f *FinancingAgreementItem1) SetReopenIndicationFromBool(value bool) {
+	f.ReopenIndication = new(YesNoIndicator)
+	f.ReopenIndication.FromBool(value)
+}
+
 func (f *FinancingAgreementItem1) AddGuarantee() *GuaranteeDetails1 {
 	newValue := new(GuaranteeDetails1)
 	f.Guarantee = append(f.Guarantee, newValue)
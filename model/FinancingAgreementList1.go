@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Defines a list of party management registration and guarantee requests.
 type FinancingAgreementList1 struct {
 
@@ -46,6 +52,10 @@ type FinancingAgreementList1 struct {
 	ValidationStatusInformation *ValidationStatusInformation1 `xml:"VldtnStsInf,omitempty"`
 }
 
+func (f *FinancingAgreementList1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancingAgreementList1) SetIdentifier(value string) {
 	f.Identifier = (*Max35Text)(&value)
 }
@@ -54,6 +64,11 @@ func (f *FinancingAgreementList1) SetDate(value string) {
 	f.Date = (*ISODate)(&value)
 }
 
+func (f *FinancingAgreementList1) SetDateFromTime(value time.Time) {
+	f.Date = new(ISODate)
+	f.Date.FromTime(value)
+}
+
 func (f *FinancingAgreementList1) AddRelatedDocument() *QualifiedDocumentInformation1 {
 	newValue := new(QualifiedDocumentInformation1)
 	f.RelatedDocument = append(f.RelatedDocument, newValue)
@@ -525,6 +540,11 @@ func (f *FinancingAgreementList1) SetControlSum(value string) {
 	f.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (f *FinancingAgreementList1) SetControlSumFromDecimal(value decimal.Decimal) {
+	f.ControlSum = new(DecimalNumber)
+	f.ControlSum.FromDecimal(value)
+}
+
 func (f *FinancingAgreementList1) SetAdditionalInformation(value string) {
 	f.AdditionalInformation = (*Max2000Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Summary information about amount financed.
 type FinancingAllowedSummary1 struct {
 
@@ -25,22 +29,44 @@ type FinancingAllowedSummary1 struct {
 	FinancingAccount *CashAccount7 `xml:"FincgAcct,omitempty"`
 }
 
+func (f *FinancingAllowedSummary1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancingAllowedSummary1) SetFinancedItemNumber(value string) {
 	f.FinancedItemNumber = (*Number)(&value)
 }
 
+func (f *FinancingAllowedSummary1) SetFinancedItemNumberFromDecimal(value decimal.Decimal) {
+	f.FinancedItemNumber = new(Number)
+	f.FinancedItemNumber.FromDecimal(value)
+}
+
 func (f *FinancingAllowedSummary1) SetTotalAcceptedItemsAmount(value, currency string) {
 	f.TotalAcceptedItemsAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancingAllowedSummary1) SetTotalAcceptedItemsAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalAcceptedItemsAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancingAllowedSummary1) SetAppliedPercentage(value string) {
 	f.AppliedPercentage = (*PercentageRate)(&value)
 }
 
+func (f *FinancingAllowedSummary1) SetAppliedPercentageFromDecimal(value decimal.Decimal) {
+	f.AppliedPercentage = new(PercentageRate)
+	f.AppliedPercentage.FromDecimal(value)
+}
+
 func (f *FinancingAllowedSummary1) SetTotalFinancedAmount(value, currency string) {
 	f.TotalFinancedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancingAllowedSummary1) SetTotalFinancedAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalFinancedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancingAllowedSummary1) AddFinancingDateDetails() *FinancingDateDetails1 {
 	f.FinancingDateDetails = new(FinancingDateDetails1)
 	return f.FinancingDateDetails
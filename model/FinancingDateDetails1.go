@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Includes a set of dates (e.g. credit date) related to settlement of the financing amount.
 type FinancingDateDetails1 struct {
 
@@ -13,6 +17,10 @@ type FinancingDateDetails1 struct {
 	DebitDate *ISODate `xml:"DbtDt,omitempty"`
 }
 
+func (f *FinancingDateDetails1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancingDateDetails1) AddBookDate(value string) {
 	f.BookDate = append(f.BookDate, (*ISODate)(&value))
 }
@@ -21,6 +29,16 @@ func (f *FinancingDateDetails1) SetCreditDate(value string) {
 	f.CreditDate = (*ISODate)(&value)
 }
 
+func (f *FinancingDateDetails1) SetCreditDateFromTime(value time.Time) {
+	f.CreditDate = new(ISODate)
+	f.CreditDate.FromTime(value)
+}
+
 func (f *FinancingDateDetails1) SetDebitDate(value string) {
 	f.DebitDate = (*ISODate)(&value)
 }
+
+func (f *FinancingDateDetails1) SetDebitDateFromTime(value time.Time) {
+	f.DebitDate = new(ISODate)
+	f.DebitDate.FromTime(value)
+}
@@ -10,6 +10,10 @@ type FinancingInformationAndStatus1 struct {
 	InvoiceFinancingDetails []*InvoiceFinancingDetails1 `xml:"InvcFincgDtls"`
 }
 
+func (f *FinancingInformationAndStatus1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancingInformationAndStatus1) AddFinancingAllowedSummary() *FinancingAllowedSummary1 {
 	f.FinancingAllowedSummary = new(FinancingAllowedSummary1)
 	return f.FinancingAllowedSummary
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies a list of financing items exchanged between two parties, for example invoice, credit, financing request.
 type FinancingItemList1 struct {
 
@@ -55,6 +61,10 @@ type FinancingItemList1 struct {
 	FinancingStatus *FinancingInformationAndStatus1 `xml:"FincgSts,omitempty"`
 }
 
+func (f *FinancingItemList1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancingItemList1) SetIdentifier(value string) {
 	f.Identifier = (*Max35Text)(&value)
 }
@@ -63,6 +73,11 @@ func (f *FinancingItemList1) SetIssueDate(value string) {
 	f.IssueDate = (*ISODate)(&value)
 }
 
+func (f *FinancingItemList1) SetIssueDateFromTime(value time.Time) {
+	f.IssueDate = new(ISODate)
+	f.IssueDate.FromTime(value)
+}
+
 func (f *FinancingItemList1) AddRelatedDocument() *QualifiedDocumentInformation1 {
 	newValue := new(QualifiedDocumentInformation1)
 	f.RelatedDocument = append(f.RelatedDocument, newValue)
@@ -213,6 +228,11 @@ func (f *FinancingItemList1) SetAmountCutOffDate(value string) {
 	f.AmountCutOffDate = (*ISODate)(&value)
 }
 
+func (f *FinancingItemList1) SetAmountCutOffDateFromTime(value time.Time) {
+	f.AmountCutOffDate = new(ISODate)
+	f.AmountCutOffDate.FromTime(value)
+}
+
 func (f *FinancingItemList1) AddAssignee() *QualifiedPartyIdentification1 {
 	f.Assignee = new(QualifiedPartyIdentification1)
 	return f.Assignee
@@ -523,10 +543,19 @@ func (f *FinancingItemList1) SetControlSum(value string) {
 	f.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (f *FinancingItemList1) SetControlSumFromDecimal(value decimal.Decimal) {
+	f.ControlSum = new(DecimalNumber)
+	f.ControlSum.FromDecimal(value)
+}
+
 func (f *FinancingItemList1) SetTotalRequestAmount(value, currency string) {
 	f.TotalRequestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancingItemList1) SetTotalRequestAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalRequestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancingItemList1) AddTotalRequestFinancing() *FinancingRateOrAmountChoice {
 	f.TotalRequestFinancing = new(FinancingRateOrAmountChoice)
 	return f.TotalRequestFinancing
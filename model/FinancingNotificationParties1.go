@@ -13,6 +13,10 @@ type FinancingNotificationParties1 struct {
 	AcknowledgementReceiver []*QualifiedPartyIdentification1 `xml:"AckRcvr,omitempty"`
 }
 
+func (f *FinancingNotificationParties1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancingNotificationParties1) AddNotifyingParty() *QualifiedPartyIdentification1 {
 	f.NotifyingParty = new(QualifiedPartyIdentification1)
 	return f.NotifyingParty
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between amount expressed as an absolute value or as a percentage rate related to another reference amount.
 type FinancingRateOrAmountChoice struct {
 
@@ -10,10 +14,23 @@ type FinancingRateOrAmountChoice struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (f *FinancingRateOrAmountChoice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancingRateOrAmountChoice) SetAmount(value, currency string) {
 	f.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FinancingRateOrAmountChoice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FinancingRateOrAmountChoice) SetRate(value string) {
 	f.Rate = (*PercentageRate)(&value)
 }
+
+func (f *FinancingRateOrAmountChoice) SetRateFromDecimal(value decimal.Decimal) {
+	f.Rate = new(PercentageRate)
+	f.Rate.FromDecimal(value)
+}
@@ -16,6 +16,10 @@ type FinancingResult1 struct {
 	FinancedAmount *FinancingRateOrAmountChoice `xml:"FincdAmt,omitempty"`
 }
 
+func (f *FinancingResult1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FinancingResult1) SetFinancingRequestStatus(value string) {
 	f.FinancingRequestStatus = (*RequestStatus1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice of start date and end date for the fiscal year.
 type FiscalYear1Choice struct {
 
@@ -10,10 +14,24 @@ type FiscalYear1Choice struct {
 	EndDate *ISODate `xml:"EndDt"`
 }
 
+func (f *FiscalYear1Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FiscalYear1Choice) SetStartDate(value string) {
 	f.StartDate = (*ISODate)(&value)
 }
 
+func (f *FiscalYear1Choice) SetStartDateFromTime(value time.Time) {
+	f.StartDate = new(ISODate)
+	f.StartDate.FromTime(value)
+}
+
 func (f *FiscalYear1Choice) SetEndDate(value string) {
 	f.EndDate = (*ISODate)(&value)
 }
+
+func (f *FiscalYear1Choice) SetEndDateFromTime(value time.Time) {
+	f.EndDate = new(ISODate)
+	f.EndDate.FromTime(value)
+}
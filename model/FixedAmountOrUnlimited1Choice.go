@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a fixed amount and an unlimited amount.
 type FixedAmountOrUnlimited1Choice struct {
 
@@ -10,10 +14,18 @@ type FixedAmountOrUnlimited1Choice struct {
 	NotLimited *Unlimited9Text `xml:"NotLtd"`
 }
 
+func (f *FixedAmountOrUnlimited1Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FixedAmountOrUnlimited1Choice) SetAmount(value, currency string) {
 	f.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FixedAmountOrUnlimited1Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FixedAmountOrUnlimited1Choice) SetNotLimited(value string) {
 	f.NotLimited = (*Unlimited9Text)(&value)
 }
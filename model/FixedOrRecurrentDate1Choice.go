@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice between a fixed date and a recurring date.
 type FixedOrRecurrentDate1Choice struct {
 
@@ -10,10 +14,19 @@ type FixedOrRecurrentDate1Choice struct {
 	RecurrentDate *DateInformation1 `xml:"RcrntDt"`
 }
 
+func (f *FixedOrRecurrentDate1Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FixedOrRecurrentDate1Choice) SetFixedDate(value string) {
 	f.FixedDate = (*ISODate)(&value)
 }
 
+func (f *FixedOrRecurrentDate1Choice) SetFixedDateFromTime(value time.Time) {
+	f.FixedDate = new(ISODate)
+	f.FixedDate.FromTime(value)
+}
+
 func (f *FixedOrRecurrentDate1Choice) AddRecurrentDate() *DateInformation1 {
 	f.RecurrentDate = new(DateInformation1)
 	return f.RecurrentDate
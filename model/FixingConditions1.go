@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the additional information for an NDF as supplied on a fixing instruction.
 type FixingConditions1 struct {
 
@@ -25,10 +31,19 @@ type FixingConditions1 struct {
 	ExchangeRate *BaseOneRate `xml:"XchgRate"`
 }
 
+func (f *FixingConditions1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FixingConditions1) SetTradeDate(value string) {
 	f.TradeDate = (*ISODate)(&value)
 }
 
+func (f *FixingConditions1) SetTradeDateFromTime(value time.Time) {
+	f.TradeDate = new(ISODate)
+	f.TradeDate.FromTime(value)
+}
+
 func (f *FixingConditions1) SetOriginatorReference(value string) {
 	f.OriginatorReference = (*Max35Text)(&value)
 }
@@ -45,10 +60,23 @@ func (f *FixingConditions1) SetTradingSideBuyAmount(value, currency string) {
 	f.TradingSideBuyAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FixingConditions1) SetTradingSideBuyAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.TradingSideBuyAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FixingConditions1) SetTradingSideSellAmount(value, currency string) {
 	f.TradingSideSellAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FixingConditions1) SetTradingSideSellAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.TradingSideSellAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FixingConditions1) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
+
+func (f *FixingConditions1) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
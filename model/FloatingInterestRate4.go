@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the index used to define the rate and optionally the basis point spread.
 type FloatingInterestRate4 struct {
 
@@ -18,6 +22,10 @@ type FloatingInterestRate4 struct {
 	BasisPointSpread *Number `xml:"BsisPtSprd"`
 }
 
+func (f *FloatingInterestRate4) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FloatingInterestRate4) AddReferenceRate() *BenchmarkCurveName4Choice {
 	f.ReferenceRate = new(BenchmarkCurveName4Choice)
 	return f.ReferenceRate
@@ -31,3 +39,8 @@ func (f *FloatingInterestRate4) AddTerm() *InterestRateContractTerm1 {
 func (f *FloatingInterestRate4) SetBasisPointSpread(value string) {
 	f.BasisPointSpread = (*Number)(&value)
 }
+
+func (f *FloatingInterestRate4) SetBasisPointSpreadFromDecimal(value decimal.Decimal) {
+	f.BasisPointSpread = new(Number)
+	f.BasisPointSpread.FromDecimal(value)
+}
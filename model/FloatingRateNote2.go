@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the details of a debt instrument in which the periodic interest payments are calculated on the basis of the value (that is, fixing of an underlying reference rate such as the Euribor) on predefined dates (that is, fixing dates) and which has a maturity of no more than one year.
 type FloatingRateNote2 struct {
 
@@ -12,6 +16,10 @@ type FloatingRateNote2 struct {
 	BasisPointSpread *Number `xml:"BsisPtSprd"`
 }
 
+func (f *FloatingRateNote2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FloatingRateNote2) SetReferenceRateIndex(value string) {
 	f.ReferenceRateIndex = (*ISINOct2015Identifier)(&value)
 }
@@ -19,3 +27,8 @@ func (f *FloatingRateNote2) SetReferenceRateIndex(value string) {
 func (f *FloatingRateNote2) SetBasisPointSpread(value string) {
 	f.BasisPointSpread = (*Number)(&value)
 }
+
+func (f *FloatingRateNote2) SetBasisPointSpreadFromDecimal(value decimal.Decimal) {
+	f.BasisPointSpread = new(Number)
+	f.BasisPointSpread.FromDecimal(value)
+}
@@ -16,6 +16,10 @@ type ForecastParameter1 struct {
 	EstimatedNetCashForecastDetails []*NetCashForecast1 `xml:"EstmtdNetCshFcstDtls,omitempty"`
 }
 
+func (f *ForecastParameter1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForecastParameter1) AddReportParameter() *ReportParameter2Choice {
 	f.ReportParameter = new(ReportParameter2Choice)
 	return f.ReportParameter
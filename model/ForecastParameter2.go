@@ -16,6 +16,10 @@ type ForecastParameter2 struct {
 	NetCashForecastDetails []*NetCashForecast1 `xml:"NetCshFcstDtls,omitempty"`
 }
 
+func (f *ForecastParameter2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForecastParameter2) AddReportParameter() *ReportParameter2Choice {
 	f.ReportParameter = new(ReportParameter2Choice)
 	return f.ReportParameter
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the details of the foreign exchange.
 type ForeignExchange1 struct {
 
@@ -15,6 +19,10 @@ type ForeignExchange1 struct {
 	ExchangeForwardPoint *DecimalNumber `xml:"XchgFwdPt"`
 }
 
+func (f *ForeignExchange1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchange1) SetForeignCurrency(value string) {
 	f.ForeignCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -23,6 +31,16 @@ func (f *ForeignExchange1) SetExchangeSpotRate(value string) {
 	f.ExchangeSpotRate = (*BaseOneRate)(&value)
 }
 
+func (f *ForeignExchange1) SetExchangeSpotRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeSpotRate = new(BaseOneRate)
+	f.ExchangeSpotRate.FromDecimal(value)
+}
+
 func (f *ForeignExchange1) SetExchangeForwardPoint(value string) {
 	f.ExchangeForwardPoint = (*DecimalNumber)(&value)
 }
+
+func (f *ForeignExchange1) SetExchangeForwardPointFromDecimal(value decimal.Decimal) {
+	f.ExchangeForwardPoint = new(DecimalNumber)
+	f.ExchangeForwardPoint.FromDecimal(value)
+}
@@ -10,6 +10,10 @@ type ForeignExchangeSwap2Choice struct {
 	Transaction []*ForeignExchangeSwapTransaction2 `xml:"Tx"`
 }
 
+func (f *ForeignExchangeSwap2Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeSwap2Choice) SetDataSetAction(value string) {
 	f.DataSetAction = (*ReportPeriodActivity1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the details of each individual foreign exchange swap transaction.
 type ForeignExchangeSwapTransaction2 struct {
 
@@ -52,6 +58,10 @@ type ForeignExchangeSwapTransaction2 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *ForeignExchangeSwapTransaction2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeSwapTransaction2) SetReportedTransactionStatus(value string) {
 	f.ReportedTransactionStatus = (*TransactionOperationType1Code)(&value)
 }
@@ -86,10 +96,20 @@ func (f *ForeignExchangeSwapTransaction2) SetSpotValueDate(value string) {
 	f.SpotValueDate = (*ISODate)(&value)
 }
 
+func (f *ForeignExchangeSwapTransaction2) SetSpotValueDateFromTime(value time.Time) {
+	f.SpotValueDate = new(ISODate)
+	f.SpotValueDate.FromTime(value)
+}
+
 func (f *ForeignExchangeSwapTransaction2) SetMaturityDate(value string) {
 	f.MaturityDate = (*ISODate)(&value)
 }
 
+func (f *ForeignExchangeSwapTransaction2) SetMaturityDateFromTime(value time.Time) {
+	f.MaturityDate = new(ISODate)
+	f.MaturityDate.FromTime(value)
+}
+
 func (f *ForeignExchangeSwapTransaction2) SetTransactionType(value string) {
 	f.TransactionType = (*SecuritiesTransactionType15Code)(&value)
 }
@@ -98,6 +118,10 @@ func (f *ForeignExchangeSwapTransaction2) SetTransactionNominalAmount(value, cur
 	f.TransactionNominalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *ForeignExchangeSwapTransaction2) SetTransactionNominalAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.TransactionNominalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *ForeignExchangeSwapTransaction2) AddForeignExchange() *ForeignExchange1 {
 	f.ForeignExchange = new(ForeignExchange1)
 	return f.ForeignExchange
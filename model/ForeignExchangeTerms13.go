@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the terms of the foreign exchange transaction.
 type ForeignExchangeTerms13 struct {
 
@@ -17,6 +21,10 @@ type ForeignExchangeTerms13 struct {
 	ResultingAmount *ActiveCurrencyAndAmount `xml:"RsltgAmt,omitempty"`
 }
 
+func (f *ForeignExchangeTerms13) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeTerms13) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -29,6 +37,15 @@ func (f *ForeignExchangeTerms13) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (f *ForeignExchangeTerms13) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
+
 func (f *ForeignExchangeTerms13) SetResultingAmount(value, currency string) {
 	f.ResultingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (f *ForeignExchangeTerms13) SetResultingAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.ResultingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information needed to process a currency exchange or conversion.
 type ForeignExchangeTerms14 struct {
 
@@ -19,6 +25,10 @@ type ForeignExchangeTerms14 struct {
 	QuotingInstitution *PartyIdentification49Choice `xml:"QtgInstn,omitempty"`
 }
 
+func (f *ForeignExchangeTerms14) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeTerms14) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -31,10 +41,20 @@ func (f *ForeignExchangeTerms14) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (f *ForeignExchangeTerms14) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
+
 func (f *ForeignExchangeTerms14) SetQuotationDate(value string) {
 	f.QuotationDate = (*ISODateTime)(&value)
 }
 
+func (f *ForeignExchangeTerms14) SetQuotationDateFromTime(value time.Time) {
+	f.QuotationDate = new(ISODateTime)
+	f.QuotationDate.FromTime(value)
+}
+
 func (f *ForeignExchangeTerms14) AddQuotingInstitution() *PartyIdentification49Choice {
 	f.QuotingInstitution = new(PartyIdentification49Choice)
 	return f.QuotingInstitution
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the terms of the foreign exchange transaction.
 type ForeignExchangeTerms18 struct {
 
@@ -17,6 +21,10 @@ type ForeignExchangeTerms18 struct {
 	ConvertedAmount *ActiveCurrencyAndAmount `xml:"ConvtdAmt"`
 }
 
+func (f *ForeignExchangeTerms18) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeTerms18) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -29,6 +37,15 @@ func (f *ForeignExchangeTerms18) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (f *ForeignExchangeTerms18) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
+
 func (f *ForeignExchangeTerms18) SetConvertedAmount(value, currency string) {
 	f.ConvertedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (f *ForeignExchangeTerms18) SetConvertedAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.ConvertedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the terms of the foreign exchange transaction.
 type ForeignExchangeTerms19 struct {
 
@@ -14,6 +18,10 @@ type ForeignExchangeTerms19 struct {
 	ExchangeRate *BaseOneRate `xml:"XchgRate"`
 }
 
+func (f *ForeignExchangeTerms19) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeTerms19) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -25,3 +33,8 @@ func (f *ForeignExchangeTerms19) SetQuotedCurrency(value string) {
 func (f *ForeignExchangeTerms19) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
+
+func (f *ForeignExchangeTerms19) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information needed to process a currency exchange or conversion.
 type ForeignExchangeTerms22 struct {
 
@@ -19,6 +25,10 @@ type ForeignExchangeTerms22 struct {
 	QuotingInstitution *PartyIdentification71Choice `xml:"QtgInstn,omitempty"`
 }
 
+func (f *ForeignExchangeTerms22) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeTerms22) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -31,10 +41,20 @@ func (f *ForeignExchangeTerms22) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (f *ForeignExchangeTerms22) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
+
 func (f *ForeignExchangeTerms22) SetQuotationDate(value string) {
 	f.QuotationDate = (*ISODateTime)(&value)
 }
 
+func (f *ForeignExchangeTerms22) SetQuotationDateFromTime(value time.Time) {
+	f.QuotationDate = new(ISODateTime)
+	f.QuotationDate.FromTime(value)
+}
+
 func (f *ForeignExchangeTerms22) AddQuotingInstitution() *PartyIdentification71Choice {
 	f.QuotingInstitution = new(PartyIdentification71Choice)
 	return f.QuotingInstitution
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the terms of the foreign exchange transaction.
 type ForeignExchangeTerms23 struct {
 
@@ -17,6 +21,10 @@ type ForeignExchangeTerms23 struct {
 	ResultingAmount *ActiveCurrencyAndAmount `xml:"RsltgAmt"`
 }
 
+func (f *ForeignExchangeTerms23) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeTerms23) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -29,6 +37,15 @@ func (f *ForeignExchangeTerms23) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (f *ForeignExchangeTerms23) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
+
 func (f *ForeignExchangeTerms23) SetResultingAmount(value, currency string) {
 	f.ResultingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (f *ForeignExchangeTerms23) SetResultingAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.ResultingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the terms of the foreign exchange transaction.
 type ForeignExchangeTerms27 struct {
 
@@ -17,6 +21,10 @@ type ForeignExchangeTerms27 struct {
 	ResultingAmount *RestrictedFINActiveCurrencyAndAmount `xml:"RsltgAmt"`
 }
 
+func (f *ForeignExchangeTerms27) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeTerms27) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -29,6 +37,15 @@ func (f *ForeignExchangeTerms27) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (f *ForeignExchangeTerms27) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
+
 func (f *ForeignExchangeTerms27) SetResultingAmount(value, currency string) {
 	f.ResultingAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
+
+func (f *ForeignExchangeTerms27) SetResultingAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.ResultingAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
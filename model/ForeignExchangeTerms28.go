@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the terms of the foreign exchange transaction.
 type ForeignExchangeTerms28 struct {
 
@@ -17,6 +21,10 @@ type ForeignExchangeTerms28 struct {
 	ResultingAmount *RestrictedFINActiveCurrencyAndAmount `xml:"RsltgAmt,omitempty"`
 }
 
+func (f *ForeignExchangeTerms28) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeTerms28) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -29,6 +37,15 @@ func (f *ForeignExchangeTerms28) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (f *ForeignExchangeTerms28) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
+
 func (f *ForeignExchangeTerms28) SetResultingAmount(value, currency string) {
 	f.ResultingAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
+
+func (f *ForeignExchangeTerms28) SetResultingAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.ResultingAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information needed to process a currency exchange or conversion.
 type ForeignExchangeTerms3 struct {
 
@@ -19,6 +25,10 @@ type ForeignExchangeTerms3 struct {
 	QuotingInstitution *PartyIdentification1Choice `xml:"QtgInstn,omitempty"`
 }
 
+func (f *ForeignExchangeTerms3) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeTerms3) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*CurrencyCode)(&value)
 }
@@ -31,10 +41,20 @@ func (f *ForeignExchangeTerms3) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (f *ForeignExchangeTerms3) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
+
 func (f *ForeignExchangeTerms3) SetQuotationDate(value string) {
 	f.QuotationDate = (*ISODateTime)(&value)
 }
 
+func (f *ForeignExchangeTerms3) SetQuotationDateFromTime(value time.Time) {
+	f.QuotationDate = new(ISODateTime)
+	f.QuotationDate.FromTime(value)
+}
+
 func (f *ForeignExchangeTerms3) AddQuotingInstitution() *PartyIdentification1Choice {
 	f.QuotingInstitution = new(PartyIdentification1Choice)
 	return f.QuotingInstitution
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information needed to process a currency exchange or conversion.
 type ForeignExchangeTerms31 struct {
 
@@ -19,6 +25,10 @@ type ForeignExchangeTerms31 struct {
 	QuotingInstitution *PartyIdentification104Choice `xml:"QtgInstn,omitempty"`
 }
 
+func (f *ForeignExchangeTerms31) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeTerms31) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -31,10 +41,20 @@ func (f *ForeignExchangeTerms31) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (f *ForeignExchangeTerms31) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
+
 func (f *ForeignExchangeTerms31) SetQuotationDate(value string) {
 	f.QuotationDate = (*ISODateTime)(&value)
 }
 
+func (f *ForeignExchangeTerms31) SetQuotationDateFromTime(value time.Time) {
+	f.QuotationDate = new(ISODateTime)
+	f.QuotationDate.FromTime(value)
+}
+
 func (f *ForeignExchangeTerms31) AddQuotingInstitution() *PartyIdentification104Choice {
 	f.QuotingInstitution = new(PartyIdentification104Choice)
 	return f.QuotingInstitution
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information needed to process a currency exchange or conversion.
 type ForeignExchangeTerms32 struct {
 
@@ -27,6 +33,10 @@ type ForeignExchangeTerms32 struct {
 	QuotingInstitution *PartyIdentification113 `xml:"QtgInstn,omitempty"`
 }
 
+func (f *ForeignExchangeTerms32) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeTerms32) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -39,10 +49,20 @@ func (f *ForeignExchangeTerms32) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (f *ForeignExchangeTerms32) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
+
 func (f *ForeignExchangeTerms32) SetQuotationDate(value string) {
 	f.QuotationDate = (*ISODateTime)(&value)
 }
 
+func (f *ForeignExchangeTerms32) SetQuotationDateFromTime(value time.Time) {
+	f.QuotationDate = new(ISODateTime)
+	f.QuotationDate.FromTime(value)
+}
+
 func (f *ForeignExchangeTerms32) AddQuotingInstitution() *PartyIdentification113 {
 	f.QuotingInstitution = new(PartyIdentification113)
 	return f.QuotingInstitution
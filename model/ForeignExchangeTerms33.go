@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information needed to process a currency exchange or conversion.
 type ForeignExchangeTerms33 struct {
 
@@ -33,14 +39,26 @@ type ForeignExchangeTerms33 struct {
 	QuotingInstitution *PartyIdentification113 `xml:"QtgInstn,omitempty"`
 }
 
+func (f *ForeignExchangeTerms33) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeTerms33) SetToAmount(value, currency string) {
 	f.ToAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *ForeignExchangeTerms33) SetToAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.ToAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *ForeignExchangeTerms33) SetFromAmount(value, currency string) {
 	f.FromAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *ForeignExchangeTerms33) SetFromAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.FromAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *ForeignExchangeTerms33) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -53,10 +71,20 @@ func (f *ForeignExchangeTerms33) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (f *ForeignExchangeTerms33) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
+
 func (f *ForeignExchangeTerms33) SetQuotationDate(value string) {
 	f.QuotationDate = (*ISODateTime)(&value)
 }
 
+func (f *ForeignExchangeTerms33) SetQuotationDateFromTime(value time.Time) {
+	f.QuotationDate = new(ISODateTime)
+	f.QuotationDate.FromTime(value)
+}
+
 func (f *ForeignExchangeTerms33) AddQuotingInstitution() *PartyIdentification113 {
 	f.QuotingInstitution = new(PartyIdentification113)
 	return f.QuotingInstitution
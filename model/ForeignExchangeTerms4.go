@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information needed to process a currency exchange or conversion.
 type ForeignExchangeTerms4 struct {
 
@@ -25,14 +31,26 @@ type ForeignExchangeTerms4 struct {
 	QuotingInstitution *PartyIdentification2Choice `xml:"QtgInstn,omitempty"`
 }
 
+func (f *ForeignExchangeTerms4) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeTerms4) SetBuyAmount(value, currency string) {
 	f.BuyAmount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (f *ForeignExchangeTerms4) SetBuyAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.BuyAmount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (f *ForeignExchangeTerms4) SetSellAmount(value, currency string) {
 	f.SellAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *ForeignExchangeTerms4) SetSellAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.SellAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *ForeignExchangeTerms4) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*CurrencyCode)(&value)
 }
@@ -45,10 +63,20 @@ func (f *ForeignExchangeTerms4) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (f *ForeignExchangeTerms4) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
+
 func (f *ForeignExchangeTerms4) SetQuotationDate(value string) {
 	f.QuotationDate = (*ISODateTime)(&value)
 }
 
+func (f *ForeignExchangeTerms4) SetQuotationDateFromTime(value time.Time) {
+	f.QuotationDate = new(ISODateTime)
+	f.QuotationDate.FromTime(value)
+}
+
 func (f *ForeignExchangeTerms4) AddQuotingInstitution() *PartyIdentification2Choice {
 	f.QuotingInstitution = new(PartyIdentification2Choice)
 	return f.QuotingInstitution
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information needed to process a currency exchange or conversion.
 type ForeignExchangeTerms7 struct {
 
@@ -25,14 +31,26 @@ type ForeignExchangeTerms7 struct {
 	QuotingInstitution *PartyIdentification2Choice `xml:"QtgInstn,omitempty"`
 }
 
+func (f *ForeignExchangeTerms7) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeTerms7) SetToAmount(value, currency string) {
 	f.ToAmount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (f *ForeignExchangeTerms7) SetToAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.ToAmount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (f *ForeignExchangeTerms7) SetFromAmount(value, currency string) {
 	f.FromAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *ForeignExchangeTerms7) SetFromAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.FromAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *ForeignExchangeTerms7) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -45,10 +63,20 @@ func (f *ForeignExchangeTerms7) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (f *ForeignExchangeTerms7) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
+
 func (f *ForeignExchangeTerms7) SetQuotationDate(value string) {
 	f.QuotationDate = (*ISODateTime)(&value)
 }
 
+func (f *ForeignExchangeTerms7) SetQuotationDateFromTime(value time.Time) {
+	f.QuotationDate = new(ISODateTime)
+	f.QuotationDate.FromTime(value)
+}
+
 func (f *ForeignExchangeTerms7) AddQuotingInstitution() *PartyIdentification2Choice {
 	f.QuotingInstitution = new(PartyIdentification2Choice)
 	return f.QuotingInstitution
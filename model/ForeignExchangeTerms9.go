@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the terms of the foreign exchange transaction.
 type ForeignExchangeTerms9 struct {
 
@@ -21,6 +25,10 @@ type ForeignExchangeTerms9 struct {
 	OriginalAmount *ActiveCurrencyAndAmount `xml:"OrgnlAmt,omitempty"`
 }
 
+func (f *ForeignExchangeTerms9) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *ForeignExchangeTerms9) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -33,10 +41,23 @@ func (f *ForeignExchangeTerms9) SetExchangeRate(value string) {
 	f.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (f *ForeignExchangeTerms9) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	f.ExchangeRate = new(BaseOneRate)
+	f.ExchangeRate.FromDecimal(value)
+}
+
 func (f *ForeignExchangeTerms9) SetResultingAmount(value, currency string) {
 	f.ResultingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *ForeignExchangeTerms9) SetResultingAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.ResultingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *ForeignExchangeTerms9) SetOriginalAmount(value, currency string) {
 	f.OriginalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (f *ForeignExchangeTerms9) SetOriginalAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.OriginalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -10,6 +10,10 @@ type FormOfSecurity2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FormOfSecurity2Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FormOfSecurity2Choice) SetCode(value string) {
 	f.Code = (*FormOfSecurity1Code)(&value)
 }
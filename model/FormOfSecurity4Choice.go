@@ -10,6 +10,10 @@ type FormOfSecurity4Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (f *FormOfSecurity4Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FormOfSecurity4Choice) SetCode(value string) {
 	f.Code = (*FormOfSecurity1Code)(&value)
 }
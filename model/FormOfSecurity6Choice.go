@@ -10,6 +10,10 @@ type FormOfSecurity6Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (f *FormOfSecurity6Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FormOfSecurity6Choice) SetCode(value string) {
 	f.Code = (*FormOfSecurity1Code)(&value)
 }
@@ -10,6 +10,10 @@ type FormOfSecurity7Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *FormOfSecurity7Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FormOfSecurity7Choice) SetCode(value string) {
 	f.Code = (*FormOfSecurity1Code)(&value)
 }
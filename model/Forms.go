@@ -10,10 +10,19 @@ type Forms struct {
 	SignatureType *SignatureType1Code `xml:"SgntrTp"`
 }
 
+func (f *Forms) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Forms) SetApplicationForm(value string) {
 	f.ApplicationForm = (*YesNoIndicator)(&value)
 }
 
+func (f *Forms) SetApplicationFormFromBool(value bool) {
+	f.ApplicationForm = new(YesNoIndicator)
+	f.ApplicationForm.FromBool(value)
+}
+
 func (f *Forms) SetSignatureType(value string) {
 	f.SignatureType = (*SignatureType1Code)(&value)
 }
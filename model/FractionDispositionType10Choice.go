@@ -10,6 +10,10 @@ type FractionDispositionType10Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType10Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType10Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType6Code)(&value)
 }
@@ -10,6 +10,10 @@ type FractionDispositionType12Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType12Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType12Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType7Code)(&value)
 }
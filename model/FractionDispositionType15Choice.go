@@ -10,6 +10,10 @@ type FractionDispositionType15Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType15Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType15Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType9Code)(&value)
 }
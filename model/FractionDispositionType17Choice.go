@@ -10,6 +10,10 @@ type FractionDispositionType17Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType17Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType17Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType10Code)(&value)
 }
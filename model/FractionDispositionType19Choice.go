@@ -10,6 +10,10 @@ type FractionDispositionType19Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType19Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType19Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType8Code)(&value)
 }
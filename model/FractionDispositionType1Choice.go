@@ -10,6 +10,10 @@ type FractionDispositionType1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType1Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType1Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType4Code)(&value)
 }
@@ -10,6 +10,10 @@ type FractionDispositionType1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType1FormatChoice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType1FormatChoice) SetCode(value string) {
 	f.Code = (*FractionDispositionType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type FractionDispositionType23Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType23Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType23Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType11Code)(&value)
 }
@@ -10,6 +10,10 @@ type FractionDispositionType25Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType25Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType25Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType9Code)(&value)
 }
@@ -10,6 +10,10 @@ type FractionDispositionType26Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType26Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType26Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType8Code)(&value)
 }
@@ -10,6 +10,10 @@ type FractionDispositionType27Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType27Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType27Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType11Code)(&value)
 }
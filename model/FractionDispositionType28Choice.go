@@ -10,6 +10,10 @@ type FractionDispositionType28Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType28Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType28Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType10Code)(&value)
 }
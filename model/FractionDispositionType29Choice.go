@@ -10,6 +10,10 @@ type FractionDispositionType29Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType29Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType29Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType10Code)(&value)
 }
@@ -10,6 +10,10 @@ type FractionDispositionType2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType2Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType2Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type FractionDispositionType30Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType30Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType30Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType11Code)(&value)
 }
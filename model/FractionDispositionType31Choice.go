@@ -10,6 +10,10 @@ type FractionDispositionType31Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType31Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType31Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType8Code)(&value)
 }
@@ -10,6 +10,10 @@ type FractionDispositionType32Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType32Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType32Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType9Code)(&value)
 }
@@ -10,6 +10,10 @@ type FractionDispositionType3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType3Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType3Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type FractionDispositionType4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType4Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType4Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType1Code)(&value)
 }
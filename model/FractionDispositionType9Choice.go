@@ -10,6 +10,10 @@ type FractionDispositionType9Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *FractionDispositionType9Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FractionDispositionType9Choice) SetCode(value string) {
 	f.Code = (*FractionDispositionType5Code)(&value)
 }
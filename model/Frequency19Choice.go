@@ -10,6 +10,10 @@ type Frequency19Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *Frequency19Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Frequency19Choice) SetCode(value string) {
 	f.Code = (*EventFrequency1Code)(&value)
 }
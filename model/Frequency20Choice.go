@@ -10,6 +10,10 @@ type Frequency20Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *Frequency20Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Frequency20Choice) SetCode(value string) {
 	f.Code = (*EventFrequency8Code)(&value)
 }
@@ -10,8 +10,16 @@ type Frequency21Choice struct {
 	Period *FrequencyPeriod1 `xml:"Prd"`
 }
 
-func (f *Frequency21Choice) SetType(value string) {
+func (f *Frequency21Choice) Validate() error {
+	return ValidateElement(f)
+}
+
+func (f *Frequency21Choice) SetType(value string) error {
+	if err := Frequency6Code(value).Validate(); err != nil {
+		return err
+	}
 	f.Type = (*Frequency6Code)(&value)
+	return nil
 }
 
 func (f *Frequency21Choice) AddPeriod() *FrequencyPeriod1 {
@@ -10,6 +10,10 @@ type Frequency22Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (f *Frequency22Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Frequency22Choice) SetCode(value string) {
 	f.Code = (*EventFrequency7Code)(&value)
 }
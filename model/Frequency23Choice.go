@@ -10,6 +10,10 @@ type Frequency23Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (f *Frequency23Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Frequency23Choice) SetCode(value string) {
 	f.Code = (*EventFrequency3Code)(&value)
 }
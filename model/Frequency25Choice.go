@@ -10,6 +10,10 @@ type Frequency25Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (f *Frequency25Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Frequency25Choice) SetCode(value string) {
 	f.Code = (*EventFrequency4Code)(&value)
 }
@@ -10,6 +10,10 @@ type Frequency26Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *Frequency26Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Frequency26Choice) SetCode(value string) {
 	f.Code = (*EventFrequency4Code)(&value)
 }
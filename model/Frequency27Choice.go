@@ -10,6 +10,10 @@ type Frequency27Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *Frequency27Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Frequency27Choice) SetCode(value string) {
 	f.Code = (*EventFrequency3Code)(&value)
 }
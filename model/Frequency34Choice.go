@@ -10,6 +10,10 @@ type Frequency34Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *Frequency34Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Frequency34Choice) SetCode(value string) {
 	f.Code = (*EventFrequency7Code)(&value)
 }
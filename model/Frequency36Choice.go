@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Choice of format for a frequency, for example, the frequency of payment.
 type Frequency36Choice struct {
 
@@ -13,8 +15,16 @@ type Frequency36Choice struct {
 	PointInTime *FrequencyAndMoment1 `xml:"PtInTm"`
 }
 
-func (f *Frequency36Choice) SetType(value string) {
+func (f *Frequency36Choice) Validate() error {
+	return ValidateElement(f)
+}
+
+func (f *Frequency36Choice) SetType(value string) error {
+	if err := Frequency6Code(value).Validate(); err != nil {
+		return err
+	}
 	f.Type = (*Frequency6Code)(&value)
+	return nil
 }
 
 func (f *Frequency36Choice) AddPeriod() *FrequencyPeriod1 {
@@ -26,3 +36,53 @@ func (f *Frequency36Choice) AddPointInTime() *FrequencyAndMoment1 {
 	f.PointInTime = new(FrequencyAndMoment1)
 	return f.PointInTime
 }
+
+func (f *Frequency36Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if f.Type != nil {
+		enc.Element("Tp", string(*f.Type))
+	}
+	if f.Period != nil {
+		f.Period.EncodeXMLFast(enc, "Prd")
+	}
+	if f.PointInTime != nil {
+		f.PointInTime.EncodeXMLFast(enc, "PtInTm")
+	}
+	enc.End(tag)
+}
+
+func (f *Frequency36Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			f.Type = (*Frequency6Code)(&value)
+		case "Prd":
+			elem := new(FrequencyPeriod1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.Period = elem
+		case "PtInTm":
+			elem := new(FrequencyAndMoment1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.PointInTime = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
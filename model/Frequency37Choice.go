@@ -10,6 +10,10 @@ type Frequency37Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (f *Frequency37Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Frequency37Choice) SetCode(value string) {
 	f.Code = (*Frequency10Code)(&value)
 }
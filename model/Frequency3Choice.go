@@ -10,6 +10,10 @@ type Frequency3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *Frequency3Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Frequency3Choice) SetCode(value string) {
 	f.Code = (*EventFrequency3Code)(&value)
 }
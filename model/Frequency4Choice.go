@@ -10,6 +10,10 @@ type Frequency4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *Frequency4Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Frequency4Choice) SetCode(value string) {
 	f.Code = (*EventFrequency4Code)(&value)
 }
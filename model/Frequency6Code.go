@@ -1,3 +1,40 @@
 package model
 
 type Frequency6Code string
+
+const (
+	Frequency6CodeYEAR Frequency6Code = "YEAR"
+	Frequency6CodeMNTH Frequency6Code = "MNTH"
+	Frequency6CodeQURT Frequency6Code = "QURT"
+	Frequency6CodeMIAN Frequency6Code = "MIAN"
+	Frequency6CodeWEEK Frequency6Code = "WEEK"
+	Frequency6CodeDAIL Frequency6Code = "DAIL"
+	Frequency6CodeADHO Frequency6Code = "ADHO"
+	Frequency6CodeINDA Frequency6Code = "INDA"
+	Frequency6CodeFRTN Frequency6Code = "FRTN"
+)
+
+var frequency6CodeDescriptions = map[Frequency6Code]string{
+	Frequency6CodeYEAR: "Annual: event takes place every year or once a year.",
+	Frequency6CodeMNTH: "Monthly: event takes place every month or once a month.",
+	Frequency6CodeQURT: "Quarterly: event takes place every three months or four times a year.",
+	Frequency6CodeMIAN: "Semi annual: event takes place every six months or two times a year.",
+	Frequency6CodeWEEK: "Weekly: event takes place once a week.",
+	Frequency6CodeDAIL: "Daily: event takes place every day.",
+	Frequency6CodeADHO: "Adhoc: event takes place on request or as necessary.",
+	Frequency6CodeINDA: "Intra day: event takes place several times a day.",
+	Frequency6CodeFRTN: "Fortnightly: event takes place every two weeks.",
+}
+
+func (f Frequency6Code) IsValid() bool {
+	_, ok := frequency6CodeDescriptions[f]
+	return ok
+}
+
+func (f Frequency6Code) Description() string {
+	return frequency6CodeDescriptions[f]
+}
+
+func (f Frequency6Code) Validate() error {
+	return validateEnumeration("Frequency6Code", string(f), f.IsValid(), "YEAR, MNTH, QURT, MIAN, WEEK, DAIL, ADHO, INDA, FRTN")
+}
@@ -10,6 +10,10 @@ type Frequency7Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (f *Frequency7Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Frequency7Choice) SetCode(value string) {
 	f.Code = (*EventFrequency3Code)(&value)
 }
@@ -10,6 +10,10 @@ type Frequency8Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (f *Frequency8Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Frequency8Choice) SetCode(value string) {
 	f.Code = (*EventFrequency1Code)(&value)
 }
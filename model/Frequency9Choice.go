@@ -10,6 +10,10 @@ type Frequency9Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (f *Frequency9Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Frequency9Choice) SetCode(value string) {
 	f.Code = (*EventFrequency7Code)(&value)
 }
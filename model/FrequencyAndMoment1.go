@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Defines a frequency in terms a specific moment within a specified period type.
 type FrequencyAndMoment1 struct {
 
@@ -10,10 +12,59 @@ type FrequencyAndMoment1 struct {
 	PointInTime *Exact2NumericText `xml:"PtInTm"`
 }
 
-func (f *FrequencyAndMoment1) SetType(value string) {
+func (f *FrequencyAndMoment1) Validate() error {
+	return ValidateElement(f)
+}
+
+func (f *FrequencyAndMoment1) SetType(value string) error {
+	if err := Frequency6Code(value).Validate(); err != nil {
+		return err
+	}
 	f.Type = (*Frequency6Code)(&value)
+	return nil
 }
 
 func (f *FrequencyAndMoment1) SetPointInTime(value string) {
 	f.PointInTime = (*Exact2NumericText)(&value)
 }
+
+func (f *FrequencyAndMoment1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if f.Type != nil {
+		enc.Element("Tp", string(*f.Type))
+	}
+	if f.PointInTime != nil {
+		enc.Element("PtInTm", string(*f.PointInTime))
+	}
+	enc.End(tag)
+}
+
+func (f *FrequencyAndMoment1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			f.Type = (*Frequency6Code)(&value)
+		case "PtInTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			f.PointInTime = (*Exact2NumericText)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
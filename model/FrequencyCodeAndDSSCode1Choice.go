@@ -10,6 +10,10 @@ type FrequencyCodeAndDSSCode1Choice struct {
 	FrequencyAsDSS *GenericIdentification7 `xml:"FrqcyAsDSS"`
 }
 
+func (f *FrequencyCodeAndDSSCode1Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FrequencyCodeAndDSSCode1Choice) SetFrequencyAsCode(value string) {
 	f.FrequencyAsCode = (*EventFrequency1Code)(&value)
 }
@@ -10,6 +10,10 @@ type FrequencyCodeAndDSSCodeChoice struct {
 	FrequencyAsDSS *GenericIdentification7 `xml:"FrqcyAsDSS"`
 }
 
+func (f *FrequencyCodeAndDSSCodeChoice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FrequencyCodeAndDSSCodeChoice) SetFrequencyAsCode(value string) {
 	f.FrequencyAsCode = (*Frequency1Code)(&value)
 }
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Defines a frequency in terms on counts per period for a specific period type.
 type FrequencyPeriod1 struct {
 
@@ -10,10 +15,64 @@ type FrequencyPeriod1 struct {
 	CountPerPeriod *DecimalNumber `xml:"CntPerPrd"`
 }
 
-func (f *FrequencyPeriod1) SetType(value string) {
+func (f *FrequencyPeriod1) Validate() error {
+	return ValidateElement(f)
+}
+
+func (f *FrequencyPeriod1) SetType(value string) error {
+	if err := Frequency6Code(value).Validate(); err != nil {
+		return err
+	}
 	f.Type = (*Frequency6Code)(&value)
+	return nil
 }
 
 func (f *FrequencyPeriod1) SetCountPerPeriod(value string) {
 	f.CountPerPeriod = (*DecimalNumber)(&value)
 }
+
+func (f *FrequencyPeriod1) SetCountPerPeriodFromDecimal(value decimal.Decimal) {
+	f.CountPerPeriod = new(DecimalNumber)
+	f.CountPerPeriod.FromDecimal(value)
+}
+
+func (f *FrequencyPeriod1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if f.Type != nil {
+		enc.Element("Tp", string(*f.Type))
+	}
+	if f.CountPerPeriod != nil {
+		enc.Element("CntPerPrd", string(*f.CountPerPeriod))
+	}
+	enc.End(tag)
+}
+
+func (f *FrequencyPeriod1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			f.Type = (*Frequency6Code)(&value)
+		case "CntPerPrd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			f.CountPerPeriod = (*DecimalNumber)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
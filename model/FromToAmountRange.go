@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Range of amount values.
 type FromToAmountRange struct {
 
@@ -10,6 +12,10 @@ type FromToAmountRange struct {
 	ToAmount *AmountRangeBoundary1 `xml:"ToAmt"`
 }
 
+func (f *FromToAmountRange) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FromToAmountRange) AddFromAmount() *AmountRangeBoundary1 {
 	f.FromAmount = new(AmountRangeBoundary1)
 	return f.FromAmount
@@ -19,3 +25,44 @@ func (f *FromToAmountRange) AddToAmount() *AmountRangeBoundary1 {
 	f.ToAmount = new(AmountRangeBoundary1)
 	return f.ToAmount
 }
+
+func (f *FromToAmountRange) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if f.FromAmount != nil {
+		f.FromAmount.EncodeXMLFast(enc, "FrAmt")
+	}
+	if f.ToAmount != nil {
+		f.ToAmount.EncodeXMLFast(enc, "ToAmt")
+	}
+	enc.End(tag)
+}
+
+func (f *FromToAmountRange) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FrAmt":
+			elem := new(AmountRangeBoundary1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.FromAmount = elem
+		case "ToAmt":
+			elem := new(AmountRangeBoundary1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.ToAmount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Range of amount values.
+type FromToAmountRange1 struct {
+
+	// Lower boundary of a range of amount values.
+	FromAmount *AmountRangeBoundary1 `xml:"FrAmt"`
+
+	// Upper boundary of a range of amount values.
+	ToAmount *AmountRangeBoundary1 `xml:"ToAmt"`
+}
+
+func (f *FromToAmountRange1) Validate() error {
+	return ValidateElement(f)
+}
+
+func (f *FromToAmountRange1) AddFromAmount() *AmountRangeBoundary1 {
+	f.FromAmount = new(AmountRangeBoundary1)
+	return f.FromAmount
+}
+
+func (f *FromToAmountRange1) AddToAmount() *AmountRangeBoundary1 {
+	f.ToAmount = new(AmountRangeBoundary1)
+	return f.ToAmount
+}
+
+func (f *FromToAmountRange1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if f.FromAmount != nil {
+		f.FromAmount.EncodeXMLFast(enc, "FrAmt")
+	}
+	if f.ToAmount != nil {
+		f.ToAmount.EncodeXMLFast(enc, "ToAmt")
+	}
+	enc.End(tag)
+}
+
+func (f *FromToAmountRange1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FrAmt":
+			elem := new(AmountRangeBoundary1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.FromAmount = elem
+		case "ToAmt":
+			elem := new(AmountRangeBoundary1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.ToAmount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ type FullLegalNameModification1 struct {
 	FullLegalName *Max350Text `xml:"FullLglNm"`
 }
 
+func (f *FullLegalNameModification1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FullLegalNameModification1) SetModificationCode(value string) {
 	f.ModificationCode = (*Modification1Code)(&value)
 }
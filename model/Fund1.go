@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about an investment fund.
 type Fund1 struct {
 
@@ -46,6 +50,10 @@ type Fund1 struct {
 	EstimatedNetCashForecastDetails []*NetCashForecast5 `xml:"EstmtdNetCshFcstDtls,omitempty"`
 }
 
+func (f *Fund1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Fund1) SetName(value string) {
 	f.Name = (*Max350Text)(&value)
 }
@@ -77,10 +85,18 @@ func (f *Fund1) SetEstimatedTotalNAV(value, currency string) {
 	f.EstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *Fund1) SetEstimatedTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.EstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *Fund1) SetPreviousTotalNAV(value, currency string) {
 	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *Fund1) SetPreviousTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *Fund1) AddEstimatedTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.EstimatedTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.EstimatedTotalUnitsNumber
@@ -95,6 +111,11 @@ func (f *Fund1) SetEstimatedPercentageOfFundTotalNAV(value string) {
 	f.EstimatedPercentageOfFundTotalNAV = (*PercentageRate)(&value)
 }
 
+func (f *Fund1) SetEstimatedPercentageOfFundTotalNAVFromDecimal(value decimal.Decimal) {
+	f.EstimatedPercentageOfFundTotalNAV = new(PercentageRate)
+	f.EstimatedPercentageOfFundTotalNAV.FromDecimal(value)
+}
+
 func (f *Fund1) AddEstimatedCashInForecastDetails() *CashInOutForecast7 {
 	newValue := new(CashInOutForecast7)
 	f.EstimatedCashInForecastDetails = append(f.EstimatedCashInForecastDetails, newValue)
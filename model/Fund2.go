@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about an investment fund.
 type Fund2 struct {
 
@@ -46,6 +50,10 @@ type Fund2 struct {
 	NetCashForecastDetails []*NetCashForecast5 `xml:"NetCshFcstDtls,omitempty"`
 }
 
+func (f *Fund2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Fund2) SetName(value string) {
 	f.Name = (*Max350Text)(&value)
 }
@@ -77,10 +85,18 @@ func (f *Fund2) SetTotalNAV(value, currency string) {
 	f.TotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *Fund2) SetTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *Fund2) SetPreviousTotalNAV(value, currency string) {
 	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *Fund2) SetPreviousTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *Fund2) AddTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.TotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.TotalUnitsNumber
@@ -95,6 +111,11 @@ func (f *Fund2) SetPercentageOfFundTotalNAV(value string) {
 	f.PercentageOfFundTotalNAV = (*PercentageRate)(&value)
 }
 
+func (f *Fund2) SetPercentageOfFundTotalNAVFromDecimal(value decimal.Decimal) {
+	f.PercentageOfFundTotalNAV = new(PercentageRate)
+	f.PercentageOfFundTotalNAV.FromDecimal(value)
+}
+
 func (f *Fund2) AddCashInForecastDetails() *CashInOutForecast7 {
 	newValue := new(CashInOutForecast7)
 	f.CashInForecastDetails = append(f.CashInForecastDetails, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about an investment fund.
 type Fund3 struct {
 
@@ -31,6 +35,10 @@ type Fund3 struct {
 	EstimatedPercentageOfFundTotalNAV *PercentageRate `xml:"EstmtdPctgOfFndTtlNAV,omitempty"`
 }
 
+func (f *Fund3) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Fund3) SetName(value string) {
 	f.Name = (*Max350Text)(&value)
 }
@@ -52,10 +60,18 @@ func (f *Fund3) SetEstimatedTotalNAV(value, currency string) {
 	f.EstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *Fund3) SetEstimatedTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.EstimatedTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *Fund3) SetPreviousTotalNAV(value, currency string) {
 	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *Fund3) SetPreviousTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *Fund3) AddEstimatedTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.EstimatedTotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.EstimatedTotalUnitsNumber
@@ -69,3 +85,8 @@ func (f *Fund3) AddPreviousTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 func (f *Fund3) SetEstimatedPercentageOfFundTotalNAV(value string) {
 	f.EstimatedPercentageOfFundTotalNAV = (*PercentageRate)(&value)
 }
+
+func (f *Fund3) SetEstimatedPercentageOfFundTotalNAVFromDecimal(value decimal.Decimal) {
+	f.EstimatedPercentageOfFundTotalNAV = new(PercentageRate)
+	f.EstimatedPercentageOfFundTotalNAV.FromDecimal(value)
+}
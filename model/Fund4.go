@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about an investment fund.
 type Fund4 struct {
 
@@ -31,6 +35,10 @@ type Fund4 struct {
 	PercentageOfFundTotalNAV *PercentageRate `xml:"PctgOfFndTtlNAV,omitempty"`
 }
 
+func (f *Fund4) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *Fund4) SetName(value string) {
 	f.Name = (*Max350Text)(&value)
 }
@@ -52,10 +60,18 @@ func (f *Fund4) SetTotalNAV(value, currency string) {
 	f.TotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *Fund4) SetTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *Fund4) SetPreviousTotalNAV(value, currency string) {
 	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *Fund4) SetPreviousTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *Fund4) AddTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.TotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.TotalUnitsNumber
@@ -69,3 +85,8 @@ func (f *Fund4) AddPreviousTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 func (f *Fund4) SetPercentageOfFundTotalNAV(value string) {
 	f.PercentageOfFundTotalNAV = (*PercentageRate)(&value)
 }
+
+func (f *Fund4) SetPercentageOfFundTotalNAVFromDecimal(value decimal.Decimal) {
+	f.PercentageOfFundTotalNAV = new(PercentageRate)
+	f.PercentageOfFundTotalNAV.FromDecimal(value)
+}
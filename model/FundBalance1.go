@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Balances of units and cash derived from investment fund orders.
 type FundBalance1 struct {
 
@@ -16,6 +20,10 @@ type FundBalance1 struct {
 	TotalCashFromCashOrders *ActiveOrHistoricCurrencyAndAmount `xml:"TtlCshFrCshOrdrs,omitempty"`
 }
 
+func (f *FundBalance1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundBalance1) AddTotalUnitsFromUnitOrders() *FinancialInstrumentQuantity1 {
 	f.TotalUnitsFromUnitOrders = new(FinancialInstrumentQuantity1)
 	return f.TotalUnitsFromUnitOrders
@@ -30,6 +38,14 @@ func (f *FundBalance1) SetTotalCashFromUnitOrders(value, currency string) {
 	f.TotalCashFromUnitOrders = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundBalance1) SetTotalCashFromUnitOrdersFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalCashFromUnitOrders = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundBalance1) SetTotalCashFromCashOrders(value, currency string) {
 	f.TotalCashFromCashOrders = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (f *FundBalance1) SetTotalCashFromCashOrdersFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalCashFromCashOrders = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
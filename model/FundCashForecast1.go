@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements from or to a fund as a result of investment funds transactions, eg, subscriptions or redemptions.
 type FundCashForecast1 struct {
 
@@ -43,6 +47,10 @@ type FundCashForecast1 struct {
 	NetCashForecastDetails []*NetCashForecast1 `xml:"NetCshFcstDtls,omitempty"`
 }
 
+func (f *FundCashForecast1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundCashForecast1) AddTradeDateTime() *DateAndDateTimeChoice {
 	f.TradeDateTime = new(DateAndDateTimeChoice)
 	return f.TradeDateTime
@@ -62,10 +70,18 @@ func (f *FundCashForecast1) SetTotalNAV(value, currency string) {
 	f.TotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundCashForecast1) SetTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundCashForecast1) SetPreviousTotalNAV(value, currency string) {
 	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundCashForecast1) SetPreviousTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundCashForecast1) AddTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.TotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.TotalUnitsNumber
@@ -80,6 +96,11 @@ func (f *FundCashForecast1) SetTotalNAVChangeRate(value string) {
 	f.TotalNAVChangeRate = (*PercentageRate)(&value)
 }
 
+func (f *FundCashForecast1) SetTotalNAVChangeRateFromDecimal(value decimal.Decimal) {
+	f.TotalNAVChangeRate = new(PercentageRate)
+	f.TotalNAVChangeRate.FromDecimal(value)
+}
+
 func (f *FundCashForecast1) AddInvestmentCurrency(value string) {
 	f.InvestmentCurrency = append(f.InvestmentCurrency, (*ActiveOrHistoricCurrencyCode)(&value))
 }
@@ -88,6 +109,11 @@ func (f *FundCashForecast1) SetExceptionalNetCashFlowIndicator(value string) {
 	f.ExceptionalNetCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FundCashForecast1) SetExceptionalNetCashFlowIndicatorFromBool(value bool) {
+	f.ExceptionalNetCashFlowIndicator = new(YesNoIndicator)
+	f.ExceptionalNetCashFlowIndicator.FromBool(value)
+}
+
 func (f *FundCashForecast1) AddCashInForecastDetails() *CashInForecast2 {
 	newValue := new(CashInForecast2)
 	f.CashInForecastDetails = append(f.CashInForecastDetails, newValue)
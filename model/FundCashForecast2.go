@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements from or to a fund as a result of investment funds transactions, eg, subscriptions or redemptions.
 type FundCashForecast2 struct {
 
@@ -40,6 +44,10 @@ type FundCashForecast2 struct {
 	NetCashForecastDetails []*NetCashForecast1 `xml:"NetCshFcstDtls,omitempty"`
 }
 
+func (f *FundCashForecast2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundCashForecast2) AddTradeDateTime() *DateAndDateTimeChoice {
 	f.TradeDateTime = new(DateAndDateTimeChoice)
 	return f.TradeDateTime
@@ -59,10 +67,18 @@ func (f *FundCashForecast2) SetTotalNAV(value, currency string) {
 	f.TotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundCashForecast2) SetTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundCashForecast2) SetPreviousTotalNAV(value, currency string) {
 	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundCashForecast2) SetPreviousTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundCashForecast2) AddTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.TotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.TotalUnitsNumber
@@ -77,6 +93,11 @@ func (f *FundCashForecast2) SetTotalNAVChangeRate(value string) {
 	f.TotalNAVChangeRate = (*PercentageRate)(&value)
 }
 
+func (f *FundCashForecast2) SetTotalNAVChangeRateFromDecimal(value decimal.Decimal) {
+	f.TotalNAVChangeRate = new(PercentageRate)
+	f.TotalNAVChangeRate.FromDecimal(value)
+}
+
 func (f *FundCashForecast2) AddInvestmentCurrency(value string) {
 	f.InvestmentCurrency = append(f.InvestmentCurrency, (*ActiveOrHistoricCurrencyCode)(&value))
 }
@@ -85,6 +106,11 @@ func (f *FundCashForecast2) SetExceptionalNetCashFlowIndicator(value string) {
 	f.ExceptionalNetCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FundCashForecast2) SetExceptionalNetCashFlowIndicatorFromBool(value bool) {
+	f.ExceptionalNetCashFlowIndicator = new(YesNoIndicator)
+	f.ExceptionalNetCashFlowIndicator.FromBool(value)
+}
+
 func (f *FundCashForecast2) AddSortingCriteriaDetails() *CashSortingCriterion2 {
 	newValue := new(CashSortingCriterion2)
 	f.SortingCriteriaDetails = append(f.SortingCriteriaDetails, newValue)
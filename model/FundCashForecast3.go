@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements from or to a fund as a result of investment funds transactions, eg, subscriptions or redemptions.
 type FundCashForecast3 struct {
 
@@ -46,6 +50,10 @@ type FundCashForecast3 struct {
 	NetCashForecastDetails []*NetCashForecast2 `xml:"NetCshFcstDtls,omitempty"`
 }
 
+func (f *FundCashForecast3) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundCashForecast3) SetIdentification(value string) {
 	f.Identification = (*Max35Text)(&value)
 }
@@ -69,10 +77,18 @@ func (f *FundCashForecast3) SetTotalNAV(value, currency string) {
 	f.TotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundCashForecast3) SetTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundCashForecast3) SetPreviousTotalNAV(value, currency string) {
 	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundCashForecast3) SetPreviousTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundCashForecast3) AddTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.TotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.TotalUnitsNumber
@@ -87,6 +103,11 @@ func (f *FundCashForecast3) SetTotalNAVChangeRate(value string) {
 	f.TotalNAVChangeRate = (*PercentageRate)(&value)
 }
 
+func (f *FundCashForecast3) SetTotalNAVChangeRateFromDecimal(value decimal.Decimal) {
+	f.TotalNAVChangeRate = new(PercentageRate)
+	f.TotalNAVChangeRate.FromDecimal(value)
+}
+
 func (f *FundCashForecast3) AddInvestmentCurrency(value string) {
 	f.InvestmentCurrency = append(f.InvestmentCurrency, (*ActiveOrHistoricCurrencyCode)(&value))
 }
@@ -95,6 +116,11 @@ func (f *FundCashForecast3) SetExceptionalNetCashFlowIndicator(value string) {
 	f.ExceptionalNetCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FundCashForecast3) SetExceptionalNetCashFlowIndicatorFromBool(value bool) {
+	f.ExceptionalNetCashFlowIndicator = new(YesNoIndicator)
+	f.ExceptionalNetCashFlowIndicator.FromBool(value)
+}
+
 func (f *FundCashForecast3) AddCashInForecastDetails() *CashInForecast4 {
 	newValue := new(CashInForecast4)
 	f.CashInForecastDetails = append(f.CashInForecastDetails, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements from or to a fund as a result of investment funds transactions, eg, subscriptions or redemptions.
 type FundCashForecast4 struct {
 
@@ -52,6 +56,10 @@ type FundCashForecast4 struct {
 	BreakdownByUserDefinedParameter []*BreakdownByUserDefinedParameter1 `xml:"BrkdwnByUsrDfndParam,omitempty"`
 }
 
+func (f *FundCashForecast4) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundCashForecast4) SetIdentification(value string) {
 	f.Identification = (*Max35Text)(&value)
 }
@@ -75,10 +83,18 @@ func (f *FundCashForecast4) SetTotalNAV(value, currency string) {
 	f.TotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundCashForecast4) SetTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundCashForecast4) SetPreviousTotalNAV(value, currency string) {
 	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundCashForecast4) SetPreviousTotalNAVFromDecimal(value decimal.Decimal, currency string) {
+	f.PreviousTotalNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundCashForecast4) AddTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.TotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.TotalUnitsNumber
@@ -93,6 +109,11 @@ func (f *FundCashForecast4) SetTotalNAVChangeRate(value string) {
 	f.TotalNAVChangeRate = (*PercentageRate)(&value)
 }
 
+func (f *FundCashForecast4) SetTotalNAVChangeRateFromDecimal(value decimal.Decimal) {
+	f.TotalNAVChangeRate = new(PercentageRate)
+	f.TotalNAVChangeRate.FromDecimal(value)
+}
+
 func (f *FundCashForecast4) AddInvestmentCurrency(value string) {
 	f.InvestmentCurrency = append(f.InvestmentCurrency, (*ActiveOrHistoricCurrencyCode)(&value))
 }
@@ -247,6 +268,11 @@ func (f *FundCashForecast4) SetExceptionalNetCashFlowIndicator(value string) {
 	f.ExceptionalNetCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FundCashForecast4) SetExceptionalNetCashFlowIndicatorFromBool(value bool) {
+	f.ExceptionalNetCashFlowIndicator = new(YesNoIndicator)
+	f.ExceptionalNetCashFlowIndicator.FromBool(value)
+}
+
 func (f *FundCashForecast4) AddBreakdownByCountry() *BreakdownByCountry1 {
 	newValue := new(BreakdownByCountry1)
 	f.BreakdownByCountry = append(f.BreakdownByCountry, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements from or to a fund as a result of investment funds transactions, eg, subscriptions or redemptions.
 type FundCashForecast6 struct {
 
@@ -64,6 +68,10 @@ type FundCashForecast6 struct {
 	NetCashForecastDetails []*NetCashForecast4 `xml:"NetCshFcstDtls,omitempty"`
 }
 
+func (f *FundCashForecast6) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundCashForecast6) SetIdentification(value string) {
 	f.Identification = (*Max35Text)(&value)
 }
@@ -105,6 +113,11 @@ func (f *FundCashForecast6) SetTotalNAVChangeRate(value string) {
 	f.TotalNAVChangeRate = (*PercentageRate)(&value)
 }
 
+func (f *FundCashForecast6) SetTotalNAVChangeRateFromDecimal(value decimal.Decimal) {
+	f.TotalNAVChangeRate = new(PercentageRate)
+	f.TotalNAVChangeRate.FromDecimal(value)
+}
+
 func (f *FundCashForecast6) AddInvestmentCurrency(value string) {
 	f.InvestmentCurrency = append(f.InvestmentCurrency, (*ActiveOrHistoricCurrencyCode)(&value))
 }
@@ -118,6 +131,11 @@ func (f *FundCashForecast6) SetExceptionalNetCashFlowIndicator(value string) {
 	f.ExceptionalNetCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FundCashForecast6) SetExceptionalNetCashFlowIndicatorFromBool(value bool) {
+	f.ExceptionalNetCashFlowIndicator = new(YesNoIndicator)
+	f.ExceptionalNetCashFlowIndicator.FromBool(value)
+}
+
 func (f *FundCashForecast6) AddPrice() *UnitPrice19 {
 	f.Price = new(UnitPrice19)
 	return f.Price
@@ -132,6 +150,11 @@ func (f *FundCashForecast6) SetPercentageOfShareClassTotalNAV(value string) {
 	f.PercentageOfShareClassTotalNAV = (*PercentageRate)(&value)
 }
 
+func (f *FundCashForecast6) SetPercentageOfShareClassTotalNAVFromDecimal(value decimal.Decimal) {
+	f.PercentageOfShareClassTotalNAV = new(PercentageRate)
+	f.PercentageOfShareClassTotalNAV.FromDecimal(value)
+}
+
 func (f *FundCashForecast6) AddBreakdownByParty() *BreakdownByParty3 {
 	newValue := new(BreakdownByParty3)
 	f.BreakdownByParty = append(f.BreakdownByParty, newValue)
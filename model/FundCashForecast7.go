@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash movements from or to a fund as a result of investment funds transactions, eg, subscriptions or redemptions.
 type FundCashForecast7 struct {
 
@@ -58,6 +62,10 @@ type FundCashForecast7 struct {
 	NetCashForecastDetails []*NetCashForecast4 `xml:"NetCshFcstDtls,omitempty"`
 }
 
+func (f *FundCashForecast7) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundCashForecast7) SetIdentification(value string) {
 	f.Identification = (*Max35Text)(&value)
 }
@@ -99,6 +107,11 @@ func (f *FundCashForecast7) SetTotalNAVChangeRate(value string) {
 	f.TotalNAVChangeRate = (*PercentageRate)(&value)
 }
 
+func (f *FundCashForecast7) SetTotalNAVChangeRateFromDecimal(value decimal.Decimal) {
+	f.TotalNAVChangeRate = new(PercentageRate)
+	f.TotalNAVChangeRate.FromDecimal(value)
+}
+
 func (f *FundCashForecast7) AddInvestmentCurrency(value string) {
 	f.InvestmentCurrency = append(f.InvestmentCurrency, (*ActiveOrHistoricCurrencyCode)(&value))
 }
@@ -112,6 +125,11 @@ func (f *FundCashForecast7) SetExceptionalNetCashFlowIndicator(value string) {
 	f.ExceptionalNetCashFlowIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FundCashForecast7) SetExceptionalNetCashFlowIndicatorFromBool(value bool) {
+	f.ExceptionalNetCashFlowIndicator = new(YesNoIndicator)
+	f.ExceptionalNetCashFlowIndicator.FromBool(value)
+}
+
 func (f *FundCashForecast7) AddPrice() *UnitPrice19 {
 	f.Price = new(UnitPrice19)
 	return f.Price
@@ -126,6 +144,11 @@ func (f *FundCashForecast7) SetPercentageOfShareClassTotalNAV(value string) {
 	f.PercentageOfShareClassTotalNAV = (*PercentageRate)(&value)
 }
 
+func (f *FundCashForecast7) SetPercentageOfShareClassTotalNAVFromDecimal(value decimal.Decimal) {
+	f.PercentageOfShareClassTotalNAV = new(PercentageRate)
+	f.PercentageOfShareClassTotalNAV.FromDecimal(value)
+}
+
 func (f *FundCashForecast7) AddCashInForecastDetails() *CashInForecast6 {
 	newValue := new(CashInForecast6)
 	f.CashInForecastDetails = append(f.CashInForecastDetails, newValue)
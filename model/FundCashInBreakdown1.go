@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Breakdown of cash movements into a fund as a result of investment funds transactions, eg, subscriptions or switch-in.
 type FundCashInBreakdown1 struct {
 
@@ -22,10 +26,18 @@ type FundCashInBreakdown1 struct {
 	CommissionDetails []*Commission4 `xml:"ComssnDtls,omitempty"`
 }
 
+func (f *FundCashInBreakdown1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundCashInBreakdown1) SetAmount(value, currency string) {
 	f.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundCashInBreakdown1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundCashInBreakdown1) AddUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.UnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.UnitsNumber
@@ -35,6 +47,11 @@ func (f *FundCashInBreakdown1) SetNewAmountIndicator(value string) {
 	f.NewAmountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FundCashInBreakdown1) SetNewAmountIndicatorFromBool(value bool) {
+	f.NewAmountIndicator = new(YesNoIndicator)
+	f.NewAmountIndicator.FromBool(value)
+}
+
 func (f *FundCashInBreakdown1) AddInvestmentFundTransactionInTypeDetails() *InvestmentFundTransactionInType1 {
 	f.InvestmentFundTransactionInTypeDetails = new(InvestmentFundTransactionInType1)
 	return f.InvestmentFundTransactionInTypeDetails
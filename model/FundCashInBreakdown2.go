@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Breakdown of cash movements into a fund as a result of investment funds transactions, eg, subscriptions or switch-in.
 type FundCashInBreakdown2 struct {
 
@@ -31,10 +35,18 @@ type FundCashInBreakdown2 struct {
 	CommissionDetails []*Commission9 `xml:"ComssnDtls,omitempty"`
 }
 
+func (f *FundCashInBreakdown2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundCashInBreakdown2) SetAmount(value, currency string) {
 	f.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundCashInBreakdown2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundCashInBreakdown2) AddUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.UnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.UnitsNumber
@@ -44,6 +56,11 @@ func (f *FundCashInBreakdown2) SetNewAmountIndicator(value string) {
 	f.NewAmountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FundCashInBreakdown2) SetNewAmountIndicatorFromBool(value bool) {
+	f.NewAmountIndicator = new(YesNoIndicator)
+	f.NewAmountIndicator.FromBool(value)
+}
+
 func (f *FundCashInBreakdown2) SetInvestmentFundTransactionInType(value string) {
 	f.InvestmentFundTransactionInType = (*InvestmentFundTransactionInType1Code)(&value)
 }
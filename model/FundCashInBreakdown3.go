@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Breakdown of cash movements into a fund as a result of investment funds transactions, eg, subscriptions or switch-in.
 type FundCashInBreakdown3 struct {
 
@@ -28,10 +32,18 @@ type FundCashInBreakdown3 struct {
 	SettlementCurrency *ActiveCurrencyCode `xml:"SttlmCcy,omitempty"`
 }
 
+func (f *FundCashInBreakdown3) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundCashInBreakdown3) SetAmount(value, currency string) {
 	f.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundCashInBreakdown3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundCashInBreakdown3) AddUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.UnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.UnitsNumber
@@ -41,6 +53,11 @@ func (f *FundCashInBreakdown3) SetNewAmountIndicator(value string) {
 	f.NewAmountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FundCashInBreakdown3) SetNewAmountIndicatorFromBool(value bool) {
+	f.NewAmountIndicator = new(YesNoIndicator)
+	f.NewAmountIndicator.FromBool(value)
+}
+
 func (f *FundCashInBreakdown3) AddInvestmentFundTransactionInType() *InvestmentFundTransactionInType1Choice {
 	f.InvestmentFundTransactionInType = new(InvestmentFundTransactionInType1Choice)
 	return f.InvestmentFundTransactionInType
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Breakdown of cash movements out of a fund as a result of investment funds transactions, eg, redemptions or switch-out.
 type FundCashOutBreakdown1 struct {
 
@@ -22,10 +26,18 @@ type FundCashOutBreakdown1 struct {
 	CommissionDetails []*Commission4 `xml:"ComssnDtls,omitempty"`
 }
 
+func (f *FundCashOutBreakdown1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundCashOutBreakdown1) SetAmount(value, currency string) {
 	f.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundCashOutBreakdown1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundCashOutBreakdown1) AddUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.UnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.UnitsNumber
@@ -35,6 +47,11 @@ func (f *FundCashOutBreakdown1) SetNewAmountIndicator(value string) {
 	f.NewAmountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FundCashOutBreakdown1) SetNewAmountIndicatorFromBool(value bool) {
+	f.NewAmountIndicator = new(YesNoIndicator)
+	f.NewAmountIndicator.FromBool(value)
+}
+
 func (f *FundCashOutBreakdown1) AddInvestmentFundTransactionOutTypeDetails() *InvestmentFundTransactionOutType1 {
 	f.InvestmentFundTransactionOutTypeDetails = new(InvestmentFundTransactionOutType1)
 	return f.InvestmentFundTransactionOutTypeDetails
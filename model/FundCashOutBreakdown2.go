@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Breakdown of cash movements out of a fund as a result of investment funds transactions, eg, redemptions or switch-out.
 type FundCashOutBreakdown2 struct {
 
@@ -31,10 +35,18 @@ type FundCashOutBreakdown2 struct {
 	CommissionDetails []*Commission9 `xml:"ComssnDtls,omitempty"`
 }
 
+func (f *FundCashOutBreakdown2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundCashOutBreakdown2) SetAmount(value, currency string) {
 	f.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundCashOutBreakdown2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundCashOutBreakdown2) AddUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.UnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.UnitsNumber
@@ -44,6 +56,11 @@ func (f *FundCashOutBreakdown2) SetNewAmountIndicator(value string) {
 	f.NewAmountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FundCashOutBreakdown2) SetNewAmountIndicatorFromBool(value bool) {
+	f.NewAmountIndicator = new(YesNoIndicator)
+	f.NewAmountIndicator.FromBool(value)
+}
+
 func (f *FundCashOutBreakdown2) SetInvestmentFundTransactionOutType(value string) {
 	f.InvestmentFundTransactionOutType = (*InvestmentFundTransactionOutType1Code)(&value)
 }
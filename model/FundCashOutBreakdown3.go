@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Breakdown of cash movements out of a fund as a result of investment funds transactions, eg, redemptions or switch-out.
 type FundCashOutBreakdown3 struct {
 
@@ -28,10 +32,18 @@ type FundCashOutBreakdown3 struct {
 	SettlementCurrency *ActiveCurrencyCode `xml:"SttlmCcy,omitempty"`
 }
 
+func (f *FundCashOutBreakdown3) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundCashOutBreakdown3) SetAmount(value, currency string) {
 	f.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundCashOutBreakdown3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundCashOutBreakdown3) AddUnitsNumber() *FinancialInstrumentQuantity1 {
 	f.UnitsNumber = new(FinancialInstrumentQuantity1)
 	return f.UnitsNumber
@@ -41,6 +53,11 @@ func (f *FundCashOutBreakdown3) SetNewAmountIndicator(value string) {
 	f.NewAmountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FundCashOutBreakdown3) SetNewAmountIndicatorFromBool(value bool) {
+	f.NewAmountIndicator = new(YesNoIndicator)
+	f.NewAmountIndicator.FromBool(value)
+}
+
 func (f *FundCashOutBreakdown3) AddInvestmentFundTransactionOutType() *InvestmentFundTransactionOutType1Choice {
 	f.InvestmentFundTransactionOutType = new(InvestmentFundTransactionOutType1Choice)
 	return f.InvestmentFundTransactionOutType
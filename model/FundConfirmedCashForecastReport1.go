@@ -10,6 +10,10 @@ type FundConfirmedCashForecastReport1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundConfirmedCashForecastReport1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundConfirmedCashForecastReport1) AddFundCashForecastDetails() *FundCashForecast1 {
 	newValue := new(FundCashForecast1)
 	f.FundCashForecastDetails = append(f.FundCashForecastDetails, newValue)
@@ -13,6 +13,10 @@ type FundConfirmedCashForecastReport2 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundConfirmedCashForecastReport2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundConfirmedCashForecastReport2) AddFundCashForecastDetails() *FundCashForecast3 {
 	newValue := new(FundCashForecast3)
 	f.FundCashForecastDetails = append(f.FundCashForecastDetails, newValue)
@@ -16,6 +16,10 @@ type FundConfirmedCashForecastReport3 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundConfirmedCashForecastReport3) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundConfirmedCashForecastReport3) AddFundOrSubFundDetails() *Fund2 {
 	newValue := new(Fund2)
 	f.FundOrSubFundDetails = append(f.FundOrSubFundDetails, newValue)
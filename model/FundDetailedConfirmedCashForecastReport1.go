@@ -10,6 +10,10 @@ type FundDetailedConfirmedCashForecastReport1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundDetailedConfirmedCashForecastReport1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundDetailedConfirmedCashForecastReport1) AddFundCashForecastDetails() *FundCashForecast2 {
 	newValue := new(FundCashForecast2)
 	f.FundCashForecastDetails = append(f.FundCashForecastDetails, newValue)
@@ -13,6 +13,10 @@ type FundDetailedConfirmedCashForecastReport2 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundDetailedConfirmedCashForecastReport2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundDetailedConfirmedCashForecastReport2) AddFundCashForecastDetails() *FundCashForecast4 {
 	newValue := new(FundCashForecast4)
 	f.FundCashForecastDetails = append(f.FundCashForecastDetails, newValue)
@@ -16,6 +16,10 @@ type FundDetailedConfirmedCashForecastReport3 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundDetailedConfirmedCashForecastReport3) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundDetailedConfirmedCashForecastReport3) AddFundOrSubFundDetails() *Fund4 {
 	f.FundOrSubFundDetails = new(Fund4)
 	return f.FundOrSubFundDetails
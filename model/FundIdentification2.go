@@ -13,6 +13,10 @@ type FundIdentification2 struct {
 	CustodianIdentification *PartyIdentification8Choice `xml:"CtdnId,omitempty"`
 }
 
+func (f *FundIdentification2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundIdentification2) SetFundIdentification(value string) {
 	f.FundIdentification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type FundIdentification4 struct {
 	CustodianIdentification *PartyIdentification73Choice `xml:"CtdnId,omitempty"`
 }
 
+func (f *FundIdentification4) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundIdentification4) AddFundIdentification() *PartyIdentification60 {
 	f.FundIdentification = new(PartyIdentification60)
 	return f.FundIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Extract of trade data for an investment fund order.
 type FundOrderData1 struct {
 
@@ -31,6 +35,10 @@ type FundOrderData1 struct {
 	QuotedCurrency *ActiveOrHistoricCurrencyCode `xml:"QtdCcy,omitempty"`
 }
 
+func (f *FundOrderData1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundOrderData1) AddInvestmentAccountDetails() *InvestmentAccount13 {
 	f.InvestmentAccountDetails = new(InvestmentAccount13)
 	return f.InvestmentAccountDetails
@@ -50,18 +58,35 @@ func (f *FundOrderData1) SetNetAmount(value, currency string) {
 	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundOrderData1) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundOrderData1) SetGrossAmount(value, currency string) {
 	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundOrderData1) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundOrderData1) SetHoldingsRedemptionRate(value string) {
 	f.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (f *FundOrderData1) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	f.HoldingsRedemptionRate = new(PercentageRate)
+	f.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (f *FundOrderData1) SetSettlementAmount(value, currency string) {
 	f.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FundOrderData1) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundOrderData1) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
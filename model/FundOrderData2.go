@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Extract of trade data for an investment fund switch order.
 type FundOrderData2 struct {
 
@@ -28,30 +32,58 @@ type FundOrderData2 struct {
 	QuotedCurrency *ActiveOrHistoricCurrencyCode `xml:"QtdCcy,omitempty"`
 }
 
+func (f *FundOrderData2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundOrderData2) SetTotalRedemptionAmount(value, currency string) {
 	f.TotalRedemptionAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundOrderData2) SetTotalRedemptionAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalRedemptionAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundOrderData2) SetTotalSubscriptionAmount(value, currency string) {
 	f.TotalSubscriptionAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundOrderData2) SetTotalSubscriptionAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.TotalSubscriptionAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundOrderData2) SetSettlementAmount(value, currency string) {
 	f.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
-func (f *FundOrderData2) SetSettlementMethod(value string) {
+func (f *FundOrderData2) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (f *FundOrderData2) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	f.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (f *FundOrderData2) SetAdditionalCashIn(value, currency string) {
 	f.AdditionalCashIn = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundOrderData2) SetAdditionalCashInFromDecimal(value decimal.Decimal, currency string) {
+	f.AdditionalCashIn = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundOrderData2) SetResultingCashOut(value, currency string) {
 	f.ResultingCashOut = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundOrderData2) SetResultingCashOutFromDecimal(value decimal.Decimal, currency string) {
+	f.ResultingCashOut = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundOrderData2) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
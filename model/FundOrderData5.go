@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Extract of trade data for an investment fund order.
 type FundOrderData5 struct {
 
@@ -39,6 +43,10 @@ type FundOrderData5 struct {
 	QuotedCurrency *ActiveCurrencyCode `xml:"QtdCcy,omitempty"`
 }
 
+func (f *FundOrderData5) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundOrderData5) AddInvestmentAccountDetails() *InvestmentAccount58 {
 	f.InvestmentAccountDetails = new(InvestmentAccount58)
 	return f.InvestmentAccountDetails
@@ -53,22 +61,44 @@ func (f *FundOrderData5) SetUnitsNumber(value string) {
 	f.UnitsNumber = (*DecimalNumber)(&value)
 }
 
+func (f *FundOrderData5) SetUnitsNumberFromDecimal(value decimal.Decimal) {
+	f.UnitsNumber = new(DecimalNumber)
+	f.UnitsNumber.FromDecimal(value)
+}
+
 func (f *FundOrderData5) SetNetAmount(value, currency string) {
 	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundOrderData5) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundOrderData5) SetGrossAmount(value, currency string) {
 	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (f *FundOrderData5) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundOrderData5) SetHoldingsRedemptionRate(value string) {
 	f.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (f *FundOrderData5) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	f.HoldingsRedemptionRate = new(PercentageRate)
+	f.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (f *FundOrderData5) SetSettlementAmount(value, currency string) {
 	f.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FundOrderData5) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FundOrderData5) SetUnitCurrency(value string) {
 	f.UnitCurrency = (*ActiveCurrencyCode)(&value)
 }
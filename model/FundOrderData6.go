@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Extract of trade data for an investment fund switch order.
 type FundOrderData6 struct {
 
@@ -21,12 +25,24 @@ type FundOrderData6 struct {
 	QuotedCurrency *ActiveCurrencyCode `xml:"QtdCcy,omitempty"`
 }
 
+func (f *FundOrderData6) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundOrderData6) SetSettlementAmount(value, currency string) {
 	f.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
-func (f *FundOrderData6) SetSettlementMethod(value string) {
+func (f *FundOrderData6) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	f.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (f *FundOrderData6) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	f.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (f *FundOrderData6) AddAdditionalAmount() *AdditionalAmount1Choice {
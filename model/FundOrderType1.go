@@ -10,6 +10,10 @@ type FundOrderType1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (f *FundOrderType1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundOrderType1) SetStructured(value string) {
 	f.Structured = (*FundOrderType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type FundOrderType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *FundOrderType1Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundOrderType1Choice) SetType(value string) {
 	f.Type = (*FundOrderType6Code)(&value)
 }
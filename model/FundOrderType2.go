@@ -10,6 +10,10 @@ type FundOrderType2 struct {
 	ExtendedOrderType *Extended350Code `xml:"XtndedOrdrTp"`
 }
 
+func (f *FundOrderType2) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundOrderType2) SetOrderType(value string) {
 	f.OrderType = (*FundOrderType3Code)(&value)
 }
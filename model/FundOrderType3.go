@@ -10,6 +10,10 @@ type FundOrderType3 struct {
 	ExtendedOrderType *Extended350Code `xml:"XtndedOrdrTp"`
 }
 
+func (f *FundOrderType3) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundOrderType3) SetOrderType(value string) {
 	f.OrderType = (*FundOrderType4Code)(&value)
 }
@@ -10,6 +10,10 @@ type FundOrderType4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (f *FundOrderType4Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundOrderType4Choice) SetCode(value string) {
 	f.Code = (*FundOrderType8Code)(&value)
 }
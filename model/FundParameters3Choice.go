@@ -10,6 +10,10 @@ type FundParameters3Choice struct {
 	Parameters *FundParameters4 `xml:"Params"`
 }
 
+func (f *FundParameters3Choice) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundParameters3Choice) SetNoCriteria(value string) {
 	f.NoCriteria = (*NoCriteria1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Parameters required to request a Fund Processing Passport (FPP).
 type FundParameters4 struct {
 
@@ -19,6 +23,10 @@ type FundParameters4 struct {
 	RegisteredDistributionCountry *CountryCode `xml:"RegdDstrbtnCtry,omitempty"`
 }
 
+func (f *FundParameters4) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundParameters4) AddFinancialInstrumentDetails() *FinancialInstrument17 {
 	newValue := new(FinancialInstrument17)
 	f.FinancialInstrumentDetails = append(f.FinancialInstrumentDetails, newValue)
@@ -315,6 +323,11 @@ func (f *FundParameters4) SetDateFrom(value string) {
 	f.DateFrom = (*ISODate)(&value)
 }
 
+func (f *FundParameters4) SetDateFromFromTime(value time.Time) {
+	f.DateFrom = new(ISODate)
+	f.DateFrom.FromTime(value)
+}
+
 func (f *FundParameters4) SetCountryOfDomicile(value string) {
 	f.CountryOfDomicile = (*CountryCode)(&value)
 }
@@ -42,6 +42,10 @@ type FundProcessingPassport1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (f *FundProcessingPassport1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundProcessingPassport1) AddUpdatedDate() *UpdatedDate {
 	f.UpdatedDate = new(UpdatedDate)
 	return f.UpdatedDate
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Parameters applied to the settlement of a security.
 type FundSettlementParameters11 struct {
 
@@ -28,10 +32,19 @@ type FundSettlementParameters11 struct {
 	DeliveringSideDetails *DeliveringPartiesAndAccount16 `xml:"DlvrgSdDtls,omitempty"`
 }
 
+func (f *FundSettlementParameters11) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundSettlementParameters11) SetSettlementDate(value string) {
 	f.SettlementDate = (*ISODate)(&value)
 }
 
+func (f *FundSettlementParameters11) SetSettlementDateFromTime(value time.Time) {
+	f.SettlementDate = new(ISODate)
+	f.SettlementDate.FromTime(value)
+}
+
 func (f *FundSettlementParameters11) AddSettlementPlace() *PartyIdentification113 {
 	f.SettlementPlace = new(PartyIdentification113)
 	return f.SettlementPlace
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type FundSettlementParameters3 struct {
 
@@ -22,10 +26,19 @@ type FundSettlementParameters3 struct {
 	DeliveringSideDetails *DeliveringPartiesAndAccount3 `xml:"DlvrgSdDtls"`
 }
 
+func (f *FundSettlementParameters3) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundSettlementParameters3) SetSettlementDate(value string) {
 	f.SettlementDate = (*ISODate)(&value)
 }
 
+func (f *FundSettlementParameters3) SetSettlementDateFromTime(value time.Time) {
+	f.SettlementDate = new(ISODate)
+	f.SettlementDate.FromTime(value)
+}
+
 func (f *FundSettlementParameters3) AddSettlementPlace() *PartyIdentification2Choice {
 	f.SettlementPlace = new(PartyIdentification2Choice)
 	return f.SettlementPlace
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Parameters applied to the settlement of a security.
 type FundSettlementParameters4 struct {
 
@@ -22,10 +26,19 @@ type FundSettlementParameters4 struct {
 	DeliveringSideDetails *DeliveringPartiesAndAccount3 `xml:"DlvrgSdDtls,omitempty"`
 }
 
+func (f *FundSettlementParameters4) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FundSettlementParameters4) SetSettlementDate(value string) {
 	f.SettlementDate = (*ISODate)(&value)
 }
 
+func (f *FundSettlementParameters4) SetSettlementDateFromTime(value time.Time) {
+	f.SettlementDate = new(ISODate)
+	f.SettlementDate.FromTime(value)
+}
+
 func (f *FundSettlementParameters4) AddSettlementPlace() *PartyIdentification2Choice {
 	f.SettlementPlace = new(PartyIdentification2Choice)
 	return f.SettlementPlace
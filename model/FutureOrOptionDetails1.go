@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters for contracts which obligate the buyer to receive and the seller to deliver in the future the assets specified at an agreed price or contracts which grant to the holder either the privilege to purchase or the privilege to sell the assets specified at a predetermined price or formula at or within a time in the future.
 type FutureOrOptionDetails1 struct {
 
@@ -64,6 +70,10 @@ type FutureOrOptionDetails1 struct {
 	SpreadTransaction *YesNoIndicator `xml:"SprdTx,omitempty"`
 }
 
+func (f *FutureOrOptionDetails1) Validate() error {
+	return ValidateElement(f)
+}
+
 func (f *FutureOrOptionDetails1) SetFutureAndOptionContractType(value string) {
 	f.FutureAndOptionContractType = (*FutureAndOptionContractType1Code)(&value)
 }
@@ -72,6 +82,11 @@ func (f *FutureOrOptionDetails1) SetLastDeliveryDate(value string) {
 	f.LastDeliveryDate = (*ISODateTime)(&value)
 }
 
+func (f *FutureOrOptionDetails1) SetLastDeliveryDateFromTime(value time.Time) {
+	f.LastDeliveryDate = new(ISODateTime)
+	f.LastDeliveryDate.FromTime(value)
+}
+
 func (f *FutureOrOptionDetails1) SetUnitOfMeasure(value string) {
 	f.UnitOfMeasure = (*UnitOfMeasure1Code)(&value)
 }
@@ -80,14 +95,28 @@ func (f *FutureOrOptionDetails1) SetFutureDate(value string) {
 	f.FutureDate = (*ISODateTime)(&value)
 }
 
+func (f *FutureOrOptionDetails1) SetFutureDateFromTime(value time.Time) {
+	f.FutureDate = new(ISODateTime)
+	f.FutureDate.FromTime(value)
+}
+
 func (f *FutureOrOptionDetails1) SetMinimumSize(value, currency string) {
 	f.MinimumSize = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (f *FutureOrOptionDetails1) SetMinimumSizeFromDecimal(value decimal.Decimal, currency string) {
+	f.MinimumSize = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (f *FutureOrOptionDetails1) SetAnnouncementDate(value string) {
 	f.AnnouncementDate = (*ISODateTime)(&value)
 }
 
+func (f *FutureOrOptionDetails1) SetAnnouncementDateFromTime(value time.Time) {
+	f.AnnouncementDate = new(ISODateTime)
+	f.AnnouncementDate.FromTime(value)
+}
+
 func (f *FutureOrOptionDetails1) SetAppearance(value string) {
 	f.Appearance = (*Appearance1Code)(&value)
 }
@@ -96,18 +125,38 @@ func (f *FutureOrOptionDetails1) SetStrippableIndicator(value string) {
 	f.StrippableIndicator = (*YesNoIndicator)(&value)
 }
 
+func (f *FutureOrOptionDetails1) SetStrippableIndicatorFromBool(value bool) {
+	f.StrippableIndicator = new(YesNoIndicator)
+	f.StrippableIndicator.FromBool(value)
+}
+
 func (f *FutureOrOptionDetails1) SetPositionLimit(value string) {
 	f.PositionLimit = (*Number)(&value)
 }
 
+func (f *FutureOrOptionDetails1) SetPositionLimitFromDecimal(value decimal.Decimal) {
+	f.PositionLimit = new(Number)
+	f.PositionLimit.FromDecimal(value)
+}
+
 func (f *FutureOrOptionDetails1) SetNearTermPositionLimit(value string) {
 	f.NearTermPositionLimit = (*Number)(&value)
 }
 
+func (f *FutureOrOptionDetails1) SetNearTermPositionLimitFromDecimal(value decimal.Decimal) {
+	f.NearTermPositionLimit = new(Number)
+	f.NearTermPositionLimit.FromDecimal(value)
+}
+
 func (f *FutureOrOptionDetails1) SetMinimumTradingPricingIncrement(value string) {
 	f.MinimumTradingPricingIncrement = (*Number)(&value)
 }
 
+func (f *FutureOrOptionDetails1) SetMinimumTradingPricingIncrementFromDecimal(value decimal.Decimal) {
+	f.MinimumTradingPricingIncrement = new(Number)
+	f.MinimumTradingPricingIncrement.FromDecimal(value)
+}
+
 func (f *FutureOrOptionDetails1) SetPurpose(value string) {
 	f.Purpose = (*Max256Text)(&value)
 }
@@ -427,6 +476,16 @@ func (f *FutureOrOptionDetails1) SetLastTransaction(value string) {
 	f.LastTransaction = (*YesNoIndicator)(&value)
 }
 
+func (f *FutureOrOptionDetails1) SetLastTransactionFromBool(value bool) {
+	f.LastTransaction = new(YesNoIndicator)
+	f.LastTransaction.FromBool(value)
+}
+
 func (f *FutureOrOptionDetails1) SetSpreadTransaction(value string) {
 	f.SpreadTransaction = (*YesNoIndicator)(&value)
 }
+
+func (f *FutureOrOptionDetails1) SetSpreadTransactionFromBool(value bool) {
+	f.SpreadTransaction = new(YesNoIndicator)
+	f.SpreadTransaction.FromBool(value)
+}
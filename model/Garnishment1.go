@@ -1,5 +1,12 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides remittance information about a payment for garnishment-related purposes.
 type Garnishment1 struct {
 
@@ -28,6 +35,10 @@ type Garnishment1 struct {
 	EmployeeTerminationIndicator *TrueFalseIndicator `xml:"MplyeeTermntnInd,omitempty"`
 }
 
+func (g *Garnishment1) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *Garnishment1) AddType() *GarnishmentType1 {
 	g.Type = new(GarnishmentType1)
 	return g.Type
@@ -51,14 +62,128 @@ func (g *Garnishment1) SetDate(value string) {
 	g.Date = (*ISODate)(&value)
 }
 
+func (g *Garnishment1) SetDateFromTime(value time.Time) {
+	g.Date = new(ISODate)
+	g.Date.FromTime(value)
+}
+
 func (g *Garnishment1) SetRemittedAmount(value, currency string) {
 	g.RemittedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (g *Garnishment1) SetRemittedAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.RemittedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (g *Garnishment1) SetFamilyMedicalInsuranceIndicator(value string) {
 	g.FamilyMedicalInsuranceIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (g *Garnishment1) SetFamilyMedicalInsuranceIndicatorFromBool(value bool) {
+	g.FamilyMedicalInsuranceIndicator = new(TrueFalseIndicator)
+	g.FamilyMedicalInsuranceIndicator.FromBool(value)
+}
+
 func (g *Garnishment1) SetEmployeeTerminationIndicator(value string) {
 	g.EmployeeTerminationIndicator = (*TrueFalseIndicator)(&value)
 }
+
+func (g *Garnishment1) SetEmployeeTerminationIndicatorFromBool(value bool) {
+	g.EmployeeTerminationIndicator = new(TrueFalseIndicator)
+	g.EmployeeTerminationIndicator.FromBool(value)
+}
+
+func (g *Garnishment1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.Type != nil {
+		g.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if g.Garnishee != nil {
+		g.Garnishee.EncodeXMLFast(enc, "Grnshee")
+	}
+	if g.GarnishmentAdministrator != nil {
+		g.GarnishmentAdministrator.EncodeXMLFast(enc, "GrnshmtAdmstr")
+	}
+	if g.ReferenceNumber != nil {
+		enc.Element("RefNb", string(*g.ReferenceNumber))
+	}
+	if g.Date != nil {
+		enc.Element("Dt", string(*g.Date))
+	}
+	if g.RemittedAmount != nil {
+		g.RemittedAmount.EncodeXMLFast(enc, "RmtdAmt")
+	}
+	if g.FamilyMedicalInsuranceIndicator != nil {
+		enc.Element("FmlyMdclInsrncInd", string(*g.FamilyMedicalInsuranceIndicator))
+	}
+	if g.EmployeeTerminationIndicator != nil {
+		enc.Element("MplyeeTermntnInd", string(*g.EmployeeTerminationIndicator))
+	}
+	enc.End(tag)
+}
+
+func (g *Garnishment1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(GarnishmentType1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.Type = elem
+		case "Grnshee":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.Garnishee = elem
+		case "GrnshmtAdmstr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.GarnishmentAdministrator = elem
+		case "RefNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.ReferenceNumber = (*Max140Text)(&value)
+		case "Dt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Date = (*ISODate)(&value)
+		case "RmtdAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.RemittedAmount = elem
+		case "FmlyMdclInsrncInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.FamilyMedicalInsuranceIndicator = (*TrueFalseIndicator)(&value)
+		case "MplyeeTermntnInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.EmployeeTerminationIndicator = (*TrueFalseIndicator)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
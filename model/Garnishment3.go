@@ -0,0 +1,189 @@
+package model
+
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Provides remittance information about a payment for garnishment-related purposes.
+type Garnishment3 struct {
+
+	// Specifies the type of garnishment.
+	Type *GarnishmentType1 `xml:"Tp"`
+
+	// Ultimate party that owes an amount of money to the (ultimate) creditor, in this case, to the garnisher.
+	Garnishee *PartyIdentification135 `xml:"Grnshee,omitempty"`
+
+	// Party on the credit side of the transaction who administers the garnishment on behalf of the ultimate beneficiary.
+	GarnishmentAdministrator *PartyIdentification135 `xml:"GrnshmtAdmstr,omitempty"`
+
+	// Reference information that is specific to the agency receiving the garnishment.
+	ReferenceNumber *Max140Text `xml:"RefNb,omitempty"`
+
+	// Date of payment which garnishment was taken from.
+	Date *ISODate `xml:"Dt,omitempty"`
+
+	// Amount of money remitted for the referred document.
+	RemittedAmount *ActiveOrHistoricCurrencyAndAmount `xml:"RmtdAmt,omitempty"`
+
+	// Indicates if the person to whom the garnishment applies (that is, the ultimate debtor) has family medical insurance coverage available.
+	FamilyMedicalInsuranceIndicator *TrueFalseIndicator `xml:"FmlyMdclInsrncInd,omitempty"`
+
+	// Indicates if the employment of the person to whom the garnishment applies (that is, the ultimate debtor) has been terminated.
+	EmployeeTerminationIndicator *TrueFalseIndicator `xml:"MplyeeTermntnInd,omitempty"`
+}
+
+func (g *Garnishment3) Validate() error {
+	return ValidateElement(g)
+}
+
+func (g *Garnishment3) AddType() *GarnishmentType1 {
+	g.Type = new(GarnishmentType1)
+	return g.Type
+}
+
+func (g *Garnishment3) AddGarnishee() *PartyIdentification135 {
+	g.Garnishee = new(PartyIdentification135)
+	return g.Garnishee
+}
+
+func (g *Garnishment3) AddGarnishmentAdministrator() *PartyIdentification135 {
+	g.GarnishmentAdministrator = new(PartyIdentification135)
+	return g.GarnishmentAdministrator
+}
+
+func (g *Garnishment3) SetReferenceNumber(value string) {
+	g.ReferenceNumber = (*Max140Text)(&value)
+}
+
+func (g *Garnishment3) SetDate(value string) {
+	g.Date = (*ISODate)(&value)
+}
+
+func (g *Garnishment3) SetDateFromTime(value time.Time) {
+	g.Date = new(ISODate)
+	g.Date.FromTime(value)
+}
+
+func (g *Garnishment3) SetRemittedAmount(value, currency string) {
+	g.RemittedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (g *Garnishment3) SetRemittedAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.RemittedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (g *Garnishment3) SetFamilyMedicalInsuranceIndicator(value string) {
+	g.FamilyMedicalInsuranceIndicator = (*TrueFalseIndicator)(&value)
+}
+
+func (g *Garnishment3) SetFamilyMedicalInsuranceIndicatorFromBool(value bool) {
+	g.FamilyMedicalInsuranceIndicator = new(TrueFalseIndicator)
+	g.FamilyMedicalInsuranceIndicator.FromBool(value)
+}
+
+func (g *Garnishment3) SetEmployeeTerminationIndicator(value string) {
+	g.EmployeeTerminationIndicator = (*TrueFalseIndicator)(&value)
+}
+
+func (g *Garnishment3) SetEmployeeTerminationIndicatorFromBool(value bool) {
+	g.EmployeeTerminationIndicator = new(TrueFalseIndicator)
+	g.EmployeeTerminationIndicator.FromBool(value)
+}
+
+func (g *Garnishment3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.Type != nil {
+		g.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if g.Garnishee != nil {
+		g.Garnishee.EncodeXMLFast(enc, "Grnshee")
+	}
+	if g.GarnishmentAdministrator != nil {
+		g.GarnishmentAdministrator.EncodeXMLFast(enc, "GrnshmtAdmstr")
+	}
+	if g.ReferenceNumber != nil {
+		enc.Element("RefNb", string(*g.ReferenceNumber))
+	}
+	if g.Date != nil {
+		enc.Element("Dt", string(*g.Date))
+	}
+	if g.RemittedAmount != nil {
+		g.RemittedAmount.EncodeXMLFast(enc, "RmtdAmt")
+	}
+	if g.FamilyMedicalInsuranceIndicator != nil {
+		enc.Element("FmlyMdclInsrncInd", string(*g.FamilyMedicalInsuranceIndicator))
+	}
+	if g.EmployeeTerminationIndicator != nil {
+		enc.Element("MplyeeTermntnInd", string(*g.EmployeeTerminationIndicator))
+	}
+	enc.End(tag)
+}
+
+func (g *Garnishment3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(GarnishmentType1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.Type = elem
+		case "Grnshee":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.Garnishee = elem
+		case "GrnshmtAdmstr":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.GarnishmentAdministrator = elem
+		case "RefNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.ReferenceNumber = (*Max140Text)(&value)
+		case "Dt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Date = (*ISODate)(&value)
+		case "RmtdAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.RemittedAmount = elem
+		case "FmlyMdclInsrncInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.FamilyMedicalInsuranceIndicator = (*TrueFalseIndicator)(&value)
+		case "MplyeeTermntnInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.EmployeeTerminationIndicator = (*TrueFalseIndicator)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
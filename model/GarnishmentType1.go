@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the type of garnishment.
 type GarnishmentType1 struct {
 
@@ -10,6 +12,10 @@ type GarnishmentType1 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (g *GarnishmentType1) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GarnishmentType1) AddCodeOrProprietary() *GarnishmentType1Choice {
 	g.CodeOrProprietary = new(GarnishmentType1Choice)
 	return g.CodeOrProprietary
@@ -18,3 +24,44 @@ func (g *GarnishmentType1) AddCodeOrProprietary() *GarnishmentType1Choice {
 func (g *GarnishmentType1) SetIssuer(value string) {
 	g.Issuer = (*Max35Text)(&value)
 }
+
+func (g *GarnishmentType1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.CodeOrProprietary != nil {
+		g.CodeOrProprietary.EncodeXMLFast(enc, "CdOrPrtry")
+	}
+	if g.Issuer != nil {
+		enc.Element("Issr", string(*g.Issuer))
+	}
+	enc.End(tag)
+}
+
+func (g *GarnishmentType1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "CdOrPrtry":
+			elem := new(GarnishmentType1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.CodeOrProprietary = elem
+		case "Issr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Issuer = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the type of garnishment.
 type GarnishmentType1Choice struct {
 
@@ -14,6 +16,10 @@ type GarnishmentType1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (g *GarnishmentType1Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GarnishmentType1Choice) SetCode(value string) {
 	g.Code = (*ExternalGarnishmentType1Code)(&value)
 }
@@ -21,3 +27,44 @@ func (g *GarnishmentType1Choice) SetCode(value string) {
 func (g *GarnishmentType1Choice) SetProprietary(value string) {
 	g.Proprietary = (*Max35Text)(&value)
 }
+
+func (g *GarnishmentType1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.Code != nil {
+		enc.Element("Cd", string(*g.Code))
+	}
+	if g.Proprietary != nil {
+		enc.Element("Prtry", string(*g.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (g *GarnishmentType1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Code = (*ExternalGarnishmentType1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
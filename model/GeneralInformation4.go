@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information concerning the negotiation process leading to a treasury trade.
 type GeneralInformation4 struct {
 
@@ -34,10 +38,19 @@ type GeneralInformation4 struct {
 	ContactInformation *ContactInformation1 `xml:"CtctInf,omitempty"`
 }
 
+func (g *GeneralInformation4) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GeneralInformation4) SetBlockIndicator(value string) {
 	g.BlockIndicator = (*YesNoIndicator)(&value)
 }
 
+func (g *GeneralInformation4) SetBlockIndicatorFromBool(value bool) {
+	g.BlockIndicator = new(YesNoIndicator)
+	g.BlockIndicator.FromBool(value)
+}
+
 func (g *GeneralInformation4) SetRelatedTradeReference(value string) {
 	g.RelatedTradeReference = (*Max35Text)(&value)
 }
@@ -59,6 +72,10 @@ func (g *GeneralInformation4) SetBrokersCommission(value, currency string) {
 	g.BrokersCommission = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (g *GeneralInformation4) SetBrokersCommissionFromDecimal(value decimal.Decimal, currency string) {
+	g.BrokersCommission = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (g *GeneralInformation4) SetSenderToReceiverInformation(value string) {
 	g.SenderToReceiverInformation = (*Max210Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information concerning the negotiation process leading to a treasury trade.
 type GeneralInformation5 struct {
 
@@ -43,10 +47,19 @@ type GeneralInformation5 struct {
 	BrokersReference *Max35Text `xml:"BrkrsRef,omitempty"`
 }
 
+func (g *GeneralInformation5) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GeneralInformation5) SetBlockIndicator(value string) {
 	g.BlockIndicator = (*YesNoIndicator)(&value)
 }
 
+func (g *GeneralInformation5) SetBlockIndicatorFromBool(value bool) {
+	g.BlockIndicator = new(YesNoIndicator)
+	g.BlockIndicator.FromBool(value)
+}
+
 func (g *GeneralInformation5) SetRelatedTradeReference(value string) {
 	g.RelatedTradeReference = (*Max35Text)(&value)
 }
@@ -68,6 +81,10 @@ func (g *GeneralInformation5) SetBrokersCommission(value, currency string) {
 	g.BrokersCommission = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (g *GeneralInformation5) SetBrokersCommissionFromDecimal(value decimal.Decimal, currency string) {
+	g.BrokersCommission = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (g *GeneralInformation5) SetSenderToReceiverInformation(value string) {
 	g.SenderToReceiverInformation = (*Max210Text)(&value)
 }
@@ -10,6 +10,10 @@ type GeneratedReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (g *GeneratedReason1) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GeneratedReason1) AddCode() *GeneratedReasons1Choice {
 	g.Code = new(GeneratedReasons1Choice)
 	return g.Code
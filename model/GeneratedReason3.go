@@ -10,6 +10,10 @@ type GeneratedReason3 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (g *GeneratedReason3) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GeneratedReason3) AddCode() *GeneratedReasons3Choice {
 	g.Code = new(GeneratedReasons3Choice)
 	return g.Code
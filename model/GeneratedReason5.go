@@ -10,6 +10,10 @@ type GeneratedReason5 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (g *GeneratedReason5) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GeneratedReason5) AddCode() *GeneratedReasons5Choice {
 	g.Code = new(GeneratedReasons5Choice)
 	return g.Code
@@ -10,6 +10,10 @@ type GeneratedReason6 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (g *GeneratedReason6) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GeneratedReason6) AddCode() *GeneratedReasons6Choice {
 	g.Code = new(GeneratedReasons6Choice)
 	return g.Code
@@ -10,6 +10,10 @@ type GeneratedReasons1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (g *GeneratedReasons1Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GeneratedReasons1Choice) SetCode(value string) {
 	g.Code = (*GeneratedReason2Code)(&value)
 }
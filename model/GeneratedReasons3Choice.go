@@ -10,6 +10,10 @@ type GeneratedReasons3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (g *GeneratedReasons3Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GeneratedReasons3Choice) SetCode(value string) {
 	g.Code = (*GeneratedReason3Code)(&value)
 }
@@ -10,6 +10,10 @@ type GeneratedReasons5Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (g *GeneratedReasons5Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GeneratedReasons5Choice) SetCode(value string) {
 	g.Code = (*GeneratedReason3Code)(&value)
 }
@@ -10,6 +10,10 @@ type GeneratedReasons6Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (g *GeneratedReasons6Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GeneratedReasons6Choice) SetCode(value string) {
 	g.Code = (*GeneratedReason3Code)(&value)
 }
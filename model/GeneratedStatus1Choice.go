@@ -10,8 +10,16 @@ type GeneratedStatus1Choice struct {
 	Reason []*GeneratedReason1 `xml:"Rsn,omitempty"`
 }
 
-func (g *GeneratedStatus1Choice) SetNoSpecifiedReason(value string) {
+func (g *GeneratedStatus1Choice) Validate() error {
+	return ValidateElement(g)
+}
+
+func (g *GeneratedStatus1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	g.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (g *GeneratedStatus1Choice) AddReason() *GeneratedReason1 {
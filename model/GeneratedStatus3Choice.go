@@ -10,8 +10,16 @@ type GeneratedStatus3Choice struct {
 	Reason []*GeneratedReason1 `xml:"Rsn"`
 }
 
-func (g *GeneratedStatus3Choice) SetNoSpecifiedReason(value string) {
+func (g *GeneratedStatus3Choice) Validate() error {
+	return ValidateElement(g)
+}
+
+func (g *GeneratedStatus3Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	g.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (g *GeneratedStatus3Choice) AddReason() *GeneratedReason1 {
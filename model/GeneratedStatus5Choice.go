@@ -10,8 +10,16 @@ type GeneratedStatus5Choice struct {
 	Reason []*GeneratedReason3 `xml:"Rsn"`
 }
 
-func (g *GeneratedStatus5Choice) SetNoSpecifiedReason(value string) {
+func (g *GeneratedStatus5Choice) Validate() error {
+	return ValidateElement(g)
+}
+
+func (g *GeneratedStatus5Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	g.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (g *GeneratedStatus5Choice) AddReason() *GeneratedReason3 {
@@ -10,8 +10,16 @@ type GeneratedStatus7Choice struct {
 	Reason []*GeneratedReason5 `xml:"Rsn"`
 }
 
-func (g *GeneratedStatus7Choice) SetNoSpecifiedReason(value string) {
+func (g *GeneratedStatus7Choice) Validate() error {
+	return ValidateElement(g)
+}
+
+func (g *GeneratedStatus7Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	g.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (g *GeneratedStatus7Choice) AddReason() *GeneratedReason5 {
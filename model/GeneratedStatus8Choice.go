@@ -10,8 +10,16 @@ type GeneratedStatus8Choice struct {
 	Reason []*GeneratedReason6 `xml:"Rsn"`
 }
 
-func (g *GeneratedStatus8Choice) SetNoSpecifiedReason(value string) {
+func (g *GeneratedStatus8Choice) Validate() error {
+	return ValidateElement(g)
+}
+
+func (g *GeneratedStatus8Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	g.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (g *GeneratedStatus8Choice) AddReason() *GeneratedReason6 {
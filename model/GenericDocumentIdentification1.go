@@ -10,6 +10,10 @@ type GenericDocumentIdentification1 struct {
 	Identification *Max35Text `xml:"Id"`
 }
 
+func (g *GenericDocumentIdentification1) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericDocumentIdentification1) AddMessageNumber() *DocumentNumber1Choice {
 	g.MessageNumber = new(DocumentNumber1Choice)
 	return g.MessageNumber
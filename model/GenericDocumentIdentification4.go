@@ -10,6 +10,10 @@ type GenericDocumentIdentification4 struct {
 	Identification *Max35Text `xml:"Id"`
 }
 
+func (g *GenericDocumentIdentification4) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericDocumentIdentification4) AddMessageNumber() *DocumentNumber5Choice {
 	g.MessageNumber = new(DocumentNumber5Choice)
 	return g.MessageNumber
@@ -10,6 +10,10 @@ type GenericDocumentIdentification5 struct {
 	Identification *RestrictedFINXMax16Text `xml:"Id"`
 }
 
+func (g *GenericDocumentIdentification5) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericDocumentIdentification5) AddMessageNumber() *DocumentNumber6Choice {
 	g.MessageNumber = new(DocumentNumber6Choice)
 	return g.MessageNumber
@@ -10,6 +10,10 @@ type GenericDocumentIdentification6 struct {
 	Identification *RestrictedFINXMax16Text `xml:"Id"`
 }
 
+func (g *GenericDocumentIdentification6) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericDocumentIdentification6) AddMessageNumber() *DocumentNumber16Choice {
 	g.MessageNumber = new(DocumentNumber16Choice)
 	return g.MessageNumber
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Information related to an identification, eg, party identification or account identification.
 type GenericIdentification1 struct {
 
@@ -13,6 +15,10 @@ type GenericIdentification1 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (g *GenericIdentification1) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification1) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -24,3 +30,53 @@ func (g *GenericIdentification1) SetSchemeName(value string) {
 func (g *GenericIdentification1) SetIssuer(value string) {
 	g.Issuer = (*Max35Text)(&value)
 }
+
+func (g *GenericIdentification1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.Identification != nil {
+		enc.Element("Id", string(*g.Identification))
+	}
+	if g.SchemeName != nil {
+		enc.Element("SchmeNm", string(*g.SchemeName))
+	}
+	if g.Issuer != nil {
+		enc.Element("Issr", string(*g.Issuer))
+	}
+	enc.End(tag)
+}
+
+func (g *GenericIdentification1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Identification = (*Max35Text)(&value)
+		case "SchmeNm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.SchemeName = (*Max35Text)(&value)
+		case "Issr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Issuer = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
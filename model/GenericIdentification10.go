@@ -13,6 +13,10 @@ type GenericIdentification10 struct {
 	ExtendedIdentificationType *Extended350Code `xml:"XtndedIdTp"`
 }
 
+func (g *GenericIdentification10) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification10) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type GenericIdentification11 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (g *GenericIdentification11) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification11) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type GenericIdentification13 struct {
 	Issuer *Max35Text `xml:"Issr"`
 }
 
+func (g *GenericIdentification13) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification13) SetIdentification(value string) {
 	g.Identification = (*Max4AlphaNumericText)(&value)
 }
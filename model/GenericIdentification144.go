@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Proprietary information related to a balance.
 type GenericIdentification144 struct {
 
@@ -16,6 +20,10 @@ type GenericIdentification144 struct {
 	Balance *RestrictedFINDecimalNumber `xml:"Bal"`
 }
 
+func (g *GenericIdentification144) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification144) SetIdentification(value string) {
 	g.Identification = (*Exact4AlphaNumericText)(&value)
 }
@@ -31,3 +39,8 @@ func (g *GenericIdentification144) SetSchemeName(value string) {
 func (g *GenericIdentification144) SetBalance(value string) {
 	g.Balance = (*RestrictedFINDecimalNumber)(&value)
 }
+
+func (g *GenericIdentification144) SetBalanceFromDecimal(value decimal.Decimal) {
+	g.Balance = new(RestrictedFINDecimalNumber)
+	g.Balance.FromDecimal(value)
+}
@@ -13,6 +13,10 @@ type GenericIdentification16 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (g *GenericIdentification16) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification16) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type GenericIdentification163 struct {
 	SchemeName *Max4AlphaNumericText `xml:"SchmeNm,omitempty"`
 }
 
+func (g *GenericIdentification163) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification163) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type GenericIdentification164 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (g *GenericIdentification164) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification164) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
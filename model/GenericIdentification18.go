@@ -13,6 +13,10 @@ type GenericIdentification18 struct {
 	Issuer *Max4AlphaNumericText `xml:"Issr,omitempty"`
 }
 
+func (g *GenericIdentification18) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification18) SetIdentification(value string) {
 	g.Identification = (*RestrictedFINXMax30Text)(&value)
 }
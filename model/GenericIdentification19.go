@@ -13,6 +13,10 @@ type GenericIdentification19 struct {
 	SchemeName *Max35Text `xml:"SchmeNm,omitempty"`
 }
 
+func (g *GenericIdentification19) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification19) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type GenericIdentification2 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (g *GenericIdentification2) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification2) SetSchemeName(value string) {
 	g.SchemeName = (*Max35Text)(&value)
 }
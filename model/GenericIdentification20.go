@@ -13,6 +13,10 @@ type GenericIdentification20 struct {
 	SchemeName *Max35Text `xml:"SchmeNm,omitempty"`
 }
 
+func (g *GenericIdentification20) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification20) SetIdentification(value string) {
 	g.Identification = (*Exact4AlphaNumericText)(&value)
 }
@@ -10,6 +10,10 @@ type GenericIdentification21 struct {
 	Identification *Max35Text `xml:"Id,omitempty"`
 }
 
+func (g *GenericIdentification21) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification21) AddType() *GenericIdentification20 {
 	g.Type = new(GenericIdentification20)
 	return g.Type
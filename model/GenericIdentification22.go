@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Proprietary information related to a balance.
 type GenericIdentification22 struct {
 
@@ -16,6 +20,10 @@ type GenericIdentification22 struct {
 	Balance *DecimalNumber `xml:"Bal"`
 }
 
+func (g *GenericIdentification22) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification22) SetIdentification(value string) {
 	g.Identification = (*Exact4AlphaNumericText)(&value)
 }
@@ -31,3 +39,8 @@ func (g *GenericIdentification22) SetSchemeName(value string) {
 func (g *GenericIdentification22) SetBalance(value string) {
 	g.Balance = (*DecimalNumber)(&value)
 }
+
+func (g *GenericIdentification22) SetBalanceFromDecimal(value decimal.Decimal) {
+	g.Balance = new(DecimalNumber)
+	g.Balance.FromDecimal(value)
+}
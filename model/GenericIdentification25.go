@@ -13,6 +13,10 @@ type GenericIdentification25 struct {
 	SchemeName *Max4AlphaNumericText `xml:"SchmeNm,omitempty"`
 }
 
+func (g *GenericIdentification25) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification25) SetIdentification(value string) {
 	g.Identification = (*Exact4AlphaNumericText)(&value)
 }
@@ -13,6 +13,10 @@ type GenericIdentification27 struct {
 	Issuer *Max4AlphaNumericText `xml:"Issr"`
 }
 
+func (g *GenericIdentification27) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification27) SetIdentification(value string) {
 	g.Identification = (*Max4AlphaNumericText)(&value)
 }
@@ -13,6 +13,10 @@ type GenericIdentification29 struct {
 	SchemeName *Max35Text `xml:"SchmeNm,omitempty"`
 }
 
+func (g *GenericIdentification29) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification29) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Information related to an identification, eg, party identification or account identification.
 type GenericIdentification3 struct {
 
@@ -10,6 +12,10 @@ type GenericIdentification3 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (g *GenericIdentification3) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification3) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -17,3 +23,44 @@ func (g *GenericIdentification3) SetIdentification(value string) {
 func (g *GenericIdentification3) SetIssuer(value string) {
 	g.Issuer = (*Max35Text)(&value)
 }
+
+func (g *GenericIdentification3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.Identification != nil {
+		enc.Element("Id", string(*g.Identification))
+	}
+	if g.Issuer != nil {
+		enc.Element("Issr", string(*g.Issuer))
+	}
+	enc.End(tag)
+}
+
+func (g *GenericIdentification3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Identification = (*Max35Text)(&value)
+		case "Issr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Issuer = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
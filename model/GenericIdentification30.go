@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Information related to an identification, for example, party identification or account identification.
 type GenericIdentification30 struct {
 
@@ -13,6 +15,10 @@ type GenericIdentification30 struct {
 	SchemeName *Max35Text `xml:"SchmeNm,omitempty"`
 }
 
+func (g *GenericIdentification30) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification30) SetIdentification(value string) {
 	g.Identification = (*Exact4AlphaNumericText)(&value)
 }
@@ -24,3 +30,53 @@ func (g *GenericIdentification30) SetIssuer(value string) {
 func (g *GenericIdentification30) SetSchemeName(value string) {
 	g.SchemeName = (*Max35Text)(&value)
 }
+
+func (g *GenericIdentification30) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.Identification != nil {
+		enc.Element("Id", string(*g.Identification))
+	}
+	if g.Issuer != nil {
+		enc.Element("Issr", string(*g.Issuer))
+	}
+	if g.SchemeName != nil {
+		enc.Element("SchmeNm", string(*g.SchemeName))
+	}
+	enc.End(tag)
+}
+
+func (g *GenericIdentification30) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Identification = (*Exact4AlphaNumericText)(&value)
+		case "Issr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Issuer = (*Max35Text)(&value)
+		case "SchmeNm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.SchemeName = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -16,6 +16,10 @@ type GenericIdentification31 struct {
 	ShortName *Max35Text `xml:"ShrtNm,omitempty"`
 }
 
+func (g *GenericIdentification31) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification31) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
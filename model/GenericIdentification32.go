@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Identification of an entity.
 type GenericIdentification32 struct {
 
@@ -16,6 +18,10 @@ type GenericIdentification32 struct {
 	ShortName *Max35Text `xml:"ShrtNm,omitempty"`
 }
 
+func (g *GenericIdentification32) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification32) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -31,3 +37,62 @@ func (g *GenericIdentification32) SetIssuer(value string) {
 func (g *GenericIdentification32) SetShortName(value string) {
 	g.ShortName = (*Max35Text)(&value)
 }
+
+func (g *GenericIdentification32) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.Identification != nil {
+		enc.Element("Id", string(*g.Identification))
+	}
+	if g.Type != nil {
+		enc.Element("Tp", string(*g.Type))
+	}
+	if g.Issuer != nil {
+		enc.Element("Issr", string(*g.Issuer))
+	}
+	if g.ShortName != nil {
+		enc.Element("ShrtNm", string(*g.ShortName))
+	}
+	enc.End(tag)
+}
+
+func (g *GenericIdentification32) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Identification = (*Max35Text)(&value)
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Type = (*PartyType3Code)(&value)
+		case "Issr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Issuer = (*PartyType4Code)(&value)
+		case "ShrtNm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.ShortName = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
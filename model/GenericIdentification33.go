@@ -16,6 +16,10 @@ type GenericIdentification33 struct {
 	ShortName *Max35Text `xml:"ShrtNm,omitempty"`
 }
 
+func (g *GenericIdentification33) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification33) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
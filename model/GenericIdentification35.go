@@ -16,6 +16,10 @@ type GenericIdentification35 struct {
 	ShortName *Max35Text `xml:"ShrtNm,omitempty"`
 }
 
+func (g *GenericIdentification35) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification35) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type GenericIdentification36 struct {
 	SchemeName *Max35Text `xml:"SchmeNm,omitempty"`
 }
 
+func (g *GenericIdentification36) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification36) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
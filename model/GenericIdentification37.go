@@ -10,6 +10,10 @@ type GenericIdentification37 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (g *GenericIdentification37) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification37) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type GenericIdentification38 struct {
 	SchemeName *Max35Text `xml:"SchmeNm,omitempty"`
 }
 
+func (g *GenericIdentification38) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification38) SetIdentification(value string) {
 	g.Identification = (*Exact4AlphaNumericText)(&value)
 }
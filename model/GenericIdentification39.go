@@ -10,6 +10,10 @@ type GenericIdentification39 struct {
 	Issuer *RestrictedFINMax8Text `xml:"Issr,omitempty"`
 }
 
+func (g *GenericIdentification39) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification39) SetIdentification(value string) {
 	g.Identification = (*RestrictedFINMax30Text)(&value)
 }
@@ -11,6 +11,10 @@ type GenericIdentification4 struct {
 	IdentificationType *Max35Text `xml:"IdTp"`
 }
 
+func (g *GenericIdentification4) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification4) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type GenericIdentification40 struct {
 	SchemeName *Max35Text `xml:"SchmeNm,omitempty"`
 }
 
+func (g *GenericIdentification40) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification40) SetIdentification(value string) {
 	g.Identification = (*Exact4AlphaNumericText)(&value)
 }
@@ -13,6 +13,10 @@ type GenericIdentification41 struct {
 	SchemeName *Max35Text `xml:"SchmeNm,omitempty"`
 }
 
+func (g *GenericIdentification41) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification41) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information related to the identification of an individual person.
 type GenericIdentification44 struct {
 
@@ -19,6 +23,10 @@ type GenericIdentification44 struct {
 	ExpiryDate *ISODate `xml:"XpryDt,omitempty"`
 }
 
+func (g *GenericIdentification44) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification44) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -36,6 +44,16 @@ func (g *GenericIdentification44) SetIssueDate(value string) {
 	g.IssueDate = (*ISODate)(&value)
 }
 
+func (g *GenericIdentification44) SetIssueDateFromTime(value time.Time) {
+	g.IssueDate = new(ISODate)
+	g.IssueDate.FromTime(value)
+}
+
 func (g *GenericIdentification44) SetExpiryDate(value string) {
 	g.ExpiryDate = (*ISODate)(&value)
 }
+
+func (g *GenericIdentification44) SetExpiryDateFromTime(value time.Time) {
+	g.ExpiryDate = new(ISODate)
+	g.ExpiryDate.FromTime(value)
+}
@@ -10,6 +10,10 @@ type GenericIdentification46 struct {
 	Type *OtherIdentification1Choice `xml:"Tp"`
 }
 
+func (g *GenericIdentification46) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification46) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type GenericIdentification47 struct {
 	SchemeName *Max4AlphaNumericText `xml:"SchmeNm,omitempty"`
 }
 
+func (g *GenericIdentification47) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification47) SetIdentification(value string) {
 	g.Identification = (*Exact4AlphaNumericText)(&value)
 }
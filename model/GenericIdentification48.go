@@ -13,6 +13,10 @@ type GenericIdentification48 struct {
 	Issuer *Max35Text `xml:"Issr"`
 }
 
+func (g *GenericIdentification48) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification48) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type GenericIdentification49 struct {
 	IdentificationType *Max35Text `xml:"IdTp"`
 }
 
+func (g *GenericIdentification49) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification49) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
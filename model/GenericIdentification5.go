@@ -13,6 +13,10 @@ type GenericIdentification5 struct {
 	Narrative *Max35Text `xml:"Nrrtv,omitempty"`
 }
 
+func (g *GenericIdentification5) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification5) SetIssuer(value string) {
 	g.Issuer = (*Max8Text)(&value)
 }
@@ -19,6 +19,10 @@ type GenericIdentification53 struct {
 	ShortName *Max35Text `xml:"ShrtNm,omitempty"`
 }
 
+func (g *GenericIdentification53) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification53) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information related to the identification of an individual person.
 type GenericIdentification55 struct {
 
@@ -22,6 +26,10 @@ type GenericIdentification55 struct {
 	IssuerCountry *CountryCode `xml:"IssrCtry,omitempty"`
 }
 
+func (g *GenericIdentification55) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification55) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -39,10 +47,20 @@ func (g *GenericIdentification55) SetIssueDate(value string) {
 	g.IssueDate = (*ISODate)(&value)
 }
 
+func (g *GenericIdentification55) SetIssueDateFromTime(value time.Time) {
+	g.IssueDate = new(ISODate)
+	g.IssueDate.FromTime(value)
+}
+
 func (g *GenericIdentification55) SetExpiryDate(value string) {
 	g.ExpiryDate = (*ISODate)(&value)
 }
 
+func (g *GenericIdentification55) SetExpiryDateFromTime(value time.Time) {
+	g.ExpiryDate = new(ISODate)
+	g.ExpiryDate.FromTime(value)
+}
+
 func (g *GenericIdentification55) SetIssuerCountry(value string) {
 	g.IssuerCountry = (*CountryCode)(&value)
 }
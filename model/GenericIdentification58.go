@@ -10,6 +10,10 @@ type GenericIdentification58 struct {
 	Type *GenericIdentification40 `xml:"Tp"`
 }
 
+func (g *GenericIdentification58) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification58) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Balance expressed with a data source scheme.
 type GenericIdentification6 struct {
 
@@ -13,6 +17,10 @@ type GenericIdentification6 struct {
 	Balance *Number `xml:"Bal"`
 }
 
+func (g *GenericIdentification6) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification6) SetIssuer(value string) {
 	g.Issuer = (*Max8Text)(&value)
 }
@@ -24,3 +32,8 @@ func (g *GenericIdentification6) SetInformation(value string) {
 func (g *GenericIdentification6) SetBalance(value string) {
 	g.Balance = (*Number)(&value)
 }
+
+func (g *GenericIdentification6) SetBalanceFromDecimal(value decimal.Decimal) {
+	g.Balance = new(Number)
+	g.Balance.FromDecimal(value)
+}
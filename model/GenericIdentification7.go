@@ -10,6 +10,10 @@ type GenericIdentification7 struct {
 	Information *Max35Text `xml:"Inf"`
 }
 
+func (g *GenericIdentification7) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification7) SetIssuer(value string) {
 	g.Issuer = (*Max8Text)(&value)
 }
@@ -19,6 +19,10 @@ type GenericIdentification70 struct {
 	ShortName *Max35Text `xml:"ShrtNm,omitempty"`
 }
 
+func (g *GenericIdentification70) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification70) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
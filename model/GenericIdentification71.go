@@ -19,6 +19,10 @@ type GenericIdentification71 struct {
 	ShortName *Max35Text `xml:"ShrtNm,omitempty"`
 }
 
+func (g *GenericIdentification71) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification71) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type GenericIdentification72 struct {
 	ShortName *Max35Text `xml:"ShrtNm,omitempty"`
 }
 
+func (g *GenericIdentification72) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification72) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
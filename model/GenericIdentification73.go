@@ -19,6 +19,10 @@ type GenericIdentification73 struct {
 	ShortName *Max35Text `xml:"ShrtNm,omitempty"`
 }
 
+func (g *GenericIdentification73) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification73) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type GenericIdentification74 struct {
 	ShortName *Max35Text `xml:"ShrtNm,omitempty"`
 }
 
+func (g *GenericIdentification74) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification74) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type GenericIdentification75 struct {
 	ShortName *Max35Text `xml:"ShrtNm,omitempty"`
 }
 
+func (g *GenericIdentification75) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification75) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
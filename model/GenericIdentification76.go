@@ -19,6 +19,10 @@ type GenericIdentification76 struct {
 	ShortName *Max35Text `xml:"ShrtNm,omitempty"`
 }
 
+func (g *GenericIdentification76) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification76) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type GenericIdentification77 struct {
 	ShortName *Max35Text `xml:"ShrtNm,omitempty"`
 }
 
+func (g *GenericIdentification77) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification77) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
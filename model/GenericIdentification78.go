@@ -10,6 +10,10 @@ type GenericIdentification78 struct {
 	Identification *Max35Text `xml:"Id,omitempty"`
 }
 
+func (g *GenericIdentification78) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification78) AddType() *GenericIdentification30 {
 	g.Type = new(GenericIdentification30)
 	return g.Type
@@ -13,6 +13,10 @@ type GenericIdentification79 struct {
 	SchemeName *Max4AlphaNumericText `xml:"SchmeNm,omitempty"`
 }
 
+func (g *GenericIdentification79) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification79) SetIdentification(value string) {
 	g.Identification = (*RestrictedFINXMax34Text)(&value)
 }
@@ -13,6 +13,10 @@ type GenericIdentification8 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (g *GenericIdentification8) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification8) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
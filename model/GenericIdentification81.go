@@ -10,6 +10,10 @@ type GenericIdentification81 struct {
 	IdentificationType *OtherIdentification3Choice `xml:"IdTp"`
 }
 
+func (g *GenericIdentification81) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification81) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
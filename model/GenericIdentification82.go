@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information related to the identification of a party.
 type GenericIdentification82 struct {
 
@@ -25,6 +29,10 @@ type GenericIdentification82 struct {
 	IssuerCountry *CountryCode `xml:"IssrCtry,omitempty"`
 }
 
+func (g *GenericIdentification82) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification82) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -42,10 +50,20 @@ func (g *GenericIdentification82) SetIssueDate(value string) {
 	g.IssueDate = (*ISODate)(&value)
 }
 
+func (g *GenericIdentification82) SetIssueDateFromTime(value time.Time) {
+	g.IssueDate = new(ISODate)
+	g.IssueDate.FromTime(value)
+}
+
 func (g *GenericIdentification82) SetExpiryDate(value string) {
 	g.ExpiryDate = (*ISODate)(&value)
 }
 
+func (g *GenericIdentification82) SetExpiryDateFromTime(value time.Time) {
+	g.ExpiryDate = new(ISODate)
+	g.ExpiryDate.FromTime(value)
+}
+
 func (g *GenericIdentification82) SetState(value string) {
 	g.State = (*Max70Text)(&value)
 }
@@ -13,6 +13,10 @@ type GenericIdentification84 struct {
 	SchemeName *Max4AlphaNumericText `xml:"SchmeNm,omitempty"`
 }
 
+func (g *GenericIdentification84) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification84) SetIdentification(value string) {
 	g.Identification = (*RestrictedFINXMax34Text)(&value)
 }
@@ -10,6 +10,10 @@ type GenericIdentification85 struct {
 	Identification *RestrictedFINXMax30Text `xml:"Id,omitempty"`
 }
 
+func (g *GenericIdentification85) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification85) AddType() *GenericIdentification47 {
 	g.Type = new(GenericIdentification47)
 	return g.Type
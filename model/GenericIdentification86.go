@@ -13,6 +13,10 @@ type GenericIdentification86 struct {
 	SchemeName *Max4AlphaNumericText `xml:"SchmeNm,omitempty"`
 }
 
+func (g *GenericIdentification86) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification86) SetIdentification(value string) {
 	g.Identification = (*RestrictedFINXMax30Text)(&value)
 }
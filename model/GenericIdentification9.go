@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information related to the identification of an individual person.
 type GenericIdentification9 struct {
 
@@ -22,6 +26,10 @@ type GenericIdentification9 struct {
 	ExpiryDate *ISODate `xml:"XpryDt,omitempty"`
 }
 
+func (g *GenericIdentification9) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification9) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -42,6 +50,16 @@ func (g *GenericIdentification9) SetIssueDate(value string) {
 	g.IssueDate = (*ISODate)(&value)
 }
 
+func (g *GenericIdentification9) SetIssueDateFromTime(value time.Time) {
+	g.IssueDate = new(ISODate)
+	g.IssueDate.FromTime(value)
+}
+
 func (g *GenericIdentification9) SetExpiryDate(value string) {
 	g.ExpiryDate = (*ISODate)(&value)
 }
+
+func (g *GenericIdentification9) SetExpiryDateFromTime(value time.Time) {
+	g.ExpiryDate = new(ISODate)
+	g.ExpiryDate.FromTime(value)
+}
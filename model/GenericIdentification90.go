@@ -19,6 +19,10 @@ type GenericIdentification90 struct {
 	ShortName *Max35Text `xml:"ShrtNm,omitempty"`
 }
 
+func (g *GenericIdentification90) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification90) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
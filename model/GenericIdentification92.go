@@ -22,6 +22,10 @@ type GenericIdentification92 struct {
 	RemoteAccess *NetworkParameters5 `xml:"RmotAccs,omitempty"`
 }
 
+func (g *GenericIdentification92) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification92) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
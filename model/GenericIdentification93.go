@@ -19,6 +19,10 @@ type GenericIdentification93 struct {
 	RemoteAccess *NetworkParameters5 `xml:"RmotAccs,omitempty"`
 }
 
+func (g *GenericIdentification93) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification93) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
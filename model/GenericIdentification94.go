@@ -22,6 +22,10 @@ type GenericIdentification94 struct {
 	RemoteAccess *NetworkParameters5 `xml:"RmotAccs,omitempty"`
 }
 
+func (g *GenericIdentification94) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericIdentification94) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
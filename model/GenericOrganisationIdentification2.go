@@ -13,6 +13,10 @@ type GenericOrganisationIdentification2 struct {
 	Issuer *RestrictedFINXMax35Text `xml:"Issr,omitempty"`
 }
 
+func (g *GenericOrganisationIdentification2) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericOrganisationIdentification2) SetIdentification(value string) {
 	g.Identification = (*RestrictedFINXMax35Text)(&value)
 }
@@ -0,0 +1,31 @@
+package model
+
+// Information related to an identification of an organisation.
+type GenericOrganisationIdentification3 struct {
+
+	// Identification assigned by an institution.
+	Identification *Max256Text `xml:"Id"`
+
+	// Name of the identification scheme.
+	SchemeName *OrganisationIdentificationSchemeName1Choice `xml:"SchmeNm,omitempty"`
+
+	// Entity that assigns the identification.
+	Issuer *Max35Text `xml:"Issr,omitempty"`
+}
+
+func (g *GenericOrganisationIdentification3) Validate() error {
+	return ValidateElement(g)
+}
+
+func (g *GenericOrganisationIdentification3) SetIdentification(value string) {
+	g.Identification = (*Max256Text)(&value)
+}
+
+func (g *GenericOrganisationIdentification3) AddSchemeName() *OrganisationIdentificationSchemeName1Choice {
+	g.SchemeName = new(OrganisationIdentificationSchemeName1Choice)
+	return g.SchemeName
+}
+
+func (g *GenericOrganisationIdentification3) SetIssuer(value string) {
+	g.Issuer = (*Max35Text)(&value)
+}
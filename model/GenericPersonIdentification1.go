@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Information related to an identification of a person.
 type GenericPersonIdentification1 struct {
 
@@ -13,6 +15,10 @@ type GenericPersonIdentification1 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (g *GenericPersonIdentification1) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericPersonIdentification1) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -25,3 +31,53 @@ func (g *GenericPersonIdentification1) AddSchemeName() *PersonIdentificationSche
 func (g *GenericPersonIdentification1) SetIssuer(value string) {
 	g.Issuer = (*Max35Text)(&value)
 }
+
+func (g *GenericPersonIdentification1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.Identification != nil {
+		enc.Element("Id", string(*g.Identification))
+	}
+	if g.SchemeName != nil {
+		g.SchemeName.EncodeXMLFast(enc, "SchmeNm")
+	}
+	if g.Issuer != nil {
+		enc.Element("Issr", string(*g.Issuer))
+	}
+	enc.End(tag)
+}
+
+func (g *GenericPersonIdentification1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Identification = (*Max35Text)(&value)
+		case "SchmeNm":
+			elem := new(PersonIdentificationSchemeName1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.SchemeName = elem
+		case "Issr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.Issuer = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package model
+
+// Unique and unambiguous identification of a person.
+type GenericPersonIdentification2 struct {
+
+	// Unique and unambiguous identification of a person.
+	Identification *Max256Text `xml:"Id"`
+
+	// Name of the identification scheme.
+	SchemeName *PersonIdentificationSchemeName1Choice `xml:"SchmeNm,omitempty"`
+
+	// Entity that assigns the identification.
+	Issuer *Max35Text `xml:"Issr,omitempty"`
+}
+
+func (g *GenericPersonIdentification2) Validate() error {
+	return ValidateElement(g)
+}
+
+func (g *GenericPersonIdentification2) SetIdentification(value string) {
+	g.Identification = (*Max256Text)(&value)
+}
+
+func (g *GenericPersonIdentification2) AddSchemeName() *PersonIdentificationSchemeName1Choice {
+	g.SchemeName = new(PersonIdentificationSchemeName1Choice)
+	return g.SchemeName
+}
+
+func (g *GenericPersonIdentification2) SetIssuer(value string) {
+	g.Issuer = (*Max35Text)(&value)
+}
@@ -16,6 +16,10 @@ type GenericValidationRuleIdentification1 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (g *GenericValidationRuleIdentification1) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GenericValidationRuleIdentification1) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
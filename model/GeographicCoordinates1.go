@@ -11,6 +11,10 @@ type GeographicCoordinates1 struct {
 	Longitude *Max16Text `xml:"Long"`
 }
 
+func (g *GeographicCoordinates1) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GeographicCoordinates1) SetLatitude(value string) {
 	g.Latitude = (*Max16Text)(&value)
 }
@@ -10,6 +10,10 @@ type GeographicLocation1Choice struct {
 	UTMCoordinates *UTMCoordinates1 `xml:"UTMCordints"`
 }
 
+func (g *GeographicLocation1Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GeographicLocation1Choice) AddGeographicCoordinates() *GeographicCoordinates1 {
 	g.GeographicCoordinates = new(GeographicCoordinates1)
 	return g.GeographicCoordinates
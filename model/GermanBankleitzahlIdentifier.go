@@ -1,3 +1,9 @@
 package model
 
 type GermanBankleitzahlIdentifier string
+
+var germanBankleitzahlIdentifierPattern = newPattern(`BL[0-9]{8,8}`)
+
+func (g GermanBankleitzahlIdentifier) Validate() error {
+	return validatePattern("GermanBankleitzahlIdentifier", string(g), germanBankleitzahlIdentifierPattern)
+}
@@ -25,10 +25,19 @@ type GlobalDistributionRequest1 struct {
 	CashMovement []*CashMovement1 `xml:"CshMvmnt,omitempty"`
 }
 
+func (g *GlobalDistributionRequest1) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GlobalDistributionRequest1) SetPreadviceIndicator(value string) {
 	g.PreadviceIndicator = (*YesNoIndicator)(&value)
 }
 
+func (g *GlobalDistributionRequest1) SetPreadviceIndicatorFromBool(value bool) {
+	g.PreadviceIndicator = new(YesNoIndicator)
+	g.PreadviceIndicator.FromBool(value)
+}
+
 func (g *GlobalDistributionRequest1) SetOptionNumber(value string) {
 	g.OptionNumber = (*Exact3NumericText)(&value)
 }
@@ -10,6 +10,10 @@ type GlobalDistributionStatus1 struct {
 	RejectedStatus *DistributionRejectionStatus1 `xml:"RjctdSts"`
 }
 
+func (g *GlobalDistributionStatus1) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GlobalDistributionStatus1) AddProcessedStatus() *DistributionProcessingStatus1 {
 	g.ProcessedStatus = new(DistributionProcessingStatus1)
 	return g.ProcessedStatus
@@ -11,6 +11,10 @@ type GovernanceIdentification1Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (g *GovernanceIdentification1Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GovernanceIdentification1Choice) SetCode(value string) {
 	g.Code = (*GovernanceIdentification1Code)(&value)
 }
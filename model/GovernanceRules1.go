@@ -13,6 +13,10 @@ type GovernanceRules1 struct {
 	Jurisdiction []*Location1 `xml:"Jursdctn,omitempty"`
 }
 
+func (g *GovernanceRules1) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GovernanceRules1) AddRuleIdentification() *GovernanceIdentification1Choice {
 	g.RuleIdentification = new(GovernanceIdentification1Choice)
 	return g.RuleIdentification
@@ -16,6 +16,10 @@ type GovernanceRules2 struct {
 	Jurisdiction []*Location1 `xml:"Jursdctn,omitempty"`
 }
 
+func (g *GovernanceRules2) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GovernanceRules2) SetIdentification(value string) {
 	g.Identification = (*ID)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format to express a gross dividend.
 type GrossDividendRate1Choice struct {
 
@@ -13,6 +17,10 @@ type GrossDividendRate1Choice struct {
 	RateTypeAmount *GrossDividendRate2 `xml:"RateTpAmt"`
 }
 
+func (g *GrossDividendRate1Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GrossDividendRate1Choice) AddNotSpecifiedRate() *RateValueType2FormatChoice {
 	g.NotSpecifiedRate = new(RateValueType2FormatChoice)
 	return g.NotSpecifiedRate
@@ -22,6 +30,10 @@ func (g *GrossDividendRate1Choice) SetAmount(value, currency string) {
 	g.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (g *GrossDividendRate1Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (g *GrossDividendRate1Choice) AddRateTypeAmount() *GrossDividendRate2 {
 	g.RateTypeAmount = new(GrossDividendRate2)
 	return g.RateTypeAmount
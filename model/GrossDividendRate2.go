@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash dividend amount per equity before deductions or allowances have been made.
 type GrossDividendRate2 struct {
 
@@ -10,6 +14,10 @@ type GrossDividendRate2 struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (g *GrossDividendRate2) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GrossDividendRate2) AddRateType() *GrossDividendRateType1FormatChoice {
 	g.RateType = new(GrossDividendRateType1FormatChoice)
 	return g.RateType
@@ -18,3 +26,7 @@ func (g *GrossDividendRate2) AddRateType() *GrossDividendRateType1FormatChoice {
 func (g *GrossDividendRate2) SetAmount(value, currency string) {
 	g.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (g *GrossDividendRate2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format to express a gross dividend.
 type GrossDividendRateFormat10Choice struct {
 
@@ -10,10 +14,18 @@ type GrossDividendRateFormat10Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus15 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (g *GrossDividendRateFormat10Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GrossDividendRateFormat10Choice) SetAmount(value, currency string) {
 	g.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (g *GrossDividendRateFormat10Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (g *GrossDividendRateFormat10Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus15 {
 	g.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus15)
 	return g.RateTypeAndAmountAndRateStatus
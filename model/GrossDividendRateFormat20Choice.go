@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type GrossDividendRateFormat20Choice struct {
 
@@ -16,10 +20,18 @@ type GrossDividendRateFormat20Choice struct {
 	NotSpecifiedRate *RateType13Code `xml:"NotSpcfdRate"`
 }
 
+func (g *GrossDividendRateFormat20Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GrossDividendRateFormat20Choice) SetAmount(value, currency string) {
 	g.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (g *GrossDividendRateFormat20Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (g *GrossDividendRateFormat20Choice) AddAmountAndRateStatus() *AmountAndRateStatus1 {
 	g.AmountAndRateStatus = new(AmountAndRateStatus1)
 	return g.AmountAndRateStatus
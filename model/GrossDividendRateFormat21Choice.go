@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format to express a gross dividend.
 type GrossDividendRateFormat21Choice struct {
 
@@ -13,10 +17,18 @@ type GrossDividendRateFormat21Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus22 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (g *GrossDividendRateFormat21Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GrossDividendRateFormat21Choice) SetAmount(value, currency string) {
 	g.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (g *GrossDividendRateFormat21Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (g *GrossDividendRateFormat21Choice) AddAmountAndRateStatus() *AmountAndRateStatus1 {
 	g.AmountAndRateStatus = new(AmountAndRateStatus1)
 	return g.AmountAndRateStatus
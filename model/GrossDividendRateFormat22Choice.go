@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format to express a gross dividend.
 type GrossDividendRateFormat22Choice struct {
 
@@ -13,10 +17,18 @@ type GrossDividendRateFormat22Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus28 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (g *GrossDividendRateFormat22Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GrossDividendRateFormat22Choice) SetAmount(value, currency string) {
 	g.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (g *GrossDividendRateFormat22Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (g *GrossDividendRateFormat22Choice) AddAmountAndRateStatus() *AmountAndRateStatus1 {
 	g.AmountAndRateStatus = new(AmountAndRateStatus1)
 	return g.AmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format to express a gross dividend.
 type GrossDividendRateFormat23Choice struct {
 
@@ -13,10 +17,18 @@ type GrossDividendRateFormat23Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus30 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (g *GrossDividendRateFormat23Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GrossDividendRateFormat23Choice) SetAmount(value, currency string) {
 	g.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (g *GrossDividendRateFormat23Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (g *GrossDividendRateFormat23Choice) AddAmountAndRateStatus() *AmountAndRateStatus2 {
 	g.AmountAndRateStatus = new(AmountAndRateStatus2)
 	return g.AmountAndRateStatus
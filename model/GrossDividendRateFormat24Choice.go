@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format to express a gross dividend.
 type GrossDividendRateFormat24Choice struct {
 
@@ -13,10 +17,18 @@ type GrossDividendRateFormat24Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus35 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (g *GrossDividendRateFormat24Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GrossDividendRateFormat24Choice) SetAmount(value, currency string) {
 	g.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (g *GrossDividendRateFormat24Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (g *GrossDividendRateFormat24Choice) AddAmountAndRateStatus() *AmountAndRateStatus2 {
 	g.AmountAndRateStatus = new(AmountAndRateStatus2)
 	return g.AmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type GrossDividendRateFormat25Choice struct {
 
@@ -16,10 +20,18 @@ type GrossDividendRateFormat25Choice struct {
 	NotSpecifiedRate *RateType13Code `xml:"NotSpcfdRate"`
 }
 
+func (g *GrossDividendRateFormat25Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GrossDividendRateFormat25Choice) SetAmount(value, currency string) {
 	g.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (g *GrossDividendRateFormat25Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (g *GrossDividendRateFormat25Choice) AddAmountAndRateStatus() *AmountAndRateStatus2 {
 	g.AmountAndRateStatus = new(AmountAndRateStatus2)
 	return g.AmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format to express a gross dividend.
 type GrossDividendRateFormat2Choice struct {
 
@@ -10,10 +14,18 @@ type GrossDividendRateFormat2Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus1 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (g *GrossDividendRateFormat2Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GrossDividendRateFormat2Choice) SetAmount(value, currency string) {
 	g.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (g *GrossDividendRateFormat2Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (g *GrossDividendRateFormat2Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus1 {
 	g.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus1)
 	return g.RateTypeAndAmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type GrossDividendRateFormat5Choice struct {
 
@@ -13,10 +17,18 @@ type GrossDividendRateFormat5Choice struct {
 	NotSpecifiedRate *RateType13Code `xml:"NotSpcfdRate"`
 }
 
+func (g *GrossDividendRateFormat5Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GrossDividendRateFormat5Choice) SetAmount(value, currency string) {
 	g.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (g *GrossDividendRateFormat5Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (g *GrossDividendRateFormat5Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus1 {
 	g.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus1)
 	return g.RateTypeAndAmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format to express a gross dividend.
 type GrossDividendRateFormat8Choice struct {
 
@@ -10,10 +14,18 @@ type GrossDividendRateFormat8Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus13 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (g *GrossDividendRateFormat8Choice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GrossDividendRateFormat8Choice) SetAmount(value, currency string) {
 	g.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (g *GrossDividendRateFormat8Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (g *GrossDividendRateFormat8Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus13 {
 	g.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus13)
 	return g.RateTypeAndAmountAndRateStatus
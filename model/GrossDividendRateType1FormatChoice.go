@@ -10,6 +10,10 @@ type GrossDividendRateType1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (g *GrossDividendRateType1FormatChoice) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GrossDividendRateType1FormatChoice) SetCode(value string) {
 	g.Code = (*GrossDividendRateType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Group1 struct {
 	Party []*PartyAndCertificate2 `xml:"Pty"`
 }
 
+func (g *Group1) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *Group1) SetGroupIdentification(value string) {
 	g.GroupIdentification = (*Max4AlphaNumericText)(&value)
 }
@@ -13,6 +13,10 @@ type Group2 struct {
 	Party []*PartyAndCertificate3 `xml:"Pty"`
 }
 
+func (g *Group2) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *Group2) SetModificationCode(value string) {
 	g.ModificationCode = (*Modification1Code)(&value)
 }
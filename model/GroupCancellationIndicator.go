@@ -1,3 +1,15 @@
 package model
 
 type GroupCancellationIndicator string
+
+func (g GroupCancellationIndicator) Validate() error {
+	return validateBoolean("GroupCancellationIndicator", string(g))
+}
+
+func (g GroupCancellationIndicator) Bool() bool {
+	return parseBoolean(string(g))
+}
+
+func (g *GroupCancellationIndicator) FromBool(value bool) {
+	*g = GroupCancellationIndicator(formatBoolean(value))
+}
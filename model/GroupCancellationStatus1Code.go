@@ -1,3 +1,30 @@
 package model
 
 type GroupCancellationStatus1Code string
+
+const (
+	GroupCancellationStatus1CodePACR GroupCancellationStatus1Code = "PACR"
+	GroupCancellationStatus1CodeRJCR GroupCancellationStatus1Code = "RJCR"
+	GroupCancellationStatus1CodeACCR GroupCancellationStatus1Code = "ACCR"
+	GroupCancellationStatus1CodePDCR GroupCancellationStatus1Code = "PDCR"
+)
+
+var groupCancellationStatus1CodeDescriptions = map[GroupCancellationStatus1Code]string{
+	GroupCancellationStatus1CodePACR: "Partially accepted cancellation request: cancellation is partially accepted.",
+	GroupCancellationStatus1CodeRJCR: "Rejected cancellation request: cancellation request is rejected.",
+	GroupCancellationStatus1CodeACCR: "Accepted cancellation request: cancellation is accepted.",
+	GroupCancellationStatus1CodePDCR: "Pending cancellation request: cancellation request is pending.",
+}
+
+func (g GroupCancellationStatus1Code) IsValid() bool {
+	_, ok := groupCancellationStatus1CodeDescriptions[g]
+	return ok
+}
+
+func (g GroupCancellationStatus1Code) Description() string {
+	return groupCancellationStatus1CodeDescriptions[g]
+}
+
+func (g GroupCancellationStatus1Code) Validate() error {
+	return validateEnumeration("GroupCancellationStatus1Code", string(g), g.IsValid(), "PACR, RJCR, ACCR, PDCR")
+}
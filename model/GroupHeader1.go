@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader1 struct {
 
@@ -35,6 +41,10 @@ type GroupHeader1 struct {
 	ForwardingAgent *BranchAndFinancialInstitutionIdentification3 `xml:"FwdgAgt,omitempty"`
 }
 
+func (g *GroupHeader1) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader1) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -43,6 +53,11 @@ func (g *GroupHeader1) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader1) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader1) AddAuthorisation(value string) {
 	g.Authorisation = append(g.Authorisation, (*Max128Text)(&value))
 }
@@ -51,6 +66,11 @@ func (g *GroupHeader1) SetBatchBooking(value string) {
 	g.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (g *GroupHeader1) SetBatchBookingFromBool(value bool) {
+	g.BatchBooking = new(BatchBookingIndicator)
+	g.BatchBooking.FromBool(value)
+}
+
 func (g *GroupHeader1) SetNumberOfTransactions(value string) {
 	g.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -59,8 +79,17 @@ func (g *GroupHeader1) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
-func (g *GroupHeader1) SetGrouping(value string) {
+func (g *GroupHeader1) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
+func (g *GroupHeader1) SetGrouping(value string) error {
+	if err := Grouping1Code(value).Validate(); err != nil {
+		return err
+	}
 	g.Grouping = (*Grouping1Code)(&value)
+	return nil
 }
 
 func (g *GroupHeader1) AddInitiatingParty() *PartyIdentification8 {
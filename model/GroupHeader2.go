@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader2 struct {
 
@@ -39,6 +45,10 @@ type GroupHeader2 struct {
 	InstructedAgent *BranchAndFinancialInstitutionIdentification3 `xml:"InstdAgt,omitempty"`
 }
 
+func (g *GroupHeader2) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader2) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -47,10 +57,20 @@ func (g *GroupHeader2) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader2) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader2) SetBatchBooking(value string) {
 	g.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (g *GroupHeader2) SetBatchBookingFromBool(value bool) {
+	g.BatchBooking = new(BatchBookingIndicator)
+	g.BatchBooking.FromBool(value)
+}
+
 func (g *GroupHeader2) SetNumberOfTransactions(value string) {
 	g.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -59,14 +79,28 @@ func (g *GroupHeader2) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader2) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader2) SetTotalInterbankSettlementAmount(value, currency string) {
 	g.TotalInterbankSettlementAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (g *GroupHeader2) SetTotalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.TotalInterbankSettlementAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (g *GroupHeader2) SetInterbankSettlementDate(value string) {
 	g.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (g *GroupHeader2) SetInterbankSettlementDateFromTime(value time.Time) {
+	g.InterbankSettlementDate = new(ISODate)
+	g.InterbankSettlementDate.FromTime(value)
+}
+
 func (g *GroupHeader2) AddSettlementInformation() *SettlementInformation1 {
 	g.SettlementInformation = new(SettlementInformation1)
 	return g.SettlementInformation
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements providing further details on the message.
 type GroupHeader23 struct {
 
@@ -25,6 +29,10 @@ type GroupHeader23 struct {
 	AdditionalInformation *Max500Text `xml:"AddtlInf,omitempty"`
 }
 
+func (g *GroupHeader23) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader23) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -33,6 +41,11 @@ func (g *GroupHeader23) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader23) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader23) AddMessageRecipient() *PartyIdentification8 {
 	g.MessageRecipient = new(PartyIdentification8)
 	return g.MessageRecipient
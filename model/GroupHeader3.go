@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader3 struct {
 
@@ -44,6 +50,10 @@ type GroupHeader3 struct {
 	InstructedAgent *BranchAndFinancialInstitutionIdentification3 `xml:"InstdAgt,omitempty"`
 }
 
+func (g *GroupHeader3) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader3) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -52,6 +62,11 @@ func (g *GroupHeader3) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader3) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader3) AddAuthorisation(value string) {
 	g.Authorisation = append(g.Authorisation, (*Max128Text)(&value))
 }
@@ -60,6 +75,11 @@ func (g *GroupHeader3) SetBatchBooking(value string) {
 	g.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (g *GroupHeader3) SetBatchBookingFromBool(value bool) {
+	g.BatchBooking = new(BatchBookingIndicator)
+	g.BatchBooking.FromBool(value)
+}
+
 func (g *GroupHeader3) SetNumberOfTransactions(value string) {
 	g.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -68,14 +88,28 @@ func (g *GroupHeader3) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader3) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader3) SetTotalInterbankSettlementAmount(value, currency string) {
 	g.TotalInterbankSettlementAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (g *GroupHeader3) SetTotalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.TotalInterbankSettlementAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (g *GroupHeader3) SetInterbankSettlementDate(value string) {
 	g.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (g *GroupHeader3) SetInterbankSettlementDateFromTime(value time.Time) {
+	g.InterbankSettlementDate = new(ISODate)
+	g.InterbankSettlementDate.FromTime(value)
+}
+
 func (g *GroupHeader3) AddSettlementInformation() *SettlementInformation2 {
 	g.SettlementInformation = new(SettlementInformation2)
 	return g.SettlementInformation
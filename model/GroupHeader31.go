@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader31 struct {
 
@@ -33,6 +37,10 @@ type GroupHeader31 struct {
 	InstructedAgent *BranchAndFinancialInstitutionIdentification4 `xml:"InstdAgt,omitempty"`
 }
 
+func (g *GroupHeader31) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader31) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -41,6 +49,11 @@ func (g *GroupHeader31) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader31) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader31) AddAuthorisation() *Authorisation1Choice {
 	newValue := new(Authorisation1Choice)
 	g.Authorisation = append(g.Authorisation, newValue)
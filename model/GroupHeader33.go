@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader33 struct {
 
@@ -39,6 +45,10 @@ type GroupHeader33 struct {
 	InstructedAgent *BranchAndFinancialInstitutionIdentification4 `xml:"InstdAgt,omitempty"`
 }
 
+func (g *GroupHeader33) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader33) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -47,10 +57,20 @@ func (g *GroupHeader33) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader33) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader33) SetBatchBooking(value string) {
 	g.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (g *GroupHeader33) SetBatchBookingFromBool(value bool) {
+	g.BatchBooking = new(BatchBookingIndicator)
+	g.BatchBooking.FromBool(value)
+}
+
 func (g *GroupHeader33) SetNumberOfTransactions(value string) {
 	g.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -59,14 +79,28 @@ func (g *GroupHeader33) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader33) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader33) SetTotalInterbankSettlementAmount(value, currency string) {
 	g.TotalInterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (g *GroupHeader33) SetTotalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.TotalInterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (g *GroupHeader33) SetInterbankSettlementDate(value string) {
 	g.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (g *GroupHeader33) SetInterbankSettlementDateFromTime(value time.Time) {
+	g.InterbankSettlementDate = new(ISODate)
+	g.InterbankSettlementDate.FromTime(value)
+}
+
 func (g *GroupHeader33) AddSettlementInformation() *SettlementInformation13 {
 	g.SettlementInformation = new(SettlementInformation13)
 	return g.SettlementInformation
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader34 struct {
 
@@ -45,6 +51,10 @@ type GroupHeader34 struct {
 	InstructedAgent *BranchAndFinancialInstitutionIdentification4 `xml:"InstdAgt,omitempty"`
 }
 
+func (g *GroupHeader34) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader34) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -53,6 +63,11 @@ func (g *GroupHeader34) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader34) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader34) AddAuthorisation() *Authorisation1Choice {
 	newValue := new(Authorisation1Choice)
 	g.Authorisation = append(g.Authorisation, newValue)
@@ -203,6 +218,11 @@ func (g *GroupHeader34) SetBatchBooking(value string) {
 	g.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (g *GroupHeader34) SetBatchBookingFromBool(value bool) {
+	g.BatchBooking = new(BatchBookingIndicator)
+	g.BatchBooking.FromBool(value)
+}
+
 func (g *GroupHeader34) SetNumberOfTransactions(value string) {
 	g.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -211,14 +231,28 @@ func (g *GroupHeader34) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader34) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader34) SetTotalInterbankSettlementAmount(value, currency string) {
 	g.TotalInterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (g *GroupHeader34) SetTotalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.TotalInterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (g *GroupHeader34) SetInterbankSettlementDate(value string) {
 	g.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (g *GroupHeader34) SetInterbankSettlementDateFromTime(value time.Time) {
+	g.InterbankSettlementDate = new(ISODate)
+	g.InterbankSettlementDate.FromTime(value)
+}
+
 func (g *GroupHeader34) AddSettlementInformation() *SettlementInformation14 {
 	g.SettlementInformation = new(SettlementInformation14)
 	return g.SettlementInformation
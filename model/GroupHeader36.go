@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader36 struct {
 
@@ -23,6 +27,10 @@ type GroupHeader36 struct {
 	CreditorAgent *BranchAndFinancialInstitutionIdentification4 `xml:"CdtrAgt,omitempty"`
 }
 
+func (g *GroupHeader36) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader36) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -31,6 +39,11 @@ func (g *GroupHeader36) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader36) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader36) AddInitiatingParty() *PartyIdentification32 {
 	g.InitiatingParty = new(PartyIdentification32)
 	return g.InitiatingParty
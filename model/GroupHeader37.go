@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader37 struct {
 
@@ -21,6 +25,10 @@ type GroupHeader37 struct {
 	InstructedAgent *BranchAndFinancialInstitutionIdentification4 `xml:"InstdAgt,omitempty"`
 }
 
+func (g *GroupHeader37) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader37) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -29,6 +37,11 @@ func (g *GroupHeader37) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader37) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader37) AddInstructingAgent() *BranchAndFinancialInstitutionIdentification4 {
 	g.InstructingAgent = new(BranchAndFinancialInstitutionIdentification4)
 	return g.InstructingAgent
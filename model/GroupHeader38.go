@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader38 struct {
 
@@ -47,6 +53,10 @@ type GroupHeader38 struct {
 	InstructedAgent *BranchAndFinancialInstitutionIdentification4 `xml:"InstdAgt,omitempty"`
 }
 
+func (g *GroupHeader38) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader38) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -55,6 +65,11 @@ func (g *GroupHeader38) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader38) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader38) AddAuthorisation() *Authorisation1Choice {
 	newValue := new(Authorisation1Choice)
 	g.Authorisation = append(g.Authorisation, newValue)
@@ -205,6 +220,11 @@ func (g *GroupHeader38) SetBatchBooking(value string) {
 	g.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (g *GroupHeader38) SetBatchBookingFromBool(value bool) {
+	g.BatchBooking = new(BatchBookingIndicator)
+	g.BatchBooking.FromBool(value)
+}
+
 func (g *GroupHeader38) SetNumberOfTransactions(value string) {
 	g.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -213,18 +233,37 @@ func (g *GroupHeader38) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader38) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader38) SetGroupReturn(value string) {
 	g.GroupReturn = (*TrueFalseIndicator)(&value)
 }
 
+func (g *GroupHeader38) SetGroupReturnFromBool(value bool) {
+	g.GroupReturn = new(TrueFalseIndicator)
+	g.GroupReturn.FromBool(value)
+}
+
 func (g *GroupHeader38) SetTotalReturnedInterbankSettlementAmount(value, currency string) {
 	g.TotalReturnedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (g *GroupHeader38) SetTotalReturnedInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.TotalReturnedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (g *GroupHeader38) SetInterbankSettlementDate(value string) {
 	g.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (g *GroupHeader38) SetInterbankSettlementDateFromTime(value time.Time) {
+	g.InterbankSettlementDate = new(ISODate)
+	g.InterbankSettlementDate.FromTime(value)
+}
+
 func (g *GroupHeader38) AddSettlementInformation() *SettlementInformation13 {
 	g.SettlementInformation = new(SettlementInformation13)
 	return g.SettlementInformation
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader39 struct {
 
@@ -31,6 +37,10 @@ type GroupHeader39 struct {
 	ForwardingAgent *BranchAndFinancialInstitutionIdentification4 `xml:"FwdgAgt,omitempty"`
 }
 
+func (g *GroupHeader39) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader39) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -39,6 +49,11 @@ func (g *GroupHeader39) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader39) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader39) AddAuthorisation() *Authorisation1Choice {
 	newValue := new(Authorisation1Choice)
 	g.Authorisation = append(g.Authorisation, newValue)
@@ -193,6 +208,11 @@ func (g *GroupHeader39) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader39) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader39) AddInitiatingParty() *PartyIdentification32 {
 	g.InitiatingParty = new(PartyIdentification32)
 	return g.InitiatingParty
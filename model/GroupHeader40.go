@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader40 struct {
 
@@ -43,6 +49,10 @@ type GroupHeader40 struct {
 	CreditorAgent *BranchAndFinancialInstitutionIdentification4 `xml:"CdtrAgt,omitempty"`
 }
 
+func (g *GroupHeader40) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader40) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -51,6 +61,11 @@ func (g *GroupHeader40) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader40) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader40) AddAuthorisation() *Authorisation1Choice {
 	newValue := new(Authorisation1Choice)
 	g.Authorisation = append(g.Authorisation, newValue)
@@ -201,6 +216,11 @@ func (g *GroupHeader40) SetBatchBooking(value string) {
 	g.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (g *GroupHeader40) SetBatchBookingFromBool(value bool) {
+	g.BatchBooking = new(BatchBookingIndicator)
+	g.BatchBooking.FromBool(value)
+}
+
 func (g *GroupHeader40) SetNumberOfTransactions(value string) {
 	g.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -209,10 +229,20 @@ func (g *GroupHeader40) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader40) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader40) SetGroupReversal(value string) {
 	g.GroupReversal = (*TrueFalseIndicator)(&value)
 }
 
+func (g *GroupHeader40) SetGroupReversalFromBool(value bool) {
+	g.GroupReversal = new(TrueFalseIndicator)
+	g.GroupReversal.FromBool(value)
+}
+
 func (g *GroupHeader40) AddInitiatingParty() *PartyIdentification32 {
 	g.InitiatingParty = new(PartyIdentification32)
 	return g.InitiatingParty
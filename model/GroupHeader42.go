@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to provide further details of the message.
 type GroupHeader42 struct {
 
@@ -23,6 +27,10 @@ type GroupHeader42 struct {
 	AdditionalInformation *Max500Text `xml:"AddtlInf,omitempty"`
 }
 
+func (g *GroupHeader42) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader42) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -31,6 +39,11 @@ func (g *GroupHeader42) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader42) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader42) AddMessageRecipient() *PartyIdentification32 {
 	g.MessageRecipient = new(PartyIdentification32)
 	return g.MessageRecipient
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to provide further details on the message.
 type GroupHeader43 struct {
 
@@ -14,6 +18,10 @@ type GroupHeader43 struct {
 	MessageSender *Party7Choice `xml:"MsgSndr,omitempty"`
 }
 
+func (g *GroupHeader43) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader43) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -22,6 +30,11 @@ func (g *GroupHeader43) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader43) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader43) AddMessageSender() *Party7Choice {
 	g.MessageSender = new(Party7Choice)
 	return g.MessageSender
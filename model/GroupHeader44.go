@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to provide further details on the message.
 type GroupHeader44 struct {
 
@@ -15,6 +19,10 @@ type GroupHeader44 struct {
 	MessageRecipient *Party7Choice `xml:"MsgRcpt,omitempty"`
 }
 
+func (g *GroupHeader44) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader44) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -23,6 +31,11 @@ func (g *GroupHeader44) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader44) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader44) AddMessageRecipient() *Party7Choice {
 	g.MessageRecipient = new(Party7Choice)
 	return g.MessageRecipient
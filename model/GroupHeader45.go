@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader45 struct {
 
@@ -21,6 +27,10 @@ type GroupHeader45 struct {
 	InitiatingParty *PartyIdentification43 `xml:"InitgPty"`
 }
 
+func (g *GroupHeader45) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader45) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -29,6 +39,11 @@ func (g *GroupHeader45) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader45) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader45) SetNumberOfTransactions(value string) {
 	g.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -37,6 +52,11 @@ func (g *GroupHeader45) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader45) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader45) AddInitiatingParty() *PartyIdentification43 {
 	g.InitiatingParty = new(PartyIdentification43)
 	return g.InitiatingParty
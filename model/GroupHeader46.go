@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader46 struct {
 
@@ -21,6 +25,10 @@ type GroupHeader46 struct {
 	CreditorAgent *BranchAndFinancialInstitutionIdentification5 `xml:"CdtrAgt,omitempty"`
 }
 
+func (g *GroupHeader46) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader46) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -29,6 +37,11 @@ func (g *GroupHeader46) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader46) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader46) AddInitiatingParty() *PartyIdentification43 {
 	g.InitiatingParty = new(PartyIdentification43)
 	return g.InitiatingParty
@@ -1,5 +1,12 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader48 struct {
 
@@ -31,6 +38,10 @@ type GroupHeader48 struct {
 	ForwardingAgent *BranchAndFinancialInstitutionIdentification5 `xml:"FwdgAgt,omitempty"`
 }
 
+func (g *GroupHeader48) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader48) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -39,6 +50,11 @@ func (g *GroupHeader48) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader48) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader48) AddAuthorisation() *Authorisation1Choice {
 	newValue := new(Authorisation1Choice)
 	g.Authorisation = append(g.Authorisation, newValue)
@@ -193,6 +209,11 @@ func (g *GroupHeader48) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader48) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader48) AddInitiatingParty() *PartyIdentification43 {
 	g.InitiatingParty = new(PartyIdentification43)
 	return g.InitiatingParty
@@ -202,3 +223,91 @@ func (g *GroupHeader48) AddForwardingAgent() *BranchAndFinancialInstitutionIdent
 	g.ForwardingAgent = new(BranchAndFinancialInstitutionIdentification5)
 	return g.ForwardingAgent
 }
+
+func (g *GroupHeader48) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.MessageIdentification != nil {
+		enc.Element("MsgId", string(*g.MessageIdentification))
+	}
+	if g.CreationDateTime != nil {
+		enc.Element("CreDtTm", string(*g.CreationDateTime))
+	}
+	for _, elem := range g.Authorisation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Authstn")
+		}
+	}
+	if g.NumberOfTransactions != nil {
+		enc.Element("NbOfTxs", string(*g.NumberOfTransactions))
+	}
+	if g.ControlSum != nil {
+		enc.Element("CtrlSum", string(*g.ControlSum))
+	}
+	if g.InitiatingParty != nil {
+		g.InitiatingParty.EncodeXMLFast(enc, "InitgPty")
+	}
+	if g.ForwardingAgent != nil {
+		g.ForwardingAgent.EncodeXMLFast(enc, "FwdgAgt")
+	}
+	enc.End(tag)
+}
+
+func (g *GroupHeader48) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "MsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.MessageIdentification = (*Max35Text)(&value)
+		case "CreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.CreationDateTime = (*ISODateTime)(&value)
+		case "Authstn":
+			elem := new(Authorisation1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.Authorisation = append(g.Authorisation, elem)
+		case "NbOfTxs":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.NumberOfTransactions = (*Max15NumericText)(&value)
+		case "CtrlSum":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.ControlSum = (*DecimalNumber)(&value)
+		case "InitgPty":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.InitiatingParty = elem
+		case "FwdgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.ForwardingAgent = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader5 struct {
 
@@ -30,6 +34,10 @@ type GroupHeader5 struct {
 	InstructedAgent *BranchAndFinancialInstitutionIdentification3 `xml:"InstdAgt,omitempty"`
 }
 
+func (g *GroupHeader5) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader5) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -38,6 +46,11 @@ func (g *GroupHeader5) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader5) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader5) AddInitiatingParty() *PartyIdentification8 {
 	g.InitiatingParty = new(PartyIdentification8)
 	return g.InitiatingParty
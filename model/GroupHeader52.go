@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader52 struct {
 
@@ -23,6 +29,10 @@ type GroupHeader52 struct {
 	CreditorAgent *BranchAndFinancialInstitutionIdentification5 `xml:"CdtrAgt,omitempty"`
 }
 
+func (g *GroupHeader52) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader52) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -31,6 +41,11 @@ func (g *GroupHeader52) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader52) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader52) AddInitiatingParty() *PartyIdentification43 {
 	g.InitiatingParty = new(PartyIdentification43)
 	return g.InitiatingParty
@@ -50,3 +65,80 @@ func (g *GroupHeader52) AddCreditorAgent() *BranchAndFinancialInstitutionIdentif
 	g.CreditorAgent = new(BranchAndFinancialInstitutionIdentification5)
 	return g.CreditorAgent
 }
+
+func (g *GroupHeader52) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.MessageIdentification != nil {
+		enc.Element("MsgId", string(*g.MessageIdentification))
+	}
+	if g.CreationDateTime != nil {
+		enc.Element("CreDtTm", string(*g.CreationDateTime))
+	}
+	if g.InitiatingParty != nil {
+		g.InitiatingParty.EncodeXMLFast(enc, "InitgPty")
+	}
+	if g.ForwardingAgent != nil {
+		g.ForwardingAgent.EncodeXMLFast(enc, "FwdgAgt")
+	}
+	if g.DebtorAgent != nil {
+		g.DebtorAgent.EncodeXMLFast(enc, "DbtrAgt")
+	}
+	if g.CreditorAgent != nil {
+		g.CreditorAgent.EncodeXMLFast(enc, "CdtrAgt")
+	}
+	enc.End(tag)
+}
+
+func (g *GroupHeader52) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "MsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.MessageIdentification = (*Max35Text)(&value)
+		case "CreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.CreationDateTime = (*ISODateTime)(&value)
+		case "InitgPty":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.InitiatingParty = elem
+		case "FwdgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.ForwardingAgent = elem
+		case "DbtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.DebtorAgent = elem
+		case "CdtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.CreditorAgent = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
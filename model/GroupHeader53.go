@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader53 struct {
 
@@ -21,6 +27,10 @@ type GroupHeader53 struct {
 	InstructedAgent *BranchAndFinancialInstitutionIdentification5 `xml:"InstdAgt,omitempty"`
 }
 
+func (g *GroupHeader53) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader53) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -29,6 +39,11 @@ func (g *GroupHeader53) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader53) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader53) AddInstructingAgent() *BranchAndFinancialInstitutionIdentification5 {
 	g.InstructingAgent = new(BranchAndFinancialInstitutionIdentification5)
 	return g.InstructingAgent
@@ -38,3 +53,62 @@ func (g *GroupHeader53) AddInstructedAgent() *BranchAndFinancialInstitutionIdent
 	g.InstructedAgent = new(BranchAndFinancialInstitutionIdentification5)
 	return g.InstructedAgent
 }
+
+func (g *GroupHeader53) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.MessageIdentification != nil {
+		enc.Element("MsgId", string(*g.MessageIdentification))
+	}
+	if g.CreationDateTime != nil {
+		enc.Element("CreDtTm", string(*g.CreationDateTime))
+	}
+	if g.InstructingAgent != nil {
+		g.InstructingAgent.EncodeXMLFast(enc, "InstgAgt")
+	}
+	if g.InstructedAgent != nil {
+		g.InstructedAgent.EncodeXMLFast(enc, "InstdAgt")
+	}
+	enc.End(tag)
+}
+
+func (g *GroupHeader53) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "MsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.MessageIdentification = (*Max35Text)(&value)
+		case "CreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.CreationDateTime = (*ISODateTime)(&value)
+		case "InstgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.InstructingAgent = elem
+		case "InstdAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.InstructedAgent = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
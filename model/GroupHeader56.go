@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader56 struct {
 
@@ -39,6 +45,10 @@ type GroupHeader56 struct {
 	CreditorAgent *BranchAndFinancialInstitutionIdentification5 `xml:"CdtrAgt,omitempty"`
 }
 
+func (g *GroupHeader56) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader56) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -47,6 +57,11 @@ func (g *GroupHeader56) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader56) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader56) AddAuthorisation() *Authorisation1Choice {
 	newValue := new(Authorisation1Choice)
 	g.Authorisation = append(g.Authorisation, newValue)
@@ -201,10 +216,20 @@ func (g *GroupHeader56) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader56) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader56) SetGroupReversal(value string) {
 	g.GroupReversal = (*TrueFalseIndicator)(&value)
 }
 
+func (g *GroupHeader56) SetGroupReversalFromBool(value bool) {
+	g.GroupReversal = new(TrueFalseIndicator)
+	g.GroupReversal.FromBool(value)
+}
+
 func (g *GroupHeader56) AddInitiatingParty() *PartyIdentification43 {
 	g.InitiatingParty = new(PartyIdentification43)
 	return g.InitiatingParty
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides further details on the message.
 type GroupHeader58 struct {
 
@@ -26,6 +32,10 @@ type GroupHeader58 struct {
 	AdditionalInformation *Max500Text `xml:"AddtlInf,omitempty"`
 }
 
+func (g *GroupHeader58) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader58) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -34,6 +44,11 @@ func (g *GroupHeader58) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader58) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader58) AddMessageRecipient() *PartyIdentification43 {
 	g.MessageRecipient = new(PartyIdentification43)
 	return g.MessageRecipient
@@ -52,3 +67,80 @@ func (g *GroupHeader58) AddOriginalBusinessQuery() *OriginalBusinessQuery1 {
 func (g *GroupHeader58) SetAdditionalInformation(value string) {
 	g.AdditionalInformation = (*Max500Text)(&value)
 }
+
+func (g *GroupHeader58) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.MessageIdentification != nil {
+		enc.Element("MsgId", string(*g.MessageIdentification))
+	}
+	if g.CreationDateTime != nil {
+		enc.Element("CreDtTm", string(*g.CreationDateTime))
+	}
+	if g.MessageRecipient != nil {
+		g.MessageRecipient.EncodeXMLFast(enc, "MsgRcpt")
+	}
+	if g.MessagePagination != nil {
+		g.MessagePagination.EncodeXMLFast(enc, "MsgPgntn")
+	}
+	if g.OriginalBusinessQuery != nil {
+		g.OriginalBusinessQuery.EncodeXMLFast(enc, "OrgnlBizQry")
+	}
+	if g.AdditionalInformation != nil {
+		enc.Element("AddtlInf", string(*g.AdditionalInformation))
+	}
+	enc.End(tag)
+}
+
+func (g *GroupHeader58) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "MsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.MessageIdentification = (*Max35Text)(&value)
+		case "CreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.CreationDateTime = (*ISODateTime)(&value)
+		case "MsgRcpt":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.MessageRecipient = elem
+		case "MsgPgntn":
+			elem := new(Pagination)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.MessagePagination = elem
+		case "OrgnlBizQry":
+			elem := new(OriginalBusinessQuery1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.OriginalBusinessQuery = elem
+		case "AddtlInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.AdditionalInformation = (*Max500Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
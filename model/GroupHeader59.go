@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details on the message.
 type GroupHeader59 struct {
 
@@ -14,6 +18,10 @@ type GroupHeader59 struct {
 	MessageSender *Party12Choice `xml:"MsgSndr,omitempty"`
 }
 
+func (g *GroupHeader59) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader59) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -22,6 +30,11 @@ func (g *GroupHeader59) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader59) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader59) AddMessageSender() *Party12Choice {
 	g.MessageSender = new(Party12Choice)
 	return g.MessageSender
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader6 struct {
 
@@ -44,6 +50,10 @@ type GroupHeader6 struct {
 	InstructedAgent *BranchAndFinancialInstitutionIdentification3 `xml:"InstdAgt,omitempty"`
 }
 
+func (g *GroupHeader6) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader6) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -52,6 +62,11 @@ func (g *GroupHeader6) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader6) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader6) AddAuthorisation(value string) {
 	g.Authorisation = append(g.Authorisation, (*Max128Text)(&value))
 }
@@ -60,6 +75,11 @@ func (g *GroupHeader6) SetBatchBooking(value string) {
 	g.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (g *GroupHeader6) SetBatchBookingFromBool(value bool) {
+	g.BatchBooking = new(BatchBookingIndicator)
+	g.BatchBooking.FromBool(value)
+}
+
 func (g *GroupHeader6) SetNumberOfTransactions(value string) {
 	g.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -68,18 +88,37 @@ func (g *GroupHeader6) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader6) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader6) SetGroupReturn(value string) {
 	g.GroupReturn = (*TrueFalseIndicator)(&value)
 }
 
+func (g *GroupHeader6) SetGroupReturnFromBool(value bool) {
+	g.GroupReturn = new(TrueFalseIndicator)
+	g.GroupReturn.FromBool(value)
+}
+
 func (g *GroupHeader6) SetTotalReturnedInterbankSettlementAmount(value, currency string) {
 	g.TotalReturnedInterbankSettlementAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (g *GroupHeader6) SetTotalReturnedInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.TotalReturnedInterbankSettlementAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (g *GroupHeader6) SetInterbankSettlementDate(value string) {
 	g.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (g *GroupHeader6) SetInterbankSettlementDateFromTime(value time.Time) {
+	g.InterbankSettlementDate = new(ISODate)
+	g.InterbankSettlementDate.FromTime(value)
+}
+
 func (g *GroupHeader6) AddSettlementInformation() *SettlementInformation1 {
 	g.SettlementInformation = new(SettlementInformation1)
 	return g.SettlementInformation
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details on the message.
 type GroupHeader60 struct {
 
@@ -15,6 +19,10 @@ type GroupHeader60 struct {
 	MessageRecipient *Party12Choice `xml:"MsgRcpt,omitempty"`
 }
 
+func (g *GroupHeader60) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader60) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -23,6 +31,11 @@ func (g *GroupHeader60) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader60) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader60) AddMessageRecipient() *Party12Choice {
 	g.MessageRecipient = new(Party12Choice)
 	return g.MessageRecipient
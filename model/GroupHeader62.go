@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader62 struct {
 
@@ -31,6 +35,10 @@ type GroupHeader62 struct {
 	ForwardingAgent *BranchAndFinancialInstitutionIdentification5 `xml:"FwdgAgt,omitempty"`
 }
 
+func (g *GroupHeader62) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader62) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -39,6 +47,11 @@ func (g *GroupHeader62) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader62) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader62) AddAuthorisation() *Authorisation1Choice {
 	newValue := new(Authorisation1Choice)
 	g.Authorisation = append(g.Authorisation, newValue)
@@ -185,8 +198,12 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 		XchgRate:               doc.XchgRate.BaseOneRate,                                  // "<XchgRate>"
 	}
 
-func (g *GroupHeader62) SetCopyIndicator(value string) {
+func (g *GroupHeader62) SetCopyIndicator(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	g.CopyIndicator = (*CopyDuplicate1Code)(&value)
+	return nil
 }
 
 func (g *GroupHeader62) AddInitiatingParty() *PartyIdentification43 {
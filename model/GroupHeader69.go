@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader69 struct {
 
@@ -28,6 +32,10 @@ type GroupHeader69 struct {
 	LanguageCode *LanguageCode `xml:"LangCd,omitempty"`
 }
 
+func (g *GroupHeader69) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader69) SetIdentification(value string) {
 	g.Identification = (*Max35Text)(&value)
 }
@@ -36,6 +44,11 @@ func (g *GroupHeader69) SetIssuedDate(value string) {
 	g.IssuedDate = (*ISODate)(&value)
 }
 
+func (g *GroupHeader69) SetIssuedDateFromTime(value time.Time) {
+	g.IssuedDate = new(ISODate)
+	g.IssuedDate.FromTime(value)
+}
+
 func (g *GroupHeader69) SetReportCategory(value string) {
 	g.ReportCategory = (*ExternalDocumentType1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader7 struct {
 
@@ -39,6 +45,10 @@ type GroupHeader7 struct {
 	InstructedAgent *BranchAndFinancialInstitutionIdentification3 `xml:"InstdAgt,omitempty"`
 }
 
+func (g *GroupHeader7) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader7) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -47,6 +57,11 @@ func (g *GroupHeader7) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader7) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader7) SetNumberOfTransactions(value string) {
 	g.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -55,10 +70,20 @@ func (g *GroupHeader7) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader7) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader7) SetGroupCancellation(value string) {
 	g.GroupCancellation = (*GroupingIndicator)(&value)
 }
 
+func (g *GroupHeader7) SetGroupCancellationFromBool(value bool) {
+	g.GroupCancellation = new(GroupingIndicator)
+	g.GroupCancellation.FromBool(value)
+}
+
 func (g *GroupHeader7) AddInitiatingParty() *PartyIdentification8 {
 	g.InitiatingParty = new(PartyIdentification8)
 	return g.InitiatingParty
@@ -1,5 +1,12 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader70 struct {
 
@@ -39,6 +46,10 @@ type GroupHeader70 struct {
 	InstructedAgent *BranchAndFinancialInstitutionIdentification5 `xml:"InstdAgt,omitempty"`
 }
 
+func (g *GroupHeader70) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader70) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -47,10 +58,20 @@ func (g *GroupHeader70) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader70) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader70) SetBatchBooking(value string) {
 	g.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (g *GroupHeader70) SetBatchBookingFromBool(value bool) {
+	g.BatchBooking = new(BatchBookingIndicator)
+	g.BatchBooking.FromBool(value)
+}
+
 func (g *GroupHeader70) SetNumberOfTransactions(value string) {
 	g.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -59,14 +80,28 @@ func (g *GroupHeader70) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader70) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader70) SetTotalInterbankSettlementAmount(value, currency string) {
 	g.TotalInterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (g *GroupHeader70) SetTotalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.TotalInterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (g *GroupHeader70) SetInterbankSettlementDate(value string) {
 	g.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (g *GroupHeader70) SetInterbankSettlementDateFromTime(value time.Time) {
+	g.InterbankSettlementDate = new(ISODate)
+	g.InterbankSettlementDate.FromTime(value)
+}
+
 func (g *GroupHeader70) AddSettlementInformation() *SettlementInstruction4 {
 	g.SettlementInformation = new(SettlementInstruction4)
 	return g.SettlementInformation
@@ -86,3 +121,125 @@ func (g *GroupHeader70) AddInstructedAgent() *BranchAndFinancialInstitutionIdent
 	g.InstructedAgent = new(BranchAndFinancialInstitutionIdentification5)
 	return g.InstructedAgent
 }
+
+func (g *GroupHeader70) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.MessageIdentification != nil {
+		enc.Element("MsgId", string(*g.MessageIdentification))
+	}
+	if g.CreationDateTime != nil {
+		enc.Element("CreDtTm", string(*g.CreationDateTime))
+	}
+	if g.BatchBooking != nil {
+		enc.Element("BtchBookg", string(*g.BatchBooking))
+	}
+	if g.NumberOfTransactions != nil {
+		enc.Element("NbOfTxs", string(*g.NumberOfTransactions))
+	}
+	if g.ControlSum != nil {
+		enc.Element("CtrlSum", string(*g.ControlSum))
+	}
+	if g.TotalInterbankSettlementAmount != nil {
+		g.TotalInterbankSettlementAmount.EncodeXMLFast(enc, "TtlIntrBkSttlmAmt")
+	}
+	if g.InterbankSettlementDate != nil {
+		enc.Element("IntrBkSttlmDt", string(*g.InterbankSettlementDate))
+	}
+	if g.SettlementInformation != nil {
+		g.SettlementInformation.EncodeXMLFast(enc, "SttlmInf")
+	}
+	if g.PaymentTypeInformation != nil {
+		g.PaymentTypeInformation.EncodeXMLFast(enc, "PmtTpInf")
+	}
+	if g.InstructingAgent != nil {
+		g.InstructingAgent.EncodeXMLFast(enc, "InstgAgt")
+	}
+	if g.InstructedAgent != nil {
+		g.InstructedAgent.EncodeXMLFast(enc, "InstdAgt")
+	}
+	enc.End(tag)
+}
+
+func (g *GroupHeader70) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "MsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.MessageIdentification = (*Max35Text)(&value)
+		case "CreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.CreationDateTime = (*ISODateTime)(&value)
+		case "BtchBookg":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.BatchBooking = (*BatchBookingIndicator)(&value)
+		case "NbOfTxs":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.NumberOfTransactions = (*Max15NumericText)(&value)
+		case "CtrlSum":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.ControlSum = (*DecimalNumber)(&value)
+		case "TtlIntrBkSttlmAmt":
+			elem := new(ActiveCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.TotalInterbankSettlementAmount = elem
+		case "IntrBkSttlmDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.InterbankSettlementDate = (*ISODate)(&value)
+		case "SttlmInf":
+			elem := new(SettlementInstruction4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.SettlementInformation = elem
+		case "PmtTpInf":
+			elem := new(PaymentTypeInformation21)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.PaymentTypeInformation = elem
+		case "InstgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.InstructingAgent = elem
+		case "InstdAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.InstructedAgent = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader71 struct {
 
@@ -49,6 +55,10 @@ type GroupHeader71 struct {
 	InstructedAgent *BranchAndFinancialInstitutionIdentification5 `xml:"InstdAgt,omitempty"`
 }
 
+func (g *GroupHeader71) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader71) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -57,6 +67,11 @@ func (g *GroupHeader71) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader71) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader71) AddAuthorisation() *Authorisation1Choice {
 	newValue := new(Authorisation1Choice)
 	g.Authorisation = append(g.Authorisation, newValue)
@@ -207,6 +222,11 @@ func (g *GroupHeader71) SetBatchBooking(value string) {
 	g.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (g *GroupHeader71) SetBatchBookingFromBool(value bool) {
+	g.BatchBooking = new(BatchBookingIndicator)
+	g.BatchBooking.FromBool(value)
+}
+
 func (g *GroupHeader71) SetNumberOfTransactions(value string) {
 	g.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -215,18 +235,37 @@ func (g *GroupHeader71) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader71) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader71) SetGroupReversal(value string) {
 	g.GroupReversal = (*TrueFalseIndicator)(&value)
 }
 
+func (g *GroupHeader71) SetGroupReversalFromBool(value bool) {
+	g.GroupReversal = new(TrueFalseIndicator)
+	g.GroupReversal.FromBool(value)
+}
+
 func (g *GroupHeader71) SetTotalReversedInterbankSettlementAmount(value, currency string) {
 	g.TotalReversedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (g *GroupHeader71) SetTotalReversedInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.TotalReversedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (g *GroupHeader71) SetInterbankSettlementDate(value string) {
 	g.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (g *GroupHeader71) SetInterbankSettlementDateFromTime(value time.Time) {
+	g.InterbankSettlementDate = new(ISODate)
+	g.InterbankSettlementDate.FromTime(value)
+}
+
 func (g *GroupHeader71) AddSettlementInformation() *SettlementInstruction4 {
 	g.SettlementInformation = new(SettlementInstruction4)
 	return g.SettlementInformation
@@ -1,5 +1,12 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader72 struct {
 
@@ -47,6 +54,10 @@ type GroupHeader72 struct {
 	InstructedAgent *BranchAndFinancialInstitutionIdentification5 `xml:"InstdAgt,omitempty"`
 }
 
+func (g *GroupHeader72) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader72) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -55,6 +66,11 @@ func (g *GroupHeader72) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader72) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader72) AddAuthorisation() *Authorisation1Choice {
 	newValue := new(Authorisation1Choice)
 	g.Authorisation = append(g.Authorisation, newValue)
@@ -205,6 +221,11 @@ func (g *GroupHeader72) SetBatchBooking(value string) {
 	g.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (g *GroupHeader72) SetBatchBookingFromBool(value bool) {
+	g.BatchBooking = new(BatchBookingIndicator)
+	g.BatchBooking.FromBool(value)
+}
+
 func (g *GroupHeader72) SetNumberOfTransactions(value string) {
 	g.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -213,18 +234,37 @@ func (g *GroupHeader72) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader72) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader72) SetGroupReturn(value string) {
 	g.GroupReturn = (*TrueFalseIndicator)(&value)
 }
 
+func (g *GroupHeader72) SetGroupReturnFromBool(value bool) {
+	g.GroupReturn = new(TrueFalseIndicator)
+	g.GroupReturn.FromBool(value)
+}
+
 func (g *GroupHeader72) SetTotalReturnedInterbankSettlementAmount(value, currency string) {
 	g.TotalReturnedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (g *GroupHeader72) SetTotalReturnedInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.TotalReturnedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (g *GroupHeader72) SetInterbankSettlementDate(value string) {
 	g.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (g *GroupHeader72) SetInterbankSettlementDateFromTime(value time.Time) {
+	g.InterbankSettlementDate = new(ISODate)
+	g.InterbankSettlementDate.FromTime(value)
+}
+
 func (g *GroupHeader72) AddSettlementInformation() *SettlementInstruction4 {
 	g.SettlementInformation = new(SettlementInstruction4)
 	return g.SettlementInformation
@@ -239,3 +279,136 @@ func (g *GroupHeader72) AddInstructedAgent() *BranchAndFinancialInstitutionIdent
 	g.InstructedAgent = new(BranchAndFinancialInstitutionIdentification5)
 	return g.InstructedAgent
 }
+
+func (g *GroupHeader72) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.MessageIdentification != nil {
+		enc.Element("MsgId", string(*g.MessageIdentification))
+	}
+	if g.CreationDateTime != nil {
+		enc.Element("CreDtTm", string(*g.CreationDateTime))
+	}
+	for _, elem := range g.Authorisation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Authstn")
+		}
+	}
+	if g.BatchBooking != nil {
+		enc.Element("BtchBookg", string(*g.BatchBooking))
+	}
+	if g.NumberOfTransactions != nil {
+		enc.Element("NbOfTxs", string(*g.NumberOfTransactions))
+	}
+	if g.ControlSum != nil {
+		enc.Element("CtrlSum", string(*g.ControlSum))
+	}
+	if g.GroupReturn != nil {
+		enc.Element("GrpRtr", string(*g.GroupReturn))
+	}
+	if g.TotalReturnedInterbankSettlementAmount != nil {
+		g.TotalReturnedInterbankSettlementAmount.EncodeXMLFast(enc, "TtlRtrdIntrBkSttlmAmt")
+	}
+	if g.InterbankSettlementDate != nil {
+		enc.Element("IntrBkSttlmDt", string(*g.InterbankSettlementDate))
+	}
+	if g.SettlementInformation != nil {
+		g.SettlementInformation.EncodeXMLFast(enc, "SttlmInf")
+	}
+	if g.InstructingAgent != nil {
+		g.InstructingAgent.EncodeXMLFast(enc, "InstgAgt")
+	}
+	if g.InstructedAgent != nil {
+		g.InstructedAgent.EncodeXMLFast(enc, "InstdAgt")
+	}
+	enc.End(tag)
+}
+
+func (g *GroupHeader72) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "MsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.MessageIdentification = (*Max35Text)(&value)
+		case "CreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.CreationDateTime = (*ISODateTime)(&value)
+		case "Authstn":
+			elem := new(Authorisation1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.Authorisation = append(g.Authorisation, elem)
+		case "BtchBookg":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.BatchBooking = (*BatchBookingIndicator)(&value)
+		case "NbOfTxs":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.NumberOfTransactions = (*Max15NumericText)(&value)
+		case "CtrlSum":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.ControlSum = (*DecimalNumber)(&value)
+		case "GrpRtr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.GroupReturn = (*TrueFalseIndicator)(&value)
+		case "TtlRtrdIntrBkSttlmAmt":
+			elem := new(ActiveCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.TotalReturnedInterbankSettlementAmount = elem
+		case "IntrBkSttlmDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.InterbankSettlementDate = (*ISODate)(&value)
+		case "SttlmInf":
+			elem := new(SettlementInstruction4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.SettlementInformation = elem
+		case "InstgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.InstructingAgent = elem
+		case "InstdAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.InstructedAgent = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package model
+
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Provides further details on the message.
+type GroupHeader81 struct {
+
+	// Point to point reference, as assigned by the account servicing institution, and sent to the account owner or the party authorised to receive the message, to unambiguously identify the message.
+	// Usage: The account servicing institution has to make sure that MessageIdentification is unique per account owner for a pre-agreed period.
+	MessageIdentification *Max35Text `xml:"MsgId"`
+
+	// Date and time at which the message was created.
+	CreationDateTime *ISODateTime `xml:"CreDtTm"`
+
+	// Party authorised by the account owner to receive information about movements on the account.
+	// Usage: MessageRecipient should only be identified when different from the account owner.
+	MessageRecipient *PartyIdentification135 `xml:"MsgRcpt,omitempty"`
+
+	// Provides details on the page number of the message.
+	//
+	// Usage: The pagination of the message is only allowed when agreed between the parties.
+	MessagePagination *Pagination1 `xml:"MsgPgntn,omitempty"`
+
+	// Unique identification, as assigned by the original requestor, to unambiguously identify the business query message.
+	OriginalBusinessQuery *OriginalBusinessQuery1 `xml:"OrgnlBizQry,omitempty"`
+
+	// Further details of the message.
+	AdditionalInformation *Max500Text `xml:"AddtlInf,omitempty"`
+}
+
+func (g *GroupHeader81) Validate() error {
+	return ValidateElement(g)
+}
+
+func (g *GroupHeader81) SetMessageIdentification(value string) {
+	g.MessageIdentification = (*Max35Text)(&value)
+}
+
+func (g *GroupHeader81) SetCreationDateTime(value string) {
+	g.CreationDateTime = (*ISODateTime)(&value)
+}
+
+func (g *GroupHeader81) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
+func (g *GroupHeader81) AddMessageRecipient() *PartyIdentification135 {
+	g.MessageRecipient = new(PartyIdentification135)
+	return g.MessageRecipient
+}
+
+func (g *GroupHeader81) AddMessagePagination() *Pagination1 {
+	g.MessagePagination = new(Pagination1)
+	return g.MessagePagination
+}
+
+func (g *GroupHeader81) AddOriginalBusinessQuery() *OriginalBusinessQuery1 {
+	g.OriginalBusinessQuery = new(OriginalBusinessQuery1)
+	return g.OriginalBusinessQuery
+}
+
+func (g *GroupHeader81) SetAdditionalInformation(value string) {
+	g.AdditionalInformation = (*Max500Text)(&value)
+}
+
+func (g *GroupHeader81) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.MessageIdentification != nil {
+		enc.Element("MsgId", string(*g.MessageIdentification))
+	}
+	if g.CreationDateTime != nil {
+		enc.Element("CreDtTm", string(*g.CreationDateTime))
+	}
+	if g.MessageRecipient != nil {
+		g.MessageRecipient.EncodeXMLFast(enc, "MsgRcpt")
+	}
+	if g.MessagePagination != nil {
+		g.MessagePagination.EncodeXMLFast(enc, "MsgPgntn")
+	}
+	if g.OriginalBusinessQuery != nil {
+		g.OriginalBusinessQuery.EncodeXMLFast(enc, "OrgnlBizQry")
+	}
+	if g.AdditionalInformation != nil {
+		enc.Element("AddtlInf", string(*g.AdditionalInformation))
+	}
+	enc.End(tag)
+}
+
+func (g *GroupHeader81) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "MsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.MessageIdentification = (*Max35Text)(&value)
+		case "CreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.CreationDateTime = (*ISODateTime)(&value)
+		case "MsgRcpt":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.MessageRecipient = elem
+		case "MsgPgntn":
+			elem := new(Pagination1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.MessagePagination = elem
+		case "OrgnlBizQry":
+			elem := new(OriginalBusinessQuery1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.OriginalBusinessQuery = elem
+		case "AddtlInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.AdditionalInformation = (*Max500Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package model
+
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Set of characteristics shared by all individual transactions included in the message.
+type GroupHeader85 struct {
+
+	// Point to point reference, as assigned by the instructing party, and sent to the next party in the chain to unambiguously identify the message.
+	// Usage: The instructing party has to make sure that MessageIdentification is unique per instructed party for a pre-agreed period.
+	MessageIdentification *Max35Text `xml:"MsgId"`
+
+	// Date and time at which the message was created.
+	CreationDateTime *ISODateTime `xml:"CreDtTm"`
+
+	// User identification or any user key to be used to check whether the initiating party is allowed to initiate transactions from the account specified in the message.
+	//
+	// Usage: The content is not of a technical nature, but reflects the organisational structure at the initiating side.
+	// The authorisation element can typically be used in relay scenarios, payment initiations, payment returns or payment reversals that are initiated on behalf of a party different from the initiating party.
+	Authorisation []*Authorisation1Choice `xml:"Authstn,omitempty"`
+
+	// Number of individual transactions contained in the message.
+	NumberOfTransactions *Max15NumericText `xml:"NbOfTxs"`
+
+	// Total of all individual amounts included in the message, irrespective of currencies.
+	ControlSum *DecimalNumber `xml:"CtrlSum,omitempty"`
+
+	// Party that initiates the payment.
+	//
+	// Usage: This can either be the debtor or the party that initiates the credit transfer on behalf of the debtor.
+	InitiatingParty *PartyIdentification135 `xml:"InitgPty"`
+
+	// Financial institution that receives the instruction from the initiating party and forwards it to the next agent in the payment chain for execution.
+	ForwardingAgent *BranchAndFinancialInstitutionIdentification6 `xml:"FwdgAgt,omitempty"`
+}
+
+func (g *GroupHeader85) Validate() error {
+	return ValidateElement(g)
+}
+
+func (g *GroupHeader85) SetMessageIdentification(value string) {
+	g.MessageIdentification = (*Max35Text)(&value)
+}
+
+func (g *GroupHeader85) SetCreationDateTime(value string) {
+	g.CreationDateTime = (*ISODateTime)(&value)
+}
+
+func (g *GroupHeader85) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
+func (g *GroupHeader85) AddAuthorisation() *Authorisation1Choice {
+	newValue := new(Authorisation1Choice)
+	g.Authorisation = append(g.Authorisation, newValue)
+	return newValue
+}
+
+func (g *GroupHeader85) SetNumberOfTransactions(value string) {
+	g.NumberOfTransactions = (*Max15NumericText)(&value)
+}
+
+func (g *GroupHeader85) SetControlSum(value string) {
+	g.ControlSum = (*DecimalNumber)(&value)
+}
+
+func (g *GroupHeader85) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
+func (g *GroupHeader85) AddInitiatingParty() *PartyIdentification135 {
+	g.InitiatingParty = new(PartyIdentification135)
+	return g.InitiatingParty
+}
+
+func (g *GroupHeader85) AddForwardingAgent() *BranchAndFinancialInstitutionIdentification6 {
+	g.ForwardingAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return g.ForwardingAgent
+}
+
+func (g *GroupHeader85) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.MessageIdentification != nil {
+		enc.Element("MsgId", string(*g.MessageIdentification))
+	}
+	if g.CreationDateTime != nil {
+		enc.Element("CreDtTm", string(*g.CreationDateTime))
+	}
+	for _, elem := range g.Authorisation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Authstn")
+		}
+	}
+	if g.NumberOfTransactions != nil {
+		enc.Element("NbOfTxs", string(*g.NumberOfTransactions))
+	}
+	if g.ControlSum != nil {
+		enc.Element("CtrlSum", string(*g.ControlSum))
+	}
+	if g.InitiatingParty != nil {
+		g.InitiatingParty.EncodeXMLFast(enc, "InitgPty")
+	}
+	if g.ForwardingAgent != nil {
+		g.ForwardingAgent.EncodeXMLFast(enc, "FwdgAgt")
+	}
+	enc.End(tag)
+}
+
+func (g *GroupHeader85) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "MsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.MessageIdentification = (*Max35Text)(&value)
+		case "CreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.CreationDateTime = (*ISODateTime)(&value)
+		case "Authstn":
+			elem := new(Authorisation1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.Authorisation = append(g.Authorisation, elem)
+		case "NbOfTxs":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.NumberOfTransactions = (*Max15NumericText)(&value)
+		case "CtrlSum":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.ControlSum = (*DecimalNumber)(&value)
+		case "InitgPty":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.InitiatingParty = elem
+		case "FwdgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.ForwardingAgent = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
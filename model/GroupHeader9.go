@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics shared by all individual transactions included in the message.
 type GroupHeader9 struct {
 
@@ -45,6 +51,10 @@ type GroupHeader9 struct {
 	InstructedAgent *BranchAndFinancialInstitutionIdentification3 `xml:"InstdAgt,omitempty"`
 }
 
+func (g *GroupHeader9) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GroupHeader9) SetMessageIdentification(value string) {
 	g.MessageIdentification = (*Max35Text)(&value)
 }
@@ -53,6 +63,11 @@ func (g *GroupHeader9) SetCreationDateTime(value string) {
 	g.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (g *GroupHeader9) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
 func (g *GroupHeader9) AddAuthorisation(value string) {
 	g.Authorisation = append(g.Authorisation, (*Max128Text)(&value))
 }
@@ -61,6 +76,11 @@ func (g *GroupHeader9) SetBatchBooking(value string) {
 	g.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (g *GroupHeader9) SetBatchBookingFromBool(value bool) {
+	g.BatchBooking = new(BatchBookingIndicator)
+	g.BatchBooking.FromBool(value)
+}
+
 func (g *GroupHeader9) SetNumberOfTransactions(value string) {
 	g.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -69,18 +89,37 @@ func (g *GroupHeader9) SetControlSum(value string) {
 	g.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (g *GroupHeader9) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
 func (g *GroupHeader9) SetGroupReversal(value string) {
 	g.GroupReversal = (*TrueFalseIndicator)(&value)
 }
 
+func (g *GroupHeader9) SetGroupReversalFromBool(value bool) {
+	g.GroupReversal = new(TrueFalseIndicator)
+	g.GroupReversal.FromBool(value)
+}
+
 func (g *GroupHeader9) SetTotalReversedInterbankSettlementAmount(value, currency string) {
 	g.TotalReversedInterbankSettlementAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (g *GroupHeader9) SetTotalReversedInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.TotalReversedInterbankSettlementAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (g *GroupHeader9) SetInterbankSettlementDate(value string) {
 	g.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (g *GroupHeader9) SetInterbankSettlementDateFromTime(value time.Time) {
+	g.InterbankSettlementDate = new(ISODate)
+	g.InterbankSettlementDate.FromTime(value)
+}
+
 func (g *GroupHeader9) AddSettlementInformation() *SettlementInformation1 {
 	g.SettlementInformation = new(SettlementInformation1)
 	return g.SettlementInformation
@@ -0,0 +1,245 @@
+package model
+
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Set of characteristics shared by all individual transactions included in the message.
+type GroupHeader93 struct {
+
+	// Point to point reference, as assigned by the instructing party, and sent to the next party in the chain to unambiguously identify the message.
+	// Usage: The instructing party has to make sure that MessageIdentification is unique per instructed party for a pre-agreed period.
+	MessageIdentification *Max35Text `xml:"MsgId"`
+
+	// Date and time at which the message was created.
+	CreationDateTime *ISODateTime `xml:"CreDtTm"`
+
+	// Identifies whether a single entry per individual transaction or a batch entry for the sum of the amounts of all transactions within the group of a message is requested.
+	// Usage: Batch booking is used to request and not order a possible batch booking.
+	BatchBooking *BatchBookingIndicator `xml:"BtchBookg,omitempty"`
+
+	// Number of individual transactions contained in the message.
+	NumberOfTransactions *Max15NumericText `xml:"NbOfTxs"`
+
+	// Total of all individual amounts included in the message, irrespective of currencies.
+	ControlSum *DecimalNumber `xml:"CtrlSum,omitempty"`
+
+	// Total amount of money moved between the instructing agent and the instructed agent.
+	TotalInterbankSettlementAmount *ActiveCurrencyAndAmount `xml:"TtlIntrBkSttlmAmt,omitempty"`
+
+	// Date on which the amount of money ceases to be available to the agent that owes it and when the amount of money becomes available to the agent to which it is due.
+	InterbankSettlementDate *ISODate `xml:"IntrBkSttlmDt,omitempty"`
+
+	// Specifies the details on how the settlement of the transaction(s) between the instructing agent and the instructed agent is completed.
+	SettlementInformation *SettlementInstruction7 `xml:"SttlmInf"`
+
+	// Set of elements used to further specify the type of transaction.
+	PaymentTypeInformation *PaymentTypeInformation28 `xml:"PmtTpInf,omitempty"`
+
+	// Agent that instructs the next party in the chain to carry out the (set of) instruction(s).
+	InstructingAgent *BranchAndFinancialInstitutionIdentification6 `xml:"InstgAgt,omitempty"`
+
+	// Agent that is instructed by the previous party in the chain to carry out the (set of) instruction(s).
+	InstructedAgent *BranchAndFinancialInstitutionIdentification6 `xml:"InstdAgt,omitempty"`
+}
+
+func (g *GroupHeader93) Validate() error {
+	return ValidateElement(g)
+}
+
+func (g *GroupHeader93) SetMessageIdentification(value string) {
+	g.MessageIdentification = (*Max35Text)(&value)
+}
+
+func (g *GroupHeader93) SetCreationDateTime(value string) {
+	g.CreationDateTime = (*ISODateTime)(&value)
+}
+
+func (g *GroupHeader93) SetCreationDateTimeFromTime(value time.Time) {
+	g.CreationDateTime = new(ISODateTime)
+	g.CreationDateTime.FromTime(value)
+}
+
+func (g *GroupHeader93) SetBatchBooking(value string) {
+	g.BatchBooking = (*BatchBookingIndicator)(&value)
+}
+
+func (g *GroupHeader93) SetBatchBookingFromBool(value bool) {
+	g.BatchBooking = new(BatchBookingIndicator)
+	g.BatchBooking.FromBool(value)
+}
+
+func (g *GroupHeader93) SetNumberOfTransactions(value string) {
+	g.NumberOfTransactions = (*Max15NumericText)(&value)
+}
+
+func (g *GroupHeader93) SetControlSum(value string) {
+	g.ControlSum = (*DecimalNumber)(&value)
+}
+
+func (g *GroupHeader93) SetControlSumFromDecimal(value decimal.Decimal) {
+	g.ControlSum = new(DecimalNumber)
+	g.ControlSum.FromDecimal(value)
+}
+
+func (g *GroupHeader93) SetTotalInterbankSettlementAmount(value, currency string) {
+	g.TotalInterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
+}
+
+func (g *GroupHeader93) SetTotalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	g.TotalInterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (g *GroupHeader93) SetInterbankSettlementDate(value string) {
+	g.InterbankSettlementDate = (*ISODate)(&value)
+}
+
+func (g *GroupHeader93) SetInterbankSettlementDateFromTime(value time.Time) {
+	g.InterbankSettlementDate = new(ISODate)
+	g.InterbankSettlementDate.FromTime(value)
+}
+
+func (g *GroupHeader93) AddSettlementInformation() *SettlementInstruction7 {
+	g.SettlementInformation = new(SettlementInstruction7)
+	return g.SettlementInformation
+}
+
+func (g *GroupHeader93) AddPaymentTypeInformation() *PaymentTypeInformation28 {
+	g.PaymentTypeInformation = new(PaymentTypeInformation28)
+	return g.PaymentTypeInformation
+}
+
+func (g *GroupHeader93) AddInstructingAgent() *BranchAndFinancialInstitutionIdentification6 {
+	g.InstructingAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return g.InstructingAgent
+}
+
+func (g *GroupHeader93) AddInstructedAgent() *BranchAndFinancialInstitutionIdentification6 {
+	g.InstructedAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return g.InstructedAgent
+}
+
+func (g *GroupHeader93) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if g.MessageIdentification != nil {
+		enc.Element("MsgId", string(*g.MessageIdentification))
+	}
+	if g.CreationDateTime != nil {
+		enc.Element("CreDtTm", string(*g.CreationDateTime))
+	}
+	if g.BatchBooking != nil {
+		enc.Element("BtchBookg", string(*g.BatchBooking))
+	}
+	if g.NumberOfTransactions != nil {
+		enc.Element("NbOfTxs", string(*g.NumberOfTransactions))
+	}
+	if g.ControlSum != nil {
+		enc.Element("CtrlSum", string(*g.ControlSum))
+	}
+	if g.TotalInterbankSettlementAmount != nil {
+		g.TotalInterbankSettlementAmount.EncodeXMLFast(enc, "TtlIntrBkSttlmAmt")
+	}
+	if g.InterbankSettlementDate != nil {
+		enc.Element("IntrBkSttlmDt", string(*g.InterbankSettlementDate))
+	}
+	if g.SettlementInformation != nil {
+		g.SettlementInformation.EncodeXMLFast(enc, "SttlmInf")
+	}
+	if g.PaymentTypeInformation != nil {
+		g.PaymentTypeInformation.EncodeXMLFast(enc, "PmtTpInf")
+	}
+	if g.InstructingAgent != nil {
+		g.InstructingAgent.EncodeXMLFast(enc, "InstgAgt")
+	}
+	if g.InstructedAgent != nil {
+		g.InstructedAgent.EncodeXMLFast(enc, "InstdAgt")
+	}
+	enc.End(tag)
+}
+
+func (g *GroupHeader93) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "MsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.MessageIdentification = (*Max35Text)(&value)
+		case "CreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.CreationDateTime = (*ISODateTime)(&value)
+		case "BtchBookg":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.BatchBooking = (*BatchBookingIndicator)(&value)
+		case "NbOfTxs":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.NumberOfTransactions = (*Max15NumericText)(&value)
+		case "CtrlSum":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.ControlSum = (*DecimalNumber)(&value)
+		case "TtlIntrBkSttlmAmt":
+			elem := new(ActiveCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.TotalInterbankSettlementAmount = elem
+		case "IntrBkSttlmDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			g.InterbankSettlementDate = (*ISODate)(&value)
+		case "SttlmInf":
+			elem := new(SettlementInstruction7)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.SettlementInformation = elem
+		case "PmtTpInf":
+			elem := new(PaymentTypeInformation28)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.PaymentTypeInformation = elem
+		case "InstgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.InstructingAgent = elem
+		case "InstdAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			g.InstructedAgent = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,3 +1,28 @@
 package model
 
 type Grouping1Code string
+
+const (
+	Grouping1CodeSNGL Grouping1Code = "SNGL"
+	Grouping1CodeGRPD Grouping1Code = "GRPD"
+	Grouping1CodeMIXD Grouping1Code = "MIXD"
+)
+
+var grouping1CodeDescriptions = map[Grouping1Code]string{
+	Grouping1CodeSNGL: "Single: indicates that for each PaymentInformation block there is only one transaction.",
+	Grouping1CodeGRPD: "Grouped: indicates that there is only one PaymentInformation block and that it contains all transactions.",
+	Grouping1CodeMIXD: "Mixed: indicates that there is one or more PaymentInformation blocks, each containing one or more transactions.",
+}
+
+func (g Grouping1Code) IsValid() bool {
+	_, ok := grouping1CodeDescriptions[g]
+	return ok
+}
+
+func (g Grouping1Code) Description() string {
+	return grouping1CodeDescriptions[g]
+}
+
+func (g Grouping1Code) Validate() error {
+	return validateEnumeration("Grouping1Code", string(g), g.IsValid(), "SNGL, GRPD, MIXD")
+}
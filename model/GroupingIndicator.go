@@ -1,3 +1,15 @@
 package model
 
 type GroupingIndicator string
+
+func (g GroupingIndicator) Validate() error {
+	return validateBoolean("GroupingIndicator", string(g))
+}
+
+func (g GroupingIndicator) Bool() bool {
+	return parseBoolean(string(g))
+}
+
+func (g *GroupingIndicator) FromBool(value bool) {
+	*g = GroupingIndicator(formatBoolean(value))
+}
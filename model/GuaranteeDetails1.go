@@ -28,6 +28,10 @@ type GuaranteeDetails1 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (g *GuaranteeDetails1) Validate() error {
+	return ValidateElement(g)
+}
+
 func (g *GuaranteeDetails1) AddIssuer() *QualifiedPartyIdentification1 {
 	g.Issuer = new(QualifiedPartyIdentification1)
 	return g.Issuer
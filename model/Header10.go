@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics related to the protocol.
 type Header10 struct {
 
@@ -25,6 +29,10 @@ type Header10 struct {
 	Traceability []*Traceability2 `xml:"Tracblt,omitempty"`
 }
 
+func (h *Header10) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header10) SetMessageFunction(value string) {
 	h.MessageFunction = (*MessageFunction4Code)(&value)
 }
@@ -41,6 +49,11 @@ func (h *Header10) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header10) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header10) AddInitiatingParty() *GenericIdentification53 {
 	h.InitiatingParty = new(GenericIdentification53)
 	return h.InitiatingParty
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics related to the protocol.
 type Header11 struct {
 
@@ -28,6 +32,10 @@ type Header11 struct {
 	Traceability []*Traceability2 `xml:"Tracblt,omitempty"`
 }
 
+func (h *Header11) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header11) SetMessageFunction(value string) {
 	h.MessageFunction = (*MessageFunction4Code)(&value)
 }
@@ -48,6 +56,11 @@ func (h *Header11) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header11) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header11) AddInitiatingParty() *GenericIdentification53 {
 	h.InitiatingParty = new(GenericIdentification53)
 	return h.InitiatingParty
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics related to the transfer of transactions.
 type Header12 struct {
 
@@ -22,10 +26,19 @@ type Header12 struct {
 	RecipientParty *GenericIdentification53 `xml:"RcptPty,omitempty"`
 }
 
+func (h *Header12) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header12) SetDownloadTransfer(value string) {
 	h.DownloadTransfer = (*TrueFalseIndicator)(&value)
 }
 
+func (h *Header12) SetDownloadTransferFromBool(value bool) {
+	h.DownloadTransfer = new(TrueFalseIndicator)
+	h.DownloadTransfer.FromBool(value)
+}
+
 func (h *Header12) SetFormatVersion(value string) {
 	h.FormatVersion = (*Max6Text)(&value)
 }
@@ -38,6 +51,11 @@ func (h *Header12) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header12) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header12) AddInitiatingParty() *GenericIdentification53 {
 	h.InitiatingParty = new(GenericIdentification53)
 	return h.InitiatingParty
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics related to the reject of a transaction.
 type Header15 struct {
 
@@ -22,10 +26,19 @@ type Header15 struct {
 	RecipientParty *GenericIdentification71 `xml:"RcptPty,omitempty"`
 }
 
+func (h *Header15) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header15) SetDownloadTransfer(value string) {
 	h.DownloadTransfer = (*TrueFalseIndicator)(&value)
 }
 
+func (h *Header15) SetDownloadTransferFromBool(value bool) {
+	h.DownloadTransfer = new(TrueFalseIndicator)
+	h.DownloadTransfer.FromBool(value)
+}
+
 func (h *Header15) SetFormatVersion(value string) {
 	h.FormatVersion = (*Max6Text)(&value)
 }
@@ -38,6 +51,11 @@ func (h *Header15) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header15) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header15) AddInitiatingParty() *GenericIdentification71 {
 	h.InitiatingParty = new(GenericIdentification71)
 	return h.InitiatingParty
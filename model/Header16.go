@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics related to the reject of a transaction.
 type Header16 struct {
 
@@ -19,6 +23,10 @@ type Header16 struct {
 	RecipientParty *GenericIdentification72 `xml:"RcptPty,omitempty"`
 }
 
+func (h *Header16) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header16) SetProtocolVersion(value string) {
 	h.ProtocolVersion = (*Max6Text)(&value)
 }
@@ -31,6 +39,11 @@ func (h *Header16) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header16) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header16) AddInitiatingParty() *GenericIdentification72 {
 	h.InitiatingParty = new(GenericIdentification72)
 	return h.InitiatingParty
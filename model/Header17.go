@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics related to the protocol.
 type Header17 struct {
 
@@ -28,6 +32,10 @@ type Header17 struct {
 	Traceability []*Traceability3 `xml:"Tracblt,omitempty"`
 }
 
+func (h *Header17) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header17) SetMessageFunction(value string) {
 	h.MessageFunction = (*MessageFunction6Code)(&value)
 }
@@ -48,6 +56,11 @@ func (h *Header17) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header17) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header17) AddInitiatingParty() *GenericIdentification73 {
 	h.InitiatingParty = new(GenericIdentification73)
 	return h.InitiatingParty
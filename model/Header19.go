@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics related to the protocol.
 type Header19 struct {
 
@@ -31,6 +35,10 @@ type Header19 struct {
 	Traceability []*Traceability3 `xml:"Tracblt,omitempty"`
 }
 
+func (h *Header19) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header19) SetMessageFunction(value string) {
 	h.MessageFunction = (*MessageFunction6Code)(&value)
 }
@@ -55,6 +63,11 @@ func (h *Header19) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header19) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header19) AddInitiatingParty() *GenericIdentification73 {
 	h.InitiatingParty = new(GenericIdentification73)
 	return h.InitiatingParty
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information related to the protocol management on a segment of the path from the ATM to the acquirer.
 type Header21 struct {
 
@@ -31,6 +37,10 @@ type Header21 struct {
 	Traceability []*Traceability4 `xml:"Tracblt,omitempty"`
 }
 
+func (h *Header21) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header21) AddMessageFunction() *ATMMessageFunction1 {
 	h.MessageFunction = new(ATMMessageFunction1)
 	return h.MessageFunction
@@ -48,10 +58,20 @@ func (h *Header21) SetReTransmissionCounter(value string) {
 	h.ReTransmissionCounter = (*Number)(&value)
 }
 
+func (h *Header21) SetReTransmissionCounterFromDecimal(value decimal.Decimal) {
+	h.ReTransmissionCounter = new(Number)
+	h.ReTransmissionCounter.FromDecimal(value)
+}
+
 func (h *Header21) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header21) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header21) SetInitiatingParty(value string) {
 	h.InitiatingParty = (*Max35Text)(&value)
 }
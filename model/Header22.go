@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information related to the protocol management on a segment of the path from the ATM to the acquirer.
 type Header22 struct {
 
@@ -28,6 +32,10 @@ type Header22 struct {
 	Traceability []*Traceability4 `xml:"Tracblt,omitempty"`
 }
 
+func (h *Header22) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header22) AddMessageFunction() *ATMMessageFunction1 {
 	h.MessageFunction = new(ATMMessageFunction1)
 	return h.MessageFunction
@@ -45,6 +53,11 @@ func (h *Header22) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header22) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header22) SetInitiatingParty(value string) {
 	h.InitiatingParty = (*Max35Text)(&value)
 }
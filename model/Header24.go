@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics related to the protocol.
 type Header24 struct {
 
@@ -28,6 +34,10 @@ type Header24 struct {
 	Traceability []*Traceability5 `xml:"Tracblt,omitempty"`
 }
 
+func (h *Header24) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header24) SetMessageFunction(value string) {
 	h.MessageFunction = (*MessageFunction10Code)(&value)
 }
@@ -40,6 +50,11 @@ func (h *Header24) SetExchangeIdentification(value string) {
 	h.ExchangeIdentification = (*Number)(&value)
 }
 
+func (h *Header24) SetExchangeIdentificationFromDecimal(value decimal.Decimal) {
+	h.ExchangeIdentification = new(Number)
+	h.ExchangeIdentification.FromDecimal(value)
+}
+
 func (h *Header24) SetReTransmissionCounter(value string) {
 	h.ReTransmissionCounter = (*Max3NumericText)(&value)
 }
@@ -48,6 +63,11 @@ func (h *Header24) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header24) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header24) AddInitiatingParty() *GenericIdentification53 {
 	h.InitiatingParty = new(GenericIdentification53)
 	return h.InitiatingParty
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics related to the transfer of transactions.
 type Header25 struct {
 
@@ -22,10 +28,19 @@ type Header25 struct {
 	RecipientParty *GenericIdentification94 `xml:"RcptPty,omitempty"`
 }
 
+func (h *Header25) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header25) SetDownloadTransfer(value string) {
 	h.DownloadTransfer = (*TrueFalseIndicator)(&value)
 }
 
+func (h *Header25) SetDownloadTransferFromBool(value bool) {
+	h.DownloadTransfer = new(TrueFalseIndicator)
+	h.DownloadTransfer.FromBool(value)
+}
+
 func (h *Header25) SetFormatVersion(value string) {
 	h.FormatVersion = (*Max6Text)(&value)
 }
@@ -34,10 +49,20 @@ func (h *Header25) SetExchangeIdentification(value string) {
 	h.ExchangeIdentification = (*Number)(&value)
 }
 
+func (h *Header25) SetExchangeIdentificationFromDecimal(value decimal.Decimal) {
+	h.ExchangeIdentification = new(Number)
+	h.ExchangeIdentification.FromDecimal(value)
+}
+
 func (h *Header25) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header25) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header25) AddInitiatingParty() *GenericIdentification53 {
 	h.InitiatingParty = new(GenericIdentification53)
 	return h.InitiatingParty
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics related to the transfer of transactions.
 type Header27 struct {
 
@@ -22,10 +28,19 @@ type Header27 struct {
 	RecipientParty *GenericIdentification92 `xml:"RcptPty,omitempty"`
 }
 
+func (h *Header27) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header27) SetDownloadTransfer(value string) {
 	h.DownloadTransfer = (*TrueFalseIndicator)(&value)
 }
 
+func (h *Header27) SetDownloadTransferFromBool(value bool) {
+	h.DownloadTransfer = new(TrueFalseIndicator)
+	h.DownloadTransfer.FromBool(value)
+}
+
 func (h *Header27) SetFormatVersion(value string) {
 	h.FormatVersion = (*Max6Text)(&value)
 }
@@ -34,10 +49,20 @@ func (h *Header27) SetExchangeIdentification(value string) {
 	h.ExchangeIdentification = (*Number)(&value)
 }
 
+func (h *Header27) SetExchangeIdentificationFromDecimal(value decimal.Decimal) {
+	h.ExchangeIdentification = new(Number)
+	h.ExchangeIdentification.FromDecimal(value)
+}
+
 func (h *Header27) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header27) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header27) AddInitiatingParty() *GenericIdentification71 {
 	h.InitiatingParty = new(GenericIdentification71)
 	return h.InitiatingParty
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics related to the reject of a transaction.
 type Header29 struct {
 
@@ -19,6 +25,10 @@ type Header29 struct {
 	RecipientParty *GenericIdentification93 `xml:"RcptPty,omitempty"`
 }
 
+func (h *Header29) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header29) SetProtocolVersion(value string) {
 	h.ProtocolVersion = (*Max6Text)(&value)
 }
@@ -27,10 +37,20 @@ func (h *Header29) SetExchangeIdentification(value string) {
 	h.ExchangeIdentification = (*Number)(&value)
 }
 
+func (h *Header29) SetExchangeIdentificationFromDecimal(value decimal.Decimal) {
+	h.ExchangeIdentification = new(Number)
+	h.ExchangeIdentification.FromDecimal(value)
+}
+
 func (h *Header29) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header29) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header29) AddInitiatingParty() *GenericIdentification72 {
 	h.InitiatingParty = new(GenericIdentification72)
 	return h.InitiatingParty
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics related to the transfer of transactions.
 type Header3 struct {
 
@@ -22,10 +26,19 @@ type Header3 struct {
 	RecipientParty *GenericIdentification32 `xml:"RcptPty,omitempty"`
 }
 
+func (h *Header3) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header3) SetDownloadTransfer(value string) {
 	h.DownloadTransfer = (*TrueFalseIndicator)(&value)
 }
 
+func (h *Header3) SetDownloadTransferFromBool(value bool) {
+	h.DownloadTransfer = new(TrueFalseIndicator)
+	h.DownloadTransfer.FromBool(value)
+}
+
 func (h *Header3) SetFormatVersion(value string) {
 	h.FormatVersion = (*Max6Text)(&value)
 }
@@ -38,6 +51,11 @@ func (h *Header3) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header3) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header3) AddInitiatingParty() *GenericIdentification32 {
 	h.InitiatingParty = new(GenericIdentification32)
 	return h.InitiatingParty
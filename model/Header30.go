@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics related to the protocol.
 type Header30 struct {
 
@@ -25,6 +31,10 @@ type Header30 struct {
 	Traceability []*Traceability5 `xml:"Tracblt,omitempty"`
 }
 
+func (h *Header30) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header30) SetMessageFunction(value string) {
 	h.MessageFunction = (*MessageFunction10Code)(&value)
 }
@@ -37,10 +47,20 @@ func (h *Header30) SetExchangeIdentification(value string) {
 	h.ExchangeIdentification = (*Number)(&value)
 }
 
+func (h *Header30) SetExchangeIdentificationFromDecimal(value decimal.Decimal) {
+	h.ExchangeIdentification = new(Number)
+	h.ExchangeIdentification.FromDecimal(value)
+}
+
 func (h *Header30) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header30) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header30) AddInitiatingParty() *GenericIdentification53 {
 	h.InitiatingParty = new(GenericIdentification53)
 	return h.InitiatingParty
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics related to the transfer of transactions.
 type Header4 struct {
 
@@ -22,10 +26,19 @@ type Header4 struct {
 	RecipientParty *GenericIdentification35 `xml:"RcptPty,omitempty"`
 }
 
+func (h *Header4) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header4) SetDownloadTransfer(value string) {
 	h.DownloadTransfer = (*TrueFalseIndicator)(&value)
 }
 
+func (h *Header4) SetDownloadTransferFromBool(value bool) {
+	h.DownloadTransfer = new(TrueFalseIndicator)
+	h.DownloadTransfer.FromBool(value)
+}
+
 func (h *Header4) SetFormatVersion(value string) {
 	h.FormatVersion = (*Max6Text)(&value)
 }
@@ -38,6 +51,11 @@ func (h *Header4) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header4) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header4) AddInitiatingParty() *GenericIdentification35 {
 	h.InitiatingParty = new(GenericIdentification35)
 	return h.InitiatingParty
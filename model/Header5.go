@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics related to the protocol after a rejection.
 type Header5 struct {
 
@@ -25,6 +29,10 @@ type Header5 struct {
 	Traceability []*Traceability1 `xml:"Tracblt,omitempty"`
 }
 
+func (h *Header5) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header5) SetMessageFunction(value string) {
 	h.MessageFunction = (*MessageFunction1Code)(&value)
 }
@@ -41,6 +49,11 @@ func (h *Header5) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header5) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header5) AddInitiatingParty() *GenericIdentification32 {
 	h.InitiatingParty = new(GenericIdentification32)
 	return h.InitiatingParty
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics related to the reject of a transaction.
 type Header6 struct {
 
@@ -22,10 +26,19 @@ type Header6 struct {
 	RecipientParty *GenericIdentification35 `xml:"RcptPty,omitempty"`
 }
 
+func (h *Header6) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header6) SetDownloadTransfer(value string) {
 	h.DownloadTransfer = (*TrueFalseIndicator)(&value)
 }
 
+func (h *Header6) SetDownloadTransferFromBool(value bool) {
+	h.DownloadTransfer = new(TrueFalseIndicator)
+	h.DownloadTransfer.FromBool(value)
+}
+
 func (h *Header6) SetFormatVersion(value string) {
 	h.FormatVersion = (*Max6Text)(&value)
 }
@@ -38,6 +51,11 @@ func (h *Header6) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header6) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header6) AddInitiatingParty() *GenericIdentification35 {
 	h.InitiatingParty = new(GenericIdentification35)
 	return h.InitiatingParty
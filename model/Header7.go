@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics related to the protocol.
 type Header7 struct {
 
@@ -25,6 +29,10 @@ type Header7 struct {
 	Traceability []*Traceability1 `xml:"Tracblt,omitempty"`
 }
 
+func (h *Header7) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header7) SetMessageFunction(value string) {
 	h.MessageFunction = (*MessageFunction4Code)(&value)
 }
@@ -41,6 +49,11 @@ func (h *Header7) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header7) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header7) AddInitiatingParty() *GenericIdentification32 {
 	h.InitiatingParty = new(GenericIdentification32)
 	return h.InitiatingParty
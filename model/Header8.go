@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics related to the protocol.
 type Header8 struct {
 
@@ -28,6 +32,10 @@ type Header8 struct {
 	Traceability []*Traceability1 `xml:"Tracblt,omitempty"`
 }
 
+func (h *Header8) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *Header8) SetMessageFunction(value string) {
 	h.MessageFunction = (*MessageFunction4Code)(&value)
 }
@@ -48,6 +56,11 @@ func (h *Header8) SetCreationDateTime(value string) {
 	h.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (h *Header8) SetCreationDateTimeFromTime(value time.Time) {
+	h.CreationDateTime = new(ISODateTime)
+	h.CreationDateTime.FromTime(value)
+}
+
 func (h *Header8) AddInitiatingParty() *GenericIdentification32 {
 	h.InitiatingParty = new(GenericIdentification32)
 	return h.InitiatingParty
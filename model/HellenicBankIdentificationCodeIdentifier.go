@@ -1,3 +1,9 @@
 package model
 
 type HellenicBankIdentificationCodeIdentifier string
+
+var hellenicBankIdentificationCodeIdentifierPattern = newPattern(`GR[0-9]{7,7}`)
+
+func (h HellenicBankIdentificationCodeIdentifier) Validate() error {
+	return validatePattern("HellenicBankIdentificationCodeIdentifier", string(h), hellenicBankIdentificationCodeIdentifierPattern)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information about a high frequency trading profile.
 type HighFrequencyTradingProfile1 struct {
 
@@ -13,10 +17,19 @@ type HighFrequencyTradingProfile1 struct {
 	ConsolidationType *ConsolidationType1Choice `xml:"CnsldtnTp,omitempty"`
 }
 
+func (h *HighFrequencyTradingProfile1) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HighFrequencyTradingProfile1) SetDate(value string) {
 	h.Date = (*ISODate)(&value)
 }
 
+func (h *HighFrequencyTradingProfile1) SetDateFromTime(value time.Time) {
+	h.Date = new(ISODate)
+	h.Date.FromTime(value)
+}
+
 func (h *HighFrequencyTradingProfile1) AddSettlementFrequency() *SettlementFrequency1Choice {
 	h.SettlementFrequency = new(SettlementFrequency1Choice)
 	return h.SettlementFrequency
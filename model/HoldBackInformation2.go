@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about hold back and gating.
 type HoldBackInformation2 struct {
 
@@ -25,6 +31,10 @@ type HoldBackInformation2 struct {
 	FinalConfirmation *YesNoIndicator `xml:"FnlConf,omitempty"`
 }
 
+func (h *HoldBackInformation2) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HoldBackInformation2) SetType(value string) {
 	h.Type = (*GateHoldBack1Code)(&value)
 }
@@ -33,10 +43,19 @@ func (h *HoldBackInformation2) SetAmount(value, currency string) {
 	h.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (h *HoldBackInformation2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	h.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (h *HoldBackInformation2) SetExpectedReleaseDate(value string) {
 	h.ExpectedReleaseDate = (*ISODate)(&value)
 }
 
+func (h *HoldBackInformation2) SetExpectedReleaseDateFromTime(value time.Time) {
+	h.ExpectedReleaseDate = new(ISODate)
+	h.ExpectedReleaseDate.FromTime(value)
+}
+
 func (h *HoldBackInformation2) AddFinancialInstrumentIdentification() *SecurityIdentification25Choice {
 	h.FinancialInstrumentIdentification = new(SecurityIdentification25Choice)
 	return h.FinancialInstrumentIdentification
@@ -53,3 +72,8 @@ func (h *HoldBackInformation2) SetRedemptionCompletion(value string) {
 func (h *HoldBackInformation2) SetFinalConfirmation(value string) {
 	h.FinalConfirmation = (*YesNoIndicator)(&value)
 }
+
+func (h *HoldBackInformation2) SetFinalConfirmationFromBool(value bool) {
+	h.FinalConfirmation = new(YesNoIndicator)
+	h.FinalConfirmation.FromBool(value)
+}
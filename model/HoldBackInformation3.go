@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about hold back and gating.
 type HoldBackInformation3 struct {
 
@@ -22,6 +28,10 @@ type HoldBackInformation3 struct {
 	RedemptionCompletion *RedemptionCompletion1Code `xml:"RedCmpltn,omitempty"`
 }
 
+func (h *HoldBackInformation3) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HoldBackInformation3) SetType(value string) {
 	h.Type = (*GateHoldBack1Code)(&value)
 }
@@ -30,10 +40,19 @@ func (h *HoldBackInformation3) SetAmount(value, currency string) {
 	h.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (h *HoldBackInformation3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	h.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (h *HoldBackInformation3) SetExpectedReleaseDate(value string) {
 	h.ExpectedReleaseDate = (*ISODate)(&value)
 }
 
+func (h *HoldBackInformation3) SetExpectedReleaseDateFromTime(value time.Time) {
+	h.ExpectedReleaseDate = new(ISODate)
+	h.ExpectedReleaseDate.FromTime(value)
+}
+
 func (h *HoldBackInformation3) AddFinancialInstrumentIdentification() *SecurityIdentification25Choice {
 	h.FinancialInstrumentIdentification = new(SecurityIdentification25Choice)
 	return h.FinancialInstrumentIdentification
@@ -10,10 +10,19 @@ type HoldIndicator2 struct {
 	Reason []*RegistrationReason1 `xml:"Rsn,omitempty"`
 }
 
+func (h *HoldIndicator2) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HoldIndicator2) SetIndicator(value string) {
 	h.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (h *HoldIndicator2) SetIndicatorFromBool(value bool) {
+	h.Indicator = new(YesNoIndicator)
+	h.Indicator.FromBool(value)
+}
+
 func (h *HoldIndicator2) AddReason() *RegistrationReason1 {
 	newValue := new(RegistrationReason1)
 	h.Reason = append(h.Reason, newValue)
@@ -10,10 +10,19 @@ type HoldIndicator4 struct {
 	Reason []*RegistrationReason3 `xml:"Rsn,omitempty"`
 }
 
+func (h *HoldIndicator4) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HoldIndicator4) SetIndicator(value string) {
 	h.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (h *HoldIndicator4) SetIndicatorFromBool(value bool) {
+	h.Indicator = new(YesNoIndicator)
+	h.Indicator.FromBool(value)
+}
+
 func (h *HoldIndicator4) AddReason() *RegistrationReason3 {
 	newValue := new(RegistrationReason3)
 	h.Reason = append(h.Reason, newValue)
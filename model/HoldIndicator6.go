@@ -10,10 +10,19 @@ type HoldIndicator6 struct {
 	Reason []*RegistrationReason5 `xml:"Rsn,omitempty"`
 }
 
+func (h *HoldIndicator6) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HoldIndicator6) SetIndicator(value string) {
 	h.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (h *HoldIndicator6) SetIndicatorFromBool(value bool) {
+	h.Indicator = new(YesNoIndicator)
+	h.Indicator.FromBool(value)
+}
+
 func (h *HoldIndicator6) AddReason() *RegistrationReason5 {
 	newValue := new(RegistrationReason5)
 	h.Reason = append(h.Reason, newValue)
@@ -10,10 +10,19 @@ type HoldIndicator7 struct {
 	Reason []*RegistrationReason6 `xml:"Rsn,omitempty"`
 }
 
+func (h *HoldIndicator7) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HoldIndicator7) SetIndicator(value string) {
 	h.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (h *HoldIndicator7) SetIndicatorFromBool(value bool) {
+	h.Indicator = new(YesNoIndicator)
+	h.Indicator.FromBool(value)
+}
+
 func (h *HoldIndicator7) AddReason() *RegistrationReason6 {
 	newValue := new(RegistrationReason6)
 	h.Reason = append(h.Reason, newValue)
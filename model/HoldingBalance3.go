@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Net position of a segregated holding of a single security within the overall position held in the securities account, eg, sub-balance per status.
 type HoldingBalance3 struct {
 
@@ -16,6 +20,10 @@ type HoldingBalance3 struct {
 	Date *ISODate `xml:"Dt,omitempty"`
 }
 
+func (h *HoldingBalance3) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HoldingBalance3) AddBalance() *UnitOrFaceAmountChoice {
 	h.Balance = new(UnitOrFaceAmountChoice)
 	return h.Balance
@@ -33,3 +41,8 @@ func (h *HoldingBalance3) AddSafekeepingPlace() *SafekeepingPlaceFormatChoice {
 func (h *HoldingBalance3) SetDate(value string) {
 	h.Date = (*ISODate)(&value)
 }
+
+func (h *HoldingBalance3) SetDateFromTime(value time.Time) {
+	h.Date = new(ISODate)
+	h.Date.FromTime(value)
+}
@@ -13,6 +13,10 @@ type HoldingBalance4 struct {
 	SafekeepingPlace *SafekeepingPlaceFormatChoice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (h *HoldingBalance4) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HoldingBalance4) AddBalance() *UnitOrFaceAmountOrCodeChoice {
 	h.Balance = new(UnitOrFaceAmountOrCodeChoice)
 	return h.Balance
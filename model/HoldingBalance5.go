@@ -13,6 +13,10 @@ type HoldingBalance5 struct {
 	SafekeepingPlace *SafekeepingPlaceFormatChoice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (h *HoldingBalance5) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HoldingBalance5) AddBalance() *UnitOrFaceAmountOrCode1Choice {
 	h.Balance = new(UnitOrFaceAmountOrCode1Choice)
 	return h.Balance
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Net position of a segregated holding of a single security within the overall position held in the securities account, eg, sub-balance per status.
 type HoldingBalance6 struct {
 
@@ -16,6 +20,10 @@ type HoldingBalance6 struct {
 	Date *ISODate `xml:"Dt,omitempty"`
 }
 
+func (h *HoldingBalance6) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HoldingBalance6) AddBalance() *UnitOrFaceAmount1Choice {
 	h.Balance = new(UnitOrFaceAmount1Choice)
 	return h.Balance
@@ -33,3 +41,8 @@ func (h *HoldingBalance6) AddSafekeepingPlace() *SafekeepingPlaceFormatChoice {
 func (h *HoldingBalance6) SetDate(value string) {
 	h.Date = (*ISODate)(&value)
 }
+
+func (h *HoldingBalance6) SetDateFromTime(value time.Time) {
+	h.Date = new(ISODate)
+	h.Date.FromTime(value)
+}
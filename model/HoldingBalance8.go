@@ -13,6 +13,10 @@ type HoldingBalance8 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat2Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (h *HoldingBalance8) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HoldingBalance8) AddBalance() *UnitOrFaceAmountOrCode1Choice {
 	h.Balance = new(UnitOrFaceAmountOrCode1Choice)
 	return h.Balance
@@ -1,3 +1,9 @@
 package model
 
 type HongKongBankIdentifier string
+
+var hongKongBankIdentifierPattern = newPattern(`HK[0-9]{3,3}`)
+
+func (h HongKongBankIdentifier) Validate() error {
+	return validatePattern("HongKongBankIdentifier", string(h), hongKongBankIdentifierPattern)
+}
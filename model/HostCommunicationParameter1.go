@@ -13,6 +13,10 @@ type HostCommunicationParameter1 struct {
 	Key []*CryptographicKey1 `xml:"Key,omitempty"`
 }
 
+func (h *HostCommunicationParameter1) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HostCommunicationParameter1) SetHostIdentification(value string) {
 	h.HostIdentification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type HostCommunicationParameter2 struct {
 	Key []*CryptographicKey3 `xml:"Key,omitempty"`
 }
 
+func (h *HostCommunicationParameter2) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HostCommunicationParameter2) SetHostIdentification(value string) {
 	h.HostIdentification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type HostCommunicationParameter3 struct {
 	Key []*KEKIdentifier2 `xml:"Key,omitempty"`
 }
 
+func (h *HostCommunicationParameter3) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HostCommunicationParameter3) SetHostIdentification(value string) {
 	h.HostIdentification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type HostCommunicationParameter4 struct {
 	NetworkServiceProvider *NetworkParameters5 `xml:"NtwkSvcPrvdr,omitempty"`
 }
 
+func (h *HostCommunicationParameter4) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HostCommunicationParameter4) SetActionType(value string) {
 	h.ActionType = (*TerminalManagementAction3Code)(&value)
 }
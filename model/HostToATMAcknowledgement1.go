@@ -7,6 +7,10 @@ type HostToATMAcknowledgement1 struct {
 	Environment *ATMEnvironment9 `xml:"Envt"`
 }
 
+func (h *HostToATMAcknowledgement1) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HostToATMAcknowledgement1) AddEnvironment() *ATMEnvironment9 {
 	h.Environment = new(ATMEnvironment9)
 	return h.Environment
@@ -13,6 +13,10 @@ type HostToATMRequest1 struct {
 	ExpectedMessageFunction *MessageFunction8Code `xml:"XpctdMsgFctn"`
 }
 
+func (h *HostToATMRequest1) Validate() error {
+	return ValidateElement(h)
+}
+
 func (h *HostToATMRequest1) AddEnvironment() *ATMEnvironment9 {
 	h.Environment = new(ATMEnvironment9)
 	return h.Environment
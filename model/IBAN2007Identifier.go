@@ -1,3 +1,16 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/validation"
+)
+
 type IBAN2007Identifier string
+
+var iban2007IdentifierPattern = newPattern(`[A-Z]{2,2}[0-9]{2,2}[a-zA-Z0-9]{1,30}`)
+
+func (i IBAN2007Identifier) Validate() error {
+	if err := validatePattern("IBAN2007Identifier", string(i), iban2007IdentifierPattern); err != nil {
+		return err
+	}
+	return validation.ValidateIBAN(string(i))
+}
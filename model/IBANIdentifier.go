@@ -1,3 +1,18 @@
 package model
 
+import (
+	"strings"
+
+	"github.com/yudaprama/iso20022/validation"
+)
+
 type IBANIdentifier string
+
+var ibanIdentifierPattern = newPattern(`[a-zA-Z]{2,2}[0-9]{2,2}[a-zA-Z0-9]{1,30}`)
+
+func (i IBANIdentifier) Validate() error {
+	if err := validatePattern("IBANIdentifier", string(i), ibanIdentifierPattern); err != nil {
+		return err
+	}
+	return validation.ValidateIBAN(strings.ToUpper(string(i)))
+}
@@ -1,3 +1,9 @@
 package model
 
 type IBEIIdentifier string
+
+var ibeiIdentifierPattern = newPattern(`[A-Z]{2,2}[B-DF-HJ-NP-TV-XZ0-9]{7,7}[0-9]{1,1}`)
+
+func (i IBEIIdentifier) Validate() error {
+	return validatePattern("IBEIIdentifier", string(i), ibeiIdentifierPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type ID string
+
+var idPattern = newPattern(`[a-zA-Z_][a-zA-Z0-9_.\-]*`)
+
+func (i ID) Validate() error {
+	return validatePattern("ID", string(i), idPattern)
+}
@@ -11,6 +11,10 @@ type ISAPortfolio1Choice struct {
 	Portfolio *Portfolio1 `xml:"Prtfl"`
 }
 
+func (i *ISAPortfolio1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISAPortfolio1Choice) AddISA() *ISAYearsOfIssue4 {
 	i.ISA = new(ISAYearsOfIssue4)
 	return i.ISA
@@ -11,6 +11,10 @@ type ISAPortfolio2Choice struct {
 	Portfolio *Portfolio1 `xml:"Prtfl"`
 }
 
+func (i *ISAPortfolio2Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISAPortfolio2Choice) AddISA() *ISAYearsOfIssue5 {
 	i.ISA = new(ISAYearsOfIssue5)
 	return i.ISA
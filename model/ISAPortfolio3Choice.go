@@ -11,6 +11,10 @@ type ISAPortfolio3Choice struct {
 	Portfolio *Portfolio1 `xml:"Prtfl"`
 }
 
+func (i *ISAPortfolio3Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISAPortfolio3Choice) AddISA() *ISAYearsOfIssue6 {
 	i.ISA = new(ISAYearsOfIssue6)
 	return i.ISA
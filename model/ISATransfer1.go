@@ -25,6 +25,10 @@ type ISATransfer1 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument23 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer1) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -51,6 +55,11 @@ func (i *ISATransfer1) SetAllOtherCash(value string) {
 	i.AllOtherCash = (*YesNoIndicator)(&value)
 }
 
+func (i *ISATransfer1) SetAllOtherCashFromBool(value bool) {
+	i.AllOtherCash = new(YesNoIndicator)
+	i.AllOtherCash.FromBool(value)
+}
+
 func (i *ISATransfer1) AddFinancialInstrumentAssetForTransfer() *FinancialInstrument23 {
 	newValue := new(FinancialInstrument23)
 	i.FinancialInstrumentAssetForTransfer = append(i.FinancialInstrumentAssetForTransfer, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Describes the type of product and the assets to be transferred.
 type ISATransfer10 struct {
 
@@ -28,6 +32,10 @@ type ISATransfer10 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument33 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer10) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer10) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -44,6 +52,11 @@ func (i *ISATransfer10) SetActualTransferDate(value string) {
 	i.ActualTransferDate = (*ISODate)(&value)
 }
 
+func (i *ISATransfer10) SetActualTransferDateFromTime(value time.Time) {
+	i.ActualTransferDate = new(ISODate)
+	i.ActualTransferDate.FromTime(value)
+}
+
 func (i *ISATransfer10) SetResidualCash(value string) {
 	i.ResidualCash = (*ResidualCash1Code)(&value)
 }
@@ -57,6 +70,11 @@ func (i *ISATransfer10) SetAllOtherCash(value string) {
 	i.AllOtherCash = (*YesNoIndicator)(&value)
 }
 
+func (i *ISATransfer10) SetAllOtherCashFromBool(value bool) {
+	i.AllOtherCash = new(YesNoIndicator)
+	i.AllOtherCash.FromBool(value)
+}
+
 func (i *ISATransfer10) AddFinancialInstrumentAssetForTransfer() *FinancialInstrument33 {
 	newValue := new(FinancialInstrument33)
 	i.FinancialInstrumentAssetForTransfer = append(i.FinancialInstrumentAssetForTransfer, newValue)
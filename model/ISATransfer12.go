@@ -40,6 +40,10 @@ type ISATransfer12 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (i *ISATransfer12) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer12) AddPrimaryIndividualInvestor() *IndividualPerson8 {
 	i.PrimaryIndividualInvestor = new(IndividualPerson8)
 	return i.PrimaryIndividualInvestor
@@ -25,6 +25,10 @@ type ISATransfer14 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument37 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer14) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer14) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -50,6 +54,11 @@ func (i *ISATransfer14) SetAllOtherCash(value string) {
 	i.AllOtherCash = (*YesNoIndicator)(&value)
 }
 
+func (i *ISATransfer14) SetAllOtherCashFromBool(value bool) {
+	i.AllOtherCash = new(YesNoIndicator)
+	i.AllOtherCash.FromBool(value)
+}
+
 func (i *ISATransfer14) AddFinancialInstrumentAssetForTransfer() *FinancialInstrument37 {
 	newValue := new(FinancialInstrument37)
 	i.FinancialInstrumentAssetForTransfer = append(i.FinancialInstrumentAssetForTransfer, newValue)
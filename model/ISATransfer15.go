@@ -19,6 +19,10 @@ type ISATransfer15 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument36 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer15) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer15) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -36,6 +40,11 @@ func (i *ISATransfer15) SetAllOtherCash(value string) {
 	i.AllOtherCash = (*YesNoIndicator)(&value)
 }
 
+func (i *ISATransfer15) SetAllOtherCashFromBool(value bool) {
+	i.AllOtherCash = new(YesNoIndicator)
+	i.AllOtherCash.FromBool(value)
+}
+
 func (i *ISATransfer15) AddFinancialInstrumentAssetForTransfer() *FinancialInstrument36 {
 	newValue := new(FinancialInstrument36)
 	i.FinancialInstrumentAssetForTransfer = append(i.FinancialInstrumentAssetForTransfer, newValue)
@@ -28,6 +28,10 @@ type ISATransfer16 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument34 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer16) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer16) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -58,6 +62,11 @@ func (i *ISATransfer16) SetAllOtherCash(value string) {
 	i.AllOtherCash = (*YesNoIndicator)(&value)
 }
 
+func (i *ISATransfer16) SetAllOtherCashFromBool(value bool) {
+	i.AllOtherCash = new(YesNoIndicator)
+	i.AllOtherCash.FromBool(value)
+}
+
 func (i *ISATransfer16) AddFinancialInstrumentAssetForTransfer() *FinancialInstrument34 {
 	newValue := new(FinancialInstrument34)
 	i.FinancialInstrumentAssetForTransfer = append(i.FinancialInstrumentAssetForTransfer, newValue)
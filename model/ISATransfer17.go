@@ -10,6 +10,10 @@ type ISATransfer17 struct {
 	ProductTransfer []*ISATransfer16 `xml:"PdctTrf"`
 }
 
+func (i *ISATransfer17) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer17) SetCancellationReference(value string) {
 	i.CancellationReference = (*Max35Text)(&value)
 }
@@ -28,6 +28,10 @@ type ISATransfer18 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument39 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer18) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer18) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -58,6 +62,11 @@ func (i *ISATransfer18) SetAllOtherCash(value string) {
 	i.AllOtherCash = (*YesNoIndicator)(&value)
 }
 
+func (i *ISATransfer18) SetAllOtherCashFromBool(value bool) {
+	i.AllOtherCash = new(YesNoIndicator)
+	i.AllOtherCash.FromBool(value)
+}
+
 func (i *ISATransfer18) AddFinancialInstrumentAssetForTransfer() *FinancialInstrument39 {
 	newValue := new(FinancialInstrument39)
 	i.FinancialInstrumentAssetForTransfer = append(i.FinancialInstrumentAssetForTransfer, newValue)
@@ -40,6 +40,10 @@ type ISATransfer19 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (i *ISATransfer19) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer19) AddPrimaryIndividualInvestor() *IndividualPerson8 {
 	i.PrimaryIndividualInvestor = new(IndividualPerson8)
 	return i.PrimaryIndividualInvestor
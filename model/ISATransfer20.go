@@ -10,6 +10,10 @@ type ISATransfer20 struct {
 	ProductTransfer []*ISATransfer18 `xml:"PdctTrf"`
 }
 
+func (i *ISATransfer20) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer20) SetCancellationReference(value string) {
 	i.CancellationReference = (*Max35Text)(&value)
 }
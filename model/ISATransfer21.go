@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Describes the type of product and the assets to be transferred.
 type ISATransfer21 struct {
 
@@ -28,6 +32,10 @@ type ISATransfer21 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument40 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer21) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer21) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -44,6 +52,11 @@ func (i *ISATransfer21) SetActualTransferDate(value string) {
 	i.ActualTransferDate = (*ISODate)(&value)
 }
 
+func (i *ISATransfer21) SetActualTransferDateFromTime(value time.Time) {
+	i.ActualTransferDate = new(ISODate)
+	i.ActualTransferDate.FromTime(value)
+}
+
 func (i *ISATransfer21) SetResidualCash(value string) {
 	i.ResidualCash = (*ResidualCash1Code)(&value)
 }
@@ -57,6 +70,11 @@ func (i *ISATransfer21) SetAllOtherCash(value string) {
 	i.AllOtherCash = (*YesNoIndicator)(&value)
 }
 
+func (i *ISATransfer21) SetAllOtherCashFromBool(value bool) {
+	i.AllOtherCash = new(YesNoIndicator)
+	i.AllOtherCash.FromBool(value)
+}
+
 func (i *ISATransfer21) AddFinancialInstrumentAssetForTransfer() *FinancialInstrument40 {
 	newValue := new(FinancialInstrument40)
 	i.FinancialInstrumentAssetForTransfer = append(i.FinancialInstrumentAssetForTransfer, newValue)
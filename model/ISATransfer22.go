@@ -37,6 +37,10 @@ type ISATransfer22 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument46 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer22) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer22) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
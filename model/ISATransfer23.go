@@ -25,6 +25,10 @@ type ISATransfer23 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument47 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer23) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer23) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
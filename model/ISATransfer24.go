@@ -43,6 +43,10 @@ type ISATransfer24 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (i *ISATransfer24) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer24) AddPrimaryIndividualInvestor() *IndividualPerson8 {
 	i.PrimaryIndividualInvestor = new(IndividualPerson8)
 	return i.PrimaryIndividualInvestor
@@ -10,6 +10,10 @@ type ISATransfer25 struct {
 	ProductTransfer []*ISATransfer22 `xml:"PdctTrf"`
 }
 
+func (i *ISATransfer25) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer25) SetCancellationReference(value string) {
 	i.CancellationReference = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Describes the type of product and the assets to be transferred.
 type ISATransfer26 struct {
 
@@ -37,6 +41,10 @@ type ISATransfer26 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument48 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer26) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer26) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -67,6 +75,11 @@ func (i *ISATransfer26) SetActualTransferDate(value string) {
 	i.ActualTransferDate = (*ISODate)(&value)
 }
 
+func (i *ISATransfer26) SetActualTransferDateFromTime(value time.Time) {
+	i.ActualTransferDate = new(ISODate)
+	i.ActualTransferDate.FromTime(value)
+}
+
 func (i *ISATransfer26) SetResidualCash(value string) {
 	i.ResidualCash = (*ResidualCash1Code)(&value)
 }
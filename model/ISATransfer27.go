@@ -19,6 +19,10 @@ type ISATransfer27 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument50 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer27) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer27) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
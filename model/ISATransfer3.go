@@ -25,6 +25,10 @@ type ISATransfer3 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument25 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer3) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -50,6 +54,11 @@ func (i *ISATransfer3) SetAllOtherCash(value string) {
 	i.AllOtherCash = (*YesNoIndicator)(&value)
 }
 
+func (i *ISATransfer3) SetAllOtherCashFromBool(value bool) {
+	i.AllOtherCash = new(YesNoIndicator)
+	i.AllOtherCash.FromBool(value)
+}
+
 func (i *ISATransfer3) AddFinancialInstrumentAssetForTransfer() *FinancialInstrument25 {
 	newValue := new(FinancialInstrument25)
 	i.FinancialInstrumentAssetForTransfer = append(i.FinancialInstrumentAssetForTransfer, newValue)
@@ -22,6 +22,10 @@ type ISATransfer4 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument26 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer4) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer4) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -43,6 +47,11 @@ func (i *ISATransfer4) SetAllOtherCash(value string) {
 	i.AllOtherCash = (*YesNoIndicator)(&value)
 }
 
+func (i *ISATransfer4) SetAllOtherCashFromBool(value bool) {
+	i.AllOtherCash = new(YesNoIndicator)
+	i.AllOtherCash.FromBool(value)
+}
+
 func (i *ISATransfer4) AddFinancialInstrumentAssetForTransfer() *FinancialInstrument26 {
 	newValue := new(FinancialInstrument26)
 	i.FinancialInstrumentAssetForTransfer = append(i.FinancialInstrumentAssetForTransfer, newValue)
@@ -19,6 +19,10 @@ type ISATransfer5 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument27 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer5) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer5) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -36,6 +40,11 @@ func (i *ISATransfer5) SetAllOtherCash(value string) {
 	i.AllOtherCash = (*YesNoIndicator)(&value)
 }
 
+func (i *ISATransfer5) SetAllOtherCashFromBool(value bool) {
+	i.AllOtherCash = new(YesNoIndicator)
+	i.AllOtherCash.FromBool(value)
+}
+
 func (i *ISATransfer5) AddFinancialInstrumentAssetForTransfer() *FinancialInstrument27 {
 	newValue := new(FinancialInstrument27)
 	i.FinancialInstrumentAssetForTransfer = append(i.FinancialInstrumentAssetForTransfer, newValue)
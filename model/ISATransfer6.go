@@ -22,6 +22,10 @@ type ISATransfer6 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument30 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer6) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer6) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -43,6 +47,11 @@ func (i *ISATransfer6) SetAllOtherCash(value string) {
 	i.AllOtherCash = (*YesNoIndicator)(&value)
 }
 
+func (i *ISATransfer6) SetAllOtherCashFromBool(value bool) {
+	i.AllOtherCash = new(YesNoIndicator)
+	i.AllOtherCash.FromBool(value)
+}
+
 func (i *ISATransfer6) AddFinancialInstrumentAssetForTransfer() *FinancialInstrument30 {
 	newValue := new(FinancialInstrument30)
 	i.FinancialInstrumentAssetForTransfer = append(i.FinancialInstrumentAssetForTransfer, newValue)
@@ -25,6 +25,10 @@ type ISATransfer7 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument31 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer7) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer7) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -51,6 +55,11 @@ func (i *ISATransfer7) SetAllOtherCash(value string) {
 	i.AllOtherCash = (*YesNoIndicator)(&value)
 }
 
+func (i *ISATransfer7) SetAllOtherCashFromBool(value bool) {
+	i.AllOtherCash = new(YesNoIndicator)
+	i.AllOtherCash.FromBool(value)
+}
+
 func (i *ISATransfer7) AddFinancialInstrumentAssetForTransfer() *FinancialInstrument31 {
 	newValue := new(FinancialInstrument31)
 	i.FinancialInstrumentAssetForTransfer = append(i.FinancialInstrumentAssetForTransfer, newValue)
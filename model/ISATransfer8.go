@@ -25,6 +25,10 @@ type ISATransfer8 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument32 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (i *ISATransfer8) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer8) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -50,6 +54,11 @@ func (i *ISATransfer8) SetAllOtherCash(value string) {
 	i.AllOtherCash = (*YesNoIndicator)(&value)
 }
 
+func (i *ISATransfer8) SetAllOtherCashFromBool(value bool) {
+	i.AllOtherCash = new(YesNoIndicator)
+	i.AllOtherCash.FromBool(value)
+}
+
 func (i *ISATransfer8) AddFinancialInstrumentAssetForTransfer() *FinancialInstrument32 {
 	newValue := new(FinancialInstrument32)
 	i.FinancialInstrumentAssetForTransfer = append(i.FinancialInstrumentAssetForTransfer, newValue)
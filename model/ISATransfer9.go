@@ -40,6 +40,10 @@ type ISATransfer9 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (i *ISATransfer9) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISATransfer9) AddPrimaryIndividualInvestor() *IndividualPerson8 {
 	i.PrimaryIndividualInvestor = new(IndividualPerson8)
 	return i.PrimaryIndividualInvestor
@@ -19,6 +19,10 @@ type ISAYearsOfIssue1 struct {
 	CurrentYearSubscriptionDetails *SubscriptionInformation1 `xml:"CurYrSbcptDtls,omitempty"`
 }
 
+func (i *ISAYearsOfIssue1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISAYearsOfIssue1) SetCurrentYearType(value string) {
 	i.CurrentYearType = (*ISAType1Code)(&value)
 }
@@ -31,6 +35,11 @@ func (i *ISAYearsOfIssue1) SetCashComponentIndicator(value string) {
 	i.CashComponentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *ISAYearsOfIssue1) SetCashComponentIndicatorFromBool(value bool) {
+	i.CashComponentIndicator = new(YesNoIndicator)
+	i.CashComponentIndicator.FromBool(value)
+}
+
 func (i *ISAYearsOfIssue1) AddPreviousYears() *PreviousYear1 {
 	i.PreviousYears = new(PreviousYear1)
 	return i.PreviousYears
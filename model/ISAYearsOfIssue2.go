@@ -13,6 +13,10 @@ type ISAYearsOfIssue2 struct {
 	PreviousYears *PreviousYearChoice `xml:"PrvsYrs,omitempty"`
 }
 
+func (i *ISAYearsOfIssue2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISAYearsOfIssue2) SetCurrentYearType(value string) {
 	i.CurrentYearType = (*ISAType2Code)(&value)
 }
@@ -19,6 +19,10 @@ type ISAYearsOfIssue3 struct {
 	PreviousYears *PreviousYear1 `xml:"PrvsYrs,omitempty"`
 }
 
+func (i *ISAYearsOfIssue3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISAYearsOfIssue3) SetCurrentYearType(value string) {
 	i.CurrentYearType = (*ISAType1Code)(&value)
 }
@@ -31,6 +35,11 @@ func (i *ISAYearsOfIssue3) SetCashComponentIndicator(value string) {
 	i.CashComponentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *ISAYearsOfIssue3) SetCashComponentIndicatorFromBool(value bool) {
+	i.CashComponentIndicator = new(YesNoIndicator)
+	i.CashComponentIndicator.FromBool(value)
+}
+
 func (i *ISAYearsOfIssue3) AddCurrentYearSubscriptionDetails() *SubscriptionInformation1 {
 	i.CurrentYearSubscriptionDetails = new(SubscriptionInformation1)
 	return i.CurrentYearSubscriptionDetails
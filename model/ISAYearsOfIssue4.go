@@ -16,6 +16,10 @@ type ISAYearsOfIssue4 struct {
 	CurrentYearSubscriptionDetails *SubscriptionInformation1 `xml:"CurYrSbcptDtls,omitempty"`
 }
 
+func (i *ISAYearsOfIssue4) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISAYearsOfIssue4) AddCurrentYear() *CurrentYearType1Choice {
 	i.CurrentYear = new(CurrentYearType1Choice)
 	return i.CurrentYear
@@ -25,6 +29,11 @@ func (i *ISAYearsOfIssue4) SetCashComponentIndicator(value string) {
 	i.CashComponentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *ISAYearsOfIssue4) SetCashComponentIndicatorFromBool(value bool) {
+	i.CashComponentIndicator = new(YesNoIndicator)
+	i.CashComponentIndicator.FromBool(value)
+}
+
 func (i *ISAYearsOfIssue4) AddPreviousYears() *PreviousYear2 {
 	i.PreviousYears = new(PreviousYear2)
 	return i.PreviousYears
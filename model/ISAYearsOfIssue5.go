@@ -16,6 +16,10 @@ type ISAYearsOfIssue5 struct {
 	CurrentYearSubscriptionDetails *SubscriptionInformation1 `xml:"CurYrSbcptDtls"`
 }
 
+func (i *ISAYearsOfIssue5) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISAYearsOfIssue5) AddCurrentYear() *CurrentYearType1Choice {
 	i.CurrentYear = new(CurrentYearType1Choice)
 	return i.CurrentYear
@@ -25,6 +29,11 @@ func (i *ISAYearsOfIssue5) SetCashComponentIndicator(value string) {
 	i.CashComponentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *ISAYearsOfIssue5) SetCashComponentIndicatorFromBool(value bool) {
+	i.CashComponentIndicator = new(YesNoIndicator)
+	i.CashComponentIndicator.FromBool(value)
+}
+
 func (i *ISAYearsOfIssue5) AddPreviousYears() *PreviousYear3 {
 	i.PreviousYears = new(PreviousYear3)
 	return i.PreviousYears
@@ -10,6 +10,10 @@ type ISAYearsOfIssue6 struct {
 	PreviousYears *PreviousYearChoice `xml:"PrvsYrs,omitempty"`
 }
 
+func (i *ISAYearsOfIssue6) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ISAYearsOfIssue6) AddCurrentYear() *CurrentYearType2Choice {
 	i.CurrentYear = new(CurrentYearType2Choice)
 	return i.CurrentYear
@@ -1,3 +1,9 @@
 package model
 
 type ISINIdentifier string
+
+var isinIdentifierPattern = newPattern(`[A-Z0-9]{12,12}`)
+
+func (i ISINIdentifier) Validate() error {
+	return validatePattern("ISINIdentifier", string(i), isinIdentifierPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type ISINOct2015Identifier string
+
+var isinOct2015IdentifierPattern = newPattern(`[A-Z]{2,2}[A-Z0-9]{9,9}[0-9]{1,1}`)
+
+func (i ISINOct2015Identifier) Validate() error {
+	return validatePattern("ISINOct2015Identifier", string(i), isinOct2015IdentifierPattern)
+}
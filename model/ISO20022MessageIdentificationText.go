@@ -1,3 +1,9 @@
 package model
 
 type ISO20022MessageIdentificationText string
+
+var iso20022MessageIdentificationTextPattern = newPattern(`[a-z]{4,4}[.]{1,1}[0-9]{3,3}[.]{1,1}001[.]{1,1}[0-9]{2,2}`)
+
+func (i ISO20022MessageIdentificationText) Validate() error {
+	return validatePattern("ISO20022MessageIdentificationText", string(i), iso20022MessageIdentificationTextPattern)
+}
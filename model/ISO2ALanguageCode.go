@@ -1,3 +1,9 @@
 package model
 
 type ISO2ALanguageCode string
+
+var iso2ALanguageCodePattern = newPattern(`[a-z]{2,2}`)
+
+func (i ISO2ALanguageCode) Validate() error {
+	return validatePattern("ISO2ALanguageCode", string(i), iso2ALanguageCodePattern)
+}
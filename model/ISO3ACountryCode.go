@@ -1,3 +1,9 @@
 package model
 
 type ISO3ACountryCode string
+
+var iso3ACountryCodePattern = newPattern(`[A-Z]{3,3}`)
+
+func (i ISO3ACountryCode) Validate() error {
+	return validatePattern("ISO3ACountryCode", string(i), iso3ACountryCodePattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type ISO3NumericCountryCode string
+
+var iso3NumericCountryCodePattern = newPattern(`[0-9]{3,3}`)
+
+func (i ISO3NumericCountryCode) Validate() error {
+	return validatePattern("ISO3NumericCountryCode", string(i), iso3NumericCountryCodePattern)
+}
@@ -1,3 +1,21 @@
 package model
 
+import (
+	"time"
+)
+
 type ISODate string
+
+func (i ISODate) Validate() error {
+	return validateBuiltin("ISODate", string(i), "date")
+}
+
+// Time returns the value as a time.Time. A value without a time zone is returned in UTC.
+func (i ISODate) Time() (time.Time, error) {
+	return parseTime("ISODate", string(i), "date", "2006-01-02")
+}
+
+// FromTime sets the value to the date of t in the location of t.
+func (i *ISODate) FromTime(value time.Time) {
+	*i = ISODate(value.Format("2006-01-02"))
+}
@@ -1,3 +1,21 @@
 package model
 
+import (
+	"time"
+)
+
 type ISODateTime string
+
+func (i ISODateTime) Validate() error {
+	return validateBuiltin("ISODateTime", string(i), "dateTime")
+}
+
+// Time returns the value as a time.Time. A value without a time zone is returned in UTC.
+func (i ISODateTime) Time() (time.Time, error) {
+	return parseTime("ISODateTime", string(i), "dateTime", "2006-01-02T15:04:05")
+}
+
+// FromTime sets the value to t, keeping the time zone offset and fractional seconds of t.
+func (i *ISODateTime) FromTime(value time.Time) {
+	*i = ISODateTime(value.Format(time.RFC3339Nano))
+}
@@ -1,3 +1,26 @@
 package model
 
+import (
+	"time"
+)
+
 type ISONormalisedDateTime string
+
+var isoNormalisedDateTimePattern = newPattern(`.*Z`)
+
+func (i ISONormalisedDateTime) Validate() error {
+	if err := validateBuiltin("ISONormalisedDateTime", string(i), "dateTime"); err != nil {
+		return err
+	}
+	return validatePattern("ISONormalisedDateTime", string(i), isoNormalisedDateTimePattern)
+}
+
+// Time returns the value as a time.Time. A value without a time zone is returned in UTC.
+func (i ISONormalisedDateTime) Time() (time.Time, error) {
+	return parseTime("ISONormalisedDateTime", string(i), "dateTime", "2006-01-02T15:04:05")
+}
+
+// FromTime sets the value to t converted to UTC.
+func (i *ISONormalisedDateTime) FromTime(value time.Time) {
+	*i = ISONormalisedDateTime(value.UTC().Format(time.RFC3339Nano))
+}
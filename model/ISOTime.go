@@ -1,3 +1,21 @@
 package model
 
+import (
+	"time"
+)
+
 type ISOTime string
+
+func (i ISOTime) Validate() error {
+	return validateBuiltin("ISOTime", string(i), "time")
+}
+
+// Time returns the value as a time.Time. A value without a time zone is returned in UTC.
+func (i ISOTime) Time() (time.Time, error) {
+	return parseTime("ISOTime", string(i), "time", "15:04:05")
+}
+
+// FromTime sets the value to the time of day of t, keeping its time zone offset.
+func (i *ISOTime) FromTime(value time.Time) {
+	*i = ISOTime(value.Format("15:04:05.999999999Z07:00"))
+}
@@ -1,3 +1,7 @@
 package model
 
 type ISOYear string
+
+func (i ISOYear) Validate() error {
+	return validateBuiltin("ISOYear", string(i), "gYear")
+}
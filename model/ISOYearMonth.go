@@ -1,3 +1,7 @@
 package model
 
 type ISOYearMonth string
+
+func (i ISOYearMonth) Validate() error {
+	return validateBuiltin("ISOYearMonth", string(i), "gYearMonth")
+}
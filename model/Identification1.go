@@ -7,6 +7,10 @@ type Identification1 struct {
 	Identification *Max35Text `xml:"Id"`
 }
 
+func (i *Identification1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Identification1) SetIdentification(value string) {
 	i.Identification = (*Max35Text)(&value)
 }
@@ -43,6 +43,10 @@ type Identification11 struct {
 	CorporateActionEventIdentification *Max35Text `xml:"CorpActnEvtId,omitempty"`
 }
 
+func (i *Identification11) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Identification11) SetAccountOwnerTransactionIdentification(value string) {
 	i.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -7,6 +7,10 @@ type Identification14 struct {
 	Identification *Max35Text `xml:"Id"`
 }
 
+func (i *Identification14) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Identification14) SetIdentification(value string) {
 	i.Identification = (*Max35Text)(&value)
 }
@@ -43,6 +43,10 @@ type Identification15 struct {
 	CorporateActionEventIdentification *Max35Text `xml:"CorpActnEvtId,omitempty"`
 }
 
+func (i *Identification15) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Identification15) SetAccountOwnerTransactionIdentification(value string) {
 	i.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
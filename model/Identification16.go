@@ -7,6 +7,10 @@ type Identification16 struct {
 	Identification *RestrictedFINXMax16Text `xml:"Id"`
 }
 
+func (i *Identification16) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Identification16) SetIdentification(value string) {
 	i.Identification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -40,6 +40,10 @@ type Identification2 struct {
 	CorporateActionEventIdentification *Max35Text `xml:"CorpActnEvtId,omitempty"`
 }
 
+func (i *Identification2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Identification2) SetAccountOwnerTransactionIdentification(value string) {
 	i.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
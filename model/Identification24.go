@@ -43,6 +43,10 @@ type Identification24 struct {
 	CorporateActionEventIdentification *RestrictedFINXMax16Text `xml:"CorpActnEvtId,omitempty"`
 }
 
+func (i *Identification24) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Identification24) SetAccountOwnerTransactionIdentification(value string) {
 	i.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -43,6 +43,10 @@ type Identification6 struct {
 	CorporateActionEventIdentification *Max35Text `xml:"CorpActnEvtId,omitempty"`
 }
 
+func (i *Identification6) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Identification6) SetAccountOwnerTransactionIdentification(value string) {
 	i.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -43,6 +43,10 @@ type Identification7 struct {
 	CorporateActionEventIdentification *Max35Text `xml:"CorpActnEvtId,omitempty"`
 }
 
+func (i *Identification7) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Identification7) SetAccountOwnerTransactionIdentification(value string) {
 	i.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements that identify the identification assignment.
 type IdentificationAssignment1 struct {
 
@@ -21,6 +25,10 @@ type IdentificationAssignment1 struct {
 	Assignee *Party7Choice `xml:"Assgne"`
 }
 
+func (i *IdentificationAssignment1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationAssignment1) SetMessageIdentification(value string) {
 	i.MessageIdentification = (*Max35Text)(&value)
 }
@@ -29,6 +37,11 @@ func (i *IdentificationAssignment1) SetCreationDateTime(value string) {
 	i.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (i *IdentificationAssignment1) SetCreationDateTimeFromTime(value time.Time) {
+	i.CreationDateTime = new(ISODateTime)
+	i.CreationDateTime.FromTime(value)
+}
+
 func (i *IdentificationAssignment1) AddCreator() *Party7Choice {
 	i.Creator = new(Party7Choice)
 	return i.Creator
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides the details of the identification assignment.
 type IdentificationAssignment2 struct {
 
@@ -24,6 +28,10 @@ type IdentificationAssignment2 struct {
 	Assignee *Party12Choice `xml:"Assgne"`
 }
 
+func (i *IdentificationAssignment2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationAssignment2) SetMessageIdentification(value string) {
 	i.MessageIdentification = (*Max35Text)(&value)
 }
@@ -32,6 +40,11 @@ func (i *IdentificationAssignment2) SetCreationDateTime(value string) {
 	i.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (i *IdentificationAssignment2) SetCreationDateTimeFromTime(value time.Time) {
+	i.CreationDateTime = new(ISODateTime)
+	i.CreationDateTime.FromTime(value)
+}
+
 func (i *IdentificationAssignment2) AddCreator() *Party12Choice {
 	i.Creator = new(Party12Choice)
 	return i.Creator
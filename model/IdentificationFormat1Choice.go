@@ -13,6 +13,10 @@ type IdentificationFormat1Choice struct {
 	ProprietaryIdentification *GenericIdentification19 `xml:"PrtryId"`
 }
 
+func (i *IdentificationFormat1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationFormat1Choice) SetShortIdentification(value string) {
 	i.ShortIdentification = (*Exact3UpperCaseAlphaNumericText)(&value)
 }
@@ -13,6 +13,10 @@ type IdentificationFormat3Choice struct {
 	ProprietaryIdentification *GenericIdentification36 `xml:"PrtryId"`
 }
 
+func (i *IdentificationFormat3Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationFormat3Choice) SetShortIdentification(value string) {
 	i.ShortIdentification = (*Exact3UpperCaseAlphaNumericText)(&value)
 }
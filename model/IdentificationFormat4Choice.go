@@ -13,6 +13,10 @@ type IdentificationFormat4Choice struct {
 	ProprietaryIdentification *GenericIdentification86 `xml:"PrtryId"`
 }
 
+func (i *IdentificationFormat4Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationFormat4Choice) SetShortIdentification(value string) {
 	i.ShortIdentification = (*Exact3UpperCaseAlphaNumericText)(&value)
 }
@@ -13,6 +13,10 @@ type IdentificationInformation1 struct {
 	Agent *BranchAndFinancialInstitutionIdentification4 `xml:"Agt,omitempty"`
 }
 
+func (i *IdentificationInformation1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationInformation1) AddParty() *PartyIdentification32 {
 	i.Party = new(PartyIdentification32)
 	return i.Party
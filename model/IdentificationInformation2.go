@@ -13,6 +13,10 @@ type IdentificationInformation2 struct {
 	Agent *BranchAndFinancialInstitutionIdentification5 `xml:"Agt,omitempty"`
 }
 
+func (i *IdentificationInformation2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationInformation2) AddParty() *PartyIdentification43 {
 	i.Party = new(PartyIdentification43)
 	return i.Party
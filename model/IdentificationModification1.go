@@ -16,6 +16,10 @@ type IdentificationModification1 struct {
 	AdditionalInformation *Max140Text `xml:"AddtlInf,omitempty"`
 }
 
+func (i *IdentificationModification1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationModification1) SetIdentification(value string) {
 	i.Identification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type IdentificationModification2 struct {
 	AdditionalInformation *Max140Text `xml:"AddtlInf,omitempty"`
 }
 
+func (i *IdentificationModification2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationModification2) SetIdentification(value string) {
 	i.Identification = (*Max35Text)(&value)
 }
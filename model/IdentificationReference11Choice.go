@@ -46,6 +46,10 @@ type IdentificationReference11Choice struct {
 	CollateralTransactionIdentification *Max35Text `xml:"CollTxId"`
 }
 
+func (i *IdentificationReference11Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationReference11Choice) SetInstructingPartyTransactionIdentification(value string) {
 	i.InstructingPartyTransactionIdentification = (*Max35Text)(&value)
 }
@@ -43,6 +43,10 @@ type IdentificationReference8Choice struct {
 	CollateralTransactionIdentification *Max35Text `xml:"CollTxId"`
 }
 
+func (i *IdentificationReference8Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationReference8Choice) SetInstructingPartyTransactionIdentification(value string) {
 	i.InstructingPartyTransactionIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type IdentificationSource1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (i *IdentificationSource1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationSource1Choice) SetDomestic(value string) {
 	i.Domestic = (*CountryCode)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Choice between source of identification of a financial instrument.
 type IdentificationSource3Choice struct {
 
@@ -10,6 +12,10 @@ type IdentificationSource3Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (i *IdentificationSource3Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationSource3Choice) SetCode(value string) {
 	i.Code = (*ExternalFinancialInstrumentIdentificationType1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (i *IdentificationSource3Choice) SetCode(value string) {
 func (i *IdentificationSource3Choice) SetProprietary(value string) {
 	i.Proprietary = (*Max35Text)(&value)
 }
+
+func (i *IdentificationSource3Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if i.Code != nil {
+		enc.Element("Cd", string(*i.Code))
+	}
+	if i.Proprietary != nil {
+		enc.Element("Prtry", string(*i.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (i *IdentificationSource3Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			i.Code = (*ExternalFinancialInstrumentIdentificationType1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			i.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
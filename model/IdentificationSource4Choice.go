@@ -10,6 +10,10 @@ type IdentificationSource4Choice struct {
 	Proprietary *RestrictedFINExact2Text `xml:"Prtry"`
 }
 
+func (i *IdentificationSource4Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationSource4Choice) SetCode(value string) {
 	i.Code = (*ExternalFinancialInstrumentIdentificationType1Code)(&value)
 }
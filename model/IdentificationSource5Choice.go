@@ -10,6 +10,10 @@ type IdentificationSource5Choice struct {
 	ProprietaryIdentificationSource *Max35Text `xml:"PrtryIdSrc"`
 }
 
+func (i *IdentificationSource5Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationSource5Choice) SetDomesticIdentificationSource(value string) {
 	i.DomesticIdentificationSource = (*CountryCode)(&value)
 }
@@ -10,6 +10,10 @@ type IdentificationType1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (i *IdentificationType1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationType1) SetStructured(value string) {
 	i.Structured = (*PersonIdentificationType1Code)(&value)
 }
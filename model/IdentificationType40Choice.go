@@ -10,6 +10,10 @@ type IdentificationType40Choice struct {
 	Proprietary *GenericIdentification29 `xml:"Prtry"`
 }
 
+func (i *IdentificationType40Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationType40Choice) SetCode(value string) {
 	i.Code = (*TypeOfIdentification2Code)(&value)
 }
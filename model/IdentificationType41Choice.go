@@ -10,6 +10,10 @@ type IdentificationType41Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (i *IdentificationType41Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationType41Choice) SetCode(value string) {
 	i.Code = (*TypeOfIdentification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type IdentificationType42Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (i *IdentificationType42Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationType42Choice) SetCode(value string) {
 	i.Code = (*TypeOfIdentification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type IdentificationType43Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (i *IdentificationType43Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationType43Choice) SetCode(value string) {
 	i.Code = (*TypeOfIdentification2Code)(&value)
 }
@@ -10,6 +10,10 @@ type IdentificationType44Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *IdentificationType44Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationType44Choice) SetCode(value string) {
 	i.Code = (*TypeOfIdentification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type IdentificationType4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (i *IdentificationType4Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationType4Choice) SetCode(value string) {
 	i.Code = (*TypeOfIdentification1Code)(&value)
 }
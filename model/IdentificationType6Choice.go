@@ -10,6 +10,10 @@ type IdentificationType6Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (i *IdentificationType6Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationType6Choice) SetCode(value string) {
 	i.Code = (*TypeOfIdentification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type IdentificationVerification1 struct {
 	PartyAndAccountIdentification *IdentificationInformation1 `xml:"PtyAndAcctId"`
 }
 
+func (i *IdentificationVerification1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationVerification1) SetIdentification(value string) {
 	i.Identification = (*Max35Text)(&value)
 }
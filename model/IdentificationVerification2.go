@@ -10,6 +10,10 @@ type IdentificationVerification2 struct {
 	PartyAndAccountIdentification *IdentificationInformation2 `xml:"PtyAndAcctId"`
 }
 
+func (i *IdentificationVerification2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IdentificationVerification2) SetIdentification(value string) {
 	i.Identification = (*Max35Text)(&value)
 }
@@ -1,3 +1,15 @@
 package model
 
 type IdentificationVerificationIndicator string
+
+func (i IdentificationVerificationIndicator) Validate() error {
+	return validateBoolean("IdentificationVerificationIndicator", string(i))
+}
+
+func (i IdentificationVerificationIndicator) Bool() bool {
+	return parseBoolean(string(i))
+}
+
+func (i *IdentificationVerificationIndicator) FromBool(value bool) {
+	*i = IdentificationVerificationIndicator(formatBoolean(value))
+}
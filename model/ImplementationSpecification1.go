@@ -0,0 +1,25 @@
+package model
+
+// Identifies the implementation specification to which the ISO 20022 message conforms.
+type ImplementationSpecification1 struct {
+
+	// Name of the implementation specification registry in which the implementation specification of the ISO 20022 message is maintained.
+	// For example, "MyStandards".
+	Registry *Max350Text `xml:"Regy"`
+
+	// Identifier which unambiguously identifies, within the implementation specification registry, the implementation specification to which the ISO 20022 message is compliant. This can be done via a URN. It can also contain a version number or date.
+	// For instance, "2018-01-01 – Version 2" or "urn:uuid:6e8bc430-9c3a-11d9-9669-0800200c9a66".
+	Identification *Max2048Text `xml:"Id"`
+}
+
+func (i *ImplementationSpecification1) Validate() error {
+	return ValidateElement(i)
+}
+
+func (i *ImplementationSpecification1) SetRegistry(value string) {
+	i.Registry = (*Max350Text)(&value)
+}
+
+func (i *ImplementationSpecification1) SetIdentification(value string) {
+	i.Identification = (*Max2048Text)(&value)
+}
@@ -0,0 +1,128 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Choice between ranges of values in which an amount is considered valid or a specified amount value which has to be matched or unmatched to be valid.
+type ImpliedCurrencyAmountRange1Choice struct {
+
+	// Lower boundary of a range of amount values.
+	FromAmount *AmountRangeBoundary1 `xml:"FrAmt"`
+
+	// Upper boundary of a range of amount values.
+	ToAmount *AmountRangeBoundary1 `xml:"ToAmt"`
+
+	// Range of valid amount values.
+	FromToAmount *FromToAmountRange1 `xml:"FrToAmt"`
+
+	// Exact value an amount must match to be considered valid.
+	EqualAmount *ImpliedCurrencyAndAmount `xml:"EQAmt"`
+
+	// Value that an amount must not match to be considered valid.
+	NotEqualAmount *ImpliedCurrencyAndAmount `xml:"NEQAmt"`
+}
+
+func (i *ImpliedCurrencyAmountRange1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
+func (i *ImpliedCurrencyAmountRange1Choice) AddFromAmount() *AmountRangeBoundary1 {
+	i.FromAmount = new(AmountRangeBoundary1)
+	return i.FromAmount
+}
+
+func (i *ImpliedCurrencyAmountRange1Choice) AddToAmount() *AmountRangeBoundary1 {
+	i.ToAmount = new(AmountRangeBoundary1)
+	return i.ToAmount
+}
+
+func (i *ImpliedCurrencyAmountRange1Choice) AddFromToAmount() *FromToAmountRange1 {
+	i.FromToAmount = new(FromToAmountRange1)
+	return i.FromToAmount
+}
+
+func (i *ImpliedCurrencyAmountRange1Choice) SetEqualAmount(value, currency string) {
+	i.EqualAmount = NewImpliedCurrencyAndAmount(value, currency)
+}
+
+func (i *ImpliedCurrencyAmountRange1Choice) SetEqualAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.EqualAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
+func (i *ImpliedCurrencyAmountRange1Choice) SetNotEqualAmount(value, currency string) {
+	i.NotEqualAmount = NewImpliedCurrencyAndAmount(value, currency)
+}
+
+func (i *ImpliedCurrencyAmountRange1Choice) SetNotEqualAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.NotEqualAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
+func (i *ImpliedCurrencyAmountRange1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if i.FromAmount != nil {
+		i.FromAmount.EncodeXMLFast(enc, "FrAmt")
+	}
+	if i.ToAmount != nil {
+		i.ToAmount.EncodeXMLFast(enc, "ToAmt")
+	}
+	if i.FromToAmount != nil {
+		i.FromToAmount.EncodeXMLFast(enc, "FrToAmt")
+	}
+	if i.EqualAmount != nil {
+		i.EqualAmount.EncodeXMLFast(enc, "EQAmt")
+	}
+	if i.NotEqualAmount != nil {
+		i.NotEqualAmount.EncodeXMLFast(enc, "NEQAmt")
+	}
+	enc.End(tag)
+}
+
+func (i *ImpliedCurrencyAmountRange1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FrAmt":
+			elem := new(AmountRangeBoundary1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.FromAmount = elem
+		case "ToAmt":
+			elem := new(AmountRangeBoundary1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.ToAmount = elem
+		case "FrToAmt":
+			elem := new(FromToAmountRange1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.FromToAmount = elem
+		case "EQAmt":
+			elem := new(ImpliedCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.EqualAmount = elem
+		case "NEQAmt":
+			elem := new(ImpliedCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.NotEqualAmount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Choice between ranges of values in which an amount is considered valid or a specified amount value which has to be matched or unmatched to be valid.
 type ImpliedCurrencyAmountRangeChoice struct {
 
@@ -19,6 +24,10 @@ type ImpliedCurrencyAmountRangeChoice struct {
 	NotEqualAmount *ImpliedCurrencyAndAmount `xml:"NEQAmt"`
 }
 
+func (i *ImpliedCurrencyAmountRangeChoice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *ImpliedCurrencyAmountRangeChoice) AddFromAmount() *AmountRangeBoundary1 {
 	i.FromAmount = new(AmountRangeBoundary1)
 	return i.FromAmount
@@ -38,6 +47,82 @@ func (i *ImpliedCurrencyAmountRangeChoice) SetEqualAmount(value, currency string
 	i.EqualAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (i *ImpliedCurrencyAmountRangeChoice) SetEqualAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.EqualAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *ImpliedCurrencyAmountRangeChoice) SetNotEqualAmount(value, currency string) {
 	i.NotEqualAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (i *ImpliedCurrencyAmountRangeChoice) SetNotEqualAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.NotEqualAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
+func (i *ImpliedCurrencyAmountRangeChoice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if i.FromAmount != nil {
+		i.FromAmount.EncodeXMLFast(enc, "FrAmt")
+	}
+	if i.ToAmount != nil {
+		i.ToAmount.EncodeXMLFast(enc, "ToAmt")
+	}
+	if i.FromToAmount != nil {
+		i.FromToAmount.EncodeXMLFast(enc, "FrToAmt")
+	}
+	if i.EqualAmount != nil {
+		i.EqualAmount.EncodeXMLFast(enc, "EQAmt")
+	}
+	if i.NotEqualAmount != nil {
+		i.NotEqualAmount.EncodeXMLFast(enc, "NEQAmt")
+	}
+	enc.End(tag)
+}
+
+func (i *ImpliedCurrencyAmountRangeChoice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FrAmt":
+			elem := new(AmountRangeBoundary1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.FromAmount = elem
+		case "ToAmt":
+			elem := new(AmountRangeBoundary1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.ToAmount = elem
+		case "FrToAmt":
+			elem := new(FromToAmountRange)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.FromToAmount = elem
+		case "EQAmt":
+			elem := new(ImpliedCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.EqualAmount = elem
+		case "NEQAmt":
+			elem := new(ImpliedCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.NotEqualAmount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
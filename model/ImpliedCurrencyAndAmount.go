@@ -1,12 +1,69 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/amount"
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Number of monetary units specified in a currency where the unit of currency is implied by the context and compliant with ISO 4217. The decimal separator is a dot.
 // Note: a zero amount is considered a positive amount.
 type ImpliedCurrencyAndAmount struct {
 	Value    string `xml:",chardata"`
-	Currency string `xml:"Ccy,attr"`
+	Currency string `xml:"Ccy,attr,omitempty"`
+}
+
+func (i *ImpliedCurrencyAndAmount) Validate() error {
+	if err := ValidateElement(i); err != nil {
+		return err
+	}
+	return validateAmount("ImpliedCurrencyAndAmount", i.Value, i.Currency, 18, 5, false, false)
 }
 
 func NewImpliedCurrencyAndAmount(value, currency string) *ImpliedCurrencyAndAmount {
 	return &ImpliedCurrencyAndAmount{Value: value, Currency: currency}
 }
+
+func (i *ImpliedCurrencyAndAmount) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(i.Value)
+}
+
+func NewImpliedCurrencyAndAmountFromDecimal(value decimal.Decimal, currency string) *ImpliedCurrencyAndAmount {
+	return NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
+func (i *ImpliedCurrencyAndAmount) Amount() (amount.Amount, error) {
+	value, err := i.Decimal()
+	if err != nil {
+		return amount.Amount{}, err
+	}
+	return amount.New(value, i.Currency), nil
+}
+
+func NewImpliedCurrencyAndAmountFromAmount(value amount.Amount) *ImpliedCurrencyAndAmount {
+	return NewImpliedCurrencyAndAmount(value.Text(), value.Currency)
+}
+
+func (i *ImpliedCurrencyAndAmount) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Open(tag)
+	if i.Currency != "" {
+		enc.Attr("Ccy", i.Currency)
+	}
+	enc.Close()
+	enc.Text(i.Value)
+	enc.End(tag)
+}
+
+func (i *ImpliedCurrencyAndAmount) DecodeXMLFast(dec *fastxml.Decoder) error {
+	value, err := dec.Attr("Ccy")
+	if err != nil {
+		return err
+	}
+	i.Currency = value
+	value, err = dec.Text()
+	if err != nil {
+		return err
+	}
+	i.Value = value
+	return nil
+}
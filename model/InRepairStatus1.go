@@ -13,8 +13,16 @@ type InRepairStatus1 struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
-func (i *InRepairStatus1) SetNoReason(value string) {
+func (i *InRepairStatus1) Validate() error {
+	return ValidateElement(i)
+}
+
+func (i *InRepairStatus1) SetNoReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	i.NoReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (i *InRepairStatus1) AddReason() *InRepairStatusReason1 {
@@ -10,11 +10,19 @@ type InRepairStatus2 struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (i *InRepairStatus2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InRepairStatus2) AddReasonDetails() *InRepairStatusReason3 {
 	i.ReasonDetails = new(InRepairStatusReason3)
 	return i.ReasonDetails
 }
 
-func (i *InRepairStatus2) SetNoSpecifiedReason(value string) {
+func (i *InRepairStatus2) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	i.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
@@ -13,6 +13,10 @@ type InRepairStatus2Choice struct {
 	NoReason *NoReasonCode `xml:"NoRsn"`
 }
 
+func (i *InRepairStatus2Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InRepairStatus2Choice) AddReason() *InRepairStatusReason2 {
 	i.Reason = new(InRepairStatusReason2)
 	return i.Reason
@@ -23,6 +27,10 @@ func (i *InRepairStatus2Choice) AddDataSourceScheme() *GenericIdentification1 {
 	return i.DataSourceScheme
 }
 
-func (i *InRepairStatus2Choice) SetNoReason(value string) {
+func (i *InRepairStatus2Choice) SetNoReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	i.NoReason = (*NoReasonCode)(&value)
+	return nil
 }
@@ -13,6 +13,10 @@ type InRepairStatus3 struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (i *InRepairStatus3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InRepairStatus3) SetReason(value string) {
 	i.Reason = (*Max350Text)(&value)
 }
@@ -22,6 +26,10 @@ func (i *InRepairStatus3) AddDataSourceScheme() *GenericIdentification1 {
 	return i.DataSourceScheme
 }
 
-func (i *InRepairStatus3) SetNoSpecifiedReason(value string) {
+func (i *InRepairStatus3) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	i.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
@@ -13,6 +13,10 @@ type InRepairStatus4Choice struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (i *InRepairStatus4Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InRepairStatus4Choice) SetReason(value string) {
 	i.Reason = (*Max350Text)(&value)
 }
@@ -22,6 +26,10 @@ func (i *InRepairStatus4Choice) AddDataSourceScheme() *GenericIdentification1 {
 	return i.DataSourceScheme
 }
 
-func (i *InRepairStatus4Choice) SetNoSpecifiedReason(value string) {
+func (i *InRepairStatus4Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	i.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
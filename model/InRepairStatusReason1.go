@@ -7,6 +7,10 @@ type InRepairStatusReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf"`
 }
 
+func (i *InRepairStatusReason1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InRepairStatusReason1) SetAdditionalInformation(value string) {
 	i.AdditionalInformation = (*Max350Text)(&value)
 }
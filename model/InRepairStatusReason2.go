@@ -7,6 +7,10 @@ type InRepairStatusReason2 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (i *InRepairStatusReason2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InRepairStatusReason2) SetAdditionalInformation(value string) {
 	i.AdditionalInformation = (*Max350Text)(&value)
 }
@@ -16,6 +16,10 @@ type InRepairStatusReason3 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (i *InRepairStatusReason3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InRepairStatusReason3) SetReason(value string) {
 	i.Reason = (*InRepairStatusReason1Code)(&value)
 }
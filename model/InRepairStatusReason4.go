@@ -10,6 +10,10 @@ type InRepairStatusReason4 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (i *InRepairStatusReason4) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InRepairStatusReason4) AddReason() *InRepairStatusReason5Choice {
 	i.Reason = new(InRepairStatusReason5Choice)
 	return i.Reason
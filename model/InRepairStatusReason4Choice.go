@@ -10,8 +10,16 @@ type InRepairStatusReason4Choice struct {
 	ReasonDetails []*InRepairStatusReason4 `xml:"RsnDtls"`
 }
 
-func (i *InRepairStatusReason4Choice) SetNoSpecifiedReason(value string) {
+func (i *InRepairStatusReason4Choice) Validate() error {
+	return ValidateElement(i)
+}
+
+func (i *InRepairStatusReason4Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	i.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (i *InRepairStatusReason4Choice) AddReasonDetails() *InRepairStatusReason4 {
@@ -10,6 +10,10 @@ type InRepairStatusReason5Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (i *InRepairStatusReason5Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InRepairStatusReason5Choice) SetCode(value string) {
 	i.Code = (*InRepairStatusReason1Code)(&value)
 }
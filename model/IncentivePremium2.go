@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash premium made available if the securities holder consents or participates to an event.
 type IncentivePremium2 struct {
 
@@ -22,6 +26,10 @@ type IncentivePremium2 struct {
 	PaymentDate *DateFormat3Choice `xml:"PmtDt,omitempty"`
 }
 
+func (i *IncentivePremium2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IncentivePremium2) SetDescription(value string) {
 	i.Description = (*Max350Text)(&value)
 }
@@ -35,14 +43,29 @@ func (i *IncentivePremium2) SetPerSecurity(value string) {
 	i.PerSecurity = (*Number)(&value)
 }
 
+func (i *IncentivePremium2) SetPerSecurityFromDecimal(value decimal.Decimal) {
+	i.PerSecurity = new(Number)
+	i.PerSecurity.FromDecimal(value)
+}
+
 func (i *IncentivePremium2) SetPerVote(value string) {
 	i.PerVote = (*Number)(&value)
 }
 
+func (i *IncentivePremium2) SetPerVoteFromDecimal(value decimal.Decimal) {
+	i.PerVote = new(Number)
+	i.PerVote.FromDecimal(value)
+}
+
 func (i *IncentivePremium2) SetPerAttendee(value string) {
 	i.PerAttendee = (*YesNoIndicator)(&value)
 }
 
+func (i *IncentivePremium2) SetPerAttendeeFromBool(value bool) {
+	i.PerAttendee = new(YesNoIndicator)
+	i.PerAttendee.FromBool(value)
+}
+
 func (i *IncentivePremium2) AddPaymentDate() *DateFormat3Choice {
 	i.PaymentDate = new(DateFormat3Choice)
 	return i.PaymentDate
@@ -16,6 +16,10 @@ type IncentivePremium3 struct {
 	PaymentDate *DateFormat3Choice `xml:"PmtDt,omitempty"`
 }
 
+func (i *IncentivePremium3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IncentivePremium3) SetDescription(value string) {
 	i.Description = (*Max350Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of cash premium paid to the security holder when voting.
 type IncentivePremiumType1Choice struct {
 
@@ -13,14 +17,33 @@ type IncentivePremiumType1Choice struct {
 	PerAttendee *YesNoIndicator `xml:"PerAttndee"`
 }
 
+func (i *IncentivePremiumType1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IncentivePremiumType1Choice) SetPerSecurity(value string) {
 	i.PerSecurity = (*Number)(&value)
 }
 
+func (i *IncentivePremiumType1Choice) SetPerSecurityFromDecimal(value decimal.Decimal) {
+	i.PerSecurity = new(Number)
+	i.PerSecurity.FromDecimal(value)
+}
+
 func (i *IncentivePremiumType1Choice) SetPerVote(value string) {
 	i.PerVote = (*Number)(&value)
 }
 
+func (i *IncentivePremiumType1Choice) SetPerVoteFromDecimal(value decimal.Decimal) {
+	i.PerVote = new(Number)
+	i.PerVote.FromDecimal(value)
+}
+
 func (i *IncentivePremiumType1Choice) SetPerAttendee(value string) {
 	i.PerAttendee = (*YesNoIndicator)(&value)
 }
+
+func (i *IncentivePremiumType1Choice) SetPerAttendeeFromBool(value bool) {
+	i.PerAttendee = new(YesNoIndicator)
+	i.PerAttendee.FromBool(value)
+}
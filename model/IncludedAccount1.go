@@ -13,6 +13,10 @@ type IncludedAccount1 struct {
 	IncludedIndicator *YesNoIndicator `xml:"InclInd"`
 }
 
+func (i *IncludedAccount1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IncludedAccount1) SetSecuritiesAccountIdentification(value string) {
 	i.SecuritiesAccountIdentification = (*Max35Text)(&value)
 }
@@ -20,3 +24,8 @@ func (i *IncludedAccount1) SetSecuritiesAccountIdentification(value string) {
 func (i *IncludedAccount1) SetIncludedIndicator(value string) {
 	i.IncludedIndicator = (*YesNoIndicator)(&value)
 }
+
+func (i *IncludedAccount1) SetIncludedIndicatorFromBool(value bool) {
+	i.IncludedIndicator = new(YesNoIndicator)
+	i.IncludedIndicator.FromBool(value)
+}
@@ -13,6 +13,10 @@ type Incoterms1 struct {
 	Location *Max35Text `xml:"Lctn,omitempty"`
 }
 
+func (i *Incoterms1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Incoterms1) SetCode(value string) {
 	i.Code = (*Incoterms1Code)(&value)
 }
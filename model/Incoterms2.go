@@ -13,6 +13,10 @@ type Incoterms2 struct {
 	Location *Max35Text `xml:"Lctn"`
 }
 
+func (i *Incoterms2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Incoterms2) SetCode(value string) {
 	i.Code = (*Incoterms1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Incoterms3 struct {
 	Location *Max35Text `xml:"Lctn,omitempty"`
 }
 
+func (i *Incoterms3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Incoterms3) AddIncotermsCode() *Incoterms4Choice {
 	i.IncotermsCode = new(Incoterms4Choice)
 	return i.IncotermsCode
@@ -10,6 +10,10 @@ type Incoterms4 struct {
 	Location *Max70Text `xml:"Lctn,omitempty"`
 }
 
+func (i *Incoterms4) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Incoterms4) AddIncotermsCode() *Incoterms4Choice {
 	i.IncotermsCode = new(Incoterms4Choice)
 	return i.IncotermsCode
@@ -10,6 +10,10 @@ type Incoterms4Choice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (i *Incoterms4Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Incoterms4Choice) SetCode(value string) {
 	i.Code = (*ExternalIncoterms1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Indicates the independent amount and how it was applied in the calculation.
 type IndependentAmount1 struct {
 
@@ -14,10 +18,18 @@ type IndependentAmount1 struct {
 	Convention *IndependentAmountConventionType1Code `xml:"Cnvntn"`
 }
 
+func (i *IndependentAmount1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndependentAmount1) SetAmount(value, currency string) {
 	i.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *IndependentAmount1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *IndependentAmount1) SetConvention(value string) {
 	i.Convention = (*IndependentAmountConventionType1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Indicates the independent amount and how it was applied in the calculation. It also provides a description of the amount type.
 type IndependentAmount2 struct {
 
@@ -17,6 +21,10 @@ type IndependentAmount2 struct {
 	Convention *IndependentAmountConventionType1Code `xml:"Cnvntn"`
 }
 
+func (i *IndependentAmount2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndependentAmount2) SetDescription(value string) {
 	i.Description = (*Max140Text)(&value)
 }
@@ -25,6 +33,10 @@ func (i *IndependentAmount2) SetAmount(value, currency string) {
 	i.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *IndependentAmount2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *IndependentAmount2) SetConvention(value string) {
 	i.Convention = (*IndependentAmountConventionType1Code)(&value)
 }
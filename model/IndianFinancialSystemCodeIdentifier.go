@@ -1,3 +1,9 @@
 package model
 
 type IndianFinancialSystemCodeIdentifier string
+
+var indianFinancialSystemCodeIdentifierPattern = newPattern(`IN[a-zA-Z0-9]{11,11}`)
+
+func (i IndianFinancialSystemCodeIdentifier) Validate() error {
+	return validatePattern("IndianFinancialSystemCodeIdentifier", string(i), indianFinancialSystemCodeIdentifierPattern)
+}
@@ -10,6 +10,10 @@ type IndicativeOrMarketPrice11Choice struct {
 	MarketPrice *PriceFormat57Choice `xml:"MktPric"`
 }
 
+func (i *IndicativeOrMarketPrice11Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndicativeOrMarketPrice11Choice) AddIndicativePrice() *PriceFormat57Choice {
 	i.IndicativePrice = new(PriceFormat57Choice)
 	return i.IndicativePrice
@@ -10,6 +10,10 @@ type IndicativeOrMarketPrice1Choice struct {
 	MarketPrice *PriceFormat11Choice `xml:"MktPric"`
 }
 
+func (i *IndicativeOrMarketPrice1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndicativeOrMarketPrice1Choice) AddIndicativePrice() *PriceFormat11Choice {
 	i.IndicativePrice = new(PriceFormat11Choice)
 	return i.IndicativePrice
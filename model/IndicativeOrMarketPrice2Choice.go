@@ -10,6 +10,10 @@ type IndicativeOrMarketPrice2Choice struct {
 	MarketPrice *PriceFormat5Choice `xml:"MktPric"`
 }
 
+func (i *IndicativeOrMarketPrice2Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndicativeOrMarketPrice2Choice) AddIndicativePrice() *PriceFormat5Choice {
 	i.IndicativePrice = new(PriceFormat5Choice)
 	return i.IndicativePrice
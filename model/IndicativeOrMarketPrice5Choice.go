@@ -10,6 +10,10 @@ type IndicativeOrMarketPrice5Choice struct {
 	MarketPrice *PriceFormat19Choice `xml:"MktPric"`
 }
 
+func (i *IndicativeOrMarketPrice5Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndicativeOrMarketPrice5Choice) AddIndicativePrice() *PriceFormat19Choice {
 	i.IndicativePrice = new(PriceFormat19Choice)
 	return i.IndicativePrice
@@ -10,6 +10,10 @@ type IndicativeOrMarketPrice7Choice struct {
 	MarketPrice *PriceFormat45Choice `xml:"MktPric"`
 }
 
+func (i *IndicativeOrMarketPrice7Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndicativeOrMarketPrice7Choice) AddIndicativePrice() *PriceFormat45Choice {
 	i.IndicativePrice = new(PriceFormat45Choice)
 	return i.IndicativePrice
@@ -10,6 +10,10 @@ type IndicativeOrMarketPrice8Choice struct {
 	MarketPrice *PriceFormat50Choice `xml:"MktPric"`
 }
 
+func (i *IndicativeOrMarketPrice8Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndicativeOrMarketPrice8Choice) AddIndicativePrice() *PriceFormat50Choice {
 	i.IndicativePrice = new(PriceFormat50Choice)
 	return i.IndicativePrice
@@ -10,6 +10,10 @@ type IndicativeOrMarketPrice9Choice struct {
 	MarketPrice *PriceFormat52Choice `xml:"MktPric"`
 }
 
+func (i *IndicativeOrMarketPrice9Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndicativeOrMarketPrice9Choice) AddIndicativePrice() *PriceFormat52Choice {
 	i.IndicativePrice = new(PriceFormat52Choice)
 	return i.IndicativePrice
@@ -13,6 +13,10 @@ type IndividualMovementStatus1 struct {
 	RejectedStatus *DistributionRejectionStatus1 `xml:"RjctdSts"`
 }
 
+func (i *IndividualMovementStatus1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualMovementStatus1) SetMovementIdentification(value string) {
 	i.MovementIdentification = (*Max35Text)(&value)
 }
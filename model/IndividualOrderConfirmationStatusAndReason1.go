@@ -34,6 +34,10 @@ type IndividualOrderConfirmationStatusAndReason1 struct {
 	FinancialInstrumentDetails *FinancialInstrument10 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (i *IndividualOrderConfirmationStatusAndReason1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualOrderConfirmationStatusAndReason1) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -28,6 +28,10 @@ type IndividualOrderConfirmationStatusAndReason2 struct {
 	FinancialInstrumentDetails *FinancialInstrument57 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (i *IndividualOrderConfirmationStatusAndReason2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualOrderConfirmationStatusAndReason2) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
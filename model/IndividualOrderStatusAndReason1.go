@@ -37,6 +37,10 @@ type IndividualOrderStatusAndReason1 struct {
 	NewDetails *ExpectedExecutionDetails1 `xml:"NewDtls,omitempty"`
 }
 
+func (i *IndividualOrderStatusAndReason1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualOrderStatusAndReason1) SetStatus(value string) {
 	i.Status = (*OrderStatus2Code)(&value)
 }
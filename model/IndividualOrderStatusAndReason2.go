@@ -52,6 +52,10 @@ type IndividualOrderStatusAndReason2 struct {
 	NewDetails *ExpectedExecutionDetails2 `xml:"NewDtls,omitempty"`
 }
 
+func (i *IndividualOrderStatusAndReason2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualOrderStatusAndReason2) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -31,6 +31,10 @@ type IndividualOrderStatusAndReason4 struct {
 	FinancialInstrumentDetails *FinancialInstrument10 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (i *IndividualOrderStatusAndReason4) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualOrderStatusAndReason4) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -37,6 +37,10 @@ type IndividualOrderStatusAndReason7 struct {
 	GatingOrHoldBackDetails *HoldBackInformation3 `xml:"GtgOrHldBckDtls,omitempty"`
 }
 
+func (i *IndividualOrderStatusAndReason7) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualOrderStatusAndReason7) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
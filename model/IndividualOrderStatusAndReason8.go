@@ -28,6 +28,10 @@ type IndividualOrderStatusAndReason8 struct {
 	FinancialInstrumentDetails *FinancialInstrument57 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (i *IndividualOrderStatusAndReason8) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualOrderStatusAndReason8) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Human entity, as distinguished from a corporate entity (which is sometimes referred to as an 'artificial person').
 type IndividualPerson11 struct {
 
@@ -70,8 +74,16 @@ type IndividualPerson11 struct {
 	ModifiedOtherIdentification []*ModificationScope2 `xml:"ModfdOthrId,omitempty"`
 }
 
-func (i *IndividualPerson11) SetNamePrefix(value string) {
+func (i *IndividualPerson11) Validate() error {
+	return ValidateElement(i)
+}
+
+func (i *IndividualPerson11) SetNamePrefix(value string) error {
+	if err := NamePrefix1Code(value).Validate(); err != nil {
+		return err
+	}
 	i.NamePrefix = (*NamePrefix1Code)(&value)
+	return nil
 }
 
 func (i *IndividualPerson11) SetExtendedNamePrefix(value string) {
@@ -106,6 +118,11 @@ func (i *IndividualPerson11) SetBirthDate(value string) {
 	i.BirthDate = (*ISODate)(&value)
 }
 
+func (i *IndividualPerson11) SetBirthDateFromTime(value time.Time) {
+	i.BirthDate = new(ISODate)
+	i.BirthDate.FromTime(value)
+}
+
 func (i *IndividualPerson11) SetCountryOfBirth(value string) {
 	i.CountryOfBirth = (*CountryCode)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Human entity, as distinguished from a corporate entity (which is sometimes referred to as an 'artificial person').
 type IndividualPerson12 struct {
 
@@ -16,6 +20,10 @@ type IndividualPerson12 struct {
 	OtherIdentification []*GenericIdentification11 `xml:"OthrId,omitempty"`
 }
 
+func (i *IndividualPerson12) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson12) SetName(value string) {
 	i.Name = (*Max350Text)(&value)
 }
@@ -24,6 +32,11 @@ func (i *IndividualPerson12) SetBirthDate(value string) {
 	i.BirthDate = (*ISODate)(&value)
 }
 
+func (i *IndividualPerson12) SetBirthDateFromTime(value time.Time) {
+	i.BirthDate = new(ISODate)
+	i.BirthDate.FromTime(value)
+}
+
 func (i *IndividualPerson12) AddCountryAndResidentialStatus() *CountryAndResidentialStatusType1 {
 	i.CountryAndResidentialStatus = new(CountryAndResidentialStatusType1)
 	return i.CountryAndResidentialStatus
@@ -22,6 +22,10 @@ type IndividualPerson13 struct {
 	AttendanceCardDetails *AttendanceCard1 `xml:"AttndncCardDtls"`
 }
 
+func (i *IndividualPerson13) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson13) SetBirthName(value string) {
 	i.BirthName = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type IndividualPerson14 struct {
 	EmployingParty *PartyIdentification9Choice `xml:"EmplngPty,omitempty"`
 }
 
+func (i *IndividualPerson14) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson14) SetBirthName(value string) {
 	i.BirthName = (*Max35Text)(&value)
 }
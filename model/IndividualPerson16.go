@@ -19,6 +19,10 @@ type IndividualPerson16 struct {
 	EmployingParty *PartyIdentification9Choice `xml:"EmplngPty,omitempty"`
 }
 
+func (i *IndividualPerson16) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson16) SetBirthName(value string) {
 	i.BirthName = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type IndividualPerson17 struct {
 	AttendanceCardDetails *AttendanceCard2 `xml:"AttndncCardDtls"`
 }
 
+func (i *IndividualPerson17) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson17) SetBirthName(value string) {
 	i.BirthName = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Human entity, as distinguished from a corporate entity (which is sometimes referred to as an 'artificial person').
 type IndividualPerson20 struct {
 
@@ -70,6 +74,10 @@ type IndividualPerson20 struct {
 	AdditionalRegulatoryInformation *RegulatoryInformation1 `xml:"AddtlRgltryInf,omitempty"`
 }
 
+func (i *IndividualPerson20) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson20) AddNamePrefix() *NamePrefix1Choice {
 	i.NamePrefix = new(NamePrefix1Choice)
 	return i.NamePrefix
@@ -103,6 +111,11 @@ func (i *IndividualPerson20) SetBirthDate(value string) {
 	i.BirthDate = (*ISODate)(&value)
 }
 
+func (i *IndividualPerson20) SetBirthDateFromTime(value time.Time) {
+	i.BirthDate = new(ISODate)
+	i.BirthDate.FromTime(value)
+}
+
 func (i *IndividualPerson20) SetCountryOfBirth(value string) {
 	i.CountryOfBirth = (*CountryCode)(&value)
 }
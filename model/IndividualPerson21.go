@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Human entity, as distinguished from a corporate entity (which is sometimes referred to as an 'artificial person').
 type IndividualPerson21 struct {
 
@@ -73,6 +77,10 @@ type IndividualPerson21 struct {
 	PoliticallyExposedPersonType *PoliticalExposureType1Choice `xml:"PltclyXpsdPrsnTp,omitempty"`
 }
 
+func (i *IndividualPerson21) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson21) AddNamePrefix() *NamePrefix1Choice {
 	i.NamePrefix = new(NamePrefix1Choice)
 	return i.NamePrefix
@@ -106,6 +114,11 @@ func (i *IndividualPerson21) SetBirthDate(value string) {
 	i.BirthDate = (*ISODate)(&value)
 }
 
+func (i *IndividualPerson21) SetBirthDateFromTime(value time.Time) {
+	i.BirthDate = new(ISODate)
+	i.BirthDate.FromTime(value)
+}
+
 func (i *IndividualPerson21) SetCountryOfBirth(value string) {
 	i.CountryOfBirth = (*CountryCode)(&value)
 }
@@ -19,6 +19,10 @@ type IndividualPerson25 struct {
 	EmployingParty *PartyIdentification40Choice `xml:"EmplngPty,omitempty"`
 }
 
+func (i *IndividualPerson25) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson25) SetBirthName(value string) {
 	i.BirthName = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type IndividualPerson26 struct {
 	AttendanceCardDetails *AttendanceCard2 `xml:"AttndncCardDtls"`
 }
 
+func (i *IndividualPerson26) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson26) SetBirthName(value string) {
 	i.BirthName = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Human entity, as distinguished from a corporate entity (which is sometimes referred to as an 'artificial person').
 type IndividualPerson27 struct {
 
@@ -64,6 +68,10 @@ type IndividualPerson27 struct {
 	FamilyInformation *PersonalInformation1 `xml:"FmlyInf,omitempty"`
 }
 
+func (i *IndividualPerson27) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson27) AddNamePrefix() *NamePrefix1Choice {
 	i.NamePrefix = new(NamePrefix1Choice)
 	return i.NamePrefix
@@ -93,6 +101,11 @@ func (i *IndividualPerson27) SetBirthDate(value string) {
 	i.BirthDate = (*ISODate)(&value)
 }
 
+func (i *IndividualPerson27) SetBirthDateFromTime(value time.Time) {
+	i.BirthDate = new(ISODate)
+	i.BirthDate.FromTime(value)
+}
+
 func (i *IndividualPerson27) SetCountryOfBirth(value string) {
 	i.CountryOfBirth = (*CountryCode)(&value)
 }
@@ -418,6 +431,11 @@ func (i *IndividualPerson27) SetDeathDate(value string) {
 	i.DeathDate = (*ISODate)(&value)
 }
 
+func (i *IndividualPerson27) SetDeathDateFromTime(value time.Time) {
+	i.DeathDate = new(ISODate)
+	i.DeathDate.FromTime(value)
+}
+
 func (i *IndividualPerson27) AddCivilStatus() *CivilStatus1Choice {
 	i.CivilStatus = new(CivilStatus1Choice)
 	return i.CivilStatus
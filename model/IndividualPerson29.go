@@ -19,6 +19,10 @@ type IndividualPerson29 struct {
 	PostalAddress []*PostalAddress21 `xml:"PstlAdr"`
 }
 
+func (i *IndividualPerson29) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson29) AddNamePrefix() *NamePrefix1Choice {
 	i.NamePrefix = new(NamePrefix1Choice)
 	return i.NamePrefix
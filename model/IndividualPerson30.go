@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Human entity, as distinguished from a corporate entity (which is sometimes referred to as an 'artificial person').
 type IndividualPerson30 struct {
 
@@ -19,6 +23,10 @@ type IndividualPerson30 struct {
 	BirthDate *ISODate `xml:"BirthDt,omitempty"`
 }
 
+func (i *IndividualPerson30) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson30) SetGivenName(value string) {
 	i.GivenName = (*Max35Text)(&value)
 }
@@ -38,3 +46,8 @@ func (i *IndividualPerson30) SetGender(value string) {
 func (i *IndividualPerson30) SetBirthDate(value string) {
 	i.BirthDate = (*ISODate)(&value)
 }
+
+func (i *IndividualPerson30) SetBirthDateFromTime(value time.Time) {
+	i.BirthDate = new(ISODate)
+	i.BirthDate.FromTime(value)
+}
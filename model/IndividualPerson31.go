@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Human entity, as distinguished from a corporate entity (which is sometimes referred to as an 'artificial person').
 type IndividualPerson31 struct {
 
@@ -19,6 +23,10 @@ type IndividualPerson31 struct {
 	OtherIdentification []*GenericIdentification164 `xml:"OthrId,omitempty"`
 }
 
+func (i *IndividualPerson31) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson31) SetName(value string) {
 	i.Name = (*Max350Text)(&value)
 }
@@ -27,6 +35,11 @@ func (i *IndividualPerson31) SetBirthDate(value string) {
 	i.BirthDate = (*ISODate)(&value)
 }
 
+func (i *IndividualPerson31) SetBirthDateFromTime(value time.Time) {
+	i.BirthDate = new(ISODate)
+	i.BirthDate.FromTime(value)
+}
+
 func (i *IndividualPerson31) AddCountryAndResidentialStatus() *CountryAndResidentialStatusType2 {
 	i.CountryAndResidentialStatus = new(CountryAndResidentialStatusType2)
 	return i.CountryAndResidentialStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Human entity, as distinguished from a corporate entity (which is sometimes referred to as an 'artificial person').
 type IndividualPerson32 struct {
 
@@ -16,6 +20,10 @@ type IndividualPerson32 struct {
 	OtherIdentification []*GenericIdentification164 `xml:"OthrId,omitempty"`
 }
 
+func (i *IndividualPerson32) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson32) SetName(value string) {
 	i.Name = (*Max350Text)(&value)
 }
@@ -24,6 +32,11 @@ func (i *IndividualPerson32) SetBirthDate(value string) {
 	i.BirthDate = (*ISODate)(&value)
 }
 
+func (i *IndividualPerson32) SetBirthDateFromTime(value time.Time) {
+	i.BirthDate = new(ISODate)
+	i.BirthDate.FromTime(value)
+}
+
 func (i *IndividualPerson32) AddCountryAndResidentialStatus() *CountryAndResidentialStatusType2 {
 	i.CountryAndResidentialStatus = new(CountryAndResidentialStatusType2)
 	return i.CountryAndResidentialStatus
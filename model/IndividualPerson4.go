@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Human entity, as distinguished from a corporate entity (which is sometimes referred to as an 'artificial person').
 type IndividualPerson4 struct {
 
@@ -19,6 +23,10 @@ type IndividualPerson4 struct {
 	BirthDate *ISODate `xml:"BirthDt,omitempty"`
 }
 
+func (i *IndividualPerson4) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson4) SetGivenName(value string) {
 	i.GivenName = (*Max35Text)(&value)
 }
@@ -38,3 +46,8 @@ func (i *IndividualPerson4) SetGender(value string) {
 func (i *IndividualPerson4) SetBirthDate(value string) {
 	i.BirthDate = (*ISODate)(&value)
 }
+
+func (i *IndividualPerson4) SetBirthDateFromTime(value time.Time) {
+	i.BirthDate = new(ISODate)
+	i.BirthDate.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Human entity, as distinguished from a corporate entity (which is sometimes referred to as an 'artificial person').
 type IndividualPerson8 struct {
 
@@ -28,6 +32,10 @@ type IndividualPerson8 struct {
 	IndividualInvestorAddress *PostalAddress1 `xml:"IndvInvstrAdr"`
 }
 
+func (i *IndividualPerson8) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson8) SetName(value string) {
 	i.Name = (*Max35Text)(&value)
 }
@@ -36,8 +44,12 @@ func (i *IndividualPerson8) SetGivenName(value string) {
 	i.GivenName = (*Max35Text)(&value)
 }
 
-func (i *IndividualPerson8) SetNamePrefix(value string) {
+func (i *IndividualPerson8) SetNamePrefix(value string) error {
+	if err := NamePrefix1Code(value).Validate(); err != nil {
+		return err
+	}
 	i.NamePrefix = (*NamePrefix1Code)(&value)
+	return nil
 }
 
 func (i *IndividualPerson8) SetNameSuffix(value string) {
@@ -52,6 +64,11 @@ func (i *IndividualPerson8) SetBirthDate(value string) {
 	i.BirthDate = (*ISODate)(&value)
 }
 
+func (i *IndividualPerson8) SetBirthDateFromTime(value time.Time) {
+	i.BirthDate = new(ISODate)
+	i.BirthDate.FromTime(value)
+}
+
 func (i *IndividualPerson8) SetSocialSecurityNumber(value string) {
 	i.SocialSecurityNumber = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Human entity, as distinguished from a corporate entity (which is sometimes referred to as an 'artificial person').
 type IndividualPerson9 struct {
 
@@ -19,6 +23,10 @@ type IndividualPerson9 struct {
 	OtherIdentification []*GenericIdentification11 `xml:"OthrId,omitempty"`
 }
 
+func (i *IndividualPerson9) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPerson9) SetName(value string) {
 	i.Name = (*Max350Text)(&value)
 }
@@ -27,6 +35,11 @@ func (i *IndividualPerson9) SetBirthDate(value string) {
 	i.BirthDate = (*ISODate)(&value)
 }
 
+func (i *IndividualPerson9) SetBirthDateFromTime(value time.Time) {
+	i.BirthDate = new(ISODate)
+	i.BirthDate.FromTime(value)
+}
+
 func (i *IndividualPerson9) AddCountryAndResidentialStatus() *CountryAndResidentialStatusType1 {
 	i.CountryAndResidentialStatus = new(CountryAndResidentialStatusType1)
 	return i.CountryAndResidentialStatus
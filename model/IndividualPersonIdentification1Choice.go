@@ -10,6 +10,10 @@ type IndividualPersonIdentification1Choice struct {
 	PersonName *IndividualPerson30 `xml:"PrsnNm"`
 }
 
+func (i *IndividualPersonIdentification1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPersonIdentification1Choice) AddIdentificationNumber() *GenericIdentification81 {
 	i.IdentificationNumber = new(GenericIdentification81)
 	return i.IdentificationNumber
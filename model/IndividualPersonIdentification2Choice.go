@@ -10,6 +10,10 @@ type IndividualPersonIdentification2Choice struct {
 	PersonName *IndividualPerson30 `xml:"PrsnNm"`
 }
 
+func (i *IndividualPersonIdentification2Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPersonIdentification2Choice) AddIdentificationNumber() *GenericIdentification81 {
 	i.IdentificationNumber = new(GenericIdentification81)
 	return i.IdentificationNumber
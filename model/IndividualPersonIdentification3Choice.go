@@ -10,6 +10,10 @@ type IndividualPersonIdentification3Choice struct {
 	PersonName *IndividualPerson35 `xml:"PrsnNm"`
 }
 
+func (i *IndividualPersonIdentification3Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPersonIdentification3Choice) AddIdentificationNumber() *GenericIdentification81 {
 	i.IdentificationNumber = new(GenericIdentification81)
 	return i.IdentificationNumber
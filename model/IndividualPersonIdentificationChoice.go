@@ -10,6 +10,10 @@ type IndividualPersonIdentificationChoice struct {
 	PersonName *IndividualPerson4 `xml:"PrsnNm"`
 }
 
+func (i *IndividualPersonIdentificationChoice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IndividualPersonIdentificationChoice) AddIdentificationNumber() *GenericIdentification10 {
 	i.IdentificationNumber = new(GenericIdentification10)
 	return i.IdentificationNumber
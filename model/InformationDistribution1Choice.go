@@ -10,6 +10,10 @@ type InformationDistribution1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *InformationDistribution1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InformationDistribution1Choice) SetCode(value string) {
 	i.Code = (*InformationDistribution2Code)(&value)
 }
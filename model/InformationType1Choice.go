@@ -10,6 +10,10 @@ type InformationType1Choice struct {
 	Proprietary *Max140Text `xml:"Prtry"`
 }
 
+func (i *InformationType1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InformationType1Choice) SetCode(value string) {
 	i.Code = (*InformationType1Code)(&value)
 }
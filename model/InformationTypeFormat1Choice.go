@@ -10,6 +10,10 @@ type InformationTypeFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (i *InformationTypeFormat1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InformationTypeFormat1Choice) SetCode(value string) {
 	i.Code = (*CorporateActionInformationType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type InformationTypeFormat4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (i *InformationTypeFormat4Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InformationTypeFormat4Choice) SetCode(value string) {
 	i.Code = (*CorporateActionInformationType1Code)(&value)
 }
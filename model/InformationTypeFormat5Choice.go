@@ -10,6 +10,10 @@ type InformationTypeFormat5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *InformationTypeFormat5Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InformationTypeFormat5Choice) SetCode(value string) {
 	i.Code = (*CorporateActionInformationType1Code)(&value)
 }
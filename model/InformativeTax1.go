@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Tax related to an investment fund order.
 type InformativeTax1 struct {
 
@@ -19,10 +23,18 @@ type InformativeTax1 struct {
 	IndividualTax []*Tax32 `xml:"IndvTax,omitempty"`
 }
 
+func (i *InformativeTax1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InformativeTax1) SetTaxableIncomePerDividend(value, currency string) {
 	i.TaxableIncomePerDividend = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InformativeTax1) SetTaxableIncomePerDividendFromDecimal(value decimal.Decimal, currency string) {
+	i.TaxableIncomePerDividend = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InformativeTax1) AddEUCapitalGain() *EUCapitalGain3Choice {
 	i.EUCapitalGain = new(EUCapitalGain3Choice)
 	return i.EUCapitalGain
@@ -37,6 +49,11 @@ func (i *InformativeTax1) SetPercentageOfDebtClaim(value string) {
 	i.PercentageOfDebtClaim = (*PercentageRate)(&value)
 }
 
+func (i *InformativeTax1) SetPercentageOfDebtClaimFromDecimal(value decimal.Decimal) {
+	i.PercentageOfDebtClaim = new(PercentageRate)
+	i.PercentageOfDebtClaim.FromDecimal(value)
+}
+
 func (i *InformativeTax1) AddIndividualTax() *Tax32 {
 	newValue := new(Tax32)
 	i.IndividualTax = append(i.IndividualTax, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of an initial amount or number of pre-paid instalments.
 type InitialAmount1Choice struct {
 
@@ -10,10 +14,23 @@ type InitialAmount1Choice struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (i *InitialAmount1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InitialAmount1Choice) SetInitialNumberOfInstalments(value string) {
 	i.InitialNumberOfInstalments = (*Number)(&value)
 }
 
+func (i *InitialAmount1Choice) SetInitialNumberOfInstalmentsFromDecimal(value decimal.Decimal) {
+	i.InitialNumberOfInstalments = new(Number)
+	i.InitialNumberOfInstalments.FromDecimal(value)
+}
+
 func (i *InitialAmount1Choice) SetAmount(value, currency string) {
 	i.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (i *InitialAmount1Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
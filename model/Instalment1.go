@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information of a single instalment related to an invoice settlement.
 type Instalment1 struct {
 
@@ -13,6 +19,10 @@ type Instalment1 struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (i *Instalment1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Instalment1) SetSequenceIdentification(value string) {
 	i.SequenceIdentification = (*Max70Text)(&value)
 }
@@ -21,6 +31,15 @@ func (i *Instalment1) SetPaymentDueDate(value string) {
 	i.PaymentDueDate = (*ISODate)(&value)
 }
 
+func (i *Instalment1) SetPaymentDueDateFromTime(value time.Time) {
+	i.PaymentDueDate = new(ISODate)
+	i.PaymentDueDate.FromTime(value)
+}
+
 func (i *Instalment1) SetAmount(value, currency string) {
 	i.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (i *Instalment1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
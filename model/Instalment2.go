@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies a single instalment related to an invoice settlement and optional reconciliation information.
 // Reconciliation information is used to indicate the amount to be allocated to a particular instalment of a financial document.
 type Instalment2 struct {
@@ -17,6 +23,10 @@ type Instalment2 struct {
 	PaymentInstrument *PaymentMeans1 `xml:"PmtInstrm,omitempty"`
 }
 
+func (i *Instalment2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Instalment2) SetSequenceIdentification(value string) {
 	i.SequenceIdentification = (*Max70Text)(&value)
 }
@@ -25,10 +35,19 @@ func (i *Instalment2) SetPaymentDueDate(value string) {
 	i.PaymentDueDate = (*ISODate)(&value)
 }
 
+func (i *Instalment2) SetPaymentDueDateFromTime(value time.Time) {
+	i.PaymentDueDate = new(ISODate)
+	i.PaymentDueDate.FromTime(value)
+}
+
 func (i *Instalment2) SetAmount(value, currency string) {
 	i.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *Instalment2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *Instalment2) AddPaymentInstrument() *PaymentMeans1 {
 	i.PaymentInstrument = new(PaymentMeans1)
 	return i.PaymentInstrument
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about result of a single instalment (financed or not) within an invoice.
 type InstalmentFinancingInformation1 struct {
 
@@ -13,6 +17,10 @@ type InstalmentFinancingInformation1 struct {
 	InstalmentFinancingResult *FinancingResult1 `xml:"InstlmtFincgRslt"`
 }
 
+func (i *InstalmentFinancingInformation1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstalmentFinancingInformation1) SetInstalmentSequenceIdentification(value string) {
 	i.InstalmentSequenceIdentification = (*Max70Text)(&value)
 }
@@ -21,6 +29,10 @@ func (i *InstalmentFinancingInformation1) SetInstalmentTotalAmount(value, curren
 	i.InstalmentTotalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InstalmentFinancingInformation1) SetInstalmentTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.InstalmentTotalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InstalmentFinancingInformation1) AddInstalmentFinancingResult() *FinancingResult1 {
 	i.InstalmentFinancingResult = new(FinancingResult1)
 	return i.InstalmentFinancingResult
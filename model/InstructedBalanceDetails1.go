@@ -10,6 +10,10 @@ type InstructedBalanceDetails1 struct {
 	OptionDetails []*InstructedCorporateActionOption1 `xml:"OptnDtls,omitempty"`
 }
 
+func (i *InstructedBalanceDetails1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructedBalanceDetails1) AddTotalInstructedBalance() *BalanceFormat1Choice {
 	i.TotalInstructedBalance = new(BalanceFormat1Choice)
 	return i.TotalInstructedBalance
@@ -10,6 +10,10 @@ type InstructedBalanceDetails3 struct {
 	OptionDetails []*InstructedCorporateActionOption4 `xml:"OptnDtls,omitempty"`
 }
 
+func (i *InstructedBalanceDetails3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructedBalanceDetails3) AddTotalInstructedBalance() *BalanceFormat1Choice {
 	i.TotalInstructedBalance = new(BalanceFormat1Choice)
 	return i.TotalInstructedBalance
@@ -10,6 +10,10 @@ type InstructedBalanceDetails5 struct {
 	OptionDetails []*InstructedCorporateActionOption6 `xml:"OptnDtls,omitempty"`
 }
 
+func (i *InstructedBalanceDetails5) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructedBalanceDetails5) AddTotalInstructedBalance() *BalanceFormat5Choice {
 	i.TotalInstructedBalance = new(BalanceFormat5Choice)
 	return i.TotalInstructedBalance
@@ -10,6 +10,10 @@ type InstructedBalanceDetails6 struct {
 	OptionDetails []*InstructedCorporateActionOption7 `xml:"OptnDtls,omitempty"`
 }
 
+func (i *InstructedBalanceDetails6) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructedBalanceDetails6) AddTotalInstructedBalance() *BalanceFormat7Choice {
 	i.TotalInstructedBalance = new(BalanceFormat7Choice)
 	return i.TotalInstructedBalance
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides corporate action option details about total instructed balance.
 type InstructedCorporateActionOption4 struct {
 
@@ -22,6 +26,10 @@ type InstructedCorporateActionOption4 struct {
 	DeadlineType *DeadlineCode1Choice `xml:"DdlnTp"`
 }
 
+func (i *InstructedCorporateActionOption4) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructedCorporateActionOption4) SetOptionNumber(value string) {
 	i.OptionNumber = (*Exact3NumericText)(&value)
 }
@@ -45,6 +53,11 @@ func (i *InstructedCorporateActionOption4) SetDeadlineDateTime(value string) {
 	i.DeadlineDateTime = (*ISODateTime)(&value)
 }
 
+func (i *InstructedCorporateActionOption4) SetDeadlineDateTimeFromTime(value time.Time) {
+	i.DeadlineDateTime = new(ISODateTime)
+	i.DeadlineDateTime.FromTime(value)
+}
+
 func (i *InstructedCorporateActionOption4) AddDeadlineType() *DeadlineCode1Choice {
 	i.DeadlineType = new(DeadlineCode1Choice)
 	return i.DeadlineType
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides corporate action option details about total instructed balance.
 type InstructedCorporateActionOption6 struct {
 
@@ -22,6 +26,10 @@ type InstructedCorporateActionOption6 struct {
 	DeadlineType *DeadlineCode3Choice `xml:"DdlnTp"`
 }
 
+func (i *InstructedCorporateActionOption6) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructedCorporateActionOption6) SetOptionNumber(value string) {
 	i.OptionNumber = (*Exact3NumericText)(&value)
 }
@@ -45,6 +53,11 @@ func (i *InstructedCorporateActionOption6) SetDeadlineDateTime(value string) {
 	i.DeadlineDateTime = (*ISODateTime)(&value)
 }
 
+func (i *InstructedCorporateActionOption6) SetDeadlineDateTimeFromTime(value time.Time) {
+	i.DeadlineDateTime = new(ISODateTime)
+	i.DeadlineDateTime.FromTime(value)
+}
+
 func (i *InstructedCorporateActionOption6) AddDeadlineType() *DeadlineCode3Choice {
 	i.DeadlineType = new(DeadlineCode3Choice)
 	return i.DeadlineType
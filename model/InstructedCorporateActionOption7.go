@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides corporate action option details about total instructed balance.
 type InstructedCorporateActionOption7 struct {
 
@@ -22,6 +26,10 @@ type InstructedCorporateActionOption7 struct {
 	DeadlineType *DeadlineCode4Choice `xml:"DdlnTp"`
 }
 
+func (i *InstructedCorporateActionOption7) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructedCorporateActionOption7) SetOptionNumber(value string) {
 	i.OptionNumber = (*Exact3NumericText)(&value)
 }
@@ -45,6 +53,11 @@ func (i *InstructedCorporateActionOption7) SetDeadlineDateTime(value string) {
 	i.DeadlineDateTime = (*ISODateTime)(&value)
 }
 
+func (i *InstructedCorporateActionOption7) SetDeadlineDateTimeFromTime(value time.Time) {
+	i.DeadlineDateTime = new(ISODateTime)
+	i.DeadlineDateTime.FromTime(value)
+}
+
 func (i *InstructedCorporateActionOption7) AddDeadlineType() *DeadlineCode4Choice {
 	i.DeadlineType = new(DeadlineCode4Choice)
 	return i.DeadlineType
@@ -10,6 +10,10 @@ type InstructedOrQuantityToReceive1Choice struct {
 	QuantityToReceive *Quantity5Choice `xml:"QtyToRcv"`
 }
 
+func (i *InstructedOrQuantityToReceive1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructedOrQuantityToReceive1Choice) AddInstructedQuantity() *Quantity5Choice {
 	i.InstructedQuantity = new(Quantity5Choice)
 	return i.InstructedQuantity
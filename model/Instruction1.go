@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information on the instruction.
 type Instruction1 struct {
 
@@ -28,6 +32,10 @@ type Instruction1 struct {
 	SpecificInstructionRequest *SpecificInstructionRequest1 `xml:"SpcfcInstrReq,omitempty"`
 }
 
+func (i *Instruction1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Instruction1) SetInstructionIdentification(value string) {
 	i.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -36,10 +44,20 @@ func (i *Instruction1) SetRequestedExecutionDate(value string) {
 	i.RequestedExecutionDate = (*ISODateTime)(&value)
 }
 
+func (i *Instruction1) SetRequestedExecutionDateFromTime(value time.Time) {
+	i.RequestedExecutionDate = new(ISODateTime)
+	i.RequestedExecutionDate.FromTime(value)
+}
+
 func (i *Instruction1) SetVoteExecutionConfirmation(value string) {
 	i.VoteExecutionConfirmation = (*YesNoIndicator)(&value)
 }
 
+func (i *Instruction1) SetVoteExecutionConfirmationFromBool(value bool) {
+	i.VoteExecutionConfirmation = new(YesNoIndicator)
+	i.VoteExecutionConfirmation.FromBool(value)
+}
+
 func (i *Instruction1) AddAccountDetails() *SafekeepingAccount3 {
 	i.AccountDetails = new(SafekeepingAccount3)
 	return i.AccountDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information on the instruction.
 type Instruction2 struct {
 
@@ -28,6 +32,10 @@ type Instruction2 struct {
 	SpecificInstructionRequest *SpecificInstructionRequest1 `xml:"SpcfcInstrReq,omitempty"`
 }
 
+func (i *Instruction2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Instruction2) SetInstructionIdentification(value string) {
 	i.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -36,10 +44,20 @@ func (i *Instruction2) SetRequestedExecutionDate(value string) {
 	i.RequestedExecutionDate = (*ISODateTime)(&value)
 }
 
+func (i *Instruction2) SetRequestedExecutionDateFromTime(value time.Time) {
+	i.RequestedExecutionDate = new(ISODateTime)
+	i.RequestedExecutionDate.FromTime(value)
+}
+
 func (i *Instruction2) SetVoteExecutionConfirmation(value string) {
 	i.VoteExecutionConfirmation = (*YesNoIndicator)(&value)
 }
 
+func (i *Instruction2) SetVoteExecutionConfirmationFromBool(value bool) {
+	i.VoteExecutionConfirmation = new(YesNoIndicator)
+	i.VoteExecutionConfirmation.FromBool(value)
+}
+
 func (i *Instruction2) AddAccountDetails() *SafekeepingAccount4 {
 	i.AccountDetails = new(SafekeepingAccount4)
 	return i.AccountDetails
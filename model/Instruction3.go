@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information on the instruction.
 type Instruction3 struct {
 
@@ -28,6 +32,10 @@ type Instruction3 struct {
 	SpecificInstructionRequest *SpecificInstructionRequest1 `xml:"SpcfcInstrReq,omitempty"`
 }
 
+func (i *Instruction3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Instruction3) SetInstructionIdentification(value string) {
 	i.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -36,10 +44,20 @@ func (i *Instruction3) SetRequestedExecutionDate(value string) {
 	i.RequestedExecutionDate = (*ISODateTime)(&value)
 }
 
+func (i *Instruction3) SetRequestedExecutionDateFromTime(value time.Time) {
+	i.RequestedExecutionDate = new(ISODateTime)
+	i.RequestedExecutionDate.FromTime(value)
+}
+
 func (i *Instruction3) SetVoteExecutionConfirmation(value string) {
 	i.VoteExecutionConfirmation = (*YesNoIndicator)(&value)
 }
 
+func (i *Instruction3) SetVoteExecutionConfirmationFromBool(value bool) {
+	i.VoteExecutionConfirmation = new(YesNoIndicator)
+	i.VoteExecutionConfirmation.FromBool(value)
+}
+
 func (i *Instruction3) AddAccountDetails() *SafekeepingAccount6 {
 	i.AccountDetails = new(SafekeepingAccount6)
 	return i.AccountDetails
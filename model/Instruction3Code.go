@@ -1,3 +1,30 @@
 package model
 
 type Instruction3Code string
+
+const (
+	Instruction3CodeCHQB Instruction3Code = "CHQB"
+	Instruction3CodeHOLD Instruction3Code = "HOLD"
+	Instruction3CodePHOB Instruction3Code = "PHOB"
+	Instruction3CodeTELB Instruction3Code = "TELB"
+)
+
+var instruction3CodeDescriptions = map[Instruction3Code]string{
+	Instruction3CodeCHQB: "Pay creditor by cheque: (ultimate) creditor must be paid by cheque.",
+	Instruction3CodeHOLD: "Hold cash for creditor: amount of money must be held for the (ultimate) creditor, who will call.",
+	Instruction3CodePHOB: "Phone beneficiary: please advise/contact (ultimate) creditor/claimant by phone.",
+	Instruction3CodeTELB: "Telecom: please advise/contact (ultimate) creditor/claimant by the most efficient means of telecommunication.",
+}
+
+func (i Instruction3Code) IsValid() bool {
+	_, ok := instruction3CodeDescriptions[i]
+	return ok
+}
+
+func (i Instruction3Code) Description() string {
+	return instruction3CodeDescriptions[i]
+}
+
+func (i Instruction3Code) Validate() error {
+	return validateEnumeration("Instruction3Code", string(i), i.IsValid(), "CHQB, HOLD, PHOB, TELB")
+}
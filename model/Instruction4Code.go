@@ -1,3 +1,26 @@
 package model
 
 type Instruction4Code string
+
+const (
+	Instruction4CodePHOA Instruction4Code = "PHOA"
+	Instruction4CodeTELA Instruction4Code = "TELA"
+)
+
+var instruction4CodeDescriptions = map[Instruction4Code]string{
+	Instruction4CodePHOA: "Phone next agent: please advise/contact next agent by phone.",
+	Instruction4CodeTELA: "Telecom next agent: please advise/contact next agent by the most efficient means of telecommunication.",
+}
+
+func (i Instruction4Code) IsValid() bool {
+	_, ok := instruction4CodeDescriptions[i]
+	return ok
+}
+
+func (i Instruction4Code) Description() string {
+	return instruction4CodeDescriptions[i]
+}
+
+func (i Instruction4Code) Validate() error {
+	return validateEnumeration("Instruction4Code", string(i), i.IsValid(), "PHOA, TELA")
+}
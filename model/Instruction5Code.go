@@ -1,3 +1,26 @@
 package model
 
 type Instruction5Code string
+
+const (
+	Instruction5CodePHOB Instruction5Code = "PHOB"
+	Instruction5CodeTELB Instruction5Code = "TELB"
+)
+
+var instruction5CodeDescriptions = map[Instruction5Code]string{
+	Instruction5CodePHOB: "Phone beneficiary: please advise/contact (ultimate) creditor/claimant by phone.",
+	Instruction5CodeTELB: "Telecom: please advise/contact (ultimate) creditor/claimant by the most efficient means of telecommunication.",
+}
+
+func (i Instruction5Code) IsValid() bool {
+	_, ok := instruction5CodeDescriptions[i]
+	return ok
+}
+
+func (i Instruction5Code) Description() string {
+	return instruction5CodeDescriptions[i]
+}
+
+func (i Instruction5Code) Validate() error {
+	return validateEnumeration("Instruction5Code", string(i), i.IsValid(), "PHOB, TELB")
+}
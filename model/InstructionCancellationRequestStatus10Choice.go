@@ -19,6 +19,10 @@ type InstructionCancellationRequestStatus10Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason7 `xml:"PrtrySts"`
 }
 
+func (i *InstructionCancellationRequestStatus10Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionCancellationRequestStatus10Choice) AddCancellationCompleted() *CancelledStatus14Choice {
 	i.CancellationCompleted = new(CancelledStatus14Choice)
 	return i.CancellationCompleted
@@ -19,6 +19,10 @@ type InstructionCancellationRequestStatus11Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason6 `xml:"PrtrySts"`
 }
 
+func (i *InstructionCancellationRequestStatus11Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionCancellationRequestStatus11Choice) AddCancellationCompleted() *CancelledStatus11Choice {
 	i.CancellationCompleted = new(CancelledStatus11Choice)
 	return i.CancellationCompleted
@@ -19,6 +19,10 @@ type InstructionCancellationRequestStatus12Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason7 `xml:"PrtrySts"`
 }
 
+func (i *InstructionCancellationRequestStatus12Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionCancellationRequestStatus12Choice) AddCancellationCompleted() *CancelledStatus14Choice {
 	i.CancellationCompleted = new(CancelledStatus14Choice)
 	return i.CancellationCompleted
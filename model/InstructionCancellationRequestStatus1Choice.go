@@ -19,6 +19,10 @@ type InstructionCancellationRequestStatus1Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts"`
 }
 
+func (i *InstructionCancellationRequestStatus1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionCancellationRequestStatus1Choice) AddCancellationCompleted() *CancelledStatus1Choice {
 	i.CancellationCompleted = new(CancelledStatus1Choice)
 	return i.CancellationCompleted
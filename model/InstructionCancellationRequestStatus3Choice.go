@@ -19,6 +19,10 @@ type InstructionCancellationRequestStatus3Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts"`
 }
 
+func (i *InstructionCancellationRequestStatus3Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionCancellationRequestStatus3Choice) AddCancellationCompleted() *CancelledStatus3Choice {
 	i.CancellationCompleted = new(CancelledStatus3Choice)
 	return i.CancellationCompleted
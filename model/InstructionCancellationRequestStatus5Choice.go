@@ -19,6 +19,10 @@ type InstructionCancellationRequestStatus5Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts"`
 }
 
+func (i *InstructionCancellationRequestStatus5Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionCancellationRequestStatus5Choice) AddCancellationCompleted() *CancelledStatus3Choice {
 	i.CancellationCompleted = new(CancelledStatus3Choice)
 	return i.CancellationCompleted
@@ -19,6 +19,10 @@ type InstructionCancellationRequestStatus7Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts"`
 }
 
+func (i *InstructionCancellationRequestStatus7Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionCancellationRequestStatus7Choice) AddCancellationCompleted() *CancelledStatus7Choice {
 	i.CancellationCompleted = new(CancelledStatus7Choice)
 	return i.CancellationCompleted
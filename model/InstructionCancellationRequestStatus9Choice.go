@@ -19,6 +19,10 @@ type InstructionCancellationRequestStatus9Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason6 `xml:"PrtrySts"`
 }
 
+func (i *InstructionCancellationRequestStatus9Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionCancellationRequestStatus9Choice) AddCancellationCompleted() *CancelledStatus11Choice {
 	i.CancellationCompleted = new(CancelledStatus11Choice)
 	return i.CancellationCompleted
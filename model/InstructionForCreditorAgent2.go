@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Further information related to the processing of the payment instruction that may need to be acted upon by the creditor's agent. The instruction may relate to a level of service, or may be an instruction that has to be executed by the creditor's agent, or may be information required by the creditor's agent.
 type InstructionForCreditorAgent2 struct {
 
@@ -10,10 +12,59 @@ type InstructionForCreditorAgent2 struct {
 	InstructionInformation *Max140Text `xml:"InstrInf,omitempty"`
 }
 
-func (i *InstructionForCreditorAgent2) SetCode(value string) {
+func (i *InstructionForCreditorAgent2) Validate() error {
+	return ValidateElement(i)
+}
+
+func (i *InstructionForCreditorAgent2) SetCode(value string) error {
+	if err := Instruction5Code(value).Validate(); err != nil {
+		return err
+	}
 	i.Code = (*Instruction5Code)(&value)
+	return nil
 }
 
 func (i *InstructionForCreditorAgent2) SetInstructionInformation(value string) {
 	i.InstructionInformation = (*Max140Text)(&value)
 }
+
+func (i *InstructionForCreditorAgent2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if i.Code != nil {
+		enc.Element("Cd", string(*i.Code))
+	}
+	if i.InstructionInformation != nil {
+		enc.Element("InstrInf", string(*i.InstructionInformation))
+	}
+	enc.End(tag)
+}
+
+func (i *InstructionForCreditorAgent2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			i.Code = (*Instruction5Code)(&value)
+		case "InstrInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			i.InstructionInformation = (*Max140Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
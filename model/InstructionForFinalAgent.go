@@ -12,8 +12,16 @@ type InstructionForFinalAgent struct {
 	Proprietary *Max140Text `xml:"Prtry,omitempty"`
 }
 
-func (i *InstructionForFinalAgent) AddCode(value string) {
+func (i *InstructionForFinalAgent) Validate() error {
+	return ValidateElement(i)
+}
+
+func (i *InstructionForFinalAgent) AddCode(value string) error {
+	if err := Instruction3Code(value).Validate(); err != nil {
+		return err
+	}
 	i.Code = append(i.Code, (*Instruction3Code)(&value))
+	return nil
 }
 
 func (i *InstructionForFinalAgent) SetProprietary(value string) {
@@ -10,6 +10,10 @@ type InstructionProcessingReason1Choice struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (i *InstructionProcessingReason1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingReason1Choice) AddReason() *RejectionReason9 {
 	newValue := new(RejectionReason9)
 	i.Reason = append(i.Reason, newValue)
@@ -156,6 +160,10 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 		XchgRate:               doc.XchgRate.BaseOneRate,                                  // "<XchgRate>"
 	}
 
-func (i *InstructionProcessingReason1Choice) SetNoSpecifiedReason(value string) {
+func (i *InstructionProcessingReason1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	i.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
@@ -10,6 +10,10 @@ type InstructionProcessingReason2Choice struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (i *InstructionProcessingReason2Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingReason2Choice) AddReason() *RepairReason5 {
 	newValue := new(RepairReason5)
 	i.Reason = append(i.Reason, newValue)
@@ -156,6 +160,10 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 		XchgRate:               doc.XchgRate.BaseOneRate,                                  // "<XchgRate>"
 	}
 
-func (i *InstructionProcessingReason2Choice) SetNoSpecifiedReason(value string) {
+func (i *InstructionProcessingReason2Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	i.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
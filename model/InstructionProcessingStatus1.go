@@ -10,6 +10,10 @@ type InstructionProcessingStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (i *InstructionProcessingStatus1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus1) SetStatus(value string) {
 	i.Status = (*Status3Code)(&value)
 }
@@ -28,6 +28,10 @@ type InstructionProcessingStatus10Choice struct {
 	ModificationRequested *ProprietaryReason1 `xml:"ModReqd"`
 }
 
+func (i *InstructionProcessingStatus10Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus10Choice) AddPendingProcessing() *PendingProcessingStatus3Choice {
 	i.PendingProcessing = new(PendingProcessingStatus3Choice)
 	return i.PendingProcessing
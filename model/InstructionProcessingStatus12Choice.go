@@ -25,6 +25,10 @@ type InstructionProcessingStatus12Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts"`
 }
 
+func (i *InstructionProcessingStatus12Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus12Choice) AddCancelled() *CancelledStatus3Choice {
 	i.Cancelled = new(CancelledStatus3Choice)
 	return i.Cancelled
@@ -28,6 +28,10 @@ type InstructionProcessingStatus14Choice struct {
 	ModificationRequested *ProprietaryReason1 `xml:"ModReqd"`
 }
 
+func (i *InstructionProcessingStatus14Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus14Choice) AddPendingProcessing() *PendingProcessingStatus3Choice {
 	i.PendingProcessing = new(PendingProcessingStatus3Choice)
 	return i.PendingProcessing
@@ -25,6 +25,10 @@ type InstructionProcessingStatus15Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts"`
 }
 
+func (i *InstructionProcessingStatus15Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus15Choice) AddCancelled() *CancelledStatus3Choice {
 	i.Cancelled = new(CancelledStatus3Choice)
 	return i.Cancelled
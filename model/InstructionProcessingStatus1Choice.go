@@ -25,6 +25,10 @@ type InstructionProcessingStatus1Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts"`
 }
 
+func (i *InstructionProcessingStatus1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus1Choice) AddCancelled() *CancelledStatus1Choice {
 	i.Cancelled = new(CancelledStatus1Choice)
 	return i.Cancelled
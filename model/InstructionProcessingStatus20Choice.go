@@ -25,6 +25,10 @@ type InstructionProcessingStatus20Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts"`
 }
 
+func (i *InstructionProcessingStatus20Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus20Choice) AddCancelled() *CancelledStatus1Choice {
 	i.Cancelled = new(CancelledStatus1Choice)
 	return i.Cancelled
@@ -28,6 +28,10 @@ type InstructionProcessingStatus22Choice struct {
 	ModificationRequested *ProprietaryReason4 `xml:"ModReqd"`
 }
 
+func (i *InstructionProcessingStatus22Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus22Choice) AddPendingProcessing() *PendingProcessingStatus11Choice {
 	i.PendingProcessing = new(PendingProcessingStatus11Choice)
 	return i.PendingProcessing
@@ -10,6 +10,10 @@ type InstructionProcessingStatus23Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (i *InstructionProcessingStatus23Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus23Choice) SetCode(value string) {
 	i.Code = (*InstructionProcessingStatus1Code)(&value)
 }
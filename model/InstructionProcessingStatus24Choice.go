@@ -25,6 +25,10 @@ type InstructionProcessingStatus24Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason6 `xml:"PrtrySts"`
 }
 
+func (i *InstructionProcessingStatus24Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus24Choice) AddCancelled() *CancelledStatus12Choice {
 	i.Cancelled = new(CancelledStatus12Choice)
 	return i.Cancelled
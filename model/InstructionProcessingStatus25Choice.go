@@ -25,6 +25,10 @@ type InstructionProcessingStatus25Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason7 `xml:"PrtrySts"`
 }
 
+func (i *InstructionProcessingStatus25Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus25Choice) AddCancelled() *CancelledStatus15Choice {
 	i.Cancelled = new(CancelledStatus15Choice)
 	return i.Cancelled
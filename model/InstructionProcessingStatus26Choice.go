@@ -10,6 +10,10 @@ type InstructionProcessingStatus26Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *InstructionProcessingStatus26Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus26Choice) SetCode(value string) {
 	i.Code = (*InstructionProcessingStatus1Code)(&value)
 }
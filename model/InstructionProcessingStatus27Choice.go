@@ -28,6 +28,10 @@ type InstructionProcessingStatus27Choice struct {
 	ModificationRequested *ProprietaryReason5 `xml:"ModReqd"`
 }
 
+func (i *InstructionProcessingStatus27Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus27Choice) AddPendingProcessing() *PendingProcessingStatus15Choice {
 	i.PendingProcessing = new(PendingProcessingStatus15Choice)
 	return i.PendingProcessing
@@ -25,6 +25,10 @@ type InstructionProcessingStatus29Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason6 `xml:"PrtrySts"`
 }
 
+func (i *InstructionProcessingStatus29Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus29Choice) AddCancelled() *CancelledStatus12Choice {
 	i.Cancelled = new(CancelledStatus12Choice)
 	return i.Cancelled
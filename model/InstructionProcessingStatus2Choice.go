@@ -10,6 +10,10 @@ type InstructionProcessingStatus2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (i *InstructionProcessingStatus2Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus2Choice) SetCode(value string) {
 	i.Code = (*InstructionProcessingStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type InstructionProcessingStatus3 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (i *InstructionProcessingStatus3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus3) SetStatus(value string) {
 	i.Status = (*Status7Code)(&value)
 }
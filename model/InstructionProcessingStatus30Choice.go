@@ -25,6 +25,10 @@ type InstructionProcessingStatus30Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason7 `xml:"PrtrySts"`
 }
 
+func (i *InstructionProcessingStatus30Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus30Choice) AddCancelled() *CancelledStatus15Choice {
 	i.Cancelled = new(CancelledStatus15Choice)
 	return i.Cancelled
@@ -28,6 +28,10 @@ type InstructionProcessingStatus3Choice struct {
 	ModificationRequested *NoSpecifiedReason1 `xml:"ModReqd"`
 }
 
+func (i *InstructionProcessingStatus3Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus3Choice) AddPendingProcessing() *PendingProcessingStatus1Choice {
 	i.PendingProcessing = new(PendingProcessingStatus1Choice)
 	return i.PendingProcessing
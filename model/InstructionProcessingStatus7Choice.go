@@ -25,6 +25,10 @@ type InstructionProcessingStatus7Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts"`
 }
 
+func (i *InstructionProcessingStatus7Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionProcessingStatus7Choice) AddCancelled() *CancelledStatus3Choice {
 	i.Cancelled = new(CancelledStatus3Choice)
 	return i.Cancelled
@@ -13,6 +13,10 @@ type InstructionRejectionStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (i *InstructionRejectionStatus1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionRejectionStatus1) SetReason(value string) {
 	i.Reason = (*RejectionReason1Code)(&value)
 }
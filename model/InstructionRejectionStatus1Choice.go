@@ -10,6 +10,10 @@ type InstructionRejectionStatus1Choice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (i *InstructionRejectionStatus1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionRejectionStatus1Choice) SetCode(value string) {
 	i.Code = (*RejectionReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type InstructionStatus1Choice struct {
 	DetailedInstructionStatus []*DetailedInstructionStatus1 `xml:"DtldInstrSts"`
 }
 
+func (i *InstructionStatus1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionStatus1Choice) AddGlobalInstructionStatus() *InstructionStatus2Choice {
 	i.GlobalInstructionStatus = new(InstructionStatus2Choice)
 	return i.GlobalInstructionStatus
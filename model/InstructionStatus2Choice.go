@@ -10,6 +10,10 @@ type InstructionStatus2Choice struct {
 	RejectionStatus *InstructionRejectionStatus1 `xml:"RjctnSts"`
 }
 
+func (i *InstructionStatus2Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionStatus2Choice) AddProcessingStatus() *InstructionProcessingStatus1 {
 	i.ProcessingStatus = new(InstructionProcessingStatus1)
 	return i.ProcessingStatus
@@ -10,6 +10,10 @@ type InstructionStatus3Choice struct {
 	DetailedInstructionStatus []*DetailedInstructionStatus8 `xml:"DtldInstrSts"`
 }
 
+func (i *InstructionStatus3Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionStatus3Choice) AddGlobalInstructionStatus() *InstructionStatus4Choice {
 	i.GlobalInstructionStatus = new(InstructionStatus4Choice)
 	return i.GlobalInstructionStatus
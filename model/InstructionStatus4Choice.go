@@ -10,6 +10,10 @@ type InstructionStatus4Choice struct {
 	RejectionStatus *AdditionalStatus1 `xml:"RjctnSts"`
 }
 
+func (i *InstructionStatus4Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionStatus4Choice) AddProcessingStatus() *InstructionProcessingStatus1 {
 	i.ProcessingStatus = new(InstructionProcessingStatus1)
 	return i.ProcessingStatus
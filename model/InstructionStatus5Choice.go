@@ -10,6 +10,10 @@ type InstructionStatus5Choice struct {
 	DetailedInstructionStatus []*DetailedInstructionStatus11 `xml:"DtldInstrSts"`
 }
 
+func (i *InstructionStatus5Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionStatus5Choice) AddGlobalInstructionStatus() *InstructionStatus6Choice {
 	i.GlobalInstructionStatus = new(InstructionStatus6Choice)
 	return i.GlobalInstructionStatus
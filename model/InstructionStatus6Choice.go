@@ -10,6 +10,10 @@ type InstructionStatus6Choice struct {
 	RejectionStatus *AdditionalStatus1 `xml:"RjctnSts"`
 }
 
+func (i *InstructionStatus6Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionStatus6Choice) AddProcessingStatus() *InstructionProcessingStatus3 {
 	i.ProcessingStatus = new(InstructionProcessingStatus3)
 	return i.ProcessingStatus
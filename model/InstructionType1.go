@@ -7,6 +7,10 @@ type InstructionType1 struct {
 	Type *InstructionType1Code `xml:"Tp"`
 }
 
+func (i *InstructionType1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionType1) SetType(value string) {
 	i.Type = (*InstructionType1Code)(&value)
 }
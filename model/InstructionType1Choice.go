@@ -10,6 +10,10 @@ type InstructionType1Choice struct {
 	InstructionCancellationIdentification *MessageIdentification `xml:"InstrCxlId"`
 }
 
+func (i *InstructionType1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionType1Choice) AddInstructionIdentification() *MessageIdentification {
 	i.InstructionIdentification = new(MessageIdentification)
 	return i.InstructionIdentification
@@ -7,6 +7,10 @@ type InstructionType3 struct {
 	Type *InstructionType3Code `xml:"Tp"`
 }
 
+func (i *InstructionType3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionType3) SetType(value string) {
 	i.Type = (*InstructionType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type InstructionTypeStatus1Choice struct {
 	CancellationStatus *CancellationStatus2Choice `xml:"CxlSts"`
 }
 
+func (i *InstructionTypeStatus1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionTypeStatus1Choice) AddInstructionStatus() *InstructionStatus3Choice {
 	i.InstructionStatus = new(InstructionStatus3Choice)
 	return i.InstructionStatus
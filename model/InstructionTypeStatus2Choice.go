@@ -10,6 +10,10 @@ type InstructionTypeStatus2Choice struct {
 	CancellationStatus *CancellationStatus2Choice `xml:"CxlSts"`
 }
 
+func (i *InstructionTypeStatus2Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InstructionTypeStatus2Choice) AddInstructionStatus() *InstructionStatus5Choice {
 	i.InstructionStatus = new(InstructionStatus5Choice)
 	return i.InstructionStatus
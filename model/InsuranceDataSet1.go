@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Formal document used to record a fact and used as proof of the fact that goods have been insured under an insurance policy.
 type InsuranceDataSet1 struct {
 
@@ -46,6 +52,10 @@ type InsuranceDataSet1 struct {
 	ClaimsPayableIn *CurrencyCode `xml:"ClmsPyblIn,omitempty"`
 }
 
+func (i *InsuranceDataSet1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InsuranceDataSet1) AddDataSetIdentification() *DocumentIdentification1 {
 	i.DataSetIdentification = new(DocumentIdentification1)
 	return i.DataSetIdentification
@@ -60,10 +70,20 @@ func (i *InsuranceDataSet1) SetIssueDate(value string) {
 	i.IssueDate = (*ISODate)(&value)
 }
 
+func (i *InsuranceDataSet1) SetIssueDateFromTime(value time.Time) {
+	i.IssueDate = new(ISODate)
+	i.IssueDate.FromTime(value)
+}
+
 func (i *InsuranceDataSet1) SetEffectiveDate(value string) {
 	i.EffectiveDate = (*ISODate)(&value)
 }
 
+func (i *InsuranceDataSet1) SetEffectiveDateFromTime(value time.Time) {
+	i.EffectiveDate = new(ISODate)
+	i.EffectiveDate.FromTime(value)
+}
+
 func (i *InsuranceDataSet1) AddPlaceOfIssue() *PostalAddress5 {
 	i.PlaceOfIssue = new(PostalAddress5)
 	return i.PlaceOfIssue
@@ -82,6 +102,10 @@ func (i *InsuranceDataSet1) SetInsuredAmount(value, currency string) {
 	i.InsuredAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (i *InsuranceDataSet1) SetInsuredAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.InsuredAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InsuranceDataSet1) SetInsuredGoodsDescription(value string) {
 	i.InsuredGoodsDescription = (*Max70Text)(&value)
 }
@@ -10,6 +10,10 @@ type InsuranceType1Choice struct {
 	Proprietary *GenericIdentification41 `xml:"Prtry"`
 }
 
+func (i *InsuranceType1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InsuranceType1Choice) SetCode(value string) {
 	i.Code = (*Insurance1Code)(&value)
 }
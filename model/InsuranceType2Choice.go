@@ -10,6 +10,10 @@ type InsuranceType2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *InsuranceType2Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InsuranceType2Choice) SetCode(value string) {
 	i.Code = (*Insurance1Code)(&value)
 }
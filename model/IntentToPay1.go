@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the details of an intention to pay based on purchase orders or commercial invoice.
 type IntentToPay1 struct {
 
@@ -16,6 +20,10 @@ type IntentToPay1 struct {
 	SettlementTerms *SettlementTerms2 `xml:"SttlmTerms,omitempty"`
 }
 
+func (i *IntentToPay1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntentToPay1) AddByPurchaseOrder() *ReportLine3 {
 	i.ByPurchaseOrder = new(ReportLine3)
 	return i.ByPurchaseOrder
@@ -30,6 +38,11 @@ func (i *IntentToPay1) SetExpectedPaymentDate(value string) {
 	i.ExpectedPaymentDate = (*ISODate)(&value)
 }
 
+func (i *IntentToPay1) SetExpectedPaymentDateFromTime(value time.Time) {
+	i.ExpectedPaymentDate = new(ISODate)
+	i.ExpectedPaymentDate.FromTime(value)
+}
+
 func (i *IntentToPay1) AddSettlementTerms() *SettlementTerms2 {
 	i.SettlementTerms = new(SettlementTerms2)
 	return i.SettlementTerms
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the details of an intention to pay based on purchase orders or commercial invoice.
 type IntentToPay2 struct {
 
@@ -13,6 +17,10 @@ type IntentToPay2 struct {
 	SettlementTerms *SettlementTerms3 `xml:"SttlmTerms,omitempty"`
 }
 
+func (i *IntentToPay2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntentToPay2) AddBreakdown() *BreakDown1Choice {
 	i.Breakdown = new(BreakDown1Choice)
 	return i.Breakdown
@@ -22,6 +30,11 @@ func (i *IntentToPay2) SetExpectedPaymentDate(value string) {
 	i.ExpectedPaymentDate = (*ISODate)(&value)
 }
 
+func (i *IntentToPay2) SetExpectedPaymentDateFromTime(value time.Time) {
+	i.ExpectedPaymentDate = new(ISODate)
+	i.ExpectedPaymentDate.FromTime(value)
+}
+
 func (i *IntentToPay2) AddSettlementTerms() *SettlementTerms3 {
 	i.SettlementTerms = new(SettlementTerms3)
 	return i.SettlementTerms
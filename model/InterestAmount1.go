@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the elements related to the interest amount calculation.
 type InterestAmount1 struct {
 
@@ -52,6 +58,10 @@ type InterestAmount1 struct {
 	ReferenceDetails *Reference20 `xml:"RefDtls,omitempty"`
 }
 
+func (i *InterestAmount1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestAmount1) SetInterestRequestSequence(value string) {
 	i.InterestRequestSequence = (*InterestRequestSequence1Code)(&value)
 }
@@ -65,10 +75,19 @@ func (i *InterestAmount1) SetAccruedInterestAmount(value, currency string) {
 	i.AccruedInterestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InterestAmount1) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.AccruedInterestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InterestAmount1) SetValueDate(value string) {
 	i.ValueDate = (*ISODate)(&value)
 }
 
+func (i *InterestAmount1) SetValueDateFromTime(value time.Time) {
+	i.ValueDate = new(ISODate)
+	i.ValueDate.FromTime(value)
+}
+
 func (i *InterestAmount1) SetInterestMethod(value string) {
 	i.InterestMethod = (*InterestMethod1Code)(&value)
 }
@@ -86,6 +105,11 @@ func (i *InterestAmount1) SetAppliedWithholdingTax(value string) {
 	i.AppliedWithholdingTax = (*YesNoIndicator)(&value)
 }
 
+func (i *InterestAmount1) SetAppliedWithholdingTaxFromBool(value bool) {
+	i.AppliedWithholdingTax = new(YesNoIndicator)
+	i.AppliedWithholdingTax.FromBool(value)
+}
+
 func (i *InterestAmount1) SetCalculationMethod(value string) {
 	i.CalculationMethod = (*CalculationMethod1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the elements related to the interest amount calculation.
 type InterestAmount2 struct {
 
@@ -46,10 +50,18 @@ type InterestAmount2 struct {
 	AdditionalInformation *Max210Text `xml:"AddtlInf,omitempty"`
 }
 
+func (i *InterestAmount2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestAmount2) SetAccruedInterestAmount(value, currency string) {
 	i.AccruedInterestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InterestAmount2) SetAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.AccruedInterestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InterestAmount2) AddValueDate() *DateAndDateTimeChoice {
 	i.ValueDate = new(DateAndDateTimeChoice)
 	return i.ValueDate
@@ -77,6 +89,11 @@ func (i *InterestAmount2) SetAppliedWithholdingTax(value string) {
 	i.AppliedWithholdingTax = (*YesNoIndicator)(&value)
 }
 
+func (i *InterestAmount2) SetAppliedWithholdingTaxFromBool(value bool) {
+	i.AppliedWithholdingTax = new(YesNoIndicator)
+	i.AppliedWithholdingTax.FromBool(value)
+}
+
 func (i *InterestAmount2) SetCalculationMethod(value string) {
 	i.CalculationMethod = (*CalculationMethod1Code)(&value)
 }
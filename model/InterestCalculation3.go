@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the details of the interest calculation.
 type InterestCalculation3 struct {
 
@@ -37,10 +43,19 @@ type InterestCalculation3 struct {
 	AggregatedInterestAmount *ActiveCurrencyAndAmount `xml:"AggtdIntrstAmt,omitempty"`
 }
 
+func (i *InterestCalculation3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestCalculation3) SetCalculationDate(value string) {
 	i.CalculationDate = (*ISODate)(&value)
 }
 
+func (i *InterestCalculation3) SetCalculationDateFromTime(value time.Time) {
+	i.CalculationDate = new(ISODate)
+	i.CalculationDate.FromTime(value)
+}
+
 func (i *InterestCalculation3) AddCollateralAccountIdentification() *AccountIdentification26 {
 	i.CollateralAccountIdentification = new(AccountIdentification26)
 	return i.CollateralAccountIdentification
@@ -65,18 +80,38 @@ func (i *InterestCalculation3) SetNumberOfDays(value string) {
 	i.NumberOfDays = (*Number)(&value)
 }
 
+func (i *InterestCalculation3) SetNumberOfDaysFromDecimal(value decimal.Decimal) {
+	i.NumberOfDays = new(Number)
+	i.NumberOfDays.FromDecimal(value)
+}
+
 func (i *InterestCalculation3) SetEffectiveRate(value string) {
 	i.EffectiveRate = (*PercentageRate)(&value)
 }
 
+func (i *InterestCalculation3) SetEffectiveRateFromDecimal(value decimal.Decimal) {
+	i.EffectiveRate = new(PercentageRate)
+	i.EffectiveRate.FromDecimal(value)
+}
+
 func (i *InterestCalculation3) SetInterestRate(value string) {
 	i.InterestRate = (*PercentageRate)(&value)
 }
 
+func (i *InterestCalculation3) SetInterestRateFromDecimal(value decimal.Decimal) {
+	i.InterestRate = new(PercentageRate)
+	i.InterestRate.FromDecimal(value)
+}
+
 func (i *InterestCalculation3) SetSpread(value string) {
 	i.Spread = (*PercentageRate)(&value)
 }
 
+func (i *InterestCalculation3) SetSpreadFromDecimal(value decimal.Decimal) {
+	i.Spread = new(PercentageRate)
+	i.Spread.FromDecimal(value)
+}
+
 func (i *InterestCalculation3) AddAccruedInterestAmount() *AmountAndDirection20 {
 	i.AccruedInterestAmount = new(AmountAndDirection20)
 	return i.AccruedInterestAmount
@@ -85,3 +120,7 @@ func (i *InterestCalculation3) AddAccruedInterestAmount() *AmountAndDirection20
 func (i *InterestCalculation3) SetAggregatedInterestAmount(value, currency string) {
 	i.AggregatedInterestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (i *InterestCalculation3) SetAggregatedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.AggregatedInterestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the details of the interest calculation.
 type InterestCalculation4 struct {
 
@@ -37,10 +43,19 @@ type InterestCalculation4 struct {
 	AggregatedInterestAmount *ActiveCurrencyAndAmount `xml:"AggtdIntrstAmt,omitempty"`
 }
 
+func (i *InterestCalculation4) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestCalculation4) SetCalculationDate(value string) {
 	i.CalculationDate = (*ISODate)(&value)
 }
 
+func (i *InterestCalculation4) SetCalculationDateFromTime(value time.Time) {
+	i.CalculationDate = new(ISODate)
+	i.CalculationDate.FromTime(value)
+}
+
 func (i *InterestCalculation4) AddCollateralAccountIdentification() *CollateralAccount2 {
 	i.CollateralAccountIdentification = new(CollateralAccount2)
 	return i.CollateralAccountIdentification
@@ -65,18 +80,38 @@ func (i *InterestCalculation4) SetNumberOfDays(value string) {
 	i.NumberOfDays = (*Number)(&value)
 }
 
+func (i *InterestCalculation4) SetNumberOfDaysFromDecimal(value decimal.Decimal) {
+	i.NumberOfDays = new(Number)
+	i.NumberOfDays.FromDecimal(value)
+}
+
 func (i *InterestCalculation4) SetEffectiveRate(value string) {
 	i.EffectiveRate = (*PercentageRate)(&value)
 }
 
+func (i *InterestCalculation4) SetEffectiveRateFromDecimal(value decimal.Decimal) {
+	i.EffectiveRate = new(PercentageRate)
+	i.EffectiveRate.FromDecimal(value)
+}
+
 func (i *InterestCalculation4) SetInterestRate(value string) {
 	i.InterestRate = (*PercentageRate)(&value)
 }
 
+func (i *InterestCalculation4) SetInterestRateFromDecimal(value decimal.Decimal) {
+	i.InterestRate = new(PercentageRate)
+	i.InterestRate.FromDecimal(value)
+}
+
 func (i *InterestCalculation4) SetSpread(value string) {
 	i.Spread = (*PercentageRate)(&value)
 }
 
+func (i *InterestCalculation4) SetSpreadFromDecimal(value decimal.Decimal) {
+	i.Spread = new(PercentageRate)
+	i.Spread.FromDecimal(value)
+}
+
 func (i *InterestCalculation4) AddAccruedInterestAmount() *AmountAndDirection20 {
 	i.AccruedInterestAmount = new(AmountAndDirection20)
 	return i.AccruedInterestAmount
@@ -85,3 +120,7 @@ func (i *InterestCalculation4) AddAccruedInterestAmount() *AmountAndDirection20
 func (i *InterestCalculation4) SetAggregatedInterestAmount(value, currency string) {
 	i.AggregatedInterestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (i *InterestCalculation4) SetAggregatedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.AggregatedInterestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
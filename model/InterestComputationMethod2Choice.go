@@ -10,6 +10,10 @@ type InterestComputationMethod2Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (i *InterestComputationMethod2Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestComputationMethod2Choice) SetCode(value string) {
 	i.Code = (*InterestComputationMethod1Code)(&value)
 }
@@ -10,6 +10,10 @@ type InterestComputationMethodFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (i *InterestComputationMethodFormat1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestComputationMethodFormat1Choice) SetCode(value string) {
 	i.Code = (*InterestComputationMethod2Code)(&value)
 }
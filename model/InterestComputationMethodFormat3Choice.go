@@ -10,6 +10,10 @@ type InterestComputationMethodFormat3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (i *InterestComputationMethodFormat3Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestComputationMethodFormat3Choice) SetCode(value string) {
 	i.Code = (*InterestComputationMethod2Code)(&value)
 }
@@ -10,6 +10,10 @@ type InterestComputationMethodFormat4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (i *InterestComputationMethodFormat4Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestComputationMethodFormat4Choice) SetCode(value string) {
 	i.Code = (*InterestComputationMethod2Code)(&value)
 }
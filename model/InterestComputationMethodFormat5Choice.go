@@ -10,6 +10,10 @@ type InterestComputationMethodFormat5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *InterestComputationMethodFormat5Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestComputationMethodFormat5Choice) SetCode(value string) {
 	i.Code = (*InterestComputationMethod2Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies an expected date and a due date for the interest payment.
 type InterestPaymentDateRange1 struct {
 
@@ -13,6 +17,10 @@ type InterestPaymentDateRange1 struct {
 	DueDate *ISODate `xml:"DueDt,omitempty"`
 }
 
+func (i *InterestPaymentDateRange1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestPaymentDateRange1) SetInterestScheduleIdentification(value string) {
 	i.InterestScheduleIdentification = (*Max35Text)(&value)
 }
@@ -21,6 +29,16 @@ func (i *InterestPaymentDateRange1) SetExpectedDate(value string) {
 	i.ExpectedDate = (*ISODate)(&value)
 }
 
+func (i *InterestPaymentDateRange1) SetExpectedDateFromTime(value time.Time) {
+	i.ExpectedDate = new(ISODate)
+	i.ExpectedDate.FromTime(value)
+}
+
 func (i *InterestPaymentDateRange1) SetDueDate(value string) {
 	i.DueDate = (*ISODate)(&value)
 }
+
+func (i *InterestPaymentDateRange1) SetDueDateFromTime(value time.Time) {
+	i.DueDate = new(ISODate)
+	i.DueDate.FromTime(value)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies an interest payment schedule, that is an amount that must be paid no sooner than the expected payment date and no later than the due date.
 type InterestPaymentDateRange2 struct {
 
@@ -16,6 +22,10 @@ type InterestPaymentDateRange2 struct {
 	AdditionalInformation *Max1025Text `xml:"AddtlInf,omitempty"`
 }
 
+func (i *InterestPaymentDateRange2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestPaymentDateRange2) SetInterestScheduleIdentification(value string) {
 	i.InterestScheduleIdentification = (*Max35Text)(&value)
 }
@@ -24,10 +34,19 @@ func (i *InterestPaymentDateRange2) SetAmount(value, currency string) {
 	i.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InterestPaymentDateRange2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InterestPaymentDateRange2) SetDueDate(value string) {
 	i.DueDate = (*ISODate)(&value)
 }
 
+func (i *InterestPaymentDateRange2) SetDueDateFromTime(value time.Time) {
+	i.DueDate = new(ISODate)
+	i.DueDate.FromTime(value)
+}
+
 func (i *InterestPaymentDateRange2) SetAdditionalInformation(value string) {
 	i.AdditionalInformation = (*Max1025Text)(&value)
 }
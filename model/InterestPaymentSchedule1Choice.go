@@ -10,6 +10,10 @@ type InterestPaymentSchedule1Choice struct {
 	SubSchedule []*InterestPaymentDateRange2 `xml:"SubSchdl"`
 }
 
+func (i *InterestPaymentSchedule1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestPaymentSchedule1Choice) AddDateRange() *InterestPaymentDateRange1 {
 	i.DateRange = new(InterestPaymentDateRange1)
 	return i.DateRange
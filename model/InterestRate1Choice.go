@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a fixed rate and a variable rate.
 type InterestRate1Choice struct {
 
@@ -10,10 +14,19 @@ type InterestRate1Choice struct {
 	VariableInterestRate *VariableInterest1Rate `xml:"VarblIntrstRate"`
 }
 
+func (i *InterestRate1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestRate1Choice) SetFixedInterestRate(value string) {
 	i.FixedInterestRate = (*PercentageRate)(&value)
 }
 
+func (i *InterestRate1Choice) SetFixedInterestRateFromDecimal(value decimal.Decimal) {
+	i.FixedInterestRate = new(PercentageRate)
+	i.FixedInterestRate.FromDecimal(value)
+}
+
 func (i *InterestRate1Choice) AddVariableInterestRate() *VariableInterest1Rate {
 	i.VariableInterestRate = new(VariableInterest1Rate)
 	return i.VariableInterestRate
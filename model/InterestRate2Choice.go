@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a fixed rate and a floating rate.
 type InterestRate2Choice struct {
 
@@ -10,10 +14,19 @@ type InterestRate2Choice struct {
 	Floating *FloatingInterestRate4 `xml:"Fltg"`
 }
 
+func (i *InterestRate2Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestRate2Choice) SetFixed(value string) {
 	i.Fixed = (*PercentageRate)(&value)
 }
 
+func (i *InterestRate2Choice) SetFixedFromDecimal(value decimal.Decimal) {
+	i.Fixed = new(PercentageRate)
+	i.Fixed.FromDecimal(value)
+}
+
 func (i *InterestRate2Choice) AddFloating() *FloatingInterestRate4 {
 	i.Floating = new(FloatingInterestRate4)
 	return i.Floating
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Describes how interest rates are reported.
 type InterestRateContractTerm1 struct {
 
@@ -10,6 +14,10 @@ type InterestRateContractTerm1 struct {
 	Value *Number `xml:"Val"`
 }
 
+func (i *InterestRateContractTerm1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestRateContractTerm1) SetUnit(value string) {
 	i.Unit = (*RateBasis1Code)(&value)
 }
@@ -17,3 +25,8 @@ func (i *InterestRateContractTerm1) SetUnit(value string) {
 func (i *InterestRateContractTerm1) SetValue(value string) {
 	i.Value = (*Number)(&value)
 }
+
+func (i *InterestRateContractTerm1) SetValueFromDecimal(value decimal.Decimal) {
+	i.Value = new(Number)
+	i.Value.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or a rate or an unspecified rate.
 type InterestRateUsedForPaymentFormat10Choice struct {
 
@@ -16,14 +20,27 @@ type InterestRateUsedForPaymentFormat10Choice struct {
 	NotSpecifiedRate *RateType13Code `xml:"NotSpcfdRate"`
 }
 
+func (i *InterestRateUsedForPaymentFormat10Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestRateUsedForPaymentFormat10Choice) SetRate(value string) {
 	i.Rate = (*PercentageRate)(&value)
 }
 
+func (i *InterestRateUsedForPaymentFormat10Choice) SetRateFromDecimal(value decimal.Decimal) {
+	i.Rate = new(PercentageRate)
+	i.Rate.FromDecimal(value)
+}
+
 func (i *InterestRateUsedForPaymentFormat10Choice) SetAmount(value, currency string) {
 	i.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (i *InterestRateUsedForPaymentFormat10Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (i *InterestRateUsedForPaymentFormat10Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus32 {
 	i.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus32)
 	return i.RateTypeAndAmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or a rate.
 type InterestRateUsedForPaymentFormat2Choice struct {
 
@@ -13,14 +17,27 @@ type InterestRateUsedForPaymentFormat2Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus3 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (i *InterestRateUsedForPaymentFormat2Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestRateUsedForPaymentFormat2Choice) SetRate(value string) {
 	i.Rate = (*PercentageRate)(&value)
 }
 
+func (i *InterestRateUsedForPaymentFormat2Choice) SetRateFromDecimal(value decimal.Decimal) {
+	i.Rate = new(PercentageRate)
+	i.Rate.FromDecimal(value)
+}
+
 func (i *InterestRateUsedForPaymentFormat2Choice) SetAmount(value, currency string) {
 	i.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (i *InterestRateUsedForPaymentFormat2Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (i *InterestRateUsedForPaymentFormat2Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus3 {
 	i.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus3)
 	return i.RateTypeAndAmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or a rate or an unspecified rate.
 type InterestRateUsedForPaymentFormat5Choice struct {
 
@@ -16,14 +20,27 @@ type InterestRateUsedForPaymentFormat5Choice struct {
 	NotSpecifiedRate *RateType13Code `xml:"NotSpcfdRate"`
 }
 
+func (i *InterestRateUsedForPaymentFormat5Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestRateUsedForPaymentFormat5Choice) SetRate(value string) {
 	i.Rate = (*PercentageRate)(&value)
 }
 
+func (i *InterestRateUsedForPaymentFormat5Choice) SetRateFromDecimal(value decimal.Decimal) {
+	i.Rate = new(PercentageRate)
+	i.Rate.FromDecimal(value)
+}
+
 func (i *InterestRateUsedForPaymentFormat5Choice) SetAmount(value, currency string) {
 	i.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (i *InterestRateUsedForPaymentFormat5Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (i *InterestRateUsedForPaymentFormat5Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus3 {
 	i.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus3)
 	return i.RateTypeAndAmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or a rate.
 type InterestRateUsedForPaymentFormat7Choice struct {
 
@@ -13,14 +17,27 @@ type InterestRateUsedForPaymentFormat7Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus24 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (i *InterestRateUsedForPaymentFormat7Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestRateUsedForPaymentFormat7Choice) SetRate(value string) {
 	i.Rate = (*PercentageRate)(&value)
 }
 
+func (i *InterestRateUsedForPaymentFormat7Choice) SetRateFromDecimal(value decimal.Decimal) {
+	i.Rate = new(PercentageRate)
+	i.Rate.FromDecimal(value)
+}
+
 func (i *InterestRateUsedForPaymentFormat7Choice) SetAmount(value, currency string) {
 	i.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (i *InterestRateUsedForPaymentFormat7Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (i *InterestRateUsedForPaymentFormat7Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus24 {
 	i.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus24)
 	return i.RateTypeAndAmountAndRateStatus
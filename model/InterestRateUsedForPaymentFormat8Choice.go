@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or a rate or an unspecified rate.
 type InterestRateUsedForPaymentFormat8Choice struct {
 
@@ -16,14 +20,27 @@ type InterestRateUsedForPaymentFormat8Choice struct {
 	NotSpecifiedRate *RateType13Code `xml:"NotSpcfdRate"`
 }
 
+func (i *InterestRateUsedForPaymentFormat8Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestRateUsedForPaymentFormat8Choice) SetRate(value string) {
 	i.Rate = (*PercentageRate)(&value)
 }
 
+func (i *InterestRateUsedForPaymentFormat8Choice) SetRateFromDecimal(value decimal.Decimal) {
+	i.Rate = new(PercentageRate)
+	i.Rate.FromDecimal(value)
+}
+
 func (i *InterestRateUsedForPaymentFormat8Choice) SetAmount(value, currency string) {
 	i.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (i *InterestRateUsedForPaymentFormat8Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (i *InterestRateUsedForPaymentFormat8Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus24 {
 	i.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus24)
 	return i.RateTypeAndAmountAndRateStatus
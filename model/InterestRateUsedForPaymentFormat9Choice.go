@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or a rate.
 type InterestRateUsedForPaymentFormat9Choice struct {
 
@@ -13,14 +17,27 @@ type InterestRateUsedForPaymentFormat9Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus32 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (i *InterestRateUsedForPaymentFormat9Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestRateUsedForPaymentFormat9Choice) SetRate(value string) {
 	i.Rate = (*PercentageRate)(&value)
 }
 
+func (i *InterestRateUsedForPaymentFormat9Choice) SetRateFromDecimal(value decimal.Decimal) {
+	i.Rate = new(PercentageRate)
+	i.Rate.FromDecimal(value)
+}
+
 func (i *InterestRateUsedForPaymentFormat9Choice) SetAmount(value, currency string) {
 	i.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (i *InterestRateUsedForPaymentFormat9Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (i *InterestRateUsedForPaymentFormat9Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus32 {
 	i.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus32)
 	return i.RateTypeAndAmountAndRateStatus
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides transaction specific interest information that applies to the underlying transaction.
 type InterestRecord1 struct {
 
@@ -26,12 +31,24 @@ type InterestRecord1 struct {
 	Tax *TaxCharges2 `xml:"Tax,omitempty"`
 }
 
+func (i *InterestRecord1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestRecord1) SetAmount(value, currency string) {
 	i.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (i *InterestRecord1) SetCreditDebitIndicator(value string) {
+func (i *InterestRecord1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (i *InterestRecord1) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	i.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (i *InterestRecord1) AddType() *InterestType1Choice {
@@ -57,3 +74,89 @@ func (i *InterestRecord1) AddTax() *TaxCharges2 {
 	i.Tax = new(TaxCharges2)
 	return i.Tax
 }
+
+func (i *InterestRecord1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if i.Amount != nil {
+		i.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if i.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*i.CreditDebitIndicator))
+	}
+	if i.Type != nil {
+		i.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if i.Rate != nil {
+		i.Rate.EncodeXMLFast(enc, "Rate")
+	}
+	if i.FromToDate != nil {
+		i.FromToDate.EncodeXMLFast(enc, "FrToDt")
+	}
+	if i.Reason != nil {
+		enc.Element("Rsn", string(*i.Reason))
+	}
+	if i.Tax != nil {
+		i.Tax.EncodeXMLFast(enc, "Tax")
+	}
+	enc.End(tag)
+}
+
+func (i *InterestRecord1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.Amount = elem
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			i.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		case "Tp":
+			elem := new(InterestType1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.Type = elem
+		case "Rate":
+			elem := new(Rate3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.Rate = elem
+		case "FrToDt":
+			elem := new(DateTimePeriodDetails)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.FromToDate = elem
+		case "Rsn":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			i.Reason = (*Max35Text)(&value)
+		case "Tax":
+			elem := new(TaxCharges2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.Tax = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
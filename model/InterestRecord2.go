@@ -0,0 +1,162 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Provides transaction specific interest information that applies to the underlying transaction.
+type InterestRecord2 struct {
+
+	// Amount of interest included in the entry amount.
+	Amount *ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+
+	// Indicates whether the interest amount included in the entry is credit or debit amount.
+	// Usage: A zero amount is considered to be a credit.
+	CreditDebitIndicator *CreditDebitCode `xml:"CdtDbtInd"`
+
+	// Specifies the type of interest.
+	Type *InterestType1Choice `xml:"Tp,omitempty"`
+
+	// Set of elements used to qualify the interest rate.
+	Rate *Rate4 `xml:"Rate,omitempty"`
+
+	// Range of time between a start date and an end date for the calculation of the interest.
+	FromToDate *DateTimePeriod1 `xml:"FrToDt,omitempty"`
+
+	// Specifies the reason for the interest.
+	Reason *Max35Text `xml:"Rsn,omitempty"`
+
+	// Provides details on the tax applied to charges.
+	Tax *TaxCharges2 `xml:"Tax,omitempty"`
+}
+
+func (i *InterestRecord2) Validate() error {
+	return ValidateElement(i)
+}
+
+func (i *InterestRecord2) SetAmount(value, currency string) {
+	i.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (i *InterestRecord2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (i *InterestRecord2) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
+	i.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
+}
+
+func (i *InterestRecord2) AddType() *InterestType1Choice {
+	i.Type = new(InterestType1Choice)
+	return i.Type
+}
+
+func (i *InterestRecord2) AddRate() *Rate4 {
+	i.Rate = new(Rate4)
+	return i.Rate
+}
+
+func (i *InterestRecord2) AddFromToDate() *DateTimePeriod1 {
+	i.FromToDate = new(DateTimePeriod1)
+	return i.FromToDate
+}
+
+func (i *InterestRecord2) SetReason(value string) {
+	i.Reason = (*Max35Text)(&value)
+}
+
+func (i *InterestRecord2) AddTax() *TaxCharges2 {
+	i.Tax = new(TaxCharges2)
+	return i.Tax
+}
+
+func (i *InterestRecord2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if i.Amount != nil {
+		i.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if i.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*i.CreditDebitIndicator))
+	}
+	if i.Type != nil {
+		i.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if i.Rate != nil {
+		i.Rate.EncodeXMLFast(enc, "Rate")
+	}
+	if i.FromToDate != nil {
+		i.FromToDate.EncodeXMLFast(enc, "FrToDt")
+	}
+	if i.Reason != nil {
+		enc.Element("Rsn", string(*i.Reason))
+	}
+	if i.Tax != nil {
+		i.Tax.EncodeXMLFast(enc, "Tax")
+	}
+	enc.End(tag)
+}
+
+func (i *InterestRecord2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.Amount = elem
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			i.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		case "Tp":
+			elem := new(InterestType1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.Type = elem
+		case "Rate":
+			elem := new(Rate4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.Rate = elem
+		case "FrToDt":
+			elem := new(DateTimePeriod1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.FromToDate = elem
+		case "Rsn":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			i.Reason = (*Max35Text)(&value)
+		case "Tax":
+			elem := new(TaxCharges2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			i.Tax = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
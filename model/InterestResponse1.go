@@ -16,6 +16,10 @@ type InterestResponse1 struct {
 	InterestPaymentRequestIdentification *Max35Text `xml:"IntrstPmtReqId"`
 }
 
+func (i *InterestResponse1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestResponse1) SetResponseType(value string) {
 	i.ResponseType = (*Status4Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Consideration, such as amount of money,  paid or received in exchange for an amount of money that has been invested, loaned or borrowed for a certain period.
 type InterestResult1 struct {
 
@@ -22,18 +28,35 @@ type InterestResult1 struct {
 	ClosingCollateralBalance *CollateralBalance1 `xml:"ClsgCollBal"`
 }
 
+func (i *InterestResult1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestResult1) SetInterestDueToA(value, currency string) {
 	i.InterestDueToA = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InterestResult1) SetInterestDueToAFromDecimal(value decimal.Decimal, currency string) {
+	i.InterestDueToA = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InterestResult1) SetInterestDueToB(value, currency string) {
 	i.InterestDueToB = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InterestResult1) SetInterestDueToBFromDecimal(value decimal.Decimal, currency string) {
+	i.InterestDueToB = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InterestResult1) SetValueDate(value string) {
 	i.ValueDate = (*ISODate)(&value)
 }
 
+func (i *InterestResult1) SetValueDateFromTime(value time.Time) {
+	i.ValueDate = new(ISODate)
+	i.ValueDate.FromTime(value)
+}
+
 func (i *InterestResult1) SetInterestMethod(value string) {
 	i.InterestMethod = (*InterestMethod1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the interest statement.
 type InterestStatement3 struct {
 
@@ -22,6 +28,10 @@ type InterestStatement3 struct {
 	InterestCalculation []*InterestCalculation3 `xml:"IntrstClctn,omitempty"`
 }
 
+func (i *InterestStatement3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InterestStatement3) AddInterestPeriod() *DatePeriodDetails {
 	i.InterestPeriod = new(DatePeriodDetails)
 	return i.InterestPeriod
@@ -31,14 +41,27 @@ func (i *InterestStatement3) SetTotalInterestAmountDueToA(value, currency string
 	i.TotalInterestAmountDueToA = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InterestStatement3) SetTotalInterestAmountDueToAFromDecimal(value decimal.Decimal, currency string) {
+	i.TotalInterestAmountDueToA = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InterestStatement3) SetTotalInterestAmountDueToB(value, currency string) {
 	i.TotalInterestAmountDueToB = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InterestStatement3) SetTotalInterestAmountDueToBFromDecimal(value decimal.Decimal, currency string) {
+	i.TotalInterestAmountDueToB = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InterestStatement3) SetValueDate(value string) {
 	i.ValueDate = (*ISODate)(&value)
 }
 
+func (i *InterestStatement3) SetValueDateFromTime(value time.Time) {
+	i.ValueDate = new(ISODate)
+	i.ValueDate.FromTime(value)
+}
+
 func (i *InterestStatement3) SetInterestPaymentRequestIdentification(value string) {
 	i.InterestPaymentRequestIdentification = (*Max35Text)(&value)
 }
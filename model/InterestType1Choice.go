@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Interest type is expressed as a code or a text.
 type InterestType1Choice struct {
 
@@ -10,10 +12,59 @@ type InterestType1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
-func (i *InterestType1Choice) SetCode(value string) {
+func (i *InterestType1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
+func (i *InterestType1Choice) SetCode(value string) error {
+	if err := InterestType1Code(value).Validate(); err != nil {
+		return err
+	}
 	i.Code = (*InterestType1Code)(&value)
+	return nil
 }
 
 func (i *InterestType1Choice) SetProprietary(value string) {
 	i.Proprietary = (*Max35Text)(&value)
 }
+
+func (i *InterestType1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if i.Code != nil {
+		enc.Element("Cd", string(*i.Code))
+	}
+	if i.Proprietary != nil {
+		enc.Element("Prtry", string(*i.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (i *InterestType1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			i.Code = (*InterestType1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			i.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,3 +1,26 @@
 package model
 
 type InterestType1Code string
+
+const (
+	InterestType1CodeINDY InterestType1Code = "INDY"
+	InterestType1CodeOVRN InterestType1Code = "OVRN"
+)
+
+var interestType1CodeDescriptions = map[InterestType1Code]string{
+	InterestType1CodeINDY: "Intra day: during or within a business day.",
+	InterestType1CodeOVRN: "Overnight: period of time between the end of a business day and the start of the next business day.",
+}
+
+func (i InterestType1Code) IsValid() bool {
+	_, ok := interestType1CodeDescriptions[i]
+	return ok
+}
+
+func (i InterestType1Code) Description() string {
+	return interestType1CodeDescriptions[i]
+}
+
+func (i InterestType1Code) Validate() error {
+	return validateEnumeration("InterestType1Code", string(i), i.IsValid(), "INDY, OVRN")
+}
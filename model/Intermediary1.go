@@ -13,6 +13,10 @@ type Intermediary1 struct {
 	Role *Max35Text `xml:"Role,omitempty"`
 }
 
+func (i *Intermediary1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary1) AddIdentification() *PartyIdentification1Choice {
 	i.Identification = new(PartyIdentification1Choice)
 	return i.Identification
@@ -19,6 +19,10 @@ type Intermediary10 struct {
 	ContactPerson *ContactIdentification2 `xml:"CtctPrsn,omitempty"`
 }
 
+func (i *Intermediary10) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary10) AddIdentification() *PartyIdentification2Choice {
 	i.Identification = new(PartyIdentification2Choice)
 	return i.Identification
@@ -16,6 +16,10 @@ type Intermediary11 struct {
 	ExtendedRole *Extended350Code `xml:"XtndedRole,omitempty"`
 }
 
+func (i *Intermediary11) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary11) AddIdentification() *PartyIdentification2Choice {
 	i.Identification = new(PartyIdentification2Choice)
 	return i.Identification
@@ -28,6 +28,10 @@ type Intermediary12 struct {
 	NameAndAddress *NameAndAddress4 `xml:"NmAndAdr,omitempty"`
 }
 
+func (i *Intermediary12) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary12) AddIdentification() *PartyIdentification4Choice {
 	i.Identification = new(PartyIdentification4Choice)
 	return i.Identification
@@ -42,6 +46,11 @@ func (i *Intermediary12) SetWaivedTrailerCommissionIndicator(value string) {
 	i.WaivedTrailerCommissionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *Intermediary12) SetWaivedTrailerCommissionIndicatorFromBool(value bool) {
+	i.WaivedTrailerCommissionIndicator = new(YesNoIndicator)
+	i.WaivedTrailerCommissionIndicator.FromBool(value)
+}
+
 func (i *Intermediary12) SetRole(value string) {
 	i.Role = (*InvestmentFundRole3Code)(&value)
 }
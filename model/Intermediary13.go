@@ -28,6 +28,10 @@ type Intermediary13 struct {
 	NameAndAddress *NameAndAddress4 `xml:"NmAndAdr,omitempty"`
 }
 
+func (i *Intermediary13) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary13) AddIdentification() *PartyIdentification4Choice {
 	i.Identification = new(PartyIdentification4Choice)
 	return i.Identification
@@ -42,6 +46,11 @@ func (i *Intermediary13) SetWaivedTrailerCommissionIndicator(value string) {
 	i.WaivedTrailerCommissionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *Intermediary13) SetWaivedTrailerCommissionIndicatorFromBool(value bool) {
+	i.WaivedTrailerCommissionIndicator = new(YesNoIndicator)
+	i.WaivedTrailerCommissionIndicator.FromBool(value)
+}
+
 func (i *Intermediary13) SetRole(value string) {
 	i.Role = (*InvestmentFundRole3Code)(&value)
 }
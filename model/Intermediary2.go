@@ -13,6 +13,10 @@ type Intermediary2 struct {
 	Account *Account9 `xml:"Acct,omitempty"`
 }
 
+func (i *Intermediary2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary2) AddIdentification() *PartyIdentification10Choice {
 	i.Identification = new(PartyIdentification10Choice)
 	return i.Identification
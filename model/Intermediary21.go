@@ -13,6 +13,10 @@ type Intermediary21 struct {
 	Account *Account11 `xml:"Acct,omitempty"`
 }
 
+func (i *Intermediary21) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary21) AddIdentification() *PartyIdentification49Choice {
 	i.Identification = new(PartyIdentification49Choice)
 	return i.Identification
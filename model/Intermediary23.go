@@ -13,6 +13,10 @@ type Intermediary23 struct {
 	Account *Account13 `xml:"Acct,omitempty"`
 }
 
+func (i *Intermediary23) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary23) AddIdentification() *PartyIdentification49Choice {
 	i.Identification = new(PartyIdentification49Choice)
 	return i.Identification
@@ -25,6 +25,10 @@ type Intermediary24 struct {
 	NameAndAddress *NameAndAddress4 `xml:"NmAndAdr,omitempty"`
 }
 
+func (i *Intermediary24) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary24) AddIdentification() *PartyIdentification4Choice {
 	i.Identification = new(PartyIdentification4Choice)
 	return i.Identification
@@ -39,6 +43,11 @@ func (i *Intermediary24) SetWaivedTrailerCommissionIndicator(value string) {
 	i.WaivedTrailerCommissionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *Intermediary24) SetWaivedTrailerCommissionIndicatorFromBool(value bool) {
+	i.WaivedTrailerCommissionIndicator = new(YesNoIndicator)
+	i.WaivedTrailerCommissionIndicator.FromBool(value)
+}
+
 func (i *Intermediary24) AddRole() *PartyRole2Choice {
 	i.Role = new(PartyRole2Choice)
 	return i.Role
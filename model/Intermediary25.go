@@ -13,6 +13,10 @@ type Intermediary25 struct {
 	Role *Role4Choice `xml:"Role,omitempty"`
 }
 
+func (i *Intermediary25) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary25) AddIdentification() *PartyIdentification2Choice {
 	i.Identification = new(PartyIdentification2Choice)
 	return i.Identification
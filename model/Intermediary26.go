@@ -16,6 +16,10 @@ type Intermediary26 struct {
 	ContactPerson *ContactIdentification2 `xml:"CtctPrsn,omitempty"`
 }
 
+func (i *Intermediary26) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary26) AddIdentification() *PartyIdentification2Choice {
 	i.Identification = new(PartyIdentification2Choice)
 	return i.Identification
@@ -13,6 +13,10 @@ type Intermediary27 struct {
 	Role *Role4Choice `xml:"Role,omitempty"`
 }
 
+func (i *Intermediary27) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary27) AddIdentification() *PartyIdentification2Choice {
 	i.Identification = new(PartyIdentification2Choice)
 	return i.Identification
@@ -16,6 +16,10 @@ type Intermediary29 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *Intermediary29) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary29) AddIdentification() *PartyIdentification100 {
 	i.Identification = new(PartyIdentification100)
 	return i.Identification
@@ -13,6 +13,10 @@ type Intermediary32 struct {
 	Account *Account18 `xml:"Acct,omitempty"`
 }
 
+func (i *Intermediary32) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary32) AddIdentification() *PartyIdentification100 {
 	i.Identification = new(PartyIdentification100)
 	return i.Identification
@@ -13,6 +13,10 @@ type Intermediary33 struct {
 	Account *Account20 `xml:"Acct,omitempty"`
 }
 
+func (i *Intermediary33) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary33) AddIdentification() *PartyIdentification70Choice {
 	i.Identification = new(PartyIdentification70Choice)
 	return i.Identification
@@ -16,6 +16,10 @@ type Intermediary34 struct {
 	ContactPerson *ContactIdentification2 `xml:"CtctPrsn,omitempty"`
 }
 
+func (i *Intermediary34) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary34) AddIdentification() *PartyIdentification70Choice {
 	i.Identification = new(PartyIdentification70Choice)
 	return i.Identification
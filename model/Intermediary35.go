@@ -13,6 +13,10 @@ type Intermediary35 struct {
 	Role *Role4Choice `xml:"Role,omitempty"`
 }
 
+func (i *Intermediary35) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary35) AddIdentification() *PartyIdentification70Choice {
 	i.Identification = new(PartyIdentification70Choice)
 	return i.Identification
@@ -28,6 +28,10 @@ type Intermediary36 struct {
 	NameAndAddress *NameAndAddress4 `xml:"NmAndAdr,omitempty"`
 }
 
+func (i *Intermediary36) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary36) AddIdentification() *PartyIdentification72Choice {
 	i.Identification = new(PartyIdentification72Choice)
 	return i.Identification
@@ -46,6 +50,11 @@ func (i *Intermediary36) SetWaivedTrailerCommissionIndicator(value string) {
 	i.WaivedTrailerCommissionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *Intermediary36) SetWaivedTrailerCommissionIndicatorFromBool(value bool) {
+	i.WaivedTrailerCommissionIndicator = new(YesNoIndicator)
+	i.WaivedTrailerCommissionIndicator.FromBool(value)
+}
+
 func (i *Intermediary36) AddRole() *PartyRole2Choice {
 	i.Role = new(PartyRole2Choice)
 	return i.Role
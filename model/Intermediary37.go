@@ -13,6 +13,10 @@ type Intermediary37 struct {
 	Account *Account21 `xml:"Acct,omitempty"`
 }
 
+func (i *Intermediary37) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary37) AddIdentification() *PartyIdentification111 {
 	i.Identification = new(PartyIdentification111)
 	return i.Identification
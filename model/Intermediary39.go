@@ -19,6 +19,10 @@ type Intermediary39 struct {
 	Role *InvestmentFundRole2Choice `xml:"Role,omitempty"`
 }
 
+func (i *Intermediary39) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary39) AddIdentification() *PartyIdentification113 {
 	i.Identification = new(PartyIdentification113)
 	return i.Identification
@@ -13,6 +13,10 @@ type Intermediary4 struct {
 	Role *Max35Text `xml:"Role,omitempty"`
 }
 
+func (i *Intermediary4) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary4) AddIdentification() *PartyIdentification2Choice {
 	i.Identification = new(PartyIdentification2Choice)
 	return i.Identification
@@ -16,6 +16,10 @@ type Intermediary40 struct {
 	Role *InvestmentFundRole2Choice `xml:"Role,omitempty"`
 }
 
+func (i *Intermediary40) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary40) AddIdentification() *PartyIdentification113 {
 	i.Identification = new(PartyIdentification113)
 	return i.Identification
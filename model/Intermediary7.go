@@ -10,6 +10,10 @@ type Intermediary7 struct {
 	Account *Account2 `xml:"Acct,omitempty"`
 }
 
+func (i *Intermediary7) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary7) AddIdentification() *PartyIdentification2Choice {
 	i.Identification = new(PartyIdentification2Choice)
 	return i.Identification
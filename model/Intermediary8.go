@@ -19,6 +19,10 @@ type Intermediary8 struct {
 	ExtendedRole *Extended350Code `xml:"XtndedRole,omitempty"`
 }
 
+func (i *Intermediary8) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary8) AddIdentification() *PartyIdentification2Choice {
 	i.Identification = new(PartyIdentification2Choice)
 	return i.Identification
@@ -22,6 +22,10 @@ type Intermediary9 struct {
 	ExtendedRole *Extended350Code `xml:"XtndedRole,omitempty"`
 }
 
+func (i *Intermediary9) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *Intermediary9) AddIdentification() *PartyIdentification2Choice {
 	i.Identification = new(PartyIdentification2Choice)
 	return i.Identification
@@ -10,6 +10,10 @@ type IntermediateSecuritiesDistributionTypeFormat15Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (i *IntermediateSecuritiesDistributionTypeFormat15Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntermediateSecuritiesDistributionTypeFormat15Choice) SetCode(value string) {
 	i.Code = (*IntermediateSecurityDistributionType5Code)(&value)
 }
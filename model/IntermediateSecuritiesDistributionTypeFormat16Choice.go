@@ -10,6 +10,10 @@ type IntermediateSecuritiesDistributionTypeFormat16Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (i *IntermediateSecuritiesDistributionTypeFormat16Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntermediateSecuritiesDistributionTypeFormat16Choice) SetCode(value string) {
 	i.Code = (*IntermediateSecurityDistributionType4Code)(&value)
 }
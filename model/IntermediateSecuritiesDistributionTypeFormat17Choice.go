@@ -10,6 +10,10 @@ type IntermediateSecuritiesDistributionTypeFormat17Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *IntermediateSecuritiesDistributionTypeFormat17Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntermediateSecuritiesDistributionTypeFormat17Choice) SetCode(value string) {
 	i.Code = (*IntermediateSecurityDistributionType4Code)(&value)
 }
@@ -10,6 +10,10 @@ type IntermediateSecuritiesDistributionTypeFormat18Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *IntermediateSecuritiesDistributionTypeFormat18Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntermediateSecuritiesDistributionTypeFormat18Choice) SetCode(value string) {
 	i.Code = (*IntermediateSecurityDistributionType5Code)(&value)
 }
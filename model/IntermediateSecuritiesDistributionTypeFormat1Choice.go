@@ -10,6 +10,10 @@ type IntermediateSecuritiesDistributionTypeFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (i *IntermediateSecuritiesDistributionTypeFormat1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntermediateSecuritiesDistributionTypeFormat1Choice) SetCode(value string) {
 	i.Code = (*IntermediateSecurityDistributionType3Code)(&value)
 }
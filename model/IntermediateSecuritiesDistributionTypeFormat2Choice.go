@@ -10,6 +10,10 @@ type IntermediateSecuritiesDistributionTypeFormat2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (i *IntermediateSecuritiesDistributionTypeFormat2Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntermediateSecuritiesDistributionTypeFormat2Choice) SetCode(value string) {
 	i.Code = (*IntermediateSecurityDistributionType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type IntermediateSecuritiesDistributionTypeFormat5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (i *IntermediateSecuritiesDistributionTypeFormat5Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntermediateSecuritiesDistributionTypeFormat5Choice) SetCode(value string) {
 	i.Code = (*IntermediateSecurityDistributionType4Code)(&value)
 }
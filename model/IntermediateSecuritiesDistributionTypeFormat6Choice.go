@@ -10,6 +10,10 @@ type IntermediateSecuritiesDistributionTypeFormat6Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (i *IntermediateSecuritiesDistributionTypeFormat6Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntermediateSecuritiesDistributionTypeFormat6Choice) SetCode(value string) {
 	i.Code = (*IntermediateSecurityDistributionType4Code)(&value)
 }
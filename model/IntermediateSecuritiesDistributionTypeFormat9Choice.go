@@ -10,6 +10,10 @@ type IntermediateSecuritiesDistributionTypeFormat9Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (i *IntermediateSecuritiesDistributionTypeFormat9Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntermediateSecuritiesDistributionTypeFormat9Choice) SetCode(value string) {
 	i.Code = (*IntermediateSecurityDistributionType5Code)(&value)
 }
@@ -10,6 +10,10 @@ type IntermediateSecurityDistributionType1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (i *IntermediateSecurityDistributionType1FormatChoice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntermediateSecurityDistributionType1FormatChoice) SetCode(value string) {
 	i.Code = (*IntermediateSecurityDistributionType1Code)(&value)
 }
@@ -22,6 +22,10 @@ type IntraPositionDetails1 struct {
 	InstructionProcessingAdditionalDetails *Max350Text `xml:"InstrPrcgAddtlDtls,omitempty"`
 }
 
+func (i *IntraPositionDetails1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails1) AddPriority() *PriorityNumeric1Choice {
 	i.Priority = new(PriorityNumeric1Choice)
 	return i.Priority
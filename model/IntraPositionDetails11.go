@@ -25,6 +25,10 @@ type IntraPositionDetails11 struct {
 	InstructionProcessingAdditionalDetails *Max350Text `xml:"InstrPrcgAddtlDtls,omitempty"`
 }
 
+func (i *IntraPositionDetails11) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails11) AddPriority() *PriorityNumeric1Choice {
 	i.Priority = new(PriorityNumeric1Choice)
 	return i.Priority
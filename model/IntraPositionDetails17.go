@@ -13,6 +13,10 @@ type IntraPositionDetails17 struct {
 	IntraPositionMovement []*IntraPositionMovementDetails5 `xml:"IntraPosMvmnt"`
 }
 
+func (i *IntraPositionDetails17) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails17) AddSafekeepingPlace() *SafekeepingPlaceFormat3Choice {
 	i.SafekeepingPlace = new(SafekeepingPlaceFormat3Choice)
 	return i.SafekeepingPlace
@@ -31,6 +31,10 @@ type IntraPositionDetails19 struct {
 	BalanceTo *SecuritiesBalanceType2Choice `xml:"BalTo,omitempty"`
 }
 
+func (i *IntraPositionDetails19) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails19) SetPoolIdentification(value string) {
 	i.PoolIdentification = (*Max35Text)(&value)
 }
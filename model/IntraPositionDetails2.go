@@ -31,6 +31,10 @@ type IntraPositionDetails2 struct {
 	InstructionProcessingAdditionalDetails *Max350Text `xml:"InstrPrcgAddtlDtls,omitempty"`
 }
 
+func (i *IntraPositionDetails2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails2) AddSettledQuantity() *FinancialInstrumentQuantity1Choice {
 	i.SettledQuantity = new(FinancialInstrumentQuantity1Choice)
 	return i.SettledQuantity
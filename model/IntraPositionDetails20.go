@@ -13,6 +13,10 @@ type IntraPositionDetails20 struct {
 	IntraPositionMovement []*IntraPositionMovementDetails7 `xml:"IntraPosMvmnt"`
 }
 
+func (i *IntraPositionDetails20) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails20) AddSafekeepingPlace() *SafekeepingPlaceFormat3Choice {
 	i.SafekeepingPlace = new(SafekeepingPlaceFormat3Choice)
 	return i.SafekeepingPlace
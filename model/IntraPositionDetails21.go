@@ -25,6 +25,10 @@ type IntraPositionDetails21 struct {
 	InstructionProcessingAdditionalDetails *Max350Text `xml:"InstrPrcgAddtlDtls,omitempty"`
 }
 
+func (i *IntraPositionDetails21) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails21) AddPriority() *PriorityNumeric1Choice {
 	i.Priority = new(PriorityNumeric1Choice)
 	return i.Priority
@@ -37,6 +37,10 @@ type IntraPositionDetails22 struct {
 	InstructionProcessingAdditionalDetails *Max350Text `xml:"InstrPrcgAddtlDtls,omitempty"`
 }
 
+func (i *IntraPositionDetails22) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails22) AddSettledQuantity() *FinancialInstrumentQuantity1Choice {
 	i.SettledQuantity = new(FinancialInstrumentQuantity1Choice)
 	return i.SettledQuantity
@@ -13,6 +13,10 @@ type IntraPositionDetails28 struct {
 	IntraPositionMovement []*IntraPositionMovementDetails9 `xml:"IntraPosMvmnt"`
 }
 
+func (i *IntraPositionDetails28) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails28) AddSafekeepingPlace() *SafekeepingPlaceFormat3Choice {
 	i.SafekeepingPlace = new(SafekeepingPlaceFormat3Choice)
 	return i.SafekeepingPlace
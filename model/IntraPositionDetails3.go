@@ -13,6 +13,10 @@ type IntraPositionDetails3 struct {
 	IntraPositionMovement []*IntraPositionMovementDetails1 `xml:"IntraPosMvmnt"`
 }
 
+func (i *IntraPositionDetails3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails3) AddSafekeepingPlace() *SafekeepingPlaceFormat3Choice {
 	i.SafekeepingPlace = new(SafekeepingPlaceFormat3Choice)
 	return i.SafekeepingPlace
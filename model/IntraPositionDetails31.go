@@ -31,6 +31,10 @@ type IntraPositionDetails31 struct {
 	BalanceTo *SecuritiesBalanceType7Choice `xml:"BalTo,omitempty"`
 }
 
+func (i *IntraPositionDetails31) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails31) SetPoolIdentification(value string) {
 	i.PoolIdentification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type IntraPositionDetails32 struct {
 	IntraPositionMovement []*IntraPositionMovementDetails11 `xml:"IntraPosMvmnt"`
 }
 
+func (i *IntraPositionDetails32) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails32) AddSafekeepingPlace() *SafekeepingPlaceFormat10Choice {
 	i.SafekeepingPlace = new(SafekeepingPlaceFormat10Choice)
 	return i.SafekeepingPlace
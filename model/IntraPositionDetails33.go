@@ -25,6 +25,10 @@ type IntraPositionDetails33 struct {
 	InstructionProcessingAdditionalDetails *Max350Text `xml:"InstrPrcgAddtlDtls,omitempty"`
 }
 
+func (i *IntraPositionDetails33) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails33) AddPriority() *PriorityNumeric4Choice {
 	i.Priority = new(PriorityNumeric4Choice)
 	return i.Priority
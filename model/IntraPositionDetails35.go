@@ -37,6 +37,10 @@ type IntraPositionDetails35 struct {
 	InstructionProcessingAdditionalDetails *RestrictedFINXMax350Text `xml:"InstrPrcgAddtlDtls,omitempty"`
 }
 
+func (i *IntraPositionDetails35) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails35) AddSettledQuantity() *FinancialInstrumentQuantity15Choice {
 	i.SettledQuantity = new(FinancialInstrumentQuantity15Choice)
 	return i.SettledQuantity
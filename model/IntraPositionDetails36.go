@@ -25,6 +25,10 @@ type IntraPositionDetails36 struct {
 	InstructionProcessingAdditionalDetails *RestrictedFINXMax350Text `xml:"InstrPrcgAddtlDtls,omitempty"`
 }
 
+func (i *IntraPositionDetails36) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails36) AddPriority() *PriorityNumeric5Choice {
 	i.Priority = new(PriorityNumeric5Choice)
 	return i.Priority
@@ -13,6 +13,10 @@ type IntraPositionDetails37 struct {
 	IntraPositionMovement []*IntraPositionMovementDetails12 `xml:"IntraPosMvmnt"`
 }
 
+func (i *IntraPositionDetails37) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails37) AddSafekeepingPlace() *SafekeepingPlaceFormat17Choice {
 	i.SafekeepingPlace = new(SafekeepingPlaceFormat17Choice)
 	return i.SafekeepingPlace
@@ -31,6 +31,10 @@ type IntraPositionDetails38 struct {
 	BalanceTo *SecuritiesBalanceType11Choice `xml:"BalTo,omitempty"`
 }
 
+func (i *IntraPositionDetails38) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails38) SetPoolIdentification(value string) {
 	i.PoolIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Details of the intra-position movement.
 type IntraPositionDetails39 struct {
 
@@ -34,6 +38,10 @@ type IntraPositionDetails39 struct {
 	BalanceTo *SecuritiesBalanceType7Choice `xml:"BalTo,omitempty"`
 }
 
+func (i *IntraPositionDetails39) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails39) SetPoolIdentification(value string) {
 	i.PoolIdentification = (*Max35Text)(&value)
 }
@@ -72,6 +80,11 @@ func (i *IntraPositionDetails39) SetAcknowledgedStatusTimeStamp(value string) {
 	i.AcknowledgedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (i *IntraPositionDetails39) SetAcknowledgedStatusTimeStampFromTime(value time.Time) {
+	i.AcknowledgedStatusTimeStamp = new(ISODateTime)
+	i.AcknowledgedStatusTimeStamp.FromTime(value)
+}
+
 func (i *IntraPositionDetails39) AddBalanceFrom() *SecuritiesBalanceType7Choice {
 	i.BalanceFrom = new(SecuritiesBalanceType7Choice)
 	return i.BalanceFrom
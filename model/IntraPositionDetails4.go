@@ -28,6 +28,10 @@ type IntraPositionDetails4 struct {
 	BalanceTo *SecuritiesBalanceType2Choice `xml:"BalTo,omitempty"`
 }
 
+func (i *IntraPositionDetails4) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails4) SetPoolIdentification(value string) {
 	i.PoolIdentification = (*Max35Text)(&value)
 }
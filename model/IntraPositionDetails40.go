@@ -13,6 +13,10 @@ type IntraPositionDetails40 struct {
 	IntraPositionMovement []*IntraPositionMovementDetails13 `xml:"IntraPosMvmnt"`
 }
 
+func (i *IntraPositionDetails40) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails40) AddSafekeepingPlace() *SafekeepingPlaceFormat10Choice {
 	i.SafekeepingPlace = new(SafekeepingPlaceFormat10Choice)
 	return i.SafekeepingPlace
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Details of the intra-position movement.
 type IntraPositionDetails42 struct {
 
@@ -34,6 +38,10 @@ type IntraPositionDetails42 struct {
 	BalanceTo *SecuritiesBalanceType11Choice `xml:"BalTo,omitempty"`
 }
 
+func (i *IntraPositionDetails42) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails42) SetPoolIdentification(value string) {
 	i.PoolIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -72,6 +80,11 @@ func (i *IntraPositionDetails42) SetAcknowledgedStatusTimeStamp(value string) {
 	i.AcknowledgedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (i *IntraPositionDetails42) SetAcknowledgedStatusTimeStampFromTime(value time.Time) {
+	i.AcknowledgedStatusTimeStamp = new(ISODateTime)
+	i.AcknowledgedStatusTimeStamp.FromTime(value)
+}
+
 func (i *IntraPositionDetails42) AddBalanceFrom() *SecuritiesBalanceType11Choice {
 	i.BalanceFrom = new(SecuritiesBalanceType11Choice)
 	return i.BalanceFrom
@@ -37,6 +37,10 @@ type IntraPositionDetails43 struct {
 	InstructionProcessingAdditionalDetails *RestrictedFINXMax350Text `xml:"InstrPrcgAddtlDtls,omitempty"`
 }
 
+func (i *IntraPositionDetails43) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails43) AddSettledQuantity() *FinancialInstrumentQuantity15Choice {
 	i.SettledQuantity = new(FinancialInstrumentQuantity15Choice)
 	return i.SettledQuantity
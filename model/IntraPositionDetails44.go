@@ -13,6 +13,10 @@ type IntraPositionDetails44 struct {
 	IntraPositionMovement []*IntraPositionMovementDetails14 `xml:"IntraPosMvmnt"`
 }
 
+func (i *IntraPositionDetails44) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails44) AddSafekeepingPlace() *SafekeepingPlaceFormat17Choice {
 	i.SafekeepingPlace = new(SafekeepingPlaceFormat17Choice)
 	return i.SafekeepingPlace
@@ -28,6 +28,10 @@ type IntraPositionDetails9 struct {
 	BalanceTo *SecuritiesBalanceType2Choice `xml:"BalTo,omitempty"`
 }
 
+func (i *IntraPositionDetails9) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionDetails9) SetPoolIdentification(value string) {
 	i.PoolIdentification = (*Max35Text)(&value)
 }
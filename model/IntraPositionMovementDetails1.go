@@ -34,6 +34,10 @@ type IntraPositionMovementDetails1 struct {
 	Extension []*Extension2 `xml:"Xtnsn,omitempty"`
 }
 
+func (i *IntraPositionMovementDetails1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionMovementDetails1) AddIdentification() *References5Choice {
 	i.Identification = new(References5Choice)
 	return i.Identification
@@ -40,6 +40,10 @@ type IntraPositionMovementDetails11 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *IntraPositionMovementDetails11) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionMovementDetails11) AddIdentification() *References42Choice {
 	i.Identification = new(References42Choice)
 	return i.Identification
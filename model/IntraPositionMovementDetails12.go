@@ -40,6 +40,10 @@ type IntraPositionMovementDetails12 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *IntraPositionMovementDetails12) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionMovementDetails12) AddIdentification() *References51Choice {
 	i.Identification = new(References51Choice)
 	return i.Identification
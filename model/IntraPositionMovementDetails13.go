@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Details of the intra-position movement.
 type IntraPositionMovementDetails13 struct {
 
@@ -43,6 +47,10 @@ type IntraPositionMovementDetails13 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *IntraPositionMovementDetails13) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionMovementDetails13) AddIdentification() *References42Choice {
 	i.Identification = new(References42Choice)
 	return i.Identification
@@ -87,6 +95,11 @@ func (i *IntraPositionMovementDetails13) SetAcknowledgedStatusTimeStamp(value st
 	i.AcknowledgedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (i *IntraPositionMovementDetails13) SetAcknowledgedStatusTimeStampFromTime(value time.Time) {
+	i.AcknowledgedStatusTimeStamp = new(ISODateTime)
+	i.AcknowledgedStatusTimeStamp.FromTime(value)
+}
+
 func (i *IntraPositionMovementDetails13) AddCorporateActionEventType() *CorporateActionEventType56Choice {
 	i.CorporateActionEventType = new(CorporateActionEventType56Choice)
 	return i.CorporateActionEventType
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Details of the intra-position movement.
 type IntraPositionMovementDetails14 struct {
 
@@ -43,6 +47,10 @@ type IntraPositionMovementDetails14 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *IntraPositionMovementDetails14) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionMovementDetails14) AddIdentification() *References51Choice {
 	i.Identification = new(References51Choice)
 	return i.Identification
@@ -87,6 +95,11 @@ func (i *IntraPositionMovementDetails14) SetAcknowledgedStatusTimeStamp(value st
 	i.AcknowledgedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (i *IntraPositionMovementDetails14) SetAcknowledgedStatusTimeStampFromTime(value time.Time) {
+	i.AcknowledgedStatusTimeStamp = new(ISODateTime)
+	i.AcknowledgedStatusTimeStamp.FromTime(value)
+}
+
 func (i *IntraPositionMovementDetails14) AddCorporateActionEventType() *CorporateActionEventType69Choice {
 	i.CorporateActionEventType = new(CorporateActionEventType69Choice)
 	return i.CorporateActionEventType
@@ -40,6 +40,10 @@ type IntraPositionMovementDetails5 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *IntraPositionMovementDetails5) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionMovementDetails5) AddIdentification() *References19Choice {
 	i.Identification = new(References19Choice)
 	return i.Identification
@@ -40,6 +40,10 @@ type IntraPositionMovementDetails7 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *IntraPositionMovementDetails7) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionMovementDetails7) AddIdentification() *References27Choice {
 	i.Identification = new(References27Choice)
 	return i.Identification
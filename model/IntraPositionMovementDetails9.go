@@ -40,6 +40,10 @@ type IntraPositionMovementDetails9 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *IntraPositionMovementDetails9) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionMovementDetails9) AddIdentification() *References27Choice {
 	i.Identification = new(References27Choice)
 	return i.Identification
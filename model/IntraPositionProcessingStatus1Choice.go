@@ -19,6 +19,10 @@ type IntraPositionProcessingStatus1Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (i *IntraPositionProcessingStatus1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionProcessingStatus1Choice) AddRejected() *RejectionOrRepairStatus2Choice {
 	i.Rejected = new(RejectionOrRepairStatus2Choice)
 	return i.Rejected
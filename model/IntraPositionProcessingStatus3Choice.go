@@ -19,6 +19,10 @@ type IntraPositionProcessingStatus3Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (i *IntraPositionProcessingStatus3Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionProcessingStatus3Choice) AddRejected() *RejectionOrRepairStatus15Choice {
 	i.Rejected = new(RejectionOrRepairStatus15Choice)
 	return i.Rejected
@@ -19,6 +19,10 @@ type IntraPositionProcessingStatus5Choice struct {
 	Proprietary *ProprietaryStatusAndReason6 `xml:"Prtry"`
 }
 
+func (i *IntraPositionProcessingStatus5Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionProcessingStatus5Choice) AddRejected() *RejectionOrRepairStatus29Choice {
 	i.Rejected = new(RejectionOrRepairStatus29Choice)
 	return i.Rejected
@@ -19,6 +19,10 @@ type IntraPositionProcessingStatus6Choice struct {
 	Proprietary *ProprietaryStatusAndReason7 `xml:"Prtry"`
 }
 
+func (i *IntraPositionProcessingStatus6Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IntraPositionProcessingStatus6Choice) AddRejected() *RejectionOrRepairStatus33Choice {
 	i.Rejected = new(RejectionOrRepairStatus33Choice)
 	return i.Rejected
@@ -10,6 +10,10 @@ type InvestigatedParties1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (i *InvestigatedParties1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestigatedParties1Choice) SetCode(value string) {
 	i.Code = (*InvestigatedParties1Code)(&value)
 }
@@ -7,6 +7,10 @@ type InvestigationRejectionJustification1 struct {
 	RejectionReason *InvestigationRejection1Code `xml:"RjctnRsn"`
 }
 
+func (i *InvestigationRejectionJustification1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestigationRejectionJustification1) SetRejectionReason(value string) {
 	i.RejectionReason = (*InvestigationRejection1Code)(&value)
 }
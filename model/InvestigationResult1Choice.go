@@ -10,6 +10,10 @@ type InvestigationResult1Choice struct {
 	InvestigationStatus *InvestigationStatus1Code `xml:"InvstgtnSts"`
 }
 
+func (i *InvestigationResult1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestigationResult1Choice) AddResult() *SupplementaryDataEnvelope1 {
 	i.Result = new(SupplementaryDataEnvelope1)
 	return i.Result
@@ -19,6 +19,10 @@ type InvestigationStatus2Choice struct {
 	AssignmentCancellationConfirmation *YesNoIndicator `xml:"AssgnmtCxlConf"`
 }
 
+func (i *InvestigationStatus2Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestigationStatus2Choice) SetConfirmation(value string) {
 	i.Confirmation = (*InvestigationExecutionConfirmation3Code)(&value)
 }
@@ -35,3 +39,8 @@ func (i *InvestigationStatus2Choice) AddDuplicateOf() *Case2 {
 func (i *InvestigationStatus2Choice) SetAssignmentCancellationConfirmation(value string) {
 	i.AssignmentCancellationConfirmation = (*YesNoIndicator)(&value)
 }
+
+func (i *InvestigationStatus2Choice) SetAssignmentCancellationConfirmationFromBool(value bool) {
+	i.AssignmentCancellationConfirmation = new(YesNoIndicator)
+	i.AssignmentCancellationConfirmation.FromBool(value)
+}
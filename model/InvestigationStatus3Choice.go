@@ -19,6 +19,10 @@ type InvestigationStatus3Choice struct {
 	AssignmentCancellationConfirmation *YesNoIndicator `xml:"AssgnmtCxlConf"`
 }
 
+func (i *InvestigationStatus3Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestigationStatus3Choice) SetConfirmation(value string) {
 	i.Confirmation = (*InvestigationExecutionConfirmation3Code)(&value)
 }
@@ -35,3 +39,8 @@ func (i *InvestigationStatus3Choice) AddDuplicateOf() *Case3 {
 func (i *InvestigationStatus3Choice) SetAssignmentCancellationConfirmation(value string) {
 	i.AssignmentCancellationConfirmation = (*YesNoIndicator)(&value)
 }
+
+func (i *InvestigationStatus3Choice) SetAssignmentCancellationConfirmationFromBool(value bool) {
+	i.AssignmentCancellationConfirmation = new(YesNoIndicator)
+	i.AssignmentCancellationConfirmation.FromBool(value)
+}
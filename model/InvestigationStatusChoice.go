@@ -20,6 +20,10 @@ type InvestigationStatusChoice struct {
 	AssignmentCancellationConfirmation *YesNoIndicator `xml:"AssgnmtCxlConf"`
 }
 
+func (i *InvestigationStatusChoice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestigationStatusChoice) SetConfirmation(value string) {
 	i.Confirmation = (*InvestigationExecutionConfirmation1Code)(&value)
 }
@@ -41,3 +45,8 @@ func (i *InvestigationStatusChoice) AddDuplicateOf() *Case {
 func (i *InvestigationStatusChoice) SetAssignmentCancellationConfirmation(value string) {
 	i.AssignmentCancellationConfirmation = (*YesNoIndicator)(&value)
 }
+
+func (i *InvestigationStatusChoice) SetAssignmentCancellationConfirmationFromBool(value bool) {
+	i.AssignmentCancellationConfirmation = new(YesNoIndicator)
+	i.AssignmentCancellationConfirmation.FromBool(value)
+}
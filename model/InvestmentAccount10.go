@@ -37,6 +37,10 @@ type InvestmentAccount10 struct {
 	AccountServicer *PartyIdentification1Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (i *InvestmentAccount10) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount10) AddOwnerIdentification() *PartyIdentification1Choice {
 	newValue := new(PartyIdentification1Choice)
 	i.OwnerIdentification = append(i.OwnerIdentification, newValue)
@@ -350,6 +354,11 @@ func (i *InvestmentAccount10) SetDematerialisedIndicator(value string) {
 	i.DematerialisedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount10) SetDematerialisedIndicatorFromBool(value bool) {
+	i.DematerialisedIndicator = new(YesNoIndicator)
+	i.DematerialisedIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount10) SetIncomePreference(value string) {
 	i.IncomePreference = (*IncomePreference1Code)(&value)
 }
@@ -358,6 +367,11 @@ func (i *InvestmentAccount10) SetBeneficiaryCertificationIndicator(value string)
 	i.BeneficiaryCertificationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount10) SetBeneficiaryCertificationIndicatorFromBool(value bool) {
+	i.BeneficiaryCertificationIndicator = new(YesNoIndicator)
+	i.BeneficiaryCertificationIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount10) AddSafekeepingPlace() *PartyIdentification1Choice {
 	i.SafekeepingPlace = new(PartyIdentification1Choice)
 	return i.SafekeepingPlace
@@ -34,6 +34,10 @@ type InvestmentAccount11 struct {
 	AccountServicer *PartyIdentification1Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (i *InvestmentAccount11) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount11) AddOwnerIdentification() *PartyIdentification1Choice {
 	newValue := new(PartyIdentification1Choice)
 	i.OwnerIdentification = append(i.OwnerIdentification, newValue)
@@ -347,6 +351,11 @@ func (i *InvestmentAccount11) SetDematerialisedIndicator(value string) {
 	i.DematerialisedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount11) SetDematerialisedIndicatorFromBool(value bool) {
+	i.DematerialisedIndicator = new(YesNoIndicator)
+	i.DematerialisedIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount11) SetIncomePreference(value string) {
 	i.IncomePreference = (*IncomePreference1Code)(&value)
 }
@@ -355,6 +364,11 @@ func (i *InvestmentAccount11) SetBeneficiaryCertificationIndicator(value string)
 	i.BeneficiaryCertificationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount11) SetBeneficiaryCertificationIndicatorFromBool(value bool) {
+	i.BeneficiaryCertificationIndicator = new(YesNoIndicator)
+	i.BeneficiaryCertificationIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount11) AddAccountServicer() *PartyIdentification1Choice {
 	i.AccountServicer = new(PartyIdentification1Choice)
 	return i.AccountServicer
@@ -19,6 +19,10 @@ type InvestmentAccount12 struct {
 	AccountServicer *PartyIdentification1Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (i *InvestmentAccount12) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount12) AddIdentification() *AccountIdentification1 {
 	i.Identification = new(AccountIdentification1)
 	return i.Identification
@@ -19,6 +19,10 @@ type InvestmentAccount13 struct {
 	AccountServicer *PartyIdentification2Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (i *InvestmentAccount13) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount13) AddAccountIdentification() *AccountIdentification1 {
 	i.AccountIdentification = new(AccountIdentification1)
 	return i.AccountIdentification
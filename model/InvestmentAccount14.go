@@ -22,6 +22,10 @@ type InvestmentAccount14 struct {
 	AccountServicer *PartyIdentification2Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (i *InvestmentAccount14) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount14) AddAccountIdentification() *AccountIdentification1 {
 	i.AccountIdentification = new(AccountIdentification1)
 	return i.AccountIdentification
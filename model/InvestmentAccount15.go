@@ -10,6 +10,10 @@ type InvestmentAccount15 struct {
 	Type *CashAccountType1 `xml:"Tp,omitempty"`
 }
 
+func (i *InvestmentAccount15) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount15) AddAccountIdentification() *AccountIdentification1 {
 	i.AccountIdentification = new(AccountIdentification1)
 	return i.AccountIdentification
@@ -13,6 +13,10 @@ type InvestmentAccount20 struct {
 	ExtendedType *Extended350Code `xml:"XtndedTp,omitempty"`
 }
 
+func (i *InvestmentAccount20) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount20) AddAccountIdentification() *AccountIdentification1 {
 	i.AccountIdentification = new(AccountIdentification1)
 	return i.AccountIdentification
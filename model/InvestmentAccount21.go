@@ -25,6 +25,10 @@ type InvestmentAccount21 struct {
 	SubAccountDetails *SubAccount1 `xml:"SubAcctDtls,omitempty"`
 }
 
+func (i *InvestmentAccount21) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount21) AddAccountIdentification() *AccountIdentification1 {
 	i.AccountIdentification = new(AccountIdentification1)
 	return i.AccountIdentification
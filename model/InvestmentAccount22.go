@@ -37,6 +37,10 @@ type InvestmentAccount22 struct {
 	AccountServicer *PartyIdentification2Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (i *InvestmentAccount22) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount22) AddOwnerIdentification() *PartyIdentification2Choice {
 	newValue := new(PartyIdentification2Choice)
 	i.OwnerIdentification = append(i.OwnerIdentification, newValue)
@@ -350,6 +354,11 @@ func (i *InvestmentAccount22) SetDematerialisedIndicator(value string) {
 	i.DematerialisedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount22) SetDematerialisedIndicatorFromBool(value bool) {
+	i.DematerialisedIndicator = new(YesNoIndicator)
+	i.DematerialisedIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount22) SetIncomePreference(value string) {
 	i.IncomePreference = (*IncomePreference1Code)(&value)
 }
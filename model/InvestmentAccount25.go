@@ -19,6 +19,10 @@ type InvestmentAccount25 struct {
 	AccountServicer *PartyIdentification2Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (i *InvestmentAccount25) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount25) AddIdentification() *AccountIdentification1 {
 	i.Identification = new(AccountIdentification1)
 	return i.Identification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Account between an investor(s) and a fund manager or a fund. The account can contain holdings in any investment fund or investment fund class managed (or distributed) by the fund manager, within the same fund family.
 type InvestmentAccount26 struct {
 
@@ -70,6 +74,10 @@ type InvestmentAccount26 struct {
 	AccountServicer *PartyIdentification2Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (i *InvestmentAccount26) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount26) AddIdentification() *AccountIdentification1 {
 	i.Identification = new(AccountIdentification1)
 	return i.Identification
@@ -143,6 +151,11 @@ func (i *InvestmentAccount26) SetRequiredSignatoriesNumber(value string) {
 	i.RequiredSignatoriesNumber = (*Number)(&value)
 }
 
+func (i *InvestmentAccount26) SetRequiredSignatoriesNumberFromDecimal(value decimal.Decimal) {
+	i.RequiredSignatoriesNumber = new(Number)
+	i.RequiredSignatoriesNumber.FromDecimal(value)
+}
+
 func (i *InvestmentAccount26) SetFundFamilyName(value string) {
 	i.FundFamilyName = (*Max350Text)(&value)
 }
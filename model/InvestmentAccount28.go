@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Account between an investor(s) and a fund manager or a fund. The account can contain holdings in any investment fund or investment fund class managed (or distributed) by the fund manager, within the same fund family.
 type InvestmentAccount28 struct {
 
@@ -67,6 +71,10 @@ type InvestmentAccount28 struct {
 	AccountServicer *PartyIdentification2Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (i *InvestmentAccount28) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount28) SetName(value string) {
 	i.Name = (*Max35Text)(&value)
 }
@@ -135,6 +143,11 @@ func (i *InvestmentAccount28) SetRequiredSignatoriesNumber(value string) {
 	i.RequiredSignatoriesNumber = (*Number)(&value)
 }
 
+func (i *InvestmentAccount28) SetRequiredSignatoriesNumberFromDecimal(value decimal.Decimal) {
+	i.RequiredSignatoriesNumber = new(Number)
+	i.RequiredSignatoriesNumber.FromDecimal(value)
+}
+
 func (i *InvestmentAccount28) SetFundFamilyName(value string) {
 	i.FundFamilyName = (*Max350Text)(&value)
 }
@@ -31,6 +31,10 @@ type InvestmentAccount29 struct {
 	AccountServicer *PartyIdentification2Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (i *InvestmentAccount29) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount29) SetName(value string) {
 	i.Name = (*Max35Text)(&value)
 }
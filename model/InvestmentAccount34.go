@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Account between an investor(s) and a fund manager or a fund. The account can contain holdings in any investment fund or investment fund class managed (or distributed) by the fund manager, within the same fund family.
 type InvestmentAccount34 struct {
 
@@ -70,6 +74,10 @@ type InvestmentAccount34 struct {
 	AccountSignatureDateTime *DateAndDateTimeChoice `xml:"AcctSgntrDtTm,omitempty"`
 }
 
+func (i *InvestmentAccount34) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount34) AddIdentification() *AccountIdentification1 {
 	i.Identification = new(AccountIdentification1)
 	return i.Identification
@@ -132,6 +140,11 @@ func (i *InvestmentAccount34) SetRequiredSignatoriesNumber(value string) {
 	i.RequiredSignatoriesNumber = (*Number)(&value)
 }
 
+func (i *InvestmentAccount34) SetRequiredSignatoriesNumberFromDecimal(value decimal.Decimal) {
+	i.RequiredSignatoriesNumber = new(Number)
+	i.RequiredSignatoriesNumber.FromDecimal(value)
+}
+
 func (i *InvestmentAccount34) SetFundFamilyName(value string) {
 	i.FundFamilyName = (*Max350Text)(&value)
 }
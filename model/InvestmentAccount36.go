@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Account between an investor(s) and a fund manager or a fund. The account can contain holdings in any investment fund or investment fund class managed (or distributed) by the fund manager, within the same fund family.
 type InvestmentAccount36 struct {
 
@@ -67,6 +71,10 @@ type InvestmentAccount36 struct {
 	AccountSignatureDateTime *DateAndDateTimeChoice `xml:"AcctSgntrDtTm,omitempty"`
 }
 
+func (i *InvestmentAccount36) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount36) SetName(value string) {
 	i.Name = (*Max35Text)(&value)
 }
@@ -124,6 +132,11 @@ func (i *InvestmentAccount36) SetRequiredSignatoriesNumber(value string) {
 	i.RequiredSignatoriesNumber = (*Number)(&value)
 }
 
+func (i *InvestmentAccount36) SetRequiredSignatoriesNumberFromDecimal(value decimal.Decimal) {
+	i.RequiredSignatoriesNumber = new(Number)
+	i.RequiredSignatoriesNumber.FromDecimal(value)
+}
+
 func (i *InvestmentAccount36) SetFundFamilyName(value string) {
 	i.FundFamilyName = (*Max350Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Account between an investor(s) and a fund manager or a fund. The account can contain holdings in any investment fund or investment fund class managed (or distributed) by the fund manager, within the same fund family.
 type InvestmentAccount37 struct {
 
@@ -79,6 +83,10 @@ type InvestmentAccount37 struct {
 	InvestmentAccountCategory *InvestmentAccountCategory1Choice `xml:"InvstmtAcctCtgy,omitempty"`
 }
 
+func (i *InvestmentAccount37) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount37) AddIdentification() *AccountIdentification1 {
 	i.Identification = new(AccountIdentification1)
 	return i.Identification
@@ -287,6 +295,11 @@ func (i *InvestmentAccount37) SetRequiredSignatoriesNumber(value string) {
 	i.RequiredSignatoriesNumber = (*Number)(&value)
 }
 
+func (i *InvestmentAccount37) SetRequiredSignatoriesNumberFromDecimal(value decimal.Decimal) {
+	i.RequiredSignatoriesNumber = new(Number)
+	i.RequiredSignatoriesNumber.FromDecimal(value)
+}
+
 func (i *InvestmentAccount37) SetFundFamilyName(value string) {
 	i.FundFamilyName = (*Max350Text)(&value)
 }
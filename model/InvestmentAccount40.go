@@ -40,6 +40,10 @@ type InvestmentAccount40 struct {
 	SubAccountDetails *SubAccount1 `xml:"SubAcctDtls,omitempty"`
 }
 
+func (i *InvestmentAccount40) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount40) AddOwnerIdentification() *PartyIdentification2Choice {
 	newValue := new(PartyIdentification2Choice)
 	i.OwnerIdentification = append(i.OwnerIdentification, newValue)
@@ -353,6 +357,11 @@ func (i *InvestmentAccount40) SetDematerialisedIndicator(value string) {
 	i.DematerialisedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount40) SetDematerialisedIndicatorFromBool(value bool) {
+	i.DematerialisedIndicator = new(YesNoIndicator)
+	i.DematerialisedIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount40) SetIncomePreference(value string) {
 	i.IncomePreference = (*IncomePreference1Code)(&value)
 }
@@ -37,6 +37,10 @@ type InvestmentAccount41 struct {
 	SubAccountDetails *SubAccount1 `xml:"SubAcctDtls,omitempty"`
 }
 
+func (i *InvestmentAccount41) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount41) AddOwnerIdentification() *PartyIdentification2Choice {
 	newValue := new(PartyIdentification2Choice)
 	i.OwnerIdentification = append(i.OwnerIdentification, newValue)
@@ -350,6 +354,11 @@ func (i *InvestmentAccount41) SetDematerialisedIndicator(value string) {
 	i.DematerialisedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount41) SetDematerialisedIndicatorFromBool(value bool) {
+	i.DematerialisedIndicator = new(YesNoIndicator)
+	i.DematerialisedIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount41) SetIncomePreference(value string) {
 	i.IncomePreference = (*IncomePreference1Code)(&value)
 }
@@ -13,6 +13,10 @@ type InvestmentAccount42 struct {
 	AccountServicer *PartyIdentification2Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (i *InvestmentAccount42) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount42) AddAccountIdentification() *AccountIdentification1 {
 	i.AccountIdentification = new(AccountIdentification1)
 	return i.AccountIdentification
@@ -19,6 +19,10 @@ type InvestmentAccount43 struct {
 	AccountServicer *PartyIdentification2Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (i *InvestmentAccount43) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount43) AddIdentification() *AccountIdentification1 {
 	i.Identification = new(AccountIdentification1)
 	return i.Identification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Account between an investor(s) and a fund manager or a fund. The account can contain holdings in any investment fund or investment fund class managed (or distributed) by the fund manager, within the same fund family.
 type InvestmentAccount47 struct {
 
@@ -76,6 +80,10 @@ type InvestmentAccount47 struct {
 	InvestmentAccountCategory *InvestmentAccountCategory1Choice `xml:"InvstmtAcctCtgy,omitempty"`
 }
 
+func (i *InvestmentAccount47) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount47) SetName(value string) {
 	i.Name = (*Max35Text)(&value)
 }
@@ -279,6 +287,11 @@ func (i *InvestmentAccount47) SetRequiredSignatoriesNumber(value string) {
 	i.RequiredSignatoriesNumber = (*Number)(&value)
 }
 
+func (i *InvestmentAccount47) SetRequiredSignatoriesNumberFromDecimal(value decimal.Decimal) {
+	i.RequiredSignatoriesNumber = new(Number)
+	i.RequiredSignatoriesNumber.FromDecimal(value)
+}
+
 func (i *InvestmentAccount47) SetFundFamilyName(value string) {
 	i.FundFamilyName = (*Max350Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about a securities account and its characteristics.
 type InvestmentAccount49 struct {
 
@@ -118,6 +122,10 @@ type InvestmentAccount49 struct {
 	FiscalYear *FiscalYear1Choice `xml:"FsclYr,omitempty"`
 }
 
+func (i *InvestmentAccount49) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount49) SetIdentification(value string) {
 	i.Identification = (*Max35Text)(&value)
 }
@@ -471,6 +479,11 @@ func (i *InvestmentAccount49) SetRequiredSignatoriesNumber(value string) {
 	i.RequiredSignatoriesNumber = (*Number)(&value)
 }
 
+func (i *InvestmentAccount49) SetRequiredSignatoriesNumberFromDecimal(value decimal.Decimal) {
+	i.RequiredSignatoriesNumber = new(Number)
+	i.RequiredSignatoriesNumber.FromDecimal(value)
+}
+
 func (i *InvestmentAccount49) SetFundFamilyName(value string) {
 	i.FundFamilyName = (*Max350Text)(&value)
 }
@@ -838,6 +851,11 @@ func (i *InvestmentAccount49) SetNegativeIndicator(value string) {
 	i.NegativeIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount49) SetNegativeIndicatorFromBool(value bool) {
+	i.NegativeIndicator = new(YesNoIndicator)
+	i.NegativeIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount49) SetProcessingOrder(value string) {
 	i.ProcessingOrder = (*PositionEffect3Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about a securities account and its characteristics.
 type InvestmentAccount50 struct {
 
@@ -124,6 +128,10 @@ type InvestmentAccount50 struct {
 	FiscalYear *FiscalYear1Choice `xml:"FsclYr,omitempty"`
 }
 
+func (i *InvestmentAccount50) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount50) SetIdentification(value string) {
 	i.Identification = (*Max35Text)(&value)
 }
@@ -487,6 +495,11 @@ func (i *InvestmentAccount50) SetRequiredSignatoriesNumber(value string) {
 	i.RequiredSignatoriesNumber = (*Number)(&value)
 }
 
+func (i *InvestmentAccount50) SetRequiredSignatoriesNumberFromDecimal(value decimal.Decimal) {
+	i.RequiredSignatoriesNumber = new(Number)
+	i.RequiredSignatoriesNumber.FromDecimal(value)
+}
+
 func (i *InvestmentAccount50) SetFundFamilyName(value string) {
 	i.FundFamilyName = (*Max350Text)(&value)
 }
@@ -854,6 +867,11 @@ func (i *InvestmentAccount50) SetNegativeIndicator(value string) {
 	i.NegativeIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount50) SetNegativeIndicatorFromBool(value bool) {
+	i.NegativeIndicator = new(YesNoIndicator)
+	i.NegativeIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount50) SetProcessingOrder(value string) {
 	i.ProcessingOrder = (*PositionEffect3Code)(&value)
 }
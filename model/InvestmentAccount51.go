@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about a securities account and its characteristics.
 type InvestmentAccount51 struct {
 
@@ -115,6 +119,10 @@ type InvestmentAccount51 struct {
 	FiscalYear *FiscalYear1Choice `xml:"FsclYr,omitempty"`
 }
 
+func (i *InvestmentAccount51) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount51) SetName(value string) {
 	i.Name = (*Max35Text)(&value)
 }
@@ -464,6 +472,11 @@ func (i *InvestmentAccount51) SetRequiredSignatoriesNumber(value string) {
 	i.RequiredSignatoriesNumber = (*Number)(&value)
 }
 
+func (i *InvestmentAccount51) SetRequiredSignatoriesNumberFromDecimal(value decimal.Decimal) {
+	i.RequiredSignatoriesNumber = new(Number)
+	i.RequiredSignatoriesNumber.FromDecimal(value)
+}
+
 func (i *InvestmentAccount51) SetFundFamilyName(value string) {
 	i.FundFamilyName = (*Max350Text)(&value)
 }
@@ -831,6 +844,11 @@ func (i *InvestmentAccount51) SetNegativeIndicator(value string) {
 	i.NegativeIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount51) SetNegativeIndicatorFromBool(value bool) {
+	i.NegativeIndicator = new(YesNoIndicator)
+	i.NegativeIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount51) SetProcessingOrder(value string) {
 	i.ProcessingOrder = (*PositionEffect3Code)(&value)
 }
@@ -28,6 +28,10 @@ type InvestmentAccount52 struct {
 	AccountServicer *PartyIdentification70Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (i *InvestmentAccount52) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount52) SetName(value string) {
 	i.Name = (*Max35Text)(&value)
 }
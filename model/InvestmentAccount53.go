@@ -19,6 +19,10 @@ type InvestmentAccount53 struct {
 	AccountServicer *PartyIdentification70Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (i *InvestmentAccount53) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount53) SetAccountIdentification(value string) {
 	i.AccountIdentification = (*Max35Text)(&value)
 }
@@ -43,6 +43,10 @@ type InvestmentAccount54 struct {
 	SettlementPartiesDetails *DeliveringPartiesAndAccount14 `xml:"SttlmPtiesDtls,omitempty"`
 }
 
+func (i *InvestmentAccount54) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount54) AddOwnerIdentification() *PartyIdentification70Choice {
 	newValue := new(PartyIdentification70Choice)
 	i.OwnerIdentification = append(i.OwnerIdentification, newValue)
@@ -355,6 +359,11 @@ func (i *InvestmentAccount54) SetDematerialisedIndicator(value string) {
 	i.DematerialisedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount54) SetDematerialisedIndicatorFromBool(value bool) {
+	i.DematerialisedIndicator = new(YesNoIndicator)
+	i.DematerialisedIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount54) SetIncomePreference(value string) {
 	i.IncomePreference = (*IncomePreference2Code)(&value)
 }
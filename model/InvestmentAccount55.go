@@ -34,6 +34,10 @@ type InvestmentAccount55 struct {
 	SubAccountDetails *SubAccount5 `xml:"SubAcctDtls,omitempty"`
 }
 
+func (i *InvestmentAccount55) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount55) AddOwnerIdentification() *PartyIdentification70Choice {
 	newValue := new(PartyIdentification70Choice)
 	i.OwnerIdentification = append(i.OwnerIdentification, newValue)
@@ -200,6 +204,11 @@ func (i *InvestmentAccount55) SetDematerialisedIndicator(value string) {
 	i.DematerialisedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount55) SetDematerialisedIndicatorFromBool(value bool) {
+	i.DematerialisedIndicator = new(YesNoIndicator)
+	i.DematerialisedIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount55) SetIncomePreference(value string) {
 	i.IncomePreference = (*IncomePreference2Code)(&value)
 }
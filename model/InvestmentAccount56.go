@@ -43,6 +43,10 @@ type InvestmentAccount56 struct {
 	SettlementPartiesDetails *ReceivingPartiesAndAccount14 `xml:"SttlmPtiesDtls,omitempty"`
 }
 
+func (i *InvestmentAccount56) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount56) AddOwnerIdentification() *PartyIdentification70Choice {
 	newValue := new(PartyIdentification70Choice)
 	i.OwnerIdentification = append(i.OwnerIdentification, newValue)
@@ -355,6 +359,11 @@ func (i *InvestmentAccount56) SetDematerialisedIndicator(value string) {
 	i.DematerialisedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount56) SetDematerialisedIndicatorFromBool(value bool) {
+	i.DematerialisedIndicator = new(YesNoIndicator)
+	i.DematerialisedIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount56) SetIncomePreference(value string) {
 	i.IncomePreference = (*IncomePreference2Code)(&value)
 }
@@ -40,6 +40,10 @@ type InvestmentAccount57 struct {
 	SubAccountDetails *SubAccount5 `xml:"SubAcctDtls,omitempty"`
 }
 
+func (i *InvestmentAccount57) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount57) AddOwnerIdentification() *PartyIdentification70Choice {
 	newValue := new(PartyIdentification70Choice)
 	i.OwnerIdentification = append(i.OwnerIdentification, newValue)
@@ -352,6 +356,11 @@ func (i *InvestmentAccount57) SetDematerialisedIndicator(value string) {
 	i.DematerialisedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount57) SetDematerialisedIndicatorFromBool(value bool) {
+	i.DematerialisedIndicator = new(YesNoIndicator)
+	i.DematerialisedIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount57) SetIncomePreference(value string) {
 	i.IncomePreference = (*IncomePreference2Code)(&value)
 }
@@ -25,6 +25,10 @@ type InvestmentAccount58 struct {
 	SubAccountDetails *SubAccount6 `xml:"SubAcctDtls,omitempty"`
 }
 
+func (i *InvestmentAccount58) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount58) SetAccountIdentification(value string) {
 	i.AccountIdentification = (*Max35Text)(&value)
 }
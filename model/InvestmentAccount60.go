@@ -10,6 +10,10 @@ type InvestmentAccount60 struct {
 	Type *InvestmentAccountType1Choice `xml:"Tp,omitempty"`
 }
 
+func (i *InvestmentAccount60) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount60) SetAccountIdentification(value string) {
 	i.AccountIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about a securities account and its characteristics.
 type InvestmentAccount61 struct {
 
@@ -118,6 +122,10 @@ type InvestmentAccount61 struct {
 	FiscalYear *FiscalYear1Choice `xml:"FsclYr,omitempty"`
 }
 
+func (i *InvestmentAccount61) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount61) SetIdentification(value string) {
 	i.Identification = (*Max35Text)(&value)
 }
@@ -471,6 +479,11 @@ func (i *InvestmentAccount61) SetRequiredSignatoriesNumber(value string) {
 	i.RequiredSignatoriesNumber = (*Number)(&value)
 }
 
+func (i *InvestmentAccount61) SetRequiredSignatoriesNumberFromDecimal(value decimal.Decimal) {
+	i.RequiredSignatoriesNumber = new(Number)
+	i.RequiredSignatoriesNumber.FromDecimal(value)
+}
+
 func (i *InvestmentAccount61) SetFundFamilyName(value string) {
 	i.FundFamilyName = (*Max350Text)(&value)
 }
@@ -697,6 +710,11 @@ func (i *InvestmentAccount61) SetNegativeIndicator(value string) {
 	i.NegativeIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount61) SetNegativeIndicatorFromBool(value bool) {
+	i.NegativeIndicator = new(YesNoIndicator)
+	i.NegativeIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount61) SetProcessingOrder(value string) {
 	i.ProcessingOrder = (*PositionEffect3Code)(&value)
 }
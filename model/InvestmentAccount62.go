@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about a securities account and its characteristics.
 type InvestmentAccount62 struct {
 
@@ -124,6 +128,10 @@ type InvestmentAccount62 struct {
 	FiscalYear *FiscalYear1Choice `xml:"FsclYr,omitempty"`
 }
 
+func (i *InvestmentAccount62) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount62) SetIdentification(value string) {
 	i.Identification = (*Max35Text)(&value)
 }
@@ -492,6 +500,11 @@ func (i *InvestmentAccount62) SetRequiredSignatoriesNumber(value string) {
 	i.RequiredSignatoriesNumber = (*Number)(&value)
 }
 
+func (i *InvestmentAccount62) SetRequiredSignatoriesNumberFromDecimal(value decimal.Decimal) {
+	i.RequiredSignatoriesNumber = new(Number)
+	i.RequiredSignatoriesNumber.FromDecimal(value)
+}
+
 func (i *InvestmentAccount62) SetFundFamilyName(value string) {
 	i.FundFamilyName = (*Max350Text)(&value)
 }
@@ -713,6 +726,11 @@ func (i *InvestmentAccount62) SetNegativeIndicator(value string) {
 	i.NegativeIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccount62) SetNegativeIndicatorFromBool(value bool) {
+	i.NegativeIndicator = new(YesNoIndicator)
+	i.NegativeIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccount62) SetProcessingOrder(value string) {
 	i.ProcessingOrder = (*PositionEffect3Code)(&value)
 }
@@ -28,6 +28,10 @@ type InvestmentAccount64 struct {
 	AccountServicer *PartyIdentification70Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (i *InvestmentAccount64) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccount64) SetName(value string) {
 	i.Name = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type InvestmentAccountCategory1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *InvestmentAccountCategory1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountCategory1Choice) SetCode(value string) {
 	i.Code = (*InvestmentAccountCategory1Code)(&value)
 }
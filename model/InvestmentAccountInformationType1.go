@@ -22,26 +22,60 @@ type InvestmentAccountInformationType1 struct {
 	ServiceLevelAgreement *YesNoIndicator `xml:"SvcLvlAgrmt"`
 }
 
+func (i *InvestmentAccountInformationType1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountInformationType1) SetInvestmentAccount(value string) {
 	i.InvestmentAccount = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountInformationType1) SetInvestmentAccountFromBool(value bool) {
+	i.InvestmentAccount = new(YesNoIndicator)
+	i.InvestmentAccount.FromBool(value)
+}
+
 func (i *InvestmentAccountInformationType1) SetAccountParties(value string) {
 	i.AccountParties = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountInformationType1) SetAccountPartiesFromBool(value bool) {
+	i.AccountParties = new(YesNoIndicator)
+	i.AccountParties.FromBool(value)
+}
+
 func (i *InvestmentAccountInformationType1) SetIntermediaries(value string) {
 	i.Intermediaries = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountInformationType1) SetIntermediariesFromBool(value bool) {
+	i.Intermediaries = new(YesNoIndicator)
+	i.Intermediaries.FromBool(value)
+}
+
 func (i *InvestmentAccountInformationType1) SetInvestmentPlan(value string) {
 	i.InvestmentPlan = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountInformationType1) SetInvestmentPlanFromBool(value bool) {
+	i.InvestmentPlan = new(YesNoIndicator)
+	i.InvestmentPlan.FromBool(value)
+}
+
 func (i *InvestmentAccountInformationType1) SetCashSettlement(value string) {
 	i.CashSettlement = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountInformationType1) SetCashSettlementFromBool(value bool) {
+	i.CashSettlement = new(YesNoIndicator)
+	i.CashSettlement.FromBool(value)
+}
+
 func (i *InvestmentAccountInformationType1) SetServiceLevelAgreement(value string) {
 	i.ServiceLevelAgreement = (*YesNoIndicator)(&value)
 }
+
+func (i *InvestmentAccountInformationType1) SetServiceLevelAgreementFromBool(value bool) {
+	i.ServiceLevelAgreement = new(YesNoIndicator)
+	i.ServiceLevelAgreement.FromBool(value)
+}
@@ -16,6 +16,10 @@ type InvestmentAccountModification1 struct {
 	CounterpartyReference *AdditionalReference2 `xml:"CtrPtyRef,omitempty"`
 }
 
+func (i *InvestmentAccountModification1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountModification1) SetModificationReason(value string) {
 	i.ModificationReason = (*Max350Text)(&value)
 }
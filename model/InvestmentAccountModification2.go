@@ -19,6 +19,10 @@ type InvestmentAccountModification2 struct {
 	ExistingAccountIdentification *Max35Text `xml:"ExstgAcctId,omitempty"`
 }
 
+func (i *InvestmentAccountModification2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountModification2) SetModificationReason(value string) {
 	i.ModificationReason = (*Max350Text)(&value)
 }
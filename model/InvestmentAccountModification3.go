@@ -19,6 +19,10 @@ type InvestmentAccountModification3 struct {
 	ExistingAccountIdentification []*Account23 `xml:"ExstgAcctId,omitempty"`
 }
 
+func (i *InvestmentAccountModification3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountModification3) SetModificationReason(value string) {
 	i.ModificationReason = (*Max350Text)(&value)
 }
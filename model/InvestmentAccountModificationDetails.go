@@ -10,6 +10,10 @@ type InvestmentAccountModificationDetails struct {
 	AccountApplicationIdentification *Max35Text `xml:"AcctApplId,omitempty"`
 }
 
+func (i *InvestmentAccountModificationDetails) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountModificationDetails) SetModificationReason(value string) {
 	i.ModificationReason = (*Max350Text)(&value)
 }
@@ -16,6 +16,10 @@ type InvestmentAccountOpening1 struct {
 	CounterpartyReference *AdditionalReference2 `xml:"CtrPtyRef,omitempty"`
 }
 
+func (i *InvestmentAccountOpening1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountOpening1) SetOpeningType(value string) {
 	i.OpeningType = (*AccountOpeningType1Code)(&value)
 }
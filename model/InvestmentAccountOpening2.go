@@ -19,6 +19,10 @@ type InvestmentAccountOpening2 struct {
 	ExistingAccountIdentification *Max35Text `xml:"ExstgAcctId,omitempty"`
 }
 
+func (i *InvestmentAccountOpening2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountOpening2) SetOpeningType(value string) {
 	i.OpeningType = (*AccountOpeningType1Code)(&value)
 }
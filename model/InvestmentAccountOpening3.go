@@ -19,6 +19,10 @@ type InvestmentAccountOpening3 struct {
 	ExistingAccountIdentification []*Account23 `xml:"ExstgAcctId,omitempty"`
 }
 
+func (i *InvestmentAccountOpening3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountOpening3) AddOpeningType() *AccountOpeningType1Choice {
 	i.OpeningType = new(AccountOpeningType1Choice)
 	return i.OpeningType
@@ -10,6 +10,10 @@ type InvestmentAccountOpeningDetails struct {
 	AccountApplicationIdentification *Max35Text `xml:"AcctApplId,omitempty"`
 }
 
+func (i *InvestmentAccountOpeningDetails) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountOpeningDetails) SetOpeningType(value string) {
 	i.OpeningType = (*AccountOpeningType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type InvestmentAccountOrFinancialInstrument1Choice struct {
 	FinancialInstrument *FinancialInstrument6 `xml:"FinInstrm"`
 }
 
+func (i *InvestmentAccountOrFinancialInstrument1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountOrFinancialInstrument1Choice) AddInvestmentAccount() *InvestmentAccount13 {
 	i.InvestmentAccount = new(InvestmentAccount13)
 	return i.InvestmentAccount
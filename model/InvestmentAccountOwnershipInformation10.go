@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Characteristics of the ownership of an investment account.
 type InvestmentAccountOwnershipInformation10 struct {
 
@@ -37,6 +41,10 @@ type InvestmentAccountOwnershipInformation10 struct {
 	FATCAStatus []*FATCAStatus1 `xml:"FATCASts,omitempty"`
 }
 
+func (i *InvestmentAccountOwnershipInformation10) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountOwnershipInformation10) AddParty() *Party23Choice {
 	i.Party = new(Party23Choice)
 	return i.Party
@@ -197,6 +205,11 @@ func (i *InvestmentAccountOwnershipInformation10) SetOwnershipBeneficiaryRate(va
 	i.OwnershipBeneficiaryRate = (*PercentageRate)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation10) SetOwnershipBeneficiaryRateFromDecimal(value decimal.Decimal) {
+	i.OwnershipBeneficiaryRate = new(PercentageRate)
+	i.OwnershipBeneficiaryRate.FromDecimal(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation10) SetClientIdentification(value string) {
 	i.ClientIdentification = (*Max35Text)(&value)
 }
@@ -205,10 +218,20 @@ func (i *InvestmentAccountOwnershipInformation10) SetFiscalExemption(value strin
 	i.FiscalExemption = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation10) SetFiscalExemptionFromBool(value bool) {
+	i.FiscalExemption = new(YesNoIndicator)
+	i.FiscalExemption.FromBool(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation10) SetSignatoryRightIndicator(value string) {
 	i.SignatoryRightIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation10) SetSignatoryRightIndicatorFromBool(value bool) {
+	i.SignatoryRightIndicator = new(YesNoIndicator)
+	i.SignatoryRightIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation10) AddMiFIDClassification() *MiFIDClassification1 {
 	i.MiFIDClassification = new(MiFIDClassification1)
 	return i.MiFIDClassification
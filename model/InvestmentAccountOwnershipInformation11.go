@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Characteristics of the ownership of an investment account.
 type InvestmentAccountOwnershipInformation11 struct {
 
@@ -37,6 +41,10 @@ type InvestmentAccountOwnershipInformation11 struct {
 	FATCAStatus []*FATCAStatus1 `xml:"FATCASts,omitempty"`
 }
 
+func (i *InvestmentAccountOwnershipInformation11) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountOwnershipInformation11) AddParty() *Party24Choice {
 	i.Party = new(Party24Choice)
 	return i.Party
@@ -51,6 +59,11 @@ func (i *InvestmentAccountOwnershipInformation11) SetOwnershipBeneficiaryRate(va
 	i.OwnershipBeneficiaryRate = (*PercentageRate)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation11) SetOwnershipBeneficiaryRateFromDecimal(value decimal.Decimal) {
+	i.OwnershipBeneficiaryRate = new(PercentageRate)
+	i.OwnershipBeneficiaryRate.FromDecimal(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation11) SetClientIdentification(value string) {
 	i.ClientIdentification = (*Max35Text)(&value)
 }
@@ -59,10 +72,20 @@ func (i *InvestmentAccountOwnershipInformation11) SetFiscalExemption(value strin
 	i.FiscalExemption = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation11) SetFiscalExemptionFromBool(value bool) {
+	i.FiscalExemption = new(YesNoIndicator)
+	i.FiscalExemption.FromBool(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation11) SetSignatoryRightIndicator(value string) {
 	i.SignatoryRightIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation11) SetSignatoryRightIndicatorFromBool(value bool) {
+	i.SignatoryRightIndicator = new(YesNoIndicator)
+	i.SignatoryRightIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation11) AddModifiedInvestorProfileValidation() *ModificationScope19 {
 	newValue := new(ModificationScope19)
 	i.ModifiedInvestorProfileValidation = append(i.ModifiedInvestorProfileValidation, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Characteristics of the ownership of a securities account.
 type InvestmentAccountOwnershipInformation12 struct {
 
@@ -85,6 +89,10 @@ type InvestmentAccountOwnershipInformation12 struct {
 	AdditionalInformation []*AccountRestrictions1 `xml:"AddtlInf,omitempty"`
 }
 
+func (i *InvestmentAccountOwnershipInformation12) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountOwnershipInformation12) AddParty() *Party31Choice {
 	i.Party = new(Party31Choice)
 	return i.Party
@@ -245,6 +253,11 @@ func (i *InvestmentAccountOwnershipInformation12) SetOwnershipBeneficiaryRate(va
 	i.OwnershipBeneficiaryRate = (*PercentageRate)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation12) SetOwnershipBeneficiaryRateFromDecimal(value decimal.Decimal) {
+	i.OwnershipBeneficiaryRate = new(PercentageRate)
+	i.OwnershipBeneficiaryRate.FromDecimal(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation12) SetClientIdentification(value string) {
 	i.ClientIdentification = (*Max35Text)(&value)
 }
@@ -253,10 +266,20 @@ func (i *InvestmentAccountOwnershipInformation12) SetFiscalExemption(value strin
 	i.FiscalExemption = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation12) SetFiscalExemptionFromBool(value bool) {
+	i.FiscalExemption = new(YesNoIndicator)
+	i.FiscalExemption.FromBool(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation12) SetSignatoryRightIndicator(value string) {
 	i.SignatoryRightIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation12) SetSignatoryRightIndicatorFromBool(value bool) {
+	i.SignatoryRightIndicator = new(YesNoIndicator)
+	i.SignatoryRightIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation12) AddMiFIDClassification() *MiFIDClassification1 {
 	i.MiFIDClassification = new(MiFIDClassification1)
 	return i.MiFIDClassification
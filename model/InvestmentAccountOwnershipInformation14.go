@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Characteristics of the ownership of a securities account.
 type InvestmentAccountOwnershipInformation14 struct {
 
@@ -101,6 +105,10 @@ type InvestmentAccountOwnershipInformation14 struct {
 	ControllingParty *YesNoIndicator `xml:"CtrlgPty,omitempty"`
 }
 
+func (i *InvestmentAccountOwnershipInformation14) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountOwnershipInformation14) AddParty() *Party32Choice {
 	i.Party = new(Party32Choice)
 	return i.Party
@@ -270,10 +278,20 @@ func (i *InvestmentAccountOwnershipInformation14) SetFiscalExemption(value strin
 	i.FiscalExemption = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation14) SetFiscalExemptionFromBool(value bool) {
+	i.FiscalExemption = new(YesNoIndicator)
+	i.FiscalExemption.FromBool(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation14) SetSignatoryRightIndicator(value string) {
 	i.SignatoryRightIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation14) SetSignatoryRightIndicatorFromBool(value bool) {
+	i.SignatoryRightIndicator = new(YesNoIndicator)
+	i.SignatoryRightIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation14) AddMiFIDClassification() *MiFIDClassification1 {
 	i.MiFIDClassification = new(MiFIDClassification1)
 	return i.MiFIDClassification
@@ -721,6 +739,11 @@ func (i *InvestmentAccountOwnershipInformation14) SetFATCAReportingDate(value st
 	i.FATCAReportingDate = (*ISODate)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation14) SetFATCAReportingDateFromTime(value time.Time) {
+	i.FATCAReportingDate = new(ISODate)
+	i.FATCAReportingDate.FromTime(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation14) AddCRSFormType() *CRSForm1Choice {
 	newValue := new(CRSForm1Choice)
 	i.CRSFormType = append(i.CRSFormType, newValue)
@@ -1017,6 +1040,11 @@ func (i *InvestmentAccountOwnershipInformation14) SetCRSReportingDate(value stri
 	i.CRSReportingDate = (*ISODate)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation14) SetCRSReportingDateFromTime(value time.Time) {
+	i.CRSReportingDate = new(ISODate)
+	i.CRSReportingDate.FromTime(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation14) AddOtherIdentification() *GenericIdentification82 {
 	newValue := new(GenericIdentification82)
 	i.OtherIdentification = append(i.OtherIdentification, newValue)
@@ -1803,3 +1831,8 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (i *InvestmentAccountOwnershipInformation14) SetControllingParty(value string) {
 	i.ControllingParty = (*YesNoIndicator)(&value)
 }
+
+func (i *InvestmentAccountOwnershipInformation14) SetControllingPartyFromBool(value bool) {
+	i.ControllingParty = new(YesNoIndicator)
+	i.ControllingParty.FromBool(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Characteristics of the ownership of an investment account.
 type InvestmentAccountOwnershipInformation4 struct {
 
@@ -31,6 +35,10 @@ type InvestmentAccountOwnershipInformation4 struct {
 	ModifiedInvestorProfileValidation []*ModificationScope11 `xml:"ModfdInvstrPrflVldtn,omitempty"`
 }
 
+func (i *InvestmentAccountOwnershipInformation4) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountOwnershipInformation4) AddOrganisation() *Organisation3 {
 	i.Organisation = new(Organisation3)
 	return i.Organisation
@@ -53,6 +61,11 @@ func (i *InvestmentAccountOwnershipInformation4) SetOwnershipBeneficiaryRate(val
 	i.OwnershipBeneficiaryRate = (*PercentageRate)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation4) SetOwnershipBeneficiaryRateFromDecimal(value decimal.Decimal) {
+	i.OwnershipBeneficiaryRate = new(PercentageRate)
+	i.OwnershipBeneficiaryRate.FromDecimal(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation4) SetClientIdentification(value string) {
 	i.ClientIdentification = (*Max35Text)(&value)
 }
@@ -61,10 +74,20 @@ func (i *InvestmentAccountOwnershipInformation4) SetFiscalExemption(value string
 	i.FiscalExemption = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation4) SetFiscalExemptionFromBool(value bool) {
+	i.FiscalExemption = new(YesNoIndicator)
+	i.FiscalExemption.FromBool(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation4) SetSignatoryRightIndicator(value string) {
 	i.SignatoryRightIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation4) SetSignatoryRightIndicatorFromBool(value bool) {
+	i.SignatoryRightIndicator = new(YesNoIndicator)
+	i.SignatoryRightIndicator.FromBool(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation4) AddModifiedInvestorProfileValidation() *ModificationScope11 {
 	newValue := new(ModificationScope11)
 	i.ModifiedInvestorProfileValidation = append(i.ModifiedInvestorProfileValidation, newValue)
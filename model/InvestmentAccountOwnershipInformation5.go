@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Characteristics of the ownership of an investment account.
 type InvestmentAccountOwnershipInformation5 struct {
 
@@ -31,6 +35,10 @@ type InvestmentAccountOwnershipInformation5 struct {
 	SignatoryRightIndicator *YesNoIndicator `xml:"SgntryRghtInd,omitempty"`
 }
 
+func (i *InvestmentAccountOwnershipInformation5) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountOwnershipInformation5) AddOrganisation() *Organisation2 {
 	i.Organisation = new(Organisation2)
 	return i.Organisation
@@ -199,6 +207,11 @@ func (i *InvestmentAccountOwnershipInformation5) SetOwnershipBeneficiaryRate(val
 	i.OwnershipBeneficiaryRate = (*PercentageRate)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation5) SetOwnershipBeneficiaryRateFromDecimal(value decimal.Decimal) {
+	i.OwnershipBeneficiaryRate = new(PercentageRate)
+	i.OwnershipBeneficiaryRate.FromDecimal(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation5) SetClientIdentification(value string) {
 	i.ClientIdentification = (*Max35Text)(&value)
 }
@@ -207,6 +220,16 @@ func (i *InvestmentAccountOwnershipInformation5) SetFiscalExemption(value string
 	i.FiscalExemption = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentAccountOwnershipInformation5) SetFiscalExemptionFromBool(value bool) {
+	i.FiscalExemption = new(YesNoIndicator)
+	i.FiscalExemption.FromBool(value)
+}
+
 func (i *InvestmentAccountOwnershipInformation5) SetSignatoryRightIndicator(value string) {
 	i.SignatoryRightIndicator = (*YesNoIndicator)(&value)
 }
+
+func (i *InvestmentAccountOwnershipInformation5) SetSignatoryRightIndicatorFromBool(value bool) {
+	i.SignatoryRightIndicator = new(YesNoIndicator)
+	i.SignatoryRightIndicator.FromBool(value)
+}
@@ -10,6 +10,10 @@ type InvestmentAccountSelection2 struct {
 	OtherAccountSelectionData *InvestmentAccount29 `xml:"OthrAcctSelctnData"`
 }
 
+func (i *InvestmentAccountSelection2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountSelection2) AddAccountIdentification() *AccountIdentification1 {
 	i.AccountIdentification = new(AccountIdentification1)
 	return i.AccountIdentification
@@ -10,6 +10,10 @@ type InvestmentAccountType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *InvestmentAccountType1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentAccountType1Choice) SetCode(value string) {
 	i.Code = (*FundCashAccount2Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details about the investment fund class.
 type InvestmentFund1 struct {
 
@@ -25,6 +29,10 @@ type InvestmentFund1 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *InvestmentFund1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFund1) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	i.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return i.FinancialInstrumentIdentification
@@ -38,10 +46,20 @@ func (i *InvestmentFund1) SetTotalUnitsOutstanding(value string) {
 	i.TotalUnitsOutstanding = (*DecimalNumber)(&value)
 }
 
+func (i *InvestmentFund1) SetTotalUnitsOutstandingFromDecimal(value decimal.Decimal) {
+	i.TotalUnitsOutstanding = new(DecimalNumber)
+	i.TotalUnitsOutstanding.FromDecimal(value)
+}
+
 func (i *InvestmentFund1) SetTransactionalUnits(value string) {
 	i.TransactionalUnits = (*DecimalNumber)(&value)
 }
 
+func (i *InvestmentFund1) SetTransactionalUnitsFromDecimal(value decimal.Decimal) {
+	i.TransactionalUnits = new(DecimalNumber)
+	i.TransactionalUnits.FromDecimal(value)
+}
+
 func (i *InvestmentFund1) AddTotalValue() *AmountAndDirection30 {
 	i.TotalValue = new(AmountAndDirection30)
 	return i.TotalValue
@@ -16,6 +16,10 @@ type InvestmentFundCashSettlementInformation3 struct {
 	SavingsPlanPaymentInstrument *PaymentInstrument8 `xml:"SvgsPlanPmtInstrm,omitempty"`
 }
 
+func (i *InvestmentFundCashSettlementInformation3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundCashSettlementInformation3) AddSubscriptionPaymentInstrument() *PaymentInstrument8 {
 	i.SubscriptionPaymentInstrument = new(PaymentInstrument8)
 	return i.SubscriptionPaymentInstrument
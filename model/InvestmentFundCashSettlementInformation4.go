@@ -19,6 +19,10 @@ type InvestmentFundCashSettlementInformation4 struct {
 	SavingsPlanPaymentInstrument *PaymentInstrument8 `xml:"SvgsPlanPmtInstrm,omitempty"`
 }
 
+func (i *InvestmentFundCashSettlementInformation4) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundCashSettlementInformation4) SetModificationScopeIndication(value string) {
 	i.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
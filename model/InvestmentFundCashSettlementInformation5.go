@@ -19,6 +19,10 @@ type InvestmentFundCashSettlementInformation5 struct {
 	InterestPaymentInstrument *PaymentInstrument10 `xml:"IntrstPmtInstrm,omitempty"`
 }
 
+func (i *InvestmentFundCashSettlementInformation5) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundCashSettlementInformation5) AddSubscriptionPaymentInstrument() *PaymentInstrument11 {
 	i.SubscriptionPaymentInstrument = new(PaymentInstrument11)
 	return i.SubscriptionPaymentInstrument
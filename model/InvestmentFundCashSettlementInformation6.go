@@ -22,6 +22,10 @@ type InvestmentFundCashSettlementInformation6 struct {
 	InterestPaymentInstrument *PaymentInstrument10 `xml:"IntrstPmtInstrm,omitempty"`
 }
 
+func (i *InvestmentFundCashSettlementInformation6) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundCashSettlementInformation6) SetModificationScopeIndication(value string) {
 	i.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
@@ -19,6 +19,10 @@ type InvestmentFundCashSettlementInformation7 struct {
 	InterestPaymentInstrument *PaymentInstrument12 `xml:"IntrstPmtInstrm,omitempty"`
 }
 
+func (i *InvestmentFundCashSettlementInformation7) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundCashSettlementInformation7) AddSubscriptionPaymentInstrument() *PaymentInstrument11 {
 	i.SubscriptionPaymentInstrument = new(PaymentInstrument11)
 	return i.SubscriptionPaymentInstrument
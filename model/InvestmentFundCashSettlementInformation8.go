@@ -22,6 +22,10 @@ type InvestmentFundCashSettlementInformation8 struct {
 	InterestPaymentInstrument *PaymentInstrument12 `xml:"IntrstPmtInstrm,omitempty"`
 }
 
+func (i *InvestmentFundCashSettlementInformation8) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundCashSettlementInformation8) SetModificationScopeIndication(value string) {
 	i.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
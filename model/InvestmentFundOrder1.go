@@ -10,6 +10,10 @@ type InvestmentFundOrder1 struct {
 	OrderReferences []*InvestmentFundOrder5 `xml:"OrdrRefs"`
 }
 
+func (i *InvestmentFundOrder1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundOrder1) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
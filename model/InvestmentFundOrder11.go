@@ -19,6 +19,10 @@ type InvestmentFundOrder11 struct {
 	CancellationReason *CancellationReason31Choice `xml:"CxlRsn,omitempty"`
 }
 
+func (i *InvestmentFundOrder11) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundOrder11) SetOrderReference(value string) {
 	i.OrderReference = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type InvestmentFundOrder2 struct {
 	FinancialInstrumentDetails *FinancialInstrument10 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (i *InvestmentFundOrder2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundOrder2) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
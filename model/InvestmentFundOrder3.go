@@ -22,6 +22,10 @@ type InvestmentFundOrder3 struct {
 	FinancialInstrumentDetails *FinancialInstrument10 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (i *InvestmentFundOrder3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundOrder3) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
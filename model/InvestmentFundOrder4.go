@@ -10,6 +10,10 @@ type InvestmentFundOrder4 struct {
 	MasterReference *Max35Text `xml:"MstrRef,omitempty"`
 }
 
+func (i *InvestmentFundOrder4) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundOrder4) SetOrderReference(value string) {
 	i.OrderReference = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type InvestmentFundOrder5 struct {
 	CancellationReference *Max35Text `xml:"CxlRef,omitempty"`
 }
 
+func (i *InvestmentFundOrder5) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundOrder5) SetOrderReference(value string) {
 	i.OrderReference = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type InvestmentFundOrder8 struct {
 	FinancialInstrumentDetails *FinancialInstrument57 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (i *InvestmentFundOrder8) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundOrder8) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
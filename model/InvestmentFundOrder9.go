@@ -16,6 +16,10 @@ type InvestmentFundOrder9 struct {
 	CancellationReason *CancellationReason32Choice `xml:"CxlRsn,omitempty"`
 }
 
+func (i *InvestmentFundOrder9) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundOrder9) SetOrderReference(value string) {
 	i.OrderReference = (*Max35Text)(&value)
 }
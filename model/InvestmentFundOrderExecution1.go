@@ -13,6 +13,10 @@ type InvestmentFundOrderExecution1 struct {
 	OrderReferences []*InvestmentFundOrderExecution2 `xml:"OrdrRefs"`
 }
 
+func (i *InvestmentFundOrderExecution1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundOrderExecution1) SetMasterReference(value string) {
 	i.MasterReference = (*Max35Text)(&value)
 }
@@ -21,6 +25,11 @@ func (i *InvestmentFundOrderExecution1) SetAmendmentIndicator(value string) {
 	i.AmendmentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentFundOrderExecution1) SetAmendmentIndicatorFromBool(value bool) {
+	i.AmendmentIndicator = new(YesNoIndicator)
+	i.AmendmentIndicator.FromBool(value)
+}
+
 func (i *InvestmentFundOrderExecution1) AddOrderReferences() *InvestmentFundOrderExecution2 {
 	newValue := new(InvestmentFundOrderExecution2)
 	i.OrderReferences = append(i.OrderReferences, newValue)
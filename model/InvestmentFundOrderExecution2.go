@@ -13,6 +13,10 @@ type InvestmentFundOrderExecution2 struct {
 	DealReference *Max35Text `xml:"DealRef,omitempty"`
 }
 
+func (i *InvestmentFundOrderExecution2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundOrderExecution2) SetOrderReference(value string) {
 	i.OrderReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type InvestmentFundRole2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *InvestmentFundRole2Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundRole2Choice) SetCode(value string) {
 	i.Code = (*InvestmentFundRole2Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Creation/cancellation of investment units on the books of the fund or its designated agent, as a result of executing an investment fund order.
 type InvestmentFundTransaction2 struct {
 
@@ -64,6 +70,10 @@ type InvestmentFundTransaction2 struct {
 	PriceDetails *UnitPrice1 `xml:"PricDtls,omitempty"`
 }
 
+func (i *InvestmentFundTransaction2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundTransaction2) AddTransactionType() *TransactionType1CodeChoice {
 	i.TransactionType = new(TransactionType1CodeChoice)
 	return i.TransactionType
@@ -102,21 +112,40 @@ func (i *InvestmentFundTransaction2) SetOrderDateTime(value string) {
 	i.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (i *InvestmentFundTransaction2) SetOrderDateTimeFromTime(value time.Time) {
+	i.OrderDateTime = new(ISODateTime)
+	i.OrderDateTime.FromTime(value)
+}
+
 func (i *InvestmentFundTransaction2) SetSettledTransactionIndicator(value string) {
 	i.SettledTransactionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentFundTransaction2) SetSettledTransactionIndicatorFromBool(value bool) {
+	i.SettledTransactionIndicator = new(YesNoIndicator)
+	i.SettledTransactionIndicator.FromBool(value)
+}
+
 func (i *InvestmentFundTransaction2) SetRegisteredTransactionIndicator(value string) {
 	i.RegisteredTransactionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentFundTransaction2) SetRegisteredTransactionIndicatorFromBool(value bool) {
+	i.RegisteredTransactionIndicator = new(YesNoIndicator)
+	i.RegisteredTransactionIndicator.FromBool(value)
+}
+
 func (i *InvestmentFundTransaction2) AddUnitsQuantity() *FinancialInstrumentQuantity1 {
 	i.UnitsQuantity = new(FinancialInstrumentQuantity1)
 	return i.UnitsQuantity
 }
 
-func (i *InvestmentFundTransaction2) SetCreditDebit(value string) {
+func (i *InvestmentFundTransaction2) SetCreditDebit(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	i.CreditDebit = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (i *InvestmentFundTransaction2) SetReversal(value string) {
@@ -127,10 +156,19 @@ func (i *InvestmentFundTransaction2) SetGrossSettlementAmount(value, currency st
 	i.GrossSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InvestmentFundTransaction2) SetGrossSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.GrossSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InvestmentFundTransaction2) SetSettlementDate(value string) {
 	i.SettlementDate = (*ISODate)(&value)
 }
 
+func (i *InvestmentFundTransaction2) SetSettlementDateFromTime(value time.Time) {
+	i.SettlementDate = new(ISODate)
+	i.SettlementDate.FromTime(value)
+}
+
 func (i *InvestmentFundTransaction2) AddTradeDateTime() *DateAndDateTimeChoice {
 	i.TradeDateTime = new(DateAndDateTimeChoice)
 	return i.TradeDateTime
@@ -140,10 +178,20 @@ func (i *InvestmentFundTransaction2) SetCumDividendIndicator(value string) {
 	i.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentFundTransaction2) SetCumDividendIndicatorFromBool(value bool) {
+	i.CumDividendIndicator = new(YesNoIndicator)
+	i.CumDividendIndicator.FromBool(value)
+}
+
 func (i *InvestmentFundTransaction2) SetPartiallyExecutedIndicator(value string) {
 	i.PartiallyExecutedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentFundTransaction2) SetPartiallyExecutedIndicatorFromBool(value bool) {
+	i.PartiallyExecutedIndicator = new(YesNoIndicator)
+	i.PartiallyExecutedIndicator.FromBool(value)
+}
+
 func (i *InvestmentFundTransaction2) AddPriceDetails() *UnitPrice1 {
 	i.PriceDetails = new(UnitPrice1)
 	return i.PriceDetails
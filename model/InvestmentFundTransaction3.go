@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Creation/cancellation of investment units on the books of the fund or its designated agent, as a result of executing an investment fund order.
 type InvestmentFundTransaction3 struct {
 
@@ -73,6 +79,10 @@ type InvestmentFundTransaction3 struct {
 	PriceDetails *UnitPrice11 `xml:"PricDtls,omitempty"`
 }
 
+func (i *InvestmentFundTransaction3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundTransaction3) SetTransactionType(value string) {
 	i.TransactionType = (*TransactionType2Code)(&value)
 }
@@ -121,21 +131,40 @@ func (i *InvestmentFundTransaction3) SetOrderDateTime(value string) {
 	i.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (i *InvestmentFundTransaction3) SetOrderDateTimeFromTime(value time.Time) {
+	i.OrderDateTime = new(ISODateTime)
+	i.OrderDateTime.FromTime(value)
+}
+
 func (i *InvestmentFundTransaction3) SetSettledTransactionIndicator(value string) {
 	i.SettledTransactionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentFundTransaction3) SetSettledTransactionIndicatorFromBool(value bool) {
+	i.SettledTransactionIndicator = new(YesNoIndicator)
+	i.SettledTransactionIndicator.FromBool(value)
+}
+
 func (i *InvestmentFundTransaction3) SetRegisteredTransactionIndicator(value string) {
 	i.RegisteredTransactionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentFundTransaction3) SetRegisteredTransactionIndicatorFromBool(value bool) {
+	i.RegisteredTransactionIndicator = new(YesNoIndicator)
+	i.RegisteredTransactionIndicator.FromBool(value)
+}
+
 func (i *InvestmentFundTransaction3) AddUnitsQuantity() *FinancialInstrumentQuantity1 {
 	i.UnitsQuantity = new(FinancialInstrumentQuantity1)
 	return i.UnitsQuantity
 }
 
-func (i *InvestmentFundTransaction3) SetCreditDebit(value string) {
+func (i *InvestmentFundTransaction3) SetCreditDebit(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	i.CreditDebit = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (i *InvestmentFundTransaction3) SetReversal(value string) {
@@ -146,10 +175,19 @@ func (i *InvestmentFundTransaction3) SetSettlementAmount(value, currency string)
 	i.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InvestmentFundTransaction3) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InvestmentFundTransaction3) SetSettlementDate(value string) {
 	i.SettlementDate = (*ISODate)(&value)
 }
 
+func (i *InvestmentFundTransaction3) SetSettlementDateFromTime(value time.Time) {
+	i.SettlementDate = new(ISODate)
+	i.SettlementDate.FromTime(value)
+}
+
 func (i *InvestmentFundTransaction3) AddTradeDateTime() *DateAndDateTimeChoice {
 	i.TradeDateTime = new(DateAndDateTimeChoice)
 	return i.TradeDateTime
@@ -159,10 +197,20 @@ func (i *InvestmentFundTransaction3) SetCumDividendIndicator(value string) {
 	i.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentFundTransaction3) SetCumDividendIndicatorFromBool(value bool) {
+	i.CumDividendIndicator = new(YesNoIndicator)
+	i.CumDividendIndicator.FromBool(value)
+}
+
 func (i *InvestmentFundTransaction3) SetPartiallyExecutedIndicator(value string) {
 	i.PartiallyExecutedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentFundTransaction3) SetPartiallyExecutedIndicatorFromBool(value bool) {
+	i.PartiallyExecutedIndicator = new(YesNoIndicator)
+	i.PartiallyExecutedIndicator.FromBool(value)
+}
+
 func (i *InvestmentFundTransaction3) AddPriceDetails() *UnitPrice11 {
 	i.PriceDetails = new(UnitPrice11)
 	return i.PriceDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Creation/cancellation of investment units on the books of the fund or its designated agent, as a result of executing an investment fund order.
 type InvestmentFundTransaction4 struct {
 
@@ -64,6 +70,10 @@ type InvestmentFundTransaction4 struct {
 	PriceDetails *UnitPrice20 `xml:"PricDtls,omitempty"`
 }
 
+func (i *InvestmentFundTransaction4) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundTransaction4) AddEventType() *TransactionType1Choice {
 	i.EventType = new(TransactionType1Choice)
 	return i.EventType
@@ -101,21 +111,40 @@ func (i *InvestmentFundTransaction4) SetOrderDateTime(value string) {
 	i.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (i *InvestmentFundTransaction4) SetOrderDateTimeFromTime(value time.Time) {
+	i.OrderDateTime = new(ISODateTime)
+	i.OrderDateTime.FromTime(value)
+}
+
 func (i *InvestmentFundTransaction4) SetSettledTransactionIndicator(value string) {
 	i.SettledTransactionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentFundTransaction4) SetSettledTransactionIndicatorFromBool(value bool) {
+	i.SettledTransactionIndicator = new(YesNoIndicator)
+	i.SettledTransactionIndicator.FromBool(value)
+}
+
 func (i *InvestmentFundTransaction4) SetRegisteredTransactionIndicator(value string) {
 	i.RegisteredTransactionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentFundTransaction4) SetRegisteredTransactionIndicatorFromBool(value bool) {
+	i.RegisteredTransactionIndicator = new(YesNoIndicator)
+	i.RegisteredTransactionIndicator.FromBool(value)
+}
+
 func (i *InvestmentFundTransaction4) AddUnitsQuantity() *FinancialInstrumentQuantity1 {
 	i.UnitsQuantity = new(FinancialInstrumentQuantity1)
 	return i.UnitsQuantity
 }
 
-func (i *InvestmentFundTransaction4) SetCreditDebit(value string) {
+func (i *InvestmentFundTransaction4) SetCreditDebit(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	i.CreditDebit = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (i *InvestmentFundTransaction4) SetReversal(value string) {
@@ -126,10 +155,19 @@ func (i *InvestmentFundTransaction4) SetSettlementAmount(value, currency string)
 	i.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InvestmentFundTransaction4) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InvestmentFundTransaction4) SetSettlementDate(value string) {
 	i.SettlementDate = (*ISODate)(&value)
 }
 
+func (i *InvestmentFundTransaction4) SetSettlementDateFromTime(value time.Time) {
+	i.SettlementDate = new(ISODate)
+	i.SettlementDate.FromTime(value)
+}
+
 func (i *InvestmentFundTransaction4) AddTradeDateTime() *DateAndDateTimeChoice {
 	i.TradeDateTime = new(DateAndDateTimeChoice)
 	return i.TradeDateTime
@@ -139,10 +177,20 @@ func (i *InvestmentFundTransaction4) SetCumDividendIndicator(value string) {
 	i.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentFundTransaction4) SetCumDividendIndicatorFromBool(value bool) {
+	i.CumDividendIndicator = new(YesNoIndicator)
+	i.CumDividendIndicator.FromBool(value)
+}
+
 func (i *InvestmentFundTransaction4) SetPartiallyExecutedIndicator(value string) {
 	i.PartiallyExecutedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentFundTransaction4) SetPartiallyExecutedIndicatorFromBool(value bool) {
+	i.PartiallyExecutedIndicator = new(YesNoIndicator)
+	i.PartiallyExecutedIndicator.FromBool(value)
+}
+
 func (i *InvestmentFundTransaction4) AddPriceDetails() *UnitPrice20 {
 	i.PriceDetails = new(UnitPrice20)
 	return i.PriceDetails
@@ -10,6 +10,10 @@ type InvestmentFundTransactionInType1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (i *InvestmentFundTransactionInType1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundTransactionInType1) SetStructured(value string) {
 	i.Structured = (*InvestmentFundTransactionInType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type InvestmentFundTransactionInType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *InvestmentFundTransactionInType1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundTransactionInType1Choice) SetCode(value string) {
 	i.Code = (*InvestmentFundTransactionInType1Code)(&value)
 }
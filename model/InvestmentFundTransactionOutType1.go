@@ -10,6 +10,10 @@ type InvestmentFundTransactionOutType1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (i *InvestmentFundTransactionOutType1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundTransactionOutType1) SetStructured(value string) {
 	i.Structured = (*InvestmentFundTransactionOutType4Code)(&value)
 }
@@ -10,6 +10,10 @@ type InvestmentFundTransactionOutType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *InvestmentFundTransactionOutType1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundTransactionOutType1Choice) SetCode(value string) {
 	i.Code = (*InvestmentFundTransactionOutType1Code)(&value)
 }
@@ -28,6 +28,10 @@ type InvestmentFundTransactionsByFund1 struct {
 	BalanceByPage *PaginationBalance1 `xml:"BalByPg,omitempty"`
 }
 
+func (i *InvestmentFundTransactionsByFund1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundTransactionsByFund1) AddIdentification() *SecurityIdentification1Choice {
 	i.Identification = new(SecurityIdentification1Choice)
 	return i.Identification
@@ -28,6 +28,10 @@ type InvestmentFundTransactionsByFund2 struct {
 	BalanceByPage *PaginationBalance1 `xml:"BalByPg,omitempty"`
 }
 
+func (i *InvestmentFundTransactionsByFund2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundTransactionsByFund2) AddIdentification() *SecurityIdentification3Choice {
 	i.Identification = new(SecurityIdentification3Choice)
 	return i.Identification
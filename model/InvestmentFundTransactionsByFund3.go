@@ -28,6 +28,10 @@ type InvestmentFundTransactionsByFund3 struct {
 	BalanceByPage *PaginationBalance2 `xml:"BalByPg,omitempty"`
 }
 
+func (i *InvestmentFundTransactionsByFund3) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundTransactionsByFund3) AddIdentification() *SecurityIdentification3Choice {
 	i.Identification = new(SecurityIdentification3Choice)
 	return i.Identification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // An investor's instruction to either subscribe or redeem an amount of money or its equivalent, eg, other assets, into or out of an investment fund.
 type InvestmentFundsOrderBreakdown1 struct {
 
@@ -13,6 +17,10 @@ type InvestmentFundsOrderBreakdown1 struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (i *InvestmentFundsOrderBreakdown1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundsOrderBreakdown1) SetOrderBreakdownType(value string) {
 	i.OrderBreakdownType = (*FundOrderType5Code)(&value)
 }
@@ -24,3 +32,7 @@ func (i *InvestmentFundsOrderBreakdown1) SetExtendedOrderBreakdownType(value str
 func (i *InvestmentFundsOrderBreakdown1) SetAmount(value, currency string) {
 	i.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (i *InvestmentFundsOrderBreakdown1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
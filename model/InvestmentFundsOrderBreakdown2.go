@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // An investor's instruction to either subscribe or redeem an amount of money or its equivalent, for example, other assets, into or out of an investment fund.
 type InvestmentFundsOrderBreakdown2 struct {
 
@@ -10,6 +14,10 @@ type InvestmentFundsOrderBreakdown2 struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (i *InvestmentFundsOrderBreakdown2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentFundsOrderBreakdown2) AddOrderBreakdownType() *OrderBreakdownType1Choice {
 	i.OrderBreakdownType = new(OrderBreakdownType1Choice)
 	return i.OrderBreakdownType
@@ -18,3 +26,7 @@ func (i *InvestmentFundsOrderBreakdown2) AddOrderBreakdownType() *OrderBreakdown
 func (i *InvestmentFundsOrderBreakdown2) SetAmount(value, currency string) {
 	i.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (i *InvestmentFundsOrderBreakdown2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Plan that allows investors to schedule periodical investments or divestments, according to pre-defined criteria.
 type InvestmentPlan13 struct {
 
@@ -58,6 +64,10 @@ type InvestmentPlan13 struct {
 	InstalmentManagerRole *PartyRole4Choice `xml:"InstlmtMgrRole,omitempty"`
 }
 
+func (i *InvestmentPlan13) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentPlan13) AddFrequency() *Frequency20Choice {
 	i.Frequency = new(Frequency20Choice)
 	return i.Frequency
@@ -67,10 +77,20 @@ func (i *InvestmentPlan13) SetStartDate(value string) {
 	i.StartDate = (*ISODate)(&value)
 }
 
+func (i *InvestmentPlan13) SetStartDateFromTime(value time.Time) {
+	i.StartDate = new(ISODate)
+	i.StartDate.FromTime(value)
+}
+
 func (i *InvestmentPlan13) SetEndDate(value string) {
 	i.EndDate = (*ISODate)(&value)
 }
 
+func (i *InvestmentPlan13) SetEndDateFromTime(value time.Time) {
+	i.EndDate = new(ISODate)
+	i.EndDate.FromTime(value)
+}
+
 func (i *InvestmentPlan13) AddQuantity() *UnitsOrAmount1Choice {
 	i.Quantity = new(UnitsOrAmount1Choice)
 	return i.Quantity
@@ -80,6 +100,11 @@ func (i *InvestmentPlan13) SetGrossAmountIndicator(value string) {
 	i.GrossAmountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentPlan13) SetGrossAmountIndicatorFromBool(value bool) {
+	i.GrossAmountIndicator = new(YesNoIndicator)
+	i.GrossAmountIndicator.FromBool(value)
+}
+
 func (i *InvestmentPlan13) SetIncomePreference(value string) {
 	i.IncomePreference = (*IncomePreference2Code)(&value)
 }
@@ -93,6 +118,11 @@ func (i *InvestmentPlan13) SetTotalNumberOfInstalments(value string) {
 	i.TotalNumberOfInstalments = (*Number)(&value)
 }
 
+func (i *InvestmentPlan13) SetTotalNumberOfInstalmentsFromDecimal(value decimal.Decimal) {
+	i.TotalNumberOfInstalments = new(Number)
+	i.TotalNumberOfInstalments.FromDecimal(value)
+}
+
 func (i *InvestmentPlan13) SetRoundingDirection(value string) {
 	i.RoundingDirection = (*RoundingDirection1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Plan that allows investors to schedule periodical investments or divestments, according to pre-defined criteria.
 type InvestmentPlan4 struct {
 
@@ -40,6 +46,10 @@ type InvestmentPlan4 struct {
 	CashSettlement *InvestmentFundCashSettlementInformation3 `xml:"CshSttlm,omitempty"`
 }
 
+func (i *InvestmentPlan4) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentPlan4) SetFrequency(value string) {
 	i.Frequency = (*EventFrequency1Code)(&value)
 }
@@ -52,18 +62,37 @@ func (i *InvestmentPlan4) SetStartDate(value string) {
 	i.StartDate = (*ISODate)(&value)
 }
 
+func (i *InvestmentPlan4) SetStartDateFromTime(value time.Time) {
+	i.StartDate = new(ISODate)
+	i.StartDate.FromTime(value)
+}
+
 func (i *InvestmentPlan4) SetEndDate(value string) {
 	i.EndDate = (*ISODate)(&value)
 }
 
+func (i *InvestmentPlan4) SetEndDateFromTime(value time.Time) {
+	i.EndDate = new(ISODate)
+	i.EndDate.FromTime(value)
+}
+
 func (i *InvestmentPlan4) SetAmount(value, currency string) {
 	i.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InvestmentPlan4) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InvestmentPlan4) SetGrossAmountIndicator(value string) {
 	i.GrossAmountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentPlan4) SetGrossAmountIndicatorFromBool(value bool) {
+	i.GrossAmountIndicator = new(YesNoIndicator)
+	i.GrossAmountIndicator.FromBool(value)
+}
+
 func (i *InvestmentPlan4) SetIncomePreference(value string) {
 	i.IncomePreference = (*IncomePreference1Code)(&value)
 }
@@ -72,10 +101,20 @@ func (i *InvestmentPlan4) SetInitialNumberOfInstalment(value string) {
 	i.InitialNumberOfInstalment = (*Number)(&value)
 }
 
+func (i *InvestmentPlan4) SetInitialNumberOfInstalmentFromDecimal(value decimal.Decimal) {
+	i.InitialNumberOfInstalment = new(Number)
+	i.InitialNumberOfInstalment.FromDecimal(value)
+}
+
 func (i *InvestmentPlan4) SetTotalNumberOfInstalment(value string) {
 	i.TotalNumberOfInstalment = (*Number)(&value)
 }
 
+func (i *InvestmentPlan4) SetTotalNumberOfInstalmentFromDecimal(value decimal.Decimal) {
+	i.TotalNumberOfInstalment = new(Number)
+	i.TotalNumberOfInstalment.FromDecimal(value)
+}
+
 func (i *InvestmentPlan4) SetRoundingDirection(value string) {
 	i.RoundingDirection = (*RoundingDirection1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Plan that allows investors to schedule periodical investments or divestments, according to pre-defined criteria.
 type InvestmentPlan7 struct {
 
@@ -52,6 +58,10 @@ type InvestmentPlan7 struct {
 	InsuranceCover *InsuranceType1Choice `xml:"InsrncCover,omitempty"`
 }
 
+func (i *InvestmentPlan7) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentPlan7) AddFrequency() *Frequency19Choice {
 	i.Frequency = new(Frequency19Choice)
 	return i.Frequency
@@ -61,10 +71,20 @@ func (i *InvestmentPlan7) SetStartDate(value string) {
 	i.StartDate = (*ISODate)(&value)
 }
 
+func (i *InvestmentPlan7) SetStartDateFromTime(value time.Time) {
+	i.StartDate = new(ISODate)
+	i.StartDate.FromTime(value)
+}
+
 func (i *InvestmentPlan7) SetEndDate(value string) {
 	i.EndDate = (*ISODate)(&value)
 }
 
+func (i *InvestmentPlan7) SetEndDateFromTime(value time.Time) {
+	i.EndDate = new(ISODate)
+	i.EndDate.FromTime(value)
+}
+
 func (i *InvestmentPlan7) AddQuantity() *UnitsOrAmount1Choice {
 	i.Quantity = new(UnitsOrAmount1Choice)
 	return i.Quantity
@@ -74,6 +94,11 @@ func (i *InvestmentPlan7) SetGrossAmountIndicator(value string) {
 	i.GrossAmountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentPlan7) SetGrossAmountIndicatorFromBool(value bool) {
+	i.GrossAmountIndicator = new(YesNoIndicator)
+	i.GrossAmountIndicator.FromBool(value)
+}
+
 func (i *InvestmentPlan7) SetIncomePreference(value string) {
 	i.IncomePreference = (*IncomePreference1Code)(&value)
 }
@@ -82,10 +107,20 @@ func (i *InvestmentPlan7) SetInitialNumberOfInstalment(value string) {
 	i.InitialNumberOfInstalment = (*Number)(&value)
 }
 
+func (i *InvestmentPlan7) SetInitialNumberOfInstalmentFromDecimal(value decimal.Decimal) {
+	i.InitialNumberOfInstalment = new(Number)
+	i.InitialNumberOfInstalment.FromDecimal(value)
+}
+
 func (i *InvestmentPlan7) SetTotalNumberOfInstalment(value string) {
 	i.TotalNumberOfInstalment = (*Number)(&value)
 }
 
+func (i *InvestmentPlan7) SetTotalNumberOfInstalmentFromDecimal(value decimal.Decimal) {
+	i.TotalNumberOfInstalment = new(Number)
+	i.TotalNumberOfInstalment.FromDecimal(value)
+}
+
 func (i *InvestmentPlan7) SetRoundingDirection(value string) {
 	i.RoundingDirection = (*RoundingDirection1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Plan that allows investors to schedule periodical investments or divestments, according to pre-defined criteria.
 type InvestmentPlan8 struct {
 
@@ -52,6 +58,10 @@ type InvestmentPlan8 struct {
 	InsuranceCover *InsuranceType1Choice `xml:"InsrncCover,omitempty"`
 }
 
+func (i *InvestmentPlan8) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentPlan8) AddFrequency() *Frequency20Choice {
 	i.Frequency = new(Frequency20Choice)
 	return i.Frequency
@@ -61,10 +71,20 @@ func (i *InvestmentPlan8) SetStartDate(value string) {
 	i.StartDate = (*ISODate)(&value)
 }
 
+func (i *InvestmentPlan8) SetStartDateFromTime(value time.Time) {
+	i.StartDate = new(ISODate)
+	i.StartDate.FromTime(value)
+}
+
 func (i *InvestmentPlan8) SetEndDate(value string) {
 	i.EndDate = (*ISODate)(&value)
 }
 
+func (i *InvestmentPlan8) SetEndDateFromTime(value time.Time) {
+	i.EndDate = new(ISODate)
+	i.EndDate.FromTime(value)
+}
+
 func (i *InvestmentPlan8) AddQuantity() *UnitsOrAmount1Choice {
 	i.Quantity = new(UnitsOrAmount1Choice)
 	return i.Quantity
@@ -74,6 +94,11 @@ func (i *InvestmentPlan8) SetGrossAmountIndicator(value string) {
 	i.GrossAmountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvestmentPlan8) SetGrossAmountIndicatorFromBool(value bool) {
+	i.GrossAmountIndicator = new(YesNoIndicator)
+	i.GrossAmountIndicator.FromBool(value)
+}
+
 func (i *InvestmentPlan8) SetIncomePreference(value string) {
 	i.IncomePreference = (*IncomePreference1Code)(&value)
 }
@@ -82,10 +107,20 @@ func (i *InvestmentPlan8) SetInitialNumberOfInstalments(value string) {
 	i.InitialNumberOfInstalments = (*Number)(&value)
 }
 
+func (i *InvestmentPlan8) SetInitialNumberOfInstalmentsFromDecimal(value decimal.Decimal) {
+	i.InitialNumberOfInstalments = new(Number)
+	i.InitialNumberOfInstalments.FromDecimal(value)
+}
+
 func (i *InvestmentPlan8) SetTotalNumberOfInstalments(value string) {
 	i.TotalNumberOfInstalments = (*Number)(&value)
 }
 
+func (i *InvestmentPlan8) SetTotalNumberOfInstalmentsFromDecimal(value decimal.Decimal) {
+	i.TotalNumberOfInstalments = new(Number)
+	i.TotalNumberOfInstalments.FromDecimal(value)
+}
+
 func (i *InvestmentPlan8) SetRoundingDirection(value string) {
 	i.RoundingDirection = (*RoundingDirection1Code)(&value)
 }
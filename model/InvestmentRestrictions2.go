@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Investment restrictions linked to the instrument.
 type InvestmentRestrictions2 struct {
 
@@ -37,30 +41,61 @@ type InvestmentRestrictions2 struct {
 	HoldingTransferable *HoldingTransferable1Code `xml:"HldgTrfbl"`
 }
 
+func (i *InvestmentRestrictions2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestmentRestrictions2) SetMinimumInitialSubscriptionAmount(value, currency string) {
 	i.MinimumInitialSubscriptionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InvestmentRestrictions2) SetMinimumInitialSubscriptionAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.MinimumInitialSubscriptionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InvestmentRestrictions2) SetMinimumInitialSubscriptionUnits(value string) {
 	i.MinimumInitialSubscriptionUnits = (*Number)(&value)
 }
 
+func (i *InvestmentRestrictions2) SetMinimumInitialSubscriptionUnitsFromDecimal(value decimal.Decimal) {
+	i.MinimumInitialSubscriptionUnits = new(Number)
+	i.MinimumInitialSubscriptionUnits.FromDecimal(value)
+}
+
 func (i *InvestmentRestrictions2) SetMinimumSubsequentSubscriptionAmount(value, currency string) {
 	i.MinimumSubsequentSubscriptionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InvestmentRestrictions2) SetMinimumSubsequentSubscriptionAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.MinimumSubsequentSubscriptionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InvestmentRestrictions2) SetMinimumSubsequentSubscriptionUnits(value string) {
 	i.MinimumSubsequentSubscriptionUnits = (*Number)(&value)
 }
 
+func (i *InvestmentRestrictions2) SetMinimumSubsequentSubscriptionUnitsFromDecimal(value decimal.Decimal) {
+	i.MinimumSubsequentSubscriptionUnits = new(Number)
+	i.MinimumSubsequentSubscriptionUnits.FromDecimal(value)
+}
+
 func (i *InvestmentRestrictions2) SetMaximumRedemptionAmount(value, currency string) {
 	i.MaximumRedemptionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InvestmentRestrictions2) SetMaximumRedemptionAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.MaximumRedemptionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InvestmentRestrictions2) SetMaximumRedemptionUnits(value string) {
 	i.MaximumRedemptionUnits = (*Number)(&value)
 }
 
+func (i *InvestmentRestrictions2) SetMaximumRedemptionUnitsFromDecimal(value decimal.Decimal) {
+	i.MaximumRedemptionUnits = new(Number)
+	i.MaximumRedemptionUnits.FromDecimal(value)
+}
+
 func (i *InvestmentRestrictions2) SetOtherRedemptionRestrictions(value string) {
 	i.OtherRedemptionRestrictions = (*Max350Text)(&value)
 }
@@ -69,10 +104,19 @@ func (i *InvestmentRestrictions2) SetMinimumHoldingAmount(value, currency string
 	i.MinimumHoldingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InvestmentRestrictions2) SetMinimumHoldingAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.MinimumHoldingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InvestmentRestrictions2) SetMinimumHoldingUnits(value string) {
 	i.MinimumHoldingUnits = (*DecimalNumber)(&value)
 }
 
+func (i *InvestmentRestrictions2) SetMinimumHoldingUnitsFromDecimal(value decimal.Decimal) {
+	i.MinimumHoldingUnits = new(DecimalNumber)
+	i.MinimumHoldingUnits.FromDecimal(value)
+}
+
 func (i *InvestmentRestrictions2) SetMinimumHoldingPeriod(value string) {
 	i.MinimumHoldingPeriod = (*Max70Text)(&value)
 }
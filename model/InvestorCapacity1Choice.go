@@ -10,6 +10,10 @@ type InvestorCapacity1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (i *InvestorCapacity1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestorCapacity1Choice) SetCode(value string) {
 	i.Code = (*Eligibility1Code)(&value)
 }
@@ -10,6 +10,10 @@ type InvestorCapacity3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (i *InvestorCapacity3Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestorCapacity3Choice) SetCode(value string) {
 	i.Code = (*Eligibility1Code)(&value)
 }
@@ -10,6 +10,10 @@ type InvestorCapacity4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (i *InvestorCapacity4Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestorCapacity4Choice) SetCode(value string) {
 	i.Code = (*Eligibility1Code)(&value)
 }
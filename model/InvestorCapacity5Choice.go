@@ -10,6 +10,10 @@ type InvestorCapacity5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *InvestorCapacity5Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestorCapacity5Choice) SetCode(value string) {
 	i.Code = (*Eligibility1Code)(&value)
 }
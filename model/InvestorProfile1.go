@@ -19,6 +19,10 @@ type InvestorProfile1 struct {
 	MarketMaker *MarketMakerProfile1 `xml:"MktMakr,omitempty"`
 }
 
+func (i *InvestorProfile1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestorProfile1) AddType() *ProfileType1Choice {
 	i.Type = new(ProfileType1Choice)
 	return i.Type
@@ -10,6 +10,10 @@ type InvestorProfileStatus1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *InvestorProfileStatus1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvestorProfileStatus1Choice) SetCode(value string) {
 	i.Code = (*InvestorProfileStatus1Code)(&value)
 }
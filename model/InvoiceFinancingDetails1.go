@@ -16,6 +16,10 @@ type InvoiceFinancingDetails1 struct {
 	InstalmentFinancingInformation []*InstalmentFinancingInformation1 `xml:"InstlmtFincgInf,omitempty"`
 }
 
+func (i *InvoiceFinancingDetails1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvoiceFinancingDetails1) AddOriginalInvoiceInformation() *OriginalInvoiceInformation1 {
 	i.OriginalInvoiceInformation = new(OriginalInvoiceInformation1)
 	return i.OriginalInvoiceInformation
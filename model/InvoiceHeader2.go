@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Collection of data for that is exchanged between two or more parties in written, printed or electronic form.
 type InvoiceHeader2 struct {
 
@@ -32,6 +36,10 @@ type InvoiceHeader2 struct {
 	IncludedNote []*AdditionalInformation6 `xml:"InclNote,omitempty"`
 }
 
+func (i *InvoiceHeader2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvoiceHeader2) SetIdentification(value string) {
 	i.Identification = (*Max35Text)(&value)
 }
@@ -48,6 +56,11 @@ func (i *InvoiceHeader2) SetIssueDateTime(value string) {
 	i.IssueDateTime = (*ISODateTime)(&value)
 }
 
+func (i *InvoiceHeader2) SetIssueDateTimeFromTime(value time.Time) {
+	i.IssueDateTime = new(ISODateTime)
+	i.IssueDateTime.FromTime(value)
+}
+
 func (i *InvoiceHeader2) AddIssuer() *TradeParty3 {
 	i.Issuer = new(TradeParty3)
 	return i.Issuer
@@ -61,6 +74,11 @@ func (i *InvoiceHeader2) SetCopyIndicator(value string) {
 	i.CopyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (i *InvoiceHeader2) SetCopyIndicatorFromBool(value bool) {
+	i.CopyIndicator = new(YesNoIndicator)
+	i.CopyIndicator.FromBool(value)
+}
+
 func (i *InvoiceHeader2) SetDocumentPurpose(value string) {
 	i.DocumentPurpose = (*ExternalDocumentPurpose1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies a document by a unique identification and a date of issue.
 type InvoiceIdentification1 struct {
 
@@ -10,6 +14,10 @@ type InvoiceIdentification1 struct {
 	IssueDate *ISODate `xml:"IsseDt"`
 }
 
+func (i *InvoiceIdentification1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvoiceIdentification1) SetInvoiceNumber(value string) {
 	i.InvoiceNumber = (*Max35Text)(&value)
 }
@@ -17,3 +25,8 @@ func (i *InvoiceIdentification1) SetInvoiceNumber(value string) {
 func (i *InvoiceIdentification1) SetIssueDate(value string) {
 	i.IssueDate = (*ISODate)(&value)
 }
+
+func (i *InvoiceIdentification1) SetIssueDateFromTime(value time.Time) {
+	i.IssueDate = new(ISODate)
+	i.IssueDate.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics that unambiguously identify the single invoice financing request.
 type InvoiceRequestInformation1 struct {
 
@@ -31,6 +35,10 @@ type InvoiceRequestInformation1 struct {
 	ReferredDocument []*ReferredDocumentInformation2 `xml:"RfrdDoc,omitempty"`
 }
 
+func (i *InvoiceRequestInformation1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvoiceRequestInformation1) AddInvoiceGeneralInformation() *DocumentGeneralInformation1 {
 	i.InvoiceGeneralInformation = new(DocumentGeneralInformation1)
 	return i.InvoiceGeneralInformation
@@ -45,6 +53,10 @@ func (i *InvoiceRequestInformation1) SetCreditDebitNoteAmount(value, currency st
 	i.CreditDebitNoteAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InvoiceRequestInformation1) SetCreditDebitNoteAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.CreditDebitNoteAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InvoiceRequestInformation1) AddInstalmentInformation() *Instalment1 {
 	newValue := new(Instalment1)
 	i.InstalmentInformation = append(i.InstalmentInformation, newValue)
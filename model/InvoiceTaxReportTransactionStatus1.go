@@ -16,6 +16,10 @@ type InvoiceTaxReportTransactionStatus1 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (i *InvoiceTaxReportTransactionStatus1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvoiceTaxReportTransactionStatus1) SetTaxReportIdentification(value string) {
 	i.TaxReportIdentification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type InvoiceTaxStatusReportHeader1 struct {
 	ValidationRule []*GenericValidationRuleIdentification1 `xml:"VldtnRule,omitempty"`
 }
 
+func (i *InvoiceTaxStatusReportHeader1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvoiceTaxStatusReportHeader1) AddTaxAuthority() *TaxOrganisationIdentification1 {
 	i.TaxAuthority = new(TaxOrganisationIdentification1)
 	return i.TaxAuthority
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies totals related to the invoice.
 type InvoiceTotals1 struct {
 
@@ -19,14 +25,26 @@ type InvoiceTotals1 struct {
 	PaymentDueDate *ISODate `xml:"PmtDueDt"`
 }
 
+func (i *InvoiceTotals1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *InvoiceTotals1) SetTotalTaxableAmount(value, currency string) {
 	i.TotalTaxableAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InvoiceTotals1) SetTotalTaxableAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.TotalTaxableAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InvoiceTotals1) SetTotalTaxAmount(value, currency string) {
 	i.TotalTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InvoiceTotals1) SetTotalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.TotalTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InvoiceTotals1) AddAdjustment() *Adjustment5 {
 	i.Adjustment = new(Adjustment5)
 	return i.Adjustment
@@ -36,6 +54,15 @@ func (i *InvoiceTotals1) SetTotalInvoiceAmount(value, currency string) {
 	i.TotalInvoiceAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (i *InvoiceTotals1) SetTotalInvoiceAmountFromDecimal(value decimal.Decimal, currency string) {
+	i.TotalInvoiceAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (i *InvoiceTotals1) SetPaymentDueDate(value string) {
 	i.PaymentDueDate = (*ISODate)(&value)
 }
+
+func (i *InvoiceTotals1) SetPaymentDueDateFromTime(value time.Time) {
+	i.PaymentDueDate = new(ISODate)
+	i.PaymentDueDate.FromTime(value)
+}
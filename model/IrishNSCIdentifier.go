@@ -1,3 +1,9 @@
 package model
 
 type IrishNSCIdentifier string
+
+var irishNSCIdentifierPattern = newPattern(`IE[0-9]{6,6}`)
+
+func (i IrishNSCIdentifier) Validate() error {
+	return validatePattern("IrishNSCIdentifier", string(i), irishNSCIdentifierPattern)
+}
@@ -10,6 +10,10 @@ type IssuerAgent1 struct {
 	Role *AgentRole1Code `xml:"Role,omitempty"`
 }
 
+func (i *IssuerAgent1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IssuerAgent1) AddIdentification() *PartyIdentification9Choice {
 	i.Identification = new(PartyIdentification9Choice)
 	return i.Identification
@@ -10,6 +10,10 @@ type IssuerAgent2 struct {
 	Role *AgentRole1Code `xml:"Role,omitempty"`
 }
 
+func (i *IssuerAgent2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IssuerAgent2) AddIdentification() *PartyIdentification40Choice {
 	i.Identification = new(PartyIdentification40Choice)
 	return i.Identification
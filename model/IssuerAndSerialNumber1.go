@@ -10,6 +10,10 @@ type IssuerAndSerialNumber1 struct {
 	SerialNumber *Max35Binary `xml:"SrlNb"`
 }
 
+func (i *IssuerAndSerialNumber1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IssuerAndSerialNumber1) AddIssuer() *CertificateIssuer1 {
 	i.Issuer = new(CertificateIssuer1)
 	return i.Issuer
@@ -10,6 +10,10 @@ type IssuerInformation1 struct {
 	URLAddress *Max256Text `xml:"URLAdr,omitempty"`
 }
 
+func (i *IssuerInformation1) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IssuerInformation1) AddIdentification() *PartyIdentification9Choice {
 	i.Identification = new(PartyIdentification9Choice)
 	return i.Identification
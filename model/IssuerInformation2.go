@@ -10,6 +10,10 @@ type IssuerInformation2 struct {
 	URLAddress *Max256Text `xml:"URLAdr,omitempty"`
 }
 
+func (i *IssuerInformation2) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IssuerInformation2) AddIdentification() *PartyIdentification40Choice {
 	i.Identification = new(PartyIdentification40Choice)
 	return i.Identification
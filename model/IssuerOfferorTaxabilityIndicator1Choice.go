@@ -10,6 +10,10 @@ type IssuerOfferorTaxabilityIndicator1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (i *IssuerOfferorTaxabilityIndicator1Choice) Validate() error {
+	return ValidateElement(i)
+}
+
 func (i *IssuerOfferorTaxabilityIndicator1Choice) SetCode(value string) {
 	i.Code = (*IssuerTaxability2Code)(&value)
 }
@@ -1,3 +1,9 @@
 package model
 
 type ItalianDomesticIdentifier string
+
+var italianDomesticIdentifierPattern = newPattern(`IT[0-9]{10,10}`)
+
+func (i ItalianDomesticIdentifier) Validate() error {
+	return validatePattern("ItalianDomesticIdentifier", string(i), italianDomesticIdentifierPattern)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Key encryption key (KEK), using previously distributed symmetric key.
 type KEK2 struct {
 
@@ -16,10 +20,19 @@ type KEK2 struct {
 	EncryptedKey *Max140Binary `xml:"NcrptdKey"`
 }
 
+func (k *KEK2) Validate() error {
+	return ValidateElement(k)
+}
+
 func (k *KEK2) SetVersion(value string) {
 	k.Version = (*Number)(&value)
 }
 
+func (k *KEK2) SetVersionFromDecimal(value decimal.Decimal) {
+	k.Version = new(Number)
+	k.Version.FromDecimal(value)
+}
+
 func (k *KEK2) AddKEKIdentification() *KEKIdentifier1 {
 	k.KEKIdentification = new(KEKIdentifier1)
 	return k.KEKIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Key encryption key (KEK), using previously distributed symmetric key.
 type KEK3 struct {
 
@@ -16,10 +20,19 @@ type KEK3 struct {
 	EncryptedKey *Max140Binary `xml:"NcrptdKey"`
 }
 
+func (k *KEK3) Validate() error {
+	return ValidateElement(k)
+}
+
 func (k *KEK3) SetVersion(value string) {
 	k.Version = (*Number)(&value)
 }
 
+func (k *KEK3) SetVersionFromDecimal(value decimal.Decimal) {
+	k.Version = new(Number)
+	k.Version.FromDecimal(value)
+}
+
 func (k *KEK3) AddKEKIdentification() *KEKIdentifier1 {
 	k.KEKIdentification = new(KEKIdentifier1)
 	return k.KEKIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Key encryption key (KEK), using previously distributed symmetric key.
 type KEK4 struct {
 
@@ -16,10 +20,19 @@ type KEK4 struct {
 	EncryptedKey *Max500Binary `xml:"NcrptdKey"`
 }
 
+func (k *KEK4) Validate() error {
+	return ValidateElement(k)
+}
+
 func (k *KEK4) SetVersion(value string) {
 	k.Version = (*Number)(&value)
 }
 
+func (k *KEK4) SetVersionFromDecimal(value decimal.Decimal) {
+	k.Version = new(Number)
+	k.Version.FromDecimal(value)
+}
+
 func (k *KEK4) AddKEKIdentification() *KEKIdentifier2 {
 	k.KEKIdentification = new(KEKIdentifier2)
 	return k.KEKIdentification
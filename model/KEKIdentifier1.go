@@ -13,6 +13,10 @@ type KEKIdentifier1 struct {
 	DerivationIdentification *Min5Max16Binary `xml:"DerivtnId,omitempty"`
 }
 
+func (k *KEKIdentifier1) Validate() error {
+	return ValidateElement(k)
+}
+
 func (k *KEKIdentifier1) SetKeyIdentification(value string) {
 	k.KeyIdentification = (*Max140Text)(&value)
 }
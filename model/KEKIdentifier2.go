@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identification of a key encryption key (KEK), using previously distributed symmetric key.
 type KEKIdentifier2 struct {
 
@@ -16,6 +20,10 @@ type KEKIdentifier2 struct {
 	DerivationIdentification *Min5Max16Binary `xml:"DerivtnId,omitempty"`
 }
 
+func (k *KEKIdentifier2) Validate() error {
+	return ValidateElement(k)
+}
+
 func (k *KEKIdentifier2) SetKeyIdentification(value string) {
 	k.KeyIdentification = (*Max140Text)(&value)
 }
@@ -28,6 +36,11 @@ func (k *KEKIdentifier2) SetSequenceNumber(value string) {
 	k.SequenceNumber = (*Number)(&value)
 }
 
+func (k *KEKIdentifier2) SetSequenceNumberFromDecimal(value decimal.Decimal) {
+	k.SequenceNumber = new(Number)
+	k.SequenceNumber.FromDecimal(value)
+}
+
 func (k *KEKIdentifier2) SetDerivationIdentification(value string) {
 	k.DerivationIdentification = (*Min5Max16Binary)(&value)
 }
@@ -16,6 +16,10 @@ type KEKIdentifier3 struct {
 	KeyCheckValue *Max35Binary `xml:"KeyChckVal,omitempty"`
 }
 
+func (k *KEKIdentifier3) Validate() error {
+	return ValidateElement(k)
+}
+
 func (k *KEKIdentifier3) SetName(value string) {
 	k.Name = (*Max140Text)(&value)
 }
@@ -13,6 +13,10 @@ type KEKIdentifier4 struct {
 	KeyVersion *Max140Text `xml:"KeyVrsn,omitempty"`
 }
 
+func (k *KEKIdentifier4) Validate() error {
+	return ValidateElement(k)
+}
+
 func (k *KEKIdentifier4) SetName(value string) {
 	k.Name = (*Max140Text)(&value)
 }
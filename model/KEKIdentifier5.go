@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identification of a key encryption key (KEK), using previously distributed symmetric key.
 type KEKIdentifier5 struct {
 
@@ -22,6 +26,10 @@ type KEKIdentifier5 struct {
 	Function []*KeyUsage1Code `xml:"Fctn,omitempty"`
 }
 
+func (k *KEKIdentifier5) Validate() error {
+	return ValidateElement(k)
+}
+
 func (k *KEKIdentifier5) SetKeyIdentification(value string) {
 	k.KeyIdentification = (*Max140Text)(&value)
 }
@@ -34,6 +42,11 @@ func (k *KEKIdentifier5) SetSequenceNumber(value string) {
 	k.SequenceNumber = (*Number)(&value)
 }
 
+func (k *KEKIdentifier5) SetSequenceNumberFromDecimal(value decimal.Decimal) {
+	k.SequenceNumber = new(Number)
+	k.SequenceNumber.FromDecimal(value)
+}
+
 func (k *KEKIdentifier5) SetDerivationIdentification(value string) {
 	k.DerivationIdentification = (*Min5Max16Binary)(&value)
 }
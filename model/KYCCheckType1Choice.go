@@ -10,6 +10,10 @@ type KYCCheckType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (k *KYCCheckType1Choice) Validate() error {
+	return ValidateElement(k)
+}
+
 func (k *KYCCheckType1Choice) SetCode(value string) {
 	k.Code = (*KnowYourCustomerCheckType1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Key encryption key (KEK), encrypted previously distributed symmetric key.
 type KeyTransport1 struct {
 
@@ -16,10 +20,19 @@ type KeyTransport1 struct {
 	EncryptedKey *Max140Binary `xml:"NcrptdKey"`
 }
 
+func (k *KeyTransport1) Validate() error {
+	return ValidateElement(k)
+}
+
 func (k *KeyTransport1) SetVersion(value string) {
 	k.Version = (*Number)(&value)
 }
 
+func (k *KeyTransport1) SetVersionFromDecimal(value decimal.Decimal) {
+	k.Version = new(Number)
+	k.Version.FromDecimal(value)
+}
+
 func (k *KeyTransport1) AddRecipientIdentification() *CertificateIdentifier1 {
 	k.RecipientIdentification = new(CertificateIdentifier1)
 	return k.RecipientIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Key encryption key (KEK), encrypted with a previously distributed asymmetric public key.
 type KeyTransport3 struct {
 
@@ -16,10 +20,19 @@ type KeyTransport3 struct {
 	EncryptedKey *Max3000Binary `xml:"NcrptdKey"`
 }
 
+func (k *KeyTransport3) Validate() error {
+	return ValidateElement(k)
+}
+
 func (k *KeyTransport3) SetVersion(value string) {
 	k.Version = (*Number)(&value)
 }
 
+func (k *KeyTransport3) SetVersionFromDecimal(value decimal.Decimal) {
+	k.Version = new(Number)
+	k.Version.FromDecimal(value)
+}
+
 func (k *KeyTransport3) AddRecipientIdentification() *CertificateIdentifier1 {
 	k.RecipientIdentification = new(CertificateIdentifier1)
 	return k.RecipientIdentification
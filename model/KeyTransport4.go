@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Key encryption key (KEK), encrypted with a previously distributed asymmetric public key.
 type KeyTransport4 struct {
 
@@ -16,10 +20,19 @@ type KeyTransport4 struct {
 	EncryptedKey *Max5000Binary `xml:"NcrptdKey"`
 }
 
+func (k *KeyTransport4) Validate() error {
+	return ValidateElement(k)
+}
+
 func (k *KeyTransport4) SetVersion(value string) {
 	k.Version = (*Number)(&value)
 }
 
+func (k *KeyTransport4) SetVersionFromDecimal(value decimal.Decimal) {
+	k.Version = new(Number)
+	k.Version.FromDecimal(value)
+}
+
 func (k *KeyTransport4) AddRecipientIdentification() *Recipient5Choice {
 	k.RecipientIdentification = new(Recipient5Choice)
 	return k.RecipientIdentification
@@ -1,3 +1,16 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/validation"
+)
+
 type LEIIdentifier string
+
+var leiIdentifierPattern = newPattern(`[A-Z0-9]{18,18}[0-9]{2,2}`)
+
+func (l LEIIdentifier) Validate() error {
+	if err := validatePattern("LEIIdentifier", string(l), leiIdentifierPattern); err != nil {
+		return err
+	}
+	return validation.ValidateLEI(string(l))
+}
@@ -10,6 +10,10 @@ type LegIdentification1Choice struct {
 	SubscriptionLegIdentification *Max35Text `xml:"SbcptLegId"`
 }
 
+func (l *LegIdentification1Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LegIdentification1Choice) SetRedemptionLegIdentification(value string) {
 	l.RedemptionLegIdentification = (*Max35Text)(&value)
 }
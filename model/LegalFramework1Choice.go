@@ -10,6 +10,10 @@ type LegalFramework1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (l *LegalFramework1Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LegalFramework1Choice) SetCode(value string) {
 	l.Code = (*LegalFramework1Code)(&value)
 }
@@ -10,6 +10,10 @@ type LegalFramework3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (l *LegalFramework3Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LegalFramework3Choice) SetCode(value string) {
 	l.Code = (*LegalFramework1Code)(&value)
 }
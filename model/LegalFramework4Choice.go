@@ -10,6 +10,10 @@ type LegalFramework4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (l *LegalFramework4Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LegalFramework4Choice) SetCode(value string) {
 	l.Code = (*LegalFramework1Code)(&value)
 }
@@ -10,6 +10,10 @@ type LegalMandate1 struct {
 	Disclaimer *Max350Text `xml:"Dsclmr,omitempty"`
 }
 
+func (l *LegalMandate1) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LegalMandate1) SetParagraph(value string) {
 	l.Paragraph = (*Max35Text)(&value)
 }
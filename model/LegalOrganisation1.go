@@ -10,6 +10,10 @@ type LegalOrganisation1 struct {
 	Name *Max140Text `xml:"Nm,omitempty"`
 }
 
+func (l *LegalOrganisation1) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LegalOrganisation1) SetIdentification(value string) {
 	l.Identification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Legally constituted organization specified for this party.
 type LegalOrganisation2 struct {
 
@@ -16,6 +20,10 @@ type LegalOrganisation2 struct {
 	RegistrationDate *ISODate `xml:"RegnDt,omitempty"`
 }
 
+func (l *LegalOrganisation2) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LegalOrganisation2) SetIdentification(value string) {
 	l.Identification = (*Max35Text)(&value)
 }
@@ -28,6 +36,16 @@ func (l *LegalOrganisation2) SetEstablishmentDate(value string) {
 	l.EstablishmentDate = (*ISODate)(&value)
 }
 
+func (l *LegalOrganisation2) SetEstablishmentDateFromTime(value time.Time) {
+	l.EstablishmentDate = new(ISODate)
+	l.EstablishmentDate.FromTime(value)
+}
+
 func (l *LegalOrganisation2) SetRegistrationDate(value string) {
 	l.RegistrationDate = (*ISODate)(&value)
 }
+
+func (l *LegalOrganisation2) SetRegistrationDateFromTime(value time.Time) {
+	l.RegistrationDate = new(ISODate)
+	l.RegistrationDate.FromTime(value)
+}
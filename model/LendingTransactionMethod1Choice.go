@@ -10,6 +10,10 @@ type LendingTransactionMethod1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (l *LendingTransactionMethod1Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LendingTransactionMethod1Choice) SetCode(value string) {
 	l.Code = (*LendingTransactionMethod1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies information about the letter of intent.
 type LetterIntent1 struct {
 
@@ -16,6 +22,10 @@ type LetterIntent1 struct {
 	EndDate *ISODate `xml:"EndDt,omitempty"`
 }
 
+func (l *LetterIntent1) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LetterIntent1) SetLetterIntentReference(value string) {
 	l.LetterIntentReference = (*Max35Text)(&value)
 }
@@ -24,10 +34,24 @@ func (l *LetterIntent1) SetAmount(value, currency string) {
 	l.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (l *LetterIntent1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (l *LetterIntent1) SetStartDate(value string) {
 	l.StartDate = (*ISODate)(&value)
 }
 
+func (l *LetterIntent1) SetStartDateFromTime(value time.Time) {
+	l.StartDate = new(ISODate)
+	l.StartDate.FromTime(value)
+}
+
 func (l *LetterIntent1) SetEndDate(value string) {
 	l.EndDate = (*ISODate)(&value)
 }
+
+func (l *LetterIntent1) SetEndDateFromTime(value time.Time) {
+	l.EndDate = new(ISODate)
+	l.EndDate.FromTime(value)
+}
@@ -10,10 +10,19 @@ type LetterOfGuarantee1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (l *LetterOfGuarantee1Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LetterOfGuarantee1Choice) SetIndicator(value string) {
 	l.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (l *LetterOfGuarantee1Choice) SetIndicatorFromBool(value bool) {
+	l.Indicator = new(YesNoIndicator)
+	l.Indicator.FromBool(value)
+}
+
 func (l *LetterOfGuarantee1Choice) AddProprietary() *GenericIdentification20 {
 	l.Proprietary = new(GenericIdentification20)
 	return l.Proprietary
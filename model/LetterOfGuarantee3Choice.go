@@ -10,10 +10,19 @@ type LetterOfGuarantee3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (l *LetterOfGuarantee3Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LetterOfGuarantee3Choice) SetIndicator(value string) {
 	l.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (l *LetterOfGuarantee3Choice) SetIndicatorFromBool(value bool) {
+	l.Indicator = new(YesNoIndicator)
+	l.Indicator.FromBool(value)
+}
+
 func (l *LetterOfGuarantee3Choice) AddProprietary() *GenericIdentification38 {
 	l.Proprietary = new(GenericIdentification38)
 	return l.Proprietary
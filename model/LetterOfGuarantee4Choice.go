@@ -10,10 +10,19 @@ type LetterOfGuarantee4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (l *LetterOfGuarantee4Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LetterOfGuarantee4Choice) SetIndicator(value string) {
 	l.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (l *LetterOfGuarantee4Choice) SetIndicatorFromBool(value bool) {
+	l.Indicator = new(YesNoIndicator)
+	l.Indicator.FromBool(value)
+}
+
 func (l *LetterOfGuarantee4Choice) AddProprietary() *GenericIdentification30 {
 	l.Proprietary = new(GenericIdentification30)
 	return l.Proprietary
@@ -10,10 +10,19 @@ type LetterOfGuarantee5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (l *LetterOfGuarantee5Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LetterOfGuarantee5Choice) SetIndicator(value string) {
 	l.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (l *LetterOfGuarantee5Choice) SetIndicatorFromBool(value bool) {
+	l.Indicator = new(YesNoIndicator)
+	l.Indicator.FromBool(value)
+}
+
 func (l *LetterOfGuarantee5Choice) AddProprietary() *GenericIdentification47 {
 	l.Proprietary = new(GenericIdentification47)
 	return l.Proprietary
@@ -10,6 +10,10 @@ type LevelOfControl1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (l *LevelOfControl1Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LevelOfControl1Choice) SetCode(value string) {
 	l.Code = (*LevelOfControl1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Liability1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (l *Liability1Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Liability1Choice) SetCode(value string) {
 	l.Code = (*Liability1Code)(&value)
 }
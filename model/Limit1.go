@@ -10,6 +10,10 @@ type Limit1 struct {
 	Limit *Max3NumericText `xml:"Lmt"`
 }
 
+func (l *Limit1) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Limit1) SetCurrent(value string) {
 	l.Current = (*Max3NumericText)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the minimum value of entries to be reported in the requested message.
 type Limit2 struct {
 
@@ -10,10 +14,18 @@ type Limit2 struct {
 	CreditDebitIndicator *FloorLimitType1Code `xml:"CdtDbtInd"`
 }
 
+func (l *Limit2) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Limit2) SetAmount(value, currency string) {
 	l.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (l *Limit2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *Limit2) SetCreditDebitIndicator(value string) {
 	l.CreditDebitIndicator = (*FloorLimitType1Code)(&value)
 }
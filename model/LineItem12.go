@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Commercial details of a trade transaction between a buyer and a seller.
 type LineItem12 struct {
 
@@ -37,6 +41,10 @@ type LineItem12 struct {
 	Incoterms *Incoterms4 `xml:"Incotrms,omitempty"`
 }
 
+func (l *LineItem12) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LineItem12) AddPurchaseOrderReference() *DocumentIdentification7 {
 	l.PurchaseOrderReference = new(DocumentIdentification7)
 	return l.PurchaseOrderReference
@@ -46,6 +54,11 @@ func (l *LineItem12) SetFinalSubmission(value string) {
 	l.FinalSubmission = (*YesNoIndicator)(&value)
 }
 
+func (l *LineItem12) SetFinalSubmissionFromBool(value bool) {
+	l.FinalSubmission = new(YesNoIndicator)
+	l.FinalSubmission.FromBool(value)
+}
+
 func (l *LineItem12) AddCommercialLineItems() *LineItemDetails11 {
 	newValue := new(LineItemDetails11)
 	l.CommercialLineItems = append(l.CommercialLineItems, newValue)
@@ -196,6 +209,10 @@ func (l *LineItem12) SetLineItemsTotalAmount(value, currency string) {
 	l.LineItemsTotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem12) SetLineItemsTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.LineItemsTotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem12) AddAdjustment() *Adjustment6 {
 	newValue := new(Adjustment6)
 	l.Adjustment = append(l.Adjustment, newValue)
@@ -497,6 +514,10 @@ func (l *LineItem12) SetTotalNetAmount(value, currency string) {
 	l.TotalNetAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem12) SetTotalNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.TotalNetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem12) AddBuyerDefinedInformation() *UserDefinedInformation1 {
 	newValue := new(UserDefinedInformation1)
 	l.BuyerDefinedInformation = append(l.BuyerDefinedInformation, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Commercial details of a trade transaction between a buyer and a seller.
 type LineItem13 struct {
 
@@ -46,6 +50,10 @@ type LineItem13 struct {
 	SellerDefinedInformation []*UserDefinedInformation1 `xml:"SellrDfndInf,omitempty"`
 }
 
+func (l *LineItem13) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LineItem13) SetGoodsAndOrServicesDescription(value string) {
 	l.GoodsAndOrServicesDescription = (*Max70Text)(&value)
 }
@@ -54,10 +62,20 @@ func (l *LineItem13) SetPartialShipment(value string) {
 	l.PartialShipment = (*YesNoIndicator)(&value)
 }
 
+func (l *LineItem13) SetPartialShipmentFromBool(value bool) {
+	l.PartialShipment = new(YesNoIndicator)
+	l.PartialShipment.FromBool(value)
+}
+
 func (l *LineItem13) SetTransShipment(value string) {
 	l.TransShipment = (*YesNoIndicator)(&value)
 }
 
+func (l *LineItem13) SetTransShipmentFromBool(value bool) {
+	l.TransShipment = new(YesNoIndicator)
+	l.TransShipment.FromBool(value)
+}
+
 func (l *LineItem13) AddShipmentDateRange() *ShipmentDateRange1 {
 	l.ShipmentDateRange = new(ShipmentDateRange1)
 	return l.ShipmentDateRange
@@ -213,6 +231,10 @@ func (l *LineItem13) SetLineItemsTotalAmount(value, currency string) {
 	l.LineItemsTotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem13) SetLineItemsTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.LineItemsTotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem13) AddRoutingSummary() *TransportMeans5 {
 	l.RoutingSummary = new(TransportMeans5)
 	return l.RoutingSummary
@@ -524,6 +546,10 @@ func (l *LineItem13) SetTotalNetAmount(value, currency string) {
 	l.TotalNetAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem13) SetTotalNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.TotalNetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem13) AddBuyerDefinedInformation() *UserDefinedInformation1 {
 	newValue := new(UserDefinedInformation1)
 	l.BuyerDefinedInformation = append(l.BuyerDefinedInformation, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Calculation of the current situation of a baseline as a result of the submission of a commercial data set.
 type LineItem14 struct {
 
@@ -31,6 +35,10 @@ type LineItem14 struct {
 	PendingTotalNetAmount *CurrencyAndAmount `xml:"PdgTtlNetAmt"`
 }
 
+func (l *LineItem14) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LineItem14) AddLineItemDetails() *LineItemDetails12 {
 	newValue := new(LineItemDetails12)
 	l.LineItemDetails = append(l.LineItemDetails, newValue)
@@ -181,30 +189,62 @@ func (l *LineItem14) SetOrderedLineItemsTotalAmount(value, currency string) {
 	l.OrderedLineItemsTotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem14) SetOrderedLineItemsTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.OrderedLineItemsTotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem14) SetAcceptedLineItemsTotalAmount(value, currency string) {
 	l.AcceptedLineItemsTotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem14) SetAcceptedLineItemsTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.AcceptedLineItemsTotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem14) SetOutstandingLineItemsTotalAmount(value, currency string) {
 	l.OutstandingLineItemsTotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem14) SetOutstandingLineItemsTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.OutstandingLineItemsTotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem14) SetPendingLineItemsTotalAmount(value, currency string) {
 	l.PendingLineItemsTotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem14) SetPendingLineItemsTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.PendingLineItemsTotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem14) SetOrderedTotalNetAmount(value, currency string) {
 	l.OrderedTotalNetAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem14) SetOrderedTotalNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.OrderedTotalNetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem14) SetAcceptedTotalNetAmount(value, currency string) {
 	l.AcceptedTotalNetAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem14) SetAcceptedTotalNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.AcceptedTotalNetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem14) SetOutstandingTotalNetAmount(value, currency string) {
 	l.OutstandingTotalNetAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem14) SetOutstandingTotalNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.OutstandingTotalNetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem14) SetPendingTotalNetAmount(value, currency string) {
 	l.PendingTotalNetAmount = NewCurrencyAndAmount(value, currency)
 }
+
+func (l *LineItem14) SetPendingTotalNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.PendingTotalNetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
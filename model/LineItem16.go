@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Unit of information  showing the related  provision of products and/or services and monetary summations reported as a discrete line items.
 type LineItem16 struct {
 
@@ -97,6 +103,10 @@ type LineItem16 struct {
 	IncludedNote []*AdditionalInformation1 `xml:"InclNote,omitempty"`
 }
 
+func (l *LineItem16) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LineItem16) SetIdentification(value string) {
 	l.Identification = (*Max35Text)(&value)
 }
@@ -1029,6 +1039,11 @@ func (l *LineItem16) SetPackageQuantity(value string) {
 	l.PackageQuantity = (*DecimalNumber)(&value)
 }
 
+func (l *LineItem16) SetPackageQuantityFromDecimal(value decimal.Decimal) {
+	l.PackageQuantity = new(DecimalNumber)
+	l.PackageQuantity.FromDecimal(value)
+}
+
 func (l *LineItem16) AddPerPackageUnitQuantity() *Quantity10 {
 	l.PerPackageUnitQuantity = new(Quantity10)
 	return l.PerPackageUnitQuantity
@@ -1199,10 +1214,20 @@ func (l *LineItem16) SetMeasureDateTimeStart(value string) {
 	l.MeasureDateTimeStart = (*ISODateTime)(&value)
 }
 
+func (l *LineItem16) SetMeasureDateTimeStartFromTime(value time.Time) {
+	l.MeasureDateTimeStart = new(ISODateTime)
+	l.MeasureDateTimeStart.FromTime(value)
+}
+
 func (l *LineItem16) SetMeasureDateTimeEnd(value string) {
 	l.MeasureDateTimeEnd = (*ISODateTime)(&value)
 }
 
+func (l *LineItem16) SetMeasureDateTimeEndFromTime(value time.Time) {
+	l.MeasureDateTimeEnd = new(ISODateTime)
+	l.MeasureDateTimeEnd.FromTime(value)
+}
+
 func (l *LineItem16) AddShipTo() *TradeParty3 {
 	l.ShipTo = new(TradeParty3)
 	return l.ShipTo
@@ -1217,6 +1242,11 @@ func (l *LineItem16) SetDeliveryDateTime(value string) {
 	l.DeliveryDateTime = (*ISODateTime)(&value)
 }
 
+func (l *LineItem16) SetDeliveryDateTimeFromTime(value time.Time) {
+	l.DeliveryDateTime = new(ISODateTime)
+	l.DeliveryDateTime.FromTime(value)
+}
+
 func (l *LineItem16) AddDeliveryNoteIdentification() *DocumentIdentification22 {
 	l.DeliveryNoteIdentification = new(DocumentIdentification22)
 	return l.DeliveryNoteIdentification
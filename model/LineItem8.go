@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Calculation of the current situation of a baseline as a result of the submission of a commercial data set.
 type LineItem8 struct {
 
@@ -31,6 +35,10 @@ type LineItem8 struct {
 	PendingTotalNetAmount *CurrencyAndAmount `xml:"PdgTtlNetAmt"`
 }
 
+func (l *LineItem8) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LineItem8) AddLineItemDetails() *LineItemDetails8 {
 	newValue := new(LineItemDetails8)
 	l.LineItemDetails = append(l.LineItemDetails, newValue)
@@ -181,30 +189,62 @@ func (l *LineItem8) SetOrderedLineItemsTotalAmount(value, currency string) {
 	l.OrderedLineItemsTotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem8) SetOrderedLineItemsTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.OrderedLineItemsTotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem8) SetAcceptedLineItemsTotalAmount(value, currency string) {
 	l.AcceptedLineItemsTotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem8) SetAcceptedLineItemsTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.AcceptedLineItemsTotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem8) SetOutstandingLineItemsTotalAmount(value, currency string) {
 	l.OutstandingLineItemsTotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem8) SetOutstandingLineItemsTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.OutstandingLineItemsTotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem8) SetPendingLineItemsTotalAmount(value, currency string) {
 	l.PendingLineItemsTotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem8) SetPendingLineItemsTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.PendingLineItemsTotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem8) SetOrderedTotalNetAmount(value, currency string) {
 	l.OrderedTotalNetAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem8) SetOrderedTotalNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.OrderedTotalNetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem8) SetAcceptedTotalNetAmount(value, currency string) {
 	l.AcceptedTotalNetAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem8) SetAcceptedTotalNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.AcceptedTotalNetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem8) SetOutstandingTotalNetAmount(value, currency string) {
 	l.OutstandingTotalNetAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem8) SetOutstandingTotalNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.OutstandingTotalNetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem8) SetPendingTotalNetAmount(value, currency string) {
 	l.PendingTotalNetAmount = NewCurrencyAndAmount(value, currency)
 }
+
+func (l *LineItem8) SetPendingTotalNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.PendingTotalNetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Commercial details of a trade transaction between a buyer and a seller.
 type LineItem9 struct {
 
@@ -37,6 +41,10 @@ type LineItem9 struct {
 	SellerDefinedInformation []*UserDefinedInformation1 `xml:"SellrDfndInf,omitempty"`
 }
 
+func (l *LineItem9) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LineItem9) AddPurchaseOrderReference() *DocumentIdentification7 {
 	l.PurchaseOrderReference = new(DocumentIdentification7)
 	return l.PurchaseOrderReference
@@ -46,6 +54,11 @@ func (l *LineItem9) SetFinalSubmission(value string) {
 	l.FinalSubmission = (*YesNoIndicator)(&value)
 }
 
+func (l *LineItem9) SetFinalSubmissionFromBool(value bool) {
+	l.FinalSubmission = new(YesNoIndicator)
+	l.FinalSubmission.FromBool(value)
+}
+
 func (l *LineItem9) AddCommercialLineItems() *LineItemDetails9 {
 	newValue := new(LineItemDetails9)
 	l.CommercialLineItems = append(l.CommercialLineItems, newValue)
@@ -196,6 +209,10 @@ func (l *LineItem9) SetLineItemsTotalAmount(value, currency string) {
 	l.LineItemsTotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem9) SetLineItemsTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.LineItemsTotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem9) AddIncoterms() *Incoterms2 {
 	l.Incoterms = new(Incoterms2)
 	return l.Incoterms
@@ -502,6 +519,10 @@ func (l *LineItem9) SetTotalNetAmount(value, currency string) {
 	l.TotalNetAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItem9) SetTotalNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.TotalNetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItem9) AddBuyerDefinedInformation() *UserDefinedInformation1 {
 	newValue := new(UserDefinedInformation1)
 	l.BuyerDefinedInformation = append(l.BuyerDefinedInformation, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Pricing component, such as a service, promotion, allowance or charge, for this line item.
 type LineItemAllowanceCharge1 struct {
 
@@ -22,10 +26,19 @@ type LineItemAllowanceCharge1 struct {
 	Reason *DiscountOrChargeType1Choice `xml:"Rsn,omitempty"`
 }
 
+func (l *LineItemAllowanceCharge1) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LineItemAllowanceCharge1) SetChargeIndicator(value string) {
 	l.ChargeIndicator = (*YesNoIndicator)(&value)
 }
 
+func (l *LineItemAllowanceCharge1) SetChargeIndicatorFromBool(value bool) {
+	l.ChargeIndicator = new(YesNoIndicator)
+	l.ChargeIndicator.FromBool(value)
+}
+
 func (l *LineItemAllowanceCharge1) AddActualAmount(value, currency string) {
 	l.ActualAmount = append(l.ActualAmount, NewCurrencyAndAmount(value, currency))
 }
@@ -39,10 +52,20 @@ func (l *LineItemAllowanceCharge1) SetCalculationPercent(value string) {
 	l.CalculationPercent = (*PercentageRate)(&value)
 }
 
+func (l *LineItemAllowanceCharge1) SetCalculationPercentFromDecimal(value decimal.Decimal) {
+	l.CalculationPercent = new(PercentageRate)
+	l.CalculationPercent.FromDecimal(value)
+}
+
 func (l *LineItemAllowanceCharge1) SetSequenceNumber(value string) {
 	l.SequenceNumber = (*Number)(&value)
 }
 
+func (l *LineItemAllowanceCharge1) SetSequenceNumberFromDecimal(value decimal.Decimal) {
+	l.SequenceNumber = new(Number)
+	l.SequenceNumber.FromDecimal(value)
+}
+
 func (l *LineItemAllowanceCharge1) AddReason() *DiscountOrChargeType1Choice {
 	l.Reason = new(DiscountOrChargeType1Choice)
 	return l.Reason
@@ -10,6 +10,10 @@ type LineItemAndPOIdentification1 struct {
 	PurchaseOrderReference *DocumentIdentification7 `xml:"PurchsOrdrRef"`
 }
 
+func (l *LineItemAndPOIdentification1) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LineItemAndPOIdentification1) AddLineItemIdentification(value string) {
 	l.LineItemIdentification = append(l.LineItemIdentification, (*Max70Text)(&value))
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Goods or services that are part of a commercial trade agreement.
 type LineItemDetails10 struct {
 
@@ -55,6 +59,10 @@ type LineItemDetails10 struct {
 	Incoterms *Incoterms4 `xml:"Incotrms,omitempty"`
 }
 
+func (l *LineItemDetails10) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LineItemDetails10) SetLineItemIdentification(value string) {
 	l.LineItemIdentification = (*Max70Text)(&value)
 }
@@ -836,6 +844,10 @@ func (l *LineItemDetails10) SetTotalAmount(value, currency string) {
 	l.TotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItemDetails10) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.TotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItemDetails10) AddIncoterms() *Incoterms4 {
 	l.Incoterms = new(Incoterms4)
 	return l.Incoterms
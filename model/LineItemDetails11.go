@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Goods or services that are part of a commercial trade agreement.
 type LineItemDetails11 struct {
 
@@ -40,6 +44,10 @@ type LineItemDetails11 struct {
 	TotalAmount *CurrencyAndAmount `xml:"TtlAmt"`
 }
 
+func (l *LineItemDetails11) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LineItemDetails11) SetLineItemIdentification(value string) {
 	l.LineItemIdentification = (*Max70Text)(&value)
 }
@@ -800,3 +808,7 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (l *LineItemDetails11) SetTotalAmount(value, currency string) {
 	l.TotalAmount = NewCurrencyAndAmount(value, currency)
 }
+
+func (l *LineItemDetails11) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.TotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
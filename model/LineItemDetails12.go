@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Calculation of the current situation of a line item as a result of the submission of a commercial dataset.
 type LineItemDetails12 struct {
 
@@ -49,6 +53,10 @@ type LineItemDetails12 struct {
 	PriceTolerance *PercentageTolerance1 `xml:"PricTlrnce,omitempty"`
 }
 
+func (l *LineItemDetails12) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LineItemDetails12) SetLineItemIdentification(value string) {
 	l.LineItemIdentification = (*Max70Text)(&value)
 }
@@ -524,18 +532,34 @@ func (l *LineItemDetails12) SetOrderedAmount(value, currency string) {
 	l.OrderedAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItemDetails12) SetOrderedAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.OrderedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItemDetails12) SetAcceptedAmount(value, currency string) {
 	l.AcceptedAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItemDetails12) SetAcceptedAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.AcceptedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItemDetails12) SetOutstandingAmount(value, currency string) {
 	l.OutstandingAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItemDetails12) SetOutstandingAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.OutstandingAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItemDetails12) SetPendingAmount(value, currency string) {
 	l.PendingAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItemDetails12) SetPendingAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.PendingAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItemDetails12) AddPriceTolerance() *PercentageTolerance1 {
 	l.PriceTolerance = new(PercentageTolerance1)
 	return l.PriceTolerance
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Goods or services that are part of a commercial trade agreement.
 type LineItemDetails7 struct {
 
@@ -55,6 +59,10 @@ type LineItemDetails7 struct {
 	TotalAmount *CurrencyAndAmount `xml:"TtlAmt"`
 }
 
+func (l *LineItemDetails7) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LineItemDetails7) SetLineItemIdentification(value string) {
 	l.LineItemIdentification = (*Max70Text)(&value)
 }
@@ -981,3 +989,7 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (l *LineItemDetails7) SetTotalAmount(value, currency string) {
 	l.TotalAmount = NewCurrencyAndAmount(value, currency)
 }
+
+func (l *LineItemDetails7) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.TotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
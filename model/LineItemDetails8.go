@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Calculation of the current situation of a line item as a result of the submission of a commercial dataset.
 type LineItemDetails8 struct {
 
@@ -49,6 +53,10 @@ type LineItemDetails8 struct {
 	PriceTolerance *PercentageTolerance1 `xml:"PricTlrnce,omitempty"`
 }
 
+func (l *LineItemDetails8) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LineItemDetails8) SetLineItemIdentification(value string) {
 	l.LineItemIdentification = (*Max70Text)(&value)
 }
@@ -524,18 +532,34 @@ func (l *LineItemDetails8) SetOrderedAmount(value, currency string) {
 	l.OrderedAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItemDetails8) SetOrderedAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.OrderedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItemDetails8) SetAcceptedAmount(value, currency string) {
 	l.AcceptedAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItemDetails8) SetAcceptedAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.AcceptedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItemDetails8) SetOutstandingAmount(value, currency string) {
 	l.OutstandingAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItemDetails8) SetOutstandingAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.OutstandingAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItemDetails8) SetPendingAmount(value, currency string) {
 	l.PendingAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (l *LineItemDetails8) SetPendingAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.PendingAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LineItemDetails8) AddPriceTolerance() *PercentageTolerance1 {
 	l.PriceTolerance = new(PercentageTolerance1)
 	return l.PriceTolerance
@@ -22,6 +22,10 @@ type LineItemMonetarySummation1 struct {
 	InformationAmount []*CurrencyAndAmount `xml:"InfAmt,omitempty"`
 }
 
+func (l *LineItemMonetarySummation1) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LineItemMonetarySummation1) AddLineTotalAmount(value, currency string) {
 	l.LineTotalAmount = append(l.LineTotalAmount, NewCurrencyAndAmount(value, currency))
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to the government or tax authority, according to various pre-defined parameters such as thresholds or income.
 type LineItemTax1 struct {
 
@@ -22,6 +28,10 @@ type LineItemTax1 struct {
 	CategoryName []*Max35Text `xml:"CtgyNm,omitempty"`
 }
 
+func (l *LineItemTax1) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LineItemTax1) AddCalculatedAmount(value, currency string) {
 	l.CalculatedAmount = append(l.CalculatedAmount, NewCurrencyAndAmount(value, currency))
 }
@@ -35,10 +45,20 @@ func (l *LineItemTax1) SetTaxPointDate(value string) {
 	l.TaxPointDate = (*ISODate)(&value)
 }
 
+func (l *LineItemTax1) SetTaxPointDateFromTime(value time.Time) {
+	l.TaxPointDate = new(ISODate)
+	l.TaxPointDate.FromTime(value)
+}
+
 func (l *LineItemTax1) SetCalculatedRate(value string) {
 	l.CalculatedRate = (*PercentageRate)(&value)
 }
 
+func (l *LineItemTax1) SetCalculatedRateFromDecimal(value decimal.Decimal) {
+	l.CalculatedRate = new(PercentageRate)
+	l.CalculatedRate.FromDecimal(value)
+}
+
 func (l *LineItemTax1) SetCategoryCode(value string) {
 	l.CategoryCode = (*Max4Text)(&value)
 }
@@ -10,6 +10,10 @@ type LinkageType1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (l *LinkageType1Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LinkageType1Choice) SetCode(value string) {
 	l.Code = (*LinkageType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type LinkageType3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (l *LinkageType3Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LinkageType3Choice) SetCode(value string) {
 	l.Code = (*LinkageType1Code)(&value)
 }
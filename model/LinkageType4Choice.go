@@ -10,6 +10,10 @@ type LinkageType4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (l *LinkageType4Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LinkageType4Choice) SetCode(value string) {
 	l.Code = (*LinkageType1Code)(&value)
 }
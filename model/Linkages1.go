@@ -16,6 +16,10 @@ type Linkages1 struct {
 	LinkedQuantity *PairedOrTurnedQuantity1Choice `xml:"LkdQty,omitempty"`
 }
 
+func (l *Linkages1) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages1) AddProcessingPosition() *ProcessingPosition1Choice {
 	l.ProcessingPosition = new(ProcessingPosition1Choice)
 	return l.ProcessingPosition
@@ -16,6 +16,10 @@ type Linkages10 struct {
 	ReferenceOwner *PartyIdentification36Choice `xml:"RefOwnr,omitempty"`
 }
 
+func (l *Linkages10) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages10) AddProcessingPosition() *ProcessingPosition2Choice {
 	l.ProcessingPosition = new(ProcessingPosition2Choice)
 	return l.ProcessingPosition
@@ -10,6 +10,10 @@ type Linkages15 struct {
 	Reference *IdentificationReference8Choice `xml:"Ref"`
 }
 
+func (l *Linkages15) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages15) AddMessageNumber() *DocumentNumber4Choice {
 	l.MessageNumber = new(DocumentNumber4Choice)
 	return l.MessageNumber
@@ -16,6 +16,10 @@ type Linkages16 struct {
 	ReferenceOwner *PartyIdentification36Choice `xml:"RefOwnr,omitempty"`
 }
 
+func (l *Linkages16) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages16) AddProcessingPosition() *ProcessingPosition2Choice {
 	l.ProcessingPosition = new(ProcessingPosition2Choice)
 	return l.ProcessingPosition
@@ -10,6 +10,10 @@ type Linkages18 struct {
 	Reference *IdentificationReference11Choice `xml:"Ref"`
 }
 
+func (l *Linkages18) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages18) AddMessageNumber() *DocumentNumber4Choice {
 	l.MessageNumber = new(DocumentNumber4Choice)
 	return l.MessageNumber
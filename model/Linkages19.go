@@ -16,6 +16,10 @@ type Linkages19 struct {
 	ReferenceOwner *PartyIdentification36Choice `xml:"RefOwnr,omitempty"`
 }
 
+func (l *Linkages19) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages19) AddProcessingPosition() *ProcessingPosition1Choice {
 	l.ProcessingPosition = new(ProcessingPosition1Choice)
 	return l.ProcessingPosition
@@ -13,6 +13,10 @@ type Linkages2 struct {
 	Reference *References1Choice `xml:"Ref"`
 }
 
+func (l *Linkages2) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages2) AddProcessingPosition() *ProcessingPosition1Choice {
 	l.ProcessingPosition = new(ProcessingPosition1Choice)
 	return l.ProcessingPosition
@@ -7,6 +7,10 @@ type Linkages21 struct {
 	Reference *References1Choice `xml:"Ref"`
 }
 
+func (l *Linkages21) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages21) AddReference() *References1Choice {
 	l.Reference = new(References1Choice)
 	return l.Reference
@@ -16,6 +16,10 @@ type Linkages27 struct {
 	ReferenceOwner *PartyIdentification36Choice `xml:"RefOwnr,omitempty"`
 }
 
+func (l *Linkages27) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages27) AddProcessingPosition() *ProcessingPosition5Choice {
 	l.ProcessingPosition = new(ProcessingPosition5Choice)
 	return l.ProcessingPosition
@@ -13,6 +13,10 @@ type Linkages3 struct {
 	Reference *References6Choice `xml:"Ref"`
 }
 
+func (l *Linkages3) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages3) AddProcessingPosition() *ProcessingPosition2Choice {
 	l.ProcessingPosition = new(ProcessingPosition2Choice)
 	return l.ProcessingPosition
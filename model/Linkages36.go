@@ -16,6 +16,10 @@ type Linkages36 struct {
 	ReferenceOwner *PartyIdentification92Choice `xml:"RefOwnr,omitempty"`
 }
 
+func (l *Linkages36) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages36) AddProcessingPosition() *ProcessingPosition7Choice {
 	l.ProcessingPosition = new(ProcessingPosition7Choice)
 	return l.ProcessingPosition
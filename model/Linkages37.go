@@ -19,6 +19,10 @@ type Linkages37 struct {
 	ReferenceOwner *PartyIdentification92Choice `xml:"RefOwnr,omitempty"`
 }
 
+func (l *Linkages37) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages37) AddProcessingPosition() *ProcessingPosition7Choice {
 	l.ProcessingPosition = new(ProcessingPosition7Choice)
 	return l.ProcessingPosition
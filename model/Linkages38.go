@@ -16,6 +16,10 @@ type Linkages38 struct {
 	LinkedQuantity *PairedOrTurnedQuantity3Choice `xml:"LkdQty,omitempty"`
 }
 
+func (l *Linkages38) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages38) AddProcessingPosition() *ProcessingPosition7Choice {
 	l.ProcessingPosition = new(ProcessingPosition7Choice)
 	return l.ProcessingPosition
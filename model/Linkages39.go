@@ -16,6 +16,10 @@ type Linkages39 struct {
 	ReferenceOwner *PartyIdentification92Choice `xml:"RefOwnr,omitempty"`
 }
 
+func (l *Linkages39) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages39) AddProcessingPosition() *ProcessingPosition8Choice {
 	l.ProcessingPosition = new(ProcessingPosition8Choice)
 	return l.ProcessingPosition
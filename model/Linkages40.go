@@ -7,6 +7,10 @@ type Linkages40 struct {
 	Reference *References47Choice `xml:"Ref"`
 }
 
+func (l *Linkages40) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages40) AddReference() *References47Choice {
 	l.Reference = new(References47Choice)
 	return l.Reference
@@ -10,6 +10,10 @@ type Linkages41 struct {
 	SecuritiesSettlementTransactionIdentification *Max35Text `xml:"SctiesSttlmTxId"`
 }
 
+func (l *Linkages41) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages41) AddProcessingPosition() *ProcessingPosition9Choice {
 	l.ProcessingPosition = new(ProcessingPosition9Choice)
 	return l.ProcessingPosition
@@ -16,6 +16,10 @@ type Linkages42 struct {
 	ReferenceOwner *PartyIdentification103Choice `xml:"RefOwnr,omitempty"`
 }
 
+func (l *Linkages42) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages42) AddProcessingPosition() *ProcessingPosition10Choice {
 	l.ProcessingPosition = new(ProcessingPosition10Choice)
 	return l.ProcessingPosition
@@ -19,6 +19,10 @@ type Linkages43 struct {
 	ReferenceOwner *PartyIdentification103Choice `xml:"RefOwnr,omitempty"`
 }
 
+func (l *Linkages43) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages43) AddProcessingPosition() *ProcessingPosition10Choice {
 	l.ProcessingPosition = new(ProcessingPosition10Choice)
 	return l.ProcessingPosition
@@ -16,6 +16,10 @@ type Linkages44 struct {
 	ReferenceOwner *PartyIdentification103Choice `xml:"RefOwnr,omitempty"`
 }
 
+func (l *Linkages44) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages44) AddProcessingPosition() *ProcessingPosition18Choice {
 	l.ProcessingPosition = new(ProcessingPosition18Choice)
 	return l.ProcessingPosition
@@ -16,6 +16,10 @@ type Linkages48 struct {
 	LinkedQuantity *PairedOrTurnedQuantity4Choice `xml:"LkdQty,omitempty"`
 }
 
+func (l *Linkages48) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages48) AddProcessingPosition() *ProcessingPosition10Choice {
 	l.ProcessingPosition = new(ProcessingPosition10Choice)
 	return l.ProcessingPosition
@@ -7,6 +7,10 @@ type Linkages49 struct {
 	Reference *References58Choice `xml:"Ref"`
 }
 
+func (l *Linkages49) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages49) AddReference() *References58Choice {
 	l.Reference = new(References58Choice)
 	return l.Reference
@@ -10,6 +10,10 @@ type Linkages50 struct {
 	SecuritiesSettlementTransactionIdentification *RestrictedFINMax16Text `xml:"SctiesSttlmTxId"`
 }
 
+func (l *Linkages50) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages50) AddProcessingPosition() *ProcessingPosition23Choice {
 	l.ProcessingPosition = new(ProcessingPosition23Choice)
 	return l.ProcessingPosition
@@ -19,6 +19,10 @@ type Linkages7 struct {
 	ReferenceOwner *PartyIdentification36Choice `xml:"RefOwnr,omitempty"`
 }
 
+func (l *Linkages7) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages7) AddProcessingPosition() *ProcessingPosition1Choice {
 	l.ProcessingPosition = new(ProcessingPosition1Choice)
 	return l.ProcessingPosition
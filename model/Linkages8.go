@@ -16,6 +16,10 @@ type Linkages8 struct {
 	ReferenceOwner *PartyIdentification36Choice `xml:"RefOwnr,omitempty"`
 }
 
+func (l *Linkages8) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages8) AddProcessingPosition() *ProcessingPosition1Choice {
 	l.ProcessingPosition = new(ProcessingPosition1Choice)
 	return l.ProcessingPosition
@@ -19,6 +19,10 @@ type Linkages9 struct {
 	ReferenceOwner *PartyIdentification36Choice `xml:"RefOwnr,omitempty"`
 }
 
+func (l *Linkages9) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Linkages9) AddProcessingPosition() *ProcessingPosition1Choice {
 	l.ProcessingPosition = new(ProcessingPosition1Choice)
 	return l.ProcessingPosition
@@ -19,6 +19,10 @@ type LinkedCorporateAction1 struct {
 	LinkedCorporateActionProcessingIdentification *Max35Text `xml:"LkdCorpActnPrcgId,omitempty"`
 }
 
+func (l *LinkedCorporateAction1) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LinkedCorporateAction1) SetNotificationType(value string) {
 	l.NotificationType = (*CorporateActionNotificationType1Code)(&value)
 }
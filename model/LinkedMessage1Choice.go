@@ -13,6 +13,10 @@ type LinkedMessage1Choice struct {
 	RelatedReference *AdditionalReference3 `xml:"RltdRef"`
 }
 
+func (l *LinkedMessage1Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LinkedMessage1Choice) AddPreviousReference() *AdditionalReference3 {
 	l.PreviousReference = new(AdditionalReference3)
 	return l.PreviousReference
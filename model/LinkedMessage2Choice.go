@@ -10,6 +10,10 @@ type LinkedMessage2Choice struct {
 	OtherReference *AdditionalReference3 `xml:"OthrRef"`
 }
 
+func (l *LinkedMessage2Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LinkedMessage2Choice) AddPreviousReference() *AdditionalReference3 {
 	l.PreviousReference = new(AdditionalReference3)
 	return l.PreviousReference
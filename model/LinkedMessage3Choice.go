@@ -10,6 +10,10 @@ type LinkedMessage3Choice struct {
 	OtherReference *AdditionalReference6 `xml:"OthrRef"`
 }
 
+func (l *LinkedMessage3Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LinkedMessage3Choice) AddPreviousReference() *AdditionalReference6 {
 	l.PreviousReference = new(AdditionalReference6)
 	return l.PreviousReference
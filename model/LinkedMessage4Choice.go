@@ -10,6 +10,10 @@ type LinkedMessage4Choice struct {
 	OtherReference *AdditionalReference6 `xml:"OthrRef"`
 }
 
+func (l *LinkedMessage4Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LinkedMessage4Choice) AddPreviousReference() *AdditionalReference6 {
 	l.PreviousReference = new(AdditionalReference6)
 	return l.PreviousReference
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Contract by which an amount of money in exchange for future repayment of the principal amount along with interest or other finance charges.
 type LoanContract1 struct {
 
@@ -58,6 +64,10 @@ type LoanContract1 struct {
 	Attachment []*DocumentGeneralInformation3 `xml:"Attchmnt,omitempty"`
 }
 
+func (l *LoanContract1) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LoanContract1) AddContractDocumentIdentification() *DocumentIdentification22 {
 	l.ContractDocumentIdentification = new(DocumentIdentification22)
 	return l.ContractDocumentIdentification
@@ -359,18 +369,37 @@ func (l *LoanContract1) SetAmount(value, currency string) {
 	l.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (l *LoanContract1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LoanContract1) SetMaturityDate(value string) {
 	l.MaturityDate = (*ISODate)(&value)
 }
 
+func (l *LoanContract1) SetMaturityDateFromTime(value time.Time) {
+	l.MaturityDate = new(ISODate)
+	l.MaturityDate.FromTime(value)
+}
+
 func (l *LoanContract1) SetProlongationFlag(value string) {
 	l.ProlongationFlag = (*TrueFalseIndicator)(&value)
 }
 
+func (l *LoanContract1) SetProlongationFlagFromBool(value bool) {
+	l.ProlongationFlag = new(TrueFalseIndicator)
+	l.ProlongationFlag.FromBool(value)
+}
+
 func (l *LoanContract1) SetStartDate(value string) {
 	l.StartDate = (*ISODate)(&value)
 }
 
+func (l *LoanContract1) SetStartDateFromTime(value time.Time) {
+	l.StartDate = new(ISODate)
+	l.StartDate.FromTime(value)
+}
+
 func (l *LoanContract1) SetSettlementCurrency(value string) {
 	l.SettlementCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -549,6 +578,11 @@ func (l *LoanContract1) SetIntraCompanyLoan(value string) {
 	l.IntraCompanyLoan = (*TrueFalseIndicator)(&value)
 }
 
+func (l *LoanContract1) SetIntraCompanyLoanFromBool(value bool) {
+	l.IntraCompanyLoan = new(TrueFalseIndicator)
+	l.IntraCompanyLoan.FromBool(value)
+}
+
 func (l *LoanContract1) AddCollateral() *ContractCollateral1 {
 	l.Collateral = new(ContractCollateral1)
 	return l.Collateral
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the tranches defined for the loan contract.
 type LoanContractTranche1 struct {
 
@@ -22,22 +28,45 @@ type LoanContractTranche1 struct {
 	LastTrancheIndicator *YesNoIndicator `xml:"LastTrchInd,omitempty"`
 }
 
+func (l *LoanContractTranche1) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LoanContractTranche1) SetTrancheNumber(value string) {
 	l.TrancheNumber = (*Number)(&value)
 }
 
+func (l *LoanContractTranche1) SetTrancheNumberFromDecimal(value decimal.Decimal) {
+	l.TrancheNumber = new(Number)
+	l.TrancheNumber.FromDecimal(value)
+}
+
 func (l *LoanContractTranche1) SetExpectedDate(value string) {
 	l.ExpectedDate = (*ISODate)(&value)
 }
 
+func (l *LoanContractTranche1) SetExpectedDateFromTime(value time.Time) {
+	l.ExpectedDate = new(ISODate)
+	l.ExpectedDate.FromTime(value)
+}
+
 func (l *LoanContractTranche1) SetAmount(value, currency string) {
 	l.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (l *LoanContractTranche1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	l.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (l *LoanContractTranche1) SetDueDate(value string) {
 	l.DueDate = (*ISODate)(&value)
 }
 
+func (l *LoanContractTranche1) SetDueDateFromTime(value time.Time) {
+	l.DueDate = new(ISODate)
+	l.DueDate.FromTime(value)
+}
+
 func (l *LoanContractTranche1) SetDurationCode(value string) {
 	l.DurationCode = (*Exact1NumericText)(&value)
 }
@@ -45,3 +74,8 @@ func (l *LoanContractTranche1) SetDurationCode(value string) {
 func (l *LoanContractTranche1) SetLastTrancheIndicator(value string) {
 	l.LastTrancheIndicator = (*YesNoIndicator)(&value)
 }
+
+func (l *LoanContractTranche1) SetLastTrancheIndicatorFromBool(value bool) {
+	l.LastTrancheIndicator = new(YesNoIndicator)
+	l.LastTrancheIndicator.FromBool(value)
+}
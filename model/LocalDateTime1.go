@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Local time offset to UTC (Coordinated Universal Time).
 type LocalDateTime1 struct {
 
@@ -13,14 +19,33 @@ type LocalDateTime1 struct {
 	UTCOffset *Number `xml:"UTCOffset"`
 }
 
+func (l *LocalDateTime1) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LocalDateTime1) SetFromDateTime(value string) {
 	l.FromDateTime = (*ISODateTime)(&value)
 }
 
+func (l *LocalDateTime1) SetFromDateTimeFromTime(value time.Time) {
+	l.FromDateTime = new(ISODateTime)
+	l.FromDateTime.FromTime(value)
+}
+
 func (l *LocalDateTime1) SetToDateTime(value string) {
 	l.ToDateTime = (*ISODateTime)(&value)
 }
 
+func (l *LocalDateTime1) SetToDateTimeFromTime(value time.Time) {
+	l.ToDateTime = new(ISODateTime)
+	l.ToDateTime.FromTime(value)
+}
+
 func (l *LocalDateTime1) SetUTCOffset(value string) {
 	l.UTCOffset = (*Number)(&value)
 }
+
+func (l *LocalDateTime1) SetUTCOffsetFromDecimal(value decimal.Decimal) {
+	l.UTCOffset = new(Number)
+	l.UTCOffset.FromDecimal(value)
+}
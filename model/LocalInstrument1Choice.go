@@ -10,6 +10,10 @@ type LocalInstrument1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (l *LocalInstrument1Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LocalInstrument1Choice) SetCode(value string) {
 	l.Code = (*ExternalLocalInstrumentCode)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements that further identifies the type of local instruments being requested by the initiating party.
 type LocalInstrument2Choice struct {
 
@@ -10,6 +12,10 @@ type LocalInstrument2Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (l *LocalInstrument2Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LocalInstrument2Choice) SetCode(value string) {
 	l.Code = (*ExternalLocalInstrument1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (l *LocalInstrument2Choice) SetCode(value string) {
 func (l *LocalInstrument2Choice) SetProprietary(value string) {
 	l.Proprietary = (*Max35Text)(&value)
 }
+
+func (l *LocalInstrument2Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if l.Code != nil {
+		enc.Element("Cd", string(*l.Code))
+	}
+	if l.Proprietary != nil {
+		enc.Element("Prtry", string(*l.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (l *LocalInstrument2Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			l.Code = (*ExternalLocalInstrument1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			l.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -19,6 +19,10 @@ type LocalMarketAnnex2 struct {
 	SettlementDetails []*CashAccount22 `xml:"SttlmDtls"`
 }
 
+func (l *LocalMarketAnnex2) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LocalMarketAnnex2) AddCountry(value string) {
 	l.Country = append(l.Country, (*CountryCode)(&value))
 }
@@ -13,6 +13,10 @@ type Location1 struct {
 	Text []*Max2000Text `xml:"Txt,omitempty"`
 }
 
+func (l *Location1) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Location1) SetCountry(value string) {
 	l.Country = (*CountryCode)(&value)
 }
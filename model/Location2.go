@@ -13,6 +13,10 @@ type Location2 struct {
 	Text *Max35Text `xml:"Txt,omitempty"`
 }
 
+func (l *Location2) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *Location2) SetCountry(value string) {
 	l.Country = (*CountryCode)(&value)
 }
@@ -10,6 +10,10 @@ type LocationFormat1Choice struct {
 	LocationCode *PlaceType1Code `xml:"LctnCd"`
 }
 
+func (l *LocationFormat1Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LocationFormat1Choice) AddAddress() *PostalAddress1 {
 	l.Address = new(PostalAddress1)
 	return l.Address
@@ -10,6 +10,10 @@ type LongPostalAddress1Choice struct {
 	Structured *StructuredLongPostalAddress1 `xml:"Strd"`
 }
 
+func (l *LongPostalAddress1Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LongPostalAddress1Choice) SetUnstructured(value string) {
 	l.Unstructured = (*Max140Text)(&value)
 }
@@ -10,6 +10,10 @@ type LongPostalAddress2Choice struct {
 	Structured *PostalAddress1 `xml:"Strd"`
 }
 
+func (l *LongPostalAddress2Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LongPostalAddress2Choice) SetUnstructured(value string) {
 	l.Unstructured = (*Max350Text)(&value)
 }
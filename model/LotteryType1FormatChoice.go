@@ -10,6 +10,10 @@ type LotteryType1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (l *LotteryType1FormatChoice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LotteryType1FormatChoice) SetCode(value string) {
 	l.Code = (*LotteryType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type LotteryTypeFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (l *LotteryTypeFormat1Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LotteryTypeFormat1Choice) SetCode(value string) {
 	l.Code = (*LotteryType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type LotteryTypeFormat4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (l *LotteryTypeFormat4Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LotteryTypeFormat4Choice) SetCode(value string) {
 	l.Code = (*LotteryType1Code)(&value)
 }
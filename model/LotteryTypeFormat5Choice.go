@@ -10,6 +10,10 @@ type LotteryTypeFormat5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (l *LotteryTypeFormat5Choice) Validate() error {
+	return ValidateElement(l)
+}
+
 func (l *LotteryTypeFormat5Choice) SetCode(value string) {
 	l.Code = (*LotteryType1Code)(&value)
 }
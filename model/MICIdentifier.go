@@ -1,3 +1,9 @@
 package model
 
 type MICIdentifier string
+
+var micIdentifierPattern = newPattern(`[A-Z0-9]{4,4}`)
+
+func (m MICIdentifier) Validate() error {
+	return validatePattern("MICIdentifier", string(m), micIdentifierPattern)
+}
@@ -10,6 +10,10 @@ type MailType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (m *MailType1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MailType1Choice) SetCode(value string) {
 	m.Code = (*MailType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type MainFundOrderDeskLocation1 struct {
 	TimeZoneOffSet *UTCOffset1 `xml:"TmZoneOffSet"`
 }
 
+func (m *MainFundOrderDeskLocation1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MainFundOrderDeskLocation1) SetCountry(value string) {
 	m.Country = (*CountryCode)(&value)
 }
@@ -28,10 +28,19 @@ type MaintenanceDelegateAction1 struct {
 	Action []*TMSAction4 `xml:"Actn,omitempty"`
 }
 
+func (m *MaintenanceDelegateAction1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MaintenanceDelegateAction1) SetPeriodicAction(value string) {
 	m.PeriodicAction = (*TrueFalseIndicator)(&value)
 }
 
+func (m *MaintenanceDelegateAction1) SetPeriodicActionFromBool(value bool) {
+	m.PeriodicAction = new(TrueFalseIndicator)
+	m.PeriodicAction.FromBool(value)
+}
+
 func (m *MaintenanceDelegateAction1) AddTMRemoteAccess() *NetworkParameters3 {
 	m.TMRemoteAccess = new(NetworkParameters3)
 	return m.TMRemoteAccess
@@ -28,10 +28,19 @@ type MaintenanceDelegateAction2 struct {
 	Action []*TMSAction5 `xml:"Actn,omitempty"`
 }
 
+func (m *MaintenanceDelegateAction2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MaintenanceDelegateAction2) SetPeriodicAction(value string) {
 	m.PeriodicAction = (*TrueFalseIndicator)(&value)
 }
 
+func (m *MaintenanceDelegateAction2) SetPeriodicActionFromBool(value bool) {
+	m.PeriodicAction = new(TrueFalseIndicator)
+	m.PeriodicAction.FromBool(value)
+}
+
 func (m *MaintenanceDelegateAction2) AddTMRemoteAccess() *NetworkParameters5 {
 	m.TMRemoteAccess = new(NetworkParameters5)
 	return m.TMRemoteAccess
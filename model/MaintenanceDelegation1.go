@@ -34,6 +34,10 @@ type MaintenanceDelegation1 struct {
 	ParameterDataSet *TerminalManagementDataSet14 `xml:"ParamDataSet,omitempty"`
 }
 
+func (m *MaintenanceDelegation1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MaintenanceDelegation1) AddMaintenanceService(value string) {
 	m.MaintenanceService = append(m.MaintenanceService, (*DataSetCategory6Code)(&value))
 }
@@ -42,6 +46,11 @@ func (m *MaintenanceDelegation1) SetPartialDelegation(value string) {
 	m.PartialDelegation = (*TrueFalseIndicator)(&value)
 }
 
+func (m *MaintenanceDelegation1) SetPartialDelegationFromBool(value bool) {
+	m.PartialDelegation = new(TrueFalseIndicator)
+	m.PartialDelegation.FromBool(value)
+}
+
 func (m *MaintenanceDelegation1) AddPOISubset(value string) {
 	m.POISubset = append(m.POISubset, (*Max35Text)(&value))
 }
@@ -31,6 +31,10 @@ type MaintenanceDelegation2 struct {
 	POIIdentificationAssociation []*MaintenanceIdentificationAssociation1 `xml:"POIIdAssoctn,omitempty"`
 }
 
+func (m *MaintenanceDelegation2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MaintenanceDelegation2) AddMaintenanceService(value string) {
 	m.MaintenanceService = append(m.MaintenanceService, (*DataSetCategory6Code)(&value))
 }
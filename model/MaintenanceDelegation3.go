@@ -37,6 +37,10 @@ type MaintenanceDelegation3 struct {
 	ParameterDataSet *TerminalManagementDataSet19 `xml:"ParamDataSet,omitempty"`
 }
 
+func (m *MaintenanceDelegation3) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MaintenanceDelegation3) SetDelegationType(value string) {
 	m.DelegationType = (*TerminalManagementAction3Code)(&value)
 }
@@ -49,6 +53,11 @@ func (m *MaintenanceDelegation3) SetPartialDelegation(value string) {
 	m.PartialDelegation = (*TrueFalseIndicator)(&value)
 }
 
+func (m *MaintenanceDelegation3) SetPartialDelegationFromBool(value bool) {
+	m.PartialDelegation = new(TrueFalseIndicator)
+	m.PartialDelegation.FromBool(value)
+}
+
 func (m *MaintenanceDelegation3) AddPOISubset(value string) {
 	m.POISubset = append(m.POISubset, (*Max35Text)(&value))
 }
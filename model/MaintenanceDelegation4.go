@@ -34,6 +34,10 @@ type MaintenanceDelegation4 struct {
 	POIIdentificationAssociation []*MaintenanceIdentificationAssociation1 `xml:"POIIdAssoctn,omitempty"`
 }
 
+func (m *MaintenanceDelegation4) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MaintenanceDelegation4) AddMaintenanceService(value string) {
 	m.MaintenanceService = append(m.MaintenanceService, (*DataSetCategory6Code)(&value))
 }
@@ -13,6 +13,10 @@ type MaintenanceDelegationRequest1 struct {
 	RequestedDelegation []*MaintenanceDelegation1 `xml:"ReqdDlgtn"`
 }
 
+func (m *MaintenanceDelegationRequest1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MaintenanceDelegationRequest1) AddTMIdentification() *GenericIdentification72 {
 	m.TMIdentification = new(GenericIdentification72)
 	return m.TMIdentification
@@ -13,6 +13,10 @@ type MaintenanceDelegationRequest2 struct {
 	RequestedDelegation []*MaintenanceDelegation3 `xml:"ReqdDlgtn"`
 }
 
+func (m *MaintenanceDelegationRequest2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MaintenanceDelegationRequest2) AddTMIdentification() *GenericIdentification72 {
 	m.TMIdentification = new(GenericIdentification72)
 	return m.TMIdentification
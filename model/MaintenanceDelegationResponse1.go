@@ -13,6 +13,10 @@ type MaintenanceDelegationResponse1 struct {
 	DelegationResponse []*MaintenanceDelegation2 `xml:"DlgtnRspn"`
 }
 
+func (m *MaintenanceDelegationResponse1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MaintenanceDelegationResponse1) AddTMIdentification() *GenericIdentification72 {
 	m.TMIdentification = new(GenericIdentification72)
 	return m.TMIdentification
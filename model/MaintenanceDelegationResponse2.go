@@ -13,6 +13,10 @@ type MaintenanceDelegationResponse2 struct {
 	DelegationResponse []*MaintenanceDelegation4 `xml:"DlgtnRspn"`
 }
 
+func (m *MaintenanceDelegationResponse2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MaintenanceDelegationResponse2) AddTMIdentification() *GenericIdentification72 {
 	m.TMIdentification = new(GenericIdentification72)
 	return m.TMIdentification
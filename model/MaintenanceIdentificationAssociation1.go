@@ -10,6 +10,10 @@ type MaintenanceIdentificationAssociation1 struct {
 	TMIdentification *Max35Text `xml:"TMId"`
 }
 
+func (m *MaintenanceIdentificationAssociation1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MaintenanceIdentificationAssociation1) SetMasterTMIdentification(value string) {
 	m.MasterTMIdentification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type ManagementPlan1 struct {
 	DataSet []*TerminalManagementDataSet2 `xml:"DataSet"`
 }
 
+func (m *ManagementPlan1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ManagementPlan1) AddPOIIdentification() *GenericIdentification35 {
 	m.POIIdentification = new(GenericIdentification35)
 	return m.POIIdentification
@@ -13,6 +13,10 @@ type ManagementPlan2 struct {
 	DataSet []*TerminalManagementDataSet5 `xml:"DataSet"`
 }
 
+func (m *ManagementPlan2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ManagementPlan2) AddPOIIdentification() *GenericIdentification35 {
 	m.POIIdentification = new(GenericIdentification35)
 	return m.POIIdentification
@@ -13,6 +13,10 @@ type ManagementPlan3 struct {
 	DataSet []*TerminalManagementDataSet10 `xml:"DataSet"`
 }
 
+func (m *ManagementPlan3) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ManagementPlan3) AddPOIIdentification() *GenericIdentification35 {
 	m.POIIdentification = new(GenericIdentification35)
 	return m.POIIdentification
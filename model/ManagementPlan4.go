@@ -13,6 +13,10 @@ type ManagementPlan4 struct {
 	DataSet *TerminalManagementDataSet15 `xml:"DataSet"`
 }
 
+func (m *ManagementPlan4) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ManagementPlan4) AddPOIIdentification() *GenericIdentification71 {
 	m.POIIdentification = new(GenericIdentification71)
 	return m.POIIdentification
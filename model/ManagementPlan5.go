@@ -13,6 +13,10 @@ type ManagementPlan5 struct {
 	DataSet *TerminalManagementDataSet18 `xml:"DataSet"`
 }
 
+func (m *ManagementPlan5) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ManagementPlan5) AddPOIIdentification() *GenericIdentification71 {
 	m.POIIdentification = new(GenericIdentification71)
 	return m.POIIdentification
@@ -7,6 +7,10 @@ type ManagementPlanContent1 struct {
 	Action []*TMSAction1 `xml:"Actn"`
 }
 
+func (m *ManagementPlanContent1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ManagementPlanContent1) AddAction() *TMSAction1 {
 	newValue := new(TMSAction1)
 	m.Action = append(m.Action, newValue)
@@ -7,6 +7,10 @@ type ManagementPlanContent2 struct {
 	Action []*TMSAction2 `xml:"Actn"`
 }
 
+func (m *ManagementPlanContent2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ManagementPlanContent2) AddAction() *TMSAction2 {
 	newValue := new(TMSAction2)
 	m.Action = append(m.Action, newValue)
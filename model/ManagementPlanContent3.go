@@ -7,6 +7,10 @@ type ManagementPlanContent3 struct {
 	Action []*TMSAction3 `xml:"Actn"`
 }
 
+func (m *ManagementPlanContent3) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ManagementPlanContent3) AddAction() *TMSAction3 {
 	newValue := new(TMSAction3)
 	m.Action = append(m.Action, newValue)
@@ -13,6 +13,10 @@ type ManagementPlanContent4 struct {
 	Action []*TMSAction4 `xml:"Actn"`
 }
 
+func (m *ManagementPlanContent4) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ManagementPlanContent4) SetTMChallenge(value string) {
 	m.TMChallenge = (*Max140Binary)(&value)
 }
@@ -13,6 +13,10 @@ type ManagementPlanContent5 struct {
 	Action []*TMSAction5 `xml:"Actn"`
 }
 
+func (m *ManagementPlanContent5) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ManagementPlanContent5) SetTMChallenge(value string) {
 	m.TMChallenge = (*Max140Binary)(&value)
 }
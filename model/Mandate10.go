@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information that serves as a basis to debit an account.
 type Mandate10 struct {
 
@@ -73,6 +77,10 @@ type Mandate10 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *Mandate10) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *Mandate10) AddMandateIdentification(value string) {
 	m.MandateIdentification = append(m.MandateIdentification, (*Max35Text)(&value))
 }
@@ -100,18 +108,35 @@ func (m *Mandate10) SetTrackingIndicator(value string) {
 	m.TrackingIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (m *Mandate10) SetTrackingIndicatorFromBool(value bool) {
+	m.TrackingIndicator = new(TrueFalseIndicator)
+	m.TrackingIndicator.FromBool(value)
+}
+
 func (m *Mandate10) SetFirstCollectionAmount(value, currency string) {
 	m.FirstCollectionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate10) SetFirstCollectionAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.FirstCollectionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate10) SetCollectionAmount(value, currency string) {
 	m.CollectionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate10) SetCollectionAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.CollectionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate10) SetMaximumAmount(value, currency string) {
 	m.MaximumAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate10) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.MaximumAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate10) AddAdjustment() *MandateAdjustment1 {
 	m.Adjustment = new(MandateAdjustment1)
 	return m.Adjustment
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information that serves as a basis to debit an account.
 type Mandate11 struct {
 
@@ -70,6 +74,10 @@ type Mandate11 struct {
 	ReferredDocument []*ReferredMandateDocument1 `xml:"RfrdDoc,omitempty"`
 }
 
+func (m *Mandate11) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *Mandate11) SetMandateIdentification(value string) {
 	m.MandateIdentification = (*Max35Text)(&value)
 }
@@ -97,18 +105,35 @@ func (m *Mandate11) SetTrackingIndicator(value string) {
 	m.TrackingIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (m *Mandate11) SetTrackingIndicatorFromBool(value bool) {
+	m.TrackingIndicator = new(TrueFalseIndicator)
+	m.TrackingIndicator.FromBool(value)
+}
+
 func (m *Mandate11) SetFirstCollectionAmount(value, currency string) {
 	m.FirstCollectionAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate11) SetFirstCollectionAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.FirstCollectionAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate11) SetCollectionAmount(value, currency string) {
 	m.CollectionAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate11) SetCollectionAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.CollectionAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate11) SetMaximumAmount(value, currency string) {
 	m.MaximumAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate11) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.MaximumAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate11) AddAdjustment() *MandateAdjustment1 {
 	m.Adjustment = new(MandateAdjustment1)
 	return m.Adjustment
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information that serves as a basis to debit an account.
 type Mandate3 struct {
 
@@ -52,6 +56,10 @@ type Mandate3 struct {
 	ReferredDocument *ReferredDocumentInformation3 `xml:"RfrdDoc,omitempty"`
 }
 
+func (m *Mandate3) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *Mandate3) SetMandateIdentification(value string) {
 	m.MandateIdentification = (*Max35Text)(&value)
 }
@@ -74,10 +82,18 @@ func (m *Mandate3) SetCollectionAmount(value, currency string) {
 	m.CollectionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate3) SetCollectionAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.CollectionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate3) SetMaximumAmount(value, currency string) {
 	m.MaximumAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate3) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.MaximumAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate3) AddCreditorSchemeIdentification() *PartyIdentification43 {
 	m.CreditorSchemeIdentification = new(PartyIdentification43)
 	return m.CreditorSchemeIdentification
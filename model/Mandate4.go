@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information that serves as a basis to debit an account.
 type Mandate4 struct {
 
@@ -55,6 +59,10 @@ type Mandate4 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *Mandate4) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *Mandate4) SetMandateIdentification(value string) {
 	m.MandateIdentification = (*Max35Text)(&value)
 }
@@ -77,10 +85,18 @@ func (m *Mandate4) SetCollectionAmount(value, currency string) {
 	m.CollectionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate4) SetCollectionAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.CollectionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate4) SetMaximumAmount(value, currency string) {
 	m.MaximumAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate4) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.MaximumAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate4) AddCreditorSchemeIdentification() *PartyIdentification43 {
 	m.CreditorSchemeIdentification = new(PartyIdentification43)
 	return m.CreditorSchemeIdentification
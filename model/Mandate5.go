@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information that serves as a basis to debit an account.
 type Mandate5 struct {
 
@@ -55,6 +59,10 @@ type Mandate5 struct {
 	ReferredDocument []*ReferredDocumentInformation6 `xml:"RfrdDoc,omitempty"`
 }
 
+func (m *Mandate5) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *Mandate5) SetMandateIdentification(value string) {
 	m.MandateIdentification = (*Max35Text)(&value)
 }
@@ -77,10 +85,18 @@ func (m *Mandate5) SetCollectionAmount(value, currency string) {
 	m.CollectionAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate5) SetCollectionAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.CollectionAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate5) SetMaximumAmount(value, currency string) {
 	m.MaximumAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate5) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.MaximumAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate5) AddReason() *MandateSetupReason1Choice {
 	m.Reason = new(MandateSetupReason1Choice)
 	return m.Reason
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information that serves as a basis to debit an account.
 type Mandate6 struct {
 
@@ -55,6 +59,10 @@ type Mandate6 struct {
 	ReferredDocument []*ReferredDocumentInformation6 `xml:"RfrdDoc,omitempty"`
 }
 
+func (m *Mandate6) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *Mandate6) SetMandateIdentification(value string) {
 	m.MandateIdentification = (*Max35Text)(&value)
 }
@@ -77,10 +85,18 @@ func (m *Mandate6) SetCollectionAmount(value, currency string) {
 	m.CollectionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate6) SetCollectionAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.CollectionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate6) SetMaximumAmount(value, currency string) {
 	m.MaximumAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate6) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.MaximumAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate6) AddReason() *MandateSetupReason1Choice {
 	m.Reason = new(MandateSetupReason1Choice)
 	return m.Reason
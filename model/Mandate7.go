@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information that serves as a basis to debit an account.
 type Mandate7 struct {
 
@@ -58,6 +62,10 @@ type Mandate7 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *Mandate7) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *Mandate7) AddMandateIdentification(value string) {
 	m.MandateIdentification = append(m.MandateIdentification, (*Max35Text)(&value))
 }
@@ -80,10 +88,18 @@ func (m *Mandate7) SetCollectionAmount(value, currency string) {
 	m.CollectionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate7) SetCollectionAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.CollectionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate7) SetMaximumAmount(value, currency string) {
 	m.MaximumAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate7) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.MaximumAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate7) AddReason() *MandateSetupReason1Choice {
 	m.Reason = new(MandateSetupReason1Choice)
 	return m.Reason
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information that serves as a basis to debit an account.
 type Mandate8 struct {
 
@@ -70,6 +74,10 @@ type Mandate8 struct {
 	ReferredDocument []*ReferredMandateDocument1 `xml:"RfrdDoc,omitempty"`
 }
 
+func (m *Mandate8) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *Mandate8) SetMandateIdentification(value string) {
 	m.MandateIdentification = (*Max35Text)(&value)
 }
@@ -97,18 +105,35 @@ func (m *Mandate8) SetTrackingIndicator(value string) {
 	m.TrackingIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (m *Mandate8) SetTrackingIndicatorFromBool(value bool) {
+	m.TrackingIndicator = new(TrueFalseIndicator)
+	m.TrackingIndicator.FromBool(value)
+}
+
 func (m *Mandate8) SetFirstCollectionAmount(value, currency string) {
 	m.FirstCollectionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate8) SetFirstCollectionAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.FirstCollectionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate8) SetCollectionAmount(value, currency string) {
 	m.CollectionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate8) SetCollectionAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.CollectionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate8) SetMaximumAmount(value, currency string) {
 	m.MaximumAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate8) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.MaximumAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate8) AddAdjustment() *MandateAdjustment1 {
 	m.Adjustment = new(MandateAdjustment1)
 	return m.Adjustment
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information that serves as a basis to debit an account.
 type Mandate9 struct {
 
@@ -70,6 +74,10 @@ type Mandate9 struct {
 	ReferredDocument []*ReferredMandateDocument1 `xml:"RfrdDoc,omitempty"`
 }
 
+func (m *Mandate9) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *Mandate9) SetMandateIdentification(value string) {
 	m.MandateIdentification = (*Max35Text)(&value)
 }
@@ -97,18 +105,35 @@ func (m *Mandate9) SetTrackingIndicator(value string) {
 	m.TrackingIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (m *Mandate9) SetTrackingIndicatorFromBool(value bool) {
+	m.TrackingIndicator = new(TrueFalseIndicator)
+	m.TrackingIndicator.FromBool(value)
+}
+
 func (m *Mandate9) SetFirstCollectionAmount(value, currency string) {
 	m.FirstCollectionAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate9) SetFirstCollectionAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.FirstCollectionAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate9) SetCollectionAmount(value, currency string) {
 	m.CollectionAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate9) SetCollectionAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.CollectionAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate9) SetMaximumAmount(value, currency string) {
 	m.MaximumAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (m *Mandate9) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.MaximumAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *Mandate9) AddAdjustment() *MandateAdjustment1 {
 	m.Adjustment = new(MandateAdjustment1)
 	return m.Adjustment
@@ -13,6 +13,10 @@ type MandateAcceptance1 struct {
 	OriginalMandate *OriginalMandate1Choice `xml:"OrgnlMndt"`
 }
 
+func (m *MandateAcceptance1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateAcceptance1) AddOriginalMessageInformation() *OriginalMessageInformation1 {
 	m.OriginalMessageInformation = new(OriginalMessageInformation1)
 	return m.OriginalMessageInformation
@@ -13,6 +13,10 @@ type MandateAcceptance2 struct {
 	OriginalMandate *OriginalMandate2Choice `xml:"OrgnlMndt"`
 }
 
+func (m *MandateAcceptance2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateAcceptance2) AddOriginalMessageInformation() *OriginalMessageInformation1 {
 	m.OriginalMessageInformation = new(OriginalMessageInformation1)
 	return m.OriginalMessageInformation
@@ -16,6 +16,10 @@ type MandateAcceptance4 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MandateAcceptance4) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateAcceptance4) AddOriginalMessageInformation() *OriginalMessageInformation1 {
 	m.OriginalMessageInformation = new(OriginalMessageInformation1)
 	return m.OriginalMessageInformation
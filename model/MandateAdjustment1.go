@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the details for the adjustment of the mandate.
 type MandateAdjustment1 struct {
 
@@ -16,10 +20,19 @@ type MandateAdjustment1 struct {
 	Rate *PercentageRate `xml:"Rate,omitempty"`
 }
 
+func (m *MandateAdjustment1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateAdjustment1) SetDateAdjustmentRuleIndicator(value string) {
 	m.DateAdjustmentRuleIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (m *MandateAdjustment1) SetDateAdjustmentRuleIndicatorFromBool(value bool) {
+	m.DateAdjustmentRuleIndicator = new(TrueFalseIndicator)
+	m.DateAdjustmentRuleIndicator.FromBool(value)
+}
+
 func (m *MandateAdjustment1) AddCategory() *Frequency37Choice {
 	m.Category = new(Frequency37Choice)
 	return m.Category
@@ -29,6 +42,15 @@ func (m *MandateAdjustment1) SetAmount(value, currency string) {
 	m.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MandateAdjustment1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MandateAdjustment1) SetRate(value string) {
 	m.Rate = (*PercentageRate)(&value)
 }
+
+func (m *MandateAdjustment1) SetRateFromDecimal(value decimal.Decimal) {
+	m.Rate = new(PercentageRate)
+	m.Rate.FromDecimal(value)
+}
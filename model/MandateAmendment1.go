@@ -16,6 +16,10 @@ type MandateAmendment1 struct {
 	OriginalMandate *OriginalMandate1Choice `xml:"OrgnlMndt"`
 }
 
+func (m *MandateAmendment1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateAmendment1) AddOriginalMessageInformation() *OriginalMessageInformation1 {
 	m.OriginalMessageInformation = new(OriginalMessageInformation1)
 	return m.OriginalMessageInformation
@@ -16,6 +16,10 @@ type MandateAmendment2 struct {
 	OriginalMandate *OriginalMandate2Choice `xml:"OrgnlMndt"`
 }
 
+func (m *MandateAmendment2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateAmendment2) AddOriginalMessageInformation() *OriginalMessageInformation1 {
 	m.OriginalMessageInformation = new(OriginalMessageInformation1)
 	return m.OriginalMessageInformation
@@ -19,6 +19,10 @@ type MandateAmendment3 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MandateAmendment3) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateAmendment3) AddOriginalMessageInformation() *OriginalMessageInformation1 {
 	m.OriginalMessageInformation = new(OriginalMessageInformation1)
 	return m.OriginalMessageInformation
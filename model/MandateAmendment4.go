@@ -19,6 +19,10 @@ type MandateAmendment4 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MandateAmendment4) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateAmendment4) AddOriginalMessageInformation() *OriginalMessageInformation1 {
 	m.OriginalMessageInformation = new(OriginalMessageInformation1)
 	return m.OriginalMessageInformation
@@ -19,6 +19,10 @@ type MandateAmendment5 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MandateAmendment5) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateAmendment5) AddOriginalMessageInformation() *OriginalMessageInformation1 {
 	m.OriginalMessageInformation = new(OriginalMessageInformation1)
 	return m.OriginalMessageInformation
@@ -13,6 +13,10 @@ type MandateAmendmentReason1 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (m *MandateAmendmentReason1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateAmendmentReason1) AddOriginator() *PartyIdentification43 {
 	m.Originator = new(PartyIdentification43)
 	return m.Originator
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the transport authentication details related to the mandate.
 type MandateAuthentication1 struct {
 
@@ -13,6 +17,10 @@ type MandateAuthentication1 struct {
 	Channel *AuthenticationChannel1Choice `xml:"Chanl,omitempty"`
 }
 
+func (m *MandateAuthentication1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateAuthentication1) SetMessageAuthenticationCode(value string) {
 	m.MessageAuthenticationCode = (*Max16Text)(&value)
 }
@@ -21,6 +29,11 @@ func (m *MandateAuthentication1) SetDate(value string) {
 	m.Date = (*ISODate)(&value)
 }
 
+func (m *MandateAuthentication1) SetDateFromTime(value time.Time) {
+	m.Date = new(ISODate)
+	m.Date.FromTime(value)
+}
+
 func (m *MandateAuthentication1) AddChannel() *AuthenticationChannel1Choice {
 	m.Channel = new(AuthenticationChannel1Choice)
 	return m.Channel
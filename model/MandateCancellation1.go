@@ -13,6 +13,10 @@ type MandateCancellation1 struct {
 	OriginalMandate *OriginalMandate1Choice `xml:"OrgnlMndt"`
 }
 
+func (m *MandateCancellation1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateCancellation1) AddOriginalMessageInformation() *OriginalMessageInformation1 {
 	m.OriginalMessageInformation = new(OriginalMessageInformation1)
 	return m.OriginalMessageInformation
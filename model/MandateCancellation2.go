@@ -13,6 +13,10 @@ type MandateCancellation2 struct {
 	OriginalMandate *OriginalMandate2Choice `xml:"OrgnlMndt"`
 }
 
+func (m *MandateCancellation2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateCancellation2) AddOriginalMessageInformation() *OriginalMessageInformation1 {
 	m.OriginalMessageInformation = new(OriginalMessageInformation1)
 	return m.OriginalMessageInformation
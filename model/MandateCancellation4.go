@@ -16,6 +16,10 @@ type MandateCancellation4 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MandateCancellation4) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateCancellation4) AddOriginalMessageInformation() *OriginalMessageInformation1 {
 	m.OriginalMessageInformation = new(OriginalMessageInformation1)
 	return m.OriginalMessageInformation
@@ -16,6 +16,10 @@ type MandateCancellation5 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MandateCancellation5) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateCancellation5) AddOriginalMessageInformation() *OriginalMessageInformation1 {
 	m.OriginalMessageInformation = new(OriginalMessageInformation1)
 	return m.OriginalMessageInformation
@@ -11,8 +11,16 @@ type MandateClassification1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
-func (m *MandateClassification1Choice) SetCode(value string) {
+func (m *MandateClassification1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
+func (m *MandateClassification1Choice) SetCode(value string) error {
+	if err := MandateClassification1Code(value).Validate(); err != nil {
+		return err
+	}
 	m.Code = (*MandateClassification1Code)(&value)
+	return nil
 }
 
 func (m *MandateClassification1Choice) SetProprietary(value string) {
@@ -1,3 +1,28 @@
 package model
 
 type MandateClassification1Code string
+
+const (
+	MandateClassification1CodeFIXE MandateClassification1Code = "FIXE"
+	MandateClassification1CodeUSGB MandateClassification1Code = "USGB"
+	MandateClassification1CodeVARI MandateClassification1Code = "VARI"
+)
+
+var mandateClassification1CodeDescriptions = map[MandateClassification1Code]string{
+	MandateClassification1CodeFIXE: "Fixed: direct debit amount is fixed.",
+	MandateClassification1CodeUSGB: "Usage based: direct debit amount is based on usage.",
+	MandateClassification1CodeVARI: "Variable: direct debit amount is variable.",
+}
+
+func (m MandateClassification1Code) IsValid() bool {
+	_, ok := mandateClassification1CodeDescriptions[m]
+	return ok
+}
+
+func (m MandateClassification1Code) Description() string {
+	return mandateClassification1CodeDescriptions[m]
+}
+
+func (m MandateClassification1Code) Validate() error {
+	return validateEnumeration("MandateClassification1Code", string(m), m.IsValid(), "FIXE, USGB, VARI")
+}
@@ -16,6 +16,10 @@ type MandateCopy1 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MandateCopy1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateCopy1) AddOriginalMessageInformation() *OriginalMessageInformation1 {
 	m.OriginalMessageInformation = new(OriginalMessageInformation1)
 	return m.OriginalMessageInformation
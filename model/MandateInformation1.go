@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information that serves as a basis to debit an account.
 type MandateInformation1 struct {
 
@@ -52,6 +56,10 @@ type MandateInformation1 struct {
 	ReferredDocument *ReferredDocumentInformation3 `xml:"RfrdDoc,omitempty"`
 }
 
+func (m *MandateInformation1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateInformation1) SetMandateIdentification(value string) {
 	m.MandateIdentification = (*Max35Text)(&value)
 }
@@ -74,10 +82,18 @@ func (m *MandateInformation1) SetCollectionAmount(value, currency string) {
 	m.CollectionAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (m *MandateInformation1) SetCollectionAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.CollectionAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MandateInformation1) SetMaximumAmount(value, currency string) {
 	m.MaximumAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (m *MandateInformation1) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.MaximumAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MandateInformation1) AddCreditorSchemeIdentification() *PartyIdentification32 {
 	m.CreditorSchemeIdentification = new(PartyIdentification32)
 	return m.CreditorSchemeIdentification
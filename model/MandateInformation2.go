@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information that serves as a basis to debit an account.
 type MandateInformation2 struct {
 
@@ -52,6 +56,10 @@ type MandateInformation2 struct {
 	ReferredDocument *ReferredDocumentInformation3 `xml:"RfrdDoc,omitempty"`
 }
 
+func (m *MandateInformation2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateInformation2) SetMandateIdentification(value string) {
 	m.MandateIdentification = (*Max35Text)(&value)
 }
@@ -74,10 +82,18 @@ func (m *MandateInformation2) SetCollectionAmount(value, currency string) {
 	m.CollectionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MandateInformation2) SetCollectionAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.CollectionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MandateInformation2) SetMaximumAmount(value, currency string) {
 	m.MaximumAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MandateInformation2) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.MaximumAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MandateInformation2) AddCreditorSchemeIdentification() *PartyIdentification32 {
 	m.CreditorSchemeIdentification = new(PartyIdentification32)
 	return m.CreditorSchemeIdentification
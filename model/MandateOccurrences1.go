@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to provide further details related to the duration of the mandate and the occurrence of the underlying transactions.
 type MandateOccurrences1 struct {
 
@@ -19,8 +23,16 @@ type MandateOccurrences1 struct {
 	FinalCollectionDate *ISODate `xml:"FnlColltnDt,omitempty"`
 }
 
-func (m *MandateOccurrences1) SetSequenceType(value string) {
+func (m *MandateOccurrences1) Validate() error {
+	return ValidateElement(m)
+}
+
+func (m *MandateOccurrences1) SetSequenceType(value string) error {
+	if err := SequenceType2Code(value).Validate(); err != nil {
+		return err
+	}
 	m.SequenceType = (*SequenceType2Code)(&value)
+	return nil
 }
 
 func (m *MandateOccurrences1) SetFrequency(value string) {
@@ -36,6 +48,16 @@ func (m *MandateOccurrences1) SetFirstCollectionDate(value string) {
 	m.FirstCollectionDate = (*ISODate)(&value)
 }
 
+func (m *MandateOccurrences1) SetFirstCollectionDateFromTime(value time.Time) {
+	m.FirstCollectionDate = new(ISODate)
+	m.FirstCollectionDate.FromTime(value)
+}
+
 func (m *MandateOccurrences1) SetFinalCollectionDate(value string) {
 	m.FinalCollectionDate = (*ISODate)(&value)
 }
+
+func (m *MandateOccurrences1) SetFinalCollectionDateFromTime(value time.Time) {
+	m.FinalCollectionDate = new(ISODate)
+	m.FinalCollectionDate.FromTime(value)
+}
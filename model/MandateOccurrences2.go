@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details related to the duration of the mandate and the occurrence of the underlying transactions.
 type MandateOccurrences2 struct {
 
@@ -19,12 +23,24 @@ type MandateOccurrences2 struct {
 	FinalCollectionDate *ISODate `xml:"FnlColltnDt,omitempty"`
 }
 
-func (m *MandateOccurrences2) SetSequenceType(value string) {
+func (m *MandateOccurrences2) Validate() error {
+	return ValidateElement(m)
+}
+
+func (m *MandateOccurrences2) SetSequenceType(value string) error {
+	if err := SequenceType2Code(value).Validate(); err != nil {
+		return err
+	}
 	m.SequenceType = (*SequenceType2Code)(&value)
+	return nil
 }
 
-func (m *MandateOccurrences2) SetFrequency(value string) {
+func (m *MandateOccurrences2) SetFrequency(value string) error {
+	if err := Frequency6Code(value).Validate(); err != nil {
+		return err
+	}
 	m.Frequency = (*Frequency6Code)(&value)
+	return nil
 }
 
 func (m *MandateOccurrences2) AddDuration() *DatePeriodDetails1 {
@@ -36,6 +52,16 @@ func (m *MandateOccurrences2) SetFirstCollectionDate(value string) {
 	m.FirstCollectionDate = (*ISODate)(&value)
 }
 
+func (m *MandateOccurrences2) SetFirstCollectionDateFromTime(value time.Time) {
+	m.FirstCollectionDate = new(ISODate)
+	m.FirstCollectionDate.FromTime(value)
+}
+
 func (m *MandateOccurrences2) SetFinalCollectionDate(value string) {
 	m.FinalCollectionDate = (*ISODate)(&value)
 }
+
+func (m *MandateOccurrences2) SetFinalCollectionDateFromTime(value time.Time) {
+	m.FinalCollectionDate = new(ISODate)
+	m.FinalCollectionDate.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details related to the duration of the mandate and the occurrence of the underlying transactions.
 type MandateOccurrences4 struct {
 
@@ -19,8 +23,16 @@ type MandateOccurrences4 struct {
 	FinalCollectionDate *ISODate `xml:"FnlColltnDt,omitempty"`
 }
 
-func (m *MandateOccurrences4) SetSequenceType(value string) {
+func (m *MandateOccurrences4) Validate() error {
+	return ValidateElement(m)
+}
+
+func (m *MandateOccurrences4) SetSequenceType(value string) error {
+	if err := SequenceType2Code(value).Validate(); err != nil {
+		return err
+	}
 	m.SequenceType = (*SequenceType2Code)(&value)
+	return nil
 }
 
 func (m *MandateOccurrences4) AddFrequency() *Frequency36Choice {
@@ -37,6 +49,16 @@ func (m *MandateOccurrences4) SetFirstCollectionDate(value string) {
 	m.FirstCollectionDate = (*ISODate)(&value)
 }
 
+func (m *MandateOccurrences4) SetFirstCollectionDateFromTime(value time.Time) {
+	m.FirstCollectionDate = new(ISODate)
+	m.FirstCollectionDate.FromTime(value)
+}
+
 func (m *MandateOccurrences4) SetFinalCollectionDate(value string) {
 	m.FinalCollectionDate = (*ISODate)(&value)
 }
+
+func (m *MandateOccurrences4) SetFinalCollectionDateFromTime(value time.Time) {
+	m.FinalCollectionDate = new(ISODate)
+	m.FinalCollectionDate.FromTime(value)
+}
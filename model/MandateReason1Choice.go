@@ -10,6 +10,10 @@ type MandateReason1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (m *MandateReason1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateReason1Choice) SetCode(value string) {
 	m.Code = (*ExternalMandateReason1Code)(&value)
 }
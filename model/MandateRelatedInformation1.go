@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to provide further details related to a direct debit mandate signed between the creditor and the debtor.
 type MandateRelatedInformation1 struct {
 
@@ -28,6 +32,10 @@ type MandateRelatedInformation1 struct {
 	Frequency *Frequency1Code `xml:"Frqcy,omitempty"`
 }
 
+func (m *MandateRelatedInformation1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateRelatedInformation1) SetMandateIdentification(value string) {
 	m.MandateIdentification = (*Max35Text)(&value)
 }
@@ -36,10 +44,20 @@ func (m *MandateRelatedInformation1) SetDateOfSignature(value string) {
 	m.DateOfSignature = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation1) SetDateOfSignatureFromTime(value time.Time) {
+	m.DateOfSignature = new(ISODate)
+	m.DateOfSignature.FromTime(value)
+}
+
 func (m *MandateRelatedInformation1) SetAmendmentIndicator(value string) {
 	m.AmendmentIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (m *MandateRelatedInformation1) SetAmendmentIndicatorFromBool(value bool) {
+	m.AmendmentIndicator = new(TrueFalseIndicator)
+	m.AmendmentIndicator.FromBool(value)
+}
+
 func (m *MandateRelatedInformation1) AddAmendmentInformationDetails() *AmendmentInformationDetails1 {
 	m.AmendmentInformationDetails = new(AmendmentInformationDetails1)
 	return m.AmendmentInformationDetails
@@ -53,10 +71,20 @@ func (m *MandateRelatedInformation1) SetFirstCollectionDate(value string) {
 	m.FirstCollectionDate = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation1) SetFirstCollectionDateFromTime(value time.Time) {
+	m.FirstCollectionDate = new(ISODate)
+	m.FirstCollectionDate.FromTime(value)
+}
+
 func (m *MandateRelatedInformation1) SetFinalCollectionDate(value string) {
 	m.FinalCollectionDate = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation1) SetFinalCollectionDateFromTime(value time.Time) {
+	m.FinalCollectionDate = new(ISODate)
+	m.FinalCollectionDate.FromTime(value)
+}
+
 func (m *MandateRelatedInformation1) SetFrequency(value string) {
 	m.Frequency = (*Frequency1Code)(&value)
 }
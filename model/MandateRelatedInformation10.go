@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details related to a direct debit mandate signed between the creditor and the debtor.
 type MandateRelatedInformation10 struct {
 
@@ -31,6 +35,10 @@ type MandateRelatedInformation10 struct {
 	Reason *MandateSetupReason1Choice `xml:"Rsn,omitempty"`
 }
 
+func (m *MandateRelatedInformation10) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateRelatedInformation10) SetMandateIdentification(value string) {
 	m.MandateIdentification = (*Max35Text)(&value)
 }
@@ -39,10 +47,20 @@ func (m *MandateRelatedInformation10) SetDateOfSignature(value string) {
 	m.DateOfSignature = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation10) SetDateOfSignatureFromTime(value time.Time) {
+	m.DateOfSignature = new(ISODate)
+	m.DateOfSignature.FromTime(value)
+}
+
 func (m *MandateRelatedInformation10) SetAmendmentIndicator(value string) {
 	m.AmendmentIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (m *MandateRelatedInformation10) SetAmendmentIndicatorFromBool(value bool) {
+	m.AmendmentIndicator = new(TrueFalseIndicator)
+	m.AmendmentIndicator.FromBool(value)
+}
+
 func (m *MandateRelatedInformation10) AddAmendmentInformationDetails() *AmendmentInformationDetails10 {
 	m.AmendmentInformationDetails = new(AmendmentInformationDetails10)
 	return m.AmendmentInformationDetails
@@ -56,10 +74,20 @@ func (m *MandateRelatedInformation10) SetFirstCollectionDate(value string) {
 	m.FirstCollectionDate = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation10) SetFirstCollectionDateFromTime(value time.Time) {
+	m.FirstCollectionDate = new(ISODate)
+	m.FirstCollectionDate.FromTime(value)
+}
+
 func (m *MandateRelatedInformation10) SetFinalCollectionDate(value string) {
 	m.FinalCollectionDate = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation10) SetFinalCollectionDateFromTime(value time.Time) {
+	m.FinalCollectionDate = new(ISODate)
+	m.FinalCollectionDate.FromTime(value)
+}
+
 func (m *MandateRelatedInformation10) AddFrequency() *Frequency21Choice {
 	m.Frequency = new(Frequency21Choice)
 	return m.Frequency
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides further details related to a direct debit mandate signed between the creditor and the debtor.
 type MandateRelatedInformation11 struct {
 
@@ -34,6 +40,10 @@ type MandateRelatedInformation11 struct {
 	TrackingDays *Exact2NumericText `xml:"TrckgDays,omitempty"`
 }
 
+func (m *MandateRelatedInformation11) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateRelatedInformation11) SetMandateIdentification(value string) {
 	m.MandateIdentification = (*Max35Text)(&value)
 }
@@ -42,10 +52,20 @@ func (m *MandateRelatedInformation11) SetDateOfSignature(value string) {
 	m.DateOfSignature = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation11) SetDateOfSignatureFromTime(value time.Time) {
+	m.DateOfSignature = new(ISODate)
+	m.DateOfSignature.FromTime(value)
+}
+
 func (m *MandateRelatedInformation11) SetAmendmentIndicator(value string) {
 	m.AmendmentIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (m *MandateRelatedInformation11) SetAmendmentIndicatorFromBool(value bool) {
+	m.AmendmentIndicator = new(TrueFalseIndicator)
+	m.AmendmentIndicator.FromBool(value)
+}
+
 func (m *MandateRelatedInformation11) AddAmendmentInformationDetails() *AmendmentInformationDetails11 {
 	m.AmendmentInformationDetails = new(AmendmentInformationDetails11)
 	return m.AmendmentInformationDetails
@@ -59,10 +79,20 @@ func (m *MandateRelatedInformation11) SetFirstCollectionDate(value string) {
 	m.FirstCollectionDate = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation11) SetFirstCollectionDateFromTime(value time.Time) {
+	m.FirstCollectionDate = new(ISODate)
+	m.FirstCollectionDate.FromTime(value)
+}
+
 func (m *MandateRelatedInformation11) SetFinalCollectionDate(value string) {
 	m.FinalCollectionDate = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation11) SetFinalCollectionDateFromTime(value time.Time) {
+	m.FinalCollectionDate = new(ISODate)
+	m.FinalCollectionDate.FromTime(value)
+}
+
 func (m *MandateRelatedInformation11) AddFrequency() *Frequency36Choice {
 	m.Frequency = new(Frequency36Choice)
 	return m.Frequency
@@ -76,3 +106,116 @@ func (m *MandateRelatedInformation11) AddReason() *MandateSetupReason1Choice {
 func (m *MandateRelatedInformation11) SetTrackingDays(value string) {
 	m.TrackingDays = (*Exact2NumericText)(&value)
 }
+
+func (m *MandateRelatedInformation11) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if m.MandateIdentification != nil {
+		enc.Element("MndtId", string(*m.MandateIdentification))
+	}
+	if m.DateOfSignature != nil {
+		enc.Element("DtOfSgntr", string(*m.DateOfSignature))
+	}
+	if m.AmendmentIndicator != nil {
+		enc.Element("AmdmntInd", string(*m.AmendmentIndicator))
+	}
+	if m.AmendmentInformationDetails != nil {
+		m.AmendmentInformationDetails.EncodeXMLFast(enc, "AmdmntInfDtls")
+	}
+	if m.ElectronicSignature != nil {
+		enc.Element("ElctrncSgntr", string(*m.ElectronicSignature))
+	}
+	if m.FirstCollectionDate != nil {
+		enc.Element("FrstColltnDt", string(*m.FirstCollectionDate))
+	}
+	if m.FinalCollectionDate != nil {
+		enc.Element("FnlColltnDt", string(*m.FinalCollectionDate))
+	}
+	if m.Frequency != nil {
+		m.Frequency.EncodeXMLFast(enc, "Frqcy")
+	}
+	if m.Reason != nil {
+		m.Reason.EncodeXMLFast(enc, "Rsn")
+	}
+	if m.TrackingDays != nil {
+		enc.Element("TrckgDays", string(*m.TrackingDays))
+	}
+	enc.End(tag)
+}
+
+func (m *MandateRelatedInformation11) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "MndtId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			m.MandateIdentification = (*Max35Text)(&value)
+		case "DtOfSgntr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			m.DateOfSignature = (*ISODate)(&value)
+		case "AmdmntInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			m.AmendmentIndicator = (*TrueFalseIndicator)(&value)
+		case "AmdmntInfDtls":
+			elem := new(AmendmentInformationDetails11)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			m.AmendmentInformationDetails = elem
+		case "ElctrncSgntr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			m.ElectronicSignature = (*Max1025Text)(&value)
+		case "FrstColltnDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			m.FirstCollectionDate = (*ISODate)(&value)
+		case "FnlColltnDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			m.FinalCollectionDate = (*ISODate)(&value)
+		case "Frqcy":
+			elem := new(Frequency36Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			m.Frequency = elem
+		case "Rsn":
+			elem := new(MandateSetupReason1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			m.Reason = elem
+		case "TrckgDays":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			m.TrackingDays = (*Exact2NumericText)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to provide further details related to a direct debit mandate signed between the creditor and the debtor.
 type MandateRelatedInformation6 struct {
 
@@ -28,6 +32,10 @@ type MandateRelatedInformation6 struct {
 	Frequency *Frequency1Code `xml:"Frqcy,omitempty"`
 }
 
+func (m *MandateRelatedInformation6) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateRelatedInformation6) SetMandateIdentification(value string) {
 	m.MandateIdentification = (*Max35Text)(&value)
 }
@@ -36,10 +44,20 @@ func (m *MandateRelatedInformation6) SetDateOfSignature(value string) {
 	m.DateOfSignature = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation6) SetDateOfSignatureFromTime(value time.Time) {
+	m.DateOfSignature = new(ISODate)
+	m.DateOfSignature.FromTime(value)
+}
+
 func (m *MandateRelatedInformation6) SetAmendmentIndicator(value string) {
 	m.AmendmentIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (m *MandateRelatedInformation6) SetAmendmentIndicatorFromBool(value bool) {
+	m.AmendmentIndicator = new(TrueFalseIndicator)
+	m.AmendmentIndicator.FromBool(value)
+}
+
 func (m *MandateRelatedInformation6) AddAmendmentInformationDetails() *AmendmentInformationDetails6 {
 	m.AmendmentInformationDetails = new(AmendmentInformationDetails6)
 	return m.AmendmentInformationDetails
@@ -53,10 +71,20 @@ func (m *MandateRelatedInformation6) SetFirstCollectionDate(value string) {
 	m.FirstCollectionDate = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation6) SetFirstCollectionDateFromTime(value time.Time) {
+	m.FirstCollectionDate = new(ISODate)
+	m.FirstCollectionDate.FromTime(value)
+}
+
 func (m *MandateRelatedInformation6) SetFinalCollectionDate(value string) {
 	m.FinalCollectionDate = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation6) SetFinalCollectionDateFromTime(value time.Time) {
+	m.FinalCollectionDate = new(ISODate)
+	m.FinalCollectionDate.FromTime(value)
+}
+
 func (m *MandateRelatedInformation6) SetFrequency(value string) {
 	m.Frequency = (*Frequency1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details related to a direct debit mandate signed between the creditor and the debtor.
 type MandateRelatedInformation8 struct {
 
@@ -28,6 +32,10 @@ type MandateRelatedInformation8 struct {
 	Frequency *Frequency6Code `xml:"Frqcy,omitempty"`
 }
 
+func (m *MandateRelatedInformation8) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateRelatedInformation8) SetMandateIdentification(value string) {
 	m.MandateIdentification = (*Max35Text)(&value)
 }
@@ -36,10 +44,20 @@ func (m *MandateRelatedInformation8) SetDateOfSignature(value string) {
 	m.DateOfSignature = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation8) SetDateOfSignatureFromTime(value time.Time) {
+	m.DateOfSignature = new(ISODate)
+	m.DateOfSignature.FromTime(value)
+}
+
 func (m *MandateRelatedInformation8) SetAmendmentIndicator(value string) {
 	m.AmendmentIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (m *MandateRelatedInformation8) SetAmendmentIndicatorFromBool(value bool) {
+	m.AmendmentIndicator = new(TrueFalseIndicator)
+	m.AmendmentIndicator.FromBool(value)
+}
+
 func (m *MandateRelatedInformation8) AddAmendmentInformationDetails() *AmendmentInformationDetails8 {
 	m.AmendmentInformationDetails = new(AmendmentInformationDetails8)
 	return m.AmendmentInformationDetails
@@ -53,10 +71,24 @@ func (m *MandateRelatedInformation8) SetFirstCollectionDate(value string) {
 	m.FirstCollectionDate = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation8) SetFirstCollectionDateFromTime(value time.Time) {
+	m.FirstCollectionDate = new(ISODate)
+	m.FirstCollectionDate.FromTime(value)
+}
+
 func (m *MandateRelatedInformation8) SetFinalCollectionDate(value string) {
 	m.FinalCollectionDate = (*ISODate)(&value)
 }
 
-func (m *MandateRelatedInformation8) SetFrequency(value string) {
+func (m *MandateRelatedInformation8) SetFinalCollectionDateFromTime(value time.Time) {
+	m.FinalCollectionDate = new(ISODate)
+	m.FinalCollectionDate.FromTime(value)
+}
+
+func (m *MandateRelatedInformation8) SetFrequency(value string) error {
+	if err := Frequency6Code(value).Validate(); err != nil {
+		return err
+	}
 	m.Frequency = (*Frequency6Code)(&value)
+	return nil
 }
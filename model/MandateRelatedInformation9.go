@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details related to a direct debit mandate signed between the creditor and the debtor.
 type MandateRelatedInformation9 struct {
 
@@ -28,6 +32,10 @@ type MandateRelatedInformation9 struct {
 	Frequency *Frequency6Code `xml:"Frqcy,omitempty"`
 }
 
+func (m *MandateRelatedInformation9) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateRelatedInformation9) SetMandateIdentification(value string) {
 	m.MandateIdentification = (*Max35Text)(&value)
 }
@@ -36,10 +44,20 @@ func (m *MandateRelatedInformation9) SetDateOfSignature(value string) {
 	m.DateOfSignature = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation9) SetDateOfSignatureFromTime(value time.Time) {
+	m.DateOfSignature = new(ISODate)
+	m.DateOfSignature.FromTime(value)
+}
+
 func (m *MandateRelatedInformation9) SetAmendmentIndicator(value string) {
 	m.AmendmentIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (m *MandateRelatedInformation9) SetAmendmentIndicatorFromBool(value bool) {
+	m.AmendmentIndicator = new(TrueFalseIndicator)
+	m.AmendmentIndicator.FromBool(value)
+}
+
 func (m *MandateRelatedInformation9) AddAmendmentInformationDetails() *AmendmentInformationDetails9 {
 	m.AmendmentInformationDetails = new(AmendmentInformationDetails9)
 	return m.AmendmentInformationDetails
@@ -53,10 +71,24 @@ func (m *MandateRelatedInformation9) SetFirstCollectionDate(value string) {
 	m.FirstCollectionDate = (*ISODate)(&value)
 }
 
+func (m *MandateRelatedInformation9) SetFirstCollectionDateFromTime(value time.Time) {
+	m.FirstCollectionDate = new(ISODate)
+	m.FirstCollectionDate.FromTime(value)
+}
+
 func (m *MandateRelatedInformation9) SetFinalCollectionDate(value string) {
 	m.FinalCollectionDate = (*ISODate)(&value)
 }
 
-func (m *MandateRelatedInformation9) SetFrequency(value string) {
+func (m *MandateRelatedInformation9) SetFinalCollectionDateFromTime(value time.Time) {
+	m.FinalCollectionDate = new(ISODate)
+	m.FinalCollectionDate.FromTime(value)
+}
+
+func (m *MandateRelatedInformation9) SetFrequency(value string) error {
+	if err := Frequency6Code(value).Validate(); err != nil {
+		return err
+	}
 	m.Frequency = (*Frequency6Code)(&value)
+	return nil
 }
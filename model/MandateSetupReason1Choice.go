@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the reason for the setup of the mandate.
 type MandateSetupReason1Choice struct {
 
@@ -10,6 +12,10 @@ type MandateSetupReason1Choice struct {
 	Proprietary *Max70Text `xml:"Prtry"`
 }
 
+func (m *MandateSetupReason1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateSetupReason1Choice) SetCode(value string) {
 	m.Code = (*ExternalMandateSetupReason1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (m *MandateSetupReason1Choice) SetCode(value string) {
 func (m *MandateSetupReason1Choice) SetProprietary(value string) {
 	m.Proprietary = (*Max70Text)(&value)
 }
+
+func (m *MandateSetupReason1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if m.Code != nil {
+		enc.Element("Cd", string(*m.Code))
+	}
+	if m.Proprietary != nil {
+		enc.Element("Prtry", string(*m.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (m *MandateSetupReason1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			m.Code = (*ExternalMandateSetupReason1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			m.Proprietary = (*Max70Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
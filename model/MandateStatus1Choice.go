@@ -10,6 +10,10 @@ type MandateStatus1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (m *MandateStatus1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateStatus1Choice) SetCode(value string) {
 	m.Code = (*ExternalMandateStatus1Code)(&value)
 }
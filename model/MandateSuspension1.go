@@ -19,6 +19,10 @@ type MandateSuspension1 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MandateSuspension1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateSuspension1) SetSuspensionRequestIdentification(value string) {
 	m.SuspensionRequestIdentification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type MandateSuspensionReason1 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (m *MandateSuspensionReason1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateSuspensionReason1) AddOriginator() *PartyIdentification43 {
 	m.Originator = new(PartyIdentification43)
 	return m.Originator
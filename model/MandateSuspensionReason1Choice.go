@@ -10,6 +10,10 @@ type MandateSuspensionReason1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (m *MandateSuspensionReason1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateSuspensionReason1Choice) SetCode(value string) {
 	m.Code = (*ExternalMandateSuspensionReason1Code)(&value)
 }
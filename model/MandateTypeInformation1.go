@@ -11,6 +11,10 @@ type MandateTypeInformation1 struct {
 	LocalInstrument *LocalInstrument2Choice `xml:"LclInstrm,omitempty"`
 }
 
+func (m *MandateTypeInformation1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateTypeInformation1) AddServiceLevel() *ServiceLevel8Choice {
 	m.ServiceLevel = new(ServiceLevel8Choice)
 	return m.ServiceLevel
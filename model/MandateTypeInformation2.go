@@ -17,6 +17,10 @@ type MandateTypeInformation2 struct {
 	Classification *MandateClassification1Choice `xml:"Clssfctn,omitempty"`
 }
 
+func (m *MandateTypeInformation2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MandateTypeInformation2) AddServiceLevel() *ServiceLevel8Choice {
 	m.ServiceLevel = new(ServiceLevel8Choice)
 	return m.ServiceLevel
@@ -10,6 +10,10 @@ type Margin1 struct {
 	SegregatedIndependentAmountMargin *SegregatedIndependentAmountMargin1 `xml:"SgrtdIndpdntAmtMrgn,omitempty"`
 }
 
+func (m *Margin1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *Margin1) AddVariationMargin() *VariationMargin1 {
 	m.VariationMargin = new(VariationMargin1)
 	return m.VariationMargin
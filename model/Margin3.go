@@ -13,6 +13,10 @@ type Margin3 struct {
 	OtherMargin []*Margin4 `xml:"OthrMrgn,omitempty"`
 }
 
+func (m *Margin3) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *Margin3) AddInitialMargin() *Amount2 {
 	m.InitialMargin = new(Amount2)
 	return m.InitialMargin
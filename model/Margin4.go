@@ -13,6 +13,10 @@ type Margin4 struct {
 	CreditDebitIndicator *CreditDebitCode `xml:"CdtDbtInd,omitempty"`
 }
 
+func (m *Margin4) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *Margin4) AddType() *MarginType1Choice {
 	m.Type = new(MarginType1Choice)
 	return m.Type
@@ -23,6 +27,10 @@ func (m *Margin4) AddAmount() *Amount2 {
 	return m.Amount
 }
 
-func (m *Margin4) SetCreditDebitIndicator(value string) {
+func (m *Margin4) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	m.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the total margin amount, the collateral amount on deposit and the total minimum requirement that used to calculate the margin result, either an excess or a deficit.
 type MarginCalculation1 struct {
 
@@ -16,6 +20,10 @@ type MarginCalculation1 struct {
 	MarginResult *MarginResult1Choice `xml:"MrgnRslt,omitempty"`
 }
 
+func (m *MarginCalculation1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarginCalculation1) AddTotalMarginAmount() *AmountAndDirection20 {
 	m.TotalMarginAmount = new(AmountAndDirection20)
 	return m.TotalMarginAmount
@@ -171,6 +179,10 @@ func (m *MarginCalculation1) SetMinimumRequirementDeposit(value, currency string
 	m.MinimumRequirementDeposit = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MarginCalculation1) SetMinimumRequirementDepositFromDecimal(value decimal.Decimal, currency string) {
+	m.MinimumRequirementDeposit = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MarginCalculation1) AddMarginResult() *MarginResult1Choice {
 	m.MarginResult = new(MarginResult1Choice)
 	return m.MarginResult
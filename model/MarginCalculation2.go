@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the details on the margin calculation per financial instrument or per currency.
 type MarginCalculation2 struct {
 
@@ -25,6 +29,10 @@ type MarginCalculation2 struct {
 	MarginTypeAmount *Margin3 `xml:"MrgnTpAmt,omitempty"`
 }
 
+func (m *MarginCalculation2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarginCalculation2) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	m.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return m.FinancialInstrumentIdentification
@@ -190,6 +198,10 @@ func (m *MarginCalculation2) SetMinimumRequirementDeposit(value, currency string
 	m.MinimumRequirementDeposit = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MarginCalculation2) SetMinimumRequirementDepositFromDecimal(value decimal.Decimal, currency string) {
+	m.MinimumRequirementDeposit = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MarginCalculation2) AddMarginResult() *MarginResult1Choice {
 	m.MarginResult = new(MarginResult1Choice)
 	return m.MarginResult
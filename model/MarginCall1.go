@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the margin call request.
 type MarginCall1 struct {
 
@@ -27,14 +31,26 @@ type MarginCall1 struct {
 	CollateralBalance *CollateralBalance1Choice `xml:"CollBal,omitempty"`
 }
 
+func (m *MarginCall1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarginCall1) SetExposedAmountPartyA(value, currency string) {
 	m.ExposedAmountPartyA = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MarginCall1) SetExposedAmountPartyAFromDecimal(value decimal.Decimal, currency string) {
+	m.ExposedAmountPartyA = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MarginCall1) SetExposedAmountPartyB(value, currency string) {
 	m.ExposedAmountPartyB = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MarginCall1) SetExposedAmountPartyBFromDecimal(value decimal.Decimal, currency string) {
+	m.ExposedAmountPartyB = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MarginCall1) SetExposureConvention(value string) {
 	m.ExposureConvention = (*ExposureConventionType1Code)(&value)
 }
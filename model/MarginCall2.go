@@ -28,6 +28,10 @@ type MarginCall2 struct {
 	ExpectedCollateralDueToB *ExpectedCollateral2Choice `xml:"XpctdCollDueToB,omitempty"`
 }
 
+func (m *MarginCall2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarginCall2) AddCollateralAccountIdentification() *CollateralAccount2 {
 	m.CollateralAccountIdentification = new(CollateralAccount2)
 	return m.CollateralAccountIdentification
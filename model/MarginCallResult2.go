@@ -10,6 +10,10 @@ type MarginCallResult2 struct {
 	SegregatedIndependentAmount *Result1 `xml:"SgrtdIndpdntAmt,omitempty"`
 }
 
+func (m *MarginCallResult2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarginCallResult2) AddVariationMarginResult() *Result1 {
 	m.VariationMarginResult = new(Result1)
 	return m.VariationMarginResult
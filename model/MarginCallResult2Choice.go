@@ -13,6 +13,10 @@ type MarginCallResult2Choice struct {
 	SegregatedIndependentAmount *Result1 `xml:"SgrtdIndpdntAmt"`
 }
 
+func (m *MarginCallResult2Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarginCallResult2Choice) AddMarginCallResultDetails() *MarginCallResult2 {
 	m.MarginCallResultDetails = new(MarginCallResult2)
 	return m.MarginCallResultDetails
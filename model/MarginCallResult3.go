@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the summation of the call amounts per margin type and optionaly the default fund amount (only for CCP).
 type MarginCallResult3 struct {
 
@@ -10,10 +14,18 @@ type MarginCallResult3 struct {
 	MarginCallResult *MarginCallResult2Choice `xml:"MrgnCallRslt"`
 }
 
+func (m *MarginCallResult3) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarginCallResult3) SetDefaultFundAmount(value, currency string) {
 	m.DefaultFundAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MarginCallResult3) SetDefaultFundAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.DefaultFundAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MarginCallResult3) AddMarginCallResult() *MarginCallResult2Choice {
 	m.MarginCallResult = new(MarginCallResult2Choice)
 	return m.MarginCallResult
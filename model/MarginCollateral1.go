@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the collateral held, in transit or that still needs to be agreed by both parties.
 type MarginCollateral1 struct {
 
@@ -22,26 +26,54 @@ type MarginCollateral1 struct {
 	InTransitToPartyB *ActiveCurrencyAndAmount `xml:"InTrnstToPtyB,omitempty"`
 }
 
+func (m *MarginCollateral1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarginCollateral1) SetHeldByPartyA(value, currency string) {
 	m.HeldByPartyA = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MarginCollateral1) SetHeldByPartyAFromDecimal(value decimal.Decimal, currency string) {
+	m.HeldByPartyA = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MarginCollateral1) SetHeldByPartyB(value, currency string) {
 	m.HeldByPartyB = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MarginCollateral1) SetHeldByPartyBFromDecimal(value decimal.Decimal, currency string) {
+	m.HeldByPartyB = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MarginCollateral1) SetPriorAgreedToPartyA(value, currency string) {
 	m.PriorAgreedToPartyA = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MarginCollateral1) SetPriorAgreedToPartyAFromDecimal(value decimal.Decimal, currency string) {
+	m.PriorAgreedToPartyA = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MarginCollateral1) SetPriorAgreedToPartyB(value, currency string) {
 	m.PriorAgreedToPartyB = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MarginCollateral1) SetPriorAgreedToPartyBFromDecimal(value decimal.Decimal, currency string) {
+	m.PriorAgreedToPartyB = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MarginCollateral1) SetInTransitToPartyA(value, currency string) {
 	m.InTransitToPartyA = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MarginCollateral1) SetInTransitToPartyAFromDecimal(value decimal.Decimal, currency string) {
+	m.InTransitToPartyA = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MarginCollateral1) SetInTransitToPartyB(value, currency string) {
 	m.InTransitToPartyB = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (m *MarginCollateral1) SetInTransitToPartyBFromDecimal(value decimal.Decimal, currency string) {
+	m.InTransitToPartyB = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -10,6 +10,10 @@ type MarginProductType1Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (m *MarginProductType1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarginProductType1Choice) SetCode(value string) {
 	m.Code = (*MarginProduct1Code)(&value)
 }
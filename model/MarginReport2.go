@@ -22,6 +22,10 @@ type MarginReport2 struct {
 	MarginCalculation []*MarginCalculation2 `xml:"MrgnClctn"`
 }
 
+func (m *MarginReport2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarginReport2) AddMarginProduct() *MarginProductType1Choice {
 	newValue := new(MarginProductType1Choice)
 	m.MarginProduct = append(m.MarginProduct, newValue)
@@ -177,6 +181,11 @@ func (m *MarginReport2) SetCollateralisedMarginAccountIndicator(value string) {
 	m.CollateralisedMarginAccountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (m *MarginReport2) SetCollateralisedMarginAccountIndicatorFromBool(value bool) {
+	m.CollateralisedMarginAccountIndicator = new(YesNoIndicator)
+	m.CollateralisedMarginAccountIndicator.FromBool(value)
+}
+
 func (m *MarginReport2) AddNonClearingMember() *PartyIdentificationAndAccount31 {
 	newValue := new(PartyIdentificationAndAccount31)
 	m.NonClearingMember = append(m.NonClearingMember, newValue)
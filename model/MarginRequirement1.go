@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of expected margin required by any of the parties of the margin calculation.
 type MarginRequirement1 struct {
 
@@ -10,10 +14,22 @@ type MarginRequirement1 struct {
 	ReturnMarginAmount *ActiveCurrencyAndAmount `xml:"RtrMrgnAmt,omitempty"`
 }
 
+func (m *MarginRequirement1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarginRequirement1) SetDeliverMarginAmount(value, currency string) {
 	m.DeliverMarginAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MarginRequirement1) SetDeliverMarginAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.DeliverMarginAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MarginRequirement1) SetReturnMarginAmount(value, currency string) {
 	m.ReturnMarginAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (m *MarginRequirement1) SetReturnMarginAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.ReturnMarginAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
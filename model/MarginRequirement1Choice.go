@@ -10,6 +10,10 @@ type MarginRequirement1Choice struct {
 	SegregatedIndependentAmountRequirement *MarginRequirement1 `xml:"SgrtdIndpdntAmtRqrmnt"`
 }
 
+func (m *MarginRequirement1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarginRequirement1Choice) AddMarginRequirement() *Requirement1 {
 	m.MarginRequirement = new(Requirement1)
 	return m.MarginRequirement
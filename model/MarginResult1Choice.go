@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the margin result.
 type MarginResult1Choice struct {
 
@@ -10,10 +14,22 @@ type MarginResult1Choice struct {
 	DeficitAmount *ActiveCurrencyAndAmount `xml:"DfcitAmt"`
 }
 
+func (m *MarginResult1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarginResult1Choice) SetExcessAmount(value, currency string) {
 	m.ExcessAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MarginResult1Choice) SetExcessAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.ExcessAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MarginResult1Choice) SetDeficitAmount(value, currency string) {
 	m.DeficitAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (m *MarginResult1Choice) SetDeficitAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.DeficitAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -10,6 +10,10 @@ type MarginTerms1Choice struct {
 	SegregatedIndependentAmountMargin *SegregatedIndependentAmountMargin1 `xml:"SgrtdIndpdntAmtMrgn"`
 }
 
+func (m *MarginTerms1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarginTerms1Choice) AddMarginDetails() *Margin1 {
 	m.MarginDetails = new(Margin1)
 	return m.MarginDetails
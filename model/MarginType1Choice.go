@@ -10,6 +10,10 @@ type MarginType1Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (m *MarginType1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarginType1Choice) SetCode(value string) {
 	m.Code = (*MarginType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type MarketClientSide1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (m *MarketClientSide1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketClientSide1Choice) SetCode(value string) {
 	m.Code = (*MarketClientSideCode)(&value)
 }
@@ -10,6 +10,10 @@ type MarketClientSide3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (m *MarketClientSide3Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketClientSide3Choice) SetCode(value string) {
 	m.Code = (*MarketClientSideCode)(&value)
 }
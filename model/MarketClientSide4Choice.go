@@ -10,6 +10,10 @@ type MarketClientSide4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (m *MarketClientSide4Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketClientSide4Choice) SetCode(value string) {
 	m.Code = (*MarketClientSideCode)(&value)
 }
@@ -10,6 +10,10 @@ type MarketClientSide5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (m *MarketClientSide5Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketClientSide5Choice) SetCode(value string) {
 	m.Code = (*MarketClientSideCode)(&value)
 }
@@ -10,6 +10,10 @@ type MarketIdentification13 struct {
 	Type *MarketType12Choice `xml:"Tp,omitempty"`
 }
 
+func (m *MarketIdentification13) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification13) AddIdentification() *MarketIdentification3Choice {
 	m.Identification = new(MarketIdentification3Choice)
 	return m.Identification
@@ -10,6 +10,10 @@ type MarketIdentification1Choice struct {
 	Description *Max35Text `xml:"Desc"`
 }
 
+func (m *MarketIdentification1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification1Choice) SetMarketIdentifierCode(value string) {
 	m.MarketIdentifierCode = (*MICIdentifier)(&value)
 }
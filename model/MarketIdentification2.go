@@ -10,6 +10,10 @@ type MarketIdentification2 struct {
 	Identification *MarketIdentification1Choice `xml:"Id,omitempty"`
 }
 
+func (m *MarketIdentification2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification2) AddType() *MarketTypeFormat1Choice {
 	m.Type = new(MarketTypeFormat1Choice)
 	return m.Type
@@ -10,6 +10,10 @@ type MarketIdentification20 struct {
 	Type *MarketType8Choice `xml:"Tp"`
 }
 
+func (m *MarketIdentification20) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification20) AddIdentification() *MarketIdentification1Choice {
 	m.Identification = new(MarketIdentification1Choice)
 	return m.Identification
@@ -10,6 +10,10 @@ type MarketIdentification2Choice struct {
 	Description *RestrictedFINXMax30Text `xml:"Desc"`
 }
 
+func (m *MarketIdentification2Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification2Choice) SetMarketIdentifierCode(value string) {
 	m.MarketIdentifierCode = (*MICIdentifier)(&value)
 }
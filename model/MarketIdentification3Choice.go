@@ -10,6 +10,10 @@ type MarketIdentification3Choice struct {
 	Description *Max35Text `xml:"Desc"`
 }
 
+func (m *MarketIdentification3Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification3Choice) SetMarketIdentifierCode(value string) {
 	m.MarketIdentifierCode = (*MICIdentifier)(&value)
 }
@@ -10,6 +10,10 @@ type MarketIdentification4 struct {
 	Type *MarketType3Choice `xml:"Tp"`
 }
 
+func (m *MarketIdentification4) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification4) AddIdentification() *MarketIdentification1Choice {
 	m.Identification = new(MarketIdentification1Choice)
 	return m.Identification
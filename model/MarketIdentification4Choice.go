@@ -10,6 +10,10 @@ type MarketIdentification4Choice struct {
 	Description *RestrictedFINXMax30Text `xml:"Desc"`
 }
 
+func (m *MarketIdentification4Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification4Choice) SetMarketIdentifierCode(value string) {
 	m.MarketIdentifierCode = (*MICIdentifier)(&value)
 }
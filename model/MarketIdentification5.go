@@ -10,6 +10,10 @@ type MarketIdentification5 struct {
 	Type *MarketType2Choice `xml:"Tp"`
 }
 
+func (m *MarketIdentification5) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification5) AddIdentification() *MarketIdentification1Choice {
 	m.Identification = new(MarketIdentification1Choice)
 	return m.Identification
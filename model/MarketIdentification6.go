@@ -10,6 +10,10 @@ type MarketIdentification6 struct {
 	Type *MarketType4Choice `xml:"Tp"`
 }
 
+func (m *MarketIdentification6) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification6) AddIdentification() *MarketIdentification1Choice {
 	m.Identification = new(MarketIdentification1Choice)
 	return m.Identification
@@ -10,6 +10,10 @@ type MarketIdentification77 struct {
 	Type *MarketType11Choice `xml:"Tp,omitempty"`
 }
 
+func (m *MarketIdentification77) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification77) AddIdentification() *MarketIdentification3Choice {
 	m.Identification = new(MarketIdentification3Choice)
 	return m.Identification
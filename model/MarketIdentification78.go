@@ -10,6 +10,10 @@ type MarketIdentification78 struct {
 	Type *MarketType3Choice `xml:"Tp"`
 }
 
+func (m *MarketIdentification78) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification78) AddIdentification() *MarketIdentification1Choice {
 	m.Identification = new(MarketIdentification1Choice)
 	return m.Identification
@@ -10,6 +10,10 @@ type MarketIdentification79 struct {
 	Type *MarketType11Choice `xml:"Tp,omitempty"`
 }
 
+func (m *MarketIdentification79) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification79) AddIdentification() *MarketIdentification3Choice {
 	m.Identification = new(MarketIdentification3Choice)
 	return m.Identification
@@ -10,6 +10,10 @@ type MarketIdentification80 struct {
 	Type *MarketType12Choice `xml:"Tp,omitempty"`
 }
 
+func (m *MarketIdentification80) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification80) AddIdentification() *MarketIdentification3Choice {
 	m.Identification = new(MarketIdentification3Choice)
 	return m.Identification
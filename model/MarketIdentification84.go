@@ -10,6 +10,10 @@ type MarketIdentification84 struct {
 	Type *MarketType8Choice `xml:"Tp"`
 }
 
+func (m *MarketIdentification84) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification84) AddIdentification() *MarketIdentification1Choice {
 	m.Identification = new(MarketIdentification1Choice)
 	return m.Identification
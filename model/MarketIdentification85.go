@@ -10,6 +10,10 @@ type MarketIdentification85 struct {
 	Type *MarketType9Choice `xml:"Tp"`
 }
 
+func (m *MarketIdentification85) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification85) AddIdentification() *MarketIdentification1Choice {
 	m.Identification = new(MarketIdentification1Choice)
 	return m.Identification
@@ -13,6 +13,10 @@ type MarketIdentification87 struct {
 	SettlementPurpose *Purpose3Choice `xml:"SttlmPurp,omitempty"`
 }
 
+func (m *MarketIdentification87) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification87) SetCountry(value string) {
 	m.Country = (*CountryCode)(&value)
 }
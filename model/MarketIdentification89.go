@@ -10,6 +10,10 @@ type MarketIdentification89 struct {
 	Type *MarketType15Choice `xml:"Tp"`
 }
 
+func (m *MarketIdentification89) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification89) AddIdentification() *MarketIdentification1Choice {
 	m.Identification = new(MarketIdentification1Choice)
 	return m.Identification
@@ -10,6 +10,10 @@ type MarketIdentification90 struct {
 	Type *MarketType16Choice `xml:"Tp"`
 }
 
+func (m *MarketIdentification90) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification90) AddIdentification() *MarketIdentification2Choice {
 	m.Identification = new(MarketIdentification2Choice)
 	return m.Identification
@@ -10,6 +10,10 @@ type MarketIdentification91 struct {
 	Type *MarketType17Choice `xml:"Tp"`
 }
 
+func (m *MarketIdentification91) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentification91) AddIdentification() *MarketIdentification2Choice {
 	m.Identification = new(MarketIdentification2Choice)
 	return m.Identification
@@ -10,6 +10,10 @@ type MarketIdentificationOrCashPurpose1Choice struct {
 	CashSSIPurpose []*ExternalMarketArea1Code `xml:"CshSSIPurp"`
 }
 
+func (m *MarketIdentificationOrCashPurpose1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketIdentificationOrCashPurpose1Choice) AddSettlementInstructionMarketIdentification() *MarketIdentification87 {
 	m.SettlementInstructionMarketIdentification = new(MarketIdentification87)
 	return m.SettlementInstructionMarketIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Market maker profile.
 type MarketMakerProfile1 struct {
 
@@ -16,6 +20,10 @@ type MarketMakerProfile1 struct {
 	Discount *PercentageRate `xml:"Dscnt,omitempty"`
 }
 
+func (m *MarketMakerProfile1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketMakerProfile1) AddContractPeriod() *DateTimePeriodDetails1 {
 	m.ContractPeriod = new(DateTimePeriodDetails1)
 	return m.ContractPeriod
@@ -25,10 +33,25 @@ func (m *MarketMakerProfile1) SetCompliance(value string) {
 	m.Compliance = (*YesNoIndicator)(&value)
 }
 
+func (m *MarketMakerProfile1) SetComplianceFromBool(value bool) {
+	m.Compliance = new(YesNoIndicator)
+	m.Compliance.FromBool(value)
+}
+
 func (m *MarketMakerProfile1) SetMaximumSpread(value string) {
 	m.MaximumSpread = (*PercentageRate)(&value)
 }
 
+func (m *MarketMakerProfile1) SetMaximumSpreadFromDecimal(value decimal.Decimal) {
+	m.MaximumSpread = new(PercentageRate)
+	m.MaximumSpread.FromDecimal(value)
+}
+
 func (m *MarketMakerProfile1) SetDiscount(value string) {
 	m.Discount = (*PercentageRate)(&value)
 }
+
+func (m *MarketMakerProfile1) SetDiscountFromDecimal(value decimal.Decimal) {
+	m.Discount = new(PercentageRate)
+	m.Discount.FromDecimal(value)
+}
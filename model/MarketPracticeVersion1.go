@@ -13,6 +13,10 @@ type MarketPracticeVersion1 struct {
 	Number *Max35Text `xml:"Nb,omitempty"`
 }
 
+func (m *MarketPracticeVersion1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketPracticeVersion1) SetName(value string) {
 	m.Name = (*Max35Text)(&value)
 }
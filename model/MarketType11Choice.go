@@ -10,6 +10,10 @@ type MarketType11Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (m *MarketType11Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketType11Choice) SetCode(value string) {
 	m.Code = (*MarketType6Code)(&value)
 }
@@ -10,6 +10,10 @@ type MarketType12Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (m *MarketType12Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketType12Choice) SetCode(value string) {
 	m.Code = (*MarketType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type MarketType15Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (m *MarketType15Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketType15Choice) SetCode(value string) {
 	m.Code = (*MarketType4Code)(&value)
 }
@@ -10,6 +10,10 @@ type MarketType16Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (m *MarketType16Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketType16Choice) SetCode(value string) {
 	m.Code = (*MarketType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type MarketType17Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (m *MarketType17Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketType17Choice) SetCode(value string) {
 	m.Code = (*MarketType4Code)(&value)
 }
@@ -10,6 +10,10 @@ type MarketType2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (m *MarketType2Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketType2Choice) SetCode(value string) {
 	m.Code = (*MarketType5Code)(&value)
 }
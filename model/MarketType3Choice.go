@@ -10,6 +10,10 @@ type MarketType3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (m *MarketType3Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketType3Choice) SetCode(value string) {
 	m.Code = (*MarketType2Code)(&value)
 }
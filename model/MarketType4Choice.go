@@ -10,6 +10,10 @@ type MarketType4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (m *MarketType4Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketType4Choice) SetCode(value string) {
 	m.Code = (*MarketType4Code)(&value)
 }
@@ -10,6 +10,10 @@ type MarketType8Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (m *MarketType8Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketType8Choice) SetCode(value string) {
 	m.Code = (*MarketType2Code)(&value)
 }
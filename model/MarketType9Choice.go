@@ -10,6 +10,10 @@ type MarketType9Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (m *MarketType9Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketType9Choice) SetCode(value string) {
 	m.Code = (*MarketType5Code)(&value)
 }
@@ -10,6 +10,10 @@ type MarketTypeFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (m *MarketTypeFormat1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MarketTypeFormat1Choice) SetCode(value string) {
 	m.Code = (*MarketType3Code)(&value)
 }
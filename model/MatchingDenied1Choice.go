@@ -10,6 +10,10 @@ type MatchingDenied1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (m *MatchingDenied1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingDenied1Choice) SetCode(value string) {
 	m.Code = (*MatchingProcess1Code)(&value)
 }
@@ -10,6 +10,10 @@ type MatchingDenied3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (m *MatchingDenied3Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingDenied3Choice) SetCode(value string) {
 	m.Code = (*MatchingProcess1Code)(&value)
 }
@@ -10,6 +10,10 @@ type MatchingDenied4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (m *MatchingDenied4Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingDenied4Choice) SetCode(value string) {
 	m.Code = (*MatchingProcess1Code)(&value)
 }
@@ -10,6 +10,10 @@ type MatchingReason4Choice struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (m *MatchingReason4Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingReason4Choice) AddReason() *AllegementMatchingReason1 {
 	newValue := new(AllegementMatchingReason1)
 	m.Reason = append(m.Reason, newValue)
@@ -156,6 +160,10 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 		XchgRate:               doc.XchgRate.BaseOneRate,                                  // "<XchgRate>"
 	}
 
-func (m *MatchingReason4Choice) SetNoSpecifiedReason(value string) {
+func (m *MatchingReason4Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	m.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
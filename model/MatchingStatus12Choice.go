@@ -13,6 +13,10 @@ type MatchingStatus12Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus12Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus12Choice) AddMatched() *ProprietaryReason1 {
 	m.Matched = new(ProprietaryReason1)
 	return m.Matched
@@ -13,6 +13,10 @@ type MatchingStatus19Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus19Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus19Choice) AddMatched() *ProprietaryReason1 {
 	m.Matched = new(ProprietaryReason1)
 	return m.Matched
@@ -10,6 +10,10 @@ type MatchingStatus1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus1Choice) SetCode(value string) {
 	m.Code = (*MatchingStatus1Code)(&value)
 }
@@ -13,6 +13,10 @@ type MatchingStatus20Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus20Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus20Choice) AddMatched() *ProprietaryReason1 {
 	m.Matched = new(ProprietaryReason1)
 	return m.Matched
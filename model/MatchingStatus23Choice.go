@@ -19,6 +19,10 @@ type MatchingStatus23Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts,omitempty"`
 }
 
+func (m *MatchingStatus23Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus23Choice) AddMatched() *ProprietaryReason1 {
 	m.Matched = new(ProprietaryReason1)
 	return m.Matched
@@ -13,6 +13,10 @@ type MatchingStatus24Choice struct {
 	Proprietary *ProprietaryStatusAndReason6 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus24Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus24Choice) AddMatched() *ProprietaryReason4 {
 	m.Matched = new(ProprietaryReason4)
 	return m.Matched
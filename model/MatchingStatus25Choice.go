@@ -13,6 +13,10 @@ type MatchingStatus25Choice struct {
 	Proprietary *ProprietaryStatusAndReason6 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus25Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus25Choice) AddMatched() *ProprietaryReason4 {
 	m.Matched = new(ProprietaryReason4)
 	return m.Matched
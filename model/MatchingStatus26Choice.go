@@ -13,6 +13,10 @@ type MatchingStatus26Choice struct {
 	Proprietary *ProprietaryStatusAndReason6 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus26Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus26Choice) AddMatched() *ProprietaryReason4 {
 	m.Matched = new(ProprietaryReason4)
 	return m.Matched
@@ -10,6 +10,10 @@ type MatchingStatus27Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus27Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus27Choice) SetCode(value string) {
 	m.Code = (*MatchingStatus1Code)(&value)
 }
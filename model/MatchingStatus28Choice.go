@@ -10,6 +10,10 @@ type MatchingStatus28Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus28Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus28Choice) SetCode(value string) {
 	m.Code = (*MatchingStatus1Code)(&value)
 }
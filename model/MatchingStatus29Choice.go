@@ -13,6 +13,10 @@ type MatchingStatus29Choice struct {
 	Proprietary *ProprietaryStatusAndReason7 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus29Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus29Choice) AddMatched() *ProprietaryReason5 {
 	m.Matched = new(ProprietaryReason5)
 	return m.Matched
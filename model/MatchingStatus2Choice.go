@@ -13,6 +13,10 @@ type MatchingStatus2Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus2Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus2Choice) AddMatched() *NoSpecifiedReason1 {
 	m.Matched = new(NoSpecifiedReason1)
 	return m.Matched
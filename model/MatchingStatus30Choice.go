@@ -13,6 +13,10 @@ type MatchingStatus30Choice struct {
 	Proprietary *ProprietaryStatusAndReason7 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus30Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus30Choice) AddMatched() *ProprietaryReason5 {
 	m.Matched = new(ProprietaryReason5)
 	return m.Matched
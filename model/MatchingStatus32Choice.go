@@ -13,6 +13,10 @@ type MatchingStatus32Choice struct {
 	Proprietary *ProprietaryStatusAndReason7 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus32Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus32Choice) AddMatched() *ProprietaryReason5 {
 	m.Matched = new(ProprietaryReason5)
 	return m.Matched
@@ -13,6 +13,10 @@ type MatchingStatus3Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus3Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus3Choice) AddMatched() *NoSpecifiedReason1 {
 	m.Matched = new(NoSpecifiedReason1)
 	return m.Matched
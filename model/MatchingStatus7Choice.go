@@ -13,6 +13,10 @@ type MatchingStatus7Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus7Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus7Choice) AddMatched() *ProprietaryReason1 {
 	m.Matched = new(ProprietaryReason1)
 	return m.Matched
@@ -10,6 +10,10 @@ type MatchingStatus8Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (m *MatchingStatus8Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus8Choice) SetCode(value string) {
 	m.Code = (*MatchingStatus1Code)(&value)
 }
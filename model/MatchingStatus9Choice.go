@@ -19,6 +19,10 @@ type MatchingStatus9Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts,omitempty"`
 }
 
+func (m *MatchingStatus9Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingStatus9Choice) AddMatched() *ProprietaryReason1 {
 	m.Matched = new(ProprietaryReason1)
 	return m.Matched
@@ -10,6 +10,10 @@ type MatchingSystemReference1Choice struct {
 	RelatedReference *Max35Text `xml:"RltdRef"`
 }
 
+func (m *MatchingSystemReference1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MatchingSystemReference1Choice) SetMatchingSystemUniqueReference(value string) {
 	m.MatchingSystemUniqueReference = (*Max35Text)(&value)
 }
@@ -1,3 +1,7 @@
 package model
 
 type Max10000Binary string
+
+func (m Max10000Binary) Validate() error {
+	return validateBinary("Max10000Binary", string(m), 1, 10000)
+}
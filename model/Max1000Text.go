@@ -1,3 +1,7 @@
 package model
 
 type Max1000Text string
+
+func (m Max1000Text) Validate() error {
+	return validateLength("Max1000Text", string(m), 1, 1000)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max100KBinary string
+
+func (m Max100KBinary) Validate() error {
+	return validateBinary("Max100KBinary", string(m), 1, 102400)
+}
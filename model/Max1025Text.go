@@ -1,3 +1,7 @@
 package model
 
 type Max1025Text string
+
+func (m Max1025Text) Validate() error {
+	return validateLength("Max1025Text", string(m), 1, 1025)
+}
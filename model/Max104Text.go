@@ -1,3 +1,7 @@
 package model
 
 type Max104Text string
+
+func (m Max104Text) Validate() error {
+	return validateLength("Max104Text", string(m), 1, 104)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max105Text string
+
+func (m Max105Text) Validate() error {
+	return validateLength("Max105Text", string(m), 1, 105)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max10KBinary string
+
+func (m Max10KBinary) Validate() error {
+	return validateBinary("Max10KBinary", string(m), 1, 10240)
+}
@@ -1,3 +1,9 @@
 package model
 
 type Max10NumericText string
+
+var max10NumericTextPattern = newPattern(`[0-9]{1,10}`)
+
+func (m Max10NumericText) Validate() error {
+	return validatePattern("Max10NumericText", string(m), max10NumericTextPattern)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max10Text string
+
+func (m Max10Text) Validate() error {
+	return validateLength("Max10Text", string(m), 1, 10)
+}
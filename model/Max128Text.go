@@ -1,3 +1,7 @@
 package model
 
 type Max128Text string
+
+func (m Max128Text) Validate() error {
+	return validateLength("Max128Text", string(m), 1, 128)
+}
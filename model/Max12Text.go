@@ -1,3 +1,7 @@
 package model
 
 type Max12Text string
+
+func (m Max12Text) Validate() error {
+	return validateLength("Max12Text", string(m), 1, 12)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max140Binary string
+
+func (m Max140Binary) Validate() error {
+	return validateBinary("Max140Binary", string(m), 1, 140)
+}
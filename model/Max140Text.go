@@ -1,3 +1,7 @@
 package model
 
 type Max140Text string
+
+func (m Max140Text) Validate() error {
+	return validateLength("Max140Text", string(m), 1, 140)
+}
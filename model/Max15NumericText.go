@@ -1,3 +1,9 @@
 package model
 
 type Max15NumericText string
+
+var max15NumericTextPattern = newPattern(`[0-9]{1,15}`)
+
+func (m Max15NumericText) Validate() error {
+	return validatePattern("Max15NumericText", string(m), max15NumericTextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type Max15PlusSignedNumericText string
+
+var max15PlusSignedNumericTextPattern = newPattern(`[\+]{0,1}[0-9]{1,15}`)
+
+func (m Max15PlusSignedNumericText) Validate() error {
+	return validatePattern("Max15PlusSignedNumericText", string(m), max15PlusSignedNumericTextPattern)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max16Text string
+
+func (m Max16Text) Validate() error {
+	return validateLength("Max16Text", string(m), 1, 16)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max20000Text string
+
+func (m Max20000Text) Validate() error {
+	return validateLength("Max20000Text", string(m), 1, 20000)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max2000Text string
+
+func (m Max2000Text) Validate() error {
+	return validateLength("Max2000Text", string(m), 1, 2000)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max2048Text string
+
+func (m Max2048Text) Validate() error {
+	return validateLength("Max2048Text", string(m), 1, 2048)
+}
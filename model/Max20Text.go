@@ -1,3 +1,7 @@
 package model
 
 type Max20Text string
+
+func (m Max20Text) Validate() error {
+	return validateLength("Max20Text", string(m), 1, 20)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max210Text string
+
+func (m Max210Text) Validate() error {
+	return validateLength("Max210Text", string(m), 1, 210)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max256Text string
+
+func (m Max256Text) Validate() error {
+	return validateLength("Max256Text", string(m), 1, 256)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max25Text string
+
+func (m Max25Text) Validate() error {
+	return validateLength("Max25Text", string(m), 1, 25)
+}
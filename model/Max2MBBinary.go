@@ -1,3 +1,7 @@
 package model
 
 type Max2MBBinary string
+
+func (m Max2MBBinary) Validate() error {
+	return validateBinary("Max2MBBinary", string(m), 1, 2097152)
+}
@@ -1,3 +1,9 @@
 package model
 
 type Max2NumericText string
+
+var max2NumericTextPattern = newPattern(`[0-9]{1,2}`)
+
+func (m Max2NumericText) Validate() error {
+	return validatePattern("Max2NumericText", string(m), max2NumericTextPattern)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max3000Binary string
+
+func (m Max3000Binary) Validate() error {
+	return validateBinary("Max3000Binary", string(m), 1, 3000)
+}
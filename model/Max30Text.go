@@ -1,3 +1,7 @@
 package model
 
 type Max30Text string
+
+func (m Max30Text) Validate() error {
+	return validateLength("Max30Text", string(m), 1, 30)
+}
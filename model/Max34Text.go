@@ -1,3 +1,7 @@
 package model
 
 type Max34Text string
+
+func (m Max34Text) Validate() error {
+	return validateLength("Max34Text", string(m), 1, 34)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max350Text string
+
+func (m Max350Text) Validate() error {
+	return validateLength("Max350Text", string(m), 1, 350)
+}
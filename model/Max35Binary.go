@@ -1,3 +1,7 @@
 package model
 
 type Max35Binary string
+
+func (m Max35Binary) Validate() error {
+	return validateBinary("Max35Binary", string(m), 1, 35)
+}
@@ -1,3 +1,9 @@
 package model
 
 type Max35NumericText string
+
+var max35NumericTextPattern = newPattern(`[0-9]{1,35}`)
+
+func (m Max35NumericText) Validate() error {
+	return validatePattern("Max35NumericText", string(m), max35NumericTextPattern)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max35Text string
+
+func (m Max35Text) Validate() error {
+	return validateLength("Max35Text", string(m), 1, 35)
+}
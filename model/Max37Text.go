@@ -1,3 +1,7 @@
 package model
 
 type Max37Text string
+
+func (m Max37Text) Validate() error {
+	return validateLength("Max37Text", string(m), 1, 37)
+}
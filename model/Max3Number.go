@@ -1,3 +1,19 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 type Max3Number string
+
+func (m Max3Number) Validate() error {
+	return validateDecimal("Max3Number", string(m), 3, 0)
+}
+
+func (m Max3Number) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(string(m))
+}
+
+func (m *Max3Number) FromDecimal(value decimal.Decimal) {
+	*m = Max3Number(value.String())
+}
@@ -1,3 +1,9 @@
 package model
 
 type Max3NumericText string
+
+var max3NumericTextPattern = newPattern(`[0-9]{1,3}`)
+
+func (m Max3NumericText) Validate() error {
+	return validatePattern("Max3NumericText", string(m), max3NumericTextPattern)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max3Text string
+
+func (m Max3Text) Validate() error {
+	return validateLength("Max3Text", string(m), 1, 3)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max40Text string
+
+func (m Max40Text) Validate() error {
+	return validateLength("Max40Text", string(m), 1, 40)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max45Text string
+
+func (m Max45Text) Validate() error {
+	return validateLength("Max45Text", string(m), 1, 45)
+}
@@ -1,3 +1,9 @@
 package model
 
 type Max4AlphaNumericText string
+
+var max4AlphaNumericTextPattern = newPattern(`[a-zA-Z0-9]{1,4}`)
+
+func (m Max4AlphaNumericText) Validate() error {
+	return validatePattern("Max4AlphaNumericText", string(m), max4AlphaNumericTextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type Max4NumericText string
+
+var max4NumericTextPattern = newPattern(`[0-9]{1,4}`)
+
+func (m Max4NumericText) Validate() error {
+	return validatePattern("Max4NumericText", string(m), max4NumericTextPattern)
+}
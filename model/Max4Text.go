@@ -1,3 +1,7 @@
 package model
 
 type Max4Text string
+
+func (m Max4Text) Validate() error {
+	return validateLength("Max4Text", string(m), 1, 4)
+}
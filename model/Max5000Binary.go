@@ -1,3 +1,7 @@
 package model
 
 type Max5000Binary string
+
+func (m Max5000Binary) Validate() error {
+	return validateBinary("Max5000Binary", string(m), 1, 5000)
+}
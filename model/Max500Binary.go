@@ -1,3 +1,7 @@
 package model
 
 type Max500Binary string
+
+func (m Max500Binary) Validate() error {
+	return validateBinary("Max500Binary", string(m), 1, 500)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max500Text string
+
+func (m Max500Text) Validate() error {
+	return validateLength("Max500Text", string(m), 1, 500)
+}
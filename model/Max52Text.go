@@ -1,3 +1,7 @@
 package model
 
 type Max52Text string
+
+func (m Max52Text) Validate() error {
+	return validateLength("Max52Text", string(m), 1, 52)
+}
@@ -1,3 +1,9 @@
 package model
 
 type Max5NumericText string
+
+var max5NumericTextPattern = newPattern(`[0-9]{1,5}`)
+
+func (m Max5NumericText) Validate() error {
+	return validatePattern("Max5NumericText", string(m), max5NumericTextPattern)
+}
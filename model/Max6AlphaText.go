@@ -1,3 +1,9 @@
 package model
 
 type Max6AlphaText string
+
+var max6AlphaTextPattern = newPattern(`[a-zA-Z]{1,6}`)
+
+func (m Max6AlphaText) Validate() error {
+	return validatePattern("Max6AlphaText", string(m), max6AlphaTextPattern)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max6Text string
+
+func (m Max6Text) Validate() error {
+	return validateLength("Max6Text", string(m), 1, 6)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max70Text string
+
+func (m Max70Text) Validate() error {
+	return validateLength("Max70Text", string(m), 1, 70)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max76Text string
+
+func (m Max76Text) Validate() error {
+	return validateLength("Max76Text", string(m), 1, 76)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max8000Text string
+
+func (m Max8000Text) Validate() error {
+	return validateLength("Max8000Text", string(m), 1, 8000)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Max8Text string
+
+func (m Max8Text) Validate() error {
+	return validateLength("Max8Text", string(m), 1, 8)
+}
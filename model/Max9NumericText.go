@@ -1,3 +1,9 @@
 package model
 
 type Max9NumericText string
+
+var max9NumericTextPattern = newPattern(`[0-9]{1,9}`)
+
+func (m Max9NumericText) Validate() error {
+	return validatePattern("Max9NumericText", string(m), max9NumericTextPattern)
+}
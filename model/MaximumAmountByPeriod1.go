@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Maximum amount allowed over a specific period of time.
 type MaximumAmountByPeriod1 struct {
 
@@ -10,10 +14,18 @@ type MaximumAmountByPeriod1 struct {
 	NumberOfDays *Max3NumericText `xml:"NbOfDays"`
 }
 
+func (m *MaximumAmountByPeriod1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MaximumAmountByPeriod1) SetMaximumAmount(value, currency string) {
 	m.MaximumAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MaximumAmountByPeriod1) SetMaximumAmountFromDecimal(value decimal.Decimal, currency string) {
+	m.MaximumAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MaximumAmountByPeriod1) SetNumberOfDays(value string) {
 	m.NumberOfDays = (*Max3NumericText)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the physical parameters of a shareholders meeting. Several dates and places can be defined for a meeting
 type Meeting2 struct {
 
@@ -22,6 +26,10 @@ type Meeting2 struct {
 	QuorumQuantityPercentage *PercentageRate `xml:"QrmQtyPctg,omitempty"`
 }
 
+func (m *Meeting2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *Meeting2) AddDateAndTime() *DateFormat2Choice {
 	m.DateAndTime = new(DateFormat2Choice)
 	return m.DateAndTime
@@ -35,6 +43,11 @@ func (m *Meeting2) SetQuorumRequired(value string) {
 	m.QuorumRequired = (*YesNoIndicator)(&value)
 }
 
+func (m *Meeting2) SetQuorumRequiredFromBool(value bool) {
+	m.QuorumRequired = new(YesNoIndicator)
+	m.QuorumRequired.FromBool(value)
+}
+
 func (m *Meeting2) AddLocation() *LocationFormat1Choice {
 	newValue := new(LocationFormat1Choice)
 	m.Location = append(m.Location, newValue)
@@ -188,3 +201,8 @@ func (m *Meeting2) SetQuorumQuantity(value string) {
 func (m *Meeting2) SetQuorumQuantityPercentage(value string) {
 	m.QuorumQuantityPercentage = (*PercentageRate)(&value)
 }
+
+func (m *Meeting2) SetQuorumQuantityPercentageFromDecimal(value decimal.Decimal) {
+	m.QuorumQuantityPercentage = new(PercentageRate)
+	m.QuorumQuantityPercentage.FromDecimal(value)
+}
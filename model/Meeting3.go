@@ -19,6 +19,10 @@ type Meeting3 struct {
 	QuorumQuantity *QuorumQuantity1Choice `xml:"QrmQty,omitempty"`
 }
 
+func (m *Meeting3) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *Meeting3) AddDateAndTime() *DateFormat2Choice {
 	m.DateAndTime = new(DateFormat2Choice)
 	return m.DateAndTime
@@ -32,6 +36,11 @@ func (m *Meeting3) SetQuorumRequired(value string) {
 	m.QuorumRequired = (*YesNoIndicator)(&value)
 }
 
+func (m *Meeting3) SetQuorumRequiredFromBool(value bool) {
+	m.QuorumRequired = new(YesNoIndicator)
+	m.QuorumRequired.FromBool(value)
+}
+
 func (m *Meeting3) AddLocation() *LocationFormat1Choice {
 	newValue := new(LocationFormat1Choice)
 	m.Location = append(m.Location, newValue)
@@ -19,6 +19,10 @@ type Meeting4 struct {
 	QuorumQuantity *QuorumQuantity1Choice `xml:"QrmQty,omitempty"`
 }
 
+func (m *Meeting4) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *Meeting4) AddDateAndTime() *DateFormat29Choice {
 	m.DateAndTime = new(DateFormat29Choice)
 	return m.DateAndTime
@@ -32,6 +36,11 @@ func (m *Meeting4) SetQuorumRequired(value string) {
 	m.QuorumRequired = (*YesNoIndicator)(&value)
 }
 
+func (m *Meeting4) SetQuorumRequiredFromBool(value bool) {
+	m.QuorumRequired = new(YesNoIndicator)
+	m.QuorumRequired.FromBool(value)
+}
+
 func (m *Meeting4) AddLocation() *LocationFormat1Choice {
 	newValue := new(LocationFormat1Choice)
 	m.Location = append(m.Location, newValue)
@@ -13,6 +13,10 @@ type MeetingCancellationReason1 struct {
 	CancellationReason *Max140Text `xml:"CxlRsn,omitempty"`
 }
 
+func (m *MeetingCancellationReason1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MeetingCancellationReason1) SetCode(value string) {
 	m.Code = (*MeetingCancellationReason2Code)(&value)
 }
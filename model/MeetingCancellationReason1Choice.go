@@ -10,6 +10,10 @@ type MeetingCancellationReason1Choice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (m *MeetingCancellationReason1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MeetingCancellationReason1Choice) SetCode(value string) {
 	m.Code = (*MeetingCancellationReason2Code)(&value)
 }
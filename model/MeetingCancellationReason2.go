@@ -10,6 +10,10 @@ type MeetingCancellationReason2 struct {
 	CancellationReason *Max140Text `xml:"CxlRsn,omitempty"`
 }
 
+func (m *MeetingCancellationReason2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MeetingCancellationReason2) AddCancellationReasonCode() *MeetingCancellationReason1Choice {
 	m.CancellationReasonCode = new(MeetingCancellationReason1Choice)
 	return m.CancellationReasonCode
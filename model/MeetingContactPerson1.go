@@ -13,6 +13,10 @@ type MeetingContactPerson1 struct {
 	PlaceOfListing *MICIdentifier `xml:"PlcOfListg,omitempty"`
 }
 
+func (m *MeetingContactPerson1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MeetingContactPerson1) AddContactPerson() *ContactIdentification1 {
 	m.ContactPerson = new(ContactIdentification1)
 	return m.ContactPerson
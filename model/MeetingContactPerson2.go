@@ -13,6 +13,10 @@ type MeetingContactPerson2 struct {
 	PlaceOfListing *MICIdentifier `xml:"PlcOfListg,omitempty"`
 }
 
+func (m *MeetingContactPerson2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MeetingContactPerson2) AddContactPerson() *ContactIdentification1 {
 	m.ContactPerson = new(ContactIdentification1)
 	return m.ContactPerson
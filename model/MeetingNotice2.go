@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about the shareholders meeting, specifying the participation requirements and the voting procedures. Alternatively, it may indicate where such information may be obtained.
 type MeetingNotice2 struct {
 
@@ -73,6 +79,10 @@ type MeetingNotice2 struct {
 	ResultPublicationDate *DateFormat3Choice `xml:"RsltPblctnDt,omitempty"`
 }
 
+func (m *MeetingNotice2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MeetingNotice2) SetMeetingIdentification(value string) {
 	m.MeetingIdentification = (*Max35Text)(&value)
 }
@@ -97,10 +107,20 @@ func (m *MeetingNotice2) SetAnnouncementDate(value string) {
 	m.AnnouncementDate = (*ISODate)(&value)
 }
 
+func (m *MeetingNotice2) SetAnnouncementDateFromTime(value time.Time) {
+	m.AnnouncementDate = new(ISODate)
+	m.AnnouncementDate.FromTime(value)
+}
+
 func (m *MeetingNotice2) SetAttendanceRequired(value string) {
 	m.AttendanceRequired = (*YesNoIndicator)(&value)
 }
 
+func (m *MeetingNotice2) SetAttendanceRequiredFromBool(value bool) {
+	m.AttendanceRequired = new(YesNoIndicator)
+	m.AttendanceRequired.FromBool(value)
+}
+
 func (m *MeetingNotice2) SetAttendanceConfirmationInformation(value string) {
 	m.AttendanceConfirmationInformation = (*Max350Text)(&value)
 }
@@ -142,14 +162,28 @@ func (m *MeetingNotice2) SetResolutionProposalThresholdPercentage(value string)
 	m.ResolutionProposalThresholdPercentage = (*PercentageRate)(&value)
 }
 
+func (m *MeetingNotice2) SetResolutionProposalThresholdPercentageFromDecimal(value decimal.Decimal) {
+	m.ResolutionProposalThresholdPercentage = new(PercentageRate)
+	m.ResolutionProposalThresholdPercentage.FromDecimal(value)
+}
+
 func (m *MeetingNotice2) SetTotalNumberOfSecuritiesOutstanding(value, currency string) {
 	m.TotalNumberOfSecuritiesOutstanding = NewCurrencyAndAmount(value, currency)
 }
 
+func (m *MeetingNotice2) SetTotalNumberOfSecuritiesOutstandingFromDecimal(value decimal.Decimal, currency string) {
+	m.TotalNumberOfSecuritiesOutstanding = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MeetingNotice2) SetTotalNumberOfVotingRights(value string) {
 	m.TotalNumberOfVotingRights = (*Number)(&value)
 }
 
+func (m *MeetingNotice2) SetTotalNumberOfVotingRightsFromDecimal(value decimal.Decimal) {
+	m.TotalNumberOfVotingRights = new(Number)
+	m.TotalNumberOfVotingRights.FromDecimal(value)
+}
+
 func (m *MeetingNotice2) AddProxyAppointmentNotificationAddress() *PostalAddress1 {
 	m.ProxyAppointmentNotificationAddress = new(PostalAddress1)
 	return m.ProxyAppointmentNotificationAddress
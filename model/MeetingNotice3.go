@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about the shareholders meeting, specifying the participation requirements and the voting procedures. Alternatively, it may indicate where such information may be obtained.
 type MeetingNotice3 struct {
 
@@ -58,6 +64,10 @@ type MeetingNotice3 struct {
 	ResultPublicationDate *DateFormat3Choice `xml:"RsltPblctnDt,omitempty"`
 }
 
+func (m *MeetingNotice3) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MeetingNotice3) SetMeetingIdentification(value string) {
 	m.MeetingIdentification = (*Max35Text)(&value)
 }
@@ -79,10 +89,20 @@ func (m *MeetingNotice3) SetAnnouncementDate(value string) {
 	m.AnnouncementDate = (*ISODate)(&value)
 }
 
+func (m *MeetingNotice3) SetAnnouncementDateFromTime(value time.Time) {
+	m.AnnouncementDate = new(ISODate)
+	m.AnnouncementDate.FromTime(value)
+}
+
 func (m *MeetingNotice3) SetAttendanceRequired(value string) {
 	m.AttendanceRequired = (*YesNoIndicator)(&value)
 }
 
+func (m *MeetingNotice3) SetAttendanceRequiredFromBool(value bool) {
+	m.AttendanceRequired = new(YesNoIndicator)
+	m.AttendanceRequired.FromBool(value)
+}
+
 func (m *MeetingNotice3) SetAttendanceConfirmationInformation(value string) {
 	m.AttendanceConfirmationInformation = (*Max350Text)(&value)
 }
@@ -256,10 +276,19 @@ func (m *MeetingNotice3) SetTotalNumberOfSecuritiesOutstanding(value, currency s
 	m.TotalNumberOfSecuritiesOutstanding = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (m *MeetingNotice3) SetTotalNumberOfSecuritiesOutstandingFromDecimal(value decimal.Decimal, currency string) {
+	m.TotalNumberOfSecuritiesOutstanding = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (m *MeetingNotice3) SetTotalNumberOfVotingRights(value string) {
 	m.TotalNumberOfVotingRights = (*Number)(&value)
 }
 
+func (m *MeetingNotice3) SetTotalNumberOfVotingRightsFromDecimal(value decimal.Decimal) {
+	m.TotalNumberOfVotingRights = new(Number)
+	m.TotalNumberOfVotingRights.FromDecimal(value)
+}
+
 func (m *MeetingNotice3) AddProxyAppointmentNotificationAddress() *PostalAddress1 {
 	m.ProxyAppointmentNotificationAddress = new(PostalAddress1)
 	return m.ProxyAppointmentNotificationAddress
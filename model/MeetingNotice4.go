@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about the shareholders meeting, specifying the participation requirements and the voting procedures. Alternatively, it may indicate where such information may be obtained.
 type MeetingNotice4 struct {
 
@@ -58,6 +64,10 @@ type MeetingNotice4 struct {
 	ResultPublicationDate *DateFormat3Choice `xml:"RsltPblctnDt,omitempty"`
 }
 
+func (m *MeetingNotice4) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MeetingNotice4) SetMeetingIdentification(value string) {
 	m.MeetingIdentification = (*Max35Text)(&value)
 }
@@ -79,10 +89,20 @@ func (m *MeetingNotice4) SetAnnouncementDate(value string) {
 	m.AnnouncementDate = (*ISODate)(&value)
 }
 
+func (m *MeetingNotice4) SetAnnouncementDateFromTime(value time.Time) {
+	m.AnnouncementDate = new(ISODate)
+	m.AnnouncementDate.FromTime(value)
+}
+
 func (m *MeetingNotice4) SetAttendanceRequired(value string) {
 	m.AttendanceRequired = (*YesNoIndicator)(&value)
 }
 
+func (m *MeetingNotice4) SetAttendanceRequiredFromBool(value bool) {
+	m.AttendanceRequired = new(YesNoIndicator)
+	m.AttendanceRequired.FromBool(value)
+}
+
 func (m *MeetingNotice4) SetAttendanceConfirmationInformation(value string) {
 	m.AttendanceConfirmationInformation = (*Max350Text)(&value)
 }
@@ -261,6 +281,11 @@ func (m *MeetingNotice4) SetTotalNumberOfVotingRights(value string) {
 	m.TotalNumberOfVotingRights = (*Number)(&value)
 }
 
+func (m *MeetingNotice4) SetTotalNumberOfVotingRightsFromDecimal(value decimal.Decimal) {
+	m.TotalNumberOfVotingRights = new(Number)
+	m.TotalNumberOfVotingRights.FromDecimal(value)
+}
+
 func (m *MeetingNotice4) AddProxyAppointmentNotificationAddress() *PostalAddress1 {
 	m.ProxyAppointmentNotificationAddress = new(PostalAddress1)
 	return m.ProxyAppointmentNotificationAddress
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Elements which allow to identify a meeting.
 type MeetingReference2 struct {
 
@@ -25,6 +29,10 @@ type MeetingReference2 struct {
 	Location []*PostalAddress1 `xml:"Lctn,omitempty"`
 }
 
+func (m *MeetingReference2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MeetingReference2) SetMeetingIdentification(value string) {
 	m.MeetingIdentification = (*Max35Text)(&value)
 }
@@ -37,6 +45,11 @@ func (m *MeetingReference2) SetMeetingDateAndTime(value string) {
 	m.MeetingDateAndTime = (*ISODateTime)(&value)
 }
 
+func (m *MeetingReference2) SetMeetingDateAndTimeFromTime(value time.Time) {
+	m.MeetingDateAndTime = new(ISODateTime)
+	m.MeetingDateAndTime.FromTime(value)
+}
+
 func (m *MeetingReference2) SetType(value string) {
 	m.Type = (*MeetingType2Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Elements which allow to identify a meeting.
 type MeetingReference3 struct {
 
@@ -25,6 +29,10 @@ type MeetingReference3 struct {
 	Location []*PostalAddress1 `xml:"Lctn,omitempty"`
 }
 
+func (m *MeetingReference3) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MeetingReference3) SetMeetingIdentification(value string) {
 	m.MeetingIdentification = (*Max35Text)(&value)
 }
@@ -37,6 +45,11 @@ func (m *MeetingReference3) SetMeetingDateAndTime(value string) {
 	m.MeetingDateAndTime = (*ISODateTime)(&value)
 }
 
+func (m *MeetingReference3) SetMeetingDateAndTimeFromTime(value time.Time) {
+	m.MeetingDateAndTime = new(ISODateTime)
+	m.MeetingDateAndTime.FromTime(value)
+}
+
 func (m *MeetingReference3) SetType(value string) {
 	m.Type = (*MeetingType2Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Elements which allow to identify a meeting.
 type MeetingReference5 struct {
 
@@ -22,6 +26,10 @@ type MeetingReference5 struct {
 	Location []*PostalAddress1 `xml:"Lctn,omitempty"`
 }
 
+func (m *MeetingReference5) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MeetingReference5) SetMeetingIdentification(value string) {
 	m.MeetingIdentification = (*Max35Text)(&value)
 }
@@ -34,6 +42,11 @@ func (m *MeetingReference5) SetMeetingDateAndTime(value string) {
 	m.MeetingDateAndTime = (*ISODateTime)(&value)
 }
 
+func (m *MeetingReference5) SetMeetingDateAndTimeFromTime(value time.Time) {
+	m.MeetingDateAndTime = new(ISODateTime)
+	m.MeetingDateAndTime.FromTime(value)
+}
+
 func (m *MeetingReference5) SetType(value string) {
 	m.Type = (*MeetingType2Code)(&value)
 }
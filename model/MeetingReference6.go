@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identification of a meeting.
 type MeetingReference6 struct {
 
@@ -22,6 +26,10 @@ type MeetingReference6 struct {
 	Location []*PostalAddress1 `xml:"Lctn,omitempty"`
 }
 
+func (m *MeetingReference6) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MeetingReference6) SetMeetingIdentification(value string) {
 	m.MeetingIdentification = (*Max35Text)(&value)
 }
@@ -34,6 +42,11 @@ func (m *MeetingReference6) SetMeetingDateAndTime(value string) {
 	m.MeetingDateAndTime = (*ISODateTime)(&value)
 }
 
+func (m *MeetingReference6) SetMeetingDateAndTimeFromTime(value time.Time) {
+	m.MeetingDateAndTime = new(ISODateTime)
+	m.MeetingDateAndTime.FromTime(value)
+}
+
 func (m *MeetingReference6) SetType(value string) {
 	m.Type = (*MeetingType3Code)(&value)
 }
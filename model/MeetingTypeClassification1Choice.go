@@ -10,6 +10,10 @@ type MeetingTypeClassification1Choice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (m *MeetingTypeClassification1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MeetingTypeClassification1Choice) SetCode(value string) {
 	m.Code = (*MeetingTypeClassification1Code)(&value)
 }
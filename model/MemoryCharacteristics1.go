@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Characteristics of a hardware memory module.
 type MemoryCharacteristics1 struct {
 
@@ -16,6 +20,10 @@ type MemoryCharacteristics1 struct {
 	Unit *MemoryUnit1Code `xml:"Unit"`
 }
 
+func (m *MemoryCharacteristics1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MemoryCharacteristics1) SetIdentification(value string) {
 	m.Identification = (*Max35Text)(&value)
 }
@@ -24,10 +32,20 @@ func (m *MemoryCharacteristics1) SetTotalSize(value string) {
 	m.TotalSize = (*DecimalNumber)(&value)
 }
 
+func (m *MemoryCharacteristics1) SetTotalSizeFromDecimal(value decimal.Decimal) {
+	m.TotalSize = new(DecimalNumber)
+	m.TotalSize.FromDecimal(value)
+}
+
 func (m *MemoryCharacteristics1) SetFreeSize(value string) {
 	m.FreeSize = (*DecimalNumber)(&value)
 }
 
+func (m *MemoryCharacteristics1) SetFreeSizeFromDecimal(value decimal.Decimal) {
+	m.FreeSize = new(DecimalNumber)
+	m.FreeSize.FromDecimal(value)
+}
+
 func (m *MemoryCharacteristics1) SetUnit(value string) {
 	m.Unit = (*MemoryUnit1Code)(&value)
 }
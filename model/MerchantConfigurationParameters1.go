@@ -13,6 +13,10 @@ type MerchantConfigurationParameters1 struct {
 	Parameters *Max10000Binary `xml:"Params"`
 }
 
+func (m *MerchantConfigurationParameters1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MerchantConfigurationParameters1) SetMerchantIdentification(value string) {
 	m.MerchantIdentification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type MerchantConfigurationParameters2 struct {
 	OtherParameters *Max10000Binary `xml:"OthrParams,omitempty"`
 }
 
+func (m *MerchantConfigurationParameters2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MerchantConfigurationParameters2) SetActionType(value string) {
 	m.ActionType = (*TerminalManagementAction3Code)(&value)
 }
@@ -16,6 +16,10 @@ type MessageAndBusinessReference1 struct {
 	TransferReference *Max35Text `xml:"TrfRef,omitempty"`
 }
 
+func (m *MessageAndBusinessReference1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MessageAndBusinessReference1) AddPreviousReference() *AdditionalReference2 {
 	m.PreviousReference = new(AdditionalReference2)
 	return m.PreviousReference
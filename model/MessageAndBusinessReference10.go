@@ -13,6 +13,10 @@ type MessageAndBusinessReference10 struct {
 	OrderReference []*InvestmentFundOrder8 `xml:"OrdrRef,omitempty"`
 }
 
+func (m *MessageAndBusinessReference10) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MessageAndBusinessReference10) AddReference() *References62Choice {
 	m.Reference = new(References62Choice)
 	return m.Reference
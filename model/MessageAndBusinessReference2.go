@@ -16,6 +16,10 @@ type MessageAndBusinessReference2 struct {
 	InvestmentAccount *InvestmentAccount13 `xml:"InvstmtAcct,omitempty"`
 }
 
+func (m *MessageAndBusinessReference2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MessageAndBusinessReference2) AddOtherReference() *AdditionalReference3 {
 	m.OtherReference = new(AdditionalReference3)
 	return m.OtherReference
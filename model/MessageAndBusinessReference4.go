@@ -16,6 +16,10 @@ type MessageAndBusinessReference4 struct {
 	OrderReference []*InvestmentFundOrder2 `xml:"OrdrRef,omitempty"`
 }
 
+func (m *MessageAndBusinessReference4) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MessageAndBusinessReference4) AddOtherReference() *AdditionalReference3 {
 	m.OtherReference = new(AdditionalReference3)
 	return m.OtherReference
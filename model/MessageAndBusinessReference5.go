@@ -16,6 +16,10 @@ type MessageAndBusinessReference5 struct {
 	OrderReference []*InvestmentFundOrder3 `xml:"OrdrRef,omitempty"`
 }
 
+func (m *MessageAndBusinessReference5) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MessageAndBusinessReference5) AddOtherReference() *AdditionalReference3 {
 	m.OtherReference = new(AdditionalReference3)
 	return m.OtherReference
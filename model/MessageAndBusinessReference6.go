@@ -25,6 +25,10 @@ type MessageAndBusinessReference6 struct {
 	InvestmentAccountDetails *InvestmentAccount22 `xml:"InvstmtAcctDtls,omitempty"`
 }
 
+func (m *MessageAndBusinessReference6) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MessageAndBusinessReference6) AddPreviousReference() *AdditionalReference3 {
 	m.PreviousReference = new(AdditionalReference3)
 	return m.PreviousReference
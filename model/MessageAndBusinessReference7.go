@@ -22,6 +22,10 @@ type MessageAndBusinessReference7 struct {
 	InvestmentAccountDetails *InvestmentAccount40 `xml:"InvstmtAcctDtls,omitempty"`
 }
 
+func (m *MessageAndBusinessReference7) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MessageAndBusinessReference7) AddReference() *References39Choice {
 	m.Reference = new(References39Choice)
 	return m.Reference
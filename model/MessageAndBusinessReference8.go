@@ -22,6 +22,10 @@ type MessageAndBusinessReference8 struct {
 	InvestmentAccountDetails *InvestmentAccount57 `xml:"InvstmtAcctDtls,omitempty"`
 }
 
+func (m *MessageAndBusinessReference8) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MessageAndBusinessReference8) AddReference() *References48Choice {
 	m.Reference = new(References48Choice)
 	return m.Reference
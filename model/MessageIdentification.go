@@ -7,6 +7,10 @@ type MessageIdentification struct {
 	Identification *Max35Text `xml:"Id"`
 }
 
+func (m *MessageIdentification) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MessageIdentification) SetIdentification(value string) {
 	m.Identification = (*Max35Text)(&value)
 }
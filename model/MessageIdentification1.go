@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies a message by a unique identifier and the date and time when the message was created by the sender.
 type MessageIdentification1 struct {
 
@@ -10,6 +14,10 @@ type MessageIdentification1 struct {
 	CreationDateTime *ISODateTime `xml:"CreDtTm"`
 }
 
+func (m *MessageIdentification1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MessageIdentification1) SetIdentification(value string) {
 	m.Identification = (*Max35Text)(&value)
 }
@@ -17,3 +25,8 @@ func (m *MessageIdentification1) SetIdentification(value string) {
 func (m *MessageIdentification1) SetCreationDateTime(value string) {
 	m.CreationDateTime = (*ISODateTime)(&value)
 }
+
+func (m *MessageIdentification1) SetCreationDateTimeFromTime(value time.Time) {
+	m.CreationDateTime = new(ISODateTime)
+	m.CreationDateTime.FromTime(value)
+}
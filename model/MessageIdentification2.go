@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements providing the identification of a message.
 type MessageIdentification2 struct {
 
@@ -10,6 +12,10 @@ type MessageIdentification2 struct {
 	MessageIdentification *Max35Text `xml:"MsgId,omitempty"`
 }
 
+func (m *MessageIdentification2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MessageIdentification2) SetMessageNameIdentification(value string) {
 	m.MessageNameIdentification = (*Max35Text)(&value)
 }
@@ -17,3 +23,44 @@ func (m *MessageIdentification2) SetMessageNameIdentification(value string) {
 func (m *MessageIdentification2) SetMessageIdentification(value string) {
 	m.MessageIdentification = (*Max35Text)(&value)
 }
+
+func (m *MessageIdentification2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if m.MessageNameIdentification != nil {
+		enc.Element("MsgNmId", string(*m.MessageNameIdentification))
+	}
+	if m.MessageIdentification != nil {
+		enc.Element("MsgId", string(*m.MessageIdentification))
+	}
+	enc.End(tag)
+}
+
+func (m *MessageIdentification2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "MsgNmId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			m.MessageNameIdentification = (*Max35Text)(&value)
+		case "MsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			m.MessageIdentification = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
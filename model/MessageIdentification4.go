@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of element to identify a message by a unique identifier and the date and time when the message was created by the sender.
 type MessageIdentification4 struct {
 
@@ -12,6 +16,10 @@ type MessageIdentification4 struct {
 	CreationDateTime *ISODateTime `xml:"CreDtTm,omitempty"`
 }
 
+func (m *MessageIdentification4) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MessageIdentification4) SetMessageIdentification(value string) {
 	m.MessageIdentification = (*Max35Text)(&value)
 }
@@ -19,3 +27,8 @@ func (m *MessageIdentification4) SetMessageIdentification(value string) {
 func (m *MessageIdentification4) SetCreationDateTime(value string) {
 	m.CreationDateTime = (*ISODateTime)(&value)
 }
+
+func (m *MessageIdentification4) SetCreationDateTimeFromTime(value time.Time) {
+	m.CreationDateTime = new(ISODateTime)
+	m.CreationDateTime.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies a message by a unique identifier and the date and time when the message was created by the sender.
 type MessageIdentification5 struct {
 
@@ -15,6 +19,10 @@ type MessageIdentification5 struct {
 	FirstAgent *BranchAndFinancialInstitutionIdentification5 `xml:"FrstAgt,omitempty"`
 }
 
+func (m *MessageIdentification5) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MessageIdentification5) SetMessageIdentification(value string) {
 	m.MessageIdentification = (*Max35Text)(&value)
 }
@@ -23,6 +31,11 @@ func (m *MessageIdentification5) SetCreationDateTime(value string) {
 	m.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (m *MessageIdentification5) SetCreationDateTimeFromTime(value time.Time) {
+	m.CreationDateTime = new(ISODateTime)
+	m.CreationDateTime.FromTime(value)
+}
+
 func (m *MessageIdentification5) AddFirstAgent() *BranchAndFinancialInstitutionIdentification5 {
 	m.FirstAgent = new(BranchAndFinancialInstitutionIdentification5)
 	return m.FirstAgent
@@ -13,6 +13,10 @@ type MessageItemCondition1 struct {
 	Value []*Max140Text `xml:"Val,omitempty"`
 }
 
+func (m *MessageItemCondition1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MessageItemCondition1) SetItemIdentification(value string) {
 	m.ItemIdentification = (*Max140Text)(&value)
 }
@@ -7,6 +7,10 @@ type MessageReference struct {
 	Reference *Max35Text `xml:"Ref"`
 }
 
+func (m *MessageReference) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MessageReference) SetReference(value string) {
 	m.Reference = (*Max35Text)(&value)
 }
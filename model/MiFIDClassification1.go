@@ -10,6 +10,10 @@ type MiFIDClassification1 struct {
 	Narrative *Max350Text `xml:"Nrrtv,omitempty"`
 }
 
+func (m *MiFIDClassification1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MiFIDClassification1) SetClassification(value string) {
 	m.Classification = (*OrderOriginatorEligibility1Code)(&value)
 }
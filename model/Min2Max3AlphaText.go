@@ -1,3 +1,9 @@
 package model
 
 type Min2Max3AlphaText string
+
+var min2Max3AlphaTextPattern = newPattern(`[a-zA-Z]{2,3}`)
+
+func (m Min2Max3AlphaText) Validate() error {
+	return validatePattern("Min2Max3AlphaText", string(m), min2Max3AlphaTextPattern)
+}
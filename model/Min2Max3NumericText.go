@@ -1,3 +1,9 @@
 package model
 
 type Min2Max3NumericText string
+
+var min2Max3NumericTextPattern = newPattern(`[0-9]{2,3}`)
+
+func (m Min2Max3NumericText) Validate() error {
+	return validatePattern("Min2Max3NumericText", string(m), min2Max3NumericTextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type Min3Max4NumericText string
+
+var min3Max4NumericTextPattern = newPattern(`[0-9]{3,4}`)
+
+func (m Min3Max4NumericText) Validate() error {
+	return validatePattern("Min3Max4NumericText", string(m), min3Max4NumericTextPattern)
+}
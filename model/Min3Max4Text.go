@@ -1,3 +1,7 @@
 package model
 
 type Min3Max4Text string
+
+func (m Min3Max4Text) Validate() error {
+	return validateLength("Min3Max4Text", string(m), 3, 4)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Min5Max16Binary string
+
+func (m Min5Max16Binary) Validate() error {
+	return validateBinary("Min5Max16Binary", string(m), 5, 16)
+}
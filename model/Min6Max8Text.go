@@ -1,3 +1,7 @@
 package model
 
 type Min6Max8Text string
+
+func (m Min6Max8Text) Validate() error {
+	return validateLength("Min6Max8Text", string(m), 6, 8)
+}
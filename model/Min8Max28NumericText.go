@@ -1,3 +1,9 @@
 package model
 
 type Min8Max28NumericText string
+
+var min8Max28NumericTextPattern = newPattern(`[0-9]{8,28}`)
+
+func (m Min8Max28NumericText) Validate() error {
+	return validatePattern("Min8Max28NumericText", string(m), min8Max28NumericTextPattern)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Description of the mis-matched situation between two baselines or between a baseline and a data set.
 type MisMatchReport3 struct {
 
@@ -10,10 +14,19 @@ type MisMatchReport3 struct {
 	MisMatchInformation []*ValidationResult5 `xml:"MisMtchInf,omitempty"`
 }
 
+func (m *MisMatchReport3) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MisMatchReport3) SetNumberOfMisMatches(value string) {
 	m.NumberOfMisMatches = (*Number)(&value)
 }
 
+func (m *MisMatchReport3) SetNumberOfMisMatchesFromDecimal(value decimal.Decimal) {
+	m.NumberOfMisMatches = new(Number)
+	m.NumberOfMisMatches.FromDecimal(value)
+}
+
 func (m *MisMatchReport3) AddMisMatchInformation() *ValidationResult5 {
 	newValue := new(ValidationResult5)
 	m.MisMatchInformation = append(m.MisMatchInformation, newValue)
@@ -7,6 +7,15 @@ type MissingCover struct {
 	MissingCoverIndication *YesNoIndicator `xml:"MssngCoverIndctn"`
 }
 
+func (m *MissingCover) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MissingCover) SetMissingCoverIndication(value string) {
 	m.MissingCoverIndication = (*YesNoIndicator)(&value)
 }
+
+func (m *MissingCover) SetMissingCoverIndicationFromBool(value bool) {
+	m.MissingCoverIndication = new(YesNoIndicator)
+	m.MissingCoverIndication.FromBool(value)
+}
@@ -10,10 +10,19 @@ type MissingCover2 struct {
 	CoverCorrection *SettlementInformation15 `xml:"CoverCrrctn,omitempty"`
 }
 
+func (m *MissingCover2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MissingCover2) SetMissingCoverIndicator(value string) {
 	m.MissingCoverIndicator = (*YesNoIndicator)(&value)
 }
 
+func (m *MissingCover2) SetMissingCoverIndicatorFromBool(value bool) {
+	m.MissingCoverIndicator = new(YesNoIndicator)
+	m.MissingCoverIndicator.FromBool(value)
+}
+
 func (m *MissingCover2) AddCoverCorrection() *SettlementInformation15 {
 	m.CoverCorrection = new(SettlementInformation15)
 	return m.CoverCorrection
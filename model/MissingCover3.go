@@ -10,10 +10,19 @@ type MissingCover3 struct {
 	CoverCorrection *SettlementInstruction3 `xml:"CoverCrrctn,omitempty"`
 }
 
+func (m *MissingCover3) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MissingCover3) SetMissingCoverIndicator(value string) {
 	m.MissingCoverIndicator = (*YesNoIndicator)(&value)
 }
 
+func (m *MissingCover3) SetMissingCoverIndicatorFromBool(value bool) {
+	m.MissingCoverIndicator = new(YesNoIndicator)
+	m.MissingCoverIndicator.FromBool(value)
+}
+
 func (m *MissingCover3) AddCoverCorrection() *SettlementInstruction3 {
 	m.CoverCorrection = new(SettlementInstruction3)
 	return m.CoverCorrection
@@ -10,6 +10,10 @@ type MissingOrIncorrectInformation struct {
 	IncorrectInformation []*UnableToApplyIncorrectInfo1Code `xml:"IncrrctInf,omitempty"`
 }
 
+func (m *MissingOrIncorrectInformation) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MissingOrIncorrectInformation) AddMissingInformation(value string) {
 	m.MissingInformation = append(m.MissingInformation, (*UnableToApplyMissingInfo1Code)(&value))
 }
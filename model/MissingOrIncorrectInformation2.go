@@ -13,10 +13,19 @@ type MissingOrIncorrectInformation2 struct {
 	IncorrectInformation []*UnableToApplyIncorrectInformation3Code `xml:"IncrrctInf,omitempty"`
 }
 
+func (m *MissingOrIncorrectInformation2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MissingOrIncorrectInformation2) SetAntiMoneyLaunderingRequest(value string) {
 	m.AntiMoneyLaunderingRequest = (*AMLIndicator)(&value)
 }
 
+func (m *MissingOrIncorrectInformation2) SetAntiMoneyLaunderingRequestFromBool(value bool) {
+	m.AntiMoneyLaunderingRequest = new(AMLIndicator)
+	m.AntiMoneyLaunderingRequest.FromBool(value)
+}
+
 func (m *MissingOrIncorrectInformation2) AddMissingInformation(value string) {
 	m.MissingInformation = append(m.MissingInformation, (*UnableToApplyMissingInformation2Code)(&value))
 }
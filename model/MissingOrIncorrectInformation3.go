@@ -13,10 +13,19 @@ type MissingOrIncorrectInformation3 struct {
 	IncorrectInformation []*UnableToApplyIncorrect1 `xml:"IncrrctInf,omitempty"`
 }
 
+func (m *MissingOrIncorrectInformation3) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MissingOrIncorrectInformation3) SetAntiMoneyLaunderingRequest(value string) {
 	m.AntiMoneyLaunderingRequest = (*AMLIndicator)(&value)
 }
 
+func (m *MissingOrIncorrectInformation3) SetAntiMoneyLaunderingRequestFromBool(value bool) {
+	m.AntiMoneyLaunderingRequest = new(AMLIndicator)
+	m.AntiMoneyLaunderingRequest.FromBool(value)
+}
+
 func (m *MissingOrIncorrectInformation3) AddMissingInformation() *UnableToApplyMissing1 {
 	newValue := new(UnableToApplyMissing1)
 	m.MissingInformation = append(m.MissingInformation, newValue)
@@ -10,6 +10,10 @@ type ModelFormIdentification1 struct {
 	Version *Max35Text `xml:"Vrsn,omitempty"`
 }
 
+func (m *ModelFormIdentification1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModelFormIdentification1) AddIdentification() *ModelFormIdentification1Choice {
 	m.Identification = new(ModelFormIdentification1Choice)
 	return m.Identification
@@ -11,6 +11,10 @@ type ModelFormIdentification1Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (m *ModelFormIdentification1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModelFormIdentification1Choice) SetCode(value string) {
 	m.Code = (*ExternalModelFormIdentification1Code)(&value)
 }
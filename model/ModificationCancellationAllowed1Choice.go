@@ -10,10 +10,19 @@ type ModificationCancellationAllowed1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (m *ModificationCancellationAllowed1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationCancellationAllowed1Choice) SetIndicator(value string) {
 	m.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (m *ModificationCancellationAllowed1Choice) SetIndicatorFromBool(value bool) {
+	m.Indicator = new(YesNoIndicator)
+	m.Indicator.FromBool(value)
+}
+
 func (m *ModificationCancellationAllowed1Choice) AddProprietary() *GenericIdentification20 {
 	m.Proprietary = new(GenericIdentification20)
 	return m.Proprietary
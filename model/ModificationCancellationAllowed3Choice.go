@@ -10,10 +10,19 @@ type ModificationCancellationAllowed3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (m *ModificationCancellationAllowed3Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationCancellationAllowed3Choice) SetIndicator(value string) {
 	m.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (m *ModificationCancellationAllowed3Choice) SetIndicatorFromBool(value bool) {
+	m.Indicator = new(YesNoIndicator)
+	m.Indicator.FromBool(value)
+}
+
 func (m *ModificationCancellationAllowed3Choice) AddProprietary() *GenericIdentification38 {
 	m.Proprietary = new(GenericIdentification38)
 	return m.Proprietary
@@ -10,10 +10,19 @@ type ModificationCancellationAllowed4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (m *ModificationCancellationAllowed4Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationCancellationAllowed4Choice) SetIndicator(value string) {
 	m.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (m *ModificationCancellationAllowed4Choice) SetIndicatorFromBool(value bool) {
+	m.Indicator = new(YesNoIndicator)
+	m.Indicator.FromBool(value)
+}
+
 func (m *ModificationCancellationAllowed4Choice) AddProprietary() *GenericIdentification30 {
 	m.Proprietary = new(GenericIdentification30)
 	return m.Proprietary
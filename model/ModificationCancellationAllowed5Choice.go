@@ -10,10 +10,19 @@ type ModificationCancellationAllowed5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (m *ModificationCancellationAllowed5Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationCancellationAllowed5Choice) SetIndicator(value string) {
 	m.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (m *ModificationCancellationAllowed5Choice) SetIndicatorFromBool(value bool) {
+	m.Indicator = new(YesNoIndicator)
+	m.Indicator.FromBool(value)
+}
+
 func (m *ModificationCancellationAllowed5Choice) AddProprietary() *GenericIdentification47 {
 	m.Proprietary = new(GenericIdentification47)
 	return m.Proprietary
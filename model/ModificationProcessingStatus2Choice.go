@@ -25,6 +25,10 @@ type ModificationProcessingStatus2Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (m *ModificationProcessingStatus2Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationProcessingStatus2Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus14Choice {
 	m.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus14Choice)
 	return m.AcknowledgedAccepted
@@ -25,6 +25,10 @@ type ModificationProcessingStatus4Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (m *ModificationProcessingStatus4Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationProcessingStatus4Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus14Choice {
 	m.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus14Choice)
 	return m.AcknowledgedAccepted
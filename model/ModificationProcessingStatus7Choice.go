@@ -25,6 +25,10 @@ type ModificationProcessingStatus7Choice struct {
 	Proprietary *ProprietaryStatusAndReason6 `xml:"Prtry"`
 }
 
+func (m *ModificationProcessingStatus7Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationProcessingStatus7Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus23Choice {
 	m.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus23Choice)
 	return m.AcknowledgedAccepted
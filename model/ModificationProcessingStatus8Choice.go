@@ -25,6 +25,10 @@ type ModificationProcessingStatus8Choice struct {
 	Proprietary *ProprietaryStatusAndReason7 `xml:"Prtry"`
 }
 
+func (m *ModificationProcessingStatus8Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationProcessingStatus8Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus30Choice {
 	m.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus30Choice)
 	return m.AcknowledgedAccepted
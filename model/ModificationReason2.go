@@ -10,6 +10,10 @@ type ModificationReason2 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (m *ModificationReason2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationReason2) AddCode() *ModificationReason2Choice {
 	m.Code = new(ModificationReason2Choice)
 	return m.Code
@@ -10,6 +10,10 @@ type ModificationReason2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (m *ModificationReason2Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationReason2Choice) SetCode(value string) {
 	m.Code = (*ModifiedStatusReason1Code)(&value)
 }
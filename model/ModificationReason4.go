@@ -10,6 +10,10 @@ type ModificationReason4 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (m *ModificationReason4) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationReason4) AddCode() *ModificationReason4Choice {
 	m.Code = new(ModificationReason4Choice)
 	return m.Code
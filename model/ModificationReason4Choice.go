@@ -10,6 +10,10 @@ type ModificationReason4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (m *ModificationReason4Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationReason4Choice) SetCode(value string) {
 	m.Code = (*ModifiedStatusReason1Code)(&value)
 }
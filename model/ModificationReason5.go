@@ -10,6 +10,10 @@ type ModificationReason5 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (m *ModificationReason5) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationReason5) AddCode() *ModificationReason5Choice {
 	m.Code = new(ModificationReason5Choice)
 	return m.Code
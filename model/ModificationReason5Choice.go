@@ -10,6 +10,10 @@ type ModificationReason5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (m *ModificationReason5Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationReason5Choice) SetCode(value string) {
 	m.Code = (*ModifiedStatusReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope1 struct {
 	PostalAddress *PostalAddress3 `xml:"PstlAdr"`
 }
 
+func (m *ModificationScope1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope1) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
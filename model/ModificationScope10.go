@@ -10,6 +10,10 @@ type ModificationScope10 struct {
 	ServiceLevelAgreement *DocumentToSend1 `xml:"SvcLvlAgrmt"`
 }
 
+func (m *ModificationScope10) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope10) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope11 struct {
 	InvestorProfileValidation *PartyProfileInformation1 `xml:"InvstrPrflVldtn"`
 }
 
+func (m *ModificationScope11) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope11) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope12 struct {
 	FundDetails *FinancialInstrument10 `xml:"FndDtls"`
 }
 
+func (m *ModificationScope12) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope12) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope13 struct {
 	FundDetails *FinancialInstrument29 `xml:"FndDtls"`
 }
 
+func (m *ModificationScope13) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope13) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope14 struct {
 	InvestorProfileValidation *PartyProfileInformation2 `xml:"InvstrPrflVldtn"`
 }
 
+func (m *ModificationScope14) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope14) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope16 struct {
 	InvestmentPlan *InvestmentPlan7 `xml:"InvstmtPlan"`
 }
 
+func (m *ModificationScope16) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope16) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope17 struct {
 	OtherIdentification *GenericIdentification44 `xml:"OthrId"`
 }
 
+func (m *ModificationScope17) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope17) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
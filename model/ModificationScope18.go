@@ -10,6 +10,10 @@ type ModificationScope18 struct {
 	InvestmentPlan *InvestmentPlan8 `xml:"InvstmtPlan"`
 }
 
+func (m *ModificationScope18) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope18) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
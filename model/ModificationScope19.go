@@ -10,6 +10,10 @@ type ModificationScope19 struct {
 	InvestorProfileValidation *PartyProfileInformation3 `xml:"InvstrPrflVldtn"`
 }
 
+func (m *ModificationScope19) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope19) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
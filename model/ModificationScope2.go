@@ -10,6 +10,10 @@ type ModificationScope2 struct {
 	OtherIdentification *GenericIdentification9 `xml:"OthrId"`
 }
 
+func (m *ModificationScope2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope2) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
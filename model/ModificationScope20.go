@@ -10,6 +10,10 @@ type ModificationScope20 struct {
 	Intermediary *Intermediary24 `xml:"Intrmy"`
 }
 
+func (m *ModificationScope20) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope20) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope21 struct {
 	IssueAllocation *NewIssueAllocation2 `xml:"IsseAllcn"`
 }
 
+func (m *ModificationScope21) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope21) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope22 struct {
 	ServiceLevelAgreement *DocumentToSend2 `xml:"SvcLvlAgrmt"`
 }
 
+func (m *ModificationScope22) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope22) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope23 struct {
 	OtherIdentification *GenericIdentification55 `xml:"OthrId"`
 }
 
+func (m *ModificationScope23) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope23) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
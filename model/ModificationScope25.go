@@ -10,6 +10,10 @@ type ModificationScope25 struct {
 	InvestmentPlan *InvestmentPlan11 `xml:"InvstmtPlan"`
 }
 
+func (m *ModificationScope25) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope25) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope26 struct {
 	Intermediary *Intermediary36 `xml:"Intrmy"`
 }
 
+func (m *ModificationScope26) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope26) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
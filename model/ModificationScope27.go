@@ -10,6 +10,10 @@ type ModificationScope27 struct {
 	InvestorProfileValidation *PartyProfileInformation5 `xml:"InvstrPrflVldtn"`
 }
 
+func (m *ModificationScope27) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope27) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
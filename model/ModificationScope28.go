@@ -10,6 +10,10 @@ type ModificationScope28 struct {
 	InvestmentPlan *InvestmentPlan13 `xml:"InvstmtPlan"`
 }
 
+func (m *ModificationScope28) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope28) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope29 struct {
 	FinancialInstrumentDetails *FinancialInstrument51 `xml:"FinInstrmDtls"`
 }
 
+func (m *ModificationScope29) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope29) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope3 struct {
 	Citizenship *CitizenshipInformation `xml:"Ctznsh"`
 }
 
+func (m *ModificationScope3) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope3) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope30 struct {
 	AdditionalInformation []*AccountRestrictions1 `xml:"AddtlInf"`
 }
 
+func (m *ModificationScope30) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope30) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
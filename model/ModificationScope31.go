@@ -10,6 +10,10 @@ type ModificationScope31 struct {
 	ServiceLevelAgreement *DocumentToSend3 `xml:"SvcLvlAgrmt"`
 }
 
+func (m *ModificationScope31) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope31) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope32 struct {
 	InvestorProfile *InvestorProfile1 `xml:"InvstrPrfl"`
 }
 
+func (m *ModificationScope32) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope32) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
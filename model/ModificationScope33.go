@@ -10,6 +10,10 @@ type ModificationScope33 struct {
 	Placement *ReferredAgent2 `xml:"Plcmnt"`
 }
 
+func (m *ModificationScope33) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope33) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope34 struct {
 	PostalAddress *PostalAddress21 `xml:"PstlAdr"`
 }
 
+func (m *ModificationScope34) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope34) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope35 struct {
 	AdditionalInformation []*AdditiononalInformation12 `xml:"AddtlInf"`
 }
 
+func (m *ModificationScope35) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope35) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope36 struct {
 	FinancialInstrumentDetails *FinancialInstrument56 `xml:"FinInstrmDtls"`
 }
 
+func (m *ModificationScope36) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope36) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
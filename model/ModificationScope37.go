@@ -10,6 +10,10 @@ type ModificationScope37 struct {
 	InvestmentPlan *InvestmentPlan15 `xml:"InvstmtPlan"`
 }
 
+func (m *ModificationScope37) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope37) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
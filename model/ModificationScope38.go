@@ -10,6 +10,10 @@ type ModificationScope38 struct {
 	Intermediary *Intermediary36 `xml:"Intrmy"`
 }
 
+func (m *ModificationScope38) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope38) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ModificationScope39 struct {
 	Citizenship *CitizenshipInformation2 `xml:"Ctznsh"`
 }
 
+func (m *ModificationScope39) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope39) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification2Code)(&value)
 }
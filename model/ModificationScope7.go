@@ -10,6 +10,10 @@ type ModificationScope7 struct {
 	Intermediary *Intermediary13 `xml:"Intrmy"`
 }
 
+func (m *ModificationScope7) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope7) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
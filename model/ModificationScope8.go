@@ -10,6 +10,10 @@ type ModificationScope8 struct {
 	InvestmentPlan *InvestmentPlan5 `xml:"InvstmtPlan"`
 }
 
+func (m *ModificationScope8) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope8) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
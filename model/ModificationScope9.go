@@ -10,6 +10,10 @@ type ModificationScope9 struct {
 	IssueAllocation *NewIssueAllocation1 `xml:"IsseAllcn"`
 }
 
+func (m *ModificationScope9) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *ModificationScope9) SetModificationScopeIndication(value string) {
 	m.ModificationScopeIndication = (*DataModification1Code)(&value)
 }
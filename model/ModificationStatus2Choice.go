@@ -10,8 +10,16 @@ type ModificationStatus2Choice struct {
 	Reason []*ModificationReason2 `xml:"Rsn,omitempty"`
 }
 
-func (m *ModificationStatus2Choice) SetNoSpecifiedReason(value string) {
+func (m *ModificationStatus2Choice) Validate() error {
+	return ValidateElement(m)
+}
+
+func (m *ModificationStatus2Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	m.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (m *ModificationStatus2Choice) AddReason() *ModificationReason2 {
@@ -10,8 +10,16 @@ type ModificationStatus4Choice struct {
 	Reason []*ModificationReason4 `xml:"Rsn,omitempty"`
 }
 
-func (m *ModificationStatus4Choice) SetNoSpecifiedReason(value string) {
+func (m *ModificationStatus4Choice) Validate() error {
+	return ValidateElement(m)
+}
+
+func (m *ModificationStatus4Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	m.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (m *ModificationStatus4Choice) AddReason() *ModificationReason4 {
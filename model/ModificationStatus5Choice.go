@@ -10,8 +10,16 @@ type ModificationStatus5Choice struct {
 	Reason []*ModificationReason5 `xml:"Rsn,omitempty"`
 }
 
-func (m *ModificationStatus5Choice) SetNoSpecifiedReason(value string) {
+func (m *ModificationStatus5Choice) Validate() error {
+	return ValidateElement(m)
+}
+
+func (m *ModificationStatus5Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	m.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (m *ModificationStatus5Choice) AddReason() *ModificationReason5 {
@@ -10,6 +10,10 @@ type MoneyLaunderingCheck1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (m *MoneyLaunderingCheck1Choice) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MoneyLaunderingCheck1Choice) SetCode(value string) {
 	m.Code = (*MoneyLaunderingCheck1Code)(&value)
 }
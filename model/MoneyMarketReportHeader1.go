@@ -10,6 +10,10 @@ type MoneyMarketReportHeader1 struct {
 	ReferencePeriod *DateTimePeriod1 `xml:"RefPrd"`
 }
 
+func (m *MoneyMarketReportHeader1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MoneyMarketReportHeader1) SetReportingAgent(value string) {
 	m.ReportingAgent = (*LEIIdentifier)(&value)
 }
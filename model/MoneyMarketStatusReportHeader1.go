@@ -17,6 +17,10 @@ type MoneyMarketStatusReportHeader1 struct {
 	ValidationRule []*GenericValidationRuleIdentification1 `xml:"VldtnRule,omitempty"`
 }
 
+func (m *MoneyMarketStatusReportHeader1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MoneyMarketStatusReportHeader1) SetReportingAgent(value string) {
 	m.ReportingAgent = (*LEIIdentifier)(&value)
 }
@@ -25,6 +25,10 @@ type MoneyMarketTransactionStatus2 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MoneyMarketTransactionStatus2) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MoneyMarketTransactionStatus2) SetUniqueTransactionIdentifier(value string) {
 	m.UniqueTransactionIdentifier = (*Max105Text)(&value)
 }
@@ -16,6 +16,10 @@ type MovementInstruction1 struct {
 	ProceedsMovementDetails []*ProceedsMovement1 `xml:"PrcdsMvmntDtls,omitempty"`
 }
 
+func (m *MovementInstruction1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MovementInstruction1) AddMovementGeneralInformation() *CorporateActionMovement1 {
 	m.MovementGeneralInformation = new(CorporateActionMovement1)
 	return m.MovementGeneralInformation
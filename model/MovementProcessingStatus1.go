@@ -10,6 +10,10 @@ type MovementProcessingStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (m *MovementProcessingStatus1) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MovementProcessingStatus1) AddStatus() *ProcessedStatus3FormatChoice {
 	m.Status = new(ProcessedStatus3FormatChoice)
 	return m.Status
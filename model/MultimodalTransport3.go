@@ -10,6 +10,10 @@ type MultimodalTransport3 struct {
 	PlaceOfFinalDestination *Max35Text `xml:"PlcOfFnlDstn"`
 }
 
+func (m *MultimodalTransport3) Validate() error {
+	return ValidateElement(m)
+}
+
 func (m *MultimodalTransport3) SetTakingInCharge(value string) {
 	m.TakingInCharge = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type NDFOpeningFixing1Choice struct {
 	OpeningConfirmationReference *Max35Text `xml:"OpngConfRef"`
 }
 
+func (n *NDFOpeningFixing1Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NDFOpeningFixing1Choice) AddOpeningConditions() *OpeningConditions1 {
 	n.OpeningConditions = new(OpeningConditions1)
 	return n.OpeningConditions
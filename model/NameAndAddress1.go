@@ -10,6 +10,10 @@ type NameAndAddress1 struct {
 	Address *LongPostalAddress1Choice `xml:"Adr"`
 }
 
+func (n *NameAndAddress1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NameAndAddress1) SetName(value string) {
 	n.Name = (*Max35Text)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Information that locates and identifies a party.
 type NameAndAddress10 struct {
 
@@ -10,6 +12,10 @@ type NameAndAddress10 struct {
 	Address *PostalAddress6 `xml:"Adr"`
 }
 
+func (n *NameAndAddress10) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NameAndAddress10) SetName(value string) {
 	n.Name = (*Max140Text)(&value)
 }
@@ -18,3 +24,44 @@ func (n *NameAndAddress10) AddAddress() *PostalAddress6 {
 	n.Address = new(PostalAddress6)
 	return n.Address
 }
+
+func (n *NameAndAddress10) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if n.Name != nil {
+		enc.Element("Nm", string(*n.Name))
+	}
+	if n.Address != nil {
+		n.Address.EncodeXMLFast(enc, "Adr")
+	}
+	enc.End(tag)
+}
+
+func (n *NameAndAddress10) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			n.Name = (*Max140Text)(&value)
+		case "Adr":
+			elem := new(PostalAddress6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			n.Address = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -7,6 +7,10 @@ type NameAndAddress12 struct {
 	Name *RestrictedFINXMax140Text `xml:"Nm"`
 }
 
+func (n *NameAndAddress12) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NameAndAddress12) SetName(value string) {
 	n.Name = (*RestrictedFINXMax140Text)(&value)
 }
@@ -10,6 +10,10 @@ type NameAndAddress13 struct {
 	Address *PostalAddress8 `xml:"Adr,omitempty"`
 }
 
+func (n *NameAndAddress13) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NameAndAddress13) SetName(value string) {
 	n.Name = (*Max350Text)(&value)
 }
@@ -10,6 +10,10 @@ type NameAndAddress15 struct {
 	PostalAddress *PostalAddress21 `xml:"PstlAdr,omitempty"`
 }
 
+func (n *NameAndAddress15) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NameAndAddress15) SetName(value string) {
 	n.Name = (*Max350Text)(&value)
 }
@@ -0,0 +1,67 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Information that locates and identifies a party.
+type NameAndAddress16 struct {
+
+	// Name by which a party is known and is usually used to identify that identity.
+	Name *Max140Text `xml:"Nm"`
+
+	// Postal address of a party.
+	Address *PostalAddress24 `xml:"Adr"`
+}
+
+func (n *NameAndAddress16) Validate() error {
+	return ValidateElement(n)
+}
+
+func (n *NameAndAddress16) SetName(value string) {
+	n.Name = (*Max140Text)(&value)
+}
+
+func (n *NameAndAddress16) AddAddress() *PostalAddress24 {
+	n.Address = new(PostalAddress24)
+	return n.Address
+}
+
+func (n *NameAndAddress16) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if n.Name != nil {
+		enc.Element("Nm", string(*n.Name))
+	}
+	if n.Address != nil {
+		n.Address.EncodeXMLFast(enc, "Adr")
+	}
+	enc.End(tag)
+}
+
+func (n *NameAndAddress16) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			n.Name = (*Max140Text)(&value)
+		case "Adr":
+			elem := new(PostalAddress24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			n.Address = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
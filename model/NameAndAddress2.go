@@ -10,6 +10,10 @@ type NameAndAddress2 struct {
 	Address *LongPostalAddress1Choice `xml:"Adr,omitempty"`
 }
 
+func (n *NameAndAddress2) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NameAndAddress2) SetName(value string) {
 	n.Name = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type NameAndAddress3 struct {
 	Address *PostalAddress1 `xml:"Adr"`
 }
 
+func (n *NameAndAddress3) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NameAndAddress3) SetName(value string) {
 	n.Name = (*Max70Text)(&value)
 }
@@ -10,6 +10,10 @@ type NameAndAddress4 struct {
 	Address *PostalAddress1 `xml:"Adr"`
 }
 
+func (n *NameAndAddress4) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NameAndAddress4) SetName(value string) {
 	n.Name = (*Max350Text)(&value)
 }
@@ -10,6 +10,10 @@ type NameAndAddress5 struct {
 	Address *PostalAddress1 `xml:"Adr,omitempty"`
 }
 
+func (n *NameAndAddress5) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NameAndAddress5) SetName(value string) {
 	n.Name = (*Max350Text)(&value)
 }
@@ -10,6 +10,10 @@ type NameAndAddress6 struct {
 	Address *PostalAddress2 `xml:"Adr"`
 }
 
+func (n *NameAndAddress6) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NameAndAddress6) SetName(value string) {
 	n.Name = (*Max70Text)(&value)
 }
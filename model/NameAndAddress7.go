@@ -10,6 +10,10 @@ type NameAndAddress7 struct {
 	PostalAddress *PostalAddress1 `xml:"PstlAdr"`
 }
 
+func (n *NameAndAddress7) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NameAndAddress7) SetName(value string) {
 	n.Name = (*Max70Text)(&value)
 }
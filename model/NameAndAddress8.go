@@ -13,6 +13,10 @@ type NameAndAddress8 struct {
 	AlternativeIdentifier []*Max35Text `xml:"AltrntvIdr,omitempty"`
 }
 
+func (n *NameAndAddress8) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NameAndAddress8) SetName(value string) {
 	n.Name = (*Max350Text)(&value)
 }
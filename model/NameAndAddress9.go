@@ -10,6 +10,10 @@ type NameAndAddress9 struct {
 	Address *LongPostalAddress2Choice `xml:"Adr,omitempty"`
 }
 
+func (n *NameAndAddress9) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NameAndAddress9) SetName(value string) {
 	n.Name = (*Max350Text)(&value)
 }
@@ -10,6 +10,10 @@ type NameModification1 struct {
 	Name *Max70Text `xml:"Nm"`
 }
 
+func (n *NameModification1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NameModification1) SetModificationCode(value string) {
 	n.ModificationCode = (*Modification1Code)(&value)
 }
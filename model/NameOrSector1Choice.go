@@ -10,6 +10,10 @@ type NameOrSector1Choice struct {
 	Sector *SNA2008SectorIdentifier `xml:"Sctr"`
 }
 
+func (n *NameOrSector1Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NameOrSector1Choice) SetName(value string) {
 	n.Name = (*Max70Text)(&value)
 }
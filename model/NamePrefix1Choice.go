@@ -10,8 +10,16 @@ type NamePrefix1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
-func (n *NamePrefix1Choice) SetCode(value string) {
+func (n *NamePrefix1Choice) Validate() error {
+	return ValidateElement(n)
+}
+
+func (n *NamePrefix1Choice) SetCode(value string) error {
+	if err := NamePrefix1Code(value).Validate(); err != nil {
+		return err
+	}
 	n.Code = (*NamePrefix1Code)(&value)
+	return nil
 }
 
 func (n *NamePrefix1Choice) AddProprietary() *GenericIdentification47 {
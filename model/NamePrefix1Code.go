@@ -1,3 +1,30 @@
 package model
 
 type NamePrefix1Code string
+
+const (
+	NamePrefix1CodeDOCT NamePrefix1Code = "DOCT"
+	NamePrefix1CodeMIST NamePrefix1Code = "MIST"
+	NamePrefix1CodeMISS NamePrefix1Code = "MISS"
+	NamePrefix1CodeMADM NamePrefix1Code = "MADM"
+)
+
+var namePrefix1CodeDescriptions = map[NamePrefix1Code]string{
+	NamePrefix1CodeDOCT: "Doctor: title of the person is Doctor or Dr.",
+	NamePrefix1CodeMIST: "Mister: title of the person is Mister or Mr.",
+	NamePrefix1CodeMISS: "Miss: title of the person is Miss.",
+	NamePrefix1CodeMADM: "Madam: title of the person is Madam.",
+}
+
+func (n NamePrefix1Code) IsValid() bool {
+	_, ok := namePrefix1CodeDescriptions[n]
+	return ok
+}
+
+func (n NamePrefix1Code) Description() string {
+	return namePrefix1CodeDescriptions[n]
+}
+
+func (n NamePrefix1Code) Validate() error {
+	return validateEnumeration("NamePrefix1Code", string(n), n.IsValid(), "DOCT, MIST, MISS, MADM")
+}
@@ -0,0 +1,32 @@
+package model
+
+type NamePrefix2Code string
+
+const (
+	NamePrefix2CodeDOCT NamePrefix2Code = "DOCT"
+	NamePrefix2CodeMADM NamePrefix2Code = "MADM"
+	NamePrefix2CodeMISS NamePrefix2Code = "MISS"
+	NamePrefix2CodeMIST NamePrefix2Code = "MIST"
+	NamePrefix2CodeMIKS NamePrefix2Code = "MIKS"
+)
+
+var namePrefix2CodeDescriptions = map[NamePrefix2Code]string{
+	NamePrefix2CodeDOCT: "Doctor: title of the person is Doctor or Dr.",
+	NamePrefix2CodeMADM: "Madam: title of the person is Madam.",
+	NamePrefix2CodeMISS: "Miss: title of the person is Miss.",
+	NamePrefix2CodeMIST: "Mister: title of the person is Mister or Mr.",
+	NamePrefix2CodeMIKS: "GenderNeutral: title of the person is gender neutral (Mx).",
+}
+
+func (n NamePrefix2Code) IsValid() bool {
+	_, ok := namePrefix2CodeDescriptions[n]
+	return ok
+}
+
+func (n NamePrefix2Code) Description() string {
+	return namePrefix2CodeDescriptions[n]
+}
+
+func (n NamePrefix2Code) Validate() error {
+	return validateEnumeration("NamePrefix2Code", string(n), n.IsValid(), "DOCT, MADM, MISS, MIST, MIKS")
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Encrypted data with an encryption key identified with a name.
 type NamedKeyEncryptedData1 struct {
 
@@ -13,10 +17,19 @@ type NamedKeyEncryptedData1 struct {
 	EncryptedContent *EncryptedContent1 `xml:"NcrptdCntt"`
 }
 
+func (n *NamedKeyEncryptedData1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NamedKeyEncryptedData1) SetVersion(value string) {
 	n.Version = (*Number)(&value)
 }
 
+func (n *NamedKeyEncryptedData1) SetVersionFromDecimal(value decimal.Decimal) {
+	n.Version = new(Number)
+	n.Version.FromDecimal(value)
+}
+
 func (n *NamedKeyEncryptedData1) SetKeyName(value string) {
 	n.KeyName = (*Max140Text)(&value)
 }
@@ -10,6 +10,10 @@ type Narrative1 struct {
 	Text []*Max20000Text `xml:"Txt"`
 }
 
+func (n *Narrative1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *Narrative1) AddType() *NarrativeType1Choice {
 	n.Type = new(NarrativeType1Choice)
 	return n.Type
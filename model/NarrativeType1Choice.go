@@ -11,6 +11,10 @@ type NarrativeType1Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (n *NarrativeType1Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NarrativeType1Choice) SetCode(value string) {
 	n.Code = (*ExternalNarrativeType1Code)(&value)
 }
@@ -1,3 +1,9 @@
 package model
 
 type NationalityCode string
+
+var nationalityCodePattern = newPattern(`[A-Z]{2,2}`)
+
+func (n NationalityCode) Validate() error {
+	return validatePattern("NationalityCode", string(n), nationalityCodePattern)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net cash movements to a fund as a result of investment funds transactions.
 type NetCashForecast1 struct {
 
@@ -16,14 +22,27 @@ type NetCashForecast1 struct {
 	FlowDirection *FlowDirectionType1Code `xml:"FlowDrctn"`
 }
 
+func (n *NetCashForecast1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetCashForecast1) SetSettlementDate(value string) {
 	n.SettlementDate = (*ISODate)(&value)
 }
 
+func (n *NetCashForecast1) SetSettlementDateFromTime(value time.Time) {
+	n.SettlementDate = new(ISODate)
+	n.SettlementDate.FromTime(value)
+}
+
 func (n *NetCashForecast1) SetNetAmount(value, currency string) {
 	n.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (n *NetCashForecast1) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (n *NetCashForecast1) AddNetUnitsNumber() *FinancialInstrumentQuantity1 {
 	n.NetUnitsNumber = new(FinancialInstrumentQuantity1)
 	return n.NetUnitsNumber
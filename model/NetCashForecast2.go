@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net cash movement to a fund as a result of investment funds transactions.
 type NetCashForecast2 struct {
 
@@ -16,14 +22,27 @@ type NetCashForecast2 struct {
 	FlowDirection *FlowDirectionType1Code `xml:"FlowDrctn"`
 }
 
+func (n *NetCashForecast2) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetCashForecast2) SetCashSettlementDate(value string) {
 	n.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (n *NetCashForecast2) SetCashSettlementDateFromTime(value time.Time) {
+	n.CashSettlementDate = new(ISODate)
+	n.CashSettlementDate.FromTime(value)
+}
+
 func (n *NetCashForecast2) SetNetAmount(value, currency string) {
 	n.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (n *NetCashForecast2) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (n *NetCashForecast2) AddNetUnitsNumber() *FinancialInstrumentQuantity1 {
 	n.NetUnitsNumber = new(FinancialInstrumentQuantity1)
 	return n.NetUnitsNumber
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net cash movement to a fund as a result of investment funds transactions.
 type NetCashForecast3 struct {
 
@@ -13,10 +17,18 @@ type NetCashForecast3 struct {
 	FlowDirection *FlowDirectionType1Code `xml:"FlowDrctn"`
 }
 
+func (n *NetCashForecast3) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetCashForecast3) SetNetAmount(value, currency string) {
 	n.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (n *NetCashForecast3) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (n *NetCashForecast3) AddNetUnitsNumber() *FinancialInstrumentQuantity1 {
 	n.NetUnitsNumber = new(FinancialInstrumentQuantity1)
 	return n.NetUnitsNumber
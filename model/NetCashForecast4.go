@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net cash movement to a fund as a result of investment funds transactions.
 type NetCashForecast4 struct {
 
@@ -20,14 +26,27 @@ type NetCashForecast4 struct {
 	AdditionalBalance *FundBalance1 `xml:"AddtlBal,omitempty"`
 }
 
+func (n *NetCashForecast4) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetCashForecast4) SetCashSettlementDate(value string) {
 	n.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (n *NetCashForecast4) SetCashSettlementDateFromTime(value time.Time) {
+	n.CashSettlementDate = new(ISODate)
+	n.CashSettlementDate.FromTime(value)
+}
+
 func (n *NetCashForecast4) SetNetAmount(value, currency string) {
 	n.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (n *NetCashForecast4) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (n *NetCashForecast4) AddNetUnitsNumber() *FinancialInstrumentQuantity1 {
 	n.NetUnitsNumber = new(FinancialInstrumentQuantity1)
 	return n.NetUnitsNumber
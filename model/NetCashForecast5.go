@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Net cash movement to a fund as a result of investment funds transactions.
 type NetCashForecast5 struct {
 
@@ -16,14 +22,27 @@ type NetCashForecast5 struct {
 	FlowDirection *FlowDirectionType1Code `xml:"FlowDrctn"`
 }
 
+func (n *NetCashForecast5) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetCashForecast5) SetCashSettlementDate(value string) {
 	n.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (n *NetCashForecast5) SetCashSettlementDateFromTime(value time.Time) {
+	n.CashSettlementDate = new(ISODate)
+	n.CashSettlementDate.FromTime(value)
+}
+
 func (n *NetCashForecast5) SetNetAmount(value, currency string) {
 	n.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (n *NetCashForecast5) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (n *NetCashForecast5) AddNetUnitsNumber() *FinancialInstrumentQuantity1 {
 	n.NetUnitsNumber = new(FinancialInstrumentQuantity1)
 	return n.NetUnitsNumber
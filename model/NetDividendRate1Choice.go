@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format to express a net dividend.
 type NetDividendRate1Choice struct {
 
@@ -13,6 +17,10 @@ type NetDividendRate1Choice struct {
 	RateTypeAmount *NetDividendRate2 `xml:"RateTpAmt"`
 }
 
+func (n *NetDividendRate1Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRate1Choice) AddNotSpecifiedRate() *RateValueType6FormatChoice {
 	n.NotSpecifiedRate = new(RateValueType6FormatChoice)
 	return n.NotSpecifiedRate
@@ -22,6 +30,10 @@ func (n *NetDividendRate1Choice) SetAmount(value, currency string) {
 	n.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (n *NetDividendRate1Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (n *NetDividendRate1Choice) AddRateTypeAmount() *NetDividendRate2 {
 	n.RateTypeAmount = new(NetDividendRate2)
 	return n.RateTypeAmount
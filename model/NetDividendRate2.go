@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Cash dividend amount per equity after deductions or allowances have been made.
 type NetDividendRate2 struct {
 
@@ -10,6 +14,10 @@ type NetDividendRate2 struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (n *NetDividendRate2) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRate2) AddRateType() *NetDividendRateType1FormatChoice {
 	n.RateType = new(NetDividendRateType1FormatChoice)
 	return n.RateType
@@ -18,3 +26,7 @@ func (n *NetDividendRate2) AddRateType() *NetDividendRateType1FormatChoice {
 func (n *NetDividendRate2) SetAmount(value, currency string) {
 	n.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (n *NetDividendRate2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between different formats to express a net dividend.
 type NetDividendRateFormat10Choice struct {
 
@@ -10,10 +14,18 @@ type NetDividendRateFormat10Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus14 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (n *NetDividendRateFormat10Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateFormat10Choice) SetAmount(value, currency string) {
 	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (n *NetDividendRateFormat10Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (n *NetDividendRateFormat10Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus14 {
 	n.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus14)
 	return n.RateTypeAndAmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type NetDividendRateFormat11Choice struct {
 
@@ -13,10 +17,18 @@ type NetDividendRateFormat11Choice struct {
 	NotSpecifiedRate *RateValueType7Code `xml:"NotSpcfdRate"`
 }
 
+func (n *NetDividendRateFormat11Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateFormat11Choice) SetAmount(value, currency string) {
 	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (n *NetDividendRateFormat11Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (n *NetDividendRateFormat11Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus16 {
 	n.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus16)
 	return n.RateTypeAndAmountAndRateStatus
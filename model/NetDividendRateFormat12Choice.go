@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type NetDividendRateFormat12Choice struct {
 
@@ -10,10 +14,18 @@ type NetDividendRateFormat12Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus16 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (n *NetDividendRateFormat12Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateFormat12Choice) SetAmount(value, currency string) {
 	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (n *NetDividendRateFormat12Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (n *NetDividendRateFormat12Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus16 {
 	n.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus16)
 	return n.RateTypeAndAmountAndRateStatus
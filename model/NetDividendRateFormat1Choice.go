@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type NetDividendRateFormat1Choice struct {
 
@@ -13,10 +17,18 @@ type NetDividendRateFormat1Choice struct {
 	NotSpecifiedRate *RateValueType6Code `xml:"NotSpcfdRate"`
 }
 
+func (n *NetDividendRateFormat1Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateFormat1Choice) SetAmount(value, currency string) {
 	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (n *NetDividendRateFormat1Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (n *NetDividendRateFormat1Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus4 {
 	n.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus4)
 	return n.RateTypeAndAmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type NetDividendRateFormat21Choice struct {
 
@@ -16,10 +20,18 @@ type NetDividendRateFormat21Choice struct {
 	NotSpecifiedRate *RateValueType7Code `xml:"NotSpcfdRate"`
 }
 
+func (n *NetDividendRateFormat21Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateFormat21Choice) SetAmount(value, currency string) {
 	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (n *NetDividendRateFormat21Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (n *NetDividendRateFormat21Choice) AddAmountAndRateStatus() *AmountAndRateStatus1 {
 	n.AmountAndRateStatus = new(AmountAndRateStatus1)
 	return n.AmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type NetDividendRateFormat22Choice struct {
 
@@ -16,10 +20,18 @@ type NetDividendRateFormat22Choice struct {
 	NotSpecifiedRate *RateValueType7Code `xml:"NotSpcfdRate"`
 }
 
+func (n *NetDividendRateFormat22Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateFormat22Choice) SetAmount(value, currency string) {
 	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (n *NetDividendRateFormat22Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (n *NetDividendRateFormat22Choice) AddAmountAndRateStatus() *AmountAndRateStatus1 {
 	n.AmountAndRateStatus = new(AmountAndRateStatus1)
 	return n.AmountAndRateStatus
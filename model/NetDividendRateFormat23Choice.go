@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type NetDividendRateFormat23Choice struct {
 
@@ -13,10 +17,18 @@ type NetDividendRateFormat23Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus25 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (n *NetDividendRateFormat23Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateFormat23Choice) SetAmount(value, currency string) {
 	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (n *NetDividendRateFormat23Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (n *NetDividendRateFormat23Choice) AddAmountAndRateStatus() *AmountAndRateStatus1 {
 	n.AmountAndRateStatus = new(AmountAndRateStatus1)
 	return n.AmountAndRateStatus
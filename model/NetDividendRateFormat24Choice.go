@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between different formats to express a net dividend.
 type NetDividendRateFormat24Choice struct {
 
@@ -13,10 +17,18 @@ type NetDividendRateFormat24Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus29 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (n *NetDividendRateFormat24Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateFormat24Choice) SetAmount(value, currency string) {
 	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (n *NetDividendRateFormat24Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (n *NetDividendRateFormat24Choice) AddAmountAndRateStatus() *AmountAndRateStatus1 {
 	n.AmountAndRateStatus = new(AmountAndRateStatus1)
 	return n.AmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type NetDividendRateFormat25Choice struct {
 
@@ -13,10 +17,18 @@ type NetDividendRateFormat25Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus31 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (n *NetDividendRateFormat25Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateFormat25Choice) SetAmount(value, currency string) {
 	n.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (n *NetDividendRateFormat25Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (n *NetDividendRateFormat25Choice) AddAmountAndRateStatus() *AmountAndRateStatus2 {
 	n.AmountAndRateStatus = new(AmountAndRateStatus2)
 	return n.AmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between different formats to express a net dividend.
 type NetDividendRateFormat26Choice struct {
 
@@ -13,10 +17,18 @@ type NetDividendRateFormat26Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus36 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (n *NetDividendRateFormat26Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateFormat26Choice) SetAmount(value, currency string) {
 	n.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (n *NetDividendRateFormat26Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (n *NetDividendRateFormat26Choice) AddAmountAndRateStatus() *AmountAndRateStatus2 {
 	n.AmountAndRateStatus = new(AmountAndRateStatus2)
 	return n.AmountAndRateStatus
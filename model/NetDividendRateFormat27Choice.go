@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type NetDividendRateFormat27Choice struct {
 
@@ -16,10 +20,18 @@ type NetDividendRateFormat27Choice struct {
 	NotSpecifiedRate *RateValueType7Code `xml:"NotSpcfdRate"`
 }
 
+func (n *NetDividendRateFormat27Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateFormat27Choice) SetAmount(value, currency string) {
 	n.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (n *NetDividendRateFormat27Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (n *NetDividendRateFormat27Choice) AddAmountAndRateStatus() *AmountAndRateStatus2 {
 	n.AmountAndRateStatus = new(AmountAndRateStatus2)
 	return n.AmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between different formats to express a net dividend.
 type NetDividendRateFormat2Choice struct {
 
@@ -10,10 +14,18 @@ type NetDividendRateFormat2Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus4 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (n *NetDividendRateFormat2Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateFormat2Choice) SetAmount(value, currency string) {
 	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (n *NetDividendRateFormat2Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (n *NetDividendRateFormat2Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus4 {
 	n.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus4)
 	return n.RateTypeAndAmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type NetDividendRateFormat5Choice struct {
 
@@ -13,10 +17,18 @@ type NetDividendRateFormat5Choice struct {
 	NotSpecifiedRate *RateValueType7Code `xml:"NotSpcfdRate"`
 }
 
+func (n *NetDividendRateFormat5Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateFormat5Choice) SetAmount(value, currency string) {
 	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (n *NetDividendRateFormat5Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (n *NetDividendRateFormat5Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus4 {
 	n.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus4)
 	return n.RateTypeAndAmountAndRateStatus
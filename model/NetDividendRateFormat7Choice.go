@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type NetDividendRateFormat7Choice struct {
 
@@ -10,10 +14,18 @@ type NetDividendRateFormat7Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus4 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (n *NetDividendRateFormat7Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateFormat7Choice) SetAmount(value, currency string) {
 	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (n *NetDividendRateFormat7Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (n *NetDividendRateFormat7Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus4 {
 	n.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus4)
 	return n.RateTypeAndAmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type NetDividendRateFormat9Choice struct {
 
@@ -13,10 +17,18 @@ type NetDividendRateFormat9Choice struct {
 	NotSpecifiedRate *RateValueType7Code `xml:"NotSpcfdRate"`
 }
 
+func (n *NetDividendRateFormat9Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateFormat9Choice) SetAmount(value, currency string) {
 	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (n *NetDividendRateFormat9Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (n *NetDividendRateFormat9Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus14 {
 	n.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus14)
 	return n.RateTypeAndAmountAndRateStatus
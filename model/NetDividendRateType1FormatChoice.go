@@ -10,6 +10,10 @@ type NetDividendRateType1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (n *NetDividendRateType1FormatChoice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetDividendRateType1FormatChoice) SetCode(value string) {
 	n.Code = (*NetDividendRateType1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Describes the amount, direction and parties involved in a payment obligation between two participants (and their netting group or trading party) of a netting service.
 type NetObligation1 struct {
 
@@ -28,6 +32,10 @@ type NetObligation1 struct {
 	TransactionsNumber *Max10NumericText `xml:"TxsNb,omitempty"`
 }
 
+func (n *NetObligation1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetObligation1) SetObligationIdentification(value string) {
 	n.ObligationIdentification = (*Max35Text)(&value)
 }
@@ -36,13 +44,21 @@ func (n *NetObligation1) SetAmount(value, currency string) {
 	n.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (n *NetObligation1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (n *NetObligation1) AddParticipantNettingIdentification() *NettingIdentification1Choice {
 	n.ParticipantNettingIdentification = new(NettingIdentification1Choice)
 	return n.ParticipantNettingIdentification
 }
 
-func (n *NetObligation1) SetObligationDirection(value string) {
+func (n *NetObligation1) SetObligationDirection(value string) error {
+	if err := CreditDebit3Code(value).Validate(); err != nil {
+		return err
+	}
 	n.ObligationDirection = (*CreditDebit3Code)(&value)
+	return nil
 }
 
 func (n *NetObligation1) AddCounterpartyNettingIdentification() *NettingIdentification1Choice {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides the net positions details.
 type NetPosition3 struct {
 
@@ -52,6 +56,10 @@ type NetPosition3 struct {
 	TradeLegDetails []*TradeLeg10 `xml:"TradLegDtls,omitempty"`
 }
 
+func (n *NetPosition3) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetPosition3) AddClearingAccount() *SecuritiesAccount18 {
 	n.ClearingAccount = new(SecuritiesAccount18)
 	return n.ClearingAccount
@@ -97,8 +105,12 @@ func (n *NetPosition3) AddNetQuantity() *FinancialInstrumentQuantity1Choice {
 	return n.NetQuantity
 }
 
-func (n *NetPosition3) SetSecuritiesMovementType(value string) {
+func (n *NetPosition3) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	n.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
 func (n *NetPosition3) AddDepository() *PartyIdentification34Choice {
@@ -119,6 +131,11 @@ func (n *NetPosition3) SetTradeDate(value string) {
 	n.TradeDate = (*ISODate)(&value)
 }
 
+func (n *NetPosition3) SetTradeDateFromTime(value time.Time) {
+	n.TradeDate = new(ISODate)
+	n.TradeDate.FromTime(value)
+}
+
 func (n *NetPosition3) AddSettlementDate() *DateFormat15Choice {
 	n.SettlementDate = new(DateFormat15Choice)
 	return n.SettlementDate
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the meta data associated with a net report.
 type NetReportData1 struct {
 
@@ -31,6 +35,10 @@ type NetReportData1 struct {
 	MessagePagination *Pagination `xml:"MsgPgntn,omitempty"`
 }
 
+func (n *NetReportData1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetReportData1) SetMessageIdentification(value string) {
 	n.MessageIdentification = (*Max35Text)(&value)
 }
@@ -39,18 +47,38 @@ func (n *NetReportData1) SetCreationDateTime(value string) {
 	n.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (n *NetReportData1) SetCreationDateTimeFromTime(value time.Time) {
+	n.CreationDateTime = new(ISODateTime)
+	n.CreationDateTime.FromTime(value)
+}
+
 func (n *NetReportData1) SetNettingCutOffTime(value string) {
 	n.NettingCutOffTime = (*ISOTime)(&value)
 }
 
+func (n *NetReportData1) SetNettingCutOffTimeFromTime(value time.Time) {
+	n.NettingCutOffTime = new(ISOTime)
+	n.NettingCutOffTime.FromTime(value)
+}
+
 func (n *NetReportData1) SetReportDate(value string) {
 	n.ReportDate = (*ISODate)(&value)
 }
 
+func (n *NetReportData1) SetReportDateFromTime(value time.Time) {
+	n.ReportDate = new(ISODate)
+	n.ReportDate.FromTime(value)
+}
+
 func (n *NetReportData1) SetValueDate(value string) {
 	n.ValueDate = (*ISODate)(&value)
 }
 
+func (n *NetReportData1) SetValueDateFromTime(value time.Time) {
+	n.ValueDate = new(ISODate)
+	n.ValueDate.FromTime(value)
+}
+
 func (n *NetReportData1) SetReportType(value string) {
 	n.ReportType = (*Max4Text)(&value)
 }
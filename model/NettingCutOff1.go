@@ -10,6 +10,10 @@ type NettingCutOff1 struct {
 	NewCutOff []*CutOff1 `xml:"NewCutOff"`
 }
 
+func (n *NettingCutOff1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NettingCutOff1) AddNettingIdentification() *NettingIdentification1Choice {
 	n.NettingIdentification = new(NettingIdentification1Choice)
 	return n.NettingIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the meta data associated with a netting cut off report.
 type NettingCutOffReportData1 struct {
 
@@ -28,6 +32,10 @@ type NettingCutOffReportData1 struct {
 	MessagePagination *Pagination `xml:"MsgPgntn,omitempty"`
 }
 
+func (n *NettingCutOffReportData1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NettingCutOffReportData1) SetMessageIdentification(value string) {
 	n.MessageIdentification = (*Max35Text)(&value)
 }
@@ -36,6 +44,11 @@ func (n *NettingCutOffReportData1) SetCreationDateTime(value string) {
 	n.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (n *NettingCutOffReportData1) SetCreationDateTimeFromTime(value time.Time) {
+	n.CreationDateTime = new(ISODateTime)
+	n.CreationDateTime.FromTime(value)
+}
+
 func (n *NettingCutOffReportData1) SetReportType(value string) {
 	n.ReportType = (*Max4Text)(&value)
 }
@@ -44,6 +57,11 @@ func (n *NettingCutOffReportData1) SetActivationDate(value string) {
 	n.ActivationDate = (*ISODate)(&value)
 }
 
+func (n *NettingCutOffReportData1) SetActivationDateFromTime(value time.Time) {
+	n.ActivationDate = new(ISODate)
+	n.ActivationDate.FromTime(value)
+}
+
 func (n *NettingCutOffReportData1) AddNetServiceParticipantIdentification() *PartyIdentification73Choice {
 	n.NetServiceParticipantIdentification = new(PartyIdentification73Choice)
 	return n.NetServiceParticipantIdentification
@@ -10,10 +10,19 @@ type NettingEligibility1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (n *NettingEligibility1Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NettingEligibility1Choice) SetIndicator(value string) {
 	n.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (n *NettingEligibility1Choice) SetIndicatorFromBool(value bool) {
+	n.Indicator = new(YesNoIndicator)
+	n.Indicator.FromBool(value)
+}
+
 func (n *NettingEligibility1Choice) AddProprietary() *GenericIdentification20 {
 	n.Proprietary = new(GenericIdentification20)
 	return n.Proprietary
@@ -10,10 +10,19 @@ type NettingEligibility3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (n *NettingEligibility3Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NettingEligibility3Choice) SetIndicator(value string) {
 	n.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (n *NettingEligibility3Choice) SetIndicatorFromBool(value bool) {
+	n.Indicator = new(YesNoIndicator)
+	n.Indicator.FromBool(value)
+}
+
 func (n *NettingEligibility3Choice) AddProprietary() *GenericIdentification38 {
 	n.Proprietary = new(GenericIdentification38)
 	return n.Proprietary
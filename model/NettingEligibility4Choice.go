@@ -10,10 +10,19 @@ type NettingEligibility4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (n *NettingEligibility4Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NettingEligibility4Choice) SetIndicator(value string) {
 	n.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (n *NettingEligibility4Choice) SetIndicatorFromBool(value bool) {
+	n.Indicator = new(YesNoIndicator)
+	n.Indicator.FromBool(value)
+}
+
 func (n *NettingEligibility4Choice) AddProprietary() *GenericIdentification30 {
 	n.Proprietary = new(GenericIdentification30)
 	return n.Proprietary
@@ -10,10 +10,19 @@ type NettingEligibility5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (n *NettingEligibility5Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NettingEligibility5Choice) SetIndicator(value string) {
 	n.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (n *NettingEligibility5Choice) SetIndicatorFromBool(value bool) {
+	n.Indicator = new(YesNoIndicator)
+	n.Indicator.FromBool(value)
+}
+
 func (n *NettingEligibility5Choice) AddProprietary() *GenericIdentification47 {
 	n.Proprietary = new(GenericIdentification47)
 	return n.Proprietary
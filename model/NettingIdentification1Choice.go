@@ -10,6 +10,10 @@ type NettingIdentification1Choice struct {
 	NettingGroupIdentification *Max35Text `xml:"NetgGrpId"`
 }
 
+func (n *NettingIdentification1Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NettingIdentification1Choice) AddTradeParty() *PartyIdentification73Choice {
 	n.TradeParty = new(PartyIdentification73Choice)
 	return n.TradeParty
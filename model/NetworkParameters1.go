@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Configuration parameters to communicate with a host.
 type NetworkParameters1 struct {
 
@@ -25,6 +29,10 @@ type NetworkParameters1 struct {
 	ClientCertificate *Max3000Binary `xml:"ClntCert,omitempty"`
 }
 
+func (n *NetworkParameters1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetworkParameters1) SetPrimaryAddress(value string) {
 	n.PrimaryAddress = (*Max35Text)(&value)
 }
@@ -33,6 +41,11 @@ func (n *NetworkParameters1) SetPrimaryPortNumber(value string) {
 	n.PrimaryPortNumber = (*Number)(&value)
 }
 
+func (n *NetworkParameters1) SetPrimaryPortNumberFromDecimal(value decimal.Decimal) {
+	n.PrimaryPortNumber = new(Number)
+	n.PrimaryPortNumber.FromDecimal(value)
+}
+
 func (n *NetworkParameters1) SetSecondaryAddress(value string) {
 	n.SecondaryAddress = (*Max35Text)(&value)
 }
@@ -41,6 +54,11 @@ func (n *NetworkParameters1) SetSecondaryPortNumber(value string) {
 	n.SecondaryPortNumber = (*Number)(&value)
 }
 
+func (n *NetworkParameters1) SetSecondaryPortNumberFromDecimal(value decimal.Decimal) {
+	n.SecondaryPortNumber = new(Number)
+	n.SecondaryPortNumber.FromDecimal(value)
+}
+
 func (n *NetworkParameters1) SetUserName(value string) {
 	n.UserName = (*Max35Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Configuration parameters to communicate with a host.
 type NetworkParameters2 struct {
 
@@ -13,6 +19,10 @@ type NetworkParameters2 struct {
 	Delay *ISOTime `xml:"Dely,omitempty"`
 }
 
+func (n *NetworkParameters2) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetworkParameters2) SetAddress(value string) {
 	n.Address = (*Max35Text)(&value)
 }
@@ -21,6 +31,16 @@ func (n *NetworkParameters2) SetPortNumber(value string) {
 	n.PortNumber = (*Number)(&value)
 }
 
+func (n *NetworkParameters2) SetPortNumberFromDecimal(value decimal.Decimal) {
+	n.PortNumber = new(Number)
+	n.PortNumber.FromDecimal(value)
+}
+
 func (n *NetworkParameters2) SetDelay(value string) {
 	n.Delay = (*ISOTime)(&value)
 }
+
+func (n *NetworkParameters2) SetDelayFromTime(value time.Time) {
+	n.Delay = new(ISOTime)
+	n.Delay.FromTime(value)
+}
@@ -19,6 +19,10 @@ type NetworkParameters3 struct {
 	ServerCertificateIdentifier []*Max140Binary `xml:"SvrCertIdr,omitempty"`
 }
 
+func (n *NetworkParameters3) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetworkParameters3) AddAddress() *NetworkParameters4 {
 	newValue := new(NetworkParameters4)
 	n.Address = append(n.Address, newValue)
@@ -10,6 +10,10 @@ type NetworkParameters4 struct {
 	AddressValue *Max70Text `xml:"AdrVal"`
 }
 
+func (n *NetworkParameters4) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetworkParameters4) SetNetworkType(value string) {
 	n.NetworkType = (*NetworkType1Code)(&value)
 }
@@ -25,6 +25,10 @@ type NetworkParameters5 struct {
 	SecurityProfile *Max35Text `xml:"SctyPrfl,omitempty"`
 }
 
+func (n *NetworkParameters5) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetworkParameters5) AddAddress() *NetworkParameters4 {
 	newValue := new(NetworkParameters4)
 	n.Address = append(n.Address, newValue)
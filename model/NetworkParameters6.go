@@ -10,6 +10,10 @@ type NetworkParameters6 struct {
 	Access *NetworkParameters5 `xml:"Accs"`
 }
 
+func (n *NetworkParameters6) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NetworkParameters6) SetType(value string) {
 	n.Type = (*NetworkType2Code)(&value)
 }
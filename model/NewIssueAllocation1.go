@@ -16,10 +16,19 @@ type NewIssueAllocation1 struct {
 	DeMinimusNotApplicable *DeMinimusNotApplicable1 `xml:"DeMnmsNotAplbl,omitempty"`
 }
 
+func (n *NewIssueAllocation1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NewIssueAllocation1) SetRestricted(value string) {
 	n.Restricted = (*YesNoIndicator)(&value)
 }
 
+func (n *NewIssueAllocation1) SetRestrictedFromBool(value bool) {
+	n.Restricted = new(YesNoIndicator)
+	n.Restricted.FromBool(value)
+}
+
 func (n *NewIssueAllocation1) SetExemptPersonReason(value string) {
 	n.ExemptPersonReason = (*Max350Text)(&value)
 }
@@ -13,10 +13,19 @@ type NewIssueAllocation2 struct {
 	DeMinimus *DeMinimus1Choice `xml:"DeMnms,omitempty"`
 }
 
+func (n *NewIssueAllocation2) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NewIssueAllocation2) SetRestricted(value string) {
 	n.Restricted = (*YesNoIndicator)(&value)
 }
 
+func (n *NewIssueAllocation2) SetRestrictedFromBool(value bool) {
+	n.Restricted = new(YesNoIndicator)
+	n.Restricted.FromBool(value)
+}
+
 func (n *NewIssueAllocation2) SetExemptPersonReason(value string) {
 	n.ExemptPersonReason = (*Max350Text)(&value)
 }
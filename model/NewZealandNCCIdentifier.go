@@ -1,3 +1,9 @@
 package model
 
 type NewZealandNCCIdentifier string
+
+var newZealandNCCIdentifierPattern = newPattern(`NZ[0-9]{6,6}`)
+
+func (n NewZealandNCCIdentifier) Validate() error {
+	return validatePattern("NewZealandNCCIdentifier", string(n), newZealandNCCIdentifierPattern)
+}
@@ -1,3 +1,24 @@
 package model
 
 type NoReasonCode string
+
+const (
+	NoReasonCodeNORE NoReasonCode = "NORE"
+)
+
+var noReasonCodeDescriptions = map[NoReasonCode]string{
+	NoReasonCodeNORE: "No reason: no reason is available or to be reported.",
+}
+
+func (n NoReasonCode) IsValid() bool {
+	_, ok := noReasonCodeDescriptions[n]
+	return ok
+}
+
+func (n NoReasonCode) Description() string {
+	return noReasonCodeDescriptions[n]
+}
+
+func (n NoReasonCode) Validate() error {
+	return validateEnumeration("NoReasonCode", string(n), n.IsValid(), "NORE")
+}
@@ -7,6 +7,14 @@ type NoSpecifiedReason1 struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
-func (n *NoSpecifiedReason1) SetNoSpecifiedReason(value string) {
+func (n *NoSpecifiedReason1) Validate() error {
+	return ValidateElement(n)
+}
+
+func (n *NoSpecifiedReason1) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	n.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
@@ -10,10 +10,19 @@ type NonDeliverableForwardConditions1 struct {
 	OpeningFixingConditions *NDFOpeningFixing1Choice `xml:"OpngFxgConds"`
 }
 
+func (n *NonDeliverableForwardConditions1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NonDeliverableForwardConditions1) SetOpeningIndicator(value string) {
 	n.OpeningIndicator = (*YesNoIndicator)(&value)
 }
 
+func (n *NonDeliverableForwardConditions1) SetOpeningIndicatorFromBool(value bool) {
+	n.OpeningIndicator = new(YesNoIndicator)
+	n.OpeningIndicator.FromBool(value)
+}
+
 func (n *NonDeliverableForwardConditions1) AddOpeningFixingConditions() *NDFOpeningFixing1Choice {
 	n.OpeningFixingConditions = new(NDFOpeningFixing1Choice)
 	return n.OpeningFixingConditions
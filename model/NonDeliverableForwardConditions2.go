@@ -10,6 +10,10 @@ type NonDeliverableForwardConditions2 struct {
 	FixingConditions *FixingConditions1 `xml:"FxgConds,omitempty"`
 }
 
+func (n *NonDeliverableForwardConditions2) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NonDeliverableForwardConditions2) AddOpeningConditions() *OpeningConditions1 {
 	n.OpeningConditions = new(OpeningConditions1)
 	return n.OpeningConditions
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of parameters used to calculate the fixing rate to be applied to a non-deliverable agreement.
 type NonDeliverableForwardValuationConditions2 struct {
 
@@ -16,6 +20,10 @@ type NonDeliverableForwardValuationConditions2 struct {
 	SettlementParty *PartyIdentification8Choice `xml:"SttlmPty,omitempty"`
 }
 
+func (n *NonDeliverableForwardValuationConditions2) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NonDeliverableForwardValuationConditions2) SetSettlementCurrency(value string) {
 	n.SettlementCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -24,6 +32,11 @@ func (n *NonDeliverableForwardValuationConditions2) SetValuationDate(value strin
 	n.ValuationDate = (*ISODate)(&value)
 }
 
+func (n *NonDeliverableForwardValuationConditions2) SetValuationDateFromTime(value time.Time) {
+	n.ValuationDate = new(ISODate)
+	n.ValuationDate.FromTime(value)
+}
+
 func (n *NonDeliverableForwardValuationConditions2) SetAdditionalValuationInformation(value string) {
 	n.AdditionalValuationInformation = (*Max140Text)(&value)
 }
@@ -10,6 +10,10 @@ type NonEligibleProceedsIndicator1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (n *NonEligibleProceedsIndicator1Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NonEligibleProceedsIndicator1Choice) SetCode(value string) {
 	n.Code = (*NonEligibleProceedsIndicator1Code)(&value)
 }
@@ -10,6 +10,10 @@ type NonEligibleProceedsIndicator3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (n *NonEligibleProceedsIndicator3Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NonEligibleProceedsIndicator3Choice) SetCode(value string) {
 	n.Code = (*NonEligibleProceedsIndicator1Code)(&value)
 }
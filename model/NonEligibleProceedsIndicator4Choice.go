@@ -10,6 +10,10 @@ type NonEligibleProceedsIndicator4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (n *NonEligibleProceedsIndicator4Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NonEligibleProceedsIndicator4Choice) SetCode(value string) {
 	n.Code = (*NonEligibleProceedsIndicator1Code)(&value)
 }
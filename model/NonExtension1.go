@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Non-extension information.
 type NonExtension1 struct {
 
@@ -19,10 +23,19 @@ type NonExtension1 struct {
 	NotificationRecipientAddress *PostalAddress6 `xml:"NtfctnRcptAdr,omitempty"`
 }
 
+func (n *NonExtension1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NonExtension1) SetNotificationPeriod(value string) {
 	n.NotificationPeriod = (*Number)(&value)
 }
 
+func (n *NonExtension1) SetNotificationPeriodFromDecimal(value decimal.Decimal) {
+	n.NotificationPeriod = new(Number)
+	n.NotificationPeriod.FromDecimal(value)
+}
+
 func (n *NonExtension1) AddNotificationMethod() *CommunicationMethod1Choice {
 	n.NotificationMethod = new(CommunicationMethod1Choice)
 	return n.NotificationMethod
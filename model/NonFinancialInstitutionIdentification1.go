@@ -25,6 +25,10 @@ type NonFinancialInstitutionIdentification1 struct {
 	ProprietaryIdentification *GenericIdentification3 `xml:"PrtryId,omitempty"`
 }
 
+func (n *NonFinancialInstitutionIdentification1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NonFinancialInstitutionIdentification1) SetBEI(value string) {
 	n.BEI = (*BEIIdentifier)(&value)
 }
@@ -16,6 +16,10 @@ type NonGuaranteedTrade3 struct {
 	ReceivingParties *ReceivingPartiesAndAccount11 `xml:"RcvgPties,omitempty"`
 }
 
+func (n *NonGuaranteedTrade3) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NonGuaranteedTrade3) AddTradeCounterpartyMemberIdentification() *PartyIdentification35Choice {
 	n.TradeCounterpartyMemberIdentification = new(PartyIdentification35Choice)
 	return n.TradeCounterpartyMemberIdentification
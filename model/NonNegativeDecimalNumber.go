@@ -1,3 +1,22 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 type NonNegativeDecimalNumber string
+
+func (n NonNegativeDecimalNumber) Validate() error {
+	if err := validateDecimal("NonNegativeDecimalNumber", string(n), 18, 17); err != nil {
+		return err
+	}
+	return validateInclusive("NonNegativeDecimalNumber", string(n), "minInclusive", "0")
+}
+
+func (n NonNegativeDecimalNumber) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(string(n))
+}
+
+func (n *NonNegativeDecimalNumber) FromDecimal(value decimal.Decimal) {
+	*n = NonNegativeDecimalNumber(value.String())
+}
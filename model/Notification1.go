@@ -10,6 +10,10 @@ type Notification1 struct {
 	AdditionalInformation *Max140Text `xml:"AddtlInf"`
 }
 
+func (n *Notification1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *Notification1) SetType(value string) {
 	n.Type = (*NotificationType1Code)(&value)
 }
@@ -13,6 +13,10 @@ type Notification2 struct {
 	DistributionType *InformationDistribution1Choice `xml:"DstrbtnTp,omitempty"`
 }
 
+func (n *Notification2) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *Notification2) SetNotificationType(value string) {
 	n.NotificationType = (*Max35Text)(&value)
 }
@@ -21,6 +25,11 @@ func (n *Notification2) SetRequired(value string) {
 	n.Required = (*YesNoIndicator)(&value)
 }
 
+func (n *Notification2) SetRequiredFromBool(value bool) {
+	n.Required = new(YesNoIndicator)
+	n.Required.FromBool(value)
+}
+
 func (n *Notification2) AddDistributionType() *InformationDistribution1Choice {
 	n.DistributionType = new(InformationDistribution1Choice)
 	return n.DistributionType
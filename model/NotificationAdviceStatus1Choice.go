@@ -10,6 +10,10 @@ type NotificationAdviceStatus1Choice struct {
 	RejectedStatus *NotificationRejectionReason1 `xml:"RjctdSts"`
 }
 
+func (n *NotificationAdviceStatus1Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NotificationAdviceStatus1Choice) AddProcessedStatus() *NotificationProcessingStatus1 {
 	n.ProcessedStatus = new(NotificationProcessingStatus1)
 	return n.ProcessedStatus
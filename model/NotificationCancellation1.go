@@ -10,6 +10,10 @@ type NotificationCancellation1 struct {
 	LinkedAgentCANotificationAdviceIdentification *DocumentIdentification8 `xml:"LkdAgtCANtfctnAdvcId"`
 }
 
+func (n *NotificationCancellation1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NotificationCancellation1) SetNotificationCancellationType(value string) {
 	n.NotificationCancellationType = (*CorporateActionNotificationType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type NotificationCancellationProcessingStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (n *NotificationCancellationProcessingStatus1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NotificationCancellationProcessingStatus1) AddStatus() *ProcessedStatus2FormatChoice {
 	n.Status = new(ProcessedStatus2FormatChoice)
 	return n.Status
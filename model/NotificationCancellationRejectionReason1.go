@@ -10,6 +10,10 @@ type NotificationCancellationRejectionReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (n *NotificationCancellationRejectionReason1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NotificationCancellationRejectionReason1) AddReason() *RejectionReason11FormatChoice {
 	newValue := new(RejectionReason11FormatChoice)
 	n.Reason = append(n.Reason, newValue)
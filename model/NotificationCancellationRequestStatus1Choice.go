@@ -10,6 +10,10 @@ type NotificationCancellationRequestStatus1Choice struct {
 	RejectedStatus *NotificationCancellationRejectionReason1 `xml:"RjctdSts"`
 }
 
+func (n *NotificationCancellationRequestStatus1Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NotificationCancellationRequestStatus1Choice) AddProcessedStatus() *NotificationCancellationProcessingStatus1 {
 	n.ProcessedStatus = new(NotificationCancellationProcessingStatus1)
 	return n.ProcessedStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the elements of an entry in the report.
 type NotificationEntry1 struct {
 
@@ -75,18 +79,35 @@ type NotificationEntry1 struct {
 	AdditionalEntryInformation *Max500Text `xml:"AddtlNtryInf,omitempty"`
 }
 
+func (n *NotificationEntry1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NotificationEntry1) SetAmount(value, currency string) {
 	n.Amount = NewCurrencyAndAmount(value, currency)
 }
 
-func (n *NotificationEntry1) SetCreditDebitIndicator(value string) {
+func (n *NotificationEntry1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
+func (n *NotificationEntry1) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	n.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (n *NotificationEntry1) SetReversalIndicator(value string) {
 	n.ReversalIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (n *NotificationEntry1) SetReversalIndicatorFromBool(value bool) {
+	n.ReversalIndicator = new(TrueFalseIndicator)
+	n.ReversalIndicator.FromBool(value)
+}
+
 func (n *NotificationEntry1) SetStatus(value string) {
 	n.Status = (*EntryStatus4Code)(&value)
 }
@@ -260,6 +281,11 @@ func (n *NotificationEntry1) SetCommissionWaiverIndicator(value string) {
 	n.CommissionWaiverIndicator = (*YesNoIndicator)(&value)
 }
 
+func (n *NotificationEntry1) SetCommissionWaiverIndicatorFromBool(value bool) {
+	n.CommissionWaiverIndicator = new(YesNoIndicator)
+	n.CommissionWaiverIndicator.FromBool(value)
+}
+
 func (n *NotificationEntry1) AddAdditionalInformationIndicator() *MessageIdentification2 {
 	n.AdditionalInformationIndicator = new(MessageIdentification2)
 	return n.AdditionalInformationIndicator
@@ -10,6 +10,10 @@ type NotificationIdentification1 struct {
 	CreationDateTime *DateAndDateTimeChoice `xml:"CreDtTm,omitempty"`
 }
 
+func (n *NotificationIdentification1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NotificationIdentification1) SetIdentification(value string) {
 	n.Identification = (*Max35Text)(&value)
 }
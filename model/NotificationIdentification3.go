@@ -10,6 +10,10 @@ type NotificationIdentification3 struct {
 	CreationDateTime *DateAndDateTimeChoice `xml:"CreDtTm,omitempty"`
 }
 
+func (n *NotificationIdentification3) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NotificationIdentification3) SetIdentification(value string) {
 	n.Identification = (*Max35Text)(&value)
 }
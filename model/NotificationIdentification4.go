@@ -10,6 +10,10 @@ type NotificationIdentification4 struct {
 	CreationDateTime *DateAndDateTimeChoice `xml:"CreDtTm,omitempty"`
 }
 
+func (n *NotificationIdentification4) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NotificationIdentification4) SetIdentification(value string) {
 	n.Identification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further means of referencing a payment transaction.
 type NotificationItem5 struct {
 
@@ -49,6 +55,10 @@ type NotificationItem5 struct {
 	RemittanceInformation *RemittanceInformation10 `xml:"RmtInf,omitempty"`
 }
 
+func (n *NotificationItem5) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NotificationItem5) SetIdentification(value string) {
 	n.Identification = (*Max35Text)(&value)
 }
@@ -81,10 +91,19 @@ func (n *NotificationItem5) SetAmount(value, currency string) {
 	n.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (n *NotificationItem5) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	n.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (n *NotificationItem5) SetExpectedValueDate(value string) {
 	n.ExpectedValueDate = (*ISODate)(&value)
 }
 
+func (n *NotificationItem5) SetExpectedValueDateFromTime(value time.Time) {
+	n.ExpectedValueDate = new(ISODate)
+	n.ExpectedValueDate.FromTime(value)
+}
+
 func (n *NotificationItem5) AddDebtor() *Party12Choice {
 	n.Debtor = new(Party12Choice)
 	return n.Debtor
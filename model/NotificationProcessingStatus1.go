@@ -10,6 +10,10 @@ type NotificationProcessingStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (n *NotificationProcessingStatus1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NotificationProcessingStatus1) AddStatus() *ProcessedStatus1FormatChoice {
 	n.Status = new(ProcessedStatus1FormatChoice)
 	return n.Status
@@ -10,6 +10,10 @@ type NotificationRejectionReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (n *NotificationRejectionReason1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NotificationRejectionReason1) AddReason() *RejectionReason6FormatChoice {
 	newValue := new(RejectionReason6FormatChoice)
 	n.Reason = append(n.Reason, newValue)
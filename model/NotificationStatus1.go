@@ -7,6 +7,10 @@ type NotificationStatus1 struct {
 	Status *NotificationStatus2Code `xml:"Sts"`
 }
 
+func (n *NotificationStatus1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NotificationStatus1) SetStatus(value string) {
 	n.Status = (*NotificationStatus2Code)(&value)
 }
@@ -7,6 +7,10 @@ type NotificationStatus2 struct {
 	Status *NotificationStatus2Code `xml:"Sts"`
 }
 
+func (n *NotificationStatus2) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NotificationStatus2) SetStatus(value string) {
 	n.Status = (*NotificationStatus2Code)(&value)
 }
@@ -1,3 +1,19 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 type Number string
+
+func (n Number) Validate() error {
+	return validateDecimal("Number", string(n), 18, 0)
+}
+
+func (n Number) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(string(n))
+}
+
+func (n *Number) FromDecimal(value decimal.Decimal) {
+	*n = Number(value.String())
+}
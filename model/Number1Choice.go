@@ -10,6 +10,10 @@ type Number1Choice struct {
 	Proprietary *GenericIdentification7 `xml:"Prtry"`
 }
 
+func (n *Number1Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *Number1Choice) SetNumberIdentification(value string) {
 	n.NumberIdentification = (*Max3NumericText)(&value)
 }
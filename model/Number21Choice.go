@@ -10,6 +10,10 @@ type Number21Choice struct {
 	Proprietary *GenericIdentification29 `xml:"Prtry"`
 }
 
+func (n *Number21Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *Number21Choice) SetNumberIdentification(value string) {
 	n.NumberIdentification = (*Max4NumericText)(&value)
 }
@@ -10,6 +10,10 @@ type Number22Choice struct {
 	Long *GenericIdentification1 `xml:"Lng"`
 }
 
+func (n *Number22Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *Number22Choice) SetShort(value string) {
 	n.Short = (*Exact3NumericText)(&value)
 }
@@ -10,6 +10,10 @@ type Number23Choice struct {
 	Long *GenericIdentification18 `xml:"Lng"`
 }
 
+func (n *Number23Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *Number23Choice) SetShort(value string) {
 	n.Short = (*Exact3NumericText)(&value)
 }
@@ -10,6 +10,10 @@ type Number2Choice struct {
 	Long *GenericIdentification1 `xml:"Lng"`
 }
 
+func (n *Number2Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *Number2Choice) SetShort(value string) {
 	n.Short = (*Exact3NumericText)(&value)
 }
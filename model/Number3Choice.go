@@ -10,6 +10,10 @@ type Number3Choice struct {
 	Long *Exact5NumericText `xml:"Lng"`
 }
 
+func (n *Number3Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *Number3Choice) SetShort(value string) {
 	n.Short = (*Exact3NumericText)(&value)
 }
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of elements providing the total sum of entries.
 type NumberAndSumOfTransactions1 struct {
 
@@ -10,6 +15,10 @@ type NumberAndSumOfTransactions1 struct {
 	Sum *DecimalNumber `xml:"Sum,omitempty"`
 }
 
+func (n *NumberAndSumOfTransactions1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NumberAndSumOfTransactions1) SetNumberOfEntries(value string) {
 	n.NumberOfEntries = (*Max15NumericText)(&value)
 }
@@ -17,3 +26,49 @@ func (n *NumberAndSumOfTransactions1) SetNumberOfEntries(value string) {
 func (n *NumberAndSumOfTransactions1) SetSum(value string) {
 	n.Sum = (*DecimalNumber)(&value)
 }
+
+func (n *NumberAndSumOfTransactions1) SetSumFromDecimal(value decimal.Decimal) {
+	n.Sum = new(DecimalNumber)
+	n.Sum.FromDecimal(value)
+}
+
+func (n *NumberAndSumOfTransactions1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if n.NumberOfEntries != nil {
+		enc.Element("NbOfNtries", string(*n.NumberOfEntries))
+	}
+	if n.Sum != nil {
+		enc.Element("Sum", string(*n.Sum))
+	}
+	enc.End(tag)
+}
+
+func (n *NumberAndSumOfTransactions1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "NbOfNtries":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			n.NumberOfEntries = (*Max15NumericText)(&value)
+		case "Sum":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			n.Sum = (*DecimalNumber)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
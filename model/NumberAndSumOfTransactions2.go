@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements providing the total sum of entries.
 type NumberAndSumOfTransactions2 struct {
 
@@ -16,6 +20,10 @@ type NumberAndSumOfTransactions2 struct {
 	CreditDebitIndicator *CreditDebitCode `xml:"CdtDbtInd,omitempty"`
 }
 
+func (n *NumberAndSumOfTransactions2) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NumberAndSumOfTransactions2) SetNumberOfEntries(value string) {
 	n.NumberOfEntries = (*Max15NumericText)(&value)
 }
@@ -24,10 +32,24 @@ func (n *NumberAndSumOfTransactions2) SetSum(value string) {
 	n.Sum = (*DecimalNumber)(&value)
 }
 
+func (n *NumberAndSumOfTransactions2) SetSumFromDecimal(value decimal.Decimal) {
+	n.Sum = new(DecimalNumber)
+	n.Sum.FromDecimal(value)
+}
+
 func (n *NumberAndSumOfTransactions2) SetTotalNetEntryAmount(value string) {
 	n.TotalNetEntryAmount = (*DecimalNumber)(&value)
 }
 
-func (n *NumberAndSumOfTransactions2) SetCreditDebitIndicator(value string) {
+func (n *NumberAndSumOfTransactions2) SetTotalNetEntryAmountFromDecimal(value decimal.Decimal) {
+	n.TotalNetEntryAmount = new(DecimalNumber)
+	n.TotalNetEntryAmount.FromDecimal(value)
+}
+
+func (n *NumberAndSumOfTransactions2) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	n.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
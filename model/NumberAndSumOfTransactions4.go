@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of elements providing the total sum of entries.
 type NumberAndSumOfTransactions4 struct {
 
@@ -13,6 +18,10 @@ type NumberAndSumOfTransactions4 struct {
 	TotalNetEntry *AmountAndDirection35 `xml:"TtlNetNtry,omitempty"`
 }
 
+func (n *NumberAndSumOfTransactions4) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NumberAndSumOfTransactions4) SetNumberOfEntries(value string) {
 	n.NumberOfEntries = (*Max15NumericText)(&value)
 }
@@ -21,7 +30,62 @@ func (n *NumberAndSumOfTransactions4) SetSum(value string) {
 	n.Sum = (*DecimalNumber)(&value)
 }
 
+func (n *NumberAndSumOfTransactions4) SetSumFromDecimal(value decimal.Decimal) {
+	n.Sum = new(DecimalNumber)
+	n.Sum.FromDecimal(value)
+}
+
 func (n *NumberAndSumOfTransactions4) AddTotalNetEntry() *AmountAndDirection35 {
 	n.TotalNetEntry = new(AmountAndDirection35)
 	return n.TotalNetEntry
 }
+
+func (n *NumberAndSumOfTransactions4) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if n.NumberOfEntries != nil {
+		enc.Element("NbOfNtries", string(*n.NumberOfEntries))
+	}
+	if n.Sum != nil {
+		enc.Element("Sum", string(*n.Sum))
+	}
+	if n.TotalNetEntry != nil {
+		n.TotalNetEntry.EncodeXMLFast(enc, "TtlNetNtry")
+	}
+	enc.End(tag)
+}
+
+func (n *NumberAndSumOfTransactions4) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "NbOfNtries":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			n.NumberOfEntries = (*Max15NumericText)(&value)
+		case "Sum":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			n.Sum = (*DecimalNumber)(&value)
+		case "TtlNetNtry":
+			elem := new(AmountAndDirection35)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			n.TotalNetEntry = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
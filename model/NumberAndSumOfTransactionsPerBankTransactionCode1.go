@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements providing the total sum of entries per bank transaction code.
 type NumberAndSumOfTransactionsPerBankTransactionCode1 struct {
 
@@ -22,6 +26,10 @@ type NumberAndSumOfTransactionsPerBankTransactionCode1 struct {
 	Availability []*CashBalanceAvailability1 `xml:"Avlbty,omitempty"`
 }
 
+func (n *NumberAndSumOfTransactionsPerBankTransactionCode1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NumberAndSumOfTransactionsPerBankTransactionCode1) SetNumberOfEntries(value string) {
 	n.NumberOfEntries = (*Max15NumericText)(&value)
 }
@@ -30,12 +38,26 @@ func (n *NumberAndSumOfTransactionsPerBankTransactionCode1) SetSum(value string)
 	n.Sum = (*DecimalNumber)(&value)
 }
 
+func (n *NumberAndSumOfTransactionsPerBankTransactionCode1) SetSumFromDecimal(value decimal.Decimal) {
+	n.Sum = new(DecimalNumber)
+	n.Sum.FromDecimal(value)
+}
+
 func (n *NumberAndSumOfTransactionsPerBankTransactionCode1) SetTotalNetEntryAmount(value string) {
 	n.TotalNetEntryAmount = (*DecimalNumber)(&value)
 }
 
-func (n *NumberAndSumOfTransactionsPerBankTransactionCode1) SetCreditDebitIndicator(value string) {
+func (n *NumberAndSumOfTransactionsPerBankTransactionCode1) SetTotalNetEntryAmountFromDecimal(value decimal.Decimal) {
+	n.TotalNetEntryAmount = new(DecimalNumber)
+	n.TotalNetEntryAmount.FromDecimal(value)
+}
+
+func (n *NumberAndSumOfTransactionsPerBankTransactionCode1) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	n.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (n *NumberAndSumOfTransactionsPerBankTransactionCode1) AddBankTransactionCode() *BankTransactionCodeStructure1 {
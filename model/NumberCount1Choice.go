@@ -10,6 +10,10 @@ type NumberCount1Choice struct {
 	TotalNumber *TotalNumber1 `xml:"TtlNb"`
 }
 
+func (n *NumberCount1Choice) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NumberCount1Choice) SetCurrentInstructionNumber(value string) {
 	n.CurrentInstructionNumber = (*Exact3NumericText)(&value)
 }
@@ -10,6 +10,10 @@ type NumberModification1 struct {
 	Number *Max5NumericText `xml:"Nb"`
 }
 
+func (n *NumberModification1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NumberModification1) SetModificationCode(value string) {
 	n.ModificationCode = (*Modification1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide detailed information on the number of transactions that are reported with a specific cancellation status.
 type NumberOfCancellationsPerStatus1 struct {
 
@@ -13,14 +17,27 @@ type NumberOfCancellationsPerStatus1 struct {
 	DetailedControlSum *DecimalNumber `xml:"DtldCtrlSum,omitempty"`
 }
 
+func (n *NumberOfCancellationsPerStatus1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NumberOfCancellationsPerStatus1) SetDetailedNumberOfTransactions(value string) {
 	n.DetailedNumberOfTransactions = (*Max15NumericText)(&value)
 }
 
-func (n *NumberOfCancellationsPerStatus1) SetDetailedStatus(value string) {
+func (n *NumberOfCancellationsPerStatus1) SetDetailedStatus(value string) error {
+	if err := CancellationIndividualStatus1Code(value).Validate(); err != nil {
+		return err
+	}
 	n.DetailedStatus = (*CancellationIndividualStatus1Code)(&value)
+	return nil
 }
 
 func (n *NumberOfCancellationsPerStatus1) SetDetailedControlSum(value string) {
 	n.DetailedControlSum = (*DecimalNumber)(&value)
 }
+
+func (n *NumberOfCancellationsPerStatus1) SetDetailedControlSumFromDecimal(value decimal.Decimal) {
+	n.DetailedControlSum = new(DecimalNumber)
+	n.DetailedControlSum.FromDecimal(value)
+}
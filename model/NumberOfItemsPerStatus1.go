@@ -10,6 +10,10 @@ type NumberOfItemsPerStatus1 struct {
 	NumberOfItems *Max15NumericText `xml:"NbOfItms"`
 }
 
+func (n *NumberOfItemsPerStatus1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NumberOfItemsPerStatus1) SetStatus(value string) {
 	n.Status = (*ReportItemStatus1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of additional elements to provide detailed information on the number of transactions that are reported with a specific transaction status.
 type NumberOfTransactionsPerStatus1 struct {
 
@@ -13,6 +17,10 @@ type NumberOfTransactionsPerStatus1 struct {
 	DetailedControlSum *DecimalNumber `xml:"DtldCtrlSum,omitempty"`
 }
 
+func (n *NumberOfTransactionsPerStatus1) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NumberOfTransactionsPerStatus1) SetDetailedNumberOfTransactions(value string) {
 	n.DetailedNumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -24,3 +32,8 @@ func (n *NumberOfTransactionsPerStatus1) SetDetailedStatus(value string) {
 func (n *NumberOfTransactionsPerStatus1) SetDetailedControlSum(value string) {
 	n.DetailedControlSum = (*DecimalNumber)(&value)
 }
+
+func (n *NumberOfTransactionsPerStatus1) SetDetailedControlSumFromDecimal(value decimal.Decimal) {
+	n.DetailedControlSum = new(DecimalNumber)
+	n.DetailedControlSum.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide detailed information on the number of transactions that are reported with a specific transaction status.
 type NumberOfTransactionsPerStatus3 struct {
 
@@ -13,14 +17,27 @@ type NumberOfTransactionsPerStatus3 struct {
 	DetailedControlSum *DecimalNumber `xml:"DtldCtrlSum,omitempty"`
 }
 
+func (n *NumberOfTransactionsPerStatus3) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NumberOfTransactionsPerStatus3) SetDetailedNumberOfTransactions(value string) {
 	n.DetailedNumberOfTransactions = (*Max15NumericText)(&value)
 }
 
-func (n *NumberOfTransactionsPerStatus3) SetDetailedStatus(value string) {
+func (n *NumberOfTransactionsPerStatus3) SetDetailedStatus(value string) error {
+	if err := TransactionIndividualStatus3Code(value).Validate(); err != nil {
+		return err
+	}
 	n.DetailedStatus = (*TransactionIndividualStatus3Code)(&value)
+	return nil
 }
 
 func (n *NumberOfTransactionsPerStatus3) SetDetailedControlSum(value string) {
 	n.DetailedControlSum = (*DecimalNumber)(&value)
 }
+
+func (n *NumberOfTransactionsPerStatus3) SetDetailedControlSumFromDecimal(value decimal.Decimal) {
+	n.DetailedControlSum = new(DecimalNumber)
+	n.DetailedControlSum.FromDecimal(value)
+}
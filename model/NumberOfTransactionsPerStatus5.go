@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of elements used to provide detailed information on the number of transactions that are reported with a specific transaction status.
 type NumberOfTransactionsPerStatus5 struct {
 
@@ -13,6 +18,10 @@ type NumberOfTransactionsPerStatus5 struct {
 	DetailedControlSum *DecimalNumber `xml:"DtldCtrlSum,omitempty"`
 }
 
+func (n *NumberOfTransactionsPerStatus5) Validate() error {
+	return ValidateElement(n)
+}
+
 func (n *NumberOfTransactionsPerStatus5) SetDetailedNumberOfTransactions(value string) {
 	n.DetailedNumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -24,3 +33,58 @@ func (n *NumberOfTransactionsPerStatus5) SetDetailedStatus(value string) {
 func (n *NumberOfTransactionsPerStatus5) SetDetailedControlSum(value string) {
 	n.DetailedControlSum = (*DecimalNumber)(&value)
 }
+
+func (n *NumberOfTransactionsPerStatus5) SetDetailedControlSumFromDecimal(value decimal.Decimal) {
+	n.DetailedControlSum = new(DecimalNumber)
+	n.DetailedControlSum.FromDecimal(value)
+}
+
+func (n *NumberOfTransactionsPerStatus5) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if n.DetailedNumberOfTransactions != nil {
+		enc.Element("DtldNbOfTxs", string(*n.DetailedNumberOfTransactions))
+	}
+	if n.DetailedStatus != nil {
+		enc.Element("DtldSts", string(*n.DetailedStatus))
+	}
+	if n.DetailedControlSum != nil {
+		enc.Element("DtldCtrlSum", string(*n.DetailedControlSum))
+	}
+	enc.End(tag)
+}
+
+func (n *NumberOfTransactionsPerStatus5) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "DtldNbOfTxs":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			n.DetailedNumberOfTransactions = (*Max15NumericText)(&value)
+		case "DtldSts":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			n.DetailedStatus = (*ExternalPaymentTransactionStatus1Code)(&value)
+		case "DtldCtrlSum":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			n.DetailedControlSum = (*DecimalNumber)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
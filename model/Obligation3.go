@@ -25,6 +25,10 @@ type Obligation3 struct {
 	ValuationDate *DateAndDateTimeChoice `xml:"ValtnDt"`
 }
 
+func (o *Obligation3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Obligation3) AddPartyA() *PartyIdentification33Choice {
 	o.PartyA = new(PartyIdentification33Choice)
 	return o.PartyA
@@ -25,6 +25,10 @@ type Obligation4 struct {
 	ValuationDate *DateAndDateTimeChoice `xml:"ValtnDt"`
 }
 
+func (o *Obligation4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Obligation4) AddPartyA() *PartyIdentification100Choice {
 	o.PartyA = new(PartyIdentification100Choice)
 	return o.PartyA
@@ -25,6 +25,10 @@ type Obligation5 struct {
 	ValuationDate *DateAndDateTimeChoice `xml:"ValtnDt"`
 }
 
+func (o *Obligation5) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Obligation5) AddPartyA() *PartyIdentification100Choice {
 	o.PartyA = new(PartyIdentification100Choice)
 	return o.PartyA
@@ -10,6 +10,10 @@ type ObligationType1Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (o *ObligationType1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *ObligationType1Choice) SetCode(value string) {
 	o.Code = (*ObligationType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type OffMarket1Choice struct {
 	SystematicInternaliser *AnyBICIdentifier `xml:"SystmtcIntlr"`
 }
 
+func (o *OffMarket1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OffMarket1Choice) SetOffMarketIndicator(value string) {
 	o.OffMarketIndicator = (*OffMarket1Code)(&value)
 }
@@ -10,6 +10,10 @@ type OfferType1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (o *OfferType1FormatChoice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OfferType1FormatChoice) SetCode(value string) {
 	o.Code = (*OfferType1Code)(&value)
 }
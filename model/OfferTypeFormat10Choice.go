@@ -10,6 +10,10 @@ type OfferTypeFormat10Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (o *OfferTypeFormat10Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OfferTypeFormat10Choice) SetCode(value string) {
 	o.Code = (*OfferType3Code)(&value)
 }
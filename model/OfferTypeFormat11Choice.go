@@ -10,6 +10,10 @@ type OfferTypeFormat11Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OfferTypeFormat11Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OfferTypeFormat11Choice) SetCode(value string) {
 	o.Code = (*OfferType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type OfferTypeFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (o *OfferTypeFormat1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OfferTypeFormat1Choice) SetCode(value string) {
 	o.Code = (*OfferType1Code)(&value)
 }
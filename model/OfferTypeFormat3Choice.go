@@ -10,6 +10,10 @@ type OfferTypeFormat3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (o *OfferTypeFormat3Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OfferTypeFormat3Choice) SetCode(value string) {
 	o.Code = (*OfferType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type OfferTypeFormat5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (o *OfferTypeFormat5Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OfferTypeFormat5Choice) SetCode(value string) {
 	o.Code = (*OfferType3Code)(&value)
 }
@@ -13,6 +13,10 @@ type OnLinePIN1 struct {
 	AdditionalInput *Max35Text `xml:"AddtlInpt,omitempty"`
 }
 
+func (o *OnLinePIN1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OnLinePIN1) AddEncryptedPINBlock() *ContentInformationType2 {
 	o.EncryptedPINBlock = new(ContentInformationType2)
 	return o.EncryptedPINBlock
@@ -13,6 +13,10 @@ type OnLinePIN2 struct {
 	AdditionalInput *Max35Text `xml:"AddtlInpt,omitempty"`
 }
 
+func (o *OnLinePIN2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OnLinePIN2) AddEncryptedPINBlock() *ContentInformationType5 {
 	o.EncryptedPINBlock = new(ContentInformationType5)
 	return o.EncryptedPINBlock
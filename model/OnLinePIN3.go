@@ -13,6 +13,10 @@ type OnLinePIN3 struct {
 	AdditionalInput *Max35Text `xml:"AddtlInpt,omitempty"`
 }
 
+func (o *OnLinePIN3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OnLinePIN3) AddEncryptedPINBlock() *ContentInformationType7 {
 	o.EncryptedPINBlock = new(ContentInformationType7)
 	return o.EncryptedPINBlock
@@ -13,6 +13,10 @@ type OnLinePIN4 struct {
 	AdditionalInput *Max35Text `xml:"AddtlInpt,omitempty"`
 }
 
+func (o *OnLinePIN4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OnLinePIN4) AddEncryptedPINBlock() *ContentInformationType10 {
 	o.EncryptedPINBlock = new(ContentInformationType10)
 	return o.EncryptedPINBlock
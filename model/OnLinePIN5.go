@@ -13,6 +13,10 @@ type OnLinePIN5 struct {
 	AdditionalInput *Max35Text `xml:"AddtlInpt,omitempty"`
 }
 
+func (o *OnLinePIN5) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OnLinePIN5) AddEncryptedPINBlock() *ContentInformationType10 {
 	o.EncryptedPINBlock = new(ContentInformationType10)
 	return o.EncryptedPINBlock
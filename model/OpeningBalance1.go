@@ -10,6 +10,10 @@ type OpeningBalance1 struct {
 	OpeningBalance *OpeningBalance1Choice `xml:"OpngBal"`
 }
 
+func (o *OpeningBalance1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OpeningBalance1) SetShortLongIndicator(value string) {
 	o.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
@@ -10,6 +10,10 @@ type OpeningBalance1Choice struct {
 	Intermediary *BalanceQuantity5Choice `xml:"Intrmy"`
 }
 
+func (o *OpeningBalance1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OpeningBalance1Choice) AddFirst() *BalanceQuantity5Choice {
 	o.First = new(BalanceQuantity5Choice)
 	return o.First
@@ -10,6 +10,10 @@ type OpeningBalance3 struct {
 	OpeningBalance *OpeningBalance4Choice `xml:"OpngBal"`
 }
 
+func (o *OpeningBalance3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OpeningBalance3) SetShortLongIndicator(value string) {
 	o.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
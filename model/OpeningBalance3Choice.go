@@ -10,6 +10,10 @@ type OpeningBalance3Choice struct {
 	IntermediaryOpeningBalance *FinancialInstrumentQuantity1 `xml:"IntrmyOpngBal"`
 }
 
+func (o *OpeningBalance3Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OpeningBalance3Choice) AddFirstOpeningBalance() *FinancialInstrumentQuantity1 {
 	o.FirstOpeningBalance = new(FinancialInstrumentQuantity1)
 	return o.FirstOpeningBalance
@@ -10,6 +10,10 @@ type OpeningBalance4 struct {
 	OpeningBalance *OpeningBalance5Choice `xml:"OpngBal"`
 }
 
+func (o *OpeningBalance4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OpeningBalance4) SetShortLongIndicator(value string) {
 	o.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
@@ -10,6 +10,10 @@ type OpeningBalance4Choice struct {
 	Intermediary *BalanceQuantity8Choice `xml:"Intrmy"`
 }
 
+func (o *OpeningBalance4Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OpeningBalance4Choice) AddFirst() *BalanceQuantity8Choice {
 	o.First = new(BalanceQuantity8Choice)
 	return o.First
@@ -10,6 +10,10 @@ type OpeningBalance5Choice struct {
 	Intermediary *BalanceQuantity12Choice `xml:"Intrmy"`
 }
 
+func (o *OpeningBalance5Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OpeningBalance5Choice) AddFirst() *BalanceQuantity12Choice {
 	o.First = new(BalanceQuantity12Choice)
 	return o.First
@@ -10,6 +10,10 @@ type OpeningClosing1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (o *OpeningClosing1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OpeningClosing1Choice) SetCode(value string) {
 	o.Code = (*OpeningClosing1Code)(&value)
 }
@@ -10,6 +10,10 @@ type OpeningClosing3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (o *OpeningClosing3Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OpeningClosing3Choice) SetCode(value string) {
 	o.Code = (*OpeningClosing1Code)(&value)
 }
@@ -10,6 +10,10 @@ type OpeningClosing4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OpeningClosing4Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OpeningClosing4Choice) SetCode(value string) {
 	o.Code = (*OpeningClosing1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the conditions for the NDF opening.
 type OpeningConditions1 struct {
 
@@ -13,6 +17,10 @@ type OpeningConditions1 struct {
 	SettlementRateSource []*SettlementRateSource1 `xml:"SttlmRateSrc"`
 }
 
+func (o *OpeningConditions1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OpeningConditions1) SetSettlementCurrency(value string) {
 	o.SettlementCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -21,6 +29,11 @@ func (o *OpeningConditions1) SetValuationDate(value string) {
 	o.ValuationDate = (*ISODate)(&value)
 }
 
+func (o *OpeningConditions1) SetValuationDateFromTime(value time.Time) {
+	o.ValuationDate = new(ISODate)
+	o.ValuationDate.FromTime(value)
+}
+
 func (o *OpeningConditions1) AddSettlementRateSource() *SettlementRateSource1 {
 	newValue := new(SettlementRateSource1)
 	o.SettlementRateSource = append(o.SettlementRateSource, newValue)
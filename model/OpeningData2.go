@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // List of elements which specify the opening of a non deliverable trade.
 type OpeningData2 struct {
 
@@ -28,10 +32,19 @@ type OpeningData2 struct {
 	ValuationConditions *NonDeliverableForwardValuationConditions2 `xml:"ValtnConds"`
 }
 
+func (o *OpeningData2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OpeningData2) SetTradeDate(value string) {
 	o.TradeDate = (*ISODate)(&value)
 }
 
+func (o *OpeningData2) SetTradeDateFromTime(value time.Time) {
+	o.TradeDate = new(ISODate)
+	o.TradeDate.FromTime(value)
+}
+
 func (o *OpeningData2) SetNotificationIdentification(value string) {
 	o.NotificationIdentification = (*Max35Text)(&value)
 }
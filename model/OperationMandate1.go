@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information specifying the Mandate.
 type OperationMandate1 struct {
 
@@ -25,6 +29,10 @@ type OperationMandate1 struct {
 	EndDate *ISODate `xml:"EndDt,omitempty"`
 }
 
+func (o *OperationMandate1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OperationMandate1) SetIdentification(value string) {
 	o.Identification = (*Max35Text)(&value)
 }
@@ -37,6 +45,11 @@ func (o *OperationMandate1) SetSignatureOrderIndicator(value string) {
 	o.SignatureOrderIndicator = (*YesNoIndicator)(&value)
 }
 
+func (o *OperationMandate1) SetSignatureOrderIndicatorFromBool(value bool) {
+	o.SignatureOrderIndicator = new(YesNoIndicator)
+	o.SignatureOrderIndicator.FromBool(value)
+}
+
 func (o *OperationMandate1) AddMandateHolder() *PartyAndCertificate1 {
 	newValue := new(PartyAndCertificate1)
 	o.MandateHolder = append(o.MandateHolder, newValue)
@@ -333,6 +346,16 @@ func (o *OperationMandate1) SetStartDate(value string) {
 	o.StartDate = (*ISODate)(&value)
 }
 
+func (o *OperationMandate1) SetStartDateFromTime(value time.Time) {
+	o.StartDate = new(ISODate)
+	o.StartDate.FromTime(value)
+}
+
 func (o *OperationMandate1) SetEndDate(value string) {
 	o.EndDate = (*ISODate)(&value)
 }
+
+func (o *OperationMandate1) SetEndDateFromTime(value time.Time) {
+	o.EndDate = new(ISODate)
+	o.EndDate.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information specifying the Mandate.
 type OperationMandate2 struct {
 
@@ -28,6 +32,10 @@ type OperationMandate2 struct {
 	EndDate *ISODate `xml:"EndDt,omitempty"`
 }
 
+func (o *OperationMandate2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OperationMandate2) SetIdentification(value string) {
 	o.Identification = (*Max35Text)(&value)
 }
@@ -186,6 +194,11 @@ func (o *OperationMandate2) SetSignatureOrderIndicator(value string) {
 	o.SignatureOrderIndicator = (*YesNoIndicator)(&value)
 }
 
+func (o *OperationMandate2) SetSignatureOrderIndicatorFromBool(value bool) {
+	o.SignatureOrderIndicator = new(YesNoIndicator)
+	o.SignatureOrderIndicator.FromBool(value)
+}
+
 func (o *OperationMandate2) AddMandateHolder() *PartyAndAuthorisation1 {
 	newValue := new(PartyAndAuthorisation1)
 	o.MandateHolder = append(o.MandateHolder, newValue)
@@ -482,6 +495,16 @@ func (o *OperationMandate2) SetStartDate(value string) {
 	o.StartDate = (*ISODate)(&value)
 }
 
+func (o *OperationMandate2) SetStartDateFromTime(value time.Time) {
+	o.StartDate = new(ISODate)
+	o.StartDate.FromTime(value)
+}
+
 func (o *OperationMandate2) SetEndDate(value string) {
 	o.EndDate = (*ISODate)(&value)
 }
+
+func (o *OperationMandate2) SetEndDateFromTime(value time.Time) {
+	o.EndDate = new(ISODate)
+	o.EndDate.FromTime(value)
+}
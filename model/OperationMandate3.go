@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information specifying the Mandate.
 type OperationMandate3 struct {
 
@@ -31,6 +35,10 @@ type OperationMandate3 struct {
 	EndDate *ISODate `xml:"EndDt,omitempty"`
 }
 
+func (o *OperationMandate3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OperationMandate3) SetModificationCode(value string) {
 	o.ModificationCode = (*Modification1Code)(&value)
 }
@@ -193,6 +201,11 @@ func (o *OperationMandate3) SetSignatureOrderIndicator(value string) {
 	o.SignatureOrderIndicator = (*YesNoIndicator)(&value)
 }
 
+func (o *OperationMandate3) SetSignatureOrderIndicatorFromBool(value bool) {
+	o.SignatureOrderIndicator = new(YesNoIndicator)
+	o.SignatureOrderIndicator.FromBool(value)
+}
+
 func (o *OperationMandate3) AddMandateHolder() *PartyAndAuthorisation3 {
 	newValue := new(PartyAndAuthorisation3)
 	o.MandateHolder = append(o.MandateHolder, newValue)
@@ -489,6 +502,16 @@ func (o *OperationMandate3) SetStartDate(value string) {
 	o.StartDate = (*ISODate)(&value)
 }
 
+func (o *OperationMandate3) SetStartDateFromTime(value time.Time) {
+	o.StartDate = new(ISODate)
+	o.StartDate.FromTime(value)
+}
+
 func (o *OperationMandate3) SetEndDate(value string) {
 	o.EndDate = (*ISODate)(&value)
 }
+
+func (o *OperationMandate3) SetEndDateFromTime(value time.Time) {
+	o.EndDate = new(ISODate)
+	o.EndDate.FromTime(value)
+}
@@ -10,6 +10,10 @@ type Option12 struct {
 	DateOrPeriod *OptionDateOrPeriod1Choice `xml:"DtOrPrd"`
 }
 
+func (o *Option12) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Option12) SetType(value string) {
 	o.Type = (*OptionType1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // List of elements which provide the parameters of an option trade.
 type Option3 struct {
 
@@ -31,6 +35,10 @@ type Option3 struct {
 	Premium *PremiumAmount2 `xml:"Prm"`
 }
 
+func (o *Option3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Option3) AddOptionAmounts() *AmountsAndValueDate2 {
 	o.OptionAmounts = new(AmountsAndValueDate2)
 	return o.OptionAmounts
@@ -49,10 +57,20 @@ func (o *Option3) SetEarliestExerciseDate(value string) {
 	o.EarliestExerciseDate = (*ISODate)(&value)
 }
 
+func (o *Option3) SetEarliestExerciseDateFromTime(value time.Time) {
+	o.EarliestExerciseDate = new(ISODate)
+	o.EarliestExerciseDate.FromTime(value)
+}
+
 func (o *Option3) SetExpiryDateAndTime(value string) {
 	o.ExpiryDateAndTime = (*ISODateTime)(&value)
 }
 
+func (o *Option3) SetExpiryDateAndTimeFromTime(value time.Time) {
+	o.ExpiryDateAndTime = new(ISODateTime)
+	o.ExpiryDateAndTime.FromTime(value)
+}
+
 func (o *Option3) SetExpiryLocation(value string) {
 	o.ExpiryLocation = (*Max4AlphaNumericText)(&value)
 }
@@ -10,6 +10,10 @@ type OptionAvailabilityStatus1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (o *OptionAvailabilityStatus1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionAvailabilityStatus1Choice) SetCode(value string) {
 	o.Code = (*OptionAvailabilityStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionAvailabilityStatus3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (o *OptionAvailabilityStatus3Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionAvailabilityStatus3Choice) SetCode(value string) {
 	o.Code = (*OptionAvailabilityStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionAvailabilityStatus4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OptionAvailabilityStatus4Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionAvailabilityStatus4Choice) SetCode(value string) {
 	o.Code = (*OptionAvailabilityStatus1Code)(&value)
 }
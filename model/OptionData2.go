@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of data which contains the link to a previously notified option trade.
 type OptionData2 struct {
 
@@ -22,10 +26,19 @@ type OptionData2 struct {
 	Option *Option3 `xml:"Optn"`
 }
 
+func (o *OptionData2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionData2) SetTradeDate(value string) {
 	o.TradeDate = (*ISODate)(&value)
 }
 
+func (o *OptionData2) SetTradeDateFromTime(value time.Time) {
+	o.TradeDate = new(ISODate)
+	o.TradeDate.FromTime(value)
+}
+
 func (o *OptionData2) SetNotificationIdentification(value string) {
 	o.NotificationIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the exercise date or notice period for a call/put option.
 type OptionDateOrPeriod1Choice struct {
 
@@ -10,10 +16,24 @@ type OptionDateOrPeriod1Choice struct {
 	NoticePeriod *Number `xml:"NtcePrd"`
 }
 
+func (o *OptionDateOrPeriod1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionDateOrPeriod1Choice) SetEarliestExerciseDate(value string) {
 	o.EarliestExerciseDate = (*ISODate)(&value)
 }
 
+func (o *OptionDateOrPeriod1Choice) SetEarliestExerciseDateFromTime(value time.Time) {
+	o.EarliestExerciseDate = new(ISODate)
+	o.EarliestExerciseDate.FromTime(value)
+}
+
 func (o *OptionDateOrPeriod1Choice) SetNoticePeriod(value string) {
 	o.NoticePeriod = (*Number)(&value)
 }
+
+func (o *OptionDateOrPeriod1Choice) SetNoticePeriodFromDecimal(value decimal.Decimal) {
+	o.NoticePeriod = new(Number)
+	o.NoticePeriod.FromDecimal(value)
+}
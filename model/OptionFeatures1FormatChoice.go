@@ -10,6 +10,10 @@ type OptionFeatures1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (o *OptionFeatures1FormatChoice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionFeatures1FormatChoice) SetCode(value string) {
 	o.Code = (*OptionFeatures1Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionFeaturesFormat12Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (o *OptionFeaturesFormat12Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionFeaturesFormat12Choice) SetCode(value string) {
 	o.Code = (*OptionFeatures5Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionFeaturesFormat16Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (o *OptionFeaturesFormat16Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionFeaturesFormat16Choice) SetCode(value string) {
 	o.Code = (*OptionFeatures8Code)(&value)
 }
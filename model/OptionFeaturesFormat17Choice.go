@@ -10,6 +10,10 @@ type OptionFeaturesFormat17Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (o *OptionFeaturesFormat17Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionFeaturesFormat17Choice) SetCode(value string) {
 	o.Code = (*OptionFeatures7Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionFeaturesFormat18Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (o *OptionFeaturesFormat18Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionFeaturesFormat18Choice) SetCode(value string) {
 	o.Code = (*OptionFeatures6Code)(&value)
 }
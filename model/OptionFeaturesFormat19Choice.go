@@ -10,6 +10,10 @@ type OptionFeaturesFormat19Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OptionFeaturesFormat19Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionFeaturesFormat19Choice) SetCode(value string) {
 	o.Code = (*OptionFeatures6Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionFeaturesFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (o *OptionFeaturesFormat1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionFeaturesFormat1Choice) SetCode(value string) {
 	o.Code = (*OptionFeatures1Code)(&value)
 }
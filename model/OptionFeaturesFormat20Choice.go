@@ -10,6 +10,10 @@ type OptionFeaturesFormat20Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OptionFeaturesFormat20Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionFeaturesFormat20Choice) SetCode(value string) {
 	o.Code = (*OptionFeatures7Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionFeaturesFormat21Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OptionFeaturesFormat21Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionFeaturesFormat21Choice) SetCode(value string) {
 	o.Code = (*OptionFeatures8Code)(&value)
 }
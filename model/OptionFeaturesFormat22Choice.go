@@ -10,6 +10,10 @@ type OptionFeaturesFormat22Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (o *OptionFeaturesFormat22Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionFeaturesFormat22Choice) SetCode(value string) {
 	o.Code = (*OptionFeatures10Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionFeaturesFormat23Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OptionFeaturesFormat23Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionFeaturesFormat23Choice) SetCode(value string) {
 	o.Code = (*OptionFeatures10Code)(&value)
 }
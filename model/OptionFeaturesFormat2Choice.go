@@ -10,6 +10,10 @@ type OptionFeaturesFormat2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (o *OptionFeaturesFormat2Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionFeaturesFormat2Choice) SetCode(value string) {
 	o.Code = (*OptionFeatures2Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionFeaturesFormat5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (o *OptionFeaturesFormat5Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionFeaturesFormat5Choice) SetCode(value string) {
 	o.Code = (*OptionFeatures3Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionFeaturesFormat6Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (o *OptionFeaturesFormat6Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionFeaturesFormat6Choice) SetCode(value string) {
 	o.Code = (*OptionFeatures3Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionFeaturesFormat9Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (o *OptionFeaturesFormat9Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionFeaturesFormat9Choice) SetCode(value string) {
 	o.Code = (*OptionFeatures4Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionNumber1Choice struct {
 	Code *OptionNumber1Code `xml:"Cd"`
 }
 
+func (o *OptionNumber1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionNumber1Choice) SetNumber(value string) {
 	o.Number = (*Exact3NumericText)(&value)
 }
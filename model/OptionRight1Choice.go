@@ -10,6 +10,10 @@ type OptionRight1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (o *OptionRight1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionRight1Choice) SetCode(value string) {
 	o.Code = (*OptionRight1Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionStyle4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (o *OptionStyle4Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionStyle4Choice) SetCode(value string) {
 	o.Code = (*OptionStyle2Code)(&value)
 }
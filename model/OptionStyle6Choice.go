@@ -10,6 +10,10 @@ type OptionStyle6Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (o *OptionStyle6Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionStyle6Choice) SetCode(value string) {
 	o.Code = (*OptionStyle4Code)(&value)
 }
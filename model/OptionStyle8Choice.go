@@ -10,6 +10,10 @@ type OptionStyle8Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (o *OptionStyle8Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionStyle8Choice) SetCode(value string) {
 	o.Code = (*OptionStyle2Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionStyle9Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OptionStyle9Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionStyle9Choice) SetCode(value string) {
 	o.Code = (*OptionStyle2Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionType2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (o *OptionType2Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionType2Choice) SetCode(value string) {
 	o.Code = (*OptionType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionType4Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (o *OptionType4Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionType4Choice) SetCode(value string) {
 	o.Code = (*OptionType1Code)(&value)
 }
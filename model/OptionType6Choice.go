@@ -10,6 +10,10 @@ type OptionType6Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (o *OptionType6Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionType6Choice) SetCode(value string) {
 	o.Code = (*OptionType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type OptionType7Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OptionType7Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OptionType7Choice) SetCode(value string) {
 	o.Code = (*OptionType1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Intention to transfer an ownership of a financial instrument.
 type Order16 struct {
 
@@ -137,6 +143,10 @@ type Order16 struct {
 	AccruedInterestAmount *AmountAndDirection29 `xml:"AcrdIntrstAmt,omitempty"`
 }
 
+func (o *Order16) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Order16) AddBusinessProcessType() *BusinessProcessType1Choice {
 	o.BusinessProcessType = new(BusinessProcessType1Choice)
 	return o.BusinessProcessType
@@ -167,8 +177,12 @@ func (o *Order16) SetSide(value string) {
 	o.Side = (*Side3Code)(&value)
 }
 
-func (o *Order16) SetPayment(value string) {
+func (o *Order16) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	o.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (o *Order16) AddTradeTransactionType() *TradeType3Choice {
@@ -326,6 +340,11 @@ func (o *Order16) SetPreAdvice(value string) {
 	o.PreAdvice = (*YesNoIndicator)(&value)
 }
 
+func (o *Order16) SetPreAdviceFromBool(value bool) {
+	o.PreAdvice = new(YesNoIndicator)
+	o.PreAdvice.FromBool(value)
+}
+
 func (o *Order16) AddPlaceOfTrade() *MarketIdentification77 {
 	o.PlaceOfTrade = new(MarketIdentification77)
 	return o.PlaceOfTrade
@@ -335,10 +354,20 @@ func (o *Order16) SetOrderBookingDate(value string) {
 	o.OrderBookingDate = (*ISODate)(&value)
 }
 
+func (o *Order16) SetOrderBookingDateFromTime(value time.Time) {
+	o.OrderBookingDate = new(ISODate)
+	o.OrderBookingDate.FromTime(value)
+}
+
 func (o *Order16) SetTradeOriginationDate(value string) {
 	o.TradeOriginationDate = (*ISODateTime)(&value)
 }
 
+func (o *Order16) SetTradeOriginationDateFromTime(value time.Time) {
+	o.TradeOriginationDate = new(ISODateTime)
+	o.TradeOriginationDate.FromTime(value)
+}
+
 func (o *Order16) AddTradeDate() *TradeDate4Choice {
 	o.TradeDate = new(TradeDate4Choice)
 	return o.TradeDate
@@ -684,10 +713,20 @@ func (o *Order16) SetNumberOfDaysAccrued(value string) {
 	o.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (o *Order16) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	o.NumberOfDaysAccrued = new(Max3Number)
+	o.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (o *Order16) SetGiveUpNumberOfDays(value string) {
 	o.GiveUpNumberOfDays = (*Max3Number)(&value)
 }
 
+func (o *Order16) SetGiveUpNumberOfDaysFromDecimal(value decimal.Decimal) {
+	o.GiveUpNumberOfDays = new(Max3Number)
+	o.GiveUpNumberOfDays.FromDecimal(value)
+}
+
 func (o *Order16) SetInterestType(value string) {
 	o.InterestType = (*InterestType2Code)(&value)
 }
@@ -696,6 +735,11 @@ func (o *Order16) SetAccruedInterestPercentage(value string) {
 	o.AccruedInterestPercentage = (*PercentageRate)(&value)
 }
 
+func (o *Order16) SetAccruedInterestPercentageFromDecimal(value decimal.Decimal) {
+	o.AccruedInterestPercentage = new(PercentageRate)
+	o.AccruedInterestPercentage.FromDecimal(value)
+}
+
 func (o *Order16) SetTradeRegulatoryConditionsType(value string) {
 	o.TradeRegulatoryConditionsType = (*TradeRegulatoryConditions1Code)(&value)
 }
@@ -717,6 +761,11 @@ func (o *Order16) SetDerivativeCovered(value string) {
 	o.DerivativeCovered = (*YesNoIndicator)(&value)
 }
 
+func (o *Order16) SetDerivativeCoveredFromBool(value bool) {
+	o.DerivativeCovered = new(YesNoIndicator)
+	o.DerivativeCovered.FromBool(value)
+}
+
 func (o *Order16) AddChargeTaxBasisType() *ChargeTaxBasisType1Choice {
 	o.ChargeTaxBasisType = new(ChargeTaxBasisType1Choice)
 	return o.ChargeTaxBasisType
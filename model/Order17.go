@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Intention to transfer an ownership of a financial instrument.
 type Order17 struct {
 
@@ -134,6 +140,10 @@ type Order17 struct {
 	AdditionalTradeInstructionProcessingInformation *Max350Text `xml:"AddtlTradInstrPrcgInf,omitempty"`
 }
 
+func (o *Order17) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Order17) AddBusinessProcessType() *BusinessProcessType1Choice {
 	o.BusinessProcessType = new(BusinessProcessType1Choice)
 	return o.BusinessProcessType
@@ -159,8 +169,12 @@ func (o *Order17) SetSide(value string) {
 	o.Side = (*Side3Code)(&value)
 }
 
-func (o *Order17) SetPayment(value string) {
+func (o *Order17) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	o.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (o *Order17) AddTradeTransactionType() *TradeType3Choice {
@@ -318,6 +332,11 @@ func (o *Order17) SetPreAdvice(value string) {
 	o.PreAdvice = (*YesNoIndicator)(&value)
 }
 
+func (o *Order17) SetPreAdviceFromBool(value bool) {
+	o.PreAdvice = new(YesNoIndicator)
+	o.PreAdvice.FromBool(value)
+}
+
 func (o *Order17) AddPlaceOfTrade() *MarketIdentification79 {
 	o.PlaceOfTrade = new(MarketIdentification79)
 	return o.PlaceOfTrade
@@ -327,10 +346,20 @@ func (o *Order17) SetOrderBookingDate(value string) {
 	o.OrderBookingDate = (*ISODate)(&value)
 }
 
+func (o *Order17) SetOrderBookingDateFromTime(value time.Time) {
+	o.OrderBookingDate = new(ISODate)
+	o.OrderBookingDate.FromTime(value)
+}
+
 func (o *Order17) SetTradeOriginationDate(value string) {
 	o.TradeOriginationDate = (*ISODateTime)(&value)
 }
 
+func (o *Order17) SetTradeOriginationDateFromTime(value time.Time) {
+	o.TradeOriginationDate = new(ISODateTime)
+	o.TradeOriginationDate.FromTime(value)
+}
+
 func (o *Order17) AddTradeDate() *TradeDate4Choice {
 	o.TradeDate = new(TradeDate4Choice)
 	return o.TradeDate
@@ -676,10 +705,20 @@ func (o *Order17) SetNumberOfDaysAccrued(value string) {
 	o.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (o *Order17) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	o.NumberOfDaysAccrued = new(Max3Number)
+	o.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (o *Order17) SetGiveUpNumberOfDays(value string) {
 	o.GiveUpNumberOfDays = (*Max3Number)(&value)
 }
 
+func (o *Order17) SetGiveUpNumberOfDaysFromDecimal(value decimal.Decimal) {
+	o.GiveUpNumberOfDays = new(Max3Number)
+	o.GiveUpNumberOfDays.FromDecimal(value)
+}
+
 func (o *Order17) SetInterestType(value string) {
 	o.InterestType = (*InterestType2Code)(&value)
 }
@@ -693,6 +732,11 @@ func (o *Order17) SetAccruedInterestPercentage(value string) {
 	o.AccruedInterestPercentage = (*PercentageRate)(&value)
 }
 
+func (o *Order17) SetAccruedInterestPercentageFromDecimal(value decimal.Decimal) {
+	o.AccruedInterestPercentage = new(PercentageRate)
+	o.AccruedInterestPercentage.FromDecimal(value)
+}
+
 func (o *Order17) SetTradeRegulatoryConditionsType(value string) {
 	o.TradeRegulatoryConditionsType = (*TradeRegulatoryConditions1Code)(&value)
 }
@@ -714,6 +758,11 @@ func (o *Order17) SetDerivativeCovered(value string) {
 	o.DerivativeCovered = (*YesNoIndicator)(&value)
 }
 
+func (o *Order17) SetDerivativeCoveredFromBool(value bool) {
+	o.DerivativeCovered = new(YesNoIndicator)
+	o.DerivativeCovered.FromBool(value)
+}
+
 func (o *Order17) AddChargeTaxBasisType() *ChargeTaxBasisType1Choice {
 	o.ChargeTaxBasisType = new(ChargeTaxBasisType1Choice)
 	return o.ChargeTaxBasisType
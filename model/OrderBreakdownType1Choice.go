@@ -10,6 +10,10 @@ type OrderBreakdownType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OrderBreakdownType1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrderBreakdownType1Choice) SetCode(value string) {
 	o.Code = (*FundOrderType5Code)(&value)
 }
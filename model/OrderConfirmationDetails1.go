@@ -16,10 +16,19 @@ type OrderConfirmationDetails1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (o *OrderConfirmationDetails1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrderConfirmationDetails1) SetAmendmentIndicator(value string) {
 	o.AmendmentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (o *OrderConfirmationDetails1) SetAmendmentIndicatorFromBool(value bool) {
+	o.AmendmentIndicator = new(YesNoIndicator)
+	o.AmendmentIndicator.FromBool(value)
+}
+
 func (o *OrderConfirmationDetails1) AddBulkExecutionDetails() *RedemptionBulkExecution3 {
 	o.BulkExecutionDetails = new(RedemptionBulkExecution3)
 	return o.BulkExecutionDetails
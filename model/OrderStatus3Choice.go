@@ -22,6 +22,10 @@ type OrderStatus3Choice struct {
 	PartiallySettled *PartiallySettledStatus10 `xml:"PrtlySttld"`
 }
 
+func (o *OrderStatus3Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrderStatus3Choice) SetStatus(value string) {
 	o.Status = (*OrderStatus4Code)(&value)
 }
@@ -25,6 +25,10 @@ type OrderStatus4Choice struct {
 	PartiallySettled *PartiallySettledStatus10 `xml:"PrtlySttld"`
 }
 
+func (o *OrderStatus4Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrderStatus4Choice) SetStatus(value string) {
 	o.Status = (*OrderStatus4Code)(&value)
 }
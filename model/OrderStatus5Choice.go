@@ -25,6 +25,10 @@ type OrderStatus5Choice struct {
 	PartiallySettled *PartiallySettledStatus10 `xml:"PrtlySttld"`
 }
 
+func (o *OrderStatus5Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrderStatus5Choice) SetStatus(value string) {
 	o.Status = (*OrderStatus4Code)(&value)
 }
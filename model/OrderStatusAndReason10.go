@@ -13,6 +13,10 @@ type OrderStatusAndReason10 struct {
 	StatusInitiator *PartyIdentification113 `xml:"StsInitr,omitempty"`
 }
 
+func (o *OrderStatusAndReason10) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrderStatusAndReason10) SetMasterReference(value string) {
 	o.MasterReference = (*Max35Text)(&value)
 }
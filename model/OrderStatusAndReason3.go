@@ -25,6 +25,10 @@ type OrderStatusAndReason3 struct {
 	SwitchOrderLegIdentification *Max35Text `xml:"SwtchOrdrLegId,omitempty"`
 }
 
+func (o *OrderStatusAndReason3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrderStatusAndReason3) SetStatus(value string) {
 	o.Status = (*OrderStatus2Code)(&value)
 }
@@ -13,6 +13,10 @@ type OrderStatusAndReason4 struct {
 	StatusInitiator *PartyIdentification2Choice `xml:"StsInitr,omitempty"`
 }
 
+func (o *OrderStatusAndReason4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrderStatusAndReason4) SetStatus(value string) {
 	o.Status = (*OrderStatus3Code)(&value)
 }
@@ -28,6 +28,10 @@ type OrderStatusAndReason7 struct {
 	StatusInitiator *PartyIdentification2Choice `xml:"StsInitr,omitempty"`
 }
 
+func (o *OrderStatusAndReason7) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrderStatusAndReason7) SetMasterReference(value string) {
 	o.MasterReference = (*Max35Text)(&value)
 }
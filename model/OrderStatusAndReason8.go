@@ -16,6 +16,10 @@ type OrderStatusAndReason8 struct {
 	StatusInitiator *PartyIdentification2Choice `xml:"StsInitr,omitempty"`
 }
 
+func (o *OrderStatusAndReason8) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrderStatusAndReason8) SetMasterReference(value string) {
 	o.MasterReference = (*Max35Text)(&value)
 }
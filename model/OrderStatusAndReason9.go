@@ -13,6 +13,10 @@ type OrderStatusAndReason9 struct {
 	StatusInitiator *PartyIdentification113 `xml:"StsInitr,omitempty"`
 }
 
+func (o *OrderStatusAndReason9) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrderStatusAndReason9) SetMasterReference(value string) {
 	o.MasterReference = (*Max35Text)(&value)
 }
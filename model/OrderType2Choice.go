@@ -10,6 +10,10 @@ type OrderType2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OrderType2Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrderType2Choice) SetType(value string) {
 	o.Type = (*FundOrderType7Code)(&value)
 }
@@ -10,6 +10,10 @@ type OrderWaiver1 struct {
 	InformationValue *Max350Text `xml:"InfVal,omitempty"`
 }
 
+func (o *OrderWaiver1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrderWaiver1) AddOrderWaiverReason() *OrderWaiverReason3Choice {
 	newValue := new(OrderWaiverReason3Choice)
 	o.OrderWaiverReason = append(o.OrderWaiverReason, newValue)
@@ -10,6 +10,10 @@ type OrderWaiverReason3Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OrderWaiverReason3Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrderWaiverReason3Choice) SetCode(value string) {
 	o.Code = (*OrderWaiverReason1Code)(&value)
 }
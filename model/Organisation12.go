@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information which describes the organisation.
 type Organisation12 struct {
 
@@ -46,6 +50,10 @@ type Organisation12 struct {
 	LegalRepresentative []*PartyIdentification40 `xml:"LglRprtv,omitempty"`
 }
 
+func (o *Organisation12) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation12) SetFullLegalName(value string) {
 	o.FullLegalName = (*Max350Text)(&value)
 }
@@ -62,6 +70,11 @@ func (o *Organisation12) SetRegistrationDate(value string) {
 	o.RegistrationDate = (*ISODate)(&value)
 }
 
+func (o *Organisation12) SetRegistrationDateFromTime(value time.Time) {
+	o.RegistrationDate = new(ISODate)
+	o.RegistrationDate.FromTime(value)
+}
+
 func (o *Organisation12) AddOperationalAddress() *PostalAddress6 {
 	o.OperationalAddress = new(PostalAddress6)
 	return o.OperationalAddress
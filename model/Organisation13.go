@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Organised structure that is set up for a particular purpose, eg, a business, government body, department, charity, or financial institution.
 type Organisation13 struct {
 
@@ -40,6 +44,10 @@ type Organisation13 struct {
 	AdditionalRegulatoryInformation *RegulatoryInformation1 `xml:"AddtlRgltryInf,omitempty"`
 }
 
+func (o *Organisation13) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation13) SetName(value string) {
 	o.Name = (*Max140Text)(&value)
 }
@@ -65,6 +73,11 @@ func (o *Organisation13) SetRegistrationDate(value string) {
 	o.RegistrationDate = (*ISODate)(&value)
 }
 
+func (o *Organisation13) SetRegistrationDateFromTime(value time.Time) {
+	o.RegistrationDate = new(ISODate)
+	o.RegistrationDate.FromTime(value)
+}
+
 func (o *Organisation13) SetTaxIdentificationNumber(value string) {
 	o.TaxIdentificationNumber = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type Organisation14 struct {
 	OrganisationIdentification *OrganisationIdentification8 `xml:"OrgId"`
 }
 
+func (o *Organisation14) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation14) SetFullLegalName(value string) {
 	o.FullLegalName = (*Max350Text)(&value)
 }
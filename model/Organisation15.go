@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Organised structure that is set up for a particular purpose, for example, a business, government body, department, charity, or financial institution.
 type Organisation15 struct {
 
@@ -40,6 +44,10 @@ type Organisation15 struct {
 	AdditionalRegulatoryInformation *RegulatoryInformation1 `xml:"AddtlRgltryInf,omitempty"`
 }
 
+func (o *Organisation15) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation15) SetName(value string) {
 	o.Name = (*Max140Text)(&value)
 }
@@ -65,6 +73,11 @@ func (o *Organisation15) SetRegistrationDate(value string) {
 	o.RegistrationDate = (*ISODate)(&value)
 }
 
+func (o *Organisation15) SetRegistrationDateFromTime(value time.Time) {
+	o.RegistrationDate = new(ISODate)
+	o.RegistrationDate.FromTime(value)
+}
+
 func (o *Organisation15) SetTaxIdentificationNumber(value string) {
 	o.TaxIdentificationNumber = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Organised structure that is set up for a particular purpose, eg, a business, government body, department, charity, or financial institution.
 type Organisation16 struct {
 
@@ -40,6 +44,10 @@ type Organisation16 struct {
 	AdditionalRegulatoryInformation *RegulatoryInformation1 `xml:"AddtlRgltryInf,omitempty"`
 }
 
+func (o *Organisation16) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation16) SetName(value string) {
 	o.Name = (*Max140Text)(&value)
 }
@@ -65,6 +73,11 @@ func (o *Organisation16) SetRegistrationDate(value string) {
 	o.RegistrationDate = (*ISODate)(&value)
 }
 
+func (o *Organisation16) SetRegistrationDateFromTime(value time.Time) {
+	o.RegistrationDate = new(ISODate)
+	o.RegistrationDate.FromTime(value)
+}
+
 func (o *Organisation16) AddTaxIdentification() *TaxIdentification2 {
 	newValue := new(TaxIdentification2)
 	o.TaxIdentification = append(o.TaxIdentification, newValue)
@@ -21,6 +21,10 @@ type Organisation18 struct {
 	SchemeData *Max140Text `xml:"SchmeData,omitempty"`
 }
 
+func (o *Organisation18) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation18) AddIdentification() *GenericIdentification32 {
 	o.Identification = new(GenericIdentification32)
 	return o.Identification
@@ -17,6 +17,10 @@ type Organisation19 struct {
 	SchemeData *Max140Text `xml:"SchmeData,omitempty"`
 }
 
+func (o *Organisation19) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation19) AddIdentification() *GenericIdentification32 {
 	o.Identification = new(GenericIdentification32)
 	return o.Identification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Organised structure that is set up for a particular purpose, eg, a business, government body, department, charity, or financial institution.
 type Organisation2 struct {
 
@@ -37,6 +41,10 @@ type Organisation2 struct {
 	SecondaryCommunicationAddress *CommunicationAddress3 `xml:"ScndryComAdr,omitempty"`
 }
 
+func (o *Organisation2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation2) SetName(value string) {
 	o.Name = (*Max140Text)(&value)
 }
@@ -62,6 +70,11 @@ func (o *Organisation2) SetRegistrationDate(value string) {
 	o.RegistrationDate = (*ISODate)(&value)
 }
 
+func (o *Organisation2) SetRegistrationDateFromTime(value time.Time) {
+	o.RegistrationDate = new(ISODate)
+	o.RegistrationDate.FromTime(value)
+}
+
 func (o *Organisation2) SetTaxIdentificationNumber(value string) {
 	o.TaxIdentificationNumber = (*Max35Text)(&value)
 }
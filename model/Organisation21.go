@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Organised structure that is set up for a particular purpose, for example, a business, government body, department, charity, or financial institution.
 type Organisation21 struct {
 
@@ -31,6 +35,10 @@ type Organisation21 struct {
 	CorporateInvestorAddress *PostalAddress1 `xml:"CorpInvstrAdr"`
 }
 
+func (o *Organisation21) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation21) SetName(value string) {
 	o.Name = (*Max140Text)(&value)
 }
@@ -56,6 +64,11 @@ func (o *Organisation21) SetRegistrationDate(value string) {
 	o.RegistrationDate = (*ISODate)(&value)
 }
 
+func (o *Organisation21) SetRegistrationDateFromTime(value time.Time) {
+	o.RegistrationDate = new(ISODate)
+	o.RegistrationDate.FromTime(value)
+}
+
 func (o *Organisation21) SetTaxIdentificationNumber(value string) {
 	o.TaxIdentificationNumber = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Organised structure that is set up for a particular purpose, for example, a business, government body, department, charity, or financial institution.
 type Organisation22 struct {
 
@@ -31,6 +35,10 @@ type Organisation22 struct {
 	TypeOfOrganisation *OrganisationType1Choice `xml:"TpOfOrg,omitempty"`
 }
 
+func (o *Organisation22) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation22) SetName(value string) {
 	o.Name = (*Max350Text)(&value)
 }
@@ -60,6 +68,11 @@ func (o *Organisation22) SetRegistrationDate(value string) {
 	o.RegistrationDate = (*ISODate)(&value)
 }
 
+func (o *Organisation22) SetRegistrationDateFromTime(value time.Time) {
+	o.RegistrationDate = new(ISODate)
+	o.RegistrationDate.FromTime(value)
+}
+
 func (o *Organisation22) AddModifiedPostalAddress() *ModificationScope34 {
 	newValue := new(ModificationScope34)
 	o.ModifiedPostalAddress = append(o.ModifiedPostalAddress, newValue)
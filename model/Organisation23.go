@@ -13,6 +13,10 @@ type Organisation23 struct {
 	PostalAddress []*PostalAddress21 `xml:"PstlAdr"`
 }
 
+func (o *Organisation23) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation23) SetName(value string) {
 	o.Name = (*Max350Text)(&value)
 }
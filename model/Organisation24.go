@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Organised structure that is set up for a particular purpose, for example, a business, government body, department, charity, or financial institution.
 type Organisation24 struct {
 
@@ -31,6 +35,10 @@ type Organisation24 struct {
 	TypeOfOrganisation *OrganisationType1Choice `xml:"TpOfOrg,omitempty"`
 }
 
+func (o *Organisation24) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation24) SetName(value string) {
 	o.Name = (*Max350Text)(&value)
 }
@@ -60,6 +68,11 @@ func (o *Organisation24) SetRegistrationDate(value string) {
 	o.RegistrationDate = (*ISODate)(&value)
 }
 
+func (o *Organisation24) SetRegistrationDateFromTime(value time.Time) {
+	o.RegistrationDate = new(ISODate)
+	o.RegistrationDate.FromTime(value)
+}
+
 func (o *Organisation24) AddPostalAddress() *PostalAddress21 {
 	newValue := new(PostalAddress21)
 	o.PostalAddress = append(o.PostalAddress, newValue)
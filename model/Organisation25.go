@@ -19,6 +19,10 @@ type Organisation25 struct {
 	SchemeData *Max140Text `xml:"SchmeData,omitempty"`
 }
 
+func (o *Organisation25) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation25) AddIdentification() *GenericIdentification32 {
 	o.Identification = new(GenericIdentification32)
 	return o.Identification
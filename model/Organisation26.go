@@ -19,6 +19,10 @@ type Organisation26 struct {
 	RegisteredIdentifier *Max35Text `xml:"RegdIdr"`
 }
 
+func (o *Organisation26) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation26) SetCommonName(value string) {
 	o.CommonName = (*Max70Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Organised structure that is set up for a particular purpose, for example, a business, government body, department, charity, or financial institution.
 type Organisation29 struct {
 
@@ -34,6 +38,10 @@ type Organisation29 struct {
 	PlaceOfListing []*MICIdentifier `xml:"PlcOfListg,omitempty"`
 }
 
+func (o *Organisation29) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation29) SetName(value string) {
 	o.Name = (*Max350Text)(&value)
 }
@@ -63,6 +71,11 @@ func (o *Organisation29) SetRegistrationDate(value string) {
 	o.RegistrationDate = (*ISODate)(&value)
 }
 
+func (o *Organisation29) SetRegistrationDateFromTime(value time.Time) {
+	o.RegistrationDate = new(ISODate)
+	o.RegistrationDate.FromTime(value)
+}
+
 func (o *Organisation29) AddModifiedPostalAddress() *ModificationScope34 {
 	newValue := new(ModificationScope34)
 	o.ModifiedPostalAddress = append(o.ModifiedPostalAddress, newValue)
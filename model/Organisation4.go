@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Organised structure that is set up for a particular purpose, eg, a business, government body, department, charity, or financial institution.
 type Organisation4 struct {
 
@@ -31,6 +35,10 @@ type Organisation4 struct {
 	CorporateInvestorAddress *PostalAddress1 `xml:"CorpInvstrAdr"`
 }
 
+func (o *Organisation4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation4) SetName(value string) {
 	o.Name = (*Max140Text)(&value)
 }
@@ -56,6 +64,11 @@ func (o *Organisation4) SetRegistrationDate(value string) {
 	o.RegistrationDate = (*ISODate)(&value)
 }
 
+func (o *Organisation4) SetRegistrationDateFromTime(value time.Time) {
+	o.RegistrationDate = new(ISODate)
+	o.RegistrationDate.FromTime(value)
+}
+
 func (o *Organisation4) SetTaxIdentificationNumber(value string) {
 	o.TaxIdentificationNumber = (*Max35Text)(&value)
 }
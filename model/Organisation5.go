@@ -22,6 +22,10 @@ type Organisation5 struct {
 	SchemeData *Max140Text `xml:"SchmeData,omitempty"`
 }
 
+func (o *Organisation5) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation5) AddIdentification() *GenericIdentification32 {
 	o.Identification = new(GenericIdentification32)
 	return o.Identification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information which describes the organisation.
 type Organisation6 struct {
 
@@ -40,6 +44,10 @@ type Organisation6 struct {
 	Sender []*PartyIdentification40 `xml:"Sndr,omitempty"`
 }
 
+func (o *Organisation6) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation6) SetFullLegalName(value string) {
 	o.FullLegalName = (*Max350Text)(&value)
 }
@@ -56,6 +64,11 @@ func (o *Organisation6) SetRegistrationDate(value string) {
 	o.RegistrationDate = (*ISODate)(&value)
 }
 
+func (o *Organisation6) SetRegistrationDateFromTime(value time.Time) {
+	o.RegistrationDate = new(ISODate)
+	o.RegistrationDate.FromTime(value)
+}
+
 func (o *Organisation6) AddOperationalAddress() *PostalAddress6 {
 	o.OperationalAddress = new(PostalAddress6)
 	return o.OperationalAddress
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information which describes the organisation.
 type Organisation7 struct {
 
@@ -40,6 +44,10 @@ type Organisation7 struct {
 	Sender []*PartyIdentification40 `xml:"Sndr,omitempty"`
 }
 
+func (o *Organisation7) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation7) SetFullLegalName(value string) {
 	o.FullLegalName = (*Max350Text)(&value)
 }
@@ -56,6 +64,11 @@ func (o *Organisation7) SetRegistrationDate(value string) {
 	o.RegistrationDate = (*ISODate)(&value)
 }
 
+func (o *Organisation7) SetRegistrationDateFromTime(value time.Time) {
+	o.RegistrationDate = new(ISODate)
+	o.RegistrationDate.FromTime(value)
+}
+
 func (o *Organisation7) AddOperationalAddress() *PostalAddress6 {
 	o.OperationalAddress = new(PostalAddress6)
 	return o.OperationalAddress
@@ -22,6 +22,10 @@ type Organisation8 struct {
 	SchemeData *Max140Text `xml:"SchmeData,omitempty"`
 }
 
+func (o *Organisation8) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation8) AddIdentification() *GenericIdentification32 {
 	o.Identification = new(GenericIdentification32)
 	return o.Identification
@@ -22,6 +22,10 @@ type Organisation9 struct {
 	SchemeData *Max140Text `xml:"SchmeData,omitempty"`
 }
 
+func (o *Organisation9) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *Organisation9) AddIdentification() *GenericIdentification32 {
 	o.Identification = new(GenericIdentification32)
 	return o.Identification
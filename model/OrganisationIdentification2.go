@@ -31,6 +31,10 @@ type OrganisationIdentification2 struct {
 	ProprietaryIdentification *GenericIdentification3 `xml:"PrtryId,omitempty"`
 }
 
+func (o *OrganisationIdentification2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrganisationIdentification2) SetBIC(value string) {
 	o.BIC = (*BICIdentifier)(&value)
 }
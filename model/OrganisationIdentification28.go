@@ -19,6 +19,10 @@ type OrganisationIdentification28 struct {
 	ContactDetails *ContactDetails2 `xml:"CtctDtls"`
 }
 
+func (o *OrganisationIdentification28) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrganisationIdentification28) SetName(value string) {
 	o.Name = (*Max140Text)(&value)
 }
@@ -0,0 +1,86 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Unique and unambiguous way to identify an organisation.
+type OrganisationIdentification29 struct {
+
+	// Code allocated to a financial institution or non financial institution by the ISO 9362 Registration Authority as described in ISO 9362 "Banking - Banking telecommunication messages - Business identifier code (BIC)".
+	AnyBIC *AnyBICDec2014Identifier `xml:"AnyBIC,omitempty"`
+
+	// Legal entity identification as an alternate identification for a party.
+	LEI *LEIIdentifier `xml:"LEI,omitempty"`
+
+	// Unique identification of an organisation, as assigned by an institution, using an identification scheme.
+	Other []*GenericOrganisationIdentification1 `xml:"Othr,omitempty"`
+}
+
+func (o *OrganisationIdentification29) Validate() error {
+	return ValidateElement(o)
+}
+
+func (o *OrganisationIdentification29) SetAnyBIC(value string) {
+	o.AnyBIC = (*AnyBICDec2014Identifier)(&value)
+}
+
+func (o *OrganisationIdentification29) SetLEI(value string) {
+	o.LEI = (*LEIIdentifier)(&value)
+}
+
+func (o *OrganisationIdentification29) AddOther() *GenericOrganisationIdentification1 {
+	newValue := new(GenericOrganisationIdentification1)
+	o.Other = append(o.Other, newValue)
+	return newValue
+}
+
+func (o *OrganisationIdentification29) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if o.AnyBIC != nil {
+		enc.Element("AnyBIC", string(*o.AnyBIC))
+	}
+	if o.LEI != nil {
+		enc.Element("LEI", string(*o.LEI))
+	}
+	for _, elem := range o.Other {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Othr")
+		}
+	}
+	enc.End(tag)
+}
+
+func (o *OrganisationIdentification29) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "AnyBIC":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.AnyBIC = (*AnyBICDec2014Identifier)(&value)
+		case "LEI":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.LEI = (*LEIIdentifier)(&value)
+		case "Othr":
+			elem := new(GenericOrganisationIdentification1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.Other = append(o.Other, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
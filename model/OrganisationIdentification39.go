@@ -0,0 +1,32 @@
+package model
+
+// Unique and unambiguous way to identify an organisation.
+type OrganisationIdentification39 struct {
+
+	// Code allocated to a financial institution or non financial institution by the ISO 9362 Registration Authority as described in ISO 9362 "Banking - Banking telecommunication messages - Business identifier code (BIC)".
+	AnyBIC *AnyBICDec2014Identifier `xml:"AnyBIC,omitempty"`
+
+	// Legal entity identification as an alternate identification for a party.
+	LEI *LEIIdentifier `xml:"LEI,omitempty"`
+
+	// Unique identification of an organisation, as assigned by an institution, using an identification scheme.
+	Other []*GenericOrganisationIdentification3 `xml:"Othr,omitempty"`
+}
+
+func (o *OrganisationIdentification39) Validate() error {
+	return ValidateElement(o)
+}
+
+func (o *OrganisationIdentification39) SetAnyBIC(value string) {
+	o.AnyBIC = (*AnyBICDec2014Identifier)(&value)
+}
+
+func (o *OrganisationIdentification39) SetLEI(value string) {
+	o.LEI = (*LEIIdentifier)(&value)
+}
+
+func (o *OrganisationIdentification39) AddOther() *GenericOrganisationIdentification3 {
+	newValue := new(GenericOrganisationIdentification3)
+	o.Other = append(o.Other, newValue)
+	return newValue
+}
@@ -10,6 +10,10 @@ type OrganisationIdentification4 struct {
 	Other []*GenericOrganisationIdentification1 `xml:"Othr,omitempty"`
 }
 
+func (o *OrganisationIdentification4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrganisationIdentification4) SetBICOrBEI(value string) {
 	o.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
@@ -10,6 +10,10 @@ type OrganisationIdentification5 struct {
 	RegisterName *Max35Text `xml:"RegrNm,omitempty"`
 }
 
+func (o *OrganisationIdentification5) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrganisationIdentification5) SetRegistrationNumber(value string) {
 	o.RegistrationNumber = (*Max35Text)(&value)
 }
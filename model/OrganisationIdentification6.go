@@ -10,6 +10,10 @@ type OrganisationIdentification6 struct {
 	Other []*GenericOrganisationIdentification1 `xml:"Othr,omitempty"`
 }
 
+func (o *OrganisationIdentification6) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrganisationIdentification6) SetBIC(value string) {
 	o.BIC = (*AnyBICIdentifier)(&value)
 }
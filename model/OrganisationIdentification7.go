@@ -10,6 +10,10 @@ type OrganisationIdentification7 struct {
 	Other []*GenericOrganisationIdentification1 `xml:"Othr,omitempty"`
 }
 
+func (o *OrganisationIdentification7) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrganisationIdentification7) SetAnyBIC(value string) {
 	o.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Unique and unambiguous way to identify an organisation.
 type OrganisationIdentification8 struct {
 
@@ -10,6 +12,10 @@ type OrganisationIdentification8 struct {
 	Other []*GenericOrganisationIdentification1 `xml:"Othr,omitempty"`
 }
 
+func (o *OrganisationIdentification8) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrganisationIdentification8) SetAnyBIC(value string) {
 	o.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -20,6 +26,49 @@ func (o *OrganisationIdentification8) AddOther() *GenericOrganisationIdentificat
 	return newValue
 }
 
+func (o *OrganisationIdentification8) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if o.AnyBIC != nil {
+		enc.Element("AnyBIC", string(*o.AnyBIC))
+	}
+	for _, elem := range o.Other {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Othr")
+		}
+	}
+	enc.End(tag)
+}
+
+func (o *OrganisationIdentification8) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "AnyBIC":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.AnyBIC = (*AnyBICIdentifier)(&value)
+		case "Othr":
+			elem := new(GenericOrganisationIdentification1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.Other = append(o.Other, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -10,6 +10,10 @@ type OrganisationIdentification9 struct {
 	Other []*GenericOrganisationIdentification2 `xml:"Othr,omitempty"`
 }
 
+func (o *OrganisationIdentification9) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrganisationIdentification9) SetAnyBIC(value string) {
 	o.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Sets of elements to identify a name of the organisation identification scheme.
 type OrganisationIdentificationSchemeName1Choice struct {
 
@@ -10,6 +12,10 @@ type OrganisationIdentificationSchemeName1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (o *OrganisationIdentificationSchemeName1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrganisationIdentificationSchemeName1Choice) SetCode(value string) {
 	o.Code = (*ExternalOrganisationIdentification1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (o *OrganisationIdentificationSchemeName1Choice) SetCode(value string) {
 func (o *OrganisationIdentificationSchemeName1Choice) SetProprietary(value string) {
 	o.Proprietary = (*Max35Text)(&value)
 }
+
+func (o *OrganisationIdentificationSchemeName1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if o.Code != nil {
+		enc.Element("Cd", string(*o.Code))
+	}
+	if o.Proprietary != nil {
+		enc.Element("Prtry", string(*o.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (o *OrganisationIdentificationSchemeName1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.Code = (*ExternalOrganisationIdentification1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ type OrganisationIdentificationSchemeName2Choice struct {
 	Proprietary *RestrictedFINXMax35Text `xml:"Prtry"`
 }
 
+func (o *OrganisationIdentificationSchemeName2Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrganisationIdentificationSchemeName2Choice) SetCode(value string) {
 	o.Code = (*ExternalOrganisationIdentification1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information which describes the organisation.
 type OrganisationModification1 struct {
 
@@ -46,6 +50,10 @@ type OrganisationModification1 struct {
 	LegalRepresentative []*PartyModification1 `xml:"LglRprtv,omitempty"`
 }
 
+func (o *OrganisationModification1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrganisationModification1) AddFullLegalName() *FullLegalNameModification1 {
 	o.FullLegalName = new(FullLegalNameModification1)
 	return o.FullLegalName
@@ -64,6 +72,11 @@ func (o *OrganisationModification1) SetRegistrationDate(value string) {
 	o.RegistrationDate = (*ISODate)(&value)
 }
 
+func (o *OrganisationModification1) SetRegistrationDateFromTime(value time.Time) {
+	o.RegistrationDate = new(ISODate)
+	o.RegistrationDate.FromTime(value)
+}
+
 func (o *OrganisationModification1) AddOperationalAddress() *AddressModification1 {
 	o.OperationalAddress = new(AddressModification1)
 	return o.OperationalAddress
@@ -10,6 +10,10 @@ type OrganisationType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OrganisationType1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OrganisationType1Choice) SetCode(value string) {
 	o.Code = (*OrganisationType1Code)(&value)
 }
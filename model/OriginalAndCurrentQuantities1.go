@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Original and current value of an asset-back instrument.
 type OriginalAndCurrentQuantities1 struct {
 
@@ -10,10 +15,63 @@ type OriginalAndCurrentQuantities1 struct {
 	AmortisedValue *ImpliedCurrencyAndAmount `xml:"AmtsdVal"`
 }
 
+func (o *OriginalAndCurrentQuantities1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalAndCurrentQuantities1) SetFaceAmount(value, currency string) {
 	o.FaceAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalAndCurrentQuantities1) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.FaceAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalAndCurrentQuantities1) SetAmortisedValue(value, currency string) {
 	o.AmortisedValue = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (o *OriginalAndCurrentQuantities1) SetAmortisedValueFromDecimal(value decimal.Decimal, currency string) {
+	o.AmortisedValue = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
+func (o *OriginalAndCurrentQuantities1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if o.FaceAmount != nil {
+		o.FaceAmount.EncodeXMLFast(enc, "FaceAmt")
+	}
+	if o.AmortisedValue != nil {
+		o.AmortisedValue.EncodeXMLFast(enc, "AmtsdVal")
+	}
+	enc.End(tag)
+}
+
+func (o *OriginalAndCurrentQuantities1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FaceAmt":
+			elem := new(ImpliedCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.FaceAmount = elem
+		case "AmtsdVal":
+			elem := new(ImpliedCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.AmortisedValue = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
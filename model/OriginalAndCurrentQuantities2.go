@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Signed face amount and amortised value.
 type OriginalAndCurrentQuantities2 struct {
 
@@ -13,6 +17,10 @@ type OriginalAndCurrentQuantities2 struct {
 	AmortisedValue *ImpliedCurrencyAndAmount `xml:"AmtsdVal"`
 }
 
+func (o *OriginalAndCurrentQuantities2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalAndCurrentQuantities2) SetShortLongPosition(value string) {
 	o.ShortLongPosition = (*ShortLong1Code)(&value)
 }
@@ -21,6 +29,14 @@ func (o *OriginalAndCurrentQuantities2) SetFaceAmount(value, currency string) {
 	o.FaceAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalAndCurrentQuantities2) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.FaceAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalAndCurrentQuantities2) SetAmortisedValue(value, currency string) {
 	o.AmortisedValue = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (o *OriginalAndCurrentQuantities2) SetAmortisedValueFromDecimal(value decimal.Decimal, currency string) {
+	o.AmortisedValue = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Original and current value of an asset-back instrument.
 type OriginalAndCurrentQuantities4 struct {
 
@@ -10,10 +14,22 @@ type OriginalAndCurrentQuantities4 struct {
 	AmortisedValue *RestrictedFINImpliedCurrencyAndAmount `xml:"AmtsdVal"`
 }
 
+func (o *OriginalAndCurrentQuantities4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalAndCurrentQuantities4) SetFaceAmount(value, currency string) {
 	o.FaceAmount = NewRestrictedFINImpliedCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalAndCurrentQuantities4) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.FaceAmount = NewRestrictedFINImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalAndCurrentQuantities4) SetAmortisedValue(value, currency string) {
 	o.AmortisedValue = NewRestrictedFINImpliedCurrencyAndAmount(value, currency)
 }
+
+func (o *OriginalAndCurrentQuantities4) SetAmortisedValueFromDecimal(value decimal.Decimal, currency string) {
+	o.AmortisedValue = NewRestrictedFINImpliedCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Signed face amount and amortised value.
 type OriginalAndCurrentQuantities7 struct {
 
@@ -13,6 +17,10 @@ type OriginalAndCurrentQuantities7 struct {
 	AmortisedValue *RestrictedFINImpliedCurrencyAndAmount `xml:"AmtsdVal"`
 }
 
+func (o *OriginalAndCurrentQuantities7) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalAndCurrentQuantities7) SetShortLongPosition(value string) {
 	o.ShortLongPosition = (*ShortLong1Code)(&value)
 }
@@ -21,6 +29,14 @@ func (o *OriginalAndCurrentQuantities7) SetFaceAmount(value, currency string) {
 	o.FaceAmount = NewRestrictedFINImpliedCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalAndCurrentQuantities7) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.FaceAmount = NewRestrictedFINImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalAndCurrentQuantities7) SetAmortisedValue(value, currency string) {
 	o.AmortisedValue = NewRestrictedFINImpliedCurrencyAndAmount(value, currency)
 }
+
+func (o *OriginalAndCurrentQuantities7) SetAmortisedValueFromDecimal(value decimal.Decimal, currency string) {
+	o.AmortisedValue = NewRestrictedFINImpliedCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Unique identification, as assigned by the original requestor, to unambiguously identify the business query message.
 type OriginalBusinessQuery1 struct {
 
@@ -13,6 +19,10 @@ type OriginalBusinessQuery1 struct {
 	CreationDateTime *ISODateTime `xml:"CreDtTm,omitempty"`
 }
 
+func (o *OriginalBusinessQuery1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalBusinessQuery1) SetMessageIdentification(value string) {
 	o.MessageIdentification = (*Max35Text)(&value)
 }
@@ -24,3 +34,58 @@ func (o *OriginalBusinessQuery1) SetMessageNameIdentification(value string) {
 func (o *OriginalBusinessQuery1) SetCreationDateTime(value string) {
 	o.CreationDateTime = (*ISODateTime)(&value)
 }
+
+func (o *OriginalBusinessQuery1) SetCreationDateTimeFromTime(value time.Time) {
+	o.CreationDateTime = new(ISODateTime)
+	o.CreationDateTime.FromTime(value)
+}
+
+func (o *OriginalBusinessQuery1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if o.MessageIdentification != nil {
+		enc.Element("MsgId", string(*o.MessageIdentification))
+	}
+	if o.MessageNameIdentification != nil {
+		enc.Element("MsgNmId", string(*o.MessageNameIdentification))
+	}
+	if o.CreationDateTime != nil {
+		enc.Element("CreDtTm", string(*o.CreationDateTime))
+	}
+	enc.End(tag)
+}
+
+func (o *OriginalBusinessQuery1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "MsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.MessageIdentification = (*Max35Text)(&value)
+		case "MsgNmId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.MessageNameIdentification = (*Max35Text)(&value)
+		case "CreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.CreationDateTime = (*ISODateTime)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
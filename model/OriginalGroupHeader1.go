@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the original group, to which the message refers.
 type OriginalGroupHeader1 struct {
 
@@ -28,6 +34,10 @@ type OriginalGroupHeader1 struct {
 	NumberOfTransactionsPerStatus []*NumberOfTransactionsPerStatus3 `xml:"NbOfTxsPerSts,omitempty"`
 }
 
+func (o *OriginalGroupHeader1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupHeader1) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -40,6 +50,11 @@ func (o *OriginalGroupHeader1) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupHeader1) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupHeader1) SetOriginalNumberOfTransactions(value string) {
 	o.OriginalNumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -48,8 +63,17 @@ func (o *OriginalGroupHeader1) SetOriginalControlSum(value string) {
 	o.OriginalControlSum = (*DecimalNumber)(&value)
 }
 
-func (o *OriginalGroupHeader1) SetGroupStatus(value string) {
+func (o *OriginalGroupHeader1) SetOriginalControlSumFromDecimal(value decimal.Decimal) {
+	o.OriginalControlSum = new(DecimalNumber)
+	o.OriginalControlSum.FromDecimal(value)
+}
+
+func (o *OriginalGroupHeader1) SetGroupStatus(value string) error {
+	if err := TransactionGroupStatus3Code(value).Validate(); err != nil {
+		return err
+	}
 	o.GroupStatus = (*TransactionGroupStatus3Code)(&value)
+	return nil
 }
 
 func (o *OriginalGroupHeader1) AddStatusReasonInformation() *StatusReasonInformation9 {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides information on the original group, to which the message refers.
 type OriginalGroupHeader2 struct {
 
@@ -16,6 +22,10 @@ type OriginalGroupHeader2 struct {
 	ReturnReasonInformation []*PaymentReturnReason1 `xml:"RtrRsnInf,omitempty"`
 }
 
+func (o *OriginalGroupHeader2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupHeader2) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -28,12 +38,78 @@ func (o *OriginalGroupHeader2) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupHeader2) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupHeader2) AddReturnReasonInformation() *PaymentReturnReason1 {
 	newValue := new(PaymentReturnReason1)
 	o.ReturnReasonInformation = append(o.ReturnReasonInformation, newValue)
 	return newValue
 }
 
+func (o *OriginalGroupHeader2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if o.OriginalMessageIdentification != nil {
+		enc.Element("OrgnlMsgId", string(*o.OriginalMessageIdentification))
+	}
+	if o.OriginalMessageNameIdentification != nil {
+		enc.Element("OrgnlMsgNmId", string(*o.OriginalMessageNameIdentification))
+	}
+	if o.OriginalCreationDateTime != nil {
+		enc.Element("OrgnlCreDtTm", string(*o.OriginalCreationDateTime))
+	}
+	for _, elem := range o.ReturnReasonInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RtrRsnInf")
+		}
+	}
+	enc.End(tag)
+}
+
+func (o *OriginalGroupHeader2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "OrgnlMsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.OriginalMessageIdentification = (*Max35Text)(&value)
+		case "OrgnlMsgNmId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.OriginalMessageNameIdentification = (*Max35Text)(&value)
+		case "OrgnlCreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.OriginalCreationDateTime = (*ISODateTime)(&value)
+		case "RtrRsnInf":
+			elem := new(PaymentReturnReason1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.ReturnReasonInformation = append(o.ReturnReasonInformation, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
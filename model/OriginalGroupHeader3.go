@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides details on the original group, to which the message refers.
 type OriginalGroupHeader3 struct {
 
@@ -16,6 +20,10 @@ type OriginalGroupHeader3 struct {
 	ReversalReasonInformation []*PaymentReversalReason7 `xml:"RvslRsnInf,omitempty"`
 }
 
+func (o *OriginalGroupHeader3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupHeader3) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +36,11 @@ func (o *OriginalGroupHeader3) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupHeader3) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupHeader3) AddReversalReasonInformation() *PaymentReversalReason7 {
 	newValue := new(PaymentReversalReason7)
 	o.ReversalReasonInformation = append(o.ReversalReasonInformation, newValue)
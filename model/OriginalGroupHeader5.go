@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the details on the original group, to which the message refers.
 type OriginalGroupHeader5 struct {
 
@@ -34,6 +40,10 @@ type OriginalGroupHeader5 struct {
 	NumberOfTransactionsPerCancellationStatus []*NumberOfTransactionsPerStatus1 `xml:"NbOfTxsPerCxlSts,omitempty"`
 }
 
+func (o *OriginalGroupHeader5) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupHeader5) SetOriginalGroupCancellationIdentification(value string) {
 	o.OriginalGroupCancellationIdentification = (*Max35Text)(&value)
 }
@@ -55,6 +65,11 @@ func (o *OriginalGroupHeader5) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupHeader5) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupHeader5) SetOriginalNumberOfTransactions(value string) {
 	o.OriginalNumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -63,8 +78,17 @@ func (o *OriginalGroupHeader5) SetOriginalControlSum(value string) {
 	o.OriginalControlSum = (*DecimalNumber)(&value)
 }
 
-func (o *OriginalGroupHeader5) SetGroupCancellationStatus(value string) {
+func (o *OriginalGroupHeader5) SetOriginalControlSumFromDecimal(value decimal.Decimal) {
+	o.OriginalControlSum = new(DecimalNumber)
+	o.OriginalControlSum.FromDecimal(value)
+}
+
+func (o *OriginalGroupHeader5) SetGroupCancellationStatus(value string) error {
+	if err := GroupCancellationStatus1Code(value).Validate(); err != nil {
+		return err
+	}
 	o.GroupCancellationStatus = (*GroupCancellationStatus1Code)(&value)
+	return nil
 }
 
 func (o *OriginalGroupHeader5) AddCancellationStatusReasonInformation() *CancellationStatusReason2 {
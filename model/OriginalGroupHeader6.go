@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the original group, to which the message refers.
 type OriginalGroupHeader6 struct {
 
@@ -33,6 +39,10 @@ type OriginalGroupHeader6 struct {
 	CancellationReasonInformation []*PaymentCancellationReason3 `xml:"CxlRsnInf,omitempty"`
 }
 
+func (o *OriginalGroupHeader6) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupHeader6) SetGroupCancellationIdentification(value string) {
 	o.GroupCancellationIdentification = (*Max35Text)(&value)
 }
@@ -54,6 +64,11 @@ func (o *OriginalGroupHeader6) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupHeader6) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupHeader6) SetNumberOfTransactions(value string) {
 	o.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -62,10 +77,20 @@ func (o *OriginalGroupHeader6) SetControlSum(value string) {
 	o.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (o *OriginalGroupHeader6) SetControlSumFromDecimal(value decimal.Decimal) {
+	o.ControlSum = new(DecimalNumber)
+	o.ControlSum.FromDecimal(value)
+}
+
 func (o *OriginalGroupHeader6) SetGroupCancellation(value string) {
 	o.GroupCancellation = (*GroupCancellationIndicator)(&value)
 }
 
+func (o *OriginalGroupHeader6) SetGroupCancellationFromBool(value bool) {
+	o.GroupCancellation = new(GroupCancellationIndicator)
+	o.GroupCancellation.FromBool(value)
+}
+
 func (o *OriginalGroupHeader6) AddCancellationReasonInformation() *PaymentCancellationReason3 {
 	newValue := new(PaymentCancellationReason3)
 	o.CancellationReasonInformation = append(o.CancellationReasonInformation, newValue)
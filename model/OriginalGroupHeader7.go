@@ -1,5 +1,12 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides details on the original group, to which the message refers.
 type OriginalGroupHeader7 struct {
 
@@ -28,6 +35,10 @@ type OriginalGroupHeader7 struct {
 	NumberOfTransactionsPerStatus []*NumberOfTransactionsPerStatus5 `xml:"NbOfTxsPerSts,omitempty"`
 }
 
+func (o *OriginalGroupHeader7) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupHeader7) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -40,6 +51,11 @@ func (o *OriginalGroupHeader7) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupHeader7) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupHeader7) SetOriginalNumberOfTransactions(value string) {
 	o.OriginalNumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -48,6 +64,11 @@ func (o *OriginalGroupHeader7) SetOriginalControlSum(value string) {
 	o.OriginalControlSum = (*DecimalNumber)(&value)
 }
 
+func (o *OriginalGroupHeader7) SetOriginalControlSumFromDecimal(value decimal.Decimal) {
+	o.OriginalControlSum = new(DecimalNumber)
+	o.OriginalControlSum.FromDecimal(value)
+}
+
 func (o *OriginalGroupHeader7) SetGroupStatus(value string) {
 	o.GroupStatus = (*ExternalPaymentGroupStatus1Code)(&value)
 }
@@ -204,6 +225,105 @@ func (o *OriginalGroupHeader7) AddNumberOfTransactionsPerStatus() *NumberOfTrans
 	return newValue
 }
 
+func (o *OriginalGroupHeader7) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if o.OriginalMessageIdentification != nil {
+		enc.Element("OrgnlMsgId", string(*o.OriginalMessageIdentification))
+	}
+	if o.OriginalMessageNameIdentification != nil {
+		enc.Element("OrgnlMsgNmId", string(*o.OriginalMessageNameIdentification))
+	}
+	if o.OriginalCreationDateTime != nil {
+		enc.Element("OrgnlCreDtTm", string(*o.OriginalCreationDateTime))
+	}
+	if o.OriginalNumberOfTransactions != nil {
+		enc.Element("OrgnlNbOfTxs", string(*o.OriginalNumberOfTransactions))
+	}
+	if o.OriginalControlSum != nil {
+		enc.Element("OrgnlCtrlSum", string(*o.OriginalControlSum))
+	}
+	if o.GroupStatus != nil {
+		enc.Element("GrpSts", string(*o.GroupStatus))
+	}
+	for _, elem := range o.StatusReasonInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "StsRsnInf")
+		}
+	}
+	for _, elem := range o.NumberOfTransactionsPerStatus {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "NbOfTxsPerSts")
+		}
+	}
+	enc.End(tag)
+}
+
+func (o *OriginalGroupHeader7) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "OrgnlMsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.OriginalMessageIdentification = (*Max35Text)(&value)
+		case "OrgnlMsgNmId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.OriginalMessageNameIdentification = (*Max35Text)(&value)
+		case "OrgnlCreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.OriginalCreationDateTime = (*ISODateTime)(&value)
+		case "OrgnlNbOfTxs":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.OriginalNumberOfTransactions = (*Max15NumericText)(&value)
+		case "OrgnlCtrlSum":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.OriginalControlSum = (*DecimalNumber)(&value)
+		case "GrpSts":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.GroupStatus = (*ExternalPaymentGroupStatus1Code)(&value)
+		case "StsRsnInf":
+			elem := new(StatusReasonInformation9)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.StatusReasonInformation = append(o.StatusReasonInformation, elem)
+		case "NbOfTxsPerSts":
+			elem := new(NumberOfTransactionsPerStatus5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.NumberOfTransactionsPerStatus = append(o.NumberOfTransactionsPerStatus, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Unique and unambiguous identifier of the group of transactions as assigned by the original instructing party.
 type OriginalGroupInformation1 struct {
 
@@ -36,6 +42,10 @@ type OriginalGroupInformation1 struct {
 	NumberOfTransactionsPerStatus []*NumberOfTransactionsPerStatus1 `xml:"NbOfTxsPerSts,omitempty"`
 }
 
+func (o *OriginalGroupInformation1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupInformation1) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -52,6 +62,11 @@ func (o *OriginalGroupInformation1) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupInformation1) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupInformation1) SetFileOriginator(value string) {
 	o.FileOriginator = (*Max35Text)(&value)
 }
@@ -64,6 +79,11 @@ func (o *OriginalGroupInformation1) SetOriginalControlSum(value string) {
 	o.OriginalControlSum = (*DecimalNumber)(&value)
 }
 
+func (o *OriginalGroupInformation1) SetOriginalControlSumFromDecimal(value decimal.Decimal) {
+	o.OriginalControlSum = new(DecimalNumber)
+	o.OriginalControlSum.FromDecimal(value)
+}
+
 func (o *OriginalGroupInformation1) SetGroupStatus(value string) {
 	o.GroupStatus = (*TransactionGroupStatus1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Unique and unambiguous identifier of the group of transactions as assigned by the original instructing party.
 type OriginalGroupInformation2 struct {
 
@@ -16,6 +20,10 @@ type OriginalGroupInformation2 struct {
 	ReturnReasonInformation []*ReturnReasonInformation1 `xml:"RtrRsnInf,omitempty"`
 }
 
+func (o *OriginalGroupInformation2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupInformation2) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +36,11 @@ func (o *OriginalGroupInformation2) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupInformation2) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupInformation2) AddReturnReasonInformation() *ReturnReasonInformation1 {
 	newValue := new(ReturnReasonInformation1)
 	o.ReturnReasonInformation = append(o.ReturnReasonInformation, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to provide information on the original group, to which the message refers.
 type OriginalGroupInformation21 struct {
 
@@ -16,6 +20,10 @@ type OriginalGroupInformation21 struct {
 	ReturnReasonInformation []*ReturnReasonInformation9 `xml:"RtrRsnInf,omitempty"`
 }
 
+func (o *OriginalGroupInformation21) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupInformation21) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +36,11 @@ func (o *OriginalGroupInformation21) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupInformation21) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupInformation21) AddReturnReasonInformation() *ReturnReasonInformation9 {
 	newValue := new(ReturnReasonInformation9)
 	o.ReturnReasonInformation = append(o.ReturnReasonInformation, newValue)
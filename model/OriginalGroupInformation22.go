@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to provide information on the original group, to which the message refers.
 type OriginalGroupInformation22 struct {
 
@@ -16,6 +20,10 @@ type OriginalGroupInformation22 struct {
 	ReversalReasonInformation []*ReversalReasonInformation6 `xml:"RvslRsnInf,omitempty"`
 }
 
+func (o *OriginalGroupInformation22) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupInformation22) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +36,11 @@ func (o *OriginalGroupInformation22) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupInformation22) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupInformation22) AddReversalReasonInformation() *ReversalReasonInformation6 {
 	newValue := new(ReversalReasonInformation6)
 	o.ReversalReasonInformation = append(o.ReversalReasonInformation, newValue)
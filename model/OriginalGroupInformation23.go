@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the original group, to which the message refers.
 type OriginalGroupInformation23 struct {
 
@@ -33,6 +39,10 @@ type OriginalGroupInformation23 struct {
 	CancellationReasonInformation []*CancellationReasonInformation3 `xml:"CxlRsnInf,omitempty"`
 }
 
+func (o *OriginalGroupInformation23) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupInformation23) SetGroupCancellationIdentification(value string) {
 	o.GroupCancellationIdentification = (*Max35Text)(&value)
 }
@@ -54,6 +64,11 @@ func (o *OriginalGroupInformation23) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupInformation23) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupInformation23) SetNumberOfTransactions(value string) {
 	o.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -62,10 +77,20 @@ func (o *OriginalGroupInformation23) SetControlSum(value string) {
 	o.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (o *OriginalGroupInformation23) SetControlSumFromDecimal(value decimal.Decimal) {
+	o.ControlSum = new(DecimalNumber)
+	o.ControlSum.FromDecimal(value)
+}
+
 func (o *OriginalGroupInformation23) SetGroupCancellation(value string) {
 	o.GroupCancellation = (*GroupCancellationIndicator)(&value)
 }
 
+func (o *OriginalGroupInformation23) SetGroupCancellationFromBool(value bool) {
+	o.GroupCancellation = new(GroupCancellationIndicator)
+	o.GroupCancellation.FromBool(value)
+}
+
 func (o *OriginalGroupInformation23) AddCancellationReasonInformation() *CancellationReasonInformation3 {
 	newValue := new(CancellationReasonInformation3)
 	o.CancellationReasonInformation = append(o.CancellationReasonInformation, newValue)
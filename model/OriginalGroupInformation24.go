@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the original group, to which the message refers.
 type OriginalGroupInformation24 struct {
 
@@ -34,6 +40,10 @@ type OriginalGroupInformation24 struct {
 	NumberOfTransactionsPerCancellationStatus []*NumberOfTransactionsPerStatus1 `xml:"NbOfTxsPerCxlSts,omitempty"`
 }
 
+func (o *OriginalGroupInformation24) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupInformation24) SetOriginalGroupCancellationIdentification(value string) {
 	o.OriginalGroupCancellationIdentification = (*Max35Text)(&value)
 }
@@ -55,6 +65,11 @@ func (o *OriginalGroupInformation24) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupInformation24) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupInformation24) SetOriginalNumberOfTransactions(value string) {
 	o.OriginalNumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -63,8 +78,17 @@ func (o *OriginalGroupInformation24) SetOriginalControlSum(value string) {
 	o.OriginalControlSum = (*DecimalNumber)(&value)
 }
 
-func (o *OriginalGroupInformation24) SetGroupCancellationStatus(value string) {
+func (o *OriginalGroupInformation24) SetOriginalControlSumFromDecimal(value decimal.Decimal) {
+	o.OriginalControlSum = new(DecimalNumber)
+	o.OriginalControlSum.FromDecimal(value)
+}
+
+func (o *OriginalGroupInformation24) SetGroupCancellationStatus(value string) error {
+	if err := GroupCancellationStatus1Code(value).Validate(); err != nil {
+		return err
+	}
 	o.GroupCancellationStatus = (*GroupCancellationStatus1Code)(&value)
+	return nil
 }
 
 func (o *OriginalGroupInformation24) AddCancellationStatusReasonInformation() *CancellationStatusReasonInformation1 {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the original group, to which the message refers.
 type OriginalGroupInformation25 struct {
 
@@ -28,6 +34,10 @@ type OriginalGroupInformation25 struct {
 	NumberOfTransactionsPerStatus []*NumberOfTransactionsPerStatus3 `xml:"NbOfTxsPerSts,omitempty"`
 }
 
+func (o *OriginalGroupInformation25) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupInformation25) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -40,6 +50,11 @@ func (o *OriginalGroupInformation25) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupInformation25) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupInformation25) SetOriginalNumberOfTransactions(value string) {
 	o.OriginalNumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -48,8 +63,17 @@ func (o *OriginalGroupInformation25) SetOriginalControlSum(value string) {
 	o.OriginalControlSum = (*DecimalNumber)(&value)
 }
 
-func (o *OriginalGroupInformation25) SetGroupStatus(value string) {
+func (o *OriginalGroupInformation25) SetOriginalControlSumFromDecimal(value decimal.Decimal) {
+	o.OriginalControlSum = new(DecimalNumber)
+	o.OriginalControlSum.FromDecimal(value)
+}
+
+func (o *OriginalGroupInformation25) SetGroupStatus(value string) error {
+	if err := TransactionGroupStatus3Code(value).Validate(); err != nil {
+		return err
+	}
 	o.GroupStatus = (*TransactionGroupStatus3Code)(&value)
+	return nil
 }
 
 func (o *OriginalGroupInformation25) AddStatusReasonInformation() *StatusReasonInformation9 {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the original group, to which the message refers.
 type OriginalGroupInformation27 struct {
 
@@ -19,6 +25,10 @@ type OriginalGroupInformation27 struct {
 	OriginalControlSum *DecimalNumber `xml:"OrgnlCtrlSum,omitempty"`
 }
 
+func (o *OriginalGroupInformation27) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupInformation27) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -31,6 +41,11 @@ func (o *OriginalGroupInformation27) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupInformation27) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupInformation27) SetOriginalNumberOfTransactions(value string) {
 	o.OriginalNumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -38,3 +53,8 @@ func (o *OriginalGroupInformation27) SetOriginalNumberOfTransactions(value strin
 func (o *OriginalGroupInformation27) SetOriginalControlSum(value string) {
 	o.OriginalControlSum = (*DecimalNumber)(&value)
 }
+
+func (o *OriginalGroupInformation27) SetOriginalControlSumFromDecimal(value decimal.Decimal) {
+	o.OriginalControlSum = new(DecimalNumber)
+	o.OriginalControlSum.FromDecimal(value)
+}
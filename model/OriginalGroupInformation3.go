@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Unique and unambiguous identifier of the group of transactions as assigned by the original instructing party.
 type OriginalGroupInformation3 struct {
 
@@ -13,6 +19,10 @@ type OriginalGroupInformation3 struct {
 	OriginalCreationDateTime *ISODateTime `xml:"OrgnlCreDtTm,omitempty"`
 }
 
+func (o *OriginalGroupInformation3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupInformation3) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -24,3 +34,58 @@ func (o *OriginalGroupInformation3) SetOriginalMessageNameIdentification(value s
 func (o *OriginalGroupInformation3) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
+
+func (o *OriginalGroupInformation3) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
+func (o *OriginalGroupInformation3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if o.OriginalMessageIdentification != nil {
+		enc.Element("OrgnlMsgId", string(*o.OriginalMessageIdentification))
+	}
+	if o.OriginalMessageNameIdentification != nil {
+		enc.Element("OrgnlMsgNmId", string(*o.OriginalMessageNameIdentification))
+	}
+	if o.OriginalCreationDateTime != nil {
+		enc.Element("OrgnlCreDtTm", string(*o.OriginalCreationDateTime))
+	}
+	enc.End(tag)
+}
+
+func (o *OriginalGroupInformation3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "OrgnlMsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.OriginalMessageIdentification = (*Max35Text)(&value)
+		case "OrgnlMsgNmId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.OriginalMessageNameIdentification = (*Max35Text)(&value)
+		case "OrgnlCreDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.OriginalCreationDateTime = (*ISODateTime)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
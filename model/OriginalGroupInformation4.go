@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Unique and unambiguous identifier of the group of transactions as assigned by the original instructing party.
 type OriginalGroupInformation4 struct {
 
@@ -16,6 +20,10 @@ type OriginalGroupInformation4 struct {
 	CancellationReasonInformation []*CancellationReasonInformation1 `xml:"CxlRsnInf,omitempty"`
 }
 
+func (o *OriginalGroupInformation4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupInformation4) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +36,11 @@ func (o *OriginalGroupInformation4) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupInformation4) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupInformation4) AddCancellationReasonInformation() *CancellationReasonInformation1 {
 	newValue := new(CancellationReasonInformation1)
 	o.CancellationReasonInformation = append(o.CancellationReasonInformation, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Unique and unambiguous identifier of the group of transactions as assigned by the original instructing party.
 type OriginalGroupInformation5 struct {
 
@@ -16,6 +20,10 @@ type OriginalGroupInformation5 struct {
 	ReversalReasonInformation []*ReversalReasonInformation1 `xml:"RvslRsnInf,omitempty"`
 }
 
+func (o *OriginalGroupInformation5) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalGroupInformation5) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +36,11 @@ func (o *OriginalGroupInformation5) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalGroupInformation5) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalGroupInformation5) AddReversalReasonInformation() *ReversalReasonInformation1 {
 	newValue := new(ReversalReasonInformation1)
 	o.ReversalReasonInformation = append(o.ReversalReasonInformation, newValue)
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // General information about the invoice contained in the original request.
 type OriginalInvoiceInformation1 struct {
 
@@ -16,6 +22,10 @@ type OriginalInvoiceInformation1 struct {
 	PaymentDueDate *ISODate `xml:"PmtDueDt"`
 }
 
+func (o *OriginalInvoiceInformation1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalInvoiceInformation1) SetDocumentNumber(value string) {
 	o.DocumentNumber = (*Max35Text)(&value)
 }
@@ -24,10 +34,24 @@ func (o *OriginalInvoiceInformation1) SetTotalInvoiceAmount(value, currency stri
 	o.TotalInvoiceAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalInvoiceInformation1) SetTotalInvoiceAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.TotalInvoiceAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalInvoiceInformation1) SetIssueDate(value string) {
 	o.IssueDate = (*ISODate)(&value)
 }
 
+func (o *OriginalInvoiceInformation1) SetIssueDateFromTime(value time.Time) {
+	o.IssueDate = new(ISODate)
+	o.IssueDate.FromTime(value)
+}
+
 func (o *OriginalInvoiceInformation1) SetPaymentDueDate(value string) {
 	o.PaymentDueDate = (*ISODate)(&value)
 }
+
+func (o *OriginalInvoiceInformation1) SetPaymentDueDateFromTime(value time.Time) {
+	o.PaymentDueDate = new(ISODate)
+	o.PaymentDueDate.FromTime(value)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identify the original notification item, to which the cancellation advice refers.
 type OriginalItem2 struct {
 
@@ -19,6 +25,10 @@ type OriginalItem2 struct {
 	OriginalItemReference *OriginalItemReference1 `xml:"OrgnlItmRef,omitempty"`
 }
 
+func (o *OriginalItem2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalItem2) SetOriginalItemIdentification(value string) {
 	o.OriginalItemIdentification = (*Max35Text)(&value)
 }
@@ -31,10 +41,19 @@ func (o *OriginalItem2) SetAmount(value, currency string) {
 	o.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalItem2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalItem2) SetExpectedValueDate(value string) {
 	o.ExpectedValueDate = (*ISODate)(&value)
 }
 
+func (o *OriginalItem2) SetExpectedValueDateFromTime(value time.Time) {
+	o.ExpectedValueDate = new(ISODate)
+	o.ExpectedValueDate.FromTime(value)
+}
+
 func (o *OriginalItem2) AddOriginalItemReference() *OriginalItemReference1 {
 	o.OriginalItemReference = new(OriginalItemReference1)
 	return o.OriginalItemReference
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identifies the original notification item, to which the cancellation advice refers.
 type OriginalItem3 struct {
 
@@ -19,6 +25,10 @@ type OriginalItem3 struct {
 	OriginalItemReference *OriginalItemReference2 `xml:"OrgnlItmRef,omitempty"`
 }
 
+func (o *OriginalItem3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalItem3) SetOriginalItemIdentification(value string) {
 	o.OriginalItemIdentification = (*Max35Text)(&value)
 }
@@ -31,10 +41,19 @@ func (o *OriginalItem3) SetAmount(value, currency string) {
 	o.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalItem3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalItem3) SetExpectedValueDate(value string) {
 	o.ExpectedValueDate = (*ISODate)(&value)
 }
 
+func (o *OriginalItem3) SetExpectedValueDateFromTime(value time.Time) {
+	o.ExpectedValueDate = new(ISODate)
+	o.ExpectedValueDate.FromTime(value)
+}
+
 func (o *OriginalItem3) AddOriginalItemReference() *OriginalItemReference2 {
 	o.OriginalItemReference = new(OriginalItemReference2)
 	return o.OriginalItemReference
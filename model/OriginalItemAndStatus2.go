@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identify the original notification item and to provide the status.
 type OriginalItemAndStatus2 struct {
 
@@ -25,6 +31,10 @@ type OriginalItemAndStatus2 struct {
 	OriginalItemReference *OriginalItemReference1 `xml:"OrgnlItmRef,omitempty"`
 }
 
+func (o *OriginalItemAndStatus2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalItemAndStatus2) SetOriginalItemIdentification(value string) {
 	o.OriginalItemIdentification = (*Max35Text)(&value)
 }
@@ -37,10 +47,19 @@ func (o *OriginalItemAndStatus2) SetAmount(value, currency string) {
 	o.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalItemAndStatus2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalItemAndStatus2) SetExpectedValueDate(value string) {
 	o.ExpectedValueDate = (*ISODate)(&value)
 }
 
+func (o *OriginalItemAndStatus2) SetExpectedValueDateFromTime(value time.Time) {
+	o.ExpectedValueDate = new(ISODate)
+	o.ExpectedValueDate.FromTime(value)
+}
+
 func (o *OriginalItemAndStatus2) SetItemStatus(value string) {
 	o.ItemStatus = (*NotificationStatus3Code)(&value)
 }
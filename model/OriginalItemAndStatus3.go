@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identifies the original notification item and to provide the status.
 type OriginalItemAndStatus3 struct {
 
@@ -25,6 +31,10 @@ type OriginalItemAndStatus3 struct {
 	OriginalItemReference *OriginalItemReference2 `xml:"OrgnlItmRef,omitempty"`
 }
 
+func (o *OriginalItemAndStatus3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalItemAndStatus3) SetOriginalItemIdentification(value string) {
 	o.OriginalItemIdentification = (*Max35Text)(&value)
 }
@@ -37,10 +47,19 @@ func (o *OriginalItemAndStatus3) SetAmount(value, currency string) {
 	o.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalItemAndStatus3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalItemAndStatus3) SetExpectedValueDate(value string) {
 	o.ExpectedValueDate = (*ISODate)(&value)
 }
 
+func (o *OriginalItemAndStatus3) SetExpectedValueDateFromTime(value time.Time) {
+	o.ExpectedValueDate = new(ISODate)
+	o.ExpectedValueDate.FromTime(value)
+}
+
 func (o *OriginalItemAndStatus3) SetItemStatus(value string) {
 	o.ItemStatus = (*NotificationStatus3Code)(&value)
 }
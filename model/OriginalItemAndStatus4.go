@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identifies the original notification item and to provide the status.
 type OriginalItemAndStatus4 struct {
 
@@ -25,6 +31,10 @@ type OriginalItemAndStatus4 struct {
 	OriginalItemReference *OriginalItemReference3 `xml:"OrgnlItmRef,omitempty"`
 }
 
+func (o *OriginalItemAndStatus4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalItemAndStatus4) SetOriginalItemIdentification(value string) {
 	o.OriginalItemIdentification = (*Max35Text)(&value)
 }
@@ -37,10 +47,19 @@ func (o *OriginalItemAndStatus4) SetAmount(value, currency string) {
 	o.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalItemAndStatus4) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalItemAndStatus4) SetExpectedValueDate(value string) {
 	o.ExpectedValueDate = (*ISODate)(&value)
 }
 
+func (o *OriginalItemAndStatus4) SetExpectedValueDateFromTime(value time.Time) {
+	o.ExpectedValueDate = new(ISODate)
+	o.ExpectedValueDate.FromTime(value)
+}
+
 func (o *OriginalItemAndStatus4) SetItemStatus(value string) {
 	o.ItemStatus = (*NotificationStatus3Code)(&value)
 }
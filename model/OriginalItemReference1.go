@@ -34,6 +34,10 @@ type OriginalItemReference1 struct {
 	RemittanceInformation *RemittanceInformation6 `xml:"RmtInf,omitempty"`
 }
 
+func (o *OriginalItemReference1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalItemReference1) AddAccount() *CashAccount16 {
 	o.Account = new(CashAccount16)
 	return o.Account
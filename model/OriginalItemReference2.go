@@ -34,6 +34,10 @@ type OriginalItemReference2 struct {
 	RemittanceInformation *RemittanceInformation7 `xml:"RmtInf,omitempty"`
 }
 
+func (o *OriginalItemReference2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalItemReference2) AddAccount() *CashAccount24 {
 	o.Account = new(CashAccount24)
 	return o.Account
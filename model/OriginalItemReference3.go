@@ -34,6 +34,10 @@ type OriginalItemReference3 struct {
 	RemittanceInformation *RemittanceInformation10 `xml:"RmtInf,omitempty"`
 }
 
+func (o *OriginalItemReference3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalItemReference3) AddAccount() *CashAccount24 {
 	o.Account = new(CashAccount24)
 	return o.Account
@@ -34,6 +34,10 @@ type OriginalItemReference4 struct {
 	RemittanceInformation *RemittanceInformation11 `xml:"RmtInf,omitempty"`
 }
 
+func (o *OriginalItemReference4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalItemReference4) AddAccount() *CashAccount24 {
 	o.Account = new(CashAccount24)
 	return o.Account
@@ -10,6 +10,10 @@ type OriginalMandate1Choice struct {
 	OriginalMandate *MandateInformation1 `xml:"OrgnlMndt"`
 }
 
+func (o *OriginalMandate1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalMandate1Choice) SetOriginalMandateIdentification(value string) {
 	o.OriginalMandateIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type OriginalMandate2Choice struct {
 	OriginalMandate *Mandate1 `xml:"OrgnlMndt"`
 }
 
+func (o *OriginalMandate2Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalMandate2Choice) SetOriginalMandateIdentification(value string) {
 	o.OriginalMandateIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type OriginalMandate3Choice struct {
 	OriginalMandate *Mandate5 `xml:"OrgnlMndt"`
 }
 
+func (o *OriginalMandate3Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalMandate3Choice) SetOriginalMandateIdentification(value string) {
 	o.OriginalMandateIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type OriginalMandate4Choice struct {
 	OriginalMandate *Mandate9 `xml:"OrgnlMndt"`
 }
 
+func (o *OriginalMandate4Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalMandate4Choice) SetOriginalMandateIdentification(value string) {
 	o.OriginalMandateIdentification = (*Max35Text)(&value)
 }
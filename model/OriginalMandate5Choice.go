@@ -10,6 +10,10 @@ type OriginalMandate5Choice struct {
 	OriginalMandate *Mandate11 `xml:"OrgnlMndt"`
 }
 
+func (o *OriginalMandate5Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalMandate5Choice) SetOriginalMandateIdentification(value string) {
 	o.OriginalMandateIdentification = (*Max35Text)(&value)
 }
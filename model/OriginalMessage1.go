@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identification of a message previously sent.
 type OriginalMessage1 struct {
 
@@ -23,6 +27,10 @@ type OriginalMessage1 struct {
 	CopyDuplicate *CopyDuplicate1Code `xml:"CpyDplct,omitempty"`
 }
 
+func (o *OriginalMessage1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalMessage1) SetMessageDefinitionIdentifier(value string) {
 	o.MessageDefinitionIdentifier = (*Max35Text)(&value)
 }
@@ -45,6 +53,15 @@ func (o *OriginalMessage1) SetCreationDate(value string) {
 	o.CreationDate = (*ISONormalisedDateTime)(&value)
 }
 
-func (o *OriginalMessage1) SetCopyDuplicate(value string) {
+func (o *OriginalMessage1) SetCreationDateFromTime(value time.Time) {
+	o.CreationDate = new(ISONormalisedDateTime)
+	o.CreationDate.FromTime(value)
+}
+
+func (o *OriginalMessage1) SetCopyDuplicate(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	o.CopyDuplicate = (*CopyDuplicate1Code)(&value)
+	return nil
 }
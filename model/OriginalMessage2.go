@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Unique and unambiguous identification of the original message references.
 type OriginalMessage2 struct {
 
@@ -22,6 +26,10 @@ type OriginalMessage2 struct {
 	OriginalRecordIdentification *Max35Text `xml:"OrgnlRcrdId"`
 }
 
+func (o *OriginalMessage2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalMessage2) AddOriginalSender() *Party28Choice {
 	o.OriginalSender = new(Party28Choice)
 	return o.OriginalSender
@@ -39,6 +47,11 @@ func (o *OriginalMessage2) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalMessage2) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalMessage2) SetOriginalPackageIdentification(value string) {
 	o.OriginalPackageIdentification = (*Max35Text)(&value)
 }
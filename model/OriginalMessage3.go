@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Unique and unambiguous identification of the original message references.
 type OriginalMessage3 struct {
 
@@ -16,6 +20,10 @@ type OriginalMessage3 struct {
 	OriginalCreationDateTime *ISODateTime `xml:"OrgnlCreDtTm,omitempty"`
 }
 
+func (o *OriginalMessage3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalMessage3) AddOriginalSender() *Party28Choice {
 	o.OriginalSender = new(Party28Choice)
 	return o.OriginalSender
@@ -32,3 +40,8 @@ func (o *OriginalMessage3) SetOriginalMessageNameIdentification(value string) {
 func (o *OriginalMessage3) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
+
+func (o *OriginalMessage3) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
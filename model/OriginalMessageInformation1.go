@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Unique identification, as assigned by the original instructing party, to unambiguously identify the message.
 type OriginalMessageInformation1 struct {
 
@@ -13,6 +17,10 @@ type OriginalMessageInformation1 struct {
 	CreationDateTime *ISODateTime `xml:"CreDtTm,omitempty"`
 }
 
+func (o *OriginalMessageInformation1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalMessageInformation1) SetMessageIdentification(value string) {
 	o.MessageIdentification = (*Max35Text)(&value)
 }
@@ -24,3 +32,8 @@ func (o *OriginalMessageInformation1) SetMessageNameIdentification(value string)
 func (o *OriginalMessageInformation1) SetCreationDateTime(value string) {
 	o.CreationDateTime = (*ISODateTime)(&value)
 }
+
+func (o *OriginalMessageInformation1) SetCreationDateTimeFromTime(value time.Time) {
+	o.CreationDateTime = new(ISODateTime)
+	o.CreationDateTime.FromTime(value)
+}
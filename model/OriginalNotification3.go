@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identify the original notification and to provide the status.
 type OriginalNotification3 struct {
 
@@ -22,6 +26,10 @@ type OriginalNotification3 struct {
 	OriginalNotificationReference []*OriginalNotificationReference2 `xml:"OrgnlNtfctnRef,omitempty"`
 }
 
+func (o *OriginalNotification3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalNotification3) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -30,6 +38,11 @@ func (o *OriginalNotification3) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalNotification3) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalNotification3) SetOriginalNotificationIdentification(value string) {
 	o.OriginalNotificationIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identify the original notification, to which the cancellation advice refers.
 type OriginalNotification4 struct {
 
@@ -19,6 +23,10 @@ type OriginalNotification4 struct {
 	OriginalNotificationReference []*OriginalNotificationReference1 `xml:"OrgnlNtfctnRef,omitempty"`
 }
 
+func (o *OriginalNotification4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalNotification4) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -27,6 +35,11 @@ func (o *OriginalNotification4) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalNotification4) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalNotification4) SetOriginalNotificationIdentification(value string) {
 	o.OriginalNotificationIdentification = (*Max35Text)(&value)
 }
@@ -35,6 +48,11 @@ func (o *OriginalNotification4) SetNotificationCancellation(value string) {
 	o.NotificationCancellation = (*GroupCancellationIndicator)(&value)
 }
 
+func (o *OriginalNotification4) SetNotificationCancellationFromBool(value bool) {
+	o.NotificationCancellation = new(GroupCancellationIndicator)
+	o.NotificationCancellation.FromBool(value)
+}
+
 func (o *OriginalNotification4) AddOriginalNotificationReference() *OriginalNotificationReference1 {
 	newValue := new(OriginalNotificationReference1)
 	o.OriginalNotificationReference = append(o.OriginalNotificationReference, newValue)
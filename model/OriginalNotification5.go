@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies the original notification and to provide the status.
 type OriginalNotification5 struct {
 
@@ -22,6 +26,10 @@ type OriginalNotification5 struct {
 	OriginalNotificationReference []*OriginalNotificationReference3 `xml:"OrgnlNtfctnRef,omitempty"`
 }
 
+func (o *OriginalNotification5) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalNotification5) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -30,6 +38,11 @@ func (o *OriginalNotification5) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalNotification5) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalNotification5) SetOriginalNotificationIdentification(value string) {
 	o.OriginalNotificationIdentification = (*Max35Text)(&value)
 }
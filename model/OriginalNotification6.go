@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies the original notification, to which the cancellation advice refers.
 type OriginalNotification6 struct {
 
@@ -19,6 +23,10 @@ type OriginalNotification6 struct {
 	OriginalNotificationReference []*OriginalNotificationReference4 `xml:"OrgnlNtfctnRef,omitempty"`
 }
 
+func (o *OriginalNotification6) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalNotification6) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -27,6 +35,11 @@ func (o *OriginalNotification6) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalNotification6) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalNotification6) SetOriginalNotificationIdentification(value string) {
 	o.OriginalNotificationIdentification = (*Max35Text)(&value)
 }
@@ -35,6 +48,11 @@ func (o *OriginalNotification6) SetNotificationCancellation(value string) {
 	o.NotificationCancellation = (*GroupCancellationIndicator)(&value)
 }
 
+func (o *OriginalNotification6) SetNotificationCancellationFromBool(value bool) {
+	o.NotificationCancellation = new(GroupCancellationIndicator)
+	o.NotificationCancellation.FromBool(value)
+}
+
 func (o *OriginalNotification6) AddOriginalNotificationReference() *OriginalNotificationReference4 {
 	newValue := new(OriginalNotificationReference4)
 	o.OriginalNotificationReference = append(o.OriginalNotificationReference, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies the original notification and to provide the status.
 type OriginalNotification7 struct {
 
@@ -22,6 +26,10 @@ type OriginalNotification7 struct {
 	OriginalNotificationReference []*OriginalNotificationReference5 `xml:"OrgnlNtfctnRef,omitempty"`
 }
 
+func (o *OriginalNotification7) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalNotification7) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -30,6 +38,11 @@ func (o *OriginalNotification7) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalNotification7) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalNotification7) SetOriginalNotificationIdentification(value string) {
 	o.OriginalNotificationIdentification = (*Max35Text)(&value)
 }
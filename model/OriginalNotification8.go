@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies the original notification, to which the cancellation advice refers.
 type OriginalNotification8 struct {
 
@@ -19,6 +23,10 @@ type OriginalNotification8 struct {
 	OriginalNotificationReference []*OriginalNotificationReference6 `xml:"OrgnlNtfctnRef,omitempty"`
 }
 
+func (o *OriginalNotification8) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalNotification8) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -27,6 +35,11 @@ func (o *OriginalNotification8) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalNotification8) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalNotification8) SetOriginalNotificationIdentification(value string) {
 	o.OriginalNotificationIdentification = (*Max35Text)(&value)
 }
@@ -35,6 +48,11 @@ func (o *OriginalNotification8) SetNotificationCancellation(value string) {
 	o.NotificationCancellation = (*GroupCancellationIndicator)(&value)
 }
 
+func (o *OriginalNotification8) SetNotificationCancellationFromBool(value bool) {
+	o.NotificationCancellation = new(GroupCancellationIndicator)
+	o.NotificationCancellation.FromBool(value)
+}
+
 func (o *OriginalNotification8) AddOriginalNotificationReference() *OriginalNotificationReference6 {
 	newValue := new(OriginalNotificationReference6)
 	o.OriginalNotificationReference = append(o.OriginalNotificationReference, newValue)
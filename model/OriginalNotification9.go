@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies the original notification and to provide the status.
 type OriginalNotification9 struct {
 
@@ -22,6 +26,10 @@ type OriginalNotification9 struct {
 	OriginalNotificationReference []*OriginalNotificationReference7 `xml:"OrgnlNtfctnRef,omitempty"`
 }
 
+func (o *OriginalNotification9) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalNotification9) SetOriginalMessageIdentification(value string) {
 	o.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -30,6 +38,11 @@ func (o *OriginalNotification9) SetOriginalCreationDateTime(value string) {
 	o.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalNotification9) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	o.OriginalCreationDateTime = new(ISODateTime)
+	o.OriginalCreationDateTime.FromTime(value)
+}
+
 func (o *OriginalNotification9) SetOriginalNotificationIdentification(value string) {
 	o.OriginalNotificationIdentification = (*Max35Text)(&value)
 }
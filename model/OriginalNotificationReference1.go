@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the account notification.
 type OriginalNotificationReference1 struct {
 
@@ -34,6 +40,10 @@ type OriginalNotificationReference1 struct {
 	OriginalItem []*OriginalItem2 `xml:"OrgnlItm"`
 }
 
+func (o *OriginalNotificationReference1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalNotificationReference1) AddAccount() *CashAccount16 {
 	o.Account = new(CashAccount16)
 	return o.Account
@@ -58,10 +68,19 @@ func (o *OriginalNotificationReference1) SetTotalAmount(value, currency string)
 	o.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalNotificationReference1) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalNotificationReference1) SetExpectedValueDate(value string) {
 	o.ExpectedValueDate = (*ISODate)(&value)
 }
 
+func (o *OriginalNotificationReference1) SetExpectedValueDateFromTime(value time.Time) {
+	o.ExpectedValueDate = new(ISODate)
+	o.ExpectedValueDate.FromTime(value)
+}
+
 func (o *OriginalNotificationReference1) AddDebtor() *Party12Choice {
 	o.Debtor = new(Party12Choice)
 	return o.Debtor
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the account notification.
 type OriginalNotificationReference2 struct {
 
@@ -34,6 +40,10 @@ type OriginalNotificationReference2 struct {
 	OriginalItemAndStatus []*OriginalItemAndStatus2 `xml:"OrgnlItmAndSts"`
 }
 
+func (o *OriginalNotificationReference2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalNotificationReference2) AddAccount() *CashAccount16 {
 	o.Account = new(CashAccount16)
 	return o.Account
@@ -58,10 +68,19 @@ func (o *OriginalNotificationReference2) SetTotalAmount(value, currency string)
 	o.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalNotificationReference2) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalNotificationReference2) SetExpectedValueDate(value string) {
 	o.ExpectedValueDate = (*ISODate)(&value)
 }
 
+func (o *OriginalNotificationReference2) SetExpectedValueDateFromTime(value time.Time) {
+	o.ExpectedValueDate = new(ISODate)
+	o.ExpectedValueDate.FromTime(value)
+}
+
 func (o *OriginalNotificationReference2) AddDebtor() *Party12Choice {
 	o.Debtor = new(Party12Choice)
 	return o.Debtor
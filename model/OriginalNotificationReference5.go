@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the account notification.
 type OriginalNotificationReference5 struct {
 
@@ -34,6 +40,10 @@ type OriginalNotificationReference5 struct {
 	OriginalItemAndStatus []*OriginalItemAndStatus4 `xml:"OrgnlItmAndSts"`
 }
 
+func (o *OriginalNotificationReference5) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalNotificationReference5) AddAccount() *CashAccount24 {
 	o.Account = new(CashAccount24)
 	return o.Account
@@ -58,10 +68,19 @@ func (o *OriginalNotificationReference5) SetTotalAmount(value, currency string)
 	o.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalNotificationReference5) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalNotificationReference5) SetExpectedValueDate(value string) {
 	o.ExpectedValueDate = (*ISODate)(&value)
 }
 
+func (o *OriginalNotificationReference5) SetExpectedValueDateFromTime(value time.Time) {
+	o.ExpectedValueDate = new(ISODate)
+	o.ExpectedValueDate.FromTime(value)
+}
+
 func (o *OriginalNotificationReference5) AddDebtor() *Party12Choice {
 	o.Debtor = new(Party12Choice)
 	return o.Debtor
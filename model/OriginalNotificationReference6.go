@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the account notification.
 type OriginalNotificationReference6 struct {
 
@@ -34,6 +40,10 @@ type OriginalNotificationReference6 struct {
 	OriginalItem []*OriginalItem4 `xml:"OrgnlItm"`
 }
 
+func (o *OriginalNotificationReference6) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalNotificationReference6) AddAccount() *CashAccount24 {
 	o.Account = new(CashAccount24)
 	return o.Account
@@ -58,10 +68,19 @@ func (o *OriginalNotificationReference6) SetTotalAmount(value, currency string)
 	o.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalNotificationReference6) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalNotificationReference6) SetExpectedValueDate(value string) {
 	o.ExpectedValueDate = (*ISODate)(&value)
 }
 
+func (o *OriginalNotificationReference6) SetExpectedValueDateFromTime(value time.Time) {
+	o.ExpectedValueDate = new(ISODate)
+	o.ExpectedValueDate.FromTime(value)
+}
+
 func (o *OriginalNotificationReference6) AddDebtor() *Party12Choice {
 	o.Debtor = new(Party12Choice)
 	return o.Debtor
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the account notification.
 type OriginalNotificationReference7 struct {
 
@@ -34,6 +40,10 @@ type OriginalNotificationReference7 struct {
 	OriginalItemAndStatus []*OriginalItemAndStatus5 `xml:"OrgnlItmAndSts"`
 }
 
+func (o *OriginalNotificationReference7) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalNotificationReference7) AddAccount() *CashAccount24 {
 	o.Account = new(CashAccount24)
 	return o.Account
@@ -58,10 +68,19 @@ func (o *OriginalNotificationReference7) SetTotalAmount(value, currency string)
 	o.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalNotificationReference7) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalNotificationReference7) SetExpectedValueDate(value string) {
 	o.ExpectedValueDate = (*ISODate)(&value)
 }
 
+func (o *OriginalNotificationReference7) SetExpectedValueDateFromTime(value time.Time) {
+	o.ExpectedValueDate = new(ISODate)
+	o.ExpectedValueDate.FromTime(value)
+}
+
 func (o *OriginalNotificationReference7) AddDebtor() *Party12Choice {
 	o.Debtor = new(Party12Choice)
 	return o.Debtor
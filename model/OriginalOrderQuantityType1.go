@@ -10,6 +10,10 @@ type OriginalOrderQuantityType1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (o *OriginalOrderQuantityType1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalOrderQuantityType1) SetStructured(value string) {
 	o.Structured = (*OrderQuantityType1Code)(&value)
 }
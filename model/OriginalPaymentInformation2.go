@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the original transactions, to which the status report message refers.
 type OriginalPaymentInformation2 struct {
 
@@ -30,6 +34,10 @@ type OriginalPaymentInformation2 struct {
 	TransactionInformation []*PaymentTransactionInformation28 `xml:"TxInf,omitempty"`
 }
 
+func (o *OriginalPaymentInformation2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalPaymentInformation2) SetReversalPaymentInformationIdentification(value string) {
 	o.ReversalPaymentInformationIdentification = (*Max35Text)(&value)
 }
@@ -46,14 +54,29 @@ func (o *OriginalPaymentInformation2) SetOriginalControlSum(value string) {
 	o.OriginalControlSum = (*DecimalNumber)(&value)
 }
 
+func (o *OriginalPaymentInformation2) SetOriginalControlSumFromDecimal(value decimal.Decimal) {
+	o.OriginalControlSum = new(DecimalNumber)
+	o.OriginalControlSum.FromDecimal(value)
+}
+
 func (o *OriginalPaymentInformation2) SetBatchBooking(value string) {
 	o.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (o *OriginalPaymentInformation2) SetBatchBookingFromBool(value bool) {
+	o.BatchBooking = new(BatchBookingIndicator)
+	o.BatchBooking.FromBool(value)
+}
+
 func (o *OriginalPaymentInformation2) SetPaymentInformationReversal(value string) {
 	o.PaymentInformationReversal = (*TrueFalseIndicator)(&value)
 }
 
+func (o *OriginalPaymentInformation2) SetPaymentInformationReversalFromBool(value bool) {
+	o.PaymentInformationReversal = new(TrueFalseIndicator)
+	o.PaymentInformationReversal.FromBool(value)
+}
+
 func (o *OriginalPaymentInformation2) AddReversalReasonInformation() *ReversalReasonInformation6 {
 	newValue := new(ReversalReasonInformation6)
 	o.ReversalReasonInformation = append(o.ReversalReasonInformation, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide reference and status information on the original transactions, included in the original instruction, to which the cancellation request message applies.
 type OriginalPaymentInformation3 struct {
 
@@ -34,6 +38,10 @@ type OriginalPaymentInformation3 struct {
 	TransactionInformationAndStatus []*PaymentTransactionInformation32 `xml:"TxInfAndSts,omitempty"`
 }
 
+func (o *OriginalPaymentInformation3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalPaymentInformation3) SetOriginalPaymentInformationCancellationIdentification(value string) {
 	o.OriginalPaymentInformationCancellationIdentification = (*Max35Text)(&value)
 }
@@ -60,8 +68,17 @@ func (o *OriginalPaymentInformation3) SetOriginalControlSum(value string) {
 	o.OriginalControlSum = (*DecimalNumber)(&value)
 }
 
-func (o *OriginalPaymentInformation3) SetPaymentInformationCancellationStatus(value string) {
+func (o *OriginalPaymentInformation3) SetOriginalControlSumFromDecimal(value decimal.Decimal) {
+	o.OriginalControlSum = new(DecimalNumber)
+	o.OriginalControlSum.FromDecimal(value)
+}
+
+func (o *OriginalPaymentInformation3) SetPaymentInformationCancellationStatus(value string) error {
+	if err := GroupCancellationStatus1Code(value).Validate(); err != nil {
+		return err
+	}
 	o.PaymentInformationCancellationStatus = (*GroupCancellationStatus1Code)(&value)
+	return nil
 }
 
 func (o *OriginalPaymentInformation3) AddCancellationStatusReasonInformation() *CancellationStatusReasonInformation1 {
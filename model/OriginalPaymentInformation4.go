@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide reference and status information on the original transactions, included in the original instruction, to which the cancellation request message applies.
 type OriginalPaymentInformation4 struct {
 
@@ -33,6 +37,10 @@ type OriginalPaymentInformation4 struct {
 	TransactionInformation []*PaymentTransactionInformation30 `xml:"TxInf,omitempty"`
 }
 
+func (o *OriginalPaymentInformation4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalPaymentInformation4) SetPaymentCancellationIdentification(value string) {
 	o.PaymentCancellationIdentification = (*Max35Text)(&value)
 }
@@ -59,10 +67,20 @@ func (o *OriginalPaymentInformation4) SetControlSum(value string) {
 	o.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (o *OriginalPaymentInformation4) SetControlSumFromDecimal(value decimal.Decimal) {
+	o.ControlSum = new(DecimalNumber)
+	o.ControlSum.FromDecimal(value)
+}
+
 func (o *OriginalPaymentInformation4) SetPaymentInformationCancellation(value string) {
 	o.PaymentInformationCancellation = (*GroupCancellationIndicator)(&value)
 }
 
+func (o *OriginalPaymentInformation4) SetPaymentInformationCancellationFromBool(value bool) {
+	o.PaymentInformationCancellation = new(GroupCancellationIndicator)
+	o.PaymentInformationCancellation.FromBool(value)
+}
+
 func (o *OriginalPaymentInformation4) AddCancellationReasonInformation() *CancellationReasonInformation3 {
 	newValue := new(CancellationReasonInformation3)
 	o.CancellationReasonInformation = append(o.CancellationReasonInformation, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the original transactions, to which the status report message refers.
 type OriginalPaymentInformation5 struct {
 
@@ -25,6 +29,10 @@ type OriginalPaymentInformation5 struct {
 	TransactionInformationAndStatus []*PaymentTransactionInformation34 `xml:"TxInfAndSts,omitempty"`
 }
 
+func (o *OriginalPaymentInformation5) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalPaymentInformation5) SetOriginalPaymentInformationIdentification(value string) {
 	o.OriginalPaymentInformationIdentification = (*Max35Text)(&value)
 }
@@ -37,8 +45,17 @@ func (o *OriginalPaymentInformation5) SetOriginalControlSum(value string) {
 	o.OriginalControlSum = (*DecimalNumber)(&value)
 }
 
-func (o *OriginalPaymentInformation5) SetPaymentInformationStatus(value string) {
+func (o *OriginalPaymentInformation5) SetOriginalControlSumFromDecimal(value decimal.Decimal) {
+	o.OriginalControlSum = new(DecimalNumber)
+	o.OriginalControlSum.FromDecimal(value)
+}
+
+func (o *OriginalPaymentInformation5) SetPaymentInformationStatus(value string) error {
+	if err := TransactionGroupStatus3Code(value).Validate(); err != nil {
+		return err
+	}
 	o.PaymentInformationStatus = (*TransactionGroupStatus3Code)(&value)
+	return nil
 }
 
 func (o *OriginalPaymentInformation5) AddStatusReasonInformation() *StatusReasonInformation9 {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information on the original payment transaction, to which the remittance message applies.
 type OriginalPaymentInformation6 struct {
 
@@ -41,6 +45,10 @@ type OriginalPaymentInformation6 struct {
 	CreditorAgent *BranchAndFinancialInstitutionIdentification5 `xml:"CdtrAgt,omitempty"`
 }
 
+func (o *OriginalPaymentInformation6) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalPaymentInformation6) AddReferences() *TransactionReferences4 {
 	o.References = new(TransactionReferences4)
 	return o.References
@@ -65,10 +73,20 @@ func (o *OriginalPaymentInformation6) SetRequestedExecutionDate(value string) {
 	o.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalPaymentInformation6) SetRequestedExecutionDateFromTime(value time.Time) {
+	o.RequestedExecutionDate = new(ISODate)
+	o.RequestedExecutionDate.FromTime(value)
+}
+
 func (o *OriginalPaymentInformation6) SetRequestedCollectionDate(value string) {
 	o.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalPaymentInformation6) SetRequestedCollectionDateFromTime(value time.Time) {
+	o.RequestedCollectionDate = new(ISODate)
+	o.RequestedCollectionDate.FromTime(value)
+}
+
 func (o *OriginalPaymentInformation6) AddDebtor() *PartyIdentification43 {
 	o.Debtor = new(PartyIdentification43)
 	return o.Debtor
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information on the original payment transaction, to which the remittance message applies.
 type OriginalPaymentInformation7 struct {
 
@@ -41,6 +45,10 @@ type OriginalPaymentInformation7 struct {
 	CreditorAgent *BranchAndFinancialInstitutionIdentification5 `xml:"CdtrAgt,omitempty"`
 }
 
+func (o *OriginalPaymentInformation7) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalPaymentInformation7) AddReferences() *TransactionReferences4 {
 	o.References = new(TransactionReferences4)
 	return o.References
@@ -70,6 +78,11 @@ func (o *OriginalPaymentInformation7) SetRequestedCollectionDate(value string) {
 	o.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalPaymentInformation7) SetRequestedCollectionDateFromTime(value time.Time) {
+	o.RequestedCollectionDate = new(ISODate)
+	o.RequestedCollectionDate.FromTime(value)
+}
+
 func (o *OriginalPaymentInformation7) AddDebtor() *PartyIdentification43 {
 	o.Debtor = new(PartyIdentification43)
 	return o.Debtor
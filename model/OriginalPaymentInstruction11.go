@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the original transactions, to which the status report message refers.
 type OriginalPaymentInstruction11 struct {
 
@@ -30,6 +34,10 @@ type OriginalPaymentInstruction11 struct {
 	TransactionInformation []*PaymentTransaction56 `xml:"TxInf,omitempty"`
 }
 
+func (o *OriginalPaymentInstruction11) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalPaymentInstruction11) SetReversalPaymentInformationIdentification(value string) {
 	o.ReversalPaymentInformationIdentification = (*Max35Text)(&value)
 }
@@ -46,14 +54,29 @@ func (o *OriginalPaymentInstruction11) SetOriginalControlSum(value string) {
 	o.OriginalControlSum = (*DecimalNumber)(&value)
 }
 
+func (o *OriginalPaymentInstruction11) SetOriginalControlSumFromDecimal(value decimal.Decimal) {
+	o.OriginalControlSum = new(DecimalNumber)
+	o.OriginalControlSum.FromDecimal(value)
+}
+
 func (o *OriginalPaymentInstruction11) SetBatchBooking(value string) {
 	o.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (o *OriginalPaymentInstruction11) SetBatchBookingFromBool(value bool) {
+	o.BatchBooking = new(BatchBookingIndicator)
+	o.BatchBooking.FromBool(value)
+}
+
 func (o *OriginalPaymentInstruction11) SetPaymentInformationReversal(value string) {
 	o.PaymentInformationReversal = (*TrueFalseIndicator)(&value)
 }
 
+func (o *OriginalPaymentInstruction11) SetPaymentInformationReversalFromBool(value bool) {
+	o.PaymentInformationReversal = new(TrueFalseIndicator)
+	o.PaymentInformationReversal.FromBool(value)
+}
+
 func (o *OriginalPaymentInstruction11) AddReversalReasonInformation() *PaymentReversalReason7 {
 	newValue := new(PaymentReversalReason7)
 	o.ReversalReasonInformation = append(o.ReversalReasonInformation, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the reference and status of the original transactions, included in the original instruction, to which the cancellation request message applies.
 type OriginalPaymentInstruction15 struct {
 
@@ -33,6 +37,10 @@ type OriginalPaymentInstruction15 struct {
 	TransactionInformation []*PaymentTransaction61 `xml:"TxInf,omitempty"`
 }
 
+func (o *OriginalPaymentInstruction15) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalPaymentInstruction15) SetPaymentCancellationIdentification(value string) {
 	o.PaymentCancellationIdentification = (*Max35Text)(&value)
 }
@@ -59,10 +67,20 @@ func (o *OriginalPaymentInstruction15) SetControlSum(value string) {
 	o.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (o *OriginalPaymentInstruction15) SetControlSumFromDecimal(value decimal.Decimal) {
+	o.ControlSum = new(DecimalNumber)
+	o.ControlSum.FromDecimal(value)
+}
+
 func (o *OriginalPaymentInstruction15) SetPaymentInformationCancellation(value string) {
 	o.PaymentInformationCancellation = (*GroupCancellationIndicator)(&value)
 }
 
+func (o *OriginalPaymentInstruction15) SetPaymentInformationCancellationFromBool(value bool) {
+	o.PaymentInformationCancellation = new(GroupCancellationIndicator)
+	o.PaymentInformationCancellation.FromBool(value)
+}
+
 func (o *OriginalPaymentInstruction15) AddCancellationReasonInformation() *PaymentCancellationReason2 {
 	newValue := new(PaymentCancellationReason2)
 	o.CancellationReasonInformation = append(o.CancellationReasonInformation, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the details on the reference and status of the original transactions, included in the original instruction, to which the cancellation request message applies.
 type OriginalPaymentInstruction17 struct {
 
@@ -34,6 +38,10 @@ type OriginalPaymentInstruction17 struct {
 	TransactionInformationAndStatus []*PaymentTransaction66 `xml:"TxInfAndSts,omitempty"`
 }
 
+func (o *OriginalPaymentInstruction17) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalPaymentInstruction17) SetOriginalPaymentInformationCancellationIdentification(value string) {
 	o.OriginalPaymentInformationCancellationIdentification = (*Max35Text)(&value)
 }
@@ -60,8 +68,17 @@ func (o *OriginalPaymentInstruction17) SetOriginalControlSum(value string) {
 	o.OriginalControlSum = (*DecimalNumber)(&value)
 }
 
-func (o *OriginalPaymentInstruction17) SetPaymentInformationCancellationStatus(value string) {
+func (o *OriginalPaymentInstruction17) SetOriginalControlSumFromDecimal(value decimal.Decimal) {
+	o.OriginalControlSum = new(DecimalNumber)
+	o.OriginalControlSum.FromDecimal(value)
+}
+
+func (o *OriginalPaymentInstruction17) SetPaymentInformationCancellationStatus(value string) error {
+	if err := GroupCancellationStatus1Code(value).Validate(); err != nil {
+		return err
+	}
 	o.PaymentInformationCancellationStatus = (*GroupCancellationStatus1Code)(&value)
+	return nil
 }
 
 func (o *OriginalPaymentInstruction17) AddCancellationStatusReasonInformation() *CancellationStatusReason2 {
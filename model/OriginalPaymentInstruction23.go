@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides details information on the original transactions, to which the status report message refers.
 type OriginalPaymentInstruction23 struct {
 
@@ -25,6 +30,10 @@ type OriginalPaymentInstruction23 struct {
 	TransactionInformationAndStatus []*PaymentTransaction82 `xml:"TxInfAndSts,omitempty"`
 }
 
+func (o *OriginalPaymentInstruction23) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalPaymentInstruction23) SetOriginalPaymentInformationIdentification(value string) {
 	o.OriginalPaymentInformationIdentification = (*Max35Text)(&value)
 }
@@ -37,6 +46,11 @@ func (o *OriginalPaymentInstruction23) SetOriginalControlSum(value string) {
 	o.OriginalControlSum = (*DecimalNumber)(&value)
 }
 
+func (o *OriginalPaymentInstruction23) SetOriginalControlSumFromDecimal(value decimal.Decimal) {
+	o.OriginalControlSum = new(DecimalNumber)
+	o.OriginalControlSum.FromDecimal(value)
+}
+
 func (o *OriginalPaymentInstruction23) SetPaymentInformationStatus(value string) {
 	o.PaymentInformationStatus = (*ExternalPaymentGroupStatus1Code)(&value)
 }
@@ -339,6 +353,98 @@ func (o *OriginalPaymentInstruction23) AddTransactionInformationAndStatus() *Pay
 	return newValue
 }
 
+func (o *OriginalPaymentInstruction23) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if o.OriginalPaymentInformationIdentification != nil {
+		enc.Element("OrgnlPmtInfId", string(*o.OriginalPaymentInformationIdentification))
+	}
+	if o.OriginalNumberOfTransactions != nil {
+		enc.Element("OrgnlNbOfTxs", string(*o.OriginalNumberOfTransactions))
+	}
+	if o.OriginalControlSum != nil {
+		enc.Element("OrgnlCtrlSum", string(*o.OriginalControlSum))
+	}
+	if o.PaymentInformationStatus != nil {
+		enc.Element("PmtInfSts", string(*o.PaymentInformationStatus))
+	}
+	for _, elem := range o.StatusReasonInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "StsRsnInf")
+		}
+	}
+	for _, elem := range o.NumberOfTransactionsPerStatus {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "NbOfTxsPerSts")
+		}
+	}
+	for _, elem := range o.TransactionInformationAndStatus {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "TxInfAndSts")
+		}
+	}
+	enc.End(tag)
+}
+
+func (o *OriginalPaymentInstruction23) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "OrgnlPmtInfId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.OriginalPaymentInformationIdentification = (*Max35Text)(&value)
+		case "OrgnlNbOfTxs":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.OriginalNumberOfTransactions = (*Max15NumericText)(&value)
+		case "OrgnlCtrlSum":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.OriginalControlSum = (*DecimalNumber)(&value)
+		case "PmtInfSts":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.PaymentInformationStatus = (*ExternalPaymentGroupStatus1Code)(&value)
+		case "StsRsnInf":
+			elem := new(StatusReasonInformation9)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.StatusReasonInformation = append(o.StatusReasonInformation, elem)
+		case "NbOfTxsPerSts":
+			elem := new(NumberOfTransactionsPerStatus5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.NumberOfTransactionsPerStatus = append(o.NumberOfTransactionsPerStatus, elem)
+		case "TxInfAndSts":
+			elem := new(PaymentTransaction82)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.TransactionInformationAndStatus = append(o.TransactionInformationAndStatus, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the original transactions, to which the status report message refers.
 type OriginalPaymentInstruction24 struct {
 
@@ -25,6 +29,10 @@ type OriginalPaymentInstruction24 struct {
 	TransactionInformationAndStatus []*PaymentTransaction83 `xml:"TxInfAndSts,omitempty"`
 }
 
+func (o *OriginalPaymentInstruction24) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalPaymentInstruction24) SetOriginalPaymentInformationIdentification(value string) {
 	o.OriginalPaymentInformationIdentification = (*Max35Text)(&value)
 }
@@ -37,6 +45,11 @@ func (o *OriginalPaymentInstruction24) SetOriginalControlSum(value string) {
 	o.OriginalControlSum = (*DecimalNumber)(&value)
 }
 
+func (o *OriginalPaymentInstruction24) SetOriginalControlSumFromDecimal(value decimal.Decimal) {
+	o.OriginalControlSum = new(DecimalNumber)
+	o.OriginalControlSum.FromDecimal(value)
+}
+
 func (o *OriginalPaymentInstruction24) SetPaymentInformationStatus(value string) {
 	o.PaymentInformationStatus = (*ExternalPaymentGroupStatus1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details on the original transactions, to which the status report message refers.
 type OriginalPaymentInstruction5 struct {
 
@@ -25,6 +29,10 @@ type OriginalPaymentInstruction5 struct {
 	TransactionInformationAndStatus []*PaymentTransaction41 `xml:"TxInfAndSts,omitempty"`
 }
 
+func (o *OriginalPaymentInstruction5) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalPaymentInstruction5) SetOriginalPaymentInformationIdentification(value string) {
 	o.OriginalPaymentInformationIdentification = (*Max35Text)(&value)
 }
@@ -37,8 +45,17 @@ func (o *OriginalPaymentInstruction5) SetOriginalControlSum(value string) {
 	o.OriginalControlSum = (*DecimalNumber)(&value)
 }
 
-func (o *OriginalPaymentInstruction5) SetPaymentInformationStatus(value string) {
+func (o *OriginalPaymentInstruction5) SetOriginalControlSumFromDecimal(value decimal.Decimal) {
+	o.OriginalControlSum = new(DecimalNumber)
+	o.OriginalControlSum.FromDecimal(value)
+}
+
+func (o *OriginalPaymentInstruction5) SetPaymentInformationStatus(value string) error {
+	if err := TransactionGroupStatus3Code(value).Validate(); err != nil {
+		return err
+	}
 	o.PaymentInformationStatus = (*TransactionGroupStatus3Code)(&value)
+	return nil
 }
 
 func (o *OriginalPaymentInstruction5) AddStatusReasonInformation() *StatusReasonInformation9 {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics that unambiguously identify the original global invoice financing request.
 type OriginalRequestInformation1 struct {
 
@@ -25,6 +29,10 @@ type OriginalRequestInformation1 struct {
 	CancellationStatusInformation *CancellationStatusInformation1 `xml:"CxlStsInf,omitempty"`
 }
 
+func (o *OriginalRequestInformation1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalRequestInformation1) SetIdentification(value string) {
 	o.Identification = (*Max35Text)(&value)
 }
@@ -33,6 +41,11 @@ func (o *OriginalRequestInformation1) SetCreationDateTime(value string) {
 	o.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalRequestInformation1) SetCreationDateTimeFromTime(value time.Time) {
+	o.CreationDateTime = new(ISODateTime)
+	o.CreationDateTime.FromTime(value)
+}
+
 func (o *OriginalRequestInformation1) AddFinancingRequestor() *PartyIdentificationAndAccount6 {
 	o.FinancingRequestor = new(PartyIdentificationAndAccount6)
 	return o.FinancingRequestor
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of key elements of the original transaction being referred to.
 type OriginalTransactionReference1 struct {
 
@@ -71,10 +77,18 @@ type OriginalTransactionReference1 struct {
 	UltimateCreditor *PartyIdentification8 `xml:"UltmtCdtr,omitempty"`
 }
 
+func (o *OriginalTransactionReference1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalTransactionReference1) SetInterbankSettlementAmount(value, currency string) {
 	o.InterbankSettlementAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalTransactionReference1) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.InterbankSettlementAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalTransactionReference1) AddAmount() *AmountType2Choice {
 	o.Amount = new(AmountType2Choice)
 	return o.Amount
@@ -84,14 +98,29 @@ func (o *OriginalTransactionReference1) SetInterbankSettlementDate(value string)
 	o.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference1) SetInterbankSettlementDateFromTime(value time.Time) {
+	o.InterbankSettlementDate = new(ISODate)
+	o.InterbankSettlementDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference1) SetRequestedExecutionDate(value string) {
 	o.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference1) SetRequestedExecutionDateFromTime(value time.Time) {
+	o.RequestedExecutionDate = new(ISODate)
+	o.RequestedExecutionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference1) SetRequestedCollectionDate(value string) {
 	o.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference1) SetRequestedCollectionDateFromTime(value time.Time) {
+	o.RequestedCollectionDate = new(ISODate)
+	o.RequestedCollectionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference1) AddCreditorSchemeIdentification() *PartyIdentification8 {
 	o.CreditorSchemeIdentification = new(PartyIdentification8)
 	return o.CreditorSchemeIdentification
@@ -107,8 +136,12 @@ func (o *OriginalTransactionReference1) AddPaymentTypeInformation() *PaymentType
 	return o.PaymentTypeInformation
 }
 
-func (o *OriginalTransactionReference1) SetPaymentMethod(value string) {
+func (o *OriginalTransactionReference1) SetPaymentMethod(value string) error {
+	if err := PaymentMethod4Code(value).Validate(); err != nil {
+		return err
+	}
 	o.PaymentMethod = (*PaymentMethod4Code)(&value)
+	return nil
 }
 
 func (o *OriginalTransactionReference1) AddMandateRelatedInformation() *MandateRelatedInformation1 {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of key elements used to refer the original transaction.
 type OriginalTransactionReference13 struct {
 
@@ -68,10 +74,18 @@ type OriginalTransactionReference13 struct {
 	UltimateCreditor *PartyIdentification32 `xml:"UltmtCdtr,omitempty"`
 }
 
+func (o *OriginalTransactionReference13) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalTransactionReference13) SetInterbankSettlementAmount(value, currency string) {
 	o.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalTransactionReference13) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalTransactionReference13) AddAmount() *AmountType3Choice {
 	o.Amount = new(AmountType3Choice)
 	return o.Amount
@@ -81,14 +95,29 @@ func (o *OriginalTransactionReference13) SetInterbankSettlementDate(value string
 	o.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference13) SetInterbankSettlementDateFromTime(value time.Time) {
+	o.InterbankSettlementDate = new(ISODate)
+	o.InterbankSettlementDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference13) SetRequestedCollectionDate(value string) {
 	o.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference13) SetRequestedCollectionDateFromTime(value time.Time) {
+	o.RequestedCollectionDate = new(ISODate)
+	o.RequestedCollectionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference13) SetRequestedExecutionDate(value string) {
 	o.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference13) SetRequestedExecutionDateFromTime(value time.Time) {
+	o.RequestedExecutionDate = new(ISODate)
+	o.RequestedExecutionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference13) AddCreditorSchemeIdentification() *PartyIdentification32 {
 	o.CreditorSchemeIdentification = new(PartyIdentification32)
 	return o.CreditorSchemeIdentification
@@ -104,8 +133,12 @@ func (o *OriginalTransactionReference13) AddPaymentTypeInformation() *PaymentTyp
 	return o.PaymentTypeInformation
 }
 
-func (o *OriginalTransactionReference13) SetPaymentMethod(value string) {
+func (o *OriginalTransactionReference13) SetPaymentMethod(value string) error {
+	if err := PaymentMethod4Code(value).Validate(); err != nil {
+		return err
+	}
 	o.PaymentMethod = (*PaymentMethod4Code)(&value)
+	return nil
 }
 
 func (o *OriginalTransactionReference13) AddMandateRelatedInformation() *MandateRelatedInformation6 {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to provide reference information to the original message.
 type OriginalTransactionReference14 struct {
 
@@ -16,6 +20,10 @@ type OriginalTransactionReference14 struct {
 	OriginalTransaction []*PaymentIdentification3 `xml:"OrgnlTx,omitempty"`
 }
 
+func (o *OriginalTransactionReference14) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalTransactionReference14) SetMessageIdentification(value string) {
 	o.MessageIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +36,11 @@ func (o *OriginalTransactionReference14) SetCreationDateTime(value string) {
 	o.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalTransactionReference14) SetCreationDateTimeFromTime(value time.Time) {
+	o.CreationDateTime = new(ISODateTime)
+	o.CreationDateTime.FromTime(value)
+}
+
 func (o *OriginalTransactionReference14) AddOriginalTransaction() *PaymentIdentification3 {
 	newValue := new(PaymentIdentification3)
 	o.OriginalTransaction = append(o.OriginalTransaction, newValue)
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of key elements used to refer the original transaction.
 type OriginalTransactionReference15 struct {
 
@@ -62,10 +68,18 @@ type OriginalTransactionReference15 struct {
 	UltimateCreditor *PartyIdentification43 `xml:"UltmtCdtr,omitempty"`
 }
 
+func (o *OriginalTransactionReference15) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalTransactionReference15) SetInterbankSettlementAmount(value, currency string) {
 	o.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalTransactionReference15) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalTransactionReference15) AddAmount() *AmountType3Choice {
 	o.Amount = new(AmountType3Choice)
 	return o.Amount
@@ -75,14 +89,29 @@ func (o *OriginalTransactionReference15) SetInterbankSettlementDate(value string
 	o.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference15) SetInterbankSettlementDateFromTime(value time.Time) {
+	o.InterbankSettlementDate = new(ISODate)
+	o.InterbankSettlementDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference15) SetRequestedCollectionDate(value string) {
 	o.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference15) SetRequestedCollectionDateFromTime(value time.Time) {
+	o.RequestedCollectionDate = new(ISODate)
+	o.RequestedCollectionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference15) SetRequestedExecutionDate(value string) {
 	o.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference15) SetRequestedExecutionDateFromTime(value time.Time) {
+	o.RequestedExecutionDate = new(ISODate)
+	o.RequestedExecutionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference15) AddCreditorSchemeIdentification() *PartyIdentification43 {
 	o.CreditorSchemeIdentification = new(PartyIdentification43)
 	return o.CreditorSchemeIdentification
@@ -98,8 +127,12 @@ func (o *OriginalTransactionReference15) AddPaymentTypeInformation() *PaymentTyp
 	return o.PaymentTypeInformation
 }
 
-func (o *OriginalTransactionReference15) SetPaymentMethod(value string) {
+func (o *OriginalTransactionReference15) SetPaymentMethod(value string) error {
+	if err := PaymentMethod4Code(value).Validate(); err != nil {
+		return err
+	}
 	o.PaymentMethod = (*PaymentMethod4Code)(&value)
+	return nil
 }
 
 func (o *OriginalTransactionReference15) AddMandateRelatedInformation() *MandateRelatedInformation7 {
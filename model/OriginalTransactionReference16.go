@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Key elements used to refer the original transaction.
 type OriginalTransactionReference16 struct {
 
@@ -68,10 +74,18 @@ type OriginalTransactionReference16 struct {
 	UltimateCreditor *PartyIdentification43 `xml:"UltmtCdtr,omitempty"`
 }
 
+func (o *OriginalTransactionReference16) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalTransactionReference16) SetInterbankSettlementAmount(value, currency string) {
 	o.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalTransactionReference16) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalTransactionReference16) AddAmount() *AmountType3Choice {
 	o.Amount = new(AmountType3Choice)
 	return o.Amount
@@ -81,14 +95,29 @@ func (o *OriginalTransactionReference16) SetInterbankSettlementDate(value string
 	o.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference16) SetInterbankSettlementDateFromTime(value time.Time) {
+	o.InterbankSettlementDate = new(ISODate)
+	o.InterbankSettlementDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference16) SetRequestedCollectionDate(value string) {
 	o.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference16) SetRequestedCollectionDateFromTime(value time.Time) {
+	o.RequestedCollectionDate = new(ISODate)
+	o.RequestedCollectionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference16) SetRequestedExecutionDate(value string) {
 	o.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference16) SetRequestedExecutionDateFromTime(value time.Time) {
+	o.RequestedExecutionDate = new(ISODate)
+	o.RequestedExecutionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference16) AddCreditorSchemeIdentification() *PartyIdentification43 {
 	o.CreditorSchemeIdentification = new(PartyIdentification43)
 	return o.CreditorSchemeIdentification
@@ -104,8 +133,12 @@ func (o *OriginalTransactionReference16) AddPaymentTypeInformation() *PaymentTyp
 	return o.PaymentTypeInformation
 }
 
-func (o *OriginalTransactionReference16) SetPaymentMethod(value string) {
+func (o *OriginalTransactionReference16) SetPaymentMethod(value string) error {
+	if err := PaymentMethod4Code(value).Validate(); err != nil {
+		return err
+	}
 	o.PaymentMethod = (*PaymentMethod4Code)(&value)
+	return nil
 }
 
 func (o *OriginalTransactionReference16) AddMandateRelatedInformation() *MandateRelatedInformation8 {
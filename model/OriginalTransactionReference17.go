@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Key elements used to refer the original transaction.
 type OriginalTransactionReference17 struct {
 
@@ -62,10 +68,18 @@ type OriginalTransactionReference17 struct {
 	UltimateCreditor *PartyIdentification43 `xml:"UltmtCdtr,omitempty"`
 }
 
+func (o *OriginalTransactionReference17) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalTransactionReference17) SetInterbankSettlementAmount(value, currency string) {
 	o.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalTransactionReference17) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalTransactionReference17) AddAmount() *AmountType3Choice {
 	o.Amount = new(AmountType3Choice)
 	return o.Amount
@@ -75,14 +89,29 @@ func (o *OriginalTransactionReference17) SetInterbankSettlementDate(value string
 	o.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference17) SetInterbankSettlementDateFromTime(value time.Time) {
+	o.InterbankSettlementDate = new(ISODate)
+	o.InterbankSettlementDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference17) SetRequestedCollectionDate(value string) {
 	o.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference17) SetRequestedCollectionDateFromTime(value time.Time) {
+	o.RequestedCollectionDate = new(ISODate)
+	o.RequestedCollectionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference17) SetRequestedExecutionDate(value string) {
 	o.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference17) SetRequestedExecutionDateFromTime(value time.Time) {
+	o.RequestedExecutionDate = new(ISODate)
+	o.RequestedExecutionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference17) AddCreditorSchemeIdentification() *PartyIdentification43 {
 	o.CreditorSchemeIdentification = new(PartyIdentification43)
 	return o.CreditorSchemeIdentification
@@ -98,8 +127,12 @@ func (o *OriginalTransactionReference17) AddPaymentTypeInformation() *PaymentTyp
 	return o.PaymentTypeInformation
 }
 
-func (o *OriginalTransactionReference17) SetPaymentMethod(value string) {
+func (o *OriginalTransactionReference17) SetPaymentMethod(value string) error {
+	if err := PaymentMethod4Code(value).Validate(); err != nil {
+		return err
+	}
 	o.PaymentMethod = (*PaymentMethod4Code)(&value)
+	return nil
 }
 
 func (o *OriginalTransactionReference17) AddMandateRelatedInformation() *MandateRelatedInformation9 {
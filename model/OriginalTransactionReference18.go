@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides reference information to the original message.
 type OriginalTransactionReference18 struct {
 
@@ -16,6 +20,10 @@ type OriginalTransactionReference18 struct {
 	OriginalTransaction []*PaymentIdentification4 `xml:"OrgnlTx,omitempty"`
 }
 
+func (o *OriginalTransactionReference18) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalTransactionReference18) SetMessageIdentification(value string) {
 	o.MessageIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +36,11 @@ func (o *OriginalTransactionReference18) SetCreationDateTime(value string) {
 	o.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (o *OriginalTransactionReference18) SetCreationDateTimeFromTime(value time.Time) {
+	o.CreationDateTime = new(ISODateTime)
+	o.CreationDateTime.FromTime(value)
+}
+
 func (o *OriginalTransactionReference18) AddOriginalTransaction() *PaymentIdentification4 {
 	newValue := new(PaymentIdentification4)
 	o.OriginalTransaction = append(o.OriginalTransaction, newValue)
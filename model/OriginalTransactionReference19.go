@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Key elements used to refer the original transaction.
 type OriginalTransactionReference19 struct {
 
@@ -44,6 +48,10 @@ type OriginalTransactionReference19 struct {
 	UltimateCreditor *PartyIdentification43 `xml:"UltmtCdtr,omitempty"`
 }
 
+func (o *OriginalTransactionReference19) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalTransactionReference19) AddAmount() *AmountType3Choice {
 	o.Amount = new(AmountType3Choice)
 	return o.Amount
@@ -53,13 +61,22 @@ func (o *OriginalTransactionReference19) SetRequestedExecutionDate(value string)
 	o.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference19) SetRequestedExecutionDateFromTime(value time.Time) {
+	o.RequestedExecutionDate = new(ISODate)
+	o.RequestedExecutionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference19) AddPaymentTypeInformation() *PaymentTypeInformation19 {
 	o.PaymentTypeInformation = new(PaymentTypeInformation19)
 	return o.PaymentTypeInformation
 }
 
-func (o *OriginalTransactionReference19) SetPaymentMethod(value string) {
+func (o *OriginalTransactionReference19) SetPaymentMethod(value string) error {
+	if err := PaymentMethod4Code(value).Validate(); err != nil {
+		return err
+	}
 	o.PaymentMethod = (*PaymentMethod4Code)(&value)
+	return nil
 }
 
 func (o *OriginalTransactionReference19) AddRemittanceInformation() *RemittanceInformation6 {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Key elements used to refer the original transaction.
 type OriginalTransactionReference20 struct {
 
@@ -68,10 +74,18 @@ type OriginalTransactionReference20 struct {
 	UltimateCreditor *PartyIdentification43 `xml:"UltmtCdtr,omitempty"`
 }
 
+func (o *OriginalTransactionReference20) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalTransactionReference20) SetInterbankSettlementAmount(value, currency string) {
 	o.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalTransactionReference20) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalTransactionReference20) AddAmount() *AmountType4Choice {
 	o.Amount = new(AmountType4Choice)
 	return o.Amount
@@ -81,14 +95,29 @@ func (o *OriginalTransactionReference20) SetInterbankSettlementDate(value string
 	o.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference20) SetInterbankSettlementDateFromTime(value time.Time) {
+	o.InterbankSettlementDate = new(ISODate)
+	o.InterbankSettlementDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference20) SetRequestedCollectionDate(value string) {
 	o.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference20) SetRequestedCollectionDateFromTime(value time.Time) {
+	o.RequestedCollectionDate = new(ISODate)
+	o.RequestedCollectionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference20) SetRequestedExecutionDate(value string) {
 	o.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference20) SetRequestedExecutionDateFromTime(value time.Time) {
+	o.RequestedExecutionDate = new(ISODate)
+	o.RequestedExecutionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference20) AddCreditorSchemeIdentification() *PartyIdentification43 {
 	o.CreditorSchemeIdentification = new(PartyIdentification43)
 	return o.CreditorSchemeIdentification
@@ -104,8 +133,12 @@ func (o *OriginalTransactionReference20) AddPaymentTypeInformation() *PaymentTyp
 	return o.PaymentTypeInformation
 }
 
-func (o *OriginalTransactionReference20) SetPaymentMethod(value string) {
+func (o *OriginalTransactionReference20) SetPaymentMethod(value string) error {
+	if err := PaymentMethod4Code(value).Validate(); err != nil {
+		return err
+	}
 	o.PaymentMethod = (*PaymentMethod4Code)(&value)
+	return nil
 }
 
 func (o *OriginalTransactionReference20) AddMandateRelatedInformation() *MandateRelatedInformation10 {
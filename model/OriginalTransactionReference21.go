@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Key elements used to refer the original transaction.
 type OriginalTransactionReference21 struct {
 
@@ -44,6 +48,10 @@ type OriginalTransactionReference21 struct {
 	UltimateCreditor *PartyIdentification43 `xml:"UltmtCdtr,omitempty"`
 }
 
+func (o *OriginalTransactionReference21) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalTransactionReference21) AddAmount() *AmountType4Choice {
 	o.Amount = new(AmountType4Choice)
 	return o.Amount
@@ -53,13 +61,22 @@ func (o *OriginalTransactionReference21) SetRequestedExecutionDate(value string)
 	o.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference21) SetRequestedExecutionDateFromTime(value time.Time) {
+	o.RequestedExecutionDate = new(ISODate)
+	o.RequestedExecutionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference21) AddPaymentTypeInformation() *PaymentTypeInformation19 {
 	o.PaymentTypeInformation = new(PaymentTypeInformation19)
 	return o.PaymentTypeInformation
 }
 
-func (o *OriginalTransactionReference21) SetPaymentMethod(value string) {
+func (o *OriginalTransactionReference21) SetPaymentMethod(value string) error {
+	if err := PaymentMethod4Code(value).Validate(); err != nil {
+		return err
+	}
 	o.PaymentMethod = (*PaymentMethod4Code)(&value)
+	return nil
 }
 
 func (o *OriginalTransactionReference21) AddRemittanceInformation() *RemittanceInformation6 {
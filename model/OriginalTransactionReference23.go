@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Key elements used to refer the original transaction.
 type OriginalTransactionReference23 struct {
 
@@ -44,6 +48,10 @@ type OriginalTransactionReference23 struct {
 	UltimateCreditor *PartyIdentification43 `xml:"UltmtCdtr,omitempty"`
 }
 
+func (o *OriginalTransactionReference23) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalTransactionReference23) AddAmount() *AmountType4Choice {
 	o.Amount = new(AmountType4Choice)
 	return o.Amount
@@ -53,13 +61,22 @@ func (o *OriginalTransactionReference23) SetRequestedExecutionDate(value string)
 	o.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference23) SetRequestedExecutionDateFromTime(value time.Time) {
+	o.RequestedExecutionDate = new(ISODate)
+	o.RequestedExecutionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference23) AddPaymentTypeInformation() *PaymentTypeInformation19 {
 	o.PaymentTypeInformation = new(PaymentTypeInformation19)
 	return o.PaymentTypeInformation
 }
 
-func (o *OriginalTransactionReference23) SetPaymentMethod(value string) {
+func (o *OriginalTransactionReference23) SetPaymentMethod(value string) error {
+	if err := PaymentMethod4Code(value).Validate(); err != nil {
+		return err
+	}
 	o.PaymentMethod = (*PaymentMethod4Code)(&value)
+	return nil
 }
 
 func (o *OriginalTransactionReference23) AddRemittanceInformation() *RemittanceInformation11 {
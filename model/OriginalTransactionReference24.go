@@ -1,5 +1,12 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Key elements used to refer the original transaction.
 type OriginalTransactionReference24 struct {
 
@@ -68,10 +75,18 @@ type OriginalTransactionReference24 struct {
 	UltimateCreditor *PartyIdentification43 `xml:"UltmtCdtr,omitempty"`
 }
 
+func (o *OriginalTransactionReference24) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalTransactionReference24) SetInterbankSettlementAmount(value, currency string) {
 	o.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (o *OriginalTransactionReference24) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OriginalTransactionReference24) AddAmount() *AmountType4Choice {
 	o.Amount = new(AmountType4Choice)
 	return o.Amount
@@ -81,10 +96,20 @@ func (o *OriginalTransactionReference24) SetInterbankSettlementDate(value string
 	o.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference24) SetInterbankSettlementDateFromTime(value time.Time) {
+	o.InterbankSettlementDate = new(ISODate)
+	o.InterbankSettlementDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference24) SetRequestedCollectionDate(value string) {
 	o.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (o *OriginalTransactionReference24) SetRequestedCollectionDateFromTime(value time.Time) {
+	o.RequestedCollectionDate = new(ISODate)
+	o.RequestedCollectionDate.FromTime(value)
+}
+
 func (o *OriginalTransactionReference24) AddRequestedExecutionDate() *DateAndDateTimeChoice {
 	o.RequestedExecutionDate = new(DateAndDateTimeChoice)
 	return o.RequestedExecutionDate
@@ -105,8 +130,12 @@ func (o *OriginalTransactionReference24) AddPaymentTypeInformation() *PaymentTyp
 	return o.PaymentTypeInformation
 }
 
-func (o *OriginalTransactionReference24) SetPaymentMethod(value string) {
+func (o *OriginalTransactionReference24) SetPaymentMethod(value string) error {
+	if err := PaymentMethod4Code(value).Validate(); err != nil {
+		return err
+	}
 	o.PaymentMethod = (*PaymentMethod4Code)(&value)
+	return nil
 }
 
 func (o *OriginalTransactionReference24) AddMandateRelatedInformation() *MandateRelatedInformation11 {
@@ -168,3 +197,215 @@ func (o *OriginalTransactionReference24) AddUltimateCreditor() *PartyIdentificat
 	o.UltimateCreditor = new(PartyIdentification43)
 	return o.UltimateCreditor
 }
+
+func (o *OriginalTransactionReference24) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if o.InterbankSettlementAmount != nil {
+		o.InterbankSettlementAmount.EncodeXMLFast(enc, "IntrBkSttlmAmt")
+	}
+	if o.Amount != nil {
+		o.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if o.InterbankSettlementDate != nil {
+		enc.Element("IntrBkSttlmDt", string(*o.InterbankSettlementDate))
+	}
+	if o.RequestedCollectionDate != nil {
+		enc.Element("ReqdColltnDt", string(*o.RequestedCollectionDate))
+	}
+	if o.RequestedExecutionDate != nil {
+		o.RequestedExecutionDate.EncodeXMLFast(enc, "ReqdExctnDt")
+	}
+	if o.CreditorSchemeIdentification != nil {
+		o.CreditorSchemeIdentification.EncodeXMLFast(enc, "CdtrSchmeId")
+	}
+	if o.SettlementInformation != nil {
+		o.SettlementInformation.EncodeXMLFast(enc, "SttlmInf")
+	}
+	if o.PaymentTypeInformation != nil {
+		o.PaymentTypeInformation.EncodeXMLFast(enc, "PmtTpInf")
+	}
+	if o.PaymentMethod != nil {
+		enc.Element("PmtMtd", string(*o.PaymentMethod))
+	}
+	if o.MandateRelatedInformation != nil {
+		o.MandateRelatedInformation.EncodeXMLFast(enc, "MndtRltdInf")
+	}
+	if o.RemittanceInformation != nil {
+		o.RemittanceInformation.EncodeXMLFast(enc, "RmtInf")
+	}
+	if o.UltimateDebtor != nil {
+		o.UltimateDebtor.EncodeXMLFast(enc, "UltmtDbtr")
+	}
+	if o.Debtor != nil {
+		o.Debtor.EncodeXMLFast(enc, "Dbtr")
+	}
+	if o.DebtorAccount != nil {
+		o.DebtorAccount.EncodeXMLFast(enc, "DbtrAcct")
+	}
+	if o.DebtorAgent != nil {
+		o.DebtorAgent.EncodeXMLFast(enc, "DbtrAgt")
+	}
+	if o.DebtorAgentAccount != nil {
+		o.DebtorAgentAccount.EncodeXMLFast(enc, "DbtrAgtAcct")
+	}
+	if o.CreditorAgent != nil {
+		o.CreditorAgent.EncodeXMLFast(enc, "CdtrAgt")
+	}
+	if o.CreditorAgentAccount != nil {
+		o.CreditorAgentAccount.EncodeXMLFast(enc, "CdtrAgtAcct")
+	}
+	if o.Creditor != nil {
+		o.Creditor.EncodeXMLFast(enc, "Cdtr")
+	}
+	if o.CreditorAccount != nil {
+		o.CreditorAccount.EncodeXMLFast(enc, "CdtrAcct")
+	}
+	if o.UltimateCreditor != nil {
+		o.UltimateCreditor.EncodeXMLFast(enc, "UltmtCdtr")
+	}
+	enc.End(tag)
+}
+
+func (o *OriginalTransactionReference24) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "IntrBkSttlmAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.InterbankSettlementAmount = elem
+		case "Amt":
+			elem := new(AmountType4Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.Amount = elem
+		case "IntrBkSttlmDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.InterbankSettlementDate = (*ISODate)(&value)
+		case "ReqdColltnDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.RequestedCollectionDate = (*ISODate)(&value)
+		case "ReqdExctnDt":
+			elem := new(DateAndDateTimeChoice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.RequestedExecutionDate = elem
+		case "CdtrSchmeId":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.CreditorSchemeIdentification = elem
+		case "SttlmInf":
+			elem := new(SettlementInstruction4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.SettlementInformation = elem
+		case "PmtTpInf":
+			elem := new(PaymentTypeInformation25)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.PaymentTypeInformation = elem
+		case "PmtMtd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.PaymentMethod = (*PaymentMethod4Code)(&value)
+		case "MndtRltdInf":
+			elem := new(MandateRelatedInformation11)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.MandateRelatedInformation = elem
+		case "RmtInf":
+			elem := new(RemittanceInformation11)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.RemittanceInformation = elem
+		case "UltmtDbtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.UltimateDebtor = elem
+		case "Dbtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.Debtor = elem
+		case "DbtrAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.DebtorAccount = elem
+		case "DbtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.DebtorAgent = elem
+		case "DbtrAgtAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.DebtorAgentAccount = elem
+		case "CdtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.CreditorAgent = elem
+		case "CdtrAgtAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.CreditorAgentAccount = elem
+		case "Cdtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.Creditor = elem
+		case "CdtrAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.CreditorAccount = elem
+		case "UltmtCdtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.UltimateCreditor = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -44,6 +44,10 @@ type OriginalTransactionReference26 struct {
 	UltimateCreditor *PartyIdentification43 `xml:"UltmtCdtr,omitempty"`
 }
 
+func (o *OriginalTransactionReference26) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OriginalTransactionReference26) AddAmount() *AmountType4Choice {
 	o.Amount = new(AmountType4Choice)
 	return o.Amount
@@ -59,8 +63,12 @@ func (o *OriginalTransactionReference26) AddPaymentTypeInformation() *PaymentTyp
 	return o.PaymentTypeInformation
 }
 
-func (o *OriginalTransactionReference26) SetPaymentMethod(value string) {
+func (o *OriginalTransactionReference26) SetPaymentMethod(value string) error {
+	if err := PaymentMethod4Code(value).Validate(); err != nil {
+		return err
+	}
 	o.PaymentMethod = (*PaymentMethod4Code)(&value)
+	return nil
 }
 
 func (o *OriginalTransactionReference26) AddRemittanceInformation() *RemittanceInformation11 {
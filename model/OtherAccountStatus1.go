@@ -10,6 +10,10 @@ type OtherAccountStatus1 struct {
 	Reason *GenericIdentification36 `xml:"Rsn,omitempty"`
 }
 
+func (o *OtherAccountStatus1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAccountStatus1) AddStatus() *GenericIdentification36 {
 	o.Status = new(GenericIdentification36)
 	return o.Status
@@ -80,6 +80,10 @@ type OtherAmounts12 struct {
 	AccruedCapitalisationAmount *AmountAndDirection9 `xml:"AcrdCptlstnAmt,omitempty"`
 }
 
+func (o *OtherAmounts12) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts12) AddAccruedInterestAmount() *AmountAndDirection9 {
 	o.AccruedInterestAmount = new(AmountAndDirection9)
 	return o.AccruedInterestAmount
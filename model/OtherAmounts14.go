@@ -77,6 +77,10 @@ type OtherAmounts14 struct {
 	AccruedCapitalisationAmount *AmountAndDirection9 `xml:"AcrdCptlstnAmt,omitempty"`
 }
 
+func (o *OtherAmounts14) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts14) AddAccruedInterestAmount() *AmountAndDirection9 {
 	o.AccruedInterestAmount = new(AmountAndDirection9)
 	return o.AccruedInterestAmount
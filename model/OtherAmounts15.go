@@ -53,6 +53,10 @@ type OtherAmounts15 struct {
 	AccruedCapitalisationAmount *AmountAndDirection9 `xml:"AcrdCptlstnAmt,omitempty"`
 }
 
+func (o *OtherAmounts15) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts15) AddAccruedInterestAmount() *AmountAndDirection9 {
 	o.AccruedInterestAmount = new(AmountAndDirection9)
 	return o.AccruedInterestAmount
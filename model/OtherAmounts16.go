@@ -125,6 +125,10 @@ type OtherAmounts16 struct {
 	OddLotFee *YesNoIndicator `xml:"OddLotFee,omitempty"`
 }
 
+func (o *OtherAmounts16) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts16) AddChargesFees() *AmountAndDirection29 {
 	o.ChargesFees = new(AmountAndDirection29)
 	return o.ChargesFees
@@ -279,6 +283,11 @@ func (o *OtherAmounts16) SetRemunerationAmountRequest(value string) {
 	o.RemunerationAmountRequest = (*YesNoIndicator)(&value)
 }
 
+func (o *OtherAmounts16) SetRemunerationAmountRequestFromBool(value bool) {
+	o.RemunerationAmountRequest = new(YesNoIndicator)
+	o.RemunerationAmountRequest.FromBool(value)
+}
+
 func (o *OtherAmounts16) AddRemunerationAmount() *AmountAndDirection29 {
 	o.RemunerationAmount = new(AmountAndDirection29)
 	return o.RemunerationAmount
@@ -322,3 +331,8 @@ func (o *OtherAmounts16) AddDifferenceInPrice() *AmountAndDirection29 {
 func (o *OtherAmounts16) SetOddLotFee(value string) {
 	o.OddLotFee = (*YesNoIndicator)(&value)
 }
+
+func (o *OtherAmounts16) SetOddLotFeeFromBool(value bool) {
+	o.OddLotFee = new(YesNoIndicator)
+	o.OddLotFee.FromBool(value)
+}
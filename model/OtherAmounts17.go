@@ -77,6 +77,10 @@ type OtherAmounts17 struct {
 	BookValue *AmountAndDirection9 `xml:"BookVal,omitempty"`
 }
 
+func (o *OtherAmounts17) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts17) AddAccruedInterestAmount() *AmountAndDirection9 {
 	o.AccruedInterestAmount = new(AmountAndDirection9)
 	return o.AccruedInterestAmount
@@ -83,6 +83,10 @@ type OtherAmounts18 struct {
 	CollateralMonitorAmount *AmountAndDirection9 `xml:"CollMntrAmt,omitempty"`
 }
 
+func (o *OtherAmounts18) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts18) AddAccruedInterestAmount() *AmountAndDirection9 {
 	o.AccruedInterestAmount = new(AmountAndDirection9)
 	return o.AccruedInterestAmount
@@ -56,6 +56,10 @@ type OtherAmounts2 struct {
 	AccruedCapitalisationAmount *AmountAndDirection9 `xml:"AcrdCptlstnAmt,omitempty"`
 }
 
+func (o *OtherAmounts2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts2) AddAccruedInterestAmount() *AmountAndDirection9 {
 	o.AccruedInterestAmount = new(AmountAndDirection9)
 	return o.AccruedInterestAmount
@@ -77,6 +77,10 @@ type OtherAmounts28 struct {
 	AccruedCapitalisationAmount *AmountAndDirection44 `xml:"AcrdCptlstnAmt,omitempty"`
 }
 
+func (o *OtherAmounts28) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts28) AddAccruedInterestAmount() *AmountAndDirection44 {
 	o.AccruedInterestAmount = new(AmountAndDirection44)
 	return o.AccruedInterestAmount
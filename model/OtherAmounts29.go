@@ -53,6 +53,10 @@ type OtherAmounts29 struct {
 	AccruedCapitalisationAmount *AmountAndDirection44 `xml:"AcrdCptlstnAmt,omitempty"`
 }
 
+func (o *OtherAmounts29) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts29) AddAccruedInterestAmount() *AmountAndDirection44 {
 	o.AccruedInterestAmount = new(AmountAndDirection44)
 	return o.AccruedInterestAmount
@@ -77,6 +77,10 @@ type OtherAmounts3 struct {
 	AccruedCapitalisationAmount *AmountAndDirection9 `xml:"AcrdCptlstnAmt,omitempty"`
 }
 
+func (o *OtherAmounts3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts3) AddAccruedInterestAmount() *AmountAndDirection9 {
 	o.AccruedInterestAmount = new(AmountAndDirection9)
 	return o.AccruedInterestAmount
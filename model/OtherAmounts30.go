@@ -83,6 +83,10 @@ type OtherAmounts30 struct {
 	CollateralMonitorAmount *AmountAndDirection44 `xml:"CollMntrAmt,omitempty"`
 }
 
+func (o *OtherAmounts30) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts30) AddAccruedInterestAmount() *AmountAndDirection44 {
 	o.AccruedInterestAmount = new(AmountAndDirection44)
 	return o.AccruedInterestAmount
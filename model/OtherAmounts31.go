@@ -77,6 +77,10 @@ type OtherAmounts31 struct {
 	BookValue *AmountAndDirection44 `xml:"BookVal,omitempty"`
 }
 
+func (o *OtherAmounts31) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts31) AddAccruedInterestAmount() *AmountAndDirection44 {
 	o.AccruedInterestAmount = new(AmountAndDirection44)
 	return o.AccruedInterestAmount
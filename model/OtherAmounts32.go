@@ -37,6 +37,10 @@ type OtherAmounts32 struct {
 	ConsumptionTax *AmountAndDirection47 `xml:"CsmptnTax,omitempty"`
 }
 
+func (o *OtherAmounts32) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts32) AddAccruedInterestAmount() *AmountAndDirection47 {
 	o.AccruedInterestAmount = new(AmountAndDirection47)
 	return o.AccruedInterestAmount
@@ -53,6 +53,10 @@ type OtherAmounts33 struct {
 	AccruedCapitalisationAmount *AmountAndDirection58 `xml:"AcrdCptlstnAmt,omitempty"`
 }
 
+func (o *OtherAmounts33) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts33) AddAccruedInterestAmount() *AmountAndDirection58 {
 	o.AccruedInterestAmount = new(AmountAndDirection58)
 	return o.AccruedInterestAmount
@@ -77,6 +77,10 @@ type OtherAmounts34 struct {
 	BookValue *AmountAndDirection58 `xml:"BookVal,omitempty"`
 }
 
+func (o *OtherAmounts34) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts34) AddAccruedInterestAmount() *AmountAndDirection58 {
 	o.AccruedInterestAmount = new(AmountAndDirection58)
 	return o.AccruedInterestAmount
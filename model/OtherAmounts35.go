@@ -77,6 +77,10 @@ type OtherAmounts35 struct {
 	AccruedCapitalisationAmount *AmountAndDirection58 `xml:"AcrdCptlstnAmt,omitempty"`
 }
 
+func (o *OtherAmounts35) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts35) AddAccruedInterestAmount() *AmountAndDirection58 {
 	o.AccruedInterestAmount = new(AmountAndDirection58)
 	return o.AccruedInterestAmount
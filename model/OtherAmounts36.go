@@ -37,6 +37,10 @@ type OtherAmounts36 struct {
 	ConsumptionTax *AmountAndDirection72 `xml:"CsmptnTax,omitempty"`
 }
 
+func (o *OtherAmounts36) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts36) AddAccruedInterestAmount() *AmountAndDirection72 {
 	o.AccruedInterestAmount = new(AmountAndDirection72)
 	return o.AccruedInterestAmount
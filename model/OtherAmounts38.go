@@ -83,6 +83,10 @@ type OtherAmounts38 struct {
 	CollateralMonitorAmount *AmountAndDirection58 `xml:"CollMntrAmt,omitempty"`
 }
 
+func (o *OtherAmounts38) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts38) AddAccruedInterestAmount() *AmountAndDirection58 {
 	o.AccruedInterestAmount = new(AmountAndDirection58)
 	return o.AccruedInterestAmount
@@ -80,6 +80,10 @@ type OtherAmounts4 struct {
 	BookValue *AmountAndDirection9 `xml:"BookVal,omitempty"`
 }
 
+func (o *OtherAmounts4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts4) AddAccruedInterestAmount() *AmountAndDirection9 {
 	o.AccruedInterestAmount = new(AmountAndDirection9)
 	return o.AccruedInterestAmount
@@ -37,6 +37,10 @@ type OtherAmounts8 struct {
 	ConsumptionTax *AmountAndDirection23 `xml:"CsmptnTax,omitempty"`
 }
 
+func (o *OtherAmounts8) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts8) AddAccruedInterestAmount() *AmountAndDirection23 {
 	o.AccruedInterestAmount = new(AmountAndDirection23)
 	return o.AccruedInterestAmount
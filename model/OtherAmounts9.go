@@ -86,6 +86,10 @@ type OtherAmounts9 struct {
 	CollateralMonitorAmount *AmountAndDirection9 `xml:"CollMntrAmt,omitempty"`
 }
 
+func (o *OtherAmounts9) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherAmounts9) AddAccruedInterestAmount() *AmountAndDirection9 {
 	o.AccruedInterestAmount = new(AmountAndDirection9)
 	return o.AccruedInterestAmount
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Formal document used to record a fact and used as proof of the fact, in the context of a commercial trade transaction.
 type OtherCertificateDataSet1 struct {
 
@@ -22,6 +26,10 @@ type OtherCertificateDataSet1 struct {
 	CertificateInformation []*Max350Text `xml:"CertInf,omitempty"`
 }
 
+func (o *OtherCertificateDataSet1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherCertificateDataSet1) AddDataSetIdentification() *DocumentIdentification1 {
 	o.DataSetIdentification = new(DocumentIdentification1)
 	return o.DataSetIdentification
@@ -39,6 +47,11 @@ func (o *OtherCertificateDataSet1) SetIssueDate(value string) {
 	o.IssueDate = (*ISODate)(&value)
 }
 
+func (o *OtherCertificateDataSet1) SetIssueDateFromTime(value time.Time) {
+	o.IssueDate = new(ISODate)
+	o.IssueDate.FromTime(value)
+}
+
 func (o *OtherCertificateDataSet1) AddIssuer() *PartyIdentification26 {
 	o.Issuer = new(PartyIdentification26)
 	return o.Issuer
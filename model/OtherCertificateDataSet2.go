@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Formal document used to record a fact and used as proof of the fact, in the context of a commercial trade transaction.
 type OtherCertificateDataSet2 struct {
 
@@ -22,6 +26,10 @@ type OtherCertificateDataSet2 struct {
 	CertificateInformation []*Max350Text `xml:"CertInf,omitempty"`
 }
 
+func (o *OtherCertificateDataSet2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherCertificateDataSet2) AddDataSetIdentification() *DocumentIdentification1 {
 	o.DataSetIdentification = new(DocumentIdentification1)
 	return o.DataSetIdentification
@@ -39,6 +47,11 @@ func (o *OtherCertificateDataSet2) SetIssueDate(value string) {
 	o.IssueDate = (*ISODate)(&value)
 }
 
+func (o *OtherCertificateDataSet2) SetIssueDateFromTime(value time.Time) {
+	o.IssueDate = new(ISODate)
+	o.IssueDate.FromTime(value)
+}
+
 func (o *OtherCertificateDataSet2) AddIssuer() *PartyIdentification26 {
 	o.Issuer = new(PartyIdentification26)
 	return o.Issuer
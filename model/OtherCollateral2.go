@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the letter of credit or bank guarantee, or other collateral, posted as collateral.
 type OtherCollateral2 struct {
 
@@ -52,6 +58,10 @@ type OtherCollateral2 struct {
 	SafekeepingAccount *SecuritiesAccount19 `xml:"SfkpgAcct,omitempty"`
 }
 
+func (o *OtherCollateral2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherCollateral2) SetCollateralIdentification(value string) {
 	o.CollateralIdentification = (*Max35Text)(&value)
 }
@@ -64,10 +74,18 @@ func (o *OtherCollateral2) SetLetterOfCreditAmount(value, currency string) {
 	o.LetterOfCreditAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral2) SetLetterOfCreditAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.LetterOfCreditAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral2) SetGuaranteeAmount(value, currency string) {
 	o.GuaranteeAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral2) SetGuaranteeAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.GuaranteeAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral2) AddOtherTypeOfCollateral() *OtherTypeOfCollateral2 {
 	o.OtherTypeOfCollateral = new(OtherTypeOfCollateral2)
 	return o.OtherTypeOfCollateral
@@ -87,6 +105,11 @@ func (o *OtherCollateral2) SetLimitedCoverageIndicator(value string) {
 	o.LimitedCoverageIndicator = (*YesNoIndicator)(&value)
 }
 
+func (o *OtherCollateral2) SetLimitedCoverageIndicatorFromBool(value bool) {
+	o.LimitedCoverageIndicator = new(YesNoIndicator)
+	o.LimitedCoverageIndicator.FromBool(value)
+}
+
 func (o *OtherCollateral2) AddIssuer() *PartyIdentification33Choice {
 	o.Issuer = new(PartyIdentification33Choice)
 	return o.Issuer
@@ -96,22 +119,45 @@ func (o *OtherCollateral2) SetValueDate(value string) {
 	o.ValueDate = (*ISODate)(&value)
 }
 
+func (o *OtherCollateral2) SetValueDateFromTime(value time.Time) {
+	o.ValueDate = new(ISODate)
+	o.ValueDate.FromTime(value)
+}
+
 func (o *OtherCollateral2) SetExchangeRate(value string) {
 	o.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (o *OtherCollateral2) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	o.ExchangeRate = new(BaseOneRate)
+	o.ExchangeRate.FromDecimal(value)
+}
+
 func (o *OtherCollateral2) SetMarketValue(value, currency string) {
 	o.MarketValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral2) SetMarketValueFromDecimal(value decimal.Decimal, currency string) {
+	o.MarketValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral2) SetHaircut(value string) {
 	o.Haircut = (*PercentageRate)(&value)
 }
 
+func (o *OtherCollateral2) SetHaircutFromDecimal(value decimal.Decimal) {
+	o.Haircut = new(PercentageRate)
+	o.Haircut.FromDecimal(value)
+}
+
 func (o *OtherCollateral2) SetCollateralValue(value, currency string) {
 	o.CollateralValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral2) SetCollateralValueFromDecimal(value decimal.Decimal, currency string) {
+	o.CollateralValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral2) AddSafekeepingPlace() *SafekeepingPlaceFormat7Choice {
 	o.SafekeepingPlace = new(SafekeepingPlaceFormat7Choice)
 	return o.SafekeepingPlace
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the letter of credit or bank guarantee, or other collateral, posted as collateral.
 type OtherCollateral3 struct {
 
@@ -58,6 +64,10 @@ type OtherCollateral3 struct {
 	SafekeepingAccount *SecuritiesAccount19 `xml:"SfkpgAcct,omitempty"`
 }
 
+func (o *OtherCollateral3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherCollateral3) SetAssetNumber(value string) {
 	o.AssetNumber = (*Max35Text)(&value)
 }
@@ -70,10 +80,18 @@ func (o *OtherCollateral3) SetLetterOfCreditAmount(value, currency string) {
 	o.LetterOfCreditAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral3) SetLetterOfCreditAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.LetterOfCreditAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral3) SetGuaranteeAmount(value, currency string) {
 	o.GuaranteeAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral3) SetGuaranteeAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.GuaranteeAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral3) AddOtherTypeOfCollateral() *OtherTypeOfCollateral2 {
 	o.OtherTypeOfCollateral = new(OtherTypeOfCollateral2)
 	return o.OtherTypeOfCollateral
@@ -98,6 +116,11 @@ func (o *OtherCollateral3) SetLimitedCoverageIndicator(value string) {
 	o.LimitedCoverageIndicator = (*YesNoIndicator)(&value)
 }
 
+func (o *OtherCollateral3) SetLimitedCoverageIndicatorFromBool(value bool) {
+	o.LimitedCoverageIndicator = new(YesNoIndicator)
+	o.LimitedCoverageIndicator.FromBool(value)
+}
+
 func (o *OtherCollateral3) AddIssuer() *PartyIdentification33Choice {
 	o.Issuer = new(PartyIdentification33Choice)
 	return o.Issuer
@@ -112,22 +135,45 @@ func (o *OtherCollateral3) SetValueDate(value string) {
 	o.ValueDate = (*ISODate)(&value)
 }
 
+func (o *OtherCollateral3) SetValueDateFromTime(value time.Time) {
+	o.ValueDate = new(ISODate)
+	o.ValueDate.FromTime(value)
+}
+
 func (o *OtherCollateral3) SetExchangeRate(value string) {
 	o.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (o *OtherCollateral3) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	o.ExchangeRate = new(BaseOneRate)
+	o.ExchangeRate.FromDecimal(value)
+}
+
 func (o *OtherCollateral3) SetMarketValue(value, currency string) {
 	o.MarketValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral3) SetMarketValueFromDecimal(value decimal.Decimal, currency string) {
+	o.MarketValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral3) SetHaircut(value string) {
 	o.Haircut = (*PercentageRate)(&value)
 }
 
+func (o *OtherCollateral3) SetHaircutFromDecimal(value decimal.Decimal) {
+	o.Haircut = new(PercentageRate)
+	o.Haircut.FromDecimal(value)
+}
+
 func (o *OtherCollateral3) SetCollateralValue(value, currency string) {
 	o.CollateralValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral3) SetCollateralValueFromDecimal(value decimal.Decimal, currency string) {
+	o.CollateralValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral3) AddSafekeepingPlace() *SafekeepingPlaceFormat7Choice {
 	o.SafekeepingPlace = new(SafekeepingPlaceFormat7Choice)
 	return o.SafekeepingPlace
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the letter of credit or bank guarantee, or other collateral, posted as collateral.
 type OtherCollateral4 struct {
 
@@ -55,6 +61,10 @@ type OtherCollateral4 struct {
 	SafekeepingAccount *SecuritiesAccount19 `xml:"SfkpgAcct,omitempty"`
 }
 
+func (o *OtherCollateral4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherCollateral4) SetCollateralIdentification(value string) {
 	o.CollateralIdentification = (*Max35Text)(&value)
 }
@@ -71,10 +81,18 @@ func (o *OtherCollateral4) SetLetterOfCreditAmount(value, currency string) {
 	o.LetterOfCreditAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral4) SetLetterOfCreditAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.LetterOfCreditAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral4) SetGuaranteeAmount(value, currency string) {
 	o.GuaranteeAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral4) SetGuaranteeAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.GuaranteeAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral4) AddOtherTypeOfCollateral() *OtherTypeOfCollateral2 {
 	o.OtherTypeOfCollateral = new(OtherTypeOfCollateral2)
 	return o.OtherTypeOfCollateral
@@ -94,6 +112,11 @@ func (o *OtherCollateral4) SetLimitedCoverageIndicator(value string) {
 	o.LimitedCoverageIndicator = (*YesNoIndicator)(&value)
 }
 
+func (o *OtherCollateral4) SetLimitedCoverageIndicatorFromBool(value bool) {
+	o.LimitedCoverageIndicator = new(YesNoIndicator)
+	o.LimitedCoverageIndicator.FromBool(value)
+}
+
 func (o *OtherCollateral4) AddIssuer() *PartyIdentification33Choice {
 	o.Issuer = new(PartyIdentification33Choice)
 	return o.Issuer
@@ -103,22 +126,45 @@ func (o *OtherCollateral4) SetValueDate(value string) {
 	o.ValueDate = (*ISODate)(&value)
 }
 
+func (o *OtherCollateral4) SetValueDateFromTime(value time.Time) {
+	o.ValueDate = new(ISODate)
+	o.ValueDate.FromTime(value)
+}
+
 func (o *OtherCollateral4) SetExchangeRate(value string) {
 	o.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (o *OtherCollateral4) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	o.ExchangeRate = new(BaseOneRate)
+	o.ExchangeRate.FromDecimal(value)
+}
+
 func (o *OtherCollateral4) SetMarketValue(value, currency string) {
 	o.MarketValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral4) SetMarketValueFromDecimal(value decimal.Decimal, currency string) {
+	o.MarketValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral4) SetHaircut(value string) {
 	o.Haircut = (*PercentageRate)(&value)
 }
 
+func (o *OtherCollateral4) SetHaircutFromDecimal(value decimal.Decimal) {
+	o.Haircut = new(PercentageRate)
+	o.Haircut.FromDecimal(value)
+}
+
 func (o *OtherCollateral4) SetCollateralValue(value, currency string) {
 	o.CollateralValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral4) SetCollateralValueFromDecimal(value decimal.Decimal, currency string) {
+	o.CollateralValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral4) AddSafekeepingPlace() *SafekeepingPlaceFormat7Choice {
 	o.SafekeepingPlace = new(SafekeepingPlaceFormat7Choice)
 	return o.SafekeepingPlace
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the letter of credit or bank guarantee, or other collateral, posted as collateral.
 type OtherCollateral5 struct {
 
@@ -52,6 +58,10 @@ type OtherCollateral5 struct {
 	SafekeepingAccount *SecuritiesAccount19 `xml:"SfkpgAcct,omitempty"`
 }
 
+func (o *OtherCollateral5) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherCollateral5) SetCollateralIdentification(value string) {
 	o.CollateralIdentification = (*Max35Text)(&value)
 }
@@ -64,10 +74,18 @@ func (o *OtherCollateral5) SetLetterOfCreditAmount(value, currency string) {
 	o.LetterOfCreditAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral5) SetLetterOfCreditAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.LetterOfCreditAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral5) SetGuaranteeAmount(value, currency string) {
 	o.GuaranteeAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral5) SetGuaranteeAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.GuaranteeAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral5) AddOtherTypeOfCollateral() *OtherTypeOfCollateral2 {
 	o.OtherTypeOfCollateral = new(OtherTypeOfCollateral2)
 	return o.OtherTypeOfCollateral
@@ -87,6 +105,11 @@ func (o *OtherCollateral5) SetLimitedCoverageIndicator(value string) {
 	o.LimitedCoverageIndicator = (*YesNoIndicator)(&value)
 }
 
+func (o *OtherCollateral5) SetLimitedCoverageIndicatorFromBool(value bool) {
+	o.LimitedCoverageIndicator = new(YesNoIndicator)
+	o.LimitedCoverageIndicator.FromBool(value)
+}
+
 func (o *OtherCollateral5) AddIssuer() *PartyIdentification100Choice {
 	o.Issuer = new(PartyIdentification100Choice)
 	return o.Issuer
@@ -96,22 +119,45 @@ func (o *OtherCollateral5) SetValueDate(value string) {
 	o.ValueDate = (*ISODate)(&value)
 }
 
+func (o *OtherCollateral5) SetValueDateFromTime(value time.Time) {
+	o.ValueDate = new(ISODate)
+	o.ValueDate.FromTime(value)
+}
+
 func (o *OtherCollateral5) SetExchangeRate(value string) {
 	o.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (o *OtherCollateral5) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	o.ExchangeRate = new(BaseOneRate)
+	o.ExchangeRate.FromDecimal(value)
+}
+
 func (o *OtherCollateral5) SetMarketValue(value, currency string) {
 	o.MarketValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral5) SetMarketValueFromDecimal(value decimal.Decimal, currency string) {
+	o.MarketValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral5) SetHaircut(value string) {
 	o.Haircut = (*PercentageRate)(&value)
 }
 
+func (o *OtherCollateral5) SetHaircutFromDecimal(value decimal.Decimal) {
+	o.Haircut = new(PercentageRate)
+	o.Haircut.FromDecimal(value)
+}
+
 func (o *OtherCollateral5) SetCollateralValue(value, currency string) {
 	o.CollateralValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral5) SetCollateralValueFromDecimal(value decimal.Decimal, currency string) {
+	o.CollateralValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral5) AddSafekeepingPlace() *SafekeepingPlaceFormat10Choice {
 	o.SafekeepingPlace = new(SafekeepingPlaceFormat10Choice)
 	return o.SafekeepingPlace
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the letter of credit or bank guarantee, or other collateral, posted as collateral.
 type OtherCollateral6 struct {
 
@@ -58,6 +64,10 @@ type OtherCollateral6 struct {
 	SafekeepingAccount *SecuritiesAccount19 `xml:"SfkpgAcct,omitempty"`
 }
 
+func (o *OtherCollateral6) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherCollateral6) SetAssetNumber(value string) {
 	o.AssetNumber = (*Max35Text)(&value)
 }
@@ -70,10 +80,18 @@ func (o *OtherCollateral6) SetLetterOfCreditAmount(value, currency string) {
 	o.LetterOfCreditAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral6) SetLetterOfCreditAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.LetterOfCreditAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral6) SetGuaranteeAmount(value, currency string) {
 	o.GuaranteeAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral6) SetGuaranteeAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.GuaranteeAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral6) AddOtherTypeOfCollateral() *OtherTypeOfCollateral2 {
 	o.OtherTypeOfCollateral = new(OtherTypeOfCollateral2)
 	return o.OtherTypeOfCollateral
@@ -98,6 +116,11 @@ func (o *OtherCollateral6) SetLimitedCoverageIndicator(value string) {
 	o.LimitedCoverageIndicator = (*YesNoIndicator)(&value)
 }
 
+func (o *OtherCollateral6) SetLimitedCoverageIndicatorFromBool(value bool) {
+	o.LimitedCoverageIndicator = new(YesNoIndicator)
+	o.LimitedCoverageIndicator.FromBool(value)
+}
+
 func (o *OtherCollateral6) AddIssuer() *PartyIdentification100Choice {
 	o.Issuer = new(PartyIdentification100Choice)
 	return o.Issuer
@@ -112,22 +135,45 @@ func (o *OtherCollateral6) SetValueDate(value string) {
 	o.ValueDate = (*ISODate)(&value)
 }
 
+func (o *OtherCollateral6) SetValueDateFromTime(value time.Time) {
+	o.ValueDate = new(ISODate)
+	o.ValueDate.FromTime(value)
+}
+
 func (o *OtherCollateral6) SetExchangeRate(value string) {
 	o.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (o *OtherCollateral6) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	o.ExchangeRate = new(BaseOneRate)
+	o.ExchangeRate.FromDecimal(value)
+}
+
 func (o *OtherCollateral6) SetMarketValue(value, currency string) {
 	o.MarketValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral6) SetMarketValueFromDecimal(value decimal.Decimal, currency string) {
+	o.MarketValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral6) SetHaircut(value string) {
 	o.Haircut = (*PercentageRate)(&value)
 }
 
+func (o *OtherCollateral6) SetHaircutFromDecimal(value decimal.Decimal) {
+	o.Haircut = new(PercentageRate)
+	o.Haircut.FromDecimal(value)
+}
+
 func (o *OtherCollateral6) SetCollateralValue(value, currency string) {
 	o.CollateralValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OtherCollateral6) SetCollateralValueFromDecimal(value decimal.Decimal, currency string) {
+	o.CollateralValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OtherCollateral6) AddSafekeepingPlace() *SafekeepingPlaceFormat10Choice {
 	o.SafekeepingPlace = new(SafekeepingPlaceFormat10Choice)
 	return o.SafekeepingPlace
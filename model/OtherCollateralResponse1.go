@@ -19,6 +19,10 @@ type OtherCollateralResponse1 struct {
 	RejectionInformation *Max35Text `xml:"RjctnInf,omitempty"`
 }
 
+func (o *OtherCollateralResponse1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherCollateralResponse1) SetResponseType(value string) {
 	o.ResponseType = (*Status4Code)(&value)
 }
@@ -19,6 +19,10 @@ type OtherCollateralResponse2 struct {
 	RejectionInformation *Max35Text `xml:"RjctnInf,omitempty"`
 }
 
+func (o *OtherCollateralResponse2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherCollateralResponse2) SetResponseType(value string) {
 	o.ResponseType = (*Status4Code)(&value)
 }
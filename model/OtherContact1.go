@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Communication device number or electronic address used for communication.
 type OtherContact1 struct {
 
@@ -10,6 +12,10 @@ type OtherContact1 struct {
 	Identification *Max128Text `xml:"Id,omitempty"`
 }
 
+func (o *OtherContact1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherContact1) SetChannelType(value string) {
 	o.ChannelType = (*Max4Text)(&value)
 }
@@ -17,3 +23,44 @@ func (o *OtherContact1) SetChannelType(value string) {
 func (o *OtherContact1) SetIdentification(value string) {
 	o.Identification = (*Max128Text)(&value)
 }
+
+func (o *OtherContact1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if o.ChannelType != nil {
+		enc.Element("ChanlTp", string(*o.ChannelType))
+	}
+	if o.Identification != nil {
+		enc.Element("Id", string(*o.Identification))
+	}
+	enc.End(tag)
+}
+
+func (o *OtherContact1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "ChanlTp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.ChannelType = (*Max4Text)(&value)
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.Identification = (*Max128Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
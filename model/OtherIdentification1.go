@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Other accepted financial instrument's identification than ISIN.
 type OtherIdentification1 struct {
 
@@ -13,6 +15,10 @@ type OtherIdentification1 struct {
 	Type *IdentificationSource3Choice `xml:"Tp"`
 }
 
+func (o *OtherIdentification1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherIdentification1) SetIdentification(value string) {
 	o.Identification = (*Max35Text)(&value)
 }
@@ -25,3 +31,53 @@ func (o *OtherIdentification1) AddType() *IdentificationSource3Choice {
 	o.Type = new(IdentificationSource3Choice)
 	return o.Type
 }
+
+func (o *OtherIdentification1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if o.Identification != nil {
+		enc.Element("Id", string(*o.Identification))
+	}
+	if o.Suffix != nil {
+		enc.Element("Sfx", string(*o.Suffix))
+	}
+	if o.Type != nil {
+		o.Type.EncodeXMLFast(enc, "Tp")
+	}
+	enc.End(tag)
+}
+
+func (o *OtherIdentification1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.Identification = (*Max35Text)(&value)
+		case "Sfx":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			o.Suffix = (*Max16Text)(&value)
+		case "Tp":
+			elem := new(IdentificationSource3Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			o.Type = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
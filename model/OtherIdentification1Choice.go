@@ -10,6 +10,10 @@ type OtherIdentification1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OtherIdentification1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherIdentification1Choice) SetCode(value string) {
 	o.Code = (*PersonIdentificationType5Code)(&value)
 }
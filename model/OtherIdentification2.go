@@ -13,6 +13,10 @@ type OtherIdentification2 struct {
 	Type *IdentificationSource4Choice `xml:"Tp"`
 }
 
+func (o *OtherIdentification2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherIdentification2) SetIdentification(value string) {
 	o.Identification = (*RestrictedFINXMax31Text)(&value)
 }
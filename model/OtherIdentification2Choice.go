@@ -10,6 +10,10 @@ type OtherIdentification2Choice struct {
 	Proprietary *GenericIdentification29 `xml:"Prtry"`
 }
 
+func (o *OtherIdentification2Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherIdentification2Choice) SetCode(value string) {
 	o.Code = (*PersonIdentificationType6Code)(&value)
 }
@@ -13,6 +13,10 @@ type OtherIdentification3 struct {
 	Type *IdentificationSource3Choice `xml:"Tp"`
 }
 
+func (o *OtherIdentification3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherIdentification3) SetIdentification(value string) {
 	o.Identification = (*RestrictedFINXMax31Text)(&value)
 }
@@ -10,6 +10,10 @@ type OtherIdentification3Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OtherIdentification3Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherIdentification3Choice) SetCode(value string) {
 	o.Code = (*PartyIdentificationType7Code)(&value)
 }
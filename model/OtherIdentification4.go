@@ -10,6 +10,10 @@ type OtherIdentification4 struct {
 	Type *IdentificationSource5Choice `xml:"Tp"`
 }
 
+func (o *OtherIdentification4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherIdentification4) SetIdentification(value string) {
 	o.Identification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type OtherIdentification4Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (o *OtherIdentification4Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherIdentification4Choice) SetCode(value string) {
 	o.Code = (*PersonIdentificationType6Code)(&value)
 }
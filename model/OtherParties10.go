@@ -13,6 +13,10 @@ type OtherParties10 struct {
 	TradeRegulator *PartyIdentification49Choice `xml:"TradRgltr,omitempty"`
 }
 
+func (o *OtherParties10) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties10) AddInvestor() *PartyIdentification37Choice {
 	o.Investor = new(PartyIdentification37Choice)
 	return o.Investor
@@ -19,6 +19,10 @@ type OtherParties11 struct {
 	TripartyAgent *PartyIdentification43Choice `xml:"TrptyAgt,omitempty"`
 }
 
+func (o *OtherParties11) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties11) AddInvestor() *PartyIdentification37Choice {
 	o.Investor = new(PartyIdentification37Choice)
 	return o.Investor
@@ -22,6 +22,10 @@ type OtherParties12 struct {
 	Broker *PartyIdentificationAndAccount41 `xml:"Brkr,omitempty"`
 }
 
+func (o *OtherParties12) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties12) AddInvestor() *PartyIdentificationAndAccount46 {
 	newValue := new(PartyIdentificationAndAccount46)
 	o.Investor = append(o.Investor, newValue)
@@ -19,6 +19,10 @@ type OtherParties18 struct {
 	QualifiedForeignIntermediary *PartyIdentificationAndAccount77 `xml:"QlfdFrgnIntrmy,omitempty"`
 }
 
+func (o *OtherParties18) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties18) AddInvestor() *PartyIdentificationAndAccount79 {
 	newValue := new(PartyIdentificationAndAccount79)
 	o.Investor = append(o.Investor, newValue)
@@ -22,6 +22,10 @@ type OtherParties19 struct {
 	Broker *PartyIdentificationAndAccount41 `xml:"Brkr,omitempty"`
 }
 
+func (o *OtherParties19) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties19) AddInvestor() *PartyIdentificationAndAccount81 {
 	newValue := new(PartyIdentificationAndAccount81)
 	o.Investor = append(o.Investor, newValue)
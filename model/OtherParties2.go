@@ -19,6 +19,10 @@ type OtherParties2 struct {
 	TripartyAgent *PartyIdentificationAndAccount21 `xml:"TrptyAgt,omitempty"`
 }
 
+func (o *OtherParties2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties2) AddInvestor() *PartyIdentificationAndAccount19 {
 	newValue := new(PartyIdentificationAndAccount19)
 	o.Investor = append(o.Investor, newValue)
@@ -13,6 +13,10 @@ type OtherParties26 struct {
 	TradeRegulator *PartyIdentification100 `xml:"TradRgltr,omitempty"`
 }
 
+func (o *OtherParties26) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties26) AddInvestor() *PartyIdentification99 {
 	o.Investor = new(PartyIdentification99)
 	return o.Investor
@@ -22,6 +22,10 @@ type OtherParties27 struct {
 	Broker *PartyIdentificationAndAccount107 `xml:"Brkr,omitempty"`
 }
 
+func (o *OtherParties27) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties27) AddInvestor() *PartyIdentificationAndAccount108 {
 	newValue := new(PartyIdentificationAndAccount108)
 	o.Investor = append(o.Investor, newValue)
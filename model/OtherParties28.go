@@ -19,6 +19,10 @@ type OtherParties28 struct {
 	TripartyAgent *PartyIdentification100 `xml:"TrptyAgt,omitempty"`
 }
 
+func (o *OtherParties28) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties28) AddInvestor() *PartyIdentification99 {
 	o.Investor = new(PartyIdentification99)
 	return o.Investor
@@ -22,6 +22,10 @@ type OtherParties29 struct {
 	Broker *PartyIdentificationAndAccount136 `xml:"Brkr,omitempty"`
 }
 
+func (o *OtherParties29) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties29) AddInvestor() *PartyIdentificationAndAccount135 {
 	newValue := new(PartyIdentificationAndAccount135)
 	o.Investor = append(o.Investor, newValue)
@@ -19,6 +19,10 @@ type OtherParties3 struct {
 	TripartyAgent *PartyIdentification10Choice `xml:"TrptyAgt,omitempty"`
 }
 
+func (o *OtherParties3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties3) AddInvestor() *PartyIdentification14Choice {
 	o.Investor = new(PartyIdentification14Choice)
 	return o.Investor
@@ -13,6 +13,10 @@ type OtherParties30 struct {
 	TradeRegulator *PartyIdentification111 `xml:"TradRgltr,omitempty"`
 }
 
+func (o *OtherParties30) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties30) AddInvestor() *PartyIdentification110 {
 	o.Investor = new(PartyIdentification110)
 	return o.Investor
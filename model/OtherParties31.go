@@ -19,6 +19,10 @@ type OtherParties31 struct {
 	TripartyAgent *PartyIdentification111 `xml:"TrptyAgt,omitempty"`
 }
 
+func (o *OtherParties31) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties31) AddInvestor() *PartyIdentification110 {
 	o.Investor = new(PartyIdentification110)
 	return o.Investor
@@ -13,6 +13,10 @@ type OtherParties4 struct {
 	TradeRegulator *PartyIdentification10Choice `xml:"TradRgltr,omitempty"`
 }
 
+func (o *OtherParties4) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties4) AddInvestor() *PartyIdentification14Choice {
 	o.Investor = new(PartyIdentification14Choice)
 	return o.Investor
@@ -22,6 +22,10 @@ type OtherParties8 struct {
 	Broker *PartyIdentificationAndAccount41 `xml:"Brkr,omitempty"`
 }
 
+func (o *OtherParties8) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties8) AddInvestor() *PartyIdentificationAndAccount40 {
 	newValue := new(PartyIdentificationAndAccount40)
 	o.Investor = append(o.Investor, newValue)
@@ -19,6 +19,10 @@ type OtherParties9 struct {
 	TripartyAgent *PartyIdentificationAndAccount37 `xml:"TrptyAgt,omitempty"`
 }
 
+func (o *OtherParties9) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherParties9) AddInvestor() *PartyIdentificationAndAccount36 {
 	newValue := new(PartyIdentificationAndAccount36)
 	o.Investor = append(o.Investor, newValue)
@@ -49,6 +49,10 @@ type OtherPrices1 struct {
 	ReferencePrice *PriceInformation9 `xml:"RefPric,omitempty"`
 }
 
+func (o *OtherPrices1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherPrices1) AddMaximum() *Price4 {
 	o.Maximum = new(Price4)
 	return o.Maximum
@@ -49,6 +49,10 @@ type OtherPrices2 struct {
 	ReferencePrice *PriceInformation11 `xml:"RefPric,omitempty"`
 }
 
+func (o *OtherPrices2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherPrices2) AddMaximum() *Price4 {
 	o.Maximum = new(Price4)
 	return o.Maximum
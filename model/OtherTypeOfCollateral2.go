@@ -10,6 +10,10 @@ type OtherTypeOfCollateral2 struct {
 	Quantity *FinancialInstrumentQuantity1Choice `xml:"Qty,omitempty"`
 }
 
+func (o *OtherTypeOfCollateral2) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OtherTypeOfCollateral2) SetDescription(value string) {
 	o.Description = (*Max140Text)(&value)
 }
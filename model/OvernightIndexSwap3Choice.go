@@ -10,6 +10,10 @@ type OvernightIndexSwap3Choice struct {
 	Transaction []*OvernightIndexSwapTransaction3 `xml:"Tx"`
 }
 
+func (o *OvernightIndexSwap3Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OvernightIndexSwap3Choice) SetDataSetAction(value string) {
 	o.DataSetAction = (*ReportPeriodActivity1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the details of each individual overnight index swap transaction.
 type OvernightIndexSwapTransaction3 struct {
 
@@ -52,6 +58,10 @@ type OvernightIndexSwapTransaction3 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (o *OvernightIndexSwapTransaction3) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OvernightIndexSwapTransaction3) SetReportedTransactionStatus(value string) {
 	o.ReportedTransactionStatus = (*TransactionOperationType1Code)(&value)
 }
@@ -86,10 +96,20 @@ func (o *OvernightIndexSwapTransaction3) SetStartDate(value string) {
 	o.StartDate = (*ISODate)(&value)
 }
 
+func (o *OvernightIndexSwapTransaction3) SetStartDateFromTime(value time.Time) {
+	o.StartDate = new(ISODate)
+	o.StartDate.FromTime(value)
+}
+
 func (o *OvernightIndexSwapTransaction3) SetMaturityDate(value string) {
 	o.MaturityDate = (*ISODate)(&value)
 }
 
+func (o *OvernightIndexSwapTransaction3) SetMaturityDateFromTime(value time.Time) {
+	o.MaturityDate = new(ISODate)
+	o.MaturityDate.FromTime(value)
+}
+
 func (o *OvernightIndexSwapTransaction3) AddFixedInterestRate() *Rate2 {
 	o.FixedInterestRate = new(Rate2)
 	return o.FixedInterestRate
@@ -103,6 +123,10 @@ func (o *OvernightIndexSwapTransaction3) SetTransactionNominalAmount(value, curr
 	o.TransactionNominalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (o *OvernightIndexSwapTransaction3) SetTransactionNominalAmountFromDecimal(value decimal.Decimal, currency string) {
+	o.TransactionNominalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (o *OvernightIndexSwapTransaction3) AddSupplementaryData() *SupplementaryData1 {
 	newValue := new(SupplementaryData1)
 	o.SupplementaryData = append(o.SupplementaryData, newValue)
@@ -10,6 +10,10 @@ type OwnerIdentification1Choice struct {
 	OrganisationOwnerIdentification *PartyIdentification5Choice `xml:"OrgOwnrId"`
 }
 
+func (o *OwnerIdentification1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OwnerIdentification1Choice) AddIndividualOwnerIdentification() *IndividualPersonIdentificationChoice {
 	o.IndividualOwnerIdentification = new(IndividualPersonIdentificationChoice)
 	return o.IndividualOwnerIdentification
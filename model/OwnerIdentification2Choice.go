@@ -10,6 +10,10 @@ type OwnerIdentification2Choice struct {
 	OrganisationOwnerIdentification *PartyIdentification95 `xml:"OrgOwnrId"`
 }
 
+func (o *OwnerIdentification2Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OwnerIdentification2Choice) AddIndividualOwnerIdentification() *IndividualPersonIdentification2Choice {
 	o.IndividualOwnerIdentification = new(IndividualPersonIdentification2Choice)
 	return o.IndividualOwnerIdentification
@@ -13,6 +13,10 @@ type OwnerType1 struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry,omitempty"`
 }
 
+func (o *OwnerType1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OwnerType1) SetType(value string) {
 	o.Type = (*AccountOwnerType1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Percentage of ownership or of beneficial ownership of the shares/units in the account.
 type OwnershipBeneficiaryRate1 struct {
 
@@ -10,10 +14,19 @@ type OwnershipBeneficiaryRate1 struct {
 	Fraction *Max35Text `xml:"Frctn,omitempty"`
 }
 
+func (o *OwnershipBeneficiaryRate1) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OwnershipBeneficiaryRate1) SetRate(value string) {
 	o.Rate = (*PercentageRate)(&value)
 }
 
+func (o *OwnershipBeneficiaryRate1) SetRateFromDecimal(value decimal.Decimal) {
+	o.Rate = new(PercentageRate)
+	o.Rate.FromDecimal(value)
+}
+
 func (o *OwnershipBeneficiaryRate1) SetFraction(value string) {
 	o.Fraction = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type OwnershipType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OwnershipType1Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OwnershipType1Choice) SetCode(value string) {
 	o.Code = (*AccountOwnershipType3Code)(&value)
 }
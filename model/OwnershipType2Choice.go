@@ -10,6 +10,10 @@ type OwnershipType2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (o *OwnershipType2Choice) Validate() error {
+	return ValidateElement(o)
+}
+
 func (o *OwnershipType2Choice) SetCode(value string) {
 	o.Code = (*AccountOwnershipType4Code)(&value)
 }
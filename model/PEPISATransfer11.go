@@ -40,6 +40,10 @@ type PEPISATransfer11 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (p *PEPISATransfer11) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PEPISATransfer11) AddPrimaryIndividualInvestor() *IndividualPerson8 {
 	p.PrimaryIndividualInvestor = new(IndividualPerson8)
 	return p.PrimaryIndividualInvestor
@@ -28,6 +28,10 @@ type PEPISATransfer3 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument11 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (p *PEPISATransfer3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PEPISATransfer3) SetMasterReference(value string) {
 	p.MasterReference = (*Max35Text)(&value)
 }
@@ -40,6 +44,11 @@ func (p *PEPISATransfer3) SetResidualCashIndicator(value string) {
 	p.ResidualCashIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *PEPISATransfer3) SetResidualCashIndicatorFromBool(value bool) {
+	p.ResidualCashIndicator = new(YesNoIndicator)
+	p.ResidualCashIndicator.FromBool(value)
+}
+
 func (p *PEPISATransfer3) AddISA() *ISAYearsOfIssue1 {
 	p.ISA = new(ISAYearsOfIssue1)
 	return p.ISA
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Describes the type of product and the assets to be transferred.
 type PEPISATransfer4 struct {
 
@@ -35,6 +39,10 @@ type PEPISATransfer4 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument11 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (p *PEPISATransfer4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PEPISATransfer4) SetMasterReference(value string) {
 	p.MasterReference = (*Max35Text)(&value)
 }
@@ -51,10 +59,20 @@ func (p *PEPISATransfer4) SetActualTransferDate(value string) {
 	p.ActualTransferDate = (*ISODate)(&value)
 }
 
+func (p *PEPISATransfer4) SetActualTransferDateFromTime(value time.Time) {
+	p.ActualTransferDate = new(ISODate)
+	p.ActualTransferDate.FromTime(value)
+}
+
 func (p *PEPISATransfer4) SetResidualCashIndicator(value string) {
 	p.ResidualCashIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *PEPISATransfer4) SetResidualCashIndicatorFromBool(value bool) {
+	p.ResidualCashIndicator = new(YesNoIndicator)
+	p.ResidualCashIndicator.FromBool(value)
+}
+
 func (p *PEPISATransfer4) AddISA() *ISAYearsOfIssue3 {
 	p.ISA = new(ISAYearsOfIssue3)
 	return p.ISA
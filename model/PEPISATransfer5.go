@@ -24,6 +24,10 @@ type PEPISATransfer5 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument12 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (p *PEPISATransfer5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PEPISATransfer5) SetMasterReference(value string) {
 	p.MasterReference = (*Max35Text)(&value)
 }
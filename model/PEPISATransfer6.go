@@ -28,6 +28,10 @@ type PEPISATransfer6 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument12 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (p *PEPISATransfer6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PEPISATransfer6) SetMasterReference(value string) {
 	p.MasterReference = (*Max35Text)(&value)
 }
@@ -40,6 +44,11 @@ func (p *PEPISATransfer6) SetResidualCashIndicator(value string) {
 	p.ResidualCashIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *PEPISATransfer6) SetResidualCashIndicatorFromBool(value bool) {
+	p.ResidualCashIndicator = new(YesNoIndicator)
+	p.ResidualCashIndicator.FromBool(value)
+}
+
 func (p *PEPISATransfer6) AddISA() *ISAYearsOfIssue1 {
 	p.ISA = new(ISAYearsOfIssue1)
 	return p.ISA
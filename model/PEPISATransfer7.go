@@ -40,6 +40,10 @@ type PEPISATransfer7 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (p *PEPISATransfer7) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PEPISATransfer7) AddPrimaryIndividualInvestor() *IndividualPerson8 {
 	p.PrimaryIndividualInvestor = new(IndividualPerson8)
 	return p.PrimaryIndividualInvestor
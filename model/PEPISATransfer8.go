@@ -31,6 +31,10 @@ type PEPISATransfer8 struct {
 	FinancialInstrumentAssetForTransfer []*FinancialInstrument11 `xml:"FinInstrmAsstForTrf,omitempty"`
 }
 
+func (p *PEPISATransfer8) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PEPISATransfer8) SetMasterReference(value string) {
 	p.MasterReference = (*Max35Text)(&value)
 }
@@ -47,6 +51,11 @@ func (p *PEPISATransfer8) SetResidualCashIndicator(value string) {
 	p.ResidualCashIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *PEPISATransfer8) SetResidualCashIndicatorFromBool(value bool) {
+	p.ResidualCashIndicator = new(YesNoIndicator)
+	p.ResidualCashIndicator.FromBool(value)
+}
+
 func (p *PEPISATransfer8) AddISA() *ISAYearsOfIssue1 {
 	p.ISA = new(ISAYearsOfIssue1)
 	return p.ISA
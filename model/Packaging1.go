@@ -10,6 +10,10 @@ type Packaging1 struct {
 	Name *Max35Text `xml:"Nm,omitempty"`
 }
 
+func (p *Packaging1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Packaging1) SetType(value string) {
 	p.Type = (*ExternalPackagingType1Code)(&value)
 }
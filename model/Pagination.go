@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Number used to sequence pages when it is not possible for data to be conveyed in a single message and the data has to be split across several pages (messages).
 type Pagination struct {
 
@@ -10,6 +12,10 @@ type Pagination struct {
 	LastPageIndicator *YesNoIndicator `xml:"LastPgInd"`
 }
 
+func (p *Pagination) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Pagination) SetPageNumber(value string) {
 	p.PageNumber = (*Max5NumericText)(&value)
 }
@@ -17,3 +23,49 @@ func (p *Pagination) SetPageNumber(value string) {
 func (p *Pagination) SetLastPageIndicator(value string) {
 	p.LastPageIndicator = (*YesNoIndicator)(&value)
 }
+
+func (p *Pagination) SetLastPageIndicatorFromBool(value bool) {
+	p.LastPageIndicator = new(YesNoIndicator)
+	p.LastPageIndicator.FromBool(value)
+}
+
+func (p *Pagination) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.PageNumber != nil {
+		enc.Element("PgNb", string(*p.PageNumber))
+	}
+	if p.LastPageIndicator != nil {
+		enc.Element("LastPgInd", string(*p.LastPageIndicator))
+	}
+	enc.End(tag)
+}
+
+func (p *Pagination) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "PgNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.PageNumber = (*Max5NumericText)(&value)
+		case "LastPgInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.LastPageIndicator = (*YesNoIndicator)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
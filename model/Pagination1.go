@@ -0,0 +1,71 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Number used to sequence pages when it is not possible for data to be conveyed in a single message and the data has to be split across several pages (messages).
+type Pagination1 struct {
+
+	// Page number.
+	PageNumber *Max5NumericText `xml:"PgNb"`
+
+	// Indicates the last page.
+	LastPageIndicator *YesNoIndicator `xml:"LastPgInd"`
+}
+
+func (p *Pagination1) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *Pagination1) SetPageNumber(value string) {
+	p.PageNumber = (*Max5NumericText)(&value)
+}
+
+func (p *Pagination1) SetLastPageIndicator(value string) {
+	p.LastPageIndicator = (*YesNoIndicator)(&value)
+}
+
+func (p *Pagination1) SetLastPageIndicatorFromBool(value bool) {
+	p.LastPageIndicator = new(YesNoIndicator)
+	p.LastPageIndicator.FromBool(value)
+}
+
+func (p *Pagination1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.PageNumber != nil {
+		enc.Element("PgNb", string(*p.PageNumber))
+	}
+	if p.LastPageIndicator != nil {
+		enc.Element("LastPgInd", string(*p.LastPageIndicator))
+	}
+	enc.End(tag)
+}
+
+func (p *Pagination1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "PgNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.PageNumber = (*Max5NumericText)(&value)
+		case "LastPgInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.LastPageIndicator = (*YesNoIndicator)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -16,6 +16,10 @@ type PaginationBalance1 struct {
 	IntermediaryClosingBalance *FinancialInstrumentQuantity1 `xml:"IntrmyClsgBal,omitempty"`
 }
 
+func (p *PaginationBalance1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaginationBalance1) AddFirstOpeningBalance() *FinancialInstrumentQuantity1 {
 	p.FirstOpeningBalance = new(FinancialInstrumentQuantity1)
 	return p.FirstOpeningBalance
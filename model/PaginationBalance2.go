@@ -10,6 +10,10 @@ type PaginationBalance2 struct {
 	ClosingBalance *ClosingBalance3Choice `xml:"ClsgBal,omitempty"`
 }
 
+func (p *PaginationBalance2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaginationBalance2) AddOpeningBalance() *OpeningBalance3Choice {
 	p.OpeningBalance = new(OpeningBalance3Choice)
 	return p.OpeningBalance
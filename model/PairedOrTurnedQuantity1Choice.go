@@ -10,6 +10,10 @@ type PairedOrTurnedQuantity1Choice struct {
 	TurnedQuantity *FinancialInstrumentQuantity1Choice `xml:"TrndQty,omitempty"`
 }
 
+func (p *PairedOrTurnedQuantity1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PairedOrTurnedQuantity1Choice) AddPairedOffQuantity() *FinancialInstrumentQuantity1Choice {
 	p.PairedOffQuantity = new(FinancialInstrumentQuantity1Choice)
 	return p.PairedOffQuantity
@@ -10,6 +10,10 @@ type PairedOrTurnedQuantity3Choice struct {
 	TurnedQuantity *FinancialInstrumentQuantity1Choice `xml:"TrndQty,omitempty"`
 }
 
+func (p *PairedOrTurnedQuantity3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PairedOrTurnedQuantity3Choice) AddPairedOffQuantity() *FinancialInstrumentQuantity1Choice {
 	p.PairedOffQuantity = new(FinancialInstrumentQuantity1Choice)
 	return p.PairedOffQuantity
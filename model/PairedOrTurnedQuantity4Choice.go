@@ -10,6 +10,10 @@ type PairedOrTurnedQuantity4Choice struct {
 	TurnedQuantity *FinancialInstrumentQuantity15Choice `xml:"TrndQty,omitempty"`
 }
 
+func (p *PairedOrTurnedQuantity4Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PairedOrTurnedQuantity4Choice) AddPairedOffQuantity() *FinancialInstrumentQuantity15Choice {
 	p.PairedOffQuantity = new(FinancialInstrumentQuantity15Choice)
 	return p.PairedOffQuantity
@@ -7,6 +7,10 @@ type Parameter1 struct {
 	InitialisationVector *Max500Binary `xml:"InitlstnVctr,omitempty"`
 }
 
+func (p *Parameter1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Parameter1) SetInitialisationVector(value string) {
 	p.InitialisationVector = (*Max500Binary)(&value)
 }
@@ -10,6 +10,10 @@ type Parameter2 struct {
 	MaskGeneratorAlgorithm *AlgorithmIdentification8 `xml:"MskGnrtrAlgo,omitempty"`
 }
 
+func (p *Parameter2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Parameter2) SetDigestAlgorithm(value string) {
 	p.DigestAlgorithm = (*Algorithm5Code)(&value)
 }
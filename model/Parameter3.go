@@ -7,6 +7,10 @@ type Parameter3 struct {
 	DigestAlgorithm *Algorithm5Code `xml:"DgstAlgo,omitempty"`
 }
 
+func (p *Parameter3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Parameter3) SetDigestAlgorithm(value string) {
 	p.DigestAlgorithm = (*Algorithm5Code)(&value)
 }
@@ -13,6 +13,10 @@ type Parameter4 struct {
 	MaskGeneratorAlgorithm *AlgorithmIdentification12 `xml:"MskGnrtrAlgo,omitempty"`
 }
 
+func (p *Parameter4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Parameter4) SetEncryptionFormat(value string) {
 	p.EncryptionFormat = (*EncryptionFormat1Code)(&value)
 }
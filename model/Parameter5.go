@@ -7,6 +7,10 @@ type Parameter5 struct {
 	DigestAlgorithm *Algorithm11Code `xml:"DgstAlgo,omitempty"`
 }
 
+func (p *Parameter5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Parameter5) SetDigestAlgorithm(value string) {
 	p.DigestAlgorithm = (*Algorithm11Code)(&value)
 }
@@ -13,6 +13,10 @@ type Parameter6 struct {
 	BytePadding *BytePadding1Code `xml:"BPddg,omitempty"`
 }
 
+func (p *Parameter6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Parameter6) SetEncryptionFormat(value string) {
 	p.EncryptionFormat = (*EncryptionFormat1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Parameter7 struct {
 	BytePadding *BytePadding1Code `xml:"BPddg,omitempty"`
 }
 
+func (p *Parameter7) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Parameter7) SetInitialisationVector(value string) {
 	p.InitialisationVector = (*Max500Binary)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters of the RSASSA-PSS digital signature algorithm (RSA signature algorithm with appendix: Probabilistic Signature Scheme).
 type Parameter8 struct {
 
@@ -16,6 +20,10 @@ type Parameter8 struct {
 	TrailerField *Number `xml:"TrlrFld,omitempty"`
 }
 
+func (p *Parameter8) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Parameter8) SetDigestAlgorithm(value string) {
 	p.DigestAlgorithm = (*Algorithm11Code)(&value)
 }
@@ -29,6 +37,16 @@ func (p *Parameter8) SetSaltLength(value string) {
 	p.SaltLength = (*Number)(&value)
 }
 
+func (p *Parameter8) SetSaltLengthFromDecimal(value decimal.Decimal) {
+	p.SaltLength = new(Number)
+	p.SaltLength.FromDecimal(value)
+}
+
 func (p *Parameter8) SetTrailerField(value string) {
 	p.TrailerField = (*Number)(&value)
 }
+
+func (p *Parameter8) SetTrailerFieldFromDecimal(value decimal.Decimal) {
+	p.TrailerField = new(Number)
+	p.TrailerField.FromDecimal(value)
+}
@@ -13,6 +13,10 @@ type ParentCashAccount1 struct {
 	Servicer *BranchAndFinancialInstitutionIdentification5 `xml:"Svcr,omitempty"`
 }
 
+func (p *ParentCashAccount1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ParentCashAccount1) SetLevel(value string) {
 	p.Level = (*AccountLevel1Code)(&value)
 }
@@ -13,6 +13,10 @@ type ParentCashAccount2 struct {
 	Servicer *BranchAndFinancialInstitutionIdentification5 `xml:"Svcr,omitempty"`
 }
 
+func (p *ParentCashAccount2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ParentCashAccount2) SetLevel(value string) {
 	p.Level = (*AccountLevel1Code)(&value)
 }
@@ -28,6 +28,10 @@ type PartialFill1 struct {
 	MatchIncrementQuantity *QuantityOrAmount1Choice `xml:"MtchIncrmtQty,omitempty"`
 }
 
+func (p *PartialFill1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartialFill1) AddConfirmationQuantity() *Quantity6Choice {
 	p.ConfirmationQuantity = new(Quantity6Choice)
 	return p.ConfirmationQuantity
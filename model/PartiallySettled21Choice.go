@@ -10,6 +10,10 @@ type PartiallySettled21Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (p *PartiallySettled21Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartiallySettled21Choice) SetCode(value string) {
 	p.Code = (*SettledStatusReason2Code)(&value)
 }
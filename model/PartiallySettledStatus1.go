@@ -16,6 +16,10 @@ type PartiallySettledStatus1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartiallySettledStatus1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartiallySettledStatus1) SetReason(value string) {
 	p.Reason = (*SettledStatusReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type PartiallySettledStatus10 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartiallySettledStatus10) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartiallySettledStatus10) AddReason() *PartiallySettled21Choice {
 	p.Reason = new(PartiallySettled21Choice)
 	return p.Reason
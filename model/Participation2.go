@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the level of participation to a shareholders meeting.
 type Participation2 struct {
 
@@ -16,18 +22,41 @@ type Participation2 struct {
 	CalculationDate *ISODate `xml:"ClctnDt,omitempty"`
 }
 
+func (p *Participation2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Participation2) SetTotalNumberOfVotingRights(value string) {
 	p.TotalNumberOfVotingRights = (*Number)(&value)
 }
 
+func (p *Participation2) SetTotalNumberOfVotingRightsFromDecimal(value decimal.Decimal) {
+	p.TotalNumberOfVotingRights = new(Number)
+	p.TotalNumberOfVotingRights.FromDecimal(value)
+}
+
 func (p *Participation2) SetPercentageOfVotingRights(value string) {
 	p.PercentageOfVotingRights = (*PercentageRate)(&value)
 }
 
+func (p *Participation2) SetPercentageOfVotingRightsFromDecimal(value decimal.Decimal) {
+	p.PercentageOfVotingRights = new(PercentageRate)
+	p.PercentageOfVotingRights.FromDecimal(value)
+}
+
 func (p *Participation2) SetTotalNumberOfSecuritiesOutstanding(value, currency string) {
 	p.TotalNumberOfSecuritiesOutstanding = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *Participation2) SetTotalNumberOfSecuritiesOutstandingFromDecimal(value decimal.Decimal, currency string) {
+	p.TotalNumberOfSecuritiesOutstanding = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *Participation2) SetCalculationDate(value string) {
 	p.CalculationDate = (*ISODate)(&value)
 }
+
+func (p *Participation2) SetCalculationDateFromTime(value time.Time) {
+	p.CalculationDate = new(ISODate)
+	p.CalculationDate.FromTime(value)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the level of participation to a shareholders meeting.
 type Participation3 struct {
 
@@ -16,18 +22,41 @@ type Participation3 struct {
 	CalculationDate *ISODate `xml:"ClctnDt,omitempty"`
 }
 
+func (p *Participation3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Participation3) SetTotalNumberOfVotingRights(value string) {
 	p.TotalNumberOfVotingRights = (*Number)(&value)
 }
 
+func (p *Participation3) SetTotalNumberOfVotingRightsFromDecimal(value decimal.Decimal) {
+	p.TotalNumberOfVotingRights = new(Number)
+	p.TotalNumberOfVotingRights.FromDecimal(value)
+}
+
 func (p *Participation3) SetPercentageOfVotingRights(value string) {
 	p.PercentageOfVotingRights = (*PercentageRate)(&value)
 }
 
+func (p *Participation3) SetPercentageOfVotingRightsFromDecimal(value decimal.Decimal) {
+	p.PercentageOfVotingRights = new(PercentageRate)
+	p.PercentageOfVotingRights.FromDecimal(value)
+}
+
 func (p *Participation3) SetTotalNumberOfSecuritiesOutstanding(value, currency string) {
 	p.TotalNumberOfSecuritiesOutstanding = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *Participation3) SetTotalNumberOfSecuritiesOutstandingFromDecimal(value decimal.Decimal, currency string) {
+	p.TotalNumberOfSecuritiesOutstanding = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *Participation3) SetCalculationDate(value string) {
 	p.CalculationDate = (*ISODate)(&value)
 }
+
+func (p *Participation3) SetCalculationDateFromTime(value time.Time) {
+	p.CalculationDate = new(ISODate)
+	p.CalculationDate.FromTime(value)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the level of participation to a shareholders meeting.
 type Participation4 struct {
 
@@ -16,14 +22,28 @@ type Participation4 struct {
 	CalculationDate *ISODate `xml:"ClctnDt,omitempty"`
 }
 
+func (p *Participation4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Participation4) SetTotalNumberOfVotingRights(value string) {
 	p.TotalNumberOfVotingRights = (*Number)(&value)
 }
 
+func (p *Participation4) SetTotalNumberOfVotingRightsFromDecimal(value decimal.Decimal) {
+	p.TotalNumberOfVotingRights = new(Number)
+	p.TotalNumberOfVotingRights.FromDecimal(value)
+}
+
 func (p *Participation4) SetPercentageOfVotingRights(value string) {
 	p.PercentageOfVotingRights = (*PercentageRate)(&value)
 }
 
+func (p *Participation4) SetPercentageOfVotingRightsFromDecimal(value decimal.Decimal) {
+	p.PercentageOfVotingRights = new(PercentageRate)
+	p.PercentageOfVotingRights.FromDecimal(value)
+}
+
 func (p *Participation4) AddTotalNumberOfSecuritiesOutstanding() *UnitOrFaceAmount1Choice {
 	p.TotalNumberOfSecuritiesOutstanding = new(UnitOrFaceAmount1Choice)
 	return p.TotalNumberOfSecuritiesOutstanding
@@ -32,3 +52,8 @@ func (p *Participation4) AddTotalNumberOfSecuritiesOutstanding() *UnitOrFaceAmou
 func (p *Participation4) SetCalculationDate(value string) {
 	p.CalculationDate = (*ISODate)(&value)
 }
+
+func (p *Participation4) SetCalculationDateFromTime(value time.Time) {
+	p.CalculationDate = new(ISODate)
+	p.CalculationDate.FromTime(value)
+}
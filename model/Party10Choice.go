@@ -10,6 +10,10 @@ type Party10Choice struct {
 	PrivateIdentification *PersonIdentification5 `xml:"PrvtId"`
 }
 
+func (p *Party10Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party10Choice) AddOrganisationIdentification() *OrganisationIdentification7 {
 	p.OrganisationIdentification = new(OrganisationIdentification7)
 	return p.OrganisationIdentification
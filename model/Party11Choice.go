@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Nature or use of the account.
 type Party11Choice struct {
 
@@ -10,6 +12,10 @@ type Party11Choice struct {
 	PrivateIdentification *PersonIdentification5 `xml:"PrvtId"`
 }
 
+func (p *Party11Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party11Choice) AddOrganisationIdentification() *OrganisationIdentification8 {
 	p.OrganisationIdentification = new(OrganisationIdentification8)
 	return p.OrganisationIdentification
@@ -19,3 +25,44 @@ func (p *Party11Choice) AddPrivateIdentification() *PersonIdentification5 {
 	p.PrivateIdentification = new(PersonIdentification5)
 	return p.PrivateIdentification
 }
+
+func (p *Party11Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.OrganisationIdentification != nil {
+		p.OrganisationIdentification.EncodeXMLFast(enc, "OrgId")
+	}
+	if p.PrivateIdentification != nil {
+		p.PrivateIdentification.EncodeXMLFast(enc, "PrvtId")
+	}
+	enc.End(tag)
+}
+
+func (p *Party11Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "OrgId":
+			elem := new(OrganisationIdentification8)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.OrganisationIdentification = elem
+		case "PrvtId":
+			elem := new(PersonIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.PrivateIdentification = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
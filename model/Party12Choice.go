@@ -10,6 +10,10 @@ type Party12Choice struct {
 	Agent *BranchAndFinancialInstitutionIdentification5 `xml:"Agt"`
 }
 
+func (p *Party12Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party12Choice) AddParty() *PartyIdentification43 {
 	p.Party = new(PartyIdentification43)
 	return p.Party
@@ -10,6 +10,10 @@ type Party13Choice struct {
 	FinancialInstitutionIdentification *FinancialInstitutionIdentification9 `xml:"FIId"`
 }
 
+func (p *Party13Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party13Choice) AddOrganisationIdentification() *OrganisationIdentification8 {
 	p.OrganisationIdentification = new(OrganisationIdentification8)
 	return p.OrganisationIdentification
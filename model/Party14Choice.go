@@ -10,6 +10,10 @@ type Party14Choice struct {
 	IndividualPerson *IndividualPerson20 `xml:"IndvPrsn"`
 }
 
+func (p *Party14Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party14Choice) AddOrganisation() *Organisation13 {
 	p.Organisation = new(Organisation13)
 	return p.Organisation
@@ -10,6 +10,10 @@ type Party15Choice struct {
 	IndividualPerson *IndividualPerson21 `xml:"IndvPrsn"`
 }
 
+func (p *Party15Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party15Choice) AddOrganisation() *Organisation15 {
 	p.Organisation = new(Organisation15)
 	return p.Organisation
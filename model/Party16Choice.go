@@ -10,6 +10,10 @@ type Party16Choice struct {
 	IndividualPerson *IndividualPerson22 `xml:"IndvPrsn"`
 }
 
+func (p *Party16Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party16Choice) AddOrganisation() *Organisation13 {
 	p.Organisation = new(Organisation13)
 	return p.Organisation
@@ -10,6 +10,10 @@ type Party1Choice struct {
 	PrivateIdentification []*PersonIdentification2 `xml:"PrvtId"`
 }
 
+func (p *Party1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party1Choice) AddOrganisationIdentification() *NonFinancialInstitutionIdentification1 {
 	p.OrganisationIdentification = new(NonFinancialInstitutionIdentification1)
 	return p.OrganisationIdentification
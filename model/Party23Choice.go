@@ -10,6 +10,10 @@ type Party23Choice struct {
 	IndividualPerson *IndividualPerson23 `xml:"IndvPrsn"`
 }
 
+func (p *Party23Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party23Choice) AddOrganisation() *Organisation16 {
 	p.Organisation = new(Organisation16)
 	return p.Organisation
@@ -10,6 +10,10 @@ type Party24Choice struct {
 	IndividualPerson *IndividualPerson24 `xml:"IndvPrsn"`
 }
 
+func (p *Party24Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party24Choice) AddOrganisation() *Organisation17 {
 	p.Organisation = new(Organisation17)
 	return p.Organisation
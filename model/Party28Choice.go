@@ -10,6 +10,10 @@ type Party28Choice struct {
 	Agent *BranchAndFinancialInstitutionIdentification5 `xml:"Agt"`
 }
 
+func (p *Party28Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party28Choice) AddParty() *PartyIdentification77 {
 	p.Party = new(PartyIdentification77)
 	return p.Party
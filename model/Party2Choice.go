@@ -10,6 +10,10 @@ type Party2Choice struct {
 	PrivateIdentification []*PersonIdentification3 `xml:"PrvtId"`
 }
 
+func (p *Party2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party2Choice) AddOrganisationIdentification() *OrganisationIdentification2 {
 	p.OrganisationIdentification = new(OrganisationIdentification2)
 	return p.OrganisationIdentification
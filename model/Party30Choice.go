@@ -10,6 +10,10 @@ type Party30Choice struct {
 	IndividualPerson *IndividualPerson28 `xml:"IndvPrsn"`
 }
 
+func (p *Party30Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party30Choice) AddOrganisation() *Organisation22 {
 	p.Organisation = new(Organisation22)
 	return p.Organisation
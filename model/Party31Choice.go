@@ -10,6 +10,10 @@ type Party31Choice struct {
 	IndividualPerson *IndividualPerson27 `xml:"IndvPrsn"`
 }
 
+func (p *Party31Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party31Choice) AddOrganisation() *Organisation24 {
 	p.Organisation = new(Organisation24)
 	return p.Organisation
@@ -10,6 +10,10 @@ type Party32Choice struct {
 	IndividualPerson *IndividualPerson34 `xml:"IndvPrsn"`
 }
 
+func (p *Party32Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party32Choice) AddOrganisation() *Organisation30 {
 	p.Organisation = new(Organisation30)
 	return p.Organisation
@@ -10,6 +10,10 @@ type Party33Choice struct {
 	IndividualPerson *IndividualPerson33 `xml:"IndvPrsn"`
 }
 
+func (p *Party33Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party33Choice) AddOrganisation() *Organisation29 {
 	p.Organisation = new(Organisation29)
 	return p.Organisation
@@ -0,0 +1,68 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Choice between the identification of an organisation or a person.
+type Party38Choice struct {
+
+	// Unique and unambiguous way to identify an organisation.
+	OrganisationIdentification *OrganisationIdentification29 `xml:"OrgId,omitempty"`
+
+	// Unique and unambiguous identification of a person, eg, passport.
+	PrivateIdentification *PersonIdentification13 `xml:"PrvtId,omitempty"`
+}
+
+func (p *Party38Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *Party38Choice) AddOrganisationIdentification() *OrganisationIdentification29 {
+	p.OrganisationIdentification = new(OrganisationIdentification29)
+	return p.OrganisationIdentification
+}
+
+func (p *Party38Choice) AddPrivateIdentification() *PersonIdentification13 {
+	p.PrivateIdentification = new(PersonIdentification13)
+	return p.PrivateIdentification
+}
+
+func (p *Party38Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.OrganisationIdentification != nil {
+		p.OrganisationIdentification.EncodeXMLFast(enc, "OrgId")
+	}
+	if p.PrivateIdentification != nil {
+		p.PrivateIdentification.EncodeXMLFast(enc, "PrvtId")
+	}
+	enc.End(tag)
+}
+
+func (p *Party38Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "OrgId":
+			elem := new(OrganisationIdentification29)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.OrganisationIdentification = elem
+		case "PrvtId":
+			elem := new(PersonIdentification13)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.PrivateIdentification = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Identification of a person, an organisation or a financial institution.
+type Party40Choice struct {
+
+	// Identification of a person or an organisation.
+	Party *PartyIdentification135 `xml:"Pty"`
+
+	// Identification of a financial institution.
+	Agent *BranchAndFinancialInstitutionIdentification6 `xml:"Agt"`
+}
+
+func (p *Party40Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *Party40Choice) AddParty() *PartyIdentification135 {
+	p.Party = new(PartyIdentification135)
+	return p.Party
+}
+
+func (p *Party40Choice) AddAgent() *BranchAndFinancialInstitutionIdentification6 {
+	p.Agent = new(BranchAndFinancialInstitutionIdentification6)
+	return p.Agent
+}
+
+func (p *Party40Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Party != nil {
+		p.Party.EncodeXMLFast(enc, "Pty")
+	}
+	if p.Agent != nil {
+		p.Agent.EncodeXMLFast(enc, "Agt")
+	}
+	enc.End(tag)
+}
+
+func (p *Party40Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Pty":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Party = elem
+		case "Agt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Agent = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package model
+
+// Identification of a person, an organisation or a financial institution.
+type Party44Choice struct {
+
+	// Identification of a person or an organisation.
+	OrganisationIdentification *PartyIdentification135 `xml:"OrgId,omitempty"`
+
+	// Identification of a financial institution.
+	FinancialInstitutionIdentification *BranchAndFinancialInstitutionIdentification6 `xml:"FIId,omitempty"`
+}
+
+func (p *Party44Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *Party44Choice) AddOrganisationIdentification() *PartyIdentification135 {
+	p.OrganisationIdentification = new(PartyIdentification135)
+	return p.OrganisationIdentification
+}
+
+func (p *Party44Choice) AddFinancialInstitutionIdentification() *BranchAndFinancialInstitutionIdentification6 {
+	p.FinancialInstitutionIdentification = new(BranchAndFinancialInstitutionIdentification6)
+	return p.FinancialInstitutionIdentification
+}
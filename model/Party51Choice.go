@@ -0,0 +1,25 @@
+package model
+
+// Identification of a person, an organisation or a financial institution.
+type Party51Choice struct {
+
+	// Identification of a person or an organisation.
+	OrganisationIdentification *PartyIdentification272 `xml:"OrgId,omitempty"`
+
+	// Identification of a financial institution.
+	FinancialInstitutionIdentification *BranchAndFinancialInstitutionIdentification8 `xml:"FIId,omitempty"`
+}
+
+func (p *Party51Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *Party51Choice) AddOrganisationIdentification() *PartyIdentification272 {
+	p.OrganisationIdentification = new(PartyIdentification272)
+	return p.OrganisationIdentification
+}
+
+func (p *Party51Choice) AddFinancialInstitutionIdentification() *BranchAndFinancialInstitutionIdentification8 {
+	p.FinancialInstitutionIdentification = new(BranchAndFinancialInstitutionIdentification8)
+	return p.FinancialInstitutionIdentification
+}
@@ -0,0 +1,25 @@
+package model
+
+// Choice between the identification of an organisation or a person.
+type Party52Choice struct {
+
+	// Unique and unambiguous way to identify an organisation.
+	OrganisationIdentification *OrganisationIdentification39 `xml:"OrgId,omitempty"`
+
+	// Unique and unambiguous identification of a person, eg, passport.
+	PrivateIdentification *PersonIdentification18 `xml:"PrvtId,omitempty"`
+}
+
+func (p *Party52Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *Party52Choice) AddOrganisationIdentification() *OrganisationIdentification39 {
+	p.OrganisationIdentification = new(OrganisationIdentification39)
+	return p.OrganisationIdentification
+}
+
+func (p *Party52Choice) AddPrivateIdentification() *PersonIdentification18 {
+	p.PrivateIdentification = new(PersonIdentification18)
+	return p.PrivateIdentification
+}
@@ -10,6 +10,10 @@ type Party6Choice struct {
 	PrivateIdentification *PersonIdentification5 `xml:"PrvtId"`
 }
 
+func (p *Party6Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party6Choice) AddOrganisationIdentification() *OrganisationIdentification4 {
 	p.OrganisationIdentification = new(OrganisationIdentification4)
 	return p.OrganisationIdentification
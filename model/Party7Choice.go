@@ -10,6 +10,10 @@ type Party7Choice struct {
 	Agent *BranchAndFinancialInstitutionIdentification4 `xml:"Agt"`
 }
 
+func (p *Party7Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party7Choice) AddParty() *PartyIdentification32 {
 	p.Party = new(PartyIdentification32)
 	return p.Party
@@ -10,6 +10,10 @@ type Party8Choice struct {
 	PrivateIdentification *PersonIdentification5 `xml:"PrvtId"`
 }
 
+func (p *Party8Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party8Choice) AddOrganisationIdentification() *OrganisationIdentification6 {
 	p.OrganisationIdentification = new(OrganisationIdentification6)
 	return p.OrganisationIdentification
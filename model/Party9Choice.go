@@ -10,6 +10,10 @@ type Party9Choice struct {
 	FinancialInstitutionIdentification *BranchAndFinancialInstitutionIdentification5 `xml:"FIId"`
 }
 
+func (p *Party9Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Party9Choice) AddOrganisationIdentification() *PartyIdentification42 {
 	p.OrganisationIdentification = new(PartyIdentification42)
 	return p.OrganisationIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Ancillary identification information about the party.
 type PartyAdditionalIdentification2Choice struct {
 
@@ -10,10 +14,19 @@ type PartyAdditionalIdentification2Choice struct {
 	RegistrationIdentification *OrganisationIdentification5 `xml:"RegnId"`
 }
 
+func (p *PartyAdditionalIdentification2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyAdditionalIdentification2Choice) SetBirthDate(value string) {
 	p.BirthDate = (*ISODate)(&value)
 }
 
+func (p *PartyAdditionalIdentification2Choice) SetBirthDateFromTime(value time.Time) {
+	p.BirthDate = new(ISODate)
+	p.BirthDate.FromTime(value)
+}
+
 func (p *PartyAdditionalIdentification2Choice) AddRegistrationIdentification() *OrganisationIdentification5 {
 	p.RegistrationIdentification = new(OrganisationIdentification5)
 	return p.RegistrationIdentification
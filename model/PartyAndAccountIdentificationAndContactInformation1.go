@@ -13,6 +13,10 @@ type PartyAndAccountIdentificationAndContactInformation1 struct {
 	ContactInformation *ContactIdentification1 `xml:"CtctInf,omitempty"`
 }
 
+func (p *PartyAndAccountIdentificationAndContactInformation1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyAndAccountIdentificationAndContactInformation1) AddPartyIdentification() *PartyIdentification8 {
 	p.PartyIdentification = new(PartyIdentification8)
 	return p.PartyIdentification
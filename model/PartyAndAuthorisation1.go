@@ -13,6 +13,10 @@ type PartyAndAuthorisation1 struct {
 	Authorisation *Authorisation2 `xml:"Authstn"`
 }
 
+func (p *PartyAndAuthorisation1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyAndAuthorisation1) AddPartyOrGroup() *PartyOrGroup1Choice {
 	p.PartyOrGroup = new(PartyOrGroup1Choice)
 	return p.PartyOrGroup
@@ -16,6 +16,10 @@ type PartyAndAuthorisation3 struct {
 	Authorisation *Authorisation2 `xml:"Authstn"`
 }
 
+func (p *PartyAndAuthorisation3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyAndAuthorisation3) SetModificationCode(value string) {
 	p.ModificationCode = (*Modification1Code)(&value)
 }
@@ -16,6 +16,10 @@ type PartyAndCertificate1 struct {
 	Authorisation *Authorisation1 `xml:"Authstn"`
 }
 
+func (p *PartyAndCertificate1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyAndCertificate1) AddParty() *PartyIdentification41 {
 	p.Party = new(PartyIdentification41)
 	return p.Party
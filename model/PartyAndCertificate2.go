@@ -10,6 +10,10 @@ type PartyAndCertificate2 struct {
 	Certificate *Max10KBinary `xml:"Cert,omitempty"`
 }
 
+func (p *PartyAndCertificate2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyAndCertificate2) AddParty() *PartyIdentification43 {
 	p.Party = new(PartyIdentification43)
 	return p.Party
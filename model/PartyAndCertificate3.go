@@ -13,6 +13,10 @@ type PartyAndCertificate3 struct {
 	Certificate *Max10KBinary `xml:"Cert,omitempty"`
 }
 
+func (p *PartyAndCertificate3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyAndCertificate3) SetModificationCode(value string) {
 	p.ModificationCode = (*Modification1Code)(&value)
 }
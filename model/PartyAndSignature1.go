@@ -10,6 +10,10 @@ type PartyAndSignature1 struct {
 	Signature *ProprietaryData3 `xml:"Sgntr"`
 }
 
+func (p *PartyAndSignature1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyAndSignature1) AddParty() *PartyIdentification41 {
 	p.Party = new(PartyIdentification41)
 	return p.Party
@@ -10,6 +10,10 @@ type PartyAndSignature2 struct {
 	Signature *ProprietaryData3 `xml:"Sgntr"`
 }
 
+func (p *PartyAndSignature2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyAndSignature2) AddParty() *PartyIdentification43 {
 	p.Party = new(PartyIdentification43)
 	return p.Party
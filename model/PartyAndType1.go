@@ -10,6 +10,10 @@ type PartyAndType1 struct {
 	Party *PartyIdentification43 `xml:"Pty,omitempty"`
 }
 
+func (p *PartyAndType1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyAndType1) AddType() *PartyType1Choice {
 	p.Type = new(PartyType1Choice)
 	return p.Type
@@ -13,6 +13,10 @@ type PartyIdentification1 struct {
 	Identification *Party1Choice `xml:"Id,omitempty"`
 }
 
+func (p *PartyIdentification1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification1) SetName(value string) {
 	p.Name = (*Max70Text)(&value)
 }
@@ -10,6 +10,10 @@ type PartyIdentification100 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (p *PartyIdentification100) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification100) AddIdentification() *PartyIdentification71Choice {
 	p.Identification = new(PartyIdentification71Choice)
 	return p.Identification
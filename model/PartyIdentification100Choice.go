@@ -13,6 +13,10 @@ type PartyIdentification100Choice struct {
 	NameAndAddress *NameAndAddress6 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification100Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification100Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
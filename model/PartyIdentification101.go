@@ -25,6 +25,10 @@ type PartyIdentification101 struct {
 	CertificationBreakdown *RestrictedFINXMax350Text `xml:"CertfctnBrkdwn,omitempty"`
 }
 
+func (p *PartyIdentification101) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification101) AddOwnerIdentification() *PartyIdentification104Choice {
 	p.OwnerIdentification = new(PartyIdentification104Choice)
 	return p.OwnerIdentification
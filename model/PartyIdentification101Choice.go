@@ -13,6 +13,10 @@ type PartyIdentification101Choice struct {
 	NameAndAddress *NameAndAddress13 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification101Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification101Choice) SetBIC(value string) {
 	p.BIC = (*AnyBICIdentifier)(&value)
 }
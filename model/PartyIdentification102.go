@@ -13,6 +13,10 @@ type PartyIdentification102 struct {
 	AlternateIdentification []*AlternatePartyIdentification9 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentification102) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification102) AddIdentification() *PartyIdentification111Choice {
 	p.Identification = new(PartyIdentification111Choice)
 	return p.Identification
@@ -13,6 +13,10 @@ type PartyIdentification102Choice struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PartyIdentification102Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification102Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
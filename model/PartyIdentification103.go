@@ -22,6 +22,10 @@ type PartyIdentification103 struct {
 	AdditionalInformation *PartyTextInformation3 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentification103) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification103) AddIdentification() *PartyIdentification58Choice {
 	p.Identification = new(PartyIdentification58Choice)
 	return p.Identification
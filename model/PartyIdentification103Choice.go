@@ -10,6 +10,10 @@ type PartyIdentification103Choice struct {
 	ProprietaryIdentification *GenericIdentification84 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification103Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification103Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
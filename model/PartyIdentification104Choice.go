@@ -13,6 +13,10 @@ type PartyIdentification104Choice struct {
 	NameAndAddress *NameAndAddress12 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification104Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification104Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
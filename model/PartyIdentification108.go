@@ -13,6 +13,10 @@ type PartyIdentification108 struct {
 	ProcessingIdentification *RestrictedFINXMax16Text `xml:"PrcgId,omitempty"`
 }
 
+func (p *PartyIdentification108) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification108) AddIdentification() *PartyIdentification58Choice {
 	p.Identification = new(PartyIdentification58Choice)
 	return p.Identification
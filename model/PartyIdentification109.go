@@ -10,6 +10,10 @@ type PartyIdentification109 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (p *PartyIdentification109) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification109) AddIdentification() *PartyIdentification114Choice {
 	p.Identification = new(PartyIdentification114Choice)
 	return p.Identification
@@ -13,6 +13,10 @@ type PartyIdentification10Choice struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification10Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification10Choice) SetBICOrBEI(value string) {
 	p.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
@@ -10,6 +10,10 @@ type PartyIdentification110 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (p *PartyIdentification110) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification110) AddIdentification() *PartyIdentification115Choice {
 	p.Identification = new(PartyIdentification115Choice)
 	return p.Identification
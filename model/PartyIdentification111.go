@@ -10,6 +10,10 @@ type PartyIdentification111 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (p *PartyIdentification111) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification111) AddIdentification() *PartyIdentification104Choice {
 	p.Identification = new(PartyIdentification104Choice)
 	return p.Identification
@@ -13,6 +13,10 @@ type PartyIdentification111Choice struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PartyIdentification111Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification111Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -19,6 +19,10 @@ type PartyIdentification112 struct {
 	ContactDetails []*Contacts3 `xml:"CtctDtls,omitempty"`
 }
 
+func (p *PartyIdentification112) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification112) AddIdentification() *Party10Choice {
 	p.Identification = new(Party10Choice)
 	return p.Identification
@@ -10,6 +10,10 @@ type PartyIdentification113 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (p *PartyIdentification113) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification113) AddParty() *PartyIdentification90Choice {
 	p.Party = new(PartyIdentification90Choice)
 	return p.Party
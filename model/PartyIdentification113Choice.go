@@ -13,6 +13,10 @@ type PartyIdentification113Choice struct {
 	ProprietaryIdentification *GenericIdentification84 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification113Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification113Choice) SetBICFI(value string) {
 	p.BICFI = (*BICFIIdentifier)(&value)
 }
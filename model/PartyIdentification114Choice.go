@@ -10,6 +10,10 @@ type PartyIdentification114Choice struct {
 	ProprietaryIdentification *GenericIdentification84 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification114Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification114Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
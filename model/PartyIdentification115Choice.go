@@ -16,6 +16,10 @@ type PartyIdentification115Choice struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PartyIdentification115Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification115Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -13,6 +13,10 @@ type PartyIdentification116 struct {
 	TaxParty *TaxParty1 `xml:"TaxPty,omitempty"`
 }
 
+func (p *PartyIdentification116) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification116) AddPartyIdentification() *OrganisationIdentification28 {
 	p.PartyIdentification = new(OrganisationIdentification28)
 	return p.PartyIdentification
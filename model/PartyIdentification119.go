@@ -10,6 +10,10 @@ type PartyIdentification119 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (p *PartyIdentification119) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification119) AddIdentification() *PartyIdentification103Choice {
 	p.Identification = new(PartyIdentification103Choice)
 	return p.Identification
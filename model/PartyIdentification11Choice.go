@@ -13,6 +13,10 @@ type PartyIdentification11Choice struct {
 	ProprietaryIdentification *GenericIdentification1 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification11Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification11Choice) SetBICOrBEI(value string) {
 	p.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
@@ -13,6 +13,10 @@ type PartyIdentification120 struct {
 	AlternateIdentification []*AlternatePartyIdentification9 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentification120) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification120) AddIdentification() *PartyIdentification58Choice {
 	p.Identification = new(PartyIdentification58Choice)
 	return p.Identification
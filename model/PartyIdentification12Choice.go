@@ -13,6 +13,10 @@ type PartyIdentification12Choice struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PartyIdentification12Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification12Choice) SetBICOrBEI(value string) {
 	p.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
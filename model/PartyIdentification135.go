@@ -0,0 +1,117 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Specifies the identification of a person or an organisation.
+type PartyIdentification135 struct {
+
+	// Name by which a party is known and which is usually used to identify that party.
+	Name *Max140Text `xml:"Nm,omitempty"`
+
+	// Information that locates and identifies a specific address, as defined by postal services.
+	PostalAddress *PostalAddress24 `xml:"PstlAdr,omitempty"`
+
+	// Unique and unambiguous identification of a party.
+	Identification *Party38Choice `xml:"Id,omitempty"`
+
+	// Country in which a person resides (the place of a person's home). In the case of a company, it is the country from which the affairs of that company are directed.
+	CountryOfResidence *CountryCode `xml:"CtryOfRes,omitempty"`
+
+	// Set of elements used to indicate how to contact the party.
+	ContactDetails *Contact4 `xml:"CtctDtls,omitempty"`
+}
+
+func (p *PartyIdentification135) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PartyIdentification135) SetName(value string) {
+	p.Name = (*Max140Text)(&value)
+}
+
+func (p *PartyIdentification135) AddPostalAddress() *PostalAddress24 {
+	p.PostalAddress = new(PostalAddress24)
+	return p.PostalAddress
+}
+
+func (p *PartyIdentification135) AddIdentification() *Party38Choice {
+	p.Identification = new(Party38Choice)
+	return p.Identification
+}
+
+func (p *PartyIdentification135) SetCountryOfResidence(value string) {
+	p.CountryOfResidence = (*CountryCode)(&value)
+}
+
+func (p *PartyIdentification135) AddContactDetails() *Contact4 {
+	p.ContactDetails = new(Contact4)
+	return p.ContactDetails
+}
+
+func (p *PartyIdentification135) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Name != nil {
+		enc.Element("Nm", string(*p.Name))
+	}
+	if p.PostalAddress != nil {
+		p.PostalAddress.EncodeXMLFast(enc, "PstlAdr")
+	}
+	if p.Identification != nil {
+		p.Identification.EncodeXMLFast(enc, "Id")
+	}
+	if p.CountryOfResidence != nil {
+		enc.Element("CtryOfRes", string(*p.CountryOfResidence))
+	}
+	if p.ContactDetails != nil {
+		p.ContactDetails.EncodeXMLFast(enc, "CtctDtls")
+	}
+	enc.End(tag)
+}
+
+func (p *PartyIdentification135) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Name = (*Max140Text)(&value)
+		case "PstlAdr":
+			elem := new(PostalAddress24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.PostalAddress = elem
+		case "Id":
+			elem := new(Party38Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Identification = elem
+		case "CtryOfRes":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.CountryOfResidence = (*CountryCode)(&value)
+		case "CtctDtls":
+			elem := new(Contact4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.ContactDetails = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ type PartyIdentification13Choice struct {
 	ProprietaryIdentification *GenericIdentification19 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification13Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification13Choice) SetBICOrBEI(value string) {
 	p.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
@@ -16,6 +16,10 @@ type PartyIdentification14Choice struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PartyIdentification14Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification14Choice) SetBICOrBEI(value string) {
 	p.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
@@ -10,6 +10,10 @@ type PartyIdentification15 struct {
 	Identification *Max35Text `xml:"Id"`
 }
 
+func (p *PartyIdentification15) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification15) SetCountry(value string) {
 	p.Country = (*CountryCode)(&value)
 }
@@ -13,6 +13,10 @@ type PartyIdentification1Choice struct {
 	NameAndAddress *NameAndAddress2 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification1Choice) SetBICOrBEI(value string) {
 	p.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
@@ -19,6 +19,10 @@ type PartyIdentification2 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentification2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification2) AddIdentification() *PartyIdentification12Choice {
 	p.Identification = new(PartyIdentification12Choice)
 	return p.Identification
@@ -16,6 +16,10 @@ type PartyIdentification21 struct {
 	ContactPerson *ContactIdentification2 `xml:"CtctPrsn,omitempty"`
 }
 
+func (p *PartyIdentification21) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification21) AddPartyIdentification() *PartyIdentification2Choice {
 	p.PartyIdentification = new(PartyIdentification2Choice)
 	return p.PartyIdentification
@@ -10,6 +10,10 @@ type PartyIdentification22 struct {
 	AlternativeIdentifier []*Max35Text `xml:"AltrntvIdr,omitempty"`
 }
 
+func (p *PartyIdentification22) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification22) SetBICOrBEI(value string) {
 	p.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
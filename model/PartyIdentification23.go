@@ -14,6 +14,10 @@ type PartyIdentification23 struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr,omitempty"`
 }
 
+func (p *PartyIdentification23) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification23) SetBICOrBEI(value string) {
 	p.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
@@ -10,6 +10,10 @@ type PartyIdentification23Choice struct {
 	ProprietaryIdentification *GenericIdentification1 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification23Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification23Choice) SetBICOrBEI(value string) {
 	p.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
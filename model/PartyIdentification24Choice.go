@@ -10,6 +10,10 @@ type PartyIdentification24Choice struct {
 	MIC *MICIdentifier `xml:"MIC"`
 }
 
+func (p *PartyIdentification24Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification24Choice) SetBICOrBEI(value string) {
 	p.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
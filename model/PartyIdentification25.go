@@ -13,6 +13,10 @@ type PartyIdentification25 struct {
 	BEI *BEIIdentifier `xml:"BEI,omitempty"`
 }
 
+func (p *PartyIdentification25) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification25) SetName(value string) {
 	p.Name = (*Max70Text)(&value)
 }
@@ -13,6 +13,10 @@ type PartyIdentification26 struct {
 	PostalAddress *PostalAddress5 `xml:"PstlAdr"`
 }
 
+func (p *PartyIdentification26) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification26) SetName(value string) {
 	p.Name = (*Max70Text)(&value)
 }
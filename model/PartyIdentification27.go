@@ -13,6 +13,10 @@ type PartyIdentification27 struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PartyIdentification27) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification27) SetName(value string) {
 	p.Name = (*Max70Text)(&value)
 }
@@ -0,0 +1,47 @@
+package model
+
+// Specifies the identification of a person or an organisation.
+type PartyIdentification272 struct {
+
+	// Name by which a party is known and which is usually used to identify that party.
+	Name *Max140Text `xml:"Nm,omitempty"`
+
+	// Information that locates and identifies a specific address, as defined by postal services.
+	PostalAddress *PostalAddress27 `xml:"PstlAdr,omitempty"`
+
+	// Unique and unambiguous identification of a party.
+	Identification *Party52Choice `xml:"Id,omitempty"`
+
+	// Country in which a person resides (the place of a person's home). In the case of a company, it is the country from which the affairs of that company are directed.
+	CountryOfResidence *CountryCode `xml:"CtryOfRes,omitempty"`
+
+	// Set of elements used to indicate how to contact the party.
+	ContactDetails *Contact13 `xml:"CtctDtls,omitempty"`
+}
+
+func (p *PartyIdentification272) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PartyIdentification272) SetName(value string) {
+	p.Name = (*Max140Text)(&value)
+}
+
+func (p *PartyIdentification272) AddPostalAddress() *PostalAddress27 {
+	p.PostalAddress = new(PostalAddress27)
+	return p.PostalAddress
+}
+
+func (p *PartyIdentification272) AddIdentification() *Party52Choice {
+	p.Identification = new(Party52Choice)
+	return p.Identification
+}
+
+func (p *PartyIdentification272) SetCountryOfResidence(value string) {
+	p.CountryOfResidence = (*CountryCode)(&value)
+}
+
+func (p *PartyIdentification272) AddContactDetails() *Contact13 {
+	p.ContactDetails = new(Contact13)
+	return p.ContactDetails
+}
@@ -10,6 +10,10 @@ type PartyIdentification28 struct {
 	ProprietaryIdentification *GenericIdentification4 `xml:"PrtryId,omitempty"`
 }
 
+func (p *PartyIdentification28) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification28) SetName(value string) {
 	p.Name = (*Max70Text)(&value)
 }
@@ -10,6 +10,10 @@ type PartyIdentification29Choice struct {
 	NameAndAddress *PartyIdentification26 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification29Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification29Choice) SetBIC(value string) {
 	p.BIC = (*BICIdentifier)(&value)
 }
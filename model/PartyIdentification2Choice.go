@@ -13,6 +13,10 @@ type PartyIdentification2Choice struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification2Choice) SetBICOrBEI(value string) {
 	p.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
@@ -7,6 +7,10 @@ type PartyIdentification3 struct {
 	BICOrBEI *AnyBICIdentifier `xml:"BICOrBEI"`
 }
 
+func (p *PartyIdentification3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification3) SetBICOrBEI(value string) {
 	p.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
@@ -13,6 +13,10 @@ type PartyIdentification30Choice struct {
 	ProprietaryIdentification *GenericIdentification19 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification30Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification30Choice) SetBIC(value string) {
 	p.BIC = (*BICIdentifier)(&value)
 }
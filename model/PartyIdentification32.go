@@ -19,6 +19,10 @@ type PartyIdentification32 struct {
 	ContactDetails *ContactDetails2 `xml:"CtctDtls,omitempty"`
 }
 
+func (p *PartyIdentification32) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification32) SetName(value string) {
 	p.Name = (*Max140Text)(&value)
 }
@@ -13,6 +13,10 @@ type PartyIdentification32Choice struct {
 	NameAndAddress *NameAndAddress13 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification32Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification32Choice) SetBIC(value string) {
 	p.BIC = (*AnyBICIdentifier)(&value)
 }
@@ -25,6 +25,10 @@ type PartyIdentification33 struct {
 	DeclarationDetails *Max350Text `xml:"DclrtnDtls,omitempty"`
 }
 
+func (p *PartyIdentification33) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification33) AddOwnerIdentification() *PartyIdentification10Choice {
 	p.OwnerIdentification = new(PartyIdentification10Choice)
 	return p.OwnerIdentification
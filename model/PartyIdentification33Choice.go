@@ -13,6 +13,10 @@ type PartyIdentification33Choice struct {
 	NameAndAddress *NameAndAddress6 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification33Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification33Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -13,6 +13,10 @@ type PartyIdentification34Choice struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PartyIdentification34Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification34Choice) SetBIC(value string) {
 	p.BIC = (*AnyBICIdentifier)(&value)
 }
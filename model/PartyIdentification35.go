@@ -16,6 +16,10 @@ type PartyIdentification35 struct {
 	AlternateIdentification []*AlternatePartyIdentification2 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentification35) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification35) AddIdentification() *PartyIdentification12Choice {
 	p.Identification = new(PartyIdentification12Choice)
 	return p.Identification
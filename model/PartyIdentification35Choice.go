@@ -10,6 +10,10 @@ type PartyIdentification35Choice struct {
 	ProprietaryIdentification *GenericIdentification29 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification35Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification35Choice) SetBIC(value string) {
 	p.BIC = (*AnyBICIdentifier)(&value)
 }
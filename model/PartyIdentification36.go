@@ -10,6 +10,10 @@ type PartyIdentification36 struct {
 	ProcessingIdentification *Max35Text `xml:"PrcgId,omitempty"`
 }
 
+func (p *PartyIdentification36) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification36) AddIdentification() *PartyIdentification12Choice {
 	p.Identification = new(PartyIdentification12Choice)
 	return p.Identification
@@ -10,6 +10,10 @@ type PartyIdentification36Choice struct {
 	ProprietaryIdentification *GenericIdentification19 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification36Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification36Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
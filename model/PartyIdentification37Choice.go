@@ -16,6 +16,10 @@ type PartyIdentification37Choice struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PartyIdentification37Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification37Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -13,6 +13,10 @@ type PartyIdentification38Choice struct {
 	ProprietaryIdentification *GenericIdentification19 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification38Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification38Choice) SetBICFI(value string) {
 	p.BICFI = (*BICFIIdentifier)(&value)
 }
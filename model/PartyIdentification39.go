@@ -10,6 +10,10 @@ type PartyIdentification39 struct {
 	AdditionalIdentificationInformation *PartyAdditionalIdentification2Choice `xml:"AddtlIdInf,omitempty"`
 }
 
+func (p *PartyIdentification39) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification39) AddIdentification() *PartyIdentification9Choice {
 	p.Identification = new(PartyIdentification9Choice)
 	return p.Identification
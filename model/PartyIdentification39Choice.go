@@ -13,6 +13,10 @@ type PartyIdentification39Choice struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PartyIdentification39Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification39Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -19,6 +19,10 @@ type PartyIdentification40 struct {
 	ContactDetails *ContactDetails2 `xml:"CtctDtls,omitempty"`
 }
 
+func (p *PartyIdentification40) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification40) SetName(value string) {
 	p.Name = (*Max140Text)(&value)
 }
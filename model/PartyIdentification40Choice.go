@@ -13,6 +13,10 @@ type PartyIdentification40Choice struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification40Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification40Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
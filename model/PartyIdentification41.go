@@ -19,6 +19,10 @@ type PartyIdentification41 struct {
 	ContactDetails *ContactDetails2 `xml:"CtctDtls,omitempty"`
 }
 
+func (p *PartyIdentification41) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification41) SetName(value string) {
 	p.Name = (*Max140Text)(&value)
 }
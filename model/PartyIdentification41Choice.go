@@ -10,6 +10,10 @@ type PartyIdentification41Choice struct {
 	ProprietaryIdentification *GenericIdentification19 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification41Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification41Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -19,6 +19,10 @@ type PartyIdentification42 struct {
 	ContactDetails *ContactDetails2 `xml:"CtctDtls,omitempty"`
 }
 
+func (p *PartyIdentification42) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification42) SetName(value string) {
 	p.Name = (*Max140Text)(&value)
 }
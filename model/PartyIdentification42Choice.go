@@ -13,6 +13,10 @@ type PartyIdentification42Choice struct {
 	ProprietaryIdentification *GenericIdentification19 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification42Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification42Choice) SetBICFI(value string) {
 	p.BICFI = (*BICFIIdentifier)(&value)
 }
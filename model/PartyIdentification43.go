@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to identify a person or an organisation.
 type PartyIdentification43 struct {
 
@@ -19,6 +21,10 @@ type PartyIdentification43 struct {
 	ContactDetails *ContactDetails2 `xml:"CtctDtls,omitempty"`
 }
 
+func (p *PartyIdentification43) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification43) SetName(value string) {
 	p.Name = (*Max140Text)(&value)
 }
@@ -41,3 +47,71 @@ func (p *PartyIdentification43) AddContactDetails() *ContactDetails2 {
 	p.ContactDetails = new(ContactDetails2)
 	return p.ContactDetails
 }
+
+func (p *PartyIdentification43) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Name != nil {
+		enc.Element("Nm", string(*p.Name))
+	}
+	if p.PostalAddress != nil {
+		p.PostalAddress.EncodeXMLFast(enc, "PstlAdr")
+	}
+	if p.Identification != nil {
+		p.Identification.EncodeXMLFast(enc, "Id")
+	}
+	if p.CountryOfResidence != nil {
+		enc.Element("CtryOfRes", string(*p.CountryOfResidence))
+	}
+	if p.ContactDetails != nil {
+		p.ContactDetails.EncodeXMLFast(enc, "CtctDtls")
+	}
+	enc.End(tag)
+}
+
+func (p *PartyIdentification43) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Name = (*Max140Text)(&value)
+		case "PstlAdr":
+			elem := new(PostalAddress6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.PostalAddress = elem
+		case "Id":
+			elem := new(Party11Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Identification = elem
+		case "CtryOfRes":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.CountryOfResidence = (*CountryCode)(&value)
+		case "CtctDtls":
+			elem := new(ContactDetails2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.ContactDetails = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -13,6 +13,10 @@ type PartyIdentification43Choice struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification43Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification43Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
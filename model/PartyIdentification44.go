@@ -10,6 +10,10 @@ type PartyIdentification44 struct {
 	AlternativeIdentifier []*Max35Text `xml:"AltrntvIdr,omitempty"`
 }
 
+func (p *PartyIdentification44) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification44) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -13,6 +13,10 @@ type PartyIdentification44Choice struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PartyIdentification44Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification44Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -19,6 +19,10 @@ type PartyIdentification45 struct {
 	ContactDetails []*Contacts3 `xml:"CtctDtls,omitempty"`
 }
 
+func (p *PartyIdentification45) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification45) AddIdentification() *Party8Choice {
 	p.Identification = new(Party8Choice)
 	return p.Identification
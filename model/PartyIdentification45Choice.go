@@ -13,6 +13,10 @@ type PartyIdentification45Choice struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification45Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification45Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -10,6 +10,10 @@ type PartyIdentification46 struct {
 	ProcessingIdentification *Max35Text `xml:"PrcgId,omitempty"`
 }
 
+func (p *PartyIdentification46) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification46) AddIdentification() *PartyIdentification44Choice {
 	p.Identification = new(PartyIdentification44Choice)
 	return p.Identification
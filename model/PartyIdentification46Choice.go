@@ -13,6 +13,10 @@ type PartyIdentification46Choice struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification46Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification46Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -16,6 +16,10 @@ type PartyIdentification47 struct {
 	AlternateIdentification []*AlternatePartyIdentification2 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentification47) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification47) AddIdentification() *PartyIdentification39Choice {
 	p.Identification = new(PartyIdentification39Choice)
 	return p.Identification
@@ -13,6 +13,10 @@ type PartyIdentification47Choice struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification47Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification47Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
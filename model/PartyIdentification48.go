@@ -19,6 +19,10 @@ type PartyIdentification48 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentification48) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification48) AddIdentification() *PartyIdentification44Choice {
 	p.Identification = new(PartyIdentification44Choice)
 	return p.Identification
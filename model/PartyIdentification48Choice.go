@@ -13,6 +13,10 @@ type PartyIdentification48Choice struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification48Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification48Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -13,6 +13,10 @@ type PartyIdentification49Choice struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification49Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification49Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
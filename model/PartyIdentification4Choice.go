@@ -10,6 +10,10 @@ type PartyIdentification4Choice struct {
 	ProprietaryIdentification *GenericIdentification1 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification4Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification4Choice) SetBICOrBEI(value string) {
 	p.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
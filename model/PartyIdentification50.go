@@ -25,6 +25,10 @@ type PartyIdentification50 struct {
 	DeclarationDetails *Max350Text `xml:"DclrtnDtls,omitempty"`
 }
 
+func (p *PartyIdentification50) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification50) AddOwnerIdentification() *PartyIdentification48Choice {
 	p.OwnerIdentification = new(PartyIdentification48Choice)
 	return p.OwnerIdentification
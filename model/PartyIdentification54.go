@@ -14,6 +14,10 @@ type PartyIdentification54 struct {
 	NameAndAddress *NameAndAddress13 `xml:"NmAndAdr,omitempty"`
 }
 
+func (p *PartyIdentification54) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification54) SetBIC(value string) {
 	p.BIC = (*AnyBICIdentifier)(&value)
 }
@@ -13,6 +13,10 @@ type PartyIdentification55 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentification55) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification55) AddIdentification() *PartyIdentification68Choice {
 	p.Identification = new(PartyIdentification68Choice)
 	return p.Identification
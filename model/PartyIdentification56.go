@@ -25,6 +25,10 @@ type PartyIdentification56 struct {
 	DeclarationDetails *Max350Text `xml:"DclrtnDtls,omitempty"`
 }
 
+func (p *PartyIdentification56) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification56) AddOwnerIdentification() *PartyIdentification48Choice {
 	p.OwnerIdentification = new(PartyIdentification48Choice)
 	return p.OwnerIdentification
@@ -22,6 +22,10 @@ type PartyIdentification58 struct {
 	ContactDetails *ContactDetails3 `xml:"CtctDtls,omitempty"`
 }
 
+func (p *PartyIdentification58) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification58) SetName(value string) {
 	p.Name = (*Max140Text)(&value)
 }
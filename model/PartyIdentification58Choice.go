@@ -13,6 +13,10 @@ type PartyIdentification58Choice struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PartyIdentification58Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification58Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
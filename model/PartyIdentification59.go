@@ -22,6 +22,10 @@ type PartyIdentification59 struct {
 	LegalEntityIdentifier *LEIIdentifier `xml:"LglNttyIdr,omitempty"`
 }
 
+func (p *PartyIdentification59) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification59) SetPartyName(value string) {
 	p.PartyName = (*Max34Text)(&value)
 }
@@ -19,6 +19,10 @@ type PartyIdentification5Choice struct {
 	NationalRegistrationNumber *Max35Text `xml:"NtlRegnNb"`
 }
 
+func (p *PartyIdentification5Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification5Choice) SetBICOrBEI(value string) {
 	p.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
@@ -13,6 +13,10 @@ type PartyIdentification60 struct {
 	LegalEntityIdentifier *LEIIdentifier `xml:"LglNttyIdr,omitempty"`
 }
 
+func (p *PartyIdentification60) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification60) SetFundIdentification(value string) {
 	p.FundIdentification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type PartyIdentification62 struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr,omitempty"`
 }
 
+func (p *PartyIdentification62) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification62) SetBICFI(value string) {
 	p.BICFI = (*BICFIIdentifier)(&value)
 }
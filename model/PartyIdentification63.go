@@ -10,6 +10,10 @@ type PartyIdentification63 struct {
 	ProcessingIdentification *Max35Text `xml:"PrcgId,omitempty"`
 }
 
+func (p *PartyIdentification63) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification63) AddPartyIdentification() *PartyIdentification75Choice {
 	p.PartyIdentification = new(PartyIdentification75Choice)
 	return p.PartyIdentification
@@ -13,6 +13,10 @@ type PartyIdentification64 struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr,omitempty"`
 }
 
+func (p *PartyIdentification64) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification64) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
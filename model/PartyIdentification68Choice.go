@@ -13,6 +13,10 @@ type PartyIdentification68Choice struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PartyIdentification68Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification68Choice) SetBIC(value string) {
 	p.BIC = (*AnyBICIdentifier)(&value)
 }
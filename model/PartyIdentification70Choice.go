@@ -13,6 +13,10 @@ type PartyIdentification70Choice struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification70Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification70Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -10,6 +10,10 @@ type PartyIdentification71 struct {
 	AdditionalIdentificationInformation *PartyAdditionalIdentification2Choice `xml:"AddtlIdInf,omitempty"`
 }
 
+func (p *PartyIdentification71) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification71) AddIdentification() *PartyIdentification40Choice {
 	p.Identification = new(PartyIdentification40Choice)
 	return p.Identification
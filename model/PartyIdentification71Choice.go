@@ -13,6 +13,10 @@ type PartyIdentification71Choice struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification71Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification71Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
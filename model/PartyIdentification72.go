@@ -13,6 +13,10 @@ type PartyIdentification72 struct {
 	TaxParty *TaxParty1 `xml:"TaxPty,omitempty"`
 }
 
+func (p *PartyIdentification72) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification72) AddPartyIdentification() *PartyIdentification43 {
 	p.PartyIdentification = new(PartyIdentification43)
 	return p.PartyIdentification
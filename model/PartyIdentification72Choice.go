@@ -10,6 +10,10 @@ type PartyIdentification72Choice struct {
 	ProprietaryIdentification *GenericIdentification1 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification72Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification72Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -13,6 +13,10 @@ type PartyIdentification73Choice struct {
 	PartyIdentification *PartyIdentification59 `xml:"PtyId"`
 }
 
+func (p *PartyIdentification73Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification73Choice) AddNameAndAddress() *NameAndAddress8 {
 	p.NameAndAddress = new(NameAndAddress8)
 	return p.NameAndAddress
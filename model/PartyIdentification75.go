@@ -22,6 +22,10 @@ type PartyIdentification75 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentification75) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification75) AddIdentification() *PartyIdentification44Choice {
 	p.Identification = new(PartyIdentification44Choice)
 	return p.Identification
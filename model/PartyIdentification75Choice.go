@@ -13,6 +13,10 @@ type PartyIdentification75Choice struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PartyIdentification75Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification75Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -19,6 +19,10 @@ type PartyIdentification77 struct {
 	ContactDetails *ContactDetails2 `xml:"CtctDtls,omitempty"`
 }
 
+func (p *PartyIdentification77) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification77) SetName(value string) {
 	p.Name = (*Max140Text)(&value)
 }
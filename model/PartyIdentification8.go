@@ -16,6 +16,10 @@ type PartyIdentification8 struct {
 	CountryOfResidence *CountryCode `xml:"CtryOfRes,omitempty"`
 }
 
+func (p *PartyIdentification8) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification8) SetName(value string) {
 	p.Name = (*Max70Text)(&value)
 }
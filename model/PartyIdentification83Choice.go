@@ -13,6 +13,10 @@ type PartyIdentification83Choice struct {
 	NameAndAddress *NameAndAddress13 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification83Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification83Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
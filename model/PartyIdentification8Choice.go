@@ -10,6 +10,10 @@ type PartyIdentification8Choice struct {
 	BICOrBEI *PartyIdentification22 `xml:"BICOrBEI"`
 }
 
+func (p *PartyIdentification8Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification8Choice) AddNameAndAddress() *NameAndAddress8 {
 	p.NameAndAddress = new(NameAndAddress8)
 	return p.NameAndAddress
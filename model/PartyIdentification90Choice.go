@@ -13,6 +13,10 @@ type PartyIdentification90Choice struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification90Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification90Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
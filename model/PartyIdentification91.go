@@ -13,6 +13,10 @@ type PartyIdentification91 struct {
 	ProcessingIdentification *Max35Text `xml:"PrcgId,omitempty"`
 }
 
+func (p *PartyIdentification91) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification91) AddIdentification() *PartyIdentification44Choice {
 	p.Identification = new(PartyIdentification44Choice)
 	return p.Identification
@@ -13,6 +13,10 @@ type PartyIdentification92 struct {
 	AlternateIdentification []*AlternatePartyIdentification7 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentification92) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification92) AddIdentification() *PartyIdentification44Choice {
 	p.Identification = new(PartyIdentification44Choice)
 	return p.Identification
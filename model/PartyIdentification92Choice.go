@@ -10,6 +10,10 @@ type PartyIdentification92Choice struct {
 	ProprietaryIdentification *GenericIdentification36 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification92Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification92Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -25,6 +25,10 @@ type PartyIdentification93 struct {
 	CertificationBreakdown *Max350Text `xml:"CertfctnBrkdwn,omitempty"`
 }
 
+func (p *PartyIdentification93) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification93) AddOwnerIdentification() *PartyIdentification71Choice {
 	p.OwnerIdentification = new(PartyIdentification71Choice)
 	return p.OwnerIdentification
@@ -16,6 +16,10 @@ type PartyIdentification93Choice struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PartyIdentification93Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification93Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
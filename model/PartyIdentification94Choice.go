@@ -13,6 +13,10 @@ type PartyIdentification94Choice struct {
 	ProprietaryIdentification *GenericIdentification36 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification94Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification94Choice) SetBICFI(value string) {
 	p.BICFI = (*BICFIIdentifier)(&value)
 }
@@ -10,6 +10,10 @@ type PartyIdentification95 struct {
 	LegalEntityIdentifier *LEIIdentifier `xml:"LglNttyIdr,omitempty"`
 }
 
+func (p *PartyIdentification95) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification95) AddIdentification() *PartyIdentification70Choice {
 	p.Identification = new(PartyIdentification70Choice)
 	return p.Identification
@@ -10,6 +10,10 @@ type PartyIdentification95Choice struct {
 	ProprietaryIdentification *GenericIdentification79 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification95Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification95Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
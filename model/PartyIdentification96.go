@@ -10,6 +10,10 @@ type PartyIdentification96 struct {
 	LegalEntityIdentifier *LEIIdentifier `xml:"LglNttyIdr,omitempty"`
 }
 
+func (p *PartyIdentification96) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification96) AddIdentification() *PartyIdentification96Choice {
 	p.Identification = new(PartyIdentification96Choice)
 	return p.Identification
@@ -19,6 +19,10 @@ type PartyIdentification96Choice struct {
 	NationalRegistrationNumber *Max35Text `xml:"NtlRegnNb"`
 }
 
+func (p *PartyIdentification96Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification96Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
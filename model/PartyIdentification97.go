@@ -16,6 +16,10 @@ type PartyIdentification97 struct {
 	ContactPerson *ContactIdentification2 `xml:"CtctPrsn,omitempty"`
 }
 
+func (p *PartyIdentification97) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification97) AddPartyIdentification() *PartyIdentification70Choice {
 	p.PartyIdentification = new(PartyIdentification70Choice)
 	return p.PartyIdentification
@@ -16,6 +16,10 @@ type PartyIdentification97Choice struct {
 	ProprietaryIdentification *GenericIdentification1 `xml:"PrtryId"`
 }
 
+func (p *PartyIdentification97Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification97Choice) SetAnyBIC(value string) {
 	p.AnyBIC = (*AnyBICIdentifier)(&value)
 }
@@ -10,6 +10,10 @@ type PartyIdentification98 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (p *PartyIdentification98) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification98) AddIdentification() *PartyIdentification92Choice {
 	p.Identification = new(PartyIdentification92Choice)
 	return p.Identification
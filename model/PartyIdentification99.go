@@ -10,6 +10,10 @@ type PartyIdentification99 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (p *PartyIdentification99) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification99) AddIdentification() *PartyIdentification93Choice {
 	p.Identification = new(PartyIdentification93Choice)
 	return p.Identification
@@ -10,6 +10,10 @@ type PartyIdentification99Choice struct {
 	AnyBIC *PartyIdentification44 `xml:"AnyBIC"`
 }
 
+func (p *PartyIdentification99Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification99Choice) AddNameAndAddress() *NameAndAddress8 {
 	p.NameAndAddress = new(NameAndAddress8)
 	return p.NameAndAddress
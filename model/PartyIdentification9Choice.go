@@ -13,6 +13,10 @@ type PartyIdentification9Choice struct {
 	NameAndAddress *NameAndAddress5 `xml:"NmAndAdr"`
 }
 
+func (p *PartyIdentification9Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentification9Choice) SetBICOrBEI(value string) {
 	p.BICOrBEI = (*AnyBICIdentifier)(&value)
 }
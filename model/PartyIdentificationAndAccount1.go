@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount1 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount1) AddIdentification() *PartyIdentification10Choice {
 	p.Identification = new(PartyIdentification10Choice)
 	return p.Identification
@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount100 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount100) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount100) AddIdentification() *PartyIdentification83Choice {
 	p.Identification = new(PartyIdentification83Choice)
 	return p.Identification
@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount101 struct {
 	AlternateIdentification *AlternatePartyIdentification2 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount101) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount101) AddIdentification() *PartyIdentification38Choice {
 	p.Identification = new(PartyIdentification38Choice)
 	return p.Identification
@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount102 struct {
 	ContactPerson *ContactIdentification2 `xml:"CtctPrsn,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount102) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount102) AddPartyIdentification() *PartyIdentification33Choice {
 	p.PartyIdentification = new(PartyIdentification33Choice)
 	return p.PartyIdentification
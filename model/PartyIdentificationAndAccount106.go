@@ -25,6 +25,10 @@ type PartyIdentificationAndAccount106 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount106) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount106) AddIdentification() *PartyIdentification71Choice {
 	p.Identification = new(PartyIdentification71Choice)
 	return p.Identification
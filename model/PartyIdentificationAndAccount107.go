@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount107 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount107) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount107) AddIdentification() *PartyIdentification71Choice {
 	p.Identification = new(PartyIdentification71Choice)
 	return p.Identification
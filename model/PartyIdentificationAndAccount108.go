@@ -25,6 +25,10 @@ type PartyIdentificationAndAccount108 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount108) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount108) AddIdentification() *PartyIdentification71Choice {
 	p.Identification = new(PartyIdentification71Choice)
 	return p.Identification
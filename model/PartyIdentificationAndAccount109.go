@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount109 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount109) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount109) AddIdentification() *PartyIdentification71Choice {
 	p.Identification = new(PartyIdentification71Choice)
 	return p.Identification
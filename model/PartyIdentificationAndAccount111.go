@@ -28,6 +28,10 @@ type PartyIdentificationAndAccount111 struct {
 	AdditionalInformation *PartyTextInformation2 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount111) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount111) AddIdentification() *PartyIdentification71Choice {
 	p.Identification = new(PartyIdentification71Choice)
 	return p.Identification
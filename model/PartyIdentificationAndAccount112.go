@@ -28,6 +28,10 @@ type PartyIdentificationAndAccount112 struct {
 	AdditionalInformation *PartyTextInformation2 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount112) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount112) AddIdentification() *PartyIdentification94Choice {
 	p.Identification = new(PartyIdentification94Choice)
 	return p.Identification
@@ -16,6 +16,10 @@ type PartyIdentificationAndAccount117 struct {
 	ProcessingIdentification *Max35Text `xml:"PrcgId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount117) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount117) AddIdentification() *PartyIdentification71Choice {
 	p.Identification = new(PartyIdentification71Choice)
 	return p.Identification
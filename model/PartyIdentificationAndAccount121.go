@@ -16,6 +16,10 @@ type PartyIdentificationAndAccount121 struct {
 	AlternateIdentification *AlternatePartyIdentification7 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount121) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount121) AddIdentification() *PartyIdentification94Choice {
 	p.Identification = new(PartyIdentification94Choice)
 	return p.Identification
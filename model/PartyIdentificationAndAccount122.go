@@ -16,6 +16,10 @@ type PartyIdentificationAndAccount122 struct {
 	AlternateIdentification []*AlternatePartyIdentification7 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount122) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount122) AddIdentification() *PartyIdentification71Choice {
 	p.Identification = new(PartyIdentification71Choice)
 	return p.Identification
@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount123 struct {
 	ContactPerson *ContactIdentification2 `xml:"CtctPrsn,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount123) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount123) AddPartyIdentification() *PartyIdentification70Choice {
 	p.PartyIdentification = new(PartyIdentification70Choice)
 	return p.PartyIdentification
@@ -16,6 +16,10 @@ type PartyIdentificationAndAccount124 struct {
 	ProcessingDate *DateAndDateTimeChoice `xml:"PrcgDt,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount124) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount124) AddPartyIdentification() *PartyIdentification70Choice {
 	p.PartyIdentification = new(PartyIdentification70Choice)
 	return p.PartyIdentification
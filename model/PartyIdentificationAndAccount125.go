@@ -13,6 +13,10 @@ type PartyIdentificationAndAccount125 struct {
 	PlaceOfSettlement *PartyIdentification70Choice `xml:"PlcOfSttlm"`
 }
 
+func (p *PartyIdentificationAndAccount125) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount125) AddPartyIdentification() *PartyIdentification70Choice {
 	p.PartyIdentification = new(PartyIdentification70Choice)
 	return p.PartyIdentification
@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount126 struct {
 	ContactPerson *ContactIdentification2 `xml:"CtctPrsn,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount126) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount126) AddPartyIdentification() *PartyIdentification100Choice {
 	p.PartyIdentification = new(PartyIdentification100Choice)
 	return p.PartyIdentification
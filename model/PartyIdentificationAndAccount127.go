@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount127 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount127) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount127) AddIdentification() *PartyIdentification101Choice {
 	p.Identification = new(PartyIdentification101Choice)
 	return p.Identification
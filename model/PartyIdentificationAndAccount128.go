@@ -16,6 +16,10 @@ type PartyIdentificationAndAccount128 struct {
 	AlternateIdentification []*AlternatePartyIdentification9 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount128) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount128) AddIdentification() *PartyIdentification104Choice {
 	p.Identification = new(PartyIdentification104Choice)
 	return p.Identification
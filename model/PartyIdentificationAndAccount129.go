@@ -16,6 +16,10 @@ type PartyIdentificationAndAccount129 struct {
 	AlternateIdentification *AlternatePartyIdentification9 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount129) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount129) AddIdentification() *PartyIdentification104Choice {
 	p.Identification = new(PartyIdentification104Choice)
 	return p.Identification
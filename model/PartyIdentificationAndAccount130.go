@@ -16,6 +16,10 @@ type PartyIdentificationAndAccount130 struct {
 	AlternateIdentification *AlternatePartyIdentification9 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount130) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount130) AddIdentification() *PartyIdentification113Choice {
 	p.Identification = new(PartyIdentification113Choice)
 	return p.Identification
@@ -25,6 +25,10 @@ type PartyIdentificationAndAccount131 struct {
 	AdditionalInformation *PartyTextInformation3 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount131) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount131) AddIdentification() *PartyIdentification104Choice {
 	p.Identification = new(PartyIdentification104Choice)
 	return p.Identification
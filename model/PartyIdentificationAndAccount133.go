@@ -28,6 +28,10 @@ type PartyIdentificationAndAccount133 struct {
 	AdditionalInformation *PartyTextInformation4 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount133) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount133) AddIdentification() *PartyIdentification104Choice {
 	p.Identification = new(PartyIdentification104Choice)
 	return p.Identification
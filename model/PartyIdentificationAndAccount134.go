@@ -28,6 +28,10 @@ type PartyIdentificationAndAccount134 struct {
 	AdditionalInformation *PartyTextInformation4 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount134) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount134) AddIdentification() *PartyIdentification113Choice {
 	p.Identification = new(PartyIdentification113Choice)
 	return p.Identification
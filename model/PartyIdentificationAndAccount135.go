@@ -25,6 +25,10 @@ type PartyIdentificationAndAccount135 struct {
 	AdditionalInformation *PartyTextInformation3 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount135) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount135) AddIdentification() *PartyIdentification104Choice {
 	p.Identification = new(PartyIdentification104Choice)
 	return p.Identification
@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount136 struct {
 	AdditionalInformation *PartyTextInformation3 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount136) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount136) AddIdentification() *PartyIdentification104Choice {
 	p.Identification = new(PartyIdentification104Choice)
 	return p.Identification
@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount137 struct {
 	AdditionalInformation *PartyTextInformation3 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount137) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount137) AddIdentification() *PartyIdentification104Choice {
 	p.Identification = new(PartyIdentification104Choice)
 	return p.Identification
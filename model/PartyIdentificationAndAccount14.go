@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount14 struct {
 	AlternateIdentification []*AlternatePartyIdentification2 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount14) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount14) AddIdentification() *PartyIdentification10Choice {
 	p.Identification = new(PartyIdentification10Choice)
 	return p.Identification
@@ -16,6 +16,10 @@ type PartyIdentificationAndAccount146 struct {
 	ProcessingIdentification *RestrictedFINXMax16Text `xml:"PrcgId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount146) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount146) AddIdentification() *PartyIdentification104Choice {
 	p.Identification = new(PartyIdentification104Choice)
 	return p.Identification
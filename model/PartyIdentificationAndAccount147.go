@@ -10,6 +10,10 @@ type PartyIdentificationAndAccount147 struct {
 	AccountIdentification *Max35Text `xml:"AcctId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount147) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount147) AddPartyIdentification() *PartyIdentification113 {
 	p.PartyIdentification = new(PartyIdentification113)
 	return p.PartyIdentification
@@ -25,6 +25,10 @@ type PartyIdentificationAndAccount15 struct {
 	AdditionalInformation *PartyTextInformation2 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount15) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount15) AddIdentification() *PartyIdentification30Choice {
 	p.Identification = new(PartyIdentification30Choice)
 	return p.Identification
@@ -13,6 +13,10 @@ type PartyIdentificationAndAccount16 struct {
 	ProcessingIdentification *Max35Text `xml:"PrcgId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount16) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount16) AddIdentification() *PartyIdentification10Choice {
 	p.Identification = new(PartyIdentification10Choice)
 	return p.Identification
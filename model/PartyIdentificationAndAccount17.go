@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount17 struct {
 	AlternateIdentification *AlternatePartyIdentification2 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount17) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount17) AddIdentification() *PartyIdentification10Choice {
 	p.Identification = new(PartyIdentification10Choice)
 	return p.Identification
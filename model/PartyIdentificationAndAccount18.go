@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount18 struct {
 	AlternateIdentification *AlternatePartyIdentification2 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount18) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount18) AddIdentification() *PartyIdentification30Choice {
 	p.Identification = new(PartyIdentification30Choice)
 	return p.Identification
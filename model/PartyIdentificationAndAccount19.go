@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount19 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount19) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount19) AddIdentification() *PartyIdentification10Choice {
 	p.Identification = new(PartyIdentification10Choice)
 	return p.Identification
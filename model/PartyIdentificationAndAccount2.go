@@ -16,6 +16,10 @@ type PartyIdentificationAndAccount2 struct {
 	ProcessingDate *DateAndDateTimeChoice `xml:"PrcgDt,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount2) AddPartyIdentification() *PartyIdentification1Choice {
 	p.PartyIdentification = new(PartyIdentification1Choice)
 	return p.PartyIdentification
@@ -25,6 +25,10 @@ type PartyIdentificationAndAccount20 struct {
 	AdditionalInformation *PartyTextInformation2 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount20) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount20) AddIdentification() *PartyIdentification10Choice {
 	p.Identification = new(PartyIdentification10Choice)
 	return p.Identification
@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount21 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount21) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount21) AddIdentification() *PartyIdentification10Choice {
 	p.Identification = new(PartyIdentification10Choice)
 	return p.Identification
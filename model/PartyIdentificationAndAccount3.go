@@ -10,6 +10,10 @@ type PartyIdentificationAndAccount3 struct {
 	AccountIdentification *AccountIdentification1 `xml:"AcctId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount3) AddPartyIdentification() *PartyIdentification2Choice {
 	p.PartyIdentification = new(PartyIdentification2Choice)
 	return p.PartyIdentification
@@ -16,6 +16,10 @@ type PartyIdentificationAndAccount31 struct {
 	ClearingAccount *SecuritiesAccount18 `xml:"ClrAcct,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount31) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount31) AddIdentification() *PartyIdentification33Choice {
 	p.Identification = new(PartyIdentification33Choice)
 	return p.Identification
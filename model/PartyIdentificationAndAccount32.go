@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount32 struct {
 	ContactPerson *ContactIdentification2 `xml:"CtctPrsn,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount32) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount32) AddPartyIdentification() *PartyIdentification33Choice {
 	p.PartyIdentification = new(PartyIdentification33Choice)
 	return p.PartyIdentification
@@ -16,6 +16,10 @@ type PartyIdentificationAndAccount34 struct {
 	SafekeepingAccount *Max35Text `xml:"SfkpgAcct,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount34) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount34) AddIdentification() *PartyIdentification32Choice {
 	p.Identification = new(PartyIdentification32Choice)
 	return p.Identification
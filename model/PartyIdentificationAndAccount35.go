@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount35 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount35) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount35) AddIdentification() *PartyIdentification49Choice {
 	p.Identification = new(PartyIdentification49Choice)
 	return p.Identification
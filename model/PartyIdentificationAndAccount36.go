@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount36 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount36) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount36) AddIdentification() *PartyIdentification49Choice {
 	p.Identification = new(PartyIdentification49Choice)
 	return p.Identification
@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount37 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount37) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount37) AddIdentification() *PartyIdentification49Choice {
 	p.Identification = new(PartyIdentification49Choice)
 	return p.Identification
@@ -25,6 +25,10 @@ type PartyIdentificationAndAccount38 struct {
 	AdditionalInformation *PartyTextInformation2 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount38) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount38) AddIdentification() *PartyIdentification49Choice {
 	p.Identification = new(PartyIdentification49Choice)
 	return p.Identification
@@ -25,6 +25,10 @@ type PartyIdentificationAndAccount39 struct {
 	AdditionalInformation *PartyTextInformation2 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount39) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount39) AddIdentification() *PartyIdentification43Choice {
 	p.Identification = new(PartyIdentification43Choice)
 	return p.Identification
@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount4 struct {
 	ContactPerson *ContactIdentification2 `xml:"CtctPrsn,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount4) AddPartyIdentification() *PartyIdentification2Choice {
 	p.PartyIdentification = new(PartyIdentification2Choice)
 	return p.PartyIdentification
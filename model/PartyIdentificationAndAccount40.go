@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount40 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount40) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount40) AddIdentification() *PartyIdentification43Choice {
 	p.Identification = new(PartyIdentification43Choice)
 	return p.Identification
@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount41 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount41) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount41) AddIdentification() *PartyIdentification43Choice {
 	p.Identification = new(PartyIdentification43Choice)
 	return p.Identification
@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount42 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount42) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount42) AddIdentification() *PartyIdentification43Choice {
 	p.Identification = new(PartyIdentification43Choice)
 	return p.Identification
@@ -13,6 +13,10 @@ type PartyIdentificationAndAccount43 struct {
 	ProcessingIdentification *Max35Text `xml:"PrcgId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount43) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount43) AddIdentification() *PartyIdentification43Choice {
 	p.Identification = new(PartyIdentification43Choice)
 	return p.Identification
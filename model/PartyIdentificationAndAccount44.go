@@ -13,6 +13,10 @@ type PartyIdentificationAndAccount44 struct {
 	ProcessingIdentification *Max35Text `xml:"PrcgId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount44) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount44) AddIdentification() *PartyIdentification45Choice {
 	p.Identification = new(PartyIdentification45Choice)
 	return p.Identification
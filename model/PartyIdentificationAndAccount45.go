@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount45 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount45) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount45) AddIdentification() *PartyIdentification45Choice {
 	p.Identification = new(PartyIdentification45Choice)
 	return p.Identification
@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount46 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount46) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount46) AddIdentification() *PartyIdentification45Choice {
 	p.Identification = new(PartyIdentification45Choice)
 	return p.Identification
@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount47 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount47) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount47) AddIdentification() *PartyIdentification45Choice {
 	p.Identification = new(PartyIdentification45Choice)
 	return p.Identification
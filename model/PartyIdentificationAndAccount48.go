@@ -25,6 +25,10 @@ type PartyIdentificationAndAccount48 struct {
 	AdditionalInformation *PartyTextInformation2 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount48) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount48) AddIdentification() *PartyIdentification45Choice {
 	p.Identification = new(PartyIdentification45Choice)
 	return p.Identification
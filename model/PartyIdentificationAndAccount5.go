@@ -16,6 +16,10 @@ type PartyIdentificationAndAccount5 struct {
 	ProcessingDate *DateAndDateTimeChoice `xml:"PrcgDt,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount5) AddPartyIdentification() *PartyIdentification2Choice {
 	p.PartyIdentification = new(PartyIdentification2Choice)
 	return p.PartyIdentification
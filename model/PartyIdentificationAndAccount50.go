@@ -25,6 +25,10 @@ type PartyIdentificationAndAccount50 struct {
 	AdditionalInformation *PartyTextInformation2 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount50) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount50) AddIdentification() *PartyIdentification38Choice {
 	p.Identification = new(PartyIdentification38Choice)
 	return p.Identification
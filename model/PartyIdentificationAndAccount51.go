@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount51 struct {
 	AlternateIdentification []*AlternatePartyIdentification2 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount51) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount51) AddIdentification() *PartyIdentification40Choice {
 	p.Identification = new(PartyIdentification40Choice)
 	return p.Identification
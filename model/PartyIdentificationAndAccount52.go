@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount52 struct {
 	AlternateIdentification *AlternatePartyIdentification2 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount52) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount52) AddIdentification() *PartyIdentification40Choice {
 	p.Identification = new(PartyIdentification40Choice)
 	return p.Identification
@@ -25,6 +25,10 @@ type PartyIdentificationAndAccount53 struct {
 	AdditionalInformation *PartyTextInformation2 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount53) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount53) AddIdentification() *PartyIdentification40Choice {
 	p.Identification = new(PartyIdentification40Choice)
 	return p.Identification
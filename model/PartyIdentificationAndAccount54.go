@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount54 struct {
 	AlternateIdentification *AlternatePartyIdentification2 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount54) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount54) AddIdentification() *PartyIdentification42Choice {
 	p.Identification = new(PartyIdentification42Choice)
 	return p.Identification
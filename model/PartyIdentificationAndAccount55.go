@@ -25,6 +25,10 @@ type PartyIdentificationAndAccount55 struct {
 	AdditionalInformation *PartyTextInformation2 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount55) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount55) AddIdentification() *PartyIdentification42Choice {
 	p.Identification = new(PartyIdentification42Choice)
 	return p.Identification
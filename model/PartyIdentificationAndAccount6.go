@@ -13,6 +13,10 @@ type PartyIdentificationAndAccount6 struct {
 	FinancingAccount *CashAccount7 `xml:"FincgAcct,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount6) AddPartyIdentification() *PartyIdentification25 {
 	p.PartyIdentification = new(PartyIdentification25)
 	return p.PartyIdentification
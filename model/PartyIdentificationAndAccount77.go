@@ -19,6 +19,10 @@ type PartyIdentificationAndAccount77 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount77) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount77) AddIdentification() *PartyIdentification32Choice {
 	p.Identification = new(PartyIdentification32Choice)
 	return p.Identification
@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount78 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount78) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount78) AddIdentification() *PartyIdentification32Choice {
 	p.Identification = new(PartyIdentification32Choice)
 	return p.Identification
@@ -25,6 +25,10 @@ type PartyIdentificationAndAccount79 struct {
 	AlternateIdentification *AlternatePartyIdentification5 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount79) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount79) AddIdentification() *PartyIdentification32Choice {
 	p.Identification = new(PartyIdentification32Choice)
 	return p.Identification
@@ -25,6 +25,10 @@ type PartyIdentificationAndAccount80 struct {
 	AdditionalInformation *PartyTextInformation2 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount80) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount80) AddIdentification() *PartyIdentification32Choice {
 	p.Identification = new(PartyIdentification32Choice)
 	return p.Identification
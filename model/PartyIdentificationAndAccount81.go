@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount81 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount81) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount81) AddIdentification() *PartyIdentification43Choice {
 	p.Identification = new(PartyIdentification43Choice)
 	return p.Identification
@@ -22,6 +22,10 @@ type PartyIdentificationAndAccount83 struct {
 	AlternateIdentification *AlternatePartyIdentification6 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount83) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount83) AddIdentification() *PartyIdentification70Choice {
 	p.Identification = new(PartyIdentification70Choice)
 	return p.Identification
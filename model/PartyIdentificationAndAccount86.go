@@ -16,6 +16,10 @@ type PartyIdentificationAndAccount86 struct {
 	AdditionalInformation *PartyTextInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount86) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount86) AddIdentification() *PartyIdentification43Choice {
 	p.Identification = new(PartyIdentification43Choice)
 	return p.Identification
@@ -16,6 +16,10 @@ type PartyIdentificationAndAccount87 struct {
 	AlternateIdentification *AlternatePartyIdentification6 `xml:"AltrnId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount87) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount87) AddIdentification() *PartyIdentification70Choice {
 	p.Identification = new(PartyIdentification70Choice)
 	return p.Identification
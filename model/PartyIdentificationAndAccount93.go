@@ -13,6 +13,10 @@ type PartyIdentificationAndAccount93 struct {
 	PlaceOfSettlement *PartyIdentification2Choice `xml:"PlcOfSttlm"`
 }
 
+func (p *PartyIdentificationAndAccount93) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount93) AddPartyIdentification() *PartyIdentification2Choice {
 	p.PartyIdentification = new(PartyIdentification2Choice)
 	return p.PartyIdentification
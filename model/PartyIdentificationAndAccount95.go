@@ -13,6 +13,10 @@ type PartyIdentificationAndAccount95 struct {
 	ProcessingIdentification *Max35Text `xml:"PrcgId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount95) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount95) AddPartyIdentification() *PartyIdentification71Choice {
 	p.PartyIdentification = new(PartyIdentification71Choice)
 	return p.PartyIdentification
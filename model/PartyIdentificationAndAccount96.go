@@ -10,6 +10,10 @@ type PartyIdentificationAndAccount96 struct {
 	AccountIdentification *AccountIdentification26 `xml:"AcctId"`
 }
 
+func (p *PartyIdentificationAndAccount96) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount96) AddPartyIdentification() *PartyIdentification64 {
 	p.PartyIdentification = new(PartyIdentification64)
 	return p.PartyIdentification
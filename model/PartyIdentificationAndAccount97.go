@@ -10,6 +10,10 @@ type PartyIdentificationAndAccount97 struct {
 	AccountIdentification *AccountIdentification26 `xml:"AcctId,omitempty"`
 }
 
+func (p *PartyIdentificationAndAccount97) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndAccount97) AddPartyIdentification() *PartyIdentification62 {
 	p.PartyIdentification = new(PartyIdentification62)
 	return p.PartyIdentification
@@ -10,6 +10,10 @@ type PartyIdentificationAndContactInformation1 struct {
 	ContactInformation *ContactIdentification1 `xml:"CtctInf,omitempty"`
 }
 
+func (p *PartyIdentificationAndContactInformation1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyIdentificationAndContactInformation1) AddPartyIdentification() *PartyIdentification8 {
 	p.PartyIdentification = new(PartyIdentification8)
 	return p.PartyIdentification
@@ -10,6 +10,10 @@ type PartyModification1 struct {
 	PartyIdentification *PartyIdentification40 `xml:"PtyId"`
 }
 
+func (p *PartyModification1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyModification1) SetModificationCode(value string) {
 	p.ModificationCode = (*Modification1Code)(&value)
 }
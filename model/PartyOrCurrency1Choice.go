@@ -10,6 +10,10 @@ type PartyOrCurrency1Choice struct {
 	SettlementCurrency *ActiveCurrencyCode `xml:"SttlmCcy"`
 }
 
+func (p *PartyOrCurrency1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyOrCurrency1Choice) AddDepository() *PartyIdentification63 {
 	p.Depository = new(PartyIdentification63)
 	return p.Depository
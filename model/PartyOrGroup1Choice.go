@@ -10,6 +10,10 @@ type PartyOrGroup1Choice struct {
 	Party *PartyAndCertificate2 `xml:"Pty"`
 }
 
+func (p *PartyOrGroup1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyOrGroup1Choice) SetGroupIdentification(value string) {
 	p.GroupIdentification = (*Max4AlphaNumericText)(&value)
 }
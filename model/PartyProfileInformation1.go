@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information to support the Know Your Customer processes.
 type PartyProfileInformation1 struct {
 
@@ -37,10 +41,19 @@ type PartyProfileInformation1 struct {
 	SourceOfWealth *Max140Text `xml:"SrcOfWlth,omitempty"`
 }
 
+func (p *PartyProfileInformation1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyProfileInformation1) SetCertificationIndicator(value string) {
 	p.CertificationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *PartyProfileInformation1) SetCertificationIndicatorFromBool(value bool) {
+	p.CertificationIndicator = new(YesNoIndicator)
+	p.CertificationIndicator.FromBool(value)
+}
+
 func (p *PartyProfileInformation1) SetValidatingParty(value string) {
 	p.ValidatingParty = (*Max140Text)(&value)
 }
@@ -65,6 +78,11 @@ func (p *PartyProfileInformation1) SetCheckingDate(value string) {
 	p.CheckingDate = (*ISODate)(&value)
 }
 
+func (p *PartyProfileInformation1) SetCheckingDateFromTime(value time.Time) {
+	p.CheckingDate = new(ISODate)
+	p.CheckingDate.FromTime(value)
+}
+
 func (p *PartyProfileInformation1) SetCheckingFrequency(value string) {
 	p.CheckingFrequency = (*EventFrequency1Code)(&value)
 }
@@ -73,6 +91,11 @@ func (p *PartyProfileInformation1) SetNextRevisionDate(value string) {
 	p.NextRevisionDate = (*ISODate)(&value)
 }
 
+func (p *PartyProfileInformation1) SetNextRevisionDateFromTime(value time.Time) {
+	p.NextRevisionDate = new(ISODate)
+	p.NextRevisionDate.FromTime(value)
+}
+
 func (p *PartyProfileInformation1) SetSalaryRange(value string) {
 	p.SalaryRange = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information to support the Know Your Customer processes.
 type PartyProfileInformation2 struct {
 
@@ -40,10 +44,19 @@ type PartyProfileInformation2 struct {
 	RiskLevel *RiskLevel1Choice `xml:"RskLvl,omitempty"`
 }
 
+func (p *PartyProfileInformation2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyProfileInformation2) SetCertificationIndicator(value string) {
 	p.CertificationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *PartyProfileInformation2) SetCertificationIndicatorFromBool(value bool) {
+	p.CertificationIndicator = new(YesNoIndicator)
+	p.CertificationIndicator.FromBool(value)
+}
+
 func (p *PartyProfileInformation2) SetValidatingParty(value string) {
 	p.ValidatingParty = (*Max140Text)(&value)
 }
@@ -65,6 +78,11 @@ func (p *PartyProfileInformation2) SetCheckingDate(value string) {
 	p.CheckingDate = (*ISODate)(&value)
 }
 
+func (p *PartyProfileInformation2) SetCheckingDateFromTime(value time.Time) {
+	p.CheckingDate = new(ISODate)
+	p.CheckingDate.FromTime(value)
+}
+
 func (p *PartyProfileInformation2) SetCheckingFrequency(value string) {
 	p.CheckingFrequency = (*EventFrequency1Code)(&value)
 }
@@ -73,6 +91,11 @@ func (p *PartyProfileInformation2) SetNextRevisionDate(value string) {
 	p.NextRevisionDate = (*ISODate)(&value)
 }
 
+func (p *PartyProfileInformation2) SetNextRevisionDateFromTime(value time.Time) {
+	p.NextRevisionDate = new(ISODate)
+	p.NextRevisionDate.FromTime(value)
+}
+
 func (p *PartyProfileInformation2) SetSalaryRange(value string) {
 	p.SalaryRange = (*Max35Text)(&value)
 }
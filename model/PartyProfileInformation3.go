@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information to support the Know Your Customer (KYC) processes.
 type PartyProfileInformation3 struct {
 
@@ -43,10 +47,19 @@ type PartyProfileInformation3 struct {
 	KnowYourCustomerCheckType *KYCCheckType1Choice `xml:"KnowYourCstmrChckTp,omitempty"`
 }
 
+func (p *PartyProfileInformation3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyProfileInformation3) SetCertificationIndicator(value string) {
 	p.CertificationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *PartyProfileInformation3) SetCertificationIndicatorFromBool(value bool) {
+	p.CertificationIndicator = new(YesNoIndicator)
+	p.CertificationIndicator.FromBool(value)
+}
+
 func (p *PartyProfileInformation3) SetValidatingParty(value string) {
 	p.ValidatingParty = (*Max140Text)(&value)
 }
@@ -68,6 +81,11 @@ func (p *PartyProfileInformation3) SetCheckingDate(value string) {
 	p.CheckingDate = (*ISODate)(&value)
 }
 
+func (p *PartyProfileInformation3) SetCheckingDateFromTime(value time.Time) {
+	p.CheckingDate = new(ISODate)
+	p.CheckingDate.FromTime(value)
+}
+
 func (p *PartyProfileInformation3) SetCheckingFrequency(value string) {
 	p.CheckingFrequency = (*EventFrequency1Code)(&value)
 }
@@ -76,6 +94,11 @@ func (p *PartyProfileInformation3) SetNextRevisionDate(value string) {
 	p.NextRevisionDate = (*ISODate)(&value)
 }
 
+func (p *PartyProfileInformation3) SetNextRevisionDateFromTime(value time.Time) {
+	p.NextRevisionDate = new(ISODate)
+	p.NextRevisionDate.FromTime(value)
+}
+
 func (p *PartyProfileInformation3) SetSalaryRange(value string) {
 	p.SalaryRange = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information to support the Know Your Customer (KYC) processes.
 type PartyProfileInformation4 struct {
 
@@ -46,10 +50,19 @@ type PartyProfileInformation4 struct {
 	KnowYourCustomerDatabaseCheck *DataBaseCheck1 `xml:"KnowYourCstmrDBChck,omitempty"`
 }
 
+func (p *PartyProfileInformation4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyProfileInformation4) SetCertificationIndicator(value string) {
 	p.CertificationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *PartyProfileInformation4) SetCertificationIndicatorFromBool(value bool) {
+	p.CertificationIndicator = new(YesNoIndicator)
+	p.CertificationIndicator.FromBool(value)
+}
+
 func (p *PartyProfileInformation4) SetValidatingParty(value string) {
 	p.ValidatingParty = (*Max140Text)(&value)
 }
@@ -71,6 +84,11 @@ func (p *PartyProfileInformation4) SetCheckingDate(value string) {
 	p.CheckingDate = (*ISODate)(&value)
 }
 
+func (p *PartyProfileInformation4) SetCheckingDateFromTime(value time.Time) {
+	p.CheckingDate = new(ISODate)
+	p.CheckingDate.FromTime(value)
+}
+
 func (p *PartyProfileInformation4) SetCheckingFrequency(value string) {
 	p.CheckingFrequency = (*EventFrequency1Code)(&value)
 }
@@ -79,6 +97,11 @@ func (p *PartyProfileInformation4) SetNextRevisionDate(value string) {
 	p.NextRevisionDate = (*ISODate)(&value)
 }
 
+func (p *PartyProfileInformation4) SetNextRevisionDateFromTime(value time.Time) {
+	p.NextRevisionDate = new(ISODate)
+	p.NextRevisionDate.FromTime(value)
+}
+
 func (p *PartyProfileInformation4) SetSalaryRange(value string) {
 	p.SalaryRange = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type PartyRole2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PartyRole2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyRole2Choice) SetCode(value string) {
 	p.Code = (*InvestmentFundRole6Code)(&value)
 }
@@ -10,6 +10,10 @@ type PartyRole4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PartyRole4Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyRole4Choice) SetCode(value string) {
 	p.Code = (*InvestmentFundRole7Code)(&value)
 }
@@ -10,6 +10,10 @@ type PartyRole5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PartyRole5Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyRole5Choice) SetCode(value string) {
 	p.Code = (*PartyRole1Code)(&value)
 }
@@ -13,6 +13,10 @@ type PartyTextInformation1 struct {
 	RegistrationDetails *Max350Text `xml:"RegnDtls,omitempty"`
 }
 
+func (p *PartyTextInformation1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyTextInformation1) SetDeclarationDetails(value string) {
 	p.DeclarationDetails = (*Max350Text)(&value)
 }
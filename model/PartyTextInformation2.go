@@ -10,6 +10,10 @@ type PartyTextInformation2 struct {
 	PartyContactDetails *Max140Text `xml:"PtyCtctDtls,omitempty"`
 }
 
+func (p *PartyTextInformation2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyTextInformation2) SetDeclarationDetails(value string) {
 	p.DeclarationDetails = (*Max350Text)(&value)
 }
@@ -13,6 +13,10 @@ type PartyTextInformation3 struct {
 	RegistrationDetails *RestrictedFINXMax350Text `xml:"RegnDtls,omitempty"`
 }
 
+func (p *PartyTextInformation3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyTextInformation3) SetDeclarationDetails(value string) {
 	p.DeclarationDetails = (*RestrictedFINXMax350Text)(&value)
 }
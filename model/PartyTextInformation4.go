@@ -10,6 +10,10 @@ type PartyTextInformation4 struct {
 	PartyContactDetails *RestrictedFINXMax140Text `xml:"PtyCtctDtls,omitempty"`
 }
 
+func (p *PartyTextInformation4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyTextInformation4) SetDeclarationDetails(value string) {
 	p.DeclarationDetails = (*RestrictedFINXMax350Text)(&value)
 }
@@ -10,6 +10,10 @@ type PartyTextInformation5 struct {
 	PartyContactDetails *Max140Text `xml:"PtyCtctDtls,omitempty"`
 }
 
+func (p *PartyTextInformation5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyTextInformation5) SetDeclarationDetails(value string) {
 	p.DeclarationDetails = (*Max350Text)(&value)
 }
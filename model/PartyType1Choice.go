@@ -11,6 +11,10 @@ type PartyType1Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (p *PartyType1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PartyType1Choice) SetCode(value string) {
 	p.Code = (*ExternalTypeOfParty1Code)(&value)
 }
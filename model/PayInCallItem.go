@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Numerical representation of the net increases and decreases in an account at a specific point in time. A cash balance is calculated from a sum of cash credits minus a sum of cash debits.
 type PayInCallItem struct {
 
@@ -7,6 +11,14 @@ type PayInCallItem struct {
 	Amount *ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
 }
 
+func (p *PayInCallItem) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PayInCallItem) SetAmount(value, currency string) {
 	p.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (p *PayInCallItem) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Factors used in the calculation of the pay in schedule.
 type PayInFactors1 struct {
 
@@ -10,10 +14,18 @@ type PayInFactors1 struct {
 	CurrencyFactors []*CurrencyFactors1 `xml:"CcyFctrs"`
 }
 
+func (p *PayInFactors1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PayInFactors1) SetAggregateShortPositionLimit(value, currency string) {
 	p.AggregateShortPositionLimit = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PayInFactors1) SetAggregateShortPositionLimitFromDecimal(value decimal.Decimal, currency string) {
+	p.AggregateShortPositionLimit = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PayInFactors1) AddCurrencyFactors() *CurrencyFactors1 {
 	newValue := new(CurrencyFactors1)
 	p.CurrencyFactors = append(p.CurrencyFactors, newValue)
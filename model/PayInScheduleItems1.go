@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Posting of an item to a cash account, in the context of a cash transaction, that results in an increase or decrease to the balance of the account.
 type PayInScheduleItems1 struct {
 
@@ -10,10 +16,23 @@ type PayInScheduleItems1 struct {
 	Deadline *ISODateTime `xml:"Ddln"`
 }
 
+func (p *PayInScheduleItems1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PayInScheduleItems1) SetAmount(value, currency string) {
 	p.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PayInScheduleItems1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PayInScheduleItems1) SetDeadline(value string) {
 	p.Deadline = (*ISODateTime)(&value)
 }
+
+func (p *PayInScheduleItems1) SetDeadlineFromTime(value time.Time) {
+	p.Deadline = new(ISODateTime)
+	p.Deadline.FromTime(value)
+}
@@ -13,6 +13,10 @@ type PaymentCancellationReason1 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PaymentCancellationReason1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCancellationReason1) AddOriginator() *PartyIdentification43 {
 	p.Originator = new(PartyIdentification43)
 	return p.Originator
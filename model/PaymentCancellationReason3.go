@@ -13,6 +13,10 @@ type PaymentCancellationReason3 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PaymentCancellationReason3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCancellationReason3) AddOriginator() *PartyIdentification43 {
 	p.Originator = new(PartyIdentification43)
 	return p.Originator
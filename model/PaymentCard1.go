@@ -22,6 +22,10 @@ type PaymentCard1 struct {
 	AdditionalCardData *Max70Text `xml:"AddtlCardData,omitempty"`
 }
 
+func (p *PaymentCard1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard1) AddProtectedCardData() *ContentInformationType2 {
 	p.ProtectedCardData = new(ContentInformationType2)
 	return p.ProtectedCardData
@@ -19,6 +19,10 @@ type PaymentCard10 struct {
 	CardProductType *CardProductType1Code `xml:"CardPdctTp,omitempty"`
 }
 
+func (p *PaymentCard10) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard10) AddProtectedCardData() *ContentInformationType10 {
 	p.ProtectedCardData = new(ContentInformationType10)
 	return p.ProtectedCardData
@@ -34,6 +34,10 @@ type PaymentCard11 struct {
 	AdditionalCardData *Max70Text `xml:"AddtlCardData,omitempty"`
 }
 
+func (p *PaymentCard11) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard11) AddProtectedCardData() *ContentInformationType10 {
 	p.ProtectedCardData = new(ContentInformationType10)
 	return p.ProtectedCardData
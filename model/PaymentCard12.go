@@ -22,6 +22,10 @@ type PaymentCard12 struct {
 	AdditionalCardData *Max70Text `xml:"AddtlCardData,omitempty"`
 }
 
+func (p *PaymentCard12) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard12) AddProtectedCardData() *ContentInformationType10 {
 	p.ProtectedCardData = new(ContentInformationType10)
 	return p.ProtectedCardData
@@ -19,6 +19,10 @@ type PaymentCard13 struct {
 	CardProductName *Max35Text `xml:"CardPdctNm,omitempty"`
 }
 
+func (p *PaymentCard13) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard13) AddProtectedCardData() *ContentInformationType10 {
 	p.ProtectedCardData = new(ContentInformationType10)
 	return p.ProtectedCardData
@@ -19,6 +19,10 @@ type PaymentCard14 struct {
 	CardCurrencyCode *Exact3AlphaNumericText `xml:"CardCcyCd,omitempty"`
 }
 
+func (p *PaymentCard14) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard14) AddProtectedCardData() *ContentInformationType10 {
 	p.ProtectedCardData = new(ContentInformationType10)
 	return p.ProtectedCardData
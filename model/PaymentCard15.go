@@ -19,6 +19,10 @@ type PaymentCard15 struct {
 	CardProductName *Max35Text `xml:"CardPdctNm,omitempty"`
 }
 
+func (p *PaymentCard15) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard15) AddProtectedCardData() *ContentInformationType10 {
 	p.ProtectedCardData = new(ContentInformationType10)
 	return p.ProtectedCardData
@@ -22,6 +22,10 @@ type PaymentCard16 struct {
 	CardCurrencyCode *Exact3AlphaNumericText `xml:"CardCcyCd,omitempty"`
 }
 
+func (p *PaymentCard16) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard16) SetCardDataEntryMode(value string) {
 	p.CardDataEntryMode = (*CardDataReading1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Card performing the withdrawal transaction.
 type PaymentCard17 struct {
 
@@ -25,6 +29,10 @@ type PaymentCard17 struct {
 	RetainedCardBalance *CurrencyAndAmount `xml:"RtndCardBal,omitempty"`
 }
 
+func (p *PaymentCard17) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard17) SetCardDataEntryMode(value string) {
 	p.CardDataEntryMode = (*CardDataReading1Code)(&value)
 }
@@ -54,3 +62,7 @@ func (p *PaymentCard17) SetCardCurrencyCode(value string) {
 func (p *PaymentCard17) SetRetainedCardBalance(value, currency string) {
 	p.RetainedCardBalance = NewCurrencyAndAmount(value, currency)
 }
+
+func (p *PaymentCard17) SetRetainedCardBalanceFromDecimal(value decimal.Decimal, currency string) {
+	p.RetainedCardBalance = NewCurrencyAndAmount(value.String(), currency)
+}
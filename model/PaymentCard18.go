@@ -31,6 +31,10 @@ type PaymentCard18 struct {
 	SequenceNumber *Max3Text `xml:"SeqNb,omitempty"`
 }
 
+func (p *PaymentCard18) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard18) SetType(value string) {
 	p.Type = (*CardType1Code)(&value)
 }
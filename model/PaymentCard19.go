@@ -22,6 +22,10 @@ type PaymentCard19 struct {
 	CardProductType *CardProductType1Code `xml:"CardPdctTp,omitempty"`
 }
 
+func (p *PaymentCard19) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard19) AddProtectedCardData() *ContentInformationType10 {
 	p.ProtectedCardData = new(ContentInformationType10)
 	return p.ProtectedCardData
@@ -31,6 +31,10 @@ type PaymentCard2 struct {
 	SequenceNumber *Max3Text `xml:"SeqNb,omitempty"`
 }
 
+func (p *PaymentCard2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard2) SetType(value string) {
 	p.Type = (*CardType1Code)(&value)
 }
@@ -37,6 +37,10 @@ type PaymentCard20 struct {
 	AdditionalCardData *Max70Text `xml:"AddtlCardData,omitempty"`
 }
 
+func (p *PaymentCard20) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard20) AddProtectedCardData() *ContentInformationType10 {
 	p.ProtectedCardData = new(ContentInformationType10)
 	return p.ProtectedCardData
@@ -40,6 +40,10 @@ type PaymentCard21 struct {
 	AdditionalCardData *Max70Text `xml:"AddtlCardData,omitempty"`
 }
 
+func (p *PaymentCard21) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard21) AddProtectedCardData() *ContentInformationType10 {
 	p.ProtectedCardData = new(ContentInformationType10)
 	return p.ProtectedCardData
@@ -78,6 +82,11 @@ func (p *PaymentCard21) SetInternationalCard(value string) {
 	p.InternationalCard = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentCard21) SetInternationalCardFromBool(value bool) {
+	p.InternationalCard = new(TrueFalseIndicator)
+	p.InternationalCard.FromBool(value)
+}
+
 func (p *PaymentCard21) AddAllowedProduct(value string) {
 	p.AllowedProduct = append(p.AllowedProduct, (*Max70Text)(&value))
 }
@@ -22,6 +22,10 @@ type PaymentCard22 struct {
 	CardCurrencyCode *Exact3AlphaNumericText `xml:"CardCcyCd,omitempty"`
 }
 
+func (p *PaymentCard22) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard22) SetCardDataEntryMode(value string) {
 	p.CardDataEntryMode = (*CardDataReading1Code)(&value)
 }
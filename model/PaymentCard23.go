@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Card performing the withdrawal transaction.
 type PaymentCard23 struct {
 
@@ -25,6 +29,10 @@ type PaymentCard23 struct {
 	ElectronicPurseBalance *CurrencyAndAmount `xml:"ElctrncPrsBal,omitempty"`
 }
 
+func (p *PaymentCard23) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard23) SetCardDataEntryMode(value string) {
 	p.CardDataEntryMode = (*CardDataReading1Code)(&value)
 }
@@ -54,3 +62,7 @@ func (p *PaymentCard23) SetCardCurrencyCode(value string) {
 func (p *PaymentCard23) SetElectronicPurseBalance(value, currency string) {
 	p.ElectronicPurseBalance = NewCurrencyAndAmount(value, currency)
 }
+
+func (p *PaymentCard23) SetElectronicPurseBalanceFromDecimal(value decimal.Decimal, currency string) {
+	p.ElectronicPurseBalance = NewCurrencyAndAmount(value.String(), currency)
+}
@@ -31,6 +31,10 @@ type PaymentCard25 struct {
 	SequenceNumber *Max3Text `xml:"SeqNb,omitempty"`
 }
 
+func (p *PaymentCard25) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard25) SetType(value string) {
 	p.Type = (*CardType1Code)(&value)
 }
@@ -22,6 +22,10 @@ type PaymentCard3 struct {
 	AdditionalCardData *Max70Text `xml:"AddtlCardData,omitempty"`
 }
 
+func (p *PaymentCard3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard3) AddProtectedCardData() *ContentInformationType2 {
 	p.ProtectedCardData = new(ContentInformationType2)
 	return p.ProtectedCardData
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Electronic money product that provides the cardholder with a portable and specialised computer device, which typically contains a microprocessor.
 type PaymentCard4 struct {
 
@@ -16,6 +18,10 @@ type PaymentCard4 struct {
 	AdditionalCardData *Max70Text `xml:"AddtlCardData,omitempty"`
 }
 
+func (p *PaymentCard4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard4) AddPlainCardData() *PlainCardData1 {
 	p.PlainCardData = new(PlainCardData1)
 	return p.PlainCardData
@@ -33,3 +39,62 @@ func (p *PaymentCard4) AddCardBrand() *GenericIdentification1 {
 func (p *PaymentCard4) SetAdditionalCardData(value string) {
 	p.AdditionalCardData = (*Max70Text)(&value)
 }
+
+func (p *PaymentCard4) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.PlainCardData != nil {
+		p.PlainCardData.EncodeXMLFast(enc, "PlainCardData")
+	}
+	if p.CardCountryCode != nil {
+		enc.Element("CardCtryCd", string(*p.CardCountryCode))
+	}
+	if p.CardBrand != nil {
+		p.CardBrand.EncodeXMLFast(enc, "CardBrnd")
+	}
+	if p.AdditionalCardData != nil {
+		enc.Element("AddtlCardData", string(*p.AdditionalCardData))
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentCard4) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "PlainCardData":
+			elem := new(PlainCardData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.PlainCardData = elem
+		case "CardCtryCd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.CardCountryCode = (*Exact3NumericText)(&value)
+		case "CardBrnd":
+			elem := new(GenericIdentification1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.CardBrand = elem
+		case "AddtlCardData":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.AdditionalCardData = (*Max70Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
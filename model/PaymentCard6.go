@@ -22,6 +22,10 @@ type PaymentCard6 struct {
 	AdditionalCardData *Max70Text `xml:"AddtlCardData,omitempty"`
 }
 
+func (p *PaymentCard6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard6) AddProtectedCardData() *ContentInformationType5 {
 	p.ProtectedCardData = new(ContentInformationType5)
 	return p.ProtectedCardData
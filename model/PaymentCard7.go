@@ -25,6 +25,10 @@ type PaymentCard7 struct {
 	AdditionalCardData *Max70Text `xml:"AddtlCardData,omitempty"`
 }
 
+func (p *PaymentCard7) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard7) AddProtectedCardData() *ContentInformationType7 {
 	p.ProtectedCardData = new(ContentInformationType7)
 	return p.ProtectedCardData
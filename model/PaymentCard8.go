@@ -25,6 +25,10 @@ type PaymentCard8 struct {
 	AdditionalCardData *Max70Text `xml:"AddtlCardData,omitempty"`
 }
 
+func (p *PaymentCard8) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard8) AddProtectedCardData() *ContentInformationType7 {
 	p.ProtectedCardData = new(ContentInformationType7)
 	return p.ProtectedCardData
@@ -28,6 +28,10 @@ type PaymentCard9 struct {
 	AdditionalCardData *Max70Text `xml:"AddtlCardData,omitempty"`
 }
 
+func (p *PaymentCard9) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCard9) AddProtectedCardData() *ContentInformationType10 {
 	p.ProtectedCardData = new(ContentInformationType10)
 	return p.ProtectedCardData
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice between a payment term in a coded or free format.
 type PaymentCodeOrOther1Choice struct {
 
@@ -13,6 +17,10 @@ type PaymentCodeOrOther1Choice struct {
 	OtherPaymentTerms *Max140Text `xml:"OthrPmtTerms"`
 }
 
+func (p *PaymentCodeOrOther1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCodeOrOther1Choice) AddPaymentCode() *PaymentPeriod3 {
 	p.PaymentCode = new(PaymentPeriod3)
 	return p.PaymentCode
@@ -22,6 +30,11 @@ func (p *PaymentCodeOrOther1Choice) SetPaymentDueDate(value string) {
 	p.PaymentDueDate = (*ISODate)(&value)
 }
 
+func (p *PaymentCodeOrOther1Choice) SetPaymentDueDateFromTime(value time.Time) {
+	p.PaymentDueDate = new(ISODate)
+	p.PaymentDueDate.FromTime(value)
+}
+
 func (p *PaymentCodeOrOther1Choice) SetOtherPaymentTerms(value string) {
 	p.OtherPaymentTerms = (*Max140Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice between a payment term in a coded or free format.
 type PaymentCodeOrOther2Choice struct {
 
@@ -13,6 +17,10 @@ type PaymentCodeOrOther2Choice struct {
 	OtherPaymentTerms *Max140Text `xml:"OthrPmtTerms"`
 }
 
+func (p *PaymentCodeOrOther2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentCodeOrOther2Choice) AddPaymentCode() *PaymentPeriod4 {
 	p.PaymentCode = new(PaymentPeriod4)
 	return p.PaymentCode
@@ -22,6 +30,11 @@ func (p *PaymentCodeOrOther2Choice) SetPaymentDueDate(value string) {
 	p.PaymentDueDate = (*ISODate)(&value)
 }
 
+func (p *PaymentCodeOrOther2Choice) SetPaymentDueDateFromTime(value time.Time) {
+	p.PaymentDueDate = new(ISODate)
+	p.PaymentDueDate.FromTime(value)
+}
+
 func (p *PaymentCodeOrOther2Choice) SetOtherPaymentTerms(value string) {
 	p.OtherPaymentTerms = (*Max140Text)(&value)
 }
@@ -43,6 +43,10 @@ type PaymentComplementaryInformation struct {
 	SenderToReceiverInformation []*Max35Text `xml:"SndrToRcvrInf,omitempty"`
 }
 
+func (p *PaymentComplementaryInformation) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentComplementaryInformation) AddRemittanceChoice() *RemittanceInformation3Choice {
 	p.RemittanceChoice = new(RemittanceInformation3Choice)
 	return p.RemittanceChoice
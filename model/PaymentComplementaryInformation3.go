@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further additional details on the underlying payment instruction that cannot be transferred in a regular statement message.
 type PaymentComplementaryInformation3 struct {
 
@@ -125,6 +131,10 @@ type PaymentComplementaryInformation3 struct {
 	RemittanceInformation *RemittanceInformation7 `xml:"RmtInf,omitempty"`
 }
 
+func (p *PaymentComplementaryInformation3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentComplementaryInformation3) SetInstructionIdentification(value string) {
 	p.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -146,14 +156,29 @@ func (p *PaymentComplementaryInformation3) SetRequestedExecutionDate(value strin
 	p.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentComplementaryInformation3) SetRequestedExecutionDateFromTime(value time.Time) {
+	p.RequestedExecutionDate = new(ISODate)
+	p.RequestedExecutionDate.FromTime(value)
+}
+
 func (p *PaymentComplementaryInformation3) SetRequestedCollectionDate(value string) {
 	p.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentComplementaryInformation3) SetRequestedCollectionDateFromTime(value time.Time) {
+	p.RequestedCollectionDate = new(ISODate)
+	p.RequestedCollectionDate.FromTime(value)
+}
+
 func (p *PaymentComplementaryInformation3) SetInterbankSettlementDate(value string) {
 	p.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentComplementaryInformation3) SetInterbankSettlementDateFromTime(value time.Time) {
+	p.InterbankSettlementDate = new(ISODate)
+	p.InterbankSettlementDate.FromTime(value)
+}
+
 func (p *PaymentComplementaryInformation3) AddAmount() *AmountType3Choice {
 	p.Amount = new(AmountType3Choice)
 	return p.Amount
@@ -163,8 +188,16 @@ func (p *PaymentComplementaryInformation3) SetInterbankSettlementAmount(value, c
 	p.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (p *PaymentComplementaryInformation3) SetChargeBearer(value string) {
+func (p *PaymentComplementaryInformation3) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (p *PaymentComplementaryInformation3) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentComplementaryInformation3) AddUltimateDebtor() *PartyIdentification43 {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further additional details on the underlying payment instruction that cannot be transferred in a regular statement message.
 type PaymentComplementaryInformation4 struct {
 
@@ -125,6 +131,10 @@ type PaymentComplementaryInformation4 struct {
 	RemittanceInformation *RemittanceInformation10 `xml:"RmtInf,omitempty"`
 }
 
+func (p *PaymentComplementaryInformation4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentComplementaryInformation4) SetInstructionIdentification(value string) {
 	p.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -146,14 +156,29 @@ func (p *PaymentComplementaryInformation4) SetRequestedExecutionDate(value strin
 	p.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentComplementaryInformation4) SetRequestedExecutionDateFromTime(value time.Time) {
+	p.RequestedExecutionDate = new(ISODate)
+	p.RequestedExecutionDate.FromTime(value)
+}
+
 func (p *PaymentComplementaryInformation4) SetRequestedCollectionDate(value string) {
 	p.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentComplementaryInformation4) SetRequestedCollectionDateFromTime(value time.Time) {
+	p.RequestedCollectionDate = new(ISODate)
+	p.RequestedCollectionDate.FromTime(value)
+}
+
 func (p *PaymentComplementaryInformation4) SetInterbankSettlementDate(value string) {
 	p.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentComplementaryInformation4) SetInterbankSettlementDateFromTime(value time.Time) {
+	p.InterbankSettlementDate = new(ISODate)
+	p.InterbankSettlementDate.FromTime(value)
+}
+
 func (p *PaymentComplementaryInformation4) AddAmount() *AmountType4Choice {
 	p.Amount = new(AmountType4Choice)
 	return p.Amount
@@ -163,8 +188,16 @@ func (p *PaymentComplementaryInformation4) SetInterbankSettlementAmount(value, c
 	p.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (p *PaymentComplementaryInformation4) SetChargeBearer(value string) {
+func (p *PaymentComplementaryInformation4) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (p *PaymentComplementaryInformation4) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentComplementaryInformation4) AddUltimateDebtor() *PartyIdentification43 {
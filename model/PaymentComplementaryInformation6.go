@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further additional details on the underlying payment instruction that cannot be transferred in a regular statement message.
 type PaymentComplementaryInformation6 struct {
 
@@ -125,6 +131,10 @@ type PaymentComplementaryInformation6 struct {
 	RemittanceInformation *RemittanceInformation11 `xml:"RmtInf,omitempty"`
 }
 
+func (p *PaymentComplementaryInformation6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentComplementaryInformation6) SetInstructionIdentification(value string) {
 	p.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -151,10 +161,20 @@ func (p *PaymentComplementaryInformation6) SetRequestedCollectionDate(value stri
 	p.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentComplementaryInformation6) SetRequestedCollectionDateFromTime(value time.Time) {
+	p.RequestedCollectionDate = new(ISODate)
+	p.RequestedCollectionDate.FromTime(value)
+}
+
 func (p *PaymentComplementaryInformation6) SetInterbankSettlementDate(value string) {
 	p.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentComplementaryInformation6) SetInterbankSettlementDateFromTime(value time.Time) {
+	p.InterbankSettlementDate = new(ISODate)
+	p.InterbankSettlementDate.FromTime(value)
+}
+
 func (p *PaymentComplementaryInformation6) AddAmount() *AmountType4Choice {
 	p.Amount = new(AmountType4Choice)
 	return p.Amount
@@ -164,8 +184,16 @@ func (p *PaymentComplementaryInformation6) SetInterbankSettlementAmount(value, c
 	p.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (p *PaymentComplementaryInformation6) SetChargeBearer(value string) {
+func (p *PaymentComplementaryInformation6) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (p *PaymentComplementaryInformation6) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentComplementaryInformation6) AddUltimateDebtor() *PartyIdentification43 {
@@ -34,18 +34,37 @@ type PaymentContext1 struct {
 	FallbackIndicator *TrueFalseIndicator `xml:"FllbckInd,omitempty"`
 }
 
+func (p *PaymentContext1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentContext1) SetCardPresent(value string) {
 	p.CardPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext1) SetCardPresentFromBool(value bool) {
+	p.CardPresent = new(TrueFalseIndicator)
+	p.CardPresent.FromBool(value)
+}
+
 func (p *PaymentContext1) SetCardholderPresent(value string) {
 	p.CardholderPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext1) SetCardholderPresentFromBool(value bool) {
+	p.CardholderPresent = new(TrueFalseIndicator)
+	p.CardholderPresent.FromBool(value)
+}
+
 func (p *PaymentContext1) SetOnLineContext(value string) {
 	p.OnLineContext = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext1) SetOnLineContextFromBool(value bool) {
+	p.OnLineContext = new(TrueFalseIndicator)
+	p.OnLineContext.FromBool(value)
+}
+
 func (p *PaymentContext1) SetAttendanceContext(value string) {
 	p.AttendanceContext = (*AttendanceContext1Code)(&value)
 }
@@ -54,14 +73,23 @@ func (p *PaymentContext1) SetTransactionEnvironment(value string) {
 	p.TransactionEnvironment = (*TransactionEnvironment1Code)(&value)
 }
 
-func (p *PaymentContext1) SetTransactionChannel(value string) {
+func (p *PaymentContext1) SetTransactionChannel(value string) error {
+	if err := TransactionChannel1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionChannel = (*TransactionChannel1Code)(&value)
+	return nil
 }
 
 func (p *PaymentContext1) SetAttendantMessageCapable(value string) {
 	p.AttendantMessageCapable = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext1) SetAttendantMessageCapableFromBool(value bool) {
+	p.AttendantMessageCapable = new(TrueFalseIndicator)
+	p.AttendantMessageCapable.FromBool(value)
+}
+
 func (p *PaymentContext1) SetAttendantLanguage(value string) {
 	p.AttendantLanguage = (*ISO2ALanguageCode)(&value)
 }
@@ -73,3 +101,8 @@ func (p *PaymentContext1) SetCardDataEntryMode(value string) {
 func (p *PaymentContext1) SetFallbackIndicator(value string) {
 	p.FallbackIndicator = (*TrueFalseIndicator)(&value)
 }
+
+func (p *PaymentContext1) SetFallbackIndicatorFromBool(value bool) {
+	p.FallbackIndicator = new(TrueFalseIndicator)
+	p.FallbackIndicator.FromBool(value)
+}
@@ -35,18 +35,37 @@ type PaymentContext10 struct {
 	FallbackIndicator *CardFallback1Code `xml:"FllbckInd,omitempty"`
 }
 
+func (p *PaymentContext10) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentContext10) SetCardPresent(value string) {
 	p.CardPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext10) SetCardPresentFromBool(value bool) {
+	p.CardPresent = new(TrueFalseIndicator)
+	p.CardPresent.FromBool(value)
+}
+
 func (p *PaymentContext10) SetCardholderPresent(value string) {
 	p.CardholderPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext10) SetCardholderPresentFromBool(value bool) {
+	p.CardholderPresent = new(TrueFalseIndicator)
+	p.CardholderPresent.FromBool(value)
+}
+
 func (p *PaymentContext10) SetOnLineContext(value string) {
 	p.OnLineContext = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext10) SetOnLineContextFromBool(value bool) {
+	p.OnLineContext = new(TrueFalseIndicator)
+	p.OnLineContext.FromBool(value)
+}
+
 func (p *PaymentContext10) SetAttendanceContext(value string) {
 	p.AttendanceContext = (*AttendanceContext1Code)(&value)
 }
@@ -63,6 +82,11 @@ func (p *PaymentContext10) SetAttendantMessageCapable(value string) {
 	p.AttendantMessageCapable = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext10) SetAttendantMessageCapableFromBool(value bool) {
+	p.AttendantMessageCapable = new(TrueFalseIndicator)
+	p.AttendantMessageCapable.FromBool(value)
+}
+
 func (p *PaymentContext10) SetAttendantLanguage(value string) {
 	p.AttendantLanguage = (*LanguageCode)(&value)
 }
@@ -28,18 +28,37 @@ type PaymentContext11 struct {
 	FallbackIndicator *CardFallback1Code `xml:"FllbckInd,omitempty"`
 }
 
+func (p *PaymentContext11) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentContext11) SetCardPresent(value string) {
 	p.CardPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext11) SetCardPresentFromBool(value bool) {
+	p.CardPresent = new(TrueFalseIndicator)
+	p.CardPresent.FromBool(value)
+}
+
 func (p *PaymentContext11) SetCardholderPresent(value string) {
 	p.CardholderPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext11) SetCardholderPresentFromBool(value bool) {
+	p.CardholderPresent = new(TrueFalseIndicator)
+	p.CardholderPresent.FromBool(value)
+}
+
 func (p *PaymentContext11) SetOnLineContext(value string) {
 	p.OnLineContext = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext11) SetOnLineContextFromBool(value bool) {
+	p.OnLineContext = new(TrueFalseIndicator)
+	p.OnLineContext.FromBool(value)
+}
+
 func (p *PaymentContext11) SetAttendanceContext(value string) {
 	p.AttendanceContext = (*AttendanceContext1Code)(&value)
 }
@@ -38,18 +38,37 @@ type PaymentContext12 struct {
 	SupportedOption []*SupportedPaymentOption1Code `xml:"SpprtdOptn,omitempty"`
 }
 
+func (p *PaymentContext12) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentContext12) SetCardPresent(value string) {
 	p.CardPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext12) SetCardPresentFromBool(value bool) {
+	p.CardPresent = new(TrueFalseIndicator)
+	p.CardPresent.FromBool(value)
+}
+
 func (p *PaymentContext12) SetCardholderPresent(value string) {
 	p.CardholderPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext12) SetCardholderPresentFromBool(value bool) {
+	p.CardholderPresent = new(TrueFalseIndicator)
+	p.CardholderPresent.FromBool(value)
+}
+
 func (p *PaymentContext12) SetOnLineContext(value string) {
 	p.OnLineContext = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext12) SetOnLineContextFromBool(value bool) {
+	p.OnLineContext = new(TrueFalseIndicator)
+	p.OnLineContext.FromBool(value)
+}
+
 func (p *PaymentContext12) SetAttendanceContext(value string) {
 	p.AttendanceContext = (*AttendanceContext1Code)(&value)
 }
@@ -66,6 +85,11 @@ func (p *PaymentContext12) SetAttendantMessageCapable(value string) {
 	p.AttendantMessageCapable = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext12) SetAttendantMessageCapableFromBool(value bool) {
+	p.AttendantMessageCapable = new(TrueFalseIndicator)
+	p.AttendantMessageCapable.FromBool(value)
+}
+
 func (p *PaymentContext12) SetAttendantLanguage(value string) {
 	p.AttendantLanguage = (*LanguageCode)(&value)
 }
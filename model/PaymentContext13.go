@@ -35,18 +35,37 @@ type PaymentContext13 struct {
 	FallbackIndicator *CardFallback1Code `xml:"FllbckInd,omitempty"`
 }
 
+func (p *PaymentContext13) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentContext13) SetCardPresent(value string) {
 	p.CardPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext13) SetCardPresentFromBool(value bool) {
+	p.CardPresent = new(TrueFalseIndicator)
+	p.CardPresent.FromBool(value)
+}
+
 func (p *PaymentContext13) SetCardholderPresent(value string) {
 	p.CardholderPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext13) SetCardholderPresentFromBool(value bool) {
+	p.CardholderPresent = new(TrueFalseIndicator)
+	p.CardholderPresent.FromBool(value)
+}
+
 func (p *PaymentContext13) SetOnLineContext(value string) {
 	p.OnLineContext = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext13) SetOnLineContextFromBool(value bool) {
+	p.OnLineContext = new(TrueFalseIndicator)
+	p.OnLineContext.FromBool(value)
+}
+
 func (p *PaymentContext13) SetAttendanceContext(value string) {
 	p.AttendanceContext = (*AttendanceContext1Code)(&value)
 }
@@ -63,6 +82,11 @@ func (p *PaymentContext13) SetAttendantMessageCapable(value string) {
 	p.AttendantMessageCapable = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext13) SetAttendantMessageCapableFromBool(value bool) {
+	p.AttendantMessageCapable = new(TrueFalseIndicator)
+	p.AttendantMessageCapable.FromBool(value)
+}
+
 func (p *PaymentContext13) SetAttendantLanguage(value string) {
 	p.AttendantLanguage = (*LanguageCode)(&value)
 }
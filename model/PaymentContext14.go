@@ -35,14 +35,28 @@ type PaymentContext14 struct {
 	SupportedOption []*SupportedPaymentOption1Code `xml:"SpprtdOptn,omitempty"`
 }
 
+func (p *PaymentContext14) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentContext14) SetCardPresent(value string) {
 	p.CardPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext14) SetCardPresentFromBool(value bool) {
+	p.CardPresent = new(TrueFalseIndicator)
+	p.CardPresent.FromBool(value)
+}
+
 func (p *PaymentContext14) SetCardholderPresent(value string) {
 	p.CardholderPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext14) SetCardholderPresentFromBool(value bool) {
+	p.CardholderPresent = new(TrueFalseIndicator)
+	p.CardholderPresent.FromBool(value)
+}
+
 func (p *PaymentContext14) SetAttendanceContext(value string) {
 	p.AttendanceContext = (*AttendanceContext1Code)(&value)
 }
@@ -59,6 +73,11 @@ func (p *PaymentContext14) SetAttendantMessageCapable(value string) {
 	p.AttendantMessageCapable = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext14) SetAttendantMessageCapableFromBool(value bool) {
+	p.AttendantMessageCapable = new(TrueFalseIndicator)
+	p.AttendantMessageCapable.FromBool(value)
+}
+
 func (p *PaymentContext14) SetAttendantLanguage(value string) {
 	p.AttendantLanguage = (*LanguageCode)(&value)
 }
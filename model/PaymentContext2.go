@@ -28,18 +28,37 @@ type PaymentContext2 struct {
 	FallbackIndicator *TrueFalseIndicator `xml:"FllbckInd,omitempty"`
 }
 
+func (p *PaymentContext2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentContext2) SetCardPresent(value string) {
 	p.CardPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext2) SetCardPresentFromBool(value bool) {
+	p.CardPresent = new(TrueFalseIndicator)
+	p.CardPresent.FromBool(value)
+}
+
 func (p *PaymentContext2) SetCardholderPresent(value string) {
 	p.CardholderPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext2) SetCardholderPresentFromBool(value bool) {
+	p.CardholderPresent = new(TrueFalseIndicator)
+	p.CardholderPresent.FromBool(value)
+}
+
 func (p *PaymentContext2) SetOnLineContext(value string) {
 	p.OnLineContext = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext2) SetOnLineContextFromBool(value bool) {
+	p.OnLineContext = new(TrueFalseIndicator)
+	p.OnLineContext.FromBool(value)
+}
+
 func (p *PaymentContext2) SetAttendanceContext(value string) {
 	p.AttendanceContext = (*AttendanceContext1Code)(&value)
 }
@@ -48,8 +67,12 @@ func (p *PaymentContext2) SetTransactionEnvironment(value string) {
 	p.TransactionEnvironment = (*TransactionEnvironment1Code)(&value)
 }
 
-func (p *PaymentContext2) SetTransactionChannel(value string) {
+func (p *PaymentContext2) SetTransactionChannel(value string) error {
+	if err := TransactionChannel1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionChannel = (*TransactionChannel1Code)(&value)
+	return nil
 }
 
 func (p *PaymentContext2) SetCardDataEntryMode(value string) {
@@ -59,3 +82,8 @@ func (p *PaymentContext2) SetCardDataEntryMode(value string) {
 func (p *PaymentContext2) SetFallbackIndicator(value string) {
 	p.FallbackIndicator = (*TrueFalseIndicator)(&value)
 }
+
+func (p *PaymentContext2) SetFallbackIndicatorFromBool(value bool) {
+	p.FallbackIndicator = new(TrueFalseIndicator)
+	p.FallbackIndicator.FromBool(value)
+}
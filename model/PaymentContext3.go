@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Payment context in which the transaction is performed.
 type PaymentContext3 struct {
 
@@ -37,18 +39,37 @@ type PaymentContext3 struct {
 	AuthenticationMethod *CardholderAuthentication2 `xml:"AuthntcnMtd,omitempty"`
 }
 
+func (p *PaymentContext3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentContext3) SetCardPresent(value string) {
 	p.CardPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext3) SetCardPresentFromBool(value bool) {
+	p.CardPresent = new(TrueFalseIndicator)
+	p.CardPresent.FromBool(value)
+}
+
 func (p *PaymentContext3) SetCardholderPresent(value string) {
 	p.CardholderPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext3) SetCardholderPresentFromBool(value bool) {
+	p.CardholderPresent = new(TrueFalseIndicator)
+	p.CardholderPresent.FromBool(value)
+}
+
 func (p *PaymentContext3) SetOnLineContext(value string) {
 	p.OnLineContext = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext3) SetOnLineContextFromBool(value bool) {
+	p.OnLineContext = new(TrueFalseIndicator)
+	p.OnLineContext.FromBool(value)
+}
+
 func (p *PaymentContext3) SetAttendanceContext(value string) {
 	p.AttendanceContext = (*AttendanceContext1Code)(&value)
 }
@@ -57,14 +78,23 @@ func (p *PaymentContext3) SetTransactionEnvironment(value string) {
 	p.TransactionEnvironment = (*TransactionEnvironment1Code)(&value)
 }
 
-func (p *PaymentContext3) SetTransactionChannel(value string) {
+func (p *PaymentContext3) SetTransactionChannel(value string) error {
+	if err := TransactionChannel1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionChannel = (*TransactionChannel1Code)(&value)
+	return nil
 }
 
 func (p *PaymentContext3) SetAttendantMessageCapable(value string) {
 	p.AttendantMessageCapable = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext3) SetAttendantMessageCapableFromBool(value bool) {
+	p.AttendantMessageCapable = new(TrueFalseIndicator)
+	p.AttendantMessageCapable.FromBool(value)
+}
+
 func (p *PaymentContext3) SetAttendantLanguage(value string) {
 	p.AttendantLanguage = (*ISO2ALanguageCode)(&value)
 }
@@ -77,7 +107,134 @@ func (p *PaymentContext3) SetFallbackIndicator(value string) {
 	p.FallbackIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext3) SetFallbackIndicatorFromBool(value bool) {
+	p.FallbackIndicator = new(TrueFalseIndicator)
+	p.FallbackIndicator.FromBool(value)
+}
+
 func (p *PaymentContext3) AddAuthenticationMethod() *CardholderAuthentication2 {
 	p.AuthenticationMethod = new(CardholderAuthentication2)
 	return p.AuthenticationMethod
 }
+
+func (p *PaymentContext3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.CardPresent != nil {
+		enc.Element("CardPres", string(*p.CardPresent))
+	}
+	if p.CardholderPresent != nil {
+		enc.Element("CrdhldrPres", string(*p.CardholderPresent))
+	}
+	if p.OnLineContext != nil {
+		enc.Element("OnLineCntxt", string(*p.OnLineContext))
+	}
+	if p.AttendanceContext != nil {
+		enc.Element("AttndncCntxt", string(*p.AttendanceContext))
+	}
+	if p.TransactionEnvironment != nil {
+		enc.Element("TxEnvt", string(*p.TransactionEnvironment))
+	}
+	if p.TransactionChannel != nil {
+		enc.Element("TxChanl", string(*p.TransactionChannel))
+	}
+	if p.AttendantMessageCapable != nil {
+		enc.Element("AttndntMsgCpbl", string(*p.AttendantMessageCapable))
+	}
+	if p.AttendantLanguage != nil {
+		enc.Element("AttndntLang", string(*p.AttendantLanguage))
+	}
+	if p.CardDataEntryMode != nil {
+		enc.Element("CardDataNtryMd", string(*p.CardDataEntryMode))
+	}
+	if p.FallbackIndicator != nil {
+		enc.Element("FllbckInd", string(*p.FallbackIndicator))
+	}
+	if p.AuthenticationMethod != nil {
+		p.AuthenticationMethod.EncodeXMLFast(enc, "AuthntcnMtd")
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentContext3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "CardPres":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.CardPresent = (*TrueFalseIndicator)(&value)
+		case "CrdhldrPres":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.CardholderPresent = (*TrueFalseIndicator)(&value)
+		case "OnLineCntxt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.OnLineContext = (*TrueFalseIndicator)(&value)
+		case "AttndncCntxt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.AttendanceContext = (*AttendanceContext1Code)(&value)
+		case "TxEnvt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.TransactionEnvironment = (*TransactionEnvironment1Code)(&value)
+		case "TxChanl":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.TransactionChannel = (*TransactionChannel1Code)(&value)
+		case "AttndntMsgCpbl":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.AttendantMessageCapable = (*TrueFalseIndicator)(&value)
+		case "AttndntLang":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.AttendantLanguage = (*ISO2ALanguageCode)(&value)
+		case "CardDataNtryMd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.CardDataEntryMode = (*CardDataReading1Code)(&value)
+		case "FllbckInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.FallbackIndicator = (*TrueFalseIndicator)(&value)
+		case "AuthntcnMtd":
+			elem := new(CardholderAuthentication2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.AuthenticationMethod = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
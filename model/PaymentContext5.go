@@ -34,14 +34,28 @@ type PaymentContext5 struct {
 	SupportedOption []*SupportedPaymentOption1Code `xml:"SpprtdOptn,omitempty"`
 }
 
+func (p *PaymentContext5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentContext5) SetCardPresent(value string) {
 	p.CardPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext5) SetCardPresentFromBool(value bool) {
+	p.CardPresent = new(TrueFalseIndicator)
+	p.CardPresent.FromBool(value)
+}
+
 func (p *PaymentContext5) SetCardholderPresent(value string) {
 	p.CardholderPresent = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext5) SetCardholderPresentFromBool(value bool) {
+	p.CardholderPresent = new(TrueFalseIndicator)
+	p.CardholderPresent.FromBool(value)
+}
+
 func (p *PaymentContext5) SetAttendanceContext(value string) {
 	p.AttendanceContext = (*AttendanceContext1Code)(&value)
 }
@@ -50,14 +64,23 @@ func (p *PaymentContext5) SetTransactionEnvironment(value string) {
 	p.TransactionEnvironment = (*TransactionEnvironment1Code)(&value)
 }
 
-func (p *PaymentContext5) SetTransactionChannel(value string) {
+func (p *PaymentContext5) SetTransactionChannel(value string) error {
+	if err := TransactionChannel1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionChannel = (*TransactionChannel1Code)(&value)
+	return nil
 }
 
 func (p *PaymentContext5) SetAttendantMessageCapable(value string) {
 	p.AttendantMessageCapable = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext5) SetAttendantMessageCapableFromBool(value bool) {
+	p.AttendantMessageCapable = new(TrueFalseIndicator)
+	p.AttendantMessageCapable.FromBool(value)
+}
+
 func (p *PaymentContext5) SetAttendantLanguage(value string) {
 	p.AttendantLanguage = (*ISO2ALanguageCode)(&value)
 }
@@ -70,6 +93,11 @@ func (p *PaymentContext5) SetFallbackIndicator(value string) {
 	p.FallbackIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PaymentContext5) SetFallbackIndicatorFromBool(value bool) {
+	p.FallbackIndicator = new(TrueFalseIndicator)
+	p.FallbackIndicator.FromBool(value)
+}
+
 func (p *PaymentContext5) AddSupportedOption(value string) {
 	p.SupportedOption = append(p.SupportedOption, (*SupportedPaymentOption1Code)(&value))
 }
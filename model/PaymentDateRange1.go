@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies expected and due payment date.
 type PaymentDateRange1 struct {
 
@@ -13,6 +17,10 @@ type PaymentDateRange1 struct {
 	DueDate *ISODate `xml:"DueDt,omitempty"`
 }
 
+func (p *PaymentDateRange1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentDateRange1) SetPaymentScheduleIdentification(value string) {
 	p.PaymentScheduleIdentification = (*Max35Text)(&value)
 }
@@ -21,6 +29,16 @@ func (p *PaymentDateRange1) SetExpectedDate(value string) {
 	p.ExpectedDate = (*ISODate)(&value)
 }
 
+func (p *PaymentDateRange1) SetExpectedDateFromTime(value time.Time) {
+	p.ExpectedDate = new(ISODate)
+	p.ExpectedDate.FromTime(value)
+}
+
 func (p *PaymentDateRange1) SetDueDate(value string) {
 	p.DueDate = (*ISODate)(&value)
 }
+
+func (p *PaymentDateRange1) SetDueDateFromTime(value time.Time) {
+	p.DueDate = new(ISODate)
+	p.DueDate.FromTime(value)
+}
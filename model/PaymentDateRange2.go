@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies an payment schedule, that is an amount that must be paid no sooner than the expected payment date and no later than the due date.
 type PaymentDateRange2 struct {
 
@@ -16,6 +22,10 @@ type PaymentDateRange2 struct {
 	DueDate *ISODate `xml:"DueDt"`
 }
 
+func (p *PaymentDateRange2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentDateRange2) SetPaymentScheduleIdentification(value string) {
 	p.PaymentScheduleIdentification = (*Max35Text)(&value)
 }
@@ -24,10 +34,24 @@ func (p *PaymentDateRange2) SetAmount(value, currency string) {
 	p.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentDateRange2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentDateRange2) SetExpectedDate(value string) {
 	p.ExpectedDate = (*ISODate)(&value)
 }
 
+func (p *PaymentDateRange2) SetExpectedDateFromTime(value time.Time) {
+	p.ExpectedDate = new(ISODate)
+	p.ExpectedDate.FromTime(value)
+}
+
 func (p *PaymentDateRange2) SetDueDate(value string) {
 	p.DueDate = (*ISODate)(&value)
 }
+
+func (p *PaymentDateRange2) SetDueDateFromTime(value time.Time) {
+	p.DueDate = new(ISODate)
+	p.DueDate.FromTime(value)
+}
@@ -10,10 +10,19 @@ type PaymentDirection2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PaymentDirection2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentDirection2Choice) SetIndicator(value string) {
 	p.Indicator = (*PaymentDirectionIndicator)(&value)
 }
 
+func (p *PaymentDirection2Choice) SetIndicatorFromBool(value bool) {
+	p.Indicator = new(PaymentDirectionIndicator)
+	p.Indicator.FromBool(value)
+}
+
 func (p *PaymentDirection2Choice) AddProprietary() *GenericIdentification20 {
 	p.Proprietary = new(GenericIdentification20)
 	return p.Proprietary
@@ -1,3 +1,15 @@
 package model
 
 type PaymentDirectionIndicator string
+
+func (p PaymentDirectionIndicator) Validate() error {
+	return validateBoolean("PaymentDirectionIndicator", string(p))
+}
+
+func (p PaymentDirectionIndicator) Bool() bool {
+	return parseBoolean(string(p))
+}
+
+func (p *PaymentDirectionIndicator) FromBool(value bool) {
+	*p = PaymentDirectionIndicator(formatBoolean(value))
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to provide further means of referencing a payment transaction.
 type PaymentIdentification1 struct {
 
@@ -14,6 +16,10 @@ type PaymentIdentification1 struct {
 	EndToEndIdentification *Max35Text `xml:"EndToEndId"`
 }
 
+func (p *PaymentIdentification1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentIdentification1) SetInstructionIdentification(value string) {
 	p.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -21,3 +27,44 @@ func (p *PaymentIdentification1) SetInstructionIdentification(value string) {
 func (p *PaymentIdentification1) SetEndToEndIdentification(value string) {
 	p.EndToEndIdentification = (*Max35Text)(&value)
 }
+
+func (p *PaymentIdentification1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.InstructionIdentification != nil {
+		enc.Element("InstrId", string(*p.InstructionIdentification))
+	}
+	if p.EndToEndIdentification != nil {
+		enc.Element("EndToEndId", string(*p.EndToEndIdentification))
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentIdentification1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "InstrId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.InstructionIdentification = (*Max35Text)(&value)
+		case "EndToEndId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.EndToEndIdentification = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -22,6 +22,10 @@ type PaymentIdentification2 struct {
 	TransactionIdentification *Max35Text `xml:"TxId"`
 }
 
+func (p *PaymentIdentification2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentIdentification2) SetInstructionIdentification(value string) {
 	p.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to provide further means of referencing a payment transaction.
 type PaymentIdentification3 struct {
 
@@ -24,6 +26,10 @@ type PaymentIdentification3 struct {
 	ClearingSystemReference *Max35Text `xml:"ClrSysRef,omitempty"`
 }
 
+func (p *PaymentIdentification3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentIdentification3) SetInstructionIdentification(value string) {
 	p.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -39,3 +45,62 @@ func (p *PaymentIdentification3) SetTransactionIdentification(value string) {
 func (p *PaymentIdentification3) SetClearingSystemReference(value string) {
 	p.ClearingSystemReference = (*Max35Text)(&value)
 }
+
+func (p *PaymentIdentification3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.InstructionIdentification != nil {
+		enc.Element("InstrId", string(*p.InstructionIdentification))
+	}
+	if p.EndToEndIdentification != nil {
+		enc.Element("EndToEndId", string(*p.EndToEndIdentification))
+	}
+	if p.TransactionIdentification != nil {
+		enc.Element("TxId", string(*p.TransactionIdentification))
+	}
+	if p.ClearingSystemReference != nil {
+		enc.Element("ClrSysRef", string(*p.ClearingSystemReference))
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentIdentification3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "InstrId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.InstructionIdentification = (*Max35Text)(&value)
+		case "EndToEndId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.EndToEndIdentification = (*Max35Text)(&value)
+		case "TxId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.TransactionIdentification = (*Max35Text)(&value)
+		case "ClrSysRef":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ClearingSystemReference = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
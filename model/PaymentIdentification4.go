@@ -27,6 +27,10 @@ type PaymentIdentification4 struct {
 	FirstAgent *BranchAndFinancialInstitutionIdentification5 `xml:"FrstAgt,omitempty"`
 }
 
+func (p *PaymentIdentification4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentIdentification4) SetInstructionIdentification(value string) {
 	p.InstructionIdentification = (*Max35Text)(&value)
 }
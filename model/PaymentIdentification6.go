@@ -0,0 +1,86 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Set of elements used to provide further means of referencing a payment transaction.
+type PaymentIdentification6 struct {
+
+	// Unique identification as assigned by an instructing party for an instructed party to unambiguously identify the instruction.
+	//
+	// Usage: the  instruction identification is a point to point reference that can be used between the instructing party and the instructed party to refer to the individual instruction. It can be included in several messages related to the instruction.
+	InstructionIdentification *Max35Text `xml:"InstrId,omitempty"`
+
+	// Unique identification assigned by the initiating party to unambiguously identify the transaction. This identification is passed on, unchanged, throughout the entire end-to-end chain.
+	//
+	// Usage: The end-to-end identification can be used for reconciliation or to link tasks relating to the transaction. It can be included in several messages related to the transaction.
+	EndToEndIdentification *Max35Text `xml:"EndToEndId"`
+
+	// Universally unique identifier to provide an end-to-end reference of a payment transaction.
+	UETR *UUIDv4Identifier `xml:"UETR,omitempty"`
+}
+
+func (p *PaymentIdentification6) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentIdentification6) SetInstructionIdentification(value string) {
+	p.InstructionIdentification = (*Max35Text)(&value)
+}
+
+func (p *PaymentIdentification6) SetEndToEndIdentification(value string) {
+	p.EndToEndIdentification = (*Max35Text)(&value)
+}
+
+func (p *PaymentIdentification6) SetUETR(value string) {
+	p.UETR = (*UUIDv4Identifier)(&value)
+}
+
+func (p *PaymentIdentification6) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.InstructionIdentification != nil {
+		enc.Element("InstrId", string(*p.InstructionIdentification))
+	}
+	if p.EndToEndIdentification != nil {
+		enc.Element("EndToEndId", string(*p.EndToEndIdentification))
+	}
+	if p.UETR != nil {
+		enc.Element("UETR", string(*p.UETR))
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentIdentification6) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "InstrId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.InstructionIdentification = (*Max35Text)(&value)
+		case "EndToEndId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.EndToEndIdentification = (*Max35Text)(&value)
+		case "UETR":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.UETR = (*UUIDv4Identifier)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
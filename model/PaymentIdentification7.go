@@ -0,0 +1,122 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Set of elements used to provide further means of referencing a payment transaction.
+type PaymentIdentification7 struct {
+
+	// Unique identification, as assigned by an instructing party for an instructed party, to unambiguously identify the instruction.
+	//
+	// Usage: The instruction identification is a point to point reference that can be used between the instructing party and the instructed party to refer to the individual instruction. It can be included in several messages related to the instruction.
+	InstructionIdentification *Max35Text `xml:"InstrId,omitempty"`
+
+	// Unique identification, as assigned by the initiating party, to unambiguously identify the transaction. This identification is passed on, unchanged, throughout the entire end-to-end chain.
+	//
+	// Usage: The end-to-end identification can be used for reconciliation or to link tasks relating to the transaction. It can be included in several messages related to the transaction.
+	//
+	// Usage: In case there are technical limitations to pass on multiple references, the end-to-end identification must be passed on throughout the entire end-to-end chain.
+	EndToEndIdentification *Max35Text `xml:"EndToEndId"`
+
+	// Unique identification, as assigned by the first instructing agent, to unambiguously identify the transaction that is passed on, unchanged, throughout the entire interbank chain.
+	// Usage: The transaction identification can be used for reconciliation, tracking or to link tasks relating to the transaction on the interbank level.
+	// Usage: The instructing agent has to make sure that the transaction identification is unique for a pre-agreed period.
+	TransactionIdentification *Max35Text `xml:"TxId,omitempty"`
+
+	// Universally unique identifier to provide an end-to-end reference of a payment transaction.
+	UETR *UUIDv4Identifier `xml:"UETR,omitempty"`
+
+	// Unique reference, as assigned by a clearing system, to unambiguously identify the instruction.
+	ClearingSystemReference *Max35Text `xml:"ClrSysRef,omitempty"`
+}
+
+func (p *PaymentIdentification7) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentIdentification7) SetInstructionIdentification(value string) {
+	p.InstructionIdentification = (*Max35Text)(&value)
+}
+
+func (p *PaymentIdentification7) SetEndToEndIdentification(value string) {
+	p.EndToEndIdentification = (*Max35Text)(&value)
+}
+
+func (p *PaymentIdentification7) SetTransactionIdentification(value string) {
+	p.TransactionIdentification = (*Max35Text)(&value)
+}
+
+func (p *PaymentIdentification7) SetUETR(value string) {
+	p.UETR = (*UUIDv4Identifier)(&value)
+}
+
+func (p *PaymentIdentification7) SetClearingSystemReference(value string) {
+	p.ClearingSystemReference = (*Max35Text)(&value)
+}
+
+func (p *PaymentIdentification7) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.InstructionIdentification != nil {
+		enc.Element("InstrId", string(*p.InstructionIdentification))
+	}
+	if p.EndToEndIdentification != nil {
+		enc.Element("EndToEndId", string(*p.EndToEndIdentification))
+	}
+	if p.TransactionIdentification != nil {
+		enc.Element("TxId", string(*p.TransactionIdentification))
+	}
+	if p.UETR != nil {
+		enc.Element("UETR", string(*p.UETR))
+	}
+	if p.ClearingSystemReference != nil {
+		enc.Element("ClrSysRef", string(*p.ClearingSystemReference))
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentIdentification7) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "InstrId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.InstructionIdentification = (*Max35Text)(&value)
+		case "EndToEndId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.EndToEndIdentification = (*Max35Text)(&value)
+		case "TxId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.TransactionIdentification = (*Max35Text)(&value)
+		case "UETR":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.UETR = (*UUIDv4Identifier)(&value)
+		case "ClrSysRef":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ClearingSystemReference = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,8 +10,16 @@ type PaymentInformation15 struct {
 	PaymentAccount *CashAccount7 `xml:"PmtAcct,omitempty"`
 }
 
-func (p *PaymentInformation15) SetPaymentMethod(value string) {
+func (p *PaymentInformation15) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentInformation15) SetPaymentMethod(value string) error {
+	if err := PaymentMethod4Code(value).Validate(); err != nil {
+		return err
+	}
 	p.PaymentMethod = (*PaymentMethod4Code)(&value)
+	return nil
 }
 
 func (p *PaymentInformation15) AddPaymentAccount() *CashAccount7 {
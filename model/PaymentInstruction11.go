@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Instruction to pay an amount of money to an ultimate beneficiary, on behalf of an originator. This instruction may have to be forwarded several times to complete the settlement chain.
 type PaymentInstruction11 struct {
 
@@ -36,12 +40,20 @@ type PaymentInstruction11 struct {
 	CreditTransferTransaction []*CreditTransferTransaction10 `xml:"CdtTrfTx"`
 }
 
+func (p *PaymentInstruction11) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstruction11) SetPaymentInformationIdentification(value string) {
 	p.PaymentInformationIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentInstruction11) SetPaymentMethod(value string) {
+func (p *PaymentInstruction11) SetPaymentMethod(value string) error {
+	if err := PaymentMethod7Code(value).Validate(); err != nil {
+		return err
+	}
 	p.PaymentMethod = (*PaymentMethod7Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstruction11) AddPaymentTypeInformation() *PaymentTypeInformation19 {
@@ -53,6 +65,11 @@ func (p *PaymentInstruction11) SetRequestedExecutionDate(value string) {
 	p.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentInstruction11) SetRequestedExecutionDateFromTime(value time.Time) {
+	p.RequestedExecutionDate = new(ISODate)
+	p.RequestedExecutionDate.FromTime(value)
+}
+
 func (p *PaymentInstruction11) AddDebtor() *PartyIdentification43 {
 	p.Debtor = new(PartyIdentification43)
 	return p.Debtor
@@ -73,8 +90,12 @@ func (p *PaymentInstruction11) AddUltimateDebtor() *PartyIdentification43 {
 	return p.UltimateDebtor
 }
 
-func (p *PaymentInstruction11) SetChargeBearer(value string) {
+func (p *PaymentInstruction11) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstruction11) AddCreditTransferTransaction() *CreditTransferTransaction10 {
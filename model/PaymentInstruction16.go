@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Characteristics that apply to the debit side of the payment transactions included in the credit transfer initiation.
 type PaymentInstruction16 struct {
 
@@ -66,18 +72,31 @@ type PaymentInstruction16 struct {
 	CreditTransferTransactionInformation []*CreditTransferTransaction20 `xml:"CdtTrfTxInf"`
 }
 
+func (p *PaymentInstruction16) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstruction16) SetPaymentInformationIdentification(value string) {
 	p.PaymentInformationIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentInstruction16) SetPaymentMethod(value string) {
+func (p *PaymentInstruction16) SetPaymentMethod(value string) error {
+	if err := PaymentMethod3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.PaymentMethod = (*PaymentMethod3Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstruction16) SetBatchBooking(value string) {
 	p.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (p *PaymentInstruction16) SetBatchBookingFromBool(value bool) {
+	p.BatchBooking = new(BatchBookingIndicator)
+	p.BatchBooking.FromBool(value)
+}
+
 func (p *PaymentInstruction16) SetNumberOfTransactions(value string) {
 	p.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -86,6 +105,11 @@ func (p *PaymentInstruction16) SetControlSum(value string) {
 	p.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (p *PaymentInstruction16) SetControlSumFromDecimal(value decimal.Decimal) {
+	p.ControlSum = new(DecimalNumber)
+	p.ControlSum.FromDecimal(value)
+}
+
 func (p *PaymentInstruction16) AddPaymentTypeInformation() *PaymentTypeInformation19 {
 	p.PaymentTypeInformation = new(PaymentTypeInformation19)
 	return p.PaymentTypeInformation
@@ -95,10 +119,20 @@ func (p *PaymentInstruction16) SetRequestedExecutionDate(value string) {
 	p.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentInstruction16) SetRequestedExecutionDateFromTime(value time.Time) {
+	p.RequestedExecutionDate = new(ISODate)
+	p.RequestedExecutionDate.FromTime(value)
+}
+
 func (p *PaymentInstruction16) SetPoolingAdjustmentDate(value string) {
 	p.PoolingAdjustmentDate = (*ISODate)(&value)
 }
 
+func (p *PaymentInstruction16) SetPoolingAdjustmentDateFromTime(value time.Time) {
+	p.PoolingAdjustmentDate = new(ISODate)
+	p.PoolingAdjustmentDate.FromTime(value)
+}
+
 func (p *PaymentInstruction16) AddDebtor() *PartyIdentification43 {
 	p.Debtor = new(PartyIdentification43)
 	return p.Debtor
@@ -128,8 +162,12 @@ func (p *PaymentInstruction16) AddUltimateDebtor() *PartyIdentification43 {
 	return p.UltimateDebtor
 }
 
-func (p *PaymentInstruction16) SetChargeBearer(value string) {
+func (p *PaymentInstruction16) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstruction16) AddChargesAccount() *CashAccount24 {
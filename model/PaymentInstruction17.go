@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Instruction to pay an amount of money to an ultimate beneficiary, on behalf of an originator. This instruction may have to be forwarded several times to complete the settlement chain.
 type PaymentInstruction17 struct {
 
@@ -36,12 +40,20 @@ type PaymentInstruction17 struct {
 	CreditTransferTransaction []*CreditTransferTransaction21 `xml:"CdtTrfTx"`
 }
 
+func (p *PaymentInstruction17) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstruction17) SetPaymentInformationIdentification(value string) {
 	p.PaymentInformationIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentInstruction17) SetPaymentMethod(value string) {
+func (p *PaymentInstruction17) SetPaymentMethod(value string) error {
+	if err := PaymentMethod7Code(value).Validate(); err != nil {
+		return err
+	}
 	p.PaymentMethod = (*PaymentMethod7Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstruction17) AddPaymentTypeInformation() *PaymentTypeInformation19 {
@@ -53,6 +65,11 @@ func (p *PaymentInstruction17) SetRequestedExecutionDate(value string) {
 	p.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentInstruction17) SetRequestedExecutionDateFromTime(value time.Time) {
+	p.RequestedExecutionDate = new(ISODate)
+	p.RequestedExecutionDate.FromTime(value)
+}
+
 func (p *PaymentInstruction17) AddDebtor() *PartyIdentification43 {
 	p.Debtor = new(PartyIdentification43)
 	return p.Debtor
@@ -73,8 +90,12 @@ func (p *PaymentInstruction17) AddUltimateDebtor() *PartyIdentification43 {
 	return p.UltimateDebtor
 }
 
-func (p *PaymentInstruction17) SetChargeBearer(value string) {
+func (p *PaymentInstruction17) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstruction17) AddCreditTransferTransaction() *CreditTransferTransaction21 {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Characteristics that apply to the credit side of the payment transactions included in the direct debit initiation.
 type PaymentInstruction18 struct {
 
@@ -60,18 +66,31 @@ type PaymentInstruction18 struct {
 	DirectDebitTransactionInformation []*DirectDebitTransactionInformation19 `xml:"DrctDbtTxInf"`
 }
 
+func (p *PaymentInstruction18) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstruction18) SetPaymentInformationIdentification(value string) {
 	p.PaymentInformationIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentInstruction18) SetPaymentMethod(value string) {
+func (p *PaymentInstruction18) SetPaymentMethod(value string) error {
+	if err := PaymentMethod2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.PaymentMethod = (*PaymentMethod2Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstruction18) SetBatchBooking(value string) {
 	p.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (p *PaymentInstruction18) SetBatchBookingFromBool(value bool) {
+	p.BatchBooking = new(BatchBookingIndicator)
+	p.BatchBooking.FromBool(value)
+}
+
 func (p *PaymentInstruction18) SetNumberOfTransactions(value string) {
 	p.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -80,6 +99,11 @@ func (p *PaymentInstruction18) SetControlSum(value string) {
 	p.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (p *PaymentInstruction18) SetControlSumFromDecimal(value decimal.Decimal) {
+	p.ControlSum = new(DecimalNumber)
+	p.ControlSum.FromDecimal(value)
+}
+
 func (p *PaymentInstruction18) AddPaymentTypeInformation() *PaymentTypeInformation24 {
 	p.PaymentTypeInformation = new(PaymentTypeInformation24)
 	return p.PaymentTypeInformation
@@ -89,6 +113,11 @@ func (p *PaymentInstruction18) SetRequestedCollectionDate(value string) {
 	p.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentInstruction18) SetRequestedCollectionDateFromTime(value time.Time) {
+	p.RequestedCollectionDate = new(ISODate)
+	p.RequestedCollectionDate.FromTime(value)
+}
+
 func (p *PaymentInstruction18) AddCreditor() *PartyIdentification43 {
 	p.Creditor = new(PartyIdentification43)
 	return p.Creditor
@@ -114,8 +143,12 @@ func (p *PaymentInstruction18) AddUltimateCreditor() *PartyIdentification43 {
 	return p.UltimateCreditor
 }
 
-func (p *PaymentInstruction18) SetChargeBearer(value string) {
+func (p *PaymentInstruction18) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstruction18) AddChargesAccount() *CashAccount24 {
@@ -1,5 +1,12 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Characteristics that apply to the debit side of the payment transactions included in the credit transfer initiation.
 type PaymentInstruction22 struct {
 
@@ -66,18 +73,31 @@ type PaymentInstruction22 struct {
 	CreditTransferTransactionInformation []*CreditTransferTransaction26 `xml:"CdtTrfTxInf"`
 }
 
+func (p *PaymentInstruction22) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstruction22) SetPaymentInformationIdentification(value string) {
 	p.PaymentInformationIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentInstruction22) SetPaymentMethod(value string) {
+func (p *PaymentInstruction22) SetPaymentMethod(value string) error {
+	if err := PaymentMethod3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.PaymentMethod = (*PaymentMethod3Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstruction22) SetBatchBooking(value string) {
 	p.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (p *PaymentInstruction22) SetBatchBookingFromBool(value bool) {
+	p.BatchBooking = new(BatchBookingIndicator)
+	p.BatchBooking.FromBool(value)
+}
+
 func (p *PaymentInstruction22) SetNumberOfTransactions(value string) {
 	p.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -86,6 +106,11 @@ func (p *PaymentInstruction22) SetControlSum(value string) {
 	p.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (p *PaymentInstruction22) SetControlSumFromDecimal(value decimal.Decimal) {
+	p.ControlSum = new(DecimalNumber)
+	p.ControlSum.FromDecimal(value)
+}
+
 func (p *PaymentInstruction22) AddPaymentTypeInformation() *PaymentTypeInformation19 {
 	p.PaymentTypeInformation = new(PaymentTypeInformation19)
 	return p.PaymentTypeInformation
@@ -100,6 +125,11 @@ func (p *PaymentInstruction22) SetPoolingAdjustmentDate(value string) {
 	p.PoolingAdjustmentDate = (*ISODate)(&value)
 }
 
+func (p *PaymentInstruction22) SetPoolingAdjustmentDateFromTime(value time.Time) {
+	p.PoolingAdjustmentDate = new(ISODate)
+	p.PoolingAdjustmentDate.FromTime(value)
+}
+
 func (p *PaymentInstruction22) AddDebtor() *PartyIdentification43 {
 	p.Debtor = new(PartyIdentification43)
 	return p.Debtor
@@ -129,8 +159,12 @@ func (p *PaymentInstruction22) AddUltimateDebtor() *PartyIdentification43 {
 	return p.UltimateDebtor
 }
 
-func (p *PaymentInstruction22) SetChargeBearer(value string) {
+func (p *PaymentInstruction22) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstruction22) AddChargesAccount() *CashAccount24 {
@@ -149,6 +183,193 @@ func (p *PaymentInstruction22) AddCreditTransferTransactionInformation() *Credit
 	return newValue
 }
 
+func (p *PaymentInstruction22) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.PaymentInformationIdentification != nil {
+		enc.Element("PmtInfId", string(*p.PaymentInformationIdentification))
+	}
+	if p.PaymentMethod != nil {
+		enc.Element("PmtMtd", string(*p.PaymentMethod))
+	}
+	if p.BatchBooking != nil {
+		enc.Element("BtchBookg", string(*p.BatchBooking))
+	}
+	if p.NumberOfTransactions != nil {
+		enc.Element("NbOfTxs", string(*p.NumberOfTransactions))
+	}
+	if p.ControlSum != nil {
+		enc.Element("CtrlSum", string(*p.ControlSum))
+	}
+	if p.PaymentTypeInformation != nil {
+		p.PaymentTypeInformation.EncodeXMLFast(enc, "PmtTpInf")
+	}
+	if p.RequestedExecutionDate != nil {
+		p.RequestedExecutionDate.EncodeXMLFast(enc, "ReqdExctnDt")
+	}
+	if p.PoolingAdjustmentDate != nil {
+		enc.Element("PoolgAdjstmntDt", string(*p.PoolingAdjustmentDate))
+	}
+	if p.Debtor != nil {
+		p.Debtor.EncodeXMLFast(enc, "Dbtr")
+	}
+	if p.DebtorAccount != nil {
+		p.DebtorAccount.EncodeXMLFast(enc, "DbtrAcct")
+	}
+	if p.DebtorAgent != nil {
+		p.DebtorAgent.EncodeXMLFast(enc, "DbtrAgt")
+	}
+	if p.DebtorAgentAccount != nil {
+		p.DebtorAgentAccount.EncodeXMLFast(enc, "DbtrAgtAcct")
+	}
+	if p.InstructionForDebtorAgent != nil {
+		enc.Element("InstrForDbtrAgt", string(*p.InstructionForDebtorAgent))
+	}
+	if p.UltimateDebtor != nil {
+		p.UltimateDebtor.EncodeXMLFast(enc, "UltmtDbtr")
+	}
+	if p.ChargeBearer != nil {
+		enc.Element("ChrgBr", string(*p.ChargeBearer))
+	}
+	if p.ChargesAccount != nil {
+		p.ChargesAccount.EncodeXMLFast(enc, "ChrgsAcct")
+	}
+	if p.ChargesAccountAgent != nil {
+		p.ChargesAccountAgent.EncodeXMLFast(enc, "ChrgsAcctAgt")
+	}
+	for _, elem := range p.CreditTransferTransactionInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "CdtTrfTxInf")
+		}
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentInstruction22) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "PmtInfId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.PaymentInformationIdentification = (*Max35Text)(&value)
+		case "PmtMtd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.PaymentMethod = (*PaymentMethod3Code)(&value)
+		case "BtchBookg":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.BatchBooking = (*BatchBookingIndicator)(&value)
+		case "NbOfTxs":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.NumberOfTransactions = (*Max15NumericText)(&value)
+		case "CtrlSum":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ControlSum = (*DecimalNumber)(&value)
+		case "PmtTpInf":
+			elem := new(PaymentTypeInformation19)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.PaymentTypeInformation = elem
+		case "ReqdExctnDt":
+			elem := new(DateAndDateTimeChoice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.RequestedExecutionDate = elem
+		case "PoolgAdjstmntDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.PoolingAdjustmentDate = (*ISODate)(&value)
+		case "Dbtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Debtor = elem
+		case "DbtrAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.DebtorAccount = elem
+		case "DbtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.DebtorAgent = elem
+		case "DbtrAgtAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.DebtorAgentAccount = elem
+		case "InstrForDbtrAgt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.InstructionForDebtorAgent = (*Max140Text)(&value)
+		case "UltmtDbtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.UltimateDebtor = elem
+		case "ChrgBr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+		case "ChrgsAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.ChargesAccount = elem
+		case "ChrgsAcctAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.ChargesAccountAgent = elem
+		case "CdtTrfTxInf":
+			elem := new(CreditTransferTransaction26)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.CreditTransferTransactionInformation = append(p.CreditTransferTransactionInformation, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
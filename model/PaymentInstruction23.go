@@ -36,12 +36,20 @@ type PaymentInstruction23 struct {
 	CreditTransferTransaction []*CreditTransferTransaction22 `xml:"CdtTrfTx"`
 }
 
+func (p *PaymentInstruction23) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstruction23) SetPaymentInformationIdentification(value string) {
 	p.PaymentInformationIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentInstruction23) SetPaymentMethod(value string) {
+func (p *PaymentInstruction23) SetPaymentMethod(value string) error {
+	if err := PaymentMethod7Code(value).Validate(); err != nil {
+		return err
+	}
 	p.PaymentMethod = (*PaymentMethod7Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstruction23) AddPaymentTypeInformation() *PaymentTypeInformation19 {
@@ -74,8 +82,12 @@ func (p *PaymentInstruction23) AddUltimateDebtor() *PartyIdentification43 {
 	return p.UltimateDebtor
 }
 
-func (p *PaymentInstruction23) SetChargeBearer(value string) {
+func (p *PaymentInstruction23) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstruction23) AddCreditTransferTransaction() *CreditTransferTransaction22 {
@@ -0,0 +1,371 @@
+package model
+
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Characteristics that apply to the debit side of the payment transactions included in the credit transfer initiation.
+type PaymentInstruction30 struct {
+
+	// Unique identification, as assigned by a sending party, to unambiguously identify the payment information group within the message.
+	PaymentInformationIdentification *Max35Text `xml:"PmtInfId"`
+
+	// Specifies the means of payment that will be used to move the amount of money.
+	PaymentMethod *PaymentMethod3Code `xml:"PmtMtd"`
+
+	// Identifies whether a single entry per individual transaction or a batch entry for the sum of the amounts of all transactions within the group of a message is requested.
+	// Usage: Batch booking is used to request and not order a possible batch booking.
+	BatchBooking *BatchBookingIndicator `xml:"BtchBookg,omitempty"`
+
+	// Number of individual transactions contained in the payment information group.
+	NumberOfTransactions *Max15NumericText `xml:"NbOfTxs,omitempty"`
+
+	// Total of all individual amounts included in the group, irrespective of currencies.
+	ControlSum *DecimalNumber `xml:"CtrlSum,omitempty"`
+
+	// Set of elements used to further specify the type of transaction.
+	PaymentTypeInformation *PaymentTypeInformation26 `xml:"PmtTpInf,omitempty"`
+
+	// Date at which the initiating party requests the clearing agent to process the payment.
+	// Usage: This is the date on which the debtor's account is to be debited. If payment by cheque, the date when the cheque must be generated by the bank.
+	RequestedExecutionDate *DateAndDateTime2Choice `xml:"ReqdExctnDt"`
+
+	// Date used for the correction of the value date of a cash pool movement that has been posted with a different value date.
+	PoolingAdjustmentDate *ISODate `xml:"PoolgAdjstmntDt,omitempty"`
+
+	// Party that owes an amount of money to the (ultimate) creditor.
+	Debtor *PartyIdentification135 `xml:"Dbtr"`
+
+	// Unambiguous identification of the account of the debtor to which a debit entry will be made as a result of the transaction.
+	DebtorAccount *CashAccount38 `xml:"DbtrAcct"`
+
+	// Financial institution servicing an account for the debtor.
+	DebtorAgent *BranchAndFinancialInstitutionIdentification6 `xml:"DbtrAgt"`
+
+	// Unambiguous identification of the account of the debtor agent at its servicing agent in the payment chain.
+	DebtorAgentAccount *CashAccount38 `xml:"DbtrAgtAcct,omitempty"`
+
+	// Further information related to the processing of the payment instruction, that may need to be acted upon by the debtor agent, depending on agreement between debtor and the debtor agent.
+	//
+	// Usage: when present, then the instructions for the debtor agent apply for all credit transfer transaction information occurrences, present in the payment information.
+	InstructionForDebtorAgent *Max140Text `xml:"InstrForDbtrAgt,omitempty"`
+
+	// Ultimate party that owes an amount of money to the (ultimate) creditor.
+	UltimateDebtor *PartyIdentification135 `xml:"UltmtDbtr,omitempty"`
+
+	// Specifies which party/parties will bear the charges associated with the processing of the payment transaction.
+	ChargeBearer *ChargeBearerType1Code `xml:"ChrgBr,omitempty"`
+
+	// Account used to process charges associated with a transaction.
+	//
+	// Usage: Charges account should be used when charges have to be booked to an account different from the account identified in debtor's account.
+	ChargesAccount *CashAccount38 `xml:"ChrgsAcct,omitempty"`
+
+	// Agent that services a charges account.
+	//
+	// Usage: Charges account agent should only be used when the charges account agent is different from the debtor agent.
+	ChargesAccountAgent *BranchAndFinancialInstitutionIdentification6 `xml:"ChrgsAcctAgt,omitempty"`
+
+	// Provides information on the individual transaction(s) included in the message.
+	CreditTransferTransactionInformation []*CreditTransferTransaction34 `xml:"CdtTrfTxInf"`
+}
+
+func (p *PaymentInstruction30) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentInstruction30) SetPaymentInformationIdentification(value string) {
+	p.PaymentInformationIdentification = (*Max35Text)(&value)
+}
+
+func (p *PaymentInstruction30) SetPaymentMethod(value string) error {
+	if err := PaymentMethod3Code(value).Validate(); err != nil {
+		return err
+	}
+	p.PaymentMethod = (*PaymentMethod3Code)(&value)
+	return nil
+}
+
+func (p *PaymentInstruction30) SetBatchBooking(value string) {
+	p.BatchBooking = (*BatchBookingIndicator)(&value)
+}
+
+func (p *PaymentInstruction30) SetBatchBookingFromBool(value bool) {
+	p.BatchBooking = new(BatchBookingIndicator)
+	p.BatchBooking.FromBool(value)
+}
+
+func (p *PaymentInstruction30) SetNumberOfTransactions(value string) {
+	p.NumberOfTransactions = (*Max15NumericText)(&value)
+}
+
+func (p *PaymentInstruction30) SetControlSum(value string) {
+	p.ControlSum = (*DecimalNumber)(&value)
+}
+
+func (p *PaymentInstruction30) SetControlSumFromDecimal(value decimal.Decimal) {
+	p.ControlSum = new(DecimalNumber)
+	p.ControlSum.FromDecimal(value)
+}
+
+func (p *PaymentInstruction30) AddPaymentTypeInformation() *PaymentTypeInformation26 {
+	p.PaymentTypeInformation = new(PaymentTypeInformation26)
+	return p.PaymentTypeInformation
+}
+
+func (p *PaymentInstruction30) AddRequestedExecutionDate() *DateAndDateTime2Choice {
+	p.RequestedExecutionDate = new(DateAndDateTime2Choice)
+	return p.RequestedExecutionDate
+}
+
+func (p *PaymentInstruction30) SetPoolingAdjustmentDate(value string) {
+	p.PoolingAdjustmentDate = (*ISODate)(&value)
+}
+
+func (p *PaymentInstruction30) SetPoolingAdjustmentDateFromTime(value time.Time) {
+	p.PoolingAdjustmentDate = new(ISODate)
+	p.PoolingAdjustmentDate.FromTime(value)
+}
+
+func (p *PaymentInstruction30) AddDebtor() *PartyIdentification135 {
+	p.Debtor = new(PartyIdentification135)
+	return p.Debtor
+}
+
+func (p *PaymentInstruction30) AddDebtorAccount() *CashAccount38 {
+	p.DebtorAccount = new(CashAccount38)
+	return p.DebtorAccount
+}
+
+func (p *PaymentInstruction30) AddDebtorAgent() *BranchAndFinancialInstitutionIdentification6 {
+	p.DebtorAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return p.DebtorAgent
+}
+
+func (p *PaymentInstruction30) AddDebtorAgentAccount() *CashAccount38 {
+	p.DebtorAgentAccount = new(CashAccount38)
+	return p.DebtorAgentAccount
+}
+
+func (p *PaymentInstruction30) SetInstructionForDebtorAgent(value string) {
+	p.InstructionForDebtorAgent = (*Max140Text)(&value)
+}
+
+func (p *PaymentInstruction30) AddUltimateDebtor() *PartyIdentification135 {
+	p.UltimateDebtor = new(PartyIdentification135)
+	return p.UltimateDebtor
+}
+
+func (p *PaymentInstruction30) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
+	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
+}
+
+func (p *PaymentInstruction30) AddChargesAccount() *CashAccount38 {
+	p.ChargesAccount = new(CashAccount38)
+	return p.ChargesAccount
+}
+
+func (p *PaymentInstruction30) AddChargesAccountAgent() *BranchAndFinancialInstitutionIdentification6 {
+	p.ChargesAccountAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return p.ChargesAccountAgent
+}
+
+func (p *PaymentInstruction30) AddCreditTransferTransactionInformation() *CreditTransferTransaction34 {
+	newValue := new(CreditTransferTransaction34)
+	p.CreditTransferTransactionInformation = append(p.CreditTransferTransactionInformation, newValue)
+	return newValue
+}
+
+func (p *PaymentInstruction30) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.PaymentInformationIdentification != nil {
+		enc.Element("PmtInfId", string(*p.PaymentInformationIdentification))
+	}
+	if p.PaymentMethod != nil {
+		enc.Element("PmtMtd", string(*p.PaymentMethod))
+	}
+	if p.BatchBooking != nil {
+		enc.Element("BtchBookg", string(*p.BatchBooking))
+	}
+	if p.NumberOfTransactions != nil {
+		enc.Element("NbOfTxs", string(*p.NumberOfTransactions))
+	}
+	if p.ControlSum != nil {
+		enc.Element("CtrlSum", string(*p.ControlSum))
+	}
+	if p.PaymentTypeInformation != nil {
+		p.PaymentTypeInformation.EncodeXMLFast(enc, "PmtTpInf")
+	}
+	if p.RequestedExecutionDate != nil {
+		p.RequestedExecutionDate.EncodeXMLFast(enc, "ReqdExctnDt")
+	}
+	if p.PoolingAdjustmentDate != nil {
+		enc.Element("PoolgAdjstmntDt", string(*p.PoolingAdjustmentDate))
+	}
+	if p.Debtor != nil {
+		p.Debtor.EncodeXMLFast(enc, "Dbtr")
+	}
+	if p.DebtorAccount != nil {
+		p.DebtorAccount.EncodeXMLFast(enc, "DbtrAcct")
+	}
+	if p.DebtorAgent != nil {
+		p.DebtorAgent.EncodeXMLFast(enc, "DbtrAgt")
+	}
+	if p.DebtorAgentAccount != nil {
+		p.DebtorAgentAccount.EncodeXMLFast(enc, "DbtrAgtAcct")
+	}
+	if p.InstructionForDebtorAgent != nil {
+		enc.Element("InstrForDbtrAgt", string(*p.InstructionForDebtorAgent))
+	}
+	if p.UltimateDebtor != nil {
+		p.UltimateDebtor.EncodeXMLFast(enc, "UltmtDbtr")
+	}
+	if p.ChargeBearer != nil {
+		enc.Element("ChrgBr", string(*p.ChargeBearer))
+	}
+	if p.ChargesAccount != nil {
+		p.ChargesAccount.EncodeXMLFast(enc, "ChrgsAcct")
+	}
+	if p.ChargesAccountAgent != nil {
+		p.ChargesAccountAgent.EncodeXMLFast(enc, "ChrgsAcctAgt")
+	}
+	for _, elem := range p.CreditTransferTransactionInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "CdtTrfTxInf")
+		}
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentInstruction30) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "PmtInfId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.PaymentInformationIdentification = (*Max35Text)(&value)
+		case "PmtMtd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.PaymentMethod = (*PaymentMethod3Code)(&value)
+		case "BtchBookg":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.BatchBooking = (*BatchBookingIndicator)(&value)
+		case "NbOfTxs":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.NumberOfTransactions = (*Max15NumericText)(&value)
+		case "CtrlSum":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ControlSum = (*DecimalNumber)(&value)
+		case "PmtTpInf":
+			elem := new(PaymentTypeInformation26)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.PaymentTypeInformation = elem
+		case "ReqdExctnDt":
+			elem := new(DateAndDateTime2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.RequestedExecutionDate = elem
+		case "PoolgAdjstmntDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.PoolingAdjustmentDate = (*ISODate)(&value)
+		case "Dbtr":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Debtor = elem
+		case "DbtrAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.DebtorAccount = elem
+		case "DbtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.DebtorAgent = elem
+		case "DbtrAgtAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.DebtorAgentAccount = elem
+		case "InstrForDbtrAgt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.InstructionForDebtorAgent = (*Max140Text)(&value)
+		case "UltmtDbtr":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.UltimateDebtor = elem
+		case "ChrgBr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+		case "ChrgsAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.ChargesAccount = elem
+		case "ChrgsAcctAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.ChargesAccountAgent = elem
+		case "CdtTrfTxInf":
+			elem := new(CreditTransferTransaction34)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.CreditTransferTransactionInformation = append(p.CreditTransferTransactionInformation, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
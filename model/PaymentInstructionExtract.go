@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of a payment instruction. The information contained in this component is sufficient to retrieve a payment instruction.
 type PaymentInstructionExtract struct {
 
@@ -16,6 +22,10 @@ type PaymentInstructionExtract struct {
 	ValueDate *ISODateTime `xml:"ValDt,omitempty"`
 }
 
+func (p *PaymentInstructionExtract) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstructionExtract) SetAssignerInstructionIdentification(value string) {
 	p.AssignerInstructionIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +38,15 @@ func (p *PaymentInstructionExtract) SetCurrencyAmount(value, currency string) {
 	p.CurrencyAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentInstructionExtract) SetCurrencyAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.CurrencyAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentInstructionExtract) SetValueDate(value string) {
 	p.ValueDate = (*ISODateTime)(&value)
 }
+
+func (p *PaymentInstructionExtract) SetValueDateFromTime(value time.Time) {
+	p.ValueDate = new(ISODateTime)
+	p.ValueDate.FromTime(value)
+}
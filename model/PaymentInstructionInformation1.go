@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics that apply to the debit side of the payment transactions included in the credit transfer transaction initiation.
 type PaymentInstructionInformation1 struct {
 
@@ -52,12 +56,20 @@ type PaymentInstructionInformation1 struct {
 	CreditTransferTransactionInformation []*CreditTransferTransactionInformation1 `xml:"CdtTrfTxInf"`
 }
 
+func (p *PaymentInstructionInformation1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstructionInformation1) SetPaymentInformationIdentification(value string) {
 	p.PaymentInformationIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentInstructionInformation1) SetPaymentMethod(value string) {
+func (p *PaymentInstructionInformation1) SetPaymentMethod(value string) error {
+	if err := PaymentMethod3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.PaymentMethod = (*PaymentMethod3Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstructionInformation1) AddPaymentTypeInformation() *PaymentTypeInformation1 {
@@ -69,10 +81,20 @@ func (p *PaymentInstructionInformation1) SetRequestedExecutionDate(value string)
 	p.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentInstructionInformation1) SetRequestedExecutionDateFromTime(value time.Time) {
+	p.RequestedExecutionDate = new(ISODate)
+	p.RequestedExecutionDate.FromTime(value)
+}
+
 func (p *PaymentInstructionInformation1) SetPoolingAdjustmentDate(value string) {
 	p.PoolingAdjustmentDate = (*ISODate)(&value)
 }
 
+func (p *PaymentInstructionInformation1) SetPoolingAdjustmentDateFromTime(value time.Time) {
+	p.PoolingAdjustmentDate = new(ISODate)
+	p.PoolingAdjustmentDate.FromTime(value)
+}
+
 func (p *PaymentInstructionInformation1) AddDebtor() *PartyIdentification8 {
 	p.Debtor = new(PartyIdentification8)
 	return p.Debtor
@@ -98,8 +120,12 @@ func (p *PaymentInstructionInformation1) AddUltimateDebtor() *PartyIdentificatio
 	return p.UltimateDebtor
 }
 
-func (p *PaymentInstructionInformation1) SetChargeBearer(value string) {
+func (p *PaymentInstructionInformation1) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstructionInformation1) AddChargesAccount() *CashAccount7 {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of characteristics that apply to the credit side of the payment transactions included in the direct debit transaction initiation.
 type PaymentInstructionInformation2 struct {
 
@@ -47,12 +51,20 @@ type PaymentInstructionInformation2 struct {
 	DirectDebitTransactionInformation []*DirectDebitTransactionInformation1 `xml:"DrctDbtTxInf"`
 }
 
+func (p *PaymentInstructionInformation2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstructionInformation2) SetPaymentInformationIdentification(value string) {
 	p.PaymentInformationIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentInstructionInformation2) SetPaymentMethod(value string) {
+func (p *PaymentInstructionInformation2) SetPaymentMethod(value string) error {
+	if err := PaymentMethod2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.PaymentMethod = (*PaymentMethod2Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstructionInformation2) AddPaymentTypeInformation() *PaymentTypeInformation2 {
@@ -64,6 +76,11 @@ func (p *PaymentInstructionInformation2) SetRequestedCollectionDate(value string
 	p.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentInstructionInformation2) SetRequestedCollectionDateFromTime(value time.Time) {
+	p.RequestedCollectionDate = new(ISODate)
+	p.RequestedCollectionDate.FromTime(value)
+}
+
 func (p *PaymentInstructionInformation2) AddCreditor() *PartyIdentification8 {
 	p.Creditor = new(PartyIdentification8)
 	return p.Creditor
@@ -89,8 +106,12 @@ func (p *PaymentInstructionInformation2) AddUltimateCreditor() *PartyIdentificat
 	return p.UltimateCreditor
 }
 
-func (p *PaymentInstructionInformation2) SetChargeBearer(value string) {
+func (p *PaymentInstructionInformation2) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstructionInformation2) AddChargesAccount() *CashAccount7 {
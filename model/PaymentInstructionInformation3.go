@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics that apply to the debit side of the payment transactions included in the credit transfer initiation.
 type PaymentInstructionInformation3 struct {
 
@@ -61,18 +67,31 @@ type PaymentInstructionInformation3 struct {
 	CreditTransferTransactionInformation []*CreditTransferTransactionInformation10 `xml:"CdtTrfTxInf"`
 }
 
+func (p *PaymentInstructionInformation3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstructionInformation3) SetPaymentInformationIdentification(value string) {
 	p.PaymentInformationIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentInstructionInformation3) SetPaymentMethod(value string) {
+func (p *PaymentInstructionInformation3) SetPaymentMethod(value string) error {
+	if err := PaymentMethod3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.PaymentMethod = (*PaymentMethod3Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstructionInformation3) SetBatchBooking(value string) {
 	p.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (p *PaymentInstructionInformation3) SetBatchBookingFromBool(value bool) {
+	p.BatchBooking = new(BatchBookingIndicator)
+	p.BatchBooking.FromBool(value)
+}
+
 func (p *PaymentInstructionInformation3) SetNumberOfTransactions(value string) {
 	p.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -81,6 +100,11 @@ func (p *PaymentInstructionInformation3) SetControlSum(value string) {
 	p.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (p *PaymentInstructionInformation3) SetControlSumFromDecimal(value decimal.Decimal) {
+	p.ControlSum = new(DecimalNumber)
+	p.ControlSum.FromDecimal(value)
+}
+
 func (p *PaymentInstructionInformation3) AddPaymentTypeInformation() *PaymentTypeInformation19 {
 	p.PaymentTypeInformation = new(PaymentTypeInformation19)
 	return p.PaymentTypeInformation
@@ -90,10 +114,20 @@ func (p *PaymentInstructionInformation3) SetRequestedExecutionDate(value string)
 	p.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentInstructionInformation3) SetRequestedExecutionDateFromTime(value time.Time) {
+	p.RequestedExecutionDate = new(ISODate)
+	p.RequestedExecutionDate.FromTime(value)
+}
+
 func (p *PaymentInstructionInformation3) SetPoolingAdjustmentDate(value string) {
 	p.PoolingAdjustmentDate = (*ISODate)(&value)
 }
 
+func (p *PaymentInstructionInformation3) SetPoolingAdjustmentDateFromTime(value time.Time) {
+	p.PoolingAdjustmentDate = new(ISODate)
+	p.PoolingAdjustmentDate.FromTime(value)
+}
+
 func (p *PaymentInstructionInformation3) AddDebtor() *PartyIdentification32 {
 	p.Debtor = new(PartyIdentification32)
 	return p.Debtor
@@ -119,8 +153,12 @@ func (p *PaymentInstructionInformation3) AddUltimateDebtor() *PartyIdentificatio
 	return p.UltimateDebtor
 }
 
-func (p *PaymentInstructionInformation3) SetChargeBearer(value string) {
+func (p *PaymentInstructionInformation3) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstructionInformation3) AddChargesAccount() *CashAccount16 {
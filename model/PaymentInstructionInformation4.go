@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics that apply to the credit side of the payment transactions included in the direct debit initiation.
 type PaymentInstructionInformation4 struct {
 
@@ -60,18 +66,31 @@ type PaymentInstructionInformation4 struct {
 	DirectDebitTransactionInformation []*DirectDebitTransactionInformation9 `xml:"DrctDbtTxInf"`
 }
 
+func (p *PaymentInstructionInformation4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstructionInformation4) SetPaymentInformationIdentification(value string) {
 	p.PaymentInformationIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentInstructionInformation4) SetPaymentMethod(value string) {
+func (p *PaymentInstructionInformation4) SetPaymentMethod(value string) error {
+	if err := PaymentMethod2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.PaymentMethod = (*PaymentMethod2Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstructionInformation4) SetBatchBooking(value string) {
 	p.BatchBooking = (*BatchBookingIndicator)(&value)
 }
 
+func (p *PaymentInstructionInformation4) SetBatchBookingFromBool(value bool) {
+	p.BatchBooking = new(BatchBookingIndicator)
+	p.BatchBooking.FromBool(value)
+}
+
 func (p *PaymentInstructionInformation4) SetNumberOfTransactions(value string) {
 	p.NumberOfTransactions = (*Max15NumericText)(&value)
 }
@@ -80,6 +99,11 @@ func (p *PaymentInstructionInformation4) SetControlSum(value string) {
 	p.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (p *PaymentInstructionInformation4) SetControlSumFromDecimal(value decimal.Decimal) {
+	p.ControlSum = new(DecimalNumber)
+	p.ControlSum.FromDecimal(value)
+}
+
 func (p *PaymentInstructionInformation4) AddPaymentTypeInformation() *PaymentTypeInformation20 {
 	p.PaymentTypeInformation = new(PaymentTypeInformation20)
 	return p.PaymentTypeInformation
@@ -89,6 +113,11 @@ func (p *PaymentInstructionInformation4) SetRequestedCollectionDate(value string
 	p.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentInstructionInformation4) SetRequestedCollectionDateFromTime(value time.Time) {
+	p.RequestedCollectionDate = new(ISODate)
+	p.RequestedCollectionDate.FromTime(value)
+}
+
 func (p *PaymentInstructionInformation4) AddCreditor() *PartyIdentification32 {
 	p.Creditor = new(PartyIdentification32)
 	return p.Creditor
@@ -114,8 +143,12 @@ func (p *PaymentInstructionInformation4) AddUltimateCreditor() *PartyIdentificat
 	return p.UltimateCreditor
 }
 
-func (p *PaymentInstructionInformation4) SetChargeBearer(value string) {
+func (p *PaymentInstructionInformation4) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentInstructionInformation4) AddChargesAccount() *CashAccount16 {
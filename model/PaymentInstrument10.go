@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Instrument that has or represents monetary value and is used to process a payment instruction.
 type PaymentInstrument10 struct {
 
@@ -13,6 +17,10 @@ type PaymentInstrument10 struct {
 	DividendPercentage *PercentageBoundedRate `xml:"DvddPctg,omitempty"`
 }
 
+func (p *PaymentInstrument10) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument10) SetSettlementCurrency(value string) {
 	p.SettlementCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -25,3 +33,8 @@ func (p *PaymentInstrument10) AddPaymentInstrument() *PaymentInstrument16Choice
 func (p *PaymentInstrument10) SetDividendPercentage(value string) {
 	p.DividendPercentage = (*PercentageBoundedRate)(&value)
 }
+
+func (p *PaymentInstrument10) SetDividendPercentageFromDecimal(value decimal.Decimal) {
+	p.DividendPercentage = new(PercentageBoundedRate)
+	p.DividendPercentage.FromDecimal(value)
+}
@@ -19,6 +19,10 @@ type PaymentInstrument10Choice struct {
 	AccountDetails *InvestmentAccount15 `xml:"AcctDtls"`
 }
 
+func (p *PaymentInstrument10Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument10Choice) AddPaymentCardDetails() *PaymentCard2 {
 	p.PaymentCardDetails = new(PaymentCard2)
 	return p.PaymentCardDetails
@@ -10,6 +10,10 @@ type PaymentInstrument11 struct {
 	PaymentInstrument *PaymentInstrument17Choice `xml:"PmtInstrm"`
 }
 
+func (p *PaymentInstrument11) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument11) SetSettlementCurrency(value string) {
 	p.SettlementCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -16,6 +16,10 @@ type PaymentInstrument11Choice struct {
 	CashAccountDetails *InvestmentAccount20 `xml:"CshAcctDtls"`
 }
 
+func (p *PaymentInstrument11Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument11Choice) AddCreditTransferDetails() *CreditTransfer6 {
 	p.CreditTransferDetails = new(CreditTransfer6)
 	return p.CreditTransferDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Instrument that has or represents monetary value and is used to process a payment instruction.
 type PaymentInstrument12 struct {
 
@@ -13,6 +17,10 @@ type PaymentInstrument12 struct {
 	DividendPercentage *PercentageBoundedRate `xml:"DvddPctg,omitempty"`
 }
 
+func (p *PaymentInstrument12) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument12) SetSettlementCurrency(value string) {
 	p.SettlementCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -25,3 +33,8 @@ func (p *PaymentInstrument12) AddPaymentInstrument() *PaymentInstrument16Choice
 func (p *PaymentInstrument12) SetDividendPercentage(value string) {
 	p.DividendPercentage = (*PercentageBoundedRate)(&value)
 }
+
+func (p *PaymentInstrument12) SetDividendPercentageFromDecimal(value decimal.Decimal) {
+	p.DividendPercentage = new(PercentageBoundedRate)
+	p.DividendPercentage.FromDecimal(value)
+}
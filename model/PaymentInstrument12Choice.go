@@ -22,6 +22,10 @@ type PaymentInstrument12Choice struct {
 	CashAccountDetails *InvestmentAccount20 `xml:"CshAcctDtls"`
 }
 
+func (p *PaymentInstrument12Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument12Choice) AddPaymentCardDetails() *PaymentCard2 {
 	p.PaymentCardDetails = new(PaymentCard2)
 	return p.PaymentCardDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Instrument used to process a payment instruction.
 type PaymentInstrument13 struct {
 
@@ -25,6 +29,10 @@ type PaymentInstrument13 struct {
 	InterestPaymentInstrument *PaymentInstrument19Choice `xml:"IntrstPmtInstrm,omitempty"`
 }
 
+func (p *PaymentInstrument13) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument13) SetSettlementCurrency(value string) {
 	p.SettlementCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -33,6 +41,11 @@ func (p *PaymentInstrument13) SetDividendPercentage(value string) {
 	p.DividendPercentage = (*PercentageBoundedRate)(&value)
 }
 
+func (p *PaymentInstrument13) SetDividendPercentageFromDecimal(value decimal.Decimal) {
+	p.DividendPercentage = new(PercentageBoundedRate)
+	p.DividendPercentage.FromDecimal(value)
+}
+
 func (p *PaymentInstrument13) AddSubscriptionPaymentInstrument() *PaymentInstrument18Choice {
 	p.SubscriptionPaymentInstrument = new(PaymentInstrument18Choice)
 	return p.SubscriptionPaymentInstrument
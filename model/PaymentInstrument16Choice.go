@@ -13,6 +13,10 @@ type PaymentInstrument16Choice struct {
 	BankersDraftDetails *Cheque4 `xml:"BkrsDrftDtls"`
 }
 
+func (p *PaymentInstrument16Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument16Choice) AddCashAccountDetails() *CashAccount26 {
 	newValue := new(CashAccount26)
 	p.CashAccountDetails = append(p.CashAccountDetails, newValue)
@@ -19,6 +19,10 @@ type PaymentInstrument17Choice struct {
 	BankersDraft *YesNoIndicator `xml:"BkrsDrft"`
 }
 
+func (p *PaymentInstrument17Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument17Choice) AddCashAccountDetails() *CashAccount26 {
 	newValue := new(CashAccount26)
 	p.CashAccountDetails = append(p.CashAccountDetails, newValue)
@@ -179,6 +183,16 @@ func (p *PaymentInstrument17Choice) SetCheque(value string) {
 	p.Cheque = (*YesNoIndicator)(&value)
 }
 
+func (p *PaymentInstrument17Choice) SetChequeFromBool(value bool) {
+	p.Cheque = new(YesNoIndicator)
+	p.Cheque.FromBool(value)
+}
+
 func (p *PaymentInstrument17Choice) SetBankersDraft(value string) {
 	p.BankersDraft = (*YesNoIndicator)(&value)
 }
+
+func (p *PaymentInstrument17Choice) SetBankersDraftFromBool(value bool) {
+	p.BankersDraft = new(YesNoIndicator)
+	p.BankersDraft.FromBool(value)
+}
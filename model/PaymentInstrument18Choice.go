@@ -16,6 +16,10 @@ type PaymentInstrument18Choice struct {
 	BankersDraft *YesNoIndicator `xml:"BkrsDrft"`
 }
 
+func (p *PaymentInstrument18Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument18Choice) AddPaymentCardDetails() *PaymentCard18 {
 	p.PaymentCardDetails = new(PaymentCard18)
 	return p.PaymentCardDetails
@@ -30,6 +34,16 @@ func (p *PaymentInstrument18Choice) SetCheque(value string) {
 	p.Cheque = (*YesNoIndicator)(&value)
 }
 
+func (p *PaymentInstrument18Choice) SetChequeFromBool(value bool) {
+	p.Cheque = new(YesNoIndicator)
+	p.Cheque.FromBool(value)
+}
+
 func (p *PaymentInstrument18Choice) SetBankersDraft(value string) {
 	p.BankersDraft = (*YesNoIndicator)(&value)
 }
+
+func (p *PaymentInstrument18Choice) SetBankersDraftFromBool(value bool) {
+	p.BankersDraft = new(YesNoIndicator)
+	p.BankersDraft.FromBool(value)
+}
@@ -10,6 +10,10 @@ type PaymentInstrument19Choice struct {
 	BankersDraftDetails *Cheque4 `xml:"BkrsDrftDtls"`
 }
 
+func (p *PaymentInstrument19Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument19Choice) AddChequeDetails() *Cheque4 {
 	p.ChequeDetails = new(Cheque4)
 	return p.ChequeDetails
@@ -22,6 +22,10 @@ type PaymentInstrument20Choice struct {
 	CashAccountDetails *InvestmentAccount60 `xml:"CshAcctDtls"`
 }
 
+func (p *PaymentInstrument20Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument20Choice) AddPaymentCardDetails() *PaymentCard25 {
 	p.PaymentCardDetails = new(PaymentCard25)
 	return p.PaymentCardDetails
@@ -16,6 +16,10 @@ type PaymentInstrument21Choice struct {
 	CashAccountDetails *InvestmentAccount60 `xml:"CshAcctDtls"`
 }
 
+func (p *PaymentInstrument21Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument21Choice) AddCreditTransferDetails() *CreditTransfer8 {
 	p.CreditTransferDetails = new(CreditTransfer8)
 	return p.CreditTransferDetails
@@ -19,6 +19,10 @@ type PaymentInstrument6Choice struct {
 	AccountDetails *InvestmentAccount15 `xml:"AcctDtls"`
 }
 
+func (p *PaymentInstrument6Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument6Choice) AddPaymentCardDetails() *PaymentCard2 {
 	p.PaymentCardDetails = new(PaymentCard2)
 	return p.PaymentCardDetails
@@ -13,6 +13,10 @@ type PaymentInstrument7Choice struct {
 	AccountDetails *InvestmentAccount15 `xml:"AcctDtls"`
 }
 
+func (p *PaymentInstrument7Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument7Choice) AddCreditTransferDetails() *CreditTransfer3 {
 	p.CreditTransferDetails = new(CreditTransfer3)
 	return p.CreditTransferDetails
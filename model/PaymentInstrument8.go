@@ -22,6 +22,10 @@ type PaymentInstrument8 struct {
 	BankersDraft *YesNoIndicator `xml:"BkrsDrft"`
 }
 
+func (p *PaymentInstrument8) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument8) SetSettlementCurrency(value string) {
 	p.SettlementCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -186,6 +190,16 @@ func (p *PaymentInstrument8) SetCheque(value string) {
 	p.Cheque = (*YesNoIndicator)(&value)
 }
 
+func (p *PaymentInstrument8) SetChequeFromBool(value bool) {
+	p.Cheque = new(YesNoIndicator)
+	p.Cheque.FromBool(value)
+}
+
 func (p *PaymentInstrument8) SetBankersDraft(value string) {
 	p.BankersDraft = (*YesNoIndicator)(&value)
 }
+
+func (p *PaymentInstrument8) SetBankersDraftFromBool(value bool) {
+	p.BankersDraft = new(YesNoIndicator)
+	p.BankersDraft.FromBool(value)
+}
@@ -19,6 +19,10 @@ type PaymentInstrument8Choice struct {
 	AccountDetails *InvestmentAccount15 `xml:"AcctDtls"`
 }
 
+func (p *PaymentInstrument8Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument8Choice) AddPaymentCardDetails() *PaymentCard2 {
 	p.PaymentCardDetails = new(PaymentCard2)
 	return p.PaymentCardDetails
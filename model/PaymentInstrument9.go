@@ -16,6 +16,10 @@ type PaymentInstrument9 struct {
 	BankersDraftDetails *Cheque4 `xml:"BkrsDrftDtls"`
 }
 
+func (p *PaymentInstrument9) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument9) SetSettlementCurrency(value string) {
 	p.SettlementCurrency = (*ActiveCurrencyCode)(&value)
 }
@@ -19,6 +19,10 @@ type PaymentInstrument9Choice struct {
 	AccountDetails *InvestmentAccount15 `xml:"AcctDtls"`
 }
 
+func (p *PaymentInstrument9Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrument9Choice) AddPaymentCardDetails() *PaymentCard2 {
 	p.PaymentCardDetails = new(PaymentCard2)
 	return p.PaymentCardDetails
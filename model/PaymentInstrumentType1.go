@@ -13,6 +13,10 @@ type PaymentInstrumentType1 struct {
 	AdditionalInformation *Max500Text `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PaymentInstrumentType1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentInstrumentType1) SetCardNumber(value string) {
 	p.CardNumber = (*Min8Max28NumericText)(&value)
 }
@@ -22,13 +22,21 @@ type PaymentMeans1 struct {
 	PayerFinancialInstitution *BranchAndFinancialInstitutionIdentification4 `xml:"PyerFI,omitempty"`
 }
 
+func (p *PaymentMeans1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentMeans1) AddPaymentType() *PaymentTypeInformation19 {
 	p.PaymentType = new(PaymentTypeInformation19)
 	return p.PaymentType
 }
 
-func (p *PaymentMeans1) SetPaymentMethodCode(value string) {
+func (p *PaymentMeans1) SetPaymentMethodCode(value string) error {
+	if err := PaymentMethod4Code(value).Validate(); err != nil {
+		return err
+	}
 	p.PaymentMethodCode = (*PaymentMethod4Code)(&value)
+	return nil
 }
 
 func (p *PaymentMeans1) AddPayeeCreditorAccount() *CashAccount16 {
@@ -1,3 +1,24 @@
 package model
 
 type PaymentMethod2Code string
+
+const (
+	PaymentMethod2CodeDD PaymentMethod2Code = "DD"
+)
+
+var paymentMethod2CodeDescriptions = map[PaymentMethod2Code]string{
+	PaymentMethod2CodeDD: "Direct debit: collection of an amount of money from the debtor's bank account by the creditor.",
+}
+
+func (p PaymentMethod2Code) IsValid() bool {
+	_, ok := paymentMethod2CodeDescriptions[p]
+	return ok
+}
+
+func (p PaymentMethod2Code) Description() string {
+	return paymentMethod2CodeDescriptions[p]
+}
+
+func (p PaymentMethod2Code) Validate() error {
+	return validateEnumeration("PaymentMethod2Code", string(p), p.IsValid(), "DD")
+}
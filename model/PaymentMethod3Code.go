@@ -1,3 +1,28 @@
 package model
 
 type PaymentMethod3Code string
+
+const (
+	PaymentMethod3CodeCHK PaymentMethod3Code = "CHK"
+	PaymentMethod3CodeTRF PaymentMethod3Code = "TRF"
+	PaymentMethod3CodeTRA PaymentMethod3Code = "TRA"
+)
+
+var paymentMethod3CodeDescriptions = map[PaymentMethod3Code]string{
+	PaymentMethod3CodeCHK: "Cheque: written order to a financial institution to pay a certain amount of money from one person to another person.",
+	PaymentMethod3CodeTRF: "Credit transfer: transfer of an amount of money in the books of the account servicer.",
+	PaymentMethod3CodeTRA: "Transfer advice: transfer of an amount of money in the books of the account servicer, with an advice sent to the creditor.",
+}
+
+func (p PaymentMethod3Code) IsValid() bool {
+	_, ok := paymentMethod3CodeDescriptions[p]
+	return ok
+}
+
+func (p PaymentMethod3Code) Description() string {
+	return paymentMethod3CodeDescriptions[p]
+}
+
+func (p PaymentMethod3Code) Validate() error {
+	return validateEnumeration("PaymentMethod3Code", string(p), p.IsValid(), "CHK, TRF, TRA")
+}
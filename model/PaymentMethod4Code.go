@@ -1,3 +1,30 @@
 package model
 
 type PaymentMethod4Code string
+
+const (
+	PaymentMethod4CodeCHK PaymentMethod4Code = "CHK"
+	PaymentMethod4CodeTRF PaymentMethod4Code = "TRF"
+	PaymentMethod4CodeDD  PaymentMethod4Code = "DD"
+	PaymentMethod4CodeTRA PaymentMethod4Code = "TRA"
+)
+
+var paymentMethod4CodeDescriptions = map[PaymentMethod4Code]string{
+	PaymentMethod4CodeCHK: "Cheque: written order to a financial institution to pay a certain amount of money from one person to another person.",
+	PaymentMethod4CodeTRF: "Credit transfer: transfer of an amount of money in the books of the account servicer.",
+	PaymentMethod4CodeDD:  "Direct debit: collection of an amount of money from the debtor's bank account by the creditor.",
+	PaymentMethod4CodeTRA: "Transfer advice: transfer of an amount of money in the books of the account servicer, with an advice sent to the creditor.",
+}
+
+func (p PaymentMethod4Code) IsValid() bool {
+	_, ok := paymentMethod4CodeDescriptions[p]
+	return ok
+}
+
+func (p PaymentMethod4Code) Description() string {
+	return paymentMethod4CodeDescriptions[p]
+}
+
+func (p PaymentMethod4Code) Validate() error {
+	return validateEnumeration("PaymentMethod4Code", string(p), p.IsValid(), "CHK, TRF, DD, TRA")
+}
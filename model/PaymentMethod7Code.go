@@ -1,3 +1,26 @@
 package model
 
 type PaymentMethod7Code string
+
+const (
+	PaymentMethod7CodeCHK PaymentMethod7Code = "CHK"
+	PaymentMethod7CodeTRF PaymentMethod7Code = "TRF"
+)
+
+var paymentMethod7CodeDescriptions = map[PaymentMethod7Code]string{
+	PaymentMethod7CodeCHK: "Cheque: written order to a financial institution to pay a certain amount of money from one person to another person.",
+	PaymentMethod7CodeTRF: "Credit transfer: transfer of an amount of money in the books of the account servicer.",
+}
+
+func (p PaymentMethod7Code) IsValid() bool {
+	_, ok := paymentMethod7CodeDescriptions[p]
+	return ok
+}
+
+func (p PaymentMethod7Code) Description() string {
+	return paymentMethod7CodeDescriptions[p]
+}
+
+func (p PaymentMethod7Code) Validate() error {
+	return validateEnumeration("PaymentMethod7Code", string(p), p.IsValid(), "CHK, TRF")
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Payment obligation contracted between two financial institutions related to the financing of a commercial transaction.
 type PaymentObligation1 struct {
 
@@ -34,6 +40,10 @@ type PaymentObligation1 struct {
 	SettlementTerms *SettlementTerms2 `xml:"SttlmTerms,omitempty"`
 }
 
+func (p *PaymentObligation1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentObligation1) AddObligorBank() *BICIdentification1 {
 	p.ObligorBank = new(BICIdentification1)
 	return p.ObligorBank
@@ -48,22 +58,45 @@ func (p *PaymentObligation1) SetAmount(value, currency string) {
 	p.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentObligation1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentObligation1) SetPercentage(value string) {
 	p.Percentage = (*PercentageRate)(&value)
 }
 
+func (p *PaymentObligation1) SetPercentageFromDecimal(value decimal.Decimal) {
+	p.Percentage = new(PercentageRate)
+	p.Percentage.FromDecimal(value)
+}
+
 func (p *PaymentObligation1) SetChargesAmount(value, currency string) {
 	p.ChargesAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentObligation1) SetChargesAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ChargesAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentObligation1) SetChargesPercentage(value string) {
 	p.ChargesPercentage = (*PercentageRate)(&value)
 }
 
+func (p *PaymentObligation1) SetChargesPercentageFromDecimal(value decimal.Decimal) {
+	p.ChargesPercentage = new(PercentageRate)
+	p.ChargesPercentage.FromDecimal(value)
+}
+
 func (p *PaymentObligation1) SetExpiryDate(value string) {
 	p.ExpiryDate = (*ISODate)(&value)
 }
 
+func (p *PaymentObligation1) SetExpiryDateFromTime(value time.Time) {
+	p.ExpiryDate = new(ISODate)
+	p.ExpiryDate.FromTime(value)
+}
+
 func (p *PaymentObligation1) SetApplicableLaw(value string) {
 	p.ApplicableLaw = (*CountryCode)(&value)
 }
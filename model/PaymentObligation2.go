@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Payment obligation contracted between two financial institutions related to the financing of a commercial transaction.
 type PaymentObligation2 struct {
 
@@ -34,6 +38,10 @@ type PaymentObligation2 struct {
 	SettlementTerms *SettlementTerms3 `xml:"SttlmTerms,omitempty"`
 }
 
+func (p *PaymentObligation2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentObligation2) AddObligorBank() *BICIdentification1 {
 	p.ObligorBank = new(BICIdentification1)
 	return p.ObligorBank
@@ -199,6 +207,11 @@ func (p *PaymentObligation2) SetExpiryDate(value string) {
 	p.ExpiryDate = (*ISODate)(&value)
 }
 
+func (p *PaymentObligation2) SetExpiryDateFromTime(value time.Time) {
+	p.ExpiryDate = new(ISODate)
+	p.ExpiryDate.FromTime(value)
+}
+
 func (p *PaymentObligation2) AddApplicableRules() *BPOApplicableRules1Choice {
 	p.ApplicableRules = new(BPOApplicableRules1Choice)
 	return p.ApplicableRules
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the payment terms by means of a code and a period.
 type PaymentPeriod1 struct {
 
@@ -10,6 +14,10 @@ type PaymentPeriod1 struct {
 	NumberOfDays *Number `xml:"NbOfDays,omitempty"`
 }
 
+func (p *PaymentPeriod1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentPeriod1) SetCode(value string) {
 	p.Code = (*PaymentTime1Code)(&value)
 }
@@ -17,3 +25,8 @@ func (p *PaymentPeriod1) SetCode(value string) {
 func (p *PaymentPeriod1) SetNumberOfDays(value string) {
 	p.NumberOfDays = (*Number)(&value)
 }
+
+func (p *PaymentPeriod1) SetNumberOfDaysFromDecimal(value decimal.Decimal) {
+	p.NumberOfDays = new(Number)
+	p.NumberOfDays.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the payment terms by means of a code and a period.
 type PaymentPeriod2 struct {
 
@@ -10,6 +14,10 @@ type PaymentPeriod2 struct {
 	NumberOfDays *Number `xml:"NbOfDays,omitempty"`
 }
 
+func (p *PaymentPeriod2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentPeriod2) SetCode(value string) {
 	p.Code = (*PaymentTime2Code)(&value)
 }
@@ -17,3 +25,8 @@ func (p *PaymentPeriod2) SetCode(value string) {
 func (p *PaymentPeriod2) SetNumberOfDays(value string) {
 	p.NumberOfDays = (*Number)(&value)
 }
+
+func (p *PaymentPeriod2) SetNumberOfDaysFromDecimal(value decimal.Decimal) {
+	p.NumberOfDays = new(Number)
+	p.NumberOfDays.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the payment terms by means of a code and a period.
 type PaymentPeriod4 struct {
 
@@ -10,6 +14,10 @@ type PaymentPeriod4 struct {
 	NumberOfDays *Number `xml:"NbOfDays,omitempty"`
 }
 
+func (p *PaymentPeriod4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentPeriod4) SetCode(value string) {
 	p.Code = (*PaymentTime4Code)(&value)
 }
@@ -17,3 +25,8 @@ func (p *PaymentPeriod4) SetCode(value string) {
 func (p *PaymentPeriod4) SetNumberOfDays(value string) {
 	p.NumberOfDays = (*Number)(&value)
 }
+
+func (p *PaymentPeriod4) SetNumberOfDaysFromDecimal(value decimal.Decimal) {
+	p.NumberOfDays = new(Number)
+	p.NumberOfDays.FromDecimal(value)
+}
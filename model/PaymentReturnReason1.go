@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Provides further details on the reason of the return of the transaction.
 type PaymentReturnReason1 struct {
 
@@ -13,6 +15,10 @@ type PaymentReturnReason1 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PaymentReturnReason1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentReturnReason1) AddOriginator() *PartyIdentification43 {
 	p.Originator = new(PartyIdentification43)
 	return p.Originator
@@ -26,3 +32,55 @@ func (p *PaymentReturnReason1) AddReason() *ReturnReason5Choice {
 func (p *PaymentReturnReason1) AddAdditionalInformation(value string) {
 	p.AdditionalInformation = append(p.AdditionalInformation, (*Max105Text)(&value))
 }
+
+func (p *PaymentReturnReason1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Originator != nil {
+		p.Originator.EncodeXMLFast(enc, "Orgtr")
+	}
+	if p.Reason != nil {
+		p.Reason.EncodeXMLFast(enc, "Rsn")
+	}
+	for _, elem := range p.AdditionalInformation {
+		if elem != nil {
+			enc.Element("AddtlInf", string(*elem))
+		}
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentReturnReason1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Orgtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Originator = elem
+		case "Rsn":
+			elem := new(ReturnReason5Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Reason = elem
+		case "AddtlInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.AdditionalInformation = append(p.AdditionalInformation, (*Max105Text)(&value))
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
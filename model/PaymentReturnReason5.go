@@ -0,0 +1,103 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Provides further details on the reason of the return of the transaction.
+type PaymentReturnReason5 struct {
+
+	// Bank transaction code included in the original entry for the transaction.
+	OriginalBankTransactionCode *BankTransactionCodeStructure4 `xml:"OrgnlBkTxCd,omitempty"`
+
+	// Party that issues the return.
+	Originator *PartyIdentification135 `xml:"Orgtr,omitempty"`
+
+	// Specifies the reason for the return.
+	Reason *ReturnReason5Choice `xml:"Rsn,omitempty"`
+
+	// Further details on the return reason.
+	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
+}
+
+func (p *PaymentReturnReason5) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentReturnReason5) AddOriginalBankTransactionCode() *BankTransactionCodeStructure4 {
+	p.OriginalBankTransactionCode = new(BankTransactionCodeStructure4)
+	return p.OriginalBankTransactionCode
+}
+
+func (p *PaymentReturnReason5) AddOriginator() *PartyIdentification135 {
+	p.Originator = new(PartyIdentification135)
+	return p.Originator
+}
+
+func (p *PaymentReturnReason5) AddReason() *ReturnReason5Choice {
+	p.Reason = new(ReturnReason5Choice)
+	return p.Reason
+}
+
+func (p *PaymentReturnReason5) AddAdditionalInformation(value string) {
+	p.AdditionalInformation = append(p.AdditionalInformation, (*Max105Text)(&value))
+}
+
+func (p *PaymentReturnReason5) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.OriginalBankTransactionCode != nil {
+		p.OriginalBankTransactionCode.EncodeXMLFast(enc, "OrgnlBkTxCd")
+	}
+	if p.Originator != nil {
+		p.Originator.EncodeXMLFast(enc, "Orgtr")
+	}
+	if p.Reason != nil {
+		p.Reason.EncodeXMLFast(enc, "Rsn")
+	}
+	for _, elem := range p.AdditionalInformation {
+		if elem != nil {
+			enc.Element("AddtlInf", string(*elem))
+		}
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentReturnReason5) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "OrgnlBkTxCd":
+			elem := new(BankTransactionCodeStructure4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.OriginalBankTransactionCode = elem
+		case "Orgtr":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Originator = elem
+		case "Rsn":
+			elem := new(ReturnReason5Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Reason = elem
+		case "AddtlInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.AdditionalInformation = append(p.AdditionalInformation, (*Max105Text)(&value))
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
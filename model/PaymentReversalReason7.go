@@ -13,6 +13,10 @@ type PaymentReversalReason7 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PaymentReversalReason7) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentReversalReason7) AddOriginator() *PartyIdentification43 {
 	p.Originator = new(PartyIdentification43)
 	return p.Originator
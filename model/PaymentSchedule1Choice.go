@@ -10,6 +10,10 @@ type PaymentSchedule1Choice struct {
 	SubSchedule []*PaymentDateRange2 `xml:"SubSchdl"`
 }
 
+func (p *PaymentSchedule1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentSchedule1Choice) AddDateRange() *PaymentDateRange1 {
 	p.DateRange = new(PaymentDateRange1)
 	return p.DateRange
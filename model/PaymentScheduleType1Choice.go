@@ -10,6 +10,10 @@ type PaymentScheduleType1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (p *PaymentScheduleType1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentScheduleType1Choice) SetCode(value string) {
 	p.Code = (*PaymentScheduleType1Code)(&value)
 }
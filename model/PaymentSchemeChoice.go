@@ -12,6 +12,10 @@ type PaymentSchemeChoice struct {
 	ProprietaryInformation *Max35Text `xml:"PrtryInf"`
 }
 
+func (p *PaymentSchemeChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentSchemeChoice) SetCode(value string) {
 	p.Code = (*CashClearingSystem2Code)(&value)
 }
@@ -13,6 +13,10 @@ type PaymentTerminalParameters1 struct {
 	OtherParameters *Max10000Binary `xml:"OthrParams,omitempty"`
 }
 
+func (p *PaymentTerminalParameters1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTerminalParameters1) AddClockSynchronisation() *ClockSynchronisation1 {
 	p.ClockSynchronisation = new(ClockSynchronisation1)
 	return p.ClockSynchronisation
@@ -22,6 +22,10 @@ type PaymentTerminalParameters2 struct {
 	OtherParameters *Max10000Binary `xml:"OthrParams,omitempty"`
 }
 
+func (p *PaymentTerminalParameters2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTerminalParameters2) SetVendorIdentification(value string) {
 	p.VendorIdentification = (*Max35Text)(&value)
 }
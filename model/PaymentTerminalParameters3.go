@@ -25,6 +25,10 @@ type PaymentTerminalParameters3 struct {
 	OtherParameters *Max10000Binary `xml:"OthrParams,omitempty"`
 }
 
+func (p *PaymentTerminalParameters3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTerminalParameters3) SetActionType(value string) {
 	p.ActionType = (*TerminalManagementAction3Code)(&value)
 }
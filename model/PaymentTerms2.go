@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the payment terms of the underlying transaction.
 type PaymentTerms2 struct {
 
@@ -16,6 +20,10 @@ type PaymentTerms2 struct {
 	Amount *CurrencyAndAmount `xml:"Amt"`
 }
 
+func (p *PaymentTerms2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTerms2) SetOtherPaymentTerms(value string) {
 	p.OtherPaymentTerms = (*Max140Text)(&value)
 }
@@ -29,6 +37,15 @@ func (p *PaymentTerms2) SetPercentage(value string) {
 	p.Percentage = (*PercentageRate)(&value)
 }
 
+func (p *PaymentTerms2) SetPercentageFromDecimal(value decimal.Decimal) {
+	p.Percentage = new(PercentageRate)
+	p.Percentage.FromDecimal(value)
+}
+
 func (p *PaymentTerms2) SetAmount(value, currency string) {
 	p.Amount = NewCurrencyAndAmount(value, currency)
 }
+
+func (p *PaymentTerms2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
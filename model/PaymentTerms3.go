@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the payment terms of the underlying transaction.
 type PaymentTerms3 struct {
 
@@ -37,10 +43,19 @@ type PaymentTerms3 struct {
 	PenaltyBasisAmount *CurrencyAndAmount `xml:"PnltyBsisAmt,omitempty"`
 }
 
+func (p *PaymentTerms3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTerms3) SetDueDate(value string) {
 	p.DueDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTerms3) SetDueDateFromTime(value time.Time) {
+	p.DueDate = new(ISODate)
+	p.DueDate.FromTime(value)
+}
+
 func (p *PaymentTerms3) AddPaymentPeriod() *PaymentPeriod1 {
 	p.PaymentPeriod = new(PaymentPeriod1)
 	return p.PaymentPeriod
@@ -54,6 +69,11 @@ func (p *PaymentTerms3) SetPartialPaymentPercent(value string) {
 	p.PartialPaymentPercent = (*PercentageRate)(&value)
 }
 
+func (p *PaymentTerms3) SetPartialPaymentPercentFromDecimal(value decimal.Decimal) {
+	p.PartialPaymentPercent = new(PercentageRate)
+	p.PartialPaymentPercent.FromDecimal(value)
+}
+
 func (p *PaymentTerms3) AddDirectDebitMandateIdentification(value string) {
 	p.DirectDebitMandateIdentification = append(p.DirectDebitMandateIdentification, (*Max35Text)(&value))
 }
@@ -62,22 +82,48 @@ func (p *PaymentTerms3) SetDiscountAmount(value, currency string) {
 	p.DiscountAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTerms3) SetDiscountAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.DiscountAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTerms3) SetDiscountPercentRate(value string) {
 	p.DiscountPercentRate = (*PercentageRate)(&value)
 }
 
+func (p *PaymentTerms3) SetDiscountPercentRateFromDecimal(value decimal.Decimal) {
+	p.DiscountPercentRate = new(PercentageRate)
+	p.DiscountPercentRate.FromDecimal(value)
+}
+
 func (p *PaymentTerms3) SetDiscountBasisAmount(value, currency string) {
 	p.DiscountBasisAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTerms3) SetDiscountBasisAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.DiscountBasisAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTerms3) SetPenaltyAmount(value, currency string) {
 	p.PenaltyAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTerms3) SetPenaltyAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.PenaltyAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTerms3) SetPenaltyPercentRate(value string) {
 	p.PenaltyPercentRate = (*PercentageRate)(&value)
 }
 
+func (p *PaymentTerms3) SetPenaltyPercentRateFromDecimal(value decimal.Decimal) {
+	p.PenaltyPercentRate = new(PercentageRate)
+	p.PenaltyPercentRate.FromDecimal(value)
+}
+
 func (p *PaymentTerms3) SetPenaltyBasisAmount(value, currency string) {
 	p.PenaltyBasisAmount = NewCurrencyAndAmount(value, currency)
 }
+
+func (p *PaymentTerms3) SetPenaltyBasisAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.PenaltyBasisAmount = NewCurrencyAndAmount(value.String(), currency)
+}
@@ -10,6 +10,10 @@ type PaymentTerms4 struct {
 	AmountOrPercentage *AmountOrPercentage2Choice `xml:"AmtOrPctg"`
 }
 
+func (p *PaymentTerms4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTerms4) AddPaymentTerms() *PaymentCodeOrOther1Choice {
 	p.PaymentTerms = new(PaymentCodeOrOther1Choice)
 	return p.PaymentTerms
@@ -10,6 +10,10 @@ type PaymentTerms5 struct {
 	AmountOrPercentage *AmountOrPercentage2Choice `xml:"AmtOrPctg"`
 }
 
+func (p *PaymentTerms5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTerms5) AddPaymentTerms() *PaymentCodeOrOther2Choice {
 	p.PaymentTerms = new(PaymentCodeOrOther2Choice)
 	return p.PaymentTerms
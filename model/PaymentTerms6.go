@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the payment terms of the underlying transaction.
 type PaymentTerms6 struct {
 
@@ -34,10 +40,19 @@ type PaymentTerms6 struct {
 	PenaltyPercentRate *PercentageRate `xml:"PnltyPctRate,omitempty"`
 }
 
+func (p *PaymentTerms6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTerms6) SetDueDate(value string) {
 	p.DueDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTerms6) SetDueDateFromTime(value time.Time) {
+	p.DueDate = new(ISODate)
+	p.DueDate.FromTime(value)
+}
+
 func (p *PaymentTerms6) AddPaymentPeriod() *PaymentPeriod1 {
 	p.PaymentPeriod = new(PaymentPeriod1)
 	return p.PaymentPeriod
@@ -51,6 +66,11 @@ func (p *PaymentTerms6) SetPartialPaymentPercent(value string) {
 	p.PartialPaymentPercent = (*PercentageRate)(&value)
 }
 
+func (p *PaymentTerms6) SetPartialPaymentPercentFromDecimal(value decimal.Decimal) {
+	p.PartialPaymentPercent = new(PercentageRate)
+	p.PartialPaymentPercent.FromDecimal(value)
+}
+
 func (p *PaymentTerms6) AddDirectDebitMandateIdentification(value string) {
 	p.DirectDebitMandateIdentification = append(p.DirectDebitMandateIdentification, (*Max35Text)(&value))
 }
@@ -59,18 +79,40 @@ func (p *PaymentTerms6) SetBasisAmount(value, currency string) {
 	p.BasisAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTerms6) SetBasisAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.BasisAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTerms6) SetDiscountAmount(value, currency string) {
 	p.DiscountAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTerms6) SetDiscountAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.DiscountAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTerms6) SetDiscountPercentRate(value string) {
 	p.DiscountPercentRate = (*PercentageRate)(&value)
 }
 
+func (p *PaymentTerms6) SetDiscountPercentRateFromDecimal(value decimal.Decimal) {
+	p.DiscountPercentRate = new(PercentageRate)
+	p.DiscountPercentRate.FromDecimal(value)
+}
+
 func (p *PaymentTerms6) SetPenaltyAmount(value, currency string) {
 	p.PenaltyAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTerms6) SetPenaltyAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.PenaltyAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTerms6) SetPenaltyPercentRate(value string) {
 	p.PenaltyPercentRate = (*PercentageRate)(&value)
 }
+
+func (p *PaymentTerms6) SetPenaltyPercentRateFromDecimal(value decimal.Decimal) {
+	p.PenaltyPercentRate = new(PercentageRate)
+	p.PenaltyPercentRate.FromDecimal(value)
+}
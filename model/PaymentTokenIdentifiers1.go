@@ -10,6 +10,10 @@ type PaymentTokenIdentifiers1 struct {
 	RequestorIdentification *Max35Text `xml:"RqstrId"`
 }
 
+func (p *PaymentTokenIdentifiers1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTokenIdentifiers1) SetProviderIdentification(value string) {
 	p.ProviderIdentification = (*Max35Text)(&value)
 }
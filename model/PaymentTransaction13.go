@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Payment processes required to transfer cash from the debtor to the creditor.
 type PaymentTransaction13 struct {
 
@@ -13,14 +19,27 @@ type PaymentTransaction13 struct {
 	PaymentInstrument *PaymentInstrument6Choice `xml:"PmtInstrm,omitempty"`
 }
 
+func (p *PaymentTransaction13) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction13) SetSettlementAmount(value, currency string) {
 	p.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction13) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction13) SetSettlementDate(value string) {
 	p.SettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction13) SetSettlementDateFromTime(value time.Time) {
+	p.SettlementDate = new(ISODate)
+	p.SettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransaction13) AddPaymentInstrument() *PaymentInstrument6Choice {
 	p.PaymentInstrument = new(PaymentInstrument6Choice)
 	return p.PaymentInstrument
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Payment processes required to transfer cash from the debtor to the creditor.
 type PaymentTransaction14 struct {
 
@@ -13,14 +19,27 @@ type PaymentTransaction14 struct {
 	CashInOrOutChoice *CashInOrOut3Choice `xml:"CshInOrOutChc,omitempty"`
 }
 
+func (p *PaymentTransaction14) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction14) SetSettlementAmount(value, currency string) {
 	p.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction14) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction14) SetSettlementDate(value string) {
 	p.SettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction14) SetSettlementDateFromTime(value time.Time) {
+	p.SettlementDate = new(ISODate)
+	p.SettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransaction14) AddCashInOrOutChoice() *CashInOrOut3Choice {
 	p.CashInOrOutChoice = new(CashInOrOut3Choice)
 	return p.CashInOrOutChoice
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Payment processes required to transfer cash from the debtor to the creditor.
 type PaymentTransaction16 struct {
 
@@ -13,14 +19,27 @@ type PaymentTransaction16 struct {
 	PaymentInstrument *PaymentInstrument8Choice `xml:"PmtInstrm,omitempty"`
 }
 
+func (p *PaymentTransaction16) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction16) SetSettlementAmount(value, currency string) {
 	p.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction16) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction16) SetSettlementDate(value string) {
 	p.SettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction16) SetSettlementDateFromTime(value time.Time) {
+	p.SettlementDate = new(ISODate)
+	p.SettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransaction16) AddPaymentInstrument() *PaymentInstrument8Choice {
 	p.PaymentInstrument = new(PaymentInstrument8Choice)
 	return p.PaymentInstrument
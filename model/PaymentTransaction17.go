@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Payment processes required to transfer cash from the debtor to the creditor.
 type PaymentTransaction17 struct {
 
@@ -13,14 +19,27 @@ type PaymentTransaction17 struct {
 	PaymentInstrument *PaymentInstrument9Choice `xml:"PmtInstrm,omitempty"`
 }
 
+func (p *PaymentTransaction17) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction17) SetSettlementAmount(value, currency string) {
 	p.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction17) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction17) SetSettlementDate(value string) {
 	p.SettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction17) SetSettlementDateFromTime(value time.Time) {
+	p.SettlementDate = new(ISODate)
+	p.SettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransaction17) AddPaymentInstrument() *PaymentInstrument9Choice {
 	p.PaymentInstrument = new(PaymentInstrument9Choice)
 	return p.PaymentInstrument
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Payment processes required to transfer cash from the debtor to the creditor.
 type PaymentTransaction18 struct {
 
@@ -13,14 +19,27 @@ type PaymentTransaction18 struct {
 	PaymentInstrument *PaymentInstrument7Choice `xml:"PmtInstrm"`
 }
 
+func (p *PaymentTransaction18) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction18) SetSettlementAmount(value, currency string) {
 	p.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction18) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction18) SetSettlementDate(value string) {
 	p.SettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction18) SetSettlementDateFromTime(value time.Time) {
+	p.SettlementDate = new(ISODate)
+	p.SettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransaction18) AddPaymentInstrument() *PaymentInstrument7Choice {
 	p.PaymentInstrument = new(PaymentInstrument7Choice)
 	return p.PaymentInstrument
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Payment processes required to transfer cash from the debtor to the creditor.
 type PaymentTransaction19 struct {
 
@@ -13,14 +19,27 @@ type PaymentTransaction19 struct {
 	PaymentInstrument *PaymentInstrument10Choice `xml:"PmtInstrm,omitempty"`
 }
 
+func (p *PaymentTransaction19) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction19) SetSettlementAmount(value, currency string) {
 	p.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction19) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction19) SetSettlementDate(value string) {
 	p.SettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction19) SetSettlementDateFromTime(value time.Time) {
+	p.SettlementDate = new(ISODate)
+	p.SettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransaction19) AddPaymentInstrument() *PaymentInstrument10Choice {
 	p.PaymentInstrument = new(PaymentInstrument10Choice)
 	return p.PaymentInstrument
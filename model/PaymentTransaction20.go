@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Payment processes required to transfer cash from the debtor to the creditor.
 type PaymentTransaction20 struct {
 
@@ -13,14 +19,27 @@ type PaymentTransaction20 struct {
 	CashInOrOut *CashInOrOut4Choice `xml:"CshInOrOut"`
 }
 
+func (p *PaymentTransaction20) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction20) SetSettlementAmount(value, currency string) {
 	p.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction20) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction20) SetSettlementDate(value string) {
 	p.SettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction20) SetSettlementDateFromTime(value time.Time) {
+	p.SettlementDate = new(ISODate)
+	p.SettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransaction20) AddCashInOrOut() *CashInOrOut4Choice {
 	p.CashInOrOut = new(CashInOrOut4Choice)
 	return p.CashInOrOut
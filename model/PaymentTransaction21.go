@@ -7,6 +7,10 @@ type PaymentTransaction21 struct {
 	PaymentInstrument *PaymentInstrument11Choice `xml:"PmtInstrm,omitempty"`
 }
 
+func (p *PaymentTransaction21) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction21) AddPaymentInstrument() *PaymentInstrument11Choice {
 	p.PaymentInstrument = new(PaymentInstrument11Choice)
 	return p.PaymentInstrument
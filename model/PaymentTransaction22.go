@@ -7,6 +7,10 @@ type PaymentTransaction22 struct {
 	PaymentInstrument *PaymentInstrument11Choice `xml:"PmtInstrm,omitempty"`
 }
 
+func (p *PaymentTransaction22) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction22) AddPaymentInstrument() *PaymentInstrument11Choice {
 	p.PaymentInstrument = new(PaymentInstrument11Choice)
 	return p.PaymentInstrument
@@ -7,6 +7,10 @@ type PaymentTransaction24 struct {
 	PaymentInstrument *PaymentInstrument12Choice `xml:"PmtInstrm,omitempty"`
 }
 
+func (p *PaymentTransaction24) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction24) AddPaymentInstrument() *PaymentInstrument12Choice {
 	p.PaymentInstrument = new(PaymentInstrument12Choice)
 	return p.PaymentInstrument
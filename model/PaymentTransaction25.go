@@ -7,6 +7,10 @@ type PaymentTransaction25 struct {
 	CashInOrOut *CashInOrOut5Choice `xml:"CshInOrOut,omitempty"`
 }
 
+func (p *PaymentTransaction25) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction25) AddCashInOrOut() *CashInOrOut5Choice {
 	p.CashInOrOut = new(CashInOrOut5Choice)
 	return p.CashInOrOut
@@ -7,6 +7,10 @@ type PaymentTransaction26 struct {
 	CashInOrOut *CashInOrOut6Choice `xml:"CshInOrOut,omitempty"`
 }
 
+func (p *PaymentTransaction26) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction26) AddCashInOrOut() *CashInOrOut6Choice {
 	p.CashInOrOut = new(CashInOrOut6Choice)
 	return p.CashInOrOut
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details on the original transactions, to which the status report message refers.
 type PaymentTransaction33 struct {
 
@@ -50,6 +54,10 @@ type PaymentTransaction33 struct {
 	OriginalTransactionReference *OriginalTransactionReference16 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransaction33) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction33) SetStatusIdentification(value string) {
 	p.StatusIdentification = (*Max35Text)(&value)
 }
@@ -66,8 +74,12 @@ func (p *PaymentTransaction33) SetOriginalTransactionIdentification(value string
 	p.OriginalTransactionIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentTransaction33) SetTransactionStatus(value string) {
+func (p *PaymentTransaction33) SetTransactionStatus(value string) error {
+	if err := TransactionIndividualStatus3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionStatus = (*TransactionIndividualStatus3Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction33) AddStatusReasonInformation() *StatusReasonInformation9 {
@@ -366,6 +378,11 @@ func (p *PaymentTransaction33) SetAcceptanceDateTime(value string) {
 	p.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (p *PaymentTransaction33) SetAcceptanceDateTimeFromTime(value time.Time) {
+	p.AcceptanceDateTime = new(ISODateTime)
+	p.AcceptanceDateTime.FromTime(value)
+}
+
 func (p *PaymentTransaction33) SetAccountServicerReference(value string) {
 	p.AccountServicerReference = (*Max35Text)(&value)
 }
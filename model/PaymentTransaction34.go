@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details on the reference and status on the original transactions, included in the original instruction, to which the return message applies.
 type PaymentTransaction34 struct {
 
@@ -67,6 +73,10 @@ type PaymentTransaction34 struct {
 	OriginalTransactionReference *OriginalTransactionReference16 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransaction34) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction34) SetReturnIdentification(value string) {
 	p.ReturnIdentification = (*Max35Text)(&value)
 }
@@ -96,28 +106,58 @@ func (p *PaymentTransaction34) SetOriginalInterbankSettlementAmount(value, curre
 	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction34) SetOriginalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction34) SetReturnedInterbankSettlementAmount(value, currency string) {
 	p.ReturnedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction34) SetReturnedInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReturnedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction34) SetInterbankSettlementDate(value string) {
 	p.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction34) SetInterbankSettlementDateFromTime(value time.Time) {
+	p.InterbankSettlementDate = new(ISODate)
+	p.InterbankSettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransaction34) SetReturnedInstructedAmount(value, currency string) {
 	p.ReturnedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction34) SetReturnedInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReturnedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction34) SetExchangeRate(value string) {
 	p.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (p *PaymentTransaction34) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	p.ExchangeRate = new(BaseOneRate)
+	p.ExchangeRate.FromDecimal(value)
+}
+
 func (p *PaymentTransaction34) SetCompensationAmount(value, currency string) {
 	p.CompensationAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (p *PaymentTransaction34) SetChargeBearer(value string) {
+func (p *PaymentTransaction34) SetCompensationAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.CompensationAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (p *PaymentTransaction34) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction34) AddChargesInformation() *Charges2 {
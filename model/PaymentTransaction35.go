@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details on the reference and status on the original transactions, included in the original instruction, to which the reversal message applies.
 type PaymentTransaction35 struct {
 
@@ -31,6 +35,10 @@ type PaymentTransaction35 struct {
 	OriginalTransactionReference *OriginalTransactionReference16 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransaction35) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction35) SetReversalIdentification(value string) {
 	p.ReversalIdentification = (*Max35Text)(&value)
 }
@@ -47,12 +55,24 @@ func (p *PaymentTransaction35) SetOriginalInstructedAmount(value, currency strin
 	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction35) SetOriginalInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction35) SetReversedInstructedAmount(value, currency string) {
 	p.ReversedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (p *PaymentTransaction35) SetChargeBearer(value string) {
+func (p *PaymentTransaction35) SetReversedInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReversedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (p *PaymentTransaction35) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction35) AddReversalReasonInformation() *PaymentReversalReason7 {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details on the reference and status on the original transactions, included in the original instruction, to which the reversal message applies.
 type PaymentTransaction36 struct {
 
@@ -64,6 +70,10 @@ type PaymentTransaction36 struct {
 	OriginalTransactionReference *OriginalTransactionReference16 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransaction36) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction36) SetReversalIdentification(value string) {
 	p.ReversalIdentification = (*Max35Text)(&value)
 }
@@ -88,28 +98,58 @@ func (p *PaymentTransaction36) SetOriginalInterbankSettlementAmount(value, curre
 	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction36) SetOriginalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction36) SetReversedInterbankSettlementAmount(value, currency string) {
 	p.ReversedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction36) SetReversedInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReversedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction36) SetInterbankSettlementDate(value string) {
 	p.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction36) SetInterbankSettlementDateFromTime(value time.Time) {
+	p.InterbankSettlementDate = new(ISODate)
+	p.InterbankSettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransaction36) SetReversedInstructedAmount(value, currency string) {
 	p.ReversedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction36) SetReversedInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReversedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction36) SetExchangeRate(value string) {
 	p.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (p *PaymentTransaction36) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	p.ExchangeRate = new(BaseOneRate)
+	p.ExchangeRate.FromDecimal(value)
+}
+
 func (p *PaymentTransaction36) SetCompensationAmount(value, currency string) {
 	p.CompensationAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (p *PaymentTransaction36) SetChargeBearer(value string) {
+func (p *PaymentTransaction36) SetCompensationAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.CompensationAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (p *PaymentTransaction36) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction36) AddChargesInformation() *Charges2 {
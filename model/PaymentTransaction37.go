@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide reference and status information on the original transactions, included in the original instruction, to which the cancellation request message applies.
 type PaymentTransaction37 struct {
 
@@ -33,6 +39,10 @@ type PaymentTransaction37 struct {
 	OriginalTransactionReference *OriginalTransactionReference16 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransaction37) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction37) SetCancellationIdentification(value string) {
 	p.CancellationIdentification = (*Max35Text)(&value)
 }
@@ -54,14 +64,28 @@ func (p *PaymentTransaction37) SetOriginalInstructedAmount(value, currency strin
 	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction37) SetOriginalInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction37) SetOriginalRequestedExecutionDate(value string) {
 	p.OriginalRequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction37) SetOriginalRequestedExecutionDateFromTime(value time.Time) {
+	p.OriginalRequestedExecutionDate = new(ISODate)
+	p.OriginalRequestedExecutionDate.FromTime(value)
+}
+
 func (p *PaymentTransaction37) SetOriginalRequestedCollectionDate(value string) {
 	p.OriginalRequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction37) SetOriginalRequestedCollectionDateFromTime(value time.Time) {
+	p.OriginalRequestedCollectionDate = new(ISODate)
+	p.OriginalRequestedCollectionDate.FromTime(value)
+}
+
 func (p *PaymentTransaction37) AddCancellationReasonInformation() *PaymentCancellationReason2 {
 	newValue := new(PaymentCancellationReason2)
 	p.CancellationReasonInformation = append(p.CancellationReasonInformation, newValue)
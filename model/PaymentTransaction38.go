@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides reference and status information on the original transactions, included in the original instruction, to which the cancellation request message applies.
 type PaymentTransaction38 struct {
 
@@ -47,6 +53,10 @@ type PaymentTransaction38 struct {
 	OriginalTransactionReference *OriginalTransactionReference16 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransaction38) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction38) SetCancellationIdentification(value string) {
 	p.CancellationIdentification = (*Max35Text)(&value)
 }
@@ -81,10 +91,19 @@ func (p *PaymentTransaction38) SetOriginalInterbankSettlementAmount(value, curre
 	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction38) SetOriginalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction38) SetOriginalInterbankSettlementDate(value string) {
 	p.OriginalInterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction38) SetOriginalInterbankSettlementDateFromTime(value time.Time) {
+	p.OriginalInterbankSettlementDate = new(ISODate)
+	p.OriginalInterbankSettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransaction38) AddAssigner() *BranchAndFinancialInstitutionIdentification5 {
 	p.Assigner = new(BranchAndFinancialInstitutionIdentification5)
 	return p.Assigner
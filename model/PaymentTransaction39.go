@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides reference and status information on the original transactions, included in the original instruction, to which the cancellation request message applies.
 type PaymentTransaction39 struct {
 
@@ -36,6 +42,10 @@ type PaymentTransaction39 struct {
 	OriginalTransactionReference *OriginalTransactionReference16 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransaction39) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction39) SetCancellationStatusIdentification(value string) {
 	p.CancellationStatusIdentification = (*Max35Text)(&value)
 }
@@ -53,8 +63,12 @@ func (p *PaymentTransaction39) SetOriginalEndToEndIdentification(value string) {
 	p.OriginalEndToEndIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentTransaction39) SetTransactionCancellationStatus(value string) {
+func (p *PaymentTransaction39) SetTransactionCancellationStatus(value string) error {
+	if err := CancellationIndividualStatus1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionCancellationStatus = (*CancellationIndividualStatus1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction39) AddCancellationStatusReasonInformation() *CancellationStatusReason2 {
@@ -207,14 +221,28 @@ func (p *PaymentTransaction39) SetOriginalInstructedAmount(value, currency strin
 	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction39) SetOriginalInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction39) SetOriginalRequestedExecutionDate(value string) {
 	p.OriginalRequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction39) SetOriginalRequestedExecutionDateFromTime(value time.Time) {
+	p.OriginalRequestedExecutionDate = new(ISODate)
+	p.OriginalRequestedExecutionDate.FromTime(value)
+}
+
 func (p *PaymentTransaction39) SetOriginalRequestedCollectionDate(value string) {
 	p.OriginalRequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction39) SetOriginalRequestedCollectionDateFromTime(value time.Time) {
+	p.OriginalRequestedCollectionDate = new(ISODate)
+	p.OriginalRequestedCollectionDate.FromTime(value)
+}
+
 func (p *PaymentTransaction39) AddOriginalTransactionReference() *OriginalTransactionReference16 {
 	p.OriginalTransactionReference = new(OriginalTransactionReference16)
 	return p.OriginalTransactionReference
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details on the original transactions, to which the status report message refers.
 type PaymentTransaction41 struct {
 
@@ -36,6 +40,10 @@ type PaymentTransaction41 struct {
 	OriginalTransactionReference *OriginalTransactionReference17 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransaction41) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction41) SetStatusIdentification(value string) {
 	p.StatusIdentification = (*Max35Text)(&value)
 }
@@ -48,8 +56,12 @@ func (p *PaymentTransaction41) SetOriginalEndToEndIdentification(value string) {
 	p.OriginalEndToEndIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentTransaction41) SetTransactionStatus(value string) {
+func (p *PaymentTransaction41) SetTransactionStatus(value string) error {
+	if err := TransactionIndividualStatus3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionStatus = (*TransactionIndividualStatus3Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction41) AddStatusReasonInformation() *StatusReasonInformation9 {
@@ -348,6 +360,11 @@ func (p *PaymentTransaction41) SetAcceptanceDateTime(value string) {
 	p.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (p *PaymentTransaction41) SetAcceptanceDateTimeFromTime(value time.Time) {
+	p.AcceptanceDateTime = new(ISODateTime)
+	p.AcceptanceDateTime.FromTime(value)
+}
+
 func (p *PaymentTransaction41) SetAccountServicerReference(value string) {
 	p.AccountServicerReference = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details on the reference and status on the original transactions, included in the original instruction, to which the reversal message applies.
 type PaymentTransaction42 struct {
 
@@ -34,6 +38,10 @@ type PaymentTransaction42 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentTransaction42) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction42) SetReversalIdentification(value string) {
 	p.ReversalIdentification = (*Max35Text)(&value)
 }
@@ -50,12 +58,24 @@ func (p *PaymentTransaction42) SetOriginalInstructedAmount(value, currency strin
 	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction42) SetOriginalInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction42) SetReversedInstructedAmount(value, currency string) {
 	p.ReversedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (p *PaymentTransaction42) SetChargeBearer(value string) {
+func (p *PaymentTransaction42) SetReversedInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReversedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (p *PaymentTransaction42) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction42) AddReversalReasonInformation() *PaymentReversalReason7 {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details on the original transactions, to which the status report message refers.
 type PaymentTransaction43 struct {
 
@@ -53,6 +57,10 @@ type PaymentTransaction43 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentTransaction43) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction43) SetStatusIdentification(value string) {
 	p.StatusIdentification = (*Max35Text)(&value)
 }
@@ -69,8 +77,12 @@ func (p *PaymentTransaction43) SetOriginalTransactionIdentification(value string
 	p.OriginalTransactionIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentTransaction43) SetTransactionStatus(value string) {
+func (p *PaymentTransaction43) SetTransactionStatus(value string) error {
+	if err := TransactionIndividualStatus3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionStatus = (*TransactionIndividualStatus3Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction43) AddStatusReasonInformation() *StatusReasonInformation9 {
@@ -369,6 +381,11 @@ func (p *PaymentTransaction43) SetAcceptanceDateTime(value string) {
 	p.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (p *PaymentTransaction43) SetAcceptanceDateTimeFromTime(value time.Time) {
+	p.AcceptanceDateTime = new(ISODateTime)
+	p.AcceptanceDateTime.FromTime(value)
+}
+
 func (p *PaymentTransaction43) SetAccountServicerReference(value string) {
 	p.AccountServicerReference = (*Max35Text)(&value)
 }
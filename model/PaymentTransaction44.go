@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details on the reference and status on the original transactions, included in the original instruction, to which the return message applies.
 type PaymentTransaction44 struct {
 
@@ -70,6 +76,10 @@ type PaymentTransaction44 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentTransaction44) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction44) SetReturnIdentification(value string) {
 	p.ReturnIdentification = (*Max35Text)(&value)
 }
@@ -99,28 +109,58 @@ func (p *PaymentTransaction44) SetOriginalInterbankSettlementAmount(value, curre
 	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction44) SetOriginalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction44) SetReturnedInterbankSettlementAmount(value, currency string) {
 	p.ReturnedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction44) SetReturnedInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReturnedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction44) SetInterbankSettlementDate(value string) {
 	p.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction44) SetInterbankSettlementDateFromTime(value time.Time) {
+	p.InterbankSettlementDate = new(ISODate)
+	p.InterbankSettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransaction44) SetReturnedInstructedAmount(value, currency string) {
 	p.ReturnedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction44) SetReturnedInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReturnedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction44) SetExchangeRate(value string) {
 	p.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (p *PaymentTransaction44) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	p.ExchangeRate = new(BaseOneRate)
+	p.ExchangeRate.FromDecimal(value)
+}
+
 func (p *PaymentTransaction44) SetCompensationAmount(value, currency string) {
 	p.CompensationAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (p *PaymentTransaction44) SetChargeBearer(value string) {
+func (p *PaymentTransaction44) SetCompensationAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.CompensationAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (p *PaymentTransaction44) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction44) AddChargesInformation() *Charges2 {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details on the reference and status on the original transactions, included in the original instruction, to which the reversal message applies.
 type PaymentTransaction45 struct {
 
@@ -67,6 +73,10 @@ type PaymentTransaction45 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentTransaction45) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction45) SetReversalIdentification(value string) {
 	p.ReversalIdentification = (*Max35Text)(&value)
 }
@@ -91,28 +101,58 @@ func (p *PaymentTransaction45) SetOriginalInterbankSettlementAmount(value, curre
 	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction45) SetOriginalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction45) SetReversedInterbankSettlementAmount(value, currency string) {
 	p.ReversedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction45) SetReversedInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReversedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction45) SetInterbankSettlementDate(value string) {
 	p.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction45) SetInterbankSettlementDateFromTime(value time.Time) {
+	p.InterbankSettlementDate = new(ISODate)
+	p.InterbankSettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransaction45) SetReversedInstructedAmount(value, currency string) {
 	p.ReversedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction45) SetReversedInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReversedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction45) SetExchangeRate(value string) {
 	p.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (p *PaymentTransaction45) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	p.ExchangeRate = new(BaseOneRate)
+	p.ExchangeRate.FromDecimal(value)
+}
+
 func (p *PaymentTransaction45) SetCompensationAmount(value, currency string) {
 	p.CompensationAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (p *PaymentTransaction45) SetChargeBearer(value string) {
+func (p *PaymentTransaction45) SetCompensationAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.CompensationAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (p *PaymentTransaction45) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction45) AddChargesInformation() *Charges2 {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details on the original transactions, to which the status report message refers.
 type PaymentTransaction46 struct {
 
@@ -39,6 +43,10 @@ type PaymentTransaction46 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentTransaction46) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction46) SetStatusIdentification(value string) {
 	p.StatusIdentification = (*Max35Text)(&value)
 }
@@ -51,8 +59,12 @@ func (p *PaymentTransaction46) SetOriginalEndToEndIdentification(value string) {
 	p.OriginalEndToEndIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentTransaction46) SetTransactionStatus(value string) {
+func (p *PaymentTransaction46) SetTransactionStatus(value string) error {
+	if err := TransactionIndividualStatus3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionStatus = (*TransactionIndividualStatus3Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction46) AddStatusReasonInformation() *StatusReasonInformation9 {
@@ -351,6 +363,11 @@ func (p *PaymentTransaction46) SetAcceptanceDateTime(value string) {
 	p.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (p *PaymentTransaction46) SetAcceptanceDateTimeFromTime(value time.Time) {
+	p.AcceptanceDateTime = new(ISODateTime)
+	p.AcceptanceDateTime.FromTime(value)
+}
+
 func (p *PaymentTransaction46) SetAccountServicerReference(value string) {
 	p.AccountServicerReference = (*Max35Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides reference and status information on the original transactions, included in the original instruction, to which the cancellation request message applies.
 type PaymentTransaction48 struct {
 
@@ -50,6 +56,10 @@ type PaymentTransaction48 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentTransaction48) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction48) SetCancellationIdentification(value string) {
 	p.CancellationIdentification = (*Max35Text)(&value)
 }
@@ -84,10 +94,19 @@ func (p *PaymentTransaction48) SetOriginalInterbankSettlementAmount(value, curre
 	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction48) SetOriginalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction48) SetOriginalInterbankSettlementDate(value string) {
 	p.OriginalInterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction48) SetOriginalInterbankSettlementDateFromTime(value time.Time) {
+	p.OriginalInterbankSettlementDate = new(ISODate)
+	p.OriginalInterbankSettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransaction48) AddAssigner() *BranchAndFinancialInstitutionIdentification5 {
 	p.Assigner = new(BranchAndFinancialInstitutionIdentification5)
 	return p.Assigner
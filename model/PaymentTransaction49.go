@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details on the original transactions, to which the status report message refers.
 type PaymentTransaction49 struct {
 
@@ -39,6 +43,10 @@ type PaymentTransaction49 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentTransaction49) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction49) SetStatusIdentification(value string) {
 	p.StatusIdentification = (*Max35Text)(&value)
 }
@@ -51,8 +59,12 @@ func (p *PaymentTransaction49) SetOriginalEndToEndIdentification(value string) {
 	p.OriginalEndToEndIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentTransaction49) SetTransactionStatus(value string) {
+func (p *PaymentTransaction49) SetTransactionStatus(value string) error {
+	if err := TransactionIndividualStatus3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionStatus = (*TransactionIndividualStatus3Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction49) AddStatusReasonInformation() *StatusReasonInformation9 {
@@ -351,6 +363,11 @@ func (p *PaymentTransaction49) SetAcceptanceDateTime(value string) {
 	p.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (p *PaymentTransaction49) SetAcceptanceDateTimeFromTime(value time.Time) {
+	p.AcceptanceDateTime = new(ISODateTime)
+	p.AcceptanceDateTime.FromTime(value)
+}
+
 func (p *PaymentTransaction49) SetAccountServicerReference(value string) {
 	p.AccountServicerReference = (*Max35Text)(&value)
 }
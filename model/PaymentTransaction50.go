@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details on the reference and status on the original transactions, included in the original instruction, to which the return message applies.
 type PaymentTransaction50 struct {
 
@@ -77,6 +83,10 @@ type PaymentTransaction50 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentTransaction50) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction50) SetReturnIdentification(value string) {
 	p.ReturnIdentification = (*Max35Text)(&value)
 }
@@ -106,32 +116,66 @@ func (p *PaymentTransaction50) SetOriginalInterbankSettlementAmount(value, curre
 	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction50) SetOriginalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction50) SetReturnedInterbankSettlementAmount(value, currency string) {
 	p.ReturnedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction50) SetReturnedInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReturnedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction50) SetInterbankSettlementDate(value string) {
 	p.InterbankSettlementDate = (*ISODate)(&value)
 }
 
-func (p *PaymentTransaction50) SetSettlementPriority(value string) {
+func (p *PaymentTransaction50) SetInterbankSettlementDateFromTime(value time.Time) {
+	p.InterbankSettlementDate = new(ISODate)
+	p.InterbankSettlementDate.FromTime(value)
+}
+
+func (p *PaymentTransaction50) SetSettlementPriority(value string) error {
+	if err := Priority3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.SettlementPriority = (*Priority3Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction50) SetReturnedInstructedAmount(value, currency string) {
 	p.ReturnedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction50) SetReturnedInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReturnedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction50) SetExchangeRate(value string) {
 	p.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (p *PaymentTransaction50) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	p.ExchangeRate = new(BaseOneRate)
+	p.ExchangeRate.FromDecimal(value)
+}
+
 func (p *PaymentTransaction50) SetCompensationAmount(value, currency string) {
 	p.CompensationAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (p *PaymentTransaction50) SetChargeBearer(value string) {
+func (p *PaymentTransaction50) SetCompensationAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.CompensationAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (p *PaymentTransaction50) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction50) AddChargesInformation() *Charges2 {
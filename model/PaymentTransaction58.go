@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide reference and status information on the original transactions, included in the original instruction, to which the cancellation request message applies.
 type PaymentTransaction58 struct {
 
@@ -38,6 +44,10 @@ type PaymentTransaction58 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentTransaction58) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction58) SetCancellationIdentification(value string) {
 	p.CancellationIdentification = (*Max35Text)(&value)
 }
@@ -59,14 +69,28 @@ func (p *PaymentTransaction58) SetOriginalInstructedAmount(value, currency strin
 	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction58) SetOriginalInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction58) SetOriginalRequestedExecutionDate(value string) {
 	p.OriginalRequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction58) SetOriginalRequestedExecutionDateFromTime(value time.Time) {
+	p.OriginalRequestedExecutionDate = new(ISODate)
+	p.OriginalRequestedExecutionDate.FromTime(value)
+}
+
 func (p *PaymentTransaction58) SetOriginalRequestedCollectionDate(value string) {
 	p.OriginalRequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction58) SetOriginalRequestedCollectionDateFromTime(value time.Time) {
+	p.OriginalRequestedCollectionDate = new(ISODate)
+	p.OriginalRequestedCollectionDate.FromTime(value)
+}
+
 func (p *PaymentTransaction58) AddCancellationReasonInformation() *PaymentCancellationReason2 {
 	newValue := new(PaymentCancellationReason2)
 	p.CancellationReasonInformation = append(p.CancellationReasonInformation, newValue)
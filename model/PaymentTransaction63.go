@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details on the original transactions, to which the status report message refers.
 type PaymentTransaction63 struct {
 
@@ -56,6 +60,10 @@ type PaymentTransaction63 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentTransaction63) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction63) SetStatusIdentification(value string) {
 	p.StatusIdentification = (*Max35Text)(&value)
 }
@@ -77,8 +85,12 @@ func (p *PaymentTransaction63) SetOriginalTransactionIdentification(value string
 	p.OriginalTransactionIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentTransaction63) SetTransactionStatus(value string) {
+func (p *PaymentTransaction63) SetTransactionStatus(value string) error {
+	if err := TransactionIndividualStatus3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionStatus = (*TransactionIndividualStatus3Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction63) AddStatusReasonInformation() *StatusReasonInformation9 {
@@ -377,6 +389,11 @@ func (p *PaymentTransaction63) SetAcceptanceDateTime(value string) {
 	p.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (p *PaymentTransaction63) SetAcceptanceDateTimeFromTime(value time.Time) {
+	p.AcceptanceDateTime = new(ISODateTime)
+	p.AcceptanceDateTime.FromTime(value)
+}
+
 func (p *PaymentTransaction63) SetAccountServicerReference(value string) {
 	p.AccountServicerReference = (*Max35Text)(&value)
 }
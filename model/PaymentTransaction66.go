@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides reference and status information on the original transactions, included in the original instruction, to which the cancellation request message applies.
 type PaymentTransaction66 struct {
 
@@ -37,6 +43,10 @@ type PaymentTransaction66 struct {
 	OriginalTransactionReference *OriginalTransactionReference22 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransaction66) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction66) SetCancellationStatusIdentification(value string) {
 	p.CancellationStatusIdentification = (*Max35Text)(&value)
 }
@@ -54,8 +64,12 @@ func (p *PaymentTransaction66) SetOriginalEndToEndIdentification(value string) {
 	p.OriginalEndToEndIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentTransaction66) SetTransactionCancellationStatus(value string) {
+func (p *PaymentTransaction66) SetTransactionCancellationStatus(value string) error {
+	if err := CancellationIndividualStatus1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionCancellationStatus = (*CancellationIndividualStatus1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction66) AddCancellationStatusReasonInformation() *CancellationStatusReason2 {
@@ -208,14 +222,28 @@ func (p *PaymentTransaction66) SetOriginalInstructedAmount(value, currency strin
 	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction66) SetOriginalInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction66) SetOriginalRequestedExecutionDate(value string) {
 	p.OriginalRequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction66) SetOriginalRequestedExecutionDateFromTime(value time.Time) {
+	p.OriginalRequestedExecutionDate = new(ISODate)
+	p.OriginalRequestedExecutionDate.FromTime(value)
+}
+
 func (p *PaymentTransaction66) SetOriginalRequestedCollectionDate(value string) {
 	p.OriginalRequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction66) SetOriginalRequestedCollectionDateFromTime(value time.Time) {
+	p.OriginalRequestedCollectionDate = new(ISODate)
+	p.OriginalRequestedCollectionDate.FromTime(value)
+}
+
 func (p *PaymentTransaction66) AddOriginalTransactionReference() *OriginalTransactionReference22 {
 	p.OriginalTransactionReference = new(OriginalTransactionReference22)
 	return p.OriginalTransactionReference
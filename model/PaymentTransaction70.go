@@ -7,6 +7,10 @@ type PaymentTransaction70 struct {
 	PaymentInstrument *PaymentInstrument20Choice `xml:"PmtInstrm"`
 }
 
+func (p *PaymentTransaction70) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction70) AddPaymentInstrument() *PaymentInstrument20Choice {
 	p.PaymentInstrument = new(PaymentInstrument20Choice)
 	return p.PaymentInstrument
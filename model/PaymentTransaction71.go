@@ -7,6 +7,10 @@ type PaymentTransaction71 struct {
 	CashInOrOut *CashInOrOut7Choice `xml:"CshInOrOut"`
 }
 
+func (p *PaymentTransaction71) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction71) AddCashInOrOut() *CashInOrOut7Choice {
 	p.CashInOrOut = new(CashInOrOut7Choice)
 	return p.CashInOrOut
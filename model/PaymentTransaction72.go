@@ -7,6 +7,10 @@ type PaymentTransaction72 struct {
 	PaymentInstrument *PaymentInstrument21Choice `xml:"PmtInstrm"`
 }
 
+func (p *PaymentTransaction72) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction72) AddPaymentInstrument() *PaymentInstrument21Choice {
 	p.PaymentInstrument = new(PaymentInstrument21Choice)
 	return p.PaymentInstrument
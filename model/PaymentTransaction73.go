@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides further details on the original transactions, to which the status report message refers.
 type PaymentTransaction73 struct {
 
@@ -42,6 +46,10 @@ type PaymentTransaction73 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentTransaction73) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction73) SetStatusRequestIdentification(value string) {
 	p.StatusRequestIdentification = (*Max35Text)(&value)
 }
@@ -67,6 +75,11 @@ func (p *PaymentTransaction73) SetAcceptanceDateTime(value string) {
 	p.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (p *PaymentTransaction73) SetAcceptanceDateTimeFromTime(value time.Time) {
+	p.AcceptanceDateTime = new(ISODateTime)
+	p.AcceptanceDateTime.FromTime(value)
+}
+
 func (p *PaymentTransaction73) SetClearingSystemReference(value string) {
 	p.ClearingSystemReference = (*Max35Text)(&value)
 }
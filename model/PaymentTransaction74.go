@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide reference and status information on the original transactions, included in the original instruction, to which the cancellation request message applies.
 type PaymentTransaction74 struct {
 
@@ -38,6 +44,10 @@ type PaymentTransaction74 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentTransaction74) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction74) SetCancellationIdentification(value string) {
 	p.CancellationIdentification = (*Max35Text)(&value)
 }
@@ -59,6 +69,10 @@ func (p *PaymentTransaction74) SetOriginalInstructedAmount(value, currency strin
 	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction74) SetOriginalInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction74) AddOriginalRequestedExecutionDate() *DateAndDateTimeChoice {
 	p.OriginalRequestedExecutionDate = new(DateAndDateTimeChoice)
 	return p.OriginalRequestedExecutionDate
@@ -68,6 +82,11 @@ func (p *PaymentTransaction74) SetOriginalRequestedCollectionDate(value string)
 	p.OriginalRequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction74) SetOriginalRequestedCollectionDateFromTime(value time.Time) {
+	p.OriginalRequestedCollectionDate = new(ISODate)
+	p.OriginalRequestedCollectionDate.FromTime(value)
+}
+
 func (p *PaymentTransaction74) AddCancellationReasonInformation() *PaymentCancellationReason3 {
 	newValue := new(PaymentCancellationReason3)
 	p.CancellationReasonInformation = append(p.CancellationReasonInformation, newValue)
@@ -1,5 +1,12 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides further details on the reference and status on the original transactions, included in the original instruction, to which the return message applies.
 type PaymentTransaction76 struct {
 
@@ -77,6 +84,10 @@ type PaymentTransaction76 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentTransaction76) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction76) SetReturnIdentification(value string) {
 	p.ReturnIdentification = (*Max35Text)(&value)
 }
@@ -106,32 +117,66 @@ func (p *PaymentTransaction76) SetOriginalInterbankSettlementAmount(value, curre
 	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction76) SetOriginalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction76) SetReturnedInterbankSettlementAmount(value, currency string) {
 	p.ReturnedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction76) SetReturnedInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReturnedInterbankSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction76) SetInterbankSettlementDate(value string) {
 	p.InterbankSettlementDate = (*ISODate)(&value)
 }
 
-func (p *PaymentTransaction76) SetSettlementPriority(value string) {
+func (p *PaymentTransaction76) SetInterbankSettlementDateFromTime(value time.Time) {
+	p.InterbankSettlementDate = new(ISODate)
+	p.InterbankSettlementDate.FromTime(value)
+}
+
+func (p *PaymentTransaction76) SetSettlementPriority(value string) error {
+	if err := Priority3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.SettlementPriority = (*Priority3Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction76) SetReturnedInstructedAmount(value, currency string) {
 	p.ReturnedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction76) SetReturnedInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReturnedInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction76) SetExchangeRate(value string) {
 	p.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (p *PaymentTransaction76) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	p.ExchangeRate = new(BaseOneRate)
+	p.ExchangeRate.FromDecimal(value)
+}
+
 func (p *PaymentTransaction76) SetCompensationAmount(value, currency string) {
 	p.CompensationAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (p *PaymentTransaction76) SetChargeBearer(value string) {
+func (p *PaymentTransaction76) SetCompensationAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.CompensationAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (p *PaymentTransaction76) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction76) AddChargesInformation() *Charges2 {
@@ -447,6 +492,215 @@ func (p *PaymentTransaction76) AddSupplementaryData() *SupplementaryData1 {
 	return newValue
 }
 
+func (p *PaymentTransaction76) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.ReturnIdentification != nil {
+		enc.Element("RtrId", string(*p.ReturnIdentification))
+	}
+	if p.OriginalGroupInformation != nil {
+		p.OriginalGroupInformation.EncodeXMLFast(enc, "OrgnlGrpInf")
+	}
+	if p.OriginalInstructionIdentification != nil {
+		enc.Element("OrgnlInstrId", string(*p.OriginalInstructionIdentification))
+	}
+	if p.OriginalEndToEndIdentification != nil {
+		enc.Element("OrgnlEndToEndId", string(*p.OriginalEndToEndIdentification))
+	}
+	if p.OriginalTransactionIdentification != nil {
+		enc.Element("OrgnlTxId", string(*p.OriginalTransactionIdentification))
+	}
+	if p.OriginalClearingSystemReference != nil {
+		enc.Element("OrgnlClrSysRef", string(*p.OriginalClearingSystemReference))
+	}
+	if p.OriginalInterbankSettlementAmount != nil {
+		p.OriginalInterbankSettlementAmount.EncodeXMLFast(enc, "OrgnlIntrBkSttlmAmt")
+	}
+	if p.ReturnedInterbankSettlementAmount != nil {
+		p.ReturnedInterbankSettlementAmount.EncodeXMLFast(enc, "RtrdIntrBkSttlmAmt")
+	}
+	if p.InterbankSettlementDate != nil {
+		enc.Element("IntrBkSttlmDt", string(*p.InterbankSettlementDate))
+	}
+	if p.SettlementPriority != nil {
+		enc.Element("SttlmPrty", string(*p.SettlementPriority))
+	}
+	if p.ReturnedInstructedAmount != nil {
+		p.ReturnedInstructedAmount.EncodeXMLFast(enc, "RtrdInstdAmt")
+	}
+	if p.ExchangeRate != nil {
+		enc.Element("XchgRate", string(*p.ExchangeRate))
+	}
+	if p.CompensationAmount != nil {
+		p.CompensationAmount.EncodeXMLFast(enc, "CompstnAmt")
+	}
+	if p.ChargeBearer != nil {
+		enc.Element("ChrgBr", string(*p.ChargeBearer))
+	}
+	for _, elem := range p.ChargesInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "ChrgsInf")
+		}
+	}
+	if p.InstructingAgent != nil {
+		p.InstructingAgent.EncodeXMLFast(enc, "InstgAgt")
+	}
+	if p.InstructedAgent != nil {
+		p.InstructedAgent.EncodeXMLFast(enc, "InstdAgt")
+	}
+	for _, elem := range p.ReturnReasonInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RtrRsnInf")
+		}
+	}
+	if p.OriginalTransactionReference != nil {
+		p.OriginalTransactionReference.EncodeXMLFast(enc, "OrgnlTxRef")
+	}
+	for _, elem := range p.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentTransaction76) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "RtrId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ReturnIdentification = (*Max35Text)(&value)
+		case "OrgnlGrpInf":
+			elem := new(OriginalGroupInformation3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.OriginalGroupInformation = elem
+		case "OrgnlInstrId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.OriginalInstructionIdentification = (*Max35Text)(&value)
+		case "OrgnlEndToEndId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.OriginalEndToEndIdentification = (*Max35Text)(&value)
+		case "OrgnlTxId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.OriginalTransactionIdentification = (*Max35Text)(&value)
+		case "OrgnlClrSysRef":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.OriginalClearingSystemReference = (*Max35Text)(&value)
+		case "OrgnlIntrBkSttlmAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.OriginalInterbankSettlementAmount = elem
+		case "RtrdIntrBkSttlmAmt":
+			elem := new(ActiveCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.ReturnedInterbankSettlementAmount = elem
+		case "IntrBkSttlmDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.InterbankSettlementDate = (*ISODate)(&value)
+		case "SttlmPrty":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.SettlementPriority = (*Priority3Code)(&value)
+		case "RtrdInstdAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.ReturnedInstructedAmount = elem
+		case "XchgRate":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ExchangeRate = (*BaseOneRate)(&value)
+		case "CompstnAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.CompensationAmount = elem
+		case "ChrgBr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+		case "ChrgsInf":
+			elem := new(Charges2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.ChargesInformation = append(p.ChargesInformation, elem)
+		case "InstgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.InstructingAgent = elem
+		case "InstdAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.InstructedAgent = elem
+		case "RtrRsnInf":
+			elem := new(PaymentReturnReason1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.ReturnReasonInformation = append(p.ReturnReasonInformation, elem)
+		case "OrgnlTxRef":
+			elem := new(OriginalTransactionReference24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.OriginalTransactionReference = elem
+		case "SplmtryData":
+			elem := new(SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.SupplementaryData = append(p.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides reference and status information on the original transactions, included in the original instruction, to which the cancellation request message applies.
 type PaymentTransaction78 struct {
 
@@ -37,6 +43,10 @@ type PaymentTransaction78 struct {
 	OriginalTransactionReference *OriginalTransactionReference24 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransaction78) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction78) SetCancellationStatusIdentification(value string) {
 	p.CancellationStatusIdentification = (*Max35Text)(&value)
 }
@@ -54,8 +64,12 @@ func (p *PaymentTransaction78) SetOriginalEndToEndIdentification(value string) {
 	p.OriginalEndToEndIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentTransaction78) SetTransactionCancellationStatus(value string) {
+func (p *PaymentTransaction78) SetTransactionCancellationStatus(value string) error {
+	if err := CancellationIndividualStatus1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionCancellationStatus = (*CancellationIndividualStatus1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction78) AddCancellationStatusReasonInformation() *CancellationStatusReason2 {
@@ -208,6 +222,10 @@ func (p *PaymentTransaction78) SetOriginalInstructedAmount(value, currency strin
 	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction78) SetOriginalInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction78) AddOriginalRequestedExecutionDate() *DateAndDateTimeChoice {
 	p.OriginalRequestedExecutionDate = new(DateAndDateTimeChoice)
 	return p.OriginalRequestedExecutionDate
@@ -217,6 +235,11 @@ func (p *PaymentTransaction78) SetOriginalRequestedCollectionDate(value string)
 	p.OriginalRequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction78) SetOriginalRequestedCollectionDateFromTime(value time.Time) {
+	p.OriginalRequestedCollectionDate = new(ISODate)
+	p.OriginalRequestedCollectionDate.FromTime(value)
+}
+
 func (p *PaymentTransaction78) AddOriginalTransactionReference() *OriginalTransactionReference24 {
 	p.OriginalTransactionReference = new(OriginalTransactionReference24)
 	return p.OriginalTransactionReference
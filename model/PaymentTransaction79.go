@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details on the reference and status on the original transactions, included in the original instruction, to which the cancellation request message applies.
 type PaymentTransaction79 struct {
 
@@ -53,6 +59,10 @@ type PaymentTransaction79 struct {
 	OriginalTransactionReference *OriginalTransactionReference24 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransaction79) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction79) SetCancellationStatusIdentification(value string) {
 	p.CancellationStatusIdentification = (*Max35Text)(&value)
 }
@@ -83,8 +93,12 @@ func (p *PaymentTransaction79) SetOriginalClearingSystemReference(value string)
 	p.OriginalClearingSystemReference = (*Max35Text)(&value)
 }
 
-func (p *PaymentTransaction79) SetTransactionCancellationStatus(value string) {
+func (p *PaymentTransaction79) SetTransactionCancellationStatus(value string) error {
+	if err := CancellationIndividualStatus1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionCancellationStatus = (*CancellationIndividualStatus1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransaction79) AddCancellationStatusReasonInformation() *CancellationStatusReason2 {
@@ -242,10 +256,19 @@ func (p *PaymentTransaction79) SetOriginalInterbankSettlementAmount(value, curre
 	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransaction79) SetOriginalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransaction79) SetOriginalInterbankSettlementDate(value string) {
 	p.OriginalInterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransaction79) SetOriginalInterbankSettlementDateFromTime(value time.Time) {
+	p.OriginalInterbankSettlementDate = new(ISODate)
+	p.OriginalInterbankSettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransaction79) AddAssigner() *Party12Choice {
 	p.Assigner = new(Party12Choice)
 	return p.Assigner
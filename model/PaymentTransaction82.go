@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides further details on the original transactions, to which the status report message refers.
 type PaymentTransaction82 struct {
 
@@ -39,6 +45,10 @@ type PaymentTransaction82 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentTransaction82) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransaction82) SetStatusIdentification(value string) {
 	p.StatusIdentification = (*Max35Text)(&value)
 }
@@ -351,6 +361,11 @@ func (p *PaymentTransaction82) SetAcceptanceDateTime(value string) {
 	p.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (p *PaymentTransaction82) SetAcceptanceDateTimeFromTime(value time.Time) {
+	p.AcceptanceDateTime = new(ISODateTime)
+	p.AcceptanceDateTime.FromTime(value)
+}
+
 func (p *PaymentTransaction82) SetAccountServicerReference(value string) {
 	p.AccountServicerReference = (*Max35Text)(&value)
 }
@@ -370,6 +385,134 @@ func (p *PaymentTransaction82) AddSupplementaryData() *SupplementaryData1 {
 	return newValue
 }
 
+func (p *PaymentTransaction82) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.StatusIdentification != nil {
+		enc.Element("StsId", string(*p.StatusIdentification))
+	}
+	if p.OriginalInstructionIdentification != nil {
+		enc.Element("OrgnlInstrId", string(*p.OriginalInstructionIdentification))
+	}
+	if p.OriginalEndToEndIdentification != nil {
+		enc.Element("OrgnlEndToEndId", string(*p.OriginalEndToEndIdentification))
+	}
+	if p.TransactionStatus != nil {
+		enc.Element("TxSts", string(*p.TransactionStatus))
+	}
+	for _, elem := range p.StatusReasonInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "StsRsnInf")
+		}
+	}
+	for _, elem := range p.ChargesInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "ChrgsInf")
+		}
+	}
+	if p.AcceptanceDateTime != nil {
+		enc.Element("AccptncDtTm", string(*p.AcceptanceDateTime))
+	}
+	if p.AccountServicerReference != nil {
+		enc.Element("AcctSvcrRef", string(*p.AccountServicerReference))
+	}
+	if p.ClearingSystemReference != nil {
+		enc.Element("ClrSysRef", string(*p.ClearingSystemReference))
+	}
+	if p.OriginalTransactionReference != nil {
+		p.OriginalTransactionReference.EncodeXMLFast(enc, "OrgnlTxRef")
+	}
+	for _, elem := range p.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentTransaction82) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "StsId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.StatusIdentification = (*Max35Text)(&value)
+		case "OrgnlInstrId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.OriginalInstructionIdentification = (*Max35Text)(&value)
+		case "OrgnlEndToEndId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.OriginalEndToEndIdentification = (*Max35Text)(&value)
+		case "TxSts":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.TransactionStatus = (*ExternalPaymentTransactionStatus1Code)(&value)
+		case "StsRsnInf":
+			elem := new(StatusReasonInformation9)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.StatusReasonInformation = append(p.StatusReasonInformation, elem)
+		case "ChrgsInf":
+			elem := new(Charges2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.ChargesInformation = append(p.ChargesInformation, elem)
+		case "AccptncDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.AcceptanceDateTime = (*ISODateTime)(&value)
+		case "AcctSvcrRef":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.AccountServicerReference = (*Max35Text)(&value)
+		case "ClrSysRef":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ClearingSystemReference = (*Max35Text)(&value)
+		case "OrgnlTxRef":
+			elem := new(OriginalTransactionReference24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.OriginalTransactionReference = elem
+		case "SplmtryData":
+			elem := new(SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.SupplementaryData = append(p.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
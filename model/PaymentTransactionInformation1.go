@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information concerning the original transactions, to which the status report message refers.
 type PaymentTransactionInformation1 struct {
 
@@ -44,6 +48,10 @@ type PaymentTransactionInformation1 struct {
 	OriginalTransactionReference *OriginalTransactionReference1 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransactionInformation1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransactionInformation1) SetStatusIdentification(value string) {
 	p.StatusIdentification = (*Max35Text)(&value)
 }
@@ -364,6 +372,11 @@ func (p *PaymentTransactionInformation1) SetAcceptanceDateTime(value string) {
 	p.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (p *PaymentTransactionInformation1) SetAcceptanceDateTimeFromTime(value time.Time) {
+	p.AcceptanceDateTime = new(ISODateTime)
+	p.AcceptanceDateTime.FromTime(value)
+}
+
 func (p *PaymentTransactionInformation1) AddInstructingAgent() *BranchAndFinancialInstitutionIdentification3 {
 	p.InstructingAgent = new(BranchAndFinancialInstitutionIdentification3)
 	return p.InstructingAgent
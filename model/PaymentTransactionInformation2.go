@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Reference and status information concerning the original transactions, included in the original instruction, to which the return message applies.
 type PaymentTransactionInformation2 struct {
 
@@ -61,6 +67,10 @@ type PaymentTransactionInformation2 struct {
 	OriginalTransactionReference *OriginalTransactionReference1 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransactionInformation2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransactionInformation2) SetReturnIdentification(value string) {
 	p.ReturnIdentification = (*Max35Text)(&value)
 }
@@ -86,28 +96,58 @@ func (p *PaymentTransactionInformation2) SetOriginalInterbankSettlementAmount(va
 	p.OriginalInterbankSettlementAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransactionInformation2) SetOriginalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInterbankSettlementAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransactionInformation2) SetReturnedInterbankSettlementAmount(value, currency string) {
 	p.ReturnedInterbankSettlementAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransactionInformation2) SetReturnedInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReturnedInterbankSettlementAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransactionInformation2) SetInterbankSettlementDate(value string) {
 	p.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransactionInformation2) SetInterbankSettlementDateFromTime(value time.Time) {
+	p.InterbankSettlementDate = new(ISODate)
+	p.InterbankSettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransactionInformation2) SetReturnedInstructedAmount(value, currency string) {
 	p.ReturnedInstructedAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransactionInformation2) SetReturnedInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReturnedInstructedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransactionInformation2) SetExchangeRate(value string) {
 	p.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (p *PaymentTransactionInformation2) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	p.ExchangeRate = new(BaseOneRate)
+	p.ExchangeRate.FromDecimal(value)
+}
+
 func (p *PaymentTransactionInformation2) SetCompensationAmount(value, currency string) {
 	p.CompensationAmount = NewCurrencyAndAmount(value, currency)
 }
 
-func (p *PaymentTransactionInformation2) SetChargeBearer(value string) {
+func (p *PaymentTransactionInformation2) SetCompensationAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.CompensationAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
+func (p *PaymentTransactionInformation2) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransactionInformation2) AddChargesInformation() *ChargesInformation1 {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to provide information on the original transactions, to which the status report message refers.
 type PaymentTransactionInformation26 struct {
 
@@ -50,6 +54,10 @@ type PaymentTransactionInformation26 struct {
 	OriginalTransactionReference *OriginalTransactionReference13 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransactionInformation26) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransactionInformation26) SetStatusIdentification(value string) {
 	p.StatusIdentification = (*Max35Text)(&value)
 }
@@ -66,8 +74,12 @@ func (p *PaymentTransactionInformation26) SetOriginalTransactionIdentification(v
 	p.OriginalTransactionIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentTransactionInformation26) SetTransactionStatus(value string) {
+func (p *PaymentTransactionInformation26) SetTransactionStatus(value string) error {
+	if err := TransactionIndividualStatus3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionStatus = (*TransactionIndividualStatus3Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransactionInformation26) AddStatusReasonInformation() *StatusReasonInformation8 {
@@ -366,6 +378,11 @@ func (p *PaymentTransactionInformation26) SetAcceptanceDateTime(value string) {
 	p.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (p *PaymentTransactionInformation26) SetAcceptanceDateTimeFromTime(value time.Time) {
+	p.AcceptanceDateTime = new(ISODateTime)
+	p.AcceptanceDateTime.FromTime(value)
+}
+
 func (p *PaymentTransactionInformation26) SetAccountServicerReference(value string) {
 	p.AccountServicerReference = (*Max35Text)(&value)
 }
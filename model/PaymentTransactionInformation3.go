@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Reference and status information concerning the original transactions, included in the original instruction, to which the cancellation request message applies.
 type PaymentTransactionInformation3 struct {
 
@@ -41,6 +45,10 @@ type PaymentTransactionInformation3 struct {
 	OriginalTransactionReference *OriginalTransactionReference1 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransactionInformation3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransactionInformation3) SetCancellationIdentification(value string) {
 	p.CancellationIdentification = (*Max35Text)(&value)
 }
@@ -65,10 +73,18 @@ func (p *PaymentTransactionInformation3) SetOriginalInterbankSettlementAmount(va
 	p.OriginalInterbankSettlementAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransactionInformation3) SetOriginalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInterbankSettlementAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransactionInformation3) SetOriginalInstructedAmount(value, currency string) {
 	p.OriginalInstructedAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransactionInformation3) SetOriginalInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInstructedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransactionInformation3) AddInstructingAgent() *BranchAndFinancialInstitutionIdentification3 {
 	p.InstructingAgent = new(BranchAndFinancialInstitutionIdentification3)
 	return p.InstructingAgent
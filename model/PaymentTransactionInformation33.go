@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide reference and status information on the original transactions, included in the original instruction, to which the cancellation request message applies.
 type PaymentTransactionInformation33 struct {
 
@@ -53,6 +59,10 @@ type PaymentTransactionInformation33 struct {
 	OriginalTransactionReference *OriginalTransactionReference13 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransactionInformation33) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransactionInformation33) SetCancellationStatusIdentification(value string) {
 	p.CancellationStatusIdentification = (*Max35Text)(&value)
 }
@@ -83,8 +93,12 @@ func (p *PaymentTransactionInformation33) SetOriginalClearingSystemReference(val
 	p.OriginalClearingSystemReference = (*Max35Text)(&value)
 }
 
-func (p *PaymentTransactionInformation33) SetTransactionCancellationStatus(value string) {
+func (p *PaymentTransactionInformation33) SetTransactionCancellationStatus(value string) error {
+	if err := CancellationIndividualStatus1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionCancellationStatus = (*CancellationIndividualStatus1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransactionInformation33) AddCancellationStatusReasonInformation() *CancellationStatusReasonInformation1 {
@@ -242,10 +256,19 @@ func (p *PaymentTransactionInformation33) SetOriginalInterbankSettlementAmount(v
 	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransactionInformation33) SetOriginalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransactionInformation33) SetOriginalInterbankSettlementDate(value string) {
 	p.OriginalInterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransactionInformation33) SetOriginalInterbankSettlementDateFromTime(value time.Time) {
+	p.OriginalInterbankSettlementDate = new(ISODate)
+	p.OriginalInterbankSettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransactionInformation33) AddAssigner() *Party7Choice {
 	p.Assigner = new(Party7Choice)
 	return p.Assigner
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to provide information on the original transactions, to which the status report message refers.
 type PaymentTransactionInformation34 struct {
 
@@ -36,6 +40,10 @@ type PaymentTransactionInformation34 struct {
 	OriginalTransactionReference *OriginalTransactionReference15 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransactionInformation34) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransactionInformation34) SetStatusIdentification(value string) {
 	p.StatusIdentification = (*Max35Text)(&value)
 }
@@ -48,8 +56,12 @@ func (p *PaymentTransactionInformation34) SetOriginalEndToEndIdentification(valu
 	p.OriginalEndToEndIdentification = (*Max35Text)(&value)
 }
 
-func (p *PaymentTransactionInformation34) SetTransactionStatus(value string) {
+func (p *PaymentTransactionInformation34) SetTransactionStatus(value string) error {
+	if err := TransactionIndividualStatus3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.TransactionStatus = (*TransactionIndividualStatus3Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransactionInformation34) AddStatusReasonInformation() *StatusReasonInformation9 {
@@ -348,6 +360,11 @@ func (p *PaymentTransactionInformation34) SetAcceptanceDateTime(value string) {
 	p.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (p *PaymentTransactionInformation34) SetAcceptanceDateTimeFromTime(value time.Time) {
+	p.AcceptanceDateTime = new(ISODateTime)
+	p.AcceptanceDateTime.FromTime(value)
+}
+
 func (p *PaymentTransactionInformation34) SetAccountServicerReference(value string) {
 	p.AccountServicerReference = (*Max35Text)(&value)
 }
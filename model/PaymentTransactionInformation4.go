@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Reference and status information concerning the original transactions, included in the original instruction, to which the reversal message applies.
 type PaymentTransactionInformation4 struct {
 
@@ -35,6 +39,10 @@ type PaymentTransactionInformation4 struct {
 	OriginalTransactionReference *OriginalTransactionReference1 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransactionInformation4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransactionInformation4) SetReversalIdentification(value string) {
 	p.ReversalIdentification = (*Max35Text)(&value)
 }
@@ -55,12 +63,24 @@ func (p *PaymentTransactionInformation4) SetOriginalInstructedAmount(value, curr
 	p.OriginalInstructedAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransactionInformation4) SetOriginalInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInstructedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransactionInformation4) SetReversedInstructedAmount(value, currency string) {
 	p.ReversedInstructedAmount = NewCurrencyAndAmount(value, currency)
 }
 
-func (p *PaymentTransactionInformation4) SetChargeBearer(value string) {
+func (p *PaymentTransactionInformation4) SetReversedInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReversedInstructedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
+func (p *PaymentTransactionInformation4) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransactionInformation4) AddReversalReasonInformation() *ReversalReasonInformation1 {
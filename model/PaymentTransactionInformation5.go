@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Reference and status information concerning the original transactions, included in the original instruction, to which the reversal message applies.
 type PaymentTransactionInformation5 struct {
 
@@ -61,6 +67,10 @@ type PaymentTransactionInformation5 struct {
 	OriginalTransactionReference *OriginalTransactionReference1 `xml:"OrgnlTxRef,omitempty"`
 }
 
+func (p *PaymentTransactionInformation5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PaymentTransactionInformation5) SetReversalIdentification(value string) {
 	p.ReversalIdentification = (*Max35Text)(&value)
 }
@@ -85,28 +95,58 @@ func (p *PaymentTransactionInformation5) SetOriginalInterbankSettlementAmount(va
 	p.OriginalInterbankSettlementAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransactionInformation5) SetOriginalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.OriginalInterbankSettlementAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransactionInformation5) SetReversedInterbankSettlementAmount(value, currency string) {
 	p.ReversedInterbankSettlementAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransactionInformation5) SetReversedInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReversedInterbankSettlementAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransactionInformation5) SetInterbankSettlementDate(value string) {
 	p.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (p *PaymentTransactionInformation5) SetInterbankSettlementDateFromTime(value time.Time) {
+	p.InterbankSettlementDate = new(ISODate)
+	p.InterbankSettlementDate.FromTime(value)
+}
+
 func (p *PaymentTransactionInformation5) SetReversedInstructedAmount(value, currency string) {
 	p.ReversedInstructedAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (p *PaymentTransactionInformation5) SetReversedInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ReversedInstructedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PaymentTransactionInformation5) SetExchangeRate(value string) {
 	p.ExchangeRate = (*BaseOneRate)(&value)
 }
 
+func (p *PaymentTransactionInformation5) SetExchangeRateFromDecimal(value decimal.Decimal) {
+	p.ExchangeRate = new(BaseOneRate)
+	p.ExchangeRate.FromDecimal(value)
+}
+
 func (p *PaymentTransactionInformation5) SetCompensationAmount(value, currency string) {
 	p.CompensationAmount = NewCurrencyAndAmount(value, currency)
 }
 
-func (p *PaymentTransactionInformation5) SetChargeBearer(value string) {
+func (p *PaymentTransactionInformation5) SetCompensationAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.CompensationAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
+func (p *PaymentTransactionInformation5) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTransactionInformation5) AddChargesInformation() *ChargesInformation1 {
@@ -21,8 +21,16 @@ type PaymentTypeInformation1 struct {
 	CategoryPurpose *PaymentCategoryPurpose1Code `xml:"CtgyPurp,omitempty"`
 }
 
-func (p *PaymentTypeInformation1) SetInstructionPriority(value string) {
+func (p *PaymentTypeInformation1) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentTypeInformation1) SetInstructionPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.InstructionPriority = (*Priority2Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation1) AddServiceLevel() *ServiceLevel2Choice {
@@ -30,8 +38,12 @@ func (p *PaymentTypeInformation1) AddServiceLevel() *ServiceLevel2Choice {
 	return p.ServiceLevel
 }
 
-func (p *PaymentTypeInformation1) SetClearingChannel(value string) {
+func (p *PaymentTypeInformation1) SetClearingChannel(value string) error {
+	if err := ClearingChannel2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ClearingChannel = (*ClearingChannel2Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation1) AddLocalInstrument() *LocalInstrument1Choice {
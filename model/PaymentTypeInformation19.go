@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to provide further details of the type of payment.
 type PaymentTypeInformation19 struct {
 
@@ -19,8 +21,16 @@ type PaymentTypeInformation19 struct {
 	CategoryPurpose *CategoryPurpose1Choice `xml:"CtgyPurp,omitempty"`
 }
 
-func (p *PaymentTypeInformation19) SetInstructionPriority(value string) {
+func (p *PaymentTypeInformation19) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentTypeInformation19) SetInstructionPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.InstructionPriority = (*Priority2Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation19) AddServiceLevel() *ServiceLevel8Choice {
@@ -37,3 +47,62 @@ func (p *PaymentTypeInformation19) AddCategoryPurpose() *CategoryPurpose1Choice
 	p.CategoryPurpose = new(CategoryPurpose1Choice)
 	return p.CategoryPurpose
 }
+
+func (p *PaymentTypeInformation19) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.InstructionPriority != nil {
+		enc.Element("InstrPrty", string(*p.InstructionPriority))
+	}
+	if p.ServiceLevel != nil {
+		p.ServiceLevel.EncodeXMLFast(enc, "SvcLvl")
+	}
+	if p.LocalInstrument != nil {
+		p.LocalInstrument.EncodeXMLFast(enc, "LclInstrm")
+	}
+	if p.CategoryPurpose != nil {
+		p.CategoryPurpose.EncodeXMLFast(enc, "CtgyPurp")
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentTypeInformation19) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "InstrPrty":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.InstructionPriority = (*Priority2Code)(&value)
+		case "SvcLvl":
+			elem := new(ServiceLevel8Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.ServiceLevel = elem
+		case "LclInstrm":
+			elem := new(LocalInstrument2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.LocalInstrument = elem
+		case "CtgyPurp":
+			elem := new(CategoryPurpose1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.CategoryPurpose = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -22,8 +22,16 @@ type PaymentTypeInformation20 struct {
 	CategoryPurpose *CategoryPurpose1Choice `xml:"CtgyPurp,omitempty"`
 }
 
-func (p *PaymentTypeInformation20) SetInstructionPriority(value string) {
+func (p *PaymentTypeInformation20) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentTypeInformation20) SetInstructionPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.InstructionPriority = (*Priority2Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation20) AddServiceLevel() *ServiceLevel8Choice {
@@ -36,8 +44,12 @@ func (p *PaymentTypeInformation20) AddLocalInstrument() *LocalInstrument2Choice
 	return p.LocalInstrument
 }
 
-func (p *PaymentTypeInformation20) SetSequenceType(value string) {
+func (p *PaymentTypeInformation20) SetSequenceType(value string) error {
+	if err := SequenceType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.SequenceType = (*SequenceType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation20) AddCategoryPurpose() *CategoryPurpose1Choice {
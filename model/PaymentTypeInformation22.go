@@ -25,12 +25,24 @@ type PaymentTypeInformation22 struct {
 	CategoryPurpose *CategoryPurpose1Choice `xml:"CtgyPurp,omitempty"`
 }
 
-func (p *PaymentTypeInformation22) SetInstructionPriority(value string) {
+func (p *PaymentTypeInformation22) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentTypeInformation22) SetInstructionPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.InstructionPriority = (*Priority2Code)(&value)
+	return nil
 }
 
-func (p *PaymentTypeInformation22) SetClearingChannel(value string) {
+func (p *PaymentTypeInformation22) SetClearingChannel(value string) error {
+	if err := ClearingChannel2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ClearingChannel = (*ClearingChannel2Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation22) AddServiceLevel() *ServiceLevel8Choice {
@@ -43,8 +55,12 @@ func (p *PaymentTypeInformation22) AddLocalInstrument() *LocalInstrument2Choice
 	return p.LocalInstrument
 }
 
-func (p *PaymentTypeInformation22) SetSequenceType(value string) {
+func (p *PaymentTypeInformation22) SetSequenceType(value string) error {
+	if err := SequenceType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.SequenceType = (*SequenceType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation22) AddCategoryPurpose() *CategoryPurpose1Choice {
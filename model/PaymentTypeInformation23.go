@@ -17,12 +17,24 @@ type PaymentTypeInformation23 struct {
 	LocalInstrument *LocalInstrument2Choice `xml:"LclInstrm,omitempty"`
 }
 
-func (p *PaymentTypeInformation23) SetInstructionPriority(value string) {
+func (p *PaymentTypeInformation23) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentTypeInformation23) SetInstructionPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.InstructionPriority = (*Priority2Code)(&value)
+	return nil
 }
 
-func (p *PaymentTypeInformation23) SetClearingChannel(value string) {
+func (p *PaymentTypeInformation23) SetClearingChannel(value string) error {
+	if err := ClearingChannel2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ClearingChannel = (*ClearingChannel2Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation23) AddServiceLevel() *ServiceLevel8Choice {
@@ -22,8 +22,16 @@ type PaymentTypeInformation24 struct {
 	CategoryPurpose *CategoryPurpose1Choice `xml:"CtgyPurp,omitempty"`
 }
 
-func (p *PaymentTypeInformation24) SetInstructionPriority(value string) {
+func (p *PaymentTypeInformation24) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentTypeInformation24) SetInstructionPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.InstructionPriority = (*Priority2Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation24) AddServiceLevel() *ServiceLevel8Choice {
@@ -36,8 +44,12 @@ func (p *PaymentTypeInformation24) AddLocalInstrument() *LocalInstrument2Choice
 	return p.LocalInstrument
 }
 
-func (p *PaymentTypeInformation24) SetSequenceType(value string) {
+func (p *PaymentTypeInformation24) SetSequenceType(value string) error {
+	if err := SequenceType3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.SequenceType = (*SequenceType3Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation24) AddCategoryPurpose() *CategoryPurpose1Choice {
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to provide further details of the type of payment.
 type PaymentTypeInformation25 struct {
 
@@ -25,12 +27,24 @@ type PaymentTypeInformation25 struct {
 	CategoryPurpose *CategoryPurpose1Choice `xml:"CtgyPurp,omitempty"`
 }
 
-func (p *PaymentTypeInformation25) SetInstructionPriority(value string) {
+func (p *PaymentTypeInformation25) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentTypeInformation25) SetInstructionPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.InstructionPriority = (*Priority2Code)(&value)
+	return nil
 }
 
-func (p *PaymentTypeInformation25) SetClearingChannel(value string) {
+func (p *PaymentTypeInformation25) SetClearingChannel(value string) error {
+	if err := ClearingChannel2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ClearingChannel = (*ClearingChannel2Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation25) AddServiceLevel() *ServiceLevel8Choice {
@@ -43,11 +57,92 @@ func (p *PaymentTypeInformation25) AddLocalInstrument() *LocalInstrument2Choice
 	return p.LocalInstrument
 }
 
-func (p *PaymentTypeInformation25) SetSequenceType(value string) {
+func (p *PaymentTypeInformation25) SetSequenceType(value string) error {
+	if err := SequenceType3Code(value).Validate(); err != nil {
+		return err
+	}
 	p.SequenceType = (*SequenceType3Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation25) AddCategoryPurpose() *CategoryPurpose1Choice {
 	p.CategoryPurpose = new(CategoryPurpose1Choice)
 	return p.CategoryPurpose
 }
+
+func (p *PaymentTypeInformation25) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.InstructionPriority != nil {
+		enc.Element("InstrPrty", string(*p.InstructionPriority))
+	}
+	if p.ClearingChannel != nil {
+		enc.Element("ClrChanl", string(*p.ClearingChannel))
+	}
+	if p.ServiceLevel != nil {
+		p.ServiceLevel.EncodeXMLFast(enc, "SvcLvl")
+	}
+	if p.LocalInstrument != nil {
+		p.LocalInstrument.EncodeXMLFast(enc, "LclInstrm")
+	}
+	if p.SequenceType != nil {
+		enc.Element("SeqTp", string(*p.SequenceType))
+	}
+	if p.CategoryPurpose != nil {
+		p.CategoryPurpose.EncodeXMLFast(enc, "CtgyPurp")
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentTypeInformation25) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "InstrPrty":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.InstructionPriority = (*Priority2Code)(&value)
+		case "ClrChanl":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ClearingChannel = (*ClearingChannel2Code)(&value)
+		case "SvcLvl":
+			elem := new(ServiceLevel8Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.ServiceLevel = elem
+		case "LclInstrm":
+			elem := new(LocalInstrument2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.LocalInstrument = elem
+		case "SeqTp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.SequenceType = (*SequenceType3Code)(&value)
+		case "CtgyPurp":
+			elem := new(CategoryPurpose1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.CategoryPurpose = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Set of elements used to provide further details of the type of payment.
+type PaymentTypeInformation28 struct {
+
+	// Indicator of the urgency or order of importance that the instructing party would like the instructed party to apply to the processing of the instruction.
+	InstructionPriority *Priority2Code `xml:"InstrPrty,omitempty"`
+
+	// Specifies the clearing channel to be used to process the payment instruction.
+	ClearingChannel *ClearingChannel2Code `xml:"ClrChanl,omitempty"`
+
+	// Agreement under which or rules under which the transaction should be processed.
+	ServiceLevel []*ServiceLevel8Choice `xml:"SvcLvl,omitempty"`
+
+	// User community specific instrument.
+	//
+	// Usage: This element is used to specify a local instrument, local clearing option and/or further qualify the service or service level.
+	LocalInstrument *LocalInstrument2Choice `xml:"LclInstrm,omitempty"`
+
+	// Specifies the high level purpose of the instruction based on a set of pre-defined categories.
+	// Usage: This is used by the initiating party to provide information concerning the processing of the payment. It is likely to trigger special processing by any of the agents involved in the payment chain.
+	CategoryPurpose *CategoryPurpose1Choice `xml:"CtgyPurp,omitempty"`
+}
+
+func (p *PaymentTypeInformation28) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentTypeInformation28) SetInstructionPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
+	p.InstructionPriority = (*Priority2Code)(&value)
+	return nil
+}
+
+func (p *PaymentTypeInformation28) SetClearingChannel(value string) error {
+	if err := ClearingChannel2Code(value).Validate(); err != nil {
+		return err
+	}
+	p.ClearingChannel = (*ClearingChannel2Code)(&value)
+	return nil
+}
+
+func (p *PaymentTypeInformation28) AddServiceLevel() *ServiceLevel8Choice {
+	newValue := new(ServiceLevel8Choice)
+	p.ServiceLevel = append(p.ServiceLevel, newValue)
+	return newValue
+}
+
+func (p *PaymentTypeInformation28) AddLocalInstrument() *LocalInstrument2Choice {
+	p.LocalInstrument = new(LocalInstrument2Choice)
+	return p.LocalInstrument
+}
+
+func (p *PaymentTypeInformation28) AddCategoryPurpose() *CategoryPurpose1Choice {
+	p.CategoryPurpose = new(CategoryPurpose1Choice)
+	return p.CategoryPurpose
+}
+
+func (p *PaymentTypeInformation28) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.InstructionPriority != nil {
+		enc.Element("InstrPrty", string(*p.InstructionPriority))
+	}
+	if p.ClearingChannel != nil {
+		enc.Element("ClrChanl", string(*p.ClearingChannel))
+	}
+	for _, elem := range p.ServiceLevel {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SvcLvl")
+		}
+	}
+	if p.LocalInstrument != nil {
+		p.LocalInstrument.EncodeXMLFast(enc, "LclInstrm")
+	}
+	if p.CategoryPurpose != nil {
+		p.CategoryPurpose.EncodeXMLFast(enc, "CtgyPurp")
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentTypeInformation28) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "InstrPrty":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.InstructionPriority = (*Priority2Code)(&value)
+		case "ClrChanl":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ClearingChannel = (*ClearingChannel2Code)(&value)
+		case "SvcLvl":
+			elem := new(ServiceLevel8Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.ServiceLevel = append(p.ServiceLevel, elem)
+		case "LclInstrm":
+			elem := new(LocalInstrument2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.LocalInstrument = elem
+		case "CtgyPurp":
+			elem := new(CategoryPurpose1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.CategoryPurpose = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -24,8 +24,16 @@ type PaymentTypeInformation4 struct {
 	CategoryPurpose *PaymentCategoryPurpose1Code `xml:"CtgyPurp,omitempty"`
 }
 
-func (p *PaymentTypeInformation4) SetInstructionPriority(value string) {
+func (p *PaymentTypeInformation4) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentTypeInformation4) SetInstructionPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.InstructionPriority = (*Priority2Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation4) AddServiceLevel() *ServiceLevel3Choice {
@@ -33,8 +41,12 @@ func (p *PaymentTypeInformation4) AddServiceLevel() *ServiceLevel3Choice {
 	return p.ServiceLevel
 }
 
-func (p *PaymentTypeInformation4) SetClearingChannel(value string) {
+func (p *PaymentTypeInformation4) SetClearingChannel(value string) error {
+	if err := ClearingChannel2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ClearingChannel = (*ClearingChannel2Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation4) AddLocalInstrument() *LocalInstrument1Choice {
@@ -42,8 +54,12 @@ func (p *PaymentTypeInformation4) AddLocalInstrument() *LocalInstrument1Choice {
 	return p.LocalInstrument
 }
 
-func (p *PaymentTypeInformation4) SetSequenceType(value string) {
+func (p *PaymentTypeInformation4) SetSequenceType(value string) error {
+	if err := SequenceType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.SequenceType = (*SequenceType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation4) SetCategoryPurpose(value string) {
@@ -18,8 +18,16 @@ type PaymentTypeInformation5 struct {
 	LocalInstrument *RestrictedProprietaryChoice `xml:"LclInstrm,omitempty"`
 }
 
-func (p *PaymentTypeInformation5) SetInstructionPriority(value string) {
+func (p *PaymentTypeInformation5) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentTypeInformation5) SetInstructionPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.InstructionPriority = (*Priority2Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation5) AddServiceLevel() *RestrictedProprietaryChoice {
@@ -27,8 +35,12 @@ func (p *PaymentTypeInformation5) AddServiceLevel() *RestrictedProprietaryChoice
 	return p.ServiceLevel
 }
 
-func (p *PaymentTypeInformation5) SetClearingChannel(value string) {
+func (p *PaymentTypeInformation5) SetClearingChannel(value string) error {
+	if err := ClearingChannel2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ClearingChannel = (*ClearingChannel2Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation5) AddLocalInstrument() *RestrictedProprietaryChoice {
@@ -24,8 +24,16 @@ type PaymentTypeInformation6 struct {
 	CategoryPurpose *PaymentCategoryPurpose1Code `xml:"CtgyPurp,omitempty"`
 }
 
-func (p *PaymentTypeInformation6) SetInstructionPriority(value string) {
+func (p *PaymentTypeInformation6) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PaymentTypeInformation6) SetInstructionPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.InstructionPriority = (*Priority2Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation6) AddServiceLevel() *ServiceLevel2Choice {
@@ -33,8 +41,12 @@ func (p *PaymentTypeInformation6) AddServiceLevel() *ServiceLevel2Choice {
 	return p.ServiceLevel
 }
 
-func (p *PaymentTypeInformation6) SetClearingChannel(value string) {
+func (p *PaymentTypeInformation6) SetClearingChannel(value string) error {
+	if err := ClearingChannel2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.ClearingChannel = (*ClearingChannel2Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation6) AddLocalInstrument() *LocalInstrument1Choice {
@@ -42,8 +54,12 @@ func (p *PaymentTypeInformation6) AddLocalInstrument() *LocalInstrument1Choice {
 	return p.LocalInstrument
 }
 
-func (p *PaymentTypeInformation6) SetSequenceType(value string) {
+func (p *PaymentTypeInformation6) SetSequenceType(value string) error {
+	if err := SequenceType1Code(value).Validate(); err != nil {
+		return err
+	}
 	p.SequenceType = (*SequenceType1Code)(&value)
+	return nil
 }
 
 func (p *PaymentTypeInformation6) SetCategoryPurpose(value string) {
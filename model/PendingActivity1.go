@@ -10,6 +10,10 @@ type PendingActivity1 struct {
 	Description *Max140Text `xml:"Desc,omitempty"`
 }
 
+func (p *PendingActivity1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingActivity1) SetType(value string) {
 	p.Type = (*Action1Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingActivity2 struct {
 	Description *Max140Text `xml:"Desc,omitempty"`
 }
 
+func (p *PendingActivity2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingActivity2) SetType(value string) {
 	p.Type = (*Action2Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingBalance1 struct {
 	PendingTransactions []*SettlementTypeAndIdentification2 `xml:"PdgTxs,omitempty"`
 }
 
+func (p *PendingBalance1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingBalance1) AddBalance() *SignedQuantityFormat2 {
 	p.Balance = new(SignedQuantityFormat2)
 	return p.Balance
@@ -10,6 +10,10 @@ type PendingBalance3 struct {
 	PendingTransactions []*SettlementTypeAndIdentification20 `xml:"PdgTxs,omitempty"`
 }
 
+func (p *PendingBalance3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingBalance3) AddBalance() *SignedQuantityFormat6 {
 	p.Balance = new(SignedQuantityFormat6)
 	return p.Balance
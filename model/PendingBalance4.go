@@ -10,6 +10,10 @@ type PendingBalance4 struct {
 	PendingTransactions []*SettlementTypeAndIdentification21 `xml:"PdgTxs,omitempty"`
 }
 
+func (p *PendingBalance4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingBalance4) AddBalance() *SignedQuantityFormat9 {
 	p.Balance = new(SignedQuantityFormat9)
 	return p.Balance
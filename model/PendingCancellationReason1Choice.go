@@ -10,6 +10,10 @@ type PendingCancellationReason1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingCancellationReason1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingCancellationReason1Choice) SetCode(value string) {
 	p.Code = (*PendingCancellationReason4Code)(&value)
 }
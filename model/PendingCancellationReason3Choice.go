@@ -10,6 +10,10 @@ type PendingCancellationReason3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PendingCancellationReason3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingCancellationReason3Choice) SetCode(value string) {
 	p.Code = (*PendingCancellationReason4Code)(&value)
 }
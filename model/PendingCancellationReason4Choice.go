@@ -10,6 +10,10 @@ type PendingCancellationReason4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PendingCancellationReason4Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingCancellationReason4Choice) SetCode(value string) {
 	p.Code = (*PendingCancellationReason4Code)(&value)
 }
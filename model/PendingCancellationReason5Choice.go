@@ -10,6 +10,10 @@ type PendingCancellationReason5Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PendingCancellationReason5Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingCancellationReason5Choice) SetCode(value string) {
 	p.Code = (*PendingCancellationReason5Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingCancellationReason6Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PendingCancellationReason6Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingCancellationReason6Choice) SetCode(value string) {
 	p.Code = (*PendingCancellationReason5Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingCancellationReasons2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingCancellationReasons2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingCancellationReasons2Choice) SetCode(value string) {
 	p.Code = (*PendingReason7Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingCancellationReasons4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PendingCancellationReasons4Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingCancellationReasons4Choice) SetCode(value string) {
 	p.Code = (*PendingReason7Code)(&value)
 }
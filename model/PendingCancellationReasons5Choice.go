@@ -10,6 +10,10 @@ type PendingCancellationReasons5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PendingCancellationReasons5Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingCancellationReasons5Choice) SetCode(value string) {
 	p.Code = (*PendingReason7Code)(&value)
 }
@@ -10,8 +10,16 @@ type PendingCancellationStatus1Choice struct {
 	Reason []*PendingCancellationStatusReason1 `xml:"Rsn"`
 }
 
-func (p *PendingCancellationStatus1Choice) SetNotSpecifiedReason(value string) {
+func (p *PendingCancellationStatus1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingCancellationStatus1Choice) SetNotSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NotSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingCancellationStatus1Choice) AddReason() *PendingCancellationStatusReason1 {
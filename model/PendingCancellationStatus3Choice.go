@@ -10,8 +10,16 @@ type PendingCancellationStatus3Choice struct {
 	Reason []*PendingCancellationStatusReason3 `xml:"Rsn"`
 }
 
-func (p *PendingCancellationStatus3Choice) SetNotSpecifiedReason(value string) {
+func (p *PendingCancellationStatus3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingCancellationStatus3Choice) SetNotSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NotSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingCancellationStatus3Choice) AddReason() *PendingCancellationStatusReason3 {
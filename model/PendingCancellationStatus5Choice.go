@@ -10,8 +10,16 @@ type PendingCancellationStatus5Choice struct {
 	Reason []*PendingCancellationStatusReason5 `xml:"Rsn"`
 }
 
-func (p *PendingCancellationStatus5Choice) SetNotSpecifiedReason(value string) {
+func (p *PendingCancellationStatus5Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingCancellationStatus5Choice) SetNotSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NotSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingCancellationStatus5Choice) AddReason() *PendingCancellationStatusReason5 {
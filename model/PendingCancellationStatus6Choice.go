@@ -10,8 +10,16 @@ type PendingCancellationStatus6Choice struct {
 	Reason []*PendingCancellationStatusReason6 `xml:"Rsn"`
 }
 
-func (p *PendingCancellationStatus6Choice) SetNotSpecifiedReason(value string) {
+func (p *PendingCancellationStatus6Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingCancellationStatus6Choice) SetNotSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NotSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingCancellationStatus6Choice) AddReason() *PendingCancellationStatusReason6 {
@@ -10,8 +10,16 @@ type PendingCancellationStatus7Choice struct {
 	Reason []*PendingCancellationStatusReason7 `xml:"Rsn"`
 }
 
-func (p *PendingCancellationStatus7Choice) SetNotSpecifiedReason(value string) {
+func (p *PendingCancellationStatus7Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingCancellationStatus7Choice) SetNotSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NotSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingCancellationStatus7Choice) AddReason() *PendingCancellationStatusReason7 {
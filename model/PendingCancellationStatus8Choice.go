@@ -10,8 +10,16 @@ type PendingCancellationStatus8Choice struct {
 	Reason []*PendingCancellationStatusReason8 `xml:"Rsn"`
 }
 
-func (p *PendingCancellationStatus8Choice) SetNotSpecifiedReason(value string) {
+func (p *PendingCancellationStatus8Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingCancellationStatus8Choice) SetNotSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NotSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingCancellationStatus8Choice) AddReason() *PendingCancellationStatusReason8 {
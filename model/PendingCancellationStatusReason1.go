@@ -10,6 +10,10 @@ type PendingCancellationStatusReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingCancellationStatusReason1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingCancellationStatusReason1) AddReasonCode() *PendingCancellationReason1Choice {
 	p.ReasonCode = new(PendingCancellationReason1Choice)
 	return p.ReasonCode
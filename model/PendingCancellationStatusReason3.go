@@ -10,6 +10,10 @@ type PendingCancellationStatusReason3 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingCancellationStatusReason3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingCancellationStatusReason3) AddReasonCode() *PendingCancellationReason1Choice {
 	p.ReasonCode = new(PendingCancellationReason1Choice)
 	return p.ReasonCode
@@ -10,6 +10,10 @@ type PendingCancellationStatusReason5 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingCancellationStatusReason5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingCancellationStatusReason5) AddReasonCode() *PendingCancellationReason3Choice {
 	p.ReasonCode = new(PendingCancellationReason3Choice)
 	return p.ReasonCode
@@ -10,6 +10,10 @@ type PendingCancellationStatusReason6 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingCancellationStatusReason6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingCancellationStatusReason6) AddReasonCode() *PendingCancellationReason4Choice {
 	p.ReasonCode = new(PendingCancellationReason4Choice)
 	return p.ReasonCode
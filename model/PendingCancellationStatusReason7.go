@@ -10,6 +10,10 @@ type PendingCancellationStatusReason7 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingCancellationStatusReason7) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingCancellationStatusReason7) AddReasonCode() *PendingCancellationReason5Choice {
 	p.ReasonCode = new(PendingCancellationReason5Choice)
 	return p.ReasonCode
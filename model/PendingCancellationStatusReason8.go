@@ -10,6 +10,10 @@ type PendingCancellationStatusReason8 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingCancellationStatusReason8) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingCancellationStatusReason8) AddReasonCode() *PendingCancellationReason6Choice {
 	p.ReasonCode = new(PendingCancellationReason6Choice)
 	return p.ReasonCode
@@ -10,6 +10,10 @@ type PendingOpeningStatusReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PendingOpeningStatusReason1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingOpeningStatusReason1) AddCode() *PendingOpeningStatusReason2Choice {
 	p.Code = new(PendingOpeningStatusReason2Choice)
 	return p.Code
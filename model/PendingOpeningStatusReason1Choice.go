@@ -10,8 +10,16 @@ type PendingOpeningStatusReason1Choice struct {
 	Reason []*PendingOpeningStatusReason1 `xml:"Rsn"`
 }
 
-func (p *PendingOpeningStatusReason1Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingOpeningStatusReason1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingOpeningStatusReason1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingOpeningStatusReason1Choice) AddReason() *PendingOpeningStatusReason1 {
@@ -10,6 +10,10 @@ type PendingOpeningStatusReason2Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (p *PendingOpeningStatusReason2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingOpeningStatusReason2Choice) SetCode(value string) {
 	p.Code = (*PendingOpeningStatusReason1Code)(&value)
 }
@@ -10,8 +10,16 @@ type PendingProcessing1Choice struct {
 	Reason []*AwaitingAffirmationReason1 `xml:"Rsn"`
 }
 
-func (p *PendingProcessing1Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingProcessing1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingProcessing1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingProcessing1Choice) AddReason() *AwaitingAffirmationReason1 {
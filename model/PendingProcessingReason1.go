@@ -10,6 +10,10 @@ type PendingProcessingReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingProcessingReason1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason1) AddCode() *PendingProcessingReason1Choice {
 	p.Code = new(PendingProcessingReason1Choice)
 	return p.Code
@@ -10,6 +10,10 @@ type PendingProcessingReason10 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingProcessingReason10) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason10) AddCode() *PendingProcessingReason12Choice {
 	p.Code = new(PendingProcessingReason12Choice)
 	return p.Code
@@ -10,6 +10,10 @@ type PendingProcessingReason10Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PendingProcessingReason10Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason10Choice) SetCode(value string) {
 	p.Code = (*PendingProcessingReason2Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingProcessingReason11 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingProcessingReason11) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason11) AddCode() *PendingProcessingReason13Choice {
 	p.Code = new(PendingProcessingReason13Choice)
 	return p.Code
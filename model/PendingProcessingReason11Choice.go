@@ -10,6 +10,10 @@ type PendingProcessingReason11Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PendingProcessingReason11Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason11Choice) SetCode(value string) {
 	p.Code = (*PendingProcessingReason1Code)(&value)
 }
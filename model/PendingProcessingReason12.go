@@ -10,6 +10,10 @@ type PendingProcessingReason12 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingProcessingReason12) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason12) AddCode() *PendingProcessingReason14Choice {
 	p.Code = new(PendingProcessingReason14Choice)
 	return p.Code
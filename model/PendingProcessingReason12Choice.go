@@ -10,6 +10,10 @@ type PendingProcessingReason12Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PendingProcessingReason12Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason12Choice) SetCode(value string) {
 	p.Code = (*PendingProcessingReason3Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingProcessingReason13 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingProcessingReason13) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason13) AddCode() *PendingProcessingReason15Choice {
 	p.Code = new(PendingProcessingReason15Choice)
 	return p.Code
@@ -10,6 +10,10 @@ type PendingProcessingReason13Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PendingProcessingReason13Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason13Choice) SetCode(value string) {
 	p.Code = (*PendingProcessingReason1Code)(&value)
 }
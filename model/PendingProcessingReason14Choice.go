@@ -10,6 +10,10 @@ type PendingProcessingReason14Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PendingProcessingReason14Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason14Choice) SetCode(value string) {
 	p.Code = (*PendingProcessingReason2Code)(&value)
 }
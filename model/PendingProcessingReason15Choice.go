@@ -10,6 +10,10 @@ type PendingProcessingReason15Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PendingProcessingReason15Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason15Choice) SetCode(value string) {
 	p.Code = (*PendingProcessingReason3Code)(&value)
 }
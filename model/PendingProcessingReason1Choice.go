@@ -10,6 +10,10 @@ type PendingProcessingReason1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingProcessingReason1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason1Choice) SetCode(value string) {
 	p.Code = (*PendingProcessingReason1Code)(&value)
 }
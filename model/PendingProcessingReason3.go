@@ -10,6 +10,10 @@ type PendingProcessingReason3 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingProcessingReason3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason3) AddCode() *PendingProcessingReason3Choice {
 	p.Code = new(PendingProcessingReason3Choice)
 	return p.Code
@@ -10,6 +10,10 @@ type PendingProcessingReason3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingProcessingReason3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason3Choice) SetCode(value string) {
 	p.Code = (*PendingProcessingReason2Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingProcessingReason5 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingProcessingReason5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason5) AddCode() *PendingProcessingReason5Choice {
 	p.Code = new(PendingProcessingReason5Choice)
 	return p.Code
@@ -10,6 +10,10 @@ type PendingProcessingReason5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingProcessingReason5Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason5Choice) SetCode(value string) {
 	p.Code = (*PendingProcessingReason3Code)(&value)
 }
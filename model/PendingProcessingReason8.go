@@ -10,6 +10,10 @@ type PendingProcessingReason8 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingProcessingReason8) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason8) AddCode() *PendingProcessingReason10Choice {
 	p.Code = new(PendingProcessingReason10Choice)
 	return p.Code
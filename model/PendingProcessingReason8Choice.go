@@ -10,6 +10,10 @@ type PendingProcessingReason8Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (p *PendingProcessingReason8Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason8Choice) SetCode(value string) {
 	p.Code = (*ExternalPendingProcessingReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingProcessingReason9 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingProcessingReason9) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingReason9) AddCode() *PendingProcessingReason11Choice {
 	p.Code = new(PendingProcessingReason11Choice)
 	return p.Code
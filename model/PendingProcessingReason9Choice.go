@@ -10,8 +10,16 @@ type PendingProcessingReason9Choice struct {
 	Reason []*PendingProcessingReason8Choice `xml:"Rsn"`
 }
 
-func (p *PendingProcessingReason9Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingProcessingReason9Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingProcessingReason9Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingProcessingReason9Choice) AddReason() *PendingProcessingReason8Choice {
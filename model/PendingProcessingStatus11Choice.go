@@ -10,8 +10,16 @@ type PendingProcessingStatus11Choice struct {
 	Reason []*PendingProcessingReason8 `xml:"Rsn"`
 }
 
-func (p *PendingProcessingStatus11Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingProcessingStatus11Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingProcessingStatus11Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingProcessingStatus11Choice) AddReason() *PendingProcessingReason8 {
@@ -10,8 +10,16 @@ type PendingProcessingStatus12Choice struct {
 	Reason []*PendingProcessingReason9 `xml:"Rsn"`
 }
 
-func (p *PendingProcessingStatus12Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingProcessingStatus12Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingProcessingStatus12Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingProcessingStatus12Choice) AddReason() *PendingProcessingReason9 {
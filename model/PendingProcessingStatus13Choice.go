@@ -10,8 +10,16 @@ type PendingProcessingStatus13Choice struct {
 	Reason []*PendingProcessingReason10 `xml:"Rsn"`
 }
 
-func (p *PendingProcessingStatus13Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingProcessingStatus13Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingProcessingStatus13Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingProcessingStatus13Choice) AddReason() *PendingProcessingReason10 {
@@ -10,8 +10,16 @@ type PendingProcessingStatus14Choice struct {
 	Reason []*PendingProcessingReason11 `xml:"Rsn"`
 }
 
-func (p *PendingProcessingStatus14Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingProcessingStatus14Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingProcessingStatus14Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingProcessingStatus14Choice) AddReason() *PendingProcessingReason11 {
@@ -10,8 +10,16 @@ type PendingProcessingStatus15Choice struct {
 	Reason []*PendingProcessingReason12 `xml:"Rsn"`
 }
 
-func (p *PendingProcessingStatus15Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingProcessingStatus15Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingProcessingStatus15Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingProcessingStatus15Choice) AddReason() *PendingProcessingReason12 {
@@ -10,8 +10,16 @@ type PendingProcessingStatus16Choice struct {
 	Reason []*PendingProcessingReason13 `xml:"Rsn"`
 }
 
-func (p *PendingProcessingStatus16Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingProcessingStatus16Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingProcessingStatus16Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingProcessingStatus16Choice) AddReason() *PendingProcessingReason13 {
@@ -10,8 +10,16 @@ type PendingProcessingStatus1Choice struct {
 	Reason []*PendingProcessingReason1 `xml:"Rsn,omitempty"`
 }
 
-func (p *PendingProcessingStatus1Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingProcessingStatus1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingProcessingStatus1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingProcessingStatus1Choice) AddReason() *PendingProcessingReason1 {
@@ -10,8 +10,16 @@ type PendingProcessingStatus3Choice struct {
 	Reason []*PendingProcessingReason3 `xml:"Rsn"`
 }
 
-func (p *PendingProcessingStatus3Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingProcessingStatus3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingProcessingStatus3Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingProcessingStatus3Choice) AddReason() *PendingProcessingReason3 {
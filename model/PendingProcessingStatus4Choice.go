@@ -10,8 +10,16 @@ type PendingProcessingStatus4Choice struct {
 	Reason []*PendingProcessingReason1 `xml:"Rsn"`
 }
 
-func (p *PendingProcessingStatus4Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingProcessingStatus4Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingProcessingStatus4Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingProcessingStatus4Choice) AddReason() *PendingProcessingReason1 {
@@ -10,8 +10,16 @@ type PendingProcessingStatus7Choice struct {
 	Reason []*PendingProcessingReason5 `xml:"Rsn"`
 }
 
-func (p *PendingProcessingStatus7Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingProcessingStatus7Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingProcessingStatus7Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingProcessingStatus7Choice) AddReason() *PendingProcessingReason5 {
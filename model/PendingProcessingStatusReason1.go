@@ -10,6 +10,10 @@ type PendingProcessingStatusReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingProcessingStatusReason1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingProcessingStatusReason1) AddReason() *PendingProcessingReason9Choice {
 	p.Reason = new(PendingProcessingReason9Choice)
 	return p.Reason
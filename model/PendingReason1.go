@@ -10,6 +10,10 @@ type PendingReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingReason1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason1) AddCode() *PendingReason1Choice {
 	p.Code = new(PendingReason1Choice)
 	return p.Code
@@ -10,6 +10,10 @@ type PendingReason11Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingReason11Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason11Choice) SetCode(value string) {
 	p.Code = (*PendingReason8Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingReason13Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingReason13Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason13Choice) SetCode(value string) {
 	p.Code = (*PendingReason2Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingReason14 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingReason14) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason14) AddCode() *PendingReason26Choice {
 	p.Code = new(PendingReason26Choice)
 	return p.Code
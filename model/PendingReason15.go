@@ -10,6 +10,10 @@ type PendingReason15 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingReason15) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason15) AddCode() *PendingReason27Choice {
 	p.Code = new(PendingReason27Choice)
 	return p.Code
@@ -10,6 +10,10 @@ type PendingReason15Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingReason15Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason15Choice) SetCode(value string) {
 	p.Code = (*PendingReason9Code)(&value)
 }
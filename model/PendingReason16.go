@@ -10,6 +10,10 @@ type PendingReason16 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingReason16) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason16) AddCode() *PendingReason28Choice {
 	p.Code = new(PendingReason28Choice)
 	return p.Code
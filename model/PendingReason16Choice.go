@@ -10,6 +10,10 @@ type PendingReason16Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingReason16Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason16Choice) SetCode(value string) {
 	p.Code = (*PendingReason10Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingReason17 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingReason17) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason17) AddCode() *PendingReason30Choice {
 	p.Code = new(PendingReason30Choice)
 	return p.Code
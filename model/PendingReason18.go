@@ -10,6 +10,10 @@ type PendingReason18 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingReason18) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason18) AddCode() *PendingReason31Choice {
 	p.Code = new(PendingReason31Choice)
 	return p.Code
@@ -10,6 +10,10 @@ type PendingReason19 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingReason19) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason19) AddCode() *PendingReason36Choice {
 	p.Code = new(PendingReason36Choice)
 	return p.Code
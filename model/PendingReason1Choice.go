@@ -10,6 +10,10 @@ type PendingReason1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingReason1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason1Choice) SetCode(value string) {
 	p.Code = (*PendingReason1Code)(&value)
 }
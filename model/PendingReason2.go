@@ -10,6 +10,10 @@ type PendingReason2 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingReason2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason2) AddCode() *PendingReason2Choice {
 	p.Code = new(PendingReason2Choice)
 	return p.Code
@@ -10,6 +10,10 @@ type PendingReason20 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingReason20) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason20) AddCode() *PendingReason37Choice {
 	p.Code = new(PendingReason37Choice)
 	return p.Code
@@ -10,6 +10,10 @@ type PendingReason21 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingReason21) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason21) AddCode() *PendingReason38Choice {
 	p.Code = new(PendingReason38Choice)
 	return p.Code
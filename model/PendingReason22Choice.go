@@ -10,6 +10,10 @@ type PendingReason22Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingReason22Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason22Choice) SetCode(value string) {
 	p.Code = (*PendingReason11Code)(&value)
 }
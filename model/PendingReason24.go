@@ -10,6 +10,10 @@ type PendingReason24 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingReason24) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason24) AddCode() *PendingReason41Choice {
 	p.Code = new(PendingReason41Choice)
 	return p.Code
@@ -10,6 +10,10 @@ type PendingReason24Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingReason24Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason24Choice) SetCode(value string) {
 	p.Code = (*PendingReason13Code)(&value)
 }
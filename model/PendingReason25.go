@@ -10,6 +10,10 @@ type PendingReason25 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingReason25) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason25) AddCode() *PendingReason42Choice {
 	p.Code = new(PendingReason42Choice)
 	return p.Code
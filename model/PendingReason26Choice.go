@@ -10,6 +10,10 @@ type PendingReason26Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PendingReason26Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason26Choice) SetCode(value string) {
 	p.Code = (*PendingReason10Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingReason27Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PendingReason27Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason27Choice) SetCode(value string) {
 	p.Code = (*PendingReason2Code)(&value)
 }
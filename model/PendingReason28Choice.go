@@ -10,6 +10,10 @@ type PendingReason28Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PendingReason28Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason28Choice) SetCode(value string) {
 	p.Code = (*PendingReason6Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingReason29Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PendingReason29Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason29Choice) SetCode(value string) {
 	p.Code = (*PendingReason8Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingReason2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingReason2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason2Choice) SetCode(value string) {
 	p.Code = (*PendingReason6Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingReason30Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PendingReason30Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason30Choice) SetCode(value string) {
 	p.Code = (*PendingReason9Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingReason31Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PendingReason31Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason31Choice) SetCode(value string) {
 	p.Code = (*PendingReason1Code)(&value)
 }
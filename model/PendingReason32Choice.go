@@ -10,6 +10,10 @@ type PendingReason32Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PendingReason32Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason32Choice) SetCode(value string) {
 	p.Code = (*PendingReason4Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingReason33Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PendingReason33Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason33Choice) SetCode(value string) {
 	p.Code = (*PendingReason13Code)(&value)
 }
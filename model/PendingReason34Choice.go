@@ -10,6 +10,10 @@ type PendingReason34Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PendingReason34Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason34Choice) SetCode(value string) {
 	p.Code = (*PendingReason4Code)(&value)
 }
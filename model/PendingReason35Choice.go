@@ -10,6 +10,10 @@ type PendingReason35Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PendingReason35Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason35Choice) SetCode(value string) {
 	p.Code = (*PendingReason13Code)(&value)
 }
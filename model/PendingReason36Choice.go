@@ -10,6 +10,10 @@ type PendingReason36Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PendingReason36Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason36Choice) SetCode(value string) {
 	p.Code = (*PendingReason10Code)(&value)
 }
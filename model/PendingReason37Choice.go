@@ -10,6 +10,10 @@ type PendingReason37Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PendingReason37Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason37Choice) SetCode(value string) {
 	p.Code = (*PendingReason6Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingReason38Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PendingReason38Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason38Choice) SetCode(value string) {
 	p.Code = (*PendingReason1Code)(&value)
 }
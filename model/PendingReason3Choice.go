@@ -10,6 +10,10 @@ type PendingReason3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingReason3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason3Choice) SetCode(value string) {
 	p.Code = (*PendingReason3Code)(&value)
 }
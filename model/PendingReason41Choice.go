@@ -10,6 +10,10 @@ type PendingReason41Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PendingReason41Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason41Choice) SetCode(value string) {
 	p.Code = (*PendingReason2Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingReason42Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PendingReason42Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason42Choice) SetCode(value string) {
 	p.Code = (*PendingReason9Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingReason47Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PendingReason47Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason47Choice) SetCode(value string) {
 	p.Code = (*PendingReason8Code)(&value)
 }
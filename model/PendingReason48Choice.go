@@ -10,6 +10,10 @@ type PendingReason48Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PendingReason48Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason48Choice) SetCode(value string) {
 	p.Code = (*PendingReason14Code)(&value)
 }
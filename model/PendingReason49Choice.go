@@ -10,6 +10,10 @@ type PendingReason49Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PendingReason49Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason49Choice) SetCode(value string) {
 	p.Code = (*PendingReason14Code)(&value)
 }
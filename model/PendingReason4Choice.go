@@ -10,6 +10,10 @@ type PendingReason4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingReason4Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason4Choice) SetCode(value string) {
 	p.Code = (*PendingReason4Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingReason5 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingReason5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason5) AddCode() *PendingReason13Choice {
 	p.Code = new(PendingReason13Choice)
 	return p.Code
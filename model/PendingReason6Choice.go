@@ -10,6 +10,10 @@ type PendingReason6Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PendingReason6Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason6Choice) SetCode(value string) {
 	p.Code = (*PendingReason5Code)(&value)
 }
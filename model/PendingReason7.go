@@ -10,6 +10,10 @@ type PendingReason7 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingReason7) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason7) AddCode() *PendingReason15Choice {
 	p.Code = new(PendingReason15Choice)
 	return p.Code
@@ -10,6 +10,10 @@ type PendingReason8 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingReason8) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingReason8) AddCode() *PendingReason16Choice {
 	p.Code = new(PendingReason16Choice)
 	return p.Code
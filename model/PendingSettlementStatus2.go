@@ -16,6 +16,10 @@ type PendingSettlementStatus2 struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (p *PendingSettlementStatus2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingSettlementStatus2) SetReason(value string) {
 	p.Reason = (*PendingSettlementStatusReason2Code)(&value)
 }
@@ -29,6 +33,10 @@ func (p *PendingSettlementStatus2) AddDataSourceScheme() *GenericIdentification1
 	return p.DataSourceScheme
 }
 
-func (p *PendingSettlementStatus2) SetNoSpecifiedReason(value string) {
+func (p *PendingSettlementStatus2) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
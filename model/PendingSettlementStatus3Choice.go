@@ -16,6 +16,10 @@ type PendingSettlementStatus3Choice struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (p *PendingSettlementStatus3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingSettlementStatus3Choice) SetReason(value string) {
 	p.Reason = (*PendingSettlementStatusReason2Code)(&value)
 }
@@ -29,6 +33,10 @@ func (p *PendingSettlementStatus3Choice) AddDataSourceScheme() *GenericIdentific
 	return p.DataSourceScheme
 }
 
-func (p *PendingSettlementStatus3Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingSettlementStatus3Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
@@ -13,6 +13,10 @@ type PendingSettlementStatusChoice struct {
 	NoReason *NoReasonCode `xml:"NoRsn"`
 }
 
+func (p *PendingSettlementStatusChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingSettlementStatusChoice) AddReason() *PendingSettlementStatusReason1 {
 	p.Reason = new(PendingSettlementStatusReason1)
 	return p.Reason
@@ -23,6 +27,10 @@ func (p *PendingSettlementStatusChoice) AddDataSourceScheme() *GenericIdentifica
 	return p.DataSourceScheme
 }
 
-func (p *PendingSettlementStatusChoice) SetNoReason(value string) {
+func (p *PendingSettlementStatusChoice) SetNoReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoReason = (*NoReasonCode)(&value)
+	return nil
 }
@@ -10,6 +10,10 @@ type PendingSettlementStatusReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PendingSettlementStatusReason1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingSettlementStatusReason1) SetStructured(value string) {
 	p.Structured = (*PendingSettlementStatusReason1Code)(&value)
 }
@@ -10,8 +10,16 @@ type PendingStatus11Choice struct {
 	Reason []*PendingReason7 `xml:"Rsn"`
 }
 
-func (p *PendingStatus11Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus11Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus11Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus11Choice) AddReason() *PendingReason7 {
@@ -10,8 +10,16 @@ type PendingStatus12Choice struct {
 	Reason []*PendingReason8 `xml:"Rsn"`
 }
 
-func (p *PendingStatus12Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus12Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus12Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus12Choice) AddReason() *PendingReason8 {
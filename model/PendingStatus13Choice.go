@@ -10,8 +10,16 @@ type PendingStatus13Choice struct {
 	Reason []*PendingReason2 `xml:"Rsn"`
 }
 
-func (p *PendingStatus13Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus13Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus13Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus13Choice) AddReason() *PendingReason2 {
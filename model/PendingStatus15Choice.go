@@ -10,8 +10,16 @@ type PendingStatus15Choice struct {
 	Reason []*PendingReason1 `xml:"Rsn"`
 }
 
-func (p *PendingStatus15Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus15Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus15Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus15Choice) AddReason() *PendingReason1 {
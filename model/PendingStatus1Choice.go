@@ -10,8 +10,16 @@ type PendingStatus1Choice struct {
 	Reason []*PendingStatusReason1 `xml:"Rsn"`
 }
 
-func (p *PendingStatus1Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus1Choice) AddReason() *PendingStatusReason1 {
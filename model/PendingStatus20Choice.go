@@ -10,6 +10,10 @@ type PendingStatus20Choice struct {
 	UnderInvestigation *NoSpecifiedReason1 `xml:"UdrInvstgtn"`
 }
 
+func (p *PendingStatus20Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingStatus20Choice) AddForwarded() *NoSpecifiedReason1 {
 	p.Forwarded = new(NoSpecifiedReason1)
 	return p.Forwarded
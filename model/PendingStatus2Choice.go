@@ -10,8 +10,16 @@ type PendingStatus2Choice struct {
 	Reason []*PendingStatusReason2 `xml:"Rsn"`
 }
 
-func (p *PendingStatus2Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus2Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus2Choice) AddReason() *PendingStatusReason2 {
@@ -10,8 +10,16 @@ type PendingStatus32Choice struct {
 	Reason []*PendingStatusReason5 `xml:"Rsn"`
 }
 
-func (p *PendingStatus32Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus32Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus32Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus32Choice) AddReason() *PendingStatusReason5 {
@@ -10,8 +10,16 @@ type PendingStatus34Choice struct {
 	Reason []*PendingStatusReason7 `xml:"Rsn"`
 }
 
-func (p *PendingStatus34Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus34Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus34Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus34Choice) AddReason() *PendingStatusReason7 {
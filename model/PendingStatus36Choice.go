@@ -10,8 +10,16 @@ type PendingStatus36Choice struct {
 	Reason []*PendingReason14 `xml:"Rsn"`
 }
 
-func (p *PendingStatus36Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus36Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus36Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus36Choice) AddReason() *PendingReason14 {
@@ -10,8 +10,16 @@ type PendingStatus37Choice struct {
 	Reason []*PendingReason15 `xml:"Rsn"`
 }
 
-func (p *PendingStatus37Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus37Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus37Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus37Choice) AddReason() *PendingReason15 {
@@ -10,8 +10,16 @@ type PendingStatus38Choice struct {
 	Reason []*PendingReason16 `xml:"Rsn"`
 }
 
-func (p *PendingStatus38Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus38Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus38Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus38Choice) AddReason() *PendingReason16 {
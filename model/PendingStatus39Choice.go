@@ -10,8 +10,16 @@ type PendingStatus39Choice struct {
 	Reason []*PendingReason17 `xml:"Rsn"`
 }
 
-func (p *PendingStatus39Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus39Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus39Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus39Choice) AddReason() *PendingReason17 {
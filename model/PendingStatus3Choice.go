@@ -10,8 +10,16 @@ type PendingStatus3Choice struct {
 	Reason []*PendingReason1 `xml:"Rsn,omitempty"`
 }
 
-func (p *PendingStatus3Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus3Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus3Choice) AddReason() *PendingReason1 {
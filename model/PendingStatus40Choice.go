@@ -10,8 +10,16 @@ type PendingStatus40Choice struct {
 	Reason []*PendingReason18 `xml:"Rsn"`
 }
 
-func (p *PendingStatus40Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus40Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus40Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus40Choice) AddReason() *PendingReason18 {
@@ -10,8 +10,16 @@ type PendingStatus41Choice struct {
 	Reason []*PendingStatusReason9 `xml:"Rsn"`
 }
 
-func (p *PendingStatus41Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus41Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus41Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus41Choice) AddReason() *PendingStatusReason9 {
@@ -10,8 +10,16 @@ type PendingStatus42Choice struct {
 	Reason []*PendingStatusReason10 `xml:"Rsn"`
 }
 
-func (p *PendingStatus42Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus42Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus42Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus42Choice) AddReason() *PendingStatusReason10 {
@@ -10,8 +10,16 @@ type PendingStatus43Choice struct {
 	Reason []*PendingStatusReason11 `xml:"Rsn"`
 }
 
-func (p *PendingStatus43Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus43Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus43Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus43Choice) AddReason() *PendingStatusReason11 {
@@ -10,8 +10,16 @@ type PendingStatus44Choice struct {
 	Reason []*PendingStatusReason12 `xml:"Rsn"`
 }
 
-func (p *PendingStatus44Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus44Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus44Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus44Choice) AddReason() *PendingStatusReason12 {
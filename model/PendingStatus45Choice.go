@@ -10,8 +10,16 @@ type PendingStatus45Choice struct {
 	Reason []*PendingReason19 `xml:"Rsn"`
 }
 
-func (p *PendingStatus45Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus45Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus45Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus45Choice) AddReason() *PendingReason19 {
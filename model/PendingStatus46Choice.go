@@ -10,8 +10,16 @@ type PendingStatus46Choice struct {
 	Reason []*PendingReason20 `xml:"Rsn"`
 }
 
-func (p *PendingStatus46Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus46Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus46Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus46Choice) AddReason() *PendingReason20 {
@@ -10,8 +10,16 @@ type PendingStatus47Choice struct {
 	Reason []*PendingReason21 `xml:"Rsn"`
 }
 
-func (p *PendingStatus47Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus47Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus47Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus47Choice) AddReason() *PendingReason21 {
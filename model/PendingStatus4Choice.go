@@ -10,8 +10,16 @@ type PendingStatus4Choice struct {
 	Reason []*PendingReason2 `xml:"Rsn,omitempty"`
 }
 
-func (p *PendingStatus4Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus4Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus4Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus4Choice) AddReason() *PendingReason2 {
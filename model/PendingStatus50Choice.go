@@ -10,8 +10,16 @@ type PendingStatus50Choice struct {
 	Reason []*PendingReason24 `xml:"Rsn"`
 }
 
-func (p *PendingStatus50Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus50Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus50Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus50Choice) AddReason() *PendingReason24 {
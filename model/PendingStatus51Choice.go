@@ -10,8 +10,16 @@ type PendingStatus51Choice struct {
 	Reason []*PendingReason25 `xml:"Rsn"`
 }
 
-func (p *PendingStatus51Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus51Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus51Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus51Choice) AddReason() *PendingReason25 {
@@ -10,8 +10,16 @@ type PendingStatus52Choice struct {
 	Reason []*PendingStatusReason13 `xml:"Rsn"`
 }
 
-func (p *PendingStatus52Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus52Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus52Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus52Choice) AddReason() *PendingStatusReason13 {
@@ -10,8 +10,16 @@ type PendingStatus53Choice struct {
 	Reason []*PendingStatusReason15 `xml:"Rsn"`
 }
 
-func (p *PendingStatus53Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus53Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus53Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus53Choice) AddReason() *PendingStatusReason15 {
@@ -10,8 +10,16 @@ type PendingStatus9Choice struct {
 	Reason []*PendingReason5 `xml:"Rsn"`
 }
 
-func (p *PendingStatus9Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatus9Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatus9Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatus9Choice) AddReason() *PendingReason5 {
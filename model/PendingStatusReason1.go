@@ -10,6 +10,10 @@ type PendingStatusReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingStatusReason1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingStatusReason1) AddReasonCode() *PendingReason6Choice {
 	p.ReasonCode = new(PendingReason6Choice)
 	return p.ReasonCode
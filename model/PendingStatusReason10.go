@@ -10,6 +10,10 @@ type PendingStatusReason10 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingStatusReason10) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingStatusReason10) AddReasonCode() *PendingReason33Choice {
 	p.ReasonCode = new(PendingReason33Choice)
 	return p.ReasonCode
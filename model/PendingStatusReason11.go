@@ -10,6 +10,10 @@ type PendingStatusReason11 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingStatusReason11) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingStatusReason11) AddReasonCode() *PendingReason34Choice {
 	p.ReasonCode = new(PendingReason34Choice)
 	return p.ReasonCode
@@ -10,6 +10,10 @@ type PendingStatusReason12 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingStatusReason12) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingStatusReason12) AddReasonCode() *PendingReason35Choice {
 	p.ReasonCode = new(PendingReason35Choice)
 	return p.ReasonCode
@@ -10,6 +10,10 @@ type PendingStatusReason13 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingStatusReason13) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingStatusReason13) AddReasonCode() *PendingReason48Choice {
 	p.ReasonCode = new(PendingReason48Choice)
 	return p.ReasonCode
@@ -10,6 +10,10 @@ type PendingStatusReason14 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PendingStatusReason14) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingStatusReason14) AddCode() *PendingStatusReason2Choice {
 	p.Code = new(PendingStatusReason2Choice)
 	return p.Code
@@ -10,6 +10,10 @@ type PendingStatusReason15 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingStatusReason15) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingStatusReason15) AddReasonCode() *PendingReason49Choice {
 	p.ReasonCode = new(PendingReason49Choice)
 	return p.ReasonCode
@@ -10,8 +10,16 @@ type PendingStatusReason1Choice struct {
 	Reason []*PendingStatusReason14 `xml:"Rsn"`
 }
 
-func (p *PendingStatusReason1Choice) SetNoSpecifiedReason(value string) {
+func (p *PendingStatusReason1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PendingStatusReason1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *PendingStatusReason1Choice) AddReason() *PendingStatusReason14 {
@@ -10,6 +10,10 @@ type PendingStatusReason2 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingStatusReason2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingStatusReason2) AddReasonCode() *PendingReason4Choice {
 	p.ReasonCode = new(PendingReason4Choice)
 	return p.ReasonCode
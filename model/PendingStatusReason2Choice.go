@@ -10,6 +10,10 @@ type PendingStatusReason2Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (p *PendingStatusReason2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingStatusReason2Choice) SetCode(value string) {
 	p.Code = (*PendingStatusReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type PendingStatusReason5 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingStatusReason5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingStatusReason5) AddReasonCode() *PendingReason22Choice {
 	p.ReasonCode = new(PendingReason22Choice)
 	return p.ReasonCode
@@ -10,6 +10,10 @@ type PendingStatusReason7 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingStatusReason7) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingStatusReason7) AddReasonCode() *PendingReason24Choice {
 	p.ReasonCode = new(PendingReason24Choice)
 	return p.ReasonCode
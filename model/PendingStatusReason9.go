@@ -10,6 +10,10 @@ type PendingStatusReason9 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *PendingStatusReason9) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PendingStatusReason9) AddReasonCode() *PendingReason32Choice {
 	p.ReasonCode = new(PendingReason32Choice)
 	return p.ReasonCode
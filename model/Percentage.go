@@ -1,3 +1,19 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 type Percentage string
+
+func (p Percentage) Validate() error {
+	return validateDecimal("Percentage", string(p), 11, 10)
+}
+
+func (p Percentage) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(string(p))
+}
+
+func (p *Percentage) FromDecimal(value decimal.Decimal) {
+	*p = Percentage(value.String())
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Rate information.
 type Percentage1 struct {
 
@@ -10,10 +14,19 @@ type Percentage1 struct {
 	RelativeTo *ExternalRelativeTo1Code `xml:"RltvTo"`
 }
 
+func (p *Percentage1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Percentage1) SetRate(value string) {
 	p.Rate = (*PercentageRate)(&value)
 }
 
+func (p *Percentage1) SetRateFromDecimal(value decimal.Decimal) {
+	p.Rate = new(PercentageRate)
+	p.Rate.FromDecimal(value)
+}
+
 func (p *Percentage1) SetRelativeTo(value string) {
 	p.RelativeTo = (*ExternalRelativeTo1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies a percentage together with a period of time. For overlapping periods, the maximum of all applicable elements at a given date is the result.
 type PercentageAndPeriod1 struct {
 
@@ -13,14 +19,33 @@ type PercentageAndPeriod1 struct {
 	EndDate *ISODate `xml:"EndDt,omitempty"`
 }
 
+func (p *PercentageAndPeriod1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PercentageAndPeriod1) SetPercentage(value string) {
 	p.Percentage = (*PercentageBoundedRate)(&value)
 }
 
+func (p *PercentageAndPeriod1) SetPercentageFromDecimal(value decimal.Decimal) {
+	p.Percentage = new(PercentageBoundedRate)
+	p.Percentage.FromDecimal(value)
+}
+
 func (p *PercentageAndPeriod1) SetStartDate(value string) {
 	p.StartDate = (*ISODate)(&value)
 }
 
+func (p *PercentageAndPeriod1) SetStartDateFromTime(value time.Time) {
+	p.StartDate = new(ISODate)
+	p.StartDate.FromTime(value)
+}
+
 func (p *PercentageAndPeriod1) SetEndDate(value string) {
 	p.EndDate = (*ISODate)(&value)
 }
+
+func (p *PercentageAndPeriod1) SetEndDateFromTime(value time.Time) {
+	p.EndDate = new(ISODate)
+	p.EndDate.FromTime(value)
+}
@@ -1,3 +1,25 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 type PercentageBoundedRate string
+
+func (p PercentageBoundedRate) Validate() error {
+	if err := validateDecimal("PercentageBoundedRate", string(p), 11, 10); err != nil {
+		return err
+	}
+	if err := validateInclusive("PercentageBoundedRate", string(p), "minInclusive", "-100"); err != nil {
+		return err
+	}
+	return validateInclusive("PercentageBoundedRate", string(p), "maxInclusive", "100")
+}
+
+func (p PercentageBoundedRate) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(string(p))
+}
+
+func (p *PercentageBoundedRate) FromDecimal(value decimal.Decimal) {
+	*p = PercentageBoundedRate(value.String())
+}
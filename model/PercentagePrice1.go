@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Price expressed as a percentage price.
 type PercentagePrice1 struct {
 
@@ -10,6 +14,10 @@ type PercentagePrice1 struct {
 	PriceValue *PercentageRate `xml:"PricVal"`
 }
 
+func (p *PercentagePrice1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PercentagePrice1) SetPercentagePriceType(value string) {
 	p.PercentagePriceType = (*PriceRateType3Code)(&value)
 }
@@ -17,3 +25,8 @@ func (p *PercentagePrice1) SetPercentagePriceType(value string) {
 func (p *PercentagePrice1) SetPriceValue(value string) {
 	p.PriceValue = (*PercentageRate)(&value)
 }
+
+func (p *PercentagePrice1) SetPriceValueFromDecimal(value decimal.Decimal) {
+	p.PriceValue = new(PercentageRate)
+	p.PriceValue.FromDecimal(value)
+}
@@ -1,3 +1,19 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 type PercentageRate string
+
+func (p PercentageRate) Validate() error {
+	return validateDecimal("PercentageRate", string(p), 11, 10)
+}
+
+func (p PercentageRate) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(string(p))
+}
+
+func (p *PercentageRate) FromDecimal(value decimal.Decimal) {
+	*p = PercentageRate(value.String())
+}
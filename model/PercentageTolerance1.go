@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Variance allowed on a quantity or on a price.
 type PercentageTolerance1 struct {
 
@@ -10,10 +14,24 @@ type PercentageTolerance1 struct {
 	MinusPercent *PercentageRate `xml:"MnsPct"`
 }
 
+func (p *PercentageTolerance1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PercentageTolerance1) SetPlusPercent(value string) {
 	p.PlusPercent = (*PercentageRate)(&value)
 }
 
+func (p *PercentageTolerance1) SetPlusPercentFromDecimal(value decimal.Decimal) {
+	p.PlusPercent = new(PercentageRate)
+	p.PlusPercent.FromDecimal(value)
+}
+
 func (p *PercentageTolerance1) SetMinusPercent(value string) {
 	p.MinusPercent = (*PercentageRate)(&value)
 }
+
+func (p *PercentageTolerance1) SetMinusPercentFromDecimal(value decimal.Decimal) {
+	p.MinusPercent = new(PercentageRate)
+	p.MinusPercent.FromDecimal(value)
+}
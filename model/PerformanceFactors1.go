@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Performance factors of the investment fund / fund class.
 type PerformanceFactors1 struct {
 
@@ -16,14 +20,28 @@ type PerformanceFactors1 struct {
 	NormalPerformance *DecimalNumber `xml:"NrmlPrfrmnc,omitempty"`
 }
 
+func (p *PerformanceFactors1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PerformanceFactors1) SetCorporateActionFactor(value string) {
 	p.CorporateActionFactor = (*DecimalNumber)(&value)
 }
 
+func (p *PerformanceFactors1) SetCorporateActionFactorFromDecimal(value decimal.Decimal) {
+	p.CorporateActionFactor = new(DecimalNumber)
+	p.CorporateActionFactor.FromDecimal(value)
+}
+
 func (p *PerformanceFactors1) SetCumulativeCorporateActionFactor(value string) {
 	p.CumulativeCorporateActionFactor = (*DecimalNumber)(&value)
 }
 
+func (p *PerformanceFactors1) SetCumulativeCorporateActionFactorFromDecimal(value decimal.Decimal) {
+	p.CumulativeCorporateActionFactor = new(DecimalNumber)
+	p.CumulativeCorporateActionFactor.FromDecimal(value)
+}
+
 func (p *PerformanceFactors1) AddAccumulationPeriod() *DatePeriodDetails {
 	p.AccumulationPeriod = new(DatePeriodDetails)
 	return p.AccumulationPeriod
@@ -32,3 +50,8 @@ func (p *PerformanceFactors1) AddAccumulationPeriod() *DatePeriodDetails {
 func (p *PerformanceFactors1) SetNormalPerformance(value string) {
 	p.NormalPerformance = (*DecimalNumber)(&value)
 }
+
+func (p *PerformanceFactors1) SetNormalPerformanceFromDecimal(value decimal.Decimal) {
+	p.NormalPerformance = new(DecimalNumber)
+	p.NormalPerformance.FromDecimal(value)
+}
@@ -10,6 +10,10 @@ type Period1 struct {
 	EndDate *DateFormat4Choice `xml:"EndDt"`
 }
 
+func (p *Period1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Period1) AddStartDate() *DateFormat4Choice {
 	p.StartDate = new(DateFormat4Choice)
 	return p.StartDate
@@ -10,6 +10,10 @@ type Period1Choice struct {
 	PeriodCode *DateType6Code `xml:"PrdCd"`
 }
 
+func (p *Period1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Period1Choice) AddPeriod() *Period3 {
 	p.Period = new(Period3)
 	return p.Period
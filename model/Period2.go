@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Time span defined by a start date and time, and an end date and time.
 type Period2 struct {
 
@@ -10,10 +14,24 @@ type Period2 struct {
 	ToDate *ISODate `xml:"ToDt"`
 }
 
+func (p *Period2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Period2) SetFromDate(value string) {
 	p.FromDate = (*ISODate)(&value)
 }
 
+func (p *Period2) SetFromDateFromTime(value time.Time) {
+	p.FromDate = new(ISODate)
+	p.FromDate.FromTime(value)
+}
+
 func (p *Period2) SetToDate(value string) {
 	p.ToDate = (*ISODate)(&value)
 }
+
+func (p *Period2) SetToDateFromTime(value time.Time) {
+	p.ToDate = new(ISODate)
+	p.ToDate.FromTime(value)
+}
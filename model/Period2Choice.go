@@ -10,6 +10,10 @@ type Period2Choice struct {
 	FromDateToDate *Period2 `xml:"FrDtToDt"`
 }
 
+func (p *Period2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Period2Choice) AddFromDateTimeToDateTime() *DateTimePeriodDetails {
 	p.FromDateTimeToDateTime = new(DateTimePeriodDetails)
 	return p.FromDateTimeToDateTime
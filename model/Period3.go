@@ -10,6 +10,10 @@ type Period3 struct {
 	EndDate *DateFormat12Choice `xml:"EndDt"`
 }
 
+func (p *Period3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Period3) AddStartDate() *DateFormat12Choice {
 	p.StartDate = new(DateFormat12Choice)
 	return p.StartDate
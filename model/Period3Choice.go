@@ -10,6 +10,10 @@ type Period3Choice struct {
 	PeriodCode *DateType8Code `xml:"PrdCd"`
 }
 
+func (p *Period3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Period3Choice) AddPeriod() *Period4 {
 	p.Period = new(Period4)
 	return p.Period
@@ -10,6 +10,10 @@ type Period4 struct {
 	EndDate *DateFormat18Choice `xml:"EndDt"`
 }
 
+func (p *Period4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Period4) AddStartDate() *DateFormat18Choice {
 	p.StartDate = new(DateFormat18Choice)
 	return p.StartDate
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice between date and date-time for the specification of a period.
 type Period4Choice struct {
 
@@ -16,18 +20,37 @@ type Period4Choice struct {
 	FromDateToDate *Period2 `xml:"FrDtToDt"`
 }
 
+func (p *Period4Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Period4Choice) SetDate(value string) {
 	p.Date = (*ISODate)(&value)
 }
 
+func (p *Period4Choice) SetDateFromTime(value time.Time) {
+	p.Date = new(ISODate)
+	p.Date.FromTime(value)
+}
+
 func (p *Period4Choice) SetFromDate(value string) {
 	p.FromDate = (*ISODate)(&value)
 }
 
+func (p *Period4Choice) SetFromDateFromTime(value time.Time) {
+	p.FromDate = new(ISODate)
+	p.FromDate.FromTime(value)
+}
+
 func (p *Period4Choice) SetToDate(value string) {
 	p.ToDate = (*ISODate)(&value)
 }
 
+func (p *Period4Choice) SetToDateFromTime(value time.Time) {
+	p.ToDate = new(ISODate)
+	p.ToDate.FromTime(value)
+}
+
 func (p *Period4Choice) AddFromDateToDate() *Period2 {
 	p.FromDateToDate = new(Period2)
 	return p.FromDateToDate
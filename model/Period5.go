@@ -10,6 +10,10 @@ type Period5 struct {
 	EndDate *DateFormat21Choice `xml:"EndDt"`
 }
 
+func (p *Period5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Period5) AddStartDate() *DateFormat21Choice {
 	p.StartDate = new(DateFormat21Choice)
 	return p.StartDate
@@ -55,6 +55,10 @@ type PersonIdentification11 struct {
 	Other []*GenericIdentification4 `xml:"Othr,omitempty"`
 }
 
+func (p *PersonIdentification11) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PersonIdentification11) SetDriverLicenseNumber(value string) {
 	p.DriverLicenseNumber = (*Max35Text)(&value)
 }
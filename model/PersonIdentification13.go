@@ -0,0 +1,71 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Unique and unambiguous way to identify a person.
+type PersonIdentification13 struct {
+
+	// Date and place of birth of a person.
+	DateAndPlaceOfBirth *DateAndPlaceOfBirth1 `xml:"DtAndPlcOfBirth,omitempty"`
+
+	// Unique identification of a person, as assigned by an institution, using an identification scheme.
+	Other []*GenericPersonIdentification1 `xml:"Othr,omitempty"`
+}
+
+func (p *PersonIdentification13) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PersonIdentification13) AddDateAndPlaceOfBirth() *DateAndPlaceOfBirth1 {
+	p.DateAndPlaceOfBirth = new(DateAndPlaceOfBirth1)
+	return p.DateAndPlaceOfBirth
+}
+
+func (p *PersonIdentification13) AddOther() *GenericPersonIdentification1 {
+	newValue := new(GenericPersonIdentification1)
+	p.Other = append(p.Other, newValue)
+	return newValue
+}
+
+func (p *PersonIdentification13) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.DateAndPlaceOfBirth != nil {
+		p.DateAndPlaceOfBirth.EncodeXMLFast(enc, "DtAndPlcOfBirth")
+	}
+	for _, elem := range p.Other {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Othr")
+		}
+	}
+	enc.End(tag)
+}
+
+func (p *PersonIdentification13) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "DtAndPlcOfBirth":
+			elem := new(DateAndPlaceOfBirth1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.DateAndPlaceOfBirth = elem
+		case "Othr":
+			elem := new(GenericPersonIdentification1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Other = append(p.Other, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
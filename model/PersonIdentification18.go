@@ -0,0 +1,26 @@
+package model
+
+// Unique and unambiguous way to identify a person.
+type PersonIdentification18 struct {
+
+	// Date and place of birth of a person.
+	DateAndPlaceOfBirth *DateAndPlaceOfBirth1 `xml:"DtAndPlcOfBirth,omitempty"`
+
+	// Unique identification of a person, as assigned by an institution, using an identification scheme.
+	Other []*GenericPersonIdentification2 `xml:"Othr,omitempty"`
+}
+
+func (p *PersonIdentification18) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PersonIdentification18) AddDateAndPlaceOfBirth() *DateAndPlaceOfBirth1 {
+	p.DateAndPlaceOfBirth = new(DateAndPlaceOfBirth1)
+	return p.DateAndPlaceOfBirth
+}
+
+func (p *PersonIdentification18) AddOther() *GenericPersonIdentification2 {
+	newValue := new(GenericPersonIdentification2)
+	p.Other = append(p.Other, newValue)
+	return newValue
+}
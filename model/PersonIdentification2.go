@@ -31,6 +31,10 @@ type PersonIdentification2 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (p *PersonIdentification2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PersonIdentification2) SetDriversLicenseNumber(value string) {
 	p.DriversLicenseNumber = (*Max35Text)(&value)
 }
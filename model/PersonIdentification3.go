@@ -37,6 +37,10 @@ type PersonIdentification3 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (p *PersonIdentification3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PersonIdentification3) SetDriversLicenseNumber(value string) {
 	p.DriversLicenseNumber = (*Max35Text)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Unique and unambiguous way to identify a person.
 type PersonIdentification5 struct {
 
@@ -10,6 +12,10 @@ type PersonIdentification5 struct {
 	Other []*GenericPersonIdentification1 `xml:"Othr,omitempty"`
 }
 
+func (p *PersonIdentification5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PersonIdentification5) AddDateAndPlaceOfBirth() *DateAndPlaceOfBirth {
 	p.DateAndPlaceOfBirth = new(DateAndPlaceOfBirth)
 	return p.DateAndPlaceOfBirth
@@ -21,6 +27,49 @@ func (p *PersonIdentification5) AddOther() *GenericPersonIdentification1 {
 	return newValue
 }
 
+func (p *PersonIdentification5) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.DateAndPlaceOfBirth != nil {
+		p.DateAndPlaceOfBirth.EncodeXMLFast(enc, "DtAndPlcOfBirth")
+	}
+	for _, elem := range p.Other {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Othr")
+		}
+	}
+	enc.End(tag)
+}
+
+func (p *PersonIdentification5) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "DtAndPlcOfBirth":
+			elem := new(DateAndPlaceOfBirth)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.DateAndPlaceOfBirth = elem
+		case "Othr":
+			elem := new(GenericPersonIdentification1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Other = append(p.Other, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -10,6 +10,10 @@ type PersonIdentification6 struct {
 	PersonIdentificationType *PersonIdentificationType1Choice `xml:"PrsnIdTp"`
 }
 
+func (p *PersonIdentification6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PersonIdentification6) SetIssuer(value string) {
 	p.Issuer = (*Max35Text)(&value)
 }
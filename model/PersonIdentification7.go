@@ -40,6 +40,10 @@ type PersonIdentification7 struct {
 	Other []*GenericIdentification4 `xml:"Othr,omitempty"`
 }
 
+func (p *PersonIdentification7) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PersonIdentification7) SetDriversLicenseNumber(value string) {
 	p.DriversLicenseNumber = (*Max35Text)(&value)
 }
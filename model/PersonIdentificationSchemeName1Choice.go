@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Sets of elements to identify a name of the identification scheme.
 type PersonIdentificationSchemeName1Choice struct {
 
@@ -10,6 +12,10 @@ type PersonIdentificationSchemeName1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (p *PersonIdentificationSchemeName1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PersonIdentificationSchemeName1Choice) SetCode(value string) {
 	p.Code = (*ExternalPersonIdentification1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (p *PersonIdentificationSchemeName1Choice) SetCode(value string) {
 func (p *PersonIdentificationSchemeName1Choice) SetProprietary(value string) {
 	p.Proprietary = (*Max35Text)(&value)
 }
+
+func (p *PersonIdentificationSchemeName1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Code != nil {
+		enc.Element("Cd", string(*p.Code))
+	}
+	if p.Proprietary != nil {
+		enc.Element("Prtry", string(*p.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (p *PersonIdentificationSchemeName1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Code = (*ExternalPersonIdentification1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
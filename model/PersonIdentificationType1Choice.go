@@ -28,6 +28,10 @@ type PersonIdentificationType1Choice struct {
 	OtherIdentification *GenericIdentification4 `xml:"OthrId"`
 }
 
+func (p *PersonIdentificationType1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PersonIdentificationType1Choice) SetPassportNumber(value string) {
 	p.PassportNumber = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type PersonIdentificationType3Choice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (p *PersonIdentificationType3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PersonIdentificationType3Choice) SetCode(value string) {
 	p.Code = (*PersonIdentificationType3Code)(&value)
 }
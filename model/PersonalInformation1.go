@@ -13,6 +13,10 @@ type PersonalInformation1 struct {
 	NameOfPartner *Max35Text `xml:"NmOfPrtnr,omitempty"`
 }
 
+func (p *PersonalInformation1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PersonalInformation1) SetNameOfFather(value string) {
 	p.NameOfFather = (*Max35Text)(&value)
 }
@@ -1,3 +1,9 @@
 package model
 
 type PhoneNumber string
+
+var phoneNumberPattern = newPattern(`\+[0-9]{1,3}-[0-9()+\-]{1,30}`)
+
+func (p PhoneNumber) Validate() error {
+	return validatePattern("PhoneNumber", string(p), phoneNumberPattern)
+}
@@ -10,6 +10,10 @@ type PlaceOfClearingIdentification1 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (p *PlaceOfClearingIdentification1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlaceOfClearingIdentification1) SetIdentification(value string) {
 	p.Identification = (*AnyBICIdentifier)(&value)
 }
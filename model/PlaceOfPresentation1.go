@@ -10,6 +10,10 @@ type PlaceOfPresentation1 struct {
 	Country *CountryCode `xml:"Ctry,omitempty"`
 }
 
+func (p *PlaceOfPresentation1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlaceOfPresentation1) SetPlace(value string) {
 	p.Place = (*ExternalTypeOfParty1Code)(&value)
 }
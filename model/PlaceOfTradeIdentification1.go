@@ -10,6 +10,10 @@ type PlaceOfTradeIdentification1 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (p *PlaceOfTradeIdentification1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlaceOfTradeIdentification1) AddMarketTypeAndIdentification() *MarketIdentification84 {
 	p.MarketTypeAndIdentification = new(MarketIdentification84)
 	return p.MarketTypeAndIdentification
@@ -16,6 +16,10 @@ type PlaceOfTradeIdentification1Choice struct {
 	OverTheCounter *Max35Text `xml:"OverTheCntr"`
 }
 
+func (p *PlaceOfTradeIdentification1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlaceOfTradeIdentification1Choice) SetCountry(value string) {
 	p.Country = (*CountryCode)(&value)
 }
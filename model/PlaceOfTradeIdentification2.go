@@ -10,6 +10,10 @@ type PlaceOfTradeIdentification2 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (p *PlaceOfTradeIdentification2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlaceOfTradeIdentification2) AddMarketTypeAndIdentification() *MarketIdentification90 {
 	p.MarketTypeAndIdentification = new(MarketIdentification90)
 	return p.MarketTypeAndIdentification
@@ -10,6 +10,10 @@ type PlaceOfTradeIdentification2Choice struct {
 	OffMarket *OffMarket1Choice `xml:"OffMkt"`
 }
 
+func (p *PlaceOfTradeIdentification2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlaceOfTradeIdentification2Choice) SetMarketIdentification(value string) {
 	p.MarketIdentification = (*MICIdentifier)(&value)
 }
@@ -10,6 +10,10 @@ type PlaceOrUnderConfirmationChoice1 struct {
 	PresentationUnderConfirmation *PresentationParty1Code `xml:"PresntnUdrConf"`
 }
 
+func (p *PlaceOrUnderConfirmationChoice1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlaceOrUnderConfirmationChoice1) AddPlaceOfPresentation() *PlaceOfPresentation1 {
 	p.PlaceOfPresentation = new(PlaceOfPresentation1)
 	return p.PlaceOfPresentation
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Sensible data associated with the payment card performing the transaction.
 type PlainCardData1 struct {
 
@@ -25,6 +27,10 @@ type PlainCardData1 struct {
 	CardSecurityCode *CardSecurityInformation1 `xml:"CardSctyCd,omitempty"`
 }
 
+func (p *PlainCardData1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData1) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
@@ -195,3 +201,91 @@ func (p *PlainCardData1) AddCardSecurityCode() *CardSecurityInformation1 {
 	p.CardSecurityCode = new(CardSecurityInformation1)
 	return p.CardSecurityCode
 }
+
+func (p *PlainCardData1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.PAN != nil {
+		enc.Element("PAN", string(*p.PAN))
+	}
+	if p.CardSequenceNumber != nil {
+		enc.Element("CardSeqNb", string(*p.CardSequenceNumber))
+	}
+	if p.EffectiveDate != nil {
+		enc.Element("FctvDt", string(*p.EffectiveDate))
+	}
+	if p.ExpiryDate != nil {
+		enc.Element("XpryDt", string(*p.ExpiryDate))
+	}
+	if p.ServiceCode != nil {
+		enc.Element("SvcCd", string(*p.ServiceCode))
+	}
+	for _, elem := range p.TrackData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "TrckData")
+		}
+	}
+	if p.CardSecurityCode != nil {
+		p.CardSecurityCode.EncodeXMLFast(enc, "CardSctyCd")
+	}
+	enc.End(tag)
+}
+
+func (p *PlainCardData1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "PAN":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.PAN = (*Min8Max28NumericText)(&value)
+		case "CardSeqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.CardSequenceNumber = (*Min2Max3NumericText)(&value)
+		case "FctvDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.EffectiveDate = (*ISOYearMonth)(&value)
+		case "XpryDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ExpiryDate = (*ISOYearMonth)(&value)
+		case "SvcCd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ServiceCode = (*Exact3NumericText)(&value)
+		case "TrckData":
+			elem := new(TrackData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.TrackData = append(p.TrackData, elem)
+		case "CardSctyCd":
+			elem := new(CardSecurityInformation1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.CardSecurityCode = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -26,6 +26,10 @@ type PlainCardData10 struct {
 	CardholderName *Max45Text `xml:"CrdhldrNm,omitempty"`
 }
 
+func (p *PlainCardData10) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData10) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
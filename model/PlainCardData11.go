@@ -19,6 +19,10 @@ type PlainCardData11 struct {
 	ServiceCode *Exact3NumericText `xml:"SvcCd,omitempty"`
 }
 
+func (p *PlainCardData11) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData11) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
@@ -13,6 +13,10 @@ type PlainCardData12 struct {
 	ExpiryDate *Max10Text `xml:"XpryDt,omitempty"`
 }
 
+func (p *PlainCardData12) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData12) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
@@ -31,6 +31,10 @@ type PlainCardData13 struct {
 	CardholderName *Max45Text `xml:"CrdhldrNm,omitempty"`
 }
 
+func (p *PlainCardData13) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData13) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
@@ -25,6 +25,10 @@ type PlainCardData14 struct {
 	Track3 *Max140Text `xml:"Trck3,omitempty"`
 }
 
+func (p *PlainCardData14) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData14) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
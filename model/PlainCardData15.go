@@ -31,6 +31,10 @@ type PlainCardData15 struct {
 	CardholderName *Max45Text `xml:"CrdhldrNm,omitempty"`
 }
 
+func (p *PlainCardData15) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData15) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
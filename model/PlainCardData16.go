@@ -28,6 +28,10 @@ type PlainCardData16 struct {
 	Track3 *Max104Text `xml:"Trck3,omitempty"`
 }
 
+func (p *PlainCardData16) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData16) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
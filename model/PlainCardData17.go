@@ -22,6 +22,10 @@ type PlainCardData17 struct {
 	EntryMode *CardDataReading5Code `xml:"NtryMd,omitempty"`
 }
 
+func (p *PlainCardData17) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData17) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
@@ -31,6 +31,10 @@ type PlainCardData18 struct {
 	CardholderName *Max45Text `xml:"CrdhldrNm,omitempty"`
 }
 
+func (p *PlainCardData18) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData18) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
@@ -25,6 +25,10 @@ type PlainCardData19 struct {
 	Track3 *Max104Text `xml:"Trck3,omitempty"`
 }
 
+func (p *PlainCardData19) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData19) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
@@ -22,6 +22,10 @@ type PlainCardData2 struct {
 	TrackData []*TrackData1 `xml:"TrckData,omitempty"`
 }
 
+func (p *PlainCardData2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData2) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
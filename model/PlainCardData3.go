@@ -16,6 +16,10 @@ type PlainCardData3 struct {
 	ExpiryDate *ISOYearMonth `xml:"XpryDt"`
 }
 
+func (p *PlainCardData3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData3) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
@@ -25,6 +25,10 @@ type PlainCardData4 struct {
 	CardSecurityCode *CardSecurityInformation1 `xml:"CardSctyCd,omitempty"`
 }
 
+func (p *PlainCardData4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData4) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
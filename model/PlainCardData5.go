@@ -16,6 +16,10 @@ type PlainCardData5 struct {
 	ExpiryDate *Max10Text `xml:"XpryDt"`
 }
 
+func (p *PlainCardData5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData5) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
@@ -22,6 +22,10 @@ type PlainCardData6 struct {
 	TrackData []*TrackData1 `xml:"TrckData,omitempty"`
 }
 
+func (p *PlainCardData6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData6) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
@@ -25,6 +25,10 @@ type PlainCardData7 struct {
 	CardholderName *Max45Text `xml:"CrdhldrNm,omitempty"`
 }
 
+func (p *PlainCardData7) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData7) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
@@ -16,6 +16,10 @@ type PlainCardData8 struct {
 	ExpiryDate *Max10Text `xml:"XpryDt"`
 }
 
+func (p *PlainCardData8) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData8) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
@@ -22,6 +22,10 @@ type PlainCardData9 struct {
 	TrackData []*TrackData1 `xml:"TrckData,omitempty"`
 }
 
+func (p *PlainCardData9) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlainCardData9) SetPAN(value string) {
 	p.PAN = (*Min8Max28NumericText)(&value)
 }
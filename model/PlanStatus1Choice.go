@@ -10,6 +10,10 @@ type PlanStatus1Choice struct {
 	Proprietary *GenericIdentification29 `xml:"Prtry"`
 }
 
+func (p *PlanStatus1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlanStatus1Choice) SetCode(value string) {
 	p.Code = (*PlanStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type PlanStatus2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PlanStatus2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PlanStatus2Choice) SetCode(value string) {
 	p.Code = (*PlanStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Pledgee1 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (p *Pledgee1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Pledgee1) AddPledgeeTypeAndIdentification() *PledgeeFormat3Choice {
 	p.PledgeeTypeAndIdentification = new(PledgeeFormat3Choice)
 	return p.PledgeeTypeAndIdentification
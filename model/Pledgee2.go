@@ -10,6 +10,10 @@ type Pledgee2 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (p *Pledgee2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Pledgee2) AddPledgeeTypeAndIdentification() *PledgeeFormat4Choice {
 	p.PledgeeTypeAndIdentification = new(PledgeeFormat4Choice)
 	return p.PledgeeTypeAndIdentification
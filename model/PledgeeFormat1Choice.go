@@ -13,6 +13,10 @@ type PledgeeFormat1Choice struct {
 	Proprietary *GenericIdentification58 `xml:"Prtry"`
 }
 
+func (p *PledgeeFormat1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PledgeeFormat1Choice) AddTypeAndIdentification() *PledgeeTypeAndAnyBICIdentifier1 {
 	p.TypeAndIdentification = new(PledgeeTypeAndAnyBICIdentifier1)
 	return p.TypeAndIdentification
@@ -13,6 +13,10 @@ type PledgeeFormat3Choice struct {
 	Proprietary *GenericIdentification80 `xml:"Prtry"`
 }
 
+func (p *PledgeeFormat3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PledgeeFormat3Choice) AddTypeAndIdentification() *PledgeeTypeAndAnyBICIdentifier1 {
 	p.TypeAndIdentification = new(PledgeeTypeAndAnyBICIdentifier1)
 	return p.TypeAndIdentification
@@ -13,6 +13,10 @@ type PledgeeFormat4Choice struct {
 	Proprietary *GenericIdentification85 `xml:"Prtry"`
 }
 
+func (p *PledgeeFormat4Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PledgeeFormat4Choice) AddTypeAndIdentification() *PledgeeTypeAndAnyBICIdentifier1 {
 	p.TypeAndIdentification = new(PledgeeTypeAndAnyBICIdentifier1)
 	return p.TypeAndIdentification
@@ -10,6 +10,10 @@ type PledgeeTypeAndAnyBICIdentifier1 struct {
 	PledgeeType *PledgeeType1Code `xml:"PldgeeTp"`
 }
 
+func (p *PledgeeTypeAndAnyBICIdentifier1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PledgeeTypeAndAnyBICIdentifier1) SetIdentification(value string) {
 	p.Identification = (*AnyBICIdentifier)(&value)
 }
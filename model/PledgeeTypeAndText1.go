@@ -10,6 +10,10 @@ type PledgeeTypeAndText1 struct {
 	PledgeeType *PledgeeType1Code `xml:"PldgeeTp"`
 }
 
+func (p *PledgeeTypeAndText1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PledgeeTypeAndText1) SetIdentification(value string) {
 	p.Identification = (*Max35Text)(&value)
 }
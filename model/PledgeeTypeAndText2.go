@@ -10,6 +10,10 @@ type PledgeeTypeAndText2 struct {
 	PledgeeType *PledgeeType1Code `xml:"PldgeeTp"`
 }
 
+func (p *PledgeeTypeAndText2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PledgeeTypeAndText2) SetIdentification(value string) {
 	p.Identification = (*RestrictedFINMax30Text)(&value)
 }
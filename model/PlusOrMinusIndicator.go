@@ -1,3 +1,15 @@
 package model
 
 type PlusOrMinusIndicator string
+
+func (p PlusOrMinusIndicator) Validate() error {
+	return validateBoolean("PlusOrMinusIndicator", string(p))
+}
+
+func (p PlusOrMinusIndicator) Bool() bool {
+	return parseBoolean(string(p))
+}
+
+func (p *PlusOrMinusIndicator) FromBool(value bool) {
+	*p = PlusOrMinusIndicator(formatBoolean(value))
+}
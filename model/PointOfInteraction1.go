@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Point of interaction (POI) performing the transaction.
 type PointOfInteraction1 struct {
 
@@ -19,6 +21,10 @@ type PointOfInteraction1 struct {
 	Component []*PointOfInteractionComponent1 `xml:"Cmpnt,omitempty"`
 }
 
+func (p *PointOfInteraction1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteraction1) AddIdentification() *GenericIdentification32 {
 	p.Identification = new(GenericIdentification32)
 	return p.Identification
@@ -43,6 +49,76 @@ func (p *PointOfInteraction1) AddComponent() *PointOfInteractionComponent1 {
 	return newValue
 }
 
+func (p *PointOfInteraction1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Identification != nil {
+		p.Identification.EncodeXMLFast(enc, "Id")
+	}
+	if p.SystemName != nil {
+		enc.Element("SysNm", string(*p.SystemName))
+	}
+	if p.GroupIdentification != nil {
+		enc.Element("GrpId", string(*p.GroupIdentification))
+	}
+	if p.Capabilities != nil {
+		p.Capabilities.EncodeXMLFast(enc, "Cpblties")
+	}
+	for _, elem := range p.Component {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Cmpnt")
+		}
+	}
+	enc.End(tag)
+}
+
+func (p *PointOfInteraction1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			elem := new(GenericIdentification32)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Identification = elem
+		case "SysNm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.SystemName = (*Max70Text)(&value)
+		case "GrpId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.GroupIdentification = (*Max35Text)(&value)
+		case "Cpblties":
+			elem := new(PointOfInteractionCapabilities1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Capabilities = elem
+		case "Cmpnt":
+			elem := new(PointOfInteractionComponent1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Component = append(p.Component, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -19,6 +19,10 @@ type PointOfInteraction2 struct {
 	Component []*PointOfInteractionComponent3 `xml:"Cmpnt,omitempty"`
 }
 
+func (p *PointOfInteraction2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteraction2) AddIdentification() *GenericIdentification32 {
 	p.Identification = new(GenericIdentification32)
 	return p.Identification
@@ -19,6 +19,10 @@ type PointOfInteraction3 struct {
 	Component []*PointOfInteractionComponent4 `xml:"Cmpnt,omitempty"`
 }
 
+func (p *PointOfInteraction3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteraction3) AddIdentification() *GenericIdentification32 {
 	p.Identification = new(GenericIdentification32)
 	return p.Identification
@@ -19,6 +19,10 @@ type PointOfInteraction4 struct {
 	Component []*PointOfInteractionComponent5 `xml:"Cmpnt,omitempty"`
 }
 
+func (p *PointOfInteraction4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteraction4) AddIdentification() *GenericIdentification32 {
 	p.Identification = new(GenericIdentification32)
 	return p.Identification
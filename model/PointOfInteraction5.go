@@ -25,6 +25,10 @@ type PointOfInteraction5 struct {
 	Component []*PointOfInteractionComponent6 `xml:"Cmpnt,omitempty"`
 }
 
+func (p *PointOfInteraction5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteraction5) AddIdentification() *GenericIdentification32 {
 	p.Identification = new(GenericIdentification32)
 	return p.Identification
@@ -13,6 +13,10 @@ type PointOfInteraction6 struct {
 	SerialNumber *Max35Text `xml:"SrlNb"`
 }
 
+func (p *PointOfInteraction6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteraction6) SetManufacturerIdentifier(value string) {
 	p.ManufacturerIdentifier = (*Max35Text)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Capabilities of the POI performing the transaction.
 type PointOfInteractionCapabilities1 struct {
 
@@ -19,6 +21,10 @@ type PointOfInteractionCapabilities1 struct {
 	PrintLineWidth *Max3NumericText `xml:"PrtLineWidth,omitempty"`
 }
 
+func (p *PointOfInteractionCapabilities1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionCapabilities1) AddCardReadingCapabilities(value string) {
 	p.CardReadingCapabilities = append(p.CardReadingCapabilities, (*CardDataReading1Code)(&value))
 }
@@ -180,3 +186,77 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (p *PointOfInteractionCapabilities1) SetPrintLineWidth(value string) {
 	p.PrintLineWidth = (*Max3NumericText)(&value)
 }
+
+func (p *PointOfInteractionCapabilities1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	for _, elem := range p.CardReadingCapabilities {
+		if elem != nil {
+			enc.Element("CardRdngCpblties", string(*elem))
+		}
+	}
+	for _, elem := range p.CardholderVerificationCapabilities {
+		if elem != nil {
+			enc.Element("CrdhldrVrfctnCpblties", string(*elem))
+		}
+	}
+	if p.OnLineCapabilities != nil {
+		enc.Element("OnLineCpblties", string(*p.OnLineCapabilities))
+	}
+	for _, elem := range p.DisplayCapabilities {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "DispCpblties")
+		}
+	}
+	if p.PrintLineWidth != nil {
+		enc.Element("PrtLineWidth", string(*p.PrintLineWidth))
+	}
+	enc.End(tag)
+}
+
+func (p *PointOfInteractionCapabilities1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "CardRdngCpblties":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.CardReadingCapabilities = append(p.CardReadingCapabilities, (*CardDataReading1Code)(&value))
+		case "CrdhldrVrfctnCpblties":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.CardholderVerificationCapabilities = append(p.CardholderVerificationCapabilities, (*CardholderVerificationCapability1Code)(&value))
+		case "OnLineCpblties":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.OnLineCapabilities = (*OnLineCapability1Code)(&value)
+		case "DispCpblties":
+			elem := new(DisplayCapabilities1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.DisplayCapabilities = append(p.DisplayCapabilities, elem)
+		case "PrtLineWidth":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.PrintLineWidth = (*Max3NumericText)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
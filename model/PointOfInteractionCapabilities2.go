@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Capabilities of the POI (Point Of Interaction) performing the transaction.
 type PointOfInteractionCapabilities2 struct {
 
@@ -22,6 +26,10 @@ type PointOfInteractionCapabilities2 struct {
 	AvailableLanguage []*ISO2ALanguageCode `xml:"AvlblLang,omitempty"`
 }
 
+func (p *PointOfInteractionCapabilities2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionCapabilities2) AddCardReadingCapabilities(value string) {
 	p.CardReadingCapabilities = append(p.CardReadingCapabilities, (*CardDataReading1Code)(&value))
 }
@@ -184,6 +192,11 @@ func (p *PointOfInteractionCapabilities2) SetPrintLineWidth(value string) {
 	p.PrintLineWidth = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities2) SetPrintLineWidthFromDecimal(value decimal.Decimal) {
+	p.PrintLineWidth = new(Number)
+	p.PrintLineWidth.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities2) AddAvailableLanguage(value string) {
 	p.AvailableLanguage = append(p.AvailableLanguage, (*ISO2ALanguageCode)(&value))
 }
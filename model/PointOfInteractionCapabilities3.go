@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Capabilities of the POI (Point Of Interaction) performing the transaction.
 type PointOfInteractionCapabilities3 struct {
 
@@ -32,6 +36,10 @@ type PointOfInteractionCapabilities3 struct {
 	AvailableLanguage []*LanguageCode `xml:"AvlblLang,omitempty"`
 }
 
+func (p *PointOfInteractionCapabilities3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionCapabilities3) AddCardReadingCapabilities(value string) {
 	p.CardReadingCapabilities = append(p.CardReadingCapabilities, (*CardDataReading1Code)(&value))
 }
@@ -44,14 +52,29 @@ func (p *PointOfInteractionCapabilities3) SetPINLengthCapabilities(value string)
 	p.PINLengthCapabilities = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities3) SetPINLengthCapabilitiesFromDecimal(value decimal.Decimal) {
+	p.PINLengthCapabilities = new(Number)
+	p.PINLengthCapabilities.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities3) SetApprovalCodeLength(value string) {
 	p.ApprovalCodeLength = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities3) SetApprovalCodeLengthFromDecimal(value decimal.Decimal) {
+	p.ApprovalCodeLength = new(Number)
+	p.ApprovalCodeLength.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities3) SetCardCaptureCapable(value string) {
 	p.CardCaptureCapable = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PointOfInteractionCapabilities3) SetCardCaptureCapableFromBool(value bool) {
+	p.CardCaptureCapable = new(TrueFalseIndicator)
+	p.CardCaptureCapable.FromBool(value)
+}
+
 func (p *PointOfInteractionCapabilities3) SetOnLineCapabilities(value string) {
 	p.OnLineCapabilities = (*OnLineCapability1Code)(&value)
 }
@@ -206,6 +229,11 @@ func (p *PointOfInteractionCapabilities3) SetPrintLineWidth(value string) {
 	p.PrintLineWidth = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities3) SetPrintLineWidthFromDecimal(value decimal.Decimal) {
+	p.PrintLineWidth = new(Number)
+	p.PrintLineWidth.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities3) AddAvailableLanguage(value string) {
 	p.AvailableLanguage = append(p.AvailableLanguage, (*LanguageCode)(&value))
 }
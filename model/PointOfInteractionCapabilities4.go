@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Capabilities of the terminal performing the transaction.
 type PointOfInteractionCapabilities4 struct {
 
@@ -36,6 +40,10 @@ type PointOfInteractionCapabilities4 struct {
 	MessageCapabilities []*DisplayCapabilities3 `xml:"MsgCpblties,omitempty"`
 }
 
+func (p *PointOfInteractionCapabilities4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionCapabilities4) AddCardReadingCapabilities(value string) {
 	p.CardReadingCapabilities = append(p.CardReadingCapabilities, (*CardDataReading2Code)(&value))
 }
@@ -52,18 +60,38 @@ func (p *PointOfInteractionCapabilities4) SetPINLengthCapabilities(value string)
 	p.PINLengthCapabilities = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities4) SetPINLengthCapabilitiesFromDecimal(value decimal.Decimal) {
+	p.PINLengthCapabilities = new(Number)
+	p.PINLengthCapabilities.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities4) SetApprovalCodeLength(value string) {
 	p.ApprovalCodeLength = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities4) SetApprovalCodeLengthFromDecimal(value decimal.Decimal) {
+	p.ApprovalCodeLength = new(Number)
+	p.ApprovalCodeLength.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities4) SetMaxScriptLength(value string) {
 	p.MaxScriptLength = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities4) SetMaxScriptLengthFromDecimal(value decimal.Decimal) {
+	p.MaxScriptLength = new(Number)
+	p.MaxScriptLength.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities4) SetCardCaptureCapable(value string) {
 	p.CardCaptureCapable = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PointOfInteractionCapabilities4) SetCardCaptureCapableFromBool(value bool) {
+	p.CardCaptureCapable = new(TrueFalseIndicator)
+	p.CardCaptureCapable.FromBool(value)
+}
+
 func (p *PointOfInteractionCapabilities4) SetOnLineCapabilities(value string) {
 	p.OnLineCapabilities = (*OnLineCapability1Code)(&value)
 }
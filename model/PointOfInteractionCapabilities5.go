@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Capabilities of the ATM terminal.
 type PointOfInteractionCapabilities5 struct {
 
@@ -25,6 +29,10 @@ type PointOfInteractionCapabilities5 struct {
 	CardCaptureCapable *TrueFalseIndicator `xml:"CardCaptrCpbl,omitempty"`
 }
 
+func (p *PointOfInteractionCapabilities5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionCapabilities5) AddCardReadData(value string) {
 	p.CardReadData = append(p.CardReadData, (*CardDataReading4Code)(&value))
 }
@@ -41,14 +49,34 @@ func (p *PointOfInteractionCapabilities5) SetPINLengthCapabilities(value string)
 	p.PINLengthCapabilities = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities5) SetPINLengthCapabilitiesFromDecimal(value decimal.Decimal) {
+	p.PINLengthCapabilities = new(Number)
+	p.PINLengthCapabilities.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities5) SetApprovalCodeLength(value string) {
 	p.ApprovalCodeLength = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities5) SetApprovalCodeLengthFromDecimal(value decimal.Decimal) {
+	p.ApprovalCodeLength = new(Number)
+	p.ApprovalCodeLength.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities5) SetMaxScriptLength(value string) {
 	p.MaxScriptLength = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities5) SetMaxScriptLengthFromDecimal(value decimal.Decimal) {
+	p.MaxScriptLength = new(Number)
+	p.MaxScriptLength.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities5) SetCardCaptureCapable(value string) {
 	p.CardCaptureCapable = (*TrueFalseIndicator)(&value)
 }
+
+func (p *PointOfInteractionCapabilities5) SetCardCaptureCapableFromBool(value bool) {
+	p.CardCaptureCapable = new(TrueFalseIndicator)
+	p.CardCaptureCapable.FromBool(value)
+}
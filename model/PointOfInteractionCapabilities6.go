@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Capabilities of the POI (Point Of Interaction) performing the transaction.
 type PointOfInteractionCapabilities6 struct {
 
@@ -28,6 +32,10 @@ type PointOfInteractionCapabilities6 struct {
 	MessageCapabilities []*DisplayCapabilities4 `xml:"MsgCpblties,omitempty"`
 }
 
+func (p *PointOfInteractionCapabilities6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionCapabilities6) AddCardReadingCapabilities(value string) {
 	p.CardReadingCapabilities = append(p.CardReadingCapabilities, (*CardDataReading5Code)(&value))
 }
@@ -40,18 +48,38 @@ func (p *PointOfInteractionCapabilities6) SetPINLengthCapabilities(value string)
 	p.PINLengthCapabilities = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities6) SetPINLengthCapabilitiesFromDecimal(value decimal.Decimal) {
+	p.PINLengthCapabilities = new(Number)
+	p.PINLengthCapabilities.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities6) SetApprovalCodeLength(value string) {
 	p.ApprovalCodeLength = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities6) SetApprovalCodeLengthFromDecimal(value decimal.Decimal) {
+	p.ApprovalCodeLength = new(Number)
+	p.ApprovalCodeLength.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities6) SetMaxScriptLength(value string) {
 	p.MaxScriptLength = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities6) SetMaxScriptLengthFromDecimal(value decimal.Decimal) {
+	p.MaxScriptLength = new(Number)
+	p.MaxScriptLength.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities6) SetCardCaptureCapable(value string) {
 	p.CardCaptureCapable = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PointOfInteractionCapabilities6) SetCardCaptureCapableFromBool(value bool) {
+	p.CardCaptureCapable = new(TrueFalseIndicator)
+	p.CardCaptureCapable.FromBool(value)
+}
+
 func (p *PointOfInteractionCapabilities6) SetOnLineCapabilities(value string) {
 	p.OnLineCapabilities = (*OnLineCapability1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Capabilities of the ATM terminal.
 type PointOfInteractionCapabilities7 struct {
 
@@ -34,6 +38,10 @@ type PointOfInteractionCapabilities7 struct {
 	MessageCapabilities []*DisplayCapabilities5 `xml:"MsgCpblties,omitempty"`
 }
 
+func (p *PointOfInteractionCapabilities7) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionCapabilities7) AddCardReadData(value string) {
 	p.CardReadData = append(p.CardReadData, (*CardDataReading4Code)(&value))
 }
@@ -50,18 +58,38 @@ func (p *PointOfInteractionCapabilities7) SetPINLengthCapabilities(value string)
 	p.PINLengthCapabilities = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities7) SetPINLengthCapabilitiesFromDecimal(value decimal.Decimal) {
+	p.PINLengthCapabilities = new(Number)
+	p.PINLengthCapabilities.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities7) SetApprovalCodeLength(value string) {
 	p.ApprovalCodeLength = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities7) SetApprovalCodeLengthFromDecimal(value decimal.Decimal) {
+	p.ApprovalCodeLength = new(Number)
+	p.ApprovalCodeLength.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities7) SetMaxScriptLength(value string) {
 	p.MaxScriptLength = (*Number)(&value)
 }
 
+func (p *PointOfInteractionCapabilities7) SetMaxScriptLengthFromDecimal(value decimal.Decimal) {
+	p.MaxScriptLength = new(Number)
+	p.MaxScriptLength.FromDecimal(value)
+}
+
 func (p *PointOfInteractionCapabilities7) SetCardCaptureCapable(value string) {
 	p.CardCaptureCapable = (*TrueFalseIndicator)(&value)
 }
 
+func (p *PointOfInteractionCapabilities7) SetCardCaptureCapableFromBool(value bool) {
+	p.CardCaptureCapable = new(TrueFalseIndicator)
+	p.CardCaptureCapable.FromBool(value)
+}
+
 func (p *PointOfInteractionCapabilities7) AddWithdrawalMedia(value string) {
 	p.WithdrawalMedia = append(p.WithdrawalMedia, (*ATMMediaType1Code)(&value))
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Data related to a component of the POI performing the transaction.
 type PointOfInteractionComponent1 struct {
 
@@ -23,6 +25,10 @@ type PointOfInteractionComponent1 struct {
 	ApprovalNumber []*Max70Text `xml:"ApprvlNb,omitempty"`
 }
 
+func (p *PointOfInteractionComponent1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionComponent1) SetPOIComponentType(value string) {
 	p.POIComponentType = (*POIComponentType1Code)(&value)
 }
@@ -46,3 +52,82 @@ func (p *PointOfInteractionComponent1) SetSerialNumber(value string) {
 func (p *PointOfInteractionComponent1) AddApprovalNumber(value string) {
 	p.ApprovalNumber = append(p.ApprovalNumber, (*Max70Text)(&value))
 }
+
+func (p *PointOfInteractionComponent1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.POIComponentType != nil {
+		enc.Element("POICmpntTp", string(*p.POIComponentType))
+	}
+	if p.ManufacturerIdentification != nil {
+		enc.Element("ManfctrId", string(*p.ManufacturerIdentification))
+	}
+	if p.Model != nil {
+		enc.Element("Mdl", string(*p.Model))
+	}
+	if p.VersionNumber != nil {
+		enc.Element("VrsnNb", string(*p.VersionNumber))
+	}
+	if p.SerialNumber != nil {
+		enc.Element("SrlNb", string(*p.SerialNumber))
+	}
+	for _, elem := range p.ApprovalNumber {
+		if elem != nil {
+			enc.Element("ApprvlNb", string(*elem))
+		}
+	}
+	enc.End(tag)
+}
+
+func (p *PointOfInteractionComponent1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "POICmpntTp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.POIComponentType = (*POIComponentType1Code)(&value)
+		case "ManfctrId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ManufacturerIdentification = (*Max35Text)(&value)
+		case "Mdl":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Model = (*Max35Text)(&value)
+		case "VrsnNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.VersionNumber = (*Max16Text)(&value)
+		case "SrlNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.SerialNumber = (*Max35Text)(&value)
+		case "ApprvlNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ApprovalNumber = append(p.ApprovalNumber, (*Max70Text)(&value))
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -23,6 +23,10 @@ type PointOfInteractionComponent2 struct {
 	ApprovalNumber []*Max70Text `xml:"ApprvlNb,omitempty"`
 }
 
+func (p *PointOfInteractionComponent2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionComponent2) SetPOIComponentType(value string) {
 	p.POIComponentType = (*POIComponentType2Code)(&value)
 }
@@ -22,6 +22,10 @@ type PointOfInteractionComponent3 struct {
 	Assessment []*PointOfInteractionComponentAssessment1 `xml:"Assmnt,omitempty"`
 }
 
+func (p *PointOfInteractionComponent3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionComponent3) SetType(value string) {
 	p.Type = (*POIComponentType3Code)(&value)
 }
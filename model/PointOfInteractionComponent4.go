@@ -22,6 +22,10 @@ type PointOfInteractionComponent4 struct {
 	Assessment []*PointOfInteractionComponentAssessment1 `xml:"Assmnt,omitempty"`
 }
 
+func (p *PointOfInteractionComponent4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionComponent4) SetType(value string) {
 	p.Type = (*POIComponentType3Code)(&value)
 }
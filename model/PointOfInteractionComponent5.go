@@ -22,6 +22,10 @@ type PointOfInteractionComponent5 struct {
 	Assessment []*PointOfInteractionComponentAssessment1 `xml:"Assmnt,omitempty"`
 }
 
+func (p *PointOfInteractionComponent5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionComponent5) SetType(value string) {
 	p.Type = (*POIComponentType3Code)(&value)
 }
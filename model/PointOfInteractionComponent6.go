@@ -22,6 +22,10 @@ type PointOfInteractionComponent6 struct {
 	Assessment []*PointOfInteractionComponentAssessment1 `xml:"Assmnt,omitempty"`
 }
 
+func (p *PointOfInteractionComponent6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionComponent6) SetType(value string) {
 	p.Type = (*POIComponentType4Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Assessments for the component of the point of interaction.
 type PointOfInteractionComponentAssessment1 struct {
 
@@ -19,6 +23,10 @@ type PointOfInteractionComponentAssessment1 struct {
 	Number *Max35Text `xml:"Nb"`
 }
 
+func (p *PointOfInteractionComponentAssessment1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionComponentAssessment1) SetType(value string) {
 	p.Type = (*POIComponentAssessment1Code)(&value)
 }
@@ -31,10 +39,20 @@ func (p *PointOfInteractionComponentAssessment1) SetDeliveryDate(value string) {
 	p.DeliveryDate = (*ISODateTime)(&value)
 }
 
+func (p *PointOfInteractionComponentAssessment1) SetDeliveryDateFromTime(value time.Time) {
+	p.DeliveryDate = new(ISODateTime)
+	p.DeliveryDate.FromTime(value)
+}
+
 func (p *PointOfInteractionComponentAssessment1) SetExpirationDate(value string) {
 	p.ExpirationDate = (*ISODateTime)(&value)
 }
 
+func (p *PointOfInteractionComponentAssessment1) SetExpirationDateFromTime(value time.Time) {
+	p.ExpirationDate = new(ISODateTime)
+	p.ExpirationDate.FromTime(value)
+}
+
 func (p *PointOfInteractionComponentAssessment1) SetNumber(value string) {
 	p.Number = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Physical and logical characteristics of a POI component (Point of Interaction).
 type PointOfInteractionComponentCharacteristics1 struct {
 
@@ -19,6 +23,10 @@ type PointOfInteractionComponentCharacteristics1 struct {
 	KeyCheckValue *Max35Binary `xml:"KeyChckVal,omitempty"`
 }
 
+func (p *PointOfInteractionComponentCharacteristics1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionComponentCharacteristics1) AddMemory() *MemoryCharacteristics1 {
 	newValue := new(MemoryCharacteristics1)
 	p.Memory = append(p.Memory, newValue)
@@ -315,10 +323,20 @@ func (p *PointOfInteractionComponentCharacteristics1) SetSecurityAccessModules(v
 	p.SecurityAccessModules = (*Number)(&value)
 }
 
+func (p *PointOfInteractionComponentCharacteristics1) SetSecurityAccessModulesFromDecimal(value decimal.Decimal) {
+	p.SecurityAccessModules = new(Number)
+	p.SecurityAccessModules.FromDecimal(value)
+}
+
 func (p *PointOfInteractionComponentCharacteristics1) SetSubscriberIdentityModules(value string) {
 	p.SubscriberIdentityModules = (*Number)(&value)
 }
 
+func (p *PointOfInteractionComponentCharacteristics1) SetSubscriberIdentityModulesFromDecimal(value decimal.Decimal) {
+	p.SubscriberIdentityModules = new(Number)
+	p.SubscriberIdentityModules.FromDecimal(value)
+}
+
 func (p *PointOfInteractionComponentCharacteristics1) SetKeyCheckValue(value string) {
 	p.KeyCheckValue = (*Max35Binary)(&value)
 }
@@ -16,6 +16,10 @@ type PointOfInteractionComponentIdentification1 struct {
 	SerialNumber *Max35Text `xml:"SrlNb,omitempty"`
 }
 
+func (p *PointOfInteractionComponentIdentification1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionComponentIdentification1) SetItemNumber(value string) {
 	p.ItemNumber = (*Max35Text)(&value)
 }
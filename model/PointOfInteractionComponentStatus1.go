@@ -10,6 +10,10 @@ type PointOfInteractionComponentStatus1 struct {
 	Status *POIComponentStatus1Code `xml:"Sts,omitempty"`
 }
 
+func (p *PointOfInteractionComponentStatus1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionComponentStatus1) SetVersionNumber(value string) {
 	p.VersionNumber = (*Max35Text)(&value)
 }
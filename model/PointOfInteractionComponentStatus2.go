@@ -10,6 +10,10 @@ type PointOfInteractionComponentStatus2 struct {
 	Status *POIComponentStatus1Code `xml:"Sts,omitempty"`
 }
 
+func (p *PointOfInteractionComponentStatus2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionComponentStatus2) SetVersionNumber(value string) {
 	p.VersionNumber = (*Max256Text)(&value)
 }
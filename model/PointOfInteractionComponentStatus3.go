@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Status of a POI component (Point of Interaction).
 type PointOfInteractionComponentStatus3 struct {
 
@@ -13,6 +17,10 @@ type PointOfInteractionComponentStatus3 struct {
 	ExpiryDate *ISODate `xml:"XpryDt,omitempty"`
 }
 
+func (p *PointOfInteractionComponentStatus3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PointOfInteractionComponentStatus3) SetVersionNumber(value string) {
 	p.VersionNumber = (*Max256Text)(&value)
 }
@@ -24,3 +32,8 @@ func (p *PointOfInteractionComponentStatus3) SetStatus(value string) {
 func (p *PointOfInteractionComponentStatus3) SetExpiryDate(value string) {
 	p.ExpiryDate = (*ISODate)(&value)
 }
+
+func (p *PointOfInteractionComponentStatus3) SetExpiryDateFromTime(value time.Time) {
+	p.ExpiryDate = new(ISODate)
+	p.ExpiryDate.FromTime(value)
+}
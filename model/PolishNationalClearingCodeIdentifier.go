@@ -1,3 +1,9 @@
 package model
 
 type PolishNationalClearingCodeIdentifier string
+
+var polishNationalClearingCodeIdentifierPattern = newPattern(`PL[0-9]{8,8}`)
+
+func (p PolishNationalClearingCodeIdentifier) Validate() error {
+	return validatePattern("PolishNationalClearingCodeIdentifier", string(p), polishNationalClearingCodeIdentifierPattern)
+}
@@ -10,6 +10,10 @@ type PoliticalExposureType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PoliticalExposureType1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PoliticalExposureType1Choice) SetCode(value string) {
 	p.Code = (*PoliticalExposureType1Code)(&value)
 }
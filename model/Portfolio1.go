@@ -7,6 +7,10 @@ type Portfolio1 struct {
 	PortfolioInformation []*Max350Text `xml:"PrtflInf,omitempty"`
 }
 
+func (p *Portfolio1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Portfolio1) AddPortfolioInformation(value string) {
 	p.PortfolioInformation = append(p.PortfolioInformation, (*Max350Text)(&value))
 }
@@ -10,6 +10,10 @@ type PortfolioBalance1 struct {
 	DetailedBalance []*BalanceDetails6 `xml:"DtldBal"`
 }
 
+func (p *PortfolioBalance1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PortfolioBalance1) AddSummaryBalance() *BalanceDetails5 {
 	newValue := new(BalanceDetails5)
 	p.SummaryBalance = append(p.SummaryBalance, newValue)
@@ -1,3 +1,9 @@
 package model
 
 type PortugueseNCCIdentifier string
+
+var portugueseNCCIdentifierPattern = newPattern(`PT[0-9]{8,8}`)
+
+func (p PortugueseNCCIdentifier) Validate() error {
+	return validatePattern("PortugueseNCCIdentifier", string(p), portugueseNCCIdentifierPattern)
+}
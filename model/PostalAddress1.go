@@ -7,7 +7,7 @@ type PostalAddress1 struct {
 	AddressType *AddressType2Code `xml:"AdrTp,omitempty"`
 
 	// Information that locates and identifies a specific address, as defined by postal services, that is presented in free format text.
-	AddressLine []*Max70Text `xml:"AdrLine,omitempty"`
+	AddressLine []*Max70Text `xml:"AdrLine,omitempty" xsd:"maxOccurs=5"`
 
 	// Name of a street or thoroughfare.
 	StreetName *Max70Text `xml:"StrtNm,omitempty"`
@@ -28,8 +28,16 @@ type PostalAddress1 struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
-func (p *PostalAddress1) SetAddressType(value string) {
+func (p *PostalAddress1) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PostalAddress1) SetAddressType(value string) error {
+	if err := AddressType2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.AddressType = (*AddressType2Code)(&value)
+	return nil
 }
 
 func (p *PostalAddress1) AddAddressLine(value string) {
@@ -31,7 +31,7 @@ type PostalAddress11 struct {
 	Country *CountryCode `xml:"Ctry,omitempty"`
 
 	// Information that locates and identifies a specific address, as defined by postal services, presented in free format text.
-	AddressLine []*Max70Text `xml:"AdrLine,omitempty"`
+	AddressLine []*Max70Text `xml:"AdrLine,omitempty" xsd:"maxOccurs=7"`
 
 	// Floor or storey within a building.
 	Floor *Max70Text `xml:"Flr,omitempty"`
@@ -46,8 +46,16 @@ type PostalAddress11 struct {
 	Room *Max70Text `xml:"Room,omitempty"`
 }
 
-func (p *PostalAddress11) SetAddressType(value string) {
+func (p *PostalAddress11) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PostalAddress11) SetAddressType(value string) error {
+	if err := AddressType2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.AddressType = (*AddressType2Code)(&value)
+	return nil
 }
 
 func (p *PostalAddress11) SetDepartment(value string) {
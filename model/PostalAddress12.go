@@ -14,6 +14,10 @@ type PostalAddress12 struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PostalAddress12) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PostalAddress12) SetTownName(value string) {
 	p.TownName = (*Max35Text)(&value)
 }
@@ -4,7 +4,7 @@ package model
 type PostalAddress13 struct {
 
 	// Information that locates and identifies a specific address, as defined by postal services, that is presented in free format text.
-	AddressLine []*Max70Text `xml:"AdrLine,omitempty"`
+	AddressLine []*Max70Text `xml:"AdrLine,omitempty" xsd:"maxOccurs=7"`
 
 	// Name of a street or thoroughfare.
 	StreetName *Max70Text `xml:"StrtNm,omitempty"`
@@ -25,6 +25,10 @@ type PostalAddress13 struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PostalAddress13) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PostalAddress13) AddAddressLine(value string) {
 	p.AddressLine = append(p.AddressLine, (*Max70Text)(&value))
 }
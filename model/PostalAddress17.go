@@ -4,7 +4,7 @@ package model
 type PostalAddress17 struct {
 
 	// Information that locates and identifies a specific address, as defined by postal services, that is presented in free format text.
-	AddressLine []*Max70Text `xml:"AdrLine,omitempty"`
+	AddressLine []*Max70Text `xml:"AdrLine,omitempty" xsd:"maxOccurs=7"`
 
 	// Name of a street or thoroughfare.
 	StreetName *Max70Text `xml:"StrtNm,omitempty"`
@@ -28,6 +28,10 @@ type PostalAddress17 struct {
 	GeoLocation *GeographicLocation1Choice `xml:"GLctn,omitempty"`
 }
 
+func (p *PostalAddress17) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PostalAddress17) AddAddressLine(value string) {
 	p.AddressLine = append(p.AddressLine, (*Max70Text)(&value))
 }
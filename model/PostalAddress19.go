@@ -37,11 +37,19 @@ type PostalAddress19 struct {
 	Country *CountryCode `xml:"Ctry,omitempty"`
 
 	// Information that locates and identifies a specific address, as defined by postal services, presented in free format text.
-	AddressLine []*Max70Text `xml:"AdrLine,omitempty"`
+	AddressLine []*Max70Text `xml:"AdrLine,omitempty" xsd:"maxOccurs=7"`
 }
 
-func (p *PostalAddress19) SetAddressType(value string) {
+func (p *PostalAddress19) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PostalAddress19) SetAddressType(value string) error {
+	if err := AddressType2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.AddressType = (*AddressType2Code)(&value)
+	return nil
 }
 
 func (p *PostalAddress19) SetDepartment(value string) {
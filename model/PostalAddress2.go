@@ -19,6 +19,10 @@ type PostalAddress2 struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PostalAddress2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PostalAddress2) SetStreetName(value string) {
 	p.StreetName = (*Max70Text)(&value)
 }
@@ -16,7 +16,7 @@ type PostalAddress21 struct {
 	CareOf *Max70Text `xml:"CareOf,omitempty"`
 
 	// Information that locates and identifies a specific address, as defined by postal services, that is presented in free format text.
-	AddressLine []*Max70Text `xml:"AdrLine,omitempty"`
+	AddressLine []*Max70Text `xml:"AdrLine,omitempty" xsd:"maxOccurs=7"`
 
 	// Name of the street or thoroughfare.
 	StreetName *Max70Text `xml:"StrtNm,omitempty"`
@@ -58,6 +58,10 @@ type PostalAddress21 struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PostalAddress21) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PostalAddress21) AddAddressType() *AddressType2Choice {
 	p.AddressType = new(AddressType2Choice)
 	return p.AddressType
@@ -67,10 +71,20 @@ func (p *PostalAddress21) SetMailingIndicator(value string) {
 	p.MailingIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *PostalAddress21) SetMailingIndicatorFromBool(value bool) {
+	p.MailingIndicator = new(YesNoIndicator)
+	p.MailingIndicator.FromBool(value)
+}
+
 func (p *PostalAddress21) SetRegistrationAddressIndicator(value string) {
 	p.RegistrationAddressIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *PostalAddress21) SetRegistrationAddressIndicatorFromBool(value bool) {
+	p.RegistrationAddressIndicator = new(YesNoIndicator)
+	p.RegistrationAddressIndicator.FromBool(value)
+}
+
 func (p *PostalAddress21) SetCareOf(value string) {
 	p.CareOf = (*Max70Text)(&value)
 }
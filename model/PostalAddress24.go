@@ -0,0 +1,293 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Information that locates and identifies a specific address, as defined by postal services.
+type PostalAddress24 struct {
+
+	// Identifies the nature of the postal address.
+	AddressType *AddressType3Choice `xml:"AdrTp,omitempty"`
+
+	// Identification of a division of a large organisation or building.
+	Department *Max70Text `xml:"Dept,omitempty"`
+
+	// Identification of a sub-division of a large organisation or building.
+	SubDepartment *Max70Text `xml:"SubDept,omitempty"`
+
+	// Name of a street or thoroughfare.
+	StreetName *Max70Text `xml:"StrtNm,omitempty"`
+
+	// Number that identifies the position of a building on a street.
+	BuildingNumber *Max16Text `xml:"BldgNb,omitempty"`
+
+	// Name of the building or house.
+	BuildingName *Max35Text `xml:"BldgNm,omitempty"`
+
+	// Floor or storey within a building.
+	Floor *Max70Text `xml:"Flr,omitempty"`
+
+	// Numbered box in a post office, assigned to a person or organisation, where letters are kept until called for.
+	PostBox *Max16Text `xml:"PstBx,omitempty"`
+
+	// Building room number.
+	Room *Max70Text `xml:"Room,omitempty"`
+
+	// Identifier consisting of a group of letters and/or numbers that is added to a postal address to assist the sorting of mail.
+	PostCode *Max16Text `xml:"PstCd,omitempty"`
+
+	// Name of a built-up area, with defined boundaries, and a local government.
+	TownName *Max35Text `xml:"TwnNm,omitempty"`
+
+	// Specific location name within the town.
+	TownLocationName *Max35Text `xml:"TwnLctnNm,omitempty"`
+
+	// Name of a district, ie, a part of a town or region.
+	DistrictName *Max35Text `xml:"DstrctNm,omitempty"`
+
+	// Identifies a subdivision of a country such as state, region, county.
+	CountrySubDivision *Max35Text `xml:"CtrySubDvsn,omitempty"`
+
+	// Nation with its own government.
+	Country *CountryCode `xml:"Ctry,omitempty"`
+
+	// Information that locates and identifies a specific address, as defined by postal services, presented in free format text.
+	AddressLine []*Max70Text `xml:"AdrLine,omitempty" xsd:"maxOccurs=7"`
+}
+
+func (p *PostalAddress24) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PostalAddress24) AddAddressType() *AddressType3Choice {
+	p.AddressType = new(AddressType3Choice)
+	return p.AddressType
+}
+
+func (p *PostalAddress24) SetDepartment(value string) {
+	p.Department = (*Max70Text)(&value)
+}
+
+func (p *PostalAddress24) SetSubDepartment(value string) {
+	p.SubDepartment = (*Max70Text)(&value)
+}
+
+func (p *PostalAddress24) SetStreetName(value string) {
+	p.StreetName = (*Max70Text)(&value)
+}
+
+func (p *PostalAddress24) SetBuildingNumber(value string) {
+	p.BuildingNumber = (*Max16Text)(&value)
+}
+
+func (p *PostalAddress24) SetBuildingName(value string) {
+	p.BuildingName = (*Max35Text)(&value)
+}
+
+func (p *PostalAddress24) SetFloor(value string) {
+	p.Floor = (*Max70Text)(&value)
+}
+
+func (p *PostalAddress24) SetPostBox(value string) {
+	p.PostBox = (*Max16Text)(&value)
+}
+
+func (p *PostalAddress24) SetRoom(value string) {
+	p.Room = (*Max70Text)(&value)
+}
+
+func (p *PostalAddress24) SetPostCode(value string) {
+	p.PostCode = (*Max16Text)(&value)
+}
+
+func (p *PostalAddress24) SetTownName(value string) {
+	p.TownName = (*Max35Text)(&value)
+}
+
+func (p *PostalAddress24) SetTownLocationName(value string) {
+	p.TownLocationName = (*Max35Text)(&value)
+}
+
+func (p *PostalAddress24) SetDistrictName(value string) {
+	p.DistrictName = (*Max35Text)(&value)
+}
+
+func (p *PostalAddress24) SetCountrySubDivision(value string) {
+	p.CountrySubDivision = (*Max35Text)(&value)
+}
+
+func (p *PostalAddress24) SetCountry(value string) {
+	p.Country = (*CountryCode)(&value)
+}
+
+func (p *PostalAddress24) AddAddressLine(value string) {
+	p.AddressLine = append(p.AddressLine, (*Max70Text)(&value))
+}
+
+func (p *PostalAddress24) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.AddressType != nil {
+		p.AddressType.EncodeXMLFast(enc, "AdrTp")
+	}
+	if p.Department != nil {
+		enc.Element("Dept", string(*p.Department))
+	}
+	if p.SubDepartment != nil {
+		enc.Element("SubDept", string(*p.SubDepartment))
+	}
+	if p.StreetName != nil {
+		enc.Element("StrtNm", string(*p.StreetName))
+	}
+	if p.BuildingNumber != nil {
+		enc.Element("BldgNb", string(*p.BuildingNumber))
+	}
+	if p.BuildingName != nil {
+		enc.Element("BldgNm", string(*p.BuildingName))
+	}
+	if p.Floor != nil {
+		enc.Element("Flr", string(*p.Floor))
+	}
+	if p.PostBox != nil {
+		enc.Element("PstBx", string(*p.PostBox))
+	}
+	if p.Room != nil {
+		enc.Element("Room", string(*p.Room))
+	}
+	if p.PostCode != nil {
+		enc.Element("PstCd", string(*p.PostCode))
+	}
+	if p.TownName != nil {
+		enc.Element("TwnNm", string(*p.TownName))
+	}
+	if p.TownLocationName != nil {
+		enc.Element("TwnLctnNm", string(*p.TownLocationName))
+	}
+	if p.DistrictName != nil {
+		enc.Element("DstrctNm", string(*p.DistrictName))
+	}
+	if p.CountrySubDivision != nil {
+		enc.Element("CtrySubDvsn", string(*p.CountrySubDivision))
+	}
+	if p.Country != nil {
+		enc.Element("Ctry", string(*p.Country))
+	}
+	for _, elem := range p.AddressLine {
+		if elem != nil {
+			enc.Element("AdrLine", string(*elem))
+		}
+	}
+	enc.End(tag)
+}
+
+func (p *PostalAddress24) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "AdrTp":
+			elem := new(AddressType3Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.AddressType = elem
+		case "Dept":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Department = (*Max70Text)(&value)
+		case "SubDept":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.SubDepartment = (*Max70Text)(&value)
+		case "StrtNm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.StreetName = (*Max70Text)(&value)
+		case "BldgNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.BuildingNumber = (*Max16Text)(&value)
+		case "BldgNm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.BuildingName = (*Max35Text)(&value)
+		case "Flr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Floor = (*Max70Text)(&value)
+		case "PstBx":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.PostBox = (*Max16Text)(&value)
+		case "Room":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Room = (*Max70Text)(&value)
+		case "PstCd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.PostCode = (*Max16Text)(&value)
+		case "TwnNm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.TownName = (*Max35Text)(&value)
+		case "TwnLctnNm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.TownLocationName = (*Max35Text)(&value)
+		case "DstrctNm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.DistrictName = (*Max35Text)(&value)
+		case "CtrySubDvsn":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.CountrySubDivision = (*Max35Text)(&value)
+		case "Ctry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Country = (*CountryCode)(&value)
+		case "AdrLine":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.AddressLine = append(p.AddressLine, (*Max70Text)(&value))
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package model
+
+// Information that locates and identifies a specific address, as defined by postal services.
+type PostalAddress27 struct {
+
+	// Identifies the nature of the postal address.
+	AddressType *AddressType3Choice `xml:"AdrTp,omitempty"`
+
+	// When the individual resides at another person’s address, the name of the other person.
+	CareOf *Max140Text `xml:"CareOf,omitempty"`
+
+	// Identification of a division of a large organisation or building.
+	Department *Max70Text `xml:"Dept,omitempty"`
+
+	// Identification of a sub-division of a large organisation or building.
+	SubDepartment *Max70Text `xml:"SubDept,omitempty"`
+
+	// Name of a street or thoroughfare.
+	StreetName *Max70Text `xml:"StrtNm,omitempty"`
+
+	// Number that identifies the position of a building on a street.
+	BuildingNumber *Max16Text `xml:"BldgNb,omitempty"`
+
+	// Name of the building or house.
+	BuildingName *Max35Text `xml:"BldgNm,omitempty"`
+
+	// Floor or storey within a building.
+	Floor *Max70Text `xml:"Flr,omitempty"`
+
+	// Number of the unit within a building, such as an apartment or office.
+	UnitNumber *Max16Text `xml:"UnitNb,omitempty"`
+
+	// Numbered box in a post office, assigned to a person or organisation, where letters are kept until called for.
+	PostBox *Max16Text `xml:"PstBx,omitempty"`
+
+	// Building room number.
+	Room *Max70Text `xml:"Room,omitempty"`
+
+	// Identifier consisting of a group of letters and/or numbers that is added to a postal address to assist the sorting of mail.
+	PostCode *Max16Text `xml:"PstCd,omitempty"`
+
+	// Name of a built-up area, with defined boundaries, and a local government.
+	TownName *Max35Text `xml:"TwnNm,omitempty"`
+
+	// Specific location name within the town.
+	TownLocationName *Max35Text `xml:"TwnLctnNm,omitempty"`
+
+	// Name of a district, ie, a part of a town or region.
+	DistrictName *Max35Text `xml:"DstrctNm,omitempty"`
+
+	// Identifies a subdivision of a country such as state, region, county.
+	CountrySubDivision *Max35Text `xml:"CtrySubDvsn,omitempty"`
+
+	// Nation with its own government.
+	Country *CountryCode `xml:"Ctry,omitempty"`
+
+	// Information that locates and identifies a specific address, as defined by postal services, presented in free format text.
+	AddressLine []*Max70Text `xml:"AdrLine,omitempty" xsd:"maxOccurs=7"`
+}
+
+func (p *PostalAddress27) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PostalAddress27) AddAddressType() *AddressType3Choice {
+	p.AddressType = new(AddressType3Choice)
+	return p.AddressType
+}
+
+func (p *PostalAddress27) SetCareOf(value string) {
+	p.CareOf = (*Max140Text)(&value)
+}
+
+func (p *PostalAddress27) SetDepartment(value string) {
+	p.Department = (*Max70Text)(&value)
+}
+
+func (p *PostalAddress27) SetSubDepartment(value string) {
+	p.SubDepartment = (*Max70Text)(&value)
+}
+
+func (p *PostalAddress27) SetStreetName(value string) {
+	p.StreetName = (*Max70Text)(&value)
+}
+
+func (p *PostalAddress27) SetBuildingNumber(value string) {
+	p.BuildingNumber = (*Max16Text)(&value)
+}
+
+func (p *PostalAddress27) SetBuildingName(value string) {
+	p.BuildingName = (*Max35Text)(&value)
+}
+
+func (p *PostalAddress27) SetFloor(value string) {
+	p.Floor = (*Max70Text)(&value)
+}
+
+func (p *PostalAddress27) SetUnitNumber(value string) {
+	p.UnitNumber = (*Max16Text)(&value)
+}
+
+func (p *PostalAddress27) SetPostBox(value string) {
+	p.PostBox = (*Max16Text)(&value)
+}
+
+func (p *PostalAddress27) SetRoom(value string) {
+	p.Room = (*Max70Text)(&value)
+}
+
+func (p *PostalAddress27) SetPostCode(value string) {
+	p.PostCode = (*Max16Text)(&value)
+}
+
+func (p *PostalAddress27) SetTownName(value string) {
+	p.TownName = (*Max35Text)(&value)
+}
+
+func (p *PostalAddress27) SetTownLocationName(value string) {
+	p.TownLocationName = (*Max35Text)(&value)
+}
+
+func (p *PostalAddress27) SetDistrictName(value string) {
+	p.DistrictName = (*Max35Text)(&value)
+}
+
+func (p *PostalAddress27) SetCountrySubDivision(value string) {
+	p.CountrySubDivision = (*Max35Text)(&value)
+}
+
+func (p *PostalAddress27) SetCountry(value string) {
+	p.Country = (*CountryCode)(&value)
+}
+
+func (p *PostalAddress27) AddAddressLine(value string) {
+	p.AddressLine = append(p.AddressLine, (*Max70Text)(&value))
+}
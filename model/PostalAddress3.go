@@ -16,6 +16,10 @@ type PostalAddress3 struct {
 	NameAndAddress *NameAndAddress4 `xml:"NmAndAdr"`
 }
 
+func (p *PostalAddress3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PostalAddress3) SetAddressType(value string) {
 	p.AddressType = (*AddressType1Code)(&value)
 }
@@ -24,10 +28,20 @@ func (p *PostalAddress3) SetMailingIndicator(value string) {
 	p.MailingIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *PostalAddress3) SetMailingIndicatorFromBool(value bool) {
+	p.MailingIndicator = new(YesNoIndicator)
+	p.MailingIndicator.FromBool(value)
+}
+
 func (p *PostalAddress3) SetRegistrationAddressIndicator(value string) {
 	p.RegistrationAddressIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *PostalAddress3) SetRegistrationAddressIndicatorFromBool(value bool) {
+	p.RegistrationAddressIndicator = new(YesNoIndicator)
+	p.RegistrationAddressIndicator.FromBool(value)
+}
+
 func (p *PostalAddress3) AddNameAndAddress() *NameAndAddress4 {
 	p.NameAndAddress = new(NameAndAddress4)
 	return p.NameAndAddress
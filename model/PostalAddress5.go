@@ -19,6 +19,10 @@ type PostalAddress5 struct {
 	Country *CountryCode `xml:"Ctry"`
 }
 
+func (p *PostalAddress5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PostalAddress5) SetStreetName(value string) {
 	p.StreetName = (*Max70Text)(&value)
 }
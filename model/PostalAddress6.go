@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Information that locates and identifies a specific address, as defined by postal services.
 type PostalAddress6 struct {
 
@@ -31,11 +33,19 @@ type PostalAddress6 struct {
 	Country *CountryCode `xml:"Ctry,omitempty"`
 
 	// Information that locates and identifies a specific address, as defined by postal services, presented in free format text.
-	AddressLine []*Max70Text `xml:"AdrLine,omitempty"`
+	AddressLine []*Max70Text `xml:"AdrLine,omitempty" xsd:"maxOccurs=7"`
+}
+
+func (p *PostalAddress6) Validate() error {
+	return ValidateElement(p)
 }
 
-func (p *PostalAddress6) SetAddressType(value string) {
+func (p *PostalAddress6) SetAddressType(value string) error {
+	if err := AddressType2Code(value).Validate(); err != nil {
+		return err
+	}
 	p.AddressType = (*AddressType2Code)(&value)
+	return nil
 }
 
 func (p *PostalAddress6) SetDepartment(value string) {
@@ -73,3 +83,118 @@ func (p *PostalAddress6) SetCountry(value string) {
 func (p *PostalAddress6) AddAddressLine(value string) {
 	p.AddressLine = append(p.AddressLine, (*Max70Text)(&value))
 }
+
+func (p *PostalAddress6) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.AddressType != nil {
+		enc.Element("AdrTp", string(*p.AddressType))
+	}
+	if p.Department != nil {
+		enc.Element("Dept", string(*p.Department))
+	}
+	if p.SubDepartment != nil {
+		enc.Element("SubDept", string(*p.SubDepartment))
+	}
+	if p.StreetName != nil {
+		enc.Element("StrtNm", string(*p.StreetName))
+	}
+	if p.BuildingNumber != nil {
+		enc.Element("BldgNb", string(*p.BuildingNumber))
+	}
+	if p.PostCode != nil {
+		enc.Element("PstCd", string(*p.PostCode))
+	}
+	if p.TownName != nil {
+		enc.Element("TwnNm", string(*p.TownName))
+	}
+	if p.CountrySubDivision != nil {
+		enc.Element("CtrySubDvsn", string(*p.CountrySubDivision))
+	}
+	if p.Country != nil {
+		enc.Element("Ctry", string(*p.Country))
+	}
+	for _, elem := range p.AddressLine {
+		if elem != nil {
+			enc.Element("AdrLine", string(*elem))
+		}
+	}
+	enc.End(tag)
+}
+
+func (p *PostalAddress6) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "AdrTp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.AddressType = (*AddressType2Code)(&value)
+		case "Dept":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Department = (*Max70Text)(&value)
+		case "SubDept":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.SubDepartment = (*Max70Text)(&value)
+		case "StrtNm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.StreetName = (*Max70Text)(&value)
+		case "BldgNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.BuildingNumber = (*Max16Text)(&value)
+		case "PstCd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.PostCode = (*Max16Text)(&value)
+		case "TwnNm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.TownName = (*Max35Text)(&value)
+		case "CtrySubDvsn":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.CountrySubDivision = (*Max35Text)(&value)
+		case "Ctry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Country = (*CountryCode)(&value)
+		case "AdrLine":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.AddressLine = append(p.AddressLine, (*Max70Text)(&value))
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ type PowerOfAttorneyRequirements2 struct {
 	OtherDocumentation *Max350Text `xml:"OthrDcmnttn,omitempty"`
 }
 
+func (p *PowerOfAttorneyRequirements2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PowerOfAttorneyRequirements2) AddLegalRequirement(value string) {
 	p.LegalRequirement = append(p.LegalRequirement, (*PowerOfAttorneyLegalisation1Code)(&value))
 }
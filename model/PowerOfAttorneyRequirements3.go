@@ -13,6 +13,10 @@ type PowerOfAttorneyRequirements3 struct {
 	DocumentSubmissionDeadline *DateFormat29Choice `xml:"DocSubmissnDdln,omitempty"`
 }
 
+func (p *PowerOfAttorneyRequirements3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PowerOfAttorneyRequirements3) AddLegalRequirement(value string) {
 	p.LegalRequirement = append(p.LegalRequirement, (*PowerOfAttorneyLegalisation1Code)(&value))
 }
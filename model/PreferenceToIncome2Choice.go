@@ -10,6 +10,10 @@ type PreferenceToIncome2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PreferenceToIncome2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PreferenceToIncome2Choice) SetCode(value string) {
 	p.Code = (*PreferenceToIncome1Code)(&value)
 }
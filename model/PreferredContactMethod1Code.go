@@ -1,3 +1,32 @@
 package model
 
 type PreferredContactMethod1Code string
+
+const (
+	PreferredContactMethod1CodeLETT PreferredContactMethod1Code = "LETT"
+	PreferredContactMethod1CodeMAIL PreferredContactMethod1Code = "MAIL"
+	PreferredContactMethod1CodePHON PreferredContactMethod1Code = "PHON"
+	PreferredContactMethod1CodeFAXX PreferredContactMethod1Code = "FAXX"
+	PreferredContactMethod1CodeCELL PreferredContactMethod1Code = "CELL"
+)
+
+var preferredContactMethod1CodeDescriptions = map[PreferredContactMethod1Code]string{
+	PreferredContactMethod1CodeLETT: "Letter: preferred method used to reach the contact is per letter.",
+	PreferredContactMethod1CodeMAIL: "Email: preferred method used to reach the contact is per email.",
+	PreferredContactMethod1CodePHON: "Phone: preferred method used to reach the contact is per phone.",
+	PreferredContactMethod1CodeFAXX: "Fax: preferred method used to reach the contact is per fax.",
+	PreferredContactMethod1CodeCELL: "Mobile or cell phone: preferred method used to reach the contact is per mobile or cell phone.",
+}
+
+func (p PreferredContactMethod1Code) IsValid() bool {
+	_, ok := preferredContactMethod1CodeDescriptions[p]
+	return ok
+}
+
+func (p PreferredContactMethod1Code) Description() string {
+	return preferredContactMethod1CodeDescriptions[p]
+}
+
+func (p PreferredContactMethod1Code) Validate() error {
+	return validateEnumeration("PreferredContactMethod1Code", string(p), p.IsValid(), "LETT, MAIL, PHON, FAXX, CELL")
+}
@@ -0,0 +1,34 @@
+package model
+
+type PreferredContactMethod2Code string
+
+const (
+	PreferredContactMethod2CodeMAIL PreferredContactMethod2Code = "MAIL"
+	PreferredContactMethod2CodeFAXX PreferredContactMethod2Code = "FAXX"
+	PreferredContactMethod2CodeLETT PreferredContactMethod2Code = "LETT"
+	PreferredContactMethod2CodeCELL PreferredContactMethod2Code = "CELL"
+	PreferredContactMethod2CodeONLI PreferredContactMethod2Code = "ONLI"
+	PreferredContactMethod2CodePHON PreferredContactMethod2Code = "PHON"
+)
+
+var preferredContactMethod2CodeDescriptions = map[PreferredContactMethod2Code]string{
+	PreferredContactMethod2CodeMAIL: "Email: preferred method used to reach the contact is per email.",
+	PreferredContactMethod2CodeFAXX: "Fax: preferred method used to reach the contact is per fax.",
+	PreferredContactMethod2CodeLETT: "Letter: preferred method used to reach the contact is per letter.",
+	PreferredContactMethod2CodeCELL: "Mobile or cell phone: preferred method used to reach the contact is per mobile or cell phone.",
+	PreferredContactMethod2CodeONLI: "Online: preferred method used to reach the contact is online.",
+	PreferredContactMethod2CodePHON: "Phone: preferred method used to reach the contact is per phone.",
+}
+
+func (p PreferredContactMethod2Code) IsValid() bool {
+	_, ok := preferredContactMethod2CodeDescriptions[p]
+	return ok
+}
+
+func (p PreferredContactMethod2Code) Description() string {
+	return preferredContactMethod2CodeDescriptions[p]
+}
+
+func (p PreferredContactMethod2Code) Validate() error {
+	return validateEnumeration("PreferredContactMethod2Code", string(p), p.IsValid(), "MAIL, FAXX, LETT, CELL, ONLI, PHON")
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Commercial agreement in which the buyer agrees to pay the seller an amount of cash. Some aspects of the payment may be defined in the agreement, eg, the method of the payment
 type PremiumAmount2 struct {
 
@@ -16,6 +22,10 @@ type PremiumAmount2 struct {
 	SettlementParty *PartyIdentification8Choice `xml:"SttlmPty,omitempty"`
 }
 
+func (p *PremiumAmount2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PremiumAmount2) AddPremiumQuote() *PremiumQuote1Choice {
 	p.PremiumQuote = new(PremiumQuote1Choice)
 	return p.PremiumQuote
@@ -25,10 +35,19 @@ func (p *PremiumAmount2) SetAmount(value, currency string) {
 	p.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (p *PremiumAmount2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *PremiumAmount2) SetSettlementDate(value string) {
 	p.SettlementDate = (*ISODate)(&value)
 }
 
+func (p *PremiumAmount2) SetSettlementDateFromTime(value time.Time) {
+	p.SettlementDate = new(ISODate)
+	p.SettlementDate.FromTime(value)
+}
+
 func (p *PremiumAmount2) AddSettlementParty() *PartyIdentification8Choice {
 	p.SettlementParty = new(PartyIdentification8Choice)
 	return p.SettlementParty
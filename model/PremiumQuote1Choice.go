@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the amount of a premium on a currency option together with its calculation method.
 type PremiumQuote1Choice struct {
 
@@ -16,18 +20,42 @@ type PremiumQuote1Choice struct {
 	PointsOfPutAmount *BaseOneRate `xml:"PtsOfPutAmt"`
 }
 
+func (p *PremiumQuote1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PremiumQuote1Choice) SetPercentageOfCallAmount(value string) {
 	p.PercentageOfCallAmount = (*PercentageRate)(&value)
 }
 
+func (p *PremiumQuote1Choice) SetPercentageOfCallAmountFromDecimal(value decimal.Decimal) {
+	p.PercentageOfCallAmount = new(PercentageRate)
+	p.PercentageOfCallAmount.FromDecimal(value)
+}
+
 func (p *PremiumQuote1Choice) SetPercentageOfPutAmount(value string) {
 	p.PercentageOfPutAmount = (*PercentageRate)(&value)
 }
 
+func (p *PremiumQuote1Choice) SetPercentageOfPutAmountFromDecimal(value decimal.Decimal) {
+	p.PercentageOfPutAmount = new(PercentageRate)
+	p.PercentageOfPutAmount.FromDecimal(value)
+}
+
 func (p *PremiumQuote1Choice) SetPointsOfCallAmount(value string) {
 	p.PointsOfCallAmount = (*BaseOneRate)(&value)
 }
 
+func (p *PremiumQuote1Choice) SetPointsOfCallAmountFromDecimal(value decimal.Decimal) {
+	p.PointsOfCallAmount = new(BaseOneRate)
+	p.PointsOfCallAmount.FromDecimal(value)
+}
+
 func (p *PremiumQuote1Choice) SetPointsOfPutAmount(value string) {
 	p.PointsOfPutAmount = (*BaseOneRate)(&value)
 }
+
+func (p *PremiumQuote1Choice) SetPointsOfPutAmountFromDecimal(value decimal.Decimal) {
+	p.PointsOfPutAmount = new(BaseOneRate)
+	p.PointsOfPutAmount.FromDecimal(value)
+}
@@ -16,6 +16,10 @@ type Presentation1 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (p *Presentation1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Presentation1) AddMedium() *PresentationMedium1Choice {
 	p.Medium = new(PresentationMedium1Choice)
 	return p.Medium
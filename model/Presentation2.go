@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information for the presentation of documents.
 type Presentation2 struct {
 
@@ -10,6 +14,10 @@ type Presentation2 struct {
 	BeneficiaryPresentationDate *ISODate `xml:"BnfcryPresntnDt,omitempty"`
 }
 
+func (p *Presentation2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Presentation2) AddPresenter() *PartyIdentification43 {
 	p.Presenter = new(PartyIdentification43)
 	return p.Presenter
@@ -18,3 +26,8 @@ func (p *Presentation2) AddPresenter() *PartyIdentification43 {
 func (p *Presentation2) SetBeneficiaryPresentationDate(value string) {
 	p.BeneficiaryPresentationDate = (*ISODate)(&value)
 }
+
+func (p *Presentation2) SetBeneficiaryPresentationDateFromTime(value time.Time) {
+	p.BeneficiaryPresentationDate = new(ISODate)
+	p.BeneficiaryPresentationDate.FromTime(value)
+}
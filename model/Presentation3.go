@@ -13,6 +13,10 @@ type Presentation3 struct {
 	Address *Max256Text `xml:"Adr,omitempty"`
 }
 
+func (p *Presentation3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Presentation3) AddFormat() *DocumentFormat1Choice {
 	p.Format = new(DocumentFormat1Choice)
 	return p.Format
@@ -13,6 +13,10 @@ type Presentation4 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (p *Presentation4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Presentation4) AddMedium() *PresentationMedium1Choice {
 	p.Medium = new(PresentationMedium1Choice)
 	return p.Medium
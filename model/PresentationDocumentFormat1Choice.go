@@ -11,6 +11,10 @@ type PresentationDocumentFormat1Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (p *PresentationDocumentFormat1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PresentationDocumentFormat1Choice) SetCode(value string) {
 	p.Code = (*ExternalUndertakingDocumentType1Code)(&value)
 }
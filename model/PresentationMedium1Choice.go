@@ -11,6 +11,10 @@ type PresentationMedium1Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (p *PresentationMedium1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PresentationMedium1Choice) SetCode(value string) {
 	p.Code = (*PresentationMedium1Code)(&value)
 }
@@ -13,6 +13,10 @@ type PreviousYear1 struct {
 	CashComponentIndicator *YesNoIndicator `xml:"CshCmpntInd"`
 }
 
+func (p *PreviousYear1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PreviousYear1) SetAllPreviousYears(value string) {
 	p.AllPreviousYears = (*PreviousAll)(&value)
 }
@@ -24,3 +28,8 @@ func (p *PreviousYear1) AddSpecificPreviousYears(value string) {
 func (p *PreviousYear1) SetCashComponentIndicator(value string) {
 	p.CashComponentIndicator = (*YesNoIndicator)(&value)
 }
+
+func (p *PreviousYear1) SetCashComponentIndicatorFromBool(value bool) {
+	p.CashComponentIndicator = new(YesNoIndicator)
+	p.CashComponentIndicator.FromBool(value)
+}
@@ -10,6 +10,10 @@ type PreviousYear1Choice struct {
 	SpecificPreviousYears []*ISOYear `xml:"SpcfcPrvsYrs"`
 }
 
+func (p *PreviousYear1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PreviousYear1Choice) SetAllPreviousYears(value string) {
 	p.AllPreviousYears = (*PreviousAll)(&value)
 }
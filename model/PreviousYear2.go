@@ -10,6 +10,10 @@ type PreviousYear2 struct {
 	CashComponentIndicator *YesNoIndicator `xml:"CshCmpntInd"`
 }
 
+func (p *PreviousYear2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PreviousYear2) AddPreviousYears() *PreviousYear1Choice {
 	p.PreviousYears = new(PreviousYear1Choice)
 	return p.PreviousYears
@@ -18,3 +22,8 @@ func (p *PreviousYear2) AddPreviousYears() *PreviousYear1Choice {
 func (p *PreviousYear2) SetCashComponentIndicator(value string) {
 	p.CashComponentIndicator = (*YesNoIndicator)(&value)
 }
+
+func (p *PreviousYear2) SetCashComponentIndicatorFromBool(value bool) {
+	p.CashComponentIndicator = new(YesNoIndicator)
+	p.CashComponentIndicator.FromBool(value)
+}
@@ -10,6 +10,10 @@ type PreviousYear3 struct {
 	CashComponentIndicator *YesNoIndicator `xml:"CshCmpntInd"`
 }
 
+func (p *PreviousYear3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PreviousYear3) AddPreviousYear() *PreviousYear1Choice {
 	p.PreviousYear = new(PreviousYear1Choice)
 	return p.PreviousYear
@@ -18,3 +22,8 @@ func (p *PreviousYear3) AddPreviousYear() *PreviousYear1Choice {
 func (p *PreviousYear3) SetCashComponentIndicator(value string) {
 	p.CashComponentIndicator = (*YesNoIndicator)(&value)
 }
+
+func (p *PreviousYear3) SetCashComponentIndicatorFromBool(value bool) {
+	p.CashComponentIndicator = new(YesNoIndicator)
+	p.CashComponentIndicator.FromBool(value)
+}
@@ -10,6 +10,10 @@ type PreviousYearChoice struct {
 	SpecificPreviousYears []*ISOYear `xml:"SpcfcPrvsYrs"`
 }
 
+func (p *PreviousYearChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PreviousYearChoice) SetAllPreviousYears(value string) {
 	p.AllPreviousYears = (*PreviousAll)(&value)
 }
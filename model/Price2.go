@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Type and information about a price.
 type Price2 struct {
 
@@ -10,6 +12,10 @@ type Price2 struct {
 	Value *PriceRateOrAmountChoice `xml:"Val"`
 }
 
+func (p *Price2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Price2) AddType() *YieldedOrValueType1Choice {
 	p.Type = new(YieldedOrValueType1Choice)
 	return p.Type
@@ -19,3 +25,44 @@ func (p *Price2) AddValue() *PriceRateOrAmountChoice {
 	p.Value = new(PriceRateOrAmountChoice)
 	return p.Value
 }
+
+func (p *Price2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Type != nil {
+		p.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if p.Value != nil {
+		p.Value.EncodeXMLFast(enc, "Val")
+	}
+	enc.End(tag)
+}
+
+func (p *Price2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(YieldedOrValueType1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Type = elem
+		case "Val":
+			elem := new(PriceRateOrAmountChoice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Value = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
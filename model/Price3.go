@@ -10,6 +10,10 @@ type Price3 struct {
 	Value *PriceRateOrAmount1Choice `xml:"Val"`
 }
 
+func (p *Price3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Price3) AddType() *YieldedOrValueType1Choice {
 	p.Type = new(YieldedOrValueType1Choice)
 	return p.Type
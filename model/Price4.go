@@ -10,6 +10,10 @@ type Price4 struct {
 	Type *PriceValueType7Code `xml:"Tp,omitempty"`
 }
 
+func (p *Price4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Price4) AddValue() *PriceRateOrAmountChoice {
 	p.Value = new(PriceRateOrAmountChoice)
 	return p.Value
@@ -13,6 +13,10 @@ type Price6 struct {
 	Source *PriceSource2Code `xml:"Src"`
 }
 
+func (p *Price6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Price6) AddRateOrAmount() *PriceRateOrAmountChoice {
 	p.RateOrAmount = new(PriceRateOrAmountChoice)
 	return p.RateOrAmount
@@ -0,0 +1,68 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Type and information about a price.
+type Price7 struct {
+
+	// Specification of the price type.
+	Type *YieldedOrValueType1Choice `xml:"Tp"`
+
+	// Value of the price, for example, as a currency and value.
+	Value *PriceRateOrAmount3Choice `xml:"Val"`
+}
+
+func (p *Price7) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *Price7) AddType() *YieldedOrValueType1Choice {
+	p.Type = new(YieldedOrValueType1Choice)
+	return p.Type
+}
+
+func (p *Price7) AddValue() *PriceRateOrAmount3Choice {
+	p.Value = new(PriceRateOrAmount3Choice)
+	return p.Value
+}
+
+func (p *Price7) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Type != nil {
+		p.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if p.Value != nil {
+		p.Value.EncodeXMLFast(enc, "Val")
+	}
+	enc.End(tag)
+}
+
+func (p *Price7) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(YieldedOrValueType1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Type = elem
+		case "Val":
+			elem := new(PriceRateOrAmount3Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Value = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Price and an indication of whether it is a increase or a decrease.
 type PriceAndDirection1 struct {
 
@@ -10,10 +14,23 @@ type PriceAndDirection1 struct {
 	Sign *PlusOrMinusIndicator `xml:"Sgn,omitempty"`
 }
 
+func (p *PriceAndDirection1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceAndDirection1) SetValue(value, currency string) {
 	p.Value = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (p *PriceAndDirection1) SetValueFromDecimal(value decimal.Decimal, currency string) {
+	p.Value = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (p *PriceAndDirection1) SetSign(value string) {
 	p.Sign = (*PlusOrMinusIndicator)(&value)
 }
+
+func (p *PriceAndDirection1) SetSignFromBool(value bool) {
+	p.Sign = new(PlusOrMinusIndicator)
+	p.Sign.FromBool(value)
+}
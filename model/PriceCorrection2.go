@@ -13,6 +13,10 @@ type PriceCorrection2 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (p *PriceCorrection2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceCorrection2) AddPreviouslySentPriceDetails() *PriceValuation2 {
 	p.PreviouslySentPriceDetails = new(PriceValuation2)
 	return p.PreviouslySentPriceDetails
@@ -13,6 +13,10 @@ type PriceCorrection3 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (p *PriceCorrection3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceCorrection3) AddPreviouslySentPriceDetails() *PriceValuation3 {
 	p.PreviouslySentPriceDetails = new(PriceValuation3)
 	return p.PreviouslySentPriceDetails
@@ -10,6 +10,10 @@ type PriceDetails10 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat20Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (p *PriceDetails10) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceDetails10) AddGenericCashPricePaidPerProduct() *PriceFormat23Choice {
 	p.GenericCashPricePaidPerProduct = new(PriceFormat23Choice)
 	return p.GenericCashPricePaidPerProduct
@@ -10,6 +10,10 @@ type PriceDetails11 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat7Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (p *PriceDetails11) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceDetails11) AddGenericCashPricePaidPerProduct() *PriceFormat6Choice {
 	p.GenericCashPricePaidPerProduct = new(PriceFormat6Choice)
 	return p.GenericCashPricePaidPerProduct
@@ -10,6 +10,10 @@ type PriceDetails14 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat34Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (p *PriceDetails14) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceDetails14) AddGenericCashPricePaidPerProduct() *PriceFormat6Choice {
 	p.GenericCashPricePaidPerProduct = new(PriceFormat6Choice)
 	return p.GenericCashPricePaidPerProduct
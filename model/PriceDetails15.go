@@ -10,6 +10,10 @@ type PriceDetails15 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat32Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (p *PriceDetails15) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceDetails15) AddGenericCashPricePaidPerProduct() *PriceFormat23Choice {
 	p.GenericCashPricePaidPerProduct = new(PriceFormat23Choice)
 	return p.GenericCashPricePaidPerProduct
@@ -15,6 +15,10 @@ type PriceDetails2 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat7Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (p *PriceDetails2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceDetails2) AddExercisePrice() *PriceFormat6Choice {
 	p.ExercisePrice = new(PriceFormat6Choice)
 	return p.ExercisePrice
@@ -10,6 +10,10 @@ type PriceDetails22 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat47Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (p *PriceDetails22) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceDetails22) AddGenericCashPricePaidPerProduct() *PriceFormat44Choice {
 	p.GenericCashPricePaidPerProduct = new(PriceFormat44Choice)
 	return p.GenericCashPricePaidPerProduct
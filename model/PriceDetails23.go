@@ -10,6 +10,10 @@ type PriceDetails23 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat48Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (p *PriceDetails23) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceDetails23) AddGenericCashPricePaidPerProduct() *PriceFormat51Choice {
 	p.GenericCashPricePaidPerProduct = new(PriceFormat51Choice)
 	return p.GenericCashPricePaidPerProduct
@@ -10,6 +10,10 @@ type PriceDetails24 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat56Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (p *PriceDetails24) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceDetails24) AddGenericCashPricePaidPerProduct() *PriceFormat55Choice {
 	p.GenericCashPricePaidPerProduct = new(PriceFormat55Choice)
 	return p.GenericCashPricePaidPerProduct
@@ -10,6 +10,10 @@ type PriceDetails25 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat60Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (p *PriceDetails25) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceDetails25) AddGenericCashPricePaidPerProduct() *PriceFormat59Choice {
 	p.GenericCashPricePaidPerProduct = new(PriceFormat59Choice)
 	return p.GenericCashPricePaidPerProduct
@@ -15,6 +15,10 @@ type PriceDetails3 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat22Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (p *PriceDetails3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceDetails3) AddExercisePrice() *PriceFormat23Choice {
 	p.ExercisePrice = new(PriceFormat23Choice)
 	return p.ExercisePrice
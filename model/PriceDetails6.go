@@ -10,6 +10,10 @@ type PriceDetails6 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat20Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (p *PriceDetails6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceDetails6) AddGenericCashPricePaidPerProduct() *PriceFormat23Choice {
 	p.GenericCashPricePaidPerProduct = new(PriceFormat23Choice)
 	return p.GenericCashPricePaidPerProduct
@@ -10,6 +10,10 @@ type PriceDetails7 struct {
 	GenericCashPriceReceivedPerProduct *PriceFormat7Choice `xml:"GncCshPricRcvdPerPdct,omitempty"`
 }
 
+func (p *PriceDetails7) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceDetails7) AddGenericCashPricePaidPerProduct() *PriceFormat6Choice {
 	p.GenericCashPricePaidPerProduct = new(PriceFormat6Choice)
 	return p.GenericCashPricePaidPerProduct
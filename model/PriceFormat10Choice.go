@@ -19,6 +19,10 @@ type PriceFormat10Choice struct {
 	AmountPricePerAmount *AmountPricePerAmount2 `xml:"AmtPricPerAmt"`
 }
 
+func (p *PriceFormat10Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat10Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
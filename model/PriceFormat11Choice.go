@@ -13,6 +13,10 @@ type PriceFormat11Choice struct {
 	NotSpecifiedPrice *PriceValueType5Code `xml:"NotSpcfdPric"`
 }
 
+func (p *PriceFormat11Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat11Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
@@ -13,6 +13,10 @@ type PriceFormat19Choice struct {
 	NotSpecifiedPrice *PriceValueType10Code `xml:"NotSpcfdPric"`
 }
 
+func (p *PriceFormat19Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat19Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
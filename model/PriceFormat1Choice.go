@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of formats to express a price.
 type PriceFormat1Choice struct {
 
@@ -19,6 +23,10 @@ type PriceFormat1Choice struct {
 	NotSpecified *PriceValueType6FormatChoice `xml:"NotSpcfd"`
 }
 
+func (p *PriceFormat1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat1Choice) AddAmount() *AmountPrice1 {
 	p.Amount = new(AmountPrice1)
 	return p.Amount
@@ -28,6 +36,11 @@ func (p *PriceFormat1Choice) SetRate(value string) {
 	p.Rate = (*PercentageRate)(&value)
 }
 
+func (p *PriceFormat1Choice) SetRateFromDecimal(value decimal.Decimal) {
+	p.Rate = new(PercentageRate)
+	p.Rate.FromDecimal(value)
+}
+
 func (p *PriceFormat1Choice) AddAmountPricePerFinancialInstrumentQuantity() *AmountPricePerFinancialInstrumentQuantity1 {
 	p.AmountPricePerFinancialInstrumentQuantity = new(AmountPricePerFinancialInstrumentQuantity1)
 	return p.AmountPricePerFinancialInstrumentQuantity
@@ -19,6 +19,10 @@ type PriceFormat20Choice struct {
 	AmountPricePerAmount *AmountPricePerAmount2 `xml:"AmtPricPerAmt"`
 }
 
+func (p *PriceFormat20Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat20Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
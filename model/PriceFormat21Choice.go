@@ -19,6 +19,10 @@ type PriceFormat21Choice struct {
 	AmountPricePerAmount *AmountPricePerAmount2 `xml:"AmtPricPerAmt"`
 }
 
+func (p *PriceFormat21Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat21Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
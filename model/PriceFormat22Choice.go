@@ -19,6 +19,10 @@ type PriceFormat22Choice struct {
 	AmountPricePerAmount *AmountPricePerAmount2 `xml:"AmtPricPerAmt"`
 }
 
+func (p *PriceFormat22Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat22Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a percentage price or an amount price or an unspecified price or index points.
 type PriceFormat23Choice struct {
 
@@ -16,6 +20,10 @@ type PriceFormat23Choice struct {
 	IndexPoints *DecimalNumber `xml:"IndxPts"`
 }
 
+func (p *PriceFormat23Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat23Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
@@ -33,3 +41,8 @@ func (p *PriceFormat23Choice) SetNotSpecifiedPrice(value string) {
 func (p *PriceFormat23Choice) SetIndexPoints(value string) {
 	p.IndexPoints = (*DecimalNumber)(&value)
 }
+
+func (p *PriceFormat23Choice) SetIndexPointsFromDecimal(value decimal.Decimal) {
+	p.IndexPoints = new(DecimalNumber)
+	p.IndexPoints.FromDecimal(value)
+}
@@ -10,6 +10,10 @@ type PriceFormat29Choice struct {
 	NotSpecifiedPrice *PriceValueType10Code `xml:"NotSpcfdPric"`
 }
 
+func (p *PriceFormat29Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat29Choice) AddAmountPrice() *AmountPrice2 {
 	p.AmountPrice = new(AmountPrice2)
 	return p.AmountPrice
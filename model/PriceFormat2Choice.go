@@ -13,6 +13,10 @@ type PriceFormat2Choice struct {
 	NotSpecified *PriceValueType5FormatChoice `xml:"NotSpcfd"`
 }
 
+func (p *PriceFormat2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat2Choice) AddAmount() *AmountPrice1 {
 	p.Amount = new(AmountPrice1)
 	return p.Amount
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a percentage price or an amount price or an unspecified price or an amount price per amount or an amount price per financial instrument quantity.
 type PriceFormat33Choice struct {
 
@@ -22,6 +26,10 @@ type PriceFormat33Choice struct {
 	IndexPoints *DecimalNumber `xml:"IndxPts"`
 }
 
+func (p *PriceFormat33Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat33Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
@@ -49,3 +57,8 @@ func (p *PriceFormat33Choice) AddAmountPricePerAmount() *AmountPricePerAmount2 {
 func (p *PriceFormat33Choice) SetIndexPoints(value string) {
 	p.IndexPoints = (*DecimalNumber)(&value)
 }
+
+func (p *PriceFormat33Choice) SetIndexPointsFromDecimal(value decimal.Decimal) {
+	p.IndexPoints = new(DecimalNumber)
+	p.IndexPoints.FromDecimal(value)
+}
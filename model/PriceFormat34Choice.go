@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a percentage price or an amount price or an amount price per amount or an amount price per financial instrument quantity.
 type PriceFormat34Choice struct {
 
@@ -19,6 +23,10 @@ type PriceFormat34Choice struct {
 	IndexPoints *DecimalNumber `xml:"IndxPts"`
 }
 
+func (p *PriceFormat34Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat34Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
@@ -42,3 +50,8 @@ func (p *PriceFormat34Choice) AddAmountPricePerAmount() *AmountPricePerAmount2 {
 func (p *PriceFormat34Choice) SetIndexPoints(value string) {
 	p.IndexPoints = (*DecimalNumber)(&value)
 }
+
+func (p *PriceFormat34Choice) SetIndexPointsFromDecimal(value decimal.Decimal) {
+	p.IndexPoints = new(DecimalNumber)
+	p.IndexPoints.FromDecimal(value)
+}
@@ -10,6 +10,10 @@ type PriceFormat3Choice struct {
 	Rate *PriceRate1 `xml:"Rate"`
 }
 
+func (p *PriceFormat3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat3Choice) AddAmount() *AmountPrice1 {
 	p.Amount = new(AmountPrice1)
 	return p.Amount
@@ -13,6 +13,10 @@ type PriceFormat45Choice struct {
 	NotSpecifiedPrice *PriceValueType10Code `xml:"NotSpcfdPric"`
 }
 
+func (p *PriceFormat45Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat45Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
@@ -10,6 +10,10 @@ type PriceFormat46Choice struct {
 	NotSpecifiedPrice *PriceValueType10Code `xml:"NotSpcfdPric"`
 }
 
+func (p *PriceFormat46Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat46Choice) AddAmountPrice() *AmountPrice2 {
 	p.AmountPrice = new(AmountPrice2)
 	return p.AmountPrice
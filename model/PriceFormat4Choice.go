@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of formats to express a price.
 type PriceFormat4Choice struct {
 
@@ -16,6 +20,10 @@ type PriceFormat4Choice struct {
 	IndexPoints *DecimalNumber `xml:"IndxPts"`
 }
 
+func (p *PriceFormat4Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat4Choice) AddAmount() *AmountPrice1 {
 	p.Amount = new(AmountPrice1)
 	return p.Amount
@@ -34,3 +42,8 @@ func (p *PriceFormat4Choice) AddNotSpecified() *PriceValueType5FormatChoice {
 func (p *PriceFormat4Choice) SetIndexPoints(value string) {
 	p.IndexPoints = (*DecimalNumber)(&value)
 }
+
+func (p *PriceFormat4Choice) SetIndexPointsFromDecimal(value decimal.Decimal) {
+	p.IndexPoints = new(DecimalNumber)
+	p.IndexPoints.FromDecimal(value)
+}
@@ -10,6 +10,10 @@ type PriceFormat50Choice struct {
 	AmountPrice *AmountPrice3 `xml:"AmtPric"`
 }
 
+func (p *PriceFormat50Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat50Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a percentage price or an amount price or index points.
 type PriceFormat51Choice struct {
 
@@ -13,6 +17,10 @@ type PriceFormat51Choice struct {
 	IndexPoints *DecimalNumber `xml:"IndxPts"`
 }
 
+func (p *PriceFormat51Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat51Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
@@ -26,3 +34,8 @@ func (p *PriceFormat51Choice) AddAmountPrice() *AmountPrice3 {
 func (p *PriceFormat51Choice) SetIndexPoints(value string) {
 	p.IndexPoints = (*DecimalNumber)(&value)
 }
+
+func (p *PriceFormat51Choice) SetIndexPointsFromDecimal(value decimal.Decimal) {
+	p.IndexPoints = new(DecimalNumber)
+	p.IndexPoints.FromDecimal(value)
+}
@@ -10,6 +10,10 @@ type PriceFormat52Choice struct {
 	AmountPrice *AmountPrice5 `xml:"AmtPric"`
 }
 
+func (p *PriceFormat52Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat52Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
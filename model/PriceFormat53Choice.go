@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a percentage price or an amount price or an unspecified price or an amount price per amount or an amount price per financial instrument quantity.
 type PriceFormat53Choice struct {
 
@@ -22,6 +26,10 @@ type PriceFormat53Choice struct {
 	IndexPoints *RestrictedFINDecimalNumber `xml:"IndxPts"`
 }
 
+func (p *PriceFormat53Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat53Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
@@ -49,3 +57,8 @@ func (p *PriceFormat53Choice) AddAmountPricePerAmount() *AmountPricePerAmount3 {
 func (p *PriceFormat53Choice) SetIndexPoints(value string) {
 	p.IndexPoints = (*RestrictedFINDecimalNumber)(&value)
 }
+
+func (p *PriceFormat53Choice) SetIndexPointsFromDecimal(value decimal.Decimal) {
+	p.IndexPoints = new(RestrictedFINDecimalNumber)
+	p.IndexPoints.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a percentage price or an amount price or index points.
 type PriceFormat55Choice struct {
 
@@ -13,6 +17,10 @@ type PriceFormat55Choice struct {
 	IndexPoints *RestrictedFINDecimalNumber `xml:"IndxPts"`
 }
 
+func (p *PriceFormat55Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat55Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
@@ -26,3 +34,8 @@ func (p *PriceFormat55Choice) AddAmountPrice() *AmountPrice5 {
 func (p *PriceFormat55Choice) SetIndexPoints(value string) {
 	p.IndexPoints = (*RestrictedFINDecimalNumber)(&value)
 }
+
+func (p *PriceFormat55Choice) SetIndexPointsFromDecimal(value decimal.Decimal) {
+	p.IndexPoints = new(RestrictedFINDecimalNumber)
+	p.IndexPoints.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a percentage price or an amount price or an amount price per amount or an amount price per financial instrument quantity.
 type PriceFormat56Choice struct {
 
@@ -19,6 +23,10 @@ type PriceFormat56Choice struct {
 	IndexPoints *RestrictedFINDecimalNumber `xml:"IndxPts"`
 }
 
+func (p *PriceFormat56Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat56Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
@@ -42,3 +50,8 @@ func (p *PriceFormat56Choice) AddAmountPricePerAmount() *AmountPricePerAmount3 {
 func (p *PriceFormat56Choice) SetIndexPoints(value string) {
 	p.IndexPoints = (*RestrictedFINDecimalNumber)(&value)
 }
+
+func (p *PriceFormat56Choice) SetIndexPointsFromDecimal(value decimal.Decimal) {
+	p.IndexPoints = new(RestrictedFINDecimalNumber)
+	p.IndexPoints.FromDecimal(value)
+}
@@ -13,6 +13,10 @@ type PriceFormat57Choice struct {
 	NotSpecifiedPrice *PriceValueType10Code `xml:"NotSpcfdPric"`
 }
 
+func (p *PriceFormat57Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat57Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
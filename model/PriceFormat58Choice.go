@@ -10,6 +10,10 @@ type PriceFormat58Choice struct {
 	NotSpecifiedPrice *PriceValueType10Code `xml:"NotSpcfdPric"`
 }
 
+func (p *PriceFormat58Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat58Choice) AddAmountPrice() *AmountPrice4 {
 	p.AmountPrice = new(AmountPrice4)
 	return p.AmountPrice
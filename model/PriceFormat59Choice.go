@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a percentage price or an amount price or an unspecified price or index points.
 type PriceFormat59Choice struct {
 
@@ -16,6 +20,10 @@ type PriceFormat59Choice struct {
 	IndexPoints *RestrictedFINDecimalNumber `xml:"IndxPts"`
 }
 
+func (p *PriceFormat59Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat59Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
@@ -33,3 +41,8 @@ func (p *PriceFormat59Choice) SetNotSpecifiedPrice(value string) {
 func (p *PriceFormat59Choice) SetIndexPoints(value string) {
 	p.IndexPoints = (*RestrictedFINDecimalNumber)(&value)
 }
+
+func (p *PriceFormat59Choice) SetIndexPointsFromDecimal(value decimal.Decimal) {
+	p.IndexPoints = new(RestrictedFINDecimalNumber)
+	p.IndexPoints.FromDecimal(value)
+}
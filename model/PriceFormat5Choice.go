@@ -10,6 +10,10 @@ type PriceFormat5Choice struct {
 	AmountPrice *AmountPrice3 `xml:"AmtPric"`
 }
 
+func (p *PriceFormat5Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat5Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
@@ -16,6 +16,10 @@ type PriceFormat7Choice struct {
 	AmountPricePerAmount *AmountPricePerAmount2 `xml:"AmtPricPerAmt"`
 }
 
+func (p *PriceFormat7Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat7Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
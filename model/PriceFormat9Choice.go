@@ -19,6 +19,10 @@ type PriceFormat9Choice struct {
 	AmountPricePerAmount *AmountPricePerAmount2 `xml:"AmtPricPerAmt"`
 }
 
+func (p *PriceFormat9Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceFormat9Choice) AddPercentagePrice() *PercentagePrice1 {
 	p.PercentagePrice = new(PercentagePrice1)
 	return p.PercentagePrice
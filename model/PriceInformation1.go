@@ -22,6 +22,10 @@ type PriceInformation1 struct {
 	Yielded *YesNoIndicator `xml:"Yldd,omitempty"`
 }
 
+func (p *PriceInformation1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceInformation1) AddValue() *PriceRateOrAmountOrUnknownChoice {
 	p.Value = new(PriceRateOrAmountOrUnknownChoice)
 	return p.Value
@@ -48,3 +52,8 @@ func (p *PriceInformation1) AddQuotationDate() *DateAndDateTimeChoice {
 func (p *PriceInformation1) SetYielded(value string) {
 	p.Yielded = (*YesNoIndicator)(&value)
 }
+
+func (p *PriceInformation1) SetYieldedFromBool(value bool) {
+	p.Yielded = new(YesNoIndicator)
+	p.Yielded.FromBool(value)
+}
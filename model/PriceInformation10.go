@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money for which goods or services are offered, sold, or bought.
 type PriceInformation10 struct {
 
@@ -16,10 +20,18 @@ type PriceInformation10 struct {
 	AmountOfChange *PriceValueAndRate4 `xml:"AmtOfChng,omitempty"`
 }
 
+func (p *PriceInformation10) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceInformation10) SetCurrentPrice(value, currency string) {
 	p.CurrentPrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (p *PriceInformation10) SetCurrentPriceFromDecimal(value decimal.Decimal, currency string) {
+	p.CurrentPrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (p *PriceInformation10) AddType() *TypeOfPrice27Choice {
 	p.Type = new(TypeOfPrice27Choice)
 	return p.Type
@@ -29,6 +41,10 @@ func (p *PriceInformation10) SetPreviousPrice(value, currency string) {
 	p.PreviousPrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (p *PriceInformation10) SetPreviousPriceFromDecimal(value decimal.Decimal, currency string) {
+	p.PreviousPrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (p *PriceInformation10) AddAmountOfChange() *PriceValueAndRate4 {
 	p.AmountOfChange = new(PriceValueAndRate4)
 	return p.AmountOfChange
@@ -16,6 +16,10 @@ type PriceInformation11 struct {
 	SourceOfPrice *MarketIdentification79 `xml:"SrcOfPric,omitempty"`
 }
 
+func (p *PriceInformation11) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceInformation11) AddValue() *Price4 {
 	p.Value = new(Price4)
 	return p.Value
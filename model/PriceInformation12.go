@@ -19,6 +19,10 @@ type PriceInformation12 struct {
 	QuotationDate *DateAndDateTimeChoice `xml:"QtnDt,omitempty"`
 }
 
+func (p *PriceInformation12) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceInformation12) AddType() *TypeOfPrice28Choice {
 	p.Type = new(TypeOfPrice28Choice)
 	return p.Type
@@ -19,6 +19,10 @@ type PriceInformation13 struct {
 	QuotationDate *DateAndDateTimeChoice `xml:"QtnDt,omitempty"`
 }
 
+func (p *PriceInformation13) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceInformation13) AddType() *TypeOfPrice30Choice {
 	p.Type = new(TypeOfPrice30Choice)
 	return p.Type
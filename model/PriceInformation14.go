@@ -19,6 +19,10 @@ type PriceInformation14 struct {
 	QuotationDate *DateAndDateTimeChoice `xml:"QtnDt,omitempty"`
 }
 
+func (p *PriceInformation14) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceInformation14) AddType() *TypeOfPrice33Choice {
 	p.Type = new(TypeOfPrice33Choice)
 	return p.Type
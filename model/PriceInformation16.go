@@ -19,6 +19,10 @@ type PriceInformation16 struct {
 	QuotationDate *DateAndDateTimeChoice `xml:"QtnDt,omitempty"`
 }
 
+func (p *PriceInformation16) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceInformation16) AddType() *TypeOfPrice45Choice {
 	p.Type = new(TypeOfPrice45Choice)
 	return p.Type
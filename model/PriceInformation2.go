@@ -25,6 +25,10 @@ type PriceInformation2 struct {
 	Yielded *YesNoIndicator `xml:"Yldd,omitempty"`
 }
 
+func (p *PriceInformation2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceInformation2) AddValue() *PriceRateOrAmountOrUnknownChoice {
 	p.Value = new(PriceRateOrAmountOrUnknownChoice)
 	return p.Value
@@ -55,3 +59,8 @@ func (p *PriceInformation2) AddQuotationDate() *DateAndDateTimeChoice {
 func (p *PriceInformation2) SetYielded(value string) {
 	p.Yielded = (*YesNoIndicator)(&value)
 }
+
+func (p *PriceInformation2) SetYieldedFromBool(value bool) {
+	p.Yielded = new(YesNoIndicator)
+	p.Yielded.FromBool(value)
+}
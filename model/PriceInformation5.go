@@ -19,6 +19,10 @@ type PriceInformation5 struct {
 	QuotationDate *DateAndDateTimeChoice `xml:"QtnDt,omitempty"`
 }
 
+func (p *PriceInformation5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceInformation5) AddType() *TypeOfPrice4Choice {
 	p.Type = new(TypeOfPrice4Choice)
 	return p.Type
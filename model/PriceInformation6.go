@@ -19,6 +19,10 @@ type PriceInformation6 struct {
 	QuotationDate *DateAndDateTimeChoice `xml:"QtnDt,omitempty"`
 }
 
+func (p *PriceInformation6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceInformation6) AddType() *TypeOfPrice6Choice {
 	p.Type = new(TypeOfPrice6Choice)
 	return p.Type
@@ -16,6 +16,10 @@ type PriceInformation9 struct {
 	SourceOfPrice *MarketIdentification77 `xml:"SrcOfPric,omitempty"`
 }
 
+func (p *PriceInformation9) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceInformation9) AddValue() *Price4 {
 	p.Value = new(Price4)
 	return p.Value
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies a price expressed as a rate.
 type PriceRate1 struct {
 
@@ -10,6 +14,10 @@ type PriceRate1 struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (p *PriceRate1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceRate1) AddRateType() *PriceRateType3FormatChoice {
 	p.RateType = new(PriceRateType3FormatChoice)
 	return p.RateType
@@ -18,3 +26,8 @@ func (p *PriceRate1) AddRateType() *PriceRateType3FormatChoice {
 func (p *PriceRate1) SetRate(value string) {
 	p.Rate = (*PercentageRate)(&value)
 }
+
+func (p *PriceRate1) SetRateFromDecimal(value decimal.Decimal) {
+	p.Rate = new(PercentageRate)
+	p.Rate.FromDecimal(value)
+}
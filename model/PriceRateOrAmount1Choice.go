@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of formats for the price.
 type PriceRateOrAmount1Choice struct {
 
@@ -10,10 +14,23 @@ type PriceRateOrAmount1Choice struct {
 	Amount *RestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount `xml:"Amt"`
 }
 
+func (p *PriceRateOrAmount1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceRateOrAmount1Choice) SetRate(value string) {
 	p.Rate = (*PercentageRate)(&value)
 }
 
+func (p *PriceRateOrAmount1Choice) SetRateFromDecimal(value decimal.Decimal) {
+	p.Rate = new(PercentageRate)
+	p.Rate.FromDecimal(value)
+}
+
 func (p *PriceRateOrAmount1Choice) SetAmount(value, currency string) {
 	p.Amount = NewRestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (p *PriceRateOrAmount1Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.Amount = NewRestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
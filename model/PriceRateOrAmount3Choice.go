@@ -0,0 +1,78 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Choice of formats for the price.
+type PriceRateOrAmount3Choice struct {
+
+	// Price expressed as a rate, that is percentage.
+	Rate *PercentageRate `xml:"Rate"`
+
+	// Price expressed as a currency and value.
+	Amount *ActiveOrHistoricCurrencyAnd13DecimalAmount `xml:"Amt"`
+}
+
+func (p *PriceRateOrAmount3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *PriceRateOrAmount3Choice) SetRate(value string) {
+	p.Rate = (*PercentageRate)(&value)
+}
+
+func (p *PriceRateOrAmount3Choice) SetRateFromDecimal(value decimal.Decimal) {
+	p.Rate = new(PercentageRate)
+	p.Rate.FromDecimal(value)
+}
+
+func (p *PriceRateOrAmount3Choice) SetAmount(value, currency string) {
+	p.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
+}
+
+func (p *PriceRateOrAmount3Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
+func (p *PriceRateOrAmount3Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Rate != nil {
+		enc.Element("Rate", string(*p.Rate))
+	}
+	if p.Amount != nil {
+		p.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	enc.End(tag)
+}
+
+func (p *PriceRateOrAmount3Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Rate":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Rate = (*PercentageRate)(&value)
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAnd13DecimalAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Amount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
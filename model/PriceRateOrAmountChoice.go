@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Choice of formats for the price.
 type PriceRateOrAmountChoice struct {
 
@@ -10,10 +15,64 @@ type PriceRateOrAmountChoice struct {
 	Amount *ActiveOrHistoricCurrencyAnd13DecimalAmount `xml:"Amt"`
 }
 
+func (p *PriceRateOrAmountChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceRateOrAmountChoice) SetRate(value string) {
 	p.Rate = (*PercentageRate)(&value)
 }
 
+func (p *PriceRateOrAmountChoice) SetRateFromDecimal(value decimal.Decimal) {
+	p.Rate = new(PercentageRate)
+	p.Rate.FromDecimal(value)
+}
+
 func (p *PriceRateOrAmountChoice) SetAmount(value, currency string) {
 	p.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (p *PriceRateOrAmountChoice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
+func (p *PriceRateOrAmountChoice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Rate != nil {
+		enc.Element("Rate", string(*p.Rate))
+	}
+	if p.Amount != nil {
+		p.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	enc.End(tag)
+}
+
+func (p *PriceRateOrAmountChoice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Rate":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Rate = (*PercentageRate)(&value)
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAnd13DecimalAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Amount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of formats for the price.
 type PriceRateOrAmountOrUnknown1Choice struct {
 
@@ -13,14 +17,32 @@ type PriceRateOrAmountOrUnknown1Choice struct {
 	UnknownIndicator *YesNoIndicator `xml:"UknwnInd"`
 }
 
+func (p *PriceRateOrAmountOrUnknown1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceRateOrAmountOrUnknown1Choice) SetRate(value string) {
 	p.Rate = (*PercentageRate)(&value)
 }
 
+func (p *PriceRateOrAmountOrUnknown1Choice) SetRateFromDecimal(value decimal.Decimal) {
+	p.Rate = new(PercentageRate)
+	p.Rate.FromDecimal(value)
+}
+
 func (p *PriceRateOrAmountOrUnknown1Choice) SetAmount(value, currency string) {
 	p.Amount = NewRestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (p *PriceRateOrAmountOrUnknown1Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.Amount = NewRestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (p *PriceRateOrAmountOrUnknown1Choice) SetUnknownIndicator(value string) {
 	p.UnknownIndicator = (*YesNoIndicator)(&value)
 }
+
+func (p *PriceRateOrAmountOrUnknown1Choice) SetUnknownIndicatorFromBool(value bool) {
+	p.UnknownIndicator = new(YesNoIndicator)
+	p.UnknownIndicator.FromBool(value)
+}
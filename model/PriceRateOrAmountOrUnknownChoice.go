@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of formats for the price.
 type PriceRateOrAmountOrUnknownChoice struct {
 
@@ -13,14 +17,32 @@ type PriceRateOrAmountOrUnknownChoice struct {
 	UnknownIndicator *YesNoIndicator `xml:"UknwnInd"`
 }
 
+func (p *PriceRateOrAmountOrUnknownChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceRateOrAmountOrUnknownChoice) SetRate(value string) {
 	p.Rate = (*PercentageRate)(&value)
 }
 
+func (p *PriceRateOrAmountOrUnknownChoice) SetRateFromDecimal(value decimal.Decimal) {
+	p.Rate = new(PercentageRate)
+	p.Rate.FromDecimal(value)
+}
+
 func (p *PriceRateOrAmountOrUnknownChoice) SetAmount(value, currency string) {
 	p.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (p *PriceRateOrAmountOrUnknownChoice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (p *PriceRateOrAmountOrUnknownChoice) SetUnknownIndicator(value string) {
 	p.UnknownIndicator = (*YesNoIndicator)(&value)
 }
+
+func (p *PriceRateOrAmountOrUnknownChoice) SetUnknownIndicatorFromBool(value bool) {
+	p.UnknownIndicator = new(YesNoIndicator)
+	p.UnknownIndicator.FromBool(value)
+}
@@ -10,6 +10,10 @@ type PriceRateType3FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (p *PriceRateType3FormatChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceRateType3FormatChoice) SetCode(value string) {
 	p.Code = (*PriceRateType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type PriceReport1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (p *PriceReport1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceReport1) AddPriceValuationDetails() *PriceValuation2 {
 	newValue := new(PriceValuation2)
 	p.PriceValuationDetails = append(p.PriceValuationDetails, newValue)
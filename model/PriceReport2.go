@@ -10,6 +10,10 @@ type PriceReport2 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (p *PriceReport2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceReport2) AddPriceValuationDetails() *PriceValuation3 {
 	newValue := new(PriceValuation3)
 	p.PriceValuationDetails = append(p.PriceValuationDetails, newValue)
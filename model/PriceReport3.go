@@ -7,6 +7,10 @@ type PriceReport3 struct {
 	PriceValuationDetails []*PriceValuation4 `xml:"PricValtnDtls"`
 }
 
+func (p *PriceReport3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceReport3) AddPriceValuationDetails() *PriceValuation4 {
 	newValue := new(PriceValuation4)
 	p.PriceValuationDetails = append(p.PriceValuationDetails, newValue)
@@ -10,6 +10,10 @@ type PriceSource struct {
 	Narrative *Max35Text `xml:"Nrrtv,omitempty"`
 }
 
+func (p *PriceSource) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceSource) SetPriceSource(value string) {
 	p.PriceSource = (*PriceSource1Code)(&value)
 }
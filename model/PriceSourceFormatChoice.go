@@ -13,6 +13,10 @@ type PriceSourceFormatChoice struct {
 	PlaceAsDSS *GenericIdentification5 `xml:"PlcAsDSS"`
 }
 
+func (p *PriceSourceFormatChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceSourceFormatChoice) SetLocalMarketPlace(value string) {
 	p.LocalMarketPlace = (*MICIdentifier)(&value)
 }
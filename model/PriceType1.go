@@ -10,6 +10,10 @@ type PriceType1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PriceType1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceType1) SetStructured(value string) {
 	p.Structured = (*TypeOfPrice7Code)(&value)
 }
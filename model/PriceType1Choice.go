@@ -10,6 +10,10 @@ type PriceType1Choice struct {
 	Indicative *Price2 `xml:"Indctv"`
 }
 
+func (p *PriceType1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceType1Choice) AddMarket() *Price2 {
 	p.Market = new(Price2)
 	return p.Market
@@ -10,6 +10,10 @@ type PriceType2 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (p *PriceType2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceType2) SetStructured(value string) {
 	p.Structured = (*TypeOfPrice6Code)(&value)
 }
@@ -10,6 +10,10 @@ type PriceType2Choice struct {
 	Indicative *Price3 `xml:"Indctv"`
 }
 
+func (p *PriceType2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceType2Choice) AddMarket() *Price3 {
 	p.Market = new(Price3)
 	return p.Market
@@ -40,6 +40,10 @@ type PriceValuation2 struct {
 	ValuationStatistics []*ValuationStatistics2 `xml:"ValtnSttstcs,omitempty"`
 }
 
+func (p *PriceValuation2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceValuation2) SetIdentification(value string) {
 	p.Identification = (*Max35Text)(&value)
 }
@@ -86,6 +90,11 @@ func (p *PriceValuation2) SetSuspendedIndicator(value string) {
 	p.SuspendedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *PriceValuation2) SetSuspendedIndicatorFromBool(value bool) {
+	p.SuspendedIndicator = new(YesNoIndicator)
+	p.SuspendedIndicator.FromBool(value)
+}
+
 func (p *PriceValuation2) AddPriceDetails() *UnitPrice6 {
 	newValue := new(UnitPrice6)
 	p.PriceDetails = append(p.PriceDetails, newValue)
@@ -52,6 +52,10 @@ type PriceValuation4 struct {
 	PerformanceDetails *PerformanceFactors1 `xml:"PrfrmncDtls,omitempty"`
 }
 
+func (p *PriceValuation4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceValuation4) SetIdentification(value string) {
 	p.Identification = (*Max35Text)(&value)
 }
@@ -107,10 +111,20 @@ func (p *PriceValuation4) SetOfficialValuationIndicator(value string) {
 	p.OfficialValuationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *PriceValuation4) SetOfficialValuationIndicatorFromBool(value bool) {
+	p.OfficialValuationIndicator = new(YesNoIndicator)
+	p.OfficialValuationIndicator.FromBool(value)
+}
+
 func (p *PriceValuation4) SetSuspendedIndicator(value string) {
 	p.SuspendedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *PriceValuation4) SetSuspendedIndicatorFromBool(value bool) {
+	p.SuspendedIndicator = new(YesNoIndicator)
+	p.SuspendedIndicator.FromBool(value)
+}
+
 func (p *PriceValuation4) AddPriceDetails() *UnitPrice15 {
 	newValue := new(UnitPrice15)
 	p.PriceDetails = append(p.PriceDetails, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Value given to a price.
 type PriceValue1 struct {
 
@@ -7,6 +11,14 @@ type PriceValue1 struct {
 	Amount *ActiveCurrencyAnd13DecimalAmount `xml:"Amt"`
 }
 
+func (p *PriceValue1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceValue1) SetAmount(value, currency string) {
 	p.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (p *PriceValue1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
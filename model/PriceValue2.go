@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Value given to a price.
 type PriceValue2 struct {
 
@@ -10,10 +14,23 @@ type PriceValue2 struct {
 	Rate *PercentageRate `xml:"Rt,omitempty"`
 }
 
+func (p *PriceValue2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceValue2) SetAmount(value, currency string) {
 	p.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (p *PriceValue2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (p *PriceValue2) SetRate(value string) {
 	p.Rate = (*PercentageRate)(&value)
 }
+
+func (p *PriceValue2) SetRateFromDecimal(value decimal.Decimal) {
+	p.Rate = new(PercentageRate)
+	p.Rate.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Value given to a price.
 type PriceValue5 struct {
 
@@ -7,6 +11,14 @@ type PriceValue5 struct {
 	Amount *ActiveOrHistoricCurrencyAnd13DecimalAmount `xml:"Amt"`
 }
 
+func (p *PriceValue5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceValue5) SetAmount(value, currency string) {
 	p.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (p *PriceValue5) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
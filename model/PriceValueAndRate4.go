@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Price and rate.
 type PriceValueAndRate4 struct {
 
@@ -10,6 +14,10 @@ type PriceValueAndRate4 struct {
 	Rate *PercentageRate `xml:"Rate,omitempty"`
 }
 
+func (p *PriceValueAndRate4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceValueAndRate4) AddValue() *PriceAndDirection1 {
 	p.Value = new(PriceAndDirection1)
 	return p.Value
@@ -18,3 +26,8 @@ func (p *PriceValueAndRate4) AddValue() *PriceAndDirection1 {
 func (p *PriceValueAndRate4) SetRate(value string) {
 	p.Rate = (*PercentageRate)(&value)
 }
+
+func (p *PriceValueAndRate4) SetRateFromDecimal(value decimal.Decimal) {
+	p.Rate = new(PercentageRate)
+	p.Rate.FromDecimal(value)
+}
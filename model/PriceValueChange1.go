@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Value given to a positive or negative price change.
 type PriceValueChange1 struct {
 
@@ -13,14 +17,32 @@ type PriceValueChange1 struct {
 	Rate *PercentageRate `xml:"Rate,omitempty"`
 }
 
+func (p *PriceValueChange1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceValueChange1) SetAmount(value, currency string) {
 	p.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (p *PriceValueChange1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (p *PriceValueChange1) SetAmountSign(value string) {
 	p.AmountSign = (*PlusOrMinusIndicator)(&value)
 }
 
+func (p *PriceValueChange1) SetAmountSignFromBool(value bool) {
+	p.AmountSign = new(PlusOrMinusIndicator)
+	p.AmountSign.FromBool(value)
+}
+
 func (p *PriceValueChange1) SetRate(value string) {
 	p.Rate = (*PercentageRate)(&value)
 }
+
+func (p *PriceValueChange1) SetRateFromDecimal(value decimal.Decimal) {
+	p.Rate = new(PercentageRate)
+	p.Rate.FromDecimal(value)
+}
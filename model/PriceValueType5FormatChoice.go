@@ -10,6 +10,10 @@ type PriceValueType5FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (p *PriceValueType5FormatChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceValueType5FormatChoice) SetCode(value string) {
 	p.Code = (*PriceValueType5Code)(&value)
 }
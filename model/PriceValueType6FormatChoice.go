@@ -10,6 +10,10 @@ type PriceValueType6FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (p *PriceValueType6FormatChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriceValueType6FormatChoice) SetCode(value string) {
 	p.Code = (*PriceValueType6Code)(&value)
 }
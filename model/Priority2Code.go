@@ -1,3 +1,26 @@
 package model
 
 type Priority2Code string
+
+const (
+	Priority2CodeHIGH Priority2Code = "HIGH"
+	Priority2CodeNORM Priority2Code = "NORM"
+)
+
+var priority2CodeDescriptions = map[Priority2Code]string{
+	Priority2CodeHIGH: "High: priority level is high.",
+	Priority2CodeNORM: "Normal: priority level is normal.",
+}
+
+func (p Priority2Code) IsValid() bool {
+	_, ok := priority2CodeDescriptions[p]
+	return ok
+}
+
+func (p Priority2Code) Description() string {
+	return priority2CodeDescriptions[p]
+}
+
+func (p Priority2Code) Validate() error {
+	return validateEnumeration("Priority2Code", string(p), p.IsValid(), "HIGH, NORM")
+}
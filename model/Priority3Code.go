@@ -1,3 +1,28 @@
 package model
 
 type Priority3Code string
+
+const (
+	Priority3CodeURGT Priority3Code = "URGT"
+	Priority3CodeHIGH Priority3Code = "HIGH"
+	Priority3CodeNORM Priority3Code = "NORM"
+)
+
+var priority3CodeDescriptions = map[Priority3Code]string{
+	Priority3CodeURGT: "Urgent: priority level is urgent (highest priority possible).",
+	Priority3CodeHIGH: "High: priority level is high.",
+	Priority3CodeNORM: "Normal: priority level is normal.",
+}
+
+func (p Priority3Code) IsValid() bool {
+	_, ok := priority3CodeDescriptions[p]
+	return ok
+}
+
+func (p Priority3Code) Description() string {
+	return priority3CodeDescriptions[p]
+}
+
+func (p Priority3Code) Validate() error {
+	return validateEnumeration("Priority3Code", string(p), p.IsValid(), "URGT, HIGH, NORM")
+}
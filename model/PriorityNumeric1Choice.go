@@ -10,6 +10,10 @@ type PriorityNumeric1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PriorityNumeric1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriorityNumeric1Choice) SetNumeric(value string) {
 	p.Numeric = (*Exact4NumericText)(&value)
 }
@@ -10,6 +10,10 @@ type PriorityNumeric3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (p *PriorityNumeric3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriorityNumeric3Choice) SetNumeric(value string) {
 	p.Numeric = (*Exact4NumericText)(&value)
 }
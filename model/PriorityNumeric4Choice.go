@@ -10,6 +10,10 @@ type PriorityNumeric4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PriorityNumeric4Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriorityNumeric4Choice) SetNumeric(value string) {
 	p.Numeric = (*Exact4NumericText)(&value)
 }
@@ -10,6 +10,10 @@ type PriorityNumeric5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PriorityNumeric5Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PriorityNumeric5Choice) SetNumeric(value string) {
 	p.Numeric = (*Exact4NumericText)(&value)
 }
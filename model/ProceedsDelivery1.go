@@ -16,6 +16,10 @@ type ProceedsDelivery1 struct {
 	AccountServicerIdentification *PartyIdentification2Choice `xml:"AcctSvcrId,omitempty"`
 }
 
+func (p *ProceedsDelivery1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProceedsDelivery1) SetSecuritiesAccountIdentification(value string) {
 	p.SecuritiesAccountIdentification = (*Max35Text)(&value)
 }
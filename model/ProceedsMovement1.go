@@ -13,6 +13,10 @@ type ProceedsMovement1 struct {
 	TaxDetails *TaxVoucher1 `xml:"TaxDtls,omitempty"`
 }
 
+func (p *ProceedsMovement1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProceedsMovement1) AddCashProceedsMovementDetails() *CashProceeds1 {
 	newValue := new(CashProceeds1)
 	p.CashProceedsMovementDetails = append(p.CashProceedsMovementDetails, newValue)
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Definition of retry process if activation of an action fails.
 type ProcessRetry1 struct {
 
@@ -13,6 +19,10 @@ type ProcessRetry1 struct {
 	LastReTryTime *ISOTime `xml:"LastReTryTm,omitempty"`
 }
 
+func (p *ProcessRetry1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessRetry1) SetDelay(value string) {
 	p.Delay = (*Max9NumericText)(&value)
 }
@@ -21,6 +31,16 @@ func (p *ProcessRetry1) SetMaximumNumber(value string) {
 	p.MaximumNumber = (*Number)(&value)
 }
 
+func (p *ProcessRetry1) SetMaximumNumberFromDecimal(value decimal.Decimal) {
+	p.MaximumNumber = new(Number)
+	p.MaximumNumber.FromDecimal(value)
+}
+
 func (p *ProcessRetry1) SetLastReTryTime(value string) {
 	p.LastReTryTime = (*ISOTime)(&value)
 }
+
+func (p *ProcessRetry1) SetLastReTryTimeFromTime(value time.Time) {
+	p.LastReTryTime = new(ISOTime)
+	p.LastReTryTime.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Definition of retry process if activation of an action fails.
 type ProcessRetry2 struct {
 
@@ -10,6 +14,10 @@ type ProcessRetry2 struct {
 	MaximumNumber *Number `xml:"MaxNb,omitempty"`
 }
 
+func (p *ProcessRetry2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessRetry2) SetDelay(value string) {
 	p.Delay = (*Max9NumericText)(&value)
 }
@@ -17,3 +25,8 @@ func (p *ProcessRetry2) SetDelay(value string) {
 func (p *ProcessRetry2) SetMaximumNumber(value string) {
 	p.MaximumNumber = (*Number)(&value)
 }
+
+func (p *ProcessRetry2) SetMaximumNumberFromDecimal(value decimal.Decimal) {
+	p.MaximumNumber = new(Number)
+	p.MaximumNumber.FromDecimal(value)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters defining the timing conditions to process an action.
 type ProcessTiming1 struct {
 
@@ -22,6 +28,10 @@ type ProcessTiming1 struct {
 	ReTry *ProcessRetry1 `xml:"ReTry,omitempty"`
 }
 
+func (p *ProcessTiming1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessTiming1) SetWaitingTime(value string) {
 	p.WaitingTime = (*Max9NumericText)(&value)
 }
@@ -30,10 +40,20 @@ func (p *ProcessTiming1) SetStartTime(value string) {
 	p.StartTime = (*ISODateTime)(&value)
 }
 
+func (p *ProcessTiming1) SetStartTimeFromTime(value time.Time) {
+	p.StartTime = new(ISODateTime)
+	p.StartTime.FromTime(value)
+}
+
 func (p *ProcessTiming1) SetEndTime(value string) {
 	p.EndTime = (*ISODateTime)(&value)
 }
 
+func (p *ProcessTiming1) SetEndTimeFromTime(value time.Time) {
+	p.EndTime = new(ISODateTime)
+	p.EndTime.FromTime(value)
+}
+
 func (p *ProcessTiming1) SetPeriod(value string) {
 	p.Period = (*Max9NumericText)(&value)
 }
@@ -42,6 +62,11 @@ func (p *ProcessTiming1) SetMaximumNumber(value string) {
 	p.MaximumNumber = (*Number)(&value)
 }
 
+func (p *ProcessTiming1) SetMaximumNumberFromDecimal(value decimal.Decimal) {
+	p.MaximumNumber = new(Number)
+	p.MaximumNumber.FromDecimal(value)
+}
+
 func (p *ProcessTiming1) AddReTry() *ProcessRetry1 {
 	p.ReTry = new(ProcessRetry1)
 	return p.ReTry
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters defining the timing conditions to process an action.
 type ProcessTiming2 struct {
 
@@ -22,6 +28,10 @@ type ProcessTiming2 struct {
 	ReTry *ProcessRetry2 `xml:"ReTry,omitempty"`
 }
 
+func (p *ProcessTiming2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessTiming2) SetWaitingTime(value string) {
 	p.WaitingTime = (*Max9NumericText)(&value)
 }
@@ -30,10 +40,20 @@ func (p *ProcessTiming2) SetStartTime(value string) {
 	p.StartTime = (*ISODateTime)(&value)
 }
 
+func (p *ProcessTiming2) SetStartTimeFromTime(value time.Time) {
+	p.StartTime = new(ISODateTime)
+	p.StartTime.FromTime(value)
+}
+
 func (p *ProcessTiming2) SetEndTime(value string) {
 	p.EndTime = (*ISODateTime)(&value)
 }
 
+func (p *ProcessTiming2) SetEndTimeFromTime(value time.Time) {
+	p.EndTime = new(ISODateTime)
+	p.EndTime.FromTime(value)
+}
+
 func (p *ProcessTiming2) SetPeriod(value string) {
 	p.Period = (*Max9NumericText)(&value)
 }
@@ -42,6 +62,11 @@ func (p *ProcessTiming2) SetMaximumNumber(value string) {
 	p.MaximumNumber = (*Number)(&value)
 }
 
+func (p *ProcessTiming2) SetMaximumNumberFromDecimal(value decimal.Decimal) {
+	p.MaximumNumber = new(Number)
+	p.MaximumNumber.FromDecimal(value)
+}
+
 func (p *ProcessTiming2) AddReTry() *ProcessRetry2 {
 	p.ReTry = new(ProcessRetry2)
 	return p.ReTry
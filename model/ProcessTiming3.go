@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters defining the timing conditions to process an action.
 type ProcessTiming3 struct {
 
@@ -19,6 +25,10 @@ type ProcessTiming3 struct {
 	MaximumNumber *Number `xml:"MaxNb,omitempty"`
 }
 
+func (p *ProcessTiming3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessTiming3) SetWaitingTime(value string) {
 	p.WaitingTime = (*Max9NumericText)(&value)
 }
@@ -27,10 +37,20 @@ func (p *ProcessTiming3) SetStartTime(value string) {
 	p.StartTime = (*ISODateTime)(&value)
 }
 
+func (p *ProcessTiming3) SetStartTimeFromTime(value time.Time) {
+	p.StartTime = new(ISODateTime)
+	p.StartTime.FromTime(value)
+}
+
 func (p *ProcessTiming3) SetEndTime(value string) {
 	p.EndTime = (*ISODateTime)(&value)
 }
 
+func (p *ProcessTiming3) SetEndTimeFromTime(value time.Time) {
+	p.EndTime = new(ISODateTime)
+	p.EndTime.FromTime(value)
+}
+
 func (p *ProcessTiming3) SetPeriod(value string) {
 	p.Period = (*Max9NumericText)(&value)
 }
@@ -38,3 +58,8 @@ func (p *ProcessTiming3) SetPeriod(value string) {
 func (p *ProcessTiming3) SetMaximumNumber(value string) {
 	p.MaximumNumber = (*Number)(&value)
 }
+
+func (p *ProcessTiming3) SetMaximumNumberFromDecimal(value decimal.Decimal) {
+	p.MaximumNumber = new(Number)
+	p.MaximumNumber.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Parameters defining the timing conditions to process an action.
 type ProcessTiming4 struct {
 
@@ -13,14 +17,28 @@ type ProcessTiming4 struct {
 	Period *Max9NumericText `xml:"Prd,omitempty"`
 }
 
+func (p *ProcessTiming4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessTiming4) SetStartTime(value string) {
 	p.StartTime = (*ISODateTime)(&value)
 }
 
+func (p *ProcessTiming4) SetStartTimeFromTime(value time.Time) {
+	p.StartTime = new(ISODateTime)
+	p.StartTime.FromTime(value)
+}
+
 func (p *ProcessTiming4) SetEndTime(value string) {
 	p.EndTime = (*ISODateTime)(&value)
 }
 
+func (p *ProcessTiming4) SetEndTimeFromTime(value time.Time) {
+	p.EndTime = new(ISODateTime)
+	p.EndTime.FromTime(value)
+}
+
 func (p *ProcessTiming4) SetPeriod(value string) {
 	p.Period = (*Max9NumericText)(&value)
 }
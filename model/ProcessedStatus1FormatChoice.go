@@ -10,6 +10,10 @@ type ProcessedStatus1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (p *ProcessedStatus1FormatChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessedStatus1FormatChoice) SetCode(value string) {
 	p.Code = (*ProcessedStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ProcessedStatus2FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (p *ProcessedStatus2FormatChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessedStatus2FormatChoice) SetCode(value string) {
 	p.Code = (*ProcessedStatus2Code)(&value)
 }
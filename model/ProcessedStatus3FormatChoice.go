@@ -10,6 +10,10 @@ type ProcessedStatus3FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (p *ProcessedStatus3FormatChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessedStatus3FormatChoice) SetCode(value string) {
 	p.Code = (*ProcessedStatus3Code)(&value)
 }
@@ -10,6 +10,10 @@ type ProcessedStatus4FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (p *ProcessedStatus4FormatChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessedStatus4FormatChoice) SetCode(value string) {
 	p.Code = (*ProcessedStatus4Code)(&value)
 }
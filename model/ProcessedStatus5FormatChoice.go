@@ -10,6 +10,10 @@ type ProcessedStatus5FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (p *ProcessedStatus5FormatChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessedStatus5FormatChoice) SetCode(value string) {
 	p.Code = (*ProcessedStatus5Code)(&value)
 }
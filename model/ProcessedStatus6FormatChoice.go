@@ -10,6 +10,10 @@ type ProcessedStatus6FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (p *ProcessedStatus6FormatChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessedStatus6FormatChoice) SetCode(value string) {
 	p.Code = (*ProcessedStatus6Code)(&value)
 }
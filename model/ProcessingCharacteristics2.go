@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Processing characteristics linked to the instrument, ie, not to  the market.
 type ProcessingCharacteristics2 struct {
 
@@ -42,6 +46,10 @@ type ProcessingCharacteristics2 struct {
 	SettlementCycle *TimeFrame5Choice `xml:"SttlmCycl"`
 }
 
+func (p *ProcessingCharacteristics2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingCharacteristics2) AddDealingCurrencyAccepted(value string) {
 	p.DealingCurrencyAccepted = append(p.DealingCurrencyAccepted, (*ActiveCurrencyCode)(&value))
 }
@@ -60,10 +68,20 @@ func (p *ProcessingCharacteristics2) SetAmountIndicator(value string) {
 	p.AmountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *ProcessingCharacteristics2) SetAmountIndicatorFromBool(value bool) {
+	p.AmountIndicator = new(YesNoIndicator)
+	p.AmountIndicator.FromBool(value)
+}
+
 func (p *ProcessingCharacteristics2) SetUnitsIndicator(value string) {
 	p.UnitsIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *ProcessingCharacteristics2) SetUnitsIndicatorFromBool(value bool) {
+	p.UnitsIndicator = new(YesNoIndicator)
+	p.UnitsIndicator.FromBool(value)
+}
+
 func (p *ProcessingCharacteristics2) AddMainFundOrderDeskLocation() *MainFundOrderDeskLocation1 {
 	p.MainFundOrderDeskLocation = new(MainFundOrderDeskLocation1)
 	return p.MainFundOrderDeskLocation
@@ -73,6 +91,11 @@ func (p *ProcessingCharacteristics2) SetDealingCutOffTime(value string) {
 	p.DealingCutOffTime = (*ISOTime)(&value)
 }
 
+func (p *ProcessingCharacteristics2) SetDealingCutOffTimeFromTime(value time.Time) {
+	p.DealingCutOffTime = new(ISOTime)
+	p.DealingCutOffTime.FromTime(value)
+}
+
 func (p *ProcessingCharacteristics2) AddDealingCutOffTimeFrame() *TimeFrame3 {
 	p.DealingCutOffTimeFrame = new(TimeFrame3)
 	return p.DealingCutOffTimeFrame
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Processing characteristics linked to the instrument, ie, not to  the market.
 type ProcessingCharacteristics3 struct {
 
@@ -40,6 +44,10 @@ type ProcessingCharacteristics3 struct {
 	SettlementCycle *TimeFrame4Choice `xml:"SttlmCycl"`
 }
 
+func (p *ProcessingCharacteristics3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingCharacteristics3) AddDealingCurrencyAccepted(value string) {
 	p.DealingCurrencyAccepted = append(p.DealingCurrencyAccepted, (*ActiveCurrencyCode)(&value))
 }
@@ -53,10 +61,20 @@ func (p *ProcessingCharacteristics3) SetAmountIndicator(value string) {
 	p.AmountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *ProcessingCharacteristics3) SetAmountIndicatorFromBool(value bool) {
+	p.AmountIndicator = new(YesNoIndicator)
+	p.AmountIndicator.FromBool(value)
+}
+
 func (p *ProcessingCharacteristics3) SetUnitsIndicator(value string) {
 	p.UnitsIndicator = (*YesNoIndicator)(&value)
 }
 
+func (p *ProcessingCharacteristics3) SetUnitsIndicatorFromBool(value bool) {
+	p.UnitsIndicator = new(YesNoIndicator)
+	p.UnitsIndicator.FromBool(value)
+}
+
 func (p *ProcessingCharacteristics3) AddMainFundOrderDeskLocation() *MainFundOrderDeskLocation1 {
 	p.MainFundOrderDeskLocation = new(MainFundOrderDeskLocation1)
 	return p.MainFundOrderDeskLocation
@@ -66,6 +84,11 @@ func (p *ProcessingCharacteristics3) SetDealingCutOffTime(value string) {
 	p.DealingCutOffTime = (*ISOTime)(&value)
 }
 
+func (p *ProcessingCharacteristics3) SetDealingCutOffTimeFromTime(value time.Time) {
+	p.DealingCutOffTime = new(ISOTime)
+	p.DealingCutOffTime.FromTime(value)
+}
+
 func (p *ProcessingCharacteristics3) AddDealingCutOffTimeFrame() *TimeFrame3 {
 	p.DealingCutOffTimeFrame = new(TimeFrame3)
 	return p.DealingCutOffTimeFrame
@@ -10,6 +10,10 @@ type ProcessingPosition10Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *ProcessingPosition10Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingPosition10Choice) SetCode(value string) {
 	p.Code = (*ProcessingPosition3Code)(&value)
 }
@@ -10,6 +10,10 @@ type ProcessingPosition18Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *ProcessingPosition18Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingPosition18Choice) SetCode(value string) {
 	p.Code = (*ProcessingPosition4Code)(&value)
 }
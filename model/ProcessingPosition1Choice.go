@@ -10,6 +10,10 @@ type ProcessingPosition1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *ProcessingPosition1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingPosition1Choice) SetCode(value string) {
 	p.Code = (*ProcessingPosition3Code)(&value)
 }
@@ -10,6 +10,10 @@ type ProcessingPosition22Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *ProcessingPosition22Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingPosition22Choice) SetCode(value string) {
 	p.Code = (*ProcessingPosition3Code)(&value)
 }
@@ -10,6 +10,10 @@ type ProcessingPosition23Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *ProcessingPosition23Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingPosition23Choice) SetCode(value string) {
 	p.Code = (*ProcessingPosition5Code)(&value)
 }
@@ -10,6 +10,10 @@ type ProcessingPosition2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *ProcessingPosition2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingPosition2Choice) SetCode(value string) {
 	p.Code = (*ProcessingPosition1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ProcessingPosition2FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (p *ProcessingPosition2FormatChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingPosition2FormatChoice) SetCode(value string) {
 	p.Code = (*ProcessingPosition2Code)(&value)
 }
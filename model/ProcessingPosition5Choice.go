@@ -10,6 +10,10 @@ type ProcessingPosition5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *ProcessingPosition5Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingPosition5Choice) SetCode(value string) {
 	p.Code = (*ProcessingPosition4Code)(&value)
 }
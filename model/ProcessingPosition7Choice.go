@@ -10,6 +10,10 @@ type ProcessingPosition7Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *ProcessingPosition7Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingPosition7Choice) SetCode(value string) {
 	p.Code = (*ProcessingPosition3Code)(&value)
 }
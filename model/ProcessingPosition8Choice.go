@@ -10,6 +10,10 @@ type ProcessingPosition8Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *ProcessingPosition8Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingPosition8Choice) SetCode(value string) {
 	p.Code = (*ProcessingPosition4Code)(&value)
 }
@@ -10,6 +10,10 @@ type ProcessingPosition9Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *ProcessingPosition9Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingPosition9Choice) SetCode(value string) {
 	p.Code = (*ProcessingPosition5Code)(&value)
 }
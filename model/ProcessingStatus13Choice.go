@@ -25,6 +25,10 @@ type ProcessingStatus13Choice struct {
 	Cancelled *CancellationStatus3Choice `xml:"Canc"`
 }
 
+func (p *ProcessingStatus13Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus13Choice) AddPendingCancellation() *PendingStatus4Choice {
 	p.PendingCancellation = new(PendingStatus4Choice)
 	return p.PendingCancellation
@@ -22,6 +22,10 @@ type ProcessingStatus14Choice struct {
 	Pending *PendingStatus4Choice `xml:"Pdg"`
 }
 
+func (p *ProcessingStatus14Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus14Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus3Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus3Choice)
 	return p.AcknowledgedAccepted
@@ -61,6 +61,10 @@ type ProcessingStatus17Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts,omitempty"`
 }
 
+func (p *ProcessingStatus17Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus17Choice) AddAcknowledgedAccepted() *ProprietaryReason1 {
 	p.AcknowledgedAccepted = new(ProprietaryReason1)
 	return p.AcknowledgedAccepted
@@ -22,6 +22,10 @@ type ProcessingStatus18Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (p *ProcessingStatus18Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus18Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus7Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus7Choice)
 	return p.AcknowledgedAccepted
@@ -31,6 +31,10 @@ type ProcessingStatus19Choice struct {
 	ModificationRequested *ProprietaryReason1 `xml:"ModReqd"`
 }
 
+func (p *ProcessingStatus19Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus19Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus7Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus7Choice)
 	return p.AcknowledgedAccepted
@@ -31,6 +31,10 @@ type ProcessingStatus1Choice struct {
 	ModificationRequested *NoSpecifiedReason1 `xml:"ModReqd"`
 }
 
+func (p *ProcessingStatus1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus1Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus3Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus3Choice)
 	return p.AcknowledgedAccepted
@@ -10,6 +10,10 @@ type ProcessingStatus1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (p *ProcessingStatus1FormatChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus1FormatChoice) SetCode(value string) {
 	p.Code = (*ProcessingStatus1Code)(&value)
 }
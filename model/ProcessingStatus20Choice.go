@@ -25,6 +25,10 @@ type ProcessingStatus20Choice struct {
 	Cancelled *CancellationStatus9Choice `xml:"Canc"`
 }
 
+func (p *ProcessingStatus20Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus20Choice) AddPendingCancellation() *PendingStatus11Choice {
 	p.PendingCancellation = new(PendingStatus11Choice)
 	return p.PendingCancellation
@@ -31,6 +31,10 @@ type ProcessingStatus21Choice struct {
 	ModificationRequested *ProprietaryReason1 `xml:"ModReqd"`
 }
 
+func (p *ProcessingStatus21Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus21Choice) AddPendingCancellation() *PendingStatus13Choice {
 	p.PendingCancellation = new(PendingStatus13Choice)
 	return p.PendingCancellation
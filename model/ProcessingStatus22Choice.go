@@ -13,6 +13,10 @@ type ProcessingStatus22Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (p *ProcessingStatus22Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus22Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus12Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus12Choice)
 	return p.AcknowledgedAccepted
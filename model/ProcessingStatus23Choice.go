@@ -22,6 +22,10 @@ type ProcessingStatus23Choice struct {
 	CancellationRequested *ProprietaryReason1 `xml:"CxlReqd"`
 }
 
+func (p *ProcessingStatus23Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus23Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus7Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus7Choice)
 	return p.AcknowledgedAccepted
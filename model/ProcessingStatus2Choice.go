@@ -25,6 +25,10 @@ type ProcessingStatus2Choice struct {
 	Cancelled *CancellationStatus3Choice `xml:"Canc"`
 }
 
+func (p *ProcessingStatus2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus2Choice) AddPendingCancellation() *PendingStatus4Choice {
 	p.PendingCancellation = new(PendingStatus4Choice)
 	return p.PendingCancellation
@@ -31,6 +31,10 @@ type ProcessingStatus37Choice struct {
 	ModificationRequested *ProprietaryReason1 `xml:"ModReqd"`
 }
 
+func (p *ProcessingStatus37Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus37Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus7Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus7Choice)
 	return p.AcknowledgedAccepted
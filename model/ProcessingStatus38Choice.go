@@ -31,6 +31,10 @@ type ProcessingStatus38Choice struct {
 	ModificationRequested *ProprietaryReason1 `xml:"ModReqd"`
 }
 
+func (p *ProcessingStatus38Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus38Choice) AddPendingCancellation() *PendingStatus13Choice {
 	p.PendingCancellation = new(PendingStatus13Choice)
 	return p.PendingCancellation
@@ -13,6 +13,10 @@ type ProcessingStatus39Choice struct {
 	Accepted *AcceptedStatus4Choice `xml:"Accptd"`
 }
 
+func (p *ProcessingStatus39Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus39Choice) AddRejected() *RejectedStatus10Choice {
 	p.Rejected = new(RejectedStatus10Choice)
 	return p.Rejected
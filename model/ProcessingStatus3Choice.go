@@ -31,6 +31,10 @@ type ProcessingStatus3Choice struct {
 	ModificationRequested *NoSpecifiedReason1 `xml:"ModReqd"`
 }
 
+func (p *ProcessingStatus3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus3Choice) AddPendingCancellation() *PendingStatus4Choice {
 	p.PendingCancellation = new(PendingStatus4Choice)
 	return p.PendingCancellation
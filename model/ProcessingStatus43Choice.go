@@ -20,6 +20,10 @@ type ProcessingStatus43Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason5 `xml:"PrtrySts"`
 }
 
+func (p *ProcessingStatus43Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus43Choice) AddReceived() *ReceivedStatusReason1 {
 	p.Received = new(ReceivedStatusReason1)
 	return p.Received
@@ -22,6 +22,10 @@ type ProcessingStatus44Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (p *ProcessingStatus44Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus44Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus7Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus7Choice)
 	return p.AcknowledgedAccepted
@@ -25,6 +25,10 @@ type ProcessingStatus45Choice struct {
 	Cancelled *CancellationStatus9Choice `xml:"Canc"`
 }
 
+func (p *ProcessingStatus45Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus45Choice) AddPendingCancellation() *PendingStatus11Choice {
 	p.PendingCancellation = new(PendingStatus11Choice)
 	return p.PendingCancellation
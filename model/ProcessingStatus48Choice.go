@@ -31,6 +31,10 @@ type ProcessingStatus48Choice struct {
 	ModificationRequested *ProprietaryReason4 `xml:"ModReqd"`
 }
 
+func (p *ProcessingStatus48Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus48Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus21Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus21Choice)
 	return p.AcknowledgedAccepted
@@ -31,6 +31,10 @@ type ProcessingStatus49Choice struct {
 	ModificationRequested *ProprietaryReason4 `xml:"ModReqd"`
 }
 
+func (p *ProcessingStatus49Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus49Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus21Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus21Choice)
 	return p.AcknowledgedAccepted
@@ -13,6 +13,10 @@ type ProcessingStatus4Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (p *ProcessingStatus4Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus4Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus1Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus1Choice)
 	return p.AcknowledgedAccepted
@@ -22,6 +22,10 @@ type ProcessingStatus50Choice struct {
 	Proprietary *ProprietaryStatusAndReason6 `xml:"Prtry"`
 }
 
+func (p *ProcessingStatus50Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus50Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus21Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus21Choice)
 	return p.AcknowledgedAccepted
@@ -31,6 +31,10 @@ type ProcessingStatus51Choice struct {
 	ModificationRequested *ProprietaryReason4 `xml:"ModReqd"`
 }
 
+func (p *ProcessingStatus51Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus51Choice) AddPendingCancellation() *PendingStatus38Choice {
 	p.PendingCancellation = new(PendingStatus38Choice)
 	return p.PendingCancellation
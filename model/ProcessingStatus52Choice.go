@@ -22,6 +22,10 @@ type ProcessingStatus52Choice struct {
 	CancellationRequested *ProprietaryReason4 `xml:"CxlReqd"`
 }
 
+func (p *ProcessingStatus52Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus52Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus21Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus21Choice)
 	return p.AcknowledgedAccepted
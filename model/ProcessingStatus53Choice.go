@@ -25,6 +25,10 @@ type ProcessingStatus53Choice struct {
 	Cancelled *CancellationStatus15Choice `xml:"Canc"`
 }
 
+func (p *ProcessingStatus53Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus53Choice) AddPendingCancellation() *PendingStatus39Choice {
 	p.PendingCancellation = new(PendingStatus39Choice)
 	return p.PendingCancellation
@@ -25,6 +25,10 @@ type ProcessingStatus54Choice struct {
 	Cancelled *CancellationStatus15Choice `xml:"Canc"`
 }
 
+func (p *ProcessingStatus54Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus54Choice) AddPendingCancellation() *PendingStatus39Choice {
 	p.PendingCancellation = new(PendingStatus39Choice)
 	return p.PendingCancellation
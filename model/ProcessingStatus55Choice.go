@@ -13,6 +13,10 @@ type ProcessingStatus55Choice struct {
 	Proprietary *ProprietaryStatusAndReason6 `xml:"Prtry"`
 }
 
+func (p *ProcessingStatus55Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus55Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus24Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus24Choice)
 	return p.AcknowledgedAccepted
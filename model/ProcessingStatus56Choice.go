@@ -13,6 +13,10 @@ type ProcessingStatus56Choice struct {
 	Accepted *AcceptedStatus7Choice `xml:"Accptd"`
 }
 
+func (p *ProcessingStatus56Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus56Choice) AddRejected() *RejectedStatus17Choice {
 	p.Rejected = new(RejectedStatus17Choice)
 	return p.Rejected
@@ -31,6 +31,10 @@ type ProcessingStatus57Choice struct {
 	ModificationRequested *ProprietaryReason5 `xml:"ModReqd"`
 }
 
+func (p *ProcessingStatus57Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus57Choice) AddPendingCancellation() *PendingStatus46Choice {
 	p.PendingCancellation = new(PendingStatus46Choice)
 	return p.PendingCancellation
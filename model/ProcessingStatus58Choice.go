@@ -22,6 +22,10 @@ type ProcessingStatus58Choice struct {
 	Proprietary *ProprietaryStatusAndReason7 `xml:"Prtry"`
 }
 
+func (p *ProcessingStatus58Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus58Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus25Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus25Choice)
 	return p.AcknowledgedAccepted
@@ -13,6 +13,10 @@ type ProcessingStatus59Choice struct {
 	Accepted *AcceptedStatus10Choice `xml:"Accptd"`
 }
 
+func (p *ProcessingStatus59Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus59Choice) AddRejected() *RejectedStatus22Choice {
 	p.Rejected = new(RejectedStatus22Choice)
 	return p.Rejected
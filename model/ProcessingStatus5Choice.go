@@ -22,6 +22,10 @@ type ProcessingStatus5Choice struct {
 	Pending *PendingStatus4Choice `xml:"Pdg"`
 }
 
+func (p *ProcessingStatus5Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus5Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus3Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus3Choice)
 	return p.AcknowledgedAccepted
@@ -31,6 +31,10 @@ type ProcessingStatus60Choice struct {
 	ModificationRequested *ProprietaryReason5 `xml:"ModReqd"`
 }
 
+func (p *ProcessingStatus60Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus60Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus25Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus25Choice)
 	return p.AcknowledgedAccepted
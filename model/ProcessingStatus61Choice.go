@@ -25,6 +25,10 @@ type ProcessingStatus61Choice struct {
 	Cancelled *CancellationStatus20Choice `xml:"Canc"`
 }
 
+func (p *ProcessingStatus61Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus61Choice) AddPendingCancellation() *PendingStatus51Choice {
 	p.PendingCancellation = new(PendingStatus51Choice)
 	return p.PendingCancellation
@@ -22,6 +22,10 @@ type ProcessingStatus62Choice struct {
 	CancellationRequested *ProprietaryReason5 `xml:"CxlReqd"`
 }
 
+func (p *ProcessingStatus62Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus62Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus25Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus25Choice)
 	return p.AcknowledgedAccepted
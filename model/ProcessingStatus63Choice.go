@@ -31,6 +31,10 @@ type ProcessingStatus63Choice struct {
 	ModificationRequested *ProprietaryReason5 `xml:"ModReqd"`
 }
 
+func (p *ProcessingStatus63Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus63Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus25Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus25Choice)
 	return p.AcknowledgedAccepted
@@ -13,6 +13,10 @@ type ProcessingStatus64Choice struct {
 	Proprietary *ProprietaryStatusAndReason7 `xml:"Prtry"`
 }
 
+func (p *ProcessingStatus64Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus64Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus31Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus31Choice)
 	return p.AcknowledgedAccepted
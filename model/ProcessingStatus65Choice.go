@@ -25,6 +25,10 @@ type ProcessingStatus65Choice struct {
 	Cancelled *CancellationStatus20Choice `xml:"Canc"`
 }
 
+func (p *ProcessingStatus65Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus65Choice) AddPendingCancellation() *PendingStatus51Choice {
 	p.PendingCancellation = new(PendingStatus51Choice)
 	return p.PendingCancellation
@@ -22,6 +22,10 @@ type ProcessingStatus6Choice struct {
 	CancellationRequested *NoSpecifiedReason1 `xml:"CxlReqd"`
 }
 
+func (p *ProcessingStatus6Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProcessingStatus6Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus3Choice {
 	p.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus3Choice)
 	return p.AcknowledgedAccepted
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Product purchased to be paid.
 type Product1 struct {
 
@@ -25,6 +29,10 @@ type Product1 struct {
 	AdditionalProductInformation *Max35Text `xml:"AddtlPdctInf,omitempty"`
 }
 
+func (p *Product1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Product1) SetProductCode(value string) {
 	p.ProductCode = (*Max70Text)(&value)
 }
@@ -37,14 +45,27 @@ func (p *Product1) SetProductQuantity(value string) {
 	p.ProductQuantity = (*DecimalNumber)(&value)
 }
 
+func (p *Product1) SetProductQuantityFromDecimal(value decimal.Decimal) {
+	p.ProductQuantity = new(DecimalNumber)
+	p.ProductQuantity.FromDecimal(value)
+}
+
 func (p *Product1) SetUnitPrice(value, currency string) {
 	p.UnitPrice = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (p *Product1) SetUnitPriceFromDecimal(value decimal.Decimal, currency string) {
+	p.UnitPrice = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *Product1) SetProductAmount(value, currency string) {
 	p.ProductAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (p *Product1) SetProductAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ProductAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *Product1) SetTaxType(value string) {
 	p.TaxType = (*Max35Text)(&value)
 }
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Product purchased to be paid.
 type Product2 struct {
 
@@ -25,6 +30,10 @@ type Product2 struct {
 	AdditionalProductInformation *Max35Text `xml:"AddtlPdctInf,omitempty"`
 }
 
+func (p *Product2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Product2) SetProductCode(value string) {
 	p.ProductCode = (*Max70Text)(&value)
 }
@@ -37,14 +46,27 @@ func (p *Product2) SetProductQuantity(value string) {
 	p.ProductQuantity = (*DecimalNumber)(&value)
 }
 
+func (p *Product2) SetProductQuantityFromDecimal(value decimal.Decimal) {
+	p.ProductQuantity = new(DecimalNumber)
+	p.ProductQuantity.FromDecimal(value)
+}
+
 func (p *Product2) SetUnitPrice(value, currency string) {
 	p.UnitPrice = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (p *Product2) SetUnitPriceFromDecimal(value decimal.Decimal, currency string) {
+	p.UnitPrice = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *Product2) SetProductAmount(value, currency string) {
 	p.ProductAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (p *Product2) SetProductAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ProductAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *Product2) SetTaxType(value string) {
 	p.TaxType = (*Max35Text)(&value)
 }
@@ -52,3 +74,89 @@ func (p *Product2) SetTaxType(value string) {
 func (p *Product2) SetAdditionalProductInformation(value string) {
 	p.AdditionalProductInformation = (*Max35Text)(&value)
 }
+
+func (p *Product2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.ProductCode != nil {
+		enc.Element("PdctCd", string(*p.ProductCode))
+	}
+	if p.UnitOfMeasure != nil {
+		enc.Element("UnitOfMeasr", string(*p.UnitOfMeasure))
+	}
+	if p.ProductQuantity != nil {
+		enc.Element("PdctQty", string(*p.ProductQuantity))
+	}
+	if p.UnitPrice != nil {
+		p.UnitPrice.EncodeXMLFast(enc, "UnitPric")
+	}
+	if p.ProductAmount != nil {
+		p.ProductAmount.EncodeXMLFast(enc, "PdctAmt")
+	}
+	if p.TaxType != nil {
+		enc.Element("TaxTp", string(*p.TaxType))
+	}
+	if p.AdditionalProductInformation != nil {
+		enc.Element("AddtlPdctInf", string(*p.AdditionalProductInformation))
+	}
+	enc.End(tag)
+}
+
+func (p *Product2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "PdctCd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ProductCode = (*Max70Text)(&value)
+		case "UnitOfMeasr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.UnitOfMeasure = (*UnitOfMeasure1Code)(&value)
+		case "PdctQty":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.ProductQuantity = (*DecimalNumber)(&value)
+		case "UnitPric":
+			elem := new(ImpliedCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.UnitPrice = elem
+		case "PdctAmt":
+			elem := new(ImpliedCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.ProductAmount = elem
+		case "TaxTp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.TaxType = (*Max35Text)(&value)
+		case "AddtlPdctInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.AdditionalProductInformation = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
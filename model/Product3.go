@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Purchased item.
 type Product3 struct {
 
@@ -46,6 +50,10 @@ type Product3 struct {
 	DeliveryService *AttendanceContext2Code `xml:"DlvrySvc,omitempty"`
 }
 
+func (p *Product3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Product3) SetItemIdentification(value string) {
 	p.ItemIdentification = (*Max35Text)(&value)
 }
@@ -66,26 +74,53 @@ func (p *Product3) SetProductQuantity(value string) {
 	p.ProductQuantity = (*DecimalNumber)(&value)
 }
 
+func (p *Product3) SetProductQuantityFromDecimal(value decimal.Decimal) {
+	p.ProductQuantity = new(DecimalNumber)
+	p.ProductQuantity.FromDecimal(value)
+}
+
 func (p *Product3) SetUnitPrice(value, currency string) {
 	p.UnitPrice = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (p *Product3) SetUnitPriceFromDecimal(value decimal.Decimal, currency string) {
+	p.UnitPrice = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *Product3) SetUnitPriceSign(value string) {
 	p.UnitPriceSign = (*PlusOrMinusIndicator)(&value)
 }
 
+func (p *Product3) SetUnitPriceSignFromBool(value bool) {
+	p.UnitPriceSign = new(PlusOrMinusIndicator)
+	p.UnitPriceSign.FromBool(value)
+}
+
 func (p *Product3) SetProductAmount(value, currency string) {
 	p.ProductAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (p *Product3) SetProductAmountFromDecimal(value decimal.Decimal, currency string) {
+	p.ProductAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *Product3) SetProductAmountSign(value string) {
 	p.ProductAmountSign = (*PlusOrMinusIndicator)(&value)
 }
 
+func (p *Product3) SetProductAmountSignFromBool(value bool) {
+	p.ProductAmountSign = new(PlusOrMinusIndicator)
+	p.ProductAmountSign.FromBool(value)
+}
+
 func (p *Product3) SetValueAddedTax(value, currency string) {
 	p.ValueAddedTax = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (p *Product3) SetValueAddedTaxFromDecimal(value decimal.Decimal, currency string) {
+	p.ValueAddedTax = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *Product3) SetTaxType(value string) {
 	p.TaxType = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type Product4 struct {
 	AdditionalProductCode *Max70Text `xml:"AddtlPdctCd,omitempty"`
 }
 
+func (p *Product4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Product4) SetProductCode(value string) {
 	p.ProductCode = (*Max70Text)(&value)
 }
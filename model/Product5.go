@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Product to purchase.
 type Product5 struct {
 
@@ -19,6 +23,10 @@ type Product5 struct {
 	UnitOfMeasure *UnitOfMeasure6Code `xml:"UnitOfMeasr,omitempty"`
 }
 
+func (p *Product5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Product5) SetProductCode(value string) {
 	p.ProductCode = (*Max70Text)(&value)
 }
@@ -31,10 +39,19 @@ func (p *Product5) SetAmountLimit(value, currency string) {
 	p.AmountLimit = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (p *Product5) SetAmountLimitFromDecimal(value decimal.Decimal, currency string) {
+	p.AmountLimit = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *Product5) SetQuantityLimit(value string) {
 	p.QuantityLimit = (*DecimalNumber)(&value)
 }
 
+func (p *Product5) SetQuantityLimitFromDecimal(value decimal.Decimal) {
+	p.QuantityLimit = new(DecimalNumber)
+	p.QuantityLimit.FromDecimal(value)
+}
+
 func (p *Product5) SetUnitOfMeasure(value string) {
 	p.UnitOfMeasure = (*UnitOfMeasure6Code)(&value)
 }
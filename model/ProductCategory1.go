@@ -10,6 +10,10 @@ type ProductCategory1 struct {
 	Category *Max35Text `xml:"Ctgy"`
 }
 
+func (p *ProductCategory1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProductCategory1) SetType(value string) {
 	p.Type = (*ProductCategory1Code)(&value)
 }
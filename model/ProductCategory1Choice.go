@@ -10,6 +10,10 @@ type ProductCategory1Choice struct {
 	OtherProductCategory *GenericIdentification4 `xml:"OthrPdctCtgy"`
 }
 
+func (p *ProductCategory1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProductCategory1Choice) AddStructuredProductCategory() *ProductCategory1 {
 	p.StructuredProductCategory = new(ProductCategory1)
 	return p.StructuredProductCategory
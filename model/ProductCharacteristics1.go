@@ -10,6 +10,10 @@ type ProductCharacteristics1 struct {
 	Characteristics *Max35Text `xml:"Chrtcs"`
 }
 
+func (p *ProductCharacteristics1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProductCharacteristics1) SetType(value string) {
 	p.Type = (*ProductCharacteristics1Code)(&value)
 }
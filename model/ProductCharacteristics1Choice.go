@@ -10,6 +10,10 @@ type ProductCharacteristics1Choice struct {
 	OtherProductCharacteristics *GenericIdentification4 `xml:"OthrPdctChrtcs"`
 }
 
+func (p *ProductCharacteristics1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProductCharacteristics1Choice) AddStructuredProductCharacteristics() *ProductCharacteristics1 {
 	p.StructuredProductCharacteristics = new(ProductCharacteristics1)
 	return p.StructuredProductCharacteristics
@@ -10,6 +10,10 @@ type ProductCharacteristics2 struct {
 	ValueMeasure *Quantity3 `xml:"ValMeasr,omitempty"`
 }
 
+func (p *ProductCharacteristics2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProductCharacteristics2) AddCharacteristic() *ProductCharacteristics1Choice {
 	p.Characteristic = new(ProductCharacteristics1Choice)
 	return p.Characteristic
@@ -10,6 +10,10 @@ type ProductCharacteristics3 struct {
 	ValueMeasure *Quantity10 `xml:"ValMeasr,omitempty"`
 }
 
+func (p *ProductCharacteristics3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProductCharacteristics3) AddCharacteristic() *ProductCharacteristics1Choice {
 	p.Characteristic = new(ProductCharacteristics1Choice)
 	return p.Characteristic
@@ -10,6 +10,10 @@ type ProductIdentifier2 struct {
 	Identifier *Max35Text `xml:"Idr"`
 }
 
+func (p *ProductIdentifier2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProductIdentifier2) SetType(value string) {
 	p.Type = (*ProductIdentifier2Code)(&value)
 }
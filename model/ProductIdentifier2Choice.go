@@ -10,6 +10,10 @@ type ProductIdentifier2Choice struct {
 	OtherProductIdentifier *GenericIdentification4 `xml:"OthrPdctIdr"`
 }
 
+func (p *ProductIdentifier2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProductIdentifier2Choice) AddStructuredProductIdentifier() *ProductIdentifier2 {
 	p.StructuredProductIdentifier = new(ProductIdentifier2)
 	return p.StructuredProductIdentifier
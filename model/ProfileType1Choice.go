@@ -10,6 +10,10 @@ type ProfileType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *ProfileType1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProfileType1Choice) SetCode(value string) {
 	p.Code = (*ProfileType1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between profit and loss.
 type ProfitAndLoss1Choice struct {
 
@@ -10,10 +14,22 @@ type ProfitAndLoss1Choice struct {
 	Loss *ActiveCurrencyAnd13DecimalAmount `xml:"Loss"`
 }
 
+func (p *ProfitAndLoss1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProfitAndLoss1Choice) SetProfit(value, currency string) {
 	p.Profit = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (p *ProfitAndLoss1Choice) SetProfitFromDecimal(value decimal.Decimal, currency string) {
+	p.Profit = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (p *ProfitAndLoss1Choice) SetLoss(value, currency string) {
 	p.Loss = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (p *ProfitAndLoss1Choice) SetLossFromDecimal(value decimal.Decimal, currency string) {
+	p.Loss = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between profit and loss.
 type ProfitAndLoss2Choice struct {
 
@@ -10,10 +14,22 @@ type ProfitAndLoss2Choice struct {
 	Loss *ActiveCurrencyAndAmount `xml:"Loss"`
 }
 
+func (p *ProfitAndLoss2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProfitAndLoss2Choice) SetProfit(value, currency string) {
 	p.Profit = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (p *ProfitAndLoss2Choice) SetProfitFromDecimal(value decimal.Decimal, currency string) {
+	p.Profit = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (p *ProfitAndLoss2Choice) SetLoss(value, currency string) {
 	p.Loss = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (p *ProfitAndLoss2Choice) SetLossFromDecimal(value decimal.Decimal, currency string) {
+	p.Loss = NewActiveCurrencyAndAmount(value.String(), currency)
+}
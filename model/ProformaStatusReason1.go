@@ -10,6 +10,10 @@ type ProformaStatusReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (p *ProformaStatusReason1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProformaStatusReason1) AddCode() *ProformaStatusReason2Choice {
 	p.Code = new(ProformaStatusReason2Choice)
 	return p.Code
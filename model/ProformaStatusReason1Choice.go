@@ -10,8 +10,16 @@ type ProformaStatusReason1Choice struct {
 	Reason []*ProformaStatusReason1 `xml:"Rsn"`
 }
 
-func (p *ProformaStatusReason1Choice) SetNoSpecifiedReason(value string) {
+func (p *ProformaStatusReason1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *ProformaStatusReason1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *ProformaStatusReason1Choice) AddReason() *ProformaStatusReason1 {
@@ -10,6 +10,10 @@ type ProformaStatusReason2Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (p *ProformaStatusReason2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProformaStatusReason2Choice) SetCode(value string) {
 	p.Code = (*ProformaStatusReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Proposal3 struct {
 	CollateralProposal *CollateralProposal3Choice `xml:"CollPrpsl"`
 }
 
+func (p *Proposal3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Proposal3) SetCollateralProposalType(value string) {
 	p.CollateralProposalType = (*ProposalType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Proposal4 struct {
 	CollateralProposal *CollateralProposal4Choice `xml:"CollPrpsl"`
 }
 
+func (p *Proposal4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Proposal4) SetCollateralProposalType(value string) {
 	p.CollateralProposalType = (*ProposalType1Code)(&value)
 }
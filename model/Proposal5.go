@@ -10,6 +10,10 @@ type Proposal5 struct {
 	CollateralProposal *CollateralProposal5Choice `xml:"CollPrpsl"`
 }
 
+func (p *Proposal5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Proposal5) SetCollateralProposalType(value string) {
 	p.CollateralProposalType = (*ProposalType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ProprietaryAgent1 struct {
 	Agent *BranchAndFinancialInstitutionIdentification3 `xml:"Agt"`
 }
 
+func (p *ProprietaryAgent1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryAgent1) SetType(value string) {
 	p.Type = (*Max35Text)(&value)
 }
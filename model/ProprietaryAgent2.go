@@ -10,6 +10,10 @@ type ProprietaryAgent2 struct {
 	Agent *BranchAndFinancialInstitutionIdentification4 `xml:"Agt"`
 }
 
+func (p *ProprietaryAgent2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryAgent2) SetType(value string) {
 	p.Type = (*Max35Text)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Identifies a proprietary party.
 type ProprietaryAgent3 struct {
 
@@ -10,6 +12,10 @@ type ProprietaryAgent3 struct {
 	Agent *BranchAndFinancialInstitutionIdentification5 `xml:"Agt"`
 }
 
+func (p *ProprietaryAgent3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryAgent3) SetType(value string) {
 	p.Type = (*Max35Text)(&value)
 }
@@ -18,3 +24,44 @@ func (p *ProprietaryAgent3) AddAgent() *BranchAndFinancialInstitutionIdentificat
 	p.Agent = new(BranchAndFinancialInstitutionIdentification5)
 	return p.Agent
 }
+
+func (p *ProprietaryAgent3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Type != nil {
+		enc.Element("Tp", string(*p.Type))
+	}
+	if p.Agent != nil {
+		p.Agent.EncodeXMLFast(enc, "Agt")
+	}
+	enc.End(tag)
+}
+
+func (p *ProprietaryAgent3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Type = (*Max35Text)(&value)
+		case "Agt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Agent = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
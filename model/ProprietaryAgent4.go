@@ -0,0 +1,67 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Identifies a proprietary party.
+type ProprietaryAgent4 struct {
+
+	// Specifies the type of proprietary agent.
+	Type *Max35Text `xml:"Tp"`
+
+	// Organisation established primarily to provide financial services.
+	Agent *BranchAndFinancialInstitutionIdentification6 `xml:"Agt"`
+}
+
+func (p *ProprietaryAgent4) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *ProprietaryAgent4) SetType(value string) {
+	p.Type = (*Max35Text)(&value)
+}
+
+func (p *ProprietaryAgent4) AddAgent() *BranchAndFinancialInstitutionIdentification6 {
+	p.Agent = new(BranchAndFinancialInstitutionIdentification6)
+	return p.Agent
+}
+
+func (p *ProprietaryAgent4) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Type != nil {
+		enc.Element("Tp", string(*p.Type))
+	}
+	if p.Agent != nil {
+		p.Agent.EncodeXMLFast(enc, "Agt")
+	}
+	enc.End(tag)
+}
+
+func (p *ProprietaryAgent4) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Type = (*Max35Text)(&value)
+		case "Agt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Agent = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements to fully identify a proprietary bank transaction code.
 type ProprietaryBankTransactionCodeStructure1 struct {
 
@@ -10,6 +12,10 @@ type ProprietaryBankTransactionCodeStructure1 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (p *ProprietaryBankTransactionCodeStructure1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryBankTransactionCodeStructure1) SetCode(value string) {
 	p.Code = (*Max35Text)(&value)
 }
@@ -17,3 +23,44 @@ func (p *ProprietaryBankTransactionCodeStructure1) SetCode(value string) {
 func (p *ProprietaryBankTransactionCodeStructure1) SetIssuer(value string) {
 	p.Issuer = (*Max35Text)(&value)
 }
+
+func (p *ProprietaryBankTransactionCodeStructure1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Code != nil {
+		enc.Element("Cd", string(*p.Code))
+	}
+	if p.Issuer != nil {
+		enc.Element("Issr", string(*p.Issuer))
+	}
+	enc.End(tag)
+}
+
+func (p *ProprietaryBankTransactionCodeStructure1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Code = (*Max35Text)(&value)
+		case "Issr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Issuer = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
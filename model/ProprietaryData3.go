@@ -7,6 +7,10 @@ type ProprietaryData3 struct {
 	Any *SkipProcessing `xml:"Any"`
 }
 
+func (p *ProprietaryData3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryData3) AddAny() *SkipProcessing {
 	p.Any = new(SkipProcessing)
 	return p.Any
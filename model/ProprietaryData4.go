@@ -10,6 +10,10 @@ type ProprietaryData4 struct {
 	Data *ProprietaryData3 `xml:"Data"`
 }
 
+func (p *ProprietaryData4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryData4) SetType(value string) {
 	p.Type = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Proprietary date information.
 type ProprietaryDate1 struct {
 
@@ -13,6 +17,10 @@ type ProprietaryDate1 struct {
 	DateTime *ISODateTime `xml:"DtTm"`
 }
 
+func (p *ProprietaryDate1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryDate1) SetType(value string) {
 	p.Type = (*Max35Text)(&value)
 }
@@ -21,6 +29,16 @@ func (p *ProprietaryDate1) SetDate(value string) {
 	p.Date = (*ISODate)(&value)
 }
 
+func (p *ProprietaryDate1) SetDateFromTime(value time.Time) {
+	p.Date = new(ISODate)
+	p.Date.FromTime(value)
+}
+
 func (p *ProprietaryDate1) SetDateTime(value string) {
 	p.DateTime = (*ISODateTime)(&value)
 }
+
+func (p *ProprietaryDate1) SetDateTimeFromTime(value time.Time) {
+	p.DateTime = new(ISODateTime)
+	p.DateTime.FromTime(value)
+}
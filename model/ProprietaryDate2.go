@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to identify a proprietary date.
 type ProprietaryDate2 struct {
 
@@ -10,6 +12,10 @@ type ProprietaryDate2 struct {
 	Date *DateAndDateTimeChoice `xml:"Dt"`
 }
 
+func (p *ProprietaryDate2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryDate2) SetType(value string) {
 	p.Type = (*Max35Text)(&value)
 }
@@ -18,3 +24,44 @@ func (p *ProprietaryDate2) AddDate() *DateAndDateTimeChoice {
 	p.Date = new(DateAndDateTimeChoice)
 	return p.Date
 }
+
+func (p *ProprietaryDate2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Type != nil {
+		enc.Element("Tp", string(*p.Type))
+	}
+	if p.Date != nil {
+		p.Date.EncodeXMLFast(enc, "Dt")
+	}
+	enc.End(tag)
+}
+
+func (p *ProprietaryDate2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Type = (*Max35Text)(&value)
+		case "Dt":
+			elem := new(DateAndDateTimeChoice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Date = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Set of elements used to identify a proprietary date.
+type ProprietaryDate3 struct {
+
+	// Specifies the type of date.
+	Type *Max35Text `xml:"Tp"`
+
+	// Date in ISO format.
+	Date *DateAndDateTime2Choice `xml:"Dt"`
+}
+
+func (p *ProprietaryDate3) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *ProprietaryDate3) SetType(value string) {
+	p.Type = (*Max35Text)(&value)
+}
+
+func (p *ProprietaryDate3) AddDate() *DateAndDateTime2Choice {
+	p.Date = new(DateAndDateTime2Choice)
+	return p.Date
+}
+
+func (p *ProprietaryDate3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Type != nil {
+		enc.Element("Tp", string(*p.Type))
+	}
+	if p.Date != nil {
+		p.Date.EncodeXMLFast(enc, "Dt")
+	}
+	enc.End(tag)
+}
+
+func (p *ProprietaryDate3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Type = (*Max35Text)(&value)
+		case "Dt":
+			elem := new(DateAndDateTime2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Date = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ type ProprietaryParty1 struct {
 	Party *PartyIdentification8 `xml:"Pty"`
 }
 
+func (p *ProprietaryParty1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryParty1) SetType(value string) {
 	p.Type = (*Max35Text)(&value)
 }
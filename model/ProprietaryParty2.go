@@ -10,6 +10,10 @@ type ProprietaryParty2 struct {
 	Party *PartyIdentification32 `xml:"Pty"`
 }
 
+func (p *ProprietaryParty2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryParty2) SetType(value string) {
 	p.Type = (*Max35Text)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Identifies a proprietary party.
 type ProprietaryParty3 struct {
 
@@ -10,6 +12,10 @@ type ProprietaryParty3 struct {
 	Party *PartyIdentification43 `xml:"Pty"`
 }
 
+func (p *ProprietaryParty3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryParty3) SetType(value string) {
 	p.Type = (*Max35Text)(&value)
 }
@@ -18,3 +24,44 @@ func (p *ProprietaryParty3) AddParty() *PartyIdentification43 {
 	p.Party = new(PartyIdentification43)
 	return p.Party
 }
+
+func (p *ProprietaryParty3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Type != nil {
+		enc.Element("Tp", string(*p.Type))
+	}
+	if p.Party != nil {
+		p.Party.EncodeXMLFast(enc, "Pty")
+	}
+	enc.End(tag)
+}
+
+func (p *ProprietaryParty3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Type = (*Max35Text)(&value)
+		case "Pty":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Party = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
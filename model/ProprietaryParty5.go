@@ -0,0 +1,67 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Identifies a proprietary party.
+type ProprietaryParty5 struct {
+
+	// Specifies the type of proprietary party.
+	Type *Max35Text `xml:"Tp"`
+
+	// Proprietary party.
+	Party *Party40Choice `xml:"Pty"`
+}
+
+func (p *ProprietaryParty5) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *ProprietaryParty5) SetType(value string) {
+	p.Type = (*Max35Text)(&value)
+}
+
+func (p *ProprietaryParty5) AddParty() *Party40Choice {
+	p.Party = new(Party40Choice)
+	return p.Party
+}
+
+func (p *ProprietaryParty5) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Type != nil {
+		enc.Element("Tp", string(*p.Type))
+	}
+	if p.Party != nil {
+		p.Party.EncodeXMLFast(enc, "Pty")
+	}
+	enc.End(tag)
+}
+
+func (p *ProprietaryParty5) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Type = (*Max35Text)(&value)
+		case "Pty":
+			elem := new(Party40Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Party = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
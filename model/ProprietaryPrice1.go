@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements to identify a proprietary price.
 type ProprietaryPrice1 struct {
 
@@ -10,6 +14,10 @@ type ProprietaryPrice1 struct {
 	Price *CurrencyAndAmount `xml:"Pric"`
 }
 
+func (p *ProprietaryPrice1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryPrice1) SetType(value string) {
 	p.Type = (*Max35Text)(&value)
 }
@@ -17,3 +25,7 @@ func (p *ProprietaryPrice1) SetType(value string) {
 func (p *ProprietaryPrice1) SetPrice(value, currency string) {
 	p.Price = NewCurrencyAndAmount(value, currency)
 }
+
+func (p *ProprietaryPrice1) SetPriceFromDecimal(value decimal.Decimal, currency string) {
+	p.Price = NewCurrencyAndAmount(value.String(), currency)
+}
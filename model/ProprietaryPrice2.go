@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of elements used to identify a proprietary price.
 type ProprietaryPrice2 struct {
 
@@ -10,6 +15,10 @@ type ProprietaryPrice2 struct {
 	Price *ActiveOrHistoricCurrencyAndAmount `xml:"Pric"`
 }
 
+func (p *ProprietaryPrice2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryPrice2) SetType(value string) {
 	p.Type = (*Max35Text)(&value)
 }
@@ -17,3 +26,48 @@ func (p *ProprietaryPrice2) SetType(value string) {
 func (p *ProprietaryPrice2) SetPrice(value, currency string) {
 	p.Price = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (p *ProprietaryPrice2) SetPriceFromDecimal(value decimal.Decimal, currency string) {
+	p.Price = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (p *ProprietaryPrice2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Type != nil {
+		enc.Element("Tp", string(*p.Type))
+	}
+	if p.Price != nil {
+		p.Price.EncodeXMLFast(enc, "Pric")
+	}
+	enc.End(tag)
+}
+
+func (p *ProprietaryPrice2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Type = (*Max35Text)(&value)
+		case "Pric":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Price = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
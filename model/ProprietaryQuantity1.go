@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements to identify a proprietary quantity.
 type ProprietaryQuantity1 struct {
 
@@ -10,6 +12,10 @@ type ProprietaryQuantity1 struct {
 	Quantity *Max35Text `xml:"Qty"`
 }
 
+func (p *ProprietaryQuantity1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryQuantity1) SetType(value string) {
 	p.Type = (*Max35Text)(&value)
 }
@@ -17,3 +23,44 @@ func (p *ProprietaryQuantity1) SetType(value string) {
 func (p *ProprietaryQuantity1) SetQuantity(value string) {
 	p.Quantity = (*Max35Text)(&value)
 }
+
+func (p *ProprietaryQuantity1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Type != nil {
+		enc.Element("Tp", string(*p.Type))
+	}
+	if p.Quantity != nil {
+		enc.Element("Qty", string(*p.Quantity))
+	}
+	enc.End(tag)
+}
+
+func (p *ProprietaryQuantity1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Type = (*Max35Text)(&value)
+		case "Qty":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Quantity = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
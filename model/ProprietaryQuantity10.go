@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Proprietary quantity format.
 type ProprietaryQuantity10 struct {
 
@@ -19,6 +23,10 @@ type ProprietaryQuantity10 struct {
 	SchemeName *Max4AlphaNumericText `xml:"SchmeNm,omitempty"`
 }
 
+func (p *ProprietaryQuantity10) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryQuantity10) SetShortLongPosition(value string) {
 	p.ShortLongPosition = (*ShortLong1Code)(&value)
 }
@@ -27,6 +35,11 @@ func (p *ProprietaryQuantity10) SetQuantity(value string) {
 	p.Quantity = (*RestrictedFINDecimalNumber)(&value)
 }
 
+func (p *ProprietaryQuantity10) SetQuantityFromDecimal(value decimal.Decimal) {
+	p.Quantity = new(RestrictedFINDecimalNumber)
+	p.Quantity.FromDecimal(value)
+}
+
 func (p *ProprietaryQuantity10) SetQuantityType(value string) {
 	p.QuantityType = (*Exact4AlphaNumericText)(&value)
 }
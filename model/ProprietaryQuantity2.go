@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Proprietary quantity format.
 type ProprietaryQuantity2 struct {
 
@@ -16,10 +20,19 @@ type ProprietaryQuantity2 struct {
 	SchemeName *Max35Text `xml:"SchmeNm,omitempty"`
 }
 
+func (p *ProprietaryQuantity2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryQuantity2) SetQuantity(value string) {
 	p.Quantity = (*DecimalNumber)(&value)
 }
 
+func (p *ProprietaryQuantity2) SetQuantityFromDecimal(value decimal.Decimal) {
+	p.Quantity = new(DecimalNumber)
+	p.Quantity.FromDecimal(value)
+}
+
 func (p *ProprietaryQuantity2) SetQuantityType(value string) {
 	p.QuantityType = (*Exact4AlphaNumericText)(&value)
 }
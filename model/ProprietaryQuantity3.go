@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Proprietary quantity format.
 type ProprietaryQuantity3 struct {
 
@@ -19,6 +23,10 @@ type ProprietaryQuantity3 struct {
 	SchemeName *Max35Text `xml:"SchmeNm,omitempty"`
 }
 
+func (p *ProprietaryQuantity3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryQuantity3) SetShortLongPosition(value string) {
 	p.ShortLongPosition = (*ShortLong1Code)(&value)
 }
@@ -27,6 +35,11 @@ func (p *ProprietaryQuantity3) SetQuantity(value string) {
 	p.Quantity = (*DecimalNumber)(&value)
 }
 
+func (p *ProprietaryQuantity3) SetQuantityFromDecimal(value decimal.Decimal) {
+	p.Quantity = new(DecimalNumber)
+	p.Quantity.FromDecimal(value)
+}
+
 func (p *ProprietaryQuantity3) SetQuantityType(value string) {
 	p.QuantityType = (*Exact4AlphaNumericText)(&value)
 }
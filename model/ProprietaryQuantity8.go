@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Proprietary quantity format.
 type ProprietaryQuantity8 struct {
 
@@ -16,10 +20,19 @@ type ProprietaryQuantity8 struct {
 	SchemeName *Max35Text `xml:"SchmeNm,omitempty"`
 }
 
+func (p *ProprietaryQuantity8) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryQuantity8) SetQuantity(value string) {
 	p.Quantity = (*DecimalNumber)(&value)
 }
 
+func (p *ProprietaryQuantity8) SetQuantityFromDecimal(value decimal.Decimal) {
+	p.Quantity = new(DecimalNumber)
+	p.Quantity.FromDecimal(value)
+}
+
 func (p *ProprietaryQuantity8) SetQuantityType(value string) {
 	p.QuantityType = (*Exact4AlphaNumericText)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Proprietary quantity format.
 type ProprietaryQuantity9 struct {
 
@@ -16,10 +20,19 @@ type ProprietaryQuantity9 struct {
 	SchemeName *Max4AlphaNumericText `xml:"SchmeNm,omitempty"`
 }
 
+func (p *ProprietaryQuantity9) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryQuantity9) SetQuantity(value string) {
 	p.Quantity = (*RestrictedFINDecimalNumber)(&value)
 }
 
+func (p *ProprietaryQuantity9) SetQuantityFromDecimal(value decimal.Decimal) {
+	p.Quantity = new(RestrictedFINDecimalNumber)
+	p.Quantity.FromDecimal(value)
+}
+
 func (p *ProprietaryQuantity9) SetQuantityType(value string) {
 	p.QuantityType = (*Exact4AlphaNumericText)(&value)
 }
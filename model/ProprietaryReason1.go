@@ -10,6 +10,10 @@ type ProprietaryReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *ProprietaryReason1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryReason1) AddReason() *GenericIdentification20 {
 	p.Reason = new(GenericIdentification20)
 	return p.Reason
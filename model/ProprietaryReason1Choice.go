@@ -10,8 +10,16 @@ type ProprietaryReason1Choice struct {
 	Reason []*GenericIdentification36 `xml:"Rsn"`
 }
 
-func (p *ProprietaryReason1Choice) SetNoSpecifiedReason(value string) {
+func (p *ProprietaryReason1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *ProprietaryReason1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	p.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (p *ProprietaryReason1Choice) AddReason() *GenericIdentification36 {
@@ -10,6 +10,10 @@ type ProprietaryReason4 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *ProprietaryReason4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryReason4) AddReason() *GenericIdentification30 {
 	p.Reason = new(GenericIdentification30)
 	return p.Reason
@@ -10,6 +10,10 @@ type ProprietaryReason5 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *ProprietaryReason5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryReason5) AddReason() *GenericIdentification47 {
 	p.Reason = new(GenericIdentification47)
 	return p.Reason
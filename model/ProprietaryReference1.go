@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements to identify a proprietary reference.
 type ProprietaryReference1 struct {
 
@@ -10,6 +12,10 @@ type ProprietaryReference1 struct {
 	Reference *Max35Text `xml:"Ref"`
 }
 
+func (p *ProprietaryReference1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryReference1) SetType(value string) {
 	p.Type = (*Max35Text)(&value)
 }
@@ -17,3 +23,44 @@ func (p *ProprietaryReference1) SetType(value string) {
 func (p *ProprietaryReference1) SetReference(value string) {
 	p.Reference = (*Max35Text)(&value)
 }
+
+func (p *ProprietaryReference1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Type != nil {
+		enc.Element("Tp", string(*p.Type))
+	}
+	if p.Reference != nil {
+		enc.Element("Ref", string(*p.Reference))
+	}
+	enc.End(tag)
+}
+
+func (p *ProprietaryReference1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Type = (*Max35Text)(&value)
+		case "Ref":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Reference = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ type ProprietaryStatusAndReason1 struct {
 	ProprietaryReason []*ProprietaryReason1 `xml:"PrtryRsn,omitempty"`
 }
 
+func (p *ProprietaryStatusAndReason1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryStatusAndReason1) AddProprietaryStatus() *GenericIdentification20 {
 	p.ProprietaryStatus = new(GenericIdentification20)
 	return p.ProprietaryStatus
@@ -13,6 +13,10 @@ type ProprietaryStatusAndReason5 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (p *ProprietaryStatusAndReason5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryStatusAndReason5) AddStatus() *GenericIdentification36 {
 	p.Status = new(GenericIdentification36)
 	return p.Status
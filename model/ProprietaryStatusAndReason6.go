@@ -10,6 +10,10 @@ type ProprietaryStatusAndReason6 struct {
 	ProprietaryReason []*ProprietaryReason4 `xml:"PrtryRsn,omitempty"`
 }
 
+func (p *ProprietaryStatusAndReason6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryStatusAndReason6) AddProprietaryStatus() *GenericIdentification30 {
 	p.ProprietaryStatus = new(GenericIdentification30)
 	return p.ProprietaryStatus
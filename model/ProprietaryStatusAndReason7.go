@@ -10,6 +10,10 @@ type ProprietaryStatusAndReason7 struct {
 	ProprietaryReason []*ProprietaryReason5 `xml:"PrtryRsn,omitempty"`
 }
 
+func (p *ProprietaryStatusAndReason7) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProprietaryStatusAndReason7) AddProprietaryStatus() *GenericIdentification47 {
 	p.ProprietaryStatus = new(GenericIdentification47)
 	return p.ProprietaryStatus
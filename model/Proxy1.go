@@ -10,6 +10,10 @@ type Proxy1 struct {
 	PreassignedProxy *IndividualPerson14 `xml:"PrssgndPrxy,omitempty"`
 }
 
+func (p *Proxy1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Proxy1) AddProxyType(value string) {
 	p.ProxyType = append(p.ProxyType, (*ProxyType2Code)(&value))
 }
@@ -10,6 +10,10 @@ type Proxy1Choice struct {
 	ProxyNotAllowed *ProxyNotAllowedCode `xml:"PrxyNotAllwd"`
 }
 
+func (p *Proxy1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Proxy1Choice) AddProxy() *ProxyAppointmentInformation2 {
 	p.Proxy = new(ProxyAppointmentInformation2)
 	return p.Proxy
@@ -13,6 +13,10 @@ type Proxy2 struct {
 	VoteInstructionForAgendaResolution *Vote1Choice `xml:"VoteInstrForAgndRsltn,omitempty"`
 }
 
+func (p *Proxy2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Proxy2) SetProxyType(value string) {
 	p.ProxyType = (*ProxyType2Code)(&value)
 }
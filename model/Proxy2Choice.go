@@ -10,6 +10,10 @@ type Proxy2Choice struct {
 	ProxyNotAllowed *ProxyNotAllowedCode `xml:"PrxyNotAllwd"`
 }
 
+func (p *Proxy2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Proxy2Choice) AddProxy() *ProxyAppointmentInformation3 {
 	p.Proxy = new(ProxyAppointmentInformation3)
 	return p.Proxy
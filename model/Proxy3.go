@@ -10,6 +10,10 @@ type Proxy3 struct {
 	PreassignedProxy *IndividualPerson16 `xml:"PrssgndPrxy,omitempty"`
 }
 
+func (p *Proxy3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Proxy3) AddProxyType(value string) {
 	p.ProxyType = append(p.ProxyType, (*ProxyType2Code)(&value))
 }
@@ -13,6 +13,10 @@ type Proxy4 struct {
 	VoteInstructionForAgendaResolution *Vote2Choice `xml:"VoteInstrForAgndRsltn,omitempty"`
 }
 
+func (p *Proxy4) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Proxy4) SetProxyType(value string) {
 	p.ProxyType = (*ProxyType2Code)(&value)
 }
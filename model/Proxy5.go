@@ -10,6 +10,10 @@ type Proxy5 struct {
 	PreassignedProxy *IndividualPerson25 `xml:"PrssgndPrxy,omitempty"`
 }
 
+func (p *Proxy5) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Proxy5) AddProxyType(value string) {
 	p.ProxyType = append(p.ProxyType, (*ProxyType2Code)(&value))
 }
@@ -13,6 +13,10 @@ type Proxy6 struct {
 	VoteInstructionForAgendaResolution *Vote3Choice `xml:"VoteInstrForAgndRsltn,omitempty"`
 }
 
+func (p *Proxy6) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Proxy6) SetProxyType(value string) {
 	p.ProxyType = (*ProxyType2Code)(&value)
 }
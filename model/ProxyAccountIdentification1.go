@@ -0,0 +1,67 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Information related to a proxy identification of the account.
+type ProxyAccountIdentification1 struct {
+
+	// Type of the proxy identification.
+	Type *ProxyAccountType1Choice `xml:"Tp,omitempty"`
+
+	// Identification used to indicate the account identification under another specified name.
+	Identification *Max2048Text `xml:"Id"`
+}
+
+func (p *ProxyAccountIdentification1) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *ProxyAccountIdentification1) AddType() *ProxyAccountType1Choice {
+	p.Type = new(ProxyAccountType1Choice)
+	return p.Type
+}
+
+func (p *ProxyAccountIdentification1) SetIdentification(value string) {
+	p.Identification = (*Max2048Text)(&value)
+}
+
+func (p *ProxyAccountIdentification1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Type != nil {
+		p.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if p.Identification != nil {
+		enc.Element("Id", string(*p.Identification))
+	}
+	enc.End(tag)
+}
+
+func (p *ProxyAccountIdentification1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(ProxyAccountType1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.Type = elem
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Identification = (*Max2048Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
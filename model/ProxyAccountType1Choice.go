@@ -0,0 +1,66 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Specifies the scheme used for the identification of an account alias.
+type ProxyAccountType1Choice struct {
+
+	// Name of the identification scheme, in a coded form as published in an external list.
+	Code *ExternalProxyAccountType1Code `xml:"Cd"`
+
+	// Name of the identification scheme, in a free text form.
+	Proprietary *Max35Text `xml:"Prtry"`
+}
+
+func (p *ProxyAccountType1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
+func (p *ProxyAccountType1Choice) SetCode(value string) {
+	p.Code = (*ExternalProxyAccountType1Code)(&value)
+}
+
+func (p *ProxyAccountType1Choice) SetProprietary(value string) {
+	p.Proprietary = (*Max35Text)(&value)
+}
+
+func (p *ProxyAccountType1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Code != nil {
+		enc.Element("Cd", string(*p.Code))
+	}
+	if p.Proprietary != nil {
+		enc.Element("Prtry", string(*p.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (p *ProxyAccountType1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Code = (*ExternalProxyAccountType1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
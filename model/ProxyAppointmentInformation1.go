@@ -19,6 +19,10 @@ type ProxyAppointmentInformation1 struct {
 	AuthorisedProxy []*Proxy1 `xml:"AuthrsdPrxy,omitempty"`
 }
 
+func (p *ProxyAppointmentInformation1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProxyAppointmentInformation1) SetRegistrationMethod(value string) {
 	p.RegistrationMethod = (*Max350Text)(&value)
 }
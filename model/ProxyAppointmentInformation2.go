@@ -19,6 +19,10 @@ type ProxyAppointmentInformation2 struct {
 	AuthorisedProxy []*Proxy3 `xml:"AuthrsdPrxy,omitempty"`
 }
 
+func (p *ProxyAppointmentInformation2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProxyAppointmentInformation2) SetRegistrationMethod(value string) {
 	p.RegistrationMethod = (*Max350Text)(&value)
 }
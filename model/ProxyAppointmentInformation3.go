@@ -19,6 +19,10 @@ type ProxyAppointmentInformation3 struct {
 	AuthorisedProxy []*Proxy5 `xml:"AuthrsdPrxy,omitempty"`
 }
 
+func (p *ProxyAppointmentInformation3) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *ProxyAppointmentInformation3) SetRegistrationMethod(value string) {
 	p.RegistrationMethod = (*Max350Text)(&value)
 }
@@ -10,6 +10,10 @@ type PublicRSAKey1 struct {
 	Exponent *Max5000Binary `xml:"Expnt"`
 }
 
+func (p *PublicRSAKey1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PublicRSAKey1) SetModulus(value string) {
 	p.Modulus = (*Max5000Binary)(&value)
 }
@@ -10,6 +10,10 @@ type PublicRSAKey2 struct {
 	PublicKeyValue *PublicRSAKey1 `xml:"PblcKeyVal"`
 }
 
+func (p *PublicRSAKey2) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PublicRSAKey2) SetAlgorithm(value string) {
 	p.Algorithm = (*Algorithm7Code)(&value)
 }
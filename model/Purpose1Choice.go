@@ -14,6 +14,10 @@ type Purpose1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (p *Purpose1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Purpose1Choice) SetCode(value string) {
 	p.Code = (*ExternalPurposeCode)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the underlying reason for the payment transaction.
 // Usage: Purpose is used by the end-customers, that is initiating party, (ultimate) debtor, (ultimate) creditor to provide information concerning the nature of the payment. Purpose is a content element, which is not used for processing by any of the agents involved in the payment chain.
 type Purpose2Choice struct {
@@ -11,6 +13,10 @@ type Purpose2Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (p *Purpose2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Purpose2Choice) SetCode(value string) {
 	p.Code = (*ExternalPurpose1Code)(&value)
 }
@@ -18,3 +24,44 @@ func (p *Purpose2Choice) SetCode(value string) {
 func (p *Purpose2Choice) SetProprietary(value string) {
 	p.Proprietary = (*Max35Text)(&value)
 }
+
+func (p *Purpose2Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.Code != nil {
+		enc.Element("Cd", string(*p.Code))
+	}
+	if p.Proprietary != nil {
+		enc.Element("Prtry", string(*p.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (p *Purpose2Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Code = (*ExternalPurpose1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			p.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ type Purpose3Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (p *Purpose3Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *Purpose3Choice) SetSecuritiesPurposeCode(value string) {
 	p.SecuritiesPurposeCode = (*ExternalSecuritiesPurpose1Code)(&value)
 }
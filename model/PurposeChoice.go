@@ -10,6 +10,10 @@ type PurposeChoice struct {
 	Code *PaymentPurpose1Code `xml:"Cd"`
 }
 
+func (p *PurposeChoice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PurposeChoice) SetProprietary(value string) {
 	p.Proprietary = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type PurposeCode1Choice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (p *PurposeCode1Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PurposeCode1Choice) SetCode(value string) {
 	p.Code = (*SecuritiesAccountPurposeType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type PurposeCode2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (p *PurposeCode2Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PurposeCode2Choice) SetCode(value string) {
 	p.Code = (*SecuritiesAccountPurposeType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type PurposeCode5Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (p *PurposeCode5Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PurposeCode5Choice) SetCode(value string) {
 	p.Code = (*SecuritiesAccountPurposeType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type PurposeCode7Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (p *PurposeCode7Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PurposeCode7Choice) SetCode(value string) {
 	p.Code = (*SecuritiesAccountPurposeType1Code)(&value)
 }
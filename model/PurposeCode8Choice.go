@@ -10,6 +10,10 @@ type PurposeCode8Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (p *PurposeCode8Choice) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PurposeCode8Choice) SetCode(value string) {
 	p.Code = (*SecuritiesAccountPurposeType1Code)(&value)
 }
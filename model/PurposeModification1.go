@@ -10,6 +10,10 @@ type PurposeModification1 struct {
 	Purpose *Max140Text `xml:"Purp"`
 }
 
+func (p *PurposeModification1) Validate() error {
+	return ValidateElement(p)
+}
+
 func (p *PurposeModification1) SetModificationCode(value string) {
 	p.ModificationCode = (*Modification1Code)(&value)
 }
@@ -1,3 +1,7 @@
 package model
 
 type QUICKIdentifier string
+
+func (q QUICKIdentifier) Validate() error {
+	return validateLength("QUICKIdentifier", string(q), 1, 35)
+}
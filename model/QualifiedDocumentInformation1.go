@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies an identification of a document assigned by and relative to the issuing party (of the identification).
 // Optionally, the component can contain a copy of the identified document and a URI/URL (Universal Resource Information/Location) facilitating retrieval of the document.
 // The component may also contain a cryptographic hash of the referenced document.
@@ -45,6 +49,10 @@ type QualifiedDocumentInformation1 struct {
 	AttachedFile []*BinaryFile1 `xml:"AttchdFile,omitempty"`
 }
 
+func (q *QualifiedDocumentInformation1) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QualifiedDocumentInformation1) SetIdentification(value string) {
 	q.Identification = (*ID)(&value)
 }
@@ -66,6 +74,11 @@ func (q *QualifiedDocumentInformation1) SetDate(value string) {
 	q.Date = (*ISODate)(&value)
 }
 
+func (q *QualifiedDocumentInformation1) SetDateFromTime(value time.Time) {
+	q.Date = new(ISODate)
+	q.Date.FromTime(value)
+}
+
 func (q *QualifiedDocumentInformation1) SetVersion(value string) {
 	q.Version = (*Max6Text)(&value)
 }
@@ -74,6 +87,11 @@ func (q *QualifiedDocumentInformation1) SetElectronicOriginal(value string) {
 	q.ElectronicOriginal = (*YesNoIndicator)(&value)
 }
 
+func (q *QualifiedDocumentInformation1) SetElectronicOriginalFromBool(value bool) {
+	q.ElectronicOriginal = new(YesNoIndicator)
+	q.ElectronicOriginal.FromBool(value)
+}
+
 func (q *QualifiedDocumentInformation1) AddDigest() *AlgorithmAndDigest1 {
 	newValue := new(AlgorithmAndDigest1)
 	q.Digest = append(q.Digest, newValue)
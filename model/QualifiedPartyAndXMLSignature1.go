@@ -10,6 +10,10 @@ type QualifiedPartyAndXMLSignature1 struct {
 	Signature *SignatureEnvelope `xml:"Sgntr"`
 }
 
+func (q *QualifiedPartyAndXMLSignature1) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QualifiedPartyAndXMLSignature1) AddParty() *QualifiedPartyIdentification1 {
 	q.Party = new(QualifiedPartyIdentification1)
 	return q.Party
@@ -22,6 +22,10 @@ type QualifiedPartyIdentification1 struct {
 	RoleDescription *Max256Text `xml:"RoleDesc,omitempty"`
 }
 
+func (q *QualifiedPartyIdentification1) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QualifiedPartyIdentification1) SetIdentification(value string) {
 	q.Identification = (*ID)(&value)
 }
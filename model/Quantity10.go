@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the quantity of a product in a trade transaction.
 type Quantity10 struct {
 
@@ -10,6 +14,10 @@ type Quantity10 struct {
 	Value *DecimalNumber `xml:"Val"`
 }
 
+func (q *Quantity10) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity10) AddUnitOfMeasure() *UnitOfMeasure3Choice {
 	q.UnitOfMeasure = new(UnitOfMeasure3Choice)
 	return q.UnitOfMeasure
@@ -18,3 +26,8 @@ func (q *Quantity10) AddUnitOfMeasure() *UnitOfMeasure3Choice {
 func (q *Quantity10) SetValue(value string) {
 	q.Value = (*DecimalNumber)(&value)
 }
+
+func (q *Quantity10) SetValueFromDecimal(value decimal.Decimal) {
+	q.Value = new(DecimalNumber)
+	q.Value.FromDecimal(value)
+}
@@ -10,6 +10,10 @@ type Quantity10Choice struct {
 	OriginalAndCurrentFace *OriginalAndCurrentQuantities4 `xml:"OrgnlAndCurFace"`
 }
 
+func (q *Quantity10Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity10Choice) AddQuantity() *FinancialInstrumentQuantity15Choice {
 	q.Quantity = new(FinancialInstrumentQuantity15Choice)
 	return q.Quantity
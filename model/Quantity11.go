@@ -16,6 +16,10 @@ type Quantity11 struct {
 	QuantityBreakdown []*QuantityBreakdown30 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (q *Quantity11) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity11) AddSettlementQuantity() *FinancialInstrumentQuantity1Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity1Choice)
 	return q.SettlementQuantity
@@ -16,6 +16,10 @@ type Quantity12 struct {
 	QuantityBreakdown []*QuantityBreakdown38 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (q *Quantity12) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity12) AddSettlementQuantity() *FinancialInstrumentQuantity15Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity15Choice)
 	return q.SettlementQuantity
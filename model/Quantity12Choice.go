@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of quantity of assets to be transferred in percentage rate or units.
 type Quantity12Choice struct {
 
@@ -10,10 +14,24 @@ type Quantity12Choice struct {
 	PercentageRate *PercentageRate `xml:"PctgRate,omitempty"`
 }
 
+func (q *Quantity12Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity12Choice) SetUnit(value string) {
 	q.Unit = (*DecimalNumber)(&value)
 }
 
+func (q *Quantity12Choice) SetUnitFromDecimal(value decimal.Decimal) {
+	q.Unit = new(DecimalNumber)
+	q.Unit.FromDecimal(value)
+}
+
 func (q *Quantity12Choice) SetPercentageRate(value string) {
 	q.PercentageRate = (*PercentageRate)(&value)
 }
+
+func (q *Quantity12Choice) SetPercentageRateFromDecimal(value decimal.Decimal) {
+	q.PercentageRate = new(PercentageRate)
+	q.PercentageRate.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Total quantity of securities to be transferred, expressed in a number of units or a percentage rate.
 type Quantity13Choice struct {
 
@@ -10,6 +14,10 @@ type Quantity13Choice struct {
 	PortfolioTransferOutRate *PercentageRate `xml:"PrtflTrfOutRate"`
 }
 
+func (q *Quantity13Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity13Choice) AddTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	q.TotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return q.TotalUnitsNumber
@@ -18,3 +26,8 @@ func (q *Quantity13Choice) AddTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 func (q *Quantity13Choice) SetPortfolioTransferOutRate(value string) {
 	q.PortfolioTransferOutRate = (*PercentageRate)(&value)
 }
+
+func (q *Quantity13Choice) SetPortfolioTransferOutRateFromDecimal(value decimal.Decimal) {
+	q.PortfolioTransferOutRate = new(PercentageRate)
+	q.PortfolioTransferOutRate.FromDecimal(value)
+}
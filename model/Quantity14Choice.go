@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of quantity of assets to be transferred in percentage rate or units.
 type Quantity14Choice struct {
 
@@ -10,6 +14,10 @@ type Quantity14Choice struct {
 	PercentageRate *PercentageRate `xml:"PctgRate"`
 }
 
+func (q *Quantity14Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity14Choice) AddUnit() *Unit4 {
 	q.Unit = new(Unit4)
 	return q.Unit
@@ -18,3 +26,8 @@ func (q *Quantity14Choice) AddUnit() *Unit4 {
 func (q *Quantity14Choice) SetPercentageRate(value string) {
 	q.PercentageRate = (*PercentageRate)(&value)
 }
+
+func (q *Quantity14Choice) SetPercentageRateFromDecimal(value decimal.Decimal) {
+	q.PercentageRate = new(PercentageRate)
+	q.PercentageRate.FromDecimal(value)
+}
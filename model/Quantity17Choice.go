@@ -10,6 +10,10 @@ type Quantity17Choice struct {
 	ProprietaryQuantity *ProprietaryQuantity7 `xml:"PrtryQty"`
 }
 
+func (q *Quantity17Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity17Choice) AddQuantityChoice() *Quantity18Choice {
 	q.QuantityChoice = new(Quantity18Choice)
 	return q.QuantityChoice
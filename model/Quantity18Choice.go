@@ -10,6 +10,10 @@ type Quantity18Choice struct {
 	SignedQuantity *SignedQuantityFormat6 `xml:"SgndQty"`
 }
 
+func (q *Quantity18Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity18Choice) AddOriginalAndCurrentFaceAmount() *OriginalAndCurrentQuantities6 {
 	q.OriginalAndCurrentFaceAmount = new(OriginalAndCurrentQuantities6)
 	return q.OriginalAndCurrentFaceAmount
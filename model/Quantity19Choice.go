@@ -10,6 +10,10 @@ type Quantity19Choice struct {
 	ProprietaryQuantity *ProprietaryQuantity8 `xml:"PrtryQty"`
 }
 
+func (q *Quantity19Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity19Choice) AddQuantity() *FinancialInstrumentQuantity1Choice {
 	q.Quantity = new(FinancialInstrumentQuantity1Choice)
 	return q.Quantity
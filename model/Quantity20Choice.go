@@ -13,6 +13,10 @@ type Quantity20Choice struct {
 	Quantity *FinancialInstrumentQuantity1Choice `xml:"Qty"`
 }
 
+func (q *Quantity20Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity20Choice) SetCode(value string) {
 	q.Code = (*Quantity1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Quantity21Choice struct {
 	ProprietaryQuantity *ProprietaryQuantity9 `xml:"PrtryQty"`
 }
 
+func (q *Quantity21Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity21Choice) AddQuantity() *FinancialInstrumentQuantity15Choice {
 	q.Quantity = new(FinancialInstrumentQuantity15Choice)
 	return q.Quantity
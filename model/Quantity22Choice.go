@@ -10,6 +10,10 @@ type Quantity22Choice struct {
 	ProprietaryQuantity *ProprietaryQuantity10 `xml:"PrtryQty"`
 }
 
+func (q *Quantity22Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity22Choice) AddQuantityChoice() *Quantity23Choice {
 	q.QuantityChoice = new(Quantity23Choice)
 	return q.QuantityChoice
@@ -10,6 +10,10 @@ type Quantity23Choice struct {
 	SignedQuantity *SignedQuantityFormat9 `xml:"SgndQty"`
 }
 
+func (q *Quantity23Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity23Choice) AddOriginalAndCurrentFaceAmount() *OriginalAndCurrentQuantities7 {
 	q.OriginalAndCurrentFaceAmount = new(OriginalAndCurrentQuantities7)
 	return q.OriginalAndCurrentFaceAmount
@@ -10,6 +10,10 @@ type Quantity2Choice struct {
 	ProprietaryQuantity *ProprietaryQuantity2 `xml:"PrtryQty"`
 }
 
+func (q *Quantity2Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity2Choice) AddQuantity() *FinancialInstrumentQuantity1Choice {
 	q.Quantity = new(FinancialInstrumentQuantity1Choice)
 	return q.Quantity
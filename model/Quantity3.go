@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the quantity of a product in a trade transaction.
 type Quantity3 struct {
 
@@ -13,6 +17,10 @@ type Quantity3 struct {
 	Value *DecimalNumber `xml:"Val"`
 }
 
+func (q *Quantity3) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity3) SetUnitOfMeasureCode(value string) {
 	q.UnitOfMeasureCode = (*UnitOfMeasure4Code)(&value)
 }
@@ -24,3 +32,8 @@ func (q *Quantity3) SetOtherUnitOfMeasure(value string) {
 func (q *Quantity3) SetValue(value string) {
 	q.Value = (*DecimalNumber)(&value)
 }
+
+func (q *Quantity3) SetValueFromDecimal(value decimal.Decimal) {
+	q.Value = new(DecimalNumber)
+	q.Value.FromDecimal(value)
+}
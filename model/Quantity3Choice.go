@@ -10,6 +10,10 @@ type Quantity3Choice struct {
 	ProprietaryQuantity *ProprietaryQuantity3 `xml:"PrtryQty"`
 }
 
+func (q *Quantity3Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity3Choice) AddQuantityChoice() *Quantity4Choice {
 	q.QuantityChoice = new(Quantity4Choice)
 	return q.QuantityChoice
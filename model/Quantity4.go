@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the quantity of a product in a trade transaction.
 type Quantity4 struct {
 
@@ -16,6 +20,10 @@ type Quantity4 struct {
 	Factor *Max15NumericText `xml:"Fctr,omitempty"`
 }
 
+func (q *Quantity4) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity4) SetUnitOfMeasureCode(value string) {
 	q.UnitOfMeasureCode = (*UnitOfMeasure4Code)(&value)
 }
@@ -28,6 +36,11 @@ func (q *Quantity4) SetValue(value string) {
 	q.Value = (*DecimalNumber)(&value)
 }
 
+func (q *Quantity4) SetValueFromDecimal(value decimal.Decimal) {
+	q.Value = new(DecimalNumber)
+	q.Value.FromDecimal(value)
+}
+
 func (q *Quantity4) SetFactor(value string) {
 	q.Factor = (*Max15NumericText)(&value)
 }
@@ -13,6 +13,10 @@ type Quantity40Choice struct {
 	Quantity *FinancialInstrumentQuantity15Choice `xml:"Qty"`
 }
 
+func (q *Quantity40Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity40Choice) SetCode(value string) {
 	q.Code = (*Quantity1Code)(&value)
 }
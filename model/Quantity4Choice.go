@@ -10,6 +10,10 @@ type Quantity4Choice struct {
 	SignedQuantity *SignedQuantityFormat2 `xml:"SgndQty"`
 }
 
+func (q *Quantity4Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity4Choice) AddOriginalAndCurrentFaceAmount() *OriginalAndCurrentQuantities2 {
 	q.OriginalAndCurrentFaceAmount = new(OriginalAndCurrentQuantities2)
 	return q.OriginalAndCurrentFaceAmount
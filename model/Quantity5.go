@@ -16,6 +16,10 @@ type Quantity5 struct {
 	QuantityBreakdown []*QuantityBreakdown3 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (q *Quantity5) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity5) AddSettlementQuantity() *FinancialInstrumentQuantity1Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity1Choice)
 	return q.SettlementQuantity
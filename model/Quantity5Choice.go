@@ -13,6 +13,10 @@ type Quantity5Choice struct {
 	Quantity *FinancialInstrumentQuantity1Choice `xml:"Qty"`
 }
 
+func (q *Quantity5Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity5Choice) SetCode(value string) {
 	q.Code = (*Quantity1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Quantity6Choice struct {
 	OriginalAndCurrentFace *OriginalAndCurrentQuantities1 `xml:"OrgnlAndCurFace"`
 }
 
+func (q *Quantity6Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity6Choice) AddQuantity() *FinancialInstrumentQuantity1Choice {
 	q.Quantity = new(FinancialInstrumentQuantity1Choice)
 	return q.Quantity
@@ -16,6 +16,10 @@ type Quantity7 struct {
 	QuantityBreakdown []*QuantityBreakdown13 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (q *Quantity7) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity7) AddSettlementQuantity() *FinancialInstrumentQuantity1Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity1Choice)
 	return q.SettlementQuantity
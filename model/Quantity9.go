@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the quantity of a product in a trade transaction.
 type Quantity9 struct {
 
@@ -13,6 +17,10 @@ type Quantity9 struct {
 	Factor *Max15NumericText `xml:"Fctr,omitempty"`
 }
 
+func (q *Quantity9) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *Quantity9) AddUnitOfMeasure() *UnitOfMeasure3Choice {
 	q.UnitOfMeasure = new(UnitOfMeasure3Choice)
 	return q.UnitOfMeasure
@@ -22,6 +30,11 @@ func (q *Quantity9) SetValue(value string) {
 	q.Value = (*DecimalNumber)(&value)
 }
 
+func (q *Quantity9) SetValueFromDecimal(value decimal.Decimal) {
+	q.Value = new(DecimalNumber)
+	q.Value.FromDecimal(value)
+}
+
 func (q *Quantity9) SetFactor(value string) {
 	q.Factor = (*Max15NumericText)(&value)
 }
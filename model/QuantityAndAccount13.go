@@ -25,6 +25,10 @@ type QuantityAndAccount13 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat3Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount13) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount13) AddSettlementQuantity() *FinancialInstrumentQuantity1Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity1Choice)
 	return q.SettlementQuantity
@@ -25,6 +25,10 @@ type QuantityAndAccount17 struct {
 	QuantityBreakdown []*QuantityBreakdown3 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (q *QuantityAndAccount17) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount17) AddSettlementQuantity() *Quantity6Choice {
 	q.SettlementQuantity = new(Quantity6Choice)
 	return q.SettlementQuantity
@@ -31,6 +31,10 @@ type QuantityAndAccount18 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat3Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount18) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount18) AddSettledQuantity() *Quantity6Choice {
 	q.SettledQuantity = new(Quantity6Choice)
 	return q.SettledQuantity
@@ -37,6 +37,10 @@ type QuantityAndAccount2 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat3Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount2) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount2) AddSettledQuantity() *Quantity6Choice {
 	q.SettledQuantity = new(Quantity6Choice)
 	return q.SettledQuantity
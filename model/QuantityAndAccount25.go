@@ -25,6 +25,10 @@ type QuantityAndAccount25 struct {
 	QuantityBreakdown []*QuantityBreakdown13 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (q *QuantityAndAccount25) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount25) AddSettlementQuantity() *Quantity6Choice {
 	q.SettlementQuantity = new(Quantity6Choice)
 	return q.SettlementQuantity
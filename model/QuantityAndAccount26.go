@@ -19,6 +19,10 @@ type QuantityAndAccount26 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat3Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount26) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount26) AddSettlementQuantity() *FinancialInstrumentQuantity1Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity1Choice)
 	return q.SettlementQuantity
@@ -25,6 +25,10 @@ type QuantityAndAccount27 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat3Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount27) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount27) AddSettlementQuantity() *FinancialInstrumentQuantity1Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity1Choice)
 	return q.SettlementQuantity
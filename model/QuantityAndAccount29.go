@@ -19,6 +19,10 @@ type QuantityAndAccount29 struct {
 	QuantityBreakdown []*QuantityBreakdown13 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (q *QuantityAndAccount29) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount29) SetDenominationChoice(value string) {
 	q.DenominationChoice = (*Max210Text)(&value)
 }
@@ -28,6 +28,10 @@ type QuantityAndAccount3 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat3Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount3) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount3) AddSettlementQuantity() *FinancialInstrumentQuantity1Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity1Choice)
 	return q.SettlementQuantity
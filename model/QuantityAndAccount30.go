@@ -25,6 +25,10 @@ type QuantityAndAccount30 struct {
 	QuantityBreakdown []*QuantityBreakdown13 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (q *QuantityAndAccount30) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount30) AddSettlementQuantity() *Quantity6Choice {
 	q.SettlementQuantity = new(Quantity6Choice)
 	return q.SettlementQuantity
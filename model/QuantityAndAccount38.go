@@ -19,6 +19,10 @@ type QuantityAndAccount38 struct {
 	SafekeepingPlace *SafeKeepingPlace1 `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount38) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount38) AddSettlementQuantity() *FinancialInstrumentQuantity1Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity1Choice)
 	return q.SettlementQuantity
@@ -25,6 +25,10 @@ type QuantityAndAccount39 struct {
 	QuantityBreakdown []*QuantityBreakdown30 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (q *QuantityAndAccount39) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount39) AddSettlementQuantity() *Quantity6Choice {
 	q.SettlementQuantity = new(Quantity6Choice)
 	return q.SettlementQuantity
@@ -31,6 +31,10 @@ type QuantityAndAccount40 struct {
 	SafekeepingPlace *SafeKeepingPlace1 `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount40) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount40) AddSettledQuantity() *Quantity6Choice {
 	q.SettledQuantity = new(Quantity6Choice)
 	return q.SettledQuantity
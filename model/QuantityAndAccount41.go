@@ -37,6 +37,10 @@ type QuantityAndAccount41 struct {
 	SafekeepingPlace *SafeKeepingPlace1 `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount41) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount41) AddSettledQuantity() *Quantity6Choice {
 	q.SettledQuantity = new(Quantity6Choice)
 	return q.SettledQuantity
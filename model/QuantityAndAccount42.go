@@ -25,6 +25,10 @@ type QuantityAndAccount42 struct {
 	SafekeepingPlace *SafeKeepingPlace1 `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount42) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount42) AddSettlementQuantity() *FinancialInstrumentQuantity1Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity1Choice)
 	return q.SettlementQuantity
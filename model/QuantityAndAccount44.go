@@ -19,6 +19,10 @@ type QuantityAndAccount44 struct {
 	QuantityBreakdown []*QuantityBreakdown30 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (q *QuantityAndAccount44) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount44) SetDenominationChoice(value string) {
 	q.DenominationChoice = (*Max210Text)(&value)
 }
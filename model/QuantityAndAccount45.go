@@ -19,6 +19,10 @@ type QuantityAndAccount45 struct {
 	SafekeepingPlace *SafeKeepingPlace1 `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount45) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount45) AddSettlementQuantity() *FinancialInstrumentQuantity1Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity1Choice)
 	return q.SettlementQuantity
@@ -31,6 +31,10 @@ type QuantityAndAccount46 struct {
 	SafekeepingPlace *SafeKeepingPlace2 `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount46) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount46) AddSettledQuantity() *Quantity10Choice {
 	q.SettledQuantity = new(Quantity10Choice)
 	return q.SettledQuantity
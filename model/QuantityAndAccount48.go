@@ -19,6 +19,10 @@ type QuantityAndAccount48 struct {
 	SafekeepingPlace *SafeKeepingPlace2 `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount48) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount48) AddSettlementQuantity() *FinancialInstrumentQuantity15Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity15Choice)
 	return q.SettlementQuantity
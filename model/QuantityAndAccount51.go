@@ -37,6 +37,10 @@ type QuantityAndAccount51 struct {
 	SafekeepingPlace *SafeKeepingPlace2 `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount51) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount51) AddSettledQuantity() *Quantity10Choice {
 	q.SettledQuantity = new(Quantity10Choice)
 	return q.SettledQuantity
@@ -19,6 +19,10 @@ type QuantityAndAccount54 struct {
 	QuantityBreakdown []*QuantityBreakdown38 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (q *QuantityAndAccount54) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount54) SetDenominationChoice(value string) {
 	q.DenominationChoice = (*RestrictedFINXMax210Text)(&value)
 }
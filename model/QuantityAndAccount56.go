@@ -25,6 +25,10 @@ type QuantityAndAccount56 struct {
 	QuantityBreakdown []*QuantityBreakdown38 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (q *QuantityAndAccount56) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount56) AddSettlementQuantity() *Quantity10Choice {
 	q.SettlementQuantity = new(Quantity10Choice)
 	return q.SettlementQuantity
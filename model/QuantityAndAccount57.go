@@ -37,6 +37,10 @@ type QuantityAndAccount57 struct {
 	SafekeepingPlace *SafeKeepingPlace2 `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount57) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount57) AddSettledQuantity() *Quantity10Choice {
 	q.SettledQuantity = new(Quantity10Choice)
 	return q.SettledQuantity
@@ -25,6 +25,10 @@ type QuantityAndAccount58 struct {
 	SafekeepingPlace *SafeKeepingPlace2 `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount58) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount58) AddSettlementQuantity() *FinancialInstrumentQuantity15Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity15Choice)
 	return q.SettlementQuantity
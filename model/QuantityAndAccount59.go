@@ -19,6 +19,10 @@ type QuantityAndAccount59 struct {
 	QuantityBreakdown []*QuantityBreakdown38 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (q *QuantityAndAccount59) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount59) SetDenominationChoice(value string) {
 	q.DenominationChoice = (*RestrictedFINXMax210Text)(&value)
 }
@@ -19,6 +19,10 @@ type QuantityAndAccount61 struct {
 	SafekeepingPlace *SafeKeepingPlace2 `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount61) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount61) AddSettlementQuantity() *FinancialInstrumentQuantity15Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity15Choice)
 	return q.SettlementQuantity
@@ -19,6 +19,10 @@ type QuantityAndAccount7 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat3Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount7) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount7) AddSettlementQuantity() *FinancialInstrumentQuantity1Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity1Choice)
 	return q.SettlementQuantity
@@ -22,6 +22,10 @@ type QuantityAndAccount8 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat3Choice `xml:"SfkpgPlc,omitempty"`
 }
 
+func (q *QuantityAndAccount8) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAccount8) AddSettlementQuantity() *FinancialInstrumentQuantity1Choice {
 	q.SettlementQuantity = new(FinancialInstrumentQuantity1Choice)
 	return q.SettlementQuantity
@@ -10,6 +10,10 @@ type QuantityAndAvailability struct {
 	AvailabilityIndicator *YesNoIndicator `xml:"AvlbtyInd"`
 }
 
+func (q *QuantityAndAvailability) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAvailability) AddQuantity() *FinancialInstrumentQuantityChoice {
 	q.Quantity = new(FinancialInstrumentQuantityChoice)
 	return q.Quantity
@@ -18,3 +22,8 @@ func (q *QuantityAndAvailability) AddQuantity() *FinancialInstrumentQuantityChoi
 func (q *QuantityAndAvailability) SetAvailabilityIndicator(value string) {
 	q.AvailabilityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (q *QuantityAndAvailability) SetAvailabilityIndicatorFromBool(value bool) {
+	q.AvailabilityIndicator = new(YesNoIndicator)
+	q.AvailabilityIndicator.FromBool(value)
+}
@@ -10,6 +10,10 @@ type QuantityAndAvailability1 struct {
 	AvailabilityIndicator *YesNoIndicator `xml:"AvlbtyInd"`
 }
 
+func (q *QuantityAndAvailability1) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAvailability1) AddQuantity() *FinancialInstrumentQuantity1Choice {
 	q.Quantity = new(FinancialInstrumentQuantity1Choice)
 	return q.Quantity
@@ -18,3 +22,8 @@ func (q *QuantityAndAvailability1) AddQuantity() *FinancialInstrumentQuantity1Ch
 func (q *QuantityAndAvailability1) SetAvailabilityIndicator(value string) {
 	q.AvailabilityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (q *QuantityAndAvailability1) SetAvailabilityIndicatorFromBool(value bool) {
+	q.AvailabilityIndicator = new(YesNoIndicator)
+	q.AvailabilityIndicator.FromBool(value)
+}
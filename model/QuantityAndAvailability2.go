@@ -10,6 +10,10 @@ type QuantityAndAvailability2 struct {
 	AvailabilityIndicator *YesNoIndicator `xml:"AvlbtyInd"`
 }
 
+func (q *QuantityAndAvailability2) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityAndAvailability2) AddQuantity() *FinancialInstrumentQuantity15Choice {
 	q.Quantity = new(FinancialInstrumentQuantity15Choice)
 	return q.Quantity
@@ -18,3 +22,8 @@ func (q *QuantityAndAvailability2) AddQuantity() *FinancialInstrumentQuantity15C
 func (q *QuantityAndAvailability2) SetAvailabilityIndicator(value string) {
 	q.AvailabilityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (q *QuantityAndAvailability2) SetAvailabilityIndicatorFromBool(value bool) {
+	q.AvailabilityIndicator = new(YesNoIndicator)
+	q.AvailabilityIndicator.FromBool(value)
+}
@@ -16,6 +16,10 @@ type QuantityBreakdown11 struct {
 	LotPrice *Price4 `xml:"LotPric,omitempty"`
 }
 
+func (q *QuantityBreakdown11) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown11) AddLotNumber() *GenericIdentification37 {
 	q.LotNumber = new(GenericIdentification37)
 	return q.LotNumber
@@ -13,6 +13,10 @@ type QuantityBreakdown12 struct {
 	SecuritiesSubBalanceType *GenericIdentification20 `xml:"SctiesSubBalTp,omitempty"`
 }
 
+func (q *QuantityBreakdown12) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown12) AddLotNumber() *GenericIdentification37 {
 	q.LotNumber = new(GenericIdentification37)
 	return q.LotNumber
@@ -19,6 +19,10 @@ type QuantityBreakdown13 struct {
 	TypeOfPrice *TypeOfPrice3Choice `xml:"TpOfPric,omitempty"`
 }
 
+func (q *QuantityBreakdown13) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown13) AddLotNumber() *GenericIdentification37 {
 	q.LotNumber = new(GenericIdentification37)
 	return q.LotNumber
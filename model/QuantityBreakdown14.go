@@ -28,6 +28,10 @@ type QuantityBreakdown14 struct {
 	AlternateReportingCurrencyAmounts *BalanceAmounts2 `xml:"AltrnRptgCcyAmts,omitempty"`
 }
 
+func (q *QuantityBreakdown14) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown14) AddLotNumber() *GenericIdentification37 {
 	q.LotNumber = new(GenericIdentification37)
 	return q.LotNumber
@@ -10,6 +10,10 @@ type QuantityBreakdown15 struct {
 	LotQuantity *FinancialInstrumentQuantity1Choice `xml:"LotQty,omitempty"`
 }
 
+func (q *QuantityBreakdown15) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown15) AddLotNumber() *GenericIdentification37 {
 	q.LotNumber = new(GenericIdentification37)
 	return q.LotNumber
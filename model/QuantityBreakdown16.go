@@ -22,6 +22,10 @@ type QuantityBreakdown16 struct {
 	TypeOfPrice *TypeOfPrice3Choice `xml:"TpOfPric,omitempty"`
 }
 
+func (q *QuantityBreakdown16) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown16) AddLotNumber() *GenericIdentification37 {
 	q.LotNumber = new(GenericIdentification37)
 	return q.LotNumber
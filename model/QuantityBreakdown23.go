@@ -19,6 +19,10 @@ type QuantityBreakdown23 struct {
 	TypeOfPrice *TypeOfPrice3Choice `xml:"TpOfPric,omitempty"`
 }
 
+func (q *QuantityBreakdown23) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown23) AddLotNumber() *GenericIdentification37 {
 	q.LotNumber = new(GenericIdentification37)
 	return q.LotNumber
@@ -28,6 +28,10 @@ type QuantityBreakdown24 struct {
 	AlternateReportingCurrencyAmounts *BalanceAmounts2 `xml:"AltrnRptgCcyAmts,omitempty"`
 }
 
+func (q *QuantityBreakdown24) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown24) AddLotNumber() *GenericIdentification37 {
 	q.LotNumber = new(GenericIdentification37)
 	return q.LotNumber
@@ -19,6 +19,10 @@ type QuantityBreakdown27 struct {
 	TypeOfPrice *TypeOfPrice29Choice `xml:"TpOfPric,omitempty"`
 }
 
+func (q *QuantityBreakdown27) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown27) AddLotNumber() *GenericIdentification37 {
 	q.LotNumber = new(GenericIdentification37)
 	return q.LotNumber
@@ -28,6 +28,10 @@ type QuantityBreakdown28 struct {
 	AlternateReportingCurrencyAmounts *BalanceAmounts2 `xml:"AltrnRptgCcyAmts,omitempty"`
 }
 
+func (q *QuantityBreakdown28) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown28) AddLotNumber() *GenericIdentification37 {
 	q.LotNumber = new(GenericIdentification37)
 	return q.LotNumber
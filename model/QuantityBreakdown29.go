@@ -22,6 +22,10 @@ type QuantityBreakdown29 struct {
 	TypeOfPrice *TypeOfPrice29Choice `xml:"TpOfPric,omitempty"`
 }
 
+func (q *QuantityBreakdown29) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown29) AddLotNumber() *GenericIdentification37 {
 	q.LotNumber = new(GenericIdentification37)
 	return q.LotNumber
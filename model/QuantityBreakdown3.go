@@ -19,6 +19,10 @@ type QuantityBreakdown3 struct {
 	TypeOfPrice *TypeOfPrice3Choice `xml:"TpOfPric,omitempty"`
 }
 
+func (q *QuantityBreakdown3) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown3) AddLotNumber() *Number2Choice {
 	q.LotNumber = new(Number2Choice)
 	return q.LotNumber
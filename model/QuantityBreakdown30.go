@@ -19,6 +19,10 @@ type QuantityBreakdown30 struct {
 	TypeOfPrice *TypeOfPrice29Choice `xml:"TpOfPric,omitempty"`
 }
 
+func (q *QuantityBreakdown30) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown30) AddLotNumber() *GenericIdentification37 {
 	q.LotNumber = new(GenericIdentification37)
 	return q.LotNumber
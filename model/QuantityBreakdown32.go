@@ -13,6 +13,10 @@ type QuantityBreakdown32 struct {
 	SecuritiesSubBalanceType *GenericIdentification30 `xml:"SctiesSubBalTp,omitempty"`
 }
 
+func (q *QuantityBreakdown32) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown32) AddLotNumber() *GenericIdentification37 {
 	q.LotNumber = new(GenericIdentification37)
 	return q.LotNumber
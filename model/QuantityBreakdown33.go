@@ -10,6 +10,10 @@ type QuantityBreakdown33 struct {
 	LotQuantity *FinancialInstrumentQuantity15Choice `xml:"LotQty,omitempty"`
 }
 
+func (q *QuantityBreakdown33) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown33) AddLotNumber() *GenericIdentification39 {
 	q.LotNumber = new(GenericIdentification39)
 	return q.LotNumber
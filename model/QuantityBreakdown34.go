@@ -13,6 +13,10 @@ type QuantityBreakdown34 struct {
 	SecuritiesSubBalanceType *GenericIdentification47 `xml:"SctiesSubBalTp,omitempty"`
 }
 
+func (q *QuantityBreakdown34) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown34) AddLotNumber() *GenericIdentification39 {
 	q.LotNumber = new(GenericIdentification39)
 	return q.LotNumber
@@ -19,6 +19,10 @@ type QuantityBreakdown38 struct {
 	TypeOfPrice *TypeOfPrice32Choice `xml:"TpOfPric,omitempty"`
 }
 
+func (q *QuantityBreakdown38) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown38) AddLotNumber() *GenericIdentification39 {
 	q.LotNumber = new(GenericIdentification39)
 	return q.LotNumber
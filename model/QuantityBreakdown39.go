@@ -28,6 +28,10 @@ type QuantityBreakdown39 struct {
 	AlternateReportingCurrencyAmounts *BalanceAmounts6 `xml:"AltrnRptgCcyAmts,omitempty"`
 }
 
+func (q *QuantityBreakdown39) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown39) AddLotNumber() *GenericIdentification39 {
 	q.LotNumber = new(GenericIdentification39)
 	return q.LotNumber
@@ -28,6 +28,10 @@ type QuantityBreakdown4 struct {
 	AlternateReportingCurrencyAmounts *BalanceAmounts2 `xml:"AltrnRptgCcyAmts,omitempty"`
 }
 
+func (q *QuantityBreakdown4) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown4) AddLotNumber() *Number2Choice {
 	q.LotNumber = new(Number2Choice)
 	return q.LotNumber
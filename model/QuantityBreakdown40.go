@@ -19,6 +19,10 @@ type QuantityBreakdown40 struct {
 	TypeOfPrice *TypeOfPrice32Choice `xml:"TpOfPric,omitempty"`
 }
 
+func (q *QuantityBreakdown40) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown40) AddLotNumber() *GenericIdentification39 {
 	q.LotNumber = new(GenericIdentification39)
 	return q.LotNumber
@@ -22,6 +22,10 @@ type QuantityBreakdown44 struct {
 	TypeOfPrice *TypeOfPrice32Choice `xml:"TpOfPric,omitempty"`
 }
 
+func (q *QuantityBreakdown44) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown44) AddLotNumber() *GenericIdentification39 {
 	q.LotNumber = new(GenericIdentification39)
 	return q.LotNumber
@@ -10,6 +10,10 @@ type QuantityBreakdown5 struct {
 	LotQuantity *FinancialInstrumentQuantity1Choice `xml:"LotQty,omitempty"`
 }
 
+func (q *QuantityBreakdown5) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown5) AddLotNumber() *Number2Choice {
 	q.LotNumber = new(Number2Choice)
 	return q.LotNumber
@@ -22,6 +22,10 @@ type QuantityBreakdown9 struct {
 	TypeOfPrice *TypeOfPrice3Choice `xml:"TpOfPric,omitempty"`
 }
 
+func (q *QuantityBreakdown9) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityBreakdown9) AddLotNumber() *Number2Choice {
 	q.LotNumber = new(Number2Choice)
 	return q.LotNumber
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a quantity expressed in units, face amount or amortised amount and a quantity expressed as an amount.
 type QuantityOrAmount1Choice struct {
 
@@ -10,6 +14,10 @@ type QuantityOrAmount1Choice struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (q *QuantityOrAmount1Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityOrAmount1Choice) AddQuantity() *FinancialInstrumentQuantityChoice {
 	q.Quantity = new(FinancialInstrumentQuantityChoice)
 	return q.Quantity
@@ -18,3 +26,7 @@ func (q *QuantityOrAmount1Choice) AddQuantity() *FinancialInstrumentQuantityChoi
 func (q *QuantityOrAmount1Choice) SetAmount(value, currency string) {
 	q.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (q *QuantityOrAmount1Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	q.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Ratio expressed as a quotient of quantities.
 type QuantityToQuantityRatio1 struct {
 
@@ -10,10 +14,24 @@ type QuantityToQuantityRatio1 struct {
 	Quantity2 *DecimalNumber `xml:"Qty2"`
 }
 
+func (q *QuantityToQuantityRatio1) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityToQuantityRatio1) SetQuantity1(value string) {
 	q.Quantity1 = (*DecimalNumber)(&value)
 }
 
+func (q *QuantityToQuantityRatio1) SetQuantity1FromDecimal(value decimal.Decimal) {
+	q.Quantity1 = new(DecimalNumber)
+	q.Quantity1.FromDecimal(value)
+}
+
 func (q *QuantityToQuantityRatio1) SetQuantity2(value string) {
 	q.Quantity2 = (*DecimalNumber)(&value)
 }
+
+func (q *QuantityToQuantityRatio1) SetQuantity2FromDecimal(value decimal.Decimal) {
+	q.Quantity2 = new(DecimalNumber)
+	q.Quantity2.FromDecimal(value)
+}
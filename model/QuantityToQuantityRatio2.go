@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Ratio expressed as a quotient of quantities.
 type QuantityToQuantityRatio2 struct {
 
@@ -10,10 +14,24 @@ type QuantityToQuantityRatio2 struct {
 	Quantity2 *RestrictedFINDecimalNumber `xml:"Qty2"`
 }
 
+func (q *QuantityToQuantityRatio2) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityToQuantityRatio2) SetQuantity1(value string) {
 	q.Quantity1 = (*RestrictedFINDecimalNumber)(&value)
 }
 
+func (q *QuantityToQuantityRatio2) SetQuantity1FromDecimal(value decimal.Decimal) {
+	q.Quantity1 = new(RestrictedFINDecimalNumber)
+	q.Quantity1.FromDecimal(value)
+}
+
 func (q *QuantityToQuantityRatio2) SetQuantity2(value string) {
 	q.Quantity2 = (*RestrictedFINDecimalNumber)(&value)
 }
+
+func (q *QuantityToQuantityRatio2) SetQuantity2FromDecimal(value decimal.Decimal) {
+	q.Quantity2 = new(RestrictedFINDecimalNumber)
+	q.Quantity2.FromDecimal(value)
+}
@@ -10,6 +10,10 @@ type QuantityType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (q *QuantityType1Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuantityType1Choice) SetCode(value string) {
 	q.Code = (*OrderQuantityType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type QueryReference struct {
 	QueryName *Max35Text `xml:"QryNm,omitempty"`
 }
 
+func (q *QueryReference) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QueryReference) SetQueryReference(value string) {
 	q.QueryReference = (*Max35Text)(&value)
 }
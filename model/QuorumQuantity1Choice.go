@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of quorum quantity.
 type QuorumQuantity1Choice struct {
 
@@ -10,6 +14,10 @@ type QuorumQuantity1Choice struct {
 	QuorumQuantityPercentage *PercentageRate `xml:"QrmQtyPctg"`
 }
 
+func (q *QuorumQuantity1Choice) Validate() error {
+	return ValidateElement(q)
+}
+
 func (q *QuorumQuantity1Choice) SetQuorumQuantity(value string) {
 	q.QuorumQuantity = (*Max35Text)(&value)
 }
@@ -17,3 +25,8 @@ func (q *QuorumQuantity1Choice) SetQuorumQuantity(value string) {
 func (q *QuorumQuantity1Choice) SetQuorumQuantityPercentage(value string) {
 	q.QuorumQuantityPercentage = (*PercentageRate)(&value)
 }
+
+func (q *QuorumQuantity1Choice) SetQuorumQuantityPercentageFromDecimal(value decimal.Decimal) {
+	q.QuorumQuantityPercentage = new(PercentageRate)
+	q.QuorumQuantityPercentage.FromDecimal(value)
+}
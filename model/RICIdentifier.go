@@ -1,3 +1,7 @@
 package model
 
 type RICIdentifier string
+
+func (r RICIdentifier) Validate() error {
+	return validateLength("RICIdentifier", string(r), 1, 35)
+}
@@ -12,6 +12,10 @@ type Rate1 struct {
 	ValidityRange *CurrencyAndAmountRange `xml:"VldtyRg,omitempty"`
 }
 
+func (r *Rate1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Rate1) AddRate() *RateTypeChoice {
 	r.Rate = new(RateTypeChoice)
 	return r.Rate
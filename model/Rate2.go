@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements qualifying the interest rate.
 type Rate2 struct {
 
@@ -10,10 +14,24 @@ type Rate2 struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (r *Rate2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Rate2) SetSign(value string) {
 	r.Sign = (*PlusOrMinusIndicator)(&value)
 }
 
+func (r *Rate2) SetSignFromBool(value bool) {
+	r.Sign = new(PlusOrMinusIndicator)
+	r.Sign.FromBool(value)
+}
+
 func (r *Rate2) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
+
+func (r *Rate2) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
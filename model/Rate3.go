@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to qualify the interest rate.
 type Rate3 struct {
 
@@ -10,6 +12,10 @@ type Rate3 struct {
 	ValidityRange *CurrencyAndAmountRange2 `xml:"VldtyRg,omitempty"`
 }
 
+func (r *Rate3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Rate3) AddType() *RateType4Choice {
 	r.Type = new(RateType4Choice)
 	return r.Type
@@ -19,3 +25,44 @@ func (r *Rate3) AddValidityRange() *CurrencyAndAmountRange2 {
 	r.ValidityRange = new(CurrencyAndAmountRange2)
 	return r.ValidityRange
 }
+
+func (r *Rate3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.Type != nil {
+		r.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if r.ValidityRange != nil {
+		r.ValidityRange.EncodeXMLFast(enc, "VldtyRg")
+	}
+	enc.End(tag)
+}
+
+func (r *Rate3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(RateType4Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Type = elem
+		case "VldtyRg":
+			elem := new(CurrencyAndAmountRange2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.ValidityRange = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Set of elements used to qualify the interest rate.
+type Rate4 struct {
+
+	// Specifies the type of interest rate.
+	Type *RateType4Choice `xml:"Tp"`
+
+	// An amount range where the interest rate is applicable.
+	ValidityRange *ActiveOrHistoricCurrencyAndAmountRange2 `xml:"VldtyRg,omitempty"`
+}
+
+func (r *Rate4) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *Rate4) AddType() *RateType4Choice {
+	r.Type = new(RateType4Choice)
+	return r.Type
+}
+
+func (r *Rate4) AddValidityRange() *ActiveOrHistoricCurrencyAndAmountRange2 {
+	r.ValidityRange = new(ActiveOrHistoricCurrencyAndAmountRange2)
+	return r.ValidityRange
+}
+
+func (r *Rate4) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.Type != nil {
+		r.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if r.ValidityRange != nil {
+		r.ValidityRange.EncodeXMLFast(enc, "VldtyRg")
+	}
+	enc.End(tag)
+}
+
+func (r *Rate4) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(RateType4Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Type = elem
+		case "VldtyRg":
+			elem := new(ActiveOrHistoricCurrencyAndAmountRange2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.ValidityRange = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format between a rate, an amount or a unspecified rate.
 type RateAndAmountFormat12Choice struct {
 
@@ -10,10 +14,23 @@ type RateAndAmountFormat12Choice struct {
 	Amount *ActiveCurrencyAnd13DecimalAmount `xml:"Amt"`
 }
 
+func (r *RateAndAmountFormat12Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateAndAmountFormat12Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateAndAmountFormat12Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateAndAmountFormat12Choice) SetAmount(value, currency string) {
 	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (r *RateAndAmountFormat12Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type RateAndAmountFormat15Choice struct {
 
@@ -10,10 +14,18 @@ type RateAndAmountFormat15Choice struct {
 	NotSpecifiedRate *RateValueType7Code `xml:"NotSpcfdRate"`
 }
 
+func (r *RateAndAmountFormat15Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateAndAmountFormat15Choice) SetAmount(value, currency string) {
 	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateAndAmountFormat15Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateAndAmountFormat15Choice) SetNotSpecifiedRate(value string) {
 	r.NotSpecifiedRate = (*RateValueType7Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format between a rate, an amount, index points or a unspecified rate.
 type RateAndAmountFormat19Choice struct {
 
@@ -17,10 +21,19 @@ type RateAndAmountFormat19Choice struct {
 	IndexPoints *DecimalNumber `xml:"IndxPts"`
 }
 
+func (r *RateAndAmountFormat19Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateAndAmountFormat19Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateAndAmountFormat19Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateAndAmountFormat19Choice) SetNotSpecifiedRate(value string) {
 	r.NotSpecifiedRate = (*RateValueType7Code)(&value)
 }
@@ -29,6 +42,15 @@ func (r *RateAndAmountFormat19Choice) SetAmount(value, currency string) {
 	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateAndAmountFormat19Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateAndAmountFormat19Choice) SetIndexPoints(value string) {
 	r.IndexPoints = (*DecimalNumber)(&value)
 }
+
+func (r *RateAndAmountFormat19Choice) SetIndexPointsFromDecimal(value decimal.Decimal) {
+	r.IndexPoints = new(DecimalNumber)
+	r.IndexPoints.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format between rate, amount and not specified.
 type RateAndAmountFormat1Choice struct {
 
@@ -13,14 +17,27 @@ type RateAndAmountFormat1Choice struct {
 	NotSpecifiedRate *RateType12FormatChoice `xml:"NotSpcfdRate"`
 }
 
+func (r *RateAndAmountFormat1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateAndAmountFormat1Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateAndAmountFormat1Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateAndAmountFormat1Choice) SetAmount(value, currency string) {
 	r.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RateAndAmountFormat1Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RateAndAmountFormat1Choice) AddNotSpecifiedRate() *RateType12FormatChoice {
 	r.NotSpecifiedRate = new(RateType12FormatChoice)
 	return r.NotSpecifiedRate
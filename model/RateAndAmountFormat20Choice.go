@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format between a rate or a rate type and rate or an amount or a unspecified rate.
 type RateAndAmountFormat20Choice struct {
 
@@ -16,10 +20,19 @@ type RateAndAmountFormat20Choice struct {
 	RateTypeAndRate *RateTypeAndPercentageRate1 `xml:"RateTpAndRate"`
 }
 
+func (r *RateAndAmountFormat20Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateAndAmountFormat20Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateAndAmountFormat20Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateAndAmountFormat20Choice) SetNotSpecifiedRate(value string) {
 	r.NotSpecifiedRate = (*RateValueType7Code)(&value)
 }
@@ -28,6 +41,10 @@ func (r *RateAndAmountFormat20Choice) SetAmount(value, currency string) {
 	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateAndAmountFormat20Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateAndAmountFormat20Choice) AddRateTypeAndRate() *RateTypeAndPercentageRate1 {
 	r.RateTypeAndRate = new(RateTypeAndPercentageRate1)
 	return r.RateTypeAndRate
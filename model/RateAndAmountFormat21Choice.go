@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format between a rate or a rate type and rate or an amount or a unspecified rate.
 type RateAndAmountFormat21Choice struct {
 
@@ -13,14 +17,27 @@ type RateAndAmountFormat21Choice struct {
 	RateTypeAndRate *RateTypeAndPercentageRate1 `xml:"RateTpAndRate"`
 }
 
+func (r *RateAndAmountFormat21Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateAndAmountFormat21Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateAndAmountFormat21Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateAndAmountFormat21Choice) SetAmount(value, currency string) {
 	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateAndAmountFormat21Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateAndAmountFormat21Choice) AddRateTypeAndRate() *RateTypeAndPercentageRate1 {
 	r.RateTypeAndRate = new(RateTypeAndPercentageRate1)
 	return r.RateTypeAndRate
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format between a rate, an amount, index points or a unspecified rate.
 type RateAndAmountFormat38Choice struct {
 
@@ -17,10 +21,19 @@ type RateAndAmountFormat38Choice struct {
 	IndexPoints *DecimalNumber `xml:"IndxPts"`
 }
 
+func (r *RateAndAmountFormat38Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateAndAmountFormat38Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateAndAmountFormat38Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateAndAmountFormat38Choice) SetNotSpecifiedRate(value string) {
 	r.NotSpecifiedRate = (*RateValueType7Code)(&value)
 }
@@ -29,6 +42,15 @@ func (r *RateAndAmountFormat38Choice) SetAmount(value, currency string) {
 	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateAndAmountFormat38Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateAndAmountFormat38Choice) SetIndexPoints(value string) {
 	r.IndexPoints = (*DecimalNumber)(&value)
 }
+
+func (r *RateAndAmountFormat38Choice) SetIndexPointsFromDecimal(value decimal.Decimal) {
+	r.IndexPoints = new(DecimalNumber)
+	r.IndexPoints.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format between a rate, an amount or a unspecified rate.
 type RateAndAmountFormat3Choice struct {
 
@@ -13,10 +17,19 @@ type RateAndAmountFormat3Choice struct {
 	Amount *ActiveCurrencyAnd13DecimalAmount `xml:"Amt"`
 }
 
+func (r *RateAndAmountFormat3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateAndAmountFormat3Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateAndAmountFormat3Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateAndAmountFormat3Choice) SetNotSpecifiedRate(value string) {
 	r.NotSpecifiedRate = (*RateValueType6Code)(&value)
 }
@@ -24,3 +37,7 @@ func (r *RateAndAmountFormat3Choice) SetNotSpecifiedRate(value string) {
 func (r *RateAndAmountFormat3Choice) SetAmount(value, currency string) {
 	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (r *RateAndAmountFormat3Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format between rate and amount.
 type RateAndAmountFormat43Choice struct {
 
@@ -10,10 +14,23 @@ type RateAndAmountFormat43Choice struct {
 	Amount *RestrictedFINActiveCurrencyAnd13DecimalAmount `xml:"Amt"`
 }
 
+func (r *RateAndAmountFormat43Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateAndAmountFormat43Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateAndAmountFormat43Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateAndAmountFormat43Choice) SetAmount(value, currency string) {
 	r.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (r *RateAndAmountFormat43Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format between a rate or a rate type and rate or an amount or a unspecified rate.
 type RateAndAmountFormat45Choice struct {
 
@@ -13,14 +17,27 @@ type RateAndAmountFormat45Choice struct {
 	RateTypeAndRate *RateTypeAndPercentageRate9 `xml:"RateTpAndRate"`
 }
 
+func (r *RateAndAmountFormat45Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateAndAmountFormat45Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateAndAmountFormat45Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateAndAmountFormat45Choice) SetAmount(value, currency string) {
 	r.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateAndAmountFormat45Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateAndAmountFormat45Choice) AddRateTypeAndRate() *RateTypeAndPercentageRate9 {
 	r.RateTypeAndRate = new(RateTypeAndPercentageRate9)
 	return r.RateTypeAndRate
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format between a rate or a rate type and rate or an amount or a unspecified rate.
 type RateAndAmountFormat47Choice struct {
 
@@ -16,10 +20,19 @@ type RateAndAmountFormat47Choice struct {
 	RateTypeAndRate *RateTypeAndPercentageRate9 `xml:"RateTpAndRate"`
 }
 
+func (r *RateAndAmountFormat47Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateAndAmountFormat47Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateAndAmountFormat47Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateAndAmountFormat47Choice) SetNotSpecifiedRate(value string) {
 	r.NotSpecifiedRate = (*RateValueType7Code)(&value)
 }
@@ -28,6 +41,10 @@ func (r *RateAndAmountFormat47Choice) SetAmount(value, currency string) {
 	r.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateAndAmountFormat47Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateAndAmountFormat47Choice) AddRateTypeAndRate() *RateTypeAndPercentageRate9 {
 	r.RateTypeAndRate = new(RateTypeAndPercentageRate9)
 	return r.RateTypeAndRate
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type RateAndAmountFormat48Choice struct {
 
@@ -10,10 +14,18 @@ type RateAndAmountFormat48Choice struct {
 	NotSpecifiedRate *RateValueType7Code `xml:"NotSpcfdRate"`
 }
 
+func (r *RateAndAmountFormat48Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateAndAmountFormat48Choice) SetAmount(value, currency string) {
 	r.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateAndAmountFormat48Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateAndAmountFormat48Choice) SetNotSpecifiedRate(value string) {
 	r.NotSpecifiedRate = (*RateValueType7Code)(&value)
 }
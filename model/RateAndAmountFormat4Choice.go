@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type RateAndAmountFormat4Choice struct {
 
@@ -10,10 +14,18 @@ type RateAndAmountFormat4Choice struct {
 	NotSpecifiedRate *RateValueType6Code `xml:"NotSpcfdRate"`
 }
 
+func (r *RateAndAmountFormat4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateAndAmountFormat4Choice) SetAmount(value, currency string) {
 	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateAndAmountFormat4Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateAndAmountFormat4Choice) SetNotSpecifiedRate(value string) {
 	r.NotSpecifiedRate = (*RateValueType6Code)(&value)
 }
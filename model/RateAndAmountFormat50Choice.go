@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format between a rate, an amount, index points or a unspecified rate.
 type RateAndAmountFormat50Choice struct {
 
@@ -17,10 +21,19 @@ type RateAndAmountFormat50Choice struct {
 	IndexPoints *RestrictedFINDecimalNumber `xml:"IndxPts"`
 }
 
+func (r *RateAndAmountFormat50Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateAndAmountFormat50Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateAndAmountFormat50Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateAndAmountFormat50Choice) SetNotSpecifiedRate(value string) {
 	r.NotSpecifiedRate = (*RateValueType7Code)(&value)
 }
@@ -29,6 +42,15 @@ func (r *RateAndAmountFormat50Choice) SetAmount(value, currency string) {
 	r.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateAndAmountFormat50Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateAndAmountFormat50Choice) SetIndexPoints(value string) {
 	r.IndexPoints = (*RestrictedFINDecimalNumber)(&value)
 }
+
+func (r *RateAndAmountFormat50Choice) SetIndexPointsFromDecimal(value decimal.Decimal) {
+	r.IndexPoints = new(RestrictedFINDecimalNumber)
+	r.IndexPoints.FromDecimal(value)
+}
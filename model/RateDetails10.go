@@ -74,6 +74,10 @@ type RateDetails10 struct {
 	EqualisationRate *RateAndAmountFormat15Choice `xml:"EqulstnRate,omitempty"`
 }
 
+func (r *RateDetails10) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateDetails10) AddAdditionalTax() *RateAndAmountFormat14Choice {
 	r.AdditionalTax = new(RateAndAmountFormat14Choice)
 	return r.AdditionalTax
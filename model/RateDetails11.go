@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the rates related to securities movement.
 type RateDetails11 struct {
 
@@ -73,6 +77,10 @@ type RateDetails11 struct {
 	EqualisationRate *ActiveCurrencyAnd13DecimalAmount `xml:"EqulstnRate,omitempty"`
 }
 
+func (r *RateDetails11) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateDetails11) AddAdditionalTax() *RateAndAmountFormat5Choice {
 	r.AdditionalTax = new(RateAndAmountFormat5Choice)
 	return r.AdditionalTax
@@ -87,10 +95,19 @@ func (r *RateDetails11) SetFinalDividendRate(value, currency string) {
 	r.FinalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateDetails11) SetFinalDividendRateFromDecimal(value decimal.Decimal, currency string) {
+	r.FinalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateDetails11) SetFiscalStamp(value string) {
 	r.FiscalStamp = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails11) SetFiscalStampFromDecimal(value decimal.Decimal) {
+	r.FiscalStamp = new(PercentageRate)
+	r.FiscalStamp.FromDecimal(value)
+}
+
 func (r *RateDetails11) AddFullyFrankedRate() *RateAndAmountFormat5Choice {
 	r.FullyFrankedRate = new(RateAndAmountFormat5Choice)
 	return r.FullyFrankedRate
@@ -553,10 +570,19 @@ func (r *RateDetails11) SetProvisionalDividendRate(value, currency string) {
 	r.ProvisionalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateDetails11) SetProvisionalDividendRateFromDecimal(value decimal.Decimal, currency string) {
+	r.ProvisionalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateDetails11) SetApplicableRate(value string) {
 	r.ApplicableRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails11) SetApplicableRateFromDecimal(value decimal.Decimal) {
+	r.ApplicableRate = new(PercentageRate)
+	r.ApplicableRate.FromDecimal(value)
+}
+
 func (r *RateDetails11) AddSolicitationFeeRate() *RateAndAmountFormat5Choice {
 	r.SolicitationFeeRate = new(RateAndAmountFormat5Choice)
 	return r.SolicitationFeeRate
@@ -858,18 +884,38 @@ func (r *RateDetails11) SetWithholdingTaxRate(value string) {
 	r.WithholdingTaxRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails11) SetWithholdingTaxRateFromDecimal(value decimal.Decimal) {
+	r.WithholdingTaxRate = new(PercentageRate)
+	r.WithholdingTaxRate.FromDecimal(value)
+}
+
 func (r *RateDetails11) SetTaxOnIncome(value string) {
 	r.TaxOnIncome = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails11) SetTaxOnIncomeFromDecimal(value decimal.Decimal) {
+	r.TaxOnIncome = new(PercentageRate)
+	r.TaxOnIncome.FromDecimal(value)
+}
+
 func (r *RateDetails11) SetTaxOnProfits(value string) {
 	r.TaxOnProfits = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails11) SetTaxOnProfitsFromDecimal(value decimal.Decimal) {
+	r.TaxOnProfits = new(PercentageRate)
+	r.TaxOnProfits.FromDecimal(value)
+}
+
 func (r *RateDetails11) SetTaxReclaimRate(value string) {
 	r.TaxReclaimRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails11) SetTaxReclaimRateFromDecimal(value decimal.Decimal) {
+	r.TaxReclaimRate = new(PercentageRate)
+	r.TaxReclaimRate.FromDecimal(value)
+}
+
 func (r *RateDetails11) AddWithholdingOfForeignTax() *RateAndAmountFormat5Choice {
 	r.WithholdingOfForeignTax = new(RateAndAmountFormat5Choice)
 	return r.WithholdingOfForeignTax
@@ -883,3 +929,7 @@ func (r *RateDetails11) AddWithholdingOfLocalTax() *RateAndAmountFormat5Choice {
 func (r *RateDetails11) SetEqualisationRate(value, currency string) {
 	r.EqualisationRate = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (r *RateDetails11) SetEqualisationRateFromDecimal(value decimal.Decimal, currency string) {
+	r.EqualisationRate = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the rates related to securities movement.
 type RateDetails15 struct {
 
@@ -73,6 +77,10 @@ type RateDetails15 struct {
 	EqualisationRate *ActiveCurrencyAnd13DecimalAmount `xml:"EqulstnRate,omitempty"`
 }
 
+func (r *RateDetails15) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateDetails15) AddAdditionalTax() *RateAndAmountFormat5Choice {
 	r.AdditionalTax = new(RateAndAmountFormat5Choice)
 	return r.AdditionalTax
@@ -87,10 +95,19 @@ func (r *RateDetails15) SetFinalDividendRate(value, currency string) {
 	r.FinalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateDetails15) SetFinalDividendRateFromDecimal(value decimal.Decimal, currency string) {
+	r.FinalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateDetails15) SetFiscalStamp(value string) {
 	r.FiscalStamp = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails15) SetFiscalStampFromDecimal(value decimal.Decimal) {
+	r.FiscalStamp = new(PercentageRate)
+	r.FiscalStamp.FromDecimal(value)
+}
+
 func (r *RateDetails15) AddFullyFrankedRate() *RateAndAmountFormat5Choice {
 	r.FullyFrankedRate = new(RateAndAmountFormat5Choice)
 	return r.FullyFrankedRate
@@ -553,10 +570,19 @@ func (r *RateDetails15) SetProvisionalDividendRate(value, currency string) {
 	r.ProvisionalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateDetails15) SetProvisionalDividendRateFromDecimal(value decimal.Decimal, currency string) {
+	r.ProvisionalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateDetails15) SetApplicableRate(value string) {
 	r.ApplicableRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails15) SetApplicableRateFromDecimal(value decimal.Decimal) {
+	r.ApplicableRate = new(PercentageRate)
+	r.ApplicableRate.FromDecimal(value)
+}
+
 func (r *RateDetails15) AddSolicitationFeeRate() *RateAndAmountFormat5Choice {
 	r.SolicitationFeeRate = new(RateAndAmountFormat5Choice)
 	return r.SolicitationFeeRate
@@ -1009,10 +1035,20 @@ func (r *RateDetails15) SetTaxOnProfits(value string) {
 	r.TaxOnProfits = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails15) SetTaxOnProfitsFromDecimal(value decimal.Decimal) {
+	r.TaxOnProfits = new(PercentageRate)
+	r.TaxOnProfits.FromDecimal(value)
+}
+
 func (r *RateDetails15) SetTaxReclaimRate(value string) {
 	r.TaxReclaimRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails15) SetTaxReclaimRateFromDecimal(value decimal.Decimal) {
+	r.TaxReclaimRate = new(PercentageRate)
+	r.TaxReclaimRate.FromDecimal(value)
+}
+
 func (r *RateDetails15) AddWithholdingOfForeignTax() *RateAndAmountFormat21Choice {
 	newValue := new(RateAndAmountFormat21Choice)
 	r.WithholdingOfForeignTax = append(r.WithholdingOfForeignTax, newValue)
@@ -1308,3 +1344,7 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (r *RateDetails15) SetEqualisationRate(value, currency string) {
 	r.EqualisationRate = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (r *RateDetails15) SetEqualisationRateFromDecimal(value decimal.Decimal, currency string) {
+	r.EqualisationRate = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the rates related to securities movement.
 type RateDetails2 struct {
 
@@ -67,6 +71,10 @@ type RateDetails2 struct {
 	WithholdingOfLocalTax *RateAndAmountFormat5Choice `xml:"WhldgOfLclTax,omitempty"`
 }
 
+func (r *RateDetails2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateDetails2) AddAdditionalTax() *RateAndAmountFormat5Choice {
 	r.AdditionalTax = new(RateAndAmountFormat5Choice)
 	return r.AdditionalTax
@@ -81,10 +89,19 @@ func (r *RateDetails2) SetFinalDividendRate(value, currency string) {
 	r.FinalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateDetails2) SetFinalDividendRateFromDecimal(value decimal.Decimal, currency string) {
+	r.FinalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateDetails2) SetFiscalStamp(value string) {
 	r.FiscalStamp = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails2) SetFiscalStampFromDecimal(value decimal.Decimal) {
+	r.FiscalStamp = new(PercentageRate)
+	r.FiscalStamp.FromDecimal(value)
+}
+
 func (r *RateDetails2) AddFullyFrankedRate() *RateAndAmountFormat5Choice {
 	r.FullyFrankedRate = new(RateAndAmountFormat5Choice)
 	return r.FullyFrankedRate
@@ -240,6 +257,11 @@ func (r *RateDetails2) SetCashIncentiveRate(value string) {
 	r.CashIncentiveRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails2) SetCashIncentiveRateFromDecimal(value decimal.Decimal) {
+	r.CashIncentiveRate = new(PercentageRate)
+	r.CashIncentiveRate.FromDecimal(value)
+}
+
 func (r *RateDetails2) AddInterestRateUsedForPayment() *InterestRateUsedForPaymentFormat2Choice {
 	newValue := new(InterestRateUsedForPaymentFormat2Choice)
 	r.InterestRateUsedForPayment = append(r.InterestRateUsedForPayment, newValue)
@@ -541,14 +563,28 @@ func (r *RateDetails2) SetProvisionalDividendRate(value, currency string) {
 	r.ProvisionalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateDetails2) SetProvisionalDividendRateFromDecimal(value decimal.Decimal, currency string) {
+	r.ProvisionalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateDetails2) SetApplicableRate(value string) {
 	r.ApplicableRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails2) SetApplicableRateFromDecimal(value decimal.Decimal) {
+	r.ApplicableRate = new(PercentageRate)
+	r.ApplicableRate.FromDecimal(value)
+}
+
 func (r *RateDetails2) SetSolicitationFeeRate(value string) {
 	r.SolicitationFeeRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails2) SetSolicitationFeeRateFromDecimal(value decimal.Decimal) {
+	r.SolicitationFeeRate = new(PercentageRate)
+	r.SolicitationFeeRate.FromDecimal(value)
+}
+
 func (r *RateDetails2) AddTaxCreditRate() *TaxCreditRateFormat2Choice {
 	newValue := new(TaxCreditRateFormat2Choice)
 	r.TaxCreditRate = append(r.TaxCreditRate, newValue)
@@ -845,18 +881,38 @@ func (r *RateDetails2) SetWithholdingTaxRate(value string) {
 	r.WithholdingTaxRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails2) SetWithholdingTaxRateFromDecimal(value decimal.Decimal) {
+	r.WithholdingTaxRate = new(PercentageRate)
+	r.WithholdingTaxRate.FromDecimal(value)
+}
+
 func (r *RateDetails2) SetTaxOnIncome(value string) {
 	r.TaxOnIncome = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails2) SetTaxOnIncomeFromDecimal(value decimal.Decimal) {
+	r.TaxOnIncome = new(PercentageRate)
+	r.TaxOnIncome.FromDecimal(value)
+}
+
 func (r *RateDetails2) SetTaxOnProfits(value string) {
 	r.TaxOnProfits = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails2) SetTaxOnProfitsFromDecimal(value decimal.Decimal) {
+	r.TaxOnProfits = new(PercentageRate)
+	r.TaxOnProfits.FromDecimal(value)
+}
+
 func (r *RateDetails2) SetTaxReclaimRate(value string) {
 	r.TaxReclaimRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails2) SetTaxReclaimRateFromDecimal(value decimal.Decimal) {
+	r.TaxReclaimRate = new(PercentageRate)
+	r.TaxReclaimRate.FromDecimal(value)
+}
+
 func (r *RateDetails2) AddWithholdingOfForeignTax() *RateAndAmountFormat5Choice {
 	r.WithholdingOfForeignTax = new(RateAndAmountFormat5Choice)
 	return r.WithholdingOfForeignTax
@@ -62,6 +62,10 @@ type RateDetails22 struct {
 	EqualisationRate *RateAndAmountFormat42Choice `xml:"EqulstnRate,omitempty"`
 }
 
+func (r *RateDetails22) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateDetails22) AddAdditionalTax() *RateAndAmountFormat37Choice {
 	r.AdditionalTax = new(RateAndAmountFormat37Choice)
 	return r.AdditionalTax
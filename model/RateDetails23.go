@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the rates related to securities movement.
 type RateDetails23 struct {
 
@@ -61,6 +65,10 @@ type RateDetails23 struct {
 	EqualisationRate *ActiveCurrencyAnd13DecimalAmount `xml:"EqulstnRate,omitempty"`
 }
 
+func (r *RateDetails23) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateDetails23) AddAdditionalTax() *RateAndAmountFormat39Choice {
 	r.AdditionalTax = new(RateAndAmountFormat39Choice)
 	return r.AdditionalTax
@@ -75,6 +83,11 @@ func (r *RateDetails23) SetFiscalStamp(value string) {
 	r.FiscalStamp = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails23) SetFiscalStampFromDecimal(value decimal.Decimal) {
+	r.FiscalStamp = new(PercentageRate)
+	r.FiscalStamp.FromDecimal(value)
+}
+
 func (r *RateDetails23) AddFullyFrankedRate() *RateAndAmountFormat39Choice {
 	r.FullyFrankedRate = new(RateAndAmountFormat39Choice)
 	return r.FullyFrankedRate
@@ -537,6 +550,11 @@ func (r *RateDetails23) SetApplicableRate(value string) {
 	r.ApplicableRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails23) SetApplicableRateFromDecimal(value decimal.Decimal) {
+	r.ApplicableRate = new(PercentageRate)
+	r.ApplicableRate.FromDecimal(value)
+}
+
 func (r *RateDetails23) AddSolicitationFeeRate() *SolicitationFeeRateFormat8Choice {
 	r.SolicitationFeeRate = new(SolicitationFeeRateFormat8Choice)
 	return r.SolicitationFeeRate
@@ -989,10 +1007,24 @@ func (r *RateDetails23) SetTaxOnProfits(value string) {
 	r.TaxOnProfits = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails23) SetTaxOnProfitsFromDecimal(value decimal.Decimal) {
+	r.TaxOnProfits = new(PercentageRate)
+	r.TaxOnProfits.FromDecimal(value)
+}
+
 func (r *RateDetails23) SetTaxReclaimRate(value string) {
 	r.TaxReclaimRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails23) SetTaxReclaimRateFromDecimal(value decimal.Decimal) {
+	r.TaxReclaimRate = new(PercentageRate)
+	r.TaxReclaimRate.FromDecimal(value)
+}
+
 func (r *RateDetails23) SetEqualisationRate(value, currency string) {
 	r.EqualisationRate = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (r *RateDetails23) SetEqualisationRateFromDecimal(value decimal.Decimal, currency string) {
+	r.EqualisationRate = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
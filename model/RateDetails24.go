@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the rates related to securities movement.
 type RateDetails24 struct {
 
@@ -61,6 +65,10 @@ type RateDetails24 struct {
 	EqualisationRate *RestrictedFINActiveCurrencyAnd13DecimalAmount `xml:"EqulstnRate,omitempty"`
 }
 
+func (r *RateDetails24) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateDetails24) AddAdditionalTax() *RateAndAmountFormat43Choice {
 	r.AdditionalTax = new(RateAndAmountFormat43Choice)
 	return r.AdditionalTax
@@ -75,6 +83,11 @@ func (r *RateDetails24) SetFiscalStamp(value string) {
 	r.FiscalStamp = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails24) SetFiscalStampFromDecimal(value decimal.Decimal) {
+	r.FiscalStamp = new(PercentageRate)
+	r.FiscalStamp.FromDecimal(value)
+}
+
 func (r *RateDetails24) AddFullyFrankedRate() *RateAndAmountFormat43Choice {
 	r.FullyFrankedRate = new(RateAndAmountFormat43Choice)
 	return r.FullyFrankedRate
@@ -537,6 +550,11 @@ func (r *RateDetails24) SetApplicableRate(value string) {
 	r.ApplicableRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails24) SetApplicableRateFromDecimal(value decimal.Decimal) {
+	r.ApplicableRate = new(PercentageRate)
+	r.ApplicableRate.FromDecimal(value)
+}
+
 func (r *RateDetails24) AddSolicitationFeeRate() *SolicitationFeeRateFormat9Choice {
 	r.SolicitationFeeRate = new(SolicitationFeeRateFormat9Choice)
 	return r.SolicitationFeeRate
@@ -989,10 +1007,24 @@ func (r *RateDetails24) SetTaxOnProfits(value string) {
 	r.TaxOnProfits = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails24) SetTaxOnProfitsFromDecimal(value decimal.Decimal) {
+	r.TaxOnProfits = new(PercentageRate)
+	r.TaxOnProfits.FromDecimal(value)
+}
+
 func (r *RateDetails24) SetTaxReclaimRate(value string) {
 	r.TaxReclaimRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails24) SetTaxReclaimRateFromDecimal(value decimal.Decimal) {
+	r.TaxReclaimRate = new(PercentageRate)
+	r.TaxReclaimRate.FromDecimal(value)
+}
+
 func (r *RateDetails24) SetEqualisationRate(value, currency string) {
 	r.EqualisationRate = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (r *RateDetails24) SetEqualisationRateFromDecimal(value decimal.Decimal, currency string) {
+	r.EqualisationRate = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -62,6 +62,10 @@ type RateDetails25 struct {
 	EqualisationRate *RateAndAmountFormat48Choice `xml:"EqulstnRate,omitempty"`
 }
 
+func (r *RateDetails25) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateDetails25) AddAdditionalTax() *RateAndAmountFormat46Choice {
 	r.AdditionalTax = new(RateAndAmountFormat46Choice)
 	return r.AdditionalTax
@@ -59,6 +59,10 @@ type RateDetails26 struct {
 	EqualisationRate *RateAndAmountFormat42Choice `xml:"EqulstnRate,omitempty"`
 }
 
+func (r *RateDetails26) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateDetails26) AddAdditionalTax() *RateAndAmountFormat37Choice {
 	r.AdditionalTax = new(RateAndAmountFormat37Choice)
 	return r.AdditionalTax
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the rates related to securities movement.
 type RateDetails27 struct {
 
@@ -58,6 +62,10 @@ type RateDetails27 struct {
 	EqualisationRate *ActiveCurrencyAnd13DecimalAmount `xml:"EqulstnRate,omitempty"`
 }
 
+func (r *RateDetails27) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateDetails27) AddAdditionalTax() *RateAndAmountFormat39Choice {
 	r.AdditionalTax = new(RateAndAmountFormat39Choice)
 	return r.AdditionalTax
@@ -72,6 +80,11 @@ func (r *RateDetails27) SetFiscalStamp(value string) {
 	r.FiscalStamp = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails27) SetFiscalStampFromDecimal(value decimal.Decimal) {
+	r.FiscalStamp = new(PercentageRate)
+	r.FiscalStamp.FromDecimal(value)
+}
+
 func (r *RateDetails27) AddGrossDividendRate() *GrossDividendRateFormat22Choice {
 	newValue := new(GrossDividendRateFormat22Choice)
 	r.GrossDividendRate = append(r.GrossDividendRate, newValue)
@@ -529,6 +542,11 @@ func (r *RateDetails27) SetApplicableRate(value string) {
 	r.ApplicableRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails27) SetApplicableRateFromDecimal(value decimal.Decimal) {
+	r.ApplicableRate = new(PercentageRate)
+	r.ApplicableRate.FromDecimal(value)
+}
+
 func (r *RateDetails27) AddSolicitationFeeRate() *SolicitationFeeRateFormat8Choice {
 	r.SolicitationFeeRate = new(SolicitationFeeRateFormat8Choice)
 	return r.SolicitationFeeRate
@@ -981,10 +999,24 @@ func (r *RateDetails27) SetTaxOnProfits(value string) {
 	r.TaxOnProfits = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails27) SetTaxOnProfitsFromDecimal(value decimal.Decimal) {
+	r.TaxOnProfits = new(PercentageRate)
+	r.TaxOnProfits.FromDecimal(value)
+}
+
 func (r *RateDetails27) SetTaxReclaimRate(value string) {
 	r.TaxReclaimRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails27) SetTaxReclaimRateFromDecimal(value decimal.Decimal) {
+	r.TaxReclaimRate = new(PercentageRate)
+	r.TaxReclaimRate.FromDecimal(value)
+}
+
 func (r *RateDetails27) SetEqualisationRate(value, currency string) {
 	r.EqualisationRate = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (r *RateDetails27) SetEqualisationRateFromDecimal(value decimal.Decimal, currency string) {
+	r.EqualisationRate = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
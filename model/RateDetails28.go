@@ -59,6 +59,10 @@ type RateDetails28 struct {
 	EqualisationRate *RateAndAmountFormat48Choice `xml:"EqulstnRate,omitempty"`
 }
 
+func (r *RateDetails28) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateDetails28) AddAdditionalTax() *RateAndAmountFormat46Choice {
 	r.AdditionalTax = new(RateAndAmountFormat46Choice)
 	return r.AdditionalTax
@@ -71,6 +71,10 @@ type RateDetails3 struct {
 	WithholdingOfLocalTax *RateAndAmountFormat14Choice `xml:"WhldgOfLclTax,omitempty"`
 }
 
+func (r *RateDetails3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateDetails3) AddAdditionalTax() *RateAndAmountFormat14Choice {
 	r.AdditionalTax = new(RateAndAmountFormat14Choice)
 	return r.AdditionalTax
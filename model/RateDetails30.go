@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the rates related to securities movement.
 type RateDetails30 struct {
 
@@ -58,6 +62,10 @@ type RateDetails30 struct {
 	EqualisationRate *RestrictedFINActiveCurrencyAnd13DecimalAmount `xml:"EqulstnRate,omitempty"`
 }
 
+func (r *RateDetails30) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateDetails30) AddAdditionalTax() *RateAndAmountFormat43Choice {
 	r.AdditionalTax = new(RateAndAmountFormat43Choice)
 	return r.AdditionalTax
@@ -72,6 +80,11 @@ func (r *RateDetails30) SetFiscalStamp(value string) {
 	r.FiscalStamp = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails30) SetFiscalStampFromDecimal(value decimal.Decimal) {
+	r.FiscalStamp = new(PercentageRate)
+	r.FiscalStamp.FromDecimal(value)
+}
+
 func (r *RateDetails30) AddGrossDividendRate() *GrossDividendRateFormat24Choice {
 	newValue := new(GrossDividendRateFormat24Choice)
 	r.GrossDividendRate = append(r.GrossDividendRate, newValue)
@@ -529,6 +542,11 @@ func (r *RateDetails30) SetApplicableRate(value string) {
 	r.ApplicableRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails30) SetApplicableRateFromDecimal(value decimal.Decimal) {
+	r.ApplicableRate = new(PercentageRate)
+	r.ApplicableRate.FromDecimal(value)
+}
+
 func (r *RateDetails30) AddSolicitationFeeRate() *SolicitationFeeRateFormat9Choice {
 	r.SolicitationFeeRate = new(SolicitationFeeRateFormat9Choice)
 	return r.SolicitationFeeRate
@@ -981,10 +999,24 @@ func (r *RateDetails30) SetTaxOnProfits(value string) {
 	r.TaxOnProfits = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails30) SetTaxOnProfitsFromDecimal(value decimal.Decimal) {
+	r.TaxOnProfits = new(PercentageRate)
+	r.TaxOnProfits.FromDecimal(value)
+}
+
 func (r *RateDetails30) SetTaxReclaimRate(value string) {
 	r.TaxReclaimRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails30) SetTaxReclaimRateFromDecimal(value decimal.Decimal) {
+	r.TaxReclaimRate = new(PercentageRate)
+	r.TaxReclaimRate.FromDecimal(value)
+}
+
 func (r *RateDetails30) SetEqualisationRate(value, currency string) {
 	r.EqualisationRate = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (r *RateDetails30) SetEqualisationRateFromDecimal(value decimal.Decimal, currency string) {
+	r.EqualisationRate = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the rates related to securities movement.
 type RateDetails7 struct {
 
@@ -70,6 +74,10 @@ type RateDetails7 struct {
 	WithholdingOfLocalTax *RateAndAmountFormat5Choice `xml:"WhldgOfLclTax,omitempty"`
 }
 
+func (r *RateDetails7) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateDetails7) AddAdditionalTax() *RateAndAmountFormat5Choice {
 	r.AdditionalTax = new(RateAndAmountFormat5Choice)
 	return r.AdditionalTax
@@ -84,10 +92,19 @@ func (r *RateDetails7) SetFinalDividendRate(value, currency string) {
 	r.FinalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateDetails7) SetFinalDividendRateFromDecimal(value decimal.Decimal, currency string) {
+	r.FinalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateDetails7) SetFiscalStamp(value string) {
 	r.FiscalStamp = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails7) SetFiscalStampFromDecimal(value decimal.Decimal) {
+	r.FiscalStamp = new(PercentageRate)
+	r.FiscalStamp.FromDecimal(value)
+}
+
 func (r *RateDetails7) AddFullyFrankedRate() *RateAndAmountFormat5Choice {
 	r.FullyFrankedRate = new(RateAndAmountFormat5Choice)
 	return r.FullyFrankedRate
@@ -243,10 +260,20 @@ func (r *RateDetails7) SetEarlySolicitationFeeRate(value string) {
 	r.EarlySolicitationFeeRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails7) SetEarlySolicitationFeeRateFromDecimal(value decimal.Decimal) {
+	r.EarlySolicitationFeeRate = new(PercentageRate)
+	r.EarlySolicitationFeeRate.FromDecimal(value)
+}
+
 func (r *RateDetails7) SetCashIncentiveRate(value string) {
 	r.CashIncentiveRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails7) SetCashIncentiveRateFromDecimal(value decimal.Decimal) {
+	r.CashIncentiveRate = new(PercentageRate)
+	r.CashIncentiveRate.FromDecimal(value)
+}
+
 func (r *RateDetails7) AddInterestRateUsedForPayment() *InterestRateUsedForPaymentFormat2Choice {
 	newValue := new(InterestRateUsedForPaymentFormat2Choice)
 	r.InterestRateUsedForPayment = append(r.InterestRateUsedForPayment, newValue)
@@ -548,14 +575,28 @@ func (r *RateDetails7) SetProvisionalDividendRate(value, currency string) {
 	r.ProvisionalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateDetails7) SetProvisionalDividendRateFromDecimal(value decimal.Decimal, currency string) {
+	r.ProvisionalDividendRate = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateDetails7) SetApplicableRate(value string) {
 	r.ApplicableRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails7) SetApplicableRateFromDecimal(value decimal.Decimal) {
+	r.ApplicableRate = new(PercentageRate)
+	r.ApplicableRate.FromDecimal(value)
+}
+
 func (r *RateDetails7) SetSolicitationFeeRate(value string) {
 	r.SolicitationFeeRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails7) SetSolicitationFeeRateFromDecimal(value decimal.Decimal) {
+	r.SolicitationFeeRate = new(PercentageRate)
+	r.SolicitationFeeRate.FromDecimal(value)
+}
+
 func (r *RateDetails7) AddTaxCreditRate() *TaxCreditRateFormat2Choice {
 	newValue := new(TaxCreditRateFormat2Choice)
 	r.TaxCreditRate = append(r.TaxCreditRate, newValue)
@@ -852,18 +893,38 @@ func (r *RateDetails7) SetWithholdingTaxRate(value string) {
 	r.WithholdingTaxRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails7) SetWithholdingTaxRateFromDecimal(value decimal.Decimal) {
+	r.WithholdingTaxRate = new(PercentageRate)
+	r.WithholdingTaxRate.FromDecimal(value)
+}
+
 func (r *RateDetails7) SetTaxOnIncome(value string) {
 	r.TaxOnIncome = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails7) SetTaxOnIncomeFromDecimal(value decimal.Decimal) {
+	r.TaxOnIncome = new(PercentageRate)
+	r.TaxOnIncome.FromDecimal(value)
+}
+
 func (r *RateDetails7) SetTaxOnProfits(value string) {
 	r.TaxOnProfits = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails7) SetTaxOnProfitsFromDecimal(value decimal.Decimal) {
+	r.TaxOnProfits = new(PercentageRate)
+	r.TaxOnProfits.FromDecimal(value)
+}
+
 func (r *RateDetails7) SetTaxReclaimRate(value string) {
 	r.TaxReclaimRate = (*PercentageRate)(&value)
 }
 
+func (r *RateDetails7) SetTaxReclaimRateFromDecimal(value decimal.Decimal) {
+	r.TaxReclaimRate = new(PercentageRate)
+	r.TaxReclaimRate.FromDecimal(value)
+}
+
 func (r *RateDetails7) AddWithholdingOfForeignTax() *RateAndAmountFormat5Choice {
 	r.WithholdingOfForeignTax = new(RateAndAmountFormat5Choice)
 	return r.WithholdingOfForeignTax
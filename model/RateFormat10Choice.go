@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a rate or a rate type and rate or an unspecified rate.
 type RateFormat10Choice struct {
 
@@ -13,10 +17,19 @@ type RateFormat10Choice struct {
 	RateTypeAndRate *RateTypeAndPercentageRate1 `xml:"RateTpAndRate"`
 }
 
+func (r *RateFormat10Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateFormat10Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateFormat10Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateFormat10Choice) SetNotSpecifiedRate(value string) {
 	r.NotSpecifiedRate = (*RateValueType7Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a rate or a rate type and rate or an unspecified rate.
 type RateFormat11Choice struct {
 
@@ -10,10 +14,19 @@ type RateFormat11Choice struct {
 	RateTypeAndRate *RateTypeAndPercentageRate1 `xml:"RateTpAndRate"`
 }
 
+func (r *RateFormat11Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateFormat11Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateFormat11Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateFormat11Choice) AddRateTypeAndRate() *RateTypeAndPercentageRate1 {
 	r.RateTypeAndRate = new(RateTypeAndPercentageRate1)
 	return r.RateTypeAndRate
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a rate or an unspecified rate.
 type RateFormat12Choice struct {
 
@@ -10,10 +14,19 @@ type RateFormat12Choice struct {
 	NotSpecifiedRate *RateType5Code `xml:"NotSpcfdRate"`
 }
 
+func (r *RateFormat12Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateFormat12Choice) SetRate(value string) {
 	r.Rate = (*BaseOne14Rate)(&value)
 }
 
+func (r *RateFormat12Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(BaseOne14Rate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateFormat12Choice) SetNotSpecifiedRate(value string) {
 	r.NotSpecifiedRate = (*RateType5Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice of format to expressed a rate.
 type RateFormat1Choice struct {
 
@@ -10,10 +14,19 @@ type RateFormat1Choice struct {
 	NotSpecifiedRate *RateType12FormatChoice `xml:"NotSpcfdRate"`
 }
 
+func (r *RateFormat1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateFormat1Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateFormat1Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateFormat1Choice) AddNotSpecifiedRate() *RateType12FormatChoice {
 	r.NotSpecifiedRate = new(RateType12FormatChoice)
 	return r.NotSpecifiedRate
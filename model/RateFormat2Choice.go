@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a rate or an unspecified rate.
 type RateFormat2Choice struct {
 
@@ -10,10 +14,19 @@ type RateFormat2Choice struct {
 	NotSpecifiedRate *RateValueType6Code `xml:"NotSpcfdRate"`
 }
 
+func (r *RateFormat2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateFormat2Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateFormat2Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateFormat2Choice) SetNotSpecifiedRate(value string) {
 	r.NotSpecifiedRate = (*RateValueType6Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a rate or an unspecified rate.
 type RateFormat3Choice struct {
 
@@ -10,10 +14,19 @@ type RateFormat3Choice struct {
 	NotSpecifiedRate *RateType5Code `xml:"NotSpcfdRate"`
 }
 
+func (r *RateFormat3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateFormat3Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateFormat3Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateFormat3Choice) SetNotSpecifiedRate(value string) {
 	r.NotSpecifiedRate = (*RateType5Code)(&value)
 }
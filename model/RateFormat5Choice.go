@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a rate or an unspecified rate.
 type RateFormat5Choice struct {
 
@@ -10,10 +14,19 @@ type RateFormat5Choice struct {
 	NotSpecifiedRate *RateType9Code `xml:"NotSpcfdRate"`
 }
 
+func (r *RateFormat5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateFormat5Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateFormat5Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateFormat5Choice) SetNotSpecifiedRate(value string) {
 	r.NotSpecifiedRate = (*RateType9Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a rate or an unspecified rate.
 type RateFormat7Choice struct {
 
@@ -10,10 +14,19 @@ type RateFormat7Choice struct {
 	NotSpecifiedRate *RateType10Code `xml:"NotSpcfdRate"`
 }
 
+func (r *RateFormat7Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateFormat7Choice) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
 
+func (r *RateFormat7Choice) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
+
 func (r *RateFormat7Choice) SetNotSpecifiedRate(value string) {
 	r.NotSpecifiedRate = (*RateType10Code)(&value)
 }
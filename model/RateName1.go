@@ -10,6 +10,10 @@ type RateName1 struct {
 	RateName *Max35Text `xml:"RateNm"`
 }
 
+func (r *RateName1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateName1) SetIssuer(value string) {
 	r.Issuer = (*Max8Text)(&value)
 }
@@ -10,6 +10,10 @@ type RateName2 struct {
 	RateName *RestrictedFINXMax24Text `xml:"RateNm"`
 }
 
+func (r *RateName2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateName2) SetIssuer(value string) {
 	r.Issuer = (*RestrictedFINXMax8Text)(&value)
 }
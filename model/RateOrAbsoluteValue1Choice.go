@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a rate or an absolute value.
 type RateOrAbsoluteValue1Choice struct {
 
@@ -10,10 +14,24 @@ type RateOrAbsoluteValue1Choice struct {
 	AbsoluteValue *Number `xml:"AbsVal"`
 }
 
+func (r *RateOrAbsoluteValue1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateOrAbsoluteValue1Choice) SetRateValue(value string) {
 	r.RateValue = (*PercentageRate)(&value)
 }
 
+func (r *RateOrAbsoluteValue1Choice) SetRateValueFromDecimal(value decimal.Decimal) {
+	r.RateValue = new(PercentageRate)
+	r.RateValue.FromDecimal(value)
+}
+
 func (r *RateOrAbsoluteValue1Choice) SetAbsoluteValue(value string) {
 	r.AbsoluteValue = (*Number)(&value)
 }
+
+func (r *RateOrAbsoluteValue1Choice) SetAbsoluteValueFromDecimal(value decimal.Decimal) {
+	r.AbsoluteValue = new(Number)
+	r.AbsoluteValue.FromDecimal(value)
+}
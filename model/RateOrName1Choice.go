@@ -10,6 +10,10 @@ type RateOrName1Choice struct {
 	RateName *RateName1 `xml:"RateNm"`
 }
 
+func (r *RateOrName1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateOrName1Choice) AddRate() *Rate2 {
 	r.Rate = new(Rate2)
 	return r.Rate
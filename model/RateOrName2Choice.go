@@ -10,6 +10,10 @@ type RateOrName2Choice struct {
 	RateName *RateName2 `xml:"RateNm"`
 }
 
+func (r *RateOrName2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateOrName2Choice) AddRate() *Rate2 {
 	r.Rate = new(Rate2)
 	return r.Rate
@@ -10,6 +10,10 @@ type RateStatus1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RateStatus1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateStatus1Choice) SetCode(value string) {
 	r.Code = (*RateStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateStatus3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RateStatus3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateStatus3Choice) SetCode(value string) {
 	r.Code = (*RateStatus1Code)(&value)
 }
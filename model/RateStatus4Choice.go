@@ -10,6 +10,10 @@ type RateStatus4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RateStatus4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateStatus4Choice) SetCode(value string) {
 	r.Code = (*RateStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType10Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RateType10Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType10Choice) SetCode(value string) {
 	r.Code = (*RateType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType11Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RateType11Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType11Choice) SetCode(value string) {
 	r.Code = (*TaxType4Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType12FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RateType12FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType12FormatChoice) SetCode(value string) {
 	r.Code = (*RateType12Code)(&value)
 }
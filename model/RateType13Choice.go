@@ -10,6 +10,10 @@ type RateType13Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RateType13Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType13Choice) SetCode(value string) {
 	r.Code = (*GrossDividendRateType1Code)(&value)
 }
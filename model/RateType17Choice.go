@@ -10,6 +10,10 @@ type RateType17Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RateType17Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType17Choice) SetCode(value string) {
 	r.Code = (*DividendRateType1Code)(&value)
 }
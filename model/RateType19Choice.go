@@ -10,6 +10,10 @@ type RateType19Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (r *RateType19Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType19Choice) SetCode(value string) {
 	r.Code = (*RateType1Code)(&value)
 }
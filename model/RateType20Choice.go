@@ -10,6 +10,10 @@ type RateType20Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RateType20Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType20Choice) SetCode(value string) {
 	r.Code = (*GrossDividendRateType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType21Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RateType21Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType21Choice) SetCode(value string) {
 	r.Code = (*NetDividendRateType2Code)(&value)
 }
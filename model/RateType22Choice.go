@@ -10,6 +10,10 @@ type RateType22Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RateType22Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType22Choice) SetCode(value string) {
 	r.Code = (*GrossDividendRateType3Code)(&value)
 }
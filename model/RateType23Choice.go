@@ -10,6 +10,10 @@ type RateType23Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RateType23Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType23Choice) SetCode(value string) {
 	r.Code = (*NetDividendRateType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType28Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RateType28Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType28Choice) SetCode(value string) {
 	r.Code = (*WithholdingTaxRateType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType33Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RateType33Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType33Choice) SetCode(value string) {
 	r.Code = (*RateType7Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType35Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RateType35Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType35Choice) SetCode(value string) {
 	r.Code = (*RateType1Code)(&value)
 }
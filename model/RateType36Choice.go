@@ -10,6 +10,10 @@ type RateType36Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RateType36Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType36Choice) SetCode(value string) {
 	r.Code = (*DividendRateType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType37Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RateType37Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType37Choice) SetCode(value string) {
 	r.Code = (*RateType3Code)(&value)
 }
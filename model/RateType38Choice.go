@@ -10,6 +10,10 @@ type RateType38Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RateType38Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType38Choice) SetCode(value string) {
 	r.Code = (*GrossDividendRateType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType39Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RateType39Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType39Choice) SetCode(value string) {
 	r.Code = (*NetDividendRateType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType40Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RateType40Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType40Choice) SetCode(value string) {
 	r.Code = (*GrossDividendRateType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType41Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RateType41Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType41Choice) SetCode(value string) {
 	r.Code = (*NetDividendRateType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType42Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RateType42Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType42Choice) SetCode(value string) {
 	r.Code = (*WithholdingTaxRateType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType44Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RateType44Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType44Choice) SetCode(value string) {
 	r.Code = (*NetDividendRateType3Code)(&value)
 }
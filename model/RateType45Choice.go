@@ -10,6 +10,10 @@ type RateType45Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RateType45Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType45Choice) SetCode(value string) {
 	r.Code = (*RateType7Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType46Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RateType46Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType46Choice) SetCode(value string) {
 	r.Code = (*WithholdingTaxRateType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType47Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RateType47Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType47Choice) SetCode(value string) {
 	r.Code = (*DividendRateType1Code)(&value)
 }
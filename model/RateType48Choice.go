@@ -10,6 +10,10 @@ type RateType48Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RateType48Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType48Choice) SetCode(value string) {
 	r.Code = (*RateType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType49Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RateType49Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType49Choice) SetCode(value string) {
 	r.Code = (*GrossDividendRateType2Code)(&value)
 }
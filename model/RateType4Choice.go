@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Specifies the rate as a percentage or a text.
 type RateType4Choice struct {
 
@@ -10,10 +15,60 @@ type RateType4Choice struct {
 	Other *Max35Text `xml:"Othr"`
 }
 
+func (r *RateType4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType4Choice) SetPercentage(value string) {
 	r.Percentage = (*PercentageRate)(&value)
 }
 
+func (r *RateType4Choice) SetPercentageFromDecimal(value decimal.Decimal) {
+	r.Percentage = new(PercentageRate)
+	r.Percentage.FromDecimal(value)
+}
+
 func (r *RateType4Choice) SetOther(value string) {
 	r.Other = (*Max35Text)(&value)
 }
+
+func (r *RateType4Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.Percentage != nil {
+		enc.Element("Pctg", string(*r.Percentage))
+	}
+	if r.Other != nil {
+		enc.Element("Othr", string(*r.Other))
+	}
+	enc.End(tag)
+}
+
+func (r *RateType4Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Pctg":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Percentage = (*PercentageRate)(&value)
+		case "Othr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Other = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ type RateType50Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RateType50Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType50Choice) SetCode(value string) {
 	r.Code = (*NetDividendRateType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType51Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RateType51Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType51Choice) SetCode(value string) {
 	r.Code = (*GrossDividendRateType3Code)(&value)
 }
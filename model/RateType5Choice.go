@@ -10,6 +10,10 @@ type RateType5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RateType5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType5Choice) SetCode(value string) {
 	r.Code = (*RateType1Code)(&value)
 }
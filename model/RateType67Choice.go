@@ -10,6 +10,10 @@ type RateType67Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RateType67Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType67Choice) SetCode(value string) {
 	r.Code = (*RateType1Code)(&value)
 }
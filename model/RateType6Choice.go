@@ -10,6 +10,10 @@ type RateType6Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RateType6Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType6Choice) SetCode(value string) {
 	r.Code = (*RateType7Code)(&value)
 }
@@ -10,6 +10,10 @@ type RateType7Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RateType7Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateType7Choice) SetCode(value string) {
 	r.Code = (*NetDividendRateType1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the value expressed as a rate and an amount.
 type RateTypeAndAmountAndStatus1 struct {
 
@@ -13,6 +17,10 @@ type RateTypeAndAmountAndStatus1 struct {
 	RateStatus *RateStatus1Choice `xml:"RateSts,omitempty"`
 }
 
+func (r *RateTypeAndAmountAndStatus1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateTypeAndAmountAndStatus1) AddRateType() *RateType13Choice {
 	r.RateType = new(RateType13Choice)
 	return r.RateType
@@ -22,6 +30,10 @@ func (r *RateTypeAndAmountAndStatus1) SetAmount(value, currency string) {
 	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateTypeAndAmountAndStatus1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateTypeAndAmountAndStatus1) AddRateStatus() *RateStatus1Choice {
 	r.RateStatus = new(RateStatus1Choice)
 	return r.RateStatus
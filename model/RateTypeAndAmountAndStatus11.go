@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the value expressed as a rate and an amount.
 type RateTypeAndAmountAndStatus11 struct {
 
@@ -13,6 +17,10 @@ type RateTypeAndAmountAndStatus11 struct {
 	RateStatus *RateStatus1Choice `xml:"RateSts,omitempty"`
 }
 
+func (r *RateTypeAndAmountAndStatus11) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateTypeAndAmountAndStatus11) AddRateType() *RateType17Choice {
 	r.RateType = new(RateType17Choice)
 	return r.RateType
@@ -22,6 +30,10 @@ func (r *RateTypeAndAmountAndStatus11) SetAmount(value, currency string) {
 	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateTypeAndAmountAndStatus11) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateTypeAndAmountAndStatus11) AddRateStatus() *RateStatus1Choice {
 	r.RateStatus = new(RateStatus1Choice)
 	return r.RateStatus
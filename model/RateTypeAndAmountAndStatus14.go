@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the value expressed as a rate and an amount.
 type RateTypeAndAmountAndStatus14 struct {
 
@@ -13,6 +17,10 @@ type RateTypeAndAmountAndStatus14 struct {
 	RateStatus *RateStatus1Choice `xml:"RateSts,omitempty"`
 }
 
+func (r *RateTypeAndAmountAndStatus14) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateTypeAndAmountAndStatus14) AddRateType() *RateType21Choice {
 	r.RateType = new(RateType21Choice)
 	return r.RateType
@@ -22,6 +30,10 @@ func (r *RateTypeAndAmountAndStatus14) SetAmount(value, currency string) {
 	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (r *RateTypeAndAmountAndStatus14) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (r *RateTypeAndAmountAndStatus14) AddRateStatus() *RateStatus1Choice {
 	r.RateStatus = new(RateStatus1Choice)
 	return r.RateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the value expressed as a rate type and a percentage rate.
 type RateTypeAndPercentageRate1 struct {
 
@@ -10,6 +14,10 @@ type RateTypeAndPercentageRate1 struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (r *RateTypeAndPercentageRate1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateTypeAndPercentageRate1) AddRateType() *RateType28Choice {
 	r.RateType = new(RateType28Choice)
 	return r.RateType
@@ -18,3 +26,8 @@ func (r *RateTypeAndPercentageRate1) AddRateType() *RateType28Choice {
 func (r *RateTypeAndPercentageRate1) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
+
+func (r *RateTypeAndPercentageRate1) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
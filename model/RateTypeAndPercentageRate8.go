@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the value expressed as a rate type and a percentage rate.
 type RateTypeAndPercentageRate8 struct {
 
@@ -10,6 +14,10 @@ type RateTypeAndPercentageRate8 struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (r *RateTypeAndPercentageRate8) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateTypeAndPercentageRate8) AddRateType() *RateType42Choice {
 	r.RateType = new(RateType42Choice)
 	return r.RateType
@@ -18,3 +26,8 @@ func (r *RateTypeAndPercentageRate8) AddRateType() *RateType42Choice {
 func (r *RateTypeAndPercentageRate8) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
+
+func (r *RateTypeAndPercentageRate8) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the value expressed as a rate type and a percentage rate.
 type RateTypeAndPercentageRate9 struct {
 
@@ -10,6 +14,10 @@ type RateTypeAndPercentageRate9 struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (r *RateTypeAndPercentageRate9) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateTypeAndPercentageRate9) AddRateType() *RateType46Choice {
 	r.RateType = new(RateType46Choice)
 	return r.RateType
@@ -18,3 +26,8 @@ func (r *RateTypeAndPercentageRate9) AddRateType() *RateType46Choice {
 func (r *RateTypeAndPercentageRate9) SetRate(value string) {
 	r.Rate = (*PercentageRate)(&value)
 }
+
+func (r *RateTypeAndPercentageRate9) SetRateFromDecimal(value decimal.Decimal) {
+	r.Rate = new(PercentageRate)
+	r.Rate.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Rate is expressed as a percentage or a text.
 type RateTypeChoice struct {
 
@@ -10,10 +14,19 @@ type RateTypeChoice struct {
 	TextualRate *Max35Text `xml:"TxtlRate"`
 }
 
+func (r *RateTypeChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateTypeChoice) SetPercentageRate(value string) {
 	r.PercentageRate = (*PercentageRate)(&value)
 }
 
+func (r *RateTypeChoice) SetPercentageRateFromDecimal(value decimal.Decimal) {
+	r.PercentageRate = new(PercentageRate)
+	r.PercentageRate.FromDecimal(value)
+}
+
 func (r *RateTypeChoice) SetTextualRate(value string) {
 	r.TextualRate = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type RateValueType2FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RateValueType2FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateValueType2FormatChoice) SetCode(value string) {
 	r.Code = (*RateValueType2Code)(&value)
 }
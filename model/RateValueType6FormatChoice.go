@@ -10,6 +10,10 @@ type RateValueType6FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RateValueType6FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RateValueType6FormatChoice) SetCode(value string) {
 	r.Code = (*RateValueType6Code)(&value)
 }
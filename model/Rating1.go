@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Assessment of securities credit and investment risk.
 type Rating1 struct {
 
@@ -13,6 +17,10 @@ type Rating1 struct {
 	ValueIdentification *RatingValueIdentifier `xml:"ValId"`
 }
 
+func (r *Rating1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Rating1) SetRatingScheme(value string) {
 	r.RatingScheme = (*Max35Text)(&value)
 }
@@ -21,6 +29,11 @@ func (r *Rating1) SetValueDate(value string) {
 	r.ValueDate = (*ISODateTime)(&value)
 }
 
+func (r *Rating1) SetValueDateFromTime(value time.Time) {
+	r.ValueDate = new(ISODateTime)
+	r.ValueDate.FromTime(value)
+}
+
 func (r *Rating1) SetValueIdentification(value string) {
 	r.ValueIdentification = (*RatingValueIdentifier)(&value)
 }
@@ -13,6 +13,10 @@ type RatioFormat11Choice struct {
 	AmountToAmount *AmountToAmountRatio2 `xml:"AmtToAmt"`
 }
 
+func (r *RatioFormat11Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat11Choice) AddQuantityToQuantity() *QuantityToQuantityRatio1 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio1)
 	return r.QuantityToQuantity
@@ -19,6 +19,10 @@ type RatioFormat12Choice struct {
 	QuantityToAmount *AmountAndQuantityRatio2 `xml:"QtyToAmt"`
 }
 
+func (r *RatioFormat12Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat12Choice) AddQuantityToQuantity() *QuantityToQuantityRatio1 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio1)
 	return r.QuantityToQuantity
@@ -13,6 +13,10 @@ type RatioFormat15Choice struct {
 	QuantityToAmount *AmountAndQuantityRatio2 `xml:"QtyToAmt"`
 }
 
+func (r *RatioFormat15Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat15Choice) AddQuantityToQuantity() *QuantityToQuantityRatio1 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio1)
 	return r.QuantityToQuantity
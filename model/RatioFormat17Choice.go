@@ -13,6 +13,10 @@ type RatioFormat17Choice struct {
 	AmountToAmount *AmountToAmountRatio2 `xml:"AmtToAmt"`
 }
 
+func (r *RatioFormat17Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat17Choice) AddQuantityToQuantity() *QuantityToQuantityRatio1 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio1)
 	return r.QuantityToQuantity
@@ -19,6 +19,10 @@ type RatioFormat18Choice struct {
 	QuantityToAmount *AmountAndQuantityRatio4 `xml:"QtyToAmt"`
 }
 
+func (r *RatioFormat18Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat18Choice) AddQuantityToQuantity() *QuantityToQuantityRatio1 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio1)
 	return r.QuantityToQuantity
@@ -16,6 +16,10 @@ type RatioFormat19Choice struct {
 	QuantityToAmount *AmountAndQuantityRatio4 `xml:"QtyToAmt"`
 }
 
+func (r *RatioFormat19Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat19Choice) AddQuantityToQuantity() *QuantityToQuantityRatio1 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio1)
 	return r.QuantityToQuantity
@@ -13,6 +13,10 @@ type RatioFormat1Choice struct {
 	NotSpecifiedRate *RateType12FormatChoice `xml:"NotSpcfdRate"`
 }
 
+func (r *RatioFormat1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat1Choice) AddQuantityToQuantity() *QuantityToQuantityRatio1 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio1)
 	return r.QuantityToQuantity
@@ -10,6 +10,10 @@ type RatioFormat20Choice struct {
 	AmountToAmount *AmountToAmountRatio2 `xml:"AmtToAmt"`
 }
 
+func (r *RatioFormat20Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat20Choice) AddQuantityToQuantity() *QuantityToQuantityRatio1 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio1)
 	return r.QuantityToQuantity
@@ -10,6 +10,10 @@ type RatioFormat21Choice struct {
 	AmountToAmount *AmountToAmountRatio3 `xml:"AmtToAmt"`
 }
 
+func (r *RatioFormat21Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat21Choice) AddQuantityToQuantity() *QuantityToQuantityRatio2 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio2)
 	return r.QuantityToQuantity
@@ -16,6 +16,10 @@ type RatioFormat22Choice struct {
 	QuantityToAmount *AmountAndQuantityRatio5 `xml:"QtyToAmt"`
 }
 
+func (r *RatioFormat22Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat22Choice) AddQuantityToQuantity() *QuantityToQuantityRatio2 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio2)
 	return r.QuantityToQuantity
@@ -13,6 +13,10 @@ type RatioFormat23Choice struct {
 	AmountToAmount *AmountToAmountRatio3 `xml:"AmtToAmt"`
 }
 
+func (r *RatioFormat23Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat23Choice) AddQuantityToQuantity() *QuantityToQuantityRatio2 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio2)
 	return r.QuantityToQuantity
@@ -19,6 +19,10 @@ type RatioFormat24Choice struct {
 	QuantityToAmount *AmountAndQuantityRatio5 `xml:"QtyToAmt"`
 }
 
+func (r *RatioFormat24Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat24Choice) AddQuantityToQuantity() *QuantityToQuantityRatio2 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio2)
 	return r.QuantityToQuantity
@@ -19,6 +19,10 @@ type RatioFormat2Choice struct {
 	NotSpecifiedRate *RateType12FormatChoice `xml:"NotSpcfdRate"`
 }
 
+func (r *RatioFormat2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat2Choice) AddQuantityToQuantity() *QuantityToQuantityRatio1 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio1)
 	return r.QuantityToQuantity
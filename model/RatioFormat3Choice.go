@@ -10,6 +10,10 @@ type RatioFormat3Choice struct {
 	AmountToAmount *AmountToAmountRatio2 `xml:"AmtToAmt"`
 }
 
+func (r *RatioFormat3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat3Choice) AddQuantityToQuantity() *QuantityToQuantityRatio1 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio1)
 	return r.QuantityToQuantity
@@ -16,6 +16,10 @@ type RatioFormat4Choice struct {
 	QuantityToAmount *AmountAndQuantityRatio2 `xml:"QtyToAmt"`
 }
 
+func (r *RatioFormat4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat4Choice) AddQuantityToQuantity() *QuantityToQuantityRatio1 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio1)
 	return r.QuantityToQuantity
@@ -13,6 +13,10 @@ type RatioFormat5Choice struct {
 	AmountToAmount *AmountToAmountRatio2 `xml:"AmtToAmt"`
 }
 
+func (r *RatioFormat5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat5Choice) AddQuantityToQuantity() *QuantityToQuantityRatio1 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio1)
 	return r.QuantityToQuantity
@@ -19,6 +19,10 @@ type RatioFormat6Choice struct {
 	QuantityToAmount *AmountAndQuantityRatio2 `xml:"QtyToAmt"`
 }
 
+func (r *RatioFormat6Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RatioFormat6Choice) AddQuantityToQuantity() *QuantityToQuantityRatio1 {
 	r.QuantityToQuantity = new(QuantityToQuantityRatio1)
 	return r.QuantityToQuantity
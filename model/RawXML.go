@@ -0,0 +1,198 @@
+package model
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// embedXML returns the XML encoding of v, to be kept as the raw content of an
+// element whose content is not constrained by the schema of the message.
+func embedXML(v interface{}) (string, error) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// extractXML decodes the first element of raw XML content into v.
+func extractXML(value string, v interface{}) error {
+	return xml.Unmarshal([]byte(value), v)
+}
+
+// rootNamespace returns the namespace of the first element of raw XML content.
+func rootNamespace(value string) string {
+	d := xml.NewDecoder(bytes.NewReader([]byte(value)))
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return ""
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name.Space
+		}
+	}
+}
+
+// innerXML reads the rest of the element start and returns its content as
+// raw XML. The content is kept as is, except that the declarations of the
+// prefixes it uses without declaring them, made on the ancestors of start, are
+// copied onto its elements, so that it can be read on its own.
+func innerXML(d *xml.Decoder, start xml.StartElement) (string, error) {
+	var c rawContent
+	if err := d.DecodeElement(&c, &start); err != nil {
+		return "", err
+	}
+	return c.declare(), nil
+}
+
+// innerXMLFast is innerXML for the fast decoder.
+func innerXMLFast(dec *fastxml.Decoder) (string, error) {
+	ns, err := dec.Namespaces()
+	if err != nil {
+		return "", err
+	}
+	value, err := dec.InnerXML()
+	if err != nil || len(ns) == 0 {
+		return value, err
+	}
+	// The names of the content are resolved in an element declaring the
+	// namespaces in scope.
+	var b strings.Builder
+	b.WriteString("<Content")
+	for _, a := range ns {
+		writeDeclaration(&b, a.Name.Local, a.Value)
+	}
+	b.WriteString(">" + value + "</Content>")
+	var c rawContent
+	if err := xml.Unmarshal([]byte(b.String()), &c); err != nil {
+		return "", err
+	}
+	return c.declare(), nil
+}
+
+// rawContent is raw XML content, with the start elements it holds in the
+// order of the input, their names resolved by encoding/xml.
+type rawContent struct {
+	Value    string       `xml:",innerxml"`
+	Elements []rawElement `xml:",any"`
+}
+
+// rawElement holds the start elements of an element and its descendants.
+type rawElement struct {
+	starts []xml.StartElement
+}
+
+func (e *rawElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	e.starts = append(e.starts, start.Copy())
+	for depth := 1; depth > 0; {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			e.starts = append(e.starts, t.Copy())
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	return nil
+}
+
+// declare returns the content with the declarations of the prefixes that an
+// element at its top and the descendants of the element use without declaring
+// them added to the start tag of the element.
+func (c *rawContent) declare() string {
+	var starts []xml.StartElement
+	for _, e := range c.Elements {
+		starts = append(starts, e.starts...)
+	}
+	type insertion struct {
+		offset int
+		decls  strings.Builder
+	}
+	var (
+		inserts  []*insertion
+		prefixes []string
+		scope    []int
+		seen     map[string]bool
+	)
+	declared := func(prefix string) bool {
+		for _, p := range prefixes {
+			if p == prefix {
+				return true
+			}
+		}
+		return false
+	}
+	// use records the prefix of a name, which encoding/xml resolved to space,
+	// or left as is if no ancestor declares it.
+	use := func(prefix, space string) {
+		if prefix == "" || prefix == "xml" || prefix == "xmlns" || space == prefix || seen[prefix] || declared(prefix) {
+			return
+		}
+		seen[prefix] = true
+		writeDeclaration(&inserts[len(inserts)-1].decls, prefix, space)
+	}
+	d := xml.NewDecoder(strings.NewReader(c.Value))
+	for i := 0; ; {
+		offset := int(d.InputOffset())
+		token, err := d.RawToken()
+		if err != nil {
+			break
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if i == len(starts) {
+				return c.Value
+			}
+			resolved := starts[i]
+			i++
+			if len(scope) == 0 {
+				name := len(t.Name.Local)
+				if t.Name.Space != "" {
+					name += len(t.Name.Space) + 1
+				}
+				inserts = append(inserts, &insertion{offset: offset + 1 + name})
+				seen = map[string]bool{}
+			}
+			scope = append(scope, len(prefixes))
+			for _, a := range t.Attr {
+				if a.Name.Space == "xmlns" {
+					prefixes = append(prefixes, a.Name.Local)
+				}
+			}
+			use(t.Name.Space, resolved.Name.Space)
+			for j, a := range t.Attr {
+				if j < len(resolved.Attr) {
+					use(a.Name.Space, resolved.Attr[j].Name.Space)
+				}
+			}
+		case xml.EndElement:
+			if len(scope) > 0 {
+				prefixes = prefixes[:scope[len(scope)-1]]
+				scope = scope[:len(scope)-1]
+			}
+		}
+	}
+	var b strings.Builder
+	last := 0
+	for _, in := range inserts {
+		b.WriteString(c.Value[last:in.offset])
+		b.WriteString(in.decls.String())
+		last = in.offset
+	}
+	b.WriteString(c.Value[last:])
+	return b.String()
+}
+
+func writeDeclaration(b *strings.Builder, prefix, space string) {
+	b.WriteString(" xmlns:" + prefix + `="`)
+	xml.EscapeText(b, []byte(space))
+	b.WriteByte('"')
+}
@@ -43,6 +43,10 @@ type Reason12Choice struct {
 	UnmatchedReason *UnmatchedReason16Choice `xml:"UmtchdRsn,omitempty"`
 }
 
+func (r *Reason12Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reason12Choice) AddRepoCallAcknowledgementReason() *AcknowledgementReason3Choice {
 	r.RepoCallAcknowledgementReason = new(AcknowledgementReason3Choice)
 	return r.RepoCallAcknowledgementReason
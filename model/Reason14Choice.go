@@ -43,6 +43,10 @@ type Reason14Choice struct {
 	UnmatchedReason *UnmatchedReason16Choice `xml:"UmtchdRsn,omitempty"`
 }
 
+func (r *Reason14Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reason14Choice) AddRepoCallAcknowledgementReason() *AcknowledgementReason3Choice {
 	r.RepoCallAcknowledgementReason = new(AcknowledgementReason3Choice)
 	return r.RepoCallAcknowledgementReason
@@ -43,6 +43,10 @@ type Reason16Choice struct {
 	UnmatchedReason *UnmatchedReason22Choice `xml:"UmtchdRsn,omitempty"`
 }
 
+func (r *Reason16Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reason16Choice) AddRepoCallAcknowledgementReason() *AcknowledgementReason13Choice {
 	r.RepoCallAcknowledgementReason = new(AcknowledgementReason13Choice)
 	return r.RepoCallAcknowledgementReason
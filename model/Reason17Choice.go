@@ -43,6 +43,10 @@ type Reason17Choice struct {
 	UnmatchedReason *UnmatchedReason29Choice `xml:"UmtchdRsn,omitempty"`
 }
 
+func (r *Reason17Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reason17Choice) AddRepoCallAcknowledgementReason() *AcknowledgementReason18Choice {
 	r.RepoCallAcknowledgementReason = new(AcknowledgementReason18Choice)
 	return r.RepoCallAcknowledgementReason
@@ -43,6 +43,10 @@ type Reason1Choice struct {
 	UnmatchedReason *UnmatchedReason2Choice `xml:"UmtchdRsn,omitempty"`
 }
 
+func (r *Reason1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reason1Choice) AddRepoCallAcknowledgementReason() *AcknowledgementReason3Choice {
 	r.RepoCallAcknowledgementReason = new(AcknowledgementReason3Choice)
 	return r.RepoCallAcknowledgementReason
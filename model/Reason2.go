@@ -7,6 +7,10 @@ type Reason2 struct {
 	Description *Max140Text `xml:"Desc"`
 }
 
+func (r *Reason2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reason2) SetDescription(value string) {
 	r.Description = (*Max140Text)(&value)
 }
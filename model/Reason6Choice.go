@@ -43,6 +43,10 @@ type Reason6Choice struct {
 	UnmatchedReason *UnmatchedReason2Choice `xml:"UmtchdRsn,omitempty"`
 }
 
+func (r *Reason6Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reason6Choice) AddRepoCallAcknowledgementReason() *AcknowledgementReason3Choice {
 	r.RepoCallAcknowledgementReason = new(AcknowledgementReason3Choice)
 	return r.RepoCallAcknowledgementReason
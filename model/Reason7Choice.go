@@ -43,6 +43,10 @@ type Reason7Choice struct {
 	UnmatchedReason *UnmatchedReason8Choice `xml:"UmtchdRsn,omitempty"`
 }
 
+func (r *Reason7Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reason7Choice) AddRepoCallAcknowledgementReason() *AcknowledgementReason3Choice {
 	r.RepoCallAcknowledgementReason = new(AcknowledgementReason3Choice)
 	return r.RepoCallAcknowledgementReason
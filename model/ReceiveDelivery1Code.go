@@ -1,3 +1,26 @@
 package model
 
 type ReceiveDelivery1Code string
+
+const (
+	ReceiveDelivery1CodeDELI ReceiveDelivery1Code = "DELI"
+	ReceiveDelivery1CodeRECE ReceiveDelivery1Code = "RECE"
+)
+
+var receiveDelivery1CodeDescriptions = map[ReceiveDelivery1Code]string{
+	ReceiveDelivery1CodeDELI: "Deliver: delivery of financial instruments.",
+	ReceiveDelivery1CodeRECE: "Receive: receipt of financial instruments.",
+}
+
+func (r ReceiveDelivery1Code) IsValid() bool {
+	_, ok := receiveDelivery1CodeDescriptions[r]
+	return ok
+}
+
+func (r ReceiveDelivery1Code) Description() string {
+	return receiveDelivery1CodeDescriptions[r]
+}
+
+func (r ReceiveDelivery1Code) Validate() error {
+	return validateEnumeration("ReceiveDelivery1Code", string(r), r.IsValid(), "DELI, RECE")
+}
@@ -13,6 +13,10 @@ type ReceiveInformation1 struct {
 	PhysicalTransferDetails *DeliveryParameters2 `xml:"PhysTrfDtls,omitempty"`
 }
 
+func (r *ReceiveInformation1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceiveInformation1) AddSettlementPartiesDetails() *ReceivingPartiesAndAccount1 {
 	r.SettlementPartiesDetails = new(ReceivingPartiesAndAccount1)
 	return r.SettlementPartiesDetails
@@ -22,6 +26,11 @@ func (r *ReceiveInformation1) SetPhysicalTransferIndicator(value string) {
 	r.PhysicalTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *ReceiveInformation1) SetPhysicalTransferIndicatorFromBool(value bool) {
+	r.PhysicalTransferIndicator = new(YesNoIndicator)
+	r.PhysicalTransferIndicator.FromBool(value)
+}
+
 func (r *ReceiveInformation1) AddPhysicalTransferDetails() *DeliveryParameters2 {
 	r.PhysicalTransferDetails = new(DeliveryParameters2)
 	return r.PhysicalTransferDetails
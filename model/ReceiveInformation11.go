@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Completion of a securities settlement instruction, wherein securities are delivered/debited from a securities account and received/credited to the designated securities account.
 type ReceiveInformation11 struct {
 
@@ -37,10 +43,19 @@ type ReceiveInformation11 struct {
 	PhysicalTransferDetails *DeliveryParameters4 `xml:"PhysTrfDtls,omitempty"`
 }
 
+func (r *ReceiveInformation11) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceiveInformation11) SetRequestedSettlementDate(value string) {
 	r.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (r *ReceiveInformation11) SetRequestedSettlementDateFromTime(value time.Time) {
+	r.RequestedSettlementDate = new(ISODate)
+	r.RequestedSettlementDate.FromTime(value)
+}
+
 func (r *ReceiveInformation11) AddEffectiveSettlementDate() *DateAndDateTimeChoice {
 	r.EffectiveSettlementDate = new(DateAndDateTimeChoice)
 	return r.EffectiveSettlementDate
@@ -50,6 +65,10 @@ func (r *ReceiveInformation11) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation11) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation11) SetStampDuty(value string) {
 	r.StampDuty = (*StampDutyType2Code)(&value)
 }
@@ -58,6 +77,10 @@ func (r *ReceiveInformation11) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation11) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation11) AddChargeDetails() *Charge20 {
 	newValue := new(Charge20)
 	r.ChargeDetails = append(r.ChargeDetails, newValue)
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type ReceiveInformation12 struct {
 
@@ -43,10 +49,19 @@ type ReceiveInformation12 struct {
 	ClientReference *Max35Text `xml:"ClntRef,omitempty"`
 }
 
+func (r *ReceiveInformation12) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceiveInformation12) SetRequestedSettlementDate(value string) {
 	r.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (r *ReceiveInformation12) SetRequestedSettlementDateFromTime(value time.Time) {
+	r.RequestedSettlementDate = new(ISODate)
+	r.RequestedSettlementDate.FromTime(value)
+}
+
 func (r *ReceiveInformation12) AddEffectiveSettlementDate() *DateAndDateTimeChoice {
 	r.EffectiveSettlementDate = new(DateAndDateTimeChoice)
 	return r.EffectiveSettlementDate
@@ -56,6 +71,10 @@ func (r *ReceiveInformation12) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation12) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation12) SetStampDuty(value string) {
 	r.StampDuty = (*StampDutyType2Code)(&value)
 }
@@ -64,6 +83,10 @@ func (r *ReceiveInformation12) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation12) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation12) AddChargeDetails() *Charge20 {
 	newValue := new(Charge20)
 	r.ChargeDetails = append(r.ChargeDetails, newValue)
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type ReceiveInformation13 struct {
 
@@ -40,14 +46,27 @@ type ReceiveInformation13 struct {
 	ClientReference *Max35Text `xml:"ClntRef,omitempty"`
 }
 
+func (r *ReceiveInformation13) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceiveInformation13) SetRequestedSettlementDate(value string) {
 	r.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (r *ReceiveInformation13) SetRequestedSettlementDateFromTime(value time.Time) {
+	r.RequestedSettlementDate = new(ISODate)
+	r.RequestedSettlementDate.FromTime(value)
+}
+
 func (r *ReceiveInformation13) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation13) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation13) SetStampDuty(value string) {
 	r.StampDuty = (*StampDutyType2Code)(&value)
 }
@@ -56,6 +75,10 @@ func (r *ReceiveInformation13) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation13) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation13) AddSettlementPartiesDetails() *ReceivingPartiesAndAccount9 {
 	r.SettlementPartiesDetails = new(ReceivingPartiesAndAccount9)
 	return r.SettlementPartiesDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type ReceiveInformation15 struct {
 
@@ -40,14 +46,27 @@ type ReceiveInformation15 struct {
 	ClientReference *Max35Text `xml:"ClntRef,omitempty"`
 }
 
+func (r *ReceiveInformation15) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceiveInformation15) SetRequestedSettlementDate(value string) {
 	r.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (r *ReceiveInformation15) SetRequestedSettlementDateFromTime(value time.Time) {
+	r.RequestedSettlementDate = new(ISODate)
+	r.RequestedSettlementDate.FromTime(value)
+}
+
 func (r *ReceiveInformation15) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation15) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation15) SetStampDuty(value string) {
 	r.StampDuty = (*StampDutyType2Code)(&value)
 }
@@ -56,6 +75,10 @@ func (r *ReceiveInformation15) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation15) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation15) AddSettlementPartiesDetails() *ReceivingPartiesAndAccount9 {
 	r.SettlementPartiesDetails = new(ReceivingPartiesAndAccount9)
 	return r.SettlementPartiesDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type ReceiveInformation16 struct {
 
@@ -49,6 +55,10 @@ type ReceiveInformation16 struct {
 	ClientReference *AdditionalReference7 `xml:"ClntRef,omitempty"`
 }
 
+func (r *ReceiveInformation16) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceiveInformation16) AddTransferee() *PartyIdentification70Choice {
 	r.Transferee = new(PartyIdentification70Choice)
 	return r.Transferee
@@ -209,10 +219,19 @@ func (r *ReceiveInformation16) SetRequestedSettlementDate(value string) {
 	r.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (r *ReceiveInformation16) SetRequestedSettlementDateFromTime(value time.Time) {
+	r.RequestedSettlementDate = new(ISODate)
+	r.RequestedSettlementDate.FromTime(value)
+}
+
 func (r *ReceiveInformation16) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation16) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation16) SetStampDuty(value string) {
 	r.StampDuty = (*StampDutyType2Code)(&value)
 }
@@ -221,6 +240,10 @@ func (r *ReceiveInformation16) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation16) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation16) AddSettlementPartiesDetails() *ReceivingPartiesAndAccount13 {
 	r.SettlementPartiesDetails = new(ReceivingPartiesAndAccount13)
 	return r.SettlementPartiesDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type ReceiveInformation17 struct {
 
@@ -52,6 +58,10 @@ type ReceiveInformation17 struct {
 	ClientReference *AdditionalReference7 `xml:"ClntRef,omitempty"`
 }
 
+func (r *ReceiveInformation17) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceiveInformation17) AddTransferee() *PartyIdentification70Choice {
 	r.Transferee = new(PartyIdentification70Choice)
 	return r.Transferee
@@ -212,6 +222,11 @@ func (r *ReceiveInformation17) SetRequestedSettlementDate(value string) {
 	r.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (r *ReceiveInformation17) SetRequestedSettlementDateFromTime(value time.Time) {
+	r.RequestedSettlementDate = new(ISODate)
+	r.RequestedSettlementDate.FromTime(value)
+}
+
 func (r *ReceiveInformation17) AddEffectiveSettlementDate() *DateAndDateTimeChoice {
 	r.EffectiveSettlementDate = new(DateAndDateTimeChoice)
 	return r.EffectiveSettlementDate
@@ -221,6 +236,10 @@ func (r *ReceiveInformation17) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation17) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation17) SetStampDuty(value string) {
 	r.StampDuty = (*StampDutyType2Code)(&value)
 }
@@ -229,6 +248,10 @@ func (r *ReceiveInformation17) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation17) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation17) AddChargeDetails() *Charge29 {
 	newValue := new(Charge29)
 	r.ChargeDetails = append(r.ChargeDetails, newValue)
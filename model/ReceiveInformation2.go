@@ -19,6 +19,10 @@ type ReceiveInformation2 struct {
 	PhysicalTransferDetails *DeliveryParameters2 `xml:"PhysTrfDtls,omitempty"`
 }
 
+func (r *ReceiveInformation2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceiveInformation2) AddChargeDetails() *Charge4 {
 	newValue := new(Charge4)
 	r.ChargeDetails = append(r.ChargeDetails, newValue)
@@ -320,6 +324,11 @@ func (r *ReceiveInformation2) SetPhysicalTransferIndicator(value string) {
 	r.PhysicalTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *ReceiveInformation2) SetPhysicalTransferIndicatorFromBool(value bool) {
+	r.PhysicalTransferIndicator = new(YesNoIndicator)
+	r.PhysicalTransferIndicator.FromBool(value)
+}
+
 func (r *ReceiveInformation2) AddPhysicalTransferDetails() *DeliveryParameters2 {
 	r.PhysicalTransferDetails = new(DeliveryParameters2)
 	return r.PhysicalTransferDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type ReceiveInformation3 struct {
 
@@ -31,18 +35,35 @@ type ReceiveInformation3 struct {
 	PhysicalTransferDetails *DeliveryParameters4 `xml:"PhysTrfDtls,omitempty"`
 }
 
+func (r *ReceiveInformation3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceiveInformation3) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation3) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation3) SetStampDutyIndicator(value string) {
 	r.StampDutyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *ReceiveInformation3) SetStampDutyIndicatorFromBool(value bool) {
+	r.StampDutyIndicator = new(YesNoIndicator)
+	r.StampDutyIndicator.FromBool(value)
+}
+
 func (r *ReceiveInformation3) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation3) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation3) AddSettlementPartiesDetails() *ReceivingPartiesAndAccount4 {
 	r.SettlementPartiesDetails = new(ReceivingPartiesAndAccount4)
 	return r.SettlementPartiesDetails
@@ -490,6 +511,11 @@ func (r *ReceiveInformation3) SetPhysicalTransferIndicator(value string) {
 	r.PhysicalTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *ReceiveInformation3) SetPhysicalTransferIndicatorFromBool(value bool) {
+	r.PhysicalTransferIndicator = new(YesNoIndicator)
+	r.PhysicalTransferIndicator.FromBool(value)
+}
+
 func (r *ReceiveInformation3) AddPhysicalTransferDetails() *DeliveryParameters4 {
 	r.PhysicalTransferDetails = new(DeliveryParameters4)
 	return r.PhysicalTransferDetails
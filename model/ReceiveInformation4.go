@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Completion of a securities settlement instruction, wherein securities are delivered/debited from a securities account and received/credited to the designated securities account.
 type ReceiveInformation4 struct {
 
@@ -34,6 +38,10 @@ type ReceiveInformation4 struct {
 	PhysicalTransferDetails *DeliveryParameters4 `xml:"PhysTrfDtls,omitempty"`
 }
 
+func (r *ReceiveInformation4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceiveInformation4) AddEffectiveSettlementDate() *DateAndDateTimeChoice {
 	r.EffectiveSettlementDate = new(DateAndDateTimeChoice)
 	return r.EffectiveSettlementDate
@@ -43,14 +51,27 @@ func (r *ReceiveInformation4) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation4) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation4) SetStampDutyIndicator(value string) {
 	r.StampDutyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *ReceiveInformation4) SetStampDutyIndicatorFromBool(value bool) {
+	r.StampDutyIndicator = new(YesNoIndicator)
+	r.StampDutyIndicator.FromBool(value)
+}
+
 func (r *ReceiveInformation4) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *ReceiveInformation4) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReceiveInformation4) AddChargeDetails() *Charge20 {
 	newValue := new(Charge20)
 	r.ChargeDetails = append(r.ChargeDetails, newValue)
@@ -498,6 +519,11 @@ func (r *ReceiveInformation4) SetPhysicalTransferIndicator(value string) {
 	r.PhysicalTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *ReceiveInformation4) SetPhysicalTransferIndicatorFromBool(value bool) {
+	r.PhysicalTransferIndicator = new(YesNoIndicator)
+	r.PhysicalTransferIndicator.FromBool(value)
+}
+
 func (r *ReceiveInformation4) AddPhysicalTransferDetails() *DeliveryParameters4 {
 	r.PhysicalTransferDetails = new(DeliveryParameters4)
 	return r.PhysicalTransferDetails
@@ -10,8 +10,16 @@ type ReceivedReason1Choice struct {
 	Reason *ReceivedReason2Choice `xml:"Rsn"`
 }
 
-func (r *ReceivedReason1Choice) SetNoSpecifiedReason(value string) {
+func (r *ReceivedReason1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *ReceivedReason1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *ReceivedReason1Choice) AddReason() *ReceivedReason2Choice {
@@ -10,6 +10,10 @@ type ReceivedReason2Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (r *ReceivedReason2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceivedReason2Choice) SetCode(value string) {
 	r.Code = (*ExternalReceivedReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ReceivedStatusReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *ReceivedStatusReason1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceivedStatusReason1) AddReason() *ReceivedReason1Choice {
 	r.Reason = new(ReceivedReason1Choice)
 	return r.Reason
@@ -22,6 +22,10 @@ type ReceivingPartiesAndAccount1 struct {
 	PlaceOfSettlementDetails *PartyIdentificationAndAccount2 `xml:"PlcOfSttlmDtls"`
 }
 
+func (r *ReceivingPartiesAndAccount1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceivingPartiesAndAccount1) AddReceiverDetails() *InvestmentAccount11 {
 	r.ReceiverDetails = new(InvestmentAccount11)
 	return r.ReceiverDetails
@@ -13,6 +13,10 @@ type ReceivingPartiesAndAccount10 struct {
 	Party2 *PartyIdentificationAndAccount77 `xml:"Pty2,omitempty"`
 }
 
+func (r *ReceivingPartiesAndAccount10) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceivingPartiesAndAccount10) AddDepository() *PartyIdentification34Choice {
 	r.Depository = new(PartyIdentification34Choice)
 	return r.Depository
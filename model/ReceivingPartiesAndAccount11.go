@@ -16,6 +16,10 @@ type ReceivingPartiesAndAccount11 struct {
 	SecuritiesSettlementSystem *Max35Text `xml:"SctiesSttlmSys,omitempty"`
 }
 
+func (r *ReceivingPartiesAndAccount11) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceivingPartiesAndAccount11) AddDepository() *PartyIdentification34Choice {
 	r.Depository = new(PartyIdentification34Choice)
 	return r.Depository
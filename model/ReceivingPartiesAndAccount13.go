@@ -25,6 +25,10 @@ type ReceivingPartiesAndAccount13 struct {
 	PlaceOfSettlementDetails *PartyIdentification97 `xml:"PlcOfSttlmDtls,omitempty"`
 }
 
+func (r *ReceivingPartiesAndAccount13) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceivingPartiesAndAccount13) AddReceiverDetails() *InvestmentAccount55 {
 	r.ReceiverDetails = new(InvestmentAccount55)
 	return r.ReceiverDetails
@@ -22,6 +22,10 @@ type ReceivingPartiesAndAccount14 struct {
 	PlaceOfSettlementDetails *PartyIdentification97 `xml:"PlcOfSttlmDtls,omitempty"`
 }
 
+func (r *ReceivingPartiesAndAccount14) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceivingPartiesAndAccount14) AddReceiversCustodianDetails() *PartyIdentificationAndAccount124 {
 	r.ReceiversCustodianDetails = new(PartyIdentificationAndAccount124)
 	return r.ReceiversCustodianDetails
@@ -13,6 +13,10 @@ type ReceivingPartiesAndAccount15 struct {
 	Party2 *PartyIdentificationAndAccount127 `xml:"Pty2,omitempty"`
 }
 
+func (r *ReceivingPartiesAndAccount15) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceivingPartiesAndAccount15) AddDepository() *PartyIdentification102Choice {
 	r.Depository = new(PartyIdentification102Choice)
 	return r.Depository
@@ -16,6 +16,10 @@ type ReceivingPartiesAndAccount16 struct {
 	ReceivingAgentDetails *PartyIdentificationAndAccount147 `xml:"RcvgAgtDtls"`
 }
 
+func (r *ReceivingPartiesAndAccount16) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceivingPartiesAndAccount16) AddReceiversCustodianDetails() *PartyIdentificationAndAccount147 {
 	r.ReceiversCustodianDetails = new(PartyIdentificationAndAccount147)
 	return r.ReceiversCustodianDetails
@@ -13,6 +13,10 @@ type ReceivingPartiesAndAccount3 struct {
 	ReceivingAgentDetails *PartyIdentificationAndAccount3 `xml:"RcvgAgtDtls"`
 }
 
+func (r *ReceivingPartiesAndAccount3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceivingPartiesAndAccount3) AddReceiversCustodianDetails() *PartyIdentificationAndAccount3 {
 	r.ReceiversCustodianDetails = new(PartyIdentificationAndAccount3)
 	return r.ReceiversCustodianDetails
@@ -22,6 +22,10 @@ type ReceivingPartiesAndAccount4 struct {
 	PlaceOfSettlementDetails *PartyIdentification21 `xml:"PlcOfSttlmDtls"`
 }
 
+func (r *ReceivingPartiesAndAccount4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceivingPartiesAndAccount4) AddReceiverDetails() *InvestmentAccount24 {
 	r.ReceiverDetails = new(InvestmentAccount24)
 	return r.ReceiverDetails
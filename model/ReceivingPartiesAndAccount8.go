@@ -22,6 +22,10 @@ type ReceivingPartiesAndAccount8 struct {
 	PlaceOfSettlementDetails *PartyIdentification21 `xml:"PlcOfSttlmDtls,omitempty"`
 }
 
+func (r *ReceivingPartiesAndAccount8) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceivingPartiesAndAccount8) AddReceiverDetails() *InvestmentAccount24 {
 	r.ReceiverDetails = new(InvestmentAccount24)
 	return r.ReceiverDetails
@@ -22,6 +22,10 @@ type ReceivingPartiesAndAccount9 struct {
 	PlaceOfSettlementDetails *PartyIdentification21 `xml:"PlcOfSttlmDtls,omitempty"`
 }
 
+func (r *ReceivingPartiesAndAccount9) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReceivingPartiesAndAccount9) AddReceiverDetails() *InvestmentAccount41 {
 	r.ReceiverDetails = new(InvestmentAccount41)
 	return r.ReceiverDetails
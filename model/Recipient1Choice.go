@@ -10,6 +10,10 @@ type Recipient1Choice struct {
 	KEK *KEK1 `xml:"KEK,omitempty"`
 }
 
+func (r *Recipient1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Recipient1Choice) AddKeyTransport() *KeyTransport1 {
 	r.KeyTransport = new(KeyTransport1)
 	return r.KeyTransport
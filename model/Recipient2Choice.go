@@ -10,6 +10,10 @@ type Recipient2Choice struct {
 	KEK *KEK2 `xml:"KEK,omitempty"`
 }
 
+func (r *Recipient2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Recipient2Choice) AddKeyTransport() *KeyTransport2 {
 	r.KeyTransport = new(KeyTransport2)
 	return r.KeyTransport
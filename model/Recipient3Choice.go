@@ -13,6 +13,10 @@ type Recipient3Choice struct {
 	KeyIdentifier *KEKIdentifier1 `xml:"KeyIdr"`
 }
 
+func (r *Recipient3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Recipient3Choice) AddKeyTransport() *KeyTransport3 {
 	r.KeyTransport = new(KeyTransport3)
 	return r.KeyTransport
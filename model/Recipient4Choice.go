@@ -13,6 +13,10 @@ type Recipient4Choice struct {
 	KeyIdentifier *KEKIdentifier2 `xml:"KeyIdr"`
 }
 
+func (r *Recipient4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Recipient4Choice) AddKeyTransport() *KeyTransport4 {
 	r.KeyTransport = new(KeyTransport4)
 	return r.KeyTransport
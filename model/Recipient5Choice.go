@@ -10,6 +10,10 @@ type Recipient5Choice struct {
 	KeyIdentifier *KEKIdentifier2 `xml:"KeyIdr"`
 }
 
+func (r *Recipient5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Recipient5Choice) AddIssuerAndSerialNumber() *IssuerAndSerialNumber1 {
 	r.IssuerAndSerialNumber = new(IssuerAndSerialNumber1)
 	return r.IssuerAndSerialNumber
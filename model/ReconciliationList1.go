@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies a list of reconciliation information concerning financial items.
 type ReconciliationList1 struct {
 
@@ -52,10 +58,19 @@ type ReconciliationList1 struct {
 	ValidationStatusInformation *ValidationStatusInformation1 `xml:"VldtnStsInf,omitempty"`
 }
 
+func (r *ReconciliationList1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReconciliationList1) SetDate(value string) {
 	r.Date = (*ISODate)(&value)
 }
 
+func (r *ReconciliationList1) SetDateFromTime(value time.Time) {
+	r.Date = new(ISODate)
+	r.Date.FromTime(value)
+}
+
 func (r *ReconciliationList1) AddRelatedDocument() *QualifiedDocumentInformation1 {
 	newValue := new(QualifiedDocumentInformation1)
 	r.RelatedDocument = append(r.RelatedDocument, newValue)
@@ -231,6 +246,11 @@ func (r *ReconciliationList1) SetPaymentDate(value string) {
 	r.PaymentDate = (*ISODate)(&value)
 }
 
+func (r *ReconciliationList1) SetPaymentDateFromTime(value time.Time) {
+	r.PaymentDate = new(ISODate)
+	r.PaymentDate.FromTime(value)
+}
+
 func (r *ReconciliationList1) AddPaymentTerms() *PaymentTerms6 {
 	r.PaymentTerms = new(PaymentTerms6)
 	return r.PaymentTerms
@@ -240,6 +260,10 @@ func (r *ReconciliationList1) SetPaymentAmount(value, currency string) {
 	r.PaymentAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (r *ReconciliationList1) SetPaymentAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.PaymentAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReconciliationList1) AddItem() *FinancialItem1 {
 	newValue := new(FinancialItem1)
 	r.Item = append(r.Item, newValue)
@@ -394,6 +418,11 @@ func (r *ReconciliationList1) SetControlSum(value string) {
 	r.ControlSum = (*DecimalNumber)(&value)
 }
 
+func (r *ReconciliationList1) SetControlSumFromDecimal(value decimal.Decimal) {
+	r.ControlSum = new(DecimalNumber)
+	r.ControlSum.FromDecimal(value)
+}
+
 func (r *ReconciliationList1) AddAssociatedDocument() *QualifiedDocumentInformation1 {
 	newValue := new(QualifiedDocumentInformation1)
 	r.AssociatedDocument = append(r.AssociatedDocument, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Financial loan (instalment) or a recurring transaction.
 type RecurringTransaction1 struct {
 
@@ -19,6 +23,10 @@ type RecurringTransaction1 struct {
 	InterestCharges *ImpliedCurrencyAndAmount `xml:"IntrstChrgs,omitempty"`
 }
 
+func (r *RecurringTransaction1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RecurringTransaction1) SetSequenceNumber(value string) {
 	r.SequenceNumber = (*Max2NumericText)(&value)
 }
@@ -31,10 +39,24 @@ func (r *RecurringTransaction1) SetInstalmentPeriod(value string) {
 	r.InstalmentPeriod = (*Number)(&value)
 }
 
+func (r *RecurringTransaction1) SetInstalmentPeriodFromDecimal(value decimal.Decimal) {
+	r.InstalmentPeriod = new(Number)
+	r.InstalmentPeriod.FromDecimal(value)
+}
+
 func (r *RecurringTransaction1) SetTotalNumberOfPayments(value string) {
 	r.TotalNumberOfPayments = (*Number)(&value)
 }
 
+func (r *RecurringTransaction1) SetTotalNumberOfPaymentsFromDecimal(value decimal.Decimal) {
+	r.TotalNumberOfPayments = new(Number)
+	r.TotalNumberOfPayments.FromDecimal(value)
+}
+
 func (r *RecurringTransaction1) SetInterestCharges(value, currency string) {
 	r.InterestCharges = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (r *RecurringTransaction1) SetInterestChargesFromDecimal(value decimal.Decimal, currency string) {
+	r.InterestCharges = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
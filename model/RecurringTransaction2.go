@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Financial loan (instalment) or a recurring transaction.
 type RecurringTransaction2 struct {
 
@@ -34,6 +40,10 @@ type RecurringTransaction2 struct {
 	Charges *ImpliedCurrencyAndAmount `xml:"Chrgs,omitempty"`
 }
 
+func (r *RecurringTransaction2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RecurringTransaction2) AddInstalmentPlan(value string) {
 	r.InstalmentPlan = append(r.InstalmentPlan, (*InstalmentPlan1Code)(&value))
 }
@@ -46,6 +56,11 @@ func (r *RecurringTransaction2) SetSequenceNumber(value string) {
 	r.SequenceNumber = (*Number)(&value)
 }
 
+func (r *RecurringTransaction2) SetSequenceNumberFromDecimal(value decimal.Decimal) {
+	r.SequenceNumber = new(Number)
+	r.SequenceNumber.FromDecimal(value)
+}
+
 func (r *RecurringTransaction2) SetPeriodUnit(value string) {
 	r.PeriodUnit = (*Frequency3Code)(&value)
 }
@@ -54,22 +69,49 @@ func (r *RecurringTransaction2) SetInstalmentPeriod(value string) {
 	r.InstalmentPeriod = (*Number)(&value)
 }
 
+func (r *RecurringTransaction2) SetInstalmentPeriodFromDecimal(value decimal.Decimal) {
+	r.InstalmentPeriod = new(Number)
+	r.InstalmentPeriod.FromDecimal(value)
+}
+
 func (r *RecurringTransaction2) SetTotalNumberOfPayments(value string) {
 	r.TotalNumberOfPayments = (*Number)(&value)
 }
 
+func (r *RecurringTransaction2) SetTotalNumberOfPaymentsFromDecimal(value decimal.Decimal) {
+	r.TotalNumberOfPayments = new(Number)
+	r.TotalNumberOfPayments.FromDecimal(value)
+}
+
 func (r *RecurringTransaction2) SetFirstPaymentDate(value string) {
 	r.FirstPaymentDate = (*ISODate)(&value)
 }
 
+func (r *RecurringTransaction2) SetFirstPaymentDateFromTime(value time.Time) {
+	r.FirstPaymentDate = new(ISODate)
+	r.FirstPaymentDate.FromTime(value)
+}
+
 func (r *RecurringTransaction2) SetTotalAmount(value, currency string) {
 	r.TotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (r *RecurringTransaction2) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.TotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RecurringTransaction2) SetFirstAmount(value, currency string) {
 	r.FirstAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (r *RecurringTransaction2) SetFirstAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.FirstAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RecurringTransaction2) SetCharges(value, currency string) {
 	r.Charges = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (r *RecurringTransaction2) SetChargesFromDecimal(value decimal.Decimal, currency string) {
+	r.Charges = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Financial loan (instalment) or a recurring transaction.
 type RecurringTransaction3 struct {
 
@@ -19,18 +25,37 @@ type RecurringTransaction3 struct {
 	IntervalDay *Number `xml:"IntrvlDay,omitempty"`
 }
 
+func (r *RecurringTransaction3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RecurringTransaction3) SetStartDate(value string) {
 	r.StartDate = (*ISODate)(&value)
 }
 
+func (r *RecurringTransaction3) SetStartDateFromTime(value time.Time) {
+	r.StartDate = new(ISODate)
+	r.StartDate.FromTime(value)
+}
+
 func (r *RecurringTransaction3) SetNumberOfOccurrences(value string) {
 	r.NumberOfOccurrences = (*Number)(&value)
 }
 
+func (r *RecurringTransaction3) SetNumberOfOccurrencesFromDecimal(value decimal.Decimal) {
+	r.NumberOfOccurrences = new(Number)
+	r.NumberOfOccurrences.FromDecimal(value)
+}
+
 func (r *RecurringTransaction3) SetEndDate(value string) {
 	r.EndDate = (*ISODate)(&value)
 }
 
+func (r *RecurringTransaction3) SetEndDateFromTime(value time.Time) {
+	r.EndDate = new(ISODate)
+	r.EndDate.FromTime(value)
+}
+
 func (r *RecurringTransaction3) SetPeriodUnit(value string) {
 	r.PeriodUnit = (*Frequency3Code)(&value)
 }
@@ -38,3 +63,8 @@ func (r *RecurringTransaction3) SetPeriodUnit(value string) {
 func (r *RecurringTransaction3) SetIntervalDay(value string) {
 	r.IntervalDay = (*Number)(&value)
 }
+
+func (r *RecurringTransaction3) SetIntervalDayFromDecimal(value decimal.Decimal) {
+	r.IntervalDay = new(Number)
+	r.IntervalDay.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Execution of a redemption order.
 type RedemptionBulkExecution2 struct {
 
@@ -28,6 +32,10 @@ type RedemptionBulkExecution2 struct {
 	BulkCashSettlementDetails *PaymentTransaction18 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (r *RedemptionBulkExecution2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionBulkExecution2) SetPlaceOfTrade(value string) {
 	r.PlaceOfTrade = (*CountryCode)(&value)
 }
@@ -36,6 +44,11 @@ func (r *RedemptionBulkExecution2) SetOrderDateTime(value string) {
 	r.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionBulkExecution2) SetOrderDateTimeFromTime(value time.Time) {
+	r.OrderDateTime = new(ISODateTime)
+	r.OrderDateTime.FromTime(value)
+}
+
 func (r *RedemptionBulkExecution2) AddCancellationRight() *CancellationRight1 {
 	r.CancellationRight = new(CancellationRight1)
 	return r.CancellationRight
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a redemption order.
 type RedemptionBulkExecution3 struct {
 
@@ -44,6 +50,10 @@ type RedemptionBulkExecution3 struct {
 	BulkCashSettlementDetails *PaymentTransaction22 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (r *RedemptionBulkExecution3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionBulkExecution3) SetMasterReference(value string) {
 	r.MasterReference = (*Max35Text)(&value)
 }
@@ -57,10 +67,20 @@ func (r *RedemptionBulkExecution3) SetOrderDateTime(value string) {
 	r.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionBulkExecution3) SetOrderDateTimeFromTime(value time.Time) {
+	r.OrderDateTime = new(ISODateTime)
+	r.OrderDateTime.FromTime(value)
+}
+
 func (r *RedemptionBulkExecution3) SetRequestedFutureTradeDate(value string) {
 	r.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (r *RedemptionBulkExecution3) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	r.RequestedFutureTradeDate = new(ISODate)
+	r.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (r *RedemptionBulkExecution3) SetCancellationRight(value string) {
 	r.CancellationRight = (*CancellationRight1Code)(&value)
 }
@@ -232,10 +252,19 @@ func (r *RedemptionBulkExecution3) SetTotalSettlementAmount(value, currency stri
 	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionBulkExecution3) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionBulkExecution3) SetCashSettlementDate(value string) {
 	r.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (r *RedemptionBulkExecution3) SetCashSettlementDateFromTime(value time.Time) {
+	r.CashSettlementDate = new(ISODate)
+	r.CashSettlementDate.FromTime(value)
+}
+
 func (r *RedemptionBulkExecution3) AddBulkCashSettlementDetails() *PaymentTransaction22 {
 	r.BulkCashSettlementDetails = new(PaymentTransaction22)
 	return r.BulkCashSettlementDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a redemption order.
 type RedemptionBulkExecution5 struct {
 
@@ -44,10 +50,19 @@ type RedemptionBulkExecution5 struct {
 	BulkCashSettlementDetails *PaymentTransaction72 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (r *RedemptionBulkExecution5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionBulkExecution5) SetAmendmentIndicator(value string) {
 	r.AmendmentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionBulkExecution5) SetAmendmentIndicatorFromBool(value bool) {
+	r.AmendmentIndicator = new(YesNoIndicator)
+	r.AmendmentIndicator.FromBool(value)
+}
+
 func (r *RedemptionBulkExecution5) SetMasterReference(value string) {
 	r.MasterReference = (*Max35Text)(&value)
 }
@@ -61,14 +76,29 @@ func (r *RedemptionBulkExecution5) SetOrderDateTime(value string) {
 	r.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionBulkExecution5) SetOrderDateTimeFromTime(value time.Time) {
+	r.OrderDateTime = new(ISODateTime)
+	r.OrderDateTime.FromTime(value)
+}
+
 func (r *RedemptionBulkExecution5) SetReceivedDateTime(value string) {
 	r.ReceivedDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionBulkExecution5) SetReceivedDateTimeFromTime(value time.Time) {
+	r.ReceivedDateTime = new(ISODateTime)
+	r.ReceivedDateTime.FromTime(value)
+}
+
 func (r *RedemptionBulkExecution5) SetRequestedFutureTradeDate(value string) {
 	r.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (r *RedemptionBulkExecution5) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	r.RequestedFutureTradeDate = new(ISODate)
+	r.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (r *RedemptionBulkExecution5) AddCancellationRight() *CancellationRight1Choice {
 	r.CancellationRight = new(CancellationRight1Choice)
 	return r.CancellationRight
@@ -237,6 +267,10 @@ func (r *RedemptionBulkExecution5) SetTotalSettlementAmount(value, currency stri
 	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionBulkExecution5) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionBulkExecution5) AddBulkCashSettlementDetails() *PaymentTransaction72 {
 	r.BulkCashSettlementDetails = new(PaymentTransaction72)
 	return r.BulkCashSettlementDetails
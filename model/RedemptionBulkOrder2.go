@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Instruction from an investor to sell investment fund units back to the fund.
 type RedemptionBulkOrder2 struct {
 
@@ -31,6 +35,10 @@ type RedemptionBulkOrder2 struct {
 	BulkCashSettlementDetails *PaymentTransaction18 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (r *RedemptionBulkOrder2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionBulkOrder2) SetPlaceOfTrade(value string) {
 	r.PlaceOfTrade = (*CountryCode)(&value)
 }
@@ -39,10 +47,20 @@ func (r *RedemptionBulkOrder2) SetOrderDateTime(value string) {
 	r.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionBulkOrder2) SetOrderDateTimeFromTime(value time.Time) {
+	r.OrderDateTime = new(ISODateTime)
+	r.OrderDateTime.FromTime(value)
+}
+
 func (r *RedemptionBulkOrder2) SetExpiryDateTime(value string) {
 	r.ExpiryDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionBulkOrder2) SetExpiryDateTimeFromTime(value time.Time) {
+	r.ExpiryDateTime = new(ISODateTime)
+	r.ExpiryDateTime.FromTime(value)
+}
+
 func (r *RedemptionBulkOrder2) AddCancellationRight() *CancellationRight1 {
 	r.CancellationRight = new(CancellationRight1)
 	return r.CancellationRight
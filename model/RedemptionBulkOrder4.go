@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Instruction from an investor to sell investment fund units back to the fund.
 type RedemptionBulkOrder4 struct {
 
@@ -47,6 +53,10 @@ type RedemptionBulkOrder4 struct {
 	BulkCashSettlementDetails *PaymentTransaction21 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (r *RedemptionBulkOrder4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionBulkOrder4) SetMasterReference(value string) {
 	r.MasterReference = (*Max35Text)(&value)
 }
@@ -60,6 +70,11 @@ func (r *RedemptionBulkOrder4) SetOrderDateTime(value string) {
 	r.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionBulkOrder4) SetOrderDateTimeFromTime(value time.Time) {
+	r.OrderDateTime = new(ISODateTime)
+	r.OrderDateTime.FromTime(value)
+}
+
 func (r *RedemptionBulkOrder4) AddExpiryDateTime() *DateAndDateTimeChoice {
 	r.ExpiryDateTime = new(DateAndDateTimeChoice)
 	return r.ExpiryDateTime
@@ -69,6 +84,11 @@ func (r *RedemptionBulkOrder4) SetRequestedFutureTradeDate(value string) {
 	r.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (r *RedemptionBulkOrder4) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	r.RequestedFutureTradeDate = new(ISODate)
+	r.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (r *RedemptionBulkOrder4) SetCancellationRight(value string) {
 	r.CancellationRight = (*CancellationRight1Code)(&value)
 }
@@ -240,10 +260,19 @@ func (r *RedemptionBulkOrder4) SetTotalSettlementAmount(value, currency string)
 	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionBulkOrder4) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionBulkOrder4) SetCashSettlementDate(value string) {
 	r.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (r *RedemptionBulkOrder4) SetCashSettlementDateFromTime(value time.Time) {
+	r.CashSettlementDate = new(ISODate)
+	r.CashSettlementDate.FromTime(value)
+}
+
 func (r *RedemptionBulkOrder4) AddBulkCashSettlementDetails() *PaymentTransaction21 {
 	r.BulkCashSettlementDetails = new(PaymentTransaction21)
 	return r.BulkCashSettlementDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Instruction from an investor to sell investment fund units back to the fund.
 type RedemptionBulkOrder6 struct {
 
@@ -41,6 +47,10 @@ type RedemptionBulkOrder6 struct {
 	BulkCashSettlementDetails *PaymentTransaction72 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (r *RedemptionBulkOrder6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionBulkOrder6) SetMasterReference(value string) {
 	r.MasterReference = (*Max35Text)(&value)
 }
@@ -54,6 +64,11 @@ func (r *RedemptionBulkOrder6) SetOrderDateTime(value string) {
 	r.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionBulkOrder6) SetOrderDateTimeFromTime(value time.Time) {
+	r.OrderDateTime = new(ISODateTime)
+	r.OrderDateTime.FromTime(value)
+}
+
 func (r *RedemptionBulkOrder6) AddExpiryDateTime() *DateAndDateTimeChoice {
 	r.ExpiryDateTime = new(DateAndDateTimeChoice)
 	return r.ExpiryDateTime
@@ -63,6 +78,11 @@ func (r *RedemptionBulkOrder6) SetRequestedFutureTradeDate(value string) {
 	r.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (r *RedemptionBulkOrder6) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	r.RequestedFutureTradeDate = new(ISODate)
+	r.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (r *RedemptionBulkOrder6) AddCancellationRight() *CancellationRight1Choice {
 	r.CancellationRight = new(CancellationRight1Choice)
 	return r.CancellationRight
@@ -231,6 +251,10 @@ func (r *RedemptionBulkOrder6) SetTotalSettlementAmount(value, currency string)
 	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionBulkOrder6) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionBulkOrder6) AddBulkCashSettlementDetails() *PaymentTransaction72 {
 	r.BulkCashSettlementDetails = new(PaymentTransaction72)
 	return r.BulkCashSettlementDetails
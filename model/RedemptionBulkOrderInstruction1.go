@@ -16,6 +16,10 @@ type RedemptionBulkOrderInstruction1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (r *RedemptionBulkOrderInstruction1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionBulkOrderInstruction1) AddBulkOrderDetails() *RedemptionBulkOrder2 {
 	r.BulkOrderDetails = new(RedemptionBulkOrder2)
 	return r.BulkOrderDetails
@@ -13,6 +13,10 @@ type RedemptionBulkOrderInstruction2 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (r *RedemptionBulkOrderInstruction2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionBulkOrderInstruction2) AddBulkOrderDetails() *RedemptionBulkOrder3 {
 	r.BulkOrderDetails = new(RedemptionBulkOrder3)
 	return r.BulkOrderDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a redemption order.
 type RedemptionExecution15 struct {
 
@@ -157,6 +163,10 @@ type RedemptionExecution15 struct {
 	GatingOrHoldBackDetails *HoldBackInformation2 `xml:"GtgOrHldBckDtls,omitempty"`
 }
 
+func (r *RedemptionExecution15) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionExecution15) SetOrderReference(value string) {
 	r.OrderReference = (*Max35Text)(&value)
 }
@@ -329,6 +339,11 @@ func (r *RedemptionExecution15) SetUnitsNumber(value string) {
 	r.UnitsNumber = (*DecimalNumber)(&value)
 }
 
+func (r *RedemptionExecution15) SetUnitsNumberFromDecimal(value decimal.Decimal) {
+	r.UnitsNumber = new(DecimalNumber)
+	r.UnitsNumber.FromDecimal(value)
+}
+
 func (r *RedemptionExecution15) SetRounding(value string) {
 	r.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -337,14 +352,27 @@ func (r *RedemptionExecution15) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution15) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution15) SetGrossAmount(value, currency string) {
 	r.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution15) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution15) SetHoldingsRedemptionRate(value string) {
 	r.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionExecution15) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	r.HoldingsRedemptionRate = new(PercentageRate)
+	r.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (r *RedemptionExecution15) AddTradeDateTime() *DateAndDateTimeChoice {
 	r.TradeDateTime = new(DateAndDateTimeChoice)
 	return r.TradeDateTime
@@ -505,18 +533,36 @@ func (r *RedemptionExecution15) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution15) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution15) SetCashSettlementDate(value string) {
 	r.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (r *RedemptionExecution15) SetSettlementMethod(value string) {
+func (r *RedemptionExecution15) SetCashSettlementDateFromTime(value time.Time) {
+	r.CashSettlementDate = new(ISODate)
+	r.CashSettlementDate.FromTime(value)
+}
+
+func (r *RedemptionExecution15) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (r *RedemptionExecution15) SetPartiallyExecutedIndicator(value string) {
 	r.PartiallyExecutedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution15) SetPartiallyExecutedIndicatorFromBool(value bool) {
+	r.PartiallyExecutedIndicator = new(YesNoIndicator)
+	r.PartiallyExecutedIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution15) SetBestExecution(value string) {
 	r.BestExecution = (*BestExecution1Code)(&value)
 }
@@ -525,6 +571,11 @@ func (r *RedemptionExecution15) SetCumDividendIndicator(value string) {
 	r.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution15) SetCumDividendIndicatorFromBool(value bool) {
+	r.CumDividendIndicator = new(YesNoIndicator)
+	r.CumDividendIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution15) AddInterimProfitAmount() *ProfitAndLoss2Choice {
 	r.InterimProfitAmount = new(ProfitAndLoss2Choice)
 	return r.InterimProfitAmount
@@ -703,6 +754,11 @@ func (r *RedemptionExecution15) SetPhysicalDeliveryIndicator(value string) {
 	r.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution15) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	r.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	r.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution15) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	r.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return r.PhysicalDeliveryDetails
@@ -729,10 +785,20 @@ func (r *RedemptionExecution15) SetPartialSettlementOfUnits(value string) {
 	r.PartialSettlementOfUnits = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionExecution15) SetPartialSettlementOfUnitsFromDecimal(value decimal.Decimal) {
+	r.PartialSettlementOfUnits = new(PercentageRate)
+	r.PartialSettlementOfUnits.FromDecimal(value)
+}
+
 func (r *RedemptionExecution15) SetPartialSettlementOfCash(value string) {
 	r.PartialSettlementOfCash = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionExecution15) SetPartialSettlementOfCashFromDecimal(value decimal.Decimal) {
+	r.PartialSettlementOfCash = new(PercentageRate)
+	r.PartialSettlementOfCash.FromDecimal(value)
+}
+
 func (r *RedemptionExecution15) AddStaffClientBreakdown() *InvestmentFundsOrderBreakdown2 {
 	newValue := new(InvestmentFundsOrderBreakdown2)
 	r.StaffClientBreakdown = append(r.StaffClientBreakdown, newValue)
@@ -883,6 +949,10 @@ func (r *RedemptionExecution15) SetPartialRedemptionWithholdingAmount(value, cur
 	r.PartialRedemptionWithholdingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution15) SetPartialRedemptionWithholdingAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.PartialRedemptionWithholdingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution15) SetFinancialAdvice(value string) {
 	r.FinancialAdvice = (*FinancialAdvice1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a redemption order.
 type RedemptionExecution16 struct {
 
@@ -150,6 +156,10 @@ type RedemptionExecution16 struct {
 	GatingOrHoldBackDetails *HoldBackInformation2 `xml:"GtgOrHldBckDtls,omitempty"`
 }
 
+func (r *RedemptionExecution16) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionExecution16) SetOrderReference(value string) {
 	r.OrderReference = (*Max35Text)(&value)
 }
@@ -458,6 +468,11 @@ func (r *RedemptionExecution16) SetUnitsNumber(value string) {
 	r.UnitsNumber = (*DecimalNumber)(&value)
 }
 
+func (r *RedemptionExecution16) SetUnitsNumberFromDecimal(value decimal.Decimal) {
+	r.UnitsNumber = new(DecimalNumber)
+	r.UnitsNumber.FromDecimal(value)
+}
+
 func (r *RedemptionExecution16) SetRounding(value string) {
 	r.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -466,6 +481,10 @@ func (r *RedemptionExecution16) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution16) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution16) AddInvestmentAccountDetails() *InvestmentAccount58 {
 	r.InvestmentAccountDetails = new(InvestmentAccount58)
 	return r.InvestmentAccountDetails
@@ -475,10 +494,19 @@ func (r *RedemptionExecution16) SetHoldingsRedemptionRate(value string) {
 	r.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionExecution16) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	r.HoldingsRedemptionRate = new(PercentageRate)
+	r.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (r *RedemptionExecution16) SetGrossAmount(value, currency string) {
 	r.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution16) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution16) AddTradeDateTime() *DateAndDateTimeChoice {
 	r.TradeDateTime = new(DateAndDateTimeChoice)
 	return r.TradeDateTime
@@ -488,12 +516,25 @@ func (r *RedemptionExecution16) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution16) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution16) SetCashSettlementDate(value string) {
 	r.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (r *RedemptionExecution16) SetSettlementMethod(value string) {
+func (r *RedemptionExecution16) SetCashSettlementDateFromTime(value time.Time) {
+	r.CashSettlementDate = new(ISODate)
+	r.CashSettlementDate.FromTime(value)
+}
+
+func (r *RedemptionExecution16) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (r *RedemptionExecution16) AddDealingPriceDetails() *UnitPrice22 {
@@ -651,6 +692,11 @@ func (r *RedemptionExecution16) SetPartiallyExecutedIndicator(value string) {
 	r.PartiallyExecutedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution16) SetPartiallyExecutedIndicatorFromBool(value bool) {
+	r.PartiallyExecutedIndicator = new(YesNoIndicator)
+	r.PartiallyExecutedIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution16) SetBestExecution(value string) {
 	r.BestExecution = (*BestExecution1Code)(&value)
 }
@@ -659,6 +705,11 @@ func (r *RedemptionExecution16) SetCumDividendIndicator(value string) {
 	r.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution16) SetCumDividendIndicatorFromBool(value bool) {
+	r.CumDividendIndicator = new(YesNoIndicator)
+	r.CumDividendIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution16) AddInterimProfitAmount() *ProfitAndLoss2Choice {
 	r.InterimProfitAmount = new(ProfitAndLoss2Choice)
 	return r.InterimProfitAmount
@@ -983,6 +1034,11 @@ func (r *RedemptionExecution16) SetPhysicalDeliveryIndicator(value string) {
 	r.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution16) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	r.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	r.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution16) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	r.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return r.PhysicalDeliveryDetails
@@ -1001,14 +1057,28 @@ func (r *RedemptionExecution16) SetPartialSettlementOfUnits(value string) {
 	r.PartialSettlementOfUnits = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionExecution16) SetPartialSettlementOfUnitsFromDecimal(value decimal.Decimal) {
+	r.PartialSettlementOfUnits = new(PercentageRate)
+	r.PartialSettlementOfUnits.FromDecimal(value)
+}
+
 func (r *RedemptionExecution16) SetPartialSettlementOfCash(value string) {
 	r.PartialSettlementOfCash = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionExecution16) SetPartialSettlementOfCashFromDecimal(value decimal.Decimal) {
+	r.PartialSettlementOfCash = new(PercentageRate)
+	r.PartialSettlementOfCash.FromDecimal(value)
+}
+
 func (r *RedemptionExecution16) SetPartialRedemptionWithholdingAmount(value, currency string) {
 	r.PartialRedemptionWithholdingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution16) SetPartialRedemptionWithholdingAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.PartialRedemptionWithholdingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution16) SetFinancialAdvice(value string) {
 	r.FinancialAdvice = (*FinancialAdvice1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a redemption order.
 type RedemptionExecution3 struct {
 
@@ -79,6 +83,10 @@ type RedemptionExecution3 struct {
 	CashSettlementDetails *PaymentTransaction18 `xml:"CshSttlmDtls,omitempty"`
 }
 
+func (r *RedemptionExecution3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionExecution3) SetOrderReference(value string) {
 	r.OrderReference = (*Max35Text)(&value)
 }
@@ -256,14 +264,27 @@ func (r *RedemptionExecution3) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution3) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution3) SetHoldingsRedemptionRate(value string) {
 	r.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionExecution3) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	r.HoldingsRedemptionRate = new(PercentageRate)
+	r.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (r *RedemptionExecution3) SetGrossAmount(value, currency string) {
 	r.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution3) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution3) AddTradeDateTime() *DateAndDateTimeChoice {
 	r.TradeDateTime = new(DateAndDateTimeChoice)
 	return r.TradeDateTime
@@ -278,10 +299,20 @@ func (r *RedemptionExecution3) SetPartiallyExecutedIndicator(value string) {
 	r.PartiallyExecutedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution3) SetPartiallyExecutedIndicatorFromBool(value bool) {
+	r.PartiallyExecutedIndicator = new(YesNoIndicator)
+	r.PartiallyExecutedIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution3) SetCumDividendIndicator(value string) {
 	r.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution3) SetCumDividendIndicatorFromBool(value bool) {
+	r.CumDividendIndicator = new(YesNoIndicator)
+	r.CumDividendIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution3) AddInterimProfitAmount() *ProfitAndLoss1Choice {
 	r.InterimProfitAmount = new(ProfitAndLoss1Choice)
 	return r.InterimProfitAmount
@@ -465,6 +496,11 @@ func (r *RedemptionExecution3) SetPhysicalDeliveryIndicator(value string) {
 	r.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution3) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	r.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	r.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution3) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	r.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return r.PhysicalDeliveryDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a redemption order.
 type RedemptionExecution4 struct {
 
@@ -82,6 +86,10 @@ type RedemptionExecution4 struct {
 	CashSettlementDetails *PaymentTransaction15 `xml:"CshSttlmDtls,omitempty"`
 }
 
+func (r *RedemptionExecution4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionExecution4) SetOrderReference(value string) {
 	r.OrderReference = (*Max35Text)(&value)
 }
@@ -254,14 +262,27 @@ func (r *RedemptionExecution4) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution4) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution4) SetHoldingsRedemptionRate(value string) {
 	r.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionExecution4) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	r.HoldingsRedemptionRate = new(PercentageRate)
+	r.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (r *RedemptionExecution4) SetGrossAmount(value, currency string) {
 	r.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution4) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution4) AddTradeDateTime() *DateAndDateTimeChoice {
 	r.TradeDateTime = new(DateAndDateTimeChoice)
 	return r.TradeDateTime
@@ -276,10 +297,20 @@ func (r *RedemptionExecution4) SetPartiallyExecutedIndicator(value string) {
 	r.PartiallyExecutedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution4) SetPartiallyExecutedIndicatorFromBool(value bool) {
+	r.PartiallyExecutedIndicator = new(YesNoIndicator)
+	r.PartiallyExecutedIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution4) SetCumDividendIndicator(value string) {
 	r.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution4) SetCumDividendIndicatorFromBool(value bool) {
+	r.CumDividendIndicator = new(YesNoIndicator)
+	r.CumDividendIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution4) AddInterimProfitAmount() *ProfitAndLoss1Choice {
 	r.InterimProfitAmount = new(ProfitAndLoss1Choice)
 	return r.InterimProfitAmount
@@ -463,6 +494,11 @@ func (r *RedemptionExecution4) SetPhysicalDeliveryIndicator(value string) {
 	r.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution4) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	r.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	r.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution4) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	r.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return r.PhysicalDeliveryDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a redemption order.
 type RedemptionExecution5 struct {
 
@@ -129,6 +135,10 @@ type RedemptionExecution5 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (r *RedemptionExecution5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionExecution5) SetOrderReference(value string) {
 	r.OrderReference = (*Max35Text)(&value)
 }
@@ -305,6 +315,10 @@ func (r *RedemptionExecution5) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution5) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution5) AddInvestmentAccountDetails() *InvestmentAccount21 {
 	r.InvestmentAccountDetails = new(InvestmentAccount21)
 	return r.InvestmentAccountDetails
@@ -314,10 +328,19 @@ func (r *RedemptionExecution5) SetHoldingsRedemptionRate(value string) {
 	r.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionExecution5) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	r.HoldingsRedemptionRate = new(PercentageRate)
+	r.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (r *RedemptionExecution5) SetGrossAmount(value, currency string) {
 	r.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution5) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution5) AddTradeDateTime() *DateAndDateTimeChoice {
 	r.TradeDateTime = new(DateAndDateTimeChoice)
 	return r.TradeDateTime
@@ -327,12 +350,25 @@ func (r *RedemptionExecution5) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution5) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution5) SetCashSettlementDate(value string) {
 	r.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (r *RedemptionExecution5) SetSettlementMethod(value string) {
+func (r *RedemptionExecution5) SetCashSettlementDateFromTime(value time.Time) {
+	r.CashSettlementDate = new(ISODate)
+	r.CashSettlementDate.FromTime(value)
+}
+
+func (r *RedemptionExecution5) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (r *RedemptionExecution5) AddDealingPriceDetails() *UnitPrice10 {
@@ -490,6 +526,11 @@ func (r *RedemptionExecution5) SetPartiallyExecutedIndicator(value string) {
 	r.PartiallyExecutedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution5) SetPartiallyExecutedIndicatorFromBool(value bool) {
+	r.PartiallyExecutedIndicator = new(YesNoIndicator)
+	r.PartiallyExecutedIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution5) SetBestExecution(value string) {
 	r.BestExecution = (*BestExecution1Code)(&value)
 }
@@ -498,6 +539,11 @@ func (r *RedemptionExecution5) SetCumDividendIndicator(value string) {
 	r.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution5) SetCumDividendIndicatorFromBool(value bool) {
+	r.CumDividendIndicator = new(YesNoIndicator)
+	r.CumDividendIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution5) AddInterimProfitAmount() *ProfitAndLoss1Choice {
 	r.InterimProfitAmount = new(ProfitAndLoss1Choice)
 	return r.InterimProfitAmount
@@ -827,6 +873,11 @@ func (r *RedemptionExecution5) SetPhysicalDeliveryIndicator(value string) {
 	r.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution5) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	r.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	r.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution5) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	r.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return r.PhysicalDeliveryDetails
@@ -845,14 +896,28 @@ func (r *RedemptionExecution5) SetPartialSettlementOfUnits(value string) {
 	r.PartialSettlementOfUnits = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionExecution5) SetPartialSettlementOfUnitsFromDecimal(value decimal.Decimal) {
+	r.PartialSettlementOfUnits = new(PercentageRate)
+	r.PartialSettlementOfUnits.FromDecimal(value)
+}
+
 func (r *RedemptionExecution5) SetPartialSettlementOfCash(value string) {
 	r.PartialSettlementOfCash = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionExecution5) SetPartialSettlementOfCashFromDecimal(value decimal.Decimal) {
+	r.PartialSettlementOfCash = new(PercentageRate)
+	r.PartialSettlementOfCash.FromDecimal(value)
+}
+
 func (r *RedemptionExecution5) SetPartialRedemptionWithholdingAmount(value, currency string) {
 	r.PartialRedemptionWithholdingAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution5) SetPartialRedemptionWithholdingAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.PartialRedemptionWithholdingAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution5) SetFinancialAdvice(value string) {
 	r.FinancialAdvice = (*FinancialAdvice1Code)(&value)
 }
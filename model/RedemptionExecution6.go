@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a redemption order.
 type RedemptionExecution6 struct {
 
@@ -135,6 +141,10 @@ type RedemptionExecution6 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (r *RedemptionExecution6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionExecution6) SetOrderReference(value string) {
 	r.OrderReference = (*Max35Text)(&value)
 }
@@ -316,14 +326,27 @@ func (r *RedemptionExecution6) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution6) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution6) SetHoldingsRedemptionRate(value string) {
 	r.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionExecution6) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	r.HoldingsRedemptionRate = new(PercentageRate)
+	r.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (r *RedemptionExecution6) SetGrossAmount(value, currency string) {
 	r.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution6) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution6) AddTradeDateTime() *DateAndDateTimeChoice {
 	r.TradeDateTime = new(DateAndDateTimeChoice)
 	return r.TradeDateTime
@@ -333,18 +356,36 @@ func (r *RedemptionExecution6) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution6) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution6) SetCashSettlementDate(value string) {
 	r.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (r *RedemptionExecution6) SetSettlementMethod(value string) {
+func (r *RedemptionExecution6) SetCashSettlementDateFromTime(value time.Time) {
+	r.CashSettlementDate = new(ISODate)
+	r.CashSettlementDate.FromTime(value)
+}
+
+func (r *RedemptionExecution6) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (r *RedemptionExecution6) SetPartiallyExecutedIndicator(value string) {
 	r.PartiallyExecutedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution6) SetPartiallyExecutedIndicatorFromBool(value bool) {
+	r.PartiallyExecutedIndicator = new(YesNoIndicator)
+	r.PartiallyExecutedIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution6) AddDealingPriceDetails() *UnitPrice10 {
 	r.DealingPriceDetails = new(UnitPrice10)
 	return r.DealingPriceDetails
@@ -504,6 +545,11 @@ func (r *RedemptionExecution6) SetCumDividendIndicator(value string) {
 	r.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution6) SetCumDividendIndicatorFromBool(value bool) {
+	r.CumDividendIndicator = new(YesNoIndicator)
+	r.CumDividendIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution6) AddInterimProfitAmount() *ProfitAndLoss1Choice {
 	r.InterimProfitAmount = new(ProfitAndLoss1Choice)
 	return r.InterimProfitAmount
@@ -833,6 +879,11 @@ func (r *RedemptionExecution6) SetPhysicalDeliveryIndicator(value string) {
 	r.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionExecution6) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	r.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	r.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (r *RedemptionExecution6) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	r.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return r.PhysicalDeliveryDetails
@@ -859,14 +910,28 @@ func (r *RedemptionExecution6) SetPartialSettlementOfUnits(value string) {
 	r.PartialSettlementOfUnits = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionExecution6) SetPartialSettlementOfUnitsFromDecimal(value decimal.Decimal) {
+	r.PartialSettlementOfUnits = new(PercentageRate)
+	r.PartialSettlementOfUnits.FromDecimal(value)
+}
+
 func (r *RedemptionExecution6) SetPartialSettlementOfCash(value string) {
 	r.PartialSettlementOfCash = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionExecution6) SetPartialSettlementOfCashFromDecimal(value decimal.Decimal) {
+	r.PartialSettlementOfCash = new(PercentageRate)
+	r.PartialSettlementOfCash.FromDecimal(value)
+}
+
 func (r *RedemptionExecution6) SetPartialRedemptionWithholdingAmount(value, currency string) {
 	r.PartialRedemptionWithholdingAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionExecution6) SetPartialRedemptionWithholdingAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.PartialRedemptionWithholdingAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionExecution6) SetFinancialAdvice(value string) {
 	r.FinancialAdvice = (*FinancialAdvice1Code)(&value)
 }
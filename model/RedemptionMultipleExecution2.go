@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Execution of a redemption order.
 type RedemptionMultipleExecution2 struct {
 
@@ -25,6 +29,10 @@ type RedemptionMultipleExecution2 struct {
 	BulkCashSettlementDetails *PaymentTransaction15 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (r *RedemptionMultipleExecution2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionMultipleExecution2) AddBeneficiaryDetails() *IndividualPerson2 {
 	r.BeneficiaryDetails = new(IndividualPerson2)
 	return r.BeneficiaryDetails
@@ -38,6 +46,11 @@ func (r *RedemptionMultipleExecution2) SetOrderDateTime(value string) {
 	r.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionMultipleExecution2) SetOrderDateTimeFromTime(value time.Time) {
+	r.OrderDateTime = new(ISODateTime)
+	r.OrderDateTime.FromTime(value)
+}
+
 func (r *RedemptionMultipleExecution2) AddCancellationRight() *CancellationRight1 {
 	r.CancellationRight = new(CancellationRight1)
 	return r.CancellationRight
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a redemption order.
 type RedemptionMultipleExecution3 struct {
 
@@ -41,6 +47,10 @@ type RedemptionMultipleExecution3 struct {
 	BulkCashSettlementDetails *PaymentTransaction22 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (r *RedemptionMultipleExecution3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionMultipleExecution3) SetMasterReference(value string) {
 	r.MasterReference = (*Max35Text)(&value)
 }
@@ -59,10 +69,20 @@ func (r *RedemptionMultipleExecution3) SetOrderDateTime(value string) {
 	r.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionMultipleExecution3) SetOrderDateTimeFromTime(value time.Time) {
+	r.OrderDateTime = new(ISODateTime)
+	r.OrderDateTime.FromTime(value)
+}
+
 func (r *RedemptionMultipleExecution3) SetRequestedFutureTradeDate(value string) {
 	r.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (r *RedemptionMultipleExecution3) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	r.RequestedFutureTradeDate = new(ISODate)
+	r.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (r *RedemptionMultipleExecution3) SetCancellationRight(value string) {
 	r.CancellationRight = (*CancellationRight1Code)(&value)
 }
@@ -226,10 +246,19 @@ func (r *RedemptionMultipleExecution3) SetTotalSettlementAmount(value, currency
 	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionMultipleExecution3) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionMultipleExecution3) SetCashSettlementDate(value string) {
 	r.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (r *RedemptionMultipleExecution3) SetCashSettlementDateFromTime(value time.Time) {
+	r.CashSettlementDate = new(ISODate)
+	r.CashSettlementDate.FromTime(value)
+}
+
 func (r *RedemptionMultipleExecution3) AddBulkCashSettlementDetails() *PaymentTransaction22 {
 	r.BulkCashSettlementDetails = new(PaymentTransaction22)
 	return r.BulkCashSettlementDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a redemption order.
 type RedemptionMultipleExecution5 struct {
 
@@ -41,10 +47,19 @@ type RedemptionMultipleExecution5 struct {
 	BulkCashSettlementDetails *PaymentTransaction72 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (r *RedemptionMultipleExecution5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionMultipleExecution5) SetAmendmentIndicator(value string) {
 	r.AmendmentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionMultipleExecution5) SetAmendmentIndicatorFromBool(value bool) {
+	r.AmendmentIndicator = new(YesNoIndicator)
+	r.AmendmentIndicator.FromBool(value)
+}
+
 func (r *RedemptionMultipleExecution5) SetMasterReference(value string) {
 	r.MasterReference = (*Max35Text)(&value)
 }
@@ -58,14 +73,29 @@ func (r *RedemptionMultipleExecution5) SetOrderDateTime(value string) {
 	r.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionMultipleExecution5) SetOrderDateTimeFromTime(value time.Time) {
+	r.OrderDateTime = new(ISODateTime)
+	r.OrderDateTime.FromTime(value)
+}
+
 func (r *RedemptionMultipleExecution5) SetReceivedDateTime(value string) {
 	r.ReceivedDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionMultipleExecution5) SetReceivedDateTimeFromTime(value time.Time) {
+	r.ReceivedDateTime = new(ISODateTime)
+	r.ReceivedDateTime.FromTime(value)
+}
+
 func (r *RedemptionMultipleExecution5) SetRequestedFutureTradeDate(value string) {
 	r.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (r *RedemptionMultipleExecution5) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	r.RequestedFutureTradeDate = new(ISODate)
+	r.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (r *RedemptionMultipleExecution5) AddCancellationRight() *CancellationRight1Choice {
 	r.CancellationRight = new(CancellationRight1Choice)
 	return r.CancellationRight
@@ -372,6 +402,10 @@ func (r *RedemptionMultipleExecution5) SetTotalSettlementAmount(value, currency
 	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionMultipleExecution5) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionMultipleExecution5) AddBulkCashSettlementDetails() *PaymentTransaction72 {
 	r.BulkCashSettlementDetails = new(PaymentTransaction72)
 	return r.BulkCashSettlementDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Instruction from an investor to sell investment fund units back to the fund.
 type RedemptionMultipleOrder2 struct {
 
@@ -28,6 +32,10 @@ type RedemptionMultipleOrder2 struct {
 	BulkCashSettlementDetails *PaymentTransaction15 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (r *RedemptionMultipleOrder2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionMultipleOrder2) SetPlaceOfTrade(value string) {
 	r.PlaceOfTrade = (*CountryCode)(&value)
 }
@@ -36,10 +44,20 @@ func (r *RedemptionMultipleOrder2) SetOrderDateTime(value string) {
 	r.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionMultipleOrder2) SetOrderDateTimeFromTime(value time.Time) {
+	r.OrderDateTime = new(ISODateTime)
+	r.OrderDateTime.FromTime(value)
+}
+
 func (r *RedemptionMultipleOrder2) SetExpiryDateTime(value string) {
 	r.ExpiryDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionMultipleOrder2) SetExpiryDateTimeFromTime(value time.Time) {
+	r.ExpiryDateTime = new(ISODateTime)
+	r.ExpiryDateTime.FromTime(value)
+}
+
 func (r *RedemptionMultipleOrder2) AddCancellationRight() *CancellationRight1 {
 	r.CancellationRight = new(CancellationRight1)
 	return r.CancellationRight
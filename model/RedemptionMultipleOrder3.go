@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Instruction from an investor to sell investment fund units back to the fund.
 type RedemptionMultipleOrder3 struct {
 
@@ -44,6 +50,10 @@ type RedemptionMultipleOrder3 struct {
 	CashSettlementDate *ISODate `xml:"CshSttlmDt,omitempty"`
 }
 
+func (r *RedemptionMultipleOrder3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionMultipleOrder3) SetMasterReference(value string) {
 	r.MasterReference = (*Max35Text)(&value)
 }
@@ -57,6 +67,11 @@ func (r *RedemptionMultipleOrder3) SetOrderDateTime(value string) {
 	r.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionMultipleOrder3) SetOrderDateTimeFromTime(value time.Time) {
+	r.OrderDateTime = new(ISODateTime)
+	r.OrderDateTime.FromTime(value)
+}
+
 func (r *RedemptionMultipleOrder3) AddExpiryDateTime() *DateAndDateTimeChoice {
 	r.ExpiryDateTime = new(DateAndDateTimeChoice)
 	return r.ExpiryDateTime
@@ -66,6 +81,11 @@ func (r *RedemptionMultipleOrder3) SetRequestedFutureTradeDate(value string) {
 	r.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (r *RedemptionMultipleOrder3) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	r.RequestedFutureTradeDate = new(ISODate)
+	r.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (r *RedemptionMultipleOrder3) AddInvestmentAccountDetails() *InvestmentAccount21 {
 	r.InvestmentAccountDetails = new(InvestmentAccount21)
 	return r.InvestmentAccountDetails
@@ -239,6 +259,15 @@ func (r *RedemptionMultipleOrder3) SetTotalSettlementAmount(value, currency stri
 	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionMultipleOrder3) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionMultipleOrder3) SetCashSettlementDate(value string) {
 	r.CashSettlementDate = (*ISODate)(&value)
 }
+
+func (r *RedemptionMultipleOrder3) SetCashSettlementDateFromTime(value time.Time) {
+	r.CashSettlementDate = new(ISODate)
+	r.CashSettlementDate.FromTime(value)
+}
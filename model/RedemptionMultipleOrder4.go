@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Instruction from an investor to sell investment fund units back to the fund.
 type RedemptionMultipleOrder4 struct {
 
@@ -44,6 +50,10 @@ type RedemptionMultipleOrder4 struct {
 	CashSettlementDate *ISODate `xml:"CshSttlmDt,omitempty"`
 }
 
+func (r *RedemptionMultipleOrder4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionMultipleOrder4) SetMasterReference(value string) {
 	r.MasterReference = (*Max35Text)(&value)
 }
@@ -57,6 +67,11 @@ func (r *RedemptionMultipleOrder4) SetOrderDateTime(value string) {
 	r.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionMultipleOrder4) SetOrderDateTimeFromTime(value time.Time) {
+	r.OrderDateTime = new(ISODateTime)
+	r.OrderDateTime.FromTime(value)
+}
+
 func (r *RedemptionMultipleOrder4) AddExpiryDateTime() *DateAndDateTimeChoice {
 	r.ExpiryDateTime = new(DateAndDateTimeChoice)
 	return r.ExpiryDateTime
@@ -66,6 +81,11 @@ func (r *RedemptionMultipleOrder4) SetRequestedFutureTradeDate(value string) {
 	r.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (r *RedemptionMultipleOrder4) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	r.RequestedFutureTradeDate = new(ISODate)
+	r.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (r *RedemptionMultipleOrder4) SetCancellationRight(value string) {
 	r.CancellationRight = (*CancellationRight1Code)(&value)
 }
@@ -239,6 +259,15 @@ func (r *RedemptionMultipleOrder4) SetTotalSettlementAmount(value, currency stri
 	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionMultipleOrder4) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionMultipleOrder4) SetCashSettlementDate(value string) {
 	r.CashSettlementDate = (*ISODate)(&value)
 }
+
+func (r *RedemptionMultipleOrder4) SetCashSettlementDateFromTime(value time.Time) {
+	r.CashSettlementDate = new(ISODate)
+	r.CashSettlementDate.FromTime(value)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Instruction from an investor to sell investment fund units back to the fund.
 type RedemptionMultipleOrder6 struct {
 
@@ -38,6 +44,10 @@ type RedemptionMultipleOrder6 struct {
 	TotalSettlementAmount *ActiveCurrencyAndAmount `xml:"TtlSttlmAmt,omitempty"`
 }
 
+func (r *RedemptionMultipleOrder6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionMultipleOrder6) SetMasterReference(value string) {
 	r.MasterReference = (*Max35Text)(&value)
 }
@@ -51,6 +61,11 @@ func (r *RedemptionMultipleOrder6) SetOrderDateTime(value string) {
 	r.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RedemptionMultipleOrder6) SetOrderDateTimeFromTime(value time.Time) {
+	r.OrderDateTime = new(ISODateTime)
+	r.OrderDateTime.FromTime(value)
+}
+
 func (r *RedemptionMultipleOrder6) AddExpiryDateTime() *DateAndDateTimeChoice {
 	r.ExpiryDateTime = new(DateAndDateTimeChoice)
 	return r.ExpiryDateTime
@@ -60,6 +75,11 @@ func (r *RedemptionMultipleOrder6) SetRequestedFutureTradeDate(value string) {
 	r.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (r *RedemptionMultipleOrder6) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	r.RequestedFutureTradeDate = new(ISODate)
+	r.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (r *RedemptionMultipleOrder6) AddCancellationRight() *CancellationRight1Choice {
 	r.CancellationRight = new(CancellationRight1Choice)
 	return r.CancellationRight
@@ -370,3 +390,7 @@ func (r *RedemptionMultipleOrder6) AddBulkCashSettlementDetails() *PaymentTransa
 func (r *RedemptionMultipleOrder6) SetTotalSettlementAmount(value, currency string) {
 	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (r *RedemptionMultipleOrder6) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -16,6 +16,10 @@ type RedemptionMultipleOrderInstruction1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (r *RedemptionMultipleOrderInstruction1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionMultipleOrderInstruction1) AddMultipleOrderDetails() *RedemptionMultipleOrder2 {
 	r.MultipleOrderDetails = new(RedemptionMultipleOrder2)
 	return r.MultipleOrderDetails
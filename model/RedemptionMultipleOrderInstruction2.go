@@ -13,6 +13,10 @@ type RedemptionMultipleOrderInstruction2 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (r *RedemptionMultipleOrderInstruction2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionMultipleOrderInstruction2) AddMultipleOrderDetails() *RedemptionMultipleOrder3 {
 	r.MultipleOrderDetails = new(RedemptionMultipleOrder3)
 	return r.MultipleOrderDetails
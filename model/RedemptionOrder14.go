@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Instruction from an investor to sell investment fund units back to the fund.
 type RedemptionOrder14 struct {
 
@@ -103,6 +109,10 @@ type RedemptionOrder14 struct {
 	OrderWaiverDetails *OrderWaiver1 `xml:"OrdrWvrDtls,omitempty"`
 }
 
+func (r *RedemptionOrder14) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionOrder14) SetOrderReference(value string) {
 	r.OrderReference = (*Max35Text)(&value)
 }
@@ -280,12 +290,25 @@ func (r *RedemptionOrder14) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionOrder14) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionOrder14) SetCashSettlementDate(value string) {
 	r.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (r *RedemptionOrder14) SetSettlementMethod(value string) {
+func (r *RedemptionOrder14) SetCashSettlementDateFromTime(value time.Time) {
+	r.CashSettlementDate = new(ISODate)
+	r.CashSettlementDate.FromTime(value)
+}
+
+func (r *RedemptionOrder14) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (r *RedemptionOrder14) AddForeignExchangeDetails() *ForeignExchangeTerms32 {
@@ -315,6 +338,11 @@ func (r *RedemptionOrder14) SetPhysicalDeliveryIndicator(value string) {
 	r.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionOrder14) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	r.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	r.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (r *RedemptionOrder14) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	r.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return r.PhysicalDeliveryDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Instruction from an investor to sell investment fund units back to the fund.
 type RedemptionOrder15 struct {
 
@@ -97,6 +103,10 @@ type RedemptionOrder15 struct {
 	OrderWaiverDetails *OrderWaiver1 `xml:"OrdrWvrDtls,omitempty"`
 }
 
+func (r *RedemptionOrder15) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionOrder15) SetOrderReference(value string) {
 	r.OrderReference = (*Max35Text)(&value)
 }
@@ -415,12 +425,25 @@ func (r *RedemptionOrder15) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionOrder15) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionOrder15) SetCashSettlementDate(value string) {
 	r.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (r *RedemptionOrder15) SetSettlementMethod(value string) {
+func (r *RedemptionOrder15) SetCashSettlementDateFromTime(value time.Time) {
+	r.CashSettlementDate = new(ISODate)
+	r.CashSettlementDate.FromTime(value)
+}
+
+func (r *RedemptionOrder15) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (r *RedemptionOrder15) AddForeignExchangeDetails() *ForeignExchangeTerms32 {
@@ -450,6 +473,11 @@ func (r *RedemptionOrder15) SetPhysicalDeliveryIndicator(value string) {
 	r.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionOrder15) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	r.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	r.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (r *RedemptionOrder15) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	r.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return r.PhysicalDeliveryDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Instruction from an investor to sell investment fund units back to the fund.
 type RedemptionOrder3 struct {
 
@@ -61,6 +65,10 @@ type RedemptionOrder3 struct {
 	CashSettlementDetails *PaymentTransaction18 `xml:"CshSttlmDtls,omitempty"`
 }
 
+func (r *RedemptionOrder3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionOrder3) SetOrderReference(value string) {
 	r.OrderReference = (*Max35Text)(&value)
 }
@@ -230,10 +238,19 @@ func (r *RedemptionOrder3) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionOrder3) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionOrder3) SetHoldingsRedemptionRate(value string) {
 	r.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionOrder3) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	r.HoldingsRedemptionRate = new(PercentageRate)
+	r.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (r *RedemptionOrder3) SetRounding(value string) {
 	r.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -242,6 +259,10 @@ func (r *RedemptionOrder3) SetGrossAmount(value, currency string) {
 	r.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionOrder3) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionOrder3) AddForeignExchangeDetails() *ForeignExchangeTerms5 {
 	r.ForeignExchangeDetails = new(ForeignExchangeTerms5)
 	return r.ForeignExchangeDetails
@@ -702,6 +723,11 @@ func (r *RedemptionOrder3) SetPhysicalDeliveryIndicator(value string) {
 	r.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionOrder3) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	r.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	r.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (r *RedemptionOrder3) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	r.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return r.PhysicalDeliveryDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Instruction from an investor to sell investment fund units back to the fund.
 type RedemptionOrder4 struct {
 
@@ -64,6 +68,10 @@ type RedemptionOrder4 struct {
 	CashSettlementDetails *PaymentTransaction15 `xml:"CshSttlmDtls,omitempty"`
 }
 
+func (r *RedemptionOrder4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionOrder4) SetOrderReference(value string) {
 	r.OrderReference = (*Max35Text)(&value)
 }
@@ -228,10 +236,19 @@ func (r *RedemptionOrder4) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionOrder4) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionOrder4) SetHoldingsRedemptionRate(value string) {
 	r.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionOrder4) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	r.HoldingsRedemptionRate = new(PercentageRate)
+	r.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (r *RedemptionOrder4) SetRounding(value string) {
 	r.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -240,6 +257,10 @@ func (r *RedemptionOrder4) SetGrossAmount(value, currency string) {
 	r.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionOrder4) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionOrder4) AddForeignExchangeDetails() *ForeignExchangeTerms5 {
 	r.ForeignExchangeDetails = new(ForeignExchangeTerms5)
 	return r.ForeignExchangeDetails
@@ -700,6 +721,11 @@ func (r *RedemptionOrder4) SetPhysicalDeliveryIndicator(value string) {
 	r.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionOrder4) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	r.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	r.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (r *RedemptionOrder4) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	r.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return r.PhysicalDeliveryDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Instruction from an investor to sell investment fund units back to the fund.
 type RedemptionOrder5 struct {
 
@@ -96,6 +102,10 @@ type RedemptionOrder5 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (r *RedemptionOrder5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionOrder5) SetOrderReference(value string) {
 	r.OrderReference = (*Max35Text)(&value)
 }
@@ -273,14 +283,27 @@ func (r *RedemptionOrder5) SetGrossAmount(value, currency string) {
 	r.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionOrder5) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionOrder5) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionOrder5) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionOrder5) SetHoldingsRedemptionRate(value string) {
 	r.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionOrder5) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	r.HoldingsRedemptionRate = new(PercentageRate)
+	r.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (r *RedemptionOrder5) SetRounding(value string) {
 	r.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -289,12 +312,25 @@ func (r *RedemptionOrder5) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionOrder5) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionOrder5) SetCashSettlementDate(value string) {
 	r.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (r *RedemptionOrder5) SetSettlementMethod(value string) {
+func (r *RedemptionOrder5) SetCashSettlementDateFromTime(value time.Time) {
+	r.CashSettlementDate = new(ISODate)
+	r.CashSettlementDate.FromTime(value)
+}
+
+func (r *RedemptionOrder5) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (r *RedemptionOrder5) AddForeignExchangeDetails() *ForeignExchangeTerms6 {
@@ -757,6 +793,11 @@ func (r *RedemptionOrder5) SetPhysicalDeliveryIndicator(value string) {
 	r.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionOrder5) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	r.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	r.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (r *RedemptionOrder5) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	r.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return r.PhysicalDeliveryDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Instruction from an investor to sell investment fund units back to the fund.
 type RedemptionOrder6 struct {
 
@@ -102,6 +108,10 @@ type RedemptionOrder6 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (r *RedemptionOrder6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionOrder6) SetOrderReference(value string) {
 	r.OrderReference = (*Max35Text)(&value)
 }
@@ -279,14 +289,27 @@ func (r *RedemptionOrder6) SetGrossAmount(value, currency string) {
 	r.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionOrder6) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionOrder6) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionOrder6) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionOrder6) SetHoldingsRedemptionRate(value string) {
 	r.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionOrder6) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	r.HoldingsRedemptionRate = new(PercentageRate)
+	r.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (r *RedemptionOrder6) SetRounding(value string) {
 	r.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -295,12 +318,25 @@ func (r *RedemptionOrder6) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionOrder6) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionOrder6) SetCashSettlementDate(value string) {
 	r.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (r *RedemptionOrder6) SetSettlementMethod(value string) {
+func (r *RedemptionOrder6) SetCashSettlementDateFromTime(value time.Time) {
+	r.CashSettlementDate = new(ISODate)
+	r.CashSettlementDate.FromTime(value)
+}
+
+func (r *RedemptionOrder6) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (r *RedemptionOrder6) AddForeignExchangeDetails() *ForeignExchangeTerms6 {
@@ -763,6 +799,11 @@ func (r *RedemptionOrder6) SetPhysicalDeliveryIndicator(value string) {
 	r.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionOrder6) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	r.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	r.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (r *RedemptionOrder6) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	r.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return r.PhysicalDeliveryDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Instruction from an investor to sell investment fund units back to the fund.
 type RedemptionOrder7 struct {
 
@@ -93,6 +99,10 @@ type RedemptionOrder7 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (r *RedemptionOrder7) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionOrder7) SetOrderReference(value string) {
 	r.OrderReference = (*Max35Text)(&value)
 }
@@ -266,14 +276,27 @@ func (r *RedemptionOrder7) SetGrossAmount(value, currency string) {
 	r.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionOrder7) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionOrder7) SetNetAmount(value, currency string) {
 	r.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionOrder7) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionOrder7) SetHoldingsRedemptionRate(value string) {
 	r.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (r *RedemptionOrder7) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	r.HoldingsRedemptionRate = new(PercentageRate)
+	r.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (r *RedemptionOrder7) SetRounding(value string) {
 	r.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -282,12 +305,25 @@ func (r *RedemptionOrder7) SetSettlementAmount(value, currency string) {
 	r.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RedemptionOrder7) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RedemptionOrder7) SetCashSettlementDate(value string) {
 	r.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (r *RedemptionOrder7) SetSettlementMethod(value string) {
+func (r *RedemptionOrder7) SetCashSettlementDateFromTime(value time.Time) {
+	r.CashSettlementDate = new(ISODate)
+	r.CashSettlementDate.FromTime(value)
+}
+
+func (r *RedemptionOrder7) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (r *RedemptionOrder7) AddForeignExchangeDetails() *ForeignExchangeTerms6 {
@@ -750,6 +786,11 @@ func (r *RedemptionOrder7) SetPhysicalDeliveryIndicator(value string) {
 	r.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionOrder7) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	r.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	r.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (r *RedemptionOrder7) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	r.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return r.PhysicalDeliveryDetails
@@ -16,10 +16,19 @@ type RedemptionOrderConfirmation1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (r *RedemptionOrderConfirmation1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RedemptionOrderConfirmation1) SetAmendmentIndicator(value string) {
 	r.AmendmentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RedemptionOrderConfirmation1) SetAmendmentIndicatorFromBool(value bool) {
+	r.AmendmentIndicator = new(YesNoIndicator)
+	r.AmendmentIndicator.FromBool(value)
+}
+
 func (r *RedemptionOrderConfirmation1) AddMultipleExecutionDetails() *RedemptionMultipleExecution3 {
 	r.MultipleExecutionDetails = new(RedemptionMultipleExecution3)
 	return r.MultipleExecutionDetails
@@ -7,6 +7,10 @@ type Reference16 struct {
 	CollateralMessageCancellationRequestIdentification *Max35Text `xml:"CollMsgCxlReqId"`
 }
 
+func (r *Reference16) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reference16) SetCollateralMessageCancellationRequestIdentification(value string) {
 	r.CollateralMessageCancellationRequestIdentification = (*Max35Text)(&value)
 }
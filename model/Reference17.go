@@ -10,6 +10,10 @@ type Reference17 struct {
 	CollateralSubstitutionResponseIdentification *Max35Text `xml:"CollSbstitnRspnId,omitempty"`
 }
 
+func (r *Reference17) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reference17) SetCollateralSubstitutionRequestIdentification(value string) {
 	r.CollateralSubstitutionRequestIdentification = (*Max35Text)(&value)
 }
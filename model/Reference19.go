@@ -10,6 +10,10 @@ type Reference19 struct {
 	NetPositionIdentification *Max35Text `xml:"NetPosId,omitempty"`
 }
 
+func (r *Reference19) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reference19) AddTradeLegNotificationIdentification(value string) {
 	r.TradeLegNotificationIdentification = append(r.TradeLegNotificationIdentification, (*Max35Text)(&value))
 }
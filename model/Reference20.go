@@ -10,6 +10,10 @@ type Reference20 struct {
 	InterestPaymentResponseIdentification *Max35Text `xml:"IntrstPmtRspnId,omitempty"`
 }
 
+func (r *Reference20) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reference20) SetInterestPaymentRequestIdentification(value string) {
 	r.InterestPaymentRequestIdentification = (*Max35Text)(&value)
 }
@@ -37,6 +37,10 @@ type Reference2Choice struct {
 	InterestPaymentStatementIdentification *Max35Text `xml:"IntrstPmtStmtId"`
 }
 
+func (r *Reference2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reference2Choice) SetMarginCallRequestIdentification(value string) {
 	r.MarginCallRequestIdentification = (*Max35Text)(&value)
 }
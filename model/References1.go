@@ -22,6 +22,10 @@ type References1 struct {
 	TradeIdentification *Max35Text `xml:"TradId,omitempty"`
 }
 
+func (r *References1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References1) SetAccountOwnerTransactionIdentification(value string) {
 	r.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type References11 struct {
 	CounterpartyReference *AdditionalReference2 `xml:"CtrPtyRef,omitempty"`
 }
 
+func (r *References11) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References11) AddPoolReference() *AdditionalReference2 {
 	r.PoolReference = new(AdditionalReference2)
 	return r.PoolReference
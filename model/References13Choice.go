@@ -31,6 +31,10 @@ type References13Choice struct {
 	OtherTransactionIdentification *Max35Text `xml:"OthrTxId,omitempty"`
 }
 
+func (r *References13Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References13Choice) SetSecuritiesSettlementTransactionIdentification(value string) {
 	r.SecuritiesSettlementTransactionIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type References14Choice struct {
 	OtherTransactionIdentification *Max35Text `xml:"OthrTxId"`
 }
 
+func (r *References14Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References14Choice) SetSecuritiesSettlementTransactionIdentification(value string) {
 	r.SecuritiesSettlementTransactionIdentification = (*Max35Text)(&value)
 }
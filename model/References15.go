@@ -13,6 +13,10 @@ type References15 struct {
 	RelatedReference *AdditionalReference2 `xml:"RltdRef,omitempty"`
 }
 
+func (r *References15) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References15) AddPoolReference() *AdditionalReference2 {
 	r.PoolReference = new(AdditionalReference2)
 	return r.PoolReference
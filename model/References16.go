@@ -25,6 +25,10 @@ type References16 struct {
 	TradeIdentification *Max35Text `xml:"TradId,omitempty"`
 }
 
+func (r *References16) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References16) SetAccountOwnerTransactionIdentification(value string) {
 	r.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type References18 struct {
 	TradeIdentification *Max35Text `xml:"TradId,omitempty"`
 }
 
+func (r *References18) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References18) SetAccountOwnerTransactionIdentification(value string) {
 	r.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -43,6 +43,10 @@ type References18Choice struct {
 	OtherMessageIdentification *Max35Text `xml:"OthrMsgId"`
 }
 
+func (r *References18Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References18Choice) AddSecuritiesSettlementTransactionConfirmationIdentification() *SettlementTypeAndIdentification13 {
 	r.SecuritiesSettlementTransactionConfirmationIdentification = new(SettlementTypeAndIdentification13)
 	return r.SecuritiesSettlementTransactionConfirmationIdentification
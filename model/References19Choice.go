@@ -19,6 +19,10 @@ type References19Choice struct {
 	ProcessorTransactionIdentification *Max35Text `xml:"PrcrTxId"`
 }
 
+func (r *References19Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References19Choice) SetAccountOwnerTransactionIdentification(value string) {
 	r.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
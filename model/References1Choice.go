@@ -19,6 +19,10 @@ type References1Choice struct {
 	OtherTransactionIdentification *Max35Text `xml:"OthrTxId"`
 }
 
+func (r *References1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References1Choice) SetSecuritiesSettlementTransactionIdentification(value string) {
 	r.SecuritiesSettlementTransactionIdentification = (*Max35Text)(&value)
 }
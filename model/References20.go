@@ -13,6 +13,10 @@ type References20 struct {
 	RelatedReference *AdditionalReference6 `xml:"RltdRef,omitempty"`
 }
 
+func (r *References20) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References20) AddPoolReference() *AdditionalReference6 {
 	r.PoolReference = new(AdditionalReference6)
 	return r.PoolReference
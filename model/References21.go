@@ -25,6 +25,10 @@ type References21 struct {
 	TradeIdentification *RestrictedFINXMax16Text `xml:"TradId,omitempty"`
 }
 
+func (r *References21) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References21) SetAccountOwnerTransactionIdentification(value string) {
 	r.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
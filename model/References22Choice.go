@@ -16,6 +16,10 @@ type References22Choice struct {
 	IntraPositionMovementIdentification *Max35Text `xml:"IntraPosMvmntId"`
 }
 
+func (r *References22Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References22Choice) AddOtherTransactionIdentification() *GenericDocumentIdentification1 {
 	r.OtherTransactionIdentification = new(GenericDocumentIdentification1)
 	return r.OtherTransactionIdentification
@@ -31,6 +31,10 @@ type References24Choice struct {
 	OtherTransactionIdentification *Max35Text `xml:"OthrTxId"`
 }
 
+func (r *References24Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References24Choice) SetSecuritiesSettlementTransactionIdentification(value string) {
 	r.SecuritiesSettlementTransactionIdentification = (*Max35Text)(&value)
 }
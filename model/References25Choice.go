@@ -25,6 +25,10 @@ type References25Choice struct {
 	OtherTransactionIdentification *Max35Text `xml:"OthrTxId"`
 }
 
+func (r *References25Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References25Choice) SetSecuritiesSettlementTransactionIdentification(value string) {
 	r.SecuritiesSettlementTransactionIdentification = (*Max35Text)(&value)
 }
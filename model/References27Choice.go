@@ -19,6 +19,10 @@ type References27Choice struct {
 	ProcessorTransactionIdentification *Max35Text `xml:"PrcrTxId"`
 }
 
+func (r *References27Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References27Choice) SetAccountOwnerTransactionIdentification(value string) {
 	r.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
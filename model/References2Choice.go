@@ -16,6 +16,10 @@ type References2Choice struct {
 	OtherTransactionIdentification *GenericDocumentIdentification1 `xml:"OthrTxId"`
 }
 
+func (r *References2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References2Choice) AddSecuritiesSettlementTransactionIdentification() *SettlementTypeAndIdentification3 {
 	r.SecuritiesSettlementTransactionIdentification = new(SettlementTypeAndIdentification3)
 	return r.SecuritiesSettlementTransactionIdentification
@@ -19,6 +19,10 @@ type References3 struct {
 	AttachedDocumentName []*Max70Text `xml:"AttchdDocNm,omitempty"`
 }
 
+func (r *References3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References3) AddMessageIdentification() *MessageIdentification1 {
 	r.MessageIdentification = new(MessageIdentification1)
 	return r.MessageIdentification
@@ -46,6 +46,10 @@ type References37Choice struct {
 	TotalPortfolioValuationReportIdentification *Max35Text `xml:"TtlPrtflValtnRptId"`
 }
 
+func (r *References37Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References37Choice) AddSecuritiesSettlementTransactionConfirmationIdentification() *SettlementTypeAndIdentification13 {
 	r.SecuritiesSettlementTransactionConfirmationIdentification = new(SettlementTypeAndIdentification13)
 	return r.SecuritiesSettlementTransactionConfirmationIdentification
@@ -10,6 +10,10 @@ type References39Choice struct {
 	OtherReference *AdditionalReference3 `xml:"OthrRef"`
 }
 
+func (r *References39Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References39Choice) AddPreviousReference() *AdditionalReference3 {
 	r.PreviousReference = new(AdditionalReference3)
 	return r.PreviousReference
@@ -43,6 +43,10 @@ type References3Choice struct {
 	OtherMessageIdentification *Max35Text `xml:"OthrMsgId"`
 }
 
+func (r *References3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References3Choice) SetSecuritiesSettlementTransactionConfirmationIdentification(value string) {
 	r.SecuritiesSettlementTransactionConfirmationIdentification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type References4 struct {
 	AttachedDocumentName []*Max70Text `xml:"AttchdDocNm,omitempty"`
 }
 
+func (r *References4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References4) AddMessageIdentification() *MessageIdentification1 {
 	r.MessageIdentification = new(MessageIdentification1)
 	return r.MessageIdentification
@@ -10,6 +10,10 @@ type References40Choice struct {
 	OtherReference []*AdditionalReference3 `xml:"OthrRef"`
 }
 
+func (r *References40Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References40Choice) AddRelatedReference() *AdditionalReference3 {
 	newValue := new(AdditionalReference3)
 	r.RelatedReference = append(r.RelatedReference, newValue)
@@ -25,6 +25,10 @@ type References41Choice struct {
 	OtherTransactionIdentification *Max35Text `xml:"OthrTxId"`
 }
 
+func (r *References41Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References41Choice) SetSecuritiesSettlementTransactionIdentification(value string) {
 	r.SecuritiesSettlementTransactionIdentification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type References42Choice struct {
 	ProcessorTransactionIdentification *Max35Text `xml:"PrcrTxId"`
 }
 
+func (r *References42Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References42Choice) SetAccountOwnerTransactionIdentification(value string) {
 	r.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -46,6 +46,10 @@ type References43Choice struct {
 	TotalPortfolioValuationReportIdentification *Max35Text `xml:"TtlPrtflValtnRptId"`
 }
 
+func (r *References43Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References43Choice) AddSecuritiesSettlementTransactionConfirmationIdentification() *SettlementTypeAndIdentification18 {
 	r.SecuritiesSettlementTransactionConfirmationIdentification = new(SettlementTypeAndIdentification18)
 	return r.SecuritiesSettlementTransactionConfirmationIdentification
@@ -16,6 +16,10 @@ type References44Choice struct {
 	IntraPositionMovementIdentification *Max35Text `xml:"IntraPosMvmntId"`
 }
 
+func (r *References44Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References44Choice) AddOtherTransactionIdentification() *GenericDocumentIdentification4 {
 	r.OtherTransactionIdentification = new(GenericDocumentIdentification4)
 	return r.OtherTransactionIdentification
@@ -16,6 +16,10 @@ type References45Choice struct {
 	OtherTransactionIdentification *GenericDocumentIdentification4 `xml:"OthrTxId"`
 }
 
+func (r *References45Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References45Choice) AddSecuritiesSettlementTransactionIdentification() *SettlementTypeAndIdentification18 {
 	r.SecuritiesSettlementTransactionIdentification = new(SettlementTypeAndIdentification18)
 	return r.SecuritiesSettlementTransactionIdentification
@@ -31,6 +31,10 @@ type References46Choice struct {
 	OtherTransactionIdentification *Max35Text `xml:"OthrTxId"`
 }
 
+func (r *References46Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References46Choice) SetSecuritiesSettlementTransactionIdentification(value string) {
 	r.SecuritiesSettlementTransactionIdentification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type References47Choice struct {
 	OtherTransactionIdentification *Max35Text `xml:"OthrTxId"`
 }
 
+func (r *References47Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References47Choice) SetSecuritiesSettlementTransactionIdentification(value string) {
 	r.SecuritiesSettlementTransactionIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type References48Choice struct {
 	OtherReference *AdditionalReference6 `xml:"OthrRef"`
 }
 
+func (r *References48Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References48Choice) AddPreviousReference() *AdditionalReference6 {
 	r.PreviousReference = new(AdditionalReference6)
 	return r.PreviousReference
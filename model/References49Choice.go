@@ -10,6 +10,10 @@ type References49Choice struct {
 	OtherReference []*AdditionalReference6 `xml:"OthrRef"`
 }
 
+func (r *References49Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References49Choice) AddRelatedReference() *AdditionalReference6 {
 	newValue := new(AdditionalReference6)
 	r.RelatedReference = append(r.RelatedReference, newValue)
@@ -16,6 +16,10 @@ type References4Choice struct {
 	IntraPositionMovementIdentification *Max35Text `xml:"IntraPosMvmntId"`
 }
 
+func (r *References4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References4Choice) AddOtherTransactionIdentification() *GenericDocumentIdentification1 {
 	r.OtherTransactionIdentification = new(GenericDocumentIdentification1)
 	return r.OtherTransactionIdentification
@@ -22,6 +22,10 @@ type References5 struct {
 	AttachedDocumentName []*Max70Text `xml:"AttchdDocNm,omitempty"`
 }
 
+func (r *References5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References5) SetRequestType(value string) {
 	r.RequestType = (*UseCases1Code)(&value)
 }
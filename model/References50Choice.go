@@ -25,6 +25,10 @@ type References50Choice struct {
 	OtherTransactionIdentification *RestrictedFINXMax16Text `xml:"OthrTxId"`
 }
 
+func (r *References50Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References50Choice) SetSecuritiesSettlementTransactionIdentification(value string) {
 	r.SecuritiesSettlementTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
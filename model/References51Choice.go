@@ -19,6 +19,10 @@ type References51Choice struct {
 	ProcessorTransactionIdentification *RestrictedFINXMax16Text `xml:"PrcrTxId"`
 }
 
+func (r *References51Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References51Choice) SetAccountOwnerTransactionIdentification(value string) {
 	r.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -46,6 +46,10 @@ type References53Choice struct {
 	TotalPortfolioValuationReportIdentification *RestrictedFINXMax16Text `xml:"TtlPrtflValtnRptId"`
 }
 
+func (r *References53Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References53Choice) AddSecuritiesSettlementTransactionConfirmationIdentification() *SettlementTypeAndIdentification22 {
 	r.SecuritiesSettlementTransactionConfirmationIdentification = new(SettlementTypeAndIdentification22)
 	return r.SecuritiesSettlementTransactionConfirmationIdentification
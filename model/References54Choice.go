@@ -31,6 +31,10 @@ type References54Choice struct {
 	OtherTransactionIdentification *RestrictedFINXMax16Text `xml:"OthrTxId"`
 }
 
+func (r *References54Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References54Choice) SetSecuritiesSettlementTransactionIdentification(value string) {
 	r.SecuritiesSettlementTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -19,6 +19,10 @@ type References58Choice struct {
 	OtherTransactionIdentification *RestrictedFINXMax16Text `xml:"OthrTxId"`
 }
 
+func (r *References58Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References58Choice) SetSecuritiesSettlementTransactionIdentification(value string) {
 	r.SecuritiesSettlementTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -16,6 +16,10 @@ type References59Choice struct {
 	IntraPositionMovementIdentification *RestrictedFINXMax16Text `xml:"IntraPosMvmntId"`
 }
 
+func (r *References59Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References59Choice) AddOtherTransactionIdentification() *GenericDocumentIdentification5 {
 	r.OtherTransactionIdentification = new(GenericDocumentIdentification5)
 	return r.OtherTransactionIdentification
@@ -16,6 +16,10 @@ type References5Choice struct {
 	MarketInfrastructureTransactionIdentification *Max35Text `xml:"MktInfrstrctrTxId"`
 }
 
+func (r *References5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References5Choice) SetAccountOwnerTransactionIdentification(value string) {
 	r.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type References6 struct {
 	AttachedDocumentName []*Max70Text `xml:"AttchdDocNm,omitempty"`
 }
 
+func (r *References6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References6) SetRejectedRequestType(value string) {
 	r.RejectedRequestType = (*UseCases1Code)(&value)
 }
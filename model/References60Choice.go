@@ -16,6 +16,10 @@ type References60Choice struct {
 	OtherTransactionIdentification *GenericDocumentIdentification6 `xml:"OthrTxId"`
 }
 
+func (r *References60Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References60Choice) AddSecuritiesSettlementTransactionIdentification() *SettlementTypeAndIdentification22 {
 	r.SecuritiesSettlementTransactionIdentification = new(SettlementTypeAndIdentification22)
 	return r.SecuritiesSettlementTransactionIdentification
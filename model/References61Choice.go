@@ -10,6 +10,10 @@ type References61Choice struct {
 	OtherReference []*AdditionalReference8 `xml:"OthrRef"`
 }
 
+func (r *References61Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References61Choice) AddRelatedReference() *AdditionalReference8 {
 	newValue := new(AdditionalReference8)
 	r.RelatedReference = append(r.RelatedReference, newValue)
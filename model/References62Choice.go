@@ -10,6 +10,10 @@ type References62Choice struct {
 	OtherReference []*AdditionalReference8 `xml:"OthrRef"`
 }
 
+func (r *References62Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References62Choice) AddPreviousReference() *AdditionalReference8 {
 	newValue := new(AdditionalReference8)
 	r.PreviousReference = append(r.PreviousReference, newValue)
@@ -22,6 +22,10 @@ type References6Choice struct {
 	TradeIdentification *Max35Text `xml:"TradId"`
 }
 
+func (r *References6Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References6Choice) SetAccountServicerTransactionIdentification(value string) {
 	r.AccountServicerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type References7 struct {
 	TradeIdentification *Max35Text `xml:"TradId,omitempty"`
 }
 
+func (r *References7) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References7) SetAccountOwnerTransactionIdentification(value string) {
 	r.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type References9 struct {
 	TradeIdentification *Max35Text `xml:"TradId,omitempty"`
 }
 
+func (r *References9) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *References9) SetAccountOwnerTransactionIdentification(value string) {
 	r.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
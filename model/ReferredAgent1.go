@@ -10,6 +10,10 @@ type ReferredAgent1 struct {
 	ReferredPlacementAgent *PartyIdentification2Choice `xml:"RfrdPlcmntAgt,omitempty"`
 }
 
+func (r *ReferredAgent1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReferredAgent1) SetReferred(value string) {
 	r.Referred = (*Referred1Code)(&value)
 }
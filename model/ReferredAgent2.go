@@ -10,6 +10,10 @@ type ReferredAgent2 struct {
 	ReferredPlacementAgent *PartyIdentification70Choice `xml:"RfrdPlcmntAgt,omitempty"`
 }
 
+func (r *ReferredAgent2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReferredAgent2) SetReferred(value string) {
 	r.Referred = (*Referred1Code)(&value)
 }
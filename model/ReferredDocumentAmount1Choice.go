@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Nature of the amount and currency on a document referred to in the remittance section, typically either the original amount due/payable or the amount actually remitted for the referenced document.
 type ReferredDocumentAmount1Choice struct {
 
@@ -19,22 +23,46 @@ type ReferredDocumentAmount1Choice struct {
 	TaxAmount *CurrencyAndAmount `xml:"TaxAmt"`
 }
 
+func (r *ReferredDocumentAmount1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReferredDocumentAmount1Choice) SetDuePayableAmount(value, currency string) {
 	r.DuePayableAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (r *ReferredDocumentAmount1Choice) SetDuePayableAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.DuePayableAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReferredDocumentAmount1Choice) SetDiscountAppliedAmount(value, currency string) {
 	r.DiscountAppliedAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (r *ReferredDocumentAmount1Choice) SetDiscountAppliedAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.DiscountAppliedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReferredDocumentAmount1Choice) SetRemittedAmount(value, currency string) {
 	r.RemittedAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (r *ReferredDocumentAmount1Choice) SetRemittedAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.RemittedAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReferredDocumentAmount1Choice) SetCreditNoteAmount(value, currency string) {
 	r.CreditNoteAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (r *ReferredDocumentAmount1Choice) SetCreditNoteAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.CreditNoteAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReferredDocumentAmount1Choice) SetTaxAmount(value, currency string) {
 	r.TaxAmount = NewCurrencyAndAmount(value, currency)
 }
+
+func (r *ReferredDocumentAmount1Choice) SetTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.TaxAmount = NewCurrencyAndAmount(value.String(), currency)
+}
@@ -10,6 +10,10 @@ type ReferredDocumentInformation1 struct {
 	ReferredDocumentNumber *Max35Text `xml:"RfrdDocNb,omitempty"`
 }
 
+func (r *ReferredDocumentInformation1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReferredDocumentInformation1) AddReferredDocumentType() *ReferredDocumentType1 {
 	r.ReferredDocumentType = new(ReferredDocumentType1)
 	return r.ReferredDocumentType
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Structured information related to the invoice to be financed.
 type ReferredDocumentInformation2 struct {
 
@@ -16,6 +22,10 @@ type ReferredDocumentInformation2 struct {
 	DocumentAmount *ActiveCurrencyAndAmount `xml:"DocAmt,omitempty"`
 }
 
+func (r *ReferredDocumentInformation2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReferredDocumentInformation2) AddType() *ReferredDocumentType1 {
 	r.Type = new(ReferredDocumentType1)
 	return r.Type
@@ -29,6 +39,15 @@ func (r *ReferredDocumentInformation2) SetRelatedDate(value string) {
 	r.RelatedDate = (*ISODate)(&value)
 }
 
+func (r *ReferredDocumentInformation2) SetRelatedDateFromTime(value time.Time) {
+	r.RelatedDate = new(ISODate)
+	r.RelatedDate.FromTime(value)
+}
+
 func (r *ReferredDocumentInformation2) SetDocumentAmount(value, currency string) {
 	r.DocumentAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (r *ReferredDocumentInformation2) SetDocumentAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.DocumentAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
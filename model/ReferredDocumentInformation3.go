@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to identify the documents referred to in the remittance information.
 type ReferredDocumentInformation3 struct {
 
@@ -13,6 +17,10 @@ type ReferredDocumentInformation3 struct {
 	RelatedDate *ISODate `xml:"RltdDt,omitempty"`
 }
 
+func (r *ReferredDocumentInformation3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReferredDocumentInformation3) AddType() *ReferredDocumentType2 {
 	r.Type = new(ReferredDocumentType2)
 	return r.Type
@@ -25,3 +33,8 @@ func (r *ReferredDocumentInformation3) SetNumber(value string) {
 func (r *ReferredDocumentInformation3) SetRelatedDate(value string) {
 	r.RelatedDate = (*ISODate)(&value)
 }
+
+func (r *ReferredDocumentInformation3) SetRelatedDateFromTime(value time.Time) {
+	r.RelatedDate = new(ISODate)
+	r.RelatedDate.FromTime(value)
+}
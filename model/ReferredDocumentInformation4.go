@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies the documents referred to in the remittance information.
 type ReferredDocumentInformation4 struct {
 
@@ -16,6 +20,10 @@ type ReferredDocumentInformation4 struct {
 	LineDetails []*DocumentLineInformation1 `xml:"LineDtls,omitempty"`
 }
 
+func (r *ReferredDocumentInformation4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReferredDocumentInformation4) AddType() *ReferredDocumentType2 {
 	r.Type = new(ReferredDocumentType2)
 	return r.Type
@@ -29,6 +37,11 @@ func (r *ReferredDocumentInformation4) SetRelatedDate(value string) {
 	r.RelatedDate = (*ISODate)(&value)
 }
 
+func (r *ReferredDocumentInformation4) SetRelatedDateFromTime(value time.Time) {
+	r.RelatedDate = new(ISODate)
+	r.RelatedDate.FromTime(value)
+}
+
 func (r *ReferredDocumentInformation4) AddLineDetails() *DocumentLineInformation1 {
 	newValue := new(DocumentLineInformation1)
 	r.LineDetails = append(r.LineDetails, newValue)
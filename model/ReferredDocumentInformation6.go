@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements used to identify the documents referred to in the remittance information.
 type ReferredDocumentInformation6 struct {
 
@@ -13,6 +17,10 @@ type ReferredDocumentInformation6 struct {
 	RelatedDate *ISODate `xml:"RltdDt,omitempty"`
 }
 
+func (r *ReferredDocumentInformation6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReferredDocumentInformation6) AddType() *ReferredDocumentType4 {
 	r.Type = new(ReferredDocumentType4)
 	return r.Type
@@ -25,3 +33,8 @@ func (r *ReferredDocumentInformation6) SetNumber(value string) {
 func (r *ReferredDocumentInformation6) SetRelatedDate(value string) {
 	r.RelatedDate = (*ISODate)(&value)
 }
+
+func (r *ReferredDocumentInformation6) SetRelatedDateFromTime(value time.Time) {
+	r.RelatedDate = new(ISODate)
+	r.RelatedDate.FromTime(value)
+}
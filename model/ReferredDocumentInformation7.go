@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of elements used to identify the documents referred to in the remittance information.
 type ReferredDocumentInformation7 struct {
 
@@ -16,6 +22,10 @@ type ReferredDocumentInformation7 struct {
 	LineDetails []*DocumentLineInformation1 `xml:"LineDtls,omitempty"`
 }
 
+func (r *ReferredDocumentInformation7) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReferredDocumentInformation7) AddType() *ReferredDocumentType4 {
 	r.Type = new(ReferredDocumentType4)
 	return r.Type
@@ -29,12 +39,78 @@ func (r *ReferredDocumentInformation7) SetRelatedDate(value string) {
 	r.RelatedDate = (*ISODate)(&value)
 }
 
+func (r *ReferredDocumentInformation7) SetRelatedDateFromTime(value time.Time) {
+	r.RelatedDate = new(ISODate)
+	r.RelatedDate.FromTime(value)
+}
+
 func (r *ReferredDocumentInformation7) AddLineDetails() *DocumentLineInformation1 {
 	newValue := new(DocumentLineInformation1)
 	r.LineDetails = append(r.LineDetails, newValue)
 	return newValue
 }
 
+func (r *ReferredDocumentInformation7) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.Type != nil {
+		r.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if r.Number != nil {
+		enc.Element("Nb", string(*r.Number))
+	}
+	if r.RelatedDate != nil {
+		enc.Element("RltdDt", string(*r.RelatedDate))
+	}
+	for _, elem := range r.LineDetails {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "LineDtls")
+		}
+	}
+	enc.End(tag)
+}
+
+func (r *ReferredDocumentInformation7) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(ReferredDocumentType4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Type = elem
+		case "Nb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Number = (*Max35Text)(&value)
+		case "RltdDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.RelatedDate = (*ISODate)(&value)
+		case "LineDtls":
+			elem := new(DocumentLineInformation1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.LineDetails = append(r.LineDetails, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -13,6 +13,10 @@ type ReferredDocumentType1 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (r *ReferredDocumentType1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReferredDocumentType1) SetCode(value string) {
 	r.Code = (*DocumentType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type ReferredDocumentType1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (r *ReferredDocumentType1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReferredDocumentType1Choice) SetCode(value string) {
 	r.Code = (*DocumentType5Code)(&value)
 }
@@ -10,6 +10,10 @@ type ReferredDocumentType2 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (r *ReferredDocumentType2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReferredDocumentType2) AddCodeOrProprietary() *ReferredDocumentType1Choice {
 	r.CodeOrProprietary = new(ReferredDocumentType1Choice)
 	return r.CodeOrProprietary
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the type of the document referred in the remittance information.
 type ReferredDocumentType3Choice struct {
 
@@ -10,10 +12,59 @@ type ReferredDocumentType3Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
-func (r *ReferredDocumentType3Choice) SetCode(value string) {
+func (r *ReferredDocumentType3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *ReferredDocumentType3Choice) SetCode(value string) error {
+	if err := DocumentType6Code(value).Validate(); err != nil {
+		return err
+	}
 	r.Code = (*DocumentType6Code)(&value)
+	return nil
 }
 
 func (r *ReferredDocumentType3Choice) SetProprietary(value string) {
 	r.Proprietary = (*Max35Text)(&value)
 }
+
+func (r *ReferredDocumentType3Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.Code != nil {
+		enc.Element("Cd", string(*r.Code))
+	}
+	if r.Proprietary != nil {
+		enc.Element("Prtry", string(*r.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (r *ReferredDocumentType3Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Code = (*DocumentType6Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
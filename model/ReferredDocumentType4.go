@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the type of the document referred in the remittance information.
 type ReferredDocumentType4 struct {
 
@@ -10,6 +12,10 @@ type ReferredDocumentType4 struct {
 	Issuer *Max35Text `xml:"Issr,omitempty"`
 }
 
+func (r *ReferredDocumentType4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReferredDocumentType4) AddCodeOrProprietary() *ReferredDocumentType3Choice {
 	r.CodeOrProprietary = new(ReferredDocumentType3Choice)
 	return r.CodeOrProprietary
@@ -18,3 +24,44 @@ func (r *ReferredDocumentType4) AddCodeOrProprietary() *ReferredDocumentType3Cho
 func (r *ReferredDocumentType4) SetIssuer(value string) {
 	r.Issuer = (*Max35Text)(&value)
 }
+
+func (r *ReferredDocumentType4) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.CodeOrProprietary != nil {
+		r.CodeOrProprietary.EncodeXMLFast(enc, "CdOrPrtry")
+	}
+	if r.Issuer != nil {
+		enc.Element("Issr", string(*r.Issuer))
+	}
+	enc.End(tag)
+}
+
+func (r *ReferredDocumentType4) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "CdOrPrtry":
+			elem := new(ReferredDocumentType3Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.CodeOrProprietary = elem
+		case "Issr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Issuer = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
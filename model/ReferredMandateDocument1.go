@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies the documents referred to in the remittance information.
 type ReferredMandateDocument1 struct {
 
@@ -16,6 +20,10 @@ type ReferredMandateDocument1 struct {
 	RelatedDate *ISODate `xml:"RltdDt,omitempty"`
 }
 
+func (r *ReferredMandateDocument1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReferredMandateDocument1) AddType() *ReferredDocumentType4 {
 	r.Type = new(ReferredDocumentType4)
 	return r.Type
@@ -32,3 +40,8 @@ func (r *ReferredMandateDocument1) SetCreditorReference(value string) {
 func (r *ReferredMandateDocument1) SetRelatedDate(value string) {
 	r.RelatedDate = (*ISODate)(&value)
 }
+
+func (r *ReferredMandateDocument1) SetRelatedDateFromTime(value time.Time) {
+	r.RelatedDate = new(ISODate)
+	r.RelatedDate.FromTime(value)
+}
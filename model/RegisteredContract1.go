@@ -19,6 +19,10 @@ type RegisteredContract1 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (r *RegisteredContract1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegisteredContract1) SetContractRegistrationAmendmentIdentification(value string) {
 	r.ContractRegistrationAmendmentIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type RegisteredContract2 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (r *RegisteredContract2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegisteredContract2) SetRegisteredContractClosureIdentification(value string) {
 	r.RegisteredContractClosureIdentification = (*Max35Text)(&value)
 }
@@ -44,8 +48,12 @@ func (r *RegisteredContract2) AddOriginalRegisteredContract() *DocumentIdentific
 	return r.OriginalRegisteredContract
 }
 
-func (r *RegisteredContract2) SetPriority(value string) {
+func (r *RegisteredContract2) SetPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.Priority = (*Priority2Code)(&value)
+	return nil
 }
 
 func (r *RegisteredContract2) AddClosureReason() *ContractClosureReason1Choice {
@@ -31,6 +31,10 @@ type RegisteredContract3 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (r *RegisteredContract3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegisteredContract3) SetRegisteredContractAmendmentIdentification(value string) {
 	r.RegisteredContractAmendmentIdentification = (*Max35Text)(&value)
 }
@@ -39,8 +43,12 @@ func (r *RegisteredContract3) SetOriginalRegisteredContractIdentification(value
 	r.OriginalRegisteredContractIdentification = (*Max35Text)(&value)
 }
 
-func (r *RegisteredContract3) SetPriority(value string) {
+func (r *RegisteredContract3) SetPriority(value string) error {
+	if err := Priority2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.Priority = (*Priority2Code)(&value)
+	return nil
 }
 
 func (r *RegisteredContract3) AddContract() *UnderlyingContract1Choice {
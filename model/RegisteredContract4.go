@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Document that a user must file with an authorized servicer for each contract that involves foreign currency transactions with non residents.
 type RegisteredContract4 struct {
 
@@ -67,6 +71,10 @@ type RegisteredContract4 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (r *RegisteredContract4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegisteredContract4) SetOriginalContractRegistrationRequest(value string) {
 	r.OriginalContractRegistrationRequest = (*Max35Text)(&value)
 }
@@ -558,14 +566,28 @@ func (r *RegisteredContract4) SetLoanPrincipalAmount(value, currency string) {
 	r.LoanPrincipalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RegisteredContract4) SetLoanPrincipalAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.LoanPrincipalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RegisteredContract4) SetEstimatedDateIndicator(value string) {
 	r.EstimatedDateIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (r *RegisteredContract4) SetEstimatedDateIndicatorFromBool(value bool) {
+	r.EstimatedDateIndicator = new(TrueFalseIndicator)
+	r.EstimatedDateIndicator.FromBool(value)
+}
+
 func (r *RegisteredContract4) SetInterCompanyLoan(value string) {
 	r.InterCompanyLoan = (*TrueFalseIndicator)(&value)
 }
 
+func (r *RegisteredContract4) SetInterCompanyLoanFromBool(value bool) {
+	r.InterCompanyLoan = new(TrueFalseIndicator)
+	r.InterCompanyLoan.FromBool(value)
+}
+
 func (r *RegisteredContract4) SetAdditionalInformation(value string) {
 	r.AdditionalInformation = (*Max1025Text)(&value)
 }
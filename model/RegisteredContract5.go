@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Document that a user must file with an authorized servicer for each contract that involves foreign currency transactions with non residents.
 type RegisteredContract5 struct {
 
@@ -58,6 +62,10 @@ type RegisteredContract5 struct {
 	AdditionalInformation *Max1025Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *RegisteredContract5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegisteredContract5) SetOriginalContractRegistrationRequest(value string) {
 	r.OriginalContractRegistrationRequest = (*Max35Text)(&value)
 }
@@ -539,14 +547,28 @@ func (r *RegisteredContract5) SetLoanPrincipalAmount(value, currency string) {
 	r.LoanPrincipalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RegisteredContract5) SetLoanPrincipalAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.LoanPrincipalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RegisteredContract5) SetEstimatedDateIndicator(value string) {
 	r.EstimatedDateIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (r *RegisteredContract5) SetEstimatedDateIndicatorFromBool(value bool) {
+	r.EstimatedDateIndicator = new(TrueFalseIndicator)
+	r.EstimatedDateIndicator.FromBool(value)
+}
+
 func (r *RegisteredContract5) SetInterCompanyLoan(value string) {
 	r.InterCompanyLoan = (*TrueFalseIndicator)(&value)
 }
 
+func (r *RegisteredContract5) SetInterCompanyLoanFromBool(value bool) {
+	r.InterCompanyLoan = new(TrueFalseIndicator)
+	r.InterCompanyLoan.FromBool(value)
+}
+
 func (r *RegisteredContract5) SetAdditionalInformation(value string) {
 	r.AdditionalInformation = (*Max1025Text)(&value)
 }
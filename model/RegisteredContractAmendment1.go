@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Amendment details and reason related to the registered contract.
 type RegisteredContractAmendment1 struct {
 
@@ -19,10 +23,19 @@ type RegisteredContractAmendment1 struct {
 	AdditionalInformation *Max1025Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *RegisteredContractAmendment1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegisteredContractAmendment1) SetAmendmentDate(value string) {
 	r.AmendmentDate = (*ISODate)(&value)
 }
 
+func (r *RegisteredContractAmendment1) SetAmendmentDateFromTime(value time.Time) {
+	r.AmendmentDate = new(ISODate)
+	r.AmendmentDate.FromTime(value)
+}
+
 func (r *RegisteredContractAmendment1) AddDocument() *DocumentIdentification28 {
 	r.Document = new(DocumentIdentification28)
 	return r.Document
@@ -32,6 +45,11 @@ func (r *RegisteredContractAmendment1) SetStartDate(value string) {
 	r.StartDate = (*ISODate)(&value)
 }
 
+func (r *RegisteredContractAmendment1) SetStartDateFromTime(value time.Time) {
+	r.StartDate = new(ISODate)
+	r.StartDate.FromTime(value)
+}
+
 func (r *RegisteredContractAmendment1) SetAmendmentReason(value string) {
 	r.AmendmentReason = (*Max35Text)(&value)
 }
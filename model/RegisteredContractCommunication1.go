@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Communication details related to the registered currency control contract.
 type RegisteredContractCommunication1 struct {
 
@@ -10,6 +14,10 @@ type RegisteredContractCommunication1 struct {
 	Date *ISODate `xml:"Dt"`
 }
 
+func (r *RegisteredContractCommunication1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegisteredContractCommunication1) SetMethod(value string) {
 	r.Method = (*CommunicationMethod4Code)(&value)
 }
@@ -17,3 +25,8 @@ func (r *RegisteredContractCommunication1) SetMethod(value string) {
 func (r *RegisteredContractCommunication1) SetDate(value string) {
 	r.Date = (*ISODate)(&value)
 }
+
+func (r *RegisteredContractCommunication1) SetDateFromTime(value time.Time) {
+	r.Date = new(ISODate)
+	r.Date.FromTime(value)
+}
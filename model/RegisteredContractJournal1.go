@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Document that a user must file with an authorised servicer for each contract that involves foreign currency transactions with non residents.
 type RegisteredContractJournal1 struct {
 
@@ -18,6 +22,10 @@ type RegisteredContractJournal1 struct {
 	ClosureReason *ContractClosureReason1Choice `xml:"ClsrRsn"`
 }
 
+func (r *RegisteredContractJournal1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegisteredContractJournal1) AddRegistrationAgent() *BranchAndFinancialInstitutionIdentification5 {
 	r.RegistrationAgent = new(BranchAndFinancialInstitutionIdentification5)
 	return r.RegistrationAgent
@@ -32,6 +40,11 @@ func (r *RegisteredContractJournal1) SetClosureDate(value string) {
 	r.ClosureDate = (*ISODate)(&value)
 }
 
+func (r *RegisteredContractJournal1) SetClosureDateFromTime(value time.Time) {
+	r.ClosureDate = new(ISODate)
+	r.ClosureDate.FromTime(value)
+}
+
 func (r *RegisteredContractJournal1) AddClosureReason() *ContractClosureReason1Choice {
 	r.ClosureReason = new(ContractClosureReason1Choice)
 	return r.ClosureReason
@@ -10,6 +10,10 @@ type RegisteredShareholderName1Choice struct {
 	Organisation *Organisation23 `xml:"Org"`
 }
 
+func (r *RegisteredShareholderName1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegisteredShareholderName1Choice) AddIndividualPerson() *IndividualPerson29 {
 	r.IndividualPerson = new(IndividualPerson29)
 	return r.IndividualPerson
@@ -10,6 +10,10 @@ type Registration10Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *Registration10Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Registration10Choice) SetCode(value string) {
 	r.Code = (*Registration2Code)(&value)
 }
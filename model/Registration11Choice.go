@@ -10,6 +10,10 @@ type Registration11Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *Registration11Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Registration11Choice) SetCode(value string) {
 	r.Code = (*Registration1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Registration12Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *Registration12Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Registration12Choice) SetCode(value string) {
 	r.Code = (*Registration2Code)(&value)
 }
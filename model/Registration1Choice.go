@@ -10,6 +10,10 @@ type Registration1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *Registration1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Registration1Choice) SetCode(value string) {
 	r.Code = (*Registration1Code)(&value)
 }
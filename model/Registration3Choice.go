@@ -10,6 +10,10 @@ type Registration3Choice struct {
 	Proprietary *GenericIdentification19 `xml:"Prtry"`
 }
 
+func (r *Registration3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Registration3Choice) SetCode(value string) {
 	r.Code = (*Registration2Code)(&value)
 }
@@ -10,6 +10,10 @@ type Registration6Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (r *Registration6Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Registration6Choice) SetCode(value string) {
 	r.Code = (*Registration1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Registration7Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *Registration7Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Registration7Choice) SetCode(value string) {
 	r.Code = (*Registration2Code)(&value)
 }
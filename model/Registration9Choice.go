@@ -10,6 +10,10 @@ type Registration9Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *Registration9Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Registration9Choice) SetCode(value string) {
 	r.Code = (*Registration1Code)(&value)
 }
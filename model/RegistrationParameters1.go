@@ -16,6 +16,10 @@ type RegistrationParameters1 struct {
 	CertificateNumber []*SecuritiesCertificate1 `xml:"CertNb,omitempty"`
 }
 
+func (r *RegistrationParameters1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegistrationParameters1) SetCertificationIdentification(value string) {
 	r.CertificationIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type RegistrationParameters3 struct {
 	CertificateNumber []*SecuritiesCertificate3 `xml:"CertNb,omitempty"`
 }
 
+func (r *RegistrationParameters3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegistrationParameters3) SetCertificationIdentification(value string) {
 	r.CertificationIdentification = (*Max35Text)(&value)
 }
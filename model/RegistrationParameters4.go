@@ -16,6 +16,10 @@ type RegistrationParameters4 struct {
 	CertificateNumber []*SecuritiesCertificate4 `xml:"CertNb,omitempty"`
 }
 
+func (r *RegistrationParameters4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegistrationParameters4) SetCertificationIdentification(value string) {
 	r.CertificationIdentification = (*Max35Text)(&value)
 }
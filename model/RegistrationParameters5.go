@@ -16,6 +16,10 @@ type RegistrationParameters5 struct {
 	CertificateNumber []*SecuritiesCertificate5 `xml:"CertNb,omitempty"`
 }
 
+func (r *RegistrationParameters5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegistrationParameters5) SetCertificationIdentification(value string) {
 	r.CertificationIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -10,6 +10,10 @@ type RegistrationProcessingStatus1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RegistrationProcessingStatus1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegistrationProcessingStatus1Choice) SetCode(value string) {
 	r.Code = (*RegistrationProcessingStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RegistrationProcessingStatus3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RegistrationProcessingStatus3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegistrationProcessingStatus3Choice) SetCode(value string) {
 	r.Code = (*RegistrationProcessingStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RegistrationProcessingStatus4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RegistrationProcessingStatus4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegistrationProcessingStatus4Choice) SetCode(value string) {
 	r.Code = (*RegistrationProcessingStatus1Code)(&value)
 }
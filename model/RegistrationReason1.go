@@ -10,6 +10,10 @@ type RegistrationReason1 struct {
 	AdditionalInformation *Max210Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *RegistrationReason1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegistrationReason1) AddCode() *Registration3Choice {
 	r.Code = new(Registration3Choice)
 	return r.Code
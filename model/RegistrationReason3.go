@@ -10,6 +10,10 @@ type RegistrationReason3 struct {
 	AdditionalInformation *Max210Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *RegistrationReason3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegistrationReason3) AddCode() *Registration7Choice {
 	r.Code = new(Registration7Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RegistrationReason5 struct {
 	AdditionalInformation *Max210Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *RegistrationReason5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegistrationReason5) AddCode() *Registration10Choice {
 	r.Code = new(Registration10Choice)
 	return r.Code
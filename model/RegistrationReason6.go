@@ -10,6 +10,10 @@ type RegistrationReason6 struct {
 	AdditionalInformation *RestrictedFINXMax210Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *RegistrationReason6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegistrationReason6) AddCode() *Registration12Choice {
 	r.Code = new(Registration12Choice)
 	return r.Code
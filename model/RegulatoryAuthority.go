@@ -10,6 +10,10 @@ type RegulatoryAuthority struct {
 	AuthorityCountry *CountryCode `xml:"AuthrtyCtry,omitempty"`
 }
 
+func (r *RegulatoryAuthority) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegulatoryAuthority) SetAuthorityName(value string) {
 	r.AuthorityName = (*Max70Text)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Entity requiring the regulatory reporting information.
 type RegulatoryAuthority2 struct {
 
@@ -10,6 +12,10 @@ type RegulatoryAuthority2 struct {
 	Country *CountryCode `xml:"Ctry,omitempty"`
 }
 
+func (r *RegulatoryAuthority2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegulatoryAuthority2) SetName(value string) {
 	r.Name = (*Max140Text)(&value)
 }
@@ -17,3 +23,44 @@ func (r *RegulatoryAuthority2) SetName(value string) {
 func (r *RegulatoryAuthority2) SetCountry(value string) {
 	r.Country = (*CountryCode)(&value)
 }
+
+func (r *RegulatoryAuthority2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.Name != nil {
+		enc.Element("Nm", string(*r.Name))
+	}
+	if r.Country != nil {
+		enc.Element("Ctry", string(*r.Country))
+	}
+	enc.End(tag)
+}
+
+func (r *RegulatoryAuthority2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Name = (*Max140Text)(&value)
+		case "Ctry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Country = (*CountryCode)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
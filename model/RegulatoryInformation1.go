@@ -16,6 +16,10 @@ type RegulatoryInformation1 struct {
 	Other *Max35Text `xml:"Othr,omitempty"`
 }
 
+func (r *RegulatoryInformation1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegulatoryInformation1) SetSector(value string) {
 	r.Sector = (*Max35Text)(&value)
 }
@@ -13,8 +13,16 @@ type RegulatoryReporting2 struct {
 	RegulatoryDetails *StructuredRegulatoryReporting2 `xml:"RgltryDtls,omitempty"`
 }
 
-func (r *RegulatoryReporting2) SetDebitCreditReportingIndicator(value string) {
+func (r *RegulatoryReporting2) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RegulatoryReporting2) SetDebitCreditReportingIndicator(value string) error {
+	if err := RegulatoryReportingType1Code(value).Validate(); err != nil {
+		return err
+	}
 	r.DebitCreditReportingIndicator = (*RegulatoryReportingType1Code)(&value)
+	return nil
 }
 
 func (r *RegulatoryReporting2) AddAuthority() *RegulatoryAuthority {
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Information needed due to regulatory and/or statutory requirements.
 type RegulatoryReporting3 struct {
 
@@ -13,8 +15,16 @@ type RegulatoryReporting3 struct {
 	Details []*StructuredRegulatoryReporting3 `xml:"Dtls,omitempty"`
 }
 
-func (r *RegulatoryReporting3) SetDebitCreditReportingIndicator(value string) {
+func (r *RegulatoryReporting3) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RegulatoryReporting3) SetDebitCreditReportingIndicator(value string) error {
+	if err := RegulatoryReportingType1Code(value).Validate(); err != nil {
+		return err
+	}
 	r.DebitCreditReportingIndicator = (*RegulatoryReportingType1Code)(&value)
+	return nil
 }
 
 func (r *RegulatoryReporting3) AddAuthority() *RegulatoryAuthority2 {
@@ -28,6 +38,58 @@ func (r *RegulatoryReporting3) AddDetails() *StructuredRegulatoryReporting3 {
 	return newValue
 }
 
+func (r *RegulatoryReporting3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.DebitCreditReportingIndicator != nil {
+		enc.Element("DbtCdtRptgInd", string(*r.DebitCreditReportingIndicator))
+	}
+	if r.Authority != nil {
+		r.Authority.EncodeXMLFast(enc, "Authrty")
+	}
+	for _, elem := range r.Details {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Dtls")
+		}
+	}
+	enc.End(tag)
+}
+
+func (r *RegulatoryReporting3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "DbtCdtRptgInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.DebitCreditReportingIndicator = (*RegulatoryReportingType1Code)(&value)
+		case "Authrty":
+			elem := new(RegulatoryAuthority2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Authority = elem
+		case "Dtls":
+			elem := new(StructuredRegulatoryReporting3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Details = append(r.Details, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -77,6 +77,10 @@ type RegulatoryReporting4 struct {
 	AdditionalReportingInformation *Max210Text `xml:"AddtlRptgInf,omitempty"`
 }
 
+func (r *RegulatoryReporting4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegulatoryReporting4) AddTradingSideTransactionReporting() *TradingSideTransactionReporting1 {
 	newValue := new(TradingSideTransactionReporting1)
 	r.TradingSideTransactionReporting = append(r.TradingSideTransactionReporting, newValue)
@@ -393,6 +397,11 @@ func (r *RegulatoryReporting4) SetClearingThresholdIndicator(value string) {
 	r.ClearingThresholdIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting4) SetClearingThresholdIndicatorFromBool(value bool) {
+	r.ClearingThresholdIndicator = new(YesNoIndicator)
+	r.ClearingThresholdIndicator.FromBool(value)
+}
+
 func (r *RegulatoryReporting4) SetClearedProductIdentification(value string) {
 	r.ClearedProductIdentification = (*Max35Text)(&value)
 }
@@ -422,6 +431,11 @@ func (r *RegulatoryReporting4) SetNonStandardFlag(value string) {
 	r.NonStandardFlag = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting4) SetNonStandardFlagFromBool(value bool) {
+	r.NonStandardFlag = new(YesNoIndicator)
+	r.NonStandardFlag.FromBool(value)
+}
+
 func (r *RegulatoryReporting4) SetLinkSwapIdentification(value string) {
 	r.LinkSwapIdentification = (*Exact42Text)(&value)
 }
@@ -430,10 +444,20 @@ func (r *RegulatoryReporting4) SetFinancialNatureOfTheCounterpartyIndicator(valu
 	r.FinancialNatureOfTheCounterpartyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting4) SetFinancialNatureOfTheCounterpartyIndicatorFromBool(value bool) {
+	r.FinancialNatureOfTheCounterpartyIndicator = new(YesNoIndicator)
+	r.FinancialNatureOfTheCounterpartyIndicator.FromBool(value)
+}
+
 func (r *RegulatoryReporting4) SetCollateralPortfolioIndicator(value string) {
 	r.CollateralPortfolioIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting4) SetCollateralPortfolioIndicatorFromBool(value bool) {
+	r.CollateralPortfolioIndicator = new(YesNoIndicator)
+	r.CollateralPortfolioIndicator.FromBool(value)
+}
+
 func (r *RegulatoryReporting4) SetCollateralPortfolioCode(value string) {
 	r.CollateralPortfolioCode = (*Max10Text)(&value)
 }
@@ -442,6 +466,11 @@ func (r *RegulatoryReporting4) SetPortfolioCompressionIndicator(value string) {
 	r.PortfolioCompressionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting4) SetPortfolioCompressionIndicatorFromBool(value bool) {
+	r.PortfolioCompressionIndicator = new(YesNoIndicator)
+	r.PortfolioCompressionIndicator.FromBool(value)
+}
+
 func (r *RegulatoryReporting4) SetCorporateSectorIndicator(value string) {
 	r.CorporateSectorIndicator = (*CorporateSectorIdentifier1Code)(&value)
 }
@@ -450,14 +479,29 @@ func (r *RegulatoryReporting4) SetTradeWithNonEEACounterpartyIndicator(value str
 	r.TradeWithNonEEACounterpartyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting4) SetTradeWithNonEEACounterpartyIndicatorFromBool(value bool) {
+	r.TradeWithNonEEACounterpartyIndicator = new(YesNoIndicator)
+	r.TradeWithNonEEACounterpartyIndicator.FromBool(value)
+}
+
 func (r *RegulatoryReporting4) SetIntragroupTradeIndicator(value string) {
 	r.IntragroupTradeIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting4) SetIntragroupTradeIndicatorFromBool(value bool) {
+	r.IntragroupTradeIndicator = new(YesNoIndicator)
+	r.IntragroupTradeIndicator.FromBool(value)
+}
+
 func (r *RegulatoryReporting4) SetCommercialOrTreasuryFinancingIndicator(value string) {
 	r.CommercialOrTreasuryFinancingIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting4) SetCommercialOrTreasuryFinancingIndicatorFromBool(value bool) {
+	r.CommercialOrTreasuryFinancingIndicator = new(YesNoIndicator)
+	r.CommercialOrTreasuryFinancingIndicator.FromBool(value)
+}
+
 func (r *RegulatoryReporting4) SetAdditionalReportingInformation(value string) {
 	r.AdditionalReportingInformation = (*Max210Text)(&value)
 }
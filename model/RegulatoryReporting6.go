@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Includes data elements that can be used for reporting to trade repositories, it is not to be used on regular trade confirmations. Although some fields, for example, unique transaction identifier and prior unique transaction identifier, might be used on regular trade confirmations.
 type RegulatoryReporting6 struct {
 
@@ -86,6 +90,10 @@ type RegulatoryReporting6 struct {
 	AdditionalReportingInformation *Max210Text `xml:"AddtlRptgInf,omitempty"`
 }
 
+func (r *RegulatoryReporting6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegulatoryReporting6) AddTradingSideTransactionReporting() *TradingSideTransactionReporting1 {
 	newValue := new(TradingSideTransactionReporting1)
 	r.TradingSideTransactionReporting = append(r.TradingSideTransactionReporting, newValue)
@@ -402,6 +410,11 @@ func (r *RegulatoryReporting6) SetClearingThresholdIndicator(value string) {
 	r.ClearingThresholdIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting6) SetClearingThresholdIndicatorFromBool(value bool) {
+	r.ClearingThresholdIndicator = new(YesNoIndicator)
+	r.ClearingThresholdIndicator.FromBool(value)
+}
+
 func (r *RegulatoryReporting6) SetClearedProductIdentification(value string) {
 	r.ClearedProductIdentification = (*Max35Text)(&value)
 }
@@ -431,6 +444,11 @@ func (r *RegulatoryReporting6) SetNonStandardFlag(value string) {
 	r.NonStandardFlag = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting6) SetNonStandardFlagFromBool(value bool) {
+	r.NonStandardFlag = new(YesNoIndicator)
+	r.NonStandardFlag.FromBool(value)
+}
+
 func (r *RegulatoryReporting6) SetLinkSwapIdentification(value string) {
 	r.LinkSwapIdentification = (*Exact42Text)(&value)
 }
@@ -439,10 +457,20 @@ func (r *RegulatoryReporting6) SetFinancialNatureOfTheCounterpartyIndicator(valu
 	r.FinancialNatureOfTheCounterpartyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting6) SetFinancialNatureOfTheCounterpartyIndicatorFromBool(value bool) {
+	r.FinancialNatureOfTheCounterpartyIndicator = new(YesNoIndicator)
+	r.FinancialNatureOfTheCounterpartyIndicator.FromBool(value)
+}
+
 func (r *RegulatoryReporting6) SetCollateralPortfolioIndicator(value string) {
 	r.CollateralPortfolioIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting6) SetCollateralPortfolioIndicatorFromBool(value bool) {
+	r.CollateralPortfolioIndicator = new(YesNoIndicator)
+	r.CollateralPortfolioIndicator.FromBool(value)
+}
+
 func (r *RegulatoryReporting6) SetCollateralPortfolioCode(value string) {
 	r.CollateralPortfolioCode = (*Max10Text)(&value)
 }
@@ -451,6 +479,11 @@ func (r *RegulatoryReporting6) SetPortfolioCompressionIndicator(value string) {
 	r.PortfolioCompressionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting6) SetPortfolioCompressionIndicatorFromBool(value bool) {
+	r.PortfolioCompressionIndicator = new(YesNoIndicator)
+	r.PortfolioCompressionIndicator.FromBool(value)
+}
+
 func (r *RegulatoryReporting6) SetCorporateSectorIndicator(value string) {
 	r.CorporateSectorIndicator = (*CorporateSectorIdentifier1Code)(&value)
 }
@@ -459,14 +492,29 @@ func (r *RegulatoryReporting6) SetTradeWithNonEEACounterpartyIndicator(value str
 	r.TradeWithNonEEACounterpartyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting6) SetTradeWithNonEEACounterpartyIndicatorFromBool(value bool) {
+	r.TradeWithNonEEACounterpartyIndicator = new(YesNoIndicator)
+	r.TradeWithNonEEACounterpartyIndicator.FromBool(value)
+}
+
 func (r *RegulatoryReporting6) SetIntragroupTradeIndicator(value string) {
 	r.IntragroupTradeIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting6) SetIntragroupTradeIndicatorFromBool(value bool) {
+	r.IntragroupTradeIndicator = new(YesNoIndicator)
+	r.IntragroupTradeIndicator.FromBool(value)
+}
+
 func (r *RegulatoryReporting6) SetCommercialOrTreasuryFinancingIndicator(value string) {
 	r.CommercialOrTreasuryFinancingIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RegulatoryReporting6) SetCommercialOrTreasuryFinancingIndicatorFromBool(value bool) {
+	r.CommercialOrTreasuryFinancingIndicator = new(YesNoIndicator)
+	r.CommercialOrTreasuryFinancingIndicator.FromBool(value)
+}
+
 func (r *RegulatoryReporting6) AddFinancialInstrumentIdentification() *SecurityIdentification19 {
 	r.FinancialInstrumentIdentification = new(SecurityIdentification19)
 	return r.FinancialInstrumentIdentification
@@ -476,10 +524,20 @@ func (r *RegulatoryReporting6) SetConfirmationDateAndTimestamp(value string) {
 	r.ConfirmationDateAndTimestamp = (*ISODateTime)(&value)
 }
 
+func (r *RegulatoryReporting6) SetConfirmationDateAndTimestampFromTime(value time.Time) {
+	r.ConfirmationDateAndTimestamp = new(ISODateTime)
+	r.ConfirmationDateAndTimestamp.FromTime(value)
+}
+
 func (r *RegulatoryReporting6) SetClearingTimestamp(value string) {
 	r.ClearingTimestamp = (*ISOTime)(&value)
 }
 
+func (r *RegulatoryReporting6) SetClearingTimestampFromTime(value time.Time) {
+	r.ClearingTimestamp = new(ISOTime)
+	r.ClearingTimestamp.FromTime(value)
+}
+
 func (r *RegulatoryReporting6) SetAdditionalReportingInformation(value string) {
 	r.AdditionalReportingInformation = (*Max210Text)(&value)
 }
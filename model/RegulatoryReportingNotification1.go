@@ -16,6 +16,10 @@ type RegulatoryReportingNotification1 struct {
 	TransactionCertificate []*TransactionCertificate1 `xml:"TxCert"`
 }
 
+func (r *RegulatoryReportingNotification1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegulatoryReportingNotification1) SetTransactionNotificationIdentification(value string) {
 	r.TransactionNotificationIdentification = (*Max35Text)(&value)
 }
@@ -1,3 +1,28 @@
 package model
 
 type RegulatoryReportingType1Code string
+
+const (
+	RegulatoryReportingType1CodeCRED RegulatoryReportingType1Code = "CRED"
+	RegulatoryReportingType1CodeDEBT RegulatoryReportingType1Code = "DEBT"
+	RegulatoryReportingType1CodeBOTH RegulatoryReportingType1Code = "BOTH"
+)
+
+var regulatoryReportingType1CodeDescriptions = map[RegulatoryReportingType1Code]string{
+	RegulatoryReportingType1CodeCRED: "Credit: regulatory information applies to the credit side.",
+	RegulatoryReportingType1CodeDEBT: "Debit: regulatory information applies to the debit side.",
+	RegulatoryReportingType1CodeBOTH: "Both: regulatory information applies to both credit and debit sides.",
+}
+
+func (r RegulatoryReportingType1Code) IsValid() bool {
+	_, ok := regulatoryReportingType1CodeDescriptions[r]
+	return ok
+}
+
+func (r RegulatoryReportingType1Code) Description() string {
+	return regulatoryReportingType1CodeDescriptions[r]
+}
+
+func (r RegulatoryReportingType1Code) Validate() error {
+	return validateEnumeration("RegulatoryReportingType1Code", string(r), r.IsValid(), "CRED, DEBT, BOTH")
+}
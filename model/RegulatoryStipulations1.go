@@ -10,6 +10,10 @@ type RegulatoryStipulations1 struct {
 	Stipulations []*Max350Text `xml:"Stiptns"`
 }
 
+func (r *RegulatoryStipulations1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RegulatoryStipulations1) SetCountry(value string) {
 	r.Country = (*CountryCode)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Reinvestment information.
 type Reinvestment1 struct {
 
@@ -13,6 +17,10 @@ type Reinvestment1 struct {
 	ReinvestmentPercentage *PercentageRate `xml:"RinvstmtPctg"`
 }
 
+func (r *Reinvestment1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reinvestment1) AddFundDetails() *FinancialInstrument29 {
 	r.FundDetails = new(FinancialInstrument29)
 	return r.FundDetails
@@ -25,3 +33,8 @@ func (r *Reinvestment1) SetRequestedNAVCurrency(value string) {
 func (r *Reinvestment1) SetReinvestmentPercentage(value string) {
 	r.ReinvestmentPercentage = (*PercentageRate)(&value)
 }
+
+func (r *Reinvestment1) SetReinvestmentPercentageFromDecimal(value decimal.Decimal) {
+	r.ReinvestmentPercentage = new(PercentageRate)
+	r.ReinvestmentPercentage.FromDecimal(value)
+}
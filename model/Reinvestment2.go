@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Reinvestment information.
 type Reinvestment2 struct {
 
@@ -13,6 +17,10 @@ type Reinvestment2 struct {
 	ReinvestmentPercentage *PercentageRate `xml:"RinvstmtPctg"`
 }
 
+func (r *Reinvestment2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reinvestment2) AddFinancialInstrumentDetails() *FinancialInstrument51 {
 	r.FinancialInstrumentDetails = new(FinancialInstrument51)
 	return r.FinancialInstrumentDetails
@@ -25,3 +33,8 @@ func (r *Reinvestment2) SetRequestedNAVCurrency(value string) {
 func (r *Reinvestment2) SetReinvestmentPercentage(value string) {
 	r.ReinvestmentPercentage = (*PercentageRate)(&value)
 }
+
+func (r *Reinvestment2) SetReinvestmentPercentageFromDecimal(value decimal.Decimal) {
+	r.ReinvestmentPercentage = new(PercentageRate)
+	r.ReinvestmentPercentage.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Reinvestment information.
 type Reinvestment3 struct {
 
@@ -13,6 +17,10 @@ type Reinvestment3 struct {
 	ReinvestmentPercentage *PercentageRate `xml:"RinvstmtPctg"`
 }
 
+func (r *Reinvestment3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reinvestment3) AddFinancialInstrumentDetails() *FinancialInstrument56 {
 	r.FinancialInstrumentDetails = new(FinancialInstrument56)
 	return r.FinancialInstrumentDetails
@@ -25,3 +33,8 @@ func (r *Reinvestment3) SetRequestedNAVCurrency(value string) {
 func (r *Reinvestment3) SetReinvestmentPercentage(value string) {
 	r.ReinvestmentPercentage = (*PercentageRate)(&value)
 }
+
+func (r *Reinvestment3) SetReinvestmentPercentageFromDecimal(value decimal.Decimal) {
+	r.ReinvestmentPercentage = new(PercentageRate)
+	r.ReinvestmentPercentage.FromDecimal(value)
+}
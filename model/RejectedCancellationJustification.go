@@ -10,6 +10,10 @@ type RejectedCancellationJustification struct {
 	Reason *Max140Text `xml:"Rsn,omitempty"`
 }
 
+func (r *RejectedCancellationJustification) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedCancellationJustification) SetReasonCode(value string) {
 	r.ReasonCode = (*PaymentCancellationRejection1Code)(&value)
 }
@@ -13,6 +13,10 @@ type RejectedCancellationStatusReason1Choice struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
+func (r *RejectedCancellationStatusReason1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedCancellationStatusReason1Choice) SetReason(value string) {
 	r.Reason = (*RejectedCancellationStatusReason1Code)(&value)
 }
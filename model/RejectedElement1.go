@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information on the rejection reason of an individual element.
 type RejectedElement1 struct {
 
@@ -10,10 +14,19 @@ type RejectedElement1 struct {
 	IndividualRejectionReason *Max140Text `xml:"IndvRjctnRsn"`
 }
 
+func (r *RejectedElement1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedElement1) SetElementSequenceNumber(value string) {
 	r.ElementSequenceNumber = (*Number)(&value)
 }
 
+func (r *RejectedElement1) SetElementSequenceNumberFromDecimal(value decimal.Decimal) {
+	r.ElementSequenceNumber = new(Number)
+	r.ElementSequenceNumber.FromDecimal(value)
+}
+
 func (r *RejectedElement1) SetIndividualRejectionReason(value string) {
 	r.IndividualRejectionReason = (*Max140Text)(&value)
 }
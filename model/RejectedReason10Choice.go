@@ -10,6 +10,10 @@ type RejectedReason10Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectedReason10Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason10Choice) SetCode(value string) {
 	r.Code = (*RejectionReason45Code)(&value)
 }
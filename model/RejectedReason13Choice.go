@@ -10,6 +10,10 @@ type RejectedReason13Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RejectedReason13Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason13Choice) SetCode(value string) {
 	r.Code = (*RejectionReason43Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectedReason14Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RejectedReason14Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason14Choice) SetCode(value string) {
 	r.Code = (*RejectionReason45Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectedReason15Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (r *RejectedReason15Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason15Choice) SetCode(value string) {
 	r.Code = (*TransferRejectedStatusReason2Code)(&value)
 }
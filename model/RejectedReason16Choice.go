@@ -10,6 +10,10 @@ type RejectedReason16Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (r *RejectedReason16Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason16Choice) SetCode(value string) {
 	r.Code = (*RejectedStatusReason6Code)(&value)
 }
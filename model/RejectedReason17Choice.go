@@ -10,6 +10,10 @@ type RejectedReason17Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (r *RejectedReason17Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason17Choice) SetCode(value string) {
 	r.Code = (*CancellationRejectedReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectedReason18Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RejectedReason18Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason18Choice) SetCode(value string) {
 	r.Code = (*RejectionReason45Code)(&value)
 }
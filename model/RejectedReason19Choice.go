@@ -10,6 +10,10 @@ type RejectedReason19Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RejectedReason19Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason19Choice) SetCode(value string) {
 	r.Code = (*RejectionReason43Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectedReason1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectedReason1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason1Choice) SetCode(value string) {
 	r.Code = (*RejectionReason17Code)(&value)
 }
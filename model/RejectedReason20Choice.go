@@ -10,6 +10,10 @@ type RejectedReason20Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (r *RejectedReason20Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason20Choice) SetCode(value string) {
 	r.Code = (*RejectedStatusReason11Code)(&value)
 }
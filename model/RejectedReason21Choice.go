@@ -10,6 +10,10 @@ type RejectedReason21Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (r *RejectedReason21Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason21Choice) SetCode(value string) {
 	r.Code = (*RejectedStatusReason8Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectedReason22Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RejectedReason22Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason22Choice) SetCode(value string) {
 	r.Code = (*RejectionReason46Code)(&value)
 }
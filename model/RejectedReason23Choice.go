@@ -10,6 +10,10 @@ type RejectedReason23Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RejectedReason23Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason23Choice) SetCode(value string) {
 	r.Code = (*RejectionReason46Code)(&value)
 }
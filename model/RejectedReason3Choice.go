@@ -10,6 +10,10 @@ type RejectedReason3Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RejectedReason3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason3Choice) SetCode(value string) {
 	r.Code = (*RejectedStatusReason6Code)(&value)
 }
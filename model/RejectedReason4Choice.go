@@ -10,6 +10,10 @@ type RejectedReason4Choice struct {
 	Proprietary *GenericIdentification29 `xml:"Prtry"`
 }
 
+func (r *RejectedReason4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason4Choice) SetCode(value string) {
 	r.Code = (*RejectedStatusReason6Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectedReason5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectedReason5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason5Choice) SetCode(value string) {
 	r.Code = (*RejectionReason42Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectedReason7Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (r *RejectedReason7Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason7Choice) SetCode(value string) {
 	r.Code = (*ExternalRejectedReason1Code)(&value)
 }
@@ -10,8 +10,16 @@ type RejectedReason8Choice struct {
 	Reason []*RejectedReason7Choice `xml:"Rsn"`
 }
 
-func (r *RejectedReason8Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectedReason8Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectedReason8Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectedReason8Choice) AddReason() *RejectedReason7Choice {
@@ -10,6 +10,10 @@ type RejectedReason9Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectedReason9Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedReason9Choice) SetCode(value string) {
 	r.Code = (*RejectionReason43Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectedStatus10 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *RejectedStatus10) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatus10) AddReason() *RejectedReason21Choice {
 	r.Reason = new(RejectedReason21Choice)
 	return r.Reason
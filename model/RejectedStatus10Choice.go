@@ -10,8 +10,16 @@ type RejectedStatus10Choice struct {
 	Reason []*RejectionReason19 `xml:"Rsn"`
 }
 
-func (r *RejectedStatus10Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectedStatus10Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectedStatus10Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectedStatus10Choice) AddReason() *RejectionReason19 {
@@ -10,8 +10,16 @@ type RejectedStatus13Choice struct {
 	Reason []*RejectedStatusReason13 `xml:"Rsn"`
 }
 
-func (r *RejectedStatus13Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectedStatus13Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectedStatus13Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectedStatus13Choice) AddReason() *RejectedStatusReason13 {
@@ -10,8 +10,16 @@ type RejectedStatus14Choice struct {
 	Reason []*RejectedStatusReason14 `xml:"Rsn"`
 }
 
-func (r *RejectedStatus14Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectedStatus14Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectedStatus14Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectedStatus14Choice) AddReason() *RejectedStatusReason14 {
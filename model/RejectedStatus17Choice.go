@@ -10,8 +10,16 @@ type RejectedStatus17Choice struct {
 	Reason []*RejectionReason27 `xml:"Rsn"`
 }
 
-func (r *RejectedStatus17Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectedStatus17Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectedStatus17Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectedStatus17Choice) AddReason() *RejectionReason27 {
@@ -10,8 +10,16 @@ type RejectedStatus18Choice struct {
 	Reason []*RejectedStatusReason18 `xml:"Rsn"`
 }
 
-func (r *RejectedStatus18Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectedStatus18Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectedStatus18Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectedStatus18Choice) AddReason() *RejectedStatusReason18 {
@@ -10,8 +10,16 @@ type RejectedStatus19Choice struct {
 	Reason []*RejectedStatusReason17 `xml:"Rsn"`
 }
 
-func (r *RejectedStatus19Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectedStatus19Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectedStatus19Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectedStatus19Choice) AddReason() *RejectedStatusReason17 {
@@ -10,8 +10,16 @@ type RejectedStatus1Choice struct {
 	Reason []*RejectedStatusReason8 `xml:"Rsn"`
 }
 
-func (r *RejectedStatus1Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectedStatus1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectedStatus1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectedStatus1Choice) AddReason() *RejectedStatusReason8 {
@@ -10,8 +10,16 @@ type RejectedStatus20Choice struct {
 	Reason []*RejectedStatusReason19 `xml:"Rsn"`
 }
 
-func (r *RejectedStatus20Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectedStatus20Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectedStatus20Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectedStatus20Choice) AddReason() *RejectedStatusReason19 {
@@ -10,8 +10,16 @@ type RejectedStatus21Choice struct {
 	Reason []*RejectedStatusReason20 `xml:"Rsn"`
 }
 
-func (r *RejectedStatus21Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectedStatus21Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectedStatus21Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectedStatus21Choice) AddReason() *RejectedStatusReason20 {
@@ -10,8 +10,16 @@ type RejectedStatus22Choice struct {
 	Reason []*RejectionReason35 `xml:"Rsn"`
 }
 
-func (r *RejectedStatus22Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectedStatus22Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectedStatus22Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectedStatus22Choice) AddReason() *RejectionReason35 {
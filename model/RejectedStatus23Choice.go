@@ -10,8 +10,16 @@ type RejectedStatus23Choice struct {
 	Reason []*RejectedStatusReason21 `xml:"Rsn"`
 }
 
-func (r *RejectedStatus23Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectedStatus23Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectedStatus23Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectedStatus23Choice) AddReason() *RejectedStatusReason21 {
@@ -10,8 +10,16 @@ type RejectedStatus24Choice struct {
 	Reason []*RejectedStatusReason22 `xml:"Rsn"`
 }
 
-func (r *RejectedStatus24Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectedStatus24Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectedStatus24Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectedStatus24Choice) AddReason() *RejectedStatusReason22 {
@@ -10,6 +10,10 @@ type RejectedStatus3 struct {
 	DataSourceScheme []*GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
+func (r *RejectedStatus3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatus3) AddReason() *RejectedStatusReason6 {
 	newValue := new(RejectedStatusReason6)
 	r.Reason = append(r.Reason, newValue)
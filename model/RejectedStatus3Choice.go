@@ -10,6 +10,10 @@ type RejectedStatus3Choice struct {
 	DataSourceScheme []*GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
+func (r *RejectedStatus3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatus3Choice) AddReason() *RejectedStatusReason5 {
 	newValue := new(RejectedStatusReason5)
 	r.Reason = append(r.Reason, newValue)
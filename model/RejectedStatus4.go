@@ -10,6 +10,10 @@ type RejectedStatus4 struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
+func (r *RejectedStatus4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatus4) AddReason() *RejectedStatusReason4 {
 	r.Reason = new(RejectedStatusReason4)
 	return r.Reason
@@ -10,6 +10,10 @@ type RejectedStatus5 struct {
 	ExtendedReason *Extended350Code `xml:"XtndedRsn"`
 }
 
+func (r *RejectedStatus5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatus5) SetReason(value string) {
 	r.Reason = (*RejectedStatusReason6Code)(&value)
 }
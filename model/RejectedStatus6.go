@@ -16,6 +16,10 @@ type RejectedStatus6 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *RejectedStatus6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatus6) SetReason(value string) {
 	r.Reason = (*RejectedStatusReason7Code)(&value)
 }
@@ -13,6 +13,10 @@ type RejectedStatus7 struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
+func (r *RejectedStatus7) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatus7) SetReason(value string) {
 	r.Reason = (*RejectedStatusReason8Code)(&value)
 }
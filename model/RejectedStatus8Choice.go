@@ -13,6 +13,10 @@ type RejectedStatus8Choice struct {
 	DataSourceScheme []*GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
+func (r *RejectedStatus8Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatus8Choice) SetReason(value string) {
 	r.Reason = (*TransferRejectedStatusReason2Code)(&value)
 }
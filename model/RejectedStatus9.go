@@ -10,6 +10,10 @@ type RejectedStatus9 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *RejectedStatus9) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatus9) AddReason() *RejectedReason20Choice {
 	r.Reason = new(RejectedReason20Choice)
 	return r.Reason
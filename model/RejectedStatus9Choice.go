@@ -10,8 +10,16 @@ type RejectedStatus9Choice struct {
 	Reason []*RejectedStatusReason10 `xml:"Rsn"`
 }
 
-func (r *RejectedStatus9Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectedStatus9Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectedStatus9Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectedStatus9Choice) AddReason() *RejectedStatusReason10 {
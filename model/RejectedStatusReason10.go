@@ -10,6 +10,10 @@ type RejectedStatusReason10 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectedStatusReason10) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatusReason10) AddReasonCode() *RejectedReason5Choice {
 	r.ReasonCode = new(RejectedReason5Choice)
 	return r.ReasonCode
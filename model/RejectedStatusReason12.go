@@ -10,6 +10,10 @@ type RejectedStatusReason12 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectedStatusReason12) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatusReason12) AddReason() *RejectedReason8Choice {
 	r.Reason = new(RejectedReason8Choice)
 	return r.Reason
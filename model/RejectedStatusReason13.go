@@ -10,6 +10,10 @@ type RejectedStatusReason13 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectedStatusReason13) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatusReason13) AddReasonCode() *RejectedReason9Choice {
 	r.ReasonCode = new(RejectedReason9Choice)
 	return r.ReasonCode
@@ -10,6 +10,10 @@ type RejectedStatusReason14 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectedStatusReason14) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatusReason14) AddReasonCode() *RejectedReason10Choice {
 	r.ReasonCode = new(RejectedReason10Choice)
 	return r.ReasonCode
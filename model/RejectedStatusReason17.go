@@ -10,6 +10,10 @@ type RejectedStatusReason17 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectedStatusReason17) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatusReason17) AddReasonCode() *RejectedReason13Choice {
 	r.ReasonCode = new(RejectedReason13Choice)
 	return r.ReasonCode
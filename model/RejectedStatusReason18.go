@@ -10,6 +10,10 @@ type RejectedStatusReason18 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectedStatusReason18) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatusReason18) AddReasonCode() *RejectedReason14Choice {
 	r.ReasonCode = new(RejectedReason14Choice)
 	return r.ReasonCode
@@ -10,6 +10,10 @@ type RejectedStatusReason19 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectedStatusReason19) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatusReason19) AddReasonCode() *RejectedReason18Choice {
 	r.ReasonCode = new(RejectedReason18Choice)
 	return r.ReasonCode
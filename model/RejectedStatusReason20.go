@@ -10,6 +10,10 @@ type RejectedStatusReason20 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectedStatusReason20) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatusReason20) AddReasonCode() *RejectedReason19Choice {
 	r.ReasonCode = new(RejectedReason19Choice)
 	return r.ReasonCode
@@ -10,6 +10,10 @@ type RejectedStatusReason21 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectedStatusReason21) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatusReason21) AddReasonCode() *RejectedReason22Choice {
 	r.ReasonCode = new(RejectedReason22Choice)
 	return r.ReasonCode
@@ -10,6 +10,10 @@ type RejectedStatusReason22 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectedStatusReason22) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatusReason22) AddReasonCode() *RejectedReason23Choice {
 	r.ReasonCode = new(RejectedReason23Choice)
 	return r.ReasonCode
@@ -10,6 +10,10 @@ type RejectedStatusReason4 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *RejectedStatusReason4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatusReason4) SetStructured(value string) {
 	r.Structured = (*RejectedStatusReason4Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectedStatusReason5 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *RejectedStatusReason5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatusReason5) SetStructured(value string) {
 	r.Structured = (*TransferRejectedStatusReason1Code)(&value)
 }
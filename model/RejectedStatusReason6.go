@@ -10,6 +10,10 @@ type RejectedStatusReason6 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *RejectedStatusReason6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatusReason6) SetStructured(value string) {
 	r.Structured = (*RejectedStatusReason5Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectedStatusReason8 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectedStatusReason8) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatusReason8) AddReasonCode() *RejectedReason1Choice {
 	r.ReasonCode = new(RejectedReason1Choice)
 	return r.ReasonCode
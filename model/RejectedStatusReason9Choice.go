@@ -13,6 +13,10 @@ type RejectedStatusReason9Choice struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
+func (r *RejectedStatusReason9Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectedStatusReason9Choice) SetReason(value string) {
 	r.Reason = (*RejectedStatusReason9Code)(&value)
 }
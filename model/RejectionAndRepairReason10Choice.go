@@ -10,6 +10,10 @@ type RejectionAndRepairReason10Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason10Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason10Choice) SetCode(value string) {
 	r.Code = (*RejectionReason21Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionAndRepairReason13Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason13Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason13Choice) SetCode(value string) {
 	r.Code = (*RejectionReason27Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionAndRepairReason14Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason14Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason14Choice) SetCode(value string) {
 	r.Code = (*RejectionReason29Code)(&value)
 }
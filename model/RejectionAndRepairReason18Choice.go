@@ -10,6 +10,10 @@ type RejectionAndRepairReason18Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason18Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason18Choice) SetCode(value string) {
 	r.Code = (*RejectionReason32Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionAndRepairReason1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason1Choice) SetCode(value string) {
 	r.Code = (*RejectionReason21Code)(&value)
 }
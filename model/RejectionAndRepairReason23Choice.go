@@ -10,6 +10,10 @@ type RejectionAndRepairReason23Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason23Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason23Choice) SetCode(value string) {
 	r.Code = (*RejectionReason29Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionAndRepairReason24Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason24Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason24Choice) SetCode(value string) {
 	r.Code = (*RejectionReason32Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionAndRepairReason25Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason25Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason25Choice) SetCode(value string) {
 	r.Code = (*RejectionReason27Code)(&value)
 }
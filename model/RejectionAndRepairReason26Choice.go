@@ -10,6 +10,10 @@ type RejectionAndRepairReason26Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason26Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason26Choice) SetCode(value string) {
 	r.Code = (*RejectionReason24Code)(&value)
 }
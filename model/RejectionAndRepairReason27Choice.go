@@ -10,6 +10,10 @@ type RejectionAndRepairReason27Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason27Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason27Choice) SetCode(value string) {
 	r.Code = (*RejectionReason29Code)(&value)
 }
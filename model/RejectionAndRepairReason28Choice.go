@@ -10,6 +10,10 @@ type RejectionAndRepairReason28Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason28Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason28Choice) SetCode(value string) {
 	r.Code = (*RejectionReason27Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionAndRepairReason29Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason29Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason29Choice) SetCode(value string) {
 	r.Code = (*RejectionReason32Code)(&value)
 }
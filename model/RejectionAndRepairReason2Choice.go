@@ -10,6 +10,10 @@ type RejectionAndRepairReason2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason2Choice) SetCode(value string) {
 	r.Code = (*RejectionReason24Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionAndRepairReason31Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason31Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason31Choice) SetCode(value string) {
 	r.Code = (*RejectionReason24Code)(&value)
 }
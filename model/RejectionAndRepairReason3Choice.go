@@ -10,6 +10,10 @@ type RejectionAndRepairReason3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason3Choice) SetCode(value string) {
 	r.Code = (*RejectionReason23Code)(&value)
 }
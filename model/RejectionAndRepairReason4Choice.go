@@ -10,6 +10,10 @@ type RejectionAndRepairReason4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason4Choice) SetCode(value string) {
 	r.Code = (*RejectionReason22Code)(&value)
 }
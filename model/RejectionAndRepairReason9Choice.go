@@ -10,6 +10,10 @@ type RejectionAndRepairReason9Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionAndRepairReason9Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionAndRepairReason9Choice) SetCode(value string) {
 	r.Code = (*RejectionReason21Code)(&value)
 }
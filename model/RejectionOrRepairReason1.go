@@ -10,6 +10,10 @@ type RejectionOrRepairReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason1) AddCode() *RejectionAndRepairReason1Choice {
 	r.Code = new(RejectionAndRepairReason1Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionOrRepairReason10 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason10) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason10) AddCode() *RejectionAndRepairReason10Choice {
 	r.Code = new(RejectionAndRepairReason10Choice)
 	return r.Code
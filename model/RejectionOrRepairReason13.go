@@ -10,6 +10,10 @@ type RejectionOrRepairReason13 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason13) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason13) AddCode() *RejectionAndRepairReason13Choice {
 	r.Code = new(RejectionAndRepairReason13Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionOrRepairReason14 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason14) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason14) AddCode() *RejectionAndRepairReason14Choice {
 	newValue := new(RejectionAndRepairReason14Choice)
 	r.Code = append(r.Code, newValue)
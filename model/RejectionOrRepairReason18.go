@@ -10,6 +10,10 @@ type RejectionOrRepairReason18 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason18) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason18) AddCode() *RejectionAndRepairReason18Choice {
 	r.Code = new(RejectionAndRepairReason18Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionOrRepairReason2 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason2) AddCode() *RejectionAndRepairReason2Choice {
 	r.Code = new(RejectionAndRepairReason2Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionOrRepairReason23 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason23) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason23) AddCode() *RejectionAndRepairReason23Choice {
 	newValue := new(RejectionAndRepairReason23Choice)
 	r.Code = append(r.Code, newValue)
@@ -10,6 +10,10 @@ type RejectionOrRepairReason24 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason24) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason24) AddCode() *RejectionAndRepairReason24Choice {
 	r.Code = new(RejectionAndRepairReason24Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionOrRepairReason25 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason25) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason25) AddCode() *RejectionAndRepairReason25Choice {
 	r.Code = new(RejectionAndRepairReason25Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionOrRepairReason26 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason26) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason26) AddCode() *RejectionAndRepairReason26Choice {
 	r.Code = new(RejectionAndRepairReason26Choice)
 	return r.Code
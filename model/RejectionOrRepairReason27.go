@@ -10,6 +10,10 @@ type RejectionOrRepairReason27 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason27) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason27) AddCode() *RejectionAndRepairReason27Choice {
 	newValue := new(RejectionAndRepairReason27Choice)
 	r.Code = append(r.Code, newValue)
@@ -10,6 +10,10 @@ type RejectionOrRepairReason28 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason28) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason28) AddCode() *RejectionAndRepairReason28Choice {
 	r.Code = new(RejectionAndRepairReason28Choice)
 	return r.Code
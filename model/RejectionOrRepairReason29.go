@@ -10,6 +10,10 @@ type RejectionOrRepairReason29 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason29) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason29) AddCode() *RejectionAndRepairReason29Choice {
 	r.Code = new(RejectionAndRepairReason29Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionOrRepairReason3 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason3) AddCode() *RejectionAndRepairReason3Choice {
 	r.Code = new(RejectionAndRepairReason3Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionOrRepairReason31 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason31) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason31) AddCode() *RejectionAndRepairReason31Choice {
 	r.Code = new(RejectionAndRepairReason31Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionOrRepairReason4 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason4) AddCode() *RejectionAndRepairReason4Choice {
 	newValue := new(RejectionAndRepairReason4Choice)
 	r.Code = append(r.Code, newValue)
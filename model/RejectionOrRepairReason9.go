@@ -10,6 +10,10 @@ type RejectionOrRepairReason9 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionOrRepairReason9) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionOrRepairReason9) AddCode() *RejectionAndRepairReason9Choice {
 	r.Code = new(RejectionAndRepairReason9Choice)
 	return r.Code
@@ -10,8 +10,16 @@ type RejectionOrRepairStatus10Choice struct {
 	Reason []*RejectionOrRepairReason9 `xml:"Rsn,omitempty"`
 }
 
-func (r *RejectionOrRepairStatus10Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus10Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus10Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus10Choice) AddReason() *RejectionOrRepairReason9 {
@@ -10,8 +10,16 @@ type RejectionOrRepairStatus11Choice struct {
 	Reason []*RejectionOrRepairReason10 `xml:"Rsn,omitempty"`
 }
 
-func (r *RejectionOrRepairStatus11Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus11Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus11Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus11Choice) AddReason() *RejectionOrRepairReason10 {
@@ -10,8 +10,16 @@ type RejectionOrRepairStatus14Choice struct {
 	Reason []*RejectionOrRepairReason13 `xml:"Rsn"`
 }
 
-func (r *RejectionOrRepairStatus14Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus14Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus14Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus14Choice) AddReason() *RejectionOrRepairReason13 {
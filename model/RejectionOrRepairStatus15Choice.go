@@ -10,8 +10,16 @@ type RejectionOrRepairStatus15Choice struct {
 	Reason []*RejectionOrRepairReason14 `xml:"Rsn"`
 }
 
-func (r *RejectionOrRepairStatus15Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus15Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus15Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus15Choice) AddReason() *RejectionOrRepairReason14 {
@@ -10,8 +10,16 @@ type RejectionOrRepairStatus18Choice struct {
 	Reason []*RejectionOrRepairReason2 `xml:"Rsn"`
 }
 
-func (r *RejectionOrRepairStatus18Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus18Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus18Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus18Choice) AddReason() *RejectionOrRepairReason2 {
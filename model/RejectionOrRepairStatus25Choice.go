@@ -10,8 +10,16 @@ type RejectionOrRepairStatus25Choice struct {
 	Reason []*RejectionOrRepairReason18 `xml:"Rsn"`
 }
 
-func (r *RejectionOrRepairStatus25Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus25Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus25Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus25Choice) AddReason() *RejectionOrRepairReason18 {
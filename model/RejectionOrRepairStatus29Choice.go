@@ -10,8 +10,16 @@ type RejectionOrRepairStatus29Choice struct {
 	Reason []*RejectionOrRepairReason23 `xml:"Rsn"`
 }
 
-func (r *RejectionOrRepairStatus29Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus29Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus29Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus29Choice) AddReason() *RejectionOrRepairReason23 {
@@ -10,8 +10,16 @@ type RejectionOrRepairStatus2Choice struct {
 	Reason []*RejectionOrRepairReason4 `xml:"Rsn,omitempty"`
 }
 
-func (r *RejectionOrRepairStatus2Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus2Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus2Choice) AddReason() *RejectionOrRepairReason4 {
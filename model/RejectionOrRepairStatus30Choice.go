@@ -10,8 +10,16 @@ type RejectionOrRepairStatus30Choice struct {
 	Reason []*RejectionOrRepairReason24 `xml:"Rsn"`
 }
 
-func (r *RejectionOrRepairStatus30Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus30Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus30Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus30Choice) AddReason() *RejectionOrRepairReason24 {
@@ -10,8 +10,16 @@ type RejectionOrRepairStatus31Choice struct {
 	Reason []*RejectionOrRepairReason25 `xml:"Rsn"`
 }
 
-func (r *RejectionOrRepairStatus31Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus31Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus31Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus31Choice) AddReason() *RejectionOrRepairReason25 {
@@ -10,8 +10,16 @@ type RejectionOrRepairStatus32Choice struct {
 	Reason []*RejectionOrRepairReason26 `xml:"Rsn"`
 }
 
-func (r *RejectionOrRepairStatus32Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus32Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus32Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus32Choice) AddReason() *RejectionOrRepairReason26 {
@@ -10,8 +10,16 @@ type RejectionOrRepairStatus33Choice struct {
 	Reason []*RejectionOrRepairReason27 `xml:"Rsn"`
 }
 
-func (r *RejectionOrRepairStatus33Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus33Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus33Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus33Choice) AddReason() *RejectionOrRepairReason27 {
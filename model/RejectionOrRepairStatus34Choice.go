@@ -10,8 +10,16 @@ type RejectionOrRepairStatus34Choice struct {
 	Reason []*RejectionOrRepairReason28 `xml:"Rsn"`
 }
 
-func (r *RejectionOrRepairStatus34Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus34Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus34Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus34Choice) AddReason() *RejectionOrRepairReason28 {
@@ -10,8 +10,16 @@ type RejectionOrRepairStatus35Choice struct {
 	Reason []*RejectionOrRepairReason29 `xml:"Rsn"`
 }
 
-func (r *RejectionOrRepairStatus35Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus35Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus35Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus35Choice) AddReason() *RejectionOrRepairReason29 {
@@ -10,8 +10,16 @@ type RejectionOrRepairStatus37Choice struct {
 	Reason []*RejectionOrRepairReason31 `xml:"Rsn"`
 }
 
-func (r *RejectionOrRepairStatus37Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus37Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus37Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus37Choice) AddReason() *RejectionOrRepairReason31 {
@@ -10,8 +10,16 @@ type RejectionOrRepairStatus4Choice struct {
 	Reason []*RejectionOrRepairReason3 `xml:"Rsn,omitempty"`
 }
 
-func (r *RejectionOrRepairStatus4Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus4Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus4Choice) AddReason() *RejectionOrRepairReason3 {
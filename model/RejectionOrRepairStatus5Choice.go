@@ -10,8 +10,16 @@ type RejectionOrRepairStatus5Choice struct {
 	Reason []*RejectionOrRepairReason2 `xml:"Rsn,omitempty"`
 }
 
-func (r *RejectionOrRepairStatus5Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus5Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus5Choice) AddReason() *RejectionOrRepairReason2 {
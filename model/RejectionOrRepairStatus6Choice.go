@@ -10,8 +10,16 @@ type RejectionOrRepairStatus6Choice struct {
 	Reason []*RejectionOrRepairReason1 `xml:"Rsn,omitempty"`
 }
 
-func (r *RejectionOrRepairStatus6Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionOrRepairStatus6Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionOrRepairStatus6Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionOrRepairStatus6Choice) AddReason() *RejectionOrRepairReason1 {
@@ -19,6 +19,10 @@ type RejectionReason1 struct {
 	LinkedMessageRelatedReference *AdditionalReference2 `xml:"LkdMsgRltdRef,omitempty"`
 }
 
+func (r *RejectionReason1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason1) SetReason(value string) {
 	r.Reason = (*MessageRejectedReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason10 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason10) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason10) AddCode() *RejectionReason10Choice {
 	r.Code = new(RejectionReason10Choice)
 	return r.Code
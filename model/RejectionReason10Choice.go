@@ -10,6 +10,10 @@ type RejectionReason10Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionReason10Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason10Choice) SetCode(value string) {
 	r.Code = (*RejectionReason30Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason10FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RejectionReason10FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason10FormatChoice) SetCode(value string) {
 	r.Code = (*RejectionReason10Code)(&value)
 }
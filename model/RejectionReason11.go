@@ -10,6 +10,10 @@ type RejectionReason11 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason11) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason11) AddCode() *RejectionReason11Choice {
 	r.Code = new(RejectionReason11Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionReason11Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionReason11Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason11Choice) SetCode(value string) {
 	r.Code = (*RejectionReason31Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason11FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RejectionReason11FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason11FormatChoice) SetCode(value string) {
 	r.Code = (*RejectionReason11Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason12 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason12) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason12) AddCode() *ConsentOrRejectionReason2Choice {
 	r.Code = new(ConsentOrRejectionReason2Choice)
 	return r.Code
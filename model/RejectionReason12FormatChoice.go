@@ -10,6 +10,10 @@ type RejectionReason12FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RejectionReason12FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason12FormatChoice) SetCode(value string) {
 	r.Code = (*RejectionReason12Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason13FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RejectionReason13FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason13FormatChoice) SetCode(value string) {
 	r.Code = (*RejectionReason13Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason14Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionReason14Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason14Choice) SetCode(value string) {
 	r.Code = (*RejectionReason37Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason14FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RejectionReason14FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason14FormatChoice) SetCode(value string) {
 	r.Code = (*RejectionReason14Code)(&value)
 }
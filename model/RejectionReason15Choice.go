@@ -10,6 +10,10 @@ type RejectionReason15Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionReason15Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason15Choice) SetCode(value string) {
 	r.Code = (*RejectionReason38Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason15FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RejectionReason15FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason15FormatChoice) SetCode(value string) {
 	r.Code = (*RejectionReason15Code)(&value)
 }
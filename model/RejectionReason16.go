@@ -10,6 +10,10 @@ type RejectionReason16 struct {
 	AdditionalReasonInformation *Max350Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason16) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason16) AddReason() *RejectedReason4Choice {
 	r.Reason = new(RejectedReason4Choice)
 	return r.Reason
@@ -10,6 +10,10 @@ type RejectionReason16Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionReason16Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason16Choice) SetCode(value string) {
 	r.Code = (*RejectionReason39Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason17 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason17) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason17) AddCode() *RejectionReason14Choice {
 	r.Code = new(RejectionReason14Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionReason17Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionReason17Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason17Choice) SetCode(value string) {
 	r.Code = (*RejectionReason40Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason18 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason18) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason18) AddCode() *RejectionReason15Choice {
 	r.Code = new(RejectionReason15Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionReason18FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RejectionReason18FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason18FormatChoice) SetCode(value string) {
 	r.Code = (*RejectionReason18Code)(&value)
 }
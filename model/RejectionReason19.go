@@ -10,6 +10,10 @@ type RejectionReason19 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason19) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason19) AddCode() *RejectionReason17Choice {
 	r.Code = new(RejectionReason17Choice)
 	return r.Code
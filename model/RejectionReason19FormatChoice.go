@@ -10,6 +10,10 @@ type RejectionReason19FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RejectionReason19FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason19FormatChoice) SetCode(value string) {
 	r.Code = (*RejectionReason19Code)(&value)
 }
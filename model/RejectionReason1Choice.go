@@ -10,6 +10,10 @@ type RejectionReason1Choice struct {
 	RejectedElement []*RejectedElement1 `xml:"RjctdElmt"`
 }
 
+func (r *RejectionReason1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason1Choice) AddGlobalRejectionReason() *Reason2 {
 	r.GlobalRejectionReason = new(Reason2)
 	return r.GlobalRejectionReason
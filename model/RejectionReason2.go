@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // General information about the reason of the rejection.
 type RejectionReason2 struct {
 
@@ -19,6 +23,10 @@ type RejectionReason2 struct {
 	AdditionalData *Max20000Text `xml:"AddtlData,omitempty"`
 }
 
+func (r *RejectionReason2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason2) SetRejectingPartyReason(value string) {
 	r.RejectingPartyReason = (*Max35Text)(&value)
 }
@@ -27,6 +35,11 @@ func (r *RejectionReason2) SetRejectionDateTime(value string) {
 	r.RejectionDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RejectionReason2) SetRejectionDateTimeFromTime(value time.Time) {
+	r.RejectionDateTime = new(ISODateTime)
+	r.RejectionDateTime.FromTime(value)
+}
+
 func (r *RejectionReason2) SetErrorLocation(value string) {
 	r.ErrorLocation = (*Max350Text)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason20FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RejectionReason20FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason20FormatChoice) SetCode(value string) {
 	r.Code = (*RejectionReason20Code)(&value)
 }
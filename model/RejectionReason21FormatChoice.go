@@ -10,6 +10,10 @@ type RejectionReason21FormatChoice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RejectionReason21FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason21FormatChoice) SetCode(value string) {
 	r.Code = (*InterestRejectionReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason22Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RejectionReason22Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason22Choice) SetCode(value string) {
 	r.Code = (*RejectionReason39Code)(&value)
 }
@@ -13,6 +13,10 @@ type RejectionReason23 struct {
 	LinkedMessage *LinkedMessage1Choice `xml:"LkdMsg,omitempty"`
 }
 
+func (r *RejectionReason23) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason23) SetReason(value string) {
 	r.Reason = (*MessageRejectedReason1Code)(&value)
 }
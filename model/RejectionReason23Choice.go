@@ -10,6 +10,10 @@ type RejectionReason23Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RejectionReason23Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason23Choice) SetCode(value string) {
 	r.Code = (*RejectionReason30Code)(&value)
 }
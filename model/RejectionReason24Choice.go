@@ -10,6 +10,10 @@ type RejectionReason24Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RejectionReason24Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason24Choice) SetCode(value string) {
 	r.Code = (*RejectionReason31Code)(&value)
 }
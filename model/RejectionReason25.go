@@ -10,6 +10,10 @@ type RejectionReason25 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason25) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason25) AddCode() *RejectionReason23Choice {
 	r.Code = new(RejectionReason23Choice)
 	return r.Code
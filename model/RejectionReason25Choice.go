@@ -10,6 +10,10 @@ type RejectionReason25Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RejectionReason25Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason25Choice) SetCode(value string) {
 	r.Code = (*RejectionReason40Code)(&value)
 }
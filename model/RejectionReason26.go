@@ -11,6 +11,10 @@ type RejectionReason26 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason26) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason26) AddCode() *RejectionReason24Choice {
 	r.Code = new(RejectionReason24Choice)
 	return r.Code
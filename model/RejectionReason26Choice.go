@@ -10,6 +10,10 @@ type RejectionReason26Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RejectionReason26Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason26Choice) SetCode(value string) {
 	r.Code = (*RejectionReason38Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason27 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason27) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason27) AddCode() *RejectionReason25Choice {
 	r.Code = new(RejectionReason25Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionReason27Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RejectionReason27Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason27Choice) SetCode(value string) {
 	r.Code = (*RejectionReason37Code)(&value)
 }
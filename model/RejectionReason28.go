@@ -10,6 +10,10 @@ type RejectionReason28 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason28) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason28) AddCode() *RejectionReason26Choice {
 	r.Code = new(RejectionReason26Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionReason28Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RejectionReason28Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason28Choice) SetCode(value string) {
 	r.Code = (*RejectionReason38Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason29 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason29) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason29) AddCode() *ConsentOrRejectionReason4Choice {
 	r.Code = new(ConsentOrRejectionReason4Choice)
 	return r.Code
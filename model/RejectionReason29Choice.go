@@ -10,6 +10,10 @@ type RejectionReason29Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RejectionReason29Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason29Choice) SetCode(value string) {
 	r.Code = (*RejectionReason40Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionReason2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason2Choice) SetCode(value string) {
 	r.Code = (*RejectionReason26Code)(&value)
 }
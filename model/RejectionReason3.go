@@ -19,6 +19,10 @@ type RejectionReason3 struct {
 	LinkedMessageRelatedReference *AdditionalReference3 `xml:"LkdMsgRltdRef,omitempty"`
 }
 
+func (r *RejectionReason3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason3) SetReason(value string) {
 	r.Reason = (*MessageRejectedReason1Code)(&value)
 }
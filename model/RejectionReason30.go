@@ -10,6 +10,10 @@ type RejectionReason30 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason30) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason30) AddCode() *RejectionReason27Choice {
 	r.Code = new(RejectionReason27Choice)
 	return r.Code
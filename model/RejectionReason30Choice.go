@@ -10,6 +10,10 @@ type RejectionReason30Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RejectionReason30Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason30Choice) SetCode(value string) {
 	r.Code = (*RejectionReason30Code)(&value)
 }
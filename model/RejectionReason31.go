@@ -10,6 +10,10 @@ type RejectionReason31 struct {
 	AdditionalReasonInformation *Max350Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason31) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason31) AddReason() *RejectedReason16Choice {
 	r.Reason = new(RejectedReason16Choice)
 	return r.Reason
@@ -10,6 +10,10 @@ type RejectionReason31Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RejectionReason31Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason31Choice) SetCode(value string) {
 	r.Code = (*RejectionReason31Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason32 struct {
 	AdditionalReasonInformation *Max350Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason32) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason32) AddReason() *RejectedReason15Choice {
 	r.Reason = new(RejectedReason15Choice)
 	return r.Reason
@@ -10,6 +10,10 @@ type RejectionReason33 struct {
 	AdditionalReasonInformation *Max350Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason33) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason33) AddReason() *RejectedReason17Choice {
 	r.Reason = new(RejectedReason17Choice)
 	return r.Reason
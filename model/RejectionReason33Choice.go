@@ -10,6 +10,10 @@ type RejectionReason33Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RejectionReason33Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason33Choice) SetCode(value string) {
 	r.Code = (*RejectionReason37Code)(&value)
 }
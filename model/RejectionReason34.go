@@ -10,6 +10,10 @@ type RejectionReason34 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason34) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason34) AddCode() *RejectionReason28Choice {
 	r.Code = new(RejectionReason28Choice)
 	return r.Code
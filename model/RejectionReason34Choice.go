@@ -10,6 +10,10 @@ type RejectionReason34Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RejectionReason34Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason34Choice) SetCode(value string) {
 	r.Code = (*RejectionReason39Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason35 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason35) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason35) AddCode() *RejectionReason29Choice {
 	r.Code = new(RejectionReason29Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionReason36 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason36) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason36) AddCode() *RejectionReason30Choice {
 	r.Code = new(RejectionReason30Choice)
 	return r.Code
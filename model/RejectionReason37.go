@@ -11,6 +11,10 @@ type RejectionReason37 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason37) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason37) AddCode() *RejectionReason31Choice {
 	r.Code = new(RejectionReason31Choice)
 	return r.Code
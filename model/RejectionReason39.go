@@ -10,6 +10,10 @@ type RejectionReason39 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason39) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason39) AddCode() *RejectionReason33Choice {
 	r.Code = new(RejectionReason33Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionReason40 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason40) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason40) AddCode() *ConsentOrRejectionReason5Choice {
 	r.Code = new(ConsentOrRejectionReason5Choice)
 	return r.Code
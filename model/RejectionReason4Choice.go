@@ -10,6 +10,10 @@ type RejectionReason4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionReason4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason4Choice) SetCode(value string) {
 	r.Code = (*RejectionReason25Code)(&value)
 }
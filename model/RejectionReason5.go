@@ -10,6 +10,10 @@ type RejectionReason5 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason5) AddCode() *RejectionReason4Choice {
 	r.Code = new(RejectionReason4Choice)
 	return r.Code
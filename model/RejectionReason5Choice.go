@@ -10,6 +10,10 @@ type RejectionReason5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RejectionReason5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason5Choice) SetCode(value string) {
 	r.Code = (*RejectionReason16Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason6 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason6) AddCode() *RejectionReason2Choice {
 	r.Code = new(RejectionReason2Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RejectionReason6FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RejectionReason6FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason6FormatChoice) SetCode(value string) {
 	r.Code = (*RejectionReason6Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason7FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RejectionReason7FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason7FormatChoice) SetCode(value string) {
 	r.Code = (*RejectionReason7Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason8FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RejectionReason8FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason8FormatChoice) SetCode(value string) {
 	r.Code = (*RejectionReason8Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason9 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RejectionReason9) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason9) AddCode() *RejectionReason9Choice {
 	r.Code = new(RejectionReason9Choice)
 	return r.Code
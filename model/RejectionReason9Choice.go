@@ -10,6 +10,10 @@ type RejectionReason9Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (r *RejectionReason9Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason9Choice) SetCode(value string) {
 	r.Code = (*RejectionReason28Code)(&value)
 }
@@ -10,6 +10,10 @@ type RejectionReason9FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RejectionReason9FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionReason9FormatChoice) SetCode(value string) {
 	r.Code = (*RejectionReason9Code)(&value)
 }
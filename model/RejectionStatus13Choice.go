@@ -10,8 +10,16 @@ type RejectionStatus13Choice struct {
 	Reason []*RejectionReason17 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus13Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus13Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus13Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus13Choice) AddReason() *RejectionReason17 {
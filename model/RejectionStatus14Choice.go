@@ -10,8 +10,16 @@ type RejectionStatus14Choice struct {
 	Reason []*RejectionReason18 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus14Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus14Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus14Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus14Choice) AddReason() *RejectionReason18 {
@@ -10,8 +10,16 @@ type RejectionStatus17Choice struct {
 	Reason []*RejectionReason25 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus17Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus17Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus17Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus17Choice) AddReason() *RejectionReason25 {
@@ -10,8 +10,16 @@ type RejectionStatus18Choice struct {
 	Reason []*RejectionReason26 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus18Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus18Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus18Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus18Choice) AddReason() *RejectionReason26 {
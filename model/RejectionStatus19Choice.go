@@ -10,8 +10,16 @@ type RejectionStatus19Choice struct {
 	Reason []*RejectionReason28 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus19Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus19Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus19Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus19Choice) AddReason() *RejectionReason28 {
@@ -10,8 +10,16 @@ type RejectionStatus1Choice struct {
 	Reason []*RejectionReason5 `xml:"Rsn,omitempty"`
 }
 
-func (r *RejectionStatus1Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus1Choice) AddReason() *RejectionReason5 {
@@ -10,6 +10,10 @@ type RejectionStatus2 struct {
 	AdditionalInformation *Max35Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *RejectionStatus2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RejectionStatus2) SetRejectedReason(value string) {
 	r.RejectedReason = (*RejectionReasonV021Code)(&value)
 }
@@ -10,8 +10,16 @@ type RejectionStatus20Choice struct {
 	Reason *RejectionReason29 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus20Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus20Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus20Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus20Choice) AddReason() *RejectionReason29 {
@@ -10,8 +10,16 @@ type RejectionStatus21Choice struct {
 	Reason []*RejectionReason30 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus21Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus21Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus21Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus21Choice) AddReason() *RejectionReason30 {
@@ -10,8 +10,16 @@ type RejectionStatus22Choice struct {
 	Reason []*RejectionReason34 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus22Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus22Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus22Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus22Choice) AddReason() *RejectionReason34 {
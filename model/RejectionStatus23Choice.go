@@ -10,8 +10,16 @@ type RejectionStatus23Choice struct {
 	Reason []*RejectionReason36 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus23Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus23Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus23Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus23Choice) AddReason() *RejectionReason36 {
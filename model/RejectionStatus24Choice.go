@@ -10,8 +10,16 @@ type RejectionStatus24Choice struct {
 	Reason []*RejectionReason37 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus24Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus24Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus24Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus24Choice) AddReason() *RejectionReason37 {
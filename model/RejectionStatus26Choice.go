@@ -10,8 +10,16 @@ type RejectionStatus26Choice struct {
 	Reason []*RejectionReason39 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus26Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus26Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus26Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus26Choice) AddReason() *RejectionReason39 {
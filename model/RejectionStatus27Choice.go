@@ -10,8 +10,16 @@ type RejectionStatus27Choice struct {
 	Reason *RejectionReason40 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus27Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus27Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus27Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus27Choice) AddReason() *RejectionReason40 {
@@ -10,8 +10,16 @@ type RejectionStatus3Choice struct {
 	Reason []*RejectionReason6 `xml:"Rsn,omitempty"`
 }
 
-func (r *RejectionStatus3Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus3Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus3Choice) AddReason() *RejectionReason6 {
@@ -10,8 +10,16 @@ type RejectionStatus5Choice struct {
 	Reason []*RejectionReason10 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus5Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus5Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus5Choice) AddReason() *RejectionReason10 {
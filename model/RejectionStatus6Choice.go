@@ -10,8 +10,16 @@ type RejectionStatus6Choice struct {
 	Reason []*RejectionReason6 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus6Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus6Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus6Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus6Choice) AddReason() *RejectionReason6 {
@@ -10,8 +10,16 @@ type RejectionStatus7Choice struct {
 	Reason *RejectionReason12 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus7Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus7Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus7Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus7Choice) AddReason() *RejectionReason12 {
@@ -10,8 +10,16 @@ type RejectionStatus8Choice struct {
 	Reason []*RejectionReason11 `xml:"Rsn"`
 }
 
-func (r *RejectionStatus8Choice) SetNoSpecifiedReason(value string) {
+func (r *RejectionStatus8Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RejectionStatus8Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RejectionStatus8Choice) AddReason() *RejectionReason11 {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the type of related tax.
 type RelatedTaxType1 struct {
 
@@ -10,6 +14,10 @@ type RelatedTaxType1 struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (r *RelatedTaxType1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RelatedTaxType1) AddTaxType() *TaxType3FormatChoice {
 	r.TaxType = new(TaxType3FormatChoice)
 	return r.TaxType
@@ -18,3 +26,7 @@ func (r *RelatedTaxType1) AddTaxType() *TaxType3FormatChoice {
 func (r *RelatedTaxType1) SetAmount(value, currency string) {
 	r.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (r *RelatedTaxType1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
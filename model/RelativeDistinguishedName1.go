@@ -10,6 +10,10 @@ type RelativeDistinguishedName1 struct {
 	AttributeValue *Max140Text `xml:"AttrVal"`
 }
 
+func (r *RelativeDistinguishedName1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RelativeDistinguishedName1) SetAttributeType(value string) {
 	r.AttributeType = (*AttributeType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RelativeDistinguishedName2 struct {
 	AttributeValue *Max140Text `xml:"AttrVal"`
 }
 
+func (r *RelativeDistinguishedName2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RelativeDistinguishedName2) SetAttributeType(value string) {
 	r.AttributeType = (*AttributeType2Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Nature of the amount and currency on a document referred to in the remittance section, typically either the original amount due/payable or the amount actually remitted for the referenced document.
 type RemittanceAmount1 struct {
 
@@ -22,22 +26,42 @@ type RemittanceAmount1 struct {
 	RemittedAmount *ActiveOrHistoricCurrencyAndAmount `xml:"RmtdAmt,omitempty"`
 }
 
+func (r *RemittanceAmount1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceAmount1) SetDuePayableAmount(value, currency string) {
 	r.DuePayableAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RemittanceAmount1) SetDuePayableAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.DuePayableAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RemittanceAmount1) SetDiscountAppliedAmount(value, currency string) {
 	r.DiscountAppliedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RemittanceAmount1) SetDiscountAppliedAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.DiscountAppliedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RemittanceAmount1) SetCreditNoteAmount(value, currency string) {
 	r.CreditNoteAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RemittanceAmount1) SetCreditNoteAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.CreditNoteAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RemittanceAmount1) SetTaxAmount(value, currency string) {
 	r.TaxAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RemittanceAmount1) SetTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.TaxAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RemittanceAmount1) AddAdjustmentAmountAndReason() *DocumentAdjustment1 {
 	newValue := new(DocumentAdjustment1)
 	r.AdjustmentAmountAndReason = append(r.AdjustmentAmountAndReason, newValue)
@@ -187,3 +211,7 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (r *RemittanceAmount1) SetRemittedAmount(value, currency string) {
 	r.RemittedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (r *RemittanceAmount1) SetRemittedAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.RemittedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
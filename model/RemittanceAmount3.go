@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Nature of the amount and currency on a document referred to in the remittance section, typically either the original amount due/payable or the amount actually remitted for the referenced document.
 type RemittanceAmount3 struct {
 
@@ -22,10 +27,18 @@ type RemittanceAmount3 struct {
 	RemittedAmount *ActiveOrHistoricCurrencyAndAmount `xml:"RmtdAmt,omitempty"`
 }
 
+func (r *RemittanceAmount3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceAmount3) SetDuePayableAmount(value, currency string) {
 	r.DuePayableAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RemittanceAmount3) SetDuePayableAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.DuePayableAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RemittanceAmount3) AddDiscountAppliedAmount() *DiscountAmountAndType1 {
 	newValue := new(DiscountAmountAndType1)
 	r.DiscountAppliedAmount = append(r.DiscountAppliedAmount, newValue)
@@ -176,6 +189,10 @@ func (r *RemittanceAmount3) SetCreditNoteAmount(value, currency string) {
 	r.CreditNoteAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *RemittanceAmount3) SetCreditNoteAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.CreditNoteAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RemittanceAmount3) AddTaxAmount() *TaxAmountAndType1 {
 	newValue := new(TaxAmountAndType1)
 	r.TaxAmount = append(r.TaxAmount, newValue)
@@ -471,3 +488,90 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (r *RemittanceAmount3) SetRemittedAmount(value, currency string) {
 	r.RemittedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (r *RemittanceAmount3) SetRemittedAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.RemittedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (r *RemittanceAmount3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.DuePayableAmount != nil {
+		r.DuePayableAmount.EncodeXMLFast(enc, "DuePyblAmt")
+	}
+	for _, elem := range r.DiscountAppliedAmount {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "DscntApldAmt")
+		}
+	}
+	if r.CreditNoteAmount != nil {
+		r.CreditNoteAmount.EncodeXMLFast(enc, "CdtNoteAmt")
+	}
+	for _, elem := range r.TaxAmount {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "TaxAmt")
+		}
+	}
+	for _, elem := range r.AdjustmentAmountAndReason {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "AdjstmntAmtAndRsn")
+		}
+	}
+	if r.RemittedAmount != nil {
+		r.RemittedAmount.EncodeXMLFast(enc, "RmtdAmt")
+	}
+	enc.End(tag)
+}
+
+func (r *RemittanceAmount3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "DuePyblAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.DuePayableAmount = elem
+		case "DscntApldAmt":
+			elem := new(DiscountAmountAndType1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.DiscountAppliedAmount = append(r.DiscountAppliedAmount, elem)
+		case "CdtNoteAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.CreditNoteAmount = elem
+		case "TaxAmt":
+			elem := new(TaxAmountAndType1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.TaxAmount = append(r.TaxAmount, elem)
+		case "AdjstmntAmtAndRsn":
+			elem := new(DocumentAdjustment1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.AdjustmentAmountAndReason = append(r.AdjustmentAmountAndReason, elem)
+		case "RmtdAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.RemittedAmount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
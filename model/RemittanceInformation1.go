@@ -10,6 +10,10 @@ type RemittanceInformation1 struct {
 	Structured []*StructuredRemittanceInformation6 `xml:"Strd,omitempty"`
 }
 
+func (r *RemittanceInformation1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceInformation1) AddUnstructured(value string) {
 	r.Unstructured = append(r.Unstructured, (*Max140Text)(&value))
 }
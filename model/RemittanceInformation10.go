@@ -10,6 +10,10 @@ type RemittanceInformation10 struct {
 	Structured []*StructuredRemittanceInformation12 `xml:"Strd,omitempty"`
 }
 
+func (r *RemittanceInformation10) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceInformation10) AddUnstructured(value string) {
 	r.Unstructured = append(r.Unstructured, (*Max140Text)(&value))
 }
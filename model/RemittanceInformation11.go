@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Information supplied to enable the matching/reconciliation of an entry with the items that the payment is intended to settle, such as commercial invoices in an accounts' receivable system.
 type RemittanceInformation11 struct {
 
@@ -10,6 +12,10 @@ type RemittanceInformation11 struct {
 	Structured []*StructuredRemittanceInformation13 `xml:"Strd,omitempty"`
 }
 
+func (r *RemittanceInformation11) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceInformation11) AddUnstructured(value string) {
 	r.Unstructured = append(r.Unstructured, (*Max140Text)(&value))
 }
@@ -20,6 +26,51 @@ func (r *RemittanceInformation11) AddStructured() *StructuredRemittanceInformati
 	return newValue
 }
 
+func (r *RemittanceInformation11) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	for _, elem := range r.Unstructured {
+		if elem != nil {
+			enc.Element("Ustrd", string(*elem))
+		}
+	}
+	for _, elem := range r.Structured {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Strd")
+		}
+	}
+	enc.End(tag)
+}
+
+func (r *RemittanceInformation11) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Ustrd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Unstructured = append(r.Unstructured, (*Max140Text)(&value))
+		case "Strd":
+			elem := new(StructuredRemittanceInformation13)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Structured = append(r.Structured, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -16,6 +16,10 @@ type RemittanceInformation12 struct {
 	OriginalPaymentInformation *OriginalPaymentInformation6 `xml:"OrgnlPmtInf"`
 }
 
+func (r *RemittanceInformation12) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceInformation12) SetRemittanceIdentification(value string) {
 	r.RemittanceIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type RemittanceInformation13 struct {
 	OriginalPaymentInformation *OriginalPaymentInformation7 `xml:"OrgnlPmtInf"`
 }
 
+func (r *RemittanceInformation13) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceInformation13) SetRemittanceIdentification(value string) {
 	r.RemittanceIdentification = (*Max35Text)(&value)
 }
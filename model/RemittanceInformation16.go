@@ -0,0 +1,72 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Information supplied to enable the matching/reconciliation of an entry with the items that the payment is intended to settle, such as commercial invoices in an accounts' receivable system.
+type RemittanceInformation16 struct {
+
+	// Information supplied to enable the matching/reconciliation of an entry with the items that the payment is intended to settle, such as commercial invoices in an accounts' receivable system, in an unstructured form.
+	Unstructured []*Max140Text `xml:"Ustrd,omitempty"`
+
+	// Information supplied to enable the matching/reconciliation of an entry with the items that the payment is intended to settle, such as commercial invoices in an accounts' receivable system, in a structured form.
+	Structured []*StructuredRemittanceInformation16 `xml:"Strd,omitempty"`
+}
+
+func (r *RemittanceInformation16) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RemittanceInformation16) AddUnstructured(value string) {
+	r.Unstructured = append(r.Unstructured, (*Max140Text)(&value))
+}
+
+func (r *RemittanceInformation16) AddStructured() *StructuredRemittanceInformation16 {
+	newValue := new(StructuredRemittanceInformation16)
+	r.Structured = append(r.Structured, newValue)
+	return newValue
+}
+
+func (r *RemittanceInformation16) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	for _, elem := range r.Unstructured {
+		if elem != nil {
+			enc.Element("Ustrd", string(*elem))
+		}
+	}
+	for _, elem := range r.Structured {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Strd")
+		}
+	}
+	enc.End(tag)
+}
+
+func (r *RemittanceInformation16) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Ustrd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Unstructured = append(r.Unstructured, (*Max140Text)(&value))
+		case "Strd":
+			elem := new(StructuredRemittanceInformation16)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Structured = append(r.Structured, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
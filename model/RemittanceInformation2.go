@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Information supplied to enable the matching of an entry with the items that the transfer is intended to settle.
 type RemittanceInformation2 struct {
 
@@ -7,6 +9,44 @@ type RemittanceInformation2 struct {
 	Unstructured []*Max140Text `xml:"Ustrd,omitempty"`
 }
 
+func (r *RemittanceInformation2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceInformation2) AddUnstructured(value string) {
 	r.Unstructured = append(r.Unstructured, (*Max140Text)(&value))
 }
+
+func (r *RemittanceInformation2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	for _, elem := range r.Unstructured {
+		if elem != nil {
+			enc.Element("Ustrd", string(*elem))
+		}
+	}
+	enc.End(tag)
+}
+
+func (r *RemittanceInformation2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Ustrd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Unstructured = append(r.Unstructured, (*Max140Text)(&value))
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ type RemittanceInformation3Choice struct {
 	Structured *StructuredRemittanceInformation2 `xml:"Strd"`
 }
 
+func (r *RemittanceInformation3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceInformation3Choice) SetUnstructured(value string) {
 	r.Unstructured = (*Max140Text)(&value)
 }
@@ -10,6 +10,10 @@ type RemittanceInformation5 struct {
 	Structured []*StructuredRemittanceInformation7 `xml:"Strd,omitempty"`
 }
 
+func (r *RemittanceInformation5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceInformation5) AddUnstructured(value string) {
 	r.Unstructured = append(r.Unstructured, (*Max140Text)(&value))
 }
@@ -10,6 +10,10 @@ type RemittanceInformation6 struct {
 	Structured []*StructuredRemittanceInformation8 `xml:"Strd,omitempty"`
 }
 
+func (r *RemittanceInformation6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceInformation6) AddUnstructured(value string) {
 	r.Unstructured = append(r.Unstructured, (*Max140Text)(&value))
 }
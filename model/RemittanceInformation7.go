@@ -10,6 +10,10 @@ type RemittanceInformation7 struct {
 	Structured []*StructuredRemittanceInformation9 `xml:"Strd,omitempty"`
 }
 
+func (r *RemittanceInformation7) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceInformation7) AddUnstructured(value string) {
 	r.Unstructured = append(r.Unstructured, (*Max140Text)(&value))
 }
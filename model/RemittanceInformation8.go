@@ -16,6 +16,10 @@ type RemittanceInformation8 struct {
 	OriginalPaymentInformation *OriginalPaymentInformation6 `xml:"OrgnlPmtInf"`
 }
 
+func (r *RemittanceInformation8) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceInformation8) SetRemittanceIdentification(value string) {
 	r.RemittanceIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type RemittanceLocation1 struct {
 	RemittanceLocationPostalAddress *NameAndAddress3 `xml:"RmtLctnPstlAdr,omitempty"`
 }
 
+func (r *RemittanceLocation1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceLocation1) SetRemittanceIdentification(value string) {
 	r.RemittanceIdentification = (*Max35Text)(&value)
 }
@@ -16,12 +16,20 @@ type RemittanceLocation2 struct {
 	RemittanceLocationPostalAddress *NameAndAddress10 `xml:"RmtLctnPstlAdr,omitempty"`
 }
 
+func (r *RemittanceLocation2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceLocation2) SetRemittanceIdentification(value string) {
 	r.RemittanceIdentification = (*Max35Text)(&value)
 }
 
-func (r *RemittanceLocation2) SetRemittanceLocationMethod(value string) {
+func (r *RemittanceLocation2) SetRemittanceLocationMethod(value string) error {
+	if err := RemittanceLocationMethod2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.RemittanceLocationMethod = (*RemittanceLocationMethod2Code)(&value)
+	return nil
 }
 
 func (r *RemittanceLocation2) SetRemittanceLocationElectronicAddress(value string) {
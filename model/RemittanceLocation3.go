@@ -13,6 +13,10 @@ type RemittanceLocation3 struct {
 	References *TransactionReferences4 `xml:"Refs"`
 }
 
+func (r *RemittanceLocation3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceLocation3) SetRemittanceIdentification(value string) {
 	r.RemittanceIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to provide information on the remittance advice.
 type RemittanceLocation4 struct {
 
@@ -10,6 +12,10 @@ type RemittanceLocation4 struct {
 	RemittanceLocationDetails []*RemittanceLocationDetails1 `xml:"RmtLctnDtls,omitempty"`
 }
 
+func (r *RemittanceLocation4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RemittanceLocation4) SetRemittanceIdentification(value string) {
 	r.RemittanceIdentification = (*Max35Text)(&value)
 }
@@ -20,6 +26,49 @@ func (r *RemittanceLocation4) AddRemittanceLocationDetails() *RemittanceLocation
 	return newValue
 }
 
+func (r *RemittanceLocation4) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.RemittanceIdentification != nil {
+		enc.Element("RmtId", string(*r.RemittanceIdentification))
+	}
+	for _, elem := range r.RemittanceLocationDetails {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RmtLctnDtls")
+		}
+	}
+	enc.End(tag)
+}
+
+func (r *RemittanceLocation4) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "RmtId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.RemittanceIdentification = (*Max35Text)(&value)
+		case "RmtLctnDtls":
+			elem := new(RemittanceLocationDetails1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.RemittanceLocationDetails = append(r.RemittanceLocationDetails, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -0,0 +1,70 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Set of elements used to provide information on the remittance advice.
+type RemittanceLocation7 struct {
+
+	// Unique identification, as assigned by the initiating party, to unambiguously identify the remittance information sent separately from the payment instruction, such as a remittance advice.
+	RemittanceIdentification *Max35Text `xml:"RmtId,omitempty"`
+
+	// Set of elements used to provide information on the location and/or delivery of the remittance information.
+	RemittanceLocationDetails []*RemittanceLocationData1 `xml:"RmtLctnDtls,omitempty"`
+}
+
+func (r *RemittanceLocation7) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RemittanceLocation7) SetRemittanceIdentification(value string) {
+	r.RemittanceIdentification = (*Max35Text)(&value)
+}
+
+func (r *RemittanceLocation7) AddRemittanceLocationDetails() *RemittanceLocationData1 {
+	newValue := new(RemittanceLocationData1)
+	r.RemittanceLocationDetails = append(r.RemittanceLocationDetails, newValue)
+	return newValue
+}
+
+func (r *RemittanceLocation7) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.RemittanceIdentification != nil {
+		enc.Element("RmtId", string(*r.RemittanceIdentification))
+	}
+	for _, elem := range r.RemittanceLocationDetails {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RmtLctnDtls")
+		}
+	}
+	enc.End(tag)
+}
+
+func (r *RemittanceLocation7) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "RmtId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.RemittanceIdentification = (*Max35Text)(&value)
+		case "RmtLctnDtls":
+			elem := new(RemittanceLocationData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.RemittanceLocationDetails = append(r.RemittanceLocationDetails, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
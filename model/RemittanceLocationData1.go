@@ -0,0 +1,87 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Provides information on the remittance advice.
+type RemittanceLocationData1 struct {
+
+	// Method used to deliver the remittance advice information.
+	Method *RemittanceLocationMethod2Code `xml:"Mtd"`
+
+	// Electronic address to which an agent is to send the remittance information.
+	ElectronicAddress *Max2048Text `xml:"ElctrncAdr,omitempty"`
+
+	// Postal address to which an agent is to send the remittance information.
+	PostalAddress *NameAndAddress16 `xml:"PstlAdr,omitempty"`
+}
+
+func (r *RemittanceLocationData1) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RemittanceLocationData1) SetMethod(value string) error {
+	if err := RemittanceLocationMethod2Code(value).Validate(); err != nil {
+		return err
+	}
+	r.Method = (*RemittanceLocationMethod2Code)(&value)
+	return nil
+}
+
+func (r *RemittanceLocationData1) SetElectronicAddress(value string) {
+	r.ElectronicAddress = (*Max2048Text)(&value)
+}
+
+func (r *RemittanceLocationData1) AddPostalAddress() *NameAndAddress16 {
+	r.PostalAddress = new(NameAndAddress16)
+	return r.PostalAddress
+}
+
+func (r *RemittanceLocationData1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.Method != nil {
+		enc.Element("Mtd", string(*r.Method))
+	}
+	if r.ElectronicAddress != nil {
+		enc.Element("ElctrncAdr", string(*r.ElectronicAddress))
+	}
+	if r.PostalAddress != nil {
+		r.PostalAddress.EncodeXMLFast(enc, "PstlAdr")
+	}
+	enc.End(tag)
+}
+
+func (r *RemittanceLocationData1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Mtd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Method = (*RemittanceLocationMethod2Code)(&value)
+		case "ElctrncAdr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.ElectronicAddress = (*Max2048Text)(&value)
+		case "PstlAdr":
+			elem := new(NameAndAddress16)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.PostalAddress = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Provides information on the remittance advice.
 type RemittanceLocationDetails1 struct {
 
@@ -13,8 +15,16 @@ type RemittanceLocationDetails1 struct {
 	PostalAddress *NameAndAddress10 `xml:"PstlAdr,omitempty"`
 }
 
-func (r *RemittanceLocationDetails1) SetMethod(value string) {
+func (r *RemittanceLocationDetails1) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RemittanceLocationDetails1) SetMethod(value string) error {
+	if err := RemittanceLocationMethod2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.Method = (*RemittanceLocationMethod2Code)(&value)
+	return nil
 }
 
 func (r *RemittanceLocationDetails1) SetElectronicAddress(value string) {
@@ -25,3 +35,53 @@ func (r *RemittanceLocationDetails1) AddPostalAddress() *NameAndAddress10 {
 	r.PostalAddress = new(NameAndAddress10)
 	return r.PostalAddress
 }
+
+func (r *RemittanceLocationDetails1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.Method != nil {
+		enc.Element("Mtd", string(*r.Method))
+	}
+	if r.ElectronicAddress != nil {
+		enc.Element("ElctrncAdr", string(*r.ElectronicAddress))
+	}
+	if r.PostalAddress != nil {
+		r.PostalAddress.EncodeXMLFast(enc, "PstlAdr")
+	}
+	enc.End(tag)
+}
+
+func (r *RemittanceLocationDetails1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Mtd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Method = (*RemittanceLocationMethod2Code)(&value)
+		case "ElctrncAdr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.ElectronicAddress = (*Max2048Text)(&value)
+		case "PstlAdr":
+			elem := new(NameAndAddress10)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.PostalAddress = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,3 +1,34 @@
 package model
 
 type RemittanceLocationMethod2Code string
+
+const (
+	RemittanceLocationMethod2CodeFAXI RemittanceLocationMethod2Code = "FAXI"
+	RemittanceLocationMethod2CodeEDIC RemittanceLocationMethod2Code = "EDIC"
+	RemittanceLocationMethod2CodeURID RemittanceLocationMethod2Code = "URID"
+	RemittanceLocationMethod2CodeEMAL RemittanceLocationMethod2Code = "EMAL"
+	RemittanceLocationMethod2CodePOST RemittanceLocationMethod2Code = "POST"
+	RemittanceLocationMethod2CodeSMSM RemittanceLocationMethod2Code = "SMSM"
+)
+
+var remittanceLocationMethod2CodeDescriptions = map[RemittanceLocationMethod2Code]string{
+	RemittanceLocationMethod2CodeFAXI: "Fax: remittance advice information must be faxed.",
+	RemittanceLocationMethod2CodeEDIC: "Electronic data interchange: remittance advice information must be sent through Electronic Data Interchange (EDI).",
+	RemittanceLocationMethod2CodeURID: "Uniform resource identifier: remittance advice information needs to be sent to a Uniform Resource Identifier (URI).",
+	RemittanceLocationMethod2CodeEMAL: "E-mail: remittance advice information must be sent through e-mail.",
+	RemittanceLocationMethod2CodePOST: "Post: remittance advice information must be sent through postal services.",
+	RemittanceLocationMethod2CodeSMSM: "SMS: remittance advice information must be sent through by phone as a short message service (SMS).",
+}
+
+func (r RemittanceLocationMethod2Code) IsValid() bool {
+	_, ok := remittanceLocationMethod2CodeDescriptions[r]
+	return ok
+}
+
+func (r RemittanceLocationMethod2Code) Description() string {
+	return remittanceLocationMethod2CodeDescriptions[r]
+}
+
+func (r RemittanceLocationMethod2Code) Validate() error {
+	return validateEnumeration("RemittanceLocationMethod2Code", string(r), r.IsValid(), "FAXI, EDIC, URID, EMAL, POST, SMSM")
+}
@@ -10,6 +10,10 @@ type RenounceableEntitlementStatusTypeFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RenounceableEntitlementStatusTypeFormat1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RenounceableEntitlementStatusTypeFormat1Choice) SetCode(value string) {
 	r.Code = (*RenounceableStatus1Code)(&value)
 }
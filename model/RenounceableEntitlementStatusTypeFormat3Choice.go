@@ -10,6 +10,10 @@ type RenounceableEntitlementStatusTypeFormat3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RenounceableEntitlementStatusTypeFormat3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RenounceableEntitlementStatusTypeFormat3Choice) SetCode(value string) {
 	r.Code = (*RenounceableStatus1Code)(&value)
 }
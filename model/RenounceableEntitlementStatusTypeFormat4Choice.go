@@ -10,6 +10,10 @@ type RenounceableEntitlementStatusTypeFormat4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RenounceableEntitlementStatusTypeFormat4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RenounceableEntitlementStatusTypeFormat4Choice) SetCode(value string) {
 	r.Code = (*RenounceableStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RenounceableStatus1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (r *RenounceableStatus1FormatChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RenounceableStatus1FormatChoice) SetCode(value string) {
 	r.Code = (*RenounceableStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepairReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RepairReason1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason1) AddCode() *RepairReason1Choice {
 	r.Code = new(RepairReason1Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RepairReason10 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RepairReason10) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason10) AddCode() *RepairReason12Choice {
 	r.Code = new(RepairReason12Choice)
 	return r.Code
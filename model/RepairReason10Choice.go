@@ -10,6 +10,10 @@ type RepairReason10Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RepairReason10Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason10Choice) SetCode(value string) {
 	r.Code = (*RepairReason4Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepairReason11 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RepairReason11) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason11) AddCode() *RepairReason13Choice {
 	r.Code = new(RepairReason13Choice)
 	return r.Code
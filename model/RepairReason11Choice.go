@@ -10,6 +10,10 @@ type RepairReason11Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RepairReason11Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason11Choice) SetCode(value string) {
 	r.Code = (*RepairReason6Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepairReason12Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RepairReason12Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason12Choice) SetCode(value string) {
 	r.Code = (*RepairReason5Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepairReason13 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RepairReason13) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason13) AddCode() *RepairReason14Choice {
 	r.Code = new(RepairReason14Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RepairReason13Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RepairReason13Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason13Choice) SetCode(value string) {
 	r.Code = (*RepairReason5Code)(&value)
 }
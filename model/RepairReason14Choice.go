@@ -10,6 +10,10 @@ type RepairReason14Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RepairReason14Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason14Choice) SetCode(value string) {
 	r.Code = (*RepairReason4Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepairReason18Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RepairReason18Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason18Choice) SetCode(value string) {
 	r.Code = (*RepairReason6Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepairReason1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RepairReason1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason1Choice) SetCode(value string) {
 	r.Code = (*RepairReason4Code)(&value)
 }
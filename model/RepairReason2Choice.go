@@ -10,6 +10,10 @@ type RepairReason2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RepairReason2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason2Choice) SetCode(value string) {
 	r.Code = (*RepairReason5Code)(&value)
 }
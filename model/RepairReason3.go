@@ -10,6 +10,10 @@ type RepairReason3 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RepairReason3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason3) AddCode() *RepairReason2Choice {
 	r.Code = new(RepairReason2Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RepairReason3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RepairReason3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason3Choice) SetCode(value string) {
 	r.Code = (*RepairReason5Code)(&value)
 }
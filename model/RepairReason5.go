@@ -10,6 +10,10 @@ type RepairReason5 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RepairReason5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason5) AddCode() *RepairReason9Choice {
 	r.Code = new(RepairReason9Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RepairReason6 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RepairReason6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason6) AddCode() *RepairReason1Choice {
 	r.Code = new(RepairReason1Choice)
 	return r.Code
@@ -10,6 +10,10 @@ type RepairReason7Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RepairReason7Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason7Choice) SetCode(value string) {
 	r.Code = (*RepairReason6Code)(&value)
 }
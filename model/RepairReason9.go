@@ -10,6 +10,10 @@ type RepairReason9 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (r *RepairReason9) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason9) AddCode() *RepairReason10Choice {
 	r.Code = new(RepairReason10Choice)
 	return r.Code
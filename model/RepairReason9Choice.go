@@ -10,6 +10,10 @@ type RepairReason9Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (r *RepairReason9Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairReason9Choice) SetCode(value string) {
 	r.Code = (*RepairReason7Code)(&value)
 }
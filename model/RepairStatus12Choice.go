@@ -10,8 +10,16 @@ type RepairStatus12Choice struct {
 	Reason []*RepairReason8 `xml:"Rsn"`
 }
 
-func (r *RepairStatus12Choice) SetNoSpecifiedReason(value string) {
+func (r *RepairStatus12Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RepairStatus12Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RepairStatus12Choice) AddReason() *RepairReason8 {
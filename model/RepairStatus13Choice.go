@@ -10,8 +10,16 @@ type RepairStatus13Choice struct {
 	Reason []*RepairReason9 `xml:"Rsn"`
 }
 
-func (r *RepairStatus13Choice) SetNoSpecifiedReason(value string) {
+func (r *RepairStatus13Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RepairStatus13Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RepairStatus13Choice) AddReason() *RepairReason9 {
@@ -10,8 +10,16 @@ type RepairStatus14Choice struct {
 	Reason []*RepairReason10 `xml:"Rsn"`
 }
 
-func (r *RepairStatus14Choice) SetNoSpecifiedReason(value string) {
+func (r *RepairStatus14Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RepairStatus14Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RepairStatus14Choice) AddReason() *RepairReason10 {
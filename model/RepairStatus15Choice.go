@@ -10,8 +10,16 @@ type RepairStatus15Choice struct {
 	Reason []*RepairReason11 `xml:"Rsn"`
 }
 
-func (r *RepairStatus15Choice) SetNoSpecifiedReason(value string) {
+func (r *RepairStatus15Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RepairStatus15Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RepairStatus15Choice) AddReason() *RepairReason11 {
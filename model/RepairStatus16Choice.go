@@ -10,8 +10,16 @@ type RepairStatus16Choice struct {
 	Reason []*RepairReason12 `xml:"Rsn"`
 }
 
-func (r *RepairStatus16Choice) SetNoSpecifiedReason(value string) {
+func (r *RepairStatus16Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RepairStatus16Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RepairStatus16Choice) AddReason() *RepairReason12 {
@@ -10,8 +10,16 @@ type RepairStatus17Choice struct {
 	Reason []*RepairReason13 `xml:"Rsn"`
 }
 
-func (r *RepairStatus17Choice) SetNoSpecifiedReason(value string) {
+func (r *RepairStatus17Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RepairStatus17Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RepairStatus17Choice) AddReason() *RepairReason13 {
@@ -10,8 +10,16 @@ type RepairStatus1Choice struct {
 	Reason []*RepairReason1 `xml:"Rsn,omitempty"`
 }
 
-func (r *RepairStatus1Choice) SetNoSpecifiedReason(value string) {
+func (r *RepairStatus1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RepairStatus1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RepairStatus1Choice) AddReason() *RepairReason1 {
@@ -10,8 +10,16 @@ type RepairStatus3Choice struct {
 	Reason []*RepairReason3 `xml:"Rsn,omitempty"`
 }
 
-func (r *RepairStatus3Choice) SetNoSpecifiedReason(value string) {
+func (r *RepairStatus3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RepairStatus3Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RepairStatus3Choice) AddReason() *RepairReason3 {
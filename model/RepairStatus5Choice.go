@@ -10,8 +10,16 @@ type RepairStatus5Choice struct {
 	Reason []*RepairReason1 `xml:"Rsn"`
 }
 
-func (r *RepairStatus5Choice) SetNoSpecifiedReason(value string) {
+func (r *RepairStatus5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RepairStatus5Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RepairStatus5Choice) AddReason() *RepairReason1 {
@@ -10,8 +10,16 @@ type RepairStatus6Choice struct {
 	Reason []*RepairReason3 `xml:"Rsn"`
 }
 
-func (r *RepairStatus6Choice) SetNoSpecifiedReason(value string) {
+func (r *RepairStatus6Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RepairStatus6Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RepairStatus6Choice) AddReason() *RepairReason3 {
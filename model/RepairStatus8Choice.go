@@ -10,8 +10,16 @@ type RepairStatus8Choice struct {
 	Reason []*RepairReason6 `xml:"Rsn"`
 }
 
-func (r *RepairStatus8Choice) SetNoSpecifiedReason(value string) {
+func (r *RepairStatus8Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *RepairStatus8Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *RepairStatus8Choice) AddReason() *RepairReason6 {
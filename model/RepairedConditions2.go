@@ -10,6 +10,10 @@ type RepairedConditions2 struct {
 	RepairedCommission []*Commission7 `xml:"RprdComssn,omitempty"`
 }
 
+func (r *RepairedConditions2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairedConditions2) AddRepairedCharge() *Charge11 {
 	newValue := new(Charge11)
 	r.RepairedCharge = append(r.RepairedCharge, newValue)
@@ -10,6 +10,10 @@ type RepairedConditions3 struct {
 	RepairedCommission []*Commission11 `xml:"RprdComssn,omitempty"`
 }
 
+func (r *RepairedConditions3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepairedConditions3) AddRepairedCharge() *Charge19 {
 	newValue := new(Charge19)
 	r.RepairedCharge = append(r.RepairedCharge, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Indicates how the amount of the investment plan is split amongst the funds.
 type Repartition1 struct {
 
@@ -10,10 +14,19 @@ type Repartition1 struct {
 	FinancialInstrument *FinancialInstrument10 `xml:"FinInstrm"`
 }
 
+func (r *Repartition1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Repartition1) SetPercentage(value string) {
 	r.Percentage = (*PercentageRate)(&value)
 }
 
+func (r *Repartition1) SetPercentageFromDecimal(value decimal.Decimal) {
+	r.Percentage = new(PercentageRate)
+	r.Percentage.FromDecimal(value)
+}
+
 func (r *Repartition1) AddFinancialInstrument() *FinancialInstrument10 {
 	r.FinancialInstrument = new(FinancialInstrument10)
 	return r.FinancialInstrument
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Indicates how the amount of the investment plan is split amongst the funds.
 type Repartition2 struct {
 
@@ -13,10 +17,19 @@ type Repartition2 struct {
 	CurrencyOfPlan *CurrencyCode `xml:"CcyOfPlan,omitempty"`
 }
 
+func (r *Repartition2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Repartition2) SetPercentage(value string) {
 	r.Percentage = (*PercentageRate)(&value)
 }
 
+func (r *Repartition2) SetPercentageFromDecimal(value decimal.Decimal) {
+	r.Percentage = new(PercentageRate)
+	r.Percentage.FromDecimal(value)
+}
+
 func (r *Repartition2) AddFinancialInstrument() *FinancialInstrument29 {
 	r.FinancialInstrument = new(FinancialInstrument29)
 	return r.FinancialInstrument
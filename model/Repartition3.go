@@ -13,6 +13,10 @@ type Repartition3 struct {
 	CurrencyOfPlan *CurrencyCode `xml:"CcyOfPlan,omitempty"`
 }
 
+func (r *Repartition3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Repartition3) AddQuantity() *UnitsOrAmountOrPercentage1Choice {
 	r.Quantity = new(UnitsOrAmountOrPercentage1Choice)
 	return r.Quantity
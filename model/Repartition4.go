@@ -13,6 +13,10 @@ type Repartition4 struct {
 	CurrencyOfPlan *ActiveOrHistoricCurrencyCode `xml:"CcyOfPlan,omitempty"`
 }
 
+func (r *Repartition4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Repartition4) AddQuantity() *UnitsOrAmountOrPercentage1Choice {
 	r.Quantity = new(UnitsOrAmountOrPercentage1Choice)
 	return r.Quantity
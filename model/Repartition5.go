@@ -13,6 +13,10 @@ type Repartition5 struct {
 	CurrencyOfPlan *ActiveOrHistoricCurrencyCode `xml:"CcyOfPlan,omitempty"`
 }
 
+func (r *Repartition5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Repartition5) AddQuantity() *UnitsOrAmountOrPercentage1Choice {
 	r.Quantity = new(UnitsOrAmountOrPercentage1Choice)
 	return r.Quantity
@@ -10,6 +10,10 @@ type Replacement2 struct {
 	ProposedValue *Max350Text `xml:"PropsdVal"`
 }
 
+func (r *Replacement2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Replacement2) SetCurrentValue(value string) {
 	r.CurrentValue = (*Max350Text)(&value)
 }
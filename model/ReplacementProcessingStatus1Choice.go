@@ -10,6 +10,10 @@ type ReplacementProcessingStatus1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *ReplacementProcessingStatus1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReplacementProcessingStatus1Choice) SetCode(value string) {
 	r.Code = (*ReplacementProcessingStatus1Code)(&value)
 }
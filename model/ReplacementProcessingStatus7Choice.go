@@ -37,6 +37,10 @@ type ReplacementProcessingStatus7Choice struct {
 	ProprietaryStatus *ProprietaryStatusAndReason1 `xml:"PrtrySts,omitempty"`
 }
 
+func (r *ReplacementProcessingStatus7Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReplacementProcessingStatus7Choice) AddAccepted() *ProprietaryReason1 {
 	r.Accepted = new(ProprietaryReason1)
 	return r.Accepted
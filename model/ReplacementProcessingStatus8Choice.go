@@ -10,6 +10,10 @@ type ReplacementProcessingStatus8Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *ReplacementProcessingStatus8Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReplacementProcessingStatus8Choice) SetCode(value string) {
 	r.Code = (*ReplacementProcessingStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ReplacementProcessingStatus9Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *ReplacementProcessingStatus9Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReplacementProcessingStatus9Choice) SetCode(value string) {
 	r.Code = (*ReplacementProcessingStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepoCallRequestStatus10Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RepoCallRequestStatus10Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepoCallRequestStatus10Choice) SetCode(value string) {
 	r.Code = (*RepoCallRequestStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepoCallRequestStatus1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RepoCallRequestStatus1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepoCallRequestStatus1Choice) SetCode(value string) {
 	r.Code = (*RepoCallRequestStatus1Code)(&value)
 }
@@ -13,6 +13,10 @@ type RepoCallRequestStatus2Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (r *RepoCallRequestStatus2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepoCallRequestStatus2Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus2Choice {
 	r.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus2Choice)
 	return r.AcknowledgedAccepted
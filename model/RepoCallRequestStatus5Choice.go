@@ -13,6 +13,10 @@ type RepoCallRequestStatus5Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (r *RepoCallRequestStatus5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepoCallRequestStatus5Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus10Choice {
 	r.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus10Choice)
 	return r.AcknowledgedAccepted
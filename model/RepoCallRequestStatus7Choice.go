@@ -13,6 +13,10 @@ type RepoCallRequestStatus7Choice struct {
 	Proprietary *ProprietaryStatusAndReason6 `xml:"Prtry"`
 }
 
+func (r *RepoCallRequestStatus7Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepoCallRequestStatus7Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus22Choice {
 	r.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus22Choice)
 	return r.AcknowledgedAccepted
@@ -10,6 +10,10 @@ type RepoCallRequestStatus8Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RepoCallRequestStatus8Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepoCallRequestStatus8Choice) SetCode(value string) {
 	r.Code = (*RepoCallRequestStatus1Code)(&value)
 }
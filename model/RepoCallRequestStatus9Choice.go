@@ -13,6 +13,10 @@ type RepoCallRequestStatus9Choice struct {
 	Proprietary *ProprietaryStatusAndReason7 `xml:"Prtry"`
 }
 
+func (r *RepoCallRequestStatus9Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepoCallRequestStatus9Choice) AddAcknowledgedAccepted() *AcknowledgedAcceptedStatus27Choice {
 	r.AcknowledgedAccepted = new(AcknowledgedAcceptedStatus27Choice)
 	return r.AcknowledgedAccepted
@@ -25,6 +25,10 @@ type Report3 struct {
 	NoticeType *GenericIdentification38 `xml:"NtceTp,omitempty"`
 }
 
+func (r *Report3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Report3) SetReportNumber(value string) {
 	r.ReportNumber = (*Max5NumericText)(&value)
 }
@@ -43,6 +43,10 @@ type Report4 struct {
 	ActivityIndicator *YesNoIndicator `xml:"ActvtyInd,omitempty"`
 }
 
+func (r *Report4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Report4) SetReportNumber(value string) {
 	r.ReportNumber = (*Max5NumericText)(&value)
 }
@@ -99,6 +103,16 @@ func (r *Report4) SetAuditedIndicator(value string) {
 	r.AuditedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *Report4) SetAuditedIndicatorFromBool(value bool) {
+	r.AuditedIndicator = new(YesNoIndicator)
+	r.AuditedIndicator.FromBool(value)
+}
+
 func (r *Report4) SetActivityIndicator(value string) {
 	r.ActivityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (r *Report4) SetActivityIndicatorFromBool(value bool) {
+	r.ActivityIndicator = new(YesNoIndicator)
+	r.ActivityIndicator.FromBool(value)
+}
@@ -10,6 +10,10 @@ type Report5 struct {
 	SettlementObligationDetails []*SettlementObligation8 `xml:"SttlmOblgtnDtls"`
 }
 
+func (r *Report5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Report5) AddNonClearingMember() *PartyIdentificationAndAccount31 {
 	newValue := new(PartyIdentificationAndAccount31)
 	r.NonClearingMember = append(r.NonClearingMember, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Numerical representation of the nett increases and decreases in an account at a specific point in time. A cash balance is calculated from a sum of cash credits minus a sum of cash debits.
 type ReportData4 struct {
 
@@ -22,6 +26,10 @@ type ReportData4 struct {
 	SettlementSessionIdentifier *Exact4AlphaNumericText `xml:"SttlmSsnIdr,omitempty"`
 }
 
+func (r *ReportData4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportData4) SetMessageIdentification(value string) {
 	r.MessageIdentification = (*Max35Text)(&value)
 }
@@ -30,10 +38,20 @@ func (r *ReportData4) SetValueDate(value string) {
 	r.ValueDate = (*ISODate)(&value)
 }
 
+func (r *ReportData4) SetValueDateFromTime(value time.Time) {
+	r.ValueDate = new(ISODate)
+	r.ValueDate.FromTime(value)
+}
+
 func (r *ReportData4) SetDateAndTimeStamp(value string) {
 	r.DateAndTimeStamp = (*ISODateTime)(&value)
 }
 
+func (r *ReportData4) SetDateAndTimeStampFromTime(value time.Time) {
+	r.DateAndTimeStamp = new(ISODateTime)
+	r.DateAndTimeStamp.FromTime(value)
+}
+
 func (r *ReportData4) SetType(value string) {
 	r.Type = (*Entry2Code)(&value)
 }
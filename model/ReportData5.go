@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Numerical representation of the nett increases and decreases in an account at a specific point in time. A cash balance is calculated from a sum of cash credits minus a sum of cash debits.
 type ReportData5 struct {
 
@@ -25,6 +29,10 @@ type ReportData5 struct {
 	AccountValue *Value `xml:"AcctVal,omitempty"`
 }
 
+func (r *ReportData5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportData5) SetMessageIdentification(value string) {
 	r.MessageIdentification = (*Max35Text)(&value)
 }
@@ -33,10 +41,20 @@ func (r *ReportData5) SetValueDate(value string) {
 	r.ValueDate = (*ISODate)(&value)
 }
 
+func (r *ReportData5) SetValueDateFromTime(value time.Time) {
+	r.ValueDate = new(ISODate)
+	r.ValueDate.FromTime(value)
+}
+
 func (r *ReportData5) SetDateAndTimeStamp(value string) {
 	r.DateAndTimeStamp = (*ISODateTime)(&value)
 }
 
+func (r *ReportData5) SetDateAndTimeStampFromTime(value time.Time) {
+	r.DateAndTimeStamp = new(ISODateTime)
+	r.DateAndTimeStamp.FromTime(value)
+}
+
 func (r *ReportData5) SetType(value string) {
 	r.Type = (*CallIn1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the elements of an entry in the report.
 type ReportEntry1 struct {
 
@@ -75,20 +79,41 @@ type ReportEntry1 struct {
 	AdditionalEntryInformation *Max500Text `xml:"AddtlNtryInf,omitempty"`
 }
 
+func (r *ReportEntry1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportEntry1) SetAmount(value, currency string) {
 	r.Amount = NewCurrencyAndAmount(value, currency)
 }
 
-func (r *ReportEntry1) SetCreditDebitIndicator(value string) {
+func (r *ReportEntry1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
+func (r *ReportEntry1) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	r.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (r *ReportEntry1) SetReversalIndicator(value string) {
 	r.ReversalIndicator = (*TrueFalseIndicator)(&value)
 }
 
-func (r *ReportEntry1) SetStatus(value string) {
+func (r *ReportEntry1) SetReversalIndicatorFromBool(value bool) {
+	r.ReversalIndicator = new(TrueFalseIndicator)
+	r.ReversalIndicator.FromBool(value)
+}
+
+func (r *ReportEntry1) SetStatus(value string) error {
+	if err := EntryStatus2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.Status = (*EntryStatus2Code)(&value)
+	return nil
 }
 
 func (r *ReportEntry1) AddBookingDate() *DateAndDateTimeChoice {
@@ -260,6 +285,11 @@ func (r *ReportEntry1) SetCommissionWaiverIndicator(value string) {
 	r.CommissionWaiverIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *ReportEntry1) SetCommissionWaiverIndicatorFromBool(value bool) {
+	r.CommissionWaiverIndicator = new(YesNoIndicator)
+	r.CommissionWaiverIndicator.FromBool(value)
+}
+
 func (r *ReportEntry1) AddAdditionalInformationIndicator() *MessageIdentification2 {
 	r.AdditionalInformationIndicator = new(MessageIdentification2)
 	return r.AdditionalInformationIndicator
@@ -0,0 +1,394 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Provides further details on an entry in the report.
+type ReportEntry10 struct {
+
+	// Unique reference for the entry.
+	EntryReference *Max35Text `xml:"NtryRef,omitempty"`
+
+	// Amount of money in the cash entry.
+	Amount *ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+
+	// Indicates whether the entry is a credit or a debit entry.
+	CreditDebitIndicator *CreditDebitCode `xml:"CdtDbtInd"`
+
+	// Indicates whether or not the entry is the result of a reversal.
+	// Usage: This element should only be present if the entry is the result of a reversal.
+	// If the CreditDebitIndicator is CRDT and ReversalIndicator is Yes, the original operation was a debit entry.
+	// If the CreditDebitIndicator is DBIT and ReversalIndicator is Yes, the original operation was a credit entry.
+	ReversalIndicator *TrueFalseIndicator `xml:"RvslInd,omitempty"`
+
+	// Status of an entry on the books of the account servicer.
+	Status *EntryStatus1Choice `xml:"Sts"`
+
+	// Date and time when an entry is posted to an account on the account servicer's books.
+	//
+	// Usage: Booking date is the expected booking date, unless the status is booked, in which case it is the actual booking date.
+	BookingDate *DateAndDateTime2Choice `xml:"BookgDt,omitempty"`
+
+	// Date and time at which assets become available to the account owner in case of a credit entry, or cease to be available to the account owner in case of a debit entry.
+	// Usage: If entry status is pending and value date is present, then the value date refers to an expected/requested value date.
+	// For entries subject to availability/float and for which availability information is provided, the value date must not be used. In this case the availability component identifies the number of availability days.
+	ValueDate *DateAndDateTime2Choice `xml:"ValDt,omitempty"`
+
+	// Unique reference as assigned by the account servicing institution to unambiguously identify the entry.
+	AccountServicerReference *Max35Text `xml:"AcctSvcrRef,omitempty"`
+
+	// Indicates when the booked amount of money will become available, that is can be accessed and starts generating interest.
+	//
+	// Usage: This type of information is used in the US and is linked to particular instruments such as cheques.
+	// Example: When a cheque is deposited, it will be booked on the deposit day, but the amount of money will only be accessible as of the indicated availability day (according to national banking regulations).
+	Availability []*CashAvailability1 `xml:"Avlbty,omitempty"`
+
+	// Set of elements used to fully identify the type of underlying transaction resulting in an entry.
+	BankTransactionCode *BankTransactionCodeStructure4 `xml:"BkTxCd"`
+
+	// Indicates whether the transaction is exempt from commission.
+	CommissionWaiverIndicator *YesNoIndicator `xml:"ComssnWvrInd,omitempty"`
+
+	// Indicates whether the underlying transaction details are provided through a separate message, as in the case of aggregate bookings.
+	AdditionalInformationIndicator *MessageIdentification2 `xml:"AddtlInfInd,omitempty"`
+
+	// Provides information on the original amount.
+	//
+	// Usage: This component (on entry level) should be used when a total original batch or aggregate amount has to be provided. If required, the individual original amounts can be included in the same component on transaction details level.
+	AmountDetails *AmountAndCurrencyExchange3 `xml:"AmtDtls,omitempty"`
+
+	// Provides information on the charges, pre-advised or included in the entry amount .
+	//
+	// Usage: This component is used on entry level in case of batch or aggregate bookings.
+	//
+	Charges *Charges6 `xml:"Chrgs,omitempty"`
+
+	// Channel used to technically input the instruction related to the entry.
+	TechnicalInputChannel *TechnicalInputChannel1Choice `xml:"TechInptChanl,omitempty"`
+
+	// Provides details of the interest amount included in the entry amount.
+	//
+	// Usage: This component is used on entry level in the case of batch or aggregate bookings.
+	Interest *TransactionInterest4 `xml:"Intrst,omitempty"`
+
+	// Provides details of the card transaction included in the entry amount, when globalised by the account servicer .
+	CardTransaction *CardEntry4 `xml:"CardTx,omitempty"`
+
+	// Provides details on the entry.
+	EntryDetails []*EntryDetails9 `xml:"NtryDtls,omitempty"`
+
+	// Further details of the entry.
+	AdditionalEntryInformation *Max500Text `xml:"AddtlNtryInf,omitempty"`
+}
+
+func (r *ReportEntry10) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *ReportEntry10) SetEntryReference(value string) {
+	r.EntryReference = (*Max35Text)(&value)
+}
+
+func (r *ReportEntry10) SetAmount(value, currency string) {
+	r.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (r *ReportEntry10) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (r *ReportEntry10) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
+	r.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
+}
+
+func (r *ReportEntry10) SetReversalIndicator(value string) {
+	r.ReversalIndicator = (*TrueFalseIndicator)(&value)
+}
+
+func (r *ReportEntry10) SetReversalIndicatorFromBool(value bool) {
+	r.ReversalIndicator = new(TrueFalseIndicator)
+	r.ReversalIndicator.FromBool(value)
+}
+
+func (r *ReportEntry10) AddStatus() *EntryStatus1Choice {
+	r.Status = new(EntryStatus1Choice)
+	return r.Status
+}
+
+func (r *ReportEntry10) AddBookingDate() *DateAndDateTime2Choice {
+	r.BookingDate = new(DateAndDateTime2Choice)
+	return r.BookingDate
+}
+
+func (r *ReportEntry10) AddValueDate() *DateAndDateTime2Choice {
+	r.ValueDate = new(DateAndDateTime2Choice)
+	return r.ValueDate
+}
+
+func (r *ReportEntry10) SetAccountServicerReference(value string) {
+	r.AccountServicerReference = (*Max35Text)(&value)
+}
+
+func (r *ReportEntry10) AddAvailability() *CashAvailability1 {
+	newValue := new(CashAvailability1)
+	r.Availability = append(r.Availability, newValue)
+	return newValue
+}
+
+func (r *ReportEntry10) AddBankTransactionCode() *BankTransactionCodeStructure4 {
+	r.BankTransactionCode = new(BankTransactionCodeStructure4)
+	return r.BankTransactionCode
+}
+
+func (r *ReportEntry10) SetCommissionWaiverIndicator(value string) {
+	r.CommissionWaiverIndicator = (*YesNoIndicator)(&value)
+}
+
+func (r *ReportEntry10) SetCommissionWaiverIndicatorFromBool(value bool) {
+	r.CommissionWaiverIndicator = new(YesNoIndicator)
+	r.CommissionWaiverIndicator.FromBool(value)
+}
+
+func (r *ReportEntry10) AddAdditionalInformationIndicator() *MessageIdentification2 {
+	r.AdditionalInformationIndicator = new(MessageIdentification2)
+	return r.AdditionalInformationIndicator
+}
+
+func (r *ReportEntry10) AddAmountDetails() *AmountAndCurrencyExchange3 {
+	r.AmountDetails = new(AmountAndCurrencyExchange3)
+	return r.AmountDetails
+}
+
+func (r *ReportEntry10) AddCharges() *Charges6 {
+	r.Charges = new(Charges6)
+	return r.Charges
+}
+
+func (r *ReportEntry10) AddTechnicalInputChannel() *TechnicalInputChannel1Choice {
+	r.TechnicalInputChannel = new(TechnicalInputChannel1Choice)
+	return r.TechnicalInputChannel
+}
+
+func (r *ReportEntry10) AddInterest() *TransactionInterest4 {
+	r.Interest = new(TransactionInterest4)
+	return r.Interest
+}
+
+func (r *ReportEntry10) AddCardTransaction() *CardEntry4 {
+	r.CardTransaction = new(CardEntry4)
+	return r.CardTransaction
+}
+
+func (r *ReportEntry10) AddEntryDetails() *EntryDetails9 {
+	newValue := new(EntryDetails9)
+	r.EntryDetails = append(r.EntryDetails, newValue)
+	return newValue
+}
+
+func (r *ReportEntry10) SetAdditionalEntryInformation(value string) {
+	r.AdditionalEntryInformation = (*Max500Text)(&value)
+}
+
+func (r *ReportEntry10) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.EntryReference != nil {
+		enc.Element("NtryRef", string(*r.EntryReference))
+	}
+	if r.Amount != nil {
+		r.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if r.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*r.CreditDebitIndicator))
+	}
+	if r.ReversalIndicator != nil {
+		enc.Element("RvslInd", string(*r.ReversalIndicator))
+	}
+	if r.Status != nil {
+		r.Status.EncodeXMLFast(enc, "Sts")
+	}
+	if r.BookingDate != nil {
+		r.BookingDate.EncodeXMLFast(enc, "BookgDt")
+	}
+	if r.ValueDate != nil {
+		r.ValueDate.EncodeXMLFast(enc, "ValDt")
+	}
+	if r.AccountServicerReference != nil {
+		enc.Element("AcctSvcrRef", string(*r.AccountServicerReference))
+	}
+	for _, elem := range r.Availability {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Avlbty")
+		}
+	}
+	if r.BankTransactionCode != nil {
+		r.BankTransactionCode.EncodeXMLFast(enc, "BkTxCd")
+	}
+	if r.CommissionWaiverIndicator != nil {
+		enc.Element("ComssnWvrInd", string(*r.CommissionWaiverIndicator))
+	}
+	if r.AdditionalInformationIndicator != nil {
+		r.AdditionalInformationIndicator.EncodeXMLFast(enc, "AddtlInfInd")
+	}
+	if r.AmountDetails != nil {
+		r.AmountDetails.EncodeXMLFast(enc, "AmtDtls")
+	}
+	if r.Charges != nil {
+		r.Charges.EncodeXMLFast(enc, "Chrgs")
+	}
+	if r.TechnicalInputChannel != nil {
+		r.TechnicalInputChannel.EncodeXMLFast(enc, "TechInptChanl")
+	}
+	if r.Interest != nil {
+		r.Interest.EncodeXMLFast(enc, "Intrst")
+	}
+	if r.CardTransaction != nil {
+		r.CardTransaction.EncodeXMLFast(enc, "CardTx")
+	}
+	for _, elem := range r.EntryDetails {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "NtryDtls")
+		}
+	}
+	if r.AdditionalEntryInformation != nil {
+		enc.Element("AddtlNtryInf", string(*r.AdditionalEntryInformation))
+	}
+	enc.End(tag)
+}
+
+func (r *ReportEntry10) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "NtryRef":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.EntryReference = (*Max35Text)(&value)
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Amount = elem
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		case "RvslInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.ReversalIndicator = (*TrueFalseIndicator)(&value)
+		case "Sts":
+			elem := new(EntryStatus1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Status = elem
+		case "BookgDt":
+			elem := new(DateAndDateTime2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.BookingDate = elem
+		case "ValDt":
+			elem := new(DateAndDateTime2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.ValueDate = elem
+		case "AcctSvcrRef":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.AccountServicerReference = (*Max35Text)(&value)
+		case "Avlbty":
+			elem := new(CashAvailability1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Availability = append(r.Availability, elem)
+		case "BkTxCd":
+			elem := new(BankTransactionCodeStructure4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.BankTransactionCode = elem
+		case "ComssnWvrInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.CommissionWaiverIndicator = (*YesNoIndicator)(&value)
+		case "AddtlInfInd":
+			elem := new(MessageIdentification2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.AdditionalInformationIndicator = elem
+		case "AmtDtls":
+			elem := new(AmountAndCurrencyExchange3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.AmountDetails = elem
+		case "Chrgs":
+			elem := new(Charges6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Charges = elem
+		case "TechInptChanl":
+			elem := new(TechnicalInputChannel1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.TechnicalInputChannel = elem
+		case "Intrst":
+			elem := new(TransactionInterest4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Interest = elem
+		case "CardTx":
+			elem := new(CardEntry4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.CardTransaction = elem
+		case "NtryDtls":
+			elem := new(EntryDetails9)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.EntryDetails = append(r.EntryDetails, elem)
+		case "AddtlNtryInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.AdditionalEntryInformation = (*Max500Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on an entry in the report.
 type ReportEntry2 struct {
 
@@ -74,6 +78,10 @@ type ReportEntry2 struct {
 	AdditionalEntryInformation *Max500Text `xml:"AddtlNtryInf,omitempty"`
 }
 
+func (r *ReportEntry2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportEntry2) SetEntryReference(value string) {
 	r.EntryReference = (*Max35Text)(&value)
 }
@@ -82,16 +90,33 @@ func (r *ReportEntry2) SetAmount(value, currency string) {
 	r.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (r *ReportEntry2) SetCreditDebitIndicator(value string) {
+func (r *ReportEntry2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (r *ReportEntry2) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	r.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (r *ReportEntry2) SetReversalIndicator(value string) {
 	r.ReversalIndicator = (*TrueFalseIndicator)(&value)
 }
 
-func (r *ReportEntry2) SetStatus(value string) {
+func (r *ReportEntry2) SetReversalIndicatorFromBool(value bool) {
+	r.ReversalIndicator = new(TrueFalseIndicator)
+	r.ReversalIndicator.FromBool(value)
+}
+
+func (r *ReportEntry2) SetStatus(value string) error {
+	if err := EntryStatus2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.Status = (*EntryStatus2Code)(&value)
+	return nil
 }
 
 func (r *ReportEntry2) AddBookingDate() *DateAndDateTimeChoice {
@@ -263,6 +288,11 @@ func (r *ReportEntry2) SetCommissionWaiverIndicator(value string) {
 	r.CommissionWaiverIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *ReportEntry2) SetCommissionWaiverIndicatorFromBool(value bool) {
+	r.CommissionWaiverIndicator = new(YesNoIndicator)
+	r.CommissionWaiverIndicator.FromBool(value)
+}
+
 func (r *ReportEntry2) AddAdditionalInformationIndicator() *MessageIdentification2 {
 	r.AdditionalInformationIndicator = new(MessageIdentification2)
 	return r.AdditionalInformationIndicator
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details on an entry in the report.
 type ReportEntry3 struct {
 
@@ -77,6 +81,10 @@ type ReportEntry3 struct {
 	AdditionalEntryInformation *Max500Text `xml:"AddtlNtryInf,omitempty"`
 }
 
+func (r *ReportEntry3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportEntry3) SetEntryReference(value string) {
 	r.EntryReference = (*Max35Text)(&value)
 }
@@ -85,16 +93,33 @@ func (r *ReportEntry3) SetAmount(value, currency string) {
 	r.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (r *ReportEntry3) SetCreditDebitIndicator(value string) {
+func (r *ReportEntry3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (r *ReportEntry3) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	r.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (r *ReportEntry3) SetReversalIndicator(value string) {
 	r.ReversalIndicator = (*TrueFalseIndicator)(&value)
 }
 
-func (r *ReportEntry3) SetStatus(value string) {
+func (r *ReportEntry3) SetReversalIndicatorFromBool(value bool) {
+	r.ReversalIndicator = new(TrueFalseIndicator)
+	r.ReversalIndicator.FromBool(value)
+}
+
+func (r *ReportEntry3) SetStatus(value string) error {
+	if err := EntryStatus2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.Status = (*EntryStatus2Code)(&value)
+	return nil
 }
 
 func (r *ReportEntry3) AddBookingDate() *DateAndDateTimeChoice {
@@ -266,6 +291,11 @@ func (r *ReportEntry3) SetCommissionWaiverIndicator(value string) {
 	r.CommissionWaiverIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *ReportEntry3) SetCommissionWaiverIndicatorFromBool(value bool) {
+	r.CommissionWaiverIndicator = new(YesNoIndicator)
+	r.CommissionWaiverIndicator.FromBool(value)
+}
+
 func (r *ReportEntry3) AddAdditionalInformationIndicator() *MessageIdentification2 {
 	r.AdditionalInformationIndicator = new(MessageIdentification2)
 	return r.AdditionalInformationIndicator
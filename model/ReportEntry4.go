@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details on an entry in the report.
 type ReportEntry4 struct {
 
@@ -78,6 +82,10 @@ type ReportEntry4 struct {
 	AdditionalEntryInformation *Max500Text `xml:"AddtlNtryInf,omitempty"`
 }
 
+func (r *ReportEntry4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportEntry4) SetEntryReference(value string) {
 	r.EntryReference = (*Max35Text)(&value)
 }
@@ -86,16 +94,33 @@ func (r *ReportEntry4) SetAmount(value, currency string) {
 	r.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (r *ReportEntry4) SetCreditDebitIndicator(value string) {
+func (r *ReportEntry4) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (r *ReportEntry4) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	r.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (r *ReportEntry4) SetReversalIndicator(value string) {
 	r.ReversalIndicator = (*TrueFalseIndicator)(&value)
 }
 
-func (r *ReportEntry4) SetStatus(value string) {
+func (r *ReportEntry4) SetReversalIndicatorFromBool(value bool) {
+	r.ReversalIndicator = new(TrueFalseIndicator)
+	r.ReversalIndicator.FromBool(value)
+}
+
+func (r *ReportEntry4) SetStatus(value string) error {
+	if err := EntryStatus2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.Status = (*EntryStatus2Code)(&value)
+	return nil
 }
 
 func (r *ReportEntry4) AddBookingDate() *DateAndDateTimeChoice {
@@ -267,6 +292,11 @@ func (r *ReportEntry4) SetCommissionWaiverIndicator(value string) {
 	r.CommissionWaiverIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *ReportEntry4) SetCommissionWaiverIndicatorFromBool(value bool) {
+	r.CommissionWaiverIndicator = new(YesNoIndicator)
+	r.CommissionWaiverIndicator.FromBool(value)
+}
+
 func (r *ReportEntry4) AddAdditionalInformationIndicator() *MessageIdentification2 {
 	r.AdditionalInformationIndicator = new(MessageIdentification2)
 	return r.AdditionalInformationIndicator
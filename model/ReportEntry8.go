@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides further details on an entry in the report.
 type ReportEntry8 struct {
 
@@ -78,6 +83,10 @@ type ReportEntry8 struct {
 	AdditionalEntryInformation *Max500Text `xml:"AddtlNtryInf,omitempty"`
 }
 
+func (r *ReportEntry8) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportEntry8) SetEntryReference(value string) {
 	r.EntryReference = (*Max35Text)(&value)
 }
@@ -86,16 +95,33 @@ func (r *ReportEntry8) SetAmount(value, currency string) {
 	r.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (r *ReportEntry8) SetCreditDebitIndicator(value string) {
+func (r *ReportEntry8) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (r *ReportEntry8) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	r.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (r *ReportEntry8) SetReversalIndicator(value string) {
 	r.ReversalIndicator = (*TrueFalseIndicator)(&value)
 }
 
-func (r *ReportEntry8) SetStatus(value string) {
+func (r *ReportEntry8) SetReversalIndicatorFromBool(value bool) {
+	r.ReversalIndicator = new(TrueFalseIndicator)
+	r.ReversalIndicator.FromBool(value)
+}
+
+func (r *ReportEntry8) SetStatus(value string) error {
+	if err := EntryStatus2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.Status = (*EntryStatus2Code)(&value)
+	return nil
 }
 
 func (r *ReportEntry8) AddBookingDate() *DateAndDateTimeChoice {
@@ -267,6 +293,11 @@ func (r *ReportEntry8) SetCommissionWaiverIndicator(value string) {
 	r.CommissionWaiverIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *ReportEntry8) SetCommissionWaiverIndicatorFromBool(value bool) {
+	r.CommissionWaiverIndicator = new(YesNoIndicator)
+	r.CommissionWaiverIndicator.FromBool(value)
+}
+
 func (r *ReportEntry8) AddAdditionalInformationIndicator() *MessageIdentification2 {
 	r.AdditionalInformationIndicator = new(MessageIdentification2)
 	return r.AdditionalInformationIndicator
@@ -446,3 +477,201 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (r *ReportEntry8) SetAdditionalEntryInformation(value string) {
 	r.AdditionalEntryInformation = (*Max500Text)(&value)
 }
+
+func (r *ReportEntry8) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.EntryReference != nil {
+		enc.Element("NtryRef", string(*r.EntryReference))
+	}
+	if r.Amount != nil {
+		r.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	if r.CreditDebitIndicator != nil {
+		enc.Element("CdtDbtInd", string(*r.CreditDebitIndicator))
+	}
+	if r.ReversalIndicator != nil {
+		enc.Element("RvslInd", string(*r.ReversalIndicator))
+	}
+	if r.Status != nil {
+		enc.Element("Sts", string(*r.Status))
+	}
+	if r.BookingDate != nil {
+		r.BookingDate.EncodeXMLFast(enc, "BookgDt")
+	}
+	if r.ValueDate != nil {
+		r.ValueDate.EncodeXMLFast(enc, "ValDt")
+	}
+	if r.AccountServicerReference != nil {
+		enc.Element("AcctSvcrRef", string(*r.AccountServicerReference))
+	}
+	for _, elem := range r.Availability {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Avlbty")
+		}
+	}
+	if r.BankTransactionCode != nil {
+		r.BankTransactionCode.EncodeXMLFast(enc, "BkTxCd")
+	}
+	if r.CommissionWaiverIndicator != nil {
+		enc.Element("ComssnWvrInd", string(*r.CommissionWaiverIndicator))
+	}
+	if r.AdditionalInformationIndicator != nil {
+		r.AdditionalInformationIndicator.EncodeXMLFast(enc, "AddtlInfInd")
+	}
+	if r.AmountDetails != nil {
+		r.AmountDetails.EncodeXMLFast(enc, "AmtDtls")
+	}
+	if r.Charges != nil {
+		r.Charges.EncodeXMLFast(enc, "Chrgs")
+	}
+	if r.TechnicalInputChannel != nil {
+		r.TechnicalInputChannel.EncodeXMLFast(enc, "TechInptChanl")
+	}
+	if r.Interest != nil {
+		r.Interest.EncodeXMLFast(enc, "Intrst")
+	}
+	if r.CardTransaction != nil {
+		r.CardTransaction.EncodeXMLFast(enc, "CardTx")
+	}
+	for _, elem := range r.EntryDetails {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "NtryDtls")
+		}
+	}
+	if r.AdditionalEntryInformation != nil {
+		enc.Element("AddtlNtryInf", string(*r.AdditionalEntryInformation))
+	}
+	enc.End(tag)
+}
+
+func (r *ReportEntry8) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "NtryRef":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.EntryReference = (*Max35Text)(&value)
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Amount = elem
+		case "CdtDbtInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.CreditDebitIndicator = (*CreditDebitCode)(&value)
+		case "RvslInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.ReversalIndicator = (*TrueFalseIndicator)(&value)
+		case "Sts":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Status = (*EntryStatus2Code)(&value)
+		case "BookgDt":
+			elem := new(DateAndDateTimeChoice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.BookingDate = elem
+		case "ValDt":
+			elem := new(DateAndDateTimeChoice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.ValueDate = elem
+		case "AcctSvcrRef":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.AccountServicerReference = (*Max35Text)(&value)
+		case "Avlbty":
+			elem := new(CashAvailability1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Availability = append(r.Availability, elem)
+		case "BkTxCd":
+			elem := new(BankTransactionCodeStructure4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.BankTransactionCode = elem
+		case "ComssnWvrInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.CommissionWaiverIndicator = (*YesNoIndicator)(&value)
+		case "AddtlInfInd":
+			elem := new(MessageIdentification2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.AdditionalInformationIndicator = elem
+		case "AmtDtls":
+			elem := new(AmountAndCurrencyExchange3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.AmountDetails = elem
+		case "Chrgs":
+			elem := new(Charges4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Charges = elem
+		case "TechInptChanl":
+			elem := new(TechnicalInputChannel1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.TechnicalInputChannel = elem
+		case "Intrst":
+			elem := new(TransactionInterest3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.Interest = elem
+		case "CardTx":
+			elem := new(CardEntry2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.CardTransaction = elem
+		case "NtryDtls":
+			elem := new(EntryDetails7)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			r.EntryDetails = append(r.EntryDetails, elem)
+		case "AddtlNtryInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.AdditionalEntryInformation = (*Max500Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
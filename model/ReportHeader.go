@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies generic information about an investigation report.
 type ReportHeader struct {
 
@@ -16,6 +20,10 @@ type ReportHeader struct {
 	CreationDateTime *ISODateTime `xml:"CreDtTm"`
 }
 
+func (r *ReportHeader) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportHeader) SetIdentification(value string) {
 	r.Identification = (*Max35Text)(&value)
 }
@@ -31,3 +39,8 @@ func (r *ReportHeader) SetTo(value string) {
 func (r *ReportHeader) SetCreationDateTime(value string) {
 	r.CreationDateTime = (*ISODateTime)(&value)
 }
+
+func (r *ReportHeader) SetCreationDateTimeFromTime(value time.Time) {
+	r.CreationDateTime = new(ISODateTime)
+	r.CreationDateTime.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies generic information about an investigation report.
 type ReportHeader2 struct {
 
@@ -16,6 +20,10 @@ type ReportHeader2 struct {
 	CreationDateTime *ISODateTime `xml:"CreDtTm"`
 }
 
+func (r *ReportHeader2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportHeader2) SetIdentification(value string) {
 	r.Identification = (*Max35Text)(&value)
 }
@@ -33,3 +41,8 @@ func (r *ReportHeader2) AddTo() *Party7Choice {
 func (r *ReportHeader2) SetCreationDateTime(value string) {
 	r.CreationDateTime = (*ISODateTime)(&value)
 }
+
+func (r *ReportHeader2) SetCreationDateTimeFromTime(value time.Time) {
+	r.CreationDateTime = new(ISODateTime)
+	r.CreationDateTime.FromTime(value)
+}
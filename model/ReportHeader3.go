@@ -12,6 +12,10 @@ type ReportHeader3 struct {
 	MessagePagination *Pagination `xml:"MsgPgntn,omitempty"`
 }
 
+func (r *ReportHeader3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportHeader3) SetReportIdentification(value string) {
 	r.ReportIdentification = (*Max35Text)(&value)
 }
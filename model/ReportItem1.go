@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Account details of the report item.
 type ReportItem1 struct {
 
@@ -16,6 +20,10 @@ type ReportItem1 struct {
 	ItemDate *ISODate `xml:"ItmDt,omitempty"`
 }
 
+func (r *ReportItem1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportItem1) AddAccountIdentification() *SecuritiesAccount19 {
 	r.AccountIdentification = new(SecuritiesAccount19)
 	return r.AccountIdentification
@@ -33,3 +41,8 @@ func (r *ReportItem1) AddFinancialInstrumentIdentification() *SecurityIdentifica
 func (r *ReportItem1) SetItemDate(value string) {
 	r.ItemDate = (*ISODate)(&value)
 }
+
+func (r *ReportItem1) SetItemDateFromTime(value time.Time) {
+	r.ItemDate = new(ISODate)
+	r.ItemDate.FromTime(value)
+}
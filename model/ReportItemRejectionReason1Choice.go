@@ -10,6 +10,10 @@ type ReportItemRejectionReason1Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *ReportItemRejectionReason1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportItemRejectionReason1Choice) SetCode(value string) {
 	r.Code = (*HoldingRejectionReason41Code)(&value)
 }
@@ -13,6 +13,10 @@ type ReportItemStatus1 struct {
 	ReportItem []*ReportItem1 `xml:"RptItm,omitempty"`
 }
 
+func (r *ReportItemStatus1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportItemStatus1) AddException() *ReportItemRejectionReason1Choice {
 	r.Exception = new(ReportItemRejectionReason1Choice)
 	return r.Exception
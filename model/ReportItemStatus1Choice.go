@@ -13,8 +13,16 @@ type ReportItemStatus1Choice struct {
 	Rejected *ReportItemStatus1 `xml:"Rjctd"`
 }
 
-func (r *ReportItemStatus1Choice) SetAccepted(value string) {
+func (r *ReportItemStatus1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
+func (r *ReportItemStatus1Choice) SetAccepted(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.Accepted = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (r *ReportItemStatus1Choice) AddAcceptedWithException() *ReportItemStatus1 {
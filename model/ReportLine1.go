@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about a payment against a purchase order.
 type ReportLine1 struct {
 
@@ -19,6 +23,10 @@ type ReportLine1 struct {
 	AccumulatedNetAmount *CurrencyAndAmount `xml:"AcmltdNetAmt"`
 }
 
+func (r *ReportLine1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportLine1) SetTransactionIdentification(value string) {
 	r.TransactionIdentification = (*Max35Text)(&value)
 }
@@ -37,6 +45,14 @@ func (r *ReportLine1) SetPurchaseOrderTotalNetAmount(value, currency string) {
 	r.PurchaseOrderTotalNetAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (r *ReportLine1) SetPurchaseOrderTotalNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.PurchaseOrderTotalNetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReportLine1) SetAccumulatedNetAmount(value, currency string) {
 	r.AccumulatedNetAmount = NewCurrencyAndAmount(value, currency)
 }
+
+func (r *ReportLine1) SetAccumulatedNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.AccumulatedNetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about a payment against a commercial invoice.
 type ReportLine4 struct {
 
@@ -16,6 +20,10 @@ type ReportLine4 struct {
 	BreakdownByPurchaseOrder []*ReportLine2 `xml:"BrkdwnByPurchsOrdr"`
 }
 
+func (r *ReportLine4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportLine4) AddCommercialDocumentReference() *InvoiceIdentification1 {
 	r.CommercialDocumentReference = new(InvoiceIdentification1)
 	return r.CommercialDocumentReference
@@ -171,6 +179,10 @@ func (r *ReportLine4) SetNetAmount(value, currency string) {
 	r.NetAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (r *ReportLine4) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReportLine4) AddBreakdownByPurchaseOrder() *ReportLine2 {
 	newValue := new(ReportLine2)
 	r.BreakdownByPurchaseOrder = append(r.BreakdownByPurchaseOrder, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about a payment against a purchase order.
 type ReportLine5 struct {
 
@@ -13,6 +17,10 @@ type ReportLine5 struct {
 	NetAmount *CurrencyAndAmount `xml:"NetAmt"`
 }
 
+func (r *ReportLine5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportLine5) AddPurchaseOrderReference() *DocumentIdentification7 {
 	r.PurchaseOrderReference = new(DocumentIdentification7)
 	return r.PurchaseOrderReference
@@ -167,3 +175,7 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (r *ReportLine5) SetNetAmount(value, currency string) {
 	r.NetAmount = NewCurrencyAndAmount(value, currency)
 }
+
+func (r *ReportLine5) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about a payment against a commercial invoice.
 type ReportLine6 struct {
 
@@ -16,6 +20,10 @@ type ReportLine6 struct {
 	BreakdownByPurchaseOrder []*ReportLine7 `xml:"BrkdwnByPurchsOrdr"`
 }
 
+func (r *ReportLine6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportLine6) AddCommercialDocumentReference() *InvoiceIdentification1 {
 	r.CommercialDocumentReference = new(InvoiceIdentification1)
 	return r.CommercialDocumentReference
@@ -171,6 +179,10 @@ func (r *ReportLine6) SetNetAmount(value, currency string) {
 	r.NetAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (r *ReportLine6) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ReportLine6) AddBreakdownByPurchaseOrder() *ReportLine7 {
 	newValue := new(ReportLine7)
 	r.BreakdownByPurchaseOrder = append(r.BreakdownByPurchaseOrder, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about a payment against a purchase order.
 type ReportLine7 struct {
 
@@ -16,6 +20,10 @@ type ReportLine7 struct {
 	NetAmount *CurrencyAndAmount `xml:"NetAmt"`
 }
 
+func (r *ReportLine7) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportLine7) SetTransactionIdentification(value string) {
 	r.TransactionIdentification = (*Max35Text)(&value)
 }
@@ -174,3 +182,7 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (r *ReportLine7) SetNetAmount(value, currency string) {
 	r.NetAmount = NewCurrencyAndAmount(value, currency)
 }
+
+func (r *ReportLine7) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.NetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
@@ -10,6 +10,10 @@ type ReportParameter1 struct {
 	Value *Max350Text `xml:"Val"`
 }
 
+func (r *ReportParameter1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportParameter1) SetName(value string) {
 	r.Name = (*Max70Text)(&value)
 }
@@ -16,6 +16,10 @@ type ReportParameter2Choice struct {
 	UserDefined *DataFormat2Choice `xml:"UsrDfnd"`
 }
 
+func (r *ReportParameter2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportParameter2Choice) AddParty() *PartyIdentification2Choice {
 	r.Party = new(PartyIdentification2Choice)
 	return r.Party
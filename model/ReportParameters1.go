@@ -22,6 +22,10 @@ type ReportParameters1 struct {
 	ActivityIndicator *YesNoIndicator `xml:"ActvtyInd"`
 }
 
+func (r *ReportParameters1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportParameters1) SetNetPositionIdentification(value string) {
 	r.NetPositionIdentification = (*Max35Text)(&value)
 }
@@ -46,3 +50,8 @@ func (r *ReportParameters1) SetReportNumber(value string) {
 func (r *ReportParameters1) SetActivityIndicator(value string) {
 	r.ActivityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (r *ReportParameters1) SetActivityIndicatorFromBool(value bool) {
+	r.ActivityIndicator = new(YesNoIndicator)
+	r.ActivityIndicator.FromBool(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides the parameters of the report.
 type ReportParameters2 struct {
 
@@ -19,6 +23,10 @@ type ReportParameters2 struct {
 	CalculationDate *ISODateTime `xml:"ClctnDt,omitempty"`
 }
 
+func (r *ReportParameters2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportParameters2) SetReportIdentification(value string) {
 	r.ReportIdentification = (*Max35Text)(&value)
 }
@@ -39,3 +47,8 @@ func (r *ReportParameters2) SetReportCurrency(value string) {
 func (r *ReportParameters2) SetCalculationDate(value string) {
 	r.CalculationDate = (*ISODateTime)(&value)
 }
+
+func (r *ReportParameters2) SetCalculationDateFromTime(value time.Time) {
+	r.CalculationDate = new(ISODateTime)
+	r.CalculationDate.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides the parameters of the report.
 type ReportParameters3 struct {
 
@@ -22,6 +26,10 @@ type ReportParameters3 struct {
 	ReportNumber *Exact5NumericText `xml:"RptNb,omitempty"`
 }
 
+func (r *ReportParameters3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportParameters3) SetReportIdentification(value string) {
 	r.ReportIdentification = (*Max35Text)(&value)
 }
@@ -39,6 +47,11 @@ func (r *ReportParameters3) SetCalculationDateAndTime(value string) {
 	r.CalculationDateAndTime = (*ISODateTime)(&value)
 }
 
+func (r *ReportParameters3) SetCalculationDateAndTimeFromTime(value time.Time) {
+	r.CalculationDateAndTime = new(ISODateTime)
+	r.CalculationDateAndTime.FromTime(value)
+}
+
 func (r *ReportParameters3) SetFrequency(value string) {
 	r.Frequency = (*EventFrequency6Code)(&value)
 }
@@ -10,6 +10,10 @@ type ReportParameters4 struct {
 	ReportDateAndTime *DateAndDateTimeChoice `xml:"RptDtAndTm"`
 }
 
+func (r *ReportParameters4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportParameters4) SetReportIdentification(value string) {
 	r.ReportIdentification = (*Max35Text)(&value)
 }
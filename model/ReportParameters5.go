@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides the parameters of the report.
 type ReportParameters5 struct {
 
@@ -19,6 +23,10 @@ type ReportParameters5 struct {
 	CalculationDate *ISODateTime `xml:"ClctnDt,omitempty"`
 }
 
+func (r *ReportParameters5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportParameters5) SetReportIdentification(value string) {
 	r.ReportIdentification = (*Max35Text)(&value)
 }
@@ -39,3 +47,8 @@ func (r *ReportParameters5) SetReportCurrency(value string) {
 func (r *ReportParameters5) SetCalculationDate(value string) {
 	r.CalculationDate = (*ISODateTime)(&value)
 }
+
+func (r *ReportParameters5) SetCalculationDateFromTime(value time.Time) {
+	r.CalculationDate = new(ISODateTime)
+	r.CalculationDate.FromTime(value)
+}
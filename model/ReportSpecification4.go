@@ -43,6 +43,10 @@ type ReportSpecification4 struct {
 	PendingRequestForAction []*PendingActivity1 `xml:"PdgReqForActn,omitempty"`
 }
 
+func (r *ReportSpecification4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportSpecification4) AddTransactionIdentification(value string) {
 	r.TransactionIdentification = append(r.TransactionIdentification, (*Max35Text)(&value))
 }
@@ -13,6 +13,10 @@ type ReportStatusAndReason1 struct {
 	Rejected []*RejectedStatusReason9Choice `xml:"Rjctd"`
 }
 
+func (r *ReportStatusAndReason1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportStatusAndReason1) SetRelatedReference(value string) {
 	r.RelatedReference = (*Max35Text)(&value)
 }
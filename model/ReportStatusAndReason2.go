@@ -13,6 +13,10 @@ type ReportStatusAndReason2 struct {
 	Rejected []*RejectedCancellationStatusReason1Choice `xml:"Rjctd"`
 }
 
+func (r *ReportStatusAndReason2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportStatusAndReason2) SetRelatedReference(value string) {
 	r.RelatedReference = (*Max35Text)(&value)
 }
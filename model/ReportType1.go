@@ -7,6 +7,10 @@ type ReportType1 struct {
 	Type *ReportType1Code `xml:"Tp"`
 }
 
+func (r *ReportType1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportType1) SetType(value string) {
 	r.Type = (*ReportType1Code)(&value)
 }
@@ -7,6 +7,10 @@ type ReportType2 struct {
 	Type *ReportType2Code `xml:"Tp"`
 }
 
+func (r *ReportType2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportType2) SetType(value string) {
 	r.Type = (*ReportType2Code)(&value)
 }
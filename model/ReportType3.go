@@ -7,6 +7,10 @@ type ReportType3 struct {
 	Type *InstructionType3Code `xml:"Tp"`
 }
 
+func (r *ReportType3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportType3) SetType(value string) {
 	r.Type = (*InstructionType3Code)(&value)
 }
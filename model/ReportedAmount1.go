@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount reported.
 type ReportedAmount1 struct {
 
@@ -13,6 +17,10 @@ type ReportedAmount1 struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (r *ReportedAmount1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportedAmount1) SetIdentification(value string) {
 	r.Identification = (*Max35Text)(&value)
 }
@@ -24,3 +32,7 @@ func (r *ReportedAmount1) SetType(value string) {
 func (r *ReportedAmount1) SetAmount(value, currency string) {
 	r.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (r *ReportedAmount1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
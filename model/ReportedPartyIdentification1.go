@@ -10,6 +10,10 @@ type ReportedPartyIdentification1 struct {
 	Location *CountryCode `xml:"Lctn"`
 }
 
+func (r *ReportedPartyIdentification1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportedPartyIdentification1) AddNameOrSector() *NameOrSector1Choice {
 	r.NameOrSector = new(NameOrSector1Choice)
 	return r.NameOrSector
@@ -10,6 +10,10 @@ type Reporting1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *Reporting1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reporting1Choice) SetCode(value string) {
 	r.Code = (*Reporting1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Reporting2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *Reporting2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reporting2Choice) SetCode(value string) {
 	r.Code = (*Reporting2Code)(&value)
 }
@@ -10,6 +10,10 @@ type Reporting5Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (r *Reporting5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reporting5Choice) SetCode(value string) {
 	r.Code = (*Reporting2Code)(&value)
 }
@@ -10,6 +10,10 @@ type Reporting6Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *Reporting6Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reporting6Choice) SetCode(value string) {
 	r.Code = (*Reporting2Code)(&value)
 }
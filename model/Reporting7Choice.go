@@ -10,6 +10,10 @@ type Reporting7Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *Reporting7Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reporting7Choice) SetCode(value string) {
 	r.Code = (*Reporting1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Reporting8Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *Reporting8Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reporting8Choice) SetCode(value string) {
 	r.Code = (*Reporting1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Reporting9Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *Reporting9Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reporting9Choice) SetCode(value string) {
 	r.Code = (*Reporting2Code)(&value)
 }
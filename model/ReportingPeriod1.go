@@ -13,6 +13,10 @@ type ReportingPeriod1 struct {
 	Type *QueryType3Code `xml:"Tp"`
 }
 
+func (r *ReportingPeriod1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportingPeriod1) AddFromToDate() *DatePeriodDetails1 {
 	r.FromToDate = new(DatePeriodDetails1)
 	return r.FromToDate
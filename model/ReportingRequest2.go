@@ -28,6 +28,10 @@ type ReportingRequest2 struct {
 	RequestedBalanceType []*BalanceType12 `xml:"ReqdBalTp,omitempty"`
 }
 
+func (r *ReportingRequest2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportingRequest2) SetIdentification(value string) {
 	r.Identification = (*Max35Text)(&value)
 }
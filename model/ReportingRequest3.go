@@ -28,6 +28,10 @@ type ReportingRequest3 struct {
 	RequestedBalanceType []*BalanceType12 `xml:"ReqdBalTp,omitempty"`
 }
 
+func (r *ReportingRequest3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportingRequest3) SetIdentification(value string) {
 	r.Identification = (*Max35Text)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the source used to generate the reporting.
 type ReportingSource1Choice struct {
 
@@ -10,6 +12,10 @@ type ReportingSource1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (r *ReportingSource1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReportingSource1Choice) SetCode(value string) {
 	r.Code = (*ExternalReportingSource1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (r *ReportingSource1Choice) SetCode(value string) {
 func (r *ReportingSource1Choice) SetProprietary(value string) {
 	r.Proprietary = (*Max35Text)(&value)
 }
+
+func (r *ReportingSource1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.Code != nil {
+		enc.Element("Cd", string(*r.Code))
+	}
+	if r.Proprietary != nil {
+		enc.Element("Prtry", string(*r.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (r *ReportingSource1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Code = (*ExternalReportingSource1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
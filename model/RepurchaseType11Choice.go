@@ -10,6 +10,10 @@ type RepurchaseType11Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType11Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType11Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType7Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepurchaseType12Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType12Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType12Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType3Code)(&value)
 }
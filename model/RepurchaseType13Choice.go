@@ -10,6 +10,10 @@ type RepurchaseType13Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType13Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType13Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType6Code)(&value)
 }
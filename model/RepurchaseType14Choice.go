@@ -10,6 +10,10 @@ type RepurchaseType14Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType14Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType14Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepurchaseType15Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType15Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType15Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType5Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepurchaseType16Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType16Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType16Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType5Code)(&value)
 }
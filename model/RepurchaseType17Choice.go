@@ -10,6 +10,10 @@ type RepurchaseType17Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType17Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType17Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType6Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepurchaseType19Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType19Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType19Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepurchaseType1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType1Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepurchaseType20Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType20Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType20Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType2Code)(&value)
 }
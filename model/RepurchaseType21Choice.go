@@ -10,6 +10,10 @@ type RepurchaseType21Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType21Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType21Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType8Code)(&value)
 }
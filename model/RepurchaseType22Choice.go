@@ -10,6 +10,10 @@ type RepurchaseType22Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType22Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType22Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType9Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepurchaseType23Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType23Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType23Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType10Code)(&value)
 }
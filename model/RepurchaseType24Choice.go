@@ -10,6 +10,10 @@ type RepurchaseType24Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType24Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType24Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType9Code)(&value)
 }
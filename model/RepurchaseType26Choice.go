@@ -10,6 +10,10 @@ type RepurchaseType26Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType26Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType26Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType10Code)(&value)
 }
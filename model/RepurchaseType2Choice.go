@@ -10,6 +10,10 @@ type RepurchaseType2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType2Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType4Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepurchaseType31Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType31Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType31Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType8Code)(&value)
 }
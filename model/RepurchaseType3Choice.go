@@ -10,6 +10,10 @@ type RepurchaseType3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType3Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type RepurchaseType7Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType7Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType7Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType5Code)(&value)
 }
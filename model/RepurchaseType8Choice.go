@@ -10,6 +10,10 @@ type RepurchaseType8Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RepurchaseType8Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RepurchaseType8Choice) SetCode(value string) {
 	r.Code = (*RepurchaseType6Code)(&value)
 }
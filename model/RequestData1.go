@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Contains the meta data for a netting cut off update request: message identification, request servicer and a request type.
 type RequestData1 struct {
 
@@ -22,6 +26,10 @@ type RequestData1 struct {
 	NetServiceType *Max35Text `xml:"NetSvcTp,omitempty"`
 }
 
+func (r *RequestData1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestData1) SetMessageIdentification(value string) {
 	r.MessageIdentification = (*Max35Text)(&value)
 }
@@ -34,6 +42,11 @@ func (r *RequestData1) SetRequestedActivationDate(value string) {
 	r.RequestedActivationDate = (*ISODate)(&value)
 }
 
+func (r *RequestData1) SetRequestedActivationDateFromTime(value time.Time) {
+	r.RequestedActivationDate = new(ISODate)
+	r.RequestedActivationDate.FromTime(value)
+}
+
 func (r *RequestData1) AddRequestServicer() *PartyIdentification73Choice {
 	r.RequestServicer = new(PartyIdentification73Choice)
 	return r.RequestServicer
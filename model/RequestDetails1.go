@@ -40,6 +40,10 @@ type RequestDetails1 struct {
 	Linkages []*Linkages3 `xml:"Lnkgs,omitempty"`
 }
 
+func (r *RequestDetails1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestDetails1) AddReference() *References1 {
 	r.Reference = new(References1)
 	return r.Reference
@@ -54,6 +58,11 @@ func (r *RequestDetails1) SetRetainIndicator(value string) {
 	r.RetainIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RequestDetails1) SetRetainIndicatorFromBool(value bool) {
+	r.RetainIndicator = new(YesNoIndicator)
+	r.RetainIndicator.FromBool(value)
+}
+
 func (r *RequestDetails1) AddLinkage() *LinkageType1Choice {
 	r.Linkage = new(LinkageType1Choice)
 	return r.Linkage
@@ -214,6 +223,11 @@ func (r *RequestDetails1) SetPartialSettlementIndicator(value string) {
 	r.PartialSettlementIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RequestDetails1) SetPartialSettlementIndicatorFromBool(value bool) {
+	r.PartialSettlementIndicator = new(YesNoIndicator)
+	r.PartialSettlementIndicator.FromBool(value)
+}
+
 func (r *RequestDetails1) AddSecuritiesRTGS() *SecuritiesRTGS1Choice {
 	r.SecuritiesRTGS = new(SecuritiesRTGS1Choice)
 	return r.SecuritiesRTGS
@@ -223,6 +237,11 @@ func (r *RequestDetails1) SetHoldIndicator(value string) {
 	r.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RequestDetails1) SetHoldIndicatorFromBool(value bool) {
+	r.HoldIndicator = new(YesNoIndicator)
+	r.HoldIndicator.FromBool(value)
+}
+
 func (r *RequestDetails1) AddMatchingDenial() *MatchingDenied1Choice {
 	r.MatchingDenial = new(MatchingDenied1Choice)
 	return r.MatchingDenial
@@ -40,6 +40,10 @@ type RequestDetails11 struct {
 	Linkages []*Linkages27 `xml:"Lnkgs,omitempty"`
 }
 
+func (r *RequestDetails11) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestDetails11) AddReference() *References9 {
 	r.Reference = new(References9)
 	return r.Reference
@@ -54,6 +58,11 @@ func (r *RequestDetails11) SetRetainIndicator(value string) {
 	r.RetainIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RequestDetails11) SetRetainIndicatorFromBool(value bool) {
+	r.RetainIndicator = new(YesNoIndicator)
+	r.RetainIndicator.FromBool(value)
+}
+
 func (r *RequestDetails11) AddLinkage() *LinkageType1Choice {
 	r.Linkage = new(LinkageType1Choice)
 	return r.Linkage
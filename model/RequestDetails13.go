@@ -40,6 +40,10 @@ type RequestDetails13 struct {
 	Linkages []*Linkages27 `xml:"Lnkgs,omitempty"`
 }
 
+func (r *RequestDetails13) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestDetails13) AddReference() *References16 {
 	r.Reference = new(References16)
 	return r.Reference
@@ -54,6 +58,11 @@ func (r *RequestDetails13) SetRetainIndicator(value string) {
 	r.RetainIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RequestDetails13) SetRetainIndicatorFromBool(value bool) {
+	r.RetainIndicator = new(YesNoIndicator)
+	r.RetainIndicator.FromBool(value)
+}
+
 func (r *RequestDetails13) AddLinkage() *LinkageType1Choice {
 	r.Linkage = new(LinkageType1Choice)
 	return r.Linkage
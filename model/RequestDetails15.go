@@ -40,6 +40,10 @@ type RequestDetails15 struct {
 	Linkages []*Linkages39 `xml:"Lnkgs,omitempty"`
 }
 
+func (r *RequestDetails15) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestDetails15) AddReference() *References18 {
 	r.Reference = new(References18)
 	return r.Reference
@@ -54,6 +58,11 @@ func (r *RequestDetails15) SetRetainIndicator(value string) {
 	r.RetainIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RequestDetails15) SetRetainIndicatorFromBool(value bool) {
+	r.RetainIndicator = new(YesNoIndicator)
+	r.RetainIndicator.FromBool(value)
+}
+
 func (r *RequestDetails15) AddLinkage() *LinkageType3Choice {
 	r.Linkage = new(LinkageType3Choice)
 	return r.Linkage
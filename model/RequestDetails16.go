@@ -40,6 +40,10 @@ type RequestDetails16 struct {
 	Linkages []*Linkages44 `xml:"Lnkgs,omitempty"`
 }
 
+func (r *RequestDetails16) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestDetails16) AddReference() *References21 {
 	r.Reference = new(References21)
 	return r.Reference
@@ -54,6 +58,11 @@ func (r *RequestDetails16) SetRetainIndicator(value string) {
 	r.RetainIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RequestDetails16) SetRetainIndicatorFromBool(value bool) {
+	r.RetainIndicator = new(YesNoIndicator)
+	r.RetainIndicator.FromBool(value)
+}
+
 func (r *RequestDetails16) AddLinkage() *LinkageType4Choice {
 	r.Linkage = new(LinkageType4Choice)
 	return r.Linkage
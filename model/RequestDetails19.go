@@ -13,6 +13,10 @@ type RequestDetails19 struct {
 	AdditionalRequestInformation []*Max35Text `xml:"AddtlReqInf,omitempty"`
 }
 
+func (r *RequestDetails19) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestDetails19) SetType(value string) {
 	r.Type = (*Max35Text)(&value)
 }
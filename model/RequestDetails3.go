@@ -10,6 +10,10 @@ type RequestDetails3 struct {
 	Key *Max35Text `xml:"Key,omitempty"`
 }
 
+func (r *RequestDetails3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestDetails3) SetType(value string) {
 	r.Type = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type RequestDetails4 struct {
 	ReportData []*ReportParameter1 `xml:"RptData,omitempty"`
 }
 
+func (r *RequestDetails4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestDetails4) SetKey(value string) {
 	r.Key = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type RequestDetails5 struct {
 	ReportKey []*RequestDetails4 `xml:"RptKey"`
 }
 
+func (r *RequestDetails5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestDetails5) SetType(value string) {
 	r.Type = (*Max35Text)(&value)
 }
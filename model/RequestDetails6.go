@@ -40,6 +40,10 @@ type RequestDetails6 struct {
 	Linkages []*Linkages10 `xml:"Lnkgs,omitempty"`
 }
 
+func (r *RequestDetails6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestDetails6) AddReference() *References7 {
 	r.Reference = new(References7)
 	return r.Reference
@@ -54,6 +58,11 @@ func (r *RequestDetails6) SetRetainIndicator(value string) {
 	r.RetainIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RequestDetails6) SetRetainIndicatorFromBool(value bool) {
+	r.RetainIndicator = new(YesNoIndicator)
+	r.RetainIndicator.FromBool(value)
+}
+
 func (r *RequestDetails6) AddLinkage() *LinkageType1Choice {
 	r.Linkage = new(LinkageType1Choice)
 	return r.Linkage
@@ -40,6 +40,10 @@ type RequestDetails8 struct {
 	Linkages []*Linkages16 `xml:"Lnkgs,omitempty"`
 }
 
+func (r *RequestDetails8) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestDetails8) AddReference() *References9 {
 	r.Reference = new(References9)
 	return r.Reference
@@ -54,6 +58,11 @@ func (r *RequestDetails8) SetRetainIndicator(value string) {
 	r.RetainIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RequestDetails8) SetRetainIndicatorFromBool(value bool) {
+	r.RetainIndicator = new(YesNoIndicator)
+	r.RetainIndicator.FromBool(value)
+}
+
 func (r *RequestDetails8) AddLinkage() *LinkageType1Choice {
 	r.Linkage = new(LinkageType1Choice)
 	return r.Linkage
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of characteristics that unambiguously identify the global invoice financing request.
 type RequestGroupInformation1 struct {
 
@@ -45,6 +51,10 @@ type RequestGroupInformation1 struct {
 	AdditionalInformation []*AdditionalInformation1 `xml:"AddtlInf,omitempty"`
 }
 
+func (r *RequestGroupInformation1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestGroupInformation1) SetGroupIdentification(value string) {
 	r.GroupIdentification = (*Max35Text)(&value)
 }
@@ -53,6 +63,11 @@ func (r *RequestGroupInformation1) SetCreationDateTime(value string) {
 	r.CreationDateTime = (*ISODateTime)(&value)
 }
 
+func (r *RequestGroupInformation1) SetCreationDateTimeFromTime(value time.Time) {
+	r.CreationDateTime = new(ISODateTime)
+	r.CreationDateTime.FromTime(value)
+}
+
 func (r *RequestGroupInformation1) AddAuthorisation(value string) {
 	r.Authorisation = append(r.Authorisation, (*Max128Text)(&value))
 }
@@ -65,6 +80,10 @@ func (r *RequestGroupInformation1) SetTotalBulkInvoiceAmount(value, currency str
 	r.TotalBulkInvoiceAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *RequestGroupInformation1) SetTotalBulkInvoiceAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.TotalBulkInvoiceAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RequestGroupInformation1) SetCurrency(value string) {
 	r.Currency = (*CurrencyCode)(&value)
 }
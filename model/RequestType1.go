@@ -13,6 +13,10 @@ type RequestType1 struct {
 	AdditionalInformation *Max500Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *RequestType1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestType1) SetNumber(value string) {
 	r.Number = (*Max35Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Contains the requested modifications.
 type RequestedModification struct {
 
@@ -61,6 +67,10 @@ type RequestedModification struct {
 	SenderToReceiverInformation []*Max35Text `xml:"SndrToRcvrInf,omitempty"`
 }
 
+func (r *RequestedModification) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestedModification) SetRelatedReference(value string) {
 	r.RelatedReference = (*Max35Text)(&value)
 }
@@ -77,14 +87,28 @@ func (r *RequestedModification) SetRequestedExecutionDate(value string) {
 	r.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (r *RequestedModification) SetRequestedExecutionDateFromTime(value time.Time) {
+	r.RequestedExecutionDate = new(ISODate)
+	r.RequestedExecutionDate.FromTime(value)
+}
+
 func (r *RequestedModification) SetValueDate(value string) {
 	r.ValueDate = (*ISODate)(&value)
 }
 
+func (r *RequestedModification) SetValueDateFromTime(value time.Time) {
+	r.ValueDate = new(ISODate)
+	r.ValueDate.FromTime(value)
+}
+
 func (r *RequestedModification) SetInterbankSettledAmount(value, currency string) {
 	r.InterbankSettledAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (r *RequestedModification) SetInterbankSettledAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.InterbankSettledAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *RequestedModification) AddDebtor() *PartyIdentification1 {
 	r.Debtor = new(PartyIdentification1)
 	return r.Debtor
@@ -140,8 +164,12 @@ func (r *RequestedModification) AddInstructionForFinalAgent() *InstructionForFin
 	return r.InstructionForFinalAgent
 }
 
-func (r *RequestedModification) SetDetailsOfCharges(value string) {
+func (r *RequestedModification) SetDetailsOfCharges(value string) error {
+	if err := ChargeBearer1Code(value).Validate(); err != nil {
+		return err
+	}
 	r.DetailsOfCharges = (*ChargeBearer1Code)(&value)
+	return nil
 }
 
 func (r *RequestedModification) AddSenderToReceiverInformation(value string) {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the requested modifications of the underlying payment instruction.
 type RequestedModification2 struct {
 
@@ -86,6 +92,10 @@ type RequestedModification2 struct {
 	RemittanceInformation *RemittanceInformation5 `xml:"RmtInf,omitempty"`
 }
 
+func (r *RequestedModification2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestedModification2) SetInstructionIdentification(value string) {
 	r.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -107,14 +117,29 @@ func (r *RequestedModification2) SetRequestedExecutionDate(value string) {
 	r.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (r *RequestedModification2) SetRequestedExecutionDateFromTime(value time.Time) {
+	r.RequestedExecutionDate = new(ISODate)
+	r.RequestedExecutionDate.FromTime(value)
+}
+
 func (r *RequestedModification2) SetRequestedCollectionDate(value string) {
 	r.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (r *RequestedModification2) SetRequestedCollectionDateFromTime(value time.Time) {
+	r.RequestedCollectionDate = new(ISODate)
+	r.RequestedCollectionDate.FromTime(value)
+}
+
 func (r *RequestedModification2) SetInterbankSettlementDate(value string) {
 	r.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (r *RequestedModification2) SetInterbankSettlementDateFromTime(value time.Time) {
+	r.InterbankSettlementDate = new(ISODate)
+	r.InterbankSettlementDate.FromTime(value)
+}
+
 func (r *RequestedModification2) AddAmount() *AmountType3Choice {
 	r.Amount = new(AmountType3Choice)
 	return r.Amount
@@ -124,8 +149,16 @@ func (r *RequestedModification2) SetInterbankSettlementAmount(value, currency st
 	r.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (r *RequestedModification2) SetChargeBearer(value string) {
+func (r *RequestedModification2) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (r *RequestedModification2) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	r.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (r *RequestedModification2) AddUltimateDebtor() *PartyIdentification32 {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provide further details on the requested modifications of the underlying payment instruction.
 type RequestedModification4 struct {
 
@@ -86,6 +92,10 @@ type RequestedModification4 struct {
 	RemittanceInformation *RemittanceInformation10 `xml:"RmtInf,omitempty"`
 }
 
+func (r *RequestedModification4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestedModification4) SetInstructionIdentification(value string) {
 	r.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -107,14 +117,29 @@ func (r *RequestedModification4) SetRequestedExecutionDate(value string) {
 	r.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (r *RequestedModification4) SetRequestedExecutionDateFromTime(value time.Time) {
+	r.RequestedExecutionDate = new(ISODate)
+	r.RequestedExecutionDate.FromTime(value)
+}
+
 func (r *RequestedModification4) SetRequestedCollectionDate(value string) {
 	r.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (r *RequestedModification4) SetRequestedCollectionDateFromTime(value time.Time) {
+	r.RequestedCollectionDate = new(ISODate)
+	r.RequestedCollectionDate.FromTime(value)
+}
+
 func (r *RequestedModification4) SetInterbankSettlementDate(value string) {
 	r.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (r *RequestedModification4) SetInterbankSettlementDateFromTime(value time.Time) {
+	r.InterbankSettlementDate = new(ISODate)
+	r.InterbankSettlementDate.FromTime(value)
+}
+
 func (r *RequestedModification4) AddAmount() *AmountType4Choice {
 	r.Amount = new(AmountType4Choice)
 	return r.Amount
@@ -124,8 +149,16 @@ func (r *RequestedModification4) SetInterbankSettlementAmount(value, currency st
 	r.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (r *RequestedModification4) SetChargeBearer(value string) {
+func (r *RequestedModification4) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (r *RequestedModification4) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	r.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (r *RequestedModification4) AddUltimateDebtor() *PartyIdentification43 {
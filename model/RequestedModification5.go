@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provide further details on the requested modifications of the underlying payment instruction.
 type RequestedModification5 struct {
 
@@ -86,6 +92,10 @@ type RequestedModification5 struct {
 	RemittanceInformation *RemittanceInformation11 `xml:"RmtInf,omitempty"`
 }
 
+func (r *RequestedModification5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestedModification5) SetInstructionIdentification(value string) {
 	r.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -107,14 +117,29 @@ func (r *RequestedModification5) SetRequestedExecutionDate(value string) {
 	r.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (r *RequestedModification5) SetRequestedExecutionDateFromTime(value time.Time) {
+	r.RequestedExecutionDate = new(ISODate)
+	r.RequestedExecutionDate.FromTime(value)
+}
+
 func (r *RequestedModification5) SetRequestedCollectionDate(value string) {
 	r.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (r *RequestedModification5) SetRequestedCollectionDateFromTime(value time.Time) {
+	r.RequestedCollectionDate = new(ISODate)
+	r.RequestedCollectionDate.FromTime(value)
+}
+
 func (r *RequestedModification5) SetInterbankSettlementDate(value string) {
 	r.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (r *RequestedModification5) SetInterbankSettlementDateFromTime(value time.Time) {
+	r.InterbankSettlementDate = new(ISODate)
+	r.InterbankSettlementDate.FromTime(value)
+}
+
 func (r *RequestedModification5) AddAmount() *AmountType4Choice {
 	r.Amount = new(AmountType4Choice)
 	return r.Amount
@@ -124,8 +149,16 @@ func (r *RequestedModification5) SetInterbankSettlementAmount(value, currency st
 	r.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (r *RequestedModification5) SetChargeBearer(value string) {
+func (r *RequestedModification5) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (r *RequestedModification5) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	r.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (r *RequestedModification5) AddUltimateDebtor() *PartyIdentification43 {
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provide further details on the requested modifications of the underlying payment instruction.
 type RequestedModification6 struct {
 
@@ -86,6 +92,10 @@ type RequestedModification6 struct {
 	RemittanceInformation *RemittanceInformation11 `xml:"RmtInf,omitempty"`
 }
 
+func (r *RequestedModification6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequestedModification6) SetInstructionIdentification(value string) {
 	r.InstructionIdentification = (*Max35Text)(&value)
 }
@@ -112,10 +122,20 @@ func (r *RequestedModification6) SetRequestedCollectionDate(value string) {
 	r.RequestedCollectionDate = (*ISODate)(&value)
 }
 
+func (r *RequestedModification6) SetRequestedCollectionDateFromTime(value time.Time) {
+	r.RequestedCollectionDate = new(ISODate)
+	r.RequestedCollectionDate.FromTime(value)
+}
+
 func (r *RequestedModification6) SetInterbankSettlementDate(value string) {
 	r.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (r *RequestedModification6) SetInterbankSettlementDateFromTime(value time.Time) {
+	r.InterbankSettlementDate = new(ISODate)
+	r.InterbankSettlementDate.FromTime(value)
+}
+
 func (r *RequestedModification6) AddAmount() *AmountType4Choice {
 	r.Amount = new(AmountType4Choice)
 	return r.Amount
@@ -125,8 +145,16 @@ func (r *RequestedModification6) SetInterbankSettlementAmount(value, currency st
 	r.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (r *RequestedModification6) SetChargeBearer(value string) {
+func (r *RequestedModification6) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (r *RequestedModification6) SetChargeBearer(value string) error {
+	if err := ChargeBearerType1Code(value).Validate(); err != nil {
+		return err
+	}
 	r.ChargeBearer = (*ChargeBearerType1Code)(&value)
+	return nil
 }
 
 func (r *RequestedModification6) AddUltimateDebtor() *PartyIdentification43 {
@@ -7,6 +7,10 @@ type RequiredSubmission2 struct {
 	Submitter []*BICIdentification1 `xml:"Submitr"`
 }
 
+func (r *RequiredSubmission2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequiredSubmission2) AddSubmitter() *BICIdentification1 {
 	newValue := new(BICIdentification1)
 	r.Submitter = append(r.Submitter, newValue)
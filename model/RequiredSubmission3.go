@@ -25,6 +25,10 @@ type RequiredSubmission3 struct {
 	MatchAssuredParty *AssuredType1Code `xml:"MtchAssrdPty,omitempty"`
 }
 
+func (r *RequiredSubmission3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequiredSubmission3) AddSubmitter() *BICIdentification1 {
 	newValue := new(BICIdentification1)
 	r.Submitter = append(r.Submitter, newValue)
@@ -180,14 +184,29 @@ func (r *RequiredSubmission3) SetMatchIssueDate(value string) {
 	r.MatchIssueDate = (*YesNoIndicator)(&value)
 }
 
+func (r *RequiredSubmission3) SetMatchIssueDateFromBool(value bool) {
+	r.MatchIssueDate = new(YesNoIndicator)
+	r.MatchIssueDate.FromBool(value)
+}
+
 func (r *RequiredSubmission3) SetMatchTransport(value string) {
 	r.MatchTransport = (*YesNoIndicator)(&value)
 }
 
+func (r *RequiredSubmission3) SetMatchTransportFromBool(value bool) {
+	r.MatchTransport = new(YesNoIndicator)
+	r.MatchTransport.FromBool(value)
+}
+
 func (r *RequiredSubmission3) SetMatchAmount(value string) {
 	r.MatchAmount = (*YesNoIndicator)(&value)
 }
 
+func (r *RequiredSubmission3) SetMatchAmountFromBool(value bool) {
+	r.MatchAmount = new(YesNoIndicator)
+	r.MatchAmount.FromBool(value)
+}
+
 func (r *RequiredSubmission3) AddClausesRequired(value string) {
 	r.ClausesRequired = append(r.ClausesRequired, (*InsuranceClauses1Code)(&value))
 }
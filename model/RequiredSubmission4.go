@@ -31,6 +31,10 @@ type RequiredSubmission4 struct {
 	LineItemIdentification []*Max70Text `xml:"LineItmId,omitempty"`
 }
 
+func (r *RequiredSubmission4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequiredSubmission4) AddSubmitter() *BICIdentification1 {
 	newValue := new(BICIdentification1)
 	r.Submitter = append(r.Submitter, newValue)
@@ -190,18 +194,38 @@ func (r *RequiredSubmission4) SetMatchIssueDate(value string) {
 	r.MatchIssueDate = (*YesNoIndicator)(&value)
 }
 
+func (r *RequiredSubmission4) SetMatchIssueDateFromBool(value bool) {
+	r.MatchIssueDate = new(YesNoIndicator)
+	r.MatchIssueDate.FromBool(value)
+}
+
 func (r *RequiredSubmission4) SetMatchInspectionDate(value string) {
 	r.MatchInspectionDate = (*YesNoIndicator)(&value)
 }
 
+func (r *RequiredSubmission4) SetMatchInspectionDateFromBool(value bool) {
+	r.MatchInspectionDate = new(YesNoIndicator)
+	r.MatchInspectionDate.FromBool(value)
+}
+
 func (r *RequiredSubmission4) SetAuthorisedInspectorIndicator(value string) {
 	r.AuthorisedInspectorIndicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RequiredSubmission4) SetAuthorisedInspectorIndicatorFromBool(value bool) {
+	r.AuthorisedInspectorIndicator = new(YesNoIndicator)
+	r.AuthorisedInspectorIndicator.FromBool(value)
+}
+
 func (r *RequiredSubmission4) SetMatchConsignee(value string) {
 	r.MatchConsignee = (*YesNoIndicator)(&value)
 }
 
+func (r *RequiredSubmission4) SetMatchConsigneeFromBool(value bool) {
+	r.MatchConsignee = new(YesNoIndicator)
+	r.MatchConsignee.FromBool(value)
+}
+
 func (r *RequiredSubmission4) AddMatchManufacturer() *PartyIdentification27 {
 	r.MatchManufacturer = new(PartyIdentification27)
 	return r.MatchManufacturer
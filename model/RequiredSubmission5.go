@@ -10,6 +10,10 @@ type RequiredSubmission5 struct {
 	CertificateType *TradeCertificateType2Code `xml:"CertTp"`
 }
 
+func (r *RequiredSubmission5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequiredSubmission5) AddSubmitter() *BICIdentification1 {
 	newValue := new(BICIdentification1)
 	r.Submitter = append(r.Submitter, newValue)
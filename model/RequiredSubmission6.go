@@ -13,6 +13,10 @@ type RequiredSubmission6 struct {
 	CertificateTypeDescription *Max140Text `xml:"CertTpDesc"`
 }
 
+func (r *RequiredSubmission6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RequiredSubmission6) AddSubmitter() *BICIdentification1 {
 	newValue := new(BICIdentification1)
 	r.Submitter = append(r.Submitter, newValue)
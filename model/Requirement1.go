@@ -10,6 +10,10 @@ type Requirement1 struct {
 	SegregatedIndependentAmountRequirement *MarginRequirement1 `xml:"SgrtdIndpdntAmtRqrmnt,omitempty"`
 }
 
+func (r *Requirement1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Requirement1) AddVariationMarginRequirement() *MarginRequirement1 {
 	r.VariationMarginRequirement = new(MarginRequirement1)
 	return r.VariationMarginRequirement
@@ -10,6 +10,10 @@ type ResidenceLocation1Choice struct {
 	Area *Max35Text `xml:"Area"`
 }
 
+func (r *ResidenceLocation1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResidenceLocation1Choice) SetCountry(value string) {
 	r.Country = (*CountryCode)(&value)
 }
@@ -34,6 +34,10 @@ type Resolution2 struct {
 	NotifyingPartyRecommendation *VoteInstruction1Code `xml:"NtifngPtyRcmmndtn,omitempty"`
 }
 
+func (r *Resolution2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Resolution2) SetIssuerLabel(value string) {
 	r.IssuerLabel = (*Max35Text)(&value)
 }
@@ -54,6 +58,11 @@ func (r *Resolution2) SetForInformationOnly(value string) {
 	r.ForInformationOnly = (*YesNoIndicator)(&value)
 }
 
+func (r *Resolution2) SetForInformationOnlyFromBool(value bool) {
+	r.ForInformationOnly = new(YesNoIndicator)
+	r.ForInformationOnly.FromBool(value)
+}
+
 func (r *Resolution2) SetStatus(value string) {
 	r.Status = (*ResolutionStatus1Code)(&value)
 }
@@ -62,6 +71,11 @@ func (r *Resolution2) SetSubmittedBySecurityHolder(value string) {
 	r.SubmittedBySecurityHolder = (*YesNoIndicator)(&value)
 }
 
+func (r *Resolution2) SetSubmittedBySecurityHolderFromBool(value bool) {
+	r.SubmittedBySecurityHolder = new(YesNoIndicator)
+	r.SubmittedBySecurityHolder.FromBool(value)
+}
+
 func (r *Resolution2) AddVoteInstructionType(value string) {
 	r.VoteInstructionType = append(r.VoteInstructionType, (*VoteInstruction2Code)(&value))
 }
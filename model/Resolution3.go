@@ -37,6 +37,10 @@ type Resolution3 struct {
 	Entitlement *Entitlement1Choice `xml:"Entitlmnt,omitempty"`
 }
 
+func (r *Resolution3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Resolution3) SetIssuerLabel(value string) {
 	r.IssuerLabel = (*Max35Text)(&value)
 }
@@ -57,6 +61,11 @@ func (r *Resolution3) SetForInformationOnly(value string) {
 	r.ForInformationOnly = (*YesNoIndicator)(&value)
 }
 
+func (r *Resolution3) SetForInformationOnlyFromBool(value bool) {
+	r.ForInformationOnly = new(YesNoIndicator)
+	r.ForInformationOnly.FromBool(value)
+}
+
 func (r *Resolution3) SetStatus(value string) {
 	r.Status = (*ResolutionStatus1Code)(&value)
 }
@@ -65,6 +74,11 @@ func (r *Resolution3) SetSubmittedBySecurityHolder(value string) {
 	r.SubmittedBySecurityHolder = (*YesNoIndicator)(&value)
 }
 
+func (r *Resolution3) SetSubmittedBySecurityHolderFromBool(value bool) {
+	r.SubmittedBySecurityHolder = new(YesNoIndicator)
+	r.SubmittedBySecurityHolder.FromBool(value)
+}
+
 func (r *Resolution3) AddVoteInstructionType(value string) {
 	r.VoteInstructionType = append(r.VoteInstructionType, (*VoteInstruction2Code)(&value))
 }
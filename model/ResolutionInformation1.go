@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the return or reversal expected by the party that initiated the initial payment instruction after a cancellation or modification request.
 type ResolutionInformation1 struct {
 
@@ -13,14 +19,31 @@ type ResolutionInformation1 struct {
 	ClearingChannel *ClearingChannel2Code `xml:"ClrChanl,omitempty"`
 }
 
+func (r *ResolutionInformation1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResolutionInformation1) SetInterbankSettlementAmount(value, currency string) {
 	r.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (r *ResolutionInformation1) SetInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	r.InterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *ResolutionInformation1) SetInterbankSettlementDate(value string) {
 	r.InterbankSettlementDate = (*ISODate)(&value)
 }
 
-func (r *ResolutionInformation1) SetClearingChannel(value string) {
+func (r *ResolutionInformation1) SetInterbankSettlementDateFromTime(value time.Time) {
+	r.InterbankSettlementDate = new(ISODate)
+	r.InterbankSettlementDate.FromTime(value)
+}
+
+func (r *ResolutionInformation1) SetClearingChannel(value string) error {
+	if err := ClearingChannel2Code(value).Validate(); err != nil {
+		return err
+	}
 	r.ClearingChannel = (*ClearingChannel2Code)(&value)
+	return nil
 }
@@ -10,6 +10,10 @@ type Response1 struct {
 	Description *Max140Text `xml:"Desc,omitempty"`
 }
 
+func (r *Response1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Response1) AddResponseTypeDetails() *ResponseType1Choice {
 	newValue := new(ResponseType1Choice)
 	r.ResponseTypeDetails = append(r.ResponseTypeDetails, newValue)
@@ -10,6 +10,10 @@ type ResponseStatus1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *ResponseStatus1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResponseStatus1Choice) SetCode(value string) {
 	r.Code = (*ResponseStatus1Code)(&value)
 }
@@ -13,6 +13,10 @@ type ResponseStatus3Choice struct {
 	Pending *PendingStatus20Choice `xml:"Pdg"`
 }
 
+func (r *ResponseStatus3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResponseStatus3Choice) AddConsented() *ConsentStatus2Choice {
 	r.Consented = new(ConsentStatus2Choice)
 	return r.Consented
@@ -10,6 +10,10 @@ type ResponseStatus5Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *ResponseStatus5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResponseStatus5Choice) SetCode(value string) {
 	r.Code = (*ResponseStatus1Code)(&value)
 }
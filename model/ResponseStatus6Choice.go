@@ -13,6 +13,10 @@ type ResponseStatus6Choice struct {
 	Pending *PendingStatus20Choice `xml:"Pdg"`
 }
 
+func (r *ResponseStatus6Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResponseStatus6Choice) AddConsented() *ConsentStatus4Choice {
 	r.Consented = new(ConsentStatus4Choice)
 	return r.Consented
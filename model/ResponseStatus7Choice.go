@@ -10,6 +10,10 @@ type ResponseStatus7Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *ResponseStatus7Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResponseStatus7Choice) SetCode(value string) {
 	r.Code = (*ResponseStatus1Code)(&value)
 }
@@ -13,6 +13,10 @@ type ResponseStatus8Choice struct {
 	Pending *PendingStatus20Choice `xml:"Pdg"`
 }
 
+func (r *ResponseStatus8Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResponseStatus8Choice) AddConsented() *ConsentStatus5Choice {
 	r.Consented = new(ConsentStatus5Choice)
 	return r.Consented
@@ -10,6 +10,10 @@ type ResponseType1 struct {
 	ResponseReason *Max35Text `xml:"RspnRsn,omitempty"`
 }
 
+func (r *ResponseType1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResponseType1) SetResponse(value string) {
 	r.Response = (*Response1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ResponseType1Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *ResponseType1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResponseType1Choice) SetCode(value string) {
 	r.Code = (*MarginCallResponse1Code)(&value)
 }
@@ -13,6 +13,10 @@ type ResponseType2 struct {
 	AdditionalResultInformation *Max140Text `xml:"AddtlRsltInf,omitempty"`
 }
 
+func (r *ResponseType2) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResponseType2) SetResult(value string) {
 	r.Result = (*Response3Code)(&value)
 }
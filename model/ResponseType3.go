@@ -13,6 +13,10 @@ type ResponseType3 struct {
 	AdditionalResponseInformation *Max140Text `xml:"AddtlRspnInf,omitempty"`
 }
 
+func (r *ResponseType3) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResponseType3) SetResponse(value string) {
 	r.Response = (*Response4Code)(&value)
 }
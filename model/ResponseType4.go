@@ -19,6 +19,10 @@ type ResponseType4 struct {
 	AdditionalResponseInformation *Max35Text `xml:"AddtlRspnInf,omitempty"`
 }
 
+func (r *ResponseType4) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResponseType4) SetResponderIdentification(value string) {
 	r.ResponderIdentification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type ResponseType5 struct {
 	AdditionalResponseInformation *Max140Text `xml:"AddtlRspnInf,omitempty"`
 }
 
+func (r *ResponseType5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResponseType5) SetResponse(value string) {
 	r.Response = (*Response4Code)(&value)
 }
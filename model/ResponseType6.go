@@ -13,6 +13,10 @@ type ResponseType6 struct {
 	AdditionalResponse *Max140Text `xml:"AddtlRspn,omitempty"`
 }
 
+func (r *ResponseType6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResponseType6) SetResponse(value string) {
 	r.Response = (*Response2Code)(&value)
 }
@@ -13,6 +13,10 @@ type ResponseType7 struct {
 	AdditionalResponseInformation *Max140Text `xml:"AddtlRspnInf,omitempty"`
 }
 
+func (r *ResponseType7) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResponseType7) SetResponse(value string) {
 	r.Response = (*Response4Code)(&value)
 }
@@ -19,6 +19,10 @@ type ResponseType8 struct {
 	AdditionalResponseInformation *Max35Text `xml:"AddtlRspnInf,omitempty"`
 }
 
+func (r *ResponseType8) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ResponseType8) SetResponderIdentification(value string) {
 	r.ResponderIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/amount"
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // A number of monetary units specified in an active currency where the unit of currency is explicit and compliant with ISO 4217. The number of fractional digits (or minor unit of currency) is not checked as per ISO 4217: It must be lesser than or equal to 13.
 // Note: The decimal separator is a dot.
 type RestrictedFINActiveCurrencyAnd13DecimalAmount struct {
@@ -7,6 +12,33 @@ type RestrictedFINActiveCurrencyAnd13DecimalAmount struct {
 	Currency string `xml:"Ccy,attr"`
 }
 
+func (r *RestrictedFINActiveCurrencyAnd13DecimalAmount) Validate() error {
+	if err := ValidateElement(r); err != nil {
+		return err
+	}
+	return validateAmount("RestrictedFINActiveCurrencyAnd13DecimalAmount", r.Value, r.Currency, 14, 13, true, false)
+}
+
 func NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency string) *RestrictedFINActiveCurrencyAnd13DecimalAmount {
 	return &RestrictedFINActiveCurrencyAnd13DecimalAmount{Value: value, Currency: currency}
 }
+
+func (r *RestrictedFINActiveCurrencyAnd13DecimalAmount) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(r.Value)
+}
+
+func NewRestrictedFINActiveCurrencyAnd13DecimalAmountFromDecimal(value decimal.Decimal, currency string) *RestrictedFINActiveCurrencyAnd13DecimalAmount {
+	return NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
+func (r *RestrictedFINActiveCurrencyAnd13DecimalAmount) Amount() (amount.Amount, error) {
+	value, err := r.Decimal()
+	if err != nil {
+		return amount.Amount{}, err
+	}
+	return amount.New(value, r.Currency), nil
+}
+
+func NewRestrictedFINActiveCurrencyAnd13DecimalAmountFromAmount(value amount.Amount) *RestrictedFINActiveCurrencyAnd13DecimalAmount {
+	return NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.Text(), value.Currency)
+}
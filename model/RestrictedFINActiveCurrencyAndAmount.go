@@ -1,11 +1,43 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/amount"
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // A number of monetary units specified in an active currency where the unit of currency is explicit and compliant with ISO 4217.
 type RestrictedFINActiveCurrencyAndAmount struct {
 	Value    string `xml:",chardata"`
 	Currency string `xml:"Ccy,attr"`
 }
 
+func (r *RestrictedFINActiveCurrencyAndAmount) Validate() error {
+	if err := ValidateElement(r); err != nil {
+		return err
+	}
+	return validateAmount("RestrictedFINActiveCurrencyAndAmount", r.Value, r.Currency, 14, 5, true, true)
+}
+
 func NewRestrictedFINActiveCurrencyAndAmount(value, currency string) *RestrictedFINActiveCurrencyAndAmount {
 	return &RestrictedFINActiveCurrencyAndAmount{Value: value, Currency: currency}
 }
+
+func (r *RestrictedFINActiveCurrencyAndAmount) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(r.Value)
+}
+
+func NewRestrictedFINActiveCurrencyAndAmountFromDecimal(value decimal.Decimal, currency string) *RestrictedFINActiveCurrencyAndAmount {
+	return NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
+
+func (r *RestrictedFINActiveCurrencyAndAmount) Amount() (amount.Amount, error) {
+	value, err := r.Decimal()
+	if err != nil {
+		return amount.Amount{}, err
+	}
+	return amount.New(value, r.Currency), nil
+}
+
+func NewRestrictedFINActiveCurrencyAndAmountFromAmount(value amount.Amount) *RestrictedFINActiveCurrencyAndAmount {
+	return NewRestrictedFINActiveCurrencyAndAmount(value.Text(), value.Currency)
+}
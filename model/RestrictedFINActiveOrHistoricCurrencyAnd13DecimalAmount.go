@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/amount"
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // A number of monetary units specified in an active or a historic currency where the unit of currency is explicit and compliant with ISO 4217. The number of fractional digits (or minor unit of currency) is not checked as per ISO 4217: It must be lesser than or equal to 13.
 // Note: The decimal separator is a dot.
 type RestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount struct {
@@ -7,6 +12,33 @@ type RestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount struct {
 	Currency string `xml:"Ccy,attr"`
 }
 
+func (r *RestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount) Validate() error {
+	if err := ValidateElement(r); err != nil {
+		return err
+	}
+	return validateAmount("RestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount", r.Value, r.Currency, 14, 13, false, false)
+}
+
 func NewRestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency string) *RestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount {
 	return &RestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount{Value: value, Currency: currency}
 }
+
+func (r *RestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(r.Value)
+}
+
+func NewRestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmountFromDecimal(value decimal.Decimal, currency string) *RestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount {
+	return NewRestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
+func (r *RestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount) Amount() (amount.Amount, error) {
+	value, err := r.Decimal()
+	if err != nil {
+		return amount.Amount{}, err
+	}
+	return amount.New(value, r.Currency), nil
+}
+
+func NewRestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmountFromAmount(value amount.Amount) *RestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount {
+	return NewRestrictedFINActiveOrHistoricCurrencyAnd13DecimalAmount(value.Text(), value.Currency)
+}
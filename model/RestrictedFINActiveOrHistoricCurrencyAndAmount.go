@@ -1,11 +1,43 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/amount"
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Number of monetary units specified in an active or a historic currency where the unit of currency is explicit and compliant with ISO 4217.
 type RestrictedFINActiveOrHistoricCurrencyAndAmount struct {
 	Value    string `xml:",chardata"`
 	Currency string `xml:"Ccy,attr"`
 }
 
+func (r *RestrictedFINActiveOrHistoricCurrencyAndAmount) Validate() error {
+	if err := ValidateElement(r); err != nil {
+		return err
+	}
+	return validateAmount("RestrictedFINActiveOrHistoricCurrencyAndAmount", r.Value, r.Currency, 14, 5, false, true)
+}
+
 func NewRestrictedFINActiveOrHistoricCurrencyAndAmount(value, currency string) *RestrictedFINActiveOrHistoricCurrencyAndAmount {
 	return &RestrictedFINActiveOrHistoricCurrencyAndAmount{Value: value, Currency: currency}
 }
+
+func (r *RestrictedFINActiveOrHistoricCurrencyAndAmount) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(r.Value)
+}
+
+func NewRestrictedFINActiveOrHistoricCurrencyAndAmountFromDecimal(value decimal.Decimal, currency string) *RestrictedFINActiveOrHistoricCurrencyAndAmount {
+	return NewRestrictedFINActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (r *RestrictedFINActiveOrHistoricCurrencyAndAmount) Amount() (amount.Amount, error) {
+	value, err := r.Decimal()
+	if err != nil {
+		return amount.Amount{}, err
+	}
+	return amount.New(value, r.Currency), nil
+}
+
+func NewRestrictedFINActiveOrHistoricCurrencyAndAmountFromAmount(value amount.Amount) *RestrictedFINActiveOrHistoricCurrencyAndAmount {
+	return NewRestrictedFINActiveOrHistoricCurrencyAndAmount(value.Text(), value.Currency)
+}
@@ -1,3 +1,19 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 type RestrictedFINDecimalNumber string
+
+func (r RestrictedFINDecimalNumber) Validate() error {
+	return validateDecimal("RestrictedFINDecimalNumber", string(r), 15, 13)
+}
+
+func (r RestrictedFINDecimalNumber) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(string(r))
+}
+
+func (r *RestrictedFINDecimalNumber) FromDecimal(value decimal.Decimal) {
+	*r = RestrictedFINDecimalNumber(value.String())
+}
@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINExact2Text string
+
+var restrictedFINExact2TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{2,2}`)
+
+func (r RestrictedFINExact2Text) Validate() error {
+	return validatePattern("RestrictedFINExact2Text", string(r), restrictedFINExact2TextPattern)
+}
@@ -1,12 +1,44 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/amount"
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Number of monetary units specified in a currency where the unit of currency is implied by the context and compliant with ISO 4217. The decimal separator is a dot.
 // Note: a zero amount is considered a positive amount.
 type RestrictedFINImpliedCurrencyAndAmount struct {
 	Value    string `xml:",chardata"`
-	Currency string `xml:"Ccy,attr"`
+	Currency string `xml:"Ccy,attr,omitempty"`
+}
+
+func (r *RestrictedFINImpliedCurrencyAndAmount) Validate() error {
+	if err := ValidateElement(r); err != nil {
+		return err
+	}
+	return validateAmount("RestrictedFINImpliedCurrencyAndAmount", r.Value, r.Currency, 14, 5, false, false)
 }
 
 func NewRestrictedFINImpliedCurrencyAndAmount(value, currency string) *RestrictedFINImpliedCurrencyAndAmount {
 	return &RestrictedFINImpliedCurrencyAndAmount{Value: value, Currency: currency}
 }
+
+func (r *RestrictedFINImpliedCurrencyAndAmount) Decimal() (decimal.Decimal, error) {
+	return decimal.Parse(r.Value)
+}
+
+func NewRestrictedFINImpliedCurrencyAndAmountFromDecimal(value decimal.Decimal, currency string) *RestrictedFINImpliedCurrencyAndAmount {
+	return NewRestrictedFINImpliedCurrencyAndAmount(value.String(), currency)
+}
+
+func (r *RestrictedFINImpliedCurrencyAndAmount) Amount() (amount.Amount, error) {
+	value, err := r.Decimal()
+	if err != nil {
+		return amount.Amount{}, err
+	}
+	return amount.New(value, r.Currency), nil
+}
+
+func NewRestrictedFINImpliedCurrencyAndAmountFromAmount(value amount.Amount) *RestrictedFINImpliedCurrencyAndAmount {
+	return NewRestrictedFINImpliedCurrencyAndAmount(value.Text(), value.Currency)
+}
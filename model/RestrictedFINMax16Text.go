@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINMax16Text string
+
+var restrictedFINMax16TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{1,16}`)
+
+func (r RestrictedFINMax16Text) Validate() error {
+	return validatePattern("RestrictedFINMax16Text", string(r), restrictedFINMax16TextPattern)
+}
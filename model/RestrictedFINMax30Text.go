@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINMax30Text string
+
+var restrictedFINMax30TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{1,30}`)
+
+func (r RestrictedFINMax30Text) Validate() error {
+	return validatePattern("RestrictedFINMax30Text", string(r), restrictedFINMax30TextPattern)
+}
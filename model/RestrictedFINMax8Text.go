@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINMax8Text string
+
+var restrictedFINMax8TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{1,8}`)
+
+func (r RestrictedFINMax8Text) Validate() error {
+	return validatePattern("RestrictedFINMax8Text", string(r), restrictedFINMax8TextPattern)
+}
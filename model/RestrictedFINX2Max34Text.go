@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINX2Max34Text string
+
+var restrictedFINX2Max34TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{1,34}`)
+
+func (r RestrictedFINX2Max34Text) Validate() error {
+	return validatePattern("RestrictedFINX2Max34Text", string(r), restrictedFINX2Max34TextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINXMax140Text string
+
+var restrictedFINXMax140TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{1,140}`)
+
+func (r RestrictedFINXMax140Text) Validate() error {
+	return validatePattern("RestrictedFINXMax140Text", string(r), restrictedFINXMax140TextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINXMax16Text string
+
+var restrictedFINXMax16TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{1,16}`)
+
+func (r RestrictedFINXMax16Text) Validate() error {
+	return validatePattern("RestrictedFINXMax16Text", string(r), restrictedFINXMax16TextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINXMax210Text string
+
+var restrictedFINXMax210TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{1,210}`)
+
+func (r RestrictedFINXMax210Text) Validate() error {
+	return validatePattern("RestrictedFINXMax210Text", string(r), restrictedFINXMax210TextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINXMax24Text string
+
+var restrictedFINXMax24TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{1,24}`)
+
+func (r RestrictedFINXMax24Text) Validate() error {
+	return validatePattern("RestrictedFINXMax24Text", string(r), restrictedFINXMax24TextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINXMax256Text string
+
+var restrictedFINXMax256TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{1,256}`)
+
+func (r RestrictedFINXMax256Text) Validate() error {
+	return validatePattern("RestrictedFINXMax256Text", string(r), restrictedFINXMax256TextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINXMax30Text string
+
+var restrictedFINXMax30TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{1,30}`)
+
+func (r RestrictedFINXMax30Text) Validate() error {
+	return validatePattern("RestrictedFINXMax30Text", string(r), restrictedFINXMax30TextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINXMax31Text string
+
+var restrictedFINXMax31TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{1,31}`)
+
+func (r RestrictedFINXMax31Text) Validate() error {
+	return validatePattern("RestrictedFINXMax31Text", string(r), restrictedFINXMax31TextPattern)
+}
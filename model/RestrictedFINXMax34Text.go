@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINXMax34Text string
+
+var restrictedFINXMax34TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{1,34}`)
+
+func (r RestrictedFINXMax34Text) Validate() error {
+	return validatePattern("RestrictedFINXMax34Text", string(r), restrictedFINXMax34TextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINXMax350Text string
+
+var restrictedFINXMax350TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{1,350}`)
+
+func (r RestrictedFINXMax350Text) Validate() error {
+	return validatePattern("RestrictedFINXMax350Text", string(r), restrictedFINXMax350TextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINXMax35Text string
+
+var restrictedFINXMax35TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{1,35}`)
+
+func (r RestrictedFINXMax35Text) Validate() error {
+	return validatePattern("RestrictedFINXMax35Text", string(r), restrictedFINXMax35TextPattern)
+}
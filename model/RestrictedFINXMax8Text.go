@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINXMax8Text string
+
+var restrictedFINXMax8TextPattern = newPattern(`[0-9a-zA-Z/\-\?:\(\)\.,'\+ ]{1,8}`)
+
+func (r RestrictedFINXMax8Text) Validate() error {
+	return validatePattern("RestrictedFINXMax8Text", string(r), restrictedFINXMax8TextPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type RestrictedFINZMax256Text string
+
+var restrictedFINZMax256TextPattern = newPattern("[0-9a-zA-Z/\\-\\?:\\(\\)\\.,'\\+ !#$%&\\*=^_`\\{\\|\\}~\\\";<>@\\[\\\\\\]]{1,256}")
+
+func (r RestrictedFINZMax256Text) Validate() error {
+	return validatePattern("RestrictedFINZMax256Text", string(r), restrictedFINZMax256TextPattern)
+}
@@ -1,3 +1,12 @@
 package model
 
 type RestrictedFINZMax8000Text string
+
+var restrictedFINZMax8000TextPattern = newPattern("[0-9a-zA-Z/\\-\\?:\\(\\)\\.,'\\+ !#$%&\\*=^_`\\{\\|\\}~\\\";<>@\\[\\\\\\]]+")
+
+func (r RestrictedFINZMax8000Text) Validate() error {
+	if err := validatePattern("RestrictedFINZMax8000Text", string(r), restrictedFINZMax8000TextPattern); err != nil {
+		return err
+	}
+	return validateLength("RestrictedFINZMax8000Text", string(r), 1, 8000)
+}
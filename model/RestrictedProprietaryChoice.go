@@ -7,6 +7,10 @@ type RestrictedProprietaryChoice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (r *RestrictedProprietaryChoice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RestrictedProprietaryChoice) SetProprietary(value string) {
 	r.Proprietary = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Restriction on capability or operations allowed.
 type Restriction1 struct {
 
@@ -13,6 +17,10 @@ type Restriction1 struct {
 	ValidUntil *ISODateTime `xml:"VldUntil,omitempty"`
 }
 
+func (r *Restriction1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Restriction1) AddRestrictionType() *CodeOrProprietary1Choice {
 	r.RestrictionType = new(CodeOrProprietary1Choice)
 	return r.RestrictionType
@@ -22,6 +30,16 @@ func (r *Restriction1) SetValidFrom(value string) {
 	r.ValidFrom = (*ISODateTime)(&value)
 }
 
+func (r *Restriction1) SetValidFromFromTime(value time.Time) {
+	r.ValidFrom = new(ISODateTime)
+	r.ValidFrom.FromTime(value)
+}
+
 func (r *Restriction1) SetValidUntil(value string) {
 	r.ValidUntil = (*ISODateTime)(&value)
 }
+
+func (r *Restriction1) SetValidUntilFromTime(value time.Time) {
+	r.ValidUntil = new(ISODateTime)
+	r.ValidUntil.FromTime(value)
+}
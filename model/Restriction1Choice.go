@@ -10,6 +10,10 @@ type Restriction1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *Restriction1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Restriction1Choice) SetCode(value string) {
 	r.Code = (*OwnershipLegalRestrictions1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Restriction3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (r *Restriction3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Restriction3Choice) SetCode(value string) {
 	r.Code = (*OwnershipLegalRestrictions1Code)(&value)
 }
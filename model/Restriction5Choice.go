@@ -10,6 +10,10 @@ type Restriction5Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *Restriction5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Restriction5Choice) SetCode(value string) {
 	r.Code = (*OwnershipLegalRestrictions1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Restriction6Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *Restriction6Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Restriction6Choice) SetCode(value string) {
 	r.Code = (*OwnershipLegalRestrictions1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RestrictionModification1 struct {
 	Restriction *Restriction1 `xml:"Rstrctn"`
 }
 
+func (r *RestrictionModification1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RestrictionModification1) SetModificationCode(value string) {
 	r.ModificationCode = (*Modification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RestrictionStatus1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RestrictionStatus1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RestrictionStatus1Choice) SetCode(value string) {
 	r.Code = (*RestrictionStatus1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Summation of the call amounts either due to A or due to B.
 type Result1 struct {
 
@@ -13,14 +17,26 @@ type Result1 struct {
 	AdditionalInformation *Max210Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *Result1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Result1) SetDueToPartyA(value, currency string) {
 	r.DueToPartyA = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *Result1) SetDueToPartyAFromDecimal(value decimal.Decimal, currency string) {
+	r.DueToPartyA = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *Result1) SetDueToPartyB(value, currency string) {
 	r.DueToPartyB = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (r *Result1) SetDueToPartyBFromDecimal(value decimal.Decimal, currency string) {
+	r.DueToPartyB = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (r *Result1) SetAdditionalInformation(value string) {
 	r.AdditionalInformation = (*Max210Text)(&value)
 }
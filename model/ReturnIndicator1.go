@@ -16,6 +16,10 @@ type ReturnIndicator1 struct {
 	AdditionalInformation *Max500Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *ReturnIndicator1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReturnIndicator1) AddResponsePeriod() *DateOrDateTimePeriodChoice {
 	r.ResponsePeriod = new(DateOrDateTimePeriodChoice)
 	return r.ResponsePeriod
@@ -10,6 +10,10 @@ type ReturnReason1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (r *ReturnReason1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReturnReason1Choice) SetCode(value string) {
 	r.Code = (*TransactionRejectReason2Code)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the reason for the return of the transaction.
 type ReturnReason5Choice struct {
 
@@ -10,6 +12,10 @@ type ReturnReason5Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (r *ReturnReason5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReturnReason5Choice) SetCode(value string) {
 	r.Code = (*ExternalReturnReason1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (r *ReturnReason5Choice) SetCode(value string) {
 func (r *ReturnReason5Choice) SetProprietary(value string) {
 	r.Proprietary = (*Max35Text)(&value)
 }
+
+func (r *ReturnReason5Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if r.Code != nil {
+		enc.Element("Cd", string(*r.Code))
+	}
+	if r.Proprietary != nil {
+		enc.Element("Prtry", string(*r.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (r *ReturnReason5Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Code = (*ExternalReturnReason1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			r.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -13,6 +13,10 @@ type ReturnReasonInformation1 struct {
 	AdditionalReturnReasonInformation []*Max105Text `xml:"AddtlRtrRsnInf,omitempty"`
 }
 
+func (r *ReturnReasonInformation1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReturnReasonInformation1) AddReturnOriginator() *PartyIdentification8 {
 	r.ReturnOriginator = new(PartyIdentification8)
 	return r.ReturnOriginator
@@ -16,6 +16,10 @@ type ReturnReasonInformation10 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *ReturnReasonInformation10) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReturnReasonInformation10) AddOriginalBankTransactionCode() *BankTransactionCodeStructure4 {
 	r.OriginalBankTransactionCode = new(BankTransactionCodeStructure4)
 	return r.OriginalBankTransactionCode
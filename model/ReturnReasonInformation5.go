@@ -16,6 +16,10 @@ type ReturnReasonInformation5 struct {
 	AdditionalReturnReasonInformation []*Max105Text `xml:"AddtlRtrRsnInf,omitempty"`
 }
 
+func (r *ReturnReasonInformation5) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReturnReasonInformation5) AddOriginalBankTransactionCode() *BankTransactionCodeStructure1 {
 	r.OriginalBankTransactionCode = new(BankTransactionCodeStructure1)
 	return r.OriginalBankTransactionCode
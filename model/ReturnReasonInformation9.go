@@ -13,6 +13,10 @@ type ReturnReasonInformation9 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *ReturnReasonInformation9) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReturnReasonInformation9) AddOriginator() *PartyIdentification32 {
 	r.Originator = new(PartyIdentification32)
 	return r.Originator
@@ -10,10 +10,19 @@ type Revaluation2Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (r *Revaluation2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Revaluation2Choice) SetIndicator(value string) {
 	r.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (r *Revaluation2Choice) SetIndicatorFromBool(value bool) {
+	r.Indicator = new(YesNoIndicator)
+	r.Indicator.FromBool(value)
+}
+
 func (r *Revaluation2Choice) AddProprietary() *GenericIdentification38 {
 	r.Proprietary = new(GenericIdentification38)
 	return r.Proprietary
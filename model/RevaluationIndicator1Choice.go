@@ -10,10 +10,19 @@ type RevaluationIndicator1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (r *RevaluationIndicator1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RevaluationIndicator1Choice) SetIndicator(value string) {
 	r.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RevaluationIndicator1Choice) SetIndicatorFromBool(value bool) {
+	r.Indicator = new(YesNoIndicator)
+	r.Indicator.FromBool(value)
+}
+
 func (r *RevaluationIndicator1Choice) AddProprietary() *GenericIdentification20 {
 	r.Proprietary = new(GenericIdentification20)
 	return r.Proprietary
@@ -10,10 +10,19 @@ type RevaluationIndicator3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (r *RevaluationIndicator3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RevaluationIndicator3Choice) SetIndicator(value string) {
 	r.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RevaluationIndicator3Choice) SetIndicatorFromBool(value bool) {
+	r.Indicator = new(YesNoIndicator)
+	r.Indicator.FromBool(value)
+}
+
 func (r *RevaluationIndicator3Choice) AddProprietary() *GenericIdentification30 {
 	r.Proprietary = new(GenericIdentification30)
 	return r.Proprietary
@@ -10,10 +10,19 @@ type RevaluationIndicator4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RevaluationIndicator4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RevaluationIndicator4Choice) SetIndicator(value string) {
 	r.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (r *RevaluationIndicator4Choice) SetIndicatorFromBool(value bool) {
+	r.Indicator = new(YesNoIndicator)
+	r.Indicator.FromBool(value)
+}
+
 func (r *RevaluationIndicator4Choice) AddProprietary() *GenericIdentification47 {
 	r.Proprietary = new(GenericIdentification47)
 	return r.Proprietary
@@ -10,6 +10,10 @@ type Reversal1Choice struct {
 	TransferOutConfirmationDetails *TransferOut10 `xml:"TrfOutConfDtls"`
 }
 
+func (r *Reversal1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reversal1Choice) AddReference() *TransferReference2 {
 	r.Reference = new(TransferReference2)
 	return r.Reference
@@ -10,6 +10,10 @@ type Reversal2Choice struct {
 	TransferInConfirmationDetails *TransferIn8 `xml:"TrfInConfDtls"`
 }
 
+func (r *Reversal2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reversal2Choice) AddReference() *TransferReference2 {
 	r.Reference = new(TransferReference2)
 	return r.Reference
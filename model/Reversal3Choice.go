@@ -10,6 +10,10 @@ type Reversal3Choice struct {
 	TransferOutConfirmationDetails *TransferOut12 `xml:"TrfOutConfDtls"`
 }
 
+func (r *Reversal3Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reversal3Choice) AddReferences() *TransferReference6 {
 	newValue := new(TransferReference6)
 	r.References = append(r.References, newValue)
@@ -10,6 +10,10 @@ type Reversal4Choice struct {
 	TransferInConfirmationDetails *TransferIn9 `xml:"TrfInConfDtls"`
 }
 
+func (r *Reversal4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reversal4Choice) AddReference() *TransferReference6 {
 	r.Reference = new(TransferReference6)
 	return r.Reference
@@ -10,6 +10,10 @@ type Reversal5Choice struct {
 	TransferOutConfirmationDetails *TransferOut14 `xml:"TrfOutConfDtls"`
 }
 
+func (r *Reversal5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reversal5Choice) AddReferences() *TransferReference6 {
 	newValue := new(TransferReference6)
 	r.References = append(r.References, newValue)
@@ -10,6 +10,10 @@ type Reversal6Choice struct {
 	TransferInConfirmationDetails *TransferIn12 `xml:"TrfInConfDtls"`
 }
 
+func (r *Reversal6Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reversal6Choice) AddReference() *TransferReference6 {
 	r.Reference = new(TransferReference6)
 	return r.Reference
@@ -10,6 +10,10 @@ type Reversal7Choice struct {
 	TransferInConfirmationDetails *TransferIn14 `xml:"TrfInConfDtls"`
 }
 
+func (r *Reversal7Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reversal7Choice) AddReference() *TransferReference10 {
 	r.Reference = new(TransferReference10)
 	return r.Reference
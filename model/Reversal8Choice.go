@@ -10,6 +10,10 @@ type Reversal8Choice struct {
 	TransferOutConfirmationDetails *TransferOut16 `xml:"TrfOutConfDtls"`
 }
 
+func (r *Reversal8Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reversal8Choice) AddReferences() *TransferReference10 {
 	newValue := new(TransferReference10)
 	r.References = append(r.References, newValue)
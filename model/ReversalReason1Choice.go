@@ -10,6 +10,10 @@ type ReversalReason1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (r *ReversalReason1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReversalReason1Choice) SetCode(value string) {
 	r.Code = (*TransactionReversalReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type ReversalReason4Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (r *ReversalReason4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReversalReason4Choice) SetCode(value string) {
 	r.Code = (*ExternalReversalReason1Code)(&value)
 }
@@ -13,6 +13,10 @@ type ReversalReasonInformation1 struct {
 	AdditionalReversalReasonInformation []*Max105Text `xml:"AddtlRvslRsnInf,omitempty"`
 }
 
+func (r *ReversalReasonInformation1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReversalReasonInformation1) AddReversalOriginator() *PartyIdentification8 {
 	r.ReversalOriginator = new(PartyIdentification8)
 	return r.ReversalOriginator
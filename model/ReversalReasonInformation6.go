@@ -13,6 +13,10 @@ type ReversalReasonInformation6 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (r *ReversalReasonInformation6) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReversalReasonInformation6) AddOriginator() *PartyIdentification32 {
 	r.Originator = new(PartyIdentification32)
 	return r.Originator
@@ -13,6 +13,10 @@ type ReversedStatus1 struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (r *ReversedStatus1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReversedStatus1) SetReason(value string) {
 	r.Reason = (*Max350Text)(&value)
 }
@@ -22,6 +26,10 @@ func (r *ReversedStatus1) AddDataSourceScheme() *GenericIdentification1 {
 	return r.DataSourceScheme
 }
 
-func (r *ReversedStatus1) SetNoSpecifiedReason(value string) {
+func (r *ReversedStatus1) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
@@ -13,6 +13,10 @@ type ReversedStatus2Choice struct {
 	NoSpecifiedReason *NoReasonCode `xml:"NoSpcfdRsn"`
 }
 
+func (r *ReversedStatus2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *ReversedStatus2Choice) SetReason(value string) {
 	r.Reason = (*Max350Text)(&value)
 }
@@ -22,6 +26,10 @@ func (r *ReversedStatus2Choice) AddDataSourceScheme() *GenericIdentification1 {
 	return r.DataSourceScheme
 }
 
-func (r *ReversedStatus2Choice) SetNoSpecifiedReason(value string) {
+func (r *ReversedStatus2Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	r.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
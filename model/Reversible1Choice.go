@@ -10,6 +10,10 @@ type Reversible1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (r *Reversible1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Reversible1Choice) SetCode(value string) {
 	r.Code = (*Reversible1Code)(&value)
 }
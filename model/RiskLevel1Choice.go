@@ -10,6 +10,10 @@ type RiskLevel1Choice struct {
 	Proprietary *GenericIdentification41 `xml:"Prtry"`
 }
 
+func (r *RiskLevel1Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RiskLevel1Choice) SetCode(value string) {
 	r.Code = (*RiskLevel1Code)(&value)
 }
@@ -10,6 +10,10 @@ type RiskLevel2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *RiskLevel2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RiskLevel2Choice) SetCode(value string) {
 	r.Code = (*RiskLevel1Code)(&value)
 }
@@ -13,6 +13,10 @@ type Role2Choice struct {
 	Text *Max350Text `xml:"Txt"`
 }
 
+func (r *Role2Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Role2Choice) SetCode(value string) {
 	r.Code = (*InvestmentFundRole2Code)(&value)
 }
@@ -10,6 +10,10 @@ type Role4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (r *Role4Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Role4Choice) SetCode(value string) {
 	r.Code = (*InvestmentFundRole2Code)(&value)
 }
@@ -10,6 +10,10 @@ type Role5Choice struct {
 	Proprietary *GenericIdentification36 `xml:"Prtry"`
 }
 
+func (r *Role5Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Role5Choice) SetCode(value string) {
 	r.Code = (*InvestmentFundRole2Code)(&value)
 }
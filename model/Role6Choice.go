@@ -13,6 +13,10 @@ type Role6Choice struct {
 	Text *Max350Text `xml:"Txt"`
 }
 
+func (r *Role6Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Role6Choice) SetCode(value string) {
 	r.Code = (*InvestmentFundRole2Code)(&value)
 }
@@ -13,6 +13,10 @@ type Role7Choice struct {
 	Text *RestrictedFINXMax350Text `xml:"Txt"`
 }
 
+func (r *Role7Choice) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *Role7Choice) SetCode(value string) {
 	r.Code = (*InvestmentFundRole2Code)(&value)
 }
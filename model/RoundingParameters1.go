@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to a fractional number.
 type RoundingParameters1 struct {
 
@@ -10,10 +14,19 @@ type RoundingParameters1 struct {
 	RoundingDirection *RoundingDirection1Code `xml:"RndgDrctn"`
 }
 
+func (r *RoundingParameters1) Validate() error {
+	return ValidateElement(r)
+}
+
 func (r *RoundingParameters1) SetRoundingModulus(value string) {
 	r.RoundingModulus = (*DecimalNumber)(&value)
 }
 
+func (r *RoundingParameters1) SetRoundingModulusFromDecimal(value decimal.Decimal) {
+	r.RoundingModulus = new(DecimalNumber)
+	r.RoundingModulus.FromDecimal(value)
+}
+
 func (r *RoundingParameters1) SetRoundingDirection(value string) {
 	r.RoundingDirection = (*RoundingDirection1Code)(&value)
 }
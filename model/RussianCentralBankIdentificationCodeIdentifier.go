@@ -1,3 +1,9 @@
 package model
 
 type RussianCentralBankIdentificationCodeIdentifier string
+
+var russianCentralBankIdentificationCodeIdentifierPattern = newPattern(`RU[0-9]{9,9}`)
+
+func (r RussianCentralBankIdentificationCodeIdentifier) Validate() error {
+	return validatePattern("RussianCentralBankIdentificationCodeIdentifier", string(r), russianCentralBankIdentificationCodeIdentifierPattern)
+}
@@ -1,3 +1,7 @@
 package model
 
 type SEDOLIdentifier string
+
+func (s SEDOLIdentifier) Validate() error {
+	return validateLength("SEDOLIdentifier", string(s), 1, 35)
+}
@@ -10,6 +10,10 @@ type SafeKeepingPlace1 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (s *SafeKeepingPlace1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafeKeepingPlace1) AddSafekeepingPlaceFormat() *SafekeepingPlaceFormat10Choice {
 	s.SafekeepingPlaceFormat = new(SafekeepingPlaceFormat10Choice)
 	return s.SafekeepingPlaceFormat
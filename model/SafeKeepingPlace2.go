@@ -10,6 +10,10 @@ type SafeKeepingPlace2 struct {
 	LEI *LEIIdentifier `xml:"LEI,omitempty"`
 }
 
+func (s *SafeKeepingPlace2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafeKeepingPlace2) AddSafekeepingPlaceFormat() *SafekeepingPlaceFormat17Choice {
 	s.SafekeepingPlaceFormat = new(SafekeepingPlaceFormat17Choice)
 	return s.SafekeepingPlaceFormat
@@ -25,6 +25,10 @@ type SafekeepingAccount1 struct {
 	AccountServicer *PartyIdentification1Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (s *SafekeepingAccount1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingAccount1) AddIdentification() *AccountIdentificationFormatChoice {
 	s.Identification = new(AccountIdentificationFormatChoice)
 	return s.Identification
@@ -34,6 +38,11 @@ func (s *SafekeepingAccount1) SetFungibleIndicator(value string) {
 	s.FungibleIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SafekeepingAccount1) SetFungibleIndicatorFromBool(value bool) {
+	s.FungibleIndicator = new(YesNoIndicator)
+	s.FungibleIndicator.FromBool(value)
+}
+
 func (s *SafekeepingAccount1) SetName(value string) {
 	s.Name = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type SafekeepingAccount2 struct {
 	AccountServicer *PartyIdentification2Choice `xml:"AcctSvcr,omitempty"`
 }
 
+func (s *SafekeepingAccount2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingAccount2) AddIdentification() *AccountIdentificationFormatChoice {
 	s.Identification = new(AccountIdentificationFormatChoice)
 	return s.Identification
@@ -34,6 +38,11 @@ func (s *SafekeepingAccount2) SetFungibleIndicator(value string) {
 	s.FungibleIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SafekeepingAccount2) SetFungibleIndicatorFromBool(value bool) {
+	s.FungibleIndicator = new(YesNoIndicator)
+	s.FungibleIndicator.FromBool(value)
+}
+
 func (s *SafekeepingAccount2) SetName(value string) {
 	s.Name = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type SafekeepingAccount3 struct {
 	RightsHolder []*PartyIdentification9Choice `xml:"RghtsHldr,omitempty"`
 }
 
+func (s *SafekeepingAccount3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingAccount3) SetAccountIdentification(value string) {
 	s.AccountIdentification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type SafekeepingAccount4 struct {
 	RightsHolder []*PartyIdentification9Choice `xml:"RghtsHldr,omitempty"`
 }
 
+func (s *SafekeepingAccount4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingAccount4) SetAccountIdentification(value string) {
 	s.AccountIdentification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type SafekeepingAccount6 struct {
 	RightsHolder []*PartyIdentification40Choice `xml:"RghtsHldr,omitempty"`
 }
 
+func (s *SafekeepingAccount6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingAccount6) SetAccountIdentification(value string) {
 	s.AccountIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type SafekeepingAccount7 struct {
 	AccountSubLevel1Difference []*AggregateHoldingBalance2 `xml:"AcctSubLvl1Diff,omitempty"`
 }
 
+func (s *SafekeepingAccount7) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingAccount7) AddAccountIdentification() *SecuritiesAccount19 {
 	s.AccountIdentification = new(SecuritiesAccount19)
 	return s.AccountIdentification
@@ -13,6 +13,10 @@ type SafekeepingPlaceAsCodeAndPartyIdentification struct {
 	Party *PartyIdentification3 `xml:"Pty,omitempty"`
 }
 
+func (s *SafekeepingPlaceAsCodeAndPartyIdentification) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceAsCodeAndPartyIdentification) SetPlaceSafekeeping(value string) {
 	s.PlaceSafekeeping = (*SafekeepingPlace1Code)(&value)
 }
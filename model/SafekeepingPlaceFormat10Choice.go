@@ -16,6 +16,10 @@ type SafekeepingPlaceFormat10Choice struct {
 	Proprietary *GenericIdentification78 `xml:"Prtry"`
 }
 
+func (s *SafekeepingPlaceFormat10Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceFormat10Choice) AddIdentification() *SafekeepingPlaceTypeAndText8 {
 	s.Identification = new(SafekeepingPlaceTypeAndText8)
 	return s.Identification
@@ -16,6 +16,10 @@ type SafekeepingPlaceFormat11Choice struct {
 	Proprietary *GenericIdentification85 `xml:"Prtry"`
 }
 
+func (s *SafekeepingPlaceFormat11Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceFormat11Choice) AddIdentification() *SafekeepingPlaceTypeAndText9 {
 	s.Identification = new(SafekeepingPlaceTypeAndText9)
 	return s.Identification
@@ -16,6 +16,10 @@ type SafekeepingPlaceFormat17Choice struct {
 	Proprietary *GenericIdentification85 `xml:"Prtry"`
 }
 
+func (s *SafekeepingPlaceFormat17Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceFormat17Choice) AddIdentification() *SafekeepingPlaceTypeAndText15 {
 	s.Identification = new(SafekeepingPlaceTypeAndText15)
 	return s.Identification
@@ -16,6 +16,10 @@ type SafekeepingPlaceFormat26Choice struct {
 	Proprietary *GenericIdentification89 `xml:"Prtry"`
 }
 
+func (s *SafekeepingPlaceFormat26Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceFormat26Choice) AddIdentification() *SafekeepingPlaceTypeAndText25 {
 	s.Identification = new(SafekeepingPlaceTypeAndText25)
 	return s.Identification
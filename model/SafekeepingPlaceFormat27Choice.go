@@ -16,6 +16,10 @@ type SafekeepingPlaceFormat27Choice struct {
 	Proprietary *GenericIdentification89 `xml:"Prtry"`
 }
 
+func (s *SafekeepingPlaceFormat27Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceFormat27Choice) AddIdentification() *SafekeepingPlaceTypeAndText9 {
 	s.Identification = new(SafekeepingPlaceTypeAndText9)
 	return s.Identification
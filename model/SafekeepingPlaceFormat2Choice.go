@@ -16,6 +16,10 @@ type SafekeepingPlaceFormat2Choice struct {
 	Proprietary *GenericIdentification21 `xml:"Prtry"`
 }
 
+func (s *SafekeepingPlaceFormat2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceFormat2Choice) AddIdentification() *SafekeepingPlaceTypeAndText2 {
 	s.Identification = new(SafekeepingPlaceTypeAndText2)
 	return s.Identification
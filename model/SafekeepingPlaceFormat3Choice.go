@@ -16,6 +16,10 @@ type SafekeepingPlaceFormat3Choice struct {
 	Proprietary *GenericIdentification21 `xml:"Prtry"`
 }
 
+func (s *SafekeepingPlaceFormat3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceFormat3Choice) AddIdentification() *SafekeepingPlaceTypeAndText3 {
 	s.Identification = new(SafekeepingPlaceTypeAndText3)
 	return s.Identification
@@ -16,6 +16,10 @@ type SafekeepingPlaceFormat7Choice struct {
 	Proprietary *GenericIdentification58 `xml:"Prtry"`
 }
 
+func (s *SafekeepingPlaceFormat7Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceFormat7Choice) AddIdentification() *SafekeepingPlaceTypeAndText1 {
 	s.Identification = new(SafekeepingPlaceTypeAndText1)
 	return s.Identification
@@ -16,6 +16,10 @@ type SafekeepingPlaceFormat8Choice struct {
 	Proprietary *GenericIdentification78 `xml:"Prtry"`
 }
 
+func (s *SafekeepingPlaceFormat8Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceFormat8Choice) AddIdentification() *SafekeepingPlaceTypeAndText6 {
 	s.Identification = new(SafekeepingPlaceTypeAndText6)
 	return s.Identification
@@ -13,6 +13,10 @@ type SafekeepingPlaceFormatChoice struct {
 	IdentificationAsCountry *CountryCode `xml:"IdAsCtry"`
 }
 
+func (s *SafekeepingPlaceFormatChoice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceFormatChoice) AddIdentification() *SafekeepingPlaceAsCodeAndPartyIdentification {
 	s.Identification = new(SafekeepingPlaceAsCodeAndPartyIdentification)
 	return s.Identification
@@ -10,6 +10,10 @@ type SafekeepingPlaceTypeAndAnyBICIdentifier1 struct {
 	Identification *AnyBICIdentifier `xml:"Id"`
 }
 
+func (s *SafekeepingPlaceTypeAndAnyBICIdentifier1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceTypeAndAnyBICIdentifier1) SetSafekeepingPlaceType(value string) {
 	s.SafekeepingPlaceType = (*SafekeepingPlace1Code)(&value)
 }
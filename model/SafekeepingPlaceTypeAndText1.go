@@ -10,6 +10,10 @@ type SafekeepingPlaceTypeAndText1 struct {
 	Identification *Max35Text `xml:"Id,omitempty"`
 }
 
+func (s *SafekeepingPlaceTypeAndText1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceTypeAndText1) SetSafekeepingPlaceType(value string) {
 	s.SafekeepingPlaceType = (*SafekeepingPlace3Code)(&value)
 }
@@ -10,6 +10,10 @@ type SafekeepingPlaceTypeAndText15 struct {
 	Identification *RestrictedFINXMax30Text `xml:"Id,omitempty"`
 }
 
+func (s *SafekeepingPlaceTypeAndText15) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceTypeAndText15) SetSafekeepingPlaceType(value string) {
 	s.SafekeepingPlaceType = (*SafekeepingPlace3Code)(&value)
 }
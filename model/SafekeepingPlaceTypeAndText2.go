@@ -10,6 +10,10 @@ type SafekeepingPlaceTypeAndText2 struct {
 	Identification *Max35Text `xml:"Id,omitempty"`
 }
 
+func (s *SafekeepingPlaceTypeAndText2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceTypeAndText2) SetSafekeepingPlaceType(value string) {
 	s.SafekeepingPlaceType = (*SafekeepingPlace2Code)(&value)
 }
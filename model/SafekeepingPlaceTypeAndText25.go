@@ -10,6 +10,10 @@ type SafekeepingPlaceTypeAndText25 struct {
 	Identification *RestrictedFINXMax30Text `xml:"Id,omitempty"`
 }
 
+func (s *SafekeepingPlaceTypeAndText25) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceTypeAndText25) SetSafekeepingPlaceType(value string) {
 	s.SafekeepingPlaceType = (*SafekeepingPlace2Code)(&value)
 }
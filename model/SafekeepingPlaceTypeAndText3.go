@@ -10,6 +10,10 @@ type SafekeepingPlaceTypeAndText3 struct {
 	Identification *Max35Text `xml:"Id,omitempty"`
 }
 
+func (s *SafekeepingPlaceTypeAndText3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceTypeAndText3) SetSafekeepingPlaceType(value string) {
 	s.SafekeepingPlaceType = (*SafekeepingPlace3Code)(&value)
 }
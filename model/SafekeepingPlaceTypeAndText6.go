@@ -10,6 +10,10 @@ type SafekeepingPlaceTypeAndText6 struct {
 	Identification *Max35Text `xml:"Id,omitempty"`
 }
 
+func (s *SafekeepingPlaceTypeAndText6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceTypeAndText6) SetSafekeepingPlaceType(value string) {
 	s.SafekeepingPlaceType = (*SafekeepingPlace2Code)(&value)
 }
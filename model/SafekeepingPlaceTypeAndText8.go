@@ -10,6 +10,10 @@ type SafekeepingPlaceTypeAndText8 struct {
 	Identification *Max35Text `xml:"Id,omitempty"`
 }
 
+func (s *SafekeepingPlaceTypeAndText8) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceTypeAndText8) SetSafekeepingPlaceType(value string) {
 	s.SafekeepingPlaceType = (*SafekeepingPlace3Code)(&value)
 }
@@ -10,6 +10,10 @@ type SafekeepingPlaceTypeAndText9 struct {
 	Identification *RestrictedFINXMax30Text `xml:"Id,omitempty"`
 }
 
+func (s *SafekeepingPlaceTypeAndText9) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SafekeepingPlaceTypeAndText9) SetSafekeepingPlaceType(value string) {
 	s.SafekeepingPlaceType = (*SafekeepingPlace2Code)(&value)
 }
@@ -22,6 +22,10 @@ type SaleContext1 struct {
 	AdditionalSaleData *Max70Text `xml:"AddtlSaleData,omitempty"`
 }
 
+func (s *SaleContext1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SaleContext1) SetSaleIdentification(value string) {
 	s.SaleIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Sale context in which the transaction is performed.
 type SaleContext2 struct {
 
@@ -40,6 +44,10 @@ type SaleContext2 struct {
 	AdditionalSaleData *Max70Text `xml:"AddtlSaleData,omitempty"`
 }
 
+func (s *SaleContext2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SaleContext2) SetSaleIdentification(value string) {
 	s.SaleIdentification = (*Max35Text)(&value)
 }
@@ -222,10 +230,19 @@ func (s *SaleContext2) SetSplitPayment(value string) {
 	s.SplitPayment = (*TrueFalseIndicator)(&value)
 }
 
+func (s *SaleContext2) SetSplitPaymentFromBool(value bool) {
+	s.SplitPayment = new(TrueFalseIndicator)
+	s.SplitPayment.FromBool(value)
+}
+
 func (s *SaleContext2) SetRemainingAmount(value, currency string) {
 	s.RemainingAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (s *SaleContext2) SetRemainingAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.RemainingAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SaleContext2) SetAdditionalSaleData(value string) {
 	s.AdditionalSaleData = (*Max70Text)(&value)
 }
@@ -16,6 +16,10 @@ type SearchCriteria1Choice struct {
 	OriginalTransactionNumber []*RequestType1 `xml:"OrgnlTxNb"`
 }
 
+func (s *SearchCriteria1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SearchCriteria1Choice) AddAccount() *AccountAndParties1 {
 	s.Account = new(AccountAndParties1)
 	return s.Account
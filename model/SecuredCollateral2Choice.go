@@ -20,6 +20,10 @@ type SecuredCollateral2Choice struct {
 	OtherCollateral []*CollateralValuation7 `xml:"OthrColl"`
 }
 
+func (s *SecuredCollateral2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuredCollateral2Choice) AddSingleCollateral() *CollateralValuation6 {
 	s.SingleCollateral = new(CollateralValuation6)
 	return s.SingleCollateral
@@ -10,6 +10,10 @@ type SecuredMarketReport3Choice struct {
 	Transaction []*SecuredMarketTransaction3 `xml:"Tx"`
 }
 
+func (s *SecuredMarketReport3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuredMarketReport3Choice) SetDataSetAction(value string) {
 	s.DataSetAction = (*ReportPeriodActivity1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the details of each individual secured market transaction.
 type SecuredMarketTransaction3 struct {
 
@@ -78,6 +84,10 @@ type SecuredMarketTransaction3 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuredMarketTransaction3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuredMarketTransaction3) SetReportedTransactionStatus(value string) {
 	s.ReportedTransactionStatus = (*TransactionOperationType1Code)(&value)
 }
@@ -116,10 +126,20 @@ func (s *SecuredMarketTransaction3) SetSettlementDate(value string) {
 	s.SettlementDate = (*ISODate)(&value)
 }
 
+func (s *SecuredMarketTransaction3) SetSettlementDateFromTime(value time.Time) {
+	s.SettlementDate = new(ISODate)
+	s.SettlementDate.FromTime(value)
+}
+
 func (s *SecuredMarketTransaction3) SetMaturityDate(value string) {
 	s.MaturityDate = (*ISODate)(&value)
 }
 
+func (s *SecuredMarketTransaction3) SetMaturityDateFromTime(value time.Time) {
+	s.MaturityDate = new(ISODate)
+	s.MaturityDate.FromTime(value)
+}
+
 func (s *SecuredMarketTransaction3) SetTransactionType(value string) {
 	s.TransactionType = (*MoneyMarketTransactionType1Code)(&value)
 }
@@ -128,6 +148,10 @@ func (s *SecuredMarketTransaction3) SetTransactionNominalAmount(value, currency
 	s.TransactionNominalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SecuredMarketTransaction3) SetTransactionNominalAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TransactionNominalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SecuredMarketTransaction3) SetRateType(value string) {
 	s.RateType = (*InterestRateType1Code)(&value)
 }
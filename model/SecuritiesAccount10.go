@@ -22,8 +22,16 @@ type SecuritiesAccount10 struct {
 	SecurityHoldingForm *FormOfSecurity1Code `xml:"SctyHldgForm,omitempty"`
 }
 
-func (s *SecuritiesAccount10) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesAccount10) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SecuritiesAccount10) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesAccount10) AddAccountOwnerIdentification() *PartyIdentification2Choice {
@@ -16,6 +16,10 @@ type SecuritiesAccount11 struct {
 	Designation *Max35Text `xml:"Dsgnt,omitempty"`
 }
 
+func (s *SecuritiesAccount11) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount11) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
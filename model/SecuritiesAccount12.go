@@ -19,8 +19,16 @@ type SecuritiesAccount12 struct {
 	SecurityHoldingForm *FormOfSecurity1Code `xml:"SctyHldgForm,omitempty"`
 }
 
-func (s *SecuritiesAccount12) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesAccount12) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SecuritiesAccount12) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesAccount12) AddAccountOwnerIdentification() *PartyIdentification2Choice {
@@ -13,6 +13,10 @@ type SecuritiesAccount13 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (s *SecuritiesAccount13) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount13) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type SecuritiesAccount14 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (s *SecuritiesAccount14) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount14) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type SecuritiesAccount18 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (s *SecuritiesAccount18) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount18) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
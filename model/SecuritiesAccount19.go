@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Account to or from which a securities entry is made.
 type SecuritiesAccount19 struct {
 
@@ -13,6 +15,10 @@ type SecuritiesAccount19 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (s *SecuritiesAccount19) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount19) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
@@ -25,3 +31,53 @@ func (s *SecuritiesAccount19) AddType() *GenericIdentification30 {
 func (s *SecuritiesAccount19) SetName(value string) {
 	s.Name = (*Max70Text)(&value)
 }
+
+func (s *SecuritiesAccount19) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if s.Identification != nil {
+		enc.Element("Id", string(*s.Identification))
+	}
+	if s.Type != nil {
+		s.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if s.Name != nil {
+		enc.Element("Nm", string(*s.Name))
+	}
+	enc.End(tag)
+}
+
+func (s *SecuritiesAccount19) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.Identification = (*Max35Text)(&value)
+		case "Tp":
+			elem := new(GenericIdentification30)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.Type = elem
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.Name = (*Max70Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -13,6 +13,10 @@ type SecuritiesAccount20 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (s *SecuritiesAccount20) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount20) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
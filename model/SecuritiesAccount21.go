@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Unambiguous identification for the account between the account owner and the account servicer.
 type SecuritiesAccount21 struct {
 
@@ -19,6 +23,10 @@ type SecuritiesAccount21 struct {
 	ForeignExchangeRate *BaseOneRate `xml:"FXRate,omitempty"`
 }
 
+func (s *SecuritiesAccount21) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount21) AddAccount() *AccountIdentification5 {
 	s.Account = new(AccountIdentification5)
 	return s.Account
@@ -40,3 +48,8 @@ func (s *SecuritiesAccount21) SetReportingCurrency(value string) {
 func (s *SecuritiesAccount21) SetForeignExchangeRate(value string) {
 	s.ForeignExchangeRate = (*BaseOneRate)(&value)
 }
+
+func (s *SecuritiesAccount21) SetForeignExchangeRateFromDecimal(value decimal.Decimal) {
+	s.ForeignExchangeRate = new(BaseOneRate)
+	s.ForeignExchangeRate.FromDecimal(value)
+}
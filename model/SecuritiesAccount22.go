@@ -13,6 +13,10 @@ type SecuritiesAccount22 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (s *SecuritiesAccount22) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount22) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
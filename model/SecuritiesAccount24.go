@@ -13,6 +13,10 @@ type SecuritiesAccount24 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (s *SecuritiesAccount24) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount24) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type SecuritiesAccount25 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (s *SecuritiesAccount25) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount25) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type SecuritiesAccount26 struct {
 	Designation *Max35Text `xml:"Dsgnt,omitempty"`
 }
 
+func (s *SecuritiesAccount26) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount26) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
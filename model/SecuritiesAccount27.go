@@ -13,6 +13,10 @@ type SecuritiesAccount27 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (s *SecuritiesAccount27) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount27) SetIdentification(value string) {
 	s.Identification = (*RestrictedFINXMax35Text)(&value)
 }
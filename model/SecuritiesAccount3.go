@@ -13,6 +13,10 @@ type SecuritiesAccount3 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (s *SecuritiesAccount3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount3) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
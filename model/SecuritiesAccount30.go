@@ -13,6 +13,10 @@ type SecuritiesAccount30 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (s *SecuritiesAccount30) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount30) SetIdentification(value string) {
 	s.Identification = (*RestrictedFINXMax35Text)(&value)
 }
@@ -16,6 +16,10 @@ type SecuritiesAccount33 struct {
 	Designation *Max35Text `xml:"Dsgnt,omitempty"`
 }
 
+func (s *SecuritiesAccount33) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount33) SetIdentification(value string) {
 	s.Identification = (*RestrictedFINXMax35Text)(&value)
 }
@@ -13,6 +13,10 @@ type SecuritiesAccount34 struct {
 	Name *Max70Text `xml:"Nm,omitempty"`
 }
 
+func (s *SecuritiesAccount34) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount34) SetIdentification(value string) {
 	s.Identification = (*RestrictedFINXMax35Text)(&value)
 }
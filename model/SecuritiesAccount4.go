@@ -22,6 +22,10 @@ type SecuritiesAccount4 struct {
 	Status *AccountStatus1Code `xml:"Sts"`
 }
 
+func (s *SecuritiesAccount4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount4) AddIdentification() *AccountIdentification1 {
 	s.Identification = new(AccountIdentification1)
 	return s.Identification
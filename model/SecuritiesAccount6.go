@@ -19,6 +19,10 @@ type SecuritiesAccount6 struct {
 	RegistrationDetails *Max350Text `xml:"RegnDtls,omitempty"`
 }
 
+func (s *SecuritiesAccount6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount6) AddSecurityIdentification() *SecurityIdentification7 {
 	s.SecurityIdentification = new(SecurityIdentification7)
 	return s.SecurityIdentification
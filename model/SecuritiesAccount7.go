@@ -10,6 +10,10 @@ type SecuritiesAccount7 struct {
 	AccountIdentification *Max35Text `xml:"AcctId"`
 }
 
+func (s *SecuritiesAccount7) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesAccount7) AddAccountOwnerIdentification() *PartyIdentification2Choice {
 	s.AccountOwnerIdentification = new(PartyIdentification2Choice)
 	return s.AccountOwnerIdentification
@@ -28,8 +28,16 @@ type SecuritiesAccount8 struct {
 	StampDuty *StampDutyType1FormatChoice `xml:"StmpDty,omitempty"`
 }
 
-func (s *SecuritiesAccount8) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesAccount8) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SecuritiesAccount8) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesAccount8) AddAccountOwnerIdentification() *PartyIdentification2Choice {
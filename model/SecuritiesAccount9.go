@@ -25,8 +25,16 @@ type SecuritiesAccount9 struct {
 	SecurityHoldingForm *FormOfSecurity1Code `xml:"SctyHldgForm,omitempty"`
 }
 
-func (s *SecuritiesAccount9) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesAccount9) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SecuritiesAccount9) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesAccount9) AddAccountOwnerIdentification() *PartyIdentification2Choice {
@@ -10,6 +10,10 @@ type SecuritiesBalanceType10FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (s *SecuritiesBalanceType10FormatChoice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesBalanceType10FormatChoice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType10Code)(&value)
 }
@@ -10,6 +10,10 @@ type SecuritiesBalanceType11Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SecuritiesBalanceType11Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesBalanceType11Choice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType13Code)(&value)
 }
@@ -10,6 +10,10 @@ type SecuritiesBalanceType1Choice struct {
 	Unstructured *Max35Text `xml:"Ustrd"`
 }
 
+func (s *SecuritiesBalanceType1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesBalanceType1Choice) SetStructured(value string) {
 	s.Structured = (*SecuritiesBalanceType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type SecuritiesBalanceType2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SecuritiesBalanceType2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesBalanceType2Choice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType13Code)(&value)
 }
@@ -10,6 +10,10 @@ type SecuritiesBalanceType3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SecuritiesBalanceType3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesBalanceType3Choice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType11Code)(&value)
 }
@@ -10,6 +10,10 @@ type SecuritiesBalanceType6Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SecuritiesBalanceType6Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesBalanceType6Choice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType11Code)(&value)
 }
@@ -10,6 +10,10 @@ type SecuritiesBalanceType6FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (s *SecuritiesBalanceType6FormatChoice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesBalanceType6FormatChoice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType6Code)(&value)
 }
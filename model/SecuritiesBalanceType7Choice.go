@@ -10,6 +10,10 @@ type SecuritiesBalanceType7Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SecuritiesBalanceType7Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesBalanceType7Choice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType13Code)(&value)
 }
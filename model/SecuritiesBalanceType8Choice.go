@@ -10,6 +10,10 @@ type SecuritiesBalanceType8Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SecuritiesBalanceType8Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesBalanceType8Choice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType11Code)(&value)
 }
@@ -10,6 +10,10 @@ type SecuritiesBalanceType9FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (s *SecuritiesBalanceType9FormatChoice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesBalanceType9FormatChoice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType9Code)(&value)
 }
@@ -13,6 +13,10 @@ type SecuritiesCertificate1 struct {
 	SchemeName *Max35Text `xml:"SchmeNm,omitempty"`
 }
 
+func (s *SecuritiesCertificate1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesCertificate1) SetNumber(value string) {
 	s.Number = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type SecuritiesCertificate3 struct {
 	SchemeName *Max35Text `xml:"SchmeNm,omitempty"`
 }
 
+func (s *SecuritiesCertificate3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesCertificate3) SetNumber(value string) {
 	s.Number = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type SecuritiesCertificate4 struct {
 	SchemeName *Max35Text `xml:"SchmeNm,omitempty"`
 }
 
+func (s *SecuritiesCertificate4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesCertificate4) SetNumber(value string) {
 	s.Number = (*Max35Text)(&value)
 }
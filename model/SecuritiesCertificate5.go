@@ -13,6 +13,10 @@ type SecuritiesCertificate5 struct {
 	SchemeName *Max4AlphaNumericText `xml:"SchmeNm,omitempty"`
 }
 
+func (s *SecuritiesCertificate5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesCertificate5) SetNumber(value string) {
 	s.Number = (*RestrictedFINXMax30Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the securities posted as collateral.
 type SecuritiesCollateral2 struct {
 
@@ -46,6 +52,10 @@ type SecuritiesCollateral2 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat7Choice `xml:"SfkpgPlc"`
 }
 
+func (s *SecuritiesCollateral2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesCollateral2) SetAssetNumber(value string) {
 	s.AssetNumber = (*Max35Text)(&value)
 }
@@ -69,6 +79,11 @@ func (s *SecuritiesCollateral2) SetLimitedCoverageIndicator(value string) {
 	s.LimitedCoverageIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesCollateral2) SetLimitedCoverageIndicatorFromBool(value bool) {
+	s.LimitedCoverageIndicator = new(YesNoIndicator)
+	s.LimitedCoverageIndicator.FromBool(value)
+}
+
 func (s *SecuritiesCollateral2) AddQuantity() *FinancialInstrumentQuantity1Choice {
 	s.Quantity = new(FinancialInstrumentQuantity1Choice)
 	return s.Quantity
@@ -88,18 +103,36 @@ func (s *SecuritiesCollateral2) SetMarketValue(value, currency string) {
 	s.MarketValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SecuritiesCollateral2) SetMarketValueFromDecimal(value decimal.Decimal, currency string) {
+	s.MarketValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SecuritiesCollateral2) SetHaircut(value string) {
 	s.Haircut = (*PercentageRate)(&value)
 }
 
+func (s *SecuritiesCollateral2) SetHaircutFromDecimal(value decimal.Decimal) {
+	s.Haircut = new(PercentageRate)
+	s.Haircut.FromDecimal(value)
+}
+
 func (s *SecuritiesCollateral2) SetCollateralValue(value, currency string) {
 	s.CollateralValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SecuritiesCollateral2) SetCollateralValueFromDecimal(value decimal.Decimal, currency string) {
+	s.CollateralValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SecuritiesCollateral2) SetValueDate(value string) {
 	s.ValueDate = (*ISODate)(&value)
 }
 
+func (s *SecuritiesCollateral2) SetValueDateFromTime(value time.Time) {
+	s.ValueDate = new(ISODate)
+	s.ValueDate.FromTime(value)
+}
+
 func (s *SecuritiesCollateral2) AddSafekeepingAccount() *SecuritiesAccount19 {
 	s.SafekeepingAccount = new(SecuritiesAccount19)
 	return s.SafekeepingAccount
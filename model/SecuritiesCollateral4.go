@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the securities posted as collateral.
 type SecuritiesCollateral4 struct {
 
@@ -46,6 +52,10 @@ type SecuritiesCollateral4 struct {
 	SettlementParameters *SettlementDetails88 `xml:"SttlmParams,omitempty"`
 }
 
+func (s *SecuritiesCollateral4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesCollateral4) SetCollateralIdentification(value string) {
 	s.CollateralIdentification = (*Max35Text)(&value)
 }
@@ -68,6 +78,11 @@ func (s *SecuritiesCollateral4) SetLimitedCoverageIndicator(value string) {
 	s.LimitedCoverageIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesCollateral4) SetLimitedCoverageIndicatorFromBool(value bool) {
+	s.LimitedCoverageIndicator = new(YesNoIndicator)
+	s.LimitedCoverageIndicator.FromBool(value)
+}
+
 func (s *SecuritiesCollateral4) AddQuantity() *FinancialInstrumentQuantity1Choice {
 	s.Quantity = new(FinancialInstrumentQuantity1Choice)
 	return s.Quantity
@@ -82,18 +97,36 @@ func (s *SecuritiesCollateral4) SetMarketValue(value, currency string) {
 	s.MarketValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SecuritiesCollateral4) SetMarketValueFromDecimal(value decimal.Decimal, currency string) {
+	s.MarketValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SecuritiesCollateral4) SetHaircut(value string) {
 	s.Haircut = (*PercentageRate)(&value)
 }
 
+func (s *SecuritiesCollateral4) SetHaircutFromDecimal(value decimal.Decimal) {
+	s.Haircut = new(PercentageRate)
+	s.Haircut.FromDecimal(value)
+}
+
 func (s *SecuritiesCollateral4) SetCollateralValue(value, currency string) {
 	s.CollateralValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SecuritiesCollateral4) SetCollateralValueFromDecimal(value decimal.Decimal, currency string) {
+	s.CollateralValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SecuritiesCollateral4) SetValueDate(value string) {
 	s.ValueDate = (*ISODate)(&value)
 }
 
+func (s *SecuritiesCollateral4) SetValueDateFromTime(value time.Time) {
+	s.ValueDate = new(ISODate)
+	s.ValueDate.FromTime(value)
+}
+
 func (s *SecuritiesCollateral4) AddSafekeepingAccount() *SecuritiesAccount19 {
 	s.SafekeepingAccount = new(SecuritiesAccount19)
 	return s.SafekeepingAccount
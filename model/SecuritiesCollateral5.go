@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the securities posted as collateral.
 type SecuritiesCollateral5 struct {
 
@@ -43,6 +49,10 @@ type SecuritiesCollateral5 struct {
 	SettlementParameters *SettlementDetails102 `xml:"SttlmParams,omitempty"`
 }
 
+func (s *SecuritiesCollateral5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesCollateral5) SetCollateralIdentification(value string) {
 	s.CollateralIdentification = (*Max35Text)(&value)
 }
@@ -61,6 +71,11 @@ func (s *SecuritiesCollateral5) SetLimitedCoverageIndicator(value string) {
 	s.LimitedCoverageIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesCollateral5) SetLimitedCoverageIndicatorFromBool(value bool) {
+	s.LimitedCoverageIndicator = new(YesNoIndicator)
+	s.LimitedCoverageIndicator.FromBool(value)
+}
+
 func (s *SecuritiesCollateral5) AddQuantity() *FinancialInstrumentQuantity1Choice {
 	s.Quantity = new(FinancialInstrumentQuantity1Choice)
 	return s.Quantity
@@ -75,18 +90,36 @@ func (s *SecuritiesCollateral5) SetMarketValue(value, currency string) {
 	s.MarketValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SecuritiesCollateral5) SetMarketValueFromDecimal(value decimal.Decimal, currency string) {
+	s.MarketValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SecuritiesCollateral5) SetHaircut(value string) {
 	s.Haircut = (*PercentageRate)(&value)
 }
 
+func (s *SecuritiesCollateral5) SetHaircutFromDecimal(value decimal.Decimal) {
+	s.Haircut = new(PercentageRate)
+	s.Haircut.FromDecimal(value)
+}
+
 func (s *SecuritiesCollateral5) SetCollateralValue(value, currency string) {
 	s.CollateralValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SecuritiesCollateral5) SetCollateralValueFromDecimal(value decimal.Decimal, currency string) {
+	s.CollateralValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SecuritiesCollateral5) SetValueDate(value string) {
 	s.ValueDate = (*ISODate)(&value)
 }
 
+func (s *SecuritiesCollateral5) SetValueDateFromTime(value time.Time) {
+	s.ValueDate = new(ISODate)
+	s.ValueDate.FromTime(value)
+}
+
 func (s *SecuritiesCollateral5) AddSafekeepingAccount() *SecuritiesAccount19 {
 	s.SafekeepingAccount = new(SecuritiesAccount19)
 	return s.SafekeepingAccount
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides details about the securities posted as collateral.
 type SecuritiesCollateral6 struct {
 
@@ -46,6 +52,10 @@ type SecuritiesCollateral6 struct {
 	SafekeepingPlace *SafekeepingPlaceFormat10Choice `xml:"SfkpgPlc"`
 }
 
+func (s *SecuritiesCollateral6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesCollateral6) SetAssetNumber(value string) {
 	s.AssetNumber = (*Max35Text)(&value)
 }
@@ -69,6 +79,11 @@ func (s *SecuritiesCollateral6) SetLimitedCoverageIndicator(value string) {
 	s.LimitedCoverageIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesCollateral6) SetLimitedCoverageIndicatorFromBool(value bool) {
+	s.LimitedCoverageIndicator = new(YesNoIndicator)
+	s.LimitedCoverageIndicator.FromBool(value)
+}
+
 func (s *SecuritiesCollateral6) AddQuantity() *FinancialInstrumentQuantity1Choice {
 	s.Quantity = new(FinancialInstrumentQuantity1Choice)
 	return s.Quantity
@@ -88,18 +103,36 @@ func (s *SecuritiesCollateral6) SetMarketValue(value, currency string) {
 	s.MarketValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SecuritiesCollateral6) SetMarketValueFromDecimal(value decimal.Decimal, currency string) {
+	s.MarketValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SecuritiesCollateral6) SetHaircut(value string) {
 	s.Haircut = (*PercentageRate)(&value)
 }
 
+func (s *SecuritiesCollateral6) SetHaircutFromDecimal(value decimal.Decimal) {
+	s.Haircut = new(PercentageRate)
+	s.Haircut.FromDecimal(value)
+}
+
 func (s *SecuritiesCollateral6) SetCollateralValue(value, currency string) {
 	s.CollateralValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SecuritiesCollateral6) SetCollateralValueFromDecimal(value decimal.Decimal, currency string) {
+	s.CollateralValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SecuritiesCollateral6) SetValueDate(value string) {
 	s.ValueDate = (*ISODate)(&value)
 }
 
+func (s *SecuritiesCollateral6) SetValueDateFromTime(value time.Time) {
+	s.ValueDate = new(ISODate)
+	s.ValueDate.FromTime(value)
+}
+
 func (s *SecuritiesCollateral6) AddSafekeepingAccount() *SecuritiesAccount19 {
 	s.SafekeepingAccount = new(SecuritiesAccount19)
 	return s.SafekeepingAccount
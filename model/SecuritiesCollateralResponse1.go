@@ -19,6 +19,10 @@ type SecuritiesCollateralResponse1 struct {
 	RejectionInformation *Max35Text `xml:"RjctnInf,omitempty"`
 }
 
+func (s *SecuritiesCollateralResponse1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesCollateralResponse1) SetCollateralIdentification(value string) {
 	s.CollateralIdentification = (*Max35Text)(&value)
 }
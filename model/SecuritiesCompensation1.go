@@ -13,6 +13,10 @@ type SecuritiesCompensation1 struct {
 	Fees *AmountAndDirection20 `xml:"Fees,omitempty"`
 }
 
+func (s *SecuritiesCompensation1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesCompensation1) AddDepository() *PartyIdentification34Choice {
 	s.Depository = new(PartyIdentification34Choice)
 	return s.Depository
@@ -10,6 +10,10 @@ type SecuritiesEntitlement1 struct {
 	EntitledSecuritiesQuantity *UnitOrFaceAmount1Choice `xml:"EntitldSctiesQty"`
 }
 
+func (s *SecuritiesEntitlement1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesEntitlement1) AddSecurityIdentification() *SecurityIdentification7 {
 	s.SecurityIdentification = new(SecurityIdentification7)
 	return s.SecurityIdentification
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the closing of the securities financing transaction.
 type SecuritiesFinancing10 struct {
 
@@ -124,10 +130,19 @@ type SecuritiesFinancing10 struct {
 	ExCoupon *YesNoIndicator `xml:"ExCpn,omitempty"`
 }
 
+func (s *SecuritiesFinancing10) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesFinancing10) SetRateChangeDate(value string) {
 	s.RateChangeDate = (*ISODateTime)(&value)
 }
 
+func (s *SecuritiesFinancing10) SetRateChangeDateFromTime(value time.Time) {
+	s.RateChangeDate = new(ISODateTime)
+	s.RateChangeDate.FromTime(value)
+}
+
 func (s *SecuritiesFinancing10) AddRateType() *RateType19Choice {
 	s.RateType = new(RateType19Choice)
 	return s.RateType
@@ -181,6 +196,11 @@ func (s *SecuritiesFinancing10) SetCallableTradeIndicator(value string) {
 	s.CallableTradeIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesFinancing10) SetCallableTradeIndicatorFromBool(value bool) {
+	s.CallableTradeIndicator = new(YesNoIndicator)
+	s.CallableTradeIndicator.FromBool(value)
+}
+
 func (s *SecuritiesFinancing10) SetTransactionCallDelay(value string) {
 	s.TransactionCallDelay = (*Max3NumericText)(&value)
 }
@@ -194,6 +214,11 @@ func (s *SecuritiesFinancing10) SetAccruedInterestPercentage(value string) {
 	s.AccruedInterestPercentage = (*PercentageRate)(&value)
 }
 
+func (s *SecuritiesFinancing10) SetAccruedInterestPercentageFromDecimal(value decimal.Decimal) {
+	s.AccruedInterestPercentage = new(PercentageRate)
+	s.AccruedInterestPercentage.FromDecimal(value)
+}
+
 func (s *SecuritiesFinancing10) AddForfeitAmount() *AmountAndDirection5 {
 	s.ForfeitAmount = new(AmountAndDirection5)
 	return s.ForfeitAmount
@@ -227,6 +252,11 @@ func (s *SecuritiesFinancing10) SetLendingWithCollateral(value string) {
 	s.LendingWithCollateral = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesFinancing10) SetLendingWithCollateralFromBool(value bool) {
+	s.LendingWithCollateral = new(YesNoIndicator)
+	s.LendingWithCollateral.FromBool(value)
+}
+
 func (s *SecuritiesFinancing10) AddBorrowingReason() *BorrowingReason1Choice {
 	s.BorrowingReason = new(BorrowingReason1Choice)
 	return s.BorrowingReason
@@ -241,6 +271,11 @@ func (s *SecuritiesFinancing10) SetContractTermsModificationChanged(value string
 	s.ContractTermsModificationChanged = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesFinancing10) SetContractTermsModificationChangedFromBool(value bool) {
+	s.ContractTermsModificationChanged = new(YesNoIndicator)
+	s.ContractTermsModificationChanged.FromBool(value)
+}
+
 func (s *SecuritiesFinancing10) AddInterestRate() *Rate2 {
 	s.InterestRate = new(Rate2)
 	return s.InterestRate
@@ -275,14 +310,29 @@ func (s *SecuritiesFinancing10) SetAccruedInterestTax(value string) {
 	s.AccruedInterestTax = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesFinancing10) SetAccruedInterestTaxFromBool(value bool) {
+	s.AccruedInterestTax = new(YesNoIndicator)
+	s.AccruedInterestTax.FromBool(value)
+}
+
 func (s *SecuritiesFinancing10) SetEndNumberOfDaysAccrued(value string) {
 	s.EndNumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesFinancing10) SetEndNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.EndNumberOfDaysAccrued = new(Max3Number)
+	s.EndNumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesFinancing10) SetEndFactor(value string) {
 	s.EndFactor = (*BaseOneRate)(&value)
 }
 
+func (s *SecuritiesFinancing10) SetEndFactorFromDecimal(value decimal.Decimal) {
+	s.EndFactor = new(BaseOneRate)
+	s.EndFactor.FromDecimal(value)
+}
+
 func (s *SecuritiesFinancing10) AddSecuritiesLendingType() *SecuritiesLendingType1Choice {
 	s.SecuritiesLendingType = new(SecuritiesLendingType1Choice)
 	return s.SecuritiesLendingType
@@ -297,14 +347,34 @@ func (s *SecuritiesFinancing10) SetMinimumDateForCallBack(value string) {
 	s.MinimumDateForCallBack = (*ISODate)(&value)
 }
 
+func (s *SecuritiesFinancing10) SetMinimumDateForCallBackFromTime(value time.Time) {
+	s.MinimumDateForCallBack = new(ISODate)
+	s.MinimumDateForCallBack.FromTime(value)
+}
+
 func (s *SecuritiesFinancing10) SetRollOver(value string) {
 	s.RollOver = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesFinancing10) SetRollOverFromBool(value bool) {
+	s.RollOver = new(YesNoIndicator)
+	s.RollOver.FromBool(value)
+}
+
 func (s *SecuritiesFinancing10) SetPeriodicPayment(value string) {
 	s.PeriodicPayment = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesFinancing10) SetPeriodicPaymentFromBool(value bool) {
+	s.PeriodicPayment = new(YesNoIndicator)
+	s.PeriodicPayment.FromBool(value)
+}
+
 func (s *SecuritiesFinancing10) SetExCoupon(value string) {
 	s.ExCoupon = (*YesNoIndicator)(&value)
 }
+
+func (s *SecuritiesFinancing10) SetExCouponFromBool(value bool) {
+	s.ExCoupon = new(YesNoIndicator)
+	s.ExCoupon.FromBool(value)
+}
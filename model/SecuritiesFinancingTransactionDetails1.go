@@ -80,6 +80,10 @@ type SecuritiesFinancingTransactionDetails1 struct {
 	SecondLegNarrative *Max140Text `xml:"ScndLegNrrtv,omitempty"`
 }
 
+func (s *SecuritiesFinancingTransactionDetails1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesFinancingTransactionDetails1) SetSecuritiesFinancingTradeIdentification(value string) {
 	s.SecuritiesFinancingTradeIdentification = (*Max35Text)(&value)
 }
@@ -122,10 +126,20 @@ func (s *SecuritiesFinancingTransactionDetails1) SetMaturityDateModification(val
 	s.MaturityDateModification = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesFinancingTransactionDetails1) SetMaturityDateModificationFromBool(value bool) {
+	s.MaturityDateModification = new(YesNoIndicator)
+	s.MaturityDateModification.FromBool(value)
+}
+
 func (s *SecuritiesFinancingTransactionDetails1) SetInterestPayment(value string) {
 	s.InterestPayment = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesFinancingTransactionDetails1) SetInterestPaymentFromBool(value bool) {
+	s.InterestPayment = new(YesNoIndicator)
+	s.InterestPayment.FromBool(value)
+}
+
 func (s *SecuritiesFinancingTransactionDetails1) AddVariableRateSupport() *RateName1 {
 	s.VariableRateSupport = new(RateName1)
 	return s.VariableRateSupport
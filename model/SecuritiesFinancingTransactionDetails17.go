@@ -113,6 +113,10 @@ type SecuritiesFinancingTransactionDetails17 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesFinancingTransactionDetails17) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesFinancingTransactionDetails17) SetSecuritiesFinancingTradeIdentification(value string) {
 	s.SecuritiesFinancingTradeIdentification = (*Max35Text)(&value)
 }
@@ -216,12 +220,20 @@ func (s *SecuritiesFinancingTransactionDetails17) SetSecuritiesFinancingTransact
 	s.SecuritiesFinancingTransactionType = (*SecuritiesFinancingTransactionType1Code)(&value)
 }
 
-func (s *SecuritiesFinancingTransactionDetails17) SetSecuritiesMovementType(value string) {
+func (s *SecuritiesFinancingTransactionDetails17) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SecuritiesFinancingTransactionDetails17) SetPayment(value string) {
+func (s *SecuritiesFinancingTransactionDetails17) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SecuritiesFinancingTransactionDetails17) AddSettlementParameters() *SettlementDetails61 {
@@ -92,6 +92,10 @@ type SecuritiesFinancingTransactionDetails19 struct {
 	SecondLegNarrative *Max140Text `xml:"ScndLegNrrtv,omitempty"`
 }
 
+func (s *SecuritiesFinancingTransactionDetails19) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesFinancingTransactionDetails19) SetSecuritiesFinancingTradeIdentification(value string) {
 	s.SecuritiesFinancingTradeIdentification = (*Max35Text)(&value)
 }
@@ -144,10 +148,20 @@ func (s *SecuritiesFinancingTransactionDetails19) SetMaturityDateModification(va
 	s.MaturityDateModification = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesFinancingTransactionDetails19) SetMaturityDateModificationFromBool(value bool) {
+	s.MaturityDateModification = new(YesNoIndicator)
+	s.MaturityDateModification.FromBool(value)
+}
+
 func (s *SecuritiesFinancingTransactionDetails19) SetInterestPayment(value string) {
 	s.InterestPayment = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesFinancingTransactionDetails19) SetInterestPaymentFromBool(value bool) {
+	s.InterestPayment = new(YesNoIndicator)
+	s.InterestPayment.FromBool(value)
+}
+
 func (s *SecuritiesFinancingTransactionDetails19) AddVariableRateSupport() *RateName1 {
 	s.VariableRateSupport = new(RateName1)
 	return s.VariableRateSupport
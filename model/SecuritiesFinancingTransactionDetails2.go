@@ -113,6 +113,10 @@ type SecuritiesFinancingTransactionDetails2 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesFinancingTransactionDetails2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesFinancingTransactionDetails2) SetSecuritiesFinancingTradeIdentification(value string) {
 	s.SecuritiesFinancingTradeIdentification = (*Max35Text)(&value)
 }
@@ -216,12 +220,20 @@ func (s *SecuritiesFinancingTransactionDetails2) SetSecuritiesFinancingTransacti
 	s.SecuritiesFinancingTransactionType = (*SecuritiesFinancingTransactionType1Code)(&value)
 }
 
-func (s *SecuritiesFinancingTransactionDetails2) SetSecuritiesMovementType(value string) {
+func (s *SecuritiesFinancingTransactionDetails2) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SecuritiesFinancingTransactionDetails2) SetPayment(value string) {
+func (s *SecuritiesFinancingTransactionDetails2) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SecuritiesFinancingTransactionDetails2) AddSettlementParameters() *SettlementDetails10 {
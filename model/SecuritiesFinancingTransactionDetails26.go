@@ -113,6 +113,10 @@ type SecuritiesFinancingTransactionDetails26 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesFinancingTransactionDetails26) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesFinancingTransactionDetails26) SetSecuritiesFinancingTradeIdentification(value string) {
 	s.SecuritiesFinancingTradeIdentification = (*Max35Text)(&value)
 }
@@ -216,12 +220,20 @@ func (s *SecuritiesFinancingTransactionDetails26) SetSecuritiesFinancingTransact
 	s.SecuritiesFinancingTransactionType = (*SecuritiesFinancingTransactionType1Code)(&value)
 }
 
-func (s *SecuritiesFinancingTransactionDetails26) SetSecuritiesMovementType(value string) {
+func (s *SecuritiesFinancingTransactionDetails26) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SecuritiesFinancingTransactionDetails26) SetPayment(value string) {
+func (s *SecuritiesFinancingTransactionDetails26) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SecuritiesFinancingTransactionDetails26) AddSettlementParameters() *SettlementDetails98 {
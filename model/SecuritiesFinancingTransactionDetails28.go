@@ -89,6 +89,10 @@ type SecuritiesFinancingTransactionDetails28 struct {
 	SecondLegNarrative *Max140Text `xml:"ScndLegNrrtv,omitempty"`
 }
 
+func (s *SecuritiesFinancingTransactionDetails28) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesFinancingTransactionDetails28) SetSecuritiesFinancingTradeIdentification(value string) {
 	s.SecuritiesFinancingTradeIdentification = (*Max35Text)(&value)
 }
@@ -141,10 +145,20 @@ func (s *SecuritiesFinancingTransactionDetails28) SetMaturityDateModification(va
 	s.MaturityDateModification = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesFinancingTransactionDetails28) SetMaturityDateModificationFromBool(value bool) {
+	s.MaturityDateModification = new(YesNoIndicator)
+	s.MaturityDateModification.FromBool(value)
+}
+
 func (s *SecuritiesFinancingTransactionDetails28) SetInterestPayment(value string) {
 	s.InterestPayment = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesFinancingTransactionDetails28) SetInterestPaymentFromBool(value bool) {
+	s.InterestPayment = new(YesNoIndicator)
+	s.InterestPayment.FromBool(value)
+}
+
 func (s *SecuritiesFinancingTransactionDetails28) AddVariableRateSupport() *RateName1 {
 	s.VariableRateSupport = new(RateName1)
 	return s.VariableRateSupport
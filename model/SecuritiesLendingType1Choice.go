@@ -10,6 +10,10 @@ type SecuritiesLendingType1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (s *SecuritiesLendingType1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesLendingType1Choice) SetCode(value string) {
 	s.Code = (*SecuritiesLendingType1Code)(&value)
 }
@@ -25,6 +25,10 @@ type SecuritiesOption1 struct {
 	BackEndOddLotQuantity *FinancialInstrumentQuantity2Choice `xml:"BckEndOddLotQty,omitempty"`
 }
 
+func (s *SecuritiesOption1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption1) AddMaximumExercisableQuantity() *FinancialInstrumentQuantity1Choice {
 	s.MaximumExercisableQuantity = new(FinancialInstrumentQuantity1Choice)
 	return s.MaximumExercisableQuantity
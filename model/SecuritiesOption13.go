@@ -40,13 +40,21 @@ type SecuritiesOption13 struct {
 	PriceDetails *CorporateActionPrice18 `xml:"PricDtls,omitempty"`
 }
 
+func (s *SecuritiesOption13) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption13) AddSecurityDetails() *FinancialInstrumentAttributes16 {
 	s.SecurityDetails = new(FinancialInstrumentAttributes16)
 	return s.SecurityDetails
 }
 
-func (s *SecuritiesOption13) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption13) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption13) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator1Choice {
@@ -37,13 +37,21 @@ type SecuritiesOption14 struct {
 	PriceDetails *CorporateActionPrice18 `xml:"PricDtls,omitempty"`
 }
 
+func (s *SecuritiesOption14) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption14) AddSecurityDetails() *FinancialInstrumentAttributes16 {
 	s.SecurityDetails = new(FinancialInstrumentAttributes16)
 	return s.SecurityDetails
 }
 
-func (s *SecuritiesOption14) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption14) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption14) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator1Choice {
@@ -25,6 +25,10 @@ type SecuritiesOption15 struct {
 	BackEndOddLotQuantity *FinancialInstrumentQuantity16Choice `xml:"BckEndOddLotQty,omitempty"`
 }
 
+func (s *SecuritiesOption15) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption15) AddMaximumExercisableQuantity() *FinancialInstrumentQuantity1Choice {
 	s.MaximumExercisableQuantity = new(FinancialInstrumentQuantity1Choice)
 	return s.MaximumExercisableQuantity
@@ -40,13 +40,21 @@ type SecuritiesOption18 struct {
 	DeliveringSettlementParties *SettlementParties15 `xml:"DlvrgSttlmPties,omitempty"`
 }
 
+func (s *SecuritiesOption18) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption18) AddSecurityIdentification() *SecurityIdentification14 {
 	s.SecurityIdentification = new(SecurityIdentification14)
 	return s.SecurityIdentification
 }
 
-func (s *SecuritiesOption18) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption18) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption18) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator1Choice {
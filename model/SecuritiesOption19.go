@@ -19,13 +19,21 @@ type SecuritiesOption19 struct {
 	OriginalPostingDate *DateAndDateTimeChoice `xml:"OrgnlPstngDt,omitempty"`
 }
 
+func (s *SecuritiesOption19) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption19) AddSecurityIdentification() *SecurityIdentification14 {
 	s.SecurityIdentification = new(SecurityIdentification14)
 	return s.SecurityIdentification
 }
 
-func (s *SecuritiesOption19) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption19) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption19) AddPostingQuantity() *Quantity6Choice {
@@ -13,6 +13,10 @@ type SecuritiesOption2 struct {
 	InstructedOrQuantityToReceive *InstructedOrQuantityToReceive1Choice `xml:"InstdOrQtyToRcv"`
 }
 
+func (s *SecuritiesOption2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption2) AddConditionalQuantity() *FinancialInstrumentQuantity1Choice {
 	s.ConditionalQuantity = new(FinancialInstrumentQuantity1Choice)
 	return s.ConditionalQuantity
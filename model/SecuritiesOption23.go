@@ -25,6 +25,10 @@ type SecuritiesOption23 struct {
 	BackEndOddLotQuantity *FinancialInstrumentQuantity20Choice `xml:"BckEndOddLotQty,omitempty"`
 }
 
+func (s *SecuritiesOption23) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption23) AddMaximumExercisableQuantity() *FinancialInstrumentQuantity19Choice {
 	s.MaximumExercisableQuantity = new(FinancialInstrumentQuantity19Choice)
 	return s.MaximumExercisableQuantity
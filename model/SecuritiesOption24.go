@@ -43,13 +43,21 @@ type SecuritiesOption24 struct {
 	PriceDetails *CorporateActionPrice26 `xml:"PricDtls,omitempty"`
 }
 
+func (s *SecuritiesOption24) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption24) AddSecurityDetails() *FinancialInstrumentAttributes34 {
 	s.SecurityDetails = new(FinancialInstrumentAttributes34)
 	return s.SecurityDetails
 }
 
-func (s *SecuritiesOption24) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption24) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption24) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator1Choice {
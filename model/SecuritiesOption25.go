@@ -40,13 +40,21 @@ type SecuritiesOption25 struct {
 	PriceDetails *CorporateActionPrice31 `xml:"PricDtls,omitempty"`
 }
 
+func (s *SecuritiesOption25) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption25) AddSecurityDetails() *FinancialInstrumentAttributes34 {
 	s.SecurityDetails = new(FinancialInstrumentAttributes34)
 	return s.SecurityDetails
 }
 
-func (s *SecuritiesOption25) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption25) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption25) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator1Choice {
@@ -40,13 +40,21 @@ type SecuritiesOption26 struct {
 	DeliveringSettlementParties *SettlementParties24 `xml:"DlvrgSttlmPties,omitempty"`
 }
 
+func (s *SecuritiesOption26) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption26) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return s.FinancialInstrumentIdentification
 }
 
-func (s *SecuritiesOption26) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption26) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption26) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator1Choice {
@@ -19,13 +19,21 @@ type SecuritiesOption27 struct {
 	OriginalPostingDate *DateAndDateTimeChoice `xml:"OrgnlPstngDt,omitempty"`
 }
 
+func (s *SecuritiesOption27) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption27) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return s.FinancialInstrumentIdentification
 }
 
-func (s *SecuritiesOption27) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption27) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption27) AddPostingQuantity() *Quantity6Choice {
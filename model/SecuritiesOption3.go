@@ -40,13 +40,21 @@ type SecuritiesOption3 struct {
 	DeliveringSettlementParties *SettlementParties4 `xml:"DlvrgSttlmPties,omitempty"`
 }
 
+func (s *SecuritiesOption3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption3) AddSecurityIdentification() *SecurityIdentification11 {
 	s.SecurityIdentification = new(SecurityIdentification11)
 	return s.SecurityIdentification
 }
 
-func (s *SecuritiesOption3) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption3) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption3) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator1Choice {
@@ -43,13 +43,21 @@ type SecuritiesOption33 struct {
 	PriceDetails *CorporateActionPrice38 `xml:"PricDtls,omitempty"`
 }
 
+func (s *SecuritiesOption33) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption33) AddSecurityDetails() *FinancialInstrumentAttributes34 {
 	s.SecurityDetails = new(FinancialInstrumentAttributes34)
 	return s.SecurityDetails
 }
 
-func (s *SecuritiesOption33) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption33) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption33) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator1Choice {
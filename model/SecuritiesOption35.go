@@ -40,13 +40,21 @@ type SecuritiesOption35 struct {
 	DeliveringSettlementParties *SettlementParties24 `xml:"DlvrgSttlmPties,omitempty"`
 }
 
+func (s *SecuritiesOption35) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption35) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return s.FinancialInstrumentIdentification
 }
 
-func (s *SecuritiesOption35) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption35) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption35) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator1Choice {
@@ -46,13 +46,21 @@ type SecuritiesOption38 struct {
 	PriceDetails *CorporateActionPrice38 `xml:"PricDtls,omitempty"`
 }
 
+func (s *SecuritiesOption38) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption38) AddSecurityDetails() *FinancialInstrumentAttributes34 {
 	s.SecurityDetails = new(FinancialInstrumentAttributes34)
 	return s.SecurityDetails
 }
 
-func (s *SecuritiesOption38) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption38) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption38) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator1Choice {
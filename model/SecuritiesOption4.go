@@ -40,13 +40,21 @@ type SecuritiesOption4 struct {
 	PriceDetails *CorporateActionPrice10 `xml:"PricDtls,omitempty"`
 }
 
+func (s *SecuritiesOption4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption4) AddSecurityDetails() *FinancialInstrumentAttributes5 {
 	s.SecurityDetails = new(FinancialInstrumentAttributes5)
 	return s.SecurityDetails
 }
 
-func (s *SecuritiesOption4) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption4) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption4) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator1Choice {
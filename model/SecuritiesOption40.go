@@ -46,13 +46,21 @@ type SecuritiesOption40 struct {
 	PriceDetails *CorporateActionPrice43 `xml:"PricDtls,omitempty"`
 }
 
+func (s *SecuritiesOption40) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption40) AddSecurityDetails() *FinancialInstrumentAttributes49 {
 	s.SecurityDetails = new(FinancialInstrumentAttributes49)
 	return s.SecurityDetails
 }
 
-func (s *SecuritiesOption40) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption40) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption40) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator1Choice {
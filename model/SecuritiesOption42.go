@@ -43,13 +43,21 @@ type SecuritiesOption42 struct {
 	DeliveringSettlementParties *SettlementParties24 `xml:"DlvrgSttlmPties,omitempty"`
 }
 
+func (s *SecuritiesOption42) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption42) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return s.FinancialInstrumentIdentification
 }
 
-func (s *SecuritiesOption42) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption42) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption42) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator1Choice {
@@ -56,13 +56,21 @@ type SecuritiesOption49 struct {
 	PriceDetails *CorporateActionPrice56 `xml:"PricDtls,omitempty"`
 }
 
+func (s *SecuritiesOption49) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption49) AddSecurityDetails() *FinancialInstrumentAttributes67 {
 	s.SecurityDetails = new(FinancialInstrumentAttributes67)
 	return s.SecurityDetails
 }
 
-func (s *SecuritiesOption49) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption49) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption49) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator3Choice {
@@ -19,13 +19,21 @@ type SecuritiesOption5 struct {
 	OriginalPostingDate *DateAndDateTimeChoice `xml:"OrgnlPstngDt,omitempty"`
 }
 
+func (s *SecuritiesOption5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption5) AddSecurityIdentification() *SecurityIdentification11 {
 	s.SecurityIdentification = new(SecurityIdentification11)
 	return s.SecurityIdentification
 }
 
-func (s *SecuritiesOption5) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption5) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption5) AddPostingQuantity() *Quantity6Choice {
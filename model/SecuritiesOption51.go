@@ -25,6 +25,10 @@ type SecuritiesOption51 struct {
 	BackEndOddLotQuantity *FinancialInstrumentQuantity20Choice `xml:"BckEndOddLotQty,omitempty"`
 }
 
+func (s *SecuritiesOption51) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption51) AddMaximumQuantityToInstruct() *FinancialInstrumentQuantity19Choice {
 	s.MaximumQuantityToInstruct = new(FinancialInstrumentQuantity19Choice)
 	return s.MaximumQuantityToInstruct
@@ -10,6 +10,10 @@ type SecuritiesOption52 struct {
 	InstructedQuantity *Quantity20Choice `xml:"InstdQty"`
 }
 
+func (s *SecuritiesOption52) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption52) AddConditionalQuantity() *FinancialInstrumentQuantity1Choice {
 	s.ConditionalQuantity = new(FinancialInstrumentQuantity1Choice)
 	return s.ConditionalQuantity
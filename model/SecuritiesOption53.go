@@ -19,13 +19,21 @@ type SecuritiesOption53 struct {
 	OriginalPostingDate *DateAndDateTimeChoice `xml:"OrgnlPstngDt,omitempty"`
 }
 
+func (s *SecuritiesOption53) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption53) AddFinancialInstrumentIdentification() *SecurityIdentification19 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification19)
 	return s.FinancialInstrumentIdentification
 }
 
-func (s *SecuritiesOption53) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption53) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption53) AddPostingQuantity() *Quantity6Choice {
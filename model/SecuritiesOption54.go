@@ -10,6 +10,10 @@ type SecuritiesOption54 struct {
 	InstructedQuantity *Quantity40Choice `xml:"InstdQty"`
 }
 
+func (s *SecuritiesOption54) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption54) AddConditionalQuantity() *FinancialInstrumentQuantity15Choice {
 	s.ConditionalQuantity = new(FinancialInstrumentQuantity15Choice)
 	return s.ConditionalQuantity
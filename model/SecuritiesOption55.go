@@ -56,13 +56,21 @@ type SecuritiesOption55 struct {
 	DeliveringSettlementParties *SettlementParties43 `xml:"DlvrgSttlmPties,omitempty"`
 }
 
+func (s *SecuritiesOption55) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption55) AddFinancialInstrumentIdentification() *SecurityIdentification20 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification20)
 	return s.FinancialInstrumentIdentification
 }
 
-func (s *SecuritiesOption55) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption55) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption55) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator4Choice {
@@ -25,6 +25,10 @@ type SecuritiesOption56 struct {
 	BackEndOddLotQuantity *FinancialInstrumentQuantity22Choice `xml:"BckEndOddLotQty,omitempty"`
 }
 
+func (s *SecuritiesOption56) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption56) AddMaximumQuantityToInstruct() *FinancialInstrumentQuantity21Choice {
 	s.MaximumQuantityToInstruct = new(FinancialInstrumentQuantity21Choice)
 	return s.MaximumQuantityToInstruct
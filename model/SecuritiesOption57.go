@@ -56,13 +56,21 @@ type SecuritiesOption57 struct {
 	PriceDetails *CorporateActionPrice66 `xml:"PricDtls,omitempty"`
 }
 
+func (s *SecuritiesOption57) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption57) AddSecurityDetails() *FinancialInstrumentAttributes71 {
 	s.SecurityDetails = new(FinancialInstrumentAttributes71)
 	return s.SecurityDetails
 }
 
-func (s *SecuritiesOption57) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption57) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption57) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator4Choice {
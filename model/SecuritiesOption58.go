@@ -19,13 +19,21 @@ type SecuritiesOption58 struct {
 	OriginalPostingDate *DateAndDateTimeChoice `xml:"OrgnlPstngDt,omitempty"`
 }
 
+func (s *SecuritiesOption58) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption58) AddFinancialInstrumentIdentification() *SecurityIdentification20 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification20)
 	return s.FinancialInstrumentIdentification
 }
 
-func (s *SecuritiesOption58) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption58) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption58) AddPostingQuantity() *Quantity10Choice {
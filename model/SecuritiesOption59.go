@@ -56,13 +56,21 @@ type SecuritiesOption59 struct {
 	PriceDetails *CorporateActionPrice56 `xml:"PricDtls,omitempty"`
 }
 
+func (s *SecuritiesOption59) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption59) AddSecurityDetails() *FinancialInstrumentAttributes80 {
 	s.SecurityDetails = new(FinancialInstrumentAttributes80)
 	return s.SecurityDetails
 }
 
-func (s *SecuritiesOption59) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption59) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption59) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator3Choice {
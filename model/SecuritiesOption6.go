@@ -37,13 +37,21 @@ type SecuritiesOption6 struct {
 	PriceDetails *CorporateActionPrice10 `xml:"PricDtls,omitempty"`
 }
 
+func (s *SecuritiesOption6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption6) AddSecurityDetails() *FinancialInstrumentAttributes5 {
 	s.SecurityDetails = new(FinancialInstrumentAttributes5)
 	return s.SecurityDetails
 }
 
-func (s *SecuritiesOption6) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption6) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption6) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator1Choice {
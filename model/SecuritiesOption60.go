@@ -56,13 +56,21 @@ type SecuritiesOption60 struct {
 	DeliveringSettlementParties *SettlementParties42 `xml:"DlvrgSttlmPties,omitempty"`
 }
 
+func (s *SecuritiesOption60) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption60) AddFinancialInstrumentIdentification() *SecurityIdentification19 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification19)
 	return s.FinancialInstrumentIdentification
 }
 
-func (s *SecuritiesOption60) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption60) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption60) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator3Choice {
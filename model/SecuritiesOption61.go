@@ -56,13 +56,21 @@ type SecuritiesOption61 struct {
 	PriceDetails *CorporateActionPrice66 `xml:"PricDtls,omitempty"`
 }
 
+func (s *SecuritiesOption61) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption61) AddSecurityDetails() *FinancialInstrumentAttributes83 {
 	s.SecurityDetails = new(FinancialInstrumentAttributes83)
 	return s.SecurityDetails
 }
 
-func (s *SecuritiesOption61) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption61) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption61) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator4Choice {
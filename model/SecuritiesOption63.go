@@ -56,13 +56,21 @@ type SecuritiesOption63 struct {
 	DeliveringSettlementParties *SettlementParties61 `xml:"DlvrgSttlmPties,omitempty"`
 }
 
+func (s *SecuritiesOption63) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOption63) AddFinancialInstrumentIdentification() *SecurityIdentification20 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification20)
 	return s.FinancialInstrumentIdentification
 }
 
-func (s *SecuritiesOption63) SetCreditDebitIndicator(value string) {
+func (s *SecuritiesOption63) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecuritiesOption63) AddTemporaryFinancialInstrumentIndicator() *TemporaryFinancialInstrumentIndicator4Choice {
@@ -10,6 +10,10 @@ type SecuritiesOrCash1Choice struct {
 	CashPartiesDetails *CashParties24 `xml:"CshPtiesDtls"`
 }
 
+func (s *SecuritiesOrCash1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesOrCash1Choice) AddSecuritiesDetails() *SettlementParties35 {
 	s.SecuritiesDetails = new(SettlementParties35)
 	return s.SecuritiesDetails
@@ -10,6 +10,10 @@ type SecuritiesPaymentStatus2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SecuritiesPaymentStatus2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesPaymentStatus2Choice) SetCode(value string) {
 	s.Code = (*SecuritiesPaymentStatus1Code)(&value)
 }
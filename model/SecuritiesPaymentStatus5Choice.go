@@ -10,6 +10,10 @@ type SecuritiesPaymentStatus5Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SecuritiesPaymentStatus5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesPaymentStatus5Choice) SetCode(value string) {
 	s.Code = (*SecuritiesPaymentStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type SecuritiesPaymentStatus6Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SecuritiesPaymentStatus6Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesPaymentStatus6Choice) SetCode(value string) {
 	s.Code = (*SecuritiesPaymentStatus1Code)(&value)
 }
@@ -16,6 +16,10 @@ type SecuritiesProceeds1 struct {
 	ReconciliationDetails *Max350Text `xml:"RcncltnDtls,omitempty"`
 }
 
+func (s *SecuritiesProceeds1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesProceeds1) AddSecurityIdentification() *SecurityIdentification7 {
 	s.SecurityIdentification = new(SecurityIdentification7)
 	return s.SecurityIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between securities quantities or an amount.
 type SecuritiesQuantityOrAmount1Choice struct {
 
@@ -10,6 +14,10 @@ type SecuritiesQuantityOrAmount1Choice struct {
 	InstructedAmount *RestrictedFINActiveCurrencyAndAmount `xml:"InstdAmt"`
 }
 
+func (s *SecuritiesQuantityOrAmount1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesQuantityOrAmount1Choice) AddSecuritiesQuantity() *SecuritiesOption54 {
 	s.SecuritiesQuantity = new(SecuritiesOption54)
 	return s.SecuritiesQuantity
@@ -18,3 +26,7 @@ func (s *SecuritiesQuantityOrAmount1Choice) AddSecuritiesQuantity() *SecuritiesO
 func (s *SecuritiesQuantityOrAmount1Choice) SetInstructedAmount(value, currency string) {
 	s.InstructedAmount = NewRestrictedFINActiveCurrencyAndAmount(value, currency)
 }
+
+func (s *SecuritiesQuantityOrAmount1Choice) SetInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.InstructedAmount = NewRestrictedFINActiveCurrencyAndAmount(value.String(), currency)
+}
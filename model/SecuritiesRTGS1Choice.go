@@ -10,10 +10,19 @@ type SecuritiesRTGS1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SecuritiesRTGS1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesRTGS1Choice) SetIndicator(value string) {
 	s.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesRTGS1Choice) SetIndicatorFromBool(value bool) {
+	s.Indicator = new(YesNoIndicator)
+	s.Indicator.FromBool(value)
+}
+
 func (s *SecuritiesRTGS1Choice) AddProprietary() *GenericIdentification20 {
 	s.Proprietary = new(GenericIdentification20)
 	return s.Proprietary
@@ -10,10 +10,19 @@ type SecuritiesRTGS3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (s *SecuritiesRTGS3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesRTGS3Choice) SetIndicator(value string) {
 	s.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesRTGS3Choice) SetIndicatorFromBool(value bool) {
+	s.Indicator = new(YesNoIndicator)
+	s.Indicator.FromBool(value)
+}
+
 func (s *SecuritiesRTGS3Choice) AddProprietary() *GenericIdentification38 {
 	s.Proprietary = new(GenericIdentification38)
 	return s.Proprietary
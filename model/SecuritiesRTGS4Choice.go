@@ -10,10 +10,19 @@ type SecuritiesRTGS4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SecuritiesRTGS4Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesRTGS4Choice) SetIndicator(value string) {
 	s.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesRTGS4Choice) SetIndicatorFromBool(value bool) {
+	s.Indicator = new(YesNoIndicator)
+	s.Indicator.FromBool(value)
+}
+
 func (s *SecuritiesRTGS4Choice) AddProprietary() *GenericIdentification30 {
 	s.Proprietary = new(GenericIdentification30)
 	return s.Proprietary
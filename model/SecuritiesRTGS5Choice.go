@@ -10,10 +10,19 @@ type SecuritiesRTGS5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SecuritiesRTGS5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesRTGS5Choice) SetIndicator(value string) {
 	s.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SecuritiesRTGS5Choice) SetIndicatorFromBool(value bool) {
+	s.Indicator = new(YesNoIndicator)
+	s.Indicator.FromBool(value)
+}
+
 func (s *SecuritiesRTGS5Choice) AddProprietary() *GenericIdentification47 {
 	s.Proprietary = new(GenericIdentification47)
 	return s.Proprietary
@@ -52,6 +52,10 @@ type SecuritiesSettlementTransactionDetails10 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuritiesSettlementTransactionDetails10) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesSettlementTransactionDetails10) AddSettlementTypeAndAdditionalParameters() *SettlementTypeAndAdditionalParameters5 {
 	s.SettlementTypeAndAdditionalParameters = new(SettlementTypeAndAdditionalParameters5)
 	return s.SettlementTypeAndAdditionalParameters
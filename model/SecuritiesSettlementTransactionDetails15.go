@@ -49,6 +49,10 @@ type SecuritiesSettlementTransactionDetails15 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuritiesSettlementTransactionDetails15) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesSettlementTransactionDetails15) AddSettlementTypeAndAdditionalParameters() *SettlementTypeAndAdditionalParameters6 {
 	s.SettlementTypeAndAdditionalParameters = new(SettlementTypeAndAdditionalParameters6)
 	return s.SettlementTypeAndAdditionalParameters
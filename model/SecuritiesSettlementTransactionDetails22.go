@@ -52,6 +52,10 @@ type SecuritiesSettlementTransactionDetails22 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuritiesSettlementTransactionDetails22) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesSettlementTransactionDetails22) AddSettlementTypeAndAdditionalParameters() *SettlementTypeAndAdditionalParameters14 {
 	s.SettlementTypeAndAdditionalParameters = new(SettlementTypeAndAdditionalParameters14)
 	return s.SettlementTypeAndAdditionalParameters
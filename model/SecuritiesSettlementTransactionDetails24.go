@@ -49,6 +49,10 @@ type SecuritiesSettlementTransactionDetails24 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuritiesSettlementTransactionDetails24) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesSettlementTransactionDetails24) AddSettlementTypeAndAdditionalParameters() *SettlementTypeAndAdditionalParameters17 {
 	s.SettlementTypeAndAdditionalParameters = new(SettlementTypeAndAdditionalParameters17)
 	return s.SettlementTypeAndAdditionalParameters
@@ -52,6 +52,10 @@ type SecuritiesSettlementTransactionDetails25 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuritiesSettlementTransactionDetails25) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesSettlementTransactionDetails25) AddSettlementTypeAndAdditionalParameters() *SettlementTypeAndAdditionalParameters18 {
 	s.SettlementTypeAndAdditionalParameters = new(SettlementTypeAndAdditionalParameters18)
 	return s.SettlementTypeAndAdditionalParameters
@@ -49,6 +49,10 @@ type SecuritiesSettlementTransactionDetails27 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuritiesSettlementTransactionDetails27) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesSettlementTransactionDetails27) AddSettlementTypeAndAdditionalParameters() *SettlementTypeAndAdditionalParameters13 {
 	s.SettlementTypeAndAdditionalParameters = new(SettlementTypeAndAdditionalParameters13)
 	return s.SettlementTypeAndAdditionalParameters
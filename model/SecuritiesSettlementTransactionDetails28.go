@@ -52,6 +52,10 @@ type SecuritiesSettlementTransactionDetails28 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuritiesSettlementTransactionDetails28) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesSettlementTransactionDetails28) AddSettlementTypeAndAdditionalParameters() *SettlementTypeAndAdditionalParameters14 {
 	s.SettlementTypeAndAdditionalParameters = new(SettlementTypeAndAdditionalParameters14)
 	return s.SettlementTypeAndAdditionalParameters
@@ -49,6 +49,10 @@ type SecuritiesSettlementTransactionDetails29 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuritiesSettlementTransactionDetails29) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesSettlementTransactionDetails29) AddSettlementTypeAndAdditionalParameters() *SettlementTypeAndAdditionalParameters17 {
 	s.SettlementTypeAndAdditionalParameters = new(SettlementTypeAndAdditionalParameters17)
 	return s.SettlementTypeAndAdditionalParameters
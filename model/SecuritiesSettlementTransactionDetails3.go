@@ -49,6 +49,10 @@ type SecuritiesSettlementTransactionDetails3 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuritiesSettlementTransactionDetails3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesSettlementTransactionDetails3) AddSettlementTypeAndAdditionalParameters() *SettlementTypeAndAdditionalParameters6 {
 	s.SettlementTypeAndAdditionalParameters = new(SettlementTypeAndAdditionalParameters6)
 	return s.SettlementTypeAndAdditionalParameters
@@ -52,6 +52,10 @@ type SecuritiesSettlementTransactionDetails31 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuritiesSettlementTransactionDetails31) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesSettlementTransactionDetails31) AddSettlementTypeAndAdditionalParameters() *SettlementTypeAndAdditionalParameters18 {
 	s.SettlementTypeAndAdditionalParameters = new(SettlementTypeAndAdditionalParameters18)
 	return s.SettlementTypeAndAdditionalParameters
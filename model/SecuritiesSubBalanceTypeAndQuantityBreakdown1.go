@@ -10,6 +10,10 @@ type SecuritiesSubBalanceTypeAndQuantityBreakdown1 struct {
 	QuantityBreakdown []*QuantityBreakdown12 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (s *SecuritiesSubBalanceTypeAndQuantityBreakdown1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesSubBalanceTypeAndQuantityBreakdown1) AddType() *SecuritiesBalanceType3Choice {
 	s.Type = new(SecuritiesBalanceType3Choice)
 	return s.Type
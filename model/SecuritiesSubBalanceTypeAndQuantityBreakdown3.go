@@ -10,6 +10,10 @@ type SecuritiesSubBalanceTypeAndQuantityBreakdown3 struct {
 	QuantityBreakdown []*QuantityBreakdown32 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (s *SecuritiesSubBalanceTypeAndQuantityBreakdown3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesSubBalanceTypeAndQuantityBreakdown3) AddType() *SecuritiesBalanceType6Choice {
 	s.Type = new(SecuritiesBalanceType6Choice)
 	return s.Type
@@ -10,6 +10,10 @@ type SecuritiesSubBalanceTypeAndQuantityBreakdown4 struct {
 	QuantityBreakdown []*QuantityBreakdown34 `xml:"QtyBrkdwn,omitempty"`
 }
 
+func (s *SecuritiesSubBalanceTypeAndQuantityBreakdown4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesSubBalanceTypeAndQuantityBreakdown4) AddType() *SecuritiesBalanceType8Choice {
 	s.Type = new(SecuritiesBalanceType8Choice)
 	return s.Type
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails1 struct {
 
@@ -66,6 +70,10 @@ type SecuritiesTradeDetails1 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails1) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*Max35Text)(&value))
 }
@@ -107,6 +115,11 @@ func (s *SecuritiesTradeDetails1) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails1) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails1) AddOpeningClosing() *OpeningClosing1Choice {
 	s.OpeningClosing = new(OpeningClosing1Choice)
 	return s.OpeningClosing
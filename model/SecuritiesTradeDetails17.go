@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails17 struct {
 
@@ -96,6 +100,10 @@ type SecuritiesTradeDetails17 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails17) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails17) SetAccountOwnerTransactionIdentification(value string) {
 	s.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -128,12 +136,20 @@ func (s *SecuritiesTradeDetails17) AddCollateralTransactionIdentification(value
 	s.CollateralTransactionIdentification = append(s.CollateralTransactionIdentification, (*Max35Text)(&value))
 }
 
-func (s *SecuritiesTradeDetails17) SetSecuritiesMovementType(value string) {
+func (s *SecuritiesTradeDetails17) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SecuritiesTradeDetails17) SetPayment(value string) {
+func (s *SecuritiesTradeDetails17) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SecuritiesTradeDetails17) AddStatus() *AllegementStatus1Choice {
@@ -169,6 +185,11 @@ func (s *SecuritiesTradeDetails17) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails17) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails17) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return s.FinancialInstrumentIdentification
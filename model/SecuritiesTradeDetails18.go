@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails18 struct {
 
@@ -42,6 +46,10 @@ type SecuritiesTradeDetails18 struct {
 	TypeOfPrice *TypeOfPrice3Choice `xml:"TpOfPric,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails18) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails18) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*Max35Text)(&value))
 }
@@ -86,6 +94,11 @@ func (s *SecuritiesTradeDetails18) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails18) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails18) AddTradeTransactionCondition() *TradeTransactionCondition1Choice {
 	newValue := new(TradeTransactionCondition1Choice)
 	s.TradeTransactionCondition = append(s.TradeTransactionCondition, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails2 struct {
 
@@ -57,6 +61,10 @@ type SecuritiesTradeDetails2 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails2) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*Max35Text)(&value))
 }
@@ -98,6 +106,11 @@ func (s *SecuritiesTradeDetails2) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails2) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails2) AddOpeningClosing() *OpeningClosing1Choice {
 	s.OpeningClosing = new(OpeningClosing1Choice)
 	return s.OpeningClosing
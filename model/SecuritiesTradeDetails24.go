@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails24 struct {
 
@@ -61,6 +65,10 @@ type SecuritiesTradeDetails24 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails24) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails24) SetNotificationSenderTransactionIdentification(value string) {
 	s.NotificationSenderTransactionIdentification = (*Max35Text)(&value)
 }
@@ -73,12 +81,20 @@ func (s *SecuritiesTradeDetails24) SetCommonIdentification(value string) {
 	s.CommonIdentification = (*Max35Text)(&value)
 }
 
-func (s *SecuritiesTradeDetails24) SetSecuritiesMovementType(value string) {
+func (s *SecuritiesTradeDetails24) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SecuritiesTradeDetails24) SetPayment(value string) {
+func (s *SecuritiesTradeDetails24) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SecuritiesTradeDetails24) AddTradeDate() *TradeDate1Choice {
@@ -95,6 +111,11 @@ func (s *SecuritiesTradeDetails24) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails24) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails24) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return s.FinancialInstrumentIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails25 struct {
 
@@ -63,6 +67,10 @@ type SecuritiesTradeDetails25 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails25) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails25) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*Max35Text)(&value))
 }
@@ -99,6 +107,11 @@ func (s *SecuritiesTradeDetails25) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails25) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails25) AddOpeningClosing() *OpeningClosing1Choice {
 	s.OpeningClosing = new(OpeningClosing1Choice)
 	return s.OpeningClosing
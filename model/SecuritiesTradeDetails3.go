@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails3 struct {
 
@@ -51,6 +55,10 @@ type SecuritiesTradeDetails3 struct {
 	FXAdditionalDetails *Max350Text `xml:"FxAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails3) AddPlaceOfTrade() *MarketIdentification4 {
 	s.PlaceOfTrade = new(MarketIdentification4)
 	return s.PlaceOfTrade
@@ -79,6 +87,11 @@ func (s *SecuritiesTradeDetails3) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails3) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails3) AddReporting() *Reporting2Choice {
 	newValue := new(Reporting2Choice)
 	s.Reporting = append(s.Reporting, newValue)
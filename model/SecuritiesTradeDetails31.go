@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails31 struct {
 
@@ -57,6 +61,10 @@ type SecuritiesTradeDetails31 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails31) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails31) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*Max35Text)(&value))
 }
@@ -98,6 +106,11 @@ func (s *SecuritiesTradeDetails31) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails31) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails31) AddOpeningClosing() *OpeningClosing1Choice {
 	s.OpeningClosing = new(OpeningClosing1Choice)
 	return s.OpeningClosing
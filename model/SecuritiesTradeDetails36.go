@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails36 struct {
 
@@ -42,6 +46,10 @@ type SecuritiesTradeDetails36 struct {
 	TypeOfPrice *TypeOfPrice3Choice `xml:"TpOfPric,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails36) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails36) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*Max35Text)(&value))
 }
@@ -86,6 +94,11 @@ func (s *SecuritiesTradeDetails36) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails36) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails36) AddTradeTransactionCondition() *TradeTransactionCondition1Choice {
 	newValue := new(TradeTransactionCondition1Choice)
 	s.TradeTransactionCondition = append(s.TradeTransactionCondition, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails38 struct {
 
@@ -45,6 +49,10 @@ type SecuritiesTradeDetails38 struct {
 	FXAdditionalDetails *Max350Text `xml:"FxAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails38) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails38) AddPlaceOfTrade() *MarketIdentification78 {
 	s.PlaceOfTrade = new(MarketIdentification78)
 	return s.PlaceOfTrade
@@ -224,6 +232,11 @@ func (s *SecuritiesTradeDetails38) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails38) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails38) AddTradeTransactionCondition() *TradeTransactionCondition1Choice {
 	newValue := new(TradeTransactionCondition1Choice)
 	s.TradeTransactionCondition = append(s.TradeTransactionCondition, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails4 struct {
 
@@ -105,6 +109,10 @@ type SecuritiesTradeDetails4 struct {
 	Extension []*Extension2 `xml:"Xtnsn,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails4) SetAccountOwnerTransactionIdentification(value string) {
 	s.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -133,12 +141,20 @@ func (s *SecuritiesTradeDetails4) AddCollateralTransactionIdentification(value s
 	s.CollateralTransactionIdentification = append(s.CollateralTransactionIdentification, (*Max35Text)(&value))
 }
 
-func (s *SecuritiesTradeDetails4) SetSecuritiesMovementType(value string) {
+func (s *SecuritiesTradeDetails4) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SecuritiesTradeDetails4) SetPayment(value string) {
+func (s *SecuritiesTradeDetails4) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SecuritiesTradeDetails4) AddStatus() *AllegementStatus1Choice {
@@ -179,6 +195,11 @@ func (s *SecuritiesTradeDetails4) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails4) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails4) AddFinancialInstrumentIdentification() *SecurityIdentification11 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification11)
 	return s.FinancialInstrumentIdentification
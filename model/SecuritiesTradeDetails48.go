@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails48 struct {
 
@@ -61,6 +65,10 @@ type SecuritiesTradeDetails48 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails48) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails48) SetNotificationSenderTransactionIdentification(value string) {
 	s.NotificationSenderTransactionIdentification = (*Max35Text)(&value)
 }
@@ -73,12 +81,20 @@ func (s *SecuritiesTradeDetails48) SetCommonIdentification(value string) {
 	s.CommonIdentification = (*Max35Text)(&value)
 }
 
-func (s *SecuritiesTradeDetails48) SetSecuritiesMovementType(value string) {
+func (s *SecuritiesTradeDetails48) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SecuritiesTradeDetails48) SetPayment(value string) {
+func (s *SecuritiesTradeDetails48) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SecuritiesTradeDetails48) AddTradeDate() *TradeDate5Choice {
@@ -95,6 +111,11 @@ func (s *SecuritiesTradeDetails48) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails48) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails48) AddFinancialInstrumentIdentification() *SecurityIdentification19 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification19)
 	return s.FinancialInstrumentIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails5 struct {
 
@@ -16,6 +20,10 @@ type SecuritiesTradeDetails5 struct {
 	InstructionProcessingAdditionalDetails *Max350Text `xml:"InstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails5) AddTradeDate() *DateAndDateTimeChoice {
 	s.TradeDate = new(DateAndDateTimeChoice)
 	return s.TradeDate
@@ -30,6 +38,11 @@ func (s *SecuritiesTradeDetails5) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails5) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails5) SetInstructionProcessingAdditionalDetails(value string) {
 	s.InstructionProcessingAdditionalDetails = (*Max350Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails50 struct {
 
@@ -63,6 +67,10 @@ type SecuritiesTradeDetails50 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails50) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails50) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*Max35Text)(&value))
 }
@@ -100,6 +108,11 @@ func (s *SecuritiesTradeDetails50) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails50) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails50) AddOpeningClosing() *OpeningClosing3Choice {
 	s.OpeningClosing = new(OpeningClosing3Choice)
 	return s.OpeningClosing
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails51 struct {
 
@@ -66,6 +70,10 @@ type SecuritiesTradeDetails51 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails51) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails51) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*Max35Text)(&value))
 }
@@ -108,6 +116,11 @@ func (s *SecuritiesTradeDetails51) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails51) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails51) AddOpeningClosing() *OpeningClosing3Choice {
 	s.OpeningClosing = new(OpeningClosing3Choice)
 	return s.OpeningClosing
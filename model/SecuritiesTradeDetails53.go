@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails53 struct {
 
@@ -57,6 +61,10 @@ type SecuritiesTradeDetails53 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails53) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails53) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*Max35Text)(&value))
 }
@@ -99,6 +107,11 @@ func (s *SecuritiesTradeDetails53) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails53) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails53) AddOpeningClosing() *OpeningClosing3Choice {
 	s.OpeningClosing = new(OpeningClosing3Choice)
 	return s.OpeningClosing
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails54 struct {
 
@@ -42,6 +46,10 @@ type SecuritiesTradeDetails54 struct {
 	TypeOfPrice *TypeOfPrice29Choice `xml:"TpOfPric,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails54) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails54) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*Max35Text)(&value))
 }
@@ -87,6 +95,11 @@ func (s *SecuritiesTradeDetails54) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails54) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails54) AddTradeTransactionCondition() *TradeTransactionCondition5Choice {
 	newValue := new(TradeTransactionCondition5Choice)
 	s.TradeTransactionCondition = append(s.TradeTransactionCondition, newValue)
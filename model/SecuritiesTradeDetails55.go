@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails55 struct {
 
@@ -45,6 +49,10 @@ type SecuritiesTradeDetails55 struct {
 	FXAdditionalDetails *Max350Text `xml:"FxAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails55) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails55) AddPlaceOfTrade() *PlaceOfTradeIdentification1 {
 	s.PlaceOfTrade = new(PlaceOfTradeIdentification1)
 	return s.PlaceOfTrade
@@ -225,6 +233,11 @@ func (s *SecuritiesTradeDetails55) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails55) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails55) AddTradeTransactionCondition() *TradeTransactionCondition5Choice {
 	newValue := new(TradeTransactionCondition5Choice)
 	s.TradeTransactionCondition = append(s.TradeTransactionCondition, newValue)
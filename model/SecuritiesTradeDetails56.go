@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails56 struct {
 
@@ -51,6 +55,10 @@ type SecuritiesTradeDetails56 struct {
 	FXAdditionalDetails *Max350Text `xml:"FxAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails56) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails56) AddPlaceOfTrade() *PlaceOfTradeIdentification1 {
 	s.PlaceOfTrade = new(PlaceOfTradeIdentification1)
 	return s.PlaceOfTrade
@@ -80,6 +88,11 @@ func (s *SecuritiesTradeDetails56) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails56) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails56) AddReporting() *Reporting6Choice {
 	newValue := new(Reporting6Choice)
 	s.Reporting = append(s.Reporting, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails57 struct {
 
@@ -61,6 +65,10 @@ type SecuritiesTradeDetails57 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails57) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails57) SetNotificationSenderTransactionIdentification(value string) {
 	s.NotificationSenderTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -73,12 +81,20 @@ func (s *SecuritiesTradeDetails57) SetCommonIdentification(value string) {
 	s.CommonIdentification = (*RestrictedFINXMax16Text)(&value)
 }
 
-func (s *SecuritiesTradeDetails57) SetSecuritiesMovementType(value string) {
+func (s *SecuritiesTradeDetails57) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SecuritiesTradeDetails57) SetPayment(value string) {
+func (s *SecuritiesTradeDetails57) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SecuritiesTradeDetails57) AddTradeDate() *TradeDate6Choice {
@@ -95,6 +111,11 @@ func (s *SecuritiesTradeDetails57) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails57) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails57) AddFinancialInstrumentIdentification() *SecurityIdentification20 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification20)
 	return s.FinancialInstrumentIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails58 struct {
 
@@ -45,6 +49,10 @@ type SecuritiesTradeDetails58 struct {
 	FXAdditionalDetails *RestrictedFINXMax350Text `xml:"FxAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails58) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails58) AddPlaceOfTrade() *PlaceOfTradeIdentification2 {
 	s.PlaceOfTrade = new(PlaceOfTradeIdentification2)
 	return s.PlaceOfTrade
@@ -225,6 +233,11 @@ func (s *SecuritiesTradeDetails58) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails58) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails58) AddTradeTransactionCondition() *TradeTransactionCondition6Choice {
 	newValue := new(TradeTransactionCondition6Choice)
 	s.TradeTransactionCondition = append(s.TradeTransactionCondition, newValue)
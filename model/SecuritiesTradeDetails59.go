@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails59 struct {
 
@@ -51,6 +55,10 @@ type SecuritiesTradeDetails59 struct {
 	FXAdditionalDetails *RestrictedFINXMax350Text `xml:"FxAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails59) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails59) AddPlaceOfTrade() *PlaceOfTradeIdentification2 {
 	s.PlaceOfTrade = new(PlaceOfTradeIdentification2)
 	return s.PlaceOfTrade
@@ -80,6 +88,11 @@ func (s *SecuritiesTradeDetails59) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails59) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails59) AddReporting() *Reporting9Choice {
 	newValue := new(Reporting9Choice)
 	s.Reporting = append(s.Reporting, newValue)
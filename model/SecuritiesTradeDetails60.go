@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails60 struct {
 
@@ -42,6 +46,10 @@ type SecuritiesTradeDetails60 struct {
 	TypeOfPrice *TypeOfPrice32Choice `xml:"TpOfPric,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails60) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails60) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*RestrictedFINXMax16Text)(&value))
 }
@@ -87,6 +95,11 @@ func (s *SecuritiesTradeDetails60) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails60) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails60) AddTradeTransactionCondition() *TradeTransactionCondition6Choice {
 	newValue := new(TradeTransactionCondition6Choice)
 	s.TradeTransactionCondition = append(s.TradeTransactionCondition, newValue)
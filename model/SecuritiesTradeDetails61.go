@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails61 struct {
 
@@ -96,6 +100,10 @@ type SecuritiesTradeDetails61 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails61) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails61) SetAccountOwnerTransactionIdentification(value string) {
 	s.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -128,12 +136,20 @@ func (s *SecuritiesTradeDetails61) AddCollateralTransactionIdentification(value
 	s.CollateralTransactionIdentification = append(s.CollateralTransactionIdentification, (*RestrictedFINXMax16Text)(&value))
 }
 
-func (s *SecuritiesTradeDetails61) SetSecuritiesMovementType(value string) {
+func (s *SecuritiesTradeDetails61) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SecuritiesTradeDetails61) SetPayment(value string) {
+func (s *SecuritiesTradeDetails61) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SecuritiesTradeDetails61) AddStatus() *AllegementStatus4Choice {
@@ -170,6 +186,11 @@ func (s *SecuritiesTradeDetails61) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails61) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails61) AddFinancialInstrumentIdentification() *SecurityIdentification20 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification20)
 	return s.FinancialInstrumentIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails62 struct {
 
@@ -57,6 +61,10 @@ type SecuritiesTradeDetails62 struct {
 	SettlementInstructionProcessingAdditionalDetails *RestrictedFINXMax350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails62) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails62) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*RestrictedFINXMax16Text)(&value))
 }
@@ -99,6 +107,11 @@ func (s *SecuritiesTradeDetails62) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails62) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails62) AddOpeningClosing() *OpeningClosing4Choice {
 	s.OpeningClosing = new(OpeningClosing4Choice)
 	return s.OpeningClosing
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails63 struct {
 
@@ -66,6 +70,10 @@ type SecuritiesTradeDetails63 struct {
 	SettlementInstructionProcessingAdditionalDetails *RestrictedFINXMax350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails63) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails63) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*RestrictedFINXMax16Text)(&value))
 }
@@ -108,6 +116,11 @@ func (s *SecuritiesTradeDetails63) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails63) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails63) AddOpeningClosing() *OpeningClosing4Choice {
 	s.OpeningClosing = new(OpeningClosing4Choice)
 	return s.OpeningClosing
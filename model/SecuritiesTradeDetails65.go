@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails65 struct {
 
@@ -63,6 +67,10 @@ type SecuritiesTradeDetails65 struct {
 	SettlementInstructionProcessingAdditionalDetails *RestrictedFINXMax350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails65) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails65) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*RestrictedFINXMax16Text)(&value))
 }
@@ -100,6 +108,11 @@ func (s *SecuritiesTradeDetails65) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails65) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails65) AddOpeningClosing() *OpeningClosing4Choice {
 	s.OpeningClosing = new(OpeningClosing4Choice)
 	return s.OpeningClosing
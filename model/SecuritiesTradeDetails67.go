@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails67 struct {
 
@@ -72,6 +78,10 @@ type SecuritiesTradeDetails67 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails67) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails67) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*Max35Text)(&value))
 }
@@ -109,10 +119,20 @@ func (s *SecuritiesTradeDetails67) SetAcknowledgedStatusTimeStamp(value string)
 	s.AcknowledgedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (s *SecuritiesTradeDetails67) SetAcknowledgedStatusTimeStampFromTime(value time.Time) {
+	s.AcknowledgedStatusTimeStamp = new(ISODateTime)
+	s.AcknowledgedStatusTimeStamp.FromTime(value)
+}
+
 func (s *SecuritiesTradeDetails67) SetMatchedStatusTimeStamp(value string) {
 	s.MatchedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (s *SecuritiesTradeDetails67) SetMatchedStatusTimeStampFromTime(value time.Time) {
+	s.MatchedStatusTimeStamp = new(ISODateTime)
+	s.MatchedStatusTimeStamp.FromTime(value)
+}
+
 func (s *SecuritiesTradeDetails67) AddDealPrice() *Price2 {
 	s.DealPrice = new(Price2)
 	return s.DealPrice
@@ -122,6 +142,11 @@ func (s *SecuritiesTradeDetails67) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails67) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails67) AddOpeningClosing() *OpeningClosing3Choice {
 	s.OpeningClosing = new(OpeningClosing3Choice)
 	return s.OpeningClosing
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails7 struct {
 
@@ -61,6 +65,10 @@ type SecuritiesTradeDetails7 struct {
 	Extension []*Extension2 `xml:"Xtnsn,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails7) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails7) SetNotificationSenderTransactionIdentification(value string) {
 	s.NotificationSenderTransactionIdentification = (*Max35Text)(&value)
 }
@@ -73,12 +81,20 @@ func (s *SecuritiesTradeDetails7) SetCommonIdentification(value string) {
 	s.CommonIdentification = (*Max35Text)(&value)
 }
 
-func (s *SecuritiesTradeDetails7) SetSecuritiesMovementType(value string) {
+func (s *SecuritiesTradeDetails7) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SecuritiesTradeDetails7) SetPayment(value string) {
+func (s *SecuritiesTradeDetails7) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SecuritiesTradeDetails7) AddTradeDate() *TradeDate1Choice {
@@ -95,6 +111,11 @@ func (s *SecuritiesTradeDetails7) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails7) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails7) AddFinancialInstrumentIdentification() *SecurityIdentification11 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification11)
 	return s.FinancialInstrumentIdentification
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the securities trade.
 type SecuritiesTradeDetails70 struct {
 
@@ -72,6 +78,10 @@ type SecuritiesTradeDetails70 struct {
 	SettlementInstructionProcessingAdditionalDetails *RestrictedFINXMax350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (s *SecuritiesTradeDetails70) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTradeDetails70) AddTradeIdentification(value string) {
 	s.TradeIdentification = append(s.TradeIdentification, (*RestrictedFINXMax16Text)(&value))
 }
@@ -109,10 +119,20 @@ func (s *SecuritiesTradeDetails70) SetAcknowledgedStatusTimeStamp(value string)
 	s.AcknowledgedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (s *SecuritiesTradeDetails70) SetAcknowledgedStatusTimeStampFromTime(value time.Time) {
+	s.AcknowledgedStatusTimeStamp = new(ISODateTime)
+	s.AcknowledgedStatusTimeStamp.FromTime(value)
+}
+
 func (s *SecuritiesTradeDetails70) SetMatchedStatusTimeStamp(value string) {
 	s.MatchedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (s *SecuritiesTradeDetails70) SetMatchedStatusTimeStampFromTime(value time.Time) {
+	s.MatchedStatusTimeStamp = new(ISODateTime)
+	s.MatchedStatusTimeStamp.FromTime(value)
+}
+
 func (s *SecuritiesTradeDetails70) AddDealPrice() *Price3 {
 	s.DealPrice = new(Price3)
 	return s.DealPrice
@@ -122,6 +142,11 @@ func (s *SecuritiesTradeDetails70) SetNumberOfDaysAccrued(value string) {
 	s.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (s *SecuritiesTradeDetails70) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	s.NumberOfDaysAccrued = new(Max3Number)
+	s.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (s *SecuritiesTradeDetails70) AddOpeningClosing() *OpeningClosing4Choice {
 	s.OpeningClosing = new(OpeningClosing4Choice)
 	return s.OpeningClosing
@@ -10,6 +10,10 @@ type SecuritiesTransactionType10Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType10Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType10Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType8Code)(&value)
 }
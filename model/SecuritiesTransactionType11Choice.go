@@ -10,6 +10,10 @@ type SecuritiesTransactionType11Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType11Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType11Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType9Code)(&value)
 }
@@ -10,6 +10,10 @@ type SecuritiesTransactionType12Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType12Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType12Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType10Code)(&value)
 }
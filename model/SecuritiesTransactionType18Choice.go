@@ -10,6 +10,10 @@ type SecuritiesTransactionType18Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType18Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType18Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType8Code)(&value)
 }
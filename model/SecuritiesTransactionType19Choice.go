@@ -10,6 +10,10 @@ type SecuritiesTransactionType19Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType19Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType19Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType16Code)(&value)
 }
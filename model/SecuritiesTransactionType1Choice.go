@@ -10,6 +10,10 @@ type SecuritiesTransactionType1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType1Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type SecuritiesTransactionType20Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType20Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType20Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType10Code)(&value)
 }
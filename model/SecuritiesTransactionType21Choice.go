@@ -10,6 +10,10 @@ type SecuritiesTransactionType21Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType21Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType21Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType7Code)(&value)
 }
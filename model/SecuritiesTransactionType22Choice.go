@@ -10,6 +10,10 @@ type SecuritiesTransactionType22Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType22Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType22Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType10Code)(&value)
 }
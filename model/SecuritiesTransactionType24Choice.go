@@ -10,6 +10,10 @@ type SecuritiesTransactionType24Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType24Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType24Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType8Code)(&value)
 }
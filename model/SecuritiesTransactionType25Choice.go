@@ -10,6 +10,10 @@ type SecuritiesTransactionType25Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType25Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType25Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType7Code)(&value)
 }
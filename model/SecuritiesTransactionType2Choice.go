@@ -10,6 +10,10 @@ type SecuritiesTransactionType2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType2Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type SecuritiesTransactionType30Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType30Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType30Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType16Code)(&value)
 }
@@ -10,6 +10,10 @@ type SecuritiesTransactionType32Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType32Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType32Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType17Code)(&value)
 }
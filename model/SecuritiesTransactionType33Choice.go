@@ -10,6 +10,10 @@ type SecuritiesTransactionType33Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType33Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType33Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType18Code)(&value)
 }
@@ -10,6 +10,10 @@ type SecuritiesTransactionType34Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType34Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType34Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType17Code)(&value)
 }
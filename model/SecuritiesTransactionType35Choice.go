@@ -10,6 +10,10 @@ type SecuritiesTransactionType35Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType35Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType35Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType18Code)(&value)
 }
@@ -10,6 +10,10 @@ type SecuritiesTransactionType3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType3Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType4Code)(&value)
 }
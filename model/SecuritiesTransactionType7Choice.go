@@ -10,6 +10,10 @@ type SecuritiesTransactionType7Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType7Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType7Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType6Code)(&value)
 }
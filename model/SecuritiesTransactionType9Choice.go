@@ -10,6 +10,10 @@ type SecuritiesTransactionType9Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SecuritiesTransactionType9Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecuritiesTransactionType9Choice) SetCode(value string) {
 	s.Code = (*SecuritiesTransactionType7Code)(&value)
 }
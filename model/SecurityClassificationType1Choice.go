@@ -10,6 +10,10 @@ type SecurityClassificationType1Choice struct {
 	AlternateClassification *GenericIdentification3 `xml:"AltrnClssfctn"`
 }
 
+func (s *SecurityClassificationType1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityClassificationType1Choice) SetCFI(value string) {
 	s.CFI = (*CFIIdentifier)(&value)
 }
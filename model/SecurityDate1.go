@@ -22,6 +22,10 @@ type SecurityDate1 struct {
 	PaymentDate *DateFormat6Choice `xml:"PmtDt,omitempty"`
 }
 
+func (s *SecurityDate1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityDate1) AddPostingDate() *DateAndDateTimeChoice {
 	s.PostingDate = new(DateAndDateTimeChoice)
 	return s.PostingDate
@@ -22,6 +22,10 @@ type SecurityDate11 struct {
 	PaymentDate *DateFormat31Choice `xml:"PmtDt,omitempty"`
 }
 
+func (s *SecurityDate11) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityDate11) AddPostingDate() *DateAndDateTimeChoice {
 	s.PostingDate = new(DateAndDateTimeChoice)
 	return s.PostingDate
@@ -22,6 +22,10 @@ type SecurityDate12 struct {
 	LastTradingDate *DateFormat31Choice `xml:"LastTradgDt,omitempty"`
 }
 
+func (s *SecurityDate12) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityDate12) AddPaymentDate() *DateFormat31Choice {
 	s.PaymentDate = new(DateFormat31Choice)
 	return s.PaymentDate
@@ -22,6 +22,10 @@ type SecurityDate13 struct {
 	PaymentDate *DateFormat34Choice `xml:"PmtDt,omitempty"`
 }
 
+func (s *SecurityDate13) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityDate13) AddPostingDate() *DateAndDateTimeChoice {
 	s.PostingDate = new(DateAndDateTimeChoice)
 	return s.PostingDate
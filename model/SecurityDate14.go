@@ -22,6 +22,10 @@ type SecurityDate14 struct {
 	LastTradingDate *DateFormat34Choice `xml:"LastTradgDt,omitempty"`
 }
 
+func (s *SecurityDate14) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityDate14) AddPaymentDate() *DateFormat34Choice {
 	s.PaymentDate = new(DateFormat34Choice)
 	return s.PaymentDate
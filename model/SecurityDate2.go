@@ -19,6 +19,10 @@ type SecurityDate2 struct {
 	PariPassuDate *DateFormat6Choice `xml:"PrpssDt,omitempty"`
 }
 
+func (s *SecurityDate2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityDate2) AddPaymentDate() *DateFormat6Choice {
 	s.PaymentDate = new(DateFormat6Choice)
 	return s.PaymentDate
@@ -19,6 +19,10 @@ type SecurityDate5 struct {
 	PariPassuDate *DateFormat19Choice `xml:"PrpssDt,omitempty"`
 }
 
+func (s *SecurityDate5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityDate5) AddPaymentDate() *DateFormat19Choice {
 	s.PaymentDate = new(DateFormat19Choice)
 	return s.PaymentDate
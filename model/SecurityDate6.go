@@ -22,6 +22,10 @@ type SecurityDate6 struct {
 	PaymentDate *DateFormat19Choice `xml:"PmtDt,omitempty"`
 }
 
+func (s *SecurityDate6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityDate6) AddPostingDate() *DateAndDateTimeChoice {
 	s.PostingDate = new(DateAndDateTimeChoice)
 	return s.PostingDate
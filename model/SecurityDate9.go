@@ -22,6 +22,10 @@ type SecurityDate9 struct {
 	LastTradingDate *DateFormat19Choice `xml:"LastTradgDt,omitempty"`
 }
 
+func (s *SecurityDate9) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityDate9) AddPaymentDate() *DateFormat19Choice {
 	s.PaymentDate = new(DateFormat19Choice)
 	return s.PaymentDate
@@ -25,6 +25,10 @@ type SecurityIdentification1 struct {
 	RegisteredDistributionCountry []*CountryCode `xml:"RegdDstrbtnCtry"`
 }
 
+func (s *SecurityIdentification1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification1) AddIdentification() *SecurityIdentification7 {
 	s.Identification = new(SecurityIdentification7)
 	return s.Identification
@@ -10,6 +10,10 @@ type SecurityIdentification11 struct {
 	Description *Max140Text `xml:"Desc,omitempty"`
 }
 
+func (s *SecurityIdentification11) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification11) AddIdentification() *SecurityIdentification11Choice {
 	s.Identification = new(SecurityIdentification11Choice)
 	return s.Identification
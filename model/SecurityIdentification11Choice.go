@@ -10,6 +10,10 @@ type SecurityIdentification11Choice struct {
 	OtherIdentification *AlternateIdentification1 `xml:"OthrId"`
 }
 
+func (s *SecurityIdentification11Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification11Choice) SetISIN(value string) {
 	s.ISIN = (*ISINIdentifier)(&value)
 }
@@ -13,6 +13,10 @@ type SecurityIdentification14 struct {
 	Description *Max140Text `xml:"Desc,omitempty"`
 }
 
+func (s *SecurityIdentification14) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification14) SetISIN(value string) {
 	s.ISIN = (*ISINIdentifier)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Identification of a security.
 type SecurityIdentification19 struct {
 
@@ -13,6 +15,10 @@ type SecurityIdentification19 struct {
 	Description *Max140Text `xml:"Desc,omitempty"`
 }
 
+func (s *SecurityIdentification19) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification19) SetISIN(value string) {
 	s.ISIN = (*ISINOct2015Identifier)(&value)
 }
@@ -166,3 +172,55 @@ func paymentInstruct(doc *Document) (paymentInstruct, error) {
 func (s *SecurityIdentification19) SetDescription(value string) {
 	s.Description = (*Max140Text)(&value)
 }
+
+func (s *SecurityIdentification19) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if s.ISIN != nil {
+		enc.Element("ISIN", string(*s.ISIN))
+	}
+	for _, elem := range s.OtherIdentification {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "OthrId")
+		}
+	}
+	if s.Description != nil {
+		enc.Element("Desc", string(*s.Description))
+	}
+	enc.End(tag)
+}
+
+func (s *SecurityIdentification19) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "ISIN":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.ISIN = (*ISINOct2015Identifier)(&value)
+		case "OthrId":
+			elem := new(OtherIdentification1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.OtherIdentification = append(s.OtherIdentification, elem)
+		case "Desc":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.Description = (*Max140Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -25,6 +25,10 @@ type SecurityIdentification1Choice struct {
 	Common *EuroclearClearstreamIdentifier `xml:"Cmon"`
 }
 
+func (s *SecurityIdentification1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification1Choice) SetISIN(value string) {
 	s.ISIN = (*ISINIdentifier)(&value)
 }
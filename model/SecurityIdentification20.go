@@ -13,6 +13,10 @@ type SecurityIdentification20 struct {
 	Description *RestrictedFINXMax140Text `xml:"Desc,omitempty"`
 }
 
+func (s *SecurityIdentification20) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification20) SetISIN(value string) {
 	s.ISIN = (*ISINOct2015Identifier)(&value)
 }
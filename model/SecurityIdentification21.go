@@ -13,6 +13,10 @@ type SecurityIdentification21 struct {
 	Description *RestrictedFINXMax140Text `xml:"Desc,omitempty"`
 }
 
+func (s *SecurityIdentification21) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification21) SetISIN(value string) {
 	s.ISIN = (*ISINOct2015Identifier)(&value)
 }
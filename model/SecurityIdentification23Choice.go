@@ -49,6 +49,10 @@ type SecurityIdentification23Choice struct {
 	OtherProprietaryIdentification *AlternateSecurityIdentification7 `xml:"OthrPrtryId"`
 }
 
+func (s *SecurityIdentification23Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification23Choice) SetISIN(value string) {
 	s.ISIN = (*ISINIdentifier)(&value)
 }
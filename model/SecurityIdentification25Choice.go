@@ -49,6 +49,10 @@ type SecurityIdentification25Choice struct {
 	OtherProprietaryIdentification *AlternateSecurityIdentification7 `xml:"OthrPrtryId"`
 }
 
+func (s *SecurityIdentification25Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification25Choice) SetISIN(value string) {
 	s.ISIN = (*ISINOct2015Identifier)(&value)
 }
@@ -22,6 +22,10 @@ type SecurityIdentification3 struct {
 	OtherIdentification *AlternateFinancialInstrumentIdentification1 `xml:"OthrId,omitempty"`
 }
 
+func (s *SecurityIdentification3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification3) SetISIN(value string) {
 	s.ISIN = (*ISINIdentifier)(&value)
 }
@@ -13,6 +13,10 @@ type SecurityIdentification32 struct {
 	Description *RestrictedFINXMax140Text `xml:"Desc,omitempty"`
 }
 
+func (s *SecurityIdentification32) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification32) SetISIN(value string) {
 	s.ISIN = (*ISINOct2015Identifier)(&value)
 }
@@ -49,6 +49,10 @@ type SecurityIdentification3Choice struct {
 	OtherProprietaryIdentification *AlternateSecurityIdentification1 `xml:"OthrPrtryId"`
 }
 
+func (s *SecurityIdentification3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification3Choice) SetISIN(value string) {
 	s.ISIN = (*ISINIdentifier)(&value)
 }
@@ -10,6 +10,10 @@ type SecurityIdentification4Choice struct {
 	Proprietary *AlternateSecurityIdentification2 `xml:"Prtry"`
 }
 
+func (s *SecurityIdentification4Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification4Choice) SetISIN(value string) {
 	s.ISIN = (*ISINIdentifier)(&value)
 }
@@ -13,6 +13,10 @@ type SecurityIdentification6Choice struct {
 	InstrumentDescription *SecurityInstrumentDescription2 `xml:"InstrmDesc"`
 }
 
+func (s *SecurityIdentification6Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification6Choice) SetISIN(value string) {
 	s.ISIN = (*ISINIdentifier)(&value)
 }
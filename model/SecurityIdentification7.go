@@ -13,6 +13,10 @@ type SecurityIdentification7 struct {
 	Description *Max140Text `xml:"Desc,omitempty"`
 }
 
+func (s *SecurityIdentification7) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityIdentification7) SetISIN(value string) {
 	s.ISIN = (*ISINIdentifier)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identifies the security instrument by its name and typical characteristics.
 type SecurityInstrumentDescription2 struct {
 
@@ -28,6 +34,10 @@ type SecurityInstrumentDescription2 struct {
 	Multiplier *BaseOneRate `xml:"Mltplr,omitempty"`
 }
 
+func (s *SecurityInstrumentDescription2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityInstrumentDescription2) SetDescription(value string) {
 	s.Description = (*Max350Text)(&value)
 }
@@ -45,10 +55,20 @@ func (s *SecurityInstrumentDescription2) SetExerciseDate(value string) {
 	s.ExerciseDate = (*ISODate)(&value)
 }
 
+func (s *SecurityInstrumentDescription2) SetExerciseDateFromTime(value time.Time) {
+	s.ExerciseDate = new(ISODate)
+	s.ExerciseDate.FromTime(value)
+}
+
 func (s *SecurityInstrumentDescription2) SetMaturityDate(value string) {
 	s.MaturityDate = (*ISODate)(&value)
 }
 
+func (s *SecurityInstrumentDescription2) SetMaturityDateFromTime(value time.Time) {
+	s.MaturityDate = new(ISODate)
+	s.MaturityDate.FromTime(value)
+}
+
 func (s *SecurityInstrumentDescription2) SetOptionType(value string) {
 	s.OptionType = (*OptionTypeCode)(&value)
 }
@@ -61,3 +81,8 @@ func (s *SecurityInstrumentDescription2) AddStrikePrice() *PriceRateOrAmountChoi
 func (s *SecurityInstrumentDescription2) SetMultiplier(value string) {
 	s.Multiplier = (*BaseOneRate)(&value)
 }
+
+func (s *SecurityInstrumentDescription2) SetMultiplierFromDecimal(value decimal.Decimal) {
+	s.Multiplier = new(BaseOneRate)
+	s.Multiplier.FromDecimal(value)
+}
@@ -16,6 +16,10 @@ type SecurityMovement1 struct {
 	AccountDetails []*SecuritiesAccount12 `xml:"AcctDtls"`
 }
 
+func (s *SecurityMovement1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityMovement1) SetMovementIdentification(value string) {
 	s.MovementIdentification = (*Max35Text)(&value)
 }
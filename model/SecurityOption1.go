@@ -49,13 +49,21 @@ type SecurityOption1 struct {
 	FractionDisposition *FractionDispositionType1FormatChoice `xml:"FrctnDspstn,omitempty"`
 }
 
+func (s *SecurityOption1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityOption1) AddSecurityIdentification() *FinancialInstrumentDescription3 {
 	s.SecurityIdentification = new(FinancialInstrumentDescription3)
 	return s.SecurityIdentification
 }
 
-func (s *SecurityOption1) SetCreditDebitIndicator(value string) {
+func (s *SecurityOption1) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *SecurityOption1) AddSecuritiesQuantity() *UnitOrFaceAmount1Choice {
@@ -117,6 +125,11 @@ func (s *SecurityOption1) SetTemporaryFinancialInstrumentIndicator(value string)
 	s.TemporaryFinancialInstrumentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SecurityOption1) SetTemporaryFinancialInstrumentIndicatorFromBool(value bool) {
+	s.TemporaryFinancialInstrumentIndicator = new(YesNoIndicator)
+	s.TemporaryFinancialInstrumentIndicator.FromBool(value)
+}
+
 func (s *SecurityOption1) AddFractionDisposition() *FractionDispositionType1FormatChoice {
 	s.FractionDisposition = new(FractionDispositionType1FormatChoice)
 	return s.FractionDisposition
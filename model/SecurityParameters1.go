@@ -13,6 +13,10 @@ type SecurityParameters1 struct {
 	SymmetricKey []*CryptographicKey2 `xml:"SmmtrcKey,omitempty"`
 }
 
+func (s *SecurityParameters1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityParameters1) SetPOIChallenge(value string) {
 	s.POIChallenge = (*Max140Binary)(&value)
 }
@@ -13,6 +13,10 @@ type SecurityParameters2 struct {
 	SymmetricKey []*CryptographicKey4 `xml:"SmmtrcKey,omitempty"`
 }
 
+func (s *SecurityParameters2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityParameters2) SetPOIChallenge(value string) {
 	s.POIChallenge = (*Max140Binary)(&value)
 }
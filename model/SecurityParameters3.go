@@ -16,6 +16,10 @@ type SecurityParameters3 struct {
 	SymmetricKey []*CryptographicKey5 `xml:"SmmtrcKey,omitempty"`
 }
 
+func (s *SecurityParameters3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityParameters3) SetVersion(value string) {
 	s.Version = (*Max256Text)(&value)
 }
@@ -19,6 +19,10 @@ type SecurityParameters4 struct {
 	RequestedKey *Max35Text `xml:"ReqdKey,omitempty"`
 }
 
+func (s *SecurityParameters4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityParameters4) AddKey() *CryptographicKey8 {
 	s.Key = new(CryptographicKey8)
 	return s.Key
@@ -13,6 +13,10 @@ type SecurityParameters5 struct {
 	DigitalSignature *ContentInformationType14 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (s *SecurityParameters5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityParameters5) SetHostChallenge(value string) {
 	s.HostChallenge = (*Max140Binary)(&value)
 }
@@ -19,6 +19,10 @@ type SecurityParameters6 struct {
 	SymmetricKey []*CryptographicKey5 `xml:"SmmtrcKey,omitempty"`
 }
 
+func (s *SecurityParameters6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityParameters6) SetActionType(value string) {
 	s.ActionType = (*TerminalManagementAction3Code)(&value)
 }
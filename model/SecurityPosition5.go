@@ -10,6 +10,10 @@ type SecurityPosition5 struct {
 	Position []*EligiblePosition2 `xml:"Pos,omitempty"`
 }
 
+func (s *SecurityPosition5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityPosition5) AddIdentification() *SecurityIdentification3 {
 	s.Identification = new(SecurityIdentification3)
 	return s.Identification
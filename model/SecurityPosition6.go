@@ -10,6 +10,10 @@ type SecurityPosition6 struct {
 	Position []*EligiblePosition3 `xml:"Pos,omitempty"`
 }
 
+func (s *SecurityPosition6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityPosition6) AddIdentification() *SecurityIdentification11 {
 	s.Identification = new(SecurityIdentification11)
 	return s.Identification
@@ -10,6 +10,10 @@ type SecurityPosition7 struct {
 	Position []*EligiblePosition4 `xml:"Pos,omitempty"`
 }
 
+func (s *SecurityPosition7) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityPosition7) AddIdentification() *SecurityIdentification11 {
 	s.Identification = new(SecurityIdentification11)
 	return s.Identification
@@ -10,6 +10,10 @@ type SecurityPosition8 struct {
 	Position []*EligiblePosition5 `xml:"Pos,omitempty"`
 }
 
+func (s *SecurityPosition8) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityPosition8) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return s.FinancialInstrumentIdentification
@@ -10,6 +10,10 @@ type SecurityPosition9 struct {
 	Position []*EligiblePosition6 `xml:"Pos,omitempty"`
 }
 
+func (s *SecurityPosition9) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SecurityPosition9) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return s.FinancialInstrumentIdentification
@@ -10,6 +10,10 @@ type SegregatedIndependentAmountDispute1 struct {
 	DisputeResolutionType1Choice []*DisputeResolutionType1Choice `xml:"DsptRsltnTp1Chc,omitempty"`
 }
 
+func (s *SegregatedIndependentAmountDispute1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SegregatedIndependentAmountDispute1) AddDisputeDetails() *Dispute1 {
 	s.DisputeDetails = new(Dispute1)
 	return s.DisputeDetails
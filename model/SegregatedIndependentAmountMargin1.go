@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Elements used to calculate the collateral margin call for the segregated independent amount.
 type SegregatedIndependentAmountMargin1 struct {
 
@@ -13,14 +17,26 @@ type SegregatedIndependentAmountMargin1 struct {
 	RoundingMethod *RoundingMethod1Code `xml:"RndgMtd,omitempty"`
 }
 
+func (s *SegregatedIndependentAmountMargin1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SegregatedIndependentAmountMargin1) SetMinimumTransferAmount(value, currency string) {
 	s.MinimumTransferAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SegregatedIndependentAmountMargin1) SetMinimumTransferAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.MinimumTransferAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SegregatedIndependentAmountMargin1) SetRoundingAmount(value, currency string) {
 	s.RoundingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SegregatedIndependentAmountMargin1) SetRoundingAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.RoundingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SegregatedIndependentAmountMargin1) SetRoundingMethod(value string) {
 	s.RoundingMethod = (*RoundingMethod1Code)(&value)
 }
@@ -0,0 +1,66 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Specifies a range of sequences from the start sequence to the end sequence.
+type SequenceRange1 struct {
+
+	// Start sequence of the range.
+	FromSequence *Max35Text `xml:"FrSeq"`
+
+	// End sequence of the range.
+	ToSequence *Max35Text `xml:"ToSeq"`
+}
+
+func (s *SequenceRange1) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SequenceRange1) SetFromSequence(value string) {
+	s.FromSequence = (*Max35Text)(&value)
+}
+
+func (s *SequenceRange1) SetToSequence(value string) {
+	s.ToSequence = (*Max35Text)(&value)
+}
+
+func (s *SequenceRange1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if s.FromSequence != nil {
+		enc.Element("FrSeq", string(*s.FromSequence))
+	}
+	if s.ToSequence != nil {
+		enc.Element("ToSeq", string(*s.ToSequence))
+	}
+	enc.End(tag)
+}
+
+func (s *SequenceRange1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FrSeq":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.FromSequence = (*Max35Text)(&value)
+		case "ToSeq":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.ToSequence = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
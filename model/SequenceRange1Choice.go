@@ -0,0 +1,122 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Specifies a range of sequences or individual sequences.
+type SequenceRange1Choice struct {
+
+	// Identification of the first sequence number in a range.
+	FromSequence *Max35Text `xml:"FrSeq"`
+
+	// Identification of the last sequence number in a range.
+	ToSequence *Max35Text `xml:"ToSeq"`
+
+	// Specified sequence range.
+	FromToSequence []*SequenceRange1 `xml:"FrToSeq"`
+
+	// Specified sequence to be included.
+	EQSequence []*Max35Text `xml:"EQSeq"`
+
+	// Specified sequence to be excluded.
+	NEQSequence []*Max35Text `xml:"NEQSeq"`
+}
+
+func (s *SequenceRange1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SequenceRange1Choice) SetFromSequence(value string) {
+	s.FromSequence = (*Max35Text)(&value)
+}
+
+func (s *SequenceRange1Choice) SetToSequence(value string) {
+	s.ToSequence = (*Max35Text)(&value)
+}
+
+func (s *SequenceRange1Choice) AddFromToSequence() *SequenceRange1 {
+	newValue := new(SequenceRange1)
+	s.FromToSequence = append(s.FromToSequence, newValue)
+	return newValue
+}
+
+func (s *SequenceRange1Choice) AddEQSequence(value string) {
+	s.EQSequence = append(s.EQSequence, (*Max35Text)(&value))
+}
+
+func (s *SequenceRange1Choice) AddNEQSequence(value string) {
+	s.NEQSequence = append(s.NEQSequence, (*Max35Text)(&value))
+}
+
+func (s *SequenceRange1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if s.FromSequence != nil {
+		enc.Element("FrSeq", string(*s.FromSequence))
+	}
+	if s.ToSequence != nil {
+		enc.Element("ToSeq", string(*s.ToSequence))
+	}
+	for _, elem := range s.FromToSequence {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "FrToSeq")
+		}
+	}
+	for _, elem := range s.EQSequence {
+		if elem != nil {
+			enc.Element("EQSeq", string(*elem))
+		}
+	}
+	for _, elem := range s.NEQSequence {
+		if elem != nil {
+			enc.Element("NEQSeq", string(*elem))
+		}
+	}
+	enc.End(tag)
+}
+
+func (s *SequenceRange1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FrSeq":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.FromSequence = (*Max35Text)(&value)
+		case "ToSeq":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.ToSequence = (*Max35Text)(&value)
+		case "FrToSeq":
+			elem := new(SequenceRange1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.FromToSequence = append(s.FromToSequence, elem)
+		case "EQSeq":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.EQSequence = append(s.EQSequence, (*Max35Text)(&value))
+		case "NEQSeq":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.NEQSequence = append(s.NEQSequence, (*Max35Text)(&value))
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,3 +1,30 @@
 package model
 
 type SequenceType1Code string
+
+const (
+	SequenceType1CodeFRST SequenceType1Code = "FRST"
+	SequenceType1CodeRCUR SequenceType1Code = "RCUR"
+	SequenceType1CodeFNAL SequenceType1Code = "FNAL"
+	SequenceType1CodeOOFF SequenceType1Code = "OOFF"
+)
+
+var sequenceType1CodeDescriptions = map[SequenceType1Code]string{
+	SequenceType1CodeFRST: "First: first collection of a series of direct debit instructions.",
+	SequenceType1CodeRCUR: "Recurring: direct debit instruction where the debtor's authorisation is used for regular direct debit transactions initiated by the creditor.",
+	SequenceType1CodeFNAL: "Final: final collection of a series of direct debit instructions.",
+	SequenceType1CodeOOFF: "One off: direct debit instruction where the debtor's authorisation is used to initiate one single direct debit transaction.",
+}
+
+func (s SequenceType1Code) IsValid() bool {
+	_, ok := sequenceType1CodeDescriptions[s]
+	return ok
+}
+
+func (s SequenceType1Code) Description() string {
+	return sequenceType1CodeDescriptions[s]
+}
+
+func (s SequenceType1Code) Validate() error {
+	return validateEnumeration("SequenceType1Code", string(s), s.IsValid(), "FRST, RCUR, FNAL, OOFF")
+}
@@ -1,3 +1,26 @@
 package model
 
 type SequenceType2Code string
+
+const (
+	SequenceType2CodeRCUR SequenceType2Code = "RCUR"
+	SequenceType2CodeOOFF SequenceType2Code = "OOFF"
+)
+
+var sequenceType2CodeDescriptions = map[SequenceType2Code]string{
+	SequenceType2CodeRCUR: "Recurring: direct debit instruction where the debtor's authorisation is used for regular direct debit transactions initiated by the creditor.",
+	SequenceType2CodeOOFF: "One off: direct debit instruction where the debtor's authorisation is used to initiate one single direct debit transaction.",
+}
+
+func (s SequenceType2Code) IsValid() bool {
+	_, ok := sequenceType2CodeDescriptions[s]
+	return ok
+}
+
+func (s SequenceType2Code) Description() string {
+	return sequenceType2CodeDescriptions[s]
+}
+
+func (s SequenceType2Code) Validate() error {
+	return validateEnumeration("SequenceType2Code", string(s), s.IsValid(), "RCUR, OOFF")
+}
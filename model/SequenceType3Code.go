@@ -1,3 +1,32 @@
 package model
 
 type SequenceType3Code string
+
+const (
+	SequenceType3CodeFRST SequenceType3Code = "FRST"
+	SequenceType3CodeRCUR SequenceType3Code = "RCUR"
+	SequenceType3CodeFNAL SequenceType3Code = "FNAL"
+	SequenceType3CodeOOFF SequenceType3Code = "OOFF"
+	SequenceType3CodeRPRE SequenceType3Code = "RPRE"
+)
+
+var sequenceType3CodeDescriptions = map[SequenceType3Code]string{
+	SequenceType3CodeFRST: "First: first collection of a series of direct debit instructions.",
+	SequenceType3CodeRCUR: "Recurring: direct debit instruction where the debtor's authorisation is used for regular direct debit transactions initiated by the creditor.",
+	SequenceType3CodeFNAL: "Final: final collection of a series of direct debit instructions.",
+	SequenceType3CodeOOFF: "One off: direct debit instruction where the debtor's authorisation is used to initiate one single direct debit transaction.",
+	SequenceType3CodeRPRE: "Represented: collection used to re-present previously reversed or returned direct debit transactions.",
+}
+
+func (s SequenceType3Code) IsValid() bool {
+	_, ok := sequenceType3CodeDescriptions[s]
+	return ok
+}
+
+func (s SequenceType3Code) Description() string {
+	return sequenceType3CodeDescriptions[s]
+}
+
+func (s SequenceType3Code) Validate() error {
+	return validateEnumeration("SequenceType3Code", string(s), s.IsValid(), "FRST, RCUR, FNAL, OOFF, RPRE")
+}
@@ -10,6 +10,10 @@ type Series1 struct {
 	SeriesName *Max35Text `xml:"SrsNm,omitempty"`
 }
 
+func (s *Series1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Series1) AddSeriesDate() *DateFormat42Choice {
 	s.SeriesDate = new(DateFormat42Choice)
 	return s.SeriesDate
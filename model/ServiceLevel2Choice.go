@@ -10,6 +10,10 @@ type ServiceLevel2Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (s *ServiceLevel2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *ServiceLevel2Choice) SetCode(value string) {
 	s.Code = (*ServiceLevel1Code)(&value)
 }
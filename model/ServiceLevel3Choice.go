@@ -10,6 +10,10 @@ type ServiceLevel3Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (s *ServiceLevel3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *ServiceLevel3Choice) SetCode(value string) {
 	s.Code = (*ServiceLevel2Code)(&value)
 }
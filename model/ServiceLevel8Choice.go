@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the service level of the transaction.
 type ServiceLevel8Choice struct {
 
@@ -10,6 +12,10 @@ type ServiceLevel8Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (s *ServiceLevel8Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *ServiceLevel8Choice) SetCode(value string) {
 	s.Code = (*ExternalServiceLevel1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (s *ServiceLevel8Choice) SetCode(value string) {
 func (s *ServiceLevel8Choice) SetProprietary(value string) {
 	s.Proprietary = (*Max35Text)(&value)
 }
+
+func (s *ServiceLevel8Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if s.Code != nil {
+		enc.Element("Cd", string(*s.Code))
+	}
+	if s.Proprietary != nil {
+		enc.Element("Prtry", string(*s.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (s *ServiceLevel8Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.Code = (*ExternalServiceLevel1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
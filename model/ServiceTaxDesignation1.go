@@ -13,6 +13,10 @@ type ServiceTaxDesignation1 struct {
 	TaxReason []*TaxReason1 `xml:"TaxRsn,omitempty"`
 }
 
+func (s *ServiceTaxDesignation1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *ServiceTaxDesignation1) SetCode(value string) {
 	s.Code = (*ServiceTaxDesignation1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Settlement1 struct {
 	Depository *PartyIdentification34Choice `xml:"Dpstry,omitempty"`
 }
 
+func (s *Settlement1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Settlement1) AddSettlementAmount() *AmountAndDirection27 {
 	s.SettlementAmount = new(AmountAndDirection27)
 	return s.SettlementAmount
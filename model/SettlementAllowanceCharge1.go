@@ -13,10 +13,19 @@ type SettlementAllowanceCharge1 struct {
 	Reason *DiscountOrChargeType1Choice `xml:"Rsn,omitempty"`
 }
 
+func (s *SettlementAllowanceCharge1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementAllowanceCharge1) SetAllowanceChargeIndicator(value string) {
 	s.AllowanceChargeIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementAllowanceCharge1) SetAllowanceChargeIndicatorFromBool(value bool) {
+	s.AllowanceChargeIndicator = new(YesNoIndicator)
+	s.AllowanceChargeIndicator.FromBool(value)
+}
+
 func (s *SettlementAllowanceCharge1) AddActualAmount(value, currency string) {
 	s.ActualAmount = append(s.ActualAmount, NewCurrencyAndAmount(value, currency))
 }
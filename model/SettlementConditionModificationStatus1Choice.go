@@ -10,6 +10,10 @@ type SettlementConditionModificationStatus1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SettlementConditionModificationStatus1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementConditionModificationStatus1Choice) SetCode(value string) {
 	s.Code = (*SettlementConditionModificationStatus1Code)(&value)
 }
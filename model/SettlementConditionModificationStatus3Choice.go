@@ -10,6 +10,10 @@ type SettlementConditionModificationStatus3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SettlementConditionModificationStatus3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementConditionModificationStatus3Choice) SetCode(value string) {
 	s.Code = (*SettlementConditionModificationStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementConditionModificationStatus4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SettlementConditionModificationStatus4Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementConditionModificationStatus4Choice) SetCode(value string) {
 	s.Code = (*SettlementConditionModificationStatus1Code)(&value)
 }
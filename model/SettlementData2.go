@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information on the settlement of a treasury trade.
 type SettlementData2 struct {
 
@@ -43,6 +49,10 @@ type SettlementData2 struct {
 	Pending *YesNoIndicator `xml:"Pdg"`
 }
 
+func (s *SettlementData2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementData2) SetCashFlowUniqueReference(value string) {
 	s.CashFlowUniqueReference = (*Max35Text)(&value)
 }
@@ -55,14 +65,26 @@ func (s *SettlementData2) SetSettlementAmount(value, currency string) {
 	s.SettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SettlementData2) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SettlementData2) SetSettledAmount(value, currency string) {
 	s.SettledAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SettlementData2) SetSettledAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettledAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SettlementData2) SetRejectedAmount(value, currency string) {
 	s.RejectedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SettlementData2) SetRejectedAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.RejectedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SettlementData2) AddPayingParty() *PartyIdentification8Choice {
 	s.PayingParty = new(PartyIdentification8Choice)
 	return s.PayingParty
@@ -77,6 +99,11 @@ func (s *SettlementData2) SetSettlementDate(value string) {
 	s.SettlementDate = (*ISODate)(&value)
 }
 
+func (s *SettlementData2) SetSettlementDateFromTime(value time.Time) {
+	s.SettlementDate = new(ISODate)
+	s.SettlementDate.FromTime(value)
+}
+
 func (s *SettlementData2) SetSettlementStatus(value string) {
 	s.SettlementStatus = (*SettlementStatus1Code)(&value)
 }
@@ -93,6 +120,16 @@ func (s *SettlementData2) SetSuspended(value string) {
 	s.Suspended = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementData2) SetSuspendedFromBool(value bool) {
+	s.Suspended = new(YesNoIndicator)
+	s.Suspended.FromBool(value)
+}
+
 func (s *SettlementData2) SetPending(value string) {
 	s.Pending = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementData2) SetPendingFromBool(value bool) {
+	s.Pending = new(YesNoIndicator)
+	s.Pending.FromBool(value)
+}
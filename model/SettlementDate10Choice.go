@@ -10,6 +10,10 @@ type SettlementDate10Choice struct {
 	DateCode *SettlementDateCode8Choice `xml:"DtCd"`
 }
 
+func (s *SettlementDate10Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDate10Choice) AddDate() *DateAndDateTimeChoice {
 	s.Date = new(DateAndDateTimeChoice)
 	return s.Date
@@ -10,6 +10,10 @@ type SettlementDate11Choice struct {
 	DateCode *GenericIdentification30 `xml:"DtCd"`
 }
 
+func (s *SettlementDate11Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDate11Choice) AddDate() *DateAndDateTimeChoice {
 	s.Date = new(DateAndDateTimeChoice)
 	return s.Date
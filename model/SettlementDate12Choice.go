@@ -10,6 +10,10 @@ type SettlementDate12Choice struct {
 	DateCode *SettlementDateCode9Choice `xml:"DtCd"`
 }
 
+func (s *SettlementDate12Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDate12Choice) AddDate() *DateAndDateTimeChoice {
 	s.Date = new(DateAndDateTimeChoice)
 	return s.Date
@@ -10,6 +10,10 @@ type SettlementDate14Choice struct {
 	DateCode *GenericIdentification47 `xml:"DtCd"`
 }
 
+func (s *SettlementDate14Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDate14Choice) AddDate() *DateAndDateTimeChoice {
 	s.Date = new(DateAndDateTimeChoice)
 	return s.Date
@@ -10,6 +10,10 @@ type SettlementDate15Choice struct {
 	DateCode *SettlementDateCode11Choice `xml:"DtCd"`
 }
 
+func (s *SettlementDate15Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDate15Choice) AddDate() *DateAndDateTimeChoice {
 	s.Date = new(DateAndDateTimeChoice)
 	return s.Date
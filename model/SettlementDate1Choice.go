@@ -10,6 +10,10 @@ type SettlementDate1Choice struct {
 	DateCode *SettlementDateCode1Choice `xml:"DtCd"`
 }
 
+func (s *SettlementDate1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDate1Choice) AddDate() *DateAndDateTimeChoice {
 	s.Date = new(DateAndDateTimeChoice)
 	return s.Date
@@ -10,6 +10,10 @@ type SettlementDate2Choice struct {
 	DateCode *SettlementDateCode2Choice `xml:"DtCd"`
 }
 
+func (s *SettlementDate2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDate2Choice) AddDate() *DateAndDateTimeChoice {
 	s.Date = new(DateAndDateTimeChoice)
 	return s.Date
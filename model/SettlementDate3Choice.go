@@ -10,6 +10,10 @@ type SettlementDate3Choice struct {
 	DateCode *GenericIdentification20 `xml:"DtCd"`
 }
 
+func (s *SettlementDate3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDate3Choice) AddDate() *DateAndDateTimeChoice {
 	s.Date = new(DateAndDateTimeChoice)
 	return s.Date
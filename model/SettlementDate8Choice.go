@@ -10,6 +10,10 @@ type SettlementDate8Choice struct {
 	Code *SettlementDateCode5Choice `xml:"Cd"`
 }
 
+func (s *SettlementDate8Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDate8Choice) AddDate() *DateAndDateTime1Choice {
 	s.Date = new(DateAndDateTime1Choice)
 	return s.Date
@@ -10,6 +10,10 @@ type SettlementDate9Choice struct {
 	DateCode *SettlementDateCode7Choice `xml:"DtCd"`
 }
 
+func (s *SettlementDate9Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDate9Choice) AddDate() *DateAndDateTimeChoice {
 	s.Date = new(DateAndDateTimeChoice)
 	return s.Date
@@ -10,6 +10,10 @@ type SettlementDateCode11Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SettlementDateCode11Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDateCode11Choice) SetCode(value string) {
 	s.Code = (*DateType4Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementDateCode1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SettlementDateCode1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDateCode1Choice) SetCode(value string) {
 	s.Code = (*SettlementDate4Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementDateCode2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SettlementDateCode2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDateCode2Choice) SetCode(value string) {
 	s.Code = (*DateType4Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementDateCode5Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (s *SettlementDateCode5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDateCode5Choice) SetCode(value string) {
 	s.Code = (*SettlementDate5Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementDateCode7Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SettlementDateCode7Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDateCode7Choice) SetCode(value string) {
 	s.Code = (*SettlementDate4Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementDateCode8Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SettlementDateCode8Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDateCode8Choice) SetCode(value string) {
 	s.Code = (*DateType4Code)(&value)
 }
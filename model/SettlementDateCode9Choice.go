@@ -10,6 +10,10 @@ type SettlementDateCode9Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SettlementDateCode9Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDateCode9Choice) SetCode(value string) {
 	s.Code = (*SettlementDate4Code)(&value)
 }
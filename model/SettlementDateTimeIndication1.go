@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Information on the occurred settlement time(s) of the payment transaction.
 type SettlementDateTimeIndication1 struct {
 
@@ -10,10 +16,65 @@ type SettlementDateTimeIndication1 struct {
 	CreditDateTime *ISODateTime `xml:"CdtDtTm,omitempty"`
 }
 
+func (s *SettlementDateTimeIndication1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDateTimeIndication1) SetDebitDateTime(value string) {
 	s.DebitDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SettlementDateTimeIndication1) SetDebitDateTimeFromTime(value time.Time) {
+	s.DebitDateTime = new(ISODateTime)
+	s.DebitDateTime.FromTime(value)
+}
+
 func (s *SettlementDateTimeIndication1) SetCreditDateTime(value string) {
 	s.CreditDateTime = (*ISODateTime)(&value)
 }
+
+func (s *SettlementDateTimeIndication1) SetCreditDateTimeFromTime(value time.Time) {
+	s.CreditDateTime = new(ISODateTime)
+	s.CreditDateTime.FromTime(value)
+}
+
+func (s *SettlementDateTimeIndication1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if s.DebitDateTime != nil {
+		enc.Element("DbtDtTm", string(*s.DebitDateTime))
+	}
+	if s.CreditDateTime != nil {
+		enc.Element("CdtDtTm", string(*s.CreditDateTime))
+	}
+	enc.End(tag)
+}
+
+func (s *SettlementDateTimeIndication1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "DbtDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.DebitDateTime = (*ISODateTime)(&value)
+		case "CdtDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.CreditDateTime = (*ISODateTime)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
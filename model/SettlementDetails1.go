@@ -88,10 +88,19 @@ type SettlementDetails1 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails1) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails1) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails1) AddPriority() *PriorityNumeric1Choice {
 	s.Priority = new(PriorityNumeric1Choice)
 	return s.Priority
@@ -252,6 +261,11 @@ func (s *SettlementDetails1) SetPartialSettlementIndicator(value string) {
 	s.PartialSettlementIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails1) SetPartialSettlementIndicatorFromBool(value bool) {
+	s.PartialSettlementIndicator = new(YesNoIndicator)
+	s.PartialSettlementIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails1) AddBeneficialOwnership() *BeneficialOwnership1Choice {
 	s.BeneficialOwnership = new(BeneficialOwnership1Choice)
 	return s.BeneficialOwnership
@@ -356,6 +370,11 @@ func (s *SettlementDetails1) SetReturnLeg(value string) {
 	s.ReturnLeg = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails1) SetReturnLegFromBool(value bool) {
+	s.ReturnLeg = new(YesNoIndicator)
+	s.ReturnLeg.FromBool(value)
+}
+
 func (s *SettlementDetails1) AddModificationCancellationAllowed() *ModificationCancellationAllowed1Choice {
 	s.ModificationCancellationAllowed = new(ModificationCancellationAllowed1Choice)
 	return s.ModificationCancellationAllowed
@@ -364,3 +383,8 @@ func (s *SettlementDetails1) AddModificationCancellationAllowed() *ModificationC
 func (s *SettlementDetails1) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails1) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
@@ -52,10 +52,19 @@ type SettlementDetails10 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails10) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails10) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails10) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails10) AddSettlementTransactionCondition() *SettlementTransactionCondition1Choice {
 	newValue := new(SettlementTransactionCondition1Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
@@ -266,6 +275,16 @@ func (s *SettlementDetails10) SetPartialSettlementIndicator(value string) {
 	s.PartialSettlementIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails10) SetPartialSettlementIndicatorFromBool(value bool) {
+	s.PartialSettlementIndicator = new(YesNoIndicator)
+	s.PartialSettlementIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails10) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails10) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
@@ -25,6 +25,10 @@ type SettlementDetails100 struct {
 	StampDutyTaxBasis *GenericIdentification30 `xml:"StmpDtyTaxBsis,omitempty"`
 }
 
+func (s *SettlementDetails100) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails100) AddSettlementTransactionCondition() *SettlementTransactionCondition19Choice {
 	newValue := new(SettlementTransactionCondition19Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Parameters which explicitly state the conditions that must be fulfilled before a particular  transaction of a financial instrument can be settled.  These parameters are defined by the instructing party in compliance with settlement rules in the market the transaction will settle in.
 type SettlementDetails102 struct {
 
@@ -13,10 +17,19 @@ type SettlementDetails102 struct {
 	CollateralOwnership *CollateralOwnership2 `xml:"CollOwnrsh"`
 }
 
+func (s *SettlementDetails102) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails102) SetTradeDate(value string) {
 	s.TradeDate = (*ISODateTime)(&value)
 }
 
+func (s *SettlementDetails102) SetTradeDateFromTime(value time.Time) {
+	s.TradeDate = new(ISODateTime)
+	s.TradeDate.FromTime(value)
+}
+
 func (s *SettlementDetails102) AddSettlementParties() *SettlementParties5Choice {
 	s.SettlementParties = new(SettlementParties5Choice)
 	return s.SettlementParties
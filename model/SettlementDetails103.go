@@ -25,6 +25,10 @@ type SettlementDetails103 struct {
 	StampDutyTaxBasis *GenericIdentification47 `xml:"StmpDtyTaxBsis,omitempty"`
 }
 
+func (s *SettlementDetails103) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails103) AddSettlementTransactionCondition() *SettlementTransactionCondition21Choice {
 	newValue := new(SettlementTransactionCondition21Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
@@ -55,6 +55,10 @@ type SettlementDetails104 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails104) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails104) AddPriority() *PriorityNumeric5Choice {
 	s.Priority = new(PriorityNumeric5Choice)
 	return s.Priority
@@ -278,3 +282,8 @@ func (s *SettlementDetails104) SetPartialSettlementIndicator(value string) {
 func (s *SettlementDetails104) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails104) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
@@ -64,10 +64,19 @@ type SettlementDetails105 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails105) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails105) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails105) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails105) AddPriority() *PriorityNumeric5Choice {
 	s.Priority = new(PriorityNumeric5Choice)
 	return s.Priority
@@ -301,3 +310,8 @@ func (s *SettlementDetails105) SetPartialSettlementIndicator(value string) {
 func (s *SettlementDetails105) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails105) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
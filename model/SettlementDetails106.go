@@ -64,10 +64,19 @@ type SettlementDetails106 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails106) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails106) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails106) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails106) AddPriority() *PriorityNumeric5Choice {
 	s.Priority = new(PriorityNumeric5Choice)
 	return s.Priority
@@ -301,3 +310,8 @@ func (s *SettlementDetails106) SetPartialSettlementIndicator(value string) {
 func (s *SettlementDetails106) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails106) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
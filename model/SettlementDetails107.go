@@ -52,10 +52,19 @@ type SettlementDetails107 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails107) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails107) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails107) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails107) AddSettlementTransactionCondition() *SettlementTransactionCondition22Choice {
 	newValue := new(SettlementTransactionCondition22Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
@@ -269,3 +278,8 @@ func (s *SettlementDetails107) SetPartialSettlementIndicator(value string) {
 func (s *SettlementDetails107) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails107) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
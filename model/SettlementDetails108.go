@@ -37,10 +37,19 @@ type SettlementDetails108 struct {
 	StampDutyTaxBasis *GenericIdentification47 `xml:"StmpDtyTaxBsis,omitempty"`
 }
 
+func (s *SettlementDetails108) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails108) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails108) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails108) AddSecuritiesTransactionType() *SecuritiesTransactionType22Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType22Choice)
 	return s.SecuritiesTransactionType
@@ -76,6 +76,10 @@ type SettlementDetails110 struct {
 	CashSubBalanceType *GenericIdentification47 `xml:"CshSubBalTp,omitempty"`
 }
 
+func (s *SettlementDetails110) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails110) AddPriority() *PriorityNumeric5Choice {
 	s.Priority = new(PriorityNumeric5Choice)
 	return s.Priority
@@ -325,6 +329,11 @@ func (s *SettlementDetails110) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails110) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
+
 func (s *SettlementDetails110) AddSecuritiesSubBalanceType() *GenericIdentification47 {
 	s.SecuritiesSubBalanceType = new(GenericIdentification47)
 	return s.SecuritiesSubBalanceType
@@ -94,6 +94,10 @@ type SettlementDetails111 struct {
 	CashSubBalanceType *GenericIdentification47 `xml:"CshSubBalTp,omitempty"`
 }
 
+func (s *SettlementDetails111) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails111) AddHoldIndicator() *HoldIndicator7 {
 	s.HoldIndicator = new(HoldIndicator7)
 	return s.HoldIndicator
@@ -363,6 +367,11 @@ func (s *SettlementDetails111) SetReturnLeg(value string) {
 	s.ReturnLeg = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails111) SetReturnLegFromBool(value bool) {
+	s.ReturnLeg = new(YesNoIndicator)
+	s.ReturnLeg.FromBool(value)
+}
+
 func (s *SettlementDetails111) AddModificationCancellationAllowed() *ModificationCancellationAllowed5Choice {
 	s.ModificationCancellationAllowed = new(ModificationCancellationAllowed5Choice)
 	return s.ModificationCancellationAllowed
@@ -372,6 +381,11 @@ func (s *SettlementDetails111) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails111) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
+
 func (s *SettlementDetails111) AddSecuritiesSubBalanceType() *GenericIdentification47 {
 	s.SecuritiesSubBalanceType = new(GenericIdentification47)
 	return s.SecuritiesSubBalanceType
@@ -70,6 +70,10 @@ type SettlementDetails113 struct {
 	CashSubBalanceType *GenericIdentification47 `xml:"CshSubBalTp,omitempty"`
 }
 
+func (s *SettlementDetails113) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails113) AddSecuritiesTransactionType() *SecuritiesTransactionType25Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType25Choice)
 	return s.SecuritiesTransactionType
@@ -300,6 +304,11 @@ func (s *SettlementDetails113) SetReturnLeg(value string) {
 	s.ReturnLeg = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails113) SetReturnLegFromBool(value bool) {
+	s.ReturnLeg = new(YesNoIndicator)
+	s.ReturnLeg.FromBool(value)
+}
+
 func (s *SettlementDetails113) AddModificationCancellationAllowed() *ModificationCancellationAllowed5Choice {
 	s.ModificationCancellationAllowed = new(ModificationCancellationAllowed5Choice)
 	return s.ModificationCancellationAllowed
@@ -309,6 +318,11 @@ func (s *SettlementDetails113) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails113) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
+
 func (s *SettlementDetails113) AddSecuritiesSubBalanceType() *GenericIdentification47 {
 	s.SecuritiesSubBalanceType = new(GenericIdentification47)
 	return s.SecuritiesSubBalanceType
@@ -67,6 +67,10 @@ type SettlementDetails115 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails115) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails115) AddHoldIndicator() *HoldIndicator7 {
 	s.HoldIndicator = new(HoldIndicator7)
 	return s.HoldIndicator
@@ -310,3 +314,8 @@ func (s *SettlementDetails115) SetPartialSettlementIndicator(value string) {
 func (s *SettlementDetails115) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails115) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
@@ -58,6 +58,10 @@ type SettlementDetails116 struct {
 	PartialSettlementIndicator *SettlementTransactionCondition5Code `xml:"PrtlSttlmInd,omitempty"`
 }
 
+func (s *SettlementDetails116) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails116) AddHoldIndicator() *HoldIndicator7 {
 	s.HoldIndicator = new(HoldIndicator7)
 	return s.HoldIndicator
@@ -55,6 +55,10 @@ type SettlementDetails117 struct {
 	PartialSettlementIndicator *SettlementTransactionCondition5Code `xml:"PrtlSttlmInd,omitempty"`
 }
 
+func (s *SettlementDetails117) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails117) AddSettlementTransactionCondition() *SettlementTransactionCondition28Choice {
 	newValue := new(SettlementTransactionCondition28Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
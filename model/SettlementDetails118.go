@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Parameters which explicitly state the conditions that must be fulfilled before a particular  transaction of a financial instrument can be settled.  These parameters are defined by the instructing party in compliance with settlement rules in the market the transaction will settle in.
 type SettlementDetails118 struct {
 
@@ -13,10 +17,19 @@ type SettlementDetails118 struct {
 	CollateralOwnership *CollateralOwnership2 `xml:"CollOwnrsh"`
 }
 
+func (s *SettlementDetails118) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails118) SetTradeDate(value string) {
 	s.TradeDate = (*ISODateTime)(&value)
 }
 
+func (s *SettlementDetails118) SetTradeDateFromTime(value time.Time) {
+	s.TradeDate = new(ISODateTime)
+	s.TradeDate.FromTime(value)
+}
+
 func (s *SettlementDetails118) AddSettlementParties() *SettlementParties7Choice {
 	s.SettlementParties = new(SettlementParties7Choice)
 	return s.SettlementParties
@@ -94,6 +94,10 @@ type SettlementDetails119 struct {
 	CashSubBalanceType *GenericIdentification30 `xml:"CshSubBalTp,omitempty"`
 }
 
+func (s *SettlementDetails119) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails119) AddHoldIndicator() *HoldIndicator6 {
 	s.HoldIndicator = new(HoldIndicator6)
 	return s.HoldIndicator
@@ -363,6 +367,11 @@ func (s *SettlementDetails119) SetReturnLeg(value string) {
 	s.ReturnLeg = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails119) SetReturnLegFromBool(value bool) {
+	s.ReturnLeg = new(YesNoIndicator)
+	s.ReturnLeg.FromBool(value)
+}
+
 func (s *SettlementDetails119) AddModificationCancellationAllowed() *ModificationCancellationAllowed4Choice {
 	s.ModificationCancellationAllowed = new(ModificationCancellationAllowed4Choice)
 	return s.ModificationCancellationAllowed
@@ -372,6 +381,11 @@ func (s *SettlementDetails119) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails119) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
+
 func (s *SettlementDetails119) AddSecuritiesSubBalanceType() *GenericIdentification30 {
 	s.SecuritiesSubBalanceType = new(GenericIdentification30)
 	return s.SecuritiesSubBalanceType
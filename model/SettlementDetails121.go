@@ -67,6 +67,10 @@ type SettlementDetails121 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails121) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails121) AddHoldIndicator() *HoldIndicator6 {
 	s.HoldIndicator = new(HoldIndicator6)
 	return s.HoldIndicator
@@ -310,3 +314,8 @@ func (s *SettlementDetails121) SetPartialSettlementIndicator(value string) {
 func (s *SettlementDetails121) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails121) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
@@ -70,6 +70,10 @@ type SettlementDetails122 struct {
 	CashSubBalanceType *GenericIdentification30 `xml:"CshSubBalTp,omitempty"`
 }
 
+func (s *SettlementDetails122) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails122) AddSecuritiesTransactionType() *SecuritiesTransactionType32Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType32Choice)
 	return s.SecuritiesTransactionType
@@ -300,6 +304,11 @@ func (s *SettlementDetails122) SetReturnLeg(value string) {
 	s.ReturnLeg = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails122) SetReturnLegFromBool(value bool) {
+	s.ReturnLeg = new(YesNoIndicator)
+	s.ReturnLeg.FromBool(value)
+}
+
 func (s *SettlementDetails122) AddModificationCancellationAllowed() *ModificationCancellationAllowed4Choice {
 	s.ModificationCancellationAllowed = new(ModificationCancellationAllowed4Choice)
 	return s.ModificationCancellationAllowed
@@ -309,6 +318,11 @@ func (s *SettlementDetails122) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails122) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
+
 func (s *SettlementDetails122) AddSecuritiesSubBalanceType() *GenericIdentification30 {
 	s.SecuritiesSubBalanceType = new(GenericIdentification30)
 	return s.SecuritiesSubBalanceType
@@ -37,10 +37,19 @@ type SettlementDetails125 struct {
 	StampDutyTaxBasis *GenericIdentification30 `xml:"StmpDtyTaxBsis,omitempty"`
 }
 
+func (s *SettlementDetails125) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails125) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails125) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails125) AddSecuritiesTransactionType() *SecuritiesTransactionType33Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType33Choice)
 	return s.SecuritiesTransactionType
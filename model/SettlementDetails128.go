@@ -76,6 +76,10 @@ type SettlementDetails128 struct {
 	CashSubBalanceType *GenericIdentification30 `xml:"CshSubBalTp,omitempty"`
 }
 
+func (s *SettlementDetails128) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails128) AddPriority() *PriorityNumeric4Choice {
 	s.Priority = new(PriorityNumeric4Choice)
 	return s.Priority
@@ -325,6 +329,11 @@ func (s *SettlementDetails128) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails128) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
+
 func (s *SettlementDetails128) AddSecuritiesSubBalanceType() *GenericIdentification30 {
 	s.SecuritiesSubBalanceType = new(GenericIdentification30)
 	return s.SecuritiesSubBalanceType
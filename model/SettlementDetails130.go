@@ -58,6 +58,10 @@ type SettlementDetails130 struct {
 	PartialSettlementIndicator *SettlementTransactionCondition5Code `xml:"PrtlSttlmInd,omitempty"`
 }
 
+func (s *SettlementDetails130) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails130) AddHoldIndicator() *HoldIndicator7 {
 	s.HoldIndicator = new(HoldIndicator7)
 	return s.HoldIndicator
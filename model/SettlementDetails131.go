@@ -37,10 +37,19 @@ type SettlementDetails131 struct {
 	StampDutyTaxBasis *GenericIdentification47 `xml:"StmpDtyTaxBsis,omitempty"`
 }
 
+func (s *SettlementDetails131) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails131) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails131) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails131) AddSecuritiesTransactionType() *SecuritiesTransactionType35Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType35Choice)
 	return s.SecuritiesTransactionType
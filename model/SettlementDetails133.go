@@ -67,6 +67,10 @@ type SettlementDetails133 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails133) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails133) AddHoldIndicator() *HoldIndicator7 {
 	s.HoldIndicator = new(HoldIndicator7)
 	return s.HoldIndicator
@@ -310,3 +314,8 @@ func (s *SettlementDetails133) SetPartialSettlementIndicator(value string) {
 func (s *SettlementDetails133) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails133) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
@@ -55,6 +55,10 @@ type SettlementDetails139 struct {
 	PartialSettlementIndicator *SettlementTransactionCondition5Code `xml:"PrtlSttlmInd,omitempty"`
 }
 
+func (s *SettlementDetails139) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails139) AddSettlementTransactionCondition() *SettlementTransactionCondition28Choice {
 	newValue := new(SettlementTransactionCondition28Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
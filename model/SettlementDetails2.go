@@ -55,6 +55,10 @@ type SettlementDetails2 struct {
 	PartialSettlementIndicator *YesNoIndicator `xml:"PrtlSttlmInd,omitempty"`
 }
 
+func (s *SettlementDetails2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails2) AddSettlementTransactionCondition() *SettlementTransactionCondition1Choice {
 	newValue := new(SettlementTransactionCondition1Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
@@ -279,3 +283,8 @@ func (s *SettlementDetails2) AddLetterOfGuarantee() *LetterOfGuarantee1Choice {
 func (s *SettlementDetails2) SetPartialSettlementIndicator(value string) {
 	s.PartialSettlementIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails2) SetPartialSettlementIndicatorFromBool(value bool) {
+	s.PartialSettlementIndicator = new(YesNoIndicator)
+	s.PartialSettlementIndicator.FromBool(value)
+}
@@ -94,6 +94,10 @@ type SettlementDetails22 struct {
 	CashSubBalanceType *GenericIdentification19 `xml:"CshSubBalTp,omitempty"`
 }
 
+func (s *SettlementDetails22) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails22) AddHoldIndicator() *HoldIndicator2 {
 	s.HoldIndicator = new(HoldIndicator2)
 	return s.HoldIndicator
@@ -363,6 +367,11 @@ func (s *SettlementDetails22) SetReturnLeg(value string) {
 	s.ReturnLeg = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails22) SetReturnLegFromBool(value bool) {
+	s.ReturnLeg = new(YesNoIndicator)
+	s.ReturnLeg.FromBool(value)
+}
+
 func (s *SettlementDetails22) AddModificationCancellationAllowed() *ModificationCancellationAllowed1Choice {
 	s.ModificationCancellationAllowed = new(ModificationCancellationAllowed1Choice)
 	return s.ModificationCancellationAllowed
@@ -372,6 +381,11 @@ func (s *SettlementDetails22) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails22) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
+
 func (s *SettlementDetails22) AddSecuritiesSubBalanceType() *GenericIdentification19 {
 	s.SecuritiesSubBalanceType = new(GenericIdentification19)
 	return s.SecuritiesSubBalanceType
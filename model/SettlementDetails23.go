@@ -67,6 +67,10 @@ type SettlementDetails23 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails23) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails23) AddHoldIndicator() *HoldIndicator2 {
 	s.HoldIndicator = new(HoldIndicator2)
 	return s.HoldIndicator
@@ -310,3 +314,8 @@ func (s *SettlementDetails23) SetPartialSettlementIndicator(value string) {
 func (s *SettlementDetails23) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails23) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
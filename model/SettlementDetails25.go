@@ -37,10 +37,19 @@ type SettlementDetails25 struct {
 	StampDutyTaxBasis *GenericIdentification20 `xml:"StmpDtyTaxBsis,omitempty"`
 }
 
+func (s *SettlementDetails25) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails25) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails25) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails25) AddSecuritiesTransactionType() *SecuritiesTransactionType7Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType7Choice)
 	return s.SecuritiesTransactionType
@@ -196,6 +205,11 @@ func (s *SettlementDetails25) SetPartialSettlementIndicator(value string) {
 	s.PartialSettlementIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails25) SetPartialSettlementIndicatorFromBool(value bool) {
+	s.PartialSettlementIndicator = new(YesNoIndicator)
+	s.PartialSettlementIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails25) AddBeneficialOwnership() *BeneficialOwnership1Choice {
 	s.BeneficialOwnership = new(BeneficialOwnership1Choice)
 	return s.BeneficialOwnership
@@ -76,6 +76,10 @@ type SettlementDetails26 struct {
 	CashSubBalanceType *GenericIdentification19 `xml:"CshSubBalTp,omitempty"`
 }
 
+func (s *SettlementDetails26) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails26) AddPriority() *PriorityNumeric1Choice {
 	s.Priority = new(PriorityNumeric1Choice)
 	return s.Priority
@@ -325,6 +329,11 @@ func (s *SettlementDetails26) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails26) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
+
 func (s *SettlementDetails26) AddSecuritiesSubBalanceType() *GenericIdentification19 {
 	s.SecuritiesSubBalanceType = new(GenericIdentification19)
 	return s.SecuritiesSubBalanceType
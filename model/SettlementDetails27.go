@@ -55,6 +55,10 @@ type SettlementDetails27 struct {
 	PartialSettlementIndicator *SettlementTransactionCondition5Code `xml:"PrtlSttlmInd,omitempty"`
 }
 
+func (s *SettlementDetails27) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails27) AddSettlementTransactionCondition() *SettlementTransactionCondition6Choice {
 	newValue := new(SettlementTransactionCondition6Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
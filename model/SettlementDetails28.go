@@ -58,6 +58,10 @@ type SettlementDetails28 struct {
 	PartialSettlementIndicator *SettlementTransactionCondition5Code `xml:"PrtlSttlmInd,omitempty"`
 }
 
+func (s *SettlementDetails28) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails28) AddHoldIndicator() *HoldIndicator2 {
 	s.HoldIndicator = new(HoldIndicator2)
 	return s.HoldIndicator
@@ -52,10 +52,19 @@ type SettlementDetails29 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails29) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails29) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails29) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails29) AddSettlementTransactionCondition() *SettlementTransactionCondition7Choice {
 	newValue := new(SettlementTransactionCondition7Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
@@ -266,6 +275,16 @@ func (s *SettlementDetails29) SetPartialSettlementIndicator(value string) {
 	s.PartialSettlementIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails29) SetPartialSettlementIndicatorFromBool(value bool) {
+	s.PartialSettlementIndicator = new(YesNoIndicator)
+	s.PartialSettlementIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails29) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails29) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
@@ -25,6 +25,10 @@ type SettlementDetails4 struct {
 	StampDutyTaxBasis *GenericIdentification20 `xml:"StmpDtyTaxBsis,omitempty"`
 }
 
+func (s *SettlementDetails4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails4) AddSettlementTransactionCondition() *SettlementTransactionCondition2Choice {
 	newValue := new(SettlementTransactionCondition2Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
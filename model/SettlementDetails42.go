@@ -94,6 +94,10 @@ type SettlementDetails42 struct {
 	CashSubBalanceType *GenericIdentification20 `xml:"CshSubBalTp,omitempty"`
 }
 
+func (s *SettlementDetails42) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails42) AddHoldIndicator() *HoldIndicator4 {
 	s.HoldIndicator = new(HoldIndicator4)
 	return s.HoldIndicator
@@ -363,6 +367,11 @@ func (s *SettlementDetails42) SetReturnLeg(value string) {
 	s.ReturnLeg = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails42) SetReturnLegFromBool(value bool) {
+	s.ReturnLeg = new(YesNoIndicator)
+	s.ReturnLeg.FromBool(value)
+}
+
 func (s *SettlementDetails42) AddModificationCancellationAllowed() *ModificationCancellationAllowed1Choice {
 	s.ModificationCancellationAllowed = new(ModificationCancellationAllowed1Choice)
 	return s.ModificationCancellationAllowed
@@ -372,6 +381,11 @@ func (s *SettlementDetails42) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails42) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
+
 func (s *SettlementDetails42) AddSecuritiesSubBalanceType() *GenericIdentification20 {
 	s.SecuritiesSubBalanceType = new(GenericIdentification20)
 	return s.SecuritiesSubBalanceType
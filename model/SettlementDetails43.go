@@ -94,6 +94,10 @@ type SettlementDetails43 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails43) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails43) AddSettlementTransactionType() *SettlementTransactionType1Choice {
 	s.SettlementTransactionType = new(SettlementTransactionType1Choice)
 	return s.SettlementTransactionType
@@ -103,6 +107,11 @@ func (s *SettlementDetails43) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails43) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails43) AddPriority() *PriorityNumeric3Choice {
 	s.Priority = new(PriorityNumeric3Choice)
 	return s.Priority
@@ -263,6 +272,11 @@ func (s *SettlementDetails43) SetPartialSettlementIndicator(value string) {
 	s.PartialSettlementIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails43) SetPartialSettlementIndicatorFromBool(value bool) {
+	s.PartialSettlementIndicator = new(YesNoIndicator)
+	s.PartialSettlementIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails43) AddBeneficialOwnership() *BeneficialOwnership3Choice {
 	s.BeneficialOwnership = new(BeneficialOwnership3Choice)
 	return s.BeneficialOwnership
@@ -347,6 +361,11 @@ func (s *SettlementDetails43) SetStampDutyIndicator(value string) {
 	s.StampDutyIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails43) SetStampDutyIndicatorFromBool(value bool) {
+	s.StampDutyIndicator = new(YesNoIndicator)
+	s.StampDutyIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails43) AddStampDutyTaxBasis() *GenericIdentification38 {
 	s.StampDutyTaxBasis = new(GenericIdentification38)
 	return s.StampDutyTaxBasis
@@ -371,6 +390,11 @@ func (s *SettlementDetails43) SetReturnLeg(value string) {
 	s.ReturnLeg = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails43) SetReturnLegFromBool(value bool) {
+	s.ReturnLeg = new(YesNoIndicator)
+	s.ReturnLeg.FromBool(value)
+}
+
 func (s *SettlementDetails43) AddModificationCancellationAllowed() *ModificationCancellationAllowed3Choice {
 	s.ModificationCancellationAllowed = new(ModificationCancellationAllowed3Choice)
 	return s.ModificationCancellationAllowed
@@ -379,3 +403,8 @@ func (s *SettlementDetails43) AddModificationCancellationAllowed() *Modification
 func (s *SettlementDetails43) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails43) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
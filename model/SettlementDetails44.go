@@ -67,6 +67,10 @@ type SettlementDetails44 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails44) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails44) AddHoldIndicator() *HoldIndicator4 {
 	s.HoldIndicator = new(HoldIndicator4)
 	return s.HoldIndicator
@@ -310,3 +314,8 @@ func (s *SettlementDetails44) SetPartialSettlementIndicator(value string) {
 func (s *SettlementDetails44) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails44) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
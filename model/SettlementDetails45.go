@@ -76,6 +76,10 @@ type SettlementDetails45 struct {
 	CashSubBalanceType *GenericIdentification20 `xml:"CshSubBalTp,omitempty"`
 }
 
+func (s *SettlementDetails45) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails45) AddPriority() *PriorityNumeric1Choice {
 	s.Priority = new(PriorityNumeric1Choice)
 	return s.Priority
@@ -325,6 +329,11 @@ func (s *SettlementDetails45) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails45) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
+
 func (s *SettlementDetails45) AddSecuritiesSubBalanceType() *GenericIdentification20 {
 	s.SecuritiesSubBalanceType = new(GenericIdentification20)
 	return s.SecuritiesSubBalanceType
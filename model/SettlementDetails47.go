@@ -58,6 +58,10 @@ type SettlementDetails47 struct {
 	PartialSettlementIndicator *SettlementTransactionCondition5Code `xml:"PrtlSttlmInd,omitempty"`
 }
 
+func (s *SettlementDetails47) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails47) AddHoldIndicator() *HoldIndicator4 {
 	s.HoldIndicator = new(HoldIndicator4)
 	return s.HoldIndicator
@@ -55,6 +55,10 @@ type SettlementDetails48 struct {
 	PartialSettlementIndicator *SettlementTransactionCondition5Code `xml:"PrtlSttlmInd,omitempty"`
 }
 
+func (s *SettlementDetails48) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails48) AddSettlementTransactionCondition() *SettlementTransactionCondition12Choice {
 	newValue := new(SettlementTransactionCondition12Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
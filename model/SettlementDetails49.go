@@ -37,10 +37,19 @@ type SettlementDetails49 struct {
 	StampDutyTaxBasis *GenericIdentification20 `xml:"StmpDtyTaxBsis,omitempty"`
 }
 
+func (s *SettlementDetails49) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails49) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails49) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails49) AddSecuritiesTransactionType() *SecuritiesTransactionType12Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType12Choice)
 	return s.SecuritiesTransactionType
@@ -61,10 +61,19 @@ type SettlementDetails5 struct {
 	LetterOfGuarantee *LetterOfGuarantee1Choice `xml:"LttrOfGrnt,omitempty"`
 }
 
+func (s *SettlementDetails5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails5) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails5) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails5) AddPriority() *PriorityNumeric1Choice {
 	s.Priority = new(PriorityNumeric1Choice)
 	return s.Priority
@@ -225,6 +234,11 @@ func (s *SettlementDetails5) SetPartialSettlementIndicator(value string) {
 	s.PartialSettlementIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails5) SetPartialSettlementIndicatorFromBool(value bool) {
+	s.PartialSettlementIndicator = new(YesNoIndicator)
+	s.PartialSettlementIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails5) AddBeneficialOwnership() *BeneficialOwnership1Choice {
 	s.BeneficialOwnership = new(BeneficialOwnership1Choice)
 	return s.BeneficialOwnership
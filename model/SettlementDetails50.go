@@ -70,6 +70,10 @@ type SettlementDetails50 struct {
 	CashSubBalanceType *GenericIdentification20 `xml:"CshSubBalTp,omitempty"`
 }
 
+func (s *SettlementDetails50) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails50) AddSecuritiesTransactionType() *SecuritiesTransactionType9Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType9Choice)
 	return s.SecuritiesTransactionType
@@ -300,6 +304,11 @@ func (s *SettlementDetails50) SetReturnLeg(value string) {
 	s.ReturnLeg = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails50) SetReturnLegFromBool(value bool) {
+	s.ReturnLeg = new(YesNoIndicator)
+	s.ReturnLeg.FromBool(value)
+}
+
 func (s *SettlementDetails50) AddModificationCancellationAllowed() *ModificationCancellationAllowed1Choice {
 	s.ModificationCancellationAllowed = new(ModificationCancellationAllowed1Choice)
 	return s.ModificationCancellationAllowed
@@ -309,6 +318,11 @@ func (s *SettlementDetails50) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails50) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
+
 func (s *SettlementDetails50) AddSecuritiesSubBalanceType() *GenericIdentification20 {
 	s.SecuritiesSubBalanceType = new(GenericIdentification20)
 	return s.SecuritiesSubBalanceType
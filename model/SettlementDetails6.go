@@ -70,6 +70,10 @@ type SettlementDetails6 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails6) AddPriority() *PriorityNumeric1Choice {
 	s.Priority = new(PriorityNumeric1Choice)
 	return s.Priority
@@ -230,6 +234,11 @@ func (s *SettlementDetails6) SetPartialSettlementIndicator(value string) {
 	s.PartialSettlementIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails6) SetPartialSettlementIndicatorFromBool(value bool) {
+	s.PartialSettlementIndicator = new(YesNoIndicator)
+	s.PartialSettlementIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails6) AddBeneficialOwnership() *BeneficialOwnership1Choice {
 	s.BeneficialOwnership = new(BeneficialOwnership1Choice)
 	return s.BeneficialOwnership
@@ -318,3 +327,8 @@ func (s *SettlementDetails6) AddLetterOfGuarantee() *LetterOfGuarantee1Choice {
 func (s *SettlementDetails6) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails6) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
@@ -52,10 +52,19 @@ type SettlementDetails61 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails61) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails61) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails61) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails61) AddSettlementTransactionCondition() *SettlementTransactionCondition7Choice {
 	newValue := new(SettlementTransactionCondition7Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
@@ -269,3 +278,8 @@ func (s *SettlementDetails61) SetPartialSettlementIndicator(value string) {
 func (s *SettlementDetails61) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails61) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
@@ -94,6 +94,10 @@ type SettlementDetails67 struct {
 	CashSubBalanceType *GenericIdentification20 `xml:"CshSubBalTp,omitempty"`
 }
 
+func (s *SettlementDetails67) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails67) AddHoldIndicator() *HoldIndicator4 {
 	s.HoldIndicator = new(HoldIndicator4)
 	return s.HoldIndicator
@@ -363,6 +367,11 @@ func (s *SettlementDetails67) SetReturnLeg(value string) {
 	s.ReturnLeg = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails67) SetReturnLegFromBool(value bool) {
+	s.ReturnLeg = new(YesNoIndicator)
+	s.ReturnLeg.FromBool(value)
+}
+
 func (s *SettlementDetails67) AddModificationCancellationAllowed() *ModificationCancellationAllowed1Choice {
 	s.ModificationCancellationAllowed = new(ModificationCancellationAllowed1Choice)
 	return s.ModificationCancellationAllowed
@@ -372,6 +381,11 @@ func (s *SettlementDetails67) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails67) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
+
 func (s *SettlementDetails67) AddSecuritiesSubBalanceType() *GenericIdentification20 {
 	s.SecuritiesSubBalanceType = new(GenericIdentification20)
 	return s.SecuritiesSubBalanceType
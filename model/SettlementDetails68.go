@@ -70,6 +70,10 @@ type SettlementDetails68 struct {
 	CashSubBalanceType *GenericIdentification20 `xml:"CshSubBalTp,omitempty"`
 }
 
+func (s *SettlementDetails68) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails68) AddSecuritiesTransactionType() *SecuritiesTransactionType9Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType9Choice)
 	return s.SecuritiesTransactionType
@@ -300,6 +304,11 @@ func (s *SettlementDetails68) SetReturnLeg(value string) {
 	s.ReturnLeg = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails68) SetReturnLegFromBool(value bool) {
+	s.ReturnLeg = new(YesNoIndicator)
+	s.ReturnLeg.FromBool(value)
+}
+
 func (s *SettlementDetails68) AddModificationCancellationAllowed() *ModificationCancellationAllowed1Choice {
 	s.ModificationCancellationAllowed = new(ModificationCancellationAllowed1Choice)
 	return s.ModificationCancellationAllowed
@@ -309,6 +318,11 @@ func (s *SettlementDetails68) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails68) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
+
 func (s *SettlementDetails68) AddSecuritiesSubBalanceType() *GenericIdentification20 {
 	s.SecuritiesSubBalanceType = new(GenericIdentification20)
 	return s.SecuritiesSubBalanceType
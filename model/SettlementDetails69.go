@@ -67,6 +67,10 @@ type SettlementDetails69 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails69) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails69) AddHoldIndicator() *HoldIndicator4 {
 	s.HoldIndicator = new(HoldIndicator4)
 	return s.HoldIndicator
@@ -310,3 +314,8 @@ func (s *SettlementDetails69) SetPartialSettlementIndicator(value string) {
 func (s *SettlementDetails69) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails69) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
@@ -58,10 +58,19 @@ type SettlementDetails7 struct {
 	PartialSettlementIndicator *YesNoIndicator `xml:"PrtlSttlmInd,omitempty"`
 }
 
+func (s *SettlementDetails7) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails7) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails7) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails7) AddSettlementTransactionCondition() *SettlementTransactionCondition1Choice {
 	newValue := new(SettlementTransactionCondition1Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
@@ -286,3 +295,8 @@ func (s *SettlementDetails7) AddLetterOfGuarantee() *LetterOfGuarantee1Choice {
 func (s *SettlementDetails7) SetPartialSettlementIndicator(value string) {
 	s.PartialSettlementIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails7) SetPartialSettlementIndicatorFromBool(value bool) {
+	s.PartialSettlementIndicator = new(YesNoIndicator)
+	s.PartialSettlementIndicator.FromBool(value)
+}
@@ -55,6 +55,10 @@ type SettlementDetails71 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails71) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails71) AddPriority() *PriorityNumeric1Choice {
 	s.Priority = new(PriorityNumeric1Choice)
 	return s.Priority
@@ -278,3 +282,8 @@ func (s *SettlementDetails71) SetPartialSettlementIndicator(value string) {
 func (s *SettlementDetails71) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails71) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
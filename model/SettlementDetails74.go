@@ -58,6 +58,10 @@ type SettlementDetails74 struct {
 	PartialSettlementIndicator *SettlementTransactionCondition5Code `xml:"PrtlSttlmInd,omitempty"`
 }
 
+func (s *SettlementDetails74) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails74) AddHoldIndicator() *HoldIndicator4 {
 	s.HoldIndicator = new(HoldIndicator4)
 	return s.HoldIndicator
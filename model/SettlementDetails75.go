@@ -55,6 +55,10 @@ type SettlementDetails75 struct {
 	PartialSettlementIndicator *SettlementTransactionCondition5Code `xml:"PrtlSttlmInd,omitempty"`
 }
 
+func (s *SettlementDetails75) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails75) AddSettlementTransactionCondition() *SettlementTransactionCondition12Choice {
 	newValue := new(SettlementTransactionCondition12Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
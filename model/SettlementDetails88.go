@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Parameters which explicitly state the conditions that must be fulfilled before a particular  transaction of a financial instrument can be settled.  These parameters are defined by the instructing party in compliance with settlement rules in the market the transaction will settle in.
 type SettlementDetails88 struct {
 
@@ -13,10 +17,19 @@ type SettlementDetails88 struct {
 	CollateralOwnership *CollateralOwnership1 `xml:"CollOwnrsh"`
 }
 
+func (s *SettlementDetails88) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails88) SetTradeDate(value string) {
 	s.TradeDate = (*ISODateTime)(&value)
 }
 
+func (s *SettlementDetails88) SetTradeDateFromTime(value time.Time) {
+	s.TradeDate = new(ISODateTime)
+	s.TradeDate.FromTime(value)
+}
+
 func (s *SettlementDetails88) AddSettlementParties() *SettlementParties3Choice {
 	s.SettlementParties = new(SettlementParties3Choice)
 	return s.SettlementParties
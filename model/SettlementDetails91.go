@@ -55,6 +55,10 @@ type SettlementDetails91 struct {
 	PartialSettlementIndicator *SettlementTransactionCondition5Code `xml:"PrtlSttlmInd,omitempty"`
 }
 
+func (s *SettlementDetails91) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails91) AddSettlementTransactionCondition() *SettlementTransactionCondition16Choice {
 	newValue := new(SettlementTransactionCondition16Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
@@ -58,6 +58,10 @@ type SettlementDetails92 struct {
 	PartialSettlementIndicator *SettlementTransactionCondition5Code `xml:"PrtlSttlmInd,omitempty"`
 }
 
+func (s *SettlementDetails92) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails92) AddHoldIndicator() *HoldIndicator6 {
 	s.HoldIndicator = new(HoldIndicator6)
 	return s.HoldIndicator
@@ -67,6 +67,10 @@ type SettlementDetails95 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails95) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails95) AddHoldIndicator() *HoldIndicator6 {
 	s.HoldIndicator = new(HoldIndicator6)
 	return s.HoldIndicator
@@ -310,3 +314,8 @@ func (s *SettlementDetails95) SetPartialSettlementIndicator(value string) {
 func (s *SettlementDetails95) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails95) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
@@ -55,6 +55,10 @@ type SettlementDetails96 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails96) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails96) AddPriority() *PriorityNumeric4Choice {
 	s.Priority = new(PriorityNumeric4Choice)
 	return s.Priority
@@ -278,3 +282,8 @@ func (s *SettlementDetails96) SetPartialSettlementIndicator(value string) {
 func (s *SettlementDetails96) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails96) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
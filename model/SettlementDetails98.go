@@ -52,10 +52,19 @@ type SettlementDetails98 struct {
 	EligibleForCollateral *YesNoIndicator `xml:"ElgblForColl,omitempty"`
 }
 
+func (s *SettlementDetails98) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails98) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails98) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails98) AddSettlementTransactionCondition() *SettlementTransactionCondition18Choice {
 	newValue := new(SettlementTransactionCondition18Choice)
 	s.SettlementTransactionCondition = append(s.SettlementTransactionCondition, newValue)
@@ -269,3 +278,8 @@ func (s *SettlementDetails98) SetPartialSettlementIndicator(value string) {
 func (s *SettlementDetails98) SetEligibleForCollateral(value string) {
 	s.EligibleForCollateral = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementDetails98) SetEligibleForCollateralFromBool(value bool) {
+	s.EligibleForCollateral = new(YesNoIndicator)
+	s.EligibleForCollateral.FromBool(value)
+}
@@ -37,10 +37,19 @@ type SettlementDetails99 struct {
 	StampDutyTaxBasis *GenericIdentification30 `xml:"StmpDtyTaxBsis,omitempty"`
 }
 
+func (s *SettlementDetails99) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementDetails99) SetHoldIndicator(value string) {
 	s.HoldIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementDetails99) SetHoldIndicatorFromBool(value bool) {
+	s.HoldIndicator = new(YesNoIndicator)
+	s.HoldIndicator.FromBool(value)
+}
+
 func (s *SettlementDetails99) AddSecuritiesTransactionType() *SecuritiesTransactionType20Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType20Choice)
 	return s.SecuritiesTransactionType
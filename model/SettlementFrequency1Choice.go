@@ -10,6 +10,10 @@ type SettlementFrequency1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SettlementFrequency1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementFrequency1Choice) SetCode(value string) {
 	s.Code = (*EventFrequency10Code)(&value)
 }
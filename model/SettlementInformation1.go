@@ -37,8 +37,16 @@ type SettlementInformation1 struct {
 	ThirdReimbursementAgentAccount *CashAccount7 `xml:"ThrdRmbrsmntAgtAcct,omitempty"`
 }
 
-func (s *SettlementInformation1) SetSettlementMethod(value string) {
+func (s *SettlementInformation1) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementInformation1) SetSettlementMethod(value string) error {
+	if err := SettlementMethod1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*SettlementMethod1Code)(&value)
+	return nil
 }
 
 func (s *SettlementInformation1) AddSettlementAccount() *CashAccount7 {
@@ -36,8 +36,16 @@ type SettlementInformation13 struct {
 	ThirdReimbursementAgentAccount *CashAccount16 `xml:"ThrdRmbrsmntAgtAcct,omitempty"`
 }
 
-func (s *SettlementInformation13) SetSettlementMethod(value string) {
+func (s *SettlementInformation13) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementInformation13) SetSettlementMethod(value string) error {
+	if err := SettlementMethod1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*SettlementMethod1Code)(&value)
+	return nil
 }
 
 func (s *SettlementInformation13) AddSettlementAccount() *CashAccount16 {
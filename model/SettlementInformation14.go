@@ -13,8 +13,16 @@ type SettlementInformation14 struct {
 	ClearingSystem *ClearingSystemIdentification3Choice `xml:"ClrSys,omitempty"`
 }
 
-func (s *SettlementInformation14) SetSettlementMethod(value string) {
+func (s *SettlementInformation14) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementInformation14) SetSettlementMethod(value string) error {
+	if err := SettlementMethod2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*SettlementMethod2Code)(&value)
+	return nil
 }
 
 func (s *SettlementInformation14) AddSettlementAccount() *CashAccount16 {
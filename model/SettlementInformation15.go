@@ -19,6 +19,10 @@ type SettlementInformation15 struct {
 	InstructedReimbursementAgentAccount *CashAccount16 `xml:"InstdRmbrsmntAgtAcct,omitempty"`
 }
 
+func (s *SettlementInformation15) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementInformation15) AddInstructingReimbursementAgent() *BranchAndFinancialInstitutionIdentification4 {
 	s.InstructingReimbursementAgent = new(BranchAndFinancialInstitutionIdentification4)
 	return s.InstructingReimbursementAgent
@@ -36,8 +36,16 @@ type SettlementInformation16 struct {
 	ThirdReimbursementAgentAccount *CashAccount16 `xml:"ThrdRmbrsmntAgtAcct,omitempty"`
 }
 
-func (s *SettlementInformation16) SetSettlementMethod(value string) {
+func (s *SettlementInformation16) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementInformation16) SetSettlementMethod(value string) error {
+	if err := SettlementMethod1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*SettlementMethod1Code)(&value)
+	return nil
 }
 
 func (s *SettlementInformation16) AddSettlementAccount() *CashAccount16 {
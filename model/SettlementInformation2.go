@@ -13,8 +13,16 @@ type SettlementInformation2 struct {
 	ClearingSystem *ClearingSystemIdentification1Choice `xml:"ClrSys,omitempty"`
 }
 
-func (s *SettlementInformation2) SetSettlementMethod(value string) {
+func (s *SettlementInformation2) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementInformation2) SetSettlementMethod(value string) error {
+	if err := SettlementMethod2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*SettlementMethod2Code)(&value)
+	return nil
 }
 
 func (s *SettlementInformation2) AddSettlementAccount() *CashAccount7 {
@@ -36,8 +36,16 @@ type SettlementInstruction1 struct {
 	ThirdReimbursementAgentAccount *CashAccount24 `xml:"ThrdRmbrsmntAgtAcct,omitempty"`
 }
 
-func (s *SettlementInstruction1) SetSettlementMethod(value string) {
+func (s *SettlementInstruction1) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementInstruction1) SetSettlementMethod(value string) error {
+	if err := SettlementMethod1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*SettlementMethod1Code)(&value)
+	return nil
 }
 
 func (s *SettlementInstruction1) AddSettlementAccount() *CashAccount24 {
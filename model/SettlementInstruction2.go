@@ -13,8 +13,16 @@ type SettlementInstruction2 struct {
 	ClearingSystem *ClearingSystemIdentification3Choice `xml:"ClrSys,omitempty"`
 }
 
-func (s *SettlementInstruction2) SetSettlementMethod(value string) {
+func (s *SettlementInstruction2) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementInstruction2) SetSettlementMethod(value string) error {
+	if err := SettlementMethod2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*SettlementMethod2Code)(&value)
+	return nil
 }
 
 func (s *SettlementInstruction2) AddSettlementAccount() *CashAccount24 {
@@ -19,6 +19,10 @@ type SettlementInstruction3 struct {
 	InstructedReimbursementAgentAccount *CashAccount24 `xml:"InstdRmbrsmntAgtAcct,omitempty"`
 }
 
+func (s *SettlementInstruction3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementInstruction3) AddInstructingReimbursementAgent() *BranchAndFinancialInstitutionIdentification5 {
 	s.InstructingReimbursementAgent = new(BranchAndFinancialInstitutionIdentification5)
 	return s.InstructingReimbursementAgent
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Provides further details on the settlement of the instruction.
 type SettlementInstruction4 struct {
 
@@ -36,8 +38,16 @@ type SettlementInstruction4 struct {
 	ThirdReimbursementAgentAccount *CashAccount24 `xml:"ThrdRmbrsmntAgtAcct,omitempty"`
 }
 
-func (s *SettlementInstruction4) SetSettlementMethod(value string) {
+func (s *SettlementInstruction4) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementInstruction4) SetSettlementMethod(value string) error {
+	if err := SettlementMethod1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*SettlementMethod1Code)(&value)
+	return nil
 }
 
 func (s *SettlementInstruction4) AddSettlementAccount() *CashAccount24 {
@@ -79,3 +89,107 @@ func (s *SettlementInstruction4) AddThirdReimbursementAgentAccount() *CashAccoun
 	s.ThirdReimbursementAgentAccount = new(CashAccount24)
 	return s.ThirdReimbursementAgentAccount
 }
+
+func (s *SettlementInstruction4) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if s.SettlementMethod != nil {
+		enc.Element("SttlmMtd", string(*s.SettlementMethod))
+	}
+	if s.SettlementAccount != nil {
+		s.SettlementAccount.EncodeXMLFast(enc, "SttlmAcct")
+	}
+	if s.ClearingSystem != nil {
+		s.ClearingSystem.EncodeXMLFast(enc, "ClrSys")
+	}
+	if s.InstructingReimbursementAgent != nil {
+		s.InstructingReimbursementAgent.EncodeXMLFast(enc, "InstgRmbrsmntAgt")
+	}
+	if s.InstructingReimbursementAgentAccount != nil {
+		s.InstructingReimbursementAgentAccount.EncodeXMLFast(enc, "InstgRmbrsmntAgtAcct")
+	}
+	if s.InstructedReimbursementAgent != nil {
+		s.InstructedReimbursementAgent.EncodeXMLFast(enc, "InstdRmbrsmntAgt")
+	}
+	if s.InstructedReimbursementAgentAccount != nil {
+		s.InstructedReimbursementAgentAccount.EncodeXMLFast(enc, "InstdRmbrsmntAgtAcct")
+	}
+	if s.ThirdReimbursementAgent != nil {
+		s.ThirdReimbursementAgent.EncodeXMLFast(enc, "ThrdRmbrsmntAgt")
+	}
+	if s.ThirdReimbursementAgentAccount != nil {
+		s.ThirdReimbursementAgentAccount.EncodeXMLFast(enc, "ThrdRmbrsmntAgtAcct")
+	}
+	enc.End(tag)
+}
+
+func (s *SettlementInstruction4) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "SttlmMtd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.SettlementMethod = (*SettlementMethod1Code)(&value)
+		case "SttlmAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.SettlementAccount = elem
+		case "ClrSys":
+			elem := new(ClearingSystemIdentification3Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.ClearingSystem = elem
+		case "InstgRmbrsmntAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.InstructingReimbursementAgent = elem
+		case "InstgRmbrsmntAgtAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.InstructingReimbursementAgentAccount = elem
+		case "InstdRmbrsmntAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.InstructedReimbursementAgent = elem
+		case "InstdRmbrsmntAgtAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.InstructedReimbursementAgentAccount = elem
+		case "ThrdRmbrsmntAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.ThirdReimbursementAgent = elem
+		case "ThrdRmbrsmntAgtAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.ThirdReimbursementAgentAccount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
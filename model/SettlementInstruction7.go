@@ -0,0 +1,195 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Provides further details on the settlement of the instruction.
+type SettlementInstruction7 struct {
+
+	// Method used to settle the (batch of) payment instructions.
+	SettlementMethod *SettlementMethod1Code `xml:"SttlmMtd"`
+
+	// A specific purpose account used to post debit and credit entries as a result of the transaction.
+	SettlementAccount *CashAccount38 `xml:"SttlmAcct,omitempty"`
+
+	// Specification of a pre-agreed offering between clearing agents or the channel through which the payment instruction is processed.
+	ClearingSystem *ClearingSystemIdentification3Choice `xml:"ClrSys,omitempty"`
+
+	// Agent through which the instructing agent will reimburse the instructed agent.
+	//
+	// Usage: If InstructingAgent and InstructedAgent have the same reimbursement agent, then only InstructingReimbursementAgent must be used.
+	InstructingReimbursementAgent *BranchAndFinancialInstitutionIdentification6 `xml:"InstgRmbrsmntAgt,omitempty"`
+
+	// Unambiguous identification of the account of the instructing reimbursement agent account at its servicing agent in the payment chain.
+	InstructingReimbursementAgentAccount *CashAccount38 `xml:"InstgRmbrsmntAgtAcct,omitempty"`
+
+	// Agent at which the instructed agent will be reimbursed.
+	// Usage: If InstructedReimbursementAgent contains a branch of the InstructedAgent, then the party in InstructedAgent will claim reimbursement from that branch/will be paid by that branch.
+	// Usage: If InstructingAgent and InstructedAgent have the same reimbursement agent, then only InstructingReimbursementAgent must be used.
+	InstructedReimbursementAgent *BranchAndFinancialInstitutionIdentification6 `xml:"InstdRmbrsmntAgt,omitempty"`
+
+	// Unambiguous identification of the account of the instructed reimbursement agent account at its servicing agent in the payment chain.
+	InstructedReimbursementAgentAccount *CashAccount38 `xml:"InstdRmbrsmntAgtAcct,omitempty"`
+
+	// Agent at which the instructed agent will be reimbursed.
+	// Usage: If ThirdReimbursementAgent contains a branch of the InstructedAgent, then the party in InstructedAgent will claim reimbursement from that branch/will be paid by that branch.
+	ThirdReimbursementAgent *BranchAndFinancialInstitutionIdentification6 `xml:"ThrdRmbrsmntAgt,omitempty"`
+
+	// Unambiguous identification of the account of the third reimbursement agent account at its servicing agent in the payment chain.
+	ThirdReimbursementAgentAccount *CashAccount38 `xml:"ThrdRmbrsmntAgtAcct,omitempty"`
+}
+
+func (s *SettlementInstruction7) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementInstruction7) SetSettlementMethod(value string) error {
+	if err := SettlementMethod1Code(value).Validate(); err != nil {
+		return err
+	}
+	s.SettlementMethod = (*SettlementMethod1Code)(&value)
+	return nil
+}
+
+func (s *SettlementInstruction7) AddSettlementAccount() *CashAccount38 {
+	s.SettlementAccount = new(CashAccount38)
+	return s.SettlementAccount
+}
+
+func (s *SettlementInstruction7) AddClearingSystem() *ClearingSystemIdentification3Choice {
+	s.ClearingSystem = new(ClearingSystemIdentification3Choice)
+	return s.ClearingSystem
+}
+
+func (s *SettlementInstruction7) AddInstructingReimbursementAgent() *BranchAndFinancialInstitutionIdentification6 {
+	s.InstructingReimbursementAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return s.InstructingReimbursementAgent
+}
+
+func (s *SettlementInstruction7) AddInstructingReimbursementAgentAccount() *CashAccount38 {
+	s.InstructingReimbursementAgentAccount = new(CashAccount38)
+	return s.InstructingReimbursementAgentAccount
+}
+
+func (s *SettlementInstruction7) AddInstructedReimbursementAgent() *BranchAndFinancialInstitutionIdentification6 {
+	s.InstructedReimbursementAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return s.InstructedReimbursementAgent
+}
+
+func (s *SettlementInstruction7) AddInstructedReimbursementAgentAccount() *CashAccount38 {
+	s.InstructedReimbursementAgentAccount = new(CashAccount38)
+	return s.InstructedReimbursementAgentAccount
+}
+
+func (s *SettlementInstruction7) AddThirdReimbursementAgent() *BranchAndFinancialInstitutionIdentification6 {
+	s.ThirdReimbursementAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return s.ThirdReimbursementAgent
+}
+
+func (s *SettlementInstruction7) AddThirdReimbursementAgentAccount() *CashAccount38 {
+	s.ThirdReimbursementAgentAccount = new(CashAccount38)
+	return s.ThirdReimbursementAgentAccount
+}
+
+func (s *SettlementInstruction7) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if s.SettlementMethod != nil {
+		enc.Element("SttlmMtd", string(*s.SettlementMethod))
+	}
+	if s.SettlementAccount != nil {
+		s.SettlementAccount.EncodeXMLFast(enc, "SttlmAcct")
+	}
+	if s.ClearingSystem != nil {
+		s.ClearingSystem.EncodeXMLFast(enc, "ClrSys")
+	}
+	if s.InstructingReimbursementAgent != nil {
+		s.InstructingReimbursementAgent.EncodeXMLFast(enc, "InstgRmbrsmntAgt")
+	}
+	if s.InstructingReimbursementAgentAccount != nil {
+		s.InstructingReimbursementAgentAccount.EncodeXMLFast(enc, "InstgRmbrsmntAgtAcct")
+	}
+	if s.InstructedReimbursementAgent != nil {
+		s.InstructedReimbursementAgent.EncodeXMLFast(enc, "InstdRmbrsmntAgt")
+	}
+	if s.InstructedReimbursementAgentAccount != nil {
+		s.InstructedReimbursementAgentAccount.EncodeXMLFast(enc, "InstdRmbrsmntAgtAcct")
+	}
+	if s.ThirdReimbursementAgent != nil {
+		s.ThirdReimbursementAgent.EncodeXMLFast(enc, "ThrdRmbrsmntAgt")
+	}
+	if s.ThirdReimbursementAgentAccount != nil {
+		s.ThirdReimbursementAgentAccount.EncodeXMLFast(enc, "ThrdRmbrsmntAgtAcct")
+	}
+	enc.End(tag)
+}
+
+func (s *SettlementInstruction7) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "SttlmMtd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.SettlementMethod = (*SettlementMethod1Code)(&value)
+		case "SttlmAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.SettlementAccount = elem
+		case "ClrSys":
+			elem := new(ClearingSystemIdentification3Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.ClearingSystem = elem
+		case "InstgRmbrsmntAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.InstructingReimbursementAgent = elem
+		case "InstgRmbrsmntAgtAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.InstructingReimbursementAgentAccount = elem
+		case "InstdRmbrsmntAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.InstructedReimbursementAgent = elem
+		case "InstdRmbrsmntAgtAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.InstructedReimbursementAgentAccount = elem
+		case "ThrdRmbrsmntAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.ThirdReimbursementAgent = elem
+		case "ThrdRmbrsmntAgtAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.ThirdReimbursementAgentAccount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ type SettlementInstructionGeneration1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (s *SettlementInstructionGeneration1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementInstructionGeneration1Choice) SetCode(value string) {
 	s.Code = (*SettlementInstructionGeneration1Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementInstructionReason1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SettlementInstructionReason1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementInstructionReason1Choice) SetCode(value string) {
 	s.Code = (*SettlementInstructionReason1Code)(&value)
 }
@@ -1,3 +1,30 @@
 package model
 
 type SettlementMethod1Code string
+
+const (
+	SettlementMethod1CodeINDA SettlementMethod1Code = "INDA"
+	SettlementMethod1CodeINGA SettlementMethod1Code = "INGA"
+	SettlementMethod1CodeCOVE SettlementMethod1Code = "COVE"
+	SettlementMethod1CodeCLRG SettlementMethod1Code = "CLRG"
+)
+
+var settlementMethod1CodeDescriptions = map[SettlementMethod1Code]string{
+	SettlementMethod1CodeINDA: "Instructed agent: settlement is done by the agent instructed to execute a payment instruction.",
+	SettlementMethod1CodeINGA: "Instructing agent: settlement is done by the agent instructing and forwarding the payment to the next party in the payment chain.",
+	SettlementMethod1CodeCOVE: "Cover method: settlement is done through a cover payment.",
+	SettlementMethod1CodeCLRG: "Clearing system: settlement is done through a payment clearing system.",
+}
+
+func (s SettlementMethod1Code) IsValid() bool {
+	_, ok := settlementMethod1CodeDescriptions[s]
+	return ok
+}
+
+func (s SettlementMethod1Code) Description() string {
+	return settlementMethod1CodeDescriptions[s]
+}
+
+func (s SettlementMethod1Code) Validate() error {
+	return validateEnumeration("SettlementMethod1Code", string(s), s.IsValid(), "INDA, INGA, COVE, CLRG")
+}
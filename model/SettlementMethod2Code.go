@@ -1,3 +1,28 @@
 package model
 
 type SettlementMethod2Code string
+
+const (
+	SettlementMethod2CodeINDA SettlementMethod2Code = "INDA"
+	SettlementMethod2CodeINGA SettlementMethod2Code = "INGA"
+	SettlementMethod2CodeCLRG SettlementMethod2Code = "CLRG"
+)
+
+var settlementMethod2CodeDescriptions = map[SettlementMethod2Code]string{
+	SettlementMethod2CodeINDA: "Instructed agent: settlement is done by the agent instructed to execute a payment instruction.",
+	SettlementMethod2CodeINGA: "Instructing agent: settlement is done by the agent instructing and forwarding the payment to the next party in the payment chain.",
+	SettlementMethod2CodeCLRG: "Clearing system: settlement is done through a payment clearing system.",
+}
+
+func (s SettlementMethod2Code) IsValid() bool {
+	_, ok := settlementMethod2CodeDescriptions[s]
+	return ok
+}
+
+func (s SettlementMethod2Code) Description() string {
+	return settlementMethod2CodeDescriptions[s]
+}
+
+func (s SettlementMethod2Code) Validate() error {
+	return validateEnumeration("SettlementMethod2Code", string(s), s.IsValid(), "INDA, INGA, CLRG")
+}
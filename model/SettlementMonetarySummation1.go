@@ -34,6 +34,10 @@ type SettlementMonetarySummation1 struct {
 	InformationAmount []*CurrencyAndAmount `xml:"InfAmt,omitempty"`
 }
 
+func (s *SettlementMonetarySummation1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementMonetarySummation1) AddLineTotalAmount(value, currency string) {
 	s.LineTotalAmount = append(s.LineTotalAmount, NewCurrencyAndAmount(value, currency))
 }
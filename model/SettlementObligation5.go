@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides details about the settlement obligation.
 type SettlementObligation5 struct {
 
@@ -40,6 +44,10 @@ type SettlementObligation5 struct {
 	References *Reference19 `xml:"Refs,omitempty"`
 }
 
+func (s *SettlementObligation5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementObligation5) SetRelatedSettlementObligationIdentification(value string) {
 	s.RelatedSettlementObligationIdentification = (*Max35Text)(&value)
 }
@@ -57,6 +65,11 @@ func (s *SettlementObligation5) SetTradeDate(value string) {
 	s.TradeDate = (*ISODate)(&value)
 }
 
+func (s *SettlementObligation5) SetTradeDateFromTime(value time.Time) {
+	s.TradeDate = new(ISODate)
+	s.TradeDate.FromTime(value)
+}
+
 func (s *SettlementObligation5) AddQuantity() *FinancialInstrumentQuantity1Choice {
 	s.Quantity = new(FinancialInstrumentQuantity1Choice)
 	return s.Quantity
@@ -80,12 +93,25 @@ func (s *SettlementObligation5) SetSettlementDate(value string) {
 	s.SettlementDate = (*ISODate)(&value)
 }
 
-func (s *SettlementObligation5) SetSecuritiesMovementType(value string) {
+func (s *SettlementObligation5) SetSettlementDateFromTime(value time.Time) {
+	s.SettlementDate = new(ISODate)
+	s.SettlementDate.FromTime(value)
+}
+
+func (s *SettlementObligation5) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementObligation5) SetPayment(value string) {
+func (s *SettlementObligation5) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SettlementObligation5) AddReferences() *Reference19 {
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides details about the settlement obligation.
 type SettlementObligation7 struct {
 
@@ -57,6 +61,10 @@ type SettlementObligation7 struct {
 	RemainingAmountToBeSettled *AmountAndDirection27 `xml:"RmngAmtToBeSttld,omitempty"`
 }
 
+func (s *SettlementObligation7) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementObligation7) SetCSDTransactionIdentification(value string) {
 	s.CSDTransactionIdentification = (*Max35Text)(&value)
 }
@@ -98,6 +106,11 @@ func (s *SettlementObligation7) SetIntendedSettlementDate(value string) {
 	s.IntendedSettlementDate = (*ISODate)(&value)
 }
 
+func (s *SettlementObligation7) SetIntendedSettlementDateFromTime(value time.Time) {
+	s.IntendedSettlementDate = new(ISODate)
+	s.IntendedSettlementDate.FromTime(value)
+}
+
 func (s *SettlementObligation7) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	s.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return s.FinancialInstrumentIdentification
@@ -107,6 +120,11 @@ func (s *SettlementObligation7) SetTradeDate(value string) {
 	s.TradeDate = (*ISODate)(&value)
 }
 
+func (s *SettlementObligation7) SetTradeDateFromTime(value time.Time) {
+	s.TradeDate = new(ISODate)
+	s.TradeDate.FromTime(value)
+}
+
 func (s *SettlementObligation7) AddDealPrice() *Price4 {
 	s.DealPrice = new(Price4)
 	return s.DealPrice
@@ -49,6 +49,10 @@ type SettlementObligation8 struct {
 	AdditionalSettlementObligationDetails []*SettlementObligation5 `xml:"AddtlSttlmOblgtnDtls,omitempty"`
 }
 
+func (s *SettlementObligation8) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementObligation8) SetSettlementObligationIdentification(value string) {
 	s.SettlementObligationIdentification = (*Max35Text)(&value)
 }
@@ -101,12 +105,20 @@ func (s *SettlementObligation8) AddSafekeepingAccount() *SecuritiesAccount19 {
 	return s.SafekeepingAccount
 }
 
-func (s *SettlementObligation8) SetSecuritiesMovementType(value string) {
+func (s *SettlementObligation8) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementObligation8) SetPayment(value string) {
+func (s *SettlementObligation8) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SettlementObligation8) AddSettlementParties() *SettlementParties4Choice {
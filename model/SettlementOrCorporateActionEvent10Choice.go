@@ -10,6 +10,10 @@ type SettlementOrCorporateActionEvent10Choice struct {
 	CorporateActionEventType *CorporateActionEventType15Choice `xml:"CorpActnEvtTp"`
 }
 
+func (s *SettlementOrCorporateActionEvent10Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementOrCorporateActionEvent10Choice) AddSecuritiesTransactionType() *SecuritiesTransactionType11Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType11Choice)
 	return s.SecuritiesTransactionType
@@ -10,6 +10,10 @@ type SettlementOrCorporateActionEvent13Choice struct {
 	CorporateActionEventType *CorporateActionEventType30Choice `xml:"CorpActnEvtTp"`
 }
 
+func (s *SettlementOrCorporateActionEvent13Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementOrCorporateActionEvent13Choice) AddSecuritiesTransactionType() *SecuritiesTransactionType18Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType18Choice)
 	return s.SecuritiesTransactionType
@@ -10,6 +10,10 @@ type SettlementOrCorporateActionEvent14Choice struct {
 	CorporateActionEventType *CorporateActionEventType30Choice `xml:"CorpActnEvtTp"`
 }
 
+func (s *SettlementOrCorporateActionEvent14Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementOrCorporateActionEvent14Choice) AddSecuritiesTransactionType() *SecuritiesTransactionType19Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType19Choice)
 	return s.SecuritiesTransactionType
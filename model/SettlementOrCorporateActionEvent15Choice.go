@@ -10,6 +10,10 @@ type SettlementOrCorporateActionEvent15Choice struct {
 	CorporateActionEventType *CorporateActionEventType48Choice `xml:"CorpActnEvtTp"`
 }
 
+func (s *SettlementOrCorporateActionEvent15Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementOrCorporateActionEvent15Choice) AddSecuritiesTransactionType() *SecuritiesTransactionType30Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType30Choice)
 	return s.SecuritiesTransactionType
@@ -10,6 +10,10 @@ type SettlementOrCorporateActionEvent16Choice struct {
 	CorporateActionEventType *CorporateActionEventType48Choice `xml:"CorpActnEvtTp"`
 }
 
+func (s *SettlementOrCorporateActionEvent16Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementOrCorporateActionEvent16Choice) AddSecuritiesTransactionType() *SecuritiesTransactionType24Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType24Choice)
 	return s.SecuritiesTransactionType
@@ -10,6 +10,10 @@ type SettlementOrCorporateActionEvent17Choice struct {
 	CorporateActionEventType *CorporateActionEventType56Choice `xml:"CorpActnEvtTp"`
 }
 
+func (s *SettlementOrCorporateActionEvent17Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementOrCorporateActionEvent17Choice) AddSecuritiesTransactionType() *SecuritiesTransactionType32Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType32Choice)
 	return s.SecuritiesTransactionType
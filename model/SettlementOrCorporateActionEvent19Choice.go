@@ -10,6 +10,10 @@ type SettlementOrCorporateActionEvent19Choice struct {
 	CorporateActionEventType *CorporateActionEventType56Choice `xml:"CorpActnEvtTp"`
 }
 
+func (s *SettlementOrCorporateActionEvent19Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementOrCorporateActionEvent19Choice) AddSecuritiesTransactionType() *SecuritiesTransactionType18Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType18Choice)
 	return s.SecuritiesTransactionType
@@ -10,6 +10,10 @@ type SettlementOrCorporateActionEvent1Choice struct {
 	CorporateActionEventType *CorporateActionEventType1Choice `xml:"CorpActnEvtTp"`
 }
 
+func (s *SettlementOrCorporateActionEvent1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementOrCorporateActionEvent1Choice) AddSecuritiesTransactionType() *SecuritiesTransactionType3Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType3Choice)
 	return s.SecuritiesTransactionType
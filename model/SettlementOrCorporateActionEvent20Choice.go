@@ -10,6 +10,10 @@ type SettlementOrCorporateActionEvent20Choice struct {
 	CorporateActionEventType *CorporateActionEventType71Choice `xml:"CorpActnEvtTp"`
 }
 
+func (s *SettlementOrCorporateActionEvent20Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementOrCorporateActionEvent20Choice) AddSecuritiesTransactionType() *SecuritiesTransactionType24Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType24Choice)
 	return s.SecuritiesTransactionType
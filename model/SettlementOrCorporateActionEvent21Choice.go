@@ -10,6 +10,10 @@ type SettlementOrCorporateActionEvent21Choice struct {
 	CorporateActionEventType *CorporateActionEventType71Choice `xml:"CorpActnEvtTp"`
 }
 
+func (s *SettlementOrCorporateActionEvent21Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementOrCorporateActionEvent21Choice) AddSecuritiesTransactionType() *SecuritiesTransactionType34Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType34Choice)
 	return s.SecuritiesTransactionType
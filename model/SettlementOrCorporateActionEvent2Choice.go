@@ -10,6 +10,10 @@ type SettlementOrCorporateActionEvent2Choice struct {
 	CorporateActionEventType *CorporateActionEventType1Choice `xml:"CorpActnEvtTp"`
 }
 
+func (s *SettlementOrCorporateActionEvent2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementOrCorporateActionEvent2Choice) AddSecuritiesTransactionType() *SecuritiesTransactionType2Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType2Choice)
 	return s.SecuritiesTransactionType
@@ -10,6 +10,10 @@ type SettlementOrCorporateActionEvent5Choice struct {
 	CorporateActionEventType *CorporateActionEventType1Choice `xml:"CorpActnEvtTp"`
 }
 
+func (s *SettlementOrCorporateActionEvent5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementOrCorporateActionEvent5Choice) AddSecuritiesTransactionType() *SecuritiesTransactionType10Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType10Choice)
 	return s.SecuritiesTransactionType
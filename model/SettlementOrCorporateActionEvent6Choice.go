@@ -10,6 +10,10 @@ type SettlementOrCorporateActionEvent6Choice struct {
 	CorporateActionEventType *CorporateActionEventType1Choice `xml:"CorpActnEvtTp"`
 }
 
+func (s *SettlementOrCorporateActionEvent6Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementOrCorporateActionEvent6Choice) AddSecuritiesTransactionType() *SecuritiesTransactionType11Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType11Choice)
 	return s.SecuritiesTransactionType
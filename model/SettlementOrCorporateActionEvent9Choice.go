@@ -10,6 +10,10 @@ type SettlementOrCorporateActionEvent9Choice struct {
 	CorporateActionEventType *CorporateActionEventType15Choice `xml:"CorpActnEvtTp"`
 }
 
+func (s *SettlementOrCorporateActionEvent9Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementOrCorporateActionEvent9Choice) AddSecuritiesTransactionType() *SecuritiesTransactionType10Choice {
 	s.SecuritiesTransactionType = new(SecuritiesTransactionType10Choice)
 	return s.SecuritiesTransactionType
@@ -22,6 +22,10 @@ type SettlementParties10 struct {
 	Party5 *PartyIdentificationAndAccount35 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties10) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties10) AddDepository() *PartyIdentification48 {
 	s.Depository = new(PartyIdentification48)
 	return s.Depository
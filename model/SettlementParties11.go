@@ -22,6 +22,10 @@ type SettlementParties11 struct {
 	Party5 *PartyIdentificationAndAccount42 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties11) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties11) AddDepository() *PartyIdentification48 {
 	s.Depository = new(PartyIdentification48)
 	return s.Depository
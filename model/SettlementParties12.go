@@ -22,6 +22,10 @@ type SettlementParties12 struct {
 	Party5 *PartyIdentificationAndAccount43 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties12) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties12) AddDepository() *PartyIdentification46 {
 	s.Depository = new(PartyIdentification46)
 	return s.Depository
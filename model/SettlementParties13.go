@@ -22,6 +22,10 @@ type SettlementParties13 struct {
 	Party5 *PartyIdentificationAndAccount44 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties13) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties13) AddDepository() *PartyIdentification46 {
 	s.Depository = new(PartyIdentification46)
 	return s.Depository
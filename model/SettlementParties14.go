@@ -22,6 +22,10 @@ type SettlementParties14 struct {
 	Party5 *PartyIdentificationAndAccount45 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties14) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties14) AddDepository() *PartyIdentification48 {
 	s.Depository = new(PartyIdentification48)
 	return s.Depository
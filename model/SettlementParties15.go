@@ -16,6 +16,10 @@ type SettlementParties15 struct {
 	Party3 *PartyIdentificationAndAccount51 `xml:"Pty3,omitempty"`
 }
 
+func (s *SettlementParties15) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties15) AddDepository() *PartyIdentification47 {
 	s.Depository = new(PartyIdentification47)
 	return s.Depository
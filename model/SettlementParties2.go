@@ -22,6 +22,10 @@ type SettlementParties2 struct {
 	Party5 *PartyIdentificationAndAccount16 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties2) AddDepository() *PartyIdentification36 {
 	s.Depository = new(PartyIdentification36)
 	return s.Depository
@@ -22,6 +22,10 @@ type SettlementParties23 struct {
 	Party5 *PartyIdentificationAndAccount34 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties23) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties23) AddDepository() *PartyIdentification55 {
 	s.Depository = new(PartyIdentification55)
 	return s.Depository
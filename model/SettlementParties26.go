@@ -16,6 +16,10 @@ type SettlementParties26 struct {
 	Party5 *PartyIdentificationAndAccount42 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties26) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties26) AddParty2() *PartyIdentificationAndAccount42 {
 	s.Party2 = new(PartyIdentificationAndAccount42)
 	return s.Party2
@@ -16,6 +16,10 @@ type SettlementParties29 struct {
 	BeneficiaryInstitution *PartyIdentification73Choice `xml:"BnfcryInstn,omitempty"`
 }
 
+func (s *SettlementParties29) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties29) AddDeliveryAgent() *PartyIdentification73Choice {
 	s.DeliveryAgent = new(PartyIdentification73Choice)
 	return s.DeliveryAgent
@@ -10,6 +10,10 @@ type SettlementParties2Choice struct {
 	ReceivingSettlementParties *ReceivingPartiesAndAccount7 `xml:"RcvgSttlmPties,omitempty"`
 }
 
+func (s *SettlementParties2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties2Choice) AddDeliveringSettlementParties() *DeliveringPartiesAndAccount7 {
 	s.DeliveringSettlementParties = new(DeliveringPartiesAndAccount7)
 	return s.DeliveringSettlementParties
@@ -22,6 +22,10 @@ type SettlementParties32 struct {
 	Party5 *PartyIdentificationAndAccount95 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties32) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties32) AddDepository() *PartyIdentification63 {
 	s.Depository = new(PartyIdentification63)
 	return s.Depository
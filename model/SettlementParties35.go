@@ -13,6 +13,10 @@ type SettlementParties35 struct {
 	RegistrationDetails *PartyIdentification99Choice `xml:"RegnDtls,omitempty"`
 }
 
+func (s *SettlementParties35) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties35) AddStandingSettlementParties() *SettlementParties32 {
 	s.StandingSettlementParties = new(SettlementParties32)
 	return s.StandingSettlementParties
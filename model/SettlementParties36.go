@@ -22,6 +22,10 @@ type SettlementParties36 struct {
 	Party5 *PartyIdentificationAndAccount106 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties36) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties36) AddDepository() *PartyIdentification75 {
 	s.Depository = new(PartyIdentification75)
 	return s.Depository
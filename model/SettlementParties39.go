@@ -16,6 +16,10 @@ type SettlementParties39 struct {
 	Party5 *PartyIdentificationAndAccount106 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties39) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties39) AddParty2() *PartyIdentificationAndAccount106 {
 	s.Party2 = new(PartyIdentificationAndAccount106)
 	return s.Party2
@@ -10,6 +10,10 @@ type SettlementParties3Choice struct {
 	ReceivingSettlementParties *ReceivingPartiesAndAccount10 `xml:"RcvgSttlmPties,omitempty"`
 }
 
+func (s *SettlementParties3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties3Choice) AddDeliveringSettlementParties() *DeliveringPartiesAndAccount10 {
 	s.DeliveringSettlementParties = new(DeliveringPartiesAndAccount10)
 	return s.DeliveringSettlementParties
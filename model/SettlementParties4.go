@@ -16,6 +16,10 @@ type SettlementParties4 struct {
 	Party3 *PartyIdentificationAndAccount14 `xml:"Pty3,omitempty"`
 }
 
+func (s *SettlementParties4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties4) AddDepository() *PartyIdentification35 {
 	s.Depository = new(PartyIdentification35)
 	return s.Depository
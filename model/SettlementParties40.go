@@ -22,6 +22,10 @@ type SettlementParties40 struct {
 	Party5 *PartyIdentificationAndAccount117 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties40) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties40) AddDepository() *PartyIdentification91 {
 	s.Depository = new(PartyIdentification91)
 	return s.Depository
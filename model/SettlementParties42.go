@@ -16,6 +16,10 @@ type SettlementParties42 struct {
 	Party3 *PartyIdentificationAndAccount122 `xml:"Pty3,omitempty"`
 }
 
+func (s *SettlementParties42) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties42) AddDepository() *PartyIdentification92 {
 	s.Depository = new(PartyIdentification92)
 	return s.Depository
@@ -16,6 +16,10 @@ type SettlementParties43 struct {
 	Party3 *PartyIdentificationAndAccount128 `xml:"Pty3,omitempty"`
 }
 
+func (s *SettlementParties43) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties43) AddDepository() *PartyIdentification102 {
 	s.Depository = new(PartyIdentification102)
 	return s.Depository
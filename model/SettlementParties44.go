@@ -22,6 +22,10 @@ type SettlementParties44 struct {
 	Party5 *PartyIdentificationAndAccount131 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties44) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties44) AddDepository() *PartyIdentification103 {
 	s.Depository = new(PartyIdentification103)
 	return s.Depository
@@ -22,6 +22,10 @@ type SettlementParties49 struct {
 	Party5 *PartyIdentificationAndAccount146 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties49) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties49) AddDepository() *PartyIdentification108 {
 	s.Depository = new(PartyIdentification108)
 	return s.Depository
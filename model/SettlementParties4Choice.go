@@ -10,6 +10,10 @@ type SettlementParties4Choice struct {
 	ReceivingSettlementParties *ReceivingPartiesAndAccount11 `xml:"RcvgSttlmPties,omitempty"`
 }
 
+func (s *SettlementParties4Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties4Choice) AddDeliveringSettlementParties() *DeliveringPartiesAndAccount11 {
 	s.DeliveringSettlementParties = new(DeliveringPartiesAndAccount11)
 	return s.DeliveringSettlementParties
@@ -22,6 +22,10 @@ type SettlementParties5 struct {
 	Party5 *PartyIdentificationAndAccount1 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties5) AddDepository() *PartyIdentification2 {
 	s.Depository = new(PartyIdentification2)
 	return s.Depository
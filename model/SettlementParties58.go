@@ -16,6 +16,10 @@ type SettlementParties58 struct {
 	Party5 *PartyIdentificationAndAccount131 `xml:"Pty5,omitempty"`
 }
 
+func (s *SettlementParties58) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties58) AddParty2() *PartyIdentificationAndAccount131 {
 	s.Party2 = new(PartyIdentificationAndAccount131)
 	return s.Party2
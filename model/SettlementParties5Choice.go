@@ -10,6 +10,10 @@ type SettlementParties5Choice struct {
 	ReceivingSettlementParties *ReceivingPartiesAndAccount15 `xml:"RcvgSttlmPties,omitempty"`
 }
 
+func (s *SettlementParties5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties5Choice) AddDeliveringSettlementParties() *DeliveringPartiesAndAccount15 {
 	s.DeliveringSettlementParties = new(DeliveringPartiesAndAccount15)
 	return s.DeliveringSettlementParties
@@ -16,6 +16,10 @@ type SettlementParties61 struct {
 	Party3 *PartyIdentificationAndAccount128 `xml:"Pty3,omitempty"`
 }
 
+func (s *SettlementParties61) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties61) AddDepository() *PartyIdentification120 {
 	s.Depository = new(PartyIdentification120)
 	return s.Depository
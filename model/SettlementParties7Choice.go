@@ -10,6 +10,10 @@ type SettlementParties7Choice struct {
 	ReceivingSettlementParties *ReceivingPartiesAndAccount15 `xml:"RcvgSttlmPties"`
 }
 
+func (s *SettlementParties7Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementParties7Choice) AddDeliveringSettlementParties() *DeliveringPartiesAndAccount15 {
 	s.DeliveringSettlementParties = new(DeliveringPartiesAndAccount15)
 	return s.DeliveringSettlementParties
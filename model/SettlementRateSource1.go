@@ -16,6 +16,10 @@ type SettlementRateSource1 struct {
 	LocationCode *Exact2AlphaNumericText `xml:"LctnCd,omitempty"`
 }
 
+func (s *SettlementRateSource1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementRateSource1) SetRateSource(value string) {
 	s.RateSource = (*RateSourceText)(&value)
 }
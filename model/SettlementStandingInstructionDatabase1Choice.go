@@ -10,6 +10,10 @@ type SettlementStandingInstructionDatabase1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SettlementStandingInstructionDatabase1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStandingInstructionDatabase1Choice) SetCode(value string) {
 	s.Code = (*SettlementStandingInstructionDatabase1Code)(&value)
 }
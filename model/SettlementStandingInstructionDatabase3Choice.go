@@ -10,6 +10,10 @@ type SettlementStandingInstructionDatabase3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (s *SettlementStandingInstructionDatabase3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStandingInstructionDatabase3Choice) SetCode(value string) {
 	s.Code = (*SettlementStandingInstructionDatabase1Code)(&value)
 }
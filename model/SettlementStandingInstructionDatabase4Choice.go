@@ -10,6 +10,10 @@ type SettlementStandingInstructionDatabase4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SettlementStandingInstructionDatabase4Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStandingInstructionDatabase4Choice) SetCode(value string) {
 	s.Code = (*SettlementStandingInstructionDatabase1Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementStandingInstructionDatabase5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SettlementStandingInstructionDatabase5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStandingInstructionDatabase5Choice) SetCode(value string) {
 	s.Code = (*SettlementStandingInstructionDatabase1Code)(&value)
 }
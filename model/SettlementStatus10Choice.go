@@ -13,6 +13,10 @@ type SettlementStatus10Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (s *SettlementStatus10Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStatus10Choice) AddPending() *PendingStatus15Choice {
 	s.Pending = new(PendingStatus15Choice)
 	return s.Pending
@@ -13,6 +13,10 @@ type SettlementStatus16Choice struct {
 	Proprietary *ProprietaryStatusAndReason6 `xml:"Prtry"`
 }
 
+func (s *SettlementStatus16Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStatus16Choice) AddPending() *PendingStatus36Choice {
 	s.Pending = new(PendingStatus36Choice)
 	return s.Pending
@@ -13,6 +13,10 @@ type SettlementStatus17Choice struct {
 	Proprietary *ProprietaryStatusAndReason6 `xml:"Prtry"`
 }
 
+func (s *SettlementStatus17Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStatus17Choice) AddPending() *PendingStatus37Choice {
 	s.Pending = new(PendingStatus37Choice)
 	return s.Pending
@@ -13,6 +13,10 @@ type SettlementStatus18Choice struct {
 	Proprietary *ProprietaryStatusAndReason6 `xml:"Prtry"`
 }
 
+func (s *SettlementStatus18Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStatus18Choice) AddPending() *PendingStatus40Choice {
 	s.Pending = new(PendingStatus40Choice)
 	return s.Pending
@@ -10,6 +10,10 @@ type SettlementStatus19Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SettlementStatus19Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStatus19Choice) SetCode(value string) {
 	s.Code = (*SecuritiesSettlementStatus2Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementStatus1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SettlementStatus1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStatus1Choice) SetCode(value string) {
 	s.Code = (*SecuritiesSettlementStatus1Code)(&value)
 }
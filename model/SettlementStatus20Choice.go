@@ -13,6 +13,10 @@ type SettlementStatus20Choice struct {
 	Proprietary *ProprietaryStatusAndReason7 `xml:"Prtry"`
 }
 
+func (s *SettlementStatus20Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStatus20Choice) AddPending() *PendingStatus45Choice {
 	s.Pending = new(PendingStatus45Choice)
 	return s.Pending
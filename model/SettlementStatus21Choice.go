@@ -13,6 +13,10 @@ type SettlementStatus21Choice struct {
 	Proprietary *ProprietaryStatusAndReason7 `xml:"Prtry"`
 }
 
+func (s *SettlementStatus21Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStatus21Choice) AddPending() *PendingStatus47Choice {
 	s.Pending = new(PendingStatus47Choice)
 	return s.Pending
@@ -13,6 +13,10 @@ type SettlementStatus22Choice struct {
 	Proprietary *ProprietaryStatusAndReason7 `xml:"Prtry"`
 }
 
+func (s *SettlementStatus22Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStatus22Choice) AddPending() *PendingStatus50Choice {
 	s.Pending = new(PendingStatus50Choice)
 	return s.Pending
@@ -10,6 +10,10 @@ type SettlementStatus25Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SettlementStatus25Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStatus25Choice) SetCode(value string) {
 	s.Code = (*SecuritiesSettlementStatus2Code)(&value)
 }
@@ -13,6 +13,10 @@ type SettlementStatus2Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (s *SettlementStatus2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStatus2Choice) AddPending() *PendingStatus3Choice {
 	s.Pending = new(PendingStatus3Choice)
 	return s.Pending
@@ -10,6 +10,10 @@ type SettlementStatus5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SettlementStatus5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStatus5Choice) SetCode(value string) {
 	s.Code = (*SecuritiesSettlementStatus2Code)(&value)
 }
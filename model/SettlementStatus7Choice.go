@@ -13,6 +13,10 @@ type SettlementStatus7Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (s *SettlementStatus7Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStatus7Choice) AddPending() *PendingStatus9Choice {
 	s.Pending = new(PendingStatus9Choice)
 	return s.Pending
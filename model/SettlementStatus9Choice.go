@@ -13,6 +13,10 @@ type SettlementStatus9Choice struct {
 	Proprietary *ProprietaryStatusAndReason1 `xml:"Prtry"`
 }
 
+func (s *SettlementStatus9Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementStatus9Choice) AddPending() *PendingStatus12Choice {
 	s.Pending = new(PendingStatus12Choice)
 	return s.Pending
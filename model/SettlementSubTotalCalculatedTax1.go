@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies  the subtotal calculated tax applicable for this settlement.
 type SettlementSubTotalCalculatedTax1 struct {
 
@@ -22,6 +26,10 @@ type SettlementSubTotalCalculatedTax1 struct {
 	ExemptionReason *TaxExemptionReason1 `xml:"XmptnRsn,omitempty"`
 }
 
+func (s *SettlementSubTotalCalculatedTax1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementSubTotalCalculatedTax1) SetTypeCode(value string) {
 	s.TypeCode = (*Max4Text)(&value)
 }
@@ -42,6 +50,11 @@ func (s *SettlementSubTotalCalculatedTax1) SetCalculatedRate(value string) {
 	s.CalculatedRate = (*PercentageRate)(&value)
 }
 
+func (s *SettlementSubTotalCalculatedTax1) SetCalculatedRateFromDecimal(value decimal.Decimal) {
+	s.CalculatedRate = new(PercentageRate)
+	s.CalculatedRate.FromDecimal(value)
+}
+
 func (s *SettlementSubTotalCalculatedTax1) AddExemptionReason() *TaxExemptionReason1 {
 	s.ExemptionReason = new(TaxExemptionReason1)
 	return s.ExemptionReason
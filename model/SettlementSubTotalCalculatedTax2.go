@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies  the subtotal calculated tax applicable for this settlement.
 type SettlementSubTotalCalculatedTax2 struct {
 
@@ -25,6 +29,10 @@ type SettlementSubTotalCalculatedTax2 struct {
 	TaxCurrencyExchange *CurrencyReference3 `xml:"TaxCcyXchg,omitempty"`
 }
 
+func (s *SettlementSubTotalCalculatedTax2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementSubTotalCalculatedTax2) SetTypeCode(value string) {
 	s.TypeCode = (*Max4Text)(&value)
 }
@@ -33,6 +41,11 @@ func (s *SettlementSubTotalCalculatedTax2) SetCalculatedRate(value string) {
 	s.CalculatedRate = (*PercentageRate)(&value)
 }
 
+func (s *SettlementSubTotalCalculatedTax2) SetCalculatedRateFromDecimal(value decimal.Decimal) {
+	s.CalculatedRate = new(PercentageRate)
+	s.CalculatedRate.FromDecimal(value)
+}
+
 func (s *SettlementSubTotalCalculatedTax2) AddBasisAmount(value, currency string) {
 	s.BasisAmount = append(s.BasisAmount, NewCurrencyAndAmount(value, currency))
 }
@@ -10,6 +10,10 @@ type SettlementSystemMethod1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SettlementSystemMethod1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementSystemMethod1Choice) SetCode(value string) {
 	s.Code = (*SettlementSystemMethod1Code)(&value)
 }
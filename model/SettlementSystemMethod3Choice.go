@@ -10,6 +10,10 @@ type SettlementSystemMethod3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (s *SettlementSystemMethod3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementSystemMethod3Choice) SetCode(value string) {
 	s.Code = (*SettlementSystemMethod1Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementSystemMethod4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SettlementSystemMethod4Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementSystemMethod4Choice) SetCode(value string) {
 	s.Code = (*SettlementSystemMethod1Code)(&value)
 }
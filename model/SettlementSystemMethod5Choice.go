@@ -10,6 +10,10 @@ type SettlementSystemMethod5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SettlementSystemMethod5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementSystemMethod5Choice) SetCode(value string) {
 	s.Code = (*SettlementSystemMethod1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Species the tax applicable for this settlement.
 type SettlementTax1 struct {
 
@@ -16,6 +20,10 @@ type SettlementTax1 struct {
 	TaxPointDate *ISODate `xml:"TaxPtDt,omitempty"`
 }
 
+func (s *SettlementTax1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTax1) AddTypeCode() *TaxTypeFormat1Choice {
 	s.TypeCode = new(TaxTypeFormat1Choice)
 	return s.TypeCode
@@ -32,3 +40,8 @@ func (s *SettlementTax1) AddBasisAmount(value, currency string) {
 func (s *SettlementTax1) SetTaxPointDate(value string) {
 	s.TaxPointDate = (*ISODate)(&value)
 }
+
+func (s *SettlementTax1) SetTaxPointDateFromTime(value time.Time) {
+	s.TaxPointDate = new(ISODate)
+	s.TaxPointDate.FromTime(value)
+}
@@ -10,6 +10,10 @@ type SettlementTerms2 struct {
 	CreditorAccount *CashAccount7 `xml:"CdtrAcct"`
 }
 
+func (s *SettlementTerms2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTerms2) AddCreditorAgent() *FinancialInstitutionIdentification4Choice {
 	s.CreditorAgent = new(FinancialInstitutionIdentification4Choice)
 	return s.CreditorAgent
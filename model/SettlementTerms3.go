@@ -10,6 +10,10 @@ type SettlementTerms3 struct {
 	CreditorAccount *CashAccount24 `xml:"CdtrAcct"`
 }
 
+func (s *SettlementTerms3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTerms3) AddCreditorAgent() *FinancialInstitutionIdentification4Choice {
 	s.CreditorAgent = new(FinancialInstitutionIdentification4Choice)
 	return s.CreditorAgent
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information on the requested settlement time of the instruction.
 type SettlementTimeRequest1 struct {
 
@@ -7,6 +11,15 @@ type SettlementTimeRequest1 struct {
 	CLSTime *ISOTime `xml:"CLSTm"`
 }
 
+func (s *SettlementTimeRequest1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTimeRequest1) SetCLSTime(value string) {
 	s.CLSTime = (*ISOTime)(&value)
 }
+
+func (s *SettlementTimeRequest1) SetCLSTimeFromTime(value time.Time) {
+	s.CLSTime = new(ISOTime)
+	s.CLSTime.FromTime(value)
+}
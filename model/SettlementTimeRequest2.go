@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides information on the requested settlement time(s) of the payment instruction.
 type SettlementTimeRequest2 struct {
 
@@ -17,18 +23,101 @@ type SettlementTimeRequest2 struct {
 	RejectTime *ISOTime `xml:"RjctTm,omitempty"`
 }
 
+func (s *SettlementTimeRequest2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTimeRequest2) SetCLSTime(value string) {
 	s.CLSTime = (*ISOTime)(&value)
 }
 
+func (s *SettlementTimeRequest2) SetCLSTimeFromTime(value time.Time) {
+	s.CLSTime = new(ISOTime)
+	s.CLSTime.FromTime(value)
+}
+
 func (s *SettlementTimeRequest2) SetTillTime(value string) {
 	s.TillTime = (*ISOTime)(&value)
 }
 
+func (s *SettlementTimeRequest2) SetTillTimeFromTime(value time.Time) {
+	s.TillTime = new(ISOTime)
+	s.TillTime.FromTime(value)
+}
+
 func (s *SettlementTimeRequest2) SetFromTime(value string) {
 	s.FromTime = (*ISOTime)(&value)
 }
 
+func (s *SettlementTimeRequest2) SetFromTimeFromTime(value time.Time) {
+	s.FromTime = new(ISOTime)
+	s.FromTime.FromTime(value)
+}
+
 func (s *SettlementTimeRequest2) SetRejectTime(value string) {
 	s.RejectTime = (*ISOTime)(&value)
 }
+
+func (s *SettlementTimeRequest2) SetRejectTimeFromTime(value time.Time) {
+	s.RejectTime = new(ISOTime)
+	s.RejectTime.FromTime(value)
+}
+
+func (s *SettlementTimeRequest2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if s.CLSTime != nil {
+		enc.Element("CLSTm", string(*s.CLSTime))
+	}
+	if s.TillTime != nil {
+		enc.Element("TillTm", string(*s.TillTime))
+	}
+	if s.FromTime != nil {
+		enc.Element("FrTm", string(*s.FromTime))
+	}
+	if s.RejectTime != nil {
+		enc.Element("RjctTm", string(*s.RejectTime))
+	}
+	enc.End(tag)
+}
+
+func (s *SettlementTimeRequest2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "CLSTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.CLSTime = (*ISOTime)(&value)
+		case "TillTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.TillTime = (*ISOTime)(&value)
+		case "FrTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.FromTime = (*ISOTime)(&value)
+		case "RjctTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.RejectTime = (*ISOTime)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
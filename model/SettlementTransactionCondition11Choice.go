@@ -10,6 +10,10 @@ type SettlementTransactionCondition11Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition11Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition11Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition7Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementTransactionCondition12Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition12Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition12Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition8Code)(&value)
 }
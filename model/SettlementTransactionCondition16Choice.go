@@ -10,6 +10,10 @@ type SettlementTransactionCondition16Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition16Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition16Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition10Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementTransactionCondition17Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition17Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition17Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition4Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementTransactionCondition18Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition18Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition18Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition6Code)(&value)
 }
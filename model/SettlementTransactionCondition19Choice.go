@@ -10,6 +10,10 @@ type SettlementTransactionCondition19Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition19Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition19Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition3Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementTransactionCondition1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition1Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition2Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementTransactionCondition20Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition20Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition20Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition8Code)(&value)
 }
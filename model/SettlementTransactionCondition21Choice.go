@@ -10,6 +10,10 @@ type SettlementTransactionCondition21Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition21Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition21Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition3Code)(&value)
 }
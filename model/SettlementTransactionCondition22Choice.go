@@ -10,6 +10,10 @@ type SettlementTransactionCondition22Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition22Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition22Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition6Code)(&value)
 }
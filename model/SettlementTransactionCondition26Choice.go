@@ -10,6 +10,10 @@ type SettlementTransactionCondition26Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition26Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition26Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition4Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementTransactionCondition28Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition28Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition28Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition10Code)(&value)
 }
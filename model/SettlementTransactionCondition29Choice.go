@@ -10,6 +10,10 @@ type SettlementTransactionCondition29Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition29Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition29Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition8Code)(&value)
 }
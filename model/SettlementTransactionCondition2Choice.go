@@ -10,6 +10,10 @@ type SettlementTransactionCondition2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition2Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition3Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementTransactionCondition30Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition30Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition30Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition11Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementTransactionCondition5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition5Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition4Code)(&value)
 }
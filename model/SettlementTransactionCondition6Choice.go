@@ -10,6 +10,10 @@ type SettlementTransactionCondition6Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition6Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition6Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition6Code)(&value)
 }
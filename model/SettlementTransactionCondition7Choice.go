@@ -10,6 +10,10 @@ type SettlementTransactionCondition7Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionCondition7Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionCondition7Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionCondition6Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlementTransactionType1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (s *SettlementTransactionType1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTransactionType1Choice) SetCode(value string) {
 	s.Code = (*SettlementTransactionType7Code)(&value)
 }
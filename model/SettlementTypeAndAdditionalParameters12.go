@@ -13,12 +13,24 @@ type SettlementTypeAndAdditionalParameters12 struct {
 	CommonIdentification *Max35Text `xml:"CmonId,omitempty"`
 }
 
-func (s *SettlementTypeAndAdditionalParameters12) SetSecuritiesMovementType(value string) {
+func (s *SettlementTypeAndAdditionalParameters12) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementTypeAndAdditionalParameters12) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementTypeAndAdditionalParameters12) SetPayment(value string) {
+func (s *SettlementTypeAndAdditionalParameters12) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SettlementTypeAndAdditionalParameters12) SetCommonIdentification(value string) {
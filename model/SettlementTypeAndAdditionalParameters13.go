@@ -13,6 +13,10 @@ type SettlementTypeAndAdditionalParameters13 struct {
 	ReconciliationIndicator *YesNoIndicator `xml:"RcncltnInd,omitempty"`
 }
 
+func (s *SettlementTypeAndAdditionalParameters13) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTypeAndAdditionalParameters13) SetCommonIdentification(value string) {
 	s.CommonIdentification = (*Max35Text)(&value)
 }
@@ -24,3 +28,8 @@ func (s *SettlementTypeAndAdditionalParameters13) SetCorporateActionEventIdentif
 func (s *SettlementTypeAndAdditionalParameters13) SetReconciliationIndicator(value string) {
 	s.ReconciliationIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementTypeAndAdditionalParameters13) SetReconciliationIndicatorFromBool(value bool) {
+	s.ReconciliationIndicator = new(YesNoIndicator)
+	s.ReconciliationIndicator.FromBool(value)
+}
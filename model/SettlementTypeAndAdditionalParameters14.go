@@ -19,12 +19,24 @@ type SettlementTypeAndAdditionalParameters14 struct {
 	ReconciliationIndicator *YesNoIndicator `xml:"RcncltnInd,omitempty"`
 }
 
-func (s *SettlementTypeAndAdditionalParameters14) SetSecuritiesMovementType(value string) {
+func (s *SettlementTypeAndAdditionalParameters14) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementTypeAndAdditionalParameters14) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementTypeAndAdditionalParameters14) SetPayment(value string) {
+func (s *SettlementTypeAndAdditionalParameters14) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SettlementTypeAndAdditionalParameters14) SetCommonIdentification(value string) {
@@ -38,3 +50,8 @@ func (s *SettlementTypeAndAdditionalParameters14) SetCorporateActionEventIdentif
 func (s *SettlementTypeAndAdditionalParameters14) SetReconciliationIndicator(value string) {
 	s.ReconciliationIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementTypeAndAdditionalParameters14) SetReconciliationIndicatorFromBool(value bool) {
+	s.ReconciliationIndicator = new(YesNoIndicator)
+	s.ReconciliationIndicator.FromBool(value)
+}
@@ -13,12 +13,24 @@ type SettlementTypeAndAdditionalParameters15 struct {
 	CommonIdentification *RestrictedFINXMax16Text `xml:"CmonId,omitempty"`
 }
 
-func (s *SettlementTypeAndAdditionalParameters15) SetSecuritiesMovementType(value string) {
+func (s *SettlementTypeAndAdditionalParameters15) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementTypeAndAdditionalParameters15) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementTypeAndAdditionalParameters15) SetPayment(value string) {
+func (s *SettlementTypeAndAdditionalParameters15) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SettlementTypeAndAdditionalParameters15) SetCommonIdentification(value string) {
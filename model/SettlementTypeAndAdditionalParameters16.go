@@ -31,12 +31,24 @@ type SettlementTypeAndAdditionalParameters16 struct {
 	TripartyCollateralInstructionIdentification *RestrictedFINXMax16Text `xml:"TrptyCollInstrId,omitempty"`
 }
 
-func (s *SettlementTypeAndAdditionalParameters16) SetSecuritiesMovementType(value string) {
+func (s *SettlementTypeAndAdditionalParameters16) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementTypeAndAdditionalParameters16) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementTypeAndAdditionalParameters16) SetPayment(value string) {
+func (s *SettlementTypeAndAdditionalParameters16) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SettlementTypeAndAdditionalParameters16) SetCommonIdentification(value string) {
@@ -51,6 +63,11 @@ func (s *SettlementTypeAndAdditionalParameters16) SetReconciliationIndicator(val
 	s.ReconciliationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementTypeAndAdditionalParameters16) SetReconciliationIndicatorFromBool(value bool) {
+	s.ReconciliationIndicator = new(YesNoIndicator)
+	s.ReconciliationIndicator.FromBool(value)
+}
+
 func (s *SettlementTypeAndAdditionalParameters16) SetClientCollateralInstructionIdentification(value string) {
 	s.ClientCollateralInstructionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -13,6 +13,10 @@ type SettlementTypeAndAdditionalParameters17 struct {
 	ReconciliationIndicator *YesNoIndicator `xml:"RcncltnInd,omitempty"`
 }
 
+func (s *SettlementTypeAndAdditionalParameters17) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTypeAndAdditionalParameters17) SetCommonIdentification(value string) {
 	s.CommonIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -24,3 +28,8 @@ func (s *SettlementTypeAndAdditionalParameters17) SetCorporateActionEventIdentif
 func (s *SettlementTypeAndAdditionalParameters17) SetReconciliationIndicator(value string) {
 	s.ReconciliationIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementTypeAndAdditionalParameters17) SetReconciliationIndicatorFromBool(value bool) {
+	s.ReconciliationIndicator = new(YesNoIndicator)
+	s.ReconciliationIndicator.FromBool(value)
+}
@@ -31,12 +31,24 @@ type SettlementTypeAndAdditionalParameters19 struct {
 	TripartyAgentServiceProviderCollateralInstructionIdentification *Max35Text `xml:"TrptyAgtSvcPrvdrCollInstrId,omitempty"`
 }
 
-func (s *SettlementTypeAndAdditionalParameters19) SetSecuritiesMovementType(value string) {
+func (s *SettlementTypeAndAdditionalParameters19) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementTypeAndAdditionalParameters19) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementTypeAndAdditionalParameters19) SetPayment(value string) {
+func (s *SettlementTypeAndAdditionalParameters19) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SettlementTypeAndAdditionalParameters19) SetCommonIdentification(value string) {
@@ -51,6 +63,11 @@ func (s *SettlementTypeAndAdditionalParameters19) SetReconciliationIndicator(val
 	s.ReconciliationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementTypeAndAdditionalParameters19) SetReconciliationIndicatorFromBool(value bool) {
+	s.ReconciliationIndicator = new(YesNoIndicator)
+	s.ReconciliationIndicator.FromBool(value)
+}
+
 func (s *SettlementTypeAndAdditionalParameters19) SetClientCollateralInstructionIdentification(value string) {
 	s.ClientCollateralInstructionIdentification = (*Max35Text)(&value)
 }
@@ -31,12 +31,24 @@ type SettlementTypeAndAdditionalParameters20 struct {
 	TripartyAgentServiceProviderCollateralInstructionIdentification *RestrictedFINXMax16Text `xml:"TrptyAgtSvcPrvdrCollInstrId,omitempty"`
 }
 
-func (s *SettlementTypeAndAdditionalParameters20) SetSecuritiesMovementType(value string) {
+func (s *SettlementTypeAndAdditionalParameters20) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementTypeAndAdditionalParameters20) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementTypeAndAdditionalParameters20) SetPayment(value string) {
+func (s *SettlementTypeAndAdditionalParameters20) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SettlementTypeAndAdditionalParameters20) SetCommonIdentification(value string) {
@@ -51,6 +63,11 @@ func (s *SettlementTypeAndAdditionalParameters20) SetReconciliationIndicator(val
 	s.ReconciliationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SettlementTypeAndAdditionalParameters20) SetReconciliationIndicatorFromBool(value bool) {
+	s.ReconciliationIndicator = new(YesNoIndicator)
+	s.ReconciliationIndicator.FromBool(value)
+}
+
 func (s *SettlementTypeAndAdditionalParameters20) SetClientCollateralInstructionIdentification(value string) {
 	s.ClientCollateralInstructionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -19,12 +19,24 @@ type SettlementTypeAndAdditionalParameters5 struct {
 	ReconciliationIndicator *YesNoIndicator `xml:"RcncltnInd,omitempty"`
 }
 
-func (s *SettlementTypeAndAdditionalParameters5) SetSecuritiesMovementType(value string) {
+func (s *SettlementTypeAndAdditionalParameters5) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementTypeAndAdditionalParameters5) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementTypeAndAdditionalParameters5) SetPayment(value string) {
+func (s *SettlementTypeAndAdditionalParameters5) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SettlementTypeAndAdditionalParameters5) SetCommonIdentification(value string) {
@@ -38,3 +50,8 @@ func (s *SettlementTypeAndAdditionalParameters5) SetCorporateActionEventIdentifi
 func (s *SettlementTypeAndAdditionalParameters5) SetReconciliationIndicator(value string) {
 	s.ReconciliationIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementTypeAndAdditionalParameters5) SetReconciliationIndicatorFromBool(value bool) {
+	s.ReconciliationIndicator = new(YesNoIndicator)
+	s.ReconciliationIndicator.FromBool(value)
+}
@@ -13,6 +13,10 @@ type SettlementTypeAndAdditionalParameters6 struct {
 	ReconciliationIndicator *YesNoIndicator `xml:"RcncltnInd,omitempty"`
 }
 
+func (s *SettlementTypeAndAdditionalParameters6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTypeAndAdditionalParameters6) SetCommonIdentification(value string) {
 	s.CommonIdentification = (*Max35Text)(&value)
 }
@@ -24,3 +28,8 @@ func (s *SettlementTypeAndAdditionalParameters6) SetCorporateActionEventIdentifi
 func (s *SettlementTypeAndAdditionalParameters6) SetReconciliationIndicator(value string) {
 	s.ReconciliationIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *SettlementTypeAndAdditionalParameters6) SetReconciliationIndicatorFromBool(value bool) {
+	s.ReconciliationIndicator = new(YesNoIndicator)
+	s.ReconciliationIndicator.FromBool(value)
+}
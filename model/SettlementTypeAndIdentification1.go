@@ -28,6 +28,10 @@ type SettlementTypeAndIdentification1 struct {
 	CorporateActionEventIdentification *Max35Text `xml:"CorpActnEvtId,omitempty"`
 }
 
+func (s *SettlementTypeAndIdentification1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTypeAndIdentification1) SetAccountOwnerTransactionIdentification(value string) {
 	s.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -40,12 +44,20 @@ func (s *SettlementTypeAndIdentification1) SetMarketInfrastructureTransactionIde
 	s.MarketInfrastructureTransactionIdentification = (*Max35Text)(&value)
 }
 
-func (s *SettlementTypeAndIdentification1) SetSecuritiesMovementType(value string) {
+func (s *SettlementTypeAndIdentification1) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementTypeAndIdentification1) SetPayment(value string) {
+func (s *SettlementTypeAndIdentification1) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SettlementTypeAndIdentification1) SetCommonIdentification(value string) {
@@ -13,14 +13,26 @@ type SettlementTypeAndIdentification13 struct {
 	Payment *DeliveryReceiptType2Code `xml:"Pmt"`
 }
 
+func (s *SettlementTypeAndIdentification13) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTypeAndIdentification13) SetTransactionIdentification(value string) {
 	s.TransactionIdentification = (*Max35Text)(&value)
 }
 
-func (s *SettlementTypeAndIdentification13) SetSecuritiesMovementType(value string) {
+func (s *SettlementTypeAndIdentification13) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementTypeAndIdentification13) SetPayment(value string) {
+func (s *SettlementTypeAndIdentification13) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
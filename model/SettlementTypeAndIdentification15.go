@@ -31,6 +31,10 @@ type SettlementTypeAndIdentification15 struct {
 	CorporateActionEventIdentification *Max35Text `xml:"CorpActnEvtId,omitempty"`
 }
 
+func (s *SettlementTypeAndIdentification15) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTypeAndIdentification15) SetAccountOwnerTransactionIdentification(value string) {
 	s.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -47,12 +51,20 @@ func (s *SettlementTypeAndIdentification15) SetProcessorTransactionIdentificatio
 	s.ProcessorTransactionIdentification = (*Max35Text)(&value)
 }
 
-func (s *SettlementTypeAndIdentification15) SetSecuritiesMovementType(value string) {
+func (s *SettlementTypeAndIdentification15) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementTypeAndIdentification15) SetPayment(value string) {
+func (s *SettlementTypeAndIdentification15) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SettlementTypeAndIdentification15) SetCommonIdentification(value string) {
@@ -13,14 +13,26 @@ type SettlementTypeAndIdentification18 struct {
 	Payment *DeliveryReceiptType2Code `xml:"Pmt"`
 }
 
+func (s *SettlementTypeAndIdentification18) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTypeAndIdentification18) SetTransactionIdentification(value string) {
 	s.TransactionIdentification = (*Max35Text)(&value)
 }
 
-func (s *SettlementTypeAndIdentification18) SetSecuritiesMovementType(value string) {
+func (s *SettlementTypeAndIdentification18) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementTypeAndIdentification18) SetPayment(value string) {
+func (s *SettlementTypeAndIdentification18) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
@@ -13,8 +13,16 @@ type SettlementTypeAndIdentification2 struct {
 	SettlementDate *DateAndDateTimeChoice `xml:"SttlmDt,omitempty"`
 }
 
-func (s *SettlementTypeAndIdentification2) SetPayment(value string) {
+func (s *SettlementTypeAndIdentification2) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SettlementTypeAndIdentification2) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SettlementTypeAndIdentification2) SetTransactionIdentification(value string) {
@@ -31,6 +31,10 @@ type SettlementTypeAndIdentification24 struct {
 	CorporateActionEventIdentification *RestrictedFINXMax16Text `xml:"CorpActnEvtId,omitempty"`
 }
 
+func (s *SettlementTypeAndIdentification24) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTypeAndIdentification24) SetAccountOwnerTransactionIdentification(value string) {
 	s.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -47,12 +51,20 @@ func (s *SettlementTypeAndIdentification24) SetProcessorTransactionIdentificatio
 	s.ProcessorTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
 
-func (s *SettlementTypeAndIdentification24) SetSecuritiesMovementType(value string) {
+func (s *SettlementTypeAndIdentification24) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementTypeAndIdentification24) SetPayment(value string) {
+func (s *SettlementTypeAndIdentification24) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SettlementTypeAndIdentification24) SetCommonIdentification(value string) {
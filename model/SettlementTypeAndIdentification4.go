@@ -13,14 +13,26 @@ type SettlementTypeAndIdentification4 struct {
 	Payment *DeliveryReceiptType2Code `xml:"Pmt,omitempty"`
 }
 
+func (s *SettlementTypeAndIdentification4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTypeAndIdentification4) SetTransactionIdentification(value string) {
 	s.TransactionIdentification = (*Max35Text)(&value)
 }
 
-func (s *SettlementTypeAndIdentification4) SetSecuritiesMovementType(value string) {
+func (s *SettlementTypeAndIdentification4) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementTypeAndIdentification4) SetPayment(value string) {
+func (s *SettlementTypeAndIdentification4) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
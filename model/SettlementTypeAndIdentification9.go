@@ -31,6 +31,10 @@ type SettlementTypeAndIdentification9 struct {
 	CorporateActionEventIdentification *Max35Text `xml:"CorpActnEvtId,omitempty"`
 }
 
+func (s *SettlementTypeAndIdentification9) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlementTypeAndIdentification9) SetAccountOwnerTransactionIdentification(value string) {
 	s.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -47,12 +51,20 @@ func (s *SettlementTypeAndIdentification9) SetProcessorTransactionIdentification
 	s.ProcessorTransactionIdentification = (*Max35Text)(&value)
 }
 
-func (s *SettlementTypeAndIdentification9) SetSecuritiesMovementType(value string) {
+func (s *SettlementTypeAndIdentification9) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (s *SettlementTypeAndIdentification9) SetPayment(value string) {
+func (s *SettlementTypeAndIdentification9) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SettlementTypeAndIdentification9) SetCommonIdentification(value string) {
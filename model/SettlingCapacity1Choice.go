@@ -10,6 +10,10 @@ type SettlingCapacity1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SettlingCapacity1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlingCapacity1Choice) SetCode(value string) {
 	s.Code = (*SettlingCapacity1Code)(&value)
 }
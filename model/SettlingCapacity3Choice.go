@@ -10,6 +10,10 @@ type SettlingCapacity3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (s *SettlingCapacity3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlingCapacity3Choice) SetCode(value string) {
 	s.Code = (*SettlingCapacity1Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlingCapacity4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SettlingCapacity4Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlingCapacity4Choice) SetCode(value string) {
 	s.Code = (*SettlingCapacity2Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlingCapacity7Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SettlingCapacity7Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlingCapacity7Choice) SetCode(value string) {
 	s.Code = (*SettlingCapacity2Code)(&value)
 }
@@ -10,6 +10,10 @@ type SettlingCapacity8Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SettlingCapacity8Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SettlingCapacity8Choice) SetCode(value string) {
 	s.Code = (*SettlingCapacity2Code)(&value)
 }
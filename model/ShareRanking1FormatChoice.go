@@ -10,6 +10,10 @@ type ShareRanking1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (s *ShareRanking1FormatChoice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *ShareRanking1FormatChoice) SetCode(value string) {
 	s.Code = (*ShareRanking1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Further details on the shipment conditions.
 type ShipmentAttribute1 struct {
 
@@ -13,6 +17,10 @@ type ShipmentAttribute1 struct {
 	CountryOfCounterParty *CountryCode `xml:"CtryOfCntrPty"`
 }
 
+func (s *ShipmentAttribute1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *ShipmentAttribute1) SetConditions(value string) {
 	s.Conditions = (*ExternalShipmentCondition1Code)(&value)
 }
@@ -21,6 +29,11 @@ func (s *ShipmentAttribute1) SetExpectedDate(value string) {
 	s.ExpectedDate = (*ISODate)(&value)
 }
 
+func (s *ShipmentAttribute1) SetExpectedDateFromTime(value time.Time) {
+	s.ExpectedDate = new(ISODate)
+	s.ExpectedDate.FromTime(value)
+}
+
 func (s *ShipmentAttribute1) SetCountryOfCounterParty(value string) {
 	s.CountryOfCounterParty = (*CountryCode)(&value)
 }
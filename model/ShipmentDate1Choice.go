@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice between proposed and actual shipment date.
 type ShipmentDate1Choice struct {
 
@@ -10,10 +14,24 @@ type ShipmentDate1Choice struct {
 	ActualShipmentDate *ISODate `xml:"ActlShipmntDt"`
 }
 
+func (s *ShipmentDate1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *ShipmentDate1Choice) SetProposedShipmentDate(value string) {
 	s.ProposedShipmentDate = (*ISODate)(&value)
 }
 
+func (s *ShipmentDate1Choice) SetProposedShipmentDateFromTime(value time.Time) {
+	s.ProposedShipmentDate = new(ISODate)
+	s.ProposedShipmentDate.FromTime(value)
+}
+
 func (s *ShipmentDate1Choice) SetActualShipmentDate(value string) {
 	s.ActualShipmentDate = (*ISODate)(&value)
 }
+
+func (s *ShipmentDate1Choice) SetActualShipmentDateFromTime(value time.Time) {
+	s.ActualShipmentDate = new(ISODate)
+	s.ActualShipmentDate.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies an earliest shipment date and a latest shipment date.
 type ShipmentDateRange1 struct {
 
@@ -10,10 +14,24 @@ type ShipmentDateRange1 struct {
 	LatestShipmentDate *ISODate `xml:"LatstShipmntDt,omitempty"`
 }
 
+func (s *ShipmentDateRange1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *ShipmentDateRange1) SetEarliestShipmentDate(value string) {
 	s.EarliestShipmentDate = (*ISODate)(&value)
 }
 
+func (s *ShipmentDateRange1) SetEarliestShipmentDateFromTime(value time.Time) {
+	s.EarliestShipmentDate = new(ISODate)
+	s.EarliestShipmentDate.FromTime(value)
+}
+
 func (s *ShipmentDateRange1) SetLatestShipmentDate(value string) {
 	s.LatestShipmentDate = (*ISODate)(&value)
 }
+
+func (s *ShipmentDateRange1) SetLatestShipmentDateFromTime(value time.Time) {
+	s.LatestShipmentDate = new(ISODate)
+	s.LatestShipmentDate.FromTime(value)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies a shipment schedule, that is the quantity that must be shipped no sooner than the earliest shipment date and no later than the latest shipment date.
 type ShipmentDateRange2 struct {
 
@@ -13,14 +19,33 @@ type ShipmentDateRange2 struct {
 	LatestShipmentDate *ISODate `xml:"LatstShipmntDt,omitempty"`
 }
 
+func (s *ShipmentDateRange2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *ShipmentDateRange2) SetSubQuantityValue(value string) {
 	s.SubQuantityValue = (*DecimalNumber)(&value)
 }
 
+func (s *ShipmentDateRange2) SetSubQuantityValueFromDecimal(value decimal.Decimal) {
+	s.SubQuantityValue = new(DecimalNumber)
+	s.SubQuantityValue.FromDecimal(value)
+}
+
 func (s *ShipmentDateRange2) SetEarliestShipmentDate(value string) {
 	s.EarliestShipmentDate = (*ISODate)(&value)
 }
 
+func (s *ShipmentDateRange2) SetEarliestShipmentDateFromTime(value time.Time) {
+	s.EarliestShipmentDate = new(ISODate)
+	s.EarliestShipmentDate.FromTime(value)
+}
+
 func (s *ShipmentDateRange2) SetLatestShipmentDate(value string) {
 	s.LatestShipmentDate = (*ISODate)(&value)
 }
+
+func (s *ShipmentDateRange2) SetLatestShipmentDateFromTime(value time.Time) {
+	s.LatestShipmentDate = new(ISODate)
+	s.LatestShipmentDate.FromTime(value)
+}
@@ -10,6 +10,10 @@ type ShipmentSchedule1Choice struct {
 	ShipmentSubSchedule []*ShipmentDateRange2 `xml:"ShipmntSubSchdl"`
 }
 
+func (s *ShipmentSchedule1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *ShipmentSchedule1Choice) AddShipmentDateRange() *ShipmentDateRange1 {
 	s.ShipmentDateRange = new(ShipmentDateRange1)
 	return s.ShipmentDateRange
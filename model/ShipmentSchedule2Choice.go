@@ -10,6 +10,10 @@ type ShipmentSchedule2Choice struct {
 	ShipmentSubSchedule []*ShipmentDateRange2 `xml:"ShipmntSubSchdl"`
 }
 
+func (s *ShipmentSchedule2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *ShipmentSchedule2Choice) AddShipmentDateRange() *ShipmentDateRange1 {
 	s.ShipmentDateRange = new(ShipmentDateRange1)
 	return s.ShipmentDateRange
@@ -1,3 +1,7 @@
 package model
 
 type SicovamIdentifier string
+
+func (s SicovamIdentifier) Validate() error {
+	return validateLength("SicovamIdentifier", string(s), 1, 35)
+}
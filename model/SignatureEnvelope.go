@@ -1,3 +1,22 @@
 package model
 
-type SignatureEnvelope string
+import "encoding/xml"
+
+// Contains the digital signature of a Business Message, typically a
+// ds:Signature element of XML Signature, kept as raw XML.
+type SignatureEnvelope struct {
+	Value string `xml:",innerxml"`
+}
+
+func (s *SignatureEnvelope) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SignatureEnvelope) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	value, err := innerXML(d, start)
+	if err != nil {
+		return err
+	}
+	s.Value = value
+	return nil
+}
@@ -10,6 +10,10 @@ type SignatureType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SignatureType1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SignatureType1Choice) SetCode(value string) {
 	s.Code = (*SignatureType2Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Digital signature of data, with an asymmetric key.
 type SignedData1 struct {
 
@@ -19,10 +23,19 @@ type SignedData1 struct {
 	Signer []*Signer1 `xml:"Sgnr"`
 }
 
+func (s *SignedData1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SignedData1) SetVersion(value string) {
 	s.Version = (*Number)(&value)
 }
 
+func (s *SignedData1) SetVersionFromDecimal(value decimal.Decimal) {
+	s.Version = new(Number)
+	s.Version.FromDecimal(value)
+}
+
 func (s *SignedData1) AddDigestAlgorithm() *AlgorithmIdentification1 {
 	newValue := new(AlgorithmIdentification1)
 	s.DigestAlgorithm = append(s.DigestAlgorithm, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Digital signature of data, with an asymmetric key.
 type SignedData3 struct {
 
@@ -19,10 +23,19 @@ type SignedData3 struct {
 	Signer []*Signer2 `xml:"Sgnr"`
 }
 
+func (s *SignedData3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SignedData3) SetVersion(value string) {
 	s.Version = (*Number)(&value)
 }
 
+func (s *SignedData3) SetVersionFromDecimal(value decimal.Decimal) {
+	s.Version = new(Number)
+	s.Version.FromDecimal(value)
+}
+
 func (s *SignedData3) AddDigestAlgorithm() *AlgorithmIdentification5 {
 	newValue := new(AlgorithmIdentification5)
 	s.DigestAlgorithm = append(s.DigestAlgorithm, newValue)
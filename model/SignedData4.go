@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Digital signatures of data from one or several signers.
 type SignedData4 struct {
 
@@ -19,10 +23,19 @@ type SignedData4 struct {
 	Signer []*Signer3 `xml:"Sgnr"`
 }
 
+func (s *SignedData4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SignedData4) SetVersion(value string) {
 	s.Version = (*Number)(&value)
 }
 
+func (s *SignedData4) SetVersionFromDecimal(value decimal.Decimal) {
+	s.Version = new(Number)
+	s.Version.FromDecimal(value)
+}
+
 func (s *SignedData4) AddDigestAlgorithm() *AlgorithmIdentification16 {
 	newValue := new(AlgorithmIdentification16)
 	s.DigestAlgorithm = append(s.DigestAlgorithm, newValue)
@@ -10,6 +10,10 @@ type SignedQuantityFormat1 struct {
 	QuantityChoice *Quantity2Choice `xml:"QtyChc"`
 }
 
+func (s *SignedQuantityFormat1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SignedQuantityFormat1) SetShortLongPosition(value string) {
 	s.ShortLongPosition = (*ShortLong1Code)(&value)
 }
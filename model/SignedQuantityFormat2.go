@@ -10,6 +10,10 @@ type SignedQuantityFormat2 struct {
 	Quantity *FinancialInstrumentQuantity1Choice `xml:"Qty"`
 }
 
+func (s *SignedQuantityFormat2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SignedQuantityFormat2) SetShortLongPosition(value string) {
 	s.ShortLongPosition = (*ShortLong1Code)(&value)
 }
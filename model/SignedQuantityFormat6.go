@@ -10,6 +10,10 @@ type SignedQuantityFormat6 struct {
 	Quantity *FinancialInstrumentQuantity1Choice `xml:"Qty"`
 }
 
+func (s *SignedQuantityFormat6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SignedQuantityFormat6) SetShortLongPosition(value string) {
 	s.ShortLongPosition = (*ShortLong1Code)(&value)
 }
@@ -10,6 +10,10 @@ type SignedQuantityFormat7 struct {
 	QuantityChoice *Quantity19Choice `xml:"QtyChc"`
 }
 
+func (s *SignedQuantityFormat7) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SignedQuantityFormat7) SetShortLongPosition(value string) {
 	s.ShortLongPosition = (*ShortLong1Code)(&value)
 }
@@ -10,6 +10,10 @@ type SignedQuantityFormat8 struct {
 	QuantityChoice *Quantity21Choice `xml:"QtyChc"`
 }
 
+func (s *SignedQuantityFormat8) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SignedQuantityFormat8) SetShortLongPosition(value string) {
 	s.ShortLongPosition = (*ShortLong1Code)(&value)
 }
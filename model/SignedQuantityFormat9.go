@@ -10,6 +10,10 @@ type SignedQuantityFormat9 struct {
 	Quantity *FinancialInstrumentQuantity15Choice `xml:"Qty"`
 }
 
+func (s *SignedQuantityFormat9) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SignedQuantityFormat9) SetShortLongPosition(value string) {
 	s.ShortLongPosition = (*ShortLong1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Entity who has signed the data and its digital signature.
 type Signer2 struct {
 
@@ -19,10 +23,19 @@ type Signer2 struct {
 	Signature *Max500Binary `xml:"Sgntr"`
 }
 
+func (s *Signer2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Signer2) SetVersion(value string) {
 	s.Version = (*Number)(&value)
 }
 
+func (s *Signer2) SetVersionFromDecimal(value decimal.Decimal) {
+	s.Version = new(Number)
+	s.Version.FromDecimal(value)
+}
+
 func (s *Signer2) AddSignerIdentification() *CertificateIdentifier1 {
 	s.SignerIdentification = new(CertificateIdentifier1)
 	return s.SignerIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Entity who has signed the data and its digital signature.
 type Signer3 struct {
 
@@ -19,10 +23,19 @@ type Signer3 struct {
 	Signature *Max3000Binary `xml:"Sgntr"`
 }
 
+func (s *Signer3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Signer3) SetVersion(value string) {
 	s.Version = (*Number)(&value)
 }
 
+func (s *Signer3) SetVersionFromDecimal(value decimal.Decimal) {
+	s.Version = new(Number)
+	s.Version.FromDecimal(value)
+}
+
 func (s *Signer3) AddSignerIdentification() *Recipient5Choice {
 	s.SignerIdentification = new(Recipient5Choice)
 	return s.SignerIdentification
@@ -7,6 +7,10 @@ type SimpleIdentificationInformation struct {
 	Identification *Max35Text `xml:"Id"`
 }
 
+func (s *SimpleIdentificationInformation) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SimpleIdentificationInformation) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
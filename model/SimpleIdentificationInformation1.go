@@ -7,6 +7,10 @@ type SimpleIdentificationInformation1 struct {
 	Identification *RestrictedFINXMax35Text `xml:"Id"`
 }
 
+func (s *SimpleIdentificationInformation1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SimpleIdentificationInformation1) SetIdentification(value string) {
 	s.Identification = (*RestrictedFINXMax35Text)(&value)
 }
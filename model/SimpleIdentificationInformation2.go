@@ -7,6 +7,10 @@ type SimpleIdentificationInformation2 struct {
 	Identification *Max34Text `xml:"Id"`
 }
 
+func (s *SimpleIdentificationInformation2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SimpleIdentificationInformation2) SetIdentification(value string) {
 	s.Identification = (*Max34Text)(&value)
 }
@@ -7,6 +7,10 @@ type SimpleIdentificationInformation4 struct {
 	Identification *Max35Text `xml:"Id"`
 }
 
+func (s *SimpleIdentificationInformation4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SimpleIdentificationInformation4) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type SingleQualifiedPartyIdentification1 struct {
 	RelativeIdentifier []*Max35Text `xml:"RltvIdr,omitempty"`
 }
 
+func (s *SingleQualifiedPartyIdentification1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SingleQualifiedPartyIdentification1) AddBaseParty() *TradeParty1 {
 	s.BaseParty = new(TradeParty1)
 	return s.BaseParty
@@ -16,6 +16,10 @@ type SingleTransport3 struct {
 	TransportByRail *TransportByRail2 `xml:"TrnsprtByRail,omitempty"`
 }
 
+func (s *SingleTransport3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SingleTransport3) AddTransportByAir() *TransportByAir2 {
 	s.TransportByAir = new(TransportByAir2)
 	return s.TransportByAir
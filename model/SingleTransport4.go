@@ -16,6 +16,10 @@ type SingleTransport4 struct {
 	TransportByRail []*TransportByRail3 `xml:"TrnsprtByRail,omitempty"`
 }
 
+func (s *SingleTransport4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SingleTransport4) AddTransportByAir() *TransportByAir3 {
 	newValue := new(TransportByAir3)
 	s.TransportByAir = append(s.TransportByAir, newValue)
@@ -16,6 +16,10 @@ type SingleTransport5 struct {
 	TransportByRail []*TransportByRail2 `xml:"TrnsprtByRail,omitempty"`
 }
 
+func (s *SingleTransport5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SingleTransport5) AddTransportByAir() *TransportByAir2 {
 	newValue := new(TransportByAir2)
 	s.TransportByAir = append(s.TransportByAir, newValue)
@@ -16,6 +16,10 @@ type SingleTransport6 struct {
 	TransportByRail []*TransportByRail4 `xml:"TrnsprtByRail,omitempty"`
 }
 
+func (s *SingleTransport6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SingleTransport6) AddTransportByAir() *TransportByAir4 {
 	newValue := new(TransportByAir4)
 	s.TransportByAir = append(s.TransportByAir, newValue)
@@ -16,6 +16,10 @@ type SingleTransport7 struct {
 	TransportByRail []*TransportByRail5 `xml:"TrnsprtByRail,omitempty"`
 }
 
+func (s *SingleTransport7) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SingleTransport7) AddTransportByAir() *TransportByAir5 {
 	newValue := new(TransportByAir5)
 	s.TransportByAir = append(s.TransportByAir, newValue)
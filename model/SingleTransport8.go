@@ -16,6 +16,10 @@ type SingleTransport8 struct {
 	TransportByRail []*TransportByRail4 `xml:"TrnsprtByRail,omitempty"`
 }
 
+func (s *SingleTransport8) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SingleTransport8) AddTransportByAir() *TransportByAir4 {
 	newValue := new(TransportByAir4)
 	s.TransportByAir = append(s.TransportByAir, newValue)
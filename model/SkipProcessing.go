@@ -1,3 +1,41 @@
 package model
 
-type SkipProcessing string
+import "encoding/xml"
+
+// Content that is not processed by the receiver, kept as raw XML.
+type SkipProcessing struct {
+	Value string `xml:",innerxml"`
+}
+
+func (s *SkipProcessing) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SkipProcessing) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	value, err := innerXML(d, start)
+	if err != nil {
+		return err
+	}
+	s.Value = value
+	return nil
+}
+
+// Embed replaces the content with the XML encoding of v.
+func (s *SkipProcessing) Embed(v interface{}) error {
+	value, err := embedXML(v)
+	if err != nil {
+		return err
+	}
+	s.Value = value
+	return nil
+}
+
+// Extract decodes the content into v.
+func (s *SkipProcessing) Extract(v interface{}) error {
+	return extractXML(s.Value, v)
+}
+
+// Namespace returns the namespace of the first element of the content.
+func (s *SkipProcessing) Namespace() string {
+	return rootNamespace(s.Value)
+}
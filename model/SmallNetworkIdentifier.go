@@ -1,3 +1,9 @@
 package model
 
 type SmallNetworkIdentifier string
+
+var smallNetworkIdentifierPattern = newPattern(`AU[0-9]{6,6}`)
+
+func (s SmallNetworkIdentifier) Validate() error {
+	return validatePattern("SmallNetworkIdentifier", string(s), smallNetworkIdentifierPattern)
+}
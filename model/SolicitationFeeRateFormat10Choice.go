@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a rate or an unspecified rate.
 type SolicitationFeeRateFormat10Choice struct {
 
@@ -16,10 +20,19 @@ type SolicitationFeeRateFormat10Choice struct {
 	NotSpecifiedRate *RateValueType7Code `xml:"NotSpcfdRate"`
 }
 
+func (s *SolicitationFeeRateFormat10Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SolicitationFeeRateFormat10Choice) SetRate(value string) {
 	s.Rate = (*PercentageRate)(&value)
 }
 
+func (s *SolicitationFeeRateFormat10Choice) SetRateFromDecimal(value decimal.Decimal) {
+	s.Rate = new(PercentageRate)
+	s.Rate.FromDecimal(value)
+}
+
 func (s *SolicitationFeeRateFormat10Choice) AddAmountToQuantity() *AmountAndQuantityRatio5 {
 	s.AmountToQuantity = new(AmountAndQuantityRatio5)
 	return s.AmountToQuantity
@@ -29,6 +42,10 @@ func (s *SolicitationFeeRateFormat10Choice) SetAmount(value, currency string) {
 	s.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (s *SolicitationFeeRateFormat10Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (s *SolicitationFeeRateFormat10Choice) SetNotSpecifiedRate(value string) {
 	s.NotSpecifiedRate = (*RateValueType7Code)(&value)
 }
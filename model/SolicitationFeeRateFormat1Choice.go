@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a rate or an unspecified rate.
 type SolicitationFeeRateFormat1Choice struct {
 
@@ -13,10 +17,19 @@ type SolicitationFeeRateFormat1Choice struct {
 	AmountToQuantity *AmountAndQuantityRatio2 `xml:"AmtToQty"`
 }
 
+func (s *SolicitationFeeRateFormat1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SolicitationFeeRateFormat1Choice) SetRate(value string) {
 	s.Rate = (*PercentageRate)(&value)
 }
 
+func (s *SolicitationFeeRateFormat1Choice) SetRateFromDecimal(value decimal.Decimal) {
+	s.Rate = new(PercentageRate)
+	s.Rate.FromDecimal(value)
+}
+
 func (s *SolicitationFeeRateFormat1Choice) SetNotSpecifiedRate(value string) {
 	s.NotSpecifiedRate = (*RateValueType6Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a rate or an unspecified rate.
 type SolicitationFeeRateFormat5Choice struct {
 
@@ -16,10 +20,19 @@ type SolicitationFeeRateFormat5Choice struct {
 	NotSpecifiedRate *RateValueType7Code `xml:"NotSpcfdRate"`
 }
 
+func (s *SolicitationFeeRateFormat5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SolicitationFeeRateFormat5Choice) SetRate(value string) {
 	s.Rate = (*PercentageRate)(&value)
 }
 
+func (s *SolicitationFeeRateFormat5Choice) SetRateFromDecimal(value decimal.Decimal) {
+	s.Rate = new(PercentageRate)
+	s.Rate.FromDecimal(value)
+}
+
 func (s *SolicitationFeeRateFormat5Choice) AddAmountToQuantity() *AmountAndQuantityRatio2 {
 	s.AmountToQuantity = new(AmountAndQuantityRatio2)
 	return s.AmountToQuantity
@@ -29,6 +42,10 @@ func (s *SolicitationFeeRateFormat5Choice) SetAmount(value, currency string) {
 	s.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (s *SolicitationFeeRateFormat5Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (s *SolicitationFeeRateFormat5Choice) SetNotSpecifiedRate(value string) {
 	s.NotSpecifiedRate = (*RateValueType7Code)(&value)
 }
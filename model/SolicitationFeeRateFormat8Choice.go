@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a rate or an unspecified rate.
 type SolicitationFeeRateFormat8Choice struct {
 
@@ -13,10 +17,19 @@ type SolicitationFeeRateFormat8Choice struct {
 	Amount *ActiveCurrencyAnd13DecimalAmount `xml:"Amt"`
 }
 
+func (s *SolicitationFeeRateFormat8Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SolicitationFeeRateFormat8Choice) SetRate(value string) {
 	s.Rate = (*PercentageRate)(&value)
 }
 
+func (s *SolicitationFeeRateFormat8Choice) SetRateFromDecimal(value decimal.Decimal) {
+	s.Rate = new(PercentageRate)
+	s.Rate.FromDecimal(value)
+}
+
 func (s *SolicitationFeeRateFormat8Choice) AddAmountToQuantity() *AmountAndQuantityRatio4 {
 	s.AmountToQuantity = new(AmountAndQuantityRatio4)
 	return s.AmountToQuantity
@@ -25,3 +38,7 @@ func (s *SolicitationFeeRateFormat8Choice) AddAmountToQuantity() *AmountAndQuant
 func (s *SolicitationFeeRateFormat8Choice) SetAmount(value, currency string) {
 	s.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (s *SolicitationFeeRateFormat8Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
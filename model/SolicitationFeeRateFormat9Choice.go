@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a rate or an unspecified rate.
 type SolicitationFeeRateFormat9Choice struct {
 
@@ -13,10 +17,19 @@ type SolicitationFeeRateFormat9Choice struct {
 	Amount *RestrictedFINActiveCurrencyAnd13DecimalAmount `xml:"Amt"`
 }
 
+func (s *SolicitationFeeRateFormat9Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SolicitationFeeRateFormat9Choice) SetRate(value string) {
 	s.Rate = (*PercentageRate)(&value)
 }
 
+func (s *SolicitationFeeRateFormat9Choice) SetRateFromDecimal(value decimal.Decimal) {
+	s.Rate = new(PercentageRate)
+	s.Rate.FromDecimal(value)
+}
+
 func (s *SolicitationFeeRateFormat9Choice) AddAmountToQuantity() *AmountAndQuantityRatio5 {
 	s.AmountToQuantity = new(AmountAndQuantityRatio5)
 	return s.AmountToQuantity
@@ -25,3 +38,7 @@ func (s *SolicitationFeeRateFormat9Choice) AddAmountToQuantity() *AmountAndQuant
 func (s *SolicitationFeeRateFormat9Choice) SetAmount(value, currency string) {
 	s.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (s *SolicitationFeeRateFormat9Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -10,6 +10,10 @@ type SortCriteria1Choice struct {
 	UserDefined *DataFormat1Choice `xml:"UsrDfnd"`
 }
 
+func (s *SortCriteria1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SortCriteria1Choice) SetPredefined(value string) {
 	s.Predefined = (*ReportSortedType1Code)(&value)
 }
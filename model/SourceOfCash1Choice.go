@@ -10,6 +10,10 @@ type SourceOfCash1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SourceOfCash1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SourceOfCash1Choice) SetCode(value string) {
 	s.Code = (*SourceOfCash1Code)(&value)
 }
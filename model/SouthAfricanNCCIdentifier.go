@@ -1,3 +1,9 @@
 package model
 
 type SouthAfricanNCCIdentifier string
+
+var southAfricanNCCIdentifierPattern = newPattern(`ZA[0-9]{6,6}`)
+
+func (s SouthAfricanNCCIdentifier) Validate() error {
+	return validatePattern("SouthAfricanNCCIdentifier", string(s), southAfricanNCCIdentifierPattern)
+}
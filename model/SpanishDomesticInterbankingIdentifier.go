@@ -1,3 +1,9 @@
 package model
 
 type SpanishDomesticInterbankingIdentifier string
+
+var spanishDomesticInterbankingIdentifierPattern = newPattern(`ES[0-9]{8,9}`)
+
+func (s SpanishDomesticInterbankingIdentifier) Validate() error {
+	return validatePattern("SpanishDomesticInterbankingIdentifier", string(s), spanishDomesticInterbankingIdentifierPattern)
+}
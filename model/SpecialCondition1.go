@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Special conditions for the loan.
 type SpecialCondition1 struct {
 
@@ -16,18 +20,38 @@ type SpecialCondition1 struct {
 	PaymentFromOtherAccount *ActiveCurrencyAndAmount `xml:"PmtFrOthrAcct,omitempty"`
 }
 
+func (s *SpecialCondition1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SpecialCondition1) SetIncomingAmount(value, currency string) {
 	s.IncomingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SpecialCondition1) SetIncomingAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.IncomingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SpecialCondition1) SetOutgoingAmount(value, currency string) {
 	s.OutgoingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SpecialCondition1) SetOutgoingAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.OutgoingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SpecialCondition1) SetIncomingAmountToOtherAccount(value, currency string) {
 	s.IncomingAmountToOtherAccount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SpecialCondition1) SetIncomingAmountToOtherAccountFromDecimal(value decimal.Decimal, currency string) {
+	s.IncomingAmountToOtherAccount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SpecialCondition1) SetPaymentFromOtherAccount(value, currency string) {
 	s.PaymentFromOtherAccount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (s *SpecialCondition1) SetPaymentFromOtherAccountFromDecimal(value decimal.Decimal, currency string) {
+	s.PaymentFromOtherAccount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -13,14 +13,33 @@ type SpecificInstructionRequest1 struct {
 	SecuritiesRegistration *YesNoIndicator `xml:"SctiesRegn,omitempty"`
 }
 
+func (s *SpecificInstructionRequest1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SpecificInstructionRequest1) SetParticipationRegistration(value string) {
 	s.ParticipationRegistration = (*YesNoIndicator)(&value)
 }
 
+func (s *SpecificInstructionRequest1) SetParticipationRegistrationFromBool(value bool) {
+	s.ParticipationRegistration = new(YesNoIndicator)
+	s.ParticipationRegistration.FromBool(value)
+}
+
 func (s *SpecificInstructionRequest1) SetBlockingSecurities(value string) {
 	s.BlockingSecurities = (*YesNoIndicator)(&value)
 }
 
+func (s *SpecificInstructionRequest1) SetBlockingSecuritiesFromBool(value bool) {
+	s.BlockingSecurities = new(YesNoIndicator)
+	s.BlockingSecurities.FromBool(value)
+}
+
 func (s *SpecificInstructionRequest1) SetSecuritiesRegistration(value string) {
 	s.SecuritiesRegistration = (*YesNoIndicator)(&value)
 }
+
+func (s *SpecificInstructionRequest1) SetSecuritiesRegistrationFromBool(value bool) {
+	s.SecuritiesRegistration = new(YesNoIndicator)
+	s.SecuritiesRegistration.FromBool(value)
+}
@@ -13,6 +13,10 @@ type SplitTradeDetails1 struct {
 	AgreedRate *AgreedRate1 `xml:"AgrdRate,omitempty"`
 }
 
+func (s *SplitTradeDetails1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SplitTradeDetails1) AddStatusDetails() *TradeData9 {
 	s.StatusDetails = new(TradeData9)
 	return s.StatusDetails
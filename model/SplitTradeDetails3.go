@@ -13,6 +13,10 @@ type SplitTradeDetails3 struct {
 	AgreedRate *AgreedRate3 `xml:"AgrdRate,omitempty"`
 }
 
+func (s *SplitTradeDetails3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SplitTradeDetails3) AddStatusDetails() *TradeData16 {
 	s.StatusDetails = new(TradeData16)
 	return s.StatusDetails
@@ -10,10 +10,19 @@ type SpreadRate1 struct {
 	RateOrAmount *AmountOrRate1Choice `xml:"RateOrAmt"`
 }
 
+func (s *SpreadRate1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SpreadRate1) SetSign(value string) {
 	s.Sign = (*PlusOrMinusIndicator)(&value)
 }
 
+func (s *SpreadRate1) SetSignFromBool(value bool) {
+	s.Sign = new(PlusOrMinusIndicator)
+	s.Sign.FromBool(value)
+}
+
 func (s *SpreadRate1) AddRateOrAmount() *AmountOrRate1Choice {
 	s.RateOrAmount = new(AmountOrRate1Choice)
 	return s.RateOrAmount
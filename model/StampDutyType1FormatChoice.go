@@ -10,6 +10,10 @@ type StampDutyType1FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (s *StampDutyType1FormatChoice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StampDutyType1FormatChoice) SetCode(value string) {
 	s.Code = (*StampDutyType1Code)(&value)
 }
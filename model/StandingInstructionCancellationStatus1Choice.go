@@ -10,6 +10,10 @@ type StandingInstructionCancellationStatus1Choice struct {
 	RejectedStatus *CorporateActionStandingInstructionCancellationRejectionStatus1 `xml:"RjctdSts"`
 }
 
+func (s *StandingInstructionCancellationStatus1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StandingInstructionCancellationStatus1Choice) AddProcessedStatus() *CorporateActionStandingInstructionCancellationProcessingStatus1 {
 	s.ProcessedStatus = new(CorporateActionStandingInstructionCancellationProcessingStatus1)
 	return s.ProcessedStatus
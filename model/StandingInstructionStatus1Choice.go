@@ -10,6 +10,10 @@ type StandingInstructionStatus1Choice struct {
 	RejectedStatus *CorporateActionStandingInstructionRejectionStatus1 `xml:"RjctdSts"`
 }
 
+func (s *StandingInstructionStatus1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StandingInstructionStatus1Choice) AddProcessedStatus() *CorporateActionStandingInstructionProcessingStatus1 {
 	s.ProcessedStatus = new(CorporateActionStandingInstructionProcessingStatus1)
 	return s.ProcessedStatus
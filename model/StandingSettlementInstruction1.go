@@ -19,6 +19,10 @@ type StandingSettlementInstruction1 struct {
 	OtherReceivingSettlementParties *SettlementParties5 `xml:"OthrRcvgSttlmPties,omitempty"`
 }
 
+func (s *StandingSettlementInstruction1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StandingSettlementInstruction1) AddSettlementStandingInstructionDatabase() *SettlementStandingInstructionDatabase1Choice {
 	s.SettlementStandingInstructionDatabase = new(SettlementStandingInstructionDatabase1Choice)
 	return s.SettlementStandingInstructionDatabase
@@ -19,6 +19,10 @@ type StandingSettlementInstruction11 struct {
 	OtherReceivingSettlementParties *SettlementParties36 `xml:"OthrRcvgSttlmPties,omitempty"`
 }
 
+func (s *StandingSettlementInstruction11) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StandingSettlementInstruction11) AddSettlementStandingInstructionDatabase() *SettlementStandingInstructionDatabase4Choice {
 	s.SettlementStandingInstructionDatabase = new(SettlementStandingInstructionDatabase4Choice)
 	return s.SettlementStandingInstructionDatabase
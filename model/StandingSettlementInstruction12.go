@@ -19,6 +19,10 @@ type StandingSettlementInstruction12 struct {
 	OtherReceivingSettlementParties *SettlementParties44 `xml:"OthrRcvgSttlmPties,omitempty"`
 }
 
+func (s *StandingSettlementInstruction12) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StandingSettlementInstruction12) AddSettlementStandingInstructionDatabase() *SettlementStandingInstructionDatabase5Choice {
 	s.SettlementStandingInstructionDatabase = new(SettlementStandingInstructionDatabase5Choice)
 	return s.SettlementStandingInstructionDatabase
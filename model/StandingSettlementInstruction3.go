@@ -19,6 +19,10 @@ type StandingSettlementInstruction3 struct {
 	OtherReceivingSettlementParties *SettlementParties10 `xml:"OthrRcvgSttlmPties,omitempty"`
 }
 
+func (s *StandingSettlementInstruction3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StandingSettlementInstruction3) AddSettlementStandingInstructionDatabase() *SettlementStandingInstructionDatabase1Choice {
 	s.SettlementStandingInstructionDatabase = new(SettlementStandingInstructionDatabase1Choice)
 	return s.SettlementStandingInstructionDatabase
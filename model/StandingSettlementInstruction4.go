@@ -19,6 +19,10 @@ type StandingSettlementInstruction4 struct {
 	OtherReceivingSettlementParties *SettlementParties11 `xml:"OthrRcvgSttlmPties,omitempty"`
 }
 
+func (s *StandingSettlementInstruction4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StandingSettlementInstruction4) AddSettlementStandingInstructionDatabase() *SettlementStandingInstructionDatabase1Choice {
 	s.SettlementStandingInstructionDatabase = new(SettlementStandingInstructionDatabase1Choice)
 	return s.SettlementStandingInstructionDatabase
@@ -19,6 +19,10 @@ type StandingSettlementInstruction5 struct {
 	OtherReceivingSettlementParties *SettlementParties14 `xml:"OthrRcvgSttlmPties,omitempty"`
 }
 
+func (s *StandingSettlementInstruction5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StandingSettlementInstruction5) AddSettlementStandingInstructionDatabase() *SettlementStandingInstructionDatabase1Choice {
 	s.SettlementStandingInstructionDatabase = new(SettlementStandingInstructionDatabase1Choice)
 	return s.SettlementStandingInstructionDatabase
@@ -16,6 +16,10 @@ type StandingSettlementInstruction9 struct {
 	OtherReceivingSettlementParties *SettlementParties23 `xml:"OthrRcvgSttlmPties,omitempty"`
 }
 
+func (s *StandingSettlementInstruction9) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StandingSettlementInstruction9) AddSettlementStandingInstructionDatabase() *SettlementStandingInstructionDatabase3Choice {
 	s.SettlementStandingInstructionDatabase = new(SettlementStandingInstructionDatabase3Choice)
 	return s.SettlementStandingInstructionDatabase
@@ -31,6 +31,10 @@ type Statement11 struct {
 	SubAccountIndicator *YesNoIndicator `xml:"SubAcctInd"`
 }
 
+func (s *Statement11) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement11) AddReportNumber() *Number3Choice {
 	s.ReportNumber = new(Number3Choice)
 	return s.ReportNumber
@@ -68,6 +72,16 @@ func (s *Statement11) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *Statement11) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *Statement11) SetSubAccountIndicator(value string) {
 	s.SubAccountIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement11) SetSubAccountIndicatorFromBool(value bool) {
+	s.SubAccountIndicator = new(YesNoIndicator)
+	s.SubAccountIndicator.FromBool(value)
+}
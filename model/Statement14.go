@@ -28,6 +28,10 @@ type Statement14 struct {
 	ActivityIndicator *YesNoIndicator `xml:"ActvtyInd"`
 }
 
+func (s *Statement14) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement14) AddReportNumber() *Number3Choice {
 	s.ReportNumber = new(Number3Choice)
 	return s.ReportNumber
@@ -63,3 +67,8 @@ func (s *Statement14) SetStatementStructure(value string) {
 func (s *Statement14) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement14) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
@@ -25,6 +25,10 @@ type Statement15 struct {
 	ActivityIndicator *YesNoIndicator `xml:"ActvtyInd"`
 }
 
+func (s *Statement15) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement15) AddReportNumber() *Number3Choice {
 	s.ReportNumber = new(Number3Choice)
 	return s.ReportNumber
@@ -56,3 +60,8 @@ func (s *Statement15) AddUpdateType() *UpdateType2Choice {
 func (s *Statement15) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement15) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
@@ -19,6 +19,10 @@ type Statement16 struct {
 	StatementType *StatementType2Choice `xml:"StmtTp,omitempty"`
 }
 
+func (s *Statement16) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement16) AddStatementDateOrPeriod() *DateAndPeriod1Choice {
 	s.StatementDateOrPeriod = new(DateAndPeriod1Choice)
 	return s.StatementDateOrPeriod
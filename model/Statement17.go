@@ -25,6 +25,10 @@ type Statement17 struct {
 	ActivityIndicator *YesNoIndicator `xml:"ActvtyInd"`
 }
 
+func (s *Statement17) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement17) AddReportNumber() *Number3Choice {
 	s.ReportNumber = new(Number3Choice)
 	return s.ReportNumber
@@ -56,3 +60,8 @@ func (s *Statement17) AddUpdateType() *UpdateType2Choice {
 func (s *Statement17) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement17) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
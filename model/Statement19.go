@@ -22,6 +22,10 @@ type Statement19 struct {
 	ActivityIndicator *YesNoIndicator `xml:"ActvtyInd"`
 }
 
+func (s *Statement19) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement19) SetCounterpartyPortfolioTransferNotificationReference(value string) {
 	s.CounterpartyPortfolioTransferNotificationReference = (*Max35Text)(&value)
 }
@@ -48,3 +52,8 @@ func (s *Statement19) AddUpdateType() *UpdateType2Choice {
 func (s *Statement19) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement19) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
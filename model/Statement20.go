@@ -37,6 +37,10 @@ type Statement20 struct {
 	TaxLotIndicator *YesNoIndicator `xml:"TaxLotInd,omitempty"`
 }
 
+func (s *Statement20) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement20) AddReportNumber() *Number3Choice {
 	s.ReportNumber = new(Number3Choice)
 	return s.ReportNumber
@@ -74,14 +78,34 @@ func (s *Statement20) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *Statement20) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *Statement20) SetAuditedIndicator(value string) {
 	s.AuditedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *Statement20) SetAuditedIndicatorFromBool(value bool) {
+	s.AuditedIndicator = new(YesNoIndicator)
+	s.AuditedIndicator.FromBool(value)
+}
+
 func (s *Statement20) SetSubAccountIndicator(value string) {
 	s.SubAccountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *Statement20) SetSubAccountIndicatorFromBool(value bool) {
+	s.SubAccountIndicator = new(YesNoIndicator)
+	s.SubAccountIndicator.FromBool(value)
+}
+
 func (s *Statement20) SetTaxLotIndicator(value string) {
 	s.TaxLotIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement20) SetTaxLotIndicatorFromBool(value bool) {
+	s.TaxLotIndicator = new(YesNoIndicator)
+	s.TaxLotIndicator.FromBool(value)
+}
@@ -28,6 +28,10 @@ type Statement3 struct {
 	ReportNumber *Max5NumericText `xml:"RptNb,omitempty"`
 }
 
+func (s *Statement3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement3) SetReference(value string) {
 	s.Reference = (*Max35Text)(&value)
 }
@@ -56,6 +60,11 @@ func (s *Statement3) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *Statement3) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *Statement3) AddStatementBasis() *StatementBasisCodeAndDSSCodeChoice {
 	s.StatementBasis = new(StatementBasisCodeAndDSSCodeChoice)
 	return s.StatementBasis
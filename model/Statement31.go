@@ -22,6 +22,10 @@ type Statement31 struct {
 	ActivityIndicator *YesNoIndicator `xml:"ActvtyInd"`
 }
 
+func (s *Statement31) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement31) SetStatementIdentification(value string) {
 	s.StatementIdentification = (*Max35Text)(&value)
 }
@@ -46,3 +50,8 @@ func (s *Statement31) SetReportNumber(value string) {
 func (s *Statement31) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement31) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
@@ -16,6 +16,10 @@ type Statement32 struct {
 	StatementDateTime *DateAndDateTimeChoice `xml:"StmtDtTm"`
 }
 
+func (s *Statement32) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement32) SetStatementIdentification(value string) {
 	s.StatementIdentification = (*Max35Text)(&value)
 }
@@ -24,6 +28,11 @@ func (s *Statement32) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *Statement32) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *Statement32) SetFrequency(value string) {
 	s.Frequency = (*Frequency1Code)(&value)
 }
@@ -25,6 +25,10 @@ type Statement39 struct {
 	ActivityIndicator *YesNoIndicator `xml:"ActvtyInd"`
 }
 
+func (s *Statement39) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement39) AddReportNumber() *Number3Choice {
 	s.ReportNumber = new(Number3Choice)
 	return s.ReportNumber
@@ -56,3 +60,8 @@ func (s *Statement39) AddUpdateType() *UpdateType15Choice {
 func (s *Statement39) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement39) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
@@ -31,6 +31,10 @@ type Statement4 struct {
 	AuditedIndicator *YesNoIndicator `xml:"AudtdInd"`
 }
 
+func (s *Statement4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement4) SetReference(value string) {
 	s.Reference = (*Max35Text)(&value)
 }
@@ -59,6 +63,11 @@ func (s *Statement4) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *Statement4) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *Statement4) AddStatementBasis() *StatementBasisCodeAndDSSCodeChoice {
 	s.StatementBasis = new(StatementBasisCodeAndDSSCodeChoice)
 	return s.StatementBasis
@@ -71,3 +80,8 @@ func (s *Statement4) SetReportNumber(value string) {
 func (s *Statement4) SetAuditedIndicator(value string) {
 	s.AuditedIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement4) SetAuditedIndicatorFromBool(value bool) {
+	s.AuditedIndicator = new(YesNoIndicator)
+	s.AuditedIndicator.FromBool(value)
+}
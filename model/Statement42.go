@@ -19,6 +19,10 @@ type Statement42 struct {
 	StatementType *StatementType5Choice `xml:"StmtTp,omitempty"`
 }
 
+func (s *Statement42) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement42) AddStatementDateOrPeriod() *DateAndPeriod1Choice {
 	s.StatementDateOrPeriod = new(DateAndPeriod1Choice)
 	return s.StatementDateOrPeriod
@@ -25,6 +25,10 @@ type Statement43 struct {
 	ActivityIndicator *YesNoIndicator `xml:"ActvtyInd"`
 }
 
+func (s *Statement43) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement43) AddReportNumber() *Number3Choice {
 	s.ReportNumber = new(Number3Choice)
 	return s.ReportNumber
@@ -56,3 +60,8 @@ func (s *Statement43) AddUpdateType() *UpdateType15Choice {
 func (s *Statement43) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement43) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
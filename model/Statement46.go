@@ -22,6 +22,10 @@ type Statement46 struct {
 	ActivityIndicator *YesNoIndicator `xml:"ActvtyInd"`
 }
 
+func (s *Statement46) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement46) SetCounterpartyPortfolioTransferNotificationReference(value string) {
 	s.CounterpartyPortfolioTransferNotificationReference = (*Max35Text)(&value)
 }
@@ -48,3 +52,8 @@ func (s *Statement46) AddUpdateType() *UpdateType15Choice {
 func (s *Statement46) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement46) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
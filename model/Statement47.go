@@ -31,6 +31,10 @@ type Statement47 struct {
 	NotificationDeadlinePeriod *DateOrDateTimePeriodChoice `xml:"NtfctnDdlnPrd,omitempty"`
 }
 
+func (s *Statement47) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement47) SetStatementType(value string) {
 	s.StatementType = (*CorporateActionStatementType1Code)(&value)
 }
@@ -66,6 +70,11 @@ func (s *Statement47) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *Statement47) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *Statement47) AddNotificationDeadlinePeriod() *DateOrDateTimePeriodChoice {
 	s.NotificationDeadlinePeriod = new(DateOrDateTimePeriodChoice)
 	return s.NotificationDeadlinePeriod
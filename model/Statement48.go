@@ -31,6 +31,10 @@ type Statement48 struct {
 	NotificationDeadlinePeriod *DateOrDateTimePeriodChoice `xml:"NtfctnDdlnPrd,omitempty"`
 }
 
+func (s *Statement48) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement48) SetStatementType(value string) {
 	s.StatementType = (*CorporateActionStatementType1Code)(&value)
 }
@@ -66,6 +70,11 @@ func (s *Statement48) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *Statement48) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *Statement48) AddNotificationDeadlinePeriod() *DateOrDateTimePeriodChoice {
 	s.NotificationDeadlinePeriod = new(DateOrDateTimePeriodChoice)
 	return s.NotificationDeadlinePeriod
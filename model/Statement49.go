@@ -25,6 +25,10 @@ type Statement49 struct {
 	ActivityIndicator *YesNoIndicator `xml:"ActvtyInd"`
 }
 
+func (s *Statement49) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement49) AddReportNumber() *Number3Choice {
 	s.ReportNumber = new(Number3Choice)
 	return s.ReportNumber
@@ -56,3 +60,8 @@ func (s *Statement49) AddUpdateType() *UpdateType16Choice {
 func (s *Statement49) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement49) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
@@ -22,6 +22,10 @@ type Statement50 struct {
 	ActivityIndicator *YesNoIndicator `xml:"ActvtyInd"`
 }
 
+func (s *Statement50) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement50) SetCounterpartyPortfolioTransferNotificationReference(value string) {
 	s.CounterpartyPortfolioTransferNotificationReference = (*RestrictedFINXMax16Text)(&value)
 }
@@ -48,3 +52,8 @@ func (s *Statement50) AddUpdateType() *UpdateType16Choice {
 func (s *Statement50) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement50) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
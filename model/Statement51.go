@@ -37,6 +37,10 @@ type Statement51 struct {
 	TaxLotIndicator *YesNoIndicator `xml:"TaxLotInd,omitempty"`
 }
 
+func (s *Statement51) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement51) AddReportNumber() *Number3Choice {
 	s.ReportNumber = new(Number3Choice)
 	return s.ReportNumber
@@ -74,14 +78,34 @@ func (s *Statement51) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *Statement51) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *Statement51) SetAuditedIndicator(value string) {
 	s.AuditedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *Statement51) SetAuditedIndicatorFromBool(value bool) {
+	s.AuditedIndicator = new(YesNoIndicator)
+	s.AuditedIndicator.FromBool(value)
+}
+
 func (s *Statement51) SetSubAccountIndicator(value string) {
 	s.SubAccountIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *Statement51) SetSubAccountIndicatorFromBool(value bool) {
+	s.SubAccountIndicator = new(YesNoIndicator)
+	s.SubAccountIndicator.FromBool(value)
+}
+
 func (s *Statement51) SetTaxLotIndicator(value string) {
 	s.TaxLotIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement51) SetTaxLotIndicatorFromBool(value bool) {
+	s.TaxLotIndicator = new(YesNoIndicator)
+	s.TaxLotIndicator.FromBool(value)
+}
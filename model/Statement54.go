@@ -19,6 +19,10 @@ type Statement54 struct {
 	StatementType *StatementType6Choice `xml:"StmtTp,omitempty"`
 }
 
+func (s *Statement54) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement54) AddStatementDateOrPeriod() *DateAndPeriod1Choice {
 	s.StatementDateOrPeriod = new(DateAndPeriod1Choice)
 	return s.StatementDateOrPeriod
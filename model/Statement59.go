@@ -34,6 +34,10 @@ type Statement59 struct {
 	ActivityIndicator *YesNoIndicator `xml:"ActvtyInd"`
 }
 
+func (s *Statement59) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement59) SetSenderBusinessRole(value string) {
 	s.SenderBusinessRole = (*SenderBusinessRole1Code)(&value)
 }
@@ -78,3 +82,8 @@ func (s *Statement59) AddUpdateType() *UpdateType4Choice {
 func (s *Statement59) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement59) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
@@ -31,6 +31,10 @@ type Statement6 struct {
 	AuditedIndicator *YesNoIndicator `xml:"AudtdInd"`
 }
 
+func (s *Statement6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement6) SetReference(value string) {
 	s.Reference = (*Max35Text)(&value)
 }
@@ -59,6 +63,11 @@ func (s *Statement6) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *Statement6) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *Statement6) AddStatementBasis() *StatementBasisCodeAndDSSCodeChoice {
 	s.StatementBasis = new(StatementBasisCodeAndDSSCodeChoice)
 	return s.StatementBasis
@@ -71,3 +80,8 @@ func (s *Statement6) SetReportNumber(value string) {
 func (s *Statement6) SetAuditedIndicator(value string) {
 	s.AuditedIndicator = (*YesNoIndicator)(&value)
 }
+
+func (s *Statement6) SetAuditedIndicatorFromBool(value bool) {
+	s.AuditedIndicator = new(YesNoIndicator)
+	s.AuditedIndicator.FromBool(value)
+}
@@ -28,6 +28,10 @@ type Statement7 struct {
 	ReportNumber *Max5NumericText `xml:"RptNb,omitempty"`
 }
 
+func (s *Statement7) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement7) SetReference(value string) {
 	s.Reference = (*Max35Text)(&value)
 }
@@ -56,6 +60,11 @@ func (s *Statement7) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *Statement7) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *Statement7) AddStatementBasis() *StatementBasisCodeAndDSSCodeChoice {
 	s.StatementBasis = new(StatementBasisCodeAndDSSCodeChoice)
 	return s.StatementBasis
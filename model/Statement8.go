@@ -25,6 +25,10 @@ type Statement8 struct {
 	ReportNumber *Max5NumericText `xml:"RptNb,omitempty"`
 }
 
+func (s *Statement8) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Statement8) SetReference(value string) {
 	s.Reference = (*Max35Text)(&value)
 }
@@ -51,6 +55,11 @@ func (s *Statement8) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *Statement8) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *Statement8) SetReportNumber(value string) {
 	s.ReportNumber = (*Max5NumericText)(&value)
 }
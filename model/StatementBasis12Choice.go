@@ -10,6 +10,10 @@ type StatementBasis12Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *StatementBasis12Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementBasis12Choice) SetCode(value string) {
 	s.Code = (*StatementBasis2Code)(&value)
 }
@@ -10,6 +10,10 @@ type StatementBasis2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *StatementBasis2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementBasis2Choice) SetCode(value string) {
 	s.Code = (*StatementBasis2Code)(&value)
 }
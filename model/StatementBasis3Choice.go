@@ -10,6 +10,10 @@ type StatementBasis3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *StatementBasis3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementBasis3Choice) SetCode(value string) {
 	s.Code = (*StatementBasis1Code)(&value)
 }
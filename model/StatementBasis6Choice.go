@@ -10,6 +10,10 @@ type StatementBasis6Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *StatementBasis6Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementBasis6Choice) SetCode(value string) {
 	s.Code = (*StatementBasis1Code)(&value)
 }
@@ -10,6 +10,10 @@ type StatementBasis7Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *StatementBasis7Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementBasis7Choice) SetCode(value string) {
 	s.Code = (*StatementBasis1Code)(&value)
 }
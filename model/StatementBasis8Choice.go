@@ -10,6 +10,10 @@ type StatementBasis8Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *StatementBasis8Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementBasis8Choice) SetCode(value string) {
 	s.Code = (*StatementBasis2Code)(&value)
 }
@@ -10,6 +10,10 @@ type StatementBasis9Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *StatementBasis9Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementBasis9Choice) SetCode(value string) {
 	s.Code = (*StatementBasis1Code)(&value)
 }
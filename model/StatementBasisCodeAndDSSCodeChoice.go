@@ -10,6 +10,10 @@ type StatementBasisCodeAndDSSCodeChoice struct {
 	StatementBasisAsDSS *GenericIdentification7 `xml:"StmtBsisAsDSS"`
 }
 
+func (s *StatementBasisCodeAndDSSCodeChoice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementBasisCodeAndDSSCodeChoice) SetStatementBasisAsCode(value string) {
 	s.StatementBasisAsCode = (*StatementBasis1Code)(&value)
 }
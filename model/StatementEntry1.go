@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the elements of an entry in the report.
 type StatementEntry1 struct {
 
@@ -72,18 +76,35 @@ type StatementEntry1 struct {
 	AdditionalEntryInformation *Max500Text `xml:"AddtlNtryInf,omitempty"`
 }
 
+func (s *StatementEntry1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementEntry1) SetAmount(value, currency string) {
 	s.Amount = NewCurrencyAndAmount(value, currency)
 }
 
-func (s *StatementEntry1) SetCreditDebitIndicator(value string) {
+func (s *StatementEntry1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
+func (s *StatementEntry1) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	s.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (s *StatementEntry1) SetReversalIndicator(value string) {
 	s.ReversalIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (s *StatementEntry1) SetReversalIndicatorFromBool(value bool) {
+	s.ReversalIndicator = new(TrueFalseIndicator)
+	s.ReversalIndicator.FromBool(value)
+}
+
 func (s *StatementEntry1) SetStatus(value string) {
 	s.Status = (*EntryStatus3Code)(&value)
 }
@@ -257,6 +278,11 @@ func (s *StatementEntry1) SetCommissionWaiverIndicator(value string) {
 	s.CommissionWaiverIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *StatementEntry1) SetCommissionWaiverIndicatorFromBool(value bool) {
+	s.CommissionWaiverIndicator = new(YesNoIndicator)
+	s.CommissionWaiverIndicator.FromBool(value)
+}
+
 func (s *StatementEntry1) AddAdditionalInformationIndicator() *MessageIdentification2 {
 	s.AdditionalInformationIndicator = new(MessageIdentification2)
 	return s.AdditionalInformationIndicator
@@ -16,6 +16,10 @@ type StatementFrequencyAndForm1 struct {
 	Format *CommunicationFormat1Choice `xml:"Frmt"`
 }
 
+func (s *StatementFrequencyAndForm1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementFrequencyAndForm1) SetFrequency(value string) {
 	s.Frequency = (*Frequency7Code)(&value)
 }
@@ -10,6 +10,10 @@ type StatementFrequencyAndFormModification1 struct {
 	StatementFrequencyAndForm *StatementFrequencyAndForm1 `xml:"StmtFrqcyAndForm"`
 }
 
+func (s *StatementFrequencyAndFormModification1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementFrequencyAndFormModification1) SetModificationCode(value string) {
 	s.ModificationCode = (*Modification1Code)(&value)
 }
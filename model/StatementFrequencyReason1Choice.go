@@ -10,6 +10,10 @@ type StatementFrequencyReason1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *StatementFrequencyReason1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementFrequencyReason1Choice) SetCode(value string) {
 	s.Code = (*EventFrequency1Code)(&value)
 }
@@ -10,6 +10,10 @@ type StatementFrequencyReason2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *StatementFrequencyReason2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementFrequencyReason2Choice) SetCode(value string) {
 	s.Code = (*EventFrequency9Code)(&value)
 }
@@ -22,6 +22,10 @@ type StatementGroup1 struct {
 	BillingStatement []*BillingStatement1 `xml:"BllgStmt"`
 }
 
+func (s *StatementGroup1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementGroup1) SetGroupIdentification(value string) {
 	s.GroupIdentification = (*Max35Text)(&value)
 }
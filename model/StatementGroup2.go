@@ -22,6 +22,10 @@ type StatementGroup2 struct {
 	BillingStatement []*BillingStatement2 `xml:"BllgStmt"`
 }
 
+func (s *StatementGroup2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementGroup2) SetGroupIdentification(value string) {
 	s.GroupIdentification = (*Max35Text)(&value)
 }
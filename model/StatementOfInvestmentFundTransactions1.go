@@ -22,6 +22,10 @@ type StatementOfInvestmentFundTransactions1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (s *StatementOfInvestmentFundTransactions1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementOfInvestmentFundTransactions1) AddMessagePagination() *Pagination {
 	s.MessagePagination = new(Pagination)
 	return s.MessagePagination
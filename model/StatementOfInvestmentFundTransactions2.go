@@ -19,6 +19,10 @@ type StatementOfInvestmentFundTransactions2 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (s *StatementOfInvestmentFundTransactions2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementOfInvestmentFundTransactions2) AddStatementGeneralDetails() *Statement8 {
 	s.StatementGeneralDetails = new(Statement8)
 	return s.StatementGeneralDetails
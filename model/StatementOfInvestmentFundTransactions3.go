@@ -19,6 +19,10 @@ type StatementOfInvestmentFundTransactions3 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (s *StatementOfInvestmentFundTransactions3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementOfInvestmentFundTransactions3) AddStatementGeneralDetails() *Statement8 {
 	s.StatementGeneralDetails = new(Statement8)
 	return s.StatementGeneralDetails
@@ -13,6 +13,10 @@ type StatementReference1 struct {
 	Pagination *Pagination `xml:"Pgntn,omitempty"`
 }
 
+func (s *StatementReference1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementReference1) SetStatementIdentification(value string) {
 	s.StatementIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide information on the statement entry for the resolution of the investigation.
 type StatementResolutionEntry1 struct {
 
@@ -25,6 +29,10 @@ type StatementResolutionEntry1 struct {
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 }
 
+func (s *StatementResolutionEntry1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementResolutionEntry1) AddOriginalGroupInformation() *OriginalGroupInformation3 {
 	s.OriginalGroupInformation = new(OriginalGroupInformation3)
 	return s.OriginalGroupInformation
@@ -42,6 +50,10 @@ func (s *StatementResolutionEntry1) SetCorrectedAmount(value, currency string) {
 	s.CorrectedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *StatementResolutionEntry1) SetCorrectedAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.CorrectedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *StatementResolutionEntry1) AddCharges() *ChargesInformation6 {
 	newValue := new(ChargesInformation6)
 	s.Charges = append(s.Charges, newValue)
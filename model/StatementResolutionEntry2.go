@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides further details on the statement entry for the resolution of the investigation.
 type StatementResolutionEntry2 struct {
 
@@ -25,6 +29,10 @@ type StatementResolutionEntry2 struct {
 	Purpose *Purpose2Choice `xml:"Purp,omitempty"`
 }
 
+func (s *StatementResolutionEntry2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementResolutionEntry2) AddOriginalGroupInformation() *OriginalGroupInformation3 {
 	s.OriginalGroupInformation = new(OriginalGroupInformation3)
 	return s.OriginalGroupInformation
@@ -42,6 +50,10 @@ func (s *StatementResolutionEntry2) SetCorrectedAmount(value, currency string) {
 	s.CorrectedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *StatementResolutionEntry2) SetCorrectedAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.CorrectedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *StatementResolutionEntry2) AddCharges() *Charges3 {
 	newValue := new(Charges3)
 	s.Charges = append(s.Charges, newValue)
@@ -10,6 +10,10 @@ type StatementSource1Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *StatementSource1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementSource1Choice) SetCode(value string) {
 	s.Code = (*StatementSource1Code)(&value)
 }
@@ -10,6 +10,10 @@ type StatementType2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *StatementType2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementType2Choice) SetCode(value string) {
 	s.Code = (*SecuritiesStatementType1Code)(&value)
 }
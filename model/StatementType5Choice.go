@@ -10,6 +10,10 @@ type StatementType5Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *StatementType5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementType5Choice) SetCode(value string) {
 	s.Code = (*SecuritiesStatementType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type StatementType6Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *StatementType6Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementType6Choice) SetCode(value string) {
 	s.Code = (*SecuritiesStatementType1Code)(&value)
 }
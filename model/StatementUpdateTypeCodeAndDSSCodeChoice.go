@@ -10,6 +10,10 @@ type StatementUpdateTypeCodeAndDSSCodeChoice struct {
 	StatementUpdateTypeAsDSS *GenericIdentification7 `xml:"StmtUpdTpAsDSS"`
 }
 
+func (s *StatementUpdateTypeCodeAndDSSCodeChoice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatementUpdateTypeCodeAndDSSCodeChoice) SetStatementUpdateTypeAsCode(value string) {
 	s.StatementUpdateTypeAsCode = (*StatementUpdateTypeCode)(&value)
 }
@@ -19,6 +19,10 @@ type StatisticsByPredefinedTimePeriods1 struct {
 	FiveYearPriceChange *PriceValue2 `xml:"FiveYrPricChng,omitempty"`
 }
 
+func (s *StatisticsByPredefinedTimePeriods1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatisticsByPredefinedTimePeriods1) AddHighestPriceValue12Months() *PriceValue1 {
 	s.HighestPriceValue12Months = new(PriceValue1)
 	return s.HighestPriceValue12Months
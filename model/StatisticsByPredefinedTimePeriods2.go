@@ -19,6 +19,10 @@ type StatisticsByPredefinedTimePeriods2 struct {
 	FiveYearPriceChange *PriceValueChange1 `xml:"FiveYrPricChng,omitempty"`
 }
 
+func (s *StatisticsByPredefinedTimePeriods2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatisticsByPredefinedTimePeriods2) AddHighestPriceValue12Months() *PriceValue5 {
 	s.HighestPriceValue12Months = new(PriceValue5)
 	return s.HighestPriceValue12Months
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Statistical data related to the price change of a security.
 type StatisticsByUserDefinedTimePeriod1 struct {
 
@@ -19,6 +23,10 @@ type StatisticsByUserDefinedTimePeriod1 struct {
 	Yield *PercentageRate `xml:"Yld,omitempty"`
 }
 
+func (s *StatisticsByUserDefinedTimePeriod1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatisticsByUserDefinedTimePeriod1) AddPeriod() *DateTimePeriodDetails {
 	s.Period = new(DateTimePeriodDetails)
 	return s.Period
@@ -42,3 +50,8 @@ func (s *StatisticsByUserDefinedTimePeriod1) AddPriceChange() *PriceValue2 {
 func (s *StatisticsByUserDefinedTimePeriod1) SetYield(value string) {
 	s.Yield = (*PercentageRate)(&value)
 }
+
+func (s *StatisticsByUserDefinedTimePeriod1) SetYieldFromDecimal(value decimal.Decimal) {
+	s.Yield = new(PercentageRate)
+	s.Yield.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Statistical data related to the price change of a security.
 type StatisticsByUserDefinedTimePeriod2 struct {
 
@@ -19,6 +23,10 @@ type StatisticsByUserDefinedTimePeriod2 struct {
 	Yield *PercentageRate `xml:"Yld,omitempty"`
 }
 
+func (s *StatisticsByUserDefinedTimePeriod2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatisticsByUserDefinedTimePeriod2) AddPeriod() *DateOrDateTimePeriodChoice {
 	s.Period = new(DateOrDateTimePeriodChoice)
 	return s.Period
@@ -42,3 +50,8 @@ func (s *StatisticsByUserDefinedTimePeriod2) AddPriceChange() *PriceValueChange1
 func (s *StatisticsByUserDefinedTimePeriod2) SetYield(value string) {
 	s.Yield = (*PercentageRate)(&value)
 }
+
+func (s *StatisticsByUserDefinedTimePeriod2) SetYieldFromDecimal(value decimal.Decimal) {
+	s.Yield = new(PercentageRate)
+	s.Yield.FromDecimal(value)
+}
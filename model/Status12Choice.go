@@ -10,6 +10,10 @@ type Status12Choice struct {
 	Rejected []*RejectedReason3Choice `xml:"Rjctd"`
 }
 
+func (s *Status12Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status12Choice) SetStatus(value string) {
 	s.Status = (*AccountManagementStatus1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Status13Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (s *Status13Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status13Choice) SetCode(value string) {
 	s.Code = (*TradeStatus5Code)(&value)
 }
@@ -10,6 +10,10 @@ type Status14Choice struct {
 	Rejected []*RejectionReason16 `xml:"Rjctd"`
 }
 
+func (s *Status14Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status14Choice) SetStatus(value string) {
 	s.Status = (*AccountManagementStatus1Code)(&value)
 }
@@ -19,6 +19,10 @@ type Status15Choice struct {
 	InstructionProcessingStatus *InstructionProcessingStatus14Choice `xml:"InstrPrcgSts"`
 }
 
+func (s *Status15Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status15Choice) AddProprietary() *ProprietaryStatusAndReason1 {
 	s.Proprietary = new(ProprietaryStatusAndReason1)
 	return s.Proprietary
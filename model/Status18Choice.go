@@ -19,6 +19,10 @@ type Status18Choice struct {
 	InstructionProcessingStatus *InstructionProcessingStatus22Choice `xml:"InstrPrcgSts"`
 }
 
+func (s *Status18Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status18Choice) AddProprietary() *ProprietaryStatusAndReason6 {
 	s.Proprietary = new(ProprietaryStatusAndReason6)
 	return s.Proprietary
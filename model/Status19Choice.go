@@ -46,6 +46,10 @@ type Status19Choice struct {
 	SettlementConditionModificationStatus *SettlementConditionModificationStatus3Choice `xml:"SttlmCondModSts"`
 }
 
+func (s *Status19Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status19Choice) AddAffirmationStatus() *AffirmationStatus8Choice {
 	s.AffirmationStatus = new(AffirmationStatus8Choice)
 	return s.AffirmationStatus
@@ -46,6 +46,10 @@ type Status1Choice struct {
 	SettlementConditionModificationStatus *SettlementConditionModificationStatus1Choice `xml:"SttlmCondModSts"`
 }
 
+func (s *Status1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status1Choice) AddAffirmationStatus() *AffirmationStatus1Choice {
 	s.AffirmationStatus = new(AffirmationStatus1Choice)
 	return s.AffirmationStatus
@@ -10,6 +10,10 @@ type Status20Choice struct {
 	Rejected []*RejectionReason31 `xml:"Rjctd"`
 }
 
+func (s *Status20Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status20Choice) SetStatus(value string) {
 	s.Status = (*AccountManagementStatus1Code)(&value)
 }
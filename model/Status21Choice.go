@@ -16,6 +16,10 @@ type Status21Choice struct {
 	Pending *TransferCancellationPendingStatus1 `xml:"Pdg"`
 }
 
+func (s *Status21Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status21Choice) AddStatus() *TransferCancellationStatus2 {
 	s.Status = new(TransferCancellationStatus2)
 	return s.Status
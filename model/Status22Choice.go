@@ -46,6 +46,10 @@ type Status22Choice struct {
 	SettlementConditionModificationStatus *SettlementConditionModificationStatus4Choice `xml:"SttlmCondModSts"`
 }
 
+func (s *Status22Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status22Choice) AddAffirmationStatus() *AffirmationStatus9Choice {
 	s.AffirmationStatus = new(AffirmationStatus9Choice)
 	return s.AffirmationStatus
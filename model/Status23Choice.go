@@ -19,6 +19,10 @@ type Status23Choice struct {
 	InstructionProcessingStatus *InstructionProcessingStatus27Choice `xml:"InstrPrcgSts"`
 }
 
+func (s *Status23Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status23Choice) AddProprietary() *ProprietaryStatusAndReason7 {
 	s.Proprietary = new(ProprietaryStatusAndReason7)
 	return s.Proprietary
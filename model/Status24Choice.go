@@ -13,6 +13,10 @@ type Status24Choice struct {
 	SwitchOrderDetailsReport []*SwitchOrderStatusAndReason2 `xml:"SwtchOrdrDtlsRpt"`
 }
 
+func (s *Status24Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status24Choice) AddOrderDetailsReport() *OrderStatusAndReason10 {
 	s.OrderDetailsReport = new(OrderStatusAndReason10)
 	return s.OrderDetailsReport
@@ -10,6 +10,10 @@ type Status25Choice struct {
 	Rejected []*RejectionReason31 `xml:"Rjctd"`
 }
 
+func (s *Status25Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status25Choice) SetStatus(value string) {
 	s.Status = (*AccountManagementStatus1Code)(&value)
 }
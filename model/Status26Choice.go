@@ -10,6 +10,10 @@ type Status26Choice struct {
 	IndividualCancellationStatusReport []*IndividualOrderStatusAndReason8 `xml:"IndvCxlStsRpt"`
 }
 
+func (s *Status26Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status26Choice) AddCancellationStatusReport() *OrderStatusAndReason9 {
 	s.CancellationStatusReport = new(OrderStatusAndReason9)
 	return s.CancellationStatusReport
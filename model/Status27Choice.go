@@ -10,6 +10,10 @@ type Status27Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (s *Status27Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status27Choice) SetCode(value string) {
 	s.Code = (*TradeStatus6Code)(&value)
 }
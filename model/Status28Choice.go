@@ -10,6 +10,10 @@ type Status28Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (s *Status28Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status28Choice) SetCode(value string) {
 	s.Code = (*TradeStatus7Code)(&value)
 }
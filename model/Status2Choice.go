@@ -19,6 +19,10 @@ type Status2Choice struct {
 	InstructionProcessingStatus *InstructionProcessingStatus3Choice `xml:"InstrPrcgSts"`
 }
 
+func (s *Status2Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status2Choice) AddProprietary() *ProprietaryStatusAndReason1 {
 	s.Proprietary = new(ProprietaryStatusAndReason1)
 	return s.Proprietary
@@ -10,6 +10,10 @@ type Status5Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (s *Status5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status5Choice) SetCode(value string) {
 	s.Code = (*TradeStatus3Code)(&value)
 }
@@ -10,6 +10,10 @@ type Status6Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (s *Status6Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status6Choice) SetCode(value string) {
 	s.Code = (*TradeStatus4Code)(&value)
 }
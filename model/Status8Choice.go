@@ -46,6 +46,10 @@ type Status8Choice struct {
 	SettlementConditionModificationStatus *SettlementConditionModificationStatus1Choice `xml:"SttlmCondModSts"`
 }
 
+func (s *Status8Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status8Choice) AddAffirmationStatus() *AffirmationStatus1Choice {
 	s.AffirmationStatus = new(AffirmationStatus1Choice)
 	return s.AffirmationStatus
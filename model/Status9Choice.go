@@ -19,6 +19,10 @@ type Status9Choice struct {
 	InstructionProcessingStatus *InstructionProcessingStatus10Choice `xml:"InstrPrcgSts"`
 }
 
+func (s *Status9Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Status9Choice) AddProprietary() *ProprietaryStatusAndReason1 {
 	s.Proprietary = new(ProprietaryStatusAndReason1)
 	return s.Proprietary
@@ -10,6 +10,10 @@ type StatusAndReason1 struct {
 	Transaction []*Transaction7 `xml:"Tx,omitempty"`
 }
 
+func (s *StatusAndReason1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason1) AddStatusAndReason() *Status2Choice {
 	s.StatusAndReason = new(Status2Choice)
 	return s.StatusAndReason
@@ -13,6 +13,10 @@ type StatusAndReason10 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (s *StatusAndReason10) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason10) AddAffirmationStatus() *AffirmationStatus7Choice {
 	s.AffirmationStatus = new(AffirmationStatus7Choice)
 	return s.AffirmationStatus
@@ -16,6 +16,10 @@ type StatusAndReason12 struct {
 	SettlementStatus *SettlementStatus7Choice `xml:"SttlmSts,omitempty"`
 }
 
+func (s *StatusAndReason12) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason12) AddProcessingStatus() *ProcessingStatus23Choice {
 	s.ProcessingStatus = new(ProcessingStatus23Choice)
 	return s.ProcessingStatus
@@ -10,6 +10,10 @@ type StatusAndReason16 struct {
 	Transaction []*Transaction28 `xml:"Tx,omitempty"`
 }
 
+func (s *StatusAndReason16) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason16) AddStatusAndReason() *Status9Choice {
 	s.StatusAndReason = new(Status9Choice)
 	return s.StatusAndReason
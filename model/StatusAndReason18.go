@@ -10,6 +10,10 @@ type StatusAndReason18 struct {
 	Transaction []*Transaction35 `xml:"Tx,omitempty"`
 }
 
+func (s *StatusAndReason18) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason18) AddStatusAndReason() *Status15Choice {
 	s.StatusAndReason = new(Status15Choice)
 	return s.StatusAndReason
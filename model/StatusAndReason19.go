@@ -16,6 +16,10 @@ type StatusAndReason19 struct {
 	SettlementStatus *SettlementStatus7Choice `xml:"SttlmSts,omitempty"`
 }
 
+func (s *StatusAndReason19) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason19) AddProcessingStatus() *ProcessingStatus23Choice {
 	s.ProcessingStatus = new(ProcessingStatus23Choice)
 	return s.ProcessingStatus
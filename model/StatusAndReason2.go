@@ -7,6 +7,10 @@ type StatusAndReason2 struct {
 	StatusAndReason *Status2Choice `xml:"StsAndRsn"`
 }
 
+func (s *StatusAndReason2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason2) AddStatusAndReason() *Status2Choice {
 	s.StatusAndReason = new(Status2Choice)
 	return s.StatusAndReason
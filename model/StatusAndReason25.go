@@ -10,6 +10,10 @@ type StatusAndReason25 struct {
 	Transaction []*Transaction40 `xml:"Tx,omitempty"`
 }
 
+func (s *StatusAndReason25) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason25) AddStatusAndReason() *Status15Choice {
 	s.StatusAndReason = new(Status15Choice)
 	return s.StatusAndReason
@@ -10,6 +10,10 @@ type StatusAndReason27 struct {
 	Transaction []*Transaction45 `xml:"Tx,omitempty"`
 }
 
+func (s *StatusAndReason27) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason27) AddStatusAndReason() *Status18Choice {
 	s.StatusAndReason = new(Status18Choice)
 	return s.StatusAndReason
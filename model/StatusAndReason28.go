@@ -16,6 +16,10 @@ type StatusAndReason28 struct {
 	SettlementStatus *SettlementStatus17Choice `xml:"SttlmSts,omitempty"`
 }
 
+func (s *StatusAndReason28) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason28) AddProcessingStatus() *ProcessingStatus52Choice {
 	s.ProcessingStatus = new(ProcessingStatus52Choice)
 	return s.ProcessingStatus
@@ -16,6 +16,10 @@ type StatusAndReason29 struct {
 	SettlementStatus *SettlementStatus22Choice `xml:"SttlmSts,omitempty"`
 }
 
+func (s *StatusAndReason29) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason29) AddProcessingStatus() *ProcessingStatus62Choice {
 	s.ProcessingStatus = new(ProcessingStatus62Choice)
 	return s.ProcessingStatus
@@ -16,6 +16,10 @@ type StatusAndReason3 struct {
 	SettlementStatus *SettlementStatus2Choice `xml:"SttlmSts,omitempty"`
 }
 
+func (s *StatusAndReason3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason3) AddProcessingStatus() *ProcessingStatus6Choice {
 	s.ProcessingStatus = new(ProcessingStatus6Choice)
 	return s.ProcessingStatus
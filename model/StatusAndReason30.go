@@ -10,6 +10,10 @@ type StatusAndReason30 struct {
 	Transaction []*Transaction48 `xml:"Tx,omitempty"`
 }
 
+func (s *StatusAndReason30) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason30) AddStatusAndReason() *Status23Choice {
 	s.StatusAndReason = new(Status23Choice)
 	return s.StatusAndReason
@@ -10,6 +10,10 @@ type StatusAndReason32 struct {
 	Transaction []*Transaction54 `xml:"Tx,omitempty"`
 }
 
+func (s *StatusAndReason32) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason32) AddStatusAndReason() *Status18Choice {
 	s.StatusAndReason = new(Status18Choice)
 	return s.StatusAndReason
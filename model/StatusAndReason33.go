@@ -10,6 +10,10 @@ type StatusAndReason33 struct {
 	Transaction []*Transaction56 `xml:"Tx,omitempty"`
 }
 
+func (s *StatusAndReason33) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason33) AddStatusAndReason() *Status23Choice {
 	s.StatusAndReason = new(Status23Choice)
 	return s.StatusAndReason
@@ -10,6 +10,10 @@ type StatusAndReason7 struct {
 	Transaction []*Transaction14 `xml:"Tx,omitempty"`
 }
 
+func (s *StatusAndReason7) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason7) AddStatusAndReason() *Status2Choice {
 	s.StatusAndReason = new(Status2Choice)
 	return s.StatusAndReason
@@ -10,6 +10,10 @@ type StatusAndReason9 struct {
 	Transaction []*Transaction20 `xml:"Tx,omitempty"`
 }
 
+func (s *StatusAndReason9) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndReason9) AddStatusAndReason() *Status9Choice {
 	s.StatusAndReason = new(Status9Choice)
 	return s.StatusAndReason
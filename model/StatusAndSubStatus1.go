@@ -10,6 +10,10 @@ type StatusAndSubStatus1 struct {
 	SubStatusCode *Exact4AlphaNumericText `xml:"SubStsCd,omitempty"`
 }
 
+func (s *StatusAndSubStatus1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndSubStatus1) AddStatusCode() *Status13Choice {
 	s.StatusCode = new(Status13Choice)
 	return s.StatusCode
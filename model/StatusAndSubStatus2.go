@@ -10,6 +10,10 @@ type StatusAndSubStatus2 struct {
 	SubStatusCode *Exact4AlphaNumericText `xml:"SubStsCd,omitempty"`
 }
 
+func (s *StatusAndSubStatus2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusAndSubStatus2) AddStatusCode() *Status27Choice {
 	s.StatusCode = new(Status27Choice)
 	return s.StatusCode
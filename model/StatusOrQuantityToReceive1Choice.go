@@ -10,6 +10,10 @@ type StatusOrQuantityToReceive1Choice struct {
 	QuantityToReceive *Quantity6Choice `xml:"QtyToRcv"`
 }
 
+func (s *StatusOrQuantityToReceive1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusOrQuantityToReceive1Choice) AddStatusQuantity() *Quantity6Choice {
 	s.StatusQuantity = new(Quantity6Choice)
 	return s.StatusQuantity
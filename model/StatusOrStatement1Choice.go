@@ -10,6 +10,10 @@ type StatusOrStatement1Choice struct {
 	Statement *DocumentNumber1 `xml:"Stmt"`
 }
 
+func (s *StatusOrStatement1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusOrStatement1Choice) AddStatusAdvice() *DocumentNumber2 {
 	s.StatusAdvice = new(DocumentNumber2)
 	return s.StatusAdvice
@@ -10,6 +10,10 @@ type StatusOrStatement3Choice struct {
 	Statement *DocumentNumber1 `xml:"Stmt"`
 }
 
+func (s *StatusOrStatement3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusOrStatement3Choice) AddStatusAdvice() *DocumentNumber6 {
 	s.StatusAdvice = new(DocumentNumber6)
 	return s.StatusAdvice
@@ -10,6 +10,10 @@ type StatusOrStatement5Choice struct {
 	Statement *DocumentNumber1 `xml:"Stmt"`
 }
 
+func (s *StatusOrStatement5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusOrStatement5Choice) AddStatusAdvice() *DocumentNumber9 {
 	s.StatusAdvice = new(DocumentNumber9)
 	return s.StatusAdvice
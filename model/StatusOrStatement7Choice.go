@@ -10,6 +10,10 @@ type StatusOrStatement7Choice struct {
 	Statement *DocumentNumber13 `xml:"Stmt"`
 }
 
+func (s *StatusOrStatement7Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusOrStatement7Choice) AddStatusAdvice() *DocumentNumber12 {
 	s.StatusAdvice = new(DocumentNumber12)
 	return s.StatusAdvice
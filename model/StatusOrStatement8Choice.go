@@ -10,6 +10,10 @@ type StatusOrStatement8Choice struct {
 	Statement *DocumentNumber14 `xml:"Stmt"`
 }
 
+func (s *StatusOrStatement8Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusOrStatement8Choice) AddStatusAdvice() *DocumentNumber15 {
 	s.StatusAdvice = new(DocumentNumber15)
 	return s.StatusAdvice
@@ -10,6 +10,10 @@ type StatusReason1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (s *StatusReason1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReason1Choice) SetCode(value string) {
 	s.Code = (*TransactionRejectReason2Code)(&value)
 }
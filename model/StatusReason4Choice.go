@@ -10,6 +10,10 @@ type StatusReason4Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (s *StatusReason4Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReason4Choice) SetCode(value string) {
 	s.Code = (*FinancingStatusReason1Code)(&value)
 }
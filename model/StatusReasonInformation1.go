@@ -15,6 +15,10 @@ type StatusReasonInformation1 struct {
 	AdditionalStatusReasonInformation []*Max105Text `xml:"AddtlStsRsnInf,omitempty"`
 }
 
+func (s *StatusReasonInformation1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReasonInformation1) AddStatusOriginator() *PartyIdentification8 {
 	s.StatusOriginator = new(PartyIdentification8)
 	return s.StatusOriginator
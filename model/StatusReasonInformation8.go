@@ -15,6 +15,10 @@ type StatusReasonInformation8 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (s *StatusReasonInformation8) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReasonInformation8) AddOriginator() *PartyIdentification32 {
 	s.Originator = new(PartyIdentification32)
 	return s.Originator
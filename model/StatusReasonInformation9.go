@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to provide information on the status reason of the transaction.
 type StatusReasonInformation9 struct {
 
@@ -15,6 +17,10 @@ type StatusReasonInformation9 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (s *StatusReasonInformation9) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReasonInformation9) AddOriginator() *PartyIdentification43 {
 	s.Originator = new(PartyIdentification43)
 	return s.Originator
@@ -28,3 +34,55 @@ func (s *StatusReasonInformation9) AddReason() *StatusReason6Choice {
 func (s *StatusReasonInformation9) AddAdditionalInformation(value string) {
 	s.AdditionalInformation = append(s.AdditionalInformation, (*Max105Text)(&value))
 }
+
+func (s *StatusReasonInformation9) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if s.Originator != nil {
+		s.Originator.EncodeXMLFast(enc, "Orgtr")
+	}
+	if s.Reason != nil {
+		s.Reason.EncodeXMLFast(enc, "Rsn")
+	}
+	for _, elem := range s.AdditionalInformation {
+		if elem != nil {
+			enc.Element("AddtlInf", string(*elem))
+		}
+	}
+	enc.End(tag)
+}
+
+func (s *StatusReasonInformation9) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Orgtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.Originator = elem
+		case "Rsn":
+			elem := new(StatusReason6Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.Reason = elem
+		case "AddtlInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.AdditionalInformation = append(s.AdditionalInformation, (*Max105Text)(&value))
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
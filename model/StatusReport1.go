@@ -13,6 +13,10 @@ type StatusReport1 struct {
 	DataSet []*TerminalManagementDataSet1 `xml:"DataSet"`
 }
 
+func (s *StatusReport1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReport1) AddPOIIdentification() *GenericIdentification35 {
 	s.POIIdentification = new(GenericIdentification35)
 	return s.POIIdentification
@@ -13,6 +13,10 @@ type StatusReport2 struct {
 	DataSet []*TerminalManagementDataSet4 `xml:"DataSet"`
 }
 
+func (s *StatusReport2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReport2) AddPOIIdentification() *GenericIdentification35 {
 	s.POIIdentification = new(GenericIdentification35)
 	return s.POIIdentification
@@ -13,6 +13,10 @@ type StatusReport3 struct {
 	DataSet []*TerminalManagementDataSet9 `xml:"DataSet"`
 }
 
+func (s *StatusReport3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReport3) AddPOIIdentification() *GenericIdentification35 {
 	s.POIIdentification = new(GenericIdentification35)
 	return s.POIIdentification
@@ -13,6 +13,10 @@ type StatusReport4 struct {
 	DataSet *TerminalManagementDataSet13 `xml:"DataSet"`
 }
 
+func (s *StatusReport4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReport4) AddPOIIdentification() *GenericIdentification71 {
 	s.POIIdentification = new(GenericIdentification71)
 	return s.POIIdentification
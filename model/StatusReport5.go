@@ -13,6 +13,10 @@ type StatusReport5 struct {
 	DataSet *TerminalManagementDataSet16 `xml:"DataSet"`
 }
 
+func (s *StatusReport5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReport5) AddPOIIdentification() *GenericIdentification71 {
 	s.POIIdentification = new(GenericIdentification71)
 	return s.POIIdentification
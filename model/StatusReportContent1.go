@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Content of the status report.
 type StatusReportContent1 struct {
 
@@ -25,6 +29,10 @@ type StatusReportContent1 struct {
 	Errors *Max140Text `xml:"Errs,omitempty"`
 }
 
+func (s *StatusReportContent1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReportContent1) AddPOICapabilities() *PointOfInteractionCapabilities1 {
 	s.POICapabilities = new(PointOfInteractionCapabilities1)
 	return s.POICapabilities
@@ -184,6 +192,11 @@ func (s *StatusReportContent1) SetPOIDateTime(value string) {
 	s.POIDateTime = (*ISODateTime)(&value)
 }
 
+func (s *StatusReportContent1) SetPOIDateTimeFromTime(value time.Time) {
+	s.POIDateTime = new(ISODateTime)
+	s.POIDateTime.FromTime(value)
+}
+
 func (s *StatusReportContent1) AddDataSetRequired() *DataSetIdentification2 {
 	s.DataSetRequired = new(DataSetIdentification2)
 	return s.DataSetRequired
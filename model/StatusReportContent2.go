@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Content of the status report.
 type StatusReportContent2 struct {
 
@@ -25,6 +29,10 @@ type StatusReportContent2 struct {
 	Errors *Max140Text `xml:"Errs,omitempty"`
 }
 
+func (s *StatusReportContent2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReportContent2) AddPOICapabilities() *PointOfInteractionCapabilities1 {
 	s.POICapabilities = new(PointOfInteractionCapabilities1)
 	return s.POICapabilities
@@ -184,6 +192,11 @@ func (s *StatusReportContent2) SetPOIDateTime(value string) {
 	s.POIDateTime = (*ISODateTime)(&value)
 }
 
+func (s *StatusReportContent2) SetPOIDateTimeFromTime(value time.Time) {
+	s.POIDateTime = new(ISODateTime)
+	s.POIDateTime.FromTime(value)
+}
+
 func (s *StatusReportContent2) AddDataSetRequired() *TerminalManagementDataSet7 {
 	s.DataSetRequired = new(TerminalManagementDataSet7)
 	return s.DataSetRequired
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Content of the status report.
 type StatusReportContent3 struct {
 
@@ -25,6 +29,10 @@ type StatusReportContent3 struct {
 	Errors []*Max140Text `xml:"Errs,omitempty"`
 }
 
+func (s *StatusReportContent3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReportContent3) AddPOICapabilities() *PointOfInteractionCapabilities2 {
 	s.POICapabilities = new(PointOfInteractionCapabilities2)
 	return s.POICapabilities
@@ -184,6 +192,11 @@ func (s *StatusReportContent3) SetPOIDateTime(value string) {
 	s.POIDateTime = (*ISODateTime)(&value)
 }
 
+func (s *StatusReportContent3) SetPOIDateTimeFromTime(value time.Time) {
+	s.POIDateTime = new(ISODateTime)
+	s.POIDateTime.FromTime(value)
+}
+
 func (s *StatusReportContent3) AddDataSetRequired() *TerminalManagementDataSet8 {
 	s.DataSetRequired = new(TerminalManagementDataSet8)
 	return s.DataSetRequired
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Content of the status report.
 type StatusReportContent4 struct {
 
@@ -25,6 +29,10 @@ type StatusReportContent4 struct {
 	Errors []*Max140Text `xml:"Errs,omitempty"`
 }
 
+func (s *StatusReportContent4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReportContent4) AddPOICapabilities() *PointOfInteractionCapabilities3 {
 	s.POICapabilities = new(PointOfInteractionCapabilities3)
 	return s.POICapabilities
@@ -184,6 +192,11 @@ func (s *StatusReportContent4) SetPOIDateTime(value string) {
 	s.POIDateTime = (*ISODateTime)(&value)
 }
 
+func (s *StatusReportContent4) SetPOIDateTimeFromTime(value time.Time) {
+	s.POIDateTime = new(ISODateTime)
+	s.POIDateTime.FromTime(value)
+}
+
 func (s *StatusReportContent4) AddDataSetRequired() *TerminalManagementDataSet12 {
 	s.DataSetRequired = new(TerminalManagementDataSet12)
 	return s.DataSetRequired
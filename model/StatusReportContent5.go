@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Content of the status report.
 type StatusReportContent5 struct {
 
@@ -25,6 +29,10 @@ type StatusReportContent5 struct {
 	Errors []*Max140Text `xml:"Errs,omitempty"`
 }
 
+func (s *StatusReportContent5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReportContent5) AddPOICapabilities() *PointOfInteractionCapabilities6 {
 	s.POICapabilities = new(PointOfInteractionCapabilities6)
 	return s.POICapabilities
@@ -184,6 +192,11 @@ func (s *StatusReportContent5) SetPOIDateTime(value string) {
 	s.POIDateTime = (*ISODateTime)(&value)
 }
 
+func (s *StatusReportContent5) SetPOIDateTimeFromTime(value time.Time) {
+	s.POIDateTime = new(ISODateTime)
+	s.POIDateTime.FromTime(value)
+}
+
 func (s *StatusReportContent5) AddDataSetRequired() *TerminalManagementDataSet17 {
 	s.DataSetRequired = new(TerminalManagementDataSet17)
 	return s.DataSetRequired
@@ -17,6 +17,10 @@ type StatusReportItems2 struct {
 	SubStatus *Max140Text `xml:"SubSts,omitempty"`
 }
 
+func (s *StatusReportItems2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusReportItems2) SetTransactionIdentification(value string) {
 	s.TransactionIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides the history of status and reasons for a pending, posted or cancelled transaction.
 type StatusTrail2 struct {
 
@@ -37,10 +41,19 @@ type StatusTrail2 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *StatusTrail2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusTrail2) SetStatusDate(value string) {
 	s.StatusDate = (*ISODateTime)(&value)
 }
 
+func (s *StatusTrail2) SetStatusDateFromTime(value time.Time) {
+	s.StatusDate = new(ISODateTime)
+	s.StatusDate.FromTime(value)
+}
+
 func (s *StatusTrail2) AddSendingOrganisationIdentification() *OrganisationIdentification7 {
 	s.SendingOrganisationIdentification = new(OrganisationIdentification7)
 	return s.SendingOrganisationIdentification
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides the history of status and reasons for a pending, posted or cancelled transaction.
 type StatusTrail6 struct {
 
@@ -37,10 +41,19 @@ type StatusTrail6 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *StatusTrail6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusTrail6) SetStatusDate(value string) {
 	s.StatusDate = (*ISODateTime)(&value)
 }
 
+func (s *StatusTrail6) SetStatusDateFromTime(value time.Time) {
+	s.StatusDate = new(ISODateTime)
+	s.StatusDate.FromTime(value)
+}
+
 func (s *StatusTrail6) AddSendingOrganisationIdentification() *OrganisationIdentification7 {
 	s.SendingOrganisationIdentification = new(OrganisationIdentification7)
 	return s.SendingOrganisationIdentification
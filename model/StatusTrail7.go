@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides the history of status and reasons for a pending, posted or cancelled transaction.
 type StatusTrail7 struct {
 
@@ -37,10 +41,19 @@ type StatusTrail7 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *StatusTrail7) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StatusTrail7) SetStatusDate(value string) {
 	s.StatusDate = (*ISODateTime)(&value)
 }
 
+func (s *StatusTrail7) SetStatusDateFromTime(value time.Time) {
+	s.StatusDate = new(ISODateTime)
+	s.StatusDate.FromTime(value)
+}
+
 func (s *StatusTrail7) AddSendingOrganisationIdentification() *OrganisationIdentification9 {
 	s.SendingOrganisationIdentification = new(OrganisationIdentification9)
 	return s.SendingOrganisationIdentification
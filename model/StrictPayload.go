@@ -1,3 +1,41 @@
 package model
 
-type StrictPayload string
+import "encoding/xml"
+
+// Encapsulated ISO 20022 message, kept as raw XML.
+type StrictPayload struct {
+	Value string `xml:",innerxml"`
+}
+
+func (s *StrictPayload) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *StrictPayload) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	value, err := innerXML(d, start)
+	if err != nil {
+		return err
+	}
+	s.Value = value
+	return nil
+}
+
+// Embed replaces the content with the XML encoding of v.
+func (s *StrictPayload) Embed(v interface{}) error {
+	value, err := embedXML(v)
+	if err != nil {
+		return err
+	}
+	s.Value = value
+	return nil
+}
+
+// Extract decodes the content into v.
+func (s *StrictPayload) Extract(v interface{}) error {
+	return extractXML(s.Value, v)
+}
+
+// Namespace returns the namespace of the first element of the content.
+func (s *StrictPayload) Namespace() string {
+	return rootNamespace(s.Value)
+}
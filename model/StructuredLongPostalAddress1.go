@@ -40,6 +40,10 @@ type StructuredLongPostalAddress1 struct {
 	PostOfficeBox *Max16Text `xml:"POB,omitempty"`
 }
 
+func (s *StructuredLongPostalAddress1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StructuredLongPostalAddress1) SetBuildingName(value string) {
 	s.BuildingName = (*Max35Text)(&value)
 }
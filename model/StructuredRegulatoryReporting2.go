@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information needed due to regulatory and statutory requirements.
 type StructuredRegulatoryReporting2 struct {
 
@@ -15,6 +19,10 @@ type StructuredRegulatoryReporting2 struct {
 	Information *Max35Text `xml:"Inf,omitempty"`
 }
 
+func (s *StructuredRegulatoryReporting2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StructuredRegulatoryReporting2) SetCode(value string) {
 	s.Code = (*Max3Text)(&value)
 }
@@ -23,6 +31,10 @@ func (s *StructuredRegulatoryReporting2) SetAmount(value, currency string) {
 	s.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (s *StructuredRegulatoryReporting2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *StructuredRegulatoryReporting2) SetInformation(value string) {
 	s.Information = (*Max35Text)(&value)
 }
@@ -1,5 +1,12 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Information needed due to regulatory and statutory requirements.
 type StructuredRegulatoryReporting3 struct {
 
@@ -22,6 +29,10 @@ type StructuredRegulatoryReporting3 struct {
 	Information []*Max35Text `xml:"Inf,omitempty"`
 }
 
+func (s *StructuredRegulatoryReporting3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StructuredRegulatoryReporting3) SetType(value string) {
 	s.Type = (*Max35Text)(&value)
 }
@@ -30,6 +41,11 @@ func (s *StructuredRegulatoryReporting3) SetDate(value string) {
 	s.Date = (*ISODate)(&value)
 }
 
+func (s *StructuredRegulatoryReporting3) SetDateFromTime(value time.Time) {
+	s.Date = new(ISODate)
+	s.Date.FromTime(value)
+}
+
 func (s *StructuredRegulatoryReporting3) SetCountry(value string) {
 	s.Country = (*CountryCode)(&value)
 }
@@ -42,6 +58,89 @@ func (s *StructuredRegulatoryReporting3) SetAmount(value, currency string) {
 	s.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *StructuredRegulatoryReporting3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *StructuredRegulatoryReporting3) AddInformation(value string) {
 	s.Information = append(s.Information, (*Max35Text)(&value))
 }
+
+func (s *StructuredRegulatoryReporting3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if s.Type != nil {
+		enc.Element("Tp", string(*s.Type))
+	}
+	if s.Date != nil {
+		enc.Element("Dt", string(*s.Date))
+	}
+	if s.Country != nil {
+		enc.Element("Ctry", string(*s.Country))
+	}
+	if s.Code != nil {
+		enc.Element("Cd", string(*s.Code))
+	}
+	if s.Amount != nil {
+		s.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	for _, elem := range s.Information {
+		if elem != nil {
+			enc.Element("Inf", string(*elem))
+		}
+	}
+	enc.End(tag)
+}
+
+func (s *StructuredRegulatoryReporting3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.Type = (*Max35Text)(&value)
+		case "Dt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.Date = (*ISODate)(&value)
+		case "Ctry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.Country = (*CountryCode)(&value)
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.Code = (*Max10Text)(&value)
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.Amount = elem
+		case "Inf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.Information = append(s.Information, (*Max35Text)(&value))
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -25,7 +25,11 @@ type StructuredRemittanceInformation12 struct {
 	GarnishmentRemittance *Garnishment1 `xml:"GrnshmtRmt,omitempty"`
 
 	// Additional information, in free text form, to complement the structured remittance information.
-	AdditionalRemittanceInformation []*Max140Text `xml:"AddtlRmtInf,omitempty"`
+	AdditionalRemittanceInformation []*Max140Text `xml:"AddtlRmtInf,omitempty" xsd:"maxOccurs=3"`
+}
+
+func (s *StructuredRemittanceInformation12) Validate() error {
+	return ValidateElement(s)
 }
 
 func (s *StructuredRemittanceInformation12) AddReferredDocumentInformation() *ReferredDocumentInformation6 {
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Information supplied to enable the matching/reconciliation of an entry with the items that the payment is intended to settle, such as commercial invoices in an accounts' receivable system, in a structured form.
 type StructuredRemittanceInformation13 struct {
 
@@ -25,7 +27,11 @@ type StructuredRemittanceInformation13 struct {
 	GarnishmentRemittance *Garnishment1 `xml:"GrnshmtRmt,omitempty"`
 
 	// Additional information, in free text form, to complement the structured remittance information.
-	AdditionalRemittanceInformation []*Max140Text `xml:"AddtlRmtInf,omitempty"`
+	AdditionalRemittanceInformation []*Max140Text `xml:"AddtlRmtInf,omitempty" xsd:"maxOccurs=3"`
+}
+
+func (s *StructuredRemittanceInformation13) Validate() error {
+	return ValidateElement(s)
 }
 
 func (s *StructuredRemittanceInformation13) AddReferredDocumentInformation() *ReferredDocumentInformation7 {
@@ -207,3 +213,102 @@ func (s *StructuredRemittanceInformation13) AddGarnishmentRemittance() *Garnishm
 func (s *StructuredRemittanceInformation13) AddAdditionalRemittanceInformation(value string) {
 	s.AdditionalRemittanceInformation = append(s.AdditionalRemittanceInformation, (*Max140Text)(&value))
 }
+
+func (s *StructuredRemittanceInformation13) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	for _, elem := range s.ReferredDocumentInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RfrdDocInf")
+		}
+	}
+	if s.ReferredDocumentAmount != nil {
+		s.ReferredDocumentAmount.EncodeXMLFast(enc, "RfrdDocAmt")
+	}
+	if s.CreditorReferenceInformation != nil {
+		s.CreditorReferenceInformation.EncodeXMLFast(enc, "CdtrRefInf")
+	}
+	if s.Invoicer != nil {
+		s.Invoicer.EncodeXMLFast(enc, "Invcr")
+	}
+	if s.Invoicee != nil {
+		s.Invoicee.EncodeXMLFast(enc, "Invcee")
+	}
+	if s.TaxRemittance != nil {
+		s.TaxRemittance.EncodeXMLFast(enc, "TaxRmt")
+	}
+	if s.GarnishmentRemittance != nil {
+		s.GarnishmentRemittance.EncodeXMLFast(enc, "GrnshmtRmt")
+	}
+	for _, elem := range s.AdditionalRemittanceInformation {
+		if elem != nil {
+			enc.Element("AddtlRmtInf", string(*elem))
+		}
+	}
+	enc.End(tag)
+}
+
+func (s *StructuredRemittanceInformation13) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "RfrdDocInf":
+			elem := new(ReferredDocumentInformation7)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.ReferredDocumentInformation = append(s.ReferredDocumentInformation, elem)
+		case "RfrdDocAmt":
+			elem := new(RemittanceAmount2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.ReferredDocumentAmount = elem
+		case "CdtrRefInf":
+			elem := new(CreditorReferenceInformation2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.CreditorReferenceInformation = elem
+		case "Invcr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.Invoicer = elem
+		case "Invcee":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.Invoicee = elem
+		case "TaxRmt":
+			elem := new(TaxInformation4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.TaxRemittance = elem
+		case "GrnshmtRmt":
+			elem := new(Garnishment1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.GarnishmentRemittance = elem
+		case "AddtlRmtInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.AdditionalRemittanceInformation = append(s.AdditionalRemittanceInformation, (*Max140Text)(&value))
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Information supplied to enable the matching/reconciliation of an entry with the items that the payment is intended to settle, such as commercial invoices in an accounts' receivable system, in a structured form.
+type StructuredRemittanceInformation16 struct {
+
+	// Provides the identification and the content of the referred document.
+	ReferredDocumentInformation []*ReferredDocumentInformation7 `xml:"RfrdDocInf,omitempty"`
+
+	// Provides details on the amounts of the referred document.
+	ReferredDocumentAmount *RemittanceAmount2 `xml:"RfrdDocAmt,omitempty"`
+
+	// Reference information provided by the creditor to allow the identification of the underlying documents.
+	CreditorReferenceInformation *CreditorReferenceInformation2 `xml:"CdtrRefInf,omitempty"`
+
+	// Identification of the organisation issuing the invoice, when it is different from the creditor or ultimate creditor.
+	Invoicer *PartyIdentification135 `xml:"Invcr,omitempty"`
+
+	// Identification of the party to whom an invoice is issued, when it is different from the debtor or ultimate debtor.
+	Invoicee *PartyIdentification135 `xml:"Invcee,omitempty"`
+
+	// Provides remittance information about a payment made for tax-related purposes.
+	TaxRemittance *TaxInformation7 `xml:"TaxRmt,omitempty"`
+
+	// Provides remittance information about a payment for garnishment-related purposes.
+	GarnishmentRemittance *Garnishment3 `xml:"GrnshmtRmt,omitempty"`
+
+	// Additional information, in free text form, to complement the structured remittance information.
+	AdditionalRemittanceInformation []*Max140Text `xml:"AddtlRmtInf,omitempty" xsd:"maxOccurs=3"`
+}
+
+func (s *StructuredRemittanceInformation16) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *StructuredRemittanceInformation16) AddReferredDocumentInformation() *ReferredDocumentInformation7 {
+	newValue := new(ReferredDocumentInformation7)
+	s.ReferredDocumentInformation = append(s.ReferredDocumentInformation, newValue)
+	return newValue
+}
+
+func (s *StructuredRemittanceInformation16) AddReferredDocumentAmount() *RemittanceAmount2 {
+	s.ReferredDocumentAmount = new(RemittanceAmount2)
+	return s.ReferredDocumentAmount
+}
+
+func (s *StructuredRemittanceInformation16) AddCreditorReferenceInformation() *CreditorReferenceInformation2 {
+	s.CreditorReferenceInformation = new(CreditorReferenceInformation2)
+	return s.CreditorReferenceInformation
+}
+
+func (s *StructuredRemittanceInformation16) AddInvoicer() *PartyIdentification135 {
+	s.Invoicer = new(PartyIdentification135)
+	return s.Invoicer
+}
+
+func (s *StructuredRemittanceInformation16) AddInvoicee() *PartyIdentification135 {
+	s.Invoicee = new(PartyIdentification135)
+	return s.Invoicee
+}
+
+func (s *StructuredRemittanceInformation16) AddTaxRemittance() *TaxInformation7 {
+	s.TaxRemittance = new(TaxInformation7)
+	return s.TaxRemittance
+}
+
+func (s *StructuredRemittanceInformation16) AddGarnishmentRemittance() *Garnishment3 {
+	s.GarnishmentRemittance = new(Garnishment3)
+	return s.GarnishmentRemittance
+}
+
+func (s *StructuredRemittanceInformation16) AddAdditionalRemittanceInformation(value string) {
+	s.AdditionalRemittanceInformation = append(s.AdditionalRemittanceInformation, (*Max140Text)(&value))
+}
+
+func (s *StructuredRemittanceInformation16) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	for _, elem := range s.ReferredDocumentInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "RfrdDocInf")
+		}
+	}
+	if s.ReferredDocumentAmount != nil {
+		s.ReferredDocumentAmount.EncodeXMLFast(enc, "RfrdDocAmt")
+	}
+	if s.CreditorReferenceInformation != nil {
+		s.CreditorReferenceInformation.EncodeXMLFast(enc, "CdtrRefInf")
+	}
+	if s.Invoicer != nil {
+		s.Invoicer.EncodeXMLFast(enc, "Invcr")
+	}
+	if s.Invoicee != nil {
+		s.Invoicee.EncodeXMLFast(enc, "Invcee")
+	}
+	if s.TaxRemittance != nil {
+		s.TaxRemittance.EncodeXMLFast(enc, "TaxRmt")
+	}
+	if s.GarnishmentRemittance != nil {
+		s.GarnishmentRemittance.EncodeXMLFast(enc, "GrnshmtRmt")
+	}
+	for _, elem := range s.AdditionalRemittanceInformation {
+		if elem != nil {
+			enc.Element("AddtlRmtInf", string(*elem))
+		}
+	}
+	enc.End(tag)
+}
+
+func (s *StructuredRemittanceInformation16) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "RfrdDocInf":
+			elem := new(ReferredDocumentInformation7)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.ReferredDocumentInformation = append(s.ReferredDocumentInformation, elem)
+		case "RfrdDocAmt":
+			elem := new(RemittanceAmount2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.ReferredDocumentAmount = elem
+		case "CdtrRefInf":
+			elem := new(CreditorReferenceInformation2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.CreditorReferenceInformation = elem
+		case "Invcr":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.Invoicer = elem
+		case "Invcee":
+			elem := new(PartyIdentification135)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.Invoicee = elem
+		case "TaxRmt":
+			elem := new(TaxInformation7)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.TaxRemittance = elem
+		case "GrnshmtRmt":
+			elem := new(Garnishment3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.GarnishmentRemittance = elem
+		case "AddtlRmtInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.AdditionalRemittanceInformation = append(s.AdditionalRemittanceInformation, (*Max140Text)(&value))
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Structured information supplied to enable the matching, ie, reconciliation, of a payment with the items that the payment is intended to settle, eg, commercial invoices in an accounts receivable system.
 type StructuredRemittanceInformation2 struct {
 
@@ -29,6 +33,10 @@ type StructuredRemittanceInformation2 struct {
 	Invoicee *PartyIdentification1 `xml:"Invcee,omitempty"`
 }
 
+func (s *StructuredRemittanceInformation2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StructuredRemittanceInformation2) SetReferredDocumentType(value string) {
 	s.ReferredDocumentType = (*DocumentType1Code)(&value)
 }
@@ -37,6 +45,11 @@ func (s *StructuredRemittanceInformation2) SetReferredDocumentRelatedDate(value
 	s.ReferredDocumentRelatedDate = (*ISODate)(&value)
 }
 
+func (s *StructuredRemittanceInformation2) SetReferredDocumentRelatedDateFromTime(value time.Time) {
+	s.ReferredDocumentRelatedDate = new(ISODate)
+	s.ReferredDocumentRelatedDate.FromTime(value)
+}
+
 func (s *StructuredRemittanceInformation2) AddReferredDocumentAmount() *ReferredDocumentAmount1Choice {
 	newValue := new(ReferredDocumentAmount1Choice)
 	s.ReferredDocumentAmount = append(s.ReferredDocumentAmount, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Structured information supplied to enable the matching, i.e.  reconciliation, of a payment with the items that the payment is intended to settle, eg, commercial invoices in an accounts receivable system.
 type StructuredRemittanceInformation6 struct {
 
@@ -25,6 +29,10 @@ type StructuredRemittanceInformation6 struct {
 	AdditionalRemittanceInformation *Max140Text `xml:"AddtlRmtInf,omitempty"`
 }
 
+func (s *StructuredRemittanceInformation6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *StructuredRemittanceInformation6) AddReferredDocumentInformation() *ReferredDocumentInformation1 {
 	s.ReferredDocumentInformation = new(ReferredDocumentInformation1)
 	return s.ReferredDocumentInformation
@@ -34,6 +42,11 @@ func (s *StructuredRemittanceInformation6) SetReferredDocumentRelatedDate(value
 	s.ReferredDocumentRelatedDate = (*ISODate)(&value)
 }
 
+func (s *StructuredRemittanceInformation6) SetReferredDocumentRelatedDateFromTime(value time.Time) {
+	s.ReferredDocumentRelatedDate = new(ISODate)
+	s.ReferredDocumentRelatedDate.FromTime(value)
+}
+
 func (s *StructuredRemittanceInformation6) AddReferredDocumentAmount() *ReferredDocumentAmount1Choice {
 	newValue := new(ReferredDocumentAmount1Choice)
 	s.ReferredDocumentAmount = append(s.ReferredDocumentAmount, newValue)
@@ -19,7 +19,11 @@ type StructuredRemittanceInformation7 struct {
 	Invoicee *PartyIdentification32 `xml:"Invcee,omitempty"`
 
 	// Additional information, in free text form, to complement the structured remittance information.
-	AdditionalRemittanceInformation []*Max140Text `xml:"AddtlRmtInf,omitempty"`
+	AdditionalRemittanceInformation []*Max140Text `xml:"AddtlRmtInf,omitempty" xsd:"maxOccurs=3"`
+}
+
+func (s *StructuredRemittanceInformation7) Validate() error {
+	return ValidateElement(s)
 }
 
 func (s *StructuredRemittanceInformation7) AddReferredDocumentInformation() *ReferredDocumentInformation3 {
@@ -13,6 +13,10 @@ type SubAccount1 struct {
 	Characteristic *Max35Text `xml:"Chrtc,omitempty"`
 }
 
+func (s *SubAccount1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccount1) AddIdentification() *AccountIdentification1 {
 	s.Identification = new(AccountIdentification1)
 	return s.Identification
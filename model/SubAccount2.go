@@ -7,6 +7,10 @@ type SubAccount2 struct {
 	Identification *Max35Text `xml:"Id"`
 }
 
+func (s *SubAccount2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccount2) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type SubAccount4 struct {
 	Characteristic *Max35Text `xml:"Chrtc,omitempty"`
 }
 
+func (s *SubAccount4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccount4) AddIdentification() *AccountIdentification26 {
 	s.Identification = new(AccountIdentification26)
 	return s.Identification
@@ -13,6 +13,10 @@ type SubAccount5 struct {
 	Characteristic *Max35Text `xml:"Chrtc,omitempty"`
 }
 
+func (s *SubAccount5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccount5) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
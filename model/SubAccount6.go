@@ -16,6 +16,10 @@ type SubAccount6 struct {
 	AccountDesignation *Max35Text `xml:"AcctDsgnt,omitempty"`
 }
 
+func (s *SubAccount6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccount6) SetIdentification(value string) {
 	s.Identification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type SubAccountIdentification1 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation1 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification1) AddIdentification() *AccountIdentificationFormatChoice {
 	s.Identification = new(AccountIdentificationFormatChoice)
 	return s.Identification
@@ -25,10 +29,20 @@ func (s *SubAccountIdentification1) SetFungibleIndicator(value string) {
 	s.FungibleIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification1) SetFungibleIndicatorFromBool(value bool) {
+	s.FungibleIndicator = new(YesNoIndicator)
+	s.FungibleIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification1) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification1) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification1) AddBalanceForSubAccount() *AggregateBalanceInformation1 {
 	newValue := new(AggregateBalanceInformation1)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
@@ -16,6 +16,10 @@ type SubAccountIdentification10 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation8 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification10) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification10) AddAccountOwner() *PartyIdentification13Choice {
 	s.AccountOwner = new(PartyIdentification13Choice)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification10) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification10) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification10) AddBalanceForSubAccount() *AggregateBalanceInformation8 {
 	newValue := new(AggregateBalanceInformation8)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
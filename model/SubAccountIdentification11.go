@@ -16,6 +16,10 @@ type SubAccountIdentification11 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation9 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification11) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification11) AddAccountOwner() *PartyIdentification13Choice {
 	s.AccountOwner = new(PartyIdentification13Choice)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification11) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification11) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification11) AddBalanceForSubAccount() *AggregateBalanceInformation9 {
 	newValue := new(AggregateBalanceInformation9)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
@@ -16,6 +16,10 @@ type SubAccountIdentification15 struct {
 	FinancialInstrumentDetails []*FinancialInstrumentDetails5 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (s *SubAccountIdentification15) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification15) AddAccountOwner() *PartyIdentification36Choice {
 	s.AccountOwner = new(PartyIdentification36Choice)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification15) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification15) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification15) AddFinancialInstrumentDetails() *FinancialInstrumentDetails5 {
 	newValue := new(FinancialInstrumentDetails5)
 	s.FinancialInstrumentDetails = append(s.FinancialInstrumentDetails, newValue)
@@ -16,6 +16,10 @@ type SubAccountIdentification16 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation13 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification16) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification16) AddAccountOwner() *PartyIdentification36Choice {
 	s.AccountOwner = new(PartyIdentification36Choice)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification16) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification16) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification16) AddBalanceForSubAccount() *AggregateBalanceInformation13 {
 	newValue := new(AggregateBalanceInformation13)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
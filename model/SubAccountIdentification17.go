@@ -16,6 +16,10 @@ type SubAccountIdentification17 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation12 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification17) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification17) AddAccountOwner() *PartyIdentification36Choice {
 	s.AccountOwner = new(PartyIdentification36Choice)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification17) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification17) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification17) AddBalanceForSubAccount() *AggregateBalanceInformation12 {
 	newValue := new(AggregateBalanceInformation12)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
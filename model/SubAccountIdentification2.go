@@ -16,6 +16,10 @@ type SubAccountIdentification2 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation2 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification2) AddIdentification() *AccountIdentificationFormatChoice {
 	s.Identification = new(AccountIdentificationFormatChoice)
 	return s.Identification
@@ -25,10 +29,20 @@ func (s *SubAccountIdentification2) SetFungibleIndicator(value string) {
 	s.FungibleIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification2) SetFungibleIndicatorFromBool(value bool) {
+	s.FungibleIndicator = new(YesNoIndicator)
+	s.FungibleIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification2) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification2) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification2) AddBalanceForSubAccount() *AggregateBalanceInformation2 {
 	newValue := new(AggregateBalanceInformation2)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
@@ -16,6 +16,10 @@ type SubAccountIdentification21 struct {
 	FinancialInstrumentDetails []*FinancialInstrumentDetails9 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (s *SubAccountIdentification21) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification21) AddAccountOwner() *PartyIdentification36Choice {
 	s.AccountOwner = new(PartyIdentification36Choice)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification21) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification21) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification21) AddFinancialInstrumentDetails() *FinancialInstrumentDetails9 {
 	newValue := new(FinancialInstrumentDetails9)
 	s.FinancialInstrumentDetails = append(s.FinancialInstrumentDetails, newValue)
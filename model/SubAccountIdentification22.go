@@ -16,6 +16,10 @@ type SubAccountIdentification22 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation16 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification22) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification22) AddAccountOwner() *PartyIdentification36Choice {
 	s.AccountOwner = new(PartyIdentification36Choice)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification22) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification22) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification22) AddBalanceForSubAccount() *AggregateBalanceInformation16 {
 	newValue := new(AggregateBalanceInformation16)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
@@ -16,6 +16,10 @@ type SubAccountIdentification23 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation17 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification23) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification23) AddAccountOwner() *PartyIdentification36Choice {
 	s.AccountOwner = new(PartyIdentification36Choice)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification23) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification23) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification23) AddBalanceForSubAccount() *AggregateBalanceInformation17 {
 	newValue := new(AggregateBalanceInformation17)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
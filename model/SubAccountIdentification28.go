@@ -16,6 +16,10 @@ type SubAccountIdentification28 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation21 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification28) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification28) AddAccountOwner() *PartyIdentification36Choice {
 	s.AccountOwner = new(PartyIdentification36Choice)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification28) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification28) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification28) AddBalanceForSubAccount() *AggregateBalanceInformation21 {
 	newValue := new(AggregateBalanceInformation21)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
@@ -16,6 +16,10 @@ type SubAccountIdentification29 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation22 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification29) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification29) AddAccountOwner() *PartyIdentification36Choice {
 	s.AccountOwner = new(PartyIdentification36Choice)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification29) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification29) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification29) AddBalanceForSubAccount() *AggregateBalanceInformation22 {
 	newValue := new(AggregateBalanceInformation22)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
@@ -16,6 +16,10 @@ type SubAccountIdentification3 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation3 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification3) AddIdentification() *AccountIdentificationFormatChoice {
 	s.Identification = new(AccountIdentificationFormatChoice)
 	return s.Identification
@@ -25,10 +29,20 @@ func (s *SubAccountIdentification3) SetFungibleIndicator(value string) {
 	s.FungibleIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification3) SetFungibleIndicatorFromBool(value bool) {
+	s.FungibleIndicator = new(YesNoIndicator)
+	s.FungibleIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification3) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification3) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification3) AddBalanceForSubAccount() *AggregateBalanceInformation3 {
 	newValue := new(AggregateBalanceInformation3)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
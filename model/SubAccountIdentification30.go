@@ -16,6 +16,10 @@ type SubAccountIdentification30 struct {
 	FinancialInstrumentDetails []*FinancialInstrumentDetails13 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (s *SubAccountIdentification30) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification30) AddAccountOwner() *PartyIdentification36Choice {
 	s.AccountOwner = new(PartyIdentification36Choice)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification30) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification30) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification30) AddFinancialInstrumentDetails() *FinancialInstrumentDetails13 {
 	newValue := new(FinancialInstrumentDetails13)
 	s.FinancialInstrumentDetails = append(s.FinancialInstrumentDetails, newValue)
@@ -16,6 +16,10 @@ type SubAccountIdentification34 struct {
 	FinancialInstrumentDetails []*FinancialInstrumentDetails17 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (s *SubAccountIdentification34) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification34) AddAccountOwner() *PartyIdentification36Choice {
 	s.AccountOwner = new(PartyIdentification36Choice)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification34) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification34) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification34) AddFinancialInstrumentDetails() *FinancialInstrumentDetails17 {
 	newValue := new(FinancialInstrumentDetails17)
 	s.FinancialInstrumentDetails = append(s.FinancialInstrumentDetails, newValue)
@@ -13,6 +13,10 @@ type SubAccountIdentification36 struct {
 	TransactionOnSubAccount []*InvestmentFundTransactionsByFund3 `xml:"TxOnSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification36) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification36) AddIdentification() *AccountIdentificationFormatChoice {
 	s.Identification = new(AccountIdentificationFormatChoice)
 	return s.Identification
@@ -22,6 +26,11 @@ func (s *SubAccountIdentification36) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification36) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification36) AddTransactionOnSubAccount() *InvestmentFundTransactionsByFund3 {
 	newValue := new(InvestmentFundTransactionsByFund3)
 	s.TransactionOnSubAccount = append(s.TransactionOnSubAccount, newValue)
@@ -16,6 +16,10 @@ type SubAccountIdentification37 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation25 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification37) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification37) AddAccountOwner() *PartyIdentification36Choice {
 	s.AccountOwner = new(PartyIdentification36Choice)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification37) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification37) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification37) AddBalanceForSubAccount() *AggregateBalanceInformation25 {
 	newValue := new(AggregateBalanceInformation25)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
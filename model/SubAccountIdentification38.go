@@ -16,6 +16,10 @@ type SubAccountIdentification38 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation26 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification38) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification38) AddAccountOwner() *PartyIdentification36Choice {
 	s.AccountOwner = new(PartyIdentification36Choice)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification38) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification38) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification38) AddBalanceForSubAccount() *AggregateBalanceInformation26 {
 	newValue := new(AggregateBalanceInformation26)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
@@ -13,6 +13,10 @@ type SubAccountIdentification4 struct {
 	TransactionOnSubAccount []*InvestmentFundTransactionsByFund1 `xml:"TxOnSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification4) AddIdentification() *AccountIdentificationFormatChoice {
 	s.Identification = new(AccountIdentificationFormatChoice)
 	return s.Identification
@@ -22,6 +26,11 @@ func (s *SubAccountIdentification4) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification4) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification4) AddTransactionOnSubAccount() *InvestmentFundTransactionsByFund1 {
 	newValue := new(InvestmentFundTransactionsByFund1)
 	s.TransactionOnSubAccount = append(s.TransactionOnSubAccount, newValue)
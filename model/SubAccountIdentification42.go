@@ -16,6 +16,10 @@ type SubAccountIdentification42 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation30 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification42) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification42) AddAccountOwner() *PartyIdentification98 {
 	s.AccountOwner = new(PartyIdentification98)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification42) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification42) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification42) AddBalanceForSubAccount() *AggregateBalanceInformation30 {
 	newValue := new(AggregateBalanceInformation30)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
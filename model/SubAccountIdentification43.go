@@ -16,6 +16,10 @@ type SubAccountIdentification43 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation31 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification43) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification43) AddAccountOwner() *PartyIdentification98 {
 	s.AccountOwner = new(PartyIdentification98)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification43) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification43) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification43) AddBalanceForSubAccount() *AggregateBalanceInformation31 {
 	newValue := new(AggregateBalanceInformation31)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
@@ -16,6 +16,10 @@ type SubAccountIdentification44 struct {
 	FinancialInstrumentDetails []*FinancialInstrumentDetails20 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (s *SubAccountIdentification44) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification44) AddAccountOwner() *PartyIdentification98 {
 	s.AccountOwner = new(PartyIdentification98)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification44) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification44) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification44) AddFinancialInstrumentDetails() *FinancialInstrumentDetails20 {
 	newValue := new(FinancialInstrumentDetails20)
 	s.FinancialInstrumentDetails = append(s.FinancialInstrumentDetails, newValue)
@@ -16,6 +16,10 @@ type SubAccountIdentification45 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation32 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification45) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification45) AddAccountOwner() *PartyIdentification109 {
 	s.AccountOwner = new(PartyIdentification109)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification45) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification45) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification45) AddBalanceForSubAccount() *AggregateBalanceInformation32 {
 	newValue := new(AggregateBalanceInformation32)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
@@ -16,6 +16,10 @@ type SubAccountIdentification46 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation33 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification46) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification46) AddAccountOwner() *PartyIdentification109 {
 	s.AccountOwner = new(PartyIdentification109)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification46) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification46) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification46) AddBalanceForSubAccount() *AggregateBalanceInformation33 {
 	newValue := new(AggregateBalanceInformation33)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
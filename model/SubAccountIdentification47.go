@@ -16,6 +16,10 @@ type SubAccountIdentification47 struct {
 	FinancialInstrumentDetails []*FinancialInstrumentDetails23 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (s *SubAccountIdentification47) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification47) AddAccountOwner() *PartyIdentification109 {
 	s.AccountOwner = new(PartyIdentification109)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification47) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification47) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification47) AddFinancialInstrumentDetails() *FinancialInstrumentDetails23 {
 	newValue := new(FinancialInstrumentDetails23)
 	s.FinancialInstrumentDetails = append(s.FinancialInstrumentDetails, newValue)
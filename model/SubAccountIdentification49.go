@@ -16,6 +16,10 @@ type SubAccountIdentification49 struct {
 	FinancialInstrumentDetails []*FinancialInstrumentDetails25 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (s *SubAccountIdentification49) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification49) AddAccountOwner() *PartyIdentification98 {
 	s.AccountOwner = new(PartyIdentification98)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification49) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification49) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification49) AddFinancialInstrumentDetails() *FinancialInstrumentDetails25 {
 	newValue := new(FinancialInstrumentDetails25)
 	s.FinancialInstrumentDetails = append(s.FinancialInstrumentDetails, newValue)
@@ -16,6 +16,10 @@ type SubAccountIdentification5 struct {
 	BalanceForSubAccount []*AggregateBalanceInformation4 `xml:"BalForSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification5) AddIdentification() *AccountIdentificationFormatChoice {
 	s.Identification = new(AccountIdentificationFormatChoice)
 	return s.Identification
@@ -25,10 +29,20 @@ func (s *SubAccountIdentification5) SetFungibleIndicator(value string) {
 	s.FungibleIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification5) SetFungibleIndicatorFromBool(value bool) {
+	s.FungibleIndicator = new(YesNoIndicator)
+	s.FungibleIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification5) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification5) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification5) AddBalanceForSubAccount() *AggregateBalanceInformation4 {
 	newValue := new(AggregateBalanceInformation4)
 	s.BalanceForSubAccount = append(s.BalanceForSubAccount, newValue)
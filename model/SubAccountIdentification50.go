@@ -16,6 +16,10 @@ type SubAccountIdentification50 struct {
 	FinancialInstrumentDetails []*FinancialInstrumentDetails27 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (s *SubAccountIdentification50) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification50) AddAccountOwner() *PartyIdentification119 {
 	s.AccountOwner = new(PartyIdentification119)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification50) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification50) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification50) AddFinancialInstrumentDetails() *FinancialInstrumentDetails27 {
 	newValue := new(FinancialInstrumentDetails27)
 	s.FinancialInstrumentDetails = append(s.FinancialInstrumentDetails, newValue)
@@ -13,6 +13,10 @@ type SubAccountIdentification6 struct {
 	TransactionOnSubAccount []*InvestmentFundTransactionsByFund2 `xml:"TxOnSubAcct,omitempty"`
 }
 
+func (s *SubAccountIdentification6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification6) AddIdentification() *AccountIdentificationFormatChoice {
 	s.Identification = new(AccountIdentificationFormatChoice)
 	return s.Identification
@@ -22,6 +26,11 @@ func (s *SubAccountIdentification6) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification6) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification6) AddTransactionOnSubAccount() *InvestmentFundTransactionsByFund2 {
 	newValue := new(InvestmentFundTransactionsByFund2)
 	s.TransactionOnSubAccount = append(s.TransactionOnSubAccount, newValue)
@@ -16,6 +16,10 @@ type SubAccountIdentification9 struct {
 	FinancialInstrumentDetails []*FinancialInstrumentDetails2 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (s *SubAccountIdentification9) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubAccountIdentification9) AddAccountOwner() *PartyIdentification13Choice {
 	s.AccountOwner = new(PartyIdentification13Choice)
 	return s.AccountOwner
@@ -30,6 +34,11 @@ func (s *SubAccountIdentification9) SetActivityIndicator(value string) {
 	s.ActivityIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubAccountIdentification9) SetActivityIndicatorFromBool(value bool) {
+	s.ActivityIndicator = new(YesNoIndicator)
+	s.ActivityIndicator.FromBool(value)
+}
+
 func (s *SubAccountIdentification9) AddFinancialInstrumentDetails() *FinancialInstrumentDetails2 {
 	newValue := new(FinancialInstrumentDetails2)
 	s.FinancialInstrumentDetails = append(s.FinancialInstrumentDetails, newValue)
@@ -10,6 +10,10 @@ type SubBalanceBreakdown1 struct {
 	Quantity *SubBalanceQuantity5Choice `xml:"Qty"`
 }
 
+func (s *SubBalanceBreakdown1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceBreakdown1) AddSubBalanceType() *SubBalanceType9Choice {
 	s.SubBalanceType = new(SubBalanceType9Choice)
 	return s.SubBalanceType
@@ -13,6 +13,10 @@ type SubBalanceInformation1 struct {
 	AdditionalBalanceBreakdownDetails []*AdditionalBalanceInformation `xml:"AddtlBalBrkdwnDtls,omitempty"`
 }
 
+func (s *SubBalanceInformation1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceInformation1) AddQuantity() *SubBalanceQuantity1Choice {
 	s.Quantity = new(SubBalanceQuantity1Choice)
 	return s.Quantity
@@ -19,6 +19,10 @@ type SubBalanceInformation11 struct {
 	AdditionalBalanceBreakdownDetails []*AdditionalBalanceInformation11 `xml:"AddtlBalBrkdwnDtls,omitempty"`
 }
 
+func (s *SubBalanceInformation11) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceInformation11) AddSubBalanceType() *SubBalanceType5Choice {
 	s.SubBalanceType = new(SubBalanceType5Choice)
 	return s.SubBalanceType
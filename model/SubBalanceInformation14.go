@@ -16,6 +16,10 @@ type SubBalanceInformation14 struct {
 	AdditionalBalanceBreakdownDetails []*AdditionalBalanceInformation14 `xml:"AddtlBalBrkdwnDtls,omitempty"`
 }
 
+func (s *SubBalanceInformation14) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceInformation14) AddSubBalanceType() *SubBalanceType11Choice {
 	s.SubBalanceType = new(SubBalanceType11Choice)
 	return s.SubBalanceType
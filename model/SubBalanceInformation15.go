@@ -19,6 +19,10 @@ type SubBalanceInformation15 struct {
 	AdditionalBalanceBreakdownDetails []*AdditionalBalanceInformation15 `xml:"AddtlBalBrkdwnDtls,omitempty"`
 }
 
+func (s *SubBalanceInformation15) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceInformation15) AddSubBalanceType() *SubBalanceType11Choice {
 	s.SubBalanceType = new(SubBalanceType11Choice)
 	return s.SubBalanceType
@@ -16,6 +16,10 @@ type SubBalanceInformation16 struct {
 	AdditionalBalanceBreakdownDetails []*AdditionalBalanceInformation16 `xml:"AddtlBalBrkdwnDtls,omitempty"`
 }
 
+func (s *SubBalanceInformation16) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceInformation16) AddSubBalanceType() *SubBalanceType13Choice {
 	s.SubBalanceType = new(SubBalanceType13Choice)
 	return s.SubBalanceType
@@ -19,6 +19,10 @@ type SubBalanceInformation17 struct {
 	AdditionalBalanceBreakdownDetails []*AdditionalBalanceInformation17 `xml:"AddtlBalBrkdwnDtls,omitempty"`
 }
 
+func (s *SubBalanceInformation17) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceInformation17) AddSubBalanceType() *SubBalanceType13Choice {
 	s.SubBalanceType = new(SubBalanceType13Choice)
 	return s.SubBalanceType
@@ -16,6 +16,10 @@ type SubBalanceInformation2 struct {
 	AdditionalBalanceBreakdownDetails []*AdditionalBalanceInformation2 `xml:"AddtlBalBrkdwnDtls,omitempty"`
 }
 
+func (s *SubBalanceInformation2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceInformation2) AddQuantity() *SubBalanceQuantity1Choice {
 	s.Quantity = new(SubBalanceQuantity1Choice)
 	return s.Quantity
@@ -19,6 +19,10 @@ type SubBalanceInformation5 struct {
 	AdditionalBalanceBreakdownDetails []*AdditionalBalanceInformation5 `xml:"AddtlBalBrkdwnDtls,omitempty"`
 }
 
+func (s *SubBalanceInformation5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceInformation5) AddSubBalanceType() *SubBalanceType5Choice {
 	s.SubBalanceType = new(SubBalanceType5Choice)
 	return s.SubBalanceType
@@ -16,6 +16,10 @@ type SubBalanceInformation6 struct {
 	AdditionalBalanceBreakdownDetails []*AdditionalBalanceInformation6 `xml:"AddtlBalBrkdwnDtls,omitempty"`
 }
 
+func (s *SubBalanceInformation6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceInformation6) AddSubBalanceType() *SubBalanceType5Choice {
 	s.SubBalanceType = new(SubBalanceType5Choice)
 	return s.SubBalanceType
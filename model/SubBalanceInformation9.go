@@ -19,6 +19,10 @@ type SubBalanceInformation9 struct {
 	AdditionalBalanceBreakdownDetails []*AdditionalBalanceInformation9 `xml:"AddtlBalBrkdwnDtls,omitempty"`
 }
 
+func (s *SubBalanceInformation9) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceInformation9) AddSubBalanceType() *SubBalanceType5Choice {
 	s.SubBalanceType = new(SubBalanceType5Choice)
 	return s.SubBalanceType
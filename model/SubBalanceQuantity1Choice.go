@@ -13,6 +13,10 @@ type SubBalanceQuantity1Choice struct {
 	QuantityAndAvailability *QuantityAndAvailability `xml:"QtyAndAvlbty"`
 }
 
+func (s *SubBalanceQuantity1Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceQuantity1Choice) AddQuantity() *FinancialInstrumentQuantityChoice {
 	s.Quantity = new(FinancialInstrumentQuantityChoice)
 	return s.Quantity
@@ -13,6 +13,10 @@ type SubBalanceQuantity3Choice struct {
 	QuantityAndAvailability *QuantityAndAvailability1 `xml:"QtyAndAvlbty"`
 }
 
+func (s *SubBalanceQuantity3Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceQuantity3Choice) AddQuantity() *FinancialInstrumentQuantity1Choice {
 	s.Quantity = new(FinancialInstrumentQuantity1Choice)
 	return s.Quantity
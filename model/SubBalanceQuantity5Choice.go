@@ -10,6 +10,10 @@ type SubBalanceQuantity5Choice struct {
 	Proprietary *GenericIdentification56 `xml:"Prtry"`
 }
 
+func (s *SubBalanceQuantity5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceQuantity5Choice) AddQuantity() *FinancialInstrumentQuantity1Choice {
 	s.Quantity = new(FinancialInstrumentQuantity1Choice)
 	return s.Quantity
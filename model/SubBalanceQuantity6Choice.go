@@ -13,6 +13,10 @@ type SubBalanceQuantity6Choice struct {
 	QuantityAndAvailability *QuantityAndAvailability1 `xml:"QtyAndAvlbty"`
 }
 
+func (s *SubBalanceQuantity6Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceQuantity6Choice) AddQuantity() *FinancialInstrumentQuantity1Choice {
 	s.Quantity = new(FinancialInstrumentQuantity1Choice)
 	return s.Quantity
@@ -13,6 +13,10 @@ type SubBalanceQuantity7Choice struct {
 	QuantityAndAvailability *QuantityAndAvailability2 `xml:"QtyAndAvlbty"`
 }
 
+func (s *SubBalanceQuantity7Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceQuantity7Choice) AddQuantity() *FinancialInstrumentQuantity15Choice {
 	s.Quantity = new(FinancialInstrumentQuantity15Choice)
 	return s.Quantity
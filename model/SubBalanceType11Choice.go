@@ -10,6 +10,10 @@ type SubBalanceType11Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SubBalanceType11Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceType11Choice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType12Code)(&value)
 }
@@ -10,6 +10,10 @@ type SubBalanceType12Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SubBalanceType12Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceType12Choice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType7Code)(&value)
 }
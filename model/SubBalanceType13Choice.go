@@ -10,6 +10,10 @@ type SubBalanceType13Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SubBalanceType13Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceType13Choice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType12Code)(&value)
 }
@@ -10,6 +10,10 @@ type SubBalanceType14Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (s *SubBalanceType14Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceType14Choice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType7Code)(&value)
 }
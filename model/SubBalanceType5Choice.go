@@ -10,6 +10,10 @@ type SubBalanceType5Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SubBalanceType5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceType5Choice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType12Code)(&value)
 }
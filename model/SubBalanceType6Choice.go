@@ -10,6 +10,10 @@ type SubBalanceType6Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (s *SubBalanceType6Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceType6Choice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType7Code)(&value)
 }
@@ -10,6 +10,10 @@ type SubBalanceType9Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (s *SubBalanceType9Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubBalanceType9Choice) SetCode(value string) {
 	s.Code = (*SecuritiesBalanceType14Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Execution of a subscription order.
 type SubscriptionBulkExecution2 struct {
 
@@ -28,6 +32,10 @@ type SubscriptionBulkExecution2 struct {
 	BulkCashSettlementDetails *PaymentTransaction16 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionBulkExecution2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionBulkExecution2) SetPlaceOfTrade(value string) {
 	s.PlaceOfTrade = (*CountryCode)(&value)
 }
@@ -36,6 +44,11 @@ func (s *SubscriptionBulkExecution2) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionBulkExecution2) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SubscriptionBulkExecution2) AddCancellationRight() *CancellationRight1 {
 	s.CancellationRight = new(CancellationRight1)
 	return s.CancellationRight
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a subscription order.
 type SubscriptionBulkExecution3 struct {
 
@@ -44,6 +50,10 @@ type SubscriptionBulkExecution3 struct {
 	BulkCashSettlementDetails *PaymentTransaction24 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionBulkExecution3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionBulkExecution3) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
@@ -57,10 +67,20 @@ func (s *SubscriptionBulkExecution3) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionBulkExecution3) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SubscriptionBulkExecution3) SetRequestedFutureTradeDate(value string) {
 	s.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (s *SubscriptionBulkExecution3) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	s.RequestedFutureTradeDate = new(ISODate)
+	s.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (s *SubscriptionBulkExecution3) SetCancellationRight(value string) {
 	s.CancellationRight = (*CancellationRight1Code)(&value)
 }
@@ -232,10 +252,19 @@ func (s *SubscriptionBulkExecution3) SetTotalSettlementAmount(value, currency st
 	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionBulkExecution3) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionBulkExecution3) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (s *SubscriptionBulkExecution3) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
 func (s *SubscriptionBulkExecution3) AddBulkCashSettlementDetails() *PaymentTransaction24 {
 	s.BulkCashSettlementDetails = new(PaymentTransaction24)
 	return s.BulkCashSettlementDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a subscription order.
 type SubscriptionBulkExecution4 struct {
 
@@ -44,10 +50,19 @@ type SubscriptionBulkExecution4 struct {
 	BulkCashSettlementDetails *PaymentTransaction70 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionBulkExecution4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionBulkExecution4) SetAmendmentIndicator(value string) {
 	s.AmendmentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionBulkExecution4) SetAmendmentIndicatorFromBool(value bool) {
+	s.AmendmentIndicator = new(YesNoIndicator)
+	s.AmendmentIndicator.FromBool(value)
+}
+
 func (s *SubscriptionBulkExecution4) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
@@ -61,14 +76,29 @@ func (s *SubscriptionBulkExecution4) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionBulkExecution4) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SubscriptionBulkExecution4) SetReceivedDateTime(value string) {
 	s.ReceivedDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionBulkExecution4) SetReceivedDateTimeFromTime(value time.Time) {
+	s.ReceivedDateTime = new(ISODateTime)
+	s.ReceivedDateTime.FromTime(value)
+}
+
 func (s *SubscriptionBulkExecution4) SetRequestedFutureTradeDate(value string) {
 	s.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (s *SubscriptionBulkExecution4) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	s.RequestedFutureTradeDate = new(ISODate)
+	s.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (s *SubscriptionBulkExecution4) AddCancellationRight() *CancellationRight1Choice {
 	s.CancellationRight = new(CancellationRight1Choice)
 	return s.CancellationRight
@@ -237,6 +267,10 @@ func (s *SubscriptionBulkExecution4) SetTotalSettlementAmount(value, currency st
 	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionBulkExecution4) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionBulkExecution4) AddBulkCashSettlementDetails() *PaymentTransaction70 {
 	s.BulkCashSettlementDetails = new(PaymentTransaction70)
 	return s.BulkCashSettlementDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Order to invest the investor's principal in an investment fund.
 type SubscriptionBulkOrder2 struct {
 
@@ -31,6 +35,10 @@ type SubscriptionBulkOrder2 struct {
 	BulkCashSettlementDetails *PaymentTransaction17 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionBulkOrder2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionBulkOrder2) SetPlaceOfTrade(value string) {
 	s.PlaceOfTrade = (*CountryCode)(&value)
 }
@@ -39,10 +47,20 @@ func (s *SubscriptionBulkOrder2) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionBulkOrder2) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SubscriptionBulkOrder2) SetExpiryDateTime(value string) {
 	s.ExpiryDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionBulkOrder2) SetExpiryDateTimeFromTime(value time.Time) {
+	s.ExpiryDateTime = new(ISODateTime)
+	s.ExpiryDateTime.FromTime(value)
+}
+
 func (s *SubscriptionBulkOrder2) AddCancellationRight() *CancellationRight1 {
 	s.CancellationRight = new(CancellationRight1)
 	return s.CancellationRight
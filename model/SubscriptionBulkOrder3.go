@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Order to invest the investor's principal in an investment fund.
 type SubscriptionBulkOrder3 struct {
 
@@ -47,6 +53,10 @@ type SubscriptionBulkOrder3 struct {
 	BulkCashSettlementDetails *PaymentTransaction23 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionBulkOrder3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionBulkOrder3) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
@@ -60,6 +70,11 @@ func (s *SubscriptionBulkOrder3) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionBulkOrder3) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SubscriptionBulkOrder3) AddExpiryDateTime() *DateAndDateTimeChoice {
 	s.ExpiryDateTime = new(DateAndDateTimeChoice)
 	return s.ExpiryDateTime
@@ -69,6 +84,11 @@ func (s *SubscriptionBulkOrder3) SetRequestedFutureTradeDate(value string) {
 	s.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (s *SubscriptionBulkOrder3) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	s.RequestedFutureTradeDate = new(ISODate)
+	s.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (s *SubscriptionBulkOrder3) SetCancellationRight(value string) {
 	s.CancellationRight = (*CancellationRight1Code)(&value)
 }
@@ -240,10 +260,19 @@ func (s *SubscriptionBulkOrder3) SetTotalSettlementAmount(value, currency string
 	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionBulkOrder3) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionBulkOrder3) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (s *SubscriptionBulkOrder3) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
 func (s *SubscriptionBulkOrder3) AddBulkCashSettlementDetails() *PaymentTransaction23 {
 	s.BulkCashSettlementDetails = new(PaymentTransaction23)
 	return s.BulkCashSettlementDetails
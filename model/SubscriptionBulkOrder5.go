@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Order to invest the investor's principal in an investment fund.
 type SubscriptionBulkOrder5 struct {
 
@@ -41,6 +47,10 @@ type SubscriptionBulkOrder5 struct {
 	BulkCashSettlementDetails *PaymentTransaction70 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionBulkOrder5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionBulkOrder5) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
@@ -54,6 +64,11 @@ func (s *SubscriptionBulkOrder5) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionBulkOrder5) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SubscriptionBulkOrder5) AddExpiryDateTime() *DateAndDateTimeChoice {
 	s.ExpiryDateTime = new(DateAndDateTimeChoice)
 	return s.ExpiryDateTime
@@ -63,6 +78,11 @@ func (s *SubscriptionBulkOrder5) SetRequestedFutureTradeDate(value string) {
 	s.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (s *SubscriptionBulkOrder5) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	s.RequestedFutureTradeDate = new(ISODate)
+	s.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (s *SubscriptionBulkOrder5) AddCancellationRight() *CancellationRight1Choice {
 	s.CancellationRight = new(CancellationRight1Choice)
 	return s.CancellationRight
@@ -231,6 +251,10 @@ func (s *SubscriptionBulkOrder5) SetTotalSettlementAmount(value, currency string
 	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionBulkOrder5) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionBulkOrder5) AddBulkCashSettlementDetails() *PaymentTransaction70 {
 	s.BulkCashSettlementDetails = new(PaymentTransaction70)
 	return s.BulkCashSettlementDetails
@@ -16,10 +16,19 @@ type SubscriptionBulkOrderConfirmation1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (s *SubscriptionBulkOrderConfirmation1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionBulkOrderConfirmation1) SetAmendmentIndicator(value string) {
 	s.AmendmentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionBulkOrderConfirmation1) SetAmendmentIndicatorFromBool(value bool) {
+	s.AmendmentIndicator = new(YesNoIndicator)
+	s.AmendmentIndicator.FromBool(value)
+}
+
 func (s *SubscriptionBulkOrderConfirmation1) AddBulkExecutionDetails() *SubscriptionBulkExecution3 {
 	s.BulkExecutionDetails = new(SubscriptionBulkExecution3)
 	return s.BulkExecutionDetails
@@ -16,6 +16,10 @@ type SubscriptionBulkOrderInstruction1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (s *SubscriptionBulkOrderInstruction1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionBulkOrderInstruction1) AddBulkOrderDetails() *SubscriptionBulkOrder2 {
 	s.BulkOrderDetails = new(SubscriptionBulkOrder2)
 	return s.BulkOrderDetails
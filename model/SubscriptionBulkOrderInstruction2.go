@@ -13,6 +13,10 @@ type SubscriptionBulkOrderInstruction2 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (s *SubscriptionBulkOrderInstruction2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionBulkOrderInstruction2) AddBulkOrderDetails() *SubscriptionBulkOrder3 {
 	s.BulkOrderDetails = new(SubscriptionBulkOrder3)
 	return s.BulkOrderDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a subscription order.
 type SubscriptionExecution12 struct {
 
@@ -153,6 +159,10 @@ type SubscriptionExecution12 struct {
 	OrderWaiverDetails *OrderWaiver1 `xml:"OrdrWvrDtls,omitempty"`
 }
 
+func (s *SubscriptionExecution12) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionExecution12) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -466,6 +476,11 @@ func (s *SubscriptionExecution12) SetUnitsNumber(value string) {
 	s.UnitsNumber = (*DecimalNumber)(&value)
 }
 
+func (s *SubscriptionExecution12) SetUnitsNumberFromDecimal(value decimal.Decimal) {
+	s.UnitsNumber = new(DecimalNumber)
+	s.UnitsNumber.FromDecimal(value)
+}
+
 func (s *SubscriptionExecution12) SetRounding(value string) {
 	s.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -474,10 +489,18 @@ func (s *SubscriptionExecution12) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution12) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution12) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution12) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution12) AddTradeDateTime() *DateAndDateTimeChoice {
 	s.TradeDateTime = new(DateAndDateTimeChoice)
 	return s.TradeDateTime
@@ -638,18 +661,36 @@ func (s *SubscriptionExecution12) SetSettlementAmount(value, currency string) {
 	s.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution12) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution12) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (s *SubscriptionExecution12) SetSettlementMethod(value string) {
+func (s *SubscriptionExecution12) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
+func (s *SubscriptionExecution12) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SubscriptionExecution12) SetPartiallyExecutedIndicator(value string) {
 	s.PartiallyExecutedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution12) SetPartiallyExecutedIndicatorFromBool(value bool) {
+	s.PartiallyExecutedIndicator = new(YesNoIndicator)
+	s.PartiallyExecutedIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution12) SetBestExecution(value string) {
 	s.BestExecution = (*BestExecution1Code)(&value)
 }
@@ -658,6 +699,11 @@ func (s *SubscriptionExecution12) SetCumDividendIndicator(value string) {
 	s.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution12) SetCumDividendIndicatorFromBool(value bool) {
+	s.CumDividendIndicator = new(YesNoIndicator)
+	s.CumDividendIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution12) AddInterimProfitAmount() *ProfitAndLoss2Choice {
 	s.InterimProfitAmount = new(ProfitAndLoss2Choice)
 	return s.InterimProfitAmount
@@ -840,6 +886,11 @@ func (s *SubscriptionExecution12) SetPhysicalDeliveryIndicator(value string) {
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution12) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution12) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	s.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return s.PhysicalDeliveryDetails
@@ -995,10 +1046,18 @@ func (s *SubscriptionExecution12) SetRefund(value, currency string) {
 	s.Refund = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution12) SetRefundFromDecimal(value decimal.Decimal, currency string) {
+	s.Refund = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution12) SetSubscriptionInterest(value, currency string) {
 	s.SubscriptionInterest = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution12) SetSubscriptionInterestFromDecimal(value decimal.Decimal, currency string) {
+	s.SubscriptionInterest = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution12) AddCashSettlementDetails() *PaymentTransaction70 {
 	s.CashSettlementDetails = new(PaymentTransaction70)
 	return s.CashSettlementDetails
@@ -1012,6 +1071,11 @@ func (s *SubscriptionExecution12) SetPartialSettlementOfUnits(value string) {
 	s.PartialSettlementOfUnits = (*PercentageRate)(&value)
 }
 
+func (s *SubscriptionExecution12) SetPartialSettlementOfUnitsFromDecimal(value decimal.Decimal) {
+	s.PartialSettlementOfUnits = new(PercentageRate)
+	s.PartialSettlementOfUnits.FromDecimal(value)
+}
+
 func (s *SubscriptionExecution12) SetFinancialAdvice(value string) {
 	s.FinancialAdvice = (*FinancialAdvice1Code)(&value)
 }
@@ -1028,6 +1092,11 @@ func (s *SubscriptionExecution12) SetPartialSettlementOfCash(value string) {
 	s.PartialSettlementOfCash = (*PercentageRate)(&value)
 }
 
+func (s *SubscriptionExecution12) SetPartialSettlementOfCashFromDecimal(value decimal.Decimal) {
+	s.PartialSettlementOfCash = new(PercentageRate)
+	s.PartialSettlementOfCash.FromDecimal(value)
+}
+
 func (s *SubscriptionExecution12) AddRelatedPartyDetails() *Intermediary39 {
 	newValue := new(Intermediary39)
 	s.RelatedPartyDetails = append(s.RelatedPartyDetails, newValue)
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a subscription order.
 type SubscriptionExecution13 struct {
 
@@ -159,6 +165,10 @@ type SubscriptionExecution13 struct {
 	OrderWaiverDetails *OrderWaiver1 `xml:"OrdrWvrDtls,omitempty"`
 }
 
+func (s *SubscriptionExecution13) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionExecution13) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -331,6 +341,11 @@ func (s *SubscriptionExecution13) SetUnitsNumber(value string) {
 	s.UnitsNumber = (*DecimalNumber)(&value)
 }
 
+func (s *SubscriptionExecution13) SetUnitsNumberFromDecimal(value decimal.Decimal) {
+	s.UnitsNumber = new(DecimalNumber)
+	s.UnitsNumber.FromDecimal(value)
+}
+
 func (s *SubscriptionExecution13) SetRounding(value string) {
 	s.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -339,10 +354,18 @@ func (s *SubscriptionExecution13) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution13) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution13) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution13) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution13) AddTradeDateTime() *DateAndDateTimeChoice {
 	s.TradeDateTime = new(DateAndDateTimeChoice)
 	return s.TradeDateTime
@@ -503,18 +526,36 @@ func (s *SubscriptionExecution13) SetSettlementAmount(value, currency string) {
 	s.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution13) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution13) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (s *SubscriptionExecution13) SetSettlementMethod(value string) {
+func (s *SubscriptionExecution13) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
+func (s *SubscriptionExecution13) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SubscriptionExecution13) SetPartiallyExecutedIndicator(value string) {
 	s.PartiallyExecutedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution13) SetPartiallyExecutedIndicatorFromBool(value bool) {
+	s.PartiallyExecutedIndicator = new(YesNoIndicator)
+	s.PartiallyExecutedIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution13) SetBestExecution(value string) {
 	s.BestExecution = (*BestExecution1Code)(&value)
 }
@@ -523,6 +564,11 @@ func (s *SubscriptionExecution13) SetCumDividendIndicator(value string) {
 	s.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution13) SetCumDividendIndicatorFromBool(value bool) {
+	s.CumDividendIndicator = new(YesNoIndicator)
+	s.CumDividendIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution13) AddInterimProfitAmount() *ProfitAndLoss2Choice {
 	s.InterimProfitAmount = new(ProfitAndLoss2Choice)
 	return s.InterimProfitAmount
@@ -705,6 +751,11 @@ func (s *SubscriptionExecution13) SetPhysicalDeliveryIndicator(value string) {
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution13) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution13) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	s.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return s.PhysicalDeliveryDetails
@@ -722,10 +773,18 @@ func (s *SubscriptionExecution13) SetRefund(value, currency string) {
 	s.Refund = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution13) SetRefundFromDecimal(value decimal.Decimal, currency string) {
+	s.Refund = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution13) SetSubscriptionInterest(value, currency string) {
 	s.SubscriptionInterest = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution13) SetSubscriptionInterestFromDecimal(value decimal.Decimal, currency string) {
+	s.SubscriptionInterest = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution13) AddCashSettlementDetails() *PaymentTransaction70 {
 	s.CashSettlementDetails = new(PaymentTransaction70)
 	return s.CashSettlementDetails
@@ -739,10 +798,20 @@ func (s *SubscriptionExecution13) SetPartialSettlementOfUnits(value string) {
 	s.PartialSettlementOfUnits = (*PercentageRate)(&value)
 }
 
+func (s *SubscriptionExecution13) SetPartialSettlementOfUnitsFromDecimal(value decimal.Decimal) {
+	s.PartialSettlementOfUnits = new(PercentageRate)
+	s.PartialSettlementOfUnits.FromDecimal(value)
+}
+
 func (s *SubscriptionExecution13) SetPartialSettlementOfCash(value string) {
 	s.PartialSettlementOfCash = (*PercentageRate)(&value)
 }
 
+func (s *SubscriptionExecution13) SetPartialSettlementOfCashFromDecimal(value decimal.Decimal) {
+	s.PartialSettlementOfCash = new(PercentageRate)
+	s.PartialSettlementOfCash.FromDecimal(value)
+}
+
 func (s *SubscriptionExecution13) AddStaffClientBreakdown() *InvestmentFundsOrderBreakdown2 {
 	newValue := new(InvestmentFundsOrderBreakdown2)
 	s.StaffClientBreakdown = append(s.StaffClientBreakdown, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a subscription order.
 type SubscriptionExecution3 struct {
 
@@ -85,6 +89,10 @@ type SubscriptionExecution3 struct {
 	CashSettlementDetails *PaymentTransaction16 `xml:"CshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionExecution3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionExecution3) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -262,10 +270,18 @@ func (s *SubscriptionExecution3) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution3) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution3) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution3) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution3) AddTradeDateTime() *DateAndDateTimeChoice {
 	s.TradeDateTime = new(DateAndDateTimeChoice)
 	return s.TradeDateTime
@@ -280,10 +296,20 @@ func (s *SubscriptionExecution3) SetPartiallyExecutedIndicator(value string) {
 	s.PartiallyExecutedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution3) SetPartiallyExecutedIndicatorFromBool(value bool) {
+	s.PartiallyExecutedIndicator = new(YesNoIndicator)
+	s.PartiallyExecutedIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution3) SetCumDividendIndicator(value string) {
 	s.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution3) SetCumDividendIndicatorFromBool(value bool) {
+	s.CumDividendIndicator = new(YesNoIndicator)
+	s.CumDividendIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution3) AddInterimProfitAmount() *ProfitAndLoss1Choice {
 	s.InterimProfitAmount = new(ProfitAndLoss1Choice)
 	return s.InterimProfitAmount
@@ -471,6 +497,11 @@ func (s *SubscriptionExecution3) SetPhysicalDeliveryIndicator(value string) {
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution3) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution3) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	s.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return s.PhysicalDeliveryDetails
@@ -480,10 +511,18 @@ func (s *SubscriptionExecution3) SetRefund(value, currency string) {
 	s.Refund = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution3) SetRefundFromDecimal(value decimal.Decimal, currency string) {
+	s.Refund = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution3) SetSubscriptionInterest(value, currency string) {
 	s.SubscriptionInterest = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution3) SetSubscriptionInterestFromDecimal(value decimal.Decimal, currency string) {
+	s.SubscriptionInterest = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution3) AddCashSettlementDetails() *PaymentTransaction16 {
 	s.CashSettlementDetails = new(PaymentTransaction16)
 	return s.CashSettlementDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a subscription order.
 type SubscriptionExecution4 struct {
 
@@ -88,6 +92,10 @@ type SubscriptionExecution4 struct {
 	CashSettlementDetails *PaymentTransaction13 `xml:"CshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionExecution4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionExecution4) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -260,10 +268,18 @@ func (s *SubscriptionExecution4) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution4) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution4) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution4) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution4) AddTradeDateTime() *DateAndDateTimeChoice {
 	s.TradeDateTime = new(DateAndDateTimeChoice)
 	return s.TradeDateTime
@@ -278,10 +294,20 @@ func (s *SubscriptionExecution4) SetPartiallyExecutedIndicator(value string) {
 	s.PartiallyExecutedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution4) SetPartiallyExecutedIndicatorFromBool(value bool) {
+	s.PartiallyExecutedIndicator = new(YesNoIndicator)
+	s.PartiallyExecutedIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution4) SetCumDividendIndicator(value string) {
 	s.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution4) SetCumDividendIndicatorFromBool(value bool) {
+	s.CumDividendIndicator = new(YesNoIndicator)
+	s.CumDividendIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution4) AddInterimProfitAmount() *ProfitAndLoss1Choice {
 	s.InterimProfitAmount = new(ProfitAndLoss1Choice)
 	return s.InterimProfitAmount
@@ -469,6 +495,11 @@ func (s *SubscriptionExecution4) SetPhysicalDeliveryIndicator(value string) {
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution4) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution4) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	s.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return s.PhysicalDeliveryDetails
@@ -486,10 +517,18 @@ func (s *SubscriptionExecution4) SetRefund(value, currency string) {
 	s.Refund = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution4) SetRefundFromDecimal(value decimal.Decimal, currency string) {
+	s.Refund = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution4) SetSubscriptionInterest(value, currency string) {
 	s.SubscriptionInterest = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution4) SetSubscriptionInterestFromDecimal(value decimal.Decimal, currency string) {
+	s.SubscriptionInterest = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution4) AddCashSettlementDetails() *PaymentTransaction13 {
 	s.CashSettlementDetails = new(PaymentTransaction13)
 	return s.CashSettlementDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a subscription order.
 type SubscriptionExecution5 struct {
 
@@ -132,6 +138,10 @@ type SubscriptionExecution5 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (s *SubscriptionExecution5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionExecution5) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -313,10 +323,18 @@ func (s *SubscriptionExecution5) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution5) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution5) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution5) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution5) AddTradeDateTime() *DateAndDateTimeChoice {
 	s.TradeDateTime = new(DateAndDateTimeChoice)
 	return s.TradeDateTime
@@ -477,18 +495,36 @@ func (s *SubscriptionExecution5) SetSettlementAmount(value, currency string) {
 	s.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution5) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution5) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (s *SubscriptionExecution5) SetSettlementMethod(value string) {
+func (s *SubscriptionExecution5) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
+func (s *SubscriptionExecution5) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SubscriptionExecution5) SetPartiallyExecutedIndicator(value string) {
 	s.PartiallyExecutedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution5) SetPartiallyExecutedIndicatorFromBool(value bool) {
+	s.PartiallyExecutedIndicator = new(YesNoIndicator)
+	s.PartiallyExecutedIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution5) SetBestExecution(value string) {
 	s.BestExecution = (*BestExecution1Code)(&value)
 }
@@ -497,6 +533,11 @@ func (s *SubscriptionExecution5) SetCumDividendIndicator(value string) {
 	s.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution5) SetCumDividendIndicatorFromBool(value bool) {
+	s.CumDividendIndicator = new(YesNoIndicator)
+	s.CumDividendIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution5) AddInterimProfitAmount() *ProfitAndLoss1Choice {
 	s.InterimProfitAmount = new(ProfitAndLoss1Choice)
 	return s.InterimProfitAmount
@@ -684,6 +725,11 @@ func (s *SubscriptionExecution5) SetPhysicalDeliveryIndicator(value string) {
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution5) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution5) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	s.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return s.PhysicalDeliveryDetails
@@ -839,10 +885,18 @@ func (s *SubscriptionExecution5) SetRefund(value, currency string) {
 	s.Refund = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution5) SetRefundFromDecimal(value decimal.Decimal, currency string) {
+	s.Refund = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution5) SetSubscriptionInterest(value, currency string) {
 	s.SubscriptionInterest = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution5) SetSubscriptionInterestFromDecimal(value decimal.Decimal, currency string) {
+	s.SubscriptionInterest = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution5) AddCashSettlementDetails() *PaymentTransaction24 {
 	s.CashSettlementDetails = new(PaymentTransaction24)
 	return s.CashSettlementDetails
@@ -856,6 +910,11 @@ func (s *SubscriptionExecution5) SetPartialSettlementOfUnits(value string) {
 	s.PartialSettlementOfUnits = (*PercentageRate)(&value)
 }
 
+func (s *SubscriptionExecution5) SetPartialSettlementOfUnitsFromDecimal(value decimal.Decimal) {
+	s.PartialSettlementOfUnits = new(PercentageRate)
+	s.PartialSettlementOfUnits.FromDecimal(value)
+}
+
 func (s *SubscriptionExecution5) SetFinancialAdvice(value string) {
 	s.FinancialAdvice = (*FinancialAdvice1Code)(&value)
 }
@@ -872,6 +931,11 @@ func (s *SubscriptionExecution5) SetPartialSettlementOfCash(value string) {
 	s.PartialSettlementOfCash = (*PercentageRate)(&value)
 }
 
+func (s *SubscriptionExecution5) SetPartialSettlementOfCashFromDecimal(value decimal.Decimal) {
+	s.PartialSettlementOfCash = new(PercentageRate)
+	s.PartialSettlementOfCash.FromDecimal(value)
+}
+
 func (s *SubscriptionExecution5) AddRelatedPartyDetails() *Intermediary9 {
 	newValue := new(Intermediary9)
 	s.RelatedPartyDetails = append(s.RelatedPartyDetails, newValue)
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a subscription order.
 type SubscriptionExecution6 struct {
 
@@ -138,6 +144,10 @@ type SubscriptionExecution6 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (s *SubscriptionExecution6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionExecution6) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -315,10 +325,18 @@ func (s *SubscriptionExecution6) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution6) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution6) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution6) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution6) SetRounding(value string) {
 	s.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -483,18 +501,36 @@ func (s *SubscriptionExecution6) SetSettlementAmount(value, currency string) {
 	s.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution6) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution6) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (s *SubscriptionExecution6) SetSettlementMethod(value string) {
+func (s *SubscriptionExecution6) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
+func (s *SubscriptionExecution6) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SubscriptionExecution6) SetPartiallyExecutedIndicator(value string) {
 	s.PartiallyExecutedIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution6) SetPartiallyExecutedIndicatorFromBool(value bool) {
+	s.PartiallyExecutedIndicator = new(YesNoIndicator)
+	s.PartiallyExecutedIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution6) SetBestExecution(value string) {
 	s.BestExecution = (*BestExecution1Code)(&value)
 }
@@ -503,6 +539,11 @@ func (s *SubscriptionExecution6) SetCumDividendIndicator(value string) {
 	s.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution6) SetCumDividendIndicatorFromBool(value bool) {
+	s.CumDividendIndicator = new(YesNoIndicator)
+	s.CumDividendIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution6) AddInterimProfitAmount() *ProfitAndLoss1Choice {
 	s.InterimProfitAmount = new(ProfitAndLoss1Choice)
 	return s.InterimProfitAmount
@@ -690,6 +731,11 @@ func (s *SubscriptionExecution6) SetPhysicalDeliveryIndicator(value string) {
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionExecution6) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SubscriptionExecution6) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	s.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return s.PhysicalDeliveryDetails
@@ -707,10 +753,18 @@ func (s *SubscriptionExecution6) SetRefund(value, currency string) {
 	s.Refund = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution6) SetRefundFromDecimal(value decimal.Decimal, currency string) {
+	s.Refund = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution6) SetSubscriptionInterest(value, currency string) {
 	s.SubscriptionInterest = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionExecution6) SetSubscriptionInterestFromDecimal(value decimal.Decimal, currency string) {
+	s.SubscriptionInterest = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionExecution6) AddCashSettlementDetails() *PaymentTransaction24 {
 	s.CashSettlementDetails = new(PaymentTransaction24)
 	return s.CashSettlementDetails
@@ -724,10 +778,20 @@ func (s *SubscriptionExecution6) SetPartialSettlementOfUnits(value string) {
 	s.PartialSettlementOfUnits = (*PercentageRate)(&value)
 }
 
+func (s *SubscriptionExecution6) SetPartialSettlementOfUnitsFromDecimal(value decimal.Decimal) {
+	s.PartialSettlementOfUnits = new(PercentageRate)
+	s.PartialSettlementOfUnits.FromDecimal(value)
+}
+
 func (s *SubscriptionExecution6) SetPartialSettlementOfCash(value string) {
 	s.PartialSettlementOfCash = (*PercentageRate)(&value)
 }
 
+func (s *SubscriptionExecution6) SetPartialSettlementOfCashFromDecimal(value decimal.Decimal) {
+	s.PartialSettlementOfCash = new(PercentageRate)
+	s.PartialSettlementOfCash.FromDecimal(value)
+}
+
 func (s *SubscriptionExecution6) AddStaffClientBreakdown() *InvestmentFundsOrderBreakdown1 {
 	newValue := new(InvestmentFundsOrderBreakdown1)
 	s.StaffClientBreakdown = append(s.StaffClientBreakdown, newValue)
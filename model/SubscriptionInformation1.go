@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a subscription order.
 type SubscriptionInformation1 struct {
 
@@ -16,18 +22,39 @@ type SubscriptionInformation1 struct {
 	TotalAmountYearToDate *ActiveCurrencyAndAmount `xml:"TtlAmtYrToDt"`
 }
 
+func (s *SubscriptionInformation1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionInformation1) SetDateOfFirstSubscription(value string) {
 	s.DateOfFirstSubscription = (*ISODate)(&value)
 }
 
+func (s *SubscriptionInformation1) SetDateOfFirstSubscriptionFromTime(value time.Time) {
+	s.DateOfFirstSubscription = new(ISODate)
+	s.DateOfFirstSubscription.FromTime(value)
+}
+
 func (s *SubscriptionInformation1) SetEquityComponent(value, currency string) {
 	s.EquityComponent = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionInformation1) SetEquityComponentFromDecimal(value decimal.Decimal, currency string) {
+	s.EquityComponent = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionInformation1) SetCashComponent(value, currency string) {
 	s.CashComponent = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionInformation1) SetCashComponentFromDecimal(value decimal.Decimal, currency string) {
+	s.CashComponent = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionInformation1) SetTotalAmountYearToDate(value, currency string) {
 	s.TotalAmountYearToDate = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (s *SubscriptionInformation1) SetTotalAmountYearToDateFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalAmountYearToDate = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Execution of a subscription order.
 type SubscriptionMultipleExecution2 struct {
 
@@ -25,6 +29,10 @@ type SubscriptionMultipleExecution2 struct {
 	BulkCashSettlementDetails *PaymentTransaction13 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionMultipleExecution2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionMultipleExecution2) SetPlaceOfTrade(value string) {
 	s.PlaceOfTrade = (*CountryCode)(&value)
 }
@@ -33,6 +41,11 @@ func (s *SubscriptionMultipleExecution2) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionMultipleExecution2) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SubscriptionMultipleExecution2) AddCancellationRight() *CancellationRight1 {
 	s.CancellationRight = new(CancellationRight1)
 	return s.CancellationRight
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a subscription order.
 type SubscriptionMultipleExecution3 struct {
 
@@ -41,6 +47,10 @@ type SubscriptionMultipleExecution3 struct {
 	BulkCashSettlementDetails *PaymentTransaction24 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionMultipleExecution3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionMultipleExecution3) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
@@ -54,10 +64,20 @@ func (s *SubscriptionMultipleExecution3) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionMultipleExecution3) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SubscriptionMultipleExecution3) SetRequestedFutureTradeDate(value string) {
 	s.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (s *SubscriptionMultipleExecution3) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	s.RequestedFutureTradeDate = new(ISODate)
+	s.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (s *SubscriptionMultipleExecution3) SetCancellationRight(value string) {
 	s.CancellationRight = (*CancellationRight1Code)(&value)
 }
@@ -226,10 +246,19 @@ func (s *SubscriptionMultipleExecution3) SetTotalSettlementAmount(value, currenc
 	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionMultipleExecution3) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionMultipleExecution3) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (s *SubscriptionMultipleExecution3) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
 func (s *SubscriptionMultipleExecution3) AddBulkCashSettlementDetails() *PaymentTransaction24 {
 	s.BulkCashSettlementDetails = new(PaymentTransaction24)
 	return s.BulkCashSettlementDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a subscription order.
 type SubscriptionMultipleExecution5 struct {
 
@@ -41,10 +47,19 @@ type SubscriptionMultipleExecution5 struct {
 	BulkCashSettlementDetails *PaymentTransaction70 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionMultipleExecution5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionMultipleExecution5) SetAmendmentIndicator(value string) {
 	s.AmendmentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionMultipleExecution5) SetAmendmentIndicatorFromBool(value bool) {
+	s.AmendmentIndicator = new(YesNoIndicator)
+	s.AmendmentIndicator.FromBool(value)
+}
+
 func (s *SubscriptionMultipleExecution5) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
@@ -58,14 +73,29 @@ func (s *SubscriptionMultipleExecution5) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionMultipleExecution5) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SubscriptionMultipleExecution5) SetReceivedDateTime(value string) {
 	s.ReceivedDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionMultipleExecution5) SetReceivedDateTimeFromTime(value time.Time) {
+	s.ReceivedDateTime = new(ISODateTime)
+	s.ReceivedDateTime.FromTime(value)
+}
+
 func (s *SubscriptionMultipleExecution5) SetRequestedFutureTradeDate(value string) {
 	s.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (s *SubscriptionMultipleExecution5) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	s.RequestedFutureTradeDate = new(ISODate)
+	s.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (s *SubscriptionMultipleExecution5) AddCancellationRight() *CancellationRight1Choice {
 	s.CancellationRight = new(CancellationRight1Choice)
 	return s.CancellationRight
@@ -372,6 +402,10 @@ func (s *SubscriptionMultipleExecution5) SetTotalSettlementAmount(value, currenc
 	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionMultipleExecution5) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionMultipleExecution5) AddBulkCashSettlementDetails() *PaymentTransaction70 {
 	s.BulkCashSettlementDetails = new(PaymentTransaction70)
 	return s.BulkCashSettlementDetails
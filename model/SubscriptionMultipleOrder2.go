@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Order to invest the investor's principal in an investment fund.
 type SubscriptionMultipleOrder2 struct {
 
@@ -28,6 +32,10 @@ type SubscriptionMultipleOrder2 struct {
 	BulkCashSettlementDetails *PaymentTransaction19 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionMultipleOrder2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionMultipleOrder2) SetPlaceOfTrade(value string) {
 	s.PlaceOfTrade = (*CountryCode)(&value)
 }
@@ -36,10 +44,20 @@ func (s *SubscriptionMultipleOrder2) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionMultipleOrder2) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SubscriptionMultipleOrder2) SetExpiryDateTime(value string) {
 	s.ExpiryDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionMultipleOrder2) SetExpiryDateTimeFromTime(value time.Time) {
+	s.ExpiryDateTime = new(ISODateTime)
+	s.ExpiryDateTime.FromTime(value)
+}
+
 func (s *SubscriptionMultipleOrder2) AddCancellationRight() *CancellationRight1 {
 	s.CancellationRight = new(CancellationRight1)
 	return s.CancellationRight
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Order to invest the investor's principal in an investment fund.
 type SubscriptionMultipleOrder3 struct {
 
@@ -44,6 +50,10 @@ type SubscriptionMultipleOrder3 struct {
 	BulkCashSettlementDetails *PaymentTransaction23 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionMultipleOrder3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionMultipleOrder3) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
@@ -57,6 +67,11 @@ func (s *SubscriptionMultipleOrder3) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionMultipleOrder3) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SubscriptionMultipleOrder3) AddExpiryDateTime() *DateAndDateTimeChoice {
 	s.ExpiryDateTime = new(DateAndDateTimeChoice)
 	return s.ExpiryDateTime
@@ -66,6 +81,11 @@ func (s *SubscriptionMultipleOrder3) SetRequestedFutureTradeDate(value string) {
 	s.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (s *SubscriptionMultipleOrder3) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	s.RequestedFutureTradeDate = new(ISODate)
+	s.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (s *SubscriptionMultipleOrder3) SetCancellationRight(value string) {
 	s.CancellationRight = (*CancellationRight1Code)(&value)
 }
@@ -234,10 +254,19 @@ func (s *SubscriptionMultipleOrder3) SetTotalSettlementAmount(value, currency st
 	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionMultipleOrder3) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionMultipleOrder3) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (s *SubscriptionMultipleOrder3) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
 func (s *SubscriptionMultipleOrder3) AddBulkCashSettlementDetails() *PaymentTransaction23 {
 	s.BulkCashSettlementDetails = new(PaymentTransaction23)
 	return s.BulkCashSettlementDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Order to invest the investor's principal in an investment fund.
 type SubscriptionMultipleOrder4 struct {
 
@@ -43,6 +49,10 @@ type SubscriptionMultipleOrder4 struct {
 	BulkCashSettlementDetails *PaymentTransaction23 `xml:"BlkCshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionMultipleOrder4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionMultipleOrder4) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
@@ -56,6 +66,11 @@ func (s *SubscriptionMultipleOrder4) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionMultipleOrder4) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SubscriptionMultipleOrder4) AddExpiryDateTime() *DateAndDateTimeChoice {
 	s.ExpiryDateTime = new(DateAndDateTimeChoice)
 	return s.ExpiryDateTime
@@ -65,6 +80,11 @@ func (s *SubscriptionMultipleOrder4) SetRequestedFutureTradeDate(value string) {
 	s.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (s *SubscriptionMultipleOrder4) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	s.RequestedFutureTradeDate = new(ISODate)
+	s.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (s *SubscriptionMultipleOrder4) SetCancellationRight(value string) {
 	s.CancellationRight = (*CancellationRight1Code)(&value)
 }
@@ -233,10 +253,19 @@ func (s *SubscriptionMultipleOrder4) SetTotalSettlementAmount(value, currency st
 	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionMultipleOrder4) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionMultipleOrder4) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
+func (s *SubscriptionMultipleOrder4) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
 func (s *SubscriptionMultipleOrder4) AddBulkCashSettlementDetails() *PaymentTransaction23 {
 	s.BulkCashSettlementDetails = new(PaymentTransaction23)
 	return s.BulkCashSettlementDetails
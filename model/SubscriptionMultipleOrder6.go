@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Order to invest the investor's principal in an investment fund.
 type SubscriptionMultipleOrder6 struct {
 
@@ -37,6 +43,10 @@ type SubscriptionMultipleOrder6 struct {
 	TotalSettlementAmount *ActiveCurrencyAndAmount `xml:"TtlSttlmAmt,omitempty"`
 }
 
+func (s *SubscriptionMultipleOrder6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionMultipleOrder6) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
@@ -50,6 +60,11 @@ func (s *SubscriptionMultipleOrder6) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SubscriptionMultipleOrder6) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SubscriptionMultipleOrder6) AddExpiryDateTime() *DateAndDateTimeChoice {
 	s.ExpiryDateTime = new(DateAndDateTimeChoice)
 	return s.ExpiryDateTime
@@ -59,6 +74,11 @@ func (s *SubscriptionMultipleOrder6) SetRequestedFutureTradeDate(value string) {
 	s.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (s *SubscriptionMultipleOrder6) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	s.RequestedFutureTradeDate = new(ISODate)
+	s.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (s *SubscriptionMultipleOrder6) AddCancellationRight() *CancellationRight1Choice {
 	s.CancellationRight = new(CancellationRight1Choice)
 	return s.CancellationRight
@@ -369,3 +389,7 @@ func (s *SubscriptionMultipleOrder6) AddBulkCashSettlementDetails() *PaymentTran
 func (s *SubscriptionMultipleOrder6) SetTotalSettlementAmount(value, currency string) {
 	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (s *SubscriptionMultipleOrder6) SetTotalSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalSettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
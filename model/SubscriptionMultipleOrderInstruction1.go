@@ -16,6 +16,10 @@ type SubscriptionMultipleOrderInstruction1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (s *SubscriptionMultipleOrderInstruction1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionMultipleOrderInstruction1) AddMultipleOrderDetails() *SubscriptionMultipleOrder2 {
 	s.MultipleOrderDetails = new(SubscriptionMultipleOrder2)
 	return s.MultipleOrderDetails
@@ -13,6 +13,10 @@ type SubscriptionMultipleOrderInstruction2 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (s *SubscriptionMultipleOrderInstruction2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionMultipleOrderInstruction2) AddMultipleOrderDetails() *SubscriptionMultipleOrder3 {
 	s.MultipleOrderDetails = new(SubscriptionMultipleOrder3)
 	return s.MultipleOrderDetails
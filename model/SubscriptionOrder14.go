@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Order to invest the investor's principal in an investment fund.
 type SubscriptionOrder14 struct {
 
@@ -111,6 +117,10 @@ type SubscriptionOrder14 struct {
 	OrderWaiverDetails *OrderWaiver1 `xml:"OrdrWvrDtls,omitempty"`
 }
 
+func (s *SubscriptionOrder14) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionOrder14) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -288,12 +298,25 @@ func (s *SubscriptionOrder14) SetSettlementAmount(value, currency string) {
 	s.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionOrder14) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionOrder14) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (s *SubscriptionOrder14) SetSettlementMethod(value string) {
+func (s *SubscriptionOrder14) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
+func (s *SubscriptionOrder14) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SubscriptionOrder14) AddForeignExchangeDetails() *ForeignExchangeTerms32 {
@@ -327,6 +350,11 @@ func (s *SubscriptionOrder14) SetPhysicalDeliveryIndicator(value string) {
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionOrder14) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SubscriptionOrder14) AddPhysicalDeliveryDetails() *NameAndAddress4 {
 	s.PhysicalDeliveryDetails = new(NameAndAddress4)
 	return s.PhysicalDeliveryDetails
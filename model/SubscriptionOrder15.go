@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Order to invest the investor's principal in an investment fund.
 type SubscriptionOrder15 struct {
 
@@ -103,6 +109,10 @@ type SubscriptionOrder15 struct {
 	OrderWaiverDetails *OrderWaiver1 `xml:"OrdrWvrDtls,omitempty"`
 }
 
+func (s *SubscriptionOrder15) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionOrder15) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -270,12 +280,25 @@ func (s *SubscriptionOrder15) SetSettlementAmount(value, currency string) {
 	s.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionOrder15) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionOrder15) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (s *SubscriptionOrder15) SetSettlementMethod(value string) {
+func (s *SubscriptionOrder15) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
+func (s *SubscriptionOrder15) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SubscriptionOrder15) SetIncomePreference(value string) {
@@ -460,6 +483,11 @@ func (s *SubscriptionOrder15) SetPhysicalDeliveryIndicator(value string) {
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionOrder15) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SubscriptionOrder15) AddPhysicalDeliveryDetails() *NameAndAddress4 {
 	s.PhysicalDeliveryDetails = new(NameAndAddress4)
 	return s.PhysicalDeliveryDetails
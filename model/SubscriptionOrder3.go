@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Order to invest the investor's principal in an investment fund.
 type SubscriptionOrder3 struct {
 
@@ -61,6 +65,10 @@ type SubscriptionOrder3 struct {
 	CashSettlementDetails *PaymentTransaction17 `xml:"CshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionOrder3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionOrder3) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -230,6 +238,10 @@ func (s *SubscriptionOrder3) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionOrder3) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionOrder3) SetRounding(value string) {
 	s.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -238,6 +250,10 @@ func (s *SubscriptionOrder3) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionOrder3) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionOrder3) AddForeignExchangeDetails() *ForeignExchangeTerms5 {
 	s.ForeignExchangeDetails = new(ForeignExchangeTerms5)
 	return s.ForeignExchangeDetails
@@ -702,6 +718,11 @@ func (s *SubscriptionOrder3) SetPhysicalDeliveryIndicator(value string) {
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionOrder3) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SubscriptionOrder3) AddPhysicalDeliveryDetails() *NameAndAddress4 {
 	s.PhysicalDeliveryDetails = new(NameAndAddress4)
 	return s.PhysicalDeliveryDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Order to invest the investor's principal in an investment fund.
 type SubscriptionOrder4 struct {
 
@@ -64,6 +68,10 @@ type SubscriptionOrder4 struct {
 	CashSettlementDetails *PaymentTransaction19 `xml:"CshSttlmDtls,omitempty"`
 }
 
+func (s *SubscriptionOrder4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionOrder4) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -228,6 +236,10 @@ func (s *SubscriptionOrder4) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionOrder4) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionOrder4) SetRounding(value string) {
 	s.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -236,6 +248,10 @@ func (s *SubscriptionOrder4) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionOrder4) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionOrder4) AddForeignExchangeDetails() *ForeignExchangeTerms5 {
 	s.ForeignExchangeDetails = new(ForeignExchangeTerms5)
 	return s.ForeignExchangeDetails
@@ -700,6 +716,11 @@ func (s *SubscriptionOrder4) SetPhysicalDeliveryIndicator(value string) {
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionOrder4) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SubscriptionOrder4) AddPhysicalDeliveryDetails() *NameAndAddress4 {
 	s.PhysicalDeliveryDetails = new(NameAndAddress4)
 	return s.PhysicalDeliveryDetails
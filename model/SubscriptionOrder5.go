@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Order to invest the investor's principal in an investment fund.
 type SubscriptionOrder5 struct {
 
@@ -96,6 +102,10 @@ type SubscriptionOrder5 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (s *SubscriptionOrder5) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionOrder5) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -268,10 +278,18 @@ func (s *SubscriptionOrder5) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionOrder5) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionOrder5) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionOrder5) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionOrder5) SetRounding(value string) {
 	s.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -280,12 +298,25 @@ func (s *SubscriptionOrder5) SetSettlementAmount(value, currency string) {
 	s.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionOrder5) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionOrder5) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (s *SubscriptionOrder5) SetSettlementMethod(value string) {
+func (s *SubscriptionOrder5) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
+func (s *SubscriptionOrder5) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SubscriptionOrder5) SetIncomePreference(value string) {
@@ -757,6 +788,11 @@ func (s *SubscriptionOrder5) SetPhysicalDeliveryIndicator(value string) {
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionOrder5) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SubscriptionOrder5) AddPhysicalDeliveryDetails() *NameAndAddress4 {
 	s.PhysicalDeliveryDetails = new(NameAndAddress4)
 	return s.PhysicalDeliveryDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Order to invest the investor's principal in an investment fund.
 type SubscriptionOrder6 struct {
 
@@ -102,6 +108,10 @@ type SubscriptionOrder6 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (s *SubscriptionOrder6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionOrder6) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -279,10 +289,18 @@ func (s *SubscriptionOrder6) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionOrder6) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionOrder6) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionOrder6) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionOrder6) SetRounding(value string) {
 	s.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -291,12 +309,25 @@ func (s *SubscriptionOrder6) SetSettlementAmount(value, currency string) {
 	s.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionOrder6) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionOrder6) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (s *SubscriptionOrder6) SetSettlementMethod(value string) {
+func (s *SubscriptionOrder6) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
+func (s *SubscriptionOrder6) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SubscriptionOrder6) AddForeignExchangeDetails() *ForeignExchangeTerms6 {
@@ -763,6 +794,11 @@ func (s *SubscriptionOrder6) SetPhysicalDeliveryIndicator(value string) {
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionOrder6) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SubscriptionOrder6) AddPhysicalDeliveryDetails() *NameAndAddress4 {
 	s.PhysicalDeliveryDetails = new(NameAndAddress4)
 	return s.PhysicalDeliveryDetails
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Order to invest the investor's principal in an investment fund.
 type SubscriptionOrder7 struct {
 
@@ -93,6 +99,10 @@ type SubscriptionOrder7 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (s *SubscriptionOrder7) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionOrder7) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -256,10 +266,18 @@ func (s *SubscriptionOrder7) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionOrder7) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionOrder7) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionOrder7) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionOrder7) SetRounding(value string) {
 	s.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -268,12 +286,25 @@ func (s *SubscriptionOrder7) SetSettlementAmount(value, currency string) {
 	s.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SubscriptionOrder7) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SubscriptionOrder7) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (s *SubscriptionOrder7) SetSettlementMethod(value string) {
+func (s *SubscriptionOrder7) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
+func (s *SubscriptionOrder7) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SubscriptionOrder7) SetIncomePreference(value string) {
@@ -750,6 +781,11 @@ func (s *SubscriptionOrder7) SetPhysicalDeliveryIndicator(value string) {
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionOrder7) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SubscriptionOrder7) AddPhysicalDeliveryDetails() *NameAndAddress4 {
 	s.PhysicalDeliveryDetails = new(NameAndAddress4)
 	return s.PhysicalDeliveryDetails
@@ -16,10 +16,19 @@ type SubscriptionOrderConfirmation1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (s *SubscriptionOrderConfirmation1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubscriptionOrderConfirmation1) SetAmendmentIndicator(value string) {
 	s.AmendmentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SubscriptionOrderConfirmation1) SetAmendmentIndicatorFromBool(value bool) {
+	s.AmendmentIndicator = new(YesNoIndicator)
+	s.AmendmentIndicator.FromBool(value)
+}
+
 func (s *SubscriptionOrderConfirmation1) AddMultipleExecutionDetails() *SubscriptionMultipleExecution3 {
 	s.MultipleExecutionDetails = new(SubscriptionMultipleExecution3)
 	return s.MultipleExecutionDetails
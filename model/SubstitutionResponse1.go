@@ -13,6 +13,10 @@ type SubstitutionResponse1 struct {
 	CollateralSubstitutionRejectionDetails *CollateralSubstitutionResponse2 `xml:"CollSbstitnRjctnDtls,omitempty"`
 }
 
+func (s *SubstitutionResponse1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SubstitutionResponse1) SetResponseType(value string) {
 	s.ResponseType = (*Status4Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information related to the collateral position, that is, the identification of the exposed party, the total exposure amount and the total collateral amount held by the taker. It also contains the valuation dates and the requested settlement date of collateral if there is a shortage of collateral.
 type Summary1 struct {
 
@@ -31,14 +37,26 @@ type Summary1 struct {
 	SummaryDetails *SummaryAmounts1 `xml:"SummryDtls,omitempty"`
 }
 
+func (s *Summary1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *Summary1) SetExposedAmountPartyA(value, currency string) {
 	s.ExposedAmountPartyA = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *Summary1) SetExposedAmountPartyAFromDecimal(value decimal.Decimal, currency string) {
+	s.ExposedAmountPartyA = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *Summary1) SetExposedAmountPartyB(value, currency string) {
 	s.ExposedAmountPartyB = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *Summary1) SetExposedAmountPartyBFromDecimal(value decimal.Decimal, currency string) {
+	s.ExposedAmountPartyB = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *Summary1) SetExposureType(value string) {
 	s.ExposureType = (*ExposureType1Code)(&value)
 }
@@ -47,10 +65,18 @@ func (s *Summary1) SetTotalValueOfCollateral(value, currency string) {
 	s.TotalValueOfCollateral = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *Summary1) SetTotalValueOfCollateralFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalValueOfCollateral = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *Summary1) SetNetExcessDeficit(value, currency string) {
 	s.NetExcessDeficit = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *Summary1) SetNetExcessDeficitFromDecimal(value decimal.Decimal, currency string) {
+	s.NetExcessDeficit = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *Summary1) SetNetExcessDeficitIndicator(value string) {
 	s.NetExcessDeficitIndicator = (*ShortLong1Code)(&value)
 }
@@ -59,10 +85,20 @@ func (s *Summary1) SetValuationDateTime(value string) {
 	s.ValuationDateTime = (*ISODateTime)(&value)
 }
 
+func (s *Summary1) SetValuationDateTimeFromTime(value time.Time) {
+	s.ValuationDateTime = new(ISODateTime)
+	s.ValuationDateTime.FromTime(value)
+}
+
 func (s *Summary1) SetRequestedSettlementDate(value string) {
 	s.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (s *Summary1) SetRequestedSettlementDateFromTime(value time.Time) {
+	s.RequestedSettlementDate = new(ISODate)
+	s.RequestedSettlementDate.FromTime(value)
+}
+
 func (s *Summary1) AddSummaryDetails() *SummaryAmounts1 {
 	s.SummaryDetails = new(SummaryAmounts1)
 	return s.SummaryDetails
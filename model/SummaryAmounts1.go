@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides amounts taken in to account to calculate the collateral position.
 type SummaryAmounts1 struct {
 
@@ -43,10 +47,18 @@ type SummaryAmounts1 struct {
 	TotalFees *ActiveCurrencyAndAmount `xml:"TtlFees,omitempty"`
 }
 
+func (s *SummaryAmounts1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SummaryAmounts1) SetThresholdAmount(value, currency string) {
 	s.ThresholdAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SummaryAmounts1) SetThresholdAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.ThresholdAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SummaryAmounts1) SetThresholdType(value string) {
 	s.ThresholdType = (*ThresholdType1Code)(&value)
 }
@@ -55,42 +67,86 @@ func (s *SummaryAmounts1) SetPreHaircutCollateralValue(value, currency string) {
 	s.PreHaircutCollateralValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SummaryAmounts1) SetPreHaircutCollateralValueFromDecimal(value decimal.Decimal, currency string) {
+	s.PreHaircutCollateralValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SummaryAmounts1) SetAdjustedExposure(value, currency string) {
 	s.AdjustedExposure = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SummaryAmounts1) SetAdjustedExposureFromDecimal(value decimal.Decimal, currency string) {
+	s.AdjustedExposure = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SummaryAmounts1) SetCollateralRequired(value, currency string) {
 	s.CollateralRequired = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SummaryAmounts1) SetCollateralRequiredFromDecimal(value decimal.Decimal, currency string) {
+	s.CollateralRequired = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SummaryAmounts1) SetMinimumTransferAmount(value, currency string) {
 	s.MinimumTransferAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SummaryAmounts1) SetMinimumTransferAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.MinimumTransferAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SummaryAmounts1) SetRoundingAmount(value, currency string) {
 	s.RoundingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SummaryAmounts1) SetRoundingAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.RoundingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SummaryAmounts1) SetPreviousExposureValue(value, currency string) {
 	s.PreviousExposureValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SummaryAmounts1) SetPreviousExposureValueFromDecimal(value decimal.Decimal, currency string) {
+	s.PreviousExposureValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SummaryAmounts1) SetPreviousCollateralValue(value, currency string) {
 	s.PreviousCollateralValue = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SummaryAmounts1) SetPreviousCollateralValueFromDecimal(value decimal.Decimal, currency string) {
+	s.PreviousCollateralValue = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SummaryAmounts1) SetTotalPendingIncomingCollateral(value, currency string) {
 	s.TotalPendingIncomingCollateral = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SummaryAmounts1) SetTotalPendingIncomingCollateralFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalPendingIncomingCollateral = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SummaryAmounts1) SetTotalPendingOutgoingCollateral(value, currency string) {
 	s.TotalPendingOutgoingCollateral = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SummaryAmounts1) SetTotalPendingOutgoingCollateralFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalPendingOutgoingCollateral = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SummaryAmounts1) SetTotalAccruedInterestAmount(value, currency string) {
 	s.TotalAccruedInterestAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SummaryAmounts1) SetTotalAccruedInterestAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalAccruedInterestAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SummaryAmounts1) SetTotalFees(value, currency string) {
 	s.TotalFees = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (s *SummaryAmounts1) SetTotalFeesFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalFees = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Additional information that can not be captured in the structured fields and/or any other specific block.
 type SupplementaryData1 struct {
 
@@ -11,6 +13,10 @@ type SupplementaryData1 struct {
 	Envelope *SupplementaryDataEnvelope1 `xml:"Envlp"`
 }
 
+func (s *SupplementaryData1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SupplementaryData1) SetPlaceAndName(value string) {
 	s.PlaceAndName = (*Max350Text)(&value)
 }
@@ -19,3 +25,44 @@ func (s *SupplementaryData1) AddEnvelope() *SupplementaryDataEnvelope1 {
 	s.Envelope = new(SupplementaryDataEnvelope1)
 	return s.Envelope
 }
+
+func (s *SupplementaryData1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if s.PlaceAndName != nil {
+		enc.Element("PlcAndNm", string(*s.PlaceAndName))
+	}
+	if s.Envelope != nil {
+		s.Envelope.EncodeXMLFast(enc, "Envlp")
+	}
+	enc.End(tag)
+}
+
+func (s *SupplementaryData1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "PlcAndNm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			s.PlaceAndName = (*Max350Text)(&value)
+		case "Envlp":
+			elem := new(SupplementaryDataEnvelope1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			s.Envelope = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
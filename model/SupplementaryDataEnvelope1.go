@@ -1,3 +1,61 @@
 package model
 
-type SupplementaryDataEnvelope1 string
+import (
+	"encoding/xml"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Technical component that contains the validated supplementary data information. This technical envelope allows to segregate the supplementary data information from any other information.
+// The content is kept as raw XML, typically the Document of a supplementary data message definition.
+type SupplementaryDataEnvelope1 struct {
+	Value string `xml:",innerxml"`
+}
+
+func (s *SupplementaryDataEnvelope1) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SupplementaryDataEnvelope1) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	value, err := innerXML(d, start)
+	if err != nil {
+		return err
+	}
+	s.Value = value
+	return nil
+}
+
+// Embed replaces the content with the XML encoding of v.
+func (s *SupplementaryDataEnvelope1) Embed(v interface{}) error {
+	value, err := embedXML(v)
+	if err != nil {
+		return err
+	}
+	s.Value = value
+	return nil
+}
+
+// Extract decodes the content into v.
+func (s *SupplementaryDataEnvelope1) Extract(v interface{}) error {
+	return extractXML(s.Value, v)
+}
+
+// Namespace returns the namespace of the first element of the content.
+func (s *SupplementaryDataEnvelope1) Namespace() string {
+	return rootNamespace(s.Value)
+}
+
+func (s *SupplementaryDataEnvelope1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	enc.Raw(s.Value)
+	enc.End(tag)
+}
+
+func (s *SupplementaryDataEnvelope1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	value, err := innerXMLFast(dec)
+	if err != nil {
+		return err
+	}
+	s.Value = value
+	return nil
+}
@@ -31,6 +31,10 @@ type SupportingDocument1 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SupportingDocument1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SupportingDocument1) SetSupportingDocumentIdentification(value string) {
 	s.SupportingDocumentIdentification = (*Max35Text)(&value)
 }
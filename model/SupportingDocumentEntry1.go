@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Currency control document entry supporting the contract registration.
 type SupportingDocumentEntry1 struct {
 
@@ -36,6 +40,10 @@ type SupportingDocumentEntry1 struct {
 	Attachment []*DocumentGeneralInformation3 `xml:"Attchmnt,omitempty"`
 }
 
+func (s *SupportingDocumentEntry1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SupportingDocumentEntry1) SetEntryIdentification(value string) {
 	s.EntryIdentification = (*Max35Text)(&value)
 }
@@ -53,18 +61,34 @@ func (s *SupportingDocumentEntry1) SetTotalAmount(value, currency string) {
 	s.TotalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SupportingDocumentEntry1) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SupportingDocumentEntry1) SetTotalAmountAfterShipment(value, currency string) {
 	s.TotalAmountAfterShipment = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SupportingDocumentEntry1) SetTotalAmountAfterShipmentFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalAmountAfterShipment = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SupportingDocumentEntry1) SetTotalAmountInContractCurrency(value, currency string) {
 	s.TotalAmountInContractCurrency = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SupportingDocumentEntry1) SetTotalAmountInContractCurrencyFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalAmountInContractCurrency = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SupportingDocumentEntry1) SetTotalAmountAfterShipmentInContractCurrency(value, currency string) {
 	s.TotalAmountAfterShipmentInContractCurrency = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SupportingDocumentEntry1) SetTotalAmountAfterShipmentInContractCurrencyFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalAmountAfterShipmentInContractCurrency = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SupportingDocumentEntry1) AddShipmentAttributes() *ShipmentAttribute1 {
 	s.ShipmentAttributes = new(ShipmentAttribute1)
 	return s.ShipmentAttributes
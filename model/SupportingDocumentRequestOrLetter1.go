@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Currency control related document or letter supporting the contract registration.
 type SupportingDocumentRequestOrLetter1 struct {
 
@@ -42,6 +46,10 @@ type SupportingDocumentRequestOrLetter1 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (s *SupportingDocumentRequestOrLetter1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SupportingDocumentRequestOrLetter1) SetRequestOrLetterIdentification(value string) {
 	s.RequestOrLetterIdentification = (*Max35Text)(&value)
 }
@@ -50,6 +58,11 @@ func (s *SupportingDocumentRequestOrLetter1) SetDate(value string) {
 	s.Date = (*ISODate)(&value)
 }
 
+func (s *SupportingDocumentRequestOrLetter1) SetDateFromTime(value time.Time) {
+	s.Date = new(ISODate)
+	s.Date.FromTime(value)
+}
+
 func (s *SupportingDocumentRequestOrLetter1) AddSender() *Party28Choice {
 	s.Sender = new(Party28Choice)
 	return s.Sender
@@ -222,10 +235,20 @@ func (s *SupportingDocumentRequestOrLetter1) SetResponseRequired(value string) {
 	s.ResponseRequired = (*TrueFalseIndicator)(&value)
 }
 
+func (s *SupportingDocumentRequestOrLetter1) SetResponseRequiredFromBool(value bool) {
+	s.ResponseRequired = new(TrueFalseIndicator)
+	s.ResponseRequired.FromBool(value)
+}
+
 func (s *SupportingDocumentRequestOrLetter1) SetDueDate(value string) {
 	s.DueDate = (*ISODate)(&value)
 }
 
+func (s *SupportingDocumentRequestOrLetter1) SetDueDateFromTime(value time.Time) {
+	s.DueDate = new(ISODate)
+	s.DueDate.FromTime(value)
+}
+
 func (s *SupportingDocumentRequestOrLetter1) AddAttachment() *DocumentGeneralInformation3 {
 	newValue := new(DocumentGeneralInformation3)
 	s.Attachment = append(s.Attachment, newValue)
@@ -13,8 +13,16 @@ type SuspendedStatus1 struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
-func (s *SuspendedStatus1) SetNoReason(value string) {
+func (s *SuspendedStatus1) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SuspendedStatus1) SetNoReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	s.NoReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (s *SuspendedStatus1) AddReason() *SuspendedStatusReason1 {
@@ -10,8 +10,16 @@ type SuspendedStatus2 struct {
 	ReasonDetails []*SuspendedStatusReason2 `xml:"RsnDtls"`
 }
 
-func (s *SuspendedStatus2) SetNoSpecifiedReason(value string) {
+func (s *SuspendedStatus2) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SuspendedStatus2) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	s.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (s *SuspendedStatus2) AddReasonDetails() *SuspendedStatusReason2 {
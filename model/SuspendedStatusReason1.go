@@ -10,6 +10,10 @@ type SuspendedStatusReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (s *SuspendedStatusReason1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SuspendedStatusReason1) AddStructured(value string) {
 	s.Structured = append(s.Structured, (*SuspendedStatusReason2Code)(&value))
 }
@@ -16,6 +16,10 @@ type SuspendedStatusReason2 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (s *SuspendedStatusReason2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SuspendedStatusReason2) SetReason(value string) {
 	s.Reason = (*SuspendedStatusReason3Code)(&value)
 }
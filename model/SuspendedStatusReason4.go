@@ -10,6 +10,10 @@ type SuspendedStatusReason4 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (s *SuspendedStatusReason4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SuspendedStatusReason4) AddReason() *SuspendedStatusReason5Choice {
 	s.Reason = new(SuspendedStatusReason5Choice)
 	return s.Reason
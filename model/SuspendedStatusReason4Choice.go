@@ -10,8 +10,16 @@ type SuspendedStatusReason4Choice struct {
 	ReasonDetails []*SuspendedStatusReason4 `xml:"RsnDtls"`
 }
 
-func (s *SuspendedStatusReason4Choice) SetNoSpecifiedReason(value string) {
+func (s *SuspendedStatusReason4Choice) Validate() error {
+	return ValidateElement(s)
+}
+
+func (s *SuspendedStatusReason4Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	s.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (s *SuspendedStatusReason4Choice) AddReasonDetails() *SuspendedStatusReason4 {
@@ -10,6 +10,10 @@ type SuspendedStatusReason5Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (s *SuspendedStatusReason5Choice) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SuspendedStatusReason5Choice) SetCode(value string) {
 	s.Code = (*SuspendedStatusReason3Code)(&value)
 }
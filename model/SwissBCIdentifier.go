@@ -1,3 +1,9 @@
 package model
 
 type SwissBCIdentifier string
+
+var swissBCIdentifierPattern = newPattern(`SW[0-9]{3,5}`)
+
+func (s SwissBCIdentifier) Validate() error {
+	return validatePattern("SwissBCIdentifier", string(s), swissBCIdentifierPattern)
+}
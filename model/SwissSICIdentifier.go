@@ -1,3 +1,9 @@
 package model
 
 type SwissSICIdentifier string
+
+var swissSICIdentifierPattern = newPattern(`SW[0-9]{6,6}`)
+
+func (s SwissSICIdentifier) Validate() error {
+	return validatePattern("SwissSICIdentifier", string(s), swissSICIdentifierPattern)
+}
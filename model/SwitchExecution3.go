@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a switch order.
 type SwitchExecution3 struct {
 
@@ -40,10 +46,19 @@ type SwitchExecution3 struct {
 	ForeignExchangeDetails []*ForeignExchangeTerms4 `xml:"FXDtls,omitempty"`
 }
 
+func (s *SwitchExecution3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchExecution3) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SwitchExecution3) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SwitchExecution3) SetDealReference(value string) {
 	s.DealReference = (*Max35Text)(&value)
 }
@@ -61,18 +76,34 @@ func (s *SwitchExecution3) SetTotalRedemptionAmount(value, currency string) {
 	s.TotalRedemptionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchExecution3) SetTotalRedemptionAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalRedemptionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchExecution3) SetTotalSubscriptionAmount(value, currency string) {
 	s.TotalSubscriptionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchExecution3) SetTotalSubscriptionAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalSubscriptionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchExecution3) SetAdditionalCashIn(value, currency string) {
 	s.AdditionalCashIn = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchExecution3) SetAdditionalCashInFromDecimal(value decimal.Decimal, currency string) {
+	s.AdditionalCashIn = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchExecution3) SetResultingCashOut(value, currency string) {
 	s.ResultingCashOut = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchExecution3) SetResultingCashOutFromDecimal(value decimal.Decimal, currency string) {
+	s.ResultingCashOut = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchExecution3) AddRedemptionLegDetails() *SwitchRedemptionLegExecution2 {
 	newValue := new(SwitchRedemptionLegExecution2)
 	s.RedemptionLegDetails = append(s.RedemptionLegDetails, newValue)
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a switch order.
 type SwitchExecution4 struct {
 
@@ -74,6 +80,10 @@ type SwitchExecution4 struct {
 	LateReport *LateReport1Code `xml:"LateRpt,omitempty"`
 }
 
+func (s *SwitchExecution4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchExecution4) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
@@ -82,6 +92,11 @@ func (s *SwitchExecution4) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SwitchExecution4) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SwitchExecution4) AddInvestmentAccountDetails() *InvestmentAccount21 {
 	s.InvestmentAccountDetails = new(InvestmentAccount21)
 	return s.InvestmentAccountDetails
@@ -103,10 +118,18 @@ func (s *SwitchExecution4) SetTotalRedemptionAmount(value, currency string) {
 	s.TotalRedemptionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchExecution4) SetTotalRedemptionAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalRedemptionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchExecution4) SetTotalSubscriptionAmount(value, currency string) {
 	s.TotalSubscriptionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchExecution4) SetTotalSubscriptionAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalSubscriptionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchExecution4) AddRelatedPartyDetails() *Intermediary9 {
 	newValue := new(Intermediary9)
 	s.RelatedPartyDetails = append(s.RelatedPartyDetails, newValue)
@@ -257,24 +280,50 @@ func (s *SwitchExecution4) SetRequestedFutureTradeDate(value string) {
 	s.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (s *SwitchExecution4) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	s.RequestedFutureTradeDate = new(ISODate)
+	s.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (s *SwitchExecution4) SetAdditionalCashIn(value, currency string) {
 	s.AdditionalCashIn = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchExecution4) SetAdditionalCashInFromDecimal(value decimal.Decimal, currency string) {
+	s.AdditionalCashIn = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchExecution4) SetResultingCashOut(value, currency string) {
 	s.ResultingCashOut = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchExecution4) SetResultingCashOutFromDecimal(value decimal.Decimal, currency string) {
+	s.ResultingCashOut = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchExecution4) SetSettlementAmount(value, currency string) {
 	s.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchExecution4) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchExecution4) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (s *SwitchExecution4) SetSettlementMethod(value string) {
+func (s *SwitchExecution4) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
+func (s *SwitchExecution4) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SwitchExecution4) SetBestExecution(value string) {
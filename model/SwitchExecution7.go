@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of a switch order.
 type SwitchExecution7 struct {
 
@@ -99,10 +105,19 @@ type SwitchExecution7 struct {
 	OrderWaiverDetails *OrderWaiver1 `xml:"OrdrWvrDtls,omitempty"`
 }
 
+func (s *SwitchExecution7) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchExecution7) SetAmendmentIndicator(value string) {
 	s.AmendmentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchExecution7) SetAmendmentIndicatorFromBool(value bool) {
+	s.AmendmentIndicator = new(YesNoIndicator)
+	s.AmendmentIndicator.FromBool(value)
+}
+
 func (s *SwitchExecution7) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
@@ -116,10 +131,20 @@ func (s *SwitchExecution7) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SwitchExecution7) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SwitchExecution7) SetReceivedDateTime(value string) {
 	s.ReceivedDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SwitchExecution7) SetReceivedDateTimeFromTime(value time.Time) {
+	s.ReceivedDateTime = new(ISODateTime)
+	s.ReceivedDateTime.FromTime(value)
+}
+
 func (s *SwitchExecution7) SetDealReference(value string) {
 	s.DealReference = (*Max35Text)(&value)
 }
@@ -292,16 +317,34 @@ func (s *SwitchExecution7) SetRequestedFutureTradeDate(value string) {
 	s.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (s *SwitchExecution7) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	s.RequestedFutureTradeDate = new(ISODate)
+	s.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (s *SwitchExecution7) SetSettlementAmount(value, currency string) {
 	s.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchExecution7) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchExecution7) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (s *SwitchExecution7) SetSettlementMethod(value string) {
+func (s *SwitchExecution7) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
+func (s *SwitchExecution7) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SwitchExecution7) AddAdditionalAmount() *AdditionalAmount1Choice {
@@ -22,6 +22,10 @@ type SwitchLegReferences1 struct {
 	FinancialInstrumentDetails *FinancialInstrument10 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (s *SwitchLegReferences1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchLegReferences1) SetRedemptionLegIdentification(value string) {
 	s.RedemptionLegIdentification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type SwitchLegReferences2 struct {
 	FinancialInstrumentDetails *FinancialInstrument57 `xml:"FinInstrmDtls,omitempty"`
 }
 
+func (s *SwitchLegReferences2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchLegReferences2) AddLegIdentification() *LegIdentification1Choice {
 	s.LegIdentification = new(LegIdentification1Choice)
 	return s.LegIdentification
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Transfer from one investment fund/fund class to another investment fund or investment fund class by the investor. A switch is composed of one or several subscription legs, and one or several redemption legs.
 type SwitchOrder2 struct {
 
@@ -43,10 +49,19 @@ type SwitchOrder2 struct {
 	ForeignExchangeDetails *ForeignExchangeTerms5 `xml:"FXDtls,omitempty"`
 }
 
+func (s *SwitchOrder2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchOrder2) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SwitchOrder2) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SwitchOrder2) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -60,22 +75,43 @@ func (s *SwitchOrder2) SetTotalRedemptionAmount(value, currency string) {
 	s.TotalRedemptionAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchOrder2) SetTotalRedemptionAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalRedemptionAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchOrder2) SetTotalSubscriptionAmount(value, currency string) {
 	s.TotalSubscriptionAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchOrder2) SetTotalSubscriptionAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalSubscriptionAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchOrder2) SetExpiryDateTime(value string) {
 	s.ExpiryDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SwitchOrder2) SetExpiryDateTimeFromTime(value time.Time) {
+	s.ExpiryDateTime = new(ISODateTime)
+	s.ExpiryDateTime.FromTime(value)
+}
+
 func (s *SwitchOrder2) SetAdditionalCashIn(value, currency string) {
 	s.AdditionalCashIn = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchOrder2) SetAdditionalCashInFromDecimal(value decimal.Decimal, currency string) {
+	s.AdditionalCashIn = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchOrder2) SetResultingCashOut(value, currency string) {
 	s.ResultingCashOut = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchOrder2) SetResultingCashOutFromDecimal(value decimal.Decimal, currency string) {
+	s.ResultingCashOut = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchOrder2) AddCancellationRight() *CancellationRight1 {
 	s.CancellationRight = new(CancellationRight1)
 	return s.CancellationRight
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Transfer from one investment fund/fund class to another investment fund or investment fund class by the investor. A switch is composed of one or several subscription legs, and one or several redemption legs.
 type SwitchOrder3 struct {
 
@@ -77,6 +83,10 @@ type SwitchOrder3 struct {
 	NegotiatedTrade *NegotiatedTrade1Code `xml:"NgtdTrad,omitempty"`
 }
 
+func (s *SwitchOrder3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchOrder3) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
@@ -85,6 +95,11 @@ func (s *SwitchOrder3) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SwitchOrder3) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SwitchOrder3) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -106,24 +121,50 @@ func (s *SwitchOrder3) SetTotalRedemptionAmount(value, currency string) {
 	s.TotalRedemptionAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchOrder3) SetTotalRedemptionAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalRedemptionAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchOrder3) SetTotalSubscriptionAmount(value, currency string) {
 	s.TotalSubscriptionAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchOrder3) SetTotalSubscriptionAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalSubscriptionAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchOrder3) SetRequestedFutureTradeDate(value string) {
 	s.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (s *SwitchOrder3) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	s.RequestedFutureTradeDate = new(ISODate)
+	s.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (s *SwitchOrder3) SetSettlementAmount(value, currency string) {
 	s.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchOrder3) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchOrder3) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (s *SwitchOrder3) SetSettlementMethod(value string) {
+func (s *SwitchOrder3) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
+func (s *SwitchOrder3) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SwitchOrder3) AddExpiryDateTime() *DateAndDateTimeChoice {
@@ -135,10 +176,18 @@ func (s *SwitchOrder3) SetAdditionalCashIn(value, currency string) {
 	s.AdditionalCashIn = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchOrder3) SetAdditionalCashInFromDecimal(value decimal.Decimal, currency string) {
+	s.AdditionalCashIn = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchOrder3) SetResultingCashOut(value, currency string) {
 	s.ResultingCashOut = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchOrder3) SetResultingCashOutFromDecimal(value decimal.Decimal, currency string) {
+	s.ResultingCashOut = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchOrder3) AddRelatedPartyDetails() *Intermediary8 {
 	newValue := new(Intermediary8)
 	s.RelatedPartyDetails = append(s.RelatedPartyDetails, newValue)
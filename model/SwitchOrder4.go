@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Transfer from one investment fund/fund class to another investment fund or investment fund class by the investor. A switch is composed of one or several subscription legs, and one or several redemption legs.
 type SwitchOrder4 struct {
 
@@ -74,6 +80,10 @@ type SwitchOrder4 struct {
 	NegotiatedTrade *NegotiatedTrade1Code `xml:"NgtdTrad,omitempty"`
 }
 
+func (s *SwitchOrder4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchOrder4) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
@@ -82,6 +92,11 @@ func (s *SwitchOrder4) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SwitchOrder4) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SwitchOrder4) SetOrderReference(value string) {
 	s.OrderReference = (*Max35Text)(&value)
 }
@@ -99,24 +114,50 @@ func (s *SwitchOrder4) SetTotalRedemptionAmount(value, currency string) {
 	s.TotalRedemptionAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchOrder4) SetTotalRedemptionAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalRedemptionAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchOrder4) SetTotalSubscriptionAmount(value, currency string) {
 	s.TotalSubscriptionAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchOrder4) SetTotalSubscriptionAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.TotalSubscriptionAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchOrder4) SetRequestedFutureTradeDate(value string) {
 	s.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (s *SwitchOrder4) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	s.RequestedFutureTradeDate = new(ISODate)
+	s.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (s *SwitchOrder4) SetSettlementAmount(value, currency string) {
 	s.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchOrder4) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchOrder4) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (s *SwitchOrder4) SetSettlementMethod(value string) {
+func (s *SwitchOrder4) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
+func (s *SwitchOrder4) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SwitchOrder4) AddExpiryDateTime() *DateAndDateTimeChoice {
@@ -128,10 +169,18 @@ func (s *SwitchOrder4) SetAdditionalCashIn(value, currency string) {
 	s.AdditionalCashIn = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchOrder4) SetAdditionalCashInFromDecimal(value decimal.Decimal, currency string) {
+	s.AdditionalCashIn = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchOrder4) SetResultingCashOut(value, currency string) {
 	s.ResultingCashOut = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchOrder4) SetResultingCashOutFromDecimal(value decimal.Decimal, currency string) {
+	s.ResultingCashOut = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchOrder4) AddRelatedPartyDetails() *Intermediary8 {
 	newValue := new(Intermediary8)
 	s.RelatedPartyDetails = append(s.RelatedPartyDetails, newValue)
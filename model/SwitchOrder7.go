@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Transfer from one investment fund/fund class to another investment fund or investment fund class by the investor. A switch is composed of one or several subscription legs, and one or several redemption legs.
 type SwitchOrder7 struct {
 
@@ -87,6 +93,10 @@ type SwitchOrder7 struct {
 	OrderWaiverDetails *OrderWaiver1 `xml:"OrdrWvrDtls,omitempty"`
 }
 
+func (s *SwitchOrder7) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchOrder7) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
@@ -95,6 +105,11 @@ func (s *SwitchOrder7) SetOrderDateTime(value string) {
 	s.OrderDateTime = (*ISODateTime)(&value)
 }
 
+func (s *SwitchOrder7) SetOrderDateTimeFromTime(value time.Time) {
+	s.OrderDateTime = new(ISODateTime)
+	s.OrderDateTime.FromTime(value)
+}
+
 func (s *SwitchOrder7) AddPlaceOfTrade() *PlaceOfTradeIdentification1Choice {
 	s.PlaceOfTrade = new(PlaceOfTradeIdentification1Choice)
 	return s.PlaceOfTrade
@@ -263,16 +278,34 @@ func (s *SwitchOrder7) SetRequestedFutureTradeDate(value string) {
 	s.RequestedFutureTradeDate = (*ISODate)(&value)
 }
 
+func (s *SwitchOrder7) SetRequestedFutureTradeDateFromTime(value time.Time) {
+	s.RequestedFutureTradeDate = new(ISODate)
+	s.RequestedFutureTradeDate.FromTime(value)
+}
+
 func (s *SwitchOrder7) SetSettlementAmount(value, currency string) {
 	s.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchOrder7) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchOrder7) SetCashSettlementDate(value string) {
 	s.CashSettlementDate = (*ISODate)(&value)
 }
 
-func (s *SwitchOrder7) SetSettlementMethod(value string) {
+func (s *SwitchOrder7) SetCashSettlementDateFromTime(value time.Time) {
+	s.CashSettlementDate = new(ISODate)
+	s.CashSettlementDate.FromTime(value)
+}
+
+func (s *SwitchOrder7) SetSettlementMethod(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	s.SettlementMethod = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (s *SwitchOrder7) AddAdditionalAmount() *AdditionalAmount1Choice {
@@ -13,10 +13,19 @@ type SwitchOrderConfirmation1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (s *SwitchOrderConfirmation1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchOrderConfirmation1) SetAmendmentIndicator(value string) {
 	s.AmendmentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchOrderConfirmation1) SetAmendmentIndicatorFromBool(value bool) {
+	s.AmendmentIndicator = new(YesNoIndicator)
+	s.AmendmentIndicator.FromBool(value)
+}
+
 func (s *SwitchOrderConfirmation1) AddSwitchExecutionDetails() *SwitchExecution4 {
 	newValue := new(SwitchExecution4)
 	s.SwitchExecutionDetails = append(s.SwitchExecutionDetails, newValue)
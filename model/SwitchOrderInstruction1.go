@@ -16,6 +16,10 @@ type SwitchOrderInstruction1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (s *SwitchOrderInstruction1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchOrderInstruction1) AddSwitchOrderDetails() *SwitchOrder2 {
 	s.SwitchOrderDetails = new(SwitchOrder2)
 	return s.SwitchOrderDetails
@@ -10,6 +10,10 @@ type SwitchOrderInstruction2 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (s *SwitchOrderInstruction2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchOrderInstruction2) AddSwitchOrderDetails() *SwitchOrder3 {
 	newValue := new(SwitchOrder3)
 	s.SwitchOrderDetails = append(s.SwitchOrderDetails, newValue)
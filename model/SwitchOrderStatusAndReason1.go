@@ -52,6 +52,10 @@ type SwitchOrderStatusAndReason1 struct {
 	NewDetails *ExpectedExecutionDetails2 `xml:"NewDtls,omitempty"`
 }
 
+func (s *SwitchOrderStatusAndReason1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchOrderStatusAndReason1) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
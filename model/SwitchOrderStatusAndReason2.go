@@ -34,6 +34,10 @@ type SwitchOrderStatusAndReason2 struct {
 	NewDetails *ExpectedExecutionDetails2 `xml:"NewDtls,omitempty"`
 }
 
+func (s *SwitchOrderStatusAndReason2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchOrderStatusAndReason2) SetMasterReference(value string) {
 	s.MasterReference = (*Max35Text)(&value)
 }
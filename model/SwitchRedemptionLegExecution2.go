@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of the redemption part, in a switch between investment funds or investment fund classes.
 type SwitchRedemptionLegExecution2 struct {
 
@@ -67,6 +71,10 @@ type SwitchRedemptionLegExecution2 struct {
 	PhysicalDeliveryDetails *DeliveryParameters3 `xml:"PhysDlvryDtls,omitempty"`
 }
 
+func (s *SwitchRedemptionLegExecution2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchRedemptionLegExecution2) SetLegIdentification(value string) {
 	s.LegIdentification = (*Max35Text)(&value)
 }
@@ -89,14 +97,27 @@ func (s *SwitchRedemptionLegExecution2) SetHoldingsRedemptionRate(value string)
 	s.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (s *SwitchRedemptionLegExecution2) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	s.HoldingsRedemptionRate = new(PercentageRate)
+	s.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (s *SwitchRedemptionLegExecution2) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchRedemptionLegExecution2) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchRedemptionLegExecution2) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchRedemptionLegExecution2) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchRedemptionLegExecution2) AddTradeDateTime() *DateAndDateTimeChoice {
 	s.TradeDateTime = new(DateAndDateTimeChoice)
 	return s.TradeDateTime
@@ -111,6 +132,11 @@ func (s *SwitchRedemptionLegExecution2) SetCumDividendIndicator(value string) {
 	s.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchRedemptionLegExecution2) SetCumDividendIndicatorFromBool(value bool) {
+	s.CumDividendIndicator = new(YesNoIndicator)
+	s.CumDividendIndicator.FromBool(value)
+}
+
 func (s *SwitchRedemptionLegExecution2) AddInterimProfitAmount() *ProfitAndLoss1Choice {
 	s.InterimProfitAmount = new(ProfitAndLoss1Choice)
 	return s.InterimProfitAmount
@@ -156,6 +182,11 @@ func (s *SwitchRedemptionLegExecution2) SetPhysicalDeliveryIndicator(value strin
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchRedemptionLegExecution2) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SwitchRedemptionLegExecution2) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	s.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return s.PhysicalDeliveryDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of the redemption part, in a switch between investment funds or investment fund classes.
 type SwitchRedemptionLegExecution3 struct {
 
@@ -78,6 +82,10 @@ type SwitchRedemptionLegExecution3 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (s *SwitchRedemptionLegExecution3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchRedemptionLegExecution3) SetLegIdentification(value string) {
 	s.LegIdentification = (*Max35Text)(&value)
 }
@@ -105,14 +113,27 @@ func (s *SwitchRedemptionLegExecution3) SetHoldingsRedemptionRate(value string)
 	s.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (s *SwitchRedemptionLegExecution3) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	s.HoldingsRedemptionRate = new(PercentageRate)
+	s.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (s *SwitchRedemptionLegExecution3) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchRedemptionLegExecution3) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchRedemptionLegExecution3) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchRedemptionLegExecution3) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchRedemptionLegExecution3) AddTradeDateTime() *DateAndDateTimeChoice {
 	s.TradeDateTime = new(DateAndDateTimeChoice)
 	return s.TradeDateTime
@@ -127,6 +148,11 @@ func (s *SwitchRedemptionLegExecution3) SetCumDividendIndicator(value string) {
 	s.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchRedemptionLegExecution3) SetCumDividendIndicatorFromBool(value bool) {
+	s.CumDividendIndicator = new(YesNoIndicator)
+	s.CumDividendIndicator.FromBool(value)
+}
+
 func (s *SwitchRedemptionLegExecution3) AddInterimProfitAmount() *ProfitAndLoss1Choice {
 	s.InterimProfitAmount = new(ProfitAndLoss1Choice)
 	return s.InterimProfitAmount
@@ -172,6 +198,11 @@ func (s *SwitchRedemptionLegExecution3) SetPhysicalDeliveryIndicator(value strin
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchRedemptionLegExecution3) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SwitchRedemptionLegExecution3) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	s.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return s.PhysicalDeliveryDetails
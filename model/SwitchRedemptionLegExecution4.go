@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of the redemption part, in a switch between investment funds or investment fund classes.
 type SwitchRedemptionLegExecution4 struct {
 
@@ -81,6 +85,10 @@ type SwitchRedemptionLegExecution4 struct {
 	GatingOrHoldBackDetails *HoldBackInformation2 `xml:"GtgOrHldBckDtls,omitempty"`
 }
 
+func (s *SwitchRedemptionLegExecution4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchRedemptionLegExecution4) SetLegIdentification(value string) {
 	s.LegIdentification = (*Max35Text)(&value)
 }
@@ -98,18 +106,36 @@ func (s *SwitchRedemptionLegExecution4) SetUnitsNumber(value string) {
 	s.UnitsNumber = (*DecimalNumber)(&value)
 }
 
+func (s *SwitchRedemptionLegExecution4) SetUnitsNumberFromDecimal(value decimal.Decimal) {
+	s.UnitsNumber = new(DecimalNumber)
+	s.UnitsNumber.FromDecimal(value)
+}
+
 func (s *SwitchRedemptionLegExecution4) SetHoldingsRedemptionRate(value string) {
 	s.HoldingsRedemptionRate = (*PercentageRate)(&value)
 }
 
+func (s *SwitchRedemptionLegExecution4) SetHoldingsRedemptionRateFromDecimal(value decimal.Decimal) {
+	s.HoldingsRedemptionRate = new(PercentageRate)
+	s.HoldingsRedemptionRate.FromDecimal(value)
+}
+
 func (s *SwitchRedemptionLegExecution4) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchRedemptionLegExecution4) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchRedemptionLegExecution4) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchRedemptionLegExecution4) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchRedemptionLegExecution4) AddInvestmentAccountDetails() *InvestmentAccount58 {
 	s.InvestmentAccountDetails = new(InvestmentAccount58)
 	return s.InvestmentAccountDetails
@@ -275,6 +301,11 @@ func (s *SwitchRedemptionLegExecution4) SetCumDividendIndicator(value string) {
 	s.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchRedemptionLegExecution4) SetCumDividendIndicatorFromBool(value bool) {
+	s.CumDividendIndicator = new(YesNoIndicator)
+	s.CumDividendIndicator.FromBool(value)
+}
+
 func (s *SwitchRedemptionLegExecution4) AddInterimProfitAmount() *ProfitAndLoss2Choice {
 	s.InterimProfitAmount = new(ProfitAndLoss2Choice)
 	return s.InterimProfitAmount
@@ -315,6 +346,11 @@ func (s *SwitchRedemptionLegExecution4) SetPhysicalDeliveryIndicator(value strin
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchRedemptionLegExecution4) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SwitchRedemptionLegExecution4) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	s.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return s.PhysicalDeliveryDetails
@@ -43,6 +43,10 @@ type SwitchRedemptionLegOrder2 struct {
 	PhysicalDeliveryDetails *DeliveryParameters3 `xml:"PhysDlvryDtls,omitempty"`
 }
 
+func (s *SwitchRedemptionLegOrder2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchRedemptionLegOrder2) SetLegIdentification(value string) {
 	s.LegIdentification = (*Max35Text)(&value)
 }
@@ -520,6 +524,11 @@ func (s *SwitchRedemptionLegOrder2) SetPhysicalDeliveryIndicator(value string) {
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchRedemptionLegOrder2) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SwitchRedemptionLegOrder2) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	s.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return s.PhysicalDeliveryDetails
@@ -52,6 +52,10 @@ type SwitchRedemptionLegOrder3 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (s *SwitchRedemptionLegOrder3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchRedemptionLegOrder3) SetLegIdentification(value string) {
 	s.LegIdentification = (*Max35Text)(&value)
 }
@@ -534,6 +538,11 @@ func (s *SwitchRedemptionLegOrder3) SetPhysicalDeliveryIndicator(value string) {
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchRedemptionLegOrder3) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SwitchRedemptionLegOrder3) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	s.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return s.PhysicalDeliveryDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of the subscription part, in a switch between investment funds or investment fund classes.
 type SwitchSubscriptionLegExecution2 struct {
 
@@ -61,6 +65,10 @@ type SwitchSubscriptionLegExecution2 struct {
 	PhysicalDeliveryDetails *DeliveryParameters3 `xml:"PhysDlvryDtls,omitempty"`
 }
 
+func (s *SwitchSubscriptionLegExecution2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchSubscriptionLegExecution2) SetLegIdentification(value string) {
 	s.LegIdentification = (*Max35Text)(&value)
 }
@@ -83,10 +91,18 @@ func (s *SwitchSubscriptionLegExecution2) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchSubscriptionLegExecution2) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchSubscriptionLegExecution2) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchSubscriptionLegExecution2) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchSubscriptionLegExecution2) AddTradeDateTime() *DateAndDateTimeChoice {
 	s.TradeDateTime = new(DateAndDateTimeChoice)
 	return s.TradeDateTime
@@ -101,6 +117,11 @@ func (s *SwitchSubscriptionLegExecution2) SetCumDividendIndicator(value string)
 	s.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchSubscriptionLegExecution2) SetCumDividendIndicatorFromBool(value bool) {
+	s.CumDividendIndicator = new(YesNoIndicator)
+	s.CumDividendIndicator.FromBool(value)
+}
+
 func (s *SwitchSubscriptionLegExecution2) AddInterimProfitAmount() *ProfitAndLoss1Choice {
 	s.InterimProfitAmount = new(ProfitAndLoss1Choice)
 	return s.InterimProfitAmount
@@ -142,6 +163,11 @@ func (s *SwitchSubscriptionLegExecution2) SetPhysicalDeliveryIndicator(value str
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchSubscriptionLegExecution2) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SwitchSubscriptionLegExecution2) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	s.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return s.PhysicalDeliveryDetails
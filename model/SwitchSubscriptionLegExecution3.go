@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of the subscription part, in a switch between investment funds or investment fund classes.
 type SwitchSubscriptionLegExecution3 struct {
 
@@ -72,6 +76,10 @@ type SwitchSubscriptionLegExecution3 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (s *SwitchSubscriptionLegExecution3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchSubscriptionLegExecution3) SetLegIdentification(value string) {
 	s.LegIdentification = (*Max35Text)(&value)
 }
@@ -99,10 +107,18 @@ func (s *SwitchSubscriptionLegExecution3) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchSubscriptionLegExecution3) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchSubscriptionLegExecution3) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchSubscriptionLegExecution3) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchSubscriptionLegExecution3) AddTradeDateTime() *DateAndDateTimeChoice {
 	s.TradeDateTime = new(DateAndDateTimeChoice)
 	return s.TradeDateTime
@@ -117,6 +133,11 @@ func (s *SwitchSubscriptionLegExecution3) SetCumDividendIndicator(value string)
 	s.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchSubscriptionLegExecution3) SetCumDividendIndicatorFromBool(value bool) {
+	s.CumDividendIndicator = new(YesNoIndicator)
+	s.CumDividendIndicator.FromBool(value)
+}
+
 func (s *SwitchSubscriptionLegExecution3) AddInterimProfitAmount() *ProfitAndLoss1Choice {
 	s.InterimProfitAmount = new(ProfitAndLoss1Choice)
 	return s.InterimProfitAmount
@@ -158,6 +179,11 @@ func (s *SwitchSubscriptionLegExecution3) SetPhysicalDeliveryIndicator(value str
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchSubscriptionLegExecution3) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SwitchSubscriptionLegExecution3) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	s.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return s.PhysicalDeliveryDetails
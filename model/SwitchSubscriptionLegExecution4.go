@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Execution of the subscription part, in a switch between investment funds or investment fund classes.
 type SwitchSubscriptionLegExecution4 struct {
 
@@ -72,6 +76,10 @@ type SwitchSubscriptionLegExecution4 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (s *SwitchSubscriptionLegExecution4) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchSubscriptionLegExecution4) SetLegIdentification(value string) {
 	s.LegIdentification = (*Max35Text)(&value)
 }
@@ -89,14 +97,27 @@ func (s *SwitchSubscriptionLegExecution4) SetUnitsNumber(value string) {
 	s.UnitsNumber = (*DecimalNumber)(&value)
 }
 
+func (s *SwitchSubscriptionLegExecution4) SetUnitsNumberFromDecimal(value decimal.Decimal) {
+	s.UnitsNumber = new(DecimalNumber)
+	s.UnitsNumber.FromDecimal(value)
+}
+
 func (s *SwitchSubscriptionLegExecution4) SetNetAmount(value, currency string) {
 	s.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchSubscriptionLegExecution4) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchSubscriptionLegExecution4) SetGrossAmount(value, currency string) {
 	s.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SwitchSubscriptionLegExecution4) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SwitchSubscriptionLegExecution4) AddInvestmentAccountDetails() *InvestmentAccount58 {
 	s.InvestmentAccountDetails = new(InvestmentAccount58)
 	return s.InvestmentAccountDetails
@@ -262,6 +283,11 @@ func (s *SwitchSubscriptionLegExecution4) SetCumDividendIndicator(value string)
 	s.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchSubscriptionLegExecution4) SetCumDividendIndicatorFromBool(value bool) {
+	s.CumDividendIndicator = new(YesNoIndicator)
+	s.CumDividendIndicator.FromBool(value)
+}
+
 func (s *SwitchSubscriptionLegExecution4) AddInterimProfitAmount() *ProfitAndLoss2Choice {
 	s.InterimProfitAmount = new(ProfitAndLoss2Choice)
 	return s.InterimProfitAmount
@@ -298,6 +324,11 @@ func (s *SwitchSubscriptionLegExecution4) SetPhysicalDeliveryIndicator(value str
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchSubscriptionLegExecution4) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SwitchSubscriptionLegExecution4) AddPhysicalDeliveryDetails() *DeliveryParameters3 {
 	s.PhysicalDeliveryDetails = new(DeliveryParameters3)
 	return s.PhysicalDeliveryDetails
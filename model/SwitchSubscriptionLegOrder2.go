@@ -40,6 +40,10 @@ type SwitchSubscriptionLegOrder2 struct {
 	PhysicalDeliveryDetails *NameAndAddress4 `xml:"PhysDlvryDtls,omitempty"`
 }
 
+func (s *SwitchSubscriptionLegOrder2) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchSubscriptionLegOrder2) SetLegIdentification(value string) {
 	s.LegIdentification = (*Max35Text)(&value)
 }
@@ -513,6 +517,11 @@ func (s *SwitchSubscriptionLegOrder2) SetPhysicalDeliveryIndicator(value string)
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchSubscriptionLegOrder2) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SwitchSubscriptionLegOrder2) AddPhysicalDeliveryDetails() *NameAndAddress4 {
 	s.PhysicalDeliveryDetails = new(NameAndAddress4)
 	return s.PhysicalDeliveryDetails
@@ -49,6 +49,10 @@ type SwitchSubscriptionLegOrder3 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (s *SwitchSubscriptionLegOrder3) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchSubscriptionLegOrder3) SetLegIdentification(value string) {
 	s.LegIdentification = (*Max35Text)(&value)
 }
@@ -381,6 +385,11 @@ func (s *SwitchSubscriptionLegOrder3) SetPhysicalDeliveryIndicator(value string)
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchSubscriptionLegOrder3) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SwitchSubscriptionLegOrder3) AddTaxDetails() *Tax16 {
 	newValue := new(Tax16)
 	s.TaxDetails = append(s.TaxDetails, newValue)
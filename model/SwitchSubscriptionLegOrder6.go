@@ -43,6 +43,10 @@ type SwitchSubscriptionLegOrder6 struct {
 	Equalisation *Equalisation1 `xml:"Equlstn,omitempty"`
 }
 
+func (s *SwitchSubscriptionLegOrder6) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SwitchSubscriptionLegOrder6) SetLegIdentification(value string) {
 	s.LegIdentification = (*Max35Text)(&value)
 }
@@ -88,6 +92,11 @@ func (s *SwitchSubscriptionLegOrder6) SetPhysicalDeliveryIndicator(value string)
 	s.PhysicalDeliveryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (s *SwitchSubscriptionLegOrder6) SetPhysicalDeliveryIndicatorFromBool(value bool) {
+	s.PhysicalDeliveryIndicator = new(YesNoIndicator)
+	s.PhysicalDeliveryIndicator.FromBool(value)
+}
+
 func (s *SwitchSubscriptionLegOrder6) AddPhysicalDeliveryDetails() *NameAndAddress4 {
 	s.PhysicalDeliveryDetails = new(NameAndAddress4)
 	return s.PhysicalDeliveryDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Loan offered by a group of lenders (called a syndicate) who work together to lend an amount of money to a single borrower.
 type SyndicatedLoan1 struct {
 
@@ -19,6 +23,10 @@ type SyndicatedLoan1 struct {
 	ExchangeRateInformation *ExchangeRate1 `xml:"XchgRateInf,omitempty"`
 }
 
+func (s *SyndicatedLoan1) Validate() error {
+	return ValidateElement(s)
+}
+
 func (s *SyndicatedLoan1) AddBorrower() *TradeParty2 {
 	s.Borrower = new(TradeParty2)
 	return s.Borrower
@@ -33,10 +41,19 @@ func (s *SyndicatedLoan1) SetAmount(value, currency string) {
 	s.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (s *SyndicatedLoan1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	s.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (s *SyndicatedLoan1) SetShare(value string) {
 	s.Share = (*Percentage)(&value)
 }
 
+func (s *SyndicatedLoan1) SetShareFromDecimal(value decimal.Decimal) {
+	s.Share = new(Percentage)
+	s.Share.FromDecimal(value)
+}
+
 func (s *SyndicatedLoan1) AddExchangeRateInformation() *ExchangeRate1 {
 	s.ExchangeRateInformation = new(ExchangeRate1)
 	return s.ExchangeRateInformation
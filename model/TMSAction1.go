@@ -25,6 +25,10 @@ type TMSAction1 struct {
 	ErrorAction []*ErrorAction1 `xml:"ErrActn,omitempty"`
 }
 
+func (t *TMSAction1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TMSAction1) SetType(value string) {
 	t.Type = (*TerminalManagementAction1Code)(&value)
 }
@@ -31,6 +31,10 @@ type TMSAction2 struct {
 	ErrorAction []*ErrorAction2 `xml:"ErrActn,omitempty"`
 }
 
+func (t *TMSAction2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TMSAction2) SetType(value string) {
 	t.Type = (*TerminalManagementAction1Code)(&value)
 }
@@ -34,6 +34,10 @@ type TMSAction3 struct {
 	ErrorAction []*ErrorAction2 `xml:"ErrActn,omitempty"`
 }
 
+func (t *TMSAction3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TMSAction3) SetType(value string) {
 	t.Type = (*TerminalManagementAction1Code)(&value)
 }
@@ -61,6 +61,10 @@ type TMSAction4 struct {
 	AdditionalInformation []*Max3000Binary `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TMSAction4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TMSAction4) SetType(value string) {
 	t.Type = (*TerminalManagementAction1Code)(&value)
 }
@@ -61,6 +61,10 @@ type TMSAction5 struct {
 	AdditionalInformation []*Max3000Binary `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TMSAction5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TMSAction5) SetType(value string) {
 	t.Type = (*TerminalManagementAction2Code)(&value)
 }
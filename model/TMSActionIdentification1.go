@@ -10,6 +10,10 @@ type TMSActionIdentification1 struct {
 	DataSetIdentification *DataSetIdentification2 `xml:"DataSetId,omitempty"`
 }
 
+func (t *TMSActionIdentification1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TMSActionIdentification1) SetActionType(value string) {
 	t.ActionType = (*TerminalManagementAction1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TMSActionIdentification2 struct {
 	DataSetIdentification *DataSetIdentification3 `xml:"DataSetId,omitempty"`
 }
 
+func (t *TMSActionIdentification2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TMSActionIdentification2) SetActionType(value string) {
 	t.ActionType = (*TerminalManagementAction1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TMSActionIdentification3 struct {
 	DataSetIdentification *DataSetIdentification4 `xml:"DataSetId,omitempty"`
 }
 
+func (t *TMSActionIdentification3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TMSActionIdentification3) SetActionType(value string) {
 	t.ActionType = (*TerminalManagementAction1Code)(&value)
 }
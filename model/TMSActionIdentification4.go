@@ -10,6 +10,10 @@ type TMSActionIdentification4 struct {
 	DataSetIdentification *DataSetIdentification6 `xml:"DataSetId,omitempty"`
 }
 
+func (t *TMSActionIdentification4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TMSActionIdentification4) SetActionType(value string) {
 	t.ActionType = (*TerminalManagementAction2Code)(&value)
 }
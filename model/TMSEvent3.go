@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Result of an individual terminal management action performed by the point of interaction.
 type TMSEvent3 struct {
 
@@ -16,10 +20,19 @@ type TMSEvent3 struct {
 	AdditionalErrorInformation *Max70Text `xml:"AddtlErrInf,omitempty"`
 }
 
+func (t *TMSEvent3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TMSEvent3) SetTimeStamp(value string) {
 	t.TimeStamp = (*ISODateTime)(&value)
 }
 
+func (t *TMSEvent3) SetTimeStampFromTime(value time.Time) {
+	t.TimeStamp = new(ISODateTime)
+	t.TimeStamp.FromTime(value)
+}
+
 func (t *TMSEvent3) SetResult(value string) {
 	t.Result = (*TerminalManagementActionResult1Code)(&value)
 }
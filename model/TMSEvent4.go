@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Result of an individual terminal management action performed by the point of interaction.
 type TMSEvent4 struct {
 
@@ -16,10 +20,19 @@ type TMSEvent4 struct {
 	AdditionalErrorInformation *Max70Text `xml:"AddtlErrInf,omitempty"`
 }
 
+func (t *TMSEvent4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TMSEvent4) SetTimeStamp(value string) {
 	t.TimeStamp = (*ISODateTime)(&value)
 }
 
+func (t *TMSEvent4) SetTimeStampFromTime(value time.Time) {
+	t.TimeStamp = new(ISODateTime)
+	t.TimeStamp.FromTime(value)
+}
+
 func (t *TMSEvent4) SetResult(value string) {
 	t.Result = (*TerminalManagementActionResult1Code)(&value)
 }
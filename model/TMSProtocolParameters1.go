@@ -31,6 +31,10 @@ type TMSProtocolParameters1 struct {
 	RecipientPartyIdentification *Max35Text `xml:"RcptPtyId,omitempty"`
 }
 
+func (t *TMSProtocolParameters1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TMSProtocolParameters1) AddTerminalManagerIdentification() *GenericIdentification71 {
 	t.TerminalManagerIdentification = new(GenericIdentification71)
 	return t.TerminalManagerIdentification
@@ -34,6 +34,10 @@ type TMSProtocolParameters2 struct {
 	FileTransfer *TrueFalseIndicator `xml:"FileTrf,omitempty"`
 }
 
+func (t *TMSProtocolParameters2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TMSProtocolParameters2) SetActionType(value string) {
 	t.ActionType = (*TerminalManagementAction3Code)(&value)
 }
@@ -74,3 +78,8 @@ func (t *TMSProtocolParameters2) SetRecipientPartyIdentification(value string) {
 func (t *TMSProtocolParameters2) SetFileTransfer(value string) {
 	t.FileTransfer = (*TrueFalseIndicator)(&value)
 }
+
+func (t *TMSProtocolParameters2) SetFileTransferFromBool(value bool) {
+	t.FileTransfer = new(TrueFalseIndicator)
+	t.FileTransfer.FromBool(value)
+}
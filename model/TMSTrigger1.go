@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Instructs the POI (Point Of Interaction) how to contact the host of the terminal management system (TMS), to initiate the maintenance of the terminal.
 type TMSTrigger1 struct {
 
@@ -13,6 +17,10 @@ type TMSTrigger1 struct {
 	TMSContactDateTime *ISODateTime `xml:"TMSCtctDtTm,omitempty"`
 }
 
+func (t *TMSTrigger1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TMSTrigger1) SetTMSContactLevel(value string) {
 	t.TMSContactLevel = (*TMSContactLevel1Code)(&value)
 }
@@ -24,3 +32,8 @@ func (t *TMSTrigger1) SetTMSIdentification(value string) {
 func (t *TMSTrigger1) SetTMSContactDateTime(value string) {
 	t.TMSContactDateTime = (*ISODateTime)(&value)
 }
+
+func (t *TMSTrigger1) SetTMSContactDateTimeFromTime(value time.Time) {
+	t.TMSContactDateTime = new(ISODateTime)
+	t.TMSContactDateTime.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to the government or tax authority, according to various pre-defined parameters such as thresholds or income.
 type Tax12 struct {
 
@@ -13,6 +17,10 @@ type Tax12 struct {
 	Amount *CurrencyAndAmount `xml:"Amt"`
 }
 
+func (t *Tax12) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax12) SetType(value string) {
 	t.Type = (*TaxType9Code)(&value)
 }
@@ -24,3 +32,7 @@ func (t *Tax12) SetOtherTaxType(value string) {
 func (t *Tax12) SetAmount(value, currency string) {
 	t.Amount = NewCurrencyAndAmount(value, currency)
 }
+
+func (t *Tax12) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to the government or tax authority, according to various pre-defined parameters such as thresholds or income.
 type Tax13 struct {
 
@@ -16,6 +20,10 @@ type Tax13 struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (t *Tax13) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax13) SetType(value string) {
 	t.Type = (*TaxType9Code)(&value)
 }
@@ -28,6 +36,15 @@ func (t *Tax13) SetAmount(value, currency string) {
 	t.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (t *Tax13) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *Tax13) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
+
+func (t *Tax13) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
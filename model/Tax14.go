@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Tax related to an investment fund order.
 type Tax14 struct {
 
@@ -34,6 +38,10 @@ type Tax14 struct {
 	TaxCalculationDetails *TaxCalculationInformation6 `xml:"TaxClctnDtls,omitempty"`
 }
 
+func (t *Tax14) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax14) SetType(value string) {
 	t.Type = (*TaxType11Code)(&value)
 }
@@ -46,10 +54,19 @@ func (t *Tax14) SetAmount(value, currency string) {
 	t.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Tax14) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Tax14) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
 
+func (t *Tax14) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
+
 func (t *Tax14) SetCountry(value string) {
 	t.Country = (*CountryCode)(&value)
 }
@@ -63,6 +80,11 @@ func (t *Tax14) SetExemptionIndicator(value string) {
 	t.ExemptionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Tax14) SetExemptionIndicatorFromBool(value bool) {
+	t.ExemptionIndicator = new(YesNoIndicator)
+	t.ExemptionIndicator.FromBool(value)
+}
+
 func (t *Tax14) SetExemptionReason(value string) {
 	t.ExemptionReason = (*TaxExemptReason1Code)(&value)
 }
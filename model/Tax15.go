@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Tax related to an investment fund order.
 type Tax15 struct {
 
@@ -31,6 +35,10 @@ type Tax15 struct {
 	ExtendedExemptionReason *Extended350Code `xml:"XtndedXmptnRsn,omitempty"`
 }
 
+func (t *Tax15) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax15) SetType(value string) {
 	t.Type = (*TaxType13Code)(&value)
 }
@@ -43,6 +51,10 @@ func (t *Tax15) SetAmount(value, currency string) {
 	t.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Tax15) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Tax15) SetBasis(value string) {
 	t.Basis = (*TaxationBasis2Code)(&value)
 }
@@ -60,6 +72,11 @@ func (t *Tax15) SetExemptionIndicator(value string) {
 	t.ExemptionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Tax15) SetExemptionIndicatorFromBool(value bool) {
+	t.ExemptionIndicator = new(YesNoIndicator)
+	t.ExemptionIndicator.FromBool(value)
+}
+
 func (t *Tax15) SetExemptionReason(value string) {
 	t.ExemptionReason = (*TaxExemptReason1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Tax related to an investment fund order.
 type Tax16 struct {
 
@@ -34,6 +38,10 @@ type Tax16 struct {
 	TaxCalculationDetails *TaxCalculationInformation5 `xml:"TaxClctnDtls,omitempty"`
 }
 
+func (t *Tax16) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax16) SetType(value string) {
 	t.Type = (*TaxType10Code)(&value)
 }
@@ -46,10 +54,19 @@ func (t *Tax16) SetAmount(value, currency string) {
 	t.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Tax16) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Tax16) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
 
+func (t *Tax16) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
+
 func (t *Tax16) SetCountry(value string) {
 	t.Country = (*CountryCode)(&value)
 }
@@ -63,6 +80,11 @@ func (t *Tax16) SetExemptionIndicator(value string) {
 	t.ExemptionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Tax16) SetExemptionIndicatorFromBool(value bool) {
+	t.ExemptionIndicator = new(YesNoIndicator)
+	t.ExemptionIndicator.FromBool(value)
+}
+
 func (t *Tax16) SetExemptionReason(value string) {
 	t.ExemptionReason = (*TaxExemptReason1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Tax related to an investment fund order.
 type Tax17 struct {
 
@@ -22,6 +26,10 @@ type Tax17 struct {
 	TaxCalculationDetails *TaxCalculationInformation4 `xml:"TaxClctnDtls,omitempty"`
 }
 
+func (t *Tax17) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax17) SetType(value string) {
 	t.Type = (*TaxType12Code)(&value)
 }
@@ -38,6 +46,11 @@ func (t *Tax17) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
 
+func (t *Tax17) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
+
 func (t *Tax17) SetCountry(value string) {
 	t.Country = (*CountryCode)(&value)
 }
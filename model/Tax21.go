@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Tax related to an investment fund order.
 type Tax21 struct {
 
@@ -25,6 +29,10 @@ type Tax21 struct {
 	TaxCalculationDetails *TaxCalculationInformation8 `xml:"TaxClctnDtls,omitempty"`
 }
 
+func (t *Tax21) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax21) AddType() *TaxType1Choice {
 	t.Type = new(TaxType1Choice)
 	return t.Type
@@ -34,6 +42,10 @@ func (t *Tax21) SetAmount(value, currency string) {
 	t.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Tax21) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Tax21) AddBasis() *TaxBasis1Choice {
 	t.Basis = new(TaxBasis1Choice)
 	return t.Basis
@@ -48,6 +60,11 @@ func (t *Tax21) SetExemptionIndicator(value string) {
 	t.ExemptionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Tax21) SetExemptionIndicatorFromBool(value bool) {
+	t.ExemptionIndicator = new(YesNoIndicator)
+	t.ExemptionIndicator.FromBool(value)
+}
+
 func (t *Tax21) AddExemptionReason() *ExemptionReason1Choice {
 	t.ExemptionReason = new(ExemptionReason1Choice)
 	return t.ExemptionReason
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to the government or tax authority, according to various pre-defined parameters such as thresholds or income.
 type Tax22 struct {
 
@@ -10,6 +14,10 @@ type Tax22 struct {
 	Amount *CurrencyAndAmount `xml:"Amt"`
 }
 
+func (t *Tax22) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax22) AddType() *TaxType2Choice {
 	t.Type = new(TaxType2Choice)
 	return t.Type
@@ -18,3 +26,7 @@ func (t *Tax22) AddType() *TaxType2Choice {
 func (t *Tax22) SetAmount(value, currency string) {
 	t.Amount = NewCurrencyAndAmount(value, currency)
 }
+
+func (t *Tax22) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
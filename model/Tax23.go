@@ -10,6 +10,10 @@ type Tax23 struct {
 	AmountOrPercentage *AmountOrPercentage2Choice `xml:"AmtOrPctg"`
 }
 
+func (t *Tax23) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax23) AddType() *TaxType2Choice {
 	t.Type = new(TaxType2Choice)
 	return t.Type
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Tax related to an investment fund order.
 type Tax25 struct {
 
@@ -25,6 +29,10 @@ type Tax25 struct {
 	TaxCalculationDetails *TaxCalculationInformation8 `xml:"TaxClctnDtls,omitempty"`
 }
 
+func (t *Tax25) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax25) AddType() *TaxType1Choice {
 	t.Type = new(TaxType1Choice)
 	return t.Type
@@ -34,6 +42,10 @@ func (t *Tax25) SetAmount(value, currency string) {
 	t.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Tax25) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Tax25) AddBasis() *TaxBasis1Choice {
 	t.Basis = new(TaxBasis1Choice)
 	return t.Basis
@@ -48,6 +60,11 @@ func (t *Tax25) SetExemptionIndicator(value string) {
 	t.ExemptionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Tax25) SetExemptionIndicatorFromBool(value bool) {
+	t.ExemptionIndicator = new(YesNoIndicator)
+	t.ExemptionIndicator.FromBool(value)
+}
+
 func (t *Tax25) AddExemptionReason() *ExemptionReason1Choice {
 	t.ExemptionReason = new(ExemptionReason1Choice)
 	return t.ExemptionReason
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to the government or tax authority, according to various pre-defined parameters such as thresholds or income.
 type Tax3 struct {
 
@@ -22,6 +26,10 @@ type Tax3 struct {
 	ExemptionReason *TaxExemptionReasonFormatChoice `xml:"XmptnRsn,omitempty"`
 }
 
+func (t *Tax3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax3) AddType() *TaxTypeFormat2Choice {
 	t.Type = new(TaxTypeFormat2Choice)
 	return t.Type
@@ -31,6 +39,10 @@ func (t *Tax3) SetAmount(value, currency string) {
 	t.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Tax3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Tax3) SetBasis(value string) {
 	t.Basis = (*TaxationBasis2Code)(&value)
 }
@@ -44,6 +56,11 @@ func (t *Tax3) SetExemptionIndicator(value string) {
 	t.ExemptionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Tax3) SetExemptionIndicatorFromBool(value bool) {
+	t.ExemptionIndicator = new(YesNoIndicator)
+	t.ExemptionIndicator.FromBool(value)
+}
+
 func (t *Tax3) AddExemptionReason() *TaxExemptionReasonFormatChoice {
 	t.ExemptionReason = new(TaxExemptionReasonFormatChoice)
 	return t.ExemptionReason
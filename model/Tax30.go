@@ -25,6 +25,10 @@ type Tax30 struct {
 	TaxCalculationDetails *TaxCalculationInformation9 `xml:"TaxClctnDtls,omitempty"`
 }
 
+func (t *Tax30) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax30) AddType() *TaxType3Choice {
 	t.Type = new(TaxType3Choice)
 	return t.Type
@@ -43,6 +47,11 @@ func (t *Tax30) SetExemptionIndicator(value string) {
 	t.ExemptionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Tax30) SetExemptionIndicatorFromBool(value bool) {
+	t.ExemptionIndicator = new(YesNoIndicator)
+	t.ExemptionIndicator.FromBool(value)
+}
+
 func (t *Tax30) AddExemptionReason() *ExemptionReason1Choice {
 	t.ExemptionReason = new(ExemptionReason1Choice)
 	return t.ExemptionReason
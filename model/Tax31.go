@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Tax related to an investment fund order.
 type Tax31 struct {
 
@@ -22,6 +26,10 @@ type Tax31 struct {
 	TaxCalculationDetails *TaxCalculationInformation10 `xml:"TaxClctnDtls,omitempty"`
 }
 
+func (t *Tax31) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax31) AddType() *TaxType3Choice {
 	t.Type = new(TaxType3Choice)
 	return t.Type
@@ -31,10 +39,19 @@ func (t *Tax31) SetAppliedAmount(value, currency string) {
 	t.AppliedAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *Tax31) SetAppliedAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.AppliedAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *Tax31) SetAppliedRate(value string) {
 	t.AppliedRate = (*PercentageRate)(&value)
 }
 
+func (t *Tax31) SetAppliedRateFromDecimal(value decimal.Decimal) {
+	t.AppliedRate = new(PercentageRate)
+	t.AppliedRate.FromDecimal(value)
+}
+
 func (t *Tax31) SetCountry(value string) {
 	t.Country = (*CountryCode)(&value)
 }
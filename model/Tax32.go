@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Tax related to an investment fund order.
 type Tax32 struct {
 
@@ -28,6 +32,10 @@ type Tax32 struct {
 	TaxCalculationDetails *TaxCalculationInformation10 `xml:"TaxClctnDtls,omitempty"`
 }
 
+func (t *Tax32) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax32) AddType() *TaxType3Choice {
 	t.Type = new(TaxType3Choice)
 	return t.Type
@@ -37,10 +45,19 @@ func (t *Tax32) SetInformativeAmount(value, currency string) {
 	t.InformativeAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *Tax32) SetInformativeAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.InformativeAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *Tax32) SetInformativeRate(value string) {
 	t.InformativeRate = (*PercentageRate)(&value)
 }
 
+func (t *Tax32) SetInformativeRateFromDecimal(value decimal.Decimal) {
+	t.InformativeRate = new(PercentageRate)
+	t.InformativeRate.FromDecimal(value)
+}
+
 func (t *Tax32) SetCountry(value string) {
 	t.Country = (*CountryCode)(&value)
 }
@@ -49,6 +66,11 @@ func (t *Tax32) SetExemptionIndicator(value string) {
 	t.ExemptionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Tax32) SetExemptionIndicatorFromBool(value bool) {
+	t.ExemptionIndicator = new(YesNoIndicator)
+	t.ExemptionIndicator.FromBool(value)
+}
+
 func (t *Tax32) AddExemptionReason() *ExemptionReason1Choice {
 	t.ExemptionReason = new(ExemptionReason1Choice)
 	return t.ExemptionReason
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Tax related to an investment fund order.
 type Tax6 struct {
 
@@ -25,6 +29,10 @@ type Tax6 struct {
 	TaxCalculationDetails *TaxCalculationInformation1 `xml:"TaxClctnDtls,omitempty"`
 }
 
+func (t *Tax6) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax6) AddType() *TaxType1 {
 	t.Type = new(TaxType1)
 	return t.Type
@@ -34,10 +42,19 @@ func (t *Tax6) SetAmount(value, currency string) {
 	t.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Tax6) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Tax6) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
 
+func (t *Tax6) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
+
 func (t *Tax6) AddRecipientIdentification() *PartyIdentification2Choice {
 	t.RecipientIdentification = new(PartyIdentification2Choice)
 	return t.RecipientIdentification
@@ -47,6 +64,11 @@ func (t *Tax6) SetExemptionIndicator(value string) {
 	t.ExemptionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Tax6) SetExemptionIndicatorFromBool(value bool) {
+	t.ExemptionIndicator = new(YesNoIndicator)
+	t.ExemptionIndicator.FromBool(value)
+}
+
 func (t *Tax6) AddExemptionReason() *TaxExemptionReason1 {
 	t.ExemptionReason = new(TaxExemptionReason1)
 	return t.ExemptionReason
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Tax related to an investment fund order.
 type Tax8 struct {
 
@@ -19,6 +23,10 @@ type Tax8 struct {
 	TaxCalculationDetails *TaxCalculationInformation2 `xml:"TaxClctnDtls,omitempty"`
 }
 
+func (t *Tax8) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tax8) AddType() *TaxType3 {
 	t.Type = new(TaxType3)
 	return t.Type
@@ -28,10 +36,19 @@ func (t *Tax8) SetAmount(value, currency string) {
 	t.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Tax8) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Tax8) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
 
+func (t *Tax8) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
+
 func (t *Tax8) SetCountry(value string) {
 	t.Country = (*CountryCode)(&value)
 }
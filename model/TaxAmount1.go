@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of elements used to provide information on the tax amount(s) of tax record.
 type TaxAmount1 struct {
 
@@ -16,24 +21,102 @@ type TaxAmount1 struct {
 	Details []*TaxRecordDetails1 `xml:"Dtls,omitempty"`
 }
 
+func (t *TaxAmount1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxAmount1) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
 
+func (t *TaxAmount1) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
+
 func (t *TaxAmount1) SetTaxableBaseAmount(value, currency string) {
 	t.TaxableBaseAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxAmount1) SetTaxableBaseAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TaxableBaseAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxAmount1) SetTotalAmount(value, currency string) {
 	t.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxAmount1) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxAmount1) AddDetails() *TaxRecordDetails1 {
 	newValue := new(TaxRecordDetails1)
 	t.Details = append(t.Details, newValue)
 	return newValue
 }
 
+func (t *TaxAmount1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Rate != nil {
+		enc.Element("Rate", string(*t.Rate))
+	}
+	if t.TaxableBaseAmount != nil {
+		t.TaxableBaseAmount.EncodeXMLFast(enc, "TaxblBaseAmt")
+	}
+	if t.TotalAmount != nil {
+		t.TotalAmount.EncodeXMLFast(enc, "TtlAmt")
+	}
+	for _, elem := range t.Details {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Dtls")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TaxAmount1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Rate":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Rate = (*PercentageRate)(&value)
+		case "TaxblBaseAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TaxableBaseAmount = elem
+		case "TtlAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalAmount = elem
+		case "Dtls":
+			elem := new(TaxRecordDetails1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Details = append(t.Details, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -0,0 +1,118 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Set of elements used to provide information on the tax amount(s) of tax record.
+type TaxAmount2 struct {
+
+	// Rate used to calculate the tax.
+	Rate *PercentageRate `xml:"Rate,omitempty"`
+
+	// Amount of money on which the tax is based.
+	TaxableBaseAmount *ActiveOrHistoricCurrencyAndAmount `xml:"TaxblBaseAmt,omitempty"`
+
+	// Total amount that is the result of the calculation of the tax for the record.
+	TotalAmount *ActiveOrHistoricCurrencyAndAmount `xml:"TtlAmt,omitempty"`
+
+	// Set of elements used to provide details on the tax period and amount.
+	Details []*TaxRecordDetails2 `xml:"Dtls,omitempty"`
+}
+
+func (t *TaxAmount2) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TaxAmount2) SetRate(value string) {
+	t.Rate = (*PercentageRate)(&value)
+}
+
+func (t *TaxAmount2) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
+
+func (t *TaxAmount2) SetTaxableBaseAmount(value, currency string) {
+	t.TaxableBaseAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (t *TaxAmount2) SetTaxableBaseAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TaxableBaseAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (t *TaxAmount2) SetTotalAmount(value, currency string) {
+	t.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (t *TaxAmount2) SetTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (t *TaxAmount2) AddDetails() *TaxRecordDetails2 {
+	newValue := new(TaxRecordDetails2)
+	t.Details = append(t.Details, newValue)
+	return newValue
+}
+
+func (t *TaxAmount2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Rate != nil {
+		enc.Element("Rate", string(*t.Rate))
+	}
+	if t.TaxableBaseAmount != nil {
+		t.TaxableBaseAmount.EncodeXMLFast(enc, "TaxblBaseAmt")
+	}
+	if t.TotalAmount != nil {
+		t.TotalAmount.EncodeXMLFast(enc, "TtlAmt")
+	}
+	for _, elem := range t.Details {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Dtls")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TaxAmount2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Rate":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Rate = (*PercentageRate)(&value)
+		case "TaxblBaseAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TaxableBaseAmount = elem
+		case "TtlAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalAmount = elem
+		case "Dtls":
+			elem := new(TaxRecordDetails2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Details = append(t.Details, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
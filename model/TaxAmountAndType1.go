@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Specifies the amount with a specific type.
 type TaxAmountAndType1 struct {
 
@@ -10,6 +15,10 @@ type TaxAmountAndType1 struct {
 	Amount *ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
 }
 
+func (t *TaxAmountAndType1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxAmountAndType1) AddType() *TaxAmountType1Choice {
 	t.Type = new(TaxAmountType1Choice)
 	return t.Type
@@ -18,3 +27,48 @@ func (t *TaxAmountAndType1) AddType() *TaxAmountType1Choice {
 func (t *TaxAmountAndType1) SetAmount(value, currency string) {
 	t.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (t *TaxAmountAndType1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (t *TaxAmountAndType1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Type != nil {
+		t.Type.EncodeXMLFast(enc, "Tp")
+	}
+	if t.Amount != nil {
+		t.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	enc.End(tag)
+}
+
+func (t *TaxAmountAndType1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			elem := new(TaxAmountType1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Type = elem
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Amount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
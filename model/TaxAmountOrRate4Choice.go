@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between formats for the specification of a tax.
 type TaxAmountOrRate4Choice struct {
 
@@ -10,10 +14,23 @@ type TaxAmountOrRate4Choice struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (t *TaxAmountOrRate4Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxAmountOrRate4Choice) SetAmount(value, currency string) {
 	t.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxAmountOrRate4Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxAmountOrRate4Choice) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
+
+func (t *TaxAmountOrRate4Choice) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the amount type.
 type TaxAmountType1Choice struct {
 
@@ -10,6 +12,10 @@ type TaxAmountType1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (t *TaxAmountType1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxAmountType1Choice) SetCode(value string) {
 	t.Code = (*ExternalTaxAmountType1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (t *TaxAmountType1Choice) SetCode(value string) {
 func (t *TaxAmountType1Choice) SetProprietary(value string) {
 	t.Proprietary = (*Max35Text)(&value)
 }
+
+func (t *TaxAmountType1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Code != nil {
+		enc.Element("Cd", string(*t.Code))
+	}
+	if t.Proprietary != nil {
+		enc.Element("Prtry", string(*t.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (t *TaxAmountType1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Code = (*ExternalTaxAmountType1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Details of the authorised tax paying party.
 type TaxAuthorisation1 struct {
 
@@ -10,6 +12,10 @@ type TaxAuthorisation1 struct {
 	Name *Max140Text `xml:"Nm,omitempty"`
 }
 
+func (t *TaxAuthorisation1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxAuthorisation1) SetTitle(value string) {
 	t.Title = (*Max35Text)(&value)
 }
@@ -17,3 +23,44 @@ func (t *TaxAuthorisation1) SetTitle(value string) {
 func (t *TaxAuthorisation1) SetName(value string) {
 	t.Name = (*Max140Text)(&value)
 }
+
+func (t *TaxAuthorisation1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Title != nil {
+		enc.Element("Titl", string(*t.Title))
+	}
+	if t.Name != nil {
+		enc.Element("Nm", string(*t.Name))
+	}
+	enc.End(tag)
+}
+
+func (t *TaxAuthorisation1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Titl":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Title = (*Max35Text)(&value)
+		case "Nm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Name = (*Max140Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
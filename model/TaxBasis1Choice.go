@@ -10,6 +10,10 @@ type TaxBasis1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TaxBasis1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxBasis1Choice) SetCode(value string) {
 	t.Code = (*TaxationBasis2Code)(&value)
 }
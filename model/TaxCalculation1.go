@@ -27,6 +27,10 @@ type TaxCalculation1 struct {
 	TotalTax *AmountAndDirection34 `xml:"TtlTax"`
 }
 
+func (t *TaxCalculation1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCalculation1) SetHostCurrency(value string) {
 	t.HostCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -7,6 +7,10 @@ type TaxCalculationInformation1 struct {
 	Basis *TaxationBasis1 `xml:"Bsis,omitempty"`
 }
 
+func (t *TaxCalculationInformation1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCalculationInformation1) AddBasis() *TaxationBasis1 {
 	t.Basis = new(TaxationBasis1)
 	return t.Basis
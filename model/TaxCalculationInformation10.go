@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information used to calculate the tax.
 type TaxCalculationInformation10 struct {
 
@@ -10,6 +14,10 @@ type TaxCalculationInformation10 struct {
 	TaxableAmount *ActiveCurrencyAndAmount `xml:"TaxblAmt"`
 }
 
+func (t *TaxCalculationInformation10) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCalculationInformation10) AddBasis() *TaxBasis1Choice {
 	t.Basis = new(TaxBasis1Choice)
 	return t.Basis
@@ -18,3 +26,7 @@ func (t *TaxCalculationInformation10) AddBasis() *TaxBasis1Choice {
 func (t *TaxCalculationInformation10) SetTaxableAmount(value, currency string) {
 	t.TaxableAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (t *TaxCalculationInformation10) SetTaxableAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TaxableAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
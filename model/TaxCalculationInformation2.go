@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information used to calculate the tax.
 type TaxCalculationInformation2 struct {
 
@@ -13,6 +17,10 @@ type TaxCalculationInformation2 struct {
 	PercentageGrandfatheredDebt *PercentageRate `xml:"PctgGrdfthdDebt,omitempty"`
 }
 
+func (t *TaxCalculationInformation2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCalculationInformation2) AddEUCapitalGain() *EUCapitalGain1 {
 	t.EUCapitalGain = new(EUCapitalGain1)
 	return t.EUCapitalGain
@@ -22,6 +30,16 @@ func (t *TaxCalculationInformation2) SetPercentageOfDebtClaim(value string) {
 	t.PercentageOfDebtClaim = (*PercentageRate)(&value)
 }
 
+func (t *TaxCalculationInformation2) SetPercentageOfDebtClaimFromDecimal(value decimal.Decimal) {
+	t.PercentageOfDebtClaim = new(PercentageRate)
+	t.PercentageOfDebtClaim.FromDecimal(value)
+}
+
 func (t *TaxCalculationInformation2) SetPercentageGrandfatheredDebt(value string) {
 	t.PercentageGrandfatheredDebt = (*PercentageRate)(&value)
 }
+
+func (t *TaxCalculationInformation2) SetPercentageGrandfatheredDebtFromDecimal(value decimal.Decimal) {
+	t.PercentageGrandfatheredDebt = new(PercentageRate)
+	t.PercentageGrandfatheredDebt.FromDecimal(value)
+}
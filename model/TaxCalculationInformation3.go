@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information used to calculate the tax.
 type TaxCalculationInformation3 struct {
 
@@ -13,6 +17,10 @@ type TaxCalculationInformation3 struct {
 	TaxableAmount *ActiveCurrencyAnd13DecimalAmount `xml:"TaxblAmt,omitempty"`
 }
 
+func (t *TaxCalculationInformation3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCalculationInformation3) AddBasis() *TaxationBasis1 {
 	t.Basis = new(TaxationBasis1)
 	return t.Basis
@@ -26,3 +34,7 @@ func (t *TaxCalculationInformation3) AddEUCapitalGain() *EUCapitalGain1 {
 func (t *TaxCalculationInformation3) SetTaxableAmount(value, currency string) {
 	t.TaxableAmount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (t *TaxCalculationInformation3) SetTaxableAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TaxableAmount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information used to calculate the tax.
 type TaxCalculationInformation4 struct {
 
@@ -25,6 +29,10 @@ type TaxCalculationInformation4 struct {
 	ExtendedEUDividendStatus *Extended350Code `xml:"XtndedEUDvddSts,omitempty"`
 }
 
+func (t *TaxCalculationInformation4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCalculationInformation4) SetEUCapitalGain(value string) {
 	t.EUCapitalGain = (*EUCapitalGain2Code)(&value)
 }
@@ -37,14 +45,28 @@ func (t *TaxCalculationInformation4) SetPercentageOfDebtClaim(value string) {
 	t.PercentageOfDebtClaim = (*PercentageRate)(&value)
 }
 
+func (t *TaxCalculationInformation4) SetPercentageOfDebtClaimFromDecimal(value decimal.Decimal) {
+	t.PercentageOfDebtClaim = new(PercentageRate)
+	t.PercentageOfDebtClaim.FromDecimal(value)
+}
+
 func (t *TaxCalculationInformation4) SetPercentageGrandfatheredDebt(value string) {
 	t.PercentageGrandfatheredDebt = (*PercentageRate)(&value)
 }
 
+func (t *TaxCalculationInformation4) SetPercentageGrandfatheredDebtFromDecimal(value decimal.Decimal) {
+	t.PercentageGrandfatheredDebt = new(PercentageRate)
+	t.PercentageGrandfatheredDebt.FromDecimal(value)
+}
+
 func (t *TaxCalculationInformation4) SetTaxableIncomePerDividend(value, currency string) {
 	t.TaxableIncomePerDividend = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *TaxCalculationInformation4) SetTaxableIncomePerDividendFromDecimal(value decimal.Decimal, currency string) {
+	t.TaxableIncomePerDividend = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *TaxCalculationInformation4) SetEUDividendStatus(value string) {
 	t.EUDividendStatus = (*EUDividendStatus1Code)(&value)
 }
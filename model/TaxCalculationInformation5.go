@@ -10,6 +10,10 @@ type TaxCalculationInformation5 struct {
 	ExtendedBasis *Extended350Code `xml:"XtndedBsis,omitempty"`
 }
 
+func (t *TaxCalculationInformation5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCalculationInformation5) SetBasis(value string) {
 	t.Basis = (*TaxationBasis2Code)(&value)
 }
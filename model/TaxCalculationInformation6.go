@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information used to calculate the tax.
 type TaxCalculationInformation6 struct {
 
@@ -13,6 +17,10 @@ type TaxCalculationInformation6 struct {
 	TaxableAmount *ActiveCurrencyAnd13DecimalAmount `xml:"TaxblAmt,omitempty"`
 }
 
+func (t *TaxCalculationInformation6) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCalculationInformation6) SetBasis(value string) {
 	t.Basis = (*TaxationBasis2Code)(&value)
 }
@@ -24,3 +32,7 @@ func (t *TaxCalculationInformation6) SetExtendedBasis(value string) {
 func (t *TaxCalculationInformation6) SetTaxableAmount(value, currency string) {
 	t.TaxableAmount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (t *TaxCalculationInformation6) SetTaxableAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TaxableAmount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
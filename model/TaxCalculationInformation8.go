@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information used to calculate the tax.
 type TaxCalculationInformation8 struct {
 
@@ -10,6 +14,10 @@ type TaxCalculationInformation8 struct {
 	TaxableAmount *ActiveCurrencyAnd13DecimalAmount `xml:"TaxblAmt"`
 }
 
+func (t *TaxCalculationInformation8) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCalculationInformation8) AddBasis() *TaxBasis1Choice {
 	t.Basis = new(TaxBasis1Choice)
 	return t.Basis
@@ -18,3 +26,7 @@ func (t *TaxCalculationInformation8) AddBasis() *TaxBasis1Choice {
 func (t *TaxCalculationInformation8) SetTaxableAmount(value, currency string) {
 	t.TaxableAmount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (t *TaxCalculationInformation8) SetTaxableAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TaxableAmount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -7,6 +7,10 @@ type TaxCalculationInformation9 struct {
 	Basis *TaxBasis1Choice `xml:"Bsis"`
 }
 
+func (t *TaxCalculationInformation9) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCalculationInformation9) AddBasis() *TaxBasis1Choice {
 	t.Basis = new(TaxBasis1Choice)
 	return t.Basis
@@ -10,6 +10,10 @@ type TaxCapacityParty1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (t *TaxCapacityParty1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCapacityParty1Choice) SetCode(value string) {
 	t.Code = (*TaxLiability1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TaxCapacityParty3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (t *TaxCapacityParty3Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCapacityParty3Choice) SetCode(value string) {
 	t.Code = (*TaxLiability1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TaxCapacityParty4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (t *TaxCapacityParty4Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCapacityParty4Choice) SetCode(value string) {
 	t.Code = (*TaxLiability1Code)(&value)
 }
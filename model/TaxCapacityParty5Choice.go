@@ -10,6 +10,10 @@ type TaxCapacityParty5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TaxCapacityParty5Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCapacityParty5Choice) SetCode(value string) {
 	t.Code = (*TaxLiability1Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money due to the government or tax authority, according to various pre-defined parameters such as thresholds or income.
 type TaxCharges1 struct {
 
@@ -13,6 +17,10 @@ type TaxCharges1 struct {
 	Amount *CurrencyAndAmount `xml:"Amt,omitempty"`
 }
 
+func (t *TaxCharges1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCharges1) SetIdentification(value string) {
 	t.Identification = (*Max35Text)(&value)
 }
@@ -21,6 +29,15 @@ func (t *TaxCharges1) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
 
+func (t *TaxCharges1) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
+
 func (t *TaxCharges1) SetAmount(value, currency string) {
 	t.Amount = NewCurrencyAndAmount(value, currency)
 }
+
+func (t *TaxCharges1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Amount of money due to the government or tax authority, according to various pre-defined parameters such as thresholds or income.
 type TaxCharges2 struct {
 
@@ -13,6 +18,10 @@ type TaxCharges2 struct {
 	Amount *ActiveOrHistoricCurrencyAndAmount `xml:"Amt,omitempty"`
 }
 
+func (t *TaxCharges2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCharges2) SetIdentification(value string) {
 	t.Identification = (*Max35Text)(&value)
 }
@@ -21,6 +30,65 @@ func (t *TaxCharges2) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
 
+func (t *TaxCharges2) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
+
 func (t *TaxCharges2) SetAmount(value, currency string) {
 	t.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (t *TaxCharges2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (t *TaxCharges2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Identification != nil {
+		enc.Element("Id", string(*t.Identification))
+	}
+	if t.Rate != nil {
+		enc.Element("Rate", string(*t.Rate))
+	}
+	if t.Amount != nil {
+		t.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	enc.End(tag)
+}
+
+func (t *TaxCharges2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Id":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Identification = (*Max35Text)(&value)
+		case "Rate":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Rate = (*PercentageRate)(&value)
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Amount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or a rate or an unspecified rate.
 type TaxCreditRateFormat10Choice struct {
 
@@ -16,14 +20,27 @@ type TaxCreditRateFormat10Choice struct {
 	NotSpecifiedRate *RateValueType7Code `xml:"NotSpcfdRate"`
 }
 
+func (t *TaxCreditRateFormat10Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCreditRateFormat10Choice) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
 
+func (t *TaxCreditRateFormat10Choice) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
+
 func (t *TaxCreditRateFormat10Choice) SetAmount(value, currency string) {
 	t.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *TaxCreditRateFormat10Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *TaxCreditRateFormat10Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus34 {
 	t.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus34)
 	return t.RateTypeAndAmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or a rate or an unspecified rate.
 type TaxCreditRateFormat1Choice struct {
 
@@ -16,14 +20,27 @@ type TaxCreditRateFormat1Choice struct {
 	NotSpecifiedRate *RateValueType6Code `xml:"NotSpcfdRate"`
 }
 
+func (t *TaxCreditRateFormat1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCreditRateFormat1Choice) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
 
+func (t *TaxCreditRateFormat1Choice) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
+
 func (t *TaxCreditRateFormat1Choice) SetAmount(value, currency string) {
 	t.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *TaxCreditRateFormat1Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *TaxCreditRateFormat1Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus5 {
 	t.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus5)
 	return t.RateTypeAndAmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type TaxCreditRateFormat2Choice struct {
 
@@ -13,14 +17,27 @@ type TaxCreditRateFormat2Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus5 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (t *TaxCreditRateFormat2Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCreditRateFormat2Choice) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
 
+func (t *TaxCreditRateFormat2Choice) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
+
 func (t *TaxCreditRateFormat2Choice) SetAmount(value, currency string) {
 	t.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *TaxCreditRateFormat2Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *TaxCreditRateFormat2Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus5 {
 	t.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus5)
 	return t.RateTypeAndAmountAndRateStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type TaxCreditRateFormat7Choice struct {
 
@@ -13,14 +17,27 @@ type TaxCreditRateFormat7Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus27 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (t *TaxCreditRateFormat7Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCreditRateFormat7Choice) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
 
+func (t *TaxCreditRateFormat7Choice) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
+
 func (t *TaxCreditRateFormat7Choice) SetAmount(value, currency string) {
 	t.Amount = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *TaxCreditRateFormat7Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *TaxCreditRateFormat7Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus27 {
 	t.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus27)
 	return t.RateTypeAndAmountAndRateStatus
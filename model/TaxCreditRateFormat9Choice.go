@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or an unspecified rate.
 type TaxCreditRateFormat9Choice struct {
 
@@ -13,14 +17,27 @@ type TaxCreditRateFormat9Choice struct {
 	RateTypeAndAmountAndRateStatus *RateTypeAndAmountAndStatus34 `xml:"RateTpAndAmtAndRateSts"`
 }
 
+func (t *TaxCreditRateFormat9Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxCreditRateFormat9Choice) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
 
+func (t *TaxCreditRateFormat9Choice) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
+
 func (t *TaxCreditRateFormat9Choice) SetAmount(value, currency string) {
 	t.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *TaxCreditRateFormat9Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewRestrictedFINActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *TaxCreditRateFormat9Choice) AddRateTypeAndAmountAndRateStatus() *RateTypeAndAmountAndStatus34 {
 	t.RateTypeAndAmountAndRateStatus = new(RateTypeAndAmountAndStatus34)
 	return t.RateTypeAndAmountAndRateStatus
@@ -10,6 +10,10 @@ type TaxDetails struct {
 	TaxType *TaxType `xml:"TaxTp,omitempty"`
 }
 
+func (t *TaxDetails) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxDetails) SetCertificateIdentification(value string) {
 	t.CertificateIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type TaxExemptionReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TaxExemptionReason1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxExemptionReason1) SetStructured(value string) {
 	t.Structured = (*TaxExemptReason2Code)(&value)
 }
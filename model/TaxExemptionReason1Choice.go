@@ -10,6 +10,10 @@ type TaxExemptionReason1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TaxExemptionReason1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxExemptionReason1Choice) SetCode(value string) {
 	t.Code = (*TaxExemptReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TaxExemptionReason2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TaxExemptionReason2Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxExemptionReason2Choice) SetCode(value string) {
 	t.Code = (*TaxExemptReason3Code)(&value)
 }
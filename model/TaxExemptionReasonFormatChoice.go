@@ -10,6 +10,10 @@ type TaxExemptionReasonFormatChoice struct {
 	Structured *TaxExemptReason1Code `xml:"Strd"`
 }
 
+func (t *TaxExemptionReasonFormatChoice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxExemptionReasonFormatChoice) SetUnstructured(value string) {
 	t.Unstructured = (*Max140Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Tax identification information.
 type TaxIdentification2 struct {
 
@@ -22,6 +26,10 @@ type TaxIdentification2 struct {
 	IssuerCountry *CountryCode `xml:"IssrCtry"`
 }
 
+func (t *TaxIdentification2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxIdentification2) SetIdentification(value string) {
 	t.Identification = (*Max35Text)(&value)
 }
@@ -39,10 +47,20 @@ func (t *TaxIdentification2) SetIssueDate(value string) {
 	t.IssueDate = (*ISODate)(&value)
 }
 
+func (t *TaxIdentification2) SetIssueDateFromTime(value time.Time) {
+	t.IssueDate = new(ISODate)
+	t.IssueDate.FromTime(value)
+}
+
 func (t *TaxIdentification2) SetExpiryDate(value string) {
 	t.ExpiryDate = (*ISODate)(&value)
 }
 
+func (t *TaxIdentification2) SetExpiryDateFromTime(value time.Time) {
+	t.ExpiryDate = new(ISODate)
+	t.ExpiryDate.FromTime(value)
+}
+
 func (t *TaxIdentification2) SetIssuerCountry(value string) {
 	t.IssuerCountry = (*CountryCode)(&value)
 }
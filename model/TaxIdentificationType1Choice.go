@@ -10,6 +10,10 @@ type TaxIdentificationType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TaxIdentificationType1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxIdentificationType1Choice) SetCode(value string) {
 	t.Code = (*TaxIdentificationNumberType1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details about tax paid, or to be paid, to the government in accordance with the law, including pre-defined parameters such as thresholds and type of account.
 type TaxInformation2 struct {
 
@@ -28,6 +34,10 @@ type TaxInformation2 struct {
 	TaxTypeInformation []*TaxDetails `xml:"TaxTpInf,omitempty"`
 }
 
+func (t *TaxInformation2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxInformation2) SetCreditorTaxIdentification(value string) {
 	t.CreditorTaxIdentification = (*Max35Text)(&value)
 }
@@ -48,14 +58,27 @@ func (t *TaxInformation2) SetTotalTaxableBaseAmount(value, currency string) {
 	t.TotalTaxableBaseAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxInformation2) SetTotalTaxableBaseAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalTaxableBaseAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxInformation2) SetTotalTaxAmount(value, currency string) {
 	t.TotalTaxAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxInformation2) SetTotalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalTaxAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxInformation2) SetTaxDate(value string) {
 	t.TaxDate = (*ISODate)(&value)
 }
 
+func (t *TaxInformation2) SetTaxDateFromTime(value time.Time) {
+	t.TaxDate = new(ISODate)
+	t.TaxDate.FromTime(value)
+}
+
 func (t *TaxInformation2) AddTaxTypeInformation() *TaxDetails {
 	newValue := new(TaxDetails)
 	t.TaxTypeInformation = append(t.TaxTypeInformation, newValue)
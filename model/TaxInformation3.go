@@ -1,5 +1,12 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Details about tax paid, or to be paid, to the government in accordance with the law, including pre-defined parameters such as thresholds and type of account.
 type TaxInformation3 struct {
 
@@ -34,6 +41,10 @@ type TaxInformation3 struct {
 	Record []*TaxRecord1 `xml:"Rcrd,omitempty"`
 }
 
+func (t *TaxInformation3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxInformation3) AddCreditor() *TaxParty1 {
 	t.Creditor = new(TaxParty1)
 	return t.Creditor
@@ -60,24 +71,157 @@ func (t *TaxInformation3) SetTotalTaxableBaseAmount(value, currency string) {
 	t.TotalTaxableBaseAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxInformation3) SetTotalTaxableBaseAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalTaxableBaseAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxInformation3) SetTotalTaxAmount(value, currency string) {
 	t.TotalTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxInformation3) SetTotalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxInformation3) SetDate(value string) {
 	t.Date = (*ISODate)(&value)
 }
 
+func (t *TaxInformation3) SetDateFromTime(value time.Time) {
+	t.Date = new(ISODate)
+	t.Date.FromTime(value)
+}
+
 func (t *TaxInformation3) SetSequenceNumber(value string) {
 	t.SequenceNumber = (*Number)(&value)
 }
 
+func (t *TaxInformation3) SetSequenceNumberFromDecimal(value decimal.Decimal) {
+	t.SequenceNumber = new(Number)
+	t.SequenceNumber.FromDecimal(value)
+}
+
 func (t *TaxInformation3) AddRecord() *TaxRecord1 {
 	newValue := new(TaxRecord1)
 	t.Record = append(t.Record, newValue)
 	return newValue
 }
 
+func (t *TaxInformation3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Creditor != nil {
+		t.Creditor.EncodeXMLFast(enc, "Cdtr")
+	}
+	if t.Debtor != nil {
+		t.Debtor.EncodeXMLFast(enc, "Dbtr")
+	}
+	if t.AdministrationZone != nil {
+		enc.Element("AdmstnZn", string(*t.AdministrationZone))
+	}
+	if t.ReferenceNumber != nil {
+		enc.Element("RefNb", string(*t.ReferenceNumber))
+	}
+	if t.Method != nil {
+		enc.Element("Mtd", string(*t.Method))
+	}
+	if t.TotalTaxableBaseAmount != nil {
+		t.TotalTaxableBaseAmount.EncodeXMLFast(enc, "TtlTaxblBaseAmt")
+	}
+	if t.TotalTaxAmount != nil {
+		t.TotalTaxAmount.EncodeXMLFast(enc, "TtlTaxAmt")
+	}
+	if t.Date != nil {
+		enc.Element("Dt", string(*t.Date))
+	}
+	if t.SequenceNumber != nil {
+		enc.Element("SeqNb", string(*t.SequenceNumber))
+	}
+	for _, elem := range t.Record {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Rcrd")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TaxInformation3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cdtr":
+			elem := new(TaxParty1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Creditor = elem
+		case "Dbtr":
+			elem := new(TaxParty2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Debtor = elem
+		case "AdmstnZn":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.AdministrationZone = (*Max35Text)(&value)
+		case "RefNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.ReferenceNumber = (*Max140Text)(&value)
+		case "Mtd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Method = (*Max35Text)(&value)
+		case "TtlTaxblBaseAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalTaxableBaseAmount = elem
+		case "TtlTaxAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalTaxAmount = elem
+		case "Dt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Date = (*ISODate)(&value)
+		case "SeqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.SequenceNumber = (*Number)(&value)
+		case "Rcrd":
+			elem := new(TaxRecord1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Record = append(t.Record, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -0,0 +1,240 @@
+package model
+
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Details about tax paid, or to be paid, to the government in accordance with the law, including pre-defined parameters such as thresholds and type of account.
+type TaxInformation7 struct {
+
+	// Party on the credit side of the transaction to which the tax applies.
+	Creditor *TaxParty1 `xml:"Cdtr,omitempty"`
+
+	// Identifies the party on the debit side of the transaction to which the tax applies.
+	Debtor *TaxParty2 `xml:"Dbtr,omitempty"`
+
+	// Ultimate party that owes an amount of money to the (ultimate) creditor, in this case, to the taxing authority.
+	UltimateDebtor *TaxParty2 `xml:"UltmtDbtr,omitempty"`
+
+	// Territorial part of a country to which the tax payment is related.
+	AdministrationZone *Max35Text `xml:"AdmstnZone,omitempty"`
+
+	// Tax reference information that is specific to a taxing agency.
+	ReferenceNumber *Max140Text `xml:"RefNb,omitempty"`
+
+	// Method used to indicate the underlying business or how the tax is paid.
+	Method *Max35Text `xml:"Mtd,omitempty"`
+
+	// Total amount of money on which the tax is based.
+	TotalTaxableBaseAmount *ActiveOrHistoricCurrencyAndAmount `xml:"TtlTaxblBaseAmt,omitempty"`
+
+	// Total amount of money as result of the calculation of the tax.
+	TotalTaxAmount *ActiveOrHistoricCurrencyAndAmount `xml:"TtlTaxAmt,omitempty"`
+
+	// Date by which tax is due.
+	Date *ISODate `xml:"Dt,omitempty"`
+
+	// Sequential number of the tax report.
+	SequenceNumber *Number `xml:"SeqNb,omitempty"`
+
+	// Record of tax details.
+	Record []*TaxRecord2 `xml:"Rcrd,omitempty"`
+}
+
+func (t *TaxInformation7) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TaxInformation7) AddCreditor() *TaxParty1 {
+	t.Creditor = new(TaxParty1)
+	return t.Creditor
+}
+
+func (t *TaxInformation7) AddDebtor() *TaxParty2 {
+	t.Debtor = new(TaxParty2)
+	return t.Debtor
+}
+
+func (t *TaxInformation7) AddUltimateDebtor() *TaxParty2 {
+	t.UltimateDebtor = new(TaxParty2)
+	return t.UltimateDebtor
+}
+
+func (t *TaxInformation7) SetAdministrationZone(value string) {
+	t.AdministrationZone = (*Max35Text)(&value)
+}
+
+func (t *TaxInformation7) SetReferenceNumber(value string) {
+	t.ReferenceNumber = (*Max140Text)(&value)
+}
+
+func (t *TaxInformation7) SetMethod(value string) {
+	t.Method = (*Max35Text)(&value)
+}
+
+func (t *TaxInformation7) SetTotalTaxableBaseAmount(value, currency string) {
+	t.TotalTaxableBaseAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (t *TaxInformation7) SetTotalTaxableBaseAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalTaxableBaseAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (t *TaxInformation7) SetTotalTaxAmount(value, currency string) {
+	t.TotalTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (t *TaxInformation7) SetTotalTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (t *TaxInformation7) SetDate(value string) {
+	t.Date = (*ISODate)(&value)
+}
+
+func (t *TaxInformation7) SetDateFromTime(value time.Time) {
+	t.Date = new(ISODate)
+	t.Date.FromTime(value)
+}
+
+func (t *TaxInformation7) SetSequenceNumber(value string) {
+	t.SequenceNumber = (*Number)(&value)
+}
+
+func (t *TaxInformation7) SetSequenceNumberFromDecimal(value decimal.Decimal) {
+	t.SequenceNumber = new(Number)
+	t.SequenceNumber.FromDecimal(value)
+}
+
+func (t *TaxInformation7) AddRecord() *TaxRecord2 {
+	newValue := new(TaxRecord2)
+	t.Record = append(t.Record, newValue)
+	return newValue
+}
+
+func (t *TaxInformation7) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Creditor != nil {
+		t.Creditor.EncodeXMLFast(enc, "Cdtr")
+	}
+	if t.Debtor != nil {
+		t.Debtor.EncodeXMLFast(enc, "Dbtr")
+	}
+	if t.UltimateDebtor != nil {
+		t.UltimateDebtor.EncodeXMLFast(enc, "UltmtDbtr")
+	}
+	if t.AdministrationZone != nil {
+		enc.Element("AdmstnZone", string(*t.AdministrationZone))
+	}
+	if t.ReferenceNumber != nil {
+		enc.Element("RefNb", string(*t.ReferenceNumber))
+	}
+	if t.Method != nil {
+		enc.Element("Mtd", string(*t.Method))
+	}
+	if t.TotalTaxableBaseAmount != nil {
+		t.TotalTaxableBaseAmount.EncodeXMLFast(enc, "TtlTaxblBaseAmt")
+	}
+	if t.TotalTaxAmount != nil {
+		t.TotalTaxAmount.EncodeXMLFast(enc, "TtlTaxAmt")
+	}
+	if t.Date != nil {
+		enc.Element("Dt", string(*t.Date))
+	}
+	if t.SequenceNumber != nil {
+		enc.Element("SeqNb", string(*t.SequenceNumber))
+	}
+	for _, elem := range t.Record {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Rcrd")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TaxInformation7) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cdtr":
+			elem := new(TaxParty1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Creditor = elem
+		case "Dbtr":
+			elem := new(TaxParty2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Debtor = elem
+		case "UltmtDbtr":
+			elem := new(TaxParty2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.UltimateDebtor = elem
+		case "AdmstnZone":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.AdministrationZone = (*Max35Text)(&value)
+		case "RefNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.ReferenceNumber = (*Max140Text)(&value)
+		case "Mtd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Method = (*Max35Text)(&value)
+		case "TtlTaxblBaseAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalTaxableBaseAmount = elem
+		case "TtlTaxAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalTaxAmount = elem
+		case "Dt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Date = (*ISODate)(&value)
+		case "SeqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.SequenceNumber = (*Number)(&value)
+		case "Rcrd":
+			elem := new(TaxRecord2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Record = append(t.Record, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
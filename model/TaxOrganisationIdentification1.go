@@ -13,6 +13,10 @@ type TaxOrganisationIdentification1 struct {
 	ContactDetails *ContactDetails2 `xml:"CtctDtls,omitempty"`
 }
 
+func (t *TaxOrganisationIdentification1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxOrganisationIdentification1) SetName(value string) {
 	t.Name = (*Max140Text)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Details about the entity involved in the tax paid or to be paid.
 type TaxParty1 struct {
 
@@ -13,6 +15,10 @@ type TaxParty1 struct {
 	TaxType *Max35Text `xml:"TaxTp,omitempty"`
 }
 
+func (t *TaxParty1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxParty1) SetTaxIdentification(value string) {
 	t.TaxIdentification = (*Max35Text)(&value)
 }
@@ -24,3 +30,53 @@ func (t *TaxParty1) SetRegistrationIdentification(value string) {
 func (t *TaxParty1) SetTaxType(value string) {
 	t.TaxType = (*Max35Text)(&value)
 }
+
+func (t *TaxParty1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.TaxIdentification != nil {
+		enc.Element("TaxId", string(*t.TaxIdentification))
+	}
+	if t.RegistrationIdentification != nil {
+		enc.Element("RegnId", string(*t.RegistrationIdentification))
+	}
+	if t.TaxType != nil {
+		enc.Element("TaxTp", string(*t.TaxType))
+	}
+	enc.End(tag)
+}
+
+func (t *TaxParty1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "TaxId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TaxIdentification = (*Max35Text)(&value)
+		case "RegnId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.RegistrationIdentification = (*Max35Text)(&value)
+		case "TaxTp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TaxType = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
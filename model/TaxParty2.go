@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Details about the entity involved in the tax paid or to be paid.
 type TaxParty2 struct {
 
@@ -16,6 +18,10 @@ type TaxParty2 struct {
 	Authorisation *TaxAuthorisation1 `xml:"Authstn,omitempty"`
 }
 
+func (t *TaxParty2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxParty2) SetTaxIdentification(value string) {
 	t.TaxIdentification = (*Max35Text)(&value)
 }
@@ -32,3 +38,62 @@ func (t *TaxParty2) AddAuthorisation() *TaxAuthorisation1 {
 	t.Authorisation = new(TaxAuthorisation1)
 	return t.Authorisation
 }
+
+func (t *TaxParty2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.TaxIdentification != nil {
+		enc.Element("TaxId", string(*t.TaxIdentification))
+	}
+	if t.RegistrationIdentification != nil {
+		enc.Element("RegnId", string(*t.RegistrationIdentification))
+	}
+	if t.TaxType != nil {
+		enc.Element("TaxTp", string(*t.TaxType))
+	}
+	if t.Authorisation != nil {
+		t.Authorisation.EncodeXMLFast(enc, "Authstn")
+	}
+	enc.End(tag)
+}
+
+func (t *TaxParty2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "TaxId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TaxIdentification = (*Max35Text)(&value)
+		case "RegnId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.RegistrationIdentification = (*Max35Text)(&value)
+		case "TaxTp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TaxType = (*Max35Text)(&value)
+		case "Authstn":
+			elem := new(TaxAuthorisation1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Authorisation = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -16,6 +16,10 @@ type TaxParty3 struct {
 	TaxExemptionReason []*TaxExemptionReasonFormatChoice `xml:"TaxXmptnRsn,omitempty"`
 }
 
+func (t *TaxParty3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxParty3) SetTaxIdentification(value string) {
 	t.TaxIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Period of time details related to the tax payment.
 type TaxPeriod1 struct {
 
@@ -13,15 +19,78 @@ type TaxPeriod1 struct {
 	FromToDate *DatePeriodDetails `xml:"FrToDt,omitempty"`
 }
 
+func (t *TaxPeriod1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxPeriod1) SetYear(value string) {
 	t.Year = (*ISODate)(&value)
 }
 
-func (t *TaxPeriod1) SetType(value string) {
+func (t *TaxPeriod1) SetYearFromTime(value time.Time) {
+	t.Year = new(ISODate)
+	t.Year.FromTime(value)
+}
+
+func (t *TaxPeriod1) SetType(value string) error {
+	if err := TaxRecordPeriod1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Type = (*TaxRecordPeriod1Code)(&value)
+	return nil
 }
 
 func (t *TaxPeriod1) AddFromToDate() *DatePeriodDetails {
 	t.FromToDate = new(DatePeriodDetails)
 	return t.FromToDate
 }
+
+func (t *TaxPeriod1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Year != nil {
+		enc.Element("Yr", string(*t.Year))
+	}
+	if t.Type != nil {
+		enc.Element("Tp", string(*t.Type))
+	}
+	if t.FromToDate != nil {
+		t.FromToDate.EncodeXMLFast(enc, "FrToDt")
+	}
+	enc.End(tag)
+}
+
+func (t *TaxPeriod1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Yr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Year = (*ISODate)(&value)
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Type = (*TaxRecordPeriod1Code)(&value)
+		case "FrToDt":
+			elem := new(DatePeriodDetails)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.FromToDate = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
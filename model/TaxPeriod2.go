@@ -0,0 +1,96 @@
+package model
+
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Period of time details related to the tax payment.
+type TaxPeriod2 struct {
+
+	// Year related to the tax payment.
+	Year *ISODate `xml:"Yr,omitempty"`
+
+	// Identification of the period related to the tax payment.
+	Type *TaxRecordPeriod1Code `xml:"Tp,omitempty"`
+
+	// Range of time between a start date and an end date for which the tax report is provided.
+	FromToDate *DatePeriod2 `xml:"FrToDt,omitempty"`
+}
+
+func (t *TaxPeriod2) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TaxPeriod2) SetYear(value string) {
+	t.Year = (*ISODate)(&value)
+}
+
+func (t *TaxPeriod2) SetYearFromTime(value time.Time) {
+	t.Year = new(ISODate)
+	t.Year.FromTime(value)
+}
+
+func (t *TaxPeriod2) SetType(value string) error {
+	if err := TaxRecordPeriod1Code(value).Validate(); err != nil {
+		return err
+	}
+	t.Type = (*TaxRecordPeriod1Code)(&value)
+	return nil
+}
+
+func (t *TaxPeriod2) AddFromToDate() *DatePeriod2 {
+	t.FromToDate = new(DatePeriod2)
+	return t.FromToDate
+}
+
+func (t *TaxPeriod2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Year != nil {
+		enc.Element("Yr", string(*t.Year))
+	}
+	if t.Type != nil {
+		enc.Element("Tp", string(*t.Type))
+	}
+	if t.FromToDate != nil {
+		t.FromToDate.EncodeXMLFast(enc, "FrToDt")
+	}
+	enc.End(tag)
+}
+
+func (t *TaxPeriod2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Yr":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Year = (*ISODate)(&value)
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Type = (*TaxRecordPeriod1Code)(&value)
+		case "FrToDt":
+			elem := new(DatePeriod2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.FromToDate = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ type TaxReason1 struct {
 	Explanation *Max105Text `xml:"Expltn"`
 }
 
+func (t *TaxReason1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxReason1) SetCode(value string) {
 	t.Code = (*Max10Text)(&value)
 }
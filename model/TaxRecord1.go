@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to define the tax record.
 type TaxRecord1 struct {
 
@@ -31,6 +33,10 @@ type TaxRecord1 struct {
 	AdditionalInformation *Max140Text `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TaxRecord1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxRecord1) SetType(value string) {
 	t.Type = (*Max35Text)(&value)
 }
@@ -68,3 +74,107 @@ func (t *TaxRecord1) AddTaxAmount() *TaxAmount1 {
 func (t *TaxRecord1) SetAdditionalInformation(value string) {
 	t.AdditionalInformation = (*Max140Text)(&value)
 }
+
+func (t *TaxRecord1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Type != nil {
+		enc.Element("Tp", string(*t.Type))
+	}
+	if t.Category != nil {
+		enc.Element("Ctgy", string(*t.Category))
+	}
+	if t.CategoryDetails != nil {
+		enc.Element("CtgyDtls", string(*t.CategoryDetails))
+	}
+	if t.DebtorStatus != nil {
+		enc.Element("DbtrSts", string(*t.DebtorStatus))
+	}
+	if t.CertificateIdentification != nil {
+		enc.Element("CertId", string(*t.CertificateIdentification))
+	}
+	if t.FormsCode != nil {
+		enc.Element("FrmsCd", string(*t.FormsCode))
+	}
+	if t.Period != nil {
+		t.Period.EncodeXMLFast(enc, "Prd")
+	}
+	if t.TaxAmount != nil {
+		t.TaxAmount.EncodeXMLFast(enc, "TaxAmt")
+	}
+	if t.AdditionalInformation != nil {
+		enc.Element("AddtlInf", string(*t.AdditionalInformation))
+	}
+	enc.End(tag)
+}
+
+func (t *TaxRecord1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Type = (*Max35Text)(&value)
+		case "Ctgy":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Category = (*Max35Text)(&value)
+		case "CtgyDtls":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.CategoryDetails = (*Max35Text)(&value)
+		case "DbtrSts":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.DebtorStatus = (*Max35Text)(&value)
+		case "CertId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.CertificateIdentification = (*Max35Text)(&value)
+		case "FrmsCd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.FormsCode = (*Max35Text)(&value)
+		case "Prd":
+			elem := new(TaxPeriod1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Period = elem
+		case "TaxAmt":
+			elem := new(TaxAmount1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TaxAmount = elem
+		case "AddtlInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.AdditionalInformation = (*Max140Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
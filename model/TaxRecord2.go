@@ -0,0 +1,180 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Set of elements used to define the tax record.
+type TaxRecord2 struct {
+
+	// High level code to identify the type of tax details.
+	Type *Max35Text `xml:"Tp,omitempty"`
+
+	// Specifies the tax code as published by the tax authority.
+	Category *Max35Text `xml:"Ctgy,omitempty"`
+
+	// Provides further details of the category tax code.
+	CategoryDetails *Max35Text `xml:"CtgyDtls,omitempty"`
+
+	// Code provided by local authority to identify the status of the party that has drawn up the settlement document.
+	DebtorStatus *Max35Text `xml:"DbtrSts,omitempty"`
+
+	// Identification number of the tax report as assigned by the taxing authority.
+	CertificateIdentification *Max35Text `xml:"CertId,omitempty"`
+
+	// Identifies, in a coded form, on which template the tax report is to be provided.
+	FormsCode *Max35Text `xml:"FrmsCd,omitempty"`
+
+	// Set of elements used to provide details on the period of time related to the tax payment.
+	Period *TaxPeriod2 `xml:"Prd,omitempty"`
+
+	// Set of elements used to provide information on the amount of the tax record.
+	TaxAmount *TaxAmount2 `xml:"TaxAmt,omitempty"`
+
+	// Further details of the tax record.
+	AdditionalInformation *Max140Text `xml:"AddtlInf,omitempty"`
+}
+
+func (t *TaxRecord2) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TaxRecord2) SetType(value string) {
+	t.Type = (*Max35Text)(&value)
+}
+
+func (t *TaxRecord2) SetCategory(value string) {
+	t.Category = (*Max35Text)(&value)
+}
+
+func (t *TaxRecord2) SetCategoryDetails(value string) {
+	t.CategoryDetails = (*Max35Text)(&value)
+}
+
+func (t *TaxRecord2) SetDebtorStatus(value string) {
+	t.DebtorStatus = (*Max35Text)(&value)
+}
+
+func (t *TaxRecord2) SetCertificateIdentification(value string) {
+	t.CertificateIdentification = (*Max35Text)(&value)
+}
+
+func (t *TaxRecord2) SetFormsCode(value string) {
+	t.FormsCode = (*Max35Text)(&value)
+}
+
+func (t *TaxRecord2) AddPeriod() *TaxPeriod2 {
+	t.Period = new(TaxPeriod2)
+	return t.Period
+}
+
+func (t *TaxRecord2) AddTaxAmount() *TaxAmount2 {
+	t.TaxAmount = new(TaxAmount2)
+	return t.TaxAmount
+}
+
+func (t *TaxRecord2) SetAdditionalInformation(value string) {
+	t.AdditionalInformation = (*Max140Text)(&value)
+}
+
+func (t *TaxRecord2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Type != nil {
+		enc.Element("Tp", string(*t.Type))
+	}
+	if t.Category != nil {
+		enc.Element("Ctgy", string(*t.Category))
+	}
+	if t.CategoryDetails != nil {
+		enc.Element("CtgyDtls", string(*t.CategoryDetails))
+	}
+	if t.DebtorStatus != nil {
+		enc.Element("DbtrSts", string(*t.DebtorStatus))
+	}
+	if t.CertificateIdentification != nil {
+		enc.Element("CertId", string(*t.CertificateIdentification))
+	}
+	if t.FormsCode != nil {
+		enc.Element("FrmsCd", string(*t.FormsCode))
+	}
+	if t.Period != nil {
+		t.Period.EncodeXMLFast(enc, "Prd")
+	}
+	if t.TaxAmount != nil {
+		t.TaxAmount.EncodeXMLFast(enc, "TaxAmt")
+	}
+	if t.AdditionalInformation != nil {
+		enc.Element("AddtlInf", string(*t.AdditionalInformation))
+	}
+	enc.End(tag)
+}
+
+func (t *TaxRecord2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Tp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Type = (*Max35Text)(&value)
+		case "Ctgy":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Category = (*Max35Text)(&value)
+		case "CtgyDtls":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.CategoryDetails = (*Max35Text)(&value)
+		case "DbtrSts":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.DebtorStatus = (*Max35Text)(&value)
+		case "CertId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.CertificateIdentification = (*Max35Text)(&value)
+		case "FrmsCd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.FormsCode = (*Max35Text)(&value)
+		case "Prd":
+			elem := new(TaxPeriod2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Period = elem
+		case "TaxAmt":
+			elem := new(TaxAmount2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TaxAmount = elem
+		case "AddtlInf":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.AdditionalInformation = (*Max140Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
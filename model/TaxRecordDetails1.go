@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provides information on the individual tax amount(s) per period of the tax record.
 type TaxRecordDetails1 struct {
 
@@ -10,6 +15,10 @@ type TaxRecordDetails1 struct {
 	Amount *ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
 }
 
+func (t *TaxRecordDetails1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxRecordDetails1) AddPeriod() *TaxPeriod1 {
 	t.Period = new(TaxPeriod1)
 	return t.Period
@@ -18,3 +27,48 @@ func (t *TaxRecordDetails1) AddPeriod() *TaxPeriod1 {
 func (t *TaxRecordDetails1) SetAmount(value, currency string) {
 	t.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
+
+func (t *TaxRecordDetails1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (t *TaxRecordDetails1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Period != nil {
+		t.Period.EncodeXMLFast(enc, "Prd")
+	}
+	if t.Amount != nil {
+		t.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	enc.End(tag)
+}
+
+func (t *TaxRecordDetails1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Prd":
+			elem := new(TaxPeriod1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Period = elem
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Amount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Provides information on the individual tax amount(s) per period of the tax record.
+type TaxRecordDetails2 struct {
+
+	// Set of elements used to provide details on the period of time related to the tax payment.
+	Period *TaxPeriod2 `xml:"Prd,omitempty"`
+
+	// Underlying tax amount related to the specified period.
+	Amount *ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+}
+
+func (t *TaxRecordDetails2) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TaxRecordDetails2) AddPeriod() *TaxPeriod2 {
+	t.Period = new(TaxPeriod2)
+	return t.Period
+}
+
+func (t *TaxRecordDetails2) SetAmount(value, currency string) {
+	t.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (t *TaxRecordDetails2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (t *TaxRecordDetails2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Period != nil {
+		t.Period.EncodeXMLFast(enc, "Prd")
+	}
+	if t.Amount != nil {
+		t.Amount.EncodeXMLFast(enc, "Amt")
+	}
+	enc.End(tag)
+}
+
+func (t *TaxRecordDetails2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Prd":
+			elem := new(TaxPeriod2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Period = elem
+		case "Amt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Amount = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
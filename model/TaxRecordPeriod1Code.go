@@ -1,3 +1,58 @@
 package model
 
 type TaxRecordPeriod1Code string
+
+const (
+	TaxRecordPeriod1CodeMM01 TaxRecordPeriod1Code = "MM01"
+	TaxRecordPeriod1CodeMM02 TaxRecordPeriod1Code = "MM02"
+	TaxRecordPeriod1CodeMM03 TaxRecordPeriod1Code = "MM03"
+	TaxRecordPeriod1CodeMM04 TaxRecordPeriod1Code = "MM04"
+	TaxRecordPeriod1CodeMM05 TaxRecordPeriod1Code = "MM05"
+	TaxRecordPeriod1CodeMM06 TaxRecordPeriod1Code = "MM06"
+	TaxRecordPeriod1CodeMM07 TaxRecordPeriod1Code = "MM07"
+	TaxRecordPeriod1CodeMM08 TaxRecordPeriod1Code = "MM08"
+	TaxRecordPeriod1CodeMM09 TaxRecordPeriod1Code = "MM09"
+	TaxRecordPeriod1CodeMM10 TaxRecordPeriod1Code = "MM10"
+	TaxRecordPeriod1CodeMM11 TaxRecordPeriod1Code = "MM11"
+	TaxRecordPeriod1CodeMM12 TaxRecordPeriod1Code = "MM12"
+	TaxRecordPeriod1CodeQTR1 TaxRecordPeriod1Code = "QTR1"
+	TaxRecordPeriod1CodeQTR2 TaxRecordPeriod1Code = "QTR2"
+	TaxRecordPeriod1CodeQTR3 TaxRecordPeriod1Code = "QTR3"
+	TaxRecordPeriod1CodeQTR4 TaxRecordPeriod1Code = "QTR4"
+	TaxRecordPeriod1CodeHLF1 TaxRecordPeriod1Code = "HLF1"
+	TaxRecordPeriod1CodeHLF2 TaxRecordPeriod1Code = "HLF2"
+)
+
+var taxRecordPeriod1CodeDescriptions = map[TaxRecordPeriod1Code]string{
+	TaxRecordPeriod1CodeMM01: "Month 1: tax is related to the month 1 of the tax year.",
+	TaxRecordPeriod1CodeMM02: "Month 2: tax is related to the month 2 of the tax year.",
+	TaxRecordPeriod1CodeMM03: "Month 3: tax is related to the month 3 of the tax year.",
+	TaxRecordPeriod1CodeMM04: "Month 4: tax is related to the month 4 of the tax year.",
+	TaxRecordPeriod1CodeMM05: "Month 5: tax is related to the month 5 of the tax year.",
+	TaxRecordPeriod1CodeMM06: "Month 6: tax is related to the month 6 of the tax year.",
+	TaxRecordPeriod1CodeMM07: "Month 7: tax is related to the month 7 of the tax year.",
+	TaxRecordPeriod1CodeMM08: "Month 8: tax is related to the month 8 of the tax year.",
+	TaxRecordPeriod1CodeMM09: "Month 9: tax is related to the month 9 of the tax year.",
+	TaxRecordPeriod1CodeMM10: "Month 10: tax is related to the month 10 of the tax year.",
+	TaxRecordPeriod1CodeMM11: "Month 11: tax is related to the month 11 of the tax year.",
+	TaxRecordPeriod1CodeMM12: "Month 12: tax is related to the month 12 of the tax year.",
+	TaxRecordPeriod1CodeQTR1: "Quarter 1: tax is related to quarter 1 of the tax year.",
+	TaxRecordPeriod1CodeQTR2: "Quarter 2: tax is related to quarter 2 of the tax year.",
+	TaxRecordPeriod1CodeQTR3: "Quarter 3: tax is related to quarter 3 of the tax year.",
+	TaxRecordPeriod1CodeQTR4: "Quarter 4: tax is related to quarter 4 of the tax year.",
+	TaxRecordPeriod1CodeHLF1: "First half: tax is related to the first half of the tax year.",
+	TaxRecordPeriod1CodeHLF2: "Second half: tax is related to the second half of the tax year.",
+}
+
+func (t TaxRecordPeriod1Code) IsValid() bool {
+	_, ok := taxRecordPeriod1CodeDescriptions[t]
+	return ok
+}
+
+func (t TaxRecordPeriod1Code) Description() string {
+	return taxRecordPeriod1CodeDescriptions[t]
+}
+
+func (t TaxRecordPeriod1Code) Validate() error {
+	return validateEnumeration("TaxRecordPeriod1Code", string(t), t.IsValid(), "MM01, MM02, MM03, MM04, MM05, MM06, MM07, MM08, MM09, MM10, MM11, MM12, QTR1, QTR2, QTR3, QTR4, HLF1, HLF2")
+}
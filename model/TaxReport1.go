@@ -29,6 +29,10 @@ type TaxReport1 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *TaxReport1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxReport1) AddTaxReportHeader() *GroupHeader69 {
 	t.TaxReportHeader = new(GroupHeader69)
 	return t.TaxReportHeader
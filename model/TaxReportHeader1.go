@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Defines message level identification, number of individual tax reports and tax authority.
 type TaxReportHeader1 struct {
 
@@ -13,6 +17,10 @@ type TaxReportHeader1 struct {
 	TaxAuthority []*TaxOrganisationIdentification1 `xml:"TaxAuthrty,omitempty"`
 }
 
+func (t *TaxReportHeader1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxReportHeader1) AddMessageIdentification() *MessageIdentification1 {
 	t.MessageIdentification = new(MessageIdentification1)
 	return t.MessageIdentification
@@ -22,6 +30,11 @@ func (t *TaxReportHeader1) SetNumberOfTaxReports(value string) {
 	t.NumberOfTaxReports = (*Number)(&value)
 }
 
+func (t *TaxReportHeader1) SetNumberOfTaxReportsFromDecimal(value decimal.Decimal) {
+	t.NumberOfTaxReports = new(Number)
+	t.NumberOfTaxReports.FromDecimal(value)
+}
+
 func (t *TaxReportHeader1) AddTaxAuthority() *TaxOrganisationIdentification1 {
 	newValue := new(TaxOrganisationIdentification1)
 	t.TaxAuthority = append(t.TaxAuthority, newValue)
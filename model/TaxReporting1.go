@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information for tax reporting.
 type TaxReporting1 struct {
 
@@ -22,6 +26,10 @@ type TaxReporting1 struct {
 	Description *Max350Text `xml:"Desc,omitempty"`
 }
 
+func (t *TaxReporting1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxReporting1) SetTaxationCountry(value string) {
 	t.TaxationCountry = (*CountryCode)(&value)
 }
@@ -30,6 +38,11 @@ func (t *TaxReporting1) SetTaxRate(value string) {
 	t.TaxRate = (*PercentageRate)(&value)
 }
 
+func (t *TaxReporting1) SetTaxRateFromDecimal(value decimal.Decimal) {
+	t.TaxRate = new(PercentageRate)
+	t.TaxRate.FromDecimal(value)
+}
+
 func (t *TaxReporting1) AddTaxPayer() *PartyIdentification70Choice {
 	t.TaxPayer = new(PartyIdentification70Choice)
 	return t.TaxPayer
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information on the type of tax.
 type TaxType struct {
 
@@ -16,6 +20,10 @@ type TaxType struct {
 	Amount *CurrencyAndAmount `xml:"Amt,omitempty"`
 }
 
+func (t *TaxType) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxType) SetCategoryDescription(value string) {
 	t.CategoryDescription = (*Max35Text)(&value)
 }
@@ -24,10 +32,23 @@ func (t *TaxType) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
 
+func (t *TaxType) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
+
 func (t *TaxType) SetTaxableBaseAmount(value, currency string) {
 	t.TaxableBaseAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxType) SetTaxableBaseAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TaxableBaseAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxType) SetAmount(value, currency string) {
 	t.Amount = NewCurrencyAndAmount(value, currency)
 }
+
+func (t *TaxType) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
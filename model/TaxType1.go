@@ -10,6 +10,10 @@ type TaxType1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TaxType1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxType1) SetStructured(value string) {
 	t.Structured = (*TaxType7Code)(&value)
 }
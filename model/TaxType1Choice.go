@@ -10,6 +10,10 @@ type TaxType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TaxType1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxType1Choice) SetCode(value string) {
 	t.Code = (*TaxType16Code)(&value)
 }
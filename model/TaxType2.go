@@ -10,6 +10,10 @@ type TaxType2 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TaxType2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxType2) SetStructured(value string) {
 	t.Structured = (*TaxType5Code)(&value)
 }
@@ -10,6 +10,10 @@ type TaxType2Choice struct {
 	OtherTaxType *Max35Text `xml:"OthrTaxTp"`
 }
 
+func (t *TaxType2Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxType2Choice) SetType(value string) {
 	t.Type = (*TaxType9Code)(&value)
 }
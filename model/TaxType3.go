@@ -10,6 +10,10 @@ type TaxType3 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TaxType3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxType3) SetStructured(value string) {
 	t.Structured = (*TaxType6Code)(&value)
 }
@@ -10,6 +10,10 @@ type TaxType3Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TaxType3Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxType3Choice) SetCode(value string) {
 	t.Code = (*TaxType17Code)(&value)
 }
@@ -10,6 +10,10 @@ type TaxType3FormatChoice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (t *TaxType3FormatChoice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxType3FormatChoice) SetCode(value string) {
 	t.Code = (*TaxType3Code)(&value)
 }
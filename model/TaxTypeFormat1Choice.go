@@ -10,6 +10,10 @@ type TaxTypeFormat1Choice struct {
 	Proprietary *GenericIdentification13 `xml:"Prtry"`
 }
 
+func (t *TaxTypeFormat1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxTypeFormat1Choice) SetCode(value string) {
 	t.Code = (*TaxType15Code)(&value)
 }
@@ -10,6 +10,10 @@ type TaxTypeFormat2Choice struct {
 	Structured *TaxType2Code `xml:"Strd"`
 }
 
+func (t *TaxTypeFormat2Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxTypeFormat2Choice) SetUnstructured(value string) {
 	t.Unstructured = (*Max35Text)(&value)
 }
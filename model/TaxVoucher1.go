@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides information about the tax voucher.
 type TaxVoucher1 struct {
 
@@ -67,26 +73,51 @@ type TaxVoucher1 struct {
 	ForeignExchangeDetails *ForeignExchangeTerms9 `xml:"FXDtls,omitempty"`
 }
 
+func (t *TaxVoucher1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxVoucher1) SetTaxVoucherRate(value string) {
 	t.TaxVoucherRate = (*BaseOneRate)(&value)
 }
 
+func (t *TaxVoucher1) SetTaxVoucherRateFromDecimal(value decimal.Decimal) {
+	t.TaxVoucherRate = new(BaseOneRate)
+	t.TaxVoucherRate.FromDecimal(value)
+}
+
 func (t *TaxVoucher1) SetTaxCredit(value, currency string) {
 	t.TaxCredit = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxVoucher1) SetTaxCreditFromDecimal(value decimal.Decimal, currency string) {
+	t.TaxCredit = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxVoucher1) SetTaxDeduction(value, currency string) {
 	t.TaxDeduction = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxVoucher1) SetTaxDeductionFromDecimal(value decimal.Decimal, currency string) {
+	t.TaxDeduction = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxVoucher1) SetGrossAmount(value, currency string) {
 	t.GrossAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxVoucher1) SetGrossAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.GrossAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxVoucher1) SetNetAmount(value, currency string) {
 	t.NetAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxVoucher1) SetNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.NetAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxVoucher1) AddRecordDateHolding() *UnitOrFaceAmount1Choice {
 	t.RecordDateHolding = new(UnitOrFaceAmount1Choice)
 	return t.RecordDateHolding
@@ -96,14 +127,28 @@ func (t *TaxVoucher1) SetTaxCreditRate(value string) {
 	t.TaxCreditRate = (*PercentageRate)(&value)
 }
 
+func (t *TaxVoucher1) SetTaxCreditRateFromDecimal(value decimal.Decimal) {
+	t.TaxCreditRate = new(PercentageRate)
+	t.TaxCreditRate.FromDecimal(value)
+}
+
 func (t *TaxVoucher1) SetWithholdingTaxAmount(value, currency string) {
 	t.WithholdingTaxAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxVoucher1) SetWithholdingTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.WithholdingTaxAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxVoucher1) SetWithholdingTaxRate(value string) {
 	t.WithholdingTaxRate = (*PercentageRate)(&value)
 }
 
+func (t *TaxVoucher1) SetWithholdingTaxRateFromDecimal(value decimal.Decimal) {
+	t.WithholdingTaxRate = new(PercentageRate)
+	t.WithholdingTaxRate.FromDecimal(value)
+}
+
 func (t *TaxVoucher1) AddScripDividendReinvestmentPricePerShare() *PriceValue1 {
 	t.ScripDividendReinvestmentPricePerShare = new(PriceValue1)
 	return t.ScripDividendReinvestmentPricePerShare
@@ -113,6 +158,10 @@ func (t *TaxVoucher1) SetCashAmountBroughtForward(value, currency string) {
 	t.CashAmountBroughtForward = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxVoucher1) SetCashAmountBroughtForwardFromDecimal(value decimal.Decimal, currency string) {
+	t.CashAmountBroughtForward = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxVoucher1) AddAllotedSharesCost() *PriceValue1 {
 	t.AllotedSharesCost = new(PriceValue1)
 	return t.AllotedSharesCost
@@ -122,34 +171,68 @@ func (t *TaxVoucher1) SetCashAmountCarriedForward(value, currency string) {
 	t.CashAmountCarriedForward = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxVoucher1) SetCashAmountCarriedForwardFromDecimal(value decimal.Decimal, currency string) {
+	t.CashAmountCarriedForward = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxVoucher1) SetNotionalTax(value, currency string) {
 	t.NotionalTax = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxVoucher1) SetNotionalTaxFromDecimal(value decimal.Decimal, currency string) {
+	t.NotionalTax = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxVoucher1) SetNotionalDividendPayable(value, currency string) {
 	t.NotionalDividendPayable = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxVoucher1) SetNotionalDividendPayableFromDecimal(value decimal.Decimal, currency string) {
+	t.NotionalDividendPayable = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxVoucher1) SetBargainDate(value string) {
 	t.BargainDate = (*ISODate)(&value)
 }
 
+func (t *TaxVoucher1) SetBargainDateFromTime(value time.Time) {
+	t.BargainDate = new(ISODate)
+	t.BargainDate.FromTime(value)
+}
+
 func (t *TaxVoucher1) SetBargainSettlementDate(value string) {
 	t.BargainSettlementDate = (*ISODate)(&value)
 }
 
+func (t *TaxVoucher1) SetBargainSettlementDateFromTime(value time.Time) {
+	t.BargainSettlementDate = new(ISODate)
+	t.BargainSettlementDate.FromTime(value)
+}
+
 func (t *TaxVoucher1) SetStampDutyAmount(value, currency string) {
 	t.StampDutyAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxVoucher1) SetStampDutyAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.StampDutyAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxVoucher1) SetChargeAmount(value, currency string) {
 	t.ChargeAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxVoucher1) SetChargeAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.ChargeAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxVoucher1) SetCommissionAmount(value, currency string) {
 	t.CommissionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TaxVoucher1) SetCommissionAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.CommissionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TaxVoucher1) AddForeignExchangeDetails() *ForeignExchangeTerms9 {
 	t.ForeignExchangeDetails = new(ForeignExchangeTerms9)
 	return t.ForeignExchangeDetails
@@ -13,6 +13,10 @@ type TaxVoucher2 struct {
 	BargainSettlementDate *DateAndDateTimeChoice `xml:"BrgnSttlmDt,omitempty"`
 }
 
+func (t *TaxVoucher2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxVoucher2) SetIdentification(value string) {
 	t.Identification = (*Max35Text)(&value)
 }
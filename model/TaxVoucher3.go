@@ -13,6 +13,10 @@ type TaxVoucher3 struct {
 	BargainSettlementDate *DateAndDateTimeChoice `xml:"BrgnSttlmDt,omitempty"`
 }
 
+func (t *TaxVoucher3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxVoucher3) SetIdentification(value string) {
 	t.Identification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -10,6 +10,10 @@ type TaxableIncomePerShareCalculated1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TaxableIncomePerShareCalculated1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxableIncomePerShareCalculated1) SetStructured(value string) {
 	t.Structured = (*TaxableIncomePerShareCalculated1Code)(&value)
 }
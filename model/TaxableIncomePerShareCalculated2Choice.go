@@ -10,6 +10,10 @@ type TaxableIncomePerShareCalculated2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TaxableIncomePerShareCalculated2Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxableIncomePerShareCalculated2Choice) SetCode(value string) {
 	t.Code = (*TaxableIncomePerShareCalculated2Code)(&value)
 }
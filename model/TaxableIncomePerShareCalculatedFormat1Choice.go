@@ -10,6 +10,10 @@ type TaxableIncomePerShareCalculatedFormat1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (t *TaxableIncomePerShareCalculatedFormat1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxableIncomePerShareCalculatedFormat1Choice) SetCode(value string) {
 	t.Code = (*CorporateActionTaxableIncomePerShareCalculated1Code)(&value)
 }
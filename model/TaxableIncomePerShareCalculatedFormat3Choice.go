@@ -10,6 +10,10 @@ type TaxableIncomePerShareCalculatedFormat3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (t *TaxableIncomePerShareCalculatedFormat3Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxableIncomePerShareCalculatedFormat3Choice) SetCode(value string) {
 	t.Code = (*CorporateActionTaxableIncomePerShareCalculated1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TaxableIncomePerShareCalculatedFormat4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TaxableIncomePerShareCalculatedFormat4Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxableIncomePerShareCalculatedFormat4Choice) SetCode(value string) {
 	t.Code = (*CorporateActionTaxableIncomePerShareCalculated1Code)(&value)
 }
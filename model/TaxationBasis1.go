@@ -10,6 +10,10 @@ type TaxationBasis1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TaxationBasis1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TaxationBasis1) SetStructured(value string) {
 	t.Structured = (*TaxationBasis3Code)(&value)
 }
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the technical input channel.
 type TechnicalInputChannel1Choice struct {
 
@@ -10,6 +12,10 @@ type TechnicalInputChannel1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (t *TechnicalInputChannel1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TechnicalInputChannel1Choice) SetCode(value string) {
 	t.Code = (*ExternalTechnicalInputChannel1Code)(&value)
 }
@@ -17,3 +23,44 @@ func (t *TechnicalInputChannel1Choice) SetCode(value string) {
 func (t *TechnicalInputChannel1Choice) SetProprietary(value string) {
 	t.Proprietary = (*Max35Text)(&value)
 }
+
+func (t *TechnicalInputChannel1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Code != nil {
+		enc.Element("Cd", string(*t.Code))
+	}
+	if t.Proprietary != nil {
+		enc.Element("Prtry", string(*t.Proprietary))
+	}
+	enc.End(tag)
+}
+
+func (t *TechnicalInputChannel1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Cd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Code = (*ExternalTechnicalInputChannel1Code)(&value)
+		case "Prtry":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Proprietary = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,10 +10,19 @@ type TemporaryFinancialInstrumentIndicator1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (t *TemporaryFinancialInstrumentIndicator1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TemporaryFinancialInstrumentIndicator1Choice) SetTemporaryIndicator(value string) {
 	t.TemporaryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *TemporaryFinancialInstrumentIndicator1Choice) SetTemporaryIndicatorFromBool(value bool) {
+	t.TemporaryIndicator = new(YesNoIndicator)
+	t.TemporaryIndicator.FromBool(value)
+}
+
 func (t *TemporaryFinancialInstrumentIndicator1Choice) AddProprietary() *GenericIdentification20 {
 	t.Proprietary = new(GenericIdentification20)
 	return t.Proprietary
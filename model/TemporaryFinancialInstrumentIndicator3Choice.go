@@ -10,10 +10,19 @@ type TemporaryFinancialInstrumentIndicator3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (t *TemporaryFinancialInstrumentIndicator3Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TemporaryFinancialInstrumentIndicator3Choice) SetTemporaryIndicator(value string) {
 	t.TemporaryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *TemporaryFinancialInstrumentIndicator3Choice) SetTemporaryIndicatorFromBool(value bool) {
+	t.TemporaryIndicator = new(YesNoIndicator)
+	t.TemporaryIndicator.FromBool(value)
+}
+
 func (t *TemporaryFinancialInstrumentIndicator3Choice) AddProprietary() *GenericIdentification30 {
 	t.Proprietary = new(GenericIdentification30)
 	return t.Proprietary
@@ -10,10 +10,19 @@ type TemporaryFinancialInstrumentIndicator4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TemporaryFinancialInstrumentIndicator4Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TemporaryFinancialInstrumentIndicator4Choice) SetTemporaryIndicator(value string) {
 	t.TemporaryIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *TemporaryFinancialInstrumentIndicator4Choice) SetTemporaryIndicatorFromBool(value bool) {
+	t.TemporaryIndicator = new(YesNoIndicator)
+	t.TemporaryIndicator.FromBool(value)
+}
+
 func (t *TemporaryFinancialInstrumentIndicator4Choice) AddProprietary() *GenericIdentification47 {
 	t.Proprietary = new(GenericIdentification47)
 	return t.Proprietary
@@ -10,6 +10,10 @@ type Term1 struct {
 	Value *RateOrAbsoluteValue1Choice `xml:"Val"`
 }
 
+func (t *Term1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Term1) SetOperator(value string) {
 	t.Operator = (*Operator1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TerminalHosting1 struct {
 	Identification *Max35Text `xml:"Id,omitempty"`
 }
 
+func (t *TerminalHosting1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalHosting1) SetCategory(value string) {
 	t.Category = (*TransactionEnvironment3Code)(&value)
 }
@@ -13,6 +13,10 @@ type TerminalManagementDataSet1 struct {
 	Content *StatusReportContent1 `xml:"Cntt"`
 }
 
+func (t *TerminalManagementDataSet1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet1) AddIdentification() *DataSetIdentification2 {
 	t.Identification = new(DataSetIdentification2)
 	return t.Identification
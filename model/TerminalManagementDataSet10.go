@@ -13,6 +13,10 @@ type TerminalManagementDataSet10 struct {
 	Content *ManagementPlanContent3 `xml:"Cntt,omitempty"`
 }
 
+func (t *TerminalManagementDataSet10) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet10) AddIdentification() *DataSetIdentification3 {
 	t.Identification = new(DataSetIdentification3)
 	return t.Identification
@@ -13,6 +13,10 @@ type TerminalManagementDataSet11 struct {
 	Content *AcceptorConfigurationContent3 `xml:"Cntt"`
 }
 
+func (t *TerminalManagementDataSet11) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet11) AddIdentification() *DataSetIdentification3 {
 	t.Identification = new(DataSetIdentification3)
 	return t.Identification
@@ -16,6 +16,10 @@ type TerminalManagementDataSet12 struct {
 	SessionKey *CryptographicKey5 `xml:"SsnKey,omitempty"`
 }
 
+func (t *TerminalManagementDataSet12) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet12) AddIdentification() *DataSetIdentification4 {
 	t.Identification = new(DataSetIdentification4)
 	return t.Identification
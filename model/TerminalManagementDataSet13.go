@@ -13,6 +13,10 @@ type TerminalManagementDataSet13 struct {
 	Content *StatusReportContent4 `xml:"Cntt"`
 }
 
+func (t *TerminalManagementDataSet13) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet13) AddIdentification() *DataSetIdentification4 {
 	t.Identification = new(DataSetIdentification4)
 	return t.Identification
@@ -13,6 +13,10 @@ type TerminalManagementDataSet14 struct {
 	Content *AcceptorConfigurationContent4 `xml:"Cntt"`
 }
 
+func (t *TerminalManagementDataSet14) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet14) AddIdentification() *DataSetIdentification4 {
 	t.Identification = new(DataSetIdentification4)
 	return t.Identification
@@ -13,6 +13,10 @@ type TerminalManagementDataSet15 struct {
 	Content *ManagementPlanContent4 `xml:"Cntt,omitempty"`
 }
 
+func (t *TerminalManagementDataSet15) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet15) AddIdentification() *DataSetIdentification4 {
 	t.Identification = new(DataSetIdentification4)
 	return t.Identification
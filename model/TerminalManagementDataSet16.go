@@ -13,6 +13,10 @@ type TerminalManagementDataSet16 struct {
 	Content *StatusReportContent5 `xml:"Cntt"`
 }
 
+func (t *TerminalManagementDataSet16) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet16) AddIdentification() *DataSetIdentification6 {
 	t.Identification = new(DataSetIdentification6)
 	return t.Identification
@@ -22,6 +22,10 @@ type TerminalManagementDataSet17 struct {
 	ProtectedDelegationProof *ContentInformationType12 `xml:"PrtctdDlgtnProof,omitempty"`
 }
 
+func (t *TerminalManagementDataSet17) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet17) AddIdentification() *DataSetIdentification6 {
 	t.Identification = new(DataSetIdentification6)
 	return t.Identification
@@ -13,6 +13,10 @@ type TerminalManagementDataSet18 struct {
 	Content *ManagementPlanContent5 `xml:"Cntt,omitempty"`
 }
 
+func (t *TerminalManagementDataSet18) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet18) AddIdentification() *DataSetIdentification6 {
 	t.Identification = new(DataSetIdentification6)
 	return t.Identification
@@ -19,6 +19,10 @@ type TerminalManagementDataSet19 struct {
 	Content *AcceptorConfigurationContent5 `xml:"Cntt"`
 }
 
+func (t *TerminalManagementDataSet19) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet19) AddIdentification() *DataSetIdentification6 {
 	t.Identification = new(DataSetIdentification6)
 	return t.Identification
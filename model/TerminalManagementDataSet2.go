@@ -13,6 +13,10 @@ type TerminalManagementDataSet2 struct {
 	Content *ManagementPlanContent1 `xml:"Cntt,omitempty"`
 }
 
+func (t *TerminalManagementDataSet2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet2) AddIdentification() *DataSetIdentification2 {
 	t.Identification = new(DataSetIdentification2)
 	return t.Identification
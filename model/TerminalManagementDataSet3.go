@@ -13,6 +13,10 @@ type TerminalManagementDataSet3 struct {
 	Content *AcceptorConfigurationContent1 `xml:"Cntt"`
 }
 
+func (t *TerminalManagementDataSet3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet3) AddIdentification() *DataSetIdentification2 {
 	t.Identification = new(DataSetIdentification2)
 	return t.Identification
@@ -13,6 +13,10 @@ type TerminalManagementDataSet4 struct {
 	Content *StatusReportContent2 `xml:"Cntt"`
 }
 
+func (t *TerminalManagementDataSet4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet4) AddIdentification() *DataSetIdentification3 {
 	t.Identification = new(DataSetIdentification3)
 	return t.Identification
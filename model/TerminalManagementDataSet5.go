@@ -13,6 +13,10 @@ type TerminalManagementDataSet5 struct {
 	Content *ManagementPlanContent2 `xml:"Cntt,omitempty"`
 }
 
+func (t *TerminalManagementDataSet5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet5) AddIdentification() *DataSetIdentification3 {
 	t.Identification = new(DataSetIdentification3)
 	return t.Identification
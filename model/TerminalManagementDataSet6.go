@@ -13,6 +13,10 @@ type TerminalManagementDataSet6 struct {
 	Content *AcceptorConfigurationContent2 `xml:"Cntt"`
 }
 
+func (t *TerminalManagementDataSet6) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet6) AddIdentification() *DataSetIdentification3 {
 	t.Identification = new(DataSetIdentification3)
 	return t.Identification
@@ -16,6 +16,10 @@ type TerminalManagementDataSet7 struct {
 	EncryptedKey *ContentInformationType5 `xml:"NcrptdKey,omitempty"`
 }
 
+func (t *TerminalManagementDataSet7) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet7) AddIdentification() *DataSetIdentification3 {
 	t.Identification = new(DataSetIdentification3)
 	return t.Identification
@@ -16,6 +16,10 @@ type TerminalManagementDataSet8 struct {
 	EncryptedKey *ContentInformationType7 `xml:"NcrptdKey,omitempty"`
 }
 
+func (t *TerminalManagementDataSet8) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet8) AddIdentification() *DataSetIdentification3 {
 	t.Identification = new(DataSetIdentification3)
 	return t.Identification
@@ -13,6 +13,10 @@ type TerminalManagementDataSet9 struct {
 	Content *StatusReportContent3 `xml:"Cntt"`
 }
 
+func (t *TerminalManagementDataSet9) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminalManagementDataSet9) AddIdentification() *DataSetIdentification3 {
 	t.Identification = new(DataSetIdentification3)
 	return t.Identification
@@ -10,6 +10,10 @@ type TerminationDate2Choice struct {
 	Code *DateCode1Choice `xml:"Cd"`
 }
 
+func (t *TerminationDate2Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminationDate2Choice) AddDate() *DateAndDateTimeChoice {
 	t.Date = new(DateAndDateTimeChoice)
 	return t.Date
@@ -10,6 +10,10 @@ type TerminationDate4Choice struct {
 	Code *DateCode18Choice `xml:"Cd"`
 }
 
+func (t *TerminationDate4Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminationDate4Choice) AddDate() *DateAndDateTimeChoice {
 	t.Date = new(DateAndDateTimeChoice)
 	return t.Date
@@ -10,6 +10,10 @@ type TerminationDate5Choice struct {
 	Code *DateCode32Choice `xml:"Cd"`
 }
 
+func (t *TerminationDate5Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminationDate5Choice) AddDate() *DateAndDateTimeChoice {
 	t.Date = new(DateAndDateTimeChoice)
 	return t.Date
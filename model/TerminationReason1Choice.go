@@ -10,6 +10,10 @@ type TerminationReason1Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (t *TerminationReason1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TerminationReason1Choice) SetCode(value string) {
 	t.Code = (*TerminationReason1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about third party rights.
 type ThirdPartyRights1 struct {
 
@@ -22,6 +28,10 @@ type ThirdPartyRights1 struct {
 	Description *Max350Text `xml:"Desc,omitempty"`
 }
 
+func (t *ThirdPartyRights1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *ThirdPartyRights1) SetType(value string) {
 	t.Type = (*Max35Text)(&value)
 }
@@ -30,6 +40,11 @@ func (t *ThirdPartyRights1) SetDateTime(value string) {
 	t.DateTime = (*ISODateTime)(&value)
 }
 
+func (t *ThirdPartyRights1) SetDateTimeFromTime(value time.Time) {
+	t.DateTime = new(ISODateTime)
+	t.DateTime.FromTime(value)
+}
+
 func (t *ThirdPartyRights1) AddHolder() *PartyIdentification70Choice {
 	t.Holder = new(PartyIdentification70Choice)
 	return t.Holder
@@ -43,6 +58,10 @@ func (t *ThirdPartyRights1) SetAmount(value, currency string) {
 	t.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *ThirdPartyRights1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *ThirdPartyRights1) SetDescription(value string) {
 	t.Description = (*Max350Text)(&value)
 }
@@ -1,3 +1,7 @@
 package model
 
 type TickerIdentifier string
+
+func (t TickerIdentifier) Validate() error {
+	return validateLength("TickerIdentifier", string(t), 1, 35)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // TimeFrame elements that define a period as number of days before or after a activity.
 type TimeFrame3 struct {
 
@@ -18,6 +22,10 @@ type TimeFrame3 struct {
 	ReferToOrderDesk *ReferToFundOrderDesk1Code `xml:"RefrToOrdrDsk,omitempty"`
 }
 
+func (t *TimeFrame3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TimeFrame3) SetOtherTimeFrameDescription(value string) {
 	t.OtherTimeFrameDescription = (*Max350Text)(&value)
 }
@@ -26,6 +34,11 @@ func (t *TimeFrame3) SetTradeMinus(value string) {
 	t.TradeMinus = (*Number)(&value)
 }
 
+func (t *TimeFrame3) SetTradeMinusFromDecimal(value decimal.Decimal) {
+	t.TradeMinus = new(Number)
+	t.TradeMinus.FromDecimal(value)
+}
+
 func (t *TimeFrame3) SetNonWorkingDayAdjustment(value string) {
 	t.NonWorkingDayAdjustment = (*BusinessDayConvention1Code)(&value)
 }
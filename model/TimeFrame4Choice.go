@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // TimeFrame elements that define a period as number of days after an activity.
 type TimeFrame4Choice struct {
 
@@ -12,10 +16,24 @@ type TimeFrame4Choice struct {
 	RenunciationPlus *Number `xml:"RPlus"`
 }
 
+func (t *TimeFrame4Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TimeFrame4Choice) SetTradePlus(value string) {
 	t.TradePlus = (*Number)(&value)
 }
 
+func (t *TimeFrame4Choice) SetTradePlusFromDecimal(value decimal.Decimal) {
+	t.TradePlus = new(Number)
+	t.TradePlus.FromDecimal(value)
+}
+
 func (t *TimeFrame4Choice) SetRenunciationPlus(value string) {
 	t.RenunciationPlus = (*Number)(&value)
 }
+
+func (t *TimeFrame4Choice) SetRenunciationPlusFromDecimal(value decimal.Decimal) {
+	t.RenunciationPlus = new(Number)
+	t.RenunciationPlus.FromDecimal(value)
+}
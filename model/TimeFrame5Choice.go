@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between TimeFrame elements that define a period as number of days after an activity.
 type TimeFrame5Choice struct {
 
@@ -12,10 +16,24 @@ type TimeFrame5Choice struct {
 	Prepayment *YesNoIndicator `xml:"Prepmt"`
 }
 
+func (t *TimeFrame5Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TimeFrame5Choice) SetTradePlus(value string) {
 	t.TradePlus = (*Number)(&value)
 }
 
+func (t *TimeFrame5Choice) SetTradePlusFromDecimal(value decimal.Decimal) {
+	t.TradePlus = new(Number)
+	t.TradePlus.FromDecimal(value)
+}
+
 func (t *TimeFrame5Choice) SetPrepayment(value string) {
 	t.Prepayment = (*YesNoIndicator)(&value)
 }
+
+func (t *TimeFrame5Choice) SetPrepaymentFromBool(value bool) {
+	t.Prepayment = new(YesNoIndicator)
+	t.Prepayment.FromBool(value)
+}
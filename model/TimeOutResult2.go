@@ -7,6 +7,10 @@ type TimeOutResult2 struct {
 	TransactionFutureStatus *TransactionStatus5 `xml:"TxFutrSts"`
 }
 
+func (t *TimeOutResult2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TimeOutResult2) AddTransactionFutureStatus() *TransactionStatus5 {
 	t.TransactionFutureStatus = new(TransactionStatus5)
 	return t.TransactionFutureStatus
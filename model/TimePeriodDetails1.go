@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Particular time span specified between a start time and an end time. The time period cannot exceed 24 hours.
 type TimePeriodDetails1 struct {
 
@@ -10,10 +14,24 @@ type TimePeriodDetails1 struct {
 	ToTime *ISOTime `xml:"ToTm,omitempty"`
 }
 
+func (t *TimePeriodDetails1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TimePeriodDetails1) SetFromTime(value string) {
 	t.FromTime = (*ISOTime)(&value)
 }
 
+func (t *TimePeriodDetails1) SetFromTimeFromTime(value time.Time) {
+	t.FromTime = new(ISOTime)
+	t.FromTime.FromTime(value)
+}
+
 func (t *TimePeriodDetails1) SetToTime(value string) {
 	t.ToTime = (*ISOTime)(&value)
 }
+
+func (t *TimePeriodDetails1) SetToTimeFromTime(value time.Time) {
+	t.ToTime = new(ISOTime)
+	t.ToTime.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Total amount of charges.
 type TotalCharges2 struct {
 
@@ -10,10 +14,18 @@ type TotalCharges2 struct {
 	ChargeDetails []*Charge10 `xml:"ChrgDtls"`
 }
 
+func (t *TotalCharges2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalCharges2) SetTotalAmountOfCharges(value, currency string) {
 	t.TotalAmountOfCharges = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *TotalCharges2) SetTotalAmountOfChargesFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalAmountOfCharges = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *TotalCharges2) AddChargeDetails() *Charge10 {
 	newValue := new(Charge10)
 	t.ChargeDetails = append(t.ChargeDetails, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Total amount of charges.
 type TotalCharges3 struct {
 
@@ -10,10 +14,18 @@ type TotalCharges3 struct {
 	ChargeDetails []*Charge18 `xml:"ChrgDtls"`
 }
 
+func (t *TotalCharges3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalCharges3) SetTotalAmountOfCharges(value, currency string) {
 	t.TotalAmountOfCharges = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *TotalCharges3) SetTotalAmountOfChargesFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalAmountOfCharges = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *TotalCharges3) AddChargeDetails() *Charge18 {
 	newValue := new(Charge18)
 	t.ChargeDetails = append(t.ChargeDetails, newValue)
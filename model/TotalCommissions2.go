@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Total amount of commissions related to a specific order.
 type TotalCommissions2 struct {
 
@@ -10,10 +14,18 @@ type TotalCommissions2 struct {
 	CommissionDetails []*Commission6 `xml:"ComssnDtls"`
 }
 
+func (t *TotalCommissions2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalCommissions2) SetTotalAmountOfCommissions(value, currency string) {
 	t.TotalAmountOfCommissions = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *TotalCommissions2) SetTotalAmountOfCommissionsFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalAmountOfCommissions = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *TotalCommissions2) AddCommissionDetails() *Commission6 {
 	newValue := new(Commission6)
 	t.CommissionDetails = append(t.CommissionDetails, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Total amount of commissions related to a specific order.
 type TotalCommissions3 struct {
 
@@ -10,10 +14,18 @@ type TotalCommissions3 struct {
 	CommissionDetails []*Commission10 `xml:"ComssnDtls"`
 }
 
+func (t *TotalCommissions3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalCommissions3) SetTotalAmountOfCommissions(value, currency string) {
 	t.TotalAmountOfCommissions = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *TotalCommissions3) SetTotalAmountOfCommissionsFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalAmountOfCommissions = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *TotalCommissions3) AddCommissionDetails() *Commission10 {
 	newValue := new(Commission10)
 	t.CommissionDetails = append(t.CommissionDetails, newValue)
@@ -13,6 +13,10 @@ type TotalEligibleBalanceFormat1 struct {
 	PartWayPeriodUnits *SignedQuantityFormat2 `xml:"PartWayPrdUnits,omitempty"`
 }
 
+func (t *TotalEligibleBalanceFormat1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalEligibleBalanceFormat1) AddBalance() *Quantity3Choice {
 	t.Balance = new(Quantity3Choice)
 	return t.Balance
@@ -13,6 +13,10 @@ type TotalEligibleBalanceFormat8 struct {
 	PartWayPeriodUnits *SignedQuantityFormat6 `xml:"PartWayPrdUnits,omitempty"`
 }
 
+func (t *TotalEligibleBalanceFormat8) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalEligibleBalanceFormat8) AddBalance() *Quantity17Choice {
 	t.Balance = new(Quantity17Choice)
 	return t.Balance
@@ -13,6 +13,10 @@ type TotalEligibleBalanceFormat9 struct {
 	PartWayPeriodUnits *SignedQuantityFormat9 `xml:"PartWayPrdUnits,omitempty"`
 }
 
+func (t *TotalEligibleBalanceFormat9) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalEligibleBalanceFormat9) AddBalance() *Quantity22Choice {
 	t.Balance = new(Quantity22Choice)
 	return t.Balance
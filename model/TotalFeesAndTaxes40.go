@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money associated with a service.
 type TotalFeesAndTaxes40 struct {
 
@@ -22,18 +26,34 @@ type TotalFeesAndTaxes40 struct {
 	IndividualTax []*Tax31 `xml:"IndvTax,omitempty"`
 }
 
+func (t *TotalFeesAndTaxes40) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalFeesAndTaxes40) SetTotalOverheadApplied(value, currency string) {
 	t.TotalOverheadApplied = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TotalFeesAndTaxes40) SetTotalOverheadAppliedFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalOverheadApplied = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TotalFeesAndTaxes40) SetTotalFees(value, currency string) {
 	t.TotalFees = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TotalFeesAndTaxes40) SetTotalFeesFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalFees = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TotalFeesAndTaxes40) SetTotalTaxes(value, currency string) {
 	t.TotalTaxes = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TotalFeesAndTaxes40) SetTotalTaxesFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalTaxes = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TotalFeesAndTaxes40) SetCommercialAgreementReference(value string) {
 	t.CommercialAgreementReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type TotalNumber1 struct {
 	TotalOfLinkedInstructions *Exact3NumericText `xml:"TtlOfLkdInstrs"`
 }
 
+func (t *TotalNumber1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalNumber1) SetCurrentInstructionNumber(value string) {
 	t.CurrentInstructionNumber = (*Exact3NumericText)(&value)
 }
@@ -46,6 +46,10 @@ type TotalPortfolioValuation1 struct {
 	InvestmentFundDetails []*InvestmentFund1 `xml:"InvstmtFndDtls,omitempty"`
 }
 
+func (t *TotalPortfolioValuation1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalPortfolioValuation1) AddTotalPortfolioValue() *AmountAndDirection30 {
 	t.TotalPortfolioValue = new(AmountAndDirection30)
 	return t.TotalPortfolioValue
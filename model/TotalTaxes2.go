@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information regarding the total amount of taxes.
 type TotalTaxes2 struct {
 
@@ -10,10 +14,18 @@ type TotalTaxes2 struct {
 	TaxDetails []*Tax7 `xml:"TaxDtls"`
 }
 
+func (t *TotalTaxes2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalTaxes2) SetTotalAmountOfTaxes(value, currency string) {
 	t.TotalAmountOfTaxes = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *TotalTaxes2) SetTotalAmountOfTaxesFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalAmountOfTaxes = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *TotalTaxes2) AddTaxDetails() *Tax7 {
 	newValue := new(Tax7)
 	t.TaxDetails = append(t.TaxDetails, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information regarding the total amount of taxes.
 type TotalTaxes3 struct {
 
@@ -28,14 +32,26 @@ type TotalTaxes3 struct {
 	TaxDetails []*Tax14 `xml:"TaxDtls,omitempty"`
 }
 
+func (t *TotalTaxes3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalTaxes3) SetTotalAmountOfTaxes(value, currency string) {
 	t.TotalAmountOfTaxes = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *TotalTaxes3) SetTotalAmountOfTaxesFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalAmountOfTaxes = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *TotalTaxes3) SetTaxableIncomePerDividend(value, currency string) {
 	t.TaxableIncomePerDividend = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TotalTaxes3) SetTaxableIncomePerDividendFromDecimal(value decimal.Decimal, currency string) {
+	t.TaxableIncomePerDividend = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TotalTaxes3) SetEUCapitalGain(value string) {
 	t.EUCapitalGain = (*EUCapitalGain2Code)(&value)
 }
@@ -56,6 +72,11 @@ func (t *TotalTaxes3) SetPercentageOfDebtClaim(value string) {
 	t.PercentageOfDebtClaim = (*PercentageRate)(&value)
 }
 
+func (t *TotalTaxes3) SetPercentageOfDebtClaimFromDecimal(value decimal.Decimal) {
+	t.PercentageOfDebtClaim = new(PercentageRate)
+	t.PercentageOfDebtClaim.FromDecimal(value)
+}
+
 func (t *TotalTaxes3) AddTaxDetails() *Tax14 {
 	newValue := new(Tax14)
 	t.TaxDetails = append(t.TaxDetails, newValue)
@@ -16,6 +16,10 @@ type TotalTransactions1 struct {
 	TotalEntriesPerBankTransactionCode []*NumberAndSumOfTransactionsPerBankTransactionCode1 `xml:"TtlNtriesPerBkTxCd,omitempty"`
 }
 
+func (t *TotalTransactions1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalTransactions1) AddTotalEntries() *NumberAndSumOfTransactions2 {
 	t.TotalEntries = new(NumberAndSumOfTransactions2)
 	return t.TotalEntries
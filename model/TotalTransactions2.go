@@ -16,6 +16,10 @@ type TotalTransactions2 struct {
 	TotalEntriesPerBankTransactionCode []*TotalsPerBankTransactionCode2 `xml:"TtlNtriesPerBkTxCd,omitempty"`
 }
 
+func (t *TotalTransactions2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalTransactions2) AddTotalEntries() *NumberAndSumOfTransactions2 {
 	t.TotalEntries = new(NumberAndSumOfTransactions2)
 	return t.TotalEntries
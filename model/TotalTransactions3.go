@@ -16,6 +16,10 @@ type TotalTransactions3 struct {
 	TotalEntriesPerBankTransactionCode []*TotalsPerBankTransactionCode2 `xml:"TtlNtriesPerBkTxCd,omitempty"`
 }
 
+func (t *TotalTransactions3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalTransactions3) AddTotalEntries() *NumberAndSumOfTransactions3 {
 	t.TotalEntries = new(NumberAndSumOfTransactions3)
 	return t.TotalEntries
@@ -16,6 +16,10 @@ type TotalTransactions4 struct {
 	TotalEntriesPerBankTransactionCode []*TotalsPerBankTransactionCode3 `xml:"TtlNtriesPerBkTxCd,omitempty"`
 }
 
+func (t *TotalTransactions4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalTransactions4) AddTotalEntries() *NumberAndSumOfTransactions4 {
 	t.TotalEntries = new(NumberAndSumOfTransactions4)
 	return t.TotalEntries
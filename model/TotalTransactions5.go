@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Set of elements used to provide summary information on entries.
 type TotalTransactions5 struct {
 
@@ -16,6 +18,10 @@ type TotalTransactions5 struct {
 	TotalEntriesPerBankTransactionCode []*TotalsPerBankTransactionCode4 `xml:"TtlNtriesPerBkTxCd,omitempty"`
 }
 
+func (t *TotalTransactions5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalTransactions5) AddTotalEntries() *NumberAndSumOfTransactions4 {
 	t.TotalEntries = new(NumberAndSumOfTransactions4)
 	return t.TotalEntries
@@ -37,6 +43,67 @@ func (t *TotalTransactions5) AddTotalEntriesPerBankTransactionCode() *TotalsPerB
 	return newValue
 }
 
+func (t *TotalTransactions5) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.TotalEntries != nil {
+		t.TotalEntries.EncodeXMLFast(enc, "TtlNtries")
+	}
+	if t.TotalCreditEntries != nil {
+		t.TotalCreditEntries.EncodeXMLFast(enc, "TtlCdtNtries")
+	}
+	if t.TotalDebitEntries != nil {
+		t.TotalDebitEntries.EncodeXMLFast(enc, "TtlDbtNtries")
+	}
+	for _, elem := range t.TotalEntriesPerBankTransactionCode {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "TtlNtriesPerBkTxCd")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TotalTransactions5) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "TtlNtries":
+			elem := new(NumberAndSumOfTransactions4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalEntries = elem
+		case "TtlCdtNtries":
+			elem := new(NumberAndSumOfTransactions1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalCreditEntries = elem
+		case "TtlDbtNtries":
+			elem := new(NumberAndSumOfTransactions1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalDebitEntries = elem
+		case "TtlNtriesPerBkTxCd":
+			elem := new(TotalsPerBankTransactionCode4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalEntriesPerBankTransactionCode = append(t.TotalEntriesPerBankTransactionCode, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
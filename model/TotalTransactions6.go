@@ -0,0 +1,105 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Set of elements used to provide summary information on entries.
+type TotalTransactions6 struct {
+
+	// Specifies the total number and sum of debit and credit entries.
+	TotalEntries *NumberAndSumOfTransactions4 `xml:"TtlNtries,omitempty"`
+
+	// Specifies the total number and sum of credit entries.
+	TotalCreditEntries *NumberAndSumOfTransactions1 `xml:"TtlCdtNtries,omitempty"`
+
+	// Specifies the total number and sum of debit entries.
+	TotalDebitEntries *NumberAndSumOfTransactions1 `xml:"TtlDbtNtries,omitempty"`
+
+	// Specifies the total number and sum of entries per bank transaction code.
+	TotalEntriesPerBankTransactionCode []*TotalsPerBankTransactionCode5 `xml:"TtlNtriesPerBkTxCd,omitempty"`
+}
+
+func (t *TotalTransactions6) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TotalTransactions6) AddTotalEntries() *NumberAndSumOfTransactions4 {
+	t.TotalEntries = new(NumberAndSumOfTransactions4)
+	return t.TotalEntries
+}
+
+func (t *TotalTransactions6) AddTotalCreditEntries() *NumberAndSumOfTransactions1 {
+	t.TotalCreditEntries = new(NumberAndSumOfTransactions1)
+	return t.TotalCreditEntries
+}
+
+func (t *TotalTransactions6) AddTotalDebitEntries() *NumberAndSumOfTransactions1 {
+	t.TotalDebitEntries = new(NumberAndSumOfTransactions1)
+	return t.TotalDebitEntries
+}
+
+func (t *TotalTransactions6) AddTotalEntriesPerBankTransactionCode() *TotalsPerBankTransactionCode5 {
+	newValue := new(TotalsPerBankTransactionCode5)
+	t.TotalEntriesPerBankTransactionCode = append(t.TotalEntriesPerBankTransactionCode, newValue)
+	return newValue
+}
+
+func (t *TotalTransactions6) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.TotalEntries != nil {
+		t.TotalEntries.EncodeXMLFast(enc, "TtlNtries")
+	}
+	if t.TotalCreditEntries != nil {
+		t.TotalCreditEntries.EncodeXMLFast(enc, "TtlCdtNtries")
+	}
+	if t.TotalDebitEntries != nil {
+		t.TotalDebitEntries.EncodeXMLFast(enc, "TtlDbtNtries")
+	}
+	for _, elem := range t.TotalEntriesPerBankTransactionCode {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "TtlNtriesPerBkTxCd")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TotalTransactions6) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "TtlNtries":
+			elem := new(NumberAndSumOfTransactions4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalEntries = elem
+		case "TtlCdtNtries":
+			elem := new(NumberAndSumOfTransactions1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalCreditEntries = elem
+		case "TtlDbtNtries":
+			elem := new(NumberAndSumOfTransactions1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalDebitEntries = elem
+		case "TtlNtriesPerBkTxCd":
+			elem := new(TotalsPerBankTransactionCode5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalEntriesPerBankTransactionCode = append(t.TotalEntriesPerBankTransactionCode, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Value of total holdings reported.
 type TotalValueInPageAndStatement struct {
 
@@ -10,10 +14,22 @@ type TotalValueInPageAndStatement struct {
 	TotalHoldingsValueOfStatement *ActiveCurrencyAndAmount `xml:"TtlHldgsValOfStmt"`
 }
 
+func (t *TotalValueInPageAndStatement) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalValueInPageAndStatement) SetTotalHoldingsValueOfPage(value, currency string) {
 	t.TotalHoldingsValueOfPage = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TotalValueInPageAndStatement) SetTotalHoldingsValueOfPageFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalHoldingsValueOfPage = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TotalValueInPageAndStatement) SetTotalHoldingsValueOfStatement(value, currency string) {
 	t.TotalHoldingsValueOfStatement = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (t *TotalValueInPageAndStatement) SetTotalHoldingsValueOfStatementFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalHoldingsValueOfStatement = NewActiveCurrencyAndAmount(value.String(), currency)
+}
@@ -16,6 +16,10 @@ type TotalValueInPageAndStatement1 struct {
 	TotalEligibleCollateralValue *AmountAndDirection6 `xml:"TtlElgblCollVal,omitempty"`
 }
 
+func (t *TotalValueInPageAndStatement1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalValueInPageAndStatement1) AddTotalHoldingsValueOfPage() *AmountAndDirection6 {
 	t.TotalHoldingsValueOfPage = new(AmountAndDirection6)
 	return t.TotalHoldingsValueOfPage
@@ -13,6 +13,10 @@ type TotalValueInPageAndStatement2 struct {
 	TotalBookValueOfStatement *AmountAndDirection6 `xml:"TtlBookValOfStmt,omitempty"`
 }
 
+func (t *TotalValueInPageAndStatement2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalValueInPageAndStatement2) AddTotalHoldingsValueOfPage() *AmountAndDirection6 {
 	t.TotalHoldingsValueOfPage = new(AmountAndDirection6)
 	return t.TotalHoldingsValueOfPage
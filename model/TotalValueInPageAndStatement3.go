@@ -16,6 +16,10 @@ type TotalValueInPageAndStatement3 struct {
 	TotalEligibleCollateralValue *AmountAndDirection14 `xml:"TtlElgblCollVal,omitempty"`
 }
 
+func (t *TotalValueInPageAndStatement3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalValueInPageAndStatement3) AddTotalHoldingsValueOfPage() *AmountAndDirection14 {
 	t.TotalHoldingsValueOfPage = new(AmountAndDirection14)
 	return t.TotalHoldingsValueOfPage
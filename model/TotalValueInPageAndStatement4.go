@@ -13,6 +13,10 @@ type TotalValueInPageAndStatement4 struct {
 	TotalBookValueOfStatement *AmountAndDirection14 `xml:"TtlBookValOfStmt,omitempty"`
 }
 
+func (t *TotalValueInPageAndStatement4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalValueInPageAndStatement4) AddTotalHoldingsValueOfPage() *AmountAndDirection14 {
 	t.TotalHoldingsValueOfPage = new(AmountAndDirection14)
 	return t.TotalHoldingsValueOfPage
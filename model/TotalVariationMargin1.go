@@ -10,6 +10,10 @@ type TotalVariationMargin1 struct {
 	AmountDetails *Amount2 `xml:"AmtDtls"`
 }
 
+func (t *TotalVariationMargin1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalVariationMargin1) SetShortLongIndicator(value string) {
 	t.ShortLongIndicator = (*ShortLong1Code)(&value)
 }
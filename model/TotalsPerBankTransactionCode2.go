@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide the total sum of entries per bank transaction code.
 type TotalsPerBankTransactionCode2 struct {
 
@@ -25,6 +29,10 @@ type TotalsPerBankTransactionCode2 struct {
 	Availability []*CashBalanceAvailability2 `xml:"Avlbty,omitempty"`
 }
 
+func (t *TotalsPerBankTransactionCode2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalsPerBankTransactionCode2) SetNumberOfEntries(value string) {
 	t.NumberOfEntries = (*Max15NumericText)(&value)
 }
@@ -33,18 +41,37 @@ func (t *TotalsPerBankTransactionCode2) SetSum(value string) {
 	t.Sum = (*DecimalNumber)(&value)
 }
 
+func (t *TotalsPerBankTransactionCode2) SetSumFromDecimal(value decimal.Decimal) {
+	t.Sum = new(DecimalNumber)
+	t.Sum.FromDecimal(value)
+}
+
 func (t *TotalsPerBankTransactionCode2) SetTotalNetEntryAmount(value string) {
 	t.TotalNetEntryAmount = (*DecimalNumber)(&value)
 }
 
-func (t *TotalsPerBankTransactionCode2) SetCreditDebitIndicator(value string) {
+func (t *TotalsPerBankTransactionCode2) SetTotalNetEntryAmountFromDecimal(value decimal.Decimal) {
+	t.TotalNetEntryAmount = new(DecimalNumber)
+	t.TotalNetEntryAmount.FromDecimal(value)
+}
+
+func (t *TotalsPerBankTransactionCode2) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	t.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (t *TotalsPerBankTransactionCode2) SetForecastIndicator(value string) {
 	t.ForecastIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (t *TotalsPerBankTransactionCode2) SetForecastIndicatorFromBool(value bool) {
+	t.ForecastIndicator = new(TrueFalseIndicator)
+	t.ForecastIndicator.FromBool(value)
+}
+
 func (t *TotalsPerBankTransactionCode2) AddBankTransactionCode() *BankTransactionCodeStructure4 {
 	t.BankTransactionCode = new(BankTransactionCodeStructure4)
 	return t.BankTransactionCode
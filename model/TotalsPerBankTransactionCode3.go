@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide the total sum of entries per bank transaction code.
 type TotalsPerBankTransactionCode3 struct {
 
@@ -22,6 +26,10 @@ type TotalsPerBankTransactionCode3 struct {
 	Availability []*CashBalanceAvailability2 `xml:"Avlbty,omitempty"`
 }
 
+func (t *TotalsPerBankTransactionCode3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalsPerBankTransactionCode3) SetNumberOfEntries(value string) {
 	t.NumberOfEntries = (*Max15NumericText)(&value)
 }
@@ -30,6 +38,11 @@ func (t *TotalsPerBankTransactionCode3) SetSum(value string) {
 	t.Sum = (*DecimalNumber)(&value)
 }
 
+func (t *TotalsPerBankTransactionCode3) SetSumFromDecimal(value decimal.Decimal) {
+	t.Sum = new(DecimalNumber)
+	t.Sum.FromDecimal(value)
+}
+
 func (t *TotalsPerBankTransactionCode3) AddTotalNetEntry() *AmountAndDirection35 {
 	t.TotalNetEntry = new(AmountAndDirection35)
 	return t.TotalNetEntry
@@ -39,6 +52,11 @@ func (t *TotalsPerBankTransactionCode3) SetForecastIndicator(value string) {
 	t.ForecastIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (t *TotalsPerBankTransactionCode3) SetForecastIndicatorFromBool(value bool) {
+	t.ForecastIndicator = new(TrueFalseIndicator)
+	t.ForecastIndicator.FromBool(value)
+}
+
 func (t *TotalsPerBankTransactionCode3) AddBankTransactionCode() *BankTransactionCodeStructure4 {
 	t.BankTransactionCode = new(BankTransactionCodeStructure4)
 	return t.BankTransactionCode
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of elements used to provide the total sum of entries per bank transaction code.
 type TotalsPerBankTransactionCode4 struct {
 
@@ -22,6 +27,10 @@ type TotalsPerBankTransactionCode4 struct {
 	Availability []*CashAvailability1 `xml:"Avlbty,omitempty"`
 }
 
+func (t *TotalsPerBankTransactionCode4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TotalsPerBankTransactionCode4) SetNumberOfEntries(value string) {
 	t.NumberOfEntries = (*Max15NumericText)(&value)
 }
@@ -30,6 +39,11 @@ func (t *TotalsPerBankTransactionCode4) SetSum(value string) {
 	t.Sum = (*DecimalNumber)(&value)
 }
 
+func (t *TotalsPerBankTransactionCode4) SetSumFromDecimal(value decimal.Decimal) {
+	t.Sum = new(DecimalNumber)
+	t.Sum.FromDecimal(value)
+}
+
 func (t *TotalsPerBankTransactionCode4) AddTotalNetEntry() *AmountAndDirection35 {
 	t.TotalNetEntry = new(AmountAndDirection35)
 	return t.TotalNetEntry
@@ -39,6 +53,11 @@ func (t *TotalsPerBankTransactionCode4) SetForecastIndicator(value string) {
 	t.ForecastIndicator = (*TrueFalseIndicator)(&value)
 }
 
+func (t *TotalsPerBankTransactionCode4) SetForecastIndicatorFromBool(value bool) {
+	t.ForecastIndicator = new(TrueFalseIndicator)
+	t.ForecastIndicator.FromBool(value)
+}
+
 func (t *TotalsPerBankTransactionCode4) AddBankTransactionCode() *BankTransactionCodeStructure4 {
 	t.BankTransactionCode = new(BankTransactionCodeStructure4)
 	return t.BankTransactionCode
@@ -50,6 +69,85 @@ func (t *TotalsPerBankTransactionCode4) AddAvailability() *CashAvailability1 {
 	return newValue
 }
 
+func (t *TotalsPerBankTransactionCode4) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.NumberOfEntries != nil {
+		enc.Element("NbOfNtries", string(*t.NumberOfEntries))
+	}
+	if t.Sum != nil {
+		enc.Element("Sum", string(*t.Sum))
+	}
+	if t.TotalNetEntry != nil {
+		t.TotalNetEntry.EncodeXMLFast(enc, "TtlNetNtry")
+	}
+	if t.ForecastIndicator != nil {
+		enc.Element("FcstInd", string(*t.ForecastIndicator))
+	}
+	if t.BankTransactionCode != nil {
+		t.BankTransactionCode.EncodeXMLFast(enc, "BkTxCd")
+	}
+	for _, elem := range t.Availability {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Avlbty")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TotalsPerBankTransactionCode4) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "NbOfNtries":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.NumberOfEntries = (*Max15NumericText)(&value)
+		case "Sum":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Sum = (*DecimalNumber)(&value)
+		case "TtlNetNtry":
+			elem := new(AmountAndDirection35)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalNetEntry = elem
+		case "FcstInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.ForecastIndicator = (*TrueFalseIndicator)(&value)
+		case "BkTxCd":
+			elem := new(BankTransactionCodeStructure4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.BankTransactionCode = elem
+		case "Avlbty":
+			elem := new(CashAvailability1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Availability = append(t.Availability, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -0,0 +1,166 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Set of elements used to provide the total sum of entries per bank transaction code.
+type TotalsPerBankTransactionCode5 struct {
+
+	// Number of individual entries for the bank transaction code.
+	NumberOfEntries *Max15NumericText `xml:"NbOfNtries,omitempty"`
+
+	// Total of all individual entries included in the report.
+	Sum *DecimalNumber `xml:"Sum,omitempty"`
+
+	// Total debit or credit amount that is the result of the netted amounts for all debit and credit entries per bank transaction code.
+	TotalNetEntry *AmountAndDirection35 `xml:"TtlNetNtry,omitempty"`
+
+	// Indicates whether the bank transaction code is related to booked or forecast items.
+	ForecastIndicator *TrueFalseIndicator `xml:"FcstInd,omitempty"`
+
+	// Set of elements used to fully identify the type of underlying transaction resulting in an entry.
+	BankTransactionCode *BankTransactionCodeStructure4 `xml:"BkTxCd"`
+
+	// Set of elements used to indicate when the booked amount of money will become available, that is can be accessed and starts generating interest.
+	Availability []*CashAvailability1 `xml:"Avlbty,omitempty"`
+
+	// Indicates the date (and time) of the transaction summary.
+	Date *DateAndDateTime2Choice `xml:"Dt,omitempty"`
+}
+
+func (t *TotalsPerBankTransactionCode5) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TotalsPerBankTransactionCode5) SetNumberOfEntries(value string) {
+	t.NumberOfEntries = (*Max15NumericText)(&value)
+}
+
+func (t *TotalsPerBankTransactionCode5) SetSum(value string) {
+	t.Sum = (*DecimalNumber)(&value)
+}
+
+func (t *TotalsPerBankTransactionCode5) SetSumFromDecimal(value decimal.Decimal) {
+	t.Sum = new(DecimalNumber)
+	t.Sum.FromDecimal(value)
+}
+
+func (t *TotalsPerBankTransactionCode5) AddTotalNetEntry() *AmountAndDirection35 {
+	t.TotalNetEntry = new(AmountAndDirection35)
+	return t.TotalNetEntry
+}
+
+func (t *TotalsPerBankTransactionCode5) SetForecastIndicator(value string) {
+	t.ForecastIndicator = (*TrueFalseIndicator)(&value)
+}
+
+func (t *TotalsPerBankTransactionCode5) SetForecastIndicatorFromBool(value bool) {
+	t.ForecastIndicator = new(TrueFalseIndicator)
+	t.ForecastIndicator.FromBool(value)
+}
+
+func (t *TotalsPerBankTransactionCode5) AddBankTransactionCode() *BankTransactionCodeStructure4 {
+	t.BankTransactionCode = new(BankTransactionCodeStructure4)
+	return t.BankTransactionCode
+}
+
+func (t *TotalsPerBankTransactionCode5) AddAvailability() *CashAvailability1 {
+	newValue := new(CashAvailability1)
+	t.Availability = append(t.Availability, newValue)
+	return newValue
+}
+
+func (t *TotalsPerBankTransactionCode5) AddDate() *DateAndDateTime2Choice {
+	t.Date = new(DateAndDateTime2Choice)
+	return t.Date
+}
+
+func (t *TotalsPerBankTransactionCode5) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.NumberOfEntries != nil {
+		enc.Element("NbOfNtries", string(*t.NumberOfEntries))
+	}
+	if t.Sum != nil {
+		enc.Element("Sum", string(*t.Sum))
+	}
+	if t.TotalNetEntry != nil {
+		t.TotalNetEntry.EncodeXMLFast(enc, "TtlNetNtry")
+	}
+	if t.ForecastIndicator != nil {
+		enc.Element("FcstInd", string(*t.ForecastIndicator))
+	}
+	if t.BankTransactionCode != nil {
+		t.BankTransactionCode.EncodeXMLFast(enc, "BkTxCd")
+	}
+	for _, elem := range t.Availability {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Avlbty")
+		}
+	}
+	if t.Date != nil {
+		t.Date.EncodeXMLFast(enc, "Dt")
+	}
+	enc.End(tag)
+}
+
+func (t *TotalsPerBankTransactionCode5) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "NbOfNtries":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.NumberOfEntries = (*Max15NumericText)(&value)
+		case "Sum":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.Sum = (*DecimalNumber)(&value)
+		case "TtlNetNtry":
+			elem := new(AmountAndDirection35)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalNetEntry = elem
+		case "FcstInd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.ForecastIndicator = (*TrueFalseIndicator)(&value)
+		case "BkTxCd":
+			elem := new(BankTransactionCodeStructure4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.BankTransactionCode = elem
+		case "Avlbty":
+			elem := new(CashAvailability1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Availability = append(t.Availability, elem)
+		case "Dt":
+			elem := new(DateAndDateTime2Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Date = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identification of partners involved in exchange from the merchant to the issuer, with the relative timestamp of their exchanges.
 type Traceability3 struct {
 
@@ -13,6 +17,10 @@ type Traceability3 struct {
 	TraceDateTimeOut *ISODateTime `xml:"TracDtTmOut"`
 }
 
+func (t *Traceability3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Traceability3) AddRelayIdentification() *GenericIdentification74 {
 	t.RelayIdentification = new(GenericIdentification74)
 	return t.RelayIdentification
@@ -22,6 +30,16 @@ func (t *Traceability3) SetTraceDateTimeIn(value string) {
 	t.TraceDateTimeIn = (*ISODateTime)(&value)
 }
 
+func (t *Traceability3) SetTraceDateTimeInFromTime(value time.Time) {
+	t.TraceDateTimeIn = new(ISODateTime)
+	t.TraceDateTimeIn.FromTime(value)
+}
+
 func (t *Traceability3) SetTraceDateTimeOut(value string) {
 	t.TraceDateTimeOut = (*ISODateTime)(&value)
 }
+
+func (t *Traceability3) SetTraceDateTimeOutFromTime(value time.Time) {
+	t.TraceDateTimeOut = new(ISODateTime)
+	t.TraceDateTimeOut.FromTime(value)
+}
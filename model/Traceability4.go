@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identification of partners involved in exchange from the ATM to the issuer, with the relative timestamp of their exchanges.
 type Traceability4 struct {
 
@@ -16,6 +20,10 @@ type Traceability4 struct {
 	TraceDateTimeOut *ISODateTime `xml:"TracDtTmOut"`
 }
 
+func (t *Traceability4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Traceability4) AddRelayIdentification() *GenericIdentification77 {
 	t.RelayIdentification = new(GenericIdentification77)
 	return t.RelayIdentification
@@ -29,6 +37,16 @@ func (t *Traceability4) SetTraceDateTimeIn(value string) {
 	t.TraceDateTimeIn = (*ISODateTime)(&value)
 }
 
+func (t *Traceability4) SetTraceDateTimeInFromTime(value time.Time) {
+	t.TraceDateTimeIn = new(ISODateTime)
+	t.TraceDateTimeIn.FromTime(value)
+}
+
 func (t *Traceability4) SetTraceDateTimeOut(value string) {
 	t.TraceDateTimeOut = (*ISODateTime)(&value)
 }
+
+func (t *Traceability4) SetTraceDateTimeOutFromTime(value time.Time) {
+	t.TraceDateTimeOut = new(ISODateTime)
+	t.TraceDateTimeOut.FromTime(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identification of partners involved in exchange from the merchant to the issuer, with the relative timestamp of their exchanges.
 type Traceability5 struct {
 
@@ -19,6 +23,10 @@ type Traceability5 struct {
 	TraceDateTimeOut *ISODateTime `xml:"TracDtTmOut"`
 }
 
+func (t *Traceability5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Traceability5) AddRelayIdentification() *GenericIdentification76 {
 	t.RelayIdentification = new(GenericIdentification76)
 	return t.RelayIdentification
@@ -36,6 +44,16 @@ func (t *Traceability5) SetTraceDateTimeIn(value string) {
 	t.TraceDateTimeIn = (*ISODateTime)(&value)
 }
 
+func (t *Traceability5) SetTraceDateTimeInFromTime(value time.Time) {
+	t.TraceDateTimeIn = new(ISODateTime)
+	t.TraceDateTimeIn.FromTime(value)
+}
+
 func (t *Traceability5) SetTraceDateTimeOut(value string) {
 	t.TraceDateTimeOut = (*ISODateTime)(&value)
 }
+
+func (t *Traceability5) SetTraceDateTimeOutFromTime(value time.Time) {
+	t.TraceDateTimeOut = new(ISODateTime)
+	t.TraceDateTimeOut.FromTime(value)
+}
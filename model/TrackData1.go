@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Magnetic track or equivalent payment card data.
 type TrackData1 struct {
 
@@ -10,6 +12,10 @@ type TrackData1 struct {
 	TrackValue *Max140Text `xml:"TrckVal"`
 }
 
+func (t *TrackData1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TrackData1) SetTrackNumber(value string) {
 	t.TrackNumber = (*Exact1NumericText)(&value)
 }
@@ -17,3 +23,44 @@ func (t *TrackData1) SetTrackNumber(value string) {
 func (t *TrackData1) SetTrackValue(value string) {
 	t.TrackValue = (*Max140Text)(&value)
 }
+
+func (t *TrackData1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.TrackNumber != nil {
+		enc.Element("TrckNb", string(*t.TrackNumber))
+	}
+	if t.TrackValue != nil {
+		enc.Element("TrckVal", string(*t.TrackValue))
+	}
+	enc.End(tag)
+}
+
+func (t *TrackData1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "TrckNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TrackNumber = (*Exact1NumericText)(&value)
+		case "TrckVal":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TrackValue = (*Max140Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,10 +10,19 @@ type Tracking1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (t *Tracking1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tracking1Choice) SetIndicator(value string) {
 	t.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Tracking1Choice) SetIndicatorFromBool(value bool) {
+	t.Indicator = new(YesNoIndicator)
+	t.Indicator.FromBool(value)
+}
+
 func (t *Tracking1Choice) AddProprietary() *GenericIdentification20 {
 	t.Proprietary = new(GenericIdentification20)
 	return t.Proprietary
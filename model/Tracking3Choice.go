@@ -10,10 +10,19 @@ type Tracking3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (t *Tracking3Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tracking3Choice) SetIndicator(value string) {
 	t.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Tracking3Choice) SetIndicatorFromBool(value bool) {
+	t.Indicator = new(YesNoIndicator)
+	t.Indicator.FromBool(value)
+}
+
 func (t *Tracking3Choice) AddProprietary() *GenericIdentification38 {
 	t.Proprietary = new(GenericIdentification38)
 	return t.Proprietary
@@ -10,10 +10,19 @@ type Tracking4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (t *Tracking4Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tracking4Choice) SetIndicator(value string) {
 	t.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Tracking4Choice) SetIndicatorFromBool(value bool) {
+	t.Indicator = new(YesNoIndicator)
+	t.Indicator.FromBool(value)
+}
+
 func (t *Tracking4Choice) AddProprietary() *GenericIdentification30 {
 	t.Proprietary = new(GenericIdentification30)
 	return t.Proprietary
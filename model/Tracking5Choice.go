@@ -10,10 +10,19 @@ type Tracking5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *Tracking5Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Tracking5Choice) SetIndicator(value string) {
 	t.Indicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Tracking5Choice) SetIndicatorFromBool(value bool) {
+	t.Indicator = new(YesNoIndicator)
+	t.Indicator.FromBool(value)
+}
+
 func (t *Tracking5Choice) AddProprietary() *GenericIdentification47 {
 	t.Proprietary = new(GenericIdentification47)
 	return t.Proprietary
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Date and identification of a trade.
 type TradeAgreement1 struct {
 
@@ -13,10 +17,19 @@ type TradeAgreement1 struct {
 	CommonReference *Max35Text `xml:"CmonRef,omitempty"`
 }
 
+func (t *TradeAgreement1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeAgreement1) SetTradeDate(value string) {
 	t.TradeDate = (*ISODate)(&value)
 }
 
+func (t *TradeAgreement1) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODate)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *TradeAgreement1) SetNotificationIdentification(value string) {
 	t.NotificationIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Date and identification of a trade.
 type TradeAgreement10 struct {
 
@@ -25,10 +29,19 @@ type TradeAgreement10 struct {
 	PaymentVersusPaymentIndicator *YesNoIndicator `xml:"PmtVrssPmtInd,omitempty"`
 }
 
+func (t *TradeAgreement10) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeAgreement10) SetTradeDate(value string) {
 	t.TradeDate = (*ISODate)(&value)
 }
 
+func (t *TradeAgreement10) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODate)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *TradeAgreement10) SetOriginatorReference(value string) {
 	t.OriginatorReference = (*Max35Text)(&value)
 }
@@ -52,3 +65,8 @@ func (t *TradeAgreement10) SetSettlementSessionIdentifier(value string) {
 func (t *TradeAgreement10) SetPaymentVersusPaymentIndicator(value string) {
 	t.PaymentVersusPaymentIndicator = (*YesNoIndicator)(&value)
 }
+
+func (t *TradeAgreement10) SetPaymentVersusPaymentIndicatorFromBool(value bool) {
+	t.PaymentVersusPaymentIndicator = new(YesNoIndicator)
+	t.PaymentVersusPaymentIndicator.FromBool(value)
+}
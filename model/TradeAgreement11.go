@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Date and identification of a trade together with references to previous events in its life.
 type TradeAgreement11 struct {
 
@@ -31,10 +35,19 @@ type TradeAgreement11 struct {
 	PaymentVersusPaymentIndicator *YesNoIndicator `xml:"PmtVrssPmtInd,omitempty"`
 }
 
+func (t *TradeAgreement11) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeAgreement11) SetTradeDate(value string) {
 	t.TradeDate = (*ISODate)(&value)
 }
 
+func (t *TradeAgreement11) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODate)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *TradeAgreement11) SetOriginatorReference(value string) {
 	t.OriginatorReference = (*Max35Text)(&value)
 }
@@ -67,3 +80,8 @@ func (t *TradeAgreement11) SetSettlementSessionIdentifier(value string) {
 func (t *TradeAgreement11) SetPaymentVersusPaymentIndicator(value string) {
 	t.PaymentVersusPaymentIndicator = (*YesNoIndicator)(&value)
 }
+
+func (t *TradeAgreement11) SetPaymentVersusPaymentIndicatorFromBool(value bool) {
+	t.PaymentVersusPaymentIndicator = new(YesNoIndicator)
+	t.PaymentVersusPaymentIndicator.FromBool(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Date and identification of a trade together with references to previous events in its life.
 type TradeAgreement12 struct {
 
@@ -40,10 +44,19 @@ type TradeAgreement12 struct {
 	PaymentVersusPaymentIndicator *YesNoIndicator `xml:"PmtVrssPmtInd,omitempty"`
 }
 
+func (t *TradeAgreement12) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeAgreement12) SetTradeDate(value string) {
 	t.TradeDate = (*ISODate)(&value)
 }
 
+func (t *TradeAgreement12) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODate)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *TradeAgreement12) SetMessageIdentification(value string) {
 	t.MessageIdentification = (*Max35Text)(&value)
 }
@@ -84,6 +97,16 @@ func (t *TradeAgreement12) SetSplitTradeIndicator(value string) {
 	t.SplitTradeIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *TradeAgreement12) SetSplitTradeIndicatorFromBool(value bool) {
+	t.SplitTradeIndicator = new(YesNoIndicator)
+	t.SplitTradeIndicator.FromBool(value)
+}
+
 func (t *TradeAgreement12) SetPaymentVersusPaymentIndicator(value string) {
 	t.PaymentVersusPaymentIndicator = (*YesNoIndicator)(&value)
 }
+
+func (t *TradeAgreement12) SetPaymentVersusPaymentIndicatorFromBool(value bool) {
+	t.PaymentVersusPaymentIndicator = new(YesNoIndicator)
+	t.PaymentVersusPaymentIndicator.FromBool(value)
+}
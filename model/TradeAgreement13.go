@@ -25,6 +25,10 @@ type TradeAgreement13 struct {
 	Incoterms *Incoterms3 `xml:"Incotrms,omitempty"`
 }
 
+func (t *TradeAgreement13) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeAgreement13) AddBuyer() *TradeParty3 {
 	t.Buyer = new(TradeParty3)
 	return t.Buyer
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Date and identification of a trade.
 type TradeAgreement14 struct {
 
@@ -28,10 +32,19 @@ type TradeAgreement14 struct {
 	PaymentVersusPaymentIndicator *YesNoIndicator `xml:"PmtVrssPmtInd,omitempty"`
 }
 
+func (t *TradeAgreement14) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeAgreement14) SetTradeDate(value string) {
 	t.TradeDate = (*ISODate)(&value)
 }
 
+func (t *TradeAgreement14) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODate)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *TradeAgreement14) SetOriginatorReference(value string) {
 	t.OriginatorReference = (*Max35Text)(&value)
 }
@@ -59,3 +72,8 @@ func (t *TradeAgreement14) SetSettlementSessionIdentifier(value string) {
 func (t *TradeAgreement14) SetPaymentVersusPaymentIndicator(value string) {
 	t.PaymentVersusPaymentIndicator = (*YesNoIndicator)(&value)
 }
+
+func (t *TradeAgreement14) SetPaymentVersusPaymentIndicatorFromBool(value bool) {
+	t.PaymentVersusPaymentIndicator = new(YesNoIndicator)
+	t.PaymentVersusPaymentIndicator.FromBool(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Date and identification of a trade together with references to previous events in its life.
 type TradeAgreement15 struct {
 
@@ -34,10 +38,19 @@ type TradeAgreement15 struct {
 	PaymentVersusPaymentIndicator *YesNoIndicator `xml:"PmtVrssPmtInd,omitempty"`
 }
 
+func (t *TradeAgreement15) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeAgreement15) SetTradeDate(value string) {
 	t.TradeDate = (*ISODate)(&value)
 }
 
+func (t *TradeAgreement15) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODate)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *TradeAgreement15) SetOriginatorReference(value string) {
 	t.OriginatorReference = (*Max35Text)(&value)
 }
@@ -74,3 +87,8 @@ func (t *TradeAgreement15) SetSettlementSessionIdentifier(value string) {
 func (t *TradeAgreement15) SetPaymentVersusPaymentIndicator(value string) {
 	t.PaymentVersusPaymentIndicator = (*YesNoIndicator)(&value)
 }
+
+func (t *TradeAgreement15) SetPaymentVersusPaymentIndicatorFromBool(value bool) {
+	t.PaymentVersusPaymentIndicator = new(YesNoIndicator)
+	t.PaymentVersusPaymentIndicator.FromBool(value)
+}
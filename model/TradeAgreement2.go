@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Date and identification of a trade together with references to previous events in its life.
 type TradeAgreement2 struct {
 
@@ -19,10 +23,19 @@ type TradeAgreement2 struct {
 	RelatedReference *Max35Text `xml:"RltdRef"`
 }
 
+func (t *TradeAgreement2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeAgreement2) SetTradeDate(value string) {
 	t.TradeDate = (*ISODate)(&value)
 }
 
+func (t *TradeAgreement2) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODate)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *TradeAgreement2) SetNotificationIdentification(value string) {
 	t.NotificationIdentification = (*Max35Text)(&value)
 }
@@ -25,6 +25,10 @@ type TradeAgreement6 struct {
 	Incoterms *Incoterms3 `xml:"Incotrms,omitempty"`
 }
 
+func (t *TradeAgreement6) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeAgreement6) AddBuyer() *TradeParty1 {
 	t.Buyer = new(TradeParty1)
 	return t.Buyer
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Agreement between the parties, stipulating the terms and conditions of the delivery of goods or services.
 type TradeContract1 struct {
 
@@ -40,6 +46,10 @@ type TradeContract1 struct {
 	Attachment []*DocumentGeneralInformation3 `xml:"Attchmnt,omitempty"`
 }
 
+func (t *TradeContract1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeContract1) AddContractDocumentIdentification() *DocumentIdentification22 {
 	t.ContractDocumentIdentification = new(DocumentIdentification22)
 	return t.ContractDocumentIdentification
@@ -49,6 +59,10 @@ func (t *TradeContract1) SetAmount(value, currency string) {
 	t.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TradeContract1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TradeContract1) AddBuyer() *TradeParty2 {
 	newValue := new(TradeParty2)
 	t.Buyer = append(t.Buyer, newValue)
@@ -345,14 +359,29 @@ func (t *TradeContract1) SetMaturityDate(value string) {
 	t.MaturityDate = (*ISODate)(&value)
 }
 
+func (t *TradeContract1) SetMaturityDateFromTime(value time.Time) {
+	t.MaturityDate = new(ISODate)
+	t.MaturityDate.FromTime(value)
+}
+
 func (t *TradeContract1) SetProlongationFlag(value string) {
 	t.ProlongationFlag = (*TrueFalseIndicator)(&value)
 }
 
+func (t *TradeContract1) SetProlongationFlagFromBool(value bool) {
+	t.ProlongationFlag = new(TrueFalseIndicator)
+	t.ProlongationFlag.FromBool(value)
+}
+
 func (t *TradeContract1) SetStartDate(value string) {
 	t.StartDate = (*ISODate)(&value)
 }
 
+func (t *TradeContract1) SetStartDateFromTime(value time.Time) {
+	t.StartDate = new(ISODate)
+	t.StartDate.FromTime(value)
+}
+
 func (t *TradeContract1) SetSettlementCurrency(value string) {
 	t.SettlementCurrency = (*ActiveCurrencyCode)(&value)
 }
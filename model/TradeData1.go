@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information on the status of a trade.
 type TradeData1 struct {
 
@@ -40,6 +44,10 @@ type TradeData1 struct {
 	ProductType *Max4AlphaNumericText `xml:"PdctTp,omitempty"`
 }
 
+func (t *TradeData1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeData1) SetNotificationIdentification(value string) {
 	t.NotificationIdentification = (*Max35Text)(&value)
 }
@@ -68,6 +76,11 @@ func (t *TradeData1) SetCurrentStatusTime(value string) {
 	t.CurrentStatusTime = (*ISODateTime)(&value)
 }
 
+func (t *TradeData1) SetCurrentStatusTimeFromTime(value time.Time) {
+	t.CurrentStatusTime = new(ISODateTime)
+	t.CurrentStatusTime.FromTime(value)
+}
+
 func (t *TradeData1) SetPreviousStatus(value string) {
 	t.PreviousStatus = (*TradeStatus1Code)(&value)
 }
@@ -84,6 +97,11 @@ func (t *TradeData1) SetPreviousStatusTime(value string) {
 	t.PreviousStatusTime = (*ISODateTime)(&value)
 }
 
+func (t *TradeData1) SetPreviousStatusTimeFromTime(value time.Time) {
+	t.PreviousStatusTime = new(ISODateTime)
+	t.PreviousStatusTime.FromTime(value)
+}
+
 func (t *TradeData1) SetProductType(value string) {
 	t.ProductType = (*Max4AlphaNumericText)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information on the status of a trade.
 type TradeData10 struct {
 
@@ -28,6 +32,10 @@ type TradeData10 struct {
 	ProductType *Max35Text `xml:"PdctTp,omitempty"`
 }
 
+func (t *TradeData10) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeData10) SetMessageIdentification(value string) {
 	t.MessageIdentification = (*Max35Text)(&value)
 }
@@ -49,6 +57,11 @@ func (t *TradeData10) SetCurrentStatusDateTime(value string) {
 	t.CurrentStatusDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TradeData10) SetCurrentStatusDateTimeFromTime(value time.Time) {
+	t.CurrentStatusDateTime = new(ISODateTime)
+	t.CurrentStatusDateTime.FromTime(value)
+}
+
 func (t *TradeData10) AddPreviousStatus() *Status5Choice {
 	t.PreviousStatus = new(Status5Choice)
 	return t.PreviousStatus
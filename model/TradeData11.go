@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information and details on the status of a trade.
 type TradeData11 struct {
 
@@ -34,6 +38,10 @@ type TradeData11 struct {
 	RegulatoryReporting *RegulatoryReporting4 `xml:"RgltryRptg,omitempty"`
 }
 
+func (t *TradeData11) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeData11) SetOriginatorReference(value string) {
 	t.OriginatorReference = (*Max35Text)(&value)
 }
@@ -54,14 +62,29 @@ func (t *TradeData11) SetCurrentSettlementDate(value string) {
 	t.CurrentSettlementDate = (*ISODate)(&value)
 }
 
+func (t *TradeData11) SetCurrentSettlementDateFromTime(value time.Time) {
+	t.CurrentSettlementDate = new(ISODate)
+	t.CurrentSettlementDate.FromTime(value)
+}
+
 func (t *TradeData11) SetNewSettlementDate(value string) {
 	t.NewSettlementDate = (*ISODate)(&value)
 }
 
+func (t *TradeData11) SetNewSettlementDateFromTime(value time.Time) {
+	t.NewSettlementDate = new(ISODate)
+	t.NewSettlementDate.FromTime(value)
+}
+
 func (t *TradeData11) SetCurrentStatusDateTime(value string) {
 	t.CurrentStatusDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TradeData11) SetCurrentStatusDateTimeFromTime(value time.Time) {
+	t.CurrentStatusDateTime = new(ISODateTime)
+	t.CurrentStatusDateTime.FromTime(value)
+}
+
 func (t *TradeData11) SetProductType(value string) {
 	t.ProductType = (*Max35Text)(&value)
 }
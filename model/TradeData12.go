@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information on the status of a trade.
 type TradeData12 struct {
 
@@ -34,6 +38,10 @@ type TradeData12 struct {
 	LinkedReportIdentification *Max35Text `xml:"LkdRptId,omitempty"`
 }
 
+func (t *TradeData12) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeData12) SetMessageIdentification(value string) {
 	t.MessageIdentification = (*Max35Text)(&value)
 }
@@ -55,6 +63,11 @@ func (t *TradeData12) SetCurrentStatusDateTime(value string) {
 	t.CurrentStatusDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TradeData12) SetCurrentStatusDateTimeFromTime(value time.Time) {
+	t.CurrentStatusDateTime = new(ISODateTime)
+	t.CurrentStatusDateTime.FromTime(value)
+}
+
 func (t *TradeData12) AddPreviousStatus() *Status28Choice {
 	t.PreviousStatus = new(Status28Choice)
 	return t.PreviousStatus
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information on the status of a trade.
 type TradeData14 struct {
 
@@ -34,6 +38,10 @@ type TradeData14 struct {
 	PreviousStatusSubType *StatusSubType2Code `xml:"PrvsStsSubTp,omitempty"`
 }
 
+func (t *TradeData14) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeData14) SetMatchingSystemUniqueReference(value string) {
 	t.MatchingSystemUniqueReference = (*Max35Text)(&value)
 }
@@ -63,6 +71,11 @@ func (t *TradeData14) SetCurrentStatusDateTime(value string) {
 	t.CurrentStatusDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TradeData14) SetCurrentStatusDateTimeFromTime(value time.Time) {
+	t.CurrentStatusDateTime = new(ISODateTime)
+	t.CurrentStatusDateTime.FromTime(value)
+}
+
 func (t *TradeData14) AddPreviousStatus() *Status28Choice {
 	t.PreviousStatus = new(Status28Choice)
 	return t.PreviousStatus
@@ -72,6 +85,11 @@ func (t *TradeData14) SetAllegedTrade(value string) {
 	t.AllegedTrade = (*YesNoIndicator)(&value)
 }
 
+func (t *TradeData14) SetAllegedTradeFromBool(value bool) {
+	t.AllegedTrade = new(YesNoIndicator)
+	t.AllegedTrade.FromBool(value)
+}
+
 func (t *TradeData14) SetPreviousStatusSubType(value string) {
 	t.PreviousStatusSubType = (*StatusSubType2Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information on the status of a trade.
 type TradeData16 struct {
 
@@ -31,6 +35,10 @@ type TradeData16 struct {
 	PreviousStatusSubType *StatusSubType2Code `xml:"PrvsStsSubTp,omitempty"`
 }
 
+func (t *TradeData16) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeData16) SetMatchingSystemUniqueReference(value string) {
 	t.MatchingSystemUniqueReference = (*Max35Text)(&value)
 }
@@ -60,6 +68,11 @@ func (t *TradeData16) SetCurrentStatusDateTime(value string) {
 	t.CurrentStatusDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TradeData16) SetCurrentStatusDateTimeFromTime(value time.Time) {
+	t.CurrentStatusDateTime = new(ISODateTime)
+	t.CurrentStatusDateTime.FromTime(value)
+}
+
 func (t *TradeData16) AddPreviousStatus() *Status28Choice {
 	t.PreviousStatus = new(Status28Choice)
 	return t.PreviousStatus
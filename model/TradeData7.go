@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information on the status of a trade.
 type TradeData7 struct {
 
@@ -49,6 +53,10 @@ type TradeData7 struct {
 	SplitTradeIndicator *YesNoIndicator `xml:"SpltTradInd,omitempty"`
 }
 
+func (t *TradeData7) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeData7) SetMessageIdentification(value string) {
 	t.MessageIdentification = (*Max35Text)(&value)
 }
@@ -86,6 +94,11 @@ func (t *TradeData7) SetCurrentStatusDateTime(value string) {
 	t.CurrentStatusDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TradeData7) SetCurrentStatusDateTimeFromTime(value time.Time) {
+	t.CurrentStatusDateTime = new(ISODateTime)
+	t.CurrentStatusDateTime.FromTime(value)
+}
+
 func (t *TradeData7) AddPreviousStatus() *Status6Choice {
 	t.PreviousStatus = new(Status6Choice)
 	return t.PreviousStatus
@@ -99,6 +112,11 @@ func (t *TradeData7) SetPreviousStatusDateTime(value string) {
 	t.PreviousStatusDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TradeData7) SetPreviousStatusDateTimeFromTime(value time.Time) {
+	t.PreviousStatusDateTime = new(ISODateTime)
+	t.PreviousStatusDateTime.FromTime(value)
+}
+
 func (t *TradeData7) SetProductType(value string) {
 	t.ProductType = (*Max35Text)(&value)
 }
@@ -110,3 +128,8 @@ func (t *TradeData7) SetSettlementSessionIdentifier(value string) {
 func (t *TradeData7) SetSplitTradeIndicator(value string) {
 	t.SplitTradeIndicator = (*YesNoIndicator)(&value)
 }
+
+func (t *TradeData7) SetSplitTradeIndicatorFromBool(value bool) {
+	t.SplitTradeIndicator = new(YesNoIndicator)
+	t.SplitTradeIndicator.FromBool(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides information on the status of a trade.
 type TradeData9 struct {
 
@@ -34,6 +38,10 @@ type TradeData9 struct {
 	PreviousStatusSubType *StatusSubType1Code `xml:"PrvsStsSubTp,omitempty"`
 }
 
+func (t *TradeData9) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeData9) SetMatchingSystemUniqueReference(value string) {
 	t.MatchingSystemUniqueReference = (*Max35Text)(&value)
 }
@@ -63,6 +71,11 @@ func (t *TradeData9) SetCurrentStatusDateTime(value string) {
 	t.CurrentStatusDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TradeData9) SetCurrentStatusDateTimeFromTime(value time.Time) {
+	t.CurrentStatusDateTime = new(ISODateTime)
+	t.CurrentStatusDateTime.FromTime(value)
+}
+
 func (t *TradeData9) AddPreviousStatus() *Status5Choice {
 	t.PreviousStatus = new(Status5Choice)
 	return t.PreviousStatus
@@ -72,6 +85,11 @@ func (t *TradeData9) SetAllegedTrade(value string) {
 	t.AllegedTrade = (*YesNoIndicator)(&value)
 }
 
+func (t *TradeData9) SetAllegedTradeFromBool(value bool) {
+	t.AllegedTrade = new(YesNoIndicator)
+	t.AllegedTrade.FromBool(value)
+}
+
 func (t *TradeData9) SetPreviousStatusSubType(value string) {
 	t.PreviousStatusSubType = (*StatusSubType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TradeDate1Choice struct {
 	DateCode *TradeDateCode1Choice `xml:"DtCd"`
 }
 
+func (t *TradeDate1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeDate1Choice) AddDate() *DateAndDateTimeChoice {
 	t.Date = new(DateAndDateTimeChoice)
 	return t.Date
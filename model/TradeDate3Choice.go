@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Choice of format for the trade date.
 type TradeDate3Choice struct {
 
@@ -10,10 +14,19 @@ type TradeDate3Choice struct {
 	DateCode *DateType1Code `xml:"DtCd"`
 }
 
+func (t *TradeDate3Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeDate3Choice) SetDate(value string) {
 	t.Date = (*ISODate)(&value)
 }
 
+func (t *TradeDate3Choice) SetDateFromTime(value time.Time) {
+	t.Date = new(ISODate)
+	t.Date.FromTime(value)
+}
+
 func (t *TradeDate3Choice) SetDateCode(value string) {
 	t.DateCode = (*DateType1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TradeDate4Choice struct {
 	Value *TradingDateCode1Choice `xml:"Val"`
 }
 
+func (t *TradeDate4Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeDate4Choice) AddDate() *DateAndDateTime1Choice {
 	t.Date = new(DateAndDateTime1Choice)
 	return t.Date
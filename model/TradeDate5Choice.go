@@ -10,6 +10,10 @@ type TradeDate5Choice struct {
 	DateCode *TradeDateCode3Choice `xml:"DtCd"`
 }
 
+func (t *TradeDate5Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeDate5Choice) AddDate() *DateAndDateTimeChoice {
 	t.Date = new(DateAndDateTimeChoice)
 	return t.Date
@@ -10,6 +10,10 @@ type TradeDate6Choice struct {
 	DateCode *TradeDateCode4Choice `xml:"DtCd"`
 }
 
+func (t *TradeDate6Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeDate6Choice) AddDate() *DateAndDateTimeChoice {
 	t.Date = new(DateAndDateTimeChoice)
 	return t.Date
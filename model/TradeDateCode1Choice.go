@@ -10,6 +10,10 @@ type TradeDateCode1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (t *TradeDateCode1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeDateCode1Choice) SetCode(value string) {
 	t.Code = (*DateType3Code)(&value)
 }
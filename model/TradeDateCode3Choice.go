@@ -10,6 +10,10 @@ type TradeDateCode3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (t *TradeDateCode3Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeDateCode3Choice) SetCode(value string) {
 	t.Code = (*DateType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type TradeDateCode4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TradeDateCode4Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeDateCode4Choice) SetCode(value string) {
 	t.Code = (*DateType3Code)(&value)
 }
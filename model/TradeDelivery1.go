@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies how the supply chain shipping arrangements and the delivery of products and/or services as well as related documentation.
 type TradeDelivery1 struct {
 
@@ -25,6 +29,10 @@ type TradeDelivery1 struct {
 	Consignment []*Consignment2 `xml:"Consgnmt,omitempty"`
 }
 
+func (t *TradeDelivery1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeDelivery1) AddDeliveryPeriod() *Period1 {
 	t.DeliveryPeriod = new(Period1)
 	return t.DeliveryPeriod
@@ -34,6 +42,11 @@ func (t *TradeDelivery1) SetDeliveryDateTime(value string) {
 	t.DeliveryDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TradeDelivery1) SetDeliveryDateTimeFromTime(value time.Time) {
+	t.DeliveryDateTime = new(ISODateTime)
+	t.DeliveryDateTime.FromTime(value)
+}
+
 func (t *TradeDelivery1) AddShipFrom() *TradeParty1 {
 	t.ShipFrom = new(TradeParty1)
 	return t.ShipFrom
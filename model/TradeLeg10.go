@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides the trade leg details.
 type TradeLeg10 struct {
 
@@ -79,6 +83,10 @@ type TradeLeg10 struct {
 	SafekeepingAccount *SecuritiesAccount19 `xml:"SfkpgAcct,omitempty"`
 }
 
+func (t *TradeLeg10) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeLeg10) SetTradeLegIdentification(value string) {
 	t.TradeLegIdentification = (*Max35Text)(&value)
 }
@@ -103,10 +111,20 @@ func (t *TradeLeg10) SetTradeDate(value string) {
 	t.TradeDate = (*ISODate)(&value)
 }
 
+func (t *TradeLeg10) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODate)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *TradeLeg10) SetTransactionDateAndTime(value string) {
 	t.TransactionDateAndTime = (*ISODateTime)(&value)
 }
 
+func (t *TradeLeg10) SetTransactionDateAndTimeFromTime(value time.Time) {
+	t.TransactionDateAndTime = new(ISODateTime)
+	t.TransactionDateAndTime.FromTime(value)
+}
+
 func (t *TradeLeg10) AddSettlementDate() *DateFormat15Choice {
 	t.SettlementDate = new(DateFormat15Choice)
 	return t.SettlementDate
@@ -153,6 +171,11 @@ func (t *TradeLeg10) SetDerivativeRelatedTrade(value string) {
 	t.DerivativeRelatedTrade = (*YesNoIndicator)(&value)
 }
 
+func (t *TradeLeg10) SetDerivativeRelatedTradeFromBool(value bool) {
+	t.DerivativeRelatedTrade = new(YesNoIndicator)
+	t.DerivativeRelatedTrade.FromBool(value)
+}
+
 func (t *TradeLeg10) AddBroker() *PartyIdentificationAndAccount100 {
 	t.Broker = new(PartyIdentificationAndAccount100)
 	return t.Broker
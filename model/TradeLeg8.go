@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides the trade leg details.
 type TradeLeg8 struct {
 
@@ -88,6 +92,10 @@ type TradeLeg8 struct {
 	SafekeepingAccount *SecuritiesAccount19 `xml:"SfkpgAcct,omitempty"`
 }
 
+func (t *TradeLeg8) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeLeg8) SetTradeLegIdentification(value string) {
 	t.TradeLegIdentification = (*Max35Text)(&value)
 }
@@ -116,10 +124,20 @@ func (t *TradeLeg8) SetTradeDate(value string) {
 	t.TradeDate = (*ISODateTime)(&value)
 }
 
+func (t *TradeLeg8) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODateTime)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *TradeLeg8) SetTransactionDateTime(value string) {
 	t.TransactionDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TradeLeg8) SetTransactionDateTimeFromTime(value time.Time) {
+	t.TransactionDateTime = new(ISODateTime)
+	t.TransactionDateTime.FromTime(value)
+}
+
 func (t *TradeLeg8) AddSettlementDate() *DateFormat15Choice {
 	t.SettlementDate = new(DateFormat15Choice)
 	return t.SettlementDate
@@ -176,6 +194,11 @@ func (t *TradeLeg8) SetDerivativeRelatedTrade(value string) {
 	t.DerivativeRelatedTrade = (*YesNoIndicator)(&value)
 }
 
+func (t *TradeLeg8) SetDerivativeRelatedTradeFromBool(value bool) {
+	t.DerivativeRelatedTrade = new(YesNoIndicator)
+	t.DerivativeRelatedTrade.FromBool(value)
+}
+
 func (t *TradeLeg8) AddBroker() *PartyIdentificationAndAccount100 {
 	t.Broker = new(PartyIdentificationAndAccount100)
 	return t.Broker
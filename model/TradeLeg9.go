@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Provides the trade leg details.
 type TradeLeg9 struct {
 
@@ -100,6 +104,10 @@ type TradeLeg9 struct {
 	GrossAmount *AmountAndDirection21 `xml:"GrssAmt,omitempty"`
 }
 
+func (t *TradeLeg9) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeLeg9) AddMarginAccount() *SecuritiesAccount19 {
 	t.MarginAccount = new(SecuritiesAccount19)
 	return t.MarginAccount
@@ -139,10 +147,20 @@ func (t *TradeLeg9) SetTradeDate(value string) {
 	t.TradeDate = (*ISODateTime)(&value)
 }
 
+func (t *TradeLeg9) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODateTime)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *TradeLeg9) SetTransactionDateAndTime(value string) {
 	t.TransactionDateAndTime = (*ISODateTime)(&value)
 }
 
+func (t *TradeLeg9) SetTransactionDateAndTimeFromTime(value time.Time) {
+	t.TransactionDateAndTime = new(ISODateTime)
+	t.TransactionDateAndTime.FromTime(value)
+}
+
 func (t *TradeLeg9) AddSettlementDate() *DateFormat15Choice {
 	t.SettlementDate = new(DateFormat15Choice)
 	return t.SettlementDate
@@ -194,6 +212,11 @@ func (t *TradeLeg9) SetDerivativeRelatedTrade(value string) {
 	t.DerivativeRelatedTrade = (*YesNoIndicator)(&value)
 }
 
+func (t *TradeLeg9) SetDerivativeRelatedTradeFromBool(value bool) {
+	t.DerivativeRelatedTrade = new(YesNoIndicator)
+	t.DerivativeRelatedTrade.FromBool(value)
+}
+
 func (t *TradeLeg9) AddBroker() *PartyIdentificationAndAccount100 {
 	t.Broker = new(PartyIdentificationAndAccount100)
 	return t.Broker
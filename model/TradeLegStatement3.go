@@ -17,6 +17,10 @@ type TradeLegStatement3 struct {
 	TradeLegsDetails []*TradeLeg9 `xml:"TradLegsDtls"`
 }
 
+func (t *TradeLegStatement3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeLegStatement3) AddClearingAccount() *SecuritiesAccount18 {
 	t.ClearingAccount = new(SecuritiesAccount18)
 	return t.ClearingAccount
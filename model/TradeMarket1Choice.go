@@ -10,6 +10,10 @@ type TradeMarket1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (t *TradeMarket1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeMarket1Choice) SetCode(value string) {
 	t.Code = (*ExternalTradeMarket1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TradeOriginator1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (t *TradeOriginator1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeOriginator1Choice) SetCode(value string) {
 	t.Code = (*OriginatorRole2Code)(&value)
 }
@@ -10,6 +10,10 @@ type TradeOriginator3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (t *TradeOriginator3Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeOriginator3Choice) SetCode(value string) {
 	t.Code = (*OriginatorRole2Code)(&value)
 }
@@ -10,6 +10,10 @@ type TradeOriginator4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TradeOriginator4Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeOriginator4Choice) SetCode(value string) {
 	t.Code = (*OriginatorRole2Code)(&value)
 }
@@ -13,6 +13,10 @@ type TradeParty1 struct {
 	TaxParty []*TaxParty3 `xml:"TaxPty,omitempty"`
 }
 
+func (t *TradeParty1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeParty1) AddPartyIdentification() *PartyIdentification45 {
 	t.PartyIdentification = new(PartyIdentification45)
 	return t.PartyIdentification
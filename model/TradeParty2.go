@@ -13,6 +13,10 @@ type TradeParty2 struct {
 	TaxParty []*TaxParty3 `xml:"TaxPty,omitempty"`
 }
 
+func (t *TradeParty2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeParty2) AddPartyIdentification() *PartyIdentification77 {
 	t.PartyIdentification = new(PartyIdentification77)
 	return t.PartyIdentification
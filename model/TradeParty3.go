@@ -13,6 +13,10 @@ type TradeParty3 struct {
 	TaxParty []*TaxParty3 `xml:"TaxPty,omitempty"`
 }
 
+func (t *TradeParty3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeParty3) AddPartyIdentification() *PartyIdentification112 {
 	t.PartyIdentification = new(PartyIdentification112)
 	return t.PartyIdentification
@@ -13,6 +13,10 @@ type TradePartyIdentification3 struct {
 	TradeParty *PartyIdentification8Choice `xml:"TradPty"`
 }
 
+func (t *TradePartyIdentification3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradePartyIdentification3) AddFundInformation() *FundIdentification2 {
 	t.FundInformation = new(FundIdentification2)
 	return t.FundInformation
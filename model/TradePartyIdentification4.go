@@ -16,6 +16,10 @@ type TradePartyIdentification4 struct {
 	TradeParty *PartyIdentification8Choice `xml:"TradPty"`
 }
 
+func (t *TradePartyIdentification4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradePartyIdentification4) AddFundInformation() *FundIdentification2 {
 	t.FundInformation = new(FundIdentification2)
 	return t.FundInformation
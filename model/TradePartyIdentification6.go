@@ -14,6 +14,10 @@ type TradePartyIdentification6 struct {
 	FundIdentification []*FundIdentification4 `xml:"FndId,omitempty"`
 }
 
+func (t *TradePartyIdentification6) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradePartyIdentification6) AddSubmittingParty() *PartyIdentification73Choice {
 	t.SubmittingParty = new(PartyIdentification73Choice)
 	return t.SubmittingParty
@@ -25,6 +25,10 @@ type TradeProduct1 struct {
 	GlobalSerialIdentifier []*Max35Text `xml:"GblSrlIdr,omitempty"`
 }
 
+func (t *TradeProduct1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeProduct1) AddIdentification() *ProductIdentifier2Choice {
 	newValue := new(ProductIdentifier2Choice)
 	t.Identification = append(t.Identification, newValue)
@@ -25,6 +25,10 @@ type TradeProduct2 struct {
 	GlobalSerialIdentifier []*Max35Text `xml:"GblSrlIdr,omitempty"`
 }
 
+func (t *TradeProduct2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeProduct2) AddIdentification() *ProductIdentifier2Choice {
 	newValue := new(ProductIdentifier2Choice)
 	t.Identification = append(t.Identification, newValue)
@@ -85,6 +85,10 @@ type TradeSettlement1 struct {
 	IssuerFactoringAgreementIdentification []*Max35Text `xml:"IssrFactrgAgrmtId,omitempty"`
 }
 
+func (t *TradeSettlement1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeSettlement1) AddDuePayableAmount(value, currency string) {
 	t.DuePayableAmount = append(t.DuePayableAmount, NewCurrencyAndAmount(value, currency))
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Trade settlement details for this invoice which involves the payment of an outstanding debt, account, or charge
 type TradeSettlement2 struct {
 
@@ -37,6 +43,10 @@ type TradeSettlement2 struct {
 	EarlyPayments []*EarlyPayment1 `xml:"EarlyPmts,omitempty"`
 }
 
+func (t *TradeSettlement2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeSettlement2) AddPaymentReference() *CreditorReferenceInformation2 {
 	t.PaymentReference = new(CreditorReferenceInformation2)
 	return t.PaymentReference
@@ -46,10 +56,19 @@ func (t *TradeSettlement2) SetDueDate(value string) {
 	t.DueDate = (*ISODate)(&value)
 }
 
+func (t *TradeSettlement2) SetDueDateFromTime(value time.Time) {
+	t.DueDate = new(ISODate)
+	t.DueDate.FromTime(value)
+}
+
 func (t *TradeSettlement2) SetDuePayableAmount(value, currency string) {
 	t.DuePayableAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (t *TradeSettlement2) SetDuePayableAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.DuePayableAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TradeSettlement2) AddInvoiceCurrencyExchange() *CurrencyReference3 {
 	t.InvoiceCurrencyExchange = new(CurrencyReference3)
 	return t.InvoiceCurrencyExchange
@@ -59,6 +78,11 @@ func (t *TradeSettlement2) SetDeliveryDate(value string) {
 	t.DeliveryDate = (*ISODate)(&value)
 }
 
+func (t *TradeSettlement2) SetDeliveryDateFromTime(value time.Time) {
+	t.DeliveryDate = new(ISODate)
+	t.DeliveryDate.FromTime(value)
+}
+
 func (t *TradeSettlement2) AddBillingPeriod() *Period2 {
 	t.BillingPeriod = new(Period2)
 	return t.BillingPeriod
@@ -68,6 +92,10 @@ func (t *TradeSettlement2) SetTaxTotalAmount(value, currency string) {
 	t.TaxTotalAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (t *TradeSettlement2) SetTaxTotalAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TaxTotalAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TradeSettlement2) SetExemptionReasonCode(value string) {
 	t.ExemptionReasonCode = (*Max4Text)(&value)
 }
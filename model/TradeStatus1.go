@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the status of a trade in a central settlement system.
 type TradeStatus1 struct {
 
@@ -25,10 +29,19 @@ type TradeStatus1 struct {
 	StatusOriginator *Max35Text `xml:"StsOrgtr,omitempty"`
 }
 
+func (t *TradeStatus1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeStatus1) SetAllegedTrade(value string) {
 	t.AllegedTrade = (*YesNoIndicator)(&value)
 }
 
+func (t *TradeStatus1) SetAllegedTradeFromBool(value bool) {
+	t.AllegedTrade = new(YesNoIndicator)
+	t.AllegedTrade.FromBool(value)
+}
+
 func (t *TradeStatus1) SetMatchingSystemUniqueReference(value string) {
 	t.MatchingSystemUniqueReference = (*Max35Text)(&value)
 }
@@ -49,6 +62,11 @@ func (t *TradeStatus1) SetStatusTime(value string) {
 	t.StatusTime = (*ISODateTime)(&value)
 }
 
+func (t *TradeStatus1) SetStatusTimeFromTime(value time.Time) {
+	t.StatusTime = new(ISODateTime)
+	t.StatusTime.FromTime(value)
+}
+
 func (t *TradeStatus1) SetStatusOriginator(value string) {
 	t.StatusOriginator = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type TradeStatusReport1 struct {
 	AdditionalInformation *Max35Text `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TradeStatusReport1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeStatusReport1) AddOriginalMessageDetails() *OriginalMessage1 {
 	t.OriginalMessageDetails = new(OriginalMessage1)
 	return t.OriginalMessageDetails
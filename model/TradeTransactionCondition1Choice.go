@@ -10,6 +10,10 @@ type TradeTransactionCondition1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (t *TradeTransactionCondition1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeTransactionCondition1Choice) SetCode(value string) {
 	t.Code = (*TradeTransactionCondition4Code)(&value)
 }
@@ -10,6 +10,10 @@ type TradeTransactionCondition4Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (t *TradeTransactionCondition4Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeTransactionCondition4Choice) SetCode(value string) {
 	t.Code = (*ExternalTradeTransactionCondition1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TradeTransactionCondition5Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (t *TradeTransactionCondition5Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeTransactionCondition5Choice) SetCode(value string) {
 	t.Code = (*TradeTransactionCondition4Code)(&value)
 }
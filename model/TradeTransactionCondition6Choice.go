@@ -10,6 +10,10 @@ type TradeTransactionCondition6Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TradeTransactionCondition6Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeTransactionCondition6Choice) SetCode(value string) {
 	t.Code = (*TradeTransactionCondition4Code)(&value)
 }
@@ -10,6 +10,10 @@ type TradeTransactionCondition8Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (t *TradeTransactionCondition8Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeTransactionCondition8Choice) SetCode(value string) {
 	t.Code = (*TradeTransactionCondition5Code)(&value)
 }
@@ -16,6 +16,10 @@ type TradeTransactionStatusAndReason1 struct {
 	Rejected []*RejectedStatusReason9Choice `xml:"Rjctd"`
 }
 
+func (t *TradeTransactionStatusAndReason1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeTransactionStatusAndReason1) SetRelatedReference(value string) {
 	t.RelatedReference = (*Max35Text)(&value)
 }
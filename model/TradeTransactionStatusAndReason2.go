@@ -16,6 +16,10 @@ type TradeTransactionStatusAndReason2 struct {
 	Rejected []*RejectedCancellationStatusReason1Choice `xml:"Rjctd"`
 }
 
+func (t *TradeTransactionStatusAndReason2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeTransactionStatusAndReason2) SetRelatedReference(value string) {
 	t.RelatedReference = (*Max35Text)(&value)
 }
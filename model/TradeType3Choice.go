@@ -10,6 +10,10 @@ type TradeType3Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (t *TradeType3Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradeType3Choice) SetCode(value string) {
 	t.Code = (*TradeType3Code)(&value)
 }
@@ -10,6 +10,10 @@ type TradingDateCode1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (t *TradingDateCode1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradingDateCode1Choice) SetCode(value string) {
 	t.Code = (*TradingDate1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TradingNameModification1 struct {
 	TradingName *Max350Text `xml:"TradgNm"`
 }
 
+func (t *TradingNameModification1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradingNameModification1) SetModificationCode(value string) {
 	t.ModificationCode = (*Modification1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TradingPartyCapacity1Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (t *TradingPartyCapacity1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradingPartyCapacity1Choice) SetCode(value string) {
 	t.Code = (*TradingCapacity4Code)(&value)
 }
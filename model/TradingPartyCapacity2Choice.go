@@ -10,6 +10,10 @@ type TradingPartyCapacity2Choice struct {
 	Proprietary *GenericIdentification29 `xml:"Prtry"`
 }
 
+func (t *TradingPartyCapacity2Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradingPartyCapacity2Choice) SetCode(value string) {
 	t.Code = (*TradingCapacity6Code)(&value)
 }
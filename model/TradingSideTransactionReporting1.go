@@ -13,6 +13,10 @@ type TradingSideTransactionReporting1 struct {
 	TradingSideUniqueTransactionIdentifier []*UniqueTransactionIdentifier2 `xml:"TradgSdUnqTxIdr,omitempty"`
 }
 
+func (t *TradingSideTransactionReporting1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TradingSideTransactionReporting1) SetReportingJurisdiction(value string) {
 	t.ReportingJurisdiction = (*Max35Text)(&value)
 }
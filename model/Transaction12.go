@@ -40,6 +40,10 @@ type Transaction12 struct {
 	StatusAndReason []*StatusAndReason2 `xml:"StsAndRsn,omitempty"`
 }
 
+func (t *Transaction12) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction12) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -40,6 +40,10 @@ type Transaction13 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *Transaction13) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction13) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -37,6 +37,10 @@ type Transaction14 struct {
 	TransactionDetails *TransactionDetails26 `xml:"TxDtls,omitempty"`
 }
 
+func (t *Transaction14) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction14) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -40,6 +40,10 @@ type Transaction18 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *Transaction18) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction18) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
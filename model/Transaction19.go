@@ -40,6 +40,10 @@ type Transaction19 struct {
 	StatusAndReason []*Status9Choice `xml:"StsAndRsn,omitempty"`
 }
 
+func (t *Transaction19) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction19) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
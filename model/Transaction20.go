@@ -37,6 +37,10 @@ type Transaction20 struct {
 	TransactionDetails *TransactionDetails39 `xml:"TxDtls,omitempty"`
 }
 
+func (t *Transaction20) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction20) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
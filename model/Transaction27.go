@@ -46,6 +46,10 @@ type Transaction27 struct {
 	StatusAndReason []*Status9Choice `xml:"StsAndRsn,omitempty"`
 }
 
+func (t *Transaction27) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction27) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -43,6 +43,10 @@ type Transaction28 struct {
 	TransactionDetails *TransactionDetails56 `xml:"TxDtls,omitempty"`
 }
 
+func (t *Transaction28) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction28) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
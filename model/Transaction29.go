@@ -46,6 +46,10 @@ type Transaction29 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *Transaction29) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction29) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -46,6 +46,10 @@ type Transaction34 struct {
 	StatusAndReason []*Status15Choice `xml:"StsAndRsn,omitempty"`
 }
 
+func (t *Transaction34) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction34) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -43,6 +43,10 @@ type Transaction35 struct {
 	TransactionDetails *TransactionDetails62 `xml:"TxDtls,omitempty"`
 }
 
+func (t *Transaction35) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction35) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
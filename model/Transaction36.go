@@ -46,6 +46,10 @@ type Transaction36 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *Transaction36) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction36) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -43,6 +43,10 @@ type Transaction40 struct {
 	TransactionDetails *TransactionDetails69 `xml:"TxDtls,omitempty"`
 }
 
+func (t *Transaction40) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction40) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
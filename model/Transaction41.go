@@ -46,6 +46,10 @@ type Transaction41 struct {
 	StatusAndReason []*Status15Choice `xml:"StsAndRsn,omitempty"`
 }
 
+func (t *Transaction41) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction41) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -43,6 +43,10 @@ type Transaction45 struct {
 	TransactionDetails *TransactionDetails75 `xml:"TxDtls,omitempty"`
 }
 
+func (t *Transaction45) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction45) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -46,6 +46,10 @@ type Transaction46 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *Transaction46) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction46) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -46,6 +46,10 @@ type Transaction47 struct {
 	StatusAndReason []*Status18Choice `xml:"StsAndRsn,omitempty"`
 }
 
+func (t *Transaction47) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction47) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -43,6 +43,10 @@ type Transaction48 struct {
 	TransactionDetails *TransactionDetails90 `xml:"TxDtls,omitempty"`
 }
 
+func (t *Transaction48) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction48) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
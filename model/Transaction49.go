@@ -46,6 +46,10 @@ type Transaction49 struct {
 	StatusAndReason []*Status23Choice `xml:"StsAndRsn,omitempty"`
 }
 
+func (t *Transaction49) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction49) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
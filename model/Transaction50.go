@@ -46,6 +46,10 @@ type Transaction50 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *Transaction50) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction50) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -46,6 +46,10 @@ type Transaction52 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *Transaction52) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction52) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -46,6 +46,10 @@ type Transaction53 struct {
 	StatusAndReason []*Status18Choice `xml:"StsAndRsn,omitempty"`
 }
 
+func (t *Transaction53) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction53) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -43,6 +43,10 @@ type Transaction54 struct {
 	TransactionDetails *TransactionDetails96 `xml:"TxDtls,omitempty"`
 }
 
+func (t *Transaction54) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction54) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -46,6 +46,10 @@ type Transaction55 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *Transaction55) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction55) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -43,6 +43,10 @@ type Transaction56 struct {
 	TransactionDetails *TransactionDetails99 `xml:"TxDtls,omitempty"`
 }
 
+func (t *Transaction56) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction56) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -46,6 +46,10 @@ type Transaction57 struct {
 	StatusAndReason []*Status23Choice `xml:"StsAndRsn,omitempty"`
 }
 
+func (t *Transaction57) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction57) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
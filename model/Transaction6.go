@@ -37,6 +37,10 @@ type Transaction6 struct {
 	Extension []*Extension2 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *Transaction6) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction6) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -34,6 +34,10 @@ type Transaction7 struct {
 	TransactionDetails *TransactionDetails7 `xml:"TxDtls,omitempty"`
 }
 
+func (t *Transaction7) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction7) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -37,6 +37,10 @@ type Transaction8 struct {
 	StatusAndReason []*StatusAndReason2 `xml:"StsAndRsn,omitempty"`
 }
 
+func (t *Transaction8) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transaction8) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type TransactionActivity1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (t *TransactionActivity1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionActivity1Choice) SetCode(value string) {
 	t.Code = (*TransactionActivity1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TransactionActivity3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (t *TransactionActivity3Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionActivity3Choice) SetCode(value string) {
 	t.Code = (*TransactionActivity1Code)(&value)
 }
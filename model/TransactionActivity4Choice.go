@@ -10,6 +10,10 @@ type TransactionActivity4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TransactionActivity4Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionActivity4Choice) SetCode(value string) {
 	t.Code = (*TransactionActivity1Code)(&value)
 }
@@ -44,6 +44,10 @@ type TransactionAgents1 struct {
 	Proprietary []*ProprietaryAgent1 `xml:"Prtry,omitempty"`
 }
 
+func (t *TransactionAgents1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionAgents1) AddDebtorAgent() *BranchAndFinancialInstitutionIdentification3 {
 	t.DebtorAgent = new(BranchAndFinancialInstitutionIdentification3)
 	return t.DebtorAgent
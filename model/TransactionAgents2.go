@@ -43,6 +43,10 @@ type TransactionAgents2 struct {
 	Proprietary []*ProprietaryAgent2 `xml:"Prtry,omitempty"`
 }
 
+func (t *TransactionAgents2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionAgents2) AddDebtorAgent() *BranchAndFinancialInstitutionIdentification4 {
 	t.DebtorAgent = new(BranchAndFinancialInstitutionIdentification4)
 	return t.DebtorAgent
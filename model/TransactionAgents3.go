@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Provides further details on the agents specific to the individual transaction.
 type TransactionAgents3 struct {
 
@@ -43,6 +45,10 @@ type TransactionAgents3 struct {
 	Proprietary []*ProprietaryAgent3 `xml:"Prtry,omitempty"`
 }
 
+func (t *TransactionAgents3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionAgents3) AddDebtorAgent() *BranchAndFinancialInstitutionIdentification5 {
 	t.DebtorAgent = new(BranchAndFinancialInstitutionIdentification5)
 	return t.DebtorAgent
@@ -94,6 +100,121 @@ func (t *TransactionAgents3) AddProprietary() *ProprietaryAgent3 {
 	return newValue
 }
 
+func (t *TransactionAgents3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.DebtorAgent != nil {
+		t.DebtorAgent.EncodeXMLFast(enc, "DbtrAgt")
+	}
+	if t.CreditorAgent != nil {
+		t.CreditorAgent.EncodeXMLFast(enc, "CdtrAgt")
+	}
+	if t.IntermediaryAgent1 != nil {
+		t.IntermediaryAgent1.EncodeXMLFast(enc, "IntrmyAgt1")
+	}
+	if t.IntermediaryAgent2 != nil {
+		t.IntermediaryAgent2.EncodeXMLFast(enc, "IntrmyAgt2")
+	}
+	if t.IntermediaryAgent3 != nil {
+		t.IntermediaryAgent3.EncodeXMLFast(enc, "IntrmyAgt3")
+	}
+	if t.ReceivingAgent != nil {
+		t.ReceivingAgent.EncodeXMLFast(enc, "RcvgAgt")
+	}
+	if t.DeliveringAgent != nil {
+		t.DeliveringAgent.EncodeXMLFast(enc, "DlvrgAgt")
+	}
+	if t.IssuingAgent != nil {
+		t.IssuingAgent.EncodeXMLFast(enc, "IssgAgt")
+	}
+	if t.SettlementPlace != nil {
+		t.SettlementPlace.EncodeXMLFast(enc, "SttlmPlc")
+	}
+	for _, elem := range t.Proprietary {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Prtry")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TransactionAgents3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "DbtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.DebtorAgent = elem
+		case "CdtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.CreditorAgent = elem
+		case "IntrmyAgt1":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.IntermediaryAgent1 = elem
+		case "IntrmyAgt2":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.IntermediaryAgent2 = elem
+		case "IntrmyAgt3":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.IntermediaryAgent3 = elem
+		case "RcvgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.ReceivingAgent = elem
+		case "DlvrgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.DeliveringAgent = elem
+		case "IssgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.IssuingAgent = elem
+		case "SttlmPlc":
+			elem := new(BranchAndFinancialInstitutionIdentification5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.SettlementPlace = elem
+		case "Prtry":
+			elem := new(ProprietaryAgent3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Proprietary = append(t.Proprietary, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -0,0 +1,250 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Provides further details on the agents specific to the individual transaction.
+type TransactionAgents5 struct {
+
+	// Agent that instructs the next party in the chain to carry out the (set of) instruction(s).
+	InstructingAgent *BranchAndFinancialInstitutionIdentification6 `xml:"InstgAgt,omitempty"`
+
+	// Agent that is instructed by the previous party in the chain to carry out the (set of) instruction(s).
+	InstructedAgent *BranchAndFinancialInstitutionIdentification6 `xml:"InstdAgt,omitempty"`
+
+	// Financial institution servicing an account for the debtor.
+	DebtorAgent *BranchAndFinancialInstitutionIdentification6 `xml:"DbtrAgt,omitempty"`
+
+	// Financial institution servicing an account for the creditor.
+	CreditorAgent *BranchAndFinancialInstitutionIdentification6 `xml:"CdtrAgt,omitempty"`
+
+	// Agent between the debtor's agent and the creditor's agent.
+	//
+	// Usage: If more than one intermediary agent is present, then IntermediaryAgent1 identifies the agent between the DebtorAgent and the IntermediaryAgent2.
+	IntermediaryAgent1 *BranchAndFinancialInstitutionIdentification6 `xml:"IntrmyAgt1,omitempty"`
+
+	// Agent between the debtor's agent and the creditor's agent.
+	//
+	// Usage: If more than two intermediary agents are present, then IntermediaryAgent2 identifies the agent between the IntermediaryAgent1 and the IntermediaryAgent3.
+	IntermediaryAgent2 *BranchAndFinancialInstitutionIdentification6 `xml:"IntrmyAgt2,omitempty"`
+
+	// Agent between the debtor's agent and the creditor's agent.
+	//
+	// Usage: If IntermediaryAgent3 is present, then it identifies the agent between the IntermediaryAgent 2 and the CreditorAgent.
+	IntermediaryAgent3 *BranchAndFinancialInstitutionIdentification6 `xml:"IntrmyAgt3,omitempty"`
+
+	// Party that receives securities from the delivering agent at the place of settlement, such as central securities depository.
+	// Can also be used in the context of treasury operations.
+	ReceivingAgent *BranchAndFinancialInstitutionIdentification6 `xml:"RcvgAgt,omitempty"`
+
+	// Party that delivers securities to the receiving agent at the place of settlement, such as a central securities depository.
+	// Can also be used in the context of treasury operations.
+	DeliveringAgent *BranchAndFinancialInstitutionIdentification6 `xml:"DlvrgAgt,omitempty"`
+
+	// Legal entity that has the right to issue securities.
+	IssuingAgent *BranchAndFinancialInstitutionIdentification6 `xml:"IssgAgt,omitempty"`
+
+	// Place where settlement of the securities takes place.
+	// Usage: This is typed by a financial institution identification as this is the standard way to identify a securities settlement agent/central system.
+	SettlementPlace *BranchAndFinancialInstitutionIdentification6 `xml:"SttlmPlc,omitempty"`
+
+	// Proprietary agent related to the underlying transaction.
+	Proprietary []*ProprietaryAgent4 `xml:"Prtry,omitempty"`
+}
+
+func (t *TransactionAgents5) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TransactionAgents5) AddInstructingAgent() *BranchAndFinancialInstitutionIdentification6 {
+	t.InstructingAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return t.InstructingAgent
+}
+
+func (t *TransactionAgents5) AddInstructedAgent() *BranchAndFinancialInstitutionIdentification6 {
+	t.InstructedAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return t.InstructedAgent
+}
+
+func (t *TransactionAgents5) AddDebtorAgent() *BranchAndFinancialInstitutionIdentification6 {
+	t.DebtorAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return t.DebtorAgent
+}
+
+func (t *TransactionAgents5) AddCreditorAgent() *BranchAndFinancialInstitutionIdentification6 {
+	t.CreditorAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return t.CreditorAgent
+}
+
+func (t *TransactionAgents5) AddIntermediaryAgent1() *BranchAndFinancialInstitutionIdentification6 {
+	t.IntermediaryAgent1 = new(BranchAndFinancialInstitutionIdentification6)
+	return t.IntermediaryAgent1
+}
+
+func (t *TransactionAgents5) AddIntermediaryAgent2() *BranchAndFinancialInstitutionIdentification6 {
+	t.IntermediaryAgent2 = new(BranchAndFinancialInstitutionIdentification6)
+	return t.IntermediaryAgent2
+}
+
+func (t *TransactionAgents5) AddIntermediaryAgent3() *BranchAndFinancialInstitutionIdentification6 {
+	t.IntermediaryAgent3 = new(BranchAndFinancialInstitutionIdentification6)
+	return t.IntermediaryAgent3
+}
+
+func (t *TransactionAgents5) AddReceivingAgent() *BranchAndFinancialInstitutionIdentification6 {
+	t.ReceivingAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return t.ReceivingAgent
+}
+
+func (t *TransactionAgents5) AddDeliveringAgent() *BranchAndFinancialInstitutionIdentification6 {
+	t.DeliveringAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return t.DeliveringAgent
+}
+
+func (t *TransactionAgents5) AddIssuingAgent() *BranchAndFinancialInstitutionIdentification6 {
+	t.IssuingAgent = new(BranchAndFinancialInstitutionIdentification6)
+	return t.IssuingAgent
+}
+
+func (t *TransactionAgents5) AddSettlementPlace() *BranchAndFinancialInstitutionIdentification6 {
+	t.SettlementPlace = new(BranchAndFinancialInstitutionIdentification6)
+	return t.SettlementPlace
+}
+
+func (t *TransactionAgents5) AddProprietary() *ProprietaryAgent4 {
+	newValue := new(ProprietaryAgent4)
+	t.Proprietary = append(t.Proprietary, newValue)
+	return newValue
+}
+
+func (t *TransactionAgents5) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.InstructingAgent != nil {
+		t.InstructingAgent.EncodeXMLFast(enc, "InstgAgt")
+	}
+	if t.InstructedAgent != nil {
+		t.InstructedAgent.EncodeXMLFast(enc, "InstdAgt")
+	}
+	if t.DebtorAgent != nil {
+		t.DebtorAgent.EncodeXMLFast(enc, "DbtrAgt")
+	}
+	if t.CreditorAgent != nil {
+		t.CreditorAgent.EncodeXMLFast(enc, "CdtrAgt")
+	}
+	if t.IntermediaryAgent1 != nil {
+		t.IntermediaryAgent1.EncodeXMLFast(enc, "IntrmyAgt1")
+	}
+	if t.IntermediaryAgent2 != nil {
+		t.IntermediaryAgent2.EncodeXMLFast(enc, "IntrmyAgt2")
+	}
+	if t.IntermediaryAgent3 != nil {
+		t.IntermediaryAgent3.EncodeXMLFast(enc, "IntrmyAgt3")
+	}
+	if t.ReceivingAgent != nil {
+		t.ReceivingAgent.EncodeXMLFast(enc, "RcvgAgt")
+	}
+	if t.DeliveringAgent != nil {
+		t.DeliveringAgent.EncodeXMLFast(enc, "DlvrgAgt")
+	}
+	if t.IssuingAgent != nil {
+		t.IssuingAgent.EncodeXMLFast(enc, "IssgAgt")
+	}
+	if t.SettlementPlace != nil {
+		t.SettlementPlace.EncodeXMLFast(enc, "SttlmPlc")
+	}
+	for _, elem := range t.Proprietary {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Prtry")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TransactionAgents5) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "InstgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.InstructingAgent = elem
+		case "InstdAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.InstructedAgent = elem
+		case "DbtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.DebtorAgent = elem
+		case "CdtrAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.CreditorAgent = elem
+		case "IntrmyAgt1":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.IntermediaryAgent1 = elem
+		case "IntrmyAgt2":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.IntermediaryAgent2 = elem
+		case "IntrmyAgt3":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.IntermediaryAgent3 = elem
+		case "RcvgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.ReceivingAgent = elem
+		case "DlvrgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.DeliveringAgent = elem
+		case "IssgAgt":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.IssuingAgent = elem
+		case "SttlmPlc":
+			elem := new(BranchAndFinancialInstitutionIdentification6)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.SettlementPlace = elem
+		case "Prtry":
+			elem := new(ProprietaryAgent4)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Proprietary = append(t.Proprietary, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -16,6 +16,10 @@ type TransactionAndDocumentIdentification1 struct {
 	CopyDuplicate *CopyDuplicate1Code `xml:"CpyDplct,omitempty"`
 }
 
+func (t *TransactionAndDocumentIdentification1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionAndDocumentIdentification1) SetTransactionIdentification(value string) {
 	t.TransactionIdentification = (*Max35Text)(&value)
 }
@@ -29,6 +33,10 @@ func (t *TransactionAndDocumentIdentification1) AddCreationDateTime() *DateAndDa
 	return t.CreationDateTime
 }
 
-func (t *TransactionAndDocumentIdentification1) SetCopyDuplicate(value string) {
+func (t *TransactionAndDocumentIdentification1) SetCopyDuplicate(value string) error {
+	if err := CopyDuplicate1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.CopyDuplicate = (*CopyDuplicate1Code)(&value)
+	return nil
 }
@@ -25,6 +25,10 @@ type TransactionCertificate1 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *TransactionCertificate1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionCertificate1) SetTransactionIdentification(value string) {
 	t.TransactionIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Certificate in which all currency control transactions are registered.
 type TransactionCertificate2 struct {
 
@@ -21,6 +27,10 @@ type TransactionCertificate2 struct {
 	Amount *ActiveCurrencyAndAmount `xml:"Amt"`
 }
 
+func (t *TransactionCertificate2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionCertificate2) AddReferredDocument() *CertificateReference1 {
 	t.ReferredDocument = new(CertificateReference1)
 	return t.ReferredDocument
@@ -30,6 +40,11 @@ func (t *TransactionCertificate2) SetTransactionDate(value string) {
 	t.TransactionDate = (*ISODate)(&value)
 }
 
+func (t *TransactionCertificate2) SetTransactionDateFromTime(value time.Time) {
+	t.TransactionDate = new(ISODate)
+	t.TransactionDate.FromTime(value)
+}
+
 func (t *TransactionCertificate2) SetTransactionType(value string) {
 	t.TransactionType = (*Exact1NumericText)(&value)
 }
@@ -41,3 +56,7 @@ func (t *TransactionCertificate2) SetLocalInstrument(value string) {
 func (t *TransactionCertificate2) SetAmount(value, currency string) {
 	t.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (t *TransactionCertificate2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
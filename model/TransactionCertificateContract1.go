@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Certificate and contract reference of a transaction.
 type TransactionCertificateContract1 struct {
 
@@ -19,6 +25,10 @@ type TransactionCertificateContract1 struct {
 	AdditionalInformation *Max1025Text `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TransactionCertificateContract1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionCertificateContract1) AddContractReference() *ContractRegistrationReference1Choice {
 	t.ContractReference = new(ContractRegistrationReference1Choice)
 	return t.ContractReference
@@ -28,14 +38,28 @@ func (t *TransactionCertificateContract1) SetTransactionAmountInContractCurrency
 	t.TransactionAmountInContractCurrency = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TransactionCertificateContract1) SetTransactionAmountInContractCurrencyFromDecimal(value decimal.Decimal, currency string) {
+	t.TransactionAmountInContractCurrency = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TransactionCertificateContract1) SetExpectedShipmentDate(value string) {
 	t.ExpectedShipmentDate = (*ISODate)(&value)
 }
 
+func (t *TransactionCertificateContract1) SetExpectedShipmentDateFromTime(value time.Time) {
+	t.ExpectedShipmentDate = new(ISODate)
+	t.ExpectedShipmentDate.FromTime(value)
+}
+
 func (t *TransactionCertificateContract1) SetExpectedAdvancePaymentReturnDate(value string) {
 	t.ExpectedAdvancePaymentReturnDate = (*ISODate)(&value)
 }
 
+func (t *TransactionCertificateContract1) SetExpectedAdvancePaymentReturnDateFromTime(value time.Time) {
+	t.ExpectedAdvancePaymentReturnDate = new(ISODate)
+	t.ExpectedAdvancePaymentReturnDate.FromTime(value)
+}
+
 func (t *TransactionCertificateContract1) SetAdditionalInformation(value string) {
 	t.AdditionalInformation = (*Max1025Text)(&value)
 }
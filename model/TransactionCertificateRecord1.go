@@ -16,6 +16,10 @@ type TransactionCertificateRecord1 struct {
 	Attachment []*DocumentGeneralInformation3 `xml:"Attchmnt,omitempty"`
 }
 
+func (t *TransactionCertificateRecord1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionCertificateRecord1) SetCertificateRecordIdentification(value string) {
 	t.CertificateRecordIdentification = (*Max35Text)(&value)
 }
@@ -1,3 +1,30 @@
 package model
 
 type TransactionChannel1Code string
+
+const (
+	TransactionChannel1CodeMAIL TransactionChannel1Code = "MAIL"
+	TransactionChannel1CodeTLPH TransactionChannel1Code = "TLPH"
+	TransactionChannel1CodeECOM TransactionChannel1Code = "ECOM"
+	TransactionChannel1CodeTVPY TransactionChannel1Code = "TVPY"
+)
+
+var transactionChannel1CodeDescriptions = map[TransactionChannel1Code]string{
+	TransactionChannel1CodeMAIL: "Mail order: payment on which the card has been used through a mail order.",
+	TransactionChannel1CodeTLPH: "Telephone order: payment on which the card has been used through a telephone order.",
+	TransactionChannel1CodeECOM: "Electronic commerce: payment on which the card has been used for electronic commerce.",
+	TransactionChannel1CodeTVPY: "Television payment: payment on which the card has been used through a television.",
+}
+
+func (t TransactionChannel1Code) IsValid() bool {
+	_, ok := transactionChannel1CodeDescriptions[t]
+	return ok
+}
+
+func (t TransactionChannel1Code) Description() string {
+	return transactionChannel1CodeDescriptions[t]
+}
+
+func (t TransactionChannel1Code) Validate() error {
+	return validateEnumeration("TransactionChannel1Code", string(t), t.IsValid(), "MAIL, TLPH, ECOM, TVPY")
+}
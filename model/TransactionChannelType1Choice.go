@@ -10,6 +10,10 @@ type TransactionChannelType1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TransactionChannelType1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionChannelType1Choice) SetCode(value string) {
 	t.Code = (*TransactionChannel2Code)(&value)
 }
@@ -19,6 +19,10 @@ type TransactionData1 struct {
 	PrePaidAccount *CashAccount24 `xml:"PrePdAcct,omitempty"`
 }
 
+func (t *TransactionData1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionData1) SetCardBrand(value string) {
 	t.CardBrand = (*Max35Text)(&value)
 }
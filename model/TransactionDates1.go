@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Set of elements identifying the dates related to the underlying transactions.
 type TransactionDates1 struct {
 
@@ -25,30 +29,64 @@ type TransactionDates1 struct {
 	Proprietary []*ProprietaryDate1 `xml:"Prtry,omitempty"`
 }
 
+func (t *TransactionDates1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDates1) SetAcceptanceDateTime(value string) {
 	t.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDates1) SetAcceptanceDateTimeFromTime(value time.Time) {
+	t.AcceptanceDateTime = new(ISODateTime)
+	t.AcceptanceDateTime.FromTime(value)
+}
+
 func (t *TransactionDates1) SetTradeDate(value string) {
 	t.TradeDate = (*ISODate)(&value)
 }
 
+func (t *TransactionDates1) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODate)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *TransactionDates1) SetInterbankSettlementDate(value string) {
 	t.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (t *TransactionDates1) SetInterbankSettlementDateFromTime(value time.Time) {
+	t.InterbankSettlementDate = new(ISODate)
+	t.InterbankSettlementDate.FromTime(value)
+}
+
 func (t *TransactionDates1) SetStartDate(value string) {
 	t.StartDate = (*ISODate)(&value)
 }
 
+func (t *TransactionDates1) SetStartDateFromTime(value time.Time) {
+	t.StartDate = new(ISODate)
+	t.StartDate.FromTime(value)
+}
+
 func (t *TransactionDates1) SetEndDate(value string) {
 	t.EndDate = (*ISODate)(&value)
 }
 
+func (t *TransactionDates1) SetEndDateFromTime(value time.Time) {
+	t.EndDate = new(ISODate)
+	t.EndDate.FromTime(value)
+}
+
 func (t *TransactionDates1) SetTransactionDateTime(value string) {
 	t.TransactionDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDates1) SetTransactionDateTimeFromTime(value time.Time) {
+	t.TransactionDateTime = new(ISODateTime)
+	t.TransactionDateTime.FromTime(value)
+}
+
 func (t *TransactionDates1) AddProprietary() *ProprietaryDate1 {
 	newValue := new(ProprietaryDate1)
 	t.Proprietary = append(t.Proprietary, newValue)
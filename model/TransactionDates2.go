@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Set of elements used to provide information on the dates related to the underlying individual transaction.
 type TransactionDates2 struct {
 
@@ -28,40 +34,176 @@ type TransactionDates2 struct {
 	Proprietary []*ProprietaryDate2 `xml:"Prtry,omitempty"`
 }
 
+func (t *TransactionDates2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDates2) SetAcceptanceDateTime(value string) {
 	t.AcceptanceDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDates2) SetAcceptanceDateTimeFromTime(value time.Time) {
+	t.AcceptanceDateTime = new(ISODateTime)
+	t.AcceptanceDateTime.FromTime(value)
+}
+
 func (t *TransactionDates2) SetTradeActivityContractualSettlementDate(value string) {
 	t.TradeActivityContractualSettlementDate = (*ISODate)(&value)
 }
 
+func (t *TransactionDates2) SetTradeActivityContractualSettlementDateFromTime(value time.Time) {
+	t.TradeActivityContractualSettlementDate = new(ISODate)
+	t.TradeActivityContractualSettlementDate.FromTime(value)
+}
+
 func (t *TransactionDates2) SetTradeDate(value string) {
 	t.TradeDate = (*ISODate)(&value)
 }
 
+func (t *TransactionDates2) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODate)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *TransactionDates2) SetInterbankSettlementDate(value string) {
 	t.InterbankSettlementDate = (*ISODate)(&value)
 }
 
+func (t *TransactionDates2) SetInterbankSettlementDateFromTime(value time.Time) {
+	t.InterbankSettlementDate = new(ISODate)
+	t.InterbankSettlementDate.FromTime(value)
+}
+
 func (t *TransactionDates2) SetStartDate(value string) {
 	t.StartDate = (*ISODate)(&value)
 }
 
+func (t *TransactionDates2) SetStartDateFromTime(value time.Time) {
+	t.StartDate = new(ISODate)
+	t.StartDate.FromTime(value)
+}
+
 func (t *TransactionDates2) SetEndDate(value string) {
 	t.EndDate = (*ISODate)(&value)
 }
 
+func (t *TransactionDates2) SetEndDateFromTime(value time.Time) {
+	t.EndDate = new(ISODate)
+	t.EndDate.FromTime(value)
+}
+
 func (t *TransactionDates2) SetTransactionDateTime(value string) {
 	t.TransactionDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDates2) SetTransactionDateTimeFromTime(value time.Time) {
+	t.TransactionDateTime = new(ISODateTime)
+	t.TransactionDateTime.FromTime(value)
+}
+
 func (t *TransactionDates2) AddProprietary() *ProprietaryDate2 {
 	newValue := new(ProprietaryDate2)
 	t.Proprietary = append(t.Proprietary, newValue)
 	return newValue
 }
 
+func (t *TransactionDates2) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.AcceptanceDateTime != nil {
+		enc.Element("AccptncDtTm", string(*t.AcceptanceDateTime))
+	}
+	if t.TradeActivityContractualSettlementDate != nil {
+		enc.Element("TradActvtyCtrctlSttlmDt", string(*t.TradeActivityContractualSettlementDate))
+	}
+	if t.TradeDate != nil {
+		enc.Element("TradDt", string(*t.TradeDate))
+	}
+	if t.InterbankSettlementDate != nil {
+		enc.Element("IntrBkSttlmDt", string(*t.InterbankSettlementDate))
+	}
+	if t.StartDate != nil {
+		enc.Element("StartDt", string(*t.StartDate))
+	}
+	if t.EndDate != nil {
+		enc.Element("EndDt", string(*t.EndDate))
+	}
+	if t.TransactionDateTime != nil {
+		enc.Element("TxDtTm", string(*t.TransactionDateTime))
+	}
+	for _, elem := range t.Proprietary {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Prtry")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TransactionDates2) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "AccptncDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.AcceptanceDateTime = (*ISODateTime)(&value)
+		case "TradActvtyCtrctlSttlmDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TradeActivityContractualSettlementDate = (*ISODate)(&value)
+		case "TradDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TradeDate = (*ISODate)(&value)
+		case "IntrBkSttlmDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.InterbankSettlementDate = (*ISODate)(&value)
+		case "StartDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.StartDate = (*ISODate)(&value)
+		case "EndDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.EndDate = (*ISODate)(&value)
+		case "TxDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TransactionDateTime = (*ISODateTime)(&value)
+		case "Prtry":
+			elem := new(ProprietaryDate2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Proprietary = append(t.Proprietary, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -0,0 +1,205 @@
+package model
+
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Set of elements used to provide information on the dates related to the underlying individual transaction.
+type TransactionDates3 struct {
+
+	// Point in time when the payment order from the initiating party meets the processing conditions of the account servicing agent. This means that the account servicing agent has received the payment order and has applied checks such as authorisation, availability of funds.
+	AcceptanceDateTime *ISODateTime `xml:"AccptncDtTm,omitempty"`
+
+	// Identifies when an amount of money should have contractually been credited or debited the account versus when the amount of money was actually settled (debited/credited) on the cash account.
+	TradeActivityContractualSettlementDate *ISODate `xml:"TradActvtyCtrctlSttlmDt,omitempty"`
+
+	// Date on which the trade was executed.
+	TradeDate *ISODate `xml:"TradDt,omitempty"`
+
+	// Date on which the amount of money ceases to be available to the agent that owes it and when the amount of money becomes available to the agent to which it is due.
+	InterbankSettlementDate *ISODate `xml:"IntrBkSttlmDt,omitempty"`
+
+	// Start date of the underlying transaction, such as a treasury transaction, an investment plan.
+	StartDate *ISODate `xml:"StartDt,omitempty"`
+
+	// End date of the underlying transaction, such as a treasury transaction, an investment plan.
+	EndDate *ISODate `xml:"EndDt,omitempty"`
+
+	// Date and time of the underlying transaction.
+	TransactionDateTime *ISODateTime `xml:"TxDtTm,omitempty"`
+
+	// Proprietary date related to the underlying transaction.
+	Proprietary []*ProprietaryDate3 `xml:"Prtry,omitempty"`
+}
+
+func (t *TransactionDates3) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TransactionDates3) SetAcceptanceDateTime(value string) {
+	t.AcceptanceDateTime = (*ISODateTime)(&value)
+}
+
+func (t *TransactionDates3) SetAcceptanceDateTimeFromTime(value time.Time) {
+	t.AcceptanceDateTime = new(ISODateTime)
+	t.AcceptanceDateTime.FromTime(value)
+}
+
+func (t *TransactionDates3) SetTradeActivityContractualSettlementDate(value string) {
+	t.TradeActivityContractualSettlementDate = (*ISODate)(&value)
+}
+
+func (t *TransactionDates3) SetTradeActivityContractualSettlementDateFromTime(value time.Time) {
+	t.TradeActivityContractualSettlementDate = new(ISODate)
+	t.TradeActivityContractualSettlementDate.FromTime(value)
+}
+
+func (t *TransactionDates3) SetTradeDate(value string) {
+	t.TradeDate = (*ISODate)(&value)
+}
+
+func (t *TransactionDates3) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODate)
+	t.TradeDate.FromTime(value)
+}
+
+func (t *TransactionDates3) SetInterbankSettlementDate(value string) {
+	t.InterbankSettlementDate = (*ISODate)(&value)
+}
+
+func (t *TransactionDates3) SetInterbankSettlementDateFromTime(value time.Time) {
+	t.InterbankSettlementDate = new(ISODate)
+	t.InterbankSettlementDate.FromTime(value)
+}
+
+func (t *TransactionDates3) SetStartDate(value string) {
+	t.StartDate = (*ISODate)(&value)
+}
+
+func (t *TransactionDates3) SetStartDateFromTime(value time.Time) {
+	t.StartDate = new(ISODate)
+	t.StartDate.FromTime(value)
+}
+
+func (t *TransactionDates3) SetEndDate(value string) {
+	t.EndDate = (*ISODate)(&value)
+}
+
+func (t *TransactionDates3) SetEndDateFromTime(value time.Time) {
+	t.EndDate = new(ISODate)
+	t.EndDate.FromTime(value)
+}
+
+func (t *TransactionDates3) SetTransactionDateTime(value string) {
+	t.TransactionDateTime = (*ISODateTime)(&value)
+}
+
+func (t *TransactionDates3) SetTransactionDateTimeFromTime(value time.Time) {
+	t.TransactionDateTime = new(ISODateTime)
+	t.TransactionDateTime.FromTime(value)
+}
+
+func (t *TransactionDates3) AddProprietary() *ProprietaryDate3 {
+	newValue := new(ProprietaryDate3)
+	t.Proprietary = append(t.Proprietary, newValue)
+	return newValue
+}
+
+func (t *TransactionDates3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.AcceptanceDateTime != nil {
+		enc.Element("AccptncDtTm", string(*t.AcceptanceDateTime))
+	}
+	if t.TradeActivityContractualSettlementDate != nil {
+		enc.Element("TradActvtyCtrctlSttlmDt", string(*t.TradeActivityContractualSettlementDate))
+	}
+	if t.TradeDate != nil {
+		enc.Element("TradDt", string(*t.TradeDate))
+	}
+	if t.InterbankSettlementDate != nil {
+		enc.Element("IntrBkSttlmDt", string(*t.InterbankSettlementDate))
+	}
+	if t.StartDate != nil {
+		enc.Element("StartDt", string(*t.StartDate))
+	}
+	if t.EndDate != nil {
+		enc.Element("EndDt", string(*t.EndDate))
+	}
+	if t.TransactionDateTime != nil {
+		enc.Element("TxDtTm", string(*t.TransactionDateTime))
+	}
+	for _, elem := range t.Proprietary {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Prtry")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TransactionDates3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "AccptncDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.AcceptanceDateTime = (*ISODateTime)(&value)
+		case "TradActvtyCtrctlSttlmDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TradeActivityContractualSettlementDate = (*ISODate)(&value)
+		case "TradDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TradeDate = (*ISODate)(&value)
+		case "IntrBkSttlmDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.InterbankSettlementDate = (*ISODate)(&value)
+		case "StartDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.StartDate = (*ISODate)(&value)
+		case "EndDt":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.EndDate = (*ISODate)(&value)
+		case "TxDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TransactionDateTime = (*ISODateTime)(&value)
+		case "Prtry":
+			elem := new(ProprietaryDate3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Proprietary = append(t.Proprietary, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
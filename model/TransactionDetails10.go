@@ -28,6 +28,10 @@ type TransactionDetails10 struct {
 	Investor *PartyIdentification14Choice `xml:"Invstr,omitempty"`
 }
 
+func (t *TransactionDetails10) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails10) AddFinancialInstrumentIdentification() *SecurityIdentification11 {
 	t.FinancialInstrumentIdentification = new(SecurityIdentification11)
 	return t.FinancialInstrumentIdentification
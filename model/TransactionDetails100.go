@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the details of the transaction.
 type TransactionDetails100 struct {
 
@@ -94,6 +98,10 @@ type TransactionDetails100 struct {
 	SettlementInstructionProcessingAdditionalDetails *RestrictedFINXMax350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (t *TransactionDetails100) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails100) AddTradeIdentification(value string) {
 	t.TradeIdentification = append(t.TradeIdentification, (*RestrictedFINXMax16Text)(&value))
 }
@@ -191,16 +199,34 @@ func (t *TransactionDetails100) SetAcknowledgedStatusTimeStamp(value string) {
 	t.AcknowledgedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDetails100) SetAcknowledgedStatusTimeStampFromTime(value time.Time) {
+	t.AcknowledgedStatusTimeStamp = new(ISODateTime)
+	t.AcknowledgedStatusTimeStamp.FromTime(value)
+}
+
 func (t *TransactionDetails100) SetMatchedStatusTimeStamp(value string) {
 	t.MatchedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
-func (t *TransactionDetails100) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails100) SetMatchedStatusTimeStampFromTime(value time.Time) {
+	t.MatchedStatusTimeStamp = new(ISODateTime)
+	t.MatchedStatusTimeStamp.FromTime(value)
+}
+
+func (t *TransactionDetails100) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails100) SetPayment(value string) {
+func (t *TransactionDetails100) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails100) AddSettlementParameters() *SettlementDetails133 {
@@ -28,6 +28,10 @@ type TransactionDetails101 struct {
 	TransactionDetails *TransactionDetails83 `xml:"TxDtls,omitempty"`
 }
 
+func (t *TransactionDetails101) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails101) AddAccountOwnerTransactionIdentification() *SettlementTypeAndIdentification22 {
 	t.AccountOwnerTransactionIdentification = new(SettlementTypeAndIdentification22)
 	return t.AccountOwnerTransactionIdentification
@@ -25,6 +25,10 @@ type TransactionDetails11 struct {
 	FXCancellation *FXCancellation1Choice `xml:"FxCxl,omitempty"`
 }
 
+func (t *TransactionDetails11) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails11) AddAccountOwnerTransactionIdentification() *References2Choice {
 	t.AccountOwnerTransactionIdentification = new(References2Choice)
 	return t.AccountOwnerTransactionIdentification
@@ -13,6 +13,10 @@ type TransactionDetails12 struct {
 	SafekeepingAccount *SecuritiesAccount13 `xml:"SfkpgAcct"`
 }
 
+func (t *TransactionDetails12) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails12) AddReference() *References3Choice {
 	t.Reference = new(References3Choice)
 	return t.Reference
@@ -19,6 +19,10 @@ type TransactionDetails13 struct {
 	TransactionDetails *TransactionDetails10 `xml:"TxDtls,omitempty"`
 }
 
+func (t *TransactionDetails13) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails13) AddAccountServicerTransactionIdentification() *SettlementTypeAndIdentification3 {
 	t.AccountServicerTransactionIdentification = new(SettlementTypeAndIdentification3)
 	return t.AccountServicerTransactionIdentification
@@ -7,6 +7,10 @@ type TransactionDetails2 struct {
 	TradeReference *Max70Text `xml:"TradRef"`
 }
 
+func (t *TransactionDetails2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails2) SetTradeReference(value string) {
 	t.TradeReference = (*Max70Text)(&value)
 }
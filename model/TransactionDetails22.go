@@ -82,6 +82,10 @@ type TransactionDetails22 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (t *TransactionDetails22) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails22) AddTradeIdentification(value string) {
 	t.TradeIdentification = append(t.TradeIdentification, (*Max35Text)(&value))
 }
@@ -166,12 +170,20 @@ func (t *TransactionDetails22) AddTradeDate() *TradeDate1Choice {
 	return t.TradeDate
 }
 
-func (t *TransactionDetails22) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails22) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails22) SetPayment(value string) {
+func (t *TransactionDetails22) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails22) AddSettlementParameters() *SettlementDetails23 {
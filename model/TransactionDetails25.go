@@ -61,6 +61,10 @@ type TransactionDetails25 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *TransactionDetails25) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails25) AddTransactionActivity() *TransactionActivity1Choice {
 	t.TransactionActivity = new(TransactionActivity1Choice)
 	return t.TransactionActivity
@@ -71,12 +75,20 @@ func (t *TransactionDetails25) AddSettlementTransactionOrCorporateActionEventTyp
 	return t.SettlementTransactionOrCorporateActionEventType
 }
 
-func (t *TransactionDetails25) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails25) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails25) SetPayment(value string) {
+func (t *TransactionDetails25) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails25) AddSettlementParameters() *SettlementDetails28 {
@@ -64,6 +64,10 @@ type TransactionDetails26 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *TransactionDetails26) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails26) AddTransactionActivity() *TransactionActivity1Choice {
 	t.TransactionActivity = new(TransactionActivity1Choice)
 	return t.TransactionActivity
@@ -74,12 +78,20 @@ func (t *TransactionDetails26) AddSettlementTransactionOrCorporateActionEventTyp
 	return t.SettlementTransactionOrCorporateActionEventType
 }
 
-func (t *TransactionDetails26) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails26) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails26) SetPayment(value string) {
+func (t *TransactionDetails26) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails26) AddSettlementParameters() *SettlementDetails28 {
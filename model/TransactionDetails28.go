@@ -28,6 +28,10 @@ type TransactionDetails28 struct {
 	Investor *PartyIdentification37Choice `xml:"Invstr,omitempty"`
 }
 
+func (t *TransactionDetails28) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails28) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	t.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return t.FinancialInstrumentIdentification
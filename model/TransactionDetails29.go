@@ -28,6 +28,10 @@ type TransactionDetails29 struct {
 	Investor *PartyIdentification37Choice `xml:"Invstr,omitempty"`
 }
 
+func (t *TransactionDetails29) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails29) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	t.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return t.FinancialInstrumentIdentification
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Details of the transaction.
 type TransactionDetails3 struct {
 
@@ -52,6 +58,10 @@ type TransactionDetails3 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TransactionDetails3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails3) SetTradeReference(value string) {
 	t.TradeReference = (*Max70Text)(&value)
 }
@@ -69,6 +79,11 @@ func (t *TransactionDetails3) SetTradeDateTime(value string) {
 	t.TradeDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDetails3) SetTradeDateTimeFromTime(value time.Time) {
+	t.TradeDateTime = new(ISODateTime)
+	t.TradeDateTime.FromTime(value)
+}
+
 func (t *TransactionDetails3) AddFinancialInstrumentDetails() *FinancialInstrument15 {
 	t.FinancialInstrumentDetails = new(FinancialInstrument15)
 	return t.FinancialInstrumentDetails
@@ -252,10 +267,19 @@ func (t *TransactionDetails3) SetSettlementAmount(value, currency string) {
 	t.SettlementAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (t *TransactionDetails3) SetSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.SettlementAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TransactionDetails3) SetSettlementDate(value string) {
 	t.SettlementDate = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDetails3) SetSettlementDateFromTime(value time.Time) {
+	t.SettlementDate = new(ISODateTime)
+	t.SettlementDate.FromTime(value)
+}
+
 func (t *TransactionDetails3) AddProxyHolder() *PartyIdentification2Choice {
 	t.ProxyHolder = new(PartyIdentification2Choice)
 	return t.ProxyHolder
@@ -34,6 +34,10 @@ type TransactionDetails30 struct {
 	Investor *PartyIdentification37Choice `xml:"Invstr,omitempty"`
 }
 
+func (t *TransactionDetails30) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails30) AddAccountOwner() *PartyIdentification36Choice {
 	t.AccountOwner = new(PartyIdentification36Choice)
 	return t.AccountOwner
@@ -64,6 +64,10 @@ type TransactionDetails39 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *TransactionDetails39) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails39) AddTransactionActivity() *TransactionActivity1Choice {
 	t.TransactionActivity = new(TransactionActivity1Choice)
 	return t.TransactionActivity
@@ -74,12 +78,20 @@ func (t *TransactionDetails39) AddSettlementTransactionOrCorporateActionEventTyp
 	return t.SettlementTransactionOrCorporateActionEventType
 }
 
-func (t *TransactionDetails39) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails39) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails39) SetPayment(value string) {
+func (t *TransactionDetails39) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails39) AddSettlementParameters() *SettlementDetails47 {
@@ -34,6 +34,10 @@ type TransactionDetails4 struct {
 	Investor *PartyIdentification14Choice `xml:"Invstr,omitempty"`
 }
 
+func (t *TransactionDetails4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails4) AddAccountOwner() *PartyIdentification13Choice {
 	t.AccountOwner = new(PartyIdentification13Choice)
 	return t.AccountOwner
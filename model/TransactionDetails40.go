@@ -37,17 +37,29 @@ type TransactionDetails40 struct {
 	Investor *PartyIdentification37Choice `xml:"Invstr,omitempty"`
 }
 
+func (t *TransactionDetails40) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails40) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	t.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return t.FinancialInstrumentIdentification
 }
 
-func (t *TransactionDetails40) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails40) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails40) SetPayment(value string) {
+func (t *TransactionDetails40) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails40) AddSettlementQuantity() *Quantity6Choice {
@@ -28,6 +28,10 @@ type TransactionDetails41 struct {
 	TransactionDetails *TransactionDetails29 `xml:"TxDtls,omitempty"`
 }
 
+func (t *TransactionDetails41) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails41) AddAccountOwnerTransactionIdentification() *SettlementTypeAndIdentification3 {
 	t.AccountOwnerTransactionIdentification = new(SettlementTypeAndIdentification3)
 	return t.AccountOwnerTransactionIdentification
@@ -34,17 +34,29 @@ type TransactionDetails45 struct {
 	Investor *PartyIdentification37Choice `xml:"Invstr,omitempty"`
 }
 
+func (t *TransactionDetails45) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails45) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	t.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return t.FinancialInstrumentIdentification
 }
 
-func (t *TransactionDetails45) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails45) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails45) SetPayment(value string) {
+func (t *TransactionDetails45) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails45) AddSettlementQuantity() *Quantity6Choice {
@@ -88,6 +88,10 @@ type TransactionDetails53 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (t *TransactionDetails53) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails53) AddTradeIdentification(value string) {
 	t.TradeIdentification = append(t.TradeIdentification, (*Max35Text)(&value))
 }
@@ -180,12 +184,20 @@ func (t *TransactionDetails53) AddTradeDate() *TradeDate1Choice {
 	return t.TradeDate
 }
 
-func (t *TransactionDetails53) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails53) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails53) SetPayment(value string) {
+func (t *TransactionDetails53) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails53) AddSettlementParameters() *SettlementDetails44 {
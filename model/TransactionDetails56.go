@@ -64,6 +64,10 @@ type TransactionDetails56 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *TransactionDetails56) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails56) AddTransactionActivity() *TransactionActivity1Choice {
 	t.TransactionActivity = new(TransactionActivity1Choice)
 	return t.TransactionActivity
@@ -74,12 +78,20 @@ func (t *TransactionDetails56) AddSettlementTransactionOrCorporateActionEventTyp
 	return t.SettlementTransactionOrCorporateActionEventType
 }
 
-func (t *TransactionDetails56) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails56) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails56) SetPayment(value string) {
+func (t *TransactionDetails56) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails56) AddSettlementParameters() *SettlementDetails47 {
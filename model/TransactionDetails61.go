@@ -88,6 +88,10 @@ type TransactionDetails61 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (t *TransactionDetails61) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails61) AddTradeIdentification(value string) {
 	t.TradeIdentification = append(t.TradeIdentification, (*Max35Text)(&value))
 }
@@ -180,12 +184,20 @@ func (t *TransactionDetails61) AddTradeDate() *TradeDate1Choice {
 	return t.TradeDate
 }
 
-func (t *TransactionDetails61) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails61) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails61) SetPayment(value string) {
+func (t *TransactionDetails61) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails61) AddSettlementParameters() *SettlementDetails69 {
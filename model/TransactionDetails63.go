@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identifies the details of the transaction.
 type TransactionDetails63 struct {
 
@@ -64,6 +68,10 @@ type TransactionDetails63 struct {
 	TransactionAdditionalDetails *Max350Text `xml:"TxAddtlDtls,omitempty"`
 }
 
+func (t *TransactionDetails63) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails63) AddTransactionActivity() *TransactionActivity1Choice {
 	t.TransactionActivity = new(TransactionActivity1Choice)
 	return t.TransactionActivity
@@ -74,12 +82,20 @@ func (t *TransactionDetails63) AddSettlementTransactionOrCorporateActionEventTyp
 	return t.SettlementTransactionOrCorporateActionEventType
 }
 
-func (t *TransactionDetails63) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails63) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails63) SetPayment(value string) {
+func (t *TransactionDetails63) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails63) AddSettlementParameters() *SettlementDetails75 {
@@ -110,6 +126,11 @@ func (t *TransactionDetails63) SetNumberOfDaysAccrued(value string) {
 	t.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (t *TransactionDetails63) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	t.NumberOfDaysAccrued = new(Max3Number)
+	t.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (t *TransactionDetails63) AddPostingAmount() *AmountAndDirection3 {
 	t.PostingAmount = new(AmountAndDirection3)
 	return t.PostingAmount
@@ -154,6 +175,11 @@ func (t *TransactionDetails63) SetReversalIndicator(value string) {
 	t.ReversalIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *TransactionDetails63) SetReversalIndicatorFromBool(value bool) {
+	t.ReversalIndicator = new(YesNoIndicator)
+	t.ReversalIndicator.FromBool(value)
+}
+
 func (t *TransactionDetails63) SetTransactionAdditionalDetails(value string) {
 	t.TransactionAdditionalDetails = (*Max350Text)(&value)
 }
@@ -28,6 +28,10 @@ type TransactionDetails64 struct {
 	TransactionDetails *TransactionDetails28 `xml:"TxDtls,omitempty"`
 }
 
+func (t *TransactionDetails64) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails64) AddAccountOwnerTransactionIdentification() *SettlementTypeAndIdentification3 {
 	t.AccountOwnerTransactionIdentification = new(SettlementTypeAndIdentification3)
 	return t.AccountOwnerTransactionIdentification
@@ -64,6 +64,10 @@ type TransactionDetails7 struct {
 	Extension []*Extension2 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransactionDetails7) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails7) AddTransactionActivity() *TransactionActivity1Choice {
 	t.TransactionActivity = new(TransactionActivity1Choice)
 	return t.TransactionActivity
@@ -74,12 +78,20 @@ func (t *TransactionDetails7) AddSettlementTransactionOrCorporateActionEventType
 	return t.SettlementTransactionOrCorporateActionEventType
 }
 
-func (t *TransactionDetails7) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails7) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails7) SetPayment(value string) {
+func (t *TransactionDetails7) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails7) AddSettlementParameters() *SettlementDetails7 {
@@ -28,6 +28,10 @@ type TransactionDetails74 struct {
 	Investor *PartyIdentification99 `xml:"Invstr,omitempty"`
 }
 
+func (t *TransactionDetails74) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails74) AddFinancialInstrumentIdentification() *SecurityIdentification19 {
 	t.FinancialInstrumentIdentification = new(SecurityIdentification19)
 	return t.FinancialInstrumentIdentification
@@ -64,6 +64,10 @@ type TransactionDetails75 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *TransactionDetails75) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails75) AddTransactionActivity() *TransactionActivity3Choice {
 	t.TransactionActivity = new(TransactionActivity3Choice)
 	return t.TransactionActivity
@@ -74,12 +78,20 @@ func (t *TransactionDetails75) AddSettlementTransactionOrCorporateActionEventTyp
 	return t.SettlementTransactionOrCorporateActionEventType
 }
 
-func (t *TransactionDetails75) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails75) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails75) SetPayment(value string) {
+func (t *TransactionDetails75) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails75) AddSettlementParameters() *SettlementDetails92 {
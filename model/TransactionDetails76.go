@@ -28,6 +28,10 @@ type TransactionDetails76 struct {
 	TransactionDetails *TransactionDetails74 `xml:"TxDtls,omitempty"`
 }
 
+func (t *TransactionDetails76) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails76) AddAccountOwnerTransactionIdentification() *SettlementTypeAndIdentification18 {
 	t.AccountOwnerTransactionIdentification = new(SettlementTypeAndIdentification18)
 	return t.AccountOwnerTransactionIdentification
@@ -88,6 +88,10 @@ type TransactionDetails79 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (t *TransactionDetails79) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails79) AddTradeIdentification(value string) {
 	t.TradeIdentification = append(t.TradeIdentification, (*Max35Text)(&value))
 }
@@ -181,12 +185,20 @@ func (t *TransactionDetails79) AddTradeDate() *TradeDate5Choice {
 	return t.TradeDate
 }
 
-func (t *TransactionDetails79) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails79) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails79) SetPayment(value string) {
+func (t *TransactionDetails79) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails79) AddSettlementParameters() *SettlementDetails95 {
@@ -61,6 +61,10 @@ type TransactionDetails8 struct {
 	Extension []*Extension2 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransactionDetails8) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails8) AddTransactionActivity() *TransactionActivity1Choice {
 	t.TransactionActivity = new(TransactionActivity1Choice)
 	return t.TransactionActivity
@@ -71,12 +75,20 @@ func (t *TransactionDetails8) AddSettlementTransactionOrCorporateActionEventType
 	return t.SettlementTransactionOrCorporateActionEventType
 }
 
-func (t *TransactionDetails8) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails8) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails8) SetPayment(value string) {
+func (t *TransactionDetails8) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails8) AddSettlementParameters() *SettlementDetails7 {
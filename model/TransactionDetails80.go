@@ -34,6 +34,10 @@ type TransactionDetails80 struct {
 	Investor *PartyIdentification99 `xml:"Invstr,omitempty"`
 }
 
+func (t *TransactionDetails80) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails80) AddAccountOwner() *PartyIdentification98 {
 	t.AccountOwner = new(PartyIdentification98)
 	return t.AccountOwner
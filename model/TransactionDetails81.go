@@ -34,17 +34,29 @@ type TransactionDetails81 struct {
 	Investor *PartyIdentification99 `xml:"Invstr,omitempty"`
 }
 
+func (t *TransactionDetails81) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails81) AddFinancialInstrumentIdentification() *SecurityIdentification19 {
 	t.FinancialInstrumentIdentification = new(SecurityIdentification19)
 	return t.FinancialInstrumentIdentification
 }
 
-func (t *TransactionDetails81) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails81) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails81) SetPayment(value string) {
+func (t *TransactionDetails81) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails81) AddSettlementQuantity() *Quantity6Choice {
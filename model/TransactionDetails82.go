@@ -37,17 +37,29 @@ type TransactionDetails82 struct {
 	Investor *PartyIdentification99 `xml:"Invstr,omitempty"`
 }
 
+func (t *TransactionDetails82) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails82) AddFinancialInstrumentIdentification() *SecurityIdentification19 {
 	t.FinancialInstrumentIdentification = new(SecurityIdentification19)
 	return t.FinancialInstrumentIdentification
 }
 
-func (t *TransactionDetails82) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails82) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails82) SetPayment(value string) {
+func (t *TransactionDetails82) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails82) AddSettlementQuantity() *Quantity6Choice {
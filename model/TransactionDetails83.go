@@ -28,6 +28,10 @@ type TransactionDetails83 struct {
 	Investor *PartyIdentification110 `xml:"Invstr,omitempty"`
 }
 
+func (t *TransactionDetails83) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails83) AddFinancialInstrumentIdentification() *SecurityIdentification20 {
 	t.FinancialInstrumentIdentification = new(SecurityIdentification20)
 	return t.FinancialInstrumentIdentification
@@ -28,6 +28,10 @@ type TransactionDetails85 struct {
 	TransactionDetails *TransactionDetails83 `xml:"TxDtls,omitempty"`
 }
 
+func (t *TransactionDetails85) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails85) AddAccountOwnerTransactionIdentification() *SettlementTypeAndIdentification22 {
 	t.AccountOwnerTransactionIdentification = new(SettlementTypeAndIdentification22)
 	return t.AccountOwnerTransactionIdentification
@@ -88,6 +88,10 @@ type TransactionDetails87 struct {
 	SettlementInstructionProcessingAdditionalDetails *RestrictedFINXMax350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (t *TransactionDetails87) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails87) AddTradeIdentification(value string) {
 	t.TradeIdentification = append(t.TradeIdentification, (*RestrictedFINXMax16Text)(&value))
 }
@@ -181,12 +185,20 @@ func (t *TransactionDetails87) AddTradeDate() *TradeDate6Choice {
 	return t.TradeDate
 }
 
-func (t *TransactionDetails87) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails87) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails87) SetPayment(value string) {
+func (t *TransactionDetails87) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails87) AddSettlementParameters() *SettlementDetails115 {
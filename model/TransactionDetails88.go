@@ -34,6 +34,10 @@ type TransactionDetails88 struct {
 	Investor *PartyIdentification110 `xml:"Invstr,omitempty"`
 }
 
+func (t *TransactionDetails88) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails88) AddAccountOwner() *PartyIdentification109 {
 	t.AccountOwner = new(PartyIdentification109)
 	return t.AccountOwner
@@ -64,6 +64,10 @@ type TransactionDetails90 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *TransactionDetails90) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails90) AddTransactionActivity() *TransactionActivity4Choice {
 	t.TransactionActivity = new(TransactionActivity4Choice)
 	return t.TransactionActivity
@@ -74,12 +78,20 @@ func (t *TransactionDetails90) AddSettlementTransactionOrCorporateActionEventTyp
 	return t.SettlementTransactionOrCorporateActionEventType
 }
 
-func (t *TransactionDetails90) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails90) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails90) SetPayment(value string) {
+func (t *TransactionDetails90) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails90) AddSettlementParameters() *SettlementDetails116 {
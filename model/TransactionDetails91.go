@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Identifies the details of the transaction.
 type TransactionDetails91 struct {
 
@@ -64,6 +68,10 @@ type TransactionDetails91 struct {
 	TransactionAdditionalDetails *RestrictedFINXMax350Text `xml:"TxAddtlDtls,omitempty"`
 }
 
+func (t *TransactionDetails91) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails91) AddTransactionActivity() *TransactionActivity4Choice {
 	t.TransactionActivity = new(TransactionActivity4Choice)
 	return t.TransactionActivity
@@ -74,12 +82,20 @@ func (t *TransactionDetails91) AddSettlementTransactionOrCorporateActionEventTyp
 	return t.SettlementTransactionOrCorporateActionEventType
 }
 
-func (t *TransactionDetails91) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails91) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails91) SetPayment(value string) {
+func (t *TransactionDetails91) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails91) AddSettlementParameters() *SettlementDetails117 {
@@ -111,6 +127,11 @@ func (t *TransactionDetails91) SetNumberOfDaysAccrued(value string) {
 	t.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (t *TransactionDetails91) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	t.NumberOfDaysAccrued = new(Max3Number)
+	t.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (t *TransactionDetails91) AddPostingAmount() *AmountAndDirection18 {
 	t.PostingAmount = new(AmountAndDirection18)
 	return t.PostingAmount
@@ -155,6 +176,11 @@ func (t *TransactionDetails91) SetReversalIndicator(value string) {
 	t.ReversalIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *TransactionDetails91) SetReversalIndicatorFromBool(value bool) {
+	t.ReversalIndicator = new(YesNoIndicator)
+	t.ReversalIndicator.FromBool(value)
+}
+
 func (t *TransactionDetails91) SetTransactionAdditionalDetails(value string) {
 	t.TransactionAdditionalDetails = (*RestrictedFINXMax350Text)(&value)
 }
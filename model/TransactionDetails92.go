@@ -37,17 +37,29 @@ type TransactionDetails92 struct {
 	Investor *PartyIdentification110 `xml:"Invstr,omitempty"`
 }
 
+func (t *TransactionDetails92) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails92) AddFinancialInstrumentIdentification() *SecurityIdentification20 {
 	t.FinancialInstrumentIdentification = new(SecurityIdentification20)
 	return t.FinancialInstrumentIdentification
 }
 
-func (t *TransactionDetails92) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails92) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails92) SetPayment(value string) {
+func (t *TransactionDetails92) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails92) AddSettlementQuantity() *Quantity10Choice {
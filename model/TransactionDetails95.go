@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the details of the transaction.
 type TransactionDetails95 struct {
 
@@ -70,6 +76,10 @@ type TransactionDetails95 struct {
 	TransactionAdditionalDetails *Max350Text `xml:"TxAddtlDtls,omitempty"`
 }
 
+func (t *TransactionDetails95) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails95) AddTransactionActivity() *TransactionActivity3Choice {
 	t.TransactionActivity = new(TransactionActivity3Choice)
 	return t.TransactionActivity
@@ -80,12 +90,20 @@ func (t *TransactionDetails95) AddSettlementTransactionOrCorporateActionEventTyp
 	return t.SettlementTransactionOrCorporateActionEventType
 }
 
-func (t *TransactionDetails95) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails95) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails95) SetPayment(value string) {
+func (t *TransactionDetails95) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails95) AddSettlementParameters() *SettlementDetails126 {
@@ -117,6 +135,11 @@ func (t *TransactionDetails95) SetNumberOfDaysAccrued(value string) {
 	t.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (t *TransactionDetails95) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	t.NumberOfDaysAccrued = new(Max3Number)
+	t.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (t *TransactionDetails95) AddPostingAmount() *AmountAndDirection3 {
 	t.PostingAmount = new(AmountAndDirection3)
 	return t.PostingAmount
@@ -151,10 +174,20 @@ func (t *TransactionDetails95) SetAcknowledgedStatusTimeStamp(value string) {
 	t.AcknowledgedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDetails95) SetAcknowledgedStatusTimeStampFromTime(value time.Time) {
+	t.AcknowledgedStatusTimeStamp = new(ISODateTime)
+	t.AcknowledgedStatusTimeStamp.FromTime(value)
+}
+
 func (t *TransactionDetails95) SetMatchedStatusTimeStamp(value string) {
 	t.MatchedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDetails95) SetMatchedStatusTimeStampFromTime(value time.Time) {
+	t.MatchedStatusTimeStamp = new(ISODateTime)
+	t.MatchedStatusTimeStamp.FromTime(value)
+}
+
 func (t *TransactionDetails95) AddDeliveringSettlementParties() *SettlementParties40 {
 	t.DeliveringSettlementParties = new(SettlementParties40)
 	return t.DeliveringSettlementParties
@@ -169,6 +202,11 @@ func (t *TransactionDetails95) SetReversalIndicator(value string) {
 	t.ReversalIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *TransactionDetails95) SetReversalIndicatorFromBool(value bool) {
+	t.ReversalIndicator = new(YesNoIndicator)
+	t.ReversalIndicator.FromBool(value)
+}
+
 func (t *TransactionDetails95) SetTransactionAdditionalDetails(value string) {
 	t.TransactionAdditionalDetails = (*Max350Text)(&value)
 }
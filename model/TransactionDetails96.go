@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the details of the transaction.
 type TransactionDetails96 struct {
 
@@ -70,6 +74,10 @@ type TransactionDetails96 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *TransactionDetails96) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails96) AddTransactionActivity() *TransactionActivity3Choice {
 	t.TransactionActivity = new(TransactionActivity3Choice)
 	return t.TransactionActivity
@@ -80,12 +88,20 @@ func (t *TransactionDetails96) AddSettlementTransactionOrCorporateActionEventTyp
 	return t.SettlementTransactionOrCorporateActionEventType
 }
 
-func (t *TransactionDetails96) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails96) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails96) SetPayment(value string) {
+func (t *TransactionDetails96) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails96) AddSettlementParameters() *SettlementDetails127 {
@@ -152,10 +168,20 @@ func (t *TransactionDetails96) SetAcknowledgedStatusTimeStamp(value string) {
 	t.AcknowledgedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDetails96) SetAcknowledgedStatusTimeStampFromTime(value time.Time) {
+	t.AcknowledgedStatusTimeStamp = new(ISODateTime)
+	t.AcknowledgedStatusTimeStamp.FromTime(value)
+}
+
 func (t *TransactionDetails96) SetMatchedStatusTimeStamp(value string) {
 	t.MatchedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDetails96) SetMatchedStatusTimeStampFromTime(value time.Time) {
+	t.MatchedStatusTimeStamp = new(ISODateTime)
+	t.MatchedStatusTimeStamp.FromTime(value)
+}
+
 func (t *TransactionDetails96) AddDeliveringSettlementParties() *SettlementParties40 {
 	t.DeliveringSettlementParties = new(SettlementParties40)
 	return t.DeliveringSettlementParties
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the details of the transaction.
 type TransactionDetails97 struct {
 
@@ -94,6 +98,10 @@ type TransactionDetails97 struct {
 	SettlementInstructionProcessingAdditionalDetails *Max350Text `xml:"SttlmInstrPrcgAddtlDtls,omitempty"`
 }
 
+func (t *TransactionDetails97) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails97) AddTradeIdentification(value string) {
 	t.TradeIdentification = append(t.TradeIdentification, (*Max35Text)(&value))
 }
@@ -191,16 +199,34 @@ func (t *TransactionDetails97) SetAcknowledgedStatusTimeStamp(value string) {
 	t.AcknowledgedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDetails97) SetAcknowledgedStatusTimeStampFromTime(value time.Time) {
+	t.AcknowledgedStatusTimeStamp = new(ISODateTime)
+	t.AcknowledgedStatusTimeStamp.FromTime(value)
+}
+
 func (t *TransactionDetails97) SetMatchedStatusTimeStamp(value string) {
 	t.MatchedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
-func (t *TransactionDetails97) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails97) SetMatchedStatusTimeStampFromTime(value time.Time) {
+	t.MatchedStatusTimeStamp = new(ISODateTime)
+	t.MatchedStatusTimeStamp.FromTime(value)
+}
+
+func (t *TransactionDetails97) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails97) SetPayment(value string) {
+func (t *TransactionDetails97) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails97) AddSettlementParameters() *SettlementDetails121 {
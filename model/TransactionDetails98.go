@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the details of the transaction.
 type TransactionDetails98 struct {
 
@@ -70,6 +76,10 @@ type TransactionDetails98 struct {
 	TransactionAdditionalDetails *RestrictedFINXMax350Text `xml:"TxAddtlDtls,omitempty"`
 }
 
+func (t *TransactionDetails98) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails98) AddTransactionActivity() *TransactionActivity4Choice {
 	t.TransactionActivity = new(TransactionActivity4Choice)
 	return t.TransactionActivity
@@ -80,12 +90,20 @@ func (t *TransactionDetails98) AddSettlementTransactionOrCorporateActionEventTyp
 	return t.SettlementTransactionOrCorporateActionEventType
 }
 
-func (t *TransactionDetails98) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails98) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails98) SetPayment(value string) {
+func (t *TransactionDetails98) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails98) AddSettlementParameters() *SettlementDetails139 {
@@ -117,6 +135,11 @@ func (t *TransactionDetails98) SetNumberOfDaysAccrued(value string) {
 	t.NumberOfDaysAccrued = (*Max3Number)(&value)
 }
 
+func (t *TransactionDetails98) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	t.NumberOfDaysAccrued = new(Max3Number)
+	t.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (t *TransactionDetails98) AddPostingAmount() *AmountAndDirection18 {
 	t.PostingAmount = new(AmountAndDirection18)
 	return t.PostingAmount
@@ -151,10 +174,20 @@ func (t *TransactionDetails98) SetAcknowledgedStatusTimeStamp(value string) {
 	t.AcknowledgedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDetails98) SetAcknowledgedStatusTimeStampFromTime(value time.Time) {
+	t.AcknowledgedStatusTimeStamp = new(ISODateTime)
+	t.AcknowledgedStatusTimeStamp.FromTime(value)
+}
+
 func (t *TransactionDetails98) SetMatchedStatusTimeStamp(value string) {
 	t.MatchedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDetails98) SetMatchedStatusTimeStampFromTime(value time.Time) {
+	t.MatchedStatusTimeStamp = new(ISODateTime)
+	t.MatchedStatusTimeStamp.FromTime(value)
+}
+
 func (t *TransactionDetails98) AddDeliveringSettlementParties() *SettlementParties49 {
 	t.DeliveringSettlementParties = new(SettlementParties49)
 	return t.DeliveringSettlementParties
@@ -169,6 +202,11 @@ func (t *TransactionDetails98) SetReversalIndicator(value string) {
 	t.ReversalIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *TransactionDetails98) SetReversalIndicatorFromBool(value bool) {
+	t.ReversalIndicator = new(YesNoIndicator)
+	t.ReversalIndicator.FromBool(value)
+}
+
 func (t *TransactionDetails98) SetTransactionAdditionalDetails(value string) {
 	t.TransactionAdditionalDetails = (*RestrictedFINXMax350Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the details of the transaction.
 type TransactionDetails99 struct {
 
@@ -70,6 +74,10 @@ type TransactionDetails99 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (t *TransactionDetails99) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionDetails99) AddTransactionActivity() *TransactionActivity4Choice {
 	t.TransactionActivity = new(TransactionActivity4Choice)
 	return t.TransactionActivity
@@ -80,12 +88,20 @@ func (t *TransactionDetails99) AddSettlementTransactionOrCorporateActionEventTyp
 	return t.SettlementTransactionOrCorporateActionEventType
 }
 
-func (t *TransactionDetails99) SetSecuritiesMovementType(value string) {
+func (t *TransactionDetails99) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionDetails99) SetPayment(value string) {
+func (t *TransactionDetails99) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionDetails99) AddSettlementParameters() *SettlementDetails130 {
@@ -152,10 +168,20 @@ func (t *TransactionDetails99) SetAcknowledgedStatusTimeStamp(value string) {
 	t.AcknowledgedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDetails99) SetAcknowledgedStatusTimeStampFromTime(value time.Time) {
+	t.AcknowledgedStatusTimeStamp = new(ISODateTime)
+	t.AcknowledgedStatusTimeStamp.FromTime(value)
+}
+
 func (t *TransactionDetails99) SetMatchedStatusTimeStamp(value string) {
 	t.MatchedStatusTimeStamp = (*ISODateTime)(&value)
 }
 
+func (t *TransactionDetails99) SetMatchedStatusTimeStampFromTime(value time.Time) {
+	t.MatchedStatusTimeStamp = new(ISODateTime)
+	t.MatchedStatusTimeStamp.FromTime(value)
+}
+
 func (t *TransactionDetails99) AddDeliveringSettlementParties() *SettlementParties49 {
 	t.DeliveringSettlementParties = new(SettlementParties49)
 	return t.DeliveringSettlementParties
@@ -1,3 +1,40 @@
 package model
 
 type TransactionGroupStatus3Code string
+
+const (
+	TransactionGroupStatus3CodeACTC TransactionGroupStatus3Code = "ACTC"
+	TransactionGroupStatus3CodeRCVD TransactionGroupStatus3Code = "RCVD"
+	TransactionGroupStatus3CodePART TransactionGroupStatus3Code = "PART"
+	TransactionGroupStatus3CodeRJCT TransactionGroupStatus3Code = "RJCT"
+	TransactionGroupStatus3CodePDNG TransactionGroupStatus3Code = "PDNG"
+	TransactionGroupStatus3CodeACCP TransactionGroupStatus3Code = "ACCP"
+	TransactionGroupStatus3CodeACSP TransactionGroupStatus3Code = "ACSP"
+	TransactionGroupStatus3CodeACSC TransactionGroupStatus3Code = "ACSC"
+	TransactionGroupStatus3CodeACWC TransactionGroupStatus3Code = "ACWC"
+)
+
+var transactionGroupStatus3CodeDescriptions = map[TransactionGroupStatus3Code]string{
+	TransactionGroupStatus3CodeACTC: "Accepted technical validation: authentication and syntactical and semantical validation are successful.",
+	TransactionGroupStatus3CodeRCVD: "Received: payment initiation has been received by the receiving agent.",
+	TransactionGroupStatus3CodePART: "Partially accepted: a number of transactions have been accepted, whereas another number of transactions have not yet achieved accepted status.",
+	TransactionGroupStatus3CodeRJCT: "Rejected: payment initiation or individual transaction included in the payment initiation has been rejected.",
+	TransactionGroupStatus3CodePDNG: "Pending: payment initiation or individual transaction included in the payment initiation is pending.",
+	TransactionGroupStatus3CodeACCP: "Accepted customer profile: preceding check of technical validation was successful. Customer profile check was also successful.",
+	TransactionGroupStatus3CodeACSP: "Accepted settlement in process: all preceding checks such as technical validation and customer profile were successful and therefore the payment initiation has been accepted for execution.",
+	TransactionGroupStatus3CodeACSC: "Accepted settlement completed: settlement on the debtor's account has been completed.",
+	TransactionGroupStatus3CodeACWC: "Accepted with change: instruction is accepted but a change will be made, such as date or remittance not sent.",
+}
+
+func (t TransactionGroupStatus3Code) IsValid() bool {
+	_, ok := transactionGroupStatus3CodeDescriptions[t]
+	return ok
+}
+
+func (t TransactionGroupStatus3Code) Description() string {
+	return transactionGroupStatus3CodeDescriptions[t]
+}
+
+func (t TransactionGroupStatus3Code) Validate() error {
+	return validateEnumeration("TransactionGroupStatus3Code", string(t), t.IsValid(), "ACTC, RCVD, PART, RJCT, PDNG, ACCP, ACSP, ACSC, ACWC")
+}
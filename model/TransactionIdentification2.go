@@ -16,6 +16,10 @@ type TransactionIdentification2 struct {
 	ProcessorTransactionIdentification *Max35Text `xml:"PrcrTxId,omitempty"`
 }
 
+func (t *TransactionIdentification2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentification2) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type TransactionIdentification6 struct {
 	ProcessorTransactionIdentification *Max35Text `xml:"PrcrTxId,omitempty"`
 }
 
+func (t *TransactionIdentification6) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentification6) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
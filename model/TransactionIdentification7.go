@@ -16,6 +16,10 @@ type TransactionIdentification7 struct {
 	ProcessorTransactionIdentification *RestrictedFINXMax16Text `xml:"PrcrTxId,omitempty"`
 }
 
+func (t *TransactionIdentification7) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentification7) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -13,6 +13,10 @@ type TransactionIdentifications1 struct {
 	CommonIdentification *Max35Text `xml:"CmonId,omitempty"`
 }
 
+func (t *TransactionIdentifications1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications1) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type TransactionIdentifications10 struct {
 	ProcessorTransactionIdentification *Max35Text `xml:"PrcrTxId,omitempty"`
 }
 
+func (t *TransactionIdentifications10) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications10) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type TransactionIdentifications11 struct {
 	AccountOwnerTransactionIdentification *References22Choice `xml:"AcctOwnrTxId"`
 }
 
+func (t *TransactionIdentifications11) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications11) SetAccountServicerTransactionIdentification(value string) {
 	t.AccountServicerTransactionIdentification = (*Max35Text)(&value)
 }
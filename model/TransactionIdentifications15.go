@@ -16,6 +16,10 @@ type TransactionIdentifications15 struct {
 	ProcessorTransactionIdentification *Max35Text `xml:"PrcrTxId,omitempty"`
 }
 
+func (t *TransactionIdentifications15) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications15) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
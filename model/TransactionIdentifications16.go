@@ -22,6 +22,10 @@ type TransactionIdentifications16 struct {
 	NettingServiceProviderIdentification *Max35Text `xml:"NetgSvcPrvdrId,omitempty"`
 }
 
+func (t *TransactionIdentifications16) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications16) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type TransactionIdentifications17 struct {
 	AccountOwnerTransactionIdentification *References22Choice `xml:"AcctOwnrTxId"`
 }
 
+func (t *TransactionIdentifications17) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications17) SetAccountServicerTransactionIdentification(value string) {
 	t.AccountServicerTransactionIdentification = (*Max35Text)(&value)
 }
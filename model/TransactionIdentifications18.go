@@ -19,6 +19,10 @@ type TransactionIdentifications18 struct {
 	OtherIdentification *Max35Text `xml:"OthrId,omitempty"`
 }
 
+func (t *TransactionIdentifications18) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications18) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type TransactionIdentifications2 struct {
 	CommonIdentification *Max35Text `xml:"CmonId,omitempty"`
 }
 
+func (t *TransactionIdentifications2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications2) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
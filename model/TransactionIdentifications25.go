@@ -19,6 +19,10 @@ type TransactionIdentifications25 struct {
 	OtherIdentification *Max35Text `xml:"OthrId,omitempty"`
 }
 
+func (t *TransactionIdentifications25) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications25) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type TransactionIdentifications29 struct {
 	ProcessorTransactionIdentification *Max35Text `xml:"PrcrTxId,omitempty"`
 }
 
+func (t *TransactionIdentifications29) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications29) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
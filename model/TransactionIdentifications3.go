@@ -13,6 +13,10 @@ type TransactionIdentifications3 struct {
 	MarketInfrastructureTransactionIdentification *Max35Text `xml:"MktInfrstrctrTxId,omitempty"`
 }
 
+func (t *TransactionIdentifications3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications3) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type TransactionIdentifications30 struct {
 	AccountOwnerTransactionIdentification *References44Choice `xml:"AcctOwnrTxId"`
 }
 
+func (t *TransactionIdentifications30) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications30) SetAccountServicerTransactionIdentification(value string) {
 	t.AccountServicerTransactionIdentification = (*Max35Text)(&value)
 }
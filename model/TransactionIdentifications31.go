@@ -22,6 +22,10 @@ type TransactionIdentifications31 struct {
 	NettingServiceProviderIdentification *Max35Text `xml:"NetgSvcPrvdrId,omitempty"`
 }
 
+func (t *TransactionIdentifications31) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications31) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type TransactionIdentifications32 struct {
 	CommonIdentification *Max35Text `xml:"CmonId,omitempty"`
 }
 
+func (t *TransactionIdentifications32) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications32) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type TransactionIdentifications33 struct {
 	OtherIdentification *Max35Text `xml:"OthrId,omitempty"`
 }
 
+func (t *TransactionIdentifications33) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications33) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type TransactionIdentifications34 struct {
 	ProcessorTransactionIdentification *RestrictedFINXMax16Text `xml:"PrcrTxId,omitempty"`
 }
 
+func (t *TransactionIdentifications34) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications34) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
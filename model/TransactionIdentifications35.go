@@ -13,6 +13,10 @@ type TransactionIdentifications35 struct {
 	CommonIdentification *RestrictedFINXMax16Text `xml:"CmonId,omitempty"`
 }
 
+func (t *TransactionIdentifications35) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications35) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
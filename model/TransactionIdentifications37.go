@@ -19,6 +19,10 @@ type TransactionIdentifications37 struct {
 	OtherIdentification *RestrictedFINXMax16Text `xml:"OthrId,omitempty"`
 }
 
+func (t *TransactionIdentifications37) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications37) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
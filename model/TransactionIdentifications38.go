@@ -22,6 +22,10 @@ type TransactionIdentifications38 struct {
 	NettingServiceProviderIdentification *RestrictedFINXMax16Text `xml:"NetgSvcPrvdrId,omitempty"`
 }
 
+func (t *TransactionIdentifications38) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications38) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -16,6 +16,10 @@ type TransactionIdentifications39 struct {
 	AccountOwnerTransactionIdentification *References59Choice `xml:"AcctOwnrTxId"`
 }
 
+func (t *TransactionIdentifications39) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications39) SetAccountServicerTransactionIdentification(value string) {
 	t.AccountServicerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -13,6 +13,10 @@ type TransactionIdentifications4 struct {
 	AccountOwnerTransactionIdentification *References4Choice `xml:"AcctOwnrTxId"`
 }
 
+func (t *TransactionIdentifications4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications4) SetAccountServicerTransactionIdentification(value string) {
 	t.AccountServicerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type TransactionIdentifications9 struct {
 	NettingServiceProviderIdentification *Max35Text `xml:"NetgSvcPrvdrId,omitempty"`
 }
 
+func (t *TransactionIdentifications9) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifications9) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Identification of the transaction in an unambiguous way.
 type TransactionIdentifier1 struct {
 
@@ -10,10 +16,60 @@ type TransactionIdentifier1 struct {
 	TransactionReference *Max35Text `xml:"TxRef"`
 }
 
+func (t *TransactionIdentifier1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifier1) SetTransactionDateTime(value string) {
 	t.TransactionDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TransactionIdentifier1) SetTransactionDateTimeFromTime(value time.Time) {
+	t.TransactionDateTime = new(ISODateTime)
+	t.TransactionDateTime.FromTime(value)
+}
+
 func (t *TransactionIdentifier1) SetTransactionReference(value string) {
 	t.TransactionReference = (*Max35Text)(&value)
 }
+
+func (t *TransactionIdentifier1) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.TransactionDateTime != nil {
+		enc.Element("TxDtTm", string(*t.TransactionDateTime))
+	}
+	if t.TransactionReference != nil {
+		enc.Element("TxRef", string(*t.TransactionReference))
+	}
+	enc.End(tag)
+}
+
+func (t *TransactionIdentifier1) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "TxDtTm":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TransactionDateTime = (*ISODateTime)(&value)
+		case "TxRef":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TransactionReference = (*Max35Text)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identification of the reconciliation period between the acquirer and the issuer or their respective agents.
 type TransactionIdentifier2 struct {
 
@@ -12,10 +16,19 @@ type TransactionIdentifier2 struct {
 	ReconciliationIdentification *Max35Text `xml:"RcncltnId,omitempty"`
 }
 
+func (t *TransactionIdentifier2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionIdentifier2) SetReconciliationDate(value string) {
 	t.ReconciliationDate = (*ISODate)(&value)
 }
 
+func (t *TransactionIdentifier2) SetReconciliationDateFromTime(value time.Time) {
+	t.ReconciliationDate = new(ISODate)
+	t.ReconciliationDate.FromTime(value)
+}
+
 func (t *TransactionIdentifier2) SetReconciliationIdentification(value string) {
 	t.ReconciliationIdentification = (*Max35Text)(&value)
 }
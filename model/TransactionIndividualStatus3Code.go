@@ -1,3 +1,36 @@
 package model
 
 type TransactionIndividualStatus3Code string
+
+const (
+	TransactionIndividualStatus3CodeACTC TransactionIndividualStatus3Code = "ACTC"
+	TransactionIndividualStatus3CodeRJCT TransactionIndividualStatus3Code = "RJCT"
+	TransactionIndividualStatus3CodePDNG TransactionIndividualStatus3Code = "PDNG"
+	TransactionIndividualStatus3CodeACCP TransactionIndividualStatus3Code = "ACCP"
+	TransactionIndividualStatus3CodeACSP TransactionIndividualStatus3Code = "ACSP"
+	TransactionIndividualStatus3CodeACSC TransactionIndividualStatus3Code = "ACSC"
+	TransactionIndividualStatus3CodeACWC TransactionIndividualStatus3Code = "ACWC"
+)
+
+var transactionIndividualStatus3CodeDescriptions = map[TransactionIndividualStatus3Code]string{
+	TransactionIndividualStatus3CodeACTC: "Accepted technical validation: authentication and syntactical and semantical validation are successful.",
+	TransactionIndividualStatus3CodeRJCT: "Rejected: payment initiation or individual transaction included in the payment initiation has been rejected.",
+	TransactionIndividualStatus3CodePDNG: "Pending: payment initiation or individual transaction included in the payment initiation is pending.",
+	TransactionIndividualStatus3CodeACCP: "Accepted customer profile: preceding check of technical validation was successful. Customer profile check was also successful.",
+	TransactionIndividualStatus3CodeACSP: "Accepted settlement in process: all preceding checks such as technical validation and customer profile were successful and therefore the payment initiation has been accepted for execution.",
+	TransactionIndividualStatus3CodeACSC: "Accepted settlement completed: settlement on the debtor's account has been completed.",
+	TransactionIndividualStatus3CodeACWC: "Accepted with change: instruction is accepted but a change will be made, such as date or remittance not sent.",
+}
+
+func (t TransactionIndividualStatus3Code) IsValid() bool {
+	_, ok := transactionIndividualStatus3CodeDescriptions[t]
+	return ok
+}
+
+func (t TransactionIndividualStatus3Code) Description() string {
+	return transactionIndividualStatus3CodeDescriptions[t]
+}
+
+func (t TransactionIndividualStatus3Code) Validate() error {
+	return validateEnumeration("TransactionIndividualStatus3Code", string(t), t.IsValid(), "ACTC, RJCT, PDNG, ACCP, ACSP, ACSC, ACWC")
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides transaction specific interest information that applies to the underlying transaction.
 type TransactionInterest1 struct {
 
@@ -22,12 +26,24 @@ type TransactionInterest1 struct {
 	Reason *Max35Text `xml:"Rsn,omitempty"`
 }
 
+func (t *TransactionInterest1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionInterest1) SetAmount(value, currency string) {
 	t.Amount = NewCurrencyAndAmount(value, currency)
 }
 
-func (t *TransactionInterest1) SetCreditDebitIndicator(value string) {
+func (t *TransactionInterest1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
+func (t *TransactionInterest1) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	t.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (t *TransactionInterest1) AddType() *InterestType1Choice {
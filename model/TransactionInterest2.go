@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements used to provide transaction specific interest information that applies to the underlying transaction.
 type TransactionInterest2 struct {
 
@@ -22,12 +26,24 @@ type TransactionInterest2 struct {
 	Reason *Max35Text `xml:"Rsn,omitempty"`
 }
 
+func (t *TransactionInterest2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionInterest2) SetAmount(value, currency string) {
 	t.Amount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
-func (t *TransactionInterest2) SetCreditDebitIndicator(value string) {
+func (t *TransactionInterest2) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (t *TransactionInterest2) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	t.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (t *TransactionInterest2) AddType() *InterestType1Choice {
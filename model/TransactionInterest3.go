@@ -1,5 +1,10 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
 // Provide further details on transaction specific interest information that applies to the underlying transaction.
 type TransactionInterest3 struct {
 
@@ -10,16 +15,67 @@ type TransactionInterest3 struct {
 	Record []*InterestRecord1 `xml:"Rcrd,omitempty"`
 }
 
+func (t *TransactionInterest3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionInterest3) SetTotalInterestAndTaxAmount(value, currency string) {
 	t.TotalInterestAndTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (t *TransactionInterest3) SetTotalInterestAndTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalInterestAndTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TransactionInterest3) AddRecord() *InterestRecord1 {
 	newValue := new(InterestRecord1)
 	t.Record = append(t.Record, newValue)
 	return newValue
 }
 
+func (t *TransactionInterest3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.TotalInterestAndTaxAmount != nil {
+		t.TotalInterestAndTaxAmount.EncodeXMLFast(enc, "TtlIntrstAndTaxAmt")
+	}
+	for _, elem := range t.Record {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Rcrd")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TransactionInterest3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "TtlIntrstAndTaxAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalInterestAndTaxAmount = elem
+		case "Rcrd":
+			elem := new(InterestRecord1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Record = append(t.Record, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/fastxml"
+)
+
+// Provide further details on transaction specific interest information that applies to the underlying transaction.
+type TransactionInterest4 struct {
+
+	// Total amount of interests and taxes included in the entry amount.
+	TotalInterestAndTaxAmount *ActiveOrHistoricCurrencyAndAmount `xml:"TtlIntrstAndTaxAmt,omitempty"`
+
+	// Individual interest record.
+	Record []*InterestRecord2 `xml:"Rcrd,omitempty"`
+}
+
+func (t *TransactionInterest4) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TransactionInterest4) SetTotalInterestAndTaxAmount(value, currency string) {
+	t.TotalInterestAndTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
+}
+
+func (t *TransactionInterest4) SetTotalInterestAndTaxAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalInterestAndTaxAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
+func (t *TransactionInterest4) AddRecord() *InterestRecord2 {
+	newValue := new(InterestRecord2)
+	t.Record = append(t.Record, newValue)
+	return newValue
+}
+
+func (t *TransactionInterest4) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.TotalInterestAndTaxAmount != nil {
+		t.TotalInterestAndTaxAmount.EncodeXMLFast(enc, "TtlIntrstAndTaxAmt")
+	}
+	for _, elem := range t.Record {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Rcrd")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TransactionInterest4) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "TtlIntrstAndTaxAmt":
+			elem := new(ActiveOrHistoricCurrencyAndAmount)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TotalInterestAndTaxAmount = elem
+		case "Rcrd":
+			elem := new(InterestRecord2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Record = append(t.Record, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
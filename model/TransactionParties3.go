@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Provides further details on the parties specific to the individual transaction.
 type TransactionParties3 struct {
 
@@ -31,6 +33,10 @@ type TransactionParties3 struct {
 	Proprietary []*ProprietaryParty3 `xml:"Prtry,omitempty"`
 }
 
+func (t *TransactionParties3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionParties3) AddInitiatingParty() *PartyIdentification43 {
 	t.InitiatingParty = new(PartyIdentification43)
 	return t.InitiatingParty
@@ -77,6 +83,112 @@ func (t *TransactionParties3) AddProprietary() *ProprietaryParty3 {
 	return newValue
 }
 
+func (t *TransactionParties3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.InitiatingParty != nil {
+		t.InitiatingParty.EncodeXMLFast(enc, "InitgPty")
+	}
+	if t.Debtor != nil {
+		t.Debtor.EncodeXMLFast(enc, "Dbtr")
+	}
+	if t.DebtorAccount != nil {
+		t.DebtorAccount.EncodeXMLFast(enc, "DbtrAcct")
+	}
+	if t.UltimateDebtor != nil {
+		t.UltimateDebtor.EncodeXMLFast(enc, "UltmtDbtr")
+	}
+	if t.Creditor != nil {
+		t.Creditor.EncodeXMLFast(enc, "Cdtr")
+	}
+	if t.CreditorAccount != nil {
+		t.CreditorAccount.EncodeXMLFast(enc, "CdtrAcct")
+	}
+	if t.UltimateCreditor != nil {
+		t.UltimateCreditor.EncodeXMLFast(enc, "UltmtCdtr")
+	}
+	if t.TradingParty != nil {
+		t.TradingParty.EncodeXMLFast(enc, "TradgPty")
+	}
+	for _, elem := range t.Proprietary {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Prtry")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TransactionParties3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "InitgPty":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.InitiatingParty = elem
+		case "Dbtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Debtor = elem
+		case "DbtrAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.DebtorAccount = elem
+		case "UltmtDbtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.UltimateDebtor = elem
+		case "Cdtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Creditor = elem
+		case "CdtrAcct":
+			elem := new(CashAccount24)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.CreditorAccount = elem
+		case "UltmtCdtr":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.UltimateCreditor = elem
+		case "TradgPty":
+			elem := new(PartyIdentification43)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TradingParty = elem
+		case "Prtry":
+			elem := new(ProprietaryParty3)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Proprietary = append(t.Proprietary, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
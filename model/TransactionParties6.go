@@ -0,0 +1,190 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Provides further details on the parties specific to the individual transaction.
+type TransactionParties6 struct {
+
+	// Party that initiated the payment that is reported in the entry.
+	InitiatingParty *Party40Choice `xml:"InitgPty,omitempty"`
+
+	// Party that owes an amount of money to the (ultimate) creditor.
+	Debtor *Party40Choice `xml:"Dbtr,omitempty"`
+
+	// Unambiguous identification of the account of the debtor.
+	DebtorAccount *CashAccount38 `xml:"DbtrAcct,omitempty"`
+
+	// Ultimate party that owes an amount of money to the (ultimate) creditor.
+	UltimateDebtor *Party40Choice `xml:"UltmtDbtr,omitempty"`
+
+	// Party to which an amount of money is due.
+	Creditor *Party40Choice `xml:"Cdtr,omitempty"`
+
+	// Unambiguous identification of the account of the creditor to which a credit entry has been posted as a result of the payment transaction.
+	CreditorAccount *CashAccount38 `xml:"CdtrAcct,omitempty"`
+
+	// Ultimate party to which an amount of money is due.
+	UltimateCreditor *Party40Choice `xml:"UltmtCdtr,omitempty"`
+
+	// Party that plays an active role in planning and executing the transactions that create or liquidate investments of the investors assets, or that move the investor's assets from one investment to another. A trading party is a trade instructor, an investment decision-maker, a post trade administrator, or a trader. In the context of treasury, it is the party that negotiates and executes the treasury transaction.
+	TradingParty *Party40Choice `xml:"TradgPty,omitempty"`
+
+	// Proprietary party related to the underlying transaction.
+	Proprietary []*ProprietaryParty5 `xml:"Prtry,omitempty"`
+}
+
+func (t *TransactionParties6) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TransactionParties6) AddInitiatingParty() *Party40Choice {
+	t.InitiatingParty = new(Party40Choice)
+	return t.InitiatingParty
+}
+
+func (t *TransactionParties6) AddDebtor() *Party40Choice {
+	t.Debtor = new(Party40Choice)
+	return t.Debtor
+}
+
+func (t *TransactionParties6) AddDebtorAccount() *CashAccount38 {
+	t.DebtorAccount = new(CashAccount38)
+	return t.DebtorAccount
+}
+
+func (t *TransactionParties6) AddUltimateDebtor() *Party40Choice {
+	t.UltimateDebtor = new(Party40Choice)
+	return t.UltimateDebtor
+}
+
+func (t *TransactionParties6) AddCreditor() *Party40Choice {
+	t.Creditor = new(Party40Choice)
+	return t.Creditor
+}
+
+func (t *TransactionParties6) AddCreditorAccount() *CashAccount38 {
+	t.CreditorAccount = new(CashAccount38)
+	return t.CreditorAccount
+}
+
+func (t *TransactionParties6) AddUltimateCreditor() *Party40Choice {
+	t.UltimateCreditor = new(Party40Choice)
+	return t.UltimateCreditor
+}
+
+func (t *TransactionParties6) AddTradingParty() *Party40Choice {
+	t.TradingParty = new(Party40Choice)
+	return t.TradingParty
+}
+
+func (t *TransactionParties6) AddProprietary() *ProprietaryParty5 {
+	newValue := new(ProprietaryParty5)
+	t.Proprietary = append(t.Proprietary, newValue)
+	return newValue
+}
+
+func (t *TransactionParties6) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.InitiatingParty != nil {
+		t.InitiatingParty.EncodeXMLFast(enc, "InitgPty")
+	}
+	if t.Debtor != nil {
+		t.Debtor.EncodeXMLFast(enc, "Dbtr")
+	}
+	if t.DebtorAccount != nil {
+		t.DebtorAccount.EncodeXMLFast(enc, "DbtrAcct")
+	}
+	if t.UltimateDebtor != nil {
+		t.UltimateDebtor.EncodeXMLFast(enc, "UltmtDbtr")
+	}
+	if t.Creditor != nil {
+		t.Creditor.EncodeXMLFast(enc, "Cdtr")
+	}
+	if t.CreditorAccount != nil {
+		t.CreditorAccount.EncodeXMLFast(enc, "CdtrAcct")
+	}
+	if t.UltimateCreditor != nil {
+		t.UltimateCreditor.EncodeXMLFast(enc, "UltmtCdtr")
+	}
+	if t.TradingParty != nil {
+		t.TradingParty.EncodeXMLFast(enc, "TradgPty")
+	}
+	for _, elem := range t.Proprietary {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Prtry")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TransactionParties6) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "InitgPty":
+			elem := new(Party40Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.InitiatingParty = elem
+		case "Dbtr":
+			elem := new(Party40Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Debtor = elem
+		case "DbtrAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.DebtorAccount = elem
+		case "UltmtDbtr":
+			elem := new(Party40Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.UltimateDebtor = elem
+		case "Cdtr":
+			elem := new(Party40Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Creditor = elem
+		case "CdtrAcct":
+			elem := new(CashAccount38)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.CreditorAccount = elem
+		case "UltmtCdtr":
+			elem := new(Party40Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.UltimateCreditor = elem
+		case "TradgPty":
+			elem := new(Party40Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.TradingParty = elem
+		case "Prtry":
+			elem := new(ProprietaryParty5)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Proprietary = append(t.Proprietary, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
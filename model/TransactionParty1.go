@@ -31,6 +31,10 @@ type TransactionParty1 struct {
 	Proprietary []*ProprietaryParty1 `xml:"Prtry,omitempty"`
 }
 
+func (t *TransactionParty1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionParty1) AddInitiatingParty() *PartyIdentification8 {
 	t.InitiatingParty = new(PartyIdentification8)
 	return t.InitiatingParty
@@ -31,6 +31,10 @@ type TransactionParty2 struct {
 	Proprietary []*ProprietaryParty2 `xml:"Prtry,omitempty"`
 }
 
+func (t *TransactionParty2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionParty2) AddInitiatingParty() *PartyIdentification32 {
 	t.InitiatingParty = new(PartyIdentification32)
 	return t.InitiatingParty
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Set of elements identifying the price information related to the underlying transaction.
 type TransactionPrice1Choice struct {
 
@@ -12,10 +16,18 @@ type TransactionPrice1Choice struct {
 	Proprietary []*ProprietaryPrice1 `xml:"Prtry"`
 }
 
+func (t *TransactionPrice1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionPrice1Choice) SetDealPrice(value, currency string) {
 	t.DealPrice = NewCurrencyAndAmount(value, currency)
 }
 
+func (t *TransactionPrice1Choice) SetDealPriceFromDecimal(value decimal.Decimal, currency string) {
+	t.DealPrice = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TransactionPrice1Choice) AddProprietary() *ProprietaryPrice1 {
 	newValue := new(ProprietaryPrice1)
 	t.Proprietary = append(t.Proprietary, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Specifies the price information related to the underlying transaction.
 type TransactionPrice2Choice struct {
 
@@ -12,10 +16,18 @@ type TransactionPrice2Choice struct {
 	Proprietary []*ProprietaryPrice2 `xml:"Prtry"`
 }
 
+func (t *TransactionPrice2Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionPrice2Choice) SetDealPrice(value, currency string) {
 	t.DealPrice = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (t *TransactionPrice2Choice) SetDealPriceFromDecimal(value decimal.Decimal, currency string) {
+	t.DealPrice = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TransactionPrice2Choice) AddProprietary() *ProprietaryPrice2 {
 	newValue := new(ProprietaryPrice2)
 	t.Proprietary = append(t.Proprietary, newValue)
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the price information related to the underlying transaction.
 type TransactionPrice3Choice struct {
 
@@ -12,6 +14,10 @@ type TransactionPrice3Choice struct {
 	Proprietary []*ProprietaryPrice2 `xml:"Prtry"`
 }
 
+func (t *TransactionPrice3Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionPrice3Choice) AddDealPrice() *Price2 {
 	t.DealPrice = new(Price2)
 	return t.DealPrice
@@ -23,6 +29,49 @@ func (t *TransactionPrice3Choice) AddProprietary() *ProprietaryPrice2 {
 	return newValue
 }
 
+func (t *TransactionPrice3Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.DealPrice != nil {
+		t.DealPrice.EncodeXMLFast(enc, "DealPric")
+	}
+	for _, elem := range t.Proprietary {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Prtry")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TransactionPrice3Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "DealPric":
+			elem := new(Price2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.DealPrice = elem
+		case "Prtry":
+			elem := new(ProprietaryPrice2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Proprietary = append(t.Proprietary, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -0,0 +1,73 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Specifies the price information related to the underlying transaction.
+type TransactionPrice4Choice struct {
+
+	// Specifies the price of the traded financial instrument.
+	// This is the deal price of the individual trade transaction.
+	// If there is only one trade transaction for the execution of the trade, then the deal price could equal the executed trade price (unless, for example, the price includes commissions or rounding, or some other factor has been applied to the deal price or the executed trade price, or both).
+	DealPrice *Price7 `xml:"DealPric"`
+
+	// Proprietary price specification related to the underlying transaction.
+	Proprietary []*ProprietaryPrice2 `xml:"Prtry"`
+}
+
+func (t *TransactionPrice4Choice) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TransactionPrice4Choice) AddDealPrice() *Price7 {
+	t.DealPrice = new(Price7)
+	return t.DealPrice
+}
+
+func (t *TransactionPrice4Choice) AddProprietary() *ProprietaryPrice2 {
+	newValue := new(ProprietaryPrice2)
+	t.Proprietary = append(t.Proprietary, newValue)
+	return newValue
+}
+
+func (t *TransactionPrice4Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.DealPrice != nil {
+		t.DealPrice.EncodeXMLFast(enc, "DealPric")
+	}
+	for _, elem := range t.Proprietary {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Prtry")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TransactionPrice4Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "DealPric":
+			elem := new(Price7)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.DealPrice = elem
+		case "Prtry":
+			elem := new(ProprietaryPrice2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Proprietary = append(t.Proprietary, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
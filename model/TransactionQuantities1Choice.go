@@ -10,6 +10,10 @@ type TransactionQuantities1Choice struct {
 	Proprietary *ProprietaryQuantity1 `xml:"Prtry"`
 }
 
+func (t *TransactionQuantities1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionQuantities1Choice) AddQuantity() *FinancialInstrumentQuantityChoice {
 	t.Quantity = new(FinancialInstrumentQuantityChoice)
 	return t.Quantity
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Specifies the quantities (such as securities) in the underlying transaction.
 type TransactionQuantities2Choice struct {
 
@@ -13,6 +15,10 @@ type TransactionQuantities2Choice struct {
 	Proprietary *ProprietaryQuantity1 `xml:"Prtry"`
 }
 
+func (t *TransactionQuantities2Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionQuantities2Choice) AddQuantity() *FinancialInstrumentQuantityChoice {
 	t.Quantity = new(FinancialInstrumentQuantityChoice)
 	return t.Quantity
@@ -27,3 +33,53 @@ func (t *TransactionQuantities2Choice) AddProprietary() *ProprietaryQuantity1 {
 	t.Proprietary = new(ProprietaryQuantity1)
 	return t.Proprietary
 }
+
+func (t *TransactionQuantities2Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Quantity != nil {
+		t.Quantity.EncodeXMLFast(enc, "Qty")
+	}
+	if t.OriginalAndCurrentFaceAmount != nil {
+		t.OriginalAndCurrentFaceAmount.EncodeXMLFast(enc, "OrgnlAndCurFaceAmt")
+	}
+	if t.Proprietary != nil {
+		t.Proprietary.EncodeXMLFast(enc, "Prtry")
+	}
+	enc.End(tag)
+}
+
+func (t *TransactionQuantities2Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Qty":
+			elem := new(FinancialInstrumentQuantityChoice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Quantity = elem
+		case "OrgnlAndCurFaceAmt":
+			elem := new(OriginalAndCurrentQuantities1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.OriginalAndCurrentFaceAmount = elem
+		case "Prtry":
+			elem := new(ProprietaryQuantity1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Proprietary = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Specifies the quantities (such as securities) in the underlying transaction.
+type TransactionQuantities3Choice struct {
+
+	// Specifies the quantity (such as securities) in the underlying transaction.
+	Quantity *FinancialInstrumentQuantity1Choice `xml:"Qty"`
+
+	// Face amount and amortised value of security.
+	OriginalAndCurrentFaceAmount *OriginalAndCurrentQuantities1 `xml:"OrgnlAndCurFaceAmt"`
+
+	// Proprietary quantities specification defined in the underlying transaction.
+	Proprietary *ProprietaryQuantity1 `xml:"Prtry"`
+}
+
+func (t *TransactionQuantities3Choice) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TransactionQuantities3Choice) AddQuantity() *FinancialInstrumentQuantity1Choice {
+	t.Quantity = new(FinancialInstrumentQuantity1Choice)
+	return t.Quantity
+}
+
+func (t *TransactionQuantities3Choice) AddOriginalAndCurrentFaceAmount() *OriginalAndCurrentQuantities1 {
+	t.OriginalAndCurrentFaceAmount = new(OriginalAndCurrentQuantities1)
+	return t.OriginalAndCurrentFaceAmount
+}
+
+func (t *TransactionQuantities3Choice) AddProprietary() *ProprietaryQuantity1 {
+	t.Proprietary = new(ProprietaryQuantity1)
+	return t.Proprietary
+}
+
+func (t *TransactionQuantities3Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.Quantity != nil {
+		t.Quantity.EncodeXMLFast(enc, "Qty")
+	}
+	if t.OriginalAndCurrentFaceAmount != nil {
+		t.OriginalAndCurrentFaceAmount.EncodeXMLFast(enc, "OrgnlAndCurFaceAmt")
+	}
+	if t.Proprietary != nil {
+		t.Proprietary.EncodeXMLFast(enc, "Prtry")
+	}
+	enc.End(tag)
+}
+
+func (t *TransactionQuantities3Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Qty":
+			elem := new(FinancialInstrumentQuantity1Choice)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Quantity = elem
+		case "OrgnlAndCurFaceAmt":
+			elem := new(OriginalAndCurrentQuantities1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.OriginalAndCurrentFaceAmount = elem
+		case "Prtry":
+			elem := new(ProprietaryQuantity1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Proprietary = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
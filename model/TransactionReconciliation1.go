@@ -19,10 +19,19 @@ type TransactionReconciliation1 struct {
 	AdditionalTransactionData *Max70Text `xml:"AddtlTxData,omitempty"`
 }
 
+func (t *TransactionReconciliation1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionReconciliation1) SetClosePeriod(value string) {
 	t.ClosePeriod = (*TrueFalseIndicator)(&value)
 }
 
+func (t *TransactionReconciliation1) SetClosePeriodFromBool(value bool) {
+	t.ClosePeriod = new(TrueFalseIndicator)
+	t.ClosePeriod.FromBool(value)
+}
+
 func (t *TransactionReconciliation1) AddReconciliationTransactionIdentification() *TransactionIdentifier1 {
 	t.ReconciliationTransactionIdentification = new(TransactionIdentifier1)
 	return t.ReconciliationTransactionIdentification
@@ -19,10 +19,19 @@ type TransactionReconciliation2 struct {
 	AdditionalTransactionData *Max70Text `xml:"AddtlTxData,omitempty"`
 }
 
+func (t *TransactionReconciliation2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionReconciliation2) SetClosePeriod(value string) {
 	t.ClosePeriod = (*TrueFalseIndicator)(&value)
 }
 
+func (t *TransactionReconciliation2) SetClosePeriodFromBool(value bool) {
+	t.ClosePeriod = new(TrueFalseIndicator)
+	t.ClosePeriod.FromBool(value)
+}
+
 func (t *TransactionReconciliation2) AddReconciliationTransactionIdentification() *TransactionIdentifier1 {
 	t.ReconciliationTransactionIdentification = new(TransactionIdentifier1)
 	return t.ReconciliationTransactionIdentification
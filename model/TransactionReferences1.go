@@ -40,6 +40,10 @@ type TransactionReferences1 struct {
 	Proprietary *ProprietaryReference1 `xml:"Prtry,omitempty"`
 }
 
+func (t *TransactionReferences1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionReferences1) SetMessageIdentification(value string) {
 	t.MessageIdentification = (*Max35Text)(&value)
 }
@@ -43,6 +43,10 @@ type TransactionReferences2 struct {
 	Proprietary *ProprietaryReference1 `xml:"Prtry,omitempty"`
 }
 
+func (t *TransactionReferences2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionReferences2) SetMessageIdentification(value string) {
 	t.MessageIdentification = (*Max35Text)(&value)
 }
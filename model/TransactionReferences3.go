@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Identifies the underlying transaction.
 type TransactionReferences3 struct {
 
@@ -55,6 +57,10 @@ type TransactionReferences3 struct {
 	Proprietary []*ProprietaryReference1 `xml:"Prtry,omitempty"`
 }
 
+func (t *TransactionReferences3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionReferences3) SetMessageIdentification(value string) {
 	t.MessageIdentification = (*Max35Text)(&value)
 }
@@ -113,6 +119,157 @@ func (t *TransactionReferences3) AddProprietary() *ProprietaryReference1 {
 	return newValue
 }
 
+func (t *TransactionReferences3) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.MessageIdentification != nil {
+		enc.Element("MsgId", string(*t.MessageIdentification))
+	}
+	if t.AccountServicerReference != nil {
+		enc.Element("AcctSvcrRef", string(*t.AccountServicerReference))
+	}
+	if t.PaymentInformationIdentification != nil {
+		enc.Element("PmtInfId", string(*t.PaymentInformationIdentification))
+	}
+	if t.InstructionIdentification != nil {
+		enc.Element("InstrId", string(*t.InstructionIdentification))
+	}
+	if t.EndToEndIdentification != nil {
+		enc.Element("EndToEndId", string(*t.EndToEndIdentification))
+	}
+	if t.TransactionIdentification != nil {
+		enc.Element("TxId", string(*t.TransactionIdentification))
+	}
+	if t.MandateIdentification != nil {
+		enc.Element("MndtId", string(*t.MandateIdentification))
+	}
+	if t.ChequeNumber != nil {
+		enc.Element("ChqNb", string(*t.ChequeNumber))
+	}
+	if t.ClearingSystemReference != nil {
+		enc.Element("ClrSysRef", string(*t.ClearingSystemReference))
+	}
+	if t.AccountOwnerTransactionIdentification != nil {
+		enc.Element("AcctOwnrTxId", string(*t.AccountOwnerTransactionIdentification))
+	}
+	if t.AccountServicerTransactionIdentification != nil {
+		enc.Element("AcctSvcrTxId", string(*t.AccountServicerTransactionIdentification))
+	}
+	if t.MarketInfrastructureTransactionIdentification != nil {
+		enc.Element("MktInfrstrctrTxId", string(*t.MarketInfrastructureTransactionIdentification))
+	}
+	if t.ProcessingIdentification != nil {
+		enc.Element("PrcgId", string(*t.ProcessingIdentification))
+	}
+	for _, elem := range t.Proprietary {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Prtry")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TransactionReferences3) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "MsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.MessageIdentification = (*Max35Text)(&value)
+		case "AcctSvcrRef":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.AccountServicerReference = (*Max35Text)(&value)
+		case "PmtInfId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.PaymentInformationIdentification = (*Max35Text)(&value)
+		case "InstrId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.InstructionIdentification = (*Max35Text)(&value)
+		case "EndToEndId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.EndToEndIdentification = (*Max35Text)(&value)
+		case "TxId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TransactionIdentification = (*Max35Text)(&value)
+		case "MndtId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.MandateIdentification = (*Max35Text)(&value)
+		case "ChqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.ChequeNumber = (*Max35Text)(&value)
+		case "ClrSysRef":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.ClearingSystemReference = (*Max35Text)(&value)
+		case "AcctOwnrTxId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
+		case "AcctSvcrTxId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.AccountServicerTransactionIdentification = (*Max35Text)(&value)
+		case "MktInfrstrctrTxId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.MarketInfrastructureTransactionIdentification = (*Max35Text)(&value)
+		case "PrcgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.ProcessingIdentification = (*Max35Text)(&value)
+		case "Prtry":
+			elem := new(ProprietaryReference1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Proprietary = append(t.Proprietary, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
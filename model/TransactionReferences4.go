@@ -30,6 +30,10 @@ type TransactionReferences4 struct {
 	CreditorSchemeIdentification *PartyIdentification43 `xml:"CdtrSchmeId,omitempty"`
 }
 
+func (t *TransactionReferences4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionReferences4) SetPaymentInformationIdentification(value string) {
 	t.PaymentInformationIdentification = (*Max35Text)(&value)
 }
@@ -0,0 +1,287 @@
+package model
+
+import "github.com/yudaprama/iso20022/fastxml"
+
+// Identifies the underlying transaction.
+type TransactionReferences6 struct {
+
+	// Point to point reference, as assigned by the instructing party of the underlying message.
+	MessageIdentification *Max35Text `xml:"MsgId,omitempty"`
+
+	// Unique reference, as assigned by the account servicing institution, to unambiguously identify the instruction.
+	AccountServicerReference *Max35Text `xml:"AcctSvcrRef,omitempty"`
+
+	// Unique identification, as assigned by a sending party, to unambiguously identify the payment information group within the message.
+	PaymentInformationIdentification *Max35Text `xml:"PmtInfId,omitempty"`
+
+	// Unique identification, as assigned by an instructing party for an instructed party, to unambiguously identify the instruction.
+	//
+	// Usage: The instruction identification is a point to point reference that can be used between the instructing party and the instructed party to refer to the individual instruction. It can be included in several messages related to the instruction.
+	InstructionIdentification *Max35Text `xml:"InstrId,omitempty"`
+
+	// Unique identification, as assigned by the initiating party, to unambiguously identify the transaction. This identification is passed on, unchanged, throughout the entire end-to-end chain.
+	//
+	// Usage: The end-to-end identification can be used for reconciliation or to link tasks relating to the transaction. It can be included in several messages related to the transaction.
+	//
+	// Usage: In case there are technical limitations to pass on multiple references, the end-to-end identification must be passed on throughout the entire end-to-end chain.
+	EndToEndIdentification *Max35Text `xml:"EndToEndId,omitempty"`
+
+	// Universally unique identifier to provide an end-to-end reference of a payment transaction.
+	UETR *UUIDv4Identifier `xml:"UETR,omitempty"`
+
+	// Unique identification, as assigned by the first instructing agent, to unambiguously identify the transaction that is passed on, unchanged, throughout the entire interbank chain.
+	// Usage: The transaction identification can be used for reconciliation, tracking or to link tasks relating to the transaction on the interbank level.
+	// Usage: The instructing agent has to make sure that the transaction identification is unique for a pre-agreed period.
+	TransactionIdentification *Max35Text `xml:"TxId,omitempty"`
+
+	// Unique identification, as assigned by the creditor, to unambiguously identify the mandate.
+	MandateIdentification *Max35Text `xml:"MndtId,omitempty"`
+
+	// Unique and unambiguous identifier for a cheque as assigned by the agent.
+	ChequeNumber *Max35Text `xml:"ChqNb,omitempty"`
+
+	// Unique reference, as assigned by a clearing system, to unambiguously identify the instruction.
+	// Usage: In case there are technical limitations to pass on multiple references, the end-to-end identification must be passed on throughout the entire end-to-end chain.
+	ClearingSystemReference *Max35Text `xml:"ClrSysRef,omitempty"`
+
+	// Unambiguous identification of the securities transaction as known by the securities account owner (or the instructing party managing the account).
+	AccountOwnerTransactionIdentification *Max35Text `xml:"AcctOwnrTxId,omitempty"`
+
+	// Unambiguous identification of the securities transaction as known by the securities account servicer.
+	AccountServicerTransactionIdentification *Max35Text `xml:"AcctSvcrTxId,omitempty"`
+
+	// Identification of a securities transaction assigned by a market infrastructure other than a central securities depository, for example, Target2-Securities.
+	MarketInfrastructureTransactionIdentification *Max35Text `xml:"MktInfrstrctrTxId,omitempty"`
+
+	// Identification of the securities transaction assigned by the processor of the instruction other than the securities account owner, the securities account servicer and the market infrastructure.
+	ProcessingIdentification *Max35Text `xml:"PrcgId,omitempty"`
+
+	// Proprietary reference related to the underlying transaction.
+	Proprietary []*ProprietaryReference1 `xml:"Prtry,omitempty"`
+}
+
+func (t *TransactionReferences6) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TransactionReferences6) SetMessageIdentification(value string) {
+	t.MessageIdentification = (*Max35Text)(&value)
+}
+
+func (t *TransactionReferences6) SetAccountServicerReference(value string) {
+	t.AccountServicerReference = (*Max35Text)(&value)
+}
+
+func (t *TransactionReferences6) SetPaymentInformationIdentification(value string) {
+	t.PaymentInformationIdentification = (*Max35Text)(&value)
+}
+
+func (t *TransactionReferences6) SetInstructionIdentification(value string) {
+	t.InstructionIdentification = (*Max35Text)(&value)
+}
+
+func (t *TransactionReferences6) SetEndToEndIdentification(value string) {
+	t.EndToEndIdentification = (*Max35Text)(&value)
+}
+
+func (t *TransactionReferences6) SetUETR(value string) {
+	t.UETR = (*UUIDv4Identifier)(&value)
+}
+
+func (t *TransactionReferences6) SetTransactionIdentification(value string) {
+	t.TransactionIdentification = (*Max35Text)(&value)
+}
+
+func (t *TransactionReferences6) SetMandateIdentification(value string) {
+	t.MandateIdentification = (*Max35Text)(&value)
+}
+
+func (t *TransactionReferences6) SetChequeNumber(value string) {
+	t.ChequeNumber = (*Max35Text)(&value)
+}
+
+func (t *TransactionReferences6) SetClearingSystemReference(value string) {
+	t.ClearingSystemReference = (*Max35Text)(&value)
+}
+
+func (t *TransactionReferences6) SetAccountOwnerTransactionIdentification(value string) {
+	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
+}
+
+func (t *TransactionReferences6) SetAccountServicerTransactionIdentification(value string) {
+	t.AccountServicerTransactionIdentification = (*Max35Text)(&value)
+}
+
+func (t *TransactionReferences6) SetMarketInfrastructureTransactionIdentification(value string) {
+	t.MarketInfrastructureTransactionIdentification = (*Max35Text)(&value)
+}
+
+func (t *TransactionReferences6) SetProcessingIdentification(value string) {
+	t.ProcessingIdentification = (*Max35Text)(&value)
+}
+
+func (t *TransactionReferences6) AddProprietary() *ProprietaryReference1 {
+	newValue := new(ProprietaryReference1)
+	t.Proprietary = append(t.Proprietary, newValue)
+	return newValue
+}
+
+func (t *TransactionReferences6) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if t.MessageIdentification != nil {
+		enc.Element("MsgId", string(*t.MessageIdentification))
+	}
+	if t.AccountServicerReference != nil {
+		enc.Element("AcctSvcrRef", string(*t.AccountServicerReference))
+	}
+	if t.PaymentInformationIdentification != nil {
+		enc.Element("PmtInfId", string(*t.PaymentInformationIdentification))
+	}
+	if t.InstructionIdentification != nil {
+		enc.Element("InstrId", string(*t.InstructionIdentification))
+	}
+	if t.EndToEndIdentification != nil {
+		enc.Element("EndToEndId", string(*t.EndToEndIdentification))
+	}
+	if t.UETR != nil {
+		enc.Element("UETR", string(*t.UETR))
+	}
+	if t.TransactionIdentification != nil {
+		enc.Element("TxId", string(*t.TransactionIdentification))
+	}
+	if t.MandateIdentification != nil {
+		enc.Element("MndtId", string(*t.MandateIdentification))
+	}
+	if t.ChequeNumber != nil {
+		enc.Element("ChqNb", string(*t.ChequeNumber))
+	}
+	if t.ClearingSystemReference != nil {
+		enc.Element("ClrSysRef", string(*t.ClearingSystemReference))
+	}
+	if t.AccountOwnerTransactionIdentification != nil {
+		enc.Element("AcctOwnrTxId", string(*t.AccountOwnerTransactionIdentification))
+	}
+	if t.AccountServicerTransactionIdentification != nil {
+		enc.Element("AcctSvcrTxId", string(*t.AccountServicerTransactionIdentification))
+	}
+	if t.MarketInfrastructureTransactionIdentification != nil {
+		enc.Element("MktInfrstrctrTxId", string(*t.MarketInfrastructureTransactionIdentification))
+	}
+	if t.ProcessingIdentification != nil {
+		enc.Element("PrcgId", string(*t.ProcessingIdentification))
+	}
+	for _, elem := range t.Proprietary {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "Prtry")
+		}
+	}
+	enc.End(tag)
+}
+
+func (t *TransactionReferences6) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "MsgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.MessageIdentification = (*Max35Text)(&value)
+		case "AcctSvcrRef":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.AccountServicerReference = (*Max35Text)(&value)
+		case "PmtInfId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.PaymentInformationIdentification = (*Max35Text)(&value)
+		case "InstrId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.InstructionIdentification = (*Max35Text)(&value)
+		case "EndToEndId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.EndToEndIdentification = (*Max35Text)(&value)
+		case "UETR":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.UETR = (*UUIDv4Identifier)(&value)
+		case "TxId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.TransactionIdentification = (*Max35Text)(&value)
+		case "MndtId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.MandateIdentification = (*Max35Text)(&value)
+		case "ChqNb":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.ChequeNumber = (*Max35Text)(&value)
+		case "ClrSysRef":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.ClearingSystemReference = (*Max35Text)(&value)
+		case "AcctOwnrTxId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
+		case "AcctSvcrTxId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.AccountServicerTransactionIdentification = (*Max35Text)(&value)
+		case "MktInfrstrctrTxId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.MarketInfrastructureTransactionIdentification = (*Max35Text)(&value)
+		case "PrcgId":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			t.ProcessingIdentification = (*Max35Text)(&value)
+		case "Prtry":
+			elem := new(ProprietaryReference1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			t.Proprietary = append(t.Proprietary, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Detailed description of the items that correspond to the parameters set in a request and for which a report has been generated.
 type TransactionReportItems3 struct {
 
@@ -53,6 +57,10 @@ type TransactionReportItems3 struct {
 	PendingRequestForAction []*PendingActivity2 `xml:"PdgReqForActn,omitempty"`
 }
 
+func (t *TransactionReportItems3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionReportItems3) SetTransactionIdentification(value string) {
 	t.TransactionIdentification = (*Max35Text)(&value)
 }
@@ -542,10 +550,18 @@ func (t *TransactionReportItems3) SetOutstandingAmount(value, currency string) {
 	t.OutstandingAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (t *TransactionReportItems3) SetOutstandingAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.OutstandingAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TransactionReportItems3) SetTotalNetAmount(value, currency string) {
 	t.TotalNetAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (t *TransactionReportItems3) SetTotalNetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.TotalNetAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TransactionReportItems3) AddPendingRequestForAction() *PendingActivity2 {
 	newValue := new(PendingActivity2)
 	t.PendingRequestForAction = append(t.PendingRequestForAction, newValue)
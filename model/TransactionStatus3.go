@@ -7,6 +7,10 @@ type TransactionStatus3 struct {
 	Status *BaselineStatus2Code `xml:"Sts"`
 }
 
+func (t *TransactionStatus3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionStatus3) SetStatus(value string) {
 	t.Status = (*BaselineStatus2Code)(&value)
 }
@@ -7,6 +7,10 @@ type TransactionStatus4 struct {
 	Status *BaselineStatus3Code `xml:"Sts"`
 }
 
+func (t *TransactionStatus4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionStatus4) SetStatus(value string) {
 	t.Status = (*BaselineStatus3Code)(&value)
 }
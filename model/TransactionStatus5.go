@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Identifies the future status of the transaction by means of a code and a period.
 type TransactionStatus5 struct {
 
@@ -13,6 +17,10 @@ type TransactionStatus5 struct {
 	Description *Max140Text `xml:"Desc,omitempty"`
 }
 
+func (t *TransactionStatus5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionStatus5) SetStatus(value string) {
 	t.Status = (*BaselineStatus3Code)(&value)
 }
@@ -21,6 +29,11 @@ func (t *TransactionStatus5) SetChangeDateTime(value string) {
 	t.ChangeDateTime = (*ISODateTime)(&value)
 }
 
+func (t *TransactionStatus5) SetChangeDateTimeFromTime(value time.Time) {
+	t.ChangeDateTime = new(ISODateTime)
+	t.ChangeDateTime.FromTime(value)
+}
+
 func (t *TransactionStatus5) SetDescription(value string) {
 	t.Description = (*Max140Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Transaction totals during the reconciliation period, for a certain type of transaction.
 type TransactionTotals2 struct {
 
@@ -22,6 +26,10 @@ type TransactionTotals2 struct {
 	CumulativeAmount *ImpliedCurrencyAndAmount `xml:"CmltvAmt"`
 }
 
+func (t *TransactionTotals2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionTotals2) SetPOIGroupIdentification(value string) {
 	t.POIGroupIdentification = (*Max35Text)(&value)
 }
@@ -45,3 +53,7 @@ func (t *TransactionTotals2) SetTotalNumber(value string) {
 func (t *TransactionTotals2) SetCumulativeAmount(value, currency string) {
 	t.CumulativeAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (t *TransactionTotals2) SetCumulativeAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.CumulativeAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
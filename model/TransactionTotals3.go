@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Transaction totals during the reconciliation period, for a certain type of transaction.
 type TransactionTotals3 struct {
 
@@ -22,6 +26,10 @@ type TransactionTotals3 struct {
 	CumulativeAmount *ImpliedCurrencyAndAmount `xml:"CmltvAmt"`
 }
 
+func (t *TransactionTotals3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionTotals3) SetPOIGroupIdentification(value string) {
 	t.POIGroupIdentification = (*Max35Text)(&value)
 }
@@ -42,6 +50,15 @@ func (t *TransactionTotals3) SetTotalNumber(value string) {
 	t.TotalNumber = (*Number)(&value)
 }
 
+func (t *TransactionTotals3) SetTotalNumberFromDecimal(value decimal.Decimal) {
+	t.TotalNumber = new(Number)
+	t.TotalNumber.FromDecimal(value)
+}
+
 func (t *TransactionTotals3) SetCumulativeAmount(value, currency string) {
 	t.CumulativeAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (t *TransactionTotals3) SetCumulativeAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.CumulativeAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
@@ -13,6 +13,10 @@ type TransactionTotals4 struct {
 	TotalNumber *TransactionTotals6 `xml:"TtlNb,omitempty"`
 }
 
+func (t *TransactionTotals4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionTotals4) AddTotalCredit() *TransactionTotals5 {
 	t.TotalCredit = new(TransactionTotals5)
 	return t.TotalCredit
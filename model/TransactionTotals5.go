@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Total of credit or debit transactions
 type TransactionTotals5 struct {
 
@@ -25,30 +29,65 @@ type TransactionTotals5 struct {
 	FeeAmounts *ImpliedCurrencyAndAmount `xml:"FeeAmts,omitempty"`
 }
 
+func (t *TransactionTotals5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionTotals5) SetAmount(value, currency string) {
 	t.Amount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (t *TransactionTotals5) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.Amount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TransactionTotals5) SetNumber(value string) {
 	t.Number = (*Number)(&value)
 }
 
+func (t *TransactionTotals5) SetNumberFromDecimal(value decimal.Decimal) {
+	t.Number = new(Number)
+	t.Number.FromDecimal(value)
+}
+
 func (t *TransactionTotals5) SetChargeBackAmount(value, currency string) {
 	t.ChargeBackAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (t *TransactionTotals5) SetChargeBackAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.ChargeBackAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TransactionTotals5) SetChargeBackNumber(value string) {
 	t.ChargeBackNumber = (*Number)(&value)
 }
 
+func (t *TransactionTotals5) SetChargeBackNumberFromDecimal(value decimal.Decimal) {
+	t.ChargeBackNumber = new(Number)
+	t.ChargeBackNumber.FromDecimal(value)
+}
+
 func (t *TransactionTotals5) SetReversalAmount(value, currency string) {
 	t.ReversalAmount = NewImpliedCurrencyAndAmount(value, currency)
 }
 
+func (t *TransactionTotals5) SetReversalAmountFromDecimal(value decimal.Decimal, currency string) {
+	t.ReversalAmount = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
+
 func (t *TransactionTotals5) SetReversalNumber(value string) {
 	t.ReversalNumber = (*Number)(&value)
 }
 
+func (t *TransactionTotals5) SetReversalNumberFromDecimal(value decimal.Decimal) {
+	t.ReversalNumber = new(Number)
+	t.ReversalNumber.FromDecimal(value)
+}
+
 func (t *TransactionTotals5) SetFeeAmounts(value, currency string) {
 	t.FeeAmounts = NewImpliedCurrencyAndAmount(value, currency)
 }
+
+func (t *TransactionTotals5) SetFeeAmountsFromDecimal(value decimal.Decimal, currency string) {
+	t.FeeAmounts = NewImpliedCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Additional count which may be utilised for reconciliation.
 type TransactionTotals6 struct {
 
@@ -31,38 +35,87 @@ type TransactionTotals6 struct {
 	FeeCollection *Number `xml:"FeeColltn,omitempty"`
 }
 
+func (t *TransactionTotals6) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionTotals6) SetAuthorisation(value string) {
 	t.Authorisation = (*Number)(&value)
 }
 
+func (t *TransactionTotals6) SetAuthorisationFromDecimal(value decimal.Decimal) {
+	t.Authorisation = new(Number)
+	t.Authorisation.FromDecimal(value)
+}
+
 func (t *TransactionTotals6) SetAuthorisationReversal(value string) {
 	t.AuthorisationReversal = (*Number)(&value)
 }
 
+func (t *TransactionTotals6) SetAuthorisationReversalFromDecimal(value decimal.Decimal) {
+	t.AuthorisationReversal = new(Number)
+	t.AuthorisationReversal.FromDecimal(value)
+}
+
 func (t *TransactionTotals6) SetInquiry(value string) {
 	t.Inquiry = (*Number)(&value)
 }
 
+func (t *TransactionTotals6) SetInquiryFromDecimal(value decimal.Decimal) {
+	t.Inquiry = new(Number)
+	t.Inquiry.FromDecimal(value)
+}
+
 func (t *TransactionTotals6) SetInquiryReversal(value string) {
 	t.InquiryReversal = (*Number)(&value)
 }
 
+func (t *TransactionTotals6) SetInquiryReversalFromDecimal(value decimal.Decimal) {
+	t.InquiryReversal = new(Number)
+	t.InquiryReversal.FromDecimal(value)
+}
+
 func (t *TransactionTotals6) SetPayments(value string) {
 	t.Payments = (*Number)(&value)
 }
 
+func (t *TransactionTotals6) SetPaymentsFromDecimal(value decimal.Decimal) {
+	t.Payments = new(Number)
+	t.Payments.FromDecimal(value)
+}
+
 func (t *TransactionTotals6) SetPaymentReversal(value string) {
 	t.PaymentReversal = (*Number)(&value)
 }
 
+func (t *TransactionTotals6) SetPaymentReversalFromDecimal(value decimal.Decimal) {
+	t.PaymentReversal = new(Number)
+	t.PaymentReversal.FromDecimal(value)
+}
+
 func (t *TransactionTotals6) SetTransfer(value string) {
 	t.Transfer = (*Number)(&value)
 }
 
+func (t *TransactionTotals6) SetTransferFromDecimal(value decimal.Decimal) {
+	t.Transfer = new(Number)
+	t.Transfer.FromDecimal(value)
+}
+
 func (t *TransactionTotals6) SetTransferReversal(value string) {
 	t.TransferReversal = (*Number)(&value)
 }
 
+func (t *TransactionTotals6) SetTransferReversalFromDecimal(value decimal.Decimal) {
+	t.TransferReversal = new(Number)
+	t.TransferReversal.FromDecimal(value)
+}
+
 func (t *TransactionTotals6) SetFeeCollection(value string) {
 	t.FeeCollection = (*Number)(&value)
 }
+
+func (t *TransactionTotals6) SetFeeCollectionFromDecimal(value decimal.Decimal) {
+	t.FeeCollection = new(Number)
+	t.FeeCollection.FromDecimal(value)
+}
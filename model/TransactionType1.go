@@ -13,12 +13,24 @@ type TransactionType1 struct {
 	FloorLimit []*Limit2 `xml:"FlrLmt,omitempty"`
 }
 
-func (t *TransactionType1) SetStatus(value string) {
+func (t *TransactionType1) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TransactionType1) SetStatus(value string) error {
+	if err := EntryStatus2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Status = (*EntryStatus2Code)(&value)
+	return nil
 }
 
-func (t *TransactionType1) SetCreditDebitIndicator(value string) {
+func (t *TransactionType1) SetCreditDebitIndicator(value string) error {
+	if err := CreditDebitCode(value).Validate(); err != nil {
+		return err
+	}
 	t.CreditDebitIndicator = (*CreditDebitCode)(&value)
+	return nil
 }
 
 func (t *TransactionType1) AddFloorLimit() *Limit2 {
@@ -10,6 +10,10 @@ type TransactionType1Choice struct {
 	CorporateActionType *CorporateAction1Choice `xml:"CorpActnTp"`
 }
 
+func (t *TransactionType1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionType1Choice) AddTransactionType() *TransactionType2Choice {
 	t.TransactionType = new(TransactionType2Choice)
 	return t.TransactionType
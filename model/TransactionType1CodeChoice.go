@@ -10,6 +10,10 @@ type TransactionType1CodeChoice struct {
 	Unstructured *Max35Text `xml:"Ustrd"`
 }
 
+func (t *TransactionType1CodeChoice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionType1CodeChoice) SetStructured(value string) {
 	t.Structured = (*TransactionType2Code)(&value)
 }
@@ -10,6 +10,10 @@ type TransactionType2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TransactionType2Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionType2Choice) SetType(value string) {
 	t.Type = (*TransactionType2Code)(&value)
 }
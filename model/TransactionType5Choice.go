@@ -10,6 +10,10 @@ type TransactionType5Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TransactionType5Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionType5Choice) SetCode(value string) {
 	t.Code = (*InvestmentFundTransactionType1Code)(&value)
 }
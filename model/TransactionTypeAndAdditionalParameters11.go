@@ -25,6 +25,10 @@ type TransactionTypeAndAdditionalParameters11 struct {
 	PoolIdentification *Max35Text `xml:"PoolId,omitempty"`
 }
 
+func (t *TransactionTypeAndAdditionalParameters11) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionTypeAndAdditionalParameters11) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -37,8 +41,12 @@ func (t *TransactionTypeAndAdditionalParameters11) SetSecuritiesFinancingTransac
 	t.SecuritiesFinancingTransactionType = (*SecuritiesFinancingTransactionType1Code)(&value)
 }
 
-func (t *TransactionTypeAndAdditionalParameters11) SetPayment(value string) {
+func (t *TransactionTypeAndAdditionalParameters11) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionTypeAndAdditionalParameters11) AddModificationType() *RepurchaseType15Choice {
@@ -28,6 +28,10 @@ type TransactionTypeAndAdditionalParameters12 struct {
 	CorporateActionEventIdentification *RestrictedFINXMax16Text `xml:"CorpActnEvtId,omitempty"`
 }
 
+func (t *TransactionTypeAndAdditionalParameters12) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionTypeAndAdditionalParameters12) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -40,12 +44,20 @@ func (t *TransactionTypeAndAdditionalParameters12) SetSecuritiesFinancingTransac
 	t.SecuritiesFinancingTransactionType = (*SecuritiesFinancingTransactionType1Code)(&value)
 }
 
-func (t *TransactionTypeAndAdditionalParameters12) SetSecuritiesMovementType(value string) {
+func (t *TransactionTypeAndAdditionalParameters12) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionTypeAndAdditionalParameters12) SetPayment(value string) {
+func (t *TransactionTypeAndAdditionalParameters12) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionTypeAndAdditionalParameters12) SetCommonIdentification(value string) {
@@ -16,12 +16,20 @@ type TransactionTypeAndAdditionalParameters13 struct {
 	ReconciliationIndicator *YesNoIndicator `xml:"RcncltnInd,omitempty"`
 }
 
+func (t *TransactionTypeAndAdditionalParameters13) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionTypeAndAdditionalParameters13) SetSecuritiesFinancingTransactionType(value string) {
 	t.SecuritiesFinancingTransactionType = (*SecuritiesFinancingTransactionType1Code)(&value)
 }
 
-func (t *TransactionTypeAndAdditionalParameters13) SetPayment(value string) {
+func (t *TransactionTypeAndAdditionalParameters13) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionTypeAndAdditionalParameters13) SetCommonIdentification(value string) {
@@ -31,3 +39,8 @@ func (t *TransactionTypeAndAdditionalParameters13) SetCommonIdentification(value
 func (t *TransactionTypeAndAdditionalParameters13) SetReconciliationIndicator(value string) {
 	t.ReconciliationIndicator = (*YesNoIndicator)(&value)
 }
+
+func (t *TransactionTypeAndAdditionalParameters13) SetReconciliationIndicatorFromBool(value bool) {
+	t.ReconciliationIndicator = new(YesNoIndicator)
+	t.ReconciliationIndicator.FromBool(value)
+}
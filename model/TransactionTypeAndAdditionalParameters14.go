@@ -25,6 +25,10 @@ type TransactionTypeAndAdditionalParameters14 struct {
 	PoolIdentification *RestrictedFINXMax16Text `xml:"PoolId,omitempty"`
 }
 
+func (t *TransactionTypeAndAdditionalParameters14) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionTypeAndAdditionalParameters14) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -37,8 +41,12 @@ func (t *TransactionTypeAndAdditionalParameters14) SetSecuritiesFinancingTransac
 	t.SecuritiesFinancingTransactionType = (*SecuritiesFinancingTransactionType1Code)(&value)
 }
 
-func (t *TransactionTypeAndAdditionalParameters14) SetPayment(value string) {
+func (t *TransactionTypeAndAdditionalParameters14) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionTypeAndAdditionalParameters14) AddModificationType() *RepurchaseType16Choice {
@@ -25,6 +25,10 @@ type TransactionTypeAndAdditionalParameters17 struct {
 	PoolIdentification *Max35Text `xml:"PoolId,omitempty"`
 }
 
+func (t *TransactionTypeAndAdditionalParameters17) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionTypeAndAdditionalParameters17) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -37,8 +41,12 @@ func (t *TransactionTypeAndAdditionalParameters17) SetSecuritiesFinancingTransac
 	t.SecuritiesFinancingTransactionType = (*SecuritiesFinancingTransactionType2Code)(&value)
 }
 
-func (t *TransactionTypeAndAdditionalParameters17) SetPayment(value string) {
+func (t *TransactionTypeAndAdditionalParameters17) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionTypeAndAdditionalParameters17) AddModificationType() *RepurchaseType21Choice {
@@ -28,6 +28,10 @@ type TransactionTypeAndAdditionalParameters19 struct {
 	CorporateActionEventIdentification *RestrictedFINXMax16Text `xml:"CorpActnEvtId,omitempty"`
 }
 
+func (t *TransactionTypeAndAdditionalParameters19) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionTypeAndAdditionalParameters19) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -40,12 +44,20 @@ func (t *TransactionTypeAndAdditionalParameters19) SetSecuritiesFinancingTransac
 	t.SecuritiesFinancingTransactionType = (*SecuritiesFinancingTransactionType2Code)(&value)
 }
 
-func (t *TransactionTypeAndAdditionalParameters19) SetSecuritiesMovementType(value string) {
+func (t *TransactionTypeAndAdditionalParameters19) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionTypeAndAdditionalParameters19) SetPayment(value string) {
+func (t *TransactionTypeAndAdditionalParameters19) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionTypeAndAdditionalParameters19) SetCommonIdentification(value string) {
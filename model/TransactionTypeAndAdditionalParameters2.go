@@ -25,6 +25,10 @@ type TransactionTypeAndAdditionalParameters2 struct {
 	PoolIdentification *Max35Text `xml:"PoolId,omitempty"`
 }
 
+func (t *TransactionTypeAndAdditionalParameters2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionTypeAndAdditionalParameters2) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -37,8 +41,12 @@ func (t *TransactionTypeAndAdditionalParameters2) SetSecuritiesFinancingTransact
 	t.SecuritiesFinancingTransactionType = (*SecuritiesFinancingTransactionType1Code)(&value)
 }
 
-func (t *TransactionTypeAndAdditionalParameters2) SetPayment(value string) {
+func (t *TransactionTypeAndAdditionalParameters2) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionTypeAndAdditionalParameters2) AddModificationType() *RepurchaseType2Choice {
@@ -25,6 +25,10 @@ type TransactionTypeAndAdditionalParameters20 struct {
 	PoolIdentification *RestrictedFINXMax16Text `xml:"PoolId,omitempty"`
 }
 
+func (t *TransactionTypeAndAdditionalParameters20) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionTypeAndAdditionalParameters20) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*RestrictedFINXMax16Text)(&value)
 }
@@ -37,8 +41,12 @@ func (t *TransactionTypeAndAdditionalParameters20) SetSecuritiesFinancingTransac
 	t.SecuritiesFinancingTransactionType = (*SecuritiesFinancingTransactionType2Code)(&value)
 }
 
-func (t *TransactionTypeAndAdditionalParameters20) SetPayment(value string) {
+func (t *TransactionTypeAndAdditionalParameters20) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionTypeAndAdditionalParameters20) AddModificationType() *RepurchaseType31Choice {
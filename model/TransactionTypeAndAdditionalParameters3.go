@@ -28,6 +28,10 @@ type TransactionTypeAndAdditionalParameters3 struct {
 	CorporateActionEventIdentification *Max35Text `xml:"CorpActnEvtId,omitempty"`
 }
 
+func (t *TransactionTypeAndAdditionalParameters3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionTypeAndAdditionalParameters3) SetAccountOwnerTransactionIdentification(value string) {
 	t.AccountOwnerTransactionIdentification = (*Max35Text)(&value)
 }
@@ -40,12 +44,20 @@ func (t *TransactionTypeAndAdditionalParameters3) SetSecuritiesFinancingTransact
 	t.SecuritiesFinancingTransactionType = (*SecuritiesFinancingTransactionType1Code)(&value)
 }
 
-func (t *TransactionTypeAndAdditionalParameters3) SetSecuritiesMovementType(value string) {
+func (t *TransactionTypeAndAdditionalParameters3) SetSecuritiesMovementType(value string) error {
+	if err := ReceiveDelivery1Code(value).Validate(); err != nil {
+		return err
+	}
 	t.SecuritiesMovementType = (*ReceiveDelivery1Code)(&value)
+	return nil
 }
 
-func (t *TransactionTypeAndAdditionalParameters3) SetPayment(value string) {
+func (t *TransactionTypeAndAdditionalParameters3) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionTypeAndAdditionalParameters3) SetCommonIdentification(value string) {
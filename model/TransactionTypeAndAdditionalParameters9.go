@@ -16,12 +16,20 @@ type TransactionTypeAndAdditionalParameters9 struct {
 	ReconciliationIndicator *YesNoIndicator `xml:"RcncltnInd,omitempty"`
 }
 
+func (t *TransactionTypeAndAdditionalParameters9) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionTypeAndAdditionalParameters9) SetSecuritiesFinancingTransactionType(value string) {
 	t.SecuritiesFinancingTransactionType = (*SecuritiesFinancingTransactionType1Code)(&value)
 }
 
-func (t *TransactionTypeAndAdditionalParameters9) SetPayment(value string) {
+func (t *TransactionTypeAndAdditionalParameters9) SetPayment(value string) error {
+	if err := DeliveryReceiptType2Code(value).Validate(); err != nil {
+		return err
+	}
 	t.Payment = (*DeliveryReceiptType2Code)(&value)
+	return nil
 }
 
 func (t *TransactionTypeAndAdditionalParameters9) SetCommonIdentification(value string) {
@@ -31,3 +39,8 @@ func (t *TransactionTypeAndAdditionalParameters9) SetCommonIdentification(value
 func (t *TransactionTypeAndAdditionalParameters9) SetReconciliationIndicator(value string) {
 	t.ReconciliationIndicator = (*YesNoIndicator)(&value)
 }
+
+func (t *TransactionTypeAndAdditionalParameters9) SetReconciliationIndicatorFromBool(value bool) {
+	t.ReconciliationIndicator = new(YesNoIndicator)
+	t.ReconciliationIndicator.FromBool(value)
+}
@@ -16,6 +16,10 @@ type TransactionVerificationResult1 struct {
 	DeclinedProductCode []*Max70Text `xml:"DclndPdctCd,omitempty"`
 }
 
+func (t *TransactionVerificationResult1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionVerificationResult1) SetElectronicCommerceAuthenticationResult(value string) {
 	t.ElectronicCommerceAuthenticationResult = (*Max500Text)(&value)
 }
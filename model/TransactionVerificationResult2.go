@@ -16,6 +16,10 @@ type TransactionVerificationResult2 struct {
 	DeclinedProductCode []*Max70Text `xml:"DclndPdctCd,omitempty"`
 }
 
+func (t *TransactionVerificationResult2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionVerificationResult2) SetElectronicCommerceAuthenticationResult(value string) {
 	t.ElectronicCommerceAuthenticationResult = (*Max500Text)(&value)
 }
@@ -16,6 +16,10 @@ type TransactionVerificationResult3 struct {
 	AdditionalResult *Max500Text `xml:"AddtlRslt,omitempty"`
 }
 
+func (t *TransactionVerificationResult3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionVerificationResult3) SetMethod(value string) {
 	t.Method = (*AuthenticationMethod4Code)(&value)
 }
@@ -16,6 +16,10 @@ type TransactionVerificationResult4 struct {
 	AdditionalResult *Max500Text `xml:"AddtlRslt,omitempty"`
 }
 
+func (t *TransactionVerificationResult4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionVerificationResult4) SetMethod(value string) {
 	t.Method = (*AuthenticationMethod6Code)(&value)
 }
@@ -19,6 +19,10 @@ type TransactionVerificationResult5 struct {
 	AuthenticationToken *Max140Binary `xml:"AuthntcnTkn,omitempty"`
 }
 
+func (t *TransactionVerificationResult5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactionVerificationResult5) SetMethod(value string) {
 	t.Method = (*AuthenticationMethod7Code)(&value)
 }
@@ -7,6 +7,10 @@ type TransactiontIdentification4 struct {
 	TransactionIdentification *Max35Text `xml:"TxId"`
 }
 
+func (t *TransactiontIdentification4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransactiontIdentification4) SetTransactionIdentification(value string) {
 	t.TransactionIdentification = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type Transfer1 struct {
 
@@ -25,6 +29,10 @@ type Transfer1 struct {
 	AveragePrice *ActiveOrHistoricCurrencyAnd13DecimalAmount `xml:"AvrgPric,omitempty"`
 }
 
+func (t *Transfer1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer1) SetTransferReference(value string) {
 	t.TransferReference = (*Max35Text)(&value)
 }
@@ -43,6 +51,11 @@ func (t *Transfer1) SetPortfolioTransferOutRate(value string) {
 	t.PortfolioTransferOutRate = (*PercentageRate)(&value)
 }
 
+func (t *Transfer1) SetPortfolioTransferOutRateFromDecimal(value decimal.Decimal) {
+	t.PortfolioTransferOutRate = new(PercentageRate)
+	t.PortfolioTransferOutRate.FromDecimal(value)
+}
+
 func (t *Transfer1) AddUnitsDetails() *Unit1 {
 	newValue := new(Unit1)
 	t.UnitsDetails = append(t.UnitsDetails, newValue)
@@ -193,6 +206,15 @@ func (t *Transfer1) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer1) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer1) SetAveragePrice(value, currency string) {
 	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (t *Transfer1) SetAveragePriceFromDecimal(value decimal.Decimal, currency string) {
+	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Completion of a securities settlement instruction, wherein securities are delivered/debited from a securities account and received/credited to the designated securities account.
 type Transfer10 struct {
 
@@ -43,6 +47,10 @@ type Transfer10 struct {
 	NonStandardSettlementInformation *Max350Text `xml:"NonStdSttlmInf,omitempty"`
 }
 
+func (t *Transfer10) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer10) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -224,6 +232,11 @@ func (t *Transfer10) SetPortfolioTransferOutRate(value string) {
 	t.PortfolioTransferOutRate = (*PercentageRate)(&value)
 }
 
+func (t *Transfer10) SetPortfolioTransferOutRateFromDecimal(value decimal.Decimal) {
+	t.PortfolioTransferOutRate = new(PercentageRate)
+	t.PortfolioTransferOutRate.FromDecimal(value)
+}
+
 func (t *Transfer10) SetRounding(value string) {
 	t.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -232,10 +245,19 @@ func (t *Transfer10) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer10) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer10) SetAveragePrice(value, currency string) {
 	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Transfer10) SetAveragePriceFromDecimal(value decimal.Decimal, currency string) {
+	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Transfer10) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type Transfer11 struct {
 
@@ -49,6 +55,10 @@ type Transfer11 struct {
 	NonStandardSettlementInformation *Max350Text `xml:"NonStdSttlmInf,omitempty"`
 }
 
+func (t *Transfer11) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer11) SetTransferReference(value string) {
 	t.TransferReference = (*Max35Text)(&value)
 }
@@ -70,6 +80,11 @@ func (t *Transfer11) SetTransferOrderDateForm(value string) {
 	t.TransferOrderDateForm = (*ISODate)(&value)
 }
 
+func (t *Transfer11) SetTransferOrderDateFormFromTime(value time.Time) {
+	t.TransferOrderDateForm = new(ISODate)
+	t.TransferOrderDateForm.FromTime(value)
+}
+
 func (t *Transfer11) AddTransferReason() *TransferReason1 {
 	t.TransferReason = new(TransferReason1)
 	return t.TransferReason
@@ -243,6 +258,10 @@ func (t *Transfer11) SetAveragePrice(value, currency string) {
 	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Transfer11) SetAveragePriceFromDecimal(value decimal.Decimal, currency string) {
+	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Transfer11) SetTransferCurrency(value string) {
 	t.TransferCurrency = (*CurrencyCode)(&value)
 }
@@ -251,6 +270,11 @@ func (t *Transfer11) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer11) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer11) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type Transfer12 struct {
 
@@ -28,6 +32,10 @@ type Transfer12 struct {
 	NonStandardSettlementInformation *Max350Text `xml:"NonStdSttlmInf,omitempty"`
 }
 
+func (t *Transfer12) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer12) AddHoldingsPlanType(value string) {
 	t.HoldingsPlanType = append(t.HoldingsPlanType, (*HoldingsPlanType1Code)(&value))
 }
@@ -196,10 +204,19 @@ func (t *Transfer12) SetAveragePrice(value, currency string) {
 	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Transfer12) SetAveragePriceFromDecimal(value decimal.Decimal, currency string) {
+	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Transfer12) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer12) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer12) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Completion of a securities settlement instruction, wherein securities are delivered/debited from a securities account and received/credited to the designated securities account.
 type Transfer13 struct {
 
@@ -58,6 +64,10 @@ type Transfer13 struct {
 	NonStandardSettlementInformation *Max350Text `xml:"NonStdSttlmInf,omitempty"`
 }
 
+func (t *Transfer13) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer13) SetTransferConfirmationReference(value string) {
 	t.TransferConfirmationReference = (*Max35Text)(&value)
 }
@@ -89,6 +99,11 @@ func (t *Transfer13) SetTransferOrderDateForm(value string) {
 	t.TransferOrderDateForm = (*ISODate)(&value)
 }
 
+func (t *Transfer13) SetTransferOrderDateFormFromTime(value time.Time) {
+	t.TransferOrderDateForm = new(ISODate)
+	t.TransferOrderDateForm.FromTime(value)
+}
+
 func (t *Transfer13) AddTransferReason() *TransferReason1 {
 	t.TransferReason = new(TransferReason1)
 	return t.TransferReason
@@ -258,6 +273,11 @@ func (t *Transfer13) SetPortfolioTransferOutRate(value string) {
 	t.PortfolioTransferOutRate = (*PercentageRate)(&value)
 }
 
+func (t *Transfer13) SetPortfolioTransferOutRateFromDecimal(value decimal.Decimal) {
+	t.PortfolioTransferOutRate = new(PercentageRate)
+	t.PortfolioTransferOutRate.FromDecimal(value)
+}
+
 func (t *Transfer13) SetRounding(value string) {
 	t.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -266,6 +286,10 @@ func (t *Transfer13) SetAveragePrice(value, currency string) {
 	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Transfer13) SetAveragePriceFromDecimal(value decimal.Decimal, currency string) {
+	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Transfer13) SetTransferCurrency(value string) {
 	t.TransferCurrency = (*CurrencyCode)(&value)
 }
@@ -274,6 +298,11 @@ func (t *Transfer13) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer13) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer13) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
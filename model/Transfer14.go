@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Completion of a securities settlement instruction, wherein securities are delivered/debited from a securities account and received/credited to the designated securities account.
 type Transfer14 struct {
 
@@ -37,6 +41,10 @@ type Transfer14 struct {
 	NonStandardSettlementInformation *Max350Text `xml:"NonStdSttlmInf,omitempty"`
 }
 
+func (t *Transfer14) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer14) AddEffectiveTransferDate() *DateAndDateTimeChoice {
 	t.EffectiveTransferDate = new(DateAndDateTimeChoice)
 	return t.EffectiveTransferDate
@@ -211,6 +219,11 @@ func (t *Transfer14) SetPortfolioTransferOutRate(value string) {
 	t.PortfolioTransferOutRate = (*PercentageRate)(&value)
 }
 
+func (t *Transfer14) SetPortfolioTransferOutRateFromDecimal(value decimal.Decimal) {
+	t.PortfolioTransferOutRate = new(PercentageRate)
+	t.PortfolioTransferOutRate.FromDecimal(value)
+}
+
 func (t *Transfer14) SetRounding(value string) {
 	t.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -219,10 +232,19 @@ func (t *Transfer14) SetAveragePrice(value, currency string) {
 	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Transfer14) SetAveragePriceFromDecimal(value decimal.Decimal, currency string) {
+	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Transfer14) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer14) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer14) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
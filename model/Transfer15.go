@@ -37,6 +37,10 @@ type Transfer15 struct {
 	NonStandardSettlementInformation *Max350Text `xml:"NonStdSttlmInf,omitempty"`
 }
 
+func (t *Transfer15) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer15) SetTransferReference(value string) {
 	t.TransferReference = (*Max35Text)(&value)
 }
@@ -82,6 +86,11 @@ func (t *Transfer15) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer15) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer15) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
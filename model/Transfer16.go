@@ -22,6 +22,10 @@ type Transfer16 struct {
 	NonStandardSettlementInformation *Max350Text `xml:"NonStdSttlmInf,omitempty"`
 }
 
+func (t *Transfer16) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer16) AddTransferDate() *DateFormat1Choice {
 	t.TransferDate = new(DateFormat1Choice)
 	return t.TransferDate
@@ -45,6 +49,11 @@ func (t *Transfer16) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer16) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer16) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Completion of a securities settlement instruction, wherein securities are delivered/debited from a securities account and received/credited to the designated securities account.
 type Transfer18 struct {
 
@@ -52,6 +58,10 @@ type Transfer18 struct {
 	NonStandardSettlementInformation *Max350Text `xml:"NonStdSttlmInf,omitempty"`
 }
 
+func (t *Transfer18) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer18) SetTransferConfirmationReference(value string) {
 	t.TransferConfirmationReference = (*Max35Text)(&value)
 }
@@ -83,6 +93,11 @@ func (t *Transfer18) SetTransferOrderDateForm(value string) {
 	t.TransferOrderDateForm = (*ISODate)(&value)
 }
 
+func (t *Transfer18) SetTransferOrderDateFormFromTime(value time.Time) {
+	t.TransferOrderDateForm = new(ISODate)
+	t.TransferOrderDateForm.FromTime(value)
+}
+
 func (t *Transfer18) AddTransferReason() *TransferReason1 {
 	t.TransferReason = new(TransferReason1)
 	return t.TransferReason
@@ -252,6 +267,10 @@ func (t *Transfer18) SetAveragePrice(value, currency string) {
 	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Transfer18) SetAveragePriceFromDecimal(value decimal.Decimal, currency string) {
+	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Transfer18) SetTransferCurrency(value string) {
 	t.TransferCurrency = (*CurrencyCode)(&value)
 }
@@ -260,6 +279,11 @@ func (t *Transfer18) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer18) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer18) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
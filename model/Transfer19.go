@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type Transfer19 struct {
 
@@ -58,6 +64,10 @@ type Transfer19 struct {
 	DeliveringAgentDetails *PartyIdentificationAndAccount93 `xml:"DlvrgAgtDtls,omitempty"`
 }
 
+func (t *Transfer19) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer19) SetTransferReference(value string) {
 	t.TransferReference = (*Max35Text)(&value)
 }
@@ -79,10 +89,20 @@ func (t *Transfer19) SetRequestedSettlementDate(value string) {
 	t.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (t *Transfer19) SetRequestedSettlementDateFromTime(value time.Time) {
+	t.RequestedSettlementDate = new(ISODate)
+	t.RequestedSettlementDate.FromTime(value)
+}
+
 func (t *Transfer19) SetTransferOrderDateForm(value string) {
 	t.TransferOrderDateForm = (*ISODate)(&value)
 }
 
+func (t *Transfer19) SetTransferOrderDateFormFromTime(value time.Time) {
+	t.TransferOrderDateForm = new(ISODate)
+	t.TransferOrderDateForm.FromTime(value)
+}
+
 func (t *Transfer19) AddTransferReason() *TransferReason1 {
 	t.TransferReason = new(TransferReason1)
 	return t.TransferReason
@@ -256,6 +276,10 @@ func (t *Transfer19) SetAveragePrice(value, currency string) {
 	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Transfer19) SetAveragePriceFromDecimal(value decimal.Decimal, currency string) {
+	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Transfer19) SetTransferCurrency(value string) {
 	t.TransferCurrency = (*CurrencyCode)(&value)
 }
@@ -264,6 +288,11 @@ func (t *Transfer19) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer19) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer19) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Completion of a securities settlement instruction, wherein securities are delivered/debited from a securities account and received/credited to the designated securities account.
 type Transfer2 struct {
 
@@ -31,6 +37,10 @@ type Transfer2 struct {
 	AveragePrice *ActiveOrHistoricCurrencyAnd13DecimalAmount `xml:"AvrgPric,omitempty"`
 }
 
+func (t *Transfer2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer2) SetTransferConfirmationReference(value string) {
 	t.TransferConfirmationReference = (*Max35Text)(&value)
 }
@@ -48,6 +58,11 @@ func (t *Transfer2) SetTradeDate(value string) {
 	t.TradeDate = (*ISODate)(&value)
 }
 
+func (t *Transfer2) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODate)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *Transfer2) AddTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	t.TotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return t.TotalUnitsNumber
@@ -203,10 +218,24 @@ func (t *Transfer2) SetPortfolioTransferOutRate(value string) {
 	t.PortfolioTransferOutRate = (*PercentageRate)(&value)
 }
 
+func (t *Transfer2) SetPortfolioTransferOutRateFromDecimal(value decimal.Decimal) {
+	t.PortfolioTransferOutRate = new(PercentageRate)
+	t.PortfolioTransferOutRate.FromDecimal(value)
+}
+
 func (t *Transfer2) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer2) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer2) SetAveragePrice(value, currency string) {
 	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (t *Transfer2) SetAveragePriceFromDecimal(value decimal.Decimal, currency string) {
+	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
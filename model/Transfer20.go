@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type Transfer20 struct {
 
@@ -37,10 +43,19 @@ type Transfer20 struct {
 	DeliveringAgentDetails *PartyIdentificationAndAccount93 `xml:"DlvrgAgtDtls,omitempty"`
 }
 
+func (t *Transfer20) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer20) SetRequestedSettlementDate(value string) {
 	t.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (t *Transfer20) SetRequestedSettlementDateFromTime(value time.Time) {
+	t.RequestedSettlementDate = new(ISODate)
+	t.RequestedSettlementDate.FromTime(value)
+}
+
 func (t *Transfer20) AddHoldingsPlanType(value string) {
 	t.HoldingsPlanType = append(t.HoldingsPlanType, (*HoldingsPlanType1Code)(&value))
 }
@@ -209,10 +224,19 @@ func (t *Transfer20) SetAveragePrice(value, currency string) {
 	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Transfer20) SetAveragePriceFromDecimal(value decimal.Decimal, currency string) {
+	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Transfer20) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer20) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer20) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type Transfer21 struct {
 
@@ -46,6 +50,10 @@ type Transfer21 struct {
 	DeliveringAgentDetails *PartyIdentificationAndAccount93 `xml:"DlvrgAgtDtls,omitempty"`
 }
 
+func (t *Transfer21) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer21) SetTransferReference(value string) {
 	t.TransferReference = (*Max35Text)(&value)
 }
@@ -77,6 +85,11 @@ func (t *Transfer21) SetRequestedSettlementDate(value string) {
 	t.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (t *Transfer21) SetRequestedSettlementDateFromTime(value time.Time) {
+	t.RequestedSettlementDate = new(ISODate)
+	t.RequestedSettlementDate.FromTime(value)
+}
+
 func (t *Transfer21) AddHoldingsPlanType(value string) {
 	t.HoldingsPlanType = append(t.HoldingsPlanType, (*HoldingsPlanType1Code)(&value))
 }
@@ -95,6 +108,11 @@ func (t *Transfer21) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer21) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer21) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
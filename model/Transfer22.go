@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type Transfer22 struct {
 
@@ -31,6 +35,10 @@ type Transfer22 struct {
 	DeliveringAgentDetails *PartyIdentificationAndAccount93 `xml:"DlvrgAgtDtls,omitempty"`
 }
 
+func (t *Transfer22) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer22) AddTransferDate() *DateFormat1Choice {
 	t.TransferDate = new(DateFormat1Choice)
 	return t.TransferDate
@@ -40,6 +48,11 @@ func (t *Transfer22) SetRequestedSettlementDate(value string) {
 	t.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (t *Transfer22) SetRequestedSettlementDateFromTime(value time.Time) {
+	t.RequestedSettlementDate = new(ISODate)
+	t.RequestedSettlementDate.FromTime(value)
+}
+
 func (t *Transfer22) AddHoldingsPlanType(value string) {
 	t.HoldingsPlanType = append(t.HoldingsPlanType, (*HoldingsPlanType1Code)(&value))
 }
@@ -58,6 +71,11 @@ func (t *Transfer22) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer22) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer22) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
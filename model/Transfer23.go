@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Completion of a securities settlement instruction, wherein securities are delivered/debited from a securities account and received/credited to the designated securities account.
 type Transfer23 struct {
 
@@ -70,6 +76,10 @@ type Transfer23 struct {
 	DeliveringAgentDetails *PartyIdentificationAndAccount93 `xml:"DlvrgAgtDtls,omitempty"`
 }
 
+func (t *Transfer23) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer23) SetTransferConfirmationReference(value string) {
 	t.TransferConfirmationReference = (*Max35Text)(&value)
 }
@@ -96,6 +106,11 @@ func (t *Transfer23) SetRequestedSettlementDate(value string) {
 	t.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (t *Transfer23) SetRequestedSettlementDateFromTime(value time.Time) {
+	t.RequestedSettlementDate = new(ISODate)
+	t.RequestedSettlementDate.FromTime(value)
+}
+
 func (t *Transfer23) AddEffectiveSettlementDate() *DateAndDateTimeChoice {
 	t.EffectiveSettlementDate = new(DateAndDateTimeChoice)
 	return t.EffectiveSettlementDate
@@ -110,6 +125,11 @@ func (t *Transfer23) SetTransferOrderDateForm(value string) {
 	t.TransferOrderDateForm = (*ISODate)(&value)
 }
 
+func (t *Transfer23) SetTransferOrderDateFormFromTime(value time.Time) {
+	t.TransferOrderDateForm = new(ISODate)
+	t.TransferOrderDateForm.FromTime(value)
+}
+
 func (t *Transfer23) AddTransferReason() *TransferReason1 {
 	t.TransferReason = new(TransferReason1)
 	return t.TransferReason
@@ -279,6 +299,11 @@ func (t *Transfer23) SetPortfolioTransferOutRate(value string) {
 	t.PortfolioTransferOutRate = (*PercentageRate)(&value)
 }
 
+func (t *Transfer23) SetPortfolioTransferOutRateFromDecimal(value decimal.Decimal) {
+	t.PortfolioTransferOutRate = new(PercentageRate)
+	t.PortfolioTransferOutRate.FromDecimal(value)
+}
+
 func (t *Transfer23) SetRounding(value string) {
 	t.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -287,6 +312,10 @@ func (t *Transfer23) SetAveragePrice(value, currency string) {
 	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Transfer23) SetAveragePriceFromDecimal(value decimal.Decimal, currency string) {
+	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Transfer23) SetTransferCurrency(value string) {
 	t.TransferCurrency = (*CurrencyCode)(&value)
 }
@@ -295,6 +324,11 @@ func (t *Transfer23) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer23) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer23) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
@@ -16,6 +16,10 @@ type Transfer3 struct {
 	OwnAccountTransferIndicator *YesNoIndicator `xml:"OwnAcctTrfInd"`
 }
 
+func (t *Transfer3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer3) SetTransferReference(value string) {
 	t.TransferReference = (*Max35Text)(&value)
 }
@@ -33,3 +37,8 @@ func (t *Transfer3) AddTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 func (t *Transfer3) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
+
+func (t *Transfer3) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type Transfer31 struct {
 
@@ -85,6 +91,10 @@ type Transfer31 struct {
 	TransferExpensesPaymentType *ChargePaymentMethod1Choice `xml:"TrfExpnssPmtTp,omitempty"`
 }
 
+func (t *Transfer31) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer31) SetTransferConfirmationReference(value string) {
 	t.TransferConfirmationReference = (*Max35Text)(&value)
 }
@@ -116,6 +126,11 @@ func (t *Transfer31) SetRequestedSettlementDate(value string) {
 	t.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (t *Transfer31) SetRequestedSettlementDateFromTime(value time.Time) {
+	t.RequestedSettlementDate = new(ISODate)
+	t.RequestedSettlementDate.FromTime(value)
+}
+
 func (t *Transfer31) AddEffectiveSettlementDate() *DateAndDateTimeChoice {
 	t.EffectiveSettlementDate = new(DateAndDateTimeChoice)
 	return t.EffectiveSettlementDate
@@ -130,6 +145,11 @@ func (t *Transfer31) SetTransferOrderDateForm(value string) {
 	t.TransferOrderDateForm = (*ISODate)(&value)
 }
 
+func (t *Transfer31) SetTransferOrderDateFormFromTime(value time.Time) {
+	t.TransferOrderDateForm = new(ISODate)
+	t.TransferOrderDateForm.FromTime(value)
+}
+
 func (t *Transfer31) AddTransferReason() *TransferReason1 {
 	t.TransferReason = new(TransferReason1)
 	return t.TransferReason
@@ -299,6 +319,11 @@ func (t *Transfer31) SetPortfolioTransferOutRate(value string) {
 	t.PortfolioTransferOutRate = (*PercentageRate)(&value)
 }
 
+func (t *Transfer31) SetPortfolioTransferOutRateFromDecimal(value decimal.Decimal) {
+	t.PortfolioTransferOutRate = new(PercentageRate)
+	t.PortfolioTransferOutRate.FromDecimal(value)
+}
+
 func (t *Transfer31) SetRounding(value string) {
 	t.Rounding = (*RoundingDirection2Code)(&value)
 }
@@ -307,18 +332,36 @@ func (t *Transfer31) SetAveragePrice(value, currency string) {
 	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Transfer31) SetAveragePriceFromDecimal(value decimal.Decimal, currency string) {
+	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Transfer31) SetNewAveragePrice(value, currency string) {
 	t.NewAveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Transfer31) SetNewAveragePriceFromDecimal(value decimal.Decimal, currency string) {
+	t.NewAveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Transfer31) SetAverageDate(value string) {
 	t.AverageDate = (*ISODate)(&value)
 }
 
+func (t *Transfer31) SetAverageDateFromTime(value time.Time) {
+	t.AverageDate = new(ISODate)
+	t.AverageDate.FromTime(value)
+}
+
 func (t *Transfer31) SetNewAverageDate(value string) {
 	t.NewAverageDate = (*ISODate)(&value)
 }
 
+func (t *Transfer31) SetNewAverageDateFromTime(value time.Time) {
+	t.NewAverageDate = new(ISODate)
+	t.NewAverageDate.FromTime(value)
+}
+
 func (t *Transfer31) SetTransferCurrency(value string) {
 	t.TransferCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -327,6 +370,11 @@ func (t *Transfer31) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer31) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer31) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
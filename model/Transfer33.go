@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Parameters applied to the settlement of a security transfer.
 type Transfer33 struct {
 
@@ -79,6 +85,10 @@ type Transfer33 struct {
 	TransferExpensesPaymentType *ChargePaymentMethod1Choice `xml:"TrfExpnssPmtTp,omitempty"`
 }
 
+func (t *Transfer33) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer33) SetTransferConfirmationReference(value string) {
 	t.TransferConfirmationReference = (*Max35Text)(&value)
 }
@@ -110,6 +120,11 @@ func (t *Transfer33) SetRequestedSettlementDate(value string) {
 	t.RequestedSettlementDate = (*ISODate)(&value)
 }
 
+func (t *Transfer33) SetRequestedSettlementDateFromTime(value time.Time) {
+	t.RequestedSettlementDate = new(ISODate)
+	t.RequestedSettlementDate.FromTime(value)
+}
+
 func (t *Transfer33) AddEffectiveSettlementDate() *DateAndDateTimeChoice {
 	t.EffectiveSettlementDate = new(DateAndDateTimeChoice)
 	return t.EffectiveSettlementDate
@@ -124,6 +139,11 @@ func (t *Transfer33) SetTransferOrderDateForm(value string) {
 	t.TransferOrderDateForm = (*ISODate)(&value)
 }
 
+func (t *Transfer33) SetTransferOrderDateFormFromTime(value time.Time) {
+	t.TransferOrderDateForm = new(ISODate)
+	t.TransferOrderDateForm.FromTime(value)
+}
+
 func (t *Transfer33) AddTransferReason() *TransferReason1 {
 	t.TransferReason = new(TransferReason1)
 	return t.TransferReason
@@ -293,18 +313,36 @@ func (t *Transfer33) SetAveragePrice(value, currency string) {
 	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Transfer33) SetAveragePriceFromDecimal(value decimal.Decimal, currency string) {
+	t.AveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Transfer33) SetNewAveragePrice(value, currency string) {
 	t.NewAveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (t *Transfer33) SetNewAveragePriceFromDecimal(value decimal.Decimal, currency string) {
+	t.NewAveragePrice = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (t *Transfer33) SetAverageDate(value string) {
 	t.AverageDate = (*ISODate)(&value)
 }
 
+func (t *Transfer33) SetAverageDateFromTime(value time.Time) {
+	t.AverageDate = new(ISODate)
+	t.AverageDate.FromTime(value)
+}
+
 func (t *Transfer33) SetNewAverageDate(value string) {
 	t.NewAverageDate = (*ISODate)(&value)
 }
 
+func (t *Transfer33) SetNewAverageDateFromTime(value time.Time) {
+	t.NewAverageDate = new(ISODate)
+	t.NewAverageDate.FromTime(value)
+}
+
 func (t *Transfer33) SetTransferCurrency(value string) {
 	t.TransferCurrency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -313,6 +351,11 @@ func (t *Transfer33) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer33) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer33) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
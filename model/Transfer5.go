@@ -25,6 +25,10 @@ type Transfer5 struct {
 	NonStandardSettlementInformation *Max350Text `xml:"NonStdSttlmInf,omitempty"`
 }
 
+func (t *Transfer5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer5) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -51,6 +55,11 @@ func (t *Transfer5) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer5) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer5) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
@@ -28,6 +28,10 @@ type Transfer6 struct {
 	NonStandardSettlementInformation *Max350Text `xml:"NonStdSttlmInf,omitempty"`
 }
 
+func (t *Transfer6) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Transfer6) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -58,6 +62,11 @@ func (t *Transfer6) SetOwnAccountTransferIndicator(value string) {
 	t.OwnAccountTransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (t *Transfer6) SetOwnAccountTransferIndicatorFromBool(value bool) {
+	t.OwnAccountTransferIndicator = new(YesNoIndicator)
+	t.OwnAccountTransferIndicator.FromBool(value)
+}
+
 func (t *Transfer6) SetNonStandardSettlementInformation(value string) {
 	t.NonStandardSettlementInformation = (*Max350Text)(&value)
 }
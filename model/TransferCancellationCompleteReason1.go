@@ -10,6 +10,10 @@ type TransferCancellationCompleteReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TransferCancellationCompleteReason1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferCancellationCompleteReason1) SetStructured(value string) {
 	t.Structured = (*CancellationCompleteStatusReason1Code)(&value)
 }
@@ -13,6 +13,10 @@ type TransferCancellationCompleteStatusAndReason1 struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
+func (t *TransferCancellationCompleteStatusAndReason1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferCancellationCompleteStatusAndReason1) SetReason(value string) {
 	t.Reason = (*CancelledStatusReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TransferCancellationCompleteStatusChoice struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
+func (t *TransferCancellationCompleteStatusChoice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferCancellationCompleteStatusChoice) AddReason() *TransferCancellationCompleteReason1 {
 	t.Reason = new(TransferCancellationCompleteReason1)
 	return t.Reason
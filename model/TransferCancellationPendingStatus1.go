@@ -7,6 +7,10 @@ type TransferCancellationPendingStatus1 struct {
 	Reason *Max350Text `xml:"Rsn,omitempty"`
 }
 
+func (t *TransferCancellationPendingStatus1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferCancellationPendingStatus1) SetReason(value string) {
 	t.Reason = (*Max350Text)(&value)
 }
@@ -13,6 +13,10 @@ type TransferCancellationRejectedStatus1 struct {
 	DataSourceScheme []*GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
+func (t *TransferCancellationRejectedStatus1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferCancellationRejectedStatus1) SetReason(value string) {
 	t.Reason = (*CancellationRejectedReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type TransferCancellationRejectedStatus1Choice struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
+func (t *TransferCancellationRejectedStatus1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferCancellationRejectedStatus1Choice) AddReason() *TransferCancellationRejectionReason1 {
 	t.Reason = new(TransferCancellationRejectionReason1)
 	return t.Reason
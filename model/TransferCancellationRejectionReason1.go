@@ -10,6 +10,10 @@ type TransferCancellationRejectionReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TransferCancellationRejectionReason1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferCancellationRejectionReason1) SetStructured(value string) {
 	t.Structured = (*CancellationRejectedReason1Code)(&value)
 }
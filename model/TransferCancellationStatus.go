@@ -10,6 +10,10 @@ type TransferCancellationStatus struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TransferCancellationStatus) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferCancellationStatus) SetStatus(value string) {
 	t.Status = (*CancellationStatus1Code)(&value)
 }
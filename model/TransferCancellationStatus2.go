@@ -10,6 +10,10 @@ type TransferCancellationStatus2 struct {
 	Reason *Max350Text `xml:"Rsn,omitempty"`
 }
 
+func (t *TransferCancellationStatus2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferCancellationStatus2) SetStatus(value string) {
 	t.Status = (*CancellationStatus2Code)(&value)
 }
@@ -19,6 +19,10 @@ type TransferIn1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferIn1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferIn1) AddTransferDetails() *Transfer4 {
 	t.TransferDetails = new(Transfer4)
 	return t.TransferDetails
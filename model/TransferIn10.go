@@ -22,6 +22,10 @@ type TransferIn10 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferIn10) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferIn10) AddRequestedTransferDate() *DateFormat1Choice {
 	t.RequestedTransferDate = new(DateFormat1Choice)
 	return t.RequestedTransferDate
@@ -10,6 +10,10 @@ type TransferIn11 struct {
 	TransferDetails []*Transfer21 `xml:"TrfDtls"`
 }
 
+func (t *TransferIn11) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferIn11) SetCancellationReference(value string) {
 	t.CancellationReference = (*Max35Text)(&value)
 }
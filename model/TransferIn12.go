@@ -19,6 +19,10 @@ type TransferIn12 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferIn12) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferIn12) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type TransferIn14 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferIn14) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferIn14) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type TransferIn16 struct {
 	TransferDetails []*Transfer32 `xml:"TrfDtls"`
 }
 
+func (t *TransferIn16) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferIn16) SetCancellationReference(value string) {
 	t.CancellationReference = (*Max35Text)(&value)
 }
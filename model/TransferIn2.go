@@ -19,6 +19,10 @@ type TransferIn2 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferIn2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferIn2) AddTransferDetails() *Transfer3 {
 	t.TransferDetails = new(Transfer3)
 	return t.TransferDetails
@@ -19,6 +19,10 @@ type TransferIn3 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferIn3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferIn3) AddTransferDetails() *Transfer6 {
 	t.TransferDetails = new(Transfer6)
 	return t.TransferDetails
@@ -19,6 +19,10 @@ type TransferIn4 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferIn4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferIn4) AddTransferDetails() *Transfer7 {
 	t.TransferDetails = new(Transfer7)
 	return t.TransferDetails
@@ -16,6 +16,10 @@ type TransferIn5 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferIn5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferIn5) AddTransferDetails() *Transfer16 {
 	newValue := new(Transfer16)
 	t.TransferDetails = append(t.TransferDetails, newValue)
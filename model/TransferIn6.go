@@ -16,6 +16,10 @@ type TransferIn6 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferIn6) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferIn6) AddTransferDetails() *Transfer17 {
 	newValue := new(Transfer17)
 	t.TransferDetails = append(t.TransferDetails, newValue)
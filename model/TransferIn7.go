@@ -16,6 +16,10 @@ type TransferIn7 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferIn7) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferIn7) AddTransferDetails() *Transfer22 {
 	newValue := new(Transfer22)
 	t.TransferDetails = append(t.TransferDetails, newValue)
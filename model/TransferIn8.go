@@ -16,6 +16,10 @@ type TransferIn8 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferIn8) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferIn8) AddTransferDetails() *Transfer25 {
 	newValue := new(Transfer25)
 	t.TransferDetails = append(t.TransferDetails, newValue)
@@ -19,6 +19,10 @@ type TransferIn9 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferIn9) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferIn9) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type TransferInstructionStatus struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TransferInstructionStatus) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferInstructionStatus) SetStatus(value string) {
 	t.Status = (*TransferStatus1Code)(&value)
 }
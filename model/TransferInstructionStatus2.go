@@ -10,6 +10,10 @@ type TransferInstructionStatus2 struct {
 	Reason *Max350Text `xml:"Rsn,omitempty"`
 }
 
+func (t *TransferInstructionStatus2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferInstructionStatus2) SetStatus(value string) {
 	t.Status = (*TransferStatus2Code)(&value)
 }
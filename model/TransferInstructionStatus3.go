@@ -10,6 +10,10 @@ type TransferInstructionStatus3 struct {
 	Reason *Max350Text `xml:"Rsn,omitempty"`
 }
 
+func (t *TransferInstructionStatus3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferInstructionStatus3) SetStatus(value string) {
 	t.Status = (*TransferStatus3Code)(&value)
 }
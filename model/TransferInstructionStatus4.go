@@ -10,6 +10,10 @@ type TransferInstructionStatus4 struct {
 	Reason *Max350Text `xml:"Rsn,omitempty"`
 }
 
+func (t *TransferInstructionStatus4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferInstructionStatus4) SetStatus(value string) {
 	t.Status = (*TransferStatus4Code)(&value)
 }
@@ -19,6 +19,10 @@ type TransferOut1 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferOut1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferOut1) AddTransferDetails() *Transfer2 {
 	t.TransferDetails = new(Transfer2)
 	return t.TransferDetails
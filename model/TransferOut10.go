@@ -16,6 +16,10 @@ type TransferOut10 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferOut10) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferOut10) AddTransferDetails() *Transfer24 {
 	newValue := new(Transfer24)
 	t.TransferDetails = append(t.TransferDetails, newValue)
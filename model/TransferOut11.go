@@ -22,6 +22,10 @@ type TransferOut11 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferOut11) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferOut11) AddRequestedTransferDate() *DateFormat1Choice {
 	t.RequestedTransferDate = new(DateFormat1Choice)
 	return t.RequestedTransferDate
@@ -19,6 +19,10 @@ type TransferOut12 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferOut12) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferOut12) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
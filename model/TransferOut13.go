@@ -10,6 +10,10 @@ type TransferOut13 struct {
 	TransferDetails []*Transfer27 `xml:"TrfDtls"`
 }
 
+func (t *TransferOut13) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferOut13) SetCancellationReference(value string) {
 	t.CancellationReference = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type TransferOut14 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferOut14) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferOut14) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
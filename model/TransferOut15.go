@@ -22,6 +22,10 @@ type TransferOut15 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferOut15) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferOut15) AddRequestedTransferDate() *DateFormat1Choice {
 	t.RequestedTransferDate = new(DateFormat1Choice)
 	return t.RequestedTransferDate
@@ -19,6 +19,10 @@ type TransferOut16 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferOut16) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferOut16) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -22,6 +22,10 @@ type TransferOut17 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferOut17) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferOut17) AddRequestedTransferDate() *DateFormat1Choice {
 	t.RequestedTransferDate = new(DateFormat1Choice)
 	return t.RequestedTransferDate
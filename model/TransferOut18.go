@@ -10,6 +10,10 @@ type TransferOut18 struct {
 	TransferDetails []*Transfer30 `xml:"TrfDtls"`
 }
 
+func (t *TransferOut18) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferOut18) SetCancellationReference(value string) {
 	t.CancellationReference = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type TransferOut2 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferOut2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferOut2) AddTransferDetails() *Transfer1 {
 	t.TransferDetails = new(Transfer1)
 	return t.TransferDetails
@@ -19,6 +19,10 @@ type TransferOut5 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferOut5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferOut5) AddTransferDetails() *Transfer9 {
 	t.TransferDetails = new(Transfer9)
 	return t.TransferDetails
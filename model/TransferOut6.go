@@ -19,6 +19,10 @@ type TransferOut6 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferOut6) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferOut6) AddTransferDetails() *Transfer10 {
 	t.TransferDetails = new(Transfer10)
 	return t.TransferDetails
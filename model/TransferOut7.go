@@ -19,6 +19,10 @@ type TransferOut7 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferOut7) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferOut7) AddRequestedTransferDate() *DateFormat1Choice {
 	t.RequestedTransferDate = new(DateFormat1Choice)
 	return t.RequestedTransferDate
@@ -16,6 +16,10 @@ type TransferOut8 struct {
 	Extension []*Extension1 `xml:"Xtnsn,omitempty"`
 }
 
+func (t *TransferOut8) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferOut8) AddTransferDetails() *Transfer14 {
 	newValue := new(Transfer14)
 	t.TransferDetails = append(t.TransferDetails, newValue)
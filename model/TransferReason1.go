@@ -10,6 +10,10 @@ type TransferReason1 struct {
 	Proprietary *GenericIdentification27 `xml:"Prtry"`
 }
 
+func (t *TransferReason1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferReason1) SetCode(value string) {
 	t.Code = (*TransferReason1Code)(&value)
 }
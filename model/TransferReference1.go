@@ -16,6 +16,10 @@ type TransferReference1 struct {
 	CancellationReference *Max35Text `xml:"CxlRef,omitempty"`
 }
 
+func (t *TransferReference1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferReference1) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type TransferReference10 struct {
 	CounterpartyReference *AdditionalReference7 `xml:"CtrPtyRef,omitempty"`
 }
 
+func (t *TransferReference10) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferReference10) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type TransferReference2 struct {
 	TransferConfirmationReference *Max35Text `xml:"TrfConfRef,omitempty"`
 }
 
+func (t *TransferReference2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferReference2) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
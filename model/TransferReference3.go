@@ -10,6 +10,10 @@ type TransferReference3 struct {
 	TransferReferences []*TransferReference4 `xml:"TrfRefs"`
 }
 
+func (t *TransferReference3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferReference3) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type TransferReference4 struct {
 	CancellationReference *Max35Text `xml:"CxlRef,omitempty"`
 }
 
+func (t *TransferReference4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferReference4) SetTransferReference(value string) {
 	t.TransferReference = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type TransferReference5 struct {
 	CancellationReference *Max35Text `xml:"CxlRef,omitempty"`
 }
 
+func (t *TransferReference5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferReference5) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -19,6 +19,10 @@ type TransferReference6 struct {
 	CounterpartyReference *AdditionalReference2 `xml:"CtrPtyRef,omitempty"`
 }
 
+func (t *TransferReference6) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferReference6) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
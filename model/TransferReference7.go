@@ -10,6 +10,10 @@ type TransferReference7 struct {
 	TransferReferences []*TransferReference8 `xml:"TrfRefs"`
 }
 
+func (t *TransferReference7) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferReference7) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type TransferReference8 struct {
 	TransferConfirmationReference *Max35Text `xml:"TrfConfRef,omitempty"`
 }
 
+func (t *TransferReference8) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferReference8) SetTransferReference(value string) {
 	t.TransferReference = (*Max35Text)(&value)
 }
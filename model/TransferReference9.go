@@ -19,6 +19,10 @@ type TransferReference9 struct {
 	CancellationReference *Max35Text `xml:"CxlRef,omitempty"`
 }
 
+func (t *TransferReference9) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferReference9) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -31,6 +31,10 @@ type TransferStatus1Choice struct {
 	CancellationPending *CancellationPendingStatus1 `xml:"CxlPdg"`
 }
 
+func (t *TransferStatus1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferStatus1Choice) AddStatus() *TransferInstructionStatus3 {
 	t.Status = new(TransferInstructionStatus3)
 	return t.Status
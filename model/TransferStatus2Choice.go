@@ -31,6 +31,10 @@ type TransferStatus2Choice struct {
 	CancellationPending *CancellationPendingStatus7Choice `xml:"CxlPdg"`
 }
 
+func (t *TransferStatus2Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferStatus2Choice) AddStatus() *TransferInstructionStatus4 {
 	t.Status = new(TransferInstructionStatus4)
 	return t.Status
@@ -25,6 +25,10 @@ type TransferStatusAndReason struct {
 	StatusInitiator *PartyIdentification1Choice `xml:"StsInitr,omitempty"`
 }
 
+func (t *TransferStatusAndReason) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferStatusAndReason) SetTransferReference(value string) {
 	t.TransferReference = (*Max35Text)(&value)
 }
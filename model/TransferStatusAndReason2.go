@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information about the status of a transfer instruction and its reason.
 type TransferStatusAndReason2 struct {
 
@@ -52,6 +56,10 @@ type TransferStatusAndReason2 struct {
 	StatusInitiator *PartyIdentification2Choice `xml:"StsInitr,omitempty"`
 }
 
+func (t *TransferStatusAndReason2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferStatusAndReason2) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -117,10 +125,20 @@ func (t *TransferStatusAndReason2) SetTradeDate(value string) {
 	t.TradeDate = (*ISODate)(&value)
 }
 
+func (t *TransferStatusAndReason2) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODate)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *TransferStatusAndReason2) SetSendOutDate(value string) {
 	t.SendOutDate = (*ISODate)(&value)
 }
 
+func (t *TransferStatusAndReason2) SetSendOutDateFromTime(value time.Time) {
+	t.SendOutDate = new(ISODate)
+	t.SendOutDate.FromTime(value)
+}
+
 func (t *TransferStatusAndReason2) AddStatusInitiator() *PartyIdentification2Choice {
 	t.StatusInitiator = new(PartyIdentification2Choice)
 	return t.StatusInitiator
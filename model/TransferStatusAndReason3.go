@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information about the status of a transfer instruction and its reason.
 type TransferStatusAndReason3 struct {
 
@@ -28,6 +32,10 @@ type TransferStatusAndReason3 struct {
 	StatusInitiator *PartyIdentification2Choice `xml:"StsInitr,omitempty"`
 }
 
+func (t *TransferStatusAndReason3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferStatusAndReason3) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -53,10 +61,20 @@ func (t *TransferStatusAndReason3) SetTradeDate(value string) {
 	t.TradeDate = (*ISODate)(&value)
 }
 
+func (t *TransferStatusAndReason3) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODate)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *TransferStatusAndReason3) SetSendOutDate(value string) {
 	t.SendOutDate = (*ISODate)(&value)
 }
 
+func (t *TransferStatusAndReason3) SetSendOutDateFromTime(value time.Time) {
+	t.SendOutDate = new(ISODate)
+	t.SendOutDate.FromTime(value)
+}
+
 func (t *TransferStatusAndReason3) AddStatusInitiator() *PartyIdentification2Choice {
 	t.StatusInitiator = new(PartyIdentification2Choice)
 	return t.StatusInitiator
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information about the status of a transfer instruction and its reason.
 type TransferStatusAndReason4 struct {
 
@@ -28,6 +32,10 @@ type TransferStatusAndReason4 struct {
 	StatusInitiator *PartyIdentification70Choice `xml:"StsInitr,omitempty"`
 }
 
+func (t *TransferStatusAndReason4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferStatusAndReason4) SetMasterReference(value string) {
 	t.MasterReference = (*Max35Text)(&value)
 }
@@ -54,10 +62,20 @@ func (t *TransferStatusAndReason4) SetTradeDate(value string) {
 	t.TradeDate = (*ISODate)(&value)
 }
 
+func (t *TransferStatusAndReason4) SetTradeDateFromTime(value time.Time) {
+	t.TradeDate = new(ISODate)
+	t.TradeDate.FromTime(value)
+}
+
 func (t *TransferStatusAndReason4) SetSendOutDate(value string) {
 	t.SendOutDate = (*ISODate)(&value)
 }
 
+func (t *TransferStatusAndReason4) SetSendOutDateFromTime(value time.Time) {
+	t.SendOutDate = new(ISODate)
+	t.SendOutDate.FromTime(value)
+}
+
 func (t *TransferStatusAndReason4) AddStatusInitiator() *PartyIdentification70Choice {
 	t.StatusInitiator = new(PartyIdentification70Choice)
 	return t.StatusInitiator
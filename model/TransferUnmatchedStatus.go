@@ -13,6 +13,10 @@ type TransferUnmatchedStatus struct {
 	NoReason *NoReasonCode `xml:"NoRsn"`
 }
 
+func (t *TransferUnmatchedStatus) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferUnmatchedStatus) AddReason() *TransferUnmatchedStatusReason1 {
 	t.Reason = new(TransferUnmatchedStatusReason1)
 	return t.Reason
@@ -23,6 +27,10 @@ func (t *TransferUnmatchedStatus) AddDataSourceScheme() *GenericIdentification1
 	return t.DataSourceScheme
 }
 
-func (t *TransferUnmatchedStatus) SetNoReason(value string) {
+func (t *TransferUnmatchedStatus) SetNoReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	t.NoReason = (*NoReasonCode)(&value)
+	return nil
 }
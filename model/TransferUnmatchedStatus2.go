@@ -16,8 +16,16 @@ type TransferUnmatchedStatus2 struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
-func (t *TransferUnmatchedStatus2) SetNoSpecifiedReason(value string) {
+func (t *TransferUnmatchedStatus2) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TransferUnmatchedStatus2) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	t.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (t *TransferUnmatchedStatus2) SetReason(value string) {
@@ -16,8 +16,16 @@ type TransferUnmatchedStatus3Choice struct {
 	DataSourceScheme *GenericIdentification1 `xml:"DataSrcSchme"`
 }
 
-func (t *TransferUnmatchedStatus3Choice) SetNoSpecifiedReason(value string) {
+func (t *TransferUnmatchedStatus3Choice) Validate() error {
+	return ValidateElement(t)
+}
+
+func (t *TransferUnmatchedStatus3Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	t.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (t *TransferUnmatchedStatus3Choice) SetReason(value string) {
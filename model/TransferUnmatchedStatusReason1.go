@@ -10,6 +10,10 @@ type TransferUnmatchedStatusReason1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (t *TransferUnmatchedStatusReason1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransferUnmatchedStatusReason1) SetStructured(value string) {
 	t.Structured = (*TransferUnmatchedReason1Code)(&value)
 }
@@ -13,6 +13,10 @@ type TransportByAir2 struct {
 	AirCarrierName *Max35Text `xml:"AirCrrierNm,omitempty"`
 }
 
+func (t *TransportByAir2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportByAir2) AddDepartureAirport() *AirportName1Choice {
 	t.DepartureAirport = new(AirportName1Choice)
 	return t.DepartureAirport
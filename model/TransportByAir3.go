@@ -13,6 +13,10 @@ type TransportByAir3 struct {
 	AirCarrierName *Max35Text `xml:"AirCrrierNm,omitempty"`
 }
 
+func (t *TransportByAir3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportByAir3) AddDepartureAirport() *AirportName1Choice {
 	newValue := new(AirportName1Choice)
 	t.DepartureAirport = append(t.DepartureAirport, newValue)
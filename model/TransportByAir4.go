@@ -25,6 +25,10 @@ type TransportByAir4 struct {
 	CarrierAgentCountry *CountryCode `xml:"CrrierAgtCtry,omitempty"`
 }
 
+func (t *TransportByAir4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportByAir4) AddDepartureAirport() *AirportName1Choice {
 	t.DepartureAirport = new(AirportName1Choice)
 	return t.DepartureAirport
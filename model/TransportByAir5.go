@@ -22,6 +22,10 @@ type TransportByAir5 struct {
 	CarrierAgentCountry *CountryCode `xml:"CrrierAgtCtry,omitempty"`
 }
 
+func (t *TransportByAir5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportByAir5) AddDepartureAirport() *AirportName1Choice {
 	newValue := new(AirportName1Choice)
 	t.DepartureAirport = append(t.DepartureAirport, newValue)
@@ -13,6 +13,10 @@ type TransportByRail2 struct {
 	RailCarrierName *Max35Text `xml:"RailCrrierNm,omitempty"`
 }
 
+func (t *TransportByRail2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportByRail2) SetPlaceOfReceipt(value string) {
 	t.PlaceOfReceipt = (*Max35Text)(&value)
 }
@@ -13,6 +13,10 @@ type TransportByRail3 struct {
 	RailCarrierName *Max35Text `xml:"RailCrrierNm,omitempty"`
 }
 
+func (t *TransportByRail3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportByRail3) AddPlaceOfReceipt(value string) {
 	t.PlaceOfReceipt = append(t.PlaceOfReceipt, (*Max35Text)(&value))
 }
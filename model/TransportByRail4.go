@@ -22,6 +22,10 @@ type TransportByRail4 struct {
 	CarrierAgentCountry *CountryCode `xml:"CrrierAgtCtry,omitempty"`
 }
 
+func (t *TransportByRail4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportByRail4) SetPlaceOfReceipt(value string) {
 	t.PlaceOfReceipt = (*Max35Text)(&value)
 }
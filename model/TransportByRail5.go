@@ -22,6 +22,10 @@ type TransportByRail5 struct {
 	CarrierAgentCountry *CountryCode `xml:"CrrierAgtCtry,omitempty"`
 }
 
+func (t *TransportByRail5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportByRail5) AddPlaceOfReceipt(value string) {
 	t.PlaceOfReceipt = append(t.PlaceOfReceipt, (*Max35Text)(&value))
 }
@@ -13,6 +13,10 @@ type TransportByRoad2 struct {
 	RoadCarrierName *Max35Text `xml:"RoadCrrierNm,omitempty"`
 }
 
+func (t *TransportByRoad2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportByRoad2) SetPlaceOfReceipt(value string) {
 	t.PlaceOfReceipt = (*Max35Text)(&value)
 }
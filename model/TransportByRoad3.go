@@ -13,6 +13,10 @@ type TransportByRoad3 struct {
 	RoadCarrierName *Max35Text `xml:"RoadCrrierNm,omitempty"`
 }
 
+func (t *TransportByRoad3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportByRoad3) AddPlaceOfReceipt(value string) {
 	t.PlaceOfReceipt = append(t.PlaceOfReceipt, (*Max35Text)(&value))
 }
@@ -22,6 +22,10 @@ type TransportByRoad4 struct {
 	CarrierAgentCountry *CountryCode `xml:"CrrierAgtCtry,omitempty"`
 }
 
+func (t *TransportByRoad4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportByRoad4) SetPlaceOfReceipt(value string) {
 	t.PlaceOfReceipt = (*Max35Text)(&value)
 }
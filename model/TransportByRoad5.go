@@ -22,6 +22,10 @@ type TransportByRoad5 struct {
 	CarrierAgentCountry *CountryCode `xml:"CrrierAgtCtry,omitempty"`
 }
 
+func (t *TransportByRoad5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportByRoad5) AddPlaceOfReceipt(value string) {
 	t.PlaceOfReceipt = append(t.PlaceOfReceipt, (*Max35Text)(&value))
 }
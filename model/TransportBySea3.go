@@ -13,6 +13,10 @@ type TransportBySea3 struct {
 	SeaCarrierName *Max35Text `xml:"SeaCrrierNm,omitempty"`
 }
 
+func (t *TransportBySea3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportBySea3) AddPortOfLoading(value string) {
 	t.PortOfLoading = append(t.PortOfLoading, (*Max35Text)(&value))
 }
@@ -16,6 +16,10 @@ type TransportBySea4 struct {
 	SeaCarrierName *Max35Text `xml:"SeaCrrierNm,omitempty"`
 }
 
+func (t *TransportBySea4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportBySea4) SetPortOfLoading(value string) {
 	t.PortOfLoading = (*Max35Text)(&value)
 }
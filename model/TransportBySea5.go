@@ -40,6 +40,10 @@ type TransportBySea5 struct {
 	VoyageNumber *Max35Text `xml:"VygNb,omitempty"`
 }
 
+func (t *TransportBySea5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportBySea5) SetPortOfLoading(value string) {
 	t.PortOfLoading = (*Max35Text)(&value)
 }
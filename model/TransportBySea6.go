@@ -25,6 +25,10 @@ type TransportBySea6 struct {
 	CarrierAgentCountry *CountryCode `xml:"CrrierAgtCtry,omitempty"`
 }
 
+func (t *TransportBySea6) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportBySea6) AddPortOfLoading(value string) {
 	t.PortOfLoading = append(t.PortOfLoading, (*Max35Text)(&value))
 }
@@ -25,6 +25,10 @@ type TransportDataSet3 struct {
 	TransportInformation *TransportDetails2 `xml:"TrnsprtInf"`
 }
 
+func (t *TransportDataSet3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportDataSet3) AddDataSetIdentification() *DocumentIdentification1 {
 	t.DataSetIdentification = new(DocumentIdentification1)
 	return t.DataSetIdentification
@@ -25,6 +25,10 @@ type TransportDataSet4 struct {
 	TransportInformation *TransportDetails3 `xml:"TrnsprtInf"`
 }
 
+func (t *TransportDataSet4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportDataSet4) AddDataSetIdentification() *DocumentIdentification1 {
 	t.DataSetIdentification = new(DocumentIdentification1)
 	return t.DataSetIdentification
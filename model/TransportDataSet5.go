@@ -25,6 +25,10 @@ type TransportDataSet5 struct {
 	TransportInformation *TransportDetails4 `xml:"TrnsprtInf"`
 }
 
+func (t *TransportDataSet5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportDataSet5) AddDataSetIdentification() *DocumentIdentification1 {
 	t.DataSetIdentification = new(DocumentIdentification1)
 	return t.DataSetIdentification
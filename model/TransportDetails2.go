@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information on the shipment date, the charges, the routing and the goods described in the transport document.
 type TransportDetails2 struct {
 
@@ -28,6 +32,10 @@ type TransportDetails2 struct {
 	FreightCharges *Charge13 `xml:"FrghtChrgs,omitempty"`
 }
 
+func (t *TransportDetails2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportDetails2) AddTransportDocumentReference() *DocumentIdentification7 {
 	newValue := new(DocumentIdentification7)
 	t.TransportDocumentReference = append(t.TransportDocumentReference, newValue)
@@ -334,10 +342,20 @@ func (t *TransportDetails2) SetProposedShipmentDate(value string) {
 	t.ProposedShipmentDate = (*ISODate)(&value)
 }
 
+func (t *TransportDetails2) SetProposedShipmentDateFromTime(value time.Time) {
+	t.ProposedShipmentDate = new(ISODate)
+	t.ProposedShipmentDate.FromTime(value)
+}
+
 func (t *TransportDetails2) SetActualShipmentDate(value string) {
 	t.ActualShipmentDate = (*ISODate)(&value)
 }
 
+func (t *TransportDetails2) SetActualShipmentDateFromTime(value time.Time) {
+	t.ActualShipmentDate = new(ISODate)
+	t.ActualShipmentDate.FromTime(value)
+}
+
 func (t *TransportDetails2) AddIncoterms() *Incoterms2 {
 	t.Incoterms = new(Incoterms2)
 	return t.Incoterms
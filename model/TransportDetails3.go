@@ -25,6 +25,10 @@ type TransportDetails3 struct {
 	Incoterms *Incoterms4 `xml:"Incotrms,omitempty"`
 }
 
+func (t *TransportDetails3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportDetails3) AddTransportDocumentReference() *DocumentIdentification7 {
 	newValue := new(DocumentIdentification7)
 	t.TransportDocumentReference = append(t.TransportDocumentReference, newValue)
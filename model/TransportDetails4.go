@@ -25,6 +25,10 @@ type TransportDetails4 struct {
 	Incoterms *Incoterms4 `xml:"Incotrms,omitempty"`
 }
 
+func (t *TransportDetails4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportDetails4) AddTransportDocumentReference() *DocumentIdentification7 {
 	newValue := new(DocumentIdentification7)
 	t.TransportDocumentReference = append(t.TransportDocumentReference, newValue)
@@ -10,6 +10,10 @@ type TransportMeans1 struct {
 	MultimodalTransport *MultimodalTransport3 `xml:"MltmdlTrnsprt,omitempty"`
 }
 
+func (t *TransportMeans1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportMeans1) AddIndividualTransport() *SingleTransport4 {
 	t.IndividualTransport = new(SingleTransport4)
 	return t.IndividualTransport
@@ -10,6 +10,10 @@ type TransportMeans2 struct {
 	MultimodalTransport *MultimodalTransport3 `xml:"MltmdlTrnsprt,omitempty"`
 }
 
+func (t *TransportMeans2) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportMeans2) AddIndividualTransport() *SingleTransport5 {
 	t.IndividualTransport = new(SingleTransport5)
 	return t.IndividualTransport
@@ -13,6 +13,10 @@ type TransportMeans3 struct {
 	Name *Max35Text `xml:"Nm,omitempty"`
 }
 
+func (t *TransportMeans3) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportMeans3) SetModeCode(value string) {
 	t.ModeCode = (*Max4Text)(&value)
 }
@@ -10,6 +10,10 @@ type TransportMeans4 struct {
 	MultimodalTransport *MultimodalTransport3 `xml:"MltmdlTrnsprt,omitempty"`
 }
 
+func (t *TransportMeans4) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportMeans4) AddIndividualTransport() *SingleTransport6 {
 	t.IndividualTransport = new(SingleTransport6)
 	return t.IndividualTransport
@@ -10,6 +10,10 @@ type TransportMeans5 struct {
 	MultimodalTransport *MultimodalTransport3 `xml:"MltmdlTrnsprt,omitempty"`
 }
 
+func (t *TransportMeans5) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportMeans5) AddIndividualTransport() *SingleTransport7 {
 	t.IndividualTransport = new(SingleTransport7)
 	return t.IndividualTransport
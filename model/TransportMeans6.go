@@ -10,6 +10,10 @@ type TransportMeans6 struct {
 	MultimodalTransport *MultimodalTransport3 `xml:"MltmdlTrnsprt,omitempty"`
 }
 
+func (t *TransportMeans6) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportMeans6) AddIndividualTransport() *SingleTransport8 {
 	t.IndividualTransport = new(SingleTransport8)
 	return t.IndividualTransport
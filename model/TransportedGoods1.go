@@ -16,6 +16,10 @@ type TransportedGoods1 struct {
 	SellerDefinedInformation []*UserDefinedInformation1 `xml:"SellrDfndInf,omitempty"`
 }
 
+func (t *TransportedGoods1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TransportedGoods1) AddPurchaseOrderReference() *DocumentIdentification7 {
 	t.PurchaseOrderReference = new(DocumentIdentification7)
 	return t.PurchaseOrderReference
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Treasury trading profile.
 type TreasuryProfile1 struct {
 
@@ -13,10 +19,19 @@ type TreasuryProfile1 struct {
 	Rate *PercentageRate `xml:"Rate"`
 }
 
+func (t *TreasuryProfile1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TreasuryProfile1) SetDate(value string) {
 	t.Date = (*ISODate)(&value)
 }
 
+func (t *TreasuryProfile1) SetDateFromTime(value time.Time) {
+	t.Date = new(ISODate)
+	t.Date.FromTime(value)
+}
+
 func (t *TreasuryProfile1) AddTraderType() *PartyRole5Choice {
 	t.TraderType = new(PartyRole5Choice)
 	return t.TraderType
@@ -25,3 +40,8 @@ func (t *TreasuryProfile1) AddTraderType() *PartyRole5Choice {
 func (t *TreasuryProfile1) SetRate(value string) {
 	t.Rate = (*PercentageRate)(&value)
 }
+
+func (t *TreasuryProfile1) SetRateFromDecimal(value decimal.Decimal) {
+	t.Rate = new(PercentageRate)
+	t.Rate.FromDecimal(value)
+}
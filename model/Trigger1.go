@@ -10,6 +10,10 @@ type Trigger1 struct {
 	DocumentaryEvent []*Document10 `xml:"DcmntryEvt,omitempty"`
 }
 
+func (t *Trigger1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *Trigger1) AddDateChoice() *FixedOrRecurrentDate1Choice {
 	t.DateChoice = new(FixedOrRecurrentDate1Choice)
 	return t.DateChoice
@@ -1,3 +1,15 @@
 package model
 
 type TrueFalseIndicator string
+
+func (t TrueFalseIndicator) Validate() error {
+	return validateBoolean("TrueFalseIndicator", string(t))
+}
+
+func (t TrueFalseIndicator) Bool() bool {
+	return parseBoolean(string(t))
+}
+
+func (t *TrueFalseIndicator) FromBool(value bool) {
+	*t = TrueFalseIndicator(formatBoolean(value))
+}
@@ -37,6 +37,10 @@ type TwoLegTransactionDetails1 struct {
 	TwoLegTransactionType *TwoLegTransactionType1Choice `xml:"TwoLegTxTp,omitempty"`
 }
 
+func (t *TwoLegTransactionDetails1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TwoLegTransactionDetails1) AddTradeDate() *TradeDate1Choice {
 	t.TradeDate = new(TradeDate1Choice)
 	return t.TradeDate
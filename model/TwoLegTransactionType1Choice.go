@@ -10,6 +10,10 @@ type TwoLegTransactionType1Choice struct {
 	SecuritiesFinancingDetails *SecuritiesFinancing10 `xml:"SctiesFincgDtls"`
 }
 
+func (t *TwoLegTransactionType1Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TwoLegTransactionType1Choice) AddFutureOrOptionDetails() *FutureOrOptionDetails1 {
 	t.FutureOrOptionDetails = new(FutureOrOptionDetails1)
 	return t.FutureOrOptionDetails
@@ -10,6 +10,10 @@ type TypeModification1 struct {
 	Type *CashAccountType2Choice `xml:"Tp"`
 }
 
+func (t *TypeModification1) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TypeModification1) SetModificationCode(value string) {
 	t.ModificationCode = (*Modification1Code)(&value)
 }
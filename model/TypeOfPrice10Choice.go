@@ -10,6 +10,10 @@ type TypeOfPrice10Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (t *TypeOfPrice10Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TypeOfPrice10Choice) SetCode(value string) {
 	t.Code = (*TypeOfPrice3Code)(&value)
 }
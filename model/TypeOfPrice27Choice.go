@@ -10,6 +10,10 @@ type TypeOfPrice27Choice struct {
 	Proprietary *GenericIdentification29 `xml:"Prtry"`
 }
 
+func (t *TypeOfPrice27Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TypeOfPrice27Choice) SetCode(value string) {
 	t.Code = (*TypeOfPrice30Code)(&value)
 }
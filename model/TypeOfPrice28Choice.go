@@ -10,6 +10,10 @@ type TypeOfPrice28Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (t *TypeOfPrice28Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TypeOfPrice28Choice) SetCode(value string) {
 	t.Code = (*TypeOfPrice11Code)(&value)
 }
@@ -10,6 +10,10 @@ type TypeOfPrice29Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (t *TypeOfPrice29Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TypeOfPrice29Choice) SetCode(value string) {
 	t.Code = (*TypeOfPrice14Code)(&value)
 }
@@ -10,6 +10,10 @@ type TypeOfPrice30Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (t *TypeOfPrice30Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TypeOfPrice30Choice) SetCode(value string) {
 	t.Code = (*TypeOfPrice16Code)(&value)
 }
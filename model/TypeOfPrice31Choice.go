@@ -10,6 +10,10 @@ type TypeOfPrice31Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TypeOfPrice31Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TypeOfPrice31Choice) SetCode(value string) {
 	t.Code = (*TypeOfPrice12Code)(&value)
 }
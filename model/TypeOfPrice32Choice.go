@@ -10,6 +10,10 @@ type TypeOfPrice32Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TypeOfPrice32Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TypeOfPrice32Choice) SetCode(value string) {
 	t.Code = (*TypeOfPrice14Code)(&value)
 }
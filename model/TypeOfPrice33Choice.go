@@ -10,6 +10,10 @@ type TypeOfPrice33Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TypeOfPrice33Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TypeOfPrice33Choice) SetCode(value string) {
 	t.Code = (*TypeOfPrice11Code)(&value)
 }
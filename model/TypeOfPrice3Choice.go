@@ -10,6 +10,10 @@ type TypeOfPrice3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (t *TypeOfPrice3Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TypeOfPrice3Choice) SetCode(value string) {
 	t.Code = (*TypeOfPrice14Code)(&value)
 }
@@ -10,6 +10,10 @@ type TypeOfPrice45Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TypeOfPrice45Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TypeOfPrice45Choice) SetCode(value string) {
 	t.Code = (*TypeOfPrice16Code)(&value)
 }
@@ -10,6 +10,10 @@ type TypeOfPrice46Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (t *TypeOfPrice46Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TypeOfPrice46Choice) SetCode(value string) {
 	t.Code = (*TypeOfPrice10Code)(&value)
 }
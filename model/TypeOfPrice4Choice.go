@@ -10,6 +10,10 @@ type TypeOfPrice4Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (t *TypeOfPrice4Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TypeOfPrice4Choice) SetCode(value string) {
 	t.Code = (*TypeOfPrice11Code)(&value)
 }
@@ -10,6 +10,10 @@ type TypeOfPrice6Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (t *TypeOfPrice6Choice) Validate() error {
+	return ValidateElement(t)
+}
+
 func (t *TypeOfPrice6Choice) SetCode(value string) {
 	t.Code = (*TypeOfPrice16Code)(&value)
 }
@@ -1,3 +1,9 @@
 package model
 
 type UKDomesticSortCodeIdentifier string
+
+var ukDomesticSortCodeIdentifierPattern = newPattern(`SC[0-9]{6,6}`)
+
+func (u UKDomesticSortCodeIdentifier) Validate() error {
+	return validatePattern("UKDomesticSortCodeIdentifier", string(u), ukDomesticSortCodeIdentifierPattern)
+}
@@ -1,3 +1,9 @@
 package model
 
 type UPICIdentifier string
+
+var upicIdentifierPattern = newPattern(`[0-9]{8,17}`)
+
+func (u UPICIdentifier) Validate() error {
+	return validatePattern("UPICIdentifier", string(u), upicIdentifierPattern)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Specifies the parameters to calculate the local reporting time.
 type UTCOffset1 struct {
 
@@ -10,10 +14,24 @@ type UTCOffset1 struct {
 	NumberOfHours *ISOTime `xml:"NbOfHrs"`
 }
 
+func (u *UTCOffset1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UTCOffset1) SetSign(value string) {
 	u.Sign = (*PlusOrMinusIndicator)(&value)
 }
 
+func (u *UTCOffset1) SetSignFromBool(value bool) {
+	u.Sign = new(PlusOrMinusIndicator)
+	u.Sign.FromBool(value)
+}
+
 func (u *UTCOffset1) SetNumberOfHours(value string) {
 	u.NumberOfHours = (*ISOTime)(&value)
 }
+
+func (u *UTCOffset1) SetNumberOfHoursFromTime(value time.Time) {
+	u.NumberOfHours = new(ISOTime)
+	u.NumberOfHours.FromTime(value)
+}
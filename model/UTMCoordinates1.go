@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Location on the Earth specified by the Universal Transverse Mercator coordinate system, using the WGS84 geodesic system.
 type UTMCoordinates1 struct {
 
@@ -13,6 +17,10 @@ type UTMCoordinates1 struct {
 	UTMNorthward *Number `xml:"UTMNrthwrd"`
 }
 
+func (u *UTMCoordinates1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UTMCoordinates1) SetUTMZone(value string) {
 	u.UTMZone = (*Max16Text)(&value)
 }
@@ -21,6 +29,16 @@ func (u *UTMCoordinates1) SetUTMEastward(value string) {
 	u.UTMEastward = (*Number)(&value)
 }
 
+func (u *UTMCoordinates1) SetUTMEastwardFromDecimal(value decimal.Decimal) {
+	u.UTMEastward = new(Number)
+	u.UTMEastward.FromDecimal(value)
+}
+
 func (u *UTMCoordinates1) SetUTMNorthward(value string) {
 	u.UTMNorthward = (*Number)(&value)
 }
+
+func (u *UTMCoordinates1) SetUTMNorthwardFromDecimal(value decimal.Decimal) {
+	u.UTMNorthward = new(Number)
+	u.UTMNorthward.FromDecimal(value)
+}
@@ -0,0 +1,9 @@
+package model
+
+type UUIDv4Identifier string
+
+var uuidv4IdentifierPattern = newPattern(`[a-f0-9]{8}-[a-f0-9]{4}-4[a-f0-9]{3}-[89ab][a-f0-9]{3}-[a-f0-9]{12}`)
+
+func (u UUIDv4Identifier) Validate() error {
+	return validatePattern("UUIDv4Identifier", string(u), uuidv4IdentifierPattern)
+}
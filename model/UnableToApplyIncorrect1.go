@@ -10,6 +10,10 @@ type UnableToApplyIncorrect1 struct {
 	AdditionalIncorrectInformation *Max140Text `xml:"AddtlIncrrctInf,omitempty"`
 }
 
+func (u *UnableToApplyIncorrect1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnableToApplyIncorrect1) SetCode(value string) {
 	u.Code = (*UnableToApplyIncorrectInformation4Code)(&value)
 }
@@ -13,10 +13,19 @@ type UnableToApplyJustification2Choice struct {
 	PossibleDuplicateInstruction *TrueFalseIndicator `xml:"PssblDplctInstr"`
 }
 
+func (u *UnableToApplyJustification2Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnableToApplyJustification2Choice) SetAnyInformation(value string) {
 	u.AnyInformation = (*YesNoIndicator)(&value)
 }
 
+func (u *UnableToApplyJustification2Choice) SetAnyInformationFromBool(value bool) {
+	u.AnyInformation = new(YesNoIndicator)
+	u.AnyInformation.FromBool(value)
+}
+
 func (u *UnableToApplyJustification2Choice) AddMissingOrIncorrectInformation() *MissingOrIncorrectInformation2 {
 	u.MissingOrIncorrectInformation = new(MissingOrIncorrectInformation2)
 	return u.MissingOrIncorrectInformation
@@ -25,3 +34,8 @@ func (u *UnableToApplyJustification2Choice) AddMissingOrIncorrectInformation() *
 func (u *UnableToApplyJustification2Choice) SetPossibleDuplicateInstruction(value string) {
 	u.PossibleDuplicateInstruction = (*TrueFalseIndicator)(&value)
 }
+
+func (u *UnableToApplyJustification2Choice) SetPossibleDuplicateInstructionFromBool(value bool) {
+	u.PossibleDuplicateInstruction = new(TrueFalseIndicator)
+	u.PossibleDuplicateInstruction.FromBool(value)
+}
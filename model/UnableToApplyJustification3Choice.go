@@ -13,10 +13,19 @@ type UnableToApplyJustification3Choice struct {
 	PossibleDuplicateInstruction *TrueFalseIndicator `xml:"PssblDplctInstr"`
 }
 
+func (u *UnableToApplyJustification3Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnableToApplyJustification3Choice) SetAnyInformation(value string) {
 	u.AnyInformation = (*YesNoIndicator)(&value)
 }
 
+func (u *UnableToApplyJustification3Choice) SetAnyInformationFromBool(value bool) {
+	u.AnyInformation = new(YesNoIndicator)
+	u.AnyInformation.FromBool(value)
+}
+
 func (u *UnableToApplyJustification3Choice) AddMissingOrIncorrectInformation() *MissingOrIncorrectInformation3 {
 	u.MissingOrIncorrectInformation = new(MissingOrIncorrectInformation3)
 	return u.MissingOrIncorrectInformation
@@ -25,3 +34,8 @@ func (u *UnableToApplyJustification3Choice) AddMissingOrIncorrectInformation() *
 func (u *UnableToApplyJustification3Choice) SetPossibleDuplicateInstruction(value string) {
 	u.PossibleDuplicateInstruction = (*TrueFalseIndicator)(&value)
 }
+
+func (u *UnableToApplyJustification3Choice) SetPossibleDuplicateInstructionFromBool(value bool) {
+	u.PossibleDuplicateInstruction = new(TrueFalseIndicator)
+	u.PossibleDuplicateInstruction.FromBool(value)
+}
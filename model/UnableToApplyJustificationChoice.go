@@ -10,10 +10,19 @@ type UnableToApplyJustificationChoice struct {
 	MissingOrIncorrectInformation *MissingOrIncorrectInformation `xml:"MssngOrIncrrctInf"`
 }
 
+func (u *UnableToApplyJustificationChoice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnableToApplyJustificationChoice) SetAnyInformation(value string) {
 	u.AnyInformation = (*YesNoIndicator)(&value)
 }
 
+func (u *UnableToApplyJustificationChoice) SetAnyInformationFromBool(value bool) {
+	u.AnyInformation = new(YesNoIndicator)
+	u.AnyInformation.FromBool(value)
+}
+
 func (u *UnableToApplyJustificationChoice) AddMissingOrIncorrectInformation() *MissingOrIncorrectInformation {
 	u.MissingOrIncorrectInformation = new(MissingOrIncorrectInformation)
 	return u.MissingOrIncorrectInformation
@@ -10,6 +10,10 @@ type UnableToApplyMissing1 struct {
 	AdditionalMissingInformation *Max140Text `xml:"AddtlMssngInf,omitempty"`
 }
 
+func (u *UnableToApplyMissing1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnableToApplyMissing1) SetCode(value string) {
 	u.Code = (*UnableToApplyMissingInformation3Code)(&value)
 }
@@ -10,6 +10,10 @@ type UnaffirmedReason2Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (u *UnaffirmedReason2Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnaffirmedReason2Choice) SetCode(value string) {
 	u.Code = (*UnaffirmedReason1Code)(&value)
 }
@@ -10,6 +10,10 @@ type UnderlyingContract1Choice struct {
 	Trade *TradeContract1 `xml:"Trad"`
 }
 
+func (u *UnderlyingContract1Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingContract1Choice) AddLoan() *LoanContract1 {
 	u.Loan = new(LoanContract1)
 	return u.Loan
@@ -10,6 +10,10 @@ type UnderlyingFinancialInstrument1 struct {
 	Attributes *FinancialInstrumentAttributes31 `xml:"Attrbts,omitempty"`
 }
 
+func (u *UnderlyingFinancialInstrument1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingFinancialInstrument1) AddIdentification() *SecurityIdentification14 {
 	u.Identification = new(SecurityIdentification14)
 	return u.Identification
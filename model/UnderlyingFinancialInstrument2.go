@@ -10,6 +10,10 @@ type UnderlyingFinancialInstrument2 struct {
 	Attributes *FinancialInstrumentAttributes44 `xml:"Attrbts,omitempty"`
 }
 
+func (u *UnderlyingFinancialInstrument2) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingFinancialInstrument2) AddIdentification() *SecurityIdentification14 {
 	u.Identification = new(SecurityIdentification14)
 	return u.Identification
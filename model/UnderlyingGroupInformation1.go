@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Unique identification, as assigned by the first instructing agent, to unambiguously identify the group of transactions.
 type UnderlyingGroupInformation1 struct {
 
@@ -16,6 +20,10 @@ type UnderlyingGroupInformation1 struct {
 	OriginalMessageDeliveryChannel *Max35Text `xml:"OrgnlMsgDlvryChanl,omitempty"`
 }
 
+func (u *UnderlyingGroupInformation1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingGroupInformation1) SetOriginalMessageIdentification(value string) {
 	u.OriginalMessageIdentification = (*Max35Text)(&value)
 }
@@ -28,6 +36,11 @@ func (u *UnderlyingGroupInformation1) SetOriginalCreationDateTime(value string)
 	u.OriginalCreationDateTime = (*ISODateTime)(&value)
 }
 
+func (u *UnderlyingGroupInformation1) SetOriginalCreationDateTimeFromTime(value time.Time) {
+	u.OriginalCreationDateTime = new(ISODateTime)
+	u.OriginalCreationDateTime.FromTime(value)
+}
+
 func (u *UnderlyingGroupInformation1) SetOriginalMessageDeliveryChannel(value string) {
 	u.OriginalMessageDeliveryChannel = (*Max35Text)(&value)
 }
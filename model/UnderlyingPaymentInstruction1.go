@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Reference information concerning the original payment initiation, to which the investigation message refers.
 type UnderlyingPaymentInstruction1 struct {
 
@@ -26,6 +32,10 @@ type UnderlyingPaymentInstruction1 struct {
 	RequestedCollectionDate *ISODate `xml:"ReqdColltnDt,omitempty"`
 }
 
+func (u *UnderlyingPaymentInstruction1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingPaymentInstruction1) AddOriginalGroupInformation() *UnderlyingGroupInformation1 {
 	u.OriginalGroupInformation = new(UnderlyingGroupInformation1)
 	return u.OriginalGroupInformation
@@ -47,10 +57,24 @@ func (u *UnderlyingPaymentInstruction1) SetOriginalInstructedAmount(value, curre
 	u.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (u *UnderlyingPaymentInstruction1) SetOriginalInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UnderlyingPaymentInstruction1) SetRequestedExecutionDate(value string) {
 	u.RequestedExecutionDate = (*ISODate)(&value)
 }
 
+func (u *UnderlyingPaymentInstruction1) SetRequestedExecutionDateFromTime(value time.Time) {
+	u.RequestedExecutionDate = new(ISODate)
+	u.RequestedExecutionDate.FromTime(value)
+}
+
 func (u *UnderlyingPaymentInstruction1) SetRequestedCollectionDate(value string) {
 	u.RequestedCollectionDate = (*ISODate)(&value)
 }
+
+func (u *UnderlyingPaymentInstruction1) SetRequestedCollectionDateFromTime(value time.Time) {
+	u.RequestedCollectionDate = new(ISODate)
+	u.RequestedCollectionDate.FromTime(value)
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Reference information concerning the original payment initiation, to which the investigation message refers.
 type UnderlyingPaymentInstruction3 struct {
 
@@ -26,6 +32,10 @@ type UnderlyingPaymentInstruction3 struct {
 	RequestedCollectionDate *ISODate `xml:"ReqdColltnDt,omitempty"`
 }
 
+func (u *UnderlyingPaymentInstruction3) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingPaymentInstruction3) AddOriginalGroupInformation() *UnderlyingGroupInformation1 {
 	u.OriginalGroupInformation = new(UnderlyingGroupInformation1)
 	return u.OriginalGroupInformation
@@ -47,6 +57,10 @@ func (u *UnderlyingPaymentInstruction3) SetOriginalInstructedAmount(value, curre
 	u.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (u *UnderlyingPaymentInstruction3) SetOriginalInstructedAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.OriginalInstructedAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UnderlyingPaymentInstruction3) AddRequestedExecutionDate() *DateAndDateTimeChoice {
 	u.RequestedExecutionDate = new(DateAndDateTimeChoice)
 	return u.RequestedExecutionDate
@@ -55,3 +69,8 @@ func (u *UnderlyingPaymentInstruction3) AddRequestedExecutionDate() *DateAndDate
 func (u *UnderlyingPaymentInstruction3) SetRequestedCollectionDate(value string) {
 	u.RequestedCollectionDate = (*ISODate)(&value)
 }
+
+func (u *UnderlyingPaymentInstruction3) SetRequestedCollectionDateFromTime(value time.Time) {
+	u.RequestedCollectionDate = new(ISODate)
+	u.RequestedCollectionDate.FromTime(value)
+}
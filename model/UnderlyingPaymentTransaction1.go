@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Reference information concerning the original transaction, to which the investigation message refers.
 type UnderlyingPaymentTransaction1 struct {
 
@@ -22,6 +28,10 @@ type UnderlyingPaymentTransaction1 struct {
 	OriginalInterbankSettlementDate *ISODate `xml:"OrgnlIntrBkSttlmDt"`
 }
 
+func (u *UnderlyingPaymentTransaction1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingPaymentTransaction1) AddOriginalGroupInformation() *UnderlyingGroupInformation1 {
 	u.OriginalGroupInformation = new(UnderlyingGroupInformation1)
 	return u.OriginalGroupInformation
@@ -43,6 +53,15 @@ func (u *UnderlyingPaymentTransaction1) SetOriginalInterbankSettlementAmount(val
 	u.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (u *UnderlyingPaymentTransaction1) SetOriginalInterbankSettlementAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.OriginalInterbankSettlementAmount = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UnderlyingPaymentTransaction1) SetOriginalInterbankSettlementDate(value string) {
 	u.OriginalInterbankSettlementDate = (*ISODate)(&value)
 }
+
+func (u *UnderlyingPaymentTransaction1) SetOriginalInterbankSettlementDateFromTime(value time.Time) {
+	u.OriginalInterbankSettlementDate = new(ISODate)
+	u.OriginalInterbankSettlementDate.FromTime(value)
+}
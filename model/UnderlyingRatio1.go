@@ -13,6 +13,10 @@ type UnderlyingRatio1 struct {
 	RelatedFinancialInstrumentIdentification []*SecurityIdentification14 `xml:"RltdFinInstrmId,omitempty"`
 }
 
+func (u *UnderlyingRatio1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingRatio1) AddUnderlyingQuantityDenominator() *FinancialInstrumentQuantity1Choice {
 	u.UnderlyingQuantityDenominator = new(FinancialInstrumentQuantity1Choice)
 	return u.UnderlyingQuantityDenominator
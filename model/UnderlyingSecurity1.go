@@ -7,6 +7,10 @@ type UnderlyingSecurity1 struct {
 	SecurityIdentification *SecurityIdentification11 `xml:"SctyId"`
 }
 
+func (u *UnderlyingSecurity1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingSecurity1) AddSecurityIdentification() *SecurityIdentification11 {
 	u.SecurityIdentification = new(SecurityIdentification11)
 	return u.SecurityIdentification
@@ -7,6 +7,10 @@ type UnderlyingSecurity3 struct {
 	SecurityIdentification *SecurityIdentification14 `xml:"SctyId"`
 }
 
+func (u *UnderlyingSecurity3) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingSecurity3) AddSecurityIdentification() *SecurityIdentification14 {
 	u.SecurityIdentification = new(SecurityIdentification14)
 	return u.SecurityIdentification
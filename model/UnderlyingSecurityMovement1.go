@@ -13,6 +13,10 @@ type UnderlyingSecurityMovement1 struct {
 	AccountDetails []*SecuritiesAccount8 `xml:"AcctDtls"`
 }
 
+func (u *UnderlyingSecurityMovement1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingSecurityMovement1) AddSecurityIdentification() *SecurityIdentification7 {
 	u.SecurityIdentification = new(SecurityIdentification7)
 	return u.SecurityIdentification
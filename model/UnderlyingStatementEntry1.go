@@ -13,6 +13,10 @@ type UnderlyingStatementEntry1 struct {
 	OriginalEntryIdentification *Max35Text `xml:"OrgnlNtryId,omitempty"`
 }
 
+func (u *UnderlyingStatementEntry1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingStatementEntry1) AddOriginalGroupInformation() *OriginalGroupInformation3 {
 	u.OriginalGroupInformation = new(OriginalGroupInformation3)
 	return u.OriginalGroupInformation
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about a transaction.
 type UnderlyingTradeTransaction1 struct {
 
@@ -25,6 +31,10 @@ type UnderlyingTradeTransaction1 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (u *UnderlyingTradeTransaction1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTradeTransaction1) AddType() *UnderlyingTradeTransactionType1Choice {
 	u.Type = new(UnderlyingTradeTransactionType1Choice)
 	return u.Type
@@ -38,18 +48,37 @@ func (u *UnderlyingTradeTransaction1) SetTransactionDate(value string) {
 	u.TransactionDate = (*ISODate)(&value)
 }
 
+func (u *UnderlyingTradeTransaction1) SetTransactionDateFromTime(value time.Time) {
+	u.TransactionDate = new(ISODate)
+	u.TransactionDate.FromTime(value)
+}
+
 func (u *UnderlyingTradeTransaction1) SetTenderClosingDate(value string) {
 	u.TenderClosingDate = (*ISODate)(&value)
 }
 
+func (u *UnderlyingTradeTransaction1) SetTenderClosingDateFromTime(value time.Time) {
+	u.TenderClosingDate = new(ISODate)
+	u.TenderClosingDate.FromTime(value)
+}
+
 func (u *UnderlyingTradeTransaction1) SetTransactionAmount(value, currency string) {
 	u.TransactionAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (u *UnderlyingTradeTransaction1) SetTransactionAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.TransactionAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UnderlyingTradeTransaction1) SetContractAmountPercentage(value string) {
 	u.ContractAmountPercentage = (*PercentageRate)(&value)
 }
 
+func (u *UnderlyingTradeTransaction1) SetContractAmountPercentageFromDecimal(value decimal.Decimal) {
+	u.ContractAmountPercentage = new(PercentageRate)
+	u.ContractAmountPercentage.FromDecimal(value)
+}
+
 func (u *UnderlyingTradeTransaction1) AddAdditionalInformation(value string) {
 	u.AdditionalInformation = append(u.AdditionalInformation, (*Max2000Text)(&value))
 }
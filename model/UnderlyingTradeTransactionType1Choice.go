@@ -11,6 +11,10 @@ type UnderlyingTradeTransactionType1Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (u *UnderlyingTradeTransactionType1Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTradeTransactionType1Choice) SetCode(value string) {
 	u.Code = (*ExternalUnderlyingTradeTransactionType1Code)(&value)
 }
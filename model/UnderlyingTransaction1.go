@@ -10,6 +10,10 @@ type UnderlyingTransaction1 struct {
 	OriginalPaymentInformationAndCancellation []*OriginalPaymentInformation4 `xml:"OrgnlPmtInfAndCxl,omitempty"`
 }
 
+func (u *UnderlyingTransaction1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction1) AddOriginalGroupInformationAndCancellation() *OriginalGroupInformation23 {
 	u.OriginalGroupInformationAndCancellation = new(OriginalGroupInformation23)
 	return u.OriginalGroupInformationAndCancellation
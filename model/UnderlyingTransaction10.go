@@ -10,6 +10,10 @@ type UnderlyingTransaction10 struct {
 	TransactionInformation []*PaymentTransaction55 `xml:"TxInf,omitempty"`
 }
 
+func (u *UnderlyingTransaction10) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction10) AddOriginalGroupInformationAndCancellation() *OriginalGroupHeader4 {
 	u.OriginalGroupInformationAndCancellation = new(OriginalGroupHeader4)
 	return u.OriginalGroupInformationAndCancellation
@@ -10,6 +10,10 @@ type UnderlyingTransaction11 struct {
 	OriginalPaymentInformationAndCancellation []*OriginalPaymentInstruction13 `xml:"OrgnlPmtInfAndCxl,omitempty"`
 }
 
+func (u *UnderlyingTransaction11) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction11) AddOriginalGroupInformationAndCancellation() *OriginalGroupHeader4 {
 	u.OriginalGroupInformationAndCancellation = new(OriginalGroupHeader4)
 	return u.OriginalGroupInformationAndCancellation
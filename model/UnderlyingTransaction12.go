@@ -10,6 +10,10 @@ type UnderlyingTransaction12 struct {
 	OriginalPaymentInformationAndCancellation []*OriginalPaymentInstruction15 `xml:"OrgnlPmtInfAndCxl,omitempty"`
 }
 
+func (u *UnderlyingTransaction12) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction12) AddOriginalGroupInformationAndCancellation() *OriginalGroupHeader4 {
 	u.OriginalGroupInformationAndCancellation = new(OriginalGroupHeader4)
 	return u.OriginalGroupInformationAndCancellation
@@ -10,6 +10,10 @@ type UnderlyingTransaction13 struct {
 	TransactionInformation []*PaymentTransaction62 `xml:"TxInf,omitempty"`
 }
 
+func (u *UnderlyingTransaction13) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction13) AddOriginalGroupInformationAndCancellation() *OriginalGroupHeader4 {
 	u.OriginalGroupInformationAndCancellation = new(OriginalGroupHeader4)
 	return u.OriginalGroupInformationAndCancellation
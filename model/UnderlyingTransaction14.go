@@ -13,6 +13,10 @@ type UnderlyingTransaction14 struct {
 	TransactionInformationAndStatus []*PaymentTransaction67 `xml:"TxInfAndSts,omitempty"`
 }
 
+func (u *UnderlyingTransaction14) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction14) AddOriginalGroupInformationAndStatus() *OriginalGroupHeader5 {
 	u.OriginalGroupInformationAndStatus = new(OriginalGroupHeader5)
 	return u.OriginalGroupInformationAndStatus
@@ -10,6 +10,10 @@ type UnderlyingTransaction15 struct {
 	OriginalPaymentInformationAndCancellation []*OriginalPaymentInstruction20 `xml:"OrgnlPmtInfAndCxl,omitempty"`
 }
 
+func (u *UnderlyingTransaction15) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction15) AddOriginalGroupInformationAndCancellation() *OriginalGroupHeader6 {
 	u.OriginalGroupInformationAndCancellation = new(OriginalGroupHeader6)
 	return u.OriginalGroupInformationAndCancellation
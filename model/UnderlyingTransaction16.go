@@ -10,6 +10,10 @@ type UnderlyingTransaction16 struct {
 	TransactionInformation []*PaymentTransaction75 `xml:"TxInf,omitempty"`
 }
 
+func (u *UnderlyingTransaction16) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction16) AddOriginalGroupInformationAndCancellation() *OriginalGroupHeader6 {
 	u.OriginalGroupInformationAndCancellation = new(OriginalGroupHeader6)
 	return u.OriginalGroupInformationAndCancellation
@@ -13,6 +13,10 @@ type UnderlyingTransaction17 struct {
 	TransactionInformationAndStatus []*PaymentTransaction79 `xml:"TxInfAndSts,omitempty"`
 }
 
+func (u *UnderlyingTransaction17) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction17) AddOriginalGroupInformationAndStatus() *OriginalGroupHeader5 {
 	u.OriginalGroupInformationAndStatus = new(OriginalGroupHeader5)
 	return u.OriginalGroupInformationAndStatus
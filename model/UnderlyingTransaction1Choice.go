@@ -13,6 +13,10 @@ type UnderlyingTransaction1Choice struct {
 	StatementEntry *UnderlyingStatementEntry1 `xml:"StmtNtry"`
 }
 
+func (u *UnderlyingTransaction1Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction1Choice) AddInitiation() *UnderlyingPaymentInstruction1 {
 	u.Initiation = new(UnderlyingPaymentInstruction1)
 	return u.Initiation
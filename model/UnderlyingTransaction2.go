@@ -10,6 +10,10 @@ type UnderlyingTransaction2 struct {
 	TransactionInformation []*PaymentTransactionInformation31 `xml:"TxInf,omitempty"`
 }
 
+func (u *UnderlyingTransaction2) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction2) AddOriginalGroupInformationAndCancellation() *OriginalGroupInformation23 {
 	u.OriginalGroupInformationAndCancellation = new(OriginalGroupInformation23)
 	return u.OriginalGroupInformationAndCancellation
@@ -13,6 +13,10 @@ type UnderlyingTransaction2Choice struct {
 	StatementEntry *UnderlyingStatementEntry1 `xml:"StmtNtry"`
 }
 
+func (u *UnderlyingTransaction2Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction2Choice) AddInitiation() *UnderlyingPaymentInstruction2 {
 	u.Initiation = new(UnderlyingPaymentInstruction2)
 	return u.Initiation
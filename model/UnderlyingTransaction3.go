@@ -13,6 +13,10 @@ type UnderlyingTransaction3 struct {
 	TransactionInformationAndStatus []*PaymentTransactionInformation33 `xml:"TxInfAndSts,omitempty"`
 }
 
+func (u *UnderlyingTransaction3) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction3) AddOriginalGroupInformationAndStatus() *OriginalGroupInformation24 {
 	u.OriginalGroupInformationAndStatus = new(OriginalGroupInformation24)
 	return u.OriginalGroupInformationAndStatus
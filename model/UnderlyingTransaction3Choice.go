@@ -13,6 +13,10 @@ type UnderlyingTransaction3Choice struct {
 	StatementEntry *UnderlyingStatementEntry1 `xml:"StmtNtry"`
 }
 
+func (u *UnderlyingTransaction3Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction3Choice) AddInitiation() *UnderlyingPaymentInstruction3 {
 	u.Initiation = new(UnderlyingPaymentInstruction3)
 	return u.Initiation
@@ -13,6 +13,10 @@ type UnderlyingTransaction4 struct {
 	TransactionInformationAndStatus []*PaymentTransaction40 `xml:"TxInfAndSts,omitempty"`
 }
 
+func (u *UnderlyingTransaction4) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction4) AddOriginalGroupInformationAndStatus() *OriginalGroupHeader5 {
 	u.OriginalGroupInformationAndStatus = new(OriginalGroupHeader5)
 	return u.OriginalGroupInformationAndStatus
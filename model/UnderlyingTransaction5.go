@@ -10,6 +10,10 @@ type UnderlyingTransaction5 struct {
 	TransactionInformation []*PaymentTransaction38 `xml:"TxInf,omitempty"`
 }
 
+func (u *UnderlyingTransaction5) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction5) AddOriginalGroupInformationAndCancellation() *OriginalGroupHeader4 {
 	u.OriginalGroupInformationAndCancellation = new(OriginalGroupHeader4)
 	return u.OriginalGroupInformationAndCancellation
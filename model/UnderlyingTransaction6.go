@@ -10,6 +10,10 @@ type UnderlyingTransaction6 struct {
 	OriginalPaymentInformationAndCancellation []*OriginalPaymentInstruction4 `xml:"OrgnlPmtInfAndCxl,omitempty"`
 }
 
+func (u *UnderlyingTransaction6) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction6) AddOriginalGroupInformationAndCancellation() *OriginalGroupHeader4 {
 	u.OriginalGroupInformationAndCancellation = new(OriginalGroupHeader4)
 	return u.OriginalGroupInformationAndCancellation
@@ -10,6 +10,10 @@ type UnderlyingTransaction7 struct {
 	OriginalPaymentInformationAndCancellation []*OriginalPaymentInstruction8 `xml:"OrgnlPmtInfAndCxl,omitempty"`
 }
 
+func (u *UnderlyingTransaction7) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction7) AddOriginalGroupInformationAndCancellation() *OriginalGroupHeader4 {
 	u.OriginalGroupInformationAndCancellation = new(OriginalGroupHeader4)
 	return u.OriginalGroupInformationAndCancellation
@@ -10,6 +10,10 @@ type UnderlyingTransaction8 struct {
 	TransactionInformation []*PaymentTransaction48 `xml:"TxInf,omitempty"`
 }
 
+func (u *UnderlyingTransaction8) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction8) AddOriginalGroupInformationAndCancellation() *OriginalGroupHeader4 {
 	u.OriginalGroupInformationAndCancellation = new(OriginalGroupHeader4)
 	return u.OriginalGroupInformationAndCancellation
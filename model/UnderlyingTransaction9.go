@@ -13,6 +13,10 @@ type UnderlyingTransaction9 struct {
 	TransactionInformationAndStatus []*PaymentTransaction53 `xml:"TxInfAndSts,omitempty"`
 }
 
+func (u *UnderlyingTransaction9) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnderlyingTransaction9) AddOriginalGroupInformationAndStatus() *OriginalGroupHeader5 {
 	u.OriginalGroupInformationAndStatus = new(OriginalGroupHeader5)
 	return u.OriginalGroupInformationAndStatus
@@ -103,6 +103,10 @@ type Undertaking1 struct {
 	AdditionalApplicationInformation []*Max2000Text `xml:"AddtlApplInf,omitempty"`
 }
 
+func (u *Undertaking1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *Undertaking1) SetApplicantReferenceNumber(value string) {
 	u.ApplicantReferenceNumber = (*Max35Text)(&value)
 }
@@ -441,10 +445,20 @@ func (u *Undertaking1) SetConfirmationIndicator(value string) {
 	u.ConfirmationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *Undertaking1) SetConfirmationIndicatorFromBool(value bool) {
+	u.ConfirmationIndicator = new(YesNoIndicator)
+	u.ConfirmationIndicator.FromBool(value)
+}
+
 func (u *Undertaking1) SetCounterUndertakingIndicator(value string) {
 	u.CounterUndertakingIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *Undertaking1) SetCounterUndertakingIndicatorFromBool(value bool) {
+	u.CounterUndertakingIndicator = new(YesNoIndicator)
+	u.CounterUndertakingIndicator.FromBool(value)
+}
+
 func (u *Undertaking1) AddCounterUndertaking() *Undertaking2 {
 	u.CounterUndertaking = new(Undertaking2)
 	return u.CounterUndertaking
@@ -771,14 +785,29 @@ func (u *Undertaking1) SetMultipleDemandIndicator(value string) {
 	u.MultipleDemandIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *Undertaking1) SetMultipleDemandIndicatorFromBool(value bool) {
+	u.MultipleDemandIndicator = new(YesNoIndicator)
+	u.MultipleDemandIndicator.FromBool(value)
+}
+
 func (u *Undertaking1) SetPartialDemandIndicator(value string) {
 	u.PartialDemandIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *Undertaking1) SetPartialDemandIndicatorFromBool(value bool) {
+	u.PartialDemandIndicator = new(YesNoIndicator)
+	u.PartialDemandIndicator.FromBool(value)
+}
+
 func (u *Undertaking1) SetTransferIndicator(value string) {
 	u.TransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *Undertaking1) SetTransferIndicatorFromBool(value bool) {
+	u.TransferIndicator = new(YesNoIndicator)
+	u.TransferIndicator.FromBool(value)
+}
+
 func (u *Undertaking1) SetTransferChargesPayableBy(value string) {
 	u.TransferChargesPayableBy = (*ExternalTypeOfParty1Code)(&value)
 }
@@ -10,6 +10,10 @@ type Undertaking10 struct {
 	NewExpiryDetails *ExpiryDetails1 `xml:"NewXpryDtls,omitempty"`
 }
 
+func (u *Undertaking10) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *Undertaking10) AddNewUndertakingAmount() *UndertakingAmount2 {
 	u.NewUndertakingAmount = new(UndertakingAmount2)
 	return u.NewUndertakingAmount
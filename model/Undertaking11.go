@@ -19,6 +19,10 @@ type Undertaking11 struct {
 	DeliveryChannel *CommunicationChannel1 `xml:"DlvryChanl,omitempty"`
 }
 
+func (u *Undertaking11) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *Undertaking11) AddNewUndertakingAmount() *UndertakingAmount2 {
 	u.NewUndertakingAmount = new(UndertakingAmount2)
 	return u.NewUndertakingAmount
@@ -28,6 +28,10 @@ type Undertaking2 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (u *Undertaking2) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *Undertaking2) SetName(value string) {
 	u.Name = (*UndertakingName1Code)(&value)
 }
@@ -60,6 +64,11 @@ func (u *Undertaking2) SetStandardClaimDocumentIndicator(value string) {
 	u.StandardClaimDocumentIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *Undertaking2) SetStandardClaimDocumentIndicatorFromBool(value bool) {
+	u.StandardClaimDocumentIndicator = new(YesNoIndicator)
+	u.StandardClaimDocumentIndicator.FromBool(value)
+}
+
 func (u *Undertaking2) AddAdditionalInformation(value string) {
 	u.AdditionalInformation = append(u.AdditionalInformation, (*Max2000Text)(&value))
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Independent undertaking, such as a demand guarantee or standby letter of credit, that provides financial assurance, to be honoured on the presentation of documents that comply with its terms and conditions.
 type Undertaking3 struct {
 
@@ -94,6 +98,10 @@ type Undertaking3 struct {
 	RequestedLocalUndertaking *Undertaking4 `xml:"ReqdLclUdrtkg,omitempty"`
 }
 
+func (u *Undertaking3) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *Undertaking3) SetIdentification(value string) {
 	u.Identification = (*Max35Text)(&value)
 }
@@ -412,6 +420,11 @@ func (u *Undertaking3) SetDateOfIssuance(value string) {
 	u.DateOfIssuance = (*ISODate)(&value)
 }
 
+func (u *Undertaking3) SetDateOfIssuanceFromTime(value time.Time) {
+	u.DateOfIssuance = new(ISODate)
+	u.DateOfIssuance.FromTime(value)
+}
+
 func (u *Undertaking3) AddPlaceOfIssue() *PostalAddress12 {
 	u.PlaceOfIssue = new(PostalAddress12)
 	return u.PlaceOfIssue
@@ -441,6 +454,11 @@ func (u *Undertaking3) SetConfirmationIndicator(value string) {
 	u.ConfirmationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *Undertaking3) SetConfirmationIndicatorFromBool(value bool) {
+	u.ConfirmationIndicator = new(YesNoIndicator)
+	u.ConfirmationIndicator.FromBool(value)
+}
+
 func (u *Undertaking3) SetConfirmationPartyType(value string) {
 	u.ConfirmationPartyType = (*ExternalTypeOfParty1Code)(&value)
 }
@@ -897,10 +915,20 @@ func (u *Undertaking3) SetMultipleDemandIndicator(value string) {
 	u.MultipleDemandIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *Undertaking3) SetMultipleDemandIndicatorFromBool(value bool) {
+	u.MultipleDemandIndicator = new(YesNoIndicator)
+	u.MultipleDemandIndicator.FromBool(value)
+}
+
 func (u *Undertaking3) SetPartialDemandIndicator(value string) {
 	u.PartialDemandIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *Undertaking3) SetPartialDemandIndicatorFromBool(value bool) {
+	u.PartialDemandIndicator = new(YesNoIndicator)
+	u.PartialDemandIndicator.FromBool(value)
+}
+
 func (u *Undertaking3) SetConfirmationChargesPayableBy(value string) {
 	u.ConfirmationChargesPayableBy = (*ExternalTypeOfParty1Code)(&value)
 }
@@ -1064,6 +1092,11 @@ func (u *Undertaking3) SetTransferIndicator(value string) {
 	u.TransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *Undertaking3) SetTransferIndicatorFromBool(value bool) {
+	u.TransferIndicator = new(YesNoIndicator)
+	u.TransferIndicator.FromBool(value)
+}
+
 func (u *Undertaking3) AddEnclosedFile() *Document9 {
 	newValue := new(Document9)
 	u.EnclosedFile = append(u.EnclosedFile, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information about an undertaking.
 type Undertaking4 struct {
 
@@ -73,6 +77,10 @@ type Undertaking4 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (u *Undertaking4) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *Undertaking4) SetName(value string) {
 	u.Name = (*UndertakingName1Code)(&value)
 }
@@ -377,6 +385,11 @@ func (u *Undertaking4) SetDateOfIssuance(value string) {
 	u.DateOfIssuance = (*ISODate)(&value)
 }
 
+func (u *Undertaking4) SetDateOfIssuanceFromTime(value time.Time) {
+	u.DateOfIssuance = new(ISODate)
+	u.DateOfIssuance.FromTime(value)
+}
+
 func (u *Undertaking4) AddAdvisingParty() *PartyIdentification43 {
 	u.AdvisingParty = new(PartyIdentification43)
 	return u.AdvisingParty
@@ -401,6 +414,11 @@ func (u *Undertaking4) SetConfirmationIndicator(value string) {
 	u.ConfirmationIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *Undertaking4) SetConfirmationIndicatorFromBool(value bool) {
+	u.ConfirmationIndicator = new(YesNoIndicator)
+	u.ConfirmationIndicator.FromBool(value)
+}
+
 func (u *Undertaking4) AddAdditionalParty() *PartyAndType1 {
 	newValue := new(PartyAndType1)
 	u.AdditionalParty = append(u.AdditionalParty, newValue)
@@ -712,10 +730,20 @@ func (u *Undertaking4) SetMultipleDemandIndicator(value string) {
 	u.MultipleDemandIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *Undertaking4) SetMultipleDemandIndicatorFromBool(value bool) {
+	u.MultipleDemandIndicator = new(YesNoIndicator)
+	u.MultipleDemandIndicator.FromBool(value)
+}
+
 func (u *Undertaking4) SetPartialDemandIndicator(value string) {
 	u.PartialDemandIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *Undertaking4) SetPartialDemandIndicatorFromBool(value bool) {
+	u.PartialDemandIndicator = new(YesNoIndicator)
+	u.PartialDemandIndicator.FromBool(value)
+}
+
 func (u *Undertaking4) SetConfirmationChargesPayableBy(value string) {
 	u.ConfirmationChargesPayableBy = (*ExternalTypeOfParty1Code)(&value)
 }
@@ -879,6 +907,11 @@ func (u *Undertaking4) SetTransferIndicator(value string) {
 	u.TransferIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *Undertaking4) SetTransferIndicatorFromBool(value bool) {
+	u.TransferIndicator = new(YesNoIndicator)
+	u.TransferIndicator.FromBool(value)
+}
+
 func (u *Undertaking4) AddAdditionalInformation(value string) {
 	u.AdditionalInformation = append(u.AdditionalInformation, (*Max2000Text)(&value))
 }
@@ -13,6 +13,10 @@ type Undertaking6 struct {
 	BeneficiaryReferenceNumber *Max35Text `xml:"BnfcryRefNb,omitempty"`
 }
 
+func (u *Undertaking6) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *Undertaking6) SetIdentification(value string) {
 	u.Identification = (*Max35Text)(&value)
 }
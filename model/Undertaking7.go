@@ -10,6 +10,10 @@ type Undertaking7 struct {
 	Issuer *PartyIdentification43 `xml:"Issr"`
 }
 
+func (u *Undertaking7) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *Undertaking7) SetIdentification(value string) {
 	u.Identification = (*Max35Text)(&value)
 }
@@ -16,6 +16,10 @@ type Undertaking8 struct {
 	BeneficiaryReferenceNumber *Max35Text `xml:"BnfcryRefNb,omitempty"`
 }
 
+func (u *Undertaking8) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *Undertaking8) SetIdentification(value string) {
 	u.Identification = (*Max35Text)(&value)
 }
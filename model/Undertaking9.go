@@ -13,6 +13,10 @@ type Undertaking9 struct {
 	ApplicantReferenceNumber *Max35Text `xml:"ApplcntRefNb,omitempty"`
 }
 
+func (u *Undertaking9) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *Undertaking9) SetIdentification(value string) {
 	u.Identification = (*Max35Text)(&value)
 }
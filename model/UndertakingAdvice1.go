@@ -19,6 +19,10 @@ type UndertakingAdvice1 struct {
 	DigitalSignature []*PartyAndSignature2 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (u *UndertakingAdvice1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingAdvice1) AddUndertakingIssuanceMessage() *UndertakingIssuanceMessage {
 	u.UndertakingIssuanceMessage = new(UndertakingIssuanceMessage)
 	return u.UndertakingIssuanceMessage
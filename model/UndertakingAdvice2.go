@@ -22,6 +22,10 @@ type UndertakingAdvice2 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (u *UndertakingAdvice2) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingAdvice2) SetApplicantReferenceNumber(value string) {
 	u.ApplicantReferenceNumber = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type UndertakingAmendmentMessage1 struct {
 	DigitalSignature *PartyAndSignature2 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (u *UndertakingAmendmentMessage1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingAmendmentMessage1) AddUndertakingAmendmentDetails() *Amendment1 {
 	u.UndertakingAmendmentDetails = new(Amendment1)
 	return u.UndertakingAmendmentDetails
@@ -10,6 +10,10 @@ type UndertakingAmendmentResponseMessage1 struct {
 	DigitalSignature *PartyAndSignature2 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (u *UndertakingAmendmentResponseMessage1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingAmendmentResponseMessage1) AddUndertakingAmendmentResponseDetails() *Amendment7 {
 	u.UndertakingAmendmentResponseDetails = new(Amendment7)
 	return u.UndertakingAmendmentResponseDetails
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about an amount.
 type UndertakingAmount1 struct {
 
@@ -13,14 +17,27 @@ type UndertakingAmount1 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (u *UndertakingAmount1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingAmount1) SetAmount(value, currency string) {
 	u.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (u *UndertakingAmount1) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UndertakingAmount1) SetPlusTolerance(value string) {
 	u.PlusTolerance = (*PercentageRate)(&value)
 }
 
+func (u *UndertakingAmount1) SetPlusToleranceFromDecimal(value decimal.Decimal) {
+	u.PlusTolerance = new(PercentageRate)
+	u.PlusTolerance.FromDecimal(value)
+}
+
 func (u *UndertakingAmount1) AddAdditionalInformation(value string) {
 	u.AdditionalInformation = append(u.AdditionalInformation, (*Max2000Text)(&value))
 }
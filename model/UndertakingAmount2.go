@@ -10,6 +10,10 @@ type UndertakingAmount2 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (u *UndertakingAmount2) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingAmount2) AddAmountChoice() *Amount1Choice {
 	u.AmountChoice = new(Amount1Choice)
 	return u.AmountChoice
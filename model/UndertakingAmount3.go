@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information about an amount.
 type UndertakingAmount3 struct {
 
@@ -10,10 +14,18 @@ type UndertakingAmount3 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (u *UndertakingAmount3) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingAmount3) SetAmount(value, currency string) {
 	u.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (u *UndertakingAmount3) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UndertakingAmount3) AddAdditionalInformation(value string) {
 	u.AdditionalInformation = append(u.AdditionalInformation, (*Max2000Text)(&value))
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Defined variation amount and balance.
 type UndertakingAmount4 struct {
 
@@ -10,10 +14,22 @@ type UndertakingAmount4 struct {
 	BalanceAmount *ActiveCurrencyAndAmount `xml:"BalAmt,omitempty"`
 }
 
+func (u *UndertakingAmount4) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingAmount4) SetVariationAmount(value, currency string) {
 	u.VariationAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (u *UndertakingAmount4) SetVariationAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.VariationAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UndertakingAmount4) SetBalanceAmount(value, currency string) {
 	u.BalanceAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (u *UndertakingAmount4) SetBalanceAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.BalanceAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
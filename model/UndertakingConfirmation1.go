@@ -16,6 +16,10 @@ type UndertakingConfirmation1 struct {
 	Confirmation []*Max2000Text `xml:"Conf,omitempty"`
 }
 
+func (u *UndertakingConfirmation1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingConfirmation1) AddConfirmer() *PartyIdentification43 {
 	u.Confirmer = new(PartyIdentification43)
 	return u.Confirmer
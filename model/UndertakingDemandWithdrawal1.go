@@ -16,6 +16,10 @@ type UndertakingDemandWithdrawal1 struct {
 	ConfirmerReferenceNumber *Max35Text `xml:"CnfrmrRefNb,omitempty"`
 }
 
+func (u *UndertakingDemandWithdrawal1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingDemandWithdrawal1) AddUndertakingIdentification() *Undertaking6 {
 	u.UndertakingIdentification = new(Undertaking6)
 	return u.UndertakingIdentification
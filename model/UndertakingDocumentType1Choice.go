@@ -11,6 +11,10 @@ type UndertakingDocumentType1Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (u *UndertakingDocumentType1Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingDocumentType1Choice) SetCode(value string) {
 	u.Code = (*ExternalUndertakingDocumentType1Code)(&value)
 }
@@ -11,6 +11,10 @@ type UndertakingDocumentType2Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (u *UndertakingDocumentType2Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingDocumentType2Choice) SetCode(value string) {
 	u.Code = (*ExternalUndertakingDocumentType2Code)(&value)
 }
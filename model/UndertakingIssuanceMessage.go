@@ -10,6 +10,10 @@ type UndertakingIssuanceMessage struct {
 	DigitalSignature *PartyAndSignature2 `xml:"DgtlSgntr,omitempty"`
 }
 
+func (u *UndertakingIssuanceMessage) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingIssuanceMessage) AddUndertakingDetails() *Undertaking3 {
 	u.UndertakingDetails = new(Undertaking3)
 	return u.UndertakingDetails
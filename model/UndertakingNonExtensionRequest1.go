@@ -10,6 +10,10 @@ type UndertakingNonExtensionRequest1 struct {
 	UndertakingIdentification *Undertaking9 `xml:"UdrtkgId"`
 }
 
+func (u *UndertakingNonExtensionRequest1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingNonExtensionRequest1) AddRequestingParty() *PartyIdentification43 {
 	u.RequestingParty = new(PartyIdentification43)
 	return u.RequestingParty
@@ -10,6 +10,10 @@ type UndertakingNonExtensionStatusAdvice1 struct {
 	UndertakingIdentification *Undertaking7 `xml:"UdrtkgId"`
 }
 
+func (u *UndertakingNonExtensionStatusAdvice1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingNonExtensionStatusAdvice1) AddNotifyingParty() *PartyIdentification43 {
 	u.NotifyingParty = new(PartyIdentification43)
 	return u.NotifyingParty
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Undertaking status information.
 type UndertakingStatusAdvice1 struct {
 
@@ -40,6 +44,10 @@ type UndertakingStatusAdvice1 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (u *UndertakingStatusAdvice1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingStatusAdvice1) AddInitiatingParty() *PartyIdentification43 {
 	u.InitiatingParty = new(PartyIdentification43)
 	return u.InitiatingParty
@@ -54,6 +62,11 @@ func (u *UndertakingStatusAdvice1) SetAmendmentSequenceNumber(value string) {
 	u.AmendmentSequenceNumber = (*Number)(&value)
 }
 
+func (u *UndertakingStatusAdvice1) SetAmendmentSequenceNumberFromDecimal(value decimal.Decimal) {
+	u.AmendmentSequenceNumber = new(Number)
+	u.AmendmentSequenceNumber.FromDecimal(value)
+}
+
 func (u *UndertakingStatusAdvice1) SetAdvisingPartyReferenceNumber(value string) {
 	u.AdvisingPartyReferenceNumber = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information about the notification of the termination of an undertaking.
 type UndertakingTermination3 struct {
 
@@ -13,10 +17,19 @@ type UndertakingTermination3 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (u *UndertakingTermination3) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingTermination3) SetEffectiveDate(value string) {
 	u.EffectiveDate = (*ISODate)(&value)
 }
 
+func (u *UndertakingTermination3) SetEffectiveDateFromTime(value time.Time) {
+	u.EffectiveDate = new(ISODate)
+	u.EffectiveDate.FromTime(value)
+}
+
 func (u *UndertakingTermination3) AddReason() *TerminationReason1Choice {
 	u.Reason = new(TerminationReason1Choice)
 	return u.Reason
@@ -16,6 +16,10 @@ type UndertakingTerminationNotice1 struct {
 	AdditionalInformation []*Max2000Text `xml:"AddtlInf,omitempty"`
 }
 
+func (u *UndertakingTerminationNotice1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingTerminationNotice1) AddUndertakingIdentification() *Undertaking9 {
 	u.UndertakingIdentification = new(Undertaking9)
 	return u.UndertakingIdentification
@@ -11,6 +11,10 @@ type UndertakingType1Choice struct {
 	Proprietary *GenericIdentification1 `xml:"Prtry"`
 }
 
+func (u *UndertakingType1Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingType1Choice) SetCode(value string) {
 	u.Code = (*ExternalUndertakingType1Code)(&value)
 }
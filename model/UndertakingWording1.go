@@ -13,6 +13,10 @@ type UndertakingWording1 struct {
 	UndertakingTermsAndConditions []*Narrative1 `xml:"UdrtkgTermsAndConds,omitempty"`
 }
 
+func (u *UndertakingWording1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UndertakingWording1) AddModelForm() *ModelFormIdentification1 {
 	u.ModelForm = new(ModelFormIdentification1)
 	return u.ModelForm
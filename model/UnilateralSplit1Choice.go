@@ -10,6 +10,10 @@ type UnilateralSplit1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (u *UnilateralSplit1Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnilateralSplit1Choice) SetCode(value string) {
 	u.Code = (*SecuritiesTransactionType5Code)(&value)
 }
@@ -10,6 +10,10 @@ type UnilateralSplit3Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (u *UnilateralSplit3Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnilateralSplit3Choice) SetCode(value string) {
 	u.Code = (*SecuritiesTransactionType5Code)(&value)
 }
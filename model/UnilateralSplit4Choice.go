@@ -10,6 +10,10 @@ type UnilateralSplit4Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (u *UnilateralSplit4Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnilateralSplit4Choice) SetCode(value string) {
 	u.Code = (*SecuritiesTransactionType5Code)(&value)
 }
@@ -10,6 +10,10 @@ type UniqueTransactionIdentifier2 struct {
 	PriorUniqueTransactionIdentifier []*Max52Text `xml:"PrrUnqTxIdr,omitempty"`
 }
 
+func (u *UniqueTransactionIdentifier2) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UniqueTransactionIdentifier2) SetUniqueTransactionIdentifier(value string) {
 	u.UniqueTransactionIdentifier = (*Max52Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information about the units to settle.
 type Unit1 struct {
 
@@ -19,6 +23,10 @@ type Unit1 struct {
 	PriceDetails *UnitPrice3 `xml:"PricDtls,omitempty"`
 }
 
+func (u *Unit1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *Unit1) AddUnitsNumber() *FinancialInstrumentQuantity1 {
 	u.UnitsNumber = new(FinancialInstrumentQuantity1)
 	return u.UnitsNumber
@@ -28,6 +36,11 @@ func (u *Unit1) SetAcquisitionDate(value string) {
 	u.AcquisitionDate = (*ISODate)(&value)
 }
 
+func (u *Unit1) SetAcquisitionDateFromTime(value time.Time) {
+	u.AcquisitionDate = new(ISODate)
+	u.AcquisitionDate.FromTime(value)
+}
+
 func (u *Unit1) AddCertificateNumber(value string) {
 	u.CertificateNumber = append(u.CertificateNumber, (*Max35Text)(&value))
 }
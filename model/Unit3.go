@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Information about the units to settle.
 type Unit3 struct {
 
@@ -19,6 +23,10 @@ type Unit3 struct {
 	PriceDetails *UnitPrice12 `xml:"PricDtls,omitempty"`
 }
 
+func (u *Unit3) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *Unit3) AddUnitsNumber() *FinancialInstrumentQuantity1 {
 	u.UnitsNumber = new(FinancialInstrumentQuantity1)
 	return u.UnitsNumber
@@ -28,6 +36,11 @@ func (u *Unit3) SetAcquisitionDate(value string) {
 	u.AcquisitionDate = (*ISODate)(&value)
 }
 
+func (u *Unit3) SetAcquisitionDateFromTime(value time.Time) {
+	u.AcquisitionDate = new(ISODate)
+	u.AcquisitionDate.FromTime(value)
+}
+
 func (u *Unit3) AddCertificateNumber(value string) {
 	u.CertificateNumber = append(u.CertificateNumber, (*Max35Text)(&value))
 }
@@ -10,6 +10,10 @@ type Unit4 struct {
 	UnitDetails []*Unit5 `xml:"UnitDtls,omitempty"`
 }
 
+func (u *Unit4) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *Unit4) AddTotalUnitsNumber() *FinancialInstrumentQuantity1 {
 	u.TotalUnitsNumber = new(FinancialInstrumentQuantity1)
 	return u.TotalUnitsNumber
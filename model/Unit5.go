@@ -10,6 +10,10 @@ type Unit5 struct {
 	Group1Or2Units *UKTaxGroupUnitCode `xml:"Grp1Or2Units"`
 }
 
+func (u *Unit5) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *Unit5) AddUnitsNumber() *FinancialInstrumentQuantity1 {
 	u.UnitsNumber = new(FinancialInstrumentQuantity1)
 	return u.UnitsNumber
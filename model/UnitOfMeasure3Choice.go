@@ -10,6 +10,10 @@ type UnitOfMeasure3Choice struct {
 	OtherUnitOfMeasure *Max35Text `xml:"OthrUnitOfMeasr"`
 }
 
+func (u *UnitOfMeasure3Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitOfMeasure3Choice) SetUnitOfMeasureCode(value string) {
 	u.UnitOfMeasureCode = (*UnitOfMeasure4Code)(&value)
 }
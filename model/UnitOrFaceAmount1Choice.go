@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a unit in decimal number or a face amount in currency and amount.
 type UnitOrFaceAmount1Choice struct {
 
@@ -10,10 +14,23 @@ type UnitOrFaceAmount1Choice struct {
 	FaceAmount *ActiveCurrencyAndAmount `xml:"FaceAmt"`
 }
 
+func (u *UnitOrFaceAmount1Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitOrFaceAmount1Choice) SetUnit(value string) {
 	u.Unit = (*DecimalNumber)(&value)
 }
 
+func (u *UnitOrFaceAmount1Choice) SetUnitFromDecimal(value decimal.Decimal) {
+	u.Unit = new(DecimalNumber)
+	u.Unit.FromDecimal(value)
+}
+
 func (u *UnitOrFaceAmount1Choice) SetFaceAmount(value, currency string) {
 	u.FaceAmount = NewActiveCurrencyAndAmount(value, currency)
 }
+
+func (u *UnitOrFaceAmount1Choice) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.FaceAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
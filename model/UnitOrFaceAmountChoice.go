@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a unit in decimal number or a face amount in currency and amount.
 type UnitOrFaceAmountChoice struct {
 
@@ -10,10 +14,23 @@ type UnitOrFaceAmountChoice struct {
 	FaceAmount *CurrencyAndAmount `xml:"FaceAmt"`
 }
 
+func (u *UnitOrFaceAmountChoice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitOrFaceAmountChoice) SetUnit(value string) {
 	u.Unit = (*DecimalNumber)(&value)
 }
 
+func (u *UnitOrFaceAmountChoice) SetUnitFromDecimal(value decimal.Decimal) {
+	u.Unit = new(DecimalNumber)
+	u.Unit.FromDecimal(value)
+}
+
 func (u *UnitOrFaceAmountChoice) SetFaceAmount(value, currency string) {
 	u.FaceAmount = NewCurrencyAndAmount(value, currency)
 }
+
+func (u *UnitOrFaceAmountChoice) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.FaceAmount = NewCurrencyAndAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a unit in decimal number, a face amount in currency and amount or a unit expressed as a code.
 type UnitOrFaceAmountOrCode1Choice struct {
 
@@ -13,14 +17,27 @@ type UnitOrFaceAmountOrCode1Choice struct {
 	Code *Quantity1Code `xml:"Cd"`
 }
 
+func (u *UnitOrFaceAmountOrCode1Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitOrFaceAmountOrCode1Choice) SetUnit(value string) {
 	u.Unit = (*DecimalNumber)(&value)
 }
 
+func (u *UnitOrFaceAmountOrCode1Choice) SetUnitFromDecimal(value decimal.Decimal) {
+	u.Unit = new(DecimalNumber)
+	u.Unit.FromDecimal(value)
+}
+
 func (u *UnitOrFaceAmountOrCode1Choice) SetFaceAmount(value, currency string) {
 	u.FaceAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (u *UnitOrFaceAmountOrCode1Choice) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.FaceAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UnitOrFaceAmountOrCode1Choice) SetCode(value string) {
 	u.Code = (*Quantity1Code)(&value)
 }
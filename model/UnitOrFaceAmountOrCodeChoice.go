@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between a unit in decimal number, a face amount in currency and amount or a unit expressed as a code.
 type UnitOrFaceAmountOrCodeChoice struct {
 
@@ -13,14 +17,27 @@ type UnitOrFaceAmountOrCodeChoice struct {
 	Code *Quantity1Code `xml:"Cd"`
 }
 
+func (u *UnitOrFaceAmountOrCodeChoice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitOrFaceAmountOrCodeChoice) SetUnit(value string) {
 	u.Unit = (*DecimalNumber)(&value)
 }
 
+func (u *UnitOrFaceAmountOrCodeChoice) SetUnitFromDecimal(value decimal.Decimal) {
+	u.Unit = new(DecimalNumber)
+	u.Unit.FromDecimal(value)
+}
+
 func (u *UnitOrFaceAmountOrCodeChoice) SetFaceAmount(value, currency string) {
 	u.FaceAmount = NewCurrencyAndAmount(value, currency)
 }
 
+func (u *UnitOrFaceAmountOrCodeChoice) SetFaceAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.FaceAmount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UnitOrFaceAmountOrCodeChoice) SetCode(value string) {
 	u.Code = (*Quantity1Code)(&value)
 }
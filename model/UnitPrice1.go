@@ -13,6 +13,10 @@ type UnitPrice1 struct {
 	PriceMethod *PriceMethod1Code `xml:"PricMtd,omitempty"`
 }
 
+func (u *UnitPrice1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPrice1) SetType(value string) {
 	u.Type = (*TypeOfPrice2Code)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money for which goods or services are offered, sold, or bought.
 type UnitPrice10 struct {
 
@@ -31,6 +35,10 @@ type UnitPrice10 struct {
 	PriceDifferenceReason *Max350Text `xml:"PricDiffRsn,omitempty"`
 }
 
+func (u *UnitPrice10) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPrice10) SetType(value string) {
 	u.Type = (*TypeOfPrice10Code)(&value)
 }
@@ -52,10 +60,19 @@ func (u *UnitPrice10) SetNumberOfDaysAccrued(value string) {
 	u.NumberOfDaysAccrued = (*Number)(&value)
 }
 
+func (u *UnitPrice10) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	u.NumberOfDaysAccrued = new(Number)
+	u.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (u *UnitPrice10) SetTaxableIncomePerShare(value, currency string) {
 	u.TaxableIncomePerShare = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (u *UnitPrice10) SetTaxableIncomePerShareFromDecimal(value decimal.Decimal, currency string) {
+	u.TaxableIncomePerShare = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (u *UnitPrice10) SetTaxableIncomePerShareCalculated(value string) {
 	u.TaxableIncomePerShareCalculated = (*TaxableIncomePerShareCalculated2Code)(&value)
 }
@@ -16,6 +16,10 @@ type UnitPrice11 struct {
 	PriceMethod *PriceMethod1Code `xml:"PricMtd,omitempty"`
 }
 
+func (u *UnitPrice11) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPrice11) SetType(value string) {
 	u.Type = (*TypeOfPrice10Code)(&value)
 }
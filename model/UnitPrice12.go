@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money for which goods or services are offered, sold, or bought.
 type UnitPrice12 struct {
 
@@ -25,6 +29,10 @@ type UnitPrice12 struct {
 	TaxableIncomePerShare *ActiveCurrencyAnd13DecimalAmount `xml:"TaxblIncmPerShr,omitempty"`
 }
 
+func (u *UnitPrice12) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPrice12) SetType(value string) {
 	u.Type = (*TypeOfPrice12Code)(&value)
 }
@@ -46,10 +54,23 @@ func (u *UnitPrice12) SetAccruedInterestNAV(value, currency string) {
 	u.AccruedInterestNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (u *UnitPrice12) SetAccruedInterestNAVFromDecimal(value decimal.Decimal, currency string) {
+	u.AccruedInterestNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UnitPrice12) SetNumberOfDaysAccrued(value string) {
 	u.NumberOfDaysAccrued = (*Number)(&value)
 }
 
+func (u *UnitPrice12) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	u.NumberOfDaysAccrued = new(Number)
+	u.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (u *UnitPrice12) SetTaxableIncomePerShare(value, currency string) {
 	u.TaxableIncomePerShare = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (u *UnitPrice12) SetTaxableIncomePerShareFromDecimal(value decimal.Decimal, currency string) {
+	u.TaxableIncomePerShare = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money for which goods or services are offered, sold, or bought.
 type UnitPrice15 struct {
 
@@ -61,6 +65,10 @@ type UnitPrice15 struct {
 	TaxRefundDetails []*Tax17 `xml:"TaxRfndDtls,omitempty"`
 }
 
+func (u *UnitPrice15) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPrice15) SetType(value string) {
 	u.Type = (*TypeOfPrice9Code)(&value)
 }
@@ -369,26 +377,55 @@ func (u *UnitPrice15) SetForExecutionIndicator(value string) {
 	u.ForExecutionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *UnitPrice15) SetForExecutionIndicatorFromBool(value bool) {
+	u.ForExecutionIndicator = new(YesNoIndicator)
+	u.ForExecutionIndicator.FromBool(value)
+}
+
 func (u *UnitPrice15) SetCumDividendIndicator(value string) {
 	u.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *UnitPrice15) SetCumDividendIndicatorFromBool(value bool) {
+	u.CumDividendIndicator = new(YesNoIndicator)
+	u.CumDividendIndicator.FromBool(value)
+}
+
 func (u *UnitPrice15) SetCalculationBasis(value string) {
 	u.CalculationBasis = (*PercentageRate)(&value)
 }
 
+func (u *UnitPrice15) SetCalculationBasisFromDecimal(value decimal.Decimal) {
+	u.CalculationBasis = new(PercentageRate)
+	u.CalculationBasis.FromDecimal(value)
+}
+
 func (u *UnitPrice15) SetEstimatedPriceIndicator(value string) {
 	u.EstimatedPriceIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *UnitPrice15) SetEstimatedPriceIndicatorFromBool(value bool) {
+	u.EstimatedPriceIndicator = new(YesNoIndicator)
+	u.EstimatedPriceIndicator.FromBool(value)
+}
+
 func (u *UnitPrice15) SetNumberOfDaysAccrued(value string) {
 	u.NumberOfDaysAccrued = (*Number)(&value)
 }
 
+func (u *UnitPrice15) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	u.NumberOfDaysAccrued = new(Number)
+	u.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (u *UnitPrice15) SetTaxableIncomePerShare(value, currency string) {
 	u.TaxableIncomePerShare = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (u *UnitPrice15) SetTaxableIncomePerShareFromDecimal(value decimal.Decimal, currency string) {
+	u.TaxableIncomePerShare = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (u *UnitPrice15) SetTaxableIncomePerShareCalculated(value string) {
 	u.TaxableIncomePerShareCalculated = (*TaxableIncomePerShareCalculated2Code)(&value)
 }
@@ -401,6 +438,10 @@ func (u *UnitPrice15) SetTaxableIncomePerDividend(value, currency string) {
 	u.TaxableIncomePerDividend = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (u *UnitPrice15) SetTaxableIncomePerDividendFromDecimal(value decimal.Decimal, currency string) {
+	u.TaxableIncomePerDividend = NewActiveOrHistoricCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (u *UnitPrice15) SetEUDividendStatus(value string) {
 	u.EUDividendStatus = (*EUDividendStatus1Code)(&value)
 }
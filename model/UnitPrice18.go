@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money for which goods or services are offered, sold, or bought.
 type UnitPrice18 struct {
 
@@ -13,6 +17,10 @@ type UnitPrice18 struct {
 	Factor *Max15NumericText `xml:"Fctr,omitempty"`
 }
 
+func (u *UnitPrice18) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPrice18) AddUnitPrice() *UnitOfMeasure3Choice {
 	u.UnitPrice = new(UnitOfMeasure3Choice)
 	return u.UnitPrice
@@ -22,6 +30,10 @@ func (u *UnitPrice18) SetAmount(value, currency string) {
 	u.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (u *UnitPrice18) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UnitPrice18) SetFactor(value string) {
 	u.Factor = (*Max15NumericText)(&value)
 }
@@ -10,6 +10,10 @@ type UnitPrice19 struct {
 	Value *PriceValue1 `xml:"Val"`
 }
 
+func (u *UnitPrice19) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPrice19) AddPriceType() *UnitPriceType2Choice {
 	u.PriceType = new(UnitPriceType2Choice)
 	return u.PriceType
@@ -13,6 +13,10 @@ type UnitPrice20 struct {
 	PriceMethod *PriceMethod1Code `xml:"PricMtd,omitempty"`
 }
 
+func (u *UnitPrice20) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPrice20) AddPriceType() *UnitPriceType2Choice {
 	u.PriceType = new(UnitPriceType2Choice)
 	return u.PriceType
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money for which goods or services are offered, sold, or bought.
 type UnitPrice21 struct {
 
@@ -22,6 +26,10 @@ type UnitPrice21 struct {
 	TaxableIncomePerShare *ActiveCurrencyAnd13DecimalAmount `xml:"TaxblIncmPerShr,omitempty"`
 }
 
+func (u *UnitPrice21) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPrice21) AddType() *TypeOfPrice31Choice {
 	u.Type = new(TypeOfPrice31Choice)
 	return u.Type
@@ -40,10 +48,23 @@ func (u *UnitPrice21) SetAccruedInterestNAV(value, currency string) {
 	u.AccruedInterestNAV = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (u *UnitPrice21) SetAccruedInterestNAVFromDecimal(value decimal.Decimal, currency string) {
+	u.AccruedInterestNAV = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UnitPrice21) SetNumberOfDaysAccrued(value string) {
 	u.NumberOfDaysAccrued = (*Number)(&value)
 }
 
+func (u *UnitPrice21) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	u.NumberOfDaysAccrued = new(Number)
+	u.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (u *UnitPrice21) SetTaxableIncomePerShare(value, currency string) {
 	u.TaxableIncomePerShare = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
+
+func (u *UnitPrice21) SetTaxableIncomePerShareFromDecimal(value decimal.Decimal, currency string) {
+	u.TaxableIncomePerShare = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
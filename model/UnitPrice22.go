@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money for which goods or services are offered, sold, or bought.
 type UnitPrice22 struct {
 
@@ -25,6 +29,10 @@ type UnitPrice22 struct {
 	PriceDifferenceReason *Max350Text `xml:"PricDiffRsn,omitempty"`
 }
 
+func (u *UnitPrice22) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPrice22) AddType() *TypeOfPrice46Choice {
 	u.Type = new(TypeOfPrice46Choice)
 	return u.Type
@@ -43,10 +51,19 @@ func (u *UnitPrice22) SetNumberOfDaysAccrued(value string) {
 	u.NumberOfDaysAccrued = (*Number)(&value)
 }
 
+func (u *UnitPrice22) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	u.NumberOfDaysAccrued = new(Number)
+	u.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (u *UnitPrice22) SetTaxableIncomePerShare(value, currency string) {
 	u.TaxableIncomePerShare = NewActiveCurrencyAnd13DecimalAmount(value, currency)
 }
 
+func (u *UnitPrice22) SetTaxableIncomePerShareFromDecimal(value decimal.Decimal, currency string) {
+	u.TaxableIncomePerShare = NewActiveCurrencyAnd13DecimalAmount(value.String(), currency)
+}
+
 func (u *UnitPrice22) AddTaxableIncomePerShareCalculated() *TaxableIncomePerShareCalculated2Choice {
 	u.TaxableIncomePerShareCalculated = new(TaxableIncomePerShareCalculated2Choice)
 	return u.TaxableIncomePerShareCalculated
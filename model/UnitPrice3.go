@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money for which goods or services are offered, sold, or bought.
 type UnitPrice3 struct {
 
@@ -22,6 +26,10 @@ type UnitPrice3 struct {
 	TaxableIncomePerShare *CurrencyAndAmount `xml:"TaxblIncmPerShr,omitempty"`
 }
 
+func (u *UnitPrice3) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPrice3) SetPriceType(value string) {
 	u.PriceType = (*TypeOfPrice2Code)(&value)
 }
@@ -39,10 +47,23 @@ func (u *UnitPrice3) SetAccruedInterestNAV(value, currency string) {
 	u.AccruedInterestNAV = NewCurrencyAndAmount(value, currency)
 }
 
+func (u *UnitPrice3) SetAccruedInterestNAVFromDecimal(value decimal.Decimal, currency string) {
+	u.AccruedInterestNAV = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UnitPrice3) SetNumberOfDaysAccrued(value string) {
 	u.NumberOfDaysAccrued = (*Number)(&value)
 }
 
+func (u *UnitPrice3) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	u.NumberOfDaysAccrued = new(Number)
+	u.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (u *UnitPrice3) SetTaxableIncomePerShare(value, currency string) {
 	u.TaxableIncomePerShare = NewCurrencyAndAmount(value, currency)
 }
+
+func (u *UnitPrice3) SetTaxableIncomePerShareFromDecimal(value decimal.Decimal, currency string) {
+	u.TaxableIncomePerShare = NewCurrencyAndAmount(value.String(), currency)
+}
@@ -10,6 +10,10 @@ type UnitPrice4 struct {
 	Value *PriceValue1 `xml:"Val"`
 }
 
+func (u *UnitPrice4) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPrice4) SetType(value string) {
 	u.Type = (*TypeOfPrice8Code)(&value)
 }
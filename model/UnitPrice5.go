@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money for which goods or services are offered, sold, or bought.
 type UnitPrice5 struct {
 
@@ -22,6 +26,10 @@ type UnitPrice5 struct {
 	TaxableIncomePerShareCalculated *TaxableIncomePerShareCalculated1 `xml:"TaxblIncmPerShrClctd,omitempty"`
 }
 
+func (u *UnitPrice5) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPrice5) AddType() *PriceType1 {
 	u.Type = new(PriceType1)
 	return u.Type
@@ -40,6 +48,11 @@ func (u *UnitPrice5) SetNumberOfDaysAccrued(value string) {
 	u.NumberOfDaysAccrued = (*Number)(&value)
 }
 
+func (u *UnitPrice5) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	u.NumberOfDaysAccrued = new(Number)
+	u.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (u *UnitPrice5) AddTaxableIncomePerShare() *AmountPrice1Choice {
 	u.TaxableIncomePerShare = new(AmountPrice1Choice)
 	return u.TaxableIncomePerShare
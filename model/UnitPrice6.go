@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money for which goods or services are offered, sold, or bought.
 type UnitPrice6 struct {
 
@@ -43,6 +47,10 @@ type UnitPrice6 struct {
 	TaxRefundDetails []*Tax8 `xml:"TaxRfndDtls,omitempty"`
 }
 
+func (u *UnitPrice6) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPrice6) AddType() *PriceType2 {
 	u.Type = new(PriceType2)
 	return u.Type
@@ -348,18 +356,38 @@ func (u *UnitPrice6) SetForExecutionIndicator(value string) {
 	u.ForExecutionIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *UnitPrice6) SetForExecutionIndicatorFromBool(value bool) {
+	u.ForExecutionIndicator = new(YesNoIndicator)
+	u.ForExecutionIndicator.FromBool(value)
+}
+
 func (u *UnitPrice6) SetCumDividendIndicator(value string) {
 	u.CumDividendIndicator = (*YesNoIndicator)(&value)
 }
 
+func (u *UnitPrice6) SetCumDividendIndicatorFromBool(value bool) {
+	u.CumDividendIndicator = new(YesNoIndicator)
+	u.CumDividendIndicator.FromBool(value)
+}
+
 func (u *UnitPrice6) SetCalculationBasis(value string) {
 	u.CalculationBasis = (*PercentageRate)(&value)
 }
 
+func (u *UnitPrice6) SetCalculationBasisFromDecimal(value decimal.Decimal) {
+	u.CalculationBasis = new(PercentageRate)
+	u.CalculationBasis.FromDecimal(value)
+}
+
 func (u *UnitPrice6) SetNumberOfDaysAccrued(value string) {
 	u.NumberOfDaysAccrued = (*Number)(&value)
 }
 
+func (u *UnitPrice6) SetNumberOfDaysAccruedFromDecimal(value decimal.Decimal) {
+	u.NumberOfDaysAccrued = new(Number)
+	u.NumberOfDaysAccrued.FromDecimal(value)
+}
+
 func (u *UnitPrice6) AddTaxableIncomePerShare() *AmountPrice1Choice {
 	u.TaxableIncomePerShare = new(AmountPrice1Choice)
 	return u.TaxableIncomePerShare
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Amount of money for which goods or services are offered, sold, or bought.
 type UnitPrice9 struct {
 
@@ -16,6 +20,10 @@ type UnitPrice9 struct {
 	Factor *Max15NumericText `xml:"Fctr,omitempty"`
 }
 
+func (u *UnitPrice9) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPrice9) SetUnitOfMeasureCode(value string) {
 	u.UnitOfMeasureCode = (*UnitOfMeasure4Code)(&value)
 }
@@ -28,6 +36,10 @@ func (u *UnitPrice9) SetAmount(value, currency string) {
 	u.Amount = NewCurrencyAndAmount(value, currency)
 }
 
+func (u *UnitPrice9) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.Amount = NewCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UnitPrice9) SetFactor(value string) {
 	u.Factor = (*Max15NumericText)(&value)
 }
@@ -10,6 +10,10 @@ type UnitPriceType2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (u *UnitPriceType2Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitPriceType2Choice) SetCode(value string) {
 	u.Code = (*TypeOfPrice10Code)(&value)
 }
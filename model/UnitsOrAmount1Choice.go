@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or number of units.
 type UnitsOrAmount1Choice struct {
 
@@ -10,10 +14,23 @@ type UnitsOrAmount1Choice struct {
 	Unit *DecimalNumber `xml:"Unit"`
 }
 
+func (u *UnitsOrAmount1Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitsOrAmount1Choice) SetAmount(value, currency string) {
 	u.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (u *UnitsOrAmount1Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UnitsOrAmount1Choice) SetUnit(value string) {
 	u.Unit = (*DecimalNumber)(&value)
 }
+
+func (u *UnitsOrAmount1Choice) SetUnitFromDecimal(value decimal.Decimal) {
+	u.Unit = new(DecimalNumber)
+	u.Unit.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Choice between an amount or number of units or percentage.
 type UnitsOrAmountOrPercentage1Choice struct {
 
@@ -13,14 +17,32 @@ type UnitsOrAmountOrPercentage1Choice struct {
 	Percentage *PercentageRate `xml:"Pctg"`
 }
 
+func (u *UnitsOrAmountOrPercentage1Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnitsOrAmountOrPercentage1Choice) SetAmount(value, currency string) {
 	u.Amount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (u *UnitsOrAmountOrPercentage1Choice) SetAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.Amount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UnitsOrAmountOrPercentage1Choice) SetUnit(value string) {
 	u.Unit = (*DecimalNumber)(&value)
 }
 
+func (u *UnitsOrAmountOrPercentage1Choice) SetUnitFromDecimal(value decimal.Decimal) {
+	u.Unit = new(DecimalNumber)
+	u.Unit.FromDecimal(value)
+}
+
 func (u *UnitsOrAmountOrPercentage1Choice) SetPercentage(value string) {
 	u.Percentage = (*PercentageRate)(&value)
 }
+
+func (u *UnitsOrAmountOrPercentage1Choice) SetPercentageFromDecimal(value decimal.Decimal) {
+	u.Percentage = new(PercentageRate)
+	u.Percentage.FromDecimal(value)
+}
@@ -1,3 +1,7 @@
 package model
 
 type Unlimited9Text string
+
+func (u Unlimited9Text) Validate() error {
+	return validateLength("Unlimited9Text", string(u), 1, -1)
+}
@@ -10,6 +10,10 @@ type UnmatchedReason1 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (u *UnmatchedReason1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason1) AddCode() *UnmatchedReason1Choice {
 	u.Code = new(UnmatchedReason1Choice)
 	return u.Code
@@ -10,6 +10,10 @@ type UnmatchedReason10Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason10Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason10Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason7Code)(&value)
 }
@@ -10,6 +10,10 @@ type UnmatchedReason11 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (u *UnmatchedReason11) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason11) AddCode() *UnmatchedReason14Choice {
 	u.Code = new(UnmatchedReason14Choice)
 	return u.Code
@@ -10,6 +10,10 @@ type UnmatchedReason12 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (u *UnmatchedReason12) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason12) AddCode() *UnmatchedReason15Choice {
 	u.Code = new(UnmatchedReason15Choice)
 	return u.Code
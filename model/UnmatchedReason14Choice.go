@@ -10,6 +10,10 @@ type UnmatchedReason14Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason14Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason14Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason8Code)(&value)
 }
@@ -10,6 +10,10 @@ type UnmatchedReason15 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (u *UnmatchedReason15) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason15) AddCode() *UnmatchedReason21Choice {
 	u.Code = new(UnmatchedReason21Choice)
 	return u.Code
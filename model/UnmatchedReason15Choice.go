@@ -10,6 +10,10 @@ type UnmatchedReason15Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason15Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason15Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason10Code)(&value)
 }
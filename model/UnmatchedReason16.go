@@ -10,6 +10,10 @@ type UnmatchedReason16 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (u *UnmatchedReason16) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason16) AddCode() *UnmatchedReason23Choice {
 	u.Code = new(UnmatchedReason23Choice)
 	return u.Code
@@ -10,6 +10,10 @@ type UnmatchedReason16Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason16Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason16Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason9Code)(&value)
 }
@@ -10,6 +10,10 @@ type UnmatchedReason17 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (u *UnmatchedReason17) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason17) AddCode() *UnmatchedReason24Choice {
 	u.Code = new(UnmatchedReason24Choice)
 	return u.Code
@@ -10,6 +10,10 @@ type UnmatchedReason18 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (u *UnmatchedReason18) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason18) AddCode() *UnmatchedReason25Choice {
 	u.Code = new(UnmatchedReason25Choice)
 	return u.Code
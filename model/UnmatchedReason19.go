@@ -10,6 +10,10 @@ type UnmatchedReason19 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (u *UnmatchedReason19) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason19) AddCode() *UnmatchedReason26Choice {
 	u.Code = new(UnmatchedReason26Choice)
 	return u.Code
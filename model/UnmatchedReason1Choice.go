@@ -10,6 +10,10 @@ type UnmatchedReason1Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason1Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason1Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason2Code)(&value)
 }
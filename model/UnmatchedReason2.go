@@ -10,6 +10,10 @@ type UnmatchedReason2 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (u *UnmatchedReason2) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason2) AddCode() *UnmatchedReason3Choice {
 	u.Code = new(UnmatchedReason3Choice)
 	return u.Code
@@ -10,6 +10,10 @@ type UnmatchedReason20 struct {
 	AdditionalReasonInformation *RestrictedFINXMax210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (u *UnmatchedReason20) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason20) AddCode() *UnmatchedReason27Choice {
 	u.Code = new(UnmatchedReason27Choice)
 	return u.Code
@@ -10,6 +10,10 @@ type UnmatchedReason21Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason21Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason21Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason11Code)(&value)
 }
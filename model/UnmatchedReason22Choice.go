@@ -10,6 +10,10 @@ type UnmatchedReason22Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason22Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason22Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason14Code)(&value)
 }
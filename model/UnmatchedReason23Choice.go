@@ -10,6 +10,10 @@ type UnmatchedReason23Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason23Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason23Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason12Code)(&value)
 }
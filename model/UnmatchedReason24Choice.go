@@ -10,6 +10,10 @@ type UnmatchedReason24Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason24Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason24Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason13Code)(&value)
 }
@@ -10,6 +10,10 @@ type UnmatchedReason25Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason25Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason25Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason13Code)(&value)
 }
@@ -10,6 +10,10 @@ type UnmatchedReason26Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason26Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason26Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason12Code)(&value)
 }
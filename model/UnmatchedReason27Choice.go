@@ -10,6 +10,10 @@ type UnmatchedReason27Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason27Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason27Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason11Code)(&value)
 }
@@ -10,6 +10,10 @@ type UnmatchedReason29Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason29Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason29Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason14Code)(&value)
 }
@@ -10,6 +10,10 @@ type UnmatchedReason2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason2Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason2Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason3Code)(&value)
 }
@@ -10,6 +10,10 @@ type UnmatchedReason3Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason3Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason3Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason1Code)(&value)
 }
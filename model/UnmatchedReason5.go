@@ -10,6 +10,10 @@ type UnmatchedReason5 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (u *UnmatchedReason5) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason5) AddCode() *UnmatchedReason7Choice {
 	u.Code = new(UnmatchedReason7Choice)
 	return u.Code
@@ -10,6 +10,10 @@ type UnmatchedReason6 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (u *UnmatchedReason6) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason6) AddCode() *UnmatchedReason9Choice {
 	u.Code = new(UnmatchedReason9Choice)
 	return u.Code
@@ -10,6 +10,10 @@ type UnmatchedReason7 struct {
 	AdditionalReasonInformation *Max210Text `xml:"AddtlRsnInf,omitempty"`
 }
 
+func (u *UnmatchedReason7) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason7) AddCode() *UnmatchedReason10Choice {
 	u.Code = new(UnmatchedReason10Choice)
 	return u.Code
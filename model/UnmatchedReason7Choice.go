@@ -10,6 +10,10 @@ type UnmatchedReason7Choice struct {
 	Proprietary *GenericIdentification38 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason7Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason7Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason4Code)(&value)
 }
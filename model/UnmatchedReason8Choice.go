@@ -10,6 +10,10 @@ type UnmatchedReason8Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason8Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason8Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason6Code)(&value)
 }
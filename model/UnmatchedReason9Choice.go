@@ -10,6 +10,10 @@ type UnmatchedReason9Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (u *UnmatchedReason9Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnmatchedReason9Choice) SetCode(value string) {
 	u.Code = (*UnmatchedReason5Code)(&value)
 }
@@ -10,8 +10,16 @@ type UnmatchedStatus12Choice struct {
 	Reason []*UnmatchedReason11 `xml:"Rsn"`
 }
 
-func (u *UnmatchedStatus12Choice) SetNoSpecifiedReason(value string) {
+func (u *UnmatchedStatus12Choice) Validate() error {
+	return ValidateElement(u)
+}
+
+func (u *UnmatchedStatus12Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	u.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (u *UnmatchedStatus12Choice) AddReason() *UnmatchedReason11 {
@@ -10,8 +10,16 @@ type UnmatchedStatus13Choice struct {
 	Reason []*UnmatchedReason12 `xml:"Rsn"`
 }
 
-func (u *UnmatchedStatus13Choice) SetNoSpecifiedReason(value string) {
+func (u *UnmatchedStatus13Choice) Validate() error {
+	return ValidateElement(u)
+}
+
+func (u *UnmatchedStatus13Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	u.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (u *UnmatchedStatus13Choice) AddReason() *UnmatchedReason12 {
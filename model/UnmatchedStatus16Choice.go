@@ -10,8 +10,16 @@ type UnmatchedStatus16Choice struct {
 	Reason []*UnmatchedReason15 `xml:"Rsn"`
 }
 
-func (u *UnmatchedStatus16Choice) SetNoSpecifiedReason(value string) {
+func (u *UnmatchedStatus16Choice) Validate() error {
+	return ValidateElement(u)
+}
+
+func (u *UnmatchedStatus16Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	u.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (u *UnmatchedStatus16Choice) AddReason() *UnmatchedReason15 {
@@ -10,8 +10,16 @@ type UnmatchedStatus17Choice struct {
 	Reason []*UnmatchedReason16 `xml:"Rsn"`
 }
 
-func (u *UnmatchedStatus17Choice) SetNoSpecifiedReason(value string) {
+func (u *UnmatchedStatus17Choice) Validate() error {
+	return ValidateElement(u)
+}
+
+func (u *UnmatchedStatus17Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	u.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (u *UnmatchedStatus17Choice) AddReason() *UnmatchedReason16 {
@@ -10,8 +10,16 @@ type UnmatchedStatus18Choice struct {
 	Reason []*UnmatchedReason17 `xml:"Rsn"`
 }
 
-func (u *UnmatchedStatus18Choice) SetNoSpecifiedReason(value string) {
+func (u *UnmatchedStatus18Choice) Validate() error {
+	return ValidateElement(u)
+}
+
+func (u *UnmatchedStatus18Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	u.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (u *UnmatchedStatus18Choice) AddReason() *UnmatchedReason17 {
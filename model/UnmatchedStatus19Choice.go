@@ -10,8 +10,16 @@ type UnmatchedStatus19Choice struct {
 	Reason []*UnmatchedReason18 `xml:"Rsn"`
 }
 
-func (u *UnmatchedStatus19Choice) SetNoSpecifiedReason(value string) {
+func (u *UnmatchedStatus19Choice) Validate() error {
+	return ValidateElement(u)
+}
+
+func (u *UnmatchedStatus19Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	u.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (u *UnmatchedStatus19Choice) AddReason() *UnmatchedReason18 {
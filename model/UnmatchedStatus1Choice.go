@@ -10,8 +10,16 @@ type UnmatchedStatus1Choice struct {
 	Reason []*UnmatchedReason1 `xml:"Rsn,omitempty"`
 }
 
-func (u *UnmatchedStatus1Choice) SetNoSpecifiedReason(value string) {
+func (u *UnmatchedStatus1Choice) Validate() error {
+	return ValidateElement(u)
+}
+
+func (u *UnmatchedStatus1Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	u.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (u *UnmatchedStatus1Choice) AddReason() *UnmatchedReason1 {
@@ -10,8 +10,16 @@ type UnmatchedStatus20Choice struct {
 	Reason []*UnmatchedReason19 `xml:"Rsn"`
 }
 
-func (u *UnmatchedStatus20Choice) SetNoSpecifiedReason(value string) {
+func (u *UnmatchedStatus20Choice) Validate() error {
+	return ValidateElement(u)
+}
+
+func (u *UnmatchedStatus20Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	u.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (u *UnmatchedStatus20Choice) AddReason() *UnmatchedReason19 {
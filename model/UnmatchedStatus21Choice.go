@@ -10,8 +10,16 @@ type UnmatchedStatus21Choice struct {
 	Reason []*UnmatchedReason20 `xml:"Rsn"`
 }
 
-func (u *UnmatchedStatus21Choice) SetNoSpecifiedReason(value string) {
+func (u *UnmatchedStatus21Choice) Validate() error {
+	return ValidateElement(u)
+}
+
+func (u *UnmatchedStatus21Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	u.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (u *UnmatchedStatus21Choice) AddReason() *UnmatchedReason20 {
@@ -10,8 +10,16 @@ type UnmatchedStatus2Choice struct {
 	Reason []*UnmatchedReason2 `xml:"Rsn,omitempty"`
 }
 
-func (u *UnmatchedStatus2Choice) SetNoSpecifiedReason(value string) {
+func (u *UnmatchedStatus2Choice) Validate() error {
+	return ValidateElement(u)
+}
+
+func (u *UnmatchedStatus2Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	u.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (u *UnmatchedStatus2Choice) AddReason() *UnmatchedReason2 {
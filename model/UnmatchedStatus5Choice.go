@@ -10,8 +10,16 @@ type UnmatchedStatus5Choice struct {
 	Reason []*UnmatchedReason6 `xml:"Rsn"`
 }
 
-func (u *UnmatchedStatus5Choice) SetNoSpecifiedReason(value string) {
+func (u *UnmatchedStatus5Choice) Validate() error {
+	return ValidateElement(u)
+}
+
+func (u *UnmatchedStatus5Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	u.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (u *UnmatchedStatus5Choice) AddReason() *UnmatchedReason6 {
@@ -10,8 +10,16 @@ type UnmatchedStatus6Choice struct {
 	Reason []*UnmatchedReason7 `xml:"Rsn"`
 }
 
-func (u *UnmatchedStatus6Choice) SetNoSpecifiedReason(value string) {
+func (u *UnmatchedStatus6Choice) Validate() error {
+	return ValidateElement(u)
+}
+
+func (u *UnmatchedStatus6Choice) SetNoSpecifiedReason(value string) error {
+	if err := NoReasonCode(value).Validate(); err != nil {
+		return err
+	}
 	u.NoSpecifiedReason = (*NoReasonCode)(&value)
+	return nil
 }
 
 func (u *UnmatchedStatus6Choice) AddReason() *UnmatchedReason7 {
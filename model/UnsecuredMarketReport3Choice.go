@@ -10,6 +10,10 @@ type UnsecuredMarketReport3Choice struct {
 	Transaction []*UnsecuredMarketTransaction3 `xml:"Tx"`
 }
 
+func (u *UnsecuredMarketReport3Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnsecuredMarketReport3Choice) SetDataSetAction(value string) {
 	u.DataSetAction = (*ReportPeriodActivity1Code)(&value)
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the details of each individual un
 // secured market transaction.
 type UnsecuredMarketTransaction3 struct {
@@ -80,6 +86,10 @@ type UnsecuredMarketTransaction3 struct {
 	SupplementaryData []*SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (u *UnsecuredMarketTransaction3) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UnsecuredMarketTransaction3) SetReportedTransactionStatus(value string) {
 	u.ReportedTransactionStatus = (*TransactionOperationType1Code)(&value)
 }
@@ -114,10 +124,20 @@ func (u *UnsecuredMarketTransaction3) SetSettlementDate(value string) {
 	u.SettlementDate = (*ISODate)(&value)
 }
 
+func (u *UnsecuredMarketTransaction3) SetSettlementDateFromTime(value time.Time) {
+	u.SettlementDate = new(ISODate)
+	u.SettlementDate.FromTime(value)
+}
+
 func (u *UnsecuredMarketTransaction3) SetMaturityDate(value string) {
 	u.MaturityDate = (*ISODate)(&value)
 }
 
+func (u *UnsecuredMarketTransaction3) SetMaturityDateFromTime(value time.Time) {
+	u.MaturityDate = new(ISODate)
+	u.MaturityDate.FromTime(value)
+}
+
 func (u *UnsecuredMarketTransaction3) SetTransactionType(value string) {
 	u.TransactionType = (*MoneyMarketTransactionType1Code)(&value)
 }
@@ -130,10 +150,19 @@ func (u *UnsecuredMarketTransaction3) SetTransactionNominalAmount(value, currenc
 	u.TransactionNominalAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (u *UnsecuredMarketTransaction3) SetTransactionNominalAmountFromDecimal(value decimal.Decimal, currency string) {
+	u.TransactionNominalAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (u *UnsecuredMarketTransaction3) SetDealPrice(value string) {
 	u.DealPrice = (*PercentageRate)(&value)
 }
 
+func (u *UnsecuredMarketTransaction3) SetDealPriceFromDecimal(value decimal.Decimal) {
+	u.DealPrice = new(PercentageRate)
+	u.DealPrice.FromDecimal(value)
+}
+
 func (u *UnsecuredMarketTransaction3) SetRateType(value string) {
 	u.RateType = (*InterestRateType1Code)(&value)
 }
@@ -13,6 +13,10 @@ type UpdateType11Choice struct {
 	Modification *SecuritiesSettlementTransactionDetails14 `xml:"Mod"`
 }
 
+func (u *UpdateType11Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdateType11Choice) AddAddition() *SecuritiesSettlementTransactionDetails15 {
 	u.Addition = new(SecuritiesSettlementTransactionDetails15)
 	return u.Addition
@@ -13,6 +13,10 @@ type UpdateType14Choice struct {
 	Modification *SecuritiesSettlementTransactionDetails22 `xml:"Mod"`
 }
 
+func (u *UpdateType14Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdateType14Choice) AddAddition() *SecuritiesSettlementTransactionDetails21 {
 	u.Addition = new(SecuritiesSettlementTransactionDetails21)
 	return u.Addition
@@ -10,6 +10,10 @@ type UpdateType15Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (u *UpdateType15Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdateType15Choice) SetCode(value string) {
 	u.Code = (*StatementUpdateType1Code)(&value)
 }
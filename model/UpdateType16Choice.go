@@ -10,6 +10,10 @@ type UpdateType16Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (u *UpdateType16Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdateType16Choice) SetCode(value string) {
 	u.Code = (*StatementUpdateType1Code)(&value)
 }
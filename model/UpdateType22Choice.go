@@ -13,6 +13,10 @@ type UpdateType22Choice struct {
 	Modification *SecuritiesSettlementTransactionDetails25 `xml:"Mod"`
 }
 
+func (u *UpdateType22Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdateType22Choice) AddAddition() *SecuritiesSettlementTransactionDetails23 {
 	u.Addition = new(SecuritiesSettlementTransactionDetails23)
 	return u.Addition
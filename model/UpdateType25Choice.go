@@ -13,6 +13,10 @@ type UpdateType25Choice struct {
 	Modification *SecuritiesSettlementTransactionDetails28 `xml:"Mod"`
 }
 
+func (u *UpdateType25Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdateType25Choice) AddAddition() *SecuritiesSettlementTransactionDetails26 {
 	u.Addition = new(SecuritiesSettlementTransactionDetails26)
 	return u.Addition
@@ -13,6 +13,10 @@ type UpdateType26Choice struct {
 	Modification *SecuritiesSettlementTransactionDetails31 `xml:"Mod"`
 }
 
+func (u *UpdateType26Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdateType26Choice) AddAddition() *SecuritiesSettlementTransactionDetails29 {
 	u.Addition = new(SecuritiesSettlementTransactionDetails29)
 	return u.Addition
@@ -10,6 +10,10 @@ type UpdateType2Choice struct {
 	Proprietary *GenericIdentification20 `xml:"Prtry"`
 }
 
+func (u *UpdateType2Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdateType2Choice) SetCode(value string) {
 	u.Code = (*StatementUpdateType1Code)(&value)
 }
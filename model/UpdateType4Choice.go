@@ -10,6 +10,10 @@ type UpdateType4Choice struct {
 	Proprietary *GenericIdentification30 `xml:"Prtry"`
 }
 
+func (u *UpdateType4Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdateType4Choice) SetCode(value string) {
 	u.Code = (*StatementUpdateType1Code)(&value)
 }
@@ -13,6 +13,10 @@ type UpdateType5Choice struct {
 	Modification *SecuritiesSettlementTransactionDetails2 `xml:"Mod"`
 }
 
+func (u *UpdateType5Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdateType5Choice) AddAddition() *SecuritiesSettlementTransactionDetails3 {
 	u.Addition = new(SecuritiesSettlementTransactionDetails3)
 	return u.Addition
@@ -13,6 +13,10 @@ type UpdateType9Choice struct {
 	Modification *SecuritiesSettlementTransactionDetails10 `xml:"Mod"`
 }
 
+func (u *UpdateType9Choice) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdateType9Choice) AddAddition() *SecuritiesSettlementTransactionDetails8 {
 	u.Addition = new(SecuritiesSettlementTransactionDetails8)
 	return u.Addition
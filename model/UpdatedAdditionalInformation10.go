@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Additional information with update description and date.
 type UpdatedAdditionalInformation10 struct {
 
@@ -13,6 +17,10 @@ type UpdatedAdditionalInformation10 struct {
 	AdditionalInformation []*RestrictedFINZMax8000Text `xml:"AddtlInf"`
 }
 
+func (u *UpdatedAdditionalInformation10) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdatedAdditionalInformation10) SetUpdateDescription(value string) {
 	u.UpdateDescription = (*RestrictedFINXMax140Text)(&value)
 }
@@ -21,6 +29,11 @@ func (u *UpdatedAdditionalInformation10) SetUpdateDate(value string) {
 	u.UpdateDate = (*ISODate)(&value)
 }
 
+func (u *UpdatedAdditionalInformation10) SetUpdateDateFromTime(value time.Time) {
+	u.UpdateDate = new(ISODate)
+	u.UpdateDate.FromTime(value)
+}
+
 func (u *UpdatedAdditionalInformation10) AddAdditionalInformation(value string) {
 	u.AdditionalInformation = append(u.AdditionalInformation, (*RestrictedFINZMax8000Text)(&value))
 }
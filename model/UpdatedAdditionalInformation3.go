@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Additional information with update description and date.
 type UpdatedAdditionalInformation3 struct {
 
@@ -13,6 +17,10 @@ type UpdatedAdditionalInformation3 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf"`
 }
 
+func (u *UpdatedAdditionalInformation3) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdatedAdditionalInformation3) SetUpdateDescription(value string) {
 	u.UpdateDescription = (*Max140Text)(&value)
 }
@@ -21,6 +29,11 @@ func (u *UpdatedAdditionalInformation3) SetUpdateDate(value string) {
 	u.UpdateDate = (*ISODate)(&value)
 }
 
+func (u *UpdatedAdditionalInformation3) SetUpdateDateFromTime(value time.Time) {
+	u.UpdateDate = new(ISODate)
+	u.UpdateDate.FromTime(value)
+}
+
 func (u *UpdatedAdditionalInformation3) SetAdditionalInformation(value string) {
 	u.AdditionalInformation = (*Max350Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Additional information with update description and date.
 type UpdatedAdditionalInformation5 struct {
 
@@ -13,6 +17,10 @@ type UpdatedAdditionalInformation5 struct {
 	AdditionalInformation []*RestrictedFINXMax350Text `xml:"AddtlInf"`
 }
 
+func (u *UpdatedAdditionalInformation5) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdatedAdditionalInformation5) SetUpdateDescription(value string) {
 	u.UpdateDescription = (*RestrictedFINXMax140Text)(&value)
 }
@@ -21,6 +29,11 @@ func (u *UpdatedAdditionalInformation5) SetUpdateDate(value string) {
 	u.UpdateDate = (*ISODate)(&value)
 }
 
+func (u *UpdatedAdditionalInformation5) SetUpdateDateFromTime(value time.Time) {
+	u.UpdateDate = new(ISODate)
+	u.UpdateDate.FromTime(value)
+}
+
 func (u *UpdatedAdditionalInformation5) AddAdditionalInformation(value string) {
 	u.AdditionalInformation = append(u.AdditionalInformation, (*RestrictedFINXMax350Text)(&value))
 }
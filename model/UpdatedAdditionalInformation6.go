@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Additional information with update description and date.
 type UpdatedAdditionalInformation6 struct {
 
@@ -13,6 +17,10 @@ type UpdatedAdditionalInformation6 struct {
 	AdditionalInformation *RestrictedFINXMax350Text `xml:"AddtlInf"`
 }
 
+func (u *UpdatedAdditionalInformation6) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdatedAdditionalInformation6) SetUpdateDescription(value string) {
 	u.UpdateDescription = (*RestrictedFINXMax140Text)(&value)
 }
@@ -21,6 +29,11 @@ func (u *UpdatedAdditionalInformation6) SetUpdateDate(value string) {
 	u.UpdateDate = (*ISODate)(&value)
 }
 
+func (u *UpdatedAdditionalInformation6) SetUpdateDateFromTime(value time.Time) {
+	u.UpdateDate = new(ISODate)
+	u.UpdateDate.FromTime(value)
+}
+
 func (u *UpdatedAdditionalInformation6) SetAdditionalInformation(value string) {
 	u.AdditionalInformation = (*RestrictedFINXMax350Text)(&value)
 }
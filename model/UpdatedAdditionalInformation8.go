@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Additional information with update description and date.
 type UpdatedAdditionalInformation8 struct {
 
@@ -13,6 +17,10 @@ type UpdatedAdditionalInformation8 struct {
 	AdditionalInformation []*Max8000Text `xml:"AddtlInf"`
 }
 
+func (u *UpdatedAdditionalInformation8) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdatedAdditionalInformation8) SetUpdateDescription(value string) {
 	u.UpdateDescription = (*Max140Text)(&value)
 }
@@ -21,6 +29,11 @@ func (u *UpdatedAdditionalInformation8) SetUpdateDate(value string) {
 	u.UpdateDate = (*ISODate)(&value)
 }
 
+func (u *UpdatedAdditionalInformation8) SetUpdateDateFromTime(value time.Time) {
+	u.UpdateDate = new(ISODate)
+	u.UpdateDate.FromTime(value)
+}
+
 func (u *UpdatedAdditionalInformation8) AddAdditionalInformation(value string) {
 	u.AdditionalInformation = append(u.AdditionalInformation, (*Max8000Text)(&value))
 }
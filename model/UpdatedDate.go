@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Date of last revision.
 type UpdatedDate struct {
 
@@ -7,6 +11,15 @@ type UpdatedDate struct {
 	Date *ISODate `xml:"Dt"`
 }
 
+func (u *UpdatedDate) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdatedDate) SetDate(value string) {
 	u.Date = (*ISODate)(&value)
 }
+
+func (u *UpdatedDate) SetDateFromTime(value time.Time) {
+	u.Date = new(ISODate)
+	u.Date.FromTime(value)
+}
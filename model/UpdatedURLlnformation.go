@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Additional information with update description and date.
 type UpdatedURLlnformation struct {
 
@@ -13,6 +17,10 @@ type UpdatedURLlnformation struct {
 	URLAddress *Max256Text `xml:"URLAdr"`
 }
 
+func (u *UpdatedURLlnformation) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdatedURLlnformation) SetUpdateDescription(value string) {
 	u.UpdateDescription = (*Max140Text)(&value)
 }
@@ -21,6 +29,11 @@ func (u *UpdatedURLlnformation) SetUpdateDate(value string) {
 	u.UpdateDate = (*ISODate)(&value)
 }
 
+func (u *UpdatedURLlnformation) SetUpdateDateFromTime(value time.Time) {
+	u.UpdateDate = new(ISODate)
+	u.UpdateDate.FromTime(value)
+}
+
 func (u *UpdatedURLlnformation) SetURLAddress(value string) {
 	u.URLAddress = (*Max256Text)(&value)
 }
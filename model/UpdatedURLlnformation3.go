@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 // Additional information with update description and date.
 type UpdatedURLlnformation3 struct {
 
@@ -13,6 +17,10 @@ type UpdatedURLlnformation3 struct {
 	URLAddress *RestrictedFINZMax256Text `xml:"URLAdr"`
 }
 
+func (u *UpdatedURLlnformation3) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UpdatedURLlnformation3) SetUpdateDescription(value string) {
 	u.UpdateDescription = (*RestrictedFINXMax140Text)(&value)
 }
@@ -21,6 +29,11 @@ func (u *UpdatedURLlnformation3) SetUpdateDate(value string) {
 	u.UpdateDate = (*ISODate)(&value)
 }
 
+func (u *UpdatedURLlnformation3) SetUpdateDateFromTime(value time.Time) {
+	u.UpdateDate = new(ISODate)
+	u.UpdateDate.FromTime(value)
+}
+
 func (u *UpdatedURLlnformation3) SetURLAddress(value string) {
 	u.URLAddress = (*RestrictedFINZMax256Text)(&value)
 }
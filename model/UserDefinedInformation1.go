@@ -10,6 +10,10 @@ type UserDefinedInformation1 struct {
 	Information *Max140Text `xml:"Inf"`
 }
 
+func (u *UserDefinedInformation1) Validate() error {
+	return ValidateElement(u)
+}
+
 func (u *UserDefinedInformation1) SetLabel(value string) {
 	u.Label = (*Max35Text)(&value)
 }
@@ -0,0 +1,227 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/yudaprama/iso20022/validation"
+)
+
+var (
+	// ErrMissing is reported for a mandatory element or attribute that is not present.
+	ErrMissing = errors.New("mandatory element is missing")
+
+	// ErrChoice is reported for a choice that does not contain exactly one element.
+	ErrChoice = errors.New("exactly one element of the choice must be present")
+)
+
+// Unchecked, when set, is called by ValidateElement with the values of the
+// simple types that have no Validate method, such as the Code types whose
+// values are not known until they are generated from their XSDs. Such values
+// are accepted.
+var Unchecked func(typ, value string)
+
+// OccurrenceError reports a repeated element whose number of occurrences is out of bounds.
+type OccurrenceError struct {
+	Count int
+	Min   int
+	Max   int
+}
+
+func (e *OccurrenceError) Error() string {
+	if e.Count < e.Min {
+		return fmt.Sprintf("element occurs %d times, at least %d required", e.Count, e.Min)
+	}
+	return fmt.Sprintf("element occurs %d times, at most %d allowed", e.Count, e.Max)
+}
+
+// ValidationError reports a problem found at a given XML path of a message,
+// for example /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[3]/ChrgBr.
+type ValidationError struct {
+	Path string
+	Err  error
+}
+
+func (e *ValidationError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors aggregates all problems found while validating an element.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	list := make([]string, len(e))
+	for i, err := range e {
+		list[i] = err.Error()
+	}
+	return strings.Join(list, "\n")
+}
+
+type validator interface {
+	Validate() error
+}
+
+// ValidateElement checks an element against the structure of its type: mandatory
+// elements and attributes must be present, repeated elements must respect their
+// number of occurrences, exactly one element of a choice must be present and simple
+// type values must respect their facets. Child elements are checked with their own
+// Validate method.
+//
+// The returned error is nil or ValidationErrors, with paths relative to the element.
+// When v carries an XMLName, as Document types do, the paths start with its root element.
+func ValidateElement(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	var errs ValidationErrors
+	prefix := ""
+	if f, ok := rv.Type().FieldByName("XMLName"); ok {
+		if name := xmlName(f.Tag.Get("xml")); name != "" {
+			prefix = "/" + name
+		}
+	}
+	validateFields(prefix, rv, &errs)
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func validateFields(path string, rv reflect.Value, errs *ValidationErrors) {
+	t := rv.Type()
+	choice := strings.HasSuffix(t.Name(), "Choice")
+	present := 0
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("xml")
+		if f.Name == "XMLName" || tag == "-" || f.PkgPath != "" {
+			continue
+		}
+		if f.Anonymous && tag == "" || tag == ",inline" {
+			// Fields of an embedded struct, or of a struct field tagged
+			// inline, are elements of the enclosing element.
+			if fv := reflect.Indirect(rv.Field(i)); fv.Kind() == reflect.Struct {
+				validateFields(path, fv, errs)
+			}
+			continue
+		}
+		name, options := tag, ""
+		if i := strings.IndexByte(tag, ','); i >= 0 {
+			name, options = tag[:i], tag[i:]
+		}
+		name = xmlName(name)
+		optional := choice || strings.Contains(options, ",omitempty")
+		fv := rv.Field(i)
+		switch {
+		case strings.Contains(options, ",attr"):
+			if fv.Kind() == reflect.String && fv.Len() == 0 && !optional {
+				*errs = append(*errs, &ValidationError{Path: path + "/@" + name, Err: ErrMissing})
+			}
+		case strings.Contains(options, ",chardata"):
+			if fv.Kind() == reflect.String && fv.Len() == 0 && !optional {
+				*errs = append(*errs, &ValidationError{Path: path, Err: ErrMissing})
+			}
+		case strings.Contains(options, ",innerxml"), strings.Contains(options, ",any"):
+			// Content is not constrained by the schema of the message.
+		case fv.Kind() == reflect.Slice:
+			if fv.Len() > 0 {
+				present++
+			}
+			min, max := 0, -1
+			if !optional {
+				min = 1
+			}
+			if s, ok := f.Tag.Lookup("xsd"); ok && strings.HasPrefix(s, "maxOccurs=") {
+				max, _ = strconv.Atoi(strings.TrimPrefix(s, "maxOccurs="))
+			}
+			if fv.Len() < min || (max >= 0 && fv.Len() > max) {
+				*errs = append(*errs, &ValidationError{Path: path + "/" + name, Err: &OccurrenceError{Count: fv.Len(), Min: min, Max: max}})
+			}
+			for j := 0; j < fv.Len(); j++ {
+				validateValue(path+"/"+name+"["+strconv.Itoa(j+1)+"]", fv.Index(j), errs)
+			}
+		case fv.Kind() == reflect.Ptr:
+			if fv.IsNil() {
+				if !optional {
+					*errs = append(*errs, &ValidationError{Path: path + "/" + name, Err: ErrMissing})
+				}
+				continue
+			}
+			present++
+			validateValue(path+"/"+name, fv, errs)
+		}
+	}
+	if choice && present != 1 {
+		*errs = append(*errs, &ValidationError{Path: path, Err: ErrChoice})
+	}
+}
+
+func validateValue(path string, fv reflect.Value, errs *ValidationErrors) {
+	if fv.Kind() == reflect.Ptr && fv.IsNil() {
+		return
+	}
+	if v, ok := fv.Interface().(validator); ok {
+		appendErrors(path, v.Validate(), errs)
+		return
+	}
+	if fv.Kind() == reflect.Ptr {
+		fv = fv.Elem()
+	}
+	switch {
+	case fv.Kind() == reflect.Struct:
+		validateFields(path, fv, errs)
+	case fv.Kind() == reflect.String && fv.Type().PkgPath() != "" && Unchecked != nil:
+		Unchecked(fv.Type().Name(), fv.String())
+	}
+}
+
+func appendErrors(path string, err error, errs *ValidationErrors) {
+	if err == nil {
+		return
+	}
+	if list, ok := err.(ValidationErrors); ok {
+		for _, e := range list {
+			*errs = append(*errs, &ValidationError{Path: path + e.Path, Err: e.Err})
+		}
+		return
+	}
+	*errs = append(*errs, &ValidationError{Path: path, Err: err})
+}
+
+// xmlName strips the namespace from the element name of an xml struct tag.
+func xmlName(name string) string {
+	if i := strings.LastIndexByte(name, ' '); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// validateCreditorReference checks the check digits of an ISO 11649 structured
+// creditor reference, which is a reference of type SCOR issued by ISO or, when
+// the issuer is not given, a reference of type SCOR starting with RF.
+func validateCreditorReference(code *DocumentType3Code, issuer, reference *Max35Text, path string) error {
+	if code == nil || *code != DocumentType3CodeSCOR || reference == nil {
+		return nil
+	}
+	if issuer == nil && !strings.HasPrefix(strings.ToUpper(string(*reference)), "RF") || issuer != nil && *issuer != "ISO" {
+		return nil
+	}
+	if err := validation.ValidateCreditorReference(string(*reference)); err != nil {
+		return ValidationErrors{{Path: path, Err: err}}
+	}
+	return nil
+}
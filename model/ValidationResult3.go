@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Describes the error that is the cause of the rejection.
 type ValidationResult3 struct {
 
@@ -16,10 +20,19 @@ type ValidationResult3 struct {
 	Element []*ElementIdentification3 `xml:"Elmt,omitempty"`
 }
 
+func (v *ValidationResult3) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *ValidationResult3) SetSequenceNumber(value string) {
 	v.SequenceNumber = (*Number)(&value)
 }
 
+func (v *ValidationResult3) SetSequenceNumberFromDecimal(value decimal.Decimal) {
+	v.SequenceNumber = new(Number)
+	v.SequenceNumber.FromDecimal(value)
+}
+
 func (v *ValidationResult3) SetRuleIdentification(value string) {
 	v.RuleIdentification = (*Max35Text)(&value)
 }
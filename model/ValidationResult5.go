@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Detailed description of the differences.
 type ValidationResult5 struct {
 
@@ -16,10 +20,19 @@ type ValidationResult5 struct {
 	MisMatchedElement []*ElementIdentification1 `xml:"MisMtchdElmt,omitempty"`
 }
 
+func (v *ValidationResult5) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *ValidationResult5) SetSequenceNumber(value string) {
 	v.SequenceNumber = (*Number)(&value)
 }
 
+func (v *ValidationResult5) SetSequenceNumberFromDecimal(value decimal.Decimal) {
+	v.SequenceNumber = new(Number)
+	v.SequenceNumber.FromDecimal(value)
+}
+
 func (v *ValidationResult5) SetRuleIdentification(value string) {
 	v.RuleIdentification = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type ValidationRuleSchemeName1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (v *ValidationRuleSchemeName1Choice) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *ValidationRuleSchemeName1Choice) SetCode(value string) {
 	v.Code = (*ExternalValidationRuleIdentification1Code)(&value)
 }
@@ -13,6 +13,10 @@ type ValidationStatusInformation1 struct {
 	AdditionalStatusReasonInformation []*Max105Text `xml:"AddtlStsRsnInf,omitempty"`
 }
 
+func (v *ValidationStatusInformation1) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *ValidationStatusInformation1) SetStatus(value string) {
 	v.Status = (*TechnicalValidationStatus1Code)(&value)
 }
@@ -18,6 +18,10 @@ type ValidationStatusReason1 struct {
 	AdditionalInformation []*Max105Text `xml:"AddtlInf,omitempty"`
 }
 
+func (v *ValidationStatusReason1) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *ValidationStatusReason1) AddOriginator() *PartyIdentification77 {
 	v.Originator = new(PartyIdentification77)
 	return v.Originator
@@ -1,3 +1,7 @@
 package model
 
 type ValorenIdentifier string
+
+func (v ValorenIdentifier) Validate() error {
+	return validateLength("ValorenIdentifier", string(v), 1, 35)
+}
@@ -16,6 +16,10 @@ type ValuationData2 struct {
 	SettlementParty *PartyIdentification8Choice `xml:"SttlmPty,omitempty"`
 }
 
+func (v *ValuationData2) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *ValuationData2) SetValuationReference(value string) {
 	v.ValuationReference = (*Max35Text)(&value)
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Valuation dealing processing characteristics linked to the instrument, ie, not to  the market.
 type ValuationDealingProcessingCharacteristics2 struct {
 
@@ -25,6 +29,10 @@ type ValuationDealingProcessingCharacteristics2 struct {
 	PriceCurrency []*ActiveCurrencyCode `xml:"PricCcy"`
 }
 
+func (v *ValuationDealingProcessingCharacteristics2) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *ValuationDealingProcessingCharacteristics2) SetValuationFrequency(value string) {
 	v.ValuationFrequency = (*EventFrequency5Code)(&value)
 }
@@ -37,14 +45,29 @@ func (v *ValuationDealingProcessingCharacteristics2) SetDecimalisationUnits(valu
 	v.DecimalisationUnits = (*Number)(&value)
 }
 
+func (v *ValuationDealingProcessingCharacteristics2) SetDecimalisationUnitsFromDecimal(value decimal.Decimal) {
+	v.DecimalisationUnits = new(Number)
+	v.DecimalisationUnits.FromDecimal(value)
+}
+
 func (v *ValuationDealingProcessingCharacteristics2) SetDecimalisationPrice(value string) {
 	v.DecimalisationPrice = (*Number)(&value)
 }
 
+func (v *ValuationDealingProcessingCharacteristics2) SetDecimalisationPriceFromDecimal(value decimal.Decimal) {
+	v.DecimalisationPrice = new(Number)
+	v.DecimalisationPrice.FromDecimal(value)
+}
+
 func (v *ValuationDealingProcessingCharacteristics2) SetDualFundIndicator(value string) {
 	v.DualFundIndicator = (*YesNoIndicator)(&value)
 }
 
+func (v *ValuationDealingProcessingCharacteristics2) SetDualFundIndicatorFromBool(value bool) {
+	v.DualFundIndicator = new(YesNoIndicator)
+	v.DualFundIndicator.FromBool(value)
+}
+
 func (v *ValuationDealingProcessingCharacteristics2) SetPriceMethod(value string) {
 	v.PriceMethod = (*PriceMethod1Code)(&value)
 }
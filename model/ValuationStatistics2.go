@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Statistical data related to the price change of a security.
 type ValuationStatistics2 struct {
 
@@ -22,6 +26,10 @@ type ValuationStatistics2 struct {
 	ByUserDefinedTimePeriod []*StatisticsByUserDefinedTimePeriod1 `xml:"ByUsrDfndTmPrd,omitempty"`
 }
 
+func (v *ValuationStatistics2) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *ValuationStatistics2) SetCurrency(value string) {
 	v.Currency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -40,6 +48,11 @@ func (v *ValuationStatistics2) SetYield(value string) {
 	v.Yield = (*PercentageRate)(&value)
 }
 
+func (v *ValuationStatistics2) SetYieldFromDecimal(value decimal.Decimal) {
+	v.Yield = new(PercentageRate)
+	v.Yield.FromDecimal(value)
+}
+
 func (v *ValuationStatistics2) AddByPredefinedTimePeriods() *StatisticsByPredefinedTimePeriods1 {
 	v.ByPredefinedTimePeriods = new(StatisticsByPredefinedTimePeriods1)
 	return v.ByPredefinedTimePeriods
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Statistical data related to the price change of a security.
 type ValuationStatistics3 struct {
 
@@ -22,6 +26,10 @@ type ValuationStatistics3 struct {
 	ByUserDefinedTimePeriod []*StatisticsByUserDefinedTimePeriod2 `xml:"ByUsrDfndTmPrd,omitempty"`
 }
 
+func (v *ValuationStatistics3) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *ValuationStatistics3) SetCurrency(value string) {
 	v.Currency = (*ActiveOrHistoricCurrencyCode)(&value)
 }
@@ -40,6 +48,11 @@ func (v *ValuationStatistics3) SetYield(value string) {
 	v.Yield = (*PercentageRate)(&value)
 }
 
+func (v *ValuationStatistics3) SetYieldFromDecimal(value decimal.Decimal) {
+	v.Yield = new(PercentageRate)
+	v.Yield.FromDecimal(value)
+}
+
 func (v *ValuationStatistics3) AddByPredefinedTimePeriods() *StatisticsByPredefinedTimePeriods2 {
 	v.ByPredefinedTimePeriods = new(StatisticsByPredefinedTimePeriods2)
 	return v.ByPredefinedTimePeriods
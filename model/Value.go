@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Posting of an item to a cash account, in the context of a cash transaction, that results in an increase or decrease to the balance of the account.
 type Value struct {
 
@@ -10,10 +14,18 @@ type Value struct {
 	AlternateCurrencyItem []*ActiveOrHistoricCurrencyAndAmount `xml:"AltrnCcyItm"`
 }
 
+func (v *Value) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *Value) SetBaseCurrencyItem(value, currency string) {
 	v.BaseCurrencyItem = NewActiveOrHistoricCurrencyAndAmount(value, currency)
 }
 
+func (v *Value) SetBaseCurrencyItemFromDecimal(value decimal.Decimal, currency string) {
+	v.BaseCurrencyItem = NewActiveOrHistoricCurrencyAndAmount(value.String(), currency)
+}
+
 func (v *Value) AddAlternateCurrencyItem(value, currency string) {
 	v.AlternateCurrencyItem = append(v.AlternateCurrencyItem, NewActiveOrHistoricCurrencyAndAmount(value, currency))
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Provides the index used to define the rate and optionaly the basis point spread.
 type VariableInterest1Rate struct {
 
@@ -10,6 +14,10 @@ type VariableInterest1Rate struct {
 	BasisPointSpread *Number `xml:"BsisPtSprd,omitempty"`
 }
 
+func (v *VariableInterest1Rate) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VariableInterest1Rate) SetIndex(value string) {
 	v.Index = (*Max35Text)(&value)
 }
@@ -17,3 +25,8 @@ func (v *VariableInterest1Rate) SetIndex(value string) {
 func (v *VariableInterest1Rate) SetBasisPointSpread(value string) {
 	v.BasisPointSpread = (*Number)(&value)
 }
+
+func (v *VariableInterest1Rate) SetBasisPointSpreadFromDecimal(value decimal.Decimal) {
+	v.BasisPointSpread = new(Number)
+	v.BasisPointSpread.FromDecimal(value)
+}
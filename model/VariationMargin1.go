@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Elements used to calculate the collateral margin call for the variation margin.
 type VariationMargin1 struct {
 
@@ -19,10 +23,18 @@ type VariationMargin1 struct {
 	RoundingMethod *RoundingMethod1Code `xml:"RndgMtd"`
 }
 
+func (v *VariationMargin1) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VariationMargin1) SetThresholdAmount(value, currency string) {
 	v.ThresholdAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (v *VariationMargin1) SetThresholdAmountFromDecimal(value decimal.Decimal, currency string) {
+	v.ThresholdAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (v *VariationMargin1) SetThresholdType(value string) {
 	v.ThresholdType = (*ThresholdType1Code)(&value)
 }
@@ -31,10 +43,18 @@ func (v *VariationMargin1) SetMinimumTransferAmount(value, currency string) {
 	v.MinimumTransferAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (v *VariationMargin1) SetMinimumTransferAmountFromDecimal(value decimal.Decimal, currency string) {
+	v.MinimumTransferAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (v *VariationMargin1) SetRoundingAmount(value, currency string) {
 	v.RoundingAmount = NewActiveCurrencyAndAmount(value, currency)
 }
 
+func (v *VariationMargin1) SetRoundingAmountFromDecimal(value decimal.Decimal, currency string) {
+	v.RoundingAmount = NewActiveCurrencyAndAmount(value.String(), currency)
+}
+
 func (v *VariationMargin1) SetRoundingMethod(value string) {
 	v.RoundingMethod = (*RoundingMethod1Code)(&value)
 }
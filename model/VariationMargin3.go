@@ -25,6 +25,10 @@ type VariationMargin3 struct {
 	FailsHaircut *Amount2 `xml:"FlsHrcut,omitempty"`
 }
 
+func (v *VariationMargin3) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VariationMargin3) AddFinancialInstrumentIdentification() *SecurityIdentification14 {
 	v.FinancialInstrumentIdentification = new(SecurityIdentification14)
 	return v.FinancialInstrumentIdentification
@@ -10,6 +10,10 @@ type VariationMarginDispute1 struct {
 	ResolutionTypeDetails []*DisputeResolutionType2Choice `xml:"RsltnTpDtls,omitempty"`
 }
 
+func (v *VariationMarginDispute1) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VariationMarginDispute1) AddDisputeDetails() *Dispute1 {
 	v.DisputeDetails = new(Dispute1)
 	return v.DisputeDetails
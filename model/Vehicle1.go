@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information related to a vehicle used during a transaction.
 type Vehicle1 struct {
 
@@ -43,6 +47,10 @@ type Vehicle1 struct {
 	AdditionalVehicleData []*Vehicle2 `xml:"AddtlVhclData,omitempty"`
 }
 
+func (v *Vehicle1) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *Vehicle1) SetVehicleNumber(value string) {
 	v.VehicleNumber = (*Max35NumericText)(&value)
 }
@@ -67,14 +75,29 @@ func (v *Vehicle1) SetReplacementCar(value string) {
 	v.ReplacementCar = (*TrueFalseIndicator)(&value)
 }
 
+func (v *Vehicle1) SetReplacementCarFromBool(value bool) {
+	v.ReplacementCar = new(TrueFalseIndicator)
+	v.ReplacementCar.FromBool(value)
+}
+
 func (v *Vehicle1) SetOdometer(value string) {
 	v.Odometer = (*DecimalNumber)(&value)
 }
 
+func (v *Vehicle1) SetOdometerFromDecimal(value decimal.Decimal) {
+	v.Odometer = new(DecimalNumber)
+	v.Odometer.FromDecimal(value)
+}
+
 func (v *Vehicle1) SetHubometer(value string) {
 	v.Hubometer = (*DecimalNumber)(&value)
 }
 
+func (v *Vehicle1) SetHubometerFromDecimal(value decimal.Decimal) {
+	v.Hubometer = new(DecimalNumber)
+	v.Hubometer.FromDecimal(value)
+}
+
 func (v *Vehicle1) SetTrailerHours(value string) {
 	v.TrailerHours = (*Max35Text)(&value)
 }
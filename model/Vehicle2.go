@@ -13,6 +13,10 @@ type Vehicle2 struct {
 	Data *Max35Text `xml:"Data"`
 }
 
+func (v *Vehicle2) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *Vehicle2) SetType(value string) {
 	v.Type = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type VerificationReason1Choice struct {
 	Proprietary *Max35Text `xml:"Prtry"`
 }
 
+func (v *VerificationReason1Choice) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VerificationReason1Choice) SetCode(value string) {
 	v.Code = (*ExternalVerificationReason1Code)(&value)
 }
@@ -19,6 +19,10 @@ type VerificationReport1 struct {
 	UpdatedPartyAndAccountIdentification *IdentificationInformation1 `xml:"UpdtdPtyAndAcctId,omitempty"`
 }
 
+func (v *VerificationReport1) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VerificationReport1) SetOriginalIdentification(value string) {
 	v.OriginalIdentification = (*Max35Text)(&value)
 }
@@ -27,6 +31,11 @@ func (v *VerificationReport1) SetVerification(value string) {
 	v.Verification = (*IdentificationVerificationIndicator)(&value)
 }
 
+func (v *VerificationReport1) SetVerificationFromBool(value bool) {
+	v.Verification = new(IdentificationVerificationIndicator)
+	v.Verification.FromBool(value)
+}
+
 func (v *VerificationReport1) AddReason() *VerificationReason1Choice {
 	v.Reason = new(VerificationReason1Choice)
 	return v.Reason
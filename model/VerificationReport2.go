@@ -19,6 +19,10 @@ type VerificationReport2 struct {
 	UpdatedPartyAndAccountIdentification *IdentificationInformation2 `xml:"UpdtdPtyAndAcctId,omitempty"`
 }
 
+func (v *VerificationReport2) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VerificationReport2) SetOriginalIdentification(value string) {
 	v.OriginalIdentification = (*Max35Text)(&value)
 }
@@ -27,6 +31,11 @@ func (v *VerificationReport2) SetVerification(value string) {
 	v.Verification = (*IdentificationVerificationIndicator)(&value)
 }
 
+func (v *VerificationReport2) SetVerificationFromBool(value bool) {
+	v.Verification = new(IdentificationVerificationIndicator)
+	v.Verification.FromBool(value)
+}
+
 func (v *VerificationReport2) AddReason() *VerificationReason1Choice {
 	v.Reason = new(VerificationReason1Choice)
 	return v.Reason
@@ -10,6 +10,10 @@ type Vote1Choice struct {
 	GlobalVoteInstruction []*Vote3 `xml:"GblVoteInstr"`
 }
 
+func (v *Vote1Choice) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *Vote1Choice) AddVoteInstruction() *Vote4 {
 	newValue := new(Vote4)
 	v.VoteInstruction = append(v.VoteInstruction, newValue)
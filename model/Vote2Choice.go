@@ -10,6 +10,10 @@ type Vote2Choice struct {
 	GlobalVoteInstruction []*Vote3 `xml:"GblVoteInstr"`
 }
 
+func (v *Vote2Choice) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *Vote2Choice) AddVoteInstruction() *Vote4 {
 	newValue := new(Vote4)
 	v.VoteInstruction = append(v.VoteInstruction, newValue)
@@ -10,6 +10,10 @@ type Vote3 struct {
 	VoteOption *VoteInstruction2Code `xml:"VoteOptn"`
 }
 
+func (v *Vote3) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *Vote3) SetIssuerLabel(value string) {
 	v.IssuerLabel = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type Vote3Choice struct {
 	GlobalVoteInstruction []*Vote9 `xml:"GblVoteInstr"`
 }
 
+func (v *Vote3Choice) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *Vote3Choice) AddVoteInstruction() *Vote8 {
 	newValue := new(Vote8)
 	v.VoteInstruction = append(v.VoteInstruction, newValue)
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Decision of the voting party for one resolution. Several types of decisions can be indicated to allow for split vote specification.
 type Vote4 struct {
 
@@ -31,6 +35,10 @@ type Vote4 struct {
 	NoAction *Number `xml:"NoActn,omitempty"`
 }
 
+func (v *Vote4) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *Vote4) SetIssuerLabel(value string) {
 	v.IssuerLabel = (*Max35Text)(&value)
 }
@@ -39,30 +47,70 @@ func (v *Vote4) SetFor(value string) {
 	v.For = (*Number)(&value)
 }
 
+func (v *Vote4) SetForFromDecimal(value decimal.Decimal) {
+	v.For = new(Number)
+	v.For.FromDecimal(value)
+}
+
 func (v *Vote4) SetAgainst(value string) {
 	v.Against = (*Number)(&value)
 }
 
+func (v *Vote4) SetAgainstFromDecimal(value decimal.Decimal) {
+	v.Against = new(Number)
+	v.Against.FromDecimal(value)
+}
+
 func (v *Vote4) SetAbstain(value string) {
 	v.Abstain = (*Number)(&value)
 }
 
+func (v *Vote4) SetAbstainFromDecimal(value decimal.Decimal) {
+	v.Abstain = new(Number)
+	v.Abstain.FromDecimal(value)
+}
+
 func (v *Vote4) SetWithhold(value string) {
 	v.Withhold = (*Number)(&value)
 }
 
+func (v *Vote4) SetWithholdFromDecimal(value decimal.Decimal) {
+	v.Withhold = new(Number)
+	v.Withhold.FromDecimal(value)
+}
+
 func (v *Vote4) SetWithManagement(value string) {
 	v.WithManagement = (*Number)(&value)
 }
 
+func (v *Vote4) SetWithManagementFromDecimal(value decimal.Decimal) {
+	v.WithManagement = new(Number)
+	v.WithManagement.FromDecimal(value)
+}
+
 func (v *Vote4) SetAgainstManagement(value string) {
 	v.AgainstManagement = (*Number)(&value)
 }
 
+func (v *Vote4) SetAgainstManagementFromDecimal(value decimal.Decimal) {
+	v.AgainstManagement = new(Number)
+	v.AgainstManagement.FromDecimal(value)
+}
+
 func (v *Vote4) SetDiscretionary(value string) {
 	v.Discretionary = (*Number)(&value)
 }
 
+func (v *Vote4) SetDiscretionaryFromDecimal(value decimal.Decimal) {
+	v.Discretionary = new(Number)
+	v.Discretionary.FromDecimal(value)
+}
+
 func (v *Vote4) SetNoAction(value string) {
 	v.NoAction = (*Number)(&value)
 }
+
+func (v *Vote4) SetNoActionFromDecimal(value decimal.Decimal) {
+	v.NoAction = new(Number)
+	v.NoAction.FromDecimal(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Indicates the number of voting rights cast to a resolution.
 type Vote5 struct {
 
@@ -25,6 +29,10 @@ type Vote5 struct {
 	NoAction *Number `xml:"NoActn,omitempty"`
 }
 
+func (v *Vote5) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *Vote5) SetIssuerLabel(value string) {
 	v.IssuerLabel = (*Max35Text)(&value)
 }
@@ -33,22 +41,52 @@ func (v *Vote5) SetAccepted(value string) {
 	v.Accepted = (*YesNoIndicator)(&value)
 }
 
+func (v *Vote5) SetAcceptedFromBool(value bool) {
+	v.Accepted = new(YesNoIndicator)
+	v.Accepted.FromBool(value)
+}
+
 func (v *Vote5) SetFor(value string) {
 	v.For = (*Number)(&value)
 }
 
+func (v *Vote5) SetForFromDecimal(value decimal.Decimal) {
+	v.For = new(Number)
+	v.For.FromDecimal(value)
+}
+
 func (v *Vote5) SetAgainst(value string) {
 	v.Against = (*Number)(&value)
 }
 
+func (v *Vote5) SetAgainstFromDecimal(value decimal.Decimal) {
+	v.Against = new(Number)
+	v.Against.FromDecimal(value)
+}
+
 func (v *Vote5) SetAbstain(value string) {
 	v.Abstain = (*Number)(&value)
 }
 
+func (v *Vote5) SetAbstainFromDecimal(value decimal.Decimal) {
+	v.Abstain = new(Number)
+	v.Abstain.FromDecimal(value)
+}
+
 func (v *Vote5) SetWithhold(value string) {
 	v.Withhold = (*Number)(&value)
 }
 
+func (v *Vote5) SetWithholdFromDecimal(value decimal.Decimal) {
+	v.Withhold = new(Number)
+	v.Withhold.FromDecimal(value)
+}
+
 func (v *Vote5) SetNoAction(value string) {
 	v.NoAction = (*Number)(&value)
 }
+
+func (v *Vote5) SetNoActionFromDecimal(value decimal.Decimal) {
+	v.NoAction = new(Number)
+	v.NoAction.FromDecimal(value)
+}
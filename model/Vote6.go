@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Decision of the voting party for one resolution. Several types of decisions can be indicated to allow for split vote specification.
 type Vote6 struct {
 
@@ -43,6 +47,10 @@ type Vote6 struct {
 	Withdrawn *YesNoIndicator `xml:"Wdrwn,omitempty"`
 }
 
+func (v *Vote6) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *Vote6) SetIssuerLabel(value string) {
 	v.IssuerLabel = (*Max35Text)(&value)
 }
@@ -51,46 +59,106 @@ func (v *Vote6) SetFor(value string) {
 	v.For = (*Number)(&value)
 }
 
+func (v *Vote6) SetForFromDecimal(value decimal.Decimal) {
+	v.For = new(Number)
+	v.For.FromDecimal(value)
+}
+
 func (v *Vote6) SetAgainst(value string) {
 	v.Against = (*Number)(&value)
 }
 
+func (v *Vote6) SetAgainstFromDecimal(value decimal.Decimal) {
+	v.Against = new(Number)
+	v.Against.FromDecimal(value)
+}
+
 func (v *Vote6) SetAbstain(value string) {
 	v.Abstain = (*Number)(&value)
 }
 
+func (v *Vote6) SetAbstainFromDecimal(value decimal.Decimal) {
+	v.Abstain = new(Number)
+	v.Abstain.FromDecimal(value)
+}
+
 func (v *Vote6) SetWithhold(value string) {
 	v.Withhold = (*Number)(&value)
 }
 
+func (v *Vote6) SetWithholdFromDecimal(value decimal.Decimal) {
+	v.Withhold = new(Number)
+	v.Withhold.FromDecimal(value)
+}
+
 func (v *Vote6) SetWithManagement(value string) {
 	v.WithManagement = (*Number)(&value)
 }
 
+func (v *Vote6) SetWithManagementFromDecimal(value decimal.Decimal) {
+	v.WithManagement = new(Number)
+	v.WithManagement.FromDecimal(value)
+}
+
 func (v *Vote6) SetAgainstManagement(value string) {
 	v.AgainstManagement = (*Number)(&value)
 }
 
+func (v *Vote6) SetAgainstManagementFromDecimal(value decimal.Decimal) {
+	v.AgainstManagement = new(Number)
+	v.AgainstManagement.FromDecimal(value)
+}
+
 func (v *Vote6) SetDiscretionary(value string) {
 	v.Discretionary = (*Number)(&value)
 }
 
+func (v *Vote6) SetDiscretionaryFromDecimal(value decimal.Decimal) {
+	v.Discretionary = new(Number)
+	v.Discretionary.FromDecimal(value)
+}
+
 func (v *Vote6) SetOneYear(value string) {
 	v.OneYear = (*Number)(&value)
 }
 
+func (v *Vote6) SetOneYearFromDecimal(value decimal.Decimal) {
+	v.OneYear = new(Number)
+	v.OneYear.FromDecimal(value)
+}
+
 func (v *Vote6) SetTwoYears(value string) {
 	v.TwoYears = (*Number)(&value)
 }
 
+func (v *Vote6) SetTwoYearsFromDecimal(value decimal.Decimal) {
+	v.TwoYears = new(Number)
+	v.TwoYears.FromDecimal(value)
+}
+
 func (v *Vote6) SetThreeYears(value string) {
 	v.ThreeYears = (*Number)(&value)
 }
 
+func (v *Vote6) SetThreeYearsFromDecimal(value decimal.Decimal) {
+	v.ThreeYears = new(Number)
+	v.ThreeYears.FromDecimal(value)
+}
+
 func (v *Vote6) SetNoAction(value string) {
 	v.NoAction = (*Number)(&value)
 }
 
+func (v *Vote6) SetNoActionFromDecimal(value decimal.Decimal) {
+	v.NoAction = new(Number)
+	v.NoAction.FromDecimal(value)
+}
+
 func (v *Vote6) SetWithdrawn(value string) {
 	v.Withdrawn = (*YesNoIndicator)(&value)
 }
+
+func (v *Vote6) SetWithdrawnFromBool(value bool) {
+	v.Withdrawn = new(YesNoIndicator)
+	v.Withdrawn.FromBool(value)
+}
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Indicates the number of voting rights cast to a resolution.
 type Vote7 struct {
 
@@ -34,6 +38,10 @@ type Vote7 struct {
 	NoAction *Number `xml:"NoActn,omitempty"`
 }
 
+func (v *Vote7) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *Vote7) SetIssuerLabel(value string) {
 	v.IssuerLabel = (*Max35Text)(&value)
 }
@@ -46,30 +54,70 @@ func (v *Vote7) SetFor(value string) {
 	v.For = (*Number)(&value)
 }
 
+func (v *Vote7) SetForFromDecimal(value decimal.Decimal) {
+	v.For = new(Number)
+	v.For.FromDecimal(value)
+}
+
 func (v *Vote7) SetAgainst(value string) {
 	v.Against = (*Number)(&value)
 }
 
+func (v *Vote7) SetAgainstFromDecimal(value decimal.Decimal) {
+	v.Against = new(Number)
+	v.Against.FromDecimal(value)
+}
+
 func (v *Vote7) SetAbstain(value string) {
 	v.Abstain = (*Number)(&value)
 }
 
+func (v *Vote7) SetAbstainFromDecimal(value decimal.Decimal) {
+	v.Abstain = new(Number)
+	v.Abstain.FromDecimal(value)
+}
+
 func (v *Vote7) SetWithhold(value string) {
 	v.Withhold = (*Number)(&value)
 }
 
+func (v *Vote7) SetWithholdFromDecimal(value decimal.Decimal) {
+	v.Withhold = new(Number)
+	v.Withhold.FromDecimal(value)
+}
+
 func (v *Vote7) SetOneYear(value string) {
 	v.OneYear = (*Number)(&value)
 }
 
+func (v *Vote7) SetOneYearFromDecimal(value decimal.Decimal) {
+	v.OneYear = new(Number)
+	v.OneYear.FromDecimal(value)
+}
+
 func (v *Vote7) SetTwoYears(value string) {
 	v.TwoYears = (*Number)(&value)
 }
 
+func (v *Vote7) SetTwoYearsFromDecimal(value decimal.Decimal) {
+	v.TwoYears = new(Number)
+	v.TwoYears.FromDecimal(value)
+}
+
 func (v *Vote7) SetThreeYears(value string) {
 	v.ThreeYears = (*Number)(&value)
 }
 
+func (v *Vote7) SetThreeYearsFromDecimal(value decimal.Decimal) {
+	v.ThreeYears = new(Number)
+	v.ThreeYears.FromDecimal(value)
+}
+
 func (v *Vote7) SetNoAction(value string) {
 	v.NoAction = (*Number)(&value)
 }
+
+func (v *Vote7) SetNoActionFromDecimal(value decimal.Decimal) {
+	v.NoAction = new(Number)
+	v.NoAction.FromDecimal(value)
+}
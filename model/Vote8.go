@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Decision of the voting party for one resolution. Several types of decisions can be indicated to allow for split vote specification.
 type Vote8 struct {
 
@@ -40,6 +44,10 @@ type Vote8 struct {
 	NoAction *Number `xml:"NoActn,omitempty"`
 }
 
+func (v *Vote8) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *Vote8) SetIssuerLabel(value string) {
 	v.IssuerLabel = (*Max35Text)(&value)
 }
@@ -48,42 +56,97 @@ func (v *Vote8) SetFor(value string) {
 	v.For = (*Number)(&value)
 }
 
+func (v *Vote8) SetForFromDecimal(value decimal.Decimal) {
+	v.For = new(Number)
+	v.For.FromDecimal(value)
+}
+
 func (v *Vote8) SetAgainst(value string) {
 	v.Against = (*Number)(&value)
 }
 
+func (v *Vote8) SetAgainstFromDecimal(value decimal.Decimal) {
+	v.Against = new(Number)
+	v.Against.FromDecimal(value)
+}
+
 func (v *Vote8) SetAbstain(value string) {
 	v.Abstain = (*Number)(&value)
 }
 
+func (v *Vote8) SetAbstainFromDecimal(value decimal.Decimal) {
+	v.Abstain = new(Number)
+	v.Abstain.FromDecimal(value)
+}
+
 func (v *Vote8) SetWithhold(value string) {
 	v.Withhold = (*Number)(&value)
 }
 
+func (v *Vote8) SetWithholdFromDecimal(value decimal.Decimal) {
+	v.Withhold = new(Number)
+	v.Withhold.FromDecimal(value)
+}
+
 func (v *Vote8) SetWithManagement(value string) {
 	v.WithManagement = (*Number)(&value)
 }
 
+func (v *Vote8) SetWithManagementFromDecimal(value decimal.Decimal) {
+	v.WithManagement = new(Number)
+	v.WithManagement.FromDecimal(value)
+}
+
 func (v *Vote8) SetAgainstManagement(value string) {
 	v.AgainstManagement = (*Number)(&value)
 }
 
+func (v *Vote8) SetAgainstManagementFromDecimal(value decimal.Decimal) {
+	v.AgainstManagement = new(Number)
+	v.AgainstManagement.FromDecimal(value)
+}
+
 func (v *Vote8) SetDiscretionary(value string) {
 	v.Discretionary = (*Number)(&value)
 }
 
+func (v *Vote8) SetDiscretionaryFromDecimal(value decimal.Decimal) {
+	v.Discretionary = new(Number)
+	v.Discretionary.FromDecimal(value)
+}
+
 func (v *Vote8) SetOneYear(value string) {
 	v.OneYear = (*Number)(&value)
 }
 
+func (v *Vote8) SetOneYearFromDecimal(value decimal.Decimal) {
+	v.OneYear = new(Number)
+	v.OneYear.FromDecimal(value)
+}
+
 func (v *Vote8) SetTwoYears(value string) {
 	v.TwoYears = (*Number)(&value)
 }
 
+func (v *Vote8) SetTwoYearsFromDecimal(value decimal.Decimal) {
+	v.TwoYears = new(Number)
+	v.TwoYears.FromDecimal(value)
+}
+
 func (v *Vote8) SetThreeYears(value string) {
 	v.ThreeYears = (*Number)(&value)
 }
 
+func (v *Vote8) SetThreeYearsFromDecimal(value decimal.Decimal) {
+	v.ThreeYears = new(Number)
+	v.ThreeYears.FromDecimal(value)
+}
+
 func (v *Vote8) SetNoAction(value string) {
 	v.NoAction = (*Number)(&value)
 }
+
+func (v *Vote8) SetNoActionFromDecimal(value decimal.Decimal) {
+	v.NoAction = new(Number)
+	v.NoAction.FromDecimal(value)
+}
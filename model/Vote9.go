@@ -10,6 +10,10 @@ type Vote9 struct {
 	VoteOption *VoteInstruction3Code `xml:"VoteOptn"`
 }
 
+func (v *Vote9) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *Vote9) SetIssuerLabel(value string) {
 	v.IssuerLabel = (*Max35Text)(&value)
 }
@@ -10,6 +10,10 @@ type VoteDetails1 struct {
 	VoteInstructionForMeetingResolution *VoteInstructionForMeetingResolution1 `xml:"VoteInstrForMtgRsltn,omitempty"`
 }
 
+func (v *VoteDetails1) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VoteDetails1) AddVoteInstructionForAgendaResolution() *Vote1Choice {
 	v.VoteInstructionForAgendaResolution = new(Vote1Choice)
 	return v.VoteInstructionForAgendaResolution
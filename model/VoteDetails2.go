@@ -10,6 +10,10 @@ type VoteDetails2 struct {
 	VoteInstructionForMeetingResolution *VoteInstructionForMeetingResolution1Choice `xml:"VoteInstrForMtgRsltn,omitempty"`
 }
 
+func (v *VoteDetails2) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VoteDetails2) AddVoteInstructionForAgendaResolution() *Vote2Choice {
 	v.VoteInstructionForAgendaResolution = new(Vote2Choice)
 	return v.VoteInstructionForAgendaResolution
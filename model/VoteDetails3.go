@@ -10,6 +10,10 @@ type VoteDetails3 struct {
 	VoteInstructionForMeetingResolution *VoteInstructionForMeetingResolution2Choice `xml:"VoteInstrForMtgRsltn,omitempty"`
 }
 
+func (v *VoteDetails3) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VoteDetails3) AddVoteInstructionForAgendaResolution() *Vote3Choice {
 	v.VoteInstructionForAgendaResolution = new(Vote3Choice)
 	return v.VoteInstructionForAgendaResolution
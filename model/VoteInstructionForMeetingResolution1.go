@@ -10,6 +10,10 @@ type VoteInstructionForMeetingResolution1 struct {
 	Shareholder *NameAndAddress9 `xml:"Shrhldr"`
 }
 
+func (v *VoteInstructionForMeetingResolution1) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VoteInstructionForMeetingResolution1) SetVoteIndication(value string) {
 	v.VoteIndication = (*VoteInstructionAtMeeting1Code)(&value)
 }
@@ -10,6 +10,10 @@ type VoteInstructionForMeetingResolution1Choice struct {
 	Shareholder *NameAndAddress9 `xml:"Shrhldr"`
 }
 
+func (v *VoteInstructionForMeetingResolution1Choice) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VoteInstructionForMeetingResolution1Choice) SetVoteIndication(value string) {
 	v.VoteIndication = (*VoteInstructionAtMeeting1Code)(&value)
 }
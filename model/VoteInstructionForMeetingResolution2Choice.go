@@ -10,6 +10,10 @@ type VoteInstructionForMeetingResolution2Choice struct {
 	Shareholder *NameAndAddress9 `xml:"Shrhldr"`
 }
 
+func (v *VoteInstructionForMeetingResolution2Choice) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VoteInstructionForMeetingResolution2Choice) SetVoteIndication(value string) {
 	v.VoteIndication = (*VoteInstruction4Code)(&value)
 }
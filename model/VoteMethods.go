@@ -16,6 +16,10 @@ type VoteMethods struct {
 	VoteByTelephone *Max35Text `xml:"VoteByTel,omitempty"`
 }
 
+func (v *VoteMethods) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VoteMethods) SetVoteThroughNetwork(value string) {
 	v.VoteThroughNetwork = (*AnyBICIdentifier)(&value)
 }
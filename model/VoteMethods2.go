@@ -16,6 +16,10 @@ type VoteMethods2 struct {
 	VoteByTelephone []*Max35Text `xml:"VoteByTel,omitempty"`
 }
 
+func (v *VoteMethods2) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VoteMethods2) AddVoteThroughNetwork(value string) {
 	v.VoteThroughNetwork = append(v.VoteThroughNetwork, (*AnyBICIdentifier)(&value))
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information describing how the voting process is organised.
 type VoteParameters3 struct {
 
@@ -61,18 +65,37 @@ type VoteParameters3 struct {
 	AdditionalVotingRequirements *Max350Text `xml:"AddtlVtngRqrmnts,omitempty"`
 }
 
+func (v *VoteParameters3) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VoteParameters3) SetSecuritiesQuantityRequiredToVote(value string) {
 	v.SecuritiesQuantityRequiredToVote = (*DecimalNumber)(&value)
 }
 
+func (v *VoteParameters3) SetSecuritiesQuantityRequiredToVoteFromDecimal(value decimal.Decimal) {
+	v.SecuritiesQuantityRequiredToVote = new(DecimalNumber)
+	v.SecuritiesQuantityRequiredToVote.FromDecimal(value)
+}
+
 func (v *VoteParameters3) SetPartialVoteAllowed(value string) {
 	v.PartialVoteAllowed = (*YesNoIndicator)(&value)
 }
 
+func (v *VoteParameters3) SetPartialVoteAllowedFromBool(value bool) {
+	v.PartialVoteAllowed = new(YesNoIndicator)
+	v.PartialVoteAllowed.FromBool(value)
+}
+
 func (v *VoteParameters3) SetSplitVoteAllowed(value string) {
 	v.SplitVoteAllowed = (*YesNoIndicator)(&value)
 }
 
+func (v *VoteParameters3) SetSplitVoteAllowedFromBool(value bool) {
+	v.SplitVoteAllowed = new(YesNoIndicator)
+	v.SplitVoteAllowed.FromBool(value)
+}
+
 func (v *VoteParameters3) AddVoteDeadline() *DateFormat2Choice {
 	v.VoteDeadline = new(DateFormat2Choice)
 	return v.VoteDeadline
@@ -122,6 +145,11 @@ func (v *VoteParameters3) SetBeneficialOwnerDisclosure(value string) {
 	v.BeneficialOwnerDisclosure = (*YesNoIndicator)(&value)
 }
 
+func (v *VoteParameters3) SetBeneficialOwnerDisclosureFromBool(value bool) {
+	v.BeneficialOwnerDisclosure = new(YesNoIndicator)
+	v.BeneficialOwnerDisclosure.FromBool(value)
+}
+
 func (v *VoteParameters3) AddVoteInstructionType(value string) {
 	v.VoteInstructionType = append(v.VoteInstructionType, (*VoteInstruction2Code)(&value))
 }
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Information describing how the voting process is organised.
 type VoteParameters4 struct {
 
@@ -70,18 +74,37 @@ type VoteParameters4 struct {
 	PreviousInstructionInvalidityIndicator *YesNoIndicator `xml:"PrvsInstrInvldtyInd,omitempty"`
 }
 
+func (v *VoteParameters4) Validate() error {
+	return ValidateElement(v)
+}
+
 func (v *VoteParameters4) SetSecuritiesQuantityRequiredToVote(value string) {
 	v.SecuritiesQuantityRequiredToVote = (*DecimalNumber)(&value)
 }
 
+func (v *VoteParameters4) SetSecuritiesQuantityRequiredToVoteFromDecimal(value decimal.Decimal) {
+	v.SecuritiesQuantityRequiredToVote = new(DecimalNumber)
+	v.SecuritiesQuantityRequiredToVote.FromDecimal(value)
+}
+
 func (v *VoteParameters4) SetPartialVoteAllowed(value string) {
 	v.PartialVoteAllowed = (*YesNoIndicator)(&value)
 }
 
+func (v *VoteParameters4) SetPartialVoteAllowedFromBool(value bool) {
+	v.PartialVoteAllowed = new(YesNoIndicator)
+	v.PartialVoteAllowed.FromBool(value)
+}
+
 func (v *VoteParameters4) SetSplitVoteAllowed(value string) {
 	v.SplitVoteAllowed = (*YesNoIndicator)(&value)
 }
 
+func (v *VoteParameters4) SetSplitVoteAllowedFromBool(value bool) {
+	v.SplitVoteAllowed = new(YesNoIndicator)
+	v.SplitVoteAllowed.FromBool(value)
+}
+
 func (v *VoteParameters4) AddVoteDeadline() *DateFormat29Choice {
 	v.VoteDeadline = new(DateFormat29Choice)
 	return v.VoteDeadline
@@ -131,6 +154,11 @@ func (v *VoteParameters4) SetBeneficialOwnerDisclosure(value string) {
 	v.BeneficialOwnerDisclosure = (*YesNoIndicator)(&value)
 }
 
+func (v *VoteParameters4) SetBeneficialOwnerDisclosureFromBool(value bool) {
+	v.BeneficialOwnerDisclosure = new(YesNoIndicator)
+	v.BeneficialOwnerDisclosure.FromBool(value)
+}
+
 func (v *VoteParameters4) AddVoteInstructionType(value string) {
 	v.VoteInstructionType = append(v.VoteInstructionType, (*VoteInstruction2Code)(&value))
 }
@@ -172,3 +200,8 @@ func (v *VoteParameters4) SetAdditionalVotingRequirements(value string) {
 func (v *VoteParameters4) SetPreviousInstructionInvalidityIndicator(value string) {
 	v.PreviousInstructionInvalidityIndicator = (*YesNoIndicator)(&value)
 }
+
+func (v *VoteParameters4) SetPreviousInstructionInvalidityIndicatorFromBool(value bool) {
+	v.PreviousInstructionInvalidityIndicator = new(YesNoIndicator)
+	v.PreviousInstructionInvalidityIndicator.FromBool(value)
+}
@@ -10,6 +10,10 @@ type WaivingInstruction1Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (w *WaivingInstruction1Choice) Validate() error {
+	return ValidateElement(w)
+}
+
 func (w *WaivingInstruction1Choice) SetCode(value string) {
 	w.Code = (*WaivingInstruction1Code)(&value)
 }
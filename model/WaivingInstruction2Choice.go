@@ -10,6 +10,10 @@ type WaivingInstruction2Choice struct {
 	Proprietary *GenericIdentification47 `xml:"Prtry"`
 }
 
+func (w *WaivingInstruction2Choice) Validate() error {
+	return ValidateElement(w)
+}
+
 func (w *WaivingInstruction2Choice) SetCode(value string) {
 	w.Code = (*WaivingInstruction1Code)(&value)
 }
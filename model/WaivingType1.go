@@ -10,6 +10,10 @@ type WaivingType1 struct {
 	AdditionalInformation *Max350Text `xml:"AddtlInf,omitempty"`
 }
 
+func (w *WaivingType1) Validate() error {
+	return ValidateElement(w)
+}
+
 func (w *WaivingType1) SetStructured(value string) {
 	w.Structured = (*WaivingInstruction2Code)(&value)
 }
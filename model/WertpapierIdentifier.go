@@ -1,3 +1,7 @@
 package model
 
 type WertpapierIdentifier string
+
+func (w WertpapierIdentifier) Validate() error {
+	return validateLength("WertpapierIdentifier", string(w), 1, 35)
+}
@@ -10,6 +10,10 @@ type WithdrawalReason1 struct {
 	WithdrawalReasonSubCode *Max4Text `xml:"WdrwlRsnSubCd,omitempty"`
 }
 
+func (w *WithdrawalReason1) Validate() error {
+	return ValidateElement(w)
+}
+
 func (w *WithdrawalReason1) SetWithdrawalReasonCode(value string) {
 	w.WithdrawalReasonCode = (*WithdrawalReason1Code)(&value)
 }
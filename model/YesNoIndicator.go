@@ -1,3 +1,15 @@
 package model
 
 type YesNoIndicator string
+
+func (y YesNoIndicator) Validate() error {
+	return validateBoolean("YesNoIndicator", string(y))
+}
+
+func (y YesNoIndicator) Bool() bool {
+	return parseBoolean(string(y))
+}
+
+func (y *YesNoIndicator) FromBool(value bool) {
+	*y = YesNoIndicator(formatBoolean(value))
+}
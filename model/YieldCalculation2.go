@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/yudaprama/iso20022/decimal"
+)
+
 // Return provided by a financial instrument.
 type YieldCalculation2 struct {
 
@@ -22,10 +28,19 @@ type YieldCalculation2 struct {
 	CalculationDate *ISODate `xml:"ClctnDt,omitempty"`
 }
 
+func (y *YieldCalculation2) Validate() error {
+	return ValidateElement(y)
+}
+
 func (y *YieldCalculation2) SetValue(value string) {
 	y.Value = (*PercentageRate)(&value)
 }
 
+func (y *YieldCalculation2) SetValueFromDecimal(value decimal.Decimal) {
+	y.Value = new(PercentageRate)
+	y.Value.FromDecimal(value)
+}
+
 func (y *YieldCalculation2) SetCalculationType(value string) {
 	y.CalculationType = (*CalculationType1Code)(&value)
 }
@@ -39,6 +54,11 @@ func (y *YieldCalculation2) SetValueDate(value string) {
 	y.ValueDate = (*ISODate)(&value)
 }
 
+func (y *YieldCalculation2) SetValueDateFromTime(value time.Time) {
+	y.ValueDate = new(ISODate)
+	y.ValueDate.FromTime(value)
+}
+
 func (y *YieldCalculation2) AddValuePeriod() *DateTimePeriodChoice {
 	y.ValuePeriod = new(DateTimePeriodChoice)
 	return y.ValuePeriod
@@ -47,3 +67,8 @@ func (y *YieldCalculation2) AddValuePeriod() *DateTimePeriodChoice {
 func (y *YieldCalculation2) SetCalculationDate(value string) {
 	y.CalculationDate = (*ISODate)(&value)
 }
+
+func (y *YieldCalculation2) SetCalculationDateFromTime(value time.Time) {
+	y.CalculationDate = new(ISODate)
+	y.CalculationDate.FromTime(value)
+}
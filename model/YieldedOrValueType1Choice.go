@@ -1,5 +1,7 @@
 package model
 
+import "github.com/yudaprama/iso20022/fastxml"
+
 // Choice of value type.
 type YieldedOrValueType1Choice struct {
 
@@ -10,10 +12,60 @@ type YieldedOrValueType1Choice struct {
 	ValueType *PriceValueType1Code `xml:"ValTp"`
 }
 
+func (y *YieldedOrValueType1Choice) Validate() error {
+	return ValidateElement(y)
+}
+
 func (y *YieldedOrValueType1Choice) SetYielded(value string) {
 	y.Yielded = (*YesNoIndicator)(&value)
 }
 
+func (y *YieldedOrValueType1Choice) SetYieldedFromBool(value bool) {
+	y.Yielded = new(YesNoIndicator)
+	y.Yielded.FromBool(value)
+}
+
 func (y *YieldedOrValueType1Choice) SetValueType(value string) {
 	y.ValueType = (*PriceValueType1Code)(&value)
 }
+
+func (y *YieldedOrValueType1Choice) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if y.Yielded != nil {
+		enc.Element("Yldd", string(*y.Yielded))
+	}
+	if y.ValueType != nil {
+		enc.Element("ValTp", string(*y.ValueType))
+	}
+	enc.End(tag)
+}
+
+func (y *YieldedOrValueType1Choice) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "Yldd":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			y.Yielded = (*YesNoIndicator)(&value)
+		case "ValTp":
+			value, err := dec.Text()
+			if err != nil {
+				return err
+			}
+			y.ValueType = (*PriceValueType1Code)(&value)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,3 +1,10 @@
 package model
 
 type positiveInteger string
+
+func (p positiveInteger) Validate() error {
+	if err := validateDecimal("positiveInteger", string(p), -1, 0); err != nil {
+		return err
+	}
+	return validateInclusive("positiveInteger", string(p), "minInclusive", "1")
+}
@@ -0,0 +1,159 @@
+package mt
+
+import (
+	"regexp"
+	"strings"
+)
+
+// balanceFields maps the balance type codes to the balance fields of an MT940.
+var balanceFields = map[string]string{
+	"OPBD": "60F",
+	"PRCD": "60F",
+	"CLBD": "62F",
+	"CLAV": "64",
+	"FWAV": "65",
+}
+
+// statementNumber is the format of the statement number and of the sequence
+// number of field 28C.
+var statementNumber = regexp.MustCompile(`^[0-9]{1,5}$`)
+
+// transactionType is the format of a transaction type identification code of
+// field 61, the letter N, S or F and three characters.
+var transactionType = regexp.MustCompile(`^[NSF][A-Z0-9]{3}$`)
+
+// mt940 builds the MT940 of the statement stmt of a camt.053, sent by the
+// servicer of the account to its owner.
+func (b *builder) mt940(grp, stmt source) *Message {
+	sender := bic11(stmt.text("Acct/Svcr/FinInstnId/BICFI|Acct/Svcr/FinInstnId/BIC"))
+	receiver := bic11(stmt.text("Acct/Ownr/Id/OrgId/AnyBIC|Acct/Ownr/Id/OrgId/BICOrBEI"))
+	b.start("940", stmt, sender, receiver)
+
+	b.field = "20"
+	b.add("20", b.reference(stmt.path+"/Id", stmt.text("Id")))
+	b.field = "25"
+	account := stmt.text("Acct/Id/IBAN")
+	path := stmt.path + "/Acct/Id/IBAN"
+	if account == "" {
+		account, path = stmt.text("Acct/Id/Othr/Id"), stmt.path+"/Acct/Id/Othr/Id"
+	}
+	if account == "" {
+		b.report(Missing, stmt.path+"/Acct/Id", "", "no account identification")
+	}
+	b.add("25", b.identifier(path, account, 35))
+	stmt.skip("Acct/Ccy")
+
+	b.field = "28C"
+	number := stmt.text("ElctrncSeqNb|LglSeqNb")
+	if number != "" && !statementNumber.MatchString(number) {
+		b.report(Unmapped, stmt.path+"/ElctrncSeqNb", number, "sequence number of more than 5 digits")
+		number = ""
+	}
+	if number == "" {
+		number = "1"
+		b.report(Defaulted, stmt.path+"/ElctrncSeqNb", number, "no sequence number of the statement")
+	}
+	if page := stmt.text("StmtPgntn/PgNb"); statementNumber.MatchString(page) {
+		number += "/" + page
+	} else if page != "" {
+		b.report(Unmapped, stmt.path+"/StmtPgntn/PgNb", page, "page number of more than 5 digits")
+	}
+	b.add("28C", number)
+
+	balances := map[string]string{}
+	for _, bal := range stmt.list("Bal") {
+		tag, ok := balanceFields[bal.text("Tp/CdOrPrtry/Cd|Tp/Cd")]
+		if !ok || balances[tag] != "" {
+			continue
+		}
+		b.field = tag
+		value, currency := bal.amount("Amt")
+		if s, ok := b.mtAmount(bal.path+"/Amt", value, currency); ok {
+			balances[tag] = b.mark(bal, false) + mtDate(bal.text("Dt/Dt|Dt/DtTm")) + currency + s
+		}
+	}
+	for _, tag := range []string{"60F", "62F"} {
+		if balances[tag] == "" {
+			b.field = tag
+			b.report(Missing, stmt.path+"/Bal", "", "no balance for field "+tag)
+		}
+	}
+	b.field = "60F"
+	b.add("60F", balances["60F"])
+	for _, n := range stmt.list("Ntry") {
+		b.entry(n)
+	}
+	b.field = "62F"
+	b.add("62F", balances["62F"])
+	b.add("64", balances["64"])
+	b.add("65", balances["65"])
+	b.field = "86"
+	l := lines{b: b, width: 65, max: 6}
+	l.add(stmt.path+"/AddtlStmtInf", stmt.text("AddtlStmtInf"), "", "")
+	b.add("86", l.list...)
+	return b.msg
+}
+
+// mark returns the debit or credit mark of the balance or entry s, with R
+// for the reversal of an entry.
+func (b *builder) mark(s source, entry bool) string {
+	debit := s.text("CdtDbtInd") == "DBIT"
+	if entry && s.text("RvslInd") == "true" {
+		// A reversed credit is a debit of the account.
+		if debit {
+			return "RC"
+		}
+		return "RD"
+	}
+	if debit {
+		return "D"
+	}
+	return "C"
+}
+
+// entry adds field 61 for the entry n, with the reference of the account
+// owner, the end-to-end identification of its first transaction, and field
+// 86 with its additional information and the remittance information of the
+// transaction.
+func (b *builder) entry(n source) {
+	b.field = "61"
+	value, currency := n.amount("Amt")
+	amt, ok := b.mtAmount(n.path+"/Amt", value, currency)
+	if !ok {
+		b.report(Unmapped, n.path, "", "entry without a valid amount")
+		return
+	}
+	value = n.text("ValDt/Dt|ValDt/DtTm")
+	booking := n.text("BookgDt/Dt|BookgDt/DtTm")
+	if value == "" {
+		value = booking
+		b.report(Defaulted, n.path+"/ValDt", booking, "no value date, the booking date")
+	}
+	line := mtDate(value)
+	if d := mtDate(booking); d != "" {
+		line += d[2:]
+	}
+	line += b.mark(n, true) + amt
+
+	code := n.text("BkTxCd/Prtry/Cd")
+	if !transactionType.MatchString(code) {
+		b.report(Defaulted, n.path+"/BkTxCd", code, "no transaction type identification code, NMSC")
+		code = "NMSC"
+	}
+	tx := n.elem("NtryDtls").elem("TxDtls")
+	reference := tx.text("Refs/EndToEndId")
+	if reference == "NOTPROVIDED" {
+		reference = ""
+	}
+	line += code + b.reference(tx.path+"/Refs/EndToEndId", reference)
+	if ref := n.text("AcctSvcrRef"); ref != "" {
+		line += "//" + b.fit(n.path+"/AcctSvcrRef", ref, 16)
+	}
+	b.add("61", line)
+
+	b.field = "86"
+	l := lines{b: b, width: 65, max: 6}
+	l.add(n.path+"/AddtlNtryInf", n.text("AddtlNtryInf"), "", "")
+	l.add(tx.path+"/RmtInf/Ustrd", strings.Join(tx.texts("RmtInf/Ustrd"), " "), "", "")
+	b.add("86", l.list...)
+}
@@ -0,0 +1,213 @@
+package mt
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yudaprama/iso20022/model"
+)
+
+// element is an element of the Document being built, a pointer to the struct
+// of its type, with its path in the Document.
+//
+// The elements under it are given by the path of their XML tags, such as
+// Dbtr/PstlAdr/AdrLine, so that the same translation applies to the versions
+// and the message definitions that share the tags. A repeated element is
+// appended to when its tag is followed by +, as in Ustrd+, and its last
+// occurrence is used otherwise.
+type element struct {
+	t    *translator
+	v    reflect.Value
+	path string
+}
+
+// resolve walks the elements of path under e, allocating them when alloc is
+// set, and returns the field of the last one, which is not allocated, with the
+// path of the element it holds.
+func (e element) resolve(path string, alloc bool) (reflect.Value, reflect.StructField, string, error) {
+	v, p := e.v.Elem(), e.path
+	names := strings.Split(path, "/")
+	for i, name := range names {
+		add := strings.HasSuffix(name, "+")
+		name = strings.TrimSuffix(name, "+")
+		f, ok := fieldByTag(v.Type(), name)
+		if !ok {
+			return reflect.Value{}, f, "", fmt.Errorf("%s has no element %s", e.t.to, strings.TrimPrefix(p+"/"+name, "/Document/"))
+		}
+		fv := v.FieldByIndex(f.Index)
+		p += "/" + name
+		if fv.Kind() == reflect.Slice {
+			n := fv.Len()
+			if add || n == 0 {
+				if max := maxOccurs(f); max > 0 && n >= max {
+					return reflect.Value{}, f, "", fmt.Errorf("%s has at most %d %s", e.t.to, max, name)
+				}
+				n++
+			}
+			p += "[" + strconv.Itoa(n) + "]"
+		}
+		if i == len(names)-1 {
+			return fv, f, p, nil
+		}
+
+		switch {
+		case fv.Kind() == reflect.Slice && (add || fv.Len() == 0):
+			elem := reflect.New(fv.Type().Elem().Elem())
+			if alloc {
+				fv.Set(reflect.Append(fv, elem))
+			}
+			v = elem.Elem()
+		case fv.Kind() == reflect.Slice:
+			v = fv.Index(fv.Len() - 1).Elem()
+		case fv.IsNil():
+			elem := reflect.New(fv.Type().Elem())
+			if alloc {
+				fv.Set(elem)
+			}
+			v = elem.Elem()
+		default:
+			v = fv.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, f, "", fmt.Errorf("%s is not a structure", strings.TrimPrefix(p, "/Document/"))
+		}
+	}
+	panic("unreachable")
+}
+
+// set sets the text of the element at path under e to value, and reports
+// whether it did. A value longer than the element is truncated; a value that
+// is not valid in the element, or an element that the message definition does
+// not have, is reported as unmapped.
+func (e element) set(path, value string) bool {
+	if value == "" {
+		return false
+	}
+	_, f, p, err := e.resolve(path, false)
+	if err != nil {
+		e.t.report(Unmapped, "", value, err.Error())
+		return false
+	}
+	typ := f.Type
+	if typ.Kind() == reflect.Slice {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Ptr || typ.Elem().Kind() != reflect.String {
+		panic("mt: " + p + " is not a text element")
+	}
+	leaf := reflect.New(typ.Elem())
+	leaf.Elem().SetString(value)
+	err = validate(leaf)
+	var facet *model.FacetError
+	if errors.As(err, &facet) && facet.Facet == "maxLength" {
+		n, _ := strconv.Atoi(facet.Limit)
+		leaf.Elem().SetString(truncate(value, n))
+		e.t.report(Truncated, p, value, "longer than "+facet.Limit+" characters")
+		err = validate(leaf)
+	}
+	if err != nil {
+		e.t.report(Unmapped, p, value, err.Error())
+		return false
+	}
+
+	fv, _, _, _ := e.resolve(path, true)
+	if fv.Kind() == reflect.Slice {
+		fv.Set(reflect.Append(fv, leaf))
+	} else {
+		fv.Set(leaf)
+	}
+	return true
+}
+
+// amount sets the amount at path under e, and reports whether it did.
+func (e element) amount(path, value, currency string) bool {
+	_, f, p, err := e.resolve(path, false)
+	if err != nil {
+		e.t.report(Unmapped, "", currency+" "+value, err.Error())
+		return false
+	}
+	if f.Type.Kind() != reflect.Ptr || f.Type.Elem().Kind() != reflect.Struct {
+		panic("mt: " + p + " is not an amount")
+	}
+	amt := reflect.New(f.Type.Elem())
+	amt.Elem().FieldByName("Value").SetString(value)
+	amt.Elem().FieldByName("Currency").SetString(currency)
+	if err := validate(amt); err != nil {
+		e.t.report(Unmapped, p, currency+" "+value, err.Error())
+		return false
+	}
+	fv, _, _, _ := e.resolve(path, true)
+	fv.Set(amt)
+	return true
+}
+
+// get returns the element at path under e, allocating it, and whether the
+// message definition has it.
+func (e element) get(path string) (element, bool) {
+	_, _, _, err := e.resolve(path, false)
+	if err != nil {
+		e.t.report(Unmapped, "", "", err.Error())
+		return element{}, false
+	}
+	fv, _, p, _ := e.resolve(path, true)
+	add := strings.HasSuffix(path, "+")
+	switch {
+	case fv.Kind() == reflect.Slice && (add || fv.Len() == 0):
+		fv.Set(reflect.Append(fv, reflect.New(fv.Type().Elem().Elem())))
+		fv = fv.Index(fv.Len() - 1)
+	case fv.Kind() == reflect.Slice:
+		fv = fv.Index(fv.Len() - 1)
+	case fv.IsNil():
+		fv.Set(reflect.New(fv.Type().Elem()))
+	}
+	return element{t: e.t, v: fv, path: p}, true
+}
+
+// free reports whether the message definition has the element at path under
+// e and the element has no value.
+func (e element) free(path string) bool {
+	fv, _, _, err := e.resolve(path, false)
+	return err == nil && (fv.Kind() == reflect.Slice || fv.IsNil())
+}
+
+func validate(v reflect.Value) error {
+	if x, ok := v.Interface().(interface{ Validate() error }); ok {
+		return x.Validate()
+	}
+	return nil
+}
+
+// truncate returns the first n characters of s.
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+// fieldByTag returns the field of the struct type typ holding the element
+// with the given tag.
+func fieldByTag(typ reflect.Type, tag string) (reflect.StructField, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		name := f.Tag.Get("xml")
+		if i := strings.IndexByte(name, ','); i >= 0 {
+			name = name[:i]
+		}
+		if name == tag {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// maxOccurs returns the maximum number of occurrences of a repeated element
+// with a bound, or 0.
+func maxOccurs(f reflect.StructField) int {
+	n, _ := strconv.Atoi(strings.TrimPrefix(f.Tag.Get("xsd"), "maxOccurs="))
+	return n
+}
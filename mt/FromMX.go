@@ -0,0 +1,632 @@
+package mt
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yudaprama/iso20022/amount"
+	"github.com/yudaprama/iso20022/decimal"
+	"github.com/yudaprama/iso20022/message"
+)
+
+// FromMX translates the transactions of a pacs.008 to MT103 messages, the
+// transactions of a pacs.009 to MT202 or MT202 COV messages, and the
+// statements of a camt.053 to MT940 messages, whatever the version of their
+// message definition.
+//
+// The text is converted to the SWIFT X character set, and the text and
+// references longer than their field are truncated, the last character
+// replaced by +. Codes, numbers and identifiers that do not fit their field,
+// and amounts that are not valid for their currency or longer than the 15
+// characters of an MT amount, are left out and reported as unmapped, and
+// exchange rates are rounded to their field. The elements that no MT field
+// holds are reported as unmapped, except the
+// identification, the creation date and time and the totals of the group
+// header, which describe the MX message as a whole.
+func FromMX(m message.Message) ([]*Message, *Report, error) {
+	id := m.MessageDefinitionIdentifier()
+	b := &builder{from: id, used: map[string]bool{}}
+	var build func(grp, s source) *Message
+	var items string
+	switch {
+	case strings.HasPrefix(id, "pacs.008."):
+		b.to, items, build = "MT103", "CdtTrfTxInf", b.mt103
+	case strings.HasPrefix(id, "pacs.009."):
+		b.to, items, build = "MT202", "CdtTrfTxInf", b.mt202
+	case strings.HasPrefix(id, "camt.053."):
+		b.to, items, build = "MT940", "Stmt", b.mt940
+	default:
+		return nil, nil, fmt.Errorf("mt: no translation of %s", id)
+	}
+	root := b.body(m)
+	list := root.list(items)
+	if len(list) == 0 {
+		return nil, nil, fmt.Errorf("mt: %s without %s", id, items)
+	}
+	grp := root.elem("GrpHdr")
+	for _, tag := range []string{"MsgId", "CreDtTm", "NbOfTxs", "CtrlSum", "TtlIntrBkSttlmAmt"} {
+		grp.skip(tag)
+	}
+
+	var messages []*Message
+	for _, s := range list {
+		messages = append(messages, build(grp, s))
+		b.field = ""
+		s.unmapped()
+	}
+	root.unmapped()
+	return messages, b.done(), nil
+}
+
+// builder builds the MT messages of a Document and collects the changes.
+type builder struct {
+	from    string
+	to      string
+	changes []Change
+
+	// used holds the paths of the leaves of the Document that were read.
+	used map[string]bool
+
+	// field is the tag of the field being built, with a lowercase a for its
+	// option until it is known.
+	field string
+
+	// msg is the message being built.
+	msg *Message
+}
+
+func (b *builder) report(kind Kind, path, value, reason string) {
+	b.changes = append(b.changes, Change{Kind: kind, Field: b.field, Path: path, Value: value, Reason: reason})
+}
+
+func (b *builder) done() *Report {
+	return &Report{From: b.from, To: b.to, Changes: b.changes}
+}
+
+// body returns the message element of the Document m.
+func (b *builder) body(m message.Message) source {
+	v := reflect.ValueOf(m).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if f.Name == "XMLName" || v.Field(i).Kind() != reflect.Ptr || v.Field(i).IsNil() {
+			continue
+		}
+		name := f.Tag.Get("xml")
+		if j := strings.IndexByte(name, ','); j >= 0 {
+			name = name[:j]
+		}
+		return source{b: b, v: v.Field(i).Elem(), path: "/Document/" + name}
+	}
+	return source{b: b}
+}
+
+// start starts a message of the given type, sent by sender to receiver. A
+// missing address is reported, as is a BIC that is not valid, which is left
+// out of the headers.
+func (b *builder) start(typ string, s source, sender, receiver string) {
+	b.field = ""
+	sender = b.address(s, sender, "sender")
+	receiver = b.address(s, receiver, "receiver")
+	b.msg = &Message{
+		Basic:       BasicHeader{ApplicationID: "F", ServiceID: "01", Address: terminal(sender, 'A'), Session: "0000", Sequence: "000000"},
+		Application: ApplicationHeader{Input: true, Type: typ, Address: terminal(receiver, 'X'), Priority: "N"},
+	}
+}
+
+// add adds a field to the text of the message being built, unless it has no
+// value.
+func (b *builder) add(tag string, lines ...string) {
+	value := strings.Join(lines, "\n")
+	if value != "" {
+		b.msg.Text = append(b.msg.Text, Field{Tag: tag, Value: value})
+	}
+}
+
+// has reports whether the text block of the message has a field tag.
+func (b *builder) has(tag string) bool {
+	for _, f := range b.msg.Text {
+		if f.Tag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// bicFormat is the format of a BIC of 8 or 11 characters.
+var bicFormat = regexp.MustCompile(`^[A-Z0-9]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+
+// address returns the BIC of the sender or the receiver of the message started
+// for s, or "" if it is missing or not valid.
+func (b *builder) address(s source, bic, party string) string {
+	switch {
+	case bic == "":
+		b.report(Missing, s.path, "", "no BIC of the "+party)
+	case !bicFormat.MatchString(bic):
+		b.report(Unmapped, s.path, bic, "BIC of the "+party+" not valid")
+		return ""
+	}
+	return bic
+}
+
+// terminal returns the logical terminal address of a BIC, with the terminal
+// code of the sender, or X for the receiver.
+func terminal(bic string, code byte) string {
+	bic = bic11(bic)
+	if len(bic) != 11 {
+		return ""
+	}
+	return bic[:8] + string(code) + bic[8:]
+}
+
+// bic11 returns a BIC with its branch code.
+func bic11(bic string) string {
+	if len(bic) == 8 {
+		return bic + "XXX"
+	}
+	return bic
+}
+
+// xCharacters are the characters of the SWIFT X character set other than the
+// letters and the digits.
+const xCharacters = "/-?:().,'+ "
+
+// transliterations gives the replacement of the characters out of the X
+// character set that have one. The others are replaced by a dot.
+var transliterations = map[rune]string{
+	'À': "A", 'Á': "A", 'Â': "A", 'Ã': "A", 'Ä': "A", 'Å': "A", 'Æ': "AE", 'Ç': "C",
+	'È': "E", 'É': "E", 'Ê': "E", 'Ë': "E", 'Ì': "I", 'Í': "I", 'Î': "I", 'Ï': "I",
+	'Ñ': "N", 'Ò': "O", 'Ó': "O", 'Ô': "O", 'Õ': "O", 'Ö': "O", 'Ø': "O", 'Œ': "OE",
+	'Ù': "U", 'Ú': "U", 'Û': "U", 'Ü': "U", 'Ý': "Y", 'ß': "ss",
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'æ': "ae", 'ç': "c",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ì': "i", 'í': "i", 'î': "i", 'ï': "i",
+	'ñ': "n", 'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'œ': "oe",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ý': "y", 'ÿ': "y",
+	'&': "+", '@': "(AT)", '_': "-", '"': "'", ';': ",", '\n': " ", '\t': " ",
+}
+
+// convert returns the value of the element at path in the X character set.
+func (b *builder) convert(path, value string) string {
+	var s strings.Builder
+	for _, r := range value {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', strings.ContainsRune(xCharacters, r):
+			s.WriteRune(r)
+		case transliterations[r] != "":
+			s.WriteString(transliterations[r])
+		default:
+			s.WriteByte('.')
+		}
+	}
+	if s.String() != value {
+		b.report(Converted, path, value, "characters out of the SWIFT X character set")
+	}
+	return s.String()
+}
+
+// fit returns the value of the element at path for a text or reference field
+// of at most n characters. A longer value is truncated, its last character
+// replaced by +.
+func (b *builder) fit(path, value string, n int) string {
+	s := b.convert(path, value)
+	if len(s) > n {
+		b.report(Truncated, path, value, "longer than "+strconv.Itoa(n)+" characters")
+		s = s[:n-1] + "+"
+	}
+	return s
+}
+
+// identifier returns the value of the element at path for a code, a number or
+// an identifier of at most n characters. A longer value cannot be truncated
+// without changing its meaning: it is reported as unmapped and left out.
+func (b *builder) identifier(path, value string, n int) string {
+	s := b.convert(path, value)
+	if len(s) > n {
+		b.report(Unmapped, path, value, "longer than "+strconv.Itoa(n)+" characters")
+		return ""
+	}
+	return s
+}
+
+// reference returns the value of the element at path for a reference field,
+// such as 20, of 16 characters that neither start nor end with a slash nor
+// contain two consecutive slashes.
+func (b *builder) reference(path, value string) string {
+	s := b.fit(path, value, 16)
+	if strings.HasPrefix(s, "/") || strings.HasSuffix(s, "/") || strings.Contains(s, "//") {
+		b.report(Converted, path, value, "slashes not allowed in a reference")
+		for strings.Contains(s, "//") {
+			s = strings.Replace(s, "//", "/", 1)
+		}
+		s = strings.Trim(s, "/")
+	}
+	if s == "" {
+		return "NONREF"
+	}
+	return s
+}
+
+// lines holds the lines of a field of at most max lines of width characters.
+type lines struct {
+	b     *builder
+	width int
+	max   int
+	list  []string
+}
+
+// add adds the value of the element at path on a new line, the first line
+// starting with first and the next ones, when the value is wrapped, with next.
+// A value that does not fit is truncated, the last character of the field
+// replaced by +, and a value for which no line is left is unmapped.
+func (l *lines) add(path, value, first, next string) {
+	if value == "" {
+		return
+	}
+	if len(l.list) == l.max {
+		l.b.report(Unmapped, path, value, "no line left in the field")
+		return
+	}
+	s := l.b.convert(path, value)
+	prefix := first
+	for s != "" {
+		if len(l.list) == l.max {
+			last := l.list[len(l.list)-1]
+			if len(last) == l.width {
+				last = last[:l.width-1]
+			}
+			l.list[len(l.list)-1] = last + "+"
+			l.b.report(Truncated, path, value, fmt.Sprintf("longer than the %d lines of %d characters of the field", l.max, l.width))
+			return
+		}
+		n := l.width - len(prefix)
+		if n > len(s) {
+			n = len(s)
+		}
+		line := prefix + s[:n]
+		if line[0] == ':' || line[0] == '-' {
+			line = "." + line[1:]
+		}
+		l.list = append(l.list, line)
+		s, prefix = s[n:], next
+	}
+}
+
+// accountID returns the IBAN or the other identification of the account at
+// path under s, for a party identifier.
+func (b *builder) accountID(s source, path string) string {
+	if path == "" {
+		return ""
+	}
+	if id := s.text(path + "/Id/IBAN"); id != "" {
+		return b.identifier(s.path+"/"+path+"/Id/IBAN", id, 34)
+	}
+	if id := s.text(path + "/Id/Othr/Id"); id != "" {
+		return b.identifier(s.path+"/"+path+"/Id/Othr/Id", id, 34)
+	}
+	return ""
+}
+
+// clearingCodes maps the ISO 20022 clearing system identification codes to the
+// codes of a party identifier.
+var clearingCodes = map[string]string{}
+
+func init() {
+	for code, iso := range clearingSystems {
+		clearingCodes[iso] = code
+	}
+}
+
+// partyIdentifier returns the party identifier of the agent at path under s,
+// its account or its clearing system member identification.
+func (b *builder) partyIdentifier(s source, path, account string) string {
+	if id := b.accountID(s, account); id != "" {
+		return "/" + id
+	}
+	member := s.text(path + "/FinInstnId/ClrSysMmbId/MmbId")
+	if member == "" {
+		return ""
+	}
+	code := clearingCodes[s.text(path+"/FinInstnId/ClrSysMmbId/ClrSysId/Cd")]
+	if code == "" {
+		if p := s.text(path + "/FinInstnId/ClrSysMmbId/ClrSysId/Prtry"); len(p) == 2 {
+			code = p
+		}
+	}
+	if code == "" {
+		b.report(Unmapped, s.path+"/"+path+"/FinInstnId/ClrSysMmbId", member, "clearing system without MT code")
+		return ""
+	}
+	member = b.identifier(s.path+"/"+path+"/FinInstnId/ClrSysMmbId/MmbId", member, 31)
+	if member == "" {
+		return ""
+	}
+	return "//" + code + member
+}
+
+// nameAndAddress adds the name at name and the postal address at address
+// under s to l: the address lines, or the street of a structured address, then
+// the town and the country.
+func (b *builder) nameAndAddress(l *lines, s source, name, address string) {
+	l.add(s.path+"/"+name, s.text(name), "", "")
+	adr := s.elem(address)
+	if !adr.present() {
+		return
+	}
+	list := adr.texts("AdrLine")
+	for i, line := range list {
+		l.add(adr.path+"/AdrLine["+strconv.Itoa(i+1)+"]", line, "", "")
+	}
+	if len(list) == 0 {
+		l.add(adr.path, join(adr.text("StrtNm"), adr.text("BldgNb")), "", "")
+	}
+	l.add(adr.path, join(adr.text("PstCd"), adr.text("TwnNm")), "", "")
+	l.add(adr.path+"/Ctry", adr.text("Ctry"), "", "")
+}
+
+// join joins the non empty values with spaces.
+func join(values ...string) string {
+	var list []string
+	for _, v := range values {
+		if v != "" {
+			list = append(list, v)
+		}
+	}
+	return strings.Join(list, " ")
+}
+
+// agent adds field number, such as 57, for the agent at path under s and its
+// account at account: option A with the BIC, option D with the name and
+// address, or option C with the party identifier alone for fields 56 and 57.
+// It reports whether the field was added.
+func (b *builder) agent(s source, number, path, account string) bool {
+	b.field = number + "a"
+	id := b.partyIdentifier(s, path, account)
+	var first []string
+	if id != "" {
+		first = []string{id}
+	}
+	bic := s.text(path + "/FinInstnId/BICFI|" + path + "/FinInstnId/BIC")
+	if bicFormat.MatchString(bic) {
+		b.add(number+"A", append(first, bic)...)
+		return true
+	}
+	if bic != "" {
+		b.report(Unmapped, s.path+"/"+path+"/FinInstnId", bic, "BIC not valid")
+	}
+	l := lines{b: b, width: 35, max: 4}
+	b.nameAndAddress(&l, s, path+"/FinInstnId/Nm", path+"/FinInstnId/PstlAdr")
+	switch {
+	case len(l.list) > 0:
+		b.add(number+"D", append(first, l.list...)...)
+	case id != "" && (number == "56" || number == "57"):
+		b.add(number+"C", id)
+	case id != "":
+		b.report(Unmapped, s.path+"/"+path, id, "party identifier without BIC or name")
+		return false
+	default:
+		return false
+	}
+	return true
+}
+
+// party adds field number, 50 or 59, for the party at path under s and its
+// account at account: option A with the BIC, or option K for field 50 and no
+// letter option for field 59 with the name and address.
+func (b *builder) party(s source, number, path, account string) bool {
+	b.field = number + "a"
+	var first []string
+	if id := b.accountID(s, account); id != "" {
+		first = []string{"/" + id}
+	}
+	bic := s.text(path + "/Id/OrgId/AnyBIC|" + path + "/Id/OrgId/BICOrBEI")
+	if bicFormat.MatchString(bic) {
+		b.add(number+"A", append(first, bic)...)
+		return true
+	}
+	if bic != "" {
+		b.report(Unmapped, s.path+"/"+path+"/Id/OrgId", bic, "BIC not valid")
+	}
+	l := lines{b: b, width: 35, max: 4}
+	b.nameAndAddress(&l, s, path+"/Nm", path+"/PstlAdr")
+	if len(l.list) == 0 && len(first) == 0 {
+		return false
+	}
+	tag := number
+	if number == "50" {
+		tag += "K"
+	}
+	b.add(tag, append(first, l.list...)...)
+	return true
+}
+
+// settlement adds fields 53a and 54a for the settlement account or the
+// reimbursement agents of the settlement information of grp.
+func (b *builder) settlement(grp source) {
+	b.field = "53a"
+	grp.text("SttlmInf/SttlmMtd")
+	if id := b.accountID(grp, "SttlmInf/SttlmAcct"); id != "" {
+		b.add("53B", "/"+id)
+	} else {
+		b.agent(grp, "53", "SttlmInf/InstgRmbrsmntAgt", "SttlmInf/InstgRmbrsmntAgtAcct")
+	}
+	b.agent(grp, "54", "SttlmInf/InstdRmbrsmntAgt", "SttlmInf/InstdRmbrsmntAgtAcct")
+}
+
+// senderToReceiver adds field 72 for the previous instructing agent, with code
+// INS, the instructions for the creditor agent without code, with code ACC,
+// and the instructions for the next agent, with their code or with code REC.
+func (b *builder) senderToReceiver(tx source) {
+	b.field = "72"
+	l := lines{b: b, width: 35, max: 6}
+	for _, tag := range []string{"PrvsInstgAgt1", "PrvsInstgAgt"} {
+		path := tag + "/FinInstnId/"
+		if ins := tx.text(path + "BICFI|" + path + "BIC|" + path + "Nm"); ins != "" {
+			l.add(tx.path+"/"+tag, ins, "/INS/", "//")
+		}
+	}
+	for _, in := range tx.list("InstrForCdtrAgt") {
+		if in.text("Cd") == "" {
+			l.add(in.path+"/InstrInf", in.text("InstrInf"), "/ACC/", "//")
+		}
+	}
+	for _, in := range tx.list("InstrForNxtAgt") {
+		if in.text("Cd") != "" {
+			continue
+		}
+		info := in.text("InstrInf")
+		if m := codeFormat.FindStringSubmatch(info); m != nil {
+			l.add(in.path+"/InstrInf", info[len(m[0]):], m[0], "//")
+		} else {
+			l.add(in.path+"/InstrInf", info, "/REC/", "//")
+		}
+	}
+	b.add("72", l.list...)
+}
+
+var codeFormat = regexp.MustCompile(`^/[A-Z0-9]{1,8}/`)
+
+// remittance adds field 70 for the unstructured remittance information of tx,
+// after the reference of the ordering customer, if any.
+func (b *builder) remittance(tx source, reference string) {
+	b.field = "70"
+	l := lines{b: b, width: 35, max: 4}
+	ustrd := tx.texts("RmtInf/Ustrd")
+	if reference != "" && (len(ustrd) == 0 || !strings.HasPrefix(ustrd[0], "/ROC/"+reference)) {
+		l.add(tx.path+"/PmtId/EndToEndId", reference+"/", "/ROC/", "")
+	}
+	for i, u := range ustrd {
+		l.add(tx.path+"/RmtInf/Ustrd["+strconv.Itoa(i+1)+"]", u, "", "")
+	}
+	b.add("70", l.list...)
+}
+
+var clockFormat = regexp.MustCompile(`([0-9]{2}):([0-9]{2})(?::[0-9]{2}(?:\.[0-9]+)?)?(Z|[+-][0-9]{2}:[0-9]{2})$`)
+
+// settlementTime adds a field 13C for each time indication of tx.
+func (b *builder) settlementTime(tx source) {
+	b.field = "13C"
+	for _, t := range []struct{ path, code string }{
+		{"SttlmTmIndctn/DbtDtTm", "SNDTIME"},
+		{"SttlmTmIndctn/CdtDtTm", "RNCTIME"},
+		{"SttlmTmReq/CLSTm", "CLSTIME"},
+		{"SttlmTmReq/TillTm", "TILTIME"},
+		{"SttlmTmReq/FrTm", "FROTIME"},
+		{"SttlmTmReq/RjctTm", "REJTIME"},
+	} {
+		value := tx.text(t.path)
+		if value == "" {
+			continue
+		}
+		m := clockFormat.FindStringSubmatch(value)
+		if m == nil {
+			b.report(Unmapped, tx.path+"/"+t.path, value, "time without time zone")
+			continue
+		}
+		offset := "+0000"
+		if m[3] != "Z" {
+			offset = strings.Replace(m[3], ":", "", 1)
+		}
+		b.add("13C", "/"+t.code+"/"+m[1]+m[2]+offset)
+	}
+}
+
+// mtDate returns the date YYMMDD of a date or a date and time.
+func mtDate(s string) string {
+	if len(s) < 10 {
+		return ""
+	}
+	return s[2:4] + s[5:7] + s[8:10]
+}
+
+// mtAmount returns the amount at path, value in currency, for the amount of a
+// field: the value with a decimal comma, of at most 15 characters. An amount
+// that is not valid for its currency, as checked by the amount package, or
+// that does not fit is reported as unmapped, and false is returned so that the
+// field is left out.
+func (b *builder) mtAmount(path, value, currency string) (string, bool) {
+	a, err := amount.Parse(value, currency)
+	reason := ""
+	switch e := err.(type) {
+	case nil:
+	case *amount.Error:
+		reason = "amount " + e.Reason
+	default:
+		reason = "invalid amount"
+	}
+	if reason == "" && a.Value.Sign() < 0 {
+		reason = "negative amount"
+	}
+	s := ""
+	if reason == "" {
+		if s = mtDecimal(a.Value.String()); len(s) > 15 {
+			s = mtDecimal(a.Value.Normalize().String())
+		}
+		if len(s) > 15 {
+			reason = "amount longer than the 15 characters of an MT amount"
+		}
+	}
+	if reason != "" {
+		b.report(Unmapped, path, strings.TrimSpace(currency+" "+value), reason)
+		return "", false
+	}
+	return s, true
+}
+
+// rate returns the exchange rate at path for field 36, of at most 12
+// characters with the decimal comma. The fraction digits that do not fit are
+// rounded, and a rate whose integer part does not fit is reported as unmapped
+// and left out.
+func (b *builder) rate(path, value string) string {
+	d, err := decimal.Parse(value)
+	if err != nil || d.Sign() <= 0 {
+		b.report(Unmapped, path, value, "invalid exchange rate")
+		return ""
+	}
+	s := mtDecimal(d.String())
+	if len(s) <= 12 {
+		return s
+	}
+	digits := strings.IndexByte(s, ',')
+	if digits > 11 {
+		b.report(Unmapped, path, value, "exchange rate longer than 12 characters")
+		return ""
+	}
+	b.report(Converted, path, value, "exchange rate rounded to 12 characters")
+	return mtDecimal(d.Round(int32(11 - digits)).Normalize().String())
+}
+
+// mtDecimal returns an amount or a rate with a decimal comma.
+func mtDecimal(s string) string {
+	if strings.Contains(s, ".") {
+		return strings.Replace(s, ".", ",", 1)
+	}
+	return s + ","
+}
+
+// senderReference adds field 20 for the instruction identification of tx, or
+// its transaction identification when it has none.
+func (b *builder) senderReference(tx source) {
+	b.field = "20"
+	id, path := tx.text("PmtId/InstrId"), tx.path+"/PmtId/InstrId"
+	txID := tx.text("PmtId/TxId")
+	switch {
+	case id == "" && txID == "":
+		b.report(Missing, tx.path+"/PmtId", "", "no reference of the sender")
+	case id == "":
+		id, path = txID, tx.path+"/PmtId/TxId"
+	case txID != "" && txID != id:
+		b.report(Unmapped, tx.path+"/PmtId/TxId", txID, "transaction identification other than the instruction identification")
+	}
+	b.add("20", b.reference(path, id))
+}
+
+// either returns the text of the leaf at path under s, or under the group
+// header grp when s does not have it.
+func either(s, grp source, path string) string {
+	if value := s.text(path); value != "" {
+		return value
+	}
+	return grp.text(path)
+}
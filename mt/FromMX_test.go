@@ -0,0 +1,468 @@
+package mt
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/yudaprama/iso20022/camt"
+	"github.com/yudaprama/iso20022/model"
+	"github.com/yudaprama/iso20022/pacs"
+)
+
+// value returns the value of field tag of m, or of user header field tag.
+func value(m *Message, tag string) string {
+	if f, ok := m.Field(tag); ok {
+		return f.Value
+	}
+	v, _ := m.UserField(tag)
+	return v
+}
+
+func TestFromMXMT103(t *testing.T) {
+	doc, _, err := TranslateMT103(parse(t, mt103))
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, report, err := FromMX(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 {
+		t.Fatalf("%d messages", len(list))
+	}
+	m := parse(t, list[0].String())
+	if m.Type() != "103" || m.Sender() != "BANKBEBBXXX" || m.Receiver() != "BANKDEFFXXX" {
+		t.Errorf("MT%s from %s to %s", m.Type(), m.Sender(), m.Receiver())
+	}
+	for _, tt := range []struct{ tag, want string }{
+		{"121", "eb6305c9-1f7f-49de-aed0-16487c27b42d"},
+		{"20", "REF123"},
+		{"23B", "CRED"},
+		{"23E", "PHOB/+32 2 555 1234"},
+		{"32A", "230915EUR1234,56"},
+		{"33B", "EUR1250,00"},
+		{"50K", "/BE71096123456769\nJOHN DOE\nRUE DE LA LOI 1\nBRUSSELS\nBE"},
+		{"52a", ""},
+		{"53A", "CITIUS33"},
+		{"57a", ""},
+		{"59", "/DE89370400440532013000\nERIKA MUSTERMANN\nHAUPTSTRASSE 5\n10115 BERLIN"},
+		{"70", "/ROC/INV-2023-001/"},
+		{"71A", "SHA"},
+		{"71F", "EUR15,44"},
+		{"72", "/INS/ABNANL2A\n/ACC/CALL BEFORE PAYMENT"},
+		{"77B", "/ORDERRES/BE//MEILAAN 1, 9000 GENT \n//GOODS EXPORT L"},
+	} {
+		if got := value(m, tt.tag); got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.tag, got, tt.want)
+		}
+	}
+	if report.From != "pacs.008.001.08" || report.To != "MT103" || !report.Lossless() {
+		t.Errorf("report:\n%s", report)
+	}
+}
+
+func TestFromMXMT202COV(t *testing.T) {
+	doc, _, err := TranslateMT202(parse(t, mt202cov))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc.Message.CreditTransferTransactionInformation[0].PaymentIdentification.TransactionIdentification = nil
+	list, report, err := FromMX(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := parse(t, list[0].String())
+	if m.Type() != "202COV" || m.Sender() != "CITIUS33XXX" || m.Receiver() != "BANKBEBBXXX" {
+		t.Errorf("MT%s from %s to %s", m.Type(), m.Sender(), m.Receiver())
+	}
+	var tags []string
+	for _, f := range m.Text {
+		tags = append(tags, f.Tag)
+	}
+	if got, want := strings.Join(tags, " "), "20 21 32A 56A 57A 58A 72 50K 52A 57A 59 70 33B"; got != want {
+		t.Errorf("fields %s, want %s", got, want)
+	}
+	for _, tt := range []struct{ tag, want string }{
+		{"121", "eb6305c9-1f7f-49de-aed0-16487c27b42d"},
+		{"20", "COVREF1"},
+		{"21", "REF123"},
+		{"72", "/BNF/INVOICE 1"},
+		{"50K", "/BE71096123456769\nJOHN DOE"},
+		{"33B", "EUR1250,00"},
+	} {
+		if got := value(m, tt.tag); got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.tag, got, tt.want)
+		}
+	}
+	if len(report.Changes) != 0 {
+		t.Errorf("report:\n%s", report)
+	}
+}
+
+func TestFromMXConversion(t *testing.T) {
+	doc, _, err := TranslateMT103(parse(t, mt103))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := doc.Message.CreditTransferTransactionInformation[0]
+	name := model.Max140Text("Société Générale & Fils_Ltd")
+	tx.Creditor.Name = &name
+	ref := model.Max35Text("INSTRUCTION-REFERENCE-2023")
+	tx.PaymentIdentification.InstructionIdentification = &ref
+	tx.PaymentIdentification.TransactionIdentification = &ref
+	var ustrd []*model.Max140Text
+	for _, s := range []string{"INVOICE 2023-001 2023-002 2023-003 2023-004", "-ORDERS 17 AND 18 OF 2023-09-01 AND 2023-09-02", "ORDER 19", "ORDER 20"} {
+		u := model.Max140Text(s)
+		ustrd = append(ustrd, &u)
+	}
+	tx.RemittanceInformation.Unstructured = ustrd
+
+	list, report, err := FromMX(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := list[0]
+	for _, tt := range []struct{ tag, want string }{
+		{"20", "INSTRUCTION-REF+"},
+		{"59", "/DE89370400440532013000\nSociete Generale + Fils-Ltd\nHAUPTSTRASSE 5\n10115 BERLIN"},
+		{"70", "/ROC/INV-2023-001/\nINVOICE 2023-001 2023-002 2023-003 \n2023-004\n.ORDERS 17 AND 18 OF 2023-09-01 AN+"},
+	} {
+		if got := value(m, tt.tag); got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.tag, got, tt.want)
+		}
+	}
+	want := []string{
+		"truncated 20 /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/PmtId/InstrId (INSTRUCTION-REFERENCE-2023): longer than 16 characters",
+		"converted 59a /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/Cdtr/Nm (Société Générale & Fils_Ltd): characters out of the SWIFT X character set",
+		"truncated 70 /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/RmtInf/Ustrd[2] (-ORDERS 17 AND 18 OF 2023-09-01 AND 2023-09-02): longer than the 4 lines of 35 characters of the field",
+		"unmapped 70 /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/RmtInf/Ustrd[3] (ORDER 19): no line left in the field",
+		"unmapped 70 /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/RmtInf/Ustrd[4] (ORDER 20): no line left in the field",
+	}
+	if len(report.Changes) != len(want) {
+		t.Fatalf("report:\n%s", report)
+	}
+	for i, c := range report.Changes {
+		if c.String() != want[i] {
+			t.Errorf("change %d is %s, want %s", i, c, want[i])
+		}
+	}
+}
+
+// changes returns the changes of the report formatted as strings.
+func changes(r *Report) []string {
+	var list []string
+	for _, c := range r.Changes {
+		list = append(list, c.String())
+	}
+	return list
+}
+
+func TestFromMXFormats(t *testing.T) {
+	doc, _, err := TranslateMT103(parse(t, mt103))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := doc.Message.CreditTransferTransactionInformation[0]
+	tx.SetInterbankSettlementAmount("1234567890123.12345", "EUR")
+	tx.SetInstructedAmount("1234567890123.10", "EUR")
+	tx.SetExchangeRate("1.123456789012345")
+	tx.AddPurpose().SetCode("GDDS")
+
+	list, report, err := FromMX(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := list[0]
+	for _, tt := range []struct{ tag, want string }{
+		{"32A", ""},
+		{"33B", "EUR1234567890123,1"},
+		{"36", "1,123456789"},
+		{"26T", ""},
+	} {
+		if got := value(m, tt.tag); got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.tag, got, tt.want)
+		}
+	}
+	want := []string{
+		"unmapped 26T /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/Purp (GDDS): purpose without a transaction type code of 3 characters",
+		"unmapped 32A /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/IntrBkSttlmAmt (EUR 1234567890123.12345): amount has 5 fraction digits, the currency has 2",
+		"converted 36 /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/XchgRate (1.123456789012345): exchange rate rounded to 12 characters",
+	}
+	if strings.Join(changes(report), "\n") != strings.Join(want, "\n") {
+		t.Errorf("report:\n%s\nwant\n%s", report, strings.Join(want, "\n"))
+	}
+
+	tx.SetInterbankSettlementAmount("12345678901234.5", "EUR")
+	tx.SetInstructedAmount("1250", "JPY")
+	tx.SetExchangeRate("123456789012.5")
+	tx.Purpose = nil
+	list, report, err = FromMX(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := value(list[0], "33B"); got != "JPY1250," {
+		t.Errorf("33B = %q", got)
+	}
+	want = []string{
+		"unmapped 32A /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/IntrBkSttlmAmt (EUR 12345678901234.5): amount longer than the 15 characters of an MT amount",
+		"unmapped 36 /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/XchgRate (123456789012.5): exchange rate longer than 12 characters",
+	}
+	if strings.Join(changes(report), "\n") != strings.Join(want, "\n") {
+		t.Errorf("report:\n%s\nwant\n%s", report, strings.Join(want, "\n"))
+	}
+}
+
+func TestFromMXCharges(t *testing.T) {
+	for _, tt := range []struct {
+		bearer  string
+		charges [][2]string
+		fields  string
+		changes []string
+	}{
+		{
+			bearer:  "SHAR",
+			charges: [][2]string{{"BANKBEBB", "15.44"}, {"BANKDEFF", "2"}},
+			fields:  "71A:SHA 71F:EUR15,44 71F:EUR2,",
+		},
+		{
+			bearer: "CRED",
+			fields: "71A:BEN 71F:EUR0,",
+			changes: []string{
+				"defaulted 71F /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/ChrgsInf (EUR0,): no charges of the sender, required with BEN",
+			},
+		},
+		{
+			bearer:  "DEBT",
+			charges: [][2]string{{"BANKBEBB", "15.44"}, {"BANKDEFF", "10"}, {"BANKDEFF", "1"}},
+			fields:  "71A:OUR 71G:EUR10,",
+			changes: []string{
+				"unmapped 71G /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/ChrgsInf[1] (EUR 15.44): charges of an agent other than the receiver, borne by the debtor",
+				"unmapped 71G /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/ChrgsInf[3] (EUR 1): second charges of the receiver",
+			},
+		},
+		{
+			bearer:  "SHAR",
+			charges: [][2]string{{"BANKBEBB", "15.4444"}},
+			fields:  "71A:SHA",
+			changes: []string{
+				"unmapped 71F /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/ChrgsInf[1]/Amt (EUR 15.4444): amount has 4 fraction digits, the currency has 2",
+			},
+		},
+	} {
+		doc, _, err := TranslateMT103(parse(t, mt103))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx := doc.Message.CreditTransferTransactionInformation[0]
+		tx.SetChargeBearer(tt.bearer)
+		tx.ChargesInformation = nil
+		for _, c := range tt.charges {
+			ch := tx.AddChargesInformation()
+			ch.SetAmount(c[1], "EUR")
+			ch.AddAgent().AddFinancialInstitutionIdentification().SetBICFI(c[0])
+		}
+		list, report, err := FromMX(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var fields []string
+		for _, f := range list[0].Text {
+			if strings.HasPrefix(f.Tag, "71") {
+				fields = append(fields, f.Tag+":"+f.Value)
+			}
+		}
+		if got := strings.Join(fields, " "); got != tt.fields {
+			t.Errorf("%s: fields %s, want %s", tt.bearer, got, tt.fields)
+		}
+		if got, want := strings.Join(changes(report), "\n"), strings.Join(tt.changes, "\n"); got != want {
+			t.Errorf("%s: report:\n%s\nwant\n%s", tt.bearer, got, want)
+		}
+	}
+
+	doc, _, err := TranslateMT103(parse(t, mt103))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc.Message.CreditTransferTransactionInformation[0].InstructedAmount = nil
+	_, report, err := FromMX(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "missing 33B /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/InstdAmt: no instructed amount, required with fields 71F and 71G"
+	if got := strings.Join(changes(report), "\n"); got != want {
+		t.Errorf("report:\n%s\nwant\n%s", got, want)
+	}
+}
+
+const camt053 = `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08">
+<BkToCstmrStmt>
+<GrpHdr><MsgId>STMT-20230915</MsgId><CreDtTm>2023-09-15T18:00:00</CreDtTm></GrpHdr>
+<Stmt>
+<Id>STMT-2023-0915-001</Id>
+<StmtPgntn><PgNb>1</PgNb><LastPgInd>true</LastPgInd></StmtPgntn>
+<ElctrncSeqNb>258</ElctrncSeqNb>
+<Acct>
+<Id><IBAN>BE71096123456769</IBAN></Id>
+<Ccy>EUR</Ccy>
+<Ownr><Id><OrgId><AnyBIC>CUSTBEBB</AnyBIC></OrgId></Id></Ownr>
+<Svcr><FinInstnId><BICFI>BANKBEBB</BICFI></FinInstnId></Svcr>
+</Acct>
+<Bal><Tp><CdOrPrtry><Cd>OPBD</Cd></CdOrPrtry></Tp><Amt Ccy="EUR">1000.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Dt><Dt>2023-09-14</Dt></Dt></Bal>
+<Bal><Tp><CdOrPrtry><Cd>CLBD</Cd></CdOrPrtry></Tp><Amt Ccy="EUR">2234.56</Amt><CdtDbtInd>CRDT</CdtDbtInd><Dt><Dt>2023-09-15</Dt></Dt></Bal>
+<Ntry>
+<Amt Ccy="EUR">1234.56</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts><Cd>BOOK</Cd></Sts>
+<BookgDt><Dt>2023-09-15</Dt></BookgDt><ValDt><Dt>2023-09-15</Dt></ValDt>
+<AcctSvcrRef>BANKREF-77</AcctSvcrRef>
+<BkTxCd><Prtry><Cd>NTRF</Cd></Prtry></BkTxCd>
+<NtryDtls><TxDtls><Refs><EndToEndId>INV-2023-001</EndToEndId></Refs><RmtInf><Ustrd>Facture n° 2023-001</Ustrd></RmtInf></TxDtls></NtryDtls>
+</Ntry>
+<Ntry>
+<Amt Ccy="EUR">50</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts><Cd>BOOK</Cd></Sts>
+<BookgDt><Dt>2023-09-15</Dt></BookgDt>
+<BkTxCd><Domn><Cd>PMNT</Cd><Fmly><Cd>CCRD</Cd><SubFmlyCd>CHRG</SubFmlyCd></Fmly></Domn></BkTxCd>
+<AddtlNtryInf>CHARGES</AddtlNtryInf>
+</Ntry>
+</Stmt>
+</BkToCstmrStmt>
+</Document>`
+
+func TestFromMXMT940(t *testing.T) {
+	doc := new(camt.Document05300108)
+	if err := xml.Unmarshal([]byte(camt053), doc); err != nil {
+		t.Fatal(err)
+	}
+	list, report, err := FromMX(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := parse(t, list[0].String())
+	if m.Type() != "940" || m.Sender() != "BANKBEBBXXX" || m.Receiver() != "CUSTBEBBXXX" {
+		t.Errorf("MT%s from %s to %s", m.Type(), m.Sender(), m.Receiver())
+	}
+	var got []string
+	for _, f := range m.Text {
+		got = append(got, ":"+f.Tag+":"+f.Value)
+	}
+	want := []string{
+		":20:STMT-2023-0915-+",
+		":25:BE71096123456769",
+		":28C:258/1",
+		":60F:C230914EUR1000,00",
+		":61:2309150915C1234,56NTRFINV-2023-001//BANKREF-77",
+		":86:Facture n. 2023-001",
+		":61:2309150915D50,NMSCNONREF",
+		":86:CHARGES",
+		":62F:C230915EUR2234,56",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("text\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if report.From != "camt.053.001.08" || report.To != "MT940" {
+		t.Errorf("report from %s to %s", report.From, report.To)
+	}
+	for _, c := range []string{
+		"truncated 20 /Document/BkToCstmrStmt/Stmt[1]/Id (STMT-2023-0915-001): longer than 16 characters",
+		"converted 86 /Document/BkToCstmrStmt/Stmt[1]/Ntry[1]/NtryDtls[1]/TxDtls[1]/RmtInf/Ustrd (Facture n° 2023-001): characters out of the SWIFT X character set",
+		"defaulted 61 /Document/BkToCstmrStmt/Stmt[1]/Ntry[2]/ValDt (2023-09-15): no value date, the booking date",
+		"defaulted 61 /Document/BkToCstmrStmt/Stmt[1]/Ntry[2]/BkTxCd: no transaction type identification code, NMSC",
+		"unmapped /Document/BkToCstmrStmt/Stmt[1]/Ntry[2]/BkTxCd/Domn/Cd (PMNT): no place in MT940",
+	} {
+		if !strings.Contains(report.String(), "\n"+c+"\n") {
+			t.Errorf("no change %s in report:\n%s", c, report)
+		}
+	}
+}
+
+func TestFromMXStatementFormats(t *testing.T) {
+	data := strings.Replace(camt053, "<ElctrncSeqNb>258</ElctrncSeqNb>", "<ElctrncSeqNb>1234567</ElctrncSeqNb>", 1)
+	data = strings.Replace(data, `<Amt Ccy="EUR">50</Amt>`, `<Amt Ccy="EUR">50.001</Amt>`, 1)
+	doc := new(camt.Document05300108)
+	if err := xml.Unmarshal([]byte(data), doc); err != nil {
+		t.Fatal(err)
+	}
+	list, report, err := FromMX(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := list[0]
+	if got := value(m, "28C"); got != "1/1" {
+		t.Errorf("28C = %q", got)
+	}
+	var entries []string
+	for _, f := range m.Text {
+		if f.Tag == "61" {
+			entries = append(entries, f.Value)
+		}
+	}
+	if len(entries) != 1 {
+		t.Errorf("entries %q", entries)
+	}
+	for _, c := range []string{
+		"unmapped 28C /Document/BkToCstmrStmt/Stmt[1]/ElctrncSeqNb (1234567): sequence number of more than 5 digits",
+		"defaulted 28C /Document/BkToCstmrStmt/Stmt[1]/ElctrncSeqNb (1): no sequence number of the statement",
+		"unmapped 61 /Document/BkToCstmrStmt/Stmt[1]/Ntry[2]/Amt (EUR 50.001): amount has 3 fraction digits, the currency has 2",
+		"unmapped 61 /Document/BkToCstmrStmt/Stmt[1]/Ntry[2]: entry without a valid amount",
+	} {
+		if !strings.Contains(report.String(), "\n"+c+"\n") {
+			t.Errorf("no change %s in report:\n%s", c, report)
+		}
+	}
+}
+
+func TestFromMXBIC(t *testing.T) {
+	doc, _, err := TranslateMT103(parse(t, mt103))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := doc.Message.CreditTransferTransactionInformation[0]
+	agent := tx.AddIntermediaryAgent1().AddFinancialInstitutionIdentification()
+	agent.SetBICFI("CITIUS")
+	agent.SetName("CITIBANK")
+	tx.AddCreditorAgent().AddFinancialInstitutionIdentification().SetBICFI("BANKDEFFXXXXX")
+	list, report, err := FromMX(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := parse(t, list[0].String())
+	for _, tt := range []struct{ tag, want string }{
+		{"56D", "CITIBANK"},
+		{"57a", ""},
+	} {
+		if got := value(m, tt.tag); got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.tag, got, tt.want)
+		}
+	}
+	for _, c := range []string{
+		"unmapped 56a /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/IntrmyAgt1/FinInstnId (CITIUS): BIC not valid",
+		"unmapped 57a /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/CdtrAgt/FinInstnId (BANKDEFFXXXXX): BIC not valid",
+	} {
+		if !strings.Contains(report.String()+"\n", "\n"+c+"\n") {
+			t.Errorf("no change %s in report:\n%s", c, report)
+		}
+	}
+
+	for _, bic := range []string{"BANK", "BANK1", "BANKDE", "99999999A999", "BANKDEFFXXXXX"} {
+		tx.AddInstructedAgent().AddFinancialInstitutionIdentification().SetBICFI(bic)
+		list, report, err := FromMX(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := list[0].Application.Address; got != "" {
+			t.Errorf("receiver %s: address %s", bic, got)
+		}
+		c := "unmapped /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1] (" + bic + "): BIC of the receiver not valid"
+		if !strings.Contains(report.String()+"\n", "\n"+c+"\n") {
+			t.Errorf("no change %s in report:\n%s", c, report)
+		}
+	}
+}
+
+func TestFromMXError(t *testing.T) {
+	if _, _, err := FromMX(new(pacs.Document00200108)); err == nil {
+		t.Error("translation of a pacs.002 succeeded")
+	}
+	if _, _, err := FromMX(new(pacs.Document00800108)); err == nil {
+		t.Error("translation of an empty pacs.008 succeeded")
+	}
+}
@@ -0,0 +1,188 @@
+package mt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yudaprama/iso20022/pacs"
+)
+
+// chargeBearers maps the details of charges of field 71A to the charge
+// bearer codes.
+var chargeBearers = map[string]string{
+	"OUR": "DEBT",
+	"BEN": "CRED",
+	"SHA": "SHAR",
+}
+
+// TranslateMT103 translates a single customer credit transfer to a
+// pacs.008.001.08.
+func TranslateMT103(m *Message) (*pacs.Document00800108, *Report, error) {
+	if m.Application.Type != "103" {
+		return nil, nil, fmt.Errorf("mt: MT%s is not an MT103", m.Type())
+	}
+	doc := new(pacs.Document00800108)
+	t, root := newTranslator(m, doc)
+	grp, _ := root.get("FIToFICstmrCdtTrf/GrpHdr")
+	tx, _ := root.get("FIToFICstmrCdtTrf/CdtTrfTxInf")
+	if err := t.header(grp, tx); err != nil {
+		return nil, nil, err
+	}
+	t.field = ""
+	if f, ok := m.Field("70"); ok && strings.HasPrefix(f.Value, "/ROC/") {
+		ref := strings.TrimPrefix(f.Lines()[0], "/ROC/")
+		if i := strings.IndexByte(ref, '/'); i >= 0 {
+			ref = ref[:i]
+		}
+		t.field = "70"
+		tx.set("PmtId/EndToEndId", ref)
+	} else {
+		t.defaulted(tx, "PmtId/EndToEndId", "NOTPROVIDED", "no reference of the ordering customer in field 70")
+	}
+	t.field = ""
+	if _, ok := m.Field("52a"); !ok {
+		t.defaulted(tx, "DbtrAgt/FinInstnId/BICFI", m.Sender(), "no field 52a, the sender")
+	}
+	if _, ok := m.Field("57a"); !ok {
+		t.defaulted(tx, "CdtrAgt/FinInstnId/BICFI", m.Receiver(), "no field 57a, the receiver")
+	}
+
+	for _, f := range m.Text {
+		t.field = f.Tag
+		switch f.Number() {
+		case "20", "32", "53", "54", "55":
+		case "13":
+			t.settlementTime(tx, f)
+		case "23":
+			if f.Tag == "23B" {
+				t.bankOperation(tx, f)
+			} else {
+				t.instructionCode(tx, f)
+			}
+		case "26":
+			tx.set("Purp/Prtry", f.Value)
+		case "33":
+			t.instructedAmount(tx, f)
+		case "36":
+			tx.set("XchgRate", mxDecimal(f.Value))
+		case "50":
+			t.party(tx, "Dbtr", "DbtrAcct", f)
+		case "52":
+			t.agent(tx, "DbtrAgt", "DbtrAgtAcct", f)
+		case "56":
+			t.agent(tx, "IntrmyAgt1", "IntrmyAgt1Acct", f)
+		case "57":
+			t.agent(tx, "CdtrAgt", "CdtrAgtAcct", f)
+		case "59":
+			t.party(tx, "Cdtr", "CdtrAcct", f)
+		case "70":
+			t.remittance(tx, f)
+		case "71":
+			t.charges(tx, f)
+		case "72":
+			t.senderToReceiver(tx, f)
+		case "77":
+			if f.Tag == "77B" {
+				t.regulatoryReporting(tx, f)
+			} else {
+				t.unmapped(f.Value, "no translation of field "+f.Tag)
+			}
+		default:
+			t.unmapped(f.Value, "no translation of field "+f.Tag)
+		}
+	}
+	return doc, t.done(), nil
+}
+
+// bankOperation translates the bank operation code of field 23B: the code
+// CRED of a plain credit transfer has no translation, the other codes are
+// kept as a proprietary service level.
+func (t *translator) bankOperation(tx element, f Field) {
+	if f.Value != "CRED" {
+		tx.set("PmtTpInf/SvcLvl+/Prtry", f.Value)
+	}
+}
+
+// instructionCode translates an instruction code of field 23E to an
+// instruction for the creditor agent or for the next agent, to a service
+// level or to a category purpose.
+func (t *translator) instructionCode(tx element, f Field) {
+	code, info := cut(f.Value)
+	switch code {
+	case "CHQB", "HOLD", "PHOB", "TELB":
+		if g, ok := tx.get("InstrForCdtrAgt+"); ok {
+			g.set("Cd", code)
+			g.set("InstrInf", info)
+		}
+	case "PHON", "PHOI", "TELE", "TELI":
+		if g, ok := tx.get("InstrForNxtAgt+"); ok {
+			g.set("Cd", code[:3]+"A")
+			g.set("InstrInf", info)
+		}
+	case "SDVA":
+		tx.set("PmtTpInf/SvcLvl+/Cd", code)
+	case "INTC", "CORT":
+		tx.set("PmtTpInf/CtgyPurp/Cd", code)
+	default:
+		t.unmapped(f.Value, "instruction code without equivalent")
+	}
+}
+
+// charges translates the details of charges of field 71A to the charge bearer,
+// and the sender's and receiver's charges of fields 71F and 71G to charges
+// information with the sender or the receiver as agent.
+func (t *translator) charges(tx element, f Field) {
+	switch f.Tag {
+	case "71A":
+		if code, ok := chargeBearers[f.Value]; ok {
+			tx.set("ChrgBr", code)
+		} else {
+			t.unmapped(f.Value, "unknown details of charges")
+		}
+	case "71F", "71G":
+		m := amountFormat.FindStringSubmatch(f.Value)
+		if m == nil {
+			t.unmapped(f.Value, "invalid amount")
+			return
+		}
+		agent := t.m.Sender()
+		if f.Tag == "71G" {
+			agent = t.m.Receiver()
+		}
+		if c, ok := tx.get("ChrgsInf+"); ok {
+			c.amount("Amt", mxDecimal(m[2]), m[1])
+			c.set("Agt/FinInstnId/BICFI", agent)
+		}
+	default:
+		t.unmapped(f.Value, "no translation of field "+f.Tag)
+	}
+}
+
+// regulatoryReporting translates the regulatory reporting of field 77B. The
+// codes BENEFRES and ORDERRES, with the country of residence, give the
+// reporting indicator and the country; the other lines are kept as
+// information.
+func (t *translator) regulatoryReporting(tx element, f Field) {
+	r, ok := tx.get("RgltryRptg+")
+	if !ok {
+		return
+	}
+	d, _ := r.get("Dtls+")
+	for _, in := range instructions(f) {
+		country, text := cut(in.text)
+		switch {
+		case in.code == "BENEFRES" && strings.HasPrefix(text, "/"):
+			r.set("DbtCdtRptgInd", "CRED")
+			d.set("Ctry", country)
+			d.set("Inf+", text[1:])
+		case in.code == "ORDERRES" && strings.HasPrefix(text, "/"):
+			r.set("DbtCdtRptgInd", "DEBT")
+			d.set("Ctry", country)
+			d.set("Inf+", text[1:])
+		case in.code != "":
+			d.set("Inf+", "/"+in.code+"/"+in.text)
+		default:
+			d.set("Inf+", in.text)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package mt
+
+import (
+	"fmt"
+
+	"github.com/yudaprama/iso20022/pacs"
+)
+
+// TranslateMT202 translates a general financial institution transfer to a
+// pacs.009.001.08. The underlying customer credit transfer of an MT202 COV,
+// its sequence B from field 50a, is translated to the underlying customer
+// credit transfer of the transaction.
+func TranslateMT202(m *Message) (*pacs.Document00900108, *Report, error) {
+	if m.Application.Type != "202" {
+		return nil, nil, fmt.Errorf("mt: MT%s is not an MT202", m.Type())
+	}
+	if _, ok := m.Field("21"); !ok {
+		return nil, nil, fmt.Errorf("mt: MT%s without field 21", m.Type())
+	}
+	doc := new(pacs.Document00900108)
+	t, root := newTranslator(m, doc)
+	grp, _ := root.get("FICdtTrf/GrpHdr")
+	tx, _ := root.get("FICdtTrf/CdtTrfTxInf")
+	if err := t.header(grp, tx); err != nil {
+		return nil, nil, err
+	}
+
+	// Sequence B of an MT202 COV starts with field 50a.
+	seqA, seqB := m.Text, []Field(nil)
+	for i, f := range m.Text {
+		if f.Number() == "50" {
+			seqA, seqB = m.Text[:i], m.Text[i:]
+			break
+		}
+	}
+	t.field = ""
+	if _, ok := find(seqA, "52"); !ok {
+		t.defaulted(tx, "Dbtr/FinInstnId/BICFI", m.Sender(), "no field 52a, the sender")
+	}
+	for _, f := range seqA {
+		t.field = f.Tag
+		switch f.Number() {
+		case "20", "32", "53", "54", "55":
+		case "21":
+			tx.set("PmtId/EndToEndId", f.Value)
+		case "13":
+			t.settlementTime(tx, f)
+		case "52":
+			t.agent(tx, "Dbtr", "DbtrAcct", f)
+		case "56":
+			t.agent(tx, "IntrmyAgt1", "IntrmyAgt1Acct", f)
+		case "57":
+			t.agent(tx, "CdtrAgt", "CdtrAgtAcct", f)
+		case "58":
+			t.agent(tx, "Cdtr", "CdtrAcct", f)
+		case "72":
+			t.senderToReceiver(tx, f)
+		default:
+			t.unmapped(f.Value, "no translation of field "+f.Tag)
+		}
+	}
+	if len(seqB) > 0 {
+		t.field = "50" + seqB[0].Option()
+		if u, ok := tx.get("UndrlygCstmrCdtTrf"); ok {
+			t.cover(u, seqA, seqB)
+		}
+	}
+	return doc, t.done(), nil
+}
+
+// cover translates sequence B of an MT202 COV to the underlying customer
+// credit transfer u. The debtor and creditor agents default to the ordering
+// and beneficiary institutions of sequence A.
+func (t *translator) cover(u element, seqA, seqB []Field) {
+	for _, f := range seqB {
+		t.field = f.Tag
+		t.underlying(u, f)
+	}
+	t.field = ""
+	if u.free("DbtrAgt") {
+		value := t.m.Sender()
+		if f, ok := find(seqA, "52"); ok {
+			value = f.Value
+			t.agent(u, "DbtrAgt", "DbtrAgtAcct", f)
+		} else {
+			u.set("DbtrAgt/FinInstnId/BICFI", value)
+		}
+		t.report(Defaulted, u.path+"/DbtrAgt", value, "no field 52a in sequence B, the ordering institution")
+	}
+	if f, ok := find(seqA, "58"); ok && u.free("CdtrAgt") {
+		t.agent(u, "CdtrAgt", "CdtrAgtAcct", f)
+		t.report(Defaulted, u.path+"/CdtrAgt", f.Value, "no field 57a in sequence B, the beneficiary institution")
+	}
+}
+
+// underlying translates a field of sequence B of an MT202 COV to the
+// underlying customer credit transfer u.
+func (t *translator) underlying(u element, f Field) {
+	switch f.Number() {
+	case "33":
+		t.instructedAmount(u, f)
+	case "50":
+		t.party(u, "Dbtr", "DbtrAcct", f)
+	case "52":
+		t.agent(u, "DbtrAgt", "DbtrAgtAcct", f)
+	case "56":
+		t.agent(u, "IntrmyAgt1", "IntrmyAgt1Acct", f)
+	case "57":
+		t.agent(u, "CdtrAgt", "CdtrAgtAcct", f)
+	case "59":
+		t.party(u, "Cdtr", "CdtrAcct", f)
+	case "70":
+		t.remittance(u, f)
+	case "72":
+		t.senderToReceiver(u, f)
+	default:
+		t.unmapped(f.Value, "no translation of field "+f.Tag+" of the underlying customer credit transfer")
+	}
+}
+
+// find returns the first field of fields with the given number.
+func find(fields []Field, number string) (Field, bool) {
+	for _, f := range fields {
+		if f.Number() == number {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
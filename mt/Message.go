@@ -0,0 +1,396 @@
+// Package mt reads SWIFT MT messages and translates the customer and financial
+// institution credit transfers to their ISO 20022 equivalents, following the
+// MT-MX translation rules of the cross-border payments and reporting plus
+// (CBPR+) usage guidelines: an MT103 becomes a pacs.008.001.08 and an MT202 or
+// MT202 COV a pacs.009.001.08.
+//
+// Parse splits a message in its blocks: the basic header (block 1), the
+// application header (block 2), the user header (block 3), the text (block 4)
+// and the trailer (block 5). The fields of the text are kept as they are,
+// with their tag, such as 50K, and their lines; the helpers of Field read the
+// components of the options used by the translation.
+//
+// An MT field rarely maps one to one to an ISO 20022 element. What cannot be
+// carried is reported: the values longer than their element are truncated, the
+// fields or parts of fields that the message definition has no place for, or
+// whose value is not valid in it, are unmapped, and the mandatory elements that
+// the MT message does not give are defaulted.
+//
+// FromMX translates the other way, for the receivers that only take MT
+// messages: the transactions of a pacs.008 become MT103s, those of a pacs.009
+// MT202s or MT202 COVs, and the statements of a camt.053 MT940s. String writes
+// the messages it builds. The text is converted to the SWIFT X character set
+// and the text and references longer than their field are truncated, their
+// last character replaced by +; codes, identifiers and amounts that do not fit
+// their field are left out. The report lists these changes, the converted
+// values, the elements that no field holds and the mandatory fields that the
+// Document does not give.
+package mt
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// BasicHeader is the basic header block of a message, {1:F01BANKBEBBAXXX0000000000}.
+type BasicHeader struct {
+	ApplicationID string
+
+	ServiceID string
+
+	// Address is the logical terminal address of the sender of an input
+	// message, or of the receiver of an output message: the BIC8, the logical
+	// terminal code and the branch code.
+	Address string
+
+	Session string
+
+	Sequence string
+}
+
+// ApplicationHeader is the application header block of a message, such as
+// {2:I103BANKDEFFXXXXN} for an input message sent to SWIFT, or
+// {2:O1031200100101BANKBEBBAXXX00000000001001011200N} for an output message
+// delivered by SWIFT.
+type ApplicationHeader struct {
+
+	// Input reports whether the message is an input message.
+	Input bool
+
+	// Type is the message type, for example 103.
+	Type string
+
+	// Address is the destination address of an input message, or the address
+	// of the sender in the message input reference of an output message.
+	Address string
+
+	Priority string
+
+	// InputTime and MIR, the message input reference, are those of an output
+	// message, as are OutputDate and OutputTime.
+	InputTime  string
+	MIR        string
+	OutputDate string
+	OutputTime string
+}
+
+// Field is a field of the user header, the text or the trailer block, for
+// example 32A with value 230915EUR1000,00.
+type Field struct {
+	Tag   string
+	Value string
+}
+
+// Number returns the number of the tag of f, 50 for 50K.
+func (f Field) Number() string {
+	return strings.TrimRight(f.Tag, "ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+}
+
+// Option returns the letter option of the tag of f, K for 50K, or an empty
+// string.
+func (f Field) Option() string {
+	return f.Tag[len(f.Number()):]
+}
+
+// Lines returns the lines of the value of f.
+func (f Field) Lines() []string {
+	return strings.Split(f.Value, "\n")
+}
+
+// Message is an MT message.
+type Message struct {
+	Basic       BasicHeader
+	Application ApplicationHeader
+	User        []Field
+	Text        []Field
+	Trailer     []Field
+}
+
+// Type returns the message type of m, for example 103, followed by the
+// validation flag of the user header, if any, as in 202COV.
+func (m *Message) Type() string {
+	for _, f := range m.User {
+		if f.Tag == "119" && f.Value == "COV" {
+			return m.Application.Type + f.Value
+		}
+	}
+	return m.Application.Type
+}
+
+// UserField returns the value of the field of the user header with the given
+// tag, such as 121 for the unique end-to-end transaction reference, and
+// whether it is present.
+func (m *Message) UserField(tag string) (string, bool) {
+	for _, f := range m.User {
+		if f.Tag == tag {
+			return f.Value, true
+		}
+	}
+	return "", false
+}
+
+// Field returns the first field of the text of m with the given tag and
+// whether it is present. A lowercase a in the tag stands for any option or
+// none, so that 50a matches 50A, 50F and 50K.
+func (m *Message) Field(tag string) (Field, bool) {
+	for _, f := range m.Text {
+		if matchTag(f.Tag, tag) {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+// Fields returns the fields of the text of m with the given tag, which may end
+// with the option wildcard a as for Field.
+func (m *Message) Fields(tag string) []Field {
+	var list []Field
+	for _, f := range m.Text {
+		if matchTag(f.Tag, tag) {
+			list = append(list, f)
+		}
+	}
+	return list
+}
+
+func matchTag(tag, pattern string) bool {
+	if strings.HasSuffix(pattern, "a") {
+		return Field{Tag: tag}.Number() == pattern[:len(pattern)-1]
+	}
+	return tag == pattern
+}
+
+// Sender returns the BIC11 of the sender of m, taken from the basic header of
+// an input message or from the application header of an output message.
+func (m *Message) Sender() string {
+	if m.Application.Input {
+		return bic(m.Basic.Address)
+	}
+	return bic(m.Application.Address)
+}
+
+// Receiver returns the BIC11 of the receiver of m.
+func (m *Message) Receiver() string {
+	if m.Application.Input {
+		return bic(m.Application.Address)
+	}
+	return bic(m.Basic.Address)
+}
+
+// bic returns the BIC11 of a logical terminal address, without its logical
+// terminal code.
+func bic(address string) string {
+	if len(address) != 12 {
+		return address
+	}
+	return address[:8] + address[9:]
+}
+
+// String returns m in the FIN format, with its lines ending with CRLF.
+func (m *Message) String() string {
+	var b strings.Builder
+	h := m.Basic
+	b.WriteString("{1:" + h.ApplicationID + h.ServiceID + h.Address + h.Session + h.Sequence + "}")
+	a := m.Application
+	if a.Input {
+		b.WriteString("{2:I" + a.Type + a.Address + a.Priority + "}")
+	} else {
+		b.WriteString("{2:O" + a.Type + a.InputTime + a.MIR + a.OutputDate + a.OutputTime + a.Priority + "}")
+	}
+	if len(m.User) > 0 {
+		b.WriteString("{3:")
+		writeSubfields(&b, m.User)
+		b.WriteString("}")
+	}
+	b.WriteString("{4:\r\n")
+	for _, f := range m.Text {
+		b.WriteString(":" + f.Tag + ":" + strings.ReplaceAll(f.Value, "\n", "\r\n") + "\r\n")
+	}
+	b.WriteString("-}")
+	if len(m.Trailer) > 0 {
+		b.WriteString("{5:")
+		writeSubfields(&b, m.Trailer)
+		b.WriteString("}")
+	}
+	return b.String()
+}
+
+func writeSubfields(b *strings.Builder, fields []Field) {
+	for _, f := range fields {
+		b.WriteString("{" + f.Tag + ":" + f.Value + "}")
+	}
+}
+
+var fieldStart = regexp.MustCompile(`^:([0-9]{2}[A-Z]?):`)
+
+// Parse parses an MT message in the FIN format. The lines may end with CRLF, as
+// sent over the network, or with LF.
+func Parse(data []byte) (*Message, error) {
+	s := strings.ReplaceAll(string(data), "\r\n", "\n")
+	s = strings.TrimSpace(s)
+	m := new(Message)
+	seen := map[string]bool{}
+	for s != "" {
+		if s[0] != '{' {
+			return nil, fmt.Errorf("mt: unexpected %q outside of a block", excerpt(s))
+		}
+		colon := strings.IndexByte(s, ':')
+		if colon < 0 {
+			return nil, fmt.Errorf("mt: block without identifier at %q", excerpt(s))
+		}
+		id := s[1:colon]
+		if seen[id] {
+			return nil, fmt.Errorf("mt: repeated block %s", id)
+		}
+		seen[id] = true
+		// The colon found may be past the end of the block, which then has no
+		// identifier.
+		var content string
+		if id == "4" {
+			end := strings.Index(s, "\n-}")
+			if end < 0 {
+				return nil, fmt.Errorf("mt: text block not terminated by -}")
+			}
+			if end < colon {
+				return nil, fmt.Errorf("mt: block without identifier at %q", excerpt(s))
+			}
+			content, s = s[colon+1:end], s[end+3:]
+		} else {
+			end := closingBrace(s)
+			if end < 0 {
+				return nil, fmt.Errorf("mt: block %s not terminated", id)
+			}
+			if end < colon {
+				return nil, fmt.Errorf("mt: block without identifier at %q", excerpt(s))
+			}
+			content, s = s[colon+1:end], s[end+1:]
+		}
+		s = strings.TrimLeft(s, "\n")
+
+		var err error
+		switch id {
+		case "1":
+			err = m.parseBasic(content)
+		case "2":
+			err = m.parseApplication(content)
+		case "3":
+			m.User, err = parseSubfields(id, content)
+		case "4":
+			m.Text, err = parseText(content)
+		case "5":
+			m.Trailer, err = parseSubfields(id, content)
+		default:
+			err = fmt.Errorf("mt: unknown block %s", id)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !seen["1"] || !seen["2"] || !seen["4"] {
+		return nil, fmt.Errorf("mt: message without basic header, application header or text")
+	}
+	return m, nil
+}
+
+func excerpt(s string) string {
+	if len(s) > 20 {
+		return s[:20] + "..."
+	}
+	return s
+}
+
+// closingBrace returns the index of the brace closing the block that s starts
+// with, or -1.
+func closingBrace(s string) int {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func (m *Message) parseBasic(s string) error {
+	if len(s) != 25 {
+		return fmt.Errorf("mt: basic header %q is not 25 characters long", s)
+	}
+	m.Basic = BasicHeader{
+		ApplicationID: s[:1],
+		ServiceID:     s[1:3],
+		Address:       s[3:15],
+		Session:       s[15:19],
+		Sequence:      s[19:],
+	}
+	return nil
+}
+
+func (m *Message) parseApplication(s string) error {
+	switch {
+	case len(s) >= 16 && len(s) <= 21 && s[0] == 'I':
+		m.Application = ApplicationHeader{
+			Input:   true,
+			Type:    s[1:4],
+			Address: s[4:16],
+		}
+		if len(s) > 16 {
+			m.Application.Priority = s[16:17]
+		}
+	case len(s) >= 46 && len(s) <= 47 && s[0] == 'O':
+		m.Application = ApplicationHeader{
+			Type:       s[1:4],
+			InputTime:  s[4:8],
+			MIR:        s[8:36],
+			Address:    s[14:26],
+			OutputDate: s[36:42],
+			OutputTime: s[42:46],
+			Priority:   s[46:],
+		}
+	default:
+		return fmt.Errorf("mt: invalid application header %q", s)
+	}
+	return nil
+}
+
+// parseSubfields parses the {tag:value} fields of the user header or the
+// trailer.
+func parseSubfields(id, s string) ([]Field, error) {
+	var list []Field
+	for s != "" {
+		end := closingBrace(s)
+		colon := strings.IndexByte(s, ':')
+		if s[0] != '{' || end < 0 || colon < 0 || colon > end {
+			return nil, fmt.Errorf("mt: invalid field %q in block %s", excerpt(s), id)
+		}
+		list = append(list, Field{Tag: s[1:colon], Value: s[colon+1 : end]})
+		s = s[end+1:]
+	}
+	return list, nil
+}
+
+// parseText parses the fields of the text block, each starting on a new line
+// with its tag between colons.
+func parseText(s string) ([]Field, error) {
+	if !strings.HasPrefix(s, "\n") {
+		return nil, fmt.Errorf("mt: text block does not start with a new line")
+	}
+	var list []Field
+	for _, line := range strings.Split(s[1:], "\n") {
+		if m := fieldStart.FindStringSubmatch(line); m != nil {
+			list = append(list, Field{Tag: m[1], Value: line[len(m[0]):]})
+			continue
+		}
+		if len(list) == 0 {
+			return nil, fmt.Errorf("mt: text block does not start with a field")
+		}
+		list[len(list)-1].Value += "\n" + line
+	}
+	return list, nil
+}
@@ -0,0 +1,140 @@
+package mt
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const mt103 = `{1:F01BANKBEBBAXXX0000000000}{2:I103BANKDEFFXXXXN}{3:{108:MUR123}{121:eb6305c9-1f7f-49de-aed0-16487c27b42d}}{4:
+:20:REF123
+:23B:CRED
+:23E:PHOB/+32 2 555 1234
+:32A:230915EUR1234,56
+:33B:EUR1250,00
+:50F:/BE71096123456769
+1/JOHN DOE
+2/RUE DE LA LOI 1
+3/BE/BRUSSELS
+:52A:BANKBEBB
+:53A:CITIUS33
+:57A:BANKDEFF
+:59:/DE89370400440532013000
+ERIKA MUSTERMANN
+HAUPTSTRASSE 5
+10115 BERLIN
+:70:/ROC/INV-2023-001/
+:71A:SHA
+:71F:EUR15,44
+:72:/INS/ABNANL2A
+/ACC/CALL BEFORE PAYMENT
+:77B:/ORDERRES/BE//MEILAAN 1, 9000 GENT
+// GOODS EXPORT LICENCE 123456
+-}{5:{CHK:123456789ABC}}`
+
+func TestParse(t *testing.T) {
+	m, err := Parse([]byte(strings.ReplaceAll(mt103, "\n", "\r\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (BasicHeader{"F", "01", "BANKBEBBAXXX", "0000", "000000"}); m.Basic != want {
+		t.Errorf("basic header %+v, want %+v", m.Basic, want)
+	}
+	if want := (ApplicationHeader{Input: true, Type: "103", Address: "BANKDEFFXXXX", Priority: "N"}); m.Application != want {
+		t.Errorf("application header %+v, want %+v", m.Application, want)
+	}
+	if m.Type() != "103" || m.Sender() != "BANKBEBBXXX" || m.Receiver() != "BANKDEFFXXX" {
+		t.Errorf("type %s, sender %s, receiver %s", m.Type(), m.Sender(), m.Receiver())
+	}
+	if uetr, _ := m.UserField("121"); uetr != "eb6305c9-1f7f-49de-aed0-16487c27b42d" {
+		t.Errorf("UETR %q", uetr)
+	}
+	if len(m.Text) != 15 {
+		t.Errorf("%d fields, want 15", len(m.Text))
+	}
+	if want := []Field{{"CHK", "123456789ABC"}}; !reflect.DeepEqual(m.Trailer, want) {
+		t.Errorf("trailer %v, want %v", m.Trailer, want)
+	}
+	f, ok := m.Field("59a")
+	if !ok || f.Tag != "59" || f.Number() != "59" || f.Option() != "" || len(f.Lines()) != 4 {
+		t.Errorf("field 59a %+v", f)
+	}
+	if got := m.Fields("7"); got != nil {
+		t.Errorf("fields 7: %v", got)
+	}
+	if got := len(m.Fields("71a")); got != 2 {
+		t.Errorf("%d fields 71a, want 2", got)
+	}
+
+	output := `{1:F01BANKBEBBAXXX0000000000}{2:O2021200230915CITIUS33AXXX00000000002309151200N}{4:
+:20:COVREF1
+-}`
+	m, err = Parse([]byte(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (ApplicationHeader{
+		Type:       "202",
+		Address:    "CITIUS33AXXX",
+		Priority:   "N",
+		InputTime:  "1200",
+		MIR:        "230915CITIUS33AXXX0000000000",
+		OutputDate: "230915",
+		OutputTime: "1200",
+	}); m.Application != want {
+		t.Errorf("application header %+v, want %+v", m.Application, want)
+	}
+	if m.Sender() != "CITIUS33XXX" || m.Receiver() != "BANKBEBBXXX" {
+		t.Errorf("sender %s, receiver %s", m.Sender(), m.Receiver())
+	}
+}
+
+func TestParseError(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"{1:F01BANKBEBBAXXX0000000000}{2:I103BANKDEFFXXXXN}",
+		"{1:F01BANKBEBBAXXX0000000000}{2:I103BANKDEFFXXXXN}{4:\n:20:REF123\n",
+		"{1:F01BANKBEBBAXXX}{2:I103BANKDEFFXXXXN}{4:\n:20:REF123\n-}",
+		"{1:F01BANKBEBBAXXX0000000000}{2:X103BANKDEFFXXXXN}{4:\n:20:REF123\n-}",
+		"{1:F01BANKBEBBAXXX0000000000}{2:I103BANKDEFFXXXXN}{4:\nREF123\n-}",
+		"{1:F01BANKBEBBAXXX0000000000}{2:I103BANKDEFFXXXXN}{4:\n:20:REF123\n-}trailing",
+		"{0}:0",
+		"{4\n-}:x",
+	} {
+		if _, err := Parse([]byte(s)); err == nil {
+			t.Errorf("Parse(%q) succeeded", s)
+		}
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	for _, s := range []string{mt103, mt202cov, "{0}:0", "{4\n-}:x"} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		if m, err := Parse([]byte(s)); err == nil {
+			_ = m.String()
+		}
+	})
+}
+
+func TestParty(t *testing.T) {
+	for _, tt := range []struct {
+		field Field
+		want  Party
+	}{
+		{Field{"57A", "//FW021000018\nCITIUS33"}, Party{ClearingCode: "FW", ClearingID: "021000018", BIC: "CITIUS33"}},
+		{Field{"50A", "/D/BE71096123456769\nBANKBEBB"}, Party{Account: "BE71096123456769", BIC: "BANKBEBB"}},
+		{Field{"53B", "/12345\nNEW YORK"}, Party{Account: "12345", Location: "NEW YORK"}},
+		{Field{"50K", "JOHN DOE\nRUE DE LA LOI 1"}, Party{Name: []string{"JOHN DOE"}, Address: []string{"RUE DE LA LOI 1"}}},
+		{
+			Field{"50F", "NIDN/BE/12345\n1/JOHN\n1/DOE\n4/19800101\n5/BE/GENT\n7/BE/1234\n8/5678"},
+			Party{Identifier: "NIDN/BE/12345", Name: []string{"JOHN", "DOE"}, BirthDate: "19800101", BirthCountry: "BE", BirthCity: "GENT", NationalID: "BE/12345678"},
+		},
+		{Field{"59F", "1/ERIKA\nUNNUMBERED"}, Party{Name: []string{"ERIKA"}, Other: []string{"UNNUMBERED"}}},
+	} {
+		if got := tt.field.Party(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: %+v, want %+v", tt.field.Tag, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package mt
+
+import (
+	"regexp"
+	"strings"
+)
+
+// chargeDetails maps the charge bearer codes to the details of charges
+// of field 71A.
+var chargeDetails = map[string]string{
+	"DEBT": "OUR",
+	"CRED": "BEN",
+	"SHAR": "SHA",
+}
+
+// nextAgentCodes maps the codes of the instructions for the next agent to the
+// instruction codes of field 23E.
+var nextAgentCodes = map[string]string{
+	"PHOA": "PHON",
+	"TELA": "TELE",
+}
+
+// transactionTypeCode is the format of the transaction type code of field 26T.
+var transactionTypeCode = regexp.MustCompile(`^[A-Z0-9]{3}$`)
+
+// mt103 builds the MT103 of the credit transfer transaction tx of a pacs.008
+// with the group header grp.
+func (b *builder) mt103(grp, tx source) *Message {
+	sender := bic11(either(tx, grp, "InstgAgt/FinInstnId/BICFI|InstgAgt/FinInstnId/BIC"))
+	receiver := bic11(either(tx, grp, "InstdAgt/FinInstnId/BICFI|InstdAgt/FinInstnId/BIC"))
+	b.start("103", tx, sender, receiver)
+	b.uetr(tx)
+
+	b.senderReference(tx)
+	b.settlementTime(tx)
+	b.bankOperation(tx, grp)
+	b.instructionCodes(tx, grp)
+	b.field = "26T"
+	if purpose := tx.text("Purp/Prtry|Purp/Cd"); transactionTypeCode.MatchString(purpose) {
+		b.add("26T", purpose)
+	} else if purpose != "" {
+		b.report(Unmapped, tx.path+"/Purp", purpose, "purpose without a transaction type code of 3 characters")
+	}
+	b.valueDate(tx, grp)
+	b.instructedAmount(tx)
+	b.field = "36"
+	if rate := tx.text("XchgRate"); rate != "" {
+		b.add("36", b.rate(tx.path+"/XchgRate", rate))
+	}
+	if !b.party(tx, "50", "Dbtr", "DbtrAcct") {
+		b.report(Missing, tx.path+"/Dbtr", "", "no ordering customer")
+	}
+	if bic11(tx.text("DbtrAgt/FinInstnId/BICFI|DbtrAgt/FinInstnId/BIC")) != sender || tx.elem("DbtrAgtAcct").present() {
+		b.agent(tx, "52", "DbtrAgt", "DbtrAgtAcct")
+	}
+	b.settlement(grp)
+	b.agent(grp, "55", "SttlmInf/ThrdRmbrsmntAgt", "SttlmInf/ThrdRmbrsmntAgtAcct")
+	b.agent(tx, "56", "IntrmyAgt1", "IntrmyAgt1Acct")
+	if bic11(tx.text("CdtrAgt/FinInstnId/BICFI|CdtrAgt/FinInstnId/BIC")) != receiver || tx.elem("CdtrAgtAcct").present() {
+		b.agent(tx, "57", "CdtrAgt", "CdtrAgtAcct")
+	}
+	if !b.party(tx, "59", "Cdtr", "CdtrAcct") {
+		b.report(Missing, tx.path+"/Cdtr", "", "no beneficiary customer")
+	}
+	reference := tx.text("PmtId/EndToEndId")
+	if reference == "NOTPROVIDED" {
+		reference = ""
+	}
+	b.remittance(tx, reference)
+	b.charges(tx, receiver)
+	b.senderToReceiver(tx)
+	b.regulatoryReporting(tx)
+	return b.msg
+}
+
+// uetr adds the unique end-to-end transaction reference of tx to the user
+// header, as field 121.
+func (b *builder) uetr(tx source) {
+	b.field = "121"
+	uetr := tx.text("PmtId/UETR")
+	if uetr == "" {
+		b.report(Missing, tx.path+"/PmtId/UETR", "", "no unique end-to-end transaction reference")
+		return
+	}
+	b.msg.User = append(b.msg.User, Field{Tag: "121", Value: uetr})
+}
+
+// valueDate adds field 32A for the interbank settlement date and amount of
+// tx.
+func (b *builder) valueDate(tx, grp source) {
+	b.field = "32A"
+	date := either(tx, grp, "IntrBkSttlmDt")
+	value, currency := tx.amount("IntrBkSttlmAmt")
+	if date == "" || value == "" {
+		b.report(Missing, tx.path+"/IntrBkSttlmDt", "", "no interbank settlement date or amount")
+		return
+	}
+	if s, ok := b.mtAmount(tx.path+"/IntrBkSttlmAmt", value, currency); ok {
+		b.add("32A", mtDate(date)+currency+s)
+	}
+}
+
+// instructedAmount adds field 33B for the instructed amount of tx.
+func (b *builder) instructedAmount(tx source) {
+	b.field = "33B"
+	value, currency := tx.amount("InstdAmt")
+	if value == "" {
+		return
+	}
+	if s, ok := b.mtAmount(tx.path+"/InstdAmt", value, currency); ok {
+		b.add("33B", currency+s)
+	}
+}
+
+// bankOperation adds field 23B, the proprietary service level or CRED.
+func (b *builder) bankOperation(tx, grp source) {
+	b.field = "23B"
+	if code := either(tx, grp, "PmtTpInf/SvcLvl/Prtry"); len(code) == 4 {
+		b.add("23B", code)
+	} else {
+		b.add("23B", "CRED")
+	}
+}
+
+// instructionCodes adds a field 23E for each coded instruction for the
+// creditor agent or the next agent of tx, and for the service level SDVA and
+// the category purposes INTC and CORT.
+func (b *builder) instructionCodes(tx, grp source) {
+	b.field = "23E"
+	for _, in := range tx.list("InstrForCdtrAgt") {
+		if code := in.text("Cd"); code != "" {
+			b.add("23E", code+b.information(in, 30))
+		}
+	}
+	for _, in := range tx.list("InstrForNxtAgt") {
+		code := in.text("Cd")
+		if code == "" {
+			continue
+		}
+		if mt, ok := nextAgentCodes[code]; ok {
+			b.add("23E", mt+b.information(in, 30))
+		} else {
+			b.report(Unmapped, in.path+"/Cd", code, "instruction code without equivalent")
+		}
+	}
+	for _, s := range []source{tx, grp} {
+		if s.text("PmtTpInf/SvcLvl/Cd") == "SDVA" {
+			b.add("23E", "SDVA")
+		}
+		if code := s.text("PmtTpInf/CtgyPurp/Cd"); code == "INTC" || code == "CORT" {
+			b.add("23E", code)
+		}
+	}
+}
+
+// information returns the instruction information of in for field 23E, after
+// a slash.
+func (b *builder) information(in source, n int) string {
+	info := in.text("InstrInf")
+	if info == "" {
+		return ""
+	}
+	return "/" + b.fit(in.path+"/InstrInf", info, n)
+}
+
+// charges adds field 71A for the charge bearer of tx, and fields 71F and 71G
+// for its charges information, as the network rules of the MT103 allow them:
+// with SHA, a field 71F for the charges of each agent; with BEN, at least one
+// field 71F, a zero amount when tx has no charges; with OUR, no field 71F but
+// a field 71G for the charges of the receiver, prepaid by the sender. The
+// charges without a field are reported as unmapped, and field 33B must be
+// present with fields 71F and 71G.
+func (b *builder) charges(tx source, receiver string) {
+	b.field = "71A"
+	bearer := tx.text("ChrgBr")
+	details, ok := chargeDetails[bearer]
+	if !ok {
+		details = "SHA"
+		b.report(Defaulted, tx.path+"/ChrgBr", bearer, "no details of charges, SHA")
+	}
+	b.add("71A", details)
+	charged, received := false, false
+	for _, c := range tx.list("ChrgsInf") {
+		value, currency := c.amount("Amt")
+		agent := bic11(c.text("Agt/FinInstnId/BICFI|Agt/FinInstnId/BIC"))
+		b.field = "71F"
+		if details == "OUR" {
+			b.field = "71G"
+		}
+		s, ok := b.mtAmount(c.path+"/Amt", value, currency)
+		switch {
+		case !ok:
+		case details != "OUR":
+			b.add("71F", currency+s)
+			charged = true
+		case agent != receiver:
+			b.report(Unmapped, c.path, currency+" "+value, "charges of an agent other than the receiver, borne by the debtor")
+		case received:
+			b.report(Unmapped, c.path, currency+" "+value, "second charges of the receiver")
+		default:
+			b.add("71G", currency+s)
+			charged, received = true, true
+		}
+	}
+	if details == "BEN" && !charged {
+		b.field = "71F"
+		_, currency := tx.amount("IntrBkSttlmAmt")
+		b.add("71F", currency+"0,")
+		b.report(Defaulted, tx.path+"/ChrgsInf", currency+"0,", "no charges of the sender, required with BEN")
+		charged = true
+	}
+	if charged && !b.has("33B") {
+		b.field = "33B"
+		b.report(Missing, tx.path+"/InstdAmt", "", "no instructed amount, required with fields 71F and 71G")
+	}
+}
+
+// regulatoryReporting adds field 77B for the regulatory reporting of tx: the
+// country of residence of the ordering or beneficiary customer, with the
+// codes ORDERRES or BENEFRES, and the information.
+func (b *builder) regulatoryReporting(tx source) {
+	b.field = "77B"
+	l := lines{b: b, width: 35, max: 3}
+	for _, r := range tx.list("RgltryRptg") {
+		code := ""
+		switch r.text("DbtCdtRptgInd") {
+		case "DEBT":
+			code = "ORDERRES"
+		case "CRED":
+			code = "BENEFRES"
+		}
+		for _, d := range r.list("Dtls") {
+			country := d.text("Ctry")
+			first := ""
+			if code != "" && country != "" {
+				first = "/" + code + "/" + country + "//"
+			}
+			info := d.texts("Inf")
+			l.add(d.path+"/Inf", strings.Join(info, " "), first, "//")
+		}
+	}
+	b.add("77B", l.list...)
+}
@@ -0,0 +1,56 @@
+package mt
+
+// mt202 builds the MT202 of the credit transfer transaction tx of a pacs.009
+// with the group header grp, or the MT202 COV when it has an underlying
+// customer credit transfer, in sequence B.
+func (b *builder) mt202(grp, tx source) *Message {
+	sender := bic11(either(tx, grp, "InstgAgt/FinInstnId/BICFI|InstgAgt/FinInstnId/BIC"))
+	receiver := bic11(either(tx, grp, "InstdAgt/FinInstnId/BICFI|InstdAgt/FinInstnId/BIC"))
+	b.start("202", tx, sender, receiver)
+	u := tx.elem("UndrlygCstmrCdtTrf")
+	if u.present() {
+		b.msg.User = append(b.msg.User, Field{Tag: "119", Value: "COV"})
+	}
+	b.uetr(tx)
+
+	b.senderReference(tx)
+	b.field = "21"
+	related := tx.text("PmtId/EndToEndId")
+	if related == "NOTPROVIDED" {
+		related = ""
+	}
+	b.add("21", b.reference(tx.path+"/PmtId/EndToEndId", related))
+	b.settlementTime(tx)
+	b.valueDate(tx, grp)
+	if bic11(tx.text("Dbtr/FinInstnId/BICFI|Dbtr/FinInstnId/BIC")) != sender || tx.elem("DbtrAcct").present() {
+		b.agent(tx, "52", "Dbtr", "DbtrAcct")
+	}
+	b.settlement(grp)
+	b.agent(tx, "56", "IntrmyAgt1", "IntrmyAgt1Acct")
+	b.agent(tx, "57", "CdtrAgt", "CdtrAgtAcct")
+	if !b.agent(tx, "58", "Cdtr", "CdtrAcct") {
+		b.report(Missing, tx.path+"/Cdtr", "", "no beneficiary institution")
+	}
+	b.senderToReceiver(tx)
+	if u.present() {
+		b.cover(u)
+	}
+	return b.msg
+}
+
+// cover adds sequence B of an MT202 COV for the underlying customer credit
+// transfer u.
+func (b *builder) cover(u source) {
+	if !b.party(u, "50", "Dbtr", "DbtrAcct") {
+		b.report(Missing, u.path+"/Dbtr", "", "no ordering customer")
+	}
+	b.agent(u, "52", "DbtrAgt", "DbtrAgtAcct")
+	b.agent(u, "56", "IntrmyAgt1", "IntrmyAgt1Acct")
+	b.agent(u, "57", "CdtrAgt", "CdtrAgtAcct")
+	if !b.party(u, "59", "Cdtr", "CdtrAcct") {
+		b.report(Missing, u.path+"/Cdtr", "", "no beneficiary customer")
+	}
+	b.remittance(u, "")
+	b.senderToReceiver(u)
+	b.instructedAmount(u)
+}
@@ -0,0 +1,153 @@
+package mt
+
+import (
+	"strings"
+)
+
+// Party is the content of a party or institution field, such as 50K, 57A or
+// 59F, split in the components of its option.
+type Party struct {
+
+	// Account is the account of the party identifier, without the /C/ or /D/
+	// mark of an account to credit or to debit.
+	Account string
+
+	// ClearingCode and ClearingID are the clearing system and the member
+	// identification of a party identifier such as //FW021000018.
+	ClearingCode string
+	ClearingID   string
+
+	// Identifier is the party identifier of option F that is not an account,
+	// such as NIDN/DE/121231234342: the code, the country and the identifier.
+	Identifier string
+
+	// BIC is the identifier code of option A.
+	BIC string
+
+	// Location is the location of option B.
+	Location string
+
+	// Name and Address are the name and address lines of options D and K, of
+	// the no letter option, and of the numbered lines 1 and 2 of option F.
+	Name    []string
+	Address []string
+
+	// Country and Town are the country code and the town of line 3 of option F.
+	Country string
+	Town    string
+
+	// BirthDate, BirthCountry and BirthCity are the date of birth, as
+	// YYYYMMDD, and the place of birth of lines 4 and 5 of option F.
+	BirthDate    string
+	BirthCountry string
+	BirthCity    string
+
+	// CustomerID is the customer identification number of line 6 of option F,
+	// the country, the issuer and the number, and NationalID the national
+	// identity number of line 7, the country and the number.
+	CustomerID string
+	NationalID string
+
+	// Other holds the lines that do not follow the format of the option.
+	Other []string
+}
+
+// Party returns the components of a party or institution field.
+func (f Field) Party() Party {
+	var p Party
+	lines := f.Lines()
+	option := f.Option()
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "/") && !(option == "F" && numbered(lines[0])) {
+		p.identifier(lines[0])
+		lines = lines[1:]
+	} else if option == "F" && len(lines) > 0 && !numbered(lines[0]) {
+		p.Identifier = lines[0]
+		lines = lines[1:]
+	}
+	switch option {
+	case "A":
+		if len(lines) > 0 {
+			p.BIC = lines[0]
+			p.Other = append(p.Other, lines[1:]...)
+		}
+	case "B":
+		p.Location = strings.Join(lines, " ")
+	case "C":
+		p.Other = append(p.Other, lines...)
+	case "F":
+		p.numbered(lines)
+	default:
+		if len(lines) > 0 {
+			p.Name = lines[:1]
+			p.Address = append(p.Address, lines[1:]...)
+		}
+	}
+	return p
+}
+
+// identifier reads a party identifier line.
+func (p *Party) identifier(line string) {
+	switch {
+	case strings.HasPrefix(line, "//") && len(line) > 4:
+		p.ClearingCode, p.ClearingID = line[2:4], line[4:]
+	case strings.HasPrefix(line, "/C/") || strings.HasPrefix(line, "/D/"):
+		p.Account = line[3:]
+	default:
+		p.Account = line[1:]
+	}
+}
+
+// numbered reports whether line is a numbered line of option F, such as
+// 1/JOHN DOE.
+func numbered(line string) bool {
+	return len(line) >= 2 && line[0] >= '1' && line[0] <= '8' && line[1] == '/'
+}
+
+// numbered reads the numbered lines of option F. Line 8 continues the
+// identification of line 6 or 7 before it.
+func (p *Party) numbered(lines []string) {
+	last := ""
+	for _, line := range lines {
+		if !numbered(line) {
+			p.Other = append(p.Other, line)
+			continue
+		}
+		value := line[2:]
+		switch line[0] {
+		case '1':
+			p.Name = append(p.Name, value)
+		case '2':
+			p.Address = append(p.Address, value)
+		case '3':
+			p.Country, p.Town = cut(value)
+		case '4':
+			p.BirthDate = value
+		case '5':
+			p.BirthCountry, p.BirthCity = cut(value)
+		case '6':
+			p.CustomerID = value
+		case '7':
+			p.NationalID = value
+		case '8':
+			switch last {
+			case "6":
+				p.CustomerID += value
+			case "7":
+				p.NationalID += value
+			default:
+				p.Other = append(p.Other, line)
+			}
+		}
+		if line[0] != '8' {
+			last = line[:1]
+		}
+	}
+}
+
+// cut splits a country code and the text after it, as in BE/BRUSSELS.
+func cut(s string) (string, string) {
+	if i := strings.IndexByte(s, '/'); i >= 0 {
+		return s[:i], s[i+1:]
+	}
+	return s, ""
+}
@@ -0,0 +1,243 @@
+package mt
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// source is an element of the Document being translated to MT, the struct of
+// its type, with its path in the Document.
+//
+// As for element, the elements under it are given by the path of their XML
+// tags, so that the versions of a message definition are read alike; a path
+// may list alternatives separated by |, such as FinInstnId/BICFI|FinInstnId/BIC
+// for the versions before and after the BIC was renamed. The first occurrence
+// of a repeated element is read. The leaves that are read are marked as used,
+// so that the ones left are reported as unmapped.
+type source struct {
+	b    *builder
+	v    reflect.Value
+	path string
+}
+
+// find returns the value of the element at path under s, the pointer or the
+// slice of its field, with its path.
+func (s source) find(path string) (reflect.Value, string) {
+	if !s.v.IsValid() {
+		return reflect.Value{}, ""
+	}
+	v, p := s.v, s.path
+	names := strings.Split(path, "/")
+	for i, name := range names {
+		f, ok := fieldByTag(v.Type(), name)
+		if !ok {
+			return reflect.Value{}, ""
+		}
+		fv := v.FieldByIndex(f.Index)
+		p += "/" + name
+		if i == len(names)-1 {
+			return fv, p
+		}
+		if fv.Kind() == reflect.Slice {
+			if fv.Len() == 0 {
+				return reflect.Value{}, ""
+			}
+			fv = fv.Index(0)
+			p += "[1]"
+		}
+		if fv.IsNil() {
+			return reflect.Value{}, ""
+		}
+		v = fv.Elem()
+	}
+	panic("unreachable")
+}
+
+// text returns the text of the leaf at path under s, and marks it as used.
+func (s source) text(path string) string {
+	for _, alt := range strings.Split(path, "|") {
+		fv, p := s.find(alt)
+		if fv.Kind() == reflect.Slice {
+			if fv.Len() == 0 {
+				continue
+			}
+			fv = fv.Index(0)
+			p += "[1]"
+		}
+		if fv.Kind() == reflect.Ptr && !fv.IsNil() && fv.Elem().Kind() == reflect.String {
+			s.b.used[p] = true
+			return fv.Elem().String()
+		}
+	}
+	return ""
+}
+
+// texts returns the texts of the repeated leaf at path under s, and marks them
+// as used.
+func (s source) texts(path string) []string {
+	var list []string
+	fv, p := s.find(path)
+	if fv.Kind() != reflect.Slice {
+		return nil
+	}
+	for i := 0; i < fv.Len(); i++ {
+		if elem := fv.Index(i); !elem.IsNil() {
+			s.b.used[p+"["+strconv.Itoa(i+1)+"]"] = true
+			list = append(list, elem.Elem().String())
+		}
+	}
+	return list
+}
+
+// amount returns the value and the currency of the amount at path under s,
+// and marks it as used.
+func (s source) amount(path string) (string, string) {
+	fv, p := s.find(path)
+	if fv.Kind() != reflect.Ptr || fv.IsNil() {
+		return "", ""
+	}
+	s.b.used[p] = true
+	v := fv.Elem()
+	currency := ""
+	if c := v.FieldByName("Currency"); c.IsValid() {
+		currency = c.String()
+	}
+	return v.FieldByName("Value").String(), currency
+}
+
+// elem returns the element at path under s, which is absent when the Document
+// does not have it.
+func (s source) elem(path string) source {
+	fv, p := s.find(path)
+	if fv.Kind() == reflect.Slice {
+		if fv.Len() == 0 {
+			return source{b: s.b}
+		}
+		fv = fv.Index(0)
+		p += "[1]"
+	}
+	if fv.Kind() != reflect.Ptr || fv.IsNil() {
+		return source{b: s.b}
+	}
+	return source{b: s.b, v: fv.Elem(), path: p}
+}
+
+// list returns the occurrences of the repeated element at path under s.
+func (s source) list(path string) []source {
+	fv, p := s.find(path)
+	if fv.Kind() != reflect.Slice {
+		return nil
+	}
+	var list []source
+	for i := 0; i < fv.Len(); i++ {
+		if elem := fv.Index(i); !elem.IsNil() {
+			list = append(list, source{b: s.b, v: elem.Elem(), path: p + "[" + strconv.Itoa(i+1) + "]"})
+		}
+	}
+	return list
+}
+
+// present reports whether the Document has s.
+func (s source) present() bool {
+	return s.v.IsValid()
+}
+
+// skip marks the leaves at path under s as used, for the elements that have
+// no equivalent but whose loss is not reported.
+func (s source) skip(path string) {
+	mark := func(p, value string) {
+		s.b.used[p] = true
+	}
+	fv, p := s.find(path)
+	switch fv.Kind() {
+	case reflect.Ptr:
+		if !fv.IsNil() {
+			leaves(fv.Elem(), p, mark)
+		}
+	case reflect.Slice:
+		for i := 0; i < fv.Len(); i++ {
+			if elem := fv.Index(i); !elem.IsNil() {
+				leaves(elem.Elem(), p+"["+strconv.Itoa(i+1)+"]", mark)
+			}
+		}
+	}
+}
+
+// unmapped reports the leaves under s that were not used.
+func (s source) unmapped() {
+	if !s.present() {
+		return
+	}
+	leaves(s.v, s.path, func(p, value string) {
+		if !s.b.used[p] {
+			s.b.report(Unmapped, p, value, "no place in "+s.b.to)
+			s.b.used[p] = true
+		}
+	})
+}
+
+// leaves calls fn with the path and the value of each leaf under the struct v.
+// The value of an amount is its currency and its value.
+func leaves(v reflect.Value, path string, fn func(path, value string)) {
+	if value, ok := leafText(v); ok {
+		if value != "" {
+			fn(path, value)
+		}
+		return
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		name := f.Tag.Get("xml")
+		if j := strings.IndexByte(name, ','); j >= 0 {
+			name = name[:j]
+		}
+		if name == "" || f.Name == "XMLName" {
+			continue
+		}
+		fv := v.Field(i)
+		switch fv.Kind() {
+		case reflect.Ptr:
+			if !fv.IsNil() {
+				leaves(fv.Elem(), path+"/"+name, fn)
+			}
+		case reflect.Slice:
+			for j := 0; j < fv.Len(); j++ {
+				if elem := fv.Index(j); !elem.IsNil() {
+					leaves(elem.Elem(), path+"/"+name+"["+strconv.Itoa(j+1)+"]", fn)
+				}
+			}
+		}
+	}
+}
+
+// leafText returns the text of a simple type, or the currency and the value of
+// an amount, or the content of an element kept as raw XML, and whether v is a
+// leaf.
+func leafText(v reflect.Value) (string, bool) {
+	if v.Kind() == reflect.String {
+		return v.String(), true
+	}
+	if v.Kind() != reflect.Struct {
+		return "", false
+	}
+	var text string
+	var attrs []string
+	for i := 0; i < v.NumField(); i++ {
+		tag := v.Type().Field(i).Tag.Get("xml")
+		switch {
+		case strings.HasSuffix(tag, ",chardata"), strings.HasSuffix(tag, ",innerxml"):
+			text = v.Field(i).String()
+		case strings.HasSuffix(tag, ",attr"):
+			if s := v.Field(i).String(); s != "" {
+				attrs = append(attrs, s)
+			}
+		default:
+			return "", false
+		}
+	}
+	return strings.TrimSpace(strings.Join(append(attrs, text), " ")), true
+}
@@ -0,0 +1,545 @@
+package mt
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yudaprama/iso20022/message"
+	"github.com/yudaprama/iso20022/validation"
+)
+
+// Kind is the kind of a change made by a translation.
+type Kind int
+
+const (
+	// Truncated is a value cut to the maximum length of its element.
+	Truncated Kind = iota
+
+	// Unmapped is a field, or a part of a field, that the message definition
+	// has no place for, or whose value is not valid in it.
+	Unmapped
+
+	// Defaulted is a mandatory element that the MT message does not give,
+	// set to a default value or to a value the translation rules derive.
+	Defaulted
+
+	// Converted is a value whose characters out of the SWIFT X character set
+	// were replaced, or that was changed to follow the format of its field.
+	Converted
+
+	// Missing is a mandatory field or header of an MT message that the
+	// Document does not give.
+	Missing
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Truncated:
+		return "truncated"
+	case Unmapped:
+		return "unmapped"
+	case Defaulted:
+		return "defaulted"
+	case Converted:
+		return "converted"
+	case Missing:
+		return "missing"
+	}
+	return "Kind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Change is a change made by a translation. The path is the path of the
+// element in the MX message, for example
+// /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/Dbtr/Nm: the translated element,
+// or empty when the message definition has no place for the value, or, for a
+// translation to MT, the element the value comes from.
+type Change struct {
+	Kind Kind
+
+	// Field is the tag of the MT field, or of the user header field, that the
+	// value comes from or goes to, with a lowercase a for an option that is
+	// not known, empty for a defaulted element or a header.
+	Field string
+
+	Path string
+
+	// Value is the value that was truncated, unmapped or converted, before the
+	// change, or the default value.
+	Value string
+
+	Reason string
+}
+
+func (c Change) String() string {
+	s := c.Kind.String()
+	if c.Field != "" {
+		s += " " + c.Field
+	}
+	if c.Path != "" {
+		s += " " + c.Path
+	}
+	if c.Value != "" {
+		s += " (" + c.Value + ")"
+	}
+	if c.Reason != "" {
+		s += ": " + c.Reason
+	}
+	return s
+}
+
+// Report lists the changes made by the translation of an MT message to a
+// message definition, or of an MX message to MT messages.
+type Report struct {
+	From    string
+	To      string
+	Changes []Change
+}
+
+// Lossless reports whether the translation carried the whole message without
+// defaulting any element.
+func (r *Report) Lossless() bool {
+	return len(r.Changes) == 0
+}
+
+// Filter returns the changes of the given kind.
+func (r *Report) Filter(kind Kind) []Change {
+	var list []Change
+	for _, c := range r.Changes {
+		if c.Kind == kind {
+			list = append(list, c)
+		}
+	}
+	return list
+}
+
+func (r *Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s to %s", r.From, r.To)
+	for _, c := range r.Changes {
+		b.WriteString("\n")
+		b.WriteString(c.String())
+	}
+	return b.String()
+}
+
+// Translate translates an MT103 to a pacs.008.001.08, and an MT202 or MT202
+// COV to a pacs.009.001.08.
+func Translate(m *Message) (message.Message, *Report, error) {
+	switch m.Application.Type {
+	case "103":
+		return TranslateMT103(m)
+	case "202":
+		return TranslateMT202(m)
+	}
+	return nil, nil, fmt.Errorf("mt: no translation of MT%s", m.Type())
+}
+
+// translator translates the fields of a message to a Document and collects
+// the changes.
+type translator struct {
+	m       *Message
+	from    string
+	to      string
+	changes []Change
+
+	// field is the tag of the field being translated.
+	field string
+
+	// date is the value date of field 32A, as YYYY-MM-DD.
+	date string
+}
+
+func newTranslator(m *Message, doc message.Message) (*translator, element) {
+	t := &translator{m: m, from: "MT" + m.Type(), to: doc.MessageDefinitionIdentifier()}
+	return t, element{t: t, v: reflect.ValueOf(doc), path: "/Document"}
+}
+
+func (t *translator) report(kind Kind, path, value, reason string) {
+	t.changes = append(t.changes, Change{Kind: kind, Field: t.field, Path: path, Value: value, Reason: reason})
+}
+
+// done returns the report of the translation.
+func (t *translator) done() *Report {
+	return &Report{From: t.from, To: t.to, Changes: t.changes}
+}
+
+// unmapped reports a field, or a part of it, that has no translation.
+func (t *translator) unmapped(value, reason string) {
+	t.report(Unmapped, "", value, reason)
+}
+
+// defaulted reports the default value of a mandatory element.
+func (t *translator) defaulted(e element, path, value, reason string) {
+	field := t.field
+	t.field = ""
+	_, _, p, _ := e.resolve(path, false)
+	if e.set(path, value) {
+		t.report(Defaulted, p, value, reason)
+	}
+	t.field = field
+}
+
+var (
+	dateAmountFormat = regexp.MustCompile(`^([0-9]{6})([A-Z]{3})([0-9]{1,14},[0-9]*)$`)
+	amountFormat     = regexp.MustCompile(`^([A-Z]{3})([0-9]{1,14},[0-9]*)$`)
+	timeFormat       = regexp.MustCompile(`^/([A-Z]{7})/([0-9]{2})([0-9]{2})([+-][0-9]{2})([0-9]{2})$`)
+)
+
+// header translates the fields common to the transactions of the group
+// header grp and of the transaction tx: the reference of field 20, the value
+// date and the amount of field 32A, the instructing and instructed agents,
+// the creation date and time, the settlement information and the user header.
+func (t *translator) header(grp, tx element) error {
+	ref, ok := t.m.Field("20")
+	if !ok {
+		return fmt.Errorf("mt: MT%s without field 20", t.m.Type())
+	}
+	f, ok := t.m.Field("32A")
+	if !ok {
+		return fmt.Errorf("mt: MT%s without field 32A", t.m.Type())
+	}
+	match := dateAmountFormat.FindStringSubmatch(f.Value)
+	if match == nil {
+		return fmt.Errorf("mt: invalid field 32A %q", f.Value)
+	}
+	t.date = date(match[1])
+
+	t.field = "20"
+	grp.set("MsgId", ref.Value)
+	tx.set("PmtId/InstrId", ref.Value)
+	tx.set("PmtId/TxId", ref.Value)
+	t.field = "32A"
+	tx.set("IntrBkSttlmDt", t.date)
+	tx.amount("IntrBkSttlmAmt", mxDecimal(match[3]), match[2])
+	t.field = ""
+	tx.set("InstgAgt/FinInstnId/BICFI", t.m.Sender())
+	tx.set("InstdAgt/FinInstnId/BICFI", t.m.Receiver())
+	grp.set("NbOfTxs", "1")
+	if h := t.m.Application; !h.Input && len(h.OutputDate) == 6 && len(h.OutputTime) == 4 {
+		grp.set("CreDtTm", date(h.OutputDate)+"T"+h.OutputTime[:2]+":"+h.OutputTime[2:]+":00")
+	} else {
+		t.defaulted(grp, "CreDtTm", time.Now().UTC().Format("2006-01-02T15:04:05Z"), "time of the translation")
+	}
+	t.settlement(grp)
+
+	for _, f := range t.m.User {
+		t.field = f.Tag
+		switch f.Tag {
+		case "119":
+		case "121":
+			tx.set("PmtId/UETR", f.Value)
+		default:
+			t.unmapped(f.Value, "user header field without equivalent")
+		}
+	}
+	return nil
+}
+
+// settlement translates the sender's, receiver's and third reimbursement
+// institutions of fields 53a, 54a and 55a to the settlement information of
+// grp: the reimbursement agents with the cover method, or the account of the
+// sender's correspondent with the instructed agent method.
+func (t *translator) settlement(grp element) {
+	f53, ok53 := t.m.Field("53a")
+	f54, ok54 := t.m.Field("54a")
+	if !ok53 && !ok54 {
+		t.defaulted(grp, "SttlmInf/SttlmMtd", "INDA", "no field 53a or 54a")
+		return
+	}
+	switch {
+	case ok53 && !ok54 && f53.Option() == "B":
+		t.field = f53.Tag
+		p := f53.Party()
+		grp.set("SttlmInf/SttlmMtd", "INDA")
+		t.account(grp, "SttlmInf/SttlmAcct", p.Account)
+		if p.Location != "" {
+			t.unmapped(p.Location, "location of the sender's correspondent")
+		}
+	case ok53:
+		t.field = f53.Tag
+		grp.set("SttlmInf/SttlmMtd", "COVE")
+		t.agent(grp, "SttlmInf/InstgRmbrsmntAgt", "SttlmInf/InstgRmbrsmntAgtAcct", f53)
+	default:
+		grp.set("SttlmInf/SttlmMtd", "COVE")
+	}
+	if ok54 {
+		t.field = f54.Tag
+		t.agent(grp, "SttlmInf/InstdRmbrsmntAgt", "SttlmInf/InstdRmbrsmntAgtAcct", f54)
+	}
+	if f, ok := t.m.Field("55a"); ok {
+		t.field = f.Tag
+		t.agent(grp, "SttlmInf/ThrdRmbrsmntAgt", "SttlmInf/ThrdRmbrsmntAgtAcct", f)
+	}
+}
+
+// clearingSystems maps the codes of the clearing systems of a party
+// identifier to the ISO 20022 external clearing system identification codes.
+var clearingSystems = map[string]string{
+	"AT": "ATBLZ",
+	"AU": "AUBSB",
+	"BL": "DEBLZ",
+	"CC": "CACPA",
+	"CN": "CNAPS",
+	"CP": "USPID",
+	"ES": "ESNCC",
+	"FW": "USABA",
+	"GR": "GRBIC",
+	"HK": "HKNCC",
+	"IE": "IENCC",
+	"IN": "INFSC",
+	"IT": "ITNCC",
+	"NZ": "NZNCC",
+	"PL": "PLKNR",
+	"PT": "PTNCC",
+	"RU": "RUCBC",
+	"SC": "GBDSC",
+	"SW": "CHBCC",
+	"ZA": "ZANCC",
+}
+
+// agent translates an institution field to the agent at path under e and to
+// its account at account, if the message definition has one.
+func (t *translator) agent(e element, path, account string, f Field) {
+	p := f.Party()
+	e.set(path+"/FinInstnId/BICFI", p.BIC)
+	if p.ClearingCode != "" {
+		if code, ok := clearingSystems[p.ClearingCode]; ok {
+			e.set(path+"/FinInstnId/ClrSysMmbId/ClrSysId/Cd", code)
+		} else {
+			e.set(path+"/FinInstnId/ClrSysMmbId/ClrSysId/Prtry", p.ClearingCode)
+		}
+		e.set(path+"/FinInstnId/ClrSysMmbId/MmbId", p.ClearingID)
+	}
+	e.set(path+"/FinInstnId/Nm", strings.Join(p.Name, " "))
+	for _, line := range p.Address {
+		e.set(path+"/FinInstnId/PstlAdr/AdrLine+", line)
+	}
+	if p.Location != "" {
+		t.unmapped(p.Location, "location of the institution")
+	}
+	if p.Account != "" {
+		if account == "" {
+			t.unmapped(p.Account, "account of the institution")
+		} else {
+			t.account(e, account, p.Account)
+		}
+	}
+	for _, line := range p.Other {
+		t.unmapped(line, "line not in the format of option "+f.Option())
+	}
+}
+
+// party translates a party field to the party at path under e and to its
+// account at account.
+func (t *translator) party(e element, path, account string, f Field) {
+	p := f.Party()
+	t.account(e, account, p.Account)
+	if p.ClearingCode != "" {
+		t.unmapped("//"+p.ClearingCode+p.ClearingID, "clearing code of a party")
+	}
+	e.set(path+"/Id/OrgId/AnyBIC", p.BIC)
+	e.set(path+"/Nm", strings.Join(p.Name, " "))
+	for _, line := range p.Address {
+		e.set(path+"/PstlAdr/AdrLine+", line)
+	}
+	if p.Country != "" {
+		e.set(path+"/PstlAdr/Ctry", p.Country)
+		e.set(path+"/PstlAdr/TwnNm", p.Town)
+	}
+	if p.BirthDate != "" {
+		if len(p.BirthDate) == 8 {
+			e.set(path+"/Id/PrvtId/DtAndPlcOfBirth/BirthDt", p.BirthDate[:4]+"-"+p.BirthDate[4:6]+"-"+p.BirthDate[6:])
+		} else {
+			t.unmapped(p.BirthDate, "invalid date of birth")
+		}
+		e.set(path+"/Id/PrvtId/DtAndPlcOfBirth/CtryOfBirth", p.BirthCountry)
+		e.set(path+"/Id/PrvtId/DtAndPlcOfBirth/CityOfBirth", p.BirthCity)
+	}
+	if p.Identifier != "" {
+		parts := strings.SplitN(p.Identifier, "/", 3)
+		if len(parts) == 3 {
+			t.identification(e, path, parts[2], parts[0], parts[1])
+		} else {
+			t.unmapped(p.Identifier, "invalid party identifier")
+		}
+	}
+	if p.CustomerID != "" {
+		parts := strings.SplitN(p.CustomerID, "/", 3)
+		if len(parts) == 3 {
+			t.identification(e, path, parts[2], "CUST", parts[0]+"/"+parts[1])
+		} else {
+			t.unmapped(p.CustomerID, "invalid customer identification number")
+		}
+	}
+	if p.NationalID != "" {
+		country, id := cut(p.NationalID)
+		t.identification(e, path, id, "NIDN", country)
+	}
+	for _, line := range p.Other {
+		t.unmapped(line, "line not in the format of option "+f.Option())
+	}
+}
+
+// identification adds a private identification to the party at path under e.
+func (t *translator) identification(e element, path, id, scheme, issuer string) {
+	if !e.free(path + "/Id/PrvtId/Othr+") {
+		t.unmapped(id, "no place for another identification")
+		return
+	}
+	if o, ok := e.get(path + "/Id/PrvtId/Othr+"); ok {
+		o.set("Id", id)
+		o.set("SchmeNm/Cd", scheme)
+		o.set("Issr", issuer)
+	}
+}
+
+// account sets the account at path under e, as an IBAN or as another
+// identification.
+func (t *translator) account(e element, path, id string) {
+	if id == "" {
+		return
+	}
+	if validation.ValidateIBAN(id) == nil {
+		e.set(path+"/Id/IBAN", id)
+	} else {
+		e.set(path+"/Id/Othr/Id", id)
+	}
+}
+
+// instruction is a line of field 72, with its code, such as /ACC/, and its
+// text, with the continuation lines.
+type instruction struct {
+	code string
+	text string
+}
+
+func instructions(f Field) []instruction {
+	var list []instruction
+	for _, line := range f.Lines() {
+		if strings.HasPrefix(line, "//") && len(list) > 0 {
+			list[len(list)-1].text += line[2:]
+			continue
+		}
+		if strings.HasPrefix(line, "/") {
+			if i := strings.IndexByte(line[1:], '/'); i >= 0 {
+				list = append(list, instruction{code: line[1 : i+1], text: line[i+2:]})
+				continue
+			}
+		}
+		if len(list) > 0 {
+			list[len(list)-1].text += " " + line
+		} else {
+			list = append(list, instruction{text: line})
+		}
+	}
+	return list
+}
+
+// previous lists the previous instructing agents of the message definitions,
+// in their order.
+var previous = []string{"PrvsInstgAgt1", "PrvsInstgAgt2", "PrvsInstgAgt3", "PrvsInstgAgt"}
+
+// senderToReceiver translates the sender to receiver information of field 72:
+// the instructions for the creditor agent of code ACC, the previous
+// instructing agents of code INS, and the instructions for the next agent of
+// the other codes, which are kept in the text.
+func (t *translator) senderToReceiver(tx element, f Field) {
+	for _, in := range instructions(f) {
+		switch in.code {
+		case "ACC":
+			if g, ok := tx.get("InstrForCdtrAgt+"); ok {
+				g.set("InstrInf", in.text)
+			}
+		case "INS":
+			done := false
+			for _, path := range previous {
+				if tx.free(path) {
+					if validation.ValidateBIC(in.text) == nil {
+						tx.set(path+"/FinInstnId/BICFI", in.text)
+					} else {
+						tx.set(path+"/FinInstnId/Nm", in.text)
+					}
+					done = true
+					break
+				}
+			}
+			if !done {
+				t.unmapped(in.text, "no place for another previous instructing agent")
+			}
+		default:
+			text := in.text
+			if in.code != "" {
+				text = "/" + in.code + "/" + text
+			}
+			if g, ok := tx.get("InstrForNxtAgt+"); ok {
+				g.set("InstrInf", text)
+			}
+		}
+	}
+}
+
+// remittance translates the remittance information of field 70 to an
+// unstructured remittance information, its lines joined.
+func (t *translator) remittance(tx element, f Field) {
+	tx.set("RmtInf/Ustrd+", strings.Join(f.Lines(), ""))
+}
+
+// settlementTime translates the time indications of field 13C.
+func (t *translator) settlementTime(tx element, f Field) {
+	m := timeFormat.FindStringSubmatch(f.Value)
+	if m == nil {
+		t.unmapped(f.Value, "invalid time indication")
+		return
+	}
+	clock := m[2] + ":" + m[3] + ":00" + m[4] + ":" + m[5]
+	switch m[1] {
+	case "CLSTIME":
+		tx.set("SttlmTmReq/CLSTm", clock)
+	case "TILTIME":
+		tx.set("SttlmTmReq/TillTm", clock)
+	case "FROTIME":
+		tx.set("SttlmTmReq/FrTm", clock)
+	case "REJTIME":
+		tx.set("SttlmTmReq/RjctTm", clock)
+	case "SNDTIME":
+		tx.set("SttlmTmIndctn/DbtDtTm", t.date+"T"+clock)
+	case "RNCTIME":
+		tx.set("SttlmTmIndctn/CdtDtTm", t.date+"T"+clock)
+	default:
+		t.unmapped(f.Value, "unknown time indication code")
+	}
+}
+
+// instructedAmount translates the currency and instructed amount of field 33B.
+func (t *translator) instructedAmount(tx element, f Field) {
+	m := amountFormat.FindStringSubmatch(f.Value)
+	if m == nil {
+		t.unmapped(f.Value, "invalid amount")
+		return
+	}
+	tx.amount("InstdAmt", mxDecimal(m[2]), m[1])
+}
+
+// date returns the date YYYY-MM-DD of a date YYMMDD of a field.
+func date(s string) string {
+	century := "20"
+	if s[:2] >= "80" {
+		century = "19"
+	}
+	return century + s[:2] + "-" + s[2:4] + "-" + s[4:]
+}
+
+// mxDecimal returns the decimal of an amount or a rate of a field, written with
+// a decimal comma.
+func mxDecimal(s string) string {
+	s = strings.Replace(s, ",", ".", 1)
+	s = strings.TrimSuffix(s, ".")
+	if s == "" {
+		return "0"
+	}
+	return s
+}
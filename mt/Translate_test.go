@@ -0,0 +1,197 @@
+package mt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yudaprama/iso20022/pacs"
+)
+
+func parse(t *testing.T, s string) *Message {
+	t.Helper()
+	m, err := Parse([]byte(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestTranslateMT103(t *testing.T) {
+	doc, report, err := TranslateMT103(parse(t, mt103))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := doc.Validate(); err != nil {
+		t.Errorf("invalid pacs.008.001.08: %v", err)
+	}
+	grp := doc.Message.GroupHeader
+	tx := doc.Message.CreditTransferTransactionInformation[0]
+	for _, tt := range []struct {
+		name      string
+		got, want interface{}
+	}{
+		{"MsgId", string(*grp.MessageIdentification), "REF123"},
+		{"SttlmMtd", string(*grp.SettlementInformation.SettlementMethod), "COVE"},
+		{"InstgRmbrsmntAgt", string(*grp.SettlementInformation.InstructingReimbursementAgent.FinancialInstitutionIdentification.BICFI), "CITIUS33"},
+		{"EndToEndId", string(*tx.PaymentIdentification.EndToEndIdentification), "INV-2023-001"},
+		{"UETR", string(*tx.PaymentIdentification.UETR), "eb6305c9-1f7f-49de-aed0-16487c27b42d"},
+		{"IntrBkSttlmDt", string(*tx.InterbankSettlementDate), "2023-09-15"},
+		{"IntrBkSttlmAmt", tx.InterbankSettlementAmount.Value + " " + tx.InterbankSettlementAmount.Currency, "1234.56 EUR"},
+		{"InstdAmt", tx.InstructedAmount.Value, "1250.00"},
+		{"ChrgBr", string(*tx.ChargeBearer), "SHAR"},
+		{"ChrgsInf", tx.ChargesInformation[0].Amount.Value, "15.44"},
+		{"ChrgsInf/Agt", string(*tx.ChargesInformation[0].Agent.FinancialInstitutionIdentification.BICFI), "BANKBEBBXXX"},
+		{"InstgAgt", string(*tx.InstructingAgent.FinancialInstitutionIdentification.BICFI), "BANKBEBBXXX"},
+		{"InstdAgt", string(*tx.InstructedAgent.FinancialInstitutionIdentification.BICFI), "BANKDEFFXXX"},
+		{"PrvsInstgAgt1", string(*tx.PreviousInstructingAgent1.FinancialInstitutionIdentification.BICFI), "ABNANL2A"},
+		{"Dbtr/Nm", string(*tx.Debtor.Name), "JOHN DOE"},
+		{"Dbtr/PstlAdr/TwnNm", string(*tx.Debtor.PostalAddress.TownName), "BRUSSELS"},
+		{"DbtrAcct", string(*tx.DebtorAccount.Identification.IBAN), "BE71096123456769"},
+		{"DbtrAgt", string(*tx.DebtorAgent.FinancialInstitutionIdentification.BICFI), "BANKBEBB"},
+		{"CdtrAgt", string(*tx.CreditorAgent.FinancialInstitutionIdentification.BICFI), "BANKDEFF"},
+		{"Cdtr/Nm", string(*tx.Creditor.Name), "ERIKA MUSTERMANN"},
+		{"Cdtr/PstlAdr/AdrLine", len(tx.Creditor.PostalAddress.AddressLine), 2},
+		{"CdtrAcct", string(*tx.CreditorAccount.Identification.IBAN), "DE89370400440532013000"},
+		{"InstrForCdtrAgt", len(tx.InstructionForCreditorAgent), 2},
+		{"InstrForCdtrAgt/Cd", string(*tx.InstructionForCreditorAgent[0].Code), "PHOB"},
+		{"InstrForCdtrAgt/InstrInf", string(*tx.InstructionForCreditorAgent[1].InstructionInformation), "CALL BEFORE PAYMENT"},
+		{"RmtInf", string(*tx.RemittanceInformation.Unstructured[0]), "/ROC/INV-2023-001/"},
+		{"RgltryRptg", string(*tx.RegulatoryReporting[0].DebitCreditReportingIndicator), "DEBT"},
+		{"RgltryRptg/Dtls/Ctry", string(*tx.RegulatoryReporting[0].Details[0].Country), "BE"},
+		{"RgltryRptg/Dtls/Inf", string(*tx.RegulatoryReporting[0].Details[0].Information[0]), "MEILAAN 1, 9000 GENT GOODS EXPORT L"},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	if report.From != "MT103" || report.To != "pacs.008.001.08" {
+		t.Errorf("report from %s to %s", report.From, report.To)
+	}
+	want := []string{
+		"defaulted /Document/FIToFICstmrCdtTrf/GrpHdr/CreDtTm",
+		"unmapped 108 (MUR123): user header field without equivalent",
+		"truncated 77B /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/RgltryRptg[1]/Dtls[1]/Inf[1] (MEILAAN 1, 9000 GENT GOODS EXPORT LICENCE 123456): longer than 35 characters",
+	}
+	if len(report.Changes) != len(want) {
+		t.Fatalf("report:\n%s", report)
+	}
+	for i, c := range report.Changes {
+		if !strings.HasPrefix(c.String(), want[i]) {
+			t.Errorf("change %d is %s, want %s", i, c, want[i])
+		}
+	}
+}
+
+const mt202cov = `{1:F01BANKBEBBAXXX0000000000}{2:O2021200230915CITIUS33AXXX00000000002309151200N}{3:{119:COV}{121:eb6305c9-1f7f-49de-aed0-16487c27b42d}}{4:
+:20:COVREF1
+:21:REF123
+:32A:230915EUR1234,56
+:56A:DEUTDEFF
+:57A:BANKDEFF
+:58A:BANKDEFF
+:72:/BNF/INVOICE 1
+:50K:/BE71096123456769
+JOHN DOE
+:52A:BANKBEBB
+:59:/DE89370400440532013000
+ERIKA MUSTERMANN
+:70:/ROC/INV-2023-001/
+:33B:EUR1250,00
+-}`
+
+func TestTranslateMT202COV(t *testing.T) {
+	m := parse(t, mt202cov)
+	if m.Type() != "202COV" {
+		t.Errorf("type %s", m.Type())
+	}
+	msg, report, err := Translate(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, ok := msg.(*pacs.Document00900108)
+	if !ok {
+		t.Fatalf("%T, want *pacs.Document00900108", msg)
+	}
+	if err := doc.Validate(); err != nil {
+		t.Errorf("invalid pacs.009.001.08: %v", err)
+	}
+	grp := doc.Message.GroupHeader
+	tx := doc.Message.CreditTransferTransactionInformation[0]
+	cov := tx.UnderlyingCustomerCreditTransfer
+	for _, tt := range []struct {
+		name      string
+		got, want interface{}
+	}{
+		{"CreDtTm", string(*grp.CreationDateTime), "2023-09-15T12:00:00"},
+		{"SttlmMtd", string(*grp.SettlementInformation.SettlementMethod), "INDA"},
+		{"InstrId", string(*tx.PaymentIdentification.InstructionIdentification), "COVREF1"},
+		{"EndToEndId", string(*tx.PaymentIdentification.EndToEndIdentification), "REF123"},
+		{"UETR", string(*tx.PaymentIdentification.UETR), "eb6305c9-1f7f-49de-aed0-16487c27b42d"},
+		{"InstgAgt", string(*tx.InstructingAgent.FinancialInstitutionIdentification.BICFI), "CITIUS33XXX"},
+		{"Dbtr", string(*tx.Debtor.FinancialInstitutionIdentification.BICFI), "CITIUS33XXX"},
+		{"IntrmyAgt1", string(*tx.IntermediaryAgent1.FinancialInstitutionIdentification.BICFI), "DEUTDEFF"},
+		{"Cdtr", string(*tx.Creditor.FinancialInstitutionIdentification.BICFI), "BANKDEFF"},
+		{"InstrForNxtAgt", string(*tx.InstructionForNextAgent[0].InstructionInformation), "/BNF/INVOICE 1"},
+		{"UndrlygCstmrCdtTrf/Dbtr/Nm", string(*cov.Debtor.Name), "JOHN DOE"},
+		{"UndrlygCstmrCdtTrf/DbtrAcct", string(*cov.DebtorAccount.Identification.IBAN), "BE71096123456769"},
+		{"UndrlygCstmrCdtTrf/DbtrAgt", string(*cov.DebtorAgent.FinancialInstitutionIdentification.BICFI), "BANKBEBB"},
+		{"UndrlygCstmrCdtTrf/CdtrAgt", string(*cov.CreditorAgent.FinancialInstitutionIdentification.BICFI), "BANKDEFF"},
+		{"UndrlygCstmrCdtTrf/Cdtr/Nm", string(*cov.Creditor.Name), "ERIKA MUSTERMANN"},
+		{"UndrlygCstmrCdtTrf/RmtInf", string(*cov.RemittanceInformation.Unstructured[0]), "/ROC/INV-2023-001/"},
+		{"UndrlygCstmrCdtTrf/InstdAmt", cov.InstructedAmount.Value, "1250.00"},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	want := []string{
+		"defaulted /Document/FICdtTrf/GrpHdr/SttlmInf/SttlmMtd (INDA): no field 53a or 54a",
+		"defaulted /Document/FICdtTrf/CdtTrfTxInf[1]/Dbtr/FinInstnId/BICFI (CITIUS33XXX): no field 52a, the sender",
+		"defaulted /Document/FICdtTrf/CdtTrfTxInf[1]/UndrlygCstmrCdtTrf/CdtrAgt (BANKDEFF): no field 57a in sequence B, the beneficiary institution",
+	}
+	if len(report.Changes) != len(want) {
+		t.Fatalf("report:\n%s", report)
+	}
+	for i, c := range report.Changes {
+		if c.String() != want[i] {
+			t.Errorf("change %d is %s, want %s", i, c, want[i])
+		}
+	}
+	if len(report.Filter(Defaulted)) != 3 || report.Lossless() {
+		t.Errorf("report:\n%s", report)
+	}
+}
+
+func TestTranslateError(t *testing.T) {
+	for _, s := range []string{
+		strings.Replace(mt103, ":20:REF123\n", "", 1),
+		strings.Replace(mt103, ":32A:230915EUR1234,56", ":32A:230915EUR", 1),
+		strings.Replace(mt202cov, ":21:REF123\n", "", 1),
+		strings.Replace(mt103, "{2:I103", "{2:I199", 1),
+	} {
+		if _, _, err := Translate(parse(t, s)); err == nil {
+			t.Errorf("translation of\n%s\nsucceeded", s)
+		}
+	}
+}
+
+// TestTranslateMaxOccurs checks that an element is not repeated more often than
+// its message definition allows.
+func TestTranslateMaxOccurs(t *testing.T) {
+	tr, root := newTranslator(parse(t, mt103), new(pacs.Document00800108))
+	tx, _ := root.get("FIToFICstmrCdtTrf/CdtTrfTxInf")
+	for i := 0; i < 7; i++ {
+		if g, ok := tx.get("InstrForNxtAgt+"); ok {
+			g.set("InstrInf", "INSTRUCTION")
+		}
+	}
+	if n := len(root.v.Interface().(*pacs.Document00800108).Message.CreditTransferTransactionInformation[0].InstructionForNextAgent); n != 6 {
+		t.Errorf("%d instructions for the next agent, want 6", n)
+	}
+	want := "unmapped: pacs.008.001.08 has at most 6 InstrForNxtAgt"
+	if report := tr.done(); len(report.Changes) != 1 || report.Changes[0].String() != want {
+		t.Errorf("report:\n%s\nwant %s", report, want)
+	}
+}
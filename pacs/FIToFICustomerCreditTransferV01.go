@@ -16,6 +16,41 @@ func (d *Document00800101) AddMessage() *FIToFICustomerCreditTransferV01 {
 	return d.Message
 }
 
+func (d *Document00800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.01"
+}
+
+func (d *Document00800101) MessageDefinitionIdentifier() string {
+	return "pacs.008.001.01"
+}
+
+func (d *Document00800101) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00800101) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800101) Variant() string {
+	return "001"
+}
+
+func (d *Document00800101) Version() string {
+	return "01"
+}
+
+func (d *Document00800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionCustomerCreditTransfer message is sent by the debtor agent to the creditor agent, directly or through other agents and/or a payment clearing and settlement system. It is used to move funds from a debtor account to a creditor.
 // Usage
@@ -36,6 +71,10 @@ type FIToFICustomerCreditTransferV01 struct {
 	CreditTransferTransactionInformation []*model.CreditTransferTransactionInformation2 `xml:"CdtTrfTxInf"`
 }
 
+func (f *FIToFICustomerCreditTransferV01) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFICustomerCreditTransferV01) AddGroupHeader() *model.GroupHeader2 {
 	f.GroupHeader = new(model.GroupHeader2)
 	return f.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00800102) AddMessage() *FIToFICustomerCreditTransferV02 {
 	return d.Message
 }
 
+func (d *Document00800102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.02"
+}
+
+func (d *Document00800102) MessageDefinitionIdentifier() string {
+	return "pacs.008.001.02"
+}
+
+func (d *Document00800102) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00800102) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800102) Variant() string {
+	return "001"
+}
+
+func (d *Document00800102) Version() string {
+	return "02"
+}
+
+func (d *Document00800102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionCustomerCreditTransfer message is sent by the debtor agent to the creditor agent, directly or through other agents and/or a payment clearing and settlement system. It is used to move funds from a debtor account to a creditor.
 // Usage
@@ -35,6 +70,10 @@ type FIToFICustomerCreditTransferV02 struct {
 	CreditTransferTransactionInformation []*model.CreditTransferTransactionInformation11 `xml:"CdtTrfTxInf"`
 }
 
+func (f *FIToFICustomerCreditTransferV02) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFICustomerCreditTransferV02) AddGroupHeader() *model.GroupHeader33 {
 	f.GroupHeader = new(model.GroupHeader33)
 	return f.GroupHeader
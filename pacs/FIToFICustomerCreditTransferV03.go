@@ -16,6 +16,41 @@ func (d *Document00800103) AddMessage() *FIToFICustomerCreditTransferV03 {
 	return d.Message
 }
 
+func (d *Document00800103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.03"
+}
+
+func (d *Document00800103) MessageDefinitionIdentifier() string {
+	return "pacs.008.001.03"
+}
+
+func (d *Document00800103) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00800103) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800103) Variant() string {
+	return "001"
+}
+
+func (d *Document00800103) Version() string {
+	return "03"
+}
+
+func (d *Document00800103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionCustomerCreditTransfer message is sent by the debtor agent to the creditor agent, directly or through other agents and/or a payment clearing and settlement system. It is used to move funds from a debtor account to a creditor.
 // Usage
@@ -38,6 +73,10 @@ type FIToFICustomerCreditTransferV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFICustomerCreditTransferV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFICustomerCreditTransferV03) AddGroupHeader() *model.GroupHeader49 {
 	f.GroupHeader = new(model.GroupHeader49)
 	return f.GroupHeader
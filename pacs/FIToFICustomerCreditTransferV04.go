@@ -16,6 +16,41 @@ func (d *Document00800104) AddMessage() *FIToFICustomerCreditTransferV04 {
 	return d.Message
 }
 
+func (d *Document00800104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.04"
+}
+
+func (d *Document00800104) MessageDefinitionIdentifier() string {
+	return "pacs.008.001.04"
+}
+
+func (d *Document00800104) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00800104) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800104) Variant() string {
+	return "001"
+}
+
+func (d *Document00800104) Version() string {
+	return "04"
+}
+
+func (d *Document00800104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionCustomerCreditTransfer message is sent by the debtor agent to the creditor agent, directly or through other agents and/or a payment clearing and settlement system. It is used to move funds from a debtor account to a creditor.
 // Usage
@@ -38,6 +73,10 @@ type FIToFICustomerCreditTransferV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFICustomerCreditTransferV04) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFICustomerCreditTransferV04) AddGroupHeader() *model.GroupHeader49 {
 	f.GroupHeader = new(model.GroupHeader49)
 	return f.GroupHeader
@@ -3,6 +3,7 @@ package pacs
 import (
 	"encoding/xml"
 
+	"github.com/yudaprama/iso20022/fastxml"
 	"github.com/yudaprama/iso20022/model"
 )
 
@@ -16,6 +17,81 @@ func (d *Document00800106) AddMessage() *FIToFICustomerCreditTransferV06 {
 	return d.Message
 }
 
+func (d *Document00800106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.06"
+}
+
+func (d *Document00800106) MessageDefinitionIdentifier() string {
+	return "pacs.008.001.06"
+}
+
+func (d *Document00800106) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00800106) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800106) Variant() string {
+	return "001"
+}
+
+func (d *Document00800106) Version() string {
+	return "06"
+}
+
+func (d *Document00800106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800106) Validate() error {
+	return model.ValidateElement(d)
+}
+
+func (d *Document00800106) MarshalXMLFast() ([]byte, error) {
+	enc := fastxml.NewEncoder(make([]byte, 0, 4096))
+	enc.Root("urn:iso:std:iso:20022:tech:xsd:pacs.008.001.06", "Document")
+	if d.Message != nil {
+		d.Message.EncodeXMLFast(enc, "FIToFICstmrCdtTrf")
+	}
+	enc.End("Document")
+	return enc.Bytes(), nil
+}
+
+func (d *Document00800106) UnmarshalXMLFast(data []byte) error {
+	dec := fastxml.NewDecoder(data)
+	name, err := dec.Root("urn:iso:std:iso:20022:tech:xsd:pacs.008.001.06", "Document")
+	if err != nil {
+		return err
+	}
+	d.XMLName = name
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FIToFICstmrCdtTrf":
+			elem := new(FIToFICustomerCreditTransferV06)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Message = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionCustomerCreditTransfer message is sent by the debtor agent to the creditor agent, directly or through other agents and/or a payment clearing and settlement system. It is used to move funds from a debtor account to a creditor.
 // Usage
@@ -38,6 +114,10 @@ type FIToFICustomerCreditTransferV06 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFICustomerCreditTransferV06) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFICustomerCreditTransferV06) AddGroupHeader() *model.GroupHeader70 {
 	f.GroupHeader = new(model.GroupHeader70)
 	return f.GroupHeader
@@ -195,6 +275,60 @@ func (f *FIToFICustomerCreditTransferV06) AddSupplementaryData() *model.Suppleme
 	return newValue
 }
 
+func (f *FIToFICustomerCreditTransferV06) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if f.GroupHeader != nil {
+		f.GroupHeader.EncodeXMLFast(enc, "GrpHdr")
+	}
+	for _, elem := range f.CreditTransferTransactionInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "CdtTrfTxInf")
+		}
+	}
+	for _, elem := range f.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (f *FIToFICustomerCreditTransferV06) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "GrpHdr":
+			elem := new(model.GroupHeader70)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.GroupHeader = elem
+		case "CdtTrfTxInf":
+			elem := new(model.CreditTransferTransaction25)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.CreditTransferTransactionInformation = append(f.CreditTransferTransactionInformation, elem)
+		case "SplmtryData":
+			elem := new(model.SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.SupplementaryData = append(f.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -0,0 +1,190 @@
+package pacs
+
+import (
+	"encoding/xml"
+
+	"github.com/yudaprama/iso20022/fastxml"
+	"github.com/yudaprama/iso20022/model"
+)
+
+type Document00800108 struct {
+	XMLName xml.Name                         `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08 Document"`
+	Message *FIToFICustomerCreditTransferV08 `xml:"FIToFICstmrCdtTrf"`
+}
+
+func (d *Document00800108) AddMessage() *FIToFICustomerCreditTransferV08 {
+	d.Message = new(FIToFICustomerCreditTransferV08)
+	return d.Message
+}
+
+func (d *Document00800108) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"
+}
+
+func (d *Document00800108) MessageDefinitionIdentifier() string {
+	return "pacs.008.001.08"
+}
+
+func (d *Document00800108) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00800108) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800108) Variant() string {
+	return "001"
+}
+
+func (d *Document00800108) Version() string {
+	return "08"
+}
+
+func (d *Document00800108) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800108) Validate() error {
+	return model.ValidateElement(d)
+}
+
+func (d *Document00800108) MarshalXMLFast() ([]byte, error) {
+	enc := fastxml.NewEncoder(make([]byte, 0, 4096))
+	enc.Root("urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08", "Document")
+	if d.Message != nil {
+		d.Message.EncodeXMLFast(enc, "FIToFICstmrCdtTrf")
+	}
+	enc.End("Document")
+	return enc.Bytes(), nil
+}
+
+func (d *Document00800108) UnmarshalXMLFast(data []byte) error {
+	dec := fastxml.NewDecoder(data)
+	name, err := dec.Root("urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08", "Document")
+	if err != nil {
+		return err
+	}
+	d.XMLName = name
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FIToFICstmrCdtTrf":
+			elem := new(FIToFICustomerCreditTransferV08)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Message = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Scope
+// The FinancialInstitutionToFinancialInstitutionCustomerCreditTransfer message is sent by the debtor agent to the creditor agent, directly or through other agents and/or a payment clearing and settlement system. It is used to move funds from a debtor account to a creditor.
+// Usage
+// The FIToFICustomerCreditTransfer message is exchanged between agents and can contain one or more customer credit transfer instructions.
+// The FIToFICustomerCreditTransfer message does not allow for grouping: a CreditTransferTransactionInformation block must be present for each credit transfer transaction.
+// The FIToFICustomerCreditTransfer message can be used in different ways:
+// - If the instructing agent and the instructed agent wish to use their direct account relationship in the currency of the transfer then the message contains both the funds for the customer transfer(s) as well as the payment details;
+// - If the instructing agent and the instructed agent have no direct account relationship in the currency of the transfer, or do not wish to use their account relationship, then other (reimbursement) agents will be involved to cover for the customer transfer(s). The FIToFICustomerCreditTransfer contains only the payment details and the instructing agent must cover the customer transfer by sending a FinancialInstitutionCreditTransfer to a reimbursement agent. This payment method is called the Cover method;
+// - If more than two financial institutions are involved in the payment chain and if the FIToFICustomerCreditTransfer is sent from one financial institution to the next financial institution in the payment chain, then the payment method is called the Serial method.
+// The FIToFICustomerCreditTransfer message can be used in domestic and cross-border scenarios.
+type FIToFICustomerCreditTransferV08 struct {
+
+	// Set of characteristics shared by all individual transactions included in the message.
+	GroupHeader *model.GroupHeader93 `xml:"GrpHdr"`
+
+	// Set of elements providing information specific to the individual credit transfer(s).
+	CreditTransferTransactionInformation []*model.CreditTransferTransaction39 `xml:"CdtTrfTxInf"`
+
+	// Additional information that cannot be captured in the structured elements and/or any other specific block.
+	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
+}
+
+func (f *FIToFICustomerCreditTransferV08) Validate() error {
+	return model.ValidateElement(f)
+}
+
+func (f *FIToFICustomerCreditTransferV08) AddGroupHeader() *model.GroupHeader93 {
+	f.GroupHeader = new(model.GroupHeader93)
+	return f.GroupHeader
+}
+
+func (f *FIToFICustomerCreditTransferV08) AddCreditTransferTransactionInformation() *model.CreditTransferTransaction39 {
+	newValue := new(model.CreditTransferTransaction39)
+	f.CreditTransferTransactionInformation = append(f.CreditTransferTransactionInformation, newValue)
+	return newValue
+}
+
+func (f *FIToFICustomerCreditTransferV08) AddSupplementaryData() *model.SupplementaryData1 {
+	newValue := new(model.SupplementaryData1)
+	f.SupplementaryData = append(f.SupplementaryData, newValue)
+	return newValue
+}
+
+func (f *FIToFICustomerCreditTransferV08) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if f.GroupHeader != nil {
+		f.GroupHeader.EncodeXMLFast(enc, "GrpHdr")
+	}
+	for _, elem := range f.CreditTransferTransactionInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "CdtTrfTxInf")
+		}
+	}
+	for _, elem := range f.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (f *FIToFICustomerCreditTransferV08) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "GrpHdr":
+			elem := new(model.GroupHeader93)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.GroupHeader = elem
+		case "CdtTrfTxInf":
+			elem := new(model.CreditTransferTransaction39)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.CreditTransferTransactionInformation = append(f.CreditTransferTransactionInformation, elem)
+		case "SplmtryData":
+			elem := new(model.SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.SupplementaryData = append(f.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
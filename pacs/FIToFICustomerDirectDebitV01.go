@@ -16,6 +16,41 @@ func (d *Document00300101) AddMessage() *FIToFICustomerDirectDebitV01 {
 	return d.Message
 }
 
+func (d *Document00300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.003.001.01"
+}
+
+func (d *Document00300101) MessageDefinitionIdentifier() string {
+	return "pacs.003.001.01"
+}
+
+func (d *Document00300101) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00300101) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300101) Variant() string {
+	return "001"
+}
+
+func (d *Document00300101) Version() string {
+	return "01"
+}
+
+func (d *Document00300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionCustomerDirectDebit message is sent by the creditor agent to the debtor agent, directly or through other agents and/or a payment clearing and settlement system.
 // It is used to collect funds from a debtor account for a creditor.
@@ -34,6 +69,10 @@ type FIToFICustomerDirectDebitV01 struct {
 	DirectDebitTransactionInformation []*model.DirectDebitTransactionInformation2 `xml:"DrctDbtTxInf"`
 }
 
+func (f *FIToFICustomerDirectDebitV01) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFICustomerDirectDebitV01) AddGroupHeader() *model.GroupHeader3 {
 	f.GroupHeader = new(model.GroupHeader3)
 	return f.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00300102) AddMessage() *FIToFICustomerDirectDebitV02 {
 	return d.Message
 }
 
+func (d *Document00300102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.003.001.02"
+}
+
+func (d *Document00300102) MessageDefinitionIdentifier() string {
+	return "pacs.003.001.02"
+}
+
+func (d *Document00300102) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00300102) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300102) Variant() string {
+	return "001"
+}
+
+func (d *Document00300102) Version() string {
+	return "02"
+}
+
+func (d *Document00300102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionCustomerDirectDebit message is sent by the creditor agent to the debtor agent, directly or through other agents and/or a payment clearing and settlement system.
 // It is used to collect funds from a debtor account for a creditor.
@@ -33,6 +68,10 @@ type FIToFICustomerDirectDebitV02 struct {
 	DirectDebitTransactionInformation []*model.DirectDebitTransactionInformation10 `xml:"DrctDbtTxInf"`
 }
 
+func (f *FIToFICustomerDirectDebitV02) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFICustomerDirectDebitV02) AddGroupHeader() *model.GroupHeader34 {
 	f.GroupHeader = new(model.GroupHeader34)
 	return f.GroupHeader
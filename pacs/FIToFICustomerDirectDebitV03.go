@@ -16,6 +16,41 @@ func (d *Document00300103) AddMessage() *FIToFICustomerDirectDebitV03 {
 	return d.Message
 }
 
+func (d *Document00300103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.003.001.03"
+}
+
+func (d *Document00300103) MessageDefinitionIdentifier() string {
+	return "pacs.003.001.03"
+}
+
+func (d *Document00300103) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00300103) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300103) Variant() string {
+	return "001"
+}
+
+func (d *Document00300103) Version() string {
+	return "03"
+}
+
+func (d *Document00300103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionCustomerDirectDebit message is sent by the creditor agent to the debtor agent, directly or through other agents and/or a payment clearing and settlement system.
 // It is used to collect funds from a debtor account for a creditor.
@@ -36,6 +71,10 @@ type FIToFICustomerDirectDebitV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFICustomerDirectDebitV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFICustomerDirectDebitV03) AddGroupHeader() *model.GroupHeader50 {
 	f.GroupHeader = new(model.GroupHeader50)
 	return f.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00300104) AddMessage() *FIToFICustomerDirectDebitV04 {
 	return d.Message
 }
 
+func (d *Document00300104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.003.001.04"
+}
+
+func (d *Document00300104) MessageDefinitionIdentifier() string {
+	return "pacs.003.001.04"
+}
+
+func (d *Document00300104) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00300104) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300104) Variant() string {
+	return "001"
+}
+
+func (d *Document00300104) Version() string {
+	return "04"
+}
+
+func (d *Document00300104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionCustomerDirectDebit message is sent by the creditor agent to the debtor agent, directly or through other agents and/or a payment clearing and settlement system.
 // It is used to collect funds from a debtor account for a creditor.
@@ -36,6 +71,10 @@ type FIToFICustomerDirectDebitV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFICustomerDirectDebitV04) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFICustomerDirectDebitV04) AddGroupHeader() *model.GroupHeader50 {
 	f.GroupHeader = new(model.GroupHeader50)
 	return f.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00300107) AddMessage() *FIToFICustomerDirectDebitV07 {
 	return d.Message
 }
 
+func (d *Document00300107) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.003.001.07"
+}
+
+func (d *Document00300107) MessageDefinitionIdentifier() string {
+	return "pacs.003.001.07"
+}
+
+func (d *Document00300107) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00300107) MessageFunctionality() string {
+	return "003"
+}
+
+func (d *Document00300107) Variant() string {
+	return "001"
+}
+
+func (d *Document00300107) Version() string {
+	return "07"
+}
+
+func (d *Document00300107) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00300107) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionCustomerDirectDebit message is sent by the creditor agent to the debtor agent, directly or through other agents and/or a payment clearing and settlement system.
 // It is used to collect funds from a debtor account for a creditor.
@@ -36,6 +71,10 @@ type FIToFICustomerDirectDebitV07 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFICustomerDirectDebitV07) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFICustomerDirectDebitV07) AddGroupHeader() *model.GroupHeader50 {
 	f.GroupHeader = new(model.GroupHeader50)
 	return f.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00700101) AddMessage() *FIToFIPaymentReversalV01 {
 	return d.Message
 }
 
+func (d *Document00700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.007.001.01"
+}
+
+func (d *Document00700101) MessageDefinitionIdentifier() string {
+	return "pacs.007.001.01"
+}
+
+func (d *Document00700101) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00700101) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700101) Variant() string {
+	return "001"
+}
+
+func (d *Document00700101) Version() string {
+	return "01"
+}
+
+func (d *Document00700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionPaymentReversal message is sent by an agent to the next party in the payment chain. It is used to reverse a payment previously executed.
 // Usage
@@ -36,6 +71,10 @@ type FIToFIPaymentReversalV01 struct {
 	TransactionInformation []*model.PaymentTransactionInformation5 `xml:"TxInf,omitempty"`
 }
 
+func (f *FIToFIPaymentReversalV01) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFIPaymentReversalV01) AddGroupHeader() *model.GroupHeader9 {
 	f.GroupHeader = new(model.GroupHeader9)
 	return f.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00700102) AddMessage() *FIToFIPaymentReversalV02 {
 	return d.Message
 }
 
+func (d *Document00700102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.007.001.02"
+}
+
+func (d *Document00700102) MessageDefinitionIdentifier() string {
+	return "pacs.007.001.02"
+}
+
+func (d *Document00700102) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00700102) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700102) Variant() string {
+	return "001"
+}
+
+func (d *Document00700102) Version() string {
+	return "02"
+}
+
+func (d *Document00700102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionPaymentReversal message is sent by an agent to the next party in the payment chain. It is used to reverse a payment previously executed.
 // Usage
@@ -35,6 +70,10 @@ type FIToFIPaymentReversalV02 struct {
 	TransactionInformation []*model.PaymentTransactionInformation29 `xml:"TxInf,omitempty"`
 }
 
+func (f *FIToFIPaymentReversalV02) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFIPaymentReversalV02) AddGroupHeader() *model.GroupHeader41 {
 	f.GroupHeader = new(model.GroupHeader41)
 	return f.GroupHeader
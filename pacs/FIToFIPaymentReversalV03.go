@@ -16,6 +16,41 @@ func (d *Document00700103) AddMessage() *FIToFIPaymentReversalV03 {
 	return d.Message
 }
 
+func (d *Document00700103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.007.001.03"
+}
+
+func (d *Document00700103) MessageDefinitionIdentifier() string {
+	return "pacs.007.001.03"
+}
+
+func (d *Document00700103) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00700103) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700103) Variant() string {
+	return "001"
+}
+
+func (d *Document00700103) Version() string {
+	return "03"
+}
+
+func (d *Document00700103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionPaymentReversal message is sent by an agent to the next party in the payment chain. It is used to reverse a payment previously executed.
 // Usage
@@ -38,6 +73,10 @@ type FIToFIPaymentReversalV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFIPaymentReversalV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFIPaymentReversalV03) AddGroupHeader() *model.GroupHeader57 {
 	f.GroupHeader = new(model.GroupHeader57)
 	return f.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00700106) AddMessage() *FIToFIPaymentReversalV06 {
 	return d.Message
 }
 
+func (d *Document00700106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.007.001.06"
+}
+
+func (d *Document00700106) MessageDefinitionIdentifier() string {
+	return "pacs.007.001.06"
+}
+
+func (d *Document00700106) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00700106) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700106) Variant() string {
+	return "001"
+}
+
+func (d *Document00700106) Version() string {
+	return "06"
+}
+
+func (d *Document00700106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700106) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionPaymentReversal message is sent by an agent to the next party in the payment chain. It is used to reverse a payment previously executed.
 // Usage
@@ -38,6 +73,10 @@ type FIToFIPaymentReversalV06 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFIPaymentReversalV06) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFIPaymentReversalV06) AddGroupHeader() *model.GroupHeader71 {
 	f.GroupHeader = new(model.GroupHeader71)
 	return f.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00700107) AddMessage() *FIToFIPaymentReversalV07 {
 	return d.Message
 }
 
+func (d *Document00700107) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.007.001.07"
+}
+
+func (d *Document00700107) MessageDefinitionIdentifier() string {
+	return "pacs.007.001.07"
+}
+
+func (d *Document00700107) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00700107) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700107) Variant() string {
+	return "001"
+}
+
+func (d *Document00700107) Version() string {
+	return "07"
+}
+
+func (d *Document00700107) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700107) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionPaymentReversal message is sent by an agent to the next party in the payment chain. It is used to reverse a payment previously executed.
 // Usage
@@ -38,6 +73,10 @@ type FIToFIPaymentReversalV07 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFIPaymentReversalV07) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFIPaymentReversalV07) AddGroupHeader() *model.GroupHeader71 {
 	f.GroupHeader = new(model.GroupHeader71)
 	return f.GroupHeader
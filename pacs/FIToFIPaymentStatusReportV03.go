@@ -16,6 +16,41 @@ func (d *Document00200103) AddMessage() *FIToFIPaymentStatusReportV03 {
 	return d.Message
 }
 
+func (d *Document00200103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.002.001.03"
+}
+
+func (d *Document00200103) MessageDefinitionIdentifier() string {
+	return "pacs.002.001.03"
+}
+
+func (d *Document00200103) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00200103) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200103) Variant() string {
+	return "001"
+}
+
+func (d *Document00200103) Version() string {
+	return "03"
+}
+
+func (d *Document00200103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionPaymentStatusReport message is sent by an instructed agent to the previous party in the payment chain. It is used to inform this party about the positive or negative status of an instruction (either single or file). It is also used to report on a pending instruction.
 // Usage
@@ -35,6 +70,10 @@ type FIToFIPaymentStatusReportV03 struct {
 	TransactionInformationAndStatus []*model.PaymentTransactionInformation26 `xml:"TxInfAndSts,omitempty"`
 }
 
+func (f *FIToFIPaymentStatusReportV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFIPaymentStatusReportV03) AddGroupHeader() *model.GroupHeader37 {
 	f.GroupHeader = new(model.GroupHeader37)
 	return f.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00200104) AddMessage() *FIToFIPaymentStatusReportV04 {
 	return d.Message
 }
 
+func (d *Document00200104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.002.001.04"
+}
+
+func (d *Document00200104) MessageDefinitionIdentifier() string {
+	return "pacs.002.001.04"
+}
+
+func (d *Document00200104) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00200104) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200104) Variant() string {
+	return "001"
+}
+
+func (d *Document00200104) Version() string {
+	return "04"
+}
+
+func (d *Document00200104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionPaymentStatusReport message is sent by an instructed agent to the previous party in the payment chain. It is used to inform this party about the positive or negative status of an instruction (either single or file). It is also used to report on a pending instruction.
 // Usage
@@ -38,6 +73,10 @@ type FIToFIPaymentStatusReportV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFIPaymentStatusReportV04) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFIPaymentStatusReportV04) AddGroupHeader() *model.GroupHeader53 {
 	f.GroupHeader = new(model.GroupHeader53)
 	return f.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00200105) AddMessage() *FIToFIPaymentStatusReportV05 {
 	return d.Message
 }
 
+func (d *Document00200105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.002.001.05"
+}
+
+func (d *Document00200105) MessageDefinitionIdentifier() string {
+	return "pacs.002.001.05"
+}
+
+func (d *Document00200105) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00200105) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200105) Variant() string {
+	return "001"
+}
+
+func (d *Document00200105) Version() string {
+	return "05"
+}
+
+func (d *Document00200105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionPaymentStatusReport message is sent by an instructed agent to the previous party in the payment chain. It is used to inform this party about the positive or negative status of an instruction (either single or file). It is also used to report on a pending instruction.
 // Usage
@@ -38,6 +73,10 @@ type FIToFIPaymentStatusReportV05 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFIPaymentStatusReportV05) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFIPaymentStatusReportV05) AddGroupHeader() *model.GroupHeader53 {
 	f.GroupHeader = new(model.GroupHeader53)
 	return f.GroupHeader
@@ -3,6 +3,7 @@ package pacs
 import (
 	"encoding/xml"
 
+	"github.com/yudaprama/iso20022/fastxml"
 	"github.com/yudaprama/iso20022/model"
 )
 
@@ -16,6 +17,81 @@ func (d *Document00200108) AddMessage() *FIToFIPaymentStatusReportV08 {
 	return d.Message
 }
 
+func (d *Document00200108) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.002.001.08"
+}
+
+func (d *Document00200108) MessageDefinitionIdentifier() string {
+	return "pacs.002.001.08"
+}
+
+func (d *Document00200108) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00200108) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200108) Variant() string {
+	return "001"
+}
+
+func (d *Document00200108) Version() string {
+	return "08"
+}
+
+func (d *Document00200108) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200108) Validate() error {
+	return model.ValidateElement(d)
+}
+
+func (d *Document00200108) MarshalXMLFast() ([]byte, error) {
+	enc := fastxml.NewEncoder(make([]byte, 0, 4096))
+	enc.Root("urn:iso:std:iso:20022:tech:xsd:pacs.002.001.08", "Document")
+	if d.Message != nil {
+		d.Message.EncodeXMLFast(enc, "FIToFIPmtStsRpt")
+	}
+	enc.End("Document")
+	return enc.Bytes(), nil
+}
+
+func (d *Document00200108) UnmarshalXMLFast(data []byte) error {
+	dec := fastxml.NewDecoder(data)
+	name, err := dec.Root("urn:iso:std:iso:20022:tech:xsd:pacs.002.001.08", "Document")
+	if err != nil {
+		return err
+	}
+	d.XMLName = name
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FIToFIPmtStsRpt":
+			elem := new(FIToFIPaymentStatusReportV08)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Message = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // Scope
 // The FIToFIPaymentStatusReport message is sent by an instructed agent to the previous party in the payment chain. It is used to inform this party about the positive or negative status of an instruction (either single or file). It is also used to report on a pending instruction.
 // Usage
@@ -39,6 +115,10 @@ type FIToFIPaymentStatusReportV08 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFIPaymentStatusReportV08) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFIPaymentStatusReportV08) AddGroupHeader() *model.GroupHeader53 {
 	f.GroupHeader = new(model.GroupHeader53)
 	return f.GroupHeader
@@ -342,6 +422,71 @@ func (f *FIToFIPaymentStatusReportV08) AddSupplementaryData() *model.Supplementa
 	return newValue
 }
 
+func (f *FIToFIPaymentStatusReportV08) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if f.GroupHeader != nil {
+		f.GroupHeader.EncodeXMLFast(enc, "GrpHdr")
+	}
+	for _, elem := range f.OriginalGroupInformationAndStatus {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "OrgnlGrpInfAndSts")
+		}
+	}
+	for _, elem := range f.TransactionInformationAndStatus {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "TxInfAndSts")
+		}
+	}
+	for _, elem := range f.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (f *FIToFIPaymentStatusReportV08) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "GrpHdr":
+			elem := new(model.GroupHeader53)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.GroupHeader = elem
+		case "OrgnlGrpInfAndSts":
+			elem := new(model.OriginalGroupHeader7)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.OriginalGroupInformationAndStatus = append(f.OriginalGroupInformationAndStatus, elem)
+		case "TxInfAndSts":
+			elem := new(model.PaymentTransaction80)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.TransactionInformationAndStatus = append(f.TransactionInformationAndStatus, elem)
+		case "SplmtryData":
+			elem := new(model.SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.SupplementaryData = append(f.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
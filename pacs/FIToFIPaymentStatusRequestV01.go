@@ -16,6 +16,41 @@ func (d *Document02800101) AddMessage() *FIToFIPaymentStatusRequestV01 {
 	return d.Message
 }
 
+func (d *Document02800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.028.001.01"
+}
+
+func (d *Document02800101) MessageDefinitionIdentifier() string {
+	return "pacs.028.001.01"
+}
+
+func (d *Document02800101) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document02800101) MessageFunctionality() string {
+	return "028"
+}
+
+func (d *Document02800101) Variant() string {
+	return "001"
+}
+
+func (d *Document02800101) Version() string {
+	return "01"
+}
+
+func (d *Document02800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document02800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionToFinancialInstitutionPaymentStatusRequest message is sent by the debtor agent to the creditor agent, directly or through other agents and/or a payment clearing and settlement system.  It is used to request a FIToFIPaymentStatusReport message containing information on the status of a previously sent instruction.
 // Usage
@@ -40,6 +75,10 @@ type FIToFIPaymentStatusRequestV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FIToFIPaymentStatusRequestV01) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FIToFIPaymentStatusRequestV01) AddGroupHeader() *model.GroupHeader53 {
 	f.GroupHeader = new(model.GroupHeader53)
 	return f.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00900101) AddMessage() *FinancialInstitutionCreditTransferV01 {
 	return d.Message
 }
 
+func (d *Document00900101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.009.001.01"
+}
+
+func (d *Document00900101) MessageDefinitionIdentifier() string {
+	return "pacs.009.001.01"
+}
+
+func (d *Document00900101) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00900101) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900101) Variant() string {
+	return "001"
+}
+
+func (d *Document00900101) Version() string {
+	return "01"
+}
+
+func (d *Document00900101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionCreditTransfer message is sent by a debtor financial institution to a creditor financial institution, directly or through other agents and/or a payment clearing and settlement system.
 // It is used to move funds from a debtor account to a creditor, where both debtor and creditor are financial institutions.
@@ -33,6 +68,10 @@ type FinancialInstitutionCreditTransferV01 struct {
 	CreditTransferTransactionInformation []*model.CreditTransferTransactionInformation3 `xml:"CdtTrfTxInf"`
 }
 
+func (f *FinancialInstitutionCreditTransferV01) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FinancialInstitutionCreditTransferV01) AddGroupHeader() *model.GroupHeader4 {
 	f.GroupHeader = new(model.GroupHeader4)
 	return f.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00900102) AddMessage() *FinancialInstitutionCreditTransferV02 {
 	return d.Message
 }
 
+func (d *Document00900102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.009.001.02"
+}
+
+func (d *Document00900102) MessageDefinitionIdentifier() string {
+	return "pacs.009.001.02"
+}
+
+func (d *Document00900102) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00900102) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900102) Variant() string {
+	return "001"
+}
+
+func (d *Document00900102) Version() string {
+	return "02"
+}
+
+func (d *Document00900102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionCreditTransfer message is sent by a debtor financial institution to a creditor financial institution, directly or through other agents and/or a payment clearing and settlement system.
 // It is used to move funds from a debtor account to a creditor, where both debtor and creditor are financial institutions.
@@ -32,6 +67,10 @@ type FinancialInstitutionCreditTransferV02 struct {
 	CreditTransferTransactionInformation []*model.CreditTransferTransactionInformation13 `xml:"CdtTrfTxInf"`
 }
 
+func (f *FinancialInstitutionCreditTransferV02) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FinancialInstitutionCreditTransferV02) AddGroupHeader() *model.GroupHeader35 {
 	f.GroupHeader = new(model.GroupHeader35)
 	return f.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00900103) AddMessage() *FinancialInstitutionCreditTransferV03 {
 	return d.Message
 }
 
+func (d *Document00900103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.009.001.03"
+}
+
+func (d *Document00900103) MessageDefinitionIdentifier() string {
+	return "pacs.009.001.03"
+}
+
+func (d *Document00900103) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00900103) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900103) Variant() string {
+	return "001"
+}
+
+func (d *Document00900103) Version() string {
+	return "03"
+}
+
+func (d *Document00900103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionCreditTransfer message is sent by a debtor financial institution to a creditor financial institution, directly or through other agents and/or a payment clearing and settlement system.
 // It is used to move funds from a debtor account to a creditor, where both debtor and creditor are financial institutions.
@@ -35,6 +70,10 @@ type FinancialInstitutionCreditTransferV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FinancialInstitutionCreditTransferV03) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FinancialInstitutionCreditTransferV03) AddGroupHeader() *model.GroupHeader51 {
 	f.GroupHeader = new(model.GroupHeader51)
 	return f.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00900106) AddMessage() *FinancialInstitutionCreditTransferV06 {
 	return d.Message
 }
 
+func (d *Document00900106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.009.001.06"
+}
+
+func (d *Document00900106) MessageDefinitionIdentifier() string {
+	return "pacs.009.001.06"
+}
+
+func (d *Document00900106) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00900106) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900106) Variant() string {
+	return "001"
+}
+
+func (d *Document00900106) Version() string {
+	return "06"
+}
+
+func (d *Document00900106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900106) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The FinancialInstitutionCreditTransfer message is sent by a debtor financial institution to a creditor financial institution, directly or through other agents and/or a payment clearing and settlement system.
 // It is used to move funds from a debtor account to a creditor, where both debtor and creditor are financial institutions.
@@ -35,6 +70,10 @@ type FinancialInstitutionCreditTransferV06 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FinancialInstitutionCreditTransferV06) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FinancialInstitutionCreditTransferV06) AddGroupHeader() *model.GroupHeader70 {
 	f.GroupHeader = new(model.GroupHeader70)
 	return f.GroupHeader
@@ -0,0 +1,187 @@
+package pacs
+
+import (
+	"encoding/xml"
+
+	"github.com/yudaprama/iso20022/fastxml"
+	"github.com/yudaprama/iso20022/model"
+)
+
+type Document00900108 struct {
+	XMLName xml.Name                               `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08 Document"`
+	Message *FinancialInstitutionCreditTransferV08 `xml:"FICdtTrf"`
+}
+
+func (d *Document00900108) AddMessage() *FinancialInstitutionCreditTransferV08 {
+	d.Message = new(FinancialInstitutionCreditTransferV08)
+	return d.Message
+}
+
+func (d *Document00900108) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08"
+}
+
+func (d *Document00900108) MessageDefinitionIdentifier() string {
+	return "pacs.009.001.08"
+}
+
+func (d *Document00900108) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00900108) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900108) Variant() string {
+	return "001"
+}
+
+func (d *Document00900108) Version() string {
+	return "08"
+}
+
+func (d *Document00900108) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900108) Validate() error {
+	return model.ValidateElement(d)
+}
+
+func (d *Document00900108) MarshalXMLFast() ([]byte, error) {
+	enc := fastxml.NewEncoder(make([]byte, 0, 4096))
+	enc.Root("urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08", "Document")
+	if d.Message != nil {
+		d.Message.EncodeXMLFast(enc, "FICdtTrf")
+	}
+	enc.End("Document")
+	return enc.Bytes(), nil
+}
+
+func (d *Document00900108) UnmarshalXMLFast(data []byte) error {
+	dec := fastxml.NewDecoder(data)
+	name, err := dec.Root("urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08", "Document")
+	if err != nil {
+		return err
+	}
+	d.XMLName = name
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "FICdtTrf":
+			elem := new(FinancialInstitutionCreditTransferV08)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Message = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Scope
+// The FinancialInstitutionCreditTransfer message is sent by a debtor financial institution to a creditor financial institution, directly or through other agents and/or a payment clearing and settlement system.
+// It is used to move funds from a debtor account to a creditor, where both debtor and creditor are financial institutions.
+// Usage
+// The FinancialInstitutionCreditTransfer message is exchanged between agents and can contain one or more credit transfer instructions where debtor and creditor are both financial institutions.
+// The FinancialInstitutionCreditTransfer message does not allow for grouping: a CreditTransferTransactionInformation block must be present for each credit transfer transaction.
+// The FinancialInstitutionCreditTransfer message can be used in domestic and cross-border scenarios.
+type FinancialInstitutionCreditTransferV08 struct {
+
+	// Set of characteristics shared by all individual transactions included in the message.
+	GroupHeader *model.GroupHeader93 `xml:"GrpHdr"`
+
+	// Set of elements providing information specific to the individual credit transfer(s).
+	CreditTransferTransactionInformation []*model.CreditTransferTransaction36 `xml:"CdtTrfTxInf"`
+
+	// Additional information that cannot be captured in the structured elements and/or any other specific block.
+	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
+}
+
+func (f *FinancialInstitutionCreditTransferV08) Validate() error {
+	return model.ValidateElement(f)
+}
+
+func (f *FinancialInstitutionCreditTransferV08) AddGroupHeader() *model.GroupHeader93 {
+	f.GroupHeader = new(model.GroupHeader93)
+	return f.GroupHeader
+}
+
+func (f *FinancialInstitutionCreditTransferV08) AddCreditTransferTransactionInformation() *model.CreditTransferTransaction36 {
+	newValue := new(model.CreditTransferTransaction36)
+	f.CreditTransferTransactionInformation = append(f.CreditTransferTransactionInformation, newValue)
+	return newValue
+}
+
+func (f *FinancialInstitutionCreditTransferV08) AddSupplementaryData() *model.SupplementaryData1 {
+	newValue := new(model.SupplementaryData1)
+	f.SupplementaryData = append(f.SupplementaryData, newValue)
+	return newValue
+}
+
+func (f *FinancialInstitutionCreditTransferV08) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if f.GroupHeader != nil {
+		f.GroupHeader.EncodeXMLFast(enc, "GrpHdr")
+	}
+	for _, elem := range f.CreditTransferTransactionInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "CdtTrfTxInf")
+		}
+	}
+	for _, elem := range f.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (f *FinancialInstitutionCreditTransferV08) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "GrpHdr":
+			elem := new(model.GroupHeader93)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.GroupHeader = elem
+		case "CdtTrfTxInf":
+			elem := new(model.CreditTransferTransaction36)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.CreditTransferTransactionInformation = append(f.CreditTransferTransactionInformation, elem)
+		case "SplmtryData":
+			elem := new(model.SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			f.SupplementaryData = append(f.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -16,6 +16,41 @@ func (d *Document01000102) AddMessage() *FinancialInstitutionDirectDebitV02 {
 	return d.Message
 }
 
+func (d *Document01000102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.010.001.02"
+}
+
+func (d *Document01000102) MessageDefinitionIdentifier() string {
+	return "pacs.010.001.02"
+}
+
+func (d *Document01000102) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document01000102) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000102) Variant() string {
+	return "001"
+}
+
+func (d *Document01000102) Version() string {
+	return "02"
+}
+
+func (d *Document01000102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope:
 // The FinancialInstitutionDirectDebit message is sent by an exchange or clearing house, or a financial institution, directly or through another agent, to the DebtorAgent.  It is used to instruct the DebtorAgent to move funds from one or more debtor(s) account(s) to one or more creditor(s), where both debtor and creditor are financial institutions.
 //
@@ -34,6 +69,10 @@ type FinancialInstitutionDirectDebitV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (f *FinancialInstitutionDirectDebitV02) Validate() error {
+	return model.ValidateElement(f)
+}
+
 func (f *FinancialInstitutionDirectDebitV02) AddGroupHeader() *model.GroupHeader63 {
 	f.GroupHeader = new(model.GroupHeader63)
 	return f.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00400101) AddMessage() *PaymentReturnV01 {
 	return d.Message
 }
 
+func (d *Document00400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.004.001.01"
+}
+
+func (d *Document00400101) MessageDefinitionIdentifier() string {
+	return "pacs.004.001.01"
+}
+
+func (d *Document00400101) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00400101) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400101) Variant() string {
+	return "001"
+}
+
+func (d *Document00400101) Version() string {
+	return "01"
+}
+
+func (d *Document00400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The PaymentReturn message is sent by an agent to the previous agent in the payment chain to undo a payment previously settled.
 // Usage
@@ -36,6 +71,10 @@ type PaymentReturnV01 struct {
 	TransactionInformation []*model.PaymentTransactionInformation2 `xml:"TxInf,omitempty"`
 }
 
+func (p *PaymentReturnV01) Validate() error {
+	return model.ValidateElement(p)
+}
+
 func (p *PaymentReturnV01) AddGroupHeader() *model.GroupHeader6 {
 	p.GroupHeader = new(model.GroupHeader6)
 	return p.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00400102) AddMessage() *PaymentReturnV02 {
 	return d.Message
 }
 
+func (d *Document00400102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.004.001.02"
+}
+
+func (d *Document00400102) MessageDefinitionIdentifier() string {
+	return "pacs.004.001.02"
+}
+
+func (d *Document00400102) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00400102) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400102) Variant() string {
+	return "001"
+}
+
+func (d *Document00400102) Version() string {
+	return "02"
+}
+
+func (d *Document00400102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The PaymentReturn message is sent by an agent to the previous agent in the payment chain to undo a payment previously settled.
 // Usage
@@ -36,6 +71,10 @@ type PaymentReturnV02 struct {
 	TransactionInformation []*model.PaymentTransactionInformation27 `xml:"TxInf,omitempty"`
 }
 
+func (p *PaymentReturnV02) Validate() error {
+	return model.ValidateElement(p)
+}
+
 func (p *PaymentReturnV02) AddGroupHeader() *model.GroupHeader38 {
 	p.GroupHeader = new(model.GroupHeader38)
 	return p.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00400104) AddMessage() *PaymentReturnV04 {
 	return d.Message
 }
 
+func (d *Document00400104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.004.001.04"
+}
+
+func (d *Document00400104) MessageDefinitionIdentifier() string {
+	return "pacs.004.001.04"
+}
+
+func (d *Document00400104) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00400104) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400104) Variant() string {
+	return "001"
+}
+
+func (d *Document00400104) Version() string {
+	return "04"
+}
+
+func (d *Document00400104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The PaymentReturn message is sent by an agent to the previous agent in the payment chain to undo a payment previously settled.
 // Usage
@@ -39,6 +74,10 @@ type PaymentReturnV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentReturnV04) Validate() error {
+	return model.ValidateElement(p)
+}
+
 func (p *PaymentReturnV04) AddGroupHeader() *model.GroupHeader54 {
 	p.GroupHeader = new(model.GroupHeader54)
 	return p.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00400105) AddMessage() *PaymentReturnV05 {
 	return d.Message
 }
 
+func (d *Document00400105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.004.001.05"
+}
+
+func (d *Document00400105) MessageDefinitionIdentifier() string {
+	return "pacs.004.001.05"
+}
+
+func (d *Document00400105) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00400105) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400105) Variant() string {
+	return "001"
+}
+
+func (d *Document00400105) Version() string {
+	return "05"
+}
+
+func (d *Document00400105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The PaymentReturn message is sent by an agent to the previous agent in the payment chain to undo a payment previously settled.
 // Usage
@@ -39,6 +74,10 @@ type PaymentReturnV05 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentReturnV05) Validate() error {
+	return model.ValidateElement(p)
+}
+
 func (p *PaymentReturnV05) AddGroupHeader() *model.GroupHeader54 {
 	p.GroupHeader = new(model.GroupHeader54)
 	return p.GroupHeader
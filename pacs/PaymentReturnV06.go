@@ -16,6 +16,41 @@ func (d *Document00400106) AddMessage() *PaymentReturnV06 {
 	return d.Message
 }
 
+func (d *Document00400106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.004.001.06"
+}
+
+func (d *Document00400106) MessageDefinitionIdentifier() string {
+	return "pacs.004.001.06"
+}
+
+func (d *Document00400106) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00400106) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400106) Variant() string {
+	return "001"
+}
+
+func (d *Document00400106) Version() string {
+	return "06"
+}
+
+func (d *Document00400106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400106) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The PaymentReturn message is sent by an agent to the previous agent in the payment chain to undo a payment previously settled.
 // Usage
@@ -39,6 +74,10 @@ type PaymentReturnV06 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentReturnV06) Validate() error {
+	return model.ValidateElement(p)
+}
+
 func (p *PaymentReturnV06) AddGroupHeader() *model.GroupHeader72 {
 	p.GroupHeader = new(model.GroupHeader72)
 	return p.GroupHeader
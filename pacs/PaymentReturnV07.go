@@ -3,6 +3,7 @@ package pacs
 import (
 	"encoding/xml"
 
+	"github.com/yudaprama/iso20022/fastxml"
 	"github.com/yudaprama/iso20022/model"
 )
 
@@ -16,6 +17,81 @@ func (d *Document00400107) AddMessage() *PaymentReturnV07 {
 	return d.Message
 }
 
+func (d *Document00400107) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pacs.004.001.07"
+}
+
+func (d *Document00400107) MessageDefinitionIdentifier() string {
+	return "pacs.004.001.07"
+}
+
+func (d *Document00400107) BusinessArea() string {
+	return "pacs"
+}
+
+func (d *Document00400107) MessageFunctionality() string {
+	return "004"
+}
+
+func (d *Document00400107) Variant() string {
+	return "001"
+}
+
+func (d *Document00400107) Version() string {
+	return "07"
+}
+
+func (d *Document00400107) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00400107) Validate() error {
+	return model.ValidateElement(d)
+}
+
+func (d *Document00400107) MarshalXMLFast() ([]byte, error) {
+	enc := fastxml.NewEncoder(make([]byte, 0, 4096))
+	enc.Root("urn:iso:std:iso:20022:tech:xsd:pacs.004.001.07", "Document")
+	if d.Message != nil {
+		d.Message.EncodeXMLFast(enc, "PmtRtr")
+	}
+	enc.End("Document")
+	return enc.Bytes(), nil
+}
+
+func (d *Document00400107) UnmarshalXMLFast(data []byte) error {
+	dec := fastxml.NewDecoder(data)
+	name, err := dec.Root("urn:iso:std:iso:20022:tech:xsd:pacs.004.001.07", "Document")
+	if err != nil {
+		return err
+	}
+	d.XMLName = name
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "PmtRtr":
+			elem := new(PaymentReturnV07)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Message = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // Scope
 // The PaymentReturn message is sent by an agent to the previous agent in the payment chain to undo a payment previously settled.
 // Usage
@@ -39,6 +115,10 @@ type PaymentReturnV07 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (p *PaymentReturnV07) Validate() error {
+	return model.ValidateElement(p)
+}
+
 func (p *PaymentReturnV07) AddGroupHeader() *model.GroupHeader72 {
 	p.GroupHeader = new(model.GroupHeader72)
 	return p.GroupHeader
@@ -201,6 +281,69 @@ func (p *PaymentReturnV07) AddSupplementaryData() *model.SupplementaryData1 {
 	return newValue
 }
 
+func (p *PaymentReturnV07) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if p.GroupHeader != nil {
+		p.GroupHeader.EncodeXMLFast(enc, "GrpHdr")
+	}
+	if p.OriginalGroupInformation != nil {
+		p.OriginalGroupInformation.EncodeXMLFast(enc, "OrgnlGrpInf")
+	}
+	for _, elem := range p.TransactionInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "TxInf")
+		}
+	}
+	for _, elem := range p.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (p *PaymentReturnV07) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "GrpHdr":
+			elem := new(model.GroupHeader72)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.GroupHeader = elem
+		case "OrgnlGrpInf":
+			elem := new(model.OriginalGroupHeader2)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.OriginalGroupInformation = elem
+		case "TxInf":
+			elem := new(model.PaymentTransaction76)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.TransactionInformation = append(p.TransactionInformation, elem)
+		case "SplmtryData":
+			elem := new(model.SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			p.SupplementaryData = append(p.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
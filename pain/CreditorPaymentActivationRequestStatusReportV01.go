@@ -16,6 +16,41 @@ func (d *Document01400101) AddMessage() *CreditorPaymentActivationRequestStatusR
 	return d.Message
 }
 
+func (d *Document01400101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.014.001.01"
+}
+
+func (d *Document01400101) MessageDefinitionIdentifier() string {
+	return "pain.014.001.01"
+}
+
+func (d *Document01400101) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01400101) MessageFunctionality() string {
+	return "014"
+}
+
+func (d *Document01400101) Variant() string {
+	return "001"
+}
+
+func (d *Document01400101) Version() string {
+	return "01"
+}
+
+func (d *Document01400101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01400101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // This message is sent by a party to the next party in the creditor payment activation request chain.
 // It is used to inform the latter about the positive or negative status of a creditor payment activation request (either single or file).
@@ -31,6 +66,10 @@ type CreditorPaymentActivationRequestStatusReportV01 struct {
 	OriginalPaymentInformationAndStatus []*model.OriginalPaymentInformation5 `xml:"OrgnlPmtInfAndSts,omitempty"`
 }
 
+func (c *CreditorPaymentActivationRequestStatusReportV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CreditorPaymentActivationRequestStatusReportV01) AddGroupHeader() *model.GroupHeader46 {
 	c.GroupHeader = new(model.GroupHeader46)
 	return c.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document01400102) AddMessage() *CreditorPaymentActivationRequestStatusR
 	return d.Message
 }
 
+func (d *Document01400102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.014.001.02"
+}
+
+func (d *Document01400102) MessageDefinitionIdentifier() string {
+	return "pain.014.001.02"
+}
+
+func (d *Document01400102) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01400102) MessageFunctionality() string {
+	return "014"
+}
+
+func (d *Document01400102) Variant() string {
+	return "001"
+}
+
+func (d *Document01400102) Version() string {
+	return "02"
+}
+
+func (d *Document01400102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01400102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // This message is sent by a party to the next party in the creditor payment activation request chain.
 // It is used to inform the latter about the positive or negative status of a creditor payment activation request (either single or file).
@@ -34,6 +69,10 @@ type CreditorPaymentActivationRequestStatusReportV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CreditorPaymentActivationRequestStatusReportV02) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CreditorPaymentActivationRequestStatusReportV02) AddGroupHeader() *model.GroupHeader46 {
 	c.GroupHeader = new(model.GroupHeader46)
 	return c.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document01400104) AddMessage() *CreditorPaymentActivationRequestStatusR
 	return d.Message
 }
 
+func (d *Document01400104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.014.001.04"
+}
+
+func (d *Document01400104) MessageDefinitionIdentifier() string {
+	return "pain.014.001.04"
+}
+
+func (d *Document01400104) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01400104) MessageFunctionality() string {
+	return "014"
+}
+
+func (d *Document01400104) Variant() string {
+	return "001"
+}
+
+func (d *Document01400104) Version() string {
+	return "04"
+}
+
+func (d *Document01400104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01400104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The CreditorPaymentActivationRequestStatusReport message is sent by a party to the next party in the creditor payment activation request chain. It is used to inform the latter about the positive or negative status of a creditor payment activation request (either single or file).
 type CreditorPaymentActivationRequestStatusReportV04 struct {
 
@@ -32,6 +67,10 @@ type CreditorPaymentActivationRequestStatusReportV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CreditorPaymentActivationRequestStatusReportV04) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CreditorPaymentActivationRequestStatusReportV04) AddGroupHeader() *model.GroupHeader46 {
 	c.GroupHeader = new(model.GroupHeader46)
 	return c.GroupHeader
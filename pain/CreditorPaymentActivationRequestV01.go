@@ -16,6 +16,41 @@ func (d *Document01300101) AddMessage() *CreditorPaymentActivationRequestV01 {
 	return d.Message
 }
 
+func (d *Document01300101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.013.001.01"
+}
+
+func (d *Document01300101) MessageDefinitionIdentifier() string {
+	return "pain.013.001.01"
+}
+
+func (d *Document01300101) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01300101) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300101) Variant() string {
+	return "001"
+}
+
+func (d *Document01300101) Version() string {
+	return "01"
+}
+
+func (d *Document01300101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // This message is sent by the Creditor sending party to the Debtor receiving party, directly or through agents.
 // It is used to initiate a creditor payment activation request.
@@ -28,6 +63,10 @@ type CreditorPaymentActivationRequestV01 struct {
 	PaymentInformation []*model.PaymentInstruction5 `xml:"PmtInf"`
 }
 
+func (c *CreditorPaymentActivationRequestV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CreditorPaymentActivationRequestV01) AddGroupHeader() *model.GroupHeader45 {
 	c.GroupHeader = new(model.GroupHeader45)
 	return c.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document01300102) AddMessage() *CreditorPaymentActivationRequestV02 {
 	return d.Message
 }
 
+func (d *Document01300102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.013.001.02"
+}
+
+func (d *Document01300102) MessageDefinitionIdentifier() string {
+	return "pain.013.001.02"
+}
+
+func (d *Document01300102) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01300102) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300102) Variant() string {
+	return "001"
+}
+
+func (d *Document01300102) Version() string {
+	return "02"
+}
+
+func (d *Document01300102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // This message is sent by the Creditor sending party to the Debtor receiving party, directly or through agents.
 // It is used to initiate a creditor payment activation request.
@@ -31,6 +66,10 @@ type CreditorPaymentActivationRequestV02 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CreditorPaymentActivationRequestV02) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CreditorPaymentActivationRequestV02) AddGroupHeader() *model.GroupHeader45 {
 	c.GroupHeader = new(model.GroupHeader45)
 	return c.GroupHeader
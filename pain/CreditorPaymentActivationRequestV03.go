@@ -16,6 +16,41 @@ func (d *Document01300103) AddMessage() *CreditorPaymentActivationRequestV03 {
 	return d.Message
 }
 
+func (d *Document01300103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.013.001.03"
+}
+
+func (d *Document01300103) MessageDefinitionIdentifier() string {
+	return "pain.013.001.03"
+}
+
+func (d *Document01300103) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01300103) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300103) Variant() string {
+	return "001"
+}
+
+func (d *Document01300103) Version() string {
+	return "03"
+}
+
+func (d *Document01300103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The CreditorPaymentActivationRequest message is sent by the Creditor sending party to the Debtor receiving party, directly or through agents. It is used to initiate a creditor payment activation request.
 type CreditorPaymentActivationRequestV03 struct {
 
@@ -29,6 +64,10 @@ type CreditorPaymentActivationRequestV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CreditorPaymentActivationRequestV03) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CreditorPaymentActivationRequestV03) AddGroupHeader() *model.GroupHeader45 {
 	c.GroupHeader = new(model.GroupHeader45)
 	return c.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document01300104) AddMessage() *CreditorPaymentActivationRequestV04 {
 	return d.Message
 }
 
+func (d *Document01300104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.013.001.04"
+}
+
+func (d *Document01300104) MessageDefinitionIdentifier() string {
+	return "pain.013.001.04"
+}
+
+func (d *Document01300104) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01300104) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300104) Variant() string {
+	return "001"
+}
+
+func (d *Document01300104) Version() string {
+	return "04"
+}
+
+func (d *Document01300104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The CreditorPaymentActivationRequest message is sent by the Creditor sending party to the Debtor receiving party, directly or through agents. It is used to initiate a creditor payment activation request.
 type CreditorPaymentActivationRequestV04 struct {
 
@@ -29,6 +64,10 @@ type CreditorPaymentActivationRequestV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CreditorPaymentActivationRequestV04) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CreditorPaymentActivationRequestV04) AddGroupHeader() *model.GroupHeader45 {
 	c.GroupHeader = new(model.GroupHeader45)
 	return c.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document01300106) AddMessage() *CreditorPaymentActivationRequestV06 {
 	return d.Message
 }
 
+func (d *Document01300106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.013.001.06"
+}
+
+func (d *Document01300106) MessageDefinitionIdentifier() string {
+	return "pain.013.001.06"
+}
+
+func (d *Document01300106) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01300106) MessageFunctionality() string {
+	return "013"
+}
+
+func (d *Document01300106) Variant() string {
+	return "001"
+}
+
+func (d *Document01300106) Version() string {
+	return "06"
+}
+
+func (d *Document01300106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01300106) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // The CreditorPaymentActivationRequest message is sent by the Creditor sending party to the Debtor receiving party, directly or through agents. It is used by a Creditor to request movement of funds from the debtor account to a creditor.
 type CreditorPaymentActivationRequestV06 struct {
 
@@ -29,6 +64,10 @@ type CreditorPaymentActivationRequestV06 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CreditorPaymentActivationRequestV06) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CreditorPaymentActivationRequestV06) AddGroupHeader() *model.GroupHeader45 {
 	c.GroupHeader = new(model.GroupHeader45)
 	return c.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00100102) AddMessage() *CustomerCreditTransferInitiationV02 {
 	return d.Message
 }
 
+func (d *Document00100102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.001.001.02"
+}
+
+func (d *Document00100102) MessageDefinitionIdentifier() string {
+	return "pain.001.001.02"
+}
+
+func (d *Document00100102) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00100102) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100102) Variant() string {
+	return "001"
+}
+
+func (d *Document00100102) Version() string {
+	return "02"
+}
+
+func (d *Document00100102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CustomerCreditTransferInitiation message is sent by the initiating party to the forwarding agent or debtor agent. It is used to request movement of funds from the debtor account to a creditor.
 // Usage
@@ -50,6 +85,10 @@ type CustomerCreditTransferInitiationV02 struct {
 	PaymentInformation []*model.PaymentInstructionInformation1 `xml:"PmtInf"`
 }
 
+func (c *CustomerCreditTransferInitiationV02) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerCreditTransferInitiationV02) AddGroupHeader() *model.GroupHeader1 {
 	c.GroupHeader = new(model.GroupHeader1)
 	return c.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00100103) AddMessage() *CustomerCreditTransferInitiationV03 {
 	return d.Message
 }
 
+func (d *Document00100103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.001.001.03"
+}
+
+func (d *Document00100103) MessageDefinitionIdentifier() string {
+	return "pain.001.001.03"
+}
+
+func (d *Document00100103) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00100103) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100103) Variant() string {
+	return "001"
+}
+
+func (d *Document00100103) Version() string {
+	return "03"
+}
+
+func (d *Document00100103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CustomerCreditTransferInitiation message is sent by the initiating party to the forwarding agent or debtor agent. It is used to request movement of funds from the debtor account to a creditor.
 // Usage
@@ -39,6 +74,10 @@ type CustomerCreditTransferInitiationV03 struct {
 	PaymentInformation []*model.PaymentInstructionInformation3 `xml:"PmtInf"`
 }
 
+func (c *CustomerCreditTransferInitiationV03) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerCreditTransferInitiationV03) AddGroupHeader() *model.GroupHeader32 {
 	c.GroupHeader = new(model.GroupHeader32)
 	return c.GroupHeader
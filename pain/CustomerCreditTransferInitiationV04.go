@@ -16,6 +16,41 @@ func (d *Document00100104) AddMessage() *CustomerCreditTransferInitiationV04 {
 	return d.Message
 }
 
+func (d *Document00100104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.001.001.04"
+}
+
+func (d *Document00100104) MessageDefinitionIdentifier() string {
+	return "pain.001.001.04"
+}
+
+func (d *Document00100104) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00100104) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100104) Variant() string {
+	return "001"
+}
+
+func (d *Document00100104) Version() string {
+	return "04"
+}
+
+func (d *Document00100104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CustomerCreditTransferInitiation message is sent by the initiating party to the forwarding agent or debtor agent. It is used to request movement of funds from the debtor account to a creditor.
 // Usage
@@ -42,6 +77,10 @@ type CustomerCreditTransferInitiationV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CustomerCreditTransferInitiationV04) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerCreditTransferInitiationV04) AddGroupHeader() *model.GroupHeader48 {
 	c.GroupHeader = new(model.GroupHeader48)
 	return c.GroupHeader
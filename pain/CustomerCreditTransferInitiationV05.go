@@ -16,6 +16,41 @@ func (d *Document00100105) AddMessage() *CustomerCreditTransferInitiationV05 {
 	return d.Message
 }
 
+func (d *Document00100105) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.001.001.05"
+}
+
+func (d *Document00100105) MessageDefinitionIdentifier() string {
+	return "pain.001.001.05"
+}
+
+func (d *Document00100105) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00100105) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100105) Variant() string {
+	return "001"
+}
+
+func (d *Document00100105) Version() string {
+	return "05"
+}
+
+func (d *Document00100105) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100105) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CustomerCreditTransferInitiation message is sent by the initiating party to the forwarding agent or debtor agent. It is used to request movement of funds from the debtor account to a creditor.
 // Usage
@@ -42,6 +77,10 @@ type CustomerCreditTransferInitiationV05 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CustomerCreditTransferInitiationV05) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerCreditTransferInitiationV05) AddGroupHeader() *model.GroupHeader48 {
 	c.GroupHeader = new(model.GroupHeader48)
 	return c.GroupHeader
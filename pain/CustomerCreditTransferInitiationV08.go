@@ -3,6 +3,7 @@ package pain
 import (
 	"encoding/xml"
 
+	"github.com/yudaprama/iso20022/fastxml"
 	"github.com/yudaprama/iso20022/model"
 )
 
@@ -16,6 +17,81 @@ func (d *Document00100108) AddMessage() *CustomerCreditTransferInitiationV08 {
 	return d.Message
 }
 
+func (d *Document00100108) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.001.001.08"
+}
+
+func (d *Document00100108) MessageDefinitionIdentifier() string {
+	return "pain.001.001.08"
+}
+
+func (d *Document00100108) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00100108) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100108) Variant() string {
+	return "001"
+}
+
+func (d *Document00100108) Version() string {
+	return "08"
+}
+
+func (d *Document00100108) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100108) Validate() error {
+	return model.ValidateElement(d)
+}
+
+func (d *Document00100108) MarshalXMLFast() ([]byte, error) {
+	enc := fastxml.NewEncoder(make([]byte, 0, 4096))
+	enc.Root("urn:iso:std:iso:20022:tech:xsd:pain.001.001.08", "Document")
+	if d.Message != nil {
+		d.Message.EncodeXMLFast(enc, "CstmrCdtTrfInitn")
+	}
+	enc.End("Document")
+	return enc.Bytes(), nil
+}
+
+func (d *Document00100108) UnmarshalXMLFast(data []byte) error {
+	dec := fastxml.NewDecoder(data)
+	name, err := dec.Root("urn:iso:std:iso:20022:tech:xsd:pain.001.001.08", "Document")
+	if err != nil {
+		return err
+	}
+	d.XMLName = name
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "CstmrCdtTrfInitn":
+			elem := new(CustomerCreditTransferInitiationV08)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Message = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // Scope
 // The CustomerCreditTransferInitiation message is sent by the initiating party to the forwarding agent or debtor agent. It is used to request movement of funds from the debtor account to a creditor.
 // Usage
@@ -42,6 +118,10 @@ type CustomerCreditTransferInitiationV08 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CustomerCreditTransferInitiationV08) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerCreditTransferInitiationV08) AddGroupHeader() *model.GroupHeader48 {
 	c.GroupHeader = new(model.GroupHeader48)
 	return c.GroupHeader
@@ -199,6 +279,60 @@ func (c *CustomerCreditTransferInitiationV08) AddSupplementaryData() *model.Supp
 	return newValue
 }
 
+func (c *CustomerCreditTransferInitiationV08) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.GroupHeader != nil {
+		c.GroupHeader.EncodeXMLFast(enc, "GrpHdr")
+	}
+	for _, elem := range c.PaymentInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "PmtInf")
+		}
+	}
+	for _, elem := range c.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (c *CustomerCreditTransferInitiationV08) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "GrpHdr":
+			elem := new(model.GroupHeader48)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.GroupHeader = elem
+		case "PmtInf":
+			elem := new(model.PaymentInstruction22)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PaymentInformation = append(c.PaymentInformation, elem)
+		case "SplmtryData":
+			elem := new(model.SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.SupplementaryData = append(c.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
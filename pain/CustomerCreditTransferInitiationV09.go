@@ -0,0 +1,194 @@
+package pain
+
+import (
+	"encoding/xml"
+
+	"github.com/yudaprama/iso20022/fastxml"
+	"github.com/yudaprama/iso20022/model"
+)
+
+type Document00100109 struct {
+	XMLName xml.Name                             `xml:"urn:iso:std:iso:20022:tech:xsd:pain.001.001.09 Document"`
+	Message *CustomerCreditTransferInitiationV09 `xml:"CstmrCdtTrfInitn"`
+}
+
+func (d *Document00100109) AddMessage() *CustomerCreditTransferInitiationV09 {
+	d.Message = new(CustomerCreditTransferInitiationV09)
+	return d.Message
+}
+
+func (d *Document00100109) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.001.001.09"
+}
+
+func (d *Document00100109) MessageDefinitionIdentifier() string {
+	return "pain.001.001.09"
+}
+
+func (d *Document00100109) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00100109) MessageFunctionality() string {
+	return "001"
+}
+
+func (d *Document00100109) Variant() string {
+	return "001"
+}
+
+func (d *Document00100109) Version() string {
+	return "09"
+}
+
+func (d *Document00100109) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00100109) Validate() error {
+	return model.ValidateElement(d)
+}
+
+func (d *Document00100109) MarshalXMLFast() ([]byte, error) {
+	enc := fastxml.NewEncoder(make([]byte, 0, 4096))
+	enc.Root("urn:iso:std:iso:20022:tech:xsd:pain.001.001.09", "Document")
+	if d.Message != nil {
+		d.Message.EncodeXMLFast(enc, "CstmrCdtTrfInitn")
+	}
+	enc.End("Document")
+	return enc.Bytes(), nil
+}
+
+func (d *Document00100109) UnmarshalXMLFast(data []byte) error {
+	dec := fastxml.NewDecoder(data)
+	name, err := dec.Root("urn:iso:std:iso:20022:tech:xsd:pain.001.001.09", "Document")
+	if err != nil {
+		return err
+	}
+	d.XMLName = name
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "CstmrCdtTrfInitn":
+			elem := new(CustomerCreditTransferInitiationV09)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Message = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Scope
+// The CustomerCreditTransferInitiation message is sent by the initiating party to the forwarding agent or debtor agent. It is used to request movement of funds from the debtor account to a creditor.
+// Usage
+// The CustomerCreditTransferInitiation message can contain one or more customer credit transfer instructions.
+// The CustomerCreditTransferInitiation message is used to exchange:
+// - One or more instances of a credit transfer initiation;
+// - Payment transactions that result in book transfers at the debtor agent or payments to another financial institution;
+// - Payment transactions that result in an electronic cash transfer to the creditor account or in the emission of a cheque.
+// The message can be used in a direct or a relay scenario:
+// - In a direct scenario, the message is sent directly to the debtor agent. The debtor agent is the account servicer of the debtor.
+// - In a relay scenario, the message is sent to a forwarding agent. The forwarding agent acts as a concentrating financial institution. It will forward the CustomerCreditTransferInitiation message to the debtor agent.
+// The message can also be used by an initiating party that has authority to send the message on behalf of the debtor. This caters for example for the scenario of a payments factory initiating all payments on behalf of a large corporate.
+// The CustomerCreditTransferInitiation message can be used in domestic and cross-border scenarios.
+// The CustomerCreditTransferInitiation message must not be used by the debtor agent to execute the credit transfer instruction(s). The FIToFICustomerCreditTransfer message must be used instead.
+type CustomerCreditTransferInitiationV09 struct {
+
+	// Set of characteristics shared by all individual transactions included in the message.
+	GroupHeader *model.GroupHeader85 `xml:"GrpHdr"`
+
+	// Set of characteristics that applies to the debit side of the payment transactions included in the credit transfer initiation.
+	PaymentInformation []*model.PaymentInstruction30 `xml:"PmtInf"`
+
+	// Additional information that cannot be captured in the structured elements and/or any other specific block.
+	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
+}
+
+func (c *CustomerCreditTransferInitiationV09) Validate() error {
+	return model.ValidateElement(c)
+}
+
+func (c *CustomerCreditTransferInitiationV09) AddGroupHeader() *model.GroupHeader85 {
+	c.GroupHeader = new(model.GroupHeader85)
+	return c.GroupHeader
+}
+
+func (c *CustomerCreditTransferInitiationV09) AddPaymentInformation() *model.PaymentInstruction30 {
+	newValue := new(model.PaymentInstruction30)
+	c.PaymentInformation = append(c.PaymentInformation, newValue)
+	return newValue
+}
+
+func (c *CustomerCreditTransferInitiationV09) AddSupplementaryData() *model.SupplementaryData1 {
+	newValue := new(model.SupplementaryData1)
+	c.SupplementaryData = append(c.SupplementaryData, newValue)
+	return newValue
+}
+
+func (c *CustomerCreditTransferInitiationV09) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.GroupHeader != nil {
+		c.GroupHeader.EncodeXMLFast(enc, "GrpHdr")
+	}
+	for _, elem := range c.PaymentInformation {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "PmtInf")
+		}
+	}
+	for _, elem := range c.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (c *CustomerCreditTransferInitiationV09) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "GrpHdr":
+			elem := new(model.GroupHeader85)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.GroupHeader = elem
+		case "PmtInf":
+			elem := new(model.PaymentInstruction30)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.PaymentInformation = append(c.PaymentInformation, elem)
+		case "SplmtryData":
+			elem := new(model.SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.SupplementaryData = append(c.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
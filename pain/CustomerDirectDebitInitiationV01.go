@@ -16,6 +16,41 @@ func (d *Document00800101) AddMessage() *CustomerDirectDebitInitiationV01 {
 	return d.Message
 }
 
+func (d *Document00800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.008.001.01"
+}
+
+func (d *Document00800101) MessageDefinitionIdentifier() string {
+	return "pain.008.001.01"
+}
+
+func (d *Document00800101) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00800101) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800101) Variant() string {
+	return "001"
+}
+
+func (d *Document00800101) Version() string {
+	return "01"
+}
+
+func (d *Document00800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CustomerDirectDebitInitiation message is sent by the initiating party to the forwarding agent or creditor agent. It is used to request single or bulk collection(s) of funds from one or various debtor's account(s) for a creditor.
 // Usage
@@ -46,6 +81,10 @@ type CustomerDirectDebitInitiationV01 struct {
 	PaymentInformation []*model.PaymentInstructionInformation2 `xml:"PmtInf"`
 }
 
+func (c *CustomerDirectDebitInitiationV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerDirectDebitInitiationV01) AddGroupHeader() *model.GroupHeader1 {
 	c.GroupHeader = new(model.GroupHeader1)
 	return c.GroupHeader
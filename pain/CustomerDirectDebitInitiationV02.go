@@ -16,6 +16,41 @@ func (d *Document00800102) AddMessage() *CustomerDirectDebitInitiationV02 {
 	return d.Message
 }
 
+func (d *Document00800102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.008.001.02"
+}
+
+func (d *Document00800102) MessageDefinitionIdentifier() string {
+	return "pain.008.001.02"
+}
+
+func (d *Document00800102) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00800102) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800102) Variant() string {
+	return "001"
+}
+
+func (d *Document00800102) Version() string {
+	return "02"
+}
+
+func (d *Document00800102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CustomerDirectDebitInitiation message is sent by the initiating party to the forwarding agent or creditor agent. It is used to request single or bulk collection(s) of funds from one or various debtor's account(s) for a creditor.
 // Usage
@@ -36,6 +71,10 @@ type CustomerDirectDebitInitiationV02 struct {
 	PaymentInformation []*model.PaymentInstructionInformation4 `xml:"PmtInf"`
 }
 
+func (c *CustomerDirectDebitInitiationV02) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerDirectDebitInitiationV02) AddGroupHeader() *model.GroupHeader39 {
 	c.GroupHeader = new(model.GroupHeader39)
 	return c.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00800103) AddMessage() *CustomerDirectDebitInitiationV03 {
 	return d.Message
 }
 
+func (d *Document00800103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.008.001.03"
+}
+
+func (d *Document00800103) MessageDefinitionIdentifier() string {
+	return "pain.008.001.03"
+}
+
+func (d *Document00800103) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00800103) MessageFunctionality() string {
+	return "008"
+}
+
+func (d *Document00800103) Variant() string {
+	return "001"
+}
+
+func (d *Document00800103) Version() string {
+	return "03"
+}
+
+func (d *Document00800103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00800103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CustomerDirectDebitInitiation message is sent by the initiating party to the forwarding agent or creditor agent. It is used to request single or bulk collection(s) of funds from one or various debtor's account(s) for a creditor.
 // Usage
@@ -39,6 +74,10 @@ type CustomerDirectDebitInitiationV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CustomerDirectDebitInitiationV03) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerDirectDebitInitiationV03) AddGroupHeader() *model.GroupHeader55 {
 	c.GroupHeader = new(model.GroupHeader55)
 	return c.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00700101) AddMessage() *CustomerPaymentReversalV01 {
 	return d.Message
 }
 
+func (d *Document00700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.007.001.01"
+}
+
+func (d *Document00700101) MessageDefinitionIdentifier() string {
+	return "pain.007.001.01"
+}
+
+func (d *Document00700101) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00700101) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700101) Variant() string {
+	return "001"
+}
+
+func (d *Document00700101) Version() string {
+	return "01"
+}
+
+func (d *Document00700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CustomerPaymentReversal message is sent by the initiating party to the next party in the payment chain. It is used to reverse a payment previously executed.
 // Usage
@@ -34,6 +69,10 @@ type CustomerPaymentReversalV01 struct {
 	TransactionInformation []*model.PaymentTransactionInformation4 `xml:"TxInf,omitempty"`
 }
 
+func (c *CustomerPaymentReversalV01) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerPaymentReversalV01) AddGroupHeader() *model.GroupHeader8 {
 	c.GroupHeader = new(model.GroupHeader8)
 	return c.GroupHeader
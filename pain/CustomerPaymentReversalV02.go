@@ -16,6 +16,41 @@ func (d *Document00700102) AddMessage() *CustomerPaymentReversalV02 {
 	return d.Message
 }
 
+func (d *Document00700102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.007.001.02"
+}
+
+func (d *Document00700102) MessageDefinitionIdentifier() string {
+	return "pain.007.001.02"
+}
+
+func (d *Document00700102) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00700102) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700102) Variant() string {
+	return "001"
+}
+
+func (d *Document00700102) Version() string {
+	return "02"
+}
+
+func (d *Document00700102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CustomerPaymentReversal message is sent by the initiating party to the next party in the payment chain. It is used to reverse a payment previously executed.
 // Usage
@@ -34,6 +69,10 @@ type CustomerPaymentReversalV02 struct {
 	OriginalPaymentInformationAndReversal []*model.OriginalPaymentInformation2 `xml:"OrgnlPmtInfAndRvsl,omitempty"`
 }
 
+func (c *CustomerPaymentReversalV02) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerPaymentReversalV02) AddGroupHeader() *model.GroupHeader40 {
 	c.GroupHeader = new(model.GroupHeader40)
 	return c.GroupHeader
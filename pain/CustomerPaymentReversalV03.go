@@ -16,6 +16,41 @@ func (d *Document00700103) AddMessage() *CustomerPaymentReversalV03 {
 	return d.Message
 }
 
+func (d *Document00700103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.007.001.03"
+}
+
+func (d *Document00700103) MessageDefinitionIdentifier() string {
+	return "pain.007.001.03"
+}
+
+func (d *Document00700103) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00700103) MessageFunctionality() string {
+	return "007"
+}
+
+func (d *Document00700103) Variant() string {
+	return "001"
+}
+
+func (d *Document00700103) Version() string {
+	return "03"
+}
+
+func (d *Document00700103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00700103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CustomerPaymentReversal message is sent by the initiating party to the next party in the payment chain. It is used to reverse a payment previously executed.
 // Usage
@@ -37,6 +72,10 @@ type CustomerPaymentReversalV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CustomerPaymentReversalV03) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerPaymentReversalV03) AddGroupHeader() *model.GroupHeader56 {
 	c.GroupHeader = new(model.GroupHeader56)
 	return c.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00200106) AddMessage() *CustomerPaymentStatusReportV06 {
 	return d.Message
 }
 
+func (d *Document00200106) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.002.001.06"
+}
+
+func (d *Document00200106) MessageDefinitionIdentifier() string {
+	return "pain.002.001.06"
+}
+
+func (d *Document00200106) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00200106) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200106) Variant() string {
+	return "001"
+}
+
+func (d *Document00200106) Version() string {
+	return "06"
+}
+
+func (d *Document00200106) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200106) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The CustomerPaymentStatusReport message is sent by an instructed agent to the previous party in the payment chain. It is used to inform this party about the positive or negative status of an instruction (either single or file). It is also used to report on a pending instruction.
 // Usage
@@ -38,6 +73,10 @@ type CustomerPaymentStatusReportV06 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CustomerPaymentStatusReportV06) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerPaymentStatusReportV06) AddGroupHeader() *model.GroupHeader52 {
 	c.GroupHeader = new(model.GroupHeader52)
 	return c.GroupHeader
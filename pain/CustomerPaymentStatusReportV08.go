@@ -3,6 +3,7 @@ package pain
 import (
 	"encoding/xml"
 
+	"github.com/yudaprama/iso20022/fastxml"
 	"github.com/yudaprama/iso20022/model"
 )
 
@@ -16,6 +17,81 @@ func (d *Document00200108) AddMessage() *CustomerPaymentStatusReportV08 {
 	return d.Message
 }
 
+func (d *Document00200108) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.002.001.08"
+}
+
+func (d *Document00200108) MessageDefinitionIdentifier() string {
+	return "pain.002.001.08"
+}
+
+func (d *Document00200108) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00200108) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200108) Variant() string {
+	return "001"
+}
+
+func (d *Document00200108) Version() string {
+	return "08"
+}
+
+func (d *Document00200108) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200108) Validate() error {
+	return model.ValidateElement(d)
+}
+
+func (d *Document00200108) MarshalXMLFast() ([]byte, error) {
+	enc := fastxml.NewEncoder(make([]byte, 0, 4096))
+	enc.Root("urn:iso:std:iso:20022:tech:xsd:pain.002.001.08", "Document")
+	if d.Message != nil {
+		d.Message.EncodeXMLFast(enc, "CstmrPmtStsRpt")
+	}
+	enc.End("Document")
+	return enc.Bytes(), nil
+}
+
+func (d *Document00200108) UnmarshalXMLFast(data []byte) error {
+	dec := fastxml.NewDecoder(data)
+	name, err := dec.Root("urn:iso:std:iso:20022:tech:xsd:pain.002.001.08", "Document")
+	if err != nil {
+		return err
+	}
+	d.XMLName = name
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "CstmrPmtStsRpt":
+			elem := new(CustomerPaymentStatusReportV08)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			d.Message = elem
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // Scope
 // The CustomerPaymentStatusReport message is sent by an instructed agent to the previous party in the payment chain. It is used to inform this party about the positive or negative status of an instruction (either single or file). It is also used to report on a pending instruction.
 // Usage
@@ -39,6 +115,10 @@ type CustomerPaymentStatusReportV08 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (c *CustomerPaymentStatusReportV08) Validate() error {
+	return model.ValidateElement(c)
+}
+
 func (c *CustomerPaymentStatusReportV08) AddGroupHeader() *model.GroupHeader52 {
 	c.GroupHeader = new(model.GroupHeader52)
 	return c.GroupHeader
@@ -201,6 +281,69 @@ func (c *CustomerPaymentStatusReportV08) AddSupplementaryData() *model.Supplemen
 	return newValue
 }
 
+func (c *CustomerPaymentStatusReportV08) EncodeXMLFast(enc *fastxml.Encoder, tag string) {
+	enc.Start(tag)
+	if c.GroupHeader != nil {
+		c.GroupHeader.EncodeXMLFast(enc, "GrpHdr")
+	}
+	if c.OriginalGroupInformationAndStatus != nil {
+		c.OriginalGroupInformationAndStatus.EncodeXMLFast(enc, "OrgnlGrpInfAndSts")
+	}
+	for _, elem := range c.OriginalPaymentInformationAndStatus {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "OrgnlPmtInfAndSts")
+		}
+	}
+	for _, elem := range c.SupplementaryData {
+		if elem != nil {
+			elem.EncodeXMLFast(enc, "SplmtryData")
+		}
+	}
+	enc.End(tag)
+}
+
+func (c *CustomerPaymentStatusReportV08) DecodeXMLFast(dec *fastxml.Decoder) error {
+	for {
+		name, err := dec.Next()
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			return nil
+		}
+		switch string(name) {
+		case "GrpHdr":
+			elem := new(model.GroupHeader52)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.GroupHeader = elem
+		case "OrgnlGrpInfAndSts":
+			elem := new(model.OriginalGroupHeader7)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.OriginalGroupInformationAndStatus = elem
+		case "OrgnlPmtInfAndSts":
+			elem := new(model.OriginalPaymentInstruction23)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.OriginalPaymentInformationAndStatus = append(c.OriginalPaymentInformationAndStatus, elem)
+		case "SplmtryData":
+			elem := new(model.SupplementaryData1)
+			if err := elem.DecodeXMLFast(dec); err != nil {
+				return err
+			}
+			c.SupplementaryData = append(c.SupplementaryData, elem)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func paymentInstruct(doc *Document) (paymentInstruct, error) {
 	output := paymentInstruct{
 		AdrLine:                doc.AdrLine.Max70Text,                                     // "<AdrLine>"
@@ -16,6 +16,41 @@ func (d *Document01200101) AddMessage() *MandateAcceptanceReportV01 {
 	return d.Message
 }
 
+func (d *Document01200101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.012.001.01"
+}
+
+func (d *Document01200101) MessageDefinitionIdentifier() string {
+	return "pain.012.001.01"
+}
+
+func (d *Document01200101) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01200101) MessageFunctionality() string {
+	return "012"
+}
+
+func (d *Document01200101) Variant() string {
+	return "001"
+}
+
+func (d *Document01200101) Version() string {
+	return "01"
+}
+
+func (d *Document01200101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01200101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The MandateAcceptanceReport message is sent from the agent of the receiver (debtor or creditor) of the MandateRequest message (initiation, amendment or cancellation) to the agent of the initiator of the MandateRequest message (debtor or creditor).
 // A MandateAcceptanceReport message is used to confirm the acceptance or rejection of a MandateRequest message.
@@ -33,6 +68,10 @@ type MandateAcceptanceReportV01 struct {
 	UnderlyingAcceptanceDetails *model.MandateAcceptance1 `xml:"UndrlygAccptncDtls"`
 }
 
+func (m *MandateAcceptanceReportV01) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MandateAcceptanceReportV01) AddGroupHeader() *model.GroupHeader31 {
 	m.GroupHeader = new(model.GroupHeader31)
 	return m.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document01200104) AddMessage() *MandateAcceptanceReportV04 {
 	return d.Message
 }
 
+func (d *Document01200104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.012.001.04"
+}
+
+func (d *Document01200104) MessageDefinitionIdentifier() string {
+	return "pain.012.001.04"
+}
+
+func (d *Document01200104) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01200104) MessageFunctionality() string {
+	return "012"
+}
+
+func (d *Document01200104) Variant() string {
+	return "001"
+}
+
+func (d *Document01200104) Version() string {
+	return "04"
+}
+
+func (d *Document01200104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01200104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The MandateAcceptanceReport message is sent from the agent of the receiver (debtor or creditor) of the MandateRequest message (initiation, amendment or cancellation) to the agent of the initiator of the MandateRequest message (debtor or creditor).
 // A MandateAcceptanceReport message is used to confirm the acceptance or rejection of a MandateRequest message. Where acceptance is part of the full process flow, a MandateRequest message only becomes valid after a confirmation of acceptance is received through a MandateAcceptanceReport message from the agent of the receiver.
@@ -35,6 +70,10 @@ type MandateAcceptanceReportV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MandateAcceptanceReportV04) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MandateAcceptanceReportV04) AddGroupHeader() *model.GroupHeader47 {
 	m.GroupHeader = new(model.GroupHeader47)
 	return m.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document01000101) AddMessage() *MandateAmendmentRequestV01 {
 	return d.Message
 }
 
+func (d *Document01000101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.010.001.01"
+}
+
+func (d *Document01000101) MessageDefinitionIdentifier() string {
+	return "pain.010.001.01"
+}
+
+func (d *Document01000101) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01000101) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000101) Variant() string {
+	return "001"
+}
+
+func (d *Document01000101) Version() string {
+	return "01"
+}
+
+func (d *Document01000101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The MandateAmendmentRequest message is sent by the initiator of the request to his agent and/or counterparty. The initiator can both be the debtor or the creditor (or where appropriate the debtor agent).
 // The MandateAmendmentRequest message is forwarded by the agent of the initiator to the agent of the counterparty.
@@ -36,6 +71,10 @@ type MandateAmendmentRequestV01 struct {
 	UnderlyingAmendmentDetails *model.MandateAmendment1 `xml:"UndrlygAmdmntDtls"`
 }
 
+func (m *MandateAmendmentRequestV01) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MandateAmendmentRequestV01) AddGroupHeader() *model.GroupHeader31 {
 	m.GroupHeader = new(model.GroupHeader31)
 	return m.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document01000103) AddMessage() *MandateAmendmentRequestV03 {
 	return d.Message
 }
 
+func (d *Document01000103) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.010.001.03"
+}
+
+func (d *Document01000103) MessageDefinitionIdentifier() string {
+	return "pain.010.001.03"
+}
+
+func (d *Document01000103) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01000103) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000103) Variant() string {
+	return "001"
+}
+
+func (d *Document01000103) Version() string {
+	return "03"
+}
+
+func (d *Document01000103) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000103) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The MandateAmendmentRequest message is sent by the initiator of the request to his agent and/or counterparty. The initiator can both be the debtor or the creditor (or where appropriate the debtor agent).
 // The MandateAmendmentRequest message is forwarded by the agent of the initiator to the agent of the counterparty.
@@ -38,6 +73,10 @@ type MandateAmendmentRequestV03 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MandateAmendmentRequestV03) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MandateAmendmentRequestV03) AddGroupHeader() *model.GroupHeader47 {
 	m.GroupHeader = new(model.GroupHeader47)
 	return m.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document01000104) AddMessage() *MandateAmendmentRequestV04 {
 	return d.Message
 }
 
+func (d *Document01000104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.010.001.04"
+}
+
+func (d *Document01000104) MessageDefinitionIdentifier() string {
+	return "pain.010.001.04"
+}
+
+func (d *Document01000104) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01000104) MessageFunctionality() string {
+	return "010"
+}
+
+func (d *Document01000104) Variant() string {
+	return "001"
+}
+
+func (d *Document01000104) Version() string {
+	return "04"
+}
+
+func (d *Document01000104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01000104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The MandateAmendmentRequest message is sent by the initiator of the request to his agent and/or counterparty. The initiator can both be the debtor or the creditor (or where appropriate the debtor agent).
 // The MandateAmendmentRequest message is forwarded by the agent of the initiator to the agent of the counterparty.
@@ -38,6 +73,10 @@ type MandateAmendmentRequestV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MandateAmendmentRequestV04) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MandateAmendmentRequestV04) AddGroupHeader() *model.GroupHeader47 {
 	m.GroupHeader = new(model.GroupHeader47)
 	return m.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document01100101) AddMessage() *MandateCancellationRequestV01 {
 	return d.Message
 }
 
+func (d *Document01100101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.011.001.01"
+}
+
+func (d *Document01100101) MessageDefinitionIdentifier() string {
+	return "pain.011.001.01"
+}
+
+func (d *Document01100101) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01100101) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100101) Variant() string {
+	return "001"
+}
+
+func (d *Document01100101) Version() string {
+	return "01"
+}
+
+func (d *Document01100101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The MandateCancellationRequest message is sent by the initiator of the request to his agent. The initiator can either be the debtor or the creditor.
 // The MandateCancellationRequest message is forwarded by the agent of the initiator to the agent of the counterparty.
@@ -34,6 +69,10 @@ type MandateCancellationRequestV01 struct {
 	UnderlyingCancellationDetails *model.MandateCancellation1 `xml:"UndrlygCxlDtls"`
 }
 
+func (m *MandateCancellationRequestV01) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MandateCancellationRequestV01) AddGroupHeader() *model.GroupHeader31 {
 	m.GroupHeader = new(model.GroupHeader31)
 	return m.GroupHeader
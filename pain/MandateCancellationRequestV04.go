@@ -16,6 +16,41 @@ func (d *Document01100104) AddMessage() *MandateCancellationRequestV04 {
 	return d.Message
 }
 
+func (d *Document01100104) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.011.001.04"
+}
+
+func (d *Document01100104) MessageDefinitionIdentifier() string {
+	return "pain.011.001.04"
+}
+
+func (d *Document01100104) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01100104) MessageFunctionality() string {
+	return "011"
+}
+
+func (d *Document01100104) Variant() string {
+	return "001"
+}
+
+func (d *Document01100104) Version() string {
+	return "04"
+}
+
+func (d *Document01100104) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01100104) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The MandateCancellationRequest message is sent by the initiator of the request to his agent. The initiator can either be the debtor or the creditor.
 // The MandateCancellationRequest message is forwarded by the agent of the initiator to the agent of the counterparty.
@@ -37,6 +72,10 @@ type MandateCancellationRequestV04 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MandateCancellationRequestV04) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MandateCancellationRequestV04) AddGroupHeader() *model.GroupHeader47 {
 	m.GroupHeader = new(model.GroupHeader47)
 	return m.GroupHeader
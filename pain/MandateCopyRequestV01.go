@@ -16,6 +16,41 @@ func (d *Document01700101) AddMessage() *MandateCopyRequestV01 {
 	return d.Message
 }
 
+func (d *Document01700101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.017.001.01"
+}
+
+func (d *Document01700101) MessageDefinitionIdentifier() string {
+	return "pain.017.001.01"
+}
+
+func (d *Document01700101) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01700101) MessageFunctionality() string {
+	return "017"
+}
+
+func (d *Document01700101) Variant() string {
+	return "001"
+}
+
+func (d *Document01700101) Version() string {
+	return "01"
+}
+
+func (d *Document01700101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01700101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The MandateCopyRequest message is sent by the initiator of the request to his agent. The initiator can either be the debtor or the creditor.
 // The MandateCopyRequest message is forwarded by the agent of the initiator to the agent of the counterparty.
@@ -38,6 +73,10 @@ type MandateCopyRequestV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MandateCopyRequestV01) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MandateCopyRequestV01) AddGroupHeader() *model.GroupHeader47 {
 	m.GroupHeader = new(model.GroupHeader47)
 	return m.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00900101) AddMessage() *MandateInitiationRequestV01 {
 	return d.Message
 }
 
+func (d *Document00900101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.009.001.01"
+}
+
+func (d *Document00900101) MessageDefinitionIdentifier() string {
+	return "pain.009.001.01"
+}
+
+func (d *Document00900101) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00900101) MessageFunctionality() string {
+	return "009"
+}
+
+func (d *Document00900101) Variant() string {
+	return "001"
+}
+
+func (d *Document00900101) Version() string {
+	return "01"
+}
+
+func (d *Document00900101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00900101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The MandateInitiationRequest message is sent by the initiator of the request to his agent. The initiator can either be the debtor or the creditor.
 // The MandateInitiationRequest message is forwarded by the agent of the initiator to the agent of the counterparty.
@@ -34,6 +69,10 @@ type MandateInitiationRequestV01 struct {
 	Mandate *model.MandateInformation2 `xml:"Mndt"`
 }
 
+func (m *MandateInitiationRequestV01) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MandateInitiationRequestV01) AddGroupHeader() *model.GroupHeader31 {
 	m.GroupHeader = new(model.GroupHeader31)
 	return m.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document01800101) AddMessage() *MandateSuspensionRequestV01 {
 	return d.Message
 }
 
+func (d *Document01800101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.018.001.01"
+}
+
+func (d *Document01800101) MessageDefinitionIdentifier() string {
+	return "pain.018.001.01"
+}
+
+func (d *Document01800101) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document01800101) MessageFunctionality() string {
+	return "018"
+}
+
+func (d *Document01800101) Variant() string {
+	return "001"
+}
+
+func (d *Document01800101) Version() string {
+	return "01"
+}
+
+func (d *Document01800101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document01800101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The MandateSuspensionRequest message is sent by the initiator of the request to its agent. The initiator can either be the debtor, debtor agent, creditor or creditor agent.
 // A MandateSuspensionRequest message is used to request the suspension of an existing mandate until the suspension is lifted.
@@ -36,6 +71,10 @@ type MandateSuspensionRequestV01 struct {
 	SupplementaryData []*model.SupplementaryData1 `xml:"SplmtryData,omitempty"`
 }
 
+func (m *MandateSuspensionRequestV01) Validate() error {
+	return model.ValidateElement(m)
+}
+
 func (m *MandateSuspensionRequestV01) AddGroupHeader() *model.GroupHeader47 {
 	m.GroupHeader = new(model.GroupHeader47)
 	return m.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00600101) AddMessage() *PaymentCancellationRequestV01 {
 	return d.Message
 }
 
+func (d *Document00600101) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.006.001.01"
+}
+
+func (d *Document00600101) MessageDefinitionIdentifier() string {
+	return "pain.006.001.01"
+}
+
+func (d *Document00600101) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00600101) MessageFunctionality() string {
+	return "006"
+}
+
+func (d *Document00600101) Variant() string {
+	return "001"
+}
+
+func (d *Document00600101) Version() string {
+	return "01"
+}
+
+func (d *Document00600101) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00600101) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The PaymentCancellationRequest message is sent by the initiating party or any agent, to the next party in the payment chain. It is used to request the cancellation of an instruction previously sent.
 // Usage
@@ -36,6 +71,10 @@ type PaymentCancellationRequestV01 struct {
 	TransactionInformation []*model.PaymentTransactionInformation3 `xml:"TxInf,omitempty"`
 }
 
+func (p *PaymentCancellationRequestV01) Validate() error {
+	return model.ValidateElement(p)
+}
+
 func (p *PaymentCancellationRequestV01) AddGroupHeader() *model.GroupHeader7 {
 	p.GroupHeader = new(model.GroupHeader7)
 	return p.GroupHeader
@@ -16,6 +16,41 @@ func (d *Document00200102) AddMessage() *PaymentStatusReportV02 {
 	return d.Message
 }
 
+func (d *Document00200102) Namespace() string {
+	return "urn:iso:std:iso:20022:tech:xsd:pain.002.001.02"
+}
+
+func (d *Document00200102) MessageDefinitionIdentifier() string {
+	return "pain.002.001.02"
+}
+
+func (d *Document00200102) BusinessArea() string {
+	return "pain"
+}
+
+func (d *Document00200102) MessageFunctionality() string {
+	return "002"
+}
+
+func (d *Document00200102) Variant() string {
+	return "001"
+}
+
+func (d *Document00200102) Version() string {
+	return "02"
+}
+
+func (d *Document00200102) Body() interface{} {
+	if d.Message == nil {
+		return nil
+	}
+	return d.Message
+}
+
+func (d *Document00200102) Validate() error {
+	return model.ValidateElement(d)
+}
+
 // Scope
 // The PaymentStatusReport message is sent by an instructed agent to the previous party in the payment chain. It is used to inform this party about the positive or negative status of an instruction (either single or file). It is also used to report on a pending instruction.
 // Usage
@@ -36,6 +71,10 @@ type PaymentStatusReportV02 struct {
 	TransactionInformationAndStatus []*model.PaymentTransactionInformation1 `xml:"TxInfAndSts,omitempty"`
 }
 
+func (p *PaymentStatusReportV02) Validate() error {
+	return model.ValidateElement(p)
+}
+
 func (p *PaymentStatusReportV02) AddGroupHeader() *model.GroupHeader5 {
 	p.GroupHeader = new(model.GroupHeader5)
 	return p.GroupHeader
@@ -0,0 +1,14563 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: iso20022/acmt.proto
+
+package acmtpb
+
+import (
+	modelpb "github.com/yudaprama/iso20022/proto/iso20022/modelpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+// Scope
+// The AccountAdditionalInformationRequest message is sent from a financial institution to an organisation as part of maintenance process. This message is sent in response to a request message from the organisation, if the business content is valid, but additional information is required.
+// Usage
+// This message should only be sent if additional information is required as part of the account maintenance process.
+type AccountAdditionalInformationRequestV01 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References3                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	OrganisationIdentification    []*modelpb.OrganisationIdentification6                `protobuf:"bytes,2,rep,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,3,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	AccountIdentification         *modelpb.AccountForAction1                            `protobuf:"bytes,4,opt,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature1                         `protobuf:"bytes,5,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountAdditionalInformationRequestV01) Reset() {
+	*x = AccountAdditionalInformationRequestV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountAdditionalInformationRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountAdditionalInformationRequestV01) ProtoMessage() {}
+
+func (x *AccountAdditionalInformationRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountAdditionalInformationRequestV01.ProtoReflect.Descriptor instead.
+func (*AccountAdditionalInformationRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccountAdditionalInformationRequestV01) GetReferences() *modelpb.References3 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountAdditionalInformationRequestV01) GetOrganisationIdentification() []*modelpb.OrganisationIdentification6 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountAdditionalInformationRequestV01) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountAdditionalInformationRequestV01) GetAccountIdentification() *modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountAdditionalInformationRequestV01) GetDigitalSignature() []*modelpb.PartyAndSignature1 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+// The AccountAdditionalInformationRequest message is sent from a financial institution to an organisation as part of maintenance process. This message is sent in response to a maintenance request message from the organisation, if the business content is valid, but additional information is required.
+type AccountAdditionalInformationRequestV02 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References3                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	From                          *modelpb.OrganisationIdentification8                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	OrganisationIdentification    *modelpb.OrganisationIdentification8                  `protobuf:"bytes,3,opt,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,4,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	AccountIdentification         []*modelpb.AccountForAction1                          `protobuf:"bytes,5,rep,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature2                         `protobuf:"bytes,6,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1                         `protobuf:"bytes,7,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountAdditionalInformationRequestV02) Reset() {
+	*x = AccountAdditionalInformationRequestV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountAdditionalInformationRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountAdditionalInformationRequestV02) ProtoMessage() {}
+
+func (x *AccountAdditionalInformationRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountAdditionalInformationRequestV02.ProtoReflect.Descriptor instead.
+func (*AccountAdditionalInformationRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccountAdditionalInformationRequestV02) GetReferences() *modelpb.References3 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountAdditionalInformationRequestV02) GetFrom() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AccountAdditionalInformationRequestV02) GetOrganisationIdentification() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountAdditionalInformationRequestV02) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountAdditionalInformationRequestV02) GetAccountIdentification() []*modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountAdditionalInformationRequestV02) GetDigitalSignature() []*modelpb.PartyAndSignature2 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+func (x *AccountAdditionalInformationRequestV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The AccountClosingAdditionalInformationRequest message is sent from a financial institution to an organisation as part of the account closing process.
+// Usage
+// This message is sent in response to an closing request message from the organisation, if the business content is valid, but additional information is required.
+type AccountClosingAdditionalInformationRequestV01 struct {
+	state                                 protoimpl.MessageState                                `protogen:"open.v1"`
+	References                            *modelpb.References3                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	OrganisationIdentification            []*modelpb.OrganisationIdentification6                `protobuf:"bytes,2,rep,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	AccountIdentification                 *modelpb.AccountForAction1                            `protobuf:"bytes,3,opt,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	AccountServicerIdentification         *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,4,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	BalanceTransferAccount                *modelpb.AccountForAction1                            `protobuf:"bytes,5,opt,name=balance_transfer_account,json=balanceTransferAccount,proto3" json:"balance_transfer_account,omitempty"`
+	TransferAccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,6,opt,name=transfer_account_servicer_identification,json=transferAccountServicerIdentification,proto3" json:"transfer_account_servicer_identification,omitempty"`
+	DigitalSignature                      []*modelpb.PartyAndSignature1                         `protobuf:"bytes,7,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	unknownFields                         protoimpl.UnknownFields
+	sizeCache                             protoimpl.SizeCache
+}
+
+func (x *AccountClosingAdditionalInformationRequestV01) Reset() {
+	*x = AccountClosingAdditionalInformationRequestV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountClosingAdditionalInformationRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountClosingAdditionalInformationRequestV01) ProtoMessage() {}
+
+func (x *AccountClosingAdditionalInformationRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountClosingAdditionalInformationRequestV01.ProtoReflect.Descriptor instead.
+func (*AccountClosingAdditionalInformationRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccountClosingAdditionalInformationRequestV01) GetReferences() *modelpb.References3 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountClosingAdditionalInformationRequestV01) GetOrganisationIdentification() []*modelpb.OrganisationIdentification6 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAdditionalInformationRequestV01) GetAccountIdentification() *modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAdditionalInformationRequestV01) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAdditionalInformationRequestV01) GetBalanceTransferAccount() *modelpb.AccountForAction1 {
+	if x != nil {
+		return x.BalanceTransferAccount
+	}
+	return nil
+}
+
+func (x *AccountClosingAdditionalInformationRequestV01) GetTransferAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.TransferAccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAdditionalInformationRequestV01) GetDigitalSignature() []*modelpb.PartyAndSignature1 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+// The AccountClosingAdditionalInformationRequest message is sent from a financial institution to an organisation as part of the account closing process. This message is sent in response to a closing request message from the organisation, if the business content is valid, but additional information is required.
+type AccountClosingAdditionalInformationRequestV02 struct {
+	state                                 protoimpl.MessageState                                `protogen:"open.v1"`
+	References                            *modelpb.References3                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	From                                  *modelpb.OrganisationIdentification8                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	OrganisationIdentification            *modelpb.OrganisationIdentification8                  `protobuf:"bytes,3,opt,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	AccountIdentification                 *modelpb.AccountForAction1                            `protobuf:"bytes,4,opt,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	AccountServicerIdentification         *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,5,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	BalanceTransferAccount                *modelpb.AccountForAction1                            `protobuf:"bytes,6,opt,name=balance_transfer_account,json=balanceTransferAccount,proto3" json:"balance_transfer_account,omitempty"`
+	TransferAccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,7,opt,name=transfer_account_servicer_identification,json=transferAccountServicerIdentification,proto3" json:"transfer_account_servicer_identification,omitempty"`
+	DigitalSignature                      []*modelpb.PartyAndSignature2                         `protobuf:"bytes,8,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	SupplementaryData                     []*modelpb.SupplementaryData1                         `protobuf:"bytes,9,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                         protoimpl.UnknownFields
+	sizeCache                             protoimpl.SizeCache
+}
+
+func (x *AccountClosingAdditionalInformationRequestV02) Reset() {
+	*x = AccountClosingAdditionalInformationRequestV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountClosingAdditionalInformationRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountClosingAdditionalInformationRequestV02) ProtoMessage() {}
+
+func (x *AccountClosingAdditionalInformationRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountClosingAdditionalInformationRequestV02.ProtoReflect.Descriptor instead.
+func (*AccountClosingAdditionalInformationRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AccountClosingAdditionalInformationRequestV02) GetReferences() *modelpb.References3 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountClosingAdditionalInformationRequestV02) GetFrom() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AccountClosingAdditionalInformationRequestV02) GetOrganisationIdentification() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAdditionalInformationRequestV02) GetAccountIdentification() *modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAdditionalInformationRequestV02) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAdditionalInformationRequestV02) GetBalanceTransferAccount() *modelpb.AccountForAction1 {
+	if x != nil {
+		return x.BalanceTransferAccount
+	}
+	return nil
+}
+
+func (x *AccountClosingAdditionalInformationRequestV02) GetTransferAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.TransferAccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAdditionalInformationRequestV02) GetDigitalSignature() []*modelpb.PartyAndSignature2 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+func (x *AccountClosingAdditionalInformationRequestV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The AccountClosingAmendmentRequest message is sent from an organisation to a financial institution as part of the account closing process. It is sent in response to a request from the financial institution to send additional information.
+// Usage
+// This message may only be sent in response to a request from the financial institution to send additional information.
+// It could be sent together with other related documents.
+type AccountClosingAmendmentRequestV01 struct {
+	state                                 protoimpl.MessageState                                `protogen:"open.v1"`
+	References                            *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	AccountIdentification                 *modelpb.AccountForAction1                            `protobuf:"bytes,2,opt,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	AccountServicerIdentification         *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,3,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	OrganisationIdentification            []*modelpb.OrganisationIdentification6                `protobuf:"bytes,4,rep,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	ContractDates                         *modelpb.AccountContract4                             `protobuf:"bytes,5,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	BalanceTransferAccount                *modelpb.AccountForAction1                            `protobuf:"bytes,6,opt,name=balance_transfer_account,json=balanceTransferAccount,proto3" json:"balance_transfer_account,omitempty"`
+	TransferAccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,7,opt,name=transfer_account_servicer_identification,json=transferAccountServicerIdentification,proto3" json:"transfer_account_servicer_identification,omitempty"`
+	DigitalSignature                      []*modelpb.PartyAndSignature1                         `protobuf:"bytes,8,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	unknownFields                         protoimpl.UnknownFields
+	sizeCache                             protoimpl.SizeCache
+}
+
+func (x *AccountClosingAmendmentRequestV01) Reset() {
+	*x = AccountClosingAmendmentRequestV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountClosingAmendmentRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountClosingAmendmentRequestV01) ProtoMessage() {}
+
+func (x *AccountClosingAmendmentRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountClosingAmendmentRequestV01.ProtoReflect.Descriptor instead.
+func (*AccountClosingAmendmentRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AccountClosingAmendmentRequestV01) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV01) GetAccountIdentification() *modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV01) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV01) GetOrganisationIdentification() []*modelpb.OrganisationIdentification6 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV01) GetContractDates() *modelpb.AccountContract4 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV01) GetBalanceTransferAccount() *modelpb.AccountForAction1 {
+	if x != nil {
+		return x.BalanceTransferAccount
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV01) GetTransferAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.TransferAccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV01) GetDigitalSignature() []*modelpb.PartyAndSignature1 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+// The AccountClosingAmendmentRequest message is sent from an organisation to a financial institution as part of the account closing process. It is sent in response to a request from the financial institution to send additional information.
+type AccountClosingAmendmentRequestV02 struct {
+	state                                 protoimpl.MessageState                                `protogen:"open.v1"`
+	References                            *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	From                                  *modelpb.OrganisationIdentification8                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	AccountIdentification                 *modelpb.AccountForAction1                            `protobuf:"bytes,3,opt,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	AccountServicerIdentification         *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,4,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	OrganisationIdentification            *modelpb.OrganisationIdentification8                  `protobuf:"bytes,5,opt,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	ContractDates                         *modelpb.AccountContract4                             `protobuf:"bytes,6,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	BalanceTransferAccount                *modelpb.AccountForAction1                            `protobuf:"bytes,7,opt,name=balance_transfer_account,json=balanceTransferAccount,proto3" json:"balance_transfer_account,omitempty"`
+	TransferAccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,8,opt,name=transfer_account_servicer_identification,json=transferAccountServicerIdentification,proto3" json:"transfer_account_servicer_identification,omitempty"`
+	DigitalSignature                      []*modelpb.PartyAndSignature2                         `protobuf:"bytes,9,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	SupplementaryData                     []*modelpb.SupplementaryData1                         `protobuf:"bytes,10,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                         protoimpl.UnknownFields
+	sizeCache                             protoimpl.SizeCache
+}
+
+func (x *AccountClosingAmendmentRequestV02) Reset() {
+	*x = AccountClosingAmendmentRequestV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountClosingAmendmentRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountClosingAmendmentRequestV02) ProtoMessage() {}
+
+func (x *AccountClosingAmendmentRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountClosingAmendmentRequestV02.ProtoReflect.Descriptor instead.
+func (*AccountClosingAmendmentRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AccountClosingAmendmentRequestV02) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV02) GetFrom() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV02) GetAccountIdentification() *modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV02) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV02) GetOrganisationIdentification() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV02) GetContractDates() *modelpb.AccountContract4 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV02) GetBalanceTransferAccount() *modelpb.AccountForAction1 {
+	if x != nil {
+		return x.BalanceTransferAccount
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV02) GetTransferAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.TransferAccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV02) GetDigitalSignature() []*modelpb.PartyAndSignature2 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+func (x *AccountClosingAmendmentRequestV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The AccountClosingRequest message is sent from an organisation to a financial institution as part of the account closing process. It is the initial request message to close an account.
+// Usage
+// This message is the initial request message to close an account.
+// It could be sent together with other related documents.
+type AccountClosingRequestV01 struct {
+	state                                 protoimpl.MessageState                                `protogen:"open.v1"`
+	References                            *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	AccountIdentification                 *modelpb.AccountForAction1                            `protobuf:"bytes,2,opt,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	AccountServicerIdentification         *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,3,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	OrganisationIdentification            []*modelpb.OrganisationIdentification6                `protobuf:"bytes,4,rep,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	ContractDates                         *modelpb.AccountContract4                             `protobuf:"bytes,5,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	BalanceTransferAccount                *modelpb.AccountForAction1                            `protobuf:"bytes,6,opt,name=balance_transfer_account,json=balanceTransferAccount,proto3" json:"balance_transfer_account,omitempty"`
+	TransferAccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,7,opt,name=transfer_account_servicer_identification,json=transferAccountServicerIdentification,proto3" json:"transfer_account_servicer_identification,omitempty"`
+	DigitalSignature                      []*modelpb.PartyAndSignature1                         `protobuf:"bytes,8,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	unknownFields                         protoimpl.UnknownFields
+	sizeCache                             protoimpl.SizeCache
+}
+
+func (x *AccountClosingRequestV01) Reset() {
+	*x = AccountClosingRequestV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountClosingRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountClosingRequestV01) ProtoMessage() {}
+
+func (x *AccountClosingRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountClosingRequestV01.ProtoReflect.Descriptor instead.
+func (*AccountClosingRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AccountClosingRequestV01) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV01) GetAccountIdentification() *modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV01) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV01) GetOrganisationIdentification() []*modelpb.OrganisationIdentification6 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV01) GetContractDates() *modelpb.AccountContract4 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV01) GetBalanceTransferAccount() *modelpb.AccountForAction1 {
+	if x != nil {
+		return x.BalanceTransferAccount
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV01) GetTransferAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.TransferAccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV01) GetDigitalSignature() []*modelpb.PartyAndSignature1 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+// The AccountClosingRequest message is sent from an organisation to a financial institution as part of the account closing process. It is the initial request message to close an account.
+type AccountClosingRequestV02 struct {
+	state                                 protoimpl.MessageState                                `protogen:"open.v1"`
+	References                            *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	From                                  *modelpb.OrganisationIdentification8                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	AccountIdentification                 *modelpb.AccountForAction2                            `protobuf:"bytes,3,opt,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	AccountServicerIdentification         *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,4,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	OrganisationIdentification            *modelpb.Organisation14                               `protobuf:"bytes,5,opt,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	ContractDates                         *modelpb.AccountContract4                             `protobuf:"bytes,6,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	BalanceTransferAccount                *modelpb.AccountForAction1                            `protobuf:"bytes,7,opt,name=balance_transfer_account,json=balanceTransferAccount,proto3" json:"balance_transfer_account,omitempty"`
+	TransferAccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,8,opt,name=transfer_account_servicer_identification,json=transferAccountServicerIdentification,proto3" json:"transfer_account_servicer_identification,omitempty"`
+	DigitalSignature                      []*modelpb.PartyAndSignature2                         `protobuf:"bytes,9,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	SupplementaryData                     []*modelpb.SupplementaryData1                         `protobuf:"bytes,10,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                         protoimpl.UnknownFields
+	sizeCache                             protoimpl.SizeCache
+}
+
+func (x *AccountClosingRequestV02) Reset() {
+	*x = AccountClosingRequestV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountClosingRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountClosingRequestV02) ProtoMessage() {}
+
+func (x *AccountClosingRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountClosingRequestV02.ProtoReflect.Descriptor instead.
+func (*AccountClosingRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AccountClosingRequestV02) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV02) GetFrom() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV02) GetAccountIdentification() *modelpb.AccountForAction2 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV02) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV02) GetOrganisationIdentification() *modelpb.Organisation14 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV02) GetContractDates() *modelpb.AccountContract4 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV02) GetBalanceTransferAccount() *modelpb.AccountForAction1 {
+	if x != nil {
+		return x.BalanceTransferAccount
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV02) GetTransferAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.TransferAccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV02) GetDigitalSignature() []*modelpb.PartyAndSignature2 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+func (x *AccountClosingRequestV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// An account servicer, eg, a registrar, transfer agent or custodian bank sends the AccountDetailsConfirmation message to an account owner, eg, an investor to confirm the opening of an investment fund account, execution of an AccountModificationInstruction or to return information requested in a GetAccountDetails message.
+// Usage
+// The AccountDetailsConfirmation message is used to confirm the opening of an account, modification of an account or the provision of information requested in a previously sent GetAccountDetails message. The message contains detailed information relevant to the opened account.
+// When the AccountDetailsConfirmation is used to confirm execution of an AccountModificationInstruction message, it contains the modified subsets of account details that were specified in the AccountModificationInstruction.
+// When the AccountDetailsConfirmation is used to reply to a GetAccountDetails message, it returns the selected subsets of account details that were specified in the GetAccountDetails message.
+type AccountDetailsConfirmationV02 struct {
+	state                    protoimpl.MessageState                            `protogen:"open.v1"`
+	MessageIdentification    *modelpb.MessageIdentification1                   `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	OrderReference           *modelpb.InvestmentFundOrder4                     `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	RelatedReference         *modelpb.AdditionalReference3                     `protobuf:"bytes,3,opt,name=related_reference,json=relatedReference,proto3" json:"related_reference,omitempty"`
+	ConfirmationDetails      *modelpb.AccountManagementConfirmation1           `protobuf:"bytes,4,opt,name=confirmation_details,json=confirmationDetails,proto3" json:"confirmation_details,omitempty"`
+	InvestmentAccount        *modelpb.InvestmentAccount27                      `protobuf:"bytes,5,opt,name=investment_account,json=investmentAccount,proto3" json:"investment_account,omitempty"`
+	AccountParties           *modelpb.AccountParties5                          `protobuf:"bytes,6,opt,name=account_parties,json=accountParties,proto3" json:"account_parties,omitempty"`
+	Intermediaries           []*modelpb.Intermediary12                         `protobuf:"bytes,7,rep,name=intermediaries,proto3" json:"intermediaries,omitempty"`
+	Placement                *modelpb.ReferredAgent1                           `protobuf:"bytes,8,opt,name=placement,proto3" json:"placement,omitempty"`
+	NewIssueAllocation       *modelpb.NewIssueAllocation1                      `protobuf:"bytes,9,opt,name=new_issue_allocation,json=newIssueAllocation,proto3" json:"new_issue_allocation,omitempty"`
+	SavingsInvestmentPlan    []*modelpb.InvestmentPlan4                        `protobuf:"bytes,10,rep,name=savings_investment_plan,json=savingsInvestmentPlan,proto3" json:"savings_investment_plan,omitempty"`
+	WithdrawalInvestmentPlan []*modelpb.InvestmentPlan4                        `protobuf:"bytes,11,rep,name=withdrawal_investment_plan,json=withdrawalInvestmentPlan,proto3" json:"withdrawal_investment_plan,omitempty"`
+	CashSettlement           *modelpb.InvestmentFundCashSettlementInformation3 `protobuf:"bytes,12,opt,name=cash_settlement,json=cashSettlement,proto3" json:"cash_settlement,omitempty"`
+	ServiceLevelAgreement    []*modelpb.DocumentToSend1                        `protobuf:"bytes,13,rep,name=service_level_agreement,json=serviceLevelAgreement,proto3" json:"service_level_agreement,omitempty"`
+	Extension                []*modelpb.Extension1                             `protobuf:"bytes,14,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AccountDetailsConfirmationV02) Reset() {
+	*x = AccountDetailsConfirmationV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountDetailsConfirmationV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountDetailsConfirmationV02) ProtoMessage() {}
+
+func (x *AccountDetailsConfirmationV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountDetailsConfirmationV02.ProtoReflect.Descriptor instead.
+func (*AccountDetailsConfirmationV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AccountDetailsConfirmationV02) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV02) GetOrderReference() *modelpb.InvestmentFundOrder4 {
+	if x != nil {
+		return x.OrderReference
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV02) GetRelatedReference() *modelpb.AdditionalReference3 {
+	if x != nil {
+		return x.RelatedReference
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV02) GetConfirmationDetails() *modelpb.AccountManagementConfirmation1 {
+	if x != nil {
+		return x.ConfirmationDetails
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV02) GetInvestmentAccount() *modelpb.InvestmentAccount27 {
+	if x != nil {
+		return x.InvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV02) GetAccountParties() *modelpb.AccountParties5 {
+	if x != nil {
+		return x.AccountParties
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV02) GetIntermediaries() []*modelpb.Intermediary12 {
+	if x != nil {
+		return x.Intermediaries
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV02) GetPlacement() *modelpb.ReferredAgent1 {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV02) GetNewIssueAllocation() *modelpb.NewIssueAllocation1 {
+	if x != nil {
+		return x.NewIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV02) GetSavingsInvestmentPlan() []*modelpb.InvestmentPlan4 {
+	if x != nil {
+		return x.SavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV02) GetWithdrawalInvestmentPlan() []*modelpb.InvestmentPlan4 {
+	if x != nil {
+		return x.WithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV02) GetCashSettlement() *modelpb.InvestmentFundCashSettlementInformation3 {
+	if x != nil {
+		return x.CashSettlement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV02) GetServiceLevelAgreement() []*modelpb.DocumentToSend1 {
+	if x != nil {
+		return x.ServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV02) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// An account servicer, for example, a registrar, transfer agent or custodian bank sends the AccountDetailsConfirmation message to the account owner, for example, an investor to confirm the opening of an investment fund account, execution of an AccountModificationInstruction or to return information requested in a GetAccountDetails message.
+// Usage
+// The AccountDetailsConfirmation message is used to confirm the opening of an account, modification of an account or the provision of information requested in a previously sent GetAccountDetails message. The message contains detailed information relevant to the opened account.
+// When the AccountDetailsConfirmation is used to confirm execution of an AccountModificationInstruction message, it contains the modified subsets of account details that were specified in the AccountModificationInstruction.
+// When the AccountDetailsConfirmation is used to reply to a GetAccountDetails message, it returns the selected subsets of account details that were specified in the GetAccountDetails message.
+type AccountDetailsConfirmationV03 struct {
+	state                    protoimpl.MessageState                              `protogen:"open.v1"`
+	MessageIdentification    *modelpb.MessageIdentification1                     `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	OrderReference           *modelpb.InvestmentFundOrder4                       `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	RelatedReference         *modelpb.AdditionalReference3                       `protobuf:"bytes,3,opt,name=related_reference,json=relatedReference,proto3" json:"related_reference,omitempty"`
+	ConfirmationDetails      *modelpb.AccountManagementConfirmation1             `protobuf:"bytes,4,opt,name=confirmation_details,json=confirmationDetails,proto3" json:"confirmation_details,omitempty"`
+	InvestmentAccount        *modelpb.InvestmentAccount35                        `protobuf:"bytes,5,opt,name=investment_account,json=investmentAccount,proto3" json:"investment_account,omitempty"`
+	AccountParties           *modelpb.AccountParties6                            `protobuf:"bytes,6,opt,name=account_parties,json=accountParties,proto3" json:"account_parties,omitempty"`
+	Intermediaries           []*modelpb.Intermediary12                           `protobuf:"bytes,7,rep,name=intermediaries,proto3" json:"intermediaries,omitempty"`
+	Placement                *modelpb.ReferredAgent1                             `protobuf:"bytes,8,opt,name=placement,proto3" json:"placement,omitempty"`
+	NewIssueAllocation       *modelpb.NewIssueAllocation1                        `protobuf:"bytes,9,opt,name=new_issue_allocation,json=newIssueAllocation,proto3" json:"new_issue_allocation,omitempty"`
+	SavingsInvestmentPlan    []*modelpb.InvestmentPlan6                          `protobuf:"bytes,10,rep,name=savings_investment_plan,json=savingsInvestmentPlan,proto3" json:"savings_investment_plan,omitempty"`
+	WithdrawalInvestmentPlan []*modelpb.InvestmentPlan6                          `protobuf:"bytes,11,rep,name=withdrawal_investment_plan,json=withdrawalInvestmentPlan,proto3" json:"withdrawal_investment_plan,omitempty"`
+	CashSettlement           []*modelpb.InvestmentFundCashSettlementInformation5 `protobuf:"bytes,12,rep,name=cash_settlement,json=cashSettlement,proto3" json:"cash_settlement,omitempty"`
+	ServiceLevelAgreement    []*modelpb.DocumentToSend1                          `protobuf:"bytes,13,rep,name=service_level_agreement,json=serviceLevelAgreement,proto3" json:"service_level_agreement,omitempty"`
+	Extension                []*modelpb.Extension1                               `protobuf:"bytes,14,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AccountDetailsConfirmationV03) Reset() {
+	*x = AccountDetailsConfirmationV03{}
+	mi := &file_iso20022_acmt_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountDetailsConfirmationV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountDetailsConfirmationV03) ProtoMessage() {}
+
+func (x *AccountDetailsConfirmationV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountDetailsConfirmationV03.ProtoReflect.Descriptor instead.
+func (*AccountDetailsConfirmationV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AccountDetailsConfirmationV03) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV03) GetOrderReference() *modelpb.InvestmentFundOrder4 {
+	if x != nil {
+		return x.OrderReference
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV03) GetRelatedReference() *modelpb.AdditionalReference3 {
+	if x != nil {
+		return x.RelatedReference
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV03) GetConfirmationDetails() *modelpb.AccountManagementConfirmation1 {
+	if x != nil {
+		return x.ConfirmationDetails
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV03) GetInvestmentAccount() *modelpb.InvestmentAccount35 {
+	if x != nil {
+		return x.InvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV03) GetAccountParties() *modelpb.AccountParties6 {
+	if x != nil {
+		return x.AccountParties
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV03) GetIntermediaries() []*modelpb.Intermediary12 {
+	if x != nil {
+		return x.Intermediaries
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV03) GetPlacement() *modelpb.ReferredAgent1 {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV03) GetNewIssueAllocation() *modelpb.NewIssueAllocation1 {
+	if x != nil {
+		return x.NewIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV03) GetSavingsInvestmentPlan() []*modelpb.InvestmentPlan6 {
+	if x != nil {
+		return x.SavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV03) GetWithdrawalInvestmentPlan() []*modelpb.InvestmentPlan6 {
+	if x != nil {
+		return x.WithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV03) GetCashSettlement() []*modelpb.InvestmentFundCashSettlementInformation5 {
+	if x != nil {
+		return x.CashSettlement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV03) GetServiceLevelAgreement() []*modelpb.DocumentToSend1 {
+	if x != nil {
+		return x.ServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV03) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// An account servicer, for example, a registrar, transfer agent or custodian bank sends the AccountDetailsConfirmation message to the account owner, for example, an investor to confirm the opening of an investment fund account, execution of an AccountModificationInstruction or to return information requested in a GetAccountDetails message.
+// Usage
+// The AccountDetailsConfirmation message is used to confirm the opening of an account, modification of an account or the provision of information requested in a previously sent GetAccountDetails message. The message contains detailed information relevant to the opened account.
+// When the AccountDetailsConfirmation is used to confirm execution of an AccountModificationInstruction message, it contains the modified subsets of account details that were specified in the AccountModificationInstruction.
+// When the AccountDetailsConfirmation is used to reply to a GetAccountDetails message, it returns the selected subsets of account details that were specified in the GetAccountDetails message.
+type AccountDetailsConfirmationV04 struct {
+	state                    protoimpl.MessageState                              `protogen:"open.v1"`
+	MessageIdentification    *modelpb.MessageIdentification1                     `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	OrderReference           *modelpb.InvestmentFundOrder4                       `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	RelatedReference         *modelpb.AdditionalReference3                       `protobuf:"bytes,3,opt,name=related_reference,json=relatedReference,proto3" json:"related_reference,omitempty"`
+	ConfirmationDetails      *modelpb.AccountManagementConfirmation1             `protobuf:"bytes,4,opt,name=confirmation_details,json=confirmationDetails,proto3" json:"confirmation_details,omitempty"`
+	InvestmentAccount        *modelpb.InvestmentAccount38                        `protobuf:"bytes,5,opt,name=investment_account,json=investmentAccount,proto3" json:"investment_account,omitempty"`
+	AccountParties           *modelpb.AccountParties9                            `protobuf:"bytes,6,opt,name=account_parties,json=accountParties,proto3" json:"account_parties,omitempty"`
+	Intermediaries           []*modelpb.Intermediary24                           `protobuf:"bytes,7,rep,name=intermediaries,proto3" json:"intermediaries,omitempty"`
+	Placement                *modelpb.ReferredAgent1                             `protobuf:"bytes,8,opt,name=placement,proto3" json:"placement,omitempty"`
+	NewIssueAllocation       *modelpb.NewIssueAllocation1                        `protobuf:"bytes,9,opt,name=new_issue_allocation,json=newIssueAllocation,proto3" json:"new_issue_allocation,omitempty"`
+	SavingsInvestmentPlan    []*modelpb.InvestmentPlan9                          `protobuf:"bytes,10,rep,name=savings_investment_plan,json=savingsInvestmentPlan,proto3" json:"savings_investment_plan,omitempty"`
+	WithdrawalInvestmentPlan []*modelpb.InvestmentPlan9                          `protobuf:"bytes,11,rep,name=withdrawal_investment_plan,json=withdrawalInvestmentPlan,proto3" json:"withdrawal_investment_plan,omitempty"`
+	CashSettlement           []*modelpb.InvestmentFundCashSettlementInformation7 `protobuf:"bytes,12,rep,name=cash_settlement,json=cashSettlement,proto3" json:"cash_settlement,omitempty"`
+	ServiceLevelAgreement    []*modelpb.DocumentToSend1                          `protobuf:"bytes,13,rep,name=service_level_agreement,json=serviceLevelAgreement,proto3" json:"service_level_agreement,omitempty"`
+	MarketPracticeVersion    *modelpb.MarketPracticeVersion1                     `protobuf:"bytes,14,opt,name=market_practice_version,json=marketPracticeVersion,proto3" json:"market_practice_version,omitempty"`
+	Extension                []*modelpb.Extension1                               `protobuf:"bytes,15,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AccountDetailsConfirmationV04) Reset() {
+	*x = AccountDetailsConfirmationV04{}
+	mi := &file_iso20022_acmt_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountDetailsConfirmationV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountDetailsConfirmationV04) ProtoMessage() {}
+
+func (x *AccountDetailsConfirmationV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountDetailsConfirmationV04.ProtoReflect.Descriptor instead.
+func (*AccountDetailsConfirmationV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AccountDetailsConfirmationV04) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV04) GetOrderReference() *modelpb.InvestmentFundOrder4 {
+	if x != nil {
+		return x.OrderReference
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV04) GetRelatedReference() *modelpb.AdditionalReference3 {
+	if x != nil {
+		return x.RelatedReference
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV04) GetConfirmationDetails() *modelpb.AccountManagementConfirmation1 {
+	if x != nil {
+		return x.ConfirmationDetails
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV04) GetInvestmentAccount() *modelpb.InvestmentAccount38 {
+	if x != nil {
+		return x.InvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV04) GetAccountParties() *modelpb.AccountParties9 {
+	if x != nil {
+		return x.AccountParties
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV04) GetIntermediaries() []*modelpb.Intermediary24 {
+	if x != nil {
+		return x.Intermediaries
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV04) GetPlacement() *modelpb.ReferredAgent1 {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV04) GetNewIssueAllocation() *modelpb.NewIssueAllocation1 {
+	if x != nil {
+		return x.NewIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV04) GetSavingsInvestmentPlan() []*modelpb.InvestmentPlan9 {
+	if x != nil {
+		return x.SavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV04) GetWithdrawalInvestmentPlan() []*modelpb.InvestmentPlan9 {
+	if x != nil {
+		return x.WithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV04) GetCashSettlement() []*modelpb.InvestmentFundCashSettlementInformation7 {
+	if x != nil {
+		return x.CashSettlement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV04) GetServiceLevelAgreement() []*modelpb.DocumentToSend1 {
+	if x != nil {
+		return x.ServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV04) GetMarketPracticeVersion() *modelpb.MarketPracticeVersion1 {
+	if x != nil {
+		return x.MarketPracticeVersion
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV04) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// An account servicer, for example, a registrar, transfer agent or custodian bank sends the AccountDetailsConfirmation message to the account owner, for example, an investor to confirm the opening of an investment fund account, execution of an AccountModificationInstruction or to return information requested in a GetAccountDetails message.
+// Usage
+// The AccountDetailsConfirmation message is used to confirm the opening of an account, modification of an account or the provision of information requested in a previously sent GetAccountDetails message. The message contains detailed information relevant to the opened account.
+// When the AccountDetailsConfirmation is used to confirm execution of an AccountModificationInstruction message, it contains the modified subsets of account details that were specified in the AccountModificationInstruction.
+// When the AccountDetailsConfirmation is used to reply to a GetAccountDetails message, it returns the selected subsets of account details that were specified in the GetAccountDetails message.
+type AccountDetailsConfirmationV05 struct {
+	state                    protoimpl.MessageState                              `protogen:"open.v1"`
+	MessageIdentification    *modelpb.MessageIdentification1                     `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	OrderReference           *modelpb.InvestmentFundOrder4                       `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	RelatedReference         *modelpb.AdditionalReference3                       `protobuf:"bytes,3,opt,name=related_reference,json=relatedReference,proto3" json:"related_reference,omitempty"`
+	ConfirmationDetails      *modelpb.AccountManagementConfirmation2             `protobuf:"bytes,4,opt,name=confirmation_details,json=confirmationDetails,proto3" json:"confirmation_details,omitempty"`
+	InvestmentAccount        *modelpb.InvestmentAccount46                        `protobuf:"bytes,5,opt,name=investment_account,json=investmentAccount,proto3" json:"investment_account,omitempty"`
+	AccountParties           *modelpb.AccountParties12                           `protobuf:"bytes,6,opt,name=account_parties,json=accountParties,proto3" json:"account_parties,omitempty"`
+	Intermediaries           []*modelpb.Intermediary24                           `protobuf:"bytes,7,rep,name=intermediaries,proto3" json:"intermediaries,omitempty"`
+	Placement                *modelpb.ReferredAgent1                             `protobuf:"bytes,8,opt,name=placement,proto3" json:"placement,omitempty"`
+	NewIssueAllocation       *modelpb.NewIssueAllocation2                        `protobuf:"bytes,9,opt,name=new_issue_allocation,json=newIssueAllocation,proto3" json:"new_issue_allocation,omitempty"`
+	SavingsInvestmentPlan    []*modelpb.InvestmentPlan10                         `protobuf:"bytes,10,rep,name=savings_investment_plan,json=savingsInvestmentPlan,proto3" json:"savings_investment_plan,omitempty"`
+	WithdrawalInvestmentPlan []*modelpb.InvestmentPlan10                         `protobuf:"bytes,11,rep,name=withdrawal_investment_plan,json=withdrawalInvestmentPlan,proto3" json:"withdrawal_investment_plan,omitempty"`
+	CashSettlement           []*modelpb.InvestmentFundCashSettlementInformation7 `protobuf:"bytes,12,rep,name=cash_settlement,json=cashSettlement,proto3" json:"cash_settlement,omitempty"`
+	ServiceLevelAgreement    []*modelpb.DocumentToSend2                          `protobuf:"bytes,13,rep,name=service_level_agreement,json=serviceLevelAgreement,proto3" json:"service_level_agreement,omitempty"`
+	MarketPracticeVersion    *modelpb.MarketPracticeVersion1                     `protobuf:"bytes,14,opt,name=market_practice_version,json=marketPracticeVersion,proto3" json:"market_practice_version,omitempty"`
+	Extension                []*modelpb.Extension1                               `protobuf:"bytes,15,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AccountDetailsConfirmationV05) Reset() {
+	*x = AccountDetailsConfirmationV05{}
+	mi := &file_iso20022_acmt_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountDetailsConfirmationV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountDetailsConfirmationV05) ProtoMessage() {}
+
+func (x *AccountDetailsConfirmationV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountDetailsConfirmationV05.ProtoReflect.Descriptor instead.
+func (*AccountDetailsConfirmationV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AccountDetailsConfirmationV05) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV05) GetOrderReference() *modelpb.InvestmentFundOrder4 {
+	if x != nil {
+		return x.OrderReference
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV05) GetRelatedReference() *modelpb.AdditionalReference3 {
+	if x != nil {
+		return x.RelatedReference
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV05) GetConfirmationDetails() *modelpb.AccountManagementConfirmation2 {
+	if x != nil {
+		return x.ConfirmationDetails
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV05) GetInvestmentAccount() *modelpb.InvestmentAccount46 {
+	if x != nil {
+		return x.InvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV05) GetAccountParties() *modelpb.AccountParties12 {
+	if x != nil {
+		return x.AccountParties
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV05) GetIntermediaries() []*modelpb.Intermediary24 {
+	if x != nil {
+		return x.Intermediaries
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV05) GetPlacement() *modelpb.ReferredAgent1 {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV05) GetNewIssueAllocation() *modelpb.NewIssueAllocation2 {
+	if x != nil {
+		return x.NewIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV05) GetSavingsInvestmentPlan() []*modelpb.InvestmentPlan10 {
+	if x != nil {
+		return x.SavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV05) GetWithdrawalInvestmentPlan() []*modelpb.InvestmentPlan10 {
+	if x != nil {
+		return x.WithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV05) GetCashSettlement() []*modelpb.InvestmentFundCashSettlementInformation7 {
+	if x != nil {
+		return x.CashSettlement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV05) GetServiceLevelAgreement() []*modelpb.DocumentToSend2 {
+	if x != nil {
+		return x.ServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV05) GetMarketPracticeVersion() *modelpb.MarketPracticeVersion1 {
+	if x != nil {
+		return x.MarketPracticeVersion
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV05) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// An account servicer, for example, a registrar, transfer agent, custodian bank or securities depository  sends the AccountDetailsConfirmation message to the account owner, for example, an investor to confirm the opening of an account, execution of an AccountModificationInstruction or to return information requested in a GetAccountDetails message.
+// Usage
+// The AccountDetailsConfirmation message is used to confirm the opening of an account, modification of an account or the provision of information requested in a previously sent GetAccountDetails message. The message contains detailed information relevant to the opened account.
+// When the AccountDetailsConfirmation is used to confirm execution of an AccountModificationInstruction message, it contains the modified subsets of account details that were specified in the AccountModificationInstruction.
+// When the AccountDetailsConfirmation is used to reply to a GetAccountDetails message, it returns the selected subsets of account details that were specified in the GetAccountDetails message.
+type AccountDetailsConfirmationV06 struct {
+	state                    protoimpl.MessageState                  `protogen:"open.v1"`
+	MessageIdentification    *modelpb.MessageIdentification1         `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	OrderReference           *modelpb.InvestmentFundOrder4           `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	RelatedReference         *modelpb.AdditionalReference6           `protobuf:"bytes,3,opt,name=related_reference,json=relatedReference,proto3" json:"related_reference,omitempty"`
+	ConfirmationDetails      *modelpb.AccountManagementConfirmation3 `protobuf:"bytes,4,opt,name=confirmation_details,json=confirmationDetails,proto3" json:"confirmation_details,omitempty"`
+	InvestmentAccount        *modelpb.InvestmentAccount50            `protobuf:"bytes,5,opt,name=investment_account,json=investmentAccount,proto3" json:"investment_account,omitempty"`
+	AccountParties           *modelpb.AccountParties13               `protobuf:"bytes,6,opt,name=account_parties,json=accountParties,proto3" json:"account_parties,omitempty"`
+	Intermediaries           []*modelpb.Intermediary36               `protobuf:"bytes,7,rep,name=intermediaries,proto3" json:"intermediaries,omitempty"`
+	Placement                *modelpb.ReferredAgent2                 `protobuf:"bytes,8,opt,name=placement,proto3" json:"placement,omitempty"`
+	NewIssueAllocation       *modelpb.NewIssueAllocation2            `protobuf:"bytes,9,opt,name=new_issue_allocation,json=newIssueAllocation,proto3" json:"new_issue_allocation,omitempty"`
+	SavingsInvestmentPlan    []*modelpb.InvestmentPlan12             `protobuf:"bytes,10,rep,name=savings_investment_plan,json=savingsInvestmentPlan,proto3" json:"savings_investment_plan,omitempty"`
+	WithdrawalInvestmentPlan []*modelpb.InvestmentPlan12             `protobuf:"bytes,11,rep,name=withdrawal_investment_plan,json=withdrawalInvestmentPlan,proto3" json:"withdrawal_investment_plan,omitempty"`
+	CashSettlement           []*modelpb.CashSettlement1              `protobuf:"bytes,12,rep,name=cash_settlement,json=cashSettlement,proto3" json:"cash_settlement,omitempty"`
+	ServiceLevelAgreement    []*modelpb.DocumentToSend3              `protobuf:"bytes,13,rep,name=service_level_agreement,json=serviceLevelAgreement,proto3" json:"service_level_agreement,omitempty"`
+	AdditionalInformation    []*modelpb.AccountRestrictions1         `protobuf:"bytes,14,rep,name=additional_information,json=additionalInformation,proto3" json:"additional_information,omitempty"`
+	MarketPracticeVersion    *modelpb.MarketPracticeVersion1         `protobuf:"bytes,15,opt,name=market_practice_version,json=marketPracticeVersion,proto3" json:"market_practice_version,omitempty"`
+	Extension                []*modelpb.Extension1                   `protobuf:"bytes,16,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AccountDetailsConfirmationV06) Reset() {
+	*x = AccountDetailsConfirmationV06{}
+	mi := &file_iso20022_acmt_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountDetailsConfirmationV06) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountDetailsConfirmationV06) ProtoMessage() {}
+
+func (x *AccountDetailsConfirmationV06) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountDetailsConfirmationV06.ProtoReflect.Descriptor instead.
+func (*AccountDetailsConfirmationV06) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AccountDetailsConfirmationV06) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV06) GetOrderReference() *modelpb.InvestmentFundOrder4 {
+	if x != nil {
+		return x.OrderReference
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV06) GetRelatedReference() *modelpb.AdditionalReference6 {
+	if x != nil {
+		return x.RelatedReference
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV06) GetConfirmationDetails() *modelpb.AccountManagementConfirmation3 {
+	if x != nil {
+		return x.ConfirmationDetails
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV06) GetInvestmentAccount() *modelpb.InvestmentAccount50 {
+	if x != nil {
+		return x.InvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV06) GetAccountParties() *modelpb.AccountParties13 {
+	if x != nil {
+		return x.AccountParties
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV06) GetIntermediaries() []*modelpb.Intermediary36 {
+	if x != nil {
+		return x.Intermediaries
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV06) GetPlacement() *modelpb.ReferredAgent2 {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV06) GetNewIssueAllocation() *modelpb.NewIssueAllocation2 {
+	if x != nil {
+		return x.NewIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV06) GetSavingsInvestmentPlan() []*modelpb.InvestmentPlan12 {
+	if x != nil {
+		return x.SavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV06) GetWithdrawalInvestmentPlan() []*modelpb.InvestmentPlan12 {
+	if x != nil {
+		return x.WithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV06) GetCashSettlement() []*modelpb.CashSettlement1 {
+	if x != nil {
+		return x.CashSettlement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV06) GetServiceLevelAgreement() []*modelpb.DocumentToSend3 {
+	if x != nil {
+		return x.ServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV06) GetAdditionalInformation() []*modelpb.AccountRestrictions1 {
+	if x != nil {
+		return x.AdditionalInformation
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV06) GetMarketPracticeVersion() *modelpb.MarketPracticeVersion1 {
+	if x != nil {
+		return x.MarketPracticeVersion
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV06) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// The AccountDetailsConfirmation message is sent by an account servicer, for example, a registrar, transfer agent, custodian bank or securities depository to the account owner, for example, an investor to confirm the opening of an account, execution of an AccountModificationInstruction or to return information requested in a GetAccountDetails message.
+// Usage
+// The AccountDetailsConfirmation message is used to confirm the opening of an account, modification of an account or the provision of information requested in a previously sent GetAccountDetails message. The message contains detailed information relevant to the opened account.
+// When the AccountDetailsConfirmation is used to confirm execution of an AccountModificationInstruction message, it may:
+// - contain the modified subsets of account details that were specified in the AccountModificationInstruction, and/or
+// - provide the status of the account.
+// When the AccountModificationInstruction message is used to instruct the closure of an account, the AccountDetailsConfirmation message is used to confirm the account has been closed.
+// When the AccountDetailsConfirmation is used to reply to a GetAccountDetails message, it returns the selected subsets of account details that were specified in the GetAccountDetails message.
+type AccountDetailsConfirmationV07 struct {
+	state                    protoimpl.MessageState                  `protogen:"open.v1"`
+	MessageIdentification    *modelpb.MessageIdentification1         `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	OrderReference           *modelpb.InvestmentFundOrder4           `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	RelatedReference         *modelpb.AdditionalReference6           `protobuf:"bytes,3,opt,name=related_reference,json=relatedReference,proto3" json:"related_reference,omitempty"`
+	ConfirmationDetails      *modelpb.AccountManagementConfirmation4 `protobuf:"bytes,4,opt,name=confirmation_details,json=confirmationDetails,proto3" json:"confirmation_details,omitempty"`
+	InvestmentAccount        *modelpb.InvestmentAccount62            `protobuf:"bytes,5,opt,name=investment_account,json=investmentAccount,proto3" json:"investment_account,omitempty"`
+	AccountParties           *modelpb.AccountParties15               `protobuf:"bytes,6,opt,name=account_parties,json=accountParties,proto3" json:"account_parties,omitempty"`
+	Intermediaries           []*modelpb.Intermediary36               `protobuf:"bytes,7,rep,name=intermediaries,proto3" json:"intermediaries,omitempty"`
+	Placement                *modelpb.ReferredAgent2                 `protobuf:"bytes,8,opt,name=placement,proto3" json:"placement,omitempty"`
+	NewIssueAllocation       *modelpb.NewIssueAllocation2            `protobuf:"bytes,9,opt,name=new_issue_allocation,json=newIssueAllocation,proto3" json:"new_issue_allocation,omitempty"`
+	SavingsInvestmentPlan    []*modelpb.InvestmentPlan14             `protobuf:"bytes,10,rep,name=savings_investment_plan,json=savingsInvestmentPlan,proto3" json:"savings_investment_plan,omitempty"`
+	WithdrawalInvestmentPlan []*modelpb.InvestmentPlan14             `protobuf:"bytes,11,rep,name=withdrawal_investment_plan,json=withdrawalInvestmentPlan,proto3" json:"withdrawal_investment_plan,omitempty"`
+	CashSettlement           []*modelpb.CashSettlement1              `protobuf:"bytes,12,rep,name=cash_settlement,json=cashSettlement,proto3" json:"cash_settlement,omitempty"`
+	ServiceLevelAgreement    []*modelpb.DocumentToSend3              `protobuf:"bytes,13,rep,name=service_level_agreement,json=serviceLevelAgreement,proto3" json:"service_level_agreement,omitempty"`
+	AdditionalInformation    []*modelpb.AdditiononalInformation12    `protobuf:"bytes,14,rep,name=additional_information,json=additionalInformation,proto3" json:"additional_information,omitempty"`
+	MarketPracticeVersion    *modelpb.MarketPracticeVersion1         `protobuf:"bytes,15,opt,name=market_practice_version,json=marketPracticeVersion,proto3" json:"market_practice_version,omitempty"`
+	Extension                []*modelpb.Extension1                   `protobuf:"bytes,16,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AccountDetailsConfirmationV07) Reset() {
+	*x = AccountDetailsConfirmationV07{}
+	mi := &file_iso20022_acmt_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountDetailsConfirmationV07) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountDetailsConfirmationV07) ProtoMessage() {}
+
+func (x *AccountDetailsConfirmationV07) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountDetailsConfirmationV07.ProtoReflect.Descriptor instead.
+func (*AccountDetailsConfirmationV07) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AccountDetailsConfirmationV07) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV07) GetOrderReference() *modelpb.InvestmentFundOrder4 {
+	if x != nil {
+		return x.OrderReference
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV07) GetRelatedReference() *modelpb.AdditionalReference6 {
+	if x != nil {
+		return x.RelatedReference
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV07) GetConfirmationDetails() *modelpb.AccountManagementConfirmation4 {
+	if x != nil {
+		return x.ConfirmationDetails
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV07) GetInvestmentAccount() *modelpb.InvestmentAccount62 {
+	if x != nil {
+		return x.InvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV07) GetAccountParties() *modelpb.AccountParties15 {
+	if x != nil {
+		return x.AccountParties
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV07) GetIntermediaries() []*modelpb.Intermediary36 {
+	if x != nil {
+		return x.Intermediaries
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV07) GetPlacement() *modelpb.ReferredAgent2 {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV07) GetNewIssueAllocation() *modelpb.NewIssueAllocation2 {
+	if x != nil {
+		return x.NewIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV07) GetSavingsInvestmentPlan() []*modelpb.InvestmentPlan14 {
+	if x != nil {
+		return x.SavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV07) GetWithdrawalInvestmentPlan() []*modelpb.InvestmentPlan14 {
+	if x != nil {
+		return x.WithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV07) GetCashSettlement() []*modelpb.CashSettlement1 {
+	if x != nil {
+		return x.CashSettlement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV07) GetServiceLevelAgreement() []*modelpb.DocumentToSend3 {
+	if x != nil {
+		return x.ServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV07) GetAdditionalInformation() []*modelpb.AdditiononalInformation12 {
+	if x != nil {
+		return x.AdditionalInformation
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV07) GetMarketPracticeVersion() *modelpb.MarketPracticeVersion1 {
+	if x != nil {
+		return x.MarketPracticeVersion
+	}
+	return nil
+}
+
+func (x *AccountDetailsConfirmationV07) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// The AccountExcludedMandateMaintenanceAmendmentRequest message is sent from an organisation to a financial institution as part of the account maintenance process. It is sent in response to a request from the financial institution to send additional information.
+// Usage
+// This update is about account details excluding any mandate information. The organisation will specify under the Account and Organisation tags the complete information as it should be in the financial institutions records after processing the update request.
+type AccountExcludedMandateMaintenanceAmendmentRequestV01 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	ContractDates                 *modelpb.AccountContract2                             `protobuf:"bytes,2,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	UnderlyingMasterAgreement     *modelpb.ContractDocument1                            `protobuf:"bytes,3,opt,name=underlying_master_agreement,json=underlyingMasterAgreement,proto3" json:"underlying_master_agreement,omitempty"`
+	Account                       *modelpb.CustomerAccount1                             `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,5,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	Organisation                  []*modelpb.Organisation6                              `protobuf:"bytes,6,rep,name=organisation,proto3" json:"organisation,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature1                         `protobuf:"bytes,7,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV01) Reset() {
+	*x = AccountExcludedMandateMaintenanceAmendmentRequestV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountExcludedMandateMaintenanceAmendmentRequestV01) ProtoMessage() {}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountExcludedMandateMaintenanceAmendmentRequestV01.ProtoReflect.Descriptor instead.
+func (*AccountExcludedMandateMaintenanceAmendmentRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV01) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV01) GetContractDates() *modelpb.AccountContract2 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV01) GetUnderlyingMasterAgreement() *modelpb.ContractDocument1 {
+	if x != nil {
+		return x.UnderlyingMasterAgreement
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV01) GetAccount() *modelpb.CustomerAccount1 {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV01) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV01) GetOrganisation() []*modelpb.Organisation6 {
+	if x != nil {
+		return x.Organisation
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV01) GetDigitalSignature() []*modelpb.PartyAndSignature1 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+// The AccountExcludedMandateMaintenanceAmendmentRequest message is sent from an organisation to a financial institution as part of the account maintenance process. It is sent in response to a request from the financial institution to send additional information. Usage: this update is about account details excluding any mandate information.
+// If modification codes are not used: the organisation will specify under the “Account” and “Organisation” tags the complete information as it should be in the financial institution’s records after processing the update request.
+// If modification codes are used (in that case, they must be used everywhere): the organisation will specify under the “Account” and “Organisation” tags which elements must be added, deleted, modified, or if they are unchanged.
+type AccountExcludedMandateMaintenanceAmendmentRequestV02 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	From                          *modelpb.OrganisationIdentification8                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	ContractDates                 *modelpb.AccountContract2                             `protobuf:"bytes,3,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	UnderlyingMasterAgreement     *modelpb.ContractDocument1                            `protobuf:"bytes,4,opt,name=underlying_master_agreement,json=underlyingMasterAgreement,proto3" json:"underlying_master_agreement,omitempty"`
+	Account                       *modelpb.CustomerAccountModification1                 `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,6,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	Organisation                  *modelpb.OrganisationModification1                    `protobuf:"bytes,7,opt,name=organisation,proto3" json:"organisation,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature2                         `protobuf:"bytes,8,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1                         `protobuf:"bytes,9,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV02) Reset() {
+	*x = AccountExcludedMandateMaintenanceAmendmentRequestV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountExcludedMandateMaintenanceAmendmentRequestV02) ProtoMessage() {}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountExcludedMandateMaintenanceAmendmentRequestV02.ProtoReflect.Descriptor instead.
+func (*AccountExcludedMandateMaintenanceAmendmentRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV02) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV02) GetFrom() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV02) GetContractDates() *modelpb.AccountContract2 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV02) GetUnderlyingMasterAgreement() *modelpb.ContractDocument1 {
+	if x != nil {
+		return x.UnderlyingMasterAgreement
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV02) GetAccount() *modelpb.CustomerAccountModification1 {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV02) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV02) GetOrganisation() *modelpb.OrganisationModification1 {
+	if x != nil {
+		return x.Organisation
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV02) GetDigitalSignature() []*modelpb.PartyAndSignature2 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceAmendmentRequestV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// This AccountExcludedMandateMaintenanceRequest message is sent from an organisation to a financial institution as part of the account maintenance process. It is the initial request message to update an account.
+// Usage
+// This update is about account details excluding any mandate information. The organisation will specify under the Account and Organisation tags the complete information as it should be in the financial institutions records after processing the update request.
+type AccountExcludedMandateMaintenanceRequestV01 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	ContractDates                 *modelpb.AccountContract2                             `protobuf:"bytes,2,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	UnderlyingMasterAgreement     *modelpb.ContractDocument1                            `protobuf:"bytes,3,opt,name=underlying_master_agreement,json=underlyingMasterAgreement,proto3" json:"underlying_master_agreement,omitempty"`
+	Account                       *modelpb.CustomerAccount1                             `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,5,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	Organisation                  []*modelpb.Organisation6                              `protobuf:"bytes,6,rep,name=organisation,proto3" json:"organisation,omitempty"`
+	AdditionalMessageInformation  *modelpb.AdditionalInformation5                       `protobuf:"bytes,7,opt,name=additional_message_information,json=additionalMessageInformation,proto3" json:"additional_message_information,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature1                         `protobuf:"bytes,8,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV01) Reset() {
+	*x = AccountExcludedMandateMaintenanceRequestV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountExcludedMandateMaintenanceRequestV01) ProtoMessage() {}
+
+func (x *AccountExcludedMandateMaintenanceRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountExcludedMandateMaintenanceRequestV01.ProtoReflect.Descriptor instead.
+func (*AccountExcludedMandateMaintenanceRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV01) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV01) GetContractDates() *modelpb.AccountContract2 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV01) GetUnderlyingMasterAgreement() *modelpb.ContractDocument1 {
+	if x != nil {
+		return x.UnderlyingMasterAgreement
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV01) GetAccount() *modelpb.CustomerAccount1 {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV01) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV01) GetOrganisation() []*modelpb.Organisation6 {
+	if x != nil {
+		return x.Organisation
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV01) GetAdditionalMessageInformation() *modelpb.AdditionalInformation5 {
+	if x != nil {
+		return x.AdditionalMessageInformation
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV01) GetDigitalSignature() []*modelpb.PartyAndSignature1 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+// The AccountExcludedMandateMaintenanceRequest message is sent from an organisation to a financial institution as part of the account maintenance process. It is the initial request message to update an account. Usage: this update is about account details excluding any mandate information.
+// If modification codes are not used: the organisation will specify under the “Account” and “Organisation” tags the complete information as it should be in the financial institution’s records after processing the update request.
+// If modification codes are used (in that case, they must be used everywhere): the organisation will specify under the “Account” and “Organisation” tags which elements must be added, deleted, modified, or if they are unchanged.
+type AccountExcludedMandateMaintenanceRequestV02 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	From                          *modelpb.OrganisationIdentification8                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	ContractDates                 *modelpb.AccountContract2                             `protobuf:"bytes,3,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	UnderlyingMasterAgreement     *modelpb.ContractDocument1                            `protobuf:"bytes,4,opt,name=underlying_master_agreement,json=underlyingMasterAgreement,proto3" json:"underlying_master_agreement,omitempty"`
+	Account                       *modelpb.CustomerAccountModification1                 `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,6,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	Organisation                  *modelpb.OrganisationModification1                    `protobuf:"bytes,7,opt,name=organisation,proto3" json:"organisation,omitempty"`
+	AdditionalMessageInformation  *modelpb.AdditionalInformation5                       `protobuf:"bytes,8,opt,name=additional_message_information,json=additionalMessageInformation,proto3" json:"additional_message_information,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature2                         `protobuf:"bytes,9,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1                         `protobuf:"bytes,10,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV02) Reset() {
+	*x = AccountExcludedMandateMaintenanceRequestV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountExcludedMandateMaintenanceRequestV02) ProtoMessage() {}
+
+func (x *AccountExcludedMandateMaintenanceRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountExcludedMandateMaintenanceRequestV02.ProtoReflect.Descriptor instead.
+func (*AccountExcludedMandateMaintenanceRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV02) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV02) GetFrom() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV02) GetContractDates() *modelpb.AccountContract2 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV02) GetUnderlyingMasterAgreement() *modelpb.ContractDocument1 {
+	if x != nil {
+		return x.UnderlyingMasterAgreement
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV02) GetAccount() *modelpb.CustomerAccountModification1 {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV02) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV02) GetOrganisation() *modelpb.OrganisationModification1 {
+	if x != nil {
+		return x.Organisation
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV02) GetAdditionalMessageInformation() *modelpb.AdditionalInformation5 {
+	if x != nil {
+		return x.AdditionalMessageInformation
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV02) GetDigitalSignature() []*modelpb.PartyAndSignature2 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+func (x *AccountExcludedMandateMaintenanceRequestV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// An account servicer, eg, a registrar, transfer agent or custodian bank sends the AccountManagementStatusReport message to an account owner or its designated agent, eg, an investor to report on the receipt or the processing status of a previously received AccountOpeningInstruction or AccountModificationInstruction or GetAccountDetails message.
+// Usage
+// The AccountManagementStatusReport message is used to provide the processing status of a previously received AccountOpeningInstruction or of an AccountModificationInstruction message.
+// The AccountManagementStatusReport message is also used by an account servicer to reject an AccountOpeningInstruction or AccountModificationInstruction or GetAccountDetails message when the message is not compliant with the agreed SLA or when the account cannot be uniquely identified.
+// The account owner may report that the status of the instruction is either rejected, accepted, that the instruction is being processed or that the instruction has been forwarded to the next intermediary party for further processing.
+type AccountManagementStatusReportV02 struct {
+	state                 protoimpl.MessageState                     `protogen:"open.v1"`
+	MessageIdentification *modelpb.MessageIdentification1            `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	RelatedReference      []*modelpb.AdditionalReference3            `protobuf:"bytes,2,rep,name=related_reference,json=relatedReference,proto3" json:"related_reference,omitempty"`
+	StatusReport          *modelpb.AccountManagementStatusAndReason1 `protobuf:"bytes,3,opt,name=status_report,json=statusReport,proto3" json:"status_report,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AccountManagementStatusReportV02) Reset() {
+	*x = AccountManagementStatusReportV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountManagementStatusReportV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountManagementStatusReportV02) ProtoMessage() {}
+
+func (x *AccountManagementStatusReportV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountManagementStatusReportV02.ProtoReflect.Descriptor instead.
+func (*AccountManagementStatusReportV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AccountManagementStatusReportV02) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV02) GetRelatedReference() []*modelpb.AdditionalReference3 {
+	if x != nil {
+		return x.RelatedReference
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV02) GetStatusReport() *modelpb.AccountManagementStatusAndReason1 {
+	if x != nil {
+		return x.StatusReport
+	}
+	return nil
+}
+
+// Scope
+// An account servicer, for example, a registrar, transfer agent or custodian bank sends the AccountManagementStatusReport message to the account owner or its designated agent, for example, an investor to report on the receipt or the processing status of a previously received AccountOpeningInstruction or AccountModificationInstruction or GetAccountDetails message.
+// Usage
+// The AccountManagementStatusReport message is used to provide the processing status of a previously received AccountOpeningInstruction or of an AccountModificationInstruction message.
+// The AccountManagementStatusReport message is also used by an account servicer to reject an AccountOpeningInstruction or AccountModificationInstruction or GetAccountDetails message when the message is not compliant with the agreed SLA or when the account cannot be uniquely identified.
+// The account owner may report that the status of the instruction is either rejected, accepted, that the instruction is being processed or that the instruction has been forwarded to the next intermediary party for further processing.
+type AccountManagementStatusReportV03 struct {
+	state                 protoimpl.MessageState                     `protogen:"open.v1"`
+	MessageIdentification *modelpb.MessageIdentification1            `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	RelatedReference      []*modelpb.AdditionalReference3            `protobuf:"bytes,2,rep,name=related_reference,json=relatedReference,proto3" json:"related_reference,omitempty"`
+	StatusReport          *modelpb.AccountManagementStatusAndReason2 `protobuf:"bytes,3,opt,name=status_report,json=statusReport,proto3" json:"status_report,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AccountManagementStatusReportV03) Reset() {
+	*x = AccountManagementStatusReportV03{}
+	mi := &file_iso20022_acmt_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountManagementStatusReportV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountManagementStatusReportV03) ProtoMessage() {}
+
+func (x *AccountManagementStatusReportV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountManagementStatusReportV03.ProtoReflect.Descriptor instead.
+func (*AccountManagementStatusReportV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AccountManagementStatusReportV03) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV03) GetRelatedReference() []*modelpb.AdditionalReference3 {
+	if x != nil {
+		return x.RelatedReference
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV03) GetStatusReport() *modelpb.AccountManagementStatusAndReason2 {
+	if x != nil {
+		return x.StatusReport
+	}
+	return nil
+}
+
+// Scope
+// An account servicer, for example, a registrar, transfer agent or custodian bank sends the AccountManagementStatusReport message to the account owner or its designated agent, for example, an investor to report on the receipt or the processing status of a previously received AccountOpeningInstruction or AccountModificationInstruction or GetAccountDetails message.
+// Usage
+// The AccountManagementStatusReport message is used to provide the processing status of a previously received AccountOpeningInstruction or of an AccountModificationInstruction message.
+// The AccountManagementStatusReport message is also used by an account servicer to reject an AccountOpeningInstruction or AccountModificationInstruction or GetAccountDetails message when the message is not compliant with the agreed SLA or when the account cannot be uniquely identified.
+// The account owner may report that the status of the instruction is either rejected, accepted, that the instruction is being processed or that the instruction has been forwarded to the next intermediary party for further processing.
+type AccountManagementStatusReportV04 struct {
+	state                 protoimpl.MessageState                     `protogen:"open.v1"`
+	MessageIdentification *modelpb.MessageIdentification1            `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	RelatedReference      []*modelpb.AdditionalReference3            `protobuf:"bytes,2,rep,name=related_reference,json=relatedReference,proto3" json:"related_reference,omitempty"`
+	StatusReport          *modelpb.AccountManagementStatusAndReason3 `protobuf:"bytes,3,opt,name=status_report,json=statusReport,proto3" json:"status_report,omitempty"`
+	MarketPracticeVersion *modelpb.MarketPracticeVersion1            `protobuf:"bytes,4,opt,name=market_practice_version,json=marketPracticeVersion,proto3" json:"market_practice_version,omitempty"`
+	Extension             []*modelpb.Extension1                      `protobuf:"bytes,5,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AccountManagementStatusReportV04) Reset() {
+	*x = AccountManagementStatusReportV04{}
+	mi := &file_iso20022_acmt_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountManagementStatusReportV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountManagementStatusReportV04) ProtoMessage() {}
+
+func (x *AccountManagementStatusReportV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountManagementStatusReportV04.ProtoReflect.Descriptor instead.
+func (*AccountManagementStatusReportV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AccountManagementStatusReportV04) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV04) GetRelatedReference() []*modelpb.AdditionalReference3 {
+	if x != nil {
+		return x.RelatedReference
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV04) GetStatusReport() *modelpb.AccountManagementStatusAndReason3 {
+	if x != nil {
+		return x.StatusReport
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV04) GetMarketPracticeVersion() *modelpb.MarketPracticeVersion1 {
+	if x != nil {
+		return x.MarketPracticeVersion
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV04) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// An account servicer, for example, a registrar, transfer agent, custodian bank or securities depository  sends the AccountManagementStatusReport message to the account owner or its designated agent, for example, an investor to report on the receipt or the processing status of a previously received AccountOpeningInstruction or AccountModificationInstruction or GetAccountDetails message.
+// Usage
+// The AccountManagementStatusReport message is used to provide the processing status of a previously received AccountOpeningInstruction or of an AccountModificationInstruction message.
+// The AccountManagementStatusReport message is also used by an account servicer to reject an AccountOpeningInstruction or AccountModificationInstruction or GetAccountDetails message when the message is not compliant with the agreed SLA or when the account cannot be uniquely identified.
+// The account owner may report that the status of the instruction is either rejected, accepted, that the instruction is being processed or that the instruction has been forwarded to the next intermediary party for further processing.
+type AccountManagementStatusReportV05 struct {
+	state                 protoimpl.MessageState                     `protogen:"open.v1"`
+	MessageIdentification *modelpb.MessageIdentification1            `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	RelatedReference      []*modelpb.AdditionalReference6            `protobuf:"bytes,2,rep,name=related_reference,json=relatedReference,proto3" json:"related_reference,omitempty"`
+	StatusReport          *modelpb.AccountManagementStatusAndReason4 `protobuf:"bytes,3,opt,name=status_report,json=statusReport,proto3" json:"status_report,omitempty"`
+	MarketPracticeVersion *modelpb.MarketPracticeVersion1            `protobuf:"bytes,4,opt,name=market_practice_version,json=marketPracticeVersion,proto3" json:"market_practice_version,omitempty"`
+	Extension             []*modelpb.Extension1                      `protobuf:"bytes,5,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AccountManagementStatusReportV05) Reset() {
+	*x = AccountManagementStatusReportV05{}
+	mi := &file_iso20022_acmt_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountManagementStatusReportV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountManagementStatusReportV05) ProtoMessage() {}
+
+func (x *AccountManagementStatusReportV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountManagementStatusReportV05.ProtoReflect.Descriptor instead.
+func (*AccountManagementStatusReportV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AccountManagementStatusReportV05) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV05) GetRelatedReference() []*modelpb.AdditionalReference6 {
+	if x != nil {
+		return x.RelatedReference
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV05) GetStatusReport() *modelpb.AccountManagementStatusAndReason4 {
+	if x != nil {
+		return x.StatusReport
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV05) GetMarketPracticeVersion() *modelpb.MarketPracticeVersion1 {
+	if x != nil {
+		return x.MarketPracticeVersion
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV05) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// The AccountManagementStatusReport message is sent by an account servicer, for example, a registrar, transfer agent, custodian bank or securities depository to the account owner or its designated agent, for example, an investor to report on the receipt or the processing status of a previously received account management message.
+// Usage
+// The AccountManagementStatusReport message is used to provide the status of a previously received AccountOpeningInstruction, an AccountModificationInstruction or a GetAccountDetails message. It may also be used to report the status of the account.
+// The AccountManagementStatusReport message is also used to reject an AccountOpeningInstruction, AccountModificationInstruction or GetAccountDetails message when the message is not compliant with the agreed SLA or when the account cannot be uniquely identified.
+type AccountManagementStatusReportV06 struct {
+	state                 protoimpl.MessageState                     `protogen:"open.v1"`
+	MessageIdentification *modelpb.MessageIdentification1            `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	RelatedReference      []*modelpb.AdditionalReference6            `protobuf:"bytes,2,rep,name=related_reference,json=relatedReference,proto3" json:"related_reference,omitempty"`
+	StatusReport          *modelpb.AccountManagementStatusAndReason5 `protobuf:"bytes,3,opt,name=status_report,json=statusReport,proto3" json:"status_report,omitempty"`
+	MarketPracticeVersion *modelpb.MarketPracticeVersion1            `protobuf:"bytes,4,opt,name=market_practice_version,json=marketPracticeVersion,proto3" json:"market_practice_version,omitempty"`
+	Extension             []*modelpb.Extension1                      `protobuf:"bytes,5,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AccountManagementStatusReportV06) Reset() {
+	*x = AccountManagementStatusReportV06{}
+	mi := &file_iso20022_acmt_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountManagementStatusReportV06) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountManagementStatusReportV06) ProtoMessage() {}
+
+func (x *AccountManagementStatusReportV06) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountManagementStatusReportV06.ProtoReflect.Descriptor instead.
+func (*AccountManagementStatusReportV06) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AccountManagementStatusReportV06) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV06) GetRelatedReference() []*modelpb.AdditionalReference6 {
+	if x != nil {
+		return x.RelatedReference
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV06) GetStatusReport() *modelpb.AccountManagementStatusAndReason5 {
+	if x != nil {
+		return x.StatusReport
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV06) GetMarketPracticeVersion() *modelpb.MarketPracticeVersion1 {
+	if x != nil {
+		return x.MarketPracticeVersion
+	}
+	return nil
+}
+
+func (x *AccountManagementStatusReportV06) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// The AccountMandateMaintenanceAmendmentRequest message is sent from an organisation to a financial institution as part of the account maintenance process. It is sent in response to a request from the financial institution to send additional information. This update is only about mandate information.
+// Usage
+// This message should only be sent in response to a request from the financial institution. This update is only about mandate information. The organisation will specify under the Mandate tag the complete information as it should be in the financial institutions records after processing the update request. It is not possible to update the account characteristics or organisation information with this message.
+// It is possible to request to update the mandate information of several accounts, if these accounts must have exactly the same mandates.
+// This message could be sent together with other related documents.
+type AccountMandateMaintenanceAmendmentRequestV01 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	ContractDates                 *modelpb.AccountContract2                             `protobuf:"bytes,2,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	UnderlyingMasterAgreement     *modelpb.ContractDocument1                            `protobuf:"bytes,3,opt,name=underlying_master_agreement,json=underlyingMasterAgreement,proto3" json:"underlying_master_agreement,omitempty"`
+	AccountIdentification         []*modelpb.AccountForAction1                          `protobuf:"bytes,4,rep,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,5,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	OrganisationIdentification    []*modelpb.OrganisationIdentification6                `protobuf:"bytes,6,rep,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	Mandate                       []*modelpb.OperationMandate1                          `protobuf:"bytes,7,rep,name=mandate,proto3" json:"mandate,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature1                         `protobuf:"bytes,8,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV01) Reset() {
+	*x = AccountMandateMaintenanceAmendmentRequestV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMandateMaintenanceAmendmentRequestV01) ProtoMessage() {}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMandateMaintenanceAmendmentRequestV01.ProtoReflect.Descriptor instead.
+func (*AccountMandateMaintenanceAmendmentRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV01) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV01) GetContractDates() *modelpb.AccountContract2 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV01) GetUnderlyingMasterAgreement() *modelpb.ContractDocument1 {
+	if x != nil {
+		return x.UnderlyingMasterAgreement
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV01) GetAccountIdentification() []*modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV01) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV01) GetOrganisationIdentification() []*modelpb.OrganisationIdentification6 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV01) GetMandate() []*modelpb.OperationMandate1 {
+	if x != nil {
+		return x.Mandate
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV01) GetDigitalSignature() []*modelpb.PartyAndSignature1 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+// The AccountMandateMaintenanceAmendmentRequest message is sent from an organisation to a financial institution as part of the account maintenance process. It is sent in response to a request from the financial institution to send additional information. Usage: this update is only about mandate information.
+// If modification codes are not used: the organisation will specify under the “Mandate” and “Group” tags the complete information as it should be in the financial institution’s records after processing the update request.
+// If modification codes are used (in that case, they must be used everywhere): the organisation will specify under the “Mandate” and “Group” tags which elements must be added, deleted, modified, or if they are unchanged.
+// It is not possible to update the account characteristics or organisation information with this message.
+type AccountMandateMaintenanceAmendmentRequestV02 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	From                          *modelpb.OrganisationIdentification8                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	ContractDates                 *modelpb.AccountContract2                             `protobuf:"bytes,3,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	UnderlyingMasterAgreement     *modelpb.ContractDocument1                            `protobuf:"bytes,4,opt,name=underlying_master_agreement,json=underlyingMasterAgreement,proto3" json:"underlying_master_agreement,omitempty"`
+	AccountIdentification         []*modelpb.AccountForAction1                          `protobuf:"bytes,5,rep,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,6,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	OrganisationIdentification    *modelpb.OrganisationIdentification8                  `protobuf:"bytes,7,opt,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	Mandate                       []*modelpb.OperationMandate3                          `protobuf:"bytes,8,rep,name=mandate,proto3" json:"mandate,omitempty"`
+	Group                         []*modelpb.Group2                                     `protobuf:"bytes,9,rep,name=group,proto3" json:"group,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature2                         `protobuf:"bytes,10,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1                         `protobuf:"bytes,11,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV02) Reset() {
+	*x = AccountMandateMaintenanceAmendmentRequestV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMandateMaintenanceAmendmentRequestV02) ProtoMessage() {}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMandateMaintenanceAmendmentRequestV02.ProtoReflect.Descriptor instead.
+func (*AccountMandateMaintenanceAmendmentRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV02) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV02) GetFrom() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV02) GetContractDates() *modelpb.AccountContract2 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV02) GetUnderlyingMasterAgreement() *modelpb.ContractDocument1 {
+	if x != nil {
+		return x.UnderlyingMasterAgreement
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV02) GetAccountIdentification() []*modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV02) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV02) GetOrganisationIdentification() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV02) GetMandate() []*modelpb.OperationMandate3 {
+	if x != nil {
+		return x.Mandate
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV02) GetGroup() []*modelpb.Group2 {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV02) GetDigitalSignature() []*modelpb.PartyAndSignature2 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceAmendmentRequestV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The AccountMandateMaintenanceRequest message is sent from an organisation to a financial institution as part of the account maintenance process. It is the initial request message to update an account. This update is only about mandate information.
+// Usage
+// This message should only be used for initiating the maintenance process of mandate information. This update is only about mandate information. The organisation will specify under the Mandate tag the complete information as it should be in the financial institutions records after processing the update request. It is not possible to update the account characteristics or organisation information with this message.
+// It is possible to request to update the mandate information of several accounts, if these accounts must have exactly the same mandates.
+// This message could be sent together with other related documents.
+type AccountMandateMaintenanceRequestV01 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	ContractDates                 *modelpb.AccountContract2                             `protobuf:"bytes,2,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	UnderlyingMasterAgreement     *modelpb.ContractDocument1                            `protobuf:"bytes,3,opt,name=underlying_master_agreement,json=underlyingMasterAgreement,proto3" json:"underlying_master_agreement,omitempty"`
+	AccountIdentification         []*modelpb.AccountForAction1                          `protobuf:"bytes,4,rep,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,5,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	OrganisationIdentification    []*modelpb.OrganisationIdentification6                `protobuf:"bytes,6,rep,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	Mandate                       []*modelpb.OperationMandate1                          `protobuf:"bytes,7,rep,name=mandate,proto3" json:"mandate,omitempty"`
+	AdditionalMessageInformation  *modelpb.AdditionalInformation5                       `protobuf:"bytes,8,opt,name=additional_message_information,json=additionalMessageInformation,proto3" json:"additional_message_information,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature1                         `protobuf:"bytes,9,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountMandateMaintenanceRequestV01) Reset() {
+	*x = AccountMandateMaintenanceRequestV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountMandateMaintenanceRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMandateMaintenanceRequestV01) ProtoMessage() {}
+
+func (x *AccountMandateMaintenanceRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMandateMaintenanceRequestV01.ProtoReflect.Descriptor instead.
+func (*AccountMandateMaintenanceRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AccountMandateMaintenanceRequestV01) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV01) GetContractDates() *modelpb.AccountContract2 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV01) GetUnderlyingMasterAgreement() *modelpb.ContractDocument1 {
+	if x != nil {
+		return x.UnderlyingMasterAgreement
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV01) GetAccountIdentification() []*modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV01) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV01) GetOrganisationIdentification() []*modelpb.OrganisationIdentification6 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV01) GetMandate() []*modelpb.OperationMandate1 {
+	if x != nil {
+		return x.Mandate
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV01) GetAdditionalMessageInformation() *modelpb.AdditionalInformation5 {
+	if x != nil {
+		return x.AdditionalMessageInformation
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV01) GetDigitalSignature() []*modelpb.PartyAndSignature1 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+// The AccountMandateMaintenanceRequest message is sent from an organisation to a financial institution as part of the account maintenance process. It is the initial request message to update one or several accounts. Usage: this update is only about mandate information.
+// If modification codes are not used: the organisation will specify under the “Mandate” and “Group” tags the complete information as it should be in the financial institution’s records after processing the update request.
+// If modification codes are used (in that case, they must be used everywhere): the organisation will specify under the “Mandate” and “Group” tags which elements must be added, deleted, modified, or if they are unchanged.
+// It is not possible to update the account characteristics or organisation information with this message.
+type AccountMandateMaintenanceRequestV02 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	From                          *modelpb.OrganisationIdentification8                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	ContractDates                 *modelpb.AccountContract2                             `protobuf:"bytes,3,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	UnderlyingMasterAgreement     *modelpb.ContractDocument1                            `protobuf:"bytes,4,opt,name=underlying_master_agreement,json=underlyingMasterAgreement,proto3" json:"underlying_master_agreement,omitempty"`
+	AccountIdentification         []*modelpb.AccountForAction1                          `protobuf:"bytes,5,rep,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,6,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	OrganisationIdentification    *modelpb.Organisation14                               `protobuf:"bytes,7,opt,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	Mandate                       []*modelpb.OperationMandate3                          `protobuf:"bytes,8,rep,name=mandate,proto3" json:"mandate,omitempty"`
+	Group                         []*modelpb.Group2                                     `protobuf:"bytes,9,rep,name=group,proto3" json:"group,omitempty"`
+	AdditionalMessageInformation  *modelpb.AdditionalInformation5                       `protobuf:"bytes,10,opt,name=additional_message_information,json=additionalMessageInformation,proto3" json:"additional_message_information,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature2                         `protobuf:"bytes,11,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1                         `protobuf:"bytes,12,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountMandateMaintenanceRequestV02) Reset() {
+	*x = AccountMandateMaintenanceRequestV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountMandateMaintenanceRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMandateMaintenanceRequestV02) ProtoMessage() {}
+
+func (x *AccountMandateMaintenanceRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMandateMaintenanceRequestV02.ProtoReflect.Descriptor instead.
+func (*AccountMandateMaintenanceRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AccountMandateMaintenanceRequestV02) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV02) GetFrom() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV02) GetContractDates() *modelpb.AccountContract2 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV02) GetUnderlyingMasterAgreement() *modelpb.ContractDocument1 {
+	if x != nil {
+		return x.UnderlyingMasterAgreement
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV02) GetAccountIdentification() []*modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV02) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV02) GetOrganisationIdentification() *modelpb.Organisation14 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV02) GetMandate() []*modelpb.OperationMandate3 {
+	if x != nil {
+		return x.Mandate
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV02) GetGroup() []*modelpb.Group2 {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV02) GetAdditionalMessageInformation() *modelpb.AdditionalInformation5 {
+	if x != nil {
+		return x.AdditionalMessageInformation
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV02) GetDigitalSignature() []*modelpb.PartyAndSignature2 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+func (x *AccountMandateMaintenanceRequestV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// An account owner, eg, and investor or its designated agent, sends the AccountModificationInstruction message to an account servicer, eg, a registrar, transfer agent or custodian bank to modify, ie, create, update or delete specific details of an existing investment fund account.
+// Usage
+// The AccountModificationInstruction message is used to modify the details of an existing account.
+// The AccountModificationInstruction message has three specific uses:
+// - to maintain/update any of the existing account details, eg, to update the address of the beneficiary or modify the preference to income from distribution to capitalisation, or,
+// - to add/create specific details to the existing account when these details were not yet recorded at the time of account creation, eg, to add a second address or to establish new cash settlement standing instructions, or,
+// - to delete specific account details, eg, delete cash standing instructions.
+// This message cannot be used to delete an entire account, as institution specific and regulatory rules pertaining to account deletion are diverse.
+// The usage of this message may be subject to service level agreement (SLA) between the counterparties.
+// Execution of the AccountModificationInstruction is confirmed via an AccountDetailsConfirmation message.
+type AccountModificationInstructionV02 struct {
+	state                            protoimpl.MessageState                              `protogen:"open.v1"`
+	MessageIdentification            *modelpb.MessageIdentification1                     `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	PreviousReference                *modelpb.AdditionalReference3                       `protobuf:"bytes,2,opt,name=previous_reference,json=previousReference,proto3" json:"previous_reference,omitempty"`
+	InstructionDetails               *modelpb.InvestmentAccountModificationDetails       `protobuf:"bytes,3,opt,name=instruction_details,json=instructionDetails,proto3" json:"instruction_details,omitempty"`
+	InvestmentAccountSelection       *modelpb.InvestmentAccountSelection2                `protobuf:"bytes,4,opt,name=investment_account_selection,json=investmentAccountSelection,proto3" json:"investment_account_selection,omitempty"`
+	ModifiedInvestmentAccount        *modelpb.InvestmentAccount28                        `protobuf:"bytes,5,opt,name=modified_investment_account,json=modifiedInvestmentAccount,proto3" json:"modified_investment_account,omitempty"`
+	ModifiedAccountParties           []*modelpb.AccountParties4                          `protobuf:"bytes,6,rep,name=modified_account_parties,json=modifiedAccountParties,proto3" json:"modified_account_parties,omitempty"`
+	ModifiedIntermediaries           []*modelpb.ModificationScope7                       `protobuf:"bytes,7,rep,name=modified_intermediaries,json=modifiedIntermediaries,proto3" json:"modified_intermediaries,omitempty"`
+	ModifiedPlacement                *modelpb.ReferredAgent1                             `protobuf:"bytes,8,opt,name=modified_placement,json=modifiedPlacement,proto3" json:"modified_placement,omitempty"`
+	ModifiedIssueAllocation          *modelpb.ModificationScope9                         `protobuf:"bytes,9,opt,name=modified_issue_allocation,json=modifiedIssueAllocation,proto3" json:"modified_issue_allocation,omitempty"`
+	ModifiedSavingsInvestmentPlan    []*modelpb.ModificationScope8                       `protobuf:"bytes,10,rep,name=modified_savings_investment_plan,json=modifiedSavingsInvestmentPlan,proto3" json:"modified_savings_investment_plan,omitempty"`
+	ModifiedWithdrawalInvestmentPlan []*modelpb.ModificationScope8                       `protobuf:"bytes,11,rep,name=modified_withdrawal_investment_plan,json=modifiedWithdrawalInvestmentPlan,proto3" json:"modified_withdrawal_investment_plan,omitempty"`
+	ModifiedCashSettlement           []*modelpb.InvestmentFundCashSettlementInformation4 `protobuf:"bytes,12,rep,name=modified_cash_settlement,json=modifiedCashSettlement,proto3" json:"modified_cash_settlement,omitempty"`
+	ModifiedServiceLevelAgreement    []*modelpb.ModificationScope10                      `protobuf:"bytes,13,rep,name=modified_service_level_agreement,json=modifiedServiceLevelAgreement,proto3" json:"modified_service_level_agreement,omitempty"`
+	Extension                        []*modelpb.Extension1                               `protobuf:"bytes,14,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *AccountModificationInstructionV02) Reset() {
+	*x = AccountModificationInstructionV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountModificationInstructionV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountModificationInstructionV02) ProtoMessage() {}
+
+func (x *AccountModificationInstructionV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountModificationInstructionV02.ProtoReflect.Descriptor instead.
+func (*AccountModificationInstructionV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AccountModificationInstructionV02) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV02) GetPreviousReference() *modelpb.AdditionalReference3 {
+	if x != nil {
+		return x.PreviousReference
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV02) GetInstructionDetails() *modelpb.InvestmentAccountModificationDetails {
+	if x != nil {
+		return x.InstructionDetails
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV02) GetInvestmentAccountSelection() *modelpb.InvestmentAccountSelection2 {
+	if x != nil {
+		return x.InvestmentAccountSelection
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV02) GetModifiedInvestmentAccount() *modelpb.InvestmentAccount28 {
+	if x != nil {
+		return x.ModifiedInvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV02) GetModifiedAccountParties() []*modelpb.AccountParties4 {
+	if x != nil {
+		return x.ModifiedAccountParties
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV02) GetModifiedIntermediaries() []*modelpb.ModificationScope7 {
+	if x != nil {
+		return x.ModifiedIntermediaries
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV02) GetModifiedPlacement() *modelpb.ReferredAgent1 {
+	if x != nil {
+		return x.ModifiedPlacement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV02) GetModifiedIssueAllocation() *modelpb.ModificationScope9 {
+	if x != nil {
+		return x.ModifiedIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV02) GetModifiedSavingsInvestmentPlan() []*modelpb.ModificationScope8 {
+	if x != nil {
+		return x.ModifiedSavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV02) GetModifiedWithdrawalInvestmentPlan() []*modelpb.ModificationScope8 {
+	if x != nil {
+		return x.ModifiedWithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV02) GetModifiedCashSettlement() []*modelpb.InvestmentFundCashSettlementInformation4 {
+	if x != nil {
+		return x.ModifiedCashSettlement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV02) GetModifiedServiceLevelAgreement() []*modelpb.ModificationScope10 {
+	if x != nil {
+		return x.ModifiedServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV02) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// An account owner, for example, an investor or its designated agent, sends the AccountModificationInstruction message to the account servicer, for example, a registrar, transfer agent or custodian bank to modify, that is, create, update or delete specific details of an existing investment fund account.
+// Usage
+// The AccountModificationInstruction message is used to modify the details of an existing account.
+// The AccountModificationInstruction message has three specific uses:
+// - to maintain/update any of the existing account details, for example, to update the address of the beneficiary or modify the preference to income from distribution to capitalisation, or,
+// - to add/create specific details to the existing account when these details were not yet recorded at the time of account creation, for example, to add a second address or to establish new cash settlement standing instructions, or,
+// - to delete specific account details, for example, delete cash standing instructions.
+// This message cannot be used to delete an entire account, as institution specific and regulatory rules pertaining to account deletion are diverse.
+// The usage of this message may be subject to service level agreement (SLA) between the counterparties.
+// Execution of the AccountModificationInstruction is confirmed via an AccountDetailsConfirmation message.
+type AccountModificationInstructionV03 struct {
+	state                            protoimpl.MessageState                              `protogen:"open.v1"`
+	MessageIdentification            *modelpb.MessageIdentification1                     `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	PreviousReference                *modelpb.AdditionalReference3                       `protobuf:"bytes,2,opt,name=previous_reference,json=previousReference,proto3" json:"previous_reference,omitempty"`
+	InstructionDetails               *modelpb.InvestmentAccountModificationDetails       `protobuf:"bytes,3,opt,name=instruction_details,json=instructionDetails,proto3" json:"instruction_details,omitempty"`
+	InvestmentAccountSelection       *modelpb.InvestmentAccountSelection2                `protobuf:"bytes,4,opt,name=investment_account_selection,json=investmentAccountSelection,proto3" json:"investment_account_selection,omitempty"`
+	ModifiedInvestmentAccount        *modelpb.InvestmentAccount36                        `protobuf:"bytes,5,opt,name=modified_investment_account,json=modifiedInvestmentAccount,proto3" json:"modified_investment_account,omitempty"`
+	ModifiedAccountParties           []*modelpb.AccountParties7                          `protobuf:"bytes,6,rep,name=modified_account_parties,json=modifiedAccountParties,proto3" json:"modified_account_parties,omitempty"`
+	ModifiedIntermediaries           []*modelpb.ModificationScope7                       `protobuf:"bytes,7,rep,name=modified_intermediaries,json=modifiedIntermediaries,proto3" json:"modified_intermediaries,omitempty"`
+	ModifiedPlacement                *modelpb.ReferredAgent1                             `protobuf:"bytes,8,opt,name=modified_placement,json=modifiedPlacement,proto3" json:"modified_placement,omitempty"`
+	ModifiedIssueAllocation          *modelpb.ModificationScope9                         `protobuf:"bytes,9,opt,name=modified_issue_allocation,json=modifiedIssueAllocation,proto3" json:"modified_issue_allocation,omitempty"`
+	ModifiedSavingsInvestmentPlan    []*modelpb.ModificationScope16                      `protobuf:"bytes,10,rep,name=modified_savings_investment_plan,json=modifiedSavingsInvestmentPlan,proto3" json:"modified_savings_investment_plan,omitempty"`
+	ModifiedWithdrawalInvestmentPlan []*modelpb.ModificationScope16                      `protobuf:"bytes,11,rep,name=modified_withdrawal_investment_plan,json=modifiedWithdrawalInvestmentPlan,proto3" json:"modified_withdrawal_investment_plan,omitempty"`
+	ModifiedCashSettlement           []*modelpb.InvestmentFundCashSettlementInformation6 `protobuf:"bytes,12,rep,name=modified_cash_settlement,json=modifiedCashSettlement,proto3" json:"modified_cash_settlement,omitempty"`
+	ModifiedServiceLevelAgreement    []*modelpb.ModificationScope10                      `protobuf:"bytes,13,rep,name=modified_service_level_agreement,json=modifiedServiceLevelAgreement,proto3" json:"modified_service_level_agreement,omitempty"`
+	Extension                        []*modelpb.Extension1                               `protobuf:"bytes,14,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *AccountModificationInstructionV03) Reset() {
+	*x = AccountModificationInstructionV03{}
+	mi := &file_iso20022_acmt_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountModificationInstructionV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountModificationInstructionV03) ProtoMessage() {}
+
+func (x *AccountModificationInstructionV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountModificationInstructionV03.ProtoReflect.Descriptor instead.
+func (*AccountModificationInstructionV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AccountModificationInstructionV03) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV03) GetPreviousReference() *modelpb.AdditionalReference3 {
+	if x != nil {
+		return x.PreviousReference
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV03) GetInstructionDetails() *modelpb.InvestmentAccountModificationDetails {
+	if x != nil {
+		return x.InstructionDetails
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV03) GetInvestmentAccountSelection() *modelpb.InvestmentAccountSelection2 {
+	if x != nil {
+		return x.InvestmentAccountSelection
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV03) GetModifiedInvestmentAccount() *modelpb.InvestmentAccount36 {
+	if x != nil {
+		return x.ModifiedInvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV03) GetModifiedAccountParties() []*modelpb.AccountParties7 {
+	if x != nil {
+		return x.ModifiedAccountParties
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV03) GetModifiedIntermediaries() []*modelpb.ModificationScope7 {
+	if x != nil {
+		return x.ModifiedIntermediaries
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV03) GetModifiedPlacement() *modelpb.ReferredAgent1 {
+	if x != nil {
+		return x.ModifiedPlacement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV03) GetModifiedIssueAllocation() *modelpb.ModificationScope9 {
+	if x != nil {
+		return x.ModifiedIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV03) GetModifiedSavingsInvestmentPlan() []*modelpb.ModificationScope16 {
+	if x != nil {
+		return x.ModifiedSavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV03) GetModifiedWithdrawalInvestmentPlan() []*modelpb.ModificationScope16 {
+	if x != nil {
+		return x.ModifiedWithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV03) GetModifiedCashSettlement() []*modelpb.InvestmentFundCashSettlementInformation6 {
+	if x != nil {
+		return x.ModifiedCashSettlement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV03) GetModifiedServiceLevelAgreement() []*modelpb.ModificationScope10 {
+	if x != nil {
+		return x.ModifiedServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV03) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// An account owner, for example, an investor or its designated agent, sends the AccountModificationInstruction message to the account servicer, for example, a registrar, transfer agent or custodian bank to modify, that is, create, update or delete specific details of an existing investment fund account.
+// Usage
+// The AccountModificationInstruction message is used to modify the details of an existing account.
+// The AccountModificationInstruction message has three specific uses:
+// - to maintain/update any of the existing account details, for example, to update the address of the beneficiary or modify the preference to income from distribution to capitalisation, or,
+// - to add/create specific details to the existing account when these details were not yet recorded at the time of account creation, for example, to add a second address or to establish new cash settlement standing instructions, or,
+// - to delete specific account details, for example, delete cash standing instructions.
+// This message cannot be used to delete an entire account, as institution specific and regulatory rules pertaining to account deletion are diverse.
+// The usage of this message may be subject to service level agreement (SLA) between the counterparties.
+// Execution of the AccountModificationInstruction is confirmed via an AccountDetailsConfirmation message.
+type AccountModificationInstructionV04 struct {
+	state                            protoimpl.MessageState                              `protogen:"open.v1"`
+	MessageIdentification            *modelpb.MessageIdentification1                     `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	PreviousReference                *modelpb.AdditionalReference3                       `protobuf:"bytes,2,opt,name=previous_reference,json=previousReference,proto3" json:"previous_reference,omitempty"`
+	InstructionDetails               *modelpb.InvestmentAccountModificationDetails       `protobuf:"bytes,3,opt,name=instruction_details,json=instructionDetails,proto3" json:"instruction_details,omitempty"`
+	InvestmentAccountSelection       *modelpb.InvestmentAccountSelection2                `protobuf:"bytes,4,opt,name=investment_account_selection,json=investmentAccountSelection,proto3" json:"investment_account_selection,omitempty"`
+	ModifiedInvestmentAccount        *modelpb.InvestmentAccount39                        `protobuf:"bytes,5,opt,name=modified_investment_account,json=modifiedInvestmentAccount,proto3" json:"modified_investment_account,omitempty"`
+	ModifiedAccountParties           []*modelpb.AccountParties8                          `protobuf:"bytes,6,rep,name=modified_account_parties,json=modifiedAccountParties,proto3" json:"modified_account_parties,omitempty"`
+	ModifiedIntermediaries           []*modelpb.ModificationScope20                      `protobuf:"bytes,7,rep,name=modified_intermediaries,json=modifiedIntermediaries,proto3" json:"modified_intermediaries,omitempty"`
+	ModifiedPlacement                *modelpb.ReferredAgent1                             `protobuf:"bytes,8,opt,name=modified_placement,json=modifiedPlacement,proto3" json:"modified_placement,omitempty"`
+	ModifiedIssueAllocation          *modelpb.ModificationScope9                         `protobuf:"bytes,9,opt,name=modified_issue_allocation,json=modifiedIssueAllocation,proto3" json:"modified_issue_allocation,omitempty"`
+	ModifiedSavingsInvestmentPlan    []*modelpb.ModificationScope18                      `protobuf:"bytes,10,rep,name=modified_savings_investment_plan,json=modifiedSavingsInvestmentPlan,proto3" json:"modified_savings_investment_plan,omitempty"`
+	ModifiedWithdrawalInvestmentPlan []*modelpb.ModificationScope18                      `protobuf:"bytes,11,rep,name=modified_withdrawal_investment_plan,json=modifiedWithdrawalInvestmentPlan,proto3" json:"modified_withdrawal_investment_plan,omitempty"`
+	ModifiedCashSettlement           []*modelpb.InvestmentFundCashSettlementInformation8 `protobuf:"bytes,12,rep,name=modified_cash_settlement,json=modifiedCashSettlement,proto3" json:"modified_cash_settlement,omitempty"`
+	ModifiedServiceLevelAgreement    []*modelpb.ModificationScope10                      `protobuf:"bytes,13,rep,name=modified_service_level_agreement,json=modifiedServiceLevelAgreement,proto3" json:"modified_service_level_agreement,omitempty"`
+	MarketPracticeVersion            *modelpb.MarketPracticeVersion1                     `protobuf:"bytes,14,opt,name=market_practice_version,json=marketPracticeVersion,proto3" json:"market_practice_version,omitempty"`
+	Extension                        []*modelpb.Extension1                               `protobuf:"bytes,15,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *AccountModificationInstructionV04) Reset() {
+	*x = AccountModificationInstructionV04{}
+	mi := &file_iso20022_acmt_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountModificationInstructionV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountModificationInstructionV04) ProtoMessage() {}
+
+func (x *AccountModificationInstructionV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountModificationInstructionV04.ProtoReflect.Descriptor instead.
+func (*AccountModificationInstructionV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AccountModificationInstructionV04) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV04) GetPreviousReference() *modelpb.AdditionalReference3 {
+	if x != nil {
+		return x.PreviousReference
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV04) GetInstructionDetails() *modelpb.InvestmentAccountModificationDetails {
+	if x != nil {
+		return x.InstructionDetails
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV04) GetInvestmentAccountSelection() *modelpb.InvestmentAccountSelection2 {
+	if x != nil {
+		return x.InvestmentAccountSelection
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV04) GetModifiedInvestmentAccount() *modelpb.InvestmentAccount39 {
+	if x != nil {
+		return x.ModifiedInvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV04) GetModifiedAccountParties() []*modelpb.AccountParties8 {
+	if x != nil {
+		return x.ModifiedAccountParties
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV04) GetModifiedIntermediaries() []*modelpb.ModificationScope20 {
+	if x != nil {
+		return x.ModifiedIntermediaries
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV04) GetModifiedPlacement() *modelpb.ReferredAgent1 {
+	if x != nil {
+		return x.ModifiedPlacement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV04) GetModifiedIssueAllocation() *modelpb.ModificationScope9 {
+	if x != nil {
+		return x.ModifiedIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV04) GetModifiedSavingsInvestmentPlan() []*modelpb.ModificationScope18 {
+	if x != nil {
+		return x.ModifiedSavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV04) GetModifiedWithdrawalInvestmentPlan() []*modelpb.ModificationScope18 {
+	if x != nil {
+		return x.ModifiedWithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV04) GetModifiedCashSettlement() []*modelpb.InvestmentFundCashSettlementInformation8 {
+	if x != nil {
+		return x.ModifiedCashSettlement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV04) GetModifiedServiceLevelAgreement() []*modelpb.ModificationScope10 {
+	if x != nil {
+		return x.ModifiedServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV04) GetMarketPracticeVersion() *modelpb.MarketPracticeVersion1 {
+	if x != nil {
+		return x.MarketPracticeVersion
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV04) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// An account owner, for example, an investor or its designated agent, sends the AccountModificationInstruction message to the account servicer, for example, a registrar, transfer agent or custodian bank to modify, that is, create, update or delete specific details of an existing investment fund account.
+// Usage
+// The AccountModificationInstruction message is used to modify the details of an existing account.
+// The AccountModificationInstruction message has three specific uses:
+// - to maintain/update any of the existing account details, for example, to update the address of the beneficiary or modify the preference to income from distribution to capitalisation, or,
+// - to add/create specific details to the existing account when these details were not yet recorded at the time of account creation, for example, to add a second address or to establish new cash settlement standing instructions, or,
+// - to delete specific account details, for example, delete cash standing instructions.
+// This message cannot be used to delete an entire account, as institution specific and regulatory rules pertaining to account deletion are diverse.
+// The usage of this message may be subject to service level agreement (SLA) between the counterparties.
+// Execution of the AccountModificationInstruction is confirmed via an AccountDetailsConfirmation message.
+type AccountModificationInstructionV05 struct {
+	state                            protoimpl.MessageState                              `protogen:"open.v1"`
+	MessageIdentification            *modelpb.MessageIdentification1                     `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	PreviousReference                *modelpb.AdditionalReference3                       `protobuf:"bytes,2,opt,name=previous_reference,json=previousReference,proto3" json:"previous_reference,omitempty"`
+	InstructionDetails               *modelpb.InvestmentAccountModification1             `protobuf:"bytes,3,opt,name=instruction_details,json=instructionDetails,proto3" json:"instruction_details,omitempty"`
+	InvestmentAccountSelection       *modelpb.InvestmentAccountSelection2                `protobuf:"bytes,4,opt,name=investment_account_selection,json=investmentAccountSelection,proto3" json:"investment_account_selection,omitempty"`
+	ModifiedInvestmentAccount        *modelpb.InvestmentAccount47                        `protobuf:"bytes,5,opt,name=modified_investment_account,json=modifiedInvestmentAccount,proto3" json:"modified_investment_account,omitempty"`
+	ModifiedAccountParties           []*modelpb.AccountParties11                         `protobuf:"bytes,6,rep,name=modified_account_parties,json=modifiedAccountParties,proto3" json:"modified_account_parties,omitempty"`
+	ModifiedIntermediaries           []*modelpb.ModificationScope20                      `protobuf:"bytes,7,rep,name=modified_intermediaries,json=modifiedIntermediaries,proto3" json:"modified_intermediaries,omitempty"`
+	ModifiedPlacement                *modelpb.ReferredAgent1                             `protobuf:"bytes,8,opt,name=modified_placement,json=modifiedPlacement,proto3" json:"modified_placement,omitempty"`
+	ModifiedIssueAllocation          *modelpb.ModificationScope21                        `protobuf:"bytes,9,opt,name=modified_issue_allocation,json=modifiedIssueAllocation,proto3" json:"modified_issue_allocation,omitempty"`
+	ModifiedSavingsInvestmentPlan    []*modelpb.ModificationScope25                      `protobuf:"bytes,10,rep,name=modified_savings_investment_plan,json=modifiedSavingsInvestmentPlan,proto3" json:"modified_savings_investment_plan,omitempty"`
+	ModifiedWithdrawalInvestmentPlan []*modelpb.ModificationScope25                      `protobuf:"bytes,11,rep,name=modified_withdrawal_investment_plan,json=modifiedWithdrawalInvestmentPlan,proto3" json:"modified_withdrawal_investment_plan,omitempty"`
+	ModifiedCashSettlement           []*modelpb.InvestmentFundCashSettlementInformation8 `protobuf:"bytes,12,rep,name=modified_cash_settlement,json=modifiedCashSettlement,proto3" json:"modified_cash_settlement,omitempty"`
+	ModifiedServiceLevelAgreement    []*modelpb.ModificationScope22                      `protobuf:"bytes,13,rep,name=modified_service_level_agreement,json=modifiedServiceLevelAgreement,proto3" json:"modified_service_level_agreement,omitempty"`
+	MarketPracticeVersion            *modelpb.MarketPracticeVersion1                     `protobuf:"bytes,14,opt,name=market_practice_version,json=marketPracticeVersion,proto3" json:"market_practice_version,omitempty"`
+	Extension                        []*modelpb.Extension1                               `protobuf:"bytes,15,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *AccountModificationInstructionV05) Reset() {
+	*x = AccountModificationInstructionV05{}
+	mi := &file_iso20022_acmt_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountModificationInstructionV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountModificationInstructionV05) ProtoMessage() {}
+
+func (x *AccountModificationInstructionV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountModificationInstructionV05.ProtoReflect.Descriptor instead.
+func (*AccountModificationInstructionV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AccountModificationInstructionV05) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV05) GetPreviousReference() *modelpb.AdditionalReference3 {
+	if x != nil {
+		return x.PreviousReference
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV05) GetInstructionDetails() *modelpb.InvestmentAccountModification1 {
+	if x != nil {
+		return x.InstructionDetails
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV05) GetInvestmentAccountSelection() *modelpb.InvestmentAccountSelection2 {
+	if x != nil {
+		return x.InvestmentAccountSelection
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV05) GetModifiedInvestmentAccount() *modelpb.InvestmentAccount47 {
+	if x != nil {
+		return x.ModifiedInvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV05) GetModifiedAccountParties() []*modelpb.AccountParties11 {
+	if x != nil {
+		return x.ModifiedAccountParties
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV05) GetModifiedIntermediaries() []*modelpb.ModificationScope20 {
+	if x != nil {
+		return x.ModifiedIntermediaries
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV05) GetModifiedPlacement() *modelpb.ReferredAgent1 {
+	if x != nil {
+		return x.ModifiedPlacement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV05) GetModifiedIssueAllocation() *modelpb.ModificationScope21 {
+	if x != nil {
+		return x.ModifiedIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV05) GetModifiedSavingsInvestmentPlan() []*modelpb.ModificationScope25 {
+	if x != nil {
+		return x.ModifiedSavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV05) GetModifiedWithdrawalInvestmentPlan() []*modelpb.ModificationScope25 {
+	if x != nil {
+		return x.ModifiedWithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV05) GetModifiedCashSettlement() []*modelpb.InvestmentFundCashSettlementInformation8 {
+	if x != nil {
+		return x.ModifiedCashSettlement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV05) GetModifiedServiceLevelAgreement() []*modelpb.ModificationScope22 {
+	if x != nil {
+		return x.ModifiedServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV05) GetMarketPracticeVersion() *modelpb.MarketPracticeVersion1 {
+	if x != nil {
+		return x.MarketPracticeVersion
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV05) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// An account owner, for example, an investor or its designated agent, sends the AccountModificationInstruction message to the account servicer, for example, a registrar, transfer agent, custodian bank or securities depository to modify, that is, create, update or delete specific details of an existing account.
+// Usage
+// The AccountModificationInstruction message is used to modify the details of an existing account.
+// The AccountModificationInstruction message has three specific uses:
+// - to maintain/update any of the existing account details, for example, to update the address of the beneficiary or modify the preference to income from distribution to capitalisation, or,
+// - to add/create specific details to the existing account when these details were not yet recorded at the time of account creation, for example, to add a second address or to establish new cash settlement standing instructions, or,
+// - to delete specific account details, for example, delete cash standing instructions.
+// This message cannot be used to delete an entire account, as institution specific and regulatory rules pertaining to account deletion are diverse.
+// The usage of this message may be subject to service level agreement (SLA) between the counterparties.
+// Execution of the AccountModificationInstruction is confirmed via an AccountDetailsConfirmation message.
+type AccountModificationInstructionV06 struct {
+	state                            protoimpl.MessageState                  `protogen:"open.v1"`
+	MessageIdentification            *modelpb.MessageIdentification1         `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	PreviousReference                *modelpb.AdditionalReference6           `protobuf:"bytes,2,opt,name=previous_reference,json=previousReference,proto3" json:"previous_reference,omitempty"`
+	InstructionDetails               *modelpb.InvestmentAccountModification2 `protobuf:"bytes,3,opt,name=instruction_details,json=instructionDetails,proto3" json:"instruction_details,omitempty"`
+	InvestmentAccountSelection       *modelpb.AccountSelection1Choice        `protobuf:"bytes,4,opt,name=investment_account_selection,json=investmentAccountSelection,proto3" json:"investment_account_selection,omitempty"`
+	ModifiedInvestmentAccount        *modelpb.InvestmentAccount51            `protobuf:"bytes,5,opt,name=modified_investment_account,json=modifiedInvestmentAccount,proto3" json:"modified_investment_account,omitempty"`
+	ModifiedAccountParties           []*modelpb.AccountParties14             `protobuf:"bytes,6,rep,name=modified_account_parties,json=modifiedAccountParties,proto3" json:"modified_account_parties,omitempty"`
+	ModifiedIntermediaries           []*modelpb.ModificationScope26          `protobuf:"bytes,7,rep,name=modified_intermediaries,json=modifiedIntermediaries,proto3" json:"modified_intermediaries,omitempty"`
+	ModifiedPlacement                *modelpb.ModificationScope33            `protobuf:"bytes,8,opt,name=modified_placement,json=modifiedPlacement,proto3" json:"modified_placement,omitempty"`
+	ModifiedIssueAllocation          *modelpb.ModificationScope21            `protobuf:"bytes,9,opt,name=modified_issue_allocation,json=modifiedIssueAllocation,proto3" json:"modified_issue_allocation,omitempty"`
+	ModifiedSavingsInvestmentPlan    []*modelpb.ModificationScope28          `protobuf:"bytes,10,rep,name=modified_savings_investment_plan,json=modifiedSavingsInvestmentPlan,proto3" json:"modified_savings_investment_plan,omitempty"`
+	ModifiedWithdrawalInvestmentPlan []*modelpb.ModificationScope28          `protobuf:"bytes,11,rep,name=modified_withdrawal_investment_plan,json=modifiedWithdrawalInvestmentPlan,proto3" json:"modified_withdrawal_investment_plan,omitempty"`
+	ModifiedCashSettlement           []*modelpb.CashSettlement2              `protobuf:"bytes,12,rep,name=modified_cash_settlement,json=modifiedCashSettlement,proto3" json:"modified_cash_settlement,omitempty"`
+	ModifiedServiceLevelAgreement    []*modelpb.ModificationScope31          `protobuf:"bytes,13,rep,name=modified_service_level_agreement,json=modifiedServiceLevelAgreement,proto3" json:"modified_service_level_agreement,omitempty"`
+	ModifiedAdditionalInformation    []*modelpb.ModificationScope30          `protobuf:"bytes,14,rep,name=modified_additional_information,json=modifiedAdditionalInformation,proto3" json:"modified_additional_information,omitempty"`
+	MarketPracticeVersion            *modelpb.MarketPracticeVersion1         `protobuf:"bytes,15,opt,name=market_practice_version,json=marketPracticeVersion,proto3" json:"market_practice_version,omitempty"`
+	Extension                        []*modelpb.Extension1                   `protobuf:"bytes,16,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *AccountModificationInstructionV06) Reset() {
+	*x = AccountModificationInstructionV06{}
+	mi := &file_iso20022_acmt_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountModificationInstructionV06) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountModificationInstructionV06) ProtoMessage() {}
+
+func (x *AccountModificationInstructionV06) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountModificationInstructionV06.ProtoReflect.Descriptor instead.
+func (*AccountModificationInstructionV06) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AccountModificationInstructionV06) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV06) GetPreviousReference() *modelpb.AdditionalReference6 {
+	if x != nil {
+		return x.PreviousReference
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV06) GetInstructionDetails() *modelpb.InvestmentAccountModification2 {
+	if x != nil {
+		return x.InstructionDetails
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV06) GetInvestmentAccountSelection() *modelpb.AccountSelection1Choice {
+	if x != nil {
+		return x.InvestmentAccountSelection
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV06) GetModifiedInvestmentAccount() *modelpb.InvestmentAccount51 {
+	if x != nil {
+		return x.ModifiedInvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV06) GetModifiedAccountParties() []*modelpb.AccountParties14 {
+	if x != nil {
+		return x.ModifiedAccountParties
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV06) GetModifiedIntermediaries() []*modelpb.ModificationScope26 {
+	if x != nil {
+		return x.ModifiedIntermediaries
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV06) GetModifiedPlacement() *modelpb.ModificationScope33 {
+	if x != nil {
+		return x.ModifiedPlacement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV06) GetModifiedIssueAllocation() *modelpb.ModificationScope21 {
+	if x != nil {
+		return x.ModifiedIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV06) GetModifiedSavingsInvestmentPlan() []*modelpb.ModificationScope28 {
+	if x != nil {
+		return x.ModifiedSavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV06) GetModifiedWithdrawalInvestmentPlan() []*modelpb.ModificationScope28 {
+	if x != nil {
+		return x.ModifiedWithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV06) GetModifiedCashSettlement() []*modelpb.CashSettlement2 {
+	if x != nil {
+		return x.ModifiedCashSettlement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV06) GetModifiedServiceLevelAgreement() []*modelpb.ModificationScope31 {
+	if x != nil {
+		return x.ModifiedServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV06) GetModifiedAdditionalInformation() []*modelpb.ModificationScope30 {
+	if x != nil {
+		return x.ModifiedAdditionalInformation
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV06) GetMarketPracticeVersion() *modelpb.MarketPracticeVersion1 {
+	if x != nil {
+		return x.MarketPracticeVersion
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV06) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// The AccountModificationInstruction message is sent by an account owner, for example, an investor or its designated agent to the account servicer, for example, a registrar, transfer agent, custodian bank or securities depository to modify, that is, create, update or delete specific details of an existing account.
+// Usage
+// The AccountModificationInstruction message is used to modify the details of an existing account.
+// The AccountModificationInstruction message can be used to:
+// - maintain/update any of the existing account details, for example, to update the address of the beneficiary or modify the preference to income from distribution to capitalisation, or,
+// - add/create specific details to the existing account when these details were not yet recorded at the time of account creation, for example, to add a second address or to establish new cash settlement standing instructions, or,
+// - delete specific account details, for example, delete cash standing instructions, or,
+// - instruct a change to the status of the account, for example, instruct the closure of the account.
+// The usage of this message may be subject to service level agreement (SLA) between the counterparties.
+// Execution of the AccountModificationInstruction is confirmed via an AccountDetailsConfirmation message.
+type AccountModificationInstructionV07 struct {
+	state                            protoimpl.MessageState                  `protogen:"open.v1"`
+	MessageIdentification            *modelpb.MessageIdentification1         `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	PreviousReference                *modelpb.AdditionalReference6           `protobuf:"bytes,2,opt,name=previous_reference,json=previousReference,proto3" json:"previous_reference,omitempty"`
+	InstructionDetails               *modelpb.InvestmentAccountModification3 `protobuf:"bytes,3,opt,name=instruction_details,json=instructionDetails,proto3" json:"instruction_details,omitempty"`
+	InvestmentAccountSelection       *modelpb.AccountSelection2Choice        `protobuf:"bytes,4,opt,name=investment_account_selection,json=investmentAccountSelection,proto3" json:"investment_account_selection,omitempty"`
+	ModifiedInvestmentAccount        *modelpb.InvestmentAccount63            `protobuf:"bytes,5,opt,name=modified_investment_account,json=modifiedInvestmentAccount,proto3" json:"modified_investment_account,omitempty"`
+	ModifiedAccountParties           []*modelpb.AccountParties16             `protobuf:"bytes,6,rep,name=modified_account_parties,json=modifiedAccountParties,proto3" json:"modified_account_parties,omitempty"`
+	ModifiedIntermediaries           []*modelpb.ModificationScope38          `protobuf:"bytes,7,rep,name=modified_intermediaries,json=modifiedIntermediaries,proto3" json:"modified_intermediaries,omitempty"`
+	ModifiedPlacement                *modelpb.ModificationScope33            `protobuf:"bytes,8,opt,name=modified_placement,json=modifiedPlacement,proto3" json:"modified_placement,omitempty"`
+	ModifiedIssueAllocation          *modelpb.ModificationScope21            `protobuf:"bytes,9,opt,name=modified_issue_allocation,json=modifiedIssueAllocation,proto3" json:"modified_issue_allocation,omitempty"`
+	ModifiedSavingsInvestmentPlan    []*modelpb.ModificationScope37          `protobuf:"bytes,10,rep,name=modified_savings_investment_plan,json=modifiedSavingsInvestmentPlan,proto3" json:"modified_savings_investment_plan,omitempty"`
+	ModifiedWithdrawalInvestmentPlan []*modelpb.ModificationScope37          `protobuf:"bytes,11,rep,name=modified_withdrawal_investment_plan,json=modifiedWithdrawalInvestmentPlan,proto3" json:"modified_withdrawal_investment_plan,omitempty"`
+	ModifiedCashSettlement           []*modelpb.CashSettlement2              `protobuf:"bytes,12,rep,name=modified_cash_settlement,json=modifiedCashSettlement,proto3" json:"modified_cash_settlement,omitempty"`
+	ModifiedServiceLevelAgreement    []*modelpb.ModificationScope31          `protobuf:"bytes,13,rep,name=modified_service_level_agreement,json=modifiedServiceLevelAgreement,proto3" json:"modified_service_level_agreement,omitempty"`
+	ModifiedAdditionalInformation    []*modelpb.ModificationScope35          `protobuf:"bytes,14,rep,name=modified_additional_information,json=modifiedAdditionalInformation,proto3" json:"modified_additional_information,omitempty"`
+	MarketPracticeVersion            *modelpb.MarketPracticeVersion1         `protobuf:"bytes,15,opt,name=market_practice_version,json=marketPracticeVersion,proto3" json:"market_practice_version,omitempty"`
+	Extension                        []*modelpb.Extension1                   `protobuf:"bytes,16,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *AccountModificationInstructionV07) Reset() {
+	*x = AccountModificationInstructionV07{}
+	mi := &file_iso20022_acmt_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountModificationInstructionV07) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountModificationInstructionV07) ProtoMessage() {}
+
+func (x *AccountModificationInstructionV07) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountModificationInstructionV07.ProtoReflect.Descriptor instead.
+func (*AccountModificationInstructionV07) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AccountModificationInstructionV07) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV07) GetPreviousReference() *modelpb.AdditionalReference6 {
+	if x != nil {
+		return x.PreviousReference
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV07) GetInstructionDetails() *modelpb.InvestmentAccountModification3 {
+	if x != nil {
+		return x.InstructionDetails
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV07) GetInvestmentAccountSelection() *modelpb.AccountSelection2Choice {
+	if x != nil {
+		return x.InvestmentAccountSelection
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV07) GetModifiedInvestmentAccount() *modelpb.InvestmentAccount63 {
+	if x != nil {
+		return x.ModifiedInvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV07) GetModifiedAccountParties() []*modelpb.AccountParties16 {
+	if x != nil {
+		return x.ModifiedAccountParties
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV07) GetModifiedIntermediaries() []*modelpb.ModificationScope38 {
+	if x != nil {
+		return x.ModifiedIntermediaries
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV07) GetModifiedPlacement() *modelpb.ModificationScope33 {
+	if x != nil {
+		return x.ModifiedPlacement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV07) GetModifiedIssueAllocation() *modelpb.ModificationScope21 {
+	if x != nil {
+		return x.ModifiedIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV07) GetModifiedSavingsInvestmentPlan() []*modelpb.ModificationScope37 {
+	if x != nil {
+		return x.ModifiedSavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV07) GetModifiedWithdrawalInvestmentPlan() []*modelpb.ModificationScope37 {
+	if x != nil {
+		return x.ModifiedWithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV07) GetModifiedCashSettlement() []*modelpb.CashSettlement2 {
+	if x != nil {
+		return x.ModifiedCashSettlement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV07) GetModifiedServiceLevelAgreement() []*modelpb.ModificationScope31 {
+	if x != nil {
+		return x.ModifiedServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV07) GetModifiedAdditionalInformation() []*modelpb.ModificationScope35 {
+	if x != nil {
+		return x.ModifiedAdditionalInformation
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV07) GetMarketPracticeVersion() *modelpb.MarketPracticeVersion1 {
+	if x != nil {
+		return x.MarketPracticeVersion
+	}
+	return nil
+}
+
+func (x *AccountModificationInstructionV07) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// The AccountOpeningAdditionalInformationRequest message is sent from a financial institution to an organisation as part of the account opening process. This message is sent in response to an opening request message from the organisation, if the business content is valid, but additional information is required.
+// Usage
+// This message should only be sent if additional information is required as part of the account opening process.
+type AccountOpeningAdditionalInformationRequestV01 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References3                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	OrganisationIdentification    []*modelpb.OrganisationIdentification6                `protobuf:"bytes,2,rep,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	Account                       *modelpb.CustomerAccount1                             `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,4,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	UnderlyingMasterAgreement     *modelpb.ContractDocument1                            `protobuf:"bytes,5,opt,name=underlying_master_agreement,json=underlyingMasterAgreement,proto3" json:"underlying_master_agreement,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature1                         `protobuf:"bytes,6,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV01) Reset() {
+	*x = AccountOpeningAdditionalInformationRequestV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountOpeningAdditionalInformationRequestV01) ProtoMessage() {}
+
+func (x *AccountOpeningAdditionalInformationRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountOpeningAdditionalInformationRequestV01.ProtoReflect.Descriptor instead.
+func (*AccountOpeningAdditionalInformationRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV01) GetReferences() *modelpb.References3 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV01) GetOrganisationIdentification() []*modelpb.OrganisationIdentification6 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV01) GetAccount() *modelpb.CustomerAccount1 {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV01) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV01) GetUnderlyingMasterAgreement() *modelpb.ContractDocument1 {
+	if x != nil {
+		return x.UnderlyingMasterAgreement
+	}
+	return nil
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV01) GetDigitalSignature() []*modelpb.PartyAndSignature1 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+// The AccountOpeningAdditionalInformationRequest message is sent from a financial institution to an organisation as part of the account opening process. This message is sent in response to an opening request message from the organisation, if the business content is valid, but additional information is required.
+type AccountOpeningAdditionalInformationRequestV02 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References3                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	From                          *modelpb.OrganisationIdentification8                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	OrganisationIdentification    *modelpb.OrganisationIdentification8                  `protobuf:"bytes,3,opt,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	Account                       *modelpb.CustomerAccount4                             `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,5,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	UnderlyingMasterAgreement     *modelpb.ContractDocument1                            `protobuf:"bytes,6,opt,name=underlying_master_agreement,json=underlyingMasterAgreement,proto3" json:"underlying_master_agreement,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature2                         `protobuf:"bytes,7,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1                         `protobuf:"bytes,8,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV02) Reset() {
+	*x = AccountOpeningAdditionalInformationRequestV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountOpeningAdditionalInformationRequestV02) ProtoMessage() {}
+
+func (x *AccountOpeningAdditionalInformationRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountOpeningAdditionalInformationRequestV02.ProtoReflect.Descriptor instead.
+func (*AccountOpeningAdditionalInformationRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV02) GetReferences() *modelpb.References3 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV02) GetFrom() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV02) GetOrganisationIdentification() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV02) GetAccount() *modelpb.CustomerAccount4 {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV02) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV02) GetUnderlyingMasterAgreement() *modelpb.ContractDocument1 {
+	if x != nil {
+		return x.UnderlyingMasterAgreement
+	}
+	return nil
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV02) GetDigitalSignature() []*modelpb.PartyAndSignature2 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+func (x *AccountOpeningAdditionalInformationRequestV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The AccountOpeningAmendmentRequest message is sent from an organisation to a financial institution as part of the account opening process. It is sent in response to a request from the financial institution to provide additional information.
+// Usage
+// This message should only be sent if additional information is requested as part of the account opening process.
+// It could be sent together with other related documents.
+type AccountOpeningAmendmentRequestV01 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	ContractDates                 *modelpb.AccountContract2                             `protobuf:"bytes,2,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	UnderlyingMasterAgreement     *modelpb.ContractDocument1                            `protobuf:"bytes,3,opt,name=underlying_master_agreement,json=underlyingMasterAgreement,proto3" json:"underlying_master_agreement,omitempty"`
+	Account                       *modelpb.CustomerAccount1                             `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,5,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	Organisation                  []*modelpb.Organisation6                              `protobuf:"bytes,6,rep,name=organisation,proto3" json:"organisation,omitempty"`
+	Mandate                       []*modelpb.OperationMandate1                          `protobuf:"bytes,7,rep,name=mandate,proto3" json:"mandate,omitempty"`
+	ReferenceAccount              *modelpb.CashAccount16                                `protobuf:"bytes,8,opt,name=reference_account,json=referenceAccount,proto3" json:"reference_account,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature1                         `protobuf:"bytes,9,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountOpeningAmendmentRequestV01) Reset() {
+	*x = AccountOpeningAmendmentRequestV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountOpeningAmendmentRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountOpeningAmendmentRequestV01) ProtoMessage() {}
+
+func (x *AccountOpeningAmendmentRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountOpeningAmendmentRequestV01.ProtoReflect.Descriptor instead.
+func (*AccountOpeningAmendmentRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AccountOpeningAmendmentRequestV01) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV01) GetContractDates() *modelpb.AccountContract2 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV01) GetUnderlyingMasterAgreement() *modelpb.ContractDocument1 {
+	if x != nil {
+		return x.UnderlyingMasterAgreement
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV01) GetAccount() *modelpb.CustomerAccount1 {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV01) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV01) GetOrganisation() []*modelpb.Organisation6 {
+	if x != nil {
+		return x.Organisation
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV01) GetMandate() []*modelpb.OperationMandate1 {
+	if x != nil {
+		return x.Mandate
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV01) GetReferenceAccount() *modelpb.CashAccount16 {
+	if x != nil {
+		return x.ReferenceAccount
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV01) GetDigitalSignature() []*modelpb.PartyAndSignature1 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+// The AccountOpeningAmendmentRequest message is sent from an organisation to a financial institution as part of the account opening process. It is sent in response to a request from the financial institution to send additional information.
+type AccountOpeningAmendmentRequestV02 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	From                          *modelpb.OrganisationIdentification8                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	ContractDates                 *modelpb.AccountContract2                             `protobuf:"bytes,3,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	UnderlyingMasterAgreement     *modelpb.ContractDocument1                            `protobuf:"bytes,4,opt,name=underlying_master_agreement,json=underlyingMasterAgreement,proto3" json:"underlying_master_agreement,omitempty"`
+	Account                       *modelpb.CustomerAccount4                             `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,6,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	Organisation                  *modelpb.Organisation12                               `protobuf:"bytes,7,opt,name=organisation,proto3" json:"organisation,omitempty"`
+	Mandate                       []*modelpb.OperationMandate2                          `protobuf:"bytes,8,rep,name=mandate,proto3" json:"mandate,omitempty"`
+	Group                         []*modelpb.Group1                                     `protobuf:"bytes,9,rep,name=group,proto3" json:"group,omitempty"`
+	ReferenceAccount              *modelpb.CashAccount24                                `protobuf:"bytes,10,opt,name=reference_account,json=referenceAccount,proto3" json:"reference_account,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature2                         `protobuf:"bytes,11,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1                         `protobuf:"bytes,12,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountOpeningAmendmentRequestV02) Reset() {
+	*x = AccountOpeningAmendmentRequestV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountOpeningAmendmentRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountOpeningAmendmentRequestV02) ProtoMessage() {}
+
+func (x *AccountOpeningAmendmentRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountOpeningAmendmentRequestV02.ProtoReflect.Descriptor instead.
+func (*AccountOpeningAmendmentRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AccountOpeningAmendmentRequestV02) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV02) GetFrom() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV02) GetContractDates() *modelpb.AccountContract2 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV02) GetUnderlyingMasterAgreement() *modelpb.ContractDocument1 {
+	if x != nil {
+		return x.UnderlyingMasterAgreement
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV02) GetAccount() *modelpb.CustomerAccount4 {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV02) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV02) GetOrganisation() *modelpb.Organisation12 {
+	if x != nil {
+		return x.Organisation
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV02) GetMandate() []*modelpb.OperationMandate2 {
+	if x != nil {
+		return x.Mandate
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV02) GetGroup() []*modelpb.Group1 {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV02) GetReferenceAccount() *modelpb.CashAccount24 {
+	if x != nil {
+		return x.ReferenceAccount
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV02) GetDigitalSignature() []*modelpb.PartyAndSignature2 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+func (x *AccountOpeningAmendmentRequestV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// An account owner, for example, an investor or its designated agent sends the AccountOpeningInstruction message to an account servicer, for example, a registrar, transfer agent or custodian to instruct the opening of an account or the opening of an account and establishing an investment plan.
+// Usage
+// The AccountOpeningInstruction is used to open an account directly or indirectly with the account servicer or an intermediary.
+// In some markets, for example, Australia, and for some products in the United Kingdom, a first order (also known as a deposit instruction) is placed at the same time as the account opening. To cater for this scenario, an order message can be linked (via references in the message) to the AccountOpeningInstruction message when needed.
+// Execution of the AccountOpeningInstruction is confirmed via an AccountDetailsConfirmation message.
+type AccountOpeningInstructionV02 struct {
+	state                    protoimpl.MessageState                            `protogen:"open.v1"`
+	MessageIdentification    *modelpb.MessageIdentification1                   `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	OrderReference           *modelpb.InvestmentFundOrder4                     `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	PreviousReference        *modelpb.AdditionalReference3                     `protobuf:"bytes,3,opt,name=previous_reference,json=previousReference,proto3" json:"previous_reference,omitempty"`
+	InstructionDetails       *modelpb.InvestmentAccountOpeningDetails          `protobuf:"bytes,4,opt,name=instruction_details,json=instructionDetails,proto3" json:"instruction_details,omitempty"`
+	InvestmentAccount        *modelpb.InvestmentAccount26                      `protobuf:"bytes,5,opt,name=investment_account,json=investmentAccount,proto3" json:"investment_account,omitempty"`
+	AccountParties           *modelpb.AccountParties5                          `protobuf:"bytes,6,opt,name=account_parties,json=accountParties,proto3" json:"account_parties,omitempty"`
+	Intermediaries           []*modelpb.Intermediary12                         `protobuf:"bytes,7,rep,name=intermediaries,proto3" json:"intermediaries,omitempty"`
+	Placement                *modelpb.ReferredAgent1                           `protobuf:"bytes,8,opt,name=placement,proto3" json:"placement,omitempty"`
+	NewIssueAllocation       *modelpb.NewIssueAllocation1                      `protobuf:"bytes,9,opt,name=new_issue_allocation,json=newIssueAllocation,proto3" json:"new_issue_allocation,omitempty"`
+	SavingsInvestmentPlan    []*modelpb.InvestmentPlan4                        `protobuf:"bytes,10,rep,name=savings_investment_plan,json=savingsInvestmentPlan,proto3" json:"savings_investment_plan,omitempty"`
+	WithdrawalInvestmentPlan []*modelpb.InvestmentPlan4                        `protobuf:"bytes,11,rep,name=withdrawal_investment_plan,json=withdrawalInvestmentPlan,proto3" json:"withdrawal_investment_plan,omitempty"`
+	CashSettlement           *modelpb.InvestmentFundCashSettlementInformation3 `protobuf:"bytes,12,opt,name=cash_settlement,json=cashSettlement,proto3" json:"cash_settlement,omitempty"`
+	ServiceLevelAgreement    []*modelpb.DocumentToSend1                        `protobuf:"bytes,13,rep,name=service_level_agreement,json=serviceLevelAgreement,proto3" json:"service_level_agreement,omitempty"`
+	Extension                []*modelpb.Extension1                             `protobuf:"bytes,14,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AccountOpeningInstructionV02) Reset() {
+	*x = AccountOpeningInstructionV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountOpeningInstructionV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountOpeningInstructionV02) ProtoMessage() {}
+
+func (x *AccountOpeningInstructionV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountOpeningInstructionV02.ProtoReflect.Descriptor instead.
+func (*AccountOpeningInstructionV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AccountOpeningInstructionV02) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV02) GetOrderReference() *modelpb.InvestmentFundOrder4 {
+	if x != nil {
+		return x.OrderReference
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV02) GetPreviousReference() *modelpb.AdditionalReference3 {
+	if x != nil {
+		return x.PreviousReference
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV02) GetInstructionDetails() *modelpb.InvestmentAccountOpeningDetails {
+	if x != nil {
+		return x.InstructionDetails
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV02) GetInvestmentAccount() *modelpb.InvestmentAccount26 {
+	if x != nil {
+		return x.InvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV02) GetAccountParties() *modelpb.AccountParties5 {
+	if x != nil {
+		return x.AccountParties
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV02) GetIntermediaries() []*modelpb.Intermediary12 {
+	if x != nil {
+		return x.Intermediaries
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV02) GetPlacement() *modelpb.ReferredAgent1 {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV02) GetNewIssueAllocation() *modelpb.NewIssueAllocation1 {
+	if x != nil {
+		return x.NewIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV02) GetSavingsInvestmentPlan() []*modelpb.InvestmentPlan4 {
+	if x != nil {
+		return x.SavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV02) GetWithdrawalInvestmentPlan() []*modelpb.InvestmentPlan4 {
+	if x != nil {
+		return x.WithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV02) GetCashSettlement() *modelpb.InvestmentFundCashSettlementInformation3 {
+	if x != nil {
+		return x.CashSettlement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV02) GetServiceLevelAgreement() []*modelpb.DocumentToSend1 {
+	if x != nil {
+		return x.ServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV02) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// An account owner, for example, an investor or its designated agent sends the AccountOpeningInstruction message to the account servicer, for example, a registrar, transfer agent or custodian to instruct the opening of an account or the opening of an account and establishing an investment plan.
+// Usage
+// The AccountOpeningInstruction is used to open an account directly or indirectly with the account servicer or an intermediary.
+// In some markets, for example, Australia, and for some products in the United Kingdom, a first order (also known as a deposit instruction) is placed at the same time as the account opening. To cater for this scenario, an order message can be linked (via references in the message) to the AccountOpeningInstruction message when needed.
+// Execution of the AccountOpeningInstruction is confirmed via an AccountDetailsConfirmation message.
+type AccountOpeningInstructionV03 struct {
+	state                    protoimpl.MessageState                              `protogen:"open.v1"`
+	MessageIdentification    *modelpb.MessageIdentification1                     `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	OrderReference           *modelpb.InvestmentFundOrder4                       `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	PreviousReference        *modelpb.AdditionalReference3                       `protobuf:"bytes,3,opt,name=previous_reference,json=previousReference,proto3" json:"previous_reference,omitempty"`
+	InstructionDetails       *modelpb.InvestmentAccountOpeningDetails            `protobuf:"bytes,4,opt,name=instruction_details,json=instructionDetails,proto3" json:"instruction_details,omitempty"`
+	InvestmentAccount        *modelpb.InvestmentAccount34                        `protobuf:"bytes,5,opt,name=investment_account,json=investmentAccount,proto3" json:"investment_account,omitempty"`
+	AccountParties           *modelpb.AccountParties6                            `protobuf:"bytes,6,opt,name=account_parties,json=accountParties,proto3" json:"account_parties,omitempty"`
+	Intermediaries           []*modelpb.Intermediary12                           `protobuf:"bytes,7,rep,name=intermediaries,proto3" json:"intermediaries,omitempty"`
+	Placement                *modelpb.ReferredAgent1                             `protobuf:"bytes,8,opt,name=placement,proto3" json:"placement,omitempty"`
+	NewIssueAllocation       *modelpb.NewIssueAllocation1                        `protobuf:"bytes,9,opt,name=new_issue_allocation,json=newIssueAllocation,proto3" json:"new_issue_allocation,omitempty"`
+	SavingsInvestmentPlan    []*modelpb.InvestmentPlan6                          `protobuf:"bytes,10,rep,name=savings_investment_plan,json=savingsInvestmentPlan,proto3" json:"savings_investment_plan,omitempty"`
+	WithdrawalInvestmentPlan []*modelpb.InvestmentPlan6                          `protobuf:"bytes,11,rep,name=withdrawal_investment_plan,json=withdrawalInvestmentPlan,proto3" json:"withdrawal_investment_plan,omitempty"`
+	CashSettlement           []*modelpb.InvestmentFundCashSettlementInformation5 `protobuf:"bytes,12,rep,name=cash_settlement,json=cashSettlement,proto3" json:"cash_settlement,omitempty"`
+	ServiceLevelAgreement    []*modelpb.DocumentToSend1                          `protobuf:"bytes,13,rep,name=service_level_agreement,json=serviceLevelAgreement,proto3" json:"service_level_agreement,omitempty"`
+	Extension                []*modelpb.Extension1                               `protobuf:"bytes,14,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AccountOpeningInstructionV03) Reset() {
+	*x = AccountOpeningInstructionV03{}
+	mi := &file_iso20022_acmt_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountOpeningInstructionV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountOpeningInstructionV03) ProtoMessage() {}
+
+func (x *AccountOpeningInstructionV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountOpeningInstructionV03.ProtoReflect.Descriptor instead.
+func (*AccountOpeningInstructionV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AccountOpeningInstructionV03) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV03) GetOrderReference() *modelpb.InvestmentFundOrder4 {
+	if x != nil {
+		return x.OrderReference
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV03) GetPreviousReference() *modelpb.AdditionalReference3 {
+	if x != nil {
+		return x.PreviousReference
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV03) GetInstructionDetails() *modelpb.InvestmentAccountOpeningDetails {
+	if x != nil {
+		return x.InstructionDetails
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV03) GetInvestmentAccount() *modelpb.InvestmentAccount34 {
+	if x != nil {
+		return x.InvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV03) GetAccountParties() *modelpb.AccountParties6 {
+	if x != nil {
+		return x.AccountParties
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV03) GetIntermediaries() []*modelpb.Intermediary12 {
+	if x != nil {
+		return x.Intermediaries
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV03) GetPlacement() *modelpb.ReferredAgent1 {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV03) GetNewIssueAllocation() *modelpb.NewIssueAllocation1 {
+	if x != nil {
+		return x.NewIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV03) GetSavingsInvestmentPlan() []*modelpb.InvestmentPlan6 {
+	if x != nil {
+		return x.SavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV03) GetWithdrawalInvestmentPlan() []*modelpb.InvestmentPlan6 {
+	if x != nil {
+		return x.WithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV03) GetCashSettlement() []*modelpb.InvestmentFundCashSettlementInformation5 {
+	if x != nil {
+		return x.CashSettlement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV03) GetServiceLevelAgreement() []*modelpb.DocumentToSend1 {
+	if x != nil {
+		return x.ServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV03) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// An account owner, for example, an investor or its designated agent sends the AccountOpeningInstruction message to the account servicer, for example, a registrar, transfer agent or custodian to instruct the opening of an account or the opening of an account and establishing an investment plan.
+// Usage
+// The AccountOpeningInstruction is used to open an account directly or indirectly with the account servicer or an intermediary.
+// In some markets, for example, Australia, and for some products in the United Kingdom, a first order (also known as a deposit instruction) is placed at the same time as the account opening. To cater for this scenario, an order message can be linked (via references in the message) to the AccountOpeningInstruction message when needed.
+// Execution of the AccountOpeningInstruction is confirmed via an AccountDetailsConfirmation message.
+type AccountOpeningInstructionV04 struct {
+	state                    protoimpl.MessageState                              `protogen:"open.v1"`
+	MessageIdentification    *modelpb.MessageIdentification1                     `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	OrderReference           *modelpb.InvestmentFundOrder4                       `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	PreviousReference        *modelpb.AdditionalReference3                       `protobuf:"bytes,3,opt,name=previous_reference,json=previousReference,proto3" json:"previous_reference,omitempty"`
+	InstructionDetails       *modelpb.InvestmentAccountOpeningDetails            `protobuf:"bytes,4,opt,name=instruction_details,json=instructionDetails,proto3" json:"instruction_details,omitempty"`
+	InvestmentAccount        *modelpb.InvestmentAccount37                        `protobuf:"bytes,5,opt,name=investment_account,json=investmentAccount,proto3" json:"investment_account,omitempty"`
+	AccountParties           *modelpb.AccountParties9                            `protobuf:"bytes,6,opt,name=account_parties,json=accountParties,proto3" json:"account_parties,omitempty"`
+	Intermediaries           []*modelpb.Intermediary24                           `protobuf:"bytes,7,rep,name=intermediaries,proto3" json:"intermediaries,omitempty"`
+	Placement                *modelpb.ReferredAgent1                             `protobuf:"bytes,8,opt,name=placement,proto3" json:"placement,omitempty"`
+	NewIssueAllocation       *modelpb.NewIssueAllocation1                        `protobuf:"bytes,9,opt,name=new_issue_allocation,json=newIssueAllocation,proto3" json:"new_issue_allocation,omitempty"`
+	SavingsInvestmentPlan    []*modelpb.InvestmentPlan9                          `protobuf:"bytes,10,rep,name=savings_investment_plan,json=savingsInvestmentPlan,proto3" json:"savings_investment_plan,omitempty"`
+	WithdrawalInvestmentPlan []*modelpb.InvestmentPlan9                          `protobuf:"bytes,11,rep,name=withdrawal_investment_plan,json=withdrawalInvestmentPlan,proto3" json:"withdrawal_investment_plan,omitempty"`
+	CashSettlement           []*modelpb.InvestmentFundCashSettlementInformation7 `protobuf:"bytes,12,rep,name=cash_settlement,json=cashSettlement,proto3" json:"cash_settlement,omitempty"`
+	ServiceLevelAgreement    []*modelpb.DocumentToSend1                          `protobuf:"bytes,13,rep,name=service_level_agreement,json=serviceLevelAgreement,proto3" json:"service_level_agreement,omitempty"`
+	MarketPracticeVersion    *modelpb.MarketPracticeVersion1                     `protobuf:"bytes,14,opt,name=market_practice_version,json=marketPracticeVersion,proto3" json:"market_practice_version,omitempty"`
+	Extension                []*modelpb.Extension1                               `protobuf:"bytes,15,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AccountOpeningInstructionV04) Reset() {
+	*x = AccountOpeningInstructionV04{}
+	mi := &file_iso20022_acmt_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountOpeningInstructionV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountOpeningInstructionV04) ProtoMessage() {}
+
+func (x *AccountOpeningInstructionV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountOpeningInstructionV04.ProtoReflect.Descriptor instead.
+func (*AccountOpeningInstructionV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AccountOpeningInstructionV04) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV04) GetOrderReference() *modelpb.InvestmentFundOrder4 {
+	if x != nil {
+		return x.OrderReference
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV04) GetPreviousReference() *modelpb.AdditionalReference3 {
+	if x != nil {
+		return x.PreviousReference
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV04) GetInstructionDetails() *modelpb.InvestmentAccountOpeningDetails {
+	if x != nil {
+		return x.InstructionDetails
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV04) GetInvestmentAccount() *modelpb.InvestmentAccount37 {
+	if x != nil {
+		return x.InvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV04) GetAccountParties() *modelpb.AccountParties9 {
+	if x != nil {
+		return x.AccountParties
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV04) GetIntermediaries() []*modelpb.Intermediary24 {
+	if x != nil {
+		return x.Intermediaries
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV04) GetPlacement() *modelpb.ReferredAgent1 {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV04) GetNewIssueAllocation() *modelpb.NewIssueAllocation1 {
+	if x != nil {
+		return x.NewIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV04) GetSavingsInvestmentPlan() []*modelpb.InvestmentPlan9 {
+	if x != nil {
+		return x.SavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV04) GetWithdrawalInvestmentPlan() []*modelpb.InvestmentPlan9 {
+	if x != nil {
+		return x.WithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV04) GetCashSettlement() []*modelpb.InvestmentFundCashSettlementInformation7 {
+	if x != nil {
+		return x.CashSettlement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV04) GetServiceLevelAgreement() []*modelpb.DocumentToSend1 {
+	if x != nil {
+		return x.ServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV04) GetMarketPracticeVersion() *modelpb.MarketPracticeVersion1 {
+	if x != nil {
+		return x.MarketPracticeVersion
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV04) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// An account owner, for example, an investor or its designated agent sends the AccountOpeningInstruction message to the account servicer, for example, a registrar, transfer agent or custodian to instruct the opening of an account or the opening of an account and establishing an investment plan.
+// Usage
+// The AccountOpeningInstruction is used to open an account directly or indirectly with the account servicer or an intermediary.
+// In some markets, for example, Australia, and for some products in the United Kingdom, a first order (also known as a deposit instruction) is placed at the same time as the account opening. To cater for this scenario, an order message can be linked (via references in the message) to the AccountOpeningInstruction message when needed.
+// Execution of the AccountOpeningInstruction is confirmed via an AccountDetailsConfirmation message.
+type AccountOpeningInstructionV05 struct {
+	state                    protoimpl.MessageState                              `protogen:"open.v1"`
+	MessageIdentification    *modelpb.MessageIdentification1                     `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	OrderReference           *modelpb.InvestmentFundOrder4                       `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	PreviousReference        *modelpb.AdditionalReference3                       `protobuf:"bytes,3,opt,name=previous_reference,json=previousReference,proto3" json:"previous_reference,omitempty"`
+	InstructionDetails       *modelpb.InvestmentAccountOpening1                  `protobuf:"bytes,4,opt,name=instruction_details,json=instructionDetails,proto3" json:"instruction_details,omitempty"`
+	InvestmentAccount        *modelpb.InvestmentAccount37                        `protobuf:"bytes,5,opt,name=investment_account,json=investmentAccount,proto3" json:"investment_account,omitempty"`
+	AccountParties           *modelpb.AccountParties10                           `protobuf:"bytes,6,opt,name=account_parties,json=accountParties,proto3" json:"account_parties,omitempty"`
+	Intermediaries           []*modelpb.Intermediary24                           `protobuf:"bytes,7,rep,name=intermediaries,proto3" json:"intermediaries,omitempty"`
+	Placement                *modelpb.ReferredAgent1                             `protobuf:"bytes,8,opt,name=placement,proto3" json:"placement,omitempty"`
+	NewIssueAllocation       *modelpb.NewIssueAllocation2                        `protobuf:"bytes,9,opt,name=new_issue_allocation,json=newIssueAllocation,proto3" json:"new_issue_allocation,omitempty"`
+	SavingsInvestmentPlan    []*modelpb.InvestmentPlan10                         `protobuf:"bytes,10,rep,name=savings_investment_plan,json=savingsInvestmentPlan,proto3" json:"savings_investment_plan,omitempty"`
+	WithdrawalInvestmentPlan []*modelpb.InvestmentPlan10                         `protobuf:"bytes,11,rep,name=withdrawal_investment_plan,json=withdrawalInvestmentPlan,proto3" json:"withdrawal_investment_plan,omitempty"`
+	CashSettlement           []*modelpb.InvestmentFundCashSettlementInformation7 `protobuf:"bytes,12,rep,name=cash_settlement,json=cashSettlement,proto3" json:"cash_settlement,omitempty"`
+	ServiceLevelAgreement    []*modelpb.DocumentToSend2                          `protobuf:"bytes,13,rep,name=service_level_agreement,json=serviceLevelAgreement,proto3" json:"service_level_agreement,omitempty"`
+	MarketPracticeVersion    *modelpb.MarketPracticeVersion1                     `protobuf:"bytes,14,opt,name=market_practice_version,json=marketPracticeVersion,proto3" json:"market_practice_version,omitempty"`
+	Extension                []*modelpb.Extension1                               `protobuf:"bytes,15,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AccountOpeningInstructionV05) Reset() {
+	*x = AccountOpeningInstructionV05{}
+	mi := &file_iso20022_acmt_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountOpeningInstructionV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountOpeningInstructionV05) ProtoMessage() {}
+
+func (x *AccountOpeningInstructionV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountOpeningInstructionV05.ProtoReflect.Descriptor instead.
+func (*AccountOpeningInstructionV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AccountOpeningInstructionV05) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV05) GetOrderReference() *modelpb.InvestmentFundOrder4 {
+	if x != nil {
+		return x.OrderReference
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV05) GetPreviousReference() *modelpb.AdditionalReference3 {
+	if x != nil {
+		return x.PreviousReference
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV05) GetInstructionDetails() *modelpb.InvestmentAccountOpening1 {
+	if x != nil {
+		return x.InstructionDetails
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV05) GetInvestmentAccount() *modelpb.InvestmentAccount37 {
+	if x != nil {
+		return x.InvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV05) GetAccountParties() *modelpb.AccountParties10 {
+	if x != nil {
+		return x.AccountParties
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV05) GetIntermediaries() []*modelpb.Intermediary24 {
+	if x != nil {
+		return x.Intermediaries
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV05) GetPlacement() *modelpb.ReferredAgent1 {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV05) GetNewIssueAllocation() *modelpb.NewIssueAllocation2 {
+	if x != nil {
+		return x.NewIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV05) GetSavingsInvestmentPlan() []*modelpb.InvestmentPlan10 {
+	if x != nil {
+		return x.SavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV05) GetWithdrawalInvestmentPlan() []*modelpb.InvestmentPlan10 {
+	if x != nil {
+		return x.WithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV05) GetCashSettlement() []*modelpb.InvestmentFundCashSettlementInformation7 {
+	if x != nil {
+		return x.CashSettlement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV05) GetServiceLevelAgreement() []*modelpb.DocumentToSend2 {
+	if x != nil {
+		return x.ServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV05) GetMarketPracticeVersion() *modelpb.MarketPracticeVersion1 {
+	if x != nil {
+		return x.MarketPracticeVersion
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV05) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// An account owner, for example, an investor or its designated agent sends the AccountOpeningInstruction message to the account servicer, for example, a registrar, transfer agent, custodian or securities depository to instruct the opening of an account or the opening of an account and the establishment of an investment plan.
+// Usage
+// The AccountOpeningInstruction is used to open an account directly or indirectly with the account servicer or an intermediary.
+// In some markets, for example, Australia, and for some products in the United Kingdom, a first order (also known as a deposit instruction) is placed at the same time as the account opening. To cater for this scenario, an order message can be linked (via references in the message) to the AccountOpeningInstruction message when needed.
+// Execution of the AccountOpeningInstruction is confirmed via an AccountDetailsConfirmation message.
+type AccountOpeningInstructionV06 struct {
+	state                    protoimpl.MessageState             `protogen:"open.v1"`
+	MessageIdentification    *modelpb.MessageIdentification1    `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	OrderReference           *modelpb.InvestmentFundOrder4      `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	PreviousReference        *modelpb.AdditionalReference6      `protobuf:"bytes,3,opt,name=previous_reference,json=previousReference,proto3" json:"previous_reference,omitempty"`
+	InstructionDetails       *modelpb.InvestmentAccountOpening2 `protobuf:"bytes,4,opt,name=instruction_details,json=instructionDetails,proto3" json:"instruction_details,omitempty"`
+	InvestmentAccount        *modelpb.InvestmentAccount49       `protobuf:"bytes,5,opt,name=investment_account,json=investmentAccount,proto3" json:"investment_account,omitempty"`
+	AccountParties           *modelpb.AccountParties13          `protobuf:"bytes,6,opt,name=account_parties,json=accountParties,proto3" json:"account_parties,omitempty"`
+	Intermediaries           []*modelpb.Intermediary36          `protobuf:"bytes,7,rep,name=intermediaries,proto3" json:"intermediaries,omitempty"`
+	Placement                *modelpb.ReferredAgent2            `protobuf:"bytes,8,opt,name=placement,proto3" json:"placement,omitempty"`
+	NewIssueAllocation       *modelpb.NewIssueAllocation2       `protobuf:"bytes,9,opt,name=new_issue_allocation,json=newIssueAllocation,proto3" json:"new_issue_allocation,omitempty"`
+	SavingsInvestmentPlan    []*modelpb.InvestmentPlan12        `protobuf:"bytes,10,rep,name=savings_investment_plan,json=savingsInvestmentPlan,proto3" json:"savings_investment_plan,omitempty"`
+	WithdrawalInvestmentPlan []*modelpb.InvestmentPlan12        `protobuf:"bytes,11,rep,name=withdrawal_investment_plan,json=withdrawalInvestmentPlan,proto3" json:"withdrawal_investment_plan,omitempty"`
+	CashSettlement           []*modelpb.CashSettlement1         `protobuf:"bytes,12,rep,name=cash_settlement,json=cashSettlement,proto3" json:"cash_settlement,omitempty"`
+	ServiceLevelAgreement    []*modelpb.DocumentToSend3         `protobuf:"bytes,13,rep,name=service_level_agreement,json=serviceLevelAgreement,proto3" json:"service_level_agreement,omitempty"`
+	AdditionalInformation    []*modelpb.AccountRestrictions1    `protobuf:"bytes,14,rep,name=additional_information,json=additionalInformation,proto3" json:"additional_information,omitempty"`
+	MarketPracticeVersion    *modelpb.MarketPracticeVersion1    `protobuf:"bytes,15,opt,name=market_practice_version,json=marketPracticeVersion,proto3" json:"market_practice_version,omitempty"`
+	Extension                []*modelpb.Extension1              `protobuf:"bytes,16,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AccountOpeningInstructionV06) Reset() {
+	*x = AccountOpeningInstructionV06{}
+	mi := &file_iso20022_acmt_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountOpeningInstructionV06) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountOpeningInstructionV06) ProtoMessage() {}
+
+func (x *AccountOpeningInstructionV06) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountOpeningInstructionV06.ProtoReflect.Descriptor instead.
+func (*AccountOpeningInstructionV06) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AccountOpeningInstructionV06) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV06) GetOrderReference() *modelpb.InvestmentFundOrder4 {
+	if x != nil {
+		return x.OrderReference
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV06) GetPreviousReference() *modelpb.AdditionalReference6 {
+	if x != nil {
+		return x.PreviousReference
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV06) GetInstructionDetails() *modelpb.InvestmentAccountOpening2 {
+	if x != nil {
+		return x.InstructionDetails
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV06) GetInvestmentAccount() *modelpb.InvestmentAccount49 {
+	if x != nil {
+		return x.InvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV06) GetAccountParties() *modelpb.AccountParties13 {
+	if x != nil {
+		return x.AccountParties
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV06) GetIntermediaries() []*modelpb.Intermediary36 {
+	if x != nil {
+		return x.Intermediaries
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV06) GetPlacement() *modelpb.ReferredAgent2 {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV06) GetNewIssueAllocation() *modelpb.NewIssueAllocation2 {
+	if x != nil {
+		return x.NewIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV06) GetSavingsInvestmentPlan() []*modelpb.InvestmentPlan12 {
+	if x != nil {
+		return x.SavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV06) GetWithdrawalInvestmentPlan() []*modelpb.InvestmentPlan12 {
+	if x != nil {
+		return x.WithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV06) GetCashSettlement() []*modelpb.CashSettlement1 {
+	if x != nil {
+		return x.CashSettlement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV06) GetServiceLevelAgreement() []*modelpb.DocumentToSend3 {
+	if x != nil {
+		return x.ServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV06) GetAdditionalInformation() []*modelpb.AccountRestrictions1 {
+	if x != nil {
+		return x.AdditionalInformation
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV06) GetMarketPracticeVersion() *modelpb.MarketPracticeVersion1 {
+	if x != nil {
+		return x.MarketPracticeVersion
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV06) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// The AccountOpeningInstruction message is sent by an account owner, for example, an investor or its designated agent to the account servicer, for example, a registrar, transfer agent, custodian or securities depository, to instruct the opening of an account or the opening of an account and the establishment of an investment plan.
+// Usage
+// The AccountOpeningInstruction is used to open an account directly or indirectly with the account servicer or an intermediary.
+// In some markets, for example, Australia, and for some products in the United Kingdom, a first order (also known as a deposit instruction) is placed at the same time as the account opening. To cater for this scenario, an order message can be linked (via references in the message) to the AccountOpeningInstruction message when needed.
+// Execution of the AccountOpeningInstruction is confirmed via an AccountDetailsConfirmation message.
+type AccountOpeningInstructionV07 struct {
+	state                    protoimpl.MessageState               `protogen:"open.v1"`
+	MessageIdentification    *modelpb.MessageIdentification1      `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	OrderReference           *modelpb.InvestmentFundOrder4        `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	PreviousReference        *modelpb.AdditionalReference6        `protobuf:"bytes,3,opt,name=previous_reference,json=previousReference,proto3" json:"previous_reference,omitempty"`
+	InstructionDetails       *modelpb.InvestmentAccountOpening3   `protobuf:"bytes,4,opt,name=instruction_details,json=instructionDetails,proto3" json:"instruction_details,omitempty"`
+	InvestmentAccount        *modelpb.InvestmentAccount61         `protobuf:"bytes,5,opt,name=investment_account,json=investmentAccount,proto3" json:"investment_account,omitempty"`
+	AccountParties           *modelpb.AccountParties15            `protobuf:"bytes,6,opt,name=account_parties,json=accountParties,proto3" json:"account_parties,omitempty"`
+	Intermediaries           []*modelpb.Intermediary36            `protobuf:"bytes,7,rep,name=intermediaries,proto3" json:"intermediaries,omitempty"`
+	Placement                *modelpb.ReferredAgent2              `protobuf:"bytes,8,opt,name=placement,proto3" json:"placement,omitempty"`
+	NewIssueAllocation       *modelpb.NewIssueAllocation2         `protobuf:"bytes,9,opt,name=new_issue_allocation,json=newIssueAllocation,proto3" json:"new_issue_allocation,omitempty"`
+	SavingsInvestmentPlan    []*modelpb.InvestmentPlan14          `protobuf:"bytes,10,rep,name=savings_investment_plan,json=savingsInvestmentPlan,proto3" json:"savings_investment_plan,omitempty"`
+	WithdrawalInvestmentPlan []*modelpb.InvestmentPlan14          `protobuf:"bytes,11,rep,name=withdrawal_investment_plan,json=withdrawalInvestmentPlan,proto3" json:"withdrawal_investment_plan,omitempty"`
+	CashSettlement           []*modelpb.CashSettlement1           `protobuf:"bytes,12,rep,name=cash_settlement,json=cashSettlement,proto3" json:"cash_settlement,omitempty"`
+	ServiceLevelAgreement    []*modelpb.DocumentToSend3           `protobuf:"bytes,13,rep,name=service_level_agreement,json=serviceLevelAgreement,proto3" json:"service_level_agreement,omitempty"`
+	AdditionalInformation    []*modelpb.AdditiononalInformation12 `protobuf:"bytes,14,rep,name=additional_information,json=additionalInformation,proto3" json:"additional_information,omitempty"`
+	MarketPracticeVersion    *modelpb.MarketPracticeVersion1      `protobuf:"bytes,15,opt,name=market_practice_version,json=marketPracticeVersion,proto3" json:"market_practice_version,omitempty"`
+	Extension                []*modelpb.Extension1                `protobuf:"bytes,16,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AccountOpeningInstructionV07) Reset() {
+	*x = AccountOpeningInstructionV07{}
+	mi := &file_iso20022_acmt_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountOpeningInstructionV07) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountOpeningInstructionV07) ProtoMessage() {}
+
+func (x *AccountOpeningInstructionV07) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountOpeningInstructionV07.ProtoReflect.Descriptor instead.
+func (*AccountOpeningInstructionV07) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AccountOpeningInstructionV07) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV07) GetOrderReference() *modelpb.InvestmentFundOrder4 {
+	if x != nil {
+		return x.OrderReference
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV07) GetPreviousReference() *modelpb.AdditionalReference6 {
+	if x != nil {
+		return x.PreviousReference
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV07) GetInstructionDetails() *modelpb.InvestmentAccountOpening3 {
+	if x != nil {
+		return x.InstructionDetails
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV07) GetInvestmentAccount() *modelpb.InvestmentAccount61 {
+	if x != nil {
+		return x.InvestmentAccount
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV07) GetAccountParties() *modelpb.AccountParties15 {
+	if x != nil {
+		return x.AccountParties
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV07) GetIntermediaries() []*modelpb.Intermediary36 {
+	if x != nil {
+		return x.Intermediaries
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV07) GetPlacement() *modelpb.ReferredAgent2 {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV07) GetNewIssueAllocation() *modelpb.NewIssueAllocation2 {
+	if x != nil {
+		return x.NewIssueAllocation
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV07) GetSavingsInvestmentPlan() []*modelpb.InvestmentPlan14 {
+	if x != nil {
+		return x.SavingsInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV07) GetWithdrawalInvestmentPlan() []*modelpb.InvestmentPlan14 {
+	if x != nil {
+		return x.WithdrawalInvestmentPlan
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV07) GetCashSettlement() []*modelpb.CashSettlement1 {
+	if x != nil {
+		return x.CashSettlement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV07) GetServiceLevelAgreement() []*modelpb.DocumentToSend3 {
+	if x != nil {
+		return x.ServiceLevelAgreement
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV07) GetAdditionalInformation() []*modelpb.AdditiononalInformation12 {
+	if x != nil {
+		return x.AdditionalInformation
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV07) GetMarketPracticeVersion() *modelpb.MarketPracticeVersion1 {
+	if x != nil {
+		return x.MarketPracticeVersion
+	}
+	return nil
+}
+
+func (x *AccountOpeningInstructionV07) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// Scope
+// The AccountOpeningRequest message is sent from an organisation to a financial institution as part of the account opening process. It is the initial request to open an account.
+// Usage
+// This message should only be used for initiating the account opening process.
+// It could be sent together with other related documents.
+type AccountOpeningRequestV01 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	Account                       *modelpb.CustomerAccount1                             `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	ContractDates                 *modelpb.AccountContract2                             `protobuf:"bytes,3,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	UnderlyingMasterAgreement     *modelpb.ContractDocument1                            `protobuf:"bytes,4,opt,name=underlying_master_agreement,json=underlyingMasterAgreement,proto3" json:"underlying_master_agreement,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,5,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	Organisation                  []*modelpb.Organisation7                              `protobuf:"bytes,6,rep,name=organisation,proto3" json:"organisation,omitempty"`
+	Mandate                       []*modelpb.OperationMandate1                          `protobuf:"bytes,7,rep,name=mandate,proto3" json:"mandate,omitempty"`
+	ReferenceAccount              *modelpb.CashAccount16                                `protobuf:"bytes,8,opt,name=reference_account,json=referenceAccount,proto3" json:"reference_account,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature1                         `protobuf:"bytes,9,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountOpeningRequestV01) Reset() {
+	*x = AccountOpeningRequestV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountOpeningRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountOpeningRequestV01) ProtoMessage() {}
+
+func (x *AccountOpeningRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountOpeningRequestV01.ProtoReflect.Descriptor instead.
+func (*AccountOpeningRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AccountOpeningRequestV01) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV01) GetAccount() *modelpb.CustomerAccount1 {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV01) GetContractDates() *modelpb.AccountContract2 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV01) GetUnderlyingMasterAgreement() *modelpb.ContractDocument1 {
+	if x != nil {
+		return x.UnderlyingMasterAgreement
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV01) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV01) GetOrganisation() []*modelpb.Organisation7 {
+	if x != nil {
+		return x.Organisation
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV01) GetMandate() []*modelpb.OperationMandate1 {
+	if x != nil {
+		return x.Mandate
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV01) GetReferenceAccount() *modelpb.CashAccount16 {
+	if x != nil {
+		return x.ReferenceAccount
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV01) GetDigitalSignature() []*modelpb.PartyAndSignature1 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+// The AccountOpeningRequest message is sent from an organisation to a financial institution as part of the account opening process. It is the initial request message to open an account.
+type AccountOpeningRequestV02 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	From                          *modelpb.OrganisationIdentification8                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Account                       *modelpb.CustomerAccount4                             `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	ContractDates                 *modelpb.AccountContract2                             `protobuf:"bytes,4,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	UnderlyingMasterAgreement     *modelpb.ContractDocument1                            `protobuf:"bytes,5,opt,name=underlying_master_agreement,json=underlyingMasterAgreement,proto3" json:"underlying_master_agreement,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,6,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	Organisation                  *modelpb.Organisation12                               `protobuf:"bytes,7,opt,name=organisation,proto3" json:"organisation,omitempty"`
+	Mandate                       []*modelpb.OperationMandate2                          `protobuf:"bytes,8,rep,name=mandate,proto3" json:"mandate,omitempty"`
+	Group                         []*modelpb.Group1                                     `protobuf:"bytes,9,rep,name=group,proto3" json:"group,omitempty"`
+	ReferenceAccount              *modelpb.CashAccount24                                `protobuf:"bytes,10,opt,name=reference_account,json=referenceAccount,proto3" json:"reference_account,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature2                         `protobuf:"bytes,11,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1                         `protobuf:"bytes,12,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountOpeningRequestV02) Reset() {
+	*x = AccountOpeningRequestV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountOpeningRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountOpeningRequestV02) ProtoMessage() {}
+
+func (x *AccountOpeningRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountOpeningRequestV02.ProtoReflect.Descriptor instead.
+func (*AccountOpeningRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AccountOpeningRequestV02) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV02) GetFrom() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV02) GetAccount() *modelpb.CustomerAccount4 {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV02) GetContractDates() *modelpb.AccountContract2 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV02) GetUnderlyingMasterAgreement() *modelpb.ContractDocument1 {
+	if x != nil {
+		return x.UnderlyingMasterAgreement
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV02) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV02) GetOrganisation() *modelpb.Organisation12 {
+	if x != nil {
+		return x.Organisation
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV02) GetMandate() []*modelpb.OperationMandate2 {
+	if x != nil {
+		return x.Mandate
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV02) GetGroup() []*modelpb.Group1 {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV02) GetReferenceAccount() *modelpb.CashAccount24 {
+	if x != nil {
+		return x.ReferenceAccount
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV02) GetDigitalSignature() []*modelpb.PartyAndSignature2 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+func (x *AccountOpeningRequestV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The AccountReportRequest message is sent from an organisation to a financial institution for reporting purposes. It is a request for an account report.
+// Usage
+// This message can be sent at any time outside of account opening, maintenance or closing processes.
+type AccountReportRequestV01 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	AccountIdentification         *modelpb.AccountForAction1                            `protobuf:"bytes,2,opt,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,3,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	OrganisationIdentification    []*modelpb.OrganisationIdentification6                `protobuf:"bytes,4,rep,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	ContractDates                 *modelpb.AccountContract2                             `protobuf:"bytes,5,opt,name=contract_dates,json=contractDates,proto3" json:"contract_dates,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature1                         `protobuf:"bytes,6,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountReportRequestV01) Reset() {
+	*x = AccountReportRequestV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountReportRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountReportRequestV01) ProtoMessage() {}
+
+func (x *AccountReportRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountReportRequestV01.ProtoReflect.Descriptor instead.
+func (*AccountReportRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AccountReportRequestV01) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountReportRequestV01) GetAccountIdentification() *modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountReportRequestV01) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountReportRequestV01) GetOrganisationIdentification() []*modelpb.OrganisationIdentification6 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountReportRequestV01) GetContractDates() *modelpb.AccountContract2 {
+	if x != nil {
+		return x.ContractDates
+	}
+	return nil
+}
+
+func (x *AccountReportRequestV01) GetDigitalSignature() []*modelpb.PartyAndSignature1 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+// The AccountReportRequest message is sent from an organisation to a financial institution for reporting purposes. It is a request for an account report. This message can be sent at any time outside of account opening, maintenance or closing processes.
+type AccountReportRequestV02 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References4                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	From                          *modelpb.OrganisationIdentification8                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	AccountIdentification         []*modelpb.AccountForAction1                          `protobuf:"bytes,3,rep,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,4,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	OrganisationIdentification    *modelpb.OrganisationIdentification8                  `protobuf:"bytes,5,opt,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature2                         `protobuf:"bytes,6,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1                         `protobuf:"bytes,7,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountReportRequestV02) Reset() {
+	*x = AccountReportRequestV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountReportRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountReportRequestV02) ProtoMessage() {}
+
+func (x *AccountReportRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountReportRequestV02.ProtoReflect.Descriptor instead.
+func (*AccountReportRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AccountReportRequestV02) GetReferences() *modelpb.References4 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountReportRequestV02) GetFrom() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AccountReportRequestV02) GetAccountIdentification() []*modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountReportRequestV02) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountReportRequestV02) GetOrganisationIdentification() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountReportRequestV02) GetDigitalSignature() []*modelpb.PartyAndSignature2 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+func (x *AccountReportRequestV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The AccountReport message is sent from a financial institution to an organisation for reporting purposes.
+// Usage
+// It can be sent unsolicited as part of opening, maintenance, or closing process, or it can be sent as response to an AccountReportRequest message.
+type AccountReportV01 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References5                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,2,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	Organisation                  []*modelpb.Organisation6                              `protobuf:"bytes,3,rep,name=organisation,proto3" json:"organisation,omitempty"`
+	Report                        []*modelpb.AccountReport1                             `protobuf:"bytes,4,rep,name=report,proto3" json:"report,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature1                         `protobuf:"bytes,5,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountReportV01) Reset() {
+	*x = AccountReportV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountReportV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountReportV01) ProtoMessage() {}
+
+func (x *AccountReportV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountReportV01.ProtoReflect.Descriptor instead.
+func (*AccountReportV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AccountReportV01) GetReferences() *modelpb.References5 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountReportV01) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountReportV01) GetOrganisation() []*modelpb.Organisation6 {
+	if x != nil {
+		return x.Organisation
+	}
+	return nil
+}
+
+func (x *AccountReportV01) GetReport() []*modelpb.AccountReport1 {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *AccountReportV01) GetDigitalSignature() []*modelpb.PartyAndSignature1 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+// The AccountReport message is sent from a financial institution to an organisation for reporting purposes. It can be sent unsolicited as part of opening, maintenance, or closing process, or it can be sent as response to an AccountReportRequest message.
+type AccountReportV02 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References5                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	From                          *modelpb.OrganisationIdentification8                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,3,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	Organisation                  *modelpb.Organisation12                               `protobuf:"bytes,4,opt,name=organisation,proto3" json:"organisation,omitempty"`
+	Report                        []*modelpb.AccountReport15                            `protobuf:"bytes,5,rep,name=report,proto3" json:"report,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature2                         `protobuf:"bytes,6,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1                         `protobuf:"bytes,7,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountReportV02) Reset() {
+	*x = AccountReportV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountReportV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountReportV02) ProtoMessage() {}
+
+func (x *AccountReportV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountReportV02.ProtoReflect.Descriptor instead.
+func (*AccountReportV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AccountReportV02) GetReferences() *modelpb.References5 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountReportV02) GetFrom() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AccountReportV02) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountReportV02) GetOrganisation() *modelpb.Organisation12 {
+	if x != nil {
+		return x.Organisation
+	}
+	return nil
+}
+
+func (x *AccountReportV02) GetReport() []*modelpb.AccountReport15 {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *AccountReportV02) GetDigitalSignature() []*modelpb.PartyAndSignature2 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+func (x *AccountReportV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The AccountRequestAcknowledgement message is sent from a financial institution to an organisation. This message is sent in response to a request message from the organisation. It is sent after the request has been validated from an authentication and authorization point of view. The business content has not yet been validated at this stage.
+// Usage
+// This message should only be sent after the request has been validated from an authentication and authorization point of view.
+type AccountRequestAcknowledgementV01 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References5                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	AccountIdentification         []*modelpb.AccountForAction1                          `protobuf:"bytes,2,rep,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	OrganisationIdentification    []*modelpb.OrganisationIdentification6                `protobuf:"bytes,3,rep,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,4,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature1                         `protobuf:"bytes,5,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountRequestAcknowledgementV01) Reset() {
+	*x = AccountRequestAcknowledgementV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountRequestAcknowledgementV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountRequestAcknowledgementV01) ProtoMessage() {}
+
+func (x *AccountRequestAcknowledgementV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountRequestAcknowledgementV01.ProtoReflect.Descriptor instead.
+func (*AccountRequestAcknowledgementV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AccountRequestAcknowledgementV01) GetReferences() *modelpb.References5 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountRequestAcknowledgementV01) GetAccountIdentification() []*modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountRequestAcknowledgementV01) GetOrganisationIdentification() []*modelpb.OrganisationIdentification6 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountRequestAcknowledgementV01) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountRequestAcknowledgementV01) GetDigitalSignature() []*modelpb.PartyAndSignature1 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+// The AccountRequestAcknowledgement message is sent from a financial institution to an organisation. This message is sent in response to a request message from the organisation. It is sent after the request has been validated from an authentication and authorization point of view. The business content has not yet been validated at this stage.
+type AccountRequestAcknowledgementV02 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References5                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	From                          *modelpb.OrganisationIdentification8                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	AccountIdentification         []*modelpb.AccountForAction1                          `protobuf:"bytes,3,rep,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	OrganisationIdentification    *modelpb.OrganisationIdentification8                  `protobuf:"bytes,4,opt,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,5,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature2                         `protobuf:"bytes,6,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1                         `protobuf:"bytes,7,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountRequestAcknowledgementV02) Reset() {
+	*x = AccountRequestAcknowledgementV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountRequestAcknowledgementV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountRequestAcknowledgementV02) ProtoMessage() {}
+
+func (x *AccountRequestAcknowledgementV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountRequestAcknowledgementV02.ProtoReflect.Descriptor instead.
+func (*AccountRequestAcknowledgementV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AccountRequestAcknowledgementV02) GetReferences() *modelpb.References5 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountRequestAcknowledgementV02) GetFrom() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AccountRequestAcknowledgementV02) GetAccountIdentification() []*modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountRequestAcknowledgementV02) GetOrganisationIdentification() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountRequestAcknowledgementV02) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountRequestAcknowledgementV02) GetDigitalSignature() []*modelpb.PartyAndSignature2 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+func (x *AccountRequestAcknowledgementV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The AccountRequestRejection message is sent from a financial institution to an organisation. This message is sent in response to a request message from the organisation, if the business content is not valid.
+// Usage
+type AccountRequestRejectionV01 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References6                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification4 `protobuf:"bytes,2,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	AccountIdentification         []*modelpb.AccountForAction1                          `protobuf:"bytes,3,rep,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	OrganisationIdentification    []*modelpb.OrganisationIdentification6                `protobuf:"bytes,4,rep,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature1                         `protobuf:"bytes,5,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountRequestRejectionV01) Reset() {
+	*x = AccountRequestRejectionV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountRequestRejectionV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountRequestRejectionV01) ProtoMessage() {}
+
+func (x *AccountRequestRejectionV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountRequestRejectionV01.ProtoReflect.Descriptor instead.
+func (*AccountRequestRejectionV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AccountRequestRejectionV01) GetReferences() *modelpb.References6 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountRequestRejectionV01) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification4 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountRequestRejectionV01) GetAccountIdentification() []*modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountRequestRejectionV01) GetOrganisationIdentification() []*modelpb.OrganisationIdentification6 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountRequestRejectionV01) GetDigitalSignature() []*modelpb.PartyAndSignature1 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+// The AccountRequestRejection message is sent from a financial institution to an organisation. This message is sent in response to a request message from the organisation, if the business content is not valid.
+type AccountRequestRejectionV02 struct {
+	state                         protoimpl.MessageState                                `protogen:"open.v1"`
+	References                    *modelpb.References6                                  `protobuf:"bytes,1,opt,name=references,proto3" json:"references,omitempty"`
+	From                          *modelpb.OrganisationIdentification8                  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	AccountServicerIdentification *modelpb.BranchAndFinancialInstitutionIdentification5 `protobuf:"bytes,3,opt,name=account_servicer_identification,json=accountServicerIdentification,proto3" json:"account_servicer_identification,omitempty"`
+	AccountIdentification         []*modelpb.AccountForAction1                          `protobuf:"bytes,4,rep,name=account_identification,json=accountIdentification,proto3" json:"account_identification,omitempty"`
+	OrganisationIdentification    *modelpb.OrganisationIdentification8                  `protobuf:"bytes,5,opt,name=organisation_identification,json=organisationIdentification,proto3" json:"organisation_identification,omitempty"`
+	DigitalSignature              []*modelpb.PartyAndSignature2                         `protobuf:"bytes,6,rep,name=digital_signature,json=digitalSignature,proto3" json:"digital_signature,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1                         `protobuf:"bytes,7,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *AccountRequestRejectionV02) Reset() {
+	*x = AccountRequestRejectionV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountRequestRejectionV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountRequestRejectionV02) ProtoMessage() {}
+
+func (x *AccountRequestRejectionV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountRequestRejectionV02.ProtoReflect.Descriptor instead.
+func (*AccountRequestRejectionV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AccountRequestRejectionV02) GetReferences() *modelpb.References6 {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *AccountRequestRejectionV02) GetFrom() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AccountRequestRejectionV02) GetAccountServicerIdentification() *modelpb.BranchAndFinancialInstitutionIdentification5 {
+	if x != nil {
+		return x.AccountServicerIdentification
+	}
+	return nil
+}
+
+func (x *AccountRequestRejectionV02) GetAccountIdentification() []*modelpb.AccountForAction1 {
+	if x != nil {
+		return x.AccountIdentification
+	}
+	return nil
+}
+
+func (x *AccountRequestRejectionV02) GetOrganisationIdentification() *modelpb.OrganisationIdentification8 {
+	if x != nil {
+		return x.OrganisationIdentification
+	}
+	return nil
+}
+
+func (x *AccountRequestRejectionV02) GetDigitalSignature() []*modelpb.PartyAndSignature2 {
+	if x != nil {
+		return x.DigitalSignature
+	}
+	return nil
+}
+
+func (x *AccountRequestRejectionV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+type Document00100102 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *AccountOpeningInstructionV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100102) Reset() {
+	*x = Document00100102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100102) ProtoMessage() {}
+
+func (x *Document00100102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100102.ProtoReflect.Descriptor instead.
+func (*Document00100102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Document00100102) GetMessage() *AccountOpeningInstructionV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00100103 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *AccountOpeningInstructionV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100103) Reset() {
+	*x = Document00100103{}
+	mi := &file_iso20022_acmt_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100103) ProtoMessage() {}
+
+func (x *Document00100103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100103.ProtoReflect.Descriptor instead.
+func (*Document00100103) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Document00100103) GetMessage() *AccountOpeningInstructionV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00100104 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *AccountOpeningInstructionV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100104) Reset() {
+	*x = Document00100104{}
+	mi := &file_iso20022_acmt_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100104) ProtoMessage() {}
+
+func (x *Document00100104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100104.ProtoReflect.Descriptor instead.
+func (*Document00100104) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Document00100104) GetMessage() *AccountOpeningInstructionV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00100105 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *AccountOpeningInstructionV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100105) Reset() {
+	*x = Document00100105{}
+	mi := &file_iso20022_acmt_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100105) ProtoMessage() {}
+
+func (x *Document00100105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100105.ProtoReflect.Descriptor instead.
+func (*Document00100105) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Document00100105) GetMessage() *AccountOpeningInstructionV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00100106 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *AccountOpeningInstructionV06 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100106) Reset() {
+	*x = Document00100106{}
+	mi := &file_iso20022_acmt_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100106) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100106) ProtoMessage() {}
+
+func (x *Document00100106) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100106.ProtoReflect.Descriptor instead.
+func (*Document00100106) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Document00100106) GetMessage() *AccountOpeningInstructionV06 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00100107 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *AccountOpeningInstructionV07 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100107) Reset() {
+	*x = Document00100107{}
+	mi := &file_iso20022_acmt_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100107) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100107) ProtoMessage() {}
+
+func (x *Document00100107) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100107.ProtoReflect.Descriptor instead.
+func (*Document00100107) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Document00100107) GetMessage() *AccountOpeningInstructionV07 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200102 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AccountDetailsConfirmationV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200102) Reset() {
+	*x = Document00200102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200102) ProtoMessage() {}
+
+func (x *Document00200102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200102.ProtoReflect.Descriptor instead.
+func (*Document00200102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Document00200102) GetMessage() *AccountDetailsConfirmationV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200103 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AccountDetailsConfirmationV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200103) Reset() {
+	*x = Document00200103{}
+	mi := &file_iso20022_acmt_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200103) ProtoMessage() {}
+
+func (x *Document00200103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200103.ProtoReflect.Descriptor instead.
+func (*Document00200103) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Document00200103) GetMessage() *AccountDetailsConfirmationV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200104 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AccountDetailsConfirmationV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200104) Reset() {
+	*x = Document00200104{}
+	mi := &file_iso20022_acmt_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200104) ProtoMessage() {}
+
+func (x *Document00200104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200104.ProtoReflect.Descriptor instead.
+func (*Document00200104) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Document00200104) GetMessage() *AccountDetailsConfirmationV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200105 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AccountDetailsConfirmationV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200105) Reset() {
+	*x = Document00200105{}
+	mi := &file_iso20022_acmt_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200105) ProtoMessage() {}
+
+func (x *Document00200105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200105.ProtoReflect.Descriptor instead.
+func (*Document00200105) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Document00200105) GetMessage() *AccountDetailsConfirmationV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200106 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AccountDetailsConfirmationV06 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200106) Reset() {
+	*x = Document00200106{}
+	mi := &file_iso20022_acmt_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200106) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200106) ProtoMessage() {}
+
+func (x *Document00200106) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200106.ProtoReflect.Descriptor instead.
+func (*Document00200106) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Document00200106) GetMessage() *AccountDetailsConfirmationV06 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200107 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AccountDetailsConfirmationV07 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200107) Reset() {
+	*x = Document00200107{}
+	mi := &file_iso20022_acmt_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200107) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200107) ProtoMessage() {}
+
+func (x *Document00200107) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200107.ProtoReflect.Descriptor instead.
+func (*Document00200107) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Document00200107) GetMessage() *AccountDetailsConfirmationV07 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300102 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *AccountModificationInstructionV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300102) Reset() {
+	*x = Document00300102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300102) ProtoMessage() {}
+
+func (x *Document00300102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300102.ProtoReflect.Descriptor instead.
+func (*Document00300102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Document00300102) GetMessage() *AccountModificationInstructionV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300103 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *AccountModificationInstructionV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300103) Reset() {
+	*x = Document00300103{}
+	mi := &file_iso20022_acmt_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300103) ProtoMessage() {}
+
+func (x *Document00300103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300103.ProtoReflect.Descriptor instead.
+func (*Document00300103) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Document00300103) GetMessage() *AccountModificationInstructionV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300104 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *AccountModificationInstructionV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300104) Reset() {
+	*x = Document00300104{}
+	mi := &file_iso20022_acmt_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300104) ProtoMessage() {}
+
+func (x *Document00300104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300104.ProtoReflect.Descriptor instead.
+func (*Document00300104) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Document00300104) GetMessage() *AccountModificationInstructionV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300105 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *AccountModificationInstructionV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300105) Reset() {
+	*x = Document00300105{}
+	mi := &file_iso20022_acmt_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300105) ProtoMessage() {}
+
+func (x *Document00300105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300105.ProtoReflect.Descriptor instead.
+func (*Document00300105) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Document00300105) GetMessage() *AccountModificationInstructionV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300106 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *AccountModificationInstructionV06 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300106) Reset() {
+	*x = Document00300106{}
+	mi := &file_iso20022_acmt_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300106) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300106) ProtoMessage() {}
+
+func (x *Document00300106) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300106.ProtoReflect.Descriptor instead.
+func (*Document00300106) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Document00300106) GetMessage() *AccountModificationInstructionV06 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300107 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *AccountModificationInstructionV07 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300107) Reset() {
+	*x = Document00300107{}
+	mi := &file_iso20022_acmt_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300107) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300107) ProtoMessage() {}
+
+func (x *Document00300107) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300107.ProtoReflect.Descriptor instead.
+func (*Document00300107) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Document00300107) GetMessage() *AccountModificationInstructionV07 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400105 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *GetAccountDetailsV05  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400105) Reset() {
+	*x = Document00400105{}
+	mi := &file_iso20022_acmt_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400105) ProtoMessage() {}
+
+func (x *Document00400105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400105.ProtoReflect.Descriptor instead.
+func (*Document00400105) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Document00400105) GetMessage() *GetAccountDetailsV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500102 struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Message       *RequestForAccountManagementStatusReportV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500102) Reset() {
+	*x = Document00500102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500102) ProtoMessage() {}
+
+func (x *Document00500102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500102.ProtoReflect.Descriptor instead.
+func (*Document00500102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Document00500102) GetMessage() *RequestForAccountManagementStatusReportV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500103 struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Message       *RequestForAccountManagementStatusReportV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500103) Reset() {
+	*x = Document00500103{}
+	mi := &file_iso20022_acmt_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500103) ProtoMessage() {}
+
+func (x *Document00500103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500103.ProtoReflect.Descriptor instead.
+func (*Document00500103) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Document00500103) GetMessage() *RequestForAccountManagementStatusReportV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500104 struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Message       *RequestForAccountManagementStatusReportV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500104) Reset() {
+	*x = Document00500104{}
+	mi := &file_iso20022_acmt_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500104) ProtoMessage() {}
+
+func (x *Document00500104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500104.ProtoReflect.Descriptor instead.
+func (*Document00500104) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Document00500104) GetMessage() *RequestForAccountManagementStatusReportV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500105 struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Message       *RequestForAccountManagementStatusReportV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500105) Reset() {
+	*x = Document00500105{}
+	mi := &file_iso20022_acmt_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500105) ProtoMessage() {}
+
+func (x *Document00500105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500105.ProtoReflect.Descriptor instead.
+func (*Document00500105) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Document00500105) GetMessage() *RequestForAccountManagementStatusReportV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600102 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AccountManagementStatusReportV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600102) Reset() {
+	*x = Document00600102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600102) ProtoMessage() {}
+
+func (x *Document00600102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600102.ProtoReflect.Descriptor instead.
+func (*Document00600102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Document00600102) GetMessage() *AccountManagementStatusReportV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600103 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AccountManagementStatusReportV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600103) Reset() {
+	*x = Document00600103{}
+	mi := &file_iso20022_acmt_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600103) ProtoMessage() {}
+
+func (x *Document00600103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600103.ProtoReflect.Descriptor instead.
+func (*Document00600103) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Document00600103) GetMessage() *AccountManagementStatusReportV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600104 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AccountManagementStatusReportV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600104) Reset() {
+	*x = Document00600104{}
+	mi := &file_iso20022_acmt_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600104) ProtoMessage() {}
+
+func (x *Document00600104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600104.ProtoReflect.Descriptor instead.
+func (*Document00600104) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Document00600104) GetMessage() *AccountManagementStatusReportV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600105 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AccountManagementStatusReportV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600105) Reset() {
+	*x = Document00600105{}
+	mi := &file_iso20022_acmt_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600105) ProtoMessage() {}
+
+func (x *Document00600105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600105.ProtoReflect.Descriptor instead.
+func (*Document00600105) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Document00600105) GetMessage() *AccountManagementStatusReportV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600106 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AccountManagementStatusReportV06 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600106) Reset() {
+	*x = Document00600106{}
+	mi := &file_iso20022_acmt_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600106) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600106) ProtoMessage() {}
+
+func (x *Document00600106) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600106.ProtoReflect.Descriptor instead.
+func (*Document00600106) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Document00600106) GetMessage() *AccountManagementStatusReportV06 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00700101 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *AccountOpeningRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00700101) Reset() {
+	*x = Document00700101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00700101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00700101) ProtoMessage() {}
+
+func (x *Document00700101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00700101.ProtoReflect.Descriptor instead.
+func (*Document00700101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Document00700101) GetMessage() *AccountOpeningRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00700102 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *AccountOpeningRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00700102) Reset() {
+	*x = Document00700102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00700102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00700102) ProtoMessage() {}
+
+func (x *Document00700102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00700102.ProtoReflect.Descriptor instead.
+func (*Document00700102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Document00700102) GetMessage() *AccountOpeningRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800101 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *AccountOpeningAmendmentRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800101) Reset() {
+	*x = Document00800101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800101) ProtoMessage() {}
+
+func (x *Document00800101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800101.ProtoReflect.Descriptor instead.
+func (*Document00800101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Document00800101) GetMessage() *AccountOpeningAmendmentRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800102 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *AccountOpeningAmendmentRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800102) Reset() {
+	*x = Document00800102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800102) ProtoMessage() {}
+
+func (x *Document00800102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800102.ProtoReflect.Descriptor instead.
+func (*Document00800102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Document00800102) GetMessage() *AccountOpeningAmendmentRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900101 struct {
+	state         protoimpl.MessageState                         `protogen:"open.v1"`
+	Message       *AccountOpeningAdditionalInformationRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900101) Reset() {
+	*x = Document00900101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900101) ProtoMessage() {}
+
+func (x *Document00900101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900101.ProtoReflect.Descriptor instead.
+func (*Document00900101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Document00900101) GetMessage() *AccountOpeningAdditionalInformationRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900102 struct {
+	state         protoimpl.MessageState                         `protogen:"open.v1"`
+	Message       *AccountOpeningAdditionalInformationRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900102) Reset() {
+	*x = Document00900102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900102) ProtoMessage() {}
+
+func (x *Document00900102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900102.ProtoReflect.Descriptor instead.
+func (*Document00900102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *Document00900102) GetMessage() *AccountOpeningAdditionalInformationRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000101 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AccountRequestAcknowledgementV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000101) Reset() {
+	*x = Document01000101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000101) ProtoMessage() {}
+
+func (x *Document01000101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000101.ProtoReflect.Descriptor instead.
+func (*Document01000101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *Document01000101) GetMessage() *AccountRequestAcknowledgementV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000102 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AccountRequestAcknowledgementV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000102) Reset() {
+	*x = Document01000102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000102) ProtoMessage() {}
+
+func (x *Document01000102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000102.ProtoReflect.Descriptor instead.
+func (*Document01000102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *Document01000102) GetMessage() *AccountRequestAcknowledgementV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01100101 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Message       *AccountRequestRejectionV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01100101) Reset() {
+	*x = Document01100101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01100101) ProtoMessage() {}
+
+func (x *Document01100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01100101.ProtoReflect.Descriptor instead.
+func (*Document01100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Document01100101) GetMessage() *AccountRequestRejectionV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01100102 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Message       *AccountRequestRejectionV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01100102) Reset() {
+	*x = Document01100102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01100102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01100102) ProtoMessage() {}
+
+func (x *Document01100102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01100102.ProtoReflect.Descriptor instead.
+func (*Document01100102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Document01100102) GetMessage() *AccountRequestRejectionV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01200101 struct {
+	state         protoimpl.MessageState                  `protogen:"open.v1"`
+	Message       *AccountAdditionalInformationRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01200101) Reset() {
+	*x = Document01200101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01200101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01200101) ProtoMessage() {}
+
+func (x *Document01200101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01200101.ProtoReflect.Descriptor instead.
+func (*Document01200101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *Document01200101) GetMessage() *AccountAdditionalInformationRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01200102 struct {
+	state         protoimpl.MessageState                  `protogen:"open.v1"`
+	Message       *AccountAdditionalInformationRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01200102) Reset() {
+	*x = Document01200102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01200102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01200102) ProtoMessage() {}
+
+func (x *Document01200102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01200102.ProtoReflect.Descriptor instead.
+func (*Document01200102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *Document01200102) GetMessage() *AccountAdditionalInformationRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01300101 struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Message       *AccountReportRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01300101) Reset() {
+	*x = Document01300101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01300101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01300101) ProtoMessage() {}
+
+func (x *Document01300101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01300101.ProtoReflect.Descriptor instead.
+func (*Document01300101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *Document01300101) GetMessage() *AccountReportRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01300102 struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Message       *AccountReportRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01300102) Reset() {
+	*x = Document01300102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01300102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01300102) ProtoMessage() {}
+
+func (x *Document01300102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01300102.ProtoReflect.Descriptor instead.
+func (*Document01300102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *Document01300102) GetMessage() *AccountReportRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01400101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *AccountReportV01      `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01400101) Reset() {
+	*x = Document01400101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01400101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01400101) ProtoMessage() {}
+
+func (x *Document01400101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01400101.ProtoReflect.Descriptor instead.
+func (*Document01400101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Document01400101) GetMessage() *AccountReportV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01400102 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *AccountReportV02      `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01400102) Reset() {
+	*x = Document01400102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01400102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01400102) ProtoMessage() {}
+
+func (x *Document01400102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01400102.ProtoReflect.Descriptor instead.
+func (*Document01400102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *Document01400102) GetMessage() *AccountReportV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01500101 struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	Message       *AccountExcludedMandateMaintenanceRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01500101) Reset() {
+	*x = Document01500101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01500101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01500101) ProtoMessage() {}
+
+func (x *Document01500101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01500101.ProtoReflect.Descriptor instead.
+func (*Document01500101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *Document01500101) GetMessage() *AccountExcludedMandateMaintenanceRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01500102 struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	Message       *AccountExcludedMandateMaintenanceRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01500102) Reset() {
+	*x = Document01500102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01500102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01500102) ProtoMessage() {}
+
+func (x *Document01500102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01500102.ProtoReflect.Descriptor instead.
+func (*Document01500102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Document01500102) GetMessage() *AccountExcludedMandateMaintenanceRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01600101 struct {
+	state         protoimpl.MessageState                                `protogen:"open.v1"`
+	Message       *AccountExcludedMandateMaintenanceAmendmentRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01600101) Reset() {
+	*x = Document01600101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01600101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01600101) ProtoMessage() {}
+
+func (x *Document01600101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01600101.ProtoReflect.Descriptor instead.
+func (*Document01600101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Document01600101) GetMessage() *AccountExcludedMandateMaintenanceAmendmentRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01600102 struct {
+	state         protoimpl.MessageState                                `protogen:"open.v1"`
+	Message       *AccountExcludedMandateMaintenanceAmendmentRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01600102) Reset() {
+	*x = Document01600102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01600102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01600102) ProtoMessage() {}
+
+func (x *Document01600102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01600102.ProtoReflect.Descriptor instead.
+func (*Document01600102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *Document01600102) GetMessage() *AccountExcludedMandateMaintenanceAmendmentRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01700101 struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Message       *AccountMandateMaintenanceRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01700101) Reset() {
+	*x = Document01700101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01700101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01700101) ProtoMessage() {}
+
+func (x *Document01700101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01700101.ProtoReflect.Descriptor instead.
+func (*Document01700101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *Document01700101) GetMessage() *AccountMandateMaintenanceRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01700102 struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Message       *AccountMandateMaintenanceRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01700102) Reset() {
+	*x = Document01700102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01700102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01700102) ProtoMessage() {}
+
+func (x *Document01700102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01700102.ProtoReflect.Descriptor instead.
+func (*Document01700102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *Document01700102) GetMessage() *AccountMandateMaintenanceRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01800101 struct {
+	state         protoimpl.MessageState                        `protogen:"open.v1"`
+	Message       *AccountMandateMaintenanceAmendmentRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01800101) Reset() {
+	*x = Document01800101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01800101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01800101) ProtoMessage() {}
+
+func (x *Document01800101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01800101.ProtoReflect.Descriptor instead.
+func (*Document01800101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *Document01800101) GetMessage() *AccountMandateMaintenanceAmendmentRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01800102 struct {
+	state         protoimpl.MessageState                        `protogen:"open.v1"`
+	Message       *AccountMandateMaintenanceAmendmentRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01800102) Reset() {
+	*x = Document01800102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01800102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01800102) ProtoMessage() {}
+
+func (x *Document01800102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01800102.ProtoReflect.Descriptor instead.
+func (*Document01800102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *Document01800102) GetMessage() *AccountMandateMaintenanceAmendmentRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01900101 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *AccountClosingRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01900101) Reset() {
+	*x = Document01900101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01900101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01900101) ProtoMessage() {}
+
+func (x *Document01900101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01900101.ProtoReflect.Descriptor instead.
+func (*Document01900101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *Document01900101) GetMessage() *AccountClosingRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01900102 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *AccountClosingRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01900102) Reset() {
+	*x = Document01900102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01900102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01900102) ProtoMessage() {}
+
+func (x *Document01900102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01900102.ProtoReflect.Descriptor instead.
+func (*Document01900102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *Document01900102) GetMessage() *AccountClosingRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02000101 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *AccountClosingAmendmentRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02000101) Reset() {
+	*x = Document02000101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02000101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02000101) ProtoMessage() {}
+
+func (x *Document02000101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02000101.ProtoReflect.Descriptor instead.
+func (*Document02000101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *Document02000101) GetMessage() *AccountClosingAmendmentRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02000102 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *AccountClosingAmendmentRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02000102) Reset() {
+	*x = Document02000102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02000102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02000102) ProtoMessage() {}
+
+func (x *Document02000102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02000102.ProtoReflect.Descriptor instead.
+func (*Document02000102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *Document02000102) GetMessage() *AccountClosingAmendmentRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02100101 struct {
+	state         protoimpl.MessageState                         `protogen:"open.v1"`
+	Message       *AccountClosingAdditionalInformationRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02100101) Reset() {
+	*x = Document02100101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02100101) ProtoMessage() {}
+
+func (x *Document02100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02100101.ProtoReflect.Descriptor instead.
+func (*Document02100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *Document02100101) GetMessage() *AccountClosingAdditionalInformationRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02100102 struct {
+	state         protoimpl.MessageState                         `protogen:"open.v1"`
+	Message       *AccountClosingAdditionalInformationRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02100102) Reset() {
+	*x = Document02100102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02100102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02100102) ProtoMessage() {}
+
+func (x *Document02100102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02100102.ProtoReflect.Descriptor instead.
+func (*Document02100102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *Document02100102) GetMessage() *AccountClosingAdditionalInformationRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02200101 struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Message       *IdentificationModificationAdviceV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02200101) Reset() {
+	*x = Document02200101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02200101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02200101) ProtoMessage() {}
+
+func (x *Document02200101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02200101.ProtoReflect.Descriptor instead.
+func (*Document02200101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *Document02200101) GetMessage() *IdentificationModificationAdviceV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02200102 struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Message       *IdentificationModificationAdviceV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02200102) Reset() {
+	*x = Document02200102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02200102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02200102) ProtoMessage() {}
+
+func (x *Document02200102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02200102.ProtoReflect.Descriptor instead.
+func (*Document02200102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *Document02200102) GetMessage() *IdentificationModificationAdviceV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02300101 struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Message       *IdentificationVerificationRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02300101) Reset() {
+	*x = Document02300101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02300101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02300101) ProtoMessage() {}
+
+func (x *Document02300101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02300101.ProtoReflect.Descriptor instead.
+func (*Document02300101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *Document02300101) GetMessage() *IdentificationVerificationRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02300102 struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Message       *IdentificationVerificationRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02300102) Reset() {
+	*x = Document02300102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02300102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02300102) ProtoMessage() {}
+
+func (x *Document02300102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02300102.ProtoReflect.Descriptor instead.
+func (*Document02300102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *Document02300102) GetMessage() *IdentificationVerificationRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02400101 struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Message       *IdentificationVerificationReportV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02400101) Reset() {
+	*x = Document02400101{}
+	mi := &file_iso20022_acmt_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02400101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02400101) ProtoMessage() {}
+
+func (x *Document02400101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02400101.ProtoReflect.Descriptor instead.
+func (*Document02400101) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *Document02400101) GetMessage() *IdentificationVerificationReportV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02400102 struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Message       *IdentificationVerificationReportV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02400102) Reset() {
+	*x = Document02400102{}
+	mi := &file_iso20022_acmt_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02400102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02400102) ProtoMessage() {}
+
+func (x *Document02400102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02400102.ProtoReflect.Descriptor instead.
+func (*Document02400102) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *Document02400102) GetMessage() *IdentificationVerificationReportV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// Scope
+// The GetAccountDetails message is sent by an account owner, for example, an investor or its designated agent to the account servicer, for example, a registrar, transfer agent, custodian bank or securities depository to query the details of an existing account.
+// Usage
+// The GetAccountDetails message is used to query all or some of the account details for a given account held with an account servicer.
+// The message is used prior to an AccountModificationInstruction in order to validate account information before requesting a modification.
+// The GetAccountDetails message may also be used to collect account information for general account reconciliation purposes.
+// The response to a GetAccountDetails message is via an AccountDetailsConfirmation message.
+type GetAccountDetailsV05 struct {
+	state                      protoimpl.MessageState                     `protogen:"open.v1"`
+	MessageIdentification      *modelpb.MessageIdentification1            `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	InvestmentAccountSelection *modelpb.AccountSelection2Choice           `protobuf:"bytes,2,opt,name=investment_account_selection,json=investmentAccountSelection,proto3" json:"investment_account_selection,omitempty"`
+	SelectedInformationType    *modelpb.InvestmentAccountInformationType1 `protobuf:"bytes,3,opt,name=selected_information_type,json=selectedInformationType,proto3" json:"selected_information_type,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *GetAccountDetailsV05) Reset() {
+	*x = GetAccountDetailsV05{}
+	mi := &file_iso20022_acmt_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountDetailsV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountDetailsV05) ProtoMessage() {}
+
+func (x *GetAccountDetailsV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountDetailsV05.ProtoReflect.Descriptor instead.
+func (*GetAccountDetailsV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetAccountDetailsV05) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *GetAccountDetailsV05) GetInvestmentAccountSelection() *modelpb.AccountSelection2Choice {
+	if x != nil {
+		return x.InvestmentAccountSelection
+	}
+	return nil
+}
+
+func (x *GetAccountDetailsV05) GetSelectedInformationType() *modelpb.InvestmentAccountInformationType1 {
+	if x != nil {
+		return x.SelectedInformationType
+	}
+	return nil
+}
+
+// Scope
+// The IdentificationModificationAdvice message is sent by an assigner to an assignee. The message is used to advice on the correct party and/or account identification information.
+// Usage
+// The IdentificationModificationAdvice message is sent after the receipt of one or several transaction messages that included no longer valid party and/or account identification information.
+// The IdentificationModificationAdvice message is exchanged between financial institutions and between financial institutions and non financial institutions and can contain one or more modification advises.
+// There is no time limit on the time between the sending of an IdentificationModificationAdvice message and the receipt of the transaction messages that the IdentificationModificationAdvice refers to.
+// The IdentificationModificationAdvice includes the correct party and/or account identification information, the IdentificationModificationAdvice or the included information may be forwarded to the initiating party of the transaction messages.
+type IdentificationModificationAdviceV01 struct {
+	state                        protoimpl.MessageState                  `protogen:"open.v1"`
+	Assignment                   *modelpb.IdentificationAssignment1      `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	OriginalTransactionReference *modelpb.OriginalTransactionReference14 `protobuf:"bytes,2,opt,name=original_transaction_reference,json=originalTransactionReference,proto3" json:"original_transaction_reference,omitempty"`
+	Modification                 []*modelpb.IdentificationModification1  `protobuf:"bytes,3,rep,name=modification,proto3" json:"modification,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *IdentificationModificationAdviceV01) Reset() {
+	*x = IdentificationModificationAdviceV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentificationModificationAdviceV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentificationModificationAdviceV01) ProtoMessage() {}
+
+func (x *IdentificationModificationAdviceV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentificationModificationAdviceV01.ProtoReflect.Descriptor instead.
+func (*IdentificationModificationAdviceV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *IdentificationModificationAdviceV01) GetAssignment() *modelpb.IdentificationAssignment1 {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+func (x *IdentificationModificationAdviceV01) GetOriginalTransactionReference() *modelpb.OriginalTransactionReference14 {
+	if x != nil {
+		return x.OriginalTransactionReference
+	}
+	return nil
+}
+
+func (x *IdentificationModificationAdviceV01) GetModification() []*modelpb.IdentificationModification1 {
+	if x != nil {
+		return x.Modification
+	}
+	return nil
+}
+
+// Scope
+// The IdentificationModificationAdvice message is sent by an assigner to an assignee. The message is used to advice on the correct party and/or account identification information.
+// Usage
+// The IdentificationModificationAdvice message is sent after the receipt of one or several transaction messages that included no longer valid party and/or account identification information.
+// The IdentificationModificationAdvice message is exchanged between financial institutions and between financial institutions and non financial institutions and can contain one or more modification advises.
+// There is no time limit on the time between the sending of an IdentificationModificationAdvice message and the receipt of the transaction messages that the IdentificationModificationAdvice refers to.
+// The IdentificationModificationAdvice includes the correct party and/or account identification information, the IdentificationModificationAdvice or the included information may be forwarded to the initiating party of the transaction messages.
+type IdentificationModificationAdviceV02 struct {
+	state                        protoimpl.MessageState                  `protogen:"open.v1"`
+	Assignment                   *modelpb.IdentificationAssignment2      `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	OriginalTransactionReference *modelpb.OriginalTransactionReference18 `protobuf:"bytes,2,opt,name=original_transaction_reference,json=originalTransactionReference,proto3" json:"original_transaction_reference,omitempty"`
+	Modification                 []*modelpb.IdentificationModification2  `protobuf:"bytes,3,rep,name=modification,proto3" json:"modification,omitempty"`
+	SupplementaryData            []*modelpb.SupplementaryData1           `protobuf:"bytes,4,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *IdentificationModificationAdviceV02) Reset() {
+	*x = IdentificationModificationAdviceV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentificationModificationAdviceV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentificationModificationAdviceV02) ProtoMessage() {}
+
+func (x *IdentificationModificationAdviceV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentificationModificationAdviceV02.ProtoReflect.Descriptor instead.
+func (*IdentificationModificationAdviceV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *IdentificationModificationAdviceV02) GetAssignment() *modelpb.IdentificationAssignment2 {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+func (x *IdentificationModificationAdviceV02) GetOriginalTransactionReference() *modelpb.OriginalTransactionReference18 {
+	if x != nil {
+		return x.OriginalTransactionReference
+	}
+	return nil
+}
+
+func (x *IdentificationModificationAdviceV02) GetModification() []*modelpb.IdentificationModification2 {
+	if x != nil {
+		return x.Modification
+	}
+	return nil
+}
+
+func (x *IdentificationModificationAdviceV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The IdentificationVerificationReport message is sent by an assigner to an assignee. It is used to confirm whether or not the presented party and/or account identification information is correct.
+// Usage
+// The IdentificationVerificationReport message is sent as a response to an IdentificationVerificationRequest message.
+// The IdentificationVerificationReport message can contain one or more reports.
+// The IdentificationVerificationReport message may include a reason if the presented party and/or account identification information is confirmed to be incorrect.
+// The IdentificationVerificationReport message may include the correct party and/or account identification information.
+type IdentificationVerificationReportV01 struct {
+	state              protoimpl.MessageState             `protogen:"open.v1"`
+	Assignment         *modelpb.IdentificationAssignment1 `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	OriginalAssignment *modelpb.MessageIdentification4    `protobuf:"bytes,2,opt,name=original_assignment,json=originalAssignment,proto3" json:"original_assignment,omitempty"`
+	Report             []*modelpb.VerificationReport1     `protobuf:"bytes,3,rep,name=report,proto3" json:"report,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *IdentificationVerificationReportV01) Reset() {
+	*x = IdentificationVerificationReportV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentificationVerificationReportV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentificationVerificationReportV01) ProtoMessage() {}
+
+func (x *IdentificationVerificationReportV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentificationVerificationReportV01.ProtoReflect.Descriptor instead.
+func (*IdentificationVerificationReportV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *IdentificationVerificationReportV01) GetAssignment() *modelpb.IdentificationAssignment1 {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+func (x *IdentificationVerificationReportV01) GetOriginalAssignment() *modelpb.MessageIdentification4 {
+	if x != nil {
+		return x.OriginalAssignment
+	}
+	return nil
+}
+
+func (x *IdentificationVerificationReportV01) GetReport() []*modelpb.VerificationReport1 {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// Scope
+// The IdentificationVerificationReport message is sent by an assigner to an assignee. It is used to confirm whether or not the presented party and/or account identification information is correct.
+// Usage
+// The IdentificationVerificationReport message is sent as a response to an IdentificationVerificationRequest message.
+// The IdentificationVerificationReport message can contain one or more reports.
+// The IdentificationVerificationReport message may include a reason if the presented party and/or account identification information is confirmed to be incorrect.
+// The IdentificationVerificationReport message may include the correct party and/or account identification information.
+type IdentificationVerificationReportV02 struct {
+	state              protoimpl.MessageState             `protogen:"open.v1"`
+	Assignment         *modelpb.IdentificationAssignment2 `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	OriginalAssignment *modelpb.MessageIdentification5    `protobuf:"bytes,2,opt,name=original_assignment,json=originalAssignment,proto3" json:"original_assignment,omitempty"`
+	Report             []*modelpb.VerificationReport2     `protobuf:"bytes,3,rep,name=report,proto3" json:"report,omitempty"`
+	SupplementaryData  []*modelpb.SupplementaryData1      `protobuf:"bytes,4,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *IdentificationVerificationReportV02) Reset() {
+	*x = IdentificationVerificationReportV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentificationVerificationReportV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentificationVerificationReportV02) ProtoMessage() {}
+
+func (x *IdentificationVerificationReportV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentificationVerificationReportV02.ProtoReflect.Descriptor instead.
+func (*IdentificationVerificationReportV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *IdentificationVerificationReportV02) GetAssignment() *modelpb.IdentificationAssignment2 {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+func (x *IdentificationVerificationReportV02) GetOriginalAssignment() *modelpb.MessageIdentification5 {
+	if x != nil {
+		return x.OriginalAssignment
+	}
+	return nil
+}
+
+func (x *IdentificationVerificationReportV02) GetReport() []*modelpb.VerificationReport2 {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *IdentificationVerificationReportV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The IdentificationVerificationRequest message is sent by an assigner to an assignee. It is used to request the verification of party and/or account identification information.
+// Usage
+// The IdentificationVerificationRequest message is sent before the sending of one or several transactions messages.
+// The IdentificationVerificationRequest message can contain one or more verification requests.
+type IdentificationVerificationRequestV01 struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Assignment    *modelpb.IdentificationAssignment1     `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	Verification  []*modelpb.IdentificationVerification1 `protobuf:"bytes,2,rep,name=verification,proto3" json:"verification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentificationVerificationRequestV01) Reset() {
+	*x = IdentificationVerificationRequestV01{}
+	mi := &file_iso20022_acmt_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentificationVerificationRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentificationVerificationRequestV01) ProtoMessage() {}
+
+func (x *IdentificationVerificationRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentificationVerificationRequestV01.ProtoReflect.Descriptor instead.
+func (*IdentificationVerificationRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *IdentificationVerificationRequestV01) GetAssignment() *modelpb.IdentificationAssignment1 {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+func (x *IdentificationVerificationRequestV01) GetVerification() []*modelpb.IdentificationVerification1 {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+// Scope
+// The IdentificationVerificationRequest message is sent by an assigner to an assignee. It is used to request the verification of party and/or account identification information.
+// Usage
+// The IdentificationVerificationRequest message is sent before the sending of one or several transactions messages.
+// The IdentificationVerificationRequest message can contain one or more verification requests.
+type IdentificationVerificationRequestV02 struct {
+	state             protoimpl.MessageState                 `protogen:"open.v1"`
+	Assignment        *modelpb.IdentificationAssignment2     `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	Verification      []*modelpb.IdentificationVerification2 `protobuf:"bytes,2,rep,name=verification,proto3" json:"verification,omitempty"`
+	SupplementaryData []*modelpb.SupplementaryData1          `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *IdentificationVerificationRequestV02) Reset() {
+	*x = IdentificationVerificationRequestV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentificationVerificationRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentificationVerificationRequestV02) ProtoMessage() {}
+
+func (x *IdentificationVerificationRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentificationVerificationRequestV02.ProtoReflect.Descriptor instead.
+func (*IdentificationVerificationRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *IdentificationVerificationRequestV02) GetAssignment() *modelpb.IdentificationAssignment2 {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+func (x *IdentificationVerificationRequestV02) GetVerification() []*modelpb.IdentificationVerification2 {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+func (x *IdentificationVerificationRequestV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// An account owner, for example, an investor or its designated agent, sends the RequestForAccountManagementStatusReport message to the account servicer, for example, a registrar, transfer agent or custodian bank to request the status of an AccountOpeningInstruction or an AccountModificationInstruction.
+// Usage
+// The RequestForAccountManagementStatusReport message is used to request the processing status of a previously sent AccountOpeningInstruction message or AccountModificationInstruction message for which a AccountDetailsConfirmation message has not yet been received.
+type RequestForAccountManagementStatusReportV02 struct {
+	state                 protoimpl.MessageState                      `protogen:"open.v1"`
+	MessageIdentification *modelpb.MessageIdentification1             `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	RequestDetails        *modelpb.AccountManagementMessageReference1 `protobuf:"bytes,2,opt,name=request_details,json=requestDetails,proto3" json:"request_details,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RequestForAccountManagementStatusReportV02) Reset() {
+	*x = RequestForAccountManagementStatusReportV02{}
+	mi := &file_iso20022_acmt_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestForAccountManagementStatusReportV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestForAccountManagementStatusReportV02) ProtoMessage() {}
+
+func (x *RequestForAccountManagementStatusReportV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestForAccountManagementStatusReportV02.ProtoReflect.Descriptor instead.
+func (*RequestForAccountManagementStatusReportV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *RequestForAccountManagementStatusReportV02) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *RequestForAccountManagementStatusReportV02) GetRequestDetails() *modelpb.AccountManagementMessageReference1 {
+	if x != nil {
+		return x.RequestDetails
+	}
+	return nil
+}
+
+// Scope
+// An account owner, for example, an investor or its designated agent, sends the RequestForAccountManagementStatusReport message to the account servicer, for example, a registrar, transfer agent or custodian bank to request the status of an AccountOpeningInstruction or an AccountModificationInstruction.
+// Usage
+// The RequestForAccountManagementStatusReport message is used to request the processing status of a previously sent AccountOpeningInstruction message or AccountModificationInstruction message for which a AccountDetailsConfirmation message has not yet been received.
+type RequestForAccountManagementStatusReportV03 struct {
+	state                 protoimpl.MessageState                      `protogen:"open.v1"`
+	MessageIdentification *modelpb.MessageIdentification1             `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	RequestDetails        *modelpb.AccountManagementMessageReference2 `protobuf:"bytes,2,opt,name=request_details,json=requestDetails,proto3" json:"request_details,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RequestForAccountManagementStatusReportV03) Reset() {
+	*x = RequestForAccountManagementStatusReportV03{}
+	mi := &file_iso20022_acmt_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestForAccountManagementStatusReportV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestForAccountManagementStatusReportV03) ProtoMessage() {}
+
+func (x *RequestForAccountManagementStatusReportV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestForAccountManagementStatusReportV03.ProtoReflect.Descriptor instead.
+func (*RequestForAccountManagementStatusReportV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *RequestForAccountManagementStatusReportV03) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *RequestForAccountManagementStatusReportV03) GetRequestDetails() *modelpb.AccountManagementMessageReference2 {
+	if x != nil {
+		return x.RequestDetails
+	}
+	return nil
+}
+
+// Scope
+// An account owner, for example, an investor or its designated agent, sends the RequestForAccountManagementStatusReport message to the account servicer, for example, a registrar, transfer agent, custodian bank or securities depository  to request the status of an AccountOpeningInstruction,  GetAccountDetails or an AccountModificationInstruction.
+// Usage
+// The RequestForAccountManagementStatusReport message is used to request the processing status of a previously sent AccountOpeningInstruction message or AccountModificationInstruction message for which a AccountDetailsConfirmation message has not yet been received.
+type RequestForAccountManagementStatusReportV04 struct {
+	state                 protoimpl.MessageState                      `protogen:"open.v1"`
+	MessageIdentification *modelpb.MessageIdentification1             `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	RequestDetails        *modelpb.AccountManagementMessageReference3 `protobuf:"bytes,2,opt,name=request_details,json=requestDetails,proto3" json:"request_details,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RequestForAccountManagementStatusReportV04) Reset() {
+	*x = RequestForAccountManagementStatusReportV04{}
+	mi := &file_iso20022_acmt_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestForAccountManagementStatusReportV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestForAccountManagementStatusReportV04) ProtoMessage() {}
+
+func (x *RequestForAccountManagementStatusReportV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestForAccountManagementStatusReportV04.ProtoReflect.Descriptor instead.
+func (*RequestForAccountManagementStatusReportV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *RequestForAccountManagementStatusReportV04) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *RequestForAccountManagementStatusReportV04) GetRequestDetails() *modelpb.AccountManagementMessageReference3 {
+	if x != nil {
+		return x.RequestDetails
+	}
+	return nil
+}
+
+// Scope
+// The RequestForAccountManagementStatusReport message is sent by an account owner, for example, an investor or its designated agent, to the account servicer, for example, a registrar, transfer agent, custodian bank or securities depository  to request the status of an AccountOpeningInstruction,  GetAccountDetails or an AccountModificationInstruction.
+// Usage
+// The RequestForAccountManagementStatusReport message is used to request the processing status of a previously sent AccountOpeningInstruction, GetAccountDetails or an AccountModificationInstruction message for which an AccountDetailsConfirmation message has not yet been received.
+type RequestForAccountManagementStatusReportV05 struct {
+	state                 protoimpl.MessageState                      `protogen:"open.v1"`
+	MessageIdentification *modelpb.MessageIdentification1             `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3" json:"message_identification,omitempty"`
+	RequestDetails        *modelpb.AccountManagementMessageReference4 `protobuf:"bytes,2,opt,name=request_details,json=requestDetails,proto3" json:"request_details,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RequestForAccountManagementStatusReportV05) Reset() {
+	*x = RequestForAccountManagementStatusReportV05{}
+	mi := &file_iso20022_acmt_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestForAccountManagementStatusReportV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestForAccountManagementStatusReportV05) ProtoMessage() {}
+
+func (x *RequestForAccountManagementStatusReportV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_acmt_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestForAccountManagementStatusReportV05.ProtoReflect.Descriptor instead.
+func (*RequestForAccountManagementStatusReportV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_acmt_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *RequestForAccountManagementStatusReportV05) GetMessageIdentification() *modelpb.MessageIdentification1 {
+	if x != nil {
+		return x.MessageIdentification
+	}
+	return nil
+}
+
+func (x *RequestForAccountManagementStatusReportV05) GetRequestDetails() *modelpb.AccountManagementMessageReference4 {
+	if x != nil {
+		return x.RequestDetails
+	}
+	return nil
+}
+
+var File_iso20022_acmt_proto protoreflect.FileDescriptor
+
+var file_iso20022_acmt_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x61, 0x63, 0x6d, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x61, 0x63, 0x6d, 0x74, 0x1a, 0x14, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x85, 0x04, 0x0a, 0x26, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x33, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x6c, 0x0a, 0x1b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x36, 0x52, 0x1a, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e,
+	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x58, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x31,
+	0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x99, 0x05, 0x0a, 0x26, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x3b, 0x0a,
+	0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x33, 0x52, 0x0a,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x6c, 0x0a, 0x1b, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x1a, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x35, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x58, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x31, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x32, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0x81,
+	0x06, 0x0a, 0x2d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x69, 0x6e,
+	0x67, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31,
+	0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x33, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x6c, 0x0a,
+	0x1b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52,
+	0x1a, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x58, 0x0a, 0x16, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63,
+	0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x1d, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x18,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x31, 0x52, 0x16, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x95, 0x01, 0x0a, 0x28, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c,
+	0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x25, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x31,
+	0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x95, 0x07, 0x0a, 0x2d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6c,
+	0x6f, 0x73, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x30, 0x32, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x33, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x3f, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x6c, 0x0a, 0x1b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x38, 0x52, 0x1a, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x58, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x31, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x35, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x5b, 0x0a, 0x18, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x16, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x95,
+	0x01, 0x0a, 0x28, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61,
+	0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52,
+	0x25, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61,
+	0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x32, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xbe, 0x06, 0x0a, 0x21, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x65,
+	0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31,
+	0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x34, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x58, 0x0a,
+	0x16, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31,
+	0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61,
+	0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52,
+	0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c,
+	0x0a, 0x1b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36,
+	0x52, 0x1a, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x34, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x18, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x16, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x95, 0x01, 0x0a, 0x28, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64,
+	0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x34, 0x52, 0x25, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x31, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xd2, 0x07, 0x0a, 0x21,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x41, 0x6d,
+	0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30,
+	0x32, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x34, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3f,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x58, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x31, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x35, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x6c, 0x0a, 0x1b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x38, 0x52, 0x1a, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x34, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x18, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x16, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x95, 0x01, 0x0a, 0x28, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41,
+	0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69,
+	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x25, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x11,
+	0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e,
+	0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x32, 0x52, 0x10, 0x64, 0x69, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x51, 0x0a,
+	0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0xb5, 0x06, 0x0a, 0x18, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x3b, 0x0a,
+	0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x34, 0x52, 0x0a,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x16, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69,
+	0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x1d, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x1b, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52, 0x1a, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x34, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x5b, 0x0a, 0x18, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x16, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x95, 0x01, 0x0a, 0x28, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34,
+	0x52, 0x25, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x31, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xbc, 0x07, 0x0a, 0x18, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x34, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x3f, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x58, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x84, 0x01,
+	0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41,
+	0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69,
+	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x1b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x34, 0x52, 0x1a, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x34, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x5b,
+	0x0a, 0x18, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x31, 0x52, 0x16, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x95, 0x01, 0x0a, 0x28,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69,
+	0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x25, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x32, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xac, 0x09, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x32, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e,
+	0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x34, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x33, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x52, 0x0a,
+	0x12, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x37, 0x52, 0x11,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x48, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x35, 0x52, 0x0e, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72,
+	0x79, 0x31, 0x32, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x55, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x4e, 0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x31, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x17, 0x73, 0x61, 0x76, 0x69,
+	0x6e, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x6c, 0x61, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x34, 0x52, 0x15, 0x73, 0x61, 0x76, 0x69,
+	0x6e, 0x67, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61,
+	0x6e, 0x12, 0x5d, 0x0a, 0x1a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x34, 0x52, 0x18, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e,
+	0x12, 0x61, 0x0a, 0x0f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x43, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x33, 0x52, 0x0e, 0x63, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x6f,
+	0x53, 0x65, 0x6e, 0x64, 0x31, 0x52, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xac, 0x09, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x33, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31,
+	0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x34, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x33, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x52, 0x0a, 0x12,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x33, 0x35, 0x52, 0x11, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x48, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x36, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79,
+	0x31, 0x32, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x55, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x4e, 0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x31, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x17, 0x73, 0x61, 0x76, 0x69, 0x6e,
+	0x67, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c,
+	0x61, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x36, 0x52, 0x15, 0x73, 0x61, 0x76, 0x69, 0x6e,
+	0x67, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e,
+	0x12, 0x5d, 0x0a, 0x1a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x36, 0x52, 0x18, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x61, 0x0a, 0x0f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x43, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x35, 0x52, 0x0e, 0x63, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x53,
+	0x65, 0x6e, 0x64, 0x31, 0x52, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8c, 0x0a, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52,
+	0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x34, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x33, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x52, 0x0a, 0x12, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x33, 0x38, 0x52, 0x11, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x48, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x39, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x32,
+	0x34, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x31, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x55, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4e,
+	0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x31, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x17, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67,
+	0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61,
+	0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x39, 0x52, 0x15, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67,
+	0x73, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x5d, 0x0a, 0x1a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x6c, 0x61, 0x6e, 0x39, 0x52, 0x18, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x61,
+	0x0a, 0x0f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x43, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x37, 0x52, 0x0e, 0x63, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x57, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x53, 0x65,
+	0x6e, 0x64, 0x31, 0x52, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x17, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8f, 0x0a, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x30, 0x35, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x34, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x33, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x32, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x52, 0x0a, 0x12, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x34, 0x36, 0x52, 0x11, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x49,
+	0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x31, 0x32, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x32,
+	0x34, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x31, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x55, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4e,
+	0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x32, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x58, 0x0a, 0x17, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67,
+	0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61,
+	0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x31, 0x30, 0x52, 0x15, 0x73, 0x61, 0x76, 0x69, 0x6e,
+	0x67, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e,
+	0x12, 0x5e, 0x0a, 0x1a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x31, 0x30, 0x52, 0x18, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e,
+	0x12, 0x61, 0x0a, 0x0f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x43, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x37, 0x52, 0x0e, 0x63, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x6f,
+	0x53, 0x65, 0x6e, 0x64, 0x32, 0x52, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x17,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd3, 0x0a, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x36, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31,
+	0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x34, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x36, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x52, 0x0a, 0x12,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x35, 0x30, 0x52, 0x11, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x49, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x31, 0x33, 0x52, 0x0e, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72,
+	0x79, 0x33, 0x36, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x32, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x55, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x4e, 0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x32, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x58, 0x0a, 0x17, 0x73, 0x61, 0x76, 0x69,
+	0x6e, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x6c, 0x61, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x31, 0x32, 0x52, 0x15, 0x73, 0x61, 0x76,
+	0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x12, 0x5e, 0x0a, 0x1a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x31, 0x32, 0x52, 0x18, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x12, 0x48, 0x0a, 0x0f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x61, 0x73,
+	0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x0e, 0x63, 0x61,
+	0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x17,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67,
+	0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x33, 0x52, 0x15,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x41, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5b, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x31, 0x52, 0x15, 0x61, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x17, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x31, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd8, 0x0a, 0x0a,
+	0x1d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x37, 0x12, 0x5d,
+	0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a,
+	0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x34, 0x52, 0x0e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x11,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x36, 0x52, 0x10, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x61, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x13, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x52, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x36, 0x32, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x31,
+	0x35, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x46, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x33, 0x36, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x32, 0x52, 0x09, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x58,
+	0x0a, 0x17, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x31,
+	0x34, 0x52, 0x15, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x5e, 0x0a, 0x1a, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x31, 0x34, 0x52, 0x18,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x48, 0x0a, 0x0f, 0x63, 0x61, 0x73, 0x68,
+	0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x31, 0x52, 0x0e, 0x63, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x53,
+	0x65, 0x6e, 0x64, 0x33, 0x52, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x60, 0x0a, 0x16, 0x61,
+	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x31, 0x32, 0x52, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a,
+	0x17, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
+	0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf6, 0x04, 0x0a, 0x34, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4d, 0x61, 0x6e, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6d, 0x65,
+	0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31,
+	0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x34, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x47, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x1b, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c,
+	0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x19,
+	0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x31, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63,
+	0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x1d, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0c,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x36, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x31, 0x52, 0x10,
+	0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0xa2, 0x06, 0x0a, 0x34, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x34, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x38, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x32, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x61, 0x0a, 0x1b, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x19, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c,
+	0x79, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x31, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x1f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64,
+	0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x35, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x31, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x32,
+	0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xdb, 0x05, 0x0a, 0x2b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x34, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32, 0x52, 0x0d, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x1b, 0x75,
+	0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x31, 0x52, 0x19, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x4d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3a,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x31, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x34, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x41, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x1e, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x35, 0x52, 0x1c, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50,
+	0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x31, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0x87, 0x07, 0x0a, 0x2b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x30, 0x32, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x34, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x3f, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x1b, 0x75, 0x6e,
+	0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x31, 0x52, 0x19, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x4d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63,
+	0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x1d, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0c,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x0c, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x1e, 0x61,
+	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x1c, 0x61, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x32, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61,
+	0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xac, 0x02,
+	0x0a, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56,
+	0x30, 0x32, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x51, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x33, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x31, 0x52, 0x0c,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xac, 0x02, 0x0a,
+	0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30,
+	0x33, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x51, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x33, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x32, 0x52, 0x0c, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xc6, 0x03, 0x0a, 0x20,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x34,
+	0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x51, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x33,
+	0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x33, 0x52, 0x0c, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x5e, 0x0a, 0x17, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc6, 0x03, 0x0a, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x35, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x36, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x6e, 0x64, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x34, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x5e, 0x0a, 0x17, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x31, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc6, 0x03,
+	0x0a, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56,
+	0x30, 0x36, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x51, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x36, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x35, 0x52, 0x0c,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x5e, 0x0a, 0x17,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf4, 0x05, 0x0a, 0x2c, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x34, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32, 0x52, 0x0d,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x61, 0x0a,
+	0x1b, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x19, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e,
+	0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x58, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x31, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x34, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x6c, 0x0a, 0x1b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x36, 0x52, 0x1a, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3b, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x6e, 0x64, 0x61,
+	0x74, 0x65, 0x31, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x12, 0x4f, 0x0a, 0x11,
+	0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e,
+	0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x31, 0x52, 0x10, 0x64, 0x69, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xb6, 0x07,
+	0x0a, 0x2c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6d, 0x65, 0x6e, 0x64,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x3b,
+	0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x34, 0x52,
+	0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x47, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x32, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x1b, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79,
+	0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x19, 0x75,
+	0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41,
+	0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c,
+	0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x1b, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x1a, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x33, 0x52, 0x07, 0x6d, 0x61, 0x6e,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x32, 0x52, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50,
+	0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x32, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xd9, 0x06, 0x0a, 0x23, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x3b,
+	0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x34, 0x52,
+	0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x32, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x1b, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69,
+	0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x19, 0x75, 0x6e,
+	0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x67,
+	0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49,
+	0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x1b, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52, 0x1a, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x31, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x6c, 0x0a, 0x1e, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x35, 0x52, 0x1c, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x31,
+	0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x8e, 0x08, 0x0a, 0x23, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61,
+	0x6e, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x34, 0x52, 0x0a, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x38, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x32, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x61, 0x0a, 0x1b, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x19, 0x75, 0x6e, 0x64, 0x65, 0x72,
+	0x6c, 0x79, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x67, 0x72, 0x65, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x84,
+	0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x1b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x34, 0x52, 0x1a, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x33, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x32, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x6c, 0x0a, 0x1e, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x35, 0x52, 0x1c, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x32, 0x52, 0x10,
+	0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31,
+	0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0xf5, 0x0a, 0x0a, 0x21, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x32, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x33, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x65, 0x0a,
+	0x13, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x52, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x6d, 0x0a, 0x1c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x52, 0x1a, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x63, 0x0a, 0x1b, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x38, 0x52, 0x19, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x59, 0x0a, 0x18, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x34, 0x52, 0x16, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x17, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x37, 0x52, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x4d, 0x0a, 0x12, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x11, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x5e, 0x0a, 0x19, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x39, 0x52, 0x17, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x6b, 0x0a, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x76, 0x69,
+	0x6e, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x6c, 0x61, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x38, 0x52, 0x1d, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x71, 0x0a, 0x23,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x6c, 0x61, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x38, 0x52, 0x20, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x72, 0x0a, 0x18, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x73, 0x68,
+	0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x38, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e,
+	0x64, 0x43, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x16, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x6c, 0x0a, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67,
+	0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x31, 0x30, 0x52, 0x1d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31,
+	0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf7, 0x0a, 0x0a, 0x21,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30,
+	0x33, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x53, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x33, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x65, 0x0a, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x6d, 0x0a, 0x1c,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x52,
+	0x1a, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x63, 0x0a, 0x1b, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x33, 0x36, 0x52, 0x19, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x49,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x59, 0x0a, 0x18, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x37, 0x52, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x17, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x37,
+	0x52, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x12, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x31, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x19, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x39, 0x52, 0x17,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x31, 0x36, 0x52, 0x1d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x72, 0x0a, 0x23, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x31, 0x36, 0x52, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x72, 0x0a, 0x18, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x43, 0x61, 0x73, 0x68, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43,
+	0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x6c, 0x0a,
+	0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x31, 0x30, 0x52, 0x1d, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd8, 0x0b, 0x0a, 0x21, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34, 0x12, 0x5d, 0x0a, 0x16, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x12, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x33, 0x52, 0x11, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x65, 0x0a, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x6d, 0x0a, 0x1c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x52, 0x1a, 0x69, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x63, 0x0a, 0x1b, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x33, 0x39, 0x52,
+	0x19, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x59, 0x0a, 0x18, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x38, 0x52, 0x16, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x17, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x32, 0x30, 0x52, 0x16, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x12, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x31, 0x52,
+	0x11, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x19, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x39, 0x52, 0x17, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73,
+	0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x31,
+	0x38, 0x52, 0x1d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x61, 0x76, 0x69, 0x6e,
+	0x67, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e,
+	0x12, 0x72, 0x0a, 0x23, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x31, 0x38, 0x52, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x12, 0x72, 0x0a, 0x18, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x5f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x43, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38,
+	0x52, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x61, 0x73, 0x68, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x6c, 0x0a, 0x20, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x31, 0x30, 0x52, 0x1d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x41, 0x67, 0x72,
+	0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x17, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x5f, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52,
+	0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0xd4, 0x0b, 0x0a, 0x21, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x30, 0x35, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x33, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x13, 0x69, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x6d, 0x0a, 0x1c, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x52, 0x1a,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x63, 0x0a, 0x1b, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x34, 0x37, 0x52, 0x19, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x5a, 0x0a, 0x18, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x31, 0x31, 0x52, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x17, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x32,
+	0x30, 0x52, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x12, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x19, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x32, 0x31,
+	0x52, 0x17, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x20, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x32, 0x35, 0x52, 0x1d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x72, 0x0a, 0x23, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x32, 0x35, 0x52, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x72, 0x0a, 0x18, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x43, 0x61, 0x73,
+	0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x43, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x6c, 0x0a, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x32, 0x32, 0x52, 0x1d,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5e, 0x0a,
+	0x17, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
+	0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa9, 0x0c, 0x0a, 0x21, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x36, 0x12, 0x5d, 0x0a,
+	0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x12,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x36, 0x52, 0x11,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x5f, 0x0a, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x52, 0x12,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x69, 0x0a, 0x1c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x43, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x52, 0x1a, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x63, 0x0a,
+	0x1b, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x35, 0x31, 0x52, 0x19, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x18, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x31, 0x34, 0x52, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x5c,
+	0x0a, 0x17, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x32, 0x36, 0x52, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x12,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x33, 0x33, 0x52, 0x11, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x5f, 0x0a, 0x19, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x32, 0x31, 0x52, 0x17, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x6c, 0x0a, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x61,
+	0x76, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x32, 0x38,
+	0x52, 0x1d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67,
+	0x73, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x72, 0x0a, 0x23, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x32,
+	0x38, 0x52, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x6c, 0x61, 0x6e, 0x12, 0x59, 0x0a, 0x18, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x63, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0x52, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x43, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x6c,
+	0x0a, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x33, 0x31, 0x52, 0x1d, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x6b, 0x0a, 0x1f,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x33, 0x30, 0x52, 0x1d, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x17, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x31, 0x52, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0xa9, 0x0c, 0x0a, 0x21, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x37, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x36, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x5f, 0x0a,
+	0x13, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x69,
+	0x0a, 0x1c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x1a, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x63, 0x0a, 0x1b, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x36, 0x33, 0x52, 0x19, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5a,
+	0x0a, 0x18, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x31, 0x36, 0x52, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x17, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x33, 0x38,
+	0x52, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x12, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x33, 0x33, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x19,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x32, 0x31, 0x52, 0x17, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a,
+	0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67,
+	0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61,
+	0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x33, 0x37, 0x52, 0x1d, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x72, 0x0a, 0x23, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c,
+	0x61, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x33, 0x37, 0x52, 0x20, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x59, 0x0a, 0x18, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x73, 0x68,
+	0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x32, 0x52, 0x16, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x61, 0x73, 0x68,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x6c, 0x0a, 0x20, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x33, 0x31, 0x52, 0x1d, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x41,
+	0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x6b, 0x0a, 0x1f, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x33, 0x35, 0x52, 0x1d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x17, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xd1, 0x04, 0x0a, 0x2d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30,
+	0x31, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x33, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x6c,
+	0x0a, 0x1b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36,
+	0x52, 0x1a, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x31, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34,
+	0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x61, 0x0a, 0x1b, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x19, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79,
+	0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50,
+	0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x31, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0xe5, 0x05, 0x0a, 0x2d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
+	0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x33, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x3f, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x6c, 0x0a, 0x1b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x1a, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x34, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x84, 0x01,
+	0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41,
+	0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69,
+	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x61, 0x0a, 0x1b, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69,
+	0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x19, 0x75, 0x6e,
+	0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x67,
+	0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x32, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xec, 0x05, 0x0a, 0x21,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6d,
+	0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30,
+	0x31, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x34, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x47,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x1b, 0x75, 0x6e, 0x64, 0x65, 0x72,
+	0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52,
+	0x19, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x31, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e,
+	0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x1d,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a,
+	0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x36, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x6e, 0x64,
+	0x61, 0x74, 0x65, 0x31, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a,
+	0x11, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x31, 0x36, 0x52, 0x10, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x31, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61,
+	0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xaf, 0x07, 0x0a, 0x21, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x65,
+	0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32,
+	0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x34, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3f, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x47,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x1b, 0x75, 0x6e, 0x64, 0x65, 0x72,
+	0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52,
+	0x19, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x34, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e,
+	0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x1d,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a,
+	0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x31, 0x32, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x6e,
+	0x64, 0x61, 0x74, 0x65, 0x32, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2c,
+	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x31, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x4a, 0x0a, 0x11,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x32, 0x34, 0x52, 0x10, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x32, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xac, 0x09, 0x0a,
+	0x1c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x32, 0x12, 0x5d, 0x0a,
+	0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x75, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x34, 0x52, 0x0e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x33, 0x52, 0x11, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x60, 0x0a, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x12,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x52, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x32, 0x36, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x35,
+	0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x46, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x31, 0x32, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x09, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a,
+	0x17, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x34, 0x52,
+	0x15, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x5d, 0x0a, 0x1a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x34, 0x52, 0x18, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x61, 0x0a, 0x0f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x43, 0x61,
+	0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x52, 0x0e, 0x63, 0x61, 0x73, 0x68, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x31, 0x52, 0x15, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31,
+	0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xac, 0x09, 0x0a, 0x1c,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x33, 0x12, 0x5d, 0x0a, 0x16,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x75, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x34, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x33, 0x52, 0x11, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x60, 0x0a, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
+	0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x12, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x52, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x33, 0x34, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x36, 0x52,
+	0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x46, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x72, 0x79, 0x31, 0x32, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x17,
+	0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x36, 0x52, 0x15,
+	0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x5d, 0x0a, 0x1a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x6c, 0x61, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x36, 0x52, 0x18, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x12, 0x61, 0x0a, 0x0f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x43, 0x61, 0x73,
+	0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x0e, 0x63, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x31, 0x52, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52,
+	0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8c, 0x0a, 0x0a, 0x1c, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34, 0x12, 0x5d, 0x0a, 0x16, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x75, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x34, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x33, 0x52, 0x11, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x60,
+	0x0a, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x12, 0x69, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x52, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x33,
+	0x37, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x39, 0x52, 0x0e,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x46,
+	0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x72, 0x79, 0x32, 0x34, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x17, 0x73,
+	0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x39, 0x52, 0x15, 0x73,
+	0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x12, 0x5d, 0x0a, 0x1a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c,
+	0x61, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x39, 0x52, 0x18, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x6c, 0x61, 0x6e, 0x12, 0x61, 0x0a, 0x0f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x43, 0x61, 0x73, 0x68,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x37, 0x52, 0x0e, 0x63, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x31, 0x52, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x5e, 0x0a, 0x17, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x38, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x89, 0x0a, 0x0a, 0x1c, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x35, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75,
+	0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x34, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x33, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x5a, 0x0a,
+	0x13, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x31, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x52, 0x0a, 0x12, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x33, 0x37, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x49, 0x0a,
+	0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x31, 0x30, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x32, 0x34,
+	0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x3c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x31, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x55,
+	0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4e, 0x65,
+	0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x32, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x58, 0x0a, 0x17, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73,
+	0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x31, 0x30, 0x52, 0x15, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67,
+	0x73, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x5e, 0x0a, 0x1a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x6c, 0x61, 0x6e, 0x31, 0x30, 0x52, 0x18, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x61, 0x0a, 0x0f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x43, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x37, 0x52, 0x0e, 0x63, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x53,
+	0x65, 0x6e, 0x64, 0x32, 0x52, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x17, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcd, 0x0a, 0x0a, 0x1c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x30, 0x36, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x34, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x36, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x13, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x32, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x52, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x34, 0x39, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x31, 0x33, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x33, 0x36, 0x52, 0x0e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x32, 0x52,
+	0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x14, 0x6e, 0x65,
+	0x77, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x52, 0x12, 0x6e,
+	0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x58, 0x0a, 0x17, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x31, 0x32, 0x52, 0x15, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x5e, 0x0a, 0x1a, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x31,
+	0x32, 0x52, 0x18, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x48, 0x0a, 0x0f, 0x63,
+	0x61, 0x73, 0x68, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x0e, 0x63, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x33, 0x52, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5b,
+	0x0a, 0x16, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x31, 0x52, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x17, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0x0a, 0x0a, 0x1c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x30, 0x37, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x34, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x36, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x13, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x33, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x52, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x36, 0x31, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x31, 0x35, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x33, 0x36, 0x52, 0x0e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x32, 0x52,
+	0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x14, 0x6e, 0x65,
+	0x77, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x52, 0x12, 0x6e,
+	0x65, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x58, 0x0a, 0x17, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x31, 0x34, 0x52, 0x15, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x5e, 0x0a, 0x1a, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x31,
+	0x34, 0x52, 0x18, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x48, 0x0a, 0x0f, 0x63,
+	0x61, 0x73, 0x68, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x0e, 0x63, 0x61, 0x73, 0x68, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x33, 0x52, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x60,
+	0x0a, 0x16, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x32, 0x52, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x5e, 0x0a, 0x17, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52,
+	0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe3, 0x05, 0x0a, 0x18, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x34, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x31, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x47, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x1b, 0x75, 0x6e, 0x64,
+	0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61,
+	0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x31, 0x52, 0x19, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x84, 0x01, 0x0a,
+	0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e,
+	0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x34, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x37, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x31, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x61, 0x73, 0x68, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x31, 0x36, 0x52, 0x10, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x31, 0x52, 0x10,
+	0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0xa6, 0x07, 0x0a, 0x18, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x3b, 0x0a,
+	0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x34, 0x52, 0x0a,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x3a, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x34, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x32, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x61, 0x0a, 0x1b, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x19, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c,
+	0x79, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61,
+	0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x1d, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0c, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x32,
+	0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b,
+	0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74,
+	0x65, 0x32, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x31, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x4a, 0x0a, 0x11, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x32, 0x34, 0x52, 0x10, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x32, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xbf, 0x04, 0x0a, 0x17, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x34, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x58, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x84, 0x01, 0x0a,
+	0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e,
+	0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x34, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x1b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52, 0x1a, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x31, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x8a, 0x05, 0x0a, 0x17,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x34, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x58, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f,
+	0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x1b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x1a, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x32, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xa2, 0x03, 0x0a, 0x10, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x12, 0x3b, 0x0a,
+	0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x35, 0x52, 0x0a,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x34, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x41, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x31, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x4f, 0x0a, 0x11,
+	0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e,
+	0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x31, 0x52, 0x10, 0x64, 0x69, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xb8, 0x04,
+	0x0a, 0x10, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56,
+	0x30, 0x32, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x35, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x3f, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e,
+	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x32, 0x52, 0x0c, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x06, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x31, 0x35, 0x52, 0x06, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x32, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xff, 0x03, 0x0a, 0x20, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x31, 0x12, 0x3b, 0x0a,
+	0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x35, 0x52, 0x0a,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x16, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x1b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52, 0x1a, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c,
+	0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x31, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61,
+	0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x93, 0x05, 0x0a, 0x20, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x32, 0x12,
+	0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x35,
+	0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x58, 0x0a,
+	0x16, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31,
+	0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x1b, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x1a, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63,
+	0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x1d, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x11,
+	0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e,
+	0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x32, 0x52, 0x10, 0x64, 0x69, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x51, 0x0a,
+	0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0xf9, 0x03, 0x0a, 0x1a, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x31, 0x12,
+	0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x36,
+	0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x84, 0x01, 0x0a,
+	0x1f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e,
+	0x64, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x34, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x58, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a,
+	0x1b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52,
+	0x1a, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x64,
+	0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x31, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x8d, 0x05, 0x0a,
+	0x1a, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x32, 0x12, 0x3b, 0x0a, 0x0a, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x36, 0x52, 0x0a, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x38, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x46, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x35, 0x52, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x58, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x31, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x1b, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x1a, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x69, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x32, 0x52, 0x10, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0x59, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x31, 0x30, 0x30, 0x31, 0x30, 0x32,
+	0x12, 0x45, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d,
+	0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x32, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x30, 0x30, 0x31, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x45, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x59, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x31, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x45, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
+	0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x31, 0x30, 0x30, 0x31, 0x30,
+	0x35, 0x12, 0x45, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63,
+	0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x35, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x31, 0x30, 0x30, 0x31, 0x30, 0x36, 0x12, 0x45, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x36, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x30, 0x31, 0x30, 0x30, 0x31, 0x30, 0x37, 0x12, 0x45, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x30, 0x37, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31,
+	0x30, 0x32, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61,
+	0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30,
+	0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x46,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x32, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31,
+	0x30, 0x36, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61,
+	0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30,
+	0x36, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x37, 0x12, 0x46,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x37, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x36, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x36, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x37, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x37, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x51, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x34, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x3d, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x56, 0x30, 0x35,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x67, 0x0a, 0x10, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x53, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x67, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x35, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x53, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46,
+	0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56,
+	0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x67, 0x0a, 0x10, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12,
+	0x53, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x39, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x67, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x53, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x36, 0x30, 0x30, 0x31, 0x30,
+	0x32, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63,
+	0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x36, 0x30, 0x30, 0x31, 0x30, 0x33,
+	0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d,
+	0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56,
+	0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x36, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12,
+	0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30,
+	0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x36, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x49,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x35,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x36, 0x30, 0x30, 0x31, 0x30, 0x36, 0x12, 0x49, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x36, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x55, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x37, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x41, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x55, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x37, 0x30, 0x30,
+	0x31, 0x30, 0x32, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x38, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x38, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x39, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x56, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x6a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x39, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x56, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
+	0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x30, 0x30, 0x30, 0x31,
+	0x30, 0x31, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61,
+	0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x30, 0x30, 0x30, 0x31, 0x30,
+	0x32, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63,
+	0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x31, 0x30, 0x30, 0x31, 0x30, 0x31,
+	0x12, 0x43, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d,
+	0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x30, 0x31, 0x31, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x43, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x63,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x32, 0x30, 0x30, 0x31,
+	0x30, 0x31, 0x12, 0x4f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61,
+	0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x63, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x31, 0x32, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x4f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x54, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x33, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x40, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x54,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x33, 0x30, 0x30, 0x31,
+	0x30, 0x32, 0x12, 0x40, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61,
+	0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x4d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x31, 0x34, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x39, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x4d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x31, 0x34, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x39, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x68, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31,
+	0x35, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x54, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x68, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x35, 0x30, 0x30, 0x31, 0x30, 0x32,
+	0x12, 0x54, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d,
+	0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x71, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x31, 0x36, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x5d, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4d, 0x61, 0x6e, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6d, 0x65,
+	0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x71, 0x0a, 0x10, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x36, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x5d, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4d, 0x61,
+	0x6e, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x37, 0x30, 0x30, 0x31, 0x30, 0x31,
+	0x12, 0x4c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d,
+	0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x37, 0x30, 0x30, 0x31,
+	0x30, 0x32, 0x12, 0x4c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61,
+	0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x69, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x38, 0x30,
+	0x30, 0x31, 0x30, 0x31, 0x12, 0x55, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x41,
+	0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x69, 0x0a, 0x10, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x38, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12,
+	0x55, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x55, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x31, 0x39, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x55, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x39, 0x30, 0x30, 0x31, 0x30,
+	0x32, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63,
+	0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x32, 0x30, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x32, 0x30, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x6a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x32, 0x31, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x56, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x6a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x32, 0x31, 0x30,
+	0x30, 0x31, 0x30, 0x32, 0x12, 0x56, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6c, 0x6f,
+	0x73, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x32, 0x32, 0x30, 0x30, 0x31, 0x30, 0x31,
+	0x12, 0x4c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d,
+	0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x32, 0x32, 0x30, 0x30, 0x31,
+	0x30, 0x32, 0x12, 0x4c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61,
+	0x63, 0x6d, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x61, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x32, 0x33, 0x30,
+	0x30, 0x31, 0x30, 0x31, 0x12, 0x4d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x61, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x32, 0x33, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x4d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x32, 0x34, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x4c, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x32, 0x34, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x4c, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x6d, 0x74, 0x2e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30,
+	0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xcf, 0x02, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x56, 0x30, 0x35, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x1c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x43, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x52, 0x1a, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6d, 0x0a,
+	0x19, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x31, 0x52, 0x17, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0xb7, 0x02, 0x0a,
+	0x23, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63,
+	0x65, 0x56, 0x30, 0x31, 0x12, 0x49, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x31, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x74, 0x0a, 0x1e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x31, 0x34, 0x52, 0x1c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8a, 0x03, 0x0a, 0x23, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x32, 0x12, 0x49,
+	0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0x52, 0x0a, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x74, 0x0a, 0x1e, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x31,
+	0x38, 0x52, 0x1c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x4f, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x32, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31,
+	0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0x86, 0x02, 0x0a, 0x23, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x12, 0x49, 0x0a, 0x0a, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x13, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x12, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x3b, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x31, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xd9, 0x02, 0x0a,
+	0x23, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x56, 0x30, 0x32, 0x12, 0x49, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x32, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x57, 0x0a, 0x13, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x35, 0x52, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x32, 0x52, 0x06, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xc2, 0x01, 0x0a, 0x24, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30,
+	0x31, 0x12, 0x49, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x31,
+	0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x0c,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52,
+	0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x95, 0x02,
+	0x0a, 0x24, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x49, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x32, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x4f, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x32, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xe8, 0x01, 0x0a, 0x2a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x56, 0x30, 0x32, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x31,
+	0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x22, 0xe8, 0x01, 0x0a, 0x2a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x33, 0x12,
+	0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b,
+	0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x32, 0x52, 0x0e, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x2a,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x34, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x31, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x0f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x33, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x2a, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x56, 0x30, 0x35, 0x12, 0x5d, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x34, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x79, 0x75, 0x64, 0x61, 0x70, 0x72, 0x61, 0x6d, 0x61, 0x2f, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2f, 0x61, 0x63, 0x6d, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_iso20022_acmt_proto_rawDescOnce sync.Once
+	file_iso20022_acmt_proto_rawDescData []byte
+)
+
+func file_iso20022_acmt_proto_rawDescGZIP() []byte {
+	file_iso20022_acmt_proto_rawDescOnce.Do(func() {
+		file_iso20022_acmt_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_iso20022_acmt_proto_rawDesc), len(file_iso20022_acmt_proto_rawDesc)))
+	})
+	return file_iso20022_acmt_proto_rawDescData
+}
+
+var file_iso20022_acmt_proto_msgTypes = make([]protoimpl.MessageInfo, 128)
+var file_iso20022_acmt_proto_goTypes = []any{
+	(*AccountAdditionalInformationRequestV01)(nil),               // 0: iso20022.acmt.AccountAdditionalInformationRequestV01
+	(*AccountAdditionalInformationRequestV02)(nil),               // 1: iso20022.acmt.AccountAdditionalInformationRequestV02
+	(*AccountClosingAdditionalInformationRequestV01)(nil),        // 2: iso20022.acmt.AccountClosingAdditionalInformationRequestV01
+	(*AccountClosingAdditionalInformationRequestV02)(nil),        // 3: iso20022.acmt.AccountClosingAdditionalInformationRequestV02
+	(*AccountClosingAmendmentRequestV01)(nil),                    // 4: iso20022.acmt.AccountClosingAmendmentRequestV01
+	(*AccountClosingAmendmentRequestV02)(nil),                    // 5: iso20022.acmt.AccountClosingAmendmentRequestV02
+	(*AccountClosingRequestV01)(nil),                             // 6: iso20022.acmt.AccountClosingRequestV01
+	(*AccountClosingRequestV02)(nil),                             // 7: iso20022.acmt.AccountClosingRequestV02
+	(*AccountDetailsConfirmationV02)(nil),                        // 8: iso20022.acmt.AccountDetailsConfirmationV02
+	(*AccountDetailsConfirmationV03)(nil),                        // 9: iso20022.acmt.AccountDetailsConfirmationV03
+	(*AccountDetailsConfirmationV04)(nil),                        // 10: iso20022.acmt.AccountDetailsConfirmationV04
+	(*AccountDetailsConfirmationV05)(nil),                        // 11: iso20022.acmt.AccountDetailsConfirmationV05
+	(*AccountDetailsConfirmationV06)(nil),                        // 12: iso20022.acmt.AccountDetailsConfirmationV06
+	(*AccountDetailsConfirmationV07)(nil),                        // 13: iso20022.acmt.AccountDetailsConfirmationV07
+	(*AccountExcludedMandateMaintenanceAmendmentRequestV01)(nil), // 14: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV01
+	(*AccountExcludedMandateMaintenanceAmendmentRequestV02)(nil), // 15: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV02
+	(*AccountExcludedMandateMaintenanceRequestV01)(nil),          // 16: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV01
+	(*AccountExcludedMandateMaintenanceRequestV02)(nil),          // 17: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV02
+	(*AccountManagementStatusReportV02)(nil),                     // 18: iso20022.acmt.AccountManagementStatusReportV02
+	(*AccountManagementStatusReportV03)(nil),                     // 19: iso20022.acmt.AccountManagementStatusReportV03
+	(*AccountManagementStatusReportV04)(nil),                     // 20: iso20022.acmt.AccountManagementStatusReportV04
+	(*AccountManagementStatusReportV05)(nil),                     // 21: iso20022.acmt.AccountManagementStatusReportV05
+	(*AccountManagementStatusReportV06)(nil),                     // 22: iso20022.acmt.AccountManagementStatusReportV06
+	(*AccountMandateMaintenanceAmendmentRequestV01)(nil),         // 23: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV01
+	(*AccountMandateMaintenanceAmendmentRequestV02)(nil),         // 24: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV02
+	(*AccountMandateMaintenanceRequestV01)(nil),                  // 25: iso20022.acmt.AccountMandateMaintenanceRequestV01
+	(*AccountMandateMaintenanceRequestV02)(nil),                  // 26: iso20022.acmt.AccountMandateMaintenanceRequestV02
+	(*AccountModificationInstructionV02)(nil),                    // 27: iso20022.acmt.AccountModificationInstructionV02
+	(*AccountModificationInstructionV03)(nil),                    // 28: iso20022.acmt.AccountModificationInstructionV03
+	(*AccountModificationInstructionV04)(nil),                    // 29: iso20022.acmt.AccountModificationInstructionV04
+	(*AccountModificationInstructionV05)(nil),                    // 30: iso20022.acmt.AccountModificationInstructionV05
+	(*AccountModificationInstructionV06)(nil),                    // 31: iso20022.acmt.AccountModificationInstructionV06
+	(*AccountModificationInstructionV07)(nil),                    // 32: iso20022.acmt.AccountModificationInstructionV07
+	(*AccountOpeningAdditionalInformationRequestV01)(nil),        // 33: iso20022.acmt.AccountOpeningAdditionalInformationRequestV01
+	(*AccountOpeningAdditionalInformationRequestV02)(nil),        // 34: iso20022.acmt.AccountOpeningAdditionalInformationRequestV02
+	(*AccountOpeningAmendmentRequestV01)(nil),                    // 35: iso20022.acmt.AccountOpeningAmendmentRequestV01
+	(*AccountOpeningAmendmentRequestV02)(nil),                    // 36: iso20022.acmt.AccountOpeningAmendmentRequestV02
+	(*AccountOpeningInstructionV02)(nil),                         // 37: iso20022.acmt.AccountOpeningInstructionV02
+	(*AccountOpeningInstructionV03)(nil),                         // 38: iso20022.acmt.AccountOpeningInstructionV03
+	(*AccountOpeningInstructionV04)(nil),                         // 39: iso20022.acmt.AccountOpeningInstructionV04
+	(*AccountOpeningInstructionV05)(nil),                         // 40: iso20022.acmt.AccountOpeningInstructionV05
+	(*AccountOpeningInstructionV06)(nil),                         // 41: iso20022.acmt.AccountOpeningInstructionV06
+	(*AccountOpeningInstructionV07)(nil),                         // 42: iso20022.acmt.AccountOpeningInstructionV07
+	(*AccountOpeningRequestV01)(nil),                             // 43: iso20022.acmt.AccountOpeningRequestV01
+	(*AccountOpeningRequestV02)(nil),                             // 44: iso20022.acmt.AccountOpeningRequestV02
+	(*AccountReportRequestV01)(nil),                              // 45: iso20022.acmt.AccountReportRequestV01
+	(*AccountReportRequestV02)(nil),                              // 46: iso20022.acmt.AccountReportRequestV02
+	(*AccountReportV01)(nil),                                     // 47: iso20022.acmt.AccountReportV01
+	(*AccountReportV02)(nil),                                     // 48: iso20022.acmt.AccountReportV02
+	(*AccountRequestAcknowledgementV01)(nil),                     // 49: iso20022.acmt.AccountRequestAcknowledgementV01
+	(*AccountRequestAcknowledgementV02)(nil),                     // 50: iso20022.acmt.AccountRequestAcknowledgementV02
+	(*AccountRequestRejectionV01)(nil),                           // 51: iso20022.acmt.AccountRequestRejectionV01
+	(*AccountRequestRejectionV02)(nil),                           // 52: iso20022.acmt.AccountRequestRejectionV02
+	(*Document00100102)(nil),                                     // 53: iso20022.acmt.Document00100102
+	(*Document00100103)(nil),                                     // 54: iso20022.acmt.Document00100103
+	(*Document00100104)(nil),                                     // 55: iso20022.acmt.Document00100104
+	(*Document00100105)(nil),                                     // 56: iso20022.acmt.Document00100105
+	(*Document00100106)(nil),                                     // 57: iso20022.acmt.Document00100106
+	(*Document00100107)(nil),                                     // 58: iso20022.acmt.Document00100107
+	(*Document00200102)(nil),                                     // 59: iso20022.acmt.Document00200102
+	(*Document00200103)(nil),                                     // 60: iso20022.acmt.Document00200103
+	(*Document00200104)(nil),                                     // 61: iso20022.acmt.Document00200104
+	(*Document00200105)(nil),                                     // 62: iso20022.acmt.Document00200105
+	(*Document00200106)(nil),                                     // 63: iso20022.acmt.Document00200106
+	(*Document00200107)(nil),                                     // 64: iso20022.acmt.Document00200107
+	(*Document00300102)(nil),                                     // 65: iso20022.acmt.Document00300102
+	(*Document00300103)(nil),                                     // 66: iso20022.acmt.Document00300103
+	(*Document00300104)(nil),                                     // 67: iso20022.acmt.Document00300104
+	(*Document00300105)(nil),                                     // 68: iso20022.acmt.Document00300105
+	(*Document00300106)(nil),                                     // 69: iso20022.acmt.Document00300106
+	(*Document00300107)(nil),                                     // 70: iso20022.acmt.Document00300107
+	(*Document00400105)(nil),                                     // 71: iso20022.acmt.Document00400105
+	(*Document00500102)(nil),                                     // 72: iso20022.acmt.Document00500102
+	(*Document00500103)(nil),                                     // 73: iso20022.acmt.Document00500103
+	(*Document00500104)(nil),                                     // 74: iso20022.acmt.Document00500104
+	(*Document00500105)(nil),                                     // 75: iso20022.acmt.Document00500105
+	(*Document00600102)(nil),                                     // 76: iso20022.acmt.Document00600102
+	(*Document00600103)(nil),                                     // 77: iso20022.acmt.Document00600103
+	(*Document00600104)(nil),                                     // 78: iso20022.acmt.Document00600104
+	(*Document00600105)(nil),                                     // 79: iso20022.acmt.Document00600105
+	(*Document00600106)(nil),                                     // 80: iso20022.acmt.Document00600106
+	(*Document00700101)(nil),                                     // 81: iso20022.acmt.Document00700101
+	(*Document00700102)(nil),                                     // 82: iso20022.acmt.Document00700102
+	(*Document00800101)(nil),                                     // 83: iso20022.acmt.Document00800101
+	(*Document00800102)(nil),                                     // 84: iso20022.acmt.Document00800102
+	(*Document00900101)(nil),                                     // 85: iso20022.acmt.Document00900101
+	(*Document00900102)(nil),                                     // 86: iso20022.acmt.Document00900102
+	(*Document01000101)(nil),                                     // 87: iso20022.acmt.Document01000101
+	(*Document01000102)(nil),                                     // 88: iso20022.acmt.Document01000102
+	(*Document01100101)(nil),                                     // 89: iso20022.acmt.Document01100101
+	(*Document01100102)(nil),                                     // 90: iso20022.acmt.Document01100102
+	(*Document01200101)(nil),                                     // 91: iso20022.acmt.Document01200101
+	(*Document01200102)(nil),                                     // 92: iso20022.acmt.Document01200102
+	(*Document01300101)(nil),                                     // 93: iso20022.acmt.Document01300101
+	(*Document01300102)(nil),                                     // 94: iso20022.acmt.Document01300102
+	(*Document01400101)(nil),                                     // 95: iso20022.acmt.Document01400101
+	(*Document01400102)(nil),                                     // 96: iso20022.acmt.Document01400102
+	(*Document01500101)(nil),                                     // 97: iso20022.acmt.Document01500101
+	(*Document01500102)(nil),                                     // 98: iso20022.acmt.Document01500102
+	(*Document01600101)(nil),                                     // 99: iso20022.acmt.Document01600101
+	(*Document01600102)(nil),                                     // 100: iso20022.acmt.Document01600102
+	(*Document01700101)(nil),                                     // 101: iso20022.acmt.Document01700101
+	(*Document01700102)(nil),                                     // 102: iso20022.acmt.Document01700102
+	(*Document01800101)(nil),                                     // 103: iso20022.acmt.Document01800101
+	(*Document01800102)(nil),                                     // 104: iso20022.acmt.Document01800102
+	(*Document01900101)(nil),                                     // 105: iso20022.acmt.Document01900101
+	(*Document01900102)(nil),                                     // 106: iso20022.acmt.Document01900102
+	(*Document02000101)(nil),                                     // 107: iso20022.acmt.Document02000101
+	(*Document02000102)(nil),                                     // 108: iso20022.acmt.Document02000102
+	(*Document02100101)(nil),                                     // 109: iso20022.acmt.Document02100101
+	(*Document02100102)(nil),                                     // 110: iso20022.acmt.Document02100102
+	(*Document02200101)(nil),                                     // 111: iso20022.acmt.Document02200101
+	(*Document02200102)(nil),                                     // 112: iso20022.acmt.Document02200102
+	(*Document02300101)(nil),                                     // 113: iso20022.acmt.Document02300101
+	(*Document02300102)(nil),                                     // 114: iso20022.acmt.Document02300102
+	(*Document02400101)(nil),                                     // 115: iso20022.acmt.Document02400101
+	(*Document02400102)(nil),                                     // 116: iso20022.acmt.Document02400102
+	(*GetAccountDetailsV05)(nil),                                 // 117: iso20022.acmt.GetAccountDetailsV05
+	(*IdentificationModificationAdviceV01)(nil),                  // 118: iso20022.acmt.IdentificationModificationAdviceV01
+	(*IdentificationModificationAdviceV02)(nil),                  // 119: iso20022.acmt.IdentificationModificationAdviceV02
+	(*IdentificationVerificationReportV01)(nil),                  // 120: iso20022.acmt.IdentificationVerificationReportV01
+	(*IdentificationVerificationReportV02)(nil),                  // 121: iso20022.acmt.IdentificationVerificationReportV02
+	(*IdentificationVerificationRequestV01)(nil),                 // 122: iso20022.acmt.IdentificationVerificationRequestV01
+	(*IdentificationVerificationRequestV02)(nil),                 // 123: iso20022.acmt.IdentificationVerificationRequestV02
+	(*RequestForAccountManagementStatusReportV02)(nil),           // 124: iso20022.acmt.RequestForAccountManagementStatusReportV02
+	(*RequestForAccountManagementStatusReportV03)(nil),           // 125: iso20022.acmt.RequestForAccountManagementStatusReportV03
+	(*RequestForAccountManagementStatusReportV04)(nil),           // 126: iso20022.acmt.RequestForAccountManagementStatusReportV04
+	(*RequestForAccountManagementStatusReportV05)(nil),           // 127: iso20022.acmt.RequestForAccountManagementStatusReportV05
+	(*modelpb.References3)(nil),                                  // 128: iso20022.model.References3
+	(*modelpb.OrganisationIdentification6)(nil),                  // 129: iso20022.model.OrganisationIdentification6
+	(*modelpb.BranchAndFinancialInstitutionIdentification4)(nil), // 130: iso20022.model.BranchAndFinancialInstitutionIdentification4
+	(*modelpb.AccountForAction1)(nil),                            // 131: iso20022.model.AccountForAction1
+	(*modelpb.PartyAndSignature1)(nil),                           // 132: iso20022.model.PartyAndSignature1
+	(*modelpb.OrganisationIdentification8)(nil),                  // 133: iso20022.model.OrganisationIdentification8
+	(*modelpb.BranchAndFinancialInstitutionIdentification5)(nil), // 134: iso20022.model.BranchAndFinancialInstitutionIdentification5
+	(*modelpb.PartyAndSignature2)(nil),                           // 135: iso20022.model.PartyAndSignature2
+	(*modelpb.SupplementaryData1)(nil),                           // 136: iso20022.model.SupplementaryData1
+	(*modelpb.References4)(nil),                                  // 137: iso20022.model.References4
+	(*modelpb.AccountContract4)(nil),                             // 138: iso20022.model.AccountContract4
+	(*modelpb.AccountForAction2)(nil),                            // 139: iso20022.model.AccountForAction2
+	(*modelpb.Organisation14)(nil),                               // 140: iso20022.model.Organisation14
+	(*modelpb.MessageIdentification1)(nil),                       // 141: iso20022.model.MessageIdentification1
+	(*modelpb.InvestmentFundOrder4)(nil),                         // 142: iso20022.model.InvestmentFundOrder4
+	(*modelpb.AdditionalReference3)(nil),                         // 143: iso20022.model.AdditionalReference3
+	(*modelpb.AccountManagementConfirmation1)(nil),               // 144: iso20022.model.AccountManagementConfirmation1
+	(*modelpb.InvestmentAccount27)(nil),                          // 145: iso20022.model.InvestmentAccount27
+	(*modelpb.AccountParties5)(nil),                              // 146: iso20022.model.AccountParties5
+	(*modelpb.Intermediary12)(nil),                               // 147: iso20022.model.Intermediary12
+	(*modelpb.ReferredAgent1)(nil),                               // 148: iso20022.model.ReferredAgent1
+	(*modelpb.NewIssueAllocation1)(nil),                          // 149: iso20022.model.NewIssueAllocation1
+	(*modelpb.InvestmentPlan4)(nil),                              // 150: iso20022.model.InvestmentPlan4
+	(*modelpb.InvestmentFundCashSettlementInformation3)(nil),     // 151: iso20022.model.InvestmentFundCashSettlementInformation3
+	(*modelpb.DocumentToSend1)(nil),                              // 152: iso20022.model.DocumentToSend1
+	(*modelpb.Extension1)(nil),                                   // 153: iso20022.model.Extension1
+	(*modelpb.InvestmentAccount35)(nil),                          // 154: iso20022.model.InvestmentAccount35
+	(*modelpb.AccountParties6)(nil),                              // 155: iso20022.model.AccountParties6
+	(*modelpb.InvestmentPlan6)(nil),                              // 156: iso20022.model.InvestmentPlan6
+	(*modelpb.InvestmentFundCashSettlementInformation5)(nil),     // 157: iso20022.model.InvestmentFundCashSettlementInformation5
+	(*modelpb.InvestmentAccount38)(nil),                          // 158: iso20022.model.InvestmentAccount38
+	(*modelpb.AccountParties9)(nil),                              // 159: iso20022.model.AccountParties9
+	(*modelpb.Intermediary24)(nil),                               // 160: iso20022.model.Intermediary24
+	(*modelpb.InvestmentPlan9)(nil),                              // 161: iso20022.model.InvestmentPlan9
+	(*modelpb.InvestmentFundCashSettlementInformation7)(nil),     // 162: iso20022.model.InvestmentFundCashSettlementInformation7
+	(*modelpb.MarketPracticeVersion1)(nil),                       // 163: iso20022.model.MarketPracticeVersion1
+	(*modelpb.AccountManagementConfirmation2)(nil),               // 164: iso20022.model.AccountManagementConfirmation2
+	(*modelpb.InvestmentAccount46)(nil),                          // 165: iso20022.model.InvestmentAccount46
+	(*modelpb.AccountParties12)(nil),                             // 166: iso20022.model.AccountParties12
+	(*modelpb.NewIssueAllocation2)(nil),                          // 167: iso20022.model.NewIssueAllocation2
+	(*modelpb.InvestmentPlan10)(nil),                             // 168: iso20022.model.InvestmentPlan10
+	(*modelpb.DocumentToSend2)(nil),                              // 169: iso20022.model.DocumentToSend2
+	(*modelpb.AdditionalReference6)(nil),                         // 170: iso20022.model.AdditionalReference6
+	(*modelpb.AccountManagementConfirmation3)(nil),               // 171: iso20022.model.AccountManagementConfirmation3
+	(*modelpb.InvestmentAccount50)(nil),                          // 172: iso20022.model.InvestmentAccount50
+	(*modelpb.AccountParties13)(nil),                             // 173: iso20022.model.AccountParties13
+	(*modelpb.Intermediary36)(nil),                               // 174: iso20022.model.Intermediary36
+	(*modelpb.ReferredAgent2)(nil),                               // 175: iso20022.model.ReferredAgent2
+	(*modelpb.InvestmentPlan12)(nil),                             // 176: iso20022.model.InvestmentPlan12
+	(*modelpb.CashSettlement1)(nil),                              // 177: iso20022.model.CashSettlement1
+	(*modelpb.DocumentToSend3)(nil),                              // 178: iso20022.model.DocumentToSend3
+	(*modelpb.AccountRestrictions1)(nil),                         // 179: iso20022.model.AccountRestrictions1
+	(*modelpb.AccountManagementConfirmation4)(nil),               // 180: iso20022.model.AccountManagementConfirmation4
+	(*modelpb.InvestmentAccount62)(nil),                          // 181: iso20022.model.InvestmentAccount62
+	(*modelpb.AccountParties15)(nil),                             // 182: iso20022.model.AccountParties15
+	(*modelpb.InvestmentPlan14)(nil),                             // 183: iso20022.model.InvestmentPlan14
+	(*modelpb.AdditiononalInformation12)(nil),                    // 184: iso20022.model.AdditiononalInformation12
+	(*modelpb.AccountContract2)(nil),                             // 185: iso20022.model.AccountContract2
+	(*modelpb.ContractDocument1)(nil),                            // 186: iso20022.model.ContractDocument1
+	(*modelpb.CustomerAccount1)(nil),                             // 187: iso20022.model.CustomerAccount1
+	(*modelpb.Organisation6)(nil),                                // 188: iso20022.model.Organisation6
+	(*modelpb.CustomerAccountModification1)(nil),                 // 189: iso20022.model.CustomerAccountModification1
+	(*modelpb.OrganisationModification1)(nil),                    // 190: iso20022.model.OrganisationModification1
+	(*modelpb.AdditionalInformation5)(nil),                       // 191: iso20022.model.AdditionalInformation5
+	(*modelpb.AccountManagementStatusAndReason1)(nil),            // 192: iso20022.model.AccountManagementStatusAndReason1
+	(*modelpb.AccountManagementStatusAndReason2)(nil),            // 193: iso20022.model.AccountManagementStatusAndReason2
+	(*modelpb.AccountManagementStatusAndReason3)(nil),            // 194: iso20022.model.AccountManagementStatusAndReason3
+	(*modelpb.AccountManagementStatusAndReason4)(nil),            // 195: iso20022.model.AccountManagementStatusAndReason4
+	(*modelpb.AccountManagementStatusAndReason5)(nil),            // 196: iso20022.model.AccountManagementStatusAndReason5
+	(*modelpb.OperationMandate1)(nil),                            // 197: iso20022.model.OperationMandate1
+	(*modelpb.OperationMandate3)(nil),                            // 198: iso20022.model.OperationMandate3
+	(*modelpb.Group2)(nil),                                       // 199: iso20022.model.Group2
+	(*modelpb.InvestmentAccountModificationDetails)(nil),         // 200: iso20022.model.InvestmentAccountModificationDetails
+	(*modelpb.InvestmentAccountSelection2)(nil),                  // 201: iso20022.model.InvestmentAccountSelection2
+	(*modelpb.InvestmentAccount28)(nil),                          // 202: iso20022.model.InvestmentAccount28
+	(*modelpb.AccountParties4)(nil),                              // 203: iso20022.model.AccountParties4
+	(*modelpb.ModificationScope7)(nil),                           // 204: iso20022.model.ModificationScope7
+	(*modelpb.ModificationScope9)(nil),                           // 205: iso20022.model.ModificationScope9
+	(*modelpb.ModificationScope8)(nil),                           // 206: iso20022.model.ModificationScope8
+	(*modelpb.InvestmentFundCashSettlementInformation4)(nil),     // 207: iso20022.model.InvestmentFundCashSettlementInformation4
+	(*modelpb.ModificationScope10)(nil),                          // 208: iso20022.model.ModificationScope10
+	(*modelpb.InvestmentAccount36)(nil),                          // 209: iso20022.model.InvestmentAccount36
+	(*modelpb.AccountParties7)(nil),                              // 210: iso20022.model.AccountParties7
+	(*modelpb.ModificationScope16)(nil),                          // 211: iso20022.model.ModificationScope16
+	(*modelpb.InvestmentFundCashSettlementInformation6)(nil),     // 212: iso20022.model.InvestmentFundCashSettlementInformation6
+	(*modelpb.InvestmentAccount39)(nil),                          // 213: iso20022.model.InvestmentAccount39
+	(*modelpb.AccountParties8)(nil),                              // 214: iso20022.model.AccountParties8
+	(*modelpb.ModificationScope20)(nil),                          // 215: iso20022.model.ModificationScope20
+	(*modelpb.ModificationScope18)(nil),                          // 216: iso20022.model.ModificationScope18
+	(*modelpb.InvestmentFundCashSettlementInformation8)(nil),     // 217: iso20022.model.InvestmentFundCashSettlementInformation8
+	(*modelpb.InvestmentAccountModification1)(nil),               // 218: iso20022.model.InvestmentAccountModification1
+	(*modelpb.InvestmentAccount47)(nil),                          // 219: iso20022.model.InvestmentAccount47
+	(*modelpb.AccountParties11)(nil),                             // 220: iso20022.model.AccountParties11
+	(*modelpb.ModificationScope21)(nil),                          // 221: iso20022.model.ModificationScope21
+	(*modelpb.ModificationScope25)(nil),                          // 222: iso20022.model.ModificationScope25
+	(*modelpb.ModificationScope22)(nil),                          // 223: iso20022.model.ModificationScope22
+	(*modelpb.InvestmentAccountModification2)(nil),               // 224: iso20022.model.InvestmentAccountModification2
+	(*modelpb.AccountSelection1Choice)(nil),                      // 225: iso20022.model.AccountSelection1Choice
+	(*modelpb.InvestmentAccount51)(nil),                          // 226: iso20022.model.InvestmentAccount51
+	(*modelpb.AccountParties14)(nil),                             // 227: iso20022.model.AccountParties14
+	(*modelpb.ModificationScope26)(nil),                          // 228: iso20022.model.ModificationScope26
+	(*modelpb.ModificationScope33)(nil),                          // 229: iso20022.model.ModificationScope33
+	(*modelpb.ModificationScope28)(nil),                          // 230: iso20022.model.ModificationScope28
+	(*modelpb.CashSettlement2)(nil),                              // 231: iso20022.model.CashSettlement2
+	(*modelpb.ModificationScope31)(nil),                          // 232: iso20022.model.ModificationScope31
+	(*modelpb.ModificationScope30)(nil),                          // 233: iso20022.model.ModificationScope30
+	(*modelpb.InvestmentAccountModification3)(nil),               // 234: iso20022.model.InvestmentAccountModification3
+	(*modelpb.AccountSelection2Choice)(nil),                      // 235: iso20022.model.AccountSelection2Choice
+	(*modelpb.InvestmentAccount63)(nil),                          // 236: iso20022.model.InvestmentAccount63
+	(*modelpb.AccountParties16)(nil),                             // 237: iso20022.model.AccountParties16
+	(*modelpb.ModificationScope38)(nil),                          // 238: iso20022.model.ModificationScope38
+	(*modelpb.ModificationScope37)(nil),                          // 239: iso20022.model.ModificationScope37
+	(*modelpb.ModificationScope35)(nil),                          // 240: iso20022.model.ModificationScope35
+	(*modelpb.CustomerAccount4)(nil),                             // 241: iso20022.model.CustomerAccount4
+	(*modelpb.CashAccount16)(nil),                                // 242: iso20022.model.CashAccount16
+	(*modelpb.Organisation12)(nil),                               // 243: iso20022.model.Organisation12
+	(*modelpb.OperationMandate2)(nil),                            // 244: iso20022.model.OperationMandate2
+	(*modelpb.Group1)(nil),                                       // 245: iso20022.model.Group1
+	(*modelpb.CashAccount24)(nil),                                // 246: iso20022.model.CashAccount24
+	(*modelpb.InvestmentAccountOpeningDetails)(nil),              // 247: iso20022.model.InvestmentAccountOpeningDetails
+	(*modelpb.InvestmentAccount26)(nil),                          // 248: iso20022.model.InvestmentAccount26
+	(*modelpb.InvestmentAccount34)(nil),                          // 249: iso20022.model.InvestmentAccount34
+	(*modelpb.InvestmentAccount37)(nil),                          // 250: iso20022.model.InvestmentAccount37
+	(*modelpb.InvestmentAccountOpening1)(nil),                    // 251: iso20022.model.InvestmentAccountOpening1
+	(*modelpb.AccountParties10)(nil),                             // 252: iso20022.model.AccountParties10
+	(*modelpb.InvestmentAccountOpening2)(nil),                    // 253: iso20022.model.InvestmentAccountOpening2
+	(*modelpb.InvestmentAccount49)(nil),                          // 254: iso20022.model.InvestmentAccount49
+	(*modelpb.InvestmentAccountOpening3)(nil),                    // 255: iso20022.model.InvestmentAccountOpening3
+	(*modelpb.InvestmentAccount61)(nil),                          // 256: iso20022.model.InvestmentAccount61
+	(*modelpb.Organisation7)(nil),                                // 257: iso20022.model.Organisation7
+	(*modelpb.References5)(nil),                                  // 258: iso20022.model.References5
+	(*modelpb.AccountReport1)(nil),                               // 259: iso20022.model.AccountReport1
+	(*modelpb.AccountReport15)(nil),                              // 260: iso20022.model.AccountReport15
+	(*modelpb.References6)(nil),                                  // 261: iso20022.model.References6
+	(*modelpb.InvestmentAccountInformationType1)(nil),            // 262: iso20022.model.InvestmentAccountInformationType1
+	(*modelpb.IdentificationAssignment1)(nil),                    // 263: iso20022.model.IdentificationAssignment1
+	(*modelpb.OriginalTransactionReference14)(nil),               // 264: iso20022.model.OriginalTransactionReference14
+	(*modelpb.IdentificationModification1)(nil),                  // 265: iso20022.model.IdentificationModification1
+	(*modelpb.IdentificationAssignment2)(nil),                    // 266: iso20022.model.IdentificationAssignment2
+	(*modelpb.OriginalTransactionReference18)(nil),               // 267: iso20022.model.OriginalTransactionReference18
+	(*modelpb.IdentificationModification2)(nil),                  // 268: iso20022.model.IdentificationModification2
+	(*modelpb.MessageIdentification4)(nil),                       // 269: iso20022.model.MessageIdentification4
+	(*modelpb.VerificationReport1)(nil),                          // 270: iso20022.model.VerificationReport1
+	(*modelpb.MessageIdentification5)(nil),                       // 271: iso20022.model.MessageIdentification5
+	(*modelpb.VerificationReport2)(nil),                          // 272: iso20022.model.VerificationReport2
+	(*modelpb.IdentificationVerification1)(nil),                  // 273: iso20022.model.IdentificationVerification1
+	(*modelpb.IdentificationVerification2)(nil),                  // 274: iso20022.model.IdentificationVerification2
+	(*modelpb.AccountManagementMessageReference1)(nil),           // 275: iso20022.model.AccountManagementMessageReference1
+	(*modelpb.AccountManagementMessageReference2)(nil),           // 276: iso20022.model.AccountManagementMessageReference2
+	(*modelpb.AccountManagementMessageReference3)(nil),           // 277: iso20022.model.AccountManagementMessageReference3
+	(*modelpb.AccountManagementMessageReference4)(nil),           // 278: iso20022.model.AccountManagementMessageReference4
+}
+var file_iso20022_acmt_proto_depIdxs = []int32{
+	128, // 0: iso20022.acmt.AccountAdditionalInformationRequestV01.references:type_name -> iso20022.model.References3
+	129, // 1: iso20022.acmt.AccountAdditionalInformationRequestV01.organisation_identification:type_name -> iso20022.model.OrganisationIdentification6
+	130, // 2: iso20022.acmt.AccountAdditionalInformationRequestV01.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	131, // 3: iso20022.acmt.AccountAdditionalInformationRequestV01.account_identification:type_name -> iso20022.model.AccountForAction1
+	132, // 4: iso20022.acmt.AccountAdditionalInformationRequestV01.digital_signature:type_name -> iso20022.model.PartyAndSignature1
+	128, // 5: iso20022.acmt.AccountAdditionalInformationRequestV02.references:type_name -> iso20022.model.References3
+	133, // 6: iso20022.acmt.AccountAdditionalInformationRequestV02.from:type_name -> iso20022.model.OrganisationIdentification8
+	133, // 7: iso20022.acmt.AccountAdditionalInformationRequestV02.organisation_identification:type_name -> iso20022.model.OrganisationIdentification8
+	134, // 8: iso20022.acmt.AccountAdditionalInformationRequestV02.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	131, // 9: iso20022.acmt.AccountAdditionalInformationRequestV02.account_identification:type_name -> iso20022.model.AccountForAction1
+	135, // 10: iso20022.acmt.AccountAdditionalInformationRequestV02.digital_signature:type_name -> iso20022.model.PartyAndSignature2
+	136, // 11: iso20022.acmt.AccountAdditionalInformationRequestV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	128, // 12: iso20022.acmt.AccountClosingAdditionalInformationRequestV01.references:type_name -> iso20022.model.References3
+	129, // 13: iso20022.acmt.AccountClosingAdditionalInformationRequestV01.organisation_identification:type_name -> iso20022.model.OrganisationIdentification6
+	131, // 14: iso20022.acmt.AccountClosingAdditionalInformationRequestV01.account_identification:type_name -> iso20022.model.AccountForAction1
+	130, // 15: iso20022.acmt.AccountClosingAdditionalInformationRequestV01.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	131, // 16: iso20022.acmt.AccountClosingAdditionalInformationRequestV01.balance_transfer_account:type_name -> iso20022.model.AccountForAction1
+	130, // 17: iso20022.acmt.AccountClosingAdditionalInformationRequestV01.transfer_account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	132, // 18: iso20022.acmt.AccountClosingAdditionalInformationRequestV01.digital_signature:type_name -> iso20022.model.PartyAndSignature1
+	128, // 19: iso20022.acmt.AccountClosingAdditionalInformationRequestV02.references:type_name -> iso20022.model.References3
+	133, // 20: iso20022.acmt.AccountClosingAdditionalInformationRequestV02.from:type_name -> iso20022.model.OrganisationIdentification8
+	133, // 21: iso20022.acmt.AccountClosingAdditionalInformationRequestV02.organisation_identification:type_name -> iso20022.model.OrganisationIdentification8
+	131, // 22: iso20022.acmt.AccountClosingAdditionalInformationRequestV02.account_identification:type_name -> iso20022.model.AccountForAction1
+	134, // 23: iso20022.acmt.AccountClosingAdditionalInformationRequestV02.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	131, // 24: iso20022.acmt.AccountClosingAdditionalInformationRequestV02.balance_transfer_account:type_name -> iso20022.model.AccountForAction1
+	134, // 25: iso20022.acmt.AccountClosingAdditionalInformationRequestV02.transfer_account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	135, // 26: iso20022.acmt.AccountClosingAdditionalInformationRequestV02.digital_signature:type_name -> iso20022.model.PartyAndSignature2
+	136, // 27: iso20022.acmt.AccountClosingAdditionalInformationRequestV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	137, // 28: iso20022.acmt.AccountClosingAmendmentRequestV01.references:type_name -> iso20022.model.References4
+	131, // 29: iso20022.acmt.AccountClosingAmendmentRequestV01.account_identification:type_name -> iso20022.model.AccountForAction1
+	130, // 30: iso20022.acmt.AccountClosingAmendmentRequestV01.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	129, // 31: iso20022.acmt.AccountClosingAmendmentRequestV01.organisation_identification:type_name -> iso20022.model.OrganisationIdentification6
+	138, // 32: iso20022.acmt.AccountClosingAmendmentRequestV01.contract_dates:type_name -> iso20022.model.AccountContract4
+	131, // 33: iso20022.acmt.AccountClosingAmendmentRequestV01.balance_transfer_account:type_name -> iso20022.model.AccountForAction1
+	130, // 34: iso20022.acmt.AccountClosingAmendmentRequestV01.transfer_account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	132, // 35: iso20022.acmt.AccountClosingAmendmentRequestV01.digital_signature:type_name -> iso20022.model.PartyAndSignature1
+	137, // 36: iso20022.acmt.AccountClosingAmendmentRequestV02.references:type_name -> iso20022.model.References4
+	133, // 37: iso20022.acmt.AccountClosingAmendmentRequestV02.from:type_name -> iso20022.model.OrganisationIdentification8
+	131, // 38: iso20022.acmt.AccountClosingAmendmentRequestV02.account_identification:type_name -> iso20022.model.AccountForAction1
+	134, // 39: iso20022.acmt.AccountClosingAmendmentRequestV02.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	133, // 40: iso20022.acmt.AccountClosingAmendmentRequestV02.organisation_identification:type_name -> iso20022.model.OrganisationIdentification8
+	138, // 41: iso20022.acmt.AccountClosingAmendmentRequestV02.contract_dates:type_name -> iso20022.model.AccountContract4
+	131, // 42: iso20022.acmt.AccountClosingAmendmentRequestV02.balance_transfer_account:type_name -> iso20022.model.AccountForAction1
+	134, // 43: iso20022.acmt.AccountClosingAmendmentRequestV02.transfer_account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	135, // 44: iso20022.acmt.AccountClosingAmendmentRequestV02.digital_signature:type_name -> iso20022.model.PartyAndSignature2
+	136, // 45: iso20022.acmt.AccountClosingAmendmentRequestV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	137, // 46: iso20022.acmt.AccountClosingRequestV01.references:type_name -> iso20022.model.References4
+	131, // 47: iso20022.acmt.AccountClosingRequestV01.account_identification:type_name -> iso20022.model.AccountForAction1
+	130, // 48: iso20022.acmt.AccountClosingRequestV01.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	129, // 49: iso20022.acmt.AccountClosingRequestV01.organisation_identification:type_name -> iso20022.model.OrganisationIdentification6
+	138, // 50: iso20022.acmt.AccountClosingRequestV01.contract_dates:type_name -> iso20022.model.AccountContract4
+	131, // 51: iso20022.acmt.AccountClosingRequestV01.balance_transfer_account:type_name -> iso20022.model.AccountForAction1
+	130, // 52: iso20022.acmt.AccountClosingRequestV01.transfer_account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	132, // 53: iso20022.acmt.AccountClosingRequestV01.digital_signature:type_name -> iso20022.model.PartyAndSignature1
+	137, // 54: iso20022.acmt.AccountClosingRequestV02.references:type_name -> iso20022.model.References4
+	133, // 55: iso20022.acmt.AccountClosingRequestV02.from:type_name -> iso20022.model.OrganisationIdentification8
+	139, // 56: iso20022.acmt.AccountClosingRequestV02.account_identification:type_name -> iso20022.model.AccountForAction2
+	134, // 57: iso20022.acmt.AccountClosingRequestV02.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	140, // 58: iso20022.acmt.AccountClosingRequestV02.organisation_identification:type_name -> iso20022.model.Organisation14
+	138, // 59: iso20022.acmt.AccountClosingRequestV02.contract_dates:type_name -> iso20022.model.AccountContract4
+	131, // 60: iso20022.acmt.AccountClosingRequestV02.balance_transfer_account:type_name -> iso20022.model.AccountForAction1
+	134, // 61: iso20022.acmt.AccountClosingRequestV02.transfer_account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	135, // 62: iso20022.acmt.AccountClosingRequestV02.digital_signature:type_name -> iso20022.model.PartyAndSignature2
+	136, // 63: iso20022.acmt.AccountClosingRequestV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	141, // 64: iso20022.acmt.AccountDetailsConfirmationV02.message_identification:type_name -> iso20022.model.MessageIdentification1
+	142, // 65: iso20022.acmt.AccountDetailsConfirmationV02.order_reference:type_name -> iso20022.model.InvestmentFundOrder4
+	143, // 66: iso20022.acmt.AccountDetailsConfirmationV02.related_reference:type_name -> iso20022.model.AdditionalReference3
+	144, // 67: iso20022.acmt.AccountDetailsConfirmationV02.confirmation_details:type_name -> iso20022.model.AccountManagementConfirmation1
+	145, // 68: iso20022.acmt.AccountDetailsConfirmationV02.investment_account:type_name -> iso20022.model.InvestmentAccount27
+	146, // 69: iso20022.acmt.AccountDetailsConfirmationV02.account_parties:type_name -> iso20022.model.AccountParties5
+	147, // 70: iso20022.acmt.AccountDetailsConfirmationV02.intermediaries:type_name -> iso20022.model.Intermediary12
+	148, // 71: iso20022.acmt.AccountDetailsConfirmationV02.placement:type_name -> iso20022.model.ReferredAgent1
+	149, // 72: iso20022.acmt.AccountDetailsConfirmationV02.new_issue_allocation:type_name -> iso20022.model.NewIssueAllocation1
+	150, // 73: iso20022.acmt.AccountDetailsConfirmationV02.savings_investment_plan:type_name -> iso20022.model.InvestmentPlan4
+	150, // 74: iso20022.acmt.AccountDetailsConfirmationV02.withdrawal_investment_plan:type_name -> iso20022.model.InvestmentPlan4
+	151, // 75: iso20022.acmt.AccountDetailsConfirmationV02.cash_settlement:type_name -> iso20022.model.InvestmentFundCashSettlementInformation3
+	152, // 76: iso20022.acmt.AccountDetailsConfirmationV02.service_level_agreement:type_name -> iso20022.model.DocumentToSend1
+	153, // 77: iso20022.acmt.AccountDetailsConfirmationV02.extension:type_name -> iso20022.model.Extension1
+	141, // 78: iso20022.acmt.AccountDetailsConfirmationV03.message_identification:type_name -> iso20022.model.MessageIdentification1
+	142, // 79: iso20022.acmt.AccountDetailsConfirmationV03.order_reference:type_name -> iso20022.model.InvestmentFundOrder4
+	143, // 80: iso20022.acmt.AccountDetailsConfirmationV03.related_reference:type_name -> iso20022.model.AdditionalReference3
+	144, // 81: iso20022.acmt.AccountDetailsConfirmationV03.confirmation_details:type_name -> iso20022.model.AccountManagementConfirmation1
+	154, // 82: iso20022.acmt.AccountDetailsConfirmationV03.investment_account:type_name -> iso20022.model.InvestmentAccount35
+	155, // 83: iso20022.acmt.AccountDetailsConfirmationV03.account_parties:type_name -> iso20022.model.AccountParties6
+	147, // 84: iso20022.acmt.AccountDetailsConfirmationV03.intermediaries:type_name -> iso20022.model.Intermediary12
+	148, // 85: iso20022.acmt.AccountDetailsConfirmationV03.placement:type_name -> iso20022.model.ReferredAgent1
+	149, // 86: iso20022.acmt.AccountDetailsConfirmationV03.new_issue_allocation:type_name -> iso20022.model.NewIssueAllocation1
+	156, // 87: iso20022.acmt.AccountDetailsConfirmationV03.savings_investment_plan:type_name -> iso20022.model.InvestmentPlan6
+	156, // 88: iso20022.acmt.AccountDetailsConfirmationV03.withdrawal_investment_plan:type_name -> iso20022.model.InvestmentPlan6
+	157, // 89: iso20022.acmt.AccountDetailsConfirmationV03.cash_settlement:type_name -> iso20022.model.InvestmentFundCashSettlementInformation5
+	152, // 90: iso20022.acmt.AccountDetailsConfirmationV03.service_level_agreement:type_name -> iso20022.model.DocumentToSend1
+	153, // 91: iso20022.acmt.AccountDetailsConfirmationV03.extension:type_name -> iso20022.model.Extension1
+	141, // 92: iso20022.acmt.AccountDetailsConfirmationV04.message_identification:type_name -> iso20022.model.MessageIdentification1
+	142, // 93: iso20022.acmt.AccountDetailsConfirmationV04.order_reference:type_name -> iso20022.model.InvestmentFundOrder4
+	143, // 94: iso20022.acmt.AccountDetailsConfirmationV04.related_reference:type_name -> iso20022.model.AdditionalReference3
+	144, // 95: iso20022.acmt.AccountDetailsConfirmationV04.confirmation_details:type_name -> iso20022.model.AccountManagementConfirmation1
+	158, // 96: iso20022.acmt.AccountDetailsConfirmationV04.investment_account:type_name -> iso20022.model.InvestmentAccount38
+	159, // 97: iso20022.acmt.AccountDetailsConfirmationV04.account_parties:type_name -> iso20022.model.AccountParties9
+	160, // 98: iso20022.acmt.AccountDetailsConfirmationV04.intermediaries:type_name -> iso20022.model.Intermediary24
+	148, // 99: iso20022.acmt.AccountDetailsConfirmationV04.placement:type_name -> iso20022.model.ReferredAgent1
+	149, // 100: iso20022.acmt.AccountDetailsConfirmationV04.new_issue_allocation:type_name -> iso20022.model.NewIssueAllocation1
+	161, // 101: iso20022.acmt.AccountDetailsConfirmationV04.savings_investment_plan:type_name -> iso20022.model.InvestmentPlan9
+	161, // 102: iso20022.acmt.AccountDetailsConfirmationV04.withdrawal_investment_plan:type_name -> iso20022.model.InvestmentPlan9
+	162, // 103: iso20022.acmt.AccountDetailsConfirmationV04.cash_settlement:type_name -> iso20022.model.InvestmentFundCashSettlementInformation7
+	152, // 104: iso20022.acmt.AccountDetailsConfirmationV04.service_level_agreement:type_name -> iso20022.model.DocumentToSend1
+	163, // 105: iso20022.acmt.AccountDetailsConfirmationV04.market_practice_version:type_name -> iso20022.model.MarketPracticeVersion1
+	153, // 106: iso20022.acmt.AccountDetailsConfirmationV04.extension:type_name -> iso20022.model.Extension1
+	141, // 107: iso20022.acmt.AccountDetailsConfirmationV05.message_identification:type_name -> iso20022.model.MessageIdentification1
+	142, // 108: iso20022.acmt.AccountDetailsConfirmationV05.order_reference:type_name -> iso20022.model.InvestmentFundOrder4
+	143, // 109: iso20022.acmt.AccountDetailsConfirmationV05.related_reference:type_name -> iso20022.model.AdditionalReference3
+	164, // 110: iso20022.acmt.AccountDetailsConfirmationV05.confirmation_details:type_name -> iso20022.model.AccountManagementConfirmation2
+	165, // 111: iso20022.acmt.AccountDetailsConfirmationV05.investment_account:type_name -> iso20022.model.InvestmentAccount46
+	166, // 112: iso20022.acmt.AccountDetailsConfirmationV05.account_parties:type_name -> iso20022.model.AccountParties12
+	160, // 113: iso20022.acmt.AccountDetailsConfirmationV05.intermediaries:type_name -> iso20022.model.Intermediary24
+	148, // 114: iso20022.acmt.AccountDetailsConfirmationV05.placement:type_name -> iso20022.model.ReferredAgent1
+	167, // 115: iso20022.acmt.AccountDetailsConfirmationV05.new_issue_allocation:type_name -> iso20022.model.NewIssueAllocation2
+	168, // 116: iso20022.acmt.AccountDetailsConfirmationV05.savings_investment_plan:type_name -> iso20022.model.InvestmentPlan10
+	168, // 117: iso20022.acmt.AccountDetailsConfirmationV05.withdrawal_investment_plan:type_name -> iso20022.model.InvestmentPlan10
+	162, // 118: iso20022.acmt.AccountDetailsConfirmationV05.cash_settlement:type_name -> iso20022.model.InvestmentFundCashSettlementInformation7
+	169, // 119: iso20022.acmt.AccountDetailsConfirmationV05.service_level_agreement:type_name -> iso20022.model.DocumentToSend2
+	163, // 120: iso20022.acmt.AccountDetailsConfirmationV05.market_practice_version:type_name -> iso20022.model.MarketPracticeVersion1
+	153, // 121: iso20022.acmt.AccountDetailsConfirmationV05.extension:type_name -> iso20022.model.Extension1
+	141, // 122: iso20022.acmt.AccountDetailsConfirmationV06.message_identification:type_name -> iso20022.model.MessageIdentification1
+	142, // 123: iso20022.acmt.AccountDetailsConfirmationV06.order_reference:type_name -> iso20022.model.InvestmentFundOrder4
+	170, // 124: iso20022.acmt.AccountDetailsConfirmationV06.related_reference:type_name -> iso20022.model.AdditionalReference6
+	171, // 125: iso20022.acmt.AccountDetailsConfirmationV06.confirmation_details:type_name -> iso20022.model.AccountManagementConfirmation3
+	172, // 126: iso20022.acmt.AccountDetailsConfirmationV06.investment_account:type_name -> iso20022.model.InvestmentAccount50
+	173, // 127: iso20022.acmt.AccountDetailsConfirmationV06.account_parties:type_name -> iso20022.model.AccountParties13
+	174, // 128: iso20022.acmt.AccountDetailsConfirmationV06.intermediaries:type_name -> iso20022.model.Intermediary36
+	175, // 129: iso20022.acmt.AccountDetailsConfirmationV06.placement:type_name -> iso20022.model.ReferredAgent2
+	167, // 130: iso20022.acmt.AccountDetailsConfirmationV06.new_issue_allocation:type_name -> iso20022.model.NewIssueAllocation2
+	176, // 131: iso20022.acmt.AccountDetailsConfirmationV06.savings_investment_plan:type_name -> iso20022.model.InvestmentPlan12
+	176, // 132: iso20022.acmt.AccountDetailsConfirmationV06.withdrawal_investment_plan:type_name -> iso20022.model.InvestmentPlan12
+	177, // 133: iso20022.acmt.AccountDetailsConfirmationV06.cash_settlement:type_name -> iso20022.model.CashSettlement1
+	178, // 134: iso20022.acmt.AccountDetailsConfirmationV06.service_level_agreement:type_name -> iso20022.model.DocumentToSend3
+	179, // 135: iso20022.acmt.AccountDetailsConfirmationV06.additional_information:type_name -> iso20022.model.AccountRestrictions1
+	163, // 136: iso20022.acmt.AccountDetailsConfirmationV06.market_practice_version:type_name -> iso20022.model.MarketPracticeVersion1
+	153, // 137: iso20022.acmt.AccountDetailsConfirmationV06.extension:type_name -> iso20022.model.Extension1
+	141, // 138: iso20022.acmt.AccountDetailsConfirmationV07.message_identification:type_name -> iso20022.model.MessageIdentification1
+	142, // 139: iso20022.acmt.AccountDetailsConfirmationV07.order_reference:type_name -> iso20022.model.InvestmentFundOrder4
+	170, // 140: iso20022.acmt.AccountDetailsConfirmationV07.related_reference:type_name -> iso20022.model.AdditionalReference6
+	180, // 141: iso20022.acmt.AccountDetailsConfirmationV07.confirmation_details:type_name -> iso20022.model.AccountManagementConfirmation4
+	181, // 142: iso20022.acmt.AccountDetailsConfirmationV07.investment_account:type_name -> iso20022.model.InvestmentAccount62
+	182, // 143: iso20022.acmt.AccountDetailsConfirmationV07.account_parties:type_name -> iso20022.model.AccountParties15
+	174, // 144: iso20022.acmt.AccountDetailsConfirmationV07.intermediaries:type_name -> iso20022.model.Intermediary36
+	175, // 145: iso20022.acmt.AccountDetailsConfirmationV07.placement:type_name -> iso20022.model.ReferredAgent2
+	167, // 146: iso20022.acmt.AccountDetailsConfirmationV07.new_issue_allocation:type_name -> iso20022.model.NewIssueAllocation2
+	183, // 147: iso20022.acmt.AccountDetailsConfirmationV07.savings_investment_plan:type_name -> iso20022.model.InvestmentPlan14
+	183, // 148: iso20022.acmt.AccountDetailsConfirmationV07.withdrawal_investment_plan:type_name -> iso20022.model.InvestmentPlan14
+	177, // 149: iso20022.acmt.AccountDetailsConfirmationV07.cash_settlement:type_name -> iso20022.model.CashSettlement1
+	178, // 150: iso20022.acmt.AccountDetailsConfirmationV07.service_level_agreement:type_name -> iso20022.model.DocumentToSend3
+	184, // 151: iso20022.acmt.AccountDetailsConfirmationV07.additional_information:type_name -> iso20022.model.AdditiononalInformation12
+	163, // 152: iso20022.acmt.AccountDetailsConfirmationV07.market_practice_version:type_name -> iso20022.model.MarketPracticeVersion1
+	153, // 153: iso20022.acmt.AccountDetailsConfirmationV07.extension:type_name -> iso20022.model.Extension1
+	137, // 154: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV01.references:type_name -> iso20022.model.References4
+	185, // 155: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV01.contract_dates:type_name -> iso20022.model.AccountContract2
+	186, // 156: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV01.underlying_master_agreement:type_name -> iso20022.model.ContractDocument1
+	187, // 157: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV01.account:type_name -> iso20022.model.CustomerAccount1
+	130, // 158: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV01.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	188, // 159: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV01.organisation:type_name -> iso20022.model.Organisation6
+	132, // 160: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV01.digital_signature:type_name -> iso20022.model.PartyAndSignature1
+	137, // 161: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV02.references:type_name -> iso20022.model.References4
+	133, // 162: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV02.from:type_name -> iso20022.model.OrganisationIdentification8
+	185, // 163: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV02.contract_dates:type_name -> iso20022.model.AccountContract2
+	186, // 164: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV02.underlying_master_agreement:type_name -> iso20022.model.ContractDocument1
+	189, // 165: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV02.account:type_name -> iso20022.model.CustomerAccountModification1
+	134, // 166: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV02.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	190, // 167: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV02.organisation:type_name -> iso20022.model.OrganisationModification1
+	135, // 168: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV02.digital_signature:type_name -> iso20022.model.PartyAndSignature2
+	136, // 169: iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	137, // 170: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV01.references:type_name -> iso20022.model.References4
+	185, // 171: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV01.contract_dates:type_name -> iso20022.model.AccountContract2
+	186, // 172: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV01.underlying_master_agreement:type_name -> iso20022.model.ContractDocument1
+	187, // 173: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV01.account:type_name -> iso20022.model.CustomerAccount1
+	130, // 174: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV01.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	188, // 175: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV01.organisation:type_name -> iso20022.model.Organisation6
+	191, // 176: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV01.additional_message_information:type_name -> iso20022.model.AdditionalInformation5
+	132, // 177: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV01.digital_signature:type_name -> iso20022.model.PartyAndSignature1
+	137, // 178: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV02.references:type_name -> iso20022.model.References4
+	133, // 179: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV02.from:type_name -> iso20022.model.OrganisationIdentification8
+	185, // 180: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV02.contract_dates:type_name -> iso20022.model.AccountContract2
+	186, // 181: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV02.underlying_master_agreement:type_name -> iso20022.model.ContractDocument1
+	189, // 182: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV02.account:type_name -> iso20022.model.CustomerAccountModification1
+	134, // 183: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV02.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	190, // 184: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV02.organisation:type_name -> iso20022.model.OrganisationModification1
+	191, // 185: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV02.additional_message_information:type_name -> iso20022.model.AdditionalInformation5
+	135, // 186: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV02.digital_signature:type_name -> iso20022.model.PartyAndSignature2
+	136, // 187: iso20022.acmt.AccountExcludedMandateMaintenanceRequestV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	141, // 188: iso20022.acmt.AccountManagementStatusReportV02.message_identification:type_name -> iso20022.model.MessageIdentification1
+	143, // 189: iso20022.acmt.AccountManagementStatusReportV02.related_reference:type_name -> iso20022.model.AdditionalReference3
+	192, // 190: iso20022.acmt.AccountManagementStatusReportV02.status_report:type_name -> iso20022.model.AccountManagementStatusAndReason1
+	141, // 191: iso20022.acmt.AccountManagementStatusReportV03.message_identification:type_name -> iso20022.model.MessageIdentification1
+	143, // 192: iso20022.acmt.AccountManagementStatusReportV03.related_reference:type_name -> iso20022.model.AdditionalReference3
+	193, // 193: iso20022.acmt.AccountManagementStatusReportV03.status_report:type_name -> iso20022.model.AccountManagementStatusAndReason2
+	141, // 194: iso20022.acmt.AccountManagementStatusReportV04.message_identification:type_name -> iso20022.model.MessageIdentification1
+	143, // 195: iso20022.acmt.AccountManagementStatusReportV04.related_reference:type_name -> iso20022.model.AdditionalReference3
+	194, // 196: iso20022.acmt.AccountManagementStatusReportV04.status_report:type_name -> iso20022.model.AccountManagementStatusAndReason3
+	163, // 197: iso20022.acmt.AccountManagementStatusReportV04.market_practice_version:type_name -> iso20022.model.MarketPracticeVersion1
+	153, // 198: iso20022.acmt.AccountManagementStatusReportV04.extension:type_name -> iso20022.model.Extension1
+	141, // 199: iso20022.acmt.AccountManagementStatusReportV05.message_identification:type_name -> iso20022.model.MessageIdentification1
+	170, // 200: iso20022.acmt.AccountManagementStatusReportV05.related_reference:type_name -> iso20022.model.AdditionalReference6
+	195, // 201: iso20022.acmt.AccountManagementStatusReportV05.status_report:type_name -> iso20022.model.AccountManagementStatusAndReason4
+	163, // 202: iso20022.acmt.AccountManagementStatusReportV05.market_practice_version:type_name -> iso20022.model.MarketPracticeVersion1
+	153, // 203: iso20022.acmt.AccountManagementStatusReportV05.extension:type_name -> iso20022.model.Extension1
+	141, // 204: iso20022.acmt.AccountManagementStatusReportV06.message_identification:type_name -> iso20022.model.MessageIdentification1
+	170, // 205: iso20022.acmt.AccountManagementStatusReportV06.related_reference:type_name -> iso20022.model.AdditionalReference6
+	196, // 206: iso20022.acmt.AccountManagementStatusReportV06.status_report:type_name -> iso20022.model.AccountManagementStatusAndReason5
+	163, // 207: iso20022.acmt.AccountManagementStatusReportV06.market_practice_version:type_name -> iso20022.model.MarketPracticeVersion1
+	153, // 208: iso20022.acmt.AccountManagementStatusReportV06.extension:type_name -> iso20022.model.Extension1
+	137, // 209: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV01.references:type_name -> iso20022.model.References4
+	185, // 210: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV01.contract_dates:type_name -> iso20022.model.AccountContract2
+	186, // 211: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV01.underlying_master_agreement:type_name -> iso20022.model.ContractDocument1
+	131, // 212: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV01.account_identification:type_name -> iso20022.model.AccountForAction1
+	130, // 213: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV01.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	129, // 214: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV01.organisation_identification:type_name -> iso20022.model.OrganisationIdentification6
+	197, // 215: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV01.mandate:type_name -> iso20022.model.OperationMandate1
+	132, // 216: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV01.digital_signature:type_name -> iso20022.model.PartyAndSignature1
+	137, // 217: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV02.references:type_name -> iso20022.model.References4
+	133, // 218: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV02.from:type_name -> iso20022.model.OrganisationIdentification8
+	185, // 219: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV02.contract_dates:type_name -> iso20022.model.AccountContract2
+	186, // 220: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV02.underlying_master_agreement:type_name -> iso20022.model.ContractDocument1
+	131, // 221: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV02.account_identification:type_name -> iso20022.model.AccountForAction1
+	134, // 222: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV02.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	133, // 223: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV02.organisation_identification:type_name -> iso20022.model.OrganisationIdentification8
+	198, // 224: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV02.mandate:type_name -> iso20022.model.OperationMandate3
+	199, // 225: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV02.group:type_name -> iso20022.model.Group2
+	135, // 226: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV02.digital_signature:type_name -> iso20022.model.PartyAndSignature2
+	136, // 227: iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	137, // 228: iso20022.acmt.AccountMandateMaintenanceRequestV01.references:type_name -> iso20022.model.References4
+	185, // 229: iso20022.acmt.AccountMandateMaintenanceRequestV01.contract_dates:type_name -> iso20022.model.AccountContract2
+	186, // 230: iso20022.acmt.AccountMandateMaintenanceRequestV01.underlying_master_agreement:type_name -> iso20022.model.ContractDocument1
+	131, // 231: iso20022.acmt.AccountMandateMaintenanceRequestV01.account_identification:type_name -> iso20022.model.AccountForAction1
+	130, // 232: iso20022.acmt.AccountMandateMaintenanceRequestV01.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	129, // 233: iso20022.acmt.AccountMandateMaintenanceRequestV01.organisation_identification:type_name -> iso20022.model.OrganisationIdentification6
+	197, // 234: iso20022.acmt.AccountMandateMaintenanceRequestV01.mandate:type_name -> iso20022.model.OperationMandate1
+	191, // 235: iso20022.acmt.AccountMandateMaintenanceRequestV01.additional_message_information:type_name -> iso20022.model.AdditionalInformation5
+	132, // 236: iso20022.acmt.AccountMandateMaintenanceRequestV01.digital_signature:type_name -> iso20022.model.PartyAndSignature1
+	137, // 237: iso20022.acmt.AccountMandateMaintenanceRequestV02.references:type_name -> iso20022.model.References4
+	133, // 238: iso20022.acmt.AccountMandateMaintenanceRequestV02.from:type_name -> iso20022.model.OrganisationIdentification8
+	185, // 239: iso20022.acmt.AccountMandateMaintenanceRequestV02.contract_dates:type_name -> iso20022.model.AccountContract2
+	186, // 240: iso20022.acmt.AccountMandateMaintenanceRequestV02.underlying_master_agreement:type_name -> iso20022.model.ContractDocument1
+	131, // 241: iso20022.acmt.AccountMandateMaintenanceRequestV02.account_identification:type_name -> iso20022.model.AccountForAction1
+	134, // 242: iso20022.acmt.AccountMandateMaintenanceRequestV02.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	140, // 243: iso20022.acmt.AccountMandateMaintenanceRequestV02.organisation_identification:type_name -> iso20022.model.Organisation14
+	198, // 244: iso20022.acmt.AccountMandateMaintenanceRequestV02.mandate:type_name -> iso20022.model.OperationMandate3
+	199, // 245: iso20022.acmt.AccountMandateMaintenanceRequestV02.group:type_name -> iso20022.model.Group2
+	191, // 246: iso20022.acmt.AccountMandateMaintenanceRequestV02.additional_message_information:type_name -> iso20022.model.AdditionalInformation5
+	135, // 247: iso20022.acmt.AccountMandateMaintenanceRequestV02.digital_signature:type_name -> iso20022.model.PartyAndSignature2
+	136, // 248: iso20022.acmt.AccountMandateMaintenanceRequestV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	141, // 249: iso20022.acmt.AccountModificationInstructionV02.message_identification:type_name -> iso20022.model.MessageIdentification1
+	143, // 250: iso20022.acmt.AccountModificationInstructionV02.previous_reference:type_name -> iso20022.model.AdditionalReference3
+	200, // 251: iso20022.acmt.AccountModificationInstructionV02.instruction_details:type_name -> iso20022.model.InvestmentAccountModificationDetails
+	201, // 252: iso20022.acmt.AccountModificationInstructionV02.investment_account_selection:type_name -> iso20022.model.InvestmentAccountSelection2
+	202, // 253: iso20022.acmt.AccountModificationInstructionV02.modified_investment_account:type_name -> iso20022.model.InvestmentAccount28
+	203, // 254: iso20022.acmt.AccountModificationInstructionV02.modified_account_parties:type_name -> iso20022.model.AccountParties4
+	204, // 255: iso20022.acmt.AccountModificationInstructionV02.modified_intermediaries:type_name -> iso20022.model.ModificationScope7
+	148, // 256: iso20022.acmt.AccountModificationInstructionV02.modified_placement:type_name -> iso20022.model.ReferredAgent1
+	205, // 257: iso20022.acmt.AccountModificationInstructionV02.modified_issue_allocation:type_name -> iso20022.model.ModificationScope9
+	206, // 258: iso20022.acmt.AccountModificationInstructionV02.modified_savings_investment_plan:type_name -> iso20022.model.ModificationScope8
+	206, // 259: iso20022.acmt.AccountModificationInstructionV02.modified_withdrawal_investment_plan:type_name -> iso20022.model.ModificationScope8
+	207, // 260: iso20022.acmt.AccountModificationInstructionV02.modified_cash_settlement:type_name -> iso20022.model.InvestmentFundCashSettlementInformation4
+	208, // 261: iso20022.acmt.AccountModificationInstructionV02.modified_service_level_agreement:type_name -> iso20022.model.ModificationScope10
+	153, // 262: iso20022.acmt.AccountModificationInstructionV02.extension:type_name -> iso20022.model.Extension1
+	141, // 263: iso20022.acmt.AccountModificationInstructionV03.message_identification:type_name -> iso20022.model.MessageIdentification1
+	143, // 264: iso20022.acmt.AccountModificationInstructionV03.previous_reference:type_name -> iso20022.model.AdditionalReference3
+	200, // 265: iso20022.acmt.AccountModificationInstructionV03.instruction_details:type_name -> iso20022.model.InvestmentAccountModificationDetails
+	201, // 266: iso20022.acmt.AccountModificationInstructionV03.investment_account_selection:type_name -> iso20022.model.InvestmentAccountSelection2
+	209, // 267: iso20022.acmt.AccountModificationInstructionV03.modified_investment_account:type_name -> iso20022.model.InvestmentAccount36
+	210, // 268: iso20022.acmt.AccountModificationInstructionV03.modified_account_parties:type_name -> iso20022.model.AccountParties7
+	204, // 269: iso20022.acmt.AccountModificationInstructionV03.modified_intermediaries:type_name -> iso20022.model.ModificationScope7
+	148, // 270: iso20022.acmt.AccountModificationInstructionV03.modified_placement:type_name -> iso20022.model.ReferredAgent1
+	205, // 271: iso20022.acmt.AccountModificationInstructionV03.modified_issue_allocation:type_name -> iso20022.model.ModificationScope9
+	211, // 272: iso20022.acmt.AccountModificationInstructionV03.modified_savings_investment_plan:type_name -> iso20022.model.ModificationScope16
+	211, // 273: iso20022.acmt.AccountModificationInstructionV03.modified_withdrawal_investment_plan:type_name -> iso20022.model.ModificationScope16
+	212, // 274: iso20022.acmt.AccountModificationInstructionV03.modified_cash_settlement:type_name -> iso20022.model.InvestmentFundCashSettlementInformation6
+	208, // 275: iso20022.acmt.AccountModificationInstructionV03.modified_service_level_agreement:type_name -> iso20022.model.ModificationScope10
+	153, // 276: iso20022.acmt.AccountModificationInstructionV03.extension:type_name -> iso20022.model.Extension1
+	141, // 277: iso20022.acmt.AccountModificationInstructionV04.message_identification:type_name -> iso20022.model.MessageIdentification1
+	143, // 278: iso20022.acmt.AccountModificationInstructionV04.previous_reference:type_name -> iso20022.model.AdditionalReference3
+	200, // 279: iso20022.acmt.AccountModificationInstructionV04.instruction_details:type_name -> iso20022.model.InvestmentAccountModificationDetails
+	201, // 280: iso20022.acmt.AccountModificationInstructionV04.investment_account_selection:type_name -> iso20022.model.InvestmentAccountSelection2
+	213, // 281: iso20022.acmt.AccountModificationInstructionV04.modified_investment_account:type_name -> iso20022.model.InvestmentAccount39
+	214, // 282: iso20022.acmt.AccountModificationInstructionV04.modified_account_parties:type_name -> iso20022.model.AccountParties8
+	215, // 283: iso20022.acmt.AccountModificationInstructionV04.modified_intermediaries:type_name -> iso20022.model.ModificationScope20
+	148, // 284: iso20022.acmt.AccountModificationInstructionV04.modified_placement:type_name -> iso20022.model.ReferredAgent1
+	205, // 285: iso20022.acmt.AccountModificationInstructionV04.modified_issue_allocation:type_name -> iso20022.model.ModificationScope9
+	216, // 286: iso20022.acmt.AccountModificationInstructionV04.modified_savings_investment_plan:type_name -> iso20022.model.ModificationScope18
+	216, // 287: iso20022.acmt.AccountModificationInstructionV04.modified_withdrawal_investment_plan:type_name -> iso20022.model.ModificationScope18
+	217, // 288: iso20022.acmt.AccountModificationInstructionV04.modified_cash_settlement:type_name -> iso20022.model.InvestmentFundCashSettlementInformation8
+	208, // 289: iso20022.acmt.AccountModificationInstructionV04.modified_service_level_agreement:type_name -> iso20022.model.ModificationScope10
+	163, // 290: iso20022.acmt.AccountModificationInstructionV04.market_practice_version:type_name -> iso20022.model.MarketPracticeVersion1
+	153, // 291: iso20022.acmt.AccountModificationInstructionV04.extension:type_name -> iso20022.model.Extension1
+	141, // 292: iso20022.acmt.AccountModificationInstructionV05.message_identification:type_name -> iso20022.model.MessageIdentification1
+	143, // 293: iso20022.acmt.AccountModificationInstructionV05.previous_reference:type_name -> iso20022.model.AdditionalReference3
+	218, // 294: iso20022.acmt.AccountModificationInstructionV05.instruction_details:type_name -> iso20022.model.InvestmentAccountModification1
+	201, // 295: iso20022.acmt.AccountModificationInstructionV05.investment_account_selection:type_name -> iso20022.model.InvestmentAccountSelection2
+	219, // 296: iso20022.acmt.AccountModificationInstructionV05.modified_investment_account:type_name -> iso20022.model.InvestmentAccount47
+	220, // 297: iso20022.acmt.AccountModificationInstructionV05.modified_account_parties:type_name -> iso20022.model.AccountParties11
+	215, // 298: iso20022.acmt.AccountModificationInstructionV05.modified_intermediaries:type_name -> iso20022.model.ModificationScope20
+	148, // 299: iso20022.acmt.AccountModificationInstructionV05.modified_placement:type_name -> iso20022.model.ReferredAgent1
+	221, // 300: iso20022.acmt.AccountModificationInstructionV05.modified_issue_allocation:type_name -> iso20022.model.ModificationScope21
+	222, // 301: iso20022.acmt.AccountModificationInstructionV05.modified_savings_investment_plan:type_name -> iso20022.model.ModificationScope25
+	222, // 302: iso20022.acmt.AccountModificationInstructionV05.modified_withdrawal_investment_plan:type_name -> iso20022.model.ModificationScope25
+	217, // 303: iso20022.acmt.AccountModificationInstructionV05.modified_cash_settlement:type_name -> iso20022.model.InvestmentFundCashSettlementInformation8
+	223, // 304: iso20022.acmt.AccountModificationInstructionV05.modified_service_level_agreement:type_name -> iso20022.model.ModificationScope22
+	163, // 305: iso20022.acmt.AccountModificationInstructionV05.market_practice_version:type_name -> iso20022.model.MarketPracticeVersion1
+	153, // 306: iso20022.acmt.AccountModificationInstructionV05.extension:type_name -> iso20022.model.Extension1
+	141, // 307: iso20022.acmt.AccountModificationInstructionV06.message_identification:type_name -> iso20022.model.MessageIdentification1
+	170, // 308: iso20022.acmt.AccountModificationInstructionV06.previous_reference:type_name -> iso20022.model.AdditionalReference6
+	224, // 309: iso20022.acmt.AccountModificationInstructionV06.instruction_details:type_name -> iso20022.model.InvestmentAccountModification2
+	225, // 310: iso20022.acmt.AccountModificationInstructionV06.investment_account_selection:type_name -> iso20022.model.AccountSelection1Choice
+	226, // 311: iso20022.acmt.AccountModificationInstructionV06.modified_investment_account:type_name -> iso20022.model.InvestmentAccount51
+	227, // 312: iso20022.acmt.AccountModificationInstructionV06.modified_account_parties:type_name -> iso20022.model.AccountParties14
+	228, // 313: iso20022.acmt.AccountModificationInstructionV06.modified_intermediaries:type_name -> iso20022.model.ModificationScope26
+	229, // 314: iso20022.acmt.AccountModificationInstructionV06.modified_placement:type_name -> iso20022.model.ModificationScope33
+	221, // 315: iso20022.acmt.AccountModificationInstructionV06.modified_issue_allocation:type_name -> iso20022.model.ModificationScope21
+	230, // 316: iso20022.acmt.AccountModificationInstructionV06.modified_savings_investment_plan:type_name -> iso20022.model.ModificationScope28
+	230, // 317: iso20022.acmt.AccountModificationInstructionV06.modified_withdrawal_investment_plan:type_name -> iso20022.model.ModificationScope28
+	231, // 318: iso20022.acmt.AccountModificationInstructionV06.modified_cash_settlement:type_name -> iso20022.model.CashSettlement2
+	232, // 319: iso20022.acmt.AccountModificationInstructionV06.modified_service_level_agreement:type_name -> iso20022.model.ModificationScope31
+	233, // 320: iso20022.acmt.AccountModificationInstructionV06.modified_additional_information:type_name -> iso20022.model.ModificationScope30
+	163, // 321: iso20022.acmt.AccountModificationInstructionV06.market_practice_version:type_name -> iso20022.model.MarketPracticeVersion1
+	153, // 322: iso20022.acmt.AccountModificationInstructionV06.extension:type_name -> iso20022.model.Extension1
+	141, // 323: iso20022.acmt.AccountModificationInstructionV07.message_identification:type_name -> iso20022.model.MessageIdentification1
+	170, // 324: iso20022.acmt.AccountModificationInstructionV07.previous_reference:type_name -> iso20022.model.AdditionalReference6
+	234, // 325: iso20022.acmt.AccountModificationInstructionV07.instruction_details:type_name -> iso20022.model.InvestmentAccountModification3
+	235, // 326: iso20022.acmt.AccountModificationInstructionV07.investment_account_selection:type_name -> iso20022.model.AccountSelection2Choice
+	236, // 327: iso20022.acmt.AccountModificationInstructionV07.modified_investment_account:type_name -> iso20022.model.InvestmentAccount63
+	237, // 328: iso20022.acmt.AccountModificationInstructionV07.modified_account_parties:type_name -> iso20022.model.AccountParties16
+	238, // 329: iso20022.acmt.AccountModificationInstructionV07.modified_intermediaries:type_name -> iso20022.model.ModificationScope38
+	229, // 330: iso20022.acmt.AccountModificationInstructionV07.modified_placement:type_name -> iso20022.model.ModificationScope33
+	221, // 331: iso20022.acmt.AccountModificationInstructionV07.modified_issue_allocation:type_name -> iso20022.model.ModificationScope21
+	239, // 332: iso20022.acmt.AccountModificationInstructionV07.modified_savings_investment_plan:type_name -> iso20022.model.ModificationScope37
+	239, // 333: iso20022.acmt.AccountModificationInstructionV07.modified_withdrawal_investment_plan:type_name -> iso20022.model.ModificationScope37
+	231, // 334: iso20022.acmt.AccountModificationInstructionV07.modified_cash_settlement:type_name -> iso20022.model.CashSettlement2
+	232, // 335: iso20022.acmt.AccountModificationInstructionV07.modified_service_level_agreement:type_name -> iso20022.model.ModificationScope31
+	240, // 336: iso20022.acmt.AccountModificationInstructionV07.modified_additional_information:type_name -> iso20022.model.ModificationScope35
+	163, // 337: iso20022.acmt.AccountModificationInstructionV07.market_practice_version:type_name -> iso20022.model.MarketPracticeVersion1
+	153, // 338: iso20022.acmt.AccountModificationInstructionV07.extension:type_name -> iso20022.model.Extension1
+	128, // 339: iso20022.acmt.AccountOpeningAdditionalInformationRequestV01.references:type_name -> iso20022.model.References3
+	129, // 340: iso20022.acmt.AccountOpeningAdditionalInformationRequestV01.organisation_identification:type_name -> iso20022.model.OrganisationIdentification6
+	187, // 341: iso20022.acmt.AccountOpeningAdditionalInformationRequestV01.account:type_name -> iso20022.model.CustomerAccount1
+	130, // 342: iso20022.acmt.AccountOpeningAdditionalInformationRequestV01.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	186, // 343: iso20022.acmt.AccountOpeningAdditionalInformationRequestV01.underlying_master_agreement:type_name -> iso20022.model.ContractDocument1
+	132, // 344: iso20022.acmt.AccountOpeningAdditionalInformationRequestV01.digital_signature:type_name -> iso20022.model.PartyAndSignature1
+	128, // 345: iso20022.acmt.AccountOpeningAdditionalInformationRequestV02.references:type_name -> iso20022.model.References3
+	133, // 346: iso20022.acmt.AccountOpeningAdditionalInformationRequestV02.from:type_name -> iso20022.model.OrganisationIdentification8
+	133, // 347: iso20022.acmt.AccountOpeningAdditionalInformationRequestV02.organisation_identification:type_name -> iso20022.model.OrganisationIdentification8
+	241, // 348: iso20022.acmt.AccountOpeningAdditionalInformationRequestV02.account:type_name -> iso20022.model.CustomerAccount4
+	134, // 349: iso20022.acmt.AccountOpeningAdditionalInformationRequestV02.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	186, // 350: iso20022.acmt.AccountOpeningAdditionalInformationRequestV02.underlying_master_agreement:type_name -> iso20022.model.ContractDocument1
+	135, // 351: iso20022.acmt.AccountOpeningAdditionalInformationRequestV02.digital_signature:type_name -> iso20022.model.PartyAndSignature2
+	136, // 352: iso20022.acmt.AccountOpeningAdditionalInformationRequestV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	137, // 353: iso20022.acmt.AccountOpeningAmendmentRequestV01.references:type_name -> iso20022.model.References4
+	185, // 354: iso20022.acmt.AccountOpeningAmendmentRequestV01.contract_dates:type_name -> iso20022.model.AccountContract2
+	186, // 355: iso20022.acmt.AccountOpeningAmendmentRequestV01.underlying_master_agreement:type_name -> iso20022.model.ContractDocument1
+	187, // 356: iso20022.acmt.AccountOpeningAmendmentRequestV01.account:type_name -> iso20022.model.CustomerAccount1
+	130, // 357: iso20022.acmt.AccountOpeningAmendmentRequestV01.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	188, // 358: iso20022.acmt.AccountOpeningAmendmentRequestV01.organisation:type_name -> iso20022.model.Organisation6
+	197, // 359: iso20022.acmt.AccountOpeningAmendmentRequestV01.mandate:type_name -> iso20022.model.OperationMandate1
+	242, // 360: iso20022.acmt.AccountOpeningAmendmentRequestV01.reference_account:type_name -> iso20022.model.CashAccount16
+	132, // 361: iso20022.acmt.AccountOpeningAmendmentRequestV01.digital_signature:type_name -> iso20022.model.PartyAndSignature1
+	137, // 362: iso20022.acmt.AccountOpeningAmendmentRequestV02.references:type_name -> iso20022.model.References4
+	133, // 363: iso20022.acmt.AccountOpeningAmendmentRequestV02.from:type_name -> iso20022.model.OrganisationIdentification8
+	185, // 364: iso20022.acmt.AccountOpeningAmendmentRequestV02.contract_dates:type_name -> iso20022.model.AccountContract2
+	186, // 365: iso20022.acmt.AccountOpeningAmendmentRequestV02.underlying_master_agreement:type_name -> iso20022.model.ContractDocument1
+	241, // 366: iso20022.acmt.AccountOpeningAmendmentRequestV02.account:type_name -> iso20022.model.CustomerAccount4
+	134, // 367: iso20022.acmt.AccountOpeningAmendmentRequestV02.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	243, // 368: iso20022.acmt.AccountOpeningAmendmentRequestV02.organisation:type_name -> iso20022.model.Organisation12
+	244, // 369: iso20022.acmt.AccountOpeningAmendmentRequestV02.mandate:type_name -> iso20022.model.OperationMandate2
+	245, // 370: iso20022.acmt.AccountOpeningAmendmentRequestV02.group:type_name -> iso20022.model.Group1
+	246, // 371: iso20022.acmt.AccountOpeningAmendmentRequestV02.reference_account:type_name -> iso20022.model.CashAccount24
+	135, // 372: iso20022.acmt.AccountOpeningAmendmentRequestV02.digital_signature:type_name -> iso20022.model.PartyAndSignature2
+	136, // 373: iso20022.acmt.AccountOpeningAmendmentRequestV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	141, // 374: iso20022.acmt.AccountOpeningInstructionV02.message_identification:type_name -> iso20022.model.MessageIdentification1
+	142, // 375: iso20022.acmt.AccountOpeningInstructionV02.order_reference:type_name -> iso20022.model.InvestmentFundOrder4
+	143, // 376: iso20022.acmt.AccountOpeningInstructionV02.previous_reference:type_name -> iso20022.model.AdditionalReference3
+	247, // 377: iso20022.acmt.AccountOpeningInstructionV02.instruction_details:type_name -> iso20022.model.InvestmentAccountOpeningDetails
+	248, // 378: iso20022.acmt.AccountOpeningInstructionV02.investment_account:type_name -> iso20022.model.InvestmentAccount26
+	146, // 379: iso20022.acmt.AccountOpeningInstructionV02.account_parties:type_name -> iso20022.model.AccountParties5
+	147, // 380: iso20022.acmt.AccountOpeningInstructionV02.intermediaries:type_name -> iso20022.model.Intermediary12
+	148, // 381: iso20022.acmt.AccountOpeningInstructionV02.placement:type_name -> iso20022.model.ReferredAgent1
+	149, // 382: iso20022.acmt.AccountOpeningInstructionV02.new_issue_allocation:type_name -> iso20022.model.NewIssueAllocation1
+	150, // 383: iso20022.acmt.AccountOpeningInstructionV02.savings_investment_plan:type_name -> iso20022.model.InvestmentPlan4
+	150, // 384: iso20022.acmt.AccountOpeningInstructionV02.withdrawal_investment_plan:type_name -> iso20022.model.InvestmentPlan4
+	151, // 385: iso20022.acmt.AccountOpeningInstructionV02.cash_settlement:type_name -> iso20022.model.InvestmentFundCashSettlementInformation3
+	152, // 386: iso20022.acmt.AccountOpeningInstructionV02.service_level_agreement:type_name -> iso20022.model.DocumentToSend1
+	153, // 387: iso20022.acmt.AccountOpeningInstructionV02.extension:type_name -> iso20022.model.Extension1
+	141, // 388: iso20022.acmt.AccountOpeningInstructionV03.message_identification:type_name -> iso20022.model.MessageIdentification1
+	142, // 389: iso20022.acmt.AccountOpeningInstructionV03.order_reference:type_name -> iso20022.model.InvestmentFundOrder4
+	143, // 390: iso20022.acmt.AccountOpeningInstructionV03.previous_reference:type_name -> iso20022.model.AdditionalReference3
+	247, // 391: iso20022.acmt.AccountOpeningInstructionV03.instruction_details:type_name -> iso20022.model.InvestmentAccountOpeningDetails
+	249, // 392: iso20022.acmt.AccountOpeningInstructionV03.investment_account:type_name -> iso20022.model.InvestmentAccount34
+	155, // 393: iso20022.acmt.AccountOpeningInstructionV03.account_parties:type_name -> iso20022.model.AccountParties6
+	147, // 394: iso20022.acmt.AccountOpeningInstructionV03.intermediaries:type_name -> iso20022.model.Intermediary12
+	148, // 395: iso20022.acmt.AccountOpeningInstructionV03.placement:type_name -> iso20022.model.ReferredAgent1
+	149, // 396: iso20022.acmt.AccountOpeningInstructionV03.new_issue_allocation:type_name -> iso20022.model.NewIssueAllocation1
+	156, // 397: iso20022.acmt.AccountOpeningInstructionV03.savings_investment_plan:type_name -> iso20022.model.InvestmentPlan6
+	156, // 398: iso20022.acmt.AccountOpeningInstructionV03.withdrawal_investment_plan:type_name -> iso20022.model.InvestmentPlan6
+	157, // 399: iso20022.acmt.AccountOpeningInstructionV03.cash_settlement:type_name -> iso20022.model.InvestmentFundCashSettlementInformation5
+	152, // 400: iso20022.acmt.AccountOpeningInstructionV03.service_level_agreement:type_name -> iso20022.model.DocumentToSend1
+	153, // 401: iso20022.acmt.AccountOpeningInstructionV03.extension:type_name -> iso20022.model.Extension1
+	141, // 402: iso20022.acmt.AccountOpeningInstructionV04.message_identification:type_name -> iso20022.model.MessageIdentification1
+	142, // 403: iso20022.acmt.AccountOpeningInstructionV04.order_reference:type_name -> iso20022.model.InvestmentFundOrder4
+	143, // 404: iso20022.acmt.AccountOpeningInstructionV04.previous_reference:type_name -> iso20022.model.AdditionalReference3
+	247, // 405: iso20022.acmt.AccountOpeningInstructionV04.instruction_details:type_name -> iso20022.model.InvestmentAccountOpeningDetails
+	250, // 406: iso20022.acmt.AccountOpeningInstructionV04.investment_account:type_name -> iso20022.model.InvestmentAccount37
+	159, // 407: iso20022.acmt.AccountOpeningInstructionV04.account_parties:type_name -> iso20022.model.AccountParties9
+	160, // 408: iso20022.acmt.AccountOpeningInstructionV04.intermediaries:type_name -> iso20022.model.Intermediary24
+	148, // 409: iso20022.acmt.AccountOpeningInstructionV04.placement:type_name -> iso20022.model.ReferredAgent1
+	149, // 410: iso20022.acmt.AccountOpeningInstructionV04.new_issue_allocation:type_name -> iso20022.model.NewIssueAllocation1
+	161, // 411: iso20022.acmt.AccountOpeningInstructionV04.savings_investment_plan:type_name -> iso20022.model.InvestmentPlan9
+	161, // 412: iso20022.acmt.AccountOpeningInstructionV04.withdrawal_investment_plan:type_name -> iso20022.model.InvestmentPlan9
+	162, // 413: iso20022.acmt.AccountOpeningInstructionV04.cash_settlement:type_name -> iso20022.model.InvestmentFundCashSettlementInformation7
+	152, // 414: iso20022.acmt.AccountOpeningInstructionV04.service_level_agreement:type_name -> iso20022.model.DocumentToSend1
+	163, // 415: iso20022.acmt.AccountOpeningInstructionV04.market_practice_version:type_name -> iso20022.model.MarketPracticeVersion1
+	153, // 416: iso20022.acmt.AccountOpeningInstructionV04.extension:type_name -> iso20022.model.Extension1
+	141, // 417: iso20022.acmt.AccountOpeningInstructionV05.message_identification:type_name -> iso20022.model.MessageIdentification1
+	142, // 418: iso20022.acmt.AccountOpeningInstructionV05.order_reference:type_name -> iso20022.model.InvestmentFundOrder4
+	143, // 419: iso20022.acmt.AccountOpeningInstructionV05.previous_reference:type_name -> iso20022.model.AdditionalReference3
+	251, // 420: iso20022.acmt.AccountOpeningInstructionV05.instruction_details:type_name -> iso20022.model.InvestmentAccountOpening1
+	250, // 421: iso20022.acmt.AccountOpeningInstructionV05.investment_account:type_name -> iso20022.model.InvestmentAccount37
+	252, // 422: iso20022.acmt.AccountOpeningInstructionV05.account_parties:type_name -> iso20022.model.AccountParties10
+	160, // 423: iso20022.acmt.AccountOpeningInstructionV05.intermediaries:type_name -> iso20022.model.Intermediary24
+	148, // 424: iso20022.acmt.AccountOpeningInstructionV05.placement:type_name -> iso20022.model.ReferredAgent1
+	167, // 425: iso20022.acmt.AccountOpeningInstructionV05.new_issue_allocation:type_name -> iso20022.model.NewIssueAllocation2
+	168, // 426: iso20022.acmt.AccountOpeningInstructionV05.savings_investment_plan:type_name -> iso20022.model.InvestmentPlan10
+	168, // 427: iso20022.acmt.AccountOpeningInstructionV05.withdrawal_investment_plan:type_name -> iso20022.model.InvestmentPlan10
+	162, // 428: iso20022.acmt.AccountOpeningInstructionV05.cash_settlement:type_name -> iso20022.model.InvestmentFundCashSettlementInformation7
+	169, // 429: iso20022.acmt.AccountOpeningInstructionV05.service_level_agreement:type_name -> iso20022.model.DocumentToSend2
+	163, // 430: iso20022.acmt.AccountOpeningInstructionV05.market_practice_version:type_name -> iso20022.model.MarketPracticeVersion1
+	153, // 431: iso20022.acmt.AccountOpeningInstructionV05.extension:type_name -> iso20022.model.Extension1
+	141, // 432: iso20022.acmt.AccountOpeningInstructionV06.message_identification:type_name -> iso20022.model.MessageIdentification1
+	142, // 433: iso20022.acmt.AccountOpeningInstructionV06.order_reference:type_name -> iso20022.model.InvestmentFundOrder4
+	170, // 434: iso20022.acmt.AccountOpeningInstructionV06.previous_reference:type_name -> iso20022.model.AdditionalReference6
+	253, // 435: iso20022.acmt.AccountOpeningInstructionV06.instruction_details:type_name -> iso20022.model.InvestmentAccountOpening2
+	254, // 436: iso20022.acmt.AccountOpeningInstructionV06.investment_account:type_name -> iso20022.model.InvestmentAccount49
+	173, // 437: iso20022.acmt.AccountOpeningInstructionV06.account_parties:type_name -> iso20022.model.AccountParties13
+	174, // 438: iso20022.acmt.AccountOpeningInstructionV06.intermediaries:type_name -> iso20022.model.Intermediary36
+	175, // 439: iso20022.acmt.AccountOpeningInstructionV06.placement:type_name -> iso20022.model.ReferredAgent2
+	167, // 440: iso20022.acmt.AccountOpeningInstructionV06.new_issue_allocation:type_name -> iso20022.model.NewIssueAllocation2
+	176, // 441: iso20022.acmt.AccountOpeningInstructionV06.savings_investment_plan:type_name -> iso20022.model.InvestmentPlan12
+	176, // 442: iso20022.acmt.AccountOpeningInstructionV06.withdrawal_investment_plan:type_name -> iso20022.model.InvestmentPlan12
+	177, // 443: iso20022.acmt.AccountOpeningInstructionV06.cash_settlement:type_name -> iso20022.model.CashSettlement1
+	178, // 444: iso20022.acmt.AccountOpeningInstructionV06.service_level_agreement:type_name -> iso20022.model.DocumentToSend3
+	179, // 445: iso20022.acmt.AccountOpeningInstructionV06.additional_information:type_name -> iso20022.model.AccountRestrictions1
+	163, // 446: iso20022.acmt.AccountOpeningInstructionV06.market_practice_version:type_name -> iso20022.model.MarketPracticeVersion1
+	153, // 447: iso20022.acmt.AccountOpeningInstructionV06.extension:type_name -> iso20022.model.Extension1
+	141, // 448: iso20022.acmt.AccountOpeningInstructionV07.message_identification:type_name -> iso20022.model.MessageIdentification1
+	142, // 449: iso20022.acmt.AccountOpeningInstructionV07.order_reference:type_name -> iso20022.model.InvestmentFundOrder4
+	170, // 450: iso20022.acmt.AccountOpeningInstructionV07.previous_reference:type_name -> iso20022.model.AdditionalReference6
+	255, // 451: iso20022.acmt.AccountOpeningInstructionV07.instruction_details:type_name -> iso20022.model.InvestmentAccountOpening3
+	256, // 452: iso20022.acmt.AccountOpeningInstructionV07.investment_account:type_name -> iso20022.model.InvestmentAccount61
+	182, // 453: iso20022.acmt.AccountOpeningInstructionV07.account_parties:type_name -> iso20022.model.AccountParties15
+	174, // 454: iso20022.acmt.AccountOpeningInstructionV07.intermediaries:type_name -> iso20022.model.Intermediary36
+	175, // 455: iso20022.acmt.AccountOpeningInstructionV07.placement:type_name -> iso20022.model.ReferredAgent2
+	167, // 456: iso20022.acmt.AccountOpeningInstructionV07.new_issue_allocation:type_name -> iso20022.model.NewIssueAllocation2
+	183, // 457: iso20022.acmt.AccountOpeningInstructionV07.savings_investment_plan:type_name -> iso20022.model.InvestmentPlan14
+	183, // 458: iso20022.acmt.AccountOpeningInstructionV07.withdrawal_investment_plan:type_name -> iso20022.model.InvestmentPlan14
+	177, // 459: iso20022.acmt.AccountOpeningInstructionV07.cash_settlement:type_name -> iso20022.model.CashSettlement1
+	178, // 460: iso20022.acmt.AccountOpeningInstructionV07.service_level_agreement:type_name -> iso20022.model.DocumentToSend3
+	184, // 461: iso20022.acmt.AccountOpeningInstructionV07.additional_information:type_name -> iso20022.model.AdditiononalInformation12
+	163, // 462: iso20022.acmt.AccountOpeningInstructionV07.market_practice_version:type_name -> iso20022.model.MarketPracticeVersion1
+	153, // 463: iso20022.acmt.AccountOpeningInstructionV07.extension:type_name -> iso20022.model.Extension1
+	137, // 464: iso20022.acmt.AccountOpeningRequestV01.references:type_name -> iso20022.model.References4
+	187, // 465: iso20022.acmt.AccountOpeningRequestV01.account:type_name -> iso20022.model.CustomerAccount1
+	185, // 466: iso20022.acmt.AccountOpeningRequestV01.contract_dates:type_name -> iso20022.model.AccountContract2
+	186, // 467: iso20022.acmt.AccountOpeningRequestV01.underlying_master_agreement:type_name -> iso20022.model.ContractDocument1
+	130, // 468: iso20022.acmt.AccountOpeningRequestV01.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	257, // 469: iso20022.acmt.AccountOpeningRequestV01.organisation:type_name -> iso20022.model.Organisation7
+	197, // 470: iso20022.acmt.AccountOpeningRequestV01.mandate:type_name -> iso20022.model.OperationMandate1
+	242, // 471: iso20022.acmt.AccountOpeningRequestV01.reference_account:type_name -> iso20022.model.CashAccount16
+	132, // 472: iso20022.acmt.AccountOpeningRequestV01.digital_signature:type_name -> iso20022.model.PartyAndSignature1
+	137, // 473: iso20022.acmt.AccountOpeningRequestV02.references:type_name -> iso20022.model.References4
+	133, // 474: iso20022.acmt.AccountOpeningRequestV02.from:type_name -> iso20022.model.OrganisationIdentification8
+	241, // 475: iso20022.acmt.AccountOpeningRequestV02.account:type_name -> iso20022.model.CustomerAccount4
+	185, // 476: iso20022.acmt.AccountOpeningRequestV02.contract_dates:type_name -> iso20022.model.AccountContract2
+	186, // 477: iso20022.acmt.AccountOpeningRequestV02.underlying_master_agreement:type_name -> iso20022.model.ContractDocument1
+	134, // 478: iso20022.acmt.AccountOpeningRequestV02.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	243, // 479: iso20022.acmt.AccountOpeningRequestV02.organisation:type_name -> iso20022.model.Organisation12
+	244, // 480: iso20022.acmt.AccountOpeningRequestV02.mandate:type_name -> iso20022.model.OperationMandate2
+	245, // 481: iso20022.acmt.AccountOpeningRequestV02.group:type_name -> iso20022.model.Group1
+	246, // 482: iso20022.acmt.AccountOpeningRequestV02.reference_account:type_name -> iso20022.model.CashAccount24
+	135, // 483: iso20022.acmt.AccountOpeningRequestV02.digital_signature:type_name -> iso20022.model.PartyAndSignature2
+	136, // 484: iso20022.acmt.AccountOpeningRequestV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	137, // 485: iso20022.acmt.AccountReportRequestV01.references:type_name -> iso20022.model.References4
+	131, // 486: iso20022.acmt.AccountReportRequestV01.account_identification:type_name -> iso20022.model.AccountForAction1
+	130, // 487: iso20022.acmt.AccountReportRequestV01.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	129, // 488: iso20022.acmt.AccountReportRequestV01.organisation_identification:type_name -> iso20022.model.OrganisationIdentification6
+	185, // 489: iso20022.acmt.AccountReportRequestV01.contract_dates:type_name -> iso20022.model.AccountContract2
+	132, // 490: iso20022.acmt.AccountReportRequestV01.digital_signature:type_name -> iso20022.model.PartyAndSignature1
+	137, // 491: iso20022.acmt.AccountReportRequestV02.references:type_name -> iso20022.model.References4
+	133, // 492: iso20022.acmt.AccountReportRequestV02.from:type_name -> iso20022.model.OrganisationIdentification8
+	131, // 493: iso20022.acmt.AccountReportRequestV02.account_identification:type_name -> iso20022.model.AccountForAction1
+	134, // 494: iso20022.acmt.AccountReportRequestV02.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	133, // 495: iso20022.acmt.AccountReportRequestV02.organisation_identification:type_name -> iso20022.model.OrganisationIdentification8
+	135, // 496: iso20022.acmt.AccountReportRequestV02.digital_signature:type_name -> iso20022.model.PartyAndSignature2
+	136, // 497: iso20022.acmt.AccountReportRequestV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	258, // 498: iso20022.acmt.AccountReportV01.references:type_name -> iso20022.model.References5
+	130, // 499: iso20022.acmt.AccountReportV01.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	188, // 500: iso20022.acmt.AccountReportV01.organisation:type_name -> iso20022.model.Organisation6
+	259, // 501: iso20022.acmt.AccountReportV01.report:type_name -> iso20022.model.AccountReport1
+	132, // 502: iso20022.acmt.AccountReportV01.digital_signature:type_name -> iso20022.model.PartyAndSignature1
+	258, // 503: iso20022.acmt.AccountReportV02.references:type_name -> iso20022.model.References5
+	133, // 504: iso20022.acmt.AccountReportV02.from:type_name -> iso20022.model.OrganisationIdentification8
+	134, // 505: iso20022.acmt.AccountReportV02.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	243, // 506: iso20022.acmt.AccountReportV02.organisation:type_name -> iso20022.model.Organisation12
+	260, // 507: iso20022.acmt.AccountReportV02.report:type_name -> iso20022.model.AccountReport15
+	135, // 508: iso20022.acmt.AccountReportV02.digital_signature:type_name -> iso20022.model.PartyAndSignature2
+	136, // 509: iso20022.acmt.AccountReportV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	258, // 510: iso20022.acmt.AccountRequestAcknowledgementV01.references:type_name -> iso20022.model.References5
+	131, // 511: iso20022.acmt.AccountRequestAcknowledgementV01.account_identification:type_name -> iso20022.model.AccountForAction1
+	129, // 512: iso20022.acmt.AccountRequestAcknowledgementV01.organisation_identification:type_name -> iso20022.model.OrganisationIdentification6
+	130, // 513: iso20022.acmt.AccountRequestAcknowledgementV01.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	132, // 514: iso20022.acmt.AccountRequestAcknowledgementV01.digital_signature:type_name -> iso20022.model.PartyAndSignature1
+	258, // 515: iso20022.acmt.AccountRequestAcknowledgementV02.references:type_name -> iso20022.model.References5
+	133, // 516: iso20022.acmt.AccountRequestAcknowledgementV02.from:type_name -> iso20022.model.OrganisationIdentification8
+	131, // 517: iso20022.acmt.AccountRequestAcknowledgementV02.account_identification:type_name -> iso20022.model.AccountForAction1
+	133, // 518: iso20022.acmt.AccountRequestAcknowledgementV02.organisation_identification:type_name -> iso20022.model.OrganisationIdentification8
+	134, // 519: iso20022.acmt.AccountRequestAcknowledgementV02.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	135, // 520: iso20022.acmt.AccountRequestAcknowledgementV02.digital_signature:type_name -> iso20022.model.PartyAndSignature2
+	136, // 521: iso20022.acmt.AccountRequestAcknowledgementV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	261, // 522: iso20022.acmt.AccountRequestRejectionV01.references:type_name -> iso20022.model.References6
+	130, // 523: iso20022.acmt.AccountRequestRejectionV01.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification4
+	131, // 524: iso20022.acmt.AccountRequestRejectionV01.account_identification:type_name -> iso20022.model.AccountForAction1
+	129, // 525: iso20022.acmt.AccountRequestRejectionV01.organisation_identification:type_name -> iso20022.model.OrganisationIdentification6
+	132, // 526: iso20022.acmt.AccountRequestRejectionV01.digital_signature:type_name -> iso20022.model.PartyAndSignature1
+	261, // 527: iso20022.acmt.AccountRequestRejectionV02.references:type_name -> iso20022.model.References6
+	133, // 528: iso20022.acmt.AccountRequestRejectionV02.from:type_name -> iso20022.model.OrganisationIdentification8
+	134, // 529: iso20022.acmt.AccountRequestRejectionV02.account_servicer_identification:type_name -> iso20022.model.BranchAndFinancialInstitutionIdentification5
+	131, // 530: iso20022.acmt.AccountRequestRejectionV02.account_identification:type_name -> iso20022.model.AccountForAction1
+	133, // 531: iso20022.acmt.AccountRequestRejectionV02.organisation_identification:type_name -> iso20022.model.OrganisationIdentification8
+	135, // 532: iso20022.acmt.AccountRequestRejectionV02.digital_signature:type_name -> iso20022.model.PartyAndSignature2
+	136, // 533: iso20022.acmt.AccountRequestRejectionV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	37,  // 534: iso20022.acmt.Document00100102.message:type_name -> iso20022.acmt.AccountOpeningInstructionV02
+	38,  // 535: iso20022.acmt.Document00100103.message:type_name -> iso20022.acmt.AccountOpeningInstructionV03
+	39,  // 536: iso20022.acmt.Document00100104.message:type_name -> iso20022.acmt.AccountOpeningInstructionV04
+	40,  // 537: iso20022.acmt.Document00100105.message:type_name -> iso20022.acmt.AccountOpeningInstructionV05
+	41,  // 538: iso20022.acmt.Document00100106.message:type_name -> iso20022.acmt.AccountOpeningInstructionV06
+	42,  // 539: iso20022.acmt.Document00100107.message:type_name -> iso20022.acmt.AccountOpeningInstructionV07
+	8,   // 540: iso20022.acmt.Document00200102.message:type_name -> iso20022.acmt.AccountDetailsConfirmationV02
+	9,   // 541: iso20022.acmt.Document00200103.message:type_name -> iso20022.acmt.AccountDetailsConfirmationV03
+	10,  // 542: iso20022.acmt.Document00200104.message:type_name -> iso20022.acmt.AccountDetailsConfirmationV04
+	11,  // 543: iso20022.acmt.Document00200105.message:type_name -> iso20022.acmt.AccountDetailsConfirmationV05
+	12,  // 544: iso20022.acmt.Document00200106.message:type_name -> iso20022.acmt.AccountDetailsConfirmationV06
+	13,  // 545: iso20022.acmt.Document00200107.message:type_name -> iso20022.acmt.AccountDetailsConfirmationV07
+	27,  // 546: iso20022.acmt.Document00300102.message:type_name -> iso20022.acmt.AccountModificationInstructionV02
+	28,  // 547: iso20022.acmt.Document00300103.message:type_name -> iso20022.acmt.AccountModificationInstructionV03
+	29,  // 548: iso20022.acmt.Document00300104.message:type_name -> iso20022.acmt.AccountModificationInstructionV04
+	30,  // 549: iso20022.acmt.Document00300105.message:type_name -> iso20022.acmt.AccountModificationInstructionV05
+	31,  // 550: iso20022.acmt.Document00300106.message:type_name -> iso20022.acmt.AccountModificationInstructionV06
+	32,  // 551: iso20022.acmt.Document00300107.message:type_name -> iso20022.acmt.AccountModificationInstructionV07
+	117, // 552: iso20022.acmt.Document00400105.message:type_name -> iso20022.acmt.GetAccountDetailsV05
+	124, // 553: iso20022.acmt.Document00500102.message:type_name -> iso20022.acmt.RequestForAccountManagementStatusReportV02
+	125, // 554: iso20022.acmt.Document00500103.message:type_name -> iso20022.acmt.RequestForAccountManagementStatusReportV03
+	126, // 555: iso20022.acmt.Document00500104.message:type_name -> iso20022.acmt.RequestForAccountManagementStatusReportV04
+	127, // 556: iso20022.acmt.Document00500105.message:type_name -> iso20022.acmt.RequestForAccountManagementStatusReportV05
+	18,  // 557: iso20022.acmt.Document00600102.message:type_name -> iso20022.acmt.AccountManagementStatusReportV02
+	19,  // 558: iso20022.acmt.Document00600103.message:type_name -> iso20022.acmt.AccountManagementStatusReportV03
+	20,  // 559: iso20022.acmt.Document00600104.message:type_name -> iso20022.acmt.AccountManagementStatusReportV04
+	21,  // 560: iso20022.acmt.Document00600105.message:type_name -> iso20022.acmt.AccountManagementStatusReportV05
+	22,  // 561: iso20022.acmt.Document00600106.message:type_name -> iso20022.acmt.AccountManagementStatusReportV06
+	43,  // 562: iso20022.acmt.Document00700101.message:type_name -> iso20022.acmt.AccountOpeningRequestV01
+	44,  // 563: iso20022.acmt.Document00700102.message:type_name -> iso20022.acmt.AccountOpeningRequestV02
+	35,  // 564: iso20022.acmt.Document00800101.message:type_name -> iso20022.acmt.AccountOpeningAmendmentRequestV01
+	36,  // 565: iso20022.acmt.Document00800102.message:type_name -> iso20022.acmt.AccountOpeningAmendmentRequestV02
+	33,  // 566: iso20022.acmt.Document00900101.message:type_name -> iso20022.acmt.AccountOpeningAdditionalInformationRequestV01
+	34,  // 567: iso20022.acmt.Document00900102.message:type_name -> iso20022.acmt.AccountOpeningAdditionalInformationRequestV02
+	49,  // 568: iso20022.acmt.Document01000101.message:type_name -> iso20022.acmt.AccountRequestAcknowledgementV01
+	50,  // 569: iso20022.acmt.Document01000102.message:type_name -> iso20022.acmt.AccountRequestAcknowledgementV02
+	51,  // 570: iso20022.acmt.Document01100101.message:type_name -> iso20022.acmt.AccountRequestRejectionV01
+	52,  // 571: iso20022.acmt.Document01100102.message:type_name -> iso20022.acmt.AccountRequestRejectionV02
+	0,   // 572: iso20022.acmt.Document01200101.message:type_name -> iso20022.acmt.AccountAdditionalInformationRequestV01
+	1,   // 573: iso20022.acmt.Document01200102.message:type_name -> iso20022.acmt.AccountAdditionalInformationRequestV02
+	45,  // 574: iso20022.acmt.Document01300101.message:type_name -> iso20022.acmt.AccountReportRequestV01
+	46,  // 575: iso20022.acmt.Document01300102.message:type_name -> iso20022.acmt.AccountReportRequestV02
+	47,  // 576: iso20022.acmt.Document01400101.message:type_name -> iso20022.acmt.AccountReportV01
+	48,  // 577: iso20022.acmt.Document01400102.message:type_name -> iso20022.acmt.AccountReportV02
+	16,  // 578: iso20022.acmt.Document01500101.message:type_name -> iso20022.acmt.AccountExcludedMandateMaintenanceRequestV01
+	17,  // 579: iso20022.acmt.Document01500102.message:type_name -> iso20022.acmt.AccountExcludedMandateMaintenanceRequestV02
+	14,  // 580: iso20022.acmt.Document01600101.message:type_name -> iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV01
+	15,  // 581: iso20022.acmt.Document01600102.message:type_name -> iso20022.acmt.AccountExcludedMandateMaintenanceAmendmentRequestV02
+	25,  // 582: iso20022.acmt.Document01700101.message:type_name -> iso20022.acmt.AccountMandateMaintenanceRequestV01
+	26,  // 583: iso20022.acmt.Document01700102.message:type_name -> iso20022.acmt.AccountMandateMaintenanceRequestV02
+	23,  // 584: iso20022.acmt.Document01800101.message:type_name -> iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV01
+	24,  // 585: iso20022.acmt.Document01800102.message:type_name -> iso20022.acmt.AccountMandateMaintenanceAmendmentRequestV02
+	6,   // 586: iso20022.acmt.Document01900101.message:type_name -> iso20022.acmt.AccountClosingRequestV01
+	7,   // 587: iso20022.acmt.Document01900102.message:type_name -> iso20022.acmt.AccountClosingRequestV02
+	4,   // 588: iso20022.acmt.Document02000101.message:type_name -> iso20022.acmt.AccountClosingAmendmentRequestV01
+	5,   // 589: iso20022.acmt.Document02000102.message:type_name -> iso20022.acmt.AccountClosingAmendmentRequestV02
+	2,   // 590: iso20022.acmt.Document02100101.message:type_name -> iso20022.acmt.AccountClosingAdditionalInformationRequestV01
+	3,   // 591: iso20022.acmt.Document02100102.message:type_name -> iso20022.acmt.AccountClosingAdditionalInformationRequestV02
+	118, // 592: iso20022.acmt.Document02200101.message:type_name -> iso20022.acmt.IdentificationModificationAdviceV01
+	119, // 593: iso20022.acmt.Document02200102.message:type_name -> iso20022.acmt.IdentificationModificationAdviceV02
+	122, // 594: iso20022.acmt.Document02300101.message:type_name -> iso20022.acmt.IdentificationVerificationRequestV01
+	123, // 595: iso20022.acmt.Document02300102.message:type_name -> iso20022.acmt.IdentificationVerificationRequestV02
+	120, // 596: iso20022.acmt.Document02400101.message:type_name -> iso20022.acmt.IdentificationVerificationReportV01
+	121, // 597: iso20022.acmt.Document02400102.message:type_name -> iso20022.acmt.IdentificationVerificationReportV02
+	141, // 598: iso20022.acmt.GetAccountDetailsV05.message_identification:type_name -> iso20022.model.MessageIdentification1
+	235, // 599: iso20022.acmt.GetAccountDetailsV05.investment_account_selection:type_name -> iso20022.model.AccountSelection2Choice
+	262, // 600: iso20022.acmt.GetAccountDetailsV05.selected_information_type:type_name -> iso20022.model.InvestmentAccountInformationType1
+	263, // 601: iso20022.acmt.IdentificationModificationAdviceV01.assignment:type_name -> iso20022.model.IdentificationAssignment1
+	264, // 602: iso20022.acmt.IdentificationModificationAdviceV01.original_transaction_reference:type_name -> iso20022.model.OriginalTransactionReference14
+	265, // 603: iso20022.acmt.IdentificationModificationAdviceV01.modification:type_name -> iso20022.model.IdentificationModification1
+	266, // 604: iso20022.acmt.IdentificationModificationAdviceV02.assignment:type_name -> iso20022.model.IdentificationAssignment2
+	267, // 605: iso20022.acmt.IdentificationModificationAdviceV02.original_transaction_reference:type_name -> iso20022.model.OriginalTransactionReference18
+	268, // 606: iso20022.acmt.IdentificationModificationAdviceV02.modification:type_name -> iso20022.model.IdentificationModification2
+	136, // 607: iso20022.acmt.IdentificationModificationAdviceV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	263, // 608: iso20022.acmt.IdentificationVerificationReportV01.assignment:type_name -> iso20022.model.IdentificationAssignment1
+	269, // 609: iso20022.acmt.IdentificationVerificationReportV01.original_assignment:type_name -> iso20022.model.MessageIdentification4
+	270, // 610: iso20022.acmt.IdentificationVerificationReportV01.report:type_name -> iso20022.model.VerificationReport1
+	266, // 611: iso20022.acmt.IdentificationVerificationReportV02.assignment:type_name -> iso20022.model.IdentificationAssignment2
+	271, // 612: iso20022.acmt.IdentificationVerificationReportV02.original_assignment:type_name -> iso20022.model.MessageIdentification5
+	272, // 613: iso20022.acmt.IdentificationVerificationReportV02.report:type_name -> iso20022.model.VerificationReport2
+	136, // 614: iso20022.acmt.IdentificationVerificationReportV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	263, // 615: iso20022.acmt.IdentificationVerificationRequestV01.assignment:type_name -> iso20022.model.IdentificationAssignment1
+	273, // 616: iso20022.acmt.IdentificationVerificationRequestV01.verification:type_name -> iso20022.model.IdentificationVerification1
+	266, // 617: iso20022.acmt.IdentificationVerificationRequestV02.assignment:type_name -> iso20022.model.IdentificationAssignment2
+	274, // 618: iso20022.acmt.IdentificationVerificationRequestV02.verification:type_name -> iso20022.model.IdentificationVerification2
+	136, // 619: iso20022.acmt.IdentificationVerificationRequestV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	141, // 620: iso20022.acmt.RequestForAccountManagementStatusReportV02.message_identification:type_name -> iso20022.model.MessageIdentification1
+	275, // 621: iso20022.acmt.RequestForAccountManagementStatusReportV02.request_details:type_name -> iso20022.model.AccountManagementMessageReference1
+	141, // 622: iso20022.acmt.RequestForAccountManagementStatusReportV03.message_identification:type_name -> iso20022.model.MessageIdentification1
+	276, // 623: iso20022.acmt.RequestForAccountManagementStatusReportV03.request_details:type_name -> iso20022.model.AccountManagementMessageReference2
+	141, // 624: iso20022.acmt.RequestForAccountManagementStatusReportV04.message_identification:type_name -> iso20022.model.MessageIdentification1
+	277, // 625: iso20022.acmt.RequestForAccountManagementStatusReportV04.request_details:type_name -> iso20022.model.AccountManagementMessageReference3
+	141, // 626: iso20022.acmt.RequestForAccountManagementStatusReportV05.message_identification:type_name -> iso20022.model.MessageIdentification1
+	278, // 627: iso20022.acmt.RequestForAccountManagementStatusReportV05.request_details:type_name -> iso20022.model.AccountManagementMessageReference4
+	628, // [628:628] is the sub-list for method output_type
+	628, // [628:628] is the sub-list for method input_type
+	628, // [628:628] is the sub-list for extension type_name
+	628, // [628:628] is the sub-list for extension extendee
+	0,   // [0:628] is the sub-list for field type_name
+}
+
+func init() { file_iso20022_acmt_proto_init() }
+func file_iso20022_acmt_proto_init() {
+	if File_iso20022_acmt_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_iso20022_acmt_proto_rawDesc), len(file_iso20022_acmt_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   128,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_iso20022_acmt_proto_goTypes,
+		DependencyIndexes: file_iso20022_acmt_proto_depIdxs,
+		MessageInfos:      file_iso20022_acmt_proto_msgTypes,
+	}.Build()
+	File_iso20022_acmt_proto = out.File
+	file_iso20022_acmt_proto_goTypes = nil
+	file_iso20022_acmt_proto_depIdxs = nil
+}
@@ -0,0 +1,899 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: iso20022/admi.proto
+
+package admipb
+
+import (
+	modelpb "github.com/yudaprama/iso20022/proto/iso20022/modelpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Document00200101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *MessageRejectV01      `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200101) Reset() {
+	*x = Document00200101{}
+	mi := &file_iso20022_admi_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200101) ProtoMessage() {}
+
+func (x *Document00200101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_admi_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200101.ProtoReflect.Descriptor instead.
+func (*Document00200101) Descriptor() ([]byte, []int) {
+	return file_iso20022_admi_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Document00200101) GetMessage() *MessageRejectV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400102 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Message       *SystemEventNotificationV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400102) Reset() {
+	*x = Document00400102{}
+	mi := &file_iso20022_admi_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400102) ProtoMessage() {}
+
+func (x *Document00400102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_admi_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400102.ProtoReflect.Descriptor instead.
+func (*Document00400102) Descriptor() ([]byte, []int) {
+	return file_iso20022_admi_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Document00400102) GetMessage() *SystemEventNotificationV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900102 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *StaticDataRequestV02  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900102) Reset() {
+	*x = Document00900102{}
+	mi := &file_iso20022_admi_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900102) ProtoMessage() {}
+
+func (x *Document00900102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_admi_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900102.ProtoReflect.Descriptor instead.
+func (*Document00900102) Descriptor() ([]byte, []int) {
+	return file_iso20022_admi_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Document00900102) GetMessage() *StaticDataRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000102 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *StaticDataReportV02   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000102) Reset() {
+	*x = Document01000102{}
+	mi := &file_iso20022_admi_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000102) ProtoMessage() {}
+
+func (x *Document01000102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_admi_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000102.ProtoReflect.Descriptor instead.
+func (*Document01000102) Descriptor() ([]byte, []int) {
+	return file_iso20022_admi_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Document01000102) GetMessage() *StaticDataReportV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01100101 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *SystemEventAcknowledgementV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01100101) Reset() {
+	*x = Document01100101{}
+	mi := &file_iso20022_admi_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01100101) ProtoMessage() {}
+
+func (x *Document01100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_admi_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01100101.ProtoReflect.Descriptor instead.
+func (*Document01100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_admi_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Document01100101) GetMessage() *SystemEventAcknowledgementV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01700101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ProcessingRequestV01  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01700101) Reset() {
+	*x = Document01700101{}
+	mi := &file_iso20022_admi_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01700101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01700101) ProtoMessage() {}
+
+func (x *Document01700101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_admi_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01700101.ProtoReflect.Descriptor instead.
+func (*Document01700101) Descriptor() ([]byte, []int) {
+	return file_iso20022_admi_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Document01700101) GetMessage() *ProcessingRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// Scope
+// The MessageReject message is sent by a central system to notify the rejection of a previously received message.
+// Usage
+// The message provides specific information about the rejection reason.
+type MessageRejectV01 struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	RelatedReference *modelpb.MessageReference `protobuf:"bytes,1,opt,name=related_reference,json=relatedReference,proto3" json:"related_reference,omitempty"`
+	Reason           *modelpb.RejectionReason2 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MessageRejectV01) Reset() {
+	*x = MessageRejectV01{}
+	mi := &file_iso20022_admi_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageRejectV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRejectV01) ProtoMessage() {}
+
+func (x *MessageRejectV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_admi_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRejectV01.ProtoReflect.Descriptor instead.
+func (*MessageRejectV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_admi_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MessageRejectV01) GetRelatedReference() *modelpb.MessageReference {
+	if x != nil {
+		return x.RelatedReference
+	}
+	return nil
+}
+
+func (x *MessageRejectV01) GetReason() *modelpb.RejectionReason2 {
+	if x != nil {
+		return x.Reason
+	}
+	return nil
+}
+
+// The Processing Request message is sent by a participant to a central system to request the initiation of a system process suported by a central system.
+type ProcessingRequestV01 struct {
+	state                       protoimpl.MessageState    `protogen:"open.v1"`
+	MessageIdentification       *string                   `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3,oneof" json:"message_identification,omitempty"`
+	SettlementSessionIdentifier *string                   `protobuf:"bytes,2,opt,name=settlement_session_identifier,json=settlementSessionIdentifier,proto3,oneof" json:"settlement_session_identifier,omitempty"`
+	Request                     *modelpb.RequestDetails19 `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ProcessingRequestV01) Reset() {
+	*x = ProcessingRequestV01{}
+	mi := &file_iso20022_admi_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessingRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessingRequestV01) ProtoMessage() {}
+
+func (x *ProcessingRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_admi_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessingRequestV01.ProtoReflect.Descriptor instead.
+func (*ProcessingRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_admi_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProcessingRequestV01) GetMessageIdentification() string {
+	if x != nil && x.MessageIdentification != nil {
+		return *x.MessageIdentification
+	}
+	return ""
+}
+
+func (x *ProcessingRequestV01) GetSettlementSessionIdentifier() string {
+	if x != nil && x.SettlementSessionIdentifier != nil {
+		return *x.SettlementSessionIdentifier
+	}
+	return ""
+}
+
+func (x *ProcessingRequestV01) GetRequest() *modelpb.RequestDetails19 {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// The StaticDataReport message is sent by a central system to the participant to provide static data held in the system.
+type StaticDataReportV02 struct {
+	state                       protoimpl.MessageState        `protogen:"open.v1"`
+	MessageIdentification       *string                       `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3,oneof" json:"message_identification,omitempty"`
+	SettlementSessionIdentifier *string                       `protobuf:"bytes,2,opt,name=settlement_session_identifier,json=settlementSessionIdentifier,proto3,oneof" json:"settlement_session_identifier,omitempty"`
+	ReportDetails               *modelpb.RequestDetails5      `protobuf:"bytes,3,opt,name=report_details,json=reportDetails,proto3" json:"report_details,omitempty"`
+	SupplementaryData           []*modelpb.SupplementaryData1 `protobuf:"bytes,4,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *StaticDataReportV02) Reset() {
+	*x = StaticDataReportV02{}
+	mi := &file_iso20022_admi_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaticDataReportV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaticDataReportV02) ProtoMessage() {}
+
+func (x *StaticDataReportV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_admi_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaticDataReportV02.ProtoReflect.Descriptor instead.
+func (*StaticDataReportV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_admi_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StaticDataReportV02) GetMessageIdentification() string {
+	if x != nil && x.MessageIdentification != nil {
+		return *x.MessageIdentification
+	}
+	return ""
+}
+
+func (x *StaticDataReportV02) GetSettlementSessionIdentifier() string {
+	if x != nil && x.SettlementSessionIdentifier != nil {
+		return *x.SettlementSessionIdentifier
+	}
+	return ""
+}
+
+func (x *StaticDataReportV02) GetReportDetails() *modelpb.RequestDetails5 {
+	if x != nil {
+		return x.ReportDetails
+	}
+	return nil
+}
+
+func (x *StaticDataReportV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The StaticDataRequest message is sent by a participant of a central system to the central system to request a static data report.
+type StaticDataRequestV02 struct {
+	state                       protoimpl.MessageState        `protogen:"open.v1"`
+	MessageIdentification       *string                       `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3,oneof" json:"message_identification,omitempty"`
+	SettlementSessionIdentifier *string                       `protobuf:"bytes,2,opt,name=settlement_session_identifier,json=settlementSessionIdentifier,proto3,oneof" json:"settlement_session_identifier,omitempty"`
+	DataRequestDetails          *modelpb.RequestDetails3      `protobuf:"bytes,3,opt,name=data_request_details,json=dataRequestDetails,proto3" json:"data_request_details,omitempty"`
+	SupplementaryData           []*modelpb.SupplementaryData1 `protobuf:"bytes,4,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *StaticDataRequestV02) Reset() {
+	*x = StaticDataRequestV02{}
+	mi := &file_iso20022_admi_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaticDataRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaticDataRequestV02) ProtoMessage() {}
+
+func (x *StaticDataRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_admi_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaticDataRequestV02.ProtoReflect.Descriptor instead.
+func (*StaticDataRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_admi_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StaticDataRequestV02) GetMessageIdentification() string {
+	if x != nil && x.MessageIdentification != nil {
+		return *x.MessageIdentification
+	}
+	return ""
+}
+
+func (x *StaticDataRequestV02) GetSettlementSessionIdentifier() string {
+	if x != nil && x.SettlementSessionIdentifier != nil {
+		return *x.SettlementSessionIdentifier
+	}
+	return ""
+}
+
+func (x *StaticDataRequestV02) GetDataRequestDetails() *modelpb.RequestDetails3 {
+	if x != nil {
+		return x.DataRequestDetails
+	}
+	return nil
+}
+
+func (x *StaticDataRequestV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The SystemEventAcknowledgement message is sent by a participant of a central system to the central system to acknowledge the notification of an occurrence of an event in a central system.
+type SystemEventAcknowledgementV01 struct {
+	state                       protoimpl.MessageState        `protogen:"open.v1"`
+	MessageIdentification       *string                       `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3,oneof" json:"message_identification,omitempty"`
+	OriginatorReference         *string                       `protobuf:"bytes,2,opt,name=originator_reference,json=originatorReference,proto3,oneof" json:"originator_reference,omitempty"`
+	SettlementSessionIdentifier *string                       `protobuf:"bytes,3,opt,name=settlement_session_identifier,json=settlementSessionIdentifier,proto3,oneof" json:"settlement_session_identifier,omitempty"`
+	AcknowledgementDetails      *modelpb.Event1               `protobuf:"bytes,4,opt,name=acknowledgement_details,json=acknowledgementDetails,proto3" json:"acknowledgement_details,omitempty"`
+	SupplementaryData           []*modelpb.SupplementaryData1 `protobuf:"bytes,5,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *SystemEventAcknowledgementV01) Reset() {
+	*x = SystemEventAcknowledgementV01{}
+	mi := &file_iso20022_admi_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemEventAcknowledgementV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemEventAcknowledgementV01) ProtoMessage() {}
+
+func (x *SystemEventAcknowledgementV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_admi_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemEventAcknowledgementV01.ProtoReflect.Descriptor instead.
+func (*SystemEventAcknowledgementV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_admi_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SystemEventAcknowledgementV01) GetMessageIdentification() string {
+	if x != nil && x.MessageIdentification != nil {
+		return *x.MessageIdentification
+	}
+	return ""
+}
+
+func (x *SystemEventAcknowledgementV01) GetOriginatorReference() string {
+	if x != nil && x.OriginatorReference != nil {
+		return *x.OriginatorReference
+	}
+	return ""
+}
+
+func (x *SystemEventAcknowledgementV01) GetSettlementSessionIdentifier() string {
+	if x != nil && x.SettlementSessionIdentifier != nil {
+		return *x.SettlementSessionIdentifier
+	}
+	return ""
+}
+
+func (x *SystemEventAcknowledgementV01) GetAcknowledgementDetails() *modelpb.Event1 {
+	if x != nil {
+		return x.AcknowledgementDetails
+	}
+	return nil
+}
+
+func (x *SystemEventAcknowledgementV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The SystemEventNotification message is sent by a central system to notify the occurrence of an event in a central system.
+// Usage
+// The message can be used by a central settlement system to inform its participants of an event that is going to occur in the system, for instance that the system will be down at a certain time, etc.
+type SystemEventNotificationV02 struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EventInformation *modelpb.Event2        `protobuf:"bytes,1,opt,name=event_information,json=eventInformation,proto3" json:"event_information,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemEventNotificationV02) Reset() {
+	*x = SystemEventNotificationV02{}
+	mi := &file_iso20022_admi_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemEventNotificationV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemEventNotificationV02) ProtoMessage() {}
+
+func (x *SystemEventNotificationV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_admi_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemEventNotificationV02.ProtoReflect.Descriptor instead.
+func (*SystemEventNotificationV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_admi_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SystemEventNotificationV02) GetEventInformation() *modelpb.Event2 {
+	if x != nil {
+		return x.EventInformation
+	}
+	return nil
+}
+
+var File_iso20022_admi_proto protoreflect.FileDescriptor
+
+var file_iso20022_admi_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x1a, 0x14, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4d, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x39,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x30, 0x31,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x10, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x34, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x43, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x2e, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x51, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x39, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x3d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x50, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x30, 0x31, 0x30, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x30, 0x31, 0x31, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x46, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x2e, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x51, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x31, 0x37, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x3d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x30, 0x31, 0x12, 0x4d, 0x0a, 0x11, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x32, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x94, 0x02, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x3a, 0x0a,
+	0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x1d, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x1b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x31, 0x39, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x19,
+	0x0a, 0x17, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xf2, 0x02, 0x0a, 0x13,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x56, 0x30, 0x32, 0x12, 0x3a, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x47, 0x0a, 0x1d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x1b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x35, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31,
+	0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x20,
+	0x0a, 0x1e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x22, 0xfe, 0x02, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x3a, 0x0a, 0x16, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x15, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x1d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x1b,
+	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x51,
+	0x0a, 0x14, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x33, 0x52, 0x12, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x44, 0x61, 0x74, 0x61, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x20, 0x0a, 0x1e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x22, 0xd6, 0x03, 0x0a, 0x1d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x30, 0x31, 0x12, 0x3a, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x36, 0x0a, 0x14, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x13, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x1d, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x1b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x4f, 0x0a, 0x17, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x16, 0x61, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x44, 0x61, 0x74, 0x61, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x17, 0x0a, 0x15, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x61, 0x0a, 0x1a, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x32, 0x12, 0x43, 0x0a, 0x11, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x32, 0x52, 0x10, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x35, 0x5a,
+	0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x75, 0x64, 0x61,
+	0x70, 0x72, 0x61, 0x6d, 0x61, 0x2f, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_iso20022_admi_proto_rawDescOnce sync.Once
+	file_iso20022_admi_proto_rawDescData []byte
+)
+
+func file_iso20022_admi_proto_rawDescGZIP() []byte {
+	file_iso20022_admi_proto_rawDescOnce.Do(func() {
+		file_iso20022_admi_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_iso20022_admi_proto_rawDesc), len(file_iso20022_admi_proto_rawDesc)))
+	})
+	return file_iso20022_admi_proto_rawDescData
+}
+
+var file_iso20022_admi_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_iso20022_admi_proto_goTypes = []any{
+	(*Document00200101)(nil),              // 0: iso20022.admi.Document00200101
+	(*Document00400102)(nil),              // 1: iso20022.admi.Document00400102
+	(*Document00900102)(nil),              // 2: iso20022.admi.Document00900102
+	(*Document01000102)(nil),              // 3: iso20022.admi.Document01000102
+	(*Document01100101)(nil),              // 4: iso20022.admi.Document01100101
+	(*Document01700101)(nil),              // 5: iso20022.admi.Document01700101
+	(*MessageRejectV01)(nil),              // 6: iso20022.admi.MessageRejectV01
+	(*ProcessingRequestV01)(nil),          // 7: iso20022.admi.ProcessingRequestV01
+	(*StaticDataReportV02)(nil),           // 8: iso20022.admi.StaticDataReportV02
+	(*StaticDataRequestV02)(nil),          // 9: iso20022.admi.StaticDataRequestV02
+	(*SystemEventAcknowledgementV01)(nil), // 10: iso20022.admi.SystemEventAcknowledgementV01
+	(*SystemEventNotificationV02)(nil),    // 11: iso20022.admi.SystemEventNotificationV02
+	(*modelpb.MessageReference)(nil),      // 12: iso20022.model.MessageReference
+	(*modelpb.RejectionReason2)(nil),      // 13: iso20022.model.RejectionReason2
+	(*modelpb.RequestDetails19)(nil),      // 14: iso20022.model.RequestDetails19
+	(*modelpb.RequestDetails5)(nil),       // 15: iso20022.model.RequestDetails5
+	(*modelpb.SupplementaryData1)(nil),    // 16: iso20022.model.SupplementaryData1
+	(*modelpb.RequestDetails3)(nil),       // 17: iso20022.model.RequestDetails3
+	(*modelpb.Event1)(nil),                // 18: iso20022.model.Event1
+	(*modelpb.Event2)(nil),                // 19: iso20022.model.Event2
+}
+var file_iso20022_admi_proto_depIdxs = []int32{
+	6,  // 0: iso20022.admi.Document00200101.message:type_name -> iso20022.admi.MessageRejectV01
+	11, // 1: iso20022.admi.Document00400102.message:type_name -> iso20022.admi.SystemEventNotificationV02
+	9,  // 2: iso20022.admi.Document00900102.message:type_name -> iso20022.admi.StaticDataRequestV02
+	8,  // 3: iso20022.admi.Document01000102.message:type_name -> iso20022.admi.StaticDataReportV02
+	10, // 4: iso20022.admi.Document01100101.message:type_name -> iso20022.admi.SystemEventAcknowledgementV01
+	7,  // 5: iso20022.admi.Document01700101.message:type_name -> iso20022.admi.ProcessingRequestV01
+	12, // 6: iso20022.admi.MessageRejectV01.related_reference:type_name -> iso20022.model.MessageReference
+	13, // 7: iso20022.admi.MessageRejectV01.reason:type_name -> iso20022.model.RejectionReason2
+	14, // 8: iso20022.admi.ProcessingRequestV01.request:type_name -> iso20022.model.RequestDetails19
+	15, // 9: iso20022.admi.StaticDataReportV02.report_details:type_name -> iso20022.model.RequestDetails5
+	16, // 10: iso20022.admi.StaticDataReportV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	17, // 11: iso20022.admi.StaticDataRequestV02.data_request_details:type_name -> iso20022.model.RequestDetails3
+	16, // 12: iso20022.admi.StaticDataRequestV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	18, // 13: iso20022.admi.SystemEventAcknowledgementV01.acknowledgement_details:type_name -> iso20022.model.Event1
+	16, // 14: iso20022.admi.SystemEventAcknowledgementV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	19, // 15: iso20022.admi.SystemEventNotificationV02.event_information:type_name -> iso20022.model.Event2
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_iso20022_admi_proto_init() }
+func file_iso20022_admi_proto_init() {
+	if File_iso20022_admi_proto != nil {
+		return
+	}
+	file_iso20022_admi_proto_msgTypes[7].OneofWrappers = []any{}
+	file_iso20022_admi_proto_msgTypes[8].OneofWrappers = []any{}
+	file_iso20022_admi_proto_msgTypes[9].OneofWrappers = []any{}
+	file_iso20022_admi_proto_msgTypes[10].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_iso20022_admi_proto_rawDesc), len(file_iso20022_admi_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_iso20022_admi_proto_goTypes,
+		DependencyIndexes: file_iso20022_admi_proto_depIdxs,
+		MessageInfos:      file_iso20022_admi_proto_msgTypes,
+	}.Build()
+	File_iso20022_admi_proto = out.File
+	file_iso20022_admi_proto_goTypes = nil
+	file_iso20022_admi_proto_depIdxs = nil
+}
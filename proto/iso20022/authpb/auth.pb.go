@@ -0,0 +1,3633 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: iso20022/auth.proto
+
+package authpb
+
+import (
+	modelpb "github.com/yudaprama/iso20022/proto/iso20022/modelpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+// The ContractRegistrationAmendmentRequest message is sent by the reporting party to the registration agent to amend the registered contract subject to currency control.
+type ContractRegistrationAmendmentRequestV01 struct {
+	state                         protoimpl.MessageState          `protogen:"open.v1"`
+	GroupHeader                   *modelpb.CurrencyControlHeader1 `protobuf:"bytes,1,opt,name=group_header,json=groupHeader,proto3" json:"group_header,omitempty"`
+	ContractRegistrationAmendment []*modelpb.RegisteredContract1  `protobuf:"bytes,2,rep,name=contract_registration_amendment,json=contractRegistrationAmendment,proto3" json:"contract_registration_amendment,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1   `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *ContractRegistrationAmendmentRequestV01) Reset() {
+	*x = ContractRegistrationAmendmentRequestV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContractRegistrationAmendmentRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractRegistrationAmendmentRequestV01) ProtoMessage() {}
+
+func (x *ContractRegistrationAmendmentRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractRegistrationAmendmentRequestV01.ProtoReflect.Descriptor instead.
+func (*ContractRegistrationAmendmentRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ContractRegistrationAmendmentRequestV01) GetGroupHeader() *modelpb.CurrencyControlHeader1 {
+	if x != nil {
+		return x.GroupHeader
+	}
+	return nil
+}
+
+func (x *ContractRegistrationAmendmentRequestV01) GetContractRegistrationAmendment() []*modelpb.RegisteredContract1 {
+	if x != nil {
+		return x.ContractRegistrationAmendment
+	}
+	return nil
+}
+
+func (x *ContractRegistrationAmendmentRequestV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The ContractRegistrationClosureRequest message is sent by the reporting party to the registration agent to close the registered contract subject to currency control.
+type ContractRegistrationClosureRequestV01 struct {
+	state                     protoimpl.MessageState          `protogen:"open.v1"`
+	GroupHeader               *modelpb.CurrencyControlHeader1 `protobuf:"bytes,1,opt,name=group_header,json=groupHeader,proto3" json:"group_header,omitempty"`
+	RegisteredContractClosure []*modelpb.RegisteredContract2  `protobuf:"bytes,2,rep,name=registered_contract_closure,json=registeredContractClosure,proto3" json:"registered_contract_closure,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1   `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *ContractRegistrationClosureRequestV01) Reset() {
+	*x = ContractRegistrationClosureRequestV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContractRegistrationClosureRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractRegistrationClosureRequestV01) ProtoMessage() {}
+
+func (x *ContractRegistrationClosureRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractRegistrationClosureRequestV01.ProtoReflect.Descriptor instead.
+func (*ContractRegistrationClosureRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ContractRegistrationClosureRequestV01) GetGroupHeader() *modelpb.CurrencyControlHeader1 {
+	if x != nil {
+		return x.GroupHeader
+	}
+	return nil
+}
+
+func (x *ContractRegistrationClosureRequestV01) GetRegisteredContractClosure() []*modelpb.RegisteredContract2 {
+	if x != nil {
+		return x.RegisteredContractClosure
+	}
+	return nil
+}
+
+func (x *ContractRegistrationClosureRequestV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The ContractRegistrationConfirmation message is sent by the registration agent to the reporting party to register the contract subject to currency control.
+type ContractRegistrationConfirmationV01 struct {
+	state              protoimpl.MessageState          `protogen:"open.v1"`
+	GroupHeader        *modelpb.CurrencyControlHeader2 `protobuf:"bytes,1,opt,name=group_header,json=groupHeader,proto3" json:"group_header,omitempty"`
+	RegisteredContract []*modelpb.RegisteredContract4  `protobuf:"bytes,2,rep,name=registered_contract,json=registeredContract,proto3" json:"registered_contract,omitempty"`
+	SupplementaryData  []*modelpb.SupplementaryData1   `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ContractRegistrationConfirmationV01) Reset() {
+	*x = ContractRegistrationConfirmationV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContractRegistrationConfirmationV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractRegistrationConfirmationV01) ProtoMessage() {}
+
+func (x *ContractRegistrationConfirmationV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractRegistrationConfirmationV01.ProtoReflect.Descriptor instead.
+func (*ContractRegistrationConfirmationV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ContractRegistrationConfirmationV01) GetGroupHeader() *modelpb.CurrencyControlHeader2 {
+	if x != nil {
+		return x.GroupHeader
+	}
+	return nil
+}
+
+func (x *ContractRegistrationConfirmationV01) GetRegisteredContract() []*modelpb.RegisteredContract4 {
+	if x != nil {
+		return x.RegisteredContract
+	}
+	return nil
+}
+
+func (x *ContractRegistrationConfirmationV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The ContractRegistrationRequest message is sent by the reporting party to the registration agent to initiate the registration of a new contract subject to currency control.
+type ContractRegistrationRequestV01 struct {
+	state                protoimpl.MessageState           `protogen:"open.v1"`
+	GroupHeader          *modelpb.CurrencyControlHeader1  `protobuf:"bytes,1,opt,name=group_header,json=groupHeader,proto3" json:"group_header,omitempty"`
+	ContractRegistration []*modelpb.ContractRegistration1 `protobuf:"bytes,2,rep,name=contract_registration,json=contractRegistration,proto3" json:"contract_registration,omitempty"`
+	SupplementaryData    []*modelpb.SupplementaryData1    `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ContractRegistrationRequestV01) Reset() {
+	*x = ContractRegistrationRequestV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContractRegistrationRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractRegistrationRequestV01) ProtoMessage() {}
+
+func (x *ContractRegistrationRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractRegistrationRequestV01.ProtoReflect.Descriptor instead.
+func (*ContractRegistrationRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ContractRegistrationRequestV01) GetGroupHeader() *modelpb.CurrencyControlHeader1 {
+	if x != nil {
+		return x.GroupHeader
+	}
+	return nil
+}
+
+func (x *ContractRegistrationRequestV01) GetContractRegistration() []*modelpb.ContractRegistration1 {
+	if x != nil {
+		return x.ContractRegistration
+	}
+	return nil
+}
+
+func (x *ContractRegistrationRequestV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The ContractRegistrationStatementRequest message is sent by the reporting party to the registration agent to request for a statement of the operations related to the registered contract subject to currency control.
+type ContractRegistrationStatementRequestV01 struct {
+	state             protoimpl.MessageState                           `protogen:"open.v1"`
+	GroupHeader       *modelpb.CurrencyControlHeader1                  `protobuf:"bytes,1,opt,name=group_header,json=groupHeader,proto3" json:"group_header,omitempty"`
+	StatementRequest  []*modelpb.ContractRegistrationStatementRequest1 `protobuf:"bytes,2,rep,name=statement_request,json=statementRequest,proto3" json:"statement_request,omitempty"`
+	SupplementaryData []*modelpb.SupplementaryData1                    `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ContractRegistrationStatementRequestV01) Reset() {
+	*x = ContractRegistrationStatementRequestV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContractRegistrationStatementRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractRegistrationStatementRequestV01) ProtoMessage() {}
+
+func (x *ContractRegistrationStatementRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractRegistrationStatementRequestV01.ProtoReflect.Descriptor instead.
+func (*ContractRegistrationStatementRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ContractRegistrationStatementRequestV01) GetGroupHeader() *modelpb.CurrencyControlHeader1 {
+	if x != nil {
+		return x.GroupHeader
+	}
+	return nil
+}
+
+func (x *ContractRegistrationStatementRequestV01) GetStatementRequest() []*modelpb.ContractRegistrationStatementRequest1 {
+	if x != nil {
+		return x.StatementRequest
+	}
+	return nil
+}
+
+func (x *ContractRegistrationStatementRequestV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The ContractRegistrationStatement message is sent by the registration agent to the reporting party, in response to a request or at a pre-agreed date, to send a statement of the operations related to the registered contract subject to currency control.
+type ContractRegistrationStatementV01 struct {
+	state             protoimpl.MessageState                    `protogen:"open.v1"`
+	GroupHeader       *modelpb.CurrencyControlHeader2           `protobuf:"bytes,1,opt,name=group_header,json=groupHeader,proto3" json:"group_header,omitempty"`
+	Statement         []*modelpb.ContractRegistrationStatement1 `protobuf:"bytes,2,rep,name=statement,proto3" json:"statement,omitempty"`
+	SupplementaryData []*modelpb.SupplementaryData1             `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ContractRegistrationStatementV01) Reset() {
+	*x = ContractRegistrationStatementV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContractRegistrationStatementV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractRegistrationStatementV01) ProtoMessage() {}
+
+func (x *ContractRegistrationStatementV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractRegistrationStatementV01.ProtoReflect.Descriptor instead.
+func (*ContractRegistrationStatementV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ContractRegistrationStatementV01) GetGroupHeader() *modelpb.CurrencyControlHeader2 {
+	if x != nil {
+		return x.GroupHeader
+	}
+	return nil
+}
+
+func (x *ContractRegistrationStatementV01) GetStatement() []*modelpb.ContractRegistrationStatement1 {
+	if x != nil {
+		return x.Statement
+	}
+	return nil
+}
+
+func (x *ContractRegistrationStatementV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The CurrencyControlRequestOrLetter message is sent by the reporting party (respectively the registration agent) to the registration agent (respectively the reporting party) to send a currency control related letter or to request for supporting documents.
+type CurrencyControlRequestOrLetterV01 struct {
+	state             protoimpl.MessageState                        `protogen:"open.v1"`
+	GroupHeader       *modelpb.CurrencyControlHeader3               `protobuf:"bytes,1,opt,name=group_header,json=groupHeader,proto3" json:"group_header,omitempty"`
+	RequestOrLetter   []*modelpb.SupportingDocumentRequestOrLetter1 `protobuf:"bytes,2,rep,name=request_or_letter,json=requestOrLetter,proto3" json:"request_or_letter,omitempty"`
+	SupplementaryData []*modelpb.SupplementaryData1                 `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CurrencyControlRequestOrLetterV01) Reset() {
+	*x = CurrencyControlRequestOrLetterV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyControlRequestOrLetterV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyControlRequestOrLetterV01) ProtoMessage() {}
+
+func (x *CurrencyControlRequestOrLetterV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyControlRequestOrLetterV01.ProtoReflect.Descriptor instead.
+func (*CurrencyControlRequestOrLetterV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CurrencyControlRequestOrLetterV01) GetGroupHeader() *modelpb.CurrencyControlHeader3 {
+	if x != nil {
+		return x.GroupHeader
+	}
+	return nil
+}
+
+func (x *CurrencyControlRequestOrLetterV01) GetRequestOrLetter() []*modelpb.SupportingDocumentRequestOrLetter1 {
+	if x != nil {
+		return x.RequestOrLetter
+	}
+	return nil
+}
+
+func (x *CurrencyControlRequestOrLetterV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The CurrencyControlStatusAdvice message is sent by either the reporting party (respectively the registration agent or the registration agent (respectively the reporting party) to provide a status advice on a previously sent currency control message.
+//
+// Usage:
+// The message may be sent in response to requests on the registration of  the currency control contract, supporting document or on the payment regulatory information notification.
+type CurrencyControlStatusAdviceV01 struct {
+	state             protoimpl.MessageState                   `protogen:"open.v1"`
+	GroupHeader       *modelpb.CurrencyControlHeader2          `protobuf:"bytes,1,opt,name=group_header,json=groupHeader,proto3" json:"group_header,omitempty"`
+	GroupStatus       []*modelpb.CurrencyControlGroupStatus1   `protobuf:"bytes,2,rep,name=group_status,json=groupStatus,proto3" json:"group_status,omitempty"`
+	PackageStatus     []*modelpb.CurrencyControlPackageStatus1 `protobuf:"bytes,3,rep,name=package_status,json=packageStatus,proto3" json:"package_status,omitempty"`
+	SupplementaryData []*modelpb.SupplementaryData1            `protobuf:"bytes,4,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CurrencyControlStatusAdviceV01) Reset() {
+	*x = CurrencyControlStatusAdviceV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyControlStatusAdviceV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyControlStatusAdviceV01) ProtoMessage() {}
+
+func (x *CurrencyControlStatusAdviceV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyControlStatusAdviceV01.ProtoReflect.Descriptor instead.
+func (*CurrencyControlStatusAdviceV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CurrencyControlStatusAdviceV01) GetGroupHeader() *modelpb.CurrencyControlHeader2 {
+	if x != nil {
+		return x.GroupHeader
+	}
+	return nil
+}
+
+func (x *CurrencyControlStatusAdviceV01) GetGroupStatus() []*modelpb.CurrencyControlGroupStatus1 {
+	if x != nil {
+		return x.GroupStatus
+	}
+	return nil
+}
+
+func (x *CurrencyControlStatusAdviceV01) GetPackageStatus() []*modelpb.CurrencyControlPackageStatus1 {
+	if x != nil {
+		return x.PackageStatus
+	}
+	return nil
+}
+
+func (x *CurrencyControlStatusAdviceV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The CurrencyControlSupportingDocumentDelivery message is sent by either the reporting party (respectively the registration agent or the registration agent (respectively the reporting party) in response to the supporting document request.
+type CurrencyControlSupportingDocumentDeliveryV01 struct {
+	state              protoimpl.MessageState          `protogen:"open.v1"`
+	GroupHeader        *modelpb.CurrencyControlHeader3 `protobuf:"bytes,1,opt,name=group_header,json=groupHeader,proto3" json:"group_header,omitempty"`
+	SupportingDocument []*modelpb.SupportingDocument1  `protobuf:"bytes,2,rep,name=supporting_document,json=supportingDocument,proto3" json:"supporting_document,omitempty"`
+	SupplementaryData  []*modelpb.SupplementaryData1   `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CurrencyControlSupportingDocumentDeliveryV01) Reset() {
+	*x = CurrencyControlSupportingDocumentDeliveryV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyControlSupportingDocumentDeliveryV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyControlSupportingDocumentDeliveryV01) ProtoMessage() {}
+
+func (x *CurrencyControlSupportingDocumentDeliveryV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyControlSupportingDocumentDeliveryV01.ProtoReflect.Descriptor instead.
+func (*CurrencyControlSupportingDocumentDeliveryV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CurrencyControlSupportingDocumentDeliveryV01) GetGroupHeader() *modelpb.CurrencyControlHeader3 {
+	if x != nil {
+		return x.GroupHeader
+	}
+	return nil
+}
+
+func (x *CurrencyControlSupportingDocumentDeliveryV01) GetSupportingDocument() []*modelpb.SupportingDocument1 {
+	if x != nil {
+		return x.SupportingDocument
+	}
+	return nil
+}
+
+func (x *CurrencyControlSupportingDocumentDeliveryV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+type Document00100101 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *InformationRequestOpeningV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100101) Reset() {
+	*x = Document00100101{}
+	mi := &file_iso20022_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100101) ProtoMessage() {}
+
+func (x *Document00100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100101.ProtoReflect.Descriptor instead.
+func (*Document00100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Document00100101) GetMessage() *InformationRequestOpeningV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200101 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *InformationRequestResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200101) Reset() {
+	*x = Document00200101{}
+	mi := &file_iso20022_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200101) ProtoMessage() {}
+
+func (x *Document00200101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200101.ProtoReflect.Descriptor instead.
+func (*Document00200101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Document00200101) GetMessage() *InformationRequestResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300101 struct {
+	state         protoimpl.MessageState                         `protogen:"open.v1"`
+	Message       *InformationRequestStatusChangeNotificationV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300101) Reset() {
+	*x = Document00300101{}
+	mi := &file_iso20022_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300101) ProtoMessage() {}
+
+func (x *Document00300101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300101.ProtoReflect.Descriptor instead.
+func (*Document00300101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Document00300101) GetMessage() *InformationRequestStatusChangeNotificationV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800102 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *RegulatoryTransactionReportV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800102) Reset() {
+	*x = Document00800102{}
+	mi := &file_iso20022_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800102) ProtoMessage() {}
+
+func (x *Document00800102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800102.ProtoReflect.Descriptor instead.
+func (*Document00800102) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Document00800102) GetMessage() *RegulatoryTransactionReportV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900102 struct {
+	state         protoimpl.MessageState                             `protogen:"open.v1"`
+	Message       *RegulatoryTransactionReportCancellationRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900102) Reset() {
+	*x = Document00900102{}
+	mi := &file_iso20022_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900102) ProtoMessage() {}
+
+func (x *Document00900102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900102.ProtoReflect.Descriptor instead.
+func (*Document00900102) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Document00900102) GetMessage() *RegulatoryTransactionReportCancellationRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000101 struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Message       *RegulatoryTransactionReportStatusV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000101) Reset() {
+	*x = Document01000101{}
+	mi := &file_iso20022_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000101) ProtoMessage() {}
+
+func (x *Document01000101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000101.ProtoReflect.Descriptor instead.
+func (*Document01000101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Document01000101) GetMessage() *RegulatoryTransactionReportStatusV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01100101 struct {
+	state         protoimpl.MessageState                            `protogen:"open.v1"`
+	Message       *RegulatoryTransactionReportCancellationStatusV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01100101) Reset() {
+	*x = Document01100101{}
+	mi := &file_iso20022_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01100101) ProtoMessage() {}
+
+func (x *Document01100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01100101.ProtoReflect.Descriptor instead.
+func (*Document01100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Document01100101) GetMessage() *RegulatoryTransactionReportCancellationStatusV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01200101 struct {
+	state         protoimpl.MessageState                        `protogen:"open.v1"`
+	Message       *MoneyMarketSecuredMarketStatisticalReportV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01200101) Reset() {
+	*x = Document01200101{}
+	mi := &file_iso20022_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01200101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01200101) ProtoMessage() {}
+
+func (x *Document01200101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01200101.ProtoReflect.Descriptor instead.
+func (*Document01200101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Document01200101) GetMessage() *MoneyMarketSecuredMarketStatisticalReportV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01300101 struct {
+	state         protoimpl.MessageState                          `protogen:"open.v1"`
+	Message       *MoneyMarketUnsecuredMarketStatisticalReportV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01300101) Reset() {
+	*x = Document01300101{}
+	mi := &file_iso20022_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01300101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01300101) ProtoMessage() {}
+
+func (x *Document01300101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01300101.ProtoReflect.Descriptor instead.
+func (*Document01300101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Document01300101) GetMessage() *MoneyMarketUnsecuredMarketStatisticalReportV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01400101 struct {
+	state         protoimpl.MessageState                               `protogen:"open.v1"`
+	Message       *MoneyMarketForeignExchangeSwapsStatisticalReportV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01400101) Reset() {
+	*x = Document01400101{}
+	mi := &file_iso20022_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01400101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01400101) ProtoMessage() {}
+
+func (x *Document01400101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01400101.ProtoReflect.Descriptor instead.
+func (*Document01400101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Document01400101) GetMessage() *MoneyMarketForeignExchangeSwapsStatisticalReportV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01500101 struct {
+	state         protoimpl.MessageState                              `protogen:"open.v1"`
+	Message       *MoneyMarketOvernightIndexSwapsStatisticalReportV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01500101) Reset() {
+	*x = Document01500101{}
+	mi := &file_iso20022_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01500101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01500101) ProtoMessage() {}
+
+func (x *Document01500101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01500101.ProtoReflect.Descriptor instead.
+func (*Document01500101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Document01500101) GetMessage() *MoneyMarketOvernightIndexSwapsStatisticalReportV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01800101 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *ContractRegistrationRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01800101) Reset() {
+	*x = Document01800101{}
+	mi := &file_iso20022_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01800101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01800101) ProtoMessage() {}
+
+func (x *Document01800101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01800101.ProtoReflect.Descriptor instead.
+func (*Document01800101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Document01800101) GetMessage() *ContractRegistrationRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01900101 struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Message       *ContractRegistrationConfirmationV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01900101) Reset() {
+	*x = Document01900101{}
+	mi := &file_iso20022_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01900101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01900101) ProtoMessage() {}
+
+func (x *Document01900101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01900101.ProtoReflect.Descriptor instead.
+func (*Document01900101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Document01900101) GetMessage() *ContractRegistrationConfirmationV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02000101 struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Message       *ContractRegistrationClosureRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02000101) Reset() {
+	*x = Document02000101{}
+	mi := &file_iso20022_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02000101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02000101) ProtoMessage() {}
+
+func (x *Document02000101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02000101.ProtoReflect.Descriptor instead.
+func (*Document02000101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Document02000101) GetMessage() *ContractRegistrationClosureRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02100101 struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	Message       *ContractRegistrationAmendmentRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02100101) Reset() {
+	*x = Document02100101{}
+	mi := &file_iso20022_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02100101) ProtoMessage() {}
+
+func (x *Document02100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02100101.ProtoReflect.Descriptor instead.
+func (*Document02100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Document02100101) GetMessage() *ContractRegistrationAmendmentRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02200101 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *ContractRegistrationStatementV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02200101) Reset() {
+	*x = Document02200101{}
+	mi := &file_iso20022_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02200101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02200101) ProtoMessage() {}
+
+func (x *Document02200101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02200101.ProtoReflect.Descriptor instead.
+func (*Document02200101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Document02200101) GetMessage() *ContractRegistrationStatementV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02300101 struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	Message       *ContractRegistrationStatementRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02300101) Reset() {
+	*x = Document02300101{}
+	mi := &file_iso20022_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02300101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02300101) ProtoMessage() {}
+
+func (x *Document02300101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02300101.ProtoReflect.Descriptor instead.
+func (*Document02300101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Document02300101) GetMessage() *ContractRegistrationStatementRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02400101 struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	Message       *PaymentRegulatoryInformationNotificationV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02400101) Reset() {
+	*x = Document02400101{}
+	mi := &file_iso20022_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02400101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02400101) ProtoMessage() {}
+
+func (x *Document02400101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02400101.ProtoReflect.Descriptor instead.
+func (*Document02400101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Document02400101) GetMessage() *PaymentRegulatoryInformationNotificationV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02500101 struct {
+	state         protoimpl.MessageState                        `protogen:"open.v1"`
+	Message       *CurrencyControlSupportingDocumentDeliveryV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02500101) Reset() {
+	*x = Document02500101{}
+	mi := &file_iso20022_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02500101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02500101) ProtoMessage() {}
+
+func (x *Document02500101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02500101.ProtoReflect.Descriptor instead.
+func (*Document02500101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Document02500101) GetMessage() *CurrencyControlSupportingDocumentDeliveryV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02600101 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *CurrencyControlRequestOrLetterV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02600101) Reset() {
+	*x = Document02600101{}
+	mi := &file_iso20022_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02600101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02600101) ProtoMessage() {}
+
+func (x *Document02600101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02600101.ProtoReflect.Descriptor instead.
+func (*Document02600101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Document02600101) GetMessage() *CurrencyControlRequestOrLetterV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02700101 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *CurrencyControlStatusAdviceV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02700101) Reset() {
+	*x = Document02700101{}
+	mi := &file_iso20022_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02700101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02700101) ProtoMessage() {}
+
+func (x *Document02700101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02700101.ProtoReflect.Descriptor instead.
+func (*Document02700101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Document02700101) GetMessage() *CurrencyControlStatusAdviceV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document02800101 struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	Message       *MoneyMarketStatisticalReportStatusAdviceV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document02800101) Reset() {
+	*x = Document02800101{}
+	mi := &file_iso20022_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document02800101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document02800101) ProtoMessage() {}
+
+func (x *Document02800101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document02800101.ProtoReflect.Descriptor instead.
+func (*Document02800101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Document02800101) GetMessage() *MoneyMarketStatisticalReportStatusAdviceV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document03400101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *InvoiceTaxReportV01   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document03400101) Reset() {
+	*x = Document03400101{}
+	mi := &file_iso20022_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document03400101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document03400101) ProtoMessage() {}
+
+func (x *Document03400101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document03400101.ProtoReflect.Descriptor instead.
+func (*Document03400101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Document03400101) GetMessage() *InvoiceTaxReportV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document03800101 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *InvoiceTaxReportStatusAdviceV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document03800101) Reset() {
+	*x = Document03800101{}
+	mi := &file_iso20022_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document03800101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document03800101) ProtoMessage() {}
+
+func (x *Document03800101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document03800101.ProtoReflect.Descriptor instead.
+func (*Document03800101) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Document03800101) GetMessage() *InvoiceTaxReportStatusAdviceV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// This message is sent by the authorities (police, customs, tax authorities, enforcement authorities) to a financial institution to request account and other banking and financial information. Requested information can relate to accounts, their signatories and beneficiaries and co-owners as well as movements plus positions on these accounts.
+//
+// Requests are underpinned by specific legal texts.
+type InformationRequestOpeningV01 struct {
+	state                       protoimpl.MessageState              `protogen:"open.v1"`
+	InvestigationIdentification *string                             `protobuf:"bytes,1,opt,name=investigation_identification,json=investigationIdentification,proto3,oneof" json:"investigation_identification,omitempty"`
+	LegalMandateBasis           *modelpb.LegalMandate1              `protobuf:"bytes,2,opt,name=legal_mandate_basis,json=legalMandateBasis,proto3" json:"legal_mandate_basis,omitempty"`
+	ConfidentialityStatus       *string                             `protobuf:"bytes,3,opt,name=confidentiality_status,json=confidentialityStatus,proto3,oneof" json:"confidentiality_status,omitempty"`
+	DueDate                     *modelpb.DueDate1                   `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	InvestigationPeriod         *modelpb.DateOrDateTimePeriodChoice `protobuf:"bytes,5,opt,name=investigation_period,json=investigationPeriod,proto3" json:"investigation_period,omitempty"`
+	SearchCriteria              *modelpb.SearchCriteria1Choice      `protobuf:"bytes,6,opt,name=search_criteria,json=searchCriteria,proto3" json:"search_criteria,omitempty"`
+	SupplementaryData           []*modelpb.SupplementaryData1       `protobuf:"bytes,7,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *InformationRequestOpeningV01) Reset() {
+	*x = InformationRequestOpeningV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InformationRequestOpeningV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InformationRequestOpeningV01) ProtoMessage() {}
+
+func (x *InformationRequestOpeningV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InformationRequestOpeningV01.ProtoReflect.Descriptor instead.
+func (*InformationRequestOpeningV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *InformationRequestOpeningV01) GetInvestigationIdentification() string {
+	if x != nil && x.InvestigationIdentification != nil {
+		return *x.InvestigationIdentification
+	}
+	return ""
+}
+
+func (x *InformationRequestOpeningV01) GetLegalMandateBasis() *modelpb.LegalMandate1 {
+	if x != nil {
+		return x.LegalMandateBasis
+	}
+	return nil
+}
+
+func (x *InformationRequestOpeningV01) GetConfidentialityStatus() string {
+	if x != nil && x.ConfidentialityStatus != nil {
+		return *x.ConfidentialityStatus
+	}
+	return ""
+}
+
+func (x *InformationRequestOpeningV01) GetDueDate() *modelpb.DueDate1 {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *InformationRequestOpeningV01) GetInvestigationPeriod() *modelpb.DateOrDateTimePeriodChoice {
+	if x != nil {
+		return x.InvestigationPeriod
+	}
+	return nil
+}
+
+func (x *InformationRequestOpeningV01) GetSearchCriteria() *modelpb.SearchCriteria1Choice {
+	if x != nil {
+		return x.SearchCriteria
+	}
+	return nil
+}
+
+func (x *InformationRequestOpeningV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// This message is sent by the financial institution to the authorities (police, customs, tax authorities, enforcement authorities) to provide a part or all of the requested information.
+// The financial institution previously received a request for financial information in the scope of a financial investigation.
+//
+// Depending on whether the response can be provided STP within the authorities financial investigations messages, the requested information may be
+// •	provided in part or in full within the response message itself, or
+// •	only referred to in the response message
+type InformationRequestResponseV01 struct {
+	state                       protoimpl.MessageState         `protogen:"open.v1"`
+	ResponseIdentification      *string                        `protobuf:"bytes,1,opt,name=response_identification,json=responseIdentification,proto3,oneof" json:"response_identification,omitempty"`
+	InvestigationIdentification *string                        `protobuf:"bytes,2,opt,name=investigation_identification,json=investigationIdentification,proto3,oneof" json:"investigation_identification,omitempty"`
+	ResponseStatus              *string                        `protobuf:"bytes,3,opt,name=response_status,json=responseStatus,proto3,oneof" json:"response_status,omitempty"`
+	SearchCriteria              *modelpb.SearchCriteria1Choice `protobuf:"bytes,4,opt,name=search_criteria,json=searchCriteria,proto3" json:"search_criteria,omitempty"`
+	ReturnIndicator             []*modelpb.ReturnIndicator1    `protobuf:"bytes,5,rep,name=return_indicator,json=returnIndicator,proto3" json:"return_indicator,omitempty"`
+	SupplementaryData           []*modelpb.SupplementaryData1  `protobuf:"bytes,6,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *InformationRequestResponseV01) Reset() {
+	*x = InformationRequestResponseV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InformationRequestResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InformationRequestResponseV01) ProtoMessage() {}
+
+func (x *InformationRequestResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InformationRequestResponseV01.ProtoReflect.Descriptor instead.
+func (*InformationRequestResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InformationRequestResponseV01) GetResponseIdentification() string {
+	if x != nil && x.ResponseIdentification != nil {
+		return *x.ResponseIdentification
+	}
+	return ""
+}
+
+func (x *InformationRequestResponseV01) GetInvestigationIdentification() string {
+	if x != nil && x.InvestigationIdentification != nil {
+		return *x.InvestigationIdentification
+	}
+	return ""
+}
+
+func (x *InformationRequestResponseV01) GetResponseStatus() string {
+	if x != nil && x.ResponseStatus != nil {
+		return *x.ResponseStatus
+	}
+	return ""
+}
+
+func (x *InformationRequestResponseV01) GetSearchCriteria() *modelpb.SearchCriteria1Choice {
+	if x != nil {
+		return x.SearchCriteria
+	}
+	return nil
+}
+
+func (x *InformationRequestResponseV01) GetReturnIndicator() []*modelpb.ReturnIndicator1 {
+	if x != nil {
+		return x.ReturnIndicator
+	}
+	return nil
+}
+
+func (x *InformationRequestResponseV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// This message is sent by the authorities (police, customs, tax authorities, enforcement authorities) to a financial institution to inform the financial institution that the confidentiality status of the investigation has changed.
+type InformationRequestStatusChangeNotificationV01 struct {
+	state                 protoimpl.MessageState        `protogen:"open.v1"`
+	OriginalBusinessQuery *string                       `protobuf:"bytes,1,opt,name=original_business_query,json=originalBusinessQuery,proto3,oneof" json:"original_business_query,omitempty"`
+	ConfidentialityStatus *string                       `protobuf:"bytes,2,opt,name=confidentiality_status,json=confidentialityStatus,proto3,oneof" json:"confidentiality_status,omitempty"`
+	SupplementaryData     []*modelpb.SupplementaryData1 `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *InformationRequestStatusChangeNotificationV01) Reset() {
+	*x = InformationRequestStatusChangeNotificationV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InformationRequestStatusChangeNotificationV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InformationRequestStatusChangeNotificationV01) ProtoMessage() {}
+
+func (x *InformationRequestStatusChangeNotificationV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InformationRequestStatusChangeNotificationV01.ProtoReflect.Descriptor instead.
+func (*InformationRequestStatusChangeNotificationV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *InformationRequestStatusChangeNotificationV01) GetOriginalBusinessQuery() string {
+	if x != nil && x.OriginalBusinessQuery != nil {
+		return *x.OriginalBusinessQuery
+	}
+	return ""
+}
+
+func (x *InformationRequestStatusChangeNotificationV01) GetConfidentialityStatus() string {
+	if x != nil && x.ConfidentialityStatus != nil {
+		return *x.ConfidentialityStatus
+	}
+	return ""
+}
+
+func (x *InformationRequestStatusChangeNotificationV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The InvoiceTaxReportStatusAdvice message is sent by the matching application to the party from which it received a message.
+// This message is used to acknowledge the InvoiceTaxReport message.
+type InvoiceTaxReportStatusAdviceV01 struct {
+	state              protoimpl.MessageState                        `protogen:"open.v1"`
+	StatusReportHeader *modelpb.InvoiceTaxStatusReportHeader1        `protobuf:"bytes,1,opt,name=status_report_header,json=statusReportHeader,proto3" json:"status_report_header,omitempty"`
+	TransactionStatus  []*modelpb.InvoiceTaxReportTransactionStatus1 `protobuf:"bytes,2,rep,name=transaction_status,json=transactionStatus,proto3" json:"transaction_status,omitempty"`
+	SupplementaryData  []*modelpb.SupplementaryData1                 `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *InvoiceTaxReportStatusAdviceV01) Reset() {
+	*x = InvoiceTaxReportStatusAdviceV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceTaxReportStatusAdviceV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceTaxReportStatusAdviceV01) ProtoMessage() {}
+
+func (x *InvoiceTaxReportStatusAdviceV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceTaxReportStatusAdviceV01.ProtoReflect.Descriptor instead.
+func (*InvoiceTaxReportStatusAdviceV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *InvoiceTaxReportStatusAdviceV01) GetStatusReportHeader() *modelpb.InvoiceTaxStatusReportHeader1 {
+	if x != nil {
+		return x.StatusReportHeader
+	}
+	return nil
+}
+
+func (x *InvoiceTaxReportStatusAdviceV01) GetTransactionStatus() []*modelpb.InvoiceTaxReportTransactionStatus1 {
+	if x != nil {
+		return x.TransactionStatus
+	}
+	return nil
+}
+
+func (x *InvoiceTaxReportStatusAdviceV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The InvoiceTaxReport message is sent by tax responsible to tax authority. Tax authorities require corporates to report their sales based value added tax (VAT). This message is targeted to this reporting based on information in sales invoices and card transactions.
+type InvoiceTaxReportV01 struct {
+	state                  protoimpl.MessageState        `protogen:"open.v1"`
+	InvoiceTaxReportHeader *modelpb.TaxReportHeader1     `protobuf:"bytes,1,opt,name=invoice_tax_report_header,json=invoiceTaxReportHeader,proto3" json:"invoice_tax_report_header,omitempty"`
+	TaxReport              []*modelpb.TaxReport1         `protobuf:"bytes,2,rep,name=tax_report,json=taxReport,proto3" json:"tax_report,omitempty"`
+	SupplementaryData      []*modelpb.SupplementaryData1 `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvoiceTaxReportV01) Reset() {
+	*x = InvoiceTaxReportV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceTaxReportV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceTaxReportV01) ProtoMessage() {}
+
+func (x *InvoiceTaxReportV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceTaxReportV01.ProtoReflect.Descriptor instead.
+func (*InvoiceTaxReportV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *InvoiceTaxReportV01) GetInvoiceTaxReportHeader() *modelpb.TaxReportHeader1 {
+	if x != nil {
+		return x.InvoiceTaxReportHeader
+	}
+	return nil
+}
+
+func (x *InvoiceTaxReportV01) GetTaxReport() []*modelpb.TaxReport1 {
+	if x != nil {
+		return x.TaxReport
+	}
+	return nil
+}
+
+func (x *InvoiceTaxReportV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The MoneyMarketSecuredMarketStatisticalReport message is sent by the reporting agents  to the relevant competent authority, to report all daily Foreign Exchange Swaps (FX Swaps) transactions.
+type MoneyMarketForeignExchangeSwapsStatisticalReportV01 struct {
+	state                      protoimpl.MessageState              `protogen:"open.v1"`
+	ReportHeader               *modelpb.MoneyMarketReportHeader1   `protobuf:"bytes,1,opt,name=report_header,json=reportHeader,proto3" json:"report_header,omitempty"`
+	ForeignExchangeSwapsReport *modelpb.ForeignExchangeSwap2Choice `protobuf:"bytes,2,opt,name=foreign_exchange_swaps_report,json=foreignExchangeSwapsReport,proto3" json:"foreign_exchange_swaps_report,omitempty"`
+	SupplementaryData          []*modelpb.SupplementaryData1       `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *MoneyMarketForeignExchangeSwapsStatisticalReportV01) Reset() {
+	*x = MoneyMarketForeignExchangeSwapsStatisticalReportV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoneyMarketForeignExchangeSwapsStatisticalReportV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoneyMarketForeignExchangeSwapsStatisticalReportV01) ProtoMessage() {}
+
+func (x *MoneyMarketForeignExchangeSwapsStatisticalReportV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoneyMarketForeignExchangeSwapsStatisticalReportV01.ProtoReflect.Descriptor instead.
+func (*MoneyMarketForeignExchangeSwapsStatisticalReportV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MoneyMarketForeignExchangeSwapsStatisticalReportV01) GetReportHeader() *modelpb.MoneyMarketReportHeader1 {
+	if x != nil {
+		return x.ReportHeader
+	}
+	return nil
+}
+
+func (x *MoneyMarketForeignExchangeSwapsStatisticalReportV01) GetForeignExchangeSwapsReport() *modelpb.ForeignExchangeSwap2Choice {
+	if x != nil {
+		return x.ForeignExchangeSwapsReport
+	}
+	return nil
+}
+
+func (x *MoneyMarketForeignExchangeSwapsStatisticalReportV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The MoneyMarketOvernightIndexSwapsStatisticalReport message is sent by the reporting agents to the relevant competent authority, to report the daily overnight index swaps (OIS) transactions.
+type MoneyMarketOvernightIndexSwapsStatisticalReportV01 struct {
+	state                     protoimpl.MessageState             `protogen:"open.v1"`
+	ReportHeader              *modelpb.MoneyMarketReportHeader1  `protobuf:"bytes,1,opt,name=report_header,json=reportHeader,proto3" json:"report_header,omitempty"`
+	OvernightIndexSwapsReport *modelpb.OvernightIndexSwap3Choice `protobuf:"bytes,2,opt,name=overnight_index_swaps_report,json=overnightIndexSwapsReport,proto3" json:"overnight_index_swaps_report,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1      `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *MoneyMarketOvernightIndexSwapsStatisticalReportV01) Reset() {
+	*x = MoneyMarketOvernightIndexSwapsStatisticalReportV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoneyMarketOvernightIndexSwapsStatisticalReportV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoneyMarketOvernightIndexSwapsStatisticalReportV01) ProtoMessage() {}
+
+func (x *MoneyMarketOvernightIndexSwapsStatisticalReportV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoneyMarketOvernightIndexSwapsStatisticalReportV01.ProtoReflect.Descriptor instead.
+func (*MoneyMarketOvernightIndexSwapsStatisticalReportV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MoneyMarketOvernightIndexSwapsStatisticalReportV01) GetReportHeader() *modelpb.MoneyMarketReportHeader1 {
+	if x != nil {
+		return x.ReportHeader
+	}
+	return nil
+}
+
+func (x *MoneyMarketOvernightIndexSwapsStatisticalReportV01) GetOvernightIndexSwapsReport() *modelpb.OvernightIndexSwap3Choice {
+	if x != nil {
+		return x.OvernightIndexSwapsReport
+	}
+	return nil
+}
+
+func (x *MoneyMarketOvernightIndexSwapsStatisticalReportV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The MoneyMarketSecuredMarketStatisticalReport message is sent by the reporting agents to the relevant competent authority, to report all relevant secured money market transactions.
+type MoneyMarketSecuredMarketStatisticalReportV01 struct {
+	state               protoimpl.MessageState              `protogen:"open.v1"`
+	ReportHeader        *modelpb.MoneyMarketReportHeader1   `protobuf:"bytes,1,opt,name=report_header,json=reportHeader,proto3" json:"report_header,omitempty"`
+	SecuredMarketReport *modelpb.SecuredMarketReport3Choice `protobuf:"bytes,2,opt,name=secured_market_report,json=securedMarketReport,proto3" json:"secured_market_report,omitempty"`
+	SupplementaryData   []*modelpb.SupplementaryData1       `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MoneyMarketSecuredMarketStatisticalReportV01) Reset() {
+	*x = MoneyMarketSecuredMarketStatisticalReportV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoneyMarketSecuredMarketStatisticalReportV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoneyMarketSecuredMarketStatisticalReportV01) ProtoMessage() {}
+
+func (x *MoneyMarketSecuredMarketStatisticalReportV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoneyMarketSecuredMarketStatisticalReportV01.ProtoReflect.Descriptor instead.
+func (*MoneyMarketSecuredMarketStatisticalReportV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MoneyMarketSecuredMarketStatisticalReportV01) GetReportHeader() *modelpb.MoneyMarketReportHeader1 {
+	if x != nil {
+		return x.ReportHeader
+	}
+	return nil
+}
+
+func (x *MoneyMarketSecuredMarketStatisticalReportV01) GetSecuredMarketReport() *modelpb.SecuredMarketReport3Choice {
+	if x != nil {
+		return x.SecuredMarketReport
+	}
+	return nil
+}
+
+func (x *MoneyMarketSecuredMarketStatisticalReportV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The MoneyMarketStatisticalReportStatusAdvice message is sent by the relevant competent authority to the reporting agents to provide the status on the reported transactions.
+type MoneyMarketStatisticalReportStatusAdviceV01 struct {
+	state              protoimpl.MessageState                   `protogen:"open.v1"`
+	StatusReportHeader *modelpb.MoneyMarketStatusReportHeader1  `protobuf:"bytes,1,opt,name=status_report_header,json=statusReportHeader,proto3" json:"status_report_header,omitempty"`
+	TransactionStatus  []*modelpb.MoneyMarketTransactionStatus2 `protobuf:"bytes,2,rep,name=transaction_status,json=transactionStatus,proto3" json:"transaction_status,omitempty"`
+	SupplementaryData  []*modelpb.SupplementaryData1            `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MoneyMarketStatisticalReportStatusAdviceV01) Reset() {
+	*x = MoneyMarketStatisticalReportStatusAdviceV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoneyMarketStatisticalReportStatusAdviceV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoneyMarketStatisticalReportStatusAdviceV01) ProtoMessage() {}
+
+func (x *MoneyMarketStatisticalReportStatusAdviceV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoneyMarketStatisticalReportStatusAdviceV01.ProtoReflect.Descriptor instead.
+func (*MoneyMarketStatisticalReportStatusAdviceV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MoneyMarketStatisticalReportStatusAdviceV01) GetStatusReportHeader() *modelpb.MoneyMarketStatusReportHeader1 {
+	if x != nil {
+		return x.StatusReportHeader
+	}
+	return nil
+}
+
+func (x *MoneyMarketStatisticalReportStatusAdviceV01) GetTransactionStatus() []*modelpb.MoneyMarketTransactionStatus2 {
+	if x != nil {
+		return x.TransactionStatus
+	}
+	return nil
+}
+
+func (x *MoneyMarketStatisticalReportStatusAdviceV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The MoneyMarketUnsecuredMarketStatisticalReport message is sent by the reporting agents to the relevant competent authority, to report all relevant unsecured money market transactions.
+type MoneyMarketUnsecuredMarketStatisticalReportV01 struct {
+	state                 protoimpl.MessageState                `protogen:"open.v1"`
+	ReportHeader          *modelpb.MoneyMarketReportHeader1     `protobuf:"bytes,1,opt,name=report_header,json=reportHeader,proto3" json:"report_header,omitempty"`
+	UnsecuredMarketReport *modelpb.UnsecuredMarketReport3Choice `protobuf:"bytes,2,opt,name=unsecured_market_report,json=unsecuredMarketReport,proto3" json:"unsecured_market_report,omitempty"`
+	SupplementaryData     []*modelpb.SupplementaryData1         `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *MoneyMarketUnsecuredMarketStatisticalReportV01) Reset() {
+	*x = MoneyMarketUnsecuredMarketStatisticalReportV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoneyMarketUnsecuredMarketStatisticalReportV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoneyMarketUnsecuredMarketStatisticalReportV01) ProtoMessage() {}
+
+func (x *MoneyMarketUnsecuredMarketStatisticalReportV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoneyMarketUnsecuredMarketStatisticalReportV01.ProtoReflect.Descriptor instead.
+func (*MoneyMarketUnsecuredMarketStatisticalReportV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MoneyMarketUnsecuredMarketStatisticalReportV01) GetReportHeader() *modelpb.MoneyMarketReportHeader1 {
+	if x != nil {
+		return x.ReportHeader
+	}
+	return nil
+}
+
+func (x *MoneyMarketUnsecuredMarketStatisticalReportV01) GetUnsecuredMarketReport() *modelpb.UnsecuredMarketReport3Choice {
+	if x != nil {
+		return x.UnsecuredMarketReport
+	}
+	return nil
+}
+
+func (x *MoneyMarketUnsecuredMarketStatisticalReportV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// The PaymentRegulatoryInformationNotification message is sent by the reporting party to the registration agent to provide details on the transaction details, when a payment has to be recorded against the registered currency control contract.
+//
+// In some cases, the registration agent may also sent this message to the reporting party.
+type PaymentRegulatoryInformationNotificationV01 struct {
+	state                   protoimpl.MessageState                      `protogen:"open.v1"`
+	GroupHeader             *modelpb.CurrencyControlHeader3             `protobuf:"bytes,1,opt,name=group_header,json=groupHeader,proto3" json:"group_header,omitempty"`
+	TransactionNotification []*modelpb.RegulatoryReportingNotification1 `protobuf:"bytes,2,rep,name=transaction_notification,json=transactionNotification,proto3" json:"transaction_notification,omitempty"`
+	SupplementaryData       []*modelpb.SupplementaryData1               `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *PaymentRegulatoryInformationNotificationV01) Reset() {
+	*x = PaymentRegulatoryInformationNotificationV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentRegulatoryInformationNotificationV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRegulatoryInformationNotificationV01) ProtoMessage() {}
+
+func (x *PaymentRegulatoryInformationNotificationV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRegulatoryInformationNotificationV01.ProtoReflect.Descriptor instead.
+func (*PaymentRegulatoryInformationNotificationV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PaymentRegulatoryInformationNotificationV01) GetGroupHeader() *modelpb.CurrencyControlHeader3 {
+	if x != nil {
+		return x.GroupHeader
+	}
+	return nil
+}
+
+func (x *PaymentRegulatoryInformationNotificationV01) GetTransactionNotification() []*modelpb.RegulatoryReportingNotification1 {
+	if x != nil {
+		return x.TransactionNotification
+	}
+	return nil
+}
+
+func (x *PaymentRegulatoryInformationNotificationV01) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// A reporting institution, eg, an investment bank, sends the RegulatoryTransactionReportCancellationRequest to a regulator or to an intermediary (eg a reporting agent), to request a cancellation of a previously sent RegulatoryTransactionReport.
+// Usage
+// The message definition can be used to cancel an entire RegulatoryTransactionReport or to cancel one or more individual transactions in a previously sent RegulatoryTransactionReport.
+type RegulatoryTransactionReportCancellationRequestV02 struct {
+	state                            protoimpl.MessageState               `protogen:"open.v1"`
+	Identification                   *modelpb.DocumentIdentification8     `protobuf:"bytes,1,opt,name=identification,proto3" json:"identification,omitempty"`
+	ReportingInstitution             *modelpb.PartyIdentification23Choice `protobuf:"bytes,2,opt,name=reporting_institution,json=reportingInstitution,proto3" json:"reporting_institution,omitempty"`
+	ReportingAgent                   *modelpb.PartyIdentification24Choice `protobuf:"bytes,3,opt,name=reporting_agent,json=reportingAgent,proto3" json:"reporting_agent,omitempty"`
+	CancellationByTransactionDetails []*modelpb.TransactionDetails3       `protobuf:"bytes,4,rep,name=cancellation_by_transaction_details,json=cancellationByTransactionDetails,proto3" json:"cancellation_by_transaction_details,omitempty"`
+	PreviousReference                *modelpb.DocumentIdentification9     `protobuf:"bytes,5,opt,name=previous_reference,json=previousReference,proto3" json:"previous_reference,omitempty"`
+	CancellationByTradeReference     []*modelpb.TransactionDetails2       `protobuf:"bytes,6,rep,name=cancellation_by_trade_reference,json=cancellationByTradeReference,proto3" json:"cancellation_by_trade_reference,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *RegulatoryTransactionReportCancellationRequestV02) Reset() {
+	*x = RegulatoryTransactionReportCancellationRequestV02{}
+	mi := &file_iso20022_auth_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegulatoryTransactionReportCancellationRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegulatoryTransactionReportCancellationRequestV02) ProtoMessage() {}
+
+func (x *RegulatoryTransactionReportCancellationRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegulatoryTransactionReportCancellationRequestV02.ProtoReflect.Descriptor instead.
+func (*RegulatoryTransactionReportCancellationRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RegulatoryTransactionReportCancellationRequestV02) GetIdentification() *modelpb.DocumentIdentification8 {
+	if x != nil {
+		return x.Identification
+	}
+	return nil
+}
+
+func (x *RegulatoryTransactionReportCancellationRequestV02) GetReportingInstitution() *modelpb.PartyIdentification23Choice {
+	if x != nil {
+		return x.ReportingInstitution
+	}
+	return nil
+}
+
+func (x *RegulatoryTransactionReportCancellationRequestV02) GetReportingAgent() *modelpb.PartyIdentification24Choice {
+	if x != nil {
+		return x.ReportingAgent
+	}
+	return nil
+}
+
+func (x *RegulatoryTransactionReportCancellationRequestV02) GetCancellationByTransactionDetails() []*modelpb.TransactionDetails3 {
+	if x != nil {
+		return x.CancellationByTransactionDetails
+	}
+	return nil
+}
+
+func (x *RegulatoryTransactionReportCancellationRequestV02) GetPreviousReference() *modelpb.DocumentIdentification9 {
+	if x != nil {
+		return x.PreviousReference
+	}
+	return nil
+}
+
+func (x *RegulatoryTransactionReportCancellationRequestV02) GetCancellationByTradeReference() []*modelpb.TransactionDetails2 {
+	if x != nil {
+		return x.CancellationByTradeReference
+	}
+	return nil
+}
+
+// Scope
+// A regulator or an intermediary sends the RegulatoryTransactionReportCancellationStatus to a reporting institution to provide the status of a RegulatoryTransactionReportCancellationRequest previously sent by the reporting institution.
+// Usage
+// The message definition may be used to provide a status for the entire report or to provide a status at the level of individual transactions within the report. One of the following statuses can be reported:
+// - Completed, or,
+// - Pending, or,
+// - Rejected.
+// If the status is rejected, then reason for the rejection must be specified.
+type RegulatoryTransactionReportCancellationStatusV01 struct {
+	state                                   protoimpl.MessageState                      `protogen:"open.v1"`
+	Identification                          *modelpb.DocumentIdentification8            `protobuf:"bytes,1,opt,name=identification,proto3" json:"identification,omitempty"`
+	ReportingInstitution                    *modelpb.PartyIdentification23Choice        `protobuf:"bytes,2,opt,name=reporting_institution,json=reportingInstitution,proto3" json:"reporting_institution,omitempty"`
+	ReportCancellationStatus                *modelpb.ReportStatusAndReason2             `protobuf:"bytes,3,opt,name=report_cancellation_status,json=reportCancellationStatus,proto3" json:"report_cancellation_status,omitempty"`
+	IndividualTransactionCancellationStatus []*modelpb.TradeTransactionStatusAndReason2 `protobuf:"bytes,4,rep,name=individual_transaction_cancellation_status,json=individualTransactionCancellationStatus,proto3" json:"individual_transaction_cancellation_status,omitempty"`
+	unknownFields                           protoimpl.UnknownFields
+	sizeCache                               protoimpl.SizeCache
+}
+
+func (x *RegulatoryTransactionReportCancellationStatusV01) Reset() {
+	*x = RegulatoryTransactionReportCancellationStatusV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegulatoryTransactionReportCancellationStatusV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegulatoryTransactionReportCancellationStatusV01) ProtoMessage() {}
+
+func (x *RegulatoryTransactionReportCancellationStatusV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegulatoryTransactionReportCancellationStatusV01.ProtoReflect.Descriptor instead.
+func (*RegulatoryTransactionReportCancellationStatusV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RegulatoryTransactionReportCancellationStatusV01) GetIdentification() *modelpb.DocumentIdentification8 {
+	if x != nil {
+		return x.Identification
+	}
+	return nil
+}
+
+func (x *RegulatoryTransactionReportCancellationStatusV01) GetReportingInstitution() *modelpb.PartyIdentification23Choice {
+	if x != nil {
+		return x.ReportingInstitution
+	}
+	return nil
+}
+
+func (x *RegulatoryTransactionReportCancellationStatusV01) GetReportCancellationStatus() *modelpb.ReportStatusAndReason2 {
+	if x != nil {
+		return x.ReportCancellationStatus
+	}
+	return nil
+}
+
+func (x *RegulatoryTransactionReportCancellationStatusV01) GetIndividualTransactionCancellationStatus() []*modelpb.TradeTransactionStatusAndReason2 {
+	if x != nil {
+		return x.IndividualTransactionCancellationStatus
+	}
+	return nil
+}
+
+// Scope
+// A regulator or an intermediary sends the RegulatoryTransactionReportStatus to a reporting institution to provide the status of a RegulatoryTransactionReport previously sent by the reporting institution.
+// Usage
+// The message definition may be used to provide a status for the entire report or to provide a status at the level of individual transactions within the report. One of the following statuses can be reported:
+// - Completed, or,
+// - Pending, or,
+// - Rejected.
+// If the status is rejected, then reason for the rejection must be specified.
+type RegulatoryTransactionReportStatusV01 struct {
+	state                       protoimpl.MessageState                      `protogen:"open.v1"`
+	Identification              *modelpb.DocumentIdentification8            `protobuf:"bytes,1,opt,name=identification,proto3" json:"identification,omitempty"`
+	ReportingInstitution        *modelpb.PartyIdentification23Choice        `protobuf:"bytes,2,opt,name=reporting_institution,json=reportingInstitution,proto3" json:"reporting_institution,omitempty"`
+	ReportStatus                *modelpb.ReportStatusAndReason1             `protobuf:"bytes,3,opt,name=report_status,json=reportStatus,proto3" json:"report_status,omitempty"`
+	IndividualTransactionStatus []*modelpb.TradeTransactionStatusAndReason1 `protobuf:"bytes,4,rep,name=individual_transaction_status,json=individualTransactionStatus,proto3" json:"individual_transaction_status,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *RegulatoryTransactionReportStatusV01) Reset() {
+	*x = RegulatoryTransactionReportStatusV01{}
+	mi := &file_iso20022_auth_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegulatoryTransactionReportStatusV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegulatoryTransactionReportStatusV01) ProtoMessage() {}
+
+func (x *RegulatoryTransactionReportStatusV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegulatoryTransactionReportStatusV01.ProtoReflect.Descriptor instead.
+func (*RegulatoryTransactionReportStatusV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RegulatoryTransactionReportStatusV01) GetIdentification() *modelpb.DocumentIdentification8 {
+	if x != nil {
+		return x.Identification
+	}
+	return nil
+}
+
+func (x *RegulatoryTransactionReportStatusV01) GetReportingInstitution() *modelpb.PartyIdentification23Choice {
+	if x != nil {
+		return x.ReportingInstitution
+	}
+	return nil
+}
+
+func (x *RegulatoryTransactionReportStatusV01) GetReportStatus() *modelpb.ReportStatusAndReason1 {
+	if x != nil {
+		return x.ReportStatus
+	}
+	return nil
+}
+
+func (x *RegulatoryTransactionReportStatusV01) GetIndividualTransactionStatus() []*modelpb.TradeTransactionStatusAndReason1 {
+	if x != nil {
+		return x.IndividualTransactionStatus
+	}
+	return nil
+}
+
+// Scope
+// A reporting institution, eg, an investment bank, sends the RegulatoryTransactionReport to a regulator or an intermediary (eg a reporting agent), to report the transaction details of a trade that has been executed on or off-exchange.
+// Usage
+// The message definition can be used to report more than one transaction. The message definition can also be used to specify, on a trade by trade basis, to which authorities the transaction report(s) need to be sent using the TransactionReportMarker.
+type RegulatoryTransactionReportV02 struct {
+	state                protoimpl.MessageState               `protogen:"open.v1"`
+	Identification       *modelpb.DocumentIdentification8     `protobuf:"bytes,1,opt,name=identification,proto3" json:"identification,omitempty"`
+	TransactionDetails   []*modelpb.TransactionDetails3       `protobuf:"bytes,2,rep,name=transaction_details,json=transactionDetails,proto3" json:"transaction_details,omitempty"`
+	ReportingInstitution *modelpb.PartyIdentification23Choice `protobuf:"bytes,3,opt,name=reporting_institution,json=reportingInstitution,proto3" json:"reporting_institution,omitempty"`
+	ReportingAgent       *modelpb.PartyIdentification24Choice `protobuf:"bytes,4,opt,name=reporting_agent,json=reportingAgent,proto3" json:"reporting_agent,omitempty"`
+	Extension            []*modelpb.Extension1                `protobuf:"bytes,5,rep,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RegulatoryTransactionReportV02) Reset() {
+	*x = RegulatoryTransactionReportV02{}
+	mi := &file_iso20022_auth_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegulatoryTransactionReportV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegulatoryTransactionReportV02) ProtoMessage() {}
+
+func (x *RegulatoryTransactionReportV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_auth_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegulatoryTransactionReportV02.ProtoReflect.Descriptor instead.
+func (*RegulatoryTransactionReportV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_auth_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RegulatoryTransactionReportV02) GetIdentification() *modelpb.DocumentIdentification8 {
+	if x != nil {
+		return x.Identification
+	}
+	return nil
+}
+
+func (x *RegulatoryTransactionReportV02) GetTransactionDetails() []*modelpb.TransactionDetails3 {
+	if x != nil {
+		return x.TransactionDetails
+	}
+	return nil
+}
+
+func (x *RegulatoryTransactionReportV02) GetReportingInstitution() *modelpb.PartyIdentification23Choice {
+	if x != nil {
+		return x.ReportingInstitution
+	}
+	return nil
+}
+
+func (x *RegulatoryTransactionReportV02) GetReportingAgent() *modelpb.PartyIdentification24Choice {
+	if x != nil {
+		return x.ReportingAgent
+	}
+	return nil
+}
+
+func (x *RegulatoryTransactionReportV02) GetExtension() []*modelpb.Extension1 {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+var File_iso20022_auth_proto protoreflect.FileDescriptor
+
+var file_iso20022_auth_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x1a, 0x14, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4, 0x02, 0x0a, 0x27, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x49, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x31, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x6b, 0x0a, 0x1f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x65, 0x6e, 0x64,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x31, 0x52,
+	0x1d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x51,
+	0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x22, 0xaa, 0x02, 0x0a, 0x25, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x49, 0x0a, 0x0c, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x63, 0x0a, 0x1b, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6c,
+	0x6f, 0x73, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32,
+	0x52, 0x19, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0x99,
+	0x02, 0x0a, 0x23, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x30, 0x31, 0x12, 0x49, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x32, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x54, 0x0a, 0x13, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x34, 0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0x9a, 0x02, 0x0a, 0x1e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x49, 0x0a,
+	0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x0b, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5a, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x14,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xab, 0x02, 0x0a, 0x27, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x30, 0x31, 0x12, 0x49, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x31, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x62,
+	0x0a, 0x11, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x31,
+	0x52, 0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0x8e, 0x02, 0x0a, 0x20, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x31, 0x12, 0x49, 0x0a, 0x0c, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xa1, 0x02, 0x0a, 0x21, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4f, 0x72, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x56, 0x30, 0x31, 0x12, 0x49, 0x0a, 0x0c,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5e, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4f, 0x72, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x31, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4f,
+	0x72, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xe4, 0x02, 0x0a, 0x1e, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x31, 0x12, 0x49, 0x0a,
+	0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x52, 0x0b, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x31, 0x52, 0x0b, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x54, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x31, 0x52,
+	0x0d, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x51,
+	0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x22, 0xa2, 0x02, 0x0a, 0x2c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x56,
+	0x30, 0x31, 0x12, 0x49, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33,
+	0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x54, 0x0a,
+	0x13, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52,
+	0x12, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0x59, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x31, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x45, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4f, 0x70,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x32,
+	0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6a, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30,
+	0x31, 0x12, 0x56, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x31,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x38, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x47, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52,
+	0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x39, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x5a, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x65, 0x67, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x61, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x31, 0x30, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x4d, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x65, 0x67, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x30, 0x31,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6d, 0x0a, 0x10, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x31, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x59, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52,
+	0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x30, 0x31, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x69, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x32, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x55, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x61,
+	0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x6b, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x31, 0x33, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x57, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x55, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x70, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x34, 0x30,
+	0x30, 0x31, 0x30, 0x31, 0x12, 0x5c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x53, 0x77, 0x61, 0x70, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x61, 0x6c,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x6f, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31,
+	0x35, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x5b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x53, 0x77, 0x61, 0x70, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x61,
+	0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x31, 0x38, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x60, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x39, 0x30,
+	0x30, 0x31, 0x30, 0x31, 0x12, 0x4c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x62, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x32,
+	0x30, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x4e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x64, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x32, 0x31, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x50, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x32, 0x32, 0x30, 0x30, 0x31, 0x30, 0x31,
+	0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56,
+	0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x64, 0x0a, 0x10, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x32, 0x33, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12,
+	0x50, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x36, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x68, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x32, 0x34,
+	0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x54, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x69, 0x0a, 0x10, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x32, 0x35, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12,
+	0x55, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x32, 0x36, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4f, 0x72, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x32, 0x37, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x68, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x32, 0x38, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x54, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x64, 0x76, 0x69, 0x63,
+	0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x50, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x33, 0x34, 0x30, 0x30, 0x31, 0x30,
+	0x31, 0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x54, 0x61, 0x78, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x5c, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x33, 0x38, 0x30, 0x30,
+	0x31, 0x30, 0x31, 0x12, 0x48, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x54, 0x61, 0x78, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x64, 0x76, 0x69, 0x63,
+	0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe4, 0x04,
+	0x0a, 0x1c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x56, 0x30, 0x31, 0x12, 0x46,
+	0x0a, 0x1c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x1b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x13, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x5f,
+	0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74,
+	0x65, 0x31, 0x52, 0x11, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x73, 0x69, 0x73, 0x12, 0x3a, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x33, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x31, 0x52, 0x07, 0x64,
+	0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x5d, 0x0a, 0x14, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x44, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x13, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x4e, 0x0a, 0x0f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x31, 0x43,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x0e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1f, 0x0a, 0x1d, 0x5f, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x94, 0x04, 0x0a, 0x1d, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x12, 0x3c, 0x0a, 0x17, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x16, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x1c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x1b, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0f, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x31, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x0e, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x4b, 0x0a, 0x10, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x6e, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x31, 0x52, 0x0f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x6e,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1f, 0x0a, 0x1d, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x2d,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x31, 0x12, 0x3b, 0x0a,
+	0x17, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x15, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x16, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x15, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0xb8, 0x02, 0x0a, 0x1f, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x54, 0x61, 0x78, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x64, 0x76, 0x69, 0x63,
+	0x65, 0x56, 0x30, 0x31, 0x12, 0x5f, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x54, 0x61, 0x78, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x31, 0x52, 0x12, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x61, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x54, 0x61, 0x78, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x31, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0x80, 0x02, 0x0a, 0x13,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x54, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x56, 0x30, 0x31, 0x12, 0x5b, 0x0a, 0x19, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x74,
+	0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x16, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x54, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x39, 0x0a, 0x0a, 0x74, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x31,
+	0x52, 0x09, 0x74, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xc6,
+	0x02, 0x0a, 0x33, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x77, 0x61,
+	0x70, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x12, 0x4d, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6d, 0x0a, 0x1d, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x46, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x77, 0x61,
+	0x70, 0x32, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x1a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xc2, 0x02, 0x0a, 0x32, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x6e, 0x69, 0x67, 0x68, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x77, 0x61, 0x70, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x12, 0x4d,
+	0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52,
+	0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6a, 0x0a,
+	0x1c, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x53, 0x77, 0x61, 0x70, 0x33, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x19,
+	0x6f, 0x76, 0x65, 0x72, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xb0, 0x02, 0x0a,
+	0x2c, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x65, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x12, 0x4d, 0x0a,
+	0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x0c,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5e, 0x0a, 0x15,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x65, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x33, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x13, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x51, 0x0a, 0x12,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22,
+	0xc0, 0x02, 0x0a, 0x2b, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x31, 0x12,
+	0x60, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x12, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x5c, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0x52, 0x11, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52,
+	0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0xb8, 0x02, 0x0a, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x55, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x56, 0x30, 0x31, 0x12, 0x4d, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x64, 0x0a, 0x17, 0x75, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65,
+	0x64, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x55, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x33, 0x43, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x52, 0x15, 0x75, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xb8, 0x02,
+	0x0a, 0x2b, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x31, 0x12, 0x49, 0x0a,
+	0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x52, 0x0b, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6b, 0x0a, 0x18, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x67, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x17, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xf4, 0x04, 0x0a, 0x31, 0x52, 0x65, 0x67,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x4f,
+	0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52,
+	0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x60, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x32, 0x33, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x14, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x54, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32,
+	0x34, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69,
+	0x6e, 0x67, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x72, 0x0a, 0x23, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x33, 0x52, 0x20, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x56, 0x0a, 0x12, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x39,
+	0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x6a, 0x0a, 0x1f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x32, 0x52, 0x1c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22,
+	0xdb, 0x03, 0x0a, 0x30, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x56, 0x30, 0x31, 0x12, 0x4f, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x33, 0x43, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x52, 0x14, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x64, 0x0a, 0x1a, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x32, 0x52, 0x18, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x8d, 0x01,
+	0x0a, 0x2a, 0x69, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x32, 0x52, 0x27, 0x69, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9c, 0x03,
+	0x0a, 0x24, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x56, 0x30, 0x31, 0x12, 0x4f, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x33, 0x43, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x52, 0x14, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x0d, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x6e,
+	0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x31, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x74, 0x0a, 0x1d, 0x69, 0x6e, 0x64, 0x69, 0x76, 0x69,
+	0x64, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x31, 0x52,
+	0x1b, 0x69, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb9, 0x03, 0x0a,
+	0x1e, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x32, 0x12,
+	0x4f, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x38,
+	0x52, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x54, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x33, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x60, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x33, 0x43, 0x68, 0x6f, 0x69,
+	0x63, 0x65, 0x52, 0x14, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x34, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x0e,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x38,
+	0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x09, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x75, 0x64, 0x61, 0x70, 0x72, 0x61, 0x6d, 0x61,
+	0x2f, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_iso20022_auth_proto_rawDescOnce sync.Once
+	file_iso20022_auth_proto_rawDescData []byte
+)
+
+func file_iso20022_auth_proto_rawDescGZIP() []byte {
+	file_iso20022_auth_proto_rawDescOnce.Do(func() {
+		file_iso20022_auth_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_iso20022_auth_proto_rawDesc), len(file_iso20022_auth_proto_rawDesc)))
+	})
+	return file_iso20022_auth_proto_rawDescData
+}
+
+var file_iso20022_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_iso20022_auth_proto_goTypes = []any{
+	(*ContractRegistrationAmendmentRequestV01)(nil),             // 0: iso20022.auth.ContractRegistrationAmendmentRequestV01
+	(*ContractRegistrationClosureRequestV01)(nil),               // 1: iso20022.auth.ContractRegistrationClosureRequestV01
+	(*ContractRegistrationConfirmationV01)(nil),                 // 2: iso20022.auth.ContractRegistrationConfirmationV01
+	(*ContractRegistrationRequestV01)(nil),                      // 3: iso20022.auth.ContractRegistrationRequestV01
+	(*ContractRegistrationStatementRequestV01)(nil),             // 4: iso20022.auth.ContractRegistrationStatementRequestV01
+	(*ContractRegistrationStatementV01)(nil),                    // 5: iso20022.auth.ContractRegistrationStatementV01
+	(*CurrencyControlRequestOrLetterV01)(nil),                   // 6: iso20022.auth.CurrencyControlRequestOrLetterV01
+	(*CurrencyControlStatusAdviceV01)(nil),                      // 7: iso20022.auth.CurrencyControlStatusAdviceV01
+	(*CurrencyControlSupportingDocumentDeliveryV01)(nil),        // 8: iso20022.auth.CurrencyControlSupportingDocumentDeliveryV01
+	(*Document00100101)(nil),                                    // 9: iso20022.auth.Document00100101
+	(*Document00200101)(nil),                                    // 10: iso20022.auth.Document00200101
+	(*Document00300101)(nil),                                    // 11: iso20022.auth.Document00300101
+	(*Document00800102)(nil),                                    // 12: iso20022.auth.Document00800102
+	(*Document00900102)(nil),                                    // 13: iso20022.auth.Document00900102
+	(*Document01000101)(nil),                                    // 14: iso20022.auth.Document01000101
+	(*Document01100101)(nil),                                    // 15: iso20022.auth.Document01100101
+	(*Document01200101)(nil),                                    // 16: iso20022.auth.Document01200101
+	(*Document01300101)(nil),                                    // 17: iso20022.auth.Document01300101
+	(*Document01400101)(nil),                                    // 18: iso20022.auth.Document01400101
+	(*Document01500101)(nil),                                    // 19: iso20022.auth.Document01500101
+	(*Document01800101)(nil),                                    // 20: iso20022.auth.Document01800101
+	(*Document01900101)(nil),                                    // 21: iso20022.auth.Document01900101
+	(*Document02000101)(nil),                                    // 22: iso20022.auth.Document02000101
+	(*Document02100101)(nil),                                    // 23: iso20022.auth.Document02100101
+	(*Document02200101)(nil),                                    // 24: iso20022.auth.Document02200101
+	(*Document02300101)(nil),                                    // 25: iso20022.auth.Document02300101
+	(*Document02400101)(nil),                                    // 26: iso20022.auth.Document02400101
+	(*Document02500101)(nil),                                    // 27: iso20022.auth.Document02500101
+	(*Document02600101)(nil),                                    // 28: iso20022.auth.Document02600101
+	(*Document02700101)(nil),                                    // 29: iso20022.auth.Document02700101
+	(*Document02800101)(nil),                                    // 30: iso20022.auth.Document02800101
+	(*Document03400101)(nil),                                    // 31: iso20022.auth.Document03400101
+	(*Document03800101)(nil),                                    // 32: iso20022.auth.Document03800101
+	(*InformationRequestOpeningV01)(nil),                        // 33: iso20022.auth.InformationRequestOpeningV01
+	(*InformationRequestResponseV01)(nil),                       // 34: iso20022.auth.InformationRequestResponseV01
+	(*InformationRequestStatusChangeNotificationV01)(nil),       // 35: iso20022.auth.InformationRequestStatusChangeNotificationV01
+	(*InvoiceTaxReportStatusAdviceV01)(nil),                     // 36: iso20022.auth.InvoiceTaxReportStatusAdviceV01
+	(*InvoiceTaxReportV01)(nil),                                 // 37: iso20022.auth.InvoiceTaxReportV01
+	(*MoneyMarketForeignExchangeSwapsStatisticalReportV01)(nil), // 38: iso20022.auth.MoneyMarketForeignExchangeSwapsStatisticalReportV01
+	(*MoneyMarketOvernightIndexSwapsStatisticalReportV01)(nil),  // 39: iso20022.auth.MoneyMarketOvernightIndexSwapsStatisticalReportV01
+	(*MoneyMarketSecuredMarketStatisticalReportV01)(nil),        // 40: iso20022.auth.MoneyMarketSecuredMarketStatisticalReportV01
+	(*MoneyMarketStatisticalReportStatusAdviceV01)(nil),         // 41: iso20022.auth.MoneyMarketStatisticalReportStatusAdviceV01
+	(*MoneyMarketUnsecuredMarketStatisticalReportV01)(nil),      // 42: iso20022.auth.MoneyMarketUnsecuredMarketStatisticalReportV01
+	(*PaymentRegulatoryInformationNotificationV01)(nil),         // 43: iso20022.auth.PaymentRegulatoryInformationNotificationV01
+	(*RegulatoryTransactionReportCancellationRequestV02)(nil),   // 44: iso20022.auth.RegulatoryTransactionReportCancellationRequestV02
+	(*RegulatoryTransactionReportCancellationStatusV01)(nil),    // 45: iso20022.auth.RegulatoryTransactionReportCancellationStatusV01
+	(*RegulatoryTransactionReportStatusV01)(nil),                // 46: iso20022.auth.RegulatoryTransactionReportStatusV01
+	(*RegulatoryTransactionReportV02)(nil),                      // 47: iso20022.auth.RegulatoryTransactionReportV02
+	(*modelpb.CurrencyControlHeader1)(nil),                      // 48: iso20022.model.CurrencyControlHeader1
+	(*modelpb.RegisteredContract1)(nil),                         // 49: iso20022.model.RegisteredContract1
+	(*modelpb.SupplementaryData1)(nil),                          // 50: iso20022.model.SupplementaryData1
+	(*modelpb.RegisteredContract2)(nil),                         // 51: iso20022.model.RegisteredContract2
+	(*modelpb.CurrencyControlHeader2)(nil),                      // 52: iso20022.model.CurrencyControlHeader2
+	(*modelpb.RegisteredContract4)(nil),                         // 53: iso20022.model.RegisteredContract4
+	(*modelpb.ContractRegistration1)(nil),                       // 54: iso20022.model.ContractRegistration1
+	(*modelpb.ContractRegistrationStatementRequest1)(nil),       // 55: iso20022.model.ContractRegistrationStatementRequest1
+	(*modelpb.ContractRegistrationStatement1)(nil),              // 56: iso20022.model.ContractRegistrationStatement1
+	(*modelpb.CurrencyControlHeader3)(nil),                      // 57: iso20022.model.CurrencyControlHeader3
+	(*modelpb.SupportingDocumentRequestOrLetter1)(nil),          // 58: iso20022.model.SupportingDocumentRequestOrLetter1
+	(*modelpb.CurrencyControlGroupStatus1)(nil),                 // 59: iso20022.model.CurrencyControlGroupStatus1
+	(*modelpb.CurrencyControlPackageStatus1)(nil),               // 60: iso20022.model.CurrencyControlPackageStatus1
+	(*modelpb.SupportingDocument1)(nil),                         // 61: iso20022.model.SupportingDocument1
+	(*modelpb.LegalMandate1)(nil),                               // 62: iso20022.model.LegalMandate1
+	(*modelpb.DueDate1)(nil),                                    // 63: iso20022.model.DueDate1
+	(*modelpb.DateOrDateTimePeriodChoice)(nil),                  // 64: iso20022.model.DateOrDateTimePeriodChoice
+	(*modelpb.SearchCriteria1Choice)(nil),                       // 65: iso20022.model.SearchCriteria1Choice
+	(*modelpb.ReturnIndicator1)(nil),                            // 66: iso20022.model.ReturnIndicator1
+	(*modelpb.InvoiceTaxStatusReportHeader1)(nil),               // 67: iso20022.model.InvoiceTaxStatusReportHeader1
+	(*modelpb.InvoiceTaxReportTransactionStatus1)(nil),          // 68: iso20022.model.InvoiceTaxReportTransactionStatus1
+	(*modelpb.TaxReportHeader1)(nil),                            // 69: iso20022.model.TaxReportHeader1
+	(*modelpb.TaxReport1)(nil),                                  // 70: iso20022.model.TaxReport1
+	(*modelpb.MoneyMarketReportHeader1)(nil),                    // 71: iso20022.model.MoneyMarketReportHeader1
+	(*modelpb.ForeignExchangeSwap2Choice)(nil),                  // 72: iso20022.model.ForeignExchangeSwap2Choice
+	(*modelpb.OvernightIndexSwap3Choice)(nil),                   // 73: iso20022.model.OvernightIndexSwap3Choice
+	(*modelpb.SecuredMarketReport3Choice)(nil),                  // 74: iso20022.model.SecuredMarketReport3Choice
+	(*modelpb.MoneyMarketStatusReportHeader1)(nil),              // 75: iso20022.model.MoneyMarketStatusReportHeader1
+	(*modelpb.MoneyMarketTransactionStatus2)(nil),               // 76: iso20022.model.MoneyMarketTransactionStatus2
+	(*modelpb.UnsecuredMarketReport3Choice)(nil),                // 77: iso20022.model.UnsecuredMarketReport3Choice
+	(*modelpb.RegulatoryReportingNotification1)(nil),            // 78: iso20022.model.RegulatoryReportingNotification1
+	(*modelpb.DocumentIdentification8)(nil),                     // 79: iso20022.model.DocumentIdentification8
+	(*modelpb.PartyIdentification23Choice)(nil),                 // 80: iso20022.model.PartyIdentification23Choice
+	(*modelpb.PartyIdentification24Choice)(nil),                 // 81: iso20022.model.PartyIdentification24Choice
+	(*modelpb.TransactionDetails3)(nil),                         // 82: iso20022.model.TransactionDetails3
+	(*modelpb.DocumentIdentification9)(nil),                     // 83: iso20022.model.DocumentIdentification9
+	(*modelpb.TransactionDetails2)(nil),                         // 84: iso20022.model.TransactionDetails2
+	(*modelpb.ReportStatusAndReason2)(nil),                      // 85: iso20022.model.ReportStatusAndReason2
+	(*modelpb.TradeTransactionStatusAndReason2)(nil),            // 86: iso20022.model.TradeTransactionStatusAndReason2
+	(*modelpb.ReportStatusAndReason1)(nil),                      // 87: iso20022.model.ReportStatusAndReason1
+	(*modelpb.TradeTransactionStatusAndReason1)(nil),            // 88: iso20022.model.TradeTransactionStatusAndReason1
+	(*modelpb.Extension1)(nil),                                  // 89: iso20022.model.Extension1
+}
+var file_iso20022_auth_proto_depIdxs = []int32{
+	48,  // 0: iso20022.auth.ContractRegistrationAmendmentRequestV01.group_header:type_name -> iso20022.model.CurrencyControlHeader1
+	49,  // 1: iso20022.auth.ContractRegistrationAmendmentRequestV01.contract_registration_amendment:type_name -> iso20022.model.RegisteredContract1
+	50,  // 2: iso20022.auth.ContractRegistrationAmendmentRequestV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	48,  // 3: iso20022.auth.ContractRegistrationClosureRequestV01.group_header:type_name -> iso20022.model.CurrencyControlHeader1
+	51,  // 4: iso20022.auth.ContractRegistrationClosureRequestV01.registered_contract_closure:type_name -> iso20022.model.RegisteredContract2
+	50,  // 5: iso20022.auth.ContractRegistrationClosureRequestV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	52,  // 6: iso20022.auth.ContractRegistrationConfirmationV01.group_header:type_name -> iso20022.model.CurrencyControlHeader2
+	53,  // 7: iso20022.auth.ContractRegistrationConfirmationV01.registered_contract:type_name -> iso20022.model.RegisteredContract4
+	50,  // 8: iso20022.auth.ContractRegistrationConfirmationV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	48,  // 9: iso20022.auth.ContractRegistrationRequestV01.group_header:type_name -> iso20022.model.CurrencyControlHeader1
+	54,  // 10: iso20022.auth.ContractRegistrationRequestV01.contract_registration:type_name -> iso20022.model.ContractRegistration1
+	50,  // 11: iso20022.auth.ContractRegistrationRequestV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	48,  // 12: iso20022.auth.ContractRegistrationStatementRequestV01.group_header:type_name -> iso20022.model.CurrencyControlHeader1
+	55,  // 13: iso20022.auth.ContractRegistrationStatementRequestV01.statement_request:type_name -> iso20022.model.ContractRegistrationStatementRequest1
+	50,  // 14: iso20022.auth.ContractRegistrationStatementRequestV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	52,  // 15: iso20022.auth.ContractRegistrationStatementV01.group_header:type_name -> iso20022.model.CurrencyControlHeader2
+	56,  // 16: iso20022.auth.ContractRegistrationStatementV01.statement:type_name -> iso20022.model.ContractRegistrationStatement1
+	50,  // 17: iso20022.auth.ContractRegistrationStatementV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	57,  // 18: iso20022.auth.CurrencyControlRequestOrLetterV01.group_header:type_name -> iso20022.model.CurrencyControlHeader3
+	58,  // 19: iso20022.auth.CurrencyControlRequestOrLetterV01.request_or_letter:type_name -> iso20022.model.SupportingDocumentRequestOrLetter1
+	50,  // 20: iso20022.auth.CurrencyControlRequestOrLetterV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	52,  // 21: iso20022.auth.CurrencyControlStatusAdviceV01.group_header:type_name -> iso20022.model.CurrencyControlHeader2
+	59,  // 22: iso20022.auth.CurrencyControlStatusAdviceV01.group_status:type_name -> iso20022.model.CurrencyControlGroupStatus1
+	60,  // 23: iso20022.auth.CurrencyControlStatusAdviceV01.package_status:type_name -> iso20022.model.CurrencyControlPackageStatus1
+	50,  // 24: iso20022.auth.CurrencyControlStatusAdviceV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	57,  // 25: iso20022.auth.CurrencyControlSupportingDocumentDeliveryV01.group_header:type_name -> iso20022.model.CurrencyControlHeader3
+	61,  // 26: iso20022.auth.CurrencyControlSupportingDocumentDeliveryV01.supporting_document:type_name -> iso20022.model.SupportingDocument1
+	50,  // 27: iso20022.auth.CurrencyControlSupportingDocumentDeliveryV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	33,  // 28: iso20022.auth.Document00100101.message:type_name -> iso20022.auth.InformationRequestOpeningV01
+	34,  // 29: iso20022.auth.Document00200101.message:type_name -> iso20022.auth.InformationRequestResponseV01
+	35,  // 30: iso20022.auth.Document00300101.message:type_name -> iso20022.auth.InformationRequestStatusChangeNotificationV01
+	47,  // 31: iso20022.auth.Document00800102.message:type_name -> iso20022.auth.RegulatoryTransactionReportV02
+	44,  // 32: iso20022.auth.Document00900102.message:type_name -> iso20022.auth.RegulatoryTransactionReportCancellationRequestV02
+	46,  // 33: iso20022.auth.Document01000101.message:type_name -> iso20022.auth.RegulatoryTransactionReportStatusV01
+	45,  // 34: iso20022.auth.Document01100101.message:type_name -> iso20022.auth.RegulatoryTransactionReportCancellationStatusV01
+	40,  // 35: iso20022.auth.Document01200101.message:type_name -> iso20022.auth.MoneyMarketSecuredMarketStatisticalReportV01
+	42,  // 36: iso20022.auth.Document01300101.message:type_name -> iso20022.auth.MoneyMarketUnsecuredMarketStatisticalReportV01
+	38,  // 37: iso20022.auth.Document01400101.message:type_name -> iso20022.auth.MoneyMarketForeignExchangeSwapsStatisticalReportV01
+	39,  // 38: iso20022.auth.Document01500101.message:type_name -> iso20022.auth.MoneyMarketOvernightIndexSwapsStatisticalReportV01
+	3,   // 39: iso20022.auth.Document01800101.message:type_name -> iso20022.auth.ContractRegistrationRequestV01
+	2,   // 40: iso20022.auth.Document01900101.message:type_name -> iso20022.auth.ContractRegistrationConfirmationV01
+	1,   // 41: iso20022.auth.Document02000101.message:type_name -> iso20022.auth.ContractRegistrationClosureRequestV01
+	0,   // 42: iso20022.auth.Document02100101.message:type_name -> iso20022.auth.ContractRegistrationAmendmentRequestV01
+	5,   // 43: iso20022.auth.Document02200101.message:type_name -> iso20022.auth.ContractRegistrationStatementV01
+	4,   // 44: iso20022.auth.Document02300101.message:type_name -> iso20022.auth.ContractRegistrationStatementRequestV01
+	43,  // 45: iso20022.auth.Document02400101.message:type_name -> iso20022.auth.PaymentRegulatoryInformationNotificationV01
+	8,   // 46: iso20022.auth.Document02500101.message:type_name -> iso20022.auth.CurrencyControlSupportingDocumentDeliveryV01
+	6,   // 47: iso20022.auth.Document02600101.message:type_name -> iso20022.auth.CurrencyControlRequestOrLetterV01
+	7,   // 48: iso20022.auth.Document02700101.message:type_name -> iso20022.auth.CurrencyControlStatusAdviceV01
+	41,  // 49: iso20022.auth.Document02800101.message:type_name -> iso20022.auth.MoneyMarketStatisticalReportStatusAdviceV01
+	37,  // 50: iso20022.auth.Document03400101.message:type_name -> iso20022.auth.InvoiceTaxReportV01
+	36,  // 51: iso20022.auth.Document03800101.message:type_name -> iso20022.auth.InvoiceTaxReportStatusAdviceV01
+	62,  // 52: iso20022.auth.InformationRequestOpeningV01.legal_mandate_basis:type_name -> iso20022.model.LegalMandate1
+	63,  // 53: iso20022.auth.InformationRequestOpeningV01.due_date:type_name -> iso20022.model.DueDate1
+	64,  // 54: iso20022.auth.InformationRequestOpeningV01.investigation_period:type_name -> iso20022.model.DateOrDateTimePeriodChoice
+	65,  // 55: iso20022.auth.InformationRequestOpeningV01.search_criteria:type_name -> iso20022.model.SearchCriteria1Choice
+	50,  // 56: iso20022.auth.InformationRequestOpeningV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	65,  // 57: iso20022.auth.InformationRequestResponseV01.search_criteria:type_name -> iso20022.model.SearchCriteria1Choice
+	66,  // 58: iso20022.auth.InformationRequestResponseV01.return_indicator:type_name -> iso20022.model.ReturnIndicator1
+	50,  // 59: iso20022.auth.InformationRequestResponseV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	50,  // 60: iso20022.auth.InformationRequestStatusChangeNotificationV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	67,  // 61: iso20022.auth.InvoiceTaxReportStatusAdviceV01.status_report_header:type_name -> iso20022.model.InvoiceTaxStatusReportHeader1
+	68,  // 62: iso20022.auth.InvoiceTaxReportStatusAdviceV01.transaction_status:type_name -> iso20022.model.InvoiceTaxReportTransactionStatus1
+	50,  // 63: iso20022.auth.InvoiceTaxReportStatusAdviceV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	69,  // 64: iso20022.auth.InvoiceTaxReportV01.invoice_tax_report_header:type_name -> iso20022.model.TaxReportHeader1
+	70,  // 65: iso20022.auth.InvoiceTaxReportV01.tax_report:type_name -> iso20022.model.TaxReport1
+	50,  // 66: iso20022.auth.InvoiceTaxReportV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	71,  // 67: iso20022.auth.MoneyMarketForeignExchangeSwapsStatisticalReportV01.report_header:type_name -> iso20022.model.MoneyMarketReportHeader1
+	72,  // 68: iso20022.auth.MoneyMarketForeignExchangeSwapsStatisticalReportV01.foreign_exchange_swaps_report:type_name -> iso20022.model.ForeignExchangeSwap2Choice
+	50,  // 69: iso20022.auth.MoneyMarketForeignExchangeSwapsStatisticalReportV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	71,  // 70: iso20022.auth.MoneyMarketOvernightIndexSwapsStatisticalReportV01.report_header:type_name -> iso20022.model.MoneyMarketReportHeader1
+	73,  // 71: iso20022.auth.MoneyMarketOvernightIndexSwapsStatisticalReportV01.overnight_index_swaps_report:type_name -> iso20022.model.OvernightIndexSwap3Choice
+	50,  // 72: iso20022.auth.MoneyMarketOvernightIndexSwapsStatisticalReportV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	71,  // 73: iso20022.auth.MoneyMarketSecuredMarketStatisticalReportV01.report_header:type_name -> iso20022.model.MoneyMarketReportHeader1
+	74,  // 74: iso20022.auth.MoneyMarketSecuredMarketStatisticalReportV01.secured_market_report:type_name -> iso20022.model.SecuredMarketReport3Choice
+	50,  // 75: iso20022.auth.MoneyMarketSecuredMarketStatisticalReportV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	75,  // 76: iso20022.auth.MoneyMarketStatisticalReportStatusAdviceV01.status_report_header:type_name -> iso20022.model.MoneyMarketStatusReportHeader1
+	76,  // 77: iso20022.auth.MoneyMarketStatisticalReportStatusAdviceV01.transaction_status:type_name -> iso20022.model.MoneyMarketTransactionStatus2
+	50,  // 78: iso20022.auth.MoneyMarketStatisticalReportStatusAdviceV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	71,  // 79: iso20022.auth.MoneyMarketUnsecuredMarketStatisticalReportV01.report_header:type_name -> iso20022.model.MoneyMarketReportHeader1
+	77,  // 80: iso20022.auth.MoneyMarketUnsecuredMarketStatisticalReportV01.unsecured_market_report:type_name -> iso20022.model.UnsecuredMarketReport3Choice
+	50,  // 81: iso20022.auth.MoneyMarketUnsecuredMarketStatisticalReportV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	57,  // 82: iso20022.auth.PaymentRegulatoryInformationNotificationV01.group_header:type_name -> iso20022.model.CurrencyControlHeader3
+	78,  // 83: iso20022.auth.PaymentRegulatoryInformationNotificationV01.transaction_notification:type_name -> iso20022.model.RegulatoryReportingNotification1
+	50,  // 84: iso20022.auth.PaymentRegulatoryInformationNotificationV01.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	79,  // 85: iso20022.auth.RegulatoryTransactionReportCancellationRequestV02.identification:type_name -> iso20022.model.DocumentIdentification8
+	80,  // 86: iso20022.auth.RegulatoryTransactionReportCancellationRequestV02.reporting_institution:type_name -> iso20022.model.PartyIdentification23Choice
+	81,  // 87: iso20022.auth.RegulatoryTransactionReportCancellationRequestV02.reporting_agent:type_name -> iso20022.model.PartyIdentification24Choice
+	82,  // 88: iso20022.auth.RegulatoryTransactionReportCancellationRequestV02.cancellation_by_transaction_details:type_name -> iso20022.model.TransactionDetails3
+	83,  // 89: iso20022.auth.RegulatoryTransactionReportCancellationRequestV02.previous_reference:type_name -> iso20022.model.DocumentIdentification9
+	84,  // 90: iso20022.auth.RegulatoryTransactionReportCancellationRequestV02.cancellation_by_trade_reference:type_name -> iso20022.model.TransactionDetails2
+	79,  // 91: iso20022.auth.RegulatoryTransactionReportCancellationStatusV01.identification:type_name -> iso20022.model.DocumentIdentification8
+	80,  // 92: iso20022.auth.RegulatoryTransactionReportCancellationStatusV01.reporting_institution:type_name -> iso20022.model.PartyIdentification23Choice
+	85,  // 93: iso20022.auth.RegulatoryTransactionReportCancellationStatusV01.report_cancellation_status:type_name -> iso20022.model.ReportStatusAndReason2
+	86,  // 94: iso20022.auth.RegulatoryTransactionReportCancellationStatusV01.individual_transaction_cancellation_status:type_name -> iso20022.model.TradeTransactionStatusAndReason2
+	79,  // 95: iso20022.auth.RegulatoryTransactionReportStatusV01.identification:type_name -> iso20022.model.DocumentIdentification8
+	80,  // 96: iso20022.auth.RegulatoryTransactionReportStatusV01.reporting_institution:type_name -> iso20022.model.PartyIdentification23Choice
+	87,  // 97: iso20022.auth.RegulatoryTransactionReportStatusV01.report_status:type_name -> iso20022.model.ReportStatusAndReason1
+	88,  // 98: iso20022.auth.RegulatoryTransactionReportStatusV01.individual_transaction_status:type_name -> iso20022.model.TradeTransactionStatusAndReason1
+	79,  // 99: iso20022.auth.RegulatoryTransactionReportV02.identification:type_name -> iso20022.model.DocumentIdentification8
+	82,  // 100: iso20022.auth.RegulatoryTransactionReportV02.transaction_details:type_name -> iso20022.model.TransactionDetails3
+	80,  // 101: iso20022.auth.RegulatoryTransactionReportV02.reporting_institution:type_name -> iso20022.model.PartyIdentification23Choice
+	81,  // 102: iso20022.auth.RegulatoryTransactionReportV02.reporting_agent:type_name -> iso20022.model.PartyIdentification24Choice
+	89,  // 103: iso20022.auth.RegulatoryTransactionReportV02.extension:type_name -> iso20022.model.Extension1
+	104, // [104:104] is the sub-list for method output_type
+	104, // [104:104] is the sub-list for method input_type
+	104, // [104:104] is the sub-list for extension type_name
+	104, // [104:104] is the sub-list for extension extendee
+	0,   // [0:104] is the sub-list for field type_name
+}
+
+func init() { file_iso20022_auth_proto_init() }
+func file_iso20022_auth_proto_init() {
+	if File_iso20022_auth_proto != nil {
+		return
+	}
+	file_iso20022_auth_proto_msgTypes[33].OneofWrappers = []any{}
+	file_iso20022_auth_proto_msgTypes[34].OneofWrappers = []any{}
+	file_iso20022_auth_proto_msgTypes[35].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_iso20022_auth_proto_rawDesc), len(file_iso20022_auth_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   48,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_iso20022_auth_proto_goTypes,
+		DependencyIndexes: file_iso20022_auth_proto_depIdxs,
+		MessageInfos:      file_iso20022_auth_proto_msgTypes,
+	}.Build()
+	File_iso20022_auth_proto = out.File
+	file_iso20022_auth_proto_goTypes = nil
+	file_iso20022_auth_proto_depIdxs = nil
+}
@@ -0,0 +1,10993 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: iso20022/caaa.proto
+
+package caaapb
+
+import (
+	modelpb "github.com/yudaprama/iso20022/proto/iso20022/modelpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+// Scope
+// The AcceptorAuthorisationRequest message is sent by the card acceptor to the acquirer or its agent when an online authorisation is required for the card payment transaction.
+// Usage
+// The AcceptorAuthorisationRequest message may embed the information required for transferring to the acquirer the data needed to perform the financial settlement of the transaction (capture). An intermediary agent may act on behalf of the card acceptor or of the acquirer in providing additional functionality such as: switching to different acquirers, loyalty processing or management of the acceptor system.
+type AcceptorAuthorisationRequestV01 struct {
+	state                protoimpl.MessageState                 `protogen:"open.v1"`
+	Header               *modelpb.Header1                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AuthorisationRequest *modelpb.AcceptorAuthorisationRequest1 `protobuf:"bytes,2,opt,name=authorisation_request,json=authorisationRequest,proto3" json:"authorisation_request,omitempty"`
+	SecurityTrailer      *modelpb.ContentInformationType3       `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AcceptorAuthorisationRequestV01) Reset() {
+	*x = AcceptorAuthorisationRequestV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorAuthorisationRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorAuthorisationRequestV01) ProtoMessage() {}
+
+func (x *AcceptorAuthorisationRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorAuthorisationRequestV01.ProtoReflect.Descriptor instead.
+func (*AcceptorAuthorisationRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AcceptorAuthorisationRequestV01) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationRequestV01) GetAuthorisationRequest() *modelpb.AcceptorAuthorisationRequest1 {
+	if x != nil {
+		return x.AuthorisationRequest
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationRequestV01) GetSecurityTrailer() *modelpb.ContentInformationType3 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorAuthorisationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check with the issuer (or its agent) that the account associated to the card has the resources to fund the payment. This checking will include validation of the card data and any additional transaction data provided.
+type AcceptorAuthorisationRequestV02 struct {
+	state                protoimpl.MessageState                 `protogen:"open.v1"`
+	Header               *modelpb.Header1                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AuthorisationRequest *modelpb.AcceptorAuthorisationRequest2 `protobuf:"bytes,2,opt,name=authorisation_request,json=authorisationRequest,proto3" json:"authorisation_request,omitempty"`
+	SecurityTrailer      *modelpb.ContentInformationType6       `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AcceptorAuthorisationRequestV02) Reset() {
+	*x = AcceptorAuthorisationRequestV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorAuthorisationRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorAuthorisationRequestV02) ProtoMessage() {}
+
+func (x *AcceptorAuthorisationRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorAuthorisationRequestV02.ProtoReflect.Descriptor instead.
+func (*AcceptorAuthorisationRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AcceptorAuthorisationRequestV02) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationRequestV02) GetAuthorisationRequest() *modelpb.AcceptorAuthorisationRequest2 {
+	if x != nil {
+		return x.AuthorisationRequest
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationRequestV02) GetSecurityTrailer() *modelpb.ContentInformationType6 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorAuthorisationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check with the issuer (or its agent) that the account associated to the card has the resources to fund the payment. This checking will include validation of the card data and any additional transaction data provided.
+type AcceptorAuthorisationRequestV03 struct {
+	state                protoimpl.MessageState                 `protogen:"open.v1"`
+	Header               *modelpb.Header7                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AuthorisationRequest *modelpb.AcceptorAuthorisationRequest3 `protobuf:"bytes,2,opt,name=authorisation_request,json=authorisationRequest,proto3" json:"authorisation_request,omitempty"`
+	SecurityTrailer      *modelpb.ContentInformationType8       `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AcceptorAuthorisationRequestV03) Reset() {
+	*x = AcceptorAuthorisationRequestV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorAuthorisationRequestV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorAuthorisationRequestV03) ProtoMessage() {}
+
+func (x *AcceptorAuthorisationRequestV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorAuthorisationRequestV03.ProtoReflect.Descriptor instead.
+func (*AcceptorAuthorisationRequestV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AcceptorAuthorisationRequestV03) GetHeader() *modelpb.Header7 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationRequestV03) GetAuthorisationRequest() *modelpb.AcceptorAuthorisationRequest3 {
+	if x != nil {
+		return x.AuthorisationRequest
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationRequestV03) GetSecurityTrailer() *modelpb.ContentInformationType8 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorAuthorisationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check with the issuer (or its agent) that the account associated to the card has the resources to fund the payment. This checking will include validation of the card data and any additional transaction data provided.
+type AcceptorAuthorisationRequestV04 struct {
+	state                protoimpl.MessageState                 `protogen:"open.v1"`
+	Header               *modelpb.Header10                      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AuthorisationRequest *modelpb.AcceptorAuthorisationRequest4 `protobuf:"bytes,2,opt,name=authorisation_request,json=authorisationRequest,proto3" json:"authorisation_request,omitempty"`
+	SecurityTrailer      *modelpb.ContentInformationType11      `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AcceptorAuthorisationRequestV04) Reset() {
+	*x = AcceptorAuthorisationRequestV04{}
+	mi := &file_iso20022_caaa_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorAuthorisationRequestV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorAuthorisationRequestV04) ProtoMessage() {}
+
+func (x *AcceptorAuthorisationRequestV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorAuthorisationRequestV04.ProtoReflect.Descriptor instead.
+func (*AcceptorAuthorisationRequestV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AcceptorAuthorisationRequestV04) GetHeader() *modelpb.Header10 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationRequestV04) GetAuthorisationRequest() *modelpb.AcceptorAuthorisationRequest4 {
+	if x != nil {
+		return x.AuthorisationRequest
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationRequestV04) GetSecurityTrailer() *modelpb.ContentInformationType11 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorAuthorisationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check with the issuer (or its agent) that the account associated to the card has the resources to fund the payment. This checking will include validation of the card data and any additional transaction data provided.
+type AcceptorAuthorisationRequestV05 struct {
+	state                protoimpl.MessageState                 `protogen:"open.v1"`
+	Header               *modelpb.Header30                      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AuthorisationRequest *modelpb.AcceptorAuthorisationRequest5 `protobuf:"bytes,2,opt,name=authorisation_request,json=authorisationRequest,proto3" json:"authorisation_request,omitempty"`
+	SecurityTrailer      *modelpb.ContentInformationType15      `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AcceptorAuthorisationRequestV05) Reset() {
+	*x = AcceptorAuthorisationRequestV05{}
+	mi := &file_iso20022_caaa_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorAuthorisationRequestV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorAuthorisationRequestV05) ProtoMessage() {}
+
+func (x *AcceptorAuthorisationRequestV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorAuthorisationRequestV05.ProtoReflect.Descriptor instead.
+func (*AcceptorAuthorisationRequestV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AcceptorAuthorisationRequestV05) GetHeader() *modelpb.Header30 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationRequestV05) GetAuthorisationRequest() *modelpb.AcceptorAuthorisationRequest5 {
+	if x != nil {
+		return x.AuthorisationRequest
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationRequestV05) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The AcceptorAuthorisationResponse message is sent by the acquirer to inform the card acceptor of the outcome of the authorisation process. The message can be sent directly to the acceptor or through an agent.
+// Usage
+// The AcceptorAuthorisationResponse message is used to indicate one of the possible outcomes of an authorisation process:
+// - a successful authorisation;
+// - a decline from the acquirer for financial reasons;
+// - a decline from the acquirer for technical reasons (for instance, a timeout).
+type AcceptorAuthorisationResponseV01 struct {
+	state                 protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                *modelpb.Header1                        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AuthorisationResponse *modelpb.AcceptorAuthorisationResponse1 `protobuf:"bytes,2,opt,name=authorisation_response,json=authorisationResponse,proto3" json:"authorisation_response,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType3        `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorAuthorisationResponseV01) Reset() {
+	*x = AcceptorAuthorisationResponseV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorAuthorisationResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorAuthorisationResponseV01) ProtoMessage() {}
+
+func (x *AcceptorAuthorisationResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorAuthorisationResponseV01.ProtoReflect.Descriptor instead.
+func (*AcceptorAuthorisationResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AcceptorAuthorisationResponseV01) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationResponseV01) GetAuthorisationResponse() *modelpb.AcceptorAuthorisationResponse1 {
+	if x != nil {
+		return x.AuthorisationResponse
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType3 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorAuthorisationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the result of the validation made by issuer about the payment transaction.
+type AcceptorAuthorisationResponseV02 struct {
+	state                 protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                *modelpb.Header1                        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AuthorisationResponse *modelpb.AcceptorAuthorisationResponse2 `protobuf:"bytes,2,opt,name=authorisation_response,json=authorisationResponse,proto3" json:"authorisation_response,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType6        `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorAuthorisationResponseV02) Reset() {
+	*x = AcceptorAuthorisationResponseV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorAuthorisationResponseV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorAuthorisationResponseV02) ProtoMessage() {}
+
+func (x *AcceptorAuthorisationResponseV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorAuthorisationResponseV02.ProtoReflect.Descriptor instead.
+func (*AcceptorAuthorisationResponseV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AcceptorAuthorisationResponseV02) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationResponseV02) GetAuthorisationResponse() *modelpb.AcceptorAuthorisationResponse2 {
+	if x != nil {
+		return x.AuthorisationResponse
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationResponseV02) GetSecurityTrailer() *modelpb.ContentInformationType6 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorAuthorisationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the result of the validation made by issuer about the payment transaction.
+type AcceptorAuthorisationResponseV03 struct {
+	state                 protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                *modelpb.Header7                        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AuthorisationResponse *modelpb.AcceptorAuthorisationResponse3 `protobuf:"bytes,2,opt,name=authorisation_response,json=authorisationResponse,proto3" json:"authorisation_response,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType8        `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorAuthorisationResponseV03) Reset() {
+	*x = AcceptorAuthorisationResponseV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorAuthorisationResponseV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorAuthorisationResponseV03) ProtoMessage() {}
+
+func (x *AcceptorAuthorisationResponseV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorAuthorisationResponseV03.ProtoReflect.Descriptor instead.
+func (*AcceptorAuthorisationResponseV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AcceptorAuthorisationResponseV03) GetHeader() *modelpb.Header7 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationResponseV03) GetAuthorisationResponse() *modelpb.AcceptorAuthorisationResponse3 {
+	if x != nil {
+		return x.AuthorisationResponse
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationResponseV03) GetSecurityTrailer() *modelpb.ContentInformationType8 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorAuthorisationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the result of the validation made by issuer about the payment transaction.
+type AcceptorAuthorisationResponseV04 struct {
+	state                 protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                *modelpb.Header10                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AuthorisationResponse *modelpb.AcceptorAuthorisationResponse4 `protobuf:"bytes,2,opt,name=authorisation_response,json=authorisationResponse,proto3" json:"authorisation_response,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType11       `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorAuthorisationResponseV04) Reset() {
+	*x = AcceptorAuthorisationResponseV04{}
+	mi := &file_iso20022_caaa_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorAuthorisationResponseV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorAuthorisationResponseV04) ProtoMessage() {}
+
+func (x *AcceptorAuthorisationResponseV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorAuthorisationResponseV04.ProtoReflect.Descriptor instead.
+func (*AcceptorAuthorisationResponseV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AcceptorAuthorisationResponseV04) GetHeader() *modelpb.Header10 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationResponseV04) GetAuthorisationResponse() *modelpb.AcceptorAuthorisationResponse4 {
+	if x != nil {
+		return x.AuthorisationResponse
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationResponseV04) GetSecurityTrailer() *modelpb.ContentInformationType11 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorAuthorisationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the result of the validation made by issuer about the payment transaction.
+type AcceptorAuthorisationResponseV05 struct {
+	state                 protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                *modelpb.Header30                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AuthorisationResponse *modelpb.AcceptorAuthorisationResponse5 `protobuf:"bytes,2,opt,name=authorisation_response,json=authorisationResponse,proto3" json:"authorisation_response,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType15       `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorAuthorisationResponseV05) Reset() {
+	*x = AcceptorAuthorisationResponseV05{}
+	mi := &file_iso20022_caaa_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorAuthorisationResponseV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorAuthorisationResponseV05) ProtoMessage() {}
+
+func (x *AcceptorAuthorisationResponseV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorAuthorisationResponseV05.ProtoReflect.Descriptor instead.
+func (*AcceptorAuthorisationResponseV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AcceptorAuthorisationResponseV05) GetHeader() *modelpb.Header30 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationResponseV05) GetAuthorisationResponse() *modelpb.AcceptorAuthorisationResponse5 {
+	if x != nil {
+		return x.AuthorisationResponse
+	}
+	return nil
+}
+
+func (x *AcceptorAuthorisationResponseV05) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The AcceptorBatchTransferResponse message is sent by the acquirer to the card acceptor to acknowledge the proper reception of the AcceptorBatchTransfer.
+// Usage
+// The AcceptorBatchTransferResponse message is used by an acquirer to inform the card acceptor of the card payment transactions that could not be captured in the AcceptorBatchTransfer.
+type AcceptorBatchTransferResponseV01 struct {
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	Header          *modelpb.Header3                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DataSet         []*modelpb.CardPaymentDataSet2   `protobuf:"bytes,2,rep,name=data_set,json=dataSet,proto3" json:"data_set,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType1 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AcceptorBatchTransferResponseV01) Reset() {
+	*x = AcceptorBatchTransferResponseV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorBatchTransferResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorBatchTransferResponseV01) ProtoMessage() {}
+
+func (x *AcceptorBatchTransferResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorBatchTransferResponseV01.ProtoReflect.Descriptor instead.
+func (*AcceptorBatchTransferResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AcceptorBatchTransferResponseV01) GetHeader() *modelpb.Header3 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferResponseV01) GetDataSet() []*modelpb.CardPaymentDataSet2 {
+	if x != nil {
+		return x.DataSet
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType1 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorBatchTransferResponse is sent by the acquirer (or its agent) to inform the acceptor (or its agent) of the transfer in a previous AcceptorBatchTransfer of a collection of transactions.
+type AcceptorBatchTransferResponseV02 struct {
+	state                 protoimpl.MessageState                     `protogen:"open.v1"`
+	Header                *modelpb.Header3                           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BatchTransferResponse *modelpb.CardPaymentBatchTransferResponse1 `protobuf:"bytes,2,opt,name=batch_transfer_response,json=batchTransferResponse,proto3" json:"batch_transfer_response,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType4           `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorBatchTransferResponseV02) Reset() {
+	*x = AcceptorBatchTransferResponseV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorBatchTransferResponseV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorBatchTransferResponseV02) ProtoMessage() {}
+
+func (x *AcceptorBatchTransferResponseV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorBatchTransferResponseV02.ProtoReflect.Descriptor instead.
+func (*AcceptorBatchTransferResponseV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AcceptorBatchTransferResponseV02) GetHeader() *modelpb.Header3 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferResponseV02) GetBatchTransferResponse() *modelpb.CardPaymentBatchTransferResponse1 {
+	if x != nil {
+		return x.BatchTransferResponse
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferResponseV02) GetSecurityTrailer() *modelpb.ContentInformationType4 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorBatchTransferResponse is sent by the acquirer (or its agent) to inform the acceptor (or its agent) of the transfer in a previous AcceptorBatchTransfer of a collection of transactions.
+type AcceptorBatchTransferResponseV03 struct {
+	state                 protoimpl.MessageState                     `protogen:"open.v1"`
+	Header                *modelpb.Header3                           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BatchTransferResponse *modelpb.CardPaymentBatchTransferResponse2 `protobuf:"bytes,2,opt,name=batch_transfer_response,json=batchTransferResponse,proto3" json:"batch_transfer_response,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType9           `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorBatchTransferResponseV03) Reset() {
+	*x = AcceptorBatchTransferResponseV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorBatchTransferResponseV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorBatchTransferResponseV03) ProtoMessage() {}
+
+func (x *AcceptorBatchTransferResponseV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorBatchTransferResponseV03.ProtoReflect.Descriptor instead.
+func (*AcceptorBatchTransferResponseV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AcceptorBatchTransferResponseV03) GetHeader() *modelpb.Header3 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferResponseV03) GetBatchTransferResponse() *modelpb.CardPaymentBatchTransferResponse2 {
+	if x != nil {
+		return x.BatchTransferResponse
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferResponseV03) GetSecurityTrailer() *modelpb.ContentInformationType9 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorBatchTransferResponse is sent by the acquirer (or its agent) to inform the acceptor (or its agent) of the transfer in a previous AcceptorBatchTransfer of a collection of transactions.
+type AcceptorBatchTransferResponseV04 struct {
+	state                 protoimpl.MessageState                     `protogen:"open.v1"`
+	Header                *modelpb.Header12                          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BatchTransferResponse *modelpb.CardPaymentBatchTransferResponse3 `protobuf:"bytes,2,opt,name=batch_transfer_response,json=batchTransferResponse,proto3" json:"batch_transfer_response,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType12          `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorBatchTransferResponseV04) Reset() {
+	*x = AcceptorBatchTransferResponseV04{}
+	mi := &file_iso20022_caaa_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorBatchTransferResponseV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorBatchTransferResponseV04) ProtoMessage() {}
+
+func (x *AcceptorBatchTransferResponseV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorBatchTransferResponseV04.ProtoReflect.Descriptor instead.
+func (*AcceptorBatchTransferResponseV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AcceptorBatchTransferResponseV04) GetHeader() *modelpb.Header12 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferResponseV04) GetBatchTransferResponse() *modelpb.CardPaymentBatchTransferResponse3 {
+	if x != nil {
+		return x.BatchTransferResponse
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferResponseV04) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorBatchTransferResponse is sent by the acquirer (or its agent) to inform the acceptor (or its agent) of the transfer in a previous AcceptorBatchTransfer of a collection of transactions.
+type AcceptorBatchTransferResponseV05 struct {
+	state                 protoimpl.MessageState                     `protogen:"open.v1"`
+	Header                *modelpb.Header25                          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BatchTransferResponse *modelpb.CardPaymentBatchTransferResponse4 `protobuf:"bytes,2,opt,name=batch_transfer_response,json=batchTransferResponse,proto3" json:"batch_transfer_response,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType12          `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorBatchTransferResponseV05) Reset() {
+	*x = AcceptorBatchTransferResponseV05{}
+	mi := &file_iso20022_caaa_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorBatchTransferResponseV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorBatchTransferResponseV05) ProtoMessage() {}
+
+func (x *AcceptorBatchTransferResponseV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorBatchTransferResponseV05.ProtoReflect.Descriptor instead.
+func (*AcceptorBatchTransferResponseV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AcceptorBatchTransferResponseV05) GetHeader() *modelpb.Header25 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferResponseV05) GetBatchTransferResponse() *modelpb.CardPaymentBatchTransferResponse4 {
+	if x != nil {
+		return x.BatchTransferResponse
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferResponseV05) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The AcceptorBatchTransfer message is sent by the card acceptor to the acquirer to capture a collection of previously completed card payment transactions.
+// Usage
+// The AcceptorBatchTransfer message embeds the information required for transferring to the acquirer the data needed to perform the financial settlement of these transactions (capture).
+type AcceptorBatchTransferV01 struct {
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	Header          *modelpb.Header3                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DataSet         []*modelpb.CardPaymentDataSet1   `protobuf:"bytes,2,rep,name=data_set,json=dataSet,proto3" json:"data_set,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType1 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AcceptorBatchTransferV01) Reset() {
+	*x = AcceptorBatchTransferV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorBatchTransferV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorBatchTransferV01) ProtoMessage() {}
+
+func (x *AcceptorBatchTransferV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorBatchTransferV01.ProtoReflect.Descriptor instead.
+func (*AcceptorBatchTransferV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AcceptorBatchTransferV01) GetHeader() *modelpb.Header3 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferV01) GetDataSet() []*modelpb.CardPaymentDataSet1 {
+	if x != nil {
+		return x.DataSet
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferV01) GetSecurityTrailer() *modelpb.ContentInformationType1 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorBatchTransfer is sent by an acceptor (or its agent) to transfer the  financial data of a collection of transactions to the acquirer (or its agent).
+type AcceptorBatchTransferV02 struct {
+	state           protoimpl.MessageState             `protogen:"open.v1"`
+	Header          *modelpb.Header3                   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BatchTransfer   *modelpb.CardPaymentBatchTransfer1 `protobuf:"bytes,2,opt,name=batch_transfer,json=batchTransfer,proto3" json:"batch_transfer,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType4   `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AcceptorBatchTransferV02) Reset() {
+	*x = AcceptorBatchTransferV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorBatchTransferV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorBatchTransferV02) ProtoMessage() {}
+
+func (x *AcceptorBatchTransferV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorBatchTransferV02.ProtoReflect.Descriptor instead.
+func (*AcceptorBatchTransferV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AcceptorBatchTransferV02) GetHeader() *modelpb.Header3 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferV02) GetBatchTransfer() *modelpb.CardPaymentBatchTransfer1 {
+	if x != nil {
+		return x.BatchTransfer
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferV02) GetSecurityTrailer() *modelpb.ContentInformationType4 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorBatchTransfer is sent by an acceptor (or its agent) to transfer the  financial data of a collection of transactions to the acquirer (or its agent).
+type AcceptorBatchTransferV03 struct {
+	state           protoimpl.MessageState             `protogen:"open.v1"`
+	Header          *modelpb.Header3                   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BatchTransfer   *modelpb.CardPaymentBatchTransfer2 `protobuf:"bytes,2,opt,name=batch_transfer,json=batchTransfer,proto3" json:"batch_transfer,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType9   `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AcceptorBatchTransferV03) Reset() {
+	*x = AcceptorBatchTransferV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorBatchTransferV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorBatchTransferV03) ProtoMessage() {}
+
+func (x *AcceptorBatchTransferV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorBatchTransferV03.ProtoReflect.Descriptor instead.
+func (*AcceptorBatchTransferV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AcceptorBatchTransferV03) GetHeader() *modelpb.Header3 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferV03) GetBatchTransfer() *modelpb.CardPaymentBatchTransfer2 {
+	if x != nil {
+		return x.BatchTransfer
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferV03) GetSecurityTrailer() *modelpb.ContentInformationType9 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorBatchTransfer is sent by an acceptor (or its agent) to transfer the  financial data of a collection of transactions to the acquirer (or its agent).
+type AcceptorBatchTransferV04 struct {
+	state           protoimpl.MessageState             `protogen:"open.v1"`
+	Header          *modelpb.Header12                  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BatchTransfer   *modelpb.CardPaymentBatchTransfer3 `protobuf:"bytes,2,opt,name=batch_transfer,json=batchTransfer,proto3" json:"batch_transfer,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType12  `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AcceptorBatchTransferV04) Reset() {
+	*x = AcceptorBatchTransferV04{}
+	mi := &file_iso20022_caaa_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorBatchTransferV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorBatchTransferV04) ProtoMessage() {}
+
+func (x *AcceptorBatchTransferV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorBatchTransferV04.ProtoReflect.Descriptor instead.
+func (*AcceptorBatchTransferV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AcceptorBatchTransferV04) GetHeader() *modelpb.Header12 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferV04) GetBatchTransfer() *modelpb.CardPaymentBatchTransfer3 {
+	if x != nil {
+		return x.BatchTransfer
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferV04) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorBatchTransfer is sent by an acceptor (or its agent) to transfer the  financial data of a collection of transactions to the acquirer (or its agent).
+type AcceptorBatchTransferV05 struct {
+	state           protoimpl.MessageState             `protogen:"open.v1"`
+	Header          *modelpb.Header25                  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BatchTransfer   *modelpb.CardPaymentBatchTransfer4 `protobuf:"bytes,2,opt,name=batch_transfer,json=batchTransfer,proto3" json:"batch_transfer,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType12  `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AcceptorBatchTransferV05) Reset() {
+	*x = AcceptorBatchTransferV05{}
+	mi := &file_iso20022_caaa_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorBatchTransferV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorBatchTransferV05) ProtoMessage() {}
+
+func (x *AcceptorBatchTransferV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorBatchTransferV05.ProtoReflect.Descriptor instead.
+func (*AcceptorBatchTransferV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AcceptorBatchTransferV05) GetHeader() *modelpb.Header25 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferV05) GetBatchTransfer() *modelpb.CardPaymentBatchTransfer4 {
+	if x != nil {
+		return x.BatchTransfer
+	}
+	return nil
+}
+
+func (x *AcceptorBatchTransferV05) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The AcceptorCancellationAdviceResponse message is sent by the acquirer to acknowledge the proper reception of the AcceptorCancellationAdvice. The message can be sent directly to the card acceptor or through an agent.
+// Usage
+// The AcceptorCancellationAdviceResponse message should be accepted by the card acceptor unless the message received was invalid.
+type AcceptorCancellationAdviceResponseV01 struct {
+	state                              protoimpl.MessageState                       `protogen:"open.v1"`
+	Header                             *modelpb.Header2                             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AcceptorCancellationAdviceResponse *modelpb.AcceptorCancellationAdviceResponse1 `protobuf:"bytes,2,opt,name=acceptor_cancellation_advice_response,json=acceptorCancellationAdviceResponse,proto3" json:"acceptor_cancellation_advice_response,omitempty"`
+	SecurityTrailer                    *modelpb.ContentInformationType3             `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                      protoimpl.UnknownFields
+	sizeCache                          protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationAdviceResponseV01) Reset() {
+	*x = AcceptorCancellationAdviceResponseV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationAdviceResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationAdviceResponseV01) ProtoMessage() {}
+
+func (x *AcceptorCancellationAdviceResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationAdviceResponseV01.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationAdviceResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AcceptorCancellationAdviceResponseV01) GetHeader() *modelpb.Header2 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceResponseV01) GetAcceptorCancellationAdviceResponse() *modelpb.AcceptorCancellationAdviceResponse1 {
+	if x != nil {
+		return x.AcceptorCancellationAdviceResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType3 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) about the notification of the payment cancellation.
+type AcceptorCancellationAdviceResponseV02 struct {
+	state                      protoimpl.MessageState                       `protogen:"open.v1"`
+	Header                     *modelpb.Header2                             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationAdviceResponse *modelpb.AcceptorCancellationAdviceResponse2 `protobuf:"bytes,2,opt,name=cancellation_advice_response,json=cancellationAdviceResponse,proto3" json:"cancellation_advice_response,omitempty"`
+	SecurityTrailer            *modelpb.ContentInformationType6             `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationAdviceResponseV02) Reset() {
+	*x = AcceptorCancellationAdviceResponseV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationAdviceResponseV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationAdviceResponseV02) ProtoMessage() {}
+
+func (x *AcceptorCancellationAdviceResponseV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationAdviceResponseV02.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationAdviceResponseV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AcceptorCancellationAdviceResponseV02) GetHeader() *modelpb.Header2 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceResponseV02) GetCancellationAdviceResponse() *modelpb.AcceptorCancellationAdviceResponse2 {
+	if x != nil {
+		return x.CancellationAdviceResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceResponseV02) GetSecurityTrailer() *modelpb.ContentInformationType6 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) about the notification of the payment cancellation.
+type AcceptorCancellationAdviceResponseV03 struct {
+	state                      protoimpl.MessageState                       `protogen:"open.v1"`
+	Header                     *modelpb.Header8                             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationAdviceResponse *modelpb.AcceptorCancellationAdviceResponse3 `protobuf:"bytes,2,opt,name=cancellation_advice_response,json=cancellationAdviceResponse,proto3" json:"cancellation_advice_response,omitempty"`
+	SecurityTrailer            *modelpb.ContentInformationType8             `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationAdviceResponseV03) Reset() {
+	*x = AcceptorCancellationAdviceResponseV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationAdviceResponseV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationAdviceResponseV03) ProtoMessage() {}
+
+func (x *AcceptorCancellationAdviceResponseV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationAdviceResponseV03.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationAdviceResponseV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AcceptorCancellationAdviceResponseV03) GetHeader() *modelpb.Header8 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceResponseV03) GetCancellationAdviceResponse() *modelpb.AcceptorCancellationAdviceResponse3 {
+	if x != nil {
+		return x.CancellationAdviceResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceResponseV03) GetSecurityTrailer() *modelpb.ContentInformationType8 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) about the notification of the payment cancellation.
+type AcceptorCancellationAdviceResponseV04 struct {
+	state                      protoimpl.MessageState                       `protogen:"open.v1"`
+	Header                     *modelpb.Header11                            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationAdviceResponse *modelpb.AcceptorCancellationAdviceResponse4 `protobuf:"bytes,2,opt,name=cancellation_advice_response,json=cancellationAdviceResponse,proto3" json:"cancellation_advice_response,omitempty"`
+	SecurityTrailer            *modelpb.ContentInformationType11            `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationAdviceResponseV04) Reset() {
+	*x = AcceptorCancellationAdviceResponseV04{}
+	mi := &file_iso20022_caaa_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationAdviceResponseV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationAdviceResponseV04) ProtoMessage() {}
+
+func (x *AcceptorCancellationAdviceResponseV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationAdviceResponseV04.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationAdviceResponseV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AcceptorCancellationAdviceResponseV04) GetHeader() *modelpb.Header11 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceResponseV04) GetCancellationAdviceResponse() *modelpb.AcceptorCancellationAdviceResponse4 {
+	if x != nil {
+		return x.CancellationAdviceResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceResponseV04) GetSecurityTrailer() *modelpb.ContentInformationType11 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) about the notification of the payment cancellation.
+type AcceptorCancellationAdviceResponseV05 struct {
+	state                      protoimpl.MessageState                       `protogen:"open.v1"`
+	Header                     *modelpb.Header24                            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationAdviceResponse *modelpb.AcceptorCancellationAdviceResponse5 `protobuf:"bytes,2,opt,name=cancellation_advice_response,json=cancellationAdviceResponse,proto3" json:"cancellation_advice_response,omitempty"`
+	SecurityTrailer            *modelpb.ContentInformationType15            `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationAdviceResponseV05) Reset() {
+	*x = AcceptorCancellationAdviceResponseV05{}
+	mi := &file_iso20022_caaa_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationAdviceResponseV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationAdviceResponseV05) ProtoMessage() {}
+
+func (x *AcceptorCancellationAdviceResponseV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationAdviceResponseV05.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationAdviceResponseV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AcceptorCancellationAdviceResponseV05) GetHeader() *modelpb.Header24 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceResponseV05) GetCancellationAdviceResponse() *modelpb.AcceptorCancellationAdviceResponse5 {
+	if x != nil {
+		return x.CancellationAdviceResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceResponseV05) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The AcceptorCancellationAdvice message is sent by a card acceptor to notify the cancellation of a successfully completed card payment transaction. The message can be sent directly to the acquirer or through an agent.
+// Usage
+// The AcceptorCancellationAdvice message is sent by the card acceptor to an acquirer when the acquirer did not receive a correct response to the AcceptorCompletionAdvice message.
+type AcceptorCancellationAdviceV01 struct {
+	state              protoimpl.MessageState               `protogen:"open.v1"`
+	Header             *modelpb.Header2                     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationAdvice *modelpb.AcceptorCancellationAdvice1 `protobuf:"bytes,2,opt,name=cancellation_advice,json=cancellationAdvice,proto3" json:"cancellation_advice,omitempty"`
+	SecurityTrailer    *modelpb.ContentInformationType3     `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationAdviceV01) Reset() {
+	*x = AcceptorCancellationAdviceV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationAdviceV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationAdviceV01) ProtoMessage() {}
+
+func (x *AcceptorCancellationAdviceV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationAdviceV01.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationAdviceV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AcceptorCancellationAdviceV01) GetHeader() *modelpb.Header2 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceV01) GetCancellationAdvice() *modelpb.AcceptorCancellationAdvice1 {
+	if x != nil {
+		return x.CancellationAdvice
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceV01) GetSecurityTrailer() *modelpb.ContentInformationType3 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the cancellation of a successfully completed transaction. The transaction has been completed without financial transfer, or the acceptor is aware that the transaction was not cleared by the acquirer.
+type AcceptorCancellationAdviceV02 struct {
+	state              protoimpl.MessageState               `protogen:"open.v1"`
+	Header             *modelpb.Header2                     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationAdvice *modelpb.AcceptorCancellationAdvice2 `protobuf:"bytes,2,opt,name=cancellation_advice,json=cancellationAdvice,proto3" json:"cancellation_advice,omitempty"`
+	SecurityTrailer    *modelpb.ContentInformationType6     `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationAdviceV02) Reset() {
+	*x = AcceptorCancellationAdviceV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationAdviceV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationAdviceV02) ProtoMessage() {}
+
+func (x *AcceptorCancellationAdviceV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationAdviceV02.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationAdviceV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AcceptorCancellationAdviceV02) GetHeader() *modelpb.Header2 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceV02) GetCancellationAdvice() *modelpb.AcceptorCancellationAdvice2 {
+	if x != nil {
+		return x.CancellationAdvice
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceV02) GetSecurityTrailer() *modelpb.ContentInformationType6 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the cancellation of a successfully completed transaction. The transaction has been completed without financial transfer, or the acceptor is aware that the transaction was not cleared by the acquirer.
+type AcceptorCancellationAdviceV03 struct {
+	state              protoimpl.MessageState               `protogen:"open.v1"`
+	Header             *modelpb.Header8                     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationAdvice *modelpb.AcceptorCancellationAdvice3 `protobuf:"bytes,2,opt,name=cancellation_advice,json=cancellationAdvice,proto3" json:"cancellation_advice,omitempty"`
+	SecurityTrailer    *modelpb.ContentInformationType8     `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationAdviceV03) Reset() {
+	*x = AcceptorCancellationAdviceV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationAdviceV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationAdviceV03) ProtoMessage() {}
+
+func (x *AcceptorCancellationAdviceV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationAdviceV03.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationAdviceV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AcceptorCancellationAdviceV03) GetHeader() *modelpb.Header8 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceV03) GetCancellationAdvice() *modelpb.AcceptorCancellationAdvice3 {
+	if x != nil {
+		return x.CancellationAdvice
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceV03) GetSecurityTrailer() *modelpb.ContentInformationType8 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the cancellation of a successfully completed transaction. The transaction has been completed without financial transfer, or the acceptor is aware that the transaction was not cleared by the acquirer.
+type AcceptorCancellationAdviceV04 struct {
+	state              protoimpl.MessageState               `protogen:"open.v1"`
+	Header             *modelpb.Header11                    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationAdvice *modelpb.AcceptorCancellationAdvice4 `protobuf:"bytes,2,opt,name=cancellation_advice,json=cancellationAdvice,proto3" json:"cancellation_advice,omitempty"`
+	SecurityTrailer    *modelpb.ContentInformationType11    `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationAdviceV04) Reset() {
+	*x = AcceptorCancellationAdviceV04{}
+	mi := &file_iso20022_caaa_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationAdviceV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationAdviceV04) ProtoMessage() {}
+
+func (x *AcceptorCancellationAdviceV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationAdviceV04.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationAdviceV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AcceptorCancellationAdviceV04) GetHeader() *modelpb.Header11 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceV04) GetCancellationAdvice() *modelpb.AcceptorCancellationAdvice4 {
+	if x != nil {
+		return x.CancellationAdvice
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceV04) GetSecurityTrailer() *modelpb.ContentInformationType11 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the cancellation of a successfully completed transaction. The transaction has been completed without financial transfer, or the acceptor is aware that the transaction was not cleared by the acquirer.
+type AcceptorCancellationAdviceV05 struct {
+	state              protoimpl.MessageState               `protogen:"open.v1"`
+	Header             *modelpb.Header24                    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationAdvice *modelpb.AcceptorCancellationAdvice5 `protobuf:"bytes,2,opt,name=cancellation_advice,json=cancellationAdvice,proto3" json:"cancellation_advice,omitempty"`
+	SecurityTrailer    *modelpb.ContentInformationType15    `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationAdviceV05) Reset() {
+	*x = AcceptorCancellationAdviceV05{}
+	mi := &file_iso20022_caaa_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationAdviceV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationAdviceV05) ProtoMessage() {}
+
+func (x *AcceptorCancellationAdviceV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationAdviceV05.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationAdviceV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AcceptorCancellationAdviceV05) GetHeader() *modelpb.Header24 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceV05) GetCancellationAdvice() *modelpb.AcceptorCancellationAdvice5 {
+	if x != nil {
+		return x.CancellationAdvice
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationAdviceV05) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The AcceptorCancellationRequest message is sent by a card acceptor to cancel a successfully completed card payment transaction. The message can be sent directly to the acquirer or through an agent.
+// Usage
+// The AcceptorCancellationRequest message is used when the card acceptor is unaware of the cancellation of the transaction by the acquirer.
+type AcceptorCancellationRequestV01 struct {
+	state               protoimpl.MessageState                `protogen:"open.v1"`
+	Header              *modelpb.Header1                      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationRequest *modelpb.AcceptorCancellationRequest1 `protobuf:"bytes,2,opt,name=cancellation_request,json=cancellationRequest,proto3" json:"cancellation_request,omitempty"`
+	SecurityTrailer     *modelpb.ContentInformationType3      `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationRequestV01) Reset() {
+	*x = AcceptorCancellationRequestV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationRequestV01) ProtoMessage() {}
+
+func (x *AcceptorCancellationRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationRequestV01.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AcceptorCancellationRequestV01) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationRequestV01) GetCancellationRequest() *modelpb.AcceptorCancellationRequest1 {
+	if x != nil {
+		return x.CancellationRequest
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationRequestV01) GetSecurityTrailer() *modelpb.ContentInformationType3 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to request the cancellation of a successfully completed transaction. Cancellation should only occur before the transaction has been cleared.
+type AcceptorCancellationRequestV02 struct {
+	state               protoimpl.MessageState                `protogen:"open.v1"`
+	Header              *modelpb.Header1                      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationRequest *modelpb.AcceptorCancellationRequest2 `protobuf:"bytes,2,opt,name=cancellation_request,json=cancellationRequest,proto3" json:"cancellation_request,omitempty"`
+	SecurityTrailer     *modelpb.ContentInformationType6      `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationRequestV02) Reset() {
+	*x = AcceptorCancellationRequestV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationRequestV02) ProtoMessage() {}
+
+func (x *AcceptorCancellationRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationRequestV02.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AcceptorCancellationRequestV02) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationRequestV02) GetCancellationRequest() *modelpb.AcceptorCancellationRequest2 {
+	if x != nil {
+		return x.CancellationRequest
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationRequestV02) GetSecurityTrailer() *modelpb.ContentInformationType6 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to request the cancellation of a successfully completed transaction. Cancellation should only occur before the transaction has been cleared.
+type AcceptorCancellationRequestV03 struct {
+	state               protoimpl.MessageState                `protogen:"open.v1"`
+	Header              *modelpb.Header7                      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationRequest *modelpb.AcceptorCancellationRequest3 `protobuf:"bytes,2,opt,name=cancellation_request,json=cancellationRequest,proto3" json:"cancellation_request,omitempty"`
+	SecurityTrailer     *modelpb.ContentInformationType8      `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationRequestV03) Reset() {
+	*x = AcceptorCancellationRequestV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationRequestV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationRequestV03) ProtoMessage() {}
+
+func (x *AcceptorCancellationRequestV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationRequestV03.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationRequestV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AcceptorCancellationRequestV03) GetHeader() *modelpb.Header7 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationRequestV03) GetCancellationRequest() *modelpb.AcceptorCancellationRequest3 {
+	if x != nil {
+		return x.CancellationRequest
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationRequestV03) GetSecurityTrailer() *modelpb.ContentInformationType8 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to request the cancellation of a successfully completed transaction. Cancellation should only occur before the transaction has been cleared.
+type AcceptorCancellationRequestV04 struct {
+	state               protoimpl.MessageState                `protogen:"open.v1"`
+	Header              *modelpb.Header10                     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationRequest *modelpb.AcceptorCancellationRequest4 `protobuf:"bytes,2,opt,name=cancellation_request,json=cancellationRequest,proto3" json:"cancellation_request,omitempty"`
+	SecurityTrailer     *modelpb.ContentInformationType11     `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationRequestV04) Reset() {
+	*x = AcceptorCancellationRequestV04{}
+	mi := &file_iso20022_caaa_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationRequestV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationRequestV04) ProtoMessage() {}
+
+func (x *AcceptorCancellationRequestV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationRequestV04.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationRequestV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AcceptorCancellationRequestV04) GetHeader() *modelpb.Header10 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationRequestV04) GetCancellationRequest() *modelpb.AcceptorCancellationRequest4 {
+	if x != nil {
+		return x.CancellationRequest
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationRequestV04) GetSecurityTrailer() *modelpb.ContentInformationType11 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to request the cancellation of a successfully completed transaction. Cancellation should only occur before the transaction has been cleared.
+type AcceptorCancellationRequestV05 struct {
+	state               protoimpl.MessageState                `protogen:"open.v1"`
+	Header              *modelpb.Header30                     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationRequest *modelpb.AcceptorCancellationRequest5 `protobuf:"bytes,2,opt,name=cancellation_request,json=cancellationRequest,proto3" json:"cancellation_request,omitempty"`
+	SecurityTrailer     *modelpb.ContentInformationType15     `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationRequestV05) Reset() {
+	*x = AcceptorCancellationRequestV05{}
+	mi := &file_iso20022_caaa_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationRequestV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationRequestV05) ProtoMessage() {}
+
+func (x *AcceptorCancellationRequestV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationRequestV05.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationRequestV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AcceptorCancellationRequestV05) GetHeader() *modelpb.Header30 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationRequestV05) GetCancellationRequest() *modelpb.AcceptorCancellationRequest5 {
+	if x != nil {
+		return x.CancellationRequest
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationRequestV05) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The AcceptorCancellationResponse message is sent by the acquirer to inform the card acceptor of the outcome of the cancellation process. The message can be sent directly to the acceptor or through an agent.
+// Usage
+// The AcceptorCancellationResponse message is used to indicate one of the possible outcomes of a cancellation process:
+// - a successful cancellation;
+// - a rejection from the acquirer for financial reasons;
+// - a rejection from the acquirer for technical reasons.
+type AcceptorCancellationResponseV01 struct {
+	state                protoimpl.MessageState                 `protogen:"open.v1"`
+	Header               *modelpb.Header1                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationResponse *modelpb.AcceptorCancellationResponse1 `protobuf:"bytes,2,opt,name=cancellation_response,json=cancellationResponse,proto3" json:"cancellation_response,omitempty"`
+	SecurityTrailer      *modelpb.ContentInformationType3       `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationResponseV01) Reset() {
+	*x = AcceptorCancellationResponseV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationResponseV01) ProtoMessage() {}
+
+func (x *AcceptorCancellationResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationResponseV01.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AcceptorCancellationResponseV01) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationResponseV01) GetCancellationResponse() *modelpb.AcceptorCancellationResponse1 {
+	if x != nil {
+		return x.CancellationResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType3 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the outcome of the cancellation request. If the response is positive, the acquirer has voided the financial data from the captured transaction.
+type AcceptorCancellationResponseV02 struct {
+	state                protoimpl.MessageState                 `protogen:"open.v1"`
+	Header               *modelpb.Header1                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationResponse *modelpb.AcceptorCancellationResponse2 `protobuf:"bytes,2,opt,name=cancellation_response,json=cancellationResponse,proto3" json:"cancellation_response,omitempty"`
+	SecurityTrailer      *modelpb.ContentInformationType6       `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationResponseV02) Reset() {
+	*x = AcceptorCancellationResponseV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationResponseV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationResponseV02) ProtoMessage() {}
+
+func (x *AcceptorCancellationResponseV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationResponseV02.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationResponseV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AcceptorCancellationResponseV02) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationResponseV02) GetCancellationResponse() *modelpb.AcceptorCancellationResponse2 {
+	if x != nil {
+		return x.CancellationResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationResponseV02) GetSecurityTrailer() *modelpb.ContentInformationType6 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the outcome of the cancellation request. If the response is positive, the acquirer has voided the financial data from the captured transaction.
+type AcceptorCancellationResponseV03 struct {
+	state                protoimpl.MessageState                 `protogen:"open.v1"`
+	Header               *modelpb.Header7                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationResponse *modelpb.AcceptorCancellationResponse3 `protobuf:"bytes,2,opt,name=cancellation_response,json=cancellationResponse,proto3" json:"cancellation_response,omitempty"`
+	SecurityTrailer      *modelpb.ContentInformationType8       `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationResponseV03) Reset() {
+	*x = AcceptorCancellationResponseV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationResponseV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationResponseV03) ProtoMessage() {}
+
+func (x *AcceptorCancellationResponseV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationResponseV03.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationResponseV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AcceptorCancellationResponseV03) GetHeader() *modelpb.Header7 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationResponseV03) GetCancellationResponse() *modelpb.AcceptorCancellationResponse3 {
+	if x != nil {
+		return x.CancellationResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationResponseV03) GetSecurityTrailer() *modelpb.ContentInformationType8 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the outcome of the cancellation request. If the response is positive, the acquirer has voided the financial data from the captured transaction.
+type AcceptorCancellationResponseV04 struct {
+	state                protoimpl.MessageState                 `protogen:"open.v1"`
+	Header               *modelpb.Header10                      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationResponse *modelpb.AcceptorCancellationResponse4 `protobuf:"bytes,2,opt,name=cancellation_response,json=cancellationResponse,proto3" json:"cancellation_response,omitempty"`
+	SecurityTrailer      *modelpb.ContentInformationType11      `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationResponseV04) Reset() {
+	*x = AcceptorCancellationResponseV04{}
+	mi := &file_iso20022_caaa_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationResponseV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationResponseV04) ProtoMessage() {}
+
+func (x *AcceptorCancellationResponseV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationResponseV04.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationResponseV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AcceptorCancellationResponseV04) GetHeader() *modelpb.Header10 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationResponseV04) GetCancellationResponse() *modelpb.AcceptorCancellationResponse4 {
+	if x != nil {
+		return x.CancellationResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationResponseV04) GetSecurityTrailer() *modelpb.ContentInformationType11 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCancellationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to return the outcome of the cancellation request. If the response is positive, the acquirer has voided the financial data from the captured transaction.
+type AcceptorCancellationResponseV05 struct {
+	state                protoimpl.MessageState                 `protogen:"open.v1"`
+	Header               *modelpb.Header30                      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CancellationResponse *modelpb.AcceptorCancellationResponse5 `protobuf:"bytes,2,opt,name=cancellation_response,json=cancellationResponse,proto3" json:"cancellation_response,omitempty"`
+	SecurityTrailer      *modelpb.ContentInformationType15      `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AcceptorCancellationResponseV05) Reset() {
+	*x = AcceptorCancellationResponseV05{}
+	mi := &file_iso20022_caaa_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCancellationResponseV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCancellationResponseV05) ProtoMessage() {}
+
+func (x *AcceptorCancellationResponseV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCancellationResponseV05.ProtoReflect.Descriptor instead.
+func (*AcceptorCancellationResponseV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AcceptorCancellationResponseV05) GetHeader() *modelpb.Header30 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationResponseV05) GetCancellationResponse() *modelpb.AcceptorCancellationResponse5 {
+	if x != nil {
+		return x.CancellationResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCancellationResponseV05) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The AcceptorCompletionAdviceResponse message is sent by the acquirer to acknowledge the proper receipt of an AcceptorCompletionAdvice. The message can be sent directly to the card acceptor or through an agent.
+// Usage
+// The AcceptorCompletionAdviceResponse message is used to acknowledge the data capture process performed by the acquirer based on the data required to carry out the financial clearing and settlement of the transaction.
+type AcceptorCompletionAdviceResponseV01 struct {
+	state                    protoimpl.MessageState                     `protogen:"open.v1"`
+	Header                   *modelpb.Header2                           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CompletionAdviceResponse *modelpb.AcceptorCompletionAdviceResponse1 `protobuf:"bytes,2,opt,name=completion_advice_response,json=completionAdviceResponse,proto3" json:"completion_advice_response,omitempty"`
+	SecurityTrailer          *modelpb.ContentInformationType3           `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AcceptorCompletionAdviceResponseV01) Reset() {
+	*x = AcceptorCompletionAdviceResponseV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCompletionAdviceResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCompletionAdviceResponseV01) ProtoMessage() {}
+
+func (x *AcceptorCompletionAdviceResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCompletionAdviceResponseV01.ProtoReflect.Descriptor instead.
+func (*AcceptorCompletionAdviceResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AcceptorCompletionAdviceResponseV01) GetHeader() *modelpb.Header2 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceResponseV01) GetCompletionAdviceResponse() *modelpb.AcceptorCompletionAdviceResponse1 {
+	if x != nil {
+		return x.CompletionAdviceResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType3 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCompletionAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) of the outcome of the payment transaction, and the transfer the  financial data of the transaction contained in the completion advice.
+type AcceptorCompletionAdviceResponseV02 struct {
+	state                    protoimpl.MessageState                     `protogen:"open.v1"`
+	Header                   *modelpb.Header2                           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CompletionAdviceResponse *modelpb.AcceptorCompletionAdviceResponse2 `protobuf:"bytes,2,opt,name=completion_advice_response,json=completionAdviceResponse,proto3" json:"completion_advice_response,omitempty"`
+	SecurityTrailer          *modelpb.ContentInformationType6           `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AcceptorCompletionAdviceResponseV02) Reset() {
+	*x = AcceptorCompletionAdviceResponseV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCompletionAdviceResponseV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCompletionAdviceResponseV02) ProtoMessage() {}
+
+func (x *AcceptorCompletionAdviceResponseV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCompletionAdviceResponseV02.ProtoReflect.Descriptor instead.
+func (*AcceptorCompletionAdviceResponseV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AcceptorCompletionAdviceResponseV02) GetHeader() *modelpb.Header2 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceResponseV02) GetCompletionAdviceResponse() *modelpb.AcceptorCompletionAdviceResponse2 {
+	if x != nil {
+		return x.CompletionAdviceResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceResponseV02) GetSecurityTrailer() *modelpb.ContentInformationType6 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCompletionAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) of the outcome of the payment transaction, and the transfer the  financial data of the transaction contained in the completion advice.
+type AcceptorCompletionAdviceResponseV03 struct {
+	state                    protoimpl.MessageState                     `protogen:"open.v1"`
+	Header                   *modelpb.Header8                           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CompletionAdviceResponse *modelpb.AcceptorCompletionAdviceResponse3 `protobuf:"bytes,2,opt,name=completion_advice_response,json=completionAdviceResponse,proto3" json:"completion_advice_response,omitempty"`
+	SecurityTrailer          *modelpb.ContentInformationType8           `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AcceptorCompletionAdviceResponseV03) Reset() {
+	*x = AcceptorCompletionAdviceResponseV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCompletionAdviceResponseV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCompletionAdviceResponseV03) ProtoMessage() {}
+
+func (x *AcceptorCompletionAdviceResponseV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCompletionAdviceResponseV03.ProtoReflect.Descriptor instead.
+func (*AcceptorCompletionAdviceResponseV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AcceptorCompletionAdviceResponseV03) GetHeader() *modelpb.Header8 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceResponseV03) GetCompletionAdviceResponse() *modelpb.AcceptorCompletionAdviceResponse3 {
+	if x != nil {
+		return x.CompletionAdviceResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceResponseV03) GetSecurityTrailer() *modelpb.ContentInformationType8 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCompletionAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) of the outcome of the payment transaction, and the transfer the  financial data of the transaction contained in the completion advice.
+type AcceptorCompletionAdviceResponseV04 struct {
+	state                    protoimpl.MessageState                     `protogen:"open.v1"`
+	Header                   *modelpb.Header11                          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CompletionAdviceResponse *modelpb.AcceptorCompletionAdviceResponse4 `protobuf:"bytes,2,opt,name=completion_advice_response,json=completionAdviceResponse,proto3" json:"completion_advice_response,omitempty"`
+	SecurityTrailer          *modelpb.ContentInformationType11          `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AcceptorCompletionAdviceResponseV04) Reset() {
+	*x = AcceptorCompletionAdviceResponseV04{}
+	mi := &file_iso20022_caaa_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCompletionAdviceResponseV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCompletionAdviceResponseV04) ProtoMessage() {}
+
+func (x *AcceptorCompletionAdviceResponseV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCompletionAdviceResponseV04.ProtoReflect.Descriptor instead.
+func (*AcceptorCompletionAdviceResponseV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AcceptorCompletionAdviceResponseV04) GetHeader() *modelpb.Header11 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceResponseV04) GetCompletionAdviceResponse() *modelpb.AcceptorCompletionAdviceResponse4 {
+	if x != nil {
+		return x.CompletionAdviceResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceResponseV04) GetSecurityTrailer() *modelpb.ContentInformationType11 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCompletionAdviceResponse message is sent by the acquirer (or its agent) to acknowledge the acceptor (or its agent) of the outcome of the payment transaction, and the transfer the  financial data of the transaction contained in the completion advice.
+type AcceptorCompletionAdviceResponseV05 struct {
+	state                    protoimpl.MessageState                     `protogen:"open.v1"`
+	Header                   *modelpb.Header24                          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CompletionAdviceResponse *modelpb.AcceptorCompletionAdviceResponse5 `protobuf:"bytes,2,opt,name=completion_advice_response,json=completionAdviceResponse,proto3" json:"completion_advice_response,omitempty"`
+	SecurityTrailer          *modelpb.ContentInformationType15          `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AcceptorCompletionAdviceResponseV05) Reset() {
+	*x = AcceptorCompletionAdviceResponseV05{}
+	mi := &file_iso20022_caaa_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCompletionAdviceResponseV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCompletionAdviceResponseV05) ProtoMessage() {}
+
+func (x *AcceptorCompletionAdviceResponseV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCompletionAdviceResponseV05.ProtoReflect.Descriptor instead.
+func (*AcceptorCompletionAdviceResponseV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AcceptorCompletionAdviceResponseV05) GetHeader() *modelpb.Header24 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceResponseV05) GetCompletionAdviceResponse() *modelpb.AcceptorCompletionAdviceResponse5 {
+	if x != nil {
+		return x.CompletionAdviceResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceResponseV05) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The AcceptorCompletionAdvice message is sent by a card acceptor to notify an acquirer about the completion and final outcome of a card payment transaction. The message can be sent directly to the acquirer or through an agent.
+// Usage
+// The AcceptorCompletionAdvice message is used either to:
+// - inform the acquirer about the successful end of a transaction;
+// - reverse a transaction which was not successfully completed (for example, cancellation of transaction by the cardholder), but where an authorisation had been previously given.
+// The AcceptorCompletionAdvice message may also embed the information required for transferring to the acquirer all data needed to perform the financial settlement of the transaction (capture). Should the acquirer not receive a correct response to an AcceptorCompletionAdvice message; the card acceptor sends back an AcceptorCompletionAdvice message to the acquirer.
+type AcceptorCompletionAdviceV01 struct {
+	state            protoimpl.MessageState             `protogen:"open.v1"`
+	Header           *modelpb.Header2                   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CompletionAdvice *modelpb.AcceptorCompletionAdvice1 `protobuf:"bytes,2,opt,name=completion_advice,json=completionAdvice,proto3" json:"completion_advice,omitempty"`
+	SecurityTrailer  *modelpb.ContentInformationType3   `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AcceptorCompletionAdviceV01) Reset() {
+	*x = AcceptorCompletionAdviceV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCompletionAdviceV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCompletionAdviceV01) ProtoMessage() {}
+
+func (x *AcceptorCompletionAdviceV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCompletionAdviceV01.ProtoReflect.Descriptor instead.
+func (*AcceptorCompletionAdviceV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AcceptorCompletionAdviceV01) GetHeader() *modelpb.Header2 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceV01) GetCompletionAdvice() *modelpb.AcceptorCompletionAdvice1 {
+	if x != nil {
+		return x.CompletionAdvice
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceV01) GetSecurityTrailer() *modelpb.ContentInformationType3 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCompletionAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the outcome of the payment at the acceptor, and to transfer the  financial data of the transaction to the acquirer (capture).
+// A AcceptorCompletionAdvice message is also sent to reverse an approved authorisation and any associated financial transfer (capture), if the card payment transaction could not be completed successfully.
+type AcceptorCompletionAdviceV02 struct {
+	state            protoimpl.MessageState             `protogen:"open.v1"`
+	Header           *modelpb.Header2                   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CompletionAdvice *modelpb.AcceptorCompletionAdvice2 `protobuf:"bytes,2,opt,name=completion_advice,json=completionAdvice,proto3" json:"completion_advice,omitempty"`
+	SecurityTrailer  *modelpb.ContentInformationType6   `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AcceptorCompletionAdviceV02) Reset() {
+	*x = AcceptorCompletionAdviceV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCompletionAdviceV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCompletionAdviceV02) ProtoMessage() {}
+
+func (x *AcceptorCompletionAdviceV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCompletionAdviceV02.ProtoReflect.Descriptor instead.
+func (*AcceptorCompletionAdviceV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AcceptorCompletionAdviceV02) GetHeader() *modelpb.Header2 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceV02) GetCompletionAdvice() *modelpb.AcceptorCompletionAdvice2 {
+	if x != nil {
+		return x.CompletionAdvice
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceV02) GetSecurityTrailer() *modelpb.ContentInformationType6 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCompletionAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the outcome of the payment at the acceptor, and to transfer the  financial data of the transaction to the acquirer (capture).
+// A AcceptorCompletionAdvice message is also sent to reverse an approved authorisation and any associated financial transfer (capture), if the card payment transaction could not be completed successfully.
+type AcceptorCompletionAdviceV03 struct {
+	state            protoimpl.MessageState             `protogen:"open.v1"`
+	Header           *modelpb.Header8                   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CompletionAdvice *modelpb.AcceptorCompletionAdvice3 `protobuf:"bytes,2,opt,name=completion_advice,json=completionAdvice,proto3" json:"completion_advice,omitempty"`
+	SecurityTrailer  *modelpb.ContentInformationType8   `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AcceptorCompletionAdviceV03) Reset() {
+	*x = AcceptorCompletionAdviceV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCompletionAdviceV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCompletionAdviceV03) ProtoMessage() {}
+
+func (x *AcceptorCompletionAdviceV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCompletionAdviceV03.ProtoReflect.Descriptor instead.
+func (*AcceptorCompletionAdviceV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AcceptorCompletionAdviceV03) GetHeader() *modelpb.Header8 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceV03) GetCompletionAdvice() *modelpb.AcceptorCompletionAdvice3 {
+	if x != nil {
+		return x.CompletionAdvice
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceV03) GetSecurityTrailer() *modelpb.ContentInformationType8 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCompletionAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the outcome of the payment at the acceptor, and to transfer the  financial data of the transaction to the acquirer (capture).
+// A AcceptorCompletionAdvice message is also sent to reverse an approved authorisation and any associated financial transfer (capture), if the card payment transaction could not be completed successfully.
+type AcceptorCompletionAdviceV04 struct {
+	state            protoimpl.MessageState             `protogen:"open.v1"`
+	Header           *modelpb.Header11                  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CompletionAdvice *modelpb.AcceptorCompletionAdvice4 `protobuf:"bytes,2,opt,name=completion_advice,json=completionAdvice,proto3" json:"completion_advice,omitempty"`
+	SecurityTrailer  *modelpb.ContentInformationType11  `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AcceptorCompletionAdviceV04) Reset() {
+	*x = AcceptorCompletionAdviceV04{}
+	mi := &file_iso20022_caaa_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCompletionAdviceV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCompletionAdviceV04) ProtoMessage() {}
+
+func (x *AcceptorCompletionAdviceV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCompletionAdviceV04.ProtoReflect.Descriptor instead.
+func (*AcceptorCompletionAdviceV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AcceptorCompletionAdviceV04) GetHeader() *modelpb.Header11 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceV04) GetCompletionAdvice() *modelpb.AcceptorCompletionAdvice4 {
+	if x != nil {
+		return x.CompletionAdvice
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceV04) GetSecurityTrailer() *modelpb.ContentInformationType11 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCompletionAdvice message is sent by an acceptor (or its agent) to notify the acquirer (or its agent) of the outcome of the payment at the acceptor, and to transfer the  financial data of the transaction to the acquirer (capture).
+// A AcceptorCompletionAdvice message is also sent to reverse an approved authorisation and any associated financial transfer (capture), if the card payment transaction could not be completed successfully.
+type AcceptorCompletionAdviceV05 struct {
+	state            protoimpl.MessageState             `protogen:"open.v1"`
+	Header           *modelpb.Header24                  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CompletionAdvice *modelpb.AcceptorCompletionAdvice5 `protobuf:"bytes,2,opt,name=completion_advice,json=completionAdvice,proto3" json:"completion_advice,omitempty"`
+	SecurityTrailer  *modelpb.ContentInformationType15  `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AcceptorCompletionAdviceV05) Reset() {
+	*x = AcceptorCompletionAdviceV05{}
+	mi := &file_iso20022_caaa_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCompletionAdviceV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCompletionAdviceV05) ProtoMessage() {}
+
+func (x *AcceptorCompletionAdviceV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCompletionAdviceV05.ProtoReflect.Descriptor instead.
+func (*AcceptorCompletionAdviceV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AcceptorCompletionAdviceV05) GetHeader() *modelpb.Header24 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceV05) GetCompletionAdvice() *modelpb.AcceptorCompletionAdvice5 {
+	if x != nil {
+		return x.CompletionAdvice
+	}
+	return nil
+}
+
+func (x *AcceptorCompletionAdviceV05) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCurrencyConversionRequest message is sent by the card acceptor to the currency conversion service provider to request if the cardholder is able to pay in the currency of its card.
+type AcceptorCurrencyConversionRequestV01 struct {
+	state                     protoimpl.MessageState                      `protogen:"open.v1"`
+	Header                    *modelpb.Header7                            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CurrencyConversionRequest *modelpb.AcceptorCurrencyConversionRequest1 `protobuf:"bytes,2,opt,name=currency_conversion_request,json=currencyConversionRequest,proto3" json:"currency_conversion_request,omitempty"`
+	SecurityTrailer           *modelpb.ContentInformationType8            `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *AcceptorCurrencyConversionRequestV01) Reset() {
+	*x = AcceptorCurrencyConversionRequestV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCurrencyConversionRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCurrencyConversionRequestV01) ProtoMessage() {}
+
+func (x *AcceptorCurrencyConversionRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCurrencyConversionRequestV01.ProtoReflect.Descriptor instead.
+func (*AcceptorCurrencyConversionRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AcceptorCurrencyConversionRequestV01) GetHeader() *modelpb.Header7 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCurrencyConversionRequestV01) GetCurrencyConversionRequest() *modelpb.AcceptorCurrencyConversionRequest1 {
+	if x != nil {
+		return x.CurrencyConversionRequest
+	}
+	return nil
+}
+
+func (x *AcceptorCurrencyConversionRequestV01) GetSecurityTrailer() *modelpb.ContentInformationType8 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCurrencyConversionRequest message is sent by the card acceptor to the currency conversion service provider to request if the cardholder is able to pay in the currency of its card.
+type AcceptorCurrencyConversionRequestV02 struct {
+	state                     protoimpl.MessageState                      `protogen:"open.v1"`
+	Header                    *modelpb.Header10                           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CurrencyConversionRequest *modelpb.AcceptorCurrencyConversionRequest2 `protobuf:"bytes,2,opt,name=currency_conversion_request,json=currencyConversionRequest,proto3" json:"currency_conversion_request,omitempty"`
+	SecurityTrailer           *modelpb.ContentInformationType11           `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *AcceptorCurrencyConversionRequestV02) Reset() {
+	*x = AcceptorCurrencyConversionRequestV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCurrencyConversionRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCurrencyConversionRequestV02) ProtoMessage() {}
+
+func (x *AcceptorCurrencyConversionRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCurrencyConversionRequestV02.ProtoReflect.Descriptor instead.
+func (*AcceptorCurrencyConversionRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AcceptorCurrencyConversionRequestV02) GetHeader() *modelpb.Header10 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCurrencyConversionRequestV02) GetCurrencyConversionRequest() *modelpb.AcceptorCurrencyConversionRequest2 {
+	if x != nil {
+		return x.CurrencyConversionRequest
+	}
+	return nil
+}
+
+func (x *AcceptorCurrencyConversionRequestV02) GetSecurityTrailer() *modelpb.ContentInformationType11 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCurrencyConversionRequest message is sent by the card acceptor to the currency conversion service provider to request if the cardholder is able to pay in the currency of its card.
+type AcceptorCurrencyConversionRequestV03 struct {
+	state                     protoimpl.MessageState                      `protogen:"open.v1"`
+	Header                    *modelpb.Header30                           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CurrencyConversionRequest *modelpb.AcceptorCurrencyConversionRequest3 `protobuf:"bytes,2,opt,name=currency_conversion_request,json=currencyConversionRequest,proto3" json:"currency_conversion_request,omitempty"`
+	SecurityTrailer           *modelpb.ContentInformationType15           `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *AcceptorCurrencyConversionRequestV03) Reset() {
+	*x = AcceptorCurrencyConversionRequestV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCurrencyConversionRequestV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCurrencyConversionRequestV03) ProtoMessage() {}
+
+func (x *AcceptorCurrencyConversionRequestV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCurrencyConversionRequestV03.ProtoReflect.Descriptor instead.
+func (*AcceptorCurrencyConversionRequestV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AcceptorCurrencyConversionRequestV03) GetHeader() *modelpb.Header30 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCurrencyConversionRequestV03) GetCurrencyConversionRequest() *modelpb.AcceptorCurrencyConversionRequest3 {
+	if x != nil {
+		return x.CurrencyConversionRequest
+	}
+	return nil
+}
+
+func (x *AcceptorCurrencyConversionRequestV03) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCurrencyConversionResponse message is sent by currency conversion service provider to the card acceptor to return the result of a potential currency conversion for the cardholder.
+type AcceptorCurrencyConversionResponseV01 struct {
+	state                      protoimpl.MessageState                       `protogen:"open.v1"`
+	Header                     *modelpb.Header7                             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CurrencyConversionResponse *modelpb.AcceptorCurrencyConversionResponse1 `protobuf:"bytes,2,opt,name=currency_conversion_response,json=currencyConversionResponse,proto3" json:"currency_conversion_response,omitempty"`
+	SecurityTrailer            *modelpb.ContentInformationType8             `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *AcceptorCurrencyConversionResponseV01) Reset() {
+	*x = AcceptorCurrencyConversionResponseV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCurrencyConversionResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCurrencyConversionResponseV01) ProtoMessage() {}
+
+func (x *AcceptorCurrencyConversionResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCurrencyConversionResponseV01.ProtoReflect.Descriptor instead.
+func (*AcceptorCurrencyConversionResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AcceptorCurrencyConversionResponseV01) GetHeader() *modelpb.Header7 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCurrencyConversionResponseV01) GetCurrencyConversionResponse() *modelpb.AcceptorCurrencyConversionResponse1 {
+	if x != nil {
+		return x.CurrencyConversionResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCurrencyConversionResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType8 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCurrencyConversionResponse message is sent by currency conversion service provider to the card acceptor to return the result of a potential currency conversion for the cardholder.
+type AcceptorCurrencyConversionResponseV02 struct {
+	state                      protoimpl.MessageState                       `protogen:"open.v1"`
+	Header                     *modelpb.Header10                            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CurrencyConversionResponse *modelpb.AcceptorCurrencyConversionResponse2 `protobuf:"bytes,2,opt,name=currency_conversion_response,json=currencyConversionResponse,proto3" json:"currency_conversion_response,omitempty"`
+	SecurityTrailer            *modelpb.ContentInformationType11            `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *AcceptorCurrencyConversionResponseV02) Reset() {
+	*x = AcceptorCurrencyConversionResponseV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCurrencyConversionResponseV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCurrencyConversionResponseV02) ProtoMessage() {}
+
+func (x *AcceptorCurrencyConversionResponseV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCurrencyConversionResponseV02.ProtoReflect.Descriptor instead.
+func (*AcceptorCurrencyConversionResponseV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AcceptorCurrencyConversionResponseV02) GetHeader() *modelpb.Header10 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCurrencyConversionResponseV02) GetCurrencyConversionResponse() *modelpb.AcceptorCurrencyConversionResponse2 {
+	if x != nil {
+		return x.CurrencyConversionResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCurrencyConversionResponseV02) GetSecurityTrailer() *modelpb.ContentInformationType11 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorCurrencyConversionResponse message is sent by currency conversion service provider to the card acceptor to return the result of a potential currency conversion for the cardholder.
+type AcceptorCurrencyConversionResponseV03 struct {
+	state                      protoimpl.MessageState                       `protogen:"open.v1"`
+	Header                     *modelpb.Header30                            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CurrencyConversionResponse *modelpb.AcceptorCurrencyConversionResponse3 `protobuf:"bytes,2,opt,name=currency_conversion_response,json=currencyConversionResponse,proto3" json:"currency_conversion_response,omitempty"`
+	SecurityTrailer            *modelpb.ContentInformationType15            `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *AcceptorCurrencyConversionResponseV03) Reset() {
+	*x = AcceptorCurrencyConversionResponseV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorCurrencyConversionResponseV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorCurrencyConversionResponseV03) ProtoMessage() {}
+
+func (x *AcceptorCurrencyConversionResponseV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorCurrencyConversionResponseV03.ProtoReflect.Descriptor instead.
+func (*AcceptorCurrencyConversionResponseV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AcceptorCurrencyConversionResponseV03) GetHeader() *modelpb.Header30 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorCurrencyConversionResponseV03) GetCurrencyConversionResponse() *modelpb.AcceptorCurrencyConversionResponse3 {
+	if x != nil {
+		return x.CurrencyConversionResponse
+	}
+	return nil
+}
+
+func (x *AcceptorCurrencyConversionResponseV03) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The AcceptorDiagnosticRequest message is sent by the card acceptor to the acquirer to ensure the availability of the acquirer. An agent never forwards the message.
+// Usage
+// The AcceptorDiagnosticRequest message is used to:
+// - test the availability of the acquirer;
+// - validate the security of the exchanges with the acquirer;
+// - validate the version of the configuration parameters.
+type AcceptorDiagnosticRequestV01 struct {
+	state             protoimpl.MessageState              `protogen:"open.v1"`
+	Header            *modelpb.Header1                    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DiagnosticRequest *modelpb.AcceptorDiagnosticRequest1 `protobuf:"bytes,2,opt,name=diagnostic_request,json=diagnosticRequest,proto3" json:"diagnostic_request,omitempty"`
+	SecurityTrailer   *modelpb.ContentInformationType3    `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AcceptorDiagnosticRequestV01) Reset() {
+	*x = AcceptorDiagnosticRequestV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorDiagnosticRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorDiagnosticRequestV01) ProtoMessage() {}
+
+func (x *AcceptorDiagnosticRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorDiagnosticRequestV01.ProtoReflect.Descriptor instead.
+func (*AcceptorDiagnosticRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AcceptorDiagnosticRequestV01) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticRequestV01) GetDiagnosticRequest() *modelpb.AcceptorDiagnosticRequest1 {
+	if x != nil {
+		return x.DiagnosticRequest
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticRequestV01) GetSecurityTrailer() *modelpb.ContentInformationType3 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorDiagnosticRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check the end-to-end communication, to test the availability of this acquirer, or to validate the security environment.
+type AcceptorDiagnosticRequestV02 struct {
+	state             protoimpl.MessageState              `protogen:"open.v1"`
+	Header            *modelpb.Header1                    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DiagnosticRequest *modelpb.AcceptorDiagnosticRequest2 `protobuf:"bytes,2,opt,name=diagnostic_request,json=diagnosticRequest,proto3" json:"diagnostic_request,omitempty"`
+	SecurityTrailer   *modelpb.ContentInformationType6    `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AcceptorDiagnosticRequestV02) Reset() {
+	*x = AcceptorDiagnosticRequestV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorDiagnosticRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorDiagnosticRequestV02) ProtoMessage() {}
+
+func (x *AcceptorDiagnosticRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorDiagnosticRequestV02.ProtoReflect.Descriptor instead.
+func (*AcceptorDiagnosticRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AcceptorDiagnosticRequestV02) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticRequestV02) GetDiagnosticRequest() *modelpb.AcceptorDiagnosticRequest2 {
+	if x != nil {
+		return x.DiagnosticRequest
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticRequestV02) GetSecurityTrailer() *modelpb.ContentInformationType6 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorDiagnosticRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check the end-to-end communication, to test the availability of this acquirer, or to validate the security environment.
+type AcceptorDiagnosticRequestV03 struct {
+	state             protoimpl.MessageState              `protogen:"open.v1"`
+	Header            *modelpb.Header7                    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DiagnosticRequest *modelpb.AcceptorDiagnosticRequest3 `protobuf:"bytes,2,opt,name=diagnostic_request,json=diagnosticRequest,proto3" json:"diagnostic_request,omitempty"`
+	SecurityTrailer   *modelpb.ContentInformationType8    `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AcceptorDiagnosticRequestV03) Reset() {
+	*x = AcceptorDiagnosticRequestV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorDiagnosticRequestV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorDiagnosticRequestV03) ProtoMessage() {}
+
+func (x *AcceptorDiagnosticRequestV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorDiagnosticRequestV03.ProtoReflect.Descriptor instead.
+func (*AcceptorDiagnosticRequestV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AcceptorDiagnosticRequestV03) GetHeader() *modelpb.Header7 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticRequestV03) GetDiagnosticRequest() *modelpb.AcceptorDiagnosticRequest3 {
+	if x != nil {
+		return x.DiagnosticRequest
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticRequestV03) GetSecurityTrailer() *modelpb.ContentInformationType8 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorDiagnosticRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check the end-to-end communication, to test the availability of this acquirer, or to validate the security environment.
+type AcceptorDiagnosticRequestV04 struct {
+	state             protoimpl.MessageState              `protogen:"open.v1"`
+	Header            *modelpb.Header10                   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DiagnosticRequest *modelpb.AcceptorDiagnosticRequest4 `protobuf:"bytes,2,opt,name=diagnostic_request,json=diagnosticRequest,proto3" json:"diagnostic_request,omitempty"`
+	SecurityTrailer   *modelpb.ContentInformationType11   `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AcceptorDiagnosticRequestV04) Reset() {
+	*x = AcceptorDiagnosticRequestV04{}
+	mi := &file_iso20022_caaa_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorDiagnosticRequestV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorDiagnosticRequestV04) ProtoMessage() {}
+
+func (x *AcceptorDiagnosticRequestV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorDiagnosticRequestV04.ProtoReflect.Descriptor instead.
+func (*AcceptorDiagnosticRequestV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AcceptorDiagnosticRequestV04) GetHeader() *modelpb.Header10 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticRequestV04) GetDiagnosticRequest() *modelpb.AcceptorDiagnosticRequest4 {
+	if x != nil {
+		return x.DiagnosticRequest
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticRequestV04) GetSecurityTrailer() *modelpb.ContentInformationType11 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorDiagnosticRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to check the end-to-end communication, to test the availability of this acquirer, or to validate the security environment.
+type AcceptorDiagnosticRequestV05 struct {
+	state             protoimpl.MessageState              `protogen:"open.v1"`
+	Header            *modelpb.Header30                   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DiagnosticRequest *modelpb.AcceptorDiagnosticRequest5 `protobuf:"bytes,2,opt,name=diagnostic_request,json=diagnosticRequest,proto3" json:"diagnostic_request,omitempty"`
+	SecurityTrailer   *modelpb.ContentInformationType15   `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AcceptorDiagnosticRequestV05) Reset() {
+	*x = AcceptorDiagnosticRequestV05{}
+	mi := &file_iso20022_caaa_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorDiagnosticRequestV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorDiagnosticRequestV05) ProtoMessage() {}
+
+func (x *AcceptorDiagnosticRequestV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorDiagnosticRequestV05.ProtoReflect.Descriptor instead.
+func (*AcceptorDiagnosticRequestV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AcceptorDiagnosticRequestV05) GetHeader() *modelpb.Header30 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticRequestV05) GetDiagnosticRequest() *modelpb.AcceptorDiagnosticRequest5 {
+	if x != nil {
+		return x.DiagnosticRequest
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticRequestV05) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The AcceptorDiagnosticResponse message is sent by the acquirer to the card acceptor to confirm the availability of the acquirer. An agent never forwards the message.
+// Usage
+// The AcceptorDiagnosticResponse message is used to:
+// - confirm the availability of the acquirer;
+// - validate the security of the exchanges with the acquirer;
+// - validate the version of the configuration parameters.
+type AcceptorDiagnosticResponseV01 struct {
+	state              protoimpl.MessageState               `protogen:"open.v1"`
+	Header             *modelpb.Header1                     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DiagnosticResponse *modelpb.AcceptorDiagnosticResponse1 `protobuf:"bytes,2,opt,name=diagnostic_response,json=diagnosticResponse,proto3" json:"diagnostic_response,omitempty"`
+	SecurityTrailer    *modelpb.ContentInformationType3     `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AcceptorDiagnosticResponseV01) Reset() {
+	*x = AcceptorDiagnosticResponseV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorDiagnosticResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorDiagnosticResponseV01) ProtoMessage() {}
+
+func (x *AcceptorDiagnosticResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorDiagnosticResponseV01.ProtoReflect.Descriptor instead.
+func (*AcceptorDiagnosticResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AcceptorDiagnosticResponseV01) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticResponseV01) GetDiagnosticResponse() *modelpb.AcceptorDiagnosticResponse1 {
+	if x != nil {
+		return x.DiagnosticResponse
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType3 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorDiagnosticResponse message is sent by the acquirer (or its agent) to provide to the acceptor the result of the diagnostic request.
+type AcceptorDiagnosticResponseV02 struct {
+	state              protoimpl.MessageState               `protogen:"open.v1"`
+	Header             *modelpb.Header1                     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DiagnosticResponse *modelpb.AcceptorDiagnosticResponse2 `protobuf:"bytes,2,opt,name=diagnostic_response,json=diagnosticResponse,proto3" json:"diagnostic_response,omitempty"`
+	SecurityTrailer    *modelpb.ContentInformationType6     `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AcceptorDiagnosticResponseV02) Reset() {
+	*x = AcceptorDiagnosticResponseV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorDiagnosticResponseV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorDiagnosticResponseV02) ProtoMessage() {}
+
+func (x *AcceptorDiagnosticResponseV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorDiagnosticResponseV02.ProtoReflect.Descriptor instead.
+func (*AcceptorDiagnosticResponseV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AcceptorDiagnosticResponseV02) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticResponseV02) GetDiagnosticResponse() *modelpb.AcceptorDiagnosticResponse2 {
+	if x != nil {
+		return x.DiagnosticResponse
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticResponseV02) GetSecurityTrailer() *modelpb.ContentInformationType6 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorDiagnosticResponse message is sent by the acquirer (or its agent) to provide to the acceptor the result of the diagnostic request.
+type AcceptorDiagnosticResponseV03 struct {
+	state              protoimpl.MessageState               `protogen:"open.v1"`
+	Header             *modelpb.Header7                     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DiagnosticResponse *modelpb.AcceptorDiagnosticResponse3 `protobuf:"bytes,2,opt,name=diagnostic_response,json=diagnosticResponse,proto3" json:"diagnostic_response,omitempty"`
+	SecurityTrailer    *modelpb.ContentInformationType8     `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AcceptorDiagnosticResponseV03) Reset() {
+	*x = AcceptorDiagnosticResponseV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorDiagnosticResponseV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorDiagnosticResponseV03) ProtoMessage() {}
+
+func (x *AcceptorDiagnosticResponseV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorDiagnosticResponseV03.ProtoReflect.Descriptor instead.
+func (*AcceptorDiagnosticResponseV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AcceptorDiagnosticResponseV03) GetHeader() *modelpb.Header7 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticResponseV03) GetDiagnosticResponse() *modelpb.AcceptorDiagnosticResponse3 {
+	if x != nil {
+		return x.DiagnosticResponse
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticResponseV03) GetSecurityTrailer() *modelpb.ContentInformationType8 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorDiagnosticResponse message is sent by the acquirer (or its agent) to provide to the acceptor the result of the diagnostic request.
+type AcceptorDiagnosticResponseV04 struct {
+	state              protoimpl.MessageState               `protogen:"open.v1"`
+	Header             *modelpb.Header10                    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DiagnosticResponse *modelpb.AcceptorDiagnosticResponse4 `protobuf:"bytes,2,opt,name=diagnostic_response,json=diagnosticResponse,proto3" json:"diagnostic_response,omitempty"`
+	SecurityTrailer    *modelpb.ContentInformationType11    `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AcceptorDiagnosticResponseV04) Reset() {
+	*x = AcceptorDiagnosticResponseV04{}
+	mi := &file_iso20022_caaa_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorDiagnosticResponseV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorDiagnosticResponseV04) ProtoMessage() {}
+
+func (x *AcceptorDiagnosticResponseV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorDiagnosticResponseV04.ProtoReflect.Descriptor instead.
+func (*AcceptorDiagnosticResponseV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AcceptorDiagnosticResponseV04) GetHeader() *modelpb.Header10 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticResponseV04) GetDiagnosticResponse() *modelpb.AcceptorDiagnosticResponse4 {
+	if x != nil {
+		return x.DiagnosticResponse
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticResponseV04) GetSecurityTrailer() *modelpb.ContentInformationType11 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorDiagnosticResponse message is sent by the acquirer (or its agent) to provide to the acceptor the result of the diagnostic request.
+type AcceptorDiagnosticResponseV05 struct {
+	state              protoimpl.MessageState               `protogen:"open.v1"`
+	Header             *modelpb.Header30                    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DiagnosticResponse *modelpb.AcceptorDiagnosticResponse4 `protobuf:"bytes,2,opt,name=diagnostic_response,json=diagnosticResponse,proto3" json:"diagnostic_response,omitempty"`
+	SecurityTrailer    *modelpb.ContentInformationType15    `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AcceptorDiagnosticResponseV05) Reset() {
+	*x = AcceptorDiagnosticResponseV05{}
+	mi := &file_iso20022_caaa_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorDiagnosticResponseV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorDiagnosticResponseV05) ProtoMessage() {}
+
+func (x *AcceptorDiagnosticResponseV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorDiagnosticResponseV05.ProtoReflect.Descriptor instead.
+func (*AcceptorDiagnosticResponseV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AcceptorDiagnosticResponseV05) GetHeader() *modelpb.Header30 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticResponseV05) GetDiagnosticResponse() *modelpb.AcceptorDiagnosticResponse4 {
+	if x != nil {
+		return x.DiagnosticResponse
+	}
+	return nil
+}
+
+func (x *AcceptorDiagnosticResponseV05) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The AcceptorReconciliationRequest message is sent by the card acceptor to the acquirer or an agent to communicate the totals of the card payment transaction for a reconciliation period. An agent never forwards the message.
+// Usage
+// The AcceptorReconciliationRequest message is used to ensure that the debits and credits correspond to the balances computed by the acquirer or its agent for the same reconciliation period.
+// The AcceptorReconciliationRequest message is also used to close a reconciliation period.
+type AcceptorReconciliationRequestV01 struct {
+	state                 protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                *modelpb.Header1                        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ReconciliationRequest *modelpb.AcceptorReconciliationRequest1 `protobuf:"bytes,2,opt,name=reconciliation_request,json=reconciliationRequest,proto3" json:"reconciliation_request,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType3        `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorReconciliationRequestV01) Reset() {
+	*x = AcceptorReconciliationRequestV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorReconciliationRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorReconciliationRequestV01) ProtoMessage() {}
+
+func (x *AcceptorReconciliationRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorReconciliationRequestV01.ProtoReflect.Descriptor instead.
+func (*AcceptorReconciliationRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AcceptorReconciliationRequestV01) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationRequestV01) GetReconciliationRequest() *modelpb.AcceptorReconciliationRequest1 {
+	if x != nil {
+		return x.ReconciliationRequest
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationRequestV01) GetSecurityTrailer() *modelpb.ContentInformationType3 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorReconciliationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to ensure that the debits and credits performed by the acceptor matches the computed balances of the acquirer for the debits and credits performed during the same reconciliation period.
+// If the acceptor or the acquirer notices a difference in totals, the discrepancy will be resolved by other means, outside the scope of the protocol.
+type AcceptorReconciliationRequestV02 struct {
+	state                 protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                *modelpb.Header1                        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ReconciliationRequest *modelpb.AcceptorReconciliationRequest2 `protobuf:"bytes,2,opt,name=reconciliation_request,json=reconciliationRequest,proto3" json:"reconciliation_request,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType6        `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorReconciliationRequestV02) Reset() {
+	*x = AcceptorReconciliationRequestV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorReconciliationRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorReconciliationRequestV02) ProtoMessage() {}
+
+func (x *AcceptorReconciliationRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorReconciliationRequestV02.ProtoReflect.Descriptor instead.
+func (*AcceptorReconciliationRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AcceptorReconciliationRequestV02) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationRequestV02) GetReconciliationRequest() *modelpb.AcceptorReconciliationRequest2 {
+	if x != nil {
+		return x.ReconciliationRequest
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationRequestV02) GetSecurityTrailer() *modelpb.ContentInformationType6 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorReconciliationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to ensure that the debits and credits performed by the acceptor matches the computed balances of the acquirer for the debits and credits performed during the same reconciliation period.
+// If the acceptor or the acquirer notices a difference in totals, the discrepancy will be resolved by other means, outside the scope of the protocol.
+type AcceptorReconciliationRequestV03 struct {
+	state                 protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                *modelpb.Header7                        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ReconciliationRequest *modelpb.AcceptorReconciliationRequest3 `protobuf:"bytes,2,opt,name=reconciliation_request,json=reconciliationRequest,proto3" json:"reconciliation_request,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType8        `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorReconciliationRequestV03) Reset() {
+	*x = AcceptorReconciliationRequestV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorReconciliationRequestV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorReconciliationRequestV03) ProtoMessage() {}
+
+func (x *AcceptorReconciliationRequestV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorReconciliationRequestV03.ProtoReflect.Descriptor instead.
+func (*AcceptorReconciliationRequestV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AcceptorReconciliationRequestV03) GetHeader() *modelpb.Header7 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationRequestV03) GetReconciliationRequest() *modelpb.AcceptorReconciliationRequest3 {
+	if x != nil {
+		return x.ReconciliationRequest
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationRequestV03) GetSecurityTrailer() *modelpb.ContentInformationType8 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorReconciliationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to ensure that the debits and credits performed by the acceptor matches the computed balances of the acquirer for the debits and credits performed during the same reconciliation period.
+// If the acceptor or the acquirer notices a difference in totals, the discrepancy will be resolved by other means, outside the scope of the protocol.
+type AcceptorReconciliationRequestV04 struct {
+	state                 protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                *modelpb.Header10                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ReconciliationRequest *modelpb.AcceptorReconciliationRequest4 `protobuf:"bytes,2,opt,name=reconciliation_request,json=reconciliationRequest,proto3" json:"reconciliation_request,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType11       `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorReconciliationRequestV04) Reset() {
+	*x = AcceptorReconciliationRequestV04{}
+	mi := &file_iso20022_caaa_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorReconciliationRequestV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorReconciliationRequestV04) ProtoMessage() {}
+
+func (x *AcceptorReconciliationRequestV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorReconciliationRequestV04.ProtoReflect.Descriptor instead.
+func (*AcceptorReconciliationRequestV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AcceptorReconciliationRequestV04) GetHeader() *modelpb.Header10 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationRequestV04) GetReconciliationRequest() *modelpb.AcceptorReconciliationRequest4 {
+	if x != nil {
+		return x.ReconciliationRequest
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationRequestV04) GetSecurityTrailer() *modelpb.ContentInformationType11 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorReconciliationRequest message is sent by an acceptor (or its agent) to the acquirer (or its agent) , to ensure that the debits and credits performed by the acceptor matches the computed balances of the acquirer for the debits and credits performed during the same reconciliation period.
+// If the acceptor or the acquirer notices a difference in totals, the discrepancy will be resolved by other means, outside the scope of the protocol.
+type AcceptorReconciliationRequestV05 struct {
+	state                 protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                *modelpb.Header30                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ReconciliationRequest *modelpb.AcceptorReconciliationRequest5 `protobuf:"bytes,2,opt,name=reconciliation_request,json=reconciliationRequest,proto3" json:"reconciliation_request,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType15       `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorReconciliationRequestV05) Reset() {
+	*x = AcceptorReconciliationRequestV05{}
+	mi := &file_iso20022_caaa_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorReconciliationRequestV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorReconciliationRequestV05) ProtoMessage() {}
+
+func (x *AcceptorReconciliationRequestV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorReconciliationRequestV05.ProtoReflect.Descriptor instead.
+func (*AcceptorReconciliationRequestV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AcceptorReconciliationRequestV05) GetHeader() *modelpb.Header30 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationRequestV05) GetReconciliationRequest() *modelpb.AcceptorReconciliationRequest5 {
+	if x != nil {
+		return x.ReconciliationRequest
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationRequestV05) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The AcceptorReconciliationResponse message is sent by the acquirer to communicate to the card acceptor the totals of the card payment transaction performed for the reconciliation period. An agent never forwards the message.
+// Usage
+// The AcceptorReconciliationResponse message is used to compare the totals between a card acceptor and an acquirer for the reconciliation period.
+type AcceptorReconciliationResponseV01 struct {
+	state                  protoimpl.MessageState                   `protogen:"open.v1"`
+	Header                 *modelpb.Header1                         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ReconciliationResponse *modelpb.AcceptorReconciliationResponse1 `protobuf:"bytes,2,opt,name=reconciliation_response,json=reconciliationResponse,proto3" json:"reconciliation_response,omitempty"`
+	SecurityTrailer        *modelpb.ContentInformationType3         `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AcceptorReconciliationResponseV01) Reset() {
+	*x = AcceptorReconciliationResponseV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorReconciliationResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorReconciliationResponseV01) ProtoMessage() {}
+
+func (x *AcceptorReconciliationResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorReconciliationResponseV01.ProtoReflect.Descriptor instead.
+func (*AcceptorReconciliationResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AcceptorReconciliationResponseV01) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationResponseV01) GetReconciliationResponse() *modelpb.AcceptorReconciliationResponse1 {
+	if x != nil {
+		return x.ReconciliationResponse
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType3 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorReconciliationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to ensure that the debits and credits performed by the acceptor matches the computed balances of the acquirer for the debits and credits performed during the same reconciliation period.
+// If the acceptor or the acquirer notices a difference in totals, the discrepancy will be resolved by other means, outside the scope of the protocol.
+type AcceptorReconciliationResponseV02 struct {
+	state                  protoimpl.MessageState                   `protogen:"open.v1"`
+	Header                 *modelpb.Header1                         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ReconciliationResponse *modelpb.AcceptorReconciliationResponse2 `protobuf:"bytes,2,opt,name=reconciliation_response,json=reconciliationResponse,proto3" json:"reconciliation_response,omitempty"`
+	SecurityTrailer        *modelpb.ContentInformationType6         `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AcceptorReconciliationResponseV02) Reset() {
+	*x = AcceptorReconciliationResponseV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorReconciliationResponseV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorReconciliationResponseV02) ProtoMessage() {}
+
+func (x *AcceptorReconciliationResponseV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorReconciliationResponseV02.ProtoReflect.Descriptor instead.
+func (*AcceptorReconciliationResponseV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AcceptorReconciliationResponseV02) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationResponseV02) GetReconciliationResponse() *modelpb.AcceptorReconciliationResponse2 {
+	if x != nil {
+		return x.ReconciliationResponse
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationResponseV02) GetSecurityTrailer() *modelpb.ContentInformationType6 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorReconciliationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to ensure that the debits and credits performed by the acceptor matches the computed balances of the acquirer for the debits and credits performed during the same reconciliation period.
+// If the acceptor or the acquirer notices a difference in totals, the discrepancy will be resolved by other means, outside the scope of the protocol.
+type AcceptorReconciliationResponseV03 struct {
+	state                  protoimpl.MessageState                   `protogen:"open.v1"`
+	Header                 *modelpb.Header7                         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ReconciliationResponse *modelpb.AcceptorReconciliationResponse2 `protobuf:"bytes,2,opt,name=reconciliation_response,json=reconciliationResponse,proto3" json:"reconciliation_response,omitempty"`
+	SecurityTrailer        *modelpb.ContentInformationType8         `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AcceptorReconciliationResponseV03) Reset() {
+	*x = AcceptorReconciliationResponseV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorReconciliationResponseV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorReconciliationResponseV03) ProtoMessage() {}
+
+func (x *AcceptorReconciliationResponseV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorReconciliationResponseV03.ProtoReflect.Descriptor instead.
+func (*AcceptorReconciliationResponseV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AcceptorReconciliationResponseV03) GetHeader() *modelpb.Header7 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationResponseV03) GetReconciliationResponse() *modelpb.AcceptorReconciliationResponse2 {
+	if x != nil {
+		return x.ReconciliationResponse
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationResponseV03) GetSecurityTrailer() *modelpb.ContentInformationType8 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorReconciliationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to ensure that the debits and credits performed by the acceptor matches the computed balances of the acquirer for the debits and credits performed during the same reconciliation period.
+// If the acceptor or the acquirer notices a difference in totals, the discrepancy will be resolved by other means, outside the scope of the protocol.
+type AcceptorReconciliationResponseV04 struct {
+	state                  protoimpl.MessageState                   `protogen:"open.v1"`
+	Header                 *modelpb.Header10                        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ReconciliationResponse *modelpb.AcceptorReconciliationResponse3 `protobuf:"bytes,2,opt,name=reconciliation_response,json=reconciliationResponse,proto3" json:"reconciliation_response,omitempty"`
+	SecurityTrailer        *modelpb.ContentInformationType11        `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AcceptorReconciliationResponseV04) Reset() {
+	*x = AcceptorReconciliationResponseV04{}
+	mi := &file_iso20022_caaa_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorReconciliationResponseV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorReconciliationResponseV04) ProtoMessage() {}
+
+func (x *AcceptorReconciliationResponseV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorReconciliationResponseV04.ProtoReflect.Descriptor instead.
+func (*AcceptorReconciliationResponseV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AcceptorReconciliationResponseV04) GetHeader() *modelpb.Header10 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationResponseV04) GetReconciliationResponse() *modelpb.AcceptorReconciliationResponse3 {
+	if x != nil {
+		return x.ReconciliationResponse
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationResponseV04) GetSecurityTrailer() *modelpb.ContentInformationType11 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcceptorReconciliationResponse message is sent by the acquirer (or its agent) to an acceptor (or its agent), to ensure that the debits and credits performed by the acceptor matches the computed balances of the acquirer for the debits and credits performed during the same reconciliation period.
+// If the acceptor or the acquirer notices a difference in totals, the discrepancy will be resolved by other means, outside the scope of the protocol.
+type AcceptorReconciliationResponseV05 struct {
+	state                  protoimpl.MessageState                   `protogen:"open.v1"`
+	Header                 *modelpb.Header30                        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ReconciliationResponse *modelpb.AcceptorReconciliationResponse4 `protobuf:"bytes,2,opt,name=reconciliation_response,json=reconciliationResponse,proto3" json:"reconciliation_response,omitempty"`
+	SecurityTrailer        *modelpb.ContentInformationType15        `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AcceptorReconciliationResponseV05) Reset() {
+	*x = AcceptorReconciliationResponseV05{}
+	mi := &file_iso20022_caaa_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorReconciliationResponseV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorReconciliationResponseV05) ProtoMessage() {}
+
+func (x *AcceptorReconciliationResponseV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorReconciliationResponseV05.ProtoReflect.Descriptor instead.
+func (*AcceptorReconciliationResponseV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AcceptorReconciliationResponseV05) GetHeader() *modelpb.Header30 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationResponseV05) GetReconciliationResponse() *modelpb.AcceptorReconciliationResponse4 {
+	if x != nil {
+		return x.ReconciliationResponse
+	}
+	return nil
+}
+
+func (x *AcceptorReconciliationResponseV05) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The AcceptorRejection message is used by the acquirer to reject a message received from the card acceptor. The acquirer uses this message as a substitute to a response or an advice response message sent to the card acceptor.
+// Usage
+// The AcceptorRejection message is used to indicate that the received message could not be processed by the acquirer (for example, unable to read or process the message, security error, duplicate message).
+type AcceptorRejectionV01 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Header        *modelpb.Header1            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Reject        *modelpb.AcceptorRejection1 `protobuf:"bytes,2,opt,name=reject,proto3" json:"reject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptorRejectionV01) Reset() {
+	*x = AcceptorRejectionV01{}
+	mi := &file_iso20022_caaa_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorRejectionV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorRejectionV01) ProtoMessage() {}
+
+func (x *AcceptorRejectionV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorRejectionV01.ProtoReflect.Descriptor instead.
+func (*AcceptorRejectionV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AcceptorRejectionV01) GetHeader() *modelpb.Header1 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorRejectionV01) GetReject() *modelpb.AcceptorRejection1 {
+	if x != nil {
+		return x.Reject
+	}
+	return nil
+}
+
+// The AcceptorRejection message is sent by the acquirer (or its agent) to reject a message request or advice sent by an acceptor (or its agent), to indicate that the received message could not be processed.
+type AcceptorRejectionV02 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Header        *modelpb.Header5            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Reject        *modelpb.AcceptorRejection1 `protobuf:"bytes,2,opt,name=reject,proto3" json:"reject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptorRejectionV02) Reset() {
+	*x = AcceptorRejectionV02{}
+	mi := &file_iso20022_caaa_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorRejectionV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorRejectionV02) ProtoMessage() {}
+
+func (x *AcceptorRejectionV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorRejectionV02.ProtoReflect.Descriptor instead.
+func (*AcceptorRejectionV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AcceptorRejectionV02) GetHeader() *modelpb.Header5 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorRejectionV02) GetReject() *modelpb.AcceptorRejection1 {
+	if x != nil {
+		return x.Reject
+	}
+	return nil
+}
+
+// The AcceptorRejection message is sent by the acquirer (or its agent) to reject a message request or advice sent by an acceptor (or its agent), to indicate that the received message could not be processed.
+type AcceptorRejectionV03 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Header        *modelpb.Header9            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Reject        *modelpb.AcceptorRejection2 `protobuf:"bytes,2,opt,name=reject,proto3" json:"reject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptorRejectionV03) Reset() {
+	*x = AcceptorRejectionV03{}
+	mi := &file_iso20022_caaa_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorRejectionV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorRejectionV03) ProtoMessage() {}
+
+func (x *AcceptorRejectionV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorRejectionV03.ProtoReflect.Descriptor instead.
+func (*AcceptorRejectionV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AcceptorRejectionV03) GetHeader() *modelpb.Header9 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorRejectionV03) GetReject() *modelpb.AcceptorRejection2 {
+	if x != nil {
+		return x.Reject
+	}
+	return nil
+}
+
+// The AcceptorRejection message is sent by the acquirer (or its agent) to reject a message request or advice sent by an acceptor (or its agent), to indicate that the received message could not be processed.
+type AcceptorRejectionV04 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Header        *modelpb.Header13           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Reject        *modelpb.AcceptorRejection2 `protobuf:"bytes,2,opt,name=reject,proto3" json:"reject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptorRejectionV04) Reset() {
+	*x = AcceptorRejectionV04{}
+	mi := &file_iso20022_caaa_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorRejectionV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorRejectionV04) ProtoMessage() {}
+
+func (x *AcceptorRejectionV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorRejectionV04.ProtoReflect.Descriptor instead.
+func (*AcceptorRejectionV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AcceptorRejectionV04) GetHeader() *modelpb.Header13 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorRejectionV04) GetReject() *modelpb.AcceptorRejection2 {
+	if x != nil {
+		return x.Reject
+	}
+	return nil
+}
+
+// The AcceptorRejection message is sent by the acquirer (or its agent) to reject a message request or advice sent by an acceptor (or its agent), to indicate that the received message could not be processed.
+type AcceptorRejectionV05 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Header        *modelpb.Header26           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Reject        *modelpb.AcceptorRejection2 `protobuf:"bytes,2,opt,name=reject,proto3" json:"reject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptorRejectionV05) Reset() {
+	*x = AcceptorRejectionV05{}
+	mi := &file_iso20022_caaa_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorRejectionV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorRejectionV05) ProtoMessage() {}
+
+func (x *AcceptorRejectionV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorRejectionV05.ProtoReflect.Descriptor instead.
+func (*AcceptorRejectionV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AcceptorRejectionV05) GetHeader() *modelpb.Header26 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorRejectionV05) GetReject() *modelpb.AcceptorRejection2 {
+	if x != nil {
+		return x.Reject
+	}
+	return nil
+}
+
+type Document00100101 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *AcceptorAuthorisationRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100101) Reset() {
+	*x = Document00100101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100101) ProtoMessage() {}
+
+func (x *Document00100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100101.ProtoReflect.Descriptor instead.
+func (*Document00100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Document00100101) GetMessage() *AcceptorAuthorisationRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00100102 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *AcceptorAuthorisationRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100102) Reset() {
+	*x = Document00100102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100102) ProtoMessage() {}
+
+func (x *Document00100102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100102.ProtoReflect.Descriptor instead.
+func (*Document00100102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Document00100102) GetMessage() *AcceptorAuthorisationRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00100103 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *AcceptorAuthorisationRequestV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100103) Reset() {
+	*x = Document00100103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100103) ProtoMessage() {}
+
+func (x *Document00100103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100103.ProtoReflect.Descriptor instead.
+func (*Document00100103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Document00100103) GetMessage() *AcceptorAuthorisationRequestV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00100104 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *AcceptorAuthorisationRequestV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100104) Reset() {
+	*x = Document00100104{}
+	mi := &file_iso20022_caaa_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100104) ProtoMessage() {}
+
+func (x *Document00100104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100104.ProtoReflect.Descriptor instead.
+func (*Document00100104) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Document00100104) GetMessage() *AcceptorAuthorisationRequestV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00100105 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *AcceptorAuthorisationRequestV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100105) Reset() {
+	*x = Document00100105{}
+	mi := &file_iso20022_caaa_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100105) ProtoMessage() {}
+
+func (x *Document00100105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100105.ProtoReflect.Descriptor instead.
+func (*Document00100105) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Document00100105) GetMessage() *AcceptorAuthorisationRequestV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200101 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AcceptorAuthorisationResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200101) Reset() {
+	*x = Document00200101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200101) ProtoMessage() {}
+
+func (x *Document00200101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200101.ProtoReflect.Descriptor instead.
+func (*Document00200101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *Document00200101) GetMessage() *AcceptorAuthorisationResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200102 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AcceptorAuthorisationResponseV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200102) Reset() {
+	*x = Document00200102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200102) ProtoMessage() {}
+
+func (x *Document00200102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200102.ProtoReflect.Descriptor instead.
+func (*Document00200102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *Document00200102) GetMessage() *AcceptorAuthorisationResponseV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200103 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AcceptorAuthorisationResponseV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200103) Reset() {
+	*x = Document00200103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200103) ProtoMessage() {}
+
+func (x *Document00200103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200103.ProtoReflect.Descriptor instead.
+func (*Document00200103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *Document00200103) GetMessage() *AcceptorAuthorisationResponseV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200104 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AcceptorAuthorisationResponseV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200104) Reset() {
+	*x = Document00200104{}
+	mi := &file_iso20022_caaa_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200104) ProtoMessage() {}
+
+func (x *Document00200104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200104.ProtoReflect.Descriptor instead.
+func (*Document00200104) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Document00200104) GetMessage() *AcceptorAuthorisationResponseV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200105 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AcceptorAuthorisationResponseV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200105) Reset() {
+	*x = Document00200105{}
+	mi := &file_iso20022_caaa_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200105) ProtoMessage() {}
+
+func (x *Document00200105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200105.ProtoReflect.Descriptor instead.
+func (*Document00200105) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Document00200105) GetMessage() *AcceptorAuthorisationResponseV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300101 struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Message       *AcceptorCompletionAdviceV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300101) Reset() {
+	*x = Document00300101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300101) ProtoMessage() {}
+
+func (x *Document00300101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300101.ProtoReflect.Descriptor instead.
+func (*Document00300101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *Document00300101) GetMessage() *AcceptorCompletionAdviceV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300102 struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Message       *AcceptorCompletionAdviceV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300102) Reset() {
+	*x = Document00300102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300102) ProtoMessage() {}
+
+func (x *Document00300102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300102.ProtoReflect.Descriptor instead.
+func (*Document00300102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *Document00300102) GetMessage() *AcceptorCompletionAdviceV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300103 struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Message       *AcceptorCompletionAdviceV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300103) Reset() {
+	*x = Document00300103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300103) ProtoMessage() {}
+
+func (x *Document00300103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300103.ProtoReflect.Descriptor instead.
+func (*Document00300103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *Document00300103) GetMessage() *AcceptorCompletionAdviceV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300104 struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Message       *AcceptorCompletionAdviceV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300104) Reset() {
+	*x = Document00300104{}
+	mi := &file_iso20022_caaa_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300104) ProtoMessage() {}
+
+func (x *Document00300104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300104.ProtoReflect.Descriptor instead.
+func (*Document00300104) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *Document00300104) GetMessage() *AcceptorCompletionAdviceV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300105 struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Message       *AcceptorCompletionAdviceV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300105) Reset() {
+	*x = Document00300105{}
+	mi := &file_iso20022_caaa_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300105) ProtoMessage() {}
+
+func (x *Document00300105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300105.ProtoReflect.Descriptor instead.
+func (*Document00300105) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Document00300105) GetMessage() *AcceptorCompletionAdviceV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400101 struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Message       *AcceptorCompletionAdviceResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400101) Reset() {
+	*x = Document00400101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400101) ProtoMessage() {}
+
+func (x *Document00400101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400101.ProtoReflect.Descriptor instead.
+func (*Document00400101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *Document00400101) GetMessage() *AcceptorCompletionAdviceResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400102 struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Message       *AcceptorCompletionAdviceResponseV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400102) Reset() {
+	*x = Document00400102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400102) ProtoMessage() {}
+
+func (x *Document00400102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400102.ProtoReflect.Descriptor instead.
+func (*Document00400102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *Document00400102) GetMessage() *AcceptorCompletionAdviceResponseV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400103 struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Message       *AcceptorCompletionAdviceResponseV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400103) Reset() {
+	*x = Document00400103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400103) ProtoMessage() {}
+
+func (x *Document00400103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400103.ProtoReflect.Descriptor instead.
+func (*Document00400103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Document00400103) GetMessage() *AcceptorCompletionAdviceResponseV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400104 struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Message       *AcceptorCompletionAdviceResponseV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400104) Reset() {
+	*x = Document00400104{}
+	mi := &file_iso20022_caaa_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400104) ProtoMessage() {}
+
+func (x *Document00400104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400104.ProtoReflect.Descriptor instead.
+func (*Document00400104) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Document00400104) GetMessage() *AcceptorCompletionAdviceResponseV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400105 struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Message       *AcceptorCompletionAdviceResponseV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400105) Reset() {
+	*x = Document00400105{}
+	mi := &file_iso20022_caaa_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400105) ProtoMessage() {}
+
+func (x *Document00400105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400105.ProtoReflect.Descriptor instead.
+func (*Document00400105) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *Document00400105) GetMessage() *AcceptorCompletionAdviceResponseV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500101 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *AcceptorCancellationRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500101) Reset() {
+	*x = Document00500101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500101) ProtoMessage() {}
+
+func (x *Document00500101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500101.ProtoReflect.Descriptor instead.
+func (*Document00500101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *Document00500101) GetMessage() *AcceptorCancellationRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500102 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *AcceptorCancellationRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500102) Reset() {
+	*x = Document00500102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500102) ProtoMessage() {}
+
+func (x *Document00500102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500102.ProtoReflect.Descriptor instead.
+func (*Document00500102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *Document00500102) GetMessage() *AcceptorCancellationRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500103 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *AcceptorCancellationRequestV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500103) Reset() {
+	*x = Document00500103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500103) ProtoMessage() {}
+
+func (x *Document00500103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500103.ProtoReflect.Descriptor instead.
+func (*Document00500103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *Document00500103) GetMessage() *AcceptorCancellationRequestV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500104 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *AcceptorCancellationRequestV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500104) Reset() {
+	*x = Document00500104{}
+	mi := &file_iso20022_caaa_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500104) ProtoMessage() {}
+
+func (x *Document00500104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500104.ProtoReflect.Descriptor instead.
+func (*Document00500104) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *Document00500104) GetMessage() *AcceptorCancellationRequestV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500105 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *AcceptorCancellationRequestV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500105) Reset() {
+	*x = Document00500105{}
+	mi := &file_iso20022_caaa_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500105) ProtoMessage() {}
+
+func (x *Document00500105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500105.ProtoReflect.Descriptor instead.
+func (*Document00500105) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *Document00500105) GetMessage() *AcceptorCancellationRequestV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600101 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *AcceptorCancellationResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600101) Reset() {
+	*x = Document00600101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600101) ProtoMessage() {}
+
+func (x *Document00600101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600101.ProtoReflect.Descriptor instead.
+func (*Document00600101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *Document00600101) GetMessage() *AcceptorCancellationResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600102 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *AcceptorCancellationResponseV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600102) Reset() {
+	*x = Document00600102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600102) ProtoMessage() {}
+
+func (x *Document00600102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600102.ProtoReflect.Descriptor instead.
+func (*Document00600102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *Document00600102) GetMessage() *AcceptorCancellationResponseV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600103 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *AcceptorCancellationResponseV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600103) Reset() {
+	*x = Document00600103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600103) ProtoMessage() {}
+
+func (x *Document00600103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600103.ProtoReflect.Descriptor instead.
+func (*Document00600103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *Document00600103) GetMessage() *AcceptorCancellationResponseV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600104 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *AcceptorCancellationResponseV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600104) Reset() {
+	*x = Document00600104{}
+	mi := &file_iso20022_caaa_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600104) ProtoMessage() {}
+
+func (x *Document00600104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600104.ProtoReflect.Descriptor instead.
+func (*Document00600104) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *Document00600104) GetMessage() *AcceptorCancellationResponseV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600105 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *AcceptorCancellationResponseV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600105) Reset() {
+	*x = Document00600105{}
+	mi := &file_iso20022_caaa_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600105) ProtoMessage() {}
+
+func (x *Document00600105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600105.ProtoReflect.Descriptor instead.
+func (*Document00600105) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *Document00600105) GetMessage() *AcceptorCancellationResponseV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00700101 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AcceptorCancellationAdviceV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00700101) Reset() {
+	*x = Document00700101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00700101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00700101) ProtoMessage() {}
+
+func (x *Document00700101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00700101.ProtoReflect.Descriptor instead.
+func (*Document00700101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *Document00700101) GetMessage() *AcceptorCancellationAdviceV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00700102 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AcceptorCancellationAdviceV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00700102) Reset() {
+	*x = Document00700102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00700102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00700102) ProtoMessage() {}
+
+func (x *Document00700102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00700102.ProtoReflect.Descriptor instead.
+func (*Document00700102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *Document00700102) GetMessage() *AcceptorCancellationAdviceV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00700103 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AcceptorCancellationAdviceV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00700103) Reset() {
+	*x = Document00700103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00700103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00700103) ProtoMessage() {}
+
+func (x *Document00700103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00700103.ProtoReflect.Descriptor instead.
+func (*Document00700103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *Document00700103) GetMessage() *AcceptorCancellationAdviceV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00700104 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AcceptorCancellationAdviceV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00700104) Reset() {
+	*x = Document00700104{}
+	mi := &file_iso20022_caaa_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00700104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00700104) ProtoMessage() {}
+
+func (x *Document00700104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00700104.ProtoReflect.Descriptor instead.
+func (*Document00700104) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *Document00700104) GetMessage() *AcceptorCancellationAdviceV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00700105 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AcceptorCancellationAdviceV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00700105) Reset() {
+	*x = Document00700105{}
+	mi := &file_iso20022_caaa_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00700105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00700105) ProtoMessage() {}
+
+func (x *Document00700105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00700105.ProtoReflect.Descriptor instead.
+func (*Document00700105) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *Document00700105) GetMessage() *AcceptorCancellationAdviceV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800101 struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Message       *AcceptorCancellationAdviceResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800101) Reset() {
+	*x = Document00800101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800101) ProtoMessage() {}
+
+func (x *Document00800101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800101.ProtoReflect.Descriptor instead.
+func (*Document00800101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *Document00800101) GetMessage() *AcceptorCancellationAdviceResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800102 struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Message       *AcceptorCancellationAdviceResponseV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800102) Reset() {
+	*x = Document00800102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800102) ProtoMessage() {}
+
+func (x *Document00800102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800102.ProtoReflect.Descriptor instead.
+func (*Document00800102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *Document00800102) GetMessage() *AcceptorCancellationAdviceResponseV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800103 struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Message       *AcceptorCancellationAdviceResponseV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800103) Reset() {
+	*x = Document00800103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800103) ProtoMessage() {}
+
+func (x *Document00800103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800103.ProtoReflect.Descriptor instead.
+func (*Document00800103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *Document00800103) GetMessage() *AcceptorCancellationAdviceResponseV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800104 struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Message       *AcceptorCancellationAdviceResponseV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800104) Reset() {
+	*x = Document00800104{}
+	mi := &file_iso20022_caaa_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800104) ProtoMessage() {}
+
+func (x *Document00800104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800104.ProtoReflect.Descriptor instead.
+func (*Document00800104) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *Document00800104) GetMessage() *AcceptorCancellationAdviceResponseV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800105 struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Message       *AcceptorCancellationAdviceResponseV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800105) Reset() {
+	*x = Document00800105{}
+	mi := &file_iso20022_caaa_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800105) ProtoMessage() {}
+
+func (x *Document00800105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800105.ProtoReflect.Descriptor instead.
+func (*Document00800105) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *Document00800105) GetMessage() *AcceptorCancellationAdviceResponseV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900101 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AcceptorReconciliationRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900101) Reset() {
+	*x = Document00900101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900101) ProtoMessage() {}
+
+func (x *Document00900101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900101.ProtoReflect.Descriptor instead.
+func (*Document00900101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *Document00900101) GetMessage() *AcceptorReconciliationRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900102 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AcceptorReconciliationRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900102) Reset() {
+	*x = Document00900102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900102) ProtoMessage() {}
+
+func (x *Document00900102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900102.ProtoReflect.Descriptor instead.
+func (*Document00900102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *Document00900102) GetMessage() *AcceptorReconciliationRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900103 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AcceptorReconciliationRequestV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900103) Reset() {
+	*x = Document00900103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900103) ProtoMessage() {}
+
+func (x *Document00900103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900103.ProtoReflect.Descriptor instead.
+func (*Document00900103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *Document00900103) GetMessage() *AcceptorReconciliationRequestV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900104 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AcceptorReconciliationRequestV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900104) Reset() {
+	*x = Document00900104{}
+	mi := &file_iso20022_caaa_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900104) ProtoMessage() {}
+
+func (x *Document00900104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900104.ProtoReflect.Descriptor instead.
+func (*Document00900104) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *Document00900104) GetMessage() *AcceptorReconciliationRequestV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900105 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AcceptorReconciliationRequestV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900105) Reset() {
+	*x = Document00900105{}
+	mi := &file_iso20022_caaa_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900105) ProtoMessage() {}
+
+func (x *Document00900105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900105.ProtoReflect.Descriptor instead.
+func (*Document00900105) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *Document00900105) GetMessage() *AcceptorReconciliationRequestV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000101 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *AcceptorReconciliationResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000101) Reset() {
+	*x = Document01000101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000101) ProtoMessage() {}
+
+func (x *Document01000101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000101.ProtoReflect.Descriptor instead.
+func (*Document01000101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *Document01000101) GetMessage() *AcceptorReconciliationResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000102 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *AcceptorReconciliationResponseV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000102) Reset() {
+	*x = Document01000102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000102) ProtoMessage() {}
+
+func (x *Document01000102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000102.ProtoReflect.Descriptor instead.
+func (*Document01000102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *Document01000102) GetMessage() *AcceptorReconciliationResponseV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000103 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *AcceptorReconciliationResponseV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000103) Reset() {
+	*x = Document01000103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000103) ProtoMessage() {}
+
+func (x *Document01000103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000103.ProtoReflect.Descriptor instead.
+func (*Document01000103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *Document01000103) GetMessage() *AcceptorReconciliationResponseV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000104 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *AcceptorReconciliationResponseV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000104) Reset() {
+	*x = Document01000104{}
+	mi := &file_iso20022_caaa_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000104) ProtoMessage() {}
+
+func (x *Document01000104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000104.ProtoReflect.Descriptor instead.
+func (*Document01000104) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *Document01000104) GetMessage() *AcceptorReconciliationResponseV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000105 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *AcceptorReconciliationResponseV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000105) Reset() {
+	*x = Document01000105{}
+	mi := &file_iso20022_caaa_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000105) ProtoMessage() {}
+
+func (x *Document01000105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000105.ProtoReflect.Descriptor instead.
+func (*Document01000105) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *Document01000105) GetMessage() *AcceptorReconciliationResponseV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01100101 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *AcceptorBatchTransferV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01100101) Reset() {
+	*x = Document01100101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01100101) ProtoMessage() {}
+
+func (x *Document01100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01100101.ProtoReflect.Descriptor instead.
+func (*Document01100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *Document01100101) GetMessage() *AcceptorBatchTransferV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01100102 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *AcceptorBatchTransferV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01100102) Reset() {
+	*x = Document01100102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01100102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01100102) ProtoMessage() {}
+
+func (x *Document01100102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01100102.ProtoReflect.Descriptor instead.
+func (*Document01100102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *Document01100102) GetMessage() *AcceptorBatchTransferV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01100103 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *AcceptorBatchTransferV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01100103) Reset() {
+	*x = Document01100103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01100103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01100103) ProtoMessage() {}
+
+func (x *Document01100103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01100103.ProtoReflect.Descriptor instead.
+func (*Document01100103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *Document01100103) GetMessage() *AcceptorBatchTransferV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01100104 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *AcceptorBatchTransferV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01100104) Reset() {
+	*x = Document01100104{}
+	mi := &file_iso20022_caaa_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01100104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01100104) ProtoMessage() {}
+
+func (x *Document01100104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01100104.ProtoReflect.Descriptor instead.
+func (*Document01100104) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *Document01100104) GetMessage() *AcceptorBatchTransferV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01100105 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *AcceptorBatchTransferV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01100105) Reset() {
+	*x = Document01100105{}
+	mi := &file_iso20022_caaa_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01100105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01100105) ProtoMessage() {}
+
+func (x *Document01100105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01100105.ProtoReflect.Descriptor instead.
+func (*Document01100105) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *Document01100105) GetMessage() *AcceptorBatchTransferV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01200101 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AcceptorBatchTransferResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01200101) Reset() {
+	*x = Document01200101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01200101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01200101) ProtoMessage() {}
+
+func (x *Document01200101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01200101.ProtoReflect.Descriptor instead.
+func (*Document01200101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *Document01200101) GetMessage() *AcceptorBatchTransferResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01200102 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AcceptorBatchTransferResponseV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01200102) Reset() {
+	*x = Document01200102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01200102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01200102) ProtoMessage() {}
+
+func (x *Document01200102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01200102.ProtoReflect.Descriptor instead.
+func (*Document01200102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *Document01200102) GetMessage() *AcceptorBatchTransferResponseV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01200103 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AcceptorBatchTransferResponseV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01200103) Reset() {
+	*x = Document01200103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01200103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01200103) ProtoMessage() {}
+
+func (x *Document01200103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01200103.ProtoReflect.Descriptor instead.
+func (*Document01200103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *Document01200103) GetMessage() *AcceptorBatchTransferResponseV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01200104 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AcceptorBatchTransferResponseV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01200104) Reset() {
+	*x = Document01200104{}
+	mi := &file_iso20022_caaa_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01200104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01200104) ProtoMessage() {}
+
+func (x *Document01200104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01200104.ProtoReflect.Descriptor instead.
+func (*Document01200104) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *Document01200104) GetMessage() *AcceptorBatchTransferResponseV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01200105 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *AcceptorBatchTransferResponseV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01200105) Reset() {
+	*x = Document01200105{}
+	mi := &file_iso20022_caaa_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01200105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01200105) ProtoMessage() {}
+
+func (x *Document01200105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01200105.ProtoReflect.Descriptor instead.
+func (*Document01200105) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *Document01200105) GetMessage() *AcceptorBatchTransferResponseV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01300101 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *AcceptorDiagnosticRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01300101) Reset() {
+	*x = Document01300101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01300101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01300101) ProtoMessage() {}
+
+func (x *Document01300101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01300101.ProtoReflect.Descriptor instead.
+func (*Document01300101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *Document01300101) GetMessage() *AcceptorDiagnosticRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01300102 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *AcceptorDiagnosticRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01300102) Reset() {
+	*x = Document01300102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01300102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01300102) ProtoMessage() {}
+
+func (x *Document01300102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01300102.ProtoReflect.Descriptor instead.
+func (*Document01300102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *Document01300102) GetMessage() *AcceptorDiagnosticRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01300103 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *AcceptorDiagnosticRequestV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01300103) Reset() {
+	*x = Document01300103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01300103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01300103) ProtoMessage() {}
+
+func (x *Document01300103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01300103.ProtoReflect.Descriptor instead.
+func (*Document01300103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *Document01300103) GetMessage() *AcceptorDiagnosticRequestV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01300104 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *AcceptorDiagnosticRequestV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01300104) Reset() {
+	*x = Document01300104{}
+	mi := &file_iso20022_caaa_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01300104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01300104) ProtoMessage() {}
+
+func (x *Document01300104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01300104.ProtoReflect.Descriptor instead.
+func (*Document01300104) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *Document01300104) GetMessage() *AcceptorDiagnosticRequestV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01300105 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *AcceptorDiagnosticRequestV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01300105) Reset() {
+	*x = Document01300105{}
+	mi := &file_iso20022_caaa_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01300105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01300105) ProtoMessage() {}
+
+func (x *Document01300105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01300105.ProtoReflect.Descriptor instead.
+func (*Document01300105) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *Document01300105) GetMessage() *AcceptorDiagnosticRequestV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01400101 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AcceptorDiagnosticResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01400101) Reset() {
+	*x = Document01400101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01400101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01400101) ProtoMessage() {}
+
+func (x *Document01400101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01400101.ProtoReflect.Descriptor instead.
+func (*Document01400101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *Document01400101) GetMessage() *AcceptorDiagnosticResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01400102 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AcceptorDiagnosticResponseV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01400102) Reset() {
+	*x = Document01400102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01400102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01400102) ProtoMessage() {}
+
+func (x *Document01400102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01400102.ProtoReflect.Descriptor instead.
+func (*Document01400102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *Document01400102) GetMessage() *AcceptorDiagnosticResponseV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01400103 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AcceptorDiagnosticResponseV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01400103) Reset() {
+	*x = Document01400103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01400103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01400103) ProtoMessage() {}
+
+func (x *Document01400103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01400103.ProtoReflect.Descriptor instead.
+func (*Document01400103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *Document01400103) GetMessage() *AcceptorDiagnosticResponseV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01400104 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AcceptorDiagnosticResponseV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01400104) Reset() {
+	*x = Document01400104{}
+	mi := &file_iso20022_caaa_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01400104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01400104) ProtoMessage() {}
+
+func (x *Document01400104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01400104.ProtoReflect.Descriptor instead.
+func (*Document01400104) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *Document01400104) GetMessage() *AcceptorDiagnosticResponseV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01400105 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AcceptorDiagnosticResponseV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01400105) Reset() {
+	*x = Document01400105{}
+	mi := &file_iso20022_caaa_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01400105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01400105) ProtoMessage() {}
+
+func (x *Document01400105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01400105.ProtoReflect.Descriptor instead.
+func (*Document01400105) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *Document01400105) GetMessage() *AcceptorDiagnosticResponseV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01500101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *AcceptorRejectionV01  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01500101) Reset() {
+	*x = Document01500101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01500101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01500101) ProtoMessage() {}
+
+func (x *Document01500101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01500101.ProtoReflect.Descriptor instead.
+func (*Document01500101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *Document01500101) GetMessage() *AcceptorRejectionV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01500102 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *AcceptorRejectionV02  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01500102) Reset() {
+	*x = Document01500102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01500102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01500102) ProtoMessage() {}
+
+func (x *Document01500102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01500102.ProtoReflect.Descriptor instead.
+func (*Document01500102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *Document01500102) GetMessage() *AcceptorRejectionV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01500103 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *AcceptorRejectionV03  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01500103) Reset() {
+	*x = Document01500103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01500103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01500103) ProtoMessage() {}
+
+func (x *Document01500103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01500103.ProtoReflect.Descriptor instead.
+func (*Document01500103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *Document01500103) GetMessage() *AcceptorRejectionV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01500104 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *AcceptorRejectionV04  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01500104) Reset() {
+	*x = Document01500104{}
+	mi := &file_iso20022_caaa_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01500104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01500104) ProtoMessage() {}
+
+func (x *Document01500104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01500104.ProtoReflect.Descriptor instead.
+func (*Document01500104) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *Document01500104) GetMessage() *AcceptorRejectionV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01500105 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *AcceptorRejectionV05  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01500105) Reset() {
+	*x = Document01500105{}
+	mi := &file_iso20022_caaa_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01500105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01500105) ProtoMessage() {}
+
+func (x *Document01500105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01500105.ProtoReflect.Descriptor instead.
+func (*Document01500105) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *Document01500105) GetMessage() *AcceptorRejectionV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01600101 struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Message       *AcceptorCurrencyConversionRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01600101) Reset() {
+	*x = Document01600101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01600101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01600101) ProtoMessage() {}
+
+func (x *Document01600101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01600101.ProtoReflect.Descriptor instead.
+func (*Document01600101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *Document01600101) GetMessage() *AcceptorCurrencyConversionRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01600102 struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Message       *AcceptorCurrencyConversionRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01600102) Reset() {
+	*x = Document01600102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01600102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01600102) ProtoMessage() {}
+
+func (x *Document01600102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01600102.ProtoReflect.Descriptor instead.
+func (*Document01600102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *Document01600102) GetMessage() *AcceptorCurrencyConversionRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01600103 struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Message       *AcceptorCurrencyConversionRequestV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01600103) Reset() {
+	*x = Document01600103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01600103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01600103) ProtoMessage() {}
+
+func (x *Document01600103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01600103.ProtoReflect.Descriptor instead.
+func (*Document01600103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *Document01600103) GetMessage() *AcceptorCurrencyConversionRequestV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01700101 struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Message       *AcceptorCurrencyConversionResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01700101) Reset() {
+	*x = Document01700101{}
+	mi := &file_iso20022_caaa_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01700101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01700101) ProtoMessage() {}
+
+func (x *Document01700101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01700101.ProtoReflect.Descriptor instead.
+func (*Document01700101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *Document01700101) GetMessage() *AcceptorCurrencyConversionResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01700102 struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Message       *AcceptorCurrencyConversionResponseV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01700102) Reset() {
+	*x = Document01700102{}
+	mi := &file_iso20022_caaa_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01700102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01700102) ProtoMessage() {}
+
+func (x *Document01700102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01700102.ProtoReflect.Descriptor instead.
+func (*Document01700102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *Document01700102) GetMessage() *AcceptorCurrencyConversionResponseV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01700103 struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Message       *AcceptorCurrencyConversionResponseV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01700103) Reset() {
+	*x = Document01700103{}
+	mi := &file_iso20022_caaa_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01700103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01700103) ProtoMessage() {}
+
+func (x *Document01700103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caaa_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01700103.ProtoReflect.Descriptor instead.
+func (*Document01700103) Descriptor() ([]byte, []int) {
+	return file_iso20022_caaa_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *Document01700103) GetMessage() *AcceptorCurrencyConversionResponseV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+var File_iso20022_caaa_proto protoreflect.FileDescriptor
+
+var file_iso20022_caaa_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x63, 0x61, 0x61, 0x61, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x63, 0x61, 0x61, 0x61, 0x1a, 0x14, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x02, 0x0a, 0x1f, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x2f,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x62, 0x0a, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x31, 0x52, 0x14, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x33, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x8a, 0x02, 0x0a, 0x1f, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x62, 0x0a, 0x15,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x52, 0x14, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x36, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x22, 0x8a, 0x02, 0x0a, 0x1f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f,
+	0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x37, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x62, 0x0a, 0x15, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x33, 0x52, 0x14, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x38,
+	0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x22, 0x8c, 0x02, 0x0a, 0x1f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x30, 0x34, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x30, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x62, 0x0a, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x34, 0x52, 0x14, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x31, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x22, 0x8c, 0x02, 0x0a, 0x1f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x30, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x62, 0x0a, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x35, 0x52, 0x14, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22,
+	0x8e, 0x02, 0x0a, 0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x30, 0x31, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x10,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x33, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x22, 0x8e, 0x02, 0x0a, 0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x30, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x36,
+	0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x22, 0x8e, 0x02, 0x0a, 0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x37, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f,
+	0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x33, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
+	0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x38, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x22, 0x90, 0x02, 0x0a, 0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31,
+	0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x16, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x34, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x31, 0x31, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x90, 0x02, 0x0a, 0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x33, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x16,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x35, 0x52, 0x15, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xe7, 0x01, 0x0a, 0x20, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x12, 0x2f, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3e,
+	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x65, 0x74, 0x32, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x53, 0x65, 0x74, 0x12, 0x52,
+	0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x31, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x22, 0x92, 0x02, 0x0a, 0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x69, 0x0a, 0x17, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x15, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x34, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x92, 0x02, 0x0a, 0x20, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x33, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x69, 0x0a,
+	0x17, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0x52, 0x15, 0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x39, 0x52, 0x0f, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x94, 0x02, 0x0a,
+	0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30,
+	0x34, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x32, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x69, 0x0a, 0x17, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x33, 0x52, 0x15, 0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
+	0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x31, 0x32, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69,
+	0x6c, 0x65, 0x72, 0x22, 0x94, 0x02, 0x0a, 0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x32, 0x35, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x69, 0x0a, 0x17, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x61, 0x72,
+	0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x34, 0x52, 0x15,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x32, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xdf, 0x01, 0x0a, 0x18, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x56, 0x30, 0x31, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x61, 0x72, 0x64,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x65, 0x74, 0x31, 0x52,
+	0x07, 0x64, 0x61, 0x74, 0x61, 0x53, 0x65, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x52, 0x0f, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xf1, 0x01, 0x0a,
+	0x18, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x56, 0x30, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x33, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x0e, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x31, 0x52, 0x0d, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x10,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x34, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x22, 0xf1, 0x01, 0x0a, 0x18, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x50,
+	0x0a, 0x0e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x32, 0x52, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x39, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x22, 0xf3, 0x01, 0x0a, 0x18, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f,
+	0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x56, 0x30,
+	0x34, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x32, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x0e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x61, 0x72,
+	0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x33, 0x52, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x32, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xf3, 0x01, 0x0a, 0x18, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32,
+	0x35, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x0e, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x34, 0x52, 0x0d, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x32, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x22, 0xb5, 0x02, 0x0a, 0x25, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x32, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x86, 0x01, 0x0a, 0x25,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31,
+	0x52, 0x22, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x33, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xa3, 0x02, 0x0a, 0x25, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x30, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x75, 0x0a, 0x1c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x52, 0x1a,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x36, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xa3,
+	0x02, 0x0a, 0x25, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x38, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x75, 0x0a, 0x1c, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x33, 0x52, 0x1a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x38, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x22, 0xa5, 0x02, 0x0a, 0x25, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f,
+	0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x12, 0x30,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x75, 0x0a, 0x1c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x34, 0x52, 0x1a, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x31, 0x52, 0x0f, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xa5, 0x02, 0x0a,
+	0x25, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x34,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x75, 0x0a, 0x1c, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x35, 0x52, 0x1a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69,
+	0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x22, 0x82, 0x02, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f,
+	0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76,
+	0x69, 0x63, 0x65, 0x56, 0x30, 0x31, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65,
+	0x31, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x33, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x82, 0x02, 0x0a, 0x1d, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x32, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x13,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x64, 0x76, 0x69, 0x63, 0x65, 0x32, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x36, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x82,
+	0x02, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x33,
+	0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x38, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x5c, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x33, 0x52, 0x12, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69,
+	0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x38, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69,
+	0x6c, 0x65, 0x72, 0x22, 0x84, 0x02, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x56, 0x30, 0x34, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x31, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65,
+	0x34, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x31, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x84, 0x02, 0x0a, 0x1d, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x32, 0x34, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5c,
+	0x0a, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
+	0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x35, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x10,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35,
+	0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x22, 0x86, 0x02, 0x0a, 0x1e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x30, 0x31, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5f, 0x0a, 0x14, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x31, 0x52, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x33, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x86, 0x02, 0x0a, 0x1e, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x2f, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5f,
+	0x0a, 0x14, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x52, 0x13, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69,
+	0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x36, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69,
+	0x6c, 0x65, 0x72, 0x22, 0x86, 0x02, 0x0a, 0x1e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x37, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5f, 0x0a, 0x14, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x33, 0x52, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x38, 0x52, 0x0f, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x88, 0x02, 0x0a,
+	0x1e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x34, 0x12,
+	0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x5f, 0x0a, 0x14, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x34, 0x52, 0x13, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x31, 0x31, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x88, 0x02, 0x0a, 0x1e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x33, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5f, 0x0a, 0x14,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x35, 0x52, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x22, 0x8a, 0x02, 0x0a, 0x1f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x62, 0x0a, 0x15, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x14, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x33, 0x52, 0x0f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22,
+	0x8a, 0x02, 0x0a, 0x1f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x30, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x62, 0x0a, 0x15, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0x52, 0x14, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x36, 0x52, 0x0f, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x8a, 0x02, 0x0a,
+	0x1f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33,
+	0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x37, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x62, 0x0a, 0x15, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x33, 0x52,
+	0x14, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x38, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x8c, 0x02, 0x0a, 0x1f, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x12, 0x30, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x62, 0x0a, 0x15, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x34, 0x52, 0x14, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x31, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x8c, 0x02, 0x0a, 0x1f, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x33, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x62,
+	0x0a, 0x15, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x35, 0x52, 0x14, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x9b, 0x02, 0x0a, 0x23, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x12,
+	0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x6f, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
+	0x64, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x18, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x33, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x9b, 0x02, 0x0a, 0x23, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x32, 0x12, 0x2f, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6f,
+	0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x52, 0x18, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69,
+	0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x36, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69,
+	0x6c, 0x65, 0x72, 0x22, 0x9b, 0x02, 0x0a, 0x23, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x38, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6f, 0x0a, 0x1a,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x33, 0x52, 0x18, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x38,
+	0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x22, 0x9d, 0x02, 0x0a, 0x23, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x31, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6f, 0x0a, 0x1a, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x34, 0x52, 0x18, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x31,
+	0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x22, 0x9d, 0x02, 0x0a, 0x23, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x32, 0x34, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6f, 0x0a, 0x1a, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x35, 0x52, 0x18, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35,
+	0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x22, 0xfa, 0x01, 0x0a, 0x1b, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30,
+	0x31, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x56, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x31, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x33, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xfa,
+	0x01, 0x0a, 0x1b, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x32, 0x12, 0x2f,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x56, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x32, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x36, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xfa, 0x01, 0x0a, 0x1b,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x38, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x56, 0x0a, 0x11,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f,
+	0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63,
+	0x65, 0x33, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x38, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xfc, 0x01, 0x0a, 0x1b, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x34, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x31, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x56, 0x0a, 0x11, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x34,
+	0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x31, 0x31, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xfc, 0x01, 0x0a, 0x1b, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32,
+	0x34, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x56, 0x0a, 0x11, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x35, 0x52,
+	0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x9f, 0x02, 0x0a, 0x24, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12,
+	0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x37, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x72, 0x0a, 0x1b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x31, 0x52, 0x19, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x38, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xa1, 0x02, 0x0a, 0x24, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30,
+	0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x72, 0x0a, 0x1b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x52, 0x19, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x31, 0x52, 0x0f, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xa1, 0x02, 0x0a,
+	0x24, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x30, 0x33, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x30, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x72, 0x0a, 0x1b, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x33,
+	0x52, 0x19, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x22, 0xa3, 0x02, 0x0a, 0x25, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x37, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x75, 0x0a, 0x1c, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x33, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x1a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x38, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xa5, 0x02, 0x0a, 0x25, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x32,
+	0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x75, 0x0a, 0x1c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x52, 0x1a, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x31, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xa5,
+	0x02, 0x0a, 0x25, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x33, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x75, 0x0a, 0x1c, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x33, 0x52, 0x1a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xfe, 0x01, 0x0a, 0x1c, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x59, 0x0a, 0x12, 0x64, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x31,
+	0x52, 0x11, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x33, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xfe, 0x01, 0x0a, 0x1c, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x59, 0x0a, 0x12, 0x64, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x32, 0x52, 0x11, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x36, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xfe, 0x01, 0x0a, 0x1c, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x37, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x59, 0x0a, 0x12, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x33, 0x52, 0x11, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x38, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x80, 0x02, 0x0a, 0x1c, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x34, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x31, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x59, 0x0a, 0x12,
+	0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x34, 0x52, 0x11, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x31, 0x52, 0x0f, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x80, 0x02, 0x0a,
+	0x1c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x59, 0x0a, 0x12, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x35, 0x52, 0x11, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22,
+	0x82, 0x02, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30,
+	0x31, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x5c, 0x0a, 0x13, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x12, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x33, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x22, 0x82, 0x02, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f,
+	0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x30, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x13, 0x64, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0x52, 0x12, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x36, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x82, 0x02, 0x0a, 0x1d, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x37, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x13,
+	0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x33, 0x52, 0x12, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x38, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x84,
+	0x02, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34,
+	0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x5c, 0x0a, 0x13, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x34, 0x52, 0x12, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x31, 0x31, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x84, 0x02, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33,
+	0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x13, 0x64, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x34, 0x52, 0x12, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x8e, 0x02, 0x0a,
+	0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30,
+	0x31, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x65, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x31, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x33, 0x52, 0x0f, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x8e, 0x02,
+	0x0a, 0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x30, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x32, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x36, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x8e,
+	0x02, 0x0a, 0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x37, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x33, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x38, 0x52, 0x0f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22,
+	0x90, 0x02, 0x0a, 0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x30, 0x34, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x30, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x34, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x31, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x22, 0x90, 0x02, 0x0a, 0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33,
+	0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x16, 0x72, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x35, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x92, 0x02, 0x0a, 0x21, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x12, 0x2f, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x17,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x16,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x33, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x92, 0x02, 0x0a, 0x21, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x32,
+	0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x68, 0x0a, 0x17, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0x52, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x36, 0x52, 0x0f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22,
+	0x92, 0x02, 0x0a, 0x21, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x37, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x17, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x52, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x38, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x22, 0x94, 0x02, 0x0a, 0x21, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x31, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x17,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x33, 0x52, 0x16,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x31, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x94, 0x02, 0x0a, 0x21,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30,
+	0x35, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x17, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x34, 0x52, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x22, 0x83, 0x01, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x31, 0x12, 0x2f, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x06,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31,
+	0x52, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x14, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30,
+	0x32, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x35, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x3a, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x83,
+	0x01, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x39,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x52, 0x06, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34, 0x12, 0x30, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x33, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x3a, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x32, 0x52, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x14,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x36, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x52, 0x06, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x22, 0x5c, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x31, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x48, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x5c, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x31, 0x30,
+	0x30, 0x31, 0x30, 0x32, 0x12, 0x48, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5c,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x31, 0x30, 0x30, 0x31,
+	0x30, 0x33, 0x12, 0x48, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63,
+	0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x31, 0x30, 0x30, 0x31, 0x30, 0x34,
+	0x12, 0x48, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61,
+	0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30,
+	0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x31, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x48,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x35, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x49, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x49, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x44, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f,
+	0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63,
+	0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30,
+	0x32, 0x12, 0x44, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61,
+	0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x32, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x44, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x58, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x33,
+	0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x44, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56,
+	0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x10, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12,
+	0x44, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x30, 0x30, 0x34, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x4c, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x30, 0x30, 0x34, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x4c, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x32,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x10, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x34, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x4c, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x10, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x34, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12,
+	0x4c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x34, 0x30, 0x30, 0x31, 0x30,
+	0x35, 0x12, 0x4c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61,
+	0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x35, 0x30, 0x30,
+	0x31, 0x30, 0x31, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x32,
+	0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61,
+	0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x47, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f,
+	0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x5c, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x36, 0x30,
+	0x30, 0x31, 0x30, 0x31, 0x12, 0x48, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5c,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x36, 0x30, 0x30, 0x31,
+	0x30, 0x32, 0x12, 0x48, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63,
+	0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x36, 0x30, 0x30, 0x31, 0x30, 0x33,
+	0x12, 0x48, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61,
+	0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30,
+	0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x36, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x48,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x36, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x48, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x37, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x37, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x37, 0x30, 0x30, 0x31,
+	0x30, 0x33, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63,
+	0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30,
+	0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x37, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x46,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x37, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x62, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x38, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x4e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x62, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x38, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x4e, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30,
+	0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x62, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x38, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x4e,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x62,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x38, 0x30, 0x30, 0x31,
+	0x30, 0x34, 0x12, 0x4e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63,
+	0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x62, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x38, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x4e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x39, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x30, 0x30, 0x39, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x30, 0x39, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x30, 0x39, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x39, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x30,
+	0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x30,
+	0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x30,
+	0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x30,
+	0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x30,
+	0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x55, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x31,
+	0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x56, 0x30, 0x31, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x55, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x31, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x41, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x55, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x31, 0x30, 0x30,
+	0x31, 0x30, 0x33, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x55, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x31, 0x31, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x55, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x31, 0x30, 0x30, 0x31, 0x30,
+	0x35, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61,
+	0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x31, 0x32, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x31, 0x32, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f,
+	0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31,
+	0x32, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x32,
+	0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x32, 0x30,
+	0x30, 0x31, 0x30, 0x35, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x59, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x33, 0x30, 0x30,
+	0x31, 0x30, 0x31, 0x12, 0x45, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30,
+	0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x33, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x45,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x30, 0x31, 0x33, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x45, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x59, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x33, 0x30,
+	0x30, 0x31, 0x30, 0x34, 0x12, 0x45, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x10, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x33, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12,
+	0x45, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x31, 0x34, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31,
+	0x34, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x34, 0x30, 0x30, 0x31,
+	0x30, 0x33, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63,
+	0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30,
+	0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x34, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x46,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x31, 0x34, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x51, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31,
+	0x35, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x3d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x51, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x30, 0x31, 0x35, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x3d, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x32, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x51, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x35, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x3d, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x51, 0x0a, 0x10, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x35, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12,
+	0x3d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x51,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x35, 0x30, 0x30, 0x31,
+	0x30, 0x35, 0x12, 0x3d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63,
+	0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x61, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x36,
+	0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x4d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x61, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x31, 0x36, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x4d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x61, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x30, 0x31, 0x36, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x4d, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30,
+	0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x62, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x37, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x4e,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x62,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x37, 0x30, 0x30, 0x31,
+	0x30, 0x32, 0x12, 0x4e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63,
+	0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x62, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31,
+	0x37, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x4e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x75, 0x64, 0x61, 0x70, 0x72, 0x61, 0x6d, 0x61, 0x2f, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x63, 0x61, 0x61, 0x61, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_iso20022_caaa_proto_rawDescOnce sync.Once
+	file_iso20022_caaa_proto_rawDescData []byte
+)
+
+func file_iso20022_caaa_proto_rawDescGZIP() []byte {
+	file_iso20022_caaa_proto_rawDescOnce.Do(func() {
+		file_iso20022_caaa_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_iso20022_caaa_proto_rawDesc), len(file_iso20022_caaa_proto_rawDesc)))
+	})
+	return file_iso20022_caaa_proto_rawDescData
+}
+
+var file_iso20022_caaa_proto_msgTypes = make([]protoimpl.MessageInfo, 162)
+var file_iso20022_caaa_proto_goTypes = []any{
+	(*AcceptorAuthorisationRequestV01)(nil),             // 0: iso20022.caaa.AcceptorAuthorisationRequestV01
+	(*AcceptorAuthorisationRequestV02)(nil),             // 1: iso20022.caaa.AcceptorAuthorisationRequestV02
+	(*AcceptorAuthorisationRequestV03)(nil),             // 2: iso20022.caaa.AcceptorAuthorisationRequestV03
+	(*AcceptorAuthorisationRequestV04)(nil),             // 3: iso20022.caaa.AcceptorAuthorisationRequestV04
+	(*AcceptorAuthorisationRequestV05)(nil),             // 4: iso20022.caaa.AcceptorAuthorisationRequestV05
+	(*AcceptorAuthorisationResponseV01)(nil),            // 5: iso20022.caaa.AcceptorAuthorisationResponseV01
+	(*AcceptorAuthorisationResponseV02)(nil),            // 6: iso20022.caaa.AcceptorAuthorisationResponseV02
+	(*AcceptorAuthorisationResponseV03)(nil),            // 7: iso20022.caaa.AcceptorAuthorisationResponseV03
+	(*AcceptorAuthorisationResponseV04)(nil),            // 8: iso20022.caaa.AcceptorAuthorisationResponseV04
+	(*AcceptorAuthorisationResponseV05)(nil),            // 9: iso20022.caaa.AcceptorAuthorisationResponseV05
+	(*AcceptorBatchTransferResponseV01)(nil),            // 10: iso20022.caaa.AcceptorBatchTransferResponseV01
+	(*AcceptorBatchTransferResponseV02)(nil),            // 11: iso20022.caaa.AcceptorBatchTransferResponseV02
+	(*AcceptorBatchTransferResponseV03)(nil),            // 12: iso20022.caaa.AcceptorBatchTransferResponseV03
+	(*AcceptorBatchTransferResponseV04)(nil),            // 13: iso20022.caaa.AcceptorBatchTransferResponseV04
+	(*AcceptorBatchTransferResponseV05)(nil),            // 14: iso20022.caaa.AcceptorBatchTransferResponseV05
+	(*AcceptorBatchTransferV01)(nil),                    // 15: iso20022.caaa.AcceptorBatchTransferV01
+	(*AcceptorBatchTransferV02)(nil),                    // 16: iso20022.caaa.AcceptorBatchTransferV02
+	(*AcceptorBatchTransferV03)(nil),                    // 17: iso20022.caaa.AcceptorBatchTransferV03
+	(*AcceptorBatchTransferV04)(nil),                    // 18: iso20022.caaa.AcceptorBatchTransferV04
+	(*AcceptorBatchTransferV05)(nil),                    // 19: iso20022.caaa.AcceptorBatchTransferV05
+	(*AcceptorCancellationAdviceResponseV01)(nil),       // 20: iso20022.caaa.AcceptorCancellationAdviceResponseV01
+	(*AcceptorCancellationAdviceResponseV02)(nil),       // 21: iso20022.caaa.AcceptorCancellationAdviceResponseV02
+	(*AcceptorCancellationAdviceResponseV03)(nil),       // 22: iso20022.caaa.AcceptorCancellationAdviceResponseV03
+	(*AcceptorCancellationAdviceResponseV04)(nil),       // 23: iso20022.caaa.AcceptorCancellationAdviceResponseV04
+	(*AcceptorCancellationAdviceResponseV05)(nil),       // 24: iso20022.caaa.AcceptorCancellationAdviceResponseV05
+	(*AcceptorCancellationAdviceV01)(nil),               // 25: iso20022.caaa.AcceptorCancellationAdviceV01
+	(*AcceptorCancellationAdviceV02)(nil),               // 26: iso20022.caaa.AcceptorCancellationAdviceV02
+	(*AcceptorCancellationAdviceV03)(nil),               // 27: iso20022.caaa.AcceptorCancellationAdviceV03
+	(*AcceptorCancellationAdviceV04)(nil),               // 28: iso20022.caaa.AcceptorCancellationAdviceV04
+	(*AcceptorCancellationAdviceV05)(nil),               // 29: iso20022.caaa.AcceptorCancellationAdviceV05
+	(*AcceptorCancellationRequestV01)(nil),              // 30: iso20022.caaa.AcceptorCancellationRequestV01
+	(*AcceptorCancellationRequestV02)(nil),              // 31: iso20022.caaa.AcceptorCancellationRequestV02
+	(*AcceptorCancellationRequestV03)(nil),              // 32: iso20022.caaa.AcceptorCancellationRequestV03
+	(*AcceptorCancellationRequestV04)(nil),              // 33: iso20022.caaa.AcceptorCancellationRequestV04
+	(*AcceptorCancellationRequestV05)(nil),              // 34: iso20022.caaa.AcceptorCancellationRequestV05
+	(*AcceptorCancellationResponseV01)(nil),             // 35: iso20022.caaa.AcceptorCancellationResponseV01
+	(*AcceptorCancellationResponseV02)(nil),             // 36: iso20022.caaa.AcceptorCancellationResponseV02
+	(*AcceptorCancellationResponseV03)(nil),             // 37: iso20022.caaa.AcceptorCancellationResponseV03
+	(*AcceptorCancellationResponseV04)(nil),             // 38: iso20022.caaa.AcceptorCancellationResponseV04
+	(*AcceptorCancellationResponseV05)(nil),             // 39: iso20022.caaa.AcceptorCancellationResponseV05
+	(*AcceptorCompletionAdviceResponseV01)(nil),         // 40: iso20022.caaa.AcceptorCompletionAdviceResponseV01
+	(*AcceptorCompletionAdviceResponseV02)(nil),         // 41: iso20022.caaa.AcceptorCompletionAdviceResponseV02
+	(*AcceptorCompletionAdviceResponseV03)(nil),         // 42: iso20022.caaa.AcceptorCompletionAdviceResponseV03
+	(*AcceptorCompletionAdviceResponseV04)(nil),         // 43: iso20022.caaa.AcceptorCompletionAdviceResponseV04
+	(*AcceptorCompletionAdviceResponseV05)(nil),         // 44: iso20022.caaa.AcceptorCompletionAdviceResponseV05
+	(*AcceptorCompletionAdviceV01)(nil),                 // 45: iso20022.caaa.AcceptorCompletionAdviceV01
+	(*AcceptorCompletionAdviceV02)(nil),                 // 46: iso20022.caaa.AcceptorCompletionAdviceV02
+	(*AcceptorCompletionAdviceV03)(nil),                 // 47: iso20022.caaa.AcceptorCompletionAdviceV03
+	(*AcceptorCompletionAdviceV04)(nil),                 // 48: iso20022.caaa.AcceptorCompletionAdviceV04
+	(*AcceptorCompletionAdviceV05)(nil),                 // 49: iso20022.caaa.AcceptorCompletionAdviceV05
+	(*AcceptorCurrencyConversionRequestV01)(nil),        // 50: iso20022.caaa.AcceptorCurrencyConversionRequestV01
+	(*AcceptorCurrencyConversionRequestV02)(nil),        // 51: iso20022.caaa.AcceptorCurrencyConversionRequestV02
+	(*AcceptorCurrencyConversionRequestV03)(nil),        // 52: iso20022.caaa.AcceptorCurrencyConversionRequestV03
+	(*AcceptorCurrencyConversionResponseV01)(nil),       // 53: iso20022.caaa.AcceptorCurrencyConversionResponseV01
+	(*AcceptorCurrencyConversionResponseV02)(nil),       // 54: iso20022.caaa.AcceptorCurrencyConversionResponseV02
+	(*AcceptorCurrencyConversionResponseV03)(nil),       // 55: iso20022.caaa.AcceptorCurrencyConversionResponseV03
+	(*AcceptorDiagnosticRequestV01)(nil),                // 56: iso20022.caaa.AcceptorDiagnosticRequestV01
+	(*AcceptorDiagnosticRequestV02)(nil),                // 57: iso20022.caaa.AcceptorDiagnosticRequestV02
+	(*AcceptorDiagnosticRequestV03)(nil),                // 58: iso20022.caaa.AcceptorDiagnosticRequestV03
+	(*AcceptorDiagnosticRequestV04)(nil),                // 59: iso20022.caaa.AcceptorDiagnosticRequestV04
+	(*AcceptorDiagnosticRequestV05)(nil),                // 60: iso20022.caaa.AcceptorDiagnosticRequestV05
+	(*AcceptorDiagnosticResponseV01)(nil),               // 61: iso20022.caaa.AcceptorDiagnosticResponseV01
+	(*AcceptorDiagnosticResponseV02)(nil),               // 62: iso20022.caaa.AcceptorDiagnosticResponseV02
+	(*AcceptorDiagnosticResponseV03)(nil),               // 63: iso20022.caaa.AcceptorDiagnosticResponseV03
+	(*AcceptorDiagnosticResponseV04)(nil),               // 64: iso20022.caaa.AcceptorDiagnosticResponseV04
+	(*AcceptorDiagnosticResponseV05)(nil),               // 65: iso20022.caaa.AcceptorDiagnosticResponseV05
+	(*AcceptorReconciliationRequestV01)(nil),            // 66: iso20022.caaa.AcceptorReconciliationRequestV01
+	(*AcceptorReconciliationRequestV02)(nil),            // 67: iso20022.caaa.AcceptorReconciliationRequestV02
+	(*AcceptorReconciliationRequestV03)(nil),            // 68: iso20022.caaa.AcceptorReconciliationRequestV03
+	(*AcceptorReconciliationRequestV04)(nil),            // 69: iso20022.caaa.AcceptorReconciliationRequestV04
+	(*AcceptorReconciliationRequestV05)(nil),            // 70: iso20022.caaa.AcceptorReconciliationRequestV05
+	(*AcceptorReconciliationResponseV01)(nil),           // 71: iso20022.caaa.AcceptorReconciliationResponseV01
+	(*AcceptorReconciliationResponseV02)(nil),           // 72: iso20022.caaa.AcceptorReconciliationResponseV02
+	(*AcceptorReconciliationResponseV03)(nil),           // 73: iso20022.caaa.AcceptorReconciliationResponseV03
+	(*AcceptorReconciliationResponseV04)(nil),           // 74: iso20022.caaa.AcceptorReconciliationResponseV04
+	(*AcceptorReconciliationResponseV05)(nil),           // 75: iso20022.caaa.AcceptorReconciliationResponseV05
+	(*AcceptorRejectionV01)(nil),                        // 76: iso20022.caaa.AcceptorRejectionV01
+	(*AcceptorRejectionV02)(nil),                        // 77: iso20022.caaa.AcceptorRejectionV02
+	(*AcceptorRejectionV03)(nil),                        // 78: iso20022.caaa.AcceptorRejectionV03
+	(*AcceptorRejectionV04)(nil),                        // 79: iso20022.caaa.AcceptorRejectionV04
+	(*AcceptorRejectionV05)(nil),                        // 80: iso20022.caaa.AcceptorRejectionV05
+	(*Document00100101)(nil),                            // 81: iso20022.caaa.Document00100101
+	(*Document00100102)(nil),                            // 82: iso20022.caaa.Document00100102
+	(*Document00100103)(nil),                            // 83: iso20022.caaa.Document00100103
+	(*Document00100104)(nil),                            // 84: iso20022.caaa.Document00100104
+	(*Document00100105)(nil),                            // 85: iso20022.caaa.Document00100105
+	(*Document00200101)(nil),                            // 86: iso20022.caaa.Document00200101
+	(*Document00200102)(nil),                            // 87: iso20022.caaa.Document00200102
+	(*Document00200103)(nil),                            // 88: iso20022.caaa.Document00200103
+	(*Document00200104)(nil),                            // 89: iso20022.caaa.Document00200104
+	(*Document00200105)(nil),                            // 90: iso20022.caaa.Document00200105
+	(*Document00300101)(nil),                            // 91: iso20022.caaa.Document00300101
+	(*Document00300102)(nil),                            // 92: iso20022.caaa.Document00300102
+	(*Document00300103)(nil),                            // 93: iso20022.caaa.Document00300103
+	(*Document00300104)(nil),                            // 94: iso20022.caaa.Document00300104
+	(*Document00300105)(nil),                            // 95: iso20022.caaa.Document00300105
+	(*Document00400101)(nil),                            // 96: iso20022.caaa.Document00400101
+	(*Document00400102)(nil),                            // 97: iso20022.caaa.Document00400102
+	(*Document00400103)(nil),                            // 98: iso20022.caaa.Document00400103
+	(*Document00400104)(nil),                            // 99: iso20022.caaa.Document00400104
+	(*Document00400105)(nil),                            // 100: iso20022.caaa.Document00400105
+	(*Document00500101)(nil),                            // 101: iso20022.caaa.Document00500101
+	(*Document00500102)(nil),                            // 102: iso20022.caaa.Document00500102
+	(*Document00500103)(nil),                            // 103: iso20022.caaa.Document00500103
+	(*Document00500104)(nil),                            // 104: iso20022.caaa.Document00500104
+	(*Document00500105)(nil),                            // 105: iso20022.caaa.Document00500105
+	(*Document00600101)(nil),                            // 106: iso20022.caaa.Document00600101
+	(*Document00600102)(nil),                            // 107: iso20022.caaa.Document00600102
+	(*Document00600103)(nil),                            // 108: iso20022.caaa.Document00600103
+	(*Document00600104)(nil),                            // 109: iso20022.caaa.Document00600104
+	(*Document00600105)(nil),                            // 110: iso20022.caaa.Document00600105
+	(*Document00700101)(nil),                            // 111: iso20022.caaa.Document00700101
+	(*Document00700102)(nil),                            // 112: iso20022.caaa.Document00700102
+	(*Document00700103)(nil),                            // 113: iso20022.caaa.Document00700103
+	(*Document00700104)(nil),                            // 114: iso20022.caaa.Document00700104
+	(*Document00700105)(nil),                            // 115: iso20022.caaa.Document00700105
+	(*Document00800101)(nil),                            // 116: iso20022.caaa.Document00800101
+	(*Document00800102)(nil),                            // 117: iso20022.caaa.Document00800102
+	(*Document00800103)(nil),                            // 118: iso20022.caaa.Document00800103
+	(*Document00800104)(nil),                            // 119: iso20022.caaa.Document00800104
+	(*Document00800105)(nil),                            // 120: iso20022.caaa.Document00800105
+	(*Document00900101)(nil),                            // 121: iso20022.caaa.Document00900101
+	(*Document00900102)(nil),                            // 122: iso20022.caaa.Document00900102
+	(*Document00900103)(nil),                            // 123: iso20022.caaa.Document00900103
+	(*Document00900104)(nil),                            // 124: iso20022.caaa.Document00900104
+	(*Document00900105)(nil),                            // 125: iso20022.caaa.Document00900105
+	(*Document01000101)(nil),                            // 126: iso20022.caaa.Document01000101
+	(*Document01000102)(nil),                            // 127: iso20022.caaa.Document01000102
+	(*Document01000103)(nil),                            // 128: iso20022.caaa.Document01000103
+	(*Document01000104)(nil),                            // 129: iso20022.caaa.Document01000104
+	(*Document01000105)(nil),                            // 130: iso20022.caaa.Document01000105
+	(*Document01100101)(nil),                            // 131: iso20022.caaa.Document01100101
+	(*Document01100102)(nil),                            // 132: iso20022.caaa.Document01100102
+	(*Document01100103)(nil),                            // 133: iso20022.caaa.Document01100103
+	(*Document01100104)(nil),                            // 134: iso20022.caaa.Document01100104
+	(*Document01100105)(nil),                            // 135: iso20022.caaa.Document01100105
+	(*Document01200101)(nil),                            // 136: iso20022.caaa.Document01200101
+	(*Document01200102)(nil),                            // 137: iso20022.caaa.Document01200102
+	(*Document01200103)(nil),                            // 138: iso20022.caaa.Document01200103
+	(*Document01200104)(nil),                            // 139: iso20022.caaa.Document01200104
+	(*Document01200105)(nil),                            // 140: iso20022.caaa.Document01200105
+	(*Document01300101)(nil),                            // 141: iso20022.caaa.Document01300101
+	(*Document01300102)(nil),                            // 142: iso20022.caaa.Document01300102
+	(*Document01300103)(nil),                            // 143: iso20022.caaa.Document01300103
+	(*Document01300104)(nil),                            // 144: iso20022.caaa.Document01300104
+	(*Document01300105)(nil),                            // 145: iso20022.caaa.Document01300105
+	(*Document01400101)(nil),                            // 146: iso20022.caaa.Document01400101
+	(*Document01400102)(nil),                            // 147: iso20022.caaa.Document01400102
+	(*Document01400103)(nil),                            // 148: iso20022.caaa.Document01400103
+	(*Document01400104)(nil),                            // 149: iso20022.caaa.Document01400104
+	(*Document01400105)(nil),                            // 150: iso20022.caaa.Document01400105
+	(*Document01500101)(nil),                            // 151: iso20022.caaa.Document01500101
+	(*Document01500102)(nil),                            // 152: iso20022.caaa.Document01500102
+	(*Document01500103)(nil),                            // 153: iso20022.caaa.Document01500103
+	(*Document01500104)(nil),                            // 154: iso20022.caaa.Document01500104
+	(*Document01500105)(nil),                            // 155: iso20022.caaa.Document01500105
+	(*Document01600101)(nil),                            // 156: iso20022.caaa.Document01600101
+	(*Document01600102)(nil),                            // 157: iso20022.caaa.Document01600102
+	(*Document01600103)(nil),                            // 158: iso20022.caaa.Document01600103
+	(*Document01700101)(nil),                            // 159: iso20022.caaa.Document01700101
+	(*Document01700102)(nil),                            // 160: iso20022.caaa.Document01700102
+	(*Document01700103)(nil),                            // 161: iso20022.caaa.Document01700103
+	(*modelpb.Header1)(nil),                             // 162: iso20022.model.Header1
+	(*modelpb.AcceptorAuthorisationRequest1)(nil),       // 163: iso20022.model.AcceptorAuthorisationRequest1
+	(*modelpb.ContentInformationType3)(nil),             // 164: iso20022.model.ContentInformationType3
+	(*modelpb.AcceptorAuthorisationRequest2)(nil),       // 165: iso20022.model.AcceptorAuthorisationRequest2
+	(*modelpb.ContentInformationType6)(nil),             // 166: iso20022.model.ContentInformationType6
+	(*modelpb.Header7)(nil),                             // 167: iso20022.model.Header7
+	(*modelpb.AcceptorAuthorisationRequest3)(nil),       // 168: iso20022.model.AcceptorAuthorisationRequest3
+	(*modelpb.ContentInformationType8)(nil),             // 169: iso20022.model.ContentInformationType8
+	(*modelpb.Header10)(nil),                            // 170: iso20022.model.Header10
+	(*modelpb.AcceptorAuthorisationRequest4)(nil),       // 171: iso20022.model.AcceptorAuthorisationRequest4
+	(*modelpb.ContentInformationType11)(nil),            // 172: iso20022.model.ContentInformationType11
+	(*modelpb.Header30)(nil),                            // 173: iso20022.model.Header30
+	(*modelpb.AcceptorAuthorisationRequest5)(nil),       // 174: iso20022.model.AcceptorAuthorisationRequest5
+	(*modelpb.ContentInformationType15)(nil),            // 175: iso20022.model.ContentInformationType15
+	(*modelpb.AcceptorAuthorisationResponse1)(nil),      // 176: iso20022.model.AcceptorAuthorisationResponse1
+	(*modelpb.AcceptorAuthorisationResponse2)(nil),      // 177: iso20022.model.AcceptorAuthorisationResponse2
+	(*modelpb.AcceptorAuthorisationResponse3)(nil),      // 178: iso20022.model.AcceptorAuthorisationResponse3
+	(*modelpb.AcceptorAuthorisationResponse4)(nil),      // 179: iso20022.model.AcceptorAuthorisationResponse4
+	(*modelpb.AcceptorAuthorisationResponse5)(nil),      // 180: iso20022.model.AcceptorAuthorisationResponse5
+	(*modelpb.Header3)(nil),                             // 181: iso20022.model.Header3
+	(*modelpb.CardPaymentDataSet2)(nil),                 // 182: iso20022.model.CardPaymentDataSet2
+	(*modelpb.ContentInformationType1)(nil),             // 183: iso20022.model.ContentInformationType1
+	(*modelpb.CardPaymentBatchTransferResponse1)(nil),   // 184: iso20022.model.CardPaymentBatchTransferResponse1
+	(*modelpb.ContentInformationType4)(nil),             // 185: iso20022.model.ContentInformationType4
+	(*modelpb.CardPaymentBatchTransferResponse2)(nil),   // 186: iso20022.model.CardPaymentBatchTransferResponse2
+	(*modelpb.ContentInformationType9)(nil),             // 187: iso20022.model.ContentInformationType9
+	(*modelpb.Header12)(nil),                            // 188: iso20022.model.Header12
+	(*modelpb.CardPaymentBatchTransferResponse3)(nil),   // 189: iso20022.model.CardPaymentBatchTransferResponse3
+	(*modelpb.ContentInformationType12)(nil),            // 190: iso20022.model.ContentInformationType12
+	(*modelpb.Header25)(nil),                            // 191: iso20022.model.Header25
+	(*modelpb.CardPaymentBatchTransferResponse4)(nil),   // 192: iso20022.model.CardPaymentBatchTransferResponse4
+	(*modelpb.CardPaymentDataSet1)(nil),                 // 193: iso20022.model.CardPaymentDataSet1
+	(*modelpb.CardPaymentBatchTransfer1)(nil),           // 194: iso20022.model.CardPaymentBatchTransfer1
+	(*modelpb.CardPaymentBatchTransfer2)(nil),           // 195: iso20022.model.CardPaymentBatchTransfer2
+	(*modelpb.CardPaymentBatchTransfer3)(nil),           // 196: iso20022.model.CardPaymentBatchTransfer3
+	(*modelpb.CardPaymentBatchTransfer4)(nil),           // 197: iso20022.model.CardPaymentBatchTransfer4
+	(*modelpb.Header2)(nil),                             // 198: iso20022.model.Header2
+	(*modelpb.AcceptorCancellationAdviceResponse1)(nil), // 199: iso20022.model.AcceptorCancellationAdviceResponse1
+	(*modelpb.AcceptorCancellationAdviceResponse2)(nil), // 200: iso20022.model.AcceptorCancellationAdviceResponse2
+	(*modelpb.Header8)(nil),                             // 201: iso20022.model.Header8
+	(*modelpb.AcceptorCancellationAdviceResponse3)(nil), // 202: iso20022.model.AcceptorCancellationAdviceResponse3
+	(*modelpb.Header11)(nil),                            // 203: iso20022.model.Header11
+	(*modelpb.AcceptorCancellationAdviceResponse4)(nil), // 204: iso20022.model.AcceptorCancellationAdviceResponse4
+	(*modelpb.Header24)(nil),                            // 205: iso20022.model.Header24
+	(*modelpb.AcceptorCancellationAdviceResponse5)(nil), // 206: iso20022.model.AcceptorCancellationAdviceResponse5
+	(*modelpb.AcceptorCancellationAdvice1)(nil),         // 207: iso20022.model.AcceptorCancellationAdvice1
+	(*modelpb.AcceptorCancellationAdvice2)(nil),         // 208: iso20022.model.AcceptorCancellationAdvice2
+	(*modelpb.AcceptorCancellationAdvice3)(nil),         // 209: iso20022.model.AcceptorCancellationAdvice3
+	(*modelpb.AcceptorCancellationAdvice4)(nil),         // 210: iso20022.model.AcceptorCancellationAdvice4
+	(*modelpb.AcceptorCancellationAdvice5)(nil),         // 211: iso20022.model.AcceptorCancellationAdvice5
+	(*modelpb.AcceptorCancellationRequest1)(nil),        // 212: iso20022.model.AcceptorCancellationRequest1
+	(*modelpb.AcceptorCancellationRequest2)(nil),        // 213: iso20022.model.AcceptorCancellationRequest2
+	(*modelpb.AcceptorCancellationRequest3)(nil),        // 214: iso20022.model.AcceptorCancellationRequest3
+	(*modelpb.AcceptorCancellationRequest4)(nil),        // 215: iso20022.model.AcceptorCancellationRequest4
+	(*modelpb.AcceptorCancellationRequest5)(nil),        // 216: iso20022.model.AcceptorCancellationRequest5
+	(*modelpb.AcceptorCancellationResponse1)(nil),       // 217: iso20022.model.AcceptorCancellationResponse1
+	(*modelpb.AcceptorCancellationResponse2)(nil),       // 218: iso20022.model.AcceptorCancellationResponse2
+	(*modelpb.AcceptorCancellationResponse3)(nil),       // 219: iso20022.model.AcceptorCancellationResponse3
+	(*modelpb.AcceptorCancellationResponse4)(nil),       // 220: iso20022.model.AcceptorCancellationResponse4
+	(*modelpb.AcceptorCancellationResponse5)(nil),       // 221: iso20022.model.AcceptorCancellationResponse5
+	(*modelpb.AcceptorCompletionAdviceResponse1)(nil),   // 222: iso20022.model.AcceptorCompletionAdviceResponse1
+	(*modelpb.AcceptorCompletionAdviceResponse2)(nil),   // 223: iso20022.model.AcceptorCompletionAdviceResponse2
+	(*modelpb.AcceptorCompletionAdviceResponse3)(nil),   // 224: iso20022.model.AcceptorCompletionAdviceResponse3
+	(*modelpb.AcceptorCompletionAdviceResponse4)(nil),   // 225: iso20022.model.AcceptorCompletionAdviceResponse4
+	(*modelpb.AcceptorCompletionAdviceResponse5)(nil),   // 226: iso20022.model.AcceptorCompletionAdviceResponse5
+	(*modelpb.AcceptorCompletionAdvice1)(nil),           // 227: iso20022.model.AcceptorCompletionAdvice1
+	(*modelpb.AcceptorCompletionAdvice2)(nil),           // 228: iso20022.model.AcceptorCompletionAdvice2
+	(*modelpb.AcceptorCompletionAdvice3)(nil),           // 229: iso20022.model.AcceptorCompletionAdvice3
+	(*modelpb.AcceptorCompletionAdvice4)(nil),           // 230: iso20022.model.AcceptorCompletionAdvice4
+	(*modelpb.AcceptorCompletionAdvice5)(nil),           // 231: iso20022.model.AcceptorCompletionAdvice5
+	(*modelpb.AcceptorCurrencyConversionRequest1)(nil),  // 232: iso20022.model.AcceptorCurrencyConversionRequest1
+	(*modelpb.AcceptorCurrencyConversionRequest2)(nil),  // 233: iso20022.model.AcceptorCurrencyConversionRequest2
+	(*modelpb.AcceptorCurrencyConversionRequest3)(nil),  // 234: iso20022.model.AcceptorCurrencyConversionRequest3
+	(*modelpb.AcceptorCurrencyConversionResponse1)(nil), // 235: iso20022.model.AcceptorCurrencyConversionResponse1
+	(*modelpb.AcceptorCurrencyConversionResponse2)(nil), // 236: iso20022.model.AcceptorCurrencyConversionResponse2
+	(*modelpb.AcceptorCurrencyConversionResponse3)(nil), // 237: iso20022.model.AcceptorCurrencyConversionResponse3
+	(*modelpb.AcceptorDiagnosticRequest1)(nil),          // 238: iso20022.model.AcceptorDiagnosticRequest1
+	(*modelpb.AcceptorDiagnosticRequest2)(nil),          // 239: iso20022.model.AcceptorDiagnosticRequest2
+	(*modelpb.AcceptorDiagnosticRequest3)(nil),          // 240: iso20022.model.AcceptorDiagnosticRequest3
+	(*modelpb.AcceptorDiagnosticRequest4)(nil),          // 241: iso20022.model.AcceptorDiagnosticRequest4
+	(*modelpb.AcceptorDiagnosticRequest5)(nil),          // 242: iso20022.model.AcceptorDiagnosticRequest5
+	(*modelpb.AcceptorDiagnosticResponse1)(nil),         // 243: iso20022.model.AcceptorDiagnosticResponse1
+	(*modelpb.AcceptorDiagnosticResponse2)(nil),         // 244: iso20022.model.AcceptorDiagnosticResponse2
+	(*modelpb.AcceptorDiagnosticResponse3)(nil),         // 245: iso20022.model.AcceptorDiagnosticResponse3
+	(*modelpb.AcceptorDiagnosticResponse4)(nil),         // 246: iso20022.model.AcceptorDiagnosticResponse4
+	(*modelpb.AcceptorReconciliationRequest1)(nil),      // 247: iso20022.model.AcceptorReconciliationRequest1
+	(*modelpb.AcceptorReconciliationRequest2)(nil),      // 248: iso20022.model.AcceptorReconciliationRequest2
+	(*modelpb.AcceptorReconciliationRequest3)(nil),      // 249: iso20022.model.AcceptorReconciliationRequest3
+	(*modelpb.AcceptorReconciliationRequest4)(nil),      // 250: iso20022.model.AcceptorReconciliationRequest4
+	(*modelpb.AcceptorReconciliationRequest5)(nil),      // 251: iso20022.model.AcceptorReconciliationRequest5
+	(*modelpb.AcceptorReconciliationResponse1)(nil),     // 252: iso20022.model.AcceptorReconciliationResponse1
+	(*modelpb.AcceptorReconciliationResponse2)(nil),     // 253: iso20022.model.AcceptorReconciliationResponse2
+	(*modelpb.AcceptorReconciliationResponse3)(nil),     // 254: iso20022.model.AcceptorReconciliationResponse3
+	(*modelpb.AcceptorReconciliationResponse4)(nil),     // 255: iso20022.model.AcceptorReconciliationResponse4
+	(*modelpb.AcceptorRejection1)(nil),                  // 256: iso20022.model.AcceptorRejection1
+	(*modelpb.Header5)(nil),                             // 257: iso20022.model.Header5
+	(*modelpb.Header9)(nil),                             // 258: iso20022.model.Header9
+	(*modelpb.AcceptorRejection2)(nil),                  // 259: iso20022.model.AcceptorRejection2
+	(*modelpb.Header13)(nil),                            // 260: iso20022.model.Header13
+	(*modelpb.Header26)(nil),                            // 261: iso20022.model.Header26
+}
+var file_iso20022_caaa_proto_depIdxs = []int32{
+	162, // 0: iso20022.caaa.AcceptorAuthorisationRequestV01.header:type_name -> iso20022.model.Header1
+	163, // 1: iso20022.caaa.AcceptorAuthorisationRequestV01.authorisation_request:type_name -> iso20022.model.AcceptorAuthorisationRequest1
+	164, // 2: iso20022.caaa.AcceptorAuthorisationRequestV01.security_trailer:type_name -> iso20022.model.ContentInformationType3
+	162, // 3: iso20022.caaa.AcceptorAuthorisationRequestV02.header:type_name -> iso20022.model.Header1
+	165, // 4: iso20022.caaa.AcceptorAuthorisationRequestV02.authorisation_request:type_name -> iso20022.model.AcceptorAuthorisationRequest2
+	166, // 5: iso20022.caaa.AcceptorAuthorisationRequestV02.security_trailer:type_name -> iso20022.model.ContentInformationType6
+	167, // 6: iso20022.caaa.AcceptorAuthorisationRequestV03.header:type_name -> iso20022.model.Header7
+	168, // 7: iso20022.caaa.AcceptorAuthorisationRequestV03.authorisation_request:type_name -> iso20022.model.AcceptorAuthorisationRequest3
+	169, // 8: iso20022.caaa.AcceptorAuthorisationRequestV03.security_trailer:type_name -> iso20022.model.ContentInformationType8
+	170, // 9: iso20022.caaa.AcceptorAuthorisationRequestV04.header:type_name -> iso20022.model.Header10
+	171, // 10: iso20022.caaa.AcceptorAuthorisationRequestV04.authorisation_request:type_name -> iso20022.model.AcceptorAuthorisationRequest4
+	172, // 11: iso20022.caaa.AcceptorAuthorisationRequestV04.security_trailer:type_name -> iso20022.model.ContentInformationType11
+	173, // 12: iso20022.caaa.AcceptorAuthorisationRequestV05.header:type_name -> iso20022.model.Header30
+	174, // 13: iso20022.caaa.AcceptorAuthorisationRequestV05.authorisation_request:type_name -> iso20022.model.AcceptorAuthorisationRequest5
+	175, // 14: iso20022.caaa.AcceptorAuthorisationRequestV05.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	162, // 15: iso20022.caaa.AcceptorAuthorisationResponseV01.header:type_name -> iso20022.model.Header1
+	176, // 16: iso20022.caaa.AcceptorAuthorisationResponseV01.authorisation_response:type_name -> iso20022.model.AcceptorAuthorisationResponse1
+	164, // 17: iso20022.caaa.AcceptorAuthorisationResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType3
+	162, // 18: iso20022.caaa.AcceptorAuthorisationResponseV02.header:type_name -> iso20022.model.Header1
+	177, // 19: iso20022.caaa.AcceptorAuthorisationResponseV02.authorisation_response:type_name -> iso20022.model.AcceptorAuthorisationResponse2
+	166, // 20: iso20022.caaa.AcceptorAuthorisationResponseV02.security_trailer:type_name -> iso20022.model.ContentInformationType6
+	167, // 21: iso20022.caaa.AcceptorAuthorisationResponseV03.header:type_name -> iso20022.model.Header7
+	178, // 22: iso20022.caaa.AcceptorAuthorisationResponseV03.authorisation_response:type_name -> iso20022.model.AcceptorAuthorisationResponse3
+	169, // 23: iso20022.caaa.AcceptorAuthorisationResponseV03.security_trailer:type_name -> iso20022.model.ContentInformationType8
+	170, // 24: iso20022.caaa.AcceptorAuthorisationResponseV04.header:type_name -> iso20022.model.Header10
+	179, // 25: iso20022.caaa.AcceptorAuthorisationResponseV04.authorisation_response:type_name -> iso20022.model.AcceptorAuthorisationResponse4
+	172, // 26: iso20022.caaa.AcceptorAuthorisationResponseV04.security_trailer:type_name -> iso20022.model.ContentInformationType11
+	173, // 27: iso20022.caaa.AcceptorAuthorisationResponseV05.header:type_name -> iso20022.model.Header30
+	180, // 28: iso20022.caaa.AcceptorAuthorisationResponseV05.authorisation_response:type_name -> iso20022.model.AcceptorAuthorisationResponse5
+	175, // 29: iso20022.caaa.AcceptorAuthorisationResponseV05.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	181, // 30: iso20022.caaa.AcceptorBatchTransferResponseV01.header:type_name -> iso20022.model.Header3
+	182, // 31: iso20022.caaa.AcceptorBatchTransferResponseV01.data_set:type_name -> iso20022.model.CardPaymentDataSet2
+	183, // 32: iso20022.caaa.AcceptorBatchTransferResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType1
+	181, // 33: iso20022.caaa.AcceptorBatchTransferResponseV02.header:type_name -> iso20022.model.Header3
+	184, // 34: iso20022.caaa.AcceptorBatchTransferResponseV02.batch_transfer_response:type_name -> iso20022.model.CardPaymentBatchTransferResponse1
+	185, // 35: iso20022.caaa.AcceptorBatchTransferResponseV02.security_trailer:type_name -> iso20022.model.ContentInformationType4
+	181, // 36: iso20022.caaa.AcceptorBatchTransferResponseV03.header:type_name -> iso20022.model.Header3
+	186, // 37: iso20022.caaa.AcceptorBatchTransferResponseV03.batch_transfer_response:type_name -> iso20022.model.CardPaymentBatchTransferResponse2
+	187, // 38: iso20022.caaa.AcceptorBatchTransferResponseV03.security_trailer:type_name -> iso20022.model.ContentInformationType9
+	188, // 39: iso20022.caaa.AcceptorBatchTransferResponseV04.header:type_name -> iso20022.model.Header12
+	189, // 40: iso20022.caaa.AcceptorBatchTransferResponseV04.batch_transfer_response:type_name -> iso20022.model.CardPaymentBatchTransferResponse3
+	190, // 41: iso20022.caaa.AcceptorBatchTransferResponseV04.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	191, // 42: iso20022.caaa.AcceptorBatchTransferResponseV05.header:type_name -> iso20022.model.Header25
+	192, // 43: iso20022.caaa.AcceptorBatchTransferResponseV05.batch_transfer_response:type_name -> iso20022.model.CardPaymentBatchTransferResponse4
+	190, // 44: iso20022.caaa.AcceptorBatchTransferResponseV05.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	181, // 45: iso20022.caaa.AcceptorBatchTransferV01.header:type_name -> iso20022.model.Header3
+	193, // 46: iso20022.caaa.AcceptorBatchTransferV01.data_set:type_name -> iso20022.model.CardPaymentDataSet1
+	183, // 47: iso20022.caaa.AcceptorBatchTransferV01.security_trailer:type_name -> iso20022.model.ContentInformationType1
+	181, // 48: iso20022.caaa.AcceptorBatchTransferV02.header:type_name -> iso20022.model.Header3
+	194, // 49: iso20022.caaa.AcceptorBatchTransferV02.batch_transfer:type_name -> iso20022.model.CardPaymentBatchTransfer1
+	185, // 50: iso20022.caaa.AcceptorBatchTransferV02.security_trailer:type_name -> iso20022.model.ContentInformationType4
+	181, // 51: iso20022.caaa.AcceptorBatchTransferV03.header:type_name -> iso20022.model.Header3
+	195, // 52: iso20022.caaa.AcceptorBatchTransferV03.batch_transfer:type_name -> iso20022.model.CardPaymentBatchTransfer2
+	187, // 53: iso20022.caaa.AcceptorBatchTransferV03.security_trailer:type_name -> iso20022.model.ContentInformationType9
+	188, // 54: iso20022.caaa.AcceptorBatchTransferV04.header:type_name -> iso20022.model.Header12
+	196, // 55: iso20022.caaa.AcceptorBatchTransferV04.batch_transfer:type_name -> iso20022.model.CardPaymentBatchTransfer3
+	190, // 56: iso20022.caaa.AcceptorBatchTransferV04.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	191, // 57: iso20022.caaa.AcceptorBatchTransferV05.header:type_name -> iso20022.model.Header25
+	197, // 58: iso20022.caaa.AcceptorBatchTransferV05.batch_transfer:type_name -> iso20022.model.CardPaymentBatchTransfer4
+	190, // 59: iso20022.caaa.AcceptorBatchTransferV05.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	198, // 60: iso20022.caaa.AcceptorCancellationAdviceResponseV01.header:type_name -> iso20022.model.Header2
+	199, // 61: iso20022.caaa.AcceptorCancellationAdviceResponseV01.acceptor_cancellation_advice_response:type_name -> iso20022.model.AcceptorCancellationAdviceResponse1
+	164, // 62: iso20022.caaa.AcceptorCancellationAdviceResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType3
+	198, // 63: iso20022.caaa.AcceptorCancellationAdviceResponseV02.header:type_name -> iso20022.model.Header2
+	200, // 64: iso20022.caaa.AcceptorCancellationAdviceResponseV02.cancellation_advice_response:type_name -> iso20022.model.AcceptorCancellationAdviceResponse2
+	166, // 65: iso20022.caaa.AcceptorCancellationAdviceResponseV02.security_trailer:type_name -> iso20022.model.ContentInformationType6
+	201, // 66: iso20022.caaa.AcceptorCancellationAdviceResponseV03.header:type_name -> iso20022.model.Header8
+	202, // 67: iso20022.caaa.AcceptorCancellationAdviceResponseV03.cancellation_advice_response:type_name -> iso20022.model.AcceptorCancellationAdviceResponse3
+	169, // 68: iso20022.caaa.AcceptorCancellationAdviceResponseV03.security_trailer:type_name -> iso20022.model.ContentInformationType8
+	203, // 69: iso20022.caaa.AcceptorCancellationAdviceResponseV04.header:type_name -> iso20022.model.Header11
+	204, // 70: iso20022.caaa.AcceptorCancellationAdviceResponseV04.cancellation_advice_response:type_name -> iso20022.model.AcceptorCancellationAdviceResponse4
+	172, // 71: iso20022.caaa.AcceptorCancellationAdviceResponseV04.security_trailer:type_name -> iso20022.model.ContentInformationType11
+	205, // 72: iso20022.caaa.AcceptorCancellationAdviceResponseV05.header:type_name -> iso20022.model.Header24
+	206, // 73: iso20022.caaa.AcceptorCancellationAdviceResponseV05.cancellation_advice_response:type_name -> iso20022.model.AcceptorCancellationAdviceResponse5
+	175, // 74: iso20022.caaa.AcceptorCancellationAdviceResponseV05.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	198, // 75: iso20022.caaa.AcceptorCancellationAdviceV01.header:type_name -> iso20022.model.Header2
+	207, // 76: iso20022.caaa.AcceptorCancellationAdviceV01.cancellation_advice:type_name -> iso20022.model.AcceptorCancellationAdvice1
+	164, // 77: iso20022.caaa.AcceptorCancellationAdviceV01.security_trailer:type_name -> iso20022.model.ContentInformationType3
+	198, // 78: iso20022.caaa.AcceptorCancellationAdviceV02.header:type_name -> iso20022.model.Header2
+	208, // 79: iso20022.caaa.AcceptorCancellationAdviceV02.cancellation_advice:type_name -> iso20022.model.AcceptorCancellationAdvice2
+	166, // 80: iso20022.caaa.AcceptorCancellationAdviceV02.security_trailer:type_name -> iso20022.model.ContentInformationType6
+	201, // 81: iso20022.caaa.AcceptorCancellationAdviceV03.header:type_name -> iso20022.model.Header8
+	209, // 82: iso20022.caaa.AcceptorCancellationAdviceV03.cancellation_advice:type_name -> iso20022.model.AcceptorCancellationAdvice3
+	169, // 83: iso20022.caaa.AcceptorCancellationAdviceV03.security_trailer:type_name -> iso20022.model.ContentInformationType8
+	203, // 84: iso20022.caaa.AcceptorCancellationAdviceV04.header:type_name -> iso20022.model.Header11
+	210, // 85: iso20022.caaa.AcceptorCancellationAdviceV04.cancellation_advice:type_name -> iso20022.model.AcceptorCancellationAdvice4
+	172, // 86: iso20022.caaa.AcceptorCancellationAdviceV04.security_trailer:type_name -> iso20022.model.ContentInformationType11
+	205, // 87: iso20022.caaa.AcceptorCancellationAdviceV05.header:type_name -> iso20022.model.Header24
+	211, // 88: iso20022.caaa.AcceptorCancellationAdviceV05.cancellation_advice:type_name -> iso20022.model.AcceptorCancellationAdvice5
+	175, // 89: iso20022.caaa.AcceptorCancellationAdviceV05.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	162, // 90: iso20022.caaa.AcceptorCancellationRequestV01.header:type_name -> iso20022.model.Header1
+	212, // 91: iso20022.caaa.AcceptorCancellationRequestV01.cancellation_request:type_name -> iso20022.model.AcceptorCancellationRequest1
+	164, // 92: iso20022.caaa.AcceptorCancellationRequestV01.security_trailer:type_name -> iso20022.model.ContentInformationType3
+	162, // 93: iso20022.caaa.AcceptorCancellationRequestV02.header:type_name -> iso20022.model.Header1
+	213, // 94: iso20022.caaa.AcceptorCancellationRequestV02.cancellation_request:type_name -> iso20022.model.AcceptorCancellationRequest2
+	166, // 95: iso20022.caaa.AcceptorCancellationRequestV02.security_trailer:type_name -> iso20022.model.ContentInformationType6
+	167, // 96: iso20022.caaa.AcceptorCancellationRequestV03.header:type_name -> iso20022.model.Header7
+	214, // 97: iso20022.caaa.AcceptorCancellationRequestV03.cancellation_request:type_name -> iso20022.model.AcceptorCancellationRequest3
+	169, // 98: iso20022.caaa.AcceptorCancellationRequestV03.security_trailer:type_name -> iso20022.model.ContentInformationType8
+	170, // 99: iso20022.caaa.AcceptorCancellationRequestV04.header:type_name -> iso20022.model.Header10
+	215, // 100: iso20022.caaa.AcceptorCancellationRequestV04.cancellation_request:type_name -> iso20022.model.AcceptorCancellationRequest4
+	172, // 101: iso20022.caaa.AcceptorCancellationRequestV04.security_trailer:type_name -> iso20022.model.ContentInformationType11
+	173, // 102: iso20022.caaa.AcceptorCancellationRequestV05.header:type_name -> iso20022.model.Header30
+	216, // 103: iso20022.caaa.AcceptorCancellationRequestV05.cancellation_request:type_name -> iso20022.model.AcceptorCancellationRequest5
+	175, // 104: iso20022.caaa.AcceptorCancellationRequestV05.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	162, // 105: iso20022.caaa.AcceptorCancellationResponseV01.header:type_name -> iso20022.model.Header1
+	217, // 106: iso20022.caaa.AcceptorCancellationResponseV01.cancellation_response:type_name -> iso20022.model.AcceptorCancellationResponse1
+	164, // 107: iso20022.caaa.AcceptorCancellationResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType3
+	162, // 108: iso20022.caaa.AcceptorCancellationResponseV02.header:type_name -> iso20022.model.Header1
+	218, // 109: iso20022.caaa.AcceptorCancellationResponseV02.cancellation_response:type_name -> iso20022.model.AcceptorCancellationResponse2
+	166, // 110: iso20022.caaa.AcceptorCancellationResponseV02.security_trailer:type_name -> iso20022.model.ContentInformationType6
+	167, // 111: iso20022.caaa.AcceptorCancellationResponseV03.header:type_name -> iso20022.model.Header7
+	219, // 112: iso20022.caaa.AcceptorCancellationResponseV03.cancellation_response:type_name -> iso20022.model.AcceptorCancellationResponse3
+	169, // 113: iso20022.caaa.AcceptorCancellationResponseV03.security_trailer:type_name -> iso20022.model.ContentInformationType8
+	170, // 114: iso20022.caaa.AcceptorCancellationResponseV04.header:type_name -> iso20022.model.Header10
+	220, // 115: iso20022.caaa.AcceptorCancellationResponseV04.cancellation_response:type_name -> iso20022.model.AcceptorCancellationResponse4
+	172, // 116: iso20022.caaa.AcceptorCancellationResponseV04.security_trailer:type_name -> iso20022.model.ContentInformationType11
+	173, // 117: iso20022.caaa.AcceptorCancellationResponseV05.header:type_name -> iso20022.model.Header30
+	221, // 118: iso20022.caaa.AcceptorCancellationResponseV05.cancellation_response:type_name -> iso20022.model.AcceptorCancellationResponse5
+	175, // 119: iso20022.caaa.AcceptorCancellationResponseV05.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	198, // 120: iso20022.caaa.AcceptorCompletionAdviceResponseV01.header:type_name -> iso20022.model.Header2
+	222, // 121: iso20022.caaa.AcceptorCompletionAdviceResponseV01.completion_advice_response:type_name -> iso20022.model.AcceptorCompletionAdviceResponse1
+	164, // 122: iso20022.caaa.AcceptorCompletionAdviceResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType3
+	198, // 123: iso20022.caaa.AcceptorCompletionAdviceResponseV02.header:type_name -> iso20022.model.Header2
+	223, // 124: iso20022.caaa.AcceptorCompletionAdviceResponseV02.completion_advice_response:type_name -> iso20022.model.AcceptorCompletionAdviceResponse2
+	166, // 125: iso20022.caaa.AcceptorCompletionAdviceResponseV02.security_trailer:type_name -> iso20022.model.ContentInformationType6
+	201, // 126: iso20022.caaa.AcceptorCompletionAdviceResponseV03.header:type_name -> iso20022.model.Header8
+	224, // 127: iso20022.caaa.AcceptorCompletionAdviceResponseV03.completion_advice_response:type_name -> iso20022.model.AcceptorCompletionAdviceResponse3
+	169, // 128: iso20022.caaa.AcceptorCompletionAdviceResponseV03.security_trailer:type_name -> iso20022.model.ContentInformationType8
+	203, // 129: iso20022.caaa.AcceptorCompletionAdviceResponseV04.header:type_name -> iso20022.model.Header11
+	225, // 130: iso20022.caaa.AcceptorCompletionAdviceResponseV04.completion_advice_response:type_name -> iso20022.model.AcceptorCompletionAdviceResponse4
+	172, // 131: iso20022.caaa.AcceptorCompletionAdviceResponseV04.security_trailer:type_name -> iso20022.model.ContentInformationType11
+	205, // 132: iso20022.caaa.AcceptorCompletionAdviceResponseV05.header:type_name -> iso20022.model.Header24
+	226, // 133: iso20022.caaa.AcceptorCompletionAdviceResponseV05.completion_advice_response:type_name -> iso20022.model.AcceptorCompletionAdviceResponse5
+	175, // 134: iso20022.caaa.AcceptorCompletionAdviceResponseV05.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	198, // 135: iso20022.caaa.AcceptorCompletionAdviceV01.header:type_name -> iso20022.model.Header2
+	227, // 136: iso20022.caaa.AcceptorCompletionAdviceV01.completion_advice:type_name -> iso20022.model.AcceptorCompletionAdvice1
+	164, // 137: iso20022.caaa.AcceptorCompletionAdviceV01.security_trailer:type_name -> iso20022.model.ContentInformationType3
+	198, // 138: iso20022.caaa.AcceptorCompletionAdviceV02.header:type_name -> iso20022.model.Header2
+	228, // 139: iso20022.caaa.AcceptorCompletionAdviceV02.completion_advice:type_name -> iso20022.model.AcceptorCompletionAdvice2
+	166, // 140: iso20022.caaa.AcceptorCompletionAdviceV02.security_trailer:type_name -> iso20022.model.ContentInformationType6
+	201, // 141: iso20022.caaa.AcceptorCompletionAdviceV03.header:type_name -> iso20022.model.Header8
+	229, // 142: iso20022.caaa.AcceptorCompletionAdviceV03.completion_advice:type_name -> iso20022.model.AcceptorCompletionAdvice3
+	169, // 143: iso20022.caaa.AcceptorCompletionAdviceV03.security_trailer:type_name -> iso20022.model.ContentInformationType8
+	203, // 144: iso20022.caaa.AcceptorCompletionAdviceV04.header:type_name -> iso20022.model.Header11
+	230, // 145: iso20022.caaa.AcceptorCompletionAdviceV04.completion_advice:type_name -> iso20022.model.AcceptorCompletionAdvice4
+	172, // 146: iso20022.caaa.AcceptorCompletionAdviceV04.security_trailer:type_name -> iso20022.model.ContentInformationType11
+	205, // 147: iso20022.caaa.AcceptorCompletionAdviceV05.header:type_name -> iso20022.model.Header24
+	231, // 148: iso20022.caaa.AcceptorCompletionAdviceV05.completion_advice:type_name -> iso20022.model.AcceptorCompletionAdvice5
+	175, // 149: iso20022.caaa.AcceptorCompletionAdviceV05.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	167, // 150: iso20022.caaa.AcceptorCurrencyConversionRequestV01.header:type_name -> iso20022.model.Header7
+	232, // 151: iso20022.caaa.AcceptorCurrencyConversionRequestV01.currency_conversion_request:type_name -> iso20022.model.AcceptorCurrencyConversionRequest1
+	169, // 152: iso20022.caaa.AcceptorCurrencyConversionRequestV01.security_trailer:type_name -> iso20022.model.ContentInformationType8
+	170, // 153: iso20022.caaa.AcceptorCurrencyConversionRequestV02.header:type_name -> iso20022.model.Header10
+	233, // 154: iso20022.caaa.AcceptorCurrencyConversionRequestV02.currency_conversion_request:type_name -> iso20022.model.AcceptorCurrencyConversionRequest2
+	172, // 155: iso20022.caaa.AcceptorCurrencyConversionRequestV02.security_trailer:type_name -> iso20022.model.ContentInformationType11
+	173, // 156: iso20022.caaa.AcceptorCurrencyConversionRequestV03.header:type_name -> iso20022.model.Header30
+	234, // 157: iso20022.caaa.AcceptorCurrencyConversionRequestV03.currency_conversion_request:type_name -> iso20022.model.AcceptorCurrencyConversionRequest3
+	175, // 158: iso20022.caaa.AcceptorCurrencyConversionRequestV03.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	167, // 159: iso20022.caaa.AcceptorCurrencyConversionResponseV01.header:type_name -> iso20022.model.Header7
+	235, // 160: iso20022.caaa.AcceptorCurrencyConversionResponseV01.currency_conversion_response:type_name -> iso20022.model.AcceptorCurrencyConversionResponse1
+	169, // 161: iso20022.caaa.AcceptorCurrencyConversionResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType8
+	170, // 162: iso20022.caaa.AcceptorCurrencyConversionResponseV02.header:type_name -> iso20022.model.Header10
+	236, // 163: iso20022.caaa.AcceptorCurrencyConversionResponseV02.currency_conversion_response:type_name -> iso20022.model.AcceptorCurrencyConversionResponse2
+	172, // 164: iso20022.caaa.AcceptorCurrencyConversionResponseV02.security_trailer:type_name -> iso20022.model.ContentInformationType11
+	173, // 165: iso20022.caaa.AcceptorCurrencyConversionResponseV03.header:type_name -> iso20022.model.Header30
+	237, // 166: iso20022.caaa.AcceptorCurrencyConversionResponseV03.currency_conversion_response:type_name -> iso20022.model.AcceptorCurrencyConversionResponse3
+	175, // 167: iso20022.caaa.AcceptorCurrencyConversionResponseV03.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	162, // 168: iso20022.caaa.AcceptorDiagnosticRequestV01.header:type_name -> iso20022.model.Header1
+	238, // 169: iso20022.caaa.AcceptorDiagnosticRequestV01.diagnostic_request:type_name -> iso20022.model.AcceptorDiagnosticRequest1
+	164, // 170: iso20022.caaa.AcceptorDiagnosticRequestV01.security_trailer:type_name -> iso20022.model.ContentInformationType3
+	162, // 171: iso20022.caaa.AcceptorDiagnosticRequestV02.header:type_name -> iso20022.model.Header1
+	239, // 172: iso20022.caaa.AcceptorDiagnosticRequestV02.diagnostic_request:type_name -> iso20022.model.AcceptorDiagnosticRequest2
+	166, // 173: iso20022.caaa.AcceptorDiagnosticRequestV02.security_trailer:type_name -> iso20022.model.ContentInformationType6
+	167, // 174: iso20022.caaa.AcceptorDiagnosticRequestV03.header:type_name -> iso20022.model.Header7
+	240, // 175: iso20022.caaa.AcceptorDiagnosticRequestV03.diagnostic_request:type_name -> iso20022.model.AcceptorDiagnosticRequest3
+	169, // 176: iso20022.caaa.AcceptorDiagnosticRequestV03.security_trailer:type_name -> iso20022.model.ContentInformationType8
+	170, // 177: iso20022.caaa.AcceptorDiagnosticRequestV04.header:type_name -> iso20022.model.Header10
+	241, // 178: iso20022.caaa.AcceptorDiagnosticRequestV04.diagnostic_request:type_name -> iso20022.model.AcceptorDiagnosticRequest4
+	172, // 179: iso20022.caaa.AcceptorDiagnosticRequestV04.security_trailer:type_name -> iso20022.model.ContentInformationType11
+	173, // 180: iso20022.caaa.AcceptorDiagnosticRequestV05.header:type_name -> iso20022.model.Header30
+	242, // 181: iso20022.caaa.AcceptorDiagnosticRequestV05.diagnostic_request:type_name -> iso20022.model.AcceptorDiagnosticRequest5
+	175, // 182: iso20022.caaa.AcceptorDiagnosticRequestV05.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	162, // 183: iso20022.caaa.AcceptorDiagnosticResponseV01.header:type_name -> iso20022.model.Header1
+	243, // 184: iso20022.caaa.AcceptorDiagnosticResponseV01.diagnostic_response:type_name -> iso20022.model.AcceptorDiagnosticResponse1
+	164, // 185: iso20022.caaa.AcceptorDiagnosticResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType3
+	162, // 186: iso20022.caaa.AcceptorDiagnosticResponseV02.header:type_name -> iso20022.model.Header1
+	244, // 187: iso20022.caaa.AcceptorDiagnosticResponseV02.diagnostic_response:type_name -> iso20022.model.AcceptorDiagnosticResponse2
+	166, // 188: iso20022.caaa.AcceptorDiagnosticResponseV02.security_trailer:type_name -> iso20022.model.ContentInformationType6
+	167, // 189: iso20022.caaa.AcceptorDiagnosticResponseV03.header:type_name -> iso20022.model.Header7
+	245, // 190: iso20022.caaa.AcceptorDiagnosticResponseV03.diagnostic_response:type_name -> iso20022.model.AcceptorDiagnosticResponse3
+	169, // 191: iso20022.caaa.AcceptorDiagnosticResponseV03.security_trailer:type_name -> iso20022.model.ContentInformationType8
+	170, // 192: iso20022.caaa.AcceptorDiagnosticResponseV04.header:type_name -> iso20022.model.Header10
+	246, // 193: iso20022.caaa.AcceptorDiagnosticResponseV04.diagnostic_response:type_name -> iso20022.model.AcceptorDiagnosticResponse4
+	172, // 194: iso20022.caaa.AcceptorDiagnosticResponseV04.security_trailer:type_name -> iso20022.model.ContentInformationType11
+	173, // 195: iso20022.caaa.AcceptorDiagnosticResponseV05.header:type_name -> iso20022.model.Header30
+	246, // 196: iso20022.caaa.AcceptorDiagnosticResponseV05.diagnostic_response:type_name -> iso20022.model.AcceptorDiagnosticResponse4
+	175, // 197: iso20022.caaa.AcceptorDiagnosticResponseV05.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	162, // 198: iso20022.caaa.AcceptorReconciliationRequestV01.header:type_name -> iso20022.model.Header1
+	247, // 199: iso20022.caaa.AcceptorReconciliationRequestV01.reconciliation_request:type_name -> iso20022.model.AcceptorReconciliationRequest1
+	164, // 200: iso20022.caaa.AcceptorReconciliationRequestV01.security_trailer:type_name -> iso20022.model.ContentInformationType3
+	162, // 201: iso20022.caaa.AcceptorReconciliationRequestV02.header:type_name -> iso20022.model.Header1
+	248, // 202: iso20022.caaa.AcceptorReconciliationRequestV02.reconciliation_request:type_name -> iso20022.model.AcceptorReconciliationRequest2
+	166, // 203: iso20022.caaa.AcceptorReconciliationRequestV02.security_trailer:type_name -> iso20022.model.ContentInformationType6
+	167, // 204: iso20022.caaa.AcceptorReconciliationRequestV03.header:type_name -> iso20022.model.Header7
+	249, // 205: iso20022.caaa.AcceptorReconciliationRequestV03.reconciliation_request:type_name -> iso20022.model.AcceptorReconciliationRequest3
+	169, // 206: iso20022.caaa.AcceptorReconciliationRequestV03.security_trailer:type_name -> iso20022.model.ContentInformationType8
+	170, // 207: iso20022.caaa.AcceptorReconciliationRequestV04.header:type_name -> iso20022.model.Header10
+	250, // 208: iso20022.caaa.AcceptorReconciliationRequestV04.reconciliation_request:type_name -> iso20022.model.AcceptorReconciliationRequest4
+	172, // 209: iso20022.caaa.AcceptorReconciliationRequestV04.security_trailer:type_name -> iso20022.model.ContentInformationType11
+	173, // 210: iso20022.caaa.AcceptorReconciliationRequestV05.header:type_name -> iso20022.model.Header30
+	251, // 211: iso20022.caaa.AcceptorReconciliationRequestV05.reconciliation_request:type_name -> iso20022.model.AcceptorReconciliationRequest5
+	175, // 212: iso20022.caaa.AcceptorReconciliationRequestV05.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	162, // 213: iso20022.caaa.AcceptorReconciliationResponseV01.header:type_name -> iso20022.model.Header1
+	252, // 214: iso20022.caaa.AcceptorReconciliationResponseV01.reconciliation_response:type_name -> iso20022.model.AcceptorReconciliationResponse1
+	164, // 215: iso20022.caaa.AcceptorReconciliationResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType3
+	162, // 216: iso20022.caaa.AcceptorReconciliationResponseV02.header:type_name -> iso20022.model.Header1
+	253, // 217: iso20022.caaa.AcceptorReconciliationResponseV02.reconciliation_response:type_name -> iso20022.model.AcceptorReconciliationResponse2
+	166, // 218: iso20022.caaa.AcceptorReconciliationResponseV02.security_trailer:type_name -> iso20022.model.ContentInformationType6
+	167, // 219: iso20022.caaa.AcceptorReconciliationResponseV03.header:type_name -> iso20022.model.Header7
+	253, // 220: iso20022.caaa.AcceptorReconciliationResponseV03.reconciliation_response:type_name -> iso20022.model.AcceptorReconciliationResponse2
+	169, // 221: iso20022.caaa.AcceptorReconciliationResponseV03.security_trailer:type_name -> iso20022.model.ContentInformationType8
+	170, // 222: iso20022.caaa.AcceptorReconciliationResponseV04.header:type_name -> iso20022.model.Header10
+	254, // 223: iso20022.caaa.AcceptorReconciliationResponseV04.reconciliation_response:type_name -> iso20022.model.AcceptorReconciliationResponse3
+	172, // 224: iso20022.caaa.AcceptorReconciliationResponseV04.security_trailer:type_name -> iso20022.model.ContentInformationType11
+	173, // 225: iso20022.caaa.AcceptorReconciliationResponseV05.header:type_name -> iso20022.model.Header30
+	255, // 226: iso20022.caaa.AcceptorReconciliationResponseV05.reconciliation_response:type_name -> iso20022.model.AcceptorReconciliationResponse4
+	175, // 227: iso20022.caaa.AcceptorReconciliationResponseV05.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	162, // 228: iso20022.caaa.AcceptorRejectionV01.header:type_name -> iso20022.model.Header1
+	256, // 229: iso20022.caaa.AcceptorRejectionV01.reject:type_name -> iso20022.model.AcceptorRejection1
+	257, // 230: iso20022.caaa.AcceptorRejectionV02.header:type_name -> iso20022.model.Header5
+	256, // 231: iso20022.caaa.AcceptorRejectionV02.reject:type_name -> iso20022.model.AcceptorRejection1
+	258, // 232: iso20022.caaa.AcceptorRejectionV03.header:type_name -> iso20022.model.Header9
+	259, // 233: iso20022.caaa.AcceptorRejectionV03.reject:type_name -> iso20022.model.AcceptorRejection2
+	260, // 234: iso20022.caaa.AcceptorRejectionV04.header:type_name -> iso20022.model.Header13
+	259, // 235: iso20022.caaa.AcceptorRejectionV04.reject:type_name -> iso20022.model.AcceptorRejection2
+	261, // 236: iso20022.caaa.AcceptorRejectionV05.header:type_name -> iso20022.model.Header26
+	259, // 237: iso20022.caaa.AcceptorRejectionV05.reject:type_name -> iso20022.model.AcceptorRejection2
+	0,   // 238: iso20022.caaa.Document00100101.message:type_name -> iso20022.caaa.AcceptorAuthorisationRequestV01
+	1,   // 239: iso20022.caaa.Document00100102.message:type_name -> iso20022.caaa.AcceptorAuthorisationRequestV02
+	2,   // 240: iso20022.caaa.Document00100103.message:type_name -> iso20022.caaa.AcceptorAuthorisationRequestV03
+	3,   // 241: iso20022.caaa.Document00100104.message:type_name -> iso20022.caaa.AcceptorAuthorisationRequestV04
+	4,   // 242: iso20022.caaa.Document00100105.message:type_name -> iso20022.caaa.AcceptorAuthorisationRequestV05
+	5,   // 243: iso20022.caaa.Document00200101.message:type_name -> iso20022.caaa.AcceptorAuthorisationResponseV01
+	6,   // 244: iso20022.caaa.Document00200102.message:type_name -> iso20022.caaa.AcceptorAuthorisationResponseV02
+	7,   // 245: iso20022.caaa.Document00200103.message:type_name -> iso20022.caaa.AcceptorAuthorisationResponseV03
+	8,   // 246: iso20022.caaa.Document00200104.message:type_name -> iso20022.caaa.AcceptorAuthorisationResponseV04
+	9,   // 247: iso20022.caaa.Document00200105.message:type_name -> iso20022.caaa.AcceptorAuthorisationResponseV05
+	45,  // 248: iso20022.caaa.Document00300101.message:type_name -> iso20022.caaa.AcceptorCompletionAdviceV01
+	46,  // 249: iso20022.caaa.Document00300102.message:type_name -> iso20022.caaa.AcceptorCompletionAdviceV02
+	47,  // 250: iso20022.caaa.Document00300103.message:type_name -> iso20022.caaa.AcceptorCompletionAdviceV03
+	48,  // 251: iso20022.caaa.Document00300104.message:type_name -> iso20022.caaa.AcceptorCompletionAdviceV04
+	49,  // 252: iso20022.caaa.Document00300105.message:type_name -> iso20022.caaa.AcceptorCompletionAdviceV05
+	40,  // 253: iso20022.caaa.Document00400101.message:type_name -> iso20022.caaa.AcceptorCompletionAdviceResponseV01
+	41,  // 254: iso20022.caaa.Document00400102.message:type_name -> iso20022.caaa.AcceptorCompletionAdviceResponseV02
+	42,  // 255: iso20022.caaa.Document00400103.message:type_name -> iso20022.caaa.AcceptorCompletionAdviceResponseV03
+	43,  // 256: iso20022.caaa.Document00400104.message:type_name -> iso20022.caaa.AcceptorCompletionAdviceResponseV04
+	44,  // 257: iso20022.caaa.Document00400105.message:type_name -> iso20022.caaa.AcceptorCompletionAdviceResponseV05
+	30,  // 258: iso20022.caaa.Document00500101.message:type_name -> iso20022.caaa.AcceptorCancellationRequestV01
+	31,  // 259: iso20022.caaa.Document00500102.message:type_name -> iso20022.caaa.AcceptorCancellationRequestV02
+	32,  // 260: iso20022.caaa.Document00500103.message:type_name -> iso20022.caaa.AcceptorCancellationRequestV03
+	33,  // 261: iso20022.caaa.Document00500104.message:type_name -> iso20022.caaa.AcceptorCancellationRequestV04
+	34,  // 262: iso20022.caaa.Document00500105.message:type_name -> iso20022.caaa.AcceptorCancellationRequestV05
+	35,  // 263: iso20022.caaa.Document00600101.message:type_name -> iso20022.caaa.AcceptorCancellationResponseV01
+	36,  // 264: iso20022.caaa.Document00600102.message:type_name -> iso20022.caaa.AcceptorCancellationResponseV02
+	37,  // 265: iso20022.caaa.Document00600103.message:type_name -> iso20022.caaa.AcceptorCancellationResponseV03
+	38,  // 266: iso20022.caaa.Document00600104.message:type_name -> iso20022.caaa.AcceptorCancellationResponseV04
+	39,  // 267: iso20022.caaa.Document00600105.message:type_name -> iso20022.caaa.AcceptorCancellationResponseV05
+	25,  // 268: iso20022.caaa.Document00700101.message:type_name -> iso20022.caaa.AcceptorCancellationAdviceV01
+	26,  // 269: iso20022.caaa.Document00700102.message:type_name -> iso20022.caaa.AcceptorCancellationAdviceV02
+	27,  // 270: iso20022.caaa.Document00700103.message:type_name -> iso20022.caaa.AcceptorCancellationAdviceV03
+	28,  // 271: iso20022.caaa.Document00700104.message:type_name -> iso20022.caaa.AcceptorCancellationAdviceV04
+	29,  // 272: iso20022.caaa.Document00700105.message:type_name -> iso20022.caaa.AcceptorCancellationAdviceV05
+	20,  // 273: iso20022.caaa.Document00800101.message:type_name -> iso20022.caaa.AcceptorCancellationAdviceResponseV01
+	21,  // 274: iso20022.caaa.Document00800102.message:type_name -> iso20022.caaa.AcceptorCancellationAdviceResponseV02
+	22,  // 275: iso20022.caaa.Document00800103.message:type_name -> iso20022.caaa.AcceptorCancellationAdviceResponseV03
+	23,  // 276: iso20022.caaa.Document00800104.message:type_name -> iso20022.caaa.AcceptorCancellationAdviceResponseV04
+	24,  // 277: iso20022.caaa.Document00800105.message:type_name -> iso20022.caaa.AcceptorCancellationAdviceResponseV05
+	66,  // 278: iso20022.caaa.Document00900101.message:type_name -> iso20022.caaa.AcceptorReconciliationRequestV01
+	67,  // 279: iso20022.caaa.Document00900102.message:type_name -> iso20022.caaa.AcceptorReconciliationRequestV02
+	68,  // 280: iso20022.caaa.Document00900103.message:type_name -> iso20022.caaa.AcceptorReconciliationRequestV03
+	69,  // 281: iso20022.caaa.Document00900104.message:type_name -> iso20022.caaa.AcceptorReconciliationRequestV04
+	70,  // 282: iso20022.caaa.Document00900105.message:type_name -> iso20022.caaa.AcceptorReconciliationRequestV05
+	71,  // 283: iso20022.caaa.Document01000101.message:type_name -> iso20022.caaa.AcceptorReconciliationResponseV01
+	72,  // 284: iso20022.caaa.Document01000102.message:type_name -> iso20022.caaa.AcceptorReconciliationResponseV02
+	73,  // 285: iso20022.caaa.Document01000103.message:type_name -> iso20022.caaa.AcceptorReconciliationResponseV03
+	74,  // 286: iso20022.caaa.Document01000104.message:type_name -> iso20022.caaa.AcceptorReconciliationResponseV04
+	75,  // 287: iso20022.caaa.Document01000105.message:type_name -> iso20022.caaa.AcceptorReconciliationResponseV05
+	15,  // 288: iso20022.caaa.Document01100101.message:type_name -> iso20022.caaa.AcceptorBatchTransferV01
+	16,  // 289: iso20022.caaa.Document01100102.message:type_name -> iso20022.caaa.AcceptorBatchTransferV02
+	17,  // 290: iso20022.caaa.Document01100103.message:type_name -> iso20022.caaa.AcceptorBatchTransferV03
+	18,  // 291: iso20022.caaa.Document01100104.message:type_name -> iso20022.caaa.AcceptorBatchTransferV04
+	19,  // 292: iso20022.caaa.Document01100105.message:type_name -> iso20022.caaa.AcceptorBatchTransferV05
+	10,  // 293: iso20022.caaa.Document01200101.message:type_name -> iso20022.caaa.AcceptorBatchTransferResponseV01
+	11,  // 294: iso20022.caaa.Document01200102.message:type_name -> iso20022.caaa.AcceptorBatchTransferResponseV02
+	12,  // 295: iso20022.caaa.Document01200103.message:type_name -> iso20022.caaa.AcceptorBatchTransferResponseV03
+	13,  // 296: iso20022.caaa.Document01200104.message:type_name -> iso20022.caaa.AcceptorBatchTransferResponseV04
+	14,  // 297: iso20022.caaa.Document01200105.message:type_name -> iso20022.caaa.AcceptorBatchTransferResponseV05
+	56,  // 298: iso20022.caaa.Document01300101.message:type_name -> iso20022.caaa.AcceptorDiagnosticRequestV01
+	57,  // 299: iso20022.caaa.Document01300102.message:type_name -> iso20022.caaa.AcceptorDiagnosticRequestV02
+	58,  // 300: iso20022.caaa.Document01300103.message:type_name -> iso20022.caaa.AcceptorDiagnosticRequestV03
+	59,  // 301: iso20022.caaa.Document01300104.message:type_name -> iso20022.caaa.AcceptorDiagnosticRequestV04
+	60,  // 302: iso20022.caaa.Document01300105.message:type_name -> iso20022.caaa.AcceptorDiagnosticRequestV05
+	61,  // 303: iso20022.caaa.Document01400101.message:type_name -> iso20022.caaa.AcceptorDiagnosticResponseV01
+	62,  // 304: iso20022.caaa.Document01400102.message:type_name -> iso20022.caaa.AcceptorDiagnosticResponseV02
+	63,  // 305: iso20022.caaa.Document01400103.message:type_name -> iso20022.caaa.AcceptorDiagnosticResponseV03
+	64,  // 306: iso20022.caaa.Document01400104.message:type_name -> iso20022.caaa.AcceptorDiagnosticResponseV04
+	65,  // 307: iso20022.caaa.Document01400105.message:type_name -> iso20022.caaa.AcceptorDiagnosticResponseV05
+	76,  // 308: iso20022.caaa.Document01500101.message:type_name -> iso20022.caaa.AcceptorRejectionV01
+	77,  // 309: iso20022.caaa.Document01500102.message:type_name -> iso20022.caaa.AcceptorRejectionV02
+	78,  // 310: iso20022.caaa.Document01500103.message:type_name -> iso20022.caaa.AcceptorRejectionV03
+	79,  // 311: iso20022.caaa.Document01500104.message:type_name -> iso20022.caaa.AcceptorRejectionV04
+	80,  // 312: iso20022.caaa.Document01500105.message:type_name -> iso20022.caaa.AcceptorRejectionV05
+	50,  // 313: iso20022.caaa.Document01600101.message:type_name -> iso20022.caaa.AcceptorCurrencyConversionRequestV01
+	51,  // 314: iso20022.caaa.Document01600102.message:type_name -> iso20022.caaa.AcceptorCurrencyConversionRequestV02
+	52,  // 315: iso20022.caaa.Document01600103.message:type_name -> iso20022.caaa.AcceptorCurrencyConversionRequestV03
+	53,  // 316: iso20022.caaa.Document01700101.message:type_name -> iso20022.caaa.AcceptorCurrencyConversionResponseV01
+	54,  // 317: iso20022.caaa.Document01700102.message:type_name -> iso20022.caaa.AcceptorCurrencyConversionResponseV02
+	55,  // 318: iso20022.caaa.Document01700103.message:type_name -> iso20022.caaa.AcceptorCurrencyConversionResponseV03
+	319, // [319:319] is the sub-list for method output_type
+	319, // [319:319] is the sub-list for method input_type
+	319, // [319:319] is the sub-list for extension type_name
+	319, // [319:319] is the sub-list for extension extendee
+	0,   // [0:319] is the sub-list for field type_name
+}
+
+func init() { file_iso20022_caaa_proto_init() }
+func file_iso20022_caaa_proto_init() {
+	if File_iso20022_caaa_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_iso20022_caaa_proto_rawDesc), len(file_iso20022_caaa_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   162,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_iso20022_caaa_proto_goTypes,
+		DependencyIndexes: file_iso20022_caaa_proto_depIdxs,
+		MessageInfos:      file_iso20022_caaa_proto_msgTypes,
+	}.Build()
+	File_iso20022_caaa_proto = out.File
+	file_iso20022_caaa_proto_goTypes = nil
+	file_iso20022_caaa_proto_depIdxs = nil
+}
@@ -0,0 +1,3087 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: iso20022/caam.proto
+
+package caampb
+
+import (
+	modelpb "github.com/yudaprama/iso20022/proto/iso20022/modelpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+// The ATMDeviceControl message is sent by a maintenance host to an ATM in response to an ATMDeviceReport message. The message contains a sequence of maintenance commands the ATM must perform.
+type ATMDeviceControlV01 struct {
+	state                     protoimpl.MessageState            `protogen:"open.v1"`
+	Header                    *modelpb.Header20                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmDeviceControl *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_device_control,json=protectedAtmDeviceControl,proto3" json:"protected_atm_device_control,omitempty"`
+	AtmDeviceControl          *modelpb.ATMDeviceControl1        `protobuf:"bytes,3,opt,name=atm_device_control,json=atmDeviceControl,proto3" json:"atm_device_control,omitempty"`
+	SecurityTrailer           *modelpb.ContentInformationType13 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *ATMDeviceControlV01) Reset() {
+	*x = ATMDeviceControlV01{}
+	mi := &file_iso20022_caam_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMDeviceControlV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMDeviceControlV01) ProtoMessage() {}
+
+func (x *ATMDeviceControlV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMDeviceControlV01.ProtoReflect.Descriptor instead.
+func (*ATMDeviceControlV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ATMDeviceControlV01) GetHeader() *modelpb.Header20 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMDeviceControlV01) GetProtectedAtmDeviceControl() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmDeviceControl
+	}
+	return nil
+}
+
+func (x *ATMDeviceControlV01) GetAtmDeviceControl() *modelpb.ATMDeviceControl1 {
+	if x != nil {
+		return x.AtmDeviceControl
+	}
+	return nil
+}
+
+func (x *ATMDeviceControlV01) GetSecurityTrailer() *modelpb.ContentInformationType13 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMDeviceControl message is sent by a maintenance host to an ATM in response to an ATMDeviceReport message. The message contains a sequence of maintenance commands the ATM must perform.
+type ATMDeviceControlV02 struct {
+	state                     protoimpl.MessageState            `protogen:"open.v1"`
+	Header                    *modelpb.Header31                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmDeviceControl *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_device_control,json=protectedAtmDeviceControl,proto3" json:"protected_atm_device_control,omitempty"`
+	AtmDeviceControl          *modelpb.ATMDeviceControl1        `protobuf:"bytes,3,opt,name=atm_device_control,json=atmDeviceControl,proto3" json:"atm_device_control,omitempty"`
+	SecurityTrailer           *modelpb.ContentInformationType13 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *ATMDeviceControlV02) Reset() {
+	*x = ATMDeviceControlV02{}
+	mi := &file_iso20022_caam_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMDeviceControlV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMDeviceControlV02) ProtoMessage() {}
+
+func (x *ATMDeviceControlV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMDeviceControlV02.ProtoReflect.Descriptor instead.
+func (*ATMDeviceControlV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ATMDeviceControlV02) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMDeviceControlV02) GetProtectedAtmDeviceControl() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmDeviceControl
+	}
+	return nil
+}
+
+func (x *ATMDeviceControlV02) GetAtmDeviceControl() *modelpb.ATMDeviceControl1 {
+	if x != nil {
+		return x.AtmDeviceControl
+	}
+	return nil
+}
+
+func (x *ATMDeviceControlV02) GetSecurityTrailer() *modelpb.ContentInformationType13 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMDeviceReport message is sent to an acquirer by an ATM, or forwarded by an agent, to report:
+// - The result of maintenance commands performed by the ATM,
+// - The components of the ATM,
+// - The status of the ATM components
+type ATMDeviceReportV01 struct {
+	state                    protoimpl.MessageState            `protogen:"open.v1"`
+	Header                   *modelpb.Header20                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmDeviceReport *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_device_report,json=protectedAtmDeviceReport,proto3" json:"protected_atm_device_report,omitempty"`
+	AtmDeviceReport          *modelpb.ATMDeviceReport1         `protobuf:"bytes,3,opt,name=atm_device_report,json=atmDeviceReport,proto3" json:"atm_device_report,omitempty"`
+	SecurityTrailer          *modelpb.ContentInformationType13 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ATMDeviceReportV01) Reset() {
+	*x = ATMDeviceReportV01{}
+	mi := &file_iso20022_caam_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMDeviceReportV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMDeviceReportV01) ProtoMessage() {}
+
+func (x *ATMDeviceReportV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMDeviceReportV01.ProtoReflect.Descriptor instead.
+func (*ATMDeviceReportV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ATMDeviceReportV01) GetHeader() *modelpb.Header20 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMDeviceReportV01) GetProtectedAtmDeviceReport() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmDeviceReport
+	}
+	return nil
+}
+
+func (x *ATMDeviceReportV01) GetAtmDeviceReport() *modelpb.ATMDeviceReport1 {
+	if x != nil {
+		return x.AtmDeviceReport
+	}
+	return nil
+}
+
+func (x *ATMDeviceReportV01) GetSecurityTrailer() *modelpb.ContentInformationType13 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMDeviceReport message is sent to an acquirer by an ATM, or forwarded by an agent, to report:
+// - The result of maintenance commands performed by the ATM,
+// - The components of the ATM,
+// - The status of the ATM components
+type ATMDeviceReportV02 struct {
+	state                    protoimpl.MessageState            `protogen:"open.v1"`
+	Header                   *modelpb.Header31                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmDeviceReport *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_device_report,json=protectedAtmDeviceReport,proto3" json:"protected_atm_device_report,omitempty"`
+	AtmDeviceReport          *modelpb.ATMDeviceReport1         `protobuf:"bytes,3,opt,name=atm_device_report,json=atmDeviceReport,proto3" json:"atm_device_report,omitempty"`
+	SecurityTrailer          *modelpb.ContentInformationType13 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ATMDeviceReportV02) Reset() {
+	*x = ATMDeviceReportV02{}
+	mi := &file_iso20022_caam_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMDeviceReportV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMDeviceReportV02) ProtoMessage() {}
+
+func (x *ATMDeviceReportV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMDeviceReportV02.ProtoReflect.Descriptor instead.
+func (*ATMDeviceReportV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ATMDeviceReportV02) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMDeviceReportV02) GetProtectedAtmDeviceReport() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmDeviceReport
+	}
+	return nil
+}
+
+func (x *ATMDeviceReportV02) GetAtmDeviceReport() *modelpb.ATMDeviceReport1 {
+	if x != nil {
+		return x.AtmDeviceReport
+	}
+	return nil
+}
+
+func (x *ATMDeviceReportV02) GetSecurityTrailer() *modelpb.ContentInformationType13 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMDiagnosticRequest message is sent from an ATM to an acquirer to verify the availability of the acquirer. The acquirer will also validate that this ATM is a valid ATM for its particular network.
+type ATMDiagnosticRequestV01 struct {
+	state                         protoimpl.MessageState            `protogen:"open.v1"`
+	Header                        *modelpb.Header20                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmDiagnosticRequest *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_diagnostic_request,json=protectedAtmDiagnosticRequest,proto3" json:"protected_atm_diagnostic_request,omitempty"`
+	AtmDiagnosticRequest          *modelpb.ATMDiagnosticRequest1    `protobuf:"bytes,3,opt,name=atm_diagnostic_request,json=atmDiagnosticRequest,proto3" json:"atm_diagnostic_request,omitempty"`
+	SecurityTrailer               *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *ATMDiagnosticRequestV01) Reset() {
+	*x = ATMDiagnosticRequestV01{}
+	mi := &file_iso20022_caam_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMDiagnosticRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMDiagnosticRequestV01) ProtoMessage() {}
+
+func (x *ATMDiagnosticRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMDiagnosticRequestV01.ProtoReflect.Descriptor instead.
+func (*ATMDiagnosticRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ATMDiagnosticRequestV01) GetHeader() *modelpb.Header20 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMDiagnosticRequestV01) GetProtectedAtmDiagnosticRequest() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmDiagnosticRequest
+	}
+	return nil
+}
+
+func (x *ATMDiagnosticRequestV01) GetAtmDiagnosticRequest() *modelpb.ATMDiagnosticRequest1 {
+	if x != nil {
+		return x.AtmDiagnosticRequest
+	}
+	return nil
+}
+
+func (x *ATMDiagnosticRequestV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMDiagnosticRequest message is sent from an ATM to an acquirer to verify the availability of the acquirer. The acquirer will also validate that this ATM is a valid ATM for its particular network.
+type ATMDiagnosticRequestV02 struct {
+	state                         protoimpl.MessageState            `protogen:"open.v1"`
+	Header                        *modelpb.Header31                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmDiagnosticRequest *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_diagnostic_request,json=protectedAtmDiagnosticRequest,proto3" json:"protected_atm_diagnostic_request,omitempty"`
+	AtmDiagnosticRequest          *modelpb.ATMDiagnosticRequest2    `protobuf:"bytes,3,opt,name=atm_diagnostic_request,json=atmDiagnosticRequest,proto3" json:"atm_diagnostic_request,omitempty"`
+	SecurityTrailer               *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *ATMDiagnosticRequestV02) Reset() {
+	*x = ATMDiagnosticRequestV02{}
+	mi := &file_iso20022_caam_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMDiagnosticRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMDiagnosticRequestV02) ProtoMessage() {}
+
+func (x *ATMDiagnosticRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMDiagnosticRequestV02.ProtoReflect.Descriptor instead.
+func (*ATMDiagnosticRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ATMDiagnosticRequestV02) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMDiagnosticRequestV02) GetProtectedAtmDiagnosticRequest() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmDiagnosticRequest
+	}
+	return nil
+}
+
+func (x *ATMDiagnosticRequestV02) GetAtmDiagnosticRequest() *modelpb.ATMDiagnosticRequest2 {
+	if x != nil {
+		return x.AtmDiagnosticRequest
+	}
+	return nil
+}
+
+func (x *ATMDiagnosticRequestV02) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMDiagnosticResponse message is sent by an acquirer to an ATM in response to an ATMDiagnosticRequest message ensuring the availability and the validity of the parameters.
+type ATMDiagnosticResponseV01 struct {
+	state                          protoimpl.MessageState            `protogen:"open.v1"`
+	Header                         *modelpb.Header20                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmDiagnosticResponse *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_diagnostic_response,json=protectedAtmDiagnosticResponse,proto3" json:"protected_atm_diagnostic_response,omitempty"`
+	AtmDiagnosticResponse          *modelpb.ATMDiagnosticResponse1   `protobuf:"bytes,3,opt,name=atm_diagnostic_response,json=atmDiagnosticResponse,proto3" json:"atm_diagnostic_response,omitempty"`
+	SecurityTrailer                *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *ATMDiagnosticResponseV01) Reset() {
+	*x = ATMDiagnosticResponseV01{}
+	mi := &file_iso20022_caam_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMDiagnosticResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMDiagnosticResponseV01) ProtoMessage() {}
+
+func (x *ATMDiagnosticResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMDiagnosticResponseV01.ProtoReflect.Descriptor instead.
+func (*ATMDiagnosticResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ATMDiagnosticResponseV01) GetHeader() *modelpb.Header20 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMDiagnosticResponseV01) GetProtectedAtmDiagnosticResponse() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmDiagnosticResponse
+	}
+	return nil
+}
+
+func (x *ATMDiagnosticResponseV01) GetAtmDiagnosticResponse() *modelpb.ATMDiagnosticResponse1 {
+	if x != nil {
+		return x.AtmDiagnosticResponse
+	}
+	return nil
+}
+
+func (x *ATMDiagnosticResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMDiagnosticResponse message is sent by an acquirer to an ATM in response to an ATMDiagnosticRequest message ensuring the availability and the validity of the parameters.
+type ATMDiagnosticResponseV02 struct {
+	state                          protoimpl.MessageState            `protogen:"open.v1"`
+	Header                         *modelpb.Header31                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmDiagnosticResponse *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_diagnostic_response,json=protectedAtmDiagnosticResponse,proto3" json:"protected_atm_diagnostic_response,omitempty"`
+	AtmDiagnosticResponse          *modelpb.ATMDiagnosticResponse2   `protobuf:"bytes,3,opt,name=atm_diagnostic_response,json=atmDiagnosticResponse,proto3" json:"atm_diagnostic_response,omitempty"`
+	SecurityTrailer                *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *ATMDiagnosticResponseV02) Reset() {
+	*x = ATMDiagnosticResponseV02{}
+	mi := &file_iso20022_caam_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMDiagnosticResponseV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMDiagnosticResponseV02) ProtoMessage() {}
+
+func (x *ATMDiagnosticResponseV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMDiagnosticResponseV02.ProtoReflect.Descriptor instead.
+func (*ATMDiagnosticResponseV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ATMDiagnosticResponseV02) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMDiagnosticResponseV02) GetProtectedAtmDiagnosticResponse() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmDiagnosticResponse
+	}
+	return nil
+}
+
+func (x *ATMDiagnosticResponseV02) GetAtmDiagnosticResponse() *modelpb.ATMDiagnosticResponse2 {
+	if x != nil {
+		return x.AtmDiagnosticResponse
+	}
+	return nil
+}
+
+func (x *ATMDiagnosticResponseV02) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMExceptionAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMExceptionAdvice message.
+type ATMExceptionAcknowledgementV01 struct {
+	state                                protoimpl.MessageState                `protogen:"open.v1"`
+	Header                               *modelpb.Header32                     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmExceptionAcknowledgement *modelpb.ContentInformationType10     `protobuf:"bytes,2,opt,name=protected_atm_exception_acknowledgement,json=protectedAtmExceptionAcknowledgement,proto3" json:"protected_atm_exception_acknowledgement,omitempty"`
+	AtmExceptionAcknowledgement          *modelpb.ATMExceptionAcknowledgement1 `protobuf:"bytes,3,opt,name=atm_exception_acknowledgement,json=atmExceptionAcknowledgement,proto3" json:"atm_exception_acknowledgement,omitempty"`
+	SecurityTrailer                      *modelpb.ContentInformationType15     `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                        protoimpl.UnknownFields
+	sizeCache                            protoimpl.SizeCache
+}
+
+func (x *ATMExceptionAcknowledgementV01) Reset() {
+	*x = ATMExceptionAcknowledgementV01{}
+	mi := &file_iso20022_caam_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMExceptionAcknowledgementV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMExceptionAcknowledgementV01) ProtoMessage() {}
+
+func (x *ATMExceptionAcknowledgementV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMExceptionAcknowledgementV01.ProtoReflect.Descriptor instead.
+func (*ATMExceptionAcknowledgementV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ATMExceptionAcknowledgementV01) GetHeader() *modelpb.Header32 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMExceptionAcknowledgementV01) GetProtectedAtmExceptionAcknowledgement() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmExceptionAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMExceptionAcknowledgementV01) GetAtmExceptionAcknowledgement() *modelpb.ATMExceptionAcknowledgement1 {
+	if x != nil {
+		return x.AtmExceptionAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMExceptionAcknowledgementV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMExceptionAdvice message is sent by an ATM to an acquirer or its agent to inform of that an exception occurred outside a service.
+type ATMExceptionAdviceV01 struct {
+	state                       protoimpl.MessageState            `protogen:"open.v1"`
+	Header                      *modelpb.Header32                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmExceptionAdvice *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_exception_advice,json=protectedAtmExceptionAdvice,proto3" json:"protected_atm_exception_advice,omitempty"`
+	AtmExceptionAdvice          *modelpb.ATMExceptionAdvice1      `protobuf:"bytes,3,opt,name=atm_exception_advice,json=atmExceptionAdvice,proto3" json:"atm_exception_advice,omitempty"`
+	SecurityTrailer             *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ATMExceptionAdviceV01) Reset() {
+	*x = ATMExceptionAdviceV01{}
+	mi := &file_iso20022_caam_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMExceptionAdviceV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMExceptionAdviceV01) ProtoMessage() {}
+
+func (x *ATMExceptionAdviceV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMExceptionAdviceV01.ProtoReflect.Descriptor instead.
+func (*ATMExceptionAdviceV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ATMExceptionAdviceV01) GetHeader() *modelpb.Header32 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMExceptionAdviceV01) GetProtectedAtmExceptionAdvice() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmExceptionAdvice
+	}
+	return nil
+}
+
+func (x *ATMExceptionAdviceV01) GetAtmExceptionAdvice() *modelpb.ATMExceptionAdvice1 {
+	if x != nil {
+		return x.AtmExceptionAdvice
+	}
+	return nil
+}
+
+func (x *ATMExceptionAdviceV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMKeyDownloadRequest message is sent by an ATM to an ATM manager to initiate the download of one or several cryptographic keys.
+type ATMKeyDownloadRequestV01 struct {
+	state                          protoimpl.MessageState            `protogen:"open.v1"`
+	Header                         *modelpb.Header20                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmKeyDownloadRequest *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_key_download_request,json=protectedAtmKeyDownloadRequest,proto3" json:"protected_atm_key_download_request,omitempty"`
+	AtmKeyDownloadRequest          *modelpb.ATMKeyDownloadRequest1   `protobuf:"bytes,3,opt,name=atm_key_download_request,json=atmKeyDownloadRequest,proto3" json:"atm_key_download_request,omitempty"`
+	SecurityTrailer                *modelpb.ContentInformationType13 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *ATMKeyDownloadRequestV01) Reset() {
+	*x = ATMKeyDownloadRequestV01{}
+	mi := &file_iso20022_caam_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMKeyDownloadRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMKeyDownloadRequestV01) ProtoMessage() {}
+
+func (x *ATMKeyDownloadRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMKeyDownloadRequestV01.ProtoReflect.Descriptor instead.
+func (*ATMKeyDownloadRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ATMKeyDownloadRequestV01) GetHeader() *modelpb.Header20 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMKeyDownloadRequestV01) GetProtectedAtmKeyDownloadRequest() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmKeyDownloadRequest
+	}
+	return nil
+}
+
+func (x *ATMKeyDownloadRequestV01) GetAtmKeyDownloadRequest() *modelpb.ATMKeyDownloadRequest1 {
+	if x != nil {
+		return x.AtmKeyDownloadRequest
+	}
+	return nil
+}
+
+func (x *ATMKeyDownloadRequestV01) GetSecurityTrailer() *modelpb.ContentInformationType13 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMKeyDownloadRequest message is sent by an ATM to an ATM manager to initiate the download of one or several cryptographic keys.
+type ATMKeyDownloadRequestV02 struct {
+	state                          protoimpl.MessageState            `protogen:"open.v1"`
+	Header                         *modelpb.Header31                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmKeyDownloadRequest *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_key_download_request,json=protectedAtmKeyDownloadRequest,proto3" json:"protected_atm_key_download_request,omitempty"`
+	AtmKeyDownloadRequest          *modelpb.ATMKeyDownloadRequest2   `protobuf:"bytes,3,opt,name=atm_key_download_request,json=atmKeyDownloadRequest,proto3" json:"atm_key_download_request,omitempty"`
+	SecurityTrailer                *modelpb.ContentInformationType13 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *ATMKeyDownloadRequestV02) Reset() {
+	*x = ATMKeyDownloadRequestV02{}
+	mi := &file_iso20022_caam_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMKeyDownloadRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMKeyDownloadRequestV02) ProtoMessage() {}
+
+func (x *ATMKeyDownloadRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMKeyDownloadRequestV02.ProtoReflect.Descriptor instead.
+func (*ATMKeyDownloadRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ATMKeyDownloadRequestV02) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMKeyDownloadRequestV02) GetProtectedAtmKeyDownloadRequest() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmKeyDownloadRequest
+	}
+	return nil
+}
+
+func (x *ATMKeyDownloadRequestV02) GetAtmKeyDownloadRequest() *modelpb.ATMKeyDownloadRequest2 {
+	if x != nil {
+		return x.AtmKeyDownloadRequest
+	}
+	return nil
+}
+
+func (x *ATMKeyDownloadRequestV02) GetSecurityTrailer() *modelpb.ContentInformationType13 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMKeyDownloadResponse message is sent from an acquirer to an ATM in response to an ATMKeyDownloadRequest message, to download of one or several cryptographic keys.
+type ATMKeyDownloadResponseV01 struct {
+	state                           protoimpl.MessageState            `protogen:"open.v1"`
+	Header                          *modelpb.Header20                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmKeyDownloadResponse *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_key_download_response,json=protectedAtmKeyDownloadResponse,proto3" json:"protected_atm_key_download_response,omitempty"`
+	AtmKeyDownloadResponse          *modelpb.ATMKeyDownloadResponse1  `protobuf:"bytes,3,opt,name=atm_key_download_response,json=atmKeyDownloadResponse,proto3" json:"atm_key_download_response,omitempty"`
+	SecurityTrailer                 *modelpb.ContentInformationType13 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
+}
+
+func (x *ATMKeyDownloadResponseV01) Reset() {
+	*x = ATMKeyDownloadResponseV01{}
+	mi := &file_iso20022_caam_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMKeyDownloadResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMKeyDownloadResponseV01) ProtoMessage() {}
+
+func (x *ATMKeyDownloadResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMKeyDownloadResponseV01.ProtoReflect.Descriptor instead.
+func (*ATMKeyDownloadResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ATMKeyDownloadResponseV01) GetHeader() *modelpb.Header20 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMKeyDownloadResponseV01) GetProtectedAtmKeyDownloadResponse() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmKeyDownloadResponse
+	}
+	return nil
+}
+
+func (x *ATMKeyDownloadResponseV01) GetAtmKeyDownloadResponse() *modelpb.ATMKeyDownloadResponse1 {
+	if x != nil {
+		return x.AtmKeyDownloadResponse
+	}
+	return nil
+}
+
+func (x *ATMKeyDownloadResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType13 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMKeyDownloadResponse message is sent from an acquirer to an ATM in response to an ATMKeyDownloadRequest message, to download of one or several cryptographic keys.
+type ATMKeyDownloadResponseV02 struct {
+	state                           protoimpl.MessageState            `protogen:"open.v1"`
+	Header                          *modelpb.Header31                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmKeyDownloadResponse *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_key_download_response,json=protectedAtmKeyDownloadResponse,proto3" json:"protected_atm_key_download_response,omitempty"`
+	AtmKeyDownloadResponse          *modelpb.ATMKeyDownloadResponse2  `protobuf:"bytes,3,opt,name=atm_key_download_response,json=atmKeyDownloadResponse,proto3" json:"atm_key_download_response,omitempty"`
+	SecurityTrailer                 *modelpb.ContentInformationType13 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
+}
+
+func (x *ATMKeyDownloadResponseV02) Reset() {
+	*x = ATMKeyDownloadResponseV02{}
+	mi := &file_iso20022_caam_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMKeyDownloadResponseV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMKeyDownloadResponseV02) ProtoMessage() {}
+
+func (x *ATMKeyDownloadResponseV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMKeyDownloadResponseV02.ProtoReflect.Descriptor instead.
+func (*ATMKeyDownloadResponseV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ATMKeyDownloadResponseV02) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMKeyDownloadResponseV02) GetProtectedAtmKeyDownloadResponse() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmKeyDownloadResponse
+	}
+	return nil
+}
+
+func (x *ATMKeyDownloadResponseV02) GetAtmKeyDownloadResponse() *modelpb.ATMKeyDownloadResponse2 {
+	if x != nil {
+		return x.AtmKeyDownloadResponse
+	}
+	return nil
+}
+
+func (x *ATMKeyDownloadResponseV02) GetSecurityTrailer() *modelpb.ContentInformationType13 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMReconciliationAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMReconciliationAdvice message.
+type ATMReconciliationAcknowledgementV01 struct {
+	state                                     protoimpl.MessageState                     `protogen:"open.v1"`
+	Header                                    *modelpb.Header21                          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmReconciliationAcknowledgement *modelpb.ContentInformationType10          `protobuf:"bytes,2,opt,name=protected_atm_reconciliation_acknowledgement,json=protectedAtmReconciliationAcknowledgement,proto3" json:"protected_atm_reconciliation_acknowledgement,omitempty"`
+	AtmReconciliationAcknowledgement          *modelpb.ATMReconciliationAcknowledgement1 `protobuf:"bytes,3,opt,name=atm_reconciliation_acknowledgement,json=atmReconciliationAcknowledgement,proto3" json:"atm_reconciliation_acknowledgement,omitempty"`
+	SecurityTrailer                           *modelpb.ContentInformationType15          `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                             protoimpl.UnknownFields
+	sizeCache                                 protoimpl.SizeCache
+}
+
+func (x *ATMReconciliationAcknowledgementV01) Reset() {
+	*x = ATMReconciliationAcknowledgementV01{}
+	mi := &file_iso20022_caam_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMReconciliationAcknowledgementV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMReconciliationAcknowledgementV01) ProtoMessage() {}
+
+func (x *ATMReconciliationAcknowledgementV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMReconciliationAcknowledgementV01.ProtoReflect.Descriptor instead.
+func (*ATMReconciliationAcknowledgementV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ATMReconciliationAcknowledgementV01) GetHeader() *modelpb.Header21 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMReconciliationAcknowledgementV01) GetProtectedAtmReconciliationAcknowledgement() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmReconciliationAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMReconciliationAcknowledgementV01) GetAtmReconciliationAcknowledgement() *modelpb.ATMReconciliationAcknowledgement1 {
+	if x != nil {
+		return x.AtmReconciliationAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMReconciliationAcknowledgementV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMReconciliationAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMReconciliationAdvice message.
+type ATMReconciliationAcknowledgementV02 struct {
+	state                                     protoimpl.MessageState                     `protogen:"open.v1"`
+	Header                                    *modelpb.Header32                          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmReconciliationAcknowledgement *modelpb.ContentInformationType10          `protobuf:"bytes,2,opt,name=protected_atm_reconciliation_acknowledgement,json=protectedAtmReconciliationAcknowledgement,proto3" json:"protected_atm_reconciliation_acknowledgement,omitempty"`
+	AtmReconciliationAcknowledgement          *modelpb.ATMReconciliationAcknowledgement2 `protobuf:"bytes,3,opt,name=atm_reconciliation_acknowledgement,json=atmReconciliationAcknowledgement,proto3" json:"atm_reconciliation_acknowledgement,omitempty"`
+	SecurityTrailer                           *modelpb.ContentInformationType15          `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                             protoimpl.UnknownFields
+	sizeCache                                 protoimpl.SizeCache
+}
+
+func (x *ATMReconciliationAcknowledgementV02) Reset() {
+	*x = ATMReconciliationAcknowledgementV02{}
+	mi := &file_iso20022_caam_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMReconciliationAcknowledgementV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMReconciliationAcknowledgementV02) ProtoMessage() {}
+
+func (x *ATMReconciliationAcknowledgementV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMReconciliationAcknowledgementV02.ProtoReflect.Descriptor instead.
+func (*ATMReconciliationAcknowledgementV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ATMReconciliationAcknowledgementV02) GetHeader() *modelpb.Header32 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMReconciliationAcknowledgementV02) GetProtectedAtmReconciliationAcknowledgement() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmReconciliationAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMReconciliationAcknowledgementV02) GetAtmReconciliationAcknowledgement() *modelpb.ATMReconciliationAcknowledgement2 {
+	if x != nil {
+		return x.AtmReconciliationAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMReconciliationAcknowledgementV02) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMReconciliationAdvice message is sent by an ATM to an acquirer or its agent to send all the counters of the ATM. It can be sent by an operator function or as a response of a command sent by an agent or a server.
+type ATMReconciliationAdviceV01 struct {
+	state                            protoimpl.MessageState            `protogen:"open.v1"`
+	Header                           *modelpb.Header21                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmReconciliationAdvice *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_reconciliation_advice,json=protectedAtmReconciliationAdvice,proto3" json:"protected_atm_reconciliation_advice,omitempty"`
+	AtmReconciliationAdvice          *modelpb.ATMReconciliationAdvice1 `protobuf:"bytes,3,opt,name=atm_reconciliation_advice,json=atmReconciliationAdvice,proto3" json:"atm_reconciliation_advice,omitempty"`
+	SecurityTrailer                  *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *ATMReconciliationAdviceV01) Reset() {
+	*x = ATMReconciliationAdviceV01{}
+	mi := &file_iso20022_caam_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMReconciliationAdviceV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMReconciliationAdviceV01) ProtoMessage() {}
+
+func (x *ATMReconciliationAdviceV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMReconciliationAdviceV01.ProtoReflect.Descriptor instead.
+func (*ATMReconciliationAdviceV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ATMReconciliationAdviceV01) GetHeader() *modelpb.Header21 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMReconciliationAdviceV01) GetProtectedAtmReconciliationAdvice() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmReconciliationAdvice
+	}
+	return nil
+}
+
+func (x *ATMReconciliationAdviceV01) GetAtmReconciliationAdvice() *modelpb.ATMReconciliationAdvice1 {
+	if x != nil {
+		return x.AtmReconciliationAdvice
+	}
+	return nil
+}
+
+func (x *ATMReconciliationAdviceV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMReconciliationAdvice message is sent by an ATM to an acquirer or its agent to send all the counters of the ATM. It can be sent by an operator function or as a response of a command sent by an agent or a server.
+type ATMReconciliationAdviceV02 struct {
+	state                            protoimpl.MessageState            `protogen:"open.v1"`
+	Header                           *modelpb.Header32                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmReconciliationAdvice *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_reconciliation_advice,json=protectedAtmReconciliationAdvice,proto3" json:"protected_atm_reconciliation_advice,omitempty"`
+	AtmReconciliationAdvice          *modelpb.ATMReconciliationAdvice2 `protobuf:"bytes,3,opt,name=atm_reconciliation_advice,json=atmReconciliationAdvice,proto3" json:"atm_reconciliation_advice,omitempty"`
+	SecurityTrailer                  *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *ATMReconciliationAdviceV02) Reset() {
+	*x = ATMReconciliationAdviceV02{}
+	mi := &file_iso20022_caam_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMReconciliationAdviceV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMReconciliationAdviceV02) ProtoMessage() {}
+
+func (x *ATMReconciliationAdviceV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMReconciliationAdviceV02.ProtoReflect.Descriptor instead.
+func (*ATMReconciliationAdviceV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ATMReconciliationAdviceV02) GetHeader() *modelpb.Header32 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMReconciliationAdviceV02) GetProtectedAtmReconciliationAdvice() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmReconciliationAdvice
+	}
+	return nil
+}
+
+func (x *ATMReconciliationAdviceV02) GetAtmReconciliationAdvice() *modelpb.ATMReconciliationAdvice2 {
+	if x != nil {
+		return x.AtmReconciliationAdvice
+	}
+	return nil
+}
+
+func (x *ATMReconciliationAdviceV02) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+type Document00100101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ATMDeviceReportV01    `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100101) Reset() {
+	*x = Document00100101{}
+	mi := &file_iso20022_caam_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100101) ProtoMessage() {}
+
+func (x *Document00100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100101.ProtoReflect.Descriptor instead.
+func (*Document00100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Document00100101) GetMessage() *ATMDeviceReportV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00100102 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ATMDeviceReportV02    `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100102) Reset() {
+	*x = Document00100102{}
+	mi := &file_iso20022_caam_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100102) ProtoMessage() {}
+
+func (x *Document00100102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100102.ProtoReflect.Descriptor instead.
+func (*Document00100102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Document00100102) GetMessage() *ATMDeviceReportV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ATMDeviceControlV01   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200101) Reset() {
+	*x = Document00200101{}
+	mi := &file_iso20022_caam_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200101) ProtoMessage() {}
+
+func (x *Document00200101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200101.ProtoReflect.Descriptor instead.
+func (*Document00200101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Document00200101) GetMessage() *ATMDeviceControlV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200102 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ATMDeviceControlV02   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200102) Reset() {
+	*x = Document00200102{}
+	mi := &file_iso20022_caam_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200102) ProtoMessage() {}
+
+func (x *Document00200102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200102.ProtoReflect.Descriptor instead.
+func (*Document00200102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Document00200102) GetMessage() *ATMDeviceControlV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300101 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *ATMKeyDownloadRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300101) Reset() {
+	*x = Document00300101{}
+	mi := &file_iso20022_caam_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300101) ProtoMessage() {}
+
+func (x *Document00300101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300101.ProtoReflect.Descriptor instead.
+func (*Document00300101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Document00300101) GetMessage() *ATMKeyDownloadRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300102 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *ATMKeyDownloadRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300102) Reset() {
+	*x = Document00300102{}
+	mi := &file_iso20022_caam_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300102) ProtoMessage() {}
+
+func (x *Document00300102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300102.ProtoReflect.Descriptor instead.
+func (*Document00300102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Document00300102) GetMessage() *ATMKeyDownloadRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400101 struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Message       *ATMKeyDownloadResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400101) Reset() {
+	*x = Document00400101{}
+	mi := &file_iso20022_caam_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400101) ProtoMessage() {}
+
+func (x *Document00400101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400101.ProtoReflect.Descriptor instead.
+func (*Document00400101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Document00400101) GetMessage() *ATMKeyDownloadResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400102 struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Message       *ATMKeyDownloadResponseV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400102) Reset() {
+	*x = Document00400102{}
+	mi := &file_iso20022_caam_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400102) ProtoMessage() {}
+
+func (x *Document00400102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400102.ProtoReflect.Descriptor instead.
+func (*Document00400102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Document00400102) GetMessage() *ATMKeyDownloadResponseV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500101 struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Message       *ATMDiagnosticRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500101) Reset() {
+	*x = Document00500101{}
+	mi := &file_iso20022_caam_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500101) ProtoMessage() {}
+
+func (x *Document00500101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500101.ProtoReflect.Descriptor instead.
+func (*Document00500101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Document00500101) GetMessage() *ATMDiagnosticRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500102 struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Message       *ATMDiagnosticRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500102) Reset() {
+	*x = Document00500102{}
+	mi := &file_iso20022_caam_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500102) ProtoMessage() {}
+
+func (x *Document00500102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500102.ProtoReflect.Descriptor instead.
+func (*Document00500102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Document00500102) GetMessage() *ATMDiagnosticRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600101 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *ATMDiagnosticResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600101) Reset() {
+	*x = Document00600101{}
+	mi := &file_iso20022_caam_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600101) ProtoMessage() {}
+
+func (x *Document00600101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600101.ProtoReflect.Descriptor instead.
+func (*Document00600101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Document00600101) GetMessage() *ATMDiagnosticResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600102 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *ATMDiagnosticResponseV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600102) Reset() {
+	*x = Document00600102{}
+	mi := &file_iso20022_caam_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600102) ProtoMessage() {}
+
+func (x *Document00600102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600102.ProtoReflect.Descriptor instead.
+func (*Document00600102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Document00600102) GetMessage() *ATMDiagnosticResponseV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00700101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *HostToATMRequestV01   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00700101) Reset() {
+	*x = Document00700101{}
+	mi := &file_iso20022_caam_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00700101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00700101) ProtoMessage() {}
+
+func (x *Document00700101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00700101.ProtoReflect.Descriptor instead.
+func (*Document00700101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Document00700101) GetMessage() *HostToATMRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800101 struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Message       *HostToATMAcknowledgementV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800101) Reset() {
+	*x = Document00800101{}
+	mi := &file_iso20022_caam_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800101) ProtoMessage() {}
+
+func (x *Document00800101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800101.ProtoReflect.Descriptor instead.
+func (*Document00800101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Document00800101) GetMessage() *HostToATMAcknowledgementV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900101 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Message       *ATMReconciliationAdviceV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900101) Reset() {
+	*x = Document00900101{}
+	mi := &file_iso20022_caam_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900101) ProtoMessage() {}
+
+func (x *Document00900101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900101.ProtoReflect.Descriptor instead.
+func (*Document00900101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Document00900101) GetMessage() *ATMReconciliationAdviceV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900102 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Message       *ATMReconciliationAdviceV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900102) Reset() {
+	*x = Document00900102{}
+	mi := &file_iso20022_caam_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900102) ProtoMessage() {}
+
+func (x *Document00900102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900102.ProtoReflect.Descriptor instead.
+func (*Document00900102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Document00900102) GetMessage() *ATMReconciliationAdviceV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000101 struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Message       *ATMReconciliationAcknowledgementV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000101) Reset() {
+	*x = Document01000101{}
+	mi := &file_iso20022_caam_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000101) ProtoMessage() {}
+
+func (x *Document01000101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000101.ProtoReflect.Descriptor instead.
+func (*Document01000101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Document01000101) GetMessage() *ATMReconciliationAcknowledgementV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000102 struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Message       *ATMReconciliationAcknowledgementV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000102) Reset() {
+	*x = Document01000102{}
+	mi := &file_iso20022_caam_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000102) ProtoMessage() {}
+
+func (x *Document01000102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000102.ProtoReflect.Descriptor instead.
+func (*Document01000102) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Document01000102) GetMessage() *ATMReconciliationAcknowledgementV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01100101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ATMExceptionAdviceV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01100101) Reset() {
+	*x = Document01100101{}
+	mi := &file_iso20022_caam_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01100101) ProtoMessage() {}
+
+func (x *Document01100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01100101.ProtoReflect.Descriptor instead.
+func (*Document01100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Document01100101) GetMessage() *ATMExceptionAdviceV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01200101 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *ATMExceptionAcknowledgementV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01200101) Reset() {
+	*x = Document01200101{}
+	mi := &file_iso20022_caam_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01200101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01200101) ProtoMessage() {}
+
+func (x *Document01200101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01200101.ProtoReflect.Descriptor instead.
+func (*Document01200101) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Document01200101) GetMessage() *ATMExceptionAcknowledgementV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// The HostToATMAcknowledgement message is sent by an ATM to a host to acknowledge the receipt of a HostToATMRequest message.
+type HostToATMAcknowledgementV01 struct {
+	state                             protoimpl.MessageState             `protogen:"open.v1"`
+	Header                            *modelpb.Header20                  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedHostToAtmAcknowledgement *modelpb.ContentInformationType10  `protobuf:"bytes,2,opt,name=protected_host_to_atm_acknowledgement,json=protectedHostToAtmAcknowledgement,proto3" json:"protected_host_to_atm_acknowledgement,omitempty"`
+	HostToAtmAcknowledgement          *modelpb.HostToATMAcknowledgement1 `protobuf:"bytes,3,opt,name=host_to_atm_acknowledgement,json=hostToAtmAcknowledgement,proto3" json:"host_to_atm_acknowledgement,omitempty"`
+	SecurityTrailer                   *modelpb.ContentInformationType15  `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *HostToATMAcknowledgementV01) Reset() {
+	*x = HostToATMAcknowledgementV01{}
+	mi := &file_iso20022_caam_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostToATMAcknowledgementV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostToATMAcknowledgementV01) ProtoMessage() {}
+
+func (x *HostToATMAcknowledgementV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostToATMAcknowledgementV01.ProtoReflect.Descriptor instead.
+func (*HostToATMAcknowledgementV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *HostToATMAcknowledgementV01) GetHeader() *modelpb.Header20 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *HostToATMAcknowledgementV01) GetProtectedHostToAtmAcknowledgement() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedHostToAtmAcknowledgement
+	}
+	return nil
+}
+
+func (x *HostToATMAcknowledgementV01) GetHostToAtmAcknowledgement() *modelpb.HostToATMAcknowledgement1 {
+	if x != nil {
+		return x.HostToAtmAcknowledgement
+	}
+	return nil
+}
+
+func (x *HostToATMAcknowledgementV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The HostToATMRequest message is sent by a host to an ATM to request the ATM to contact a host by sending of a maintenance messages.
+type HostToATMRequestV01 struct {
+	state                     protoimpl.MessageState            `protogen:"open.v1"`
+	Header                    *modelpb.Header20                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedHostToAtmRequest *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_host_to_atm_request,json=protectedHostToAtmRequest,proto3" json:"protected_host_to_atm_request,omitempty"`
+	HostToAtmRequest          *modelpb.HostToATMRequest1        `protobuf:"bytes,3,opt,name=host_to_atm_request,json=hostToAtmRequest,proto3" json:"host_to_atm_request,omitempty"`
+	SecurityTrailer           *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *HostToATMRequestV01) Reset() {
+	*x = HostToATMRequestV01{}
+	mi := &file_iso20022_caam_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostToATMRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostToATMRequestV01) ProtoMessage() {}
+
+func (x *HostToATMRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_caam_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostToATMRequestV01.ProtoReflect.Descriptor instead.
+func (*HostToATMRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_caam_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *HostToATMRequestV01) GetHeader() *modelpb.Header20 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *HostToATMRequestV01) GetProtectedHostToAtmRequest() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedHostToAtmRequest
+	}
+	return nil
+}
+
+func (x *HostToATMRequestV01) GetHostToAtmRequest() *modelpb.HostToATMRequest1 {
+	if x != nil {
+		return x.HostToAtmRequest
+	}
+	return nil
+}
+
+func (x *HostToATMRequestV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+var File_iso20022_caam_proto protoreflect.FileDescriptor
+
+var file_iso20022_caam_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x63, 0x61, 0x61, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x63, 0x61, 0x61, 0x6d, 0x1a, 0x14, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x02, 0x0a, 0x13, 0x41,
+	0x54, 0x4d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x56,
+	0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x30, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x69, 0x0a, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x31, 0x30, 0x52, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x6d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12,
+	0x4f, 0x0a, 0x12, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x31, 0x52, 0x10,
+	0x61, 0x74, 0x6d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x31, 0x33, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xd8, 0x02, 0x0a, 0x13, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x56, 0x30, 0x32, 0x12, 0x30, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x69, 0x0a, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d,
+	0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52,
+	0x19, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x4f, 0x0a, 0x12, 0x61, 0x74,
+	0x6d, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x31, 0x52, 0x10, 0x61, 0x74, 0x6d, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x53, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x33, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x22, 0xd2, 0x02, 0x0a, 0x12, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32,
+	0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x67, 0x0a, 0x1b, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x4c, 0x0a, 0x11, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
+	0x54, 0x4d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x31, 0x52,
+	0x0f, 0x61, 0x74, 0x6d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x31, 0x33, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xd2, 0x02, 0x0a, 0x12, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x32, 0x12, 0x30, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x33, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x67,
+	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x18, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x4c, 0x0a, 0x11, 0x61, 0x74, 0x6d, 0x5f, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x31, 0x52, 0x0f, 0x61, 0x74, 0x6d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x33, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xf0, 0x02, 0x0a, 0x17, 0x41,
+	0x54, 0x4d, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x30,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x71, 0x0a, 0x20, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x1d, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x16, 0x61,
+	0x74, 0x6d, 0x5f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d,
+	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x31, 0x52, 0x14, 0x61, 0x74, 0x6d, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xf0, 0x02,
+	0x0a, 0x17, 0x41, 0x54, 0x4d, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x33, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x71, 0x0a, 0x20, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52,
+	0x1d, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x44, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5b,
+	0x0a, 0x16, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x54, 0x4d, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x32, 0x52, 0x14, 0x61, 0x74, 0x6d, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x22, 0xf6, 0x02, 0x0a, 0x18, 0x41, 0x54, 0x4d, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x73, 0x0a, 0x21, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d,
+	0x5f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x31, 0x30, 0x52, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x6d, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x17, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x15, 0x61,
+	0x74, 0x6d, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xf6, 0x02, 0x0a, 0x18, 0x41, 0x54,
+	0x4d, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x31,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x73, 0x0a, 0x21, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x1e, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a,
+	0x17, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x54, 0x4d, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x52, 0x15, 0x61, 0x74, 0x6d, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x22, 0x9a, 0x03, 0x0a, 0x1e, 0x41, 0x54, 0x4d, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x32, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x7f, 0x0a, 0x27, 0x70, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x31, 0x30, 0x52, 0x24, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d,
+	0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x70, 0x0a, 0x1d, 0x61, 0x74, 0x6d, 0x5f,
+	0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x54, 0x4d, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x1b, 0x61,
+	0x74, 0x6d, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22,
+	0xe4, 0x02, 0x0a, 0x15, 0x41, 0x54, 0x4d, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x33, 0x32, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6d, 0x0a, 0x1e, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x65, 0x78, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x1b, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x45, 0x78, 0x63, 0x65, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x61, 0x74,
+	0x6d, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x45, 0x78, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x31, 0x52, 0x12, 0x61,
+	0x74, 0x6d, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xf8, 0x02, 0x0a, 0x18, 0x41, 0x54, 0x4d, 0x4b, 0x65,
+	0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x30, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x74, 0x0a, 0x22, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x1e, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x18, 0x61,
+	0x74, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
+	0x54, 0x4d, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x31, 0x52, 0x15, 0x61, 0x74, 0x6d, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x33,
+	0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x22, 0xf8, 0x02, 0x0a, 0x18, 0x41, 0x54, 0x4d, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x30,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x74, 0x0a, 0x22, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x6d, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x18, 0x61, 0x74, 0x6d, 0x5f, 0x6b, 0x65,
+	0x79, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x4b, 0x65, 0x79,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32,
+	0x52, 0x15, 0x61, 0x74, 0x6d, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x33, 0x52, 0x0f, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xfe, 0x02, 0x0a,
+	0x19, 0x41, 0x54, 0x4d, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x32, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x76, 0x0a, 0x23,
+	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x6b, 0x65,
+	0x79, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x31, 0x30, 0x52, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x6d, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x19, 0x61, 0x74, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x4b, 0x65, 0x79, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31,
+	0x52, 0x16, 0x61, 0x74, 0x6d, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x33, 0x52, 0x0f, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xfe, 0x02,
+	0x0a, 0x19, 0x41, 0x54, 0x4d, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x33, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x76, 0x0a,
+	0x23, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x6b,
+	0x65, 0x79, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x31, 0x30, 0x52, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x6d, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x19, 0x61, 0x74, 0x6d, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x4b, 0x65, 0x79,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0x52, 0x16, 0x61, 0x74, 0x6d, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x33, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xb9,
+	0x03, 0x0a, 0x23, 0x41, 0x54, 0x4d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x31,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x89, 0x01, 0x0a, 0x2c, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x29, 0x70, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x7f, 0x0a, 0x22, 0x61, 0x74, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x31, 0x52, 0x20, 0x61, 0x74, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xb9, 0x03, 0x0a, 0x23, 0x41,
+	0x54, 0x4d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56,
+	0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x32, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x89, 0x01, 0x0a, 0x2c, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x29, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x7f, 0x0a, 0x22, 0x61, 0x74, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54,
+	0x4d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0x52,
+	0x20, 0x61, 0x74, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x82, 0x03, 0x0a, 0x1a, 0x41, 0x54, 0x4d, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x31, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x77, 0x0a, 0x23, 0x70, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x20,
+	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x64, 0x0a, 0x19, 0x61, 0x74, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x31, 0x52, 0x17, 0x61,
+	0x74, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x82, 0x03, 0x0a, 0x1a,
+	0x41, 0x54, 0x4d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x33, 0x32, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x77, 0x0a, 0x23,
+	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x31, 0x30, 0x52, 0x20, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x6d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x64, 0x0a, 0x19, 0x61, 0x74, 0x6d, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63,
+	0x65, 0x32, 0x52, 0x17, 0x61, 0x74, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x22, 0x4f, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x31, 0x30,
+	0x30, 0x31, 0x30, 0x31, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x61, 0x61, 0x6d, 0x2e, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x4f, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x31,
+	0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x63, 0x61, 0x61, 0x6d, 0x2e, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x50, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x32, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x6d, 0x2e, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x50, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x6d, 0x2e, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x55, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x6d, 0x2e, 0x41, 0x54, 0x4d, 0x4b,
+	0x65, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x55, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30,
+	0x32, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61,
+	0x61, 0x6d, 0x2e, 0x41, 0x54, 0x4d, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x56, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x30, 0x34, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x42, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x6d, 0x2e, 0x41, 0x54, 0x4d, 0x4b, 0x65, 0x79,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x56, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x34, 0x30, 0x30, 0x31, 0x30, 0x32,
+	0x12, 0x42, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61,
+	0x6d, 0x2e, 0x41, 0x54, 0x4d, 0x4b, 0x65, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x54, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x40, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x6d, 0x2e, 0x41, 0x54, 0x4d, 0x44, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30,
+	0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x54, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x40,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x6d, 0x2e,
+	0x41, 0x54, 0x4d, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x55, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x36, 0x30,
+	0x30, 0x31, 0x30, 0x31, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x61, 0x61, 0x6d, 0x2e, 0x41, 0x54, 0x4d, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x55, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x30, 0x30, 0x36, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x41, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x6d, 0x2e, 0x41, 0x54, 0x4d,
+	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x50,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x37, 0x30, 0x30, 0x31,
+	0x30, 0x31, 0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63,
+	0x61, 0x61, 0x6d, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x41, 0x54, 0x4d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x58, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x38, 0x30,
+	0x30, 0x31, 0x30, 0x31, 0x12, 0x44, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x61, 0x61, 0x6d, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x41, 0x54, 0x4d, 0x41,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30,
+	0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x39, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x43,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x6d, 0x2e,
+	0x41, 0x54, 0x4d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x30, 0x39, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x43, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x6d, 0x2e, 0x41, 0x54, 0x4d, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65,
+	0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x30, 0x30, 0x30, 0x31, 0x30, 0x31,
+	0x12, 0x4c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61,
+	0x6d, 0x2e, 0x41, 0x54, 0x4d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x30, 0x30, 0x30, 0x31,
+	0x30, 0x32, 0x12, 0x4c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63,
+	0x61, 0x61, 0x6d, 0x2e, 0x41, 0x54, 0x4d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x52, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x31, 0x30,
+	0x30, 0x31, 0x30, 0x31, 0x12, 0x3e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x61, 0x61, 0x6d, 0x2e, 0x41, 0x54, 0x4d, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x31, 0x32, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x61, 0x6d, 0x2e, 0x41, 0x54, 0x4d, 0x45, 0x78, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x8a, 0x03, 0x0a, 0x1b, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x41, 0x54, 0x4d, 0x41,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30,
+	0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x7a, 0x0a, 0x25, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x61, 0x63, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x21, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x41, 0x74, 0x6d,
+	0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x68, 0x0a, 0x1b, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x61,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x41, 0x54, 0x4d, 0x41,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52,
+	0x18, 0x68, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x41, 0x74, 0x6d, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xda,
+	0x02, 0x0a, 0x13, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x41, 0x54, 0x4d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x30,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6a, 0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x74,
+	0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x41, 0x74, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x13, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x5f,
+	0x61, 0x74, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x41, 0x54, 0x4d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x31, 0x52, 0x10, 0x68, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x41, 0x74, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x42, 0x35, 0x5a, 0x33, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x75, 0x64, 0x61, 0x70, 0x72,
+	0x61, 0x6d, 0x61, 0x2f, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x63, 0x61, 0x61, 0x6d,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_iso20022_caam_proto_rawDescOnce sync.Once
+	file_iso20022_caam_proto_rawDescData []byte
+)
+
+func file_iso20022_caam_proto_rawDescGZIP() []byte {
+	file_iso20022_caam_proto_rawDescOnce.Do(func() {
+		file_iso20022_caam_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_iso20022_caam_proto_rawDesc), len(file_iso20022_caam_proto_rawDesc)))
+	})
+	return file_iso20022_caam_proto_rawDescData
+}
+
+var file_iso20022_caam_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
+var file_iso20022_caam_proto_goTypes = []any{
+	(*ATMDeviceControlV01)(nil),                       // 0: iso20022.caam.ATMDeviceControlV01
+	(*ATMDeviceControlV02)(nil),                       // 1: iso20022.caam.ATMDeviceControlV02
+	(*ATMDeviceReportV01)(nil),                        // 2: iso20022.caam.ATMDeviceReportV01
+	(*ATMDeviceReportV02)(nil),                        // 3: iso20022.caam.ATMDeviceReportV02
+	(*ATMDiagnosticRequestV01)(nil),                   // 4: iso20022.caam.ATMDiagnosticRequestV01
+	(*ATMDiagnosticRequestV02)(nil),                   // 5: iso20022.caam.ATMDiagnosticRequestV02
+	(*ATMDiagnosticResponseV01)(nil),                  // 6: iso20022.caam.ATMDiagnosticResponseV01
+	(*ATMDiagnosticResponseV02)(nil),                  // 7: iso20022.caam.ATMDiagnosticResponseV02
+	(*ATMExceptionAcknowledgementV01)(nil),            // 8: iso20022.caam.ATMExceptionAcknowledgementV01
+	(*ATMExceptionAdviceV01)(nil),                     // 9: iso20022.caam.ATMExceptionAdviceV01
+	(*ATMKeyDownloadRequestV01)(nil),                  // 10: iso20022.caam.ATMKeyDownloadRequestV01
+	(*ATMKeyDownloadRequestV02)(nil),                  // 11: iso20022.caam.ATMKeyDownloadRequestV02
+	(*ATMKeyDownloadResponseV01)(nil),                 // 12: iso20022.caam.ATMKeyDownloadResponseV01
+	(*ATMKeyDownloadResponseV02)(nil),                 // 13: iso20022.caam.ATMKeyDownloadResponseV02
+	(*ATMReconciliationAcknowledgementV01)(nil),       // 14: iso20022.caam.ATMReconciliationAcknowledgementV01
+	(*ATMReconciliationAcknowledgementV02)(nil),       // 15: iso20022.caam.ATMReconciliationAcknowledgementV02
+	(*ATMReconciliationAdviceV01)(nil),                // 16: iso20022.caam.ATMReconciliationAdviceV01
+	(*ATMReconciliationAdviceV02)(nil),                // 17: iso20022.caam.ATMReconciliationAdviceV02
+	(*Document00100101)(nil),                          // 18: iso20022.caam.Document00100101
+	(*Document00100102)(nil),                          // 19: iso20022.caam.Document00100102
+	(*Document00200101)(nil),                          // 20: iso20022.caam.Document00200101
+	(*Document00200102)(nil),                          // 21: iso20022.caam.Document00200102
+	(*Document00300101)(nil),                          // 22: iso20022.caam.Document00300101
+	(*Document00300102)(nil),                          // 23: iso20022.caam.Document00300102
+	(*Document00400101)(nil),                          // 24: iso20022.caam.Document00400101
+	(*Document00400102)(nil),                          // 25: iso20022.caam.Document00400102
+	(*Document00500101)(nil),                          // 26: iso20022.caam.Document00500101
+	(*Document00500102)(nil),                          // 27: iso20022.caam.Document00500102
+	(*Document00600101)(nil),                          // 28: iso20022.caam.Document00600101
+	(*Document00600102)(nil),                          // 29: iso20022.caam.Document00600102
+	(*Document00700101)(nil),                          // 30: iso20022.caam.Document00700101
+	(*Document00800101)(nil),                          // 31: iso20022.caam.Document00800101
+	(*Document00900101)(nil),                          // 32: iso20022.caam.Document00900101
+	(*Document00900102)(nil),                          // 33: iso20022.caam.Document00900102
+	(*Document01000101)(nil),                          // 34: iso20022.caam.Document01000101
+	(*Document01000102)(nil),                          // 35: iso20022.caam.Document01000102
+	(*Document01100101)(nil),                          // 36: iso20022.caam.Document01100101
+	(*Document01200101)(nil),                          // 37: iso20022.caam.Document01200101
+	(*HostToATMAcknowledgementV01)(nil),               // 38: iso20022.caam.HostToATMAcknowledgementV01
+	(*HostToATMRequestV01)(nil),                       // 39: iso20022.caam.HostToATMRequestV01
+	(*modelpb.Header20)(nil),                          // 40: iso20022.model.Header20
+	(*modelpb.ContentInformationType10)(nil),          // 41: iso20022.model.ContentInformationType10
+	(*modelpb.ATMDeviceControl1)(nil),                 // 42: iso20022.model.ATMDeviceControl1
+	(*modelpb.ContentInformationType13)(nil),          // 43: iso20022.model.ContentInformationType13
+	(*modelpb.Header31)(nil),                          // 44: iso20022.model.Header31
+	(*modelpb.ATMDeviceReport1)(nil),                  // 45: iso20022.model.ATMDeviceReport1
+	(*modelpb.ATMDiagnosticRequest1)(nil),             // 46: iso20022.model.ATMDiagnosticRequest1
+	(*modelpb.ContentInformationType15)(nil),          // 47: iso20022.model.ContentInformationType15
+	(*modelpb.ATMDiagnosticRequest2)(nil),             // 48: iso20022.model.ATMDiagnosticRequest2
+	(*modelpb.ATMDiagnosticResponse1)(nil),            // 49: iso20022.model.ATMDiagnosticResponse1
+	(*modelpb.ATMDiagnosticResponse2)(nil),            // 50: iso20022.model.ATMDiagnosticResponse2
+	(*modelpb.Header32)(nil),                          // 51: iso20022.model.Header32
+	(*modelpb.ATMExceptionAcknowledgement1)(nil),      // 52: iso20022.model.ATMExceptionAcknowledgement1
+	(*modelpb.ATMExceptionAdvice1)(nil),               // 53: iso20022.model.ATMExceptionAdvice1
+	(*modelpb.ATMKeyDownloadRequest1)(nil),            // 54: iso20022.model.ATMKeyDownloadRequest1
+	(*modelpb.ATMKeyDownloadRequest2)(nil),            // 55: iso20022.model.ATMKeyDownloadRequest2
+	(*modelpb.ATMKeyDownloadResponse1)(nil),           // 56: iso20022.model.ATMKeyDownloadResponse1
+	(*modelpb.ATMKeyDownloadResponse2)(nil),           // 57: iso20022.model.ATMKeyDownloadResponse2
+	(*modelpb.Header21)(nil),                          // 58: iso20022.model.Header21
+	(*modelpb.ATMReconciliationAcknowledgement1)(nil), // 59: iso20022.model.ATMReconciliationAcknowledgement1
+	(*modelpb.ATMReconciliationAcknowledgement2)(nil), // 60: iso20022.model.ATMReconciliationAcknowledgement2
+	(*modelpb.ATMReconciliationAdvice1)(nil),          // 61: iso20022.model.ATMReconciliationAdvice1
+	(*modelpb.ATMReconciliationAdvice2)(nil),          // 62: iso20022.model.ATMReconciliationAdvice2
+	(*modelpb.HostToATMAcknowledgement1)(nil),         // 63: iso20022.model.HostToATMAcknowledgement1
+	(*modelpb.HostToATMRequest1)(nil),                 // 64: iso20022.model.HostToATMRequest1
+}
+var file_iso20022_caam_proto_depIdxs = []int32{
+	40,  // 0: iso20022.caam.ATMDeviceControlV01.header:type_name -> iso20022.model.Header20
+	41,  // 1: iso20022.caam.ATMDeviceControlV01.protected_atm_device_control:type_name -> iso20022.model.ContentInformationType10
+	42,  // 2: iso20022.caam.ATMDeviceControlV01.atm_device_control:type_name -> iso20022.model.ATMDeviceControl1
+	43,  // 3: iso20022.caam.ATMDeviceControlV01.security_trailer:type_name -> iso20022.model.ContentInformationType13
+	44,  // 4: iso20022.caam.ATMDeviceControlV02.header:type_name -> iso20022.model.Header31
+	41,  // 5: iso20022.caam.ATMDeviceControlV02.protected_atm_device_control:type_name -> iso20022.model.ContentInformationType10
+	42,  // 6: iso20022.caam.ATMDeviceControlV02.atm_device_control:type_name -> iso20022.model.ATMDeviceControl1
+	43,  // 7: iso20022.caam.ATMDeviceControlV02.security_trailer:type_name -> iso20022.model.ContentInformationType13
+	40,  // 8: iso20022.caam.ATMDeviceReportV01.header:type_name -> iso20022.model.Header20
+	41,  // 9: iso20022.caam.ATMDeviceReportV01.protected_atm_device_report:type_name -> iso20022.model.ContentInformationType10
+	45,  // 10: iso20022.caam.ATMDeviceReportV01.atm_device_report:type_name -> iso20022.model.ATMDeviceReport1
+	43,  // 11: iso20022.caam.ATMDeviceReportV01.security_trailer:type_name -> iso20022.model.ContentInformationType13
+	44,  // 12: iso20022.caam.ATMDeviceReportV02.header:type_name -> iso20022.model.Header31
+	41,  // 13: iso20022.caam.ATMDeviceReportV02.protected_atm_device_report:type_name -> iso20022.model.ContentInformationType10
+	45,  // 14: iso20022.caam.ATMDeviceReportV02.atm_device_report:type_name -> iso20022.model.ATMDeviceReport1
+	43,  // 15: iso20022.caam.ATMDeviceReportV02.security_trailer:type_name -> iso20022.model.ContentInformationType13
+	40,  // 16: iso20022.caam.ATMDiagnosticRequestV01.header:type_name -> iso20022.model.Header20
+	41,  // 17: iso20022.caam.ATMDiagnosticRequestV01.protected_atm_diagnostic_request:type_name -> iso20022.model.ContentInformationType10
+	46,  // 18: iso20022.caam.ATMDiagnosticRequestV01.atm_diagnostic_request:type_name -> iso20022.model.ATMDiagnosticRequest1
+	47,  // 19: iso20022.caam.ATMDiagnosticRequestV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	44,  // 20: iso20022.caam.ATMDiagnosticRequestV02.header:type_name -> iso20022.model.Header31
+	41,  // 21: iso20022.caam.ATMDiagnosticRequestV02.protected_atm_diagnostic_request:type_name -> iso20022.model.ContentInformationType10
+	48,  // 22: iso20022.caam.ATMDiagnosticRequestV02.atm_diagnostic_request:type_name -> iso20022.model.ATMDiagnosticRequest2
+	47,  // 23: iso20022.caam.ATMDiagnosticRequestV02.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	40,  // 24: iso20022.caam.ATMDiagnosticResponseV01.header:type_name -> iso20022.model.Header20
+	41,  // 25: iso20022.caam.ATMDiagnosticResponseV01.protected_atm_diagnostic_response:type_name -> iso20022.model.ContentInformationType10
+	49,  // 26: iso20022.caam.ATMDiagnosticResponseV01.atm_diagnostic_response:type_name -> iso20022.model.ATMDiagnosticResponse1
+	47,  // 27: iso20022.caam.ATMDiagnosticResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	44,  // 28: iso20022.caam.ATMDiagnosticResponseV02.header:type_name -> iso20022.model.Header31
+	41,  // 29: iso20022.caam.ATMDiagnosticResponseV02.protected_atm_diagnostic_response:type_name -> iso20022.model.ContentInformationType10
+	50,  // 30: iso20022.caam.ATMDiagnosticResponseV02.atm_diagnostic_response:type_name -> iso20022.model.ATMDiagnosticResponse2
+	47,  // 31: iso20022.caam.ATMDiagnosticResponseV02.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	51,  // 32: iso20022.caam.ATMExceptionAcknowledgementV01.header:type_name -> iso20022.model.Header32
+	41,  // 33: iso20022.caam.ATMExceptionAcknowledgementV01.protected_atm_exception_acknowledgement:type_name -> iso20022.model.ContentInformationType10
+	52,  // 34: iso20022.caam.ATMExceptionAcknowledgementV01.atm_exception_acknowledgement:type_name -> iso20022.model.ATMExceptionAcknowledgement1
+	47,  // 35: iso20022.caam.ATMExceptionAcknowledgementV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	51,  // 36: iso20022.caam.ATMExceptionAdviceV01.header:type_name -> iso20022.model.Header32
+	41,  // 37: iso20022.caam.ATMExceptionAdviceV01.protected_atm_exception_advice:type_name -> iso20022.model.ContentInformationType10
+	53,  // 38: iso20022.caam.ATMExceptionAdviceV01.atm_exception_advice:type_name -> iso20022.model.ATMExceptionAdvice1
+	47,  // 39: iso20022.caam.ATMExceptionAdviceV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	40,  // 40: iso20022.caam.ATMKeyDownloadRequestV01.header:type_name -> iso20022.model.Header20
+	41,  // 41: iso20022.caam.ATMKeyDownloadRequestV01.protected_atm_key_download_request:type_name -> iso20022.model.ContentInformationType10
+	54,  // 42: iso20022.caam.ATMKeyDownloadRequestV01.atm_key_download_request:type_name -> iso20022.model.ATMKeyDownloadRequest1
+	43,  // 43: iso20022.caam.ATMKeyDownloadRequestV01.security_trailer:type_name -> iso20022.model.ContentInformationType13
+	44,  // 44: iso20022.caam.ATMKeyDownloadRequestV02.header:type_name -> iso20022.model.Header31
+	41,  // 45: iso20022.caam.ATMKeyDownloadRequestV02.protected_atm_key_download_request:type_name -> iso20022.model.ContentInformationType10
+	55,  // 46: iso20022.caam.ATMKeyDownloadRequestV02.atm_key_download_request:type_name -> iso20022.model.ATMKeyDownloadRequest2
+	43,  // 47: iso20022.caam.ATMKeyDownloadRequestV02.security_trailer:type_name -> iso20022.model.ContentInformationType13
+	40,  // 48: iso20022.caam.ATMKeyDownloadResponseV01.header:type_name -> iso20022.model.Header20
+	41,  // 49: iso20022.caam.ATMKeyDownloadResponseV01.protected_atm_key_download_response:type_name -> iso20022.model.ContentInformationType10
+	56,  // 50: iso20022.caam.ATMKeyDownloadResponseV01.atm_key_download_response:type_name -> iso20022.model.ATMKeyDownloadResponse1
+	43,  // 51: iso20022.caam.ATMKeyDownloadResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType13
+	44,  // 52: iso20022.caam.ATMKeyDownloadResponseV02.header:type_name -> iso20022.model.Header31
+	41,  // 53: iso20022.caam.ATMKeyDownloadResponseV02.protected_atm_key_download_response:type_name -> iso20022.model.ContentInformationType10
+	57,  // 54: iso20022.caam.ATMKeyDownloadResponseV02.atm_key_download_response:type_name -> iso20022.model.ATMKeyDownloadResponse2
+	43,  // 55: iso20022.caam.ATMKeyDownloadResponseV02.security_trailer:type_name -> iso20022.model.ContentInformationType13
+	58,  // 56: iso20022.caam.ATMReconciliationAcknowledgementV01.header:type_name -> iso20022.model.Header21
+	41,  // 57: iso20022.caam.ATMReconciliationAcknowledgementV01.protected_atm_reconciliation_acknowledgement:type_name -> iso20022.model.ContentInformationType10
+	59,  // 58: iso20022.caam.ATMReconciliationAcknowledgementV01.atm_reconciliation_acknowledgement:type_name -> iso20022.model.ATMReconciliationAcknowledgement1
+	47,  // 59: iso20022.caam.ATMReconciliationAcknowledgementV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	51,  // 60: iso20022.caam.ATMReconciliationAcknowledgementV02.header:type_name -> iso20022.model.Header32
+	41,  // 61: iso20022.caam.ATMReconciliationAcknowledgementV02.protected_atm_reconciliation_acknowledgement:type_name -> iso20022.model.ContentInformationType10
+	60,  // 62: iso20022.caam.ATMReconciliationAcknowledgementV02.atm_reconciliation_acknowledgement:type_name -> iso20022.model.ATMReconciliationAcknowledgement2
+	47,  // 63: iso20022.caam.ATMReconciliationAcknowledgementV02.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	58,  // 64: iso20022.caam.ATMReconciliationAdviceV01.header:type_name -> iso20022.model.Header21
+	41,  // 65: iso20022.caam.ATMReconciliationAdviceV01.protected_atm_reconciliation_advice:type_name -> iso20022.model.ContentInformationType10
+	61,  // 66: iso20022.caam.ATMReconciliationAdviceV01.atm_reconciliation_advice:type_name -> iso20022.model.ATMReconciliationAdvice1
+	47,  // 67: iso20022.caam.ATMReconciliationAdviceV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	51,  // 68: iso20022.caam.ATMReconciliationAdviceV02.header:type_name -> iso20022.model.Header32
+	41,  // 69: iso20022.caam.ATMReconciliationAdviceV02.protected_atm_reconciliation_advice:type_name -> iso20022.model.ContentInformationType10
+	62,  // 70: iso20022.caam.ATMReconciliationAdviceV02.atm_reconciliation_advice:type_name -> iso20022.model.ATMReconciliationAdvice2
+	47,  // 71: iso20022.caam.ATMReconciliationAdviceV02.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	2,   // 72: iso20022.caam.Document00100101.message:type_name -> iso20022.caam.ATMDeviceReportV01
+	3,   // 73: iso20022.caam.Document00100102.message:type_name -> iso20022.caam.ATMDeviceReportV02
+	0,   // 74: iso20022.caam.Document00200101.message:type_name -> iso20022.caam.ATMDeviceControlV01
+	1,   // 75: iso20022.caam.Document00200102.message:type_name -> iso20022.caam.ATMDeviceControlV02
+	10,  // 76: iso20022.caam.Document00300101.message:type_name -> iso20022.caam.ATMKeyDownloadRequestV01
+	11,  // 77: iso20022.caam.Document00300102.message:type_name -> iso20022.caam.ATMKeyDownloadRequestV02
+	12,  // 78: iso20022.caam.Document00400101.message:type_name -> iso20022.caam.ATMKeyDownloadResponseV01
+	13,  // 79: iso20022.caam.Document00400102.message:type_name -> iso20022.caam.ATMKeyDownloadResponseV02
+	4,   // 80: iso20022.caam.Document00500101.message:type_name -> iso20022.caam.ATMDiagnosticRequestV01
+	5,   // 81: iso20022.caam.Document00500102.message:type_name -> iso20022.caam.ATMDiagnosticRequestV02
+	6,   // 82: iso20022.caam.Document00600101.message:type_name -> iso20022.caam.ATMDiagnosticResponseV01
+	7,   // 83: iso20022.caam.Document00600102.message:type_name -> iso20022.caam.ATMDiagnosticResponseV02
+	39,  // 84: iso20022.caam.Document00700101.message:type_name -> iso20022.caam.HostToATMRequestV01
+	38,  // 85: iso20022.caam.Document00800101.message:type_name -> iso20022.caam.HostToATMAcknowledgementV01
+	16,  // 86: iso20022.caam.Document00900101.message:type_name -> iso20022.caam.ATMReconciliationAdviceV01
+	17,  // 87: iso20022.caam.Document00900102.message:type_name -> iso20022.caam.ATMReconciliationAdviceV02
+	14,  // 88: iso20022.caam.Document01000101.message:type_name -> iso20022.caam.ATMReconciliationAcknowledgementV01
+	15,  // 89: iso20022.caam.Document01000102.message:type_name -> iso20022.caam.ATMReconciliationAcknowledgementV02
+	9,   // 90: iso20022.caam.Document01100101.message:type_name -> iso20022.caam.ATMExceptionAdviceV01
+	8,   // 91: iso20022.caam.Document01200101.message:type_name -> iso20022.caam.ATMExceptionAcknowledgementV01
+	40,  // 92: iso20022.caam.HostToATMAcknowledgementV01.header:type_name -> iso20022.model.Header20
+	41,  // 93: iso20022.caam.HostToATMAcknowledgementV01.protected_host_to_atm_acknowledgement:type_name -> iso20022.model.ContentInformationType10
+	63,  // 94: iso20022.caam.HostToATMAcknowledgementV01.host_to_atm_acknowledgement:type_name -> iso20022.model.HostToATMAcknowledgement1
+	47,  // 95: iso20022.caam.HostToATMAcknowledgementV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	40,  // 96: iso20022.caam.HostToATMRequestV01.header:type_name -> iso20022.model.Header20
+	41,  // 97: iso20022.caam.HostToATMRequestV01.protected_host_to_atm_request:type_name -> iso20022.model.ContentInformationType10
+	64,  // 98: iso20022.caam.HostToATMRequestV01.host_to_atm_request:type_name -> iso20022.model.HostToATMRequest1
+	47,  // 99: iso20022.caam.HostToATMRequestV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	100, // [100:100] is the sub-list for method output_type
+	100, // [100:100] is the sub-list for method input_type
+	100, // [100:100] is the sub-list for extension type_name
+	100, // [100:100] is the sub-list for extension extendee
+	0,   // [0:100] is the sub-list for field type_name
+}
+
+func init() { file_iso20022_caam_proto_init() }
+func file_iso20022_caam_proto_init() {
+	if File_iso20022_caam_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_iso20022_caam_proto_rawDesc), len(file_iso20022_caam_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   40,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_iso20022_caam_proto_goTypes,
+		DependencyIndexes: file_iso20022_caam_proto_depIdxs,
+		MessageInfos:      file_iso20022_caam_proto_msgTypes,
+	}.Build()
+	File_iso20022_caam_proto = out.File
+	file_iso20022_caam_proto_goTypes = nil
+	file_iso20022_caam_proto_depIdxs = nil
+}
@@ -0,0 +1,1806 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: iso20022/cain.proto
+
+package cainpb
+
+import (
+	modelpb "github.com/yudaprama/iso20022/proto/iso20022/modelpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+// The AcquirerAuthorisationInitiation message is sent by an acquirer or an agent to an issuer or an agent, to request, advice or notify the approval of a card transaction.
+type AcquirerAuthorisationInitiation struct {
+	state                   protoimpl.MessageState                    `protogen:"open.v1"`
+	Header                  *modelpb.Header17                         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AuthorisationInitiation *modelpb.AcquirerAuthorisationInitiation1 `protobuf:"bytes,2,opt,name=authorisation_initiation,json=authorisationInitiation,proto3" json:"authorisation_initiation,omitempty"`
+	SecurityTrailer         *modelpb.ContentInformationType15         `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *AcquirerAuthorisationInitiation) Reset() {
+	*x = AcquirerAuthorisationInitiation{}
+	mi := &file_iso20022_cain_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcquirerAuthorisationInitiation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquirerAuthorisationInitiation) ProtoMessage() {}
+
+func (x *AcquirerAuthorisationInitiation) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquirerAuthorisationInitiation.ProtoReflect.Descriptor instead.
+func (*AcquirerAuthorisationInitiation) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AcquirerAuthorisationInitiation) GetHeader() *modelpb.Header17 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcquirerAuthorisationInitiation) GetAuthorisationInitiation() *modelpb.AcquirerAuthorisationInitiation1 {
+	if x != nil {
+		return x.AuthorisationInitiation
+	}
+	return nil
+}
+
+func (x *AcquirerAuthorisationInitiation) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcquirerAuthorisationResponse message is sent by an issuer or an agent to answer to an AcquirerAuthorisationInitiation message.
+type AcquirerAuthorisationResponse struct {
+	state                 protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                *modelpb.Header17                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AuthorisationResponse *modelpb.AcquirerAuthorisationResponse1 `protobuf:"bytes,2,opt,name=authorisation_response,json=authorisationResponse,proto3" json:"authorisation_response,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType15       `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcquirerAuthorisationResponse) Reset() {
+	*x = AcquirerAuthorisationResponse{}
+	mi := &file_iso20022_cain_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcquirerAuthorisationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquirerAuthorisationResponse) ProtoMessage() {}
+
+func (x *AcquirerAuthorisationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquirerAuthorisationResponse.ProtoReflect.Descriptor instead.
+func (*AcquirerAuthorisationResponse) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AcquirerAuthorisationResponse) GetHeader() *modelpb.Header17 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcquirerAuthorisationResponse) GetAuthorisationResponse() *modelpb.AcquirerAuthorisationResponse1 {
+	if x != nil {
+		return x.AuthorisationResponse
+	}
+	return nil
+}
+
+func (x *AcquirerAuthorisationResponse) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcquirerFinancialInitiation message is sent by an acquirer or  an agent to an issuer or an agent, to request, advice or notify the approval and the clearing of a card transaction.
+type AcquirerFinancialInitiation struct {
+	state               protoimpl.MessageState                `protogen:"open.v1"`
+	Header              *modelpb.Header17                     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	FinancialInitiation *modelpb.AcquirerFinancialInitiation1 `protobuf:"bytes,2,opt,name=financial_initiation,json=financialInitiation,proto3" json:"financial_initiation,omitempty"`
+	SecurityTrailer     *modelpb.ContentInformationType15     `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AcquirerFinancialInitiation) Reset() {
+	*x = AcquirerFinancialInitiation{}
+	mi := &file_iso20022_cain_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcquirerFinancialInitiation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquirerFinancialInitiation) ProtoMessage() {}
+
+func (x *AcquirerFinancialInitiation) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquirerFinancialInitiation.ProtoReflect.Descriptor instead.
+func (*AcquirerFinancialInitiation) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AcquirerFinancialInitiation) GetHeader() *modelpb.Header17 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcquirerFinancialInitiation) GetFinancialInitiation() *modelpb.AcquirerFinancialInitiation1 {
+	if x != nil {
+		return x.FinancialInitiation
+	}
+	return nil
+}
+
+func (x *AcquirerFinancialInitiation) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcquirerFinancialResponse message is sent by an issuer or an agent to answer to an AcquirerFinancialInitiation message.
+type AcquirerFinancialResponse struct {
+	state             protoimpl.MessageState              `protogen:"open.v1"`
+	Header            *modelpb.Header17                   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	FinancialResponse *modelpb.AcquirerFinancialResponse1 `protobuf:"bytes,2,opt,name=financial_response,json=financialResponse,proto3" json:"financial_response,omitempty"`
+	SecurityTrailer   *modelpb.ContentInformationType15   `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AcquirerFinancialResponse) Reset() {
+	*x = AcquirerFinancialResponse{}
+	mi := &file_iso20022_cain_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcquirerFinancialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquirerFinancialResponse) ProtoMessage() {}
+
+func (x *AcquirerFinancialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquirerFinancialResponse.ProtoReflect.Descriptor instead.
+func (*AcquirerFinancialResponse) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AcquirerFinancialResponse) GetHeader() *modelpb.Header17 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcquirerFinancialResponse) GetFinancialResponse() *modelpb.AcquirerFinancialResponse1 {
+	if x != nil {
+		return x.FinancialResponse
+	}
+	return nil
+}
+
+func (x *AcquirerFinancialResponse) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcquirerRejection message is sent by any party, to reject an Acquirer to Issuer message.
+type AcquirerRejection struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Header        *modelpb.Header19           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Reject        *modelpb.AcceptorRejection4 `protobuf:"bytes,2,opt,name=reject,proto3" json:"reject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcquirerRejection) Reset() {
+	*x = AcquirerRejection{}
+	mi := &file_iso20022_cain_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcquirerRejection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquirerRejection) ProtoMessage() {}
+
+func (x *AcquirerRejection) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquirerRejection.ProtoReflect.Descriptor instead.
+func (*AcquirerRejection) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AcquirerRejection) GetHeader() *modelpb.Header19 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcquirerRejection) GetReject() *modelpb.AcceptorRejection4 {
+	if x != nil {
+		return x.Reject
+	}
+	return nil
+}
+
+// The AcquirerReversalInitiation message is sent by an acquirer or an agent to an issuer or an agent, to request, advice or notify the reversal of a card transaction.
+type AcquirerReversalInitiation struct {
+	state              protoimpl.MessageState               `protogen:"open.v1"`
+	Header             *modelpb.Header18                    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ReversalInitiation *modelpb.AcquirerReversalInitiation1 `protobuf:"bytes,2,opt,name=reversal_initiation,json=reversalInitiation,proto3" json:"reversal_initiation,omitempty"`
+	SecurityTrailer    *modelpb.ContentInformationType15    `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AcquirerReversalInitiation) Reset() {
+	*x = AcquirerReversalInitiation{}
+	mi := &file_iso20022_cain_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcquirerReversalInitiation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquirerReversalInitiation) ProtoMessage() {}
+
+func (x *AcquirerReversalInitiation) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquirerReversalInitiation.ProtoReflect.Descriptor instead.
+func (*AcquirerReversalInitiation) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AcquirerReversalInitiation) GetHeader() *modelpb.Header18 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcquirerReversalInitiation) GetReversalInitiation() *modelpb.AcquirerReversalInitiation1 {
+	if x != nil {
+		return x.ReversalInitiation
+	}
+	return nil
+}
+
+func (x *AcquirerReversalInitiation) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The AcquirerReversalResponse message is sent by an issuer or an agent to answer to an AcquirerReversalInitiation message.
+type AcquirerReversalResponse struct {
+	state            protoimpl.MessageState             `protogen:"open.v1"`
+	Header           *modelpb.Header18                  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ReversalResponse *modelpb.AcquirerReversalResponse1 `protobuf:"bytes,2,opt,name=reversal_response,json=reversalResponse,proto3" json:"reversal_response,omitempty"`
+	SecurityTrailer  *modelpb.ContentInformationType15  `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AcquirerReversalResponse) Reset() {
+	*x = AcquirerReversalResponse{}
+	mi := &file_iso20022_cain_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcquirerReversalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquirerReversalResponse) ProtoMessage() {}
+
+func (x *AcquirerReversalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquirerReversalResponse.ProtoReflect.Descriptor instead.
+func (*AcquirerReversalResponse) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AcquirerReversalResponse) GetHeader() *modelpb.Header18 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcquirerReversalResponse) GetReversalResponse() *modelpb.AcquirerReversalResponse1 {
+	if x != nil {
+		return x.ReversalResponse
+	}
+	return nil
+}
+
+func (x *AcquirerReversalResponse) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+type Document00100101 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *AcquirerAuthorisationInitiation `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100101) Reset() {
+	*x = Document00100101{}
+	mi := &file_iso20022_cain_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100101) ProtoMessage() {}
+
+func (x *Document00100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100101.ProtoReflect.Descriptor instead.
+func (*Document00100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Document00100101) GetMessage() *AcquirerAuthorisationInitiation {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200101 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *AcquirerAuthorisationResponse `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200101) Reset() {
+	*x = Document00200101{}
+	mi := &file_iso20022_cain_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200101) ProtoMessage() {}
+
+func (x *Document00200101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200101.ProtoReflect.Descriptor instead.
+func (*Document00200101) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Document00200101) GetMessage() *AcquirerAuthorisationResponse {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300101 struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Message       *AcquirerFinancialInitiation `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300101) Reset() {
+	*x = Document00300101{}
+	mi := &file_iso20022_cain_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300101) ProtoMessage() {}
+
+func (x *Document00300101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300101.ProtoReflect.Descriptor instead.
+func (*Document00300101) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Document00300101) GetMessage() *AcquirerFinancialInitiation {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400101 struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Message       *AcquirerFinancialResponse `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400101) Reset() {
+	*x = Document00400101{}
+	mi := &file_iso20022_cain_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400101) ProtoMessage() {}
+
+func (x *Document00400101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400101.ProtoReflect.Descriptor instead.
+func (*Document00400101) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Document00400101) GetMessage() *AcquirerFinancialResponse {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500101 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Message       *AcquirerReversalInitiation `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500101) Reset() {
+	*x = Document00500101{}
+	mi := &file_iso20022_cain_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500101) ProtoMessage() {}
+
+func (x *Document00500101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500101.ProtoReflect.Descriptor instead.
+func (*Document00500101) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Document00500101) GetMessage() *AcquirerReversalInitiation {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600101 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *AcquirerReversalResponse `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600101) Reset() {
+	*x = Document00600101{}
+	mi := &file_iso20022_cain_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600101) ProtoMessage() {}
+
+func (x *Document00600101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600101.ProtoReflect.Descriptor instead.
+func (*Document00600101) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Document00600101) GetMessage() *AcquirerReversalResponse {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00700101 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *ReconciliationInitiation `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00700101) Reset() {
+	*x = Document00700101{}
+	mi := &file_iso20022_cain_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00700101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00700101) ProtoMessage() {}
+
+func (x *Document00700101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00700101.ProtoReflect.Descriptor instead.
+func (*Document00700101) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Document00700101) GetMessage() *ReconciliationInitiation {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800101 struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Message       *ReconciliationResponse `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800101) Reset() {
+	*x = Document00800101{}
+	mi := &file_iso20022_cain_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800101) ProtoMessage() {}
+
+func (x *Document00800101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800101.ProtoReflect.Descriptor instead.
+func (*Document00800101) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Document00800101) GetMessage() *ReconciliationResponse {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900101 struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Message       *NetworkManagementInitiation `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900101) Reset() {
+	*x = Document00900101{}
+	mi := &file_iso20022_cain_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900101) ProtoMessage() {}
+
+func (x *Document00900101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900101.ProtoReflect.Descriptor instead.
+func (*Document00900101) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Document00900101) GetMessage() *NetworkManagementInitiation {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000101 struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Message       *NetworkManagementResponse `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000101) Reset() {
+	*x = Document01000101{}
+	mi := &file_iso20022_cain_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000101) ProtoMessage() {}
+
+func (x *Document01000101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000101.ProtoReflect.Descriptor instead.
+func (*Document01000101) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Document01000101) GetMessage() *NetworkManagementResponse {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01100101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *KeyExchangeInitiation `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01100101) Reset() {
+	*x = Document01100101{}
+	mi := &file_iso20022_cain_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01100101) ProtoMessage() {}
+
+func (x *Document01100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01100101.ProtoReflect.Descriptor instead.
+func (*Document01100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Document01100101) GetMessage() *KeyExchangeInitiation {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01200101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *KeyExchangeResponse   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01200101) Reset() {
+	*x = Document01200101{}
+	mi := &file_iso20022_cain_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01200101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01200101) ProtoMessage() {}
+
+func (x *Document01200101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01200101.ProtoReflect.Descriptor instead.
+func (*Document01200101) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Document01200101) GetMessage() *KeyExchangeResponse {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01300101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *AcquirerRejection     `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01300101) Reset() {
+	*x = Document01300101{}
+	mi := &file_iso20022_cain_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01300101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01300101) ProtoMessage() {}
+
+func (x *Document01300101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01300101.ProtoReflect.Descriptor instead.
+func (*Document01300101) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Document01300101) GetMessage() *AcquirerRejection {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// The KeyExchangeInitiation message is sent by any party to an acquirer, an issuer or an agent, to initiate a cryptographic key exchange.
+type KeyExchangeInitiation struct {
+	state                 protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                *modelpb.Header17                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	KeyExchangeInitiation *modelpb.AcquirerKeyExchangeInitiation1 `protobuf:"bytes,2,opt,name=key_exchange_initiation,json=keyExchangeInitiation,proto3" json:"key_exchange_initiation,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType12       `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *KeyExchangeInitiation) Reset() {
+	*x = KeyExchangeInitiation{}
+	mi := &file_iso20022_cain_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyExchangeInitiation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyExchangeInitiation) ProtoMessage() {}
+
+func (x *KeyExchangeInitiation) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyExchangeInitiation.ProtoReflect.Descriptor instead.
+func (*KeyExchangeInitiation) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *KeyExchangeInitiation) GetHeader() *modelpb.Header17 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *KeyExchangeInitiation) GetKeyExchangeInitiation() *modelpb.AcquirerKeyExchangeInitiation1 {
+	if x != nil {
+		return x.KeyExchangeInitiation
+	}
+	return nil
+}
+
+func (x *KeyExchangeInitiation) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The KeyExchangeResponse message is sent by an acquirer, an issuer or an agent to answer to a KeyExchangeInitiation message and complete a cryptographic key exchange.
+type KeyExchangeResponse struct {
+	state               protoimpl.MessageState                `protogen:"open.v1"`
+	Header              *modelpb.Header17                     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	KeyExchangeResponse *modelpb.AcquirerKeyExchangeResponse1 `protobuf:"bytes,2,opt,name=key_exchange_response,json=keyExchangeResponse,proto3" json:"key_exchange_response,omitempty"`
+	SecurityTrailer     *modelpb.ContentInformationType12     `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *KeyExchangeResponse) Reset() {
+	*x = KeyExchangeResponse{}
+	mi := &file_iso20022_cain_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyExchangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyExchangeResponse) ProtoMessage() {}
+
+func (x *KeyExchangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyExchangeResponse.ProtoReflect.Descriptor instead.
+func (*KeyExchangeResponse) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *KeyExchangeResponse) GetHeader() *modelpb.Header17 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *KeyExchangeResponse) GetKeyExchangeResponse() *modelpb.AcquirerKeyExchangeResponse1 {
+	if x != nil {
+		return x.KeyExchangeResponse
+	}
+	return nil
+}
+
+func (x *KeyExchangeResponse) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The NetworkManagementInitiation message covers the range of activities to control the operating condition of the network and may be initiated by any party to an acquirer, an issuer or an agent.
+type NetworkManagementInitiation struct {
+	state                       protoimpl.MessageState                        `protogen:"open.v1"`
+	Header                      *modelpb.Header17                             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	NetworkManagementInitiation *modelpb.AcquirerNetworkManagementInitiation1 `protobuf:"bytes,2,opt,name=network_management_initiation,json=networkManagementInitiation,proto3" json:"network_management_initiation,omitempty"`
+	SecurityTrailer             *modelpb.ContentInformationType15             `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *NetworkManagementInitiation) Reset() {
+	*x = NetworkManagementInitiation{}
+	mi := &file_iso20022_cain_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkManagementInitiation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkManagementInitiation) ProtoMessage() {}
+
+func (x *NetworkManagementInitiation) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkManagementInitiation.ProtoReflect.Descriptor instead.
+func (*NetworkManagementInitiation) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NetworkManagementInitiation) GetHeader() *modelpb.Header17 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *NetworkManagementInitiation) GetNetworkManagementInitiation() *modelpb.AcquirerNetworkManagementInitiation1 {
+	if x != nil {
+		return x.NetworkManagementInitiation
+	}
+	return nil
+}
+
+func (x *NetworkManagementInitiation) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The NetworkManagementResponse message is sent by an acquirer, an issuer or an agent to answer to an NetworkManagementInitiation message.
+type NetworkManagementResponse struct {
+	state                     protoimpl.MessageState                      `protogen:"open.v1"`
+	Header                    *modelpb.Header17                           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	NetworkManagementResponse *modelpb.AcquirerNetworkManagementResponse1 `protobuf:"bytes,2,opt,name=network_management_response,json=networkManagementResponse,proto3" json:"network_management_response,omitempty"`
+	SecurityTrailer           *modelpb.ContentInformationType15           `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *NetworkManagementResponse) Reset() {
+	*x = NetworkManagementResponse{}
+	mi := &file_iso20022_cain_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkManagementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkManagementResponse) ProtoMessage() {}
+
+func (x *NetworkManagementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkManagementResponse.ProtoReflect.Descriptor instead.
+func (*NetworkManagementResponse) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *NetworkManagementResponse) GetHeader() *modelpb.Header17 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *NetworkManagementResponse) GetNetworkManagementResponse() *modelpb.AcquirerNetworkManagementResponse1 {
+	if x != nil {
+		return x.NetworkManagementResponse
+	}
+	return nil
+}
+
+func (x *NetworkManagementResponse) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ReconciliationInitiation message is sent by an acquirer or an agent to an issuer or an agent, to initiate an exchange of totals to be reconciled for debits, credits, chargebacks and other transactions.
+type ReconciliationInitiation struct {
+	state                    protoimpl.MessageState                     `protogen:"open.v1"`
+	Header                   *modelpb.Header17                          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ReconciliationInitiation *modelpb.AcquirerReconciliationInitiation1 `protobuf:"bytes,2,opt,name=reconciliation_initiation,json=reconciliationInitiation,proto3" json:"reconciliation_initiation,omitempty"`
+	SecurityTrailer          *modelpb.ContentInformationType15          `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ReconciliationInitiation) Reset() {
+	*x = ReconciliationInitiation{}
+	mi := &file_iso20022_cain_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationInitiation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationInitiation) ProtoMessage() {}
+
+func (x *ReconciliationInitiation) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationInitiation.ProtoReflect.Descriptor instead.
+func (*ReconciliationInitiation) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReconciliationInitiation) GetHeader() *modelpb.Header17 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ReconciliationInitiation) GetReconciliationInitiation() *modelpb.AcquirerReconciliationInitiation1 {
+	if x != nil {
+		return x.ReconciliationInitiation
+	}
+	return nil
+}
+
+func (x *ReconciliationInitiation) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ReconciliationResponse message is sent by an issuer or an agent to return the reconciled totals for debits, credits, chargebacks and other transactions.
+type ReconciliationResponse struct {
+	state                  protoimpl.MessageState                   `protogen:"open.v1"`
+	Header                 *modelpb.Header17                        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ReconciliationResponse *modelpb.AcquirerReconciliationResponse1 `protobuf:"bytes,2,opt,name=reconciliation_response,json=reconciliationResponse,proto3" json:"reconciliation_response,omitempty"`
+	SecurityTrailer        *modelpb.ContentInformationType15        `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReconciliationResponse) Reset() {
+	*x = ReconciliationResponse{}
+	mi := &file_iso20022_cain_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationResponse) ProtoMessage() {}
+
+func (x *ReconciliationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_cain_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationResponse.ProtoReflect.Descriptor instead.
+func (*ReconciliationResponse) Descriptor() ([]byte, []int) {
+	return file_iso20022_cain_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReconciliationResponse) GetHeader() *modelpb.Header17 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ReconciliationResponse) GetReconciliationResponse() *modelpb.AcquirerReconciliationResponse1 {
+	if x != nil {
+		return x.ReconciliationResponse
+	}
+	return nil
+}
+
+func (x *ReconciliationResponse) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+var File_iso20022_cain_proto protoreflect.FileDescriptor
+
+var file_iso20022_cain_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x63, 0x61, 0x69, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x63, 0x61, 0x69, 0x6e, 0x1a, 0x14, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x95, 0x02, 0x0a, 0x1f, 0x41,
+	0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x37, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x6b, 0x0a, 0x18, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x31, 0x52, 0x17, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x22, 0x8d, 0x02, 0x0a, 0x1d, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x37, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x22, 0x85, 0x02, 0x0a, 0x1b, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x46,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x37, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x5f, 0x0a, 0x14, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61,
+	0x6c, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x46, 0x69, 0x6e, 0x61,
+	0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31,
+	0x52, 0x13, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xfd, 0x01, 0x0a, 0x19, 0x41,
+	0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x31, 0x37, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x59, 0x0a, 0x12, 0x66, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72,
+	0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x31, 0x52, 0x11, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x41,
+	0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x39, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x3a, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x81,
+	0x02, 0x0a, 0x1a, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x6c, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x38, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x5c, 0x0a, 0x13, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x12, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x6c, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x22, 0xf9, 0x01, 0x0a, 0x18, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x38, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x56, 0x0a, 0x11, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x10, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x5c,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x31, 0x30, 0x30, 0x31,
+	0x30, 0x31, 0x12, 0x48, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63,
+	0x61, 0x69, 0x6e, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x31,
+	0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x69,
+	0x6e, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x44, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x69, 0x6e, 0x2e, 0x41, 0x63,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x56, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x34, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x42, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x69, 0x6e, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72,
+	0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x43,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x69, 0x6e, 0x2e,
+	0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x55, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x30, 0x36, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x69, 0x6e, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x72, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x55, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x37, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x41,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x69, 0x6e, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x53, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x38,
+	0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x3f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x63, 0x61, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x39, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x44, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x69, 0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x56, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x30, 0x30,
+	0x30, 0x31, 0x30, 0x31, 0x12, 0x42, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x61, 0x69, 0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x31, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x3e, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x69, 0x6e, 0x2e, 0x4b, 0x65,
+	0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x50, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x32, 0x30, 0x30, 0x31, 0x30, 0x31,
+	0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x69,
+	0x6e, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4e,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x33, 0x30, 0x30, 0x31,
+	0x30, 0x31, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63,
+	0x61, 0x69, 0x6e, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x86,
+	0x02, 0x0a, 0x15, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x31, 0x37, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x66, 0x0a, 0x17, 0x6b, 0x65,
+	0x79, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x6b, 0x65, 0x79,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x31, 0x32, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xfe, 0x01, 0x0a, 0x13, 0x4b, 0x65, 0x79, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x37, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x60, 0x0a, 0x15, 0x6b, 0x65, 0x79, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x13,
+	0x6b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x32, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x9e, 0x02, 0x0a, 0x1b, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x31, 0x37, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x78, 0x0a, 0x1d, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x1b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x96, 0x02, 0x0a, 0x19, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31,
+	0x37, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x72, 0x0a, 0x1b, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x31, 0x52, 0x19, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x22, 0x91, 0x02, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x37, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x6e, 0x0a, 0x19, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x18, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x89, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x37, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x17, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x72, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x79, 0x75, 0x64, 0x61, 0x70, 0x72, 0x61, 0x6d, 0x61, 0x2f, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2f, 0x63, 0x61, 0x69, 0x6e, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+})
+
+var (
+	file_iso20022_cain_proto_rawDescOnce sync.Once
+	file_iso20022_cain_proto_rawDescData []byte
+)
+
+func file_iso20022_cain_proto_rawDescGZIP() []byte {
+	file_iso20022_cain_proto_rawDescOnce.Do(func() {
+		file_iso20022_cain_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_iso20022_cain_proto_rawDesc), len(file_iso20022_cain_proto_rawDesc)))
+	})
+	return file_iso20022_cain_proto_rawDescData
+}
+
+var file_iso20022_cain_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_iso20022_cain_proto_goTypes = []any{
+	(*AcquirerAuthorisationInitiation)(nil),              // 0: iso20022.cain.AcquirerAuthorisationInitiation
+	(*AcquirerAuthorisationResponse)(nil),                // 1: iso20022.cain.AcquirerAuthorisationResponse
+	(*AcquirerFinancialInitiation)(nil),                  // 2: iso20022.cain.AcquirerFinancialInitiation
+	(*AcquirerFinancialResponse)(nil),                    // 3: iso20022.cain.AcquirerFinancialResponse
+	(*AcquirerRejection)(nil),                            // 4: iso20022.cain.AcquirerRejection
+	(*AcquirerReversalInitiation)(nil),                   // 5: iso20022.cain.AcquirerReversalInitiation
+	(*AcquirerReversalResponse)(nil),                     // 6: iso20022.cain.AcquirerReversalResponse
+	(*Document00100101)(nil),                             // 7: iso20022.cain.Document00100101
+	(*Document00200101)(nil),                             // 8: iso20022.cain.Document00200101
+	(*Document00300101)(nil),                             // 9: iso20022.cain.Document00300101
+	(*Document00400101)(nil),                             // 10: iso20022.cain.Document00400101
+	(*Document00500101)(nil),                             // 11: iso20022.cain.Document00500101
+	(*Document00600101)(nil),                             // 12: iso20022.cain.Document00600101
+	(*Document00700101)(nil),                             // 13: iso20022.cain.Document00700101
+	(*Document00800101)(nil),                             // 14: iso20022.cain.Document00800101
+	(*Document00900101)(nil),                             // 15: iso20022.cain.Document00900101
+	(*Document01000101)(nil),                             // 16: iso20022.cain.Document01000101
+	(*Document01100101)(nil),                             // 17: iso20022.cain.Document01100101
+	(*Document01200101)(nil),                             // 18: iso20022.cain.Document01200101
+	(*Document01300101)(nil),                             // 19: iso20022.cain.Document01300101
+	(*KeyExchangeInitiation)(nil),                        // 20: iso20022.cain.KeyExchangeInitiation
+	(*KeyExchangeResponse)(nil),                          // 21: iso20022.cain.KeyExchangeResponse
+	(*NetworkManagementInitiation)(nil),                  // 22: iso20022.cain.NetworkManagementInitiation
+	(*NetworkManagementResponse)(nil),                    // 23: iso20022.cain.NetworkManagementResponse
+	(*ReconciliationInitiation)(nil),                     // 24: iso20022.cain.ReconciliationInitiation
+	(*ReconciliationResponse)(nil),                       // 25: iso20022.cain.ReconciliationResponse
+	(*modelpb.Header17)(nil),                             // 26: iso20022.model.Header17
+	(*modelpb.AcquirerAuthorisationInitiation1)(nil),     // 27: iso20022.model.AcquirerAuthorisationInitiation1
+	(*modelpb.ContentInformationType15)(nil),             // 28: iso20022.model.ContentInformationType15
+	(*modelpb.AcquirerAuthorisationResponse1)(nil),       // 29: iso20022.model.AcquirerAuthorisationResponse1
+	(*modelpb.AcquirerFinancialInitiation1)(nil),         // 30: iso20022.model.AcquirerFinancialInitiation1
+	(*modelpb.AcquirerFinancialResponse1)(nil),           // 31: iso20022.model.AcquirerFinancialResponse1
+	(*modelpb.Header19)(nil),                             // 32: iso20022.model.Header19
+	(*modelpb.AcceptorRejection4)(nil),                   // 33: iso20022.model.AcceptorRejection4
+	(*modelpb.Header18)(nil),                             // 34: iso20022.model.Header18
+	(*modelpb.AcquirerReversalInitiation1)(nil),          // 35: iso20022.model.AcquirerReversalInitiation1
+	(*modelpb.AcquirerReversalResponse1)(nil),            // 36: iso20022.model.AcquirerReversalResponse1
+	(*modelpb.AcquirerKeyExchangeInitiation1)(nil),       // 37: iso20022.model.AcquirerKeyExchangeInitiation1
+	(*modelpb.ContentInformationType12)(nil),             // 38: iso20022.model.ContentInformationType12
+	(*modelpb.AcquirerKeyExchangeResponse1)(nil),         // 39: iso20022.model.AcquirerKeyExchangeResponse1
+	(*modelpb.AcquirerNetworkManagementInitiation1)(nil), // 40: iso20022.model.AcquirerNetworkManagementInitiation1
+	(*modelpb.AcquirerNetworkManagementResponse1)(nil),   // 41: iso20022.model.AcquirerNetworkManagementResponse1
+	(*modelpb.AcquirerReconciliationInitiation1)(nil),    // 42: iso20022.model.AcquirerReconciliationInitiation1
+	(*modelpb.AcquirerReconciliationResponse1)(nil),      // 43: iso20022.model.AcquirerReconciliationResponse1
+}
+var file_iso20022_cain_proto_depIdxs = []int32{
+	26, // 0: iso20022.cain.AcquirerAuthorisationInitiation.header:type_name -> iso20022.model.Header17
+	27, // 1: iso20022.cain.AcquirerAuthorisationInitiation.authorisation_initiation:type_name -> iso20022.model.AcquirerAuthorisationInitiation1
+	28, // 2: iso20022.cain.AcquirerAuthorisationInitiation.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	26, // 3: iso20022.cain.AcquirerAuthorisationResponse.header:type_name -> iso20022.model.Header17
+	29, // 4: iso20022.cain.AcquirerAuthorisationResponse.authorisation_response:type_name -> iso20022.model.AcquirerAuthorisationResponse1
+	28, // 5: iso20022.cain.AcquirerAuthorisationResponse.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	26, // 6: iso20022.cain.AcquirerFinancialInitiation.header:type_name -> iso20022.model.Header17
+	30, // 7: iso20022.cain.AcquirerFinancialInitiation.financial_initiation:type_name -> iso20022.model.AcquirerFinancialInitiation1
+	28, // 8: iso20022.cain.AcquirerFinancialInitiation.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	26, // 9: iso20022.cain.AcquirerFinancialResponse.header:type_name -> iso20022.model.Header17
+	31, // 10: iso20022.cain.AcquirerFinancialResponse.financial_response:type_name -> iso20022.model.AcquirerFinancialResponse1
+	28, // 11: iso20022.cain.AcquirerFinancialResponse.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	32, // 12: iso20022.cain.AcquirerRejection.header:type_name -> iso20022.model.Header19
+	33, // 13: iso20022.cain.AcquirerRejection.reject:type_name -> iso20022.model.AcceptorRejection4
+	34, // 14: iso20022.cain.AcquirerReversalInitiation.header:type_name -> iso20022.model.Header18
+	35, // 15: iso20022.cain.AcquirerReversalInitiation.reversal_initiation:type_name -> iso20022.model.AcquirerReversalInitiation1
+	28, // 16: iso20022.cain.AcquirerReversalInitiation.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	34, // 17: iso20022.cain.AcquirerReversalResponse.header:type_name -> iso20022.model.Header18
+	36, // 18: iso20022.cain.AcquirerReversalResponse.reversal_response:type_name -> iso20022.model.AcquirerReversalResponse1
+	28, // 19: iso20022.cain.AcquirerReversalResponse.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	0,  // 20: iso20022.cain.Document00100101.message:type_name -> iso20022.cain.AcquirerAuthorisationInitiation
+	1,  // 21: iso20022.cain.Document00200101.message:type_name -> iso20022.cain.AcquirerAuthorisationResponse
+	2,  // 22: iso20022.cain.Document00300101.message:type_name -> iso20022.cain.AcquirerFinancialInitiation
+	3,  // 23: iso20022.cain.Document00400101.message:type_name -> iso20022.cain.AcquirerFinancialResponse
+	5,  // 24: iso20022.cain.Document00500101.message:type_name -> iso20022.cain.AcquirerReversalInitiation
+	6,  // 25: iso20022.cain.Document00600101.message:type_name -> iso20022.cain.AcquirerReversalResponse
+	24, // 26: iso20022.cain.Document00700101.message:type_name -> iso20022.cain.ReconciliationInitiation
+	25, // 27: iso20022.cain.Document00800101.message:type_name -> iso20022.cain.ReconciliationResponse
+	22, // 28: iso20022.cain.Document00900101.message:type_name -> iso20022.cain.NetworkManagementInitiation
+	23, // 29: iso20022.cain.Document01000101.message:type_name -> iso20022.cain.NetworkManagementResponse
+	20, // 30: iso20022.cain.Document01100101.message:type_name -> iso20022.cain.KeyExchangeInitiation
+	21, // 31: iso20022.cain.Document01200101.message:type_name -> iso20022.cain.KeyExchangeResponse
+	4,  // 32: iso20022.cain.Document01300101.message:type_name -> iso20022.cain.AcquirerRejection
+	26, // 33: iso20022.cain.KeyExchangeInitiation.header:type_name -> iso20022.model.Header17
+	37, // 34: iso20022.cain.KeyExchangeInitiation.key_exchange_initiation:type_name -> iso20022.model.AcquirerKeyExchangeInitiation1
+	38, // 35: iso20022.cain.KeyExchangeInitiation.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	26, // 36: iso20022.cain.KeyExchangeResponse.header:type_name -> iso20022.model.Header17
+	39, // 37: iso20022.cain.KeyExchangeResponse.key_exchange_response:type_name -> iso20022.model.AcquirerKeyExchangeResponse1
+	38, // 38: iso20022.cain.KeyExchangeResponse.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	26, // 39: iso20022.cain.NetworkManagementInitiation.header:type_name -> iso20022.model.Header17
+	40, // 40: iso20022.cain.NetworkManagementInitiation.network_management_initiation:type_name -> iso20022.model.AcquirerNetworkManagementInitiation1
+	28, // 41: iso20022.cain.NetworkManagementInitiation.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	26, // 42: iso20022.cain.NetworkManagementResponse.header:type_name -> iso20022.model.Header17
+	41, // 43: iso20022.cain.NetworkManagementResponse.network_management_response:type_name -> iso20022.model.AcquirerNetworkManagementResponse1
+	28, // 44: iso20022.cain.NetworkManagementResponse.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	26, // 45: iso20022.cain.ReconciliationInitiation.header:type_name -> iso20022.model.Header17
+	42, // 46: iso20022.cain.ReconciliationInitiation.reconciliation_initiation:type_name -> iso20022.model.AcquirerReconciliationInitiation1
+	28, // 47: iso20022.cain.ReconciliationInitiation.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	26, // 48: iso20022.cain.ReconciliationResponse.header:type_name -> iso20022.model.Header17
+	43, // 49: iso20022.cain.ReconciliationResponse.reconciliation_response:type_name -> iso20022.model.AcquirerReconciliationResponse1
+	28, // 50: iso20022.cain.ReconciliationResponse.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	51, // [51:51] is the sub-list for method output_type
+	51, // [51:51] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
+}
+
+func init() { file_iso20022_cain_proto_init() }
+func file_iso20022_cain_proto_init() {
+	if File_iso20022_cain_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_iso20022_cain_proto_rawDesc), len(file_iso20022_cain_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_iso20022_cain_proto_goTypes,
+		DependencyIndexes: file_iso20022_cain_proto_depIdxs,
+		MessageInfos:      file_iso20022_cain_proto_msgTypes,
+	}.Build()
+	File_iso20022_cain_proto = out.File
+	file_iso20022_cain_proto_goTypes = nil
+	file_iso20022_cain_proto_depIdxs = nil
+}
@@ -0,0 +1,3374 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: iso20022/catm.proto
+
+package catmpb
+
+import (
+	modelpb "github.com/yudaprama/iso20022/proto/iso20022/modelpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+// Scope
+// The AcceptorConfigurationUpdate message is sent by the master terminal manager or delegated terminal manager to the acceptor system for the update of acquirer parameters, merchant parameters, vendor parameters or cryptographic keys of the acquirer.
+// Usage
+// The AcceptorConfigurationUpdate message may embed the information required by the acceptor system for the configuration of:
+// - the application parameters necessary for software applications processed by the POI system,
+// - the acquirer protocol parameters for the message content and message exchange behaviour of the acquirer protocol supported by the POI system,
+// - the host communication parameters to define the addresses of the connected acquirer hosts, and
+// - the merchant parameters needed for the retailer protocol settings of the POI system.
+type AcceptorConfigurationUpdateV01 struct {
+	state                 protoimpl.MessageState           `protogen:"open.v1"`
+	Header                *modelpb.Header4                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AcceptorConfiguration *modelpb.AcceptorConfiguration1  `protobuf:"bytes,2,opt,name=acceptor_configuration,json=acceptorConfiguration,proto3" json:"acceptor_configuration,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType1 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorConfigurationUpdateV01) Reset() {
+	*x = AcceptorConfigurationUpdateV01{}
+	mi := &file_iso20022_catm_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorConfigurationUpdateV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorConfigurationUpdateV01) ProtoMessage() {}
+
+func (x *AcceptorConfigurationUpdateV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorConfigurationUpdateV01.ProtoReflect.Descriptor instead.
+func (*AcceptorConfigurationUpdateV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AcceptorConfigurationUpdateV01) GetHeader() *modelpb.Header4 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorConfigurationUpdateV01) GetAcceptorConfiguration() *modelpb.AcceptorConfiguration1 {
+	if x != nil {
+		return x.AcceptorConfiguration
+	}
+	return nil
+}
+
+func (x *AcceptorConfigurationUpdateV01) GetSecurityTrailer() *modelpb.ContentInformationType1 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Update of the acceptor configuration to be dowloaded by the terminal management system.
+type AcceptorConfigurationUpdateV02 struct {
+	state                 protoimpl.MessageState           `protogen:"open.v1"`
+	Header                *modelpb.Header4                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AcceptorConfiguration *modelpb.AcceptorConfiguration2  `protobuf:"bytes,2,opt,name=acceptor_configuration,json=acceptorConfiguration,proto3" json:"acceptor_configuration,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType4 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorConfigurationUpdateV02) Reset() {
+	*x = AcceptorConfigurationUpdateV02{}
+	mi := &file_iso20022_catm_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorConfigurationUpdateV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorConfigurationUpdateV02) ProtoMessage() {}
+
+func (x *AcceptorConfigurationUpdateV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorConfigurationUpdateV02.ProtoReflect.Descriptor instead.
+func (*AcceptorConfigurationUpdateV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AcceptorConfigurationUpdateV02) GetHeader() *modelpb.Header4 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorConfigurationUpdateV02) GetAcceptorConfiguration() *modelpb.AcceptorConfiguration2 {
+	if x != nil {
+		return x.AcceptorConfiguration
+	}
+	return nil
+}
+
+func (x *AcceptorConfigurationUpdateV02) GetSecurityTrailer() *modelpb.ContentInformationType4 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Update of the acceptor configuration to be dowloaded by the terminal management system.
+type AcceptorConfigurationUpdateV03 struct {
+	state                 protoimpl.MessageState           `protogen:"open.v1"`
+	Header                *modelpb.Header4                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AcceptorConfiguration *modelpb.AcceptorConfiguration3  `protobuf:"bytes,2,opt,name=acceptor_configuration,json=acceptorConfiguration,proto3" json:"acceptor_configuration,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType9 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorConfigurationUpdateV03) Reset() {
+	*x = AcceptorConfigurationUpdateV03{}
+	mi := &file_iso20022_catm_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorConfigurationUpdateV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorConfigurationUpdateV03) ProtoMessage() {}
+
+func (x *AcceptorConfigurationUpdateV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorConfigurationUpdateV03.ProtoReflect.Descriptor instead.
+func (*AcceptorConfigurationUpdateV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AcceptorConfigurationUpdateV03) GetHeader() *modelpb.Header4 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorConfigurationUpdateV03) GetAcceptorConfiguration() *modelpb.AcceptorConfiguration3 {
+	if x != nil {
+		return x.AcceptorConfiguration
+	}
+	return nil
+}
+
+func (x *AcceptorConfigurationUpdateV03) GetSecurityTrailer() *modelpb.ContentInformationType9 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Update of the acceptor configuration to be downloaded by the terminal management system.
+type AcceptorConfigurationUpdateV04 struct {
+	state                 protoimpl.MessageState            `protogen:"open.v1"`
+	Header                *modelpb.Header14                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AcceptorConfiguration *modelpb.AcceptorConfiguration4   `protobuf:"bytes,2,opt,name=acceptor_configuration,json=acceptorConfiguration,proto3" json:"acceptor_configuration,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType12 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorConfigurationUpdateV04) Reset() {
+	*x = AcceptorConfigurationUpdateV04{}
+	mi := &file_iso20022_catm_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorConfigurationUpdateV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorConfigurationUpdateV04) ProtoMessage() {}
+
+func (x *AcceptorConfigurationUpdateV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorConfigurationUpdateV04.ProtoReflect.Descriptor instead.
+func (*AcceptorConfigurationUpdateV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AcceptorConfigurationUpdateV04) GetHeader() *modelpb.Header14 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorConfigurationUpdateV04) GetAcceptorConfiguration() *modelpb.AcceptorConfiguration4 {
+	if x != nil {
+		return x.AcceptorConfiguration
+	}
+	return nil
+}
+
+func (x *AcceptorConfigurationUpdateV04) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Update of the acceptor configuration to be downloaded by the terminal management system.
+type AcceptorConfigurationUpdateV05 struct {
+	state                 protoimpl.MessageState            `protogen:"open.v1"`
+	Header                *modelpb.Header27                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AcceptorConfiguration *modelpb.AcceptorConfiguration5   `protobuf:"bytes,2,opt,name=acceptor_configuration,json=acceptorConfiguration,proto3" json:"acceptor_configuration,omitempty"`
+	SecurityTrailer       *modelpb.ContentInformationType12 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AcceptorConfigurationUpdateV05) Reset() {
+	*x = AcceptorConfigurationUpdateV05{}
+	mi := &file_iso20022_catm_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptorConfigurationUpdateV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptorConfigurationUpdateV05) ProtoMessage() {}
+
+func (x *AcceptorConfigurationUpdateV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptorConfigurationUpdateV05.ProtoReflect.Descriptor instead.
+func (*AcceptorConfigurationUpdateV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AcceptorConfigurationUpdateV05) GetHeader() *modelpb.Header27 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptorConfigurationUpdateV05) GetAcceptorConfiguration() *modelpb.AcceptorConfiguration5 {
+	if x != nil {
+		return x.AcceptorConfiguration
+	}
+	return nil
+}
+
+func (x *AcceptorConfigurationUpdateV05) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The certificate management request message is sent by a POI terminal or any intermediary entity either to a terminal manager acting as a certificate authority for  managing X.509 certificate of a public key owned by the initiating party, or for requesting the inclusion or the removal of the POI to a white list of the terminal manager.
+type CertificateManagementRequestV01 struct {
+	state                        protoimpl.MessageState                 `protogen:"open.v1"`
+	Header                       *modelpb.Header29                      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CertificateManagementRequest *modelpb.CertificateManagementRequest1 `protobuf:"bytes,2,opt,name=certificate_management_request,json=certificateManagementRequest,proto3" json:"certificate_management_request,omitempty"`
+	SecurityTrailer              *modelpb.ContentInformationType13      `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *CertificateManagementRequestV01) Reset() {
+	*x = CertificateManagementRequestV01{}
+	mi := &file_iso20022_catm_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateManagementRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateManagementRequestV01) ProtoMessage() {}
+
+func (x *CertificateManagementRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateManagementRequestV01.ProtoReflect.Descriptor instead.
+func (*CertificateManagementRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CertificateManagementRequestV01) GetHeader() *modelpb.Header29 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *CertificateManagementRequestV01) GetCertificateManagementRequest() *modelpb.CertificateManagementRequest1 {
+	if x != nil {
+		return x.CertificateManagementRequest
+	}
+	return nil
+}
+
+func (x *CertificateManagementRequestV01) GetSecurityTrailer() *modelpb.ContentInformationType13 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The CertificateManagementResponse is sent by a terminal manager in response to a CertificateManagementRequest to provide the outcome of the requested service.
+type CertificateManagementResponseV01 struct {
+	state                         protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                        *modelpb.Header29                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CertificateManagementResponse *modelpb.CertificateManagementResponse1 `protobuf:"bytes,2,opt,name=certificate_management_response,json=certificateManagementResponse,proto3" json:"certificate_management_response,omitempty"`
+	SecurityTrailer               *modelpb.ContentInformationType13       `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *CertificateManagementResponseV01) Reset() {
+	*x = CertificateManagementResponseV01{}
+	mi := &file_iso20022_catm_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateManagementResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateManagementResponseV01) ProtoMessage() {}
+
+func (x *CertificateManagementResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateManagementResponseV01.ProtoReflect.Descriptor instead.
+func (*CertificateManagementResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CertificateManagementResponseV01) GetHeader() *modelpb.Header29 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *CertificateManagementResponseV01) GetCertificateManagementResponse() *modelpb.CertificateManagementResponse1 {
+	if x != nil {
+		return x.CertificateManagementResponse
+	}
+	return nil
+}
+
+func (x *CertificateManagementResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType13 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+type Document00100101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *StatusReportV01       `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100101) Reset() {
+	*x = Document00100101{}
+	mi := &file_iso20022_catm_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100101) ProtoMessage() {}
+
+func (x *Document00100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100101.ProtoReflect.Descriptor instead.
+func (*Document00100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Document00100101) GetMessage() *StatusReportV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00100102 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *StatusReportV02       `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100102) Reset() {
+	*x = Document00100102{}
+	mi := &file_iso20022_catm_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100102) ProtoMessage() {}
+
+func (x *Document00100102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100102.ProtoReflect.Descriptor instead.
+func (*Document00100102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Document00100102) GetMessage() *StatusReportV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00100103 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *StatusReportV03       `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100103) Reset() {
+	*x = Document00100103{}
+	mi := &file_iso20022_catm_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100103) ProtoMessage() {}
+
+func (x *Document00100103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100103.ProtoReflect.Descriptor instead.
+func (*Document00100103) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Document00100103) GetMessage() *StatusReportV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00100104 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *StatusReportV04       `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100104) Reset() {
+	*x = Document00100104{}
+	mi := &file_iso20022_catm_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100104) ProtoMessage() {}
+
+func (x *Document00100104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100104.ProtoReflect.Descriptor instead.
+func (*Document00100104) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Document00100104) GetMessage() *StatusReportV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00100105 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *StatusReportV05       `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100105) Reset() {
+	*x = Document00100105{}
+	mi := &file_iso20022_catm_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100105) ProtoMessage() {}
+
+func (x *Document00100105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100105.ProtoReflect.Descriptor instead.
+func (*Document00100105) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Document00100105) GetMessage() *StatusReportV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200101 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *ManagementPlanReplacementV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200101) Reset() {
+	*x = Document00200101{}
+	mi := &file_iso20022_catm_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200101) ProtoMessage() {}
+
+func (x *Document00200101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200101.ProtoReflect.Descriptor instead.
+func (*Document00200101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Document00200101) GetMessage() *ManagementPlanReplacementV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200102 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *ManagementPlanReplacementV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200102) Reset() {
+	*x = Document00200102{}
+	mi := &file_iso20022_catm_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200102) ProtoMessage() {}
+
+func (x *Document00200102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200102.ProtoReflect.Descriptor instead.
+func (*Document00200102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Document00200102) GetMessage() *ManagementPlanReplacementV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200103 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *ManagementPlanReplacementV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200103) Reset() {
+	*x = Document00200103{}
+	mi := &file_iso20022_catm_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200103) ProtoMessage() {}
+
+func (x *Document00200103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200103.ProtoReflect.Descriptor instead.
+func (*Document00200103) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Document00200103) GetMessage() *ManagementPlanReplacementV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200104 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *ManagementPlanReplacementV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200104) Reset() {
+	*x = Document00200104{}
+	mi := &file_iso20022_catm_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200104) ProtoMessage() {}
+
+func (x *Document00200104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200104.ProtoReflect.Descriptor instead.
+func (*Document00200104) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Document00200104) GetMessage() *ManagementPlanReplacementV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200105 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *ManagementPlanReplacementV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200105) Reset() {
+	*x = Document00200105{}
+	mi := &file_iso20022_catm_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200105) ProtoMessage() {}
+
+func (x *Document00200105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200105.ProtoReflect.Descriptor instead.
+func (*Document00200105) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Document00200105) GetMessage() *ManagementPlanReplacementV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300101 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *AcceptorConfigurationUpdateV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300101) Reset() {
+	*x = Document00300101{}
+	mi := &file_iso20022_catm_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300101) ProtoMessage() {}
+
+func (x *Document00300101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300101.ProtoReflect.Descriptor instead.
+func (*Document00300101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Document00300101) GetMessage() *AcceptorConfigurationUpdateV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300102 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *AcceptorConfigurationUpdateV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300102) Reset() {
+	*x = Document00300102{}
+	mi := &file_iso20022_catm_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300102) ProtoMessage() {}
+
+func (x *Document00300102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300102.ProtoReflect.Descriptor instead.
+func (*Document00300102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Document00300102) GetMessage() *AcceptorConfigurationUpdateV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300103 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *AcceptorConfigurationUpdateV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300103) Reset() {
+	*x = Document00300103{}
+	mi := &file_iso20022_catm_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300103) ProtoMessage() {}
+
+func (x *Document00300103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300103.ProtoReflect.Descriptor instead.
+func (*Document00300103) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Document00300103) GetMessage() *AcceptorConfigurationUpdateV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300104 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *AcceptorConfigurationUpdateV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300104) Reset() {
+	*x = Document00300104{}
+	mi := &file_iso20022_catm_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300104) ProtoMessage() {}
+
+func (x *Document00300104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300104.ProtoReflect.Descriptor instead.
+func (*Document00300104) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Document00300104) GetMessage() *AcceptorConfigurationUpdateV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300105 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *AcceptorConfigurationUpdateV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300105) Reset() {
+	*x = Document00300105{}
+	mi := &file_iso20022_catm_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300105) ProtoMessage() {}
+
+func (x *Document00300105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300105.ProtoReflect.Descriptor instead.
+func (*Document00300105) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Document00300105) GetMessage() *AcceptorConfigurationUpdateV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400101 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *TerminalManagementRejectionV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400101) Reset() {
+	*x = Document00400101{}
+	mi := &file_iso20022_catm_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400101) ProtoMessage() {}
+
+func (x *Document00400101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400101.ProtoReflect.Descriptor instead.
+func (*Document00400101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Document00400101) GetMessage() *TerminalManagementRejectionV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400102 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *TerminalManagementRejectionV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400102) Reset() {
+	*x = Document00400102{}
+	mi := &file_iso20022_catm_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400102) ProtoMessage() {}
+
+func (x *Document00400102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400102.ProtoReflect.Descriptor instead.
+func (*Document00400102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Document00400102) GetMessage() *TerminalManagementRejectionV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400103 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *TerminalManagementRejectionV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400103) Reset() {
+	*x = Document00400103{}
+	mi := &file_iso20022_catm_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400103) ProtoMessage() {}
+
+func (x *Document00400103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400103.ProtoReflect.Descriptor instead.
+func (*Document00400103) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Document00400103) GetMessage() *TerminalManagementRejectionV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400104 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *TerminalManagementRejectionV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400104) Reset() {
+	*x = Document00400104{}
+	mi := &file_iso20022_catm_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400104) ProtoMessage() {}
+
+func (x *Document00400104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400104.ProtoReflect.Descriptor instead.
+func (*Document00400104) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Document00400104) GetMessage() *TerminalManagementRejectionV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500101 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *MaintenanceDelegationRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500101) Reset() {
+	*x = Document00500101{}
+	mi := &file_iso20022_catm_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500101) ProtoMessage() {}
+
+func (x *Document00500101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500101.ProtoReflect.Descriptor instead.
+func (*Document00500101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Document00500101) GetMessage() *MaintenanceDelegationRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500102 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *MaintenanceDelegationRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500102) Reset() {
+	*x = Document00500102{}
+	mi := &file_iso20022_catm_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500102) ProtoMessage() {}
+
+func (x *Document00500102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500102.ProtoReflect.Descriptor instead.
+func (*Document00500102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Document00500102) GetMessage() *MaintenanceDelegationRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600101 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *MaintenanceDelegationResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600101) Reset() {
+	*x = Document00600101{}
+	mi := &file_iso20022_catm_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600101) ProtoMessage() {}
+
+func (x *Document00600101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600101.ProtoReflect.Descriptor instead.
+func (*Document00600101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Document00600101) GetMessage() *MaintenanceDelegationResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600102 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *MaintenanceDelegationResponseV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600102) Reset() {
+	*x = Document00600102{}
+	mi := &file_iso20022_catm_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600102) ProtoMessage() {}
+
+func (x *Document00600102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600102.ProtoReflect.Descriptor instead.
+func (*Document00600102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Document00600102) GetMessage() *MaintenanceDelegationResponseV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00700101 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *CertificateManagementRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00700101) Reset() {
+	*x = Document00700101{}
+	mi := &file_iso20022_catm_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00700101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00700101) ProtoMessage() {}
+
+func (x *Document00700101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00700101.ProtoReflect.Descriptor instead.
+func (*Document00700101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Document00700101) GetMessage() *CertificateManagementRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800101 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *CertificateManagementResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800101) Reset() {
+	*x = Document00800101{}
+	mi := &file_iso20022_catm_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800101) ProtoMessage() {}
+
+func (x *Document00800101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800101.ProtoReflect.Descriptor instead.
+func (*Document00800101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Document00800101) GetMessage() *CertificateManagementResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// A terminal manager requests to the master terminal manager the delegation of maintenance functions or maintenance operation on the terminal estate managed by the master terminal manager.
+type MaintenanceDelegationRequestV01 struct {
+	state                        protoimpl.MessageState                 `protogen:"open.v1"`
+	Header                       *modelpb.Header16                      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	MaintenanceDelegationRequest *modelpb.MaintenanceDelegationRequest1 `protobuf:"bytes,2,opt,name=maintenance_delegation_request,json=maintenanceDelegationRequest,proto3" json:"maintenance_delegation_request,omitempty"`
+	SecurityTrailer              *modelpb.ContentInformationType12      `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *MaintenanceDelegationRequestV01) Reset() {
+	*x = MaintenanceDelegationRequestV01{}
+	mi := &file_iso20022_catm_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceDelegationRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceDelegationRequestV01) ProtoMessage() {}
+
+func (x *MaintenanceDelegationRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceDelegationRequestV01.ProtoReflect.Descriptor instead.
+func (*MaintenanceDelegationRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MaintenanceDelegationRequestV01) GetHeader() *modelpb.Header16 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *MaintenanceDelegationRequestV01) GetMaintenanceDelegationRequest() *modelpb.MaintenanceDelegationRequest1 {
+	if x != nil {
+		return x.MaintenanceDelegationRequest
+	}
+	return nil
+}
+
+func (x *MaintenanceDelegationRequestV01) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// A terminal manager requests to the master terminal manager the delegation of maintenance functions or maintenance operation on the terminal estate managed by the master terminal manager.
+type MaintenanceDelegationRequestV02 struct {
+	state                        protoimpl.MessageState                 `protogen:"open.v1"`
+	Header                       *modelpb.Header29                      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	MaintenanceDelegationRequest *modelpb.MaintenanceDelegationRequest2 `protobuf:"bytes,2,opt,name=maintenance_delegation_request,json=maintenanceDelegationRequest,proto3" json:"maintenance_delegation_request,omitempty"`
+	SecurityTrailer              *modelpb.ContentInformationType12      `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *MaintenanceDelegationRequestV02) Reset() {
+	*x = MaintenanceDelegationRequestV02{}
+	mi := &file_iso20022_catm_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceDelegationRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceDelegationRequestV02) ProtoMessage() {}
+
+func (x *MaintenanceDelegationRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceDelegationRequestV02.ProtoReflect.Descriptor instead.
+func (*MaintenanceDelegationRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MaintenanceDelegationRequestV02) GetHeader() *modelpb.Header29 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *MaintenanceDelegationRequestV02) GetMaintenanceDelegationRequest() *modelpb.MaintenanceDelegationRequest2 {
+	if x != nil {
+		return x.MaintenanceDelegationRequest
+	}
+	return nil
+}
+
+func (x *MaintenanceDelegationRequestV02) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The master terminal manager provides the outcome of a maintenance delegation request to a terminal manager.
+type MaintenanceDelegationResponseV01 struct {
+	state                         protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                        *modelpb.Header16                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	MaintenanceDelegationResponse *modelpb.MaintenanceDelegationResponse1 `protobuf:"bytes,2,opt,name=maintenance_delegation_response,json=maintenanceDelegationResponse,proto3" json:"maintenance_delegation_response,omitempty"`
+	SecurityTrailer               *modelpb.ContentInformationType12       `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *MaintenanceDelegationResponseV01) Reset() {
+	*x = MaintenanceDelegationResponseV01{}
+	mi := &file_iso20022_catm_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceDelegationResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceDelegationResponseV01) ProtoMessage() {}
+
+func (x *MaintenanceDelegationResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceDelegationResponseV01.ProtoReflect.Descriptor instead.
+func (*MaintenanceDelegationResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MaintenanceDelegationResponseV01) GetHeader() *modelpb.Header16 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *MaintenanceDelegationResponseV01) GetMaintenanceDelegationResponse() *modelpb.MaintenanceDelegationResponse1 {
+	if x != nil {
+		return x.MaintenanceDelegationResponse
+	}
+	return nil
+}
+
+func (x *MaintenanceDelegationResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The master terminal manager provides the outcome of a maintenance delegation request to a terminal manager.
+type MaintenanceDelegationResponseV02 struct {
+	state                         protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                        *modelpb.Header29                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	MaintenanceDelegationResponse *modelpb.MaintenanceDelegationResponse2 `protobuf:"bytes,2,opt,name=maintenance_delegation_response,json=maintenanceDelegationResponse,proto3" json:"maintenance_delegation_response,omitempty"`
+	SecurityTrailer               *modelpb.ContentInformationType12       `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *MaintenanceDelegationResponseV02) Reset() {
+	*x = MaintenanceDelegationResponseV02{}
+	mi := &file_iso20022_catm_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceDelegationResponseV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceDelegationResponseV02) ProtoMessage() {}
+
+func (x *MaintenanceDelegationResponseV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceDelegationResponseV02.ProtoReflect.Descriptor instead.
+func (*MaintenanceDelegationResponseV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MaintenanceDelegationResponseV02) GetHeader() *modelpb.Header29 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *MaintenanceDelegationResponseV02) GetMaintenanceDelegationResponse() *modelpb.MaintenanceDelegationResponse2 {
+	if x != nil {
+		return x.MaintenanceDelegationResponse
+	}
+	return nil
+}
+
+func (x *MaintenanceDelegationResponseV02) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The ManagementPlanReplacement message is sent by the master terminal manager or delegated terminal manager to the acceptor system to replace the TMS action list of the POI system.
+// Usage
+// The ManagementPlanReplacement message may embed the information required by the acceptor system for the planning of the TMS actions to be performed by the POI including the trigger, time conditions and TMS addresses.
+type ManagementPlanReplacementV01 struct {
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	Header          *modelpb.Header4                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ManagementPlan  *modelpb.ManagementPlan1         `protobuf:"bytes,2,opt,name=management_plan,json=managementPlan,proto3" json:"management_plan,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType1 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ManagementPlanReplacementV01) Reset() {
+	*x = ManagementPlanReplacementV01{}
+	mi := &file_iso20022_catm_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManagementPlanReplacementV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManagementPlanReplacementV01) ProtoMessage() {}
+
+func (x *ManagementPlanReplacementV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManagementPlanReplacementV01.ProtoReflect.Descriptor instead.
+func (*ManagementPlanReplacementV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ManagementPlanReplacementV01) GetHeader() *modelpb.Header4 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ManagementPlanReplacementV01) GetManagementPlan() *modelpb.ManagementPlan1 {
+	if x != nil {
+		return x.ManagementPlan
+	}
+	return nil
+}
+
+func (x *ManagementPlanReplacementV01) GetSecurityTrailer() *modelpb.ContentInformationType1 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Terminal maintenance actions to be performed by a point of interaction (POI).
+type ManagementPlanReplacementV02 struct {
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	Header          *modelpb.Header4                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ManagementPlan  *modelpb.ManagementPlan2         `protobuf:"bytes,2,opt,name=management_plan,json=managementPlan,proto3" json:"management_plan,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType4 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ManagementPlanReplacementV02) Reset() {
+	*x = ManagementPlanReplacementV02{}
+	mi := &file_iso20022_catm_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManagementPlanReplacementV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManagementPlanReplacementV02) ProtoMessage() {}
+
+func (x *ManagementPlanReplacementV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManagementPlanReplacementV02.ProtoReflect.Descriptor instead.
+func (*ManagementPlanReplacementV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ManagementPlanReplacementV02) GetHeader() *modelpb.Header4 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ManagementPlanReplacementV02) GetManagementPlan() *modelpb.ManagementPlan2 {
+	if x != nil {
+		return x.ManagementPlan
+	}
+	return nil
+}
+
+func (x *ManagementPlanReplacementV02) GetSecurityTrailer() *modelpb.ContentInformationType4 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Terminal maintenance actions to be performed by a point of interaction (POI).
+type ManagementPlanReplacementV03 struct {
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	Header          *modelpb.Header4                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ManagementPlan  *modelpb.ManagementPlan3         `protobuf:"bytes,2,opt,name=management_plan,json=managementPlan,proto3" json:"management_plan,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType9 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ManagementPlanReplacementV03) Reset() {
+	*x = ManagementPlanReplacementV03{}
+	mi := &file_iso20022_catm_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManagementPlanReplacementV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManagementPlanReplacementV03) ProtoMessage() {}
+
+func (x *ManagementPlanReplacementV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManagementPlanReplacementV03.ProtoReflect.Descriptor instead.
+func (*ManagementPlanReplacementV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ManagementPlanReplacementV03) GetHeader() *modelpb.Header4 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ManagementPlanReplacementV03) GetManagementPlan() *modelpb.ManagementPlan3 {
+	if x != nil {
+		return x.ManagementPlan
+	}
+	return nil
+}
+
+func (x *ManagementPlanReplacementV03) GetSecurityTrailer() *modelpb.ContentInformationType9 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Terminal maintenance actions to be performed by a point of interaction (POI).
+type ManagementPlanReplacementV04 struct {
+	state           protoimpl.MessageState            `protogen:"open.v1"`
+	Header          *modelpb.Header14                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ManagementPlan  *modelpb.ManagementPlan4          `protobuf:"bytes,2,opt,name=management_plan,json=managementPlan,proto3" json:"management_plan,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType12 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ManagementPlanReplacementV04) Reset() {
+	*x = ManagementPlanReplacementV04{}
+	mi := &file_iso20022_catm_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManagementPlanReplacementV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManagementPlanReplacementV04) ProtoMessage() {}
+
+func (x *ManagementPlanReplacementV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManagementPlanReplacementV04.ProtoReflect.Descriptor instead.
+func (*ManagementPlanReplacementV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ManagementPlanReplacementV04) GetHeader() *modelpb.Header14 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ManagementPlanReplacementV04) GetManagementPlan() *modelpb.ManagementPlan4 {
+	if x != nil {
+		return x.ManagementPlan
+	}
+	return nil
+}
+
+func (x *ManagementPlanReplacementV04) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Terminal maintenance actions to be performed by a point of interaction (POI).
+type ManagementPlanReplacementV05 struct {
+	state           protoimpl.MessageState            `protogen:"open.v1"`
+	Header          *modelpb.Header27                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ManagementPlan  *modelpb.ManagementPlan5          `protobuf:"bytes,2,opt,name=management_plan,json=managementPlan,proto3" json:"management_plan,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType12 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ManagementPlanReplacementV05) Reset() {
+	*x = ManagementPlanReplacementV05{}
+	mi := &file_iso20022_catm_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManagementPlanReplacementV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManagementPlanReplacementV05) ProtoMessage() {}
+
+func (x *ManagementPlanReplacementV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManagementPlanReplacementV05.ProtoReflect.Descriptor instead.
+func (*ManagementPlanReplacementV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ManagementPlanReplacementV05) GetHeader() *modelpb.Header27 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ManagementPlanReplacementV05) GetManagementPlan() *modelpb.ManagementPlan5 {
+	if x != nil {
+		return x.ManagementPlan
+	}
+	return nil
+}
+
+func (x *ManagementPlanReplacementV05) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Scope
+// The StatusReport message is sent by the card acceptor to the master terminal manager or delegated terminal manager to inform these entities about the status of the acceptor system.
+// Usage
+// The StatusReport message may embed the information required by the master terminal manager or delegated terminal manager for preparing the needed TMS actions in the management plan to be replaced and the acceptor parameters to be updated.
+type StatusReportV01 struct {
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	Header          *modelpb.Header4                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	StatusReport    *modelpb.StatusReport1           `protobuf:"bytes,2,opt,name=status_report,json=statusReport,proto3" json:"status_report,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType1 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StatusReportV01) Reset() {
+	*x = StatusReportV01{}
+	mi := &file_iso20022_catm_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusReportV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusReportV01) ProtoMessage() {}
+
+func (x *StatusReportV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusReportV01.ProtoReflect.Descriptor instead.
+func (*StatusReportV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *StatusReportV01) GetHeader() *modelpb.Header4 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *StatusReportV01) GetStatusReport() *modelpb.StatusReport1 {
+	if x != nil {
+		return x.StatusReport
+	}
+	return nil
+}
+
+func (x *StatusReportV01) GetSecurityTrailer() *modelpb.ContentInformationType1 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Informs the master terminal manager (MTM) or the terminal manager (TM) about the status of the acceptor system including the identification of the POI, its components and their installed versions.
+type StatusReportV02 struct {
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	Header          *modelpb.Header4                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	StatusReport    *modelpb.StatusReport2           `protobuf:"bytes,2,opt,name=status_report,json=statusReport,proto3" json:"status_report,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType4 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StatusReportV02) Reset() {
+	*x = StatusReportV02{}
+	mi := &file_iso20022_catm_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusReportV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusReportV02) ProtoMessage() {}
+
+func (x *StatusReportV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusReportV02.ProtoReflect.Descriptor instead.
+func (*StatusReportV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *StatusReportV02) GetHeader() *modelpb.Header4 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *StatusReportV02) GetStatusReport() *modelpb.StatusReport2 {
+	if x != nil {
+		return x.StatusReport
+	}
+	return nil
+}
+
+func (x *StatusReportV02) GetSecurityTrailer() *modelpb.ContentInformationType4 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Informs the master terminal manager (MTM) or the terminal manager (TM) about the status of the acceptor system including the identification of the POI, its components and their installed versions.
+type StatusReportV03 struct {
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	Header          *modelpb.Header4                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	StatusReport    *modelpb.StatusReport3           `protobuf:"bytes,2,opt,name=status_report,json=statusReport,proto3" json:"status_report,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType9 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StatusReportV03) Reset() {
+	*x = StatusReportV03{}
+	mi := &file_iso20022_catm_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusReportV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusReportV03) ProtoMessage() {}
+
+func (x *StatusReportV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusReportV03.ProtoReflect.Descriptor instead.
+func (*StatusReportV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StatusReportV03) GetHeader() *modelpb.Header4 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *StatusReportV03) GetStatusReport() *modelpb.StatusReport3 {
+	if x != nil {
+		return x.StatusReport
+	}
+	return nil
+}
+
+func (x *StatusReportV03) GetSecurityTrailer() *modelpb.ContentInformationType9 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Informs the master terminal manager (MTM) or the terminal manager (TM) about the status of the acceptor system including the identification of the POI, its components and their installed versions.
+type StatusReportV04 struct {
+	state           protoimpl.MessageState            `protogen:"open.v1"`
+	Header          *modelpb.Header14                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	StatusReport    *modelpb.StatusReport4            `protobuf:"bytes,2,opt,name=status_report,json=statusReport,proto3" json:"status_report,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType12 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StatusReportV04) Reset() {
+	*x = StatusReportV04{}
+	mi := &file_iso20022_catm_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusReportV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusReportV04) ProtoMessage() {}
+
+func (x *StatusReportV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusReportV04.ProtoReflect.Descriptor instead.
+func (*StatusReportV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *StatusReportV04) GetHeader() *modelpb.Header14 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *StatusReportV04) GetStatusReport() *modelpb.StatusReport4 {
+	if x != nil {
+		return x.StatusReport
+	}
+	return nil
+}
+
+func (x *StatusReportV04) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// Informs the master terminal manager (MTM) or the terminal manager (TM) about the status of the acceptor system including the identification of the POI, its components and their installed versions.
+type StatusReportV05 struct {
+	state           protoimpl.MessageState            `protogen:"open.v1"`
+	Header          *modelpb.Header27                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	StatusReport    *modelpb.StatusReport5            `protobuf:"bytes,2,opt,name=status_report,json=statusReport,proto3" json:"status_report,omitempty"`
+	SecurityTrailer *modelpb.ContentInformationType12 `protobuf:"bytes,3,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StatusReportV05) Reset() {
+	*x = StatusReportV05{}
+	mi := &file_iso20022_catm_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusReportV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusReportV05) ProtoMessage() {}
+
+func (x *StatusReportV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusReportV05.ProtoReflect.Descriptor instead.
+func (*StatusReportV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *StatusReportV05) GetHeader() *modelpb.Header27 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *StatusReportV05) GetStatusReport() *modelpb.StatusReport5 {
+	if x != nil {
+		return x.StatusReport
+	}
+	return nil
+}
+
+func (x *StatusReportV05) GetSecurityTrailer() *modelpb.ContentInformationType12 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The TerminalManagementRejection message is sent by the terminal manager to reject a message request sent by an acceptor, to indicate that the received message could not be processed.
+type TerminalManagementRejectionV01 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Header        *modelpb.Header6            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Reject        *modelpb.AcceptorRejection1 `protobuf:"bytes,2,opt,name=reject,proto3" json:"reject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalManagementRejectionV01) Reset() {
+	*x = TerminalManagementRejectionV01{}
+	mi := &file_iso20022_catm_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalManagementRejectionV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalManagementRejectionV01) ProtoMessage() {}
+
+func (x *TerminalManagementRejectionV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalManagementRejectionV01.ProtoReflect.Descriptor instead.
+func (*TerminalManagementRejectionV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TerminalManagementRejectionV01) GetHeader() *modelpb.Header6 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TerminalManagementRejectionV01) GetReject() *modelpb.AcceptorRejection1 {
+	if x != nil {
+		return x.Reject
+	}
+	return nil
+}
+
+// The TerminalManagementRejection message is sent by the terminal manager to reject a message request sent by an acceptor, to indicate that the received message could not be processed.
+type TerminalManagementRejectionV02 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Header        *modelpb.Header6            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Reject        *modelpb.AcceptorRejection2 `protobuf:"bytes,2,opt,name=reject,proto3" json:"reject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalManagementRejectionV02) Reset() {
+	*x = TerminalManagementRejectionV02{}
+	mi := &file_iso20022_catm_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalManagementRejectionV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalManagementRejectionV02) ProtoMessage() {}
+
+func (x *TerminalManagementRejectionV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalManagementRejectionV02.ProtoReflect.Descriptor instead.
+func (*TerminalManagementRejectionV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TerminalManagementRejectionV02) GetHeader() *modelpb.Header6 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TerminalManagementRejectionV02) GetReject() *modelpb.AcceptorRejection2 {
+	if x != nil {
+		return x.Reject
+	}
+	return nil
+}
+
+// The TerminalManagementRejection message is sent by the terminal manager to reject a message request sent by an acceptor, to indicate that the received message could not be processed.
+type TerminalManagementRejectionV03 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Header        *modelpb.Header15           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Reject        *modelpb.AcceptorRejection3 `protobuf:"bytes,2,opt,name=reject,proto3" json:"reject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalManagementRejectionV03) Reset() {
+	*x = TerminalManagementRejectionV03{}
+	mi := &file_iso20022_catm_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalManagementRejectionV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalManagementRejectionV03) ProtoMessage() {}
+
+func (x *TerminalManagementRejectionV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalManagementRejectionV03.ProtoReflect.Descriptor instead.
+func (*TerminalManagementRejectionV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TerminalManagementRejectionV03) GetHeader() *modelpb.Header15 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TerminalManagementRejectionV03) GetReject() *modelpb.AcceptorRejection3 {
+	if x != nil {
+		return x.Reject
+	}
+	return nil
+}
+
+// The TerminalManagementRejection message is sent by the terminal manager to reject a message request sent by an acceptor, to indicate that the received message could not be processed.
+type TerminalManagementRejectionV04 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Header        *modelpb.Header28           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Reject        *modelpb.AcceptorRejection3 `protobuf:"bytes,2,opt,name=reject,proto3" json:"reject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalManagementRejectionV04) Reset() {
+	*x = TerminalManagementRejectionV04{}
+	mi := &file_iso20022_catm_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalManagementRejectionV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalManagementRejectionV04) ProtoMessage() {}
+
+func (x *TerminalManagementRejectionV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catm_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalManagementRejectionV04.ProtoReflect.Descriptor instead.
+func (*TerminalManagementRejectionV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_catm_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TerminalManagementRejectionV04) GetHeader() *modelpb.Header28 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TerminalManagementRejectionV04) GetReject() *modelpb.AcceptorRejection3 {
+	if x != nil {
+		return x.Reject
+	}
+	return nil
+}
+
+var File_iso20022_catm_proto protoreflect.FileDescriptor
+
+var file_iso20022_catm_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x63, 0x61, 0x74, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x63, 0x61, 0x74, 0x6d, 0x1a, 0x14, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x02, 0x0a, 0x1e, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x30, 0x31, 0x12, 0x2f, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x34, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5d,
+	0x0a, 0x16, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x15, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x22, 0x84, 0x02, 0x0a, 0x1e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x56, 0x30, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x34, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x52, 0x15, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x34, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x84, 0x02, 0x0a, 0x1e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x34, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x16,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x33, 0x52, 0x15, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x39, 0x52, 0x0f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22,
+	0x86, 0x02, 0x0a, 0x1e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56,
+	0x30, 0x34, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x34, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x15, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x32, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x86, 0x02, 0x0a, 0x1e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x32, 0x37, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5d, 0x0a,
+	0x16, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x15, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x10,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x32,
+	0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x22, 0x9d, 0x02, 0x0a, 0x1f, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x39, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x73, 0x0a, 0x1e, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x31, 0x52, 0x1c,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x33,
+	0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x22, 0xa1, 0x02, 0x0a, 0x20, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x39,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x76, 0x0a, 0x1f, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x31, 0x52, 0x1d, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x31, 0x33, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x4c, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x30, 0x30, 0x31, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x38, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x4c, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x30, 0x31, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x38, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x4c, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x31,
+	0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x38, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x4c, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x31, 0x30, 0x30,
+	0x31, 0x30, 0x34, 0x12, 0x38, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x63, 0x61, 0x74, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4c, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x31, 0x30, 0x30, 0x31, 0x30,
+	0x35, 0x12, 0x38, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61,
+	0x74, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56,
+	0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x10, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12,
+	0x45, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d,
+	0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x45, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x59, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x32,
+	0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x45, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x34,
+	0x12, 0x45, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74,
+	0x6d, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x34, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x45, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x33, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30,
+	0x31, 0x30, 0x32, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x63, 0x61, 0x74, 0x6d, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x33,
+	0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74,
+	0x6d, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x30, 0x33,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x47, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x30, 0x34, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x34, 0x30,
+	0x30, 0x31, 0x30, 0x32, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x34, 0x30, 0x30, 0x31, 0x30,
+	0x33, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61,
+	0x74, 0x6d, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30,
+	0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x34, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x47,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x30, 0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x48, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x30, 0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x48, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x30, 0x36, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x36, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x5c, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x37,
+	0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x48, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x63, 0x61, 0x74, 0x6d, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x38, 0x30, 0x30,
+	0x31, 0x30, 0x31, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x63, 0x61, 0x74, 0x6d, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9d,
+	0x02, 0x0a, 0x1f, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x36, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x73, 0x0a, 0x1e, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x31, 0x52, 0x1c, 0x6d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x32, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x9d,
+	0x02, 0x0a, 0x1f, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x39, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x73, 0x0a, 0x1e, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x52, 0x1c, 0x6d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x32, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xa1,
+	0x02, 0x0a, 0x20, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x36, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x76, 0x0a, 0x1f, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x1d,
+	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x32, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x22, 0xa1, 0x02, 0x0a, 0x20, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32,
+	0x39, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x76, 0x0a, 0x1f, 0x6d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0x52, 0x1d, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x31, 0x32, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xed, 0x01, 0x0a, 0x1c, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x31, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x34,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x0f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61,
+	0x6e, 0x31, 0x52, 0x0e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x31, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xed, 0x01, 0x0a, 0x1c, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x34,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x0f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61,
+	0x6e, 0x32, 0x52, 0x0e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x34, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xed, 0x01, 0x0a, 0x1c, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x34,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x0f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61,
+	0x6e, 0x33, 0x52, 0x0e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x39, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xef, 0x01, 0x0a, 0x1c, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x34, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31,
+	0x34, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x0f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x34, 0x52, 0x0e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x6c, 0x61, 0x6e, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x32, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xef, 0x01, 0x0a, 0x1c, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x32, 0x37, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x0f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x35, 0x52, 0x0e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x32, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xda, 0x01, 0x0a, 0x0f, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x31, 0x12, 0x2f,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x34, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x42, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x31, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xda, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x34, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0d,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x32, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x52, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x34, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x22, 0xda, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x33, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x34, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0d, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x33, 0x52,
+	0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x52, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x39,
+	0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x22, 0xdc, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x56, 0x30, 0x34, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x34, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x34, 0x52, 0x0c, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x32, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x22, 0xdc, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x56, 0x30, 0x35, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x37, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x35, 0x52, 0x0c, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x32, 0x52, 0x0f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22,
+	0x8d, 0x01, 0x0a, 0x1e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x30, 0x31, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x36, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x22,
+	0x8d, 0x01, 0x0a, 0x1e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x30, 0x32, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x36, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x52, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x22,
+	0x8e, 0x01, 0x0a, 0x1e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x30, 0x33, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x35, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x52, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x22, 0x8e, 0x01, 0x0a, 0x1e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x30, 0x34, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x38, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x52, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x79, 0x75, 0x64, 0x61, 0x70, 0x72, 0x61, 0x6d, 0x61, 0x2f, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2f, 0x63, 0x61, 0x74, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_iso20022_catm_proto_rawDescOnce sync.Once
+	file_iso20022_catm_proto_rawDescData []byte
+)
+
+func file_iso20022_catm_proto_rawDescGZIP() []byte {
+	file_iso20022_catm_proto_rawDescOnce.Do(func() {
+		file_iso20022_catm_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_iso20022_catm_proto_rawDesc), len(file_iso20022_catm_proto_rawDesc)))
+	})
+	return file_iso20022_catm_proto_rawDescData
+}
+
+var file_iso20022_catm_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_iso20022_catm_proto_goTypes = []any{
+	(*AcceptorConfigurationUpdateV01)(nil),         // 0: iso20022.catm.AcceptorConfigurationUpdateV01
+	(*AcceptorConfigurationUpdateV02)(nil),         // 1: iso20022.catm.AcceptorConfigurationUpdateV02
+	(*AcceptorConfigurationUpdateV03)(nil),         // 2: iso20022.catm.AcceptorConfigurationUpdateV03
+	(*AcceptorConfigurationUpdateV04)(nil),         // 3: iso20022.catm.AcceptorConfigurationUpdateV04
+	(*AcceptorConfigurationUpdateV05)(nil),         // 4: iso20022.catm.AcceptorConfigurationUpdateV05
+	(*CertificateManagementRequestV01)(nil),        // 5: iso20022.catm.CertificateManagementRequestV01
+	(*CertificateManagementResponseV01)(nil),       // 6: iso20022.catm.CertificateManagementResponseV01
+	(*Document00100101)(nil),                       // 7: iso20022.catm.Document00100101
+	(*Document00100102)(nil),                       // 8: iso20022.catm.Document00100102
+	(*Document00100103)(nil),                       // 9: iso20022.catm.Document00100103
+	(*Document00100104)(nil),                       // 10: iso20022.catm.Document00100104
+	(*Document00100105)(nil),                       // 11: iso20022.catm.Document00100105
+	(*Document00200101)(nil),                       // 12: iso20022.catm.Document00200101
+	(*Document00200102)(nil),                       // 13: iso20022.catm.Document00200102
+	(*Document00200103)(nil),                       // 14: iso20022.catm.Document00200103
+	(*Document00200104)(nil),                       // 15: iso20022.catm.Document00200104
+	(*Document00200105)(nil),                       // 16: iso20022.catm.Document00200105
+	(*Document00300101)(nil),                       // 17: iso20022.catm.Document00300101
+	(*Document00300102)(nil),                       // 18: iso20022.catm.Document00300102
+	(*Document00300103)(nil),                       // 19: iso20022.catm.Document00300103
+	(*Document00300104)(nil),                       // 20: iso20022.catm.Document00300104
+	(*Document00300105)(nil),                       // 21: iso20022.catm.Document00300105
+	(*Document00400101)(nil),                       // 22: iso20022.catm.Document00400101
+	(*Document00400102)(nil),                       // 23: iso20022.catm.Document00400102
+	(*Document00400103)(nil),                       // 24: iso20022.catm.Document00400103
+	(*Document00400104)(nil),                       // 25: iso20022.catm.Document00400104
+	(*Document00500101)(nil),                       // 26: iso20022.catm.Document00500101
+	(*Document00500102)(nil),                       // 27: iso20022.catm.Document00500102
+	(*Document00600101)(nil),                       // 28: iso20022.catm.Document00600101
+	(*Document00600102)(nil),                       // 29: iso20022.catm.Document00600102
+	(*Document00700101)(nil),                       // 30: iso20022.catm.Document00700101
+	(*Document00800101)(nil),                       // 31: iso20022.catm.Document00800101
+	(*MaintenanceDelegationRequestV01)(nil),        // 32: iso20022.catm.MaintenanceDelegationRequestV01
+	(*MaintenanceDelegationRequestV02)(nil),        // 33: iso20022.catm.MaintenanceDelegationRequestV02
+	(*MaintenanceDelegationResponseV01)(nil),       // 34: iso20022.catm.MaintenanceDelegationResponseV01
+	(*MaintenanceDelegationResponseV02)(nil),       // 35: iso20022.catm.MaintenanceDelegationResponseV02
+	(*ManagementPlanReplacementV01)(nil),           // 36: iso20022.catm.ManagementPlanReplacementV01
+	(*ManagementPlanReplacementV02)(nil),           // 37: iso20022.catm.ManagementPlanReplacementV02
+	(*ManagementPlanReplacementV03)(nil),           // 38: iso20022.catm.ManagementPlanReplacementV03
+	(*ManagementPlanReplacementV04)(nil),           // 39: iso20022.catm.ManagementPlanReplacementV04
+	(*ManagementPlanReplacementV05)(nil),           // 40: iso20022.catm.ManagementPlanReplacementV05
+	(*StatusReportV01)(nil),                        // 41: iso20022.catm.StatusReportV01
+	(*StatusReportV02)(nil),                        // 42: iso20022.catm.StatusReportV02
+	(*StatusReportV03)(nil),                        // 43: iso20022.catm.StatusReportV03
+	(*StatusReportV04)(nil),                        // 44: iso20022.catm.StatusReportV04
+	(*StatusReportV05)(nil),                        // 45: iso20022.catm.StatusReportV05
+	(*TerminalManagementRejectionV01)(nil),         // 46: iso20022.catm.TerminalManagementRejectionV01
+	(*TerminalManagementRejectionV02)(nil),         // 47: iso20022.catm.TerminalManagementRejectionV02
+	(*TerminalManagementRejectionV03)(nil),         // 48: iso20022.catm.TerminalManagementRejectionV03
+	(*TerminalManagementRejectionV04)(nil),         // 49: iso20022.catm.TerminalManagementRejectionV04
+	(*modelpb.Header4)(nil),                        // 50: iso20022.model.Header4
+	(*modelpb.AcceptorConfiguration1)(nil),         // 51: iso20022.model.AcceptorConfiguration1
+	(*modelpb.ContentInformationType1)(nil),        // 52: iso20022.model.ContentInformationType1
+	(*modelpb.AcceptorConfiguration2)(nil),         // 53: iso20022.model.AcceptorConfiguration2
+	(*modelpb.ContentInformationType4)(nil),        // 54: iso20022.model.ContentInformationType4
+	(*modelpb.AcceptorConfiguration3)(nil),         // 55: iso20022.model.AcceptorConfiguration3
+	(*modelpb.ContentInformationType9)(nil),        // 56: iso20022.model.ContentInformationType9
+	(*modelpb.Header14)(nil),                       // 57: iso20022.model.Header14
+	(*modelpb.AcceptorConfiguration4)(nil),         // 58: iso20022.model.AcceptorConfiguration4
+	(*modelpb.ContentInformationType12)(nil),       // 59: iso20022.model.ContentInformationType12
+	(*modelpb.Header27)(nil),                       // 60: iso20022.model.Header27
+	(*modelpb.AcceptorConfiguration5)(nil),         // 61: iso20022.model.AcceptorConfiguration5
+	(*modelpb.Header29)(nil),                       // 62: iso20022.model.Header29
+	(*modelpb.CertificateManagementRequest1)(nil),  // 63: iso20022.model.CertificateManagementRequest1
+	(*modelpb.ContentInformationType13)(nil),       // 64: iso20022.model.ContentInformationType13
+	(*modelpb.CertificateManagementResponse1)(nil), // 65: iso20022.model.CertificateManagementResponse1
+	(*modelpb.Header16)(nil),                       // 66: iso20022.model.Header16
+	(*modelpb.MaintenanceDelegationRequest1)(nil),  // 67: iso20022.model.MaintenanceDelegationRequest1
+	(*modelpb.MaintenanceDelegationRequest2)(nil),  // 68: iso20022.model.MaintenanceDelegationRequest2
+	(*modelpb.MaintenanceDelegationResponse1)(nil), // 69: iso20022.model.MaintenanceDelegationResponse1
+	(*modelpb.MaintenanceDelegationResponse2)(nil), // 70: iso20022.model.MaintenanceDelegationResponse2
+	(*modelpb.ManagementPlan1)(nil),                // 71: iso20022.model.ManagementPlan1
+	(*modelpb.ManagementPlan2)(nil),                // 72: iso20022.model.ManagementPlan2
+	(*modelpb.ManagementPlan3)(nil),                // 73: iso20022.model.ManagementPlan3
+	(*modelpb.ManagementPlan4)(nil),                // 74: iso20022.model.ManagementPlan4
+	(*modelpb.ManagementPlan5)(nil),                // 75: iso20022.model.ManagementPlan5
+	(*modelpb.StatusReport1)(nil),                  // 76: iso20022.model.StatusReport1
+	(*modelpb.StatusReport2)(nil),                  // 77: iso20022.model.StatusReport2
+	(*modelpb.StatusReport3)(nil),                  // 78: iso20022.model.StatusReport3
+	(*modelpb.StatusReport4)(nil),                  // 79: iso20022.model.StatusReport4
+	(*modelpb.StatusReport5)(nil),                  // 80: iso20022.model.StatusReport5
+	(*modelpb.Header6)(nil),                        // 81: iso20022.model.Header6
+	(*modelpb.AcceptorRejection1)(nil),             // 82: iso20022.model.AcceptorRejection1
+	(*modelpb.AcceptorRejection2)(nil),             // 83: iso20022.model.AcceptorRejection2
+	(*modelpb.Header15)(nil),                       // 84: iso20022.model.Header15
+	(*modelpb.AcceptorRejection3)(nil),             // 85: iso20022.model.AcceptorRejection3
+	(*modelpb.Header28)(nil),                       // 86: iso20022.model.Header28
+}
+var file_iso20022_catm_proto_depIdxs = []int32{
+	50, // 0: iso20022.catm.AcceptorConfigurationUpdateV01.header:type_name -> iso20022.model.Header4
+	51, // 1: iso20022.catm.AcceptorConfigurationUpdateV01.acceptor_configuration:type_name -> iso20022.model.AcceptorConfiguration1
+	52, // 2: iso20022.catm.AcceptorConfigurationUpdateV01.security_trailer:type_name -> iso20022.model.ContentInformationType1
+	50, // 3: iso20022.catm.AcceptorConfigurationUpdateV02.header:type_name -> iso20022.model.Header4
+	53, // 4: iso20022.catm.AcceptorConfigurationUpdateV02.acceptor_configuration:type_name -> iso20022.model.AcceptorConfiguration2
+	54, // 5: iso20022.catm.AcceptorConfigurationUpdateV02.security_trailer:type_name -> iso20022.model.ContentInformationType4
+	50, // 6: iso20022.catm.AcceptorConfigurationUpdateV03.header:type_name -> iso20022.model.Header4
+	55, // 7: iso20022.catm.AcceptorConfigurationUpdateV03.acceptor_configuration:type_name -> iso20022.model.AcceptorConfiguration3
+	56, // 8: iso20022.catm.AcceptorConfigurationUpdateV03.security_trailer:type_name -> iso20022.model.ContentInformationType9
+	57, // 9: iso20022.catm.AcceptorConfigurationUpdateV04.header:type_name -> iso20022.model.Header14
+	58, // 10: iso20022.catm.AcceptorConfigurationUpdateV04.acceptor_configuration:type_name -> iso20022.model.AcceptorConfiguration4
+	59, // 11: iso20022.catm.AcceptorConfigurationUpdateV04.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	60, // 12: iso20022.catm.AcceptorConfigurationUpdateV05.header:type_name -> iso20022.model.Header27
+	61, // 13: iso20022.catm.AcceptorConfigurationUpdateV05.acceptor_configuration:type_name -> iso20022.model.AcceptorConfiguration5
+	59, // 14: iso20022.catm.AcceptorConfigurationUpdateV05.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	62, // 15: iso20022.catm.CertificateManagementRequestV01.header:type_name -> iso20022.model.Header29
+	63, // 16: iso20022.catm.CertificateManagementRequestV01.certificate_management_request:type_name -> iso20022.model.CertificateManagementRequest1
+	64, // 17: iso20022.catm.CertificateManagementRequestV01.security_trailer:type_name -> iso20022.model.ContentInformationType13
+	62, // 18: iso20022.catm.CertificateManagementResponseV01.header:type_name -> iso20022.model.Header29
+	65, // 19: iso20022.catm.CertificateManagementResponseV01.certificate_management_response:type_name -> iso20022.model.CertificateManagementResponse1
+	64, // 20: iso20022.catm.CertificateManagementResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType13
+	41, // 21: iso20022.catm.Document00100101.message:type_name -> iso20022.catm.StatusReportV01
+	42, // 22: iso20022.catm.Document00100102.message:type_name -> iso20022.catm.StatusReportV02
+	43, // 23: iso20022.catm.Document00100103.message:type_name -> iso20022.catm.StatusReportV03
+	44, // 24: iso20022.catm.Document00100104.message:type_name -> iso20022.catm.StatusReportV04
+	45, // 25: iso20022.catm.Document00100105.message:type_name -> iso20022.catm.StatusReportV05
+	36, // 26: iso20022.catm.Document00200101.message:type_name -> iso20022.catm.ManagementPlanReplacementV01
+	37, // 27: iso20022.catm.Document00200102.message:type_name -> iso20022.catm.ManagementPlanReplacementV02
+	38, // 28: iso20022.catm.Document00200103.message:type_name -> iso20022.catm.ManagementPlanReplacementV03
+	39, // 29: iso20022.catm.Document00200104.message:type_name -> iso20022.catm.ManagementPlanReplacementV04
+	40, // 30: iso20022.catm.Document00200105.message:type_name -> iso20022.catm.ManagementPlanReplacementV05
+	0,  // 31: iso20022.catm.Document00300101.message:type_name -> iso20022.catm.AcceptorConfigurationUpdateV01
+	1,  // 32: iso20022.catm.Document00300102.message:type_name -> iso20022.catm.AcceptorConfigurationUpdateV02
+	2,  // 33: iso20022.catm.Document00300103.message:type_name -> iso20022.catm.AcceptorConfigurationUpdateV03
+	3,  // 34: iso20022.catm.Document00300104.message:type_name -> iso20022.catm.AcceptorConfigurationUpdateV04
+	4,  // 35: iso20022.catm.Document00300105.message:type_name -> iso20022.catm.AcceptorConfigurationUpdateV05
+	46, // 36: iso20022.catm.Document00400101.message:type_name -> iso20022.catm.TerminalManagementRejectionV01
+	47, // 37: iso20022.catm.Document00400102.message:type_name -> iso20022.catm.TerminalManagementRejectionV02
+	48, // 38: iso20022.catm.Document00400103.message:type_name -> iso20022.catm.TerminalManagementRejectionV03
+	49, // 39: iso20022.catm.Document00400104.message:type_name -> iso20022.catm.TerminalManagementRejectionV04
+	32, // 40: iso20022.catm.Document00500101.message:type_name -> iso20022.catm.MaintenanceDelegationRequestV01
+	33, // 41: iso20022.catm.Document00500102.message:type_name -> iso20022.catm.MaintenanceDelegationRequestV02
+	34, // 42: iso20022.catm.Document00600101.message:type_name -> iso20022.catm.MaintenanceDelegationResponseV01
+	35, // 43: iso20022.catm.Document00600102.message:type_name -> iso20022.catm.MaintenanceDelegationResponseV02
+	5,  // 44: iso20022.catm.Document00700101.message:type_name -> iso20022.catm.CertificateManagementRequestV01
+	6,  // 45: iso20022.catm.Document00800101.message:type_name -> iso20022.catm.CertificateManagementResponseV01
+	66, // 46: iso20022.catm.MaintenanceDelegationRequestV01.header:type_name -> iso20022.model.Header16
+	67, // 47: iso20022.catm.MaintenanceDelegationRequestV01.maintenance_delegation_request:type_name -> iso20022.model.MaintenanceDelegationRequest1
+	59, // 48: iso20022.catm.MaintenanceDelegationRequestV01.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	62, // 49: iso20022.catm.MaintenanceDelegationRequestV02.header:type_name -> iso20022.model.Header29
+	68, // 50: iso20022.catm.MaintenanceDelegationRequestV02.maintenance_delegation_request:type_name -> iso20022.model.MaintenanceDelegationRequest2
+	59, // 51: iso20022.catm.MaintenanceDelegationRequestV02.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	66, // 52: iso20022.catm.MaintenanceDelegationResponseV01.header:type_name -> iso20022.model.Header16
+	69, // 53: iso20022.catm.MaintenanceDelegationResponseV01.maintenance_delegation_response:type_name -> iso20022.model.MaintenanceDelegationResponse1
+	59, // 54: iso20022.catm.MaintenanceDelegationResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	62, // 55: iso20022.catm.MaintenanceDelegationResponseV02.header:type_name -> iso20022.model.Header29
+	70, // 56: iso20022.catm.MaintenanceDelegationResponseV02.maintenance_delegation_response:type_name -> iso20022.model.MaintenanceDelegationResponse2
+	59, // 57: iso20022.catm.MaintenanceDelegationResponseV02.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	50, // 58: iso20022.catm.ManagementPlanReplacementV01.header:type_name -> iso20022.model.Header4
+	71, // 59: iso20022.catm.ManagementPlanReplacementV01.management_plan:type_name -> iso20022.model.ManagementPlan1
+	52, // 60: iso20022.catm.ManagementPlanReplacementV01.security_trailer:type_name -> iso20022.model.ContentInformationType1
+	50, // 61: iso20022.catm.ManagementPlanReplacementV02.header:type_name -> iso20022.model.Header4
+	72, // 62: iso20022.catm.ManagementPlanReplacementV02.management_plan:type_name -> iso20022.model.ManagementPlan2
+	54, // 63: iso20022.catm.ManagementPlanReplacementV02.security_trailer:type_name -> iso20022.model.ContentInformationType4
+	50, // 64: iso20022.catm.ManagementPlanReplacementV03.header:type_name -> iso20022.model.Header4
+	73, // 65: iso20022.catm.ManagementPlanReplacementV03.management_plan:type_name -> iso20022.model.ManagementPlan3
+	56, // 66: iso20022.catm.ManagementPlanReplacementV03.security_trailer:type_name -> iso20022.model.ContentInformationType9
+	57, // 67: iso20022.catm.ManagementPlanReplacementV04.header:type_name -> iso20022.model.Header14
+	74, // 68: iso20022.catm.ManagementPlanReplacementV04.management_plan:type_name -> iso20022.model.ManagementPlan4
+	59, // 69: iso20022.catm.ManagementPlanReplacementV04.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	60, // 70: iso20022.catm.ManagementPlanReplacementV05.header:type_name -> iso20022.model.Header27
+	75, // 71: iso20022.catm.ManagementPlanReplacementV05.management_plan:type_name -> iso20022.model.ManagementPlan5
+	59, // 72: iso20022.catm.ManagementPlanReplacementV05.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	50, // 73: iso20022.catm.StatusReportV01.header:type_name -> iso20022.model.Header4
+	76, // 74: iso20022.catm.StatusReportV01.status_report:type_name -> iso20022.model.StatusReport1
+	52, // 75: iso20022.catm.StatusReportV01.security_trailer:type_name -> iso20022.model.ContentInformationType1
+	50, // 76: iso20022.catm.StatusReportV02.header:type_name -> iso20022.model.Header4
+	77, // 77: iso20022.catm.StatusReportV02.status_report:type_name -> iso20022.model.StatusReport2
+	54, // 78: iso20022.catm.StatusReportV02.security_trailer:type_name -> iso20022.model.ContentInformationType4
+	50, // 79: iso20022.catm.StatusReportV03.header:type_name -> iso20022.model.Header4
+	78, // 80: iso20022.catm.StatusReportV03.status_report:type_name -> iso20022.model.StatusReport3
+	56, // 81: iso20022.catm.StatusReportV03.security_trailer:type_name -> iso20022.model.ContentInformationType9
+	57, // 82: iso20022.catm.StatusReportV04.header:type_name -> iso20022.model.Header14
+	79, // 83: iso20022.catm.StatusReportV04.status_report:type_name -> iso20022.model.StatusReport4
+	59, // 84: iso20022.catm.StatusReportV04.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	60, // 85: iso20022.catm.StatusReportV05.header:type_name -> iso20022.model.Header27
+	80, // 86: iso20022.catm.StatusReportV05.status_report:type_name -> iso20022.model.StatusReport5
+	59, // 87: iso20022.catm.StatusReportV05.security_trailer:type_name -> iso20022.model.ContentInformationType12
+	81, // 88: iso20022.catm.TerminalManagementRejectionV01.header:type_name -> iso20022.model.Header6
+	82, // 89: iso20022.catm.TerminalManagementRejectionV01.reject:type_name -> iso20022.model.AcceptorRejection1
+	81, // 90: iso20022.catm.TerminalManagementRejectionV02.header:type_name -> iso20022.model.Header6
+	83, // 91: iso20022.catm.TerminalManagementRejectionV02.reject:type_name -> iso20022.model.AcceptorRejection2
+	84, // 92: iso20022.catm.TerminalManagementRejectionV03.header:type_name -> iso20022.model.Header15
+	85, // 93: iso20022.catm.TerminalManagementRejectionV03.reject:type_name -> iso20022.model.AcceptorRejection3
+	86, // 94: iso20022.catm.TerminalManagementRejectionV04.header:type_name -> iso20022.model.Header28
+	85, // 95: iso20022.catm.TerminalManagementRejectionV04.reject:type_name -> iso20022.model.AcceptorRejection3
+	96, // [96:96] is the sub-list for method output_type
+	96, // [96:96] is the sub-list for method input_type
+	96, // [96:96] is the sub-list for extension type_name
+	96, // [96:96] is the sub-list for extension extendee
+	0,  // [0:96] is the sub-list for field type_name
+}
+
+func init() { file_iso20022_catm_proto_init() }
+func file_iso20022_catm_proto_init() {
+	if File_iso20022_catm_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_iso20022_catm_proto_rawDesc), len(file_iso20022_catm_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   50,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_iso20022_catm_proto_goTypes,
+		DependencyIndexes: file_iso20022_catm_proto_depIdxs,
+		MessageInfos:      file_iso20022_catm_proto_msgTypes,
+	}.Build()
+	File_iso20022_catm_proto = out.File
+	file_iso20022_catm_proto_goTypes = nil
+	file_iso20022_catm_proto_depIdxs = nil
+}
@@ -0,0 +1,4244 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: iso20022/catp.proto
+
+package catppb
+
+import (
+	modelpb "github.com/yudaprama/iso20022/proto/iso20022/modelpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+// The ATMCompletionAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMCompletionAdvice message.
+type ATMCompletionAcknowledgementV01 struct {
+	state                                 protoimpl.MessageState                 `protogen:"open.v1"`
+	Header                                *modelpb.Header21                      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmCompletionAcknowledgement *modelpb.ContentInformationType10      `protobuf:"bytes,2,opt,name=protected_atm_completion_acknowledgement,json=protectedAtmCompletionAcknowledgement,proto3" json:"protected_atm_completion_acknowledgement,omitempty"`
+	AtmCompletionAcknowledgement          *modelpb.ATMCompletionAcknowledgement1 `protobuf:"bytes,3,opt,name=atm_completion_acknowledgement,json=atmCompletionAcknowledgement,proto3" json:"atm_completion_acknowledgement,omitempty"`
+	SecurityTrailer                       *modelpb.ContentInformationType15      `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                         protoimpl.UnknownFields
+	sizeCache                             protoimpl.SizeCache
+}
+
+func (x *ATMCompletionAcknowledgementV01) Reset() {
+	*x = ATMCompletionAcknowledgementV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMCompletionAcknowledgementV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMCompletionAcknowledgementV01) ProtoMessage() {}
+
+func (x *ATMCompletionAcknowledgementV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMCompletionAcknowledgementV01.ProtoReflect.Descriptor instead.
+func (*ATMCompletionAcknowledgementV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ATMCompletionAcknowledgementV01) GetHeader() *modelpb.Header21 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMCompletionAcknowledgementV01) GetProtectedAtmCompletionAcknowledgement() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmCompletionAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMCompletionAcknowledgementV01) GetAtmCompletionAcknowledgement() *modelpb.ATMCompletionAcknowledgement1 {
+	if x != nil {
+		return x.AtmCompletionAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMCompletionAcknowledgementV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMCompletionAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMCompletionAdvice message.
+type ATMCompletionAcknowledgementV02 struct {
+	state                                 protoimpl.MessageState                 `protogen:"open.v1"`
+	Header                                *modelpb.Header32                      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmCompletionAcknowledgement *modelpb.ContentInformationType10      `protobuf:"bytes,2,opt,name=protected_atm_completion_acknowledgement,json=protectedAtmCompletionAcknowledgement,proto3" json:"protected_atm_completion_acknowledgement,omitempty"`
+	AtmCompletionAcknowledgement          *modelpb.ATMCompletionAcknowledgement2 `protobuf:"bytes,3,opt,name=atm_completion_acknowledgement,json=atmCompletionAcknowledgement,proto3" json:"atm_completion_acknowledgement,omitempty"`
+	SecurityTrailer                       *modelpb.ContentInformationType15      `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                         protoimpl.UnknownFields
+	sizeCache                             protoimpl.SizeCache
+}
+
+func (x *ATMCompletionAcknowledgementV02) Reset() {
+	*x = ATMCompletionAcknowledgementV02{}
+	mi := &file_iso20022_catp_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMCompletionAcknowledgementV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMCompletionAcknowledgementV02) ProtoMessage() {}
+
+func (x *ATMCompletionAcknowledgementV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMCompletionAcknowledgementV02.ProtoReflect.Descriptor instead.
+func (*ATMCompletionAcknowledgementV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ATMCompletionAcknowledgementV02) GetHeader() *modelpb.Header32 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMCompletionAcknowledgementV02) GetProtectedAtmCompletionAcknowledgement() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmCompletionAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMCompletionAcknowledgementV02) GetAtmCompletionAcknowledgement() *modelpb.ATMCompletionAcknowledgement2 {
+	if x != nil {
+		return x.AtmCompletionAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMCompletionAcknowledgementV02) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMCompletionAdvice message is sent by an ATM to an acquirer or its agent to inform of the result of a transaction performed on the ATM.
+type ATMCompletionAdviceV01 struct {
+	state                        protoimpl.MessageState            `protogen:"open.v1"`
+	Header                       *modelpb.Header21                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmCompletionAdvice *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_completion_advice,json=protectedAtmCompletionAdvice,proto3" json:"protected_atm_completion_advice,omitempty"`
+	AtmCompletionAdvice          *modelpb.ATMCompletionAdvice1     `protobuf:"bytes,3,opt,name=atm_completion_advice,json=atmCompletionAdvice,proto3" json:"atm_completion_advice,omitempty"`
+	SecurityTrailer              *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ATMCompletionAdviceV01) Reset() {
+	*x = ATMCompletionAdviceV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMCompletionAdviceV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMCompletionAdviceV01) ProtoMessage() {}
+
+func (x *ATMCompletionAdviceV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMCompletionAdviceV01.ProtoReflect.Descriptor instead.
+func (*ATMCompletionAdviceV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ATMCompletionAdviceV01) GetHeader() *modelpb.Header21 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMCompletionAdviceV01) GetProtectedAtmCompletionAdvice() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmCompletionAdvice
+	}
+	return nil
+}
+
+func (x *ATMCompletionAdviceV01) GetAtmCompletionAdvice() *modelpb.ATMCompletionAdvice1 {
+	if x != nil {
+		return x.AtmCompletionAdvice
+	}
+	return nil
+}
+
+func (x *ATMCompletionAdviceV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMCompletionAdvice message is sent by an ATM to an acquirer or its agent to inform of the result of a transaction performed on the ATM.
+type ATMCompletionAdviceV02 struct {
+	state                        protoimpl.MessageState            `protogen:"open.v1"`
+	Header                       *modelpb.Header32                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmCompletionAdvice *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_completion_advice,json=protectedAtmCompletionAdvice,proto3" json:"protected_atm_completion_advice,omitempty"`
+	AtmCompletionAdvice          *modelpb.ATMCompletionAdvice2     `protobuf:"bytes,3,opt,name=atm_completion_advice,json=atmCompletionAdvice,proto3" json:"atm_completion_advice,omitempty"`
+	SecurityTrailer              *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ATMCompletionAdviceV02) Reset() {
+	*x = ATMCompletionAdviceV02{}
+	mi := &file_iso20022_catp_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMCompletionAdviceV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMCompletionAdviceV02) ProtoMessage() {}
+
+func (x *ATMCompletionAdviceV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMCompletionAdviceV02.ProtoReflect.Descriptor instead.
+func (*ATMCompletionAdviceV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ATMCompletionAdviceV02) GetHeader() *modelpb.Header32 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMCompletionAdviceV02) GetProtectedAtmCompletionAdvice() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmCompletionAdvice
+	}
+	return nil
+}
+
+func (x *ATMCompletionAdviceV02) GetAtmCompletionAdvice() *modelpb.ATMCompletionAdvice2 {
+	if x != nil {
+		return x.AtmCompletionAdvice
+	}
+	return nil
+}
+
+func (x *ATMCompletionAdviceV02) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMDepositCompletionAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMDepositCompletionAdvice message.
+type ATMDepositCompletionAcknowledgementV01 struct {
+	state                                        protoimpl.MessageState                        `protogen:"open.v1"`
+	Header                                       *modelpb.Header32                             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmDepositCompletionAcknowledgement *modelpb.ContentInformationType10             `protobuf:"bytes,2,opt,name=protected_atm_deposit_completion_acknowledgement,json=protectedAtmDepositCompletionAcknowledgement,proto3" json:"protected_atm_deposit_completion_acknowledgement,omitempty"`
+	AtmDepositCompletionAcknowledgement          *modelpb.ATMDepositCompletionAcknowledgement1 `protobuf:"bytes,3,opt,name=atm_deposit_completion_acknowledgement,json=atmDepositCompletionAcknowledgement,proto3" json:"atm_deposit_completion_acknowledgement,omitempty"`
+	SecurityTrailer                              *modelpb.ContentInformationType15             `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                                protoimpl.UnknownFields
+	sizeCache                                    protoimpl.SizeCache
+}
+
+func (x *ATMDepositCompletionAcknowledgementV01) Reset() {
+	*x = ATMDepositCompletionAcknowledgementV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMDepositCompletionAcknowledgementV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMDepositCompletionAcknowledgementV01) ProtoMessage() {}
+
+func (x *ATMDepositCompletionAcknowledgementV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMDepositCompletionAcknowledgementV01.ProtoReflect.Descriptor instead.
+func (*ATMDepositCompletionAcknowledgementV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ATMDepositCompletionAcknowledgementV01) GetHeader() *modelpb.Header32 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMDepositCompletionAcknowledgementV01) GetProtectedAtmDepositCompletionAcknowledgement() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmDepositCompletionAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMDepositCompletionAcknowledgementV01) GetAtmDepositCompletionAcknowledgement() *modelpb.ATMDepositCompletionAcknowledgement1 {
+	if x != nil {
+		return x.AtmDepositCompletionAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMDepositCompletionAcknowledgementV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMDepositCompletionAdvice message is sent by an ATM to an acquirer or its agent to inform of the result of a deposit transaction at an ATM.
+// If the ATM is configured to only send negative completion, a generic completion message should be used instead of ATMCompletionAdvice.
+// This message can be used each time a bundle is put in the ATM safe and/or at the end of a multi bundle deposit.
+type ATMDepositCompletionAdviceV01 struct {
+	state                               protoimpl.MessageState               `protogen:"open.v1"`
+	Header                              *modelpb.Header32                    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmDepositCompletionAdvice *modelpb.ContentInformationType10    `protobuf:"bytes,2,opt,name=protected_atm_deposit_completion_advice,json=protectedAtmDepositCompletionAdvice,proto3" json:"protected_atm_deposit_completion_advice,omitempty"`
+	AtmDepositCompletionAdvice          *modelpb.ATMDepositCompletionAdvice1 `protobuf:"bytes,3,opt,name=atm_deposit_completion_advice,json=atmDepositCompletionAdvice,proto3" json:"atm_deposit_completion_advice,omitempty"`
+	SecurityTrailer                     *modelpb.ContentInformationType15    `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                       protoimpl.UnknownFields
+	sizeCache                           protoimpl.SizeCache
+}
+
+func (x *ATMDepositCompletionAdviceV01) Reset() {
+	*x = ATMDepositCompletionAdviceV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMDepositCompletionAdviceV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMDepositCompletionAdviceV01) ProtoMessage() {}
+
+func (x *ATMDepositCompletionAdviceV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMDepositCompletionAdviceV01.ProtoReflect.Descriptor instead.
+func (*ATMDepositCompletionAdviceV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ATMDepositCompletionAdviceV01) GetHeader() *modelpb.Header32 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMDepositCompletionAdviceV01) GetProtectedAtmDepositCompletionAdvice() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmDepositCompletionAdvice
+	}
+	return nil
+}
+
+func (x *ATMDepositCompletionAdviceV01) GetAtmDepositCompletionAdvice() *modelpb.ATMDepositCompletionAdvice1 {
+	if x != nil {
+		return x.AtmDepositCompletionAdvice
+	}
+	return nil
+}
+
+func (x *ATMDepositCompletionAdviceV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMDepositRequest message is sent by an ATM to an acquirer or its agent to request the approval of a deposit transaction at an ATM, before or after deposit media inside the ATM.
+type ATMDepositRequestV01 struct {
+	state                      protoimpl.MessageState            `protogen:"open.v1"`
+	Header                     *modelpb.Header31                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmDepositRequest *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_deposit_request,json=protectedAtmDepositRequest,proto3" json:"protected_atm_deposit_request,omitempty"`
+	AtmDepositRequest          *modelpb.ATMDepositRequest1       `protobuf:"bytes,3,opt,name=atm_deposit_request,json=atmDepositRequest,proto3" json:"atm_deposit_request,omitempty"`
+	SecurityTrailer            *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ATMDepositRequestV01) Reset() {
+	*x = ATMDepositRequestV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMDepositRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMDepositRequestV01) ProtoMessage() {}
+
+func (x *ATMDepositRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMDepositRequestV01.ProtoReflect.Descriptor instead.
+func (*ATMDepositRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ATMDepositRequestV01) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMDepositRequestV01) GetProtectedAtmDepositRequest() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmDepositRequest
+	}
+	return nil
+}
+
+func (x *ATMDepositRequestV01) GetAtmDepositRequest() *modelpb.ATMDepositRequest1 {
+	if x != nil {
+		return x.AtmDepositRequest
+	}
+	return nil
+}
+
+func (x *ATMDepositRequestV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMDepositResponse message is sent by an ATM manager or its agent to inform the ATM of the approval or decline of the deposit transaction.
+type ATMDepositResponseV01 struct {
+	state                       protoimpl.MessageState            `protogen:"open.v1"`
+	Header                      *modelpb.Header31                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmDepositResponse *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_deposit_response,json=protectedAtmDepositResponse,proto3" json:"protected_atm_deposit_response,omitempty"`
+	AtmDepositResponse          *modelpb.ATMDepositResponse1      `protobuf:"bytes,3,opt,name=atm_deposit_response,json=atmDepositResponse,proto3" json:"atm_deposit_response,omitempty"`
+	SecurityTrailer             *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ATMDepositResponseV01) Reset() {
+	*x = ATMDepositResponseV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMDepositResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMDepositResponseV01) ProtoMessage() {}
+
+func (x *ATMDepositResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMDepositResponseV01.ProtoReflect.Descriptor instead.
+func (*ATMDepositResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ATMDepositResponseV01) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMDepositResponseV01) GetProtectedAtmDepositResponse() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmDepositResponse
+	}
+	return nil
+}
+
+func (x *ATMDepositResponseV01) GetAtmDepositResponse() *modelpb.ATMDepositResponse1 {
+	if x != nil {
+		return x.AtmDepositResponse
+	}
+	return nil
+}
+
+func (x *ATMDepositResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMInquiryRequest message is sent by an ATM to an ATM manager to request information about a customer (for example card, account).
+type ATMInquiryRequestV01 struct {
+	state                      protoimpl.MessageState            `protogen:"open.v1"`
+	Header                     *modelpb.Header20                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmInquiryRequest *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_inquiry_request,json=protectedAtmInquiryRequest,proto3" json:"protected_atm_inquiry_request,omitempty"`
+	AtmInquiryRequest          *modelpb.ATMInquiryRequest1       `protobuf:"bytes,3,opt,name=atm_inquiry_request,json=atmInquiryRequest,proto3" json:"atm_inquiry_request,omitempty"`
+	SecurityTrailer            *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ATMInquiryRequestV01) Reset() {
+	*x = ATMInquiryRequestV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMInquiryRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMInquiryRequestV01) ProtoMessage() {}
+
+func (x *ATMInquiryRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMInquiryRequestV01.ProtoReflect.Descriptor instead.
+func (*ATMInquiryRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ATMInquiryRequestV01) GetHeader() *modelpb.Header20 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMInquiryRequestV01) GetProtectedAtmInquiryRequest() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmInquiryRequest
+	}
+	return nil
+}
+
+func (x *ATMInquiryRequestV01) GetAtmInquiryRequest() *modelpb.ATMInquiryRequest1 {
+	if x != nil {
+		return x.AtmInquiryRequest
+	}
+	return nil
+}
+
+func (x *ATMInquiryRequestV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMInquiryRequest message is sent by an ATM to an ATM manager to request information about a customer (for example card, account).
+type ATMInquiryRequestV02 struct {
+	state                      protoimpl.MessageState            `protogen:"open.v1"`
+	Header                     *modelpb.Header31                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmInquiryRequest *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_inquiry_request,json=protectedAtmInquiryRequest,proto3" json:"protected_atm_inquiry_request,omitempty"`
+	AtmInquiryRequest          *modelpb.ATMInquiryRequest2       `protobuf:"bytes,3,opt,name=atm_inquiry_request,json=atmInquiryRequest,proto3" json:"atm_inquiry_request,omitempty"`
+	SecurityTrailer            *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ATMInquiryRequestV02) Reset() {
+	*x = ATMInquiryRequestV02{}
+	mi := &file_iso20022_catp_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMInquiryRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMInquiryRequestV02) ProtoMessage() {}
+
+func (x *ATMInquiryRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMInquiryRequestV02.ProtoReflect.Descriptor instead.
+func (*ATMInquiryRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ATMInquiryRequestV02) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMInquiryRequestV02) GetProtectedAtmInquiryRequest() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmInquiryRequest
+	}
+	return nil
+}
+
+func (x *ATMInquiryRequestV02) GetAtmInquiryRequest() *modelpb.ATMInquiryRequest2 {
+	if x != nil {
+		return x.AtmInquiryRequest
+	}
+	return nil
+}
+
+func (x *ATMInquiryRequestV02) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMInquiryResponse message is sent by an ATM manager or its agent to the ATM to provide the information and the outcome of the verifications requested in the ATMInquiryRequest.
+type ATMInquiryResponseV01 struct {
+	state                       protoimpl.MessageState            `protogen:"open.v1"`
+	Header                      *modelpb.Header20                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmInquiryResponse *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_inquiry_response,json=protectedAtmInquiryResponse,proto3" json:"protected_atm_inquiry_response,omitempty"`
+	AtmInquiryResponse          *modelpb.ATMInquiryResponse1      `protobuf:"bytes,3,opt,name=atm_inquiry_response,json=atmInquiryResponse,proto3" json:"atm_inquiry_response,omitempty"`
+	SecurityTrailer             *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ATMInquiryResponseV01) Reset() {
+	*x = ATMInquiryResponseV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMInquiryResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMInquiryResponseV01) ProtoMessage() {}
+
+func (x *ATMInquiryResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMInquiryResponseV01.ProtoReflect.Descriptor instead.
+func (*ATMInquiryResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ATMInquiryResponseV01) GetHeader() *modelpb.Header20 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMInquiryResponseV01) GetProtectedAtmInquiryResponse() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmInquiryResponse
+	}
+	return nil
+}
+
+func (x *ATMInquiryResponseV01) GetAtmInquiryResponse() *modelpb.ATMInquiryResponse1 {
+	if x != nil {
+		return x.AtmInquiryResponse
+	}
+	return nil
+}
+
+func (x *ATMInquiryResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMInquiryResponse message is sent by an ATM manager or its agent to the ATM to provide the information and the outcome of the verifications requested in the ATMInquiryRequest.
+type ATMInquiryResponseV02 struct {
+	state                       protoimpl.MessageState            `protogen:"open.v1"`
+	Header                      *modelpb.Header31                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmInquiryResponse *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_inquiry_response,json=protectedAtmInquiryResponse,proto3" json:"protected_atm_inquiry_response,omitempty"`
+	AtmInquiryResponse          *modelpb.ATMInquiryResponse2      `protobuf:"bytes,3,opt,name=atm_inquiry_response,json=atmInquiryResponse,proto3" json:"atm_inquiry_response,omitempty"`
+	SecurityTrailer             *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ATMInquiryResponseV02) Reset() {
+	*x = ATMInquiryResponseV02{}
+	mi := &file_iso20022_catp_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMInquiryResponseV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMInquiryResponseV02) ProtoMessage() {}
+
+func (x *ATMInquiryResponseV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMInquiryResponseV02.ProtoReflect.Descriptor instead.
+func (*ATMInquiryResponseV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ATMInquiryResponseV02) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMInquiryResponseV02) GetProtectedAtmInquiryResponse() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmInquiryResponse
+	}
+	return nil
+}
+
+func (x *ATMInquiryResponseV02) GetAtmInquiryResponse() *modelpb.ATMInquiryResponse2 {
+	if x != nil {
+		return x.AtmInquiryResponse
+	}
+	return nil
+}
+
+func (x *ATMInquiryResponseV02) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMPINManagementRequest message is sent by an ATM to an ATM manager to request an operation on the cardholder PIN.
+type ATMPINManagementRequestV01 struct {
+	state                            protoimpl.MessageState            `protogen:"open.v1"`
+	Header                           *modelpb.Header20                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmpinManagementRequest *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atmpin_management_request,json=protectedAtmpinManagementRequest,proto3" json:"protected_atmpin_management_request,omitempty"`
+	AtmpinManagementRequest          *modelpb.ATMPINManagementRequest1 `protobuf:"bytes,3,opt,name=atmpin_management_request,json=atmpinManagementRequest,proto3" json:"atmpin_management_request,omitempty"`
+	SecurityTrailer                  *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *ATMPINManagementRequestV01) Reset() {
+	*x = ATMPINManagementRequestV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMPINManagementRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMPINManagementRequestV01) ProtoMessage() {}
+
+func (x *ATMPINManagementRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMPINManagementRequestV01.ProtoReflect.Descriptor instead.
+func (*ATMPINManagementRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ATMPINManagementRequestV01) GetHeader() *modelpb.Header20 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMPINManagementRequestV01) GetProtectedAtmpinManagementRequest() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmpinManagementRequest
+	}
+	return nil
+}
+
+func (x *ATMPINManagementRequestV01) GetAtmpinManagementRequest() *modelpb.ATMPINManagementRequest1 {
+	if x != nil {
+		return x.AtmpinManagementRequest
+	}
+	return nil
+}
+
+func (x *ATMPINManagementRequestV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMPINManagementRequest message is sent by an ATM to an ATM manager to request an operation on the cardholder PIN.
+type ATMPINManagementRequestV02 struct {
+	state                            protoimpl.MessageState            `protogen:"open.v1"`
+	Header                           *modelpb.Header31                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmpinManagementRequest *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atmpin_management_request,json=protectedAtmpinManagementRequest,proto3" json:"protected_atmpin_management_request,omitempty"`
+	AtmpinManagementRequest          *modelpb.ATMPINManagementRequest2 `protobuf:"bytes,3,opt,name=atmpin_management_request,json=atmpinManagementRequest,proto3" json:"atmpin_management_request,omitempty"`
+	SecurityTrailer                  *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *ATMPINManagementRequestV02) Reset() {
+	*x = ATMPINManagementRequestV02{}
+	mi := &file_iso20022_catp_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMPINManagementRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMPINManagementRequestV02) ProtoMessage() {}
+
+func (x *ATMPINManagementRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMPINManagementRequestV02.ProtoReflect.Descriptor instead.
+func (*ATMPINManagementRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ATMPINManagementRequestV02) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMPINManagementRequestV02) GetProtectedAtmpinManagementRequest() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmpinManagementRequest
+	}
+	return nil
+}
+
+func (x *ATMPINManagementRequestV02) GetAtmpinManagementRequest() *modelpb.ATMPINManagementRequest2 {
+	if x != nil {
+		return x.AtmpinManagementRequest
+	}
+	return nil
+}
+
+func (x *ATMPINManagementRequestV02) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMPINManagementResponse message is sent by an ATM manager or its agent to the ATM to provide the information and the outcome of the cardholder PIN operation requested in the ATMPINManagementRequest.
+type ATMPINManagementResponseV01 struct {
+	state                             protoimpl.MessageState             `protogen:"open.v1"`
+	Header                            *modelpb.Header20                  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmpinManagementResponse *modelpb.ContentInformationType10  `protobuf:"bytes,2,opt,name=protected_atmpin_management_response,json=protectedAtmpinManagementResponse,proto3" json:"protected_atmpin_management_response,omitempty"`
+	AtmpinManagementResponse          *modelpb.ATMPINManagementResponse1 `protobuf:"bytes,3,opt,name=atmpin_management_response,json=atmpinManagementResponse,proto3" json:"atmpin_management_response,omitempty"`
+	SecurityTrailer                   *modelpb.ContentInformationType15  `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *ATMPINManagementResponseV01) Reset() {
+	*x = ATMPINManagementResponseV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMPINManagementResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMPINManagementResponseV01) ProtoMessage() {}
+
+func (x *ATMPINManagementResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMPINManagementResponseV01.ProtoReflect.Descriptor instead.
+func (*ATMPINManagementResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ATMPINManagementResponseV01) GetHeader() *modelpb.Header20 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMPINManagementResponseV01) GetProtectedAtmpinManagementResponse() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmpinManagementResponse
+	}
+	return nil
+}
+
+func (x *ATMPINManagementResponseV01) GetAtmpinManagementResponse() *modelpb.ATMPINManagementResponse1 {
+	if x != nil {
+		return x.AtmpinManagementResponse
+	}
+	return nil
+}
+
+func (x *ATMPINManagementResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMPINManagementResponse message is sent by an ATM manager or its agent to the ATM to provide the information and the outcome of the cardholder PIN operation requested in the ATMPINManagementRequest.
+type ATMPINManagementResponseV02 struct {
+	state                             protoimpl.MessageState             `protogen:"open.v1"`
+	Header                            *modelpb.Header31                  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmpinManagementResponse *modelpb.ContentInformationType10  `protobuf:"bytes,2,opt,name=protected_atmpin_management_response,json=protectedAtmpinManagementResponse,proto3" json:"protected_atmpin_management_response,omitempty"`
+	AtmpinManagementResponse          *modelpb.ATMPINManagementResponse2 `protobuf:"bytes,3,opt,name=atmpin_management_response,json=atmpinManagementResponse,proto3" json:"atmpin_management_response,omitempty"`
+	SecurityTrailer                   *modelpb.ContentInformationType15  `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *ATMPINManagementResponseV02) Reset() {
+	*x = ATMPINManagementResponseV02{}
+	mi := &file_iso20022_catp_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMPINManagementResponseV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMPINManagementResponseV02) ProtoMessage() {}
+
+func (x *ATMPINManagementResponseV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMPINManagementResponseV02.ProtoReflect.Descriptor instead.
+func (*ATMPINManagementResponseV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ATMPINManagementResponseV02) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMPINManagementResponseV02) GetProtectedAtmpinManagementResponse() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmpinManagementResponse
+	}
+	return nil
+}
+
+func (x *ATMPINManagementResponseV02) GetAtmpinManagementResponse() *modelpb.ATMPINManagementResponse2 {
+	if x != nil {
+		return x.AtmpinManagementResponse
+	}
+	return nil
+}
+
+func (x *ATMPINManagementResponseV02) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMReject message is sent by any entity to reject a received message.
+type ATMRejectV01 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Header        *modelpb.Header22      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AtmReject     *modelpb.ATMReject1    `protobuf:"bytes,2,opt,name=atm_reject,json=atmReject,proto3" json:"atm_reject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ATMRejectV01) Reset() {
+	*x = ATMRejectV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMRejectV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMRejectV01) ProtoMessage() {}
+
+func (x *ATMRejectV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMRejectV01.ProtoReflect.Descriptor instead.
+func (*ATMRejectV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ATMRejectV01) GetHeader() *modelpb.Header22 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMRejectV01) GetAtmReject() *modelpb.ATMReject1 {
+	if x != nil {
+		return x.AtmReject
+	}
+	return nil
+}
+
+// The ATMReject message is sent by any entity to reject a received message.
+type ATMRejectV02 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Header        *modelpb.Header33      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AtmReject     *modelpb.ATMReject2    `protobuf:"bytes,2,opt,name=atm_reject,json=atmReject,proto3" json:"atm_reject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ATMRejectV02) Reset() {
+	*x = ATMRejectV02{}
+	mi := &file_iso20022_catp_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMRejectV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMRejectV02) ProtoMessage() {}
+
+func (x *ATMRejectV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMRejectV02.ProtoReflect.Descriptor instead.
+func (*ATMRejectV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ATMRejectV02) GetHeader() *modelpb.Header33 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMRejectV02) GetAtmReject() *modelpb.ATMReject2 {
+	if x != nil {
+		return x.AtmReject
+	}
+	return nil
+}
+
+// The ATMTransferRequest message is sent by an ATM to an ATM manager to request the approval of a fund transfer at the ATM.
+type ATMTransferRequestV01 struct {
+	state                       protoimpl.MessageState            `protogen:"open.v1"`
+	Header                      *modelpb.Header31                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmTransferRequest *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_transfer_request,json=protectedAtmTransferRequest,proto3" json:"protected_atm_transfer_request,omitempty"`
+	AtmTransferRequest          *modelpb.ATMTransferRequest1      `protobuf:"bytes,3,opt,name=atm_transfer_request,json=atmTransferRequest,proto3" json:"atm_transfer_request,omitempty"`
+	SecurityTrailer             *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ATMTransferRequestV01) Reset() {
+	*x = ATMTransferRequestV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMTransferRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMTransferRequestV01) ProtoMessage() {}
+
+func (x *ATMTransferRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMTransferRequestV01.ProtoReflect.Descriptor instead.
+func (*ATMTransferRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ATMTransferRequestV01) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMTransferRequestV01) GetProtectedAtmTransferRequest() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmTransferRequest
+	}
+	return nil
+}
+
+func (x *ATMTransferRequestV01) GetAtmTransferRequest() *modelpb.ATMTransferRequest1 {
+	if x != nil {
+		return x.AtmTransferRequest
+	}
+	return nil
+}
+
+func (x *ATMTransferRequestV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMTransferResponse message is sent by an acquirer or its agent to inform the ATM of the approval or decline of the transfer transaction.
+type ATMTransferResponseV01 struct {
+	state                        protoimpl.MessageState            `protogen:"open.v1"`
+	Header                       *modelpb.Header31                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmTransferResponse *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_transfer_response,json=protectedAtmTransferResponse,proto3" json:"protected_atm_transfer_response,omitempty"`
+	AtmTransferResponse          *modelpb.ATMTransferResponse1     `protobuf:"bytes,3,opt,name=atm_transfer_response,json=atmTransferResponse,proto3" json:"atm_transfer_response,omitempty"`
+	SecurityTrailer              *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ATMTransferResponseV01) Reset() {
+	*x = ATMTransferResponseV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMTransferResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMTransferResponseV01) ProtoMessage() {}
+
+func (x *ATMTransferResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMTransferResponseV01.ProtoReflect.Descriptor instead.
+func (*ATMTransferResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ATMTransferResponseV01) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMTransferResponseV01) GetProtectedAtmTransferResponse() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmTransferResponse
+	}
+	return nil
+}
+
+func (x *ATMTransferResponseV01) GetAtmTransferResponse() *modelpb.ATMTransferResponse1 {
+	if x != nil {
+		return x.AtmTransferResponse
+	}
+	return nil
+}
+
+func (x *ATMTransferResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMWithdrawalCompletionAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMWithdrawalCompletionAdvice message.
+type ATMWithdrawalCompletionAcknowledgementV01 struct {
+	state                                           protoimpl.MessageState                           `protogen:"open.v1"`
+	Header                                          *modelpb.Header21                                `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmWithdrawalCompletionAcknowledgement *modelpb.ContentInformationType10                `protobuf:"bytes,2,opt,name=protected_atm_withdrawal_completion_acknowledgement,json=protectedAtmWithdrawalCompletionAcknowledgement,proto3" json:"protected_atm_withdrawal_completion_acknowledgement,omitempty"`
+	AtmWithdrawalCompletionAcknowledgement          *modelpb.ATMWithdrawalCompletionAcknowledgement1 `protobuf:"bytes,3,opt,name=atm_withdrawal_completion_acknowledgement,json=atmWithdrawalCompletionAcknowledgement,proto3" json:"atm_withdrawal_completion_acknowledgement,omitempty"`
+	SecurityTrailer                                 *modelpb.ContentInformationType15                `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                                   protoimpl.UnknownFields
+	sizeCache                                       protoimpl.SizeCache
+}
+
+func (x *ATMWithdrawalCompletionAcknowledgementV01) Reset() {
+	*x = ATMWithdrawalCompletionAcknowledgementV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMWithdrawalCompletionAcknowledgementV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMWithdrawalCompletionAcknowledgementV01) ProtoMessage() {}
+
+func (x *ATMWithdrawalCompletionAcknowledgementV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMWithdrawalCompletionAcknowledgementV01.ProtoReflect.Descriptor instead.
+func (*ATMWithdrawalCompletionAcknowledgementV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ATMWithdrawalCompletionAcknowledgementV01) GetHeader() *modelpb.Header21 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalCompletionAcknowledgementV01) GetProtectedAtmWithdrawalCompletionAcknowledgement() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmWithdrawalCompletionAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalCompletionAcknowledgementV01) GetAtmWithdrawalCompletionAcknowledgement() *modelpb.ATMWithdrawalCompletionAcknowledgement1 {
+	if x != nil {
+		return x.AtmWithdrawalCompletionAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalCompletionAcknowledgementV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMWithdrawalCompletionAcknowledgement message is sent by an acquirer or its agent to an ATM to acknowledge the receipt of an ATMWithdrawalCompletionAdvice message.
+type ATMWithdrawalCompletionAcknowledgementV02 struct {
+	state                                           protoimpl.MessageState                           `protogen:"open.v1"`
+	Header                                          *modelpb.Header32                                `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmWithdrawalCompletionAcknowledgement *modelpb.ContentInformationType10                `protobuf:"bytes,2,opt,name=protected_atm_withdrawal_completion_acknowledgement,json=protectedAtmWithdrawalCompletionAcknowledgement,proto3" json:"protected_atm_withdrawal_completion_acknowledgement,omitempty"`
+	AtmWithdrawalCompletionAcknowledgement          *modelpb.ATMWithdrawalCompletionAcknowledgement2 `protobuf:"bytes,3,opt,name=atm_withdrawal_completion_acknowledgement,json=atmWithdrawalCompletionAcknowledgement,proto3" json:"atm_withdrawal_completion_acknowledgement,omitempty"`
+	SecurityTrailer                                 *modelpb.ContentInformationType15                `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                                   protoimpl.UnknownFields
+	sizeCache                                       protoimpl.SizeCache
+}
+
+func (x *ATMWithdrawalCompletionAcknowledgementV02) Reset() {
+	*x = ATMWithdrawalCompletionAcknowledgementV02{}
+	mi := &file_iso20022_catp_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMWithdrawalCompletionAcknowledgementV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMWithdrawalCompletionAcknowledgementV02) ProtoMessage() {}
+
+func (x *ATMWithdrawalCompletionAcknowledgementV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMWithdrawalCompletionAcknowledgementV02.ProtoReflect.Descriptor instead.
+func (*ATMWithdrawalCompletionAcknowledgementV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ATMWithdrawalCompletionAcknowledgementV02) GetHeader() *modelpb.Header32 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalCompletionAcknowledgementV02) GetProtectedAtmWithdrawalCompletionAcknowledgement() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmWithdrawalCompletionAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalCompletionAcknowledgementV02) GetAtmWithdrawalCompletionAcknowledgement() *modelpb.ATMWithdrawalCompletionAcknowledgement2 {
+	if x != nil {
+		return x.AtmWithdrawalCompletionAcknowledgement
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalCompletionAcknowledgementV02) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMWithdrawalCompletionAdvice message is sent by an ATM to an acquirer or its agent to inform of the result of a withdrawal transaction at an ATM.
+// If the ATM is configured to only send negative completion, a generic completion message should be used instead of ATMCompletionAdvice.
+type ATMWithdrawalCompletionAdviceV01 struct {
+	state                                  protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                                 *modelpb.Header21                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmWithdrawalCompletionAdvice *modelpb.ContentInformationType10       `protobuf:"bytes,2,opt,name=protected_atm_withdrawal_completion_advice,json=protectedAtmWithdrawalCompletionAdvice,proto3" json:"protected_atm_withdrawal_completion_advice,omitempty"`
+	AtmWithdrawalCompletionAdvice          *modelpb.ATMWithdrawalCompletionAdvice1 `protobuf:"bytes,3,opt,name=atm_withdrawal_completion_advice,json=atmWithdrawalCompletionAdvice,proto3" json:"atm_withdrawal_completion_advice,omitempty"`
+	SecurityTrailer                        *modelpb.ContentInformationType15       `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
+}
+
+func (x *ATMWithdrawalCompletionAdviceV01) Reset() {
+	*x = ATMWithdrawalCompletionAdviceV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMWithdrawalCompletionAdviceV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMWithdrawalCompletionAdviceV01) ProtoMessage() {}
+
+func (x *ATMWithdrawalCompletionAdviceV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMWithdrawalCompletionAdviceV01.ProtoReflect.Descriptor instead.
+func (*ATMWithdrawalCompletionAdviceV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ATMWithdrawalCompletionAdviceV01) GetHeader() *modelpb.Header21 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalCompletionAdviceV01) GetProtectedAtmWithdrawalCompletionAdvice() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmWithdrawalCompletionAdvice
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalCompletionAdviceV01) GetAtmWithdrawalCompletionAdvice() *modelpb.ATMWithdrawalCompletionAdvice1 {
+	if x != nil {
+		return x.AtmWithdrawalCompletionAdvice
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalCompletionAdviceV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMWithdrawalCompletionAdvice message is sent by an ATM to an acquirer or its agent to inform of the result of a withdrawal transaction at an ATM.
+// If the ATM is configured to only send negative completion, a generic completion message should be used instead of ATMCompletionAdvice.
+type ATMWithdrawalCompletionAdviceV02 struct {
+	state                                  protoimpl.MessageState                  `protogen:"open.v1"`
+	Header                                 *modelpb.Header32                       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmWithdrawalCompletionAdvice *modelpb.ContentInformationType10       `protobuf:"bytes,2,opt,name=protected_atm_withdrawal_completion_advice,json=protectedAtmWithdrawalCompletionAdvice,proto3" json:"protected_atm_withdrawal_completion_advice,omitempty"`
+	AtmWithdrawalCompletionAdvice          *modelpb.ATMWithdrawalCompletionAdvice2 `protobuf:"bytes,3,opt,name=atm_withdrawal_completion_advice,json=atmWithdrawalCompletionAdvice,proto3" json:"atm_withdrawal_completion_advice,omitempty"`
+	SecurityTrailer                        *modelpb.ContentInformationType15       `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
+}
+
+func (x *ATMWithdrawalCompletionAdviceV02) Reset() {
+	*x = ATMWithdrawalCompletionAdviceV02{}
+	mi := &file_iso20022_catp_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMWithdrawalCompletionAdviceV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMWithdrawalCompletionAdviceV02) ProtoMessage() {}
+
+func (x *ATMWithdrawalCompletionAdviceV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMWithdrawalCompletionAdviceV02.ProtoReflect.Descriptor instead.
+func (*ATMWithdrawalCompletionAdviceV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ATMWithdrawalCompletionAdviceV02) GetHeader() *modelpb.Header32 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalCompletionAdviceV02) GetProtectedAtmWithdrawalCompletionAdvice() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmWithdrawalCompletionAdvice
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalCompletionAdviceV02) GetAtmWithdrawalCompletionAdvice() *modelpb.ATMWithdrawalCompletionAdvice2 {
+	if x != nil {
+		return x.AtmWithdrawalCompletionAdvice
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalCompletionAdviceV02) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMWithdrawalRequest message is sent by an ATM to an acquirer or its agent to request the approval of a withdrawal transaction at an ATM.
+type ATMWithdrawalRequestV01 struct {
+	state                         protoimpl.MessageState            `protogen:"open.v1"`
+	Header                        *modelpb.Header20                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmWithdrawalRequest *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_withdrawal_request,json=protectedAtmWithdrawalRequest,proto3" json:"protected_atm_withdrawal_request,omitempty"`
+	AtmWithdrawalRequest          *modelpb.ATMWithdrawalRequest1    `protobuf:"bytes,3,opt,name=atm_withdrawal_request,json=atmWithdrawalRequest,proto3" json:"atm_withdrawal_request,omitempty"`
+	SecurityTrailer               *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *ATMWithdrawalRequestV01) Reset() {
+	*x = ATMWithdrawalRequestV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMWithdrawalRequestV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMWithdrawalRequestV01) ProtoMessage() {}
+
+func (x *ATMWithdrawalRequestV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMWithdrawalRequestV01.ProtoReflect.Descriptor instead.
+func (*ATMWithdrawalRequestV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ATMWithdrawalRequestV01) GetHeader() *modelpb.Header20 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalRequestV01) GetProtectedAtmWithdrawalRequest() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmWithdrawalRequest
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalRequestV01) GetAtmWithdrawalRequest() *modelpb.ATMWithdrawalRequest1 {
+	if x != nil {
+		return x.AtmWithdrawalRequest
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalRequestV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMWithdrawalRequest message is sent by an ATM to an acquirer or its agent to request the approval of a withdrawal transaction at an ATM.
+type ATMWithdrawalRequestV02 struct {
+	state                         protoimpl.MessageState            `protogen:"open.v1"`
+	Header                        *modelpb.Header31                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmWithdrawalRequest *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_withdrawal_request,json=protectedAtmWithdrawalRequest,proto3" json:"protected_atm_withdrawal_request,omitempty"`
+	AtmWithdrawalRequest          *modelpb.ATMWithdrawalRequest2    `protobuf:"bytes,3,opt,name=atm_withdrawal_request,json=atmWithdrawalRequest,proto3" json:"atm_withdrawal_request,omitempty"`
+	SecurityTrailer               *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *ATMWithdrawalRequestV02) Reset() {
+	*x = ATMWithdrawalRequestV02{}
+	mi := &file_iso20022_catp_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMWithdrawalRequestV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMWithdrawalRequestV02) ProtoMessage() {}
+
+func (x *ATMWithdrawalRequestV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMWithdrawalRequestV02.ProtoReflect.Descriptor instead.
+func (*ATMWithdrawalRequestV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ATMWithdrawalRequestV02) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalRequestV02) GetProtectedAtmWithdrawalRequest() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmWithdrawalRequest
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalRequestV02) GetAtmWithdrawalRequest() *modelpb.ATMWithdrawalRequest2 {
+	if x != nil {
+		return x.AtmWithdrawalRequest
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalRequestV02) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMWithdrawalResponse message is sent by an acquirer or its agent to an ATM in response to the ATMWithdrawalRequest to inform the ATM of the approval or decline of the withdrawal transaction.
+type ATMWithdrawalResponseV01 struct {
+	state                          protoimpl.MessageState            `protogen:"open.v1"`
+	Header                         *modelpb.Header20                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmWithdrawalResponse *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_withdrawal_response,json=protectedAtmWithdrawalResponse,proto3" json:"protected_atm_withdrawal_response,omitempty"`
+	AtmWithdrawalResponse          *modelpb.ATMWithdrawalResponse1   `protobuf:"bytes,3,opt,name=atm_withdrawal_response,json=atmWithdrawalResponse,proto3" json:"atm_withdrawal_response,omitempty"`
+	SecurityTrailer                *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *ATMWithdrawalResponseV01) Reset() {
+	*x = ATMWithdrawalResponseV01{}
+	mi := &file_iso20022_catp_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMWithdrawalResponseV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMWithdrawalResponseV01) ProtoMessage() {}
+
+func (x *ATMWithdrawalResponseV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMWithdrawalResponseV01.ProtoReflect.Descriptor instead.
+func (*ATMWithdrawalResponseV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ATMWithdrawalResponseV01) GetHeader() *modelpb.Header20 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalResponseV01) GetProtectedAtmWithdrawalResponse() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmWithdrawalResponse
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalResponseV01) GetAtmWithdrawalResponse() *modelpb.ATMWithdrawalResponse1 {
+	if x != nil {
+		return x.AtmWithdrawalResponse
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalResponseV01) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+// The ATMWithdrawalResponse message is sent by an acquirer or its agent to an ATM in response to the ATMWithdrawalRequest to inform the ATM of the approval or decline of the withdrawal transaction.
+type ATMWithdrawalResponseV02 struct {
+	state                          protoimpl.MessageState            `protogen:"open.v1"`
+	Header                         *modelpb.Header31                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ProtectedAtmWithdrawalResponse *modelpb.ContentInformationType10 `protobuf:"bytes,2,opt,name=protected_atm_withdrawal_response,json=protectedAtmWithdrawalResponse,proto3" json:"protected_atm_withdrawal_response,omitempty"`
+	AtmWithdrawalResponse          *modelpb.ATMWithdrawalResponse2   `protobuf:"bytes,3,opt,name=atm_withdrawal_response,json=atmWithdrawalResponse,proto3" json:"atm_withdrawal_response,omitempty"`
+	SecurityTrailer                *modelpb.ContentInformationType15 `protobuf:"bytes,4,opt,name=security_trailer,json=securityTrailer,proto3" json:"security_trailer,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *ATMWithdrawalResponseV02) Reset() {
+	*x = ATMWithdrawalResponseV02{}
+	mi := &file_iso20022_catp_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ATMWithdrawalResponseV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ATMWithdrawalResponseV02) ProtoMessage() {}
+
+func (x *ATMWithdrawalResponseV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ATMWithdrawalResponseV02.ProtoReflect.Descriptor instead.
+func (*ATMWithdrawalResponseV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ATMWithdrawalResponseV02) GetHeader() *modelpb.Header31 {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalResponseV02) GetProtectedAtmWithdrawalResponse() *modelpb.ContentInformationType10 {
+	if x != nil {
+		return x.ProtectedAtmWithdrawalResponse
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalResponseV02) GetAtmWithdrawalResponse() *modelpb.ATMWithdrawalResponse2 {
+	if x != nil {
+		return x.AtmWithdrawalResponse
+	}
+	return nil
+}
+
+func (x *ATMWithdrawalResponseV02) GetSecurityTrailer() *modelpb.ContentInformationType15 {
+	if x != nil {
+		return x.SecurityTrailer
+	}
+	return nil
+}
+
+type Document00100101 struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Message       *ATMWithdrawalRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100101) Reset() {
+	*x = Document00100101{}
+	mi := &file_iso20022_catp_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100101) ProtoMessage() {}
+
+func (x *Document00100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100101.ProtoReflect.Descriptor instead.
+func (*Document00100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Document00100101) GetMessage() *ATMWithdrawalRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00100102 struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Message       *ATMWithdrawalRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100102) Reset() {
+	*x = Document00100102{}
+	mi := &file_iso20022_catp_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100102) ProtoMessage() {}
+
+func (x *Document00100102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100102.ProtoReflect.Descriptor instead.
+func (*Document00100102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Document00100102) GetMessage() *ATMWithdrawalRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200101 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *ATMWithdrawalResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200101) Reset() {
+	*x = Document00200101{}
+	mi := &file_iso20022_catp_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200101) ProtoMessage() {}
+
+func (x *Document00200101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200101.ProtoReflect.Descriptor instead.
+func (*Document00200101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Document00200101) GetMessage() *ATMWithdrawalResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00200102 struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Message       *ATMWithdrawalResponseV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00200102) Reset() {
+	*x = Document00200102{}
+	mi := &file_iso20022_catp_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00200102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00200102) ProtoMessage() {}
+
+func (x *Document00200102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00200102.ProtoReflect.Descriptor instead.
+func (*Document00200102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Document00200102) GetMessage() *ATMWithdrawalResponseV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300101 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *ATMWithdrawalCompletionAdviceV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300101) Reset() {
+	*x = Document00300101{}
+	mi := &file_iso20022_catp_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300101) ProtoMessage() {}
+
+func (x *Document00300101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300101.ProtoReflect.Descriptor instead.
+func (*Document00300101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Document00300101) GetMessage() *ATMWithdrawalCompletionAdviceV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300102 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *ATMWithdrawalCompletionAdviceV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300102) Reset() {
+	*x = Document00300102{}
+	mi := &file_iso20022_catp_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300102) ProtoMessage() {}
+
+func (x *Document00300102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300102.ProtoReflect.Descriptor instead.
+func (*Document00300102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Document00300102) GetMessage() *ATMWithdrawalCompletionAdviceV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400101 struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	Message       *ATMWithdrawalCompletionAcknowledgementV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400101) Reset() {
+	*x = Document00400101{}
+	mi := &file_iso20022_catp_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400101) ProtoMessage() {}
+
+func (x *Document00400101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400101.ProtoReflect.Descriptor instead.
+func (*Document00400101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Document00400101) GetMessage() *ATMWithdrawalCompletionAcknowledgementV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400102 struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	Message       *ATMWithdrawalCompletionAcknowledgementV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400102) Reset() {
+	*x = Document00400102{}
+	mi := &file_iso20022_catp_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400102) ProtoMessage() {}
+
+func (x *Document00400102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400102.ProtoReflect.Descriptor instead.
+func (*Document00400102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Document00400102) GetMessage() *ATMWithdrawalCompletionAcknowledgementV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ATMRejectV01          `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500101) Reset() {
+	*x = Document00500101{}
+	mi := &file_iso20022_catp_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500101) ProtoMessage() {}
+
+func (x *Document00500101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500101.ProtoReflect.Descriptor instead.
+func (*Document00500101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Document00500101) GetMessage() *ATMRejectV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500102 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ATMRejectV02          `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500102) Reset() {
+	*x = Document00500102{}
+	mi := &file_iso20022_catp_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500102) ProtoMessage() {}
+
+func (x *Document00500102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500102.ProtoReflect.Descriptor instead.
+func (*Document00500102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Document00500102) GetMessage() *ATMRejectV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ATMInquiryRequestV01  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600101) Reset() {
+	*x = Document00600101{}
+	mi := &file_iso20022_catp_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600101) ProtoMessage() {}
+
+func (x *Document00600101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600101.ProtoReflect.Descriptor instead.
+func (*Document00600101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Document00600101) GetMessage() *ATMInquiryRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600102 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ATMInquiryRequestV02  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600102) Reset() {
+	*x = Document00600102{}
+	mi := &file_iso20022_catp_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600102) ProtoMessage() {}
+
+func (x *Document00600102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600102.ProtoReflect.Descriptor instead.
+func (*Document00600102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Document00600102) GetMessage() *ATMInquiryRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00700101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ATMInquiryResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00700101) Reset() {
+	*x = Document00700101{}
+	mi := &file_iso20022_catp_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00700101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00700101) ProtoMessage() {}
+
+func (x *Document00700101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00700101.ProtoReflect.Descriptor instead.
+func (*Document00700101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Document00700101) GetMessage() *ATMInquiryResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00700102 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ATMInquiryResponseV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00700102) Reset() {
+	*x = Document00700102{}
+	mi := &file_iso20022_catp_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00700102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00700102) ProtoMessage() {}
+
+func (x *Document00700102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00700102.ProtoReflect.Descriptor instead.
+func (*Document00700102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Document00700102) GetMessage() *ATMInquiryResponseV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800101 struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Message       *ATMCompletionAdviceV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800101) Reset() {
+	*x = Document00800101{}
+	mi := &file_iso20022_catp_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800101) ProtoMessage() {}
+
+func (x *Document00800101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800101.ProtoReflect.Descriptor instead.
+func (*Document00800101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Document00800101) GetMessage() *ATMCompletionAdviceV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800102 struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Message       *ATMCompletionAdviceV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800102) Reset() {
+	*x = Document00800102{}
+	mi := &file_iso20022_catp_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800102) ProtoMessage() {}
+
+func (x *Document00800102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800102.ProtoReflect.Descriptor instead.
+func (*Document00800102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Document00800102) GetMessage() *ATMCompletionAdviceV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900101 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *ATMCompletionAcknowledgementV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900101) Reset() {
+	*x = Document00900101{}
+	mi := &file_iso20022_catp_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900101) ProtoMessage() {}
+
+func (x *Document00900101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900101.ProtoReflect.Descriptor instead.
+func (*Document00900101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Document00900101) GetMessage() *ATMCompletionAcknowledgementV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900102 struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Message       *ATMCompletionAcknowledgementV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900102) Reset() {
+	*x = Document00900102{}
+	mi := &file_iso20022_catp_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900102) ProtoMessage() {}
+
+func (x *Document00900102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900102.ProtoReflect.Descriptor instead.
+func (*Document00900102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Document00900102) GetMessage() *ATMCompletionAcknowledgementV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000101 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Message       *ATMPINManagementRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000101) Reset() {
+	*x = Document01000101{}
+	mi := &file_iso20022_catp_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000101) ProtoMessage() {}
+
+func (x *Document01000101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000101.ProtoReflect.Descriptor instead.
+func (*Document01000101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Document01000101) GetMessage() *ATMPINManagementRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000102 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Message       *ATMPINManagementRequestV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000102) Reset() {
+	*x = Document01000102{}
+	mi := &file_iso20022_catp_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000102) ProtoMessage() {}
+
+func (x *Document01000102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000102.ProtoReflect.Descriptor instead.
+func (*Document01000102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Document01000102) GetMessage() *ATMPINManagementRequestV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01100101 struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Message       *ATMPINManagementResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01100101) Reset() {
+	*x = Document01100101{}
+	mi := &file_iso20022_catp_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01100101) ProtoMessage() {}
+
+func (x *Document01100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01100101.ProtoReflect.Descriptor instead.
+func (*Document01100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Document01100101) GetMessage() *ATMPINManagementResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01100102 struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Message       *ATMPINManagementResponseV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01100102) Reset() {
+	*x = Document01100102{}
+	mi := &file_iso20022_catp_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01100102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01100102) ProtoMessage() {}
+
+func (x *Document01100102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01100102.ProtoReflect.Descriptor instead.
+func (*Document01100102) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Document01100102) GetMessage() *ATMPINManagementResponseV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01200101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ATMDepositRequestV01  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01200101) Reset() {
+	*x = Document01200101{}
+	mi := &file_iso20022_catp_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01200101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01200101) ProtoMessage() {}
+
+func (x *Document01200101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01200101.ProtoReflect.Descriptor instead.
+func (*Document01200101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Document01200101) GetMessage() *ATMDepositRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01300101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ATMDepositResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01300101) Reset() {
+	*x = Document01300101{}
+	mi := &file_iso20022_catp_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01300101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01300101) ProtoMessage() {}
+
+func (x *Document01300101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01300101.ProtoReflect.Descriptor instead.
+func (*Document01300101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Document01300101) GetMessage() *ATMDepositResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01400101 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *ATMDepositCompletionAdviceV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01400101) Reset() {
+	*x = Document01400101{}
+	mi := &file_iso20022_catp_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01400101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01400101) ProtoMessage() {}
+
+func (x *Document01400101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01400101.ProtoReflect.Descriptor instead.
+func (*Document01400101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Document01400101) GetMessage() *ATMDepositCompletionAdviceV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01500101 struct {
+	state         protoimpl.MessageState                  `protogen:"open.v1"`
+	Message       *ATMDepositCompletionAcknowledgementV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01500101) Reset() {
+	*x = Document01500101{}
+	mi := &file_iso20022_catp_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01500101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01500101) ProtoMessage() {}
+
+func (x *Document01500101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01500101.ProtoReflect.Descriptor instead.
+func (*Document01500101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Document01500101) GetMessage() *ATMDepositCompletionAcknowledgementV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01600101 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ATMTransferRequestV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01600101) Reset() {
+	*x = Document01600101{}
+	mi := &file_iso20022_catp_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01600101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01600101) ProtoMessage() {}
+
+func (x *Document01600101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01600101.ProtoReflect.Descriptor instead.
+func (*Document01600101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Document01600101) GetMessage() *ATMTransferRequestV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01700101 struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Message       *ATMTransferResponseV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01700101) Reset() {
+	*x = Document01700101{}
+	mi := &file_iso20022_catp_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01700101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01700101) ProtoMessage() {}
+
+func (x *Document01700101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_catp_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01700101.ProtoReflect.Descriptor instead.
+func (*Document01700101) Descriptor() ([]byte, []int) {
+	return file_iso20022_catp_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Document01700101) GetMessage() *ATMTransferResponseV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+var File_iso20022_catp_proto protoreflect.FileDescriptor
+
+var file_iso20022_catp_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x63, 0x61, 0x74, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x63, 0x61, 0x74, 0x70, 0x1a, 0x14, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x03, 0x0a, 0x1f, 0x41,
+	0x54, 0x4d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x31, 0x12, 0x30,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x81, 0x01, 0x0a, 0x28, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x6d, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x25, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x73, 0x0a, 0x1e, 0x61, 0x74, 0x6d, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54,
+	0x4d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x52, 0x1c, 0x61, 0x74, 0x6d,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xa1,
+	0x03, 0x0a, 0x1f, 0x41, 0x54, 0x4d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56,
+	0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x32, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x81, 0x01, 0x0a, 0x28, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x30, 0x52, 0x25, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x73, 0x0a, 0x1e, 0x61, 0x74, 0x6d, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0x52,
+	0x1c, 0x61, 0x74, 0x6d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x53, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x22, 0xea, 0x02, 0x0a, 0x16, 0x41, 0x54, 0x4d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x6f, 0x0a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x31, 0x30, 0x52, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x58, 0x0a, 0x15, 0x61, 0x74, 0x6d, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x54, 0x4d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x31, 0x52, 0x13, 0x61, 0x74, 0x6d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22,
+	0xea, 0x02, 0x0a, 0x16, 0x41, 0x54, 0x4d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x33, 0x32, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6f, 0x0a, 0x1f,
+	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52,
+	0x1c, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x58, 0x0a,
+	0x15, 0x61, 0x74, 0x6d, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54,
+	0x4d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63,
+	0x65, 0x32, 0x52, 0x13, 0x61, 0x74, 0x6d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xce, 0x03, 0x0a,
+	0x26, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33,
+	0x32, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x90, 0x01, 0x0a, 0x30, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x2c,
+	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x89, 0x01, 0x0a,
+	0x26, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
+	0x54, 0x4d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x31, 0x52, 0x23, 0x61, 0x74, 0x6d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x96, 0x03,
+	0x0a, 0x1d, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x31, 0x12,
+	0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x32, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x7e, 0x0a, 0x27, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x6d, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x23, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x6e, 0x0a, 0x1d, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x31, 0x52, 0x1a, 0x61, 0x74, 0x6d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xde, 0x02, 0x0a, 0x14, 0x41, 0x54, 0x4d, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12,
+	0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x6b, 0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x6d, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x31, 0x30, 0x52, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52,
+	0x0a, 0x13, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x31, 0x52,
+	0x11, 0x61, 0x74, 0x6d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xe4, 0x02, 0x0a, 0x15, 0x41, 0x54, 0x4d, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30,
+	0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x6d, 0x0a, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x6d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x61, 0x74, 0x6d, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x12, 0x61, 0x74, 0x6d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xde,
+	0x02, 0x0a, 0x14, 0x41, 0x54, 0x4d, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32,
+	0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6b, 0x0a, 0x1d, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x69, 0x6e, 0x71, 0x75, 0x69,
+	0x72, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x1a, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x13, 0x61, 0x74, 0x6d, 0x5f, 0x69, 0x6e,
+	0x71, 0x75, 0x69, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x31, 0x52, 0x11, 0x61, 0x74, 0x6d, 0x49, 0x6e, 0x71, 0x75,
+	0x69, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22,
+	0xde, 0x02, 0x0a, 0x14, 0x41, 0x54, 0x4d, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x33, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6b, 0x0a, 0x1d, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x69, 0x6e, 0x71, 0x75,
+	0x69, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x1a, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x13, 0x61, 0x74, 0x6d, 0x5f, 0x69,
+	0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x52, 0x11, 0x61, 0x74, 0x6d, 0x49, 0x6e, 0x71,
+	0x75, 0x69, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x22, 0xe4, 0x02, 0x0a, 0x15, 0x41, 0x54, 0x4d, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x32, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6d, 0x0a, 0x1e,
+	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x69, 0x6e,
+	0x71, 0x75, 0x69, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x1b,
+	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x49, 0x6e, 0x71, 0x75,
+	0x69, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x61,
+	0x74, 0x6d, 0x5f, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x49, 0x6e,
+	0x71, 0x75, 0x69, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x12,
+	0x61, 0x74, 0x6d, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xe4, 0x02, 0x0a, 0x15, 0x41, 0x54, 0x4d, 0x49,
+	0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30,
+	0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x6d, 0x0a, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x6d, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x61, 0x74, 0x6d, 0x5f, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72,
+	0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x52, 0x12, 0x61, 0x74, 0x6d, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x82,
+	0x03, 0x0a, 0x1a, 0x41, 0x54, 0x4d, 0x50, 0x49, 0x4e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x77, 0x0a, 0x23, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d,
+	0x70, 0x69, 0x6e, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x20, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x6d, 0x70, 0x69, 0x6e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x64, 0x0a, 0x19, 0x61, 0x74, 0x6d, 0x70,
+	0x69, 0x6e, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d,
+	0x50, 0x49, 0x4e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x31, 0x52, 0x17, 0x61, 0x74, 0x6d, 0x70, 0x69, 0x6e, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53,
+	0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69,
+	0x6c, 0x65, 0x72, 0x22, 0x82, 0x03, 0x0a, 0x1a, 0x41, 0x54, 0x4d, 0x50, 0x49, 0x4e, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x31, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x77, 0x0a, 0x23, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x6d, 0x70, 0x69, 0x6e, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x20, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x70, 0x69, 0x6e, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x64, 0x0a,
+	0x19, 0x61, 0x74, 0x6d, 0x70, 0x69, 0x6e, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x50, 0x49, 0x4e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x52, 0x17, 0x61, 0x74, 0x6d, 0x70,
+	0x69, 0x6e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x88, 0x03, 0x0a, 0x1b, 0x41, 0x54, 0x4d,
+	0x50, 0x49, 0x4e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x32, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x79, 0x0a, 0x24, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x70, 0x69, 0x6e, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x31, 0x30, 0x52, 0x21, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d,
+	0x70, 0x69, 0x6e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x1a, 0x61, 0x74, 0x6d, 0x70, 0x69, 0x6e, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x50, 0x49,
+	0x4e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x31, 0x52, 0x18, 0x61, 0x74, 0x6d, 0x70, 0x69, 0x6e, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
+	0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69,
+	0x6c, 0x65, 0x72, 0x22, 0x88, 0x03, 0x0a, 0x1b, 0x41, 0x54, 0x4d, 0x50, 0x49, 0x4e, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x31, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x79, 0x0a, 0x24, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x70, 0x69, 0x6e, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x21, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x70, 0x69, 0x6e, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x67, 0x0a, 0x1a, 0x61, 0x74, 0x6d, 0x70, 0x69, 0x6e, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x50, 0x49, 0x4e, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x52,
+	0x18, 0x61, 0x74, 0x6d, 0x70, 0x69, 0x6e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0x7b,
+	0x0a, 0x0c, 0x41, 0x54, 0x4d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x30, 0x31, 0x12, 0x30,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x32, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x39, 0x0a, 0x0a, 0x61, 0x74, 0x6d, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x31,
+	0x52, 0x09, 0x61, 0x74, 0x6d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7b, 0x0a, 0x0c, 0x41,
+	0x54, 0x4d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x33, 0x33, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a,
+	0x0a, 0x61, 0x74, 0x6d, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x32, 0x52, 0x09, 0x61,
+	0x74, 0x6d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xe4, 0x02, 0x0a, 0x15, 0x41, 0x54, 0x4d,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x31, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x6d, 0x0a, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x14, 0x61, 0x74, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x31, 0x52, 0x12, 0x61, 0x74, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22,
+	0xea, 0x02, 0x0a, 0x16, 0x41, 0x54, 0x4d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x33, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6f, 0x0a, 0x1f,
+	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52,
+	0x1c, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a,
+	0x15, 0x61, 0x74, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54,
+	0x4d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x31, 0x52, 0x13, 0x61, 0x74, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xe0, 0x03, 0x0a,
+	0x29, 0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x32, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x96, 0x01, 0x0a,
+	0x33, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x31, 0x30, 0x52, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x92, 0x01, 0x0a, 0x29, 0x61, 0x74, 0x6d, 0x5f, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x31, 0x52, 0x26, 0x61, 0x74, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22,
+	0xe0, 0x03, 0x0a, 0x29, 0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x32, 0x12, 0x30, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x32, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x96, 0x01, 0x0a, 0x33, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x6d, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x92, 0x01, 0x0a, 0x29, 0x61, 0x74, 0x6d,
+	0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54,
+	0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x32, 0x52, 0x26, 0x61, 0x74, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x53, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x22, 0xa9, 0x03, 0x0a, 0x20, 0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32,
+	0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x84, 0x01, 0x0a, 0x2a, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x26, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x77, 0x0a, 0x20, 0x61, 0x74, 0x6d, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x31, 0x52, 0x1d, 0x61, 0x74, 0x6d, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xa9,
+	0x03, 0x0a, 0x20, 0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65,
+	0x56, 0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x32, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x84, 0x01, 0x0a, 0x2a, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x31, 0x30, 0x52, 0x26, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x77, 0x0a, 0x20,
+	0x61, 0x74, 0x6d, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x64, 0x76, 0x69, 0x63, 0x65, 0x32, 0x52, 0x1d, 0x61, 0x74, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xf0, 0x02, 0x0a, 0x17, 0x41,
+	0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x30,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x71, 0x0a, 0x20, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x1d, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x16, 0x61,
+	0x74, 0x6d, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x31, 0x52, 0x14, 0x61, 0x74, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xf0, 0x02,
+	0x0a, 0x17, 0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x33, 0x31, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x71, 0x0a, 0x20, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52,
+	0x1d, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5b,
+	0x0a, 0x16, 0x61, 0x74, 0x6d, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x32, 0x52, 0x14, 0x61, 0x74, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x22, 0xf6, 0x02, 0x0a, 0x18, 0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x12, 0x30, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x30, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x73, 0x0a, 0x21, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d,
+	0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x31, 0x30, 0x52, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x17, 0x61, 0x74, 0x6d, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x15, 0x61,
+	0x74, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x22, 0xf6, 0x02, 0x0a, 0x18, 0x41, 0x54,
+	0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x30, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x33, 0x31,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x73, 0x0a, 0x21, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31, 0x30, 0x52, 0x1e, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a,
+	0x17, 0x61, 0x74, 0x6d, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x52, 0x15, 0x61, 0x74, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x35, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x22, 0x54, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x31, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x40, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x54, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x31, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x40, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54,
+	0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x55,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31,
+	0x30, 0x31, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63,
+	0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x55, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x30, 0x30, 0x32, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x31,
+	0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74,
+	0x70, 0x2e, 0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56,
+	0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12,
+	0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70,
+	0x2e, 0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30,
+	0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x66, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x34, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x52,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x38, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e,
+	0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x66, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x34, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x52, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30,
+	0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x49, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x35,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e,
+	0x41, 0x54, 0x4d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x49, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x30, 0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x51, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x36, 0x30,
+	0x30, 0x31, 0x30, 0x31, 0x12, 0x3d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x51, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x30, 0x36, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x3d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x49, 0x6e, 0x71, 0x75,
+	0x69, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x37, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x3e, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x49,
+	0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30,
+	0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x37, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x3e,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e,
+	0x41, 0x54, 0x4d, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x53,
+	0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x38, 0x30, 0x30, 0x31,
+	0x30, 0x31, 0x12, 0x3f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63,
+	0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x53, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x30, 0x38, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x3f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x56, 0x30, 0x32, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x39, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x48, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54,
+	0x4d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x39, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x48, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x31, 0x30, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x43, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x50, 0x49, 0x4e,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x30, 0x30, 0x30, 0x31, 0x30,
+	0x32, 0x12, 0x43, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61,
+	0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x50, 0x49, 0x4e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x31, 0x31, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x44, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x50,
+	0x49, 0x4e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x58, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x31, 0x30,
+	0x30, 0x31, 0x30, 0x32, 0x12, 0x44, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x50, 0x49, 0x4e, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30,
+	0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x51, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x32, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x3d,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e,
+	0x41, 0x54, 0x4d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x33, 0x30, 0x30, 0x31, 0x30,
+	0x31, 0x12, 0x3e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61,
+	0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x34,
+	0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x76, 0x69, 0x63,
+	0x65, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x63, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x35, 0x30, 0x30, 0x31, 0x30,
+	0x31, 0x12, 0x4f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61,
+	0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x52, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31,
+	0x36, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x3e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x53, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x31, 0x37, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x3f, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x61, 0x74, 0x70, 0x2e, 0x41, 0x54, 0x4d, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x30, 0x31, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x35, 0x5a, 0x33, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x75, 0x64, 0x61, 0x70, 0x72,
+	0x61, 0x6d, 0x61, 0x2f, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x63, 0x61, 0x74, 0x70,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_iso20022_catp_proto_rawDescOnce sync.Once
+	file_iso20022_catp_proto_rawDescData []byte
+)
+
+func file_iso20022_catp_proto_rawDescGZIP() []byte {
+	file_iso20022_catp_proto_rawDescOnce.Do(func() {
+		file_iso20022_catp_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_iso20022_catp_proto_rawDesc), len(file_iso20022_catp_proto_rawDesc)))
+	})
+	return file_iso20022_catp_proto_rawDescData
+}
+
+var file_iso20022_catp_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
+var file_iso20022_catp_proto_goTypes = []any{
+	(*ATMCompletionAcknowledgementV01)(nil),                 // 0: iso20022.catp.ATMCompletionAcknowledgementV01
+	(*ATMCompletionAcknowledgementV02)(nil),                 // 1: iso20022.catp.ATMCompletionAcknowledgementV02
+	(*ATMCompletionAdviceV01)(nil),                          // 2: iso20022.catp.ATMCompletionAdviceV01
+	(*ATMCompletionAdviceV02)(nil),                          // 3: iso20022.catp.ATMCompletionAdviceV02
+	(*ATMDepositCompletionAcknowledgementV01)(nil),          // 4: iso20022.catp.ATMDepositCompletionAcknowledgementV01
+	(*ATMDepositCompletionAdviceV01)(nil),                   // 5: iso20022.catp.ATMDepositCompletionAdviceV01
+	(*ATMDepositRequestV01)(nil),                            // 6: iso20022.catp.ATMDepositRequestV01
+	(*ATMDepositResponseV01)(nil),                           // 7: iso20022.catp.ATMDepositResponseV01
+	(*ATMInquiryRequestV01)(nil),                            // 8: iso20022.catp.ATMInquiryRequestV01
+	(*ATMInquiryRequestV02)(nil),                            // 9: iso20022.catp.ATMInquiryRequestV02
+	(*ATMInquiryResponseV01)(nil),                           // 10: iso20022.catp.ATMInquiryResponseV01
+	(*ATMInquiryResponseV02)(nil),                           // 11: iso20022.catp.ATMInquiryResponseV02
+	(*ATMPINManagementRequestV01)(nil),                      // 12: iso20022.catp.ATMPINManagementRequestV01
+	(*ATMPINManagementRequestV02)(nil),                      // 13: iso20022.catp.ATMPINManagementRequestV02
+	(*ATMPINManagementResponseV01)(nil),                     // 14: iso20022.catp.ATMPINManagementResponseV01
+	(*ATMPINManagementResponseV02)(nil),                     // 15: iso20022.catp.ATMPINManagementResponseV02
+	(*ATMRejectV01)(nil),                                    // 16: iso20022.catp.ATMRejectV01
+	(*ATMRejectV02)(nil),                                    // 17: iso20022.catp.ATMRejectV02
+	(*ATMTransferRequestV01)(nil),                           // 18: iso20022.catp.ATMTransferRequestV01
+	(*ATMTransferResponseV01)(nil),                          // 19: iso20022.catp.ATMTransferResponseV01
+	(*ATMWithdrawalCompletionAcknowledgementV01)(nil),       // 20: iso20022.catp.ATMWithdrawalCompletionAcknowledgementV01
+	(*ATMWithdrawalCompletionAcknowledgementV02)(nil),       // 21: iso20022.catp.ATMWithdrawalCompletionAcknowledgementV02
+	(*ATMWithdrawalCompletionAdviceV01)(nil),                // 22: iso20022.catp.ATMWithdrawalCompletionAdviceV01
+	(*ATMWithdrawalCompletionAdviceV02)(nil),                // 23: iso20022.catp.ATMWithdrawalCompletionAdviceV02
+	(*ATMWithdrawalRequestV01)(nil),                         // 24: iso20022.catp.ATMWithdrawalRequestV01
+	(*ATMWithdrawalRequestV02)(nil),                         // 25: iso20022.catp.ATMWithdrawalRequestV02
+	(*ATMWithdrawalResponseV01)(nil),                        // 26: iso20022.catp.ATMWithdrawalResponseV01
+	(*ATMWithdrawalResponseV02)(nil),                        // 27: iso20022.catp.ATMWithdrawalResponseV02
+	(*Document00100101)(nil),                                // 28: iso20022.catp.Document00100101
+	(*Document00100102)(nil),                                // 29: iso20022.catp.Document00100102
+	(*Document00200101)(nil),                                // 30: iso20022.catp.Document00200101
+	(*Document00200102)(nil),                                // 31: iso20022.catp.Document00200102
+	(*Document00300101)(nil),                                // 32: iso20022.catp.Document00300101
+	(*Document00300102)(nil),                                // 33: iso20022.catp.Document00300102
+	(*Document00400101)(nil),                                // 34: iso20022.catp.Document00400101
+	(*Document00400102)(nil),                                // 35: iso20022.catp.Document00400102
+	(*Document00500101)(nil),                                // 36: iso20022.catp.Document00500101
+	(*Document00500102)(nil),                                // 37: iso20022.catp.Document00500102
+	(*Document00600101)(nil),                                // 38: iso20022.catp.Document00600101
+	(*Document00600102)(nil),                                // 39: iso20022.catp.Document00600102
+	(*Document00700101)(nil),                                // 40: iso20022.catp.Document00700101
+	(*Document00700102)(nil),                                // 41: iso20022.catp.Document00700102
+	(*Document00800101)(nil),                                // 42: iso20022.catp.Document00800101
+	(*Document00800102)(nil),                                // 43: iso20022.catp.Document00800102
+	(*Document00900101)(nil),                                // 44: iso20022.catp.Document00900101
+	(*Document00900102)(nil),                                // 45: iso20022.catp.Document00900102
+	(*Document01000101)(nil),                                // 46: iso20022.catp.Document01000101
+	(*Document01000102)(nil),                                // 47: iso20022.catp.Document01000102
+	(*Document01100101)(nil),                                // 48: iso20022.catp.Document01100101
+	(*Document01100102)(nil),                                // 49: iso20022.catp.Document01100102
+	(*Document01200101)(nil),                                // 50: iso20022.catp.Document01200101
+	(*Document01300101)(nil),                                // 51: iso20022.catp.Document01300101
+	(*Document01400101)(nil),                                // 52: iso20022.catp.Document01400101
+	(*Document01500101)(nil),                                // 53: iso20022.catp.Document01500101
+	(*Document01600101)(nil),                                // 54: iso20022.catp.Document01600101
+	(*Document01700101)(nil),                                // 55: iso20022.catp.Document01700101
+	(*modelpb.Header21)(nil),                                // 56: iso20022.model.Header21
+	(*modelpb.ContentInformationType10)(nil),                // 57: iso20022.model.ContentInformationType10
+	(*modelpb.ATMCompletionAcknowledgement1)(nil),           // 58: iso20022.model.ATMCompletionAcknowledgement1
+	(*modelpb.ContentInformationType15)(nil),                // 59: iso20022.model.ContentInformationType15
+	(*modelpb.Header32)(nil),                                // 60: iso20022.model.Header32
+	(*modelpb.ATMCompletionAcknowledgement2)(nil),           // 61: iso20022.model.ATMCompletionAcknowledgement2
+	(*modelpb.ATMCompletionAdvice1)(nil),                    // 62: iso20022.model.ATMCompletionAdvice1
+	(*modelpb.ATMCompletionAdvice2)(nil),                    // 63: iso20022.model.ATMCompletionAdvice2
+	(*modelpb.ATMDepositCompletionAcknowledgement1)(nil),    // 64: iso20022.model.ATMDepositCompletionAcknowledgement1
+	(*modelpb.ATMDepositCompletionAdvice1)(nil),             // 65: iso20022.model.ATMDepositCompletionAdvice1
+	(*modelpb.Header31)(nil),                                // 66: iso20022.model.Header31
+	(*modelpb.ATMDepositRequest1)(nil),                      // 67: iso20022.model.ATMDepositRequest1
+	(*modelpb.ATMDepositResponse1)(nil),                     // 68: iso20022.model.ATMDepositResponse1
+	(*modelpb.Header20)(nil),                                // 69: iso20022.model.Header20
+	(*modelpb.ATMInquiryRequest1)(nil),                      // 70: iso20022.model.ATMInquiryRequest1
+	(*modelpb.ATMInquiryRequest2)(nil),                      // 71: iso20022.model.ATMInquiryRequest2
+	(*modelpb.ATMInquiryResponse1)(nil),                     // 72: iso20022.model.ATMInquiryResponse1
+	(*modelpb.ATMInquiryResponse2)(nil),                     // 73: iso20022.model.ATMInquiryResponse2
+	(*modelpb.ATMPINManagementRequest1)(nil),                // 74: iso20022.model.ATMPINManagementRequest1
+	(*modelpb.ATMPINManagementRequest2)(nil),                // 75: iso20022.model.ATMPINManagementRequest2
+	(*modelpb.ATMPINManagementResponse1)(nil),               // 76: iso20022.model.ATMPINManagementResponse1
+	(*modelpb.ATMPINManagementResponse2)(nil),               // 77: iso20022.model.ATMPINManagementResponse2
+	(*modelpb.Header22)(nil),                                // 78: iso20022.model.Header22
+	(*modelpb.ATMReject1)(nil),                              // 79: iso20022.model.ATMReject1
+	(*modelpb.Header33)(nil),                                // 80: iso20022.model.Header33
+	(*modelpb.ATMReject2)(nil),                              // 81: iso20022.model.ATMReject2
+	(*modelpb.ATMTransferRequest1)(nil),                     // 82: iso20022.model.ATMTransferRequest1
+	(*modelpb.ATMTransferResponse1)(nil),                    // 83: iso20022.model.ATMTransferResponse1
+	(*modelpb.ATMWithdrawalCompletionAcknowledgement1)(nil), // 84: iso20022.model.ATMWithdrawalCompletionAcknowledgement1
+	(*modelpb.ATMWithdrawalCompletionAcknowledgement2)(nil), // 85: iso20022.model.ATMWithdrawalCompletionAcknowledgement2
+	(*modelpb.ATMWithdrawalCompletionAdvice1)(nil),          // 86: iso20022.model.ATMWithdrawalCompletionAdvice1
+	(*modelpb.ATMWithdrawalCompletionAdvice2)(nil),          // 87: iso20022.model.ATMWithdrawalCompletionAdvice2
+	(*modelpb.ATMWithdrawalRequest1)(nil),                   // 88: iso20022.model.ATMWithdrawalRequest1
+	(*modelpb.ATMWithdrawalRequest2)(nil),                   // 89: iso20022.model.ATMWithdrawalRequest2
+	(*modelpb.ATMWithdrawalResponse1)(nil),                  // 90: iso20022.model.ATMWithdrawalResponse1
+	(*modelpb.ATMWithdrawalResponse2)(nil),                  // 91: iso20022.model.ATMWithdrawalResponse2
+}
+var file_iso20022_catp_proto_depIdxs = []int32{
+	56,  // 0: iso20022.catp.ATMCompletionAcknowledgementV01.header:type_name -> iso20022.model.Header21
+	57,  // 1: iso20022.catp.ATMCompletionAcknowledgementV01.protected_atm_completion_acknowledgement:type_name -> iso20022.model.ContentInformationType10
+	58,  // 2: iso20022.catp.ATMCompletionAcknowledgementV01.atm_completion_acknowledgement:type_name -> iso20022.model.ATMCompletionAcknowledgement1
+	59,  // 3: iso20022.catp.ATMCompletionAcknowledgementV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	60,  // 4: iso20022.catp.ATMCompletionAcknowledgementV02.header:type_name -> iso20022.model.Header32
+	57,  // 5: iso20022.catp.ATMCompletionAcknowledgementV02.protected_atm_completion_acknowledgement:type_name -> iso20022.model.ContentInformationType10
+	61,  // 6: iso20022.catp.ATMCompletionAcknowledgementV02.atm_completion_acknowledgement:type_name -> iso20022.model.ATMCompletionAcknowledgement2
+	59,  // 7: iso20022.catp.ATMCompletionAcknowledgementV02.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	56,  // 8: iso20022.catp.ATMCompletionAdviceV01.header:type_name -> iso20022.model.Header21
+	57,  // 9: iso20022.catp.ATMCompletionAdviceV01.protected_atm_completion_advice:type_name -> iso20022.model.ContentInformationType10
+	62,  // 10: iso20022.catp.ATMCompletionAdviceV01.atm_completion_advice:type_name -> iso20022.model.ATMCompletionAdvice1
+	59,  // 11: iso20022.catp.ATMCompletionAdviceV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	60,  // 12: iso20022.catp.ATMCompletionAdviceV02.header:type_name -> iso20022.model.Header32
+	57,  // 13: iso20022.catp.ATMCompletionAdviceV02.protected_atm_completion_advice:type_name -> iso20022.model.ContentInformationType10
+	63,  // 14: iso20022.catp.ATMCompletionAdviceV02.atm_completion_advice:type_name -> iso20022.model.ATMCompletionAdvice2
+	59,  // 15: iso20022.catp.ATMCompletionAdviceV02.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	60,  // 16: iso20022.catp.ATMDepositCompletionAcknowledgementV01.header:type_name -> iso20022.model.Header32
+	57,  // 17: iso20022.catp.ATMDepositCompletionAcknowledgementV01.protected_atm_deposit_completion_acknowledgement:type_name -> iso20022.model.ContentInformationType10
+	64,  // 18: iso20022.catp.ATMDepositCompletionAcknowledgementV01.atm_deposit_completion_acknowledgement:type_name -> iso20022.model.ATMDepositCompletionAcknowledgement1
+	59,  // 19: iso20022.catp.ATMDepositCompletionAcknowledgementV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	60,  // 20: iso20022.catp.ATMDepositCompletionAdviceV01.header:type_name -> iso20022.model.Header32
+	57,  // 21: iso20022.catp.ATMDepositCompletionAdviceV01.protected_atm_deposit_completion_advice:type_name -> iso20022.model.ContentInformationType10
+	65,  // 22: iso20022.catp.ATMDepositCompletionAdviceV01.atm_deposit_completion_advice:type_name -> iso20022.model.ATMDepositCompletionAdvice1
+	59,  // 23: iso20022.catp.ATMDepositCompletionAdviceV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	66,  // 24: iso20022.catp.ATMDepositRequestV01.header:type_name -> iso20022.model.Header31
+	57,  // 25: iso20022.catp.ATMDepositRequestV01.protected_atm_deposit_request:type_name -> iso20022.model.ContentInformationType10
+	67,  // 26: iso20022.catp.ATMDepositRequestV01.atm_deposit_request:type_name -> iso20022.model.ATMDepositRequest1
+	59,  // 27: iso20022.catp.ATMDepositRequestV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	66,  // 28: iso20022.catp.ATMDepositResponseV01.header:type_name -> iso20022.model.Header31
+	57,  // 29: iso20022.catp.ATMDepositResponseV01.protected_atm_deposit_response:type_name -> iso20022.model.ContentInformationType10
+	68,  // 30: iso20022.catp.ATMDepositResponseV01.atm_deposit_response:type_name -> iso20022.model.ATMDepositResponse1
+	59,  // 31: iso20022.catp.ATMDepositResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	69,  // 32: iso20022.catp.ATMInquiryRequestV01.header:type_name -> iso20022.model.Header20
+	57,  // 33: iso20022.catp.ATMInquiryRequestV01.protected_atm_inquiry_request:type_name -> iso20022.model.ContentInformationType10
+	70,  // 34: iso20022.catp.ATMInquiryRequestV01.atm_inquiry_request:type_name -> iso20022.model.ATMInquiryRequest1
+	59,  // 35: iso20022.catp.ATMInquiryRequestV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	66,  // 36: iso20022.catp.ATMInquiryRequestV02.header:type_name -> iso20022.model.Header31
+	57,  // 37: iso20022.catp.ATMInquiryRequestV02.protected_atm_inquiry_request:type_name -> iso20022.model.ContentInformationType10
+	71,  // 38: iso20022.catp.ATMInquiryRequestV02.atm_inquiry_request:type_name -> iso20022.model.ATMInquiryRequest2
+	59,  // 39: iso20022.catp.ATMInquiryRequestV02.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	69,  // 40: iso20022.catp.ATMInquiryResponseV01.header:type_name -> iso20022.model.Header20
+	57,  // 41: iso20022.catp.ATMInquiryResponseV01.protected_atm_inquiry_response:type_name -> iso20022.model.ContentInformationType10
+	72,  // 42: iso20022.catp.ATMInquiryResponseV01.atm_inquiry_response:type_name -> iso20022.model.ATMInquiryResponse1
+	59,  // 43: iso20022.catp.ATMInquiryResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	66,  // 44: iso20022.catp.ATMInquiryResponseV02.header:type_name -> iso20022.model.Header31
+	57,  // 45: iso20022.catp.ATMInquiryResponseV02.protected_atm_inquiry_response:type_name -> iso20022.model.ContentInformationType10
+	73,  // 46: iso20022.catp.ATMInquiryResponseV02.atm_inquiry_response:type_name -> iso20022.model.ATMInquiryResponse2
+	59,  // 47: iso20022.catp.ATMInquiryResponseV02.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	69,  // 48: iso20022.catp.ATMPINManagementRequestV01.header:type_name -> iso20022.model.Header20
+	57,  // 49: iso20022.catp.ATMPINManagementRequestV01.protected_atmpin_management_request:type_name -> iso20022.model.ContentInformationType10
+	74,  // 50: iso20022.catp.ATMPINManagementRequestV01.atmpin_management_request:type_name -> iso20022.model.ATMPINManagementRequest1
+	59,  // 51: iso20022.catp.ATMPINManagementRequestV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	66,  // 52: iso20022.catp.ATMPINManagementRequestV02.header:type_name -> iso20022.model.Header31
+	57,  // 53: iso20022.catp.ATMPINManagementRequestV02.protected_atmpin_management_request:type_name -> iso20022.model.ContentInformationType10
+	75,  // 54: iso20022.catp.ATMPINManagementRequestV02.atmpin_management_request:type_name -> iso20022.model.ATMPINManagementRequest2
+	59,  // 55: iso20022.catp.ATMPINManagementRequestV02.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	69,  // 56: iso20022.catp.ATMPINManagementResponseV01.header:type_name -> iso20022.model.Header20
+	57,  // 57: iso20022.catp.ATMPINManagementResponseV01.protected_atmpin_management_response:type_name -> iso20022.model.ContentInformationType10
+	76,  // 58: iso20022.catp.ATMPINManagementResponseV01.atmpin_management_response:type_name -> iso20022.model.ATMPINManagementResponse1
+	59,  // 59: iso20022.catp.ATMPINManagementResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	66,  // 60: iso20022.catp.ATMPINManagementResponseV02.header:type_name -> iso20022.model.Header31
+	57,  // 61: iso20022.catp.ATMPINManagementResponseV02.protected_atmpin_management_response:type_name -> iso20022.model.ContentInformationType10
+	77,  // 62: iso20022.catp.ATMPINManagementResponseV02.atmpin_management_response:type_name -> iso20022.model.ATMPINManagementResponse2
+	59,  // 63: iso20022.catp.ATMPINManagementResponseV02.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	78,  // 64: iso20022.catp.ATMRejectV01.header:type_name -> iso20022.model.Header22
+	79,  // 65: iso20022.catp.ATMRejectV01.atm_reject:type_name -> iso20022.model.ATMReject1
+	80,  // 66: iso20022.catp.ATMRejectV02.header:type_name -> iso20022.model.Header33
+	81,  // 67: iso20022.catp.ATMRejectV02.atm_reject:type_name -> iso20022.model.ATMReject2
+	66,  // 68: iso20022.catp.ATMTransferRequestV01.header:type_name -> iso20022.model.Header31
+	57,  // 69: iso20022.catp.ATMTransferRequestV01.protected_atm_transfer_request:type_name -> iso20022.model.ContentInformationType10
+	82,  // 70: iso20022.catp.ATMTransferRequestV01.atm_transfer_request:type_name -> iso20022.model.ATMTransferRequest1
+	59,  // 71: iso20022.catp.ATMTransferRequestV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	66,  // 72: iso20022.catp.ATMTransferResponseV01.header:type_name -> iso20022.model.Header31
+	57,  // 73: iso20022.catp.ATMTransferResponseV01.protected_atm_transfer_response:type_name -> iso20022.model.ContentInformationType10
+	83,  // 74: iso20022.catp.ATMTransferResponseV01.atm_transfer_response:type_name -> iso20022.model.ATMTransferResponse1
+	59,  // 75: iso20022.catp.ATMTransferResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	56,  // 76: iso20022.catp.ATMWithdrawalCompletionAcknowledgementV01.header:type_name -> iso20022.model.Header21
+	57,  // 77: iso20022.catp.ATMWithdrawalCompletionAcknowledgementV01.protected_atm_withdrawal_completion_acknowledgement:type_name -> iso20022.model.ContentInformationType10
+	84,  // 78: iso20022.catp.ATMWithdrawalCompletionAcknowledgementV01.atm_withdrawal_completion_acknowledgement:type_name -> iso20022.model.ATMWithdrawalCompletionAcknowledgement1
+	59,  // 79: iso20022.catp.ATMWithdrawalCompletionAcknowledgementV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	60,  // 80: iso20022.catp.ATMWithdrawalCompletionAcknowledgementV02.header:type_name -> iso20022.model.Header32
+	57,  // 81: iso20022.catp.ATMWithdrawalCompletionAcknowledgementV02.protected_atm_withdrawal_completion_acknowledgement:type_name -> iso20022.model.ContentInformationType10
+	85,  // 82: iso20022.catp.ATMWithdrawalCompletionAcknowledgementV02.atm_withdrawal_completion_acknowledgement:type_name -> iso20022.model.ATMWithdrawalCompletionAcknowledgement2
+	59,  // 83: iso20022.catp.ATMWithdrawalCompletionAcknowledgementV02.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	56,  // 84: iso20022.catp.ATMWithdrawalCompletionAdviceV01.header:type_name -> iso20022.model.Header21
+	57,  // 85: iso20022.catp.ATMWithdrawalCompletionAdviceV01.protected_atm_withdrawal_completion_advice:type_name -> iso20022.model.ContentInformationType10
+	86,  // 86: iso20022.catp.ATMWithdrawalCompletionAdviceV01.atm_withdrawal_completion_advice:type_name -> iso20022.model.ATMWithdrawalCompletionAdvice1
+	59,  // 87: iso20022.catp.ATMWithdrawalCompletionAdviceV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	60,  // 88: iso20022.catp.ATMWithdrawalCompletionAdviceV02.header:type_name -> iso20022.model.Header32
+	57,  // 89: iso20022.catp.ATMWithdrawalCompletionAdviceV02.protected_atm_withdrawal_completion_advice:type_name -> iso20022.model.ContentInformationType10
+	87,  // 90: iso20022.catp.ATMWithdrawalCompletionAdviceV02.atm_withdrawal_completion_advice:type_name -> iso20022.model.ATMWithdrawalCompletionAdvice2
+	59,  // 91: iso20022.catp.ATMWithdrawalCompletionAdviceV02.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	69,  // 92: iso20022.catp.ATMWithdrawalRequestV01.header:type_name -> iso20022.model.Header20
+	57,  // 93: iso20022.catp.ATMWithdrawalRequestV01.protected_atm_withdrawal_request:type_name -> iso20022.model.ContentInformationType10
+	88,  // 94: iso20022.catp.ATMWithdrawalRequestV01.atm_withdrawal_request:type_name -> iso20022.model.ATMWithdrawalRequest1
+	59,  // 95: iso20022.catp.ATMWithdrawalRequestV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	66,  // 96: iso20022.catp.ATMWithdrawalRequestV02.header:type_name -> iso20022.model.Header31
+	57,  // 97: iso20022.catp.ATMWithdrawalRequestV02.protected_atm_withdrawal_request:type_name -> iso20022.model.ContentInformationType10
+	89,  // 98: iso20022.catp.ATMWithdrawalRequestV02.atm_withdrawal_request:type_name -> iso20022.model.ATMWithdrawalRequest2
+	59,  // 99: iso20022.catp.ATMWithdrawalRequestV02.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	69,  // 100: iso20022.catp.ATMWithdrawalResponseV01.header:type_name -> iso20022.model.Header20
+	57,  // 101: iso20022.catp.ATMWithdrawalResponseV01.protected_atm_withdrawal_response:type_name -> iso20022.model.ContentInformationType10
+	90,  // 102: iso20022.catp.ATMWithdrawalResponseV01.atm_withdrawal_response:type_name -> iso20022.model.ATMWithdrawalResponse1
+	59,  // 103: iso20022.catp.ATMWithdrawalResponseV01.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	66,  // 104: iso20022.catp.ATMWithdrawalResponseV02.header:type_name -> iso20022.model.Header31
+	57,  // 105: iso20022.catp.ATMWithdrawalResponseV02.protected_atm_withdrawal_response:type_name -> iso20022.model.ContentInformationType10
+	91,  // 106: iso20022.catp.ATMWithdrawalResponseV02.atm_withdrawal_response:type_name -> iso20022.model.ATMWithdrawalResponse2
+	59,  // 107: iso20022.catp.ATMWithdrawalResponseV02.security_trailer:type_name -> iso20022.model.ContentInformationType15
+	24,  // 108: iso20022.catp.Document00100101.message:type_name -> iso20022.catp.ATMWithdrawalRequestV01
+	25,  // 109: iso20022.catp.Document00100102.message:type_name -> iso20022.catp.ATMWithdrawalRequestV02
+	26,  // 110: iso20022.catp.Document00200101.message:type_name -> iso20022.catp.ATMWithdrawalResponseV01
+	27,  // 111: iso20022.catp.Document00200102.message:type_name -> iso20022.catp.ATMWithdrawalResponseV02
+	22,  // 112: iso20022.catp.Document00300101.message:type_name -> iso20022.catp.ATMWithdrawalCompletionAdviceV01
+	23,  // 113: iso20022.catp.Document00300102.message:type_name -> iso20022.catp.ATMWithdrawalCompletionAdviceV02
+	20,  // 114: iso20022.catp.Document00400101.message:type_name -> iso20022.catp.ATMWithdrawalCompletionAcknowledgementV01
+	21,  // 115: iso20022.catp.Document00400102.message:type_name -> iso20022.catp.ATMWithdrawalCompletionAcknowledgementV02
+	16,  // 116: iso20022.catp.Document00500101.message:type_name -> iso20022.catp.ATMRejectV01
+	17,  // 117: iso20022.catp.Document00500102.message:type_name -> iso20022.catp.ATMRejectV02
+	8,   // 118: iso20022.catp.Document00600101.message:type_name -> iso20022.catp.ATMInquiryRequestV01
+	9,   // 119: iso20022.catp.Document00600102.message:type_name -> iso20022.catp.ATMInquiryRequestV02
+	10,  // 120: iso20022.catp.Document00700101.message:type_name -> iso20022.catp.ATMInquiryResponseV01
+	11,  // 121: iso20022.catp.Document00700102.message:type_name -> iso20022.catp.ATMInquiryResponseV02
+	2,   // 122: iso20022.catp.Document00800101.message:type_name -> iso20022.catp.ATMCompletionAdviceV01
+	3,   // 123: iso20022.catp.Document00800102.message:type_name -> iso20022.catp.ATMCompletionAdviceV02
+	0,   // 124: iso20022.catp.Document00900101.message:type_name -> iso20022.catp.ATMCompletionAcknowledgementV01
+	1,   // 125: iso20022.catp.Document00900102.message:type_name -> iso20022.catp.ATMCompletionAcknowledgementV02
+	12,  // 126: iso20022.catp.Document01000101.message:type_name -> iso20022.catp.ATMPINManagementRequestV01
+	13,  // 127: iso20022.catp.Document01000102.message:type_name -> iso20022.catp.ATMPINManagementRequestV02
+	14,  // 128: iso20022.catp.Document01100101.message:type_name -> iso20022.catp.ATMPINManagementResponseV01
+	15,  // 129: iso20022.catp.Document01100102.message:type_name -> iso20022.catp.ATMPINManagementResponseV02
+	6,   // 130: iso20022.catp.Document01200101.message:type_name -> iso20022.catp.ATMDepositRequestV01
+	7,   // 131: iso20022.catp.Document01300101.message:type_name -> iso20022.catp.ATMDepositResponseV01
+	5,   // 132: iso20022.catp.Document01400101.message:type_name -> iso20022.catp.ATMDepositCompletionAdviceV01
+	4,   // 133: iso20022.catp.Document01500101.message:type_name -> iso20022.catp.ATMDepositCompletionAcknowledgementV01
+	18,  // 134: iso20022.catp.Document01600101.message:type_name -> iso20022.catp.ATMTransferRequestV01
+	19,  // 135: iso20022.catp.Document01700101.message:type_name -> iso20022.catp.ATMTransferResponseV01
+	136, // [136:136] is the sub-list for method output_type
+	136, // [136:136] is the sub-list for method input_type
+	136, // [136:136] is the sub-list for extension type_name
+	136, // [136:136] is the sub-list for extension extendee
+	0,   // [0:136] is the sub-list for field type_name
+}
+
+func init() { file_iso20022_catp_proto_init() }
+func file_iso20022_catp_proto_init() {
+	if File_iso20022_catp_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_iso20022_catp_proto_rawDesc), len(file_iso20022_catp_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   56,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_iso20022_catp_proto_goTypes,
+		DependencyIndexes: file_iso20022_catp_proto_depIdxs,
+		MessageInfos:      file_iso20022_catp_proto_msgTypes,
+	}.Build()
+	File_iso20022_catp_proto = out.File
+	file_iso20022_catp_proto_goTypes = nil
+	file_iso20022_catp_proto_depIdxs = nil
+}
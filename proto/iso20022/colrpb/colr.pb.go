@@ -0,0 +1,5656 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: iso20022/colr.proto
+
+package colrpb
+
+import (
+	modelpb "github.com/yudaprama/iso20022/proto/iso20022/modelpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+// Scope
+// This message is sent:
+// - either by the collateral giver, or its collateral manager, to the collateral taker, or its collateral manager, or
+// - or by the collateral taker, or its collateral manager to the collateral giver, or its collateral manager
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The CollateralAndExposureReport is used to provide the details of the valuation of the collateral, that is, the valuation of securities collateral, cash collateral or other type of collateral, posted at a specific calculation date.
+type CollateralAndExposureReportV02 struct {
+	state             protoimpl.MessageState        `protogen:"open.v1"`
+	ReportParameters  *modelpb.ReportParameters2    `protobuf:"bytes,1,opt,name=report_parameters,json=reportParameters,proto3" json:"report_parameters,omitempty"`
+	Pagination        *modelpb.Pagination           `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Obligation        *modelpb.Obligation3          `protobuf:"bytes,3,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement         *modelpb.Agreement2           `protobuf:"bytes,4,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	CollateralReport  []*modelpb.Collateral9        `protobuf:"bytes,5,rep,name=collateral_report,json=collateralReport,proto3" json:"collateral_report,omitempty"`
+	SupplementaryData []*modelpb.SupplementaryData1 `protobuf:"bytes,6,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CollateralAndExposureReportV02) Reset() {
+	*x = CollateralAndExposureReportV02{}
+	mi := &file_iso20022_colr_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralAndExposureReportV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralAndExposureReportV02) ProtoMessage() {}
+
+func (x *CollateralAndExposureReportV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralAndExposureReportV02.ProtoReflect.Descriptor instead.
+func (*CollateralAndExposureReportV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CollateralAndExposureReportV02) GetReportParameters() *modelpb.ReportParameters2 {
+	if x != nil {
+		return x.ReportParameters
+	}
+	return nil
+}
+
+func (x *CollateralAndExposureReportV02) GetPagination() *modelpb.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *CollateralAndExposureReportV02) GetObligation() *modelpb.Obligation3 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralAndExposureReportV02) GetAgreement() *modelpb.Agreement2 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *CollateralAndExposureReportV02) GetCollateralReport() []*modelpb.Collateral9 {
+	if x != nil {
+		return x.CollateralReport
+	}
+	return nil
+}
+
+func (x *CollateralAndExposureReportV02) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The CollateralAndExposureReport message is sent:
+// - either by the collateral giver, or its collateral manager, to the collateral taker, or its collateral manager, or
+// - or by the collateral taker, or its collateral manager to the collateral giver, or its collateral manager
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The CollateralAndExposureReport is used to provide the details of the valuation of the collateral, that is, the valuation of securities collateral, cash collateral or other type of collateral, posted at a specific calculation date.
+type CollateralAndExposureReportV03 struct {
+	state             protoimpl.MessageState        `protogen:"open.v1"`
+	ReportParameters  *modelpb.ReportParameters5    `protobuf:"bytes,1,opt,name=report_parameters,json=reportParameters,proto3" json:"report_parameters,omitempty"`
+	Pagination        *modelpb.Pagination           `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Obligation        *modelpb.Obligation4          `protobuf:"bytes,3,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement         *modelpb.Agreement4           `protobuf:"bytes,4,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	CollateralReport  []*modelpb.Collateral13       `protobuf:"bytes,5,rep,name=collateral_report,json=collateralReport,proto3" json:"collateral_report,omitempty"`
+	SupplementaryData []*modelpb.SupplementaryData1 `protobuf:"bytes,6,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CollateralAndExposureReportV03) Reset() {
+	*x = CollateralAndExposureReportV03{}
+	mi := &file_iso20022_colr_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralAndExposureReportV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralAndExposureReportV03) ProtoMessage() {}
+
+func (x *CollateralAndExposureReportV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralAndExposureReportV03.ProtoReflect.Descriptor instead.
+func (*CollateralAndExposureReportV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CollateralAndExposureReportV03) GetReportParameters() *modelpb.ReportParameters5 {
+	if x != nil {
+		return x.ReportParameters
+	}
+	return nil
+}
+
+func (x *CollateralAndExposureReportV03) GetPagination() *modelpb.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *CollateralAndExposureReportV03) GetObligation() *modelpb.Obligation4 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralAndExposureReportV03) GetAgreement() *modelpb.Agreement4 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *CollateralAndExposureReportV03) GetCollateralReport() []*modelpb.Collateral13 {
+	if x != nil {
+		return x.CollateralReport
+	}
+	return nil
+}
+
+func (x *CollateralAndExposureReportV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// This CollateralManagementCancellationRequest message is sent by:
+// - the collateral taker or its collateral manager to the collateral giver or its collateral manager,
+// - the collateral giver or its collateral manager to the collateral taker or its collateral manager
+// This message is used to request the cancellation of a previously sent MarginCallRequest message, MarginCallResponse message, CollateralProposal message, CollateralProposalResponse message, MarginCallDisputeNotification message or a CollateralSubstitutionRequest message.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The CollateralManagementCancellationRequest message is used to request the cancellation of a collateral message. When requesting the cancellation of a message there must be a cancellation reason specified.
+// When the CollateralManagementCancellationRequest message is used to cancel a collateral message the reference of the original message must be specified. The rejection or acceptance of a CollateralManagementCancellationRequest message is made using a CollateralManagementCancellationStatus message.
+type CollateralManagementCancellationRequestV03 struct {
+	state                     protoimpl.MessageState                 `protogen:"open.v1"`
+	TransactionIdentification *string                                `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Reference                 *modelpb.Reference2Choice              `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Obligation                *modelpb.Obligation3                   `protobuf:"bytes,3,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	CancellationReason        *modelpb.CollateralCancellationReason1 `protobuf:"bytes,4,opt,name=cancellation_reason,json=cancellationReason,proto3" json:"cancellation_reason,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1          `protobuf:"bytes,5,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CollateralManagementCancellationRequestV03) Reset() {
+	*x = CollateralManagementCancellationRequestV03{}
+	mi := &file_iso20022_colr_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralManagementCancellationRequestV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralManagementCancellationRequestV03) ProtoMessage() {}
+
+func (x *CollateralManagementCancellationRequestV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralManagementCancellationRequestV03.ProtoReflect.Descriptor instead.
+func (*CollateralManagementCancellationRequestV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CollateralManagementCancellationRequestV03) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralManagementCancellationRequestV03) GetReference() *modelpb.Reference2Choice {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *CollateralManagementCancellationRequestV03) GetObligation() *modelpb.Obligation3 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralManagementCancellationRequestV03) GetCancellationReason() *modelpb.CollateralCancellationReason1 {
+	if x != nil {
+		return x.CancellationReason
+	}
+	return nil
+}
+
+func (x *CollateralManagementCancellationRequestV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The CollateralManagementCancellationRequest message is sent by:
+// - the collateral taker or its collateral manager to the collateral giver or its collateral manager,
+// - the collateral giver or its collateral manager to the collateral taker or its collateral manager
+// This message is used to request the cancellation of a previously sent MarginCallRequest message, MarginCallResponse message, CollateralProposal message, CollateralProposalResponse message, MarginCallDisputeNotification message or a CollateralSubstitutionRequest message.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The CollateralManagementCancellationRequest message is used to request the cancellation of a collateral message. When requesting the cancellation of a message there must be a cancellation reason specified.
+// When the CollateralManagementCancellationRequest message is used to cancel a collateral message the reference of the original message must be specified. The rejection or acceptance of a CollateralManagementCancellationRequest message is made using a CollateralManagementCancellationStatus message.
+type CollateralManagementCancellationRequestV04 struct {
+	state                     protoimpl.MessageState                 `protogen:"open.v1"`
+	TransactionIdentification *string                                `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Reference                 *modelpb.Reference2Choice              `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Obligation                *modelpb.Obligation4                   `protobuf:"bytes,3,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	CancellationReason        *modelpb.CollateralCancellationReason1 `protobuf:"bytes,4,opt,name=cancellation_reason,json=cancellationReason,proto3" json:"cancellation_reason,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1          `protobuf:"bytes,5,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CollateralManagementCancellationRequestV04) Reset() {
+	*x = CollateralManagementCancellationRequestV04{}
+	mi := &file_iso20022_colr_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralManagementCancellationRequestV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralManagementCancellationRequestV04) ProtoMessage() {}
+
+func (x *CollateralManagementCancellationRequestV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralManagementCancellationRequestV04.ProtoReflect.Descriptor instead.
+func (*CollateralManagementCancellationRequestV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CollateralManagementCancellationRequestV04) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralManagementCancellationRequestV04) GetReference() *modelpb.Reference2Choice {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *CollateralManagementCancellationRequestV04) GetObligation() *modelpb.Obligation4 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralManagementCancellationRequestV04) GetCancellationReason() *modelpb.CollateralCancellationReason1 {
+	if x != nil {
+		return x.CancellationReason
+	}
+	return nil
+}
+
+func (x *CollateralManagementCancellationRequestV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// This CollateralManagementCancellationStatus message is sent by:
+// - the collateral taker or its collateral manager to the collateral giver or its collateral manager, or
+// - the collateral giver or its collateral manager to the collateral taker or its collateral manager.
+// This message is used to provide the status of accepted or rejected for the CollateralManagementCancellationRequest message previously received.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The CollateralManagementCancellationStatus message can be sent to provide a status for a previously received CollateralManagementCancellationRequest message.
+type CollateralManagementCancellationStatusV03 struct {
+	state                     protoimpl.MessageState                 `protogen:"open.v1"`
+	TransactionIdentification *string                                `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Reference                 *modelpb.Reference16                   `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Obligation                *modelpb.Obligation3                   `protobuf:"bytes,3,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	CancellationStatus        *modelpb.CollateralCancellationStatus1 `protobuf:"bytes,4,opt,name=cancellation_status,json=cancellationStatus,proto3" json:"cancellation_status,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1          `protobuf:"bytes,5,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CollateralManagementCancellationStatusV03) Reset() {
+	*x = CollateralManagementCancellationStatusV03{}
+	mi := &file_iso20022_colr_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralManagementCancellationStatusV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralManagementCancellationStatusV03) ProtoMessage() {}
+
+func (x *CollateralManagementCancellationStatusV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralManagementCancellationStatusV03.ProtoReflect.Descriptor instead.
+func (*CollateralManagementCancellationStatusV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CollateralManagementCancellationStatusV03) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralManagementCancellationStatusV03) GetReference() *modelpb.Reference16 {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *CollateralManagementCancellationStatusV03) GetObligation() *modelpb.Obligation3 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralManagementCancellationStatusV03) GetCancellationStatus() *modelpb.CollateralCancellationStatus1 {
+	if x != nil {
+		return x.CancellationStatus
+	}
+	return nil
+}
+
+func (x *CollateralManagementCancellationStatusV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The CollateralManagementCancellationStatus message is sent by:
+// - the collateral taker or its collateral manager to the collateral giver or its collateral manager, or
+// - the collateral giver or its collateral manager to the collateral taker or its collateral manager.
+// This message is used to provide the status of accepted or rejected for the CollateralManagementCancellationRequest message previously received.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The CollateralManagementCancellationStatus message can be sent to provide a status for a previously received CollateralManagementCancellationRequest message.
+type CollateralManagementCancellationStatusV04 struct {
+	state                     protoimpl.MessageState                 `protogen:"open.v1"`
+	TransactionIdentification *string                                `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Reference                 *modelpb.Reference16                   `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Obligation                *modelpb.Obligation4                   `protobuf:"bytes,3,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	CancellationStatus        *modelpb.CollateralCancellationStatus1 `protobuf:"bytes,4,opt,name=cancellation_status,json=cancellationStatus,proto3" json:"cancellation_status,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1          `protobuf:"bytes,5,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CollateralManagementCancellationStatusV04) Reset() {
+	*x = CollateralManagementCancellationStatusV04{}
+	mi := &file_iso20022_colr_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralManagementCancellationStatusV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralManagementCancellationStatusV04) ProtoMessage() {}
+
+func (x *CollateralManagementCancellationStatusV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralManagementCancellationStatusV04.ProtoReflect.Descriptor instead.
+func (*CollateralManagementCancellationStatusV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CollateralManagementCancellationStatusV04) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralManagementCancellationStatusV04) GetReference() *modelpb.Reference16 {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *CollateralManagementCancellationStatusV04) GetObligation() *modelpb.Obligation4 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralManagementCancellationStatusV04) GetCancellationStatus() *modelpb.CollateralCancellationStatus1 {
+	if x != nil {
+		return x.CancellationStatus
+	}
+	return nil
+}
+
+func (x *CollateralManagementCancellationStatusV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// This CollateralProposalResponse message is sent by the collateral taker or its collateral manager to the collateral giver or its collateral manager to either accept or reject the collateral which has been proposed for the margin call. This message applies to both initial and counter proposals. If the collateral proposal is rejected then a new collateral proposal must be made.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The CollateralProposalResponse message can be sent in response to a previously received CollateralProposal message in order to accept or reject the collateral that has been proposed to cover the margin call.
+type CollateralProposalResponseV03 struct {
+	state                     protoimpl.MessageState                     `protogen:"open.v1"`
+	TransactionIdentification *string                                    `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation3                       `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	ProposalResponse          *modelpb.CollateralProposalResponse2Choice `protobuf:"bytes,3,opt,name=proposal_response,json=proposalResponse,proto3" json:"proposal_response,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1              `protobuf:"bytes,4,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CollateralProposalResponseV03) Reset() {
+	*x = CollateralProposalResponseV03{}
+	mi := &file_iso20022_colr_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralProposalResponseV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralProposalResponseV03) ProtoMessage() {}
+
+func (x *CollateralProposalResponseV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralProposalResponseV03.ProtoReflect.Descriptor instead.
+func (*CollateralProposalResponseV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CollateralProposalResponseV03) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralProposalResponseV03) GetObligation() *modelpb.Obligation3 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralProposalResponseV03) GetProposalResponse() *modelpb.CollateralProposalResponse2Choice {
+	if x != nil {
+		return x.ProposalResponse
+	}
+	return nil
+}
+
+func (x *CollateralProposalResponseV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The CollateralProposalResponse message is sent by the collateral taker or its collateral manager to the collateral giver or its collateral manager to either accept or reject the collateral which has been proposed for the margin call. This message applies to both initial and counter proposals. If the collateral proposal is rejected then a new collateral proposal must be made.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The CollateralProposalResponse message can be sent in response to a previously received CollateralProposal message in order to accept or reject the collateral that has been proposed to cover the margin call.
+type CollateralProposalResponseV04 struct {
+	state                     protoimpl.MessageState                     `protogen:"open.v1"`
+	TransactionIdentification *string                                    `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation4                       `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	ProposalResponse          *modelpb.CollateralProposalResponse2Choice `protobuf:"bytes,3,opt,name=proposal_response,json=proposalResponse,proto3" json:"proposal_response,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1              `protobuf:"bytes,4,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CollateralProposalResponseV04) Reset() {
+	*x = CollateralProposalResponseV04{}
+	mi := &file_iso20022_colr_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralProposalResponseV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralProposalResponseV04) ProtoMessage() {}
+
+func (x *CollateralProposalResponseV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralProposalResponseV04.ProtoReflect.Descriptor instead.
+func (*CollateralProposalResponseV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CollateralProposalResponseV04) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralProposalResponseV04) GetObligation() *modelpb.Obligation4 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralProposalResponseV04) GetProposalResponse() *modelpb.CollateralProposalResponse2Choice {
+	if x != nil {
+		return x.ProposalResponse
+	}
+	return nil
+}
+
+func (x *CollateralProposalResponseV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The CollateralProposalResponse message is sent by the collateral taker or its collateral manager to the collateral giver or its collateral manager to either accept or reject the collateral which has been proposed for the margin call. This message applies to both initial and counter proposals. If the collateral proposal is rejected then a new collateral proposal must be made.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The CollateralProposalResponse message can be sent in response to a previously received CollateralProposal message in order to accept or reject the collateral that has been proposed to cover the margin call.
+type CollateralProposalResponseV05 struct {
+	state                     protoimpl.MessageState                     `protogen:"open.v1"`
+	TransactionIdentification *string                                    `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation5                       `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	ProposalResponse          *modelpb.CollateralProposalResponse3Choice `protobuf:"bytes,3,opt,name=proposal_response,json=proposalResponse,proto3" json:"proposal_response,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1              `protobuf:"bytes,4,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CollateralProposalResponseV05) Reset() {
+	*x = CollateralProposalResponseV05{}
+	mi := &file_iso20022_colr_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralProposalResponseV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralProposalResponseV05) ProtoMessage() {}
+
+func (x *CollateralProposalResponseV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralProposalResponseV05.ProtoReflect.Descriptor instead.
+func (*CollateralProposalResponseV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CollateralProposalResponseV05) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralProposalResponseV05) GetObligation() *modelpb.Obligation5 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralProposalResponseV05) GetProposalResponse() *modelpb.CollateralProposalResponse3Choice {
+	if x != nil {
+		return x.ProposalResponse
+	}
+	return nil
+}
+
+func (x *CollateralProposalResponseV05) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// This message is sent by the collateral giver or its collateral manager to the collateral taker or its collateral manager, to propose the collateral to be delivered. This message is sent once the Margin Call is agreed or partially agreed and can be used for new collateral at the initiation of an exposure or for additional collateral for variation of an existing exposure. This message is used for both initial collateral proposal and subsequent counter proposals.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// This message is sent once the Margin Call is agreed or partially agreed and can be used for new collateral at the initiation of an exposure or for additional collateral for variation of an existing exposure. The collateral proposal can include securities, cash and other types of collateral.
+type CollateralProposalV03 struct {
+	state                     protoimpl.MessageState        `protogen:"open.v1"`
+	TransactionIdentification *string                       `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation3          `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                 *modelpb.Agreement2           `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	TypeAndDetails            *modelpb.Proposal3            `protobuf:"bytes,4,opt,name=type_and_details,json=typeAndDetails,proto3" json:"type_and_details,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1 `protobuf:"bytes,5,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CollateralProposalV03) Reset() {
+	*x = CollateralProposalV03{}
+	mi := &file_iso20022_colr_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralProposalV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralProposalV03) ProtoMessage() {}
+
+func (x *CollateralProposalV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralProposalV03.ProtoReflect.Descriptor instead.
+func (*CollateralProposalV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CollateralProposalV03) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralProposalV03) GetObligation() *modelpb.Obligation3 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralProposalV03) GetAgreement() *modelpb.Agreement2 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *CollateralProposalV03) GetTypeAndDetails() *modelpb.Proposal3 {
+	if x != nil {
+		return x.TypeAndDetails
+	}
+	return nil
+}
+
+func (x *CollateralProposalV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The CollateralProposal message is sent by the collateral giver or its collateral manager to the collateral taker or its collateral manager, to propose the collateral to be delivered. This message is sent once the Margin Call is agreed or partially agreed and can be used for new collateral at the initiation of an exposure or for additional collateral for variation of an existing exposure. This message is used for both initial collateral proposal and subsequent counter proposals.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// This message is sent once the Margin Call is agreed or partially agreed and can be used for new collateral at the initiation of an exposure or for additional collateral for variation of an existing exposure. The collateral proposal can include securities, cash and other types of collateral.
+type CollateralProposalV04 struct {
+	state                     protoimpl.MessageState        `protogen:"open.v1"`
+	TransactionIdentification *string                       `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation4          `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                 *modelpb.Agreement4           `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	TypeAndDetails            *modelpb.Proposal4            `protobuf:"bytes,4,opt,name=type_and_details,json=typeAndDetails,proto3" json:"type_and_details,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1 `protobuf:"bytes,5,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CollateralProposalV04) Reset() {
+	*x = CollateralProposalV04{}
+	mi := &file_iso20022_colr_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralProposalV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralProposalV04) ProtoMessage() {}
+
+func (x *CollateralProposalV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralProposalV04.ProtoReflect.Descriptor instead.
+func (*CollateralProposalV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CollateralProposalV04) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralProposalV04) GetObligation() *modelpb.Obligation4 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralProposalV04) GetAgreement() *modelpb.Agreement4 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *CollateralProposalV04) GetTypeAndDetails() *modelpb.Proposal4 {
+	if x != nil {
+		return x.TypeAndDetails
+	}
+	return nil
+}
+
+func (x *CollateralProposalV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The CollateralProposal message is sent by the collateral giver or its collateral manager to the collateral taker or its collateral manager, to propose the collateral to be delivered. This message is sent once the Margin Call is agreed or partially agreed and can be used for new collateral at the initiation of an exposure or for additional collateral for variation of an existing exposure. This message is used for both initial collateral proposal and subsequent counter proposals.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// This message is sent once the Margin Call is agreed or partially agreed and can be used for new collateral at the initiation of an exposure or for additional collateral for variation of an existing exposure. The collateral proposal can include securities, cash and other types of collateral.
+type CollateralProposalV05 struct {
+	state                     protoimpl.MessageState        `protogen:"open.v1"`
+	TransactionIdentification *string                       `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation5          `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                 *modelpb.Agreement4           `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	TypeAndDetails            *modelpb.Proposal5            `protobuf:"bytes,4,opt,name=type_and_details,json=typeAndDetails,proto3" json:"type_and_details,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1 `protobuf:"bytes,5,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CollateralProposalV05) Reset() {
+	*x = CollateralProposalV05{}
+	mi := &file_iso20022_colr_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralProposalV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralProposalV05) ProtoMessage() {}
+
+func (x *CollateralProposalV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralProposalV05.ProtoReflect.Descriptor instead.
+func (*CollateralProposalV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CollateralProposalV05) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralProposalV05) GetObligation() *modelpb.Obligation5 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralProposalV05) GetAgreement() *modelpb.Agreement4 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *CollateralProposalV05) GetTypeAndDetails() *modelpb.Proposal5 {
+	if x != nil {
+		return x.TypeAndDetails
+	}
+	return nil
+}
+
+func (x *CollateralProposalV05) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// This CollateralSubstitutionConfirmation message is sent by:
+// - the collateral taker or its collateral manager to the collateral giver or its collateral manager, or
+// - the collateral giver or its collateral manager to the collateral taker or its collateral manager.
+// This message confirms the collateral delivery. The collateral taker will only release the return of collateral when the new piece of collateral is received. The collateral giver sends the collateral taker the notification that the collateral substitution (that is new piece(s) of collateral) have been released. In the event that multiple pieces of collateral are being delivered in place of the collateral due to be returned by the giver, this message should only be generated once all collateral pieces have been agreed between both parties. Then the taker confirms that the collateral substitution (that is all pieces have been received) and acknowledges return of collateral.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// This message confirms the collateral delivery. The collateral taker will only release the return of collateral when the new piece of collateral is received. The collateral giver sends the collateral taker the notification that the collateral substitution (that is new piece(s) of collateral) have been released.
+type CollateralSubstitutionConfirmationV03 struct {
+	state                     protoimpl.MessageState           `protogen:"open.v1"`
+	TransactionIdentification *string                          `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation3             `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                 *modelpb.Agreement2              `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	SubstitutionConfirmation  *modelpb.CollateralConfirmation1 `protobuf:"bytes,4,opt,name=substitution_confirmation,json=substitutionConfirmation,proto3" json:"substitution_confirmation,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1    `protobuf:"bytes,5,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CollateralSubstitutionConfirmationV03) Reset() {
+	*x = CollateralSubstitutionConfirmationV03{}
+	mi := &file_iso20022_colr_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralSubstitutionConfirmationV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralSubstitutionConfirmationV03) ProtoMessage() {}
+
+func (x *CollateralSubstitutionConfirmationV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralSubstitutionConfirmationV03.ProtoReflect.Descriptor instead.
+func (*CollateralSubstitutionConfirmationV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CollateralSubstitutionConfirmationV03) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralSubstitutionConfirmationV03) GetObligation() *modelpb.Obligation3 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionConfirmationV03) GetAgreement() *modelpb.Agreement2 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionConfirmationV03) GetSubstitutionConfirmation() *modelpb.CollateralConfirmation1 {
+	if x != nil {
+		return x.SubstitutionConfirmation
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionConfirmationV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The CollateralSubstitutionConfirmation message is sent by:
+// - the collateral taker or its collateral manager to the collateral giver or its collateral manager, or
+// - the collateral giver or its collateral manager to the collateral taker or its collateral manager.
+// This message confirms the collateral delivery. The collateral taker will only release the return of collateral when the new piece of collateral is received. The collateral giver sends the collateral taker the notification that the collateral substitution (that is new piece(s) of collateral) have been released. In the event that multiple pieces of collateral are being delivered in place of the collateral due to be returned by the giver, this message should only be generated once all collateral pieces have been agreed between both parties. Then the taker confirms that the collateral substitution (that is all pieces have been received) and acknowledges return of collateral.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// This message confirms the collateral delivery. The collateral taker will only release the return of collateral when the new piece of collateral is received. The collateral giver sends the collateral taker the notification that the collateral substitution (that is new piece(s) of collateral) have been released.
+type CollateralSubstitutionConfirmationV04 struct {
+	state                     protoimpl.MessageState           `protogen:"open.v1"`
+	TransactionIdentification *string                          `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation4             `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                 *modelpb.Agreement4              `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	SubstitutionConfirmation  *modelpb.CollateralConfirmation1 `protobuf:"bytes,4,opt,name=substitution_confirmation,json=substitutionConfirmation,proto3" json:"substitution_confirmation,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1    `protobuf:"bytes,5,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CollateralSubstitutionConfirmationV04) Reset() {
+	*x = CollateralSubstitutionConfirmationV04{}
+	mi := &file_iso20022_colr_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralSubstitutionConfirmationV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralSubstitutionConfirmationV04) ProtoMessage() {}
+
+func (x *CollateralSubstitutionConfirmationV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralSubstitutionConfirmationV04.ProtoReflect.Descriptor instead.
+func (*CollateralSubstitutionConfirmationV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CollateralSubstitutionConfirmationV04) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralSubstitutionConfirmationV04) GetObligation() *modelpb.Obligation4 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionConfirmationV04) GetAgreement() *modelpb.Agreement4 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionConfirmationV04) GetSubstitutionConfirmation() *modelpb.CollateralConfirmation1 {
+	if x != nil {
+		return x.SubstitutionConfirmation
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionConfirmationV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// This CollateralSubstitutionRequest message is sent by either the collateral giver or its collateral manager to the collateral taker or its collateral manager. It is used to request a substitution of collateral by specifying the collateral to be returned and proposing the new type(s) of collateral to be delivered. Note: There are cases where the collateral taker can initiate the CollateralSubstitutionRequest message, for example in case of breach in the concentration limit.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The CollateralSubstitutionRequest message can be sent by either the collateral giver or collateral taker in order to substitute collateral that is already held by the other party.
+type CollateralSubstitutionRequestV03 struct {
+	state                         protoimpl.MessageState           `protogen:"open.v1"`
+	TransactionIdentification     *string                          `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                    *modelpb.Obligation3             `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                     *modelpb.Agreement2              `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	CollateralSubstitutionReturn  *modelpb.CollateralSubstitution2 `protobuf:"bytes,4,opt,name=collateral_substitution_return,json=collateralSubstitutionReturn,proto3" json:"collateral_substitution_return,omitempty"`
+	CollateralSubstitutionDeliver *modelpb.CollateralSubstitution3 `protobuf:"bytes,5,opt,name=collateral_substitution_deliver,json=collateralSubstitutionDeliver,proto3" json:"collateral_substitution_deliver,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1    `protobuf:"bytes,6,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *CollateralSubstitutionRequestV03) Reset() {
+	*x = CollateralSubstitutionRequestV03{}
+	mi := &file_iso20022_colr_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralSubstitutionRequestV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralSubstitutionRequestV03) ProtoMessage() {}
+
+func (x *CollateralSubstitutionRequestV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralSubstitutionRequestV03.ProtoReflect.Descriptor instead.
+func (*CollateralSubstitutionRequestV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CollateralSubstitutionRequestV03) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralSubstitutionRequestV03) GetObligation() *modelpb.Obligation3 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionRequestV03) GetAgreement() *modelpb.Agreement2 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionRequestV03) GetCollateralSubstitutionReturn() *modelpb.CollateralSubstitution2 {
+	if x != nil {
+		return x.CollateralSubstitutionReturn
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionRequestV03) GetCollateralSubstitutionDeliver() *modelpb.CollateralSubstitution3 {
+	if x != nil {
+		return x.CollateralSubstitutionDeliver
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionRequestV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The CollateralSubstitutionRequest message is sent by either the collateral giver or its collateral manager to the collateral taker or its collateral manager. It is used to request a substitution of collateral by specifying the collateral to be returned and proposing the new type(s) of collateral to be delivered. Note: There are cases where the collateral taker can initiate the CollateralSubstitutionRequest message, for example in case of breach in the concentration limit.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The CollateralSubstitutionRequest message can be sent by either the collateral giver or collateral taker in order to substitute collateral that is already held by the other party.
+type CollateralSubstitutionRequestV04 struct {
+	state                         protoimpl.MessageState           `protogen:"open.v1"`
+	TransactionIdentification     *string                          `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                    *modelpb.Obligation4             `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                     *modelpb.Agreement4              `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	CollateralSubstitutionReturn  *modelpb.CollateralSubstitution5 `protobuf:"bytes,4,opt,name=collateral_substitution_return,json=collateralSubstitutionReturn,proto3" json:"collateral_substitution_return,omitempty"`
+	CollateralSubstitutionDeliver *modelpb.CollateralSubstitution4 `protobuf:"bytes,5,opt,name=collateral_substitution_deliver,json=collateralSubstitutionDeliver,proto3" json:"collateral_substitution_deliver,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1    `protobuf:"bytes,6,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *CollateralSubstitutionRequestV04) Reset() {
+	*x = CollateralSubstitutionRequestV04{}
+	mi := &file_iso20022_colr_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralSubstitutionRequestV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralSubstitutionRequestV04) ProtoMessage() {}
+
+func (x *CollateralSubstitutionRequestV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralSubstitutionRequestV04.ProtoReflect.Descriptor instead.
+func (*CollateralSubstitutionRequestV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CollateralSubstitutionRequestV04) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralSubstitutionRequestV04) GetObligation() *modelpb.Obligation4 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionRequestV04) GetAgreement() *modelpb.Agreement4 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionRequestV04) GetCollateralSubstitutionReturn() *modelpb.CollateralSubstitution5 {
+	if x != nil {
+		return x.CollateralSubstitutionReturn
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionRequestV04) GetCollateralSubstitutionDeliver() *modelpb.CollateralSubstitution4 {
+	if x != nil {
+		return x.CollateralSubstitutionDeliver
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionRequestV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// This message is sent by either the collateral taker or its collateral manager to the collateral giver or its collateral manager. This is a response to the CollateralSubstitutionRequest message and the collateral proposed in the substitution request can be accepted or rejected. If the collateral proposed is rejected then a new CollateralSubstitutionRequest should be sent. Note: There are cases where the collateral giver will send this message when the collateral taker has initiated the CollateralSubstitutionRequest message, for example in case of breach in the concentration limit.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// This is a response to the CollateralSubstitutionRequest message and the collateral proposed in the substitution request can be accepted or rejected.
+type CollateralSubstitutionResponseV03 struct {
+	state                     protoimpl.MessageState         `protogen:"open.v1"`
+	TransactionIdentification *string                        `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation3           `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                 *modelpb.Agreement2            `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	SubstitutionResponse      *modelpb.SubstitutionResponse1 `protobuf:"bytes,4,opt,name=substitution_response,json=substitutionResponse,proto3" json:"substitution_response,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1  `protobuf:"bytes,5,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CollateralSubstitutionResponseV03) Reset() {
+	*x = CollateralSubstitutionResponseV03{}
+	mi := &file_iso20022_colr_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralSubstitutionResponseV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralSubstitutionResponseV03) ProtoMessage() {}
+
+func (x *CollateralSubstitutionResponseV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralSubstitutionResponseV03.ProtoReflect.Descriptor instead.
+func (*CollateralSubstitutionResponseV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CollateralSubstitutionResponseV03) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralSubstitutionResponseV03) GetObligation() *modelpb.Obligation3 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionResponseV03) GetAgreement() *modelpb.Agreement2 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionResponseV03) GetSubstitutionResponse() *modelpb.SubstitutionResponse1 {
+	if x != nil {
+		return x.SubstitutionResponse
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionResponseV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The CollateralSubstitutionResponse message is sent by either the collateral taker or its collateral manager to the collateral giver or its collateral manager. This is a response to the CollateralSubstitutionRequest message and the collateral proposed in the substitution request can be accepted or rejected. If the collateral proposed is rejected then a new CollateralSubstitutionRequest should be sent. Note: There are cases where the collateral giver will send this message when the collateral taker has initiated the CollateralSubstitutionRequest message, for example in case of breach in the concentration limit.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// This is a response to the CollateralSubstitutionRequest message and the collateral proposed in the substitution request can be accepted or rejected.
+type CollateralSubstitutionResponseV04 struct {
+	state                     protoimpl.MessageState         `protogen:"open.v1"`
+	TransactionIdentification *string                        `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation4           `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                 *modelpb.Agreement4            `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	SubstitutionResponse      *modelpb.SubstitutionResponse1 `protobuf:"bytes,4,opt,name=substitution_response,json=substitutionResponse,proto3" json:"substitution_response,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1  `protobuf:"bytes,5,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CollateralSubstitutionResponseV04) Reset() {
+	*x = CollateralSubstitutionResponseV04{}
+	mi := &file_iso20022_colr_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralSubstitutionResponseV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralSubstitutionResponseV04) ProtoMessage() {}
+
+func (x *CollateralSubstitutionResponseV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralSubstitutionResponseV04.ProtoReflect.Descriptor instead.
+func (*CollateralSubstitutionResponseV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CollateralSubstitutionResponseV04) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *CollateralSubstitutionResponseV04) GetObligation() *modelpb.Obligation4 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionResponseV04) GetAgreement() *modelpb.Agreement4 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionResponseV04) GetSubstitutionResponse() *modelpb.SubstitutionResponse1 {
+	if x != nil {
+		return x.SubstitutionResponse
+	}
+	return nil
+}
+
+func (x *CollateralSubstitutionResponseV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+type Document00300103 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *MarginCallRequestV03  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300103) Reset() {
+	*x = Document00300103{}
+	mi := &file_iso20022_colr_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300103) ProtoMessage() {}
+
+func (x *Document00300103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300103.ProtoReflect.Descriptor instead.
+func (*Document00300103) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Document00300103) GetMessage() *MarginCallRequestV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00300104 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *MarginCallRequestV04  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00300104) Reset() {
+	*x = Document00300104{}
+	mi := &file_iso20022_colr_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00300104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00300104) ProtoMessage() {}
+
+func (x *Document00300104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00300104.ProtoReflect.Descriptor instead.
+func (*Document00300104) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Document00300104) GetMessage() *MarginCallRequestV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400103 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *MarginCallResponseV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400103) Reset() {
+	*x = Document00400103{}
+	mi := &file_iso20022_colr_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400103) ProtoMessage() {}
+
+func (x *Document00400103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400103.ProtoReflect.Descriptor instead.
+func (*Document00400103) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Document00400103) GetMessage() *MarginCallResponseV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00400104 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *MarginCallResponseV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00400104) Reset() {
+	*x = Document00400104{}
+	mi := &file_iso20022_colr_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00400104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00400104) ProtoMessage() {}
+
+func (x *Document00400104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00400104.ProtoReflect.Descriptor instead.
+func (*Document00400104) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Document00400104) GetMessage() *MarginCallResponseV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500103 struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Message       *CollateralManagementCancellationRequestV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500103) Reset() {
+	*x = Document00500103{}
+	mi := &file_iso20022_colr_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500103) ProtoMessage() {}
+
+func (x *Document00500103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500103.ProtoReflect.Descriptor instead.
+func (*Document00500103) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Document00500103) GetMessage() *CollateralManagementCancellationRequestV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00500104 struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Message       *CollateralManagementCancellationRequestV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00500104) Reset() {
+	*x = Document00500104{}
+	mi := &file_iso20022_colr_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00500104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00500104) ProtoMessage() {}
+
+func (x *Document00500104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00500104.ProtoReflect.Descriptor instead.
+func (*Document00500104) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Document00500104) GetMessage() *CollateralManagementCancellationRequestV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600103 struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	Message       *CollateralManagementCancellationStatusV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600103) Reset() {
+	*x = Document00600103{}
+	mi := &file_iso20022_colr_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600103) ProtoMessage() {}
+
+func (x *Document00600103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600103.ProtoReflect.Descriptor instead.
+func (*Document00600103) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Document00600103) GetMessage() *CollateralManagementCancellationStatusV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00600104 struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	Message       *CollateralManagementCancellationStatusV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00600104) Reset() {
+	*x = Document00600104{}
+	mi := &file_iso20022_colr_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00600104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00600104) ProtoMessage() {}
+
+func (x *Document00600104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00600104.ProtoReflect.Descriptor instead.
+func (*Document00600104) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Document00600104) GetMessage() *CollateralManagementCancellationStatusV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00700103 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *CollateralProposalV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00700103) Reset() {
+	*x = Document00700103{}
+	mi := &file_iso20022_colr_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00700103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00700103) ProtoMessage() {}
+
+func (x *Document00700103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00700103.ProtoReflect.Descriptor instead.
+func (*Document00700103) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Document00700103) GetMessage() *CollateralProposalV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00700104 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *CollateralProposalV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00700104) Reset() {
+	*x = Document00700104{}
+	mi := &file_iso20022_colr_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00700104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00700104) ProtoMessage() {}
+
+func (x *Document00700104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00700104.ProtoReflect.Descriptor instead.
+func (*Document00700104) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Document00700104) GetMessage() *CollateralProposalV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00700105 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *CollateralProposalV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00700105) Reset() {
+	*x = Document00700105{}
+	mi := &file_iso20022_colr_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00700105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00700105) ProtoMessage() {}
+
+func (x *Document00700105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00700105.ProtoReflect.Descriptor instead.
+func (*Document00700105) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Document00700105) GetMessage() *CollateralProposalV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800103 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *CollateralProposalResponseV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800103) Reset() {
+	*x = Document00800103{}
+	mi := &file_iso20022_colr_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800103) ProtoMessage() {}
+
+func (x *Document00800103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800103.ProtoReflect.Descriptor instead.
+func (*Document00800103) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Document00800103) GetMessage() *CollateralProposalResponseV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800104 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *CollateralProposalResponseV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800104) Reset() {
+	*x = Document00800104{}
+	mi := &file_iso20022_colr_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800104) ProtoMessage() {}
+
+func (x *Document00800104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800104.ProtoReflect.Descriptor instead.
+func (*Document00800104) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Document00800104) GetMessage() *CollateralProposalResponseV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800105 struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Message       *CollateralProposalResponseV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800105) Reset() {
+	*x = Document00800105{}
+	mi := &file_iso20022_colr_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800105) ProtoMessage() {}
+
+func (x *Document00800105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800105.ProtoReflect.Descriptor instead.
+func (*Document00800105) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Document00800105) GetMessage() *CollateralProposalResponseV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900103 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *MarginCallDisputeNotificationV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900103) Reset() {
+	*x = Document00900103{}
+	mi := &file_iso20022_colr_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900103) ProtoMessage() {}
+
+func (x *Document00900103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900103.ProtoReflect.Descriptor instead.
+func (*Document00900103) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Document00900103) GetMessage() *MarginCallDisputeNotificationV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00900104 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *MarginCallDisputeNotificationV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00900104) Reset() {
+	*x = Document00900104{}
+	mi := &file_iso20022_colr_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00900104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00900104) ProtoMessage() {}
+
+func (x *Document00900104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00900104.ProtoReflect.Descriptor instead.
+func (*Document00900104) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Document00900104) GetMessage() *MarginCallDisputeNotificationV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000103 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *CollateralSubstitutionRequestV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000103) Reset() {
+	*x = Document01000103{}
+	mi := &file_iso20022_colr_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000103) ProtoMessage() {}
+
+func (x *Document01000103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000103.ProtoReflect.Descriptor instead.
+func (*Document01000103) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Document01000103) GetMessage() *CollateralSubstitutionRequestV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01000104 struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Message       *CollateralSubstitutionRequestV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01000104) Reset() {
+	*x = Document01000104{}
+	mi := &file_iso20022_colr_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01000104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01000104) ProtoMessage() {}
+
+func (x *Document01000104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01000104.ProtoReflect.Descriptor instead.
+func (*Document01000104) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Document01000104) GetMessage() *CollateralSubstitutionRequestV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01100103 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *CollateralSubstitutionResponseV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01100103) Reset() {
+	*x = Document01100103{}
+	mi := &file_iso20022_colr_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01100103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01100103) ProtoMessage() {}
+
+func (x *Document01100103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01100103.ProtoReflect.Descriptor instead.
+func (*Document01100103) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Document01100103) GetMessage() *CollateralSubstitutionResponseV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01100104 struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Message       *CollateralSubstitutionResponseV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01100104) Reset() {
+	*x = Document01100104{}
+	mi := &file_iso20022_colr_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01100104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01100104) ProtoMessage() {}
+
+func (x *Document01100104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01100104.ProtoReflect.Descriptor instead.
+func (*Document01100104) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Document01100104) GetMessage() *CollateralSubstitutionResponseV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01200103 struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Message       *CollateralSubstitutionConfirmationV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01200103) Reset() {
+	*x = Document01200103{}
+	mi := &file_iso20022_colr_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01200103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01200103) ProtoMessage() {}
+
+func (x *Document01200103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01200103.ProtoReflect.Descriptor instead.
+func (*Document01200103) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Document01200103) GetMessage() *CollateralSubstitutionConfirmationV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01200104 struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Message       *CollateralSubstitutionConfirmationV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01200104) Reset() {
+	*x = Document01200104{}
+	mi := &file_iso20022_colr_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01200104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01200104) ProtoMessage() {}
+
+func (x *Document01200104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01200104.ProtoReflect.Descriptor instead.
+func (*Document01200104) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Document01200104) GetMessage() *CollateralSubstitutionConfirmationV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01300103 struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Message       *InterestPaymentRequestV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01300103) Reset() {
+	*x = Document01300103{}
+	mi := &file_iso20022_colr_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01300103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01300103) ProtoMessage() {}
+
+func (x *Document01300103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01300103.ProtoReflect.Descriptor instead.
+func (*Document01300103) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Document01300103) GetMessage() *InterestPaymentRequestV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01300104 struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Message       *InterestPaymentRequestV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01300104) Reset() {
+	*x = Document01300104{}
+	mi := &file_iso20022_colr_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01300104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01300104) ProtoMessage() {}
+
+func (x *Document01300104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01300104.ProtoReflect.Descriptor instead.
+func (*Document01300104) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Document01300104) GetMessage() *InterestPaymentRequestV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01400103 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Message       *InterestPaymentResponseV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01400103) Reset() {
+	*x = Document01400103{}
+	mi := &file_iso20022_colr_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01400103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01400103) ProtoMessage() {}
+
+func (x *Document01400103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01400103.ProtoReflect.Descriptor instead.
+func (*Document01400103) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Document01400103) GetMessage() *InterestPaymentResponseV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01400104 struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Message       *InterestPaymentResponseV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01400104) Reset() {
+	*x = Document01400104{}
+	mi := &file_iso20022_colr_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01400104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01400104) ProtoMessage() {}
+
+func (x *Document01400104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01400104.ProtoReflect.Descriptor instead.
+func (*Document01400104) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Document01400104) GetMessage() *InterestPaymentResponseV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01500103 struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Message       *InterestPaymentStatementV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01500103) Reset() {
+	*x = Document01500103{}
+	mi := &file_iso20022_colr_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01500103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01500103) ProtoMessage() {}
+
+func (x *Document01500103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01500103.ProtoReflect.Descriptor instead.
+func (*Document01500103) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Document01500103) GetMessage() *InterestPaymentStatementV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01500104 struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Message       *InterestPaymentStatementV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01500104) Reset() {
+	*x = Document01500104{}
+	mi := &file_iso20022_colr_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01500104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01500104) ProtoMessage() {}
+
+func (x *Document01500104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01500104.ProtoReflect.Descriptor instead.
+func (*Document01500104) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Document01500104) GetMessage() *InterestPaymentStatementV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01600102 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *CollateralAndExposureReportV02 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01600102) Reset() {
+	*x = Document01600102{}
+	mi := &file_iso20022_colr_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01600102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01600102) ProtoMessage() {}
+
+func (x *Document01600102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01600102.ProtoReflect.Descriptor instead.
+func (*Document01600102) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Document01600102) GetMessage() *CollateralAndExposureReportV02 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01600103 struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Message       *CollateralAndExposureReportV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01600103) Reset() {
+	*x = Document01600103{}
+	mi := &file_iso20022_colr_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01600103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01600103) ProtoMessage() {}
+
+func (x *Document01600103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01600103.ProtoReflect.Descriptor instead.
+func (*Document01600103) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Document01600103) GetMessage() *CollateralAndExposureReportV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// Scope
+// This InterestPaymentRequest message is sent by either;
+// - the collateral taker or its collateral manager to the collateral giver or its collateral manager, or
+// - the collateral giver or its collateral manager to the collateral taker or its collateral manager
+// It is used to request payment or advise the amount due for interest calculated for a specified period. The interest is based on the amount of collateral that has been held during the calculation period. It is possible to send these messages on a bi-lateral basis for matching.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The InterestPaymentRequest message is used to advise the interest amount calculated for a specific period or request payment for an interest amount for a specific period.
+type InterestPaymentRequestV03 struct {
+	state                     protoimpl.MessageState        `protogen:"open.v1"`
+	TransactionIdentification *string                       `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation3          `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                 *modelpb.Agreement2           `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	InterestDueToA            *modelpb.InterestAmount1      `protobuf:"bytes,4,opt,name=interest_due_to_a,json=interestDueToA,proto3" json:"interest_due_to_a,omitempty"`
+	InterestDueToB            *modelpb.InterestAmount1      `protobuf:"bytes,5,opt,name=interest_due_to_b,json=interestDueToB,proto3" json:"interest_due_to_b,omitempty"`
+	NetAmountDetails          *modelpb.InterestResult1      `protobuf:"bytes,6,opt,name=net_amount_details,json=netAmountDetails,proto3" json:"net_amount_details,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1 `protobuf:"bytes,7,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *InterestPaymentRequestV03) Reset() {
+	*x = InterestPaymentRequestV03{}
+	mi := &file_iso20022_colr_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterestPaymentRequestV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterestPaymentRequestV03) ProtoMessage() {}
+
+func (x *InterestPaymentRequestV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterestPaymentRequestV03.ProtoReflect.Descriptor instead.
+func (*InterestPaymentRequestV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *InterestPaymentRequestV03) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *InterestPaymentRequestV03) GetObligation() *modelpb.Obligation3 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *InterestPaymentRequestV03) GetAgreement() *modelpb.Agreement2 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *InterestPaymentRequestV03) GetInterestDueToA() *modelpb.InterestAmount1 {
+	if x != nil {
+		return x.InterestDueToA
+	}
+	return nil
+}
+
+func (x *InterestPaymentRequestV03) GetInterestDueToB() *modelpb.InterestAmount1 {
+	if x != nil {
+		return x.InterestDueToB
+	}
+	return nil
+}
+
+func (x *InterestPaymentRequestV03) GetNetAmountDetails() *modelpb.InterestResult1 {
+	if x != nil {
+		return x.NetAmountDetails
+	}
+	return nil
+}
+
+func (x *InterestPaymentRequestV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The InterestPaymentRequest message is sent by either;
+// - the collateral taker or its collateral manager to the collateral giver or its collateral manager, or
+// - the collateral giver or its collateral manager to the collateral taker or its collateral manager
+// It is used to request payment or advise the amount due for interest calculated for a specified period. The interest is based on the amount of collateral that has been held during the calculation period. It is possible to send these messages on a bi-lateral basis for matching.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The InterestPaymentRequest message is used to advise the interest amount calculated for a specific period or request payment for an interest amount for a specific period.
+type InterestPaymentRequestV04 struct {
+	state                     protoimpl.MessageState        `protogen:"open.v1"`
+	TransactionIdentification *string                       `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation4          `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                 *modelpb.Agreement4           `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	InterestDueToA            *modelpb.InterestAmount1      `protobuf:"bytes,4,opt,name=interest_due_to_a,json=interestDueToA,proto3" json:"interest_due_to_a,omitempty"`
+	InterestDueToB            *modelpb.InterestAmount1      `protobuf:"bytes,5,opt,name=interest_due_to_b,json=interestDueToB,proto3" json:"interest_due_to_b,omitempty"`
+	NetAmountDetails          *modelpb.InterestResult1      `protobuf:"bytes,6,opt,name=net_amount_details,json=netAmountDetails,proto3" json:"net_amount_details,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1 `protobuf:"bytes,7,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *InterestPaymentRequestV04) Reset() {
+	*x = InterestPaymentRequestV04{}
+	mi := &file_iso20022_colr_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterestPaymentRequestV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterestPaymentRequestV04) ProtoMessage() {}
+
+func (x *InterestPaymentRequestV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterestPaymentRequestV04.ProtoReflect.Descriptor instead.
+func (*InterestPaymentRequestV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *InterestPaymentRequestV04) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *InterestPaymentRequestV04) GetObligation() *modelpb.Obligation4 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *InterestPaymentRequestV04) GetAgreement() *modelpb.Agreement4 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *InterestPaymentRequestV04) GetInterestDueToA() *modelpb.InterestAmount1 {
+	if x != nil {
+		return x.InterestDueToA
+	}
+	return nil
+}
+
+func (x *InterestPaymentRequestV04) GetInterestDueToB() *modelpb.InterestAmount1 {
+	if x != nil {
+		return x.InterestDueToB
+	}
+	return nil
+}
+
+func (x *InterestPaymentRequestV04) GetNetAmountDetails() *modelpb.InterestResult1 {
+	if x != nil {
+		return x.NetAmountDetails
+	}
+	return nil
+}
+
+func (x *InterestPaymentRequestV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// This InterestPaymentResponse message is sent by either;
+// - the collateral taker or its collateral manager to the collateral giver or its collateral manager, or
+// - the collateral giver or its collateral manager to the collateral taker or its collateral manager
+// This is a response to the InterestPaymentRequest message and the amount of interest requested or advised can be accepted or rejected.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The InterestPaymentResponse message is sent in response to the InterestPaymentRequest in order to accept or reject the amount of interest requested or advised. A rejection reason and information can be provide if the InterestPaymentRequest is being rejected.
+type InterestPaymentResponseV03 struct {
+	state                     protoimpl.MessageState        `protogen:"open.v1"`
+	TransactionIdentification *string                       `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation3          `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                 *modelpb.Agreement2           `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	InterestDueToA            *modelpb.InterestAmount2      `protobuf:"bytes,4,opt,name=interest_due_to_a,json=interestDueToA,proto3" json:"interest_due_to_a,omitempty"`
+	InterestDueToB            *modelpb.InterestAmount2      `protobuf:"bytes,5,opt,name=interest_due_to_b,json=interestDueToB,proto3" json:"interest_due_to_b,omitempty"`
+	InterestResponse          *modelpb.InterestResponse1    `protobuf:"bytes,6,opt,name=interest_response,json=interestResponse,proto3" json:"interest_response,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1 `protobuf:"bytes,7,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *InterestPaymentResponseV03) Reset() {
+	*x = InterestPaymentResponseV03{}
+	mi := &file_iso20022_colr_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterestPaymentResponseV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterestPaymentResponseV03) ProtoMessage() {}
+
+func (x *InterestPaymentResponseV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterestPaymentResponseV03.ProtoReflect.Descriptor instead.
+func (*InterestPaymentResponseV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *InterestPaymentResponseV03) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *InterestPaymentResponseV03) GetObligation() *modelpb.Obligation3 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *InterestPaymentResponseV03) GetAgreement() *modelpb.Agreement2 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *InterestPaymentResponseV03) GetInterestDueToA() *modelpb.InterestAmount2 {
+	if x != nil {
+		return x.InterestDueToA
+	}
+	return nil
+}
+
+func (x *InterestPaymentResponseV03) GetInterestDueToB() *modelpb.InterestAmount2 {
+	if x != nil {
+		return x.InterestDueToB
+	}
+	return nil
+}
+
+func (x *InterestPaymentResponseV03) GetInterestResponse() *modelpb.InterestResponse1 {
+	if x != nil {
+		return x.InterestResponse
+	}
+	return nil
+}
+
+func (x *InterestPaymentResponseV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The InterestPaymentResponse message is sent by either;
+// - the collateral taker or its collateral manager to the collateral giver or its collateral manager, or
+// - the collateral giver or its collateral manager to the collateral taker or its collateral manager
+// This is a response to the InterestPaymentRequest message and the amount of interest requested or advised can be accepted or rejected.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The InterestPaymentResponse message is sent in response to the InterestPaymentRequest in order to accept or reject the amount of interest requested or advised. A rejection reason and information can be provide if the InterestPaymentRequest is being rejected.
+type InterestPaymentResponseV04 struct {
+	state                     protoimpl.MessageState        `protogen:"open.v1"`
+	TransactionIdentification *string                       `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation4          `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                 *modelpb.Agreement4           `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	InterestDueToA            *modelpb.InterestAmount2      `protobuf:"bytes,4,opt,name=interest_due_to_a,json=interestDueToA,proto3" json:"interest_due_to_a,omitempty"`
+	InterestDueToB            *modelpb.InterestAmount2      `protobuf:"bytes,5,opt,name=interest_due_to_b,json=interestDueToB,proto3" json:"interest_due_to_b,omitempty"`
+	InterestResponse          *modelpb.InterestResponse1    `protobuf:"bytes,6,opt,name=interest_response,json=interestResponse,proto3" json:"interest_response,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1 `protobuf:"bytes,7,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *InterestPaymentResponseV04) Reset() {
+	*x = InterestPaymentResponseV04{}
+	mi := &file_iso20022_colr_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterestPaymentResponseV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterestPaymentResponseV04) ProtoMessage() {}
+
+func (x *InterestPaymentResponseV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterestPaymentResponseV04.ProtoReflect.Descriptor instead.
+func (*InterestPaymentResponseV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *InterestPaymentResponseV04) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *InterestPaymentResponseV04) GetObligation() *modelpb.Obligation4 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *InterestPaymentResponseV04) GetAgreement() *modelpb.Agreement4 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *InterestPaymentResponseV04) GetInterestDueToA() *modelpb.InterestAmount2 {
+	if x != nil {
+		return x.InterestDueToA
+	}
+	return nil
+}
+
+func (x *InterestPaymentResponseV04) GetInterestDueToB() *modelpb.InterestAmount2 {
+	if x != nil {
+		return x.InterestDueToB
+	}
+	return nil
+}
+
+func (x *InterestPaymentResponseV04) GetInterestResponse() *modelpb.InterestResponse1 {
+	if x != nil {
+		return x.InterestResponse
+	}
+	return nil
+}
+
+func (x *InterestPaymentResponseV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// This message is sent by either the collateral giver or its collateral manager to the collateral taker or its collateral manager. It is used to report the interest amounts calculated based on the effective posted collateral amount, over a specific period of time agreed by both parties.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The InterestPaymentStatement message is used for reporting the interest per period on collateral held.
+type InterestPaymentStatementV03 struct {
+	state                     protoimpl.MessageState        `protogen:"open.v1"`
+	TransactionIdentification *string                       `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Agreement                 *modelpb.Agreement2           `protobuf:"bytes,2,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	Obligation                *modelpb.Obligation3          `protobuf:"bytes,3,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	StatementParameters       *modelpb.Statement32          `protobuf:"bytes,4,opt,name=statement_parameters,json=statementParameters,proto3" json:"statement_parameters,omitempty"`
+	Pagination                *modelpb.Pagination           `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	InterestStatement         *modelpb.InterestStatement3   `protobuf:"bytes,6,opt,name=interest_statement,json=interestStatement,proto3" json:"interest_statement,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1 `protobuf:"bytes,7,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *InterestPaymentStatementV03) Reset() {
+	*x = InterestPaymentStatementV03{}
+	mi := &file_iso20022_colr_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterestPaymentStatementV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterestPaymentStatementV03) ProtoMessage() {}
+
+func (x *InterestPaymentStatementV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterestPaymentStatementV03.ProtoReflect.Descriptor instead.
+func (*InterestPaymentStatementV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *InterestPaymentStatementV03) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *InterestPaymentStatementV03) GetAgreement() *modelpb.Agreement2 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *InterestPaymentStatementV03) GetObligation() *modelpb.Obligation3 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *InterestPaymentStatementV03) GetStatementParameters() *modelpb.Statement32 {
+	if x != nil {
+		return x.StatementParameters
+	}
+	return nil
+}
+
+func (x *InterestPaymentStatementV03) GetPagination() *modelpb.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *InterestPaymentStatementV03) GetInterestStatement() *modelpb.InterestStatement3 {
+	if x != nil {
+		return x.InterestStatement
+	}
+	return nil
+}
+
+func (x *InterestPaymentStatementV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The InterestPaymentStatement message is sent by either the collateral giver or its collateral manager to the collateral taker or its collateral manager. It is used to report the interest amounts calculated based on the effective posted collateral amount, over a specific period of time agreed by both parties.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// The InterestPaymentStatement message is used for reporting the interest per period on collateral held.
+type InterestPaymentStatementV04 struct {
+	state                     protoimpl.MessageState        `protogen:"open.v1"`
+	TransactionIdentification *string                       `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Agreement                 *modelpb.Agreement4           `protobuf:"bytes,2,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	Obligation                *modelpb.Obligation4          `protobuf:"bytes,3,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	StatementParameters       *modelpb.Statement32          `protobuf:"bytes,4,opt,name=statement_parameters,json=statementParameters,proto3" json:"statement_parameters,omitempty"`
+	Pagination                *modelpb.Pagination           `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	InterestStatement         *modelpb.InterestStatement4   `protobuf:"bytes,6,opt,name=interest_statement,json=interestStatement,proto3" json:"interest_statement,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1 `protobuf:"bytes,7,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *InterestPaymentStatementV04) Reset() {
+	*x = InterestPaymentStatementV04{}
+	mi := &file_iso20022_colr_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterestPaymentStatementV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterestPaymentStatementV04) ProtoMessage() {}
+
+func (x *InterestPaymentStatementV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterestPaymentStatementV04.ProtoReflect.Descriptor instead.
+func (*InterestPaymentStatementV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *InterestPaymentStatementV04) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *InterestPaymentStatementV04) GetAgreement() *modelpb.Agreement4 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *InterestPaymentStatementV04) GetObligation() *modelpb.Obligation4 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *InterestPaymentStatementV04) GetStatementParameters() *modelpb.Statement32 {
+	if x != nil {
+		return x.StatementParameters
+	}
+	return nil
+}
+
+func (x *InterestPaymentStatementV04) GetPagination() *modelpb.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *InterestPaymentStatementV04) GetInterestStatement() *modelpb.InterestStatement4 {
+	if x != nil {
+		return x.InterestStatement
+	}
+	return nil
+}
+
+func (x *InterestPaymentStatementV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The MarginCallDisputeNotification message is sent by the collateral taker or its collateral manager to the collateral giver or its collateral manager to acknowledge the notification of the dispute (either full or partial dispute) of the MarginCallRequest. The message will detail the amount of the dispute and the reason.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// When there is a dispute by the collateral giver to the collateral taker a MarginCallDisputeNotification message is sent with the disputed amount (full or partial) stating the reason why the margin call is being disputed.
+type MarginCallDisputeNotificationV03 struct {
+	state                     protoimpl.MessageState              `protogen:"open.v1"`
+	TransactionIdentification *string                             `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation3                `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	DisputeNotification       *modelpb.DisputeNotification1Choice `protobuf:"bytes,3,opt,name=dispute_notification,json=disputeNotification,proto3" json:"dispute_notification,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1       `protobuf:"bytes,4,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *MarginCallDisputeNotificationV03) Reset() {
+	*x = MarginCallDisputeNotificationV03{}
+	mi := &file_iso20022_colr_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarginCallDisputeNotificationV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginCallDisputeNotificationV03) ProtoMessage() {}
+
+func (x *MarginCallDisputeNotificationV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarginCallDisputeNotificationV03.ProtoReflect.Descriptor instead.
+func (*MarginCallDisputeNotificationV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MarginCallDisputeNotificationV03) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *MarginCallDisputeNotificationV03) GetObligation() *modelpb.Obligation3 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *MarginCallDisputeNotificationV03) GetDisputeNotification() *modelpb.DisputeNotification1Choice {
+	if x != nil {
+		return x.DisputeNotification
+	}
+	return nil
+}
+
+func (x *MarginCallDisputeNotificationV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The MarginCallDisputeNotification message is sent by the collateral taker or its collateral manager to the collateral giver or its collateral manager to acknowledge the notification of the dispute (either full or partial dispute) of the MarginCallRequest. The message will detail the amount of the dispute and the reason.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// When there is a dispute by the collateral giver to the collateral taker a MarginCallDisputeNotification message is sent with the disputed amount (full or partial) stating the reason why the margin call is being disputed.
+type MarginCallDisputeNotificationV04 struct {
+	state                     protoimpl.MessageState              `protogen:"open.v1"`
+	TransactionIdentification *string                             `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation4                `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	DisputeNotification       *modelpb.DisputeNotification1Choice `protobuf:"bytes,3,opt,name=dispute_notification,json=disputeNotification,proto3" json:"dispute_notification,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1       `protobuf:"bytes,4,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *MarginCallDisputeNotificationV04) Reset() {
+	*x = MarginCallDisputeNotificationV04{}
+	mi := &file_iso20022_colr_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarginCallDisputeNotificationV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginCallDisputeNotificationV04) ProtoMessage() {}
+
+func (x *MarginCallDisputeNotificationV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarginCallDisputeNotificationV04.ProtoReflect.Descriptor instead.
+func (*MarginCallDisputeNotificationV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MarginCallDisputeNotificationV04) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *MarginCallDisputeNotificationV04) GetObligation() *modelpb.Obligation4 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *MarginCallDisputeNotificationV04) GetDisputeNotification() *modelpb.DisputeNotification1Choice {
+	if x != nil {
+		return x.DisputeNotification
+	}
+	return nil
+}
+
+func (x *MarginCallDisputeNotificationV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The MarginCallRequest message is sent by the collateral taker or its collateral manager to the collateral giver or its collateral manager
+// This message is used to request new collateral at the initiation of an exposure or request additional collateral for an existing exposure. It can also be used to recall collateral upon the collateral giver or its collateral manager's request.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// When sent by the collateral taker the MarginCallRequest message is used to:
+// - request new collateral at the initiation of an exposure
+// - request additional collateral
+// When sent by the collateral giver the MarginCallRequest message is used to:
+// - request the return of collateral
+type MarginCallRequestV03 struct {
+	state                     protoimpl.MessageState             `protogen:"open.v1"`
+	TransactionIdentification *string                            `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation3               `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                 *modelpb.Agreement2                `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	MarginDetailsDueToA       *modelpb.MarginCall1               `protobuf:"bytes,4,opt,name=margin_details_due_to_a,json=marginDetailsDueToA,proto3" json:"margin_details_due_to_a,omitempty"`
+	MarginDetailsDueToB       *modelpb.MarginCall1               `protobuf:"bytes,5,opt,name=margin_details_due_to_b,json=marginDetailsDueToB,proto3" json:"margin_details_due_to_b,omitempty"`
+	RequirementDetailsDueToA  *modelpb.MarginRequirement1Choice  `protobuf:"bytes,6,opt,name=requirement_details_due_to_a,json=requirementDetailsDueToA,proto3" json:"requirement_details_due_to_a,omitempty"`
+	RequirementDetailsDueToB  *modelpb.MarginRequirement1Choice  `protobuf:"bytes,7,opt,name=requirement_details_due_to_b,json=requirementDetailsDueToB,proto3" json:"requirement_details_due_to_b,omitempty"`
+	MarginCallResult          *modelpb.MarginCallResult3         `protobuf:"bytes,8,opt,name=margin_call_result,json=marginCallResult,proto3" json:"margin_call_result,omitempty"`
+	ExpectedCollateralDueToB  *modelpb.ExpectedCollateral1Choice `protobuf:"bytes,9,opt,name=expected_collateral_due_to_b,json=expectedCollateralDueToB,proto3" json:"expected_collateral_due_to_b,omitempty"`
+	ExpectedCollateralDueToA  *modelpb.ExpectedCollateral1Choice `protobuf:"bytes,10,opt,name=expected_collateral_due_to_a,json=expectedCollateralDueToA,proto3" json:"expected_collateral_due_to_a,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1      `protobuf:"bytes,11,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *MarginCallRequestV03) Reset() {
+	*x = MarginCallRequestV03{}
+	mi := &file_iso20022_colr_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarginCallRequestV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginCallRequestV03) ProtoMessage() {}
+
+func (x *MarginCallRequestV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarginCallRequestV03.ProtoReflect.Descriptor instead.
+func (*MarginCallRequestV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MarginCallRequestV03) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *MarginCallRequestV03) GetObligation() *modelpb.Obligation3 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV03) GetAgreement() *modelpb.Agreement2 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV03) GetMarginDetailsDueToA() *modelpb.MarginCall1 {
+	if x != nil {
+		return x.MarginDetailsDueToA
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV03) GetMarginDetailsDueToB() *modelpb.MarginCall1 {
+	if x != nil {
+		return x.MarginDetailsDueToB
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV03) GetRequirementDetailsDueToA() *modelpb.MarginRequirement1Choice {
+	if x != nil {
+		return x.RequirementDetailsDueToA
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV03) GetRequirementDetailsDueToB() *modelpb.MarginRequirement1Choice {
+	if x != nil {
+		return x.RequirementDetailsDueToB
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV03) GetMarginCallResult() *modelpb.MarginCallResult3 {
+	if x != nil {
+		return x.MarginCallResult
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV03) GetExpectedCollateralDueToB() *modelpb.ExpectedCollateral1Choice {
+	if x != nil {
+		return x.ExpectedCollateralDueToB
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV03) GetExpectedCollateralDueToA() *modelpb.ExpectedCollateral1Choice {
+	if x != nil {
+		return x.ExpectedCollateralDueToA
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The MarginCallRequest message is sent by the collateral taker or its collateral manager to the collateral giver or its collateral manager
+// This message is used to request new collateral at the initiation of an exposure or request additional collateral for an existing exposure. It can also be used to recall collateral upon the collateral giver or its collateral manager's request.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// When sent by the collateral taker the MarginCallRequest message is used to:
+// - request new collateral at the initiation of an exposure
+// - request additional collateral
+// When sent by the collateral giver the MarginCallRequest message is used to:
+// - request the return of collateral
+type MarginCallRequestV04 struct {
+	state                     protoimpl.MessageState             `protogen:"open.v1"`
+	TransactionIdentification *string                            `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation4               `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                 *modelpb.Agreement4                `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	MarginCallResult          *modelpb.MarginCallResult3         `protobuf:"bytes,4,opt,name=margin_call_result,json=marginCallResult,proto3" json:"margin_call_result,omitempty"`
+	MarginDetailsDueToA       *modelpb.MarginCall1               `protobuf:"bytes,5,opt,name=margin_details_due_to_a,json=marginDetailsDueToA,proto3" json:"margin_details_due_to_a,omitempty"`
+	MarginDetailsDueToB       *modelpb.MarginCall1               `protobuf:"bytes,6,opt,name=margin_details_due_to_b,json=marginDetailsDueToB,proto3" json:"margin_details_due_to_b,omitempty"`
+	RequirementDetailsDueToA  *modelpb.MarginRequirement1Choice  `protobuf:"bytes,7,opt,name=requirement_details_due_to_a,json=requirementDetailsDueToA,proto3" json:"requirement_details_due_to_a,omitempty"`
+	RequirementDetailsDueToB  *modelpb.MarginRequirement1Choice  `protobuf:"bytes,8,opt,name=requirement_details_due_to_b,json=requirementDetailsDueToB,proto3" json:"requirement_details_due_to_b,omitempty"`
+	ExpectedCollateralDueToA  *modelpb.ExpectedCollateral2Choice `protobuf:"bytes,9,opt,name=expected_collateral_due_to_a,json=expectedCollateralDueToA,proto3" json:"expected_collateral_due_to_a,omitempty"`
+	ExpectedCollateralDueToB  *modelpb.ExpectedCollateral2Choice `protobuf:"bytes,10,opt,name=expected_collateral_due_to_b,json=expectedCollateralDueToB,proto3" json:"expected_collateral_due_to_b,omitempty"`
+	MarginCallDetails         []*modelpb.MarginCall2             `protobuf:"bytes,11,rep,name=margin_call_details,json=marginCallDetails,proto3" json:"margin_call_details,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1      `protobuf:"bytes,12,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *MarginCallRequestV04) Reset() {
+	*x = MarginCallRequestV04{}
+	mi := &file_iso20022_colr_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarginCallRequestV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginCallRequestV04) ProtoMessage() {}
+
+func (x *MarginCallRequestV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarginCallRequestV04.ProtoReflect.Descriptor instead.
+func (*MarginCallRequestV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MarginCallRequestV04) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *MarginCallRequestV04) GetObligation() *modelpb.Obligation4 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV04) GetAgreement() *modelpb.Agreement4 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV04) GetMarginCallResult() *modelpb.MarginCallResult3 {
+	if x != nil {
+		return x.MarginCallResult
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV04) GetMarginDetailsDueToA() *modelpb.MarginCall1 {
+	if x != nil {
+		return x.MarginDetailsDueToA
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV04) GetMarginDetailsDueToB() *modelpb.MarginCall1 {
+	if x != nil {
+		return x.MarginDetailsDueToB
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV04) GetRequirementDetailsDueToA() *modelpb.MarginRequirement1Choice {
+	if x != nil {
+		return x.RequirementDetailsDueToA
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV04) GetRequirementDetailsDueToB() *modelpb.MarginRequirement1Choice {
+	if x != nil {
+		return x.RequirementDetailsDueToB
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV04) GetExpectedCollateralDueToA() *modelpb.ExpectedCollateral2Choice {
+	if x != nil {
+		return x.ExpectedCollateralDueToA
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV04) GetExpectedCollateralDueToB() *modelpb.ExpectedCollateral2Choice {
+	if x != nil {
+		return x.ExpectedCollateralDueToB
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV04) GetMarginCallDetails() []*modelpb.MarginCall2 {
+	if x != nil {
+		return x.MarginCallDetails
+	}
+	return nil
+}
+
+func (x *MarginCallRequestV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// This message is sent by the collateral giver or its collateral manager to the collateral taker or its collateral manager or vice versa. This is a response to the MarginCallRequest message. The margin call can be accepted, fully disputed or partially disputed.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// When sent by the collateral giver the MarginCallResponse message is used to:
+// - fully accept the MarginCallRequest
+// - or partially accept the MarginCallRequest.
+//
+// When sent by the collateral taker the MarginCallResponse message is used to:
+// - fully accept the recall of collateral
+// - or partially accept the recall of collateral.
+type MarginCallResponseV03 struct {
+	state                     protoimpl.MessageState        `protogen:"open.v1"`
+	TransactionIdentification *string                       `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation3          `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                 *modelpb.Agreement2           `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	MarginDetailsDueToA       *modelpb.MarginCall1          `protobuf:"bytes,4,opt,name=margin_details_due_to_a,json=marginDetailsDueToA,proto3" json:"margin_details_due_to_a,omitempty"`
+	MarginDetailsDueToB       *modelpb.MarginCall1          `protobuf:"bytes,5,opt,name=margin_details_due_to_b,json=marginDetailsDueToB,proto3" json:"margin_details_due_to_b,omitempty"`
+	AgreedAmountDueToA        *modelpb.AgreedAmount1Choice  `protobuf:"bytes,6,opt,name=agreed_amount_due_to_a,json=agreedAmountDueToA,proto3" json:"agreed_amount_due_to_a,omitempty"`
+	AgreedAmountDueToB        *modelpb.AgreedAmount1Choice  `protobuf:"bytes,7,opt,name=agreed_amount_due_to_b,json=agreedAmountDueToB,proto3" json:"agreed_amount_due_to_b,omitempty"`
+	ResponseDetails           *modelpb.Response1            `protobuf:"bytes,8,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1 `protobuf:"bytes,9,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *MarginCallResponseV03) Reset() {
+	*x = MarginCallResponseV03{}
+	mi := &file_iso20022_colr_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarginCallResponseV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginCallResponseV03) ProtoMessage() {}
+
+func (x *MarginCallResponseV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarginCallResponseV03.ProtoReflect.Descriptor instead.
+func (*MarginCallResponseV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MarginCallResponseV03) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *MarginCallResponseV03) GetObligation() *modelpb.Obligation3 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *MarginCallResponseV03) GetAgreement() *modelpb.Agreement2 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *MarginCallResponseV03) GetMarginDetailsDueToA() *modelpb.MarginCall1 {
+	if x != nil {
+		return x.MarginDetailsDueToA
+	}
+	return nil
+}
+
+func (x *MarginCallResponseV03) GetMarginDetailsDueToB() *modelpb.MarginCall1 {
+	if x != nil {
+		return x.MarginDetailsDueToB
+	}
+	return nil
+}
+
+func (x *MarginCallResponseV03) GetAgreedAmountDueToA() *modelpb.AgreedAmount1Choice {
+	if x != nil {
+		return x.AgreedAmountDueToA
+	}
+	return nil
+}
+
+func (x *MarginCallResponseV03) GetAgreedAmountDueToB() *modelpb.AgreedAmount1Choice {
+	if x != nil {
+		return x.AgreedAmountDueToB
+	}
+	return nil
+}
+
+func (x *MarginCallResponseV03) GetResponseDetails() *modelpb.Response1 {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+func (x *MarginCallResponseV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The MarginCallResponse message is sent by the collateral giver or its collateral manager to the collateral taker or its collateral manager or vice versa. This is a response to the MarginCallRequest message. The margin call can be accepted, fully disputed or partially disputed.
+//
+// The message definition is intended for use with the ISO20022 Business Application Header.
+//
+// Usage
+// When sent by the collateral giver the MarginCallResponse message is used to:
+// - fully accept the MarginCallRequest
+// - or partially accept the MarginCallRequest.
+//
+// When sent by the collateral taker the MarginCallResponse message is used to:
+// - fully accept the recall of collateral
+// - or partially accept the recall of collateral.
+type MarginCallResponseV04 struct {
+	state                     protoimpl.MessageState        `protogen:"open.v1"`
+	TransactionIdentification *string                       `protobuf:"bytes,1,opt,name=transaction_identification,json=transactionIdentification,proto3,oneof" json:"transaction_identification,omitempty"`
+	Obligation                *modelpb.Obligation4          `protobuf:"bytes,2,opt,name=obligation,proto3" json:"obligation,omitempty"`
+	Agreement                 *modelpb.Agreement4           `protobuf:"bytes,3,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	MarginDetailsDueToA       *modelpb.MarginCall1          `protobuf:"bytes,4,opt,name=margin_details_due_to_a,json=marginDetailsDueToA,proto3" json:"margin_details_due_to_a,omitempty"`
+	MarginDetailsDueToB       *modelpb.MarginCall1          `protobuf:"bytes,5,opt,name=margin_details_due_to_b,json=marginDetailsDueToB,proto3" json:"margin_details_due_to_b,omitempty"`
+	AgreedAmountDueToA        *modelpb.AgreedAmount1Choice  `protobuf:"bytes,6,opt,name=agreed_amount_due_to_a,json=agreedAmountDueToA,proto3" json:"agreed_amount_due_to_a,omitempty"`
+	AgreedAmountDueToB        *modelpb.AgreedAmount1Choice  `protobuf:"bytes,7,opt,name=agreed_amount_due_to_b,json=agreedAmountDueToB,proto3" json:"agreed_amount_due_to_b,omitempty"`
+	ResponseDetails           *modelpb.Response1            `protobuf:"bytes,8,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	SupplementaryData         []*modelpb.SupplementaryData1 `protobuf:"bytes,9,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *MarginCallResponseV04) Reset() {
+	*x = MarginCallResponseV04{}
+	mi := &file_iso20022_colr_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarginCallResponseV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginCallResponseV04) ProtoMessage() {}
+
+func (x *MarginCallResponseV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_colr_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarginCallResponseV04.ProtoReflect.Descriptor instead.
+func (*MarginCallResponseV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_colr_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MarginCallResponseV04) GetTransactionIdentification() string {
+	if x != nil && x.TransactionIdentification != nil {
+		return *x.TransactionIdentification
+	}
+	return ""
+}
+
+func (x *MarginCallResponseV04) GetObligation() *modelpb.Obligation4 {
+	if x != nil {
+		return x.Obligation
+	}
+	return nil
+}
+
+func (x *MarginCallResponseV04) GetAgreement() *modelpb.Agreement4 {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *MarginCallResponseV04) GetMarginDetailsDueToA() *modelpb.MarginCall1 {
+	if x != nil {
+		return x.MarginDetailsDueToA
+	}
+	return nil
+}
+
+func (x *MarginCallResponseV04) GetMarginDetailsDueToB() *modelpb.MarginCall1 {
+	if x != nil {
+		return x.MarginDetailsDueToB
+	}
+	return nil
+}
+
+func (x *MarginCallResponseV04) GetAgreedAmountDueToA() *modelpb.AgreedAmount1Choice {
+	if x != nil {
+		return x.AgreedAmountDueToA
+	}
+	return nil
+}
+
+func (x *MarginCallResponseV04) GetAgreedAmountDueToB() *modelpb.AgreedAmount1Choice {
+	if x != nil {
+		return x.AgreedAmountDueToB
+	}
+	return nil
+}
+
+func (x *MarginCallResponseV04) GetResponseDetails() *modelpb.Response1 {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+func (x *MarginCallResponseV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+var File_iso20022_colr_proto protoreflect.FileDescriptor
+
+var file_iso20022_colr_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x63, 0x6f, 0x6c, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x63, 0x6f, 0x6c, 0x72, 0x1a, 0x14, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc0, 0x03, 0x0a, 0x1e, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x45, 0x78, 0x70, 0x6f,
+	0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x32, 0x12, 0x4e, 0x0a,
+	0x11, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x32, 0x52, 0x10, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f,
+	0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x32, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x48, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x39, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xc1, 0x03,
+	0x0a, 0x1e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x45,
+	0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x33,
+	0x12, 0x4e, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x35, 0x52, 0x10,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0a,
+	0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x0a, 0x6f,
+	0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67,
+	0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x34, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x33, 0x52, 0x10, 0x63, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x51,
+	0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x22, 0xbf, 0x03, 0x0a, 0x2a, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x33,
+	0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x32, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x33, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x5e, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x31, 0x52, 0x12, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xbf, 0x03, 0x0a, 0x2a, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x30, 0x34, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x32, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x31, 0x52,
+	0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb9, 0x03, 0x0a, 0x29, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x56, 0x30, 0x33, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x31, 0x36, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x33, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x5e, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x31, 0x52, 0x12, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52,
+	0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xb9, 0x03, 0x0a, 0x29, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x30, 0x34, 0x12,
+	0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x31, 0x36, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b,
+	0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52,
+	0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x13, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x31, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d,
+	0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf2, 0x02,
+	0x0a, 0x1d, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33, 0x12,
+	0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x33, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x5e, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x10,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31,
+	0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xf2, 0x02, 0x0a, 0x1d, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x30, 0x34, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x43, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf2, 0x02, 0x0a, 0x1d, 0x43, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x35, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a,
+	0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x0a,
+	0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x11, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x33, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a,
+	0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x89, 0x03, 0x0a,
+	0x15, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x56, 0x30, 0x33, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x52, 0x0a, 0x6f, 0x62, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x43, 0x0a, 0x10, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x33, 0x52, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x41, 0x6e, 0x64, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x89, 0x03, 0x0a, 0x15, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56,
+	0x30, 0x34, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x34, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a,
+	0x10, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x34, 0x52, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x89, 0x03, 0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x30, 0x35, 0x12, 0x42,
+	0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x35, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x34, 0x52, 0x09,
+	0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x10, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x35, 0x52, 0x0e,
+	0x74, 0x79, 0x70, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x51,
+	0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xba, 0x03, 0x0a, 0x25, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53,
+	0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x33, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b,
+	0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x52,
+	0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x61,
+	0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x64, 0x0a, 0x19, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x31, 0x52, 0x18, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x12, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d,
+	0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xba, 0x03,
+	0x0a, 0x25, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f,
+	0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x0a, 0x6f, 0x62,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72,
+	0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x34, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x64, 0x0a, 0x19, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x18,
+	0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaf, 0x04, 0x0a, 0x20, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x33, 0x12,
+	0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x33, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0x52,
+	0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x6d, 0x0a, 0x1e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75,
+	0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x52, 0x1c, 0x63, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x6f, 0x0a, 0x1f, 0x63, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75,
+	0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x52, 0x1d, 0x63, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a,
+	0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaf, 0x04, 0x0a,
+	0x20, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30,
+	0x34, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x34, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x6d, 0x0a, 0x1e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x1c, 0x63,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x6f, 0x0a, 0x1f, 0x63,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69,
+	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x1d, 0x63,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x12,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42,
+	0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xac,
+	0x03, 0x0a, 0x21, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x62,
+	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x30, 0x33, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x32, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x5a, 0x0a, 0x15, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x14, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d,
+	0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xac, 0x03,
+	0x0a, 0x21, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x30, 0x34, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x34, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5a,
+	0x0a, 0x15, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x31, 0x52, 0x14, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a,
+	0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30, 0x31, 0x30, 0x33,
+	0x12, 0x3d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c,
+	0x72, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x51, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x33, 0x30, 0x30,
+	0x31, 0x30, 0x34, 0x12, 0x3d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x52, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x34, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x3e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x30, 0x34, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x3e, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x4d, 0x61, 0x72, 0x67,
+	0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30,
+	0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x67, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x53,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x39, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x67, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x30, 0x35, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x53, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x66, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x36, 0x30, 0x30, 0x31, 0x30, 0x33,
+	0x12, 0x52, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x38, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c,
+	0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x66, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x30, 0x36, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x52, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x37, 0x30, 0x30, 0x31, 0x30, 0x33,
+	0x12, 0x3e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c,
+	0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x52, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x37, 0x30,
+	0x30, 0x31, 0x30, 0x34, 0x12, 0x3e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x30, 0x37, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x3e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x30, 0x35, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x38, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x46, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x30, 0x38, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x5a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x38, 0x30,
+	0x30, 0x31, 0x30, 0x35, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x39, 0x30, 0x30, 0x31, 0x30, 0x33,
+	0x12, 0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c,
+	0x72, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x44, 0x69, 0x73, 0x70,
+	0x75, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x39, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12,
+	0x49, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72,
+	0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x44, 0x69, 0x73, 0x70, 0x75,
+	0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30,
+	0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x30, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x49,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69,
+	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x33,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x30, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x49, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x34, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x31, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x4a, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x31, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x4a, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x62, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x32, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x4e, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x62, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x32, 0x30, 0x30, 0x31, 0x30, 0x34,
+	0x12, 0x4e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c,
+	0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x56, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x33, 0x30,
+	0x30, 0x31, 0x30, 0x33, 0x12, 0x42, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x33, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x56, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x33, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x42, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x57, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x34, 0x30,
+	0x30, 0x31, 0x30, 0x33, 0x12, 0x43, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x10, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x34, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x43, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x58, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31,
+	0x35, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x44, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x35, 0x30, 0x30, 0x31, 0x30, 0x34,
+	0x12, 0x44, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c,
+	0x72, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x31, 0x36, 0x30, 0x30, 0x31, 0x30, 0x32, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x32, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x31, 0x36, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6c, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x41, 0x6e, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0xaf, 0x04, 0x0a, 0x19, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x33, 0x12, 0x42,
+	0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x33, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0x52, 0x09,
+	0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x11, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x31, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x44,
+	0x75, 0x65, 0x54, 0x6f, 0x41, 0x12, 0x4a, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x31, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x44, 0x75, 0x65, 0x54, 0x6f,
+	0x42, 0x12, 0x4d, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x31, 0x52, 0x10,
+	0x6e, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31,
+	0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xaf, 0x04, 0x0a, 0x19, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x34,
+	0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x34,
+	0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x11, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x31, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x41, 0x12, 0x4a, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x31, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x44, 0x75, 0x65,
+	0x54, 0x6f, 0x42, 0x12, 0x4d, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x31,
+	0x52, 0x10, 0x6e, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb1, 0x04, 0x0a, 0x1a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x30, 0x33, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x32, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4a,
+	0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74,
+	0x6f, 0x5f, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x41, 0x12, 0x4a, 0x0a, 0x11, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
+	0x44, 0x75, 0x65, 0x54, 0x6f, 0x42, 0x12, 0x4e, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x31, 0x52, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb1, 0x04, 0x0a, 0x1a, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f,
+	0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x0a, 0x6f, 0x62,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72,
+	0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x34, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x64,
+	0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x52, 0x0e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x41, 0x12, 0x4a,
+	0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74,
+	0x6f, 0x5f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x42, 0x12, 0x4e, 0x0a, 0x11, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a,
+	0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa9, 0x04, 0x0a,
+	0x1b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x33, 0x12, 0x42, 0x0a, 0x1a,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0x52,
+	0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x52, 0x0a, 0x6f, 0x62, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x33, 0x32, 0x52, 0x13, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x12, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x33, 0x52, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa9, 0x04, 0x0a, 0x1b, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x34, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09,
+	0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x34, 0x52, 0x09, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x13,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x51, 0x0a, 0x12, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x34, 0x52,
+	0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf4, 0x02, 0x0a, 0x20, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43,
+	0x61, 0x6c, 0x6c, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x33, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a,
+	0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x52, 0x0a,
+	0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x14, 0x64, 0x69,
+	0x73, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74,
+	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x43, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x52, 0x13, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf4, 0x02, 0x0a, 0x20,
+	0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74,
+	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34,
+	0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x5d, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x31, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x13, 0x64, 0x69, 0x73,
+	0x70, 0x75, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31,
+	0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xe4, 0x07, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x30, 0x33, 0x12, 0x42, 0x0a, 0x1a, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x33,
+	0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09,
+	0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0x52, 0x09, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x17, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43,
+	0x61, 0x6c, 0x6c, 0x31, 0x52, 0x13, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x41, 0x12, 0x51, 0x0a, 0x17, 0x6d, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x64, 0x75, 0x65, 0x5f,
+	0x74, 0x6f, 0x5f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x67,
+	0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x31, 0x52, 0x13, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x42, 0x12, 0x68, 0x0a, 0x1c,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x18, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x44, 0x75, 0x65, 0x54, 0x6f, 0x41, 0x12, 0x68, 0x0a, 0x1c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x64, 0x75,
+	0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x31,
+	0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x18, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x42,
+	0x12, 0x4f, 0x0a, 0x12, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x33, 0x52,
+	0x10, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x69, 0x0a, 0x1c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f,
+	0x62, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x43, 0x68, 0x6f, 0x69,
+	0x63, 0x65, 0x52, 0x18, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x42, 0x12, 0x69, 0x0a, 0x1c,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x18, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x41, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb1, 0x08, 0x0a, 0x14, 0x4d, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x30, 0x34, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x34, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4f, 0x0a,
+	0x12, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x33, 0x52, 0x10, 0x6d, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x51,
+	0x0a, 0x17, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x31, 0x52, 0x13, 0x6d, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x44, 0x75, 0x65, 0x54, 0x6f,
+	0x41, 0x12, 0x51, 0x0a, 0x17, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x31, 0x52,
+	0x13, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x44, 0x75,
+	0x65, 0x54, 0x6f, 0x42, 0x12, 0x68, 0x0a, 0x1c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x64, 0x75, 0x65, 0x5f,
+	0x74, 0x6f, 0x5f, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x43, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x52, 0x18, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x41, 0x12, 0x68,
+	0x0a, 0x1c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x18,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x42, 0x12, 0x69, 0x0a, 0x1c, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f,
+	0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x32, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x18, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x75, 0x65,
+	0x54, 0x6f, 0x41, 0x12, 0x69, 0x0a, 0x1c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74,
+	0x6f, 0x5f, 0x62, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x32, 0x43, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x52, 0x18, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x42, 0x12, 0x4b,
+	0x0a, 0x13, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x32, 0x52, 0x11, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x43, 0x61, 0x6c, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d,
+	0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe2, 0x05,
+	0x0a, 0x15, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x33, 0x12, 0x42, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x6f,
+	0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x52, 0x0a, 0x6f, 0x62,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72,
+	0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x51, 0x0a, 0x17, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x31,
+	0x52, 0x13, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x44,
+	0x75, 0x65, 0x54, 0x6f, 0x41, 0x12, 0x51, 0x0a, 0x17, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x62,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61,
+	0x6c, 0x6c, 0x31, 0x52, 0x13, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x42, 0x12, 0x57, 0x0a, 0x16, 0x61, 0x67, 0x72, 0x65,
+	0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f,
+	0x5f, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x31, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x12, 0x61,
+	0x67, 0x72, 0x65, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x75, 0x65, 0x54, 0x6f,
+	0x41, 0x12, 0x57, 0x0a, 0x16, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x31,
+	0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x12, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x42, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x31, 0x52,
+	0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31,
+	0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xe2, 0x05, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x30, 0x34, 0x12, 0x42, 0x0a, 0x1a,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x34, 0x52, 0x0a, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
+	0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x34, 0x52, 0x09, 0x61, 0x67,
+	0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x17, 0x6d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f,
+	0x5f, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x43, 0x61, 0x6c, 0x6c, 0x31, 0x52, 0x13, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x41, 0x12, 0x51, 0x0a, 0x17, 0x6d, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x64, 0x75, 0x65,
+	0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x31, 0x52, 0x13, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x42, 0x12, 0x57, 0x0a,
+	0x16, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64,
+	0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
+	0x67, 0x72, 0x65, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x31, 0x43, 0x68, 0x6f, 0x69,
+	0x63, 0x65, 0x52, 0x12, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x44, 0x75, 0x65, 0x54, 0x6f, 0x41, 0x12, 0x57, 0x0a, 0x16, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x62,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x64, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x31, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x12, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x75, 0x65, 0x54, 0x6f, 0x42, 0x12,
+	0x44, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x31, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x75, 0x64, 0x61, 0x70, 0x72, 0x61, 0x6d, 0x61, 0x2f,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x63, 0x6f, 0x6c, 0x72, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_iso20022_colr_proto_rawDescOnce sync.Once
+	file_iso20022_colr_proto_rawDescData []byte
+)
+
+func file_iso20022_colr_proto_rawDescGZIP() []byte {
+	file_iso20022_colr_proto_rawDescOnce.Do(func() {
+		file_iso20022_colr_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_iso20022_colr_proto_rawDesc), len(file_iso20022_colr_proto_rawDesc)))
+	})
+	return file_iso20022_colr_proto_rawDescData
+}
+
+var file_iso20022_colr_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
+var file_iso20022_colr_proto_goTypes = []any{
+	(*CollateralAndExposureReportV02)(nil),             // 0: iso20022.colr.CollateralAndExposureReportV02
+	(*CollateralAndExposureReportV03)(nil),             // 1: iso20022.colr.CollateralAndExposureReportV03
+	(*CollateralManagementCancellationRequestV03)(nil), // 2: iso20022.colr.CollateralManagementCancellationRequestV03
+	(*CollateralManagementCancellationRequestV04)(nil), // 3: iso20022.colr.CollateralManagementCancellationRequestV04
+	(*CollateralManagementCancellationStatusV03)(nil),  // 4: iso20022.colr.CollateralManagementCancellationStatusV03
+	(*CollateralManagementCancellationStatusV04)(nil),  // 5: iso20022.colr.CollateralManagementCancellationStatusV04
+	(*CollateralProposalResponseV03)(nil),              // 6: iso20022.colr.CollateralProposalResponseV03
+	(*CollateralProposalResponseV04)(nil),              // 7: iso20022.colr.CollateralProposalResponseV04
+	(*CollateralProposalResponseV05)(nil),              // 8: iso20022.colr.CollateralProposalResponseV05
+	(*CollateralProposalV03)(nil),                      // 9: iso20022.colr.CollateralProposalV03
+	(*CollateralProposalV04)(nil),                      // 10: iso20022.colr.CollateralProposalV04
+	(*CollateralProposalV05)(nil),                      // 11: iso20022.colr.CollateralProposalV05
+	(*CollateralSubstitutionConfirmationV03)(nil),      // 12: iso20022.colr.CollateralSubstitutionConfirmationV03
+	(*CollateralSubstitutionConfirmationV04)(nil),      // 13: iso20022.colr.CollateralSubstitutionConfirmationV04
+	(*CollateralSubstitutionRequestV03)(nil),           // 14: iso20022.colr.CollateralSubstitutionRequestV03
+	(*CollateralSubstitutionRequestV04)(nil),           // 15: iso20022.colr.CollateralSubstitutionRequestV04
+	(*CollateralSubstitutionResponseV03)(nil),          // 16: iso20022.colr.CollateralSubstitutionResponseV03
+	(*CollateralSubstitutionResponseV04)(nil),          // 17: iso20022.colr.CollateralSubstitutionResponseV04
+	(*Document00300103)(nil),                           // 18: iso20022.colr.Document00300103
+	(*Document00300104)(nil),                           // 19: iso20022.colr.Document00300104
+	(*Document00400103)(nil),                           // 20: iso20022.colr.Document00400103
+	(*Document00400104)(nil),                           // 21: iso20022.colr.Document00400104
+	(*Document00500103)(nil),                           // 22: iso20022.colr.Document00500103
+	(*Document00500104)(nil),                           // 23: iso20022.colr.Document00500104
+	(*Document00600103)(nil),                           // 24: iso20022.colr.Document00600103
+	(*Document00600104)(nil),                           // 25: iso20022.colr.Document00600104
+	(*Document00700103)(nil),                           // 26: iso20022.colr.Document00700103
+	(*Document00700104)(nil),                           // 27: iso20022.colr.Document00700104
+	(*Document00700105)(nil),                           // 28: iso20022.colr.Document00700105
+	(*Document00800103)(nil),                           // 29: iso20022.colr.Document00800103
+	(*Document00800104)(nil),                           // 30: iso20022.colr.Document00800104
+	(*Document00800105)(nil),                           // 31: iso20022.colr.Document00800105
+	(*Document00900103)(nil),                           // 32: iso20022.colr.Document00900103
+	(*Document00900104)(nil),                           // 33: iso20022.colr.Document00900104
+	(*Document01000103)(nil),                           // 34: iso20022.colr.Document01000103
+	(*Document01000104)(nil),                           // 35: iso20022.colr.Document01000104
+	(*Document01100103)(nil),                           // 36: iso20022.colr.Document01100103
+	(*Document01100104)(nil),                           // 37: iso20022.colr.Document01100104
+	(*Document01200103)(nil),                           // 38: iso20022.colr.Document01200103
+	(*Document01200104)(nil),                           // 39: iso20022.colr.Document01200104
+	(*Document01300103)(nil),                           // 40: iso20022.colr.Document01300103
+	(*Document01300104)(nil),                           // 41: iso20022.colr.Document01300104
+	(*Document01400103)(nil),                           // 42: iso20022.colr.Document01400103
+	(*Document01400104)(nil),                           // 43: iso20022.colr.Document01400104
+	(*Document01500103)(nil),                           // 44: iso20022.colr.Document01500103
+	(*Document01500104)(nil),                           // 45: iso20022.colr.Document01500104
+	(*Document01600102)(nil),                           // 46: iso20022.colr.Document01600102
+	(*Document01600103)(nil),                           // 47: iso20022.colr.Document01600103
+	(*InterestPaymentRequestV03)(nil),                  // 48: iso20022.colr.InterestPaymentRequestV03
+	(*InterestPaymentRequestV04)(nil),                  // 49: iso20022.colr.InterestPaymentRequestV04
+	(*InterestPaymentResponseV03)(nil),                 // 50: iso20022.colr.InterestPaymentResponseV03
+	(*InterestPaymentResponseV04)(nil),                 // 51: iso20022.colr.InterestPaymentResponseV04
+	(*InterestPaymentStatementV03)(nil),                // 52: iso20022.colr.InterestPaymentStatementV03
+	(*InterestPaymentStatementV04)(nil),                // 53: iso20022.colr.InterestPaymentStatementV04
+	(*MarginCallDisputeNotificationV03)(nil),           // 54: iso20022.colr.MarginCallDisputeNotificationV03
+	(*MarginCallDisputeNotificationV04)(nil),           // 55: iso20022.colr.MarginCallDisputeNotificationV04
+	(*MarginCallRequestV03)(nil),                       // 56: iso20022.colr.MarginCallRequestV03
+	(*MarginCallRequestV04)(nil),                       // 57: iso20022.colr.MarginCallRequestV04
+	(*MarginCallResponseV03)(nil),                      // 58: iso20022.colr.MarginCallResponseV03
+	(*MarginCallResponseV04)(nil),                      // 59: iso20022.colr.MarginCallResponseV04
+	(*modelpb.ReportParameters2)(nil),                  // 60: iso20022.model.ReportParameters2
+	(*modelpb.Pagination)(nil),                         // 61: iso20022.model.Pagination
+	(*modelpb.Obligation3)(nil),                        // 62: iso20022.model.Obligation3
+	(*modelpb.Agreement2)(nil),                         // 63: iso20022.model.Agreement2
+	(*modelpb.Collateral9)(nil),                        // 64: iso20022.model.Collateral9
+	(*modelpb.SupplementaryData1)(nil),                 // 65: iso20022.model.SupplementaryData1
+	(*modelpb.ReportParameters5)(nil),                  // 66: iso20022.model.ReportParameters5
+	(*modelpb.Obligation4)(nil),                        // 67: iso20022.model.Obligation4
+	(*modelpb.Agreement4)(nil),                         // 68: iso20022.model.Agreement4
+	(*modelpb.Collateral13)(nil),                       // 69: iso20022.model.Collateral13
+	(*modelpb.Reference2Choice)(nil),                   // 70: iso20022.model.Reference2Choice
+	(*modelpb.CollateralCancellationReason1)(nil),      // 71: iso20022.model.CollateralCancellationReason1
+	(*modelpb.Reference16)(nil),                        // 72: iso20022.model.Reference16
+	(*modelpb.CollateralCancellationStatus1)(nil),      // 73: iso20022.model.CollateralCancellationStatus1
+	(*modelpb.CollateralProposalResponse2Choice)(nil),  // 74: iso20022.model.CollateralProposalResponse2Choice
+	(*modelpb.Obligation5)(nil),                        // 75: iso20022.model.Obligation5
+	(*modelpb.CollateralProposalResponse3Choice)(nil),  // 76: iso20022.model.CollateralProposalResponse3Choice
+	(*modelpb.Proposal3)(nil),                          // 77: iso20022.model.Proposal3
+	(*modelpb.Proposal4)(nil),                          // 78: iso20022.model.Proposal4
+	(*modelpb.Proposal5)(nil),                          // 79: iso20022.model.Proposal5
+	(*modelpb.CollateralConfirmation1)(nil),            // 80: iso20022.model.CollateralConfirmation1
+	(*modelpb.CollateralSubstitution2)(nil),            // 81: iso20022.model.CollateralSubstitution2
+	(*modelpb.CollateralSubstitution3)(nil),            // 82: iso20022.model.CollateralSubstitution3
+	(*modelpb.CollateralSubstitution5)(nil),            // 83: iso20022.model.CollateralSubstitution5
+	(*modelpb.CollateralSubstitution4)(nil),            // 84: iso20022.model.CollateralSubstitution4
+	(*modelpb.SubstitutionResponse1)(nil),              // 85: iso20022.model.SubstitutionResponse1
+	(*modelpb.InterestAmount1)(nil),                    // 86: iso20022.model.InterestAmount1
+	(*modelpb.InterestResult1)(nil),                    // 87: iso20022.model.InterestResult1
+	(*modelpb.InterestAmount2)(nil),                    // 88: iso20022.model.InterestAmount2
+	(*modelpb.InterestResponse1)(nil),                  // 89: iso20022.model.InterestResponse1
+	(*modelpb.Statement32)(nil),                        // 90: iso20022.model.Statement32
+	(*modelpb.InterestStatement3)(nil),                 // 91: iso20022.model.InterestStatement3
+	(*modelpb.InterestStatement4)(nil),                 // 92: iso20022.model.InterestStatement4
+	(*modelpb.DisputeNotification1Choice)(nil),         // 93: iso20022.model.DisputeNotification1Choice
+	(*modelpb.MarginCall1)(nil),                        // 94: iso20022.model.MarginCall1
+	(*modelpb.MarginRequirement1Choice)(nil),           // 95: iso20022.model.MarginRequirement1Choice
+	(*modelpb.MarginCallResult3)(nil),                  // 96: iso20022.model.MarginCallResult3
+	(*modelpb.ExpectedCollateral1Choice)(nil),          // 97: iso20022.model.ExpectedCollateral1Choice
+	(*modelpb.ExpectedCollateral2Choice)(nil),          // 98: iso20022.model.ExpectedCollateral2Choice
+	(*modelpb.MarginCall2)(nil),                        // 99: iso20022.model.MarginCall2
+	(*modelpb.AgreedAmount1Choice)(nil),                // 100: iso20022.model.AgreedAmount1Choice
+	(*modelpb.Response1)(nil),                          // 101: iso20022.model.Response1
+}
+var file_iso20022_colr_proto_depIdxs = []int32{
+	60,  // 0: iso20022.colr.CollateralAndExposureReportV02.report_parameters:type_name -> iso20022.model.ReportParameters2
+	61,  // 1: iso20022.colr.CollateralAndExposureReportV02.pagination:type_name -> iso20022.model.Pagination
+	62,  // 2: iso20022.colr.CollateralAndExposureReportV02.obligation:type_name -> iso20022.model.Obligation3
+	63,  // 3: iso20022.colr.CollateralAndExposureReportV02.agreement:type_name -> iso20022.model.Agreement2
+	64,  // 4: iso20022.colr.CollateralAndExposureReportV02.collateral_report:type_name -> iso20022.model.Collateral9
+	65,  // 5: iso20022.colr.CollateralAndExposureReportV02.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	66,  // 6: iso20022.colr.CollateralAndExposureReportV03.report_parameters:type_name -> iso20022.model.ReportParameters5
+	61,  // 7: iso20022.colr.CollateralAndExposureReportV03.pagination:type_name -> iso20022.model.Pagination
+	67,  // 8: iso20022.colr.CollateralAndExposureReportV03.obligation:type_name -> iso20022.model.Obligation4
+	68,  // 9: iso20022.colr.CollateralAndExposureReportV03.agreement:type_name -> iso20022.model.Agreement4
+	69,  // 10: iso20022.colr.CollateralAndExposureReportV03.collateral_report:type_name -> iso20022.model.Collateral13
+	65,  // 11: iso20022.colr.CollateralAndExposureReportV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	70,  // 12: iso20022.colr.CollateralManagementCancellationRequestV03.reference:type_name -> iso20022.model.Reference2Choice
+	62,  // 13: iso20022.colr.CollateralManagementCancellationRequestV03.obligation:type_name -> iso20022.model.Obligation3
+	71,  // 14: iso20022.colr.CollateralManagementCancellationRequestV03.cancellation_reason:type_name -> iso20022.model.CollateralCancellationReason1
+	65,  // 15: iso20022.colr.CollateralManagementCancellationRequestV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	70,  // 16: iso20022.colr.CollateralManagementCancellationRequestV04.reference:type_name -> iso20022.model.Reference2Choice
+	67,  // 17: iso20022.colr.CollateralManagementCancellationRequestV04.obligation:type_name -> iso20022.model.Obligation4
+	71,  // 18: iso20022.colr.CollateralManagementCancellationRequestV04.cancellation_reason:type_name -> iso20022.model.CollateralCancellationReason1
+	65,  // 19: iso20022.colr.CollateralManagementCancellationRequestV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	72,  // 20: iso20022.colr.CollateralManagementCancellationStatusV03.reference:type_name -> iso20022.model.Reference16
+	62,  // 21: iso20022.colr.CollateralManagementCancellationStatusV03.obligation:type_name -> iso20022.model.Obligation3
+	73,  // 22: iso20022.colr.CollateralManagementCancellationStatusV03.cancellation_status:type_name -> iso20022.model.CollateralCancellationStatus1
+	65,  // 23: iso20022.colr.CollateralManagementCancellationStatusV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	72,  // 24: iso20022.colr.CollateralManagementCancellationStatusV04.reference:type_name -> iso20022.model.Reference16
+	67,  // 25: iso20022.colr.CollateralManagementCancellationStatusV04.obligation:type_name -> iso20022.model.Obligation4
+	73,  // 26: iso20022.colr.CollateralManagementCancellationStatusV04.cancellation_status:type_name -> iso20022.model.CollateralCancellationStatus1
+	65,  // 27: iso20022.colr.CollateralManagementCancellationStatusV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	62,  // 28: iso20022.colr.CollateralProposalResponseV03.obligation:type_name -> iso20022.model.Obligation3
+	74,  // 29: iso20022.colr.CollateralProposalResponseV03.proposal_response:type_name -> iso20022.model.CollateralProposalResponse2Choice
+	65,  // 30: iso20022.colr.CollateralProposalResponseV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	67,  // 31: iso20022.colr.CollateralProposalResponseV04.obligation:type_name -> iso20022.model.Obligation4
+	74,  // 32: iso20022.colr.CollateralProposalResponseV04.proposal_response:type_name -> iso20022.model.CollateralProposalResponse2Choice
+	65,  // 33: iso20022.colr.CollateralProposalResponseV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	75,  // 34: iso20022.colr.CollateralProposalResponseV05.obligation:type_name -> iso20022.model.Obligation5
+	76,  // 35: iso20022.colr.CollateralProposalResponseV05.proposal_response:type_name -> iso20022.model.CollateralProposalResponse3Choice
+	65,  // 36: iso20022.colr.CollateralProposalResponseV05.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	62,  // 37: iso20022.colr.CollateralProposalV03.obligation:type_name -> iso20022.model.Obligation3
+	63,  // 38: iso20022.colr.CollateralProposalV03.agreement:type_name -> iso20022.model.Agreement2
+	77,  // 39: iso20022.colr.CollateralProposalV03.type_and_details:type_name -> iso20022.model.Proposal3
+	65,  // 40: iso20022.colr.CollateralProposalV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	67,  // 41: iso20022.colr.CollateralProposalV04.obligation:type_name -> iso20022.model.Obligation4
+	68,  // 42: iso20022.colr.CollateralProposalV04.agreement:type_name -> iso20022.model.Agreement4
+	78,  // 43: iso20022.colr.CollateralProposalV04.type_and_details:type_name -> iso20022.model.Proposal4
+	65,  // 44: iso20022.colr.CollateralProposalV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	75,  // 45: iso20022.colr.CollateralProposalV05.obligation:type_name -> iso20022.model.Obligation5
+	68,  // 46: iso20022.colr.CollateralProposalV05.agreement:type_name -> iso20022.model.Agreement4
+	79,  // 47: iso20022.colr.CollateralProposalV05.type_and_details:type_name -> iso20022.model.Proposal5
+	65,  // 48: iso20022.colr.CollateralProposalV05.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	62,  // 49: iso20022.colr.CollateralSubstitutionConfirmationV03.obligation:type_name -> iso20022.model.Obligation3
+	63,  // 50: iso20022.colr.CollateralSubstitutionConfirmationV03.agreement:type_name -> iso20022.model.Agreement2
+	80,  // 51: iso20022.colr.CollateralSubstitutionConfirmationV03.substitution_confirmation:type_name -> iso20022.model.CollateralConfirmation1
+	65,  // 52: iso20022.colr.CollateralSubstitutionConfirmationV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	67,  // 53: iso20022.colr.CollateralSubstitutionConfirmationV04.obligation:type_name -> iso20022.model.Obligation4
+	68,  // 54: iso20022.colr.CollateralSubstitutionConfirmationV04.agreement:type_name -> iso20022.model.Agreement4
+	80,  // 55: iso20022.colr.CollateralSubstitutionConfirmationV04.substitution_confirmation:type_name -> iso20022.model.CollateralConfirmation1
+	65,  // 56: iso20022.colr.CollateralSubstitutionConfirmationV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	62,  // 57: iso20022.colr.CollateralSubstitutionRequestV03.obligation:type_name -> iso20022.model.Obligation3
+	63,  // 58: iso20022.colr.CollateralSubstitutionRequestV03.agreement:type_name -> iso20022.model.Agreement2
+	81,  // 59: iso20022.colr.CollateralSubstitutionRequestV03.collateral_substitution_return:type_name -> iso20022.model.CollateralSubstitution2
+	82,  // 60: iso20022.colr.CollateralSubstitutionRequestV03.collateral_substitution_deliver:type_name -> iso20022.model.CollateralSubstitution3
+	65,  // 61: iso20022.colr.CollateralSubstitutionRequestV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	67,  // 62: iso20022.colr.CollateralSubstitutionRequestV04.obligation:type_name -> iso20022.model.Obligation4
+	68,  // 63: iso20022.colr.CollateralSubstitutionRequestV04.agreement:type_name -> iso20022.model.Agreement4
+	83,  // 64: iso20022.colr.CollateralSubstitutionRequestV04.collateral_substitution_return:type_name -> iso20022.model.CollateralSubstitution5
+	84,  // 65: iso20022.colr.CollateralSubstitutionRequestV04.collateral_substitution_deliver:type_name -> iso20022.model.CollateralSubstitution4
+	65,  // 66: iso20022.colr.CollateralSubstitutionRequestV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	62,  // 67: iso20022.colr.CollateralSubstitutionResponseV03.obligation:type_name -> iso20022.model.Obligation3
+	63,  // 68: iso20022.colr.CollateralSubstitutionResponseV03.agreement:type_name -> iso20022.model.Agreement2
+	85,  // 69: iso20022.colr.CollateralSubstitutionResponseV03.substitution_response:type_name -> iso20022.model.SubstitutionResponse1
+	65,  // 70: iso20022.colr.CollateralSubstitutionResponseV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	67,  // 71: iso20022.colr.CollateralSubstitutionResponseV04.obligation:type_name -> iso20022.model.Obligation4
+	68,  // 72: iso20022.colr.CollateralSubstitutionResponseV04.agreement:type_name -> iso20022.model.Agreement4
+	85,  // 73: iso20022.colr.CollateralSubstitutionResponseV04.substitution_response:type_name -> iso20022.model.SubstitutionResponse1
+	65,  // 74: iso20022.colr.CollateralSubstitutionResponseV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	56,  // 75: iso20022.colr.Document00300103.message:type_name -> iso20022.colr.MarginCallRequestV03
+	57,  // 76: iso20022.colr.Document00300104.message:type_name -> iso20022.colr.MarginCallRequestV04
+	58,  // 77: iso20022.colr.Document00400103.message:type_name -> iso20022.colr.MarginCallResponseV03
+	59,  // 78: iso20022.colr.Document00400104.message:type_name -> iso20022.colr.MarginCallResponseV04
+	2,   // 79: iso20022.colr.Document00500103.message:type_name -> iso20022.colr.CollateralManagementCancellationRequestV03
+	3,   // 80: iso20022.colr.Document00500104.message:type_name -> iso20022.colr.CollateralManagementCancellationRequestV04
+	4,   // 81: iso20022.colr.Document00600103.message:type_name -> iso20022.colr.CollateralManagementCancellationStatusV03
+	5,   // 82: iso20022.colr.Document00600104.message:type_name -> iso20022.colr.CollateralManagementCancellationStatusV04
+	9,   // 83: iso20022.colr.Document00700103.message:type_name -> iso20022.colr.CollateralProposalV03
+	10,  // 84: iso20022.colr.Document00700104.message:type_name -> iso20022.colr.CollateralProposalV04
+	11,  // 85: iso20022.colr.Document00700105.message:type_name -> iso20022.colr.CollateralProposalV05
+	6,   // 86: iso20022.colr.Document00800103.message:type_name -> iso20022.colr.CollateralProposalResponseV03
+	7,   // 87: iso20022.colr.Document00800104.message:type_name -> iso20022.colr.CollateralProposalResponseV04
+	8,   // 88: iso20022.colr.Document00800105.message:type_name -> iso20022.colr.CollateralProposalResponseV05
+	54,  // 89: iso20022.colr.Document00900103.message:type_name -> iso20022.colr.MarginCallDisputeNotificationV03
+	55,  // 90: iso20022.colr.Document00900104.message:type_name -> iso20022.colr.MarginCallDisputeNotificationV04
+	14,  // 91: iso20022.colr.Document01000103.message:type_name -> iso20022.colr.CollateralSubstitutionRequestV03
+	15,  // 92: iso20022.colr.Document01000104.message:type_name -> iso20022.colr.CollateralSubstitutionRequestV04
+	16,  // 93: iso20022.colr.Document01100103.message:type_name -> iso20022.colr.CollateralSubstitutionResponseV03
+	17,  // 94: iso20022.colr.Document01100104.message:type_name -> iso20022.colr.CollateralSubstitutionResponseV04
+	12,  // 95: iso20022.colr.Document01200103.message:type_name -> iso20022.colr.CollateralSubstitutionConfirmationV03
+	13,  // 96: iso20022.colr.Document01200104.message:type_name -> iso20022.colr.CollateralSubstitutionConfirmationV04
+	48,  // 97: iso20022.colr.Document01300103.message:type_name -> iso20022.colr.InterestPaymentRequestV03
+	49,  // 98: iso20022.colr.Document01300104.message:type_name -> iso20022.colr.InterestPaymentRequestV04
+	50,  // 99: iso20022.colr.Document01400103.message:type_name -> iso20022.colr.InterestPaymentResponseV03
+	51,  // 100: iso20022.colr.Document01400104.message:type_name -> iso20022.colr.InterestPaymentResponseV04
+	52,  // 101: iso20022.colr.Document01500103.message:type_name -> iso20022.colr.InterestPaymentStatementV03
+	53,  // 102: iso20022.colr.Document01500104.message:type_name -> iso20022.colr.InterestPaymentStatementV04
+	0,   // 103: iso20022.colr.Document01600102.message:type_name -> iso20022.colr.CollateralAndExposureReportV02
+	1,   // 104: iso20022.colr.Document01600103.message:type_name -> iso20022.colr.CollateralAndExposureReportV03
+	62,  // 105: iso20022.colr.InterestPaymentRequestV03.obligation:type_name -> iso20022.model.Obligation3
+	63,  // 106: iso20022.colr.InterestPaymentRequestV03.agreement:type_name -> iso20022.model.Agreement2
+	86,  // 107: iso20022.colr.InterestPaymentRequestV03.interest_due_to_a:type_name -> iso20022.model.InterestAmount1
+	86,  // 108: iso20022.colr.InterestPaymentRequestV03.interest_due_to_b:type_name -> iso20022.model.InterestAmount1
+	87,  // 109: iso20022.colr.InterestPaymentRequestV03.net_amount_details:type_name -> iso20022.model.InterestResult1
+	65,  // 110: iso20022.colr.InterestPaymentRequestV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	67,  // 111: iso20022.colr.InterestPaymentRequestV04.obligation:type_name -> iso20022.model.Obligation4
+	68,  // 112: iso20022.colr.InterestPaymentRequestV04.agreement:type_name -> iso20022.model.Agreement4
+	86,  // 113: iso20022.colr.InterestPaymentRequestV04.interest_due_to_a:type_name -> iso20022.model.InterestAmount1
+	86,  // 114: iso20022.colr.InterestPaymentRequestV04.interest_due_to_b:type_name -> iso20022.model.InterestAmount1
+	87,  // 115: iso20022.colr.InterestPaymentRequestV04.net_amount_details:type_name -> iso20022.model.InterestResult1
+	65,  // 116: iso20022.colr.InterestPaymentRequestV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	62,  // 117: iso20022.colr.InterestPaymentResponseV03.obligation:type_name -> iso20022.model.Obligation3
+	63,  // 118: iso20022.colr.InterestPaymentResponseV03.agreement:type_name -> iso20022.model.Agreement2
+	88,  // 119: iso20022.colr.InterestPaymentResponseV03.interest_due_to_a:type_name -> iso20022.model.InterestAmount2
+	88,  // 120: iso20022.colr.InterestPaymentResponseV03.interest_due_to_b:type_name -> iso20022.model.InterestAmount2
+	89,  // 121: iso20022.colr.InterestPaymentResponseV03.interest_response:type_name -> iso20022.model.InterestResponse1
+	65,  // 122: iso20022.colr.InterestPaymentResponseV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	67,  // 123: iso20022.colr.InterestPaymentResponseV04.obligation:type_name -> iso20022.model.Obligation4
+	68,  // 124: iso20022.colr.InterestPaymentResponseV04.agreement:type_name -> iso20022.model.Agreement4
+	88,  // 125: iso20022.colr.InterestPaymentResponseV04.interest_due_to_a:type_name -> iso20022.model.InterestAmount2
+	88,  // 126: iso20022.colr.InterestPaymentResponseV04.interest_due_to_b:type_name -> iso20022.model.InterestAmount2
+	89,  // 127: iso20022.colr.InterestPaymentResponseV04.interest_response:type_name -> iso20022.model.InterestResponse1
+	65,  // 128: iso20022.colr.InterestPaymentResponseV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	63,  // 129: iso20022.colr.InterestPaymentStatementV03.agreement:type_name -> iso20022.model.Agreement2
+	62,  // 130: iso20022.colr.InterestPaymentStatementV03.obligation:type_name -> iso20022.model.Obligation3
+	90,  // 131: iso20022.colr.InterestPaymentStatementV03.statement_parameters:type_name -> iso20022.model.Statement32
+	61,  // 132: iso20022.colr.InterestPaymentStatementV03.pagination:type_name -> iso20022.model.Pagination
+	91,  // 133: iso20022.colr.InterestPaymentStatementV03.interest_statement:type_name -> iso20022.model.InterestStatement3
+	65,  // 134: iso20022.colr.InterestPaymentStatementV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	68,  // 135: iso20022.colr.InterestPaymentStatementV04.agreement:type_name -> iso20022.model.Agreement4
+	67,  // 136: iso20022.colr.InterestPaymentStatementV04.obligation:type_name -> iso20022.model.Obligation4
+	90,  // 137: iso20022.colr.InterestPaymentStatementV04.statement_parameters:type_name -> iso20022.model.Statement32
+	61,  // 138: iso20022.colr.InterestPaymentStatementV04.pagination:type_name -> iso20022.model.Pagination
+	92,  // 139: iso20022.colr.InterestPaymentStatementV04.interest_statement:type_name -> iso20022.model.InterestStatement4
+	65,  // 140: iso20022.colr.InterestPaymentStatementV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	62,  // 141: iso20022.colr.MarginCallDisputeNotificationV03.obligation:type_name -> iso20022.model.Obligation3
+	93,  // 142: iso20022.colr.MarginCallDisputeNotificationV03.dispute_notification:type_name -> iso20022.model.DisputeNotification1Choice
+	65,  // 143: iso20022.colr.MarginCallDisputeNotificationV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	67,  // 144: iso20022.colr.MarginCallDisputeNotificationV04.obligation:type_name -> iso20022.model.Obligation4
+	93,  // 145: iso20022.colr.MarginCallDisputeNotificationV04.dispute_notification:type_name -> iso20022.model.DisputeNotification1Choice
+	65,  // 146: iso20022.colr.MarginCallDisputeNotificationV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	62,  // 147: iso20022.colr.MarginCallRequestV03.obligation:type_name -> iso20022.model.Obligation3
+	63,  // 148: iso20022.colr.MarginCallRequestV03.agreement:type_name -> iso20022.model.Agreement2
+	94,  // 149: iso20022.colr.MarginCallRequestV03.margin_details_due_to_a:type_name -> iso20022.model.MarginCall1
+	94,  // 150: iso20022.colr.MarginCallRequestV03.margin_details_due_to_b:type_name -> iso20022.model.MarginCall1
+	95,  // 151: iso20022.colr.MarginCallRequestV03.requirement_details_due_to_a:type_name -> iso20022.model.MarginRequirement1Choice
+	95,  // 152: iso20022.colr.MarginCallRequestV03.requirement_details_due_to_b:type_name -> iso20022.model.MarginRequirement1Choice
+	96,  // 153: iso20022.colr.MarginCallRequestV03.margin_call_result:type_name -> iso20022.model.MarginCallResult3
+	97,  // 154: iso20022.colr.MarginCallRequestV03.expected_collateral_due_to_b:type_name -> iso20022.model.ExpectedCollateral1Choice
+	97,  // 155: iso20022.colr.MarginCallRequestV03.expected_collateral_due_to_a:type_name -> iso20022.model.ExpectedCollateral1Choice
+	65,  // 156: iso20022.colr.MarginCallRequestV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	67,  // 157: iso20022.colr.MarginCallRequestV04.obligation:type_name -> iso20022.model.Obligation4
+	68,  // 158: iso20022.colr.MarginCallRequestV04.agreement:type_name -> iso20022.model.Agreement4
+	96,  // 159: iso20022.colr.MarginCallRequestV04.margin_call_result:type_name -> iso20022.model.MarginCallResult3
+	94,  // 160: iso20022.colr.MarginCallRequestV04.margin_details_due_to_a:type_name -> iso20022.model.MarginCall1
+	94,  // 161: iso20022.colr.MarginCallRequestV04.margin_details_due_to_b:type_name -> iso20022.model.MarginCall1
+	95,  // 162: iso20022.colr.MarginCallRequestV04.requirement_details_due_to_a:type_name -> iso20022.model.MarginRequirement1Choice
+	95,  // 163: iso20022.colr.MarginCallRequestV04.requirement_details_due_to_b:type_name -> iso20022.model.MarginRequirement1Choice
+	98,  // 164: iso20022.colr.MarginCallRequestV04.expected_collateral_due_to_a:type_name -> iso20022.model.ExpectedCollateral2Choice
+	98,  // 165: iso20022.colr.MarginCallRequestV04.expected_collateral_due_to_b:type_name -> iso20022.model.ExpectedCollateral2Choice
+	99,  // 166: iso20022.colr.MarginCallRequestV04.margin_call_details:type_name -> iso20022.model.MarginCall2
+	65,  // 167: iso20022.colr.MarginCallRequestV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	62,  // 168: iso20022.colr.MarginCallResponseV03.obligation:type_name -> iso20022.model.Obligation3
+	63,  // 169: iso20022.colr.MarginCallResponseV03.agreement:type_name -> iso20022.model.Agreement2
+	94,  // 170: iso20022.colr.MarginCallResponseV03.margin_details_due_to_a:type_name -> iso20022.model.MarginCall1
+	94,  // 171: iso20022.colr.MarginCallResponseV03.margin_details_due_to_b:type_name -> iso20022.model.MarginCall1
+	100, // 172: iso20022.colr.MarginCallResponseV03.agreed_amount_due_to_a:type_name -> iso20022.model.AgreedAmount1Choice
+	100, // 173: iso20022.colr.MarginCallResponseV03.agreed_amount_due_to_b:type_name -> iso20022.model.AgreedAmount1Choice
+	101, // 174: iso20022.colr.MarginCallResponseV03.response_details:type_name -> iso20022.model.Response1
+	65,  // 175: iso20022.colr.MarginCallResponseV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	67,  // 176: iso20022.colr.MarginCallResponseV04.obligation:type_name -> iso20022.model.Obligation4
+	68,  // 177: iso20022.colr.MarginCallResponseV04.agreement:type_name -> iso20022.model.Agreement4
+	94,  // 178: iso20022.colr.MarginCallResponseV04.margin_details_due_to_a:type_name -> iso20022.model.MarginCall1
+	94,  // 179: iso20022.colr.MarginCallResponseV04.margin_details_due_to_b:type_name -> iso20022.model.MarginCall1
+	100, // 180: iso20022.colr.MarginCallResponseV04.agreed_amount_due_to_a:type_name -> iso20022.model.AgreedAmount1Choice
+	100, // 181: iso20022.colr.MarginCallResponseV04.agreed_amount_due_to_b:type_name -> iso20022.model.AgreedAmount1Choice
+	101, // 182: iso20022.colr.MarginCallResponseV04.response_details:type_name -> iso20022.model.Response1
+	65,  // 183: iso20022.colr.MarginCallResponseV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	184, // [184:184] is the sub-list for method output_type
+	184, // [184:184] is the sub-list for method input_type
+	184, // [184:184] is the sub-list for extension type_name
+	184, // [184:184] is the sub-list for extension extendee
+	0,   // [0:184] is the sub-list for field type_name
+}
+
+func init() { file_iso20022_colr_proto_init() }
+func file_iso20022_colr_proto_init() {
+	if File_iso20022_colr_proto != nil {
+		return
+	}
+	file_iso20022_colr_proto_msgTypes[2].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[3].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[4].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[5].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[6].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[7].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[8].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[9].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[10].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[11].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[12].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[13].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[14].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[15].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[16].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[17].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[48].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[49].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[50].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[51].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[52].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[53].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[54].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[55].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[56].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[57].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[58].OneofWrappers = []any{}
+	file_iso20022_colr_proto_msgTypes[59].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_iso20022_colr_proto_rawDesc), len(file_iso20022_colr_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   60,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_iso20022_colr_proto_goTypes,
+		DependencyIndexes: file_iso20022_colr_proto_depIdxs,
+		MessageInfos:      file_iso20022_colr_proto_msgTypes,
+	}.Build()
+	File_iso20022_colr_proto = out.File
+	file_iso20022_colr_proto_goTypes = nil
+	file_iso20022_colr_proto_depIdxs = nil
+}
@@ -0,0 +1,2939 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: iso20022/fxtr.proto
+
+package fxtrpb
+
+import (
+	modelpb "github.com/yudaprama/iso20022/proto/iso20022/modelpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Document00800105 struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	Message       *ForeignExchangeTradeStatusNotificationV05 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800105) Reset() {
+	*x = Document00800105{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800105) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800105) ProtoMessage() {}
+
+func (x *Document00800105) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800105.ProtoReflect.Descriptor instead.
+func (*Document00800105) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Document00800105) GetMessage() *ForeignExchangeTradeStatusNotificationV05 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document00800106 struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	Message       *ForeignExchangeTradeStatusNotificationV06 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00800106) Reset() {
+	*x = Document00800106{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00800106) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00800106) ProtoMessage() {}
+
+func (x *Document00800106) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00800106.ProtoReflect.Descriptor instead.
+func (*Document00800106) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Document00800106) GetMessage() *ForeignExchangeTradeStatusNotificationV06 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01300103 struct {
+	state         protoimpl.MessageState                         `protogen:"open.v1"`
+	Message       *ForeignExchangeTradeWithdrawalNotificationV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01300103) Reset() {
+	*x = Document01300103{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01300103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01300103) ProtoMessage() {}
+
+func (x *Document01300103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01300103.ProtoReflect.Descriptor instead.
+func (*Document01300103) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Document01300103) GetMessage() *ForeignExchangeTradeWithdrawalNotificationV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01400103 struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Message       *ForeignExchangeTradeInstructionV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01400103) Reset() {
+	*x = Document01400103{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01400103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01400103) ProtoMessage() {}
+
+func (x *Document01400103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01400103.ProtoReflect.Descriptor instead.
+func (*Document01400103) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Document01400103) GetMessage() *ForeignExchangeTradeInstructionV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01400104 struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Message       *ForeignExchangeTradeInstructionV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01400104) Reset() {
+	*x = Document01400104{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01400104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01400104) ProtoMessage() {}
+
+func (x *Document01400104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01400104.ProtoReflect.Descriptor instead.
+func (*Document01400104) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Document01400104) GetMessage() *ForeignExchangeTradeInstructionV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01500103 struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	Message       *ForeignExchangeTradeInstructionAmendmentV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01500103) Reset() {
+	*x = Document01500103{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01500103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01500103) ProtoMessage() {}
+
+func (x *Document01500103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01500103.ProtoReflect.Descriptor instead.
+func (*Document01500103) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Document01500103) GetMessage() *ForeignExchangeTradeInstructionAmendmentV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01500104 struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	Message       *ForeignExchangeTradeInstructionAmendmentV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01500104) Reset() {
+	*x = Document01500104{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01500104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01500104) ProtoMessage() {}
+
+func (x *Document01500104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01500104.ProtoReflect.Descriptor instead.
+func (*Document01500104) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Document01500104) GetMessage() *ForeignExchangeTradeInstructionAmendmentV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01600103 struct {
+	state         protoimpl.MessageState                          `protogen:"open.v1"`
+	Message       *ForeignExchangeTradeInstructionCancellationV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01600103) Reset() {
+	*x = Document01600103{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01600103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01600103) ProtoMessage() {}
+
+func (x *Document01600103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01600103.ProtoReflect.Descriptor instead.
+func (*Document01600103) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Document01600103) GetMessage() *ForeignExchangeTradeInstructionCancellationV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01600104 struct {
+	state         protoimpl.MessageState                          `protogen:"open.v1"`
+	Message       *ForeignExchangeTradeInstructionCancellationV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01600104) Reset() {
+	*x = Document01600104{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01600104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01600104) ProtoMessage() {}
+
+func (x *Document01600104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01600104.ProtoReflect.Descriptor instead.
+func (*Document01600104) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Document01600104) GetMessage() *ForeignExchangeTradeInstructionCancellationV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01700103 struct {
+	state         protoimpl.MessageState                               `protogen:"open.v1"`
+	Message       *ForeignExchangeTradeStatusAndDetailsNotificationV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01700103) Reset() {
+	*x = Document01700103{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01700103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01700103) ProtoMessage() {}
+
+func (x *Document01700103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01700103.ProtoReflect.Descriptor instead.
+func (*Document01700103) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Document01700103) GetMessage() *ForeignExchangeTradeStatusAndDetailsNotificationV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document01700104 struct {
+	state         protoimpl.MessageState                               `protogen:"open.v1"`
+	Message       *ForeignExchangeTradeStatusAndDetailsNotificationV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document01700104) Reset() {
+	*x = Document01700104{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document01700104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document01700104) ProtoMessage() {}
+
+func (x *Document01700104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document01700104.ProtoReflect.Descriptor instead.
+func (*Document01700104) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Document01700104) GetMessage() *ForeignExchangeTradeStatusAndDetailsNotificationV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document03000103 struct {
+	state         protoimpl.MessageState                         `protogen:"open.v1"`
+	Message       *ForeignExchangeTradeBulkStatusNotificationV03 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document03000103) Reset() {
+	*x = Document03000103{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document03000103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document03000103) ProtoMessage() {}
+
+func (x *Document03000103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document03000103.ProtoReflect.Descriptor instead.
+func (*Document03000103) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Document03000103) GetMessage() *ForeignExchangeTradeBulkStatusNotificationV03 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Document03000104 struct {
+	state         protoimpl.MessageState                         `protogen:"open.v1"`
+	Message       *ForeignExchangeTradeBulkStatusNotificationV04 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document03000104) Reset() {
+	*x = Document03000104{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document03000104) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document03000104) ProtoMessage() {}
+
+func (x *Document03000104) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document03000104.ProtoReflect.Descriptor instead.
+func (*Document03000104) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Document03000104) GetMessage() *ForeignExchangeTradeBulkStatusNotificationV04 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// Scope
+// The ForeignExchangeTradeBulkStatusNotification message is sent by a central system to the participant to provide notification of the current status of one or more foreign exchange trades.
+type ForeignExchangeTradeBulkStatusNotificationV03 struct {
+	state             protoimpl.MessageState        `protogen:"open.v1"`
+	StatusDetails     *modelpb.TradeData10          `protobuf:"bytes,1,opt,name=status_details,json=statusDetails,proto3" json:"status_details,omitempty"`
+	TradeData         []*modelpb.TradeData11        `protobuf:"bytes,2,rep,name=trade_data,json=tradeData,proto3" json:"trade_data,omitempty"`
+	MessagePagination *modelpb.Pagination           `protobuf:"bytes,3,opt,name=message_pagination,json=messagePagination,proto3" json:"message_pagination,omitempty"`
+	SupplementaryData []*modelpb.SupplementaryData1 `protobuf:"bytes,4,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ForeignExchangeTradeBulkStatusNotificationV03) Reset() {
+	*x = ForeignExchangeTradeBulkStatusNotificationV03{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForeignExchangeTradeBulkStatusNotificationV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignExchangeTradeBulkStatusNotificationV03) ProtoMessage() {}
+
+func (x *ForeignExchangeTradeBulkStatusNotificationV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignExchangeTradeBulkStatusNotificationV03.ProtoReflect.Descriptor instead.
+func (*ForeignExchangeTradeBulkStatusNotificationV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ForeignExchangeTradeBulkStatusNotificationV03) GetStatusDetails() *modelpb.TradeData10 {
+	if x != nil {
+		return x.StatusDetails
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeBulkStatusNotificationV03) GetTradeData() []*modelpb.TradeData11 {
+	if x != nil {
+		return x.TradeData
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeBulkStatusNotificationV03) GetMessagePagination() *modelpb.Pagination {
+	if x != nil {
+		return x.MessagePagination
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeBulkStatusNotificationV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The ForeignExchangeTradeBulkStatusNotification message is sent by a central system to the participant to provide notification of the current status of one or more foreign exchange trades.
+type ForeignExchangeTradeBulkStatusNotificationV04 struct {
+	state             protoimpl.MessageState        `protogen:"open.v1"`
+	StatusDetails     *modelpb.TradeData12          `protobuf:"bytes,1,opt,name=status_details,json=statusDetails,proto3" json:"status_details,omitempty"`
+	TradeData         []*modelpb.TradeData11        `protobuf:"bytes,2,rep,name=trade_data,json=tradeData,proto3" json:"trade_data,omitempty"`
+	MessagePagination *modelpb.Pagination           `protobuf:"bytes,3,opt,name=message_pagination,json=messagePagination,proto3" json:"message_pagination,omitempty"`
+	SupplementaryData []*modelpb.SupplementaryData1 `protobuf:"bytes,4,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ForeignExchangeTradeBulkStatusNotificationV04) Reset() {
+	*x = ForeignExchangeTradeBulkStatusNotificationV04{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForeignExchangeTradeBulkStatusNotificationV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignExchangeTradeBulkStatusNotificationV04) ProtoMessage() {}
+
+func (x *ForeignExchangeTradeBulkStatusNotificationV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignExchangeTradeBulkStatusNotificationV04.ProtoReflect.Descriptor instead.
+func (*ForeignExchangeTradeBulkStatusNotificationV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ForeignExchangeTradeBulkStatusNotificationV04) GetStatusDetails() *modelpb.TradeData12 {
+	if x != nil {
+		return x.StatusDetails
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeBulkStatusNotificationV04) GetTradeData() []*modelpb.TradeData11 {
+	if x != nil {
+		return x.TradeData
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeBulkStatusNotificationV04) GetMessagePagination() *modelpb.Pagination {
+	if x != nil {
+		return x.MessagePagination
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeBulkStatusNotificationV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The ForeignExchangeTradeInstructionAmendment message is sent by a participant to a central settlement system to notify the amendment of the foreign exchange trade previously confirmed by the sender.
+// Usage
+// The ForeignExchangeTradeInstructionAmendment message is sent from a participant to a central settlement system to advise of the update of a previously sent notification. The "Related Reference" must be used to link it to the previous notification.
+type ForeignExchangeTradeInstructionAmendmentV03 struct {
+	state                                  protoimpl.MessageState             `protogen:"open.v1"`
+	TradeInformation                       *modelpb.TradeAgreement11          `protobuf:"bytes,1,opt,name=trade_information,json=tradeInformation,proto3" json:"trade_information,omitempty"`
+	TradingSideIdentification              *modelpb.TradePartyIdentification6 `protobuf:"bytes,2,opt,name=trading_side_identification,json=tradingSideIdentification,proto3" json:"trading_side_identification,omitempty"`
+	CounterpartySideIdentification         *modelpb.TradePartyIdentification6 `protobuf:"bytes,3,opt,name=counterparty_side_identification,json=counterpartySideIdentification,proto3" json:"counterparty_side_identification,omitempty"`
+	TradeAmounts                           *modelpb.AmountsAndValueDate1      `protobuf:"bytes,4,opt,name=trade_amounts,json=tradeAmounts,proto3" json:"trade_amounts,omitempty"`
+	AgreedRate                             *modelpb.AgreedRate1               `protobuf:"bytes,5,opt,name=agreed_rate,json=agreedRate,proto3" json:"agreed_rate,omitempty"`
+	TradingSideSettlementInstructions      *modelpb.SettlementParties29       `protobuf:"bytes,6,opt,name=trading_side_settlement_instructions,json=tradingSideSettlementInstructions,proto3" json:"trading_side_settlement_instructions,omitempty"`
+	CounterpartySideSettlementInstructions *modelpb.SettlementParties29       `protobuf:"bytes,7,opt,name=counterparty_side_settlement_instructions,json=counterpartySideSettlementInstructions,proto3" json:"counterparty_side_settlement_instructions,omitempty"`
+	OptionalGeneralInformation             *modelpb.GeneralInformation4       `protobuf:"bytes,8,opt,name=optional_general_information,json=optionalGeneralInformation,proto3" json:"optional_general_information,omitempty"`
+	RegulatoryReporting                    *modelpb.RegulatoryReporting4      `protobuf:"bytes,9,opt,name=regulatory_reporting,json=regulatoryReporting,proto3" json:"regulatory_reporting,omitempty"`
+	SupplementaryData                      []*modelpb.SupplementaryData1      `protobuf:"bytes,10,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV03) Reset() {
+	*x = ForeignExchangeTradeInstructionAmendmentV03{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignExchangeTradeInstructionAmendmentV03) ProtoMessage() {}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignExchangeTradeInstructionAmendmentV03.ProtoReflect.Descriptor instead.
+func (*ForeignExchangeTradeInstructionAmendmentV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV03) GetTradeInformation() *modelpb.TradeAgreement11 {
+	if x != nil {
+		return x.TradeInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV03) GetTradingSideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.TradingSideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV03) GetCounterpartySideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.CounterpartySideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV03) GetTradeAmounts() *modelpb.AmountsAndValueDate1 {
+	if x != nil {
+		return x.TradeAmounts
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV03) GetAgreedRate() *modelpb.AgreedRate1 {
+	if x != nil {
+		return x.AgreedRate
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV03) GetTradingSideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.TradingSideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV03) GetCounterpartySideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.CounterpartySideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV03) GetOptionalGeneralInformation() *modelpb.GeneralInformation4 {
+	if x != nil {
+		return x.OptionalGeneralInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV03) GetRegulatoryReporting() *modelpb.RegulatoryReporting4 {
+	if x != nil {
+		return x.RegulatoryReporting
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The ForeignExchangeTradeInstructionAmendment message is sent by a participant to a central settlement system to notify the amendment of the foreign exchange trade previously confirmed by the sender.
+// Usage
+// The ForeignExchangeTradeInstructionAmendment message is sent from a participant to a central settlement system to advise of the update of a previously sent notification. The "Related Reference" must be used to link it to the previous notification.
+type ForeignExchangeTradeInstructionAmendmentV04 struct {
+	state                                  protoimpl.MessageState                    `protogen:"open.v1"`
+	TradeInformation                       *modelpb.TradeAgreement15                 `protobuf:"bytes,1,opt,name=trade_information,json=tradeInformation,proto3" json:"trade_information,omitempty"`
+	TradingSideIdentification              *modelpb.TradePartyIdentification6        `protobuf:"bytes,2,opt,name=trading_side_identification,json=tradingSideIdentification,proto3" json:"trading_side_identification,omitempty"`
+	CounterpartySideIdentification         *modelpb.TradePartyIdentification6        `protobuf:"bytes,3,opt,name=counterparty_side_identification,json=counterpartySideIdentification,proto3" json:"counterparty_side_identification,omitempty"`
+	TradeAmounts                           *modelpb.AmountsAndValueDate1             `protobuf:"bytes,4,opt,name=trade_amounts,json=tradeAmounts,proto3" json:"trade_amounts,omitempty"`
+	AgreedRate                             *modelpb.AgreedRate3                      `protobuf:"bytes,5,opt,name=agreed_rate,json=agreedRate,proto3" json:"agreed_rate,omitempty"`
+	NonDeliverableForwardConditions        *modelpb.NonDeliverableForwardConditions1 `protobuf:"bytes,6,opt,name=non_deliverable_forward_conditions,json=nonDeliverableForwardConditions,proto3" json:"non_deliverable_forward_conditions,omitempty"`
+	TradingSideSettlementInstructions      *modelpb.SettlementParties29              `protobuf:"bytes,7,opt,name=trading_side_settlement_instructions,json=tradingSideSettlementInstructions,proto3" json:"trading_side_settlement_instructions,omitempty"`
+	CounterpartySideSettlementInstructions *modelpb.SettlementParties29              `protobuf:"bytes,8,opt,name=counterparty_side_settlement_instructions,json=counterpartySideSettlementInstructions,proto3" json:"counterparty_side_settlement_instructions,omitempty"`
+	OptionalGeneralInformation             *modelpb.GeneralInformation5              `protobuf:"bytes,9,opt,name=optional_general_information,json=optionalGeneralInformation,proto3" json:"optional_general_information,omitempty"`
+	RegulatoryReporting                    *modelpb.RegulatoryReporting6             `protobuf:"bytes,10,opt,name=regulatory_reporting,json=regulatoryReporting,proto3" json:"regulatory_reporting,omitempty"`
+	SupplementaryData                      []*modelpb.SupplementaryData1             `protobuf:"bytes,11,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV04) Reset() {
+	*x = ForeignExchangeTradeInstructionAmendmentV04{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignExchangeTradeInstructionAmendmentV04) ProtoMessage() {}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignExchangeTradeInstructionAmendmentV04.ProtoReflect.Descriptor instead.
+func (*ForeignExchangeTradeInstructionAmendmentV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV04) GetTradeInformation() *modelpb.TradeAgreement15 {
+	if x != nil {
+		return x.TradeInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV04) GetTradingSideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.TradingSideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV04) GetCounterpartySideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.CounterpartySideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV04) GetTradeAmounts() *modelpb.AmountsAndValueDate1 {
+	if x != nil {
+		return x.TradeAmounts
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV04) GetAgreedRate() *modelpb.AgreedRate3 {
+	if x != nil {
+		return x.AgreedRate
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV04) GetNonDeliverableForwardConditions() *modelpb.NonDeliverableForwardConditions1 {
+	if x != nil {
+		return x.NonDeliverableForwardConditions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV04) GetTradingSideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.TradingSideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV04) GetCounterpartySideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.CounterpartySideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV04) GetOptionalGeneralInformation() *modelpb.GeneralInformation5 {
+	if x != nil {
+		return x.OptionalGeneralInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV04) GetRegulatoryReporting() *modelpb.RegulatoryReporting6 {
+	if x != nil {
+		return x.RegulatoryReporting
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionAmendmentV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The ForeignExchangeTradeInstructionCancellation message is sent by a participant to a central settlement system to notify the cancellation of the foreign exchange trade previously confirmed by the sender.
+// Usage
+// The ForeignExchangeTradeInstructionCancellation message is sent from a participant to a central settlement system to advise of the cancellation of a previously sent notification. The "Related Reference" must be used to link it to the previous notification.
+type ForeignExchangeTradeInstructionCancellationV03 struct {
+	state                                  protoimpl.MessageState             `protogen:"open.v1"`
+	TradeInformation                       *modelpb.TradeAgreement11          `protobuf:"bytes,1,opt,name=trade_information,json=tradeInformation,proto3" json:"trade_information,omitempty"`
+	TradingSideIdentification              *modelpb.TradePartyIdentification6 `protobuf:"bytes,2,opt,name=trading_side_identification,json=tradingSideIdentification,proto3" json:"trading_side_identification,omitempty"`
+	CounterpartySideIdentification         *modelpb.TradePartyIdentification6 `protobuf:"bytes,3,opt,name=counterparty_side_identification,json=counterpartySideIdentification,proto3" json:"counterparty_side_identification,omitempty"`
+	AgreedRate                             *modelpb.AgreedRate1               `protobuf:"bytes,4,opt,name=agreed_rate,json=agreedRate,proto3" json:"agreed_rate,omitempty"`
+	TradingSideSettlementInstructions      *modelpb.SettlementParties29       `protobuf:"bytes,5,opt,name=trading_side_settlement_instructions,json=tradingSideSettlementInstructions,proto3" json:"trading_side_settlement_instructions,omitempty"`
+	CounterpartySideSettlementInstructions *modelpb.SettlementParties29       `protobuf:"bytes,6,opt,name=counterparty_side_settlement_instructions,json=counterpartySideSettlementInstructions,proto3" json:"counterparty_side_settlement_instructions,omitempty"`
+	OptionalGeneralInformation             *modelpb.GeneralInformation4       `protobuf:"bytes,7,opt,name=optional_general_information,json=optionalGeneralInformation,proto3" json:"optional_general_information,omitempty"`
+	TradeAmounts                           *modelpb.AmountsAndValueDate1      `protobuf:"bytes,8,opt,name=trade_amounts,json=tradeAmounts,proto3" json:"trade_amounts,omitempty"`
+	RegulatoryReporting                    *modelpb.RegulatoryReporting4      `protobuf:"bytes,9,opt,name=regulatory_reporting,json=regulatoryReporting,proto3" json:"regulatory_reporting,omitempty"`
+	SupplementaryData                      []*modelpb.SupplementaryData1      `protobuf:"bytes,10,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV03) Reset() {
+	*x = ForeignExchangeTradeInstructionCancellationV03{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignExchangeTradeInstructionCancellationV03) ProtoMessage() {}
+
+func (x *ForeignExchangeTradeInstructionCancellationV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignExchangeTradeInstructionCancellationV03.ProtoReflect.Descriptor instead.
+func (*ForeignExchangeTradeInstructionCancellationV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV03) GetTradeInformation() *modelpb.TradeAgreement11 {
+	if x != nil {
+		return x.TradeInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV03) GetTradingSideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.TradingSideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV03) GetCounterpartySideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.CounterpartySideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV03) GetAgreedRate() *modelpb.AgreedRate1 {
+	if x != nil {
+		return x.AgreedRate
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV03) GetTradingSideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.TradingSideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV03) GetCounterpartySideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.CounterpartySideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV03) GetOptionalGeneralInformation() *modelpb.GeneralInformation4 {
+	if x != nil {
+		return x.OptionalGeneralInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV03) GetTradeAmounts() *modelpb.AmountsAndValueDate1 {
+	if x != nil {
+		return x.TradeAmounts
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV03) GetRegulatoryReporting() *modelpb.RegulatoryReporting4 {
+	if x != nil {
+		return x.RegulatoryReporting
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The ForeignExchangeTradeInstructionCancellation message is sent by a participant to a central settlement system to notify the cancellation of the foreign exchange trade previously confirmed by the sender.
+// Usage
+// The ForeignExchangeTradeInstructionCancellation message is sent from a participant to a central settlement system to advise of the cancellation of a previously sent notification. The "Related Reference" must be used to link it to the previous notification.
+type ForeignExchangeTradeInstructionCancellationV04 struct {
+	state                                  protoimpl.MessageState                    `protogen:"open.v1"`
+	TradeInformation                       *modelpb.TradeAgreement15                 `protobuf:"bytes,1,opt,name=trade_information,json=tradeInformation,proto3" json:"trade_information,omitempty"`
+	TradingSideIdentification              *modelpb.TradePartyIdentification6        `protobuf:"bytes,2,opt,name=trading_side_identification,json=tradingSideIdentification,proto3" json:"trading_side_identification,omitempty"`
+	CounterpartySideIdentification         *modelpb.TradePartyIdentification6        `protobuf:"bytes,3,opt,name=counterparty_side_identification,json=counterpartySideIdentification,proto3" json:"counterparty_side_identification,omitempty"`
+	AgreedRate                             *modelpb.AgreedRate3                      `protobuf:"bytes,4,opt,name=agreed_rate,json=agreedRate,proto3" json:"agreed_rate,omitempty"`
+	NonDeliverableForwardConditions        *modelpb.NonDeliverableForwardConditions1 `protobuf:"bytes,5,opt,name=non_deliverable_forward_conditions,json=nonDeliverableForwardConditions,proto3" json:"non_deliverable_forward_conditions,omitempty"`
+	TradingSideSettlementInstructions      *modelpb.SettlementParties29              `protobuf:"bytes,6,opt,name=trading_side_settlement_instructions,json=tradingSideSettlementInstructions,proto3" json:"trading_side_settlement_instructions,omitempty"`
+	CounterpartySideSettlementInstructions *modelpb.SettlementParties29              `protobuf:"bytes,7,opt,name=counterparty_side_settlement_instructions,json=counterpartySideSettlementInstructions,proto3" json:"counterparty_side_settlement_instructions,omitempty"`
+	OptionalGeneralInformation             *modelpb.GeneralInformation5              `protobuf:"bytes,8,opt,name=optional_general_information,json=optionalGeneralInformation,proto3" json:"optional_general_information,omitempty"`
+	TradeAmounts                           *modelpb.AmountsAndValueDate1             `protobuf:"bytes,9,opt,name=trade_amounts,json=tradeAmounts,proto3" json:"trade_amounts,omitempty"`
+	RegulatoryReporting                    *modelpb.RegulatoryReporting6             `protobuf:"bytes,10,opt,name=regulatory_reporting,json=regulatoryReporting,proto3" json:"regulatory_reporting,omitempty"`
+	SupplementaryData                      []*modelpb.SupplementaryData1             `protobuf:"bytes,11,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV04) Reset() {
+	*x = ForeignExchangeTradeInstructionCancellationV04{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignExchangeTradeInstructionCancellationV04) ProtoMessage() {}
+
+func (x *ForeignExchangeTradeInstructionCancellationV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignExchangeTradeInstructionCancellationV04.ProtoReflect.Descriptor instead.
+func (*ForeignExchangeTradeInstructionCancellationV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV04) GetTradeInformation() *modelpb.TradeAgreement15 {
+	if x != nil {
+		return x.TradeInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV04) GetTradingSideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.TradingSideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV04) GetCounterpartySideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.CounterpartySideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV04) GetAgreedRate() *modelpb.AgreedRate3 {
+	if x != nil {
+		return x.AgreedRate
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV04) GetNonDeliverableForwardConditions() *modelpb.NonDeliverableForwardConditions1 {
+	if x != nil {
+		return x.NonDeliverableForwardConditions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV04) GetTradingSideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.TradingSideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV04) GetCounterpartySideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.CounterpartySideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV04) GetOptionalGeneralInformation() *modelpb.GeneralInformation5 {
+	if x != nil {
+		return x.OptionalGeneralInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV04) GetTradeAmounts() *modelpb.AmountsAndValueDate1 {
+	if x != nil {
+		return x.TradeAmounts
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV04) GetRegulatoryReporting() *modelpb.RegulatoryReporting6 {
+	if x != nil {
+		return x.RegulatoryReporting
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionCancellationV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The ForeignExchangeTradeInstruction message is sent by a participant to a central settlement system to notify the creation of the foreign exchange trade agreed by both trading parties.
+// Usage
+// The ForeignExchangeTradeInstruction message is sent from a participant to a central settlement system to advise of the creation of a foreign exchange trade.
+type ForeignExchangeTradeInstructionV03 struct {
+	state                                  protoimpl.MessageState             `protogen:"open.v1"`
+	TradeInformation                       *modelpb.TradeAgreement10          `protobuf:"bytes,1,opt,name=trade_information,json=tradeInformation,proto3" json:"trade_information,omitempty"`
+	TradingSideIdentification              *modelpb.TradePartyIdentification6 `protobuf:"bytes,2,opt,name=trading_side_identification,json=tradingSideIdentification,proto3" json:"trading_side_identification,omitempty"`
+	CounterpartySideIdentification         *modelpb.TradePartyIdentification6 `protobuf:"bytes,3,opt,name=counterparty_side_identification,json=counterpartySideIdentification,proto3" json:"counterparty_side_identification,omitempty"`
+	TradeAmounts                           *modelpb.AmountsAndValueDate1      `protobuf:"bytes,4,opt,name=trade_amounts,json=tradeAmounts,proto3" json:"trade_amounts,omitempty"`
+	AgreedRate                             *modelpb.AgreedRate1               `protobuf:"bytes,5,opt,name=agreed_rate,json=agreedRate,proto3" json:"agreed_rate,omitempty"`
+	TradingSideSettlementInstructions      *modelpb.SettlementParties29       `protobuf:"bytes,6,opt,name=trading_side_settlement_instructions,json=tradingSideSettlementInstructions,proto3" json:"trading_side_settlement_instructions,omitempty"`
+	CounterpartySideSettlementInstructions *modelpb.SettlementParties29       `protobuf:"bytes,7,opt,name=counterparty_side_settlement_instructions,json=counterpartySideSettlementInstructions,proto3" json:"counterparty_side_settlement_instructions,omitempty"`
+	OptionalGeneralInformation             *modelpb.GeneralInformation4       `protobuf:"bytes,8,opt,name=optional_general_information,json=optionalGeneralInformation,proto3" json:"optional_general_information,omitempty"`
+	RegulatoryReporting                    *modelpb.RegulatoryReporting4      `protobuf:"bytes,9,opt,name=regulatory_reporting,json=regulatoryReporting,proto3" json:"regulatory_reporting,omitempty"`
+	SupplementaryData                      []*modelpb.SupplementaryData1      `protobuf:"bytes,10,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
+}
+
+func (x *ForeignExchangeTradeInstructionV03) Reset() {
+	*x = ForeignExchangeTradeInstructionV03{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForeignExchangeTradeInstructionV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignExchangeTradeInstructionV03) ProtoMessage() {}
+
+func (x *ForeignExchangeTradeInstructionV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignExchangeTradeInstructionV03.ProtoReflect.Descriptor instead.
+func (*ForeignExchangeTradeInstructionV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ForeignExchangeTradeInstructionV03) GetTradeInformation() *modelpb.TradeAgreement10 {
+	if x != nil {
+		return x.TradeInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV03) GetTradingSideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.TradingSideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV03) GetCounterpartySideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.CounterpartySideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV03) GetTradeAmounts() *modelpb.AmountsAndValueDate1 {
+	if x != nil {
+		return x.TradeAmounts
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV03) GetAgreedRate() *modelpb.AgreedRate1 {
+	if x != nil {
+		return x.AgreedRate
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV03) GetTradingSideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.TradingSideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV03) GetCounterpartySideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.CounterpartySideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV03) GetOptionalGeneralInformation() *modelpb.GeneralInformation4 {
+	if x != nil {
+		return x.OptionalGeneralInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV03) GetRegulatoryReporting() *modelpb.RegulatoryReporting4 {
+	if x != nil {
+		return x.RegulatoryReporting
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The ForeignExchangeTradeInstruction message is sent by a participant to a central settlement system to notify the creation of the foreign exchange trade agreed by both trading parties.
+// Usage
+// The ForeignExchangeTradeInstruction message is sent from a participant to a central settlement system to advise of the creation of a foreign exchange trade.
+type ForeignExchangeTradeInstructionV04 struct {
+	state                                  protoimpl.MessageState                    `protogen:"open.v1"`
+	TradeInformation                       *modelpb.TradeAgreement14                 `protobuf:"bytes,1,opt,name=trade_information,json=tradeInformation,proto3" json:"trade_information,omitempty"`
+	TradingSideIdentification              *modelpb.TradePartyIdentification6        `protobuf:"bytes,2,opt,name=trading_side_identification,json=tradingSideIdentification,proto3" json:"trading_side_identification,omitempty"`
+	CounterpartySideIdentification         *modelpb.TradePartyIdentification6        `protobuf:"bytes,3,opt,name=counterparty_side_identification,json=counterpartySideIdentification,proto3" json:"counterparty_side_identification,omitempty"`
+	TradeAmounts                           *modelpb.AmountsAndValueDate1             `protobuf:"bytes,4,opt,name=trade_amounts,json=tradeAmounts,proto3" json:"trade_amounts,omitempty"`
+	AgreedRate                             *modelpb.AgreedRate3                      `protobuf:"bytes,5,opt,name=agreed_rate,json=agreedRate,proto3" json:"agreed_rate,omitempty"`
+	NonDeliverableForwardConditions        *modelpb.NonDeliverableForwardConditions1 `protobuf:"bytes,6,opt,name=non_deliverable_forward_conditions,json=nonDeliverableForwardConditions,proto3" json:"non_deliverable_forward_conditions,omitempty"`
+	TradingSideSettlementInstructions      *modelpb.SettlementParties29              `protobuf:"bytes,7,opt,name=trading_side_settlement_instructions,json=tradingSideSettlementInstructions,proto3" json:"trading_side_settlement_instructions,omitempty"`
+	CounterpartySideSettlementInstructions *modelpb.SettlementParties29              `protobuf:"bytes,8,opt,name=counterparty_side_settlement_instructions,json=counterpartySideSettlementInstructions,proto3" json:"counterparty_side_settlement_instructions,omitempty"`
+	OptionalGeneralInformation             *modelpb.GeneralInformation5              `protobuf:"bytes,9,opt,name=optional_general_information,json=optionalGeneralInformation,proto3" json:"optional_general_information,omitempty"`
+	RegulatoryReporting                    *modelpb.RegulatoryReporting6             `protobuf:"bytes,10,opt,name=regulatory_reporting,json=regulatoryReporting,proto3" json:"regulatory_reporting,omitempty"`
+	SupplementaryData                      []*modelpb.SupplementaryData1             `protobuf:"bytes,11,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
+}
+
+func (x *ForeignExchangeTradeInstructionV04) Reset() {
+	*x = ForeignExchangeTradeInstructionV04{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForeignExchangeTradeInstructionV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignExchangeTradeInstructionV04) ProtoMessage() {}
+
+func (x *ForeignExchangeTradeInstructionV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignExchangeTradeInstructionV04.ProtoReflect.Descriptor instead.
+func (*ForeignExchangeTradeInstructionV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ForeignExchangeTradeInstructionV04) GetTradeInformation() *modelpb.TradeAgreement14 {
+	if x != nil {
+		return x.TradeInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV04) GetTradingSideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.TradingSideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV04) GetCounterpartySideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.CounterpartySideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV04) GetTradeAmounts() *modelpb.AmountsAndValueDate1 {
+	if x != nil {
+		return x.TradeAmounts
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV04) GetAgreedRate() *modelpb.AgreedRate3 {
+	if x != nil {
+		return x.AgreedRate
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV04) GetNonDeliverableForwardConditions() *modelpb.NonDeliverableForwardConditions1 {
+	if x != nil {
+		return x.NonDeliverableForwardConditions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV04) GetTradingSideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.TradingSideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV04) GetCounterpartySideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.CounterpartySideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV04) GetOptionalGeneralInformation() *modelpb.GeneralInformation5 {
+	if x != nil {
+		return x.OptionalGeneralInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV04) GetRegulatoryReporting() *modelpb.RegulatoryReporting6 {
+	if x != nil {
+		return x.RegulatoryReporting
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeInstructionV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The ForeignExchangeTradeStatusAndDetails message is sent by a central system to the participant to provide notification of the status and details of a foreign exchange trade.
+// Usage
+// The notification is sent by a central settlement system to the two trading parties after it has received foreign exchange trade instructions from both.
+type ForeignExchangeTradeStatusAndDetailsNotificationV03 struct {
+	state                                  protoimpl.MessageState             `protogen:"open.v1"`
+	StatusDetails                          *modelpb.TradeData9                `protobuf:"bytes,1,opt,name=status_details,json=statusDetails,proto3" json:"status_details,omitempty"`
+	TradeInformation                       *modelpb.TradeAgreement12          `protobuf:"bytes,2,opt,name=trade_information,json=tradeInformation,proto3" json:"trade_information,omitempty"`
+	TradingSideIdentification              *modelpb.TradePartyIdentification6 `protobuf:"bytes,3,opt,name=trading_side_identification,json=tradingSideIdentification,proto3" json:"trading_side_identification,omitempty"`
+	CounterpartySideIdentification         *modelpb.TradePartyIdentification6 `protobuf:"bytes,4,opt,name=counterparty_side_identification,json=counterpartySideIdentification,proto3" json:"counterparty_side_identification,omitempty"`
+	TradeAmounts                           *modelpb.AmountsAndValueDate1      `protobuf:"bytes,5,opt,name=trade_amounts,json=tradeAmounts,proto3" json:"trade_amounts,omitempty"`
+	AgreedRate                             *modelpb.AgreedRate1               `protobuf:"bytes,6,opt,name=agreed_rate,json=agreedRate,proto3" json:"agreed_rate,omitempty"`
+	TradingSideSettlementInstructions      *modelpb.SettlementParties29       `protobuf:"bytes,7,opt,name=trading_side_settlement_instructions,json=tradingSideSettlementInstructions,proto3" json:"trading_side_settlement_instructions,omitempty"`
+	CounterpartySideSettlementInstructions *modelpb.SettlementParties29       `protobuf:"bytes,8,opt,name=counterparty_side_settlement_instructions,json=counterpartySideSettlementInstructions,proto3" json:"counterparty_side_settlement_instructions,omitempty"`
+	GeneralInformation                     *modelpb.GeneralInformation4       `protobuf:"bytes,9,opt,name=general_information,json=generalInformation,proto3" json:"general_information,omitempty"`
+	SplitTradeInformation                  []*modelpb.SplitTradeDetails1      `protobuf:"bytes,10,rep,name=split_trade_information,json=splitTradeInformation,proto3" json:"split_trade_information,omitempty"`
+	RegulatoryReporting                    *modelpb.RegulatoryReporting4      `protobuf:"bytes,11,opt,name=regulatory_reporting,json=regulatoryReporting,proto3" json:"regulatory_reporting,omitempty"`
+	SupplementaryData                      []*modelpb.SupplementaryData1      `protobuf:"bytes,12,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV03) Reset() {
+	*x = ForeignExchangeTradeStatusAndDetailsNotificationV03{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignExchangeTradeStatusAndDetailsNotificationV03) ProtoMessage() {}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignExchangeTradeStatusAndDetailsNotificationV03.ProtoReflect.Descriptor instead.
+func (*ForeignExchangeTradeStatusAndDetailsNotificationV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV03) GetStatusDetails() *modelpb.TradeData9 {
+	if x != nil {
+		return x.StatusDetails
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV03) GetTradeInformation() *modelpb.TradeAgreement12 {
+	if x != nil {
+		return x.TradeInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV03) GetTradingSideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.TradingSideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV03) GetCounterpartySideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.CounterpartySideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV03) GetTradeAmounts() *modelpb.AmountsAndValueDate1 {
+	if x != nil {
+		return x.TradeAmounts
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV03) GetAgreedRate() *modelpb.AgreedRate1 {
+	if x != nil {
+		return x.AgreedRate
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV03) GetTradingSideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.TradingSideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV03) GetCounterpartySideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.CounterpartySideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV03) GetGeneralInformation() *modelpb.GeneralInformation4 {
+	if x != nil {
+		return x.GeneralInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV03) GetSplitTradeInformation() []*modelpb.SplitTradeDetails1 {
+	if x != nil {
+		return x.SplitTradeInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV03) GetRegulatoryReporting() *modelpb.RegulatoryReporting4 {
+	if x != nil {
+		return x.RegulatoryReporting
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The ForeignExchangeTradeStatusAndDetails message is sent by a central system to the participant to provide notification of the status and details of a foreign exchange trade.
+// Usage
+// The notification is sent by a central settlement system to the two trading parties after it has received foreign exchange trade instructions from both.
+type ForeignExchangeTradeStatusAndDetailsNotificationV04 struct {
+	state                                  protoimpl.MessageState                    `protogen:"open.v1"`
+	StatusDetails                          *modelpb.TradeData14                      `protobuf:"bytes,1,opt,name=status_details,json=statusDetails,proto3" json:"status_details,omitempty"`
+	TradeInformation                       *modelpb.TradeAgreement12                 `protobuf:"bytes,2,opt,name=trade_information,json=tradeInformation,proto3" json:"trade_information,omitempty"`
+	TradingSideIdentification              *modelpb.TradePartyIdentification6        `protobuf:"bytes,3,opt,name=trading_side_identification,json=tradingSideIdentification,proto3" json:"trading_side_identification,omitempty"`
+	CounterpartySideIdentification         *modelpb.TradePartyIdentification6        `protobuf:"bytes,4,opt,name=counterparty_side_identification,json=counterpartySideIdentification,proto3" json:"counterparty_side_identification,omitempty"`
+	TradeAmounts                           *modelpb.AmountsAndValueDate1             `protobuf:"bytes,5,opt,name=trade_amounts,json=tradeAmounts,proto3" json:"trade_amounts,omitempty"`
+	AgreedRate                             *modelpb.AgreedRate3                      `protobuf:"bytes,6,opt,name=agreed_rate,json=agreedRate,proto3" json:"agreed_rate,omitempty"`
+	NonDeliverableForwardConditions        *modelpb.NonDeliverableForwardConditions2 `protobuf:"bytes,7,opt,name=non_deliverable_forward_conditions,json=nonDeliverableForwardConditions,proto3" json:"non_deliverable_forward_conditions,omitempty"`
+	TradingSideSettlementInstructions      *modelpb.SettlementParties29              `protobuf:"bytes,8,opt,name=trading_side_settlement_instructions,json=tradingSideSettlementInstructions,proto3" json:"trading_side_settlement_instructions,omitempty"`
+	CounterpartySideSettlementInstructions *modelpb.SettlementParties29              `protobuf:"bytes,9,opt,name=counterparty_side_settlement_instructions,json=counterpartySideSettlementInstructions,proto3" json:"counterparty_side_settlement_instructions,omitempty"`
+	GeneralInformation                     *modelpb.GeneralInformation5              `protobuf:"bytes,10,opt,name=general_information,json=generalInformation,proto3" json:"general_information,omitempty"`
+	SplitTradeInformation                  []*modelpb.SplitTradeDetails3             `protobuf:"bytes,11,rep,name=split_trade_information,json=splitTradeInformation,proto3" json:"split_trade_information,omitempty"`
+	RegulatoryReporting                    *modelpb.RegulatoryReporting6             `protobuf:"bytes,12,opt,name=regulatory_reporting,json=regulatoryReporting,proto3" json:"regulatory_reporting,omitempty"`
+	SupplementaryData                      []*modelpb.SupplementaryData1             `protobuf:"bytes,13,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) Reset() {
+	*x = ForeignExchangeTradeStatusAndDetailsNotificationV04{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignExchangeTradeStatusAndDetailsNotificationV04) ProtoMessage() {}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignExchangeTradeStatusAndDetailsNotificationV04.ProtoReflect.Descriptor instead.
+func (*ForeignExchangeTradeStatusAndDetailsNotificationV04) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) GetStatusDetails() *modelpb.TradeData14 {
+	if x != nil {
+		return x.StatusDetails
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) GetTradeInformation() *modelpb.TradeAgreement12 {
+	if x != nil {
+		return x.TradeInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) GetTradingSideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.TradingSideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) GetCounterpartySideIdentification() *modelpb.TradePartyIdentification6 {
+	if x != nil {
+		return x.CounterpartySideIdentification
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) GetTradeAmounts() *modelpb.AmountsAndValueDate1 {
+	if x != nil {
+		return x.TradeAmounts
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) GetAgreedRate() *modelpb.AgreedRate3 {
+	if x != nil {
+		return x.AgreedRate
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) GetNonDeliverableForwardConditions() *modelpb.NonDeliverableForwardConditions2 {
+	if x != nil {
+		return x.NonDeliverableForwardConditions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) GetTradingSideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.TradingSideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) GetCounterpartySideSettlementInstructions() *modelpb.SettlementParties29 {
+	if x != nil {
+		return x.CounterpartySideSettlementInstructions
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) GetGeneralInformation() *modelpb.GeneralInformation5 {
+	if x != nil {
+		return x.GeneralInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) GetSplitTradeInformation() []*modelpb.SplitTradeDetails3 {
+	if x != nil {
+		return x.SplitTradeInformation
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) GetRegulatoryReporting() *modelpb.RegulatoryReporting6 {
+	if x != nil {
+		return x.RegulatoryReporting
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusAndDetailsNotificationV04) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The ForeignExchangeTradeStatusNotification message is sent by a central system to the participant to notify the current status of a foreign exchange trade in the system.
+// Usage
+// This ForeignExchangeTradeStatusNotification message will be sent at specific times agreed upon by the central settlement system and a participant in a central settlement system.
+type ForeignExchangeTradeStatusNotificationV05 struct {
+	state               protoimpl.MessageState        `protogen:"open.v1"`
+	TradeData           *modelpb.TradeData7           `protobuf:"bytes,1,opt,name=trade_data,json=tradeData,proto3" json:"trade_data,omitempty"`
+	RegulatoryReporting *modelpb.RegulatoryReporting4 `protobuf:"bytes,2,opt,name=regulatory_reporting,json=regulatoryReporting,proto3" json:"regulatory_reporting,omitempty"`
+	SupplementaryData   []*modelpb.SupplementaryData1 `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ForeignExchangeTradeStatusNotificationV05) Reset() {
+	*x = ForeignExchangeTradeStatusNotificationV05{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForeignExchangeTradeStatusNotificationV05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignExchangeTradeStatusNotificationV05) ProtoMessage() {}
+
+func (x *ForeignExchangeTradeStatusNotificationV05) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignExchangeTradeStatusNotificationV05.ProtoReflect.Descriptor instead.
+func (*ForeignExchangeTradeStatusNotificationV05) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ForeignExchangeTradeStatusNotificationV05) GetTradeData() *modelpb.TradeData7 {
+	if x != nil {
+		return x.TradeData
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusNotificationV05) GetRegulatoryReporting() *modelpb.RegulatoryReporting4 {
+	if x != nil {
+		return x.RegulatoryReporting
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusNotificationV05) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The ForeignExchangeTradeStatusNotification message is sent by a central system to the participant to notify the current status of a foreign exchange trade in the system.
+// Usage
+// This ForeignExchangeTradeStatusNotification message will be sent at specific times agreed upon by the central settlement system and a participant in a central settlement system.
+type ForeignExchangeTradeStatusNotificationV06 struct {
+	state               protoimpl.MessageState        `protogen:"open.v1"`
+	TradeData           *modelpb.TradeData15          `protobuf:"bytes,1,opt,name=trade_data,json=tradeData,proto3" json:"trade_data,omitempty"`
+	RegulatoryReporting *modelpb.RegulatoryReporting6 `protobuf:"bytes,2,opt,name=regulatory_reporting,json=regulatoryReporting,proto3" json:"regulatory_reporting,omitempty"`
+	SupplementaryData   []*modelpb.SupplementaryData1 `protobuf:"bytes,3,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ForeignExchangeTradeStatusNotificationV06) Reset() {
+	*x = ForeignExchangeTradeStatusNotificationV06{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForeignExchangeTradeStatusNotificationV06) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignExchangeTradeStatusNotificationV06) ProtoMessage() {}
+
+func (x *ForeignExchangeTradeStatusNotificationV06) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignExchangeTradeStatusNotificationV06.ProtoReflect.Descriptor instead.
+func (*ForeignExchangeTradeStatusNotificationV06) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ForeignExchangeTradeStatusNotificationV06) GetTradeData() *modelpb.TradeData15 {
+	if x != nil {
+		return x.TradeData
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusNotificationV06) GetRegulatoryReporting() *modelpb.RegulatoryReporting6 {
+	if x != nil {
+		return x.RegulatoryReporting
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeStatusNotificationV06) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+// Scope
+// The ForeignExchangeTradeWithdrawalNotification message is sent by a central system to notify the withdrawal of a foreign exchange trade which was previously notified to the receiver as an alleged trade.
+// Usage
+// The ForeignExchangeTradeWithdrawalNotification message is used to confirm the cancellation of a previously notified trade.
+type ForeignExchangeTradeWithdrawalNotificationV03 struct {
+	state                         protoimpl.MessageState        `protogen:"open.v1"`
+	MessageIdentification         *string                       `protobuf:"bytes,1,opt,name=message_identification,json=messageIdentification,proto3,oneof" json:"message_identification,omitempty"`
+	MatchingSystemUniqueReference *string                       `protobuf:"bytes,2,opt,name=matching_system_unique_reference,json=matchingSystemUniqueReference,proto3,oneof" json:"matching_system_unique_reference,omitempty"`
+	WithdrawalReason              *modelpb.WithdrawalReason1    `protobuf:"bytes,3,opt,name=withdrawal_reason,json=withdrawalReason,proto3" json:"withdrawal_reason,omitempty"`
+	SettlementSessionIdentifier   *string                       `protobuf:"bytes,4,opt,name=settlement_session_identifier,json=settlementSessionIdentifier,proto3,oneof" json:"settlement_session_identifier,omitempty"`
+	SupplementaryData             []*modelpb.SupplementaryData1 `protobuf:"bytes,5,rep,name=supplementary_data,json=supplementaryData,proto3" json:"supplementary_data,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *ForeignExchangeTradeWithdrawalNotificationV03) Reset() {
+	*x = ForeignExchangeTradeWithdrawalNotificationV03{}
+	mi := &file_iso20022_fxtr_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForeignExchangeTradeWithdrawalNotificationV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignExchangeTradeWithdrawalNotificationV03) ProtoMessage() {}
+
+func (x *ForeignExchangeTradeWithdrawalNotificationV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_fxtr_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignExchangeTradeWithdrawalNotificationV03.ProtoReflect.Descriptor instead.
+func (*ForeignExchangeTradeWithdrawalNotificationV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_fxtr_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ForeignExchangeTradeWithdrawalNotificationV03) GetMessageIdentification() string {
+	if x != nil && x.MessageIdentification != nil {
+		return *x.MessageIdentification
+	}
+	return ""
+}
+
+func (x *ForeignExchangeTradeWithdrawalNotificationV03) GetMatchingSystemUniqueReference() string {
+	if x != nil && x.MatchingSystemUniqueReference != nil {
+		return *x.MatchingSystemUniqueReference
+	}
+	return ""
+}
+
+func (x *ForeignExchangeTradeWithdrawalNotificationV03) GetWithdrawalReason() *modelpb.WithdrawalReason1 {
+	if x != nil {
+		return x.WithdrawalReason
+	}
+	return nil
+}
+
+func (x *ForeignExchangeTradeWithdrawalNotificationV03) GetSettlementSessionIdentifier() string {
+	if x != nil && x.SettlementSessionIdentifier != nil {
+		return *x.SettlementSessionIdentifier
+	}
+	return ""
+}
+
+func (x *ForeignExchangeTradeWithdrawalNotificationV03) GetSupplementaryData() []*modelpb.SupplementaryData1 {
+	if x != nil {
+		return x.SupplementaryData
+	}
+	return nil
+}
+
+var File_iso20022_fxtr_proto protoreflect.FileDescriptor
+
+var file_iso20022_fxtr_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x66, 0x78, 0x74, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x66, 0x78, 0x74, 0x72, 0x1a, 0x14, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x66, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x38, 0x30, 0x30, 0x31, 0x30, 0x35, 0x12, 0x52,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x38, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x66, 0x78, 0x74, 0x72, 0x2e,
+	0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x35, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x66, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30,
+	0x38, 0x30, 0x30, 0x31, 0x30, 0x36, 0x12, 0x52, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x66, 0x78, 0x74, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30,
+	0x36, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6a, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x33, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x56,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x66, 0x78, 0x74, 0x72, 0x2e,
+	0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5f, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x31, 0x34, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x4b, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x66, 0x78, 0x74, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x33, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5f, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x30, 0x31, 0x34, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x4b, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x66, 0x78, 0x74, 0x72, 0x2e, 0x46, 0x6f, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x68, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x35, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x54, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x66, 0x78, 0x74, 0x72, 0x2e, 0x46, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x65,
+	0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x68, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31,
+	0x35, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x54, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x66, 0x78, 0x74, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6b, 0x0a, 0x10,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x36, 0x30, 0x30, 0x31, 0x30, 0x33,
+	0x12, 0x57, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x66, 0x78, 0x74,
+	0x72, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x33,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6b, 0x0a, 0x10, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x36, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x57, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x66, 0x78, 0x74, 0x72, 0x2e, 0x46,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x70, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x31, 0x37, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x5c, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x66, 0x78, 0x74, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x33, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x70, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x30, 0x31, 0x37, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x5c, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x66, 0x78, 0x74, 0x72, 0x2e, 0x46, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30,
+	0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6a, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x33, 0x30, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x56,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x66, 0x78, 0x74, 0x72, 0x2e,
+	0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x33, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x30, 0x33, 0x30, 0x30, 0x30, 0x31, 0x30, 0x34, 0x12, 0x56, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x66, 0x78, 0x74, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x42, 0x75, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0xcd, 0x02, 0x0a, 0x2d, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x30, 0x33, 0x12, 0x42, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x31, 0x30, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x31, 0x31, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x49, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52,
+	0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0xcd, 0x02, 0x0a, 0x2d, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x30, 0x34, 0x12, 0x42, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x31, 0x32, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x31, 0x31, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x49, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52,
+	0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0xee, 0x07, 0x0a, 0x2b, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x56,
+	0x30, 0x33, 0x12, 0x4d, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x31, 0x52,
+	0x10, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x69, 0x0a, 0x1b, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x36, 0x52, 0x19, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x64, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x73, 0x0a, 0x20,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x73, 0x69, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x36, 0x52, 0x1e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x53,
+	0x69, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x49, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x41, 0x6e, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x31, 0x52, 0x0c,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0b,
+	0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x31, 0x52, 0x0a,
+	0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x74, 0x0a, 0x24, 0x74, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x32, 0x39, 0x52, 0x21, 0x74,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x7e, 0x0a, 0x29, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x32, 0x39, 0x52, 0x26, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x53, 0x69, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x65, 0x0a, 0x1c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x1a, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x34, 0x52, 0x13, 0x72, 0x65, 0x67,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31,
+	0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0xed, 0x08, 0x0a, 0x2b, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x30, 0x34, 0x12, 0x4d, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x35,
+	0x52, 0x10, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x1b, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x36, 0x52, 0x19, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x64, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x73, 0x0a,
+	0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x73, 0x69,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x36, 0x52, 0x1e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x53, 0x69, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x41, 0x6e, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x31, 0x52,
+	0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a,
+	0x0b, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x33, 0x52,
+	0x0a, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x7d, 0x0a, 0x22, 0x6e,
+	0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4e, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x31, 0x52, 0x1f, 0x6e, 0x6f, 0x6e, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x74, 0x0a, 0x24, 0x74, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x32, 0x39, 0x52, 0x21, 0x74,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x7e, 0x0a, 0x29, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x32, 0x39, 0x52, 0x26, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x53, 0x69, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x65, 0x0a, 0x1c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x1a, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x36, 0x52, 0x13, 0x72, 0x65, 0x67,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31,
+	0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0xf1, 0x07, 0x0a, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x30, 0x33, 0x12, 0x4d, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x31, 0x31, 0x52, 0x10, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x1b, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52, 0x19, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69,
+	0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x73, 0x0a, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52, 0x1e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x53, 0x69, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0b, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65,
+	0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x31, 0x52, 0x0a, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x74, 0x0a, 0x24, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x69, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x32, 0x39, 0x52, 0x21, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x69, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x7e, 0x0a, 0x29, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x73,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x32,
+	0x39, 0x52, 0x26, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x53,
+	0x69, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x65, 0x0a, 0x1c, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x34, 0x52, 0x1a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x49, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x41, 0x6e, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x31, 0x52, 0x0c, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x57, 0x0a, 0x14, 0x72,
+	0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x67, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x34, 0x52,
+	0x13, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xf0, 0x08, 0x0a, 0x2e, 0x46, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34, 0x12, 0x4d, 0x0a, 0x11, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x35, 0x52, 0x10, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x1b, 0x74, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52, 0x19, 0x74, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x53, 0x69, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x73, 0x0a, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52, 0x1e, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x53, 0x69, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0b, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x67, 0x72, 0x65, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x33, 0x52, 0x0a, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x7d, 0x0a, 0x22, 0x6e, 0x6f, 0x6e, 0x5f, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4e, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x61,
+	0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x31, 0x52, 0x1f, 0x6e, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x74, 0x0a, 0x24, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x32, 0x39, 0x52, 0x21, 0x74, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x53, 0x69, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x7e, 0x0a, 0x29,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x73, 0x69, 0x64,
+	0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x32, 0x39, 0x52, 0x26, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x53, 0x69, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x65, 0x0a, 0x1c,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x1a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x31,
+	0x52, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x57,
+	0x0a, 0x14, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65,
+	0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x36, 0x52, 0x13, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0xe5, 0x07, 0x0a, 0x22, 0x46,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30,
+	0x33, 0x12, 0x4d, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x30, 0x52, 0x10,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x69, 0x0a, 0x1b, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36,
+	0x52, 0x19, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x64, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x73, 0x0a, 0x20, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36,
+	0x52, 0x1e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x53, 0x69,
+	0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x49, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x41, 0x6e, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x31, 0x52, 0x0c, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x61,
+	0x67, 0x72, 0x65, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x31, 0x52, 0x0a, 0x61,
+	0x67, 0x72, 0x65, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x74, 0x0a, 0x24, 0x74, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x32, 0x39, 0x52, 0x21, 0x74, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x7e, 0x0a, 0x29, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f,
+	0x73, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x32, 0x39, 0x52, 0x26, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x53, 0x69, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x65, 0x0a, 0x1c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x52, 0x1a, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x34, 0x52, 0x13, 0x72, 0x65, 0x67, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52,
+	0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0xe4, 0x08, 0x0a, 0x22, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34, 0x12, 0x4d, 0x0a, 0x11, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x31, 0x34, 0x52, 0x10, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x1b, 0x74, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52, 0x19, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x69, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x73, 0x0a, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52, 0x1e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x53, 0x69, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x31, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x64,
+	0x52, 0x61, 0x74, 0x65, 0x33, 0x52, 0x0a, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x7d, 0x0a, 0x22, 0x6e, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4e,
+	0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x31, 0x52,
+	0x1f, 0x6e, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x74, 0x0a, 0x24, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x64, 0x65,
+	0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x32, 0x39, 0x52, 0x21, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x64, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x7e, 0x0a, 0x29, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x32, 0x39, 0x52, 0x26,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x53, 0x69, 0x64, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x65, 0x0a, 0x1c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x35, 0x52, 0x1a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a,
+	0x14, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x67,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x36, 0x52, 0x13, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0x84, 0x09, 0x0a, 0x33, 0x46, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30,
+	0x33, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x39, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x4d, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x31,
+	0x32, 0x52, 0x10, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x1b, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x36, 0x52, 0x19, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x64, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x73,
+	0x0a, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x73,
+	0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x36, 0x52, 0x1e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x53, 0x69, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x31,
+	0x52, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x3c,
+	0x0a, 0x0b, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x31,
+	0x52, 0x0a, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x74, 0x0a, 0x24,
+	0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x32, 0x39, 0x52,
+	0x21, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x7e, 0x0a, 0x29, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x32, 0x39, 0x52, 0x26, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x53, 0x69, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x54, 0x0a, 0x13, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x34, 0x52, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x17, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x31, 0x52, 0x15, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x34, 0x52, 0x13, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x51, 0x0a,
+	0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0x84, 0x0a, 0x0a, 0x33, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41,
+	0x6e, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x34, 0x12, 0x42, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x31, 0x34, 0x52, 0x0d, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x4d, 0x0a, 0x11,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x67,
+	0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x32, 0x52, 0x10, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x1b, 0x74,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52, 0x19, 0x74, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x73, 0x0a, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x52, 0x1e, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x53, 0x69, 0x64, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x0d, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x31, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x67, 0x72,
+	0x65, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x33, 0x52, 0x0a, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x7d, 0x0a, 0x22, 0x6e, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x4e, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x32, 0x52, 0x1f, 0x6e, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x74, 0x0a, 0x24, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x69, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x32, 0x39, 0x52, 0x21, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x69, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x7e, 0x0a, 0x29, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x73,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69,
+	0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x32,
+	0x39, 0x52, 0x26, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x53,
+	0x69, 0x64, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x54, 0x0a, 0x13, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x52, 0x12, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x5a, 0x0a, 0x17, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x33, 0x52, 0x15, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x14, 0x72,
+	0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x67, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x36, 0x52,
+	0x13, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0x92, 0x02, 0x0a, 0x29, 0x46, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x30, 0x35, 0x12, 0x39, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x37, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x57, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x34, 0x52, 0x13, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0x93, 0x02, 0x0a,
+	0x29, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x36, 0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x31, 0x35, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x57, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x36, 0x52, 0x13, 0x72, 0x65, 0x67, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52,
+	0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0x87, 0x04, 0x0a, 0x2d, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x30, 0x33, 0x12, 0x3a, 0x0a, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x4c, 0x0a, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x1d, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4e,
+	0x0a, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x31, 0x52, 0x10, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x47,
+	0x0a, 0x1d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x1b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x31, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x23, 0x0a, 0x21, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x73,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x35, 0x5a, 0x33,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x75, 0x64, 0x61, 0x70,
+	0x72, 0x61, 0x6d, 0x61, 0x2f, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x66, 0x78, 0x74,
+	0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_iso20022_fxtr_proto_rawDescOnce sync.Once
+	file_iso20022_fxtr_proto_rawDescData []byte
+)
+
+func file_iso20022_fxtr_proto_rawDescGZIP() []byte {
+	file_iso20022_fxtr_proto_rawDescOnce.Do(func() {
+		file_iso20022_fxtr_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_iso20022_fxtr_proto_rawDesc), len(file_iso20022_fxtr_proto_rawDesc)))
+	})
+	return file_iso20022_fxtr_proto_rawDescData
+}
+
+var file_iso20022_fxtr_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_iso20022_fxtr_proto_goTypes = []any{
+	(*Document00800105)(nil),                                    // 0: iso20022.fxtr.Document00800105
+	(*Document00800106)(nil),                                    // 1: iso20022.fxtr.Document00800106
+	(*Document01300103)(nil),                                    // 2: iso20022.fxtr.Document01300103
+	(*Document01400103)(nil),                                    // 3: iso20022.fxtr.Document01400103
+	(*Document01400104)(nil),                                    // 4: iso20022.fxtr.Document01400104
+	(*Document01500103)(nil),                                    // 5: iso20022.fxtr.Document01500103
+	(*Document01500104)(nil),                                    // 6: iso20022.fxtr.Document01500104
+	(*Document01600103)(nil),                                    // 7: iso20022.fxtr.Document01600103
+	(*Document01600104)(nil),                                    // 8: iso20022.fxtr.Document01600104
+	(*Document01700103)(nil),                                    // 9: iso20022.fxtr.Document01700103
+	(*Document01700104)(nil),                                    // 10: iso20022.fxtr.Document01700104
+	(*Document03000103)(nil),                                    // 11: iso20022.fxtr.Document03000103
+	(*Document03000104)(nil),                                    // 12: iso20022.fxtr.Document03000104
+	(*ForeignExchangeTradeBulkStatusNotificationV03)(nil),       // 13: iso20022.fxtr.ForeignExchangeTradeBulkStatusNotificationV03
+	(*ForeignExchangeTradeBulkStatusNotificationV04)(nil),       // 14: iso20022.fxtr.ForeignExchangeTradeBulkStatusNotificationV04
+	(*ForeignExchangeTradeInstructionAmendmentV03)(nil),         // 15: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV03
+	(*ForeignExchangeTradeInstructionAmendmentV04)(nil),         // 16: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV04
+	(*ForeignExchangeTradeInstructionCancellationV03)(nil),      // 17: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV03
+	(*ForeignExchangeTradeInstructionCancellationV04)(nil),      // 18: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV04
+	(*ForeignExchangeTradeInstructionV03)(nil),                  // 19: iso20022.fxtr.ForeignExchangeTradeInstructionV03
+	(*ForeignExchangeTradeInstructionV04)(nil),                  // 20: iso20022.fxtr.ForeignExchangeTradeInstructionV04
+	(*ForeignExchangeTradeStatusAndDetailsNotificationV03)(nil), // 21: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV03
+	(*ForeignExchangeTradeStatusAndDetailsNotificationV04)(nil), // 22: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV04
+	(*ForeignExchangeTradeStatusNotificationV05)(nil),           // 23: iso20022.fxtr.ForeignExchangeTradeStatusNotificationV05
+	(*ForeignExchangeTradeStatusNotificationV06)(nil),           // 24: iso20022.fxtr.ForeignExchangeTradeStatusNotificationV06
+	(*ForeignExchangeTradeWithdrawalNotificationV03)(nil),       // 25: iso20022.fxtr.ForeignExchangeTradeWithdrawalNotificationV03
+	(*modelpb.TradeData10)(nil),                                 // 26: iso20022.model.TradeData10
+	(*modelpb.TradeData11)(nil),                                 // 27: iso20022.model.TradeData11
+	(*modelpb.Pagination)(nil),                                  // 28: iso20022.model.Pagination
+	(*modelpb.SupplementaryData1)(nil),                          // 29: iso20022.model.SupplementaryData1
+	(*modelpb.TradeData12)(nil),                                 // 30: iso20022.model.TradeData12
+	(*modelpb.TradeAgreement11)(nil),                            // 31: iso20022.model.TradeAgreement11
+	(*modelpb.TradePartyIdentification6)(nil),                   // 32: iso20022.model.TradePartyIdentification6
+	(*modelpb.AmountsAndValueDate1)(nil),                        // 33: iso20022.model.AmountsAndValueDate1
+	(*modelpb.AgreedRate1)(nil),                                 // 34: iso20022.model.AgreedRate1
+	(*modelpb.SettlementParties29)(nil),                         // 35: iso20022.model.SettlementParties29
+	(*modelpb.GeneralInformation4)(nil),                         // 36: iso20022.model.GeneralInformation4
+	(*modelpb.RegulatoryReporting4)(nil),                        // 37: iso20022.model.RegulatoryReporting4
+	(*modelpb.TradeAgreement15)(nil),                            // 38: iso20022.model.TradeAgreement15
+	(*modelpb.AgreedRate3)(nil),                                 // 39: iso20022.model.AgreedRate3
+	(*modelpb.NonDeliverableForwardConditions1)(nil),            // 40: iso20022.model.NonDeliverableForwardConditions1
+	(*modelpb.GeneralInformation5)(nil),                         // 41: iso20022.model.GeneralInformation5
+	(*modelpb.RegulatoryReporting6)(nil),                        // 42: iso20022.model.RegulatoryReporting6
+	(*modelpb.TradeAgreement10)(nil),                            // 43: iso20022.model.TradeAgreement10
+	(*modelpb.TradeAgreement14)(nil),                            // 44: iso20022.model.TradeAgreement14
+	(*modelpb.TradeData9)(nil),                                  // 45: iso20022.model.TradeData9
+	(*modelpb.TradeAgreement12)(nil),                            // 46: iso20022.model.TradeAgreement12
+	(*modelpb.SplitTradeDetails1)(nil),                          // 47: iso20022.model.SplitTradeDetails1
+	(*modelpb.TradeData14)(nil),                                 // 48: iso20022.model.TradeData14
+	(*modelpb.NonDeliverableForwardConditions2)(nil),            // 49: iso20022.model.NonDeliverableForwardConditions2
+	(*modelpb.SplitTradeDetails3)(nil),                          // 50: iso20022.model.SplitTradeDetails3
+	(*modelpb.TradeData7)(nil),                                  // 51: iso20022.model.TradeData7
+	(*modelpb.TradeData15)(nil),                                 // 52: iso20022.model.TradeData15
+	(*modelpb.WithdrawalReason1)(nil),                           // 53: iso20022.model.WithdrawalReason1
+}
+var file_iso20022_fxtr_proto_depIdxs = []int32{
+	23,  // 0: iso20022.fxtr.Document00800105.message:type_name -> iso20022.fxtr.ForeignExchangeTradeStatusNotificationV05
+	24,  // 1: iso20022.fxtr.Document00800106.message:type_name -> iso20022.fxtr.ForeignExchangeTradeStatusNotificationV06
+	25,  // 2: iso20022.fxtr.Document01300103.message:type_name -> iso20022.fxtr.ForeignExchangeTradeWithdrawalNotificationV03
+	19,  // 3: iso20022.fxtr.Document01400103.message:type_name -> iso20022.fxtr.ForeignExchangeTradeInstructionV03
+	20,  // 4: iso20022.fxtr.Document01400104.message:type_name -> iso20022.fxtr.ForeignExchangeTradeInstructionV04
+	15,  // 5: iso20022.fxtr.Document01500103.message:type_name -> iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV03
+	16,  // 6: iso20022.fxtr.Document01500104.message:type_name -> iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV04
+	17,  // 7: iso20022.fxtr.Document01600103.message:type_name -> iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV03
+	18,  // 8: iso20022.fxtr.Document01600104.message:type_name -> iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV04
+	21,  // 9: iso20022.fxtr.Document01700103.message:type_name -> iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV03
+	22,  // 10: iso20022.fxtr.Document01700104.message:type_name -> iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV04
+	13,  // 11: iso20022.fxtr.Document03000103.message:type_name -> iso20022.fxtr.ForeignExchangeTradeBulkStatusNotificationV03
+	14,  // 12: iso20022.fxtr.Document03000104.message:type_name -> iso20022.fxtr.ForeignExchangeTradeBulkStatusNotificationV04
+	26,  // 13: iso20022.fxtr.ForeignExchangeTradeBulkStatusNotificationV03.status_details:type_name -> iso20022.model.TradeData10
+	27,  // 14: iso20022.fxtr.ForeignExchangeTradeBulkStatusNotificationV03.trade_data:type_name -> iso20022.model.TradeData11
+	28,  // 15: iso20022.fxtr.ForeignExchangeTradeBulkStatusNotificationV03.message_pagination:type_name -> iso20022.model.Pagination
+	29,  // 16: iso20022.fxtr.ForeignExchangeTradeBulkStatusNotificationV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	30,  // 17: iso20022.fxtr.ForeignExchangeTradeBulkStatusNotificationV04.status_details:type_name -> iso20022.model.TradeData12
+	27,  // 18: iso20022.fxtr.ForeignExchangeTradeBulkStatusNotificationV04.trade_data:type_name -> iso20022.model.TradeData11
+	28,  // 19: iso20022.fxtr.ForeignExchangeTradeBulkStatusNotificationV04.message_pagination:type_name -> iso20022.model.Pagination
+	29,  // 20: iso20022.fxtr.ForeignExchangeTradeBulkStatusNotificationV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	31,  // 21: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV03.trade_information:type_name -> iso20022.model.TradeAgreement11
+	32,  // 22: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV03.trading_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	32,  // 23: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV03.counterparty_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	33,  // 24: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV03.trade_amounts:type_name -> iso20022.model.AmountsAndValueDate1
+	34,  // 25: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV03.agreed_rate:type_name -> iso20022.model.AgreedRate1
+	35,  // 26: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV03.trading_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	35,  // 27: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV03.counterparty_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	36,  // 28: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV03.optional_general_information:type_name -> iso20022.model.GeneralInformation4
+	37,  // 29: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV03.regulatory_reporting:type_name -> iso20022.model.RegulatoryReporting4
+	29,  // 30: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	38,  // 31: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV04.trade_information:type_name -> iso20022.model.TradeAgreement15
+	32,  // 32: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV04.trading_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	32,  // 33: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV04.counterparty_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	33,  // 34: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV04.trade_amounts:type_name -> iso20022.model.AmountsAndValueDate1
+	39,  // 35: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV04.agreed_rate:type_name -> iso20022.model.AgreedRate3
+	40,  // 36: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV04.non_deliverable_forward_conditions:type_name -> iso20022.model.NonDeliverableForwardConditions1
+	35,  // 37: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV04.trading_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	35,  // 38: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV04.counterparty_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	41,  // 39: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV04.optional_general_information:type_name -> iso20022.model.GeneralInformation5
+	42,  // 40: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV04.regulatory_reporting:type_name -> iso20022.model.RegulatoryReporting6
+	29,  // 41: iso20022.fxtr.ForeignExchangeTradeInstructionAmendmentV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	31,  // 42: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV03.trade_information:type_name -> iso20022.model.TradeAgreement11
+	32,  // 43: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV03.trading_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	32,  // 44: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV03.counterparty_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	34,  // 45: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV03.agreed_rate:type_name -> iso20022.model.AgreedRate1
+	35,  // 46: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV03.trading_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	35,  // 47: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV03.counterparty_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	36,  // 48: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV03.optional_general_information:type_name -> iso20022.model.GeneralInformation4
+	33,  // 49: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV03.trade_amounts:type_name -> iso20022.model.AmountsAndValueDate1
+	37,  // 50: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV03.regulatory_reporting:type_name -> iso20022.model.RegulatoryReporting4
+	29,  // 51: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	38,  // 52: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV04.trade_information:type_name -> iso20022.model.TradeAgreement15
+	32,  // 53: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV04.trading_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	32,  // 54: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV04.counterparty_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	39,  // 55: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV04.agreed_rate:type_name -> iso20022.model.AgreedRate3
+	40,  // 56: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV04.non_deliverable_forward_conditions:type_name -> iso20022.model.NonDeliverableForwardConditions1
+	35,  // 57: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV04.trading_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	35,  // 58: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV04.counterparty_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	41,  // 59: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV04.optional_general_information:type_name -> iso20022.model.GeneralInformation5
+	33,  // 60: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV04.trade_amounts:type_name -> iso20022.model.AmountsAndValueDate1
+	42,  // 61: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV04.regulatory_reporting:type_name -> iso20022.model.RegulatoryReporting6
+	29,  // 62: iso20022.fxtr.ForeignExchangeTradeInstructionCancellationV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	43,  // 63: iso20022.fxtr.ForeignExchangeTradeInstructionV03.trade_information:type_name -> iso20022.model.TradeAgreement10
+	32,  // 64: iso20022.fxtr.ForeignExchangeTradeInstructionV03.trading_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	32,  // 65: iso20022.fxtr.ForeignExchangeTradeInstructionV03.counterparty_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	33,  // 66: iso20022.fxtr.ForeignExchangeTradeInstructionV03.trade_amounts:type_name -> iso20022.model.AmountsAndValueDate1
+	34,  // 67: iso20022.fxtr.ForeignExchangeTradeInstructionV03.agreed_rate:type_name -> iso20022.model.AgreedRate1
+	35,  // 68: iso20022.fxtr.ForeignExchangeTradeInstructionV03.trading_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	35,  // 69: iso20022.fxtr.ForeignExchangeTradeInstructionV03.counterparty_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	36,  // 70: iso20022.fxtr.ForeignExchangeTradeInstructionV03.optional_general_information:type_name -> iso20022.model.GeneralInformation4
+	37,  // 71: iso20022.fxtr.ForeignExchangeTradeInstructionV03.regulatory_reporting:type_name -> iso20022.model.RegulatoryReporting4
+	29,  // 72: iso20022.fxtr.ForeignExchangeTradeInstructionV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	44,  // 73: iso20022.fxtr.ForeignExchangeTradeInstructionV04.trade_information:type_name -> iso20022.model.TradeAgreement14
+	32,  // 74: iso20022.fxtr.ForeignExchangeTradeInstructionV04.trading_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	32,  // 75: iso20022.fxtr.ForeignExchangeTradeInstructionV04.counterparty_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	33,  // 76: iso20022.fxtr.ForeignExchangeTradeInstructionV04.trade_amounts:type_name -> iso20022.model.AmountsAndValueDate1
+	39,  // 77: iso20022.fxtr.ForeignExchangeTradeInstructionV04.agreed_rate:type_name -> iso20022.model.AgreedRate3
+	40,  // 78: iso20022.fxtr.ForeignExchangeTradeInstructionV04.non_deliverable_forward_conditions:type_name -> iso20022.model.NonDeliverableForwardConditions1
+	35,  // 79: iso20022.fxtr.ForeignExchangeTradeInstructionV04.trading_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	35,  // 80: iso20022.fxtr.ForeignExchangeTradeInstructionV04.counterparty_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	41,  // 81: iso20022.fxtr.ForeignExchangeTradeInstructionV04.optional_general_information:type_name -> iso20022.model.GeneralInformation5
+	42,  // 82: iso20022.fxtr.ForeignExchangeTradeInstructionV04.regulatory_reporting:type_name -> iso20022.model.RegulatoryReporting6
+	29,  // 83: iso20022.fxtr.ForeignExchangeTradeInstructionV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	45,  // 84: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV03.status_details:type_name -> iso20022.model.TradeData9
+	46,  // 85: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV03.trade_information:type_name -> iso20022.model.TradeAgreement12
+	32,  // 86: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV03.trading_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	32,  // 87: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV03.counterparty_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	33,  // 88: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV03.trade_amounts:type_name -> iso20022.model.AmountsAndValueDate1
+	34,  // 89: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV03.agreed_rate:type_name -> iso20022.model.AgreedRate1
+	35,  // 90: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV03.trading_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	35,  // 91: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV03.counterparty_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	36,  // 92: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV03.general_information:type_name -> iso20022.model.GeneralInformation4
+	47,  // 93: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV03.split_trade_information:type_name -> iso20022.model.SplitTradeDetails1
+	37,  // 94: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV03.regulatory_reporting:type_name -> iso20022.model.RegulatoryReporting4
+	29,  // 95: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	48,  // 96: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV04.status_details:type_name -> iso20022.model.TradeData14
+	46,  // 97: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV04.trade_information:type_name -> iso20022.model.TradeAgreement12
+	32,  // 98: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV04.trading_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	32,  // 99: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV04.counterparty_side_identification:type_name -> iso20022.model.TradePartyIdentification6
+	33,  // 100: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV04.trade_amounts:type_name -> iso20022.model.AmountsAndValueDate1
+	39,  // 101: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV04.agreed_rate:type_name -> iso20022.model.AgreedRate3
+	49,  // 102: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV04.non_deliverable_forward_conditions:type_name -> iso20022.model.NonDeliverableForwardConditions2
+	35,  // 103: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV04.trading_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	35,  // 104: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV04.counterparty_side_settlement_instructions:type_name -> iso20022.model.SettlementParties29
+	41,  // 105: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV04.general_information:type_name -> iso20022.model.GeneralInformation5
+	50,  // 106: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV04.split_trade_information:type_name -> iso20022.model.SplitTradeDetails3
+	42,  // 107: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV04.regulatory_reporting:type_name -> iso20022.model.RegulatoryReporting6
+	29,  // 108: iso20022.fxtr.ForeignExchangeTradeStatusAndDetailsNotificationV04.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	51,  // 109: iso20022.fxtr.ForeignExchangeTradeStatusNotificationV05.trade_data:type_name -> iso20022.model.TradeData7
+	37,  // 110: iso20022.fxtr.ForeignExchangeTradeStatusNotificationV05.regulatory_reporting:type_name -> iso20022.model.RegulatoryReporting4
+	29,  // 111: iso20022.fxtr.ForeignExchangeTradeStatusNotificationV05.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	52,  // 112: iso20022.fxtr.ForeignExchangeTradeStatusNotificationV06.trade_data:type_name -> iso20022.model.TradeData15
+	42,  // 113: iso20022.fxtr.ForeignExchangeTradeStatusNotificationV06.regulatory_reporting:type_name -> iso20022.model.RegulatoryReporting6
+	29,  // 114: iso20022.fxtr.ForeignExchangeTradeStatusNotificationV06.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	53,  // 115: iso20022.fxtr.ForeignExchangeTradeWithdrawalNotificationV03.withdrawal_reason:type_name -> iso20022.model.WithdrawalReason1
+	29,  // 116: iso20022.fxtr.ForeignExchangeTradeWithdrawalNotificationV03.supplementary_data:type_name -> iso20022.model.SupplementaryData1
+	117, // [117:117] is the sub-list for method output_type
+	117, // [117:117] is the sub-list for method input_type
+	117, // [117:117] is the sub-list for extension type_name
+	117, // [117:117] is the sub-list for extension extendee
+	0,   // [0:117] is the sub-list for field type_name
+}
+
+func init() { file_iso20022_fxtr_proto_init() }
+func file_iso20022_fxtr_proto_init() {
+	if File_iso20022_fxtr_proto != nil {
+		return
+	}
+	file_iso20022_fxtr_proto_msgTypes[25].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_iso20022_fxtr_proto_rawDesc), len(file_iso20022_fxtr_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_iso20022_fxtr_proto_goTypes,
+		DependencyIndexes: file_iso20022_fxtr_proto_depIdxs,
+		MessageInfos:      file_iso20022_fxtr_proto_msgTypes,
+	}.Build()
+	File_iso20022_fxtr_proto = out.File
+	file_iso20022_fxtr_proto_goTypes = nil
+	file_iso20022_fxtr_proto_depIdxs = nil
+}
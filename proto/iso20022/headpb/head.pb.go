@@ -0,0 +1,1438 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: iso20022/head.proto
+
+package headpb
+
+import (
+	modelpb "github.com/yudaprama/iso20022/proto/iso20022/modelpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+// AppHdr00100101 is the root element of a head.001.001.01 Business Application Header.
+// Unlike Document00100101, the header is not wrapped in a Document element.
+type AppHdr00100101 struct {
+	state                       protoimpl.MessageState              `protogen:"open.v1"`
+	CharacterSet                *string                             `protobuf:"bytes,1,opt,name=character_set,json=characterSet,proto3,oneof" json:"character_set,omitempty"`
+	From                        *modelpb.Party9Choice               `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                          *modelpb.Party9Choice               `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	BusinessMessageIdentifier   *string                             `protobuf:"bytes,4,opt,name=business_message_identifier,json=businessMessageIdentifier,proto3,oneof" json:"business_message_identifier,omitempty"`
+	MessageDefinitionIdentifier *string                             `protobuf:"bytes,5,opt,name=message_definition_identifier,json=messageDefinitionIdentifier,proto3,oneof" json:"message_definition_identifier,omitempty"`
+	BusinessService             *string                             `protobuf:"bytes,6,opt,name=business_service,json=businessService,proto3,oneof" json:"business_service,omitempty"`
+	CreationDate                *string                             `protobuf:"bytes,7,opt,name=creation_date,json=creationDate,proto3,oneof" json:"creation_date,omitempty"`
+	CopyDuplicate               *modelpb.CopyDuplicate1Code         `protobuf:"varint,8,opt,name=copy_duplicate,json=copyDuplicate,proto3,enum=iso20022.model.CopyDuplicate1Code,oneof" json:"copy_duplicate,omitempty"`
+	PossibleDuplicate           *string                             `protobuf:"bytes,9,opt,name=possible_duplicate,json=possibleDuplicate,proto3,oneof" json:"possible_duplicate,omitempty"`
+	Priority                    *string                             `protobuf:"bytes,10,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	Signature                   *modelpb.SignatureEnvelope          `protobuf:"bytes,11,opt,name=signature,proto3" json:"signature,omitempty"`
+	Related                     *modelpb.BusinessApplicationHeader1 `protobuf:"bytes,12,opt,name=related,proto3" json:"related,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *AppHdr00100101) Reset() {
+	*x = AppHdr00100101{}
+	mi := &file_iso20022_head_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppHdr00100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppHdr00100101) ProtoMessage() {}
+
+func (x *AppHdr00100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_head_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppHdr00100101.ProtoReflect.Descriptor instead.
+func (*AppHdr00100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_head_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AppHdr00100101) GetCharacterSet() string {
+	if x != nil && x.CharacterSet != nil {
+		return *x.CharacterSet
+	}
+	return ""
+}
+
+func (x *AppHdr00100101) GetFrom() *modelpb.Party9Choice {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AppHdr00100101) GetTo() *modelpb.Party9Choice {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *AppHdr00100101) GetBusinessMessageIdentifier() string {
+	if x != nil && x.BusinessMessageIdentifier != nil {
+		return *x.BusinessMessageIdentifier
+	}
+	return ""
+}
+
+func (x *AppHdr00100101) GetMessageDefinitionIdentifier() string {
+	if x != nil && x.MessageDefinitionIdentifier != nil {
+		return *x.MessageDefinitionIdentifier
+	}
+	return ""
+}
+
+func (x *AppHdr00100101) GetBusinessService() string {
+	if x != nil && x.BusinessService != nil {
+		return *x.BusinessService
+	}
+	return ""
+}
+
+func (x *AppHdr00100101) GetCreationDate() string {
+	if x != nil && x.CreationDate != nil {
+		return *x.CreationDate
+	}
+	return ""
+}
+
+func (x *AppHdr00100101) GetCopyDuplicate() modelpb.CopyDuplicate1Code {
+	if x != nil && x.CopyDuplicate != nil {
+		return *x.CopyDuplicate
+	}
+	return modelpb.CopyDuplicate1Code(0)
+}
+
+func (x *AppHdr00100101) GetPossibleDuplicate() string {
+	if x != nil && x.PossibleDuplicate != nil {
+		return *x.PossibleDuplicate
+	}
+	return ""
+}
+
+func (x *AppHdr00100101) GetPriority() string {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return ""
+}
+
+func (x *AppHdr00100101) GetSignature() *modelpb.SignatureEnvelope {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *AppHdr00100101) GetRelated() *modelpb.BusinessApplicationHeader1 {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+// AppHdr00100102 is the root element of a head.001.001.02 Business Application Header.
+type AppHdr00100102 struct {
+	state                       protoimpl.MessageState                `protogen:"open.v1"`
+	CharacterSet                *string                               `protobuf:"bytes,1,opt,name=character_set,json=characterSet,proto3,oneof" json:"character_set,omitempty"`
+	From                        *modelpb.Party44Choice                `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                          *modelpb.Party44Choice                `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	BusinessMessageIdentifier   *string                               `protobuf:"bytes,4,opt,name=business_message_identifier,json=businessMessageIdentifier,proto3,oneof" json:"business_message_identifier,omitempty"`
+	MessageDefinitionIdentifier *string                               `protobuf:"bytes,5,opt,name=message_definition_identifier,json=messageDefinitionIdentifier,proto3,oneof" json:"message_definition_identifier,omitempty"`
+	BusinessService             *string                               `protobuf:"bytes,6,opt,name=business_service,json=businessService,proto3,oneof" json:"business_service,omitempty"`
+	MarketPractice              *modelpb.ImplementationSpecification1 `protobuf:"bytes,7,opt,name=market_practice,json=marketPractice,proto3" json:"market_practice,omitempty"`
+	CreationDate                *string                               `protobuf:"bytes,8,opt,name=creation_date,json=creationDate,proto3,oneof" json:"creation_date,omitempty"`
+	BusinessProcessingDate      *string                               `protobuf:"bytes,9,opt,name=business_processing_date,json=businessProcessingDate,proto3,oneof" json:"business_processing_date,omitempty"`
+	CopyDuplicate               *modelpb.CopyDuplicate1Code           `protobuf:"varint,10,opt,name=copy_duplicate,json=copyDuplicate,proto3,enum=iso20022.model.CopyDuplicate1Code,oneof" json:"copy_duplicate,omitempty"`
+	PossibleDuplicate           *string                               `protobuf:"bytes,11,opt,name=possible_duplicate,json=possibleDuplicate,proto3,oneof" json:"possible_duplicate,omitempty"`
+	Priority                    *string                               `protobuf:"bytes,12,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	Signature                   *modelpb.SignatureEnvelope            `protobuf:"bytes,13,opt,name=signature,proto3" json:"signature,omitempty"`
+	Related                     []*modelpb.BusinessApplicationHeader5 `protobuf:"bytes,14,rep,name=related,proto3" json:"related,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *AppHdr00100102) Reset() {
+	*x = AppHdr00100102{}
+	mi := &file_iso20022_head_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppHdr00100102) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppHdr00100102) ProtoMessage() {}
+
+func (x *AppHdr00100102) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_head_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppHdr00100102.ProtoReflect.Descriptor instead.
+func (*AppHdr00100102) Descriptor() ([]byte, []int) {
+	return file_iso20022_head_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AppHdr00100102) GetCharacterSet() string {
+	if x != nil && x.CharacterSet != nil {
+		return *x.CharacterSet
+	}
+	return ""
+}
+
+func (x *AppHdr00100102) GetFrom() *modelpb.Party44Choice {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AppHdr00100102) GetTo() *modelpb.Party44Choice {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *AppHdr00100102) GetBusinessMessageIdentifier() string {
+	if x != nil && x.BusinessMessageIdentifier != nil {
+		return *x.BusinessMessageIdentifier
+	}
+	return ""
+}
+
+func (x *AppHdr00100102) GetMessageDefinitionIdentifier() string {
+	if x != nil && x.MessageDefinitionIdentifier != nil {
+		return *x.MessageDefinitionIdentifier
+	}
+	return ""
+}
+
+func (x *AppHdr00100102) GetBusinessService() string {
+	if x != nil && x.BusinessService != nil {
+		return *x.BusinessService
+	}
+	return ""
+}
+
+func (x *AppHdr00100102) GetMarketPractice() *modelpb.ImplementationSpecification1 {
+	if x != nil {
+		return x.MarketPractice
+	}
+	return nil
+}
+
+func (x *AppHdr00100102) GetCreationDate() string {
+	if x != nil && x.CreationDate != nil {
+		return *x.CreationDate
+	}
+	return ""
+}
+
+func (x *AppHdr00100102) GetBusinessProcessingDate() string {
+	if x != nil && x.BusinessProcessingDate != nil {
+		return *x.BusinessProcessingDate
+	}
+	return ""
+}
+
+func (x *AppHdr00100102) GetCopyDuplicate() modelpb.CopyDuplicate1Code {
+	if x != nil && x.CopyDuplicate != nil {
+		return *x.CopyDuplicate
+	}
+	return modelpb.CopyDuplicate1Code(0)
+}
+
+func (x *AppHdr00100102) GetPossibleDuplicate() string {
+	if x != nil && x.PossibleDuplicate != nil {
+		return *x.PossibleDuplicate
+	}
+	return ""
+}
+
+func (x *AppHdr00100102) GetPriority() string {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return ""
+}
+
+func (x *AppHdr00100102) GetSignature() *modelpb.SignatureEnvelope {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *AppHdr00100102) GetRelated() []*modelpb.BusinessApplicationHeader5 {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+// AppHdr00100103 is the root element of a head.001.001.03 Business Application Header.
+type AppHdr00100103 struct {
+	state                       protoimpl.MessageState                `protogen:"open.v1"`
+	CharacterSet                *string                               `protobuf:"bytes,1,opt,name=character_set,json=characterSet,proto3,oneof" json:"character_set,omitempty"`
+	From                        *modelpb.Party51Choice                `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                          *modelpb.Party51Choice                `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	BusinessMessageIdentifier   *string                               `protobuf:"bytes,4,opt,name=business_message_identifier,json=businessMessageIdentifier,proto3,oneof" json:"business_message_identifier,omitempty"`
+	MessageDefinitionIdentifier *string                               `protobuf:"bytes,5,opt,name=message_definition_identifier,json=messageDefinitionIdentifier,proto3,oneof" json:"message_definition_identifier,omitempty"`
+	BusinessService             *string                               `protobuf:"bytes,6,opt,name=business_service,json=businessService,proto3,oneof" json:"business_service,omitempty"`
+	MarketPractice              *modelpb.ImplementationSpecification1 `protobuf:"bytes,7,opt,name=market_practice,json=marketPractice,proto3" json:"market_practice,omitempty"`
+	CreationDate                *string                               `protobuf:"bytes,8,opt,name=creation_date,json=creationDate,proto3,oneof" json:"creation_date,omitempty"`
+	BusinessProcessingDate      *string                               `protobuf:"bytes,9,opt,name=business_processing_date,json=businessProcessingDate,proto3,oneof" json:"business_processing_date,omitempty"`
+	CopyDuplicate               *modelpb.CopyDuplicate1Code           `protobuf:"varint,10,opt,name=copy_duplicate,json=copyDuplicate,proto3,enum=iso20022.model.CopyDuplicate1Code,oneof" json:"copy_duplicate,omitempty"`
+	PossibleDuplicate           *string                               `protobuf:"bytes,11,opt,name=possible_duplicate,json=possibleDuplicate,proto3,oneof" json:"possible_duplicate,omitempty"`
+	Priority                    *string                               `protobuf:"bytes,12,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	Signature                   *modelpb.SignatureEnvelope            `protobuf:"bytes,13,opt,name=signature,proto3" json:"signature,omitempty"`
+	Related                     []*modelpb.BusinessApplicationHeader8 `protobuf:"bytes,14,rep,name=related,proto3" json:"related,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *AppHdr00100103) Reset() {
+	*x = AppHdr00100103{}
+	mi := &file_iso20022_head_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppHdr00100103) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppHdr00100103) ProtoMessage() {}
+
+func (x *AppHdr00100103) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_head_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppHdr00100103.ProtoReflect.Descriptor instead.
+func (*AppHdr00100103) Descriptor() ([]byte, []int) {
+	return file_iso20022_head_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AppHdr00100103) GetCharacterSet() string {
+	if x != nil && x.CharacterSet != nil {
+		return *x.CharacterSet
+	}
+	return ""
+}
+
+func (x *AppHdr00100103) GetFrom() *modelpb.Party51Choice {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AppHdr00100103) GetTo() *modelpb.Party51Choice {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *AppHdr00100103) GetBusinessMessageIdentifier() string {
+	if x != nil && x.BusinessMessageIdentifier != nil {
+		return *x.BusinessMessageIdentifier
+	}
+	return ""
+}
+
+func (x *AppHdr00100103) GetMessageDefinitionIdentifier() string {
+	if x != nil && x.MessageDefinitionIdentifier != nil {
+		return *x.MessageDefinitionIdentifier
+	}
+	return ""
+}
+
+func (x *AppHdr00100103) GetBusinessService() string {
+	if x != nil && x.BusinessService != nil {
+		return *x.BusinessService
+	}
+	return ""
+}
+
+func (x *AppHdr00100103) GetMarketPractice() *modelpb.ImplementationSpecification1 {
+	if x != nil {
+		return x.MarketPractice
+	}
+	return nil
+}
+
+func (x *AppHdr00100103) GetCreationDate() string {
+	if x != nil && x.CreationDate != nil {
+		return *x.CreationDate
+	}
+	return ""
+}
+
+func (x *AppHdr00100103) GetBusinessProcessingDate() string {
+	if x != nil && x.BusinessProcessingDate != nil {
+		return *x.BusinessProcessingDate
+	}
+	return ""
+}
+
+func (x *AppHdr00100103) GetCopyDuplicate() modelpb.CopyDuplicate1Code {
+	if x != nil && x.CopyDuplicate != nil {
+		return *x.CopyDuplicate
+	}
+	return modelpb.CopyDuplicate1Code(0)
+}
+
+func (x *AppHdr00100103) GetPossibleDuplicate() string {
+	if x != nil && x.PossibleDuplicate != nil {
+		return *x.PossibleDuplicate
+	}
+	return ""
+}
+
+func (x *AppHdr00100103) GetPriority() string {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return ""
+}
+
+func (x *AppHdr00100103) GetSignature() *modelpb.SignatureEnvelope {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *AppHdr00100103) GetRelated() []*modelpb.BusinessApplicationHeader8 {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+// The Business Layer deals with Business Messages. The behaviour of the Business Messages is fully described by the Business Transaction and the structure of the Business Messages is fully described by the Message Definitions and related Message Rules, Rules and Market Practices. All of which are registered in the ISO 20022 Repository.
+// A single new Business Message (with its accompagnying business application header) is created - by the sending MessagingEndpoint - for each business event; that is each interaction in a Business Transaction. A Business Message adheres to the following principles:
+// " A Business Message (and its business application header) must not contain information about the Message Transport System or the mechanics or mechanism of message sending, transportation, or receipt.
+// " A Business Message must be comprehensible outside of the context of the Transport Message. That is the Business Message must not require knowledge of the Transport Message to be understood.
+// " A Business Message may contain headers, footers, and envelopes that are meaningful for the business. When present, they are treated as any other message content, which means that they are considered part of the Message Definition of the Business Message and as such will be part of the ISO 20022 Repository.
+// " A Business Message refers to Business Actors by their Name. Each instance of a Business Actor has one Name. The Business Actor must not be referred to in the Transport Layer.
+// Specific usage of this BusinessMessageHeader may be defined by the relevant SEG.
+type BusinessApplicationHeaderV01 struct {
+	state                       protoimpl.MessageState              `protogen:"open.v1"`
+	CharacterSet                *string                             `protobuf:"bytes,1,opt,name=character_set,json=characterSet,proto3,oneof" json:"character_set,omitempty"`
+	From                        *modelpb.Party9Choice               `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                          *modelpb.Party9Choice               `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	BusinessMessageIdentifier   *string                             `protobuf:"bytes,4,opt,name=business_message_identifier,json=businessMessageIdentifier,proto3,oneof" json:"business_message_identifier,omitempty"`
+	MessageDefinitionIdentifier *string                             `protobuf:"bytes,5,opt,name=message_definition_identifier,json=messageDefinitionIdentifier,proto3,oneof" json:"message_definition_identifier,omitempty"`
+	BusinessService             *string                             `protobuf:"bytes,6,opt,name=business_service,json=businessService,proto3,oneof" json:"business_service,omitempty"`
+	CreationDate                *string                             `protobuf:"bytes,7,opt,name=creation_date,json=creationDate,proto3,oneof" json:"creation_date,omitempty"`
+	CopyDuplicate               *modelpb.CopyDuplicate1Code         `protobuf:"varint,8,opt,name=copy_duplicate,json=copyDuplicate,proto3,enum=iso20022.model.CopyDuplicate1Code,oneof" json:"copy_duplicate,omitempty"`
+	PossibleDuplicate           *string                             `protobuf:"bytes,9,opt,name=possible_duplicate,json=possibleDuplicate,proto3,oneof" json:"possible_duplicate,omitempty"`
+	Priority                    *string                             `protobuf:"bytes,10,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	Signature                   *modelpb.SignatureEnvelope          `protobuf:"bytes,11,opt,name=signature,proto3" json:"signature,omitempty"`
+	Related                     *modelpb.BusinessApplicationHeader1 `protobuf:"bytes,12,opt,name=related,proto3" json:"related,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *BusinessApplicationHeaderV01) Reset() {
+	*x = BusinessApplicationHeaderV01{}
+	mi := &file_iso20022_head_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BusinessApplicationHeaderV01) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusinessApplicationHeaderV01) ProtoMessage() {}
+
+func (x *BusinessApplicationHeaderV01) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_head_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusinessApplicationHeaderV01.ProtoReflect.Descriptor instead.
+func (*BusinessApplicationHeaderV01) Descriptor() ([]byte, []int) {
+	return file_iso20022_head_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BusinessApplicationHeaderV01) GetCharacterSet() string {
+	if x != nil && x.CharacterSet != nil {
+		return *x.CharacterSet
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV01) GetFrom() *modelpb.Party9Choice {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *BusinessApplicationHeaderV01) GetTo() *modelpb.Party9Choice {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *BusinessApplicationHeaderV01) GetBusinessMessageIdentifier() string {
+	if x != nil && x.BusinessMessageIdentifier != nil {
+		return *x.BusinessMessageIdentifier
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV01) GetMessageDefinitionIdentifier() string {
+	if x != nil && x.MessageDefinitionIdentifier != nil {
+		return *x.MessageDefinitionIdentifier
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV01) GetBusinessService() string {
+	if x != nil && x.BusinessService != nil {
+		return *x.BusinessService
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV01) GetCreationDate() string {
+	if x != nil && x.CreationDate != nil {
+		return *x.CreationDate
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV01) GetCopyDuplicate() modelpb.CopyDuplicate1Code {
+	if x != nil && x.CopyDuplicate != nil {
+		return *x.CopyDuplicate
+	}
+	return modelpb.CopyDuplicate1Code(0)
+}
+
+func (x *BusinessApplicationHeaderV01) GetPossibleDuplicate() string {
+	if x != nil && x.PossibleDuplicate != nil {
+		return *x.PossibleDuplicate
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV01) GetPriority() string {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV01) GetSignature() *modelpb.SignatureEnvelope {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *BusinessApplicationHeaderV01) GetRelated() *modelpb.BusinessApplicationHeader1 {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+// The Business Layer deals with Business Messages. The behaviour of the Business Messages is fully described by the Business Transaction and the structure of the Business Messages is fully described by the Message Definitions and related Message Rules, Rules and Market Practices. All of which are registered in the ISO 20022 Repository.
+// A single new Business Message (with its accompagnying business application header) is created - by the sending MessagingEndpoint - for each business event; that is each interaction in a Business Transaction. A Business Message adheres to the following principles:
+// " A Business Message (and its business application header) must not contain information about the Message Transport System or the mechanics or mechanism of message sending, transportation, or receipt.
+// " A Business Message must be comprehensible outside of the context of the Transport Message. That is the Business Message must not require knowledge of the Transport Message to be understood.
+// " A Business Message may contain headers, footers, and envelopes that are meaningful for the business. When present, they are treated as any other message content, which means that they are considered part of the Message Definition of the Business Message and as such will be part of the ISO 20022 Repository.
+// " A Business Message refers to Business Actors by their Name. Each instance of a Business Actor has one Name. The Business Actor must not be referred to in the Transport Layer.
+// Specific usage of this BusinessMessageHeader may be defined by the relevant SEG.
+type BusinessApplicationHeaderV02 struct {
+	state                       protoimpl.MessageState                `protogen:"open.v1"`
+	CharacterSet                *string                               `protobuf:"bytes,1,opt,name=character_set,json=characterSet,proto3,oneof" json:"character_set,omitempty"`
+	From                        *modelpb.Party44Choice                `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                          *modelpb.Party44Choice                `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	BusinessMessageIdentifier   *string                               `protobuf:"bytes,4,opt,name=business_message_identifier,json=businessMessageIdentifier,proto3,oneof" json:"business_message_identifier,omitempty"`
+	MessageDefinitionIdentifier *string                               `protobuf:"bytes,5,opt,name=message_definition_identifier,json=messageDefinitionIdentifier,proto3,oneof" json:"message_definition_identifier,omitempty"`
+	BusinessService             *string                               `protobuf:"bytes,6,opt,name=business_service,json=businessService,proto3,oneof" json:"business_service,omitempty"`
+	MarketPractice              *modelpb.ImplementationSpecification1 `protobuf:"bytes,7,opt,name=market_practice,json=marketPractice,proto3" json:"market_practice,omitempty"`
+	CreationDate                *string                               `protobuf:"bytes,8,opt,name=creation_date,json=creationDate,proto3,oneof" json:"creation_date,omitempty"`
+	BusinessProcessingDate      *string                               `protobuf:"bytes,9,opt,name=business_processing_date,json=businessProcessingDate,proto3,oneof" json:"business_processing_date,omitempty"`
+	CopyDuplicate               *modelpb.CopyDuplicate1Code           `protobuf:"varint,10,opt,name=copy_duplicate,json=copyDuplicate,proto3,enum=iso20022.model.CopyDuplicate1Code,oneof" json:"copy_duplicate,omitempty"`
+	PossibleDuplicate           *string                               `protobuf:"bytes,11,opt,name=possible_duplicate,json=possibleDuplicate,proto3,oneof" json:"possible_duplicate,omitempty"`
+	Priority                    *string                               `protobuf:"bytes,12,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	Signature                   *modelpb.SignatureEnvelope            `protobuf:"bytes,13,opt,name=signature,proto3" json:"signature,omitempty"`
+	Related                     []*modelpb.BusinessApplicationHeader5 `protobuf:"bytes,14,rep,name=related,proto3" json:"related,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *BusinessApplicationHeaderV02) Reset() {
+	*x = BusinessApplicationHeaderV02{}
+	mi := &file_iso20022_head_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BusinessApplicationHeaderV02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusinessApplicationHeaderV02) ProtoMessage() {}
+
+func (x *BusinessApplicationHeaderV02) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_head_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusinessApplicationHeaderV02.ProtoReflect.Descriptor instead.
+func (*BusinessApplicationHeaderV02) Descriptor() ([]byte, []int) {
+	return file_iso20022_head_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BusinessApplicationHeaderV02) GetCharacterSet() string {
+	if x != nil && x.CharacterSet != nil {
+		return *x.CharacterSet
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV02) GetFrom() *modelpb.Party44Choice {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *BusinessApplicationHeaderV02) GetTo() *modelpb.Party44Choice {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *BusinessApplicationHeaderV02) GetBusinessMessageIdentifier() string {
+	if x != nil && x.BusinessMessageIdentifier != nil {
+		return *x.BusinessMessageIdentifier
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV02) GetMessageDefinitionIdentifier() string {
+	if x != nil && x.MessageDefinitionIdentifier != nil {
+		return *x.MessageDefinitionIdentifier
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV02) GetBusinessService() string {
+	if x != nil && x.BusinessService != nil {
+		return *x.BusinessService
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV02) GetMarketPractice() *modelpb.ImplementationSpecification1 {
+	if x != nil {
+		return x.MarketPractice
+	}
+	return nil
+}
+
+func (x *BusinessApplicationHeaderV02) GetCreationDate() string {
+	if x != nil && x.CreationDate != nil {
+		return *x.CreationDate
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV02) GetBusinessProcessingDate() string {
+	if x != nil && x.BusinessProcessingDate != nil {
+		return *x.BusinessProcessingDate
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV02) GetCopyDuplicate() modelpb.CopyDuplicate1Code {
+	if x != nil && x.CopyDuplicate != nil {
+		return *x.CopyDuplicate
+	}
+	return modelpb.CopyDuplicate1Code(0)
+}
+
+func (x *BusinessApplicationHeaderV02) GetPossibleDuplicate() string {
+	if x != nil && x.PossibleDuplicate != nil {
+		return *x.PossibleDuplicate
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV02) GetPriority() string {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV02) GetSignature() *modelpb.SignatureEnvelope {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *BusinessApplicationHeaderV02) GetRelated() []*modelpb.BusinessApplicationHeader5 {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+// The Business Layer deals with Business Messages. The behaviour of the Business Messages is fully described by the Business Transaction and the structure of the Business Messages is fully described by the Message Definitions and related Message Rules, Rules and Market Practices. All of which are registered in the ISO 20022 Repository.
+// A single new Business Message (with its accompagnying business application header) is created - by the sending MessagingEndpoint - for each business event; that is each interaction in a Business Transaction. A Business Message adheres to the following principles:
+// " A Business Message (and its business application header) must not contain information about the Message Transport System or the mechanics or mechanism of message sending, transportation, or receipt.
+// " A Business Message must be comprehensible outside of the context of the Transport Message. That is the Business Message must not require knowledge of the Transport Message to be understood.
+// " A Business Message may contain headers, footers, and envelopes that are meaningful for the business. When present, they are treated as any other message content, which means that they are considered part of the Message Definition of the Business Message and as such will be part of the ISO 20022 Repository.
+// " A Business Message refers to Business Actors by their Name. Each instance of a Business Actor has one Name. The Business Actor must not be referred to in the Transport Layer.
+// Specific usage of this BusinessMessageHeader may be defined by the relevant SEG.
+type BusinessApplicationHeaderV03 struct {
+	state                       protoimpl.MessageState                `protogen:"open.v1"`
+	CharacterSet                *string                               `protobuf:"bytes,1,opt,name=character_set,json=characterSet,proto3,oneof" json:"character_set,omitempty"`
+	From                        *modelpb.Party51Choice                `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                          *modelpb.Party51Choice                `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	BusinessMessageIdentifier   *string                               `protobuf:"bytes,4,opt,name=business_message_identifier,json=businessMessageIdentifier,proto3,oneof" json:"business_message_identifier,omitempty"`
+	MessageDefinitionIdentifier *string                               `protobuf:"bytes,5,opt,name=message_definition_identifier,json=messageDefinitionIdentifier,proto3,oneof" json:"message_definition_identifier,omitempty"`
+	BusinessService             *string                               `protobuf:"bytes,6,opt,name=business_service,json=businessService,proto3,oneof" json:"business_service,omitempty"`
+	MarketPractice              *modelpb.ImplementationSpecification1 `protobuf:"bytes,7,opt,name=market_practice,json=marketPractice,proto3" json:"market_practice,omitempty"`
+	CreationDate                *string                               `protobuf:"bytes,8,opt,name=creation_date,json=creationDate,proto3,oneof" json:"creation_date,omitempty"`
+	BusinessProcessingDate      *string                               `protobuf:"bytes,9,opt,name=business_processing_date,json=businessProcessingDate,proto3,oneof" json:"business_processing_date,omitempty"`
+	CopyDuplicate               *modelpb.CopyDuplicate1Code           `protobuf:"varint,10,opt,name=copy_duplicate,json=copyDuplicate,proto3,enum=iso20022.model.CopyDuplicate1Code,oneof" json:"copy_duplicate,omitempty"`
+	PossibleDuplicate           *string                               `protobuf:"bytes,11,opt,name=possible_duplicate,json=possibleDuplicate,proto3,oneof" json:"possible_duplicate,omitempty"`
+	Priority                    *string                               `protobuf:"bytes,12,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	Signature                   *modelpb.SignatureEnvelope            `protobuf:"bytes,13,opt,name=signature,proto3" json:"signature,omitempty"`
+	Related                     []*modelpb.BusinessApplicationHeader8 `protobuf:"bytes,14,rep,name=related,proto3" json:"related,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *BusinessApplicationHeaderV03) Reset() {
+	*x = BusinessApplicationHeaderV03{}
+	mi := &file_iso20022_head_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BusinessApplicationHeaderV03) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusinessApplicationHeaderV03) ProtoMessage() {}
+
+func (x *BusinessApplicationHeaderV03) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_head_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusinessApplicationHeaderV03.ProtoReflect.Descriptor instead.
+func (*BusinessApplicationHeaderV03) Descriptor() ([]byte, []int) {
+	return file_iso20022_head_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BusinessApplicationHeaderV03) GetCharacterSet() string {
+	if x != nil && x.CharacterSet != nil {
+		return *x.CharacterSet
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV03) GetFrom() *modelpb.Party51Choice {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *BusinessApplicationHeaderV03) GetTo() *modelpb.Party51Choice {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *BusinessApplicationHeaderV03) GetBusinessMessageIdentifier() string {
+	if x != nil && x.BusinessMessageIdentifier != nil {
+		return *x.BusinessMessageIdentifier
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV03) GetMessageDefinitionIdentifier() string {
+	if x != nil && x.MessageDefinitionIdentifier != nil {
+		return *x.MessageDefinitionIdentifier
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV03) GetBusinessService() string {
+	if x != nil && x.BusinessService != nil {
+		return *x.BusinessService
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV03) GetMarketPractice() *modelpb.ImplementationSpecification1 {
+	if x != nil {
+		return x.MarketPractice
+	}
+	return nil
+}
+
+func (x *BusinessApplicationHeaderV03) GetCreationDate() string {
+	if x != nil && x.CreationDate != nil {
+		return *x.CreationDate
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV03) GetBusinessProcessingDate() string {
+	if x != nil && x.BusinessProcessingDate != nil {
+		return *x.BusinessProcessingDate
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV03) GetCopyDuplicate() modelpb.CopyDuplicate1Code {
+	if x != nil && x.CopyDuplicate != nil {
+		return *x.CopyDuplicate
+	}
+	return modelpb.CopyDuplicate1Code(0)
+}
+
+func (x *BusinessApplicationHeaderV03) GetPossibleDuplicate() string {
+	if x != nil && x.PossibleDuplicate != nil {
+		return *x.PossibleDuplicate
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV03) GetPriority() string {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return ""
+}
+
+func (x *BusinessApplicationHeaderV03) GetSignature() *modelpb.SignatureEnvelope {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *BusinessApplicationHeaderV03) GetRelated() []*modelpb.BusinessApplicationHeader8 {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+// Document00100101 wraps the header in a Document element, which is not the
+// layout defined by the head.001.001.01 schema.
+//
+// Deprecated: Use AppHdr00100101, whose root element is AppHdr.
+type Document00100101 struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Message       *BusinessApplicationHeaderV01 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document00100101) Reset() {
+	*x = Document00100101{}
+	mi := &file_iso20022_head_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document00100101) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document00100101) ProtoMessage() {}
+
+func (x *Document00100101) ProtoReflect() protoreflect.Message {
+	mi := &file_iso20022_head_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document00100101.ProtoReflect.Descriptor instead.
+func (*Document00100101) Descriptor() ([]byte, []int) {
+	return file_iso20022_head_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Document00100101) GetMessage() *BusinessApplicationHeaderV01 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+var File_iso20022_head_proto protoreflect.FileDescriptor
+
+var file_iso20022_head_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x68, 0x65, 0x61, 0x64, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e,
+	0x68, 0x65, 0x61, 0x64, 0x1a, 0x14, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe0, 0x06, 0x0a, 0x0e, 0x41,
+	0x70, 0x70, 0x48, 0x64, 0x72, 0x30, 0x30, 0x31, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x28, 0x0a,
+	0x0d, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x39, 0x43, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2c, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x39, 0x43, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x43, 0x0a, 0x1b, 0x62, 0x75, 0x73, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x19,
+	0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x1d,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x1b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x0f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x4e, 0x0a, 0x0e, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x44, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x31, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x05, 0x52, 0x0d, 0x63,
+	0x6f, 0x70, 0x79, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x32, 0x0a, 0x12, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x11, 0x70,
+	0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x31, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x42, 0x1e, 0x0a,
+	0x1c, 0x5f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x20, 0x0a,
+	0x1e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x5f,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x6f,
+	0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x95, 0x08,
+	0x0a, 0x0e, 0x41, 0x70, 0x70, 0x48, 0x64, 0x72, 0x30, 0x30, 0x31, 0x30, 0x30, 0x31, 0x30, 0x32,
+	0x12, 0x28, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x53, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x34,
+	0x34, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2d, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79,
+	0x34, 0x34, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x43, 0x0a, 0x1b,
+	0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x19, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x47, 0x0a, 0x1d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x1b, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x62, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x0f, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x31, 0x52, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63,
+	0x65, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x18, 0x62,
+	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52,
+	0x16, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0e, 0x63, 0x6f,
+	0x70, 0x79, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x31, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x06, 0x52, 0x0d, 0x63, 0x6f, 0x70, 0x79, 0x44, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x12, 0x70, 0x6f,
+	0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x11, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62,
+	0x6c, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x08, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12,
+	0x3f, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x35, 0x52, 0x07, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x62, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x62,
+	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x64,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x95, 0x08, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x48, 0x64, 0x72,
+	0x30, 0x30, 0x31, 0x30, 0x30, 0x31, 0x30, 0x33, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x53, 0x65, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x31, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x35, 0x31, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2d, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x35, 0x31, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x43, 0x0a, 0x1b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x19, 0x62, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x1d, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x1b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0f,
+	0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x55, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x04, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x18, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x16, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0e, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x70, 0x79,
+	0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x31, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x06,
+	0x52, 0x0d, 0x63, 0x6f, 0x70, 0x79, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x32, 0x0a, 0x12, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x64,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07,
+	0x52, 0x11, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32,
+	0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x75, 0x73, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x38, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74,
+	0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x62, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x5f,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x6f,
+	0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xee, 0x06,
+	0x0a, 0x1c, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x30, 0x31, 0x12, 0x28,
+	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x39, 0x43, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2c, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x39, 0x43, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x43, 0x0a, 0x1b, 0x62, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x19, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a,
+	0x1d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x1b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x0f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52,
+	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x4e, 0x0a, 0x0e, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x44, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x31, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x05, 0x52, 0x0d,
+	0x63, 0x6f, 0x70, 0x79, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x32, 0x0a, 0x12, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x11,
+	0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x31, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x42, 0x1e,
+	0x0a, 0x1c, 0x5f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x20,
+	0x0a, 0x1e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x6f, 0x70, 0x79,
+	0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70,
+	0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xa3,
+	0x08, 0x0a, 0x1c, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x30, 0x32, 0x12,
+	0x28, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x53, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x34, 0x34,
+	0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2d, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x34,
+	0x34, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x43, 0x0a, 0x1b, 0x62,
+	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x19, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x47, 0x0a, 0x1d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x1b, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x62, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x0f, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31,
+	0x52, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65,
+	0x12, 0x28, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x18, 0x62, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x16,
+	0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0e, 0x63, 0x6f, 0x70,
+	0x79, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x31, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x06, 0x52, 0x0d, 0x63, 0x6f, 0x70, 0x79, 0x44, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x12, 0x70, 0x6f, 0x73,
+	0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x11, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c,
+	0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x08, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3f,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x44, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x35, 0x52, 0x07, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x65, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x62, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x62, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x22, 0xa3, 0x08, 0x0a, 0x1c, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x56, 0x30, 0x33, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x53, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x31, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50,
+	0x61, 0x72, 0x74, 0x79, 0x35, 0x31, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x2d, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x79, 0x35, 0x31, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x02, 0x74,
+	0x6f, 0x12, 0x43, 0x0a, 0x1b, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x19, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x1d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x1b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x2e, 0x0a, 0x10, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0f, 0x62, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x55, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30,
+	0x30, 0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x52, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52,
+	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x3d, 0x0a, 0x18, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x05, 0x52, 0x16, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x4e, 0x0a, 0x0e, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x44, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x31, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x06, 0x52, 0x0d, 0x63,
+	0x6f, 0x70, 0x79, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x32, 0x0a, 0x12, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x11, 0x70,
+	0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30,
+	0x32, 0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x38, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x42, 0x1e, 0x0a,
+	0x1c, 0x5f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x20, 0x0a,
+	0x1e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x62, 0x75, 0x73, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x6f, 0x73, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x59, 0x0a, 0x10, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x30, 0x31, 0x30, 0x30, 0x31, 0x30, 0x31, 0x12, 0x45,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x69, 0x73, 0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2e, 0x68, 0x65, 0x61, 0x64, 0x2e,
+	0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x30, 0x31, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x75, 0x64, 0x61, 0x70, 0x72, 0x61, 0x6d, 0x61, 0x2f, 0x69, 0x73,
+	0x6f, 0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x73, 0x6f,
+	0x32, 0x30, 0x30, 0x32, 0x32, 0x2f, 0x68, 0x65, 0x61, 0x64, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_iso20022_head_proto_rawDescOnce sync.Once
+	file_iso20022_head_proto_rawDescData []byte
+)
+
+func file_iso20022_head_proto_rawDescGZIP() []byte {
+	file_iso20022_head_proto_rawDescOnce.Do(func() {
+		file_iso20022_head_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_iso20022_head_proto_rawDesc), len(file_iso20022_head_proto_rawDesc)))
+	})
+	return file_iso20022_head_proto_rawDescData
+}
+
+var file_iso20022_head_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_iso20022_head_proto_goTypes = []any{
+	(*AppHdr00100101)(nil),                       // 0: iso20022.head.AppHdr00100101
+	(*AppHdr00100102)(nil),                       // 1: iso20022.head.AppHdr00100102
+	(*AppHdr00100103)(nil),                       // 2: iso20022.head.AppHdr00100103
+	(*BusinessApplicationHeaderV01)(nil),         // 3: iso20022.head.BusinessApplicationHeaderV01
+	(*BusinessApplicationHeaderV02)(nil),         // 4: iso20022.head.BusinessApplicationHeaderV02
+	(*BusinessApplicationHeaderV03)(nil),         // 5: iso20022.head.BusinessApplicationHeaderV03
+	(*Document00100101)(nil),                     // 6: iso20022.head.Document00100101
+	(*modelpb.Party9Choice)(nil),                 // 7: iso20022.model.Party9Choice
+	(modelpb.CopyDuplicate1Code)(0),              // 8: iso20022.model.CopyDuplicate1Code
+	(*modelpb.SignatureEnvelope)(nil),            // 9: iso20022.model.SignatureEnvelope
+	(*modelpb.BusinessApplicationHeader1)(nil),   // 10: iso20022.model.BusinessApplicationHeader1
+	(*modelpb.Party44Choice)(nil),                // 11: iso20022.model.Party44Choice
+	(*modelpb.ImplementationSpecification1)(nil), // 12: iso20022.model.ImplementationSpecification1
+	(*modelpb.BusinessApplicationHeader5)(nil),   // 13: iso20022.model.BusinessApplicationHeader5
+	(*modelpb.Party51Choice)(nil),                // 14: iso20022.model.Party51Choice
+	(*modelpb.BusinessApplicationHeader8)(nil),   // 15: iso20022.model.BusinessApplicationHeader8
+}
+var file_iso20022_head_proto_depIdxs = []int32{
+	7,  // 0: iso20022.head.AppHdr00100101.from:type_name -> iso20022.model.Party9Choice
+	7,  // 1: iso20022.head.AppHdr00100101.to:type_name -> iso20022.model.Party9Choice
+	8,  // 2: iso20022.head.AppHdr00100101.copy_duplicate:type_name -> iso20022.model.CopyDuplicate1Code
+	9,  // 3: iso20022.head.AppHdr00100101.signature:type_name -> iso20022.model.SignatureEnvelope
+	10, // 4: iso20022.head.AppHdr00100101.related:type_name -> iso20022.model.BusinessApplicationHeader1
+	11, // 5: iso20022.head.AppHdr00100102.from:type_name -> iso20022.model.Party44Choice
+	11, // 6: iso20022.head.AppHdr00100102.to:type_name -> iso20022.model.Party44Choice
+	12, // 7: iso20022.head.AppHdr00100102.market_practice:type_name -> iso20022.model.ImplementationSpecification1
+	8,  // 8: iso20022.head.AppHdr00100102.copy_duplicate:type_name -> iso20022.model.CopyDuplicate1Code
+	9,  // 9: iso20022.head.AppHdr00100102.signature:type_name -> iso20022.model.SignatureEnvelope
+	13, // 10: iso20022.head.AppHdr00100102.related:type_name -> iso20022.model.BusinessApplicationHeader5
+	14, // 11: iso20022.head.AppHdr00100103.from:type_name -> iso20022.model.Party51Choice
+	14, // 12: iso20022.head.AppHdr00100103.to:type_name -> iso20022.model.Party51Choice
+	12, // 13: iso20022.head.AppHdr00100103.market_practice:type_name -> iso20022.model.ImplementationSpecification1
+	8,  // 14: iso20022.head.AppHdr00100103.copy_duplicate:type_name -> iso20022.model.CopyDuplicate1Code
+	9,  // 15: iso20022.head.AppHdr00100103.signature:type_name -> iso20022.model.SignatureEnvelope
+	15, // 16: iso20022.head.AppHdr00100103.related:type_name -> iso20022.model.BusinessApplicationHeader8
+	7,  // 17: iso20022.head.BusinessApplicationHeaderV01.from:type_name -> iso20022.model.Party9Choice
+	7,  // 18: iso20022.head.BusinessApplicationHeaderV01.to:type_name -> iso20022.model.Party9Choice
+	8,  // 19: iso20022.head.BusinessApplicationHeaderV01.copy_duplicate:type_name -> iso20022.model.CopyDuplicate1Code
+	9,  // 20: iso20022.head.BusinessApplicationHeaderV01.signature:type_name -> iso20022.model.SignatureEnvelope
+	10, // 21: iso20022.head.BusinessApplicationHeaderV01.related:type_name -> iso20022.model.BusinessApplicationHeader1
+	11, // 22: iso20022.head.BusinessApplicationHeaderV02.from:type_name -> iso20022.model.Party44Choice
+	11, // 23: iso20022.head.BusinessApplicationHeaderV02.to:type_name -> iso20022.model.Party44Choice
+	12, // 24: iso20022.head.BusinessApplicationHeaderV02.market_practice:type_name -> iso20022.model.ImplementationSpecification1
+	8,  // 25: iso20022.head.BusinessApplicationHeaderV02.copy_duplicate:type_name -> iso20022.model.CopyDuplicate1Code
+	9,  // 26: iso20022.head.BusinessApplicationHeaderV02.signature:type_name -> iso20022.model.SignatureEnvelope
+	13, // 27: iso20022.head.BusinessApplicationHeaderV02.related:type_name -> iso20022.model.BusinessApplicationHeader5
+	14, // 28: iso20022.head.BusinessApplicationHeaderV03.from:type_name -> iso20022.model.Party51Choice
+	14, // 29: iso20022.head.BusinessApplicationHeaderV03.to:type_name -> iso20022.model.Party51Choice
+	12, // 30: iso20022.head.BusinessApplicationHeaderV03.market_practice:type_name -> iso20022.model.ImplementationSpecification1
+	8,  // 31: iso20022.head.BusinessApplicationHeaderV03.copy_duplicate:type_name -> iso20022.model.CopyDuplicate1Code
+	9,  // 32: iso20022.head.BusinessApplicationHeaderV03.signature:type_name -> iso20022.model.SignatureEnvelope
+	15, // 33: iso20022.head.BusinessApplicationHeaderV03.related:type_name -> iso20022.model.BusinessApplicationHeader8
+	3,  // 34: iso20022.head.Document00100101.message:type_name -> iso20022.head.BusinessApplicationHeaderV01
+	35, // [35:35] is the sub-list for method output_type
+	35, // [35:35] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
+}
+
+func init() { file_iso20022_head_proto_init() }
+func file_iso20022_head_proto_init() {
+	if File_iso20022_head_proto != nil {
+		return
+	}
+	file_iso20022_head_proto_msgTypes[0].OneofWrappers = []any{}
+	file_iso20022_head_proto_msgTypes[1].OneofWrappers = []any{}
+	file_iso20022_head_proto_msgTypes[2].OneofWrappers = []any{}
+	file_iso20022_head_proto_msgTypes[3].OneofWrappers = []any{}
+	file_iso20022_head_proto_msgTypes[4].OneofWrappers = []any{}
+	file_iso20022_head_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_iso20022_head_proto_rawDesc), len(file_iso20022_head_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_iso20022_head_proto_goTypes,
+		DependencyIndexes: file_iso20022_head_proto_depIdxs,
+		MessageInfos:      file_iso20022_head_proto_msgTypes,
+	}.Build()
+	File_iso20022_head_proto = out.File
+	file_iso20022_head_proto_goTypes = nil
+	file_iso20022_head_proto_depIdxs = nil
+}